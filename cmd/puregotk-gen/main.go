@@ -0,0 +1,135 @@
+// Command puregotk-gen generates puregotk bindings for GObject introspectable libraries that are not part of puregotk,
+// e.g. libsecret, libsoup or gexiv2, into a package of your module per namespace:
+//
+//	go install github.com/jwijenbergh/puregotk/cmd/puregotk-gen@latest
+//	puregotk-gen -o ./bindings -module example.com/app/bindings /usr/share/gir-1.0/Secret-1.gir
+//
+// The namespaces that the GIR files include are looked up in the GIR files of puregotk first,
+// the generated packages refer to the puregotk packages of those, e.g. github.com/jwijenbergh/puregotk/v4/gio.
+// Other included namespaces are looked up in the -I directories and have to be generated as well, see -namespaces.
+// The module needs a requirement on github.com/jwijenbergh/puregotk of the same version as puregotk-gen.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/pkg/gir/bindings"
+	"github.com/jwijenbergh/puregotk/pkg/gir/pass"
+)
+
+// includeDirs is a flag that can be given multiple times
+type includeDirs []string
+
+func (d *includeDirs) String() string {
+	return strings.Join(*d, string(os.PathListSeparator))
+}
+
+func (d *includeDirs) Set(dir string) error {
+	*d = append(*d, dir)
+	return nil
+}
+
+func main() {
+	out := flag.String("o", ".", "output directory, a package is generated in it for every namespace")
+	module := flag.String("module", "", "import path of the output directory, derived from the go.mod file above it if empty")
+	namespaces := flag.String("namespaces", "", "comma separated namespaces to generate, e.g. Secret, the namespaces of the given GIR files if empty")
+	var dirs includeDirs
+	flag.Var(&dirs, "I", "directory to look up included GIR files in, can be given multiple times, /usr/share/gir-1.0 and the gir-1.0 directories of XDG_DATA_DIRS if none")
+	suffix := flag.String("library-suffix", "", "suffix of the library names of the generated namespaces, e.g. 3 to load Gtk-3.0 from PUREGOTK_GTK3_PATH")
+	config := flag.String("config", "", "YAML file that configures which symbols are skipped, renamed, retyped or written by hand, see puregotk.yaml")
+	tolerant := flag.Bool("tolerant", false, "leave out symbols of the GIR files that cannot be converted instead of failing")
+	coverage := flag.String("coverage", "", "write a JSON report per namespace of the symbols that are missing from the bindings to this directory")
+	report := flag.String("report", "", "write a JSON summary per namespace of the generated, skipped and downgraded symbols to this directory and print the regressions since the previous summaries in it")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: puregotk-gen [flags] file.gir...\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := bindings.Options{
+		Files:         flag.Args(),
+		Dir:           *out,
+		Module:        *module,
+		IncludeDirs:   dirs,
+		LibrarySuffix: *suffix,
+		Tolerant:      *tolerant,
+		Coverage:      *coverage,
+		Report:        *report,
+	}
+	if *namespaces != "" {
+		for _, ns := range strings.Split(*namespaces, ",") {
+			opts.Namespaces = append(opts.Namespaces, strings.TrimSpace(ns))
+		}
+	}
+	if len(opts.IncludeDirs) == 0 {
+		opts.IncludeDirs = defaultIncludeDirs()
+	}
+	if err := run(opts, *config); err != nil {
+		fmt.Fprintln(os.Stderr, "puregotk-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts bindings.Options, config string) error {
+	if opts.Module == "" {
+		var err error
+		if opts.Module, err = modulePath(opts.Dir); err != nil {
+			return err
+		}
+	}
+	if config != "" {
+		c, err := pass.LoadConfig(config)
+		if err != nil {
+			return err
+		}
+		opts.Config = c
+	}
+	return bindings.Generate(opts)
+}
+
+// defaultIncludeDirs returns the directories that GObject introspection installs GIR files in
+func defaultIncludeDirs() []string {
+	dirs := []string{"/usr/share/gir-1.0"}
+	for _, d := range filepath.SplitList(os.Getenv("XDG_DATA_DIRS")) {
+		if d != "" && d != "/usr/share" {
+			dirs = append(dirs, filepath.Join(d, "gir-1.0"))
+		}
+	}
+	return dirs
+}
+
+// modulePath returns the import path of dir from the module directive of the go.mod file in it or above it
+func modulePath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	var rel []string
+	for d := abs; ; d = filepath.Dir(d) {
+		data, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if m, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					path := strings.Trim(strings.TrimSpace(m), `"`)
+					for i := len(rel) - 1; i >= 0; i-- {
+						path += "/" + rel[i]
+					}
+					return path, nil
+				}
+			}
+			return "", fmt.Errorf("%s has no module directive", filepath.Join(d, "go.mod"))
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("no go.mod file above %s, set -module", abs)
+		}
+		rel = append(rel, filepath.Base(d))
+	}
+}
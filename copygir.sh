@@ -1,5 +1,22 @@
 #!/bin/sh
 
+# copies the GIR files of the bindings from the GNOME SDK
+# pass the names of additional GIR files to add their namespaces, e.g. ./copygir.sh GtkSource-5
+# pass -3 first to add them to the GTK 3 tree in v3 instead, e.g. ./copygir.sh -3 Gtk-3.0 Gdk-3.0 Atk-1.0 xlib-2.0
+
 set -e
 
-for f in internal/gir/spec/*.gir; do flatpak run --filesystem="${PWD}" --command=sh org.gnome.Sdk -c "cp /usr/share/gir-1.0/$(basename ${f}) ${PWD}/${f}"; done
+dest=internal/gir/spec
+if [ "$1" = "-3" ]; then
+	dest=internal/gir/spec/v3
+	mkdir -p "${dest}"
+	shift
+fi
+
+for f in internal/gir/spec/*.gir internal/gir/spec/v3/*.gir; do
+	[ -e "${f}" ] || continue
+	# not part of the GNOME SDK, copy it from a gtk4-layer-shell build
+	[ "$(basename ${f})" = Gtk4LayerShell-1.0.gir ] && continue
+	flatpak run --filesystem="${PWD}" --command=sh org.gnome.Sdk -c "cp /usr/share/gir-1.0/$(basename ${f}) ${PWD}/${f}"
+done
+for n in "$@"; do flatpak run --filesystem="${PWD}" --command=sh org.gnome.Sdk -c "cp /usr/share/gir-1.0/${n}.gir ${PWD}/${dest}/${n}.gir"; done
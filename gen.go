@@ -1,82 +1,286 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 
+	"github.com/jwijenbergh/puregotk/pkg/dbusproxy"
+	"github.com/jwijenbergh/puregotk/pkg/gir/bindings"
 	"github.com/jwijenbergh/puregotk/pkg/gir/pass"
-	"github.com/jwijenbergh/puregotk/pkg/gir/util"
+	"github.com/jwijenbergh/puregotk/pkg/gsettings"
+	"github.com/jwijenbergh/puregotk/templates"
 )
 
 //go:generate go run gen.go
 
+// extras are hand written files that are copied into the generated bindings
+var extras = []struct {
+	src string
+	dst string
+}{
+	{"templates/gobject", "v4/gobject/more.go"},
+	{"templates/gtype", "v4/gobject/types/types.go"},
+	{"templates/gtype_long", "v4/gobject/types/long.go"},
+	{"templates/gtype_long_windows", "v4/gobject/types/long_windows.go"},
+	{"templates/gobject_iface", "v4/gobject/more_iface.go"},
+	{"templates/gobject_data", "v4/gobject/more_data.go"},
+	{"templates/gobject_handlers", "v4/gobject/more_handlers.go"},
+	{"templates/gobject_weak", "v4/gobject/more_weak.go"},
+	{"templates/gobject_properties", "v4/gobject/more_properties.go"},
+	{"templates/gobject_toggle", "v4/gobject/more_toggle.go"},
+	{"templates/gobject_closure", "v4/gobject/more_closure.go"},
+	{"templates/gobject_binding", "v4/gobject/binding/binding.go"},
+	{"templates/gobject_introspect", "v4/gobject/introspect/introspect.go"},
+	{"templates/gobject_introspect_ref", "v4/gobject/introspect/ref.go"},
+	{"templates/gobject_introspect_get", "v4/gobject/introspect/get.go"},
+	{"templates/gobject_value", "v4/gobject/more_value.go"},
+	{"templates/gobject_varargs", "v4/gobject/more_varargs.go"},
+	{"templates/gobject_reflect", "v4/gobject/more_reflect.go"},
+	{"templates/gobject_abi", "v4/gobject/more_abi.go"},
+	{"templates/gobject_signals_test", "v4/gobject/signals_test.go"},
+	{"templates/gobject_value_test", "v4/gobject/value_test.go"},
+	{"templates/gobject_closure_test", "v4/gobject/closure_test.go"},
+	{"templates/glib", "v4/glib/more.go"},
+	{"templates/glib_sysv", "v4/glib/more_sysv.go"},
+	{"templates/glib_windows", "v4/glib/more_windows.go"},
+	{"templates/glib_other", "v4/glib/more_other.go"},
+	{"templates/glib_variant", "v4/glib/more_variant.go"},
+	{"templates/glib_mainloop", "v4/glib/more_mainloop.go"},
+	{"templates/glib_leaks", "v4/glib/more_leaks.go"},
+	{"templates/glib_threads", "v4/glib/more_threads.go"},
+	{"templates/glib_watchdog", "v4/glib/more_watchdog.go"},
+	{"templates/glib_bytes", "v4/glib/more_bytes.go"},
+	{"templates/glib_userdata", "v4/glib/more_userdata.go"},
+	{"templates/gio_actions", "v4/gio/more_actions.go"},
+	{"templates/gio_actions_methods", "v4/gio/more_actions_methods.go"},
+	{"templates/gio_resource", "v4/gio/more_resource.go"},
+	{"templates/gio_notify", "v4/gio/more_notify.go"},
+	{"templates/gio_progress", "v4/gio/more_progress.go"},
+	{"templates/gio_volumes", "v4/gio/more_volumes.go"},
+	{"templates/gio_trash", "v4/gio/more_trash.go"},
+	{"templates/gio_contenttype", "v4/gio/more_contenttype.go"},
+	{"templates/gio_icons", "v4/gio/more_icons.go"},
+	{"templates/gtk_actions", "v4/gtk/more_actions.go"},
+	{"templates/gtk_drawingarea", "v4/gtk/more_drawingarea.go"},
+	{"templates/gtk_paintable", "v4/gtk/more_paintable.go"},
+	{"templates/gtk_expression", "v4/gtk/more_expression.go"},
+	{"templates/gtk_listitemfactory", "v4/gtk/more_listitemfactory.go"},
+	{"templates/gtk_sortfilter", "v4/gtk/more_sortfilter.go"},
+	{"templates/gtk_treelistmodel", "v4/gtk/more_treelistmodel.go"},
+	{"templates/gtk_textbuffer", "v4/gtk/more_textbuffer.go"},
+	{"templates/gtk_cssload", "v4/gtk/more_css.go"},
+	{"templates/gtk_gestures", "v4/gtk/more_gestures.go"},
+	{"templates/gtk_dnd", "v4/gtk/more_dnd.go"},
+	{"templates/gtk_imcontext", "v4/gtk/more_imcontext.go"},
+	{"templates/gtk_varargs", "v4/gtk/more_varargs.go"},
+	{"templates/gtk_varargs_deprecated", "v4/gtk/more_varargs_deprecated.go"},
+	{"templates/gtk_properties", "v4/gtk/more_properties.go"},
+	{"templates/gtk_accessible", "v4/gtk/more_accessible.go"},
+	{"templates/gtk_alertdialog", "v4/gtk/more_alertdialog.go"},
+	{"templates/gtk_filedialog", "v4/gtk/more_filedialog.go"},
+	{"templates/gtk_constraints", "v4/gtk/more_constraints.go"},
+	{"templates/gtk_icons", "v4/gtk/more_icons.go"},
+	{"templates/gtk_metainfo", "v4/gtk/more_metainfo.go"},
+	{"templates/gtk_busy", "v4/gtk/busy/busy.go"},
+	{"templates/gtk_css", "v4/gtk/css/css.go"},
+	{"templates/gtk_theme", "v4/gtk/theme/theme.go"},
+	{"templates/gtk_focus", "v4/gtk/focus/focus.go"},
+	{"templates/gtk_gtktest", "v4/gtk/gtktest/gtktest.go"},
+	{"templates/gtk_gtktest_headless", "v4/gtk/gtktest/headless.go"},
+	{"templates/gtk_replay", "v4/gtk/replay/replay.go"},
+	{"templates/gtk_responsive", "v4/gtk/responsive/responsive.go"},
+	{"templates/gtk_titlebar", "v4/gtk/titlebar/titlebar.go"},
+	{"templates/gtk_vdom", "v4/gtk/vdom/vdom.go"},
+	{"templates/gtk_vdom_container", "v4/gtk/vdom/container.go"},
+	{"templates/gtk_widgetpool", "v4/gtk/widgetpool/widgetpool.go"},
+	{"templates/gtk_treeview", "v4/gtk/treeview/treeview.go"},
+	{"templates/gtk_canvas", "v4/gtk/canvas/canvas.go"},
+	{"templates/gst", "v4/gst/more.go"},
+	{"templates/gst_test", "v4/gst/more_test.go"},
+	{"templates/gtk4layershell", "v4/gtk4layershell/more.go"},
+	{"templates/javascriptcore", "v4/javascriptcore/more.go"},
+	{"templates/javascriptcore_test", "v4/javascriptcore/more_test.go"},
+	{"templates/webkit", "v4/webkit/more.go"},
+	{"templates/xdp", "v4/xdp/more.go"},
+	{"templates/gdk_events", "v4/gdk/more_events.go"},
+	{"templates/gdk_image", "v4/gdk/more_image.go"},
+	{"templates/gdk_clipboard", "v4/gdk/more_clipboard.go"},
+	{"templates/gdk_clipboard_history", "v4/gdk/more_clipboard_history.go"},
+	{"templates/gdk_dnd", "v4/gdk/more_dnd.go"},
+	{"templates/gdkpixbuf_image", "v4/gdkpixbuf/more_image.go"},
+	{"templates/gdk_thumbnails", "v4/gdk/thumbnails/thumbnails.go"},
+	{"templates/adw_about", "v4/adw/more_about.go"},
+	{"templates/adw_about_deprecated", "v4/adw/more_about_deprecated.go"},
+	{"templates/pango", "v4/pango/more.go"},
+	{"templates/gsk_inspect", "v4/gsk/more_inspect.go"},
+	{"templates/gio_dbus", "v4/gio/dbus/dbus.go"},
+	{"templates/gio_dbus_service", "v4/gio/dbus/service.go"},
+}
+
 func main() {
+	schema := flag.String("gsettings", "", "generate typed accessors for a .gschema.xml file instead of the bindings")
+	introspection := flag.String("dbus", "", "generate client proxies for a D-Bus introspection XML file instead of the bindings")
+	pkg := flag.String("pkg", "main", "package name of the generated gsettings accessors or D-Bus proxies")
+	out := flag.String("o", "", "output file of the generated gsettings accessors or D-Bus proxies, defaults to stdout")
+	tolerant := flag.Bool("tolerant", false, "leave out symbols of the GIR files that cannot be converted instead of failing")
+	coverage := flag.String("coverage", "", "write a JSON report per namespace of the symbols that are missing from the bindings to this directory")
+	report := flag.String("report", "", "write a JSON summary per namespace of the generated, skipped and downgraded symbols to this directory and print the regressions since the previous summaries in it")
+	config := flag.String("config", "puregotk.yaml", "YAML file that configures which symbols are skipped, renamed, retyped or written by hand, empty for none")
+	flag.Parse()
+
+	if *schema != "" {
+		genGSettings(*schema, *pkg, *out)
+		return
+	}
+	if *introspection != "" {
+		genDBus(*introspection, *pkg, *out)
+		return
+	}
+
 	dir := "v4"
 	os.RemoveAll(dir)
-	var girs []string
-	filepath.Walk("internal/gir/spec", func(path string, f os.FileInfo, err error) error {
-		if !strings.HasSuffix(path, ".gir") {
-			return nil
-		}
-		girs = append(girs, path)
-		return nil
-	})
-	p, err := pass.New(girs)
+	// the GIR files of the GTK 3 tree are in internal/gir/spec/v3
+	girs, err := filepath.Glob("internal/gir/spec/*.gir")
 	if err != nil {
 		panic(err)
 	}
+	p, err := pass.New(girs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *config != "" {
+		c, err := pass.LoadConfig(*config)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := p.Configure(c); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
 	// collect basic type info
 	p.First()
 
 	// Create the template
-	gotemp, err := template.New("go").Funcs(template.FuncMap{
-		"conv":     util.ConvertArgs,
-		"convc":    util.ConvertArgsComma,
-		"convcb":   util.ConvertCallbackArgs,
-		"convcd":   util.ConvertArgsCommaDeref,
-		"convd":    util.ConvertArgsDeref,
-		"convcbne": util.ConvertCallbackArgsNoErr,
-		"propsset": util.PropertyScalarSet,
-		"propsget": util.PropertyScalarGet,
-		"propvset": util.PropertyVectorSet,
-		"propvget": util.PropertyVectorGet,
-	}).ParseFiles("templates/go")
+	gotemp, err := templates.Parse()
 	if err != nil {
 		panic(err)
 	}
 
 	// Write go files by making the second pass
 	p.Second(dir, gotemp)
+	pkgs := make(map[string]string)
+	var dirs []string
+	for _, r := range p.Parsed {
+		pkg := strings.ToLower(r.Namespaces[0].Name)
+		pkgs[pkg] = "github.com/jwijenbergh/puregotk/v4/" + pkg
+		dirs = append(dirs, filepath.Join(dir, pkg))
+	}
+	if err := bindings.FixImports(dirs, pkgs); err != nil {
+		panic(err)
+	}
+	if len(p.Diagnostics) > 0 {
+		fmt.Fprintln(os.Stderr, p.Diagnostics.Summary())
+	}
+	if !*tolerant && p.Diagnostics.Errors() > 0 {
+		fmt.Fprintln(os.Stderr, "some symbols could not be converted, run with -tolerant to generate the bindings without them")
+		os.Exit(1)
+	}
+	if *coverage != "" {
+		if err := p.WriteCoverage(*coverage); err != nil {
+			panic(err)
+		}
+	}
+	if *report != "" {
+		if err := p.WriteReports(*report, os.Stderr); err != nil {
+			panic(err)
+		}
+	}
 
 	// Finally copy some extra code that we want in the API
-	data, err := os.ReadFile("templates/gobject")
-	if err == nil {
-		os.WriteFile("v4/gobject/more.go", data, 0o644)
-	}
-	data, err = os.ReadFile("templates/gtype")
-	if err == nil {
-		mkerr := os.MkdirAll("v4/gobject/types", 0o755)
-		if mkerr != nil {
-			panic(mkerr)
+	for _, e := range extras {
+		data, err := os.ReadFile(e.src)
+		if err != nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(e.dst), 0o755); err != nil {
+			panic(err)
 		}
-		os.WriteFile("v4/gobject/types/types.go", data, 0o644)
+		os.WriteFile(e.dst, data, 0o644)
 	}
-	data, err = os.ReadFile("templates/glib")
-	if err == nil {
-		os.WriteFile("v4/glib/more.go", data, 0o644)
+
+	genV3(*tolerant, *coverage, *report)
+}
+
+// genV3 generates the GTK 3 tree in v3 from the GIR files in internal/gir/spec/v3, if there are any.
+// The namespaces that it shares with v4, e.g. GLib, Gio and Pango, are imported from v4,
+// the other namespaces are loaded with library names that end with 3, e.g. PUREGOTK_GTK3_PATH.
+// The configuration is only for v4, its entries would not match the symbols of GTK 3.
+func genV3(tolerant bool, coverage string, report string) {
+	girs, err := filepath.Glob("internal/gir/spec/v3/*.gir")
+	if err != nil {
+		panic(err)
 	}
-	data, err = os.ReadFile("templates/glib_sysv")
-	if err == nil {
-		os.WriteFile("v4/glib/more_sysv.go", data, 0o644)
+	if len(girs) == 0 {
+		return
+	}
+	os.RemoveAll("v3")
+	err = bindings.Generate(bindings.Options{
+		Files:         girs,
+		Dir:           "v3",
+		Module:        "github.com/jwijenbergh/puregotk/v3",
+		LibrarySuffix: "3",
+		Tolerant:      tolerant,
+		Coverage:      coverage,
+		Report:        report,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "v3:", err)
+		os.Exit(1)
 	}
-	data, err = os.ReadFile("templates/glib_windows")
-	if err == nil {
-		os.WriteFile("v4/glib/more_windows.go", data, 0o644)
+}
+
+// genGSettings generates typed accessors for the schemas in a .gschema.xml file
+func genGSettings(schema string, pkg string, out string) {
+	sl, err := gsettings.ParseFile(schema)
+	if err != nil {
+		panic(err)
+	}
+	w := os.Stdout
+	if out != "" {
+		w, err = os.Create(out)
+		if err != nil {
+			panic(err)
+		}
+		defer w.Close()
 	}
-	data, err = os.ReadFile("templates/glib_other")
-	if err == nil {
-		os.WriteFile("v4/glib/more_other.go", data, 0o644)
+	err = gsettings.Generate(w, sl, pkg, filepath.Base(schema))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// genDBus generates client proxies for the interfaces in a D-Bus introspection XML file
+func genDBus(introspection string, pkg string, out string) {
+	n, err := dbusproxy.ParseFile(introspection)
+	if err != nil {
+		panic(err)
+	}
+	w := os.Stdout
+	if out != "" {
+		w, err = os.Create(out)
+		if err != nil {
+			panic(err)
+		}
+		defer w.Close()
+	}
+	err = dbusproxy.Generate(w, n, pkg, filepath.Base(introspection))
+	if err != nil {
+		panic(err)
 	}
 }
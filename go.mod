@@ -1,7 +1,10 @@
 module github.com/jwijenbergh/puregotk
 
-go 1.23.0
+go 1.24.0
 
 toolchain go1.24.5
 
-require github.com/jwijenbergh/purego v0.0.0-20251017112123-b71757b9ba42
+require (
+	github.com/jwijenbergh/purego v0.0.0-20251017112123-b71757b9ba42
+	gopkg.in/yaml.v3 v3.0.1
+)
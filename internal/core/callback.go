@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// MaxCallbacks is the number of callbacks that can be alive at the same time, a limit of purego
+const MaxCallbacks = 2000
+
+// Debug reports whether the comma separated PUREGOTK_DEBUG environment variable contains flag, e.g. "leaks"
+func Debug(flag string) bool {
+	for _, f := range strings.Split(os.Getenv("PUREGOTK_DEBUG"), ",") {
+		if strings.TrimSpace(f) == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// recordStacks is set with PUREGOTK_DEBUG=leaks, the creation stack of every callback is then recorded
+var recordStacks = Debug("leaks")
+
+// liveCallbacks holds the callbacks that were created and not released by their C function pointer
+// the value is the stack trace of the creation, or nil if stacks are not recorded
+var liveCallbacks = struct {
+	sync.Mutex
+	stacks map[uintptr][]byte
+}{
+	stacks: make(map[uintptr][]byte),
+}
+
+func trackCallback(cb uintptr) uintptr {
+	var stack []byte
+	if recordStacks {
+		stack = debug.Stack()
+	}
+	liveCallbacks.Lock()
+	liveCallbacks.stacks[cb] = stack
+	liveCallbacks.Unlock()
+	return cb
+}
+
+func untrackCallback(cb uintptr) {
+	liveCallbacks.Lock()
+	delete(liveCallbacks.stacks, cb)
+	liveCallbacks.Unlock()
+}
+
+// LiveCallbacks returns the number of callbacks that are alive
+// and, with PUREGOTK_DEBUG=leaks, the stack traces of where they were created
+func LiveCallbacks() (int, [][]byte) {
+	liveCallbacks.Lock()
+	defer liveCallbacks.Unlock()
+	var stacks [][]byte
+	for _, stack := range liveCallbacks.stacks {
+		if stack != nil {
+			stacks = append(stacks, stack)
+		}
+	}
+	return len(liveCallbacks.stacks), stacks
+}
+
+// PanicHandler is called with the recovered value and the stack trace of a panic in a Go callback called from C
+type PanicHandler func(v interface{}, stack []byte)
+
+var panicHandler = struct {
+	sync.RWMutex
+	fn PanicHandler
+}{
+	fn: printPanic,
+}
+
+// printPanic is the default PanicHandler
+func printPanic(v interface{}, stack []byte) {
+	fmt.Fprintf(os.Stderr, "puregotk: recovered panic in callback: %v\n%s", v, stack)
+}
+
+// SetPanicHandler sets the function that is called when a Go callback panics, nil restores the default handler
+// which writes the panic and its stack trace to standard error
+func SetPanicHandler(fn PanicHandler) {
+	if fn == nil {
+		fn = printPanic
+	}
+	panicHandler.Lock()
+	panicHandler.fn = fn
+	panicHandler.Unlock()
+}
+
+// RecoverPanic recovers a panic of the Go callback that defers it and passes it to the panic handler,
+// instead of unwinding through the C frames, which crashes the process with an unreadable stack.
+// The callback then returns its zero values. The callbacks of the bindings defer it as their first statement:
+//
+//	core.NewCallback(func(data uintptr) bool {
+//		defer core.RecoverPanic()
+//		...
+//	})
+//
+// It has to be deferred directly, as recover only stops a panic when the deferred function calls it.
+func RecoverPanic() {
+	if r := recover(); r != nil {
+		stack := debug.Stack()
+		panicHandler.RLock()
+		handler := panicHandler.fn
+		panicHandler.RUnlock()
+		handler(r, stack)
+	}
+}
+
+// fnPtrCallbacks maps the function pointers passed to NewCallbackFnPtr to their callbacks,
+// purego reuses the callback for the same function pointer
+var fnPtrCallbacks = struct {
+	sync.Mutex
+	cbs map[uintptr]uintptr
+	// ptrs maps the C function pointers to the keys of cbs
+	ptrs map[uintptr]uintptr
+}{
+	cbs:  make(map[uintptr]uintptr),
+	ptrs: make(map[uintptr]uintptr),
+}
+
+// trackCallbackFnPtr tracks the callback cb created for fnPtr
+func trackCallbackFnPtr(fnPtr interface{}, cb uintptr) uintptr {
+	val := reflect.ValueOf(fnPtr)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return cb
+	}
+	fnPtrCallbacks.Lock()
+	fnPtrCallbacks.cbs[val.Pointer()] = cb
+	fnPtrCallbacks.ptrs[cb] = val.Pointer()
+	fnPtrCallbacks.Unlock()
+	return trackCallback(cb)
+}
+
+// forgetCallbackFnPtr stops tracking the callback of fnPtr
+func forgetCallbackFnPtr(fnPtr interface{}) {
+	val := reflect.ValueOf(fnPtr)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return
+	}
+	fnPtrCallbacks.Lock()
+	cb, ok := fnPtrCallbacks.cbs[val.Pointer()]
+	delete(fnPtrCallbacks.cbs, val.Pointer())
+	delete(fnPtrCallbacks.ptrs, cb)
+	fnPtrCallbacks.Unlock()
+	if ok {
+		untrackCallback(cb)
+	}
+}
+
+// forgetCallback stops tracking the callback, including the function pointer if it was created by NewCallbackFnPtr
+func forgetCallback(cb uintptr) {
+	untrackCallback(cb)
+	fnPtrCallbacks.Lock()
+	defer fnPtrCallbacks.Unlock()
+	if key, ok := fnPtrCallbacks.ptrs[cb]; ok {
+		delete(fnPtrCallbacks.ptrs, cb)
+		delete(fnPtrCallbacks.cbs, key)
+	}
+}
@@ -4,27 +4,168 @@ package core
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"sync"
 	"unsafe"
-
-	"github.com/jwijenbergh/purego"
 )
 
+// ErrUnsupported is returned on platforms where the GTK libraries cannot be called,
+// see Supported
+var ErrUnsupported = errors.New("puregotk: GTK is not supported on " + runtime.GOOS + "/" + runtime.GOARCH)
+
+// ErrUnsupportedABI is wrapped by the error of a generated function whose C signature purego cannot call,
+// e.g. because it passes a struct by value, see UnsupportedABI
+var ErrUnsupportedABI = errors.New("puregotk: the C function cannot be called through purego")
+
+// unsupportedABIWarned are the symbols whose UnsupportedABI error was written to standard error
+var unsupportedABIWarned sync.Map
+
+// UnsupportedABI returns the error of calling the C function symbol, which the generator replaced with a stub
+// because purego cannot call it, reason describes why. The first call for a symbol also writes the error
+// to standard error, as the stubs of functions that do not throw cannot return it and return zero values.
+func UnsupportedABI(symbol, reason string) error {
+	err := fmt.Errorf("%w: %s: %s", ErrUnsupportedABI, symbol, reason)
+	if _, warned := unsupportedABIWarned.LoadOrStore(symbol, true); !warned {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
+	return err
+}
+
+// MissingSymbolError is the value that a generated function panics with if its C function is not
+// in the loaded library, e.g. a function that was added in GTK 4.12 on a system with GTK 4.8.
+// The panic happens on the first call and not at init, check the version of the library before the call,
+// e.g. with gtk.CheckVersionAtLeast, or look up the symbols that are missing with MissingSymbols.
+type MissingSymbolError struct {
+	// Symbol is the name of the C function
+	Symbol string
+	// Files are the file names of the libraries that the symbol was looked up in, e.g. libgtk-4.so.1
+	Files []string
+	// Library is the name of the library in the load environment variable, e.g. GTK, empty if it is not known
+	Library string
+	// Version is the version of the library that added the C function, empty if it is not known
+	Version string
+	// Err is the error of loading the library if it could not be loaded, see LibraryError
+	Err error
+}
+
+func (e *MissingSymbolError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("puregotk: symbol %s not found: %v", e.Symbol, e.Err)
+	}
+	msg := fmt.Sprintf("puregotk: symbol %s not found in %s", e.Symbol, strings.Join(e.Files, ", "))
+	if e.Library != "" && e.Version != "" {
+		msg += fmt.Sprintf(" (requires %s >= %s)", e.Library, e.Version)
+	}
+	return msg
+}
+
+func (e *MissingSymbolError) Unwrap() error {
+	return e.Err
+}
+
+// openedLibraries are the paths of the libraries opened with Dlopen by their handle
+var openedLibraries sync.Map
+
+// libraryErrors are the errors of the optional libraries that could not be loaded by library name
+var libraryErrors sync.Map
+
+// SetLibraryError records that the library name, e.g. GTK4LAYERSHELL, could not be loaded.
+// The generated bindings of optional libraries call it instead of panicking, see LibraryError.
+func SetLibraryError(name string, err error) {
+	libraryErrors.Store(name, err)
+}
+
+// LibraryError returns the error of loading the optional library name, e.g. GTK4LAYERSHELL, or nil if it was loaded.
+// The functions of a library that could not be loaded panic with a *MissingSymbolError that wraps the error,
+// so an application can check it to run without the library, e.g. without layer surfaces if gtk4-layer-shell is not installed.
+func LibraryError(name string) error {
+	if err, ok := libraryErrors.Load(name); ok {
+		return err.(error)
+	}
+	return nil
+}
+
+// missingSymbols are the symbols that were not found by PuregoSafeRegister and PuregoRegisterSince in registration order
+var missingSymbols struct {
+	sync.Mutex
+	errs []*MissingSymbolError
+}
+
+// MissingSymbols returns the symbols that were not found in the loaded libraries so far, in the order of registration.
+// The bindings of a package register their symbols when it is initialized, so an application can call it at startup
+// to tell which functions it cannot call, e.g. to report that the installed GTK is too old.
+// It is empty on unsupported platforms, see Supported.
+func MissingSymbols() []*MissingSymbolError {
+	missingSymbols.Lock()
+	defer missingSymbols.Unlock()
+	return append([]*MissingSymbolError(nil), missingSymbols.errs...)
+}
+
+// PuregoSafeRegister sets the function pointed to by fptr to call the C function name of the first of libs that has it.
+// If none has it, the function stays nil and the symbol is recorded, see MissingSymbols.
 func PuregoSafeRegister(fptr interface{}, libs []uintptr, name string) {
+	register(fptr, libs, name, "", "")
+}
+
+// PuregoRegisterSince is PuregoSafeRegister for the generated functions, version is the version of library that added
+// the C function or empty if it is not known. If the loaded library does not have it, the function pointed to by fptr
+// panics with a *MissingSymbolError when it is called instead of leaving it nil.
+func PuregoRegisterSince(fptr interface{}, libs []uintptr, name string, library string, version string) {
+	err := register(fptr, libs, name, library, version)
+	if err == nil {
+		return
+	}
+	fn := reflect.ValueOf(fptr).Elem()
+	fn.Set(reflect.MakeFunc(fn.Type(), func([]reflect.Value) []reflect.Value {
+		panic(err)
+	}))
+}
+
+// register sets the function pointed to by fptr to call the C function name, it returns the recorded error if none of libs has it
+func register(fptr interface{}, libs []uintptr, name string, library string, version string) *MissingSymbolError {
 	for _, lib := range libs {
-		sym, err := purego.Dlsym(lib, name)
+		sym, err := Dlsym(lib, name)
 		if err == nil {
-			purego.RegisterFunc(fptr, sym)
+			RegisterFunc(fptr, sym)
 
-			return
+			return nil
 		}
 	}
+	if !Supported {
+		registerStub(fptr)
+		return nil
+	}
+	err := &MissingSymbolError{Symbol: name, Library: library, Version: version, Err: LibraryError(library)}
+	for _, lib := range libs {
+		if path, ok := openedLibraries.Load(lib); ok {
+			err.Files = append(err.Files, filepath.Base(path.(string)))
+		}
+	}
+	missingSymbols.Lock()
+	missingSymbols.errs = append(missingSymbols.errs, err)
+	missingSymbols.Unlock()
+	return err
+}
+
+// registerStub sets the function pointed to by fptr to a function that returns zero values
+// this keeps the bindings interface compatible on unsupported platforms
+func registerStub(fptr interface{}) {
+	fn := reflect.ValueOf(fptr).Elem()
+	t := fn.Type()
+	outs := make([]reflect.Value, t.NumOut())
+	for i := range outs {
+		outs[i] = reflect.Zero(t.Out(i))
+	}
+	fn.Set(reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+		return outs
+	}))
 }
 
 // paths to where the shared object files should be located
@@ -50,10 +191,16 @@ var names = map[string][]string{}
 // This is populated dynamically via SetPackageName
 var pkgConfNames = map[string]string{}
 
+// libNamesMu guards names and pkgConfNames, the bindings of each namespace register their libraries
+// while libraries of other namespaces may be looked up
+var libNamesMu sync.RWMutex
+
 // SetPackageName registers a pkg-config package name for a library.
 // This is used by the code generator to set package names from GIR files.
 // It won't override existing entries to preserve defaults.
 func SetPackageName(libName, pkgName string) {
+	libNamesMu.Lock()
+	defer libNamesMu.Unlock()
 	if _, exists := pkgConfNames[libName]; !exists && pkgName != "" {
 		pkgConfNames[libName] = pkgName
 	}
@@ -63,6 +210,8 @@ func SetPackageName(libName, pkgName string) {
 // This is used by the code generator to set library names from GIR files.
 // It won't override existing entries to preserve defaults.
 func SetSharedLibraries(libName string, sharedLibs []string) {
+	libNamesMu.Lock()
+	defer libNamesMu.Unlock()
 	if _, exists := names[libName]; !exists && len(sharedLibs) > 0 {
 		names[libName] = sharedLibs
 	}
@@ -72,7 +221,10 @@ func SetSharedLibraries(libName string, sharedLibs []string) {
 // It does this by mapping the library name to all suitable shared object filenames and then trying some suffixes
 func findSos(path string, name string) []string {
 	sos := []string{}
-	for _, n := range names[name] {
+	libNamesMu.RLock()
+	libs := names[name]
+	libNamesMu.RUnlock()
+	for _, n := range libs {
 		suffixes := []string{"", ".0", ".1", ".2"}
 		fn := filepath.Join(path, n)
 		for _, s := range suffixes {
@@ -88,7 +240,10 @@ func findSos(path string, name string) []string {
 // it does this by running pkg-config --libs-only-L libname
 // and then it loops over the directories returned and finds all suitable ones
 func findPkgConf(name string) []string {
-	cmd := exec.Command("pkg-config", "--libs-only-L", pkgConfNames[name])
+	libNamesMu.RLock()
+	pkgName := pkgConfNames[name]
+	libNamesMu.RUnlock()
+	cmd := exec.Command("pkg-config", "--libs-only-L", pkgName)
 	var out, outerr bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = &outerr
@@ -119,13 +274,28 @@ func findPkgConf(name string) []string {
 // go over the hardcoded paths
 // find a library name with pkg-config
 // panic if failed
+// On unsupported platforms no paths are returned, such that the generated functions are stubs
 // TODO: Hardcore a library shared object with linker -X flag
 // This is useful for packaging
 func GetPaths(name string) []string {
+	paths, err := LookupPaths(name)
+	if err != nil {
+		panic(err.Error())
+	}
+	return paths
+}
+
+// LookupPaths gets all shared object files from a library name like GetPaths,
+// but returns an error instead of panicking if none is found, for the libraries that may be missing at runtime
+func LookupPaths(name string) ([]string, error) {
+	if !Supported {
+		return nil, nil
+	}
+
 	// try to get from env var
 	ev := fmt.Sprintf("PUREGOTK_%s_PATH", name)
 	if v := os.Getenv(ev); v != "" {
-		return []string{v}
+		return []string{v}, nil
 	}
 
 	// Or if a general folder is set where everywhere is located, return that
@@ -133,9 +303,9 @@ func GetPaths(name string) []string {
 	if ep != "" {
 		g := findSos(ep, name)
 		if len(g) == 0 {
-			panic(fmt.Sprintf("Could not find lib: %s, at path: %s with env: %s", name, ep, "PUREGOTK_FOLDER"))
+			return nil, fmt.Errorf("Could not find lib: %s, at path: %s with env: %s", name, ep, "PUREGOTK_FOLDER")
 		}
-		return g
+		return g, nil
 	}
 
 	// fallback to lookup a path if no env var is found
@@ -145,7 +315,7 @@ func GetPaths(name string) []string {
 		for _, p := range gp {
 			g := findSos(p, name)
 			if len(g) > 0 {
-				return g
+				return g, nil
 			}
 
 		}
@@ -153,10 +323,10 @@ func GetPaths(name string) []string {
 	// last effort: pkg-config
 	g := findPkgConf(name)
 	if len(g) > 0 {
-		return g
+		return g, nil
 	}
 
-	panic(fmt.Sprintf("Path for library: %s not found. Please set the path to this library shared object file manually with env variable: %s or PUREGOTK_LIB_FOLDER. Or make sure pkg-config is setup correctly", strings.ToLower(name), ev))
+	return nil, fmt.Errorf("Path for library: %s not found. Please set the path to this library shared object file manually with env variable: %s or PUREGOTK_LIB_FOLDER. Or make sure pkg-config is setup correctly", strings.ToLower(name), ev)
 }
 
 // hasSuffix tests whether the string s ends with suffix.
@@ -196,6 +366,9 @@ func CString(name string) *byte {
 // This function was copied from purego
 func GoStringSlice(c uintptr) []string {
 	var ret []string
+	if c == 0 {
+		return ret
+	}
 	for i := 0; ; i++ {
 		ptrAddr := c + uintptr(i)*unsafe.Sizeof(uintptr(0))
 		addr := *(*unsafe.Pointer)(unsafe.Pointer(&ptrAddr))
@@ -228,11 +401,61 @@ func GoString(c uintptr) string {
 	return string(unsafe.Slice((*byte)(ptr), length))
 }
 
+// GoRunes copies an array of gunichar, which are UTF-32 code points, to a Go slice.
+// A negative n reads until the terminating zero, e.g. for the result of g_utf8_to_ucs4_fast.
+func GoRunes(c uintptr, n int) []rune {
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	ptr := *(*unsafe.Pointer)(unsafe.Pointer(&c))
+	if ptr == nil {
+		return nil
+	}
+	if n < 0 {
+		n = 0
+		for *(*rune)(unsafe.Add(ptr, uintptr(n)*4)) != 0 {
+			n++
+		}
+	}
+	return append([]rune(nil), unsafe.Slice((*rune)(ptr), n)...)
+}
+
+// RuneSlice returns a zero terminated copy of runes that can be passed as an array of gunichar to C code.
+func RuneSlice(runes []rune) *rune {
+	res := make([]rune, len(runes)+1)
+	copy(res, runes)
+	return &res[0]
+}
+
+// GoStringSliceFull copies a NULL terminated char** array that the caller owns with transfer full
+// to a string slice and frees the array and its strings with g_strfreev, e.g. the result of g_strsplit.
+func GoStringSliceFull(c uintptr) []string {
+	if c == 0 {
+		return nil
+	}
+	ret := GoStringSlice(c)
+	GStrfreev(c)
+	return ret
+}
+
+// GoStringSliceContainer copies a NULL terminated char** array that the caller owns with transfer container
+// to a string slice and frees only the array with g_free, its strings are owned by the callee.
+func GoStringSliceContainer(c uintptr) []string {
+	if c == 0 {
+		return nil
+	}
+	ret := GoStringSlice(c)
+	GFree(c)
+	return ret
+}
+
 var (
-	xGStrdup    func(string) uintptr
-	gstrdupOnce sync.Once
-	xGFree      func(uintptr)
-	gfreeOnce   sync.Once
+	xGStrdup      func(string) uintptr
+	gstrdupOnce   sync.Once
+	xGFree        func(uintptr)
+	gfreeOnce     sync.Once
+	xGMalloc0     func(uintptr) uintptr
+	gmallocOnce   sync.Once
+	xGStrfreev    func(uintptr)
+	gstrfreevOnce sync.Once
 )
 
 // GStrdup allocates a C-owned copy of a Go string using g_strdup.
@@ -242,7 +465,7 @@ func GStrdup(s string) uintptr {
 	gstrdupOnce.Do(func() {
 		var libs []uintptr
 		for _, libPath := range GetPaths("GLIB") {
-			lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+			lib, err := Dlopen(libPath, RTLD_NOW|RTLD_GLOBAL)
 			if err != nil {
 				continue
 			}
@@ -271,7 +494,7 @@ func GFree(ptr uintptr) {
 	gfreeOnce.Do(func() {
 		var libs []uintptr
 		for _, libPath := range GetPaths("GLIB") {
-			lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+			lib, err := Dlopen(libPath, RTLD_NOW|RTLD_GLOBAL)
 			if err != nil {
 				continue
 			}
@@ -282,6 +505,43 @@ func GFree(ptr uintptr) {
 	xGFree(ptr)
 }
 
+// GMalloc0 allocates size zeroed bytes using g_malloc0.
+// The returned pointer must be freed with g_free.
+func GMalloc0(size uintptr) uintptr {
+	gmallocOnce.Do(func() {
+		var libs []uintptr
+		for _, libPath := range GetPaths("GLIB") {
+			lib, err := Dlopen(libPath, RTLD_NOW|RTLD_GLOBAL)
+			if err != nil {
+				continue
+			}
+			libs = append(libs, lib)
+		}
+		PuregoSafeRegister(&xGMalloc0, libs, "g_malloc0")
+	})
+	return xGMalloc0(size)
+}
+
+// GStrfreev frees a NULL terminated char** array and its strings using g_strfreev.
+// Passing 0 is a no-op.
+func GStrfreev(ptr uintptr) {
+	if ptr == 0 {
+		return
+	}
+	gstrfreevOnce.Do(func() {
+		var libs []uintptr
+		for _, libPath := range GetPaths("GLIB") {
+			lib, err := Dlopen(libPath, RTLD_NOW|RTLD_GLOBAL)
+			if err != nil {
+				continue
+			}
+			libs = append(libs, lib)
+		}
+		PuregoSafeRegister(&xGStrfreev, libs, "g_strfreev")
+	})
+	xGStrfreev(ptr)
+}
+
 // GFreeNullable frees a nullable GLib-allocated pointer.
 func GFreeNullable(ptr uintptr) {
 	if ptr == 0 {
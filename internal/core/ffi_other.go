@@ -0,0 +1,50 @@
+//go:build !windows && !darwin && !netbsd && (!linux || (!amd64 && !arm64 && !loong64))
+
+package core
+
+// Supported reports whether the bindings can call into the GTK libraries on this platform
+// On this platform the bindings compile, but every function is a stub that returns zero values
+const Supported = false
+
+// PlatformError is returned by generated functions that throw when no GError was set
+var PlatformError error = ErrUnsupported
+
+const (
+	RTLD_NOW    = 0
+	RTLD_GLOBAL = 0
+)
+
+// Dlopen always fails with ErrUnsupported
+func Dlopen(string, int) (uintptr, error) {
+	return 0, ErrUnsupported
+}
+
+// Dlsym always fails with ErrUnsupported
+func Dlsym(uintptr, string) (uintptr, error) {
+	return 0, ErrUnsupported
+}
+
+// RegisterFunc sets the function pointed to by fptr to a stub that returns zero values
+func RegisterFunc(fptr interface{}, _ uintptr) {
+	registerStub(fptr)
+}
+
+// NewCallback returns 0, callbacks are never called on this platform
+func NewCallback(interface{}) uintptr {
+	return 0
+}
+
+// NewCallbackFnPtr returns 0, callbacks are never called on this platform
+func NewCallbackFnPtr(interface{}) uintptr {
+	return 0
+}
+
+// UnrefCallback always fails with ErrUnsupported
+func UnrefCallback(uintptr) error {
+	return ErrUnsupported
+}
+
+// UnrefCallbackFnPtr always fails with ErrUnsupported
+func UnrefCallbackFnPtr(interface{}) error {
+	return ErrUnsupported
+}
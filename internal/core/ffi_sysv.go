@@ -0,0 +1,59 @@
+//go:build darwin || netbsd || (linux && (amd64 || arm64 || loong64))
+
+package core
+
+import "github.com/jwijenbergh/purego"
+
+// Supported reports whether the bindings can call into the GTK libraries on this platform
+const Supported = true
+
+// PlatformError is returned by generated functions that throw when no GError was set
+// It is nil on supported platforms
+var PlatformError error
+
+const (
+	RTLD_NOW    = purego.RTLD_NOW
+	RTLD_GLOBAL = purego.RTLD_GLOBAL
+)
+
+// Dlopen opens the shared library at path
+func Dlopen(path string, mode int) (uintptr, error) {
+	lib, err := purego.Dlopen(path, mode)
+	if err == nil {
+		openedLibraries.Store(lib, path)
+	}
+	return lib, err
+}
+
+// Dlsym returns the address of the symbol name in the library
+func Dlsym(lib uintptr, name string) (uintptr, error) {
+	return purego.Dlsym(lib, name)
+}
+
+// RegisterFunc sets the function pointed to by fptr to call the C function at cfn
+func RegisterFunc(fptr interface{}, cfn uintptr) {
+	purego.RegisterFunc(fptr, cfn)
+}
+
+// NewCallback returns a C function pointer that calls the Go function fn,
+// fn should defer RecoverPanic such that a panic does not unwind through C
+func NewCallback(fn interface{}) uintptr {
+	return trackCallback(purego.NewCallback(fn))
+}
+
+// NewCallbackFnPtr is like NewCallback but takes a pointer to the Go function
+func NewCallbackFnPtr(fnPtr interface{}) uintptr {
+	return trackCallbackFnPtr(fnPtr, purego.NewCallbackFnPtr(fnPtr))
+}
+
+// UnrefCallback releases the callback with the C function pointer cb
+func UnrefCallback(cb uintptr) error {
+	forgetCallback(cb)
+	return purego.UnrefCallback(cb)
+}
+
+// UnrefCallbackFnPtr releases the callback created by NewCallbackFnPtr for the function pointer
+func UnrefCallbackFnPtr(fnPtr interface{}) error {
+	forgetCallbackFnPtr(fnPtr)
+	return purego.UnrefCallbackFnPtr(fnPtr)
+}
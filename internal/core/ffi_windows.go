@@ -0,0 +1,60 @@
+package core
+
+import (
+	"syscall"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// Supported reports whether the bindings can call into the GTK libraries on this platform
+const Supported = true
+
+// PlatformError is returned by generated functions that throw when no GError was set
+// It is nil on supported platforms
+var PlatformError error
+
+// RTLD_NOW and RTLD_GLOBAL are accepted for portability, Windows always resolves symbols globally
+const (
+	RTLD_NOW    = 0
+	RTLD_GLOBAL = 0
+)
+
+// Dlopen opens the DLL at path
+func Dlopen(path string, _ int) (uintptr, error) {
+	lib, err := syscall.LoadLibrary(path)
+	if err == nil {
+		openedLibraries.Store(uintptr(lib), path)
+	}
+	return uintptr(lib), err
+}
+
+// Dlsym returns the address of the symbol name in the DLL
+func Dlsym(lib uintptr, name string) (uintptr, error) {
+	return syscall.GetProcAddress(syscall.Handle(lib), name)
+}
+
+// RegisterFunc sets the function pointed to by fptr to call the C function at cfn
+func RegisterFunc(fptr interface{}, cfn uintptr) {
+	purego.RegisterFunc(fptr, cfn)
+}
+
+// NewCallback returns a C function pointer that calls the Go function fn,
+// fn should defer RecoverPanic such that a panic does not unwind through C
+func NewCallback(fn interface{}) uintptr {
+	return trackCallback(purego.NewCallback(fn))
+}
+
+// NewCallbackFnPtr is like NewCallback but takes a pointer to the Go function
+func NewCallbackFnPtr(fnPtr interface{}) uintptr {
+	return trackCallbackFnPtr(fnPtr, purego.NewCallbackFnPtr(fnPtr))
+}
+
+// UnrefCallback is a NOOP, Windows does not support releasing callbacks
+func UnrefCallback(uintptr) error {
+	return nil
+}
+
+// UnrefCallbackFnPtr is a NOOP, Windows does not support releasing callbacks
+func UnrefCallbackFnPtr(interface{}) error {
+	return nil
+}
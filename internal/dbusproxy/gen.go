@@ -0,0 +1,375 @@
+package dbusproxy
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// basicTypes maps the basic D-Bus types to the Go types that (*glib.Variant).GoValue returns
+var basicTypes = map[byte]string{
+	'b': "bool",
+	'y': "byte",
+	'n': "int16",
+	'q': "uint16",
+	'i': "int32",
+	'u': "uint32",
+	'x': "int64",
+	't': "uint64",
+	'h': "int32",
+	'd': "float64",
+	's': "string",
+	'o': "string",
+	'g': "string",
+	'v': "interface{}",
+}
+
+// GoType returns the Go type that a value of D-Bus signature sig is converted to
+func GoType(sig string) string {
+	if len(sig) == 1 {
+		if t, ok := basicTypes[sig[0]]; ok {
+			return t
+		}
+	}
+	switch {
+	case sig == "as" || sig == "ao":
+		return "[]string"
+	case sig == "ay":
+		return "[]byte"
+	case strings.HasPrefix(sig, "a{"):
+		if strings.ContainsRune("sog", rune(sig[2])) {
+			return "map[string]interface{}"
+		}
+		return "map[interface{}]interface{}"
+	case strings.HasPrefix(sig, "a"), strings.HasPrefix(sig, "("):
+		return "[]interface{}"
+	}
+	return "interface{}"
+}
+
+type argTemplate struct {
+	Name   string
+	GoType string
+}
+
+type methodTemplate struct {
+	Name       string
+	CName      string
+	Deprecated bool
+	InSig      string
+	In         []argTemplate
+	Out        []argTemplate
+}
+
+type signalTemplate struct {
+	Name       string
+	CName      string
+	Deprecated bool
+	Args       []argTemplate
+}
+
+type propertyTemplate struct {
+	Name       string
+	CName      string
+	Type       string
+	GoType     string
+	Deprecated bool
+	Read       bool
+	Write      bool
+}
+
+type interfaceTemplate struct {
+	Name       string
+	ID         string
+	Deprecated bool
+	Methods    []methodTemplate
+	Signals    []signalTemplate
+	Properties []propertyTemplate
+}
+
+type fileTemplate struct {
+	Source     string
+	Package    string
+	NeedsGLib  bool
+	Interfaces []interfaceTemplate
+}
+
+// GoName converts a D-Bus interface name to an exported Go name
+// the first two components are dropped as they are the reverse domain
+// e.g. org.freedesktop.portal.FileChooser becomes PortalFileChooser
+func GoName(iface string) string {
+	parts := strings.Split(iface, ".")
+	if len(parts) > 2 {
+		parts = parts[2:]
+	}
+	var sb strings.Builder
+	for _, p := range parts {
+		sb.WriteString(util.SnakeToCamel(p))
+	}
+	return sb.String()
+}
+
+// reserved are the identifiers that arguments can not be named as in the generated code
+var reserved = map[string]bool{
+	"x":   true,
+	"fn":  true,
+	"ret": true,
+	"err": true,
+	"s":   true,
+}
+
+// argNames converts D-Bus argument names to unique lowerCamelCase Go identifiers
+// unnamed arguments are called <prefix><index>
+func argNames(args []Arg, prefix string, used map[string]bool) []argTemplate {
+	ret := make([]argTemplate, len(args))
+	for i, a := range args {
+		name := util.SnakeToCamel(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return '_'
+		}, a.Name))
+		if name == "" || unicode.IsDigit(rune(name[0])) {
+			name = fmt.Sprintf("%s%d%s", prefix, i, name)
+		}
+		name = strings.ToLower(name[:1]) + name[1:]
+		for token.IsKeyword(name) || reserved[name] || used[name] {
+			name += "Arg"
+		}
+		used[name] = true
+		ret[i] = argTemplate{
+			Name:   name,
+			GoType: GoType(a.Type),
+		}
+	}
+	return ret
+}
+
+// methodName converts a D-Bus method name to an exported Go name that is not taken yet
+// a name that collides with a field, an accessor or another method is suffixed with Method,
+// e.g. a method GetName next to a property Name becomes GetNameMethod
+func methodName(name string, taken map[string]bool) string {
+	ret := util.SnakeToCamel(name)
+	for taken[ret] {
+		ret += "Method"
+	}
+	taken[ret] = true
+	return ret
+}
+
+func deprecated(annotations []Annotation) bool {
+	for _, a := range annotations {
+		if a.Name == "org.freedesktop.DBus.Deprecated" && a.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func convert(n *Node, pkg string, source string) fileTemplate {
+	ft := fileTemplate{
+		Source:  source,
+		Package: pkg,
+	}
+	for _, iface := range n.AllInterfaces() {
+		// the standard interfaces are implemented by the dbus package itself
+		if strings.HasPrefix(iface.Name, "org.freedesktop.DBus.") {
+			continue
+		}
+		it := interfaceTemplate{
+			Name:       GoName(iface.Name),
+			ID:         iface.Name,
+			Deprecated: deprecated(iface.Annotations),
+		}
+		// the methods are named after the fields and accessors such that they do not collide with them
+		taken := map[string]bool{"Conn": true, "Dest": true, "Path": true}
+		for _, s := range iface.Signals {
+			taken["Subscribe"+util.SnakeToCamel(s.Name)] = true
+		}
+		for _, p := range iface.Properties {
+			taken["Get"+util.SnakeToCamel(p.Name)] = true
+			taken["Set"+util.SnakeToCamel(p.Name)] = true
+		}
+		for _, m := range iface.Methods {
+			var in, out []Arg
+			var sig strings.Builder
+			for _, a := range m.Args {
+				if a.Direction == "out" {
+					out = append(out, a)
+					continue
+				}
+				in = append(in, a)
+				sig.WriteString(a.Type)
+			}
+			used := map[string]bool{}
+			it.Methods = append(it.Methods, methodTemplate{
+				Name:       methodName(m.Name, taken),
+				CName:      m.Name,
+				Deprecated: deprecated(m.Annotations),
+				InSig:      sig.String(),
+				In:         argNames(in, "arg", used),
+				Out:        argNames(out, "out", used),
+			})
+		}
+		for _, s := range iface.Signals {
+			it.Signals = append(it.Signals, signalTemplate{
+				Name:       util.SnakeToCamel(s.Name),
+				CName:      s.Name,
+				Deprecated: deprecated(s.Annotations),
+				Args:       argNames(s.Args, "arg", map[string]bool{}),
+			})
+		}
+		for _, p := range iface.Properties {
+			pt := propertyTemplate{
+				Name:       util.SnakeToCamel(p.Name),
+				CName:      p.Name,
+				Type:       p.Type,
+				GoType:     GoType(p.Type),
+				Deprecated: deprecated(p.Annotations),
+				Read:       strings.Contains(p.Access, "read"),
+				Write:      strings.Contains(p.Access, "write"),
+			}
+			if pt.Write {
+				ft.NeedsGLib = true
+			}
+			it.Properties = append(it.Properties, pt)
+		}
+		ft.Interfaces = append(ft.Interfaces, it)
+	}
+	return ft
+}
+
+// Generate writes Go source code with a client proxy for every interface in n to w
+// pkg is the package name of the generated file and source is the introspection filename mentioned in the header
+func Generate(w io.Writer, n *Node, pkg string, source string) error {
+	var buf bytes.Buffer
+	if err := gotemp.Execute(&buf, convert(n, pkg, source)); err != nil {
+		return err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated dbus proxy code: %w", err)
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+var gotemp = template.Must(template.New("dbus").Parse(`// Code generated by github.com/jwijenbergh/puregotk from {{.Source}}. DO NOT EDIT.
+package {{.Package}}
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio/dbus"
+{{- if .NeedsGLib}}
+	"github.com/jwijenbergh/puregotk/v4/glib"
+{{- end}}
+)
+
+{{range .Interfaces -}}
+{{$iface := . -}}
+// {{.Name}}Interface is the name of the "{{.ID}}" D-Bus interface.
+const {{.Name}}Interface = "{{.ID}}"
+
+// {{.Name}} is a client for the "{{.ID}}" D-Bus interface.
+{{- if .Deprecated}}
+//
+// Deprecated: the interface is marked as deprecated.
+{{- end}}
+type {{.Name}} struct {
+	// Conn is the connection the methods are called on.
+	Conn *dbus.Conn
+	// Dest is the bus name of the peer, e.g. a well known name.
+	Dest string
+	// Path is the object path of the remote object.
+	Path string
+}
+
+// New{{.Name}} creates a client for the "{{.ID}}" interface of the object at path of dest.
+func New{{.Name}}(conn *dbus.Conn, dest string, path string) *{{.Name}} {
+	return &{{.Name}}{Conn: conn, Dest: dest, Path: path}
+}
+
+{{range .Methods -}}
+// {{.Name}} calls the "{{.CName}}" method.
+{{- if .Deprecated}}
+//
+// Deprecated: the method is marked as deprecated.
+{{- end}}
+func (x *{{$iface.Name}}) {{.Name}}({{range $i, $a := .In}}{{if $i}}, {{end}}{{.Name}} {{.GoType}}{{end}}) ({{range .Out}}{{.Name}} {{.GoType}}, {{end}}err error) {
+	{{if .Out}}ret, err := {{else}}_, err = {{end}}x.Conn.CallSignature(x.Dest, x.Path, {{$iface.Name}}Interface, "{{.CName}}", "{{.InSig}}"{{range .In}}, {{.Name}}{{end}})
+	if err != nil {
+		return
+	}
+{{- range $i, $a := .Out}}
+	if {{.Name}}, err = dbus.Arg[{{.GoType}}](ret, {{$i}}); err != nil {
+		return
+	}
+{{- end}}
+	return
+}
+
+{{end -}}
+
+{{range .Signals -}}
+// Subscribe{{.Name}} calls fn every time the "{{.CName}}" signal is emitted by the object at Path.
+// Signals are matched on the path and not on Dest as the sender of a signal is always a unique name.
+// Call the returned function to unsubscribe.
+{{- if .Deprecated}}
+//
+// Deprecated: the signal is marked as deprecated.
+{{- end}}
+func (x *{{$iface.Name}}) Subscribe{{.Name}}(fn func({{range $i, $a := .Args}}{{if $i}}, {{end}}{{.Name}} {{.GoType}}{{end}})) func() {
+	return x.Conn.Subscribe("", x.Path, {{$iface.Name}}Interface, "{{.CName}}", func(s dbus.Signal) {
+{{- range $i, $a := .Args}}
+		{{.Name}}, err := dbus.Arg[{{.GoType}}](s.Body, {{$i}})
+		if err != nil {
+			return
+		}
+{{- end}}
+		fn({{range $i, $a := .Args}}{{if $i}}, {{end}}{{.Name}}{{end}})
+	})
+}
+
+{{end -}}
+
+{{range .Properties -}}
+{{- if .Read -}}
+// Get{{.Name}} gets the "{{.CName}}" property.
+{{- if .Deprecated}}
+//
+// Deprecated: the property is marked as deprecated.
+{{- end}}
+func (x *{{$iface.Name}}) Get{{.Name}}() ({{.GoType}}, error) {
+	value, err := x.Conn.GetProperty(x.Dest, x.Path, {{$iface.Name}}Interface, "{{.CName}}")
+	if err != nil {
+		var zero {{.GoType}}
+		return zero, err
+	}
+	return dbus.Arg[{{.GoType}}]([]interface{}{value}, 0)
+}
+
+{{end -}}
+{{- if .Write -}}
+// Set{{.Name}} sets the "{{.CName}}" property.
+{{- if .Deprecated}}
+//
+// Deprecated: the property is marked as deprecated.
+{{- end}}
+func (x *{{$iface.Name}}) Set{{.Name}}(value {{.GoType}}) error {
+	v, err := glib.NewVariantFromGoWithType("{{.Type}}", value)
+	if err != nil {
+		return err
+	}
+	return x.Conn.SetProperty(x.Dest, x.Path, {{$iface.Name}}Interface, "{{.CName}}", v)
+}
+
+{{end -}}
+{{end -}}
+{{end -}}
+`))
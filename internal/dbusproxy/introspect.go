@@ -0,0 +1,90 @@
+// package dbusproxy implements a generator for typed D-Bus client proxies
+// it reads D-Bus introspection XML and converts every interface into a Go struct that calls methods through the dbus package
+package dbusproxy
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// Node is an object in the introspection data, the root element is a node too
+// See https://dbus.freedesktop.org/doc/dbus-specification.html#introspection-format
+type Node struct {
+	XMLName    xml.Name    `xml:"node"`
+	Name       string      `xml:"name,attr"`
+	Interfaces []Interface `xml:"interface"`
+	Children   []Node      `xml:"node"`
+}
+
+// Interface is a D-Bus interface with its members
+type Interface struct {
+	Name        string       `xml:"name,attr"`
+	Methods     []Method     `xml:"method"`
+	Signals     []Signal     `xml:"signal"`
+	Properties  []Property   `xml:"property"`
+	Annotations []Annotation `xml:"annotation"`
+}
+
+// Method is a method of an interface
+type Method struct {
+	Name        string       `xml:"name,attr"`
+	Args        []Arg        `xml:"arg"`
+	Annotations []Annotation `xml:"annotation"`
+}
+
+// Signal is a signal of an interface
+type Signal struct {
+	Name        string       `xml:"name,attr"`
+	Args        []Arg        `xml:"arg"`
+	Annotations []Annotation `xml:"annotation"`
+}
+
+// Property is a property of an interface
+// Access is one of read, write or readwrite
+type Property struct {
+	Name        string       `xml:"name,attr"`
+	Type        string       `xml:"type,attr"`
+	Access      string       `xml:"access,attr"`
+	Annotations []Annotation `xml:"annotation"`
+}
+
+// Arg is an argument of a method or signal
+// Direction is in or out for methods and empty for signals
+type Arg struct {
+	Name      string `xml:"name,attr"`
+	Type      string `xml:"type,attr"`
+	Direction string `xml:"direction,attr"`
+}
+
+// Annotation is a name/value annotation on an interface or a member, e.g. org.freedesktop.DBus.Deprecated
+type Annotation struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// AllInterfaces returns the interfaces of the node and all of its child nodes
+func (n *Node) AllInterfaces() []Interface {
+	ifaces := append([]Interface{}, n.Interfaces...)
+	for i := range n.Children {
+		ifaces = append(ifaces, n.Children[i].AllInterfaces()...)
+	}
+	return ifaces
+}
+
+// Parse parses D-Bus introspection XML
+func Parse(b []byte) (*Node, error) {
+	var n Node
+	if err := xml.Unmarshal(b, &n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// ParseFile reads and parses a D-Bus introspection XML file
+func ParseFile(filename string) (*Node, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
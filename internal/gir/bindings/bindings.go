@@ -0,0 +1,320 @@
+// package bindings generates the bindings of GIR files into a directory of any module,
+// the namespaces that the GIR files include are taken from the bindings of puregotk where it has them
+package bindings
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/imports"
+	"github.com/jwijenbergh/puregotk/internal/gir/pass"
+	"github.com/jwijenbergh/puregotk/internal/gir/spec"
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+	"github.com/jwijenbergh/puregotk/templates"
+)
+
+// puregotk is the import path of puregotk
+const puregotk = "github.com/jwijenbergh/puregotk"
+
+// stdlib are the packages of the standard library that generated files use
+var stdlib = []string{"fmt", "strings", "structs", "unsafe"}
+
+// Options configures Generate
+type Options struct {
+	// Files are the GIR files to generate
+	Files []string
+	// Dir is the output directory, a package is generated in it for every namespace
+	Dir string
+	// Module is the import path of Dir
+	Module string
+	// Namespaces are the namespaces to generate, the namespaces of Files if empty
+	Namespaces []string
+	// IncludeDirs are the directories to look up the included GIR files in that puregotk does not have
+	IncludeDirs []string
+	// LibrarySuffix is appended to the library names of the generated namespaces, e.g. "3" to load Gtk-3.0
+	// from the environment variable PUREGOTK_GTK3_PATH, such that the library names differ from the ones of puregotk
+	LibrarySuffix string
+	// Config configures the generator, see puregotk.yaml, it can be nil
+	Config *pass.Config
+	// Tolerant leaves out the symbols that cannot be converted instead of failing
+	Tolerant bool
+	// Coverage and Report are the directories to write the coverage and the summaries to, if they are set, see Pass.WriteCoverage and Pass.WriteReports
+	Coverage string
+	Report   string
+}
+
+// Generate generates the bindings of the namespaces into their packages in opts.Dir, the packages are removed first.
+// The included namespaces are looked up in the GIR files of puregotk first, the generated packages import the packages of puregotk for them,
+// e.g. github.com/jwijenbergh/puregotk/v4/gio. Other included namespaces are looked up in opts.IncludeDirs and have to be generated as well.
+// The imports that gen.sh adds with goimports for the bindings of puregotk are added without it.
+func Generate(opts Options) error {
+	tmp, err := os.MkdirTemp("", "puregotk-gen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	girs, err := resolve(opts.Files, opts.IncludeDirs, tmp)
+	if err != nil {
+		return err
+	}
+	targets := make(map[string]bool)
+	for _, ns := range opts.Namespaces {
+		targets[ns] = true
+	}
+	if len(targets) == 0 {
+		for _, g := range girs {
+			if g.given {
+				targets[g.namespace] = true
+			}
+		}
+	}
+
+	// the packages of the namespaces by name
+	pkgs := make(map[string]string)
+	paths := make([]string, 0, len(girs))
+	for _, g := range girs {
+		pkg := strings.ToLower(g.namespace)
+		switch {
+		case targets[g.namespace]:
+			pkgs[pkg] = opts.Module + "/" + pkg
+		case g.puregotk:
+			pkgs[pkg] = puregotk + "/v4/" + pkg
+		default:
+			return fmt.Errorf("%s is included from %s but is not a namespace of puregotk, generate it as well", g.namespace, g.includedBy)
+		}
+		paths = append(paths, g.path)
+	}
+	for ns := range targets {
+		if _, ok := pkgs[strings.ToLower(ns)]; !ok {
+			return fmt.Errorf("namespace %s is not in the GIR files", ns)
+		}
+	}
+
+	p, err := pass.New(paths)
+	if err != nil {
+		return err
+	}
+	// the helpers of variadic functions are hand-written for v4
+	types.VarArgsHelpers = false
+	defer func() { types.VarArgsHelpers = true }()
+	for ns := range targets {
+		p.Namespaces = append(p.Namespaces, ns)
+	}
+	p.LibrarySuffix = opts.LibrarySuffix
+	if opts.Config != nil {
+		if err := p.Configure(opts.Config); err != nil {
+			return err
+		}
+	}
+	p.First()
+	gotemp, err := templates.Parse()
+	if err != nil {
+		return err
+	}
+	for ns := range targets {
+		if err := os.RemoveAll(filepath.Join(opts.Dir, strings.ToLower(ns))); err != nil {
+			return err
+		}
+	}
+	p.Second(opts.Dir, gotemp)
+	if len(p.Diagnostics) > 0 {
+		fmt.Fprintln(os.Stderr, p.Diagnostics.Summary())
+	}
+	if !opts.Tolerant && p.Diagnostics.Errors() > 0 {
+		return errors.New("some symbols could not be converted, run with -tolerant to generate the bindings without them")
+	}
+	if opts.Coverage != "" {
+		if err := p.WriteCoverage(opts.Coverage); err != nil {
+			return err
+		}
+	}
+	if opts.Report != "" {
+		if err := p.WriteReports(opts.Report, os.Stderr); err != nil {
+			return err
+		}
+	}
+
+	var dirs []string
+	for ns := range targets {
+		dirs = append(dirs, filepath.Join(opts.Dir, strings.ToLower(ns)))
+	}
+	return FixImports(dirs, pkgs)
+}
+
+// FixImports adds the imports that the generated Go files in dirs need and removes the unused ones, see imports.Fix.
+// pkgs are the import paths of the packages of the namespaces by package name, e.g. "gio" for Gio,
+// the packages of the standard library, core and gobject/types are added to them.
+// The bindings of puregotk used to rely on goimports for this, which cannot tell packages with the same name apart, e.g. gtk of v3 and v4.
+func FixImports(dirs []string, pkgs map[string]string) error {
+	known := map[string]string{
+		"core":  puregotk + "/pkg/core",
+		"types": puregotk + "/v4/gobject/types",
+	}
+	for _, name := range stdlib {
+		known[name] = name
+	}
+	for name, path := range pkgs {
+		known[name] = path
+	}
+	for _, dir := range dirs {
+		names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+			fixed, err := imports.Fix(src, func(pkg string) string { return known[pkg] })
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			if err := os.WriteFile(name, fixed, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// gir is a GIR file to parse
+type gir struct {
+	path      string
+	namespace string
+	// given is set for the files on the command line
+	given bool
+	// puregotk is set for the GIR files of puregotk, the bindings of their namespaces are part of puregotk
+	puregotk bool
+	// includedBy is the namespace that included the file first
+	includedBy string
+}
+
+// resolve returns the given GIR files and the files of the namespaces they include, recursively.
+// Included namespaces are looked up in the GIR files of puregotk first, which are extracted to tmp, and then in dirs
+func resolve(files []string, dirs []string, tmp string) ([]gir, error) {
+	var girs []gir
+	seen := make(map[string]bool)
+	var queue []include
+	add := func(g gir) error {
+		f, err := os.Open(g.path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		ns, incs, err := header(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", g.path, err)
+		}
+		if seen[ns.Name] {
+			return nil
+		}
+		seen[ns.Name] = true
+		g.namespace = ns.Name
+		girs = append(girs, g)
+		for _, inc := range incs {
+			queue = append(queue, include{inc, ns.Name, g.puregotk})
+		}
+		return nil
+	}
+	for _, f := range files {
+		if err := add(gir{path: f, given: true}); err != nil {
+			return nil, err
+		}
+	}
+	for len(queue) > 0 {
+		inc := queue[0]
+		queue = queue[1:]
+		if seen[inc.Name] {
+			continue
+		}
+		name := inc.Name + "-" + inc.Version + ".gir"
+		if data, err := fs.ReadFile(spec.FS, name); err == nil {
+			path := filepath.Join(tmp, name)
+			if err := os.WriteFile(path, data, 0o644); err != nil {
+				return nil, err
+			}
+			if err := add(gir{path: path, puregotk: true, includedBy: inc.by}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if inc.puregotk {
+			// the bindings of puregotk are generated without the namespaces it does not bind, e.g. HarfBuzz
+			continue
+		}
+		found := false
+		for _, dir := range dirs {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err != nil {
+				continue
+			}
+			if err := add(gir{path: path, includedBy: inc.by}); err != nil {
+				return nil, err
+			}
+			found = true
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("%s is included from %s but cannot be found in %s", name, inc.by, strings.Join(dirs, ", "))
+		}
+	}
+	return girs, nil
+}
+
+// include is an include element of the GIR file of the namespace by
+type include struct {
+	xmlInclude
+	by string
+	// puregotk is set if by is a namespace of puregotk
+	puregotk bool
+}
+
+type xmlInclude struct {
+	Name    string `xml:"name,attr"`
+	Version string `xml:"version,attr"`
+}
+
+type xmlNamespace struct {
+	Name string `xml:"name,attr"`
+}
+
+// header reads the includes and the namespace of a GIR file, without parsing the rest of it
+func header(r io.Reader) (ns xmlNamespace, incs []xmlInclude, err error) {
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = errors.New("no namespace element")
+			}
+			return ns, nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch {
+		case start.Name.Local == "include" && start.Name.Space == "http://www.gtk.org/introspection/core/1.0":
+			var inc xmlInclude
+			if err := d.DecodeElement(&inc, &start); err != nil {
+				return ns, nil, err
+			}
+			incs = append(incs, inc)
+		case start.Name.Local == "namespace":
+			for _, attr := range start.Attr {
+				if attr.Name.Local == "name" && attr.Name.Space == "" {
+					ns.Name = attr.Value
+				}
+			}
+			return ns, incs, nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// package imports fixes the imports of generated Go files without goimports,
+// so that bindings can be generated outside of this repository with known import paths
+package imports
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Fix adds the imports of the packages that the Go source uses without importing them and removes the unused imports.
+// resolve returns the import path of a package name, or "" if it is unknown, unknown packages are left to the compiler.
+// The result is formatted like gofmt does.
+func Fix(src []byte, resolve func(name string) string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		// package qualified identifiers are not declared in the file
+		if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+			used[id.Name] = true
+		}
+		return true
+	})
+
+	// the imports that are kept, by name
+	specs := make(map[string]string)
+	from, to := f.Name.End(), f.Name.End()
+	for _, spec := range f.Imports {
+		name := importName(spec)
+		if name != "_" && name != "." && !used[name] {
+			continue
+		}
+		specs[name] = source(src, fset, spec.Pos(), spec.End())
+	}
+	// a parenthesized declaration stays one, like goimports does
+	paren := false
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			to = gd.End()
+			paren = paren || gd.Lparen.IsValid()
+		}
+	}
+	if len(f.Imports) == 0 {
+		from = to
+	} else {
+		// the imports come first, the declarations of all of them are replaced
+		from = f.Decls[0].Pos()
+	}
+	for name := range used {
+		if _, ok := specs[name]; ok {
+			continue
+		}
+		path := resolve(name)
+		if path == "" {
+			continue
+		}
+		if path[strings.LastIndex(path, "/")+1:] != name {
+			specs[name] = name + " " + strconv.Quote(path)
+		} else {
+			specs[name] = strconv.Quote(path)
+		}
+	}
+
+	// the standard library first, like goimports
+	var std, other []string
+	for _, spec := range specs {
+		if path := spec[strings.IndexByte(spec, '"')+1:]; strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	var buf bytes.Buffer
+	buf.Write(src[:fset.Position(from).Offset])
+	if len(specs) > 0 {
+		if len(f.Imports) == 0 {
+			buf.WriteString("\n\n")
+		}
+		if len(specs) == 1 && !paren {
+			buf.WriteString("import " + append(std, other...)[0])
+		} else {
+			buf.WriteString("import (\n")
+			for i, group := range [][]string{std, other} {
+				if i > 0 && len(std) > 0 && len(other) > 0 {
+					buf.WriteString("\n")
+				}
+				for _, spec := range group {
+					buf.WriteString("\t" + spec + "\n")
+				}
+			}
+			buf.WriteString(")")
+		}
+	}
+	buf.Write(src[fset.Position(to).Offset:])
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	// gofmt moves some lines of doc comments only when it formats them the second time
+	return format.Source(formatted)
+}
+
+// source returns the source between the positions
+func source(src []byte, fset *token.FileSet, from, to token.Pos) string {
+	return string(src[fset.Position(from).Offset:fset.Position(to).Offset])
+}
+
+// importName returns the name an import is referred to with in the file
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return path[strings.LastIndex(path, "/")+1:]
+}
@@ -0,0 +1,23 @@
+package pass
+
+import (
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// boxedMethods returns whether Copy and Free are generated for the boxed record rec with g_boxed_copy and g_boxed_free.
+// They are not if the record has a method or field of that name,
+// or is reference counted, where g_boxed_copy takes a reference and a copy would be misleading
+func (p *Pass) boxedMethods(rec types.Record, fields []types.RecordField) (copy bool, free bool) {
+	names := make(map[string]bool)
+	for _, m := range rec.Methods {
+		names[util.SnakeToCamel(m.Name)] = true
+	}
+	for _, f := range fields {
+		names[f.Name] = true
+	}
+	if names["Ref"] || names["Unref"] {
+		return false, false
+	}
+	return !names["Copy"], !names["Free"]
+}
@@ -0,0 +1,89 @@
+package pass
+
+import (
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// shadowDoc deprecates c in favour of the callable that shadows it in the documentation of c, see types.Shadows,
+// goName converts a GIR name of the scope to a Go name.
+// Both callables are generated under their own names, such that the shadowed one keeps its signature
+func shadowDoc(doc string, c types.CallableAttrs, shadows map[string]string, goName func(string) string) string {
+	shadowing, ok := shadows[c.Name]
+	if !ok {
+		return doc
+	}
+	return types.ShadowedDoc(doc, goName(shadowing), c.Deprecated)
+}
+
+// movedFunction looks up the function that a function of the namespace moved to, e.g. adw_easing_ease to Easing.ease
+// It returns the Go name of the function and whether it is generated with the functions of a class,
+// functions of other types are generated with the functions of the namespace under this name
+func (p *Pass) movedFunction(ns types.Namespace, f types.Function) (name string, class bool, ok bool) {
+	typeName, fn, moved := f.Moved()
+	if !moved || typeName == "" {
+		return "", false, false
+	}
+	has := func(fs []types.Function) bool {
+		for _, f := range fs {
+			if f.Name == fn {
+				return true
+			}
+		}
+		return false
+	}
+	for _, cls := range ns.Classes {
+		if cls.Name == typeName && has(cls.Functions) {
+			return util.SnakeToCamel(cls.Name) + util.SnakeToCamel(fn), true, true
+		}
+	}
+	found := false
+	for _, rec := range ns.Records {
+		found = found || rec.Name == typeName && has(rec.Functions)
+	}
+	for _, inter := range ns.Interfaces {
+		found = found || inter.Name == typeName && has(inter.Functions)
+	}
+	for _, enum := range ns.Enums {
+		found = found || enum.Name == typeName && has(enum.Functions)
+	}
+	for _, bf := range ns.Bitfields {
+		found = found || bf.Name == typeName && has(bf.Functions)
+	}
+	for _, union := range ns.Unions {
+		found = found || union.Name == typeName && has(union.Functions)
+	}
+	if !found {
+		return "", false, false
+	}
+	return p.functionName(ns.Name, typeName+"_"+fn), false, true
+}
+
+// movedMethod returns the Go name of the function of the namespace that a method of owner moved to,
+// e.g. gdk_events_get_angle for a method of GdkEvent, if the function takes the instance as its first argument
+func (p *Pass) movedMethod(ns types.Namespace, owner string, m types.Method) (string, bool) {
+	typeName, fn, moved := m.Moved()
+	if !moved || typeName != "" {
+		return "", false
+	}
+	for _, f := range ns.Functions {
+		if f.Name != fn {
+			continue
+		}
+		args := f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC)
+		if len(args.API.Types) == 0 || args.API.Types[0] != "*"+util.SnakeToCamel(owner) {
+			return "", false
+		}
+		return p.functionName(ns.Name, fn), true
+	}
+	return "", false
+}
+
+// functionName returns the Go name of a function of the namespace ns
+func (p *Pass) functionName(ns string, name string) string {
+	name = util.SnakeToCamel(name)
+	if p.Types.Kind(ns, name) != types.UnknownType {
+		name = "New" + name
+	}
+	return name
+}
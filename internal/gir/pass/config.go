@@ -0,0 +1,287 @@
+package pass
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// Config changes what the generator makes of the GIR files, it is read from puregotk.yaml:
+//
+//	skip:
+//	  - gtk_widget_get_template_child   # a callable by C identifier
+//	  - Gtk.PrintJob                    # a type by namespace and GIR name
+//	manual:
+//	  - g_idle_add_full
+//	rename:
+//	  gtk_widget_get_name: get_widget_name
+//	types:
+//	  gtk_widget_foo:
+//	    data: gpointer
+//	    return: utf8 const char*
+//	no_deref:
+//	  - ModelVar
+//	optional:
+//	  - Gtk4LayerShell
+//
+// Unknown keys are an error, so that a typo does not silently change nothing.
+type Config struct {
+	// Skip are the symbols that are left out of the bindings,
+	// callables by C identifier and classes, interfaces, records, unions, enumerations, bitfields, callbacks,
+	// aliases and constants by namespace and GIR name, e.g. "Gtk.Widget". Symbols that use a skipped type fail to convert.
+	Skip []string `yaml:"skip"`
+	// Manual are the callables by C identifier that are written by hand in templates/, they are left out
+	// but counted as available in the coverage
+	Manual []string `yaml:"manual"`
+	// Rename maps callables by C identifier to the GIR name they are generated with, e.g. get_widget_name for GetWidgetName
+	Rename map[string]string `yaml:"rename"`
+	// Types overrides the types of parameters of callables by C identifier and parameter name, or "return" for the return value.
+	// A type is a GIR type name like "gpointer" or "Gtk.Widget", optionally followed by the C type, e.g. "Gtk.Widget GtkWidget*",
+	// without C type it keeps the pointers of the C type of the GIR file
+	Types map[string]map[string]string `yaml:"types"`
+	// NoDeref are the names of generated variables that are passed as they are instead of dereferenced,
+	// for parameters of which the GIR files have the wrong pointer count
+	NoDeref []string `yaml:"no_deref"`
+	// Optional are the namespaces whose library may be missing at runtime, e.g. libraries that only some systems have.
+	// If it cannot be loaded, the error is recorded instead of panicking when the package is initialized, see core.LibraryError,
+	// and its functions panic with a *core.MissingSymbolError when they are called
+	Optional []string `yaml:"optional"`
+}
+
+// LoadConfig reads the configuration of the generator from the YAML file name
+func LoadConfig(name string) (*Config, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &c, nil
+}
+
+// configured is a symbol that the configuration left out
+type configured struct {
+	symbol Skipped
+	// callable is set for callables, which are counted as symbols in the coverage
+	callable bool
+}
+
+// Configure applies the configuration to the parsed GIR files, it must be called before First.
+// Left out symbols are recorded in the coverage of their namespace, configuration entries that match no symbol are returned as an error.
+func (p *Pass) Configure(c *Config) error {
+	util.NoDerefVars = c.NoDeref
+	used := make(map[string]bool)
+	set := func(list []string) map[string]bool {
+		m := make(map[string]bool, len(list))
+		for _, s := range list {
+			m[s] = true
+		}
+		return m
+	}
+	skip, manual := set(c.Skip), set(c.Manual)
+
+	// keep reports whether the callable is generated, renames it and overrides its types
+	keep := func(ns, path string, ca *types.CallableAttrs) bool {
+		cid := ca.CIdentifier
+		switch {
+		case skip[cid]:
+			used[cid] = true
+			p.configured[ns] = append(p.configured[ns], configured{callable: true,
+				symbol: Skipped{Path: path, CIdentifier: cid, Reason: ReasonConfig, Detail: "left out by the configuration"}})
+			return false
+		case manual[cid]:
+			used[cid] = true
+			p.configured[ns] = append(p.configured[ns], configured{callable: true,
+				symbol: Skipped{Path: path, CIdentifier: cid, Reason: ReasonManual, Generated: true, Detail: "written by hand"}})
+			return false
+		}
+		if name, ok := c.Rename[cid]; ok {
+			used["rename "+cid] = true
+			ca.Name = name
+		}
+		if overrides, ok := c.Types[cid]; ok {
+			used["types "+cid] = true
+			for param, t := range overrides {
+				if !overrideType(ca, param, t) {
+					// reported as an unused entry
+					continue
+				}
+				used["types "+cid+" "+param] = true
+			}
+		}
+		return true
+	}
+	// keepType reports whether the type name of the namespace is generated
+	keepType := func(ns, path, name string) bool {
+		full := ns + "." + name
+		if !skip[full] {
+			return true
+		}
+		used[full] = true
+		p.configured[ns] = append(p.configured[ns], configured{symbol: Skipped{Path: path, Reason: ReasonConfig, Detail: "left out by the configuration"}})
+		return false
+	}
+
+	optional := set(c.Optional)
+	for i := range p.Parsed {
+		ns := &p.Parsed[i].Namespaces[0]
+		if optional[ns.Name] {
+			used[ns.Name] = true
+			p.optional[ns.Name] = true
+		}
+		nsPath := elementPath("", "namespace", ns.Name)
+		ns.Functions = filterCallables(ns.Functions, ns.Name, nsPath, "function", keep)
+		ns.Callbacks = filter(ns.Callbacks, func(cb *types.Callback) bool {
+			return keepType(ns.Name, elementPath(nsPath, "callback", cb.Name), cb.Name)
+		})
+		ns.Aliases = filter(ns.Aliases, func(a *types.Alias) bool {
+			return keepType(ns.Name, elementPath(nsPath, "alias", a.Name), a.Name)
+		})
+		ns.Constants = filter(ns.Constants, func(con *types.Constant) bool {
+			return keepType(ns.Name, elementPath(nsPath, "constant", con.Name), con.Name)
+		})
+		ns.Enums = filter(ns.Enums, func(e *types.Enum) bool {
+			path := elementPath(nsPath, "enumeration", e.Name)
+			e.Functions = filterCallables(e.Functions, ns.Name, path, "function", keep)
+			return keepType(ns.Name, path, e.Name)
+		})
+		ns.Bitfields = filter(ns.Bitfields, func(b *types.Bitfield) bool {
+			path := elementPath(nsPath, "bitfield", b.Name)
+			b.Functions = filterCallables(b.Functions, ns.Name, path, "function", keep)
+			return keepType(ns.Name, path, b.Name)
+		})
+		ns.Unions = filter(ns.Unions, func(u *types.Union) bool {
+			return keepType(ns.Name, elementPath(nsPath, "union", u.Name), u.Name)
+		})
+		ns.Records = filter(ns.Records, func(r *types.Record) bool {
+			path := elementPath(nsPath, "record", r.Name)
+			r.Constructors = filterCallables(r.Constructors, ns.Name, path, "constructor", keep)
+			r.Methods = filterCallables(r.Methods, ns.Name, path, "method", keep)
+			r.Functions = filterCallables(r.Functions, ns.Name, path, "function", keep)
+			return keepType(ns.Name, path, r.Name)
+		})
+		ns.Interfaces = filter(ns.Interfaces, func(inter *types.Interface) bool {
+			path := elementPath(nsPath, "interface", inter.Name)
+			inter.Methods = filterCallables(inter.Methods, ns.Name, path, "method", keep)
+			inter.Functions = filterCallables(inter.Functions, ns.Name, path, "function", keep)
+			return keepType(ns.Name, path, inter.Name)
+		})
+		ns.Classes = filter(ns.Classes, func(cls *types.Class) bool {
+			path := elementPath(nsPath, "class", cls.Name)
+			cls.Constructors = filterCallables(cls.Constructors, ns.Name, path, "constructor", keep)
+			cls.Methods = filterCallables(cls.Methods, ns.Name, path, "method", keep)
+			cls.Functions = filterCallables(cls.Functions, ns.Name, path, "function", keep)
+			return keepType(ns.Name, path, cls.Name)
+		})
+	}
+
+	var unused []string
+	for _, s := range append(append(c.Skip, c.Manual...), c.Optional...) {
+		if !used[s] {
+			unused = append(unused, s)
+		}
+	}
+	for cid := range c.Rename {
+		if !used["rename "+cid] {
+			unused = append(unused, "rename "+cid)
+		}
+	}
+	for cid, overrides := range c.Types {
+		for param := range overrides {
+			if !used["types "+cid+" "+param] {
+				unused = append(unused, "types "+cid+" "+param)
+			}
+		}
+	}
+	if len(unused) > 0 {
+		return fmt.Errorf("the configuration has entries that match no symbol: %s", strings.Join(unused, ", "))
+	}
+	return nil
+}
+
+// recordConfigured records the symbols of the namespace that the configuration left out in its coverage
+func (p *Pass) recordConfigured(ns string) {
+	for _, c := range p.configured[ns] {
+		if c.callable {
+			p.coverage.Symbols++
+		}
+		p.skip(c.symbol)
+	}
+}
+
+// overrideType replaces the type of the parameter param of the callable, or of its return value for "return",
+// with the GIR type t, see Config.Types. It returns false if there is no such parameter
+func overrideType(ca *types.CallableAttrs, param string, t string) bool {
+	var any *types.AnyType
+	if param == "return" {
+		if ca.ReturnValue != nil {
+			any = &ca.ReturnValue.AnyType
+		}
+	} else if ca.Parameters != nil {
+		for i := range ca.Parameters.Parameters {
+			if ca.Parameters.Parameters[i].Name == param {
+				any = &ca.Parameters.Parameters[i].AnyType
+			}
+		}
+	}
+	if any == nil {
+		return false
+	}
+	name, ctype, found := strings.Cut(t, " ")
+	if !found {
+		// keep the pointers of the type in the GIR file
+		stars := 0
+		if any.Type != nil {
+			stars = strings.Count(any.Type.CType, "*")
+		} else if any.Array != nil {
+			stars = strings.Count(any.Array.CType, "*")
+		}
+		ctype = name + strings.Repeat("*", stars)
+	}
+	*any = types.AnyType{Type: &types.Type{Name: name, CType: strings.TrimSpace(ctype)}}
+	return true
+}
+
+// filter returns the elements of s for which keep returns true, keep may change the element
+func filter[T any](s []T, keep func(*T) bool) []T {
+	kept := s[:0]
+	for i := range s {
+		if keep(&s[i]) {
+			kept = append(kept, s[i])
+		}
+	}
+	return kept
+}
+
+// filterCallables returns the callables of s that keep returns true for, the element of their paths below parent is element
+func filterCallables[T any](s []T, ns, parent, element string, keep func(ns, path string, ca *types.CallableAttrs) bool) []T {
+	return filter(s, func(c *T) bool {
+		ca := callableAttrs(c)
+		return keep(ns, elementPath(parent, element, ca.Name), ca)
+	})
+}
+
+// callableAttrs returns the attributes of a function, method or constructor
+func callableAttrs(c any) *types.CallableAttrs {
+	switch c := c.(type) {
+	case *types.Function:
+		return &c.CallableAttrs
+	case *types.Method:
+		return &c.CallableAttrs
+	case *types.Constructor:
+		return &c.CallableAttrs
+	}
+	panic(fmt.Sprintf("%T is not a callable", c))
+}
@@ -0,0 +1,192 @@
+package pass
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+)
+
+// The reasons why a symbol is missing from the bindings or cannot be used as generated
+const (
+	// ReasonError is a symbol that could not be converted, see the diagnostics
+	ReasonError = "error"
+	// ReasonVarArgs is a C variadic function, purego cannot call these
+	ReasonVarArgs = "varargs"
+	// ReasonUnion is a union or a callable of a union, unions are only generated as uintptr
+	ReasonUnion = "union"
+	// ReasonStructByValue is a callable that passes or returns a struct by value, which purego does not support
+	ReasonStructByValue = "struct-by-value"
+	// ReasonABI is a function with a signature that purego cannot call, it is generated as a stub that returns an error
+	ReasonABI = "abi"
+	// ReasonArray is a callable with an array whose elements cannot be marshaled, it is passed as uintptr
+	ReasonArray = "array"
+	// ReasonPropertyType is a property of a type that has no GValue accessors
+	ReasonPropertyType = "property-type"
+	// ReasonFieldType is a record field without type information
+	ReasonFieldType = "field-type"
+	// ReasonConfig is a symbol that the skip list of the configuration leaves out, see Config
+	ReasonConfig = "config"
+	// ReasonManual is a callable that is written by hand in templates/, the manual list of the configuration leaves it out
+	ReasonManual = "manual"
+)
+
+// Skipped is a GIR symbol that is missing from the bindings or is generated in a form that cannot be used as is
+type Skipped struct {
+	// Path is the element path within the file, e.g. "namespace[Gtk]/class[Widget]/method[get_size]"
+	Path string `json:"path"`
+	// CIdentifier is the C symbol, if the element has one
+	CIdentifier string `json:"c_identifier,omitempty"`
+	// Reason is one of the Reason constants
+	Reason string `json:"reason"`
+	// Detail describes the reason for the symbol
+	Detail string `json:"detail,omitempty"`
+	// Generated is set if Go code is generated for the symbol anyway, e.g. a function that takes a uintptr for a struct
+	Generated bool `json:"generated"`
+}
+
+// Coverage lists the symbols of a namespace that are missing from the bindings
+type Coverage struct {
+	Namespace string `json:"namespace"`
+	Version   string `json:"version"`
+	// Symbols is the number of functions, methods, constructors, signals, callbacks and properties in the namespace
+	Symbols int `json:"symbols"`
+	// Missing is the number of symbols that have no Go counterpart
+	Missing int       `json:"missing"`
+	Skipped []Skipped `json:"skipped"`
+
+	// generated is the number of generated symbols by GIR element, see Report
+	generated map[string]int
+}
+
+// skip records a skipped symbol in the coverage of the namespace that is being converted
+func (p *Pass) skip(s Skipped) {
+	if p.coverage == nil {
+		return
+	}
+	if !s.Generated {
+		p.coverage.Missing++
+	}
+	p.coverage.Skipped = append(p.coverage.Skipped, s)
+}
+
+// callable converts a function, method, constructor, signal or callback with convert
+// and records it in the coverage, together with the arguments that purego cannot marshal
+func (p *Pass) callable(src source, ns, path, cid string, params *types.Parameters, ret *types.ReturnValue, throws bool, fn func()) bool {
+	p.coverage.Symbols++
+	if !p.convert(src, path, fn) {
+		return false
+	}
+	p.checkArgs(src, ns, path, cid, params, ret, throws)
+	return true
+}
+
+// checkArgs records the arguments of a converted callable that purego cannot marshal in the coverage
+func (p *Pass) checkArgs(src source, ns, path, cid string, params *types.Parameters, ret *types.ReturnValue, throws bool) {
+	// functions that are called from Go, not callbacks and signals, are replaced with a stub, see types.MapABI
+	if cid != "" {
+		if reason := types.UnsupportedABI(ns, p.Types, params, ret, throws); reason != "" {
+			p.diag(src, Warning, path, "", reason, "a stub that returns core.ErrUnsupportedABI is generated, call it through C code")
+			p.skip(Skipped{Path: path, CIdentifier: cid, Reason: ReasonABI, Generated: true, Detail: reason})
+			return
+		}
+	}
+	var args []types.ParameterAttrs
+	if params != nil {
+		for _, par := range params.Parameters {
+			args = append(args, par.ParameterAttrs)
+		}
+	}
+	if ret != nil {
+		args = append(args, types.ParameterAttrs{Name: "return value", AnyType: ret.AnyType})
+	}
+	for _, a := range args {
+		if a.Name == "..." {
+			if types.VarArgsMapped(cid, params) {
+				continue
+			}
+			p.skip(Skipped{Path: path, CIdentifier: cid, Reason: ReasonVarArgs, Generated: true,
+				Detail: "variadic C functions cannot be called through purego, use the variant that takes an array, if there is one"})
+			continue
+		}
+		if reason, detail := p.unmarshalable(ns, a.AnyType); reason != "" {
+			p.skip(Skipped{Path: path, CIdentifier: cid, Reason: reason, Generated: true,
+				Detail: fmt.Sprintf("%s: %s", a.Name, detail)})
+		}
+	}
+}
+
+// unmarshalable returns the reason why a type is generated as uintptr instead of a Go type, if it is
+func (p *Pass) unmarshalable(ns string, t types.AnyType) (reason string, detail string) {
+	if a := t.Array; a != nil {
+		if a.Template(ns, p.Types) != "uintptr" {
+			return "", ""
+		}
+		if a.Type == nil {
+			return ReasonArray, "the array has no element type"
+		}
+		return ReasonArray, fmt.Sprintf("the array of %s is passed as uintptr", a.Type.Name)
+	}
+	// without a C type the argument is a signal argument, which are always pointers
+	if t.Type == nil || t.Type.CType == "" || strings.Contains(t.Type.CType, "*") {
+		return "", ""
+	}
+	if c := types.StructByValue(ns, p.Types, t); c != "" {
+		return ReasonStructByValue, fmt.Sprintf("%s is passed by value, the Go function takes a uintptr", c)
+	}
+	return "", ""
+}
+
+// checkProperty records a property that has no Go accessors in the coverage
+func (p *Pass) checkProperty(ns, path string, prop types.Property) {
+	if prop.Template(ns, p.Types).GValueType != "" {
+		return
+	}
+	p.skip(Skipped{Path: path, Reason: ReasonPropertyType,
+		Detail: fmt.Sprintf("properties of type %s have no accessors, use GetProperty and SetProperty with a gobject.Value", prop.AnyType.Translate(ns, p.Types))})
+}
+
+// skipUnion records a union and its callables in the coverage, only a uintptr type is generated for it
+func (p *Pass) skipUnion(nsPath string, union types.Union) {
+	path := elementPath(nsPath, "union", union.Name)
+	p.skip(Skipped{Path: path, Reason: ReasonUnion, Detail: "the union is generated as uintptr, its fields are not accessible"})
+	skip := func(element string, c types.CallableAttrs) {
+		p.coverage.Symbols++
+		p.skip(Skipped{Path: elementPath(path, element, c.Name), CIdentifier: c.CIdentifier, Reason: ReasonUnion,
+			Detail: "callables of unions are not generated"})
+	}
+	for _, c := range union.Constructors {
+		skip("constructor", c.CallableAttrs)
+	}
+	for _, m := range union.Methods {
+		skip("method", m.CallableAttrs)
+	}
+	for _, f := range union.Functions {
+		skip("function", f.CallableAttrs)
+	}
+}
+
+// WriteCoverage writes the coverage of every namespace as JSON to dir, in a file named after the namespace and version,
+// e.g. Gtk-4.0.json
+func (p *Pass) WriteCoverage(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, c := range p.Coverage {
+		if c.Skipped == nil {
+			c.Skipped = []Skipped{}
+		}
+		data, err := json.MarshalIndent(c, "", "\t")
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(dir, fmt.Sprintf("%s-%s.json", c.Namespace, c.Version))
+		if err := os.WriteFile(name, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
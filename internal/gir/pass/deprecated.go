@@ -0,0 +1,57 @@
+package pass
+
+import "github.com/jwijenbergh/puregotk/internal/gir/types"
+
+// splitDeprecated moves the callables that the GIR files mark as deprecated out of args into the file
+// that it returns, the second return value is false if there are none. The file has the build constraint
+// !puregotk_no_deprecated, such that building with -tags puregotk_no_deprecated reports the uses of deprecated API as errors.
+// The types stay in args even if they are deprecated, as other symbols refer to them, and so do the methods of interfaces,
+// as the classes that implement them have to satisfy the Go interfaces.
+func splitDeprecated(args *types.TemplateArg) (types.TemplateArg, bool) {
+	deprecated := types.TemplateArg{
+		PkgName:         args.PkgName,
+		PkgEnv:          args.PkgEnv,
+		PkgConfigName:   args.PkgConfigName,
+		SharedLibraries: args.SharedLibraries,
+		Optional:        args.Optional,
+		Deprecated:      true,
+	}
+	found := false
+	split := func(fs []types.FuncTemplate) (kept []types.FuncTemplate, moved []types.FuncTemplate) {
+		for _, f := range fs {
+			if f.Deprecated {
+				moved = append(moved, f)
+				found = true
+			} else {
+				kept = append(kept, f)
+			}
+		}
+		return kept, moved
+	}
+
+	args.Functions, deprecated.Functions = split(args.Functions)
+	records := make([]types.RecordTemplate, 0, len(args.Records))
+	for _, rec := range args.Records {
+		moved := types.RecordTemplate{Name: rec.Name}
+		rec.Constructors, moved.Constructors = split(rec.Constructors)
+		rec.Receivers, moved.Receivers = split(rec.Receivers)
+		records = append(records, rec)
+		if len(moved.Constructors)+len(moved.Receivers) > 0 {
+			deprecated.Records = append(deprecated.Records, moved)
+		}
+	}
+	args.Records = records
+	classes := make([]types.ClassTemplate, 0, len(args.Classes))
+	for _, cls := range args.Classes {
+		moved := types.ClassTemplate{Name: cls.Name}
+		cls.Constructors, moved.Constructors = split(cls.Constructors)
+		cls.Receivers, moved.Receivers = split(cls.Receivers)
+		cls.Functions, moved.Functions = split(cls.Functions)
+		classes = append(classes, cls)
+		if len(moved.Constructors)+len(moved.Receivers)+len(moved.Functions) > 0 {
+			deprecated.Classes = append(deprecated.Classes, moved)
+		}
+	}
+	args.Classes = classes
+	return deprecated, found
+}
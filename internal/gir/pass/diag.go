@@ -0,0 +1,212 @@
+package pass
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Severity is the severity of a diagnostic
+type Severity int
+
+const (
+	// Warning is a construct that is generated, but possibly not correctly
+	Warning Severity = iota
+	// Error is a construct that could not be generated, the symbol is left out
+	Error
+)
+
+func (s Severity) String() string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// Diagnostic describes a construct of a GIR file that the generator cannot handle
+type Diagnostic struct {
+	Severity Severity
+	// File is the GIR file
+	File string
+	// Line is the line of the element in the file, 0 if it is not known
+	Line int
+	// Path is the element path within the file, e.g. "namespace[Gtk]/class[Widget]/method[get_size]"
+	Path string
+	// Attr is the attribute that caused the diagnostic, if any
+	Attr string
+	// Message describes the problem
+	Message string
+	// Suggestion describes how to fix or work around the problem, if known
+	Suggestion string
+}
+
+func (d Diagnostic) Error() string {
+	var sb strings.Builder
+	sb.WriteString(filepath.Base(d.File))
+	if d.Line > 0 {
+		fmt.Fprintf(&sb, ":%d", d.Line)
+	}
+	fmt.Fprintf(&sb, ": %s: %s", d.Severity, d.Path)
+	if d.Attr != "" {
+		fmt.Fprintf(&sb, " (%s)", d.Attr)
+	}
+	fmt.Fprintf(&sb, ": %s", d.Message)
+	if d.Suggestion != "" {
+		fmt.Fprintf(&sb, "; %s", d.Suggestion)
+	}
+	return sb.String()
+}
+
+// Diagnostics are the diagnostics of a run
+type Diagnostics []Diagnostic
+
+// Errors returns the number of errors
+func (ds Diagnostics) Errors() int {
+	n := 0
+	for _, d := range ds {
+		if d.Severity == Error {
+			n++
+		}
+	}
+	return n
+}
+
+// Summary lists the diagnostics by file and line, followed by their count
+func (ds Diagnostics) Summary() string {
+	sorted := append(Diagnostics(nil), ds...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].File != sorted[j].File {
+			return sorted[i].File < sorted[j].File
+		}
+		return sorted[i].Line < sorted[j].Line
+	})
+	var sb strings.Builder
+	for _, d := range sorted {
+		sb.WriteString(d.Error())
+		sb.WriteByte('\n')
+	}
+	errs := ds.Errors()
+	fmt.Fprintf(&sb, "%d errors, %d warnings", errs, len(ds)-errs)
+	return sb.String()
+}
+
+// elementPath returns the path of a child element with the name attribute below parent
+func elementPath(parent, element, name string) string {
+	p := element
+	if name != "" {
+		p += "[" + name + "]"
+	}
+	if parent == "" {
+		return p
+	}
+	return parent + "/" + p
+}
+
+// elementLines returns the line of every element path in the GIR file, the paths start below the repository element
+// encoding/xml does not keep positions while unmarshaling, so they are collected by walking the tokens
+func elementLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var stack []string
+	line, counted := 1, int64(0)
+	for {
+		// the offset before the token is the start of the element
+		offset := dec.InputOffset()
+		tok, err := dec.Token()
+		if err != nil {
+			return lines
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			line += bytes.Count(data[counted:offset], []byte("\n"))
+			counted = offset
+			if len(stack) == 0 {
+				// the repository element itself
+				stack = append(stack, "")
+				continue
+			}
+			var name string
+			for _, a := range t.Attr {
+				if a.Name.Local == "name" && a.Name.Space == "" {
+					name = a.Value
+					break
+				}
+			}
+			p := elementPath(stack[len(stack)-1], t.Name.Local, name)
+			if _, ok := lines[p]; !ok {
+				lines[p] = line
+			}
+			stack = append(stack, p)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// parseError converts an error of unmarshaling the GIR file to a diagnostic
+func parseError(file string, err error) Diagnostic {
+	d := Diagnostic{
+		Severity: Error,
+		File:     file,
+		Path:     "repository",
+		Message:  err.Error(),
+	}
+	if se, ok := err.(*xml.SyntaxError); ok {
+		d.Line = se.Line
+		d.Message = se.Msg
+		d.Suggestion = "the file is not well-formed XML, copy it again with copygir.sh"
+	}
+	return d
+}
+
+// source is a parsed GIR file together with what is needed to report diagnostics for it
+type source struct {
+	file  string
+	lines map[string]int
+}
+
+// diag records a diagnostic for the element path in the source
+func (p *Pass) diag(src source, severity Severity, path, attr, message, suggestion string) {
+	p.Diagnostics = append(p.Diagnostics, Diagnostic{
+		Severity:   severity,
+		File:       src.file,
+		Line:       src.lines[path],
+		Path:       path,
+		Attr:       attr,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// convert runs fn, which converts the symbol at the element path, and turns a panic into an error diagnostic
+// It returns false if the symbol could not be converted and should be left out
+func (p *Pass) convert(src source, path string, fn func()) (ok bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		ok = false
+		message := fmt.Sprint(r)
+		attr, suggestion := "", ""
+		switch {
+		case strings.Contains(message, "strconv"):
+			attr = "value"
+			suggestion = "the member value is not a decimal integer"
+		case strings.Contains(message, "unknown type"):
+			attr = "type"
+			suggestion = "the element has neither a type nor an array, check that its includes are in internal/gir/spec"
+		case strings.Contains(message, "not an interface"):
+			attr = "implements"
+			suggestion = "the implemented interface is not known, check that its namespace is in internal/gir/spec"
+		}
+		p.diag(src, Error, path, attr, message, suggestion)
+		p.skip(Skipped{Path: path, Reason: ReasonError, Detail: message})
+	}()
+	fn()
+	p.generated(path)
+	return true
+}
@@ -0,0 +1,450 @@
+package pass
+
+import (
+	"bytes"
+	"html"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+)
+
+// docIndex maps the symbols that the GIR docs refer to onto the generated Go symbols, qualified with their package, e.g. gtk.Widget.Show
+type docIndex struct {
+	// c maps C identifiers of callables and enumeration values to Go symbols
+	c map[string]string
+	// gir maps GIR names of types, constants, properties and signals to Go symbols,
+	// e.g. "Gtk.Widget", "Gtk.Widget:visible" and "Gtk.Widget::destroy"
+	gir map[string]string
+	// girC maps GIR names of callables and enumeration values to their C identifiers,
+	// e.g. "Gtk.Widget.show" to gtk_widget_show and "Gtk.Align.fill" to GTK_ALIGN_FILL
+	girC map[string]string
+	// cGIR maps the C names of types and constants to their GIR names, e.g. GtkWidget to "Gtk.Widget"
+	cGIR map[string]string
+	// written are the package names of the generated files by path, their docs are rewritten
+	written map[string]string
+}
+
+func newDocIndex() *docIndex {
+	return &docIndex{
+		c:       make(map[string]string),
+		gir:     make(map[string]string),
+		girC:    make(map[string]string),
+		cGIR:    make(map[string]string),
+		written: make(map[string]string),
+	}
+}
+
+// add maps key to the Go symbol sym of the package, the first symbol for a key wins
+func (d *docIndex) add(m map[string]string, key string, pkg string, sym string) {
+	if key == "" {
+		return
+	}
+	if _, ok := m[key]; !ok {
+		m[key] = pkg + "." + sym
+	}
+}
+
+// index records the symbols of a generated file of the namespace ns
+func (d *docIndex) index(ns string, args types.TemplateArg) {
+	pkg := args.PkgName
+	funcs := func(owner string, fs []types.FuncTemplate) {
+		for _, f := range fs {
+			if f.AliasCall != "" {
+				continue
+			}
+			sym := f.Name
+			if owner != "" {
+				sym = owner + "." + f.Name
+			}
+			d.add(d.c, f.CName, pkg, sym)
+		}
+	}
+	props := func(owner string, recv string, ps []types.PropertyTemplate) {
+		for _, prop := range ps {
+			method := "GetProperty" + prop.Name
+			if !prop.Readable {
+				method = "SetProperty" + prop.Name
+			}
+			d.add(d.gir, ns+"."+owner+":"+prop.CName, pkg, recv+"."+method)
+		}
+	}
+	for _, a := range args.Aliases {
+		d.add(d.gir, ns+"."+a.Name, pkg, a.Name)
+	}
+	for _, cb := range args.Callbacks {
+		d.add(d.gir, ns+"."+cb.Name, pkg, cb.Name)
+	}
+	for _, c := range args.Constants {
+		d.add(d.gir, ns+"."+c.Name, pkg, c.Name)
+	}
+	for _, e := range args.Enums {
+		d.add(d.gir, ns+"."+e.Name, pkg, e.Name)
+		for _, v := range e.Values {
+			d.add(d.c, v.CIdentifier, pkg, v.Name)
+		}
+	}
+	funcs("", args.Functions)
+	for _, r := range args.Records {
+		d.add(d.gir, ns+"."+r.Name, pkg, r.Name)
+		funcs("", r.Constructors)
+		funcs(r.Name, r.Receivers)
+	}
+	for _, i := range args.Interfaces {
+		d.add(d.gir, ns+"."+i.Name, pkg, i.Name)
+		for _, m := range i.Methods {
+			d.add(d.c, m.CName, pkg, i.Name+"."+m.Name)
+		}
+		props(i.Name, i.Name+"Base", i.Properties)
+	}
+	for _, cls := range args.Classes {
+		d.add(d.gir, ns+"."+cls.Name, pkg, cls.Name)
+		funcs("", cls.Constructors)
+		funcs(cls.Name, cls.Receivers)
+		funcs("", cls.Functions)
+		props(cls.Name, cls.Name, cls.Properties)
+		for _, s := range cls.Signals {
+			d.add(d.gir, ns+"."+cls.Name+"::"+s.CName, pkg, cls.Name+".Connect"+s.Name+"Func")
+		}
+	}
+}
+
+// names records the GIR and C names of the symbols of the parsed repositories
+func (d *docIndex) names(parsed []types.Repository) {
+	for _, r := range parsed {
+		ns := r.Namespaces[0]
+		callables := func(owner string, cas ...types.CallableAttrs) {
+			for _, ca := range cas {
+				d.girC[ns.Name+"."+owner+ca.Name] = ca.CIdentifier
+			}
+		}
+		typ := func(name string, ctype string) {
+			if ctype != "" {
+				d.cGIR[ctype] = ns.Name + "." + name
+			}
+		}
+		for _, f := range ns.Functions {
+			callables("", f.CallableAttrs)
+		}
+		for _, c := range ns.Constants {
+			typ(c.Name, c.CType)
+		}
+		for _, a := range ns.Aliases {
+			typ(a.Name, a.CType)
+		}
+		for _, cb := range ns.Callbacks {
+			// callbacks have no C type attribute, their C type is the one of the typedef
+			prefix, _, _ := strings.Cut(ns.CIdentifierPrefixes, ",")
+			typ(cb.Name, prefix+cb.Name)
+		}
+		for _, u := range ns.Unions {
+			typ(u.Name, u.CType)
+		}
+		enum := func(name string, ctype string, members []types.Member, functions []types.Function) {
+			typ(name, ctype)
+			for _, m := range members {
+				d.girC[ns.Name+"."+name+"."+m.Name()] = m.CIdentifier
+			}
+			for _, f := range functions {
+				callables(name+".", f.CallableAttrs)
+			}
+		}
+		for _, e := range ns.Enums {
+			enum(e.Name, e.CType, e.Members, e.Functions)
+		}
+		for _, b := range ns.Bitfields {
+			enum(b.Name, b.CType, b.Members, b.Functions)
+		}
+		for _, rec := range ns.Records {
+			typ(rec.Name, rec.CType)
+			for _, c := range rec.Constructors {
+				callables(rec.Name+".", c.CallableAttrs)
+			}
+			for _, m := range rec.Methods {
+				callables(rec.Name+".", m.CallableAttrs)
+			}
+			for _, f := range rec.Functions {
+				callables(rec.Name+".", f.CallableAttrs)
+			}
+		}
+		for _, i := range ns.Interfaces {
+			typ(i.Name, i.CType)
+			for _, m := range i.Methods {
+				callables(i.Name+".", m.CallableAttrs)
+			}
+			for _, f := range i.Functions {
+				callables(i.Name+".", f.CallableAttrs)
+			}
+		}
+		for _, cls := range ns.Classes {
+			typ(cls.Name, cls.CType)
+			for _, c := range cls.Constructors {
+				callables(cls.Name+".", c.CallableAttrs)
+			}
+			for _, m := range cls.Methods {
+				callables(cls.Name+".", m.CallableAttrs)
+			}
+			for _, f := range cls.Functions {
+				callables(cls.Name+".", f.CallableAttrs)
+			}
+		}
+	}
+}
+
+// resolve returns the Go symbol of a gi-docgen link like [method@Gtk.Widget.show], kind is method and target is Gtk.Widget.show
+func (d *docIndex) resolve(kind string, target string) (string, bool) {
+	switch kind {
+	case "method", "ctor", "func":
+		sym, ok := d.c[d.girC[target]]
+		return sym, ok
+	case "id":
+		sym, ok := d.c[target]
+		return sym, ok
+	case "property", "signal", "const", "class", "iface", "struct", "enum", "flags", "error", "callback", "type", "alias":
+		if sym, ok := d.gir[target]; ok {
+			return sym, true
+		}
+		// a value of an enumeration, e.g. [enum@Gtk.Align.fill]
+		sym, ok := d.c[d.girC[target]]
+		return sym, ok
+	}
+	return "", false
+}
+
+// lookupC returns the Go symbol of a C identifier or of a C type name with an optional property or signal,
+// e.g. GtkWidget:visible or GtkWidget::destroy
+func (d *docIndex) lookupC(id string) (string, bool) {
+	if sym, ok := d.c[id]; ok {
+		return sym, true
+	}
+	if name, signal, ok := strings.Cut(id, "::"); ok {
+		return d.resolve("signal", d.cGIR[name]+"::"+signal)
+	}
+	if name, prop, ok := strings.Cut(id, ":"); ok {
+		return d.resolve("property", d.cGIR[name]+":"+prop)
+	}
+	gir, ok := d.cGIR[id]
+	if !ok {
+		return "", false
+	}
+	return d.resolve("type", gir)
+}
+
+var (
+	docLinkRe      = regexp.MustCompile(`\[([a-z]+)@([A-Za-z0-9_.:-]+)\]`)
+	mdImageRe      = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	mdLinkRe       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	docConstRe     = regexp.MustCompile(`%([A-Z][A-Z0-9_]*)\b`)
+	docTypeRe      = regexp.MustCompile(`(^|[^\w&#])#([A-Z][A-Za-z0-9]*(?:::?[a-z][a-z0-9-]*)?)`)
+	docFuncRe      = regexp.MustCompile(`\b([a-z][a-z0-9]*_[a-z0-9_]+)\(\)`)
+	docParamRe     = regexp.MustCompile(`(^|[^\w@.])@([a-z_][a-z0-9_]*)\b`)
+	docHeadingRe   = regexp.MustCompile(`^#+\s+(.*)$`)
+	docTagRe       = regexp.MustCompile(`</?(?:kbd|br|span|div|p|b|i|em|strong|code|sup|sub)\b[^>]*>`)
+	docImgRe       = regexp.MustCompile(`<(?:img|source)\b[^>]*>`)
+	docPictureRe   = regexp.MustCompile(`<picture\b[^>]*>`)
+	docPictureEnd  = "</picture>"
+	docFenceStart  = []string{"```", "|["}
+	docCommentLine = regexp.MustCompile(`^(\s*)//(.*)$`)
+)
+
+// rewrite rewrites the doc comments of the written files, see (*docIndex).comment
+func (d *docIndex) rewrite() error {
+	for path, pkg := range d.written {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		out := d.file(src, pkg)
+		if bytes.Equal(src, out) {
+			continue
+		}
+		if err := os.WriteFile(path, out, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// file rewrites the blocks of line comments of a generated Go file of the package pkg
+func (d *docIndex) file(src []byte, pkg string) []byte {
+	lines := strings.Split(string(src), "\n")
+	out := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); {
+		m := docCommentLine.FindStringSubmatch(lines[i])
+		if m == nil || isDirective(m[2]) {
+			out = append(out, lines[i])
+			i++
+			continue
+		}
+		indent := m[1]
+		var block []string
+		for ; i < len(lines); i++ {
+			m := docCommentLine.FindStringSubmatch(lines[i])
+			if m == nil || isDirective(m[2]) {
+				break
+			}
+			block = append(block, strings.TrimPrefix(m[2], " "))
+		}
+		for _, l := range d.comment(block, pkg) {
+			if l == "" {
+				out = append(out, indent+"//")
+			} else {
+				out = append(out, indent+"// "+l)
+			}
+		}
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+// isDirective reports whether the text of a line comment is a directive like //go:build
+func isDirective(text string) bool {
+	return strings.HasPrefix(text, "go:") || strings.HasPrefix(text, "export ") || strings.HasPrefix(text, "line ")
+}
+
+// comment converts the lines of a doc comment from gi-docgen and gtk-doc markup to Go doc comment syntax:
+// links to symbols become Go doc links, %TRUE, %FALSE and %NULL become true, false and nil,
+// code blocks are indented and markdown links become link definitions
+func (d *docIndex) comment(lines []string, pkg string) []string {
+	out := make([]string, 0, len(lines))
+	blank := func() {
+		if len(out) > 0 && out[len(out)-1] != "" {
+			out = append(out, "")
+		}
+	}
+	var defs []string
+	linked := make(map[string]bool)
+	inCode, inPicture := false, false
+	for _, l := range lines {
+		l = html.UnescapeString(l)
+		trimmed := strings.TrimSpace(l)
+		if inCode {
+			if strings.HasPrefix(trimmed, "```") || strings.HasSuffix(trimmed, "]|") {
+				inCode = false
+				out = append(out, "")
+				continue
+			}
+			if trimmed == "" {
+				out = append(out, "")
+			} else {
+				out = append(out, "\t"+l)
+			}
+			continue
+		}
+		if code := docCodeStart(trimmed); code {
+			inCode = true
+			blank()
+			continue
+		}
+		if inPicture {
+			if _, after, ok := strings.Cut(l, docPictureEnd); ok {
+				inPicture = false
+				l = after
+			} else {
+				continue
+			}
+		}
+		if loc := docPictureRe.FindStringIndex(l); loc != nil {
+			before, after := l[:loc[0]], l[loc[1]:]
+			if _, rest, ok := strings.Cut(after, docPictureEnd); ok {
+				l = before + rest
+			} else {
+				l = before
+				inPicture = true
+			}
+		}
+		l = docImgRe.ReplaceAllString(l, "")
+		l = docTagRe.ReplaceAllString(l, "")
+		l = mdImageRe.ReplaceAllString(l, "")
+		if strings.TrimSpace(l) == "" && trimmed != "" {
+			// the line only had an image
+			continue
+		}
+		if m := docHeadingRe.FindStringSubmatch(l); m != nil {
+			blank()
+			out = append(out, "# "+m[1], "")
+			continue
+		}
+		l = d.line(l, pkg)
+		l = mdLinkRe.ReplaceAllStringFunc(l, func(s string) string {
+			m := mdLinkRe.FindStringSubmatch(s)
+			text := strings.ReplaceAll(m[1], "`", "")
+			if !strings.HasPrefix(m[2], "http://") && !strings.HasPrefix(m[2], "https://") {
+				return text
+			}
+			if !linked[text] {
+				linked[text] = true
+				defs = append(defs, "["+text+"]: "+m[2])
+			}
+			return "[" + text + "]"
+		})
+		if len(out) > 0 && out[len(out)-1] == "" && strings.TrimSpace(l) == "" {
+			continue
+		}
+		out = append(out, strings.TrimRight(l, " \t"))
+	}
+	if len(defs) > 0 {
+		blank()
+		out = append(out, defs...)
+	}
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// docCodeStart reports whether the trimmed line starts a code block, a markdown fence or a gtk-doc |[ block
+func docCodeStart(trimmed string) bool {
+	for _, s := range docFenceStart {
+		if strings.HasPrefix(trimmed, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// line rewrites the references to symbols in a line of a doc comment of the package pkg
+func (d *docIndex) line(l string, pkg string) string {
+	link := func(sym string) string {
+		return "[" + strings.TrimPrefix(sym, pkg+".") + "]"
+	}
+	l = docLinkRe.ReplaceAllStringFunc(l, func(s string) string {
+		m := docLinkRe.FindStringSubmatch(s)
+		if sym, ok := d.resolve(m[1], m[2]); ok {
+			return link(sym)
+		}
+		// keep the name without the namespace as text
+		if _, name, ok := strings.Cut(m[2], "."); ok && m[1] != "id" {
+			return name
+		}
+		return m[2]
+	})
+	l = docConstRe.ReplaceAllStringFunc(l, func(s string) string {
+		switch s {
+		case "%TRUE":
+			return "true"
+		case "%FALSE":
+			return "false"
+		case "%NULL":
+			return "nil"
+		}
+		if sym, ok := d.lookupC(s[1:]); ok {
+			return link(sym)
+		}
+		return s[1:]
+	})
+	l = docTypeRe.ReplaceAllStringFunc(l, func(s string) string {
+		m := docTypeRe.FindStringSubmatch(s)
+		if sym, ok := d.lookupC(m[2]); ok {
+			return m[1] + link(sym)
+		}
+		return m[1] + m[2]
+	})
+	l = docFuncRe.ReplaceAllStringFunc(l, func(s string) string {
+		if sym, ok := d.c[strings.TrimSuffix(s, "()")]; ok {
+			return link(sym)
+		}
+		return s
+	})
+	return docParamRe.ReplaceAllString(l, "$1$2")
+}
@@ -0,0 +1,188 @@
+package pass
+
+import (
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/types"
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// basicSizes are the sizes in bytes of the fundamental GIR types on 64-bit platforms,
+// long is counted with 8 bytes on every platform as the sizes only need to be large enough
+var basicSizes = map[string]int{
+	"gboolean": 4, "gint": 4, "guint": 4, "gint32": 4, "guint32": 4, "gfloat": 4, "gunichar": 4,
+	"gint8": 1, "guint8": 1, "gchar": 1, "guchar": 1,
+	"gint16": 2, "guint16": 2, "gunichar2": 2, "gshort": 2, "gushort": 2,
+	"gint64": 8, "guint64": 8, "gdouble": 8, "gsize": 8, "gssize": 8, "glong": 8, "gulong": 8,
+	"goffset": 8, "gintptr": 8, "guintptr": 8, "GType": 8, "time_t": 8, "off_t": 8, "pid_t": 4, "uid_t": 4,
+	"gpointer": 8, "gconstpointer": 8, "utf8": 8, "filename": 8,
+}
+
+// collectCallerAllocated records the records that are passed to a function as caller allocated out parameters
+func (p *Pass) collectCallerAllocated(ns string, params ...*types.Parameters) {
+	for _, ps := range params {
+		if ps == nil {
+			continue
+		}
+		for _, param := range ps.Parameters {
+			if !param.CallerAllocates || param.Type == nil {
+				continue
+			}
+			if p.Types.Kind(ns, param.Type.Name) == types.RecordsType {
+				p.callerAllocated[util.NormalizeNamespace(ns, param.Type.Name, false)] = true
+			}
+		}
+	}
+}
+
+// collectRepositoryCallerAllocated records the caller allocated records of all functions of the repository
+func (p *Pass) collectRepositoryCallerAllocated(r types.Repository) {
+	ns := r.Namespaces[0]
+	callables := func(fns []types.Function, ctors []types.Constructor, methods []types.Method) {
+		for _, f := range fns {
+			p.collectCallerAllocated(ns.Name, f.Parameters)
+		}
+		for _, c := range ctors {
+			p.collectCallerAllocated(ns.Name, c.Parameters)
+		}
+		for _, m := range methods {
+			p.collectCallerAllocated(ns.Name, m.Parameters)
+		}
+	}
+	callables(ns.Functions, nil, nil)
+	for _, cls := range ns.Classes {
+		callables(cls.Functions, cls.Constructors, cls.Methods)
+	}
+	for _, inter := range ns.Interfaces {
+		callables(inter.Functions, nil, inter.Methods)
+	}
+	for _, rec := range ns.Records {
+		callables(rec.Functions, rec.Constructors, rec.Methods)
+	}
+}
+
+// hasAllocator returns whether the record has its own constructor to allocate it, e.g. graphene_rect_alloc,
+// which is used instead of New<Name>Alloc as the memory may need to be released in a specific way
+func hasAllocator(rec types.Record) bool {
+	for _, c := range rec.Constructors {
+		if c.Name == "alloc" {
+			return true
+		}
+	}
+	for _, f := range rec.Functions {
+		if f.Name == "alloc" {
+			return true
+		}
+	}
+	return false
+}
+
+// allocSize returns a size in bytes that is at least the size of the C struct of the record on 64-bit platforms,
+// or 0 if the record is opaque or a field has a size that is not known.
+// The fields of records and unions are not in document order once parsed,
+// so every field is padded to the largest alignment, which can only overestimate the size
+func (p *Pass) allocSize(ns string, rec types.Record) int {
+	if rec.Disguised || rec.GLibIsGTypeStructFor != "" || len(rec.Fields)+len(rec.Unions) == 0 {
+		return 0
+	}
+	size, _ := p.structSize(ns, rec.Fields, rec.Unions, nil, false, 0)
+	return size
+}
+
+// structSize returns the size and alignment of a struct, or of a union if union is true
+func (p *Pass) structSize(ns string, fields []types.Field, unions []types.Union, records []types.Record, union bool, depth int) (int, int) {
+	if depth > 8 {
+		return 0, 0
+	}
+	var sizes []int
+	align := 1
+	add := func(size, a int) bool {
+		if size == 0 {
+			return false
+		}
+		sizes = append(sizes, size)
+		if a > align {
+			align = a
+		}
+		return true
+	}
+	for _, f := range fields {
+		if !add(p.fieldSize(ns, f, depth)) {
+			return 0, 0
+		}
+	}
+	for _, u := range unions {
+		if !add(p.structSize(ns, u.Fields, nil, u.Records, true, depth+1)) {
+			return 0, 0
+		}
+	}
+	for _, r := range records {
+		if !add(p.structSize(ns, r.Fields, r.Unions, nil, false, depth+1)) {
+			return 0, 0
+		}
+	}
+	total := 0
+	for _, s := range sizes {
+		padded := roundUp(s, align)
+		if union {
+			total = max(total, padded)
+		} else {
+			total += padded
+		}
+	}
+	return roundUp(total, align), align
+}
+
+// fieldSize returns the size and alignment of a field, or 0 if it is not known
+func (p *Pass) fieldSize(ns string, f types.Field, depth int) (int, int) {
+	switch {
+	case f.Callback != nil:
+		return 8, 8
+	case f.Bits > 0:
+		// every bit field is counted as a full int
+		return 4, 4
+	case f.Array != nil:
+		if f.Array.FixedSize == 0 || strings.Contains(f.Array.CType, "*") {
+			return 8, 8
+		}
+		if f.Array.Type == nil {
+			return 0, 0
+		}
+		size, align := p.typeSize(ns, *f.Array.Type, depth)
+		return size * f.Array.FixedSize, align
+	case f.Type != nil:
+		return p.typeSize(ns, *f.Type, depth)
+	}
+	return 0, 0
+}
+
+// typeSize returns the size and alignment of a type that is stored by value, or 0 if it is not known
+func (p *Pass) typeSize(ns string, t types.Type, depth int) (int, int) {
+	if strings.Contains(t.CType, "*") {
+		return 8, 8
+	}
+	if size, ok := basicSizes[t.Name]; ok {
+		return size, size
+	}
+	name := util.NormalizeNamespace(ns, t.Name, false)
+	typeNs, _, _ := strings.Cut(name, ".")
+	if u, ok := p.unions[name]; ok {
+		return p.structSize(typeNs, u.Fields, nil, u.Records, true, depth+1)
+	}
+	switch v := p.Types[name].Value.(type) {
+	case types.Enum, types.Bitfield:
+		return 4, 4
+	case types.Alias:
+		return p.typeSize(typeNs, v.Type, depth+1)
+	case types.Record:
+		if v.Disguised || len(v.Fields)+len(v.Unions) == 0 {
+			return 0, 0
+		}
+		return p.structSize(typeNs, v.Fields, v.Unions, nil, false, depth+1)
+	}
+	return 0, 0
+}
+
+func roundUp(n, align int) int {
+	return (n + align - 1) / align * align
+}
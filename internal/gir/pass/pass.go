@@ -7,6 +7,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -17,14 +18,44 @@ import (
 type Pass struct {
 	Parsed []types.Repository
 	Types  types.KindMap
+	// Diagnostics are the constructs of the GIR files that could not be converted, filled by the second pass
+	Diagnostics Diagnostics
+	// Coverage lists the symbols of every namespace that are missing from the bindings, filled by the second pass
+	Coverage []*Coverage
+	// Namespaces are the namespaces that the second pass converts to Go files, all parsed namespaces if empty.
+	// The other namespaces only provide the types that the converted ones refer to
+	Namespaces []string
+	// LibrarySuffix is appended to the library names that the converted namespaces are loaded with, see core.GetPaths,
+	// such that e.g. Gtk-3.0 is loaded as GTK3 instead of sharing the library names of Gtk-4.0
+	LibrarySuffix string
+
+	sources  []source
+	coverage *Coverage
+	// callerAllocated are the records, by namespace qualified name, that functions fill as caller allocated out parameters
+	callerAllocated map[string]bool
+	// unions are the unions of all namespaces by namespace qualified name, to compute the size of records
+	unions map[string]types.Union
+	// configured are the symbols that the configuration left out by namespace, see Configure
+	configured map[string][]configured
+	// optional are the namespaces whose library may be missing at runtime, see Config.Optional
+	optional map[string]bool
+	// docs are the symbols that the doc comments of the written files link to, see (*docIndex).rewrite
+	docs *docIndex
 }
 
 // New creates a new pass struct by parsing gir files in the string slice
 // This pass object will then be used to go over these files multiple times up until we have the full info to convert it to go files
+// A file that cannot be parsed results in a Diagnostic error with the line of the problem
 func New(files []string) (*Pass, error) {
 	p := Pass{
-		Parsed: make([]types.Repository, len(files)),
-		Types:  make(types.KindMap),
+		Parsed:          make([]types.Repository, len(files)),
+		Types:           make(types.KindMap),
+		sources:         make([]source, len(files)),
+		callerAllocated: make(map[string]bool),
+		unions:          make(map[string]types.Union),
+		configured:      make(map[string][]configured),
+		optional:        make(map[string]bool),
+		docs:            newDocIndex(),
 	}
 	for i, f := range files {
 		b, err := os.ReadFile(f)
@@ -34,9 +65,10 @@ func New(files []string) (*Pass, error) {
 		var r types.Repository
 		err = xml.Unmarshal(b, &r)
 		if err != nil {
-			return nil, err
+			return nil, parseError(f, err)
 		}
 		p.Parsed[i] = r
+		p.sources[i] = source{file: f, lines: elementLines(b)}
 	}
 	return &p, nil
 }
@@ -85,192 +117,214 @@ func (p *Pass) First() {
 	for _, r := range p.Parsed {
 		p.collectTypes(r)
 	}
+	for _, r := range p.Parsed {
+		p.collectRepositoryCallerAllocated(r)
+		ns := r.Namespaces[0]
+		for _, u := range ns.Unions {
+			p.unions[util.NormalizeNamespace(ns.Name, u.Name, false)] = u
+		}
+	}
 }
 
-func (p *Pass) writeGo(r types.Repository, gotemp *template.Template, dir string) {
+func (p *Pass) writeGo(r types.Repository, src source, gotemp *template.Template, dir string) {
 	ns := r.Namespaces[0]
+	nsPath := elementPath("", "namespace", ns.Name)
+	p.coverage = &Coverage{Namespace: ns.Name, Version: ns.Version}
+	p.Coverage = append(p.Coverage, p.coverage)
+	p.recordConfigured(ns.Name)
 
 	aliases := make(map[string][]types.AliasTemplate)
 	enums := make(map[string][]types.EnumTemplate)
 	var files []string
 	for _, el := range ns.Bitfields {
-		temp := el.Template(ns.Name)
-		fn := el.FilenameSafe()
-		files = append(files, fn)
-		enums[fn] = append(enums[fn], temp)
+		p.convert(src, elementPath(nsPath, "bitfield", el.Name), func() {
+			temp := el.Template(ns.Name)
+			fn := el.FilenameSafe()
+			files = append(files, fn)
+			enums[fn] = append(enums[fn], temp)
+		})
 	}
 
 	for _, el := range ns.Enums {
-		temp := el.Template(ns.Name)
-		fn := el.FilenameSafe()
-		files = append(files, fn)
-		enums[fn] = append(enums[fn], temp)
+		p.convert(src, elementPath(nsPath, "enumeration", el.Name), func() {
+			temp := el.Template(ns.Name)
+			fn := el.FilenameSafe()
+			files = append(files, fn)
+			enums[fn] = append(enums[fn], temp)
+		})
 	}
 
 	constants := make(map[string][]types.ConstantTemplate)
 	for _, con := range ns.Constants {
-		fn := con.FilenameSafe()
-		files = append(files, fn)
-		constants[fn] = append(constants[fn], con.Template(ns.Name, p.Types))
+		p.convert(src, elementPath(nsPath, "constant", con.Name), func() {
+			temp := con.Template(ns.Name, p.Types)
+			fn := con.FilenameSafe()
+			files = append(files, fn)
+			constants[fn] = append(constants[fn], temp)
+		})
 	}
 
 	callbackDocs := make(map[string]string)
 	for _, cb := range ns.Callbacks {
-		callbackDocs[cb.Name] = cb.Doc.StringSafe()
+		callbackDocs[cb.Name] = types.DocString(cb.InfoAttrs, cb.InfoElements)
 	}
 
 	records := make(map[string][]types.RecordTemplate)
 	recordLookup := make(map[string]bool)
+	// typeStructs are the C names of the generated class and interface structures by their Go name,
+	// opaque structures are left out as their Go struct has no size
+	typeStructs := make(map[string]string)
+	// vtables are the callback fields of the class and interface structures by their Go name
+	vtables := make(map[string][]types.CallbackAccessor)
 	for _, rec := range ns.Records {
+		recPath := elementPath(nsPath, "record", rec.Name)
 		name := util.SnakeToCamel(rec.Name)
-		constructors := make([]types.FuncTemplate, len(rec.Constructors))
+		constructors := make([]types.FuncTemplate, 0, len(rec.Constructors))
 		receivers := make([]types.FuncTemplate, 0, len(rec.Methods))
-		fields := make([]types.RecordField, 0, len(rec.Fields))
-		callbackAccessors := make([]types.CallbackAccessor, 0)
+		var fields []types.RecordField
+		var callbackAccessors []types.CallbackAccessor
+		// the fields make up the layout of the struct, so the record is left out if one cannot be converted
+		if !p.convert(src, recPath, func() {
+			fields, callbackAccessors = p.recordFields(src, recPath, ns.Name, rec, callbackDocs)
+		}) {
+			continue
+		}
 		fn := rec.FilenameSafe()
 		files = append(files, fn)
-		for i, c := range rec.Constructors {
-			constructors[i] = types.FuncTemplate{
-				Name:  util.ConstructorName(c.Name, rec.Name),
-				CName: c.CIdentifier,
-				Doc:   c.Doc.StringSafe(),
-				Args:  c.Parameters.Template(ns.Name, "", p.Types, c.Throws, types.ArgsFromGoToC),
-				Ret:   c.ReturnValue.Template(ns.Name, "", p.Types, c.Throws),
-			}
+		recConstructorName := func(name string) string {
+			return util.ConstructorName(name, rec.Name)
+		}
+		shadows := types.Shadows(rec.Constructors)
+		for _, c := range rec.Constructors {
+			cPath := elementPath(recPath, "constructor", c.Name)
+			name := recConstructorName(c.Name)
+			p.callable(src, ns.Name, cPath, c.CIdentifier, c.Parameters, c.ReturnValue, c.Throws, func() {
+				cT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+					Name:       name,
+					CName:      c.CIdentifier,
+					Doc:        shadowDoc(types.DocString(c.InfoAttrs, c.InfoElements), c.CallableAttrs, shadows, recConstructorName),
+					Deprecated: c.Deprecated,
+					Since:      types.Since(c.Version, rec.Version),
+					Args:       c.Parameters.Template(ns.Name, "", p.Types, c.Throws, types.ArgsFromGoToC),
+					Ret:        c.ReturnValue.Template(ns.Name, "", p.Types, c.Throws),
+				}, c.Parameters, false), c.Parameters, c.ReturnValue), c.ReturnValue), ns.Name, p.Types, c.Parameters, c.ReturnValue, c.Throws)
+				constructors = append(constructors, cT)
+			})
 		}
-		for _, f := range rec.Fields {
-			var _type string
-			var fieldName string
-
-			// Check if this field is a callback
-			if f.Callback != nil {
-				_type = "uintptr"
-				fieldName = "x" + util.SnakeToCamel(f.Name) // Prefix callback pointer fields with `x` to make them private
-
-				callbackName := util.SnakeToCamel(f.Name)
-				args := f.Callback.Parameters.Template(ns.Name, "", p.Types, f.Callback.Throws, types.ArgsFromCToGo)
-				ret := f.Callback.ReturnValue.Template(ns.Name, "", p.Types, f.Callback.Throws)
-
-				apiTypes := args.API.Types
-
-				var doc string
-				if f.Callback.Doc != nil && f.Callback.Doc.String != "" {
-					doc = f.Callback.Doc.StringSafe()
-				} else {
-					baseClassName := strings.TrimSuffix(rec.Name, "Class")
-					callbackName := baseClassName + util.SnakeToCamel(f.Name) + "Func"
-
-					if callbackDoc, exists := callbackDocs[callbackName]; exists && callbackDoc != "" {
-						doc = callbackDoc
-					} else {
-						doc = f.Doc.StringSafe()
-					}
-				}
-
-				callbackAccessors = append(callbackAccessors, types.CallbackAccessor{
-					Name:         callbackName,
-					CName:        f.Name,
-					Doc:          doc,
-					CallbackType: "func(" + strings.Join(apiTypes, ", ") + ") " + ret.Value,
-					Args:         args,
-					Ret:          ret,
-				})
-			} else {
-				_type = f.Translate(ns.Name, p.Types)
-				if _type == "" {
-					continue
-				}
-				// HACK: Handle the specific case where a gint is converted to an int
-				// But for structs this needs to be an int32 as purego just gets the pointer to the struct
-				// Instead of converting each field separately
-				if f.AnyType.Type != nil && f.AnyType.Type.CType == "gint" {
-					_type = "int32"
-				}
-
-				// HACK: in structs the strings should be uintptr as we convert it ourselves
-				if _type == "string" {
-					_type = "uintptr"
-				}
-
-				// HACK: Special handling for parent_class field - it should be embedded as a full struct
-				// to match C's memory layout, not converted to uintptr
-				// See https://docs.gtk.org/gobject/tutorial.html
-				if f.Name == "parent_class" && f.AnyType.Type != nil {
-					// Check if this is a Record type with no pointers (embedded struct)
-					typeName := util.NormalizeNamespace(ns.Name, f.AnyType.Type.Name, true)
-					kind := p.Types.Kind(ns.Name, typeName)
-					if kind == types.RecordsType && !strings.Contains(f.AnyType.Type.CType, "*") {
-						// Use the full struct type for embedding
-						_type = typeName
-					}
+		recMethodName := func(name string) string {
+			goName := util.SnakeToCamel(name)
+			for _, f := range fields {
+				if f.Name == goName {
+					return goName + "Fn"
 				}
-
-				fieldName = util.SnakeToCamel(f.Name)
 			}
-
-			fields = append(fields, types.RecordField{
-				Name: fieldName,
-				Type: _type,
-			})
+			return goName
 		}
+		shadows = types.Shadows(rec.Methods)
 		for _, f := range rec.Methods {
-			name := util.SnakeToCamel(f.Name)
+			mPath := elementPath(recPath, "method", f.Name)
+			name := recMethodName(f.Name)
 			if name == "" {
 				name = util.SnakeToCamel(f.CIdentifier)
 			}
-			for _, f := range fields {
-				if f.Name == name {
-					name = name + "Fn"
-					break
+			p.callable(src, ns.Name, mPath, f.CIdentifier, f.Parameters, f.ReturnValue, f.Throws, func() {
+				mT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+					Doc:        shadowDoc(types.DocString(f.InfoAttrs, f.InfoElements), f.CallableAttrs, shadows, recMethodName),
+					Deprecated: f.Deprecated,
+					Since:      types.Since(f.Version, rec.Version),
+					Name:       name,
+					CName:      f.CIdentifier,
+					Args:       f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
+					Ret:        f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+				}, f.Parameters, true), f.Parameters, f.ReturnValue), f.ReturnValue), ns.Name, p.Types, f.Parameters, f.ReturnValue, f.Throws)
+				if moved, ok := p.movedMethod(ns, rec.Name, f); ok {
+					mT = types.AliasFunc(mT, name, moved, "x")
 				}
-			}
-			receivers = append(receivers, types.FuncTemplate{
-				Doc:   f.Doc.StringSafe(),
-				Name:  name,
-				CName: f.CIdentifier,
-				Args:  f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
-				Ret:   f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+				receivers = append(receivers, mT)
 			})
 		}
-		records[fn] = append(records[fn], types.RecordTemplate{
+		rT := types.RecordTemplate{
 			Name:              name,
-			Doc:               rec.Doc.StringSafe(),
+			Doc:               types.DocString(rec.InfoAttrs, rec.InfoElements),
 			Constructors:      constructors,
 			Receivers:         receivers,
 			Fields:            fields,
 			CallbackAccessors: callbackAccessors,
 			TypeGetter:        rec.GLibGetType,
-		})
+		}
+		if rec.Boxed(ns.Name) {
+			rT.BoxedCopy, rT.BoxedFree = p.boxedMethods(rec, fields)
+			if strings.ToLower(ns.Name) != "gobject" {
+				rT.GObject = "gobject."
+			}
+		}
+		if p.callerAllocated[util.NormalizeNamespace(ns.Name, rec.Name, false)] && !hasAllocator(rec) {
+			rT.AllocSize = p.allocSize(ns.Name, rec)
+			if rT.AllocSize > 0 && !rec.Boxed(ns.Name) {
+				// the memory of the allocation is released with g_free unless the record has its own free function
+				_, rT.AllocFree = p.boxedMethods(rec, fields)
+			}
+		}
+		records[fn] = append(records[fn], rT)
 		recordLookup[name] = true
+		if rec.GLibIsGTypeStructFor != "" && len(fields) > 0 {
+			typeStructs[name] = rec.CType
+			vtables[name] = callbackAccessors
+		}
 	}
 
 	callbacks := make(map[string][]types.CallbackTemplate)
 	// set every callback equal to uintptr as well
 	for _, cb := range ns.Callbacks {
-		fn := cb.FilenameSafe()
-		files = append(files, fn)
-		cbT := types.CallbackTemplate{
-			Doc:  cb.Doc.StringSafe(),
-			Name: cb.Name,
-			Args: cb.Parameters.Template(ns.Name, "", p.Types, cb.Throws, types.ArgsFromCToGo),
-			Ret:  cb.ReturnValue.Template(ns.Name, "", p.Types, cb.Throws),
-		}
-		callbacks[fn] = append(callbacks[fn], cbT)
+		p.callable(src, ns.Name, elementPath(nsPath, "callback", cb.Name), "", cb.Parameters, cb.ReturnValue, cb.Throws, func() {
+			cbT := types.CallbackTemplate{
+				Doc:  types.DocString(cb.InfoAttrs, cb.InfoElements),
+				Name: cb.Name,
+				Args: cb.Parameters.Template(ns.Name, "", p.Types, cb.Throws, types.ArgsFromCToGo),
+				Ret:  cb.ReturnValue.Template(ns.Name, "", p.Types, cb.Throws),
+			}
+			fn := cb.FilenameSafe()
+			files = append(files, fn)
+			callbacks[fn] = append(callbacks[fn], cbT)
+		})
 	}
 
 	interfaces := make(map[string][]types.InterfaceTemplate)
 	for _, inter := range ns.Interfaces {
-		fn := inter.FilenameSafe()
-		files = append(files, fn)
-		interfaces[fn] = append(interfaces[fn], types.ConvertInterface(ns.Name, "", inter, nil, p.Types))
+		interPath := elementPath(nsPath, "interface", inter.Name)
+		p.coverage.Symbols += len(inter.Methods) + len(inter.Properties)
+		if !p.convert(src, interPath, func() {
+			interT := types.ConvertInterface(ns.Name, "", inter, nil, p.Types)
+			if cName, ok := typeStructs[inter.GLibTypeStruct]; ok && inter.GLibGetType != "" {
+				interT.TypeStruct, interT.TypeStructCName = inter.GLibTypeStruct, cName
+				for _, cb := range vtables[inter.GLibTypeStruct] {
+					if impl, ok := types.NewImplement(interT.Name, cb); ok {
+						interT.Implement = append(interT.Implement, impl)
+					}
+				}
+			}
+			fn := inter.FilenameSafe()
+			files = append(files, fn)
+			interfaces[fn] = append(interfaces[fn], interT)
+		}) {
+			continue
+		}
+		for _, m := range inter.Methods {
+			p.generated(elementPath(interPath, "method", m.Name))
+			p.checkArgs(src, ns.Name, elementPath(interPath, "method", m.Name), m.CIdentifier, m.Parameters, m.ReturnValue, m.Throws)
+		}
+		for _, prop := range inter.Properties {
+			p.checkProperty(ns.Name, elementPath(interPath, "property", prop.Name), prop)
+		}
 	}
 
 	for _, union := range ns.Unions {
+		p.skipUnion(nsPath, union)
 		fn := union.FilenameSafe()
 		files = append(files, fn)
 		name := util.SnakeToCamel(union.Name)
 		interT := types.AliasTemplate{
-			Doc:  union.Doc.StringSafe(),
+			Doc:  types.DocString(union.InfoAttrs, union.InfoElements),
 			Name: name,
 			// structs are not yet supported in CGO
 			Value: "uintptr",
@@ -279,105 +333,176 @@ func (p *Pass) writeGo(r types.Repository, gotemp *template.Template, dir string
 	}
 
 	for _, alias := range ns.Aliases {
-		fn := alias.FilenameSafe()
-		files = append(files, fn)
-		typeName := alias.Template(ns.Name, p.Types)
-		if typeName == "" {
-			typeName = "uintptr"
-		}
-		name := util.SnakeToCamel(alias.Name)
-		aliasT := types.AliasTemplate{
-			Doc:  alias.Doc.StringSafe(),
-			Name: name,
-			// structs are not yet supported in CGO
-			Value: typeName,
-		}
-		aliases[fn] = append(aliases[fn], aliasT)
+		p.convert(src, elementPath(nsPath, "alias", alias.Name), func() {
+			typeName := alias.Template(ns.Name, p.Types)
+			if typeName == "" {
+				typeName = "uintptr"
+			}
+			name := util.SnakeToCamel(alias.Name)
+			aliasT := types.AliasTemplate{
+				Doc:  types.DocString(alias.InfoAttrs, alias.InfoElements),
+				Name: name,
+				// structs are not yet supported in CGO
+				Value: typeName,
+			}
+			fn := alias.FilenameSafe()
+			files = append(files, fn)
+			aliases[fn] = append(aliases[fn], aliasT)
+		})
 	}
 
 	functions := make(map[string][]types.FuncTemplate)
+	nsFunctionName := func(name string) string {
+		return p.functionName(ns.Name, name)
+	}
+	shadows := types.Shadows(ns.Functions)
 	for _, f := range ns.Functions {
-		name := util.SnakeToCamel(f.Name)
-		if p.Types.Kind(ns.Name, name) != types.UnknownType {
-			name = "New" + name
-		}
-		fn := f.FilenameSafe()
-		files = append(files, fn)
-		functions[fn] = append(functions[fn], types.FuncTemplate{
-			Name:  name,
-			CName: f.CIdentifier,
-			Doc:   f.Doc.StringSafe(),
-			Args:  f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
-			Ret:   f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+		fPath := elementPath(nsPath, "function", f.Name)
+		name := nsFunctionName(f.Name)
+		var alias string
+		// a function that moved to a type is generated under the name of the function of the type,
+		// functions of classes are generated with the class so only the old name is kept
+		moved, class, isMoved := p.movedFunction(ns, f)
+		if isMoved && moved != name {
+			alias = name
+			name = moved
+		}
+		if isMoved && class && alias == "" {
+			continue
+		}
+		p.callable(src, ns.Name, fPath, f.CIdentifier, f.Parameters, f.ReturnValue, f.Throws, func() {
+			funcT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+				Name:       name,
+				CName:      f.CIdentifier,
+				Doc:        shadowDoc(types.DocString(f.InfoAttrs, f.InfoElements), f.CallableAttrs, shadows, nsFunctionName),
+				Deprecated: f.Deprecated,
+				Since:      f.Version,
+				Args:       f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
+				Ret:        f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+			}, f.Parameters, false), f.Parameters, f.ReturnValue), f.ReturnValue), ns.Name, p.Types, f.Parameters, f.ReturnValue, f.Throws)
+			fn := f.FilenameSafe()
+			files = append(files, fn)
+			if !isMoved || !class {
+				functions[fn] = append(functions[fn], funcT)
+			}
+			if alias != "" {
+				functions[fn] = append(functions[fn], types.AliasFunc(funcT, alias, name, ""))
+			}
 		})
 	}
 
+	versionFile, version := versionCheck(functions)
+
 	classes := make(map[string][]types.ClassTemplate)
 	for _, cls := range ns.Classes {
+		clsPath := elementPath(nsPath, "class", cls.Name)
+		p.generated(clsPath)
 		implemented := make(map[string]bool)
-		constructors := make([]types.FuncTemplate, len(cls.Constructors))
-		functions := make([]types.FuncTemplate, len(cls.Functions))
+		constructors := make([]types.FuncTemplate, 0, len(cls.Constructors))
+		functions := make([]types.FuncTemplate, 0, len(cls.Functions))
 		fn := cls.FilenameSafe()
 		files = append(files, fn)
 
-		for i, c := range cls.Constructors {
-			c.ReturnValue.AnyType.Type.Name = cls.Name
-			constructors[i] = types.FuncTemplate{
-				Name:  util.ConstructorName(c.Name, cls.Name),
-				CName: c.CIdentifier,
-				Doc:   c.Doc.StringSafe(),
-				Args:  c.Parameters.Template(ns.Name, "", p.Types, c.Throws, types.ArgsFromGoToC),
-				Ret:   c.ReturnValue.Template(ns.Name, "", p.Types, c.Throws),
-			}
+		clsConstructorName := func(name string) string {
+			return util.ConstructorName(name, cls.Name)
+		}
+		shadows := types.Shadows(cls.Constructors)
+		for _, c := range cls.Constructors {
+			cPath := elementPath(clsPath, "constructor", c.Name)
+			name := clsConstructorName(c.Name)
+			p.callable(src, ns.Name, cPath, c.CIdentifier, c.Parameters, c.ReturnValue, c.Throws, func() {
+				c.ReturnValue.AnyType.Type.Name = cls.Name
+				cT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+					Name:       name,
+					CName:      c.CIdentifier,
+					Doc:        shadowDoc(types.DocString(c.InfoAttrs, c.InfoElements), c.CallableAttrs, shadows, clsConstructorName),
+					Deprecated: c.Deprecated,
+					Since:      types.Since(c.Version, cls.Version),
+					Args:       c.Parameters.Template(ns.Name, "", p.Types, c.Throws, types.ArgsFromGoToC),
+					Ret:        c.ReturnValue.Template(ns.Name, "", p.Types, c.Throws),
+				}, c.Parameters, false), c.Parameters, c.ReturnValue), c.ReturnValue), ns.Name, p.Types, c.Parameters, c.ReturnValue, c.Throws)
+				constructors = append(constructors, cT)
+			})
 		}
-		signals := make([]types.SignalsTemplate, len(cls.Signals))
-		for i, s := range cls.Signals {
-			signals[i] = types.SignalsTemplate{
-				Doc:      s.Doc.StringSafe(),
-				Name:     util.DashToCamel(s.Name),
-				CName:    s.Name,
-				Args:     s.Parameters.Template(ns.Name, "", p.Types, false, types.ArgsFromCToGo),
-				Ret:      s.ReturnValue.Template(ns.Name, "", p.Types, false),
-				Detailed: s.Detailed,
-			}
+		signals := make([]types.SignalsTemplate, 0, len(cls.Signals))
+		for _, s := range cls.Signals {
+			p.callable(src, ns.Name, elementPath(clsPath, "signal", s.Name), "", s.Parameters, s.ReturnValue, false, func() {
+				signals = append(signals, types.SignalsTemplate{
+					Doc:        types.DocString(s.InfoAttrs, s.InfoElements),
+					Name:       util.DashToCamel(s.Name),
+					CName:      s.Name,
+					Args:       s.Parameters.Template(ns.Name, "", p.Types, false, types.ArgsFromCToGo),
+					Ret:        s.ReturnValue.Template(ns.Name, "", p.Types, false),
+					Detailed:   s.Detailed,
+					Deprecated: s.Deprecated,
+				})
+			})
 		}
-		receivers := make([]types.FuncTemplate, len(cls.Methods))
-		for i, f := range cls.Methods {
+		receivers := make([]types.FuncTemplate, 0, len(cls.Methods))
+		shadows = types.Shadows(cls.Methods)
+		for _, f := range cls.Methods {
+			mPath := elementPath(clsPath, "method", f.Name)
 			name := util.SnakeToCamel(f.Name)
-			implemented[name] = true
-			receivers[i] = types.FuncTemplate{
-				Doc:   f.Doc.StringSafe(),
-				Name:  name,
-				CName: f.CIdentifier,
-				Args:  f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
-				Ret:   f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
-			}
+			p.callable(src, ns.Name, mPath, f.CIdentifier, f.Parameters, f.ReturnValue, f.Throws, func() {
+				mT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+					Doc:        shadowDoc(types.DocString(f.InfoAttrs, f.InfoElements), f.CallableAttrs, shadows, util.SnakeToCamel),
+					Deprecated: f.Deprecated,
+					Since:      types.Since(f.Version, cls.Version),
+					Name:       name,
+					CName:      f.CIdentifier,
+					Args:       f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
+					Ret:        f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+				}, f.Parameters, true), f.Parameters, f.ReturnValue), f.ReturnValue), ns.Name, p.Types, f.Parameters, f.ReturnValue, f.Throws)
+				if moved, ok := p.movedMethod(ns, cls.Name, f); ok {
+					mT = types.AliasFunc(mT, name, moved, "x")
+				}
+				receivers = append(receivers, mT)
+				implemented[name] = true
+			})
 		}
 		var interfaces []types.InterfaceTemplate
-		for i, f := range cls.Functions {
-			name := fmt.Sprintf("%s%s", util.SnakeToCamel(cls.Name), util.SnakeToCamel(f.Name))
-			functions[i] = types.FuncTemplate{
-				Name:  name,
-				CName: f.CIdentifier,
-				Doc:   f.Doc.StringSafe(),
-				Args:  f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
-				Ret:   f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
-			}
+		clsFunctionName := func(name string) string {
+			return fmt.Sprintf("%s%s", util.SnakeToCamel(cls.Name), util.SnakeToCamel(name))
+		}
+		shadows = types.Shadows(cls.Functions)
+		for _, f := range cls.Functions {
+			fPath := elementPath(clsPath, "function", f.Name)
+			name := clsFunctionName(f.Name)
+			p.callable(src, ns.Name, fPath, f.CIdentifier, f.Parameters, f.ReturnValue, f.Throws, func() {
+				funcT := types.MapABI(types.MapStrv(types.MapBytes(types.MapVarArgs(types.FuncTemplate{
+					Name:       name,
+					CName:      f.CIdentifier,
+					Doc:        shadowDoc(types.DocString(f.InfoAttrs, f.InfoElements), f.CallableAttrs, shadows, clsFunctionName),
+					Deprecated: f.Deprecated,
+					Since:      types.Since(f.Version, cls.Version),
+					Args:       f.Parameters.Template(ns.Name, "", p.Types, f.Throws, types.ArgsFromGoToC),
+					Ret:        f.ReturnValue.Template(ns.Name, "", p.Types, f.Throws),
+				}, f.Parameters, false), f.Parameters, f.ReturnValue), f.ReturnValue), ns.Name, p.Types, f.Parameters, f.ReturnValue, f.Throws)
+				functions = append(functions, funcT)
+			})
 		}
 		for _, impl := range cls.Implements {
-			interfaces = append(interfaces, types.GetInterfaceFuncs(ns.Name, impl.Name, implemented, p.Types))
+			p.convert(src, elementPath(clsPath, "implements", impl.Name), func() {
+				interfaces = append(interfaces, types.GetInterfaceFuncs(ns.Name, impl.Name, implemented, p.Types))
+			})
 		}
 		properties := make([]types.PropertyTemplate, 0, len(cls.Properties))
 		for _, prop := range cls.Properties {
-			propTemp := prop.Template(ns.Name, p.Types)
-
-			// TODO: Implement non-primitive types, then remove this
-			if propTemp.GValueType != "" {
-				properties = append(properties, propTemp)
+			propPath := elementPath(clsPath, "property", prop.Name)
+			p.coverage.Symbols++
+			if p.convert(src, propPath, func() {
+				propTemp := prop.Template(ns.Name, p.Types)
+
+				// TODO: Implement non-primitive types, then remove this
+				if propTemp.GValueType != "" {
+					properties = append(properties, propTemp)
+				}
+			}) {
+				p.checkProperty(ns.Name, propPath, prop)
 			}
 		}
-		classes[fn] = append(classes[fn], types.ClassTemplate{
-			Doc:          cls.Doc.StringSafe(),
+		clsT := types.ClassTemplate{
+			Doc:          types.DocString(cls.InfoAttrs, cls.InfoElements),
 			Name:         cls.Name,
 			Parent:       util.NormalizeNamespace(ns.Name, cls.Parent, true),
 			Constructors: constructors,
@@ -387,7 +512,11 @@ func (p *Pass) writeGo(r types.Repository, gotemp *template.Template, dir string
 			Properties:   properties,
 			Signals:      signals,
 			TypeGetter:   cls.GLibGetType,
-		})
+		}
+		if cName, ok := typeStructs[cls.GLibTypeStruct]; ok && cls.GLibGetType != "" {
+			clsT.TypeStruct, clsT.TypeStructCName = cls.GLibTypeStruct, cName
+		}
+		classes[fn] = append(classes[fn], clsT)
 	}
 
 	pkgName := strings.ToLower(ns.Name)
@@ -407,28 +536,84 @@ func (p *Pass) writeGo(r types.Repository, gotemp *template.Template, dir string
 	}
 
 	for _, fn := range files {
-		methods := 0
-		for _, i := range interfaces[fn] {
-			methods += len(i.Methods)
-		}
-		for _, i := range records[fn] {
-			methods += len(i.Constructors)
-			methods += len(i.Receivers)
-		}
-		for _, i := range classes[fn] {
-			methods += len(i.Constructors)
-			methods += len(i.Receivers)
-			methods += len(i.Functions)
-		}
-		// we do not need to add the length of interfaces in here
-		// as they should only be loaded when there are classes
-		needsInit := (len(functions[fn]) + methods) > 0
-
-		// Check if any receiver method has callback parameters
-		// This is used to conditionally import unsafe and purego
-		hasReceiverCallbacks := false
-		for _, rec := range records[fn] {
-			for _, r := range rec.Receivers {
+		args := types.TemplateArg{
+			PkgName:         pkgName,
+			PkgEnv:          strings.ToUpper(pkgName) + p.LibrarySuffix,
+			PkgConfigName:   pkgConfigName,
+			SharedLibraries: sharedLibraries,
+			Optional:        p.optional[ns.Name],
+			Aliases:         aliases[fn],
+			Callbacks:       callbacks[fn],
+			Records:         records[fn],
+			Enums:           enums[fn],
+			Constants:       constants[fn],
+			Functions:       functions[fn],
+			Interfaces:      interfaces[fn],
+			Classes:         classes[fn],
+		}
+		if fn == versionFile {
+			args.VersionCheck = version
+		}
+		p.docs.index(ns.Name, args)
+
+		os.MkdirAll(fmt.Sprintf(dir+"/%s", pkgName), 0o755)
+
+		deprecated, ok := splitDeprecated(&args)
+		p.writeFile(gotemp, fmt.Sprintf(dir+"/%s/%s", pkgName, fn), args)
+		if ok {
+			p.writeFile(gotemp, fmt.Sprintf(dir+"/%s/%s_deprecated.go", pkgName, strings.TrimSuffix(fn, ".go")), deprecated)
+		}
+	}
+}
+
+// writeFile writes the Go file of args to path with the template
+func (p *Pass) writeFile(gotemp *template.Template, path string, args types.TemplateArg) {
+	methods := 0
+	for _, i := range args.Interfaces {
+		methods += len(i.Methods)
+	}
+	for _, i := range args.Records {
+		methods += len(i.Constructors)
+		methods += len(i.Receivers)
+	}
+	for _, i := range args.Classes {
+		methods += len(i.Constructors)
+		methods += len(i.Receivers)
+		methods += len(i.Functions)
+	}
+	// the GLib types of enums, flags and records are loaded on their own,
+	// e.g. for a file with only enums such as gioenums.h
+	for _, e := range args.Enums {
+		if e.TypeGetter != "" {
+			methods++
+		}
+	}
+	for _, r := range args.Records {
+		if r.TypeGetter != "" {
+			methods++
+		}
+	}
+	// we do not need to add the length of interfaces in here
+	// as they should only be loaded when there are classes
+	needsInit := (len(args.Functions) + methods) > 0
+
+	// Check if any receiver method has callback parameters
+	// This is used to conditionally import unsafe and purego
+	hasReceiverCallbacks := false
+	for _, rec := range args.Records {
+		for _, r := range rec.Receivers {
+			if len(r.Args.Callbacks) > 0 {
+				hasReceiverCallbacks = true
+				break
+			}
+		}
+		if hasReceiverCallbacks {
+			break
+		}
+	}
+	if !hasReceiverCallbacks {
+		for _, cls := range args.Classes {
+			for _, r := range cls.Receivers {
 				if len(r.Args.Callbacks) > 0 {
 					hasReceiverCallbacks = true
 					break
@@ -438,102 +623,176 @@ func (p *Pass) writeGo(r types.Repository, gotemp *template.Template, dir string
 				break
 			}
 		}
-		if !hasReceiverCallbacks {
-			for _, cls := range classes[fn] {
-				for _, r := range cls.Receivers {
-					if len(r.Args.Callbacks) > 0 {
-						hasReceiverCallbacks = true
-						break
-					}
-				}
-				if hasReceiverCallbacks {
-					break
-				}
-			}
-		}
+	}
 
-		// Check if any standalone function has callback parameters
-		hasFunctionCallbacks := false
-		for _, f := range functions[fn] {
-			if len(f.Args.Callbacks) > 0 {
-				hasFunctionCallbacks = true
-				break
-			}
+	// Check if any standalone function has callback parameters
+	hasFunctionCallbacks := false
+	for _, f := range args.Functions {
+		if len(f.Args.Callbacks) > 0 {
+			hasFunctionCallbacks = true
+			break
 		}
+	}
 
-		needsCoreHelpers := false
-		checkFuncArgs := func(funcs []types.FuncTemplate) {
-			if needsCoreHelpers {
+	needsCoreHelpers := false
+	checkFuncArgs := func(funcs []types.FuncTemplate) {
+		if needsCoreHelpers {
+			return
+		}
+		for _, f := range funcs {
+			if f.Args.NeedsCore() {
+				needsCoreHelpers = true
 				return
 			}
-			for _, f := range funcs {
-				if f.Args.NeedsCore() {
-					needsCoreHelpers = true
-					return
-				}
-			}
 		}
-		checkInterfaceArgs := func(funcs []types.InterfaceFuncTemplate) {
-			if needsCoreHelpers {
+	}
+	checkInterfaceArgs := func(funcs []types.InterfaceFuncTemplate) {
+		if needsCoreHelpers {
+			return
+		}
+		for _, f := range funcs {
+			if f.Args.NeedsCore() {
+				needsCoreHelpers = true
 				return
 			}
-			for _, f := range funcs {
-				if f.Args.NeedsCore() {
-					needsCoreHelpers = true
-					return
-				}
-			}
 		}
+	}
 
-		for _, rec := range records[fn] {
-			checkFuncArgs(rec.Constructors)
-			checkFuncArgs(rec.Receivers)
-		}
-		for _, cls := range classes[fn] {
-			checkFuncArgs(cls.Constructors)
-			checkFuncArgs(cls.Receivers)
-			checkFuncArgs(cls.Functions)
-		}
-		checkFuncArgs(functions[fn])
-		for _, inter := range interfaces[fn] {
-			checkInterfaceArgs(inter.Methods)
+	for _, rec := range args.Records {
+		checkFuncArgs(rec.Constructors)
+		checkFuncArgs(rec.Receivers)
+		// New<Name>Alloc and Free allocate and release with the core helpers
+		if rec.AllocSize > 0 {
+			needsCoreHelpers = true
 		}
+	}
+	for _, cls := range args.Classes {
+		checkFuncArgs(cls.Constructors)
+		checkFuncArgs(cls.Receivers)
+		checkFuncArgs(cls.Functions)
+	}
+	checkFuncArgs(args.Functions)
+	for _, inter := range args.Interfaces {
+		checkInterfaceArgs(inter.Methods)
+	}
+	args.NeedsInit = needsInit
+	args.NeedsCore = needsCoreHelpers
+	args.HasReceiverCallbacks = hasReceiverCallbacks
+	args.HasFunctionCallbacks = hasFunctionCallbacks
+
+	f, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	p.docs.written[path] = args.PkgName
+	err = gotemp.Execute(f, args)
+	if err != nil {
+		panic(err)
+	}
+	f.Close()
+}
 
-		args := types.TemplateArg{
-			PkgName:              pkgName,
-			PkgEnv:               strings.ToUpper(pkgName),
-			PkgConfigName:        pkgConfigName,
-			SharedLibraries:      sharedLibraries,
-			NeedsInit:            needsInit,
-			NeedsCore:            needsCoreHelpers,
-			HasReceiverCallbacks: hasReceiverCallbacks,
-			HasFunctionCallbacks: hasFunctionCallbacks,
-			Aliases:              aliases[fn],
-			Callbacks:            callbacks[fn],
-			Records:              records[fn],
-			Enums:                enums[fn],
-			Constants:            constants[fn],
-			Functions:            functions[fn],
-			Interfaces:           interfaces[fn],
-			Classes:              classes[fn],
+// Second does the "second pass" meaning it converts the repositories to go files
+// Symbols that cannot be converted are left out and reported in Diagnostics.
+// The references to symbols in the docs of the GIR files are then rewritten to links to the generated Go symbols
+func (p *Pass) Second(dir string, gotemp *template.Template) {
+	for i, r := range p.Parsed {
+		if len(p.Namespaces) > 0 && !slices.Contains(p.Namespaces, r.Namespaces[0].Name) {
+			continue
 		}
+		p.writeGo(r, p.sources[i], gotemp, dir)
+	}
+	p.docs.names(p.Parsed)
+	if err := p.docs.rewrite(); err != nil {
+		panic(err)
+	}
+}
 
-		os.MkdirAll(fmt.Sprintf(dir+"/%s", pkgName), 0o755)
+// recordFields converts the fields of the record to struct fields and the accessors of its callback fields
+func (p *Pass) recordFields(src source, recPath string, ns string, rec types.Record, callbackDocs map[string]string) ([]types.RecordField, []types.CallbackAccessor) {
+	fields := make([]types.RecordField, 0, len(rec.Fields))
+	callbackAccessors := make([]types.CallbackAccessor, 0)
+	for _, f := range rec.Fields {
+		var _type string
+		var fieldName string
 
-		f, err := os.Create(fmt.Sprintf(dir+"/%s/%s", pkgName, fn))
-		if err != nil {
-			panic(err)
-		}
-		err = gotemp.Execute(f, args)
-		if err != nil {
-			panic(err)
-		}
+		// Check if this field is a callback
+		if f.Callback != nil {
+			_type = "uintptr"
+			fieldName = "x" + util.SnakeToCamel(f.Name) // Prefix callback pointer fields with `x` to make them private
 
-	}
-}
+			callbackName := util.SnakeToCamel(f.Name)
+			args := f.Callback.Parameters.Template(ns, "", p.Types, f.Callback.Throws, types.ArgsFromCToGo)
+			ret := f.Callback.ReturnValue.Template(ns, "", p.Types, f.Callback.Throws)
 
-func (p *Pass) Second(dir string, gotemp *template.Template) {
-	for _, r := range p.Parsed {
-		p.writeGo(r, gotemp, dir)
+			apiTypes := args.API.Types
+
+			var doc string
+			if f.Callback.Doc != nil && f.Callback.Doc.String != "" {
+				doc = types.DocString(f.Callback.InfoAttrs, f.Callback.InfoElements)
+			} else {
+				baseClassName := strings.TrimSuffix(rec.Name, "Class")
+				callbackName := baseClassName + util.SnakeToCamel(f.Name) + "Func"
+
+				if callbackDoc, exists := callbackDocs[callbackName]; exists && callbackDoc != "" {
+					doc = callbackDoc
+				} else {
+					doc = f.Doc.StringSafe()
+				}
+			}
+
+			callbackAccessors = append(callbackAccessors, types.CallbackAccessor{
+				Name:         callbackName,
+				CName:        f.Name,
+				Doc:          doc,
+				CallbackType: "func(" + strings.Join(apiTypes, ", ") + ") " + ret.Value,
+				Args:         args,
+				Ret:          ret,
+			})
+		} else {
+			_type = f.Translate(ns, p.Types)
+			if _type == "" {
+				p.diag(src, Warning, elementPath(recPath, "field", f.Name), "type",
+					"the field has no type information and is left out",
+					"the Go struct does not match the C layout, only use it through pointers")
+				p.skip(Skipped{Path: elementPath(recPath, "field", f.Name), Reason: ReasonFieldType,
+					Detail: "the field has no type information and is left out of the struct"})
+				continue
+			}
+			// HACK: Handle the specific case where a gint is converted to an int
+			// But for structs this needs to be an int32 as purego just gets the pointer to the struct
+			// Instead of converting each field separately
+			if f.AnyType.Type != nil && f.AnyType.Type.CType == "gint" {
+				_type = "int32"
+			}
+
+			// HACK: in structs the strings should be uintptr as we convert it ourselves
+			if _type == "string" {
+				_type = "uintptr"
+			}
+
+			// HACK: Special handling for parent_class field - it should be embedded as a full struct
+			// to match C's memory layout, not converted to uintptr
+			// The same holds for the GTypeInterface that every interface vtable starts with,
+			// named g_iface, parent_iface or base_iface depending on the library
+			// See https://docs.gtk.org/gobject/tutorial.html
+			if (f.Name == "parent_class" || len(fields) == 0) && f.AnyType.Type != nil {
+				// Check if this is a Record type with no pointers (embedded struct)
+				typeName := util.NormalizeNamespace(ns, f.AnyType.Type.Name, true)
+				kind := p.Types.Kind(ns, typeName)
+				if kind == types.RecordsType && !strings.Contains(f.AnyType.Type.CType, "*") {
+					// Use the full struct type for embedding
+					_type = typeName
+				}
+			}
+
+			fieldName = util.SnakeToCamel(f.Name)
+		}
+
+		fields = append(fields, types.RecordField{
+			Name: fieldName,
+			Type: _type,
+		})
 	}
+	return fields, callbackAccessors
 }
@@ -0,0 +1,172 @@
+package pass
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Report summarizes the conversion of a namespace, it is computed from the coverage, see WriteReports
+type Report struct {
+	Namespace string `json:"namespace"`
+	Version   string `json:"version"`
+	// Generated is the number of generated symbols by GIR element, e.g. "method" or "class"
+	Generated map[string]int `json:"generated"`
+	// Skipped is the number of symbols that are left out of the bindings by reason, see the Reason constants
+	Skipped map[string]int `json:"skipped"`
+	// Downgraded are the symbols that are generated in a form that cannot be used as is,
+	// e.g. with a uintptr for an array or a struct that cannot be marshaled
+	Downgraded []Skipped `json:"downgraded"`
+	// Stubs are the functions that are generated as stubs because purego cannot call them
+	Stubs []Skipped `json:"stubs"`
+	// MissingCallbacks are the callback types of the namespace that could not be generated,
+	// the functions that take them are left out
+	MissingCallbacks []string `json:"missing_callbacks"`
+}
+
+// generated counts the symbol at the element path as generated in the coverage of the namespace that is being converted
+func (p *Pass) generated(path string) {
+	if p.coverage == nil {
+		return
+	}
+	element := path[strings.LastIndex(path, "/")+1:]
+	if i := strings.IndexByte(element, '['); i >= 0 {
+		element = element[:i]
+	}
+	if element == "implements" {
+		return
+	}
+	if p.coverage.generated == nil {
+		p.coverage.generated = make(map[string]int)
+	}
+	p.coverage.generated[element]++
+}
+
+// Report returns the report of the coverage
+func (c *Coverage) Report() Report {
+	r := Report{
+		Namespace:        c.Namespace,
+		Version:          c.Version,
+		Generated:        make(map[string]int),
+		Skipped:          make(map[string]int),
+		Downgraded:       []Skipped{},
+		Stubs:            []Skipped{},
+		MissingCallbacks: []string{},
+	}
+	for element, n := range c.generated {
+		r.Generated[element] = n
+	}
+	for _, s := range c.Skipped {
+		switch {
+		case s.Reason == ReasonManual:
+			// written by hand, not by the generator
+		case s.Reason == ReasonABI:
+			r.Stubs = append(r.Stubs, s)
+		case s.Generated:
+			r.Downgraded = append(r.Downgraded, s)
+		default:
+			r.Skipped[s.Reason]++
+			if s.Reason == ReasonError && strings.HasPrefix(s.Path[strings.LastIndex(s.Path, "/")+1:], "callback[") {
+				r.MissingCallbacks = append(r.MissingCallbacks, s.Path)
+			}
+		}
+	}
+	sort.Strings(r.MissingCallbacks)
+	return r
+}
+
+// CompareReports returns the regressions of cur compared with the report old of a previous run,
+// e.g. with older GIR files: fewer generated symbols of an element, more skipped symbols of a reason,
+// new stubs and new missing callbacks
+func CompareReports(old, cur Report) []string {
+	var regressions []string
+	for _, element := range sortedKeys(old.Generated) {
+		if n, was := cur.Generated[element], old.Generated[element]; n < was {
+			regressions = append(regressions, fmt.Sprintf("%d fewer %s generated (%d, was %d)", was-n, element, n, was))
+		}
+	}
+	for _, reason := range sortedKeys(cur.Skipped) {
+		if n, was := cur.Skipped[reason], old.Skipped[reason]; n > was {
+			regressions = append(regressions, fmt.Sprintf("%d more skipped because of %s (%d, was %d)", n-was, reason, n, was))
+		}
+	}
+	stubs := make(map[string]bool, len(old.Stubs))
+	for _, s := range old.Stubs {
+		stubs[s.Path] = true
+	}
+	for _, s := range cur.Stubs {
+		if !stubs[s.Path] {
+			regressions = append(regressions, fmt.Sprintf("%s is a stub: %s", s.Path, s.Detail))
+		}
+	}
+	callbacks := make(map[string]bool, len(old.MissingCallbacks))
+	for _, c := range old.MissingCallbacks {
+		callbacks[c] = true
+	}
+	for _, c := range cur.MissingCallbacks {
+		if !callbacks[c] {
+			regressions = append(regressions, fmt.Sprintf("%s is missing", c))
+		}
+	}
+	return regressions
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// WriteReports writes the report of every namespace as JSON to dir, in a file named after the namespace and version,
+// e.g. Gtk-4.0.report.json. If dir has the report of a previous run, the regressions are written to w.
+func (p *Pass) WriteReports(dir string, w io.Writer) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, c := range p.Coverage {
+		r := c.Report()
+		name := filepath.Join(dir, fmt.Sprintf("%s-%s.report.json", c.Namespace, c.Version))
+		old, err := readReport(name)
+		if err != nil {
+			return err
+		}
+		if old != nil {
+			for _, regression := range CompareReports(*old, r) {
+				fmt.Fprintf(w, "%s-%s: %s\n", c.Namespace, c.Version, regression)
+			}
+		}
+		data, err := json.MarshalIndent(r, "", "\t")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readReport reads the report of a previous run, it returns nil if there is none
+func readReport(name string) (*Report, error) {
+	data, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &r, nil
+}
@@ -0,0 +1,37 @@
+package pass
+
+import "github.com/jwijenbergh/puregotk/internal/gir/types"
+
+// versionCheck returns the CheckVersionAtLeast function of a namespace and the file of the functions it calls,
+// from its functions that return the parts of the version of the loaded library, e.g. gtk_get_major_version,
+// or else from its function that checks the version, e.g. glib_check_version. The file is empty if there are neither.
+func versionCheck(functions map[string][]types.FuncTemplate) (string, *types.VersionCheckTemplate) {
+	for fn, fs := range functions {
+		parts := make(map[string]bool)
+		for _, f := range fs {
+			if f.AliasCall == "" && f.Unsupported == "" && len(f.Args.API.Names) == 0 && f.Ret.Value == "uint" {
+				parts[f.Name] = true
+			}
+		}
+		if parts["GetMajorVersion"] && parts["GetMinorVersion"] && parts["GetMicroVersion"] {
+			return fn, &types.VersionCheckTemplate{
+				GetMajor: "GetMajorVersion",
+				GetMinor: "GetMinorVersion",
+				GetMicro: "GetMicroVersion",
+			}
+		}
+	}
+	for fn, fs := range functions {
+		for _, f := range fs {
+			if f.Name != "CheckVersion" && f.Name != "VersionCheck" {
+				continue
+			}
+			t := f.Args.API.Types
+			if f.AliasCall != "" || f.Unsupported != "" || len(t) != 3 || t[0] != t[1] || t[1] != t[2] || f.Ret.Value != "string" {
+				continue
+			}
+			return fn, &types.VersionCheckTemplate{Check: f.Name, CheckType: t[0]}
+		}
+	}
+	return "", nil
+}
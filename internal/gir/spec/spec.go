@@ -0,0 +1,9 @@
+// package spec embeds the GIR files that the bindings in v4 are generated from, see copygir.sh
+package spec
+
+import "embed"
+
+// FS holds the GIR files by file name, e.g. Gtk-4.0.gir
+//
+//go:embed *.gir
+var FS embed.FS
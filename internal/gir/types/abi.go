@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The limits of the arguments that purego passes, on the platforms with the fewest registers
+const (
+	// maxFloatArgs is the number of float registers, purego places further floats at the wrong stack offsets
+	maxFloatArgs = 8
+	// maxIntArgs is the number of integer and pointer arguments that fit in the registers and the stack slots of purego
+	maxIntArgs = 15
+)
+
+// pointerCTypes are the C types of records that are pointers, not the struct
+var pointerCTypes = map[string]bool{
+	"gpointer":      true,
+	"gconstpointer": true,
+}
+
+// StructByValue returns the C type of the record that t passes by value, or "" if it does not pass one.
+// purego only passes structs by value on darwin, the generated functions take a uintptr for them.
+func StructByValue(ns string, kinds KindMap, t AnyType) string {
+	if t.Type == nil || t.Type.CType == "" || strings.Contains(t.Type.CType, "*") || pointerCTypes[t.Type.CType] {
+		return ""
+	}
+	p := kinds.pair(ns, t.Type.Name)
+	if p.K != RecordsType {
+		return ""
+	}
+	// records that are typedefs of pointers, e.g. GIConv, are passed as pointers
+	if rec, ok := p.Value.(Record); ok && rec.Pointer {
+		return ""
+	}
+	return t.Type.CType
+}
+
+// UnsupportedABI returns why purego cannot call a C function with the parameters and the return value,
+// or "" if it can. The calls would pass the arguments at the wrong places, so MapABI replaces them with a stub.
+func UnsupportedABI(ns string, kinds KindMap, params *Parameters, ret *ReturnValue, throws bool) string {
+	ints, floats := 0, 0
+	if throws {
+		// the GError** argument
+		ints++
+	}
+	if params != nil {
+		if params.InstanceParameter != nil {
+			ints++
+		}
+		for _, p := range params.Parameters {
+			if p.Name == "..." {
+				continue
+			}
+			if p.Type != nil && p.Type.Name == "long double" && !strings.Contains(p.Type.CType, "*") {
+				return fmt.Sprintf("%s is a long double, which purego cannot pass", p.Name)
+			}
+			if c := StructByValue(ns, kinds, p.AnyType); c != "" {
+				return fmt.Sprintf("%s passes the struct %s by value, which purego cannot pass on this platform", p.Name, c)
+			}
+			if p.Type != nil && p.Direction != "out" && p.Direction != "inout" && !strings.Contains(p.Type.CType, "*") {
+				switch p.Type.Name {
+				case "gfloat", "gdouble", "float", "double":
+					floats++
+					continue
+				}
+			}
+			ints++
+		}
+	}
+	if ret != nil {
+		if ret.Type != nil && ret.Type.Name == "long double" && !strings.Contains(ret.Type.CType, "*") {
+			return "the return value is a long double, which purego cannot return"
+		}
+		if c := StructByValue(ns, kinds, ret.AnyType); c != "" {
+			return fmt.Sprintf("the struct %s is returned by value, which purego cannot return on this platform", c)
+		}
+	}
+	if floats > maxFloatArgs {
+		return fmt.Sprintf("%d float arguments do not fit in the %d float registers that purego uses", floats, maxFloatArgs)
+	}
+	if ints > maxIntArgs {
+		return fmt.Sprintf("%d arguments are more than the %d that purego passes", ints, maxIntArgs)
+	}
+	return ""
+}
+
+// MapABI replaces the body of a function that purego cannot call with a stub that returns core.ErrUnsupportedABI,
+// see UnsupportedABI. The Go signature is kept, a function that does not throw returns zero values.
+func MapABI(f FuncTemplate, ns string, kinds KindMap, params *Parameters, ret *ReturnValue, throws bool) FuncTemplate {
+	if f.VarArgsCall != "" {
+		return f
+	}
+	f.Unsupported = UnsupportedABI(ns, kinds, params, ret, throws)
+	return f
+}
+
+// UnsupportedBody returns the body of the stub of a function that purego cannot call, see MapABI
+func (f FuncTemplate) UnsupportedBody() string {
+	call := fmt.Sprintf("core.UnsupportedABI(%q, %q)", f.CName, f.Unsupported)
+	var sb strings.Builder
+	if f.Ret.Value != "" {
+		fmt.Fprintf(&sb, "var cret %s\n", f.Ret.Value)
+	}
+	switch {
+	case f.Ret.Throws && f.Ret.Value != "":
+		sb.WriteString("return cret, " + call)
+	case f.Ret.Throws:
+		sb.WriteString("return " + call)
+	case f.Ret.Value != "":
+		sb.WriteString(call + "\nreturn cret")
+	default:
+		sb.WriteString(call)
+	}
+	return sb.String()
+}
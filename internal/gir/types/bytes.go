@@ -0,0 +1,68 @@
+package types
+
+import "strings"
+
+// bytesSlices are the C functions whose GBytes parameters and return value are Go byte slices,
+// these are the functions of the content APIs that pass data which Go code has or wants as a []byte
+// The GBytes are converted with glib.NewBytesFromSlice and Bytes.Data, see templates/glib_bytes
+var bytesSlices = map[string]bool{
+	"g_bytes_icon_new":                      true,
+	"g_bytes_icon_get_bytes":                true,
+	"g_file_load_bytes":                     true,
+	"g_file_load_bytes_finish":              true,
+	"g_input_stream_read_bytes":             true,
+	"g_input_stream_read_bytes_finish":      true,
+	"g_output_stream_write_bytes":           true,
+	"g_memory_input_stream_new_from_bytes":  true,
+	"g_memory_output_stream_steal_as_bytes": true,
+	"g_resources_lookup_data":               true,
+	"gdk_content_provider_new_for_bytes":    true,
+	"gdk_texture_new_from_bytes":            true,
+	"gdk_texture_save_to_png_bytes":         true,
+	"gdk_texture_save_to_tiff_bytes":        true,
+	"gtk_css_provider_load_from_bytes":      true,
+}
+
+// isBytes returns whether the GIR type is a GBytes
+func isBytes(t *Type) bool {
+	return t != nil && strings.TrimPrefix(t.Name, "GLib.") == "Bytes" && strings.Count(t.CType, "*") == 1
+}
+
+// MapBytes changes the GBytes parameters and return value of the functions in bytesSlices to []byte
+// A parameter is copied to a new GBytes that is released after the call unless C takes it,
+// a returned GBytes is copied to a Go slice and released if the caller owns it
+func MapBytes(f FuncTemplate, params *Parameters, ret *ReturnValue) FuncTemplate {
+	if !bytesSlices[f.CName] {
+		return f
+	}
+	if params != nil {
+		for _, p := range params.Parameters {
+			if !isBytes(p.Type) || p.Direction == "out" || p.Direction == "inout" {
+				continue
+			}
+			n := p.VarName()
+			for i, name := range f.Args.API.Names {
+				if name != n {
+					continue
+				}
+				owned := p.TransferOwnership.TransferOwnership == "full"
+				c := n + "Bytes"
+				if owned {
+					// the boxed copy of a transfer full record is replaced, C takes the new GBytes instead
+					c += ".GoPointer()"
+				}
+				f.Args.API.Types[i] = "[]byte"
+				f.Args.API.Full[i] = n + " []byte"
+				f.Args.API.Call[i] = c
+				f.Args.API.CallWithRefs[i] = c
+				f.Args.ByteSlices = append(f.Args.ByteSlices, ByteSliceParam{Name: n, Owned: owned})
+			}
+		}
+	}
+	if ret != nil && isBytes(ret.Type) {
+		f.Ret.Value = "[]byte"
+		f.Ret.Bytes = true
+		f.Ret.BytesOwned = ret.TransferOwnership.TransferOwnership == "full"
+	}
+	return f
+}
@@ -9,6 +9,12 @@ import (
 func ConvertInterface(currns string, ins string, inter Interface, implemented map[string]bool, kinds KindMap) InterfaceTemplate {
 	var methods []InterfaceFuncTemplate
 
+	// the types of the methods are looked up in the namespace of the interface
+	abiNS := currns
+	if ins != "" {
+		abiNS = ins
+	}
+	shadows := Shadows(inter.Methods)
 	for _, m := range inter.Methods {
 		name := util.SnakeToCamel(m.Name)
 		if implemented != nil {
@@ -21,17 +27,23 @@ func ConvertInterface(currns string, ins string, inter Interface, implemented ma
 		if ins != "" {
 			newns = ins + "."
 		}
-		methods = append(methods, InterfaceFuncTemplate{
+		doc := DocString(m.InfoAttrs, m.InfoElements)
+		if shadowing, ok := shadows[m.Name]; ok {
+			doc = ShadowedDoc(doc, util.SnakeToCamel(shadowing), m.Deprecated)
+		}
+		method := InterfaceFuncTemplate{
 			Namespace: newns,
 			FullName:  util.SnakeToCamel(m.CIdentifier),
-			FuncTemplate: FuncTemplate{
-				Doc:   m.Doc.StringSafe(),
+			FuncTemplate: MapABI(MapStrv(MapBytes(FuncTemplate{
+				Doc:   doc,
 				CName: m.CIdentifier,
 				Name:  name,
+				Since: Since(m.Version, inter.Version),
 				Args:  m.Parameters.Template(currns, ins, kinds, m.Throws, ArgsFromGoToC),
 				Ret:   m.ReturnValue.Template(currns, ins, kinds, m.Throws),
-			},
-		})
+			}, m.Parameters, m.ReturnValue), m.ReturnValue), abiNS, kinds, m.Parameters, m.ReturnValue, m.Throws),
+		}
+		methods = append(methods, method)
 	}
 
 	properties := make([]PropertyTemplate, 0, len(inter.Properties))
@@ -47,7 +59,7 @@ func ConvertInterface(currns string, ins string, inter Interface, implemented ma
 	name := util.SnakeToCamel(inter.Name)
 	return InterfaceTemplate{
 		Name:       name,
-		Doc:        inter.Doc.StringSafe(),
+		Doc:        DocString(inter.InfoAttrs, inter.InfoElements),
 		Methods:    methods,
 		Properties: properties,
 		TypeGetter: inter.GLibGetType,
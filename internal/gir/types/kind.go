@@ -64,6 +64,17 @@ func (km KindMap) GetCallback(ns string, name string) (Callback, bool) {
 	return cb, ok
 }
 
+// GetRecord retrieves a record definition by namespace and name.
+// Returns the Record and true if found, otherwise an empty Record and false.
+func (km KindMap) GetRecord(ns string, name string) (Record, bool) {
+	p := km.pair(ns, name)
+	if p.K != RecordsType {
+		return Record{}, false
+	}
+	rec, ok := p.Value.(Record)
+	return rec, ok
+}
+
 type KindPair struct {
 	K     Kind
 	Value interface{}
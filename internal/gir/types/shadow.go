@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Callable is a GIR element that can be called, a function, method or constructor
+type Callable interface {
+	Attrs() CallableAttrs
+}
+
+// Attrs returns the attributes shared by all callables
+func (c CallableAttrs) Attrs() CallableAttrs {
+	return c
+}
+
+// Shadows returns the GIR names of the callables of one scope, e.g. the methods of a class, that are shadowed by another one
+// mapped to the GIR name of the callable that shadows them.
+// Language bindings are meant to offer the shadowing callable under the name of the shadowed one, e.g. g_idle_add_full as idle_add,
+// but both are generated under their own names, such that the Go API does not change when a GIR file adds a shadow.
+// The shadowed callables are deprecated in favour of the shadowing ones in their documentation instead, see ShadowedDoc.
+func Shadows[T Callable](cs []T) map[string]string {
+	byName := make(map[string]bool, len(cs))
+	for _, c := range cs {
+		byName[c.Attrs().Name] = true
+	}
+	names := make(map[string]string)
+	for _, c := range cs {
+		attrs := c.Attrs()
+		if attrs.Shadows == "" || !byName[attrs.Shadows] {
+			continue
+		}
+		names[attrs.Shadows] = attrs.Name
+	}
+	return names
+}
+
+// ShadowedDoc deprecates a shadowed callable in its documentation doc in favour of shadowing,
+// the Go name of the callable that shadows it, such that linters like staticcheck report its uses.
+// A callable that the GIR file deprecates already only gets a reference to shadowing
+func ShadowedDoc(doc string, shadowing string, deprecated bool) string {
+	if doc != "" {
+		doc += "\n//\n"
+	}
+	if deprecated {
+		return doc + fmt.Sprintf("// See [%s] for the version of this function that language bindings are meant to use.", shadowing)
+	}
+	return doc + fmt.Sprintf("// Deprecated: use [%s], the version of this function that language bindings are meant to use.", shadowing)
+}
+
+// Moved returns the type and the name of the callable that replaces c, e.g. "Easing" and "ease" for adw_easing_ease
+// typeName is empty if the callable moved to a function of the namespace
+func (c CallableAttrs) Moved() (typeName string, name string, ok bool) {
+	if c.MovedTo == "" {
+		return "", "", false
+	}
+	typeName, name, found := strings.Cut(c.MovedTo, ".")
+	if !found {
+		return "", c.MovedTo, true
+	}
+	return typeName, name, true
+}
+
+// AliasFunc returns f as a function named name that is kept for compatibility and calls call, the canonical function or method,
+// with the arguments of f. first is passed before the arguments, e.g. the instance of a method that moved to a function
+func AliasFunc(f FuncTemplate, name string, call string, first string) FuncTemplate {
+	var args []string
+	if first != "" {
+		args = append(args, first)
+	}
+	for _, n := range f.Args.API.Names {
+		if n == "varArgs" {
+			n += "..."
+		}
+		args = append(args, n)
+	}
+	if f.Doc != "" {
+		f.Doc += "\n//\n"
+	}
+	if f.Deprecated {
+		// the doc already ends with the deprecation of the GIR file
+		f.Doc += fmt.Sprintf("// This name of %s is kept for compatibility, use %s.", f.CName, strings.TrimPrefix(call, "x."))
+	} else {
+		f.Doc += fmt.Sprintf("// Deprecated: use %s, this name of %s is kept for compatibility.", strings.TrimPrefix(call, "x."), f.CName)
+	}
+	f.Name = name
+	f.AliasCall = fmt.Sprintf("%s(%s)", call, strings.Join(args, ", "))
+	f.VarArgsCall = ""
+	return f
+}
@@ -0,0 +1,32 @@
+package types
+
+// MapStrv makes the functions that return a NULL terminated string array owned by the caller copy and free it,
+// purego converts a returned char** to []string itself but cannot know whether to free it, which leaks it.
+// The array is returned as a uintptr and copied with core.GoStringSliceFull or core.GoStringSliceContainer
+// depending on the transfer of the return value.
+func MapStrv(f FuncTemplate, ret *ReturnValue) FuncTemplate {
+	if ret == nil || f.VarArgsCall != "" || f.Ret.Value != "[]string" || !ret.ownedStrv() {
+		return f
+	}
+	f.Ret.Raw = "uintptr"
+	f.Ret.Strv = ret.TransferOwnership.TransferOwnership
+	return f
+}
+
+// ownedStrv returns whether the return value is a NULL terminated string array that the caller frees,
+// only the array with transfer container or also the strings with transfer full
+func (r *ReturnValue) ownedStrv() bool {
+	if r.Array == nil {
+		return false
+	}
+	switch r.TransferOwnership.TransferOwnership {
+	case "full", "container":
+	default:
+		return false
+	}
+	if r.Array.ZeroTerminated != nil {
+		return *r.Array.ZeroTerminated
+	}
+	// arrays are zero terminated unless they have a length or a fixed size
+	return r.Array.Length == nil && r.Array.FixedSize == 0
+}
@@ -47,6 +47,14 @@ type NullableStringParam struct {
 	Name string
 }
 
+// ByteSliceParam holds metadata for []byte parameters that are passed to C as a GBytes, see MapBytes.
+type ByteSliceParam struct {
+	// Name is the parameter name (e.g., "BytesVar")
+	Name string
+	// Owned indicates that C takes the reference of the GBytes, which is released after the call otherwise
+	Owned bool
+}
+
 type funcArgsTemplate struct {
 	// Pure are the arguments as passed directly to PureGo
 	// The pure Call is a special case that contains the arguments for a callback call
@@ -61,6 +69,9 @@ type funcArgsTemplate struct {
 	// NullableStrings tracks nullable string parameters that need temporary C strings
 	NullableStrings []NullableStringParam
 
+	// ByteSlices tracks []byte parameters that are copied to a GBytes for the call
+	ByteSlices []ByteSliceParam
+
 	// UsesNullableHelper indicates nullable string handling that needs core import.
 	UsesNullableHelper bool
 
@@ -339,6 +350,26 @@ func (f *funcArgsTemplate) Add(p Parameter, ins string, ns string, kinds KindMap
 	f.AddAPI(goType, varName, kind, ns, p.Nullable, isOut, ctx, transferFull)
 	f.AddPure(goType, varName, kind, isOut, p.Nullable, ctx, transferFull)
 
+	// C takes ownership of boxed records passed with transfer full, so a copy is passed to keep the Go value valid
+	if ctx == ArgsFromGoToC && transferFull && p.Direction != "inout" && !isOut && kind == RecordsType && stars == 1 {
+		if rec, ok := kinds.GetRecord(lns, originalType); ok && rec.Boxed(ns) {
+			gobjectNs := "gobject."
+			if strings.ToLower(ns) == "gobject" {
+				gobjectNs = ""
+			}
+			copyFn := "BoxedCopy"
+			if p.Nullable {
+				copyFn = "BoxedCopyNullable"
+			}
+			c := fmt.Sprintf("%s%s(%sGLibType(), %s.GoPointer())", gobjectNs, copyFn, strings.TrimPrefix(goType, "*"), varName)
+			last := len(f.API.Call) - 1
+			f.API.Call[last] = c
+			f.API.CallWithRefs[last] = c
+			f.Pure.Types[len(f.Pure.Types)-1] = "uintptr"
+			f.Pure.Full[len(f.Pure.Full)-1] = f.Pure.Names[len(f.Pure.Names)-1] + " uintptr"
+		}
+	}
+
 	// For callback parameters (not out parameters), populate callback metadata
 	// This enables the template to generate proper closure wrapping
 	if kind == CallbackType && !isOut {
@@ -468,6 +499,20 @@ type RecordTemplate struct {
 
 	// TypeGetter is the function to get the GLib type
 	TypeGetter string
+
+	// BoxedCopy and BoxedFree are set to generate Copy and Free with g_boxed_copy and g_boxed_free
+	// for boxed records that do not have these methods themselves
+	BoxedCopy bool
+	BoxedFree bool
+
+	// GObject is the qualifier of the gobject package, empty in the GObject namespace
+	GObject string
+
+	// AllocSize is the size in bytes to allocate in New<Name>Alloc, at least the size of the C struct,
+	// it is 0 for records that are never caller allocated or whose size is not known
+	AllocSize int
+	// AllocFree is set to generate Free with g_free for records that are not boxed and have no free function
+	AllocFree bool
 }
 
 type enumValues struct {
@@ -477,6 +522,18 @@ type enumValues struct {
 	Name string
 	// Value is the actual underlying value
 	Value int
+	// CIdentifier is the C name of the value
+	CIdentifier string
+	// Nick is the short name of the value, e.g. "center"
+	Nick string
+}
+
+// enumParse are the strings that parse to an enumeration value
+type enumParse struct {
+	// Name is the name of the enumeration value
+	Name string
+	// Strings are the C name and the nick of the value, without those of a previous value
+	Strings []string
 }
 
 type EnumTemplate struct {
@@ -488,6 +545,19 @@ type EnumTemplate struct {
 	Values []enumValues
 	// TypeGetter is the function to get the GLib type
 	TypeGetter string
+	// ErrorDomain is the GLib error domain if the enumeration are the codes of an error domain, e.g. g-io-error-quark
+	ErrorDomain string
+	// ErrorCodes are the values of an error domain without the values that alias a previous value
+	ErrorCodes []enumValues
+	// Unique are the values without the values that alias a previous value, for enumerations
+	Unique []enumValues
+	// Parse are the strings of the values that FromString accepts, for enumerations
+	Parse []enumParse
+	// Flags is true for bitfields, which are uint32 such that values with the high bit set fit on 32-bit platforms
+	// and get the methods to test, set and clear the bits
+	Flags bool
+	// ZeroName is the name String returns for the flags without bits set, the C name of the value 0 if there is one
+	ZeroName string
 }
 
 type ConstantTemplate struct {
@@ -512,6 +582,12 @@ type funcRetTemplate struct {
 	RefSink bool
 	// Throws indicates whether or not this function throws
 	Throws bool
+	// Bytes indicates that the GBytes returned by C is returned as a copy of its data, see MapBytes
+	Bytes bool
+	// BytesOwned indicates that the returned GBytes is owned by the caller, which releases it after the copy
+	BytesOwned bool
+	// Strv is the transfer of a returned string array that is copied and freed, "full" or "container", see core.GoStringSliceFull
+	Strv string
 }
 
 func (fr *funcRetTemplate) Instance() string {
@@ -563,6 +639,9 @@ func (fr *funcRetTemplate) Fmt(ngo bool) string {
 		if fr.Throws {
 			after.WriteString(`
     if cret == 0 {
+        if cerr == nil {
+            return nil, core.PlatformError
+        }
         return nil, cerr
     }
 `)
@@ -585,14 +664,27 @@ func (fr *funcRetTemplate) Fmt(ngo bool) string {
 		after.WriteString("cls.Ptr = cret\n")
 		val = "cls"
 	}
+	if fr.Bytes {
+		if fr.BytesOwned {
+			after.WriteString("defer cret.Unref()\n")
+		}
+		val = "cret.Data()"
+	}
+	switch fr.Strv {
+	case "full":
+		val = "core.GoStringSliceFull(cret)"
+	case "container":
+		val = "core.GoStringSliceContainer(cret)"
+	}
 	if fr.Throws {
+		// without a GError the call succeeded, or it was a stub on an unsupported platform
 		after.WriteString("if cerr == nil {\n")
 		after.WriteString("return ")
 		if fr.Value != "" {
 			after.WriteString(val)
 			after.WriteString(",")
 		}
-		after.WriteString("nil\n")
+		after.WriteString("core.PlatformError\n")
 		after.WriteString("}\n")
 		after.WriteString("return ")
 		if fr.Value != "" {
@@ -618,6 +710,17 @@ type FuncTemplate struct {
 	Args funcArgsTemplate
 	// Ret is the return argument
 	Ret funcRetTemplate
+	// VarArgsCall is the call of the Go helper that implements a variadic function, see MapVarArgs
+	VarArgsCall string
+	// AliasCall is the call of the canonical function if this is a name kept for compatibility, see AliasFunc
+	AliasCall string
+	// Deprecated is set for callables that the GIR files mark as deprecated, see TemplateArg.Deprecated
+	Deprecated bool
+	// Since is the version of the library that added the callable, from the GIR version attribute of it or of its type,
+	// calling it panics with a core.MissingSymbolError if the loaded library is older
+	Since string
+	// Unsupported is why purego cannot call the C function, its body is a stub, see MapABI
+	Unsupported string
 }
 
 type InterfaceFuncTemplate struct {
@@ -633,6 +736,8 @@ type SignalsTemplate struct {
 	Args     funcArgsTemplate
 	Ret      funcRetTemplate
 	Detailed bool
+	// Deprecated is set for signals that the GIR files mark as deprecated
+	Deprecated bool
 }
 
 type PropertyTemplate struct {
@@ -677,6 +782,10 @@ type ClassTemplate struct {
 	Signals []SignalsTemplate
 	// TypeGetter is the function to get the GLib type
 	TypeGetter string
+	// TypeStruct is the Go struct of the class structure whose size is checked at init, see gobject.CheckClassSize
+	TypeStruct string
+	// TypeStructCName is the C name of TypeStruct
+	TypeStructCName string
 }
 
 type InterfaceTemplate struct {
@@ -688,6 +797,49 @@ type InterfaceTemplate struct {
 	Properties []PropertyTemplate
 	// TypeGetter is the function to get the GLib type
 	TypeGetter string
+	// TypeStruct is the Go struct of the interface structure whose size is checked at init, see gobject.CheckClassSize
+	TypeStruct string
+	// TypeStructCName is the C name of TypeStruct
+	TypeStructCName string
+	// Implement are the virtual functions of TypeStruct that Go values implement with methods, see Implement{{.Name}}
+	Implement []ImplementTemplate
+}
+
+// ImplementTemplate is a virtual function of an interface that the Go value of an instance implements with a method
+type ImplementTemplate struct {
+	// Name is the name of the method and of the Override function of the interface structure
+	Name string
+	// Params are the parameters of the Override callback, starting with the instance
+	Params string
+	// Types are the types of the method parameters, those of the callback without the instance
+	Types string
+	// Call are the arguments of the method call
+	Call string
+	// Ret is the return type, empty for none
+	Ret string
+}
+
+// NewImplement returns the ImplementTemplate of the callback field of an interface structure,
+// it returns false if its first parameter is not an instance of the interface iface
+func NewImplement(iface string, cb CallbackAccessor) (ImplementTemplate, bool) {
+	t := cb.Args.API.Types
+	if len(t) == 0 || t[0] != iface {
+		return ImplementTemplate{}, false
+	}
+	params := []string{"self " + iface}
+	var call []string
+	for i, typ := range t[1:] {
+		name := fmt.Sprintf("arg%d", i)
+		params = append(params, name+" "+typ)
+		call = append(call, name)
+	}
+	return ImplementTemplate{
+		Name:   cb.Name,
+		Params: strings.Join(params, ", "),
+		Types:  strings.Join(t[1:], ", "),
+		Call:   strings.Join(call, ", "),
+		Ret:    cb.Ret.Value,
+	}, true
 }
 
 type TemplateArg struct {
@@ -699,6 +851,8 @@ type TemplateArg struct {
 	PkgConfigName string
 	// SharedLibraries is the list of shared library names from the GIR file
 	SharedLibraries []string
+	// Optional is true if the library may be missing at runtime, a failure to load it is recorded instead of panicking
+	Optional bool
 	// NeedsInit declares whether or not this file needs an init code to register functions with purego
 	NeedsInit bool
 	// NeedsCore indicates if core helpers are required even without init.
@@ -728,4 +882,21 @@ type TemplateArg struct {
 	Interfaces []InterfaceTemplate
 	// Classes are the Go struct with receiver declarations
 	Classes []ClassTemplate
+	// Deprecated is set for the file of the deprecated callables of a source file, it only declares the callables
+	// of the classes and records and is left out with the puregotk_no_deprecated build tag
+	Deprecated bool
+	// VersionCheck is the CheckVersionAtLeast function of the namespace, declared in the file of the functions it calls
+	VersionCheck *VersionCheckTemplate
+}
+
+// VersionCheckTemplate is a CheckVersionAtLeast function that tells whether the loaded library has the symbols of a version,
+// it compares the version parts returned by GetMajor, GetMinor and GetMicro or calls Check if there are none
+type VersionCheckTemplate struct {
+	GetMajor string
+	GetMinor string
+	GetMicro string
+	// Check returns an empty string if the loaded library is compatible with the version
+	Check string
+	// CheckType is the Go type of the version parts that Check takes
+	CheckType string
 }
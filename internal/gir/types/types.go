@@ -12,6 +12,9 @@ import (
 )
 
 // convList maps the given GIR primitive type to a Go builtin type.
+// The types must have the size of the C type on every platform that is supported, which for 64-bit platforms means:
+// gsize, gssize and the pointer sized integers are Go uint and int, gint64 and guint64 have 64 bits everywhere,
+// and glong and gulong are types.Long and types.ULong as a C long has 32 bits on Windows and 64 bits elsewhere.
 // See https://github.com/diamondburned/gotk4/blob/fd960d20b525a07580938d10a214336bafb47d12/gir/girgen/types/types.go#LL483C1-L512C2
 var convList = map[string]string{
 	"none":     "",
@@ -24,7 +27,7 @@ var convList = map[string]string{
 	"gint16":   "int16",
 	"gshort":   "int16",
 	"gint32":   "int32",
-	"glong":    "int",
+	"glong":    "types.Long",
 	"int32":    "int32",
 	"gint64":   "int64",
 	"guint":    "uint",
@@ -35,8 +38,8 @@ var convList = map[string]string{
 	"guint16":  "uint16",
 	"gushort":  "uint16",
 	"guint32":  "uint32",
-	"gulong":   "uint",
-	"gunichar": "uint32",
+	"gulong":   "types.ULong",
+	"gunichar": "rune",
 	"guint64":  "uint64",
 	"guintptr": "uintptr",
 	"utf8":     "string",
@@ -207,18 +210,32 @@ func (b *Bitfield) Template(ns string) EnumTemplate {
 		if err != nil {
 			panic(err)
 		}
+		// masks such as G_LOG_LEVEL_MASK are negative in the GIR, flags are unsigned
+		if v < 0 {
+			v = int(uint32(int32(v)))
+		}
 		// + Value needed to get rid of duplicates
 		els[i] = enumValues{
-			Doc:   m.Doc.StringSafe(),
-			Name:  util.SnakeToCamel(util.RemoveSnakePrefix(strings.ToLower(m.CIdentifier), ns)) + "Value",
-			Value: v,
+			Doc:         DocString(m.InfoAttrs, m.InfoElements),
+			Name:        util.SnakeToCamel(util.RemoveSnakePrefix(strings.ToLower(m.CIdentifier), ns)) + "Value",
+			Value:       v,
+			CIdentifier: m.CIdentifier,
+		}
+	}
+	zero := "0"
+	for _, el := range els {
+		if el.Value == 0 {
+			zero = el.CIdentifier
+			break
 		}
 	}
 	return EnumTemplate{
 		Name:       util.SnakeToCamel(b.Name),
-		Doc:        b.Doc.StringSafe(),
+		Doc:        DocString(b.InfoAttrs, b.InfoElements),
 		Values:     els,
 		TypeGetter: b.GLibGetType,
+		Flags:      true,
+		ZeroName:   zero,
 	}
 }
 
@@ -230,6 +247,7 @@ type Signal struct {
 	Action    bool       `xml:"action,attr"`
 	NoHooks   bool       `xml:"no-hooks,attr"`
 	NoRecurse bool       `xml:"no-recurse,attr"`
+	InfoAttrs
 	InfoElements
 	Parameters  *Parameters  `xml:"http://www.gtk.org/introspection/core/1.0 parameters"`
 	ReturnValue *ReturnValue `xml:"http://www.gtk.org/introspection/core/1.0 return-value"`
@@ -403,7 +421,7 @@ func (c *Constant) Template(ns string, kinds KindMap) ConstantTemplate {
 
 	return ConstantTemplate{
 		Name:  c.Name,
-		Doc:   c.Doc.StringSafe(),
+		Doc:   DocString(c.InfoAttrs, c.InfoElements),
 		Type:  t,
 		Value: v,
 	}
@@ -432,6 +450,46 @@ func (d *Doc) StringSafe() string {
 	return strings.Join(lines, "\n")
 }
 
+// Since returns the first version that is set, e.g. the version of a method and then of its class,
+// as the methods of a type that was added in a version do not repeat it
+func Since(versions ...string) string {
+	for _, v := range versions {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// DocString returns the doc comment of an element, see Doc.StringSafe, followed by a "Deprecated:" paragraph
+// if the GIR file marks it as deprecated, with the version it is deprecated since and the text of its doc-deprecated element,
+// such that linters like staticcheck report the uses of the element
+func DocString(info InfoAttrs, el InfoElements) string {
+	doc := el.Doc.StringSafe()
+	if !info.Deprecated {
+		return doc
+	}
+	deprecated := "Deprecated: "
+	if info.DeprecatedVersion != "" {
+		deprecated += "since " + info.DeprecatedVersion + ". "
+	}
+	text := ""
+	if el.DocDeprecated != nil {
+		text = strings.TrimSpace(el.DocDeprecated.String)
+	}
+	if text == "" {
+		text = "do not use it in new code."
+	}
+	lines := strings.Split(deprecated+text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight("// "+l, " ")
+	}
+	if doc != "" {
+		doc += "\n//\n"
+	}
+	return doc + strings.Join(lines, "\n")
+}
+
 type DocDeprecated struct {
 	XMLName xml.Name `xml:"http://www.gtk.org/introspection/core/1.0 doc-deprecated"`
 	String  string   `xml:",innerxml"`
@@ -480,17 +538,50 @@ func (e *Enum) Template(ns string) EnumTemplate {
 		id := strings.ToLower(m.CIdentifier)
 		sID := util.RemoveSnakePrefix(id, ns)
 		els[i] = enumValues{
-			Doc: m.Doc.StringSafe(),
+			Doc: DocString(m.InfoAttrs, m.InfoElements),
 			// + Value needed to get rid of duplicates
-			Name:  util.SnakeToCamel(sID) + "Value",
-			Value: v,
+			Name:        util.SnakeToCamel(sID) + "Value",
+			Value:       v,
+			CIdentifier: m.CIdentifier,
+			Nick:        m.Nick(),
 		}
 	}
+	var unique []enumValues
+	seen := make(map[int]bool)
+	for _, el := range els {
+		if !seen[el.Value] {
+			seen[el.Value] = true
+			unique = append(unique, el)
+		}
+	}
+	// every C name and nick is parsed once, a nick may be shared by values that alias each other
+	var parse []enumParse
+	parsed := make(map[string]bool)
+	for _, el := range els {
+		ep := enumParse{Name: el.Name}
+		for _, str := range []string{el.CIdentifier, el.Nick} {
+			if str != "" && !parsed[str] {
+				parsed[str] = true
+				ep.Strings = append(ep.Strings, str)
+			}
+		}
+		if len(ep.Strings) > 0 {
+			parse = append(parse, ep)
+		}
+	}
+	var codes []enumValues
+	if e.GLibErrorDomain != "" {
+		codes = unique
+	}
 	return EnumTemplate{
-		Name:       util.SnakeToCamel(e.Name),
-		Doc:        e.Doc.StringSafe(),
-		Values:     els,
-		TypeGetter: e.GLibGetType,
+		Name:        util.SnakeToCamel(e.Name),
+		Doc:         DocString(e.InfoAttrs, e.InfoElements),
+		Values:      els,
+		TypeGetter:  e.GLibGetType,
+		ErrorDomain: e.GLibErrorDomain,
+		ErrorCodes:  codes,
+		Unique:      unique,
+		Parse:       parse,
 	}
 }
 
@@ -589,6 +680,15 @@ func (m Member) Name() string {
 	return m.nameAttr(xml.Name{Local: "name"})
 }
 
+// Nick returns the nick of the member that GLib uses for the enum value, e.g. "center" for GTK_ALIGN_CENTER,
+// members of enums without a GLib type have no nick, their name is used with the dashes of a nick
+func (m Member) Nick() string {
+	if m.GLibNick != "" {
+		return m.GLibNick
+	}
+	return strings.ReplaceAll(m.Name(), "_", "-")
+}
+
 func (m Member) GLibName() string {
 	return m.nameAttr(xml.Name{Space: "http://www.gtk.org/introspection/glib/1.0", Local: "name"})
 }
@@ -716,6 +816,7 @@ type Property struct {
 	TransferOwnership string   `xml:"transfer-ownership,attr"`
 
 	AnyType
+	InfoAttrs
 	InfoElements
 }
 
@@ -731,7 +832,7 @@ func (p *Property) Template(ns string, kinds KindMap) PropertyTemplate {
 	)
 
 	return PropertyTemplate{
-		Doc:        p.Doc.StringSafe(),
+		Doc:        DocString(p.InfoAttrs, p.InfoElements),
 		Name:       util.DashToCamel(cName),
 		CName:      cName,
 		GoType:     goType,
@@ -759,6 +860,10 @@ func mapGoTypeToGValue(goType string) (gvalueType, setMethod, getMethod string)
 		return "TypeLongVal", "SetLong", "GetLong"
 	case "uint32":
 		return "TypeUlongVal", "SetUlong", "GetUlong"
+	case "types.Long":
+		return "TypeLongVal", "SetLong", "GetLong"
+	case "types.ULong":
+		return "TypeUlongVal", "SetUlong", "GetUlong"
 	case "int64":
 		return "TypeInt64Val", "SetInt64", "GetInt64"
 	case "uint64":
@@ -791,6 +896,7 @@ type Record struct {
 	CSymbolPrefix        string   `xml:"http://www.gtk.org/introspection/c/1.0 symbol-prefix,attr"`
 	GLibIsGTypeStructFor string   `xml:"http://www.gtk.org/introspection/glib/1.0 is-gtype-struct-for,attr"`
 	Disguised            bool     `xml:"disguised,attr"`
+	Pointer              bool     `xml:"pointer,attr"` // the record is a typedef of a pointer, e.g. GIConv
 	Foreign              bool     `xml:"foreign,attr"`
 
 	Fields       []Field       `xml:"http://www.gtk.org/introspection/core/1.0 field"`
@@ -804,6 +910,12 @@ type Record struct {
 	InfoElements
 }
 
+// Boxed returns whether the record is a boxed type that the Go package of the namespace ns
+// can copy and free with g_boxed_copy and g_boxed_free, the GLib package cannot as it does not use GObject
+func (r Record) Boxed(ns string) bool {
+	return r.GLibGetType != "" && r.GLibGetType != "intern" && strings.ToLower(ns) != "glib"
+}
+
 type ReturnValue struct {
 	XMLName        xml.Name `xml:"http://www.gtk.org/introspection/core/1.0 return-value"`
 	Scope          string   `xml:"scope,attr"`
@@ -861,6 +973,12 @@ func (r *ReturnValue) Template(ns string, ins string, kinds KindMap, throws bool
 			val = "uintptr"
 		}
 	}
+	// the gunichar arrays that e.g. g_utf8_to_ucs4 returns are annotated as a single gunichar,
+	// their address is returned to be read with core.GoRunes
+	if r.Type != nil && r.Type.Name == "gunichar" && strings.Contains(r.Type.CType, "*") {
+		raw = "uintptr"
+		val = "uintptr"
+	}
 	return funcRetTemplate{
 		Raw:     raw,
 		Value:   val,
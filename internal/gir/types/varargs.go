@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// varArgsHelpers maps variadic C functions to the hand-written Go functions that implement them in the same package,
+// see templates/gobject_varargs and templates/gtk_varargs
+// purego passes Go values to variadic C functions as is, so these call the non-variadic equivalent instead,
+// e.g. g_object_new_with_properties for g_object_new or a loop of gtk_dialog_add_button for gtk_dialog_add_buttons
+var varArgsHelpers = map[string]string{
+	"g_object_new":                      "newObjectVarArgs",
+	"g_object_set":                      "objectSetVarArgs",
+	"g_object_get":                      "objectGetVarArgs",
+	"g_signal_emit":                     "signalEmitVarArgs",
+	"g_signal_emit_by_name":             "signalEmitByNameVarArgs",
+	"gtk_dialog_new_with_buttons":       "newDialogWithButtonsVarArgs",
+	"gtk_dialog_add_buttons":            "dialogAddButtonsVarArgs",
+	"gtk_info_bar_new_with_buttons":     "newInfoBarWithButtonsVarArgs",
+	"gtk_info_bar_add_buttons":          "infoBarAddButtonsVarArgs",
+	"gtk_file_chooser_dialog_new":       "newFileChooserDialogVarArgs",
+	"gtk_text_buffer_create_tag":        "textBufferCreateTagVarArgs",
+	"gtk_list_store_new":                "newListStoreVarArgs",
+	"gtk_list_store_set":                "listStoreSetVarArgs",
+	"gtk_list_store_insert_with_values": "listStoreInsertWithValuesVarArgs",
+	"gtk_tree_store_new":                "newTreeStoreVarArgs",
+	"gtk_tree_store_set":                "treeStoreSetVarArgs",
+	"gtk_tree_store_insert_with_values": "treeStoreInsertWithValuesVarArgs",
+}
+
+// VarArgsHelpers enables the helpers of varArgsHelpers, they are only available in the bindings of v4,
+// other bindings are generated without the hand-written files of templates/, see bindings.Generate
+var VarArgsHelpers = true
+
+// varArgsHelper returns the Go helper of a variadic C function, if there is one
+func varArgsHelper(cid string) (string, bool) {
+	if !VarArgsHelpers {
+		return "", false
+	}
+	helper, ok := varArgsHelpers[cid]
+	return helper, ok
+}
+
+// printfFormat returns the printf-style format parameter of a variadic function, if it has one
+// The format is recognized by its documentation, the escaping variants such as g_markup_printf_escaped are left out
+// as formatting in Go would not escape the arguments
+func printfFormat(cid string, params *Parameters) (Parameter, bool) {
+	if params == nil || strings.Contains(cid, "escape") {
+		return Parameter{}, false
+	}
+	n := len(params.Parameters)
+	if n < 2 || params.Parameters[n-1].Name != "..." {
+		return Parameter{}, false
+	}
+	format := params.Parameters[n-2]
+	if format.Nullable || format.Doc == nil || !strings.Contains(format.Doc.String, "printf") {
+		return Parameter{}, false
+	}
+	return format, true
+}
+
+// VarArgsMapped returns whether the variadic function is generated with a call to a non-variadic equivalent
+func VarArgsMapped(cid string, params *Parameters) bool {
+	_, ok := varArgsHelper(cid)
+	if ok {
+		return true
+	}
+	_, ok = printfFormat(cid, params)
+	return ok
+}
+
+// MapVarArgs changes the call of a variadic function to a non-variadic equivalent, if there is one:
+// the functions in varArgsHelpers call their Go helper and printf-style functions format the arguments with fmt.Sprintf
+// receiver is set for methods, which pass their instance to the helper
+func MapVarArgs(f FuncTemplate, params *Parameters, receiver bool) FuncTemplate {
+	if helper, ok := varArgsHelper(f.CName); ok {
+		var args []string
+		if receiver {
+			args = append(args, "x")
+		}
+		for _, n := range f.Args.API.Names {
+			if n == "varArgs" {
+				n += "..."
+			}
+			args = append(args, n)
+		}
+		f.VarArgsCall = fmt.Sprintf("%s(%s)", helper, strings.Join(args, ", "))
+		return f
+	}
+
+	param, ok := printfFormat(f.CName, params)
+	if !ok {
+		return f
+	}
+	format := param.VarName()
+	i := -1
+	for j, n := range f.Args.API.Names {
+		if n == format {
+			i = j
+		}
+	}
+	if i < 0 || i+1 >= len(f.Args.API.Names) || f.Args.API.Names[i+1] != "varArgs" || f.Args.API.Types[i] != "string" {
+		return f
+	}
+	// the C function formats a single string argument, such that Go values are never interpreted by printf
+	f.Args.API.Call[i] = `"%s"`
+	f.Args.API.CallWithRefs[i] = `"%s"`
+	f.Args.API.Call[i+1] = fmt.Sprintf("fmt.Sprintf(%s, varArgs...)", format)
+	f.Args.API.CallWithRefs[i+1] = f.Args.API.Call[i+1]
+	if f.Doc != "" {
+		f.Doc += "\n//\n"
+	}
+	f.Doc += fmt.Sprintf("// %s is a Go format, the arguments are formatted with fmt.Sprintf.", format)
+	return f
+}
@@ -10,15 +10,11 @@ import (
 )
 
 var (
-	// Variable names that should not be dereferenced when using ConvertPtr() in handlePtr mode
-	// TODO: This was mostly discovered via trial and error, and might point towards issues in
+	// NoDerefVars are the variable names that should not be dereferenced when using ConvertPtr() in handlePtr mode,
+	// they are set from the no_deref list of puregotk.yaml
+	// TODO: These were mostly discovered via trial and error, and might point towards issues in
 	// the GIR files
-	specialConvertPtrVars = []string{
-		"ModelVar",
-		"TreeModelVar",
-		"OutChildVar",
-		"ChildVar",
-	}
+	NoDerefVars []string
 )
 
 // delimToCamel to camel converts a string with parts separated by `delim` to CamelCase
@@ -185,7 +181,7 @@ func convertCallbackArgs(a []string, prependComma, skipEmpty, skipErr, handlePtr
 			validArgs = append(validArgs, "&"+arg)
 		} else if strings.Contains(arg, "ConvertPtr(") && handlePtr {
 			isSpecialVar := false
-			for _, specialVar := range specialConvertPtrVars {
+			for _, specialVar := range NoDerefVars {
 				if strings.Contains(arg, specialVar) {
 					isSpecialVar = true
 
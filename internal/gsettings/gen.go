@@ -0,0 +1,373 @@
+package gsettings
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+// accessor describes how a GVariant type string maps to the typed gio.Settings getters/setters
+type accessor struct {
+	GoType string
+	Get    string
+	Set    string
+}
+
+// accessors maps the GVariant type strings that gio.Settings has dedicated getters/setters for
+// every other type falls back to GetValue/SetValue with a *glib.Variant
+var accessors = map[string]accessor{
+	"b":  {"bool", "GetBoolean", "SetBoolean"},
+	"i":  {"int", "GetInt", "SetInt"},
+	"u":  {"uint", "GetUint", "SetUint"},
+	"x":  {"int64", "GetInt64", "SetInt64"},
+	"t":  {"uint64", "GetUint64", "SetUint64"},
+	"d":  {"float64", "GetDouble", "SetDouble"},
+	"s":  {"string", "GetString", "SetString"},
+	"as": {"[]string", "GetStrv", "SetStrv"},
+}
+
+var variantAccessor = accessor{"*glib.Variant", "GetValue", "SetValue"}
+
+type enumValueTemplate struct {
+	Name  string
+	Nick  string
+	Value int
+}
+
+type enumTemplate struct {
+	Name   string
+	ID     string
+	Flags  bool
+	Values []enumValueTemplate
+}
+
+type keyTemplate struct {
+	Name   string
+	CName  string
+	Doc    string
+	GoType string
+	Get    string
+	Set    string
+	// Conv is the conversion applied to the raw getter value, e.g. an enum type cast
+	Conv string
+	// RawConv is the conversion applied to the typed value before it is given to the setter
+	RawConv string
+}
+
+type childTemplate struct {
+	Name   string
+	CName  string
+	GoType string
+	Typed  bool
+}
+
+type schemaTemplate struct {
+	Name        string
+	ID          string
+	Relocatable bool
+	Keys        []keyTemplate
+	Children    []childTemplate
+}
+
+type fileTemplate struct {
+	Source    string
+	Package   string
+	NeedsGLib bool
+	Enums     []enumTemplate
+	Schemas   []schemaTemplate
+}
+
+// GoName converts a reverse DNS schema or enum id to an exported Go name
+// the first two components are dropped as they are the reverse domain
+// e.g. org.gnome.desktop.interface becomes DesktopInterface
+func GoName(id string) string {
+	parts := strings.Split(id, ".")
+	if len(parts) > 2 {
+		parts = parts[2:]
+	}
+	var sb strings.Builder
+	for _, p := range parts {
+		sb.WriteString(identifier(p))
+	}
+	return sb.String()
+}
+
+// identifier converts an arbitrary key name or nick to a CamelCase Go identifier
+func identifier(s string) string {
+	clean := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '-'
+	}, s)
+	name := util.DashToCamel(clean)
+	if name != "" && unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// comment converts free form text from a schema to a Go comment
+func comment(s string) string {
+	var lines []string
+	for _, l := range strings.Split(strings.TrimSpace(s), "\n") {
+		lines = append(lines, "// "+strings.TrimSpace(l))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func convertEnum(e Enum, flags bool) enumTemplate {
+	name := GoName(e.ID)
+	values := make([]enumValueTemplate, len(e.Values))
+	for i, v := range e.Values {
+		values[i] = enumValueTemplate{
+			Name:  name + identifier(v.Nick),
+			Nick:  v.Nick,
+			Value: v.Value,
+		}
+	}
+	return enumTemplate{
+		Name:   name,
+		ID:     e.ID,
+		Flags:  flags,
+		Values: values,
+	}
+}
+
+func convertKey(k Key) (keyTemplate, bool) {
+	kt := keyTemplate{
+		Name:  identifier(k.Name),
+		CName: k.Name,
+	}
+	var doc []string
+	if k.Summary != "" {
+		doc = append(doc, comment(k.Summary))
+	}
+	if k.Description != "" {
+		if len(doc) > 0 {
+			doc = append(doc, "//")
+		}
+		doc = append(doc, comment(k.Description))
+	}
+	kt.Doc = strings.Join(doc, "\n")
+
+	switch {
+	case k.Enum != "":
+		kt.GoType = GoName(k.Enum)
+		kt.Get, kt.Set = "GetEnum", "SetEnum"
+		kt.Conv, kt.RawConv = kt.GoType, "int"
+		return kt, false
+	case k.Flags != "":
+		kt.GoType = GoName(k.Flags)
+		kt.Get, kt.Set = "GetFlags", "SetFlags"
+		kt.Conv, kt.RawConv = kt.GoType, "uint"
+		return kt, false
+	}
+	acc, ok := accessors[k.Type]
+	if !ok {
+		acc = variantAccessor
+	}
+	kt.GoType, kt.Get, kt.Set = acc.GoType, acc.Get, acc.Set
+	return kt, !ok
+}
+
+// resolveKeys returns the keys of a schema including the ones of the schema it extends
+// keys defined in the schema itself override the keys of the extended schema
+func resolveKeys(s Schema, byID map[string]Schema, seen map[string]bool) []Key {
+	if seen[s.ID] {
+		return s.Keys
+	}
+	seen[s.ID] = true
+	parent, ok := byID[s.Extends]
+	if s.Extends == "" || !ok {
+		return s.Keys
+	}
+	own := make(map[string]bool, len(s.Keys))
+	for _, k := range s.Keys {
+		own[k.Name] = true
+	}
+	var keys []Key
+	for _, k := range resolveKeys(parent, byID, seen) {
+		if !own[k.Name] {
+			keys = append(keys, k)
+		}
+	}
+	return append(keys, s.Keys...)
+}
+
+func convert(sl *SchemaList, pkg string, source string) fileTemplate {
+	ft := fileTemplate{
+		Source:  source,
+		Package: pkg,
+	}
+	for _, e := range sl.Enums {
+		ft.Enums = append(ft.Enums, convertEnum(e, false))
+	}
+	for _, f := range sl.Flags {
+		ft.Enums = append(ft.Enums, convertEnum(f, true))
+	}
+
+	byID := make(map[string]Schema, len(sl.Schemas))
+	for _, s := range sl.Schemas {
+		byID[s.ID] = s
+	}
+	for _, s := range sl.Schemas {
+		st := schemaTemplate{
+			Name:        GoName(s.ID),
+			ID:          s.ID,
+			Relocatable: s.Path == "",
+		}
+		for _, k := range resolveKeys(s, byID, map[string]bool{}) {
+			kt, variant := convertKey(k)
+			if variant {
+				ft.NeedsGLib = true
+			}
+			st.Keys = append(st.Keys, kt)
+		}
+		for _, c := range s.Children {
+			ct := childTemplate{
+				Name:   identifier(c.Name),
+				CName:  c.Name,
+				GoType: "gio.Settings",
+			}
+			if _, ok := byID[c.Schema]; ok {
+				ct.GoType = GoName(c.Schema)
+				ct.Typed = true
+			}
+			st.Children = append(st.Children, ct)
+		}
+		sort.SliceStable(st.Keys, func(i, j int) bool {
+			return st.Keys[i].CName < st.Keys[j].CName
+		})
+		ft.Schemas = append(ft.Schemas, st)
+	}
+	return ft
+}
+
+// Generate writes Go source code with typed accessors for all schemas in sl to w
+// pkg is the package name of the generated file and source is the schema filename mentioned in the header
+func Generate(w io.Writer, sl *SchemaList, pkg string, source string) error {
+	var buf bytes.Buffer
+	if err := gotemp.Execute(&buf, convert(sl, pkg, source)); err != nil {
+		return err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated gsettings code: %w", err)
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+var gotemp = template.Must(template.New("gsettings").Parse(`// Code generated by github.com/jwijenbergh/puregotk from {{.Source}}. DO NOT EDIT.
+package {{.Package}}
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio"
+{{- if .NeedsGLib}}
+	"github.com/jwijenbergh/puregotk/v4/glib"
+{{- end}}
+)
+
+{{range .Enums -}}
+{{$enum := . -}}
+// {{.Name}} are the values of the "{{.ID}}" {{if .Flags}}flags{{else}}enumeration{{end}}.
+type {{.Name}} {{if .Flags}}uint{{else}}int{{end}}
+
+const (
+{{- range .Values}}
+	// {{.Name}} is the "{{.Nick}}" value.
+	{{.Name}} {{$enum.Name}} = {{.Value}}
+{{- end}}
+)
+
+{{end}}
+
+{{- range .Schemas -}}
+{{$schema := . -}}
+// {{.Name}}SchemaID is the id of the "{{.ID}}" schema.
+const {{.Name}}SchemaID = "{{.ID}}"
+
+// {{.Name}} provides typed access to the keys of the "{{.ID}}" schema.
+type {{.Name}} struct {
+	// Settings is the underlying settings object.
+	// It can be used for everything that has no typed accessor, e.g. Bind or Delay.
+	Settings *gio.Settings
+}
+
+{{if .Relocatable -}}
+// New{{.Name}} creates settings for the relocatable "{{.ID}}" schema at path.
+func New{{.Name}}(path string) *{{.Name}} {
+	return &{{.Name}}{Settings: gio.NewSettingsWithPath({{.Name}}SchemaID, path)}
+}
+{{- else -}}
+// New{{.Name}} creates settings for the "{{.ID}}" schema.
+func New{{.Name}}() *{{.Name}} {
+	return &{{.Name}}{Settings: gio.NewSettings({{.Name}}SchemaID)}
+}
+{{- end}}
+
+// Unref drops the reference to the underlying settings object.
+func (s *{{.Name}}) Unref() {
+	s.Settings.Unref()
+}
+
+{{range .Keys -}}
+// Get{{.Name}} gets the value of the "{{.CName}}" key.
+{{- if .Doc}}
+//
+{{.Doc}}
+{{- end}}
+func (s *{{$schema.Name}}) Get{{.Name}}() {{.GoType}} {
+	return {{if .Conv}}{{.Conv}}(s.Settings.{{.Get}}("{{.CName}}")){{else}}s.Settings.{{.Get}}("{{.CName}}"){{end}}
+}
+
+// Set{{.Name}} sets the value of the "{{.CName}}" key.
+// It returns false if the key is not writable.
+func (s *{{$schema.Name}}) Set{{.Name}}(value {{.GoType}}) bool {
+	return s.Settings.{{.Set}}("{{.CName}}", {{if .RawConv}}{{.RawConv}}(value){{else}}value{{end}})
+}
+
+// Watch{{.Name}} returns a channel that receives the value of the "{{.CName}}" key every time it changes.
+// Only the most recent value is buffered, older values are dropped when the receiver is too slow.
+// Call the returned function to disconnect the signal handler and close the channel.
+func (s *{{$schema.Name}}) Watch{{.Name}}() (<-chan {{.GoType}}, func()) {
+	ch := make(chan {{.GoType}}, 1)
+	cb := func(_ gio.Settings, _ string) {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- s.Get{{.Name}}()
+	}
+	handler := s.Settings.ConnectChangedWithDetail("{{.CName}}", &cb)
+	// GSettings only emits "changed" for keys that have been read after connecting
+	s.Settings.GetValue("{{.CName}}").Unref()
+	return ch, func() {
+		s.Settings.DisconnectSignal(handler)
+		close(ch)
+	}
+}
+
+{{end -}}
+
+{{range .Children -}}
+// Child{{.Name}} gets the "{{.CName}}" child schema.
+func (s *{{$schema.Name}}) Child{{.Name}}() *{{.GoType}} {
+{{- if .Typed}}
+	return &{{.GoType}}{Settings: s.Settings.GetChild("{{.CName}}")}
+{{- else}}
+	return s.Settings.GetChild("{{.CName}}")
+{{- end}}
+}
+
+{{end -}}
+{{end -}}
+`))
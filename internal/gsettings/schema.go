@@ -0,0 +1,76 @@
+// package gsettings implements a generator for typed GSettings accessors
+// it reads a .gschema.xml file and converts every schema into a Go struct that wraps gio.Settings
+package gsettings
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// SchemaList is the root element of a .gschema.xml file
+// See https://gitlab.gnome.org/GNOME/glib/-/blob/main/gio/gschema.dtd
+type SchemaList struct {
+	XMLName       xml.Name `xml:"schemalist"`
+	GettextDomain string   `xml:"gettext-domain,attr"`
+
+	Schemas []Schema `xml:"schema"`
+	Enums   []Enum   `xml:"enum"`
+	Flags   []Enum   `xml:"flags"`
+}
+
+// Schema is a single schema with its keys and child schemas
+type Schema struct {
+	ID       string  `xml:"id,attr"`
+	Path     string  `xml:"path,attr"`
+	Extends  string  `xml:"extends,attr"`
+	Keys     []Key   `xml:"key"`
+	Children []Child `xml:"child"`
+}
+
+// Key is a key inside of a schema
+// Exactly one of Type, Enum or Flags is set
+type Key struct {
+	Name        string `xml:"name,attr"`
+	Type        string `xml:"type,attr"`
+	Enum        string `xml:"enum,attr"`
+	Flags       string `xml:"flags,attr"`
+	Default     string `xml:"default"`
+	Summary     string `xml:"summary"`
+	Description string `xml:"description"`
+}
+
+// Child is a child schema that is reachable from a path relative to the parent
+type Child struct {
+	Name   string `xml:"name,attr"`
+	Schema string `xml:"schema,attr"`
+}
+
+// Enum is an enumeration or flags definition that keys can refer to
+type Enum struct {
+	ID     string      `xml:"id,attr"`
+	Values []EnumValue `xml:"value"`
+}
+
+// EnumValue is a single nick/value pair of an enumeration or flags definition
+type EnumValue struct {
+	Nick  string `xml:"nick,attr"`
+	Value int    `xml:"value,attr"`
+}
+
+// Parse parses the contents of a .gschema.xml file
+func Parse(b []byte) (*SchemaList, error) {
+	var sl SchemaList
+	if err := xml.Unmarshal(b, &sl); err != nil {
+		return nil, err
+	}
+	return &sl, nil
+}
+
+// ParseFile reads and parses a .gschema.xml file
+func ParseFile(filename string) (*SchemaList, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
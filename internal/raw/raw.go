@@ -0,0 +1,210 @@
+// package raw calls functions of the loaded libraries that the generated bindings do not cover,
+// with the same library resolution as the bindings, see core.GetPaths
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/internal/core"
+)
+
+// ErrNotFound is returned when a library or a symbol cannot be found
+var ErrNotFound = errors.New("raw: not found")
+
+// Library is a loaded library, e.g. "GTK", named like the environment variables PUREGOTK_<NAME>_PATH.
+type Library struct {
+	name    string
+	handles []uintptr
+
+	mu    sync.Mutex
+	funcs map[string]reflect.Value
+}
+
+var libraries = struct {
+	sync.Mutex
+	m map[string]*Library
+}{m: make(map[string]*Library)}
+
+// Open returns the library name, e.g. "GTK", "GIO" or "ADW", and loads it on the first call.
+// The shared objects of a library are registered by the init function of its bindings,
+// so the package of the library has to be imported, or registered with core.SetSharedLibraries.
+func Open(name string) (lib *Library, err error) {
+	name = strings.ToUpper(name)
+	libraries.Lock()
+	defer libraries.Unlock()
+	if l, ok := libraries.m[name]; ok {
+		return l, nil
+	}
+	if !core.Supported {
+		return nil, core.ErrUnsupported
+	}
+	defer func() {
+		// GetPaths panics when no shared object can be found
+		if r := recover(); r != nil {
+			lib, err = nil, fmt.Errorf("%w: library %s: %v", ErrNotFound, name, r)
+		}
+	}()
+	l := &Library{name: name, funcs: make(map[string]reflect.Value)}
+	for _, path := range core.GetPaths(name) {
+		h, err := core.Dlopen(path, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			continue
+		}
+		l.handles = append(l.handles, h)
+	}
+	if len(l.handles) == 0 {
+		return nil, fmt.Errorf("%w: library %s", ErrNotFound, name)
+	}
+	libraries.m[name] = l
+	return l, nil
+}
+
+// Name returns the name the library was opened with.
+func (l *Library) Name() string {
+	return l.name
+}
+
+// Symbol returns the address of the symbol in the library, e.g. of a function or a global variable.
+func (l *Library) Symbol(symbol string) (uintptr, error) {
+	for _, h := range l.handles {
+		if sym, err := core.Dlsym(h, symbol); err == nil && sym != 0 {
+			return sym, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: symbol %s in library %s", ErrNotFound, symbol, l.name)
+}
+
+// Func sets the function that fptr points to to call the C function symbol, like the generated bindings do:
+//
+//	var setOpacity func(widget uintptr, opacity float64)
+//	err := lib.Func("gtk_widget_set_opacity", &setOpacity)
+//
+// The Go function type must match the C signature, see purego.RegisterFunc for the supported types.
+// Strings are passed as temporary C strings and returned strings are copied.
+func (l *Library) Func(symbol string, fptr any) error {
+	sym, err := l.Symbol(symbol)
+	if err != nil {
+		return err
+	}
+	core.RegisterFunc(fptr, sym)
+	return nil
+}
+
+// Call calls the C function symbol with args and returns its result as a uintptr, or 0 for a void function.
+// The arguments are converted with Arg. Use Func for functions that return a float or a struct.
+func (l *Library) Call(symbol string, args ...any) (uintptr, error) {
+	in := make([]reflect.Value, len(args))
+	types := make([]reflect.Type, len(args))
+	for i, a := range args {
+		v, err := Arg(a)
+		if err != nil {
+			return 0, fmt.Errorf("raw: %s argument %d: %w", symbol, i, err)
+		}
+		in[i] = v
+		types[i] = v.Type()
+	}
+	fn, err := l.function(symbol, reflect.FuncOf(types, []reflect.Type{reflect.TypeOf(uintptr(0))}, false))
+	if err != nil {
+		return 0, err
+	}
+	return uintptr(fn.Call(in)[0].Uint()), nil
+}
+
+// function returns the C function symbol registered with the Go type t, it is cached per symbol and type
+func (l *Library) function(symbol string, t reflect.Type) (reflect.Value, error) {
+	key := symbol + " " + t.String()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if fn, ok := l.funcs[key]; ok {
+		return fn, nil
+	}
+	fptr := reflect.New(t)
+	if err := l.Func(symbol, fptr.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	l.funcs[key] = fptr.Elem()
+	return fptr.Elem(), nil
+}
+
+// Arg converts a Go value to an argument of Call:
+//   - nil is NULL
+//   - integers, floats, bools, strings, uintptrs, unsafe.Pointers and pointers are passed as they are,
+//     strings as temporary NUL terminated C strings
+//   - values with a GoPointer method, like the types of the generated bindings, are passed as their C pointer
+//   - a []string is passed as a NULL terminated char** array
+//
+// Other values return an error.
+func Arg(a any) (reflect.Value, error) {
+	switch v := a.(type) {
+	case nil:
+		return reflect.ValueOf(uintptr(0)), nil
+	case interface{ GoPointer() uintptr }:
+		if rv := reflect.ValueOf(a); rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return reflect.ValueOf(uintptr(0)), nil
+		}
+		return reflect.ValueOf(v.GoPointer()), nil
+	case []string:
+		return reflect.ValueOf(core.ByteSlice(v)), nil
+	}
+	rv := reflect.ValueOf(a)
+	switch rv.Kind() {
+	case reflect.Bool, reflect.String, reflect.Uintptr, reflect.UnsafePointer, reflect.Pointer,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return rv, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported type %T", a)
+}
+
+// Call opens the library and calls the C function symbol in it with args, see Library.Call:
+//
+//	r, err := raw.Call("GTK", "gtk_get_major_version")
+func Call(library, symbol string, args ...any) (uintptr, error) {
+	l, err := Open(library)
+	if err != nil {
+		return 0, err
+	}
+	return l.Call(symbol, args...)
+}
+
+// Func opens the library and sets the function that fptr points to to call the C function symbol, see Library.Func.
+func Func(library, symbol string, fptr any) error {
+	l, err := Open(library)
+	if err != nil {
+		return err
+	}
+	return l.Func(symbol, fptr)
+}
+
+// String copies the NUL terminated C string at ptr, e.g. a result of Call, it returns "" for NULL.
+func String(ptr uintptr) string {
+	return core.GoString(ptr)
+}
+
+// Strings copies the NULL terminated char** array at ptr.
+func Strings(ptr uintptr) []string {
+	return core.GoStringSlice(ptr)
+}
+
+// Bool converts a gboolean returned by Call.
+func Bool(r uintptr) bool {
+	// gboolean is a C int, the upper bits of the register are undefined
+	return uint32(r) != 0
+}
+
+// Int converts a C int returned by Call.
+func Int(r uintptr) int {
+	return int(int32(r))
+}
+
+// Pointer converts a pointer returned by Call, e.g. to read a C struct with a Go struct of the same layout.
+func Pointer(r uintptr) unsafe.Pointer {
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return *(*unsafe.Pointer)(unsafe.Pointer(&r))
+}
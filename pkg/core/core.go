@@ -2,18 +2,58 @@ package core
 
 import "github.com/jwijenbergh/puregotk/internal/core"
 
+const (
+	Supported    = core.Supported
+	MaxCallbacks = core.MaxCallbacks
+	RTLD_NOW     = core.RTLD_NOW
+	RTLD_GLOBAL  = core.RTLD_GLOBAL
+)
+
+type PanicHandler = core.PanicHandler
+
+type MissingSymbolError = core.MissingSymbolError
+
+var (
+	ErrUnsupported    = core.ErrUnsupported
+	ErrUnsupportedABI = core.ErrUnsupportedABI
+	PlatformError     = core.PlatformError
+)
+
 var (
-	GetPaths            = core.GetPaths
-	ByteSlice           = core.ByteSlice
-	GoStringSlice       = core.GoStringSlice
-	GoString            = core.GoString
-	GStrdup             = core.GStrdup
-	GStrdupNullable     = core.GStrdupNullable
-	GFree               = core.GFree
-	GFreeNullable       = core.GFreeNullable
-	NullableStringToPtr = core.NullableStringToPtr
-	PtrToNullableString = core.PtrToNullableString
-	SetPackageName      = core.SetPackageName
-	SetSharedLibraries  = core.SetSharedLibraries
-	PuregoSafeRegister  = core.PuregoSafeRegister
+	GetPaths               = core.GetPaths
+	LookupPaths            = core.LookupPaths
+	ByteSlice              = core.ByteSlice
+	GoStringSlice          = core.GoStringSlice
+	GoStringSliceFull      = core.GoStringSliceFull
+	GoStringSliceContainer = core.GoStringSliceContainer
+	GoString               = core.GoString
+	GoRunes                = core.GoRunes
+	RuneSlice              = core.RuneSlice
+	GStrdup                = core.GStrdup
+	GStrdupNullable        = core.GStrdupNullable
+	GFree                  = core.GFree
+	GStrfreev              = core.GStrfreev
+	GFreeNullable          = core.GFreeNullable
+	GMalloc0               = core.GMalloc0
+	NullableStringToPtr    = core.NullableStringToPtr
+	PtrToNullableString    = core.PtrToNullableString
+	SetPackageName         = core.SetPackageName
+	SetSharedLibraries     = core.SetSharedLibraries
+	PuregoSafeRegister     = core.PuregoSafeRegister
+	PuregoRegisterSince    = core.PuregoRegisterSince
+	MissingSymbols         = core.MissingSymbols
+	SetLibraryError        = core.SetLibraryError
+	LibraryError           = core.LibraryError
+	Dlopen                 = core.Dlopen
+	Dlsym                  = core.Dlsym
+	RegisterFunc           = core.RegisterFunc
+	NewCallback            = core.NewCallback
+	NewCallbackFnPtr       = core.NewCallbackFnPtr
+	UnrefCallback          = core.UnrefCallback
+	UnrefCallbackFnPtr     = core.UnrefCallbackFnPtr
+	SetPanicHandler        = core.SetPanicHandler
+	RecoverPanic           = core.RecoverPanic
+	LiveCallbacks          = core.LiveCallbacks
+	Debug                  = core.Debug
+	UnsupportedABI         = core.UnsupportedABI
 )
@@ -0,0 +1,21 @@
+package dbusproxy
+
+import "github.com/jwijenbergh/puregotk/internal/dbusproxy"
+
+type (
+	Node       = dbusproxy.Node
+	Interface  = dbusproxy.Interface
+	Method     = dbusproxy.Method
+	Signal     = dbusproxy.Signal
+	Property   = dbusproxy.Property
+	Arg        = dbusproxy.Arg
+	Annotation = dbusproxy.Annotation
+)
+
+var (
+	Parse     = dbusproxy.Parse
+	ParseFile = dbusproxy.ParseFile
+	Generate  = dbusproxy.Generate
+	GoName    = dbusproxy.GoName
+	GoType    = dbusproxy.GoType
+)
@@ -0,0 +1,10 @@
+package bindings
+
+import "github.com/jwijenbergh/puregotk/internal/gir/bindings"
+
+type Options = bindings.Options
+
+var (
+	Generate   = bindings.Generate
+	FixImports = bindings.FixImports
+)
@@ -6,10 +6,24 @@ import (
 )
 
 type (
-	Pass       = pass.Pass
-	Repository = types.Repository
+	Pass        = pass.Pass
+	Repository  = types.Repository
+	Diagnostic  = pass.Diagnostic
+	Diagnostics = pass.Diagnostics
+	Severity    = pass.Severity
+	Coverage    = pass.Coverage
+	Skipped     = pass.Skipped
+	Report      = pass.Report
+	Config      = pass.Config
+)
+
+const (
+	Warning = pass.Warning
+	Error   = pass.Error
 )
 
 var (
-	New = pass.New
+	New            = pass.New
+	LoadConfig     = pass.LoadConfig
+	CompareReports = pass.CompareReports
 )
@@ -0,0 +1,19 @@
+package gsettings
+
+import "github.com/jwijenbergh/puregotk/internal/gsettings"
+
+type (
+	SchemaList = gsettings.SchemaList
+	Schema     = gsettings.Schema
+	Key        = gsettings.Key
+	Child      = gsettings.Child
+	Enum       = gsettings.Enum
+	EnumValue  = gsettings.EnumValue
+)
+
+var (
+	Parse     = gsettings.Parse
+	ParseFile = gsettings.ParseFile
+	Generate  = gsettings.Generate
+	GoName    = gsettings.GoName
+)
@@ -0,0 +1,21 @@
+// Package raw calls functions of the GTK libraries that the generated bindings do not cover yet,
+// with the libraries and paths that the bindings load, see the README.
+package raw
+
+import "github.com/jwijenbergh/puregotk/internal/raw"
+
+type Library = raw.Library
+
+var ErrNotFound = raw.ErrNotFound
+
+var (
+	Open    = raw.Open
+	Call    = raw.Call
+	Func    = raw.Func
+	Arg     = raw.Arg
+	String  = raw.String
+	Strings = raw.Strings
+	Bool    = raw.Bool
+	Int     = raw.Int
+	Pointer = raw.Pointer
+)
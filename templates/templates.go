@@ -0,0 +1,29 @@
+// package templates embeds the template of the generated Go files.
+// The other files in this directory are hand written Go files that gen.go copies into the bindings, see extras
+package templates
+
+import (
+	_ "embed"
+	"text/template"
+
+	"github.com/jwijenbergh/puregotk/internal/gir/util"
+)
+
+//go:embed go
+var source string
+
+// Parse returns the template of the generated Go files, the second pass executes it for every file of a namespace
+func Parse() (*template.Template, error) {
+	return template.New("go").Funcs(template.FuncMap{
+		"conv":     util.ConvertArgs,
+		"convc":    util.ConvertArgsComma,
+		"convcb":   util.ConvertCallbackArgs,
+		"convcd":   util.ConvertArgsCommaDeref,
+		"convd":    util.ConvertArgsDeref,
+		"convcbne": util.ConvertCallbackArgsNoErr,
+		"propsset": util.PropertyScalarSet,
+		"propsget": util.PropertyScalarGet,
+		"propvset": util.PropertyVectorSet,
+		"propvget": util.PropertyVectorGet,
+	}).Parse(source)
+}
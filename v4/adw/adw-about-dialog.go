@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,7 @@ var xShowAboutDialogFromAppdata func(uintptr, string, uintptr, string, ...interf
 // A convenience function for showing an application’s about dialog from
 // AppStream metadata.
 //
-// See [ctor@AboutDialog.new_from_appdata] for details.
+// See new_from_appdata for details.
 func ShowAboutDialogFromAppdata(ParentVar *gtk.Widget, ResourcePathVar string, ReleaseNotesVersionVar *string, FirstPropertyNameVar string, varArgs ...interface{}) {
 
 	ReleaseNotesVersionVarPtr := core.GStrdupNullable(ReleaseNotesVersionVar)
@@ -49,40 +48,33 @@ func ShowAboutDialogFromAppdata(ParentVar *gtk.Widget, ResourcePathVar string, R
 
 // A dialog showing information about the application.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="about-dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="about-dialog.png" alt="about-dialog"&gt;
-//
-// &lt;/picture&gt;
-//
 // an about dialog is typically opened when the user activates the `About …`
 // item in the application's primary menu. All parts of the dialog are optional.
 //
-// ## Main page
+// # Main page
 //
 // `AdwAboutDialog` prominently displays the application's icon, name, developer
-// name and version. They can be set with the [property@AboutDialog:application-icon],
-// [property@AboutDialog:application-name],
-// [property@AboutDialog:developer-name] and [property@AboutDialog:version]
+// name and version. They can be set with the AboutDialog:application-icon,
+// AboutDialog:application-name,
+// AboutDialog:developer-name and AboutDialog:version
 // respectively.
 //
-// ## What's New
+// # What's New
 //
 // `AdwAboutDialog` provides a way for applications to display their release
-// notes, set with the [property@AboutDialog:release-notes] property.
+// notes, set with the AboutDialog:release-notes property.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -90,50 +82,50 @@ func ShowAboutDialogFromAppdata(ParentVar *gtk.Widget, ResourcePathVar string, R
 // Nested lists are not supported.
 //
 // Only one version can be shown at a time. By default, the displayed version
-// number matches [property@AboutDialog:version]. Use
-// [property@AboutDialog:release-notes-version] to override it.
+// number matches AboutDialog:version. Use
+// AboutDialog:release-notes-version to override it.
 //
-// ## Details
+// # Details
 //
 // The Details page displays the application comments and links.
 //
-// The comments can be set with the [property@AboutDialog:comments] property.
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// The comments can be set with the AboutDialog:comments property.
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
 //
-// To set the application website, use [property@AboutDialog:website].
-// To add extra links below the website, use [method@AboutDialog.add_link].
+// To set the application website, use AboutDialog:website.
+// To add extra links below the website, use add_link.
 //
 // If the Details page doesn't have any other content besides website, the
 // website will be displayed on the main page instead.
 //
-// ## Troubleshooting
+// # Troubleshooting
 //
 // `AdwAboutDialog` displays the following two links on the main page:
 //
-// * Support Questions, set with the [property@AboutDialog:support-url] property,
-// * Report an Issue, set with the [property@AboutDialog:issue-url] property.
+// * Support Questions, set with the AboutDialog:support-url property,
+// * Report an Issue, set with the AboutDialog:issue-url property.
 //
 // Additionally, applications can provide debugging information. It will be
 // shown separately on the Troubleshooting page. Use the
-// [property@AboutDialog:debug-info] property to specify it.
+// AboutDialog:debug-info property to specify it.
 //
 // It's intended to be attached to issue reports when reporting issues against
 // the application. As such, it cannot contain markup or links.
 //
 // `AdwAboutDialog` provides a quick way to save debug information to a file.
-// When saving, [property@AboutDialog:debug-info-filename] would be used as
+// When saving, AboutDialog:debug-info-filename would be used as
 // the suggested filename.
 //
-// ## Credits and Acknowledgements
+// # Credits and Acknowledgements
 //
 // The Credits page has the following default sections:
 //
-// * Developers, set with the [property@AboutDialog:developers] property,
-// * Designers, set with the [property@AboutDialog:designers] property,
-// * Artists, set with the [property@AboutDialog:artists] property,
-// * Documenters, set with the [property@AboutDialog:documenters] property,
-// * Translators, set with the [property@AboutDialog:translator-credits] property.
+// * Developers, set with the AboutDialog:developers property,
+// * Designers, set with the AboutDialog:designers property,
+// * Artists, set with the AboutDialog:artists property,
+// * Documenters, set with the AboutDialog:documenters property,
+// * Translators, set with the AboutDialog:translator-credits property.
 //
 // When setting translator credits, use the strings `"translator-credits"` or
 // `"translator_credits"` and mark them as translatable.
@@ -141,58 +133,49 @@ func ShowAboutDialogFromAppdata(ParentVar *gtk.Widget, ResourcePathVar string, R
 // The default sections that don't contain any names won't be displayed.
 //
 // The Credits page can also contain an arbitrary number of extra sections below
-// the default ones. Use [method@AboutDialog.add_credit_section] to add them.
+// the default ones. Use add_credit_section to add them.
 //
 // The Acknowledgements page can be used to acknowledge additional people and
 // organizations for their non-development contributions. Use
-// [method@AboutDialog.add_acknowledgement_section] to add sections to it. For
+// add_acknowledgement_section to add sections to it. For
 // example, it can be used to list backers in a crowdfunded project or to give
 // special thanks.
 //
 // Each of the people or organizations can have an email address or a website
 // specified. To add a email address, use a string like
-// `Edgar Allan Poe &lt;edgar@poe.com&gt;`. To specify a website with a title, use a
+// `Edgar Allan Poe <edgar@poe.com>`. To specify a website with a title, use a
 // string like `The GNOME Project https://www.gnome.org`:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="about-dialog-credits-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="about-dialog-credits.png" alt="about-dialog-credits"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Legal
+// # Legal
 //
 // The Legal page displays the copyright and licensing information for the
 // application and other modules.
 //
-// The copyright string is set with the [property@AboutDialog:copyright]
+// The copyright string is set with the AboutDialog:copyright
 // property and should be a short string of one or two lines, for example:
 // `© 2022 Example`.
 //
 // Licensing information can be quickly set from a list of known licenses with
-// the [property@AboutDialog:license-type] property. If the application's
-// license is not in the list, [property@AboutDialog:license] can be used
+// the AboutDialog:license-type property. If the application's
+// license is not in the list, AboutDialog:license can be used
 // instead.
 //
 // To add information about other modules, such as application dependencies or
-// data, use [method@AboutDialog.add_legal_section].
+// data, use add_legal_section.
 //
-// ## Other applications
+// # Other applications
 //
 // `AdwAboutDialog` can show links to your other apps at the end of the main
-// page. To add them, use [method@AboutDialog.add_other_app].
+// page. To add them, use add_other_app.
 //
-// ## Constructing
+// # Constructing
 //
 // To make constructing an `AdwAboutDialog` as convenient as possible, you can
-// use the function [func@show_about_dialog] which constructs and shows a
+// use the function show_about_dialog which constructs and shows a
 // dialog.
 //
-// ```c
-// static void
-// show_about (GtkApplication *app)
-//
+//	static void
+//	show_about (GtkApplication *app)
 //	{
 //	  const char *developers[] = {
 //	    "Angela Avery",
@@ -217,12 +200,12 @@ func ShowAboutDialogFromAppdata(ParentVar *gtk.Widget, ResourcePathVar string, R
 //	                         NULL);
 //	}
 //
-// ```
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwAboutDialog` has a main CSS node with the name `dialog` and the
 // style class `.about`.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 type AboutDialog struct {
 	Dialog
 }
@@ -263,21 +246,21 @@ var xNewAboutDialogFromAppdata func(string, uintptr) uintptr
 // This automatically sets the following properties with the following AppStream
 // values:
 //
-//   - [property@AboutDialog:application-icon] is set from the `&lt;id&gt;`
-//   - [property@AboutDialog:application-name] is set from the `&lt;name&gt;`
-//   - [property@AboutDialog:developer-name] is set from the `&lt;name&gt;` within
-//     `&lt;developer&gt;`
-//   - [property@AboutDialog:version] is set from the version of the latest release
-//   - [property@AboutDialog:website] is set from the `&lt;url type="homepage"&gt;`
-//   - [property@AboutDialog:support-url] is set from the `&lt;url type="help"&gt;`
-//   - [property@AboutDialog:issue-url] is set from the `&lt;url type="bugtracker"&gt;`
-//   - [property@AboutDialog:license-type] is set from the `&lt;project_license&gt;`.
+//   - AboutDialog:application-icon is set from the `<id>`
+//   - AboutDialog:application-name is set from the `<name>`
+//   - AboutDialog:developer-name is set from the `<name>` within
+//     `<developer>`
+//   - AboutDialog:version is set from the version of the latest release
+//   - AboutDialog:website is set from the `<url type="homepage">`
+//   - AboutDialog:support-url is set from the `<url type="help">`
+//   - AboutDialog:issue-url is set from the `<url type="bugtracker">`
+//   - AboutDialog:license-type is set from the `<project_license>`.
 //     If the license type retrieved from AppStream is not listed in
-//     [enum@Gtk.License], it will be set to `GTK_LICENCE_CUSTOM`.
+//     [gtk.License], it will be set to `GTK_LICENCE_CUSTOM`.
 //
-// If @release_notes_version is not `NULL`,
-// [property@AboutDialog:release-notes-version] is set to match it, while
-// [property@AboutDialog:release-notes] is set from the AppStream release
+// If release_notes_version is not `NULL`,
+// AboutDialog:release-notes-version is set to match it, while
+// AboutDialog:release-notes is set from the AppStream release
 // description for that version.
 func NewAboutDialogFromAppdata(ResourcePathVar string, ReleaseNotesVersionVar *string) *AboutDialog {
 	var cls *AboutDialog
@@ -309,12 +292,12 @@ var xAboutDialogAddAcknowledgementSection func(uintptr, uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
 func (x *AboutDialog) AddAcknowledgementSection(NameVar *string, PeopleVar []string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -335,12 +318,12 @@ var xAboutDialogAddCreditSection func(uintptr, uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_acknowledgement_section
 func (x *AboutDialog) AddCreditSection(NameVar *string, PeopleVar []string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -356,9 +339,9 @@ var xAboutDialogAddLegalSection func(uintptr, string, uintptr, gtk.License, uint
 //
 // Extra sections will be displayed below the application's own information.
 //
-// The parameters @copyright, @license_type and @license will be used to present
-// the it the same way as [property@AboutDialog:copyright],
-// [property@AboutDialog:license-type] and [property@AboutDialog:license] are
+// The parameters copyright, license_type and license will be used to present
+// the it the same way as AboutDialog:copyright,
+// AboutDialog:license-type and AboutDialog:license are
 // for the application's own information.
 //
 // See those properties for more details.
@@ -367,36 +350,29 @@ var xAboutDialogAddLegalSection func(uintptr, string, uintptr, gtk.License, uint
 //
 // Examples:
 //
-// ```c
-// adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
-//
-//	_("Copyright and a known license"),
-//	"© 2022 Example",
-//	GTK_LICENSE_LGPL_2_1,
-//	NULL);
-//
-// adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
-//
-//	_("Copyright and custom license"),
-//	"© 2022 Example",
-//	GTK_LICENSE_CUSTOM,
-//	"Custom license text");
-//
-// adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
-//
-//	_("Copyright only"),
-//	"© 2022 Example",
-//	GTK_LICENSE_UNKNOWN,
-//	NULL);
-//
-// adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
-//
-//	_("Custom license only"),
-//	NULL,
-//	GTK_LICENSE_CUSTOM,
-//	"Something completely custom here.");
-//
-// ```
+//	adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
+//	                                    _("Copyright and a known license"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_LGPL_2_1,
+//	                                    NULL);
+//
+//	adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
+//	                                    _("Copyright and custom license"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_CUSTOM,
+//	                                    "Custom license text");
+//
+//	adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
+//	                                    _("Copyright only"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_UNKNOWN,
+//	                                    NULL);
+//
+//	adw_about_dialog_add_legal_section (ADW_ABOUT_DIALOG (about),
+//	                                    _("Custom license only"),
+//	                                    NULL,
+//	                                    GTK_LICENSE_CUSTOM,
+//	                                    "Something completely custom here.");
 func (x *AboutDialog) AddLegalSection(TitleVar string, CopyrightVar *string, LicenseTypeVar gtk.License, LicenseVar *string) {
 
 	CopyrightVarPtr := core.GStrdupNullable(CopyrightVar)
@@ -415,9 +391,9 @@ var xAboutDialogAddLink func(uintptr, string, string)
 //
 // Extra links are displayed under the comment and website.
 //
-// Underlines in @title will be interpreted as indicating a mnemonic.
+// Underlines in title will be interpreted as indicating a mnemonic.
 //
-// See [property@AboutDialog:website].
+// See AboutDialog:website.
 func (x *AboutDialog) AddLink(TitleVar string, UrlVar string) {
 
 	xAboutDialogAddLink(x.GoPointer(), TitleVar, UrlVar)
@@ -426,25 +402,21 @@ func (x *AboutDialog) AddLink(TitleVar string, UrlVar string) {
 
 var xAboutDialogAddOtherApp func(uintptr, string, string, string)
 
-// Adds another application to @self.
+// Adds another application to self.
 //
 // The application will be displayed at the bottom of the main page, in a
 // separate section. Each added application will be presented as a row with
-// @title and @summary, as well as an icon with the name @appid. Clicking the
-// row will show @appid in the software center app.
+// title and summary, as well as an icon with the name appid. Clicking the
+// row will show appid in the software center app.
 //
 // This can be used to link to your other applications if you have multiple.
 //
 // Example:
 //
-// ```c
-// adw_about_dialog_add_other_app (ADW_ABOUT_DIALOG (about),
-//
-//	"org.gnome.Boxes",
-//	_("Boxes"),
-//	_("Virtualization made simple"));
-//
-// ```
+//	adw_about_dialog_add_other_app (ADW_ABOUT_DIALOG (about),
+//	                                "org.gnome.Boxes",
+//	                                _("Boxes"),
+//	                                _("Virtualization made simple"));
 func (x *AboutDialog) AddOtherApp(AppidVar string, NameVar string, SummaryVar string) {
 
 	xAboutDialogAddOtherApp(x.GoPointer(), AppidVar, NameVar, SummaryVar)
@@ -453,7 +425,7 @@ func (x *AboutDialog) AddOtherApp(AppidVar string, NameVar string, SummaryVar st
 
 var xAboutDialogGetApplicationIcon func(uintptr) string
 
-// Gets the name of the application icon for @self.
+// Gets the name of the application icon for self.
 func (x *AboutDialog) GetApplicationIcon() string {
 
 	cret := xAboutDialogGetApplicationIcon(x.GoPointer())
@@ -462,7 +434,7 @@ func (x *AboutDialog) GetApplicationIcon() string {
 
 var xAboutDialogGetApplicationName func(uintptr) string
 
-// Gets the application name for @self.
+// Gets the application name for self.
 func (x *AboutDialog) GetApplicationName() string {
 
 	cret := xAboutDialogGetApplicationName(x.GoPointer())
@@ -489,7 +461,7 @@ func (x *AboutDialog) GetComments() string {
 
 var xAboutDialogGetCopyright func(uintptr) string
 
-// Gets the copyright information for @self.
+// Gets the copyright information for self.
 func (x *AboutDialog) GetCopyright() string {
 
 	cret := xAboutDialogGetCopyright(x.GoPointer())
@@ -498,7 +470,7 @@ func (x *AboutDialog) GetCopyright() string {
 
 var xAboutDialogGetDebugInfo func(uintptr) string
 
-// Gets the debug information for @self.
+// Gets the debug information for self.
 func (x *AboutDialog) GetDebugInfo() string {
 
 	cret := xAboutDialogGetDebugInfo(x.GoPointer())
@@ -507,7 +479,7 @@ func (x *AboutDialog) GetDebugInfo() string {
 
 var xAboutDialogGetDebugInfoFilename func(uintptr) string
 
-// Gets the debug information filename for @self.
+// Gets the debug information filename for self.
 func (x *AboutDialog) GetDebugInfoFilename() string {
 
 	cret := xAboutDialogGetDebugInfoFilename(x.GoPointer())
@@ -525,7 +497,7 @@ func (x *AboutDialog) GetDesigners() []string {
 
 var xAboutDialogGetDeveloperName func(uintptr) string
 
-// Gets the developer name for @self.
+// Gets the developer name for self.
 func (x *AboutDialog) GetDeveloperName() string {
 
 	cret := xAboutDialogGetDeveloperName(x.GoPointer())
@@ -552,7 +524,7 @@ func (x *AboutDialog) GetDocumenters() []string {
 
 var xAboutDialogGetIssueUrl func(uintptr) string
 
-// Gets the issue tracker URL for @self.
+// Gets the issue tracker URL for self.
 func (x *AboutDialog) GetIssueUrl() string {
 
 	cret := xAboutDialogGetIssueUrl(x.GoPointer())
@@ -561,7 +533,7 @@ func (x *AboutDialog) GetIssueUrl() string {
 
 var xAboutDialogGetLicense func(uintptr) string
 
-// Gets the license for @self.
+// Gets the license for self.
 func (x *AboutDialog) GetLicense() string {
 
 	cret := xAboutDialogGetLicense(x.GoPointer())
@@ -570,7 +542,7 @@ func (x *AboutDialog) GetLicense() string {
 
 var xAboutDialogGetLicenseType func(uintptr) gtk.License
 
-// Gets the license type for @self.
+// Gets the license type for self.
 func (x *AboutDialog) GetLicenseType() gtk.License {
 
 	cret := xAboutDialogGetLicenseType(x.GoPointer())
@@ -579,7 +551,7 @@ func (x *AboutDialog) GetLicenseType() gtk.License {
 
 var xAboutDialogGetReleaseNotes func(uintptr) string
 
-// Gets the release notes for @self.
+// Gets the release notes for self.
 func (x *AboutDialog) GetReleaseNotes() string {
 
 	cret := xAboutDialogGetReleaseNotes(x.GoPointer())
@@ -597,7 +569,7 @@ func (x *AboutDialog) GetReleaseNotesVersion() string {
 
 var xAboutDialogGetSupportUrl func(uintptr) string
 
-// Gets the URL of the support page for @self.
+// Gets the URL of the support page for self.
 func (x *AboutDialog) GetSupportUrl() string {
 
 	cret := xAboutDialogGetSupportUrl(x.GoPointer())
@@ -615,7 +587,7 @@ func (x *AboutDialog) GetTranslatorCredits() string {
 
 var xAboutDialogGetVersion func(uintptr) string
 
-// Gets the version for @self.
+// Gets the version for self.
 func (x *AboutDialog) GetVersion() string {
 
 	cret := xAboutDialogGetVersion(x.GoPointer())
@@ -624,7 +596,7 @@ func (x *AboutDialog) GetVersion() string {
 
 var xAboutDialogGetWebsite func(uintptr) string
 
-// Gets the application website URL for @self.
+// Gets the application website URL for self.
 func (x *AboutDialog) GetWebsite() string {
 
 	cret := xAboutDialogGetWebsite(x.GoPointer())
@@ -633,7 +605,7 @@ func (x *AboutDialog) GetWebsite() string {
 
 var xAboutDialogSetApplicationIcon func(uintptr, string)
 
-// Sets the name of the application icon for @self.
+// Sets the name of the application icon for self.
 //
 // The icon is displayed at the top of the main page.
 func (x *AboutDialog) SetApplicationIcon(ApplicationIconVar string) {
@@ -644,7 +616,7 @@ func (x *AboutDialog) SetApplicationIcon(ApplicationIconVar string) {
 
 var xAboutDialogSetApplicationName func(uintptr, string)
 
-// Sets the application name for @self.
+// Sets the application name for self.
 //
 // The name is displayed at the top of the main page.
 func (x *AboutDialog) SetApplicationName(ApplicationNameVar string) {
@@ -664,12 +636,12 @@ var xAboutDialogSetArtists func(uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetArtists(ArtistsVar []string) {
 
 	xAboutDialogSetArtists(x.GoPointer(), ArtistsVar)
@@ -682,7 +654,7 @@ var xAboutDialogSetComments func(uintptr, string)
 //
 // Comments will be shown on the Details page, above links.
 //
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
 func (x *AboutDialog) SetComments(CommentsVar string) {
 
@@ -692,7 +664,7 @@ func (x *AboutDialog) SetComments(CommentsVar string) {
 
 var xAboutDialogSetCopyright func(uintptr, string)
 
-// Sets the copyright information for @self.
+// Sets the copyright information for self.
 //
 // This should be a short string of one or two lines, for example:
 // `© 2022 Example`.
@@ -700,7 +672,7 @@ var xAboutDialogSetCopyright func(uintptr, string)
 // The copyright information will be displayed on the Legal page, before the
 // application license.
 //
-// [method@AboutDialog.add_legal_section] can be used to add copyright
+// add_legal_section can be used to add copyright
 // information for the application dependencies or other components.
 func (x *AboutDialog) SetCopyright(CopyrightVar string) {
 
@@ -710,14 +682,14 @@ func (x *AboutDialog) SetCopyright(CopyrightVar string) {
 
 var xAboutDialogSetDebugInfo func(uintptr, string)
 
-// Sets the debug information for @self.
+// Sets the debug information for self.
 //
 // Debug information will be shown on the Troubleshooting page. It's intended
 // to be attached to issue reports when reporting issues against the
 // application.
 //
 // `AdwAboutDialog` provides a quick way to save debug information to a file.
-// When saving, [property@AboutDialog:debug-info-filename] would be used as
+// When saving, AboutDialog:debug-info-filename would be used as
 // the suggested filename.
 //
 // Debug information cannot contain markup or links.
@@ -729,12 +701,12 @@ func (x *AboutDialog) SetDebugInfo(DebugInfoVar string) {
 
 var xAboutDialogSetDebugInfoFilename func(uintptr, string)
 
-// Sets the debug information filename for @self.
+// Sets the debug information filename for self.
 //
 // It will be used as the suggested filename when saving debug information to a
 // file.
 //
-// See [property@AboutDialog:debug-info].
+// See AboutDialog:debug-info.
 func (x *AboutDialog) SetDebugInfoFilename(FilenameVar string) {
 
 	xAboutDialogSetDebugInfoFilename(x.GoPointer(), FilenameVar)
@@ -752,12 +724,12 @@ var xAboutDialogSetDesigners func(uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetDesigners(DesignersVar []string) {
 
 	xAboutDialogSetDesigners(x.GoPointer(), DesignersVar)
@@ -766,14 +738,14 @@ func (x *AboutDialog) SetDesigners(DesignersVar []string) {
 
 var xAboutDialogSetDeveloperName func(uintptr, string)
 
-// Sets the developer name for @self.
+// Sets the developer name for self.
 //
 // The developer name is displayed on the main page, under the application name.
 //
 // If the application is developed by multiple people, the developer name can be
 // set to values like "AppName team", "AppName developers" or
 // "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutDialog:developers] and related properties.
+// Credits page, with AboutDialog:developers and related properties.
 func (x *AboutDialog) SetDeveloperName(DeveloperNameVar string) {
 
 	xAboutDialogSetDeveloperName(x.GoPointer(), DeveloperNameVar)
@@ -791,12 +763,12 @@ var xAboutDialogSetDevelopers func(uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetDevelopers(DevelopersVar []string) {
 
 	xAboutDialogSetDevelopers(x.GoPointer(), DevelopersVar)
@@ -814,12 +786,12 @@ var xAboutDialogSetDocumenters func(uintptr, []string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetDocumenters(DocumentersVar []string) {
 
 	xAboutDialogSetDocumenters(x.GoPointer(), DocumentersVar)
@@ -828,7 +800,7 @@ func (x *AboutDialog) SetDocumenters(DocumentersVar []string) {
 
 var xAboutDialogSetIssueUrl func(uintptr, string)
 
-// Sets the issue tracker URL for @self.
+// Sets the issue tracker URL for self.
 //
 // The issue tracker link is displayed on the main page.
 func (x *AboutDialog) SetIssueUrl(IssueUrlVar string) {
@@ -839,12 +811,12 @@ func (x *AboutDialog) SetIssueUrl(IssueUrlVar string) {
 
 var xAboutDialogSetLicense func(uintptr, string)
 
-// Sets the license for @self.
+// Sets the license for self.
 //
 // This can be used to set a custom text for the license if it can't be set via
-// [property@AboutDialog:license-type].
+// AboutDialog:license-type.
 //
-// When set, [property@AboutDialog:license-type] will be set to
+// When set, AboutDialog:license-type will be set to
 // `GTK_LICENSE_CUSTOM`.
 //
 // The license text will be displayed on the Legal page, below the copyright
@@ -852,7 +824,7 @@ var xAboutDialogSetLicense func(uintptr, string)
 //
 // License text can contain Pango markup and links.
 //
-// [method@AboutDialog.add_legal_section] can be used to add license information
+// add_legal_section can be used to add license information
 // for the application dependencies or other components.
 func (x *AboutDialog) SetLicense(LicenseVar string) {
 
@@ -862,21 +834,21 @@ func (x *AboutDialog) SetLicense(LicenseVar string) {
 
 var xAboutDialogSetLicenseType func(uintptr, gtk.License)
 
-// Sets the license for @self from a list of known licenses.
+// Sets the license for self from a list of known licenses.
 //
 // If the application's license is not in the list,
-// [property@AboutDialog:license] can be used instead. The license type will be
+// AboutDialog:license can be used instead. The license type will be
 // automatically set to `GTK_LICENSE_CUSTOM` in that case.
 //
-// If @license_type is `GTK_LICENSE_UNKNOWN`, no information will be displayed.
+// If license_type is `GTK_LICENSE_UNKNOWN`, no information will be displayed.
 //
-// If @license_type is different from `GTK_LICENSE_CUSTOM`.
-// [property@AboutDialog:license] will be cleared out.
+// If license_type is different from `GTK_LICENSE_CUSTOM`.
+// AboutDialog:license will be cleared out.
 //
 // The license description will be displayed on the Legal page, below the
 // copyright information.
 //
-// [method@AboutDialog.add_legal_section] can be used to add license information
+// add_legal_section can be used to add license information
 // for the application dependencies or other components.
 func (x *AboutDialog) SetLicenseType(LicenseTypeVar gtk.License) {
 
@@ -886,21 +858,21 @@ func (x *AboutDialog) SetLicenseType(LicenseTypeVar gtk.License) {
 
 var xAboutDialogSetReleaseNotes func(uintptr, string)
 
-// Sets the release notes for @self.
+// Sets the release notes for self.
 //
 // Release notes are displayed on the the What's New page.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -908,8 +880,10 @@ var xAboutDialogSetReleaseNotes func(uintptr, string)
 // Nested lists are not supported.
 //
 // `AdwAboutDialog` displays the version above the release notes. If set, the
-// [property@AboutDialog:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutDialog:version] is used.
+// AboutDialog:release-notes-version of the property will be used
+// as the version; otherwise, AboutDialog:version is used.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 func (x *AboutDialog) SetReleaseNotes(ReleaseNotesVar string) {
 
 	xAboutDialogSetReleaseNotes(x.GoPointer(), ReleaseNotesVar)
@@ -923,13 +897,13 @@ var xAboutDialogSetReleaseNotesVersion func(uintptr, string)
 // The release notes version is displayed on the What's New page, above the
 // release notes.
 //
-// If not set, [property@AboutDialog:version] will be used instead.
+// If not set, AboutDialog:version will be used instead.
 //
 // For example, an application with the current version 2.0.2 might want to
 // keep the release notes from 2.0.0, and set the release notes version
 // accordingly.
 //
-// See [property@AboutDialog:release-notes].
+// See AboutDialog:release-notes.
 func (x *AboutDialog) SetReleaseNotesVersion(VersionVar string) {
 
 	xAboutDialogSetReleaseNotesVersion(x.GoPointer(), VersionVar)
@@ -938,7 +912,7 @@ func (x *AboutDialog) SetReleaseNotesVersion(VersionVar string) {
 
 var xAboutDialogSetSupportUrl func(uintptr, string)
 
-// Sets the URL of the support page for @self.
+// Sets the URL of the support page for self.
 //
 // The support page link is displayed on the main page.
 func (x *AboutDialog) SetSupportUrl(SupportUrlVar string) {
@@ -962,12 +936,12 @@ var xAboutDialogSetTranslatorCredits func(uintptr, string)
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetTranslatorCredits(TranslatorCreditsVar string) {
 
 	xAboutDialogSetTranslatorCredits(x.GoPointer(), TranslatorCreditsVar)
@@ -976,11 +950,11 @@ func (x *AboutDialog) SetTranslatorCredits(TranslatorCreditsVar string) {
 
 var xAboutDialogSetVersion func(uintptr, string)
 
-// Sets the version for @self.
+// Sets the version for self.
 //
 // The version is displayed on the main page.
 //
-// If [property@AboutDialog:release-notes-version] is not set, the version will
+// If AboutDialog:release-notes-version is not set, the version will
 // also be displayed above the release notes on the What's New page.
 func (x *AboutDialog) SetVersion(VersionVar string) {
 
@@ -990,12 +964,12 @@ func (x *AboutDialog) SetVersion(VersionVar string) {
 
 var xAboutDialogSetWebsite func(uintptr, string)
 
-// Sets the application website URL for @self.
+// Sets the application website URL for self.
 //
 // Website is displayed on the Details page, below comments, or on the main page
 // if the Details page doesn't have any other content.
 //
-// Applications can add other links below, see [method@AboutDialog.add_link].
+// Applications can add other links below, see add_link.
 func (x *AboutDialog) SetWebsite(WebsiteVar string) {
 
 	xAboutDialogSetWebsite(x.GoPointer(), WebsiteVar)
@@ -1065,12 +1039,12 @@ func (x *AboutDialog) GetPropertyApplicationName() string {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetPropertyArtists(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1088,12 +1062,12 @@ func (x *AboutDialog) SetPropertyArtists(value []string) {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) GetPropertyArtists() []string {
 	var v gobject.Value
 	x.GetProperty("artists", &v)
@@ -1105,7 +1079,7 @@ func (x *AboutDialog) GetPropertyArtists() []string {
 //
 // Comments will be shown on the Details page, above links.
 //
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
 func (x *AboutDialog) SetPropertyComments(value string) {
 	var v gobject.Value
@@ -1119,7 +1093,7 @@ func (x *AboutDialog) SetPropertyComments(value string) {
 //
 // Comments will be shown on the Details page, above links.
 //
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
 func (x *AboutDialog) GetPropertyComments() string {
 	var v gobject.Value
@@ -1136,7 +1110,7 @@ func (x *AboutDialog) GetPropertyComments() string {
 // The copyright information will be displayed on the Legal page, above the
 // application license.
 //
-// [method@AboutDialog.add_legal_section] can be used to add copyright
+// add_legal_section can be used to add copyright
 // information for the application dependencies or other components.
 func (x *AboutDialog) SetPropertyCopyright(value string) {
 	var v gobject.Value
@@ -1154,7 +1128,7 @@ func (x *AboutDialog) SetPropertyCopyright(value string) {
 // The copyright information will be displayed on the Legal page, above the
 // application license.
 //
-// [method@AboutDialog.add_legal_section] can be used to add copyright
+// add_legal_section can be used to add copyright
 // information for the application dependencies or other components.
 func (x *AboutDialog) GetPropertyCopyright() string {
 	var v gobject.Value
@@ -1170,7 +1144,7 @@ func (x *AboutDialog) GetPropertyCopyright() string {
 // application.
 //
 // `AdwAboutDialog` provides a quick way to save debug information to a file.
-// When saving, [property@AboutDialog:debug-info-filename] would be used as
+// When saving, AboutDialog:debug-info-filename would be used as
 // the suggested filename.
 //
 // Debug information cannot contain markup or links.
@@ -1189,7 +1163,7 @@ func (x *AboutDialog) SetPropertyDebugInfo(value string) {
 // application.
 //
 // `AdwAboutDialog` provides a quick way to save debug information to a file.
-// When saving, [property@AboutDialog:debug-info-filename] would be used as
+// When saving, AboutDialog:debug-info-filename would be used as
 // the suggested filename.
 //
 // Debug information cannot contain markup or links.
@@ -1205,7 +1179,7 @@ func (x *AboutDialog) GetPropertyDebugInfo() string {
 // It will be used as the suggested filename when saving debug information to
 // a file.
 //
-// See [property@AboutDialog:debug-info].
+// See AboutDialog:debug-info.
 func (x *AboutDialog) SetPropertyDebugInfoFilename(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1219,7 +1193,7 @@ func (x *AboutDialog) SetPropertyDebugInfoFilename(value string) {
 // It will be used as the suggested filename when saving debug information to
 // a file.
 //
-// See [property@AboutDialog:debug-info].
+// See AboutDialog:debug-info.
 func (x *AboutDialog) GetPropertyDebugInfoFilename() string {
 	var v gobject.Value
 	x.GetProperty("debug-info-filename", &v)
@@ -1236,12 +1210,12 @@ func (x *AboutDialog) GetPropertyDebugInfoFilename() string {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetPropertyDesigners(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1259,12 +1233,12 @@ func (x *AboutDialog) SetPropertyDesigners(value []string) {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) GetPropertyDesigners() []string {
 	var v gobject.Value
 	x.GetProperty("designers", &v)
@@ -1280,7 +1254,7 @@ func (x *AboutDialog) GetPropertyDesigners() []string {
 // If the application is developed by multiple people, the developer name can
 // be set to values like "AppName team", "AppName developers" or
 // "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutDialog:developers] and related
+// Credits page, with AboutDialog:developers and related
 // properties.
 func (x *AboutDialog) SetPropertyDeveloperName(value string) {
 	var v gobject.Value
@@ -1298,7 +1272,7 @@ func (x *AboutDialog) SetPropertyDeveloperName(value string) {
 // If the application is developed by multiple people, the developer name can
 // be set to values like "AppName team", "AppName developers" or
 // "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutDialog:developers] and related
+// Credits page, with AboutDialog:developers and related
 // properties.
 func (x *AboutDialog) GetPropertyDeveloperName() string {
 	var v gobject.Value
@@ -1316,12 +1290,12 @@ func (x *AboutDialog) GetPropertyDeveloperName() string {
 //
 // See also:
 //
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetPropertyDevelopers(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1339,12 +1313,12 @@ func (x *AboutDialog) SetPropertyDevelopers(value []string) {
 //
 // See also:
 //
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) GetPropertyDevelopers() []string {
 	var v gobject.Value
 	x.GetProperty("developers", &v)
@@ -1361,12 +1335,12 @@ func (x *AboutDialog) GetPropertyDevelopers() []string {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetPropertyDocumenters(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1384,12 +1358,12 @@ func (x *AboutDialog) SetPropertyDocumenters(value []string) {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:translator-credits]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) GetPropertyDocumenters() []string {
 	var v gobject.Value
 	x.GetProperty("documenters", &v)
@@ -1421,9 +1395,9 @@ func (x *AboutDialog) GetPropertyIssueUrl() string {
 // The license text.
 //
 // This can be used to set a custom text for the license if it can't be set
-// via [property@AboutDialog:license-type].
+// via AboutDialog:license-type.
 //
-// When set, [property@AboutDialog:license-type] will be set to
+// When set, AboutDialog:license-type will be set to
 // `GTK_LICENSE_CUSTOM`.
 //
 // The license text will be displayed on the Legal page, below the copyright
@@ -1431,7 +1405,7 @@ func (x *AboutDialog) GetPropertyIssueUrl() string {
 //
 // License text can contain Pango markup and links.
 //
-// [method@AboutDialog.add_legal_section] can be used to add license
+// add_legal_section can be used to add license
 // information for the application dependencies or other components.
 func (x *AboutDialog) SetPropertyLicense(value string) {
 	var v gobject.Value
@@ -1444,9 +1418,9 @@ func (x *AboutDialog) SetPropertyLicense(value string) {
 // The license text.
 //
 // This can be used to set a custom text for the license if it can't be set
-// via [property@AboutDialog:license-type].
+// via AboutDialog:license-type.
 //
-// When set, [property@AboutDialog:license-type] will be set to
+// When set, AboutDialog:license-type will be set to
 // `GTK_LICENSE_CUSTOM`.
 //
 // The license text will be displayed on the Legal page, below the copyright
@@ -1454,7 +1428,7 @@ func (x *AboutDialog) SetPropertyLicense(value string) {
 //
 // License text can contain Pango markup and links.
 //
-// [method@AboutDialog.add_legal_section] can be used to add license
+// add_legal_section can be used to add license
 // information for the application dependencies or other components.
 func (x *AboutDialog) GetPropertyLicense() string {
 	var v gobject.Value
@@ -1468,16 +1442,16 @@ func (x *AboutDialog) GetPropertyLicense() string {
 // Release notes are displayed on the the What's New page.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -1485,8 +1459,10 @@ func (x *AboutDialog) GetPropertyLicense() string {
 // Nested lists are not supported.
 //
 // `AdwAboutDialog` displays the version above the release notes. If set, the
-// [property@AboutDialog:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutDialog:version] is used.
+// AboutDialog:release-notes-version of the property will be used
+// as the version; otherwise, AboutDialog:version is used.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 func (x *AboutDialog) SetPropertyReleaseNotes(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1500,16 +1476,16 @@ func (x *AboutDialog) SetPropertyReleaseNotes(value string) {
 // Release notes are displayed on the the What's New page.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -1517,8 +1493,10 @@ func (x *AboutDialog) SetPropertyReleaseNotes(value string) {
 // Nested lists are not supported.
 //
 // `AdwAboutDialog` displays the version above the release notes. If set, the
-// [property@AboutDialog:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutDialog:version] is used.
+// AboutDialog:release-notes-version of the property will be used
+// as the version; otherwise, AboutDialog:version is used.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 func (x *AboutDialog) GetPropertyReleaseNotes() string {
 	var v gobject.Value
 	x.GetProperty("release-notes", &v)
@@ -1531,13 +1509,13 @@ func (x *AboutDialog) GetPropertyReleaseNotes() string {
 // The release notes version is displayed on the What's New page, above the
 // release notes.
 //
-// If not set, [property@AboutDialog:version] will be used instead.
+// If not set, AboutDialog:version will be used instead.
 //
 // For example, an application with the current version 2.0.2 might want to
 // keep the release notes from 2.0.0, and set the release notes version
 // accordingly.
 //
-// See [property@AboutDialog:release-notes].
+// See AboutDialog:release-notes.
 func (x *AboutDialog) SetPropertyReleaseNotesVersion(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1551,13 +1529,13 @@ func (x *AboutDialog) SetPropertyReleaseNotesVersion(value string) {
 // The release notes version is displayed on the What's New page, above the
 // release notes.
 //
-// If not set, [property@AboutDialog:version] will be used instead.
+// If not set, AboutDialog:version will be used instead.
 //
 // For example, an application with the current version 2.0.2 might want to
 // keep the release notes from 2.0.0, and set the release notes version
 // accordingly.
 //
-// See [property@AboutDialog:release-notes].
+// See AboutDialog:release-notes.
 func (x *AboutDialog) GetPropertyReleaseNotesVersion() string {
 	var v gobject.Value
 	x.GetProperty("release-notes-version", &v)
@@ -1598,12 +1576,12 @@ func (x *AboutDialog) GetPropertySupportUrl() string {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) SetPropertyTranslatorCredits(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1624,12 +1602,12 @@ func (x *AboutDialog) SetPropertyTranslatorCredits(value string) {
 //
 // See also:
 //
-// * [property@AboutDialog:developers]
-// * [property@AboutDialog:designers]
-// * [property@AboutDialog:artists]
-// * [property@AboutDialog:documenters]
-// * [method@AboutDialog.add_credit_section]
-// * [method@AboutDialog.add_acknowledgement_section]
+// * AboutDialog:developers
+// * AboutDialog:designers
+// * AboutDialog:artists
+// * AboutDialog:documenters
+// * add_credit_section
+// * add_acknowledgement_section
 func (x *AboutDialog) GetPropertyTranslatorCredits() string {
 	var v gobject.Value
 	x.GetProperty("translator-credits", &v)
@@ -1641,7 +1619,7 @@ func (x *AboutDialog) GetPropertyTranslatorCredits() string {
 //
 // The version is displayed on the main page.
 //
-// If [property@AboutDialog:release-notes-version] is not set, the version
+// If AboutDialog:release-notes-version is not set, the version
 // will also be displayed above the release notes on the What's New page.
 func (x *AboutDialog) SetPropertyVersion(value string) {
 	var v gobject.Value
@@ -1655,7 +1633,7 @@ func (x *AboutDialog) SetPropertyVersion(value string) {
 //
 // The version is displayed on the main page.
 //
-// If [property@AboutDialog:release-notes-version] is not set, the version
+// If AboutDialog:release-notes-version is not set, the version
 // will also be displayed above the release notes on the What's New page.
 func (x *AboutDialog) GetPropertyVersion() string {
 	var v gobject.Value
@@ -1669,7 +1647,7 @@ func (x *AboutDialog) GetPropertyVersion() string {
 // Website is displayed on the Details page, below comments, or on the main
 // page if the Details page doesn't have any other content.
 //
-// Applications can add other links below, see [method@AboutDialog.add_link].
+// Applications can add other links below, see add_link.
 func (x *AboutDialog) SetPropertyWebsite(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1683,7 +1661,7 @@ func (x *AboutDialog) SetPropertyWebsite(value string) {
 // Website is displayed on the Details page, below comments, or on the main
 // page if the Details page doesn't have any other content.
 //
-// Applications can add other links below, see [method@AboutDialog.add_link].
+// Applications can add other links below, see add_link.
 func (x *AboutDialog) GetPropertyWebsite() string {
 	var v gobject.Value
 	x.GetProperty("website", &v)
@@ -1693,28 +1671,50 @@ func (x *AboutDialog) GetPropertyWebsite() string {
 // Emitted when a URL is activated.
 //
 // Applications may connect to it to override the default behavior, which is
-// to call [func@Gtk.show_uri].
+// to call [gtk.ShowUri].
+//
+// Deprecated: use ConnectActivateLinkFunc, which also accepts method values and closures.
 func (x *AboutDialog) ConnectActivateLink(cb *func(AboutDialog, string) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, UriVarp string) bool {
+			defer core.RecoverPanic()
+			fa := AboutDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, UriVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-link", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, UriVarp string) bool {
+var xAboutDialogActivateLinkTrampoline uintptr
+
+func xAboutDialogActivateLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, UriVarp string, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AboutDialog, string) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AboutDialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, UriVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateLinkFunc connects cb to the "activate-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AboutDialog) ConnectActivateLinkFunc(cb func(AboutDialog, string) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-link", &xAboutDialogActivateLinkTrampoline, xAboutDialogActivateLinkNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1878,7 +1878,7 @@ func (x *AboutDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1886,14 +1886,13 @@ func (x *AboutDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1917,19 +1916,17 @@ func (x *AboutDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1950,7 +1947,7 @@ func (x *AboutDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1959,14 +1956,12 @@ func (x *AboutDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1985,10 +1980,10 @@ func (x *AboutDialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AboutDialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -2000,65 +1995,67 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xShowAboutDialog, libs, "adw_show_about_dialog")
-	core.PuregoSafeRegister(&xShowAboutDialogFromAppdata, libs, "adw_show_about_dialog_from_appdata")
+	core.PuregoRegisterSince(&xShowAboutDialog, libs, "adw_show_about_dialog", "ADW", "1.5")
+	core.PuregoRegisterSince(&xShowAboutDialogFromAppdata, libs, "adw_show_about_dialog_from_appdata", "ADW", "1.5")
 
 	core.PuregoSafeRegister(&xAboutDialogGLibType, libs, "adw_about_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewAboutDialog, libs, "adw_about_dialog_new")
-	core.PuregoSafeRegister(&xNewAboutDialogFromAppdata, libs, "adw_about_dialog_new_from_appdata")
-
-	core.PuregoSafeRegister(&xAboutDialogAddAcknowledgementSection, libs, "adw_about_dialog_add_acknowledgement_section")
-	core.PuregoSafeRegister(&xAboutDialogAddCreditSection, libs, "adw_about_dialog_add_credit_section")
-	core.PuregoSafeRegister(&xAboutDialogAddLegalSection, libs, "adw_about_dialog_add_legal_section")
-	core.PuregoSafeRegister(&xAboutDialogAddLink, libs, "adw_about_dialog_add_link")
-	core.PuregoSafeRegister(&xAboutDialogAddOtherApp, libs, "adw_about_dialog_add_other_app")
-	core.PuregoSafeRegister(&xAboutDialogGetApplicationIcon, libs, "adw_about_dialog_get_application_icon")
-	core.PuregoSafeRegister(&xAboutDialogGetApplicationName, libs, "adw_about_dialog_get_application_name")
-	core.PuregoSafeRegister(&xAboutDialogGetArtists, libs, "adw_about_dialog_get_artists")
-	core.PuregoSafeRegister(&xAboutDialogGetComments, libs, "adw_about_dialog_get_comments")
-	core.PuregoSafeRegister(&xAboutDialogGetCopyright, libs, "adw_about_dialog_get_copyright")
-	core.PuregoSafeRegister(&xAboutDialogGetDebugInfo, libs, "adw_about_dialog_get_debug_info")
-	core.PuregoSafeRegister(&xAboutDialogGetDebugInfoFilename, libs, "adw_about_dialog_get_debug_info_filename")
-	core.PuregoSafeRegister(&xAboutDialogGetDesigners, libs, "adw_about_dialog_get_designers")
-	core.PuregoSafeRegister(&xAboutDialogGetDeveloperName, libs, "adw_about_dialog_get_developer_name")
-	core.PuregoSafeRegister(&xAboutDialogGetDevelopers, libs, "adw_about_dialog_get_developers")
-	core.PuregoSafeRegister(&xAboutDialogGetDocumenters, libs, "adw_about_dialog_get_documenters")
-	core.PuregoSafeRegister(&xAboutDialogGetIssueUrl, libs, "adw_about_dialog_get_issue_url")
-	core.PuregoSafeRegister(&xAboutDialogGetLicense, libs, "adw_about_dialog_get_license")
-	core.PuregoSafeRegister(&xAboutDialogGetLicenseType, libs, "adw_about_dialog_get_license_type")
-	core.PuregoSafeRegister(&xAboutDialogGetReleaseNotes, libs, "adw_about_dialog_get_release_notes")
-	core.PuregoSafeRegister(&xAboutDialogGetReleaseNotesVersion, libs, "adw_about_dialog_get_release_notes_version")
-	core.PuregoSafeRegister(&xAboutDialogGetSupportUrl, libs, "adw_about_dialog_get_support_url")
-	core.PuregoSafeRegister(&xAboutDialogGetTranslatorCredits, libs, "adw_about_dialog_get_translator_credits")
-	core.PuregoSafeRegister(&xAboutDialogGetVersion, libs, "adw_about_dialog_get_version")
-	core.PuregoSafeRegister(&xAboutDialogGetWebsite, libs, "adw_about_dialog_get_website")
-	core.PuregoSafeRegister(&xAboutDialogSetApplicationIcon, libs, "adw_about_dialog_set_application_icon")
-	core.PuregoSafeRegister(&xAboutDialogSetApplicationName, libs, "adw_about_dialog_set_application_name")
-	core.PuregoSafeRegister(&xAboutDialogSetArtists, libs, "adw_about_dialog_set_artists")
-	core.PuregoSafeRegister(&xAboutDialogSetComments, libs, "adw_about_dialog_set_comments")
-	core.PuregoSafeRegister(&xAboutDialogSetCopyright, libs, "adw_about_dialog_set_copyright")
-	core.PuregoSafeRegister(&xAboutDialogSetDebugInfo, libs, "adw_about_dialog_set_debug_info")
-	core.PuregoSafeRegister(&xAboutDialogSetDebugInfoFilename, libs, "adw_about_dialog_set_debug_info_filename")
-	core.PuregoSafeRegister(&xAboutDialogSetDesigners, libs, "adw_about_dialog_set_designers")
-	core.PuregoSafeRegister(&xAboutDialogSetDeveloperName, libs, "adw_about_dialog_set_developer_name")
-	core.PuregoSafeRegister(&xAboutDialogSetDevelopers, libs, "adw_about_dialog_set_developers")
-	core.PuregoSafeRegister(&xAboutDialogSetDocumenters, libs, "adw_about_dialog_set_documenters")
-	core.PuregoSafeRegister(&xAboutDialogSetIssueUrl, libs, "adw_about_dialog_set_issue_url")
-	core.PuregoSafeRegister(&xAboutDialogSetLicense, libs, "adw_about_dialog_set_license")
-	core.PuregoSafeRegister(&xAboutDialogSetLicenseType, libs, "adw_about_dialog_set_license_type")
-	core.PuregoSafeRegister(&xAboutDialogSetReleaseNotes, libs, "adw_about_dialog_set_release_notes")
-	core.PuregoSafeRegister(&xAboutDialogSetReleaseNotesVersion, libs, "adw_about_dialog_set_release_notes_version")
-	core.PuregoSafeRegister(&xAboutDialogSetSupportUrl, libs, "adw_about_dialog_set_support_url")
-	core.PuregoSafeRegister(&xAboutDialogSetTranslatorCredits, libs, "adw_about_dialog_set_translator_credits")
-	core.PuregoSafeRegister(&xAboutDialogSetVersion, libs, "adw_about_dialog_set_version")
-	core.PuregoSafeRegister(&xAboutDialogSetWebsite, libs, "adw_about_dialog_set_website")
+	gobject.CheckClassSize("AdwAboutDialogClass", xAboutDialogGLibType, unsafe.Sizeof(AboutDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewAboutDialog, libs, "adw_about_dialog_new", "ADW", "1.5")
+	core.PuregoRegisterSince(&xNewAboutDialogFromAppdata, libs, "adw_about_dialog_new_from_appdata", "ADW", "1.5")
+
+	core.PuregoRegisterSince(&xAboutDialogAddAcknowledgementSection, libs, "adw_about_dialog_add_acknowledgement_section", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogAddCreditSection, libs, "adw_about_dialog_add_credit_section", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogAddLegalSection, libs, "adw_about_dialog_add_legal_section", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogAddLink, libs, "adw_about_dialog_add_link", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogAddOtherApp, libs, "adw_about_dialog_add_other_app", "ADW", "1.7")
+	core.PuregoRegisterSince(&xAboutDialogGetApplicationIcon, libs, "adw_about_dialog_get_application_icon", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetApplicationName, libs, "adw_about_dialog_get_application_name", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetArtists, libs, "adw_about_dialog_get_artists", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetComments, libs, "adw_about_dialog_get_comments", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetCopyright, libs, "adw_about_dialog_get_copyright", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDebugInfo, libs, "adw_about_dialog_get_debug_info", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDebugInfoFilename, libs, "adw_about_dialog_get_debug_info_filename", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDesigners, libs, "adw_about_dialog_get_designers", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDeveloperName, libs, "adw_about_dialog_get_developer_name", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDevelopers, libs, "adw_about_dialog_get_developers", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetDocumenters, libs, "adw_about_dialog_get_documenters", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetIssueUrl, libs, "adw_about_dialog_get_issue_url", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetLicense, libs, "adw_about_dialog_get_license", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetLicenseType, libs, "adw_about_dialog_get_license_type", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetReleaseNotes, libs, "adw_about_dialog_get_release_notes", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetReleaseNotesVersion, libs, "adw_about_dialog_get_release_notes_version", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetSupportUrl, libs, "adw_about_dialog_get_support_url", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetTranslatorCredits, libs, "adw_about_dialog_get_translator_credits", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetVersion, libs, "adw_about_dialog_get_version", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogGetWebsite, libs, "adw_about_dialog_get_website", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetApplicationIcon, libs, "adw_about_dialog_set_application_icon", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetApplicationName, libs, "adw_about_dialog_set_application_name", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetArtists, libs, "adw_about_dialog_set_artists", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetComments, libs, "adw_about_dialog_set_comments", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetCopyright, libs, "adw_about_dialog_set_copyright", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDebugInfo, libs, "adw_about_dialog_set_debug_info", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDebugInfoFilename, libs, "adw_about_dialog_set_debug_info_filename", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDesigners, libs, "adw_about_dialog_set_designers", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDeveloperName, libs, "adw_about_dialog_set_developer_name", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDevelopers, libs, "adw_about_dialog_set_developers", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetDocumenters, libs, "adw_about_dialog_set_documenters", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetIssueUrl, libs, "adw_about_dialog_set_issue_url", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetLicense, libs, "adw_about_dialog_set_license", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetLicenseType, libs, "adw_about_dialog_set_license_type", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetReleaseNotes, libs, "adw_about_dialog_set_release_notes", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetReleaseNotesVersion, libs, "adw_about_dialog_set_release_notes_version", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetSupportUrl, libs, "adw_about_dialog_set_support_url", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetTranslatorCredits, libs, "adw_about_dialog_set_translator_credits", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetVersion, libs, "adw_about_dialog_set_version", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAboutDialogSetWebsite, libs, "adw_about_dialog_set_website", "ADW", "1.5")
 
 }
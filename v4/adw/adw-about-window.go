@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -25,66 +24,35 @@ func (x *AboutWindowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xShowAboutWindow func(uintptr, string, ...interface{})
-
-// A convenience function for showing an application’s about window.
-func ShowAboutWindow(ParentVar *gtk.Window, FirstPropertyNameVar string, varArgs ...interface{}) {
-
-	xShowAboutWindow(ParentVar.GoPointer(), FirstPropertyNameVar, varArgs...)
-
-}
-
-var xShowAboutWindowFromAppdata func(uintptr, string, uintptr, string, ...interface{})
-
-// A convenience function for showing an application’s about window from
-// AppStream metadata.
-//
-// See [ctor@AboutWindow.new_from_appdata] for details.
-func ShowAboutWindowFromAppdata(ParentVar *gtk.Window, ResourcePathVar string, ReleaseNotesVersionVar *string, FirstPropertyNameVar string, varArgs ...interface{}) {
-
-	ReleaseNotesVersionVarPtr := core.GStrdupNullable(ReleaseNotesVersionVar)
-	defer core.GFreeNullable(ReleaseNotesVersionVarPtr)
-
-	xShowAboutWindowFromAppdata(ParentVar.GoPointer(), ResourcePathVar, ReleaseNotesVersionVarPtr, FirstPropertyNameVar, varArgs...)
-
-}
-
 // A window showing information about the application.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="about-window-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="about-window.png" alt="about-window"&gt;
-//
-// &lt;/picture&gt;
-//
 // An about window is typically opened when the user activates the `About …`
 // item in the application's primary menu. All parts of the window are optional.
 //
-// ## Main page
+// # Main page
 //
 // `AdwAboutWindow` prominently displays the application's icon, name, developer
-// name and version. They can be set with the [property@AboutWindow:application-icon],
-// [property@AboutWindow:application-name],
-// [property@AboutWindow:developer-name] and [property@AboutWindow:version]
+// name and version. They can be set with the AboutWindow:application-icon,
+// AboutWindow:application-name,
+// AboutWindow:developer-name and AboutWindow:version
 // respectively.
 //
-// ## What's New
+// # What's New
 //
 // `AdwAboutWindow` provides a way for applications to display their release
-// notes, set with the [property@AboutWindow:release-notes] property.
+// notes, set with the AboutWindow:release-notes property.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -92,50 +60,50 @@ func ShowAboutWindowFromAppdata(ParentVar *gtk.Window, ResourcePathVar string, R
 // Nested lists are not supported.
 //
 // Only one version can be shown at a time. By default, the displayed version
-// number matches [property@AboutWindow:version]. Use
-// [property@AboutWindow:release-notes-version] to override it.
+// number matches AboutWindow:version. Use
+// AboutWindow:release-notes-version to override it.
 //
-// ## Details
+// # Details
 //
 // The Details page displays the application comments and links.
 //
-// The comments can be set with the [property@AboutWindow:comments] property.
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// The comments can be set with the AboutWindow:comments property.
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
 //
-// To set the application website, use [property@AboutWindow:website].
-// To add extra links below the website, use [method@AboutWindow.add_link].
+// To set the application website, use AboutWindow:website.
+// To add extra links below the website, use add_link.
 //
 // If the Details page doesn't have any other content besides website, the
 // website will be displayed on the main page instead.
 //
-// ## Troubleshooting
+// # Troubleshooting
 //
 // `AdwAboutWindow` displays the following two links on the main page:
 //
-// * Support Questions, set with the [property@AboutWindow:support-url] property,
-// * Report an Issue, set with the [property@AboutWindow:issue-url] property.
+// * Support Questions, set with the AboutWindow:support-url property,
+// * Report an Issue, set with the AboutWindow:issue-url property.
 //
 // Additionally, applications can provide debugging information. It will be
 // shown separately on the Troubleshooting page. Use the
-// [property@AboutWindow:debug-info] property to specify it.
+// AboutWindow:debug-info property to specify it.
 //
 // It's intended to be attached to issue reports when reporting issues against
 // the application. As such, it cannot contain markup or links.
 //
 // `AdwAboutWindow` provides a quick way to save debug information to a file.
-// When saving, [property@AboutWindow:debug-info-filename] would be used as
+// When saving, AboutWindow:debug-info-filename would be used as
 // the suggested filename.
 //
-// ## Credits and Acknowledgements
+// # Credits and Acknowledgements
 //
 // The Credits page has the following default sections:
 //
-// * Developers, set with the [property@AboutWindow:developers] property,
-// * Designers, set with the [property@AboutWindow:designers] property,
-// * Artists, set with the [property@AboutWindow:artists] property,
-// * Documenters, set with the [property@AboutWindow:documenters] property,
-// * Translators, set with the [property@AboutWindow:translator-credits] property.
+// * Developers, set with the AboutWindow:developers property,
+// * Designers, set with the AboutWindow:designers property,
+// * Artists, set with the AboutWindow:artists property,
+// * Documenters, set with the AboutWindow:documenters property,
+// * Translators, set with the AboutWindow:translator-credits property.
 //
 // When setting translator credits, use the strings `"translator-credits"` or
 // `"translator_credits"` and mark them as translatable.
@@ -143,53 +111,44 @@ func ShowAboutWindowFromAppdata(ParentVar *gtk.Window, ResourcePathVar string, R
 // The default sections that don't contain any names won't be displayed.
 //
 // The Credits page can also contain an arbitrary number of extra sections below
-// the default ones. Use [method@AboutWindow.add_credit_section] to add them.
+// the default ones. Use add_credit_section to add them.
 //
 // The Acknowledgements page can be used to acknowledge additional people and
 // organizations for their non-development contributions. Use
-// [method@AboutWindow.add_acknowledgement_section] to add sections to it. For
+// add_acknowledgement_section to add sections to it. For
 // example, it can be used to list backers in a crowdfunded project or to give
 // special thanks.
 //
 // Each of the people or organizations can have an email address or a website
 // specified. To add a email address, use a string like
-// `Edgar Allan Poe &lt;edgar@poe.com&gt;`. To specify a website with a title, use a
+// `Edgar Allan Poe <edgar@poe.com>`. To specify a website with a title, use a
 // string like `The GNOME Project https://www.gnome.org`:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="about-window-credits-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="about-window-credits.png" alt="about-window-credits"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Legal
+// # Legal
 //
 // The Legal page displays the copyright and licensing information for the
 // application and other modules.
 //
-// The copyright string is set with the [property@AboutWindow:copyright]
+// The copyright string is set with the AboutWindow:copyright
 // property and should be a short string of one or two lines, for example:
 // `© 2022 Example`.
 //
 // Licensing information can be quickly set from a list of known licenses with
-// the [property@AboutWindow:license-type] property. If the application's
-// license is not in the list, [property@AboutWindow:license] can be used
+// the AboutWindow:license-type property. If the application's
+// license is not in the list, AboutWindow:license can be used
 // instead.
 //
 // To add information about other modules, such as application dependencies or
-// data, use [method@AboutWindow.add_legal_section].
+// data, use add_legal_section.
 //
-// ## Constructing
+// # Constructing
 //
 // To make constructing an `AdwAboutWindow` as convenient as possible, you can
-// use the function [func@show_about_window] which constructs and shows a
+// use the function show_about_window which constructs and shows a
 // window.
 //
-// ```c
-// static void
-// show_about (GtkApplication *app)
-//
+//	static void
+//	show_about (GtkApplication *app)
 //	{
 //	  const char *developers[] = {
 //	    "Angela Avery",
@@ -214,12 +173,14 @@ func ShowAboutWindowFromAppdata(ParentVar *gtk.Window, ResourcePathVar string, R
 //	                         NULL);
 //	}
 //
-// ```
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwAboutWindow` has a main CSS node with the name `window` and the
 // style class `.about`.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 type AboutWindow struct {
 	Window
 }
@@ -236,742 +197,6 @@ func AboutWindowNewFromInternalPtr(ptr uintptr) *AboutWindow {
 	return cls
 }
 
-var xNewAboutWindow func() uintptr
-
-// Creates a new `AdwAboutWindow`.
-func NewAboutWindow() *AboutWindow {
-	var cls *AboutWindow
-
-	cret := xNewAboutWindow()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AboutWindow{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewAboutWindowFromAppdata func(string, uintptr) uintptr
-
-// Creates a new `AdwAboutWindow` using AppStream metadata.
-//
-// This automatically sets the following properties with the following AppStream
-// values:
-//
-//   - [property@AboutWindow:application-icon] is set from the `&lt;id&gt;`
-//   - [property@AboutWindow:application-name] is set from the `&lt;name&gt;`
-//   - [property@AboutWindow:developer-name] is set from the `&lt;name&gt;` within
-//     `&lt;developer&gt;`
-//   - [property@AboutWindow:version] is set from the version of the latest release
-//   - [property@AboutWindow:website] is set from the `&lt;url type="homepage"&gt;`
-//   - [property@AboutWindow:support-url] is set from the `&lt;url type="help"&gt;`
-//   - [property@AboutWindow:issue-url] is set from the `&lt;url type="bugtracker"&gt;`
-//   - [property@AboutWindow:license-type] is set from the `&lt;project_license&gt;`.
-//     If the license type retrieved from AppStream is not listed in
-//     [enum@Gtk.License], it will be set to `GTK_LICENCE_CUSTOM`.
-//
-// If @release_notes_version is not `NULL`,
-// [property@AboutWindow:release-notes-version] is set to match it, while
-// [property@AboutWindow:release-notes] is set from the AppStream release
-// description for that version.
-func NewAboutWindowFromAppdata(ResourcePathVar string, ReleaseNotesVersionVar *string) *AboutWindow {
-	var cls *AboutWindow
-
-	ReleaseNotesVersionVarPtr := core.GStrdupNullable(ReleaseNotesVersionVar)
-	defer core.GFreeNullable(ReleaseNotesVersionVarPtr)
-
-	cret := xNewAboutWindowFromAppdata(ResourcePathVar, ReleaseNotesVersionVarPtr)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AboutWindow{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAboutWindowAddAcknowledgementSection func(uintptr, uintptr, []string)
-
-// Adds a section to the Acknowledgements page.
-//
-// This can be used to acknowledge additional people and organizations for their
-// non-development contributions - for example, backers in a crowdfunded
-// project.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-func (x *AboutWindow) AddAcknowledgementSection(NameVar *string, PeopleVar []string) {
-
-	NameVarPtr := core.GStrdupNullable(NameVar)
-	defer core.GFreeNullable(NameVarPtr)
-
-	xAboutWindowAddAcknowledgementSection(x.GoPointer(), NameVarPtr, PeopleVar)
-
-}
-
-var xAboutWindowAddCreditSection func(uintptr, uintptr, []string)
-
-// Adds an extra section to the Credits page.
-//
-// Extra sections are displayed below the standard categories.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) AddCreditSection(NameVar *string, PeopleVar []string) {
-
-	NameVarPtr := core.GStrdupNullable(NameVar)
-	defer core.GFreeNullable(NameVarPtr)
-
-	xAboutWindowAddCreditSection(x.GoPointer(), NameVarPtr, PeopleVar)
-
-}
-
-var xAboutWindowAddLegalSection func(uintptr, string, uintptr, gtk.License, uintptr)
-
-// Adds an extra section to the Legal page.
-//
-// Extra sections will be displayed below the application's own information.
-//
-// The parameters @copyright, @license_type and @license will be used to present
-// the it the same way as [property@AboutWindow:copyright],
-// [property@AboutWindow:license-type] and [property@AboutWindow:license] are
-// for the application's own information.
-//
-// See those properties for more details.
-//
-// This can be useful to attribute the application dependencies or data.
-//
-// Examples:
-//
-// ```c
-// adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
-//
-//	_("Copyright and a known license"),
-//	"© 2022 Example",
-//	GTK_LICENSE_LGPL_2_1,
-//	NULL);
-//
-// adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
-//
-//	_("Copyright and custom license"),
-//	"© 2022 Example",
-//	GTK_LICENSE_CUSTOM,
-//	"Custom license text");
-//
-// adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
-//
-//	_("Copyright only"),
-//	"© 2022 Example",
-//	GTK_LICENSE_UNKNOWN,
-//	NULL);
-//
-// adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
-//
-//	_("Custom license only"),
-//	NULL,
-//	GTK_LICENSE_CUSTOM,
-//	"Something completely custom here.");
-//
-// ```
-func (x *AboutWindow) AddLegalSection(TitleVar string, CopyrightVar *string, LicenseTypeVar gtk.License, LicenseVar *string) {
-
-	CopyrightVarPtr := core.GStrdupNullable(CopyrightVar)
-	defer core.GFreeNullable(CopyrightVarPtr)
-
-	LicenseVarPtr := core.GStrdupNullable(LicenseVar)
-	defer core.GFreeNullable(LicenseVarPtr)
-
-	xAboutWindowAddLegalSection(x.GoPointer(), TitleVar, CopyrightVarPtr, LicenseTypeVar, LicenseVarPtr)
-
-}
-
-var xAboutWindowAddLink func(uintptr, string, string)
-
-// Adds an extra link to the Details page.
-//
-// Extra links are displayed under the comment and website.
-//
-// Underlines in @title will be interpreted as indicating a mnemonic.
-//
-// See [property@AboutWindow:website].
-func (x *AboutWindow) AddLink(TitleVar string, UrlVar string) {
-
-	xAboutWindowAddLink(x.GoPointer(), TitleVar, UrlVar)
-
-}
-
-var xAboutWindowGetApplicationIcon func(uintptr) string
-
-// Gets the name of the application icon for @self.
-func (x *AboutWindow) GetApplicationIcon() string {
-
-	cret := xAboutWindowGetApplicationIcon(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetApplicationName func(uintptr) string
-
-// Gets the application name for @self.
-func (x *AboutWindow) GetApplicationName() string {
-
-	cret := xAboutWindowGetApplicationName(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetArtists func(uintptr) []string
-
-// Gets the list of artists of the application.
-func (x *AboutWindow) GetArtists() []string {
-
-	cret := xAboutWindowGetArtists(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetComments func(uintptr) string
-
-// Gets the comments about the application.
-func (x *AboutWindow) GetComments() string {
-
-	cret := xAboutWindowGetComments(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetCopyright func(uintptr) string
-
-// Gets the copyright information for @self.
-func (x *AboutWindow) GetCopyright() string {
-
-	cret := xAboutWindowGetCopyright(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDebugInfo func(uintptr) string
-
-// Gets the debug information for @self.
-func (x *AboutWindow) GetDebugInfo() string {
-
-	cret := xAboutWindowGetDebugInfo(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDebugInfoFilename func(uintptr) string
-
-// Gets the debug information filename for @self.
-func (x *AboutWindow) GetDebugInfoFilename() string {
-
-	cret := xAboutWindowGetDebugInfoFilename(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDesigners func(uintptr) []string
-
-// Gets the list of designers of the application.
-func (x *AboutWindow) GetDesigners() []string {
-
-	cret := xAboutWindowGetDesigners(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDeveloperName func(uintptr) string
-
-// Gets the developer name for @self.
-func (x *AboutWindow) GetDeveloperName() string {
-
-	cret := xAboutWindowGetDeveloperName(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDevelopers func(uintptr) []string
-
-// Gets the list of developers of the application.
-func (x *AboutWindow) GetDevelopers() []string {
-
-	cret := xAboutWindowGetDevelopers(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetDocumenters func(uintptr) []string
-
-// Gets the list of documenters of the application.
-func (x *AboutWindow) GetDocumenters() []string {
-
-	cret := xAboutWindowGetDocumenters(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetIssueUrl func(uintptr) string
-
-// Gets the issue tracker URL for @self.
-func (x *AboutWindow) GetIssueUrl() string {
-
-	cret := xAboutWindowGetIssueUrl(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetLicense func(uintptr) string
-
-// Gets the license for @self.
-func (x *AboutWindow) GetLicense() string {
-
-	cret := xAboutWindowGetLicense(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetLicenseType func(uintptr) gtk.License
-
-// Gets the license type for @self.
-func (x *AboutWindow) GetLicenseType() gtk.License {
-
-	cret := xAboutWindowGetLicenseType(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetReleaseNotes func(uintptr) string
-
-// Gets the release notes for @self.
-func (x *AboutWindow) GetReleaseNotes() string {
-
-	cret := xAboutWindowGetReleaseNotes(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetReleaseNotesVersion func(uintptr) string
-
-// Gets the version described by the application's release notes.
-func (x *AboutWindow) GetReleaseNotesVersion() string {
-
-	cret := xAboutWindowGetReleaseNotesVersion(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetSupportUrl func(uintptr) string
-
-// Gets the URL of the support page for @self.
-func (x *AboutWindow) GetSupportUrl() string {
-
-	cret := xAboutWindowGetSupportUrl(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetTranslatorCredits func(uintptr) string
-
-// Gets the translator credits string.
-func (x *AboutWindow) GetTranslatorCredits() string {
-
-	cret := xAboutWindowGetTranslatorCredits(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetVersion func(uintptr) string
-
-// Gets the version for @self.
-func (x *AboutWindow) GetVersion() string {
-
-	cret := xAboutWindowGetVersion(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowGetWebsite func(uintptr) string
-
-// Gets the application website URL for @self.
-func (x *AboutWindow) GetWebsite() string {
-
-	cret := xAboutWindowGetWebsite(x.GoPointer())
-	return cret
-}
-
-var xAboutWindowSetApplicationIcon func(uintptr, string)
-
-// Sets the name of the application icon for @self.
-//
-// The icon is displayed at the top of the main page.
-func (x *AboutWindow) SetApplicationIcon(ApplicationIconVar string) {
-
-	xAboutWindowSetApplicationIcon(x.GoPointer(), ApplicationIconVar)
-
-}
-
-var xAboutWindowSetApplicationName func(uintptr, string)
-
-// Sets the application name for @self.
-//
-// The name is displayed at the top of the main page.
-func (x *AboutWindow) SetApplicationName(ApplicationNameVar string) {
-
-	xAboutWindowSetApplicationName(x.GoPointer(), ApplicationNameVar)
-
-}
-
-var xAboutWindowSetArtists func(uintptr, []string)
-
-// Sets the list of artists of the application.
-//
-// It will be displayed on the Credits page.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) SetArtists(ArtistsVar []string) {
-
-	xAboutWindowSetArtists(x.GoPointer(), ArtistsVar)
-
-}
-
-var xAboutWindowSetComments func(uintptr, string)
-
-// Sets the comments about the application.
-//
-// Comments will be shown on the Details page, above links.
-//
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
-// detailed. It can also contain links and Pango markup.
-func (x *AboutWindow) SetComments(CommentsVar string) {
-
-	xAboutWindowSetComments(x.GoPointer(), CommentsVar)
-
-}
-
-var xAboutWindowSetCopyright func(uintptr, string)
-
-// Sets the copyright information for @self.
-//
-// This should be a short string of one or two lines, for example:
-// `© 2022 Example`.
-//
-// The copyright information will be displayed on the Legal page, before the
-// application license.
-//
-// [method@AboutWindow.add_legal_section] can be used to add copyright
-// information for the application dependencies or other components.
-func (x *AboutWindow) SetCopyright(CopyrightVar string) {
-
-	xAboutWindowSetCopyright(x.GoPointer(), CopyrightVar)
-
-}
-
-var xAboutWindowSetDebugInfo func(uintptr, string)
-
-// Sets the debug information for @self.
-//
-// Debug information will be shown on the Troubleshooting page. It's intended
-// to be attached to issue reports when reporting issues against the
-// application.
-//
-// `AdwAboutWindow` provides a quick way to save debug information to a file.
-// When saving, [property@AboutWindow:debug-info-filename] would be used as
-// the suggested filename.
-//
-// Debug information cannot contain markup or links.
-func (x *AboutWindow) SetDebugInfo(DebugInfoVar string) {
-
-	xAboutWindowSetDebugInfo(x.GoPointer(), DebugInfoVar)
-
-}
-
-var xAboutWindowSetDebugInfoFilename func(uintptr, string)
-
-// Sets the debug information filename for @self.
-//
-// It will be used as the suggested filename when saving debug information to a
-// file.
-//
-// See [property@AboutWindow:debug-info].
-func (x *AboutWindow) SetDebugInfoFilename(FilenameVar string) {
-
-	xAboutWindowSetDebugInfoFilename(x.GoPointer(), FilenameVar)
-
-}
-
-var xAboutWindowSetDesigners func(uintptr, []string)
-
-// Sets the list of designers of the application.
-//
-// It will be displayed on the Credits page.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) SetDesigners(DesignersVar []string) {
-
-	xAboutWindowSetDesigners(x.GoPointer(), DesignersVar)
-
-}
-
-var xAboutWindowSetDeveloperName func(uintptr, string)
-
-// Sets the developer name for @self.
-//
-// The developer name is displayed on the main page, under the application name.
-//
-// If the application is developed by multiple people, the developer name can be
-// set to values like "AppName team", "AppName developers" or
-// "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutWindow:developers] and related properties.
-func (x *AboutWindow) SetDeveloperName(DeveloperNameVar string) {
-
-	xAboutWindowSetDeveloperName(x.GoPointer(), DeveloperNameVar)
-
-}
-
-var xAboutWindowSetDevelopers func(uintptr, []string)
-
-// Sets the list of developers of the application.
-//
-// It will be displayed on the Credits page.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) SetDevelopers(DevelopersVar []string) {
-
-	xAboutWindowSetDevelopers(x.GoPointer(), DevelopersVar)
-
-}
-
-var xAboutWindowSetDocumenters func(uintptr, []string)
-
-// Sets the list of documenters of the application.
-//
-// It will be displayed on the Credits page.
-//
-// Each name may contain email addresses and URLs, see the introduction for more
-// details.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) SetDocumenters(DocumentersVar []string) {
-
-	xAboutWindowSetDocumenters(x.GoPointer(), DocumentersVar)
-
-}
-
-var xAboutWindowSetIssueUrl func(uintptr, string)
-
-// Sets the issue tracker URL for @self.
-//
-// The issue tracker link is displayed on the main page.
-func (x *AboutWindow) SetIssueUrl(IssueUrlVar string) {
-
-	xAboutWindowSetIssueUrl(x.GoPointer(), IssueUrlVar)
-
-}
-
-var xAboutWindowSetLicense func(uintptr, string)
-
-// Sets the license for @self.
-//
-// This can be used to set a custom text for the license if it can't be set via
-// [property@AboutWindow:license-type].
-//
-// When set, [property@AboutWindow:license-type] will be set to
-// `GTK_LICENSE_CUSTOM`.
-//
-// The license text will be displayed on the Legal page, below the copyright
-// information.
-//
-// License text can contain Pango markup and links.
-//
-// [method@AboutWindow.add_legal_section] can be used to add license information
-// for the application dependencies or other components.
-func (x *AboutWindow) SetLicense(LicenseVar string) {
-
-	xAboutWindowSetLicense(x.GoPointer(), LicenseVar)
-
-}
-
-var xAboutWindowSetLicenseType func(uintptr, gtk.License)
-
-// Sets the license for @self from a list of known licenses.
-//
-// If the application's license is not in the list,
-// [property@AboutWindow:license] can be used instead. The license type will be
-// automatically set to `GTK_LICENSE_CUSTOM` in that case.
-//
-// If @license_type is `GTK_LICENSE_UNKNOWN`, no information will be displayed.
-//
-// If @license_type is different from `GTK_LICENSE_CUSTOM`.
-// [property@AboutWindow:license] will be cleared out.
-//
-// The license description will be displayed on the Legal page, below the
-// copyright information.
-//
-// [method@AboutWindow.add_legal_section] can be used to add license information
-// for the application dependencies or other components.
-func (x *AboutWindow) SetLicenseType(LicenseTypeVar gtk.License) {
-
-	xAboutWindowSetLicenseType(x.GoPointer(), LicenseTypeVar)
-
-}
-
-var xAboutWindowSetReleaseNotes func(uintptr, string)
-
-// Sets the release notes for @self.
-//
-// Release notes are displayed on the the What's New page.
-//
-// Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
-//
-// The supported formatting options are:
-//
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
-//
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
-// while inline code is shown in a monospaced font.
-//
-// Any text outside paragraphs or list items is ignored.
-//
-// Nested lists are not supported.
-//
-// `AdwAboutWindow` displays the version above the release notes. If set, the
-// [property@AboutWindow:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutWindow:version] is used.
-func (x *AboutWindow) SetReleaseNotes(ReleaseNotesVar string) {
-
-	xAboutWindowSetReleaseNotes(x.GoPointer(), ReleaseNotesVar)
-
-}
-
-var xAboutWindowSetReleaseNotesVersion func(uintptr, string)
-
-// Sets the version described by the application's release notes.
-//
-// The release notes version is displayed on the What's New page, above the
-// release notes.
-//
-// If not set, [property@AboutWindow:version] will be used instead.
-//
-// For example, an application with the current version 2.0.2 might want to
-// keep the release notes from 2.0.0, and set the release notes version
-// accordingly.
-//
-// See [property@AboutWindow:release-notes].
-func (x *AboutWindow) SetReleaseNotesVersion(VersionVar string) {
-
-	xAboutWindowSetReleaseNotesVersion(x.GoPointer(), VersionVar)
-
-}
-
-var xAboutWindowSetSupportUrl func(uintptr, string)
-
-// Sets the URL of the support page for @self.
-//
-// The support page link is displayed on the main page.
-func (x *AboutWindow) SetSupportUrl(SupportUrlVar string) {
-
-	xAboutWindowSetSupportUrl(x.GoPointer(), SupportUrlVar)
-
-}
-
-var xAboutWindowSetTranslatorCredits func(uintptr, string)
-
-// Sets the translator credits string.
-//
-// It will be displayed on the Credits page.
-//
-// This string should be `"translator-credits"` or `"translator_credits"` and
-// should be marked as translatable.
-//
-// The string may contain email addresses and URLs, see the introduction for
-// more details. When there is more than one translator, they must be
-// separated by a newline in the same string.
-//
-// See also:
-//
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
-func (x *AboutWindow) SetTranslatorCredits(TranslatorCreditsVar string) {
-
-	xAboutWindowSetTranslatorCredits(x.GoPointer(), TranslatorCreditsVar)
-
-}
-
-var xAboutWindowSetVersion func(uintptr, string)
-
-// Sets the version for @self.
-//
-// The version is displayed on the main page.
-//
-// If [property@AboutWindow:release-notes-version] is not set, the version will
-// also be displayed above the release notes on the What's New page.
-func (x *AboutWindow) SetVersion(VersionVar string) {
-
-	xAboutWindowSetVersion(x.GoPointer(), VersionVar)
-
-}
-
-var xAboutWindowSetWebsite func(uintptr, string)
-
-// Sets the application website URL for @self.
-//
-// Website is displayed on the Details page, below comments, or on the main page
-// if the Details page doesn't have any other content.
-//
-// Applications can add other links below, see [method@AboutWindow.add_link].
-func (x *AboutWindow) SetWebsite(WebsiteVar string) {
-
-	xAboutWindowSetWebsite(x.GoPointer(), WebsiteVar)
-
-}
-
 func (c *AboutWindow) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -987,6 +212,8 @@ func (c *AboutWindow) SetGoPointer(ptr uintptr) {
 // The name of the application icon.
 //
 // The icon is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyApplicationIcon(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -998,6 +225,8 @@ func (x *AboutWindow) SetPropertyApplicationIcon(value string) {
 // The name of the application icon.
 //
 // The icon is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyApplicationIcon() string {
 	var v gobject.Value
 	x.GetProperty("application-icon", &v)
@@ -1008,6 +237,8 @@ func (x *AboutWindow) GetPropertyApplicationIcon() string {
 // The name of the application.
 //
 // The name is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyApplicationName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1019,6 +250,8 @@ func (x *AboutWindow) SetPropertyApplicationName(value string) {
 // The name of the application.
 //
 // The name is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyApplicationName() string {
 	var v gobject.Value
 	x.GetProperty("application-name", &v)
@@ -1035,12 +268,14 @@ func (x *AboutWindow) GetPropertyApplicationName() string {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyArtists(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1058,12 +293,14 @@ func (x *AboutWindow) SetPropertyArtists(value []string) {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyArtists() []string {
 	var v gobject.Value
 	x.GetProperty("artists", &v)
@@ -1075,8 +312,10 @@ func (x *AboutWindow) GetPropertyArtists() []string {
 //
 // Comments will be shown on the Details page, above links.
 //
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyComments(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1089,8 +328,10 @@ func (x *AboutWindow) SetPropertyComments(value string) {
 //
 // Comments will be shown on the Details page, above links.
 //
-// Unlike [property@Gtk.AboutDialog:comments], this string can be long and
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
 // detailed. It can also contain links and Pango markup.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyComments() string {
 	var v gobject.Value
 	x.GetProperty("comments", &v)
@@ -1106,8 +347,10 @@ func (x *AboutWindow) GetPropertyComments() string {
 // The copyright information will be displayed on the Legal page, above the
 // application license.
 //
-// [method@AboutWindow.add_legal_section] can be used to add copyright
+// add_legal_section can be used to add copyright
 // information for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyCopyright(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1124,8 +367,10 @@ func (x *AboutWindow) SetPropertyCopyright(value string) {
 // The copyright information will be displayed on the Legal page, above the
 // application license.
 //
-// [method@AboutWindow.add_legal_section] can be used to add copyright
+// add_legal_section can be used to add copyright
 // information for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyCopyright() string {
 	var v gobject.Value
 	x.GetProperty("copyright", &v)
@@ -1140,10 +385,12 @@ func (x *AboutWindow) GetPropertyCopyright() string {
 // application.
 //
 // `AdwAboutWindow` provides a quick way to save debug information to a file.
-// When saving, [property@AboutWindow:debug-info-filename] would be used as
+// When saving, AboutWindow:debug-info-filename would be used as
 // the suggested filename.
 //
 // Debug information cannot contain markup or links.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDebugInfo(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1159,10 +406,12 @@ func (x *AboutWindow) SetPropertyDebugInfo(value string) {
 // application.
 //
 // `AdwAboutWindow` provides a quick way to save debug information to a file.
-// When saving, [property@AboutWindow:debug-info-filename] would be used as
+// When saving, AboutWindow:debug-info-filename would be used as
 // the suggested filename.
 //
 // Debug information cannot contain markup or links.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDebugInfo() string {
 	var v gobject.Value
 	x.GetProperty("debug-info", &v)
@@ -1175,7 +424,9 @@ func (x *AboutWindow) GetPropertyDebugInfo() string {
 // It will be used as the suggested filename when saving debug information to
 // a file.
 //
-// See [property@AboutWindow:debug-info].
+// See AboutWindow:debug-info.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDebugInfoFilename(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1189,7 +440,9 @@ func (x *AboutWindow) SetPropertyDebugInfoFilename(value string) {
 // It will be used as the suggested filename when saving debug information to
 // a file.
 //
-// See [property@AboutWindow:debug-info].
+// See AboutWindow:debug-info.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDebugInfoFilename() string {
 	var v gobject.Value
 	x.GetProperty("debug-info-filename", &v)
@@ -1206,12 +459,14 @@ func (x *AboutWindow) GetPropertyDebugInfoFilename() string {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDesigners(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1229,12 +484,14 @@ func (x *AboutWindow) SetPropertyDesigners(value []string) {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDesigners() []string {
 	var v gobject.Value
 	x.GetProperty("designers", &v)
@@ -1250,8 +507,10 @@ func (x *AboutWindow) GetPropertyDesigners() []string {
 // If the application is developed by multiple people, the developer name can
 // be set to values like "AppName team", "AppName developers" or
 // "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutWindow:developers] and related
+// Credits page, with AboutWindow:developers and related
 // properties.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDeveloperName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1268,8 +527,10 @@ func (x *AboutWindow) SetPropertyDeveloperName(value string) {
 // If the application is developed by multiple people, the developer name can
 // be set to values like "AppName team", "AppName developers" or
 // "The AppName project", and the individual contributors can be listed on the
-// Credits page, with [property@AboutWindow:developers] and related
+// Credits page, with AboutWindow:developers and related
 // properties.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDeveloperName() string {
 	var v gobject.Value
 	x.GetProperty("developer-name", &v)
@@ -1286,12 +547,14 @@ func (x *AboutWindow) GetPropertyDeveloperName() string {
 //
 // See also:
 //
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDevelopers(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1309,12 +572,14 @@ func (x *AboutWindow) SetPropertyDevelopers(value []string) {
 //
 // See also:
 //
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDevelopers() []string {
 	var v gobject.Value
 	x.GetProperty("developers", &v)
@@ -1331,12 +596,14 @@ func (x *AboutWindow) GetPropertyDevelopers() []string {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyDocumenters(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -1354,12 +621,14 @@ func (x *AboutWindow) SetPropertyDocumenters(value []string) {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:translator-credits]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyDocumenters() []string {
 	var v gobject.Value
 	x.GetProperty("documenters", &v)
@@ -1370,6 +639,8 @@ func (x *AboutWindow) GetPropertyDocumenters() []string {
 // The URL for the application's issue tracker.
 //
 // The issue tracker link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyIssueUrl(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1381,6 +652,8 @@ func (x *AboutWindow) SetPropertyIssueUrl(value string) {
 // The URL for the application's issue tracker.
 //
 // The issue tracker link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyIssueUrl() string {
 	var v gobject.Value
 	x.GetProperty("issue-url", &v)
@@ -1391,9 +664,9 @@ func (x *AboutWindow) GetPropertyIssueUrl() string {
 // The license text.
 //
 // This can be used to set a custom text for the license if it can't be set
-// via [property@AboutWindow:license-type].
+// via AboutWindow:license-type.
 //
-// When set, [property@AboutWindow:license-type] will be set to
+// When set, AboutWindow:license-type will be set to
 // `GTK_LICENSE_CUSTOM`.
 //
 // The license text will be displayed on the Legal page, below the copyright
@@ -1401,8 +674,10 @@ func (x *AboutWindow) GetPropertyIssueUrl() string {
 //
 // License text can contain Pango markup and links.
 //
-// [method@AboutWindow.add_legal_section] can be used to add license
+// add_legal_section can be used to add license
 // information for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyLicense(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1414,9 +689,9 @@ func (x *AboutWindow) SetPropertyLicense(value string) {
 // The license text.
 //
 // This can be used to set a custom text for the license if it can't be set
-// via [property@AboutWindow:license-type].
+// via AboutWindow:license-type.
 //
-// When set, [property@AboutWindow:license-type] will be set to
+// When set, AboutWindow:license-type will be set to
 // `GTK_LICENSE_CUSTOM`.
 //
 // The license text will be displayed on the Legal page, below the copyright
@@ -1424,8 +699,10 @@ func (x *AboutWindow) SetPropertyLicense(value string) {
 //
 // License text can contain Pango markup and links.
 //
-// [method@AboutWindow.add_legal_section] can be used to add license
+// add_legal_section can be used to add license
 // information for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyLicense() string {
 	var v gobject.Value
 	x.GetProperty("license", &v)
@@ -1438,16 +715,16 @@ func (x *AboutWindow) GetPropertyLicense() string {
 // Release notes are displayed on the the What's New page.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -1455,8 +732,12 @@ func (x *AboutWindow) GetPropertyLicense() string {
 // Nested lists are not supported.
 //
 // `AdwAboutWindow` displays the version above the release notes. If set, the
-// [property@AboutWindow:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutWindow:version] is used.
+// AboutWindow:release-notes-version of the property will be used
+// as the version; otherwise, AboutWindow:version is used.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 func (x *AboutWindow) SetPropertyReleaseNotes(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1470,16 +751,16 @@ func (x *AboutWindow) SetPropertyReleaseNotes(value string) {
 // Release notes are displayed on the the What's New page.
 //
 // Release notes are formatted the same way as
-// [AppStream descriptions](https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description).
+// [AppStream descriptions].
 //
 // The supported formatting options are:
 //
-// * Paragraph (`&lt;p&gt;`)
-// * Ordered list (`&lt;ol&gt;`), with list items (`&lt;li&gt;`)
-// * Unordered list (`&lt;ul&gt;`), with list items (`&lt;li&gt;`)
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
 //
-// Within paragraphs and list items, emphasis (`&lt;em&gt;`) and inline code
-// (`&lt;code&gt;`) text styles are supported. The emphasis is rendered in italic,
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
 // while inline code is shown in a monospaced font.
 //
 // Any text outside paragraphs or list items is ignored.
@@ -1487,8 +768,12 @@ func (x *AboutWindow) SetPropertyReleaseNotes(value string) {
 // Nested lists are not supported.
 //
 // `AdwAboutWindow` displays the version above the release notes. If set, the
-// [property@AboutWindow:release-notes-version] of the property will be used
-// as the version; otherwise, [property@AboutWindow:version] is used.
+// AboutWindow:release-notes-version of the property will be used
+// as the version; otherwise, AboutWindow:version is used.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
 func (x *AboutWindow) GetPropertyReleaseNotes() string {
 	var v gobject.Value
 	x.GetProperty("release-notes", &v)
@@ -1501,13 +786,15 @@ func (x *AboutWindow) GetPropertyReleaseNotes() string {
 // The release notes version is displayed on the What's New page, above the
 // release notes.
 //
-// If not set, [property@AboutWindow:version] will be used instead.
+// If not set, AboutWindow:version will be used instead.
 //
 // For example, an application with the current version 2.0.2 might want to
 // keep the release notes from 2.0.0, and set the release notes version
 // accordingly.
 //
-// See [property@AboutWindow:release-notes].
+// See AboutWindow:release-notes.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyReleaseNotesVersion(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1521,13 +808,15 @@ func (x *AboutWindow) SetPropertyReleaseNotesVersion(value string) {
 // The release notes version is displayed on the What's New page, above the
 // release notes.
 //
-// If not set, [property@AboutWindow:version] will be used instead.
+// If not set, AboutWindow:version will be used instead.
 //
 // For example, an application with the current version 2.0.2 might want to
 // keep the release notes from 2.0.0, and set the release notes version
 // accordingly.
 //
-// See [property@AboutWindow:release-notes].
+// See AboutWindow:release-notes.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyReleaseNotesVersion() string {
 	var v gobject.Value
 	x.GetProperty("release-notes-version", &v)
@@ -1538,6 +827,8 @@ func (x *AboutWindow) GetPropertyReleaseNotesVersion() string {
 // The URL of the application's support page.
 //
 // The support page link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertySupportUrl(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1549,6 +840,8 @@ func (x *AboutWindow) SetPropertySupportUrl(value string) {
 // The URL of the application's support page.
 //
 // The support page link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertySupportUrl() string {
 	var v gobject.Value
 	x.GetProperty("support-url", &v)
@@ -1568,12 +861,14 @@ func (x *AboutWindow) GetPropertySupportUrl() string {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyTranslatorCredits(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1594,12 +889,14 @@ func (x *AboutWindow) SetPropertyTranslatorCredits(value string) {
 //
 // See also:
 //
-// * [property@AboutWindow:developers]
-// * [property@AboutWindow:designers]
-// * [property@AboutWindow:artists]
-// * [property@AboutWindow:documenters]
-// * [method@AboutWindow.add_credit_section]
-// * [method@AboutWindow.add_acknowledgement_section]
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyTranslatorCredits() string {
 	var v gobject.Value
 	x.GetProperty("translator-credits", &v)
@@ -1611,8 +908,10 @@ func (x *AboutWindow) GetPropertyTranslatorCredits() string {
 //
 // The version is displayed on the main page.
 //
-// If [property@AboutWindow:release-notes-version] is not set, the version
+// If AboutWindow:release-notes-version is not set, the version
 // will also be displayed above the release notes on the What's New page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyVersion(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1625,8 +924,10 @@ func (x *AboutWindow) SetPropertyVersion(value string) {
 //
 // The version is displayed on the main page.
 //
-// If [property@AboutWindow:release-notes-version] is not set, the version
+// If AboutWindow:release-notes-version is not set, the version
 // will also be displayed above the release notes on the What's New page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyVersion() string {
 	var v gobject.Value
 	x.GetProperty("version", &v)
@@ -1639,7 +940,9 @@ func (x *AboutWindow) GetPropertyVersion() string {
 // Website is displayed on the Details page, below comments, or on the main
 // page if the Details page doesn't have any other content.
 //
-// Applications can add other links below, see [method@AboutWindow.add_link].
+// Applications can add other links below, see add_link.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) SetPropertyWebsite(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1653,7 +956,9 @@ func (x *AboutWindow) SetPropertyWebsite(value string) {
 // Website is displayed on the Details page, below comments, or on the main
 // page if the Details page doesn't have any other content.
 //
-// Applications can add other links below, see [method@AboutWindow.add_link].
+// Applications can add other links below, see add_link.
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) GetPropertyWebsite() string {
 	var v gobject.Value
 	x.GetProperty("website", &v)
@@ -1663,28 +968,52 @@ func (x *AboutWindow) GetPropertyWebsite() string {
 // Emitted when a URL is activated.
 //
 // Applications may connect to it to override the default behavior, which is
-// to call [func@Gtk.show_uri].
+// to call [gtk.ShowUri].
+//
+// Deprecated: since 1.6. Use AboutDialog.
 func (x *AboutWindow) ConnectActivateLink(cb *func(AboutWindow, string) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, UriVarp string) bool {
+			defer core.RecoverPanic()
+			fa := AboutWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, UriVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-link", cbRefPtr, cbPtr)
+}
+
+var xAboutWindowActivateLinkTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, UriVarp string) bool {
+func xAboutWindowActivateLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, UriVarp string, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AboutWindow, string) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AboutWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, UriVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateLinkFunc connects cb to the "activate-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "activate-link" signal is deprecated, see ConnectActivateLink.
+func (x *AboutWindow) ConnectActivateLinkFunc(cb func(AboutWindow, string) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-link", &xAboutWindowActivateLinkTrampoline, xAboutWindowActivateLinkNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1848,7 +1177,7 @@ func (x *AboutWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1856,14 +1185,13 @@ func (x *AboutWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AboutWindow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1887,19 +1215,17 @@ func (x *AboutWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AboutWindow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1920,7 +1246,7 @@ func (x *AboutWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1929,14 +1255,12 @@ func (x *AboutWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AboutWindow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1955,10 +1279,10 @@ func (x *AboutWindow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AboutWindow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1995,10 +1319,10 @@ func (x *AboutWindow) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *AboutWindow) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	gtk.XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -2042,7 +1366,7 @@ func (x *AboutWindow) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *AboutWindow) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
@@ -2058,83 +1382,16 @@ func (x *AboutWindow) GetFocus() *gtk.Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [gtk.Widget.GrabFocus] instead of
 // this function.
 func (x *AboutWindow) SetFocus(FocusVar *gtk.Widget) {
 
 	gtk.XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("ADW", "libadwaita-1")
-	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xShowAboutWindow, libs, "adw_show_about_window")
-	core.PuregoSafeRegister(&xShowAboutWindowFromAppdata, libs, "adw_show_about_window_from_appdata")
-
-	core.PuregoSafeRegister(&xAboutWindowGLibType, libs, "adw_about_window_get_type")
-
-	core.PuregoSafeRegister(&xNewAboutWindow, libs, "adw_about_window_new")
-	core.PuregoSafeRegister(&xNewAboutWindowFromAppdata, libs, "adw_about_window_new_from_appdata")
-
-	core.PuregoSafeRegister(&xAboutWindowAddAcknowledgementSection, libs, "adw_about_window_add_acknowledgement_section")
-	core.PuregoSafeRegister(&xAboutWindowAddCreditSection, libs, "adw_about_window_add_credit_section")
-	core.PuregoSafeRegister(&xAboutWindowAddLegalSection, libs, "adw_about_window_add_legal_section")
-	core.PuregoSafeRegister(&xAboutWindowAddLink, libs, "adw_about_window_add_link")
-	core.PuregoSafeRegister(&xAboutWindowGetApplicationIcon, libs, "adw_about_window_get_application_icon")
-	core.PuregoSafeRegister(&xAboutWindowGetApplicationName, libs, "adw_about_window_get_application_name")
-	core.PuregoSafeRegister(&xAboutWindowGetArtists, libs, "adw_about_window_get_artists")
-	core.PuregoSafeRegister(&xAboutWindowGetComments, libs, "adw_about_window_get_comments")
-	core.PuregoSafeRegister(&xAboutWindowGetCopyright, libs, "adw_about_window_get_copyright")
-	core.PuregoSafeRegister(&xAboutWindowGetDebugInfo, libs, "adw_about_window_get_debug_info")
-	core.PuregoSafeRegister(&xAboutWindowGetDebugInfoFilename, libs, "adw_about_window_get_debug_info_filename")
-	core.PuregoSafeRegister(&xAboutWindowGetDesigners, libs, "adw_about_window_get_designers")
-	core.PuregoSafeRegister(&xAboutWindowGetDeveloperName, libs, "adw_about_window_get_developer_name")
-	core.PuregoSafeRegister(&xAboutWindowGetDevelopers, libs, "adw_about_window_get_developers")
-	core.PuregoSafeRegister(&xAboutWindowGetDocumenters, libs, "adw_about_window_get_documenters")
-	core.PuregoSafeRegister(&xAboutWindowGetIssueUrl, libs, "adw_about_window_get_issue_url")
-	core.PuregoSafeRegister(&xAboutWindowGetLicense, libs, "adw_about_window_get_license")
-	core.PuregoSafeRegister(&xAboutWindowGetLicenseType, libs, "adw_about_window_get_license_type")
-	core.PuregoSafeRegister(&xAboutWindowGetReleaseNotes, libs, "adw_about_window_get_release_notes")
-	core.PuregoSafeRegister(&xAboutWindowGetReleaseNotesVersion, libs, "adw_about_window_get_release_notes_version")
-	core.PuregoSafeRegister(&xAboutWindowGetSupportUrl, libs, "adw_about_window_get_support_url")
-	core.PuregoSafeRegister(&xAboutWindowGetTranslatorCredits, libs, "adw_about_window_get_translator_credits")
-	core.PuregoSafeRegister(&xAboutWindowGetVersion, libs, "adw_about_window_get_version")
-	core.PuregoSafeRegister(&xAboutWindowGetWebsite, libs, "adw_about_window_get_website")
-	core.PuregoSafeRegister(&xAboutWindowSetApplicationIcon, libs, "adw_about_window_set_application_icon")
-	core.PuregoSafeRegister(&xAboutWindowSetApplicationName, libs, "adw_about_window_set_application_name")
-	core.PuregoSafeRegister(&xAboutWindowSetArtists, libs, "adw_about_window_set_artists")
-	core.PuregoSafeRegister(&xAboutWindowSetComments, libs, "adw_about_window_set_comments")
-	core.PuregoSafeRegister(&xAboutWindowSetCopyright, libs, "adw_about_window_set_copyright")
-	core.PuregoSafeRegister(&xAboutWindowSetDebugInfo, libs, "adw_about_window_set_debug_info")
-	core.PuregoSafeRegister(&xAboutWindowSetDebugInfoFilename, libs, "adw_about_window_set_debug_info_filename")
-	core.PuregoSafeRegister(&xAboutWindowSetDesigners, libs, "adw_about_window_set_designers")
-	core.PuregoSafeRegister(&xAboutWindowSetDeveloperName, libs, "adw_about_window_set_developer_name")
-	core.PuregoSafeRegister(&xAboutWindowSetDevelopers, libs, "adw_about_window_set_developers")
-	core.PuregoSafeRegister(&xAboutWindowSetDocumenters, libs, "adw_about_window_set_documenters")
-	core.PuregoSafeRegister(&xAboutWindowSetIssueUrl, libs, "adw_about_window_set_issue_url")
-	core.PuregoSafeRegister(&xAboutWindowSetLicense, libs, "adw_about_window_set_license")
-	core.PuregoSafeRegister(&xAboutWindowSetLicenseType, libs, "adw_about_window_set_license_type")
-	core.PuregoSafeRegister(&xAboutWindowSetReleaseNotes, libs, "adw_about_window_set_release_notes")
-	core.PuregoSafeRegister(&xAboutWindowSetReleaseNotesVersion, libs, "adw_about_window_set_release_notes_version")
-	core.PuregoSafeRegister(&xAboutWindowSetSupportUrl, libs, "adw_about_window_set_support_url")
-	core.PuregoSafeRegister(&xAboutWindowSetTranslatorCredits, libs, "adw_about_window_set_translator_credits")
-	core.PuregoSafeRegister(&xAboutWindowSetVersion, libs, "adw_about_window_set_version")
-	core.PuregoSafeRegister(&xAboutWindowSetWebsite, libs, "adw_about_window_set_website")
-
-}
@@ -0,0 +1,926 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xShowAboutWindow func(uintptr, string, ...interface{})
+
+// A convenience function for showing an application’s about window.
+//
+// Deprecated: since 1.6. Use show_about_dialog.
+func ShowAboutWindow(ParentVar *gtk.Window, FirstPropertyNameVar string, varArgs ...interface{}) {
+
+	xShowAboutWindow(ParentVar.GoPointer(), FirstPropertyNameVar, varArgs...)
+
+}
+
+var xShowAboutWindowFromAppdata func(uintptr, string, uintptr, string, ...interface{})
+
+// A convenience function for showing an application’s about window from
+// AppStream metadata.
+//
+// See new_from_appdata for details.
+//
+// Deprecated: since 1.6. Use show_about_dialog_from_appdata.
+func ShowAboutWindowFromAppdata(ParentVar *gtk.Window, ResourcePathVar string, ReleaseNotesVersionVar *string, FirstPropertyNameVar string, varArgs ...interface{}) {
+
+	ReleaseNotesVersionVarPtr := core.GStrdupNullable(ReleaseNotesVersionVar)
+	defer core.GFreeNullable(ReleaseNotesVersionVarPtr)
+
+	xShowAboutWindowFromAppdata(ParentVar.GoPointer(), ResourcePathVar, ReleaseNotesVersionVarPtr, FirstPropertyNameVar, varArgs...)
+
+}
+
+var xNewAboutWindow func() uintptr
+
+// Creates a new `AdwAboutWindow`.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func NewAboutWindow() *AboutWindow {
+	var cls *AboutWindow
+
+	cret := xNewAboutWindow()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AboutWindow{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewAboutWindowFromAppdata func(string, uintptr) uintptr
+
+// Creates a new `AdwAboutWindow` using AppStream metadata.
+//
+// This automatically sets the following properties with the following AppStream
+// values:
+//
+//   - AboutWindow:application-icon is set from the `<id>`
+//   - AboutWindow:application-name is set from the `<name>`
+//   - AboutWindow:developer-name is set from the `<name>` within
+//     `<developer>`
+//   - AboutWindow:version is set from the version of the latest release
+//   - AboutWindow:website is set from the `<url type="homepage">`
+//   - AboutWindow:support-url is set from the `<url type="help">`
+//   - AboutWindow:issue-url is set from the `<url type="bugtracker">`
+//   - AboutWindow:license-type is set from the `<project_license>`.
+//     If the license type retrieved from AppStream is not listed in
+//     [gtk.License], it will be set to `GTK_LICENCE_CUSTOM`.
+//
+// If release_notes_version is not `NULL`,
+// AboutWindow:release-notes-version is set to match it, while
+// AboutWindow:release-notes is set from the AppStream release
+// description for that version.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func NewAboutWindowFromAppdata(ResourcePathVar string, ReleaseNotesVersionVar *string) *AboutWindow {
+	var cls *AboutWindow
+
+	ReleaseNotesVersionVarPtr := core.GStrdupNullable(ReleaseNotesVersionVar)
+	defer core.GFreeNullable(ReleaseNotesVersionVarPtr)
+
+	cret := xNewAboutWindowFromAppdata(ResourcePathVar, ReleaseNotesVersionVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AboutWindow{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAboutWindowAddAcknowledgementSection func(uintptr, uintptr, []string)
+
+// Adds a section to the Acknowledgements page.
+//
+// This can be used to acknowledge additional people and organizations for their
+// non-development contributions - for example, backers in a crowdfunded
+// project.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) AddAcknowledgementSection(NameVar *string, PeopleVar []string) {
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	xAboutWindowAddAcknowledgementSection(x.GoPointer(), NameVarPtr, PeopleVar)
+
+}
+
+var xAboutWindowAddCreditSection func(uintptr, uintptr, []string)
+
+// Adds an extra section to the Credits page.
+//
+// Extra sections are displayed below the standard categories.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) AddCreditSection(NameVar *string, PeopleVar []string) {
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	xAboutWindowAddCreditSection(x.GoPointer(), NameVarPtr, PeopleVar)
+
+}
+
+var xAboutWindowAddLegalSection func(uintptr, string, uintptr, gtk.License, uintptr)
+
+// Adds an extra section to the Legal page.
+//
+// Extra sections will be displayed below the application's own information.
+//
+// The parameters copyright, license_type and license will be used to present
+// the it the same way as AboutWindow:copyright,
+// AboutWindow:license-type and AboutWindow:license are
+// for the application's own information.
+//
+// See those properties for more details.
+//
+// This can be useful to attribute the application dependencies or data.
+//
+// Examples:
+//
+//	adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
+//	                                    _("Copyright and a known license"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_LGPL_2_1,
+//	                                    NULL);
+//
+//	adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
+//	                                    _("Copyright and custom license"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_CUSTOM,
+//	                                    "Custom license text");
+//
+//	adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
+//	                                    _("Copyright only"),
+//	                                    "© 2022 Example",
+//	                                    GTK_LICENSE_UNKNOWN,
+//	                                    NULL);
+//
+//	adw_about_window_add_legal_section (ADW_ABOUT_WINDOW (about),
+//	                                    _("Custom license only"),
+//	                                    NULL,
+//	                                    GTK_LICENSE_CUSTOM,
+//	                                    "Something completely custom here.");
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) AddLegalSection(TitleVar string, CopyrightVar *string, LicenseTypeVar gtk.License, LicenseVar *string) {
+
+	CopyrightVarPtr := core.GStrdupNullable(CopyrightVar)
+	defer core.GFreeNullable(CopyrightVarPtr)
+
+	LicenseVarPtr := core.GStrdupNullable(LicenseVar)
+	defer core.GFreeNullable(LicenseVarPtr)
+
+	xAboutWindowAddLegalSection(x.GoPointer(), TitleVar, CopyrightVarPtr, LicenseTypeVar, LicenseVarPtr)
+
+}
+
+var xAboutWindowAddLink func(uintptr, string, string)
+
+// Adds an extra link to the Details page.
+//
+// Extra links are displayed under the comment and website.
+//
+// Underlines in title will be interpreted as indicating a mnemonic.
+//
+// See AboutWindow:website.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) AddLink(TitleVar string, UrlVar string) {
+
+	xAboutWindowAddLink(x.GoPointer(), TitleVar, UrlVar)
+
+}
+
+var xAboutWindowGetApplicationIcon func(uintptr) string
+
+// Gets the name of the application icon for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetApplicationIcon() string {
+
+	cret := xAboutWindowGetApplicationIcon(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetApplicationName func(uintptr) string
+
+// Gets the application name for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetApplicationName() string {
+
+	cret := xAboutWindowGetApplicationName(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetArtists func(uintptr) []string
+
+// Gets the list of artists of the application.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetArtists() []string {
+
+	cret := xAboutWindowGetArtists(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetComments func(uintptr) string
+
+// Gets the comments about the application.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetComments() string {
+
+	cret := xAboutWindowGetComments(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetCopyright func(uintptr) string
+
+// Gets the copyright information for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetCopyright() string {
+
+	cret := xAboutWindowGetCopyright(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDebugInfo func(uintptr) string
+
+// Gets the debug information for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDebugInfo() string {
+
+	cret := xAboutWindowGetDebugInfo(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDebugInfoFilename func(uintptr) string
+
+// Gets the debug information filename for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDebugInfoFilename() string {
+
+	cret := xAboutWindowGetDebugInfoFilename(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDesigners func(uintptr) []string
+
+// Gets the list of designers of the application.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDesigners() []string {
+
+	cret := xAboutWindowGetDesigners(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDeveloperName func(uintptr) string
+
+// Gets the developer name for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDeveloperName() string {
+
+	cret := xAboutWindowGetDeveloperName(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDevelopers func(uintptr) []string
+
+// Gets the list of developers of the application.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDevelopers() []string {
+
+	cret := xAboutWindowGetDevelopers(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetDocumenters func(uintptr) []string
+
+// Gets the list of documenters of the application.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetDocumenters() []string {
+
+	cret := xAboutWindowGetDocumenters(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetIssueUrl func(uintptr) string
+
+// Gets the issue tracker URL for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetIssueUrl() string {
+
+	cret := xAboutWindowGetIssueUrl(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetLicense func(uintptr) string
+
+// Gets the license for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetLicense() string {
+
+	cret := xAboutWindowGetLicense(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetLicenseType func(uintptr) gtk.License
+
+// Gets the license type for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetLicenseType() gtk.License {
+
+	cret := xAboutWindowGetLicenseType(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetReleaseNotes func(uintptr) string
+
+// Gets the release notes for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetReleaseNotes() string {
+
+	cret := xAboutWindowGetReleaseNotes(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetReleaseNotesVersion func(uintptr) string
+
+// Gets the version described by the application's release notes.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetReleaseNotesVersion() string {
+
+	cret := xAboutWindowGetReleaseNotesVersion(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetSupportUrl func(uintptr) string
+
+// Gets the URL of the support page for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetSupportUrl() string {
+
+	cret := xAboutWindowGetSupportUrl(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetTranslatorCredits func(uintptr) string
+
+// Gets the translator credits string.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetTranslatorCredits() string {
+
+	cret := xAboutWindowGetTranslatorCredits(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetVersion func(uintptr) string
+
+// Gets the version for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetVersion() string {
+
+	cret := xAboutWindowGetVersion(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowGetWebsite func(uintptr) string
+
+// Gets the application website URL for self.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) GetWebsite() string {
+
+	cret := xAboutWindowGetWebsite(x.GoPointer())
+	return cret
+}
+
+var xAboutWindowSetApplicationIcon func(uintptr, string)
+
+// Sets the name of the application icon for self.
+//
+// The icon is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetApplicationIcon(ApplicationIconVar string) {
+
+	xAboutWindowSetApplicationIcon(x.GoPointer(), ApplicationIconVar)
+
+}
+
+var xAboutWindowSetApplicationName func(uintptr, string)
+
+// Sets the application name for self.
+//
+// The name is displayed at the top of the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetApplicationName(ApplicationNameVar string) {
+
+	xAboutWindowSetApplicationName(x.GoPointer(), ApplicationNameVar)
+
+}
+
+var xAboutWindowSetArtists func(uintptr, []string)
+
+// Sets the list of artists of the application.
+//
+// It will be displayed on the Credits page.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetArtists(ArtistsVar []string) {
+
+	xAboutWindowSetArtists(x.GoPointer(), ArtistsVar)
+
+}
+
+var xAboutWindowSetComments func(uintptr, string)
+
+// Sets the comments about the application.
+//
+// Comments will be shown on the Details page, above links.
+//
+// Unlike [gtk.AboutDialog.GetPropertyComments], this string can be long and
+// detailed. It can also contain links and Pango markup.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetComments(CommentsVar string) {
+
+	xAboutWindowSetComments(x.GoPointer(), CommentsVar)
+
+}
+
+var xAboutWindowSetCopyright func(uintptr, string)
+
+// Sets the copyright information for self.
+//
+// This should be a short string of one or two lines, for example:
+// `© 2022 Example`.
+//
+// The copyright information will be displayed on the Legal page, before the
+// application license.
+//
+// add_legal_section can be used to add copyright
+// information for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetCopyright(CopyrightVar string) {
+
+	xAboutWindowSetCopyright(x.GoPointer(), CopyrightVar)
+
+}
+
+var xAboutWindowSetDebugInfo func(uintptr, string)
+
+// Sets the debug information for self.
+//
+// Debug information will be shown on the Troubleshooting page. It's intended
+// to be attached to issue reports when reporting issues against the
+// application.
+//
+// `AdwAboutWindow` provides a quick way to save debug information to a file.
+// When saving, AboutWindow:debug-info-filename would be used as
+// the suggested filename.
+//
+// Debug information cannot contain markup or links.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDebugInfo(DebugInfoVar string) {
+
+	xAboutWindowSetDebugInfo(x.GoPointer(), DebugInfoVar)
+
+}
+
+var xAboutWindowSetDebugInfoFilename func(uintptr, string)
+
+// Sets the debug information filename for self.
+//
+// It will be used as the suggested filename when saving debug information to a
+// file.
+//
+// See AboutWindow:debug-info.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDebugInfoFilename(FilenameVar string) {
+
+	xAboutWindowSetDebugInfoFilename(x.GoPointer(), FilenameVar)
+
+}
+
+var xAboutWindowSetDesigners func(uintptr, []string)
+
+// Sets the list of designers of the application.
+//
+// It will be displayed on the Credits page.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDesigners(DesignersVar []string) {
+
+	xAboutWindowSetDesigners(x.GoPointer(), DesignersVar)
+
+}
+
+var xAboutWindowSetDeveloperName func(uintptr, string)
+
+// Sets the developer name for self.
+//
+// The developer name is displayed on the main page, under the application name.
+//
+// If the application is developed by multiple people, the developer name can be
+// set to values like "AppName team", "AppName developers" or
+// "The AppName project", and the individual contributors can be listed on the
+// Credits page, with AboutWindow:developers and related properties.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDeveloperName(DeveloperNameVar string) {
+
+	xAboutWindowSetDeveloperName(x.GoPointer(), DeveloperNameVar)
+
+}
+
+var xAboutWindowSetDevelopers func(uintptr, []string)
+
+// Sets the list of developers of the application.
+//
+// It will be displayed on the Credits page.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDevelopers(DevelopersVar []string) {
+
+	xAboutWindowSetDevelopers(x.GoPointer(), DevelopersVar)
+
+}
+
+var xAboutWindowSetDocumenters func(uintptr, []string)
+
+// Sets the list of documenters of the application.
+//
+// It will be displayed on the Credits page.
+//
+// Each name may contain email addresses and URLs, see the introduction for more
+// details.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:translator-credits
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetDocumenters(DocumentersVar []string) {
+
+	xAboutWindowSetDocumenters(x.GoPointer(), DocumentersVar)
+
+}
+
+var xAboutWindowSetIssueUrl func(uintptr, string)
+
+// Sets the issue tracker URL for self.
+//
+// The issue tracker link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetIssueUrl(IssueUrlVar string) {
+
+	xAboutWindowSetIssueUrl(x.GoPointer(), IssueUrlVar)
+
+}
+
+var xAboutWindowSetLicense func(uintptr, string)
+
+// Sets the license for self.
+//
+// This can be used to set a custom text for the license if it can't be set via
+// AboutWindow:license-type.
+//
+// When set, AboutWindow:license-type will be set to
+// `GTK_LICENSE_CUSTOM`.
+//
+// The license text will be displayed on the Legal page, below the copyright
+// information.
+//
+// License text can contain Pango markup and links.
+//
+// add_legal_section can be used to add license information
+// for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetLicense(LicenseVar string) {
+
+	xAboutWindowSetLicense(x.GoPointer(), LicenseVar)
+
+}
+
+var xAboutWindowSetLicenseType func(uintptr, gtk.License)
+
+// Sets the license for self from a list of known licenses.
+//
+// If the application's license is not in the list,
+// AboutWindow:license can be used instead. The license type will be
+// automatically set to `GTK_LICENSE_CUSTOM` in that case.
+//
+// If license_type is `GTK_LICENSE_UNKNOWN`, no information will be displayed.
+//
+// If license_type is different from `GTK_LICENSE_CUSTOM`.
+// AboutWindow:license will be cleared out.
+//
+// The license description will be displayed on the Legal page, below the
+// copyright information.
+//
+// add_legal_section can be used to add license information
+// for the application dependencies or other components.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetLicenseType(LicenseTypeVar gtk.License) {
+
+	xAboutWindowSetLicenseType(x.GoPointer(), LicenseTypeVar)
+
+}
+
+var xAboutWindowSetReleaseNotes func(uintptr, string)
+
+// Sets the release notes for self.
+//
+// Release notes are displayed on the the What's New page.
+//
+// Release notes are formatted the same way as
+// [AppStream descriptions].
+//
+// The supported formatting options are:
+//
+// * Paragraph (“)
+// * Ordered list (`<ol>`), with list items (`<li>`)
+// * Unordered list (`<ul>`), with list items (`<li>`)
+//
+// Within paragraphs and list items, emphasis (“) and inline code
+// (“) text styles are supported. The emphasis is rendered in italic,
+// while inline code is shown in a monospaced font.
+//
+// Any text outside paragraphs or list items is ignored.
+//
+// Nested lists are not supported.
+//
+// `AdwAboutWindow` displays the version above the release notes. If set, the
+// AboutWindow:release-notes-version of the property will be used
+// as the version; otherwise, AboutWindow:version is used.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+//
+// [AppStream descriptions]: https://freedesktop.org/software/appstream/docs/chap-Metadata.html#tag-description
+func (x *AboutWindow) SetReleaseNotes(ReleaseNotesVar string) {
+
+	xAboutWindowSetReleaseNotes(x.GoPointer(), ReleaseNotesVar)
+
+}
+
+var xAboutWindowSetReleaseNotesVersion func(uintptr, string)
+
+// Sets the version described by the application's release notes.
+//
+// The release notes version is displayed on the What's New page, above the
+// release notes.
+//
+// If not set, AboutWindow:version will be used instead.
+//
+// For example, an application with the current version 2.0.2 might want to
+// keep the release notes from 2.0.0, and set the release notes version
+// accordingly.
+//
+// See AboutWindow:release-notes.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetReleaseNotesVersion(VersionVar string) {
+
+	xAboutWindowSetReleaseNotesVersion(x.GoPointer(), VersionVar)
+
+}
+
+var xAboutWindowSetSupportUrl func(uintptr, string)
+
+// Sets the URL of the support page for self.
+//
+// The support page link is displayed on the main page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetSupportUrl(SupportUrlVar string) {
+
+	xAboutWindowSetSupportUrl(x.GoPointer(), SupportUrlVar)
+
+}
+
+var xAboutWindowSetTranslatorCredits func(uintptr, string)
+
+// Sets the translator credits string.
+//
+// It will be displayed on the Credits page.
+//
+// This string should be `"translator-credits"` or `"translator_credits"` and
+// should be marked as translatable.
+//
+// The string may contain email addresses and URLs, see the introduction for
+// more details. When there is more than one translator, they must be
+// separated by a newline in the same string.
+//
+// See also:
+//
+// * AboutWindow:developers
+// * AboutWindow:designers
+// * AboutWindow:artists
+// * AboutWindow:documenters
+// * add_credit_section
+// * add_acknowledgement_section
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetTranslatorCredits(TranslatorCreditsVar string) {
+
+	xAboutWindowSetTranslatorCredits(x.GoPointer(), TranslatorCreditsVar)
+
+}
+
+var xAboutWindowSetVersion func(uintptr, string)
+
+// Sets the version for self.
+//
+// The version is displayed on the main page.
+//
+// If AboutWindow:release-notes-version is not set, the version will
+// also be displayed above the release notes on the What's New page.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetVersion(VersionVar string) {
+
+	xAboutWindowSetVersion(x.GoPointer(), VersionVar)
+
+}
+
+var xAboutWindowSetWebsite func(uintptr, string)
+
+// Sets the application website URL for self.
+//
+// Website is displayed on the Details page, below comments, or on the main page
+// if the Details page doesn't have any other content.
+//
+// Applications can add other links below, see add_link.
+//
+// Deprecated: since 1.6. Use AboutDialog.
+func (x *AboutWindow) SetWebsite(WebsiteVar string) {
+
+	xAboutWindowSetWebsite(x.GoPointer(), WebsiteVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xShowAboutWindow, libs, "adw_show_about_window", "ADW", "1.2")
+	core.PuregoRegisterSince(&xShowAboutWindowFromAppdata, libs, "adw_show_about_window_from_appdata", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xNewAboutWindow, libs, "adw_about_window_new", "ADW", "1.2")
+	core.PuregoRegisterSince(&xNewAboutWindowFromAppdata, libs, "adw_about_window_new_from_appdata", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xAboutWindowAddAcknowledgementSection, libs, "adw_about_window_add_acknowledgement_section", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowAddCreditSection, libs, "adw_about_window_add_credit_section", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowAddLegalSection, libs, "adw_about_window_add_legal_section", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowAddLink, libs, "adw_about_window_add_link", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetApplicationIcon, libs, "adw_about_window_get_application_icon", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetApplicationName, libs, "adw_about_window_get_application_name", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetArtists, libs, "adw_about_window_get_artists", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetComments, libs, "adw_about_window_get_comments", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetCopyright, libs, "adw_about_window_get_copyright", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDebugInfo, libs, "adw_about_window_get_debug_info", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDebugInfoFilename, libs, "adw_about_window_get_debug_info_filename", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDesigners, libs, "adw_about_window_get_designers", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDeveloperName, libs, "adw_about_window_get_developer_name", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDevelopers, libs, "adw_about_window_get_developers", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetDocumenters, libs, "adw_about_window_get_documenters", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetIssueUrl, libs, "adw_about_window_get_issue_url", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetLicense, libs, "adw_about_window_get_license", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetLicenseType, libs, "adw_about_window_get_license_type", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetReleaseNotes, libs, "adw_about_window_get_release_notes", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetReleaseNotesVersion, libs, "adw_about_window_get_release_notes_version", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetSupportUrl, libs, "adw_about_window_get_support_url", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetTranslatorCredits, libs, "adw_about_window_get_translator_credits", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetVersion, libs, "adw_about_window_get_version", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowGetWebsite, libs, "adw_about_window_get_website", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetApplicationIcon, libs, "adw_about_window_set_application_icon", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetApplicationName, libs, "adw_about_window_set_application_name", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetArtists, libs, "adw_about_window_set_artists", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetComments, libs, "adw_about_window_set_comments", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetCopyright, libs, "adw_about_window_set_copyright", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDebugInfo, libs, "adw_about_window_set_debug_info", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDebugInfoFilename, libs, "adw_about_window_set_debug_info_filename", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDesigners, libs, "adw_about_window_set_designers", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDeveloperName, libs, "adw_about_window_set_developer_name", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDevelopers, libs, "adw_about_window_set_developers", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetDocumenters, libs, "adw_about_window_set_documenters", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetIssueUrl, libs, "adw_about_window_set_issue_url", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetLicense, libs, "adw_about_window_set_license", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetLicenseType, libs, "adw_about_window_set_license_type", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetReleaseNotes, libs, "adw_about_window_set_release_notes", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetReleaseNotesVersion, libs, "adw_about_window_set_release_notes_version", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetSupportUrl, libs, "adw_about_window_set_support_url", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetTranslatorCredits, libs, "adw_about_window_set_translator_credits", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetVersion, libs, "adw_about_window_set_version", "ADW", "1.2")
+	core.PuregoRegisterSince(&xAboutWindowSetWebsite, libs, "adw_about_window_set_website", "ADW", "1.2")
+
+}
@@ -2,7 +2,8 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -39,9 +40,84 @@ const (
 	AccentColorSlateValue AccentColor = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AccentColor) String() string {
+	switch x {
+	case AccentColorBlueValue:
+		return "ADW_ACCENT_COLOR_BLUE"
+	case AccentColorTealValue:
+		return "ADW_ACCENT_COLOR_TEAL"
+	case AccentColorGreenValue:
+		return "ADW_ACCENT_COLOR_GREEN"
+	case AccentColorYellowValue:
+		return "ADW_ACCENT_COLOR_YELLOW"
+	case AccentColorOrangeValue:
+		return "ADW_ACCENT_COLOR_ORANGE"
+	case AccentColorRedValue:
+		return "ADW_ACCENT_COLOR_RED"
+	case AccentColorPinkValue:
+		return "ADW_ACCENT_COLOR_PINK"
+	case AccentColorPurpleValue:
+		return "ADW_ACCENT_COLOR_PURPLE"
+	case AccentColorSlateValue:
+		return "ADW_ACCENT_COLOR_SLATE"
+	}
+	return fmt.Sprintf("AccentColor(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccentColor) Nick() string {
+	switch x {
+	case AccentColorBlueValue:
+		return "blue"
+	case AccentColorTealValue:
+		return "teal"
+	case AccentColorGreenValue:
+		return "green"
+	case AccentColorYellowValue:
+		return "yellow"
+	case AccentColorOrangeValue:
+		return "orange"
+	case AccentColorRedValue:
+		return "red"
+	case AccentColorPinkValue:
+		return "pink"
+	case AccentColorPurpleValue:
+		return "purple"
+	case AccentColorSlateValue:
+		return "slate"
+	}
+	return ""
+}
+
+// AccentColorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccentColorFromString(s string) (AccentColor, bool) {
+	switch s {
+	case "ADW_ACCENT_COLOR_BLUE", "blue":
+		return AccentColorBlueValue, true
+	case "ADW_ACCENT_COLOR_TEAL", "teal":
+		return AccentColorTealValue, true
+	case "ADW_ACCENT_COLOR_GREEN", "green":
+		return AccentColorGreenValue, true
+	case "ADW_ACCENT_COLOR_YELLOW", "yellow":
+		return AccentColorYellowValue, true
+	case "ADW_ACCENT_COLOR_ORANGE", "orange":
+		return AccentColorOrangeValue, true
+	case "ADW_ACCENT_COLOR_RED", "red":
+		return AccentColorRedValue, true
+	case "ADW_ACCENT_COLOR_PINK", "pink":
+		return AccentColorPinkValue, true
+	case "ADW_ACCENT_COLOR_PURPLE", "purple":
+		return AccentColorPurpleValue, true
+	case "ADW_ACCENT_COLOR_SLATE", "slate":
+		return AccentColorSlateValue, true
+	}
+	return 0, false
+}
+
 var xAccentColorToRgba func(AccentColor, *gdk.RGBA)
 
-// Converts @self to a `GdkRGBA` representing its background color.
+// Converts self to a `GdkRGBA` representing its background color.
 //
 // The matching foreground color is white.
 func AccentColorToRgba(SelfVar AccentColor, RgbaVar *gdk.RGBA) {
@@ -52,7 +128,7 @@ func AccentColorToRgba(SelfVar AccentColor, RgbaVar *gdk.RGBA) {
 
 var xAccentColorToStandaloneRgba func(AccentColor, bool, *gdk.RGBA)
 
-// Converts @self to a `GdkRGBA` representing its standalone color.
+// Converts self to a `GdkRGBA` representing its standalone color.
 //
 // It will typically be darker for light background, and lighter for dark
 // background, ensuring contrast.
@@ -64,7 +140,7 @@ func AccentColorToStandaloneRgba(SelfVar AccentColor, DarkVar bool, RgbaVar *gdk
 
 var xRgbaToStandalone func(*gdk.RGBA, bool, *gdk.RGBA)
 
-// Adjusts @rgba to be suitable as a standalone color.
+// Adjusts rgba to be suitable as a standalone color.
 //
 // It will typically be darker for light background, and lighter for dark
 // background, ensuring contrast.
@@ -79,7 +155,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -88,8 +164,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xAccentColorGLibType, libs, "adw_accent_color_get_type")
 
-	core.PuregoSafeRegister(&xAccentColorToRgba, libs, "adw_accent_color_to_rgba")
-	core.PuregoSafeRegister(&xAccentColorToStandaloneRgba, libs, "adw_accent_color_to_standalone_rgba")
-	core.PuregoSafeRegister(&xRgbaToStandalone, libs, "adw_rgba_to_standalone")
+	core.PuregoRegisterSince(&xAccentColorToRgba, libs, "adw_accent_color_to_rgba", "ADW", "1.6")
+	core.PuregoRegisterSince(&xAccentColorToStandaloneRgba, libs, "adw_accent_color_to_standalone_rgba", "ADW", "1.6")
+	core.PuregoRegisterSince(&xRgbaToStandalone, libs, "adw_rgba_to_standalone", "ADW", "1.6")
 
 }
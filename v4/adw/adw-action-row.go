@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -33,7 +32,8 @@ func (x *ActionRowClass) OverrideActivate(cb func(*ActionRow)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xActivate = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ActionRowNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -46,20 +46,13 @@ func (x *ActionRowClass) GetActivate() func(*ActionRow) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(SelfVar *ActionRow) {
 		rawCallback(SelfVar.GoPointer())
 	}
 }
 
-// A [class@Gtk.ListBoxRow] used to present actions.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="action-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="action-row.png" alt="action-row"&gt;
-//
-// &lt;/picture&gt;
+// A [gtk.ListBoxRow] used to present actions.
 //
 // The `AdwActionRow` widget can have a title, a subtitle and an icon. The row
 // can receive additional widgets at its end, or prefix widgets at its start.
@@ -70,16 +63,16 @@ func (x *ActionRowClass) GetActivate() func(*ActionRow) {
 // will automatically make it activatable, but unsetting it won't change the
 // row's activatability.
 //
-// ## AdwActionRow as GtkBuildable
+// # AdwActionRow as GtkBuildable
 //
-// The `AdwActionRow` implementation of the [iface@Gtk.Buildable] interface
+// The `AdwActionRow` implementation of the [gtk.Buildable] interface
 // supports adding a child at its end by specifying “suffix” or omitting the
-// “type” attribute of a &lt;child&gt; element.
+// “type” attribute of a <child> element.
 //
 // It also supports adding a child as a prefix widget by specifying “prefix” as
-// the “type” attribute of a &lt;child&gt; element.
+// the “type” attribute of a <child> element.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwActionRow` has a main CSS node with name `row`.
 //
@@ -89,19 +82,12 @@ func (x *ActionRowClass) GetActivate() func(*ActionRow) {
 // It contains subnodes `label.title` and `label.subtitle` representing
 // respectively the title label and subtitle label.
 //
-// ## Style classes
+// # Style classes
 //
-// `AdwActionRow` can use the [`.property`](style-classes.html#property-rows)
+// `AdwActionRow` can use the .property
 // style class to emphasize the row subtitle instead of the row title, which is
 // useful for displaying read-only properties.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="property-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="property-row.png" alt="property-row"&gt;
-//
-// &lt;/picture&gt;
-//
 // When used together with the `.monospace` style class, only the subtitle
 // becomes monospace, not the title or any extra widgets.
 type ActionRow struct {
@@ -139,7 +125,7 @@ func NewActionRow() *ActionRow {
 
 var xActionRowActivate func(uintptr)
 
-// Activates @self.
+// Activates self.
 func (x *ActionRow) Activate() {
 
 	xActionRowActivate(x.GoPointer())
@@ -148,7 +134,7 @@ func (x *ActionRow) Activate() {
 
 var xActionRowAddPrefix func(uintptr, uintptr)
 
-// Adds a prefix widget to @self.
+// Adds a prefix widget to self.
 func (x *ActionRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 	xActionRowAddPrefix(x.GoPointer(), WidgetVar.GoPointer())
@@ -157,7 +143,7 @@ func (x *ActionRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 var xActionRowAddSuffix func(uintptr, uintptr)
 
-// Adds a suffix widget to @self.
+// Adds a suffix widget to self.
 func (x *ActionRow) AddSuffix(WidgetVar *gtk.Widget) {
 
 	xActionRowAddSuffix(x.GoPointer(), WidgetVar.GoPointer())
@@ -166,7 +152,7 @@ func (x *ActionRow) AddSuffix(WidgetVar *gtk.Widget) {
 
 var xActionRowGetActivatableWidget func(uintptr) uintptr
 
-// Gets the widget activated when @self is activated.
+// Gets the widget activated when self is activated.
 func (x *ActionRow) GetActivatableWidget() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -181,18 +167,9 @@ func (x *ActionRow) GetActivatableWidget() *gtk.Widget {
 	return cls
 }
 
-var xActionRowGetIconName func(uintptr) string
-
-// Gets the icon name for @self.
-func (x *ActionRow) GetIconName() string {
-
-	cret := xActionRowGetIconName(x.GoPointer())
-	return cret
-}
-
 var xActionRowGetSubtitle func(uintptr) string
 
-// Gets the subtitle for @self.
+// Gets the subtitle for self.
 func (x *ActionRow) GetSubtitle() string {
 
 	cret := xActionRowGetSubtitle(x.GoPointer())
@@ -230,7 +207,7 @@ func (x *ActionRow) GetTitleLines() int {
 
 var xActionRowRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 func (x *ActionRow) Remove(WidgetVar *gtk.Widget) {
 
 	xActionRowRemove(x.GoPointer(), WidgetVar.GoPointer())
@@ -239,38 +216,26 @@ func (x *ActionRow) Remove(WidgetVar *gtk.Widget) {
 
 var xActionRowSetActivatableWidget func(uintptr, uintptr)
 
-// Sets the widget to activate when @self is activated.
+// Sets the widget to activate when self is activated.
 //
 // The row can be activated either by clicking on it, calling
-// [method@ActionRow.activate], or via mnemonics in the title.
-// See the [property@PreferencesRow:use-underline] property to enable mnemonics.
+// activate, or via mnemonics in the title.
+// See the PreferencesRow:use-underline property to enable mnemonics.
 //
 // The target widget will be activated by emitting the
-// [signal@Gtk.Widget::mnemonic-activate] signal on it.
+// [gtk.Widget.ConnectMnemonicActivateFunc] signal on it.
 func (x *ActionRow) SetActivatableWidget(WidgetVar *gtk.Widget) {
 
 	xActionRowSetActivatableWidget(x.GoPointer(), WidgetVar.GoPointer())
 
 }
 
-var xActionRowSetIconName func(uintptr, uintptr)
-
-// Sets the icon name for @self.
-func (x *ActionRow) SetIconName(IconNameVar *string) {
-
-	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
-	defer core.GFreeNullable(IconNameVarPtr)
-
-	xActionRowSetIconName(x.GoPointer(), IconNameVarPtr)
-
-}
-
 var xActionRowSetSubtitle func(uintptr, string)
 
-// Sets the subtitle for @self.
+// Sets the subtitle for self.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ActionRow) SetSubtitle(SubtitleVar string) {
 
 	xActionRowSetSubtitle(x.GoPointer(), SubtitleVar)
@@ -293,7 +258,7 @@ var xActionRowSetSubtitleSelectable func(uintptr, bool)
 
 // Sets whether the user can copy the subtitle from the label
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *ActionRow) SetSubtitleSelectable(SubtitleSelectableVar bool) {
 
 	xActionRowSetSubtitleSelectable(x.GoPointer(), SubtitleSelectableVar)
@@ -325,6 +290,8 @@ func (c *ActionRow) SetGoPointer(ptr uintptr) {
 
 // SetPropertyIconName sets the "icon-name" property.
 // The icon name for this row.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
 func (x *ActionRow) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -334,6 +301,8 @@ func (x *ActionRow) SetPropertyIconName(value string) {
 
 // GetPropertyIconName gets the "icon-name" property.
 // The icon name for this row.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
 func (x *ActionRow) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -344,7 +313,7 @@ func (x *ActionRow) GetPropertyIconName() string {
 // The subtitle for this row.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ActionRow) SetPropertySubtitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -356,7 +325,7 @@ func (x *ActionRow) SetPropertySubtitle(value string) {
 // The subtitle for this row.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ActionRow) GetPropertySubtitle() string {
 	var v gobject.Value
 	x.GetProperty("subtitle", &v)
@@ -389,7 +358,7 @@ func (x *ActionRow) GetPropertySubtitleLines() int {
 // SetPropertySubtitleSelectable sets the "subtitle-selectable" property.
 // Whether the user can copy the subtitle from the label.
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *ActionRow) SetPropertySubtitleSelectable(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -400,7 +369,7 @@ func (x *ActionRow) SetPropertySubtitleSelectable(value bool) {
 // GetPropertySubtitleSelectable gets the "subtitle-selectable" property.
 // Whether the user can copy the subtitle from the label.
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *ActionRow) GetPropertySubtitleSelectable() bool {
 	var v gobject.Value
 	x.GetProperty("subtitle-selectable", &v)
@@ -429,27 +398,49 @@ func (x *ActionRow) GetPropertyTitleLines() int {
 }
 
 // This signal is emitted after the row has been activated.
+//
+// Deprecated: use ConnectActivatedFunc, which also accepts method values and closures.
 func (x *ActionRow) ConnectActivated(cb *func(ActionRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ActionRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activated", cbRefPtr, cbPtr)
+}
+
+var xActionRowActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xActionRowActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ActionRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ActionRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivatedFunc connects cb to the "activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ActionRow) ConnectActivatedFunc(cb func(ActionRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activated", &xActionRowActivatedTrampoline, xActionRowActivatedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -613,7 +604,7 @@ func (x *ActionRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -621,14 +612,13 @@ func (x *ActionRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ActionRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -652,19 +642,17 @@ func (x *ActionRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ActionRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -685,7 +673,7 @@ func (x *ActionRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -694,14 +682,12 @@ func (x *ActionRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ActionRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -720,14 +706,14 @@ func (x *ActionRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ActionRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ActionRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -737,15 +723,15 @@ func (x *ActionRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ActionRow) SetActionName(ActionNameVar *string) {
 
@@ -758,13 +744,13 @@ func (x *ActionRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *ActionRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -773,14 +759,14 @@ func (x *ActionRow) SetActionTarget(FormatStringVar string, varArgs ...interface
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -798,18 +784,18 @@ func (x *ActionRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ActionRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ActionRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -821,7 +807,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -830,23 +816,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xActionRowGLibType, libs, "adw_action_row_get_type")
 
-	core.PuregoSafeRegister(&xNewActionRow, libs, "adw_action_row_new")
-
-	core.PuregoSafeRegister(&xActionRowActivate, libs, "adw_action_row_activate")
-	core.PuregoSafeRegister(&xActionRowAddPrefix, libs, "adw_action_row_add_prefix")
-	core.PuregoSafeRegister(&xActionRowAddSuffix, libs, "adw_action_row_add_suffix")
-	core.PuregoSafeRegister(&xActionRowGetActivatableWidget, libs, "adw_action_row_get_activatable_widget")
-	core.PuregoSafeRegister(&xActionRowGetIconName, libs, "adw_action_row_get_icon_name")
-	core.PuregoSafeRegister(&xActionRowGetSubtitle, libs, "adw_action_row_get_subtitle")
-	core.PuregoSafeRegister(&xActionRowGetSubtitleLines, libs, "adw_action_row_get_subtitle_lines")
-	core.PuregoSafeRegister(&xActionRowGetSubtitleSelectable, libs, "adw_action_row_get_subtitle_selectable")
-	core.PuregoSafeRegister(&xActionRowGetTitleLines, libs, "adw_action_row_get_title_lines")
-	core.PuregoSafeRegister(&xActionRowRemove, libs, "adw_action_row_remove")
-	core.PuregoSafeRegister(&xActionRowSetActivatableWidget, libs, "adw_action_row_set_activatable_widget")
-	core.PuregoSafeRegister(&xActionRowSetIconName, libs, "adw_action_row_set_icon_name")
-	core.PuregoSafeRegister(&xActionRowSetSubtitle, libs, "adw_action_row_set_subtitle")
-	core.PuregoSafeRegister(&xActionRowSetSubtitleLines, libs, "adw_action_row_set_subtitle_lines")
-	core.PuregoSafeRegister(&xActionRowSetSubtitleSelectable, libs, "adw_action_row_set_subtitle_selectable")
-	core.PuregoSafeRegister(&xActionRowSetTitleLines, libs, "adw_action_row_set_title_lines")
+	gobject.CheckClassSize("AdwActionRowClass", xActionRowGLibType, unsafe.Sizeof(ActionRowClass{}))
+
+	core.PuregoRegisterSince(&xNewActionRow, libs, "adw_action_row_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xActionRowActivate, libs, "adw_action_row_activate", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowAddPrefix, libs, "adw_action_row_add_prefix", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowAddSuffix, libs, "adw_action_row_add_suffix", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowGetActivatableWidget, libs, "adw_action_row_get_activatable_widget", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowGetSubtitle, libs, "adw_action_row_get_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowGetSubtitleLines, libs, "adw_action_row_get_subtitle_lines", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowGetSubtitleSelectable, libs, "adw_action_row_get_subtitle_selectable", "ADW", "1.3")
+	core.PuregoRegisterSince(&xActionRowGetTitleLines, libs, "adw_action_row_get_title_lines", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowRemove, libs, "adw_action_row_remove", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowSetActivatableWidget, libs, "adw_action_row_set_activatable_widget", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowSetSubtitle, libs, "adw_action_row_set_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowSetSubtitleLines, libs, "adw_action_row_set_subtitle_lines", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowSetSubtitleSelectable, libs, "adw_action_row_set_subtitle_selectable", "ADW", "1.3")
+	core.PuregoRegisterSince(&xActionRowSetTitleLines, libs, "adw_action_row_set_title_lines", "ADW", "")
 
 }
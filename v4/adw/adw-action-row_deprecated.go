@@ -0,0 +1,50 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xActionRowGetIconName func(uintptr) string
+
+// Gets the icon name for self.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
+func (x *ActionRow) GetIconName() string {
+
+	cret := xActionRowGetIconName(x.GoPointer())
+	return cret
+}
+
+var xActionRowSetIconName func(uintptr, uintptr)
+
+// Sets the icon name for self.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
+func (x *ActionRow) SetIconName(IconNameVar *string) {
+
+	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
+	defer core.GFreeNullable(IconNameVarPtr)
+
+	xActionRowSetIconName(x.GoPointer(), IconNameVarPtr)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xActionRowGetIconName, libs, "adw_action_row_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xActionRowSetIconName, libs, "adw_action_row_set_icon_name", "ADW", "")
+
+}
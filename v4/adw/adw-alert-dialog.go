@@ -6,7 +6,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -34,7 +33,8 @@ func (x *AlertDialogClass) OverrideResponse(cb func(*AlertDialog, string)) {
 	if cb == nil {
 		x.xResponse = 0
 	} else {
-		x.xResponse = purego.NewCallback(func(SelfVarp uintptr, ResponseVarp string) {
+		x.xResponse = core.NewCallback(func(SelfVarp uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
 			cb(AlertDialogNewFromInternalPtr(SelfVarp), ResponseVarp)
 		})
 	}
@@ -46,15 +46,15 @@ func (x *AlertDialogClass) GetResponse() func(*AlertDialog, string) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResponseVarp string)
-	purego.RegisterFunc(&rawCallback, x.xResponse)
+	core.RegisterFunc(&rawCallback, x.xResponse)
 	return func(SelfVar *AlertDialog, ResponseVar string) {
 		rawCallback(SelfVar.GoPointer(), ResponseVar)
 	}
 }
 
-// Describes the possible styles of [class@AlertDialog] response buttons.
+// Describes the possible styles of AlertDialog response buttons.
 //
-// See [method@AlertDialog.set_response_appearance].
+// See set_response_appearance.
 type ResponseAppearance int
 
 var xResponseAppearanceGLibType func() types.GType
@@ -76,15 +76,47 @@ const (
 	ResponseDestructiveValue ResponseAppearance = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ResponseAppearance) String() string {
+	switch x {
+	case ResponseDefaultValue:
+		return "ADW_RESPONSE_DEFAULT"
+	case ResponseSuggestedValue:
+		return "ADW_RESPONSE_SUGGESTED"
+	case ResponseDestructiveValue:
+		return "ADW_RESPONSE_DESTRUCTIVE"
+	}
+	return fmt.Sprintf("ResponseAppearance(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ResponseAppearance) Nick() string {
+	switch x {
+	case ResponseDefaultValue:
+		return "default"
+	case ResponseSuggestedValue:
+		return "suggested"
+	case ResponseDestructiveValue:
+		return "destructive"
+	}
+	return ""
+}
+
+// ResponseAppearanceFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ResponseAppearanceFromString(s string) (ResponseAppearance, bool) {
+	switch s {
+	case "ADW_RESPONSE_DEFAULT", "default":
+		return ResponseDefaultValue, true
+	case "ADW_RESPONSE_SUGGESTED", "suggested":
+		return ResponseSuggestedValue, true
+	case "ADW_RESPONSE_DESTRUCTIVE", "destructive":
+		return ResponseDestructiveValue, true
+	}
+	return 0, false
+}
+
 // A dialog presenting a message or a question.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="alert-dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="alert-dialog.png" alt="alert-dialog"&gt;
-//
-// &lt;/picture&gt;
-//
 // Alert dialogs have a heading, a body, an optional child widget, and one or
 // multiple responses, each presented as a button.
 //
@@ -93,10 +125,10 @@ const (
 // appearance.
 //
 // When one of the responses is activated, or the dialog is closed, the
-// [signal@AlertDialog::response] signal will be emitted. This signal is
+// AlertDialog::response signal will be emitted. This signal is
 // detailed, and the detail, as well as the `response` parameter will be set to
 // the ID of the activated response, or to the value of the
-// [property@AlertDialog:close-response] property if the dialog had been closed
+// AlertDialog:close-response property if the dialog had been closed
 // without activating any of the responses.
 //
 // Response buttons can be presented horizontally or vertically depending on
@@ -106,56 +138,47 @@ const (
 //
 // An example of using an alert dialog:
 //
-// ```c
-// AdwDialog *dialog;
-//
-// dialog = adw_alert_dialog_new (_("Replace File?"), NULL);
-//
-// adw_alert_dialog_format_body (ADW_ALERT_DIALOG (dialog),
-//
-//	_("A file named “%s” already exists. Do you want to replace it?"),
-//	filename);
+//	AdwDialog *dialog;
 //
-// adw_alert_dialog_add_responses (ADW_ALERT_DIALOG (dialog),
+//	dialog = adw_alert_dialog_new (_("Replace File?"), NULL);
 //
-//	"cancel",  _("_Cancel"),
-//	"replace", _("_Replace"),
-//	NULL);
+//	adw_alert_dialog_format_body (ADW_ALERT_DIALOG (dialog),
+//	                              _("A file named “%s” already exists. Do you want to replace it?"),
+//	                              filename);
 //
-// adw_alert_dialog_set_response_appearance (ADW_ALERT_DIALOG (dialog),
+//	adw_alert_dialog_add_responses (ADW_ALERT_DIALOG (dialog),
+//	                                "cancel",  _("_Cancel"),
+//	                                "replace", _("_Replace"),
+//	                                NULL);
 //
-//	"replace",
-//	ADW_RESPONSE_DESTRUCTIVE);
+//	adw_alert_dialog_set_response_appearance (ADW_ALERT_DIALOG (dialog),
+//	                                          "replace",
+//	                                          ADW_RESPONSE_DESTRUCTIVE);
 //
-// adw_alert_dialog_set_default_response (ADW_ALERT_DIALOG (dialog), "cancel");
-// adw_alert_dialog_set_close_response (ADW_ALERT_DIALOG (dialog), "cancel");
+//	adw_alert_dialog_set_default_response (ADW_ALERT_DIALOG (dialog), "cancel");
+//	adw_alert_dialog_set_close_response (ADW_ALERT_DIALOG (dialog), "cancel");
 //
-// g_signal_connect (dialog, "response", G_CALLBACK (response_cb), self);
+//	g_signal_connect (dialog, "response", G_CALLBACK (response_cb), self);
 //
-// adw_dialog_present (dialog, parent);
-// ```
+//	adw_dialog_present (dialog, parent);
 //
-// ## Async API
+// # Async API
 //
-// `AdwAlertDialog` can also be used via the [method@AlertDialog.choose] method.
+// `AdwAlertDialog` can also be used via the choose method.
 // This API follows the GIO async pattern, for example:
 //
-// ```c
-// static void
-// dialog_cb (AdwAlertDialog *dialog,
-//
-//	GAsyncResult   *result,
-//	MyWindow       *self)
-//
+//	static void
+//	dialog_cb (AdwAlertDialog *dialog,
+//	           GAsyncResult   *result,
+//	           MyWindow       *self)
 //	{
 //	  const char *response = adw_alert_dialog_choose_finish (dialog, result);
 //
 //	  // ...
 //	}
 //
-// static void
-// show_dialog (MyWindow *self)
-//
+//	static void
+//	show_dialog (MyWindow *self)
 //	{
 //	  AdwDialog *dialog;
 //
@@ -181,42 +204,36 @@ const (
 //	                           NULL, (GAsyncReadyCallback) dialog_cb, self);
 //	}
 //
-// ```
-//
-// ## AdwAlertDialog as GtkBuildable
+// # AdwAlertDialog as GtkBuildable
 //
 // `AdwAlertDialog` supports adding responses in UI definitions by via the
-// `&lt;responses&gt;` element that may contain multiple `&lt;response&gt;` elements, each
+// `<responses>` element that may contain multiple `<response>` elements, each
 // representing a response.
 //
-// Each of the `&lt;response&gt;` elements must have the `id` attribute specifying the
+// Each of the `<response>` elements must have the `id` attribute specifying the
 // response ID. The contents of the element are used as the response label.
 //
 // Response labels can be translated with the usual `translatable`, `context`
 // and `comments` attributes.
 //
-// The `&lt;response&gt;` elements can also have `enabled` and/or `appearance`
-// attributes. See [method@AlertDialog.set_response_enabled] and
-// [method@AlertDialog.set_response_appearance] for details.
+// The `<response>` elements can also have `enabled` and/or `appearance`
+// attributes. See set_response_enabled and
+// set_response_appearance for details.
 //
 // Example of an `AdwAlertDialog` UI definition:
 //
-// ```xml
-// &lt;object class="AdwAlertDialog" id="dialog"&gt;
-//
-//	&lt;property name="heading" translatable="yes"&gt;Save Changes?&lt;/property&gt;
-//	&lt;property name="body" translatable="yes"&gt;Open documents contain unsaved changes. Changes which are not saved will be permanently lost.&lt;/property&gt;
-//	&lt;property name="default-response"&gt;save&lt;/property&gt;
-//	&lt;property name="close-response"&gt;cancel&lt;/property&gt;
-//	&lt;signal name="response" handler="response_cb"/&gt;
-//	&lt;responses&gt;
-//	  &lt;response id="cancel" translatable="yes"&gt;_Cancel&lt;/response&gt;
-//	  &lt;response id="discard" translatable="yes" appearance="destructive"&gt;_Discard&lt;/response&gt;
-//	  &lt;response id="save" translatable="yes" appearance="suggested" enabled="false"&gt;_Save&lt;/response&gt;
-//	&lt;/responses&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwAlertDialog" id="dialog">
+//	  <property name="heading" translatable="yes">Save Changes?</property>
+//	  <property name="body" translatable="yes">Open documents contain unsaved changes. Changes which are not saved will be permanently lost.</property>
+//	  <property name="default-response">save</property>
+//	  <property name="close-response">cancel</property>
+//	  <signal name="response" handler="response_cb"/>
+//	  <responses>
+//	    <response id="cancel" translatable="yes">_Cancel</response>
+//	    <response id="discard" translatable="yes" appearance="destructive">_Discard</response>
+//	    <response id="save" translatable="yes" appearance="suggested" enabled="false">_Save</response>
+//	  </responses>
+//	</object>
 type AlertDialog struct {
 	Dialog
 }
@@ -237,20 +254,16 @@ var xNewAlertDialog func(uintptr, uintptr) uintptr
 
 // Creates a new `AdwAlertDialog`.
 //
-// @heading and @body can be set to `NULL`. This can be useful if they need to
+// heading and body can be set to `NULL`. This can be useful if they need to
 // be formatted or use markup. In that case, set them to `NULL` and call
-// [method@AlertDialog.format_body] or similar methods afterwards:
-//
-// ```c
-// AdwDialog *dialog;
-//
-// dialog = adw_alert_dialog_new (_("Replace File?"), NULL);
-// adw_alert_dialog_format_body (ADW_ALERT_DIALOG (dialog),
+// format_body or similar methods afterwards:
 //
-//	_("A file named “%s” already exists.  Do you want to replace it?"),
-//	filename);
+//	AdwDialog *dialog;
 //
-// ```
+//	dialog = adw_alert_dialog_new (_("Replace File?"), NULL);
+//	adw_alert_dialog_format_body (ADW_ALERT_DIALOG (dialog),
+//	                              _("A file named “%s” already exists.  Do you want to replace it?"),
+//	                              filename);
 func NewAlertDialog(HeadingVar *string, BodyVar *string) *AlertDialog {
 	var cls *AlertDialog
 
@@ -273,21 +286,21 @@ func NewAlertDialog(HeadingVar *string, BodyVar *string) *AlertDialog {
 
 var xAlertDialogAddResponse func(uintptr, string, string)
 
-// Adds a response with @id and @label to @self.
+// Adds a response with id and label to self.
 //
 // Responses are represented as buttons in the dialog.
 //
-// Response ID must be unique. It will be used in [signal@AlertDialog::response]
+// Response ID must be unique. It will be used in AlertDialog::response
 // to tell which response had been activated, as well as to inspect and modify
 // the response later.
 //
-// An embedded underline in @label indicates a mnemonic.
+// An embedded underline in label indicates a mnemonic.
 //
-// [method@AlertDialog.set_response_label] can be used to change the response
+// set_response_label can be used to change the response
 // label after it had been added.
 //
-// [method@AlertDialog.set_response_enabled] and
-// [method@AlertDialog.set_response_appearance] can be used to customize the
+// set_response_enabled and
+// set_response_appearance can be used to customize the
 // responses further.
 func (x *AlertDialog) AddResponse(IdVar string, LabelVar string) {
 
@@ -297,23 +310,19 @@ func (x *AlertDialog) AddResponse(IdVar string, LabelVar string) {
 
 var xAlertDialogAddResponses func(uintptr, string, ...interface{})
 
-// Adds multiple responses to @self.
+// Adds multiple responses to self.
 //
-// This is the same as calling [method@AlertDialog.add_response] repeatedly. The
+// This is the same as calling add_response repeatedly. The
 // variable argument list should be `NULL`-terminated list of response IDs and
 // labels.
 //
 // Example:
 //
-// ```c
-// adw_alert_dialog_add_responses (dialog,
-//
-//	"cancel",  _("_Cancel"),
-//	"discard", _("_Discard"),
-//	"save",    _("_Save"),
-//	NULL);
-//
-// ```
+//	adw_alert_dialog_add_responses (dialog,
+//	                                "cancel",  _("_Cancel"),
+//	                                "discard", _("_Discard"),
+//	                                "save",    _("_Save"),
+//	                                NULL);
 func (x *AlertDialog) AddResponses(FirstIdVar string, varArgs ...interface{}) {
 
 	xAlertDialogAddResponses(x.GoPointer(), FirstIdVar, varArgs...)
@@ -322,9 +331,9 @@ func (x *AlertDialog) AddResponses(FirstIdVar string, varArgs ...interface{}) {
 
 var xAlertDialogChoose func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// This function shows @self to the user.
+// This function shows self to the user.
 //
-// If the window is an [class@Window] or [class@ApplicationWindow], the dialog
+// If the window is an Window or ApplicationWindow, the dialog
 // will be shown within it. Otherwise, it will be a separate window.
 func (x *AlertDialog) Choose(ParentVar *gtk.Widget, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -335,11 +344,12 @@ func (x *AlertDialog) Choose(ParentVar *gtk.Widget, CancellableVar *gio.Cancella
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -349,7 +359,7 @@ func (x *AlertDialog) Choose(ParentVar *gtk.Widget, CancellableVar *gio.Cancella
 
 var xAlertDialogChooseFinish func(uintptr, uintptr) string
 
-// Finishes the [method@AlertDialog.choose] call and returns the response ID.
+// Finishes the choose call and returns the response ID.
 func (x *AlertDialog) ChooseFinish(ResultVar gio.AsyncResult) string {
 
 	cret := xAlertDialogChooseFinish(x.GoPointer(), ResultVar.GoPointer())
@@ -358,9 +368,9 @@ func (x *AlertDialog) ChooseFinish(ResultVar gio.AsyncResult) string {
 
 var xAlertDialogFormatBody func(uintptr, string, ...interface{})
 
-// Sets the formatted body text of @self.
+// Sets the formatted body text of self.
 //
-// See [property@AlertDialog:body].
+// See AlertDialog:body.
 func (x *AlertDialog) FormatBody(FormatVar string, varArgs ...interface{}) {
 
 	xAlertDialogFormatBody(x.GoPointer(), FormatVar, varArgs...)
@@ -369,15 +379,15 @@ func (x *AlertDialog) FormatBody(FormatVar string, varArgs ...interface{}) {
 
 var xAlertDialogFormatBodyMarkup func(uintptr, string, ...interface{})
 
-// Sets the formatted body text of @self with Pango markup.
+// Sets the formatted body text of self with Pango markup.
 //
-// The @format is assumed to contain Pango markup.
+// The format is assumed to contain Pango markup.
 //
 // Special XML characters in the `printf()` arguments passed to this function
 // will automatically be escaped as necessary, see
-// [func@GLib.markup_printf_escaped].
+// [glib.MarkupPrintfEscaped].
 //
-// See [property@AlertDialog:body].
+// See AlertDialog:body.
 func (x *AlertDialog) FormatBodyMarkup(FormatVar string, varArgs ...interface{}) {
 
 	xAlertDialogFormatBodyMarkup(x.GoPointer(), FormatVar, varArgs...)
@@ -386,9 +396,9 @@ func (x *AlertDialog) FormatBodyMarkup(FormatVar string, varArgs ...interface{})
 
 var xAlertDialogFormatHeading func(uintptr, string, ...interface{})
 
-// Sets the formatted heading of @self.
+// Sets the formatted heading of self.
 //
-// See [property@AlertDialog:heading].
+// See AlertDialog:heading.
 func (x *AlertDialog) FormatHeading(FormatVar string, varArgs ...interface{}) {
 
 	xAlertDialogFormatHeading(x.GoPointer(), FormatVar, varArgs...)
@@ -397,15 +407,15 @@ func (x *AlertDialog) FormatHeading(FormatVar string, varArgs ...interface{}) {
 
 var xAlertDialogFormatHeadingMarkup func(uintptr, string, ...interface{})
 
-// Sets the formatted heading of @self with Pango markup.
+// Sets the formatted heading of self with Pango markup.
 //
-// The @format is assumed to contain Pango markup.
+// The format is assumed to contain Pango markup.
 //
 // Special XML characters in the `printf()` arguments passed to this function
 // will automatically be escaped as necessary, see
-// [func@GLib.markup_printf_escaped].
+// [glib.MarkupPrintfEscaped].
 //
-// See [property@AlertDialog:heading].
+// See AlertDialog:heading.
 func (x *AlertDialog) FormatHeadingMarkup(FormatVar string, varArgs ...interface{}) {
 
 	xAlertDialogFormatHeadingMarkup(x.GoPointer(), FormatVar, varArgs...)
@@ -414,7 +424,7 @@ func (x *AlertDialog) FormatHeadingMarkup(FormatVar string, varArgs ...interface
 
 var xAlertDialogGetBody func(uintptr) string
 
-// Gets the body text of @self.
+// Gets the body text of self.
 func (x *AlertDialog) GetBody() string {
 
 	cret := xAlertDialogGetBody(x.GoPointer())
@@ -423,7 +433,7 @@ func (x *AlertDialog) GetBody() string {
 
 var xAlertDialogGetBodyUseMarkup func(uintptr) bool
 
-// Gets whether the body text of @self includes Pango markup.
+// Gets whether the body text of self includes Pango markup.
 func (x *AlertDialog) GetBodyUseMarkup() bool {
 
 	cret := xAlertDialogGetBodyUseMarkup(x.GoPointer())
@@ -432,7 +442,7 @@ func (x *AlertDialog) GetBodyUseMarkup() bool {
 
 var xAlertDialogGetCloseResponse func(uintptr) string
 
-// Gets the ID of the close response of @self.
+// Gets the ID of the close response of self.
 func (x *AlertDialog) GetCloseResponse() string {
 
 	cret := xAlertDialogGetCloseResponse(x.GoPointer())
@@ -441,7 +451,7 @@ func (x *AlertDialog) GetCloseResponse() string {
 
 var xAlertDialogGetDefaultResponse func(uintptr) string
 
-// Gets the ID of the default response of @self.
+// Gets the ID of the default response of self.
 func (x *AlertDialog) GetDefaultResponse() string {
 
 	cret := xAlertDialogGetDefaultResponse(x.GoPointer())
@@ -450,7 +460,7 @@ func (x *AlertDialog) GetDefaultResponse() string {
 
 var xAlertDialogGetExtraChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *AlertDialog) GetExtraChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -467,7 +477,7 @@ func (x *AlertDialog) GetExtraChild() *gtk.Widget {
 
 var xAlertDialogGetHeading func(uintptr) string
 
-// Gets the heading of @self.
+// Gets the heading of self.
 func (x *AlertDialog) GetHeading() string {
 
 	cret := xAlertDialogGetHeading(x.GoPointer())
@@ -476,7 +486,7 @@ func (x *AlertDialog) GetHeading() string {
 
 var xAlertDialogGetHeadingUseMarkup func(uintptr) bool
 
-// Gets whether the heading of @self includes Pango markup.
+// Gets whether the heading of self includes Pango markup.
 func (x *AlertDialog) GetHeadingUseMarkup() bool {
 
 	cret := xAlertDialogGetHeadingUseMarkup(x.GoPointer())
@@ -485,7 +495,7 @@ func (x *AlertDialog) GetHeadingUseMarkup() bool {
 
 var xAlertDialogGetPreferWideLayout func(uintptr) bool
 
-// Gets whether @self prefers wide layout.
+// Gets whether self prefers wide layout.
 func (x *AlertDialog) GetPreferWideLayout() bool {
 
 	cret := xAlertDialogGetPreferWideLayout(x.GoPointer())
@@ -494,9 +504,9 @@ func (x *AlertDialog) GetPreferWideLayout() bool {
 
 var xAlertDialogGetResponseAppearance func(uintptr, string) ResponseAppearance
 
-// Gets the appearance of @response.
+// Gets the appearance of response.
 //
-// See [method@AlertDialog.set_response_appearance].
+// See set_response_appearance.
 func (x *AlertDialog) GetResponseAppearance(ResponseVar string) ResponseAppearance {
 
 	cret := xAlertDialogGetResponseAppearance(x.GoPointer(), ResponseVar)
@@ -505,9 +515,9 @@ func (x *AlertDialog) GetResponseAppearance(ResponseVar string) ResponseAppearan
 
 var xAlertDialogGetResponseEnabled func(uintptr, string) bool
 
-// Gets whether @response is enabled.
+// Gets whether response is enabled.
 //
-// See [method@AlertDialog.set_response_enabled].
+// See set_response_enabled.
 func (x *AlertDialog) GetResponseEnabled(ResponseVar string) bool {
 
 	cret := xAlertDialogGetResponseEnabled(x.GoPointer(), ResponseVar)
@@ -516,9 +526,9 @@ func (x *AlertDialog) GetResponseEnabled(ResponseVar string) bool {
 
 var xAlertDialogGetResponseLabel func(uintptr, string) string
 
-// Gets the label of @response.
+// Gets the label of response.
 //
-// See [method@AlertDialog.set_response_label].
+// See set_response_label.
 func (x *AlertDialog) GetResponseLabel(ResponseVar string) string {
 
 	cret := xAlertDialogGetResponseLabel(x.GoPointer(), ResponseVar)
@@ -527,7 +537,7 @@ func (x *AlertDialog) GetResponseLabel(ResponseVar string) string {
 
 var xAlertDialogHasResponse func(uintptr, string) bool
 
-// Gets whether @self has a response with the ID @response.
+// Gets whether self has a response with the ID response.
 func (x *AlertDialog) HasResponse(ResponseVar string) bool {
 
 	cret := xAlertDialogHasResponse(x.GoPointer(), ResponseVar)
@@ -536,7 +546,7 @@ func (x *AlertDialog) HasResponse(ResponseVar string) bool {
 
 var xAlertDialogRemoveResponse func(uintptr, string)
 
-// Removes a response from @self.
+// Removes a response from self.
 func (x *AlertDialog) RemoveResponse(IdVar string) {
 
 	xAlertDialogRemoveResponse(x.GoPointer(), IdVar)
@@ -545,7 +555,7 @@ func (x *AlertDialog) RemoveResponse(IdVar string) {
 
 var xAlertDialogSetBody func(uintptr, string)
 
-// Sets the body text of @self.
+// Sets the body text of self.
 func (x *AlertDialog) SetBody(BodyVar string) {
 
 	xAlertDialogSetBody(x.GoPointer(), BodyVar)
@@ -554,9 +564,9 @@ func (x *AlertDialog) SetBody(BodyVar string) {
 
 var xAlertDialogSetBodyUseMarkup func(uintptr, bool)
 
-// Sets whether the body text of @self includes Pango markup.
+// Sets whether the body text of self includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) SetBodyUseMarkup(UseMarkupVar bool) {
 
 	xAlertDialogSetBodyUseMarkup(x.GoPointer(), UseMarkupVar)
@@ -565,10 +575,10 @@ func (x *AlertDialog) SetBodyUseMarkup(UseMarkupVar bool) {
 
 var xAlertDialogSetCloseResponse func(uintptr, string)
 
-// Sets the ID of the close response of @self.
+// Sets the ID of the close response of self.
 //
-// It will be passed to [signal@AlertDialog::response] if the dialog is closed
-// by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
+// It will be passed to AlertDialog::response if the dialog is closed
+// by pressing Escape or with a system action.
 //
 // It doesn't have to correspond to any of the responses in the dialog.
 //
@@ -581,15 +591,15 @@ func (x *AlertDialog) SetCloseResponse(ResponseVar string) {
 
 var xAlertDialogSetDefaultResponse func(uintptr, uintptr)
 
-// Sets the ID of the default response of @self.
+// Sets the ID of the default response of self.
 //
 // The button corresponding to this response will be set as the default widget
-// of @self.
+// of self.
 //
 // If not set, the default widget will not be set, and the last added response
 // will be focused by default.
 //
-// See [property@Dialog:default-widget].
+// See Dialog:default-widget.
 func (x *AlertDialog) SetDefaultResponse(ResponseVar *string) {
 
 	ResponseVarPtr := core.GStrdupNullable(ResponseVar)
@@ -601,7 +611,7 @@ func (x *AlertDialog) SetDefaultResponse(ResponseVar *string) {
 
 var xAlertDialogSetExtraChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 //
 // The child widget is displayed below the heading and body.
 func (x *AlertDialog) SetExtraChild(ChildVar *gtk.Widget) {
@@ -612,7 +622,7 @@ func (x *AlertDialog) SetExtraChild(ChildVar *gtk.Widget) {
 
 var xAlertDialogSetHeading func(uintptr, uintptr)
 
-// Sets the heading of @self.
+// Sets the heading of self.
 func (x *AlertDialog) SetHeading(HeadingVar *string) {
 
 	HeadingVarPtr := core.GStrdupNullable(HeadingVar)
@@ -624,9 +634,9 @@ func (x *AlertDialog) SetHeading(HeadingVar *string) {
 
 var xAlertDialogSetHeadingUseMarkup func(uintptr, bool)
 
-// Sets whether the heading of @self includes Pango markup.
+// Sets whether the heading of self includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) SetHeadingUseMarkup(UseMarkupVar bool) {
 
 	xAlertDialogSetHeadingUseMarkup(x.GoPointer(), UseMarkupVar)
@@ -635,7 +645,7 @@ func (x *AlertDialog) SetHeadingUseMarkup(UseMarkupVar bool) {
 
 var xAlertDialogSetPreferWideLayout func(uintptr, bool)
 
-// Sets whether @self prefers wide layout.
+// Sets whether self prefers wide layout.
 //
 // Prefer horizontal button layout when possible, and wider dialog width
 // otherwise.
@@ -647,20 +657,13 @@ func (x *AlertDialog) SetPreferWideLayout(PreferWideLayoutVar bool) {
 
 var xAlertDialogSetResponseAppearance func(uintptr, string, ResponseAppearance)
 
-// Sets the appearance for @response.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="alert-dialog-appearance-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="alert-dialog-appearance.png" alt="alert-dialog-appearance"&gt;
-//
-// &lt;/picture&gt;
+// Sets the appearance for response.
 //
 // Use `ADW_RESPONSE_SUGGESTED` to mark important responses such as the
 // affirmative action, like the Save button in the example.
 //
 // Use `ADW_RESPONSE_DESTRUCTIVE` to draw attention to the potentially damaging
-// consequences of using @response. This appearance acts as a warning to the
+// consequences of using response. This appearance acts as a warning to the
 // user. The Discard button in the example is using this appearance.
 //
 // The default appearance is `ADW_RESPONSE_DEFAULT`.
@@ -674,13 +677,13 @@ func (x *AlertDialog) SetResponseAppearance(ResponseVar string, AppearanceVar Re
 
 var xAlertDialogSetResponseEnabled func(uintptr, string, bool)
 
-// Sets whether @response is enabled.
+// Sets whether response is enabled.
 //
-// If @response is not enabled, the corresponding button will have
-// [property@Gtk.Widget:sensitive] set to `FALSE` and it can't be activated as
+// If response is not enabled, the corresponding button will have
+// [gtk.Widget.GetPropertySensitive] set to `FALSE` and it can't be activated as
 // a default response.
 //
-// @response can still be used as [property@AlertDialog:close-response] while
+// response can still be used as AlertDialog:close-response while
 // it's not enabled.
 //
 // Responses are enabled by default.
@@ -692,9 +695,9 @@ func (x *AlertDialog) SetResponseEnabled(ResponseVar string, EnabledVar bool) {
 
 var xAlertDialogSetResponseLabel func(uintptr, string, string)
 
-// Sets the label of @response to @label.
+// Sets the label of response to label.
 //
-// Labels are displayed on the dialog buttons. An embedded underline in @label
+// Labels are displayed on the dialog buttons. An embedded underline in label
 // indicates a mnemonic.
 func (x *AlertDialog) SetResponseLabel(ResponseVar string, LabelVar string) {
 
@@ -733,7 +736,7 @@ func (x *AlertDialog) GetPropertyBody() string {
 // SetPropertyBodyUseMarkup sets the "body-use-markup" property.
 // Whether the body text includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) SetPropertyBodyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -744,7 +747,7 @@ func (x *AlertDialog) SetPropertyBodyUseMarkup(value bool) {
 // GetPropertyBodyUseMarkup gets the "body-use-markup" property.
 // Whether the body text includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) GetPropertyBodyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("body-use-markup", &v)
@@ -754,8 +757,8 @@ func (x *AlertDialog) GetPropertyBodyUseMarkup() bool {
 // SetPropertyCloseResponse sets the "close-response" property.
 // The ID of the close response.
 //
-// It will be passed to [signal@AlertDialog::response] if the dialog is
-// closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
+// It will be passed to AlertDialog::response if the dialog is
+// closed by pressing Escape or with a system action.
 //
 // It doesn't have to correspond to any of the responses in the dialog.
 //
@@ -770,8 +773,8 @@ func (x *AlertDialog) SetPropertyCloseResponse(value string) {
 // GetPropertyCloseResponse gets the "close-response" property.
 // The ID of the close response.
 //
-// It will be passed to [signal@AlertDialog::response] if the dialog is
-// closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
+// It will be passed to AlertDialog::response if the dialog is
+// closed by pressing Escape or with a system action.
 //
 // It doesn't have to correspond to any of the responses in the dialog.
 //
@@ -791,7 +794,7 @@ func (x *AlertDialog) GetPropertyCloseResponse() string {
 // If not set, the default widget will not be set, and the last added response
 // will be focused by default.
 //
-// See [property@Dialog:default-widget].
+// See Dialog:default-widget.
 func (x *AlertDialog) SetPropertyDefaultResponse(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -808,7 +811,7 @@ func (x *AlertDialog) SetPropertyDefaultResponse(value string) {
 // If not set, the default widget will not be set, and the last added response
 // will be focused by default.
 //
-// See [property@Dialog:default-widget].
+// See Dialog:default-widget.
 func (x *AlertDialog) GetPropertyDefaultResponse() string {
 	var v gobject.Value
 	x.GetProperty("default-response", &v)
@@ -835,7 +838,7 @@ func (x *AlertDialog) GetPropertyHeading() string {
 // SetPropertyHeadingUseMarkup sets the "heading-use-markup" property.
 // Whether the heading includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) SetPropertyHeadingUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -846,7 +849,7 @@ func (x *AlertDialog) SetPropertyHeadingUseMarkup(value bool) {
 // GetPropertyHeadingUseMarkup gets the "heading-use-markup" property.
 // Whether the heading includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *AlertDialog) GetPropertyHeadingUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("heading-use-markup", &v)
@@ -878,59 +881,84 @@ func (x *AlertDialog) GetPropertyPreferWideLayout() bool {
 
 // This signal is emitted when the dialog is closed.
 //
-// @response will be set to the response ID of the button that had been
+// response will be set to the response ID of the button that had been
 // activated.
 //
-// if the dialog was closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system
-// action, @response will be set to the value of
-// [property@AlertDialog:close-response].
+// if the dialog was closed by pressing Escape or with a system
+// action, response will be set to the value of
+// AlertDialog:close-response.
+//
+// Deprecated: use ConnectResponseFunc, which also accepts method values and closures.
 func (x *AlertDialog) ConnectResponse(cb *func(AlertDialog, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
-
-	fcb := func(clsPtr uintptr, ResponseVarp string) {
-		fa := AlertDialog{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
+			fa := AlertDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-		cbFn(fa, ResponseVarp)
+			cbFn(fa, ResponseVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "response", cbRefPtr, cbPtr)
 }
 
 // ConnectResponseWithDetail connects to the "response" signal with a detail string.
 // The detail is appended as "response::<detail>".
+//
+// Deprecated: use ConnectResponseWithDetailFunc, which also accepts method values and closures.
 func (x *AlertDialog) ConnectResponseWithDetail(detail string, cb *func(AlertDialog, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("response::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
+			fa := AlertDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResponseVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xAlertDialogResponseTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ResponseVarp string) {
+func xAlertDialogResponseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResponseVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AlertDialog, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AlertDialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResponseVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResponseFunc connects cb to the "response" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AlertDialog) ConnectResponseFunc(cb func(AlertDialog, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "response", &xAlertDialogResponseTrampoline, xAlertDialogResponseNewTrampoline, cb)
+}
+
+// ConnectResponseWithDetailFunc connects cb to the "response" signal with a detail string, see ConnectResponseFunc.
+// The detail is appended as "response::<detail>".
+func (x *AlertDialog) ConnectResponseWithDetailFunc(detail string, cb func(AlertDialog, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("response::%s", detail), &xAlertDialogResponseTrampoline, xAlertDialogResponseNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1094,7 +1122,7 @@ func (x *AlertDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1102,14 +1130,13 @@ func (x *AlertDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AlertDialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1133,19 +1160,17 @@ func (x *AlertDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AlertDialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1166,7 +1191,7 @@ func (x *AlertDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1175,14 +1200,12 @@ func (x *AlertDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AlertDialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1201,10 +1224,10 @@ func (x *AlertDialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AlertDialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1216,7 +1239,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1227,39 +1250,41 @@ func init() {
 
 	core.PuregoSafeRegister(&xAlertDialogGLibType, libs, "adw_alert_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewAlertDialog, libs, "adw_alert_dialog_new")
-
-	core.PuregoSafeRegister(&xAlertDialogAddResponse, libs, "adw_alert_dialog_add_response")
-	core.PuregoSafeRegister(&xAlertDialogAddResponses, libs, "adw_alert_dialog_add_responses")
-	core.PuregoSafeRegister(&xAlertDialogChoose, libs, "adw_alert_dialog_choose")
-	core.PuregoSafeRegister(&xAlertDialogChooseFinish, libs, "adw_alert_dialog_choose_finish")
-	core.PuregoSafeRegister(&xAlertDialogFormatBody, libs, "adw_alert_dialog_format_body")
-	core.PuregoSafeRegister(&xAlertDialogFormatBodyMarkup, libs, "adw_alert_dialog_format_body_markup")
-	core.PuregoSafeRegister(&xAlertDialogFormatHeading, libs, "adw_alert_dialog_format_heading")
-	core.PuregoSafeRegister(&xAlertDialogFormatHeadingMarkup, libs, "adw_alert_dialog_format_heading_markup")
-	core.PuregoSafeRegister(&xAlertDialogGetBody, libs, "adw_alert_dialog_get_body")
-	core.PuregoSafeRegister(&xAlertDialogGetBodyUseMarkup, libs, "adw_alert_dialog_get_body_use_markup")
-	core.PuregoSafeRegister(&xAlertDialogGetCloseResponse, libs, "adw_alert_dialog_get_close_response")
-	core.PuregoSafeRegister(&xAlertDialogGetDefaultResponse, libs, "adw_alert_dialog_get_default_response")
-	core.PuregoSafeRegister(&xAlertDialogGetExtraChild, libs, "adw_alert_dialog_get_extra_child")
-	core.PuregoSafeRegister(&xAlertDialogGetHeading, libs, "adw_alert_dialog_get_heading")
-	core.PuregoSafeRegister(&xAlertDialogGetHeadingUseMarkup, libs, "adw_alert_dialog_get_heading_use_markup")
-	core.PuregoSafeRegister(&xAlertDialogGetPreferWideLayout, libs, "adw_alert_dialog_get_prefer_wide_layout")
-	core.PuregoSafeRegister(&xAlertDialogGetResponseAppearance, libs, "adw_alert_dialog_get_response_appearance")
-	core.PuregoSafeRegister(&xAlertDialogGetResponseEnabled, libs, "adw_alert_dialog_get_response_enabled")
-	core.PuregoSafeRegister(&xAlertDialogGetResponseLabel, libs, "adw_alert_dialog_get_response_label")
-	core.PuregoSafeRegister(&xAlertDialogHasResponse, libs, "adw_alert_dialog_has_response")
-	core.PuregoSafeRegister(&xAlertDialogRemoveResponse, libs, "adw_alert_dialog_remove_response")
-	core.PuregoSafeRegister(&xAlertDialogSetBody, libs, "adw_alert_dialog_set_body")
-	core.PuregoSafeRegister(&xAlertDialogSetBodyUseMarkup, libs, "adw_alert_dialog_set_body_use_markup")
-	core.PuregoSafeRegister(&xAlertDialogSetCloseResponse, libs, "adw_alert_dialog_set_close_response")
-	core.PuregoSafeRegister(&xAlertDialogSetDefaultResponse, libs, "adw_alert_dialog_set_default_response")
-	core.PuregoSafeRegister(&xAlertDialogSetExtraChild, libs, "adw_alert_dialog_set_extra_child")
-	core.PuregoSafeRegister(&xAlertDialogSetHeading, libs, "adw_alert_dialog_set_heading")
-	core.PuregoSafeRegister(&xAlertDialogSetHeadingUseMarkup, libs, "adw_alert_dialog_set_heading_use_markup")
-	core.PuregoSafeRegister(&xAlertDialogSetPreferWideLayout, libs, "adw_alert_dialog_set_prefer_wide_layout")
-	core.PuregoSafeRegister(&xAlertDialogSetResponseAppearance, libs, "adw_alert_dialog_set_response_appearance")
-	core.PuregoSafeRegister(&xAlertDialogSetResponseEnabled, libs, "adw_alert_dialog_set_response_enabled")
-	core.PuregoSafeRegister(&xAlertDialogSetResponseLabel, libs, "adw_alert_dialog_set_response_label")
+	gobject.CheckClassSize("AdwAlertDialogClass", xAlertDialogGLibType, unsafe.Sizeof(AlertDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewAlertDialog, libs, "adw_alert_dialog_new", "ADW", "1.5")
+
+	core.PuregoRegisterSince(&xAlertDialogAddResponse, libs, "adw_alert_dialog_add_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogAddResponses, libs, "adw_alert_dialog_add_responses", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogChoose, libs, "adw_alert_dialog_choose", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogChooseFinish, libs, "adw_alert_dialog_choose_finish", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogFormatBody, libs, "adw_alert_dialog_format_body", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogFormatBodyMarkup, libs, "adw_alert_dialog_format_body_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogFormatHeading, libs, "adw_alert_dialog_format_heading", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogFormatHeadingMarkup, libs, "adw_alert_dialog_format_heading_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetBody, libs, "adw_alert_dialog_get_body", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetBodyUseMarkup, libs, "adw_alert_dialog_get_body_use_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetCloseResponse, libs, "adw_alert_dialog_get_close_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetDefaultResponse, libs, "adw_alert_dialog_get_default_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetExtraChild, libs, "adw_alert_dialog_get_extra_child", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetHeading, libs, "adw_alert_dialog_get_heading", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetHeadingUseMarkup, libs, "adw_alert_dialog_get_heading_use_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetPreferWideLayout, libs, "adw_alert_dialog_get_prefer_wide_layout", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetResponseAppearance, libs, "adw_alert_dialog_get_response_appearance", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetResponseEnabled, libs, "adw_alert_dialog_get_response_enabled", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogGetResponseLabel, libs, "adw_alert_dialog_get_response_label", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogHasResponse, libs, "adw_alert_dialog_has_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogRemoveResponse, libs, "adw_alert_dialog_remove_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetBody, libs, "adw_alert_dialog_set_body", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetBodyUseMarkup, libs, "adw_alert_dialog_set_body_use_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetCloseResponse, libs, "adw_alert_dialog_set_close_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetDefaultResponse, libs, "adw_alert_dialog_set_default_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetExtraChild, libs, "adw_alert_dialog_set_extra_child", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetHeading, libs, "adw_alert_dialog_set_heading", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetHeadingUseMarkup, libs, "adw_alert_dialog_set_heading_use_markup", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetPreferWideLayout, libs, "adw_alert_dialog_set_prefer_wide_layout", "ADW", "1.6")
+	core.PuregoRegisterSince(&xAlertDialogSetResponseAppearance, libs, "adw_alert_dialog_set_response_appearance", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetResponseEnabled, libs, "adw_alert_dialog_set_response_enabled", "ADW", "1.5")
+	core.PuregoRegisterSince(&xAlertDialogSetResponseLabel, libs, "adw_alert_dialog_set_response_label", "ADW", "1.5")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,7 +38,7 @@ func (x *PropertyAnimationTargetClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Represents a value [class@Animation] can animate.
+// Represents a value Animation can animate.
 type AnimationTarget struct {
 	gobject.Object
 }
@@ -67,7 +66,7 @@ func (c *AnimationTarget) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// An [class@AnimationTarget] that calls a given callback during the
+// An AnimationTarget that calls a given callback during the
 // animation.
 type CallbackAnimationTarget struct {
 	AnimationTarget
@@ -87,7 +86,7 @@ func CallbackAnimationTargetNewFromInternalPtr(ptr uintptr) *CallbackAnimationTa
 
 var xNewCallbackAnimationTarget func(uintptr, uintptr, uintptr) uintptr
 
-// Creates a new `AdwAnimationTarget` that calls the given @callback during
+// Creates a new `AdwAnimationTarget` that calls the given callback during
 // the animation.
 func NewCallbackAnimationTarget(CallbackVar *AnimationTargetFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) *CallbackAnimationTarget {
 	var cls *CallbackAnimationTarget
@@ -99,11 +98,12 @@ func NewCallbackAnimationTarget(CallbackVar *AnimationTargetFunc, UserDataVar ui
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 float64, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -114,11 +114,12 @@ func NewCallbackAnimationTarget(CallbackVar *AnimationTargetFunc, UserDataVar ui
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -143,8 +144,8 @@ func (c *CallbackAnimationTarget) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// An [class@AnimationTarget] changing the value of a property of a
-// [class@GObject.Object] instance.
+// An AnimationTarget changing the value of a property of a
+// [gobject.Object] instance.
 type PropertyAnimationTarget struct {
 	AnimationTarget
 }
@@ -163,8 +164,8 @@ func PropertyAnimationTargetNewFromInternalPtr(ptr uintptr) *PropertyAnimationTa
 
 var xNewPropertyAnimationTarget func(uintptr, string) uintptr
 
-// Creates a new `AdwPropertyAnimationTarget` for the @property_name property on
-// @object.
+// Creates a new `AdwPropertyAnimationTarget` for the property_name property on
+// object.
 func NewPropertyAnimationTarget(ObjectVar *gobject.Object, PropertyNameVar string) *PropertyAnimationTarget {
 	var cls *PropertyAnimationTarget
 
@@ -180,8 +181,8 @@ func NewPropertyAnimationTarget(ObjectVar *gobject.Object, PropertyNameVar strin
 
 var xNewPropertyAnimationTargetForPspec func(uintptr, uintptr) uintptr
 
-// Creates a new `AdwPropertyAnimationTarget` for the @pspec property on
-// @object.
+// Creates a new `AdwPropertyAnimationTarget` for the pspec property on
+// object.
 func NewPropertyAnimationTargetForPspec(ObjectVar *gobject.Object, PspecVar *gobject.ParamSpec) *PropertyAnimationTarget {
 	var cls *PropertyAnimationTarget
 
@@ -197,7 +198,7 @@ func NewPropertyAnimationTargetForPspec(ObjectVar *gobject.Object, PspecVar *gob
 
 var xPropertyAnimationTargetGetObject func(uintptr) uintptr
 
-// Gets the object animated by @self.
+// Gets the object animated by self.
 //
 // The `AdwPropertyAnimationTarget` instance does not hold a strong reference on
 // the object; make sure the object is kept alive throughout the target's
@@ -218,7 +219,7 @@ func (x *PropertyAnimationTarget) GetObject() *gobject.Object {
 
 var xPropertyAnimationTargetGetPspec func(uintptr) uintptr
 
-// Gets the `GParamSpec` of the property animated by @self.
+// Gets the `GParamSpec` of the property animated by self.
 func (x *PropertyAnimationTarget) GetPspec() *gobject.ParamSpec {
 	var cls *gobject.ParamSpec
 
@@ -249,7 +250,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -260,14 +261,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xCallbackAnimationTargetGLibType, libs, "adw_callback_animation_target_get_type")
 
-	core.PuregoSafeRegister(&xNewCallbackAnimationTarget, libs, "adw_callback_animation_target_new")
+	core.PuregoRegisterSince(&xNewCallbackAnimationTarget, libs, "adw_callback_animation_target_new", "ADW", "")
 
 	core.PuregoSafeRegister(&xPropertyAnimationTargetGLibType, libs, "adw_property_animation_target_get_type")
 
-	core.PuregoSafeRegister(&xNewPropertyAnimationTarget, libs, "adw_property_animation_target_new")
-	core.PuregoSafeRegister(&xNewPropertyAnimationTargetForPspec, libs, "adw_property_animation_target_new_for_pspec")
+	core.PuregoRegisterSince(&xNewPropertyAnimationTarget, libs, "adw_property_animation_target_new", "ADW", "1.2")
+	core.PuregoRegisterSince(&xNewPropertyAnimationTargetForPspec, libs, "adw_property_animation_target_new_for_pspec", "ADW", "1.2")
 
-	core.PuregoSafeRegister(&xPropertyAnimationTargetGetObject, libs, "adw_property_animation_target_get_object")
-	core.PuregoSafeRegister(&xPropertyAnimationTargetGetPspec, libs, "adw_property_animation_target_get_pspec")
+	core.PuregoRegisterSince(&xPropertyAnimationTargetGetObject, libs, "adw_property_animation_target_get_object", "ADW", "1.2")
+	core.PuregoRegisterSince(&xPropertyAnimationTargetGetPspec, libs, "adw_property_animation_target_get_pspec", "ADW", "1.2")
 
 }
@@ -2,14 +2,13 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 )
 
 var xGetEnableAnimations func(uintptr) bool
 
-// Checks whether animations are enabled for @widget.
+// Checks whether animations are enabled for widget.
 //
 // This should be used when implementing an animated widget to know whether to
 // animate it or not.
@@ -21,7 +20,7 @@ func GetEnableAnimations(WidgetVar *gtk.Widget) bool {
 
 var xLerp func(float64, float64, float64) float64
 
-// Computes the linear interpolation between @a and @b for @t.
+// Computes the linear interpolation between a and b for t.
 func Lerp(AVar float64, BVar float64, TVar float64) float64 {
 
 	cret := xLerp(AVar, BVar, TVar)
@@ -33,14 +32,14 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xGetEnableAnimations, libs, "adw_get_enable_animations")
-	core.PuregoSafeRegister(&xLerp, libs, "adw_lerp")
+	core.PuregoRegisterSince(&xGetEnableAnimations, libs, "adw_get_enable_animations", "ADW", "")
+	core.PuregoRegisterSince(&xLerp, libs, "adw_lerp", "ADW", "")
 
 }
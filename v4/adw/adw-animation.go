@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,17 +22,17 @@ func (x *AnimationClass) GoPointer() uintptr {
 }
 
 const (
-	// Indicates an [class@Animation] with an infinite duration.
+	// Indicates an Animation with an infinite duration.
 	//
 	// This value is mostly used internally.
 	DURATION_INFINITE uint = 4294967295
 )
 
-// Describes the possible states of an [class@Animation].
+// Describes the possible states of an Animation.
 //
-// The state can be controlled with [method@Animation.play],
-// [method@Animation.pause], [method@Animation.resume],
-// [method@Animation.reset] and [method@Animation.skip].
+// The state can be controlled with play,
+// pause, resume,
+// reset and skip.
 type AnimationState int
 
 var xAnimationStateGLibType func() types.GType
@@ -53,6 +53,51 @@ const (
 	AnimationFinishedValue AnimationState = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AnimationState) String() string {
+	switch x {
+	case AnimationIdleValue:
+		return "ADW_ANIMATION_IDLE"
+	case AnimationPausedValue:
+		return "ADW_ANIMATION_PAUSED"
+	case AnimationPlayingValue:
+		return "ADW_ANIMATION_PLAYING"
+	case AnimationFinishedValue:
+		return "ADW_ANIMATION_FINISHED"
+	}
+	return fmt.Sprintf("AnimationState(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AnimationState) Nick() string {
+	switch x {
+	case AnimationIdleValue:
+		return "idle"
+	case AnimationPausedValue:
+		return "paused"
+	case AnimationPlayingValue:
+		return "playing"
+	case AnimationFinishedValue:
+		return "finished"
+	}
+	return ""
+}
+
+// AnimationStateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AnimationStateFromString(s string) (AnimationState, bool) {
+	switch s {
+	case "ADW_ANIMATION_IDLE", "idle":
+		return AnimationIdleValue, true
+	case "ADW_ANIMATION_PAUSED", "paused":
+		return AnimationPausedValue, true
+	case "ADW_ANIMATION_PLAYING", "playing":
+		return AnimationPlayingValue, true
+	case "ADW_ANIMATION_FINISHED", "finished":
+		return AnimationFinishedValue, true
+	}
+	return 0, false
+}
+
 // A base class for animations.
 //
 // `AdwAnimation` represents an animation on a widget. It has a target that
@@ -60,33 +105,29 @@ const (
 // animation hasn't been started yet, is playing, paused or finished.
 //
 // Currently there are two concrete animation types:
-// [class@TimedAnimation] and [class@SpringAnimation].
+// TimedAnimation and SpringAnimation.
 //
 // `AdwAnimation` will automatically skip the animation if
-// [property@Animation:widget] is unmapped, or if
-// [property@Gtk.Settings:gtk-enable-animations] is `FALSE`.
+// Animation:widget is unmapped, or if
+// [gtk.Settings.GetPropertyGtkEnableAnimations] is `FALSE`.
 //
-// The [signal@Animation::done] signal can be used to perform an action after
+// The Animation::done signal can be used to perform an action after
 // the animation ends, for example hiding a widget after animating its
-// [property@Gtk.Widget:opacity] to 0.
+// [gtk.Widget.GetPropertyOpacity] to 0.
 //
 // `AdwAnimation` will be kept alive while the animation is playing. As such,
 // it's safe to create an animation, start it and immediately unref it:
 // A fire-and-forget animation:
 //
-// ```c
-// static void
-// animation_cb (double    value,
-//
-//	MyObject *self)
-//
+//	static void
+//	animation_cb (double    value,
+//	              MyObject *self)
 //	{
 //	  // Do something with @value
 //	}
 //
-// static void
-// my_object_animate (MyObject *self)
-//
+//	static void
+//	my_object_animate (MyObject *self)
 //	{
 //	  AdwAnimationTarget *target =
 //	    adw_callback_animation_target_new ((AdwAnimationTargetFunc) animation_cb,
@@ -97,8 +138,6 @@ const (
 //	  adw_animation_play (animation);
 //	}
 //
-// ```
-//
 // If there's a chance the previous animation for the same target hasn't yet
 // finished, the previous animation should be stopped first, or the existing
 // `AdwAnimation` object can be reused.
@@ -120,7 +159,7 @@ func AnimationNewFromInternalPtr(ptr uintptr) *Animation {
 
 var xAnimationGetFollowEnableAnimationsSetting func(uintptr) bool
 
-// Gets whether @self should be skipped when animations are globally disabled.
+// Gets whether self should be skipped when animations are globally disabled.
 func (x *Animation) GetFollowEnableAnimationsSetting() bool {
 
 	cret := xAnimationGetFollowEnableAnimationsSetting(x.GoPointer())
@@ -129,9 +168,9 @@ func (x *Animation) GetFollowEnableAnimationsSetting() bool {
 
 var xAnimationGetState func(uintptr) AnimationState
 
-// Gets the current value of @self.
+// Gets the current value of self.
 //
-// The state indicates whether @self is currently playing, paused, finished or
+// The state indicates whether self is currently playing, paused, finished or
 // hasn't been started yet.
 func (x *Animation) GetState() AnimationState {
 
@@ -141,7 +180,7 @@ func (x *Animation) GetState() AnimationState {
 
 var xAnimationGetTarget func(uintptr) uintptr
 
-// Gets the target @self animates.
+// Gets the target self animates.
 func (x *Animation) GetTarget() *AnimationTarget {
 	var cls *AnimationTarget
 
@@ -158,7 +197,7 @@ func (x *Animation) GetTarget() *AnimationTarget {
 
 var xAnimationGetValue func(uintptr) float64
 
-// Gets the current value of @self.
+// Gets the current value of self.
 func (x *Animation) GetValue() float64 {
 
 	cret := xAnimationGetValue(x.GoPointer())
@@ -167,7 +206,7 @@ func (x *Animation) GetValue() float64 {
 
 var xAnimationGetWidget func(uintptr) uintptr
 
-// Gets the widget @self was created for.
+// Gets the widget self was created for.
 //
 // It provides the frame clock for the animation. It's not strictly necessary
 // for this widget to be same as the one being animated.
@@ -191,11 +230,11 @@ func (x *Animation) GetWidget() *gtk.Widget {
 
 var xAnimationPause func(uintptr)
 
-// Pauses a playing animation for @self.
+// Pauses a playing animation for self.
 //
-// Does nothing if the current state of @self isn't `ADW_ANIMATION_PLAYING`.
+// Does nothing if the current state of self isn't `ADW_ANIMATION_PLAYING`.
 //
-// Sets [property@Animation:state] to `ADW_ANIMATION_PAUSED`.
+// Sets Animation:state to `ADW_ANIMATION_PAUSED`.
 func (x *Animation) Pause() {
 
 	xAnimationPause(x.GoPointer())
@@ -204,19 +243,19 @@ func (x *Animation) Pause() {
 
 var xAnimationPlay func(uintptr)
 
-// Starts the animation for @self.
+// Starts the animation for self.
 //
 // If the animation is playing, paused or has been completed, restarts it from
 // the beginning. This allows to easily play an animation regardless of whether
 // it's already playing or not.
 //
-// Sets [property@Animation:state] to `ADW_ANIMATION_PLAYING`.
+// Sets Animation:state to `ADW_ANIMATION_PLAYING`.
 //
-// The animation will be automatically skipped if [property@Animation:widget] is
-// unmapped, or if [property@Gtk.Settings:gtk-enable-animations] is `FALSE`.
+// The animation will be automatically skipped if Animation:widget is
+// unmapped, or if [gtk.Settings.GetPropertyGtkEnableAnimations] is `FALSE`.
 //
 // As such, it's not guaranteed that the animation will actually run. For
-// example, when using [func@GLib.idle_add] and starting an animation
+// example, when using [glib.IdleAdd] and starting an animation
 // immediately afterwards, it's entirely possible that the idle callback will
 // run after the animation has already finished, and not while it's playing.
 func (x *Animation) Play() {
@@ -227,9 +266,9 @@ func (x *Animation) Play() {
 
 var xAnimationReset func(uintptr)
 
-// Resets the animation for @self.
+// Resets the animation for self.
 //
-// Sets [property@Animation:state] to `ADW_ANIMATION_IDLE`.
+// Sets Animation:state to `ADW_ANIMATION_IDLE`.
 func (x *Animation) Reset() {
 
 	xAnimationReset(x.GoPointer())
@@ -238,12 +277,12 @@ func (x *Animation) Reset() {
 
 var xAnimationResume func(uintptr)
 
-// Resumes a paused animation for @self.
+// Resumes a paused animation for self.
 //
 // This function must only be used if the animation has been paused with
-// [method@Animation.pause].
+// pause.
 //
-// Sets [property@Animation:state] to `ADW_ANIMATION_PLAYING`.
+// Sets Animation:state to `ADW_ANIMATION_PLAYING`.
 func (x *Animation) Resume() {
 
 	xAnimationResume(x.GoPointer())
@@ -252,7 +291,7 @@ func (x *Animation) Resume() {
 
 var xAnimationSetFollowEnableAnimationsSetting func(uintptr, bool)
 
-// Sets whether to skip @self when animations are globally disabled.
+// Sets whether to skip self when animations are globally disabled.
 //
 // The default behavior is to skip the animation. Set to `FALSE` to disable this
 // behavior.
@@ -260,7 +299,7 @@ var xAnimationSetFollowEnableAnimationsSetting func(uintptr, bool)
 // This can be useful for cases where animation is essential, like spinners, or
 // in demo applications. Most other animations should keep it enabled.
 //
-// See [property@Gtk.Settings:gtk-enable-animations].
+// See [gtk.Settings.GetPropertyGtkEnableAnimations].
 func (x *Animation) SetFollowEnableAnimationsSetting(SettingVar bool) {
 
 	xAnimationSetFollowEnableAnimationsSetting(x.GoPointer(), SettingVar)
@@ -269,7 +308,7 @@ func (x *Animation) SetFollowEnableAnimationsSetting(SettingVar bool) {
 
 var xAnimationSetTarget func(uintptr, uintptr)
 
-// Sets the target @self animates to @target.
+// Sets the target self animates to target.
 func (x *Animation) SetTarget(TargetVar *AnimationTarget) {
 
 	xAnimationSetTarget(x.GoPointer(), TargetVar.GoPointer())
@@ -278,13 +317,13 @@ func (x *Animation) SetTarget(TargetVar *AnimationTarget) {
 
 var xAnimationSkip func(uintptr)
 
-// Skips the animation for @self.
+// Skips the animation for self.
 //
 // If the animation hasn't been started yet, is playing, or is paused, instantly
-// skips the animation to the end and causes [signal@Animation::done] to be
+// skips the animation to the end and causes Animation::done to be
 // emitted.
 //
-// Sets [property@Animation:state] to `ADW_ANIMATION_FINISHED`.
+// Sets Animation:state to `ADW_ANIMATION_FINISHED`.
 func (x *Animation) Skip() {
 
 	xAnimationSkip(x.GoPointer())
@@ -311,7 +350,7 @@ func (c *Animation) SetGoPointer(ptr uintptr) {
 // This can be useful for cases where animation is essential, like spinners,
 // or in demo applications. Most other animations should keep it enabled.
 //
-// See [property@Gtk.Settings:gtk-enable-animations].
+// See [gtk.Settings.GetPropertyGtkEnableAnimations].
 func (x *Animation) SetPropertyFollowEnableAnimationsSetting(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -328,7 +367,7 @@ func (x *Animation) SetPropertyFollowEnableAnimationsSetting(value bool) {
 // This can be useful for cases where animation is essential, like spinners,
 // or in demo applications. Most other animations should keep it enabled.
 //
-// See [property@Gtk.Settings:gtk-enable-animations].
+// See [gtk.Settings.GetPropertyGtkEnableAnimations].
 func (x *Animation) GetPropertyFollowEnableAnimationsSetting() bool {
 	var v gobject.Value
 	x.GetProperty("follow-enable-animations-setting", &v)
@@ -344,28 +383,50 @@ func (x *Animation) GetPropertyValue() float64 {
 }
 
 // This signal is emitted when the animation has been completed, either on its
-// own or via calling [method@Animation.skip].
+// own or via calling skip.
+//
+// Deprecated: use ConnectDoneFunc, which also accepts method values and closures.
 func (x *Animation) ConnectDone(cb *func(Animation)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "done", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Animation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "done", cbRefPtr, cbPtr)
+}
+
+var xAnimationDoneTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xAnimationDoneNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Animation))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Animation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "done", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDoneFunc connects cb to the "done" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Animation) ConnectDoneFunc(cb func(Animation)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "done", &xAnimationDoneTrampoline, xAnimationDoneNewTrampoline, cb)
 }
 
 func init() {
@@ -373,7 +434,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -384,17 +445,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xAnimationGLibType, libs, "adw_animation_get_type")
 
-	core.PuregoSafeRegister(&xAnimationGetFollowEnableAnimationsSetting, libs, "adw_animation_get_follow_enable_animations_setting")
-	core.PuregoSafeRegister(&xAnimationGetState, libs, "adw_animation_get_state")
-	core.PuregoSafeRegister(&xAnimationGetTarget, libs, "adw_animation_get_target")
-	core.PuregoSafeRegister(&xAnimationGetValue, libs, "adw_animation_get_value")
-	core.PuregoSafeRegister(&xAnimationGetWidget, libs, "adw_animation_get_widget")
-	core.PuregoSafeRegister(&xAnimationPause, libs, "adw_animation_pause")
-	core.PuregoSafeRegister(&xAnimationPlay, libs, "adw_animation_play")
-	core.PuregoSafeRegister(&xAnimationReset, libs, "adw_animation_reset")
-	core.PuregoSafeRegister(&xAnimationResume, libs, "adw_animation_resume")
-	core.PuregoSafeRegister(&xAnimationSetFollowEnableAnimationsSetting, libs, "adw_animation_set_follow_enable_animations_setting")
-	core.PuregoSafeRegister(&xAnimationSetTarget, libs, "adw_animation_set_target")
-	core.PuregoSafeRegister(&xAnimationSkip, libs, "adw_animation_skip")
+	core.PuregoRegisterSince(&xAnimationGetFollowEnableAnimationsSetting, libs, "adw_animation_get_follow_enable_animations_setting", "ADW", "1.3")
+	core.PuregoRegisterSince(&xAnimationGetState, libs, "adw_animation_get_state", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationGetTarget, libs, "adw_animation_get_target", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationGetValue, libs, "adw_animation_get_value", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationGetWidget, libs, "adw_animation_get_widget", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationPause, libs, "adw_animation_pause", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationPlay, libs, "adw_animation_play", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationReset, libs, "adw_animation_reset", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationResume, libs, "adw_animation_resume", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationSetFollowEnableAnimationsSetting, libs, "adw_animation_set_follow_enable_animations_setting", "ADW", "1.3")
+	core.PuregoRegisterSince(&xAnimationSetTarget, libs, "adw_animation_set_target", "ADW", "")
+	core.PuregoRegisterSince(&xAnimationSkip, libs, "adw_animation_skip", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -30,38 +29,27 @@ func (x *ApplicationWindowClass) GoPointer() uintptr {
 
 // A freeform application window.
 //
-// &lt;picture&gt;
+// `AdwApplicationWindow` is a [gtk.ApplicationWindow] subclass providing
+// the same features as Window.
 //
-//	&lt;source srcset="application-window-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="application-window.png" alt="application-window"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwApplicationWindow` is a [class@Gtk.ApplicationWindow] subclass providing
-// the same features as [class@Window].
-//
-// See [class@Window] for details.
+// See Window for details.
 //
 // Example of an `AdwApplicationWindow` UI definition:
 //
-// ```xml
-// &lt;object class="AdwApplicationWindow"&gt;
-//
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar"/&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;!-- ... --&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// Using [property@Gtk.Application:menubar] is not supported and may result in
+//	<object class="AdwApplicationWindow">
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar"/>
+//	      </child>
+//	      <property name="content">
+//	        <!-- ... -->
+//	      </property>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// Using Application:menubar is not supported and may result in
 // visual glitches.
 type ApplicationWindow struct {
 	gtk.ApplicationWindow
@@ -81,7 +69,7 @@ func ApplicationWindowNewFromInternalPtr(ptr uintptr) *ApplicationWindow {
 
 var xNewApplicationWindow func(uintptr) uintptr
 
-// Creates a new `AdwApplicationWindow` for @app.
+// Creates a new `AdwApplicationWindow` for app.
 func NewApplicationWindow(AppVar *gtk.Application) *ApplicationWindow {
 	var cls *ApplicationWindow
 
@@ -98,7 +86,7 @@ func NewApplicationWindow(AppVar *gtk.Application) *ApplicationWindow {
 
 var xApplicationWindowAddBreakpoint func(uintptr, uintptr)
 
-// Adds @breakpoint to @self.
+// Adds breakpoint to self.
 func (x *ApplicationWindow) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 	xApplicationWindowAddBreakpoint(x.GoPointer(), BreakpointVar.GoPointer())
@@ -107,7 +95,7 @@ func (x *ApplicationWindow) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 var xApplicationWindowGetAdaptivePreview func(uintptr) bool
 
-// Gets whether adaptive preview for @self is currently open.
+// Gets whether adaptive preview for self is currently open.
 func (x *ApplicationWindow) GetAdaptivePreview() bool {
 
 	cret := xApplicationWindowGetAdaptivePreview(x.GoPointer())
@@ -116,9 +104,9 @@ func (x *ApplicationWindow) GetAdaptivePreview() bool {
 
 var xApplicationWindowGetContent func(uintptr) uintptr
 
-// Gets the content widget of @self.
+// Gets the content widget of self.
 //
-// This method should always be used instead of [method@Gtk.Window.get_child].
+// This method should always be used instead of [gtk.Window.GetChild].
 func (x *ApplicationWindow) GetContent() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -152,7 +140,7 @@ func (x *ApplicationWindow) GetCurrentBreakpoint() *Breakpoint {
 
 var xApplicationWindowGetDialogs func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the open dialogs of @self.
+// Returns a [gio.ListModel] that contains the open dialogs of self.
 //
 // This can be used to keep an up-to-date view.
 func (x *ApplicationWindow) GetDialogs() *gio.ListModelBase {
@@ -170,7 +158,7 @@ func (x *ApplicationWindow) GetDialogs() *gio.ListModelBase {
 
 var xApplicationWindowGetVisibleDialog func(uintptr) uintptr
 
-// Returns the currently visible dialog in @self, if there's one.
+// Returns the currently visible dialog in self, if there's one.
 func (x *ApplicationWindow) GetVisibleDialog() *Dialog {
 	var cls *Dialog
 
@@ -187,7 +175,7 @@ func (x *ApplicationWindow) GetVisibleDialog() *Dialog {
 
 var xApplicationWindowSetAdaptivePreview func(uintptr, bool)
 
-// Sets whether adaptive preview for @self is currently open.
+// Sets whether adaptive preview for self is currently open.
 //
 // Adaptive preview is a debugging tool used for testing the window
 // contents at specific screen sizes, simulating mobile environment.
@@ -204,9 +192,9 @@ func (x *ApplicationWindow) SetAdaptivePreview(AdaptivePreviewVar bool) {
 
 var xApplicationWindowSetContent func(uintptr, uintptr)
 
-// Sets the content widget of @self.
+// Sets the content widget of self.
 //
-// This method should always be used instead of [method@Gtk.Window.set_child].
+// This method should always be used instead of [gtk.Window.SetChild].
 func (x *ApplicationWindow) SetContent(ContentVar *gtk.Widget) {
 
 	xApplicationWindowSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -257,99 +245,97 @@ func (x *ApplicationWindow) GetPropertyAdaptivePreview() bool {
 	return v.GetBoolean()
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionAdded(ActionNameVar string) {
 
 	gio.XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	gio.XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionRemoved(ActionNameVar string) {
 
 	gio.XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	gio.XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [gio.ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [gio.ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [gio.DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [gio.DBusConnection.Flush] is called
+// before `exit()`. Without `[gio.DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *ApplicationWindow) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	gio.XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [gio.ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [gio.ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *ApplicationWindow) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	gio.XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -360,14 +346,14 @@ func (x *ApplicationWindow) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [gio.ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -378,14 +364,14 @@ func (x *ApplicationWindow) GetActionParameterType(ActionNameVar string) *glib.V
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [gio.ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -393,14 +379,14 @@ func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -409,7 +395,7 @@ func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ApplicationWindow) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -417,17 +403,17 @@ func (x *ApplicationWindow) GetActionStateHint(ActionNameVar string) *glib.Varia
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [gio.ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [gio.ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [gio.ActionGroup.GetActionState] will return `NULL`
+// and you must not call [gio.ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -438,38 +424,38 @@ func (x *ApplicationWindow) GetActionStateType(ActionNameVar string) *glib.Varia
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *ApplicationWindow) HasAction(ActionNameVar string) bool {
 
 	cret := gio.XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *ApplicationWindow) ListActions() []string {
 
 	cret := gio.XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [gio.ActionGroup.HasAction], [gio.ActionGroup.GetActionEnabled],
+// [gio.ActionGroup.GetActionParameterType],
+// [gio.ActionGroup.GetActionStateType],
+// [gio.ActionGroup.GetActionStateHint] and
+// [gio.ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [gio.ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -488,47 +474,41 @@ func (x *ApplicationWindow) QueryAction(ActionNameVar string, EnabledVar *bool,
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *ApplicationWindow) AddAction(ActionVar gio.Action) {
 
 	gio.XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
+// A convenience function for creating multiple [gio.SimpleAction]
+// instances and adding them to a [gio.ActionMap].
 //
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [gio.ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -541,15 +521,13 @@ func (x *ApplicationWindow) AddAction(ActionVar gio.Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *ApplicationWindow) AddActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	gio.XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *ApplicationWindow) LookupAction(ActionNameVar string) *gio.ActionBase {
@@ -575,31 +553,25 @@ func (x *ApplicationWindow) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [gio.ActionMap]. This is meant as the reverse of
+// [gio.ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *ApplicationWindow) RemoveActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int) {
 
 	gio.XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -767,7 +739,7 @@ func (x *ApplicationWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -775,14 +747,13 @@ func (x *ApplicationWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -806,19 +777,17 @@ func (x *ApplicationWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -839,7 +808,7 @@ func (x *ApplicationWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -848,14 +817,12 @@ func (x *ApplicationWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -874,10 +841,10 @@ func (x *ApplicationWindow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acc
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ApplicationWindow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -914,10 +881,10 @@ func (x *ApplicationWindow) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *ApplicationWindow) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	gtk.XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -961,7 +928,7 @@ func (x *ApplicationWindow) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *ApplicationWindow) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
@@ -977,13 +944,13 @@ func (x *ApplicationWindow) GetFocus() *gtk.Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [gtk.Widget.GrabFocus] instead of
 // this function.
 func (x *ApplicationWindow) SetFocus(FocusVar *gtk.Widget) {
 
@@ -996,7 +963,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1005,15 +972,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationWindowGLibType, libs, "adw_application_window_get_type")
 
-	core.PuregoSafeRegister(&xNewApplicationWindow, libs, "adw_application_window_new")
+	gobject.CheckClassSize("AdwApplicationWindowClass", xApplicationWindowGLibType, unsafe.Sizeof(ApplicationWindowClass{}))
+
+	core.PuregoRegisterSince(&xNewApplicationWindow, libs, "adw_application_window_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xApplicationWindowAddBreakpoint, libs, "adw_application_window_add_breakpoint")
-	core.PuregoSafeRegister(&xApplicationWindowGetAdaptivePreview, libs, "adw_application_window_get_adaptive_preview")
-	core.PuregoSafeRegister(&xApplicationWindowGetContent, libs, "adw_application_window_get_content")
-	core.PuregoSafeRegister(&xApplicationWindowGetCurrentBreakpoint, libs, "adw_application_window_get_current_breakpoint")
-	core.PuregoSafeRegister(&xApplicationWindowGetDialogs, libs, "adw_application_window_get_dialogs")
-	core.PuregoSafeRegister(&xApplicationWindowGetVisibleDialog, libs, "adw_application_window_get_visible_dialog")
-	core.PuregoSafeRegister(&xApplicationWindowSetAdaptivePreview, libs, "adw_application_window_set_adaptive_preview")
-	core.PuregoSafeRegister(&xApplicationWindowSetContent, libs, "adw_application_window_set_content")
+	core.PuregoRegisterSince(&xApplicationWindowAddBreakpoint, libs, "adw_application_window_add_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xApplicationWindowGetAdaptivePreview, libs, "adw_application_window_get_adaptive_preview", "ADW", "1.7")
+	core.PuregoRegisterSince(&xApplicationWindowGetContent, libs, "adw_application_window_get_content", "ADW", "")
+	core.PuregoRegisterSince(&xApplicationWindowGetCurrentBreakpoint, libs, "adw_application_window_get_current_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xApplicationWindowGetDialogs, libs, "adw_application_window_get_dialogs", "ADW", "1.5")
+	core.PuregoRegisterSince(&xApplicationWindowGetVisibleDialog, libs, "adw_application_window_get_visible_dialog", "ADW", "1.5")
+	core.PuregoRegisterSince(&xApplicationWindowSetAdaptivePreview, libs, "adw_application_window_set_adaptive_preview", "ADW", "1.7")
+	core.PuregoRegisterSince(&xApplicationWindowSetContent, libs, "adw_application_window_set_content", "ADW", "")
 
 }
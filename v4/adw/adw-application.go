@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -28,39 +27,39 @@ func (x *ApplicationClass) GoPointer() uintptr {
 
 // A base class for Adwaita applications.
 //
-// `AdwApplication` handles library initialization by calling [func@init] in the
-// default [signal@Gio.Application::startup] signal handler, in turn chaining up
-// as required by [class@Gtk.Application]. Therefore, any subclass of
+// `AdwApplication` handles library initialization by calling init in the
+// default [gio.Application.ConnectStartupFunc] signal handler, in turn chaining up
+// as required by [gtk.Application]. Therefore, any subclass of
 // `AdwApplication` should always chain up its `startup` handler before using
 // any Adwaita or GTK API.
 //
-// ## Automatic Resources
+// # Automatic Resources
 //
-// ### Shortcuts Dialog
+// # Shortcuts Dialog
 //
 // If there's a resource located at `shortcuts-dialog.ui` which defines an
-// [class@ShortcutsDialog] with the ID `shortcuts_dialog`, `AdwApplication`
+// ShortcutsDialog with the ID `shortcuts_dialog`, `AdwApplication`
 // will set up an `app.shortcuts` action that creates and presents this dialog,
-// as well as a &lt;kbd&gt;Ctrl&lt;/kbd&gt;&lt;kbd&gt;?&lt;/kbd&gt; accelerator for it.
+// as well as a Ctrl? accelerator for it.
 //
-// ### Stylesheet
+// # Stylesheet
 //
 // `AdwApplication` will automatically load stylesheets located in the
 // application's resource base path (see
-// [method@Gio.Application.set_resource_base_path], if they're present.
+// [gio.Application.SetResourceBasePath], if they're present.
 //
 // They can be used to add custom styles to the application, as follows:
 //
 // - `style.css` contains styles that are always present.
 //
 // - `style-dark.css` contains styles only used when
-// [property@StyleManager:dark] is `TRUE`.
+// StyleManager:dark is `TRUE`.
 //
 //   - `style-hc.css` contains styles used when the system high contrast
 //     preference is enabled.
 //
 //   - `style-hc-dark.css` contains styles used when the system high contrast
-//     preference is enabled and [property@StyleManager:dark] is `TRUE`.
+//     preference is enabled and StyleManager:dark is `TRUE`.
 //
 // :::note
 //
@@ -91,7 +90,7 @@ var xNewApplication func(uintptr, gio.ApplicationFlags) uintptr
 // Creates a new `AdwApplication`.
 //
 // If `application_id` is not `NULL`, then it must be valid. See
-// [func@Gio.Application.id_is_valid].
+// [gio.ApplicationIdIsValid].
 //
 // If no application ID is given then some features (most notably application
 // uniqueness) will be disabled.
@@ -113,7 +112,7 @@ func NewApplication(ApplicationIdVar *string, FlagsVar gio.ApplicationFlags) *Ap
 
 var xApplicationGetStyleManager func(uintptr) uintptr
 
-// Gets the style manager for @self.
+// Gets the style manager for self.
 //
 // This is a convenience property allowing to access `AdwStyleManager` through
 // property bindings or expressions.
@@ -142,99 +141,97 @@ func (c *Application) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionAdded(ActionNameVar string) {
 
 	gio.XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	gio.XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionRemoved(ActionNameVar string) {
 
 	gio.XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	gio.XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [gio.ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [gio.ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [gio.DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [gio.DBusConnection.Flush] is called
+// before `exit()`. Without `[gio.DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *Application) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	gio.XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [gio.ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [gio.ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *Application) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	gio.XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -245,14 +242,14 @@ func (x *Application) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [gio.ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -263,14 +260,14 @@ func (x *Application) GetActionParameterType(ActionNameVar string) *glib.Variant
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [gio.ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -278,14 +275,14 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -294,7 +291,7 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -302,17 +299,17 @@ func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [gio.ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [gio.ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [gio.ActionGroup.GetActionState] will return `NULL`
+// and you must not call [gio.ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -323,38 +320,38 @@ func (x *Application) GetActionStateType(ActionNameVar string) *glib.VariantType
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *Application) HasAction(ActionNameVar string) bool {
 
 	cret := gio.XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *Application) ListActions() []string {
 
 	cret := gio.XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [gio.ActionGroup.HasAction], [gio.ActionGroup.GetActionEnabled],
+// [gio.ActionGroup.GetActionParameterType],
+// [gio.ActionGroup.GetActionStateType],
+// [gio.ActionGroup.GetActionStateHint] and
+// [gio.ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [gio.ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -373,47 +370,41 @@ func (x *Application) QueryAction(ActionNameVar string, EnabledVar *bool, Parame
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *Application) AddAction(ActionVar gio.Action) {
 
 	gio.XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
-//
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
+// A convenience function for creating multiple [gio.SimpleAction]
+// instances and adding them to a [gio.ActionMap].
 //
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [gio.ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -426,15 +417,13 @@ func (x *Application) AddAction(ActionVar gio.Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *Application) AddActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	gio.XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *Application) LookupAction(ActionNameVar string) *gio.ActionBase {
@@ -460,31 +449,25 @@ func (x *Application) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [gio.ActionMap]. This is meant as the reverse of
+// [gio.ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *Application) RemoveActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int) {
 
 	gio.XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -496,7 +479,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -505,8 +488,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationGLibType, libs, "adw_application_get_type")
 
-	core.PuregoSafeRegister(&xNewApplication, libs, "adw_application_new")
+	gobject.CheckClassSize("AdwApplicationClass", xApplicationGLibType, unsafe.Sizeof(ApplicationClass{}))
+
+	core.PuregoRegisterSince(&xNewApplication, libs, "adw_application_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xApplicationGetStyleManager, libs, "adw_application_get_style_manager")
+	core.PuregoRegisterSince(&xApplicationGetStyleManager, libs, "adw_application_get_style_manager", "ADW", "")
 
 }
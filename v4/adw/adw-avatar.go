@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,31 +24,24 @@ func (x *AvatarClass) GoPointer() uintptr {
 
 // A widget displaying an image, with a generated fallback.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="avatar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="avatar.png" alt="avatar"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwAvatar` is a widget that shows a round avatar.
 //
 // `AdwAvatar` generates an avatar with the initials of  the
-// [property@Avatar:text] on top of a colored background.
+// Avatar:text on top of a colored background.
 //
-// The color is picked based on the hash of the [property@Avatar:text].
+// The color is picked based on the hash of the Avatar:text.
 //
-// If [property@Avatar:show-initials] is set to `FALSE`,
-// [property@Avatar:icon-name] or `avatar-default-symbolic` is shown instead of
+// If Avatar:show-initials is set to `FALSE`,
+// Avatar:icon-name or `avatar-default-symbolic` is shown instead of
 // the initials.
 //
-// Use [property@Avatar:custom-image] to set a custom image.
+// Use Avatar:custom-image to set a custom image.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwAvatar` has a single CSS node with name `avatar`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwAvatar` uses the `GTK_ACCESSIBLE_ROLE_IMG` role.
 type Avatar struct {
@@ -90,7 +82,7 @@ func NewAvatar(SizeVar int, TextVar *string, ShowInitialsVar bool) *Avatar {
 
 var xAvatarDrawToTexture func(uintptr, int) uintptr
 
-// Renders @self into a [class@Gdk.Texture] at @scale_factor.
+// Renders self into a [gdk.Texture] at scale_factor.
 //
 // This can be used to export the fallback avatar.
 func (x *Avatar) DrawToTexture(ScaleFactorVar int) *gdk.Texture {
@@ -188,7 +180,7 @@ var xAvatarSetShowInitials func(uintptr, bool)
 
 // Sets whether to use initials instead of an icon on the fallback avatar.
 //
-// See [property@Avatar:icon-name] for how to change the fallback icon.
+// See Avatar:icon-name for how to change the fallback icon.
 func (x *Avatar) SetShowInitials(ShowInitialsVar bool) {
 
 	xAvatarSetShowInitials(x.GoPointer(), ShowInitialsVar)
@@ -208,7 +200,7 @@ var xAvatarSetText func(uintptr, uintptr)
 
 // Sets the text used to generate the fallback initials and color.
 //
-// It's only used to generate the color if [property@Avatar:show-initials] is
+// It's only used to generate the color if Avatar:show-initials is
 // `FALSE`.
 func (x *Avatar) SetText(TextVar *string) {
 
@@ -254,7 +246,7 @@ func (x *Avatar) GetPropertyIconName() string {
 // SetPropertyShowInitials sets the "show-initials" property.
 // Whether initials are used instead of an icon on the fallback avatar.
 //
-// See [property@Avatar:icon-name] for how to change the fallback icon.
+// See Avatar:icon-name for how to change the fallback icon.
 func (x *Avatar) SetPropertyShowInitials(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -265,7 +257,7 @@ func (x *Avatar) SetPropertyShowInitials(value bool) {
 // GetPropertyShowInitials gets the "show-initials" property.
 // Whether initials are used instead of an icon on the fallback avatar.
 //
-// See [property@Avatar:icon-name] for how to change the fallback icon.
+// See Avatar:icon-name for how to change the fallback icon.
 func (x *Avatar) GetPropertyShowInitials() bool {
 	var v gobject.Value
 	x.GetProperty("show-initials", &v)
@@ -292,7 +284,7 @@ func (x *Avatar) GetPropertySize() int {
 // SetPropertyText sets the "text" property.
 // Sets the text used to generate the fallback initials and color.
 //
-// It's only used to generate the color if [property@Avatar:show-initials] is
+// It's only used to generate the color if Avatar:show-initials is
 // `FALSE`.
 func (x *Avatar) SetPropertyText(value string) {
 	var v gobject.Value
@@ -304,7 +296,7 @@ func (x *Avatar) SetPropertyText(value string) {
 // GetPropertyText gets the "text" property.
 // Sets the text used to generate the fallback initials and color.
 //
-// It's only used to generate the color if [property@Avatar:show-initials] is
+// It's only used to generate the color if Avatar:show-initials is
 // `FALSE`.
 func (x *Avatar) GetPropertyText() string {
 	var v gobject.Value
@@ -473,7 +465,7 @@ func (x *Avatar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -481,14 +473,13 @@ func (x *Avatar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Avatar) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -512,19 +503,17 @@ func (x *Avatar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Avatar) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -545,7 +534,7 @@ func (x *Avatar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -554,14 +543,12 @@ func (x *Avatar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Avatar) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -580,10 +567,10 @@ func (x *Avatar) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Avatar) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -595,7 +582,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -604,18 +591,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xAvatarGLibType, libs, "adw_avatar_get_type")
 
-	core.PuregoSafeRegister(&xNewAvatar, libs, "adw_avatar_new")
-
-	core.PuregoSafeRegister(&xAvatarDrawToTexture, libs, "adw_avatar_draw_to_texture")
-	core.PuregoSafeRegister(&xAvatarGetCustomImage, libs, "adw_avatar_get_custom_image")
-	core.PuregoSafeRegister(&xAvatarGetIconName, libs, "adw_avatar_get_icon_name")
-	core.PuregoSafeRegister(&xAvatarGetShowInitials, libs, "adw_avatar_get_show_initials")
-	core.PuregoSafeRegister(&xAvatarGetSize, libs, "adw_avatar_get_size")
-	core.PuregoSafeRegister(&xAvatarGetText, libs, "adw_avatar_get_text")
-	core.PuregoSafeRegister(&xAvatarSetCustomImage, libs, "adw_avatar_set_custom_image")
-	core.PuregoSafeRegister(&xAvatarSetIconName, libs, "adw_avatar_set_icon_name")
-	core.PuregoSafeRegister(&xAvatarSetShowInitials, libs, "adw_avatar_set_show_initials")
-	core.PuregoSafeRegister(&xAvatarSetSize, libs, "adw_avatar_set_size")
-	core.PuregoSafeRegister(&xAvatarSetText, libs, "adw_avatar_set_text")
+	gobject.CheckClassSize("AdwAvatarClass", xAvatarGLibType, unsafe.Sizeof(AvatarClass{}))
+
+	core.PuregoRegisterSince(&xNewAvatar, libs, "adw_avatar_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xAvatarDrawToTexture, libs, "adw_avatar_draw_to_texture", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarGetCustomImage, libs, "adw_avatar_get_custom_image", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarGetIconName, libs, "adw_avatar_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarGetShowInitials, libs, "adw_avatar_get_show_initials", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarGetSize, libs, "adw_avatar_get_size", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarGetText, libs, "adw_avatar_get_text", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarSetCustomImage, libs, "adw_avatar_set_custom_image", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarSetIconName, libs, "adw_avatar_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarSetShowInitials, libs, "adw_avatar_set_show_initials", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarSetSize, libs, "adw_avatar_set_size", "ADW", "")
+	core.PuregoRegisterSince(&xAvatarSetText, libs, "adw_avatar_set_text", "ADW", "")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,11 +23,11 @@ func (x *BannerClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the available button styles for [class@Banner].
+// Describes the available button styles for Banner.
 //
 // New values may be added to this enumeration over time.
 //
-// See [property@Banner:button-style].
+// See Banner:button-style.
 type BannerButtonStyle int
 
 var xBannerButtonStyleGLibType func() types.GType
@@ -44,36 +44,55 @@ const (
 	BannerButtonSuggestedValue BannerButtonStyle = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x BannerButtonStyle) String() string {
+	switch x {
+	case BannerButtonDefaultValue:
+		return "ADW_BANNER_BUTTON_DEFAULT"
+	case BannerButtonSuggestedValue:
+		return "ADW_BANNER_BUTTON_SUGGESTED"
+	}
+	return fmt.Sprintf("BannerButtonStyle(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BannerButtonStyle) Nick() string {
+	switch x {
+	case BannerButtonDefaultValue:
+		return "default"
+	case BannerButtonSuggestedValue:
+		return "suggested"
+	}
+	return ""
+}
+
+// BannerButtonStyleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BannerButtonStyleFromString(s string) (BannerButtonStyle, bool) {
+	switch s {
+	case "ADW_BANNER_BUTTON_DEFAULT", "default":
+		return BannerButtonDefaultValue, true
+	case "ADW_BANNER_BUTTON_SUGGESTED", "suggested":
+		return BannerButtonSuggestedValue, true
+	}
+	return 0, false
+}
+
 // A bar with contextual information.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="banner-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="banner.png" alt="banner"&gt;
-//
-// &lt;/picture&gt;
-//
-// Banners are hidden by default, use [property@Banner:revealed] to show them.
+// Banners are hidden by default, use Banner:revealed to show them.
 //
-// Banners have a title, set with [property@Banner:title]. Titles can be marked
-// up with Pango markup, use [property@Banner:use-markup] to enable it.
+// Banners have a title, set with Banner:title. Titles can be marked
+// up with Pango markup, use Banner:use-markup to enable it.
 //
 // The title will be shown centered or left-aligned depending on available
 // space.
 //
 // Banners can optionally have a button with text on it, set through
-// [property@Banner:button-label]. The button can be used with a `GAction`,
-// or with the [signal@Banner::button-clicked] signal. The button can have
+// Banner:button-label. The button can be used with a `GAction`,
+// or with the Banner::button-clicked signal. The button can have
 // different styles, a gray style and a suggested style.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="banner-suggested-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="banner-suggested.png" alt="banner with suggested button style"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwBanner` has a main CSS node with the name `banner`.
 type Banner struct {
@@ -111,7 +130,7 @@ func NewBanner(TitleVar string) *Banner {
 
 var xBannerGetButtonLabel func(uintptr) string
 
-// Gets the button label for @self.
+// Gets the button label for self.
 func (x *Banner) GetButtonLabel() string {
 
 	cret := xBannerGetButtonLabel(x.GoPointer())
@@ -138,7 +157,7 @@ func (x *Banner) GetRevealed() bool {
 
 var xBannerGetTitle func(uintptr) string
 
-// Gets the title for @self.
+// Gets the title for self.
 func (x *Banner) GetTitle() string {
 
 	cret := xBannerGetTitle(x.GoPointer())
@@ -156,12 +175,12 @@ func (x *Banner) GetUseMarkup() bool {
 
 var xBannerSetButtonLabel func(uintptr, uintptr)
 
-// Sets the button label for @self.
+// Sets the button label for self.
 //
 // If set to `""` or `NULL`, the button won't be shown.
 //
 // The button can be used with a `GAction`, or with the
-// [signal@Banner::button-clicked] signal.
+// Banner::button-clicked signal.
 func (x *Banner) SetButtonLabel(LabelVar *string) {
 
 	LabelVarPtr := core.GStrdupNullable(LabelVar)
@@ -176,14 +195,7 @@ var xBannerSetButtonStyle func(uintptr, BannerButtonStyle)
 // Sets the style class to use for the banner button.
 //
 // When set to `ADW_BANNER_BUTTON_DEFAULT`, the button stays grey.
-// When set to `ADW_BANNER_BUTTON_SUGGESTED`, the button follows the [`.suggested-action`](style-classes.html#suggested-action) style
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="banner-suggested-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="banner-suggested.png" alt="banner with suggested button style"&gt;
-//
-// &lt;/picture&gt;
+// When set to `ADW_BANNER_BUTTON_SUGGESTED`, the button follows the .suggested-action style
 func (x *Banner) SetButtonStyle(StyleVar BannerButtonStyle) {
 
 	xBannerSetButtonStyle(x.GoPointer(), StyleVar)
@@ -203,7 +215,7 @@ var xBannerSetTitle func(uintptr, string)
 
 // Sets the title for this banner.
 //
-// See also: [property@Banner:use-markup].
+// See also: Banner:use-markup.
 func (x *Banner) SetTitle(TitleVar string) {
 
 	xBannerSetTitle(x.GoPointer(), TitleVar)
@@ -214,7 +226,7 @@ var xBannerSetUseMarkup func(uintptr, bool)
 
 // Sets whether to use Pango markup for the banner title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Banner) SetUseMarkup(UseMarkupVar bool) {
 
 	xBannerSetUseMarkup(x.GoPointer(), UseMarkupVar)
@@ -238,7 +250,7 @@ func (c *Banner) SetGoPointer(ptr uintptr) {
 // If set to `""` or `NULL`, the button won't be shown.
 //
 // The button can be used with a `GAction`, or with the
-// [signal@Banner::button-clicked] signal.
+// Banner::button-clicked signal.
 func (x *Banner) SetPropertyButtonLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -252,7 +264,7 @@ func (x *Banner) SetPropertyButtonLabel(value string) {
 // If set to `""` or `NULL`, the button won't be shown.
 //
 // The button can be used with a `GAction`, or with the
-// [signal@Banner::button-clicked] signal.
+// Banner::button-clicked signal.
 func (x *Banner) GetPropertyButtonLabel() string {
 	var v gobject.Value
 	x.GetProperty("button-label", &v)
@@ -279,7 +291,7 @@ func (x *Banner) GetPropertyRevealed() bool {
 // SetPropertyTitle sets the "title" property.
 // The title for this banner.
 //
-// See also: [property@Banner:use-markup].
+// See also: Banner:use-markup.
 func (x *Banner) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -290,7 +302,7 @@ func (x *Banner) SetPropertyTitle(value string) {
 // GetPropertyTitle gets the "title" property.
 // The title for this banner.
 //
-// See also: [property@Banner:use-markup].
+// See also: Banner:use-markup.
 func (x *Banner) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -300,7 +312,7 @@ func (x *Banner) GetPropertyTitle() string {
 // SetPropertyUseMarkup sets the "use-markup" property.
 // Whether to use Pango markup for the banner title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Banner) SetPropertyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -311,7 +323,7 @@ func (x *Banner) SetPropertyUseMarkup(value bool) {
 // GetPropertyUseMarkup gets the "use-markup" property.
 // Whether to use Pango markup for the banner title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Banner) GetPropertyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("use-markup", &v)
@@ -321,27 +333,49 @@ func (x *Banner) GetPropertyUseMarkup() bool {
 // This signal is emitted after the action button has been clicked.
 //
 // It can be used as an alternative to setting an action.
+//
+// Deprecated: use ConnectButtonClickedFunc, which also accepts method values and closures.
 func (x *Banner) ConnectButtonClicked(cb *func(Banner)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "button-clicked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Banner{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "button-clicked", cbRefPtr, cbPtr)
+}
+
+var xBannerButtonClickedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xBannerButtonClickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Banner))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Banner{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "button-clicked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectButtonClickedFunc connects cb to the "button-clicked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Banner) ConnectButtonClickedFunc(cb func(Banner)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "button-clicked", &xBannerButtonClickedTrampoline, xBannerButtonClickedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -505,7 +539,7 @@ func (x *Banner) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -513,14 +547,13 @@ func (x *Banner) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Banner) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -544,19 +577,17 @@ func (x *Banner) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Banner) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -577,7 +608,7 @@ func (x *Banner) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -586,14 +617,12 @@ func (x *Banner) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Banner) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -612,14 +641,14 @@ func (x *Banner) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleStat
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *Banner) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *Banner) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -629,15 +658,15 @@ func (x *Banner) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *Banner) SetActionName(ActionNameVar *string) {
 
@@ -650,13 +679,13 @@ func (x *Banner) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *Banner) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -665,14 +694,14 @@ func (x *Banner) SetActionTarget(FormatStringVar string, varArgs ...interface{})
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -690,18 +719,18 @@ func (x *Banner) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *Banner) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Banner) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -713,7 +742,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -724,17 +753,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xBannerGLibType, libs, "adw_banner_get_type")
 
-	core.PuregoSafeRegister(&xNewBanner, libs, "adw_banner_new")
-
-	core.PuregoSafeRegister(&xBannerGetButtonLabel, libs, "adw_banner_get_button_label")
-	core.PuregoSafeRegister(&xBannerGetButtonStyle, libs, "adw_banner_get_button_style")
-	core.PuregoSafeRegister(&xBannerGetRevealed, libs, "adw_banner_get_revealed")
-	core.PuregoSafeRegister(&xBannerGetTitle, libs, "adw_banner_get_title")
-	core.PuregoSafeRegister(&xBannerGetUseMarkup, libs, "adw_banner_get_use_markup")
-	core.PuregoSafeRegister(&xBannerSetButtonLabel, libs, "adw_banner_set_button_label")
-	core.PuregoSafeRegister(&xBannerSetButtonStyle, libs, "adw_banner_set_button_style")
-	core.PuregoSafeRegister(&xBannerSetRevealed, libs, "adw_banner_set_revealed")
-	core.PuregoSafeRegister(&xBannerSetTitle, libs, "adw_banner_set_title")
-	core.PuregoSafeRegister(&xBannerSetUseMarkup, libs, "adw_banner_set_use_markup")
+	gobject.CheckClassSize("AdwBannerClass", xBannerGLibType, unsafe.Sizeof(BannerClass{}))
+
+	core.PuregoRegisterSince(&xNewBanner, libs, "adw_banner_new", "ADW", "1.3")
+
+	core.PuregoRegisterSince(&xBannerGetButtonLabel, libs, "adw_banner_get_button_label", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerGetButtonStyle, libs, "adw_banner_get_button_style", "ADW", "1.7")
+	core.PuregoRegisterSince(&xBannerGetRevealed, libs, "adw_banner_get_revealed", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerGetTitle, libs, "adw_banner_get_title", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerGetUseMarkup, libs, "adw_banner_get_use_markup", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerSetButtonLabel, libs, "adw_banner_set_button_label", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerSetButtonStyle, libs, "adw_banner_set_button_style", "ADW", "1.7")
+	core.PuregoRegisterSince(&xBannerSetRevealed, libs, "adw_banner_set_revealed", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerSetTitle, libs, "adw_banner_set_title", "ADW", "1.3")
+	core.PuregoRegisterSince(&xBannerSetUseMarkup, libs, "adw_banner_set_use_markup", "ADW", "1.3")
 
 }
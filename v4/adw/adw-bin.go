@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,14 +23,7 @@ func (x *BinClass) GoPointer() uintptr {
 
 // A widget with one child.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="bin-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="bin.png" alt="bin"&gt;
-//
-// &lt;/picture&gt;
-//
-// The `AdwBin` widget has only one child, set with the [property@Bin:child]
+// The `AdwBin` widget has only one child, set with the Bin:child
 // property.
 //
 // It is useful for deriving subclasses, since it provides common code needed
@@ -71,7 +63,7 @@ func NewBin() *Bin {
 
 var xBinGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *Bin) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -88,7 +80,7 @@ func (x *Bin) GetChild() *gtk.Widget {
 
 var xBinSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *Bin) SetChild(ChildVar *gtk.Widget) {
 
 	xBinSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -267,7 +259,7 @@ func (x *Bin) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -275,14 +267,13 @@ func (x *Bin) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Bin) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -306,19 +297,17 @@ func (x *Bin) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Bin) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -339,7 +328,7 @@ func (x *Bin) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -348,14 +337,12 @@ func (x *Bin) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Bin) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -374,10 +361,10 @@ func (x *Bin) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Bin) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -389,7 +376,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -398,9 +385,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xBinGLibType, libs, "adw_bin_get_type")
 
-	core.PuregoSafeRegister(&xNewBin, libs, "adw_bin_new")
+	gobject.CheckClassSize("AdwBinClass", xBinGLibType, unsafe.Sizeof(BinClass{}))
+
+	core.PuregoRegisterSince(&xNewBin, libs, "adw_bin_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xBinGetChild, libs, "adw_bin_get_child")
-	core.PuregoSafeRegister(&xBinSetChild, libs, "adw_bin_set_child")
+	core.PuregoRegisterSince(&xBinGetChild, libs, "adw_bin_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xBinSetChild, libs, "adw_bin_set_child", "ADW", "")
 
 }
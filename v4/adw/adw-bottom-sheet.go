@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -26,76 +25,69 @@ func (x *BottomSheetClass) GoPointer() uintptr {
 
 // A bottom sheet with an optional bottom bar.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="bottom-sheet-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="bottom-sheet.png" alt="bottom-sheet"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwBottomSheet` has three child widgets. [property@BottomSheet:content] is
-// shown persistently. [property@BottomSheet:sheet] is displayed above it when
-// it's open, and [property@BottomSheet:bottom-bar] is displayed when it's not.
+// `AdwBottomSheet` has three child widgets. BottomSheet:content is
+// shown persistently. BottomSheet:sheet is displayed above it when
+// it's open, and BottomSheet:bottom-bar is displayed when it's not.
 //
 // Bottom sheet and bottom bar are attached to the bottom edge of the widget.
 // They take the full width by default, but can only take a portion of it if
-// [property@BottomSheet:full-width] is set to `FALSE`. In this case,
-// [property@BottomSheet:align] determines where along the bottom edge they are
+// BottomSheet:full-width is set to `FALSE`. In this case,
+// BottomSheet:align determines where along the bottom edge they are
 // placed.
 //
-// Bottom bar can be hidden using the [property@BottomSheet:reveal-bottom-bar]
+// Bottom bar can be hidden using the BottomSheet:reveal-bottom-bar
 // property.
 //
 // `AdwBottomSheet` can be useful for applications such as music players, that
 // want to have a persistent bottom bar that expands into a bottom sheet when
 // clicked. It's meant for cases where a bottom sheet is tightly integrated into
-// the UI. For more transient bottom sheets, see [class@Dialog].
+// the UI. For more transient bottom sheets, see Dialog.
 //
-// To open or close the bottom sheet, use the [property@BottomSheet:open]
+// To open or close the bottom sheet, use the BottomSheet:open
 // property.
 //
 // By default, the bottom sheet has an overlaid drag handle. It can be disabled
-// by setting [property@BottomSheet:show-drag-handle] to `FALSE`. Note that the
+// by setting BottomSheet:show-drag-handle to `FALSE`. Note that the
 // handle also controls whether the sheet can be dragged using a pointer.
 //
 // Bottom sheets are modal by default, meaning that the content is dimmed and
-// cannot be accessed while the sheet is open. Set [property@BottomSheet:modal]
+// cannot be accessed while the sheet is open. Set BottomSheet:modal
 // to `FALSE` if this behavior is unwanted.
 //
 // To disable user interactions for opening or closing the bottom sheet (such as
 // swipes or clicking the bottom bar or close button), set
-// [property@BottomSheet:can-open] or [property@BottomSheet:can-close] to
+// BottomSheet:can-open or BottomSheet:can-close to
 // `FALSE`.
 //
 // In some cases, particularly when using a full-width bottom bar, it may be
-// necessary to shift [property@BottomSheet:content] upwards. Use the
-// [property@BottomSheet:bottom-bar-height] and
-// [property@BottomSheet:sheet-height] for that.
+// necessary to shift BottomSheet:content upwards. Use the
+// BottomSheet:bottom-bar-height and
+// BottomSheet:sheet-height for that.
 //
 // `AdwBottomSheet` is not adaptive, and for larger window sizes applications
 // may want to replace it with another UI, such as a sidebar. This can be done
-// using [class@MultiLayoutView].
+// using MultiLayoutView.
 //
-// ## Sizing
+// # Sizing
 //
-// Unlike [class@Dialog] presented as a bottom sheet, `AdwBottomSheet` just
+// Unlike Dialog presented as a bottom sheet, `AdwBottomSheet` just
 // follows the content's natural size, and it's up to the applications to make
 // sure their content provides one. For example, when using
-// [class@Gtk.ScrolledWindow], make sure to set
-// [property@Gtk.ScrolledWindow:propagate-natural-height] to `TRUE`.
+// [gtk.ScrolledWindow], make sure to set
+// [gtk.ScrolledWindow.GetPropertyPropagateNaturalHeight] to `TRUE`.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When placed inside an `AdwBottomSheet`, [class@HeaderBar] will not show the
-// title when [property@BottomSheet:show-drag-handle] is `TRUE`, regardless of
-// [property@HeaderBar:show-title]. This only applies to the default title,
-// titles set with [property@HeaderBar:title-widget] will still be shown.
+// When placed inside an `AdwBottomSheet`, HeaderBar will not show the
+// title when BottomSheet:show-drag-handle is `TRUE`, regardless of
+// HeaderBar:show-title. This only applies to the default title,
+// titles set with HeaderBar:title-widget will still be shown.
 //
-// ## `AdwBottomSheet` as `GtkBuildable`:
+// # `AdwBottomSheet` as `GtkBuildable`:
 //
-// The `AdwBottomSheet` implementation of the [iface@Gtk.Buildable] interface
+// The `AdwBottomSheet` implementation of the [gtk.Buildable] interface
 // supports setting the sheet widget by specifying “sheet” as the “type”
-// attribute of a `&lt;child&gt;` element, and the bottom bar by specifying
+// attribute of a `<child>` element, and the bottom bar by specifying
 // “bottom-bar”. Specifying “content” or omitting the child type results in
 // setting the content child.
 type BottomSheet struct {
@@ -142,7 +134,7 @@ func (x *BottomSheet) GetAlign() float32 {
 
 var xBottomSheetGetBottomBar func(uintptr) uintptr
 
-// Gets the bottom bar widget for @self.
+// Gets the bottom bar widget for self.
 func (x *BottomSheet) GetBottomBar() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -189,7 +181,7 @@ func (x *BottomSheet) GetCanOpen() bool {
 
 var xBottomSheetGetContent func(uintptr) uintptr
 
-// Gets the content widget for @self.
+// Gets the content widget for self.
 func (x *BottomSheet) GetContent() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -242,7 +234,7 @@ func (x *BottomSheet) GetRevealBottomBar() bool {
 
 var xBottomSheetGetSheet func(uintptr) uintptr
 
-// Gets the bottom sheet widget for @self.
+// Gets the bottom sheet widget for self.
 func (x *BottomSheet) GetSheet() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -284,7 +276,7 @@ var xBottomSheetSetAlign func(uintptr, float32)
 // 0 means the bottom sheet is flush with the start edge, 1 means it's flush
 // with the end edge. 0.5 means it's centered.
 //
-// Only used when [property@BottomSheet:full-width] is set to `FALSE`.
+// Only used when BottomSheet:full-width is set to `FALSE`.
 func (x *BottomSheet) SetAlign(AlignVar float32) {
 
 	xBottomSheetSetAlign(x.GoPointer(), AlignVar)
@@ -293,13 +285,13 @@ func (x *BottomSheet) SetAlign(AlignVar float32) {
 
 var xBottomSheetSetBottomBar func(uintptr, uintptr)
 
-// Sets the bottom bar widget for @self.
+// Sets the bottom bar widget for self.
 //
-// Shown when [property@BottomSheet:open] is `FALSE`. When open, morphs into
-// the [property@BottomSheet:sheet].
+// Shown when BottomSheet:open is `FALSE`. When open, morphs into
+// the BottomSheet:sheet.
 //
 // Bottom bar can be temporarily hidden using the
-// [property@BottomSheet:reveal-bottom-bar] property.
+// BottomSheet:reveal-bottom-bar property.
 func (x *BottomSheet) SetBottomBar(BottomBarVar *gtk.Widget) {
 
 	xBottomSheetSetBottomBar(x.GoPointer(), BottomBarVar.GoPointer())
@@ -311,9 +303,9 @@ var xBottomSheetSetCanClose func(uintptr, bool)
 // Sets whether the bottom sheet can be closed by user.
 //
 // It can be closed via the close button, swiping down, pressing
-// &lt;kbd&gt;Escape&lt;/kbd&gt; or clicking the content dimming (when modal).
+// Escape or clicking the content dimming (when modal).
 //
-// Bottom sheet can still be closed using [property@BottomSheet:open].
+// Bottom sheet can still be closed using BottomSheet:open.
 func (x *BottomSheet) SetCanClose(CanCloseVar bool) {
 
 	xBottomSheetSetCanClose(x.GoPointer(), CanCloseVar)
@@ -326,9 +318,9 @@ var xBottomSheetSetCanOpen func(uintptr, bool)
 //
 // It can be opened via clicking or swiping up from the bottom bar.
 //
-// Does nothing if [property@BottomSheet:bottom-bar] is not set.
+// Does nothing if BottomSheet:bottom-bar is not set.
 //
-// Bottom sheet can still be opened using [property@BottomSheet:open].
+// Bottom sheet can still be opened using BottomSheet:open.
 func (x *BottomSheet) SetCanOpen(CanOpenVar bool) {
 
 	xBottomSheetSetCanOpen(x.GoPointer(), CanOpenVar)
@@ -337,7 +329,7 @@ func (x *BottomSheet) SetCanOpen(CanOpenVar bool) {
 
 var xBottomSheetSetContent func(uintptr, uintptr)
 
-// Sets the content widget for @self.
+// Sets the content widget for self.
 //
 // It's always shown, and the bottom sheet is overlaid over it.
 func (x *BottomSheet) SetContent(ContentVar *gtk.Widget) {
@@ -350,7 +342,7 @@ var xBottomSheetSetFullWidth func(uintptr, bool)
 
 // Sets whether the bottom sheet takes the full width.
 //
-// When full width, [property@BottomSheet:align] is ignored.
+// When full width, BottomSheet:align is ignored.
 func (x *BottomSheet) SetFullWidth(FullWidthVar bool) {
 
 	xBottomSheetSetFullWidth(x.GoPointer(), FullWidthVar)
@@ -361,7 +353,7 @@ var xBottomSheetSetModal func(uintptr, bool)
 
 // Sets whether the bottom sheet is modal.
 //
-// When modal, [property@BottomSheet:content] will be dimmed when the bottom
+// When modal, BottomSheet:content will be dimmed when the bottom
 // sheet is open, and clicking it will close the bottom sheet. It also cannot be
 // focused with keyboard.
 //
@@ -387,8 +379,8 @@ var xBottomSheetSetRevealBottomBar func(uintptr, bool)
 //
 // The transition will be animated.
 //
-// See [property@BottomSheet:bottom-bar] and
-// [property@BottomSheet:bottom-bar-height].
+// See BottomSheet:bottom-bar and
+// BottomSheet:bottom-bar-height.
 func (x *BottomSheet) SetRevealBottomBar(RevealVar bool) {
 
 	xBottomSheetSetRevealBottomBar(x.GoPointer(), RevealVar)
@@ -397,9 +389,9 @@ func (x *BottomSheet) SetRevealBottomBar(RevealVar bool) {
 
 var xBottomSheetSetSheet func(uintptr, uintptr)
 
-// Sets the bottom sheet widget for @self.
+// Sets the bottom sheet widget for self.
 //
-// Only shown when [property@BottomSheet:open] is `TRUE`.
+// Only shown when BottomSheet:open is `TRUE`.
 func (x *BottomSheet) SetSheet(SheetVar *gtk.Widget) {
 
 	xBottomSheetSetSheet(x.GoPointer(), SheetVar.GoPointer())
@@ -410,10 +402,10 @@ var xBottomSheetSetShowDragHandle func(uintptr, bool)
 
 // Sets whether to show a drag handle in the bottom sheet.
 //
-// The handle will be overlaid over [property@BottomSheet:sheet].
+// The handle will be overlaid over BottomSheet:sheet.
 //
-// When the handle is shown, [class@HeaderBar] will hide its default title, and
-// [class@ToolbarView] will reserve space if there are no top bars.
+// When the handle is shown, HeaderBar will hide its default title, and
+// ToolbarView will reserve space if there are no top bars.
 //
 // Showing drag handle also allows to swipe the bottom sheet down (and to swipe
 // the bottom bar up) with a pointer, instead of just touchscreen.
@@ -440,7 +432,7 @@ func (c *BottomSheet) SetGoPointer(ptr uintptr) {
 // 0 means the bottom sheet is flush with the start edge, 1 means it's flush
 // with the end edge. 0.5 means it's centered.
 //
-// Only used when [property@BottomSheet:full-width] is set to `FALSE`.
+// Only used when BottomSheet:full-width is set to `FALSE`.
 func (x *BottomSheet) SetPropertyAlign(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -454,7 +446,7 @@ func (x *BottomSheet) SetPropertyAlign(value float32) {
 // 0 means the bottom sheet is flush with the start edge, 1 means it's flush
 // with the end edge. 0.5 means it's centered.
 //
-// Only used when [property@BottomSheet:full-width] is set to `FALSE`.
+// Only used when BottomSheet:full-width is set to `FALSE`.
 func (x *BottomSheet) GetPropertyAlign() float32 {
 	var v gobject.Value
 	x.GetProperty("align", &v)
@@ -476,9 +468,9 @@ func (x *BottomSheet) GetPropertyBottomBarHeight() int {
 // Whether the bottom sheet can be closed by user.
 //
 // It can be closed via the close button, swiping down, pressing
-// &lt;kbd&gt;Escape&lt;/kbd&gt; or clicking the content dimming (when modal).
+// Escape or clicking the content dimming (when modal).
 //
-// Bottom sheet can still be closed using [property@BottomSheet:open].
+// Bottom sheet can still be closed using BottomSheet:open.
 func (x *BottomSheet) SetPropertyCanClose(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -490,9 +482,9 @@ func (x *BottomSheet) SetPropertyCanClose(value bool) {
 // Whether the bottom sheet can be closed by user.
 //
 // It can be closed via the close button, swiping down, pressing
-// &lt;kbd&gt;Escape&lt;/kbd&gt; or clicking the content dimming (when modal).
+// Escape or clicking the content dimming (when modal).
 //
-// Bottom sheet can still be closed using [property@BottomSheet:open].
+// Bottom sheet can still be closed using BottomSheet:open.
 func (x *BottomSheet) GetPropertyCanClose() bool {
 	var v gobject.Value
 	x.GetProperty("can-close", &v)
@@ -504,9 +496,9 @@ func (x *BottomSheet) GetPropertyCanClose() bool {
 //
 // It can be opened via clicking or swiping up from the bottom bar.
 //
-// Does nothing if [property@BottomSheet:bottom-bar] is not set.
+// Does nothing if BottomSheet:bottom-bar is not set.
 //
-// Bottom sheet can still be opened using [property@BottomSheet:open].
+// Bottom sheet can still be opened using BottomSheet:open.
 func (x *BottomSheet) SetPropertyCanOpen(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -519,9 +511,9 @@ func (x *BottomSheet) SetPropertyCanOpen(value bool) {
 //
 // It can be opened via clicking or swiping up from the bottom bar.
 //
-// Does nothing if [property@BottomSheet:bottom-bar] is not set.
+// Does nothing if BottomSheet:bottom-bar is not set.
 //
-// Bottom sheet can still be opened using [property@BottomSheet:open].
+// Bottom sheet can still be opened using BottomSheet:open.
 func (x *BottomSheet) GetPropertyCanOpen() bool {
 	var v gobject.Value
 	x.GetProperty("can-open", &v)
@@ -531,7 +523,7 @@ func (x *BottomSheet) GetPropertyCanOpen() bool {
 // SetPropertyFullWidth sets the "full-width" property.
 // Whether the bottom sheet takes the full width.
 //
-// When full width, [property@BottomSheet:align] is ignored.
+// When full width, BottomSheet:align is ignored.
 func (x *BottomSheet) SetPropertyFullWidth(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -542,7 +534,7 @@ func (x *BottomSheet) SetPropertyFullWidth(value bool) {
 // GetPropertyFullWidth gets the "full-width" property.
 // Whether the bottom sheet takes the full width.
 //
-// When full width, [property@BottomSheet:align] is ignored.
+// When full width, BottomSheet:align is ignored.
 func (x *BottomSheet) GetPropertyFullWidth() bool {
 	var v gobject.Value
 	x.GetProperty("full-width", &v)
@@ -552,7 +544,7 @@ func (x *BottomSheet) GetPropertyFullWidth() bool {
 // SetPropertyModal sets the "modal" property.
 // Whether the bottom sheet is modal.
 //
-// When modal, [property@BottomSheet:content] will be dimmed when the bottom
+// When modal, BottomSheet:content will be dimmed when the bottom
 // sheet is open, and clicking it will close the bottom sheet. It also cannot
 // be focused with keyboard.
 //
@@ -567,7 +559,7 @@ func (x *BottomSheet) SetPropertyModal(value bool) {
 // GetPropertyModal gets the "modal" property.
 // Whether the bottom sheet is modal.
 //
-// When modal, [property@BottomSheet:content] will be dimmed when the bottom
+// When modal, BottomSheet:content will be dimmed when the bottom
 // sheet is open, and clicking it will close the bottom sheet. It also cannot
 // be focused with keyboard.
 //
@@ -600,8 +592,8 @@ func (x *BottomSheet) GetPropertyOpen() bool {
 //
 // The transition will be animated.
 //
-// See [property@BottomSheet:bottom-bar] and
-// [property@BottomSheet:bottom-bar-height].
+// See BottomSheet:bottom-bar and
+// BottomSheet:bottom-bar-height.
 func (x *BottomSheet) SetPropertyRevealBottomBar(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -614,8 +606,8 @@ func (x *BottomSheet) SetPropertyRevealBottomBar(value bool) {
 //
 // The transition will be animated.
 //
-// See [property@BottomSheet:bottom-bar] and
-// [property@BottomSheet:bottom-bar-height].
+// See BottomSheet:bottom-bar and
+// BottomSheet:bottom-bar-height.
 func (x *BottomSheet) GetPropertyRevealBottomBar() bool {
 	var v gobject.Value
 	x.GetProperty("reveal-bottom-bar", &v)
@@ -635,10 +627,10 @@ func (x *BottomSheet) GetPropertySheetHeight() int {
 // SetPropertyShowDragHandle sets the "show-drag-handle" property.
 // Whether to overlay a drag handle in the bottom sheet.
 //
-// The handle will be overlaid over [property@BottomSheet:sheet].
+// The handle will be overlaid over BottomSheet:sheet.
 //
-// When the handle is shown, [class@HeaderBar] will hide its default title,
-// and [class@ToolbarView] will reserve space if there are no top bars.
+// When the handle is shown, HeaderBar will hide its default title,
+// and ToolbarView will reserve space if there are no top bars.
 //
 // Showing drag handle also allows to swipe the bottom sheet down (and to
 // swipe the bottom bar up) with a pointer, instead of just touchscreen.
@@ -652,10 +644,10 @@ func (x *BottomSheet) SetPropertyShowDragHandle(value bool) {
 // GetPropertyShowDragHandle gets the "show-drag-handle" property.
 // Whether to overlay a drag handle in the bottom sheet.
 //
-// The handle will be overlaid over [property@BottomSheet:sheet].
+// The handle will be overlaid over BottomSheet:sheet.
 //
-// When the handle is shown, [class@HeaderBar] will hide its default title,
-// and [class@ToolbarView] will reserve space if there are no top bars.
+// When the handle is shown, HeaderBar will hide its default title,
+// and ToolbarView will reserve space if there are no top bars.
 //
 // Showing drag handle also allows to swipe the bottom sheet down (and to
 // swipe the bottom bar up) with a pointer, instead of just touchscreen.
@@ -666,38 +658,60 @@ func (x *BottomSheet) GetPropertyShowDragHandle() bool {
 }
 
 // Emitted when the close button or shortcut is used while
-// [property@Dialog:can-close] is set to `FALSE`.
+// Dialog:can-close is set to `FALSE`.
+//
+// Deprecated: use ConnectCloseAttemptFunc, which also accepts method values and closures.
 func (x *BottomSheet) ConnectCloseAttempt(cb *func(BottomSheet)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close-attempt", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := BottomSheet{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close-attempt", cbRefPtr, cbPtr)
+}
+
+var xBottomSheetCloseAttemptTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xBottomSheetCloseAttemptNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(BottomSheet))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := BottomSheet{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close-attempt", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// ConnectCloseAttemptFunc connects cb to the "close-attempt" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *BottomSheet) ConnectCloseAttemptFunc(cb func(BottomSheet)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close-attempt", &xBottomSheetCloseAttemptTrampoline, xBottomSheetCloseAttemptNewTrampoline, cb)
+}
+
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *BottomSheet) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *BottomSheet) GetDistance() float64 {
@@ -706,14 +720,14 @@ func (x *BottomSheet) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *BottomSheet) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -723,7 +737,7 @@ func (x *BottomSheet) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -731,7 +745,7 @@ func (x *BottomSheet) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *BottomSheet) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -899,7 +913,7 @@ func (x *BottomSheet) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -907,14 +921,13 @@ func (x *BottomSheet) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *BottomSheet) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -938,19 +951,17 @@ func (x *BottomSheet) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *BottomSheet) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -971,7 +982,7 @@ func (x *BottomSheet) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -980,14 +991,12 @@ func (x *BottomSheet) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *BottomSheet) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1006,10 +1015,10 @@ func (x *BottomSheet) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *BottomSheet) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1021,7 +1030,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1030,31 +1039,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xBottomSheetGLibType, libs, "adw_bottom_sheet_get_type")
 
-	core.PuregoSafeRegister(&xNewBottomSheet, libs, "adw_bottom_sheet_new")
-
-	core.PuregoSafeRegister(&xBottomSheetGetAlign, libs, "adw_bottom_sheet_get_align")
-	core.PuregoSafeRegister(&xBottomSheetGetBottomBar, libs, "adw_bottom_sheet_get_bottom_bar")
-	core.PuregoSafeRegister(&xBottomSheetGetBottomBarHeight, libs, "adw_bottom_sheet_get_bottom_bar_height")
-	core.PuregoSafeRegister(&xBottomSheetGetCanClose, libs, "adw_bottom_sheet_get_can_close")
-	core.PuregoSafeRegister(&xBottomSheetGetCanOpen, libs, "adw_bottom_sheet_get_can_open")
-	core.PuregoSafeRegister(&xBottomSheetGetContent, libs, "adw_bottom_sheet_get_content")
-	core.PuregoSafeRegister(&xBottomSheetGetFullWidth, libs, "adw_bottom_sheet_get_full_width")
-	core.PuregoSafeRegister(&xBottomSheetGetModal, libs, "adw_bottom_sheet_get_modal")
-	core.PuregoSafeRegister(&xBottomSheetGetOpen, libs, "adw_bottom_sheet_get_open")
-	core.PuregoSafeRegister(&xBottomSheetGetRevealBottomBar, libs, "adw_bottom_sheet_get_reveal_bottom_bar")
-	core.PuregoSafeRegister(&xBottomSheetGetSheet, libs, "adw_bottom_sheet_get_sheet")
-	core.PuregoSafeRegister(&xBottomSheetGetSheetHeight, libs, "adw_bottom_sheet_get_sheet_height")
-	core.PuregoSafeRegister(&xBottomSheetGetShowDragHandle, libs, "adw_bottom_sheet_get_show_drag_handle")
-	core.PuregoSafeRegister(&xBottomSheetSetAlign, libs, "adw_bottom_sheet_set_align")
-	core.PuregoSafeRegister(&xBottomSheetSetBottomBar, libs, "adw_bottom_sheet_set_bottom_bar")
-	core.PuregoSafeRegister(&xBottomSheetSetCanClose, libs, "adw_bottom_sheet_set_can_close")
-	core.PuregoSafeRegister(&xBottomSheetSetCanOpen, libs, "adw_bottom_sheet_set_can_open")
-	core.PuregoSafeRegister(&xBottomSheetSetContent, libs, "adw_bottom_sheet_set_content")
-	core.PuregoSafeRegister(&xBottomSheetSetFullWidth, libs, "adw_bottom_sheet_set_full_width")
-	core.PuregoSafeRegister(&xBottomSheetSetModal, libs, "adw_bottom_sheet_set_modal")
-	core.PuregoSafeRegister(&xBottomSheetSetOpen, libs, "adw_bottom_sheet_set_open")
-	core.PuregoSafeRegister(&xBottomSheetSetRevealBottomBar, libs, "adw_bottom_sheet_set_reveal_bottom_bar")
-	core.PuregoSafeRegister(&xBottomSheetSetSheet, libs, "adw_bottom_sheet_set_sheet")
-	core.PuregoSafeRegister(&xBottomSheetSetShowDragHandle, libs, "adw_bottom_sheet_set_show_drag_handle")
+	gobject.CheckClassSize("AdwBottomSheetClass", xBottomSheetGLibType, unsafe.Sizeof(BottomSheetClass{}))
+
+	core.PuregoRegisterSince(&xNewBottomSheet, libs, "adw_bottom_sheet_new", "ADW", "1.6")
+
+	core.PuregoRegisterSince(&xBottomSheetGetAlign, libs, "adw_bottom_sheet_get_align", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetBottomBar, libs, "adw_bottom_sheet_get_bottom_bar", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetBottomBarHeight, libs, "adw_bottom_sheet_get_bottom_bar_height", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetCanClose, libs, "adw_bottom_sheet_get_can_close", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetCanOpen, libs, "adw_bottom_sheet_get_can_open", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetContent, libs, "adw_bottom_sheet_get_content", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetFullWidth, libs, "adw_bottom_sheet_get_full_width", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetModal, libs, "adw_bottom_sheet_get_modal", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetOpen, libs, "adw_bottom_sheet_get_open", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetRevealBottomBar, libs, "adw_bottom_sheet_get_reveal_bottom_bar", "ADW", "1.7")
+	core.PuregoRegisterSince(&xBottomSheetGetSheet, libs, "adw_bottom_sheet_get_sheet", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetSheetHeight, libs, "adw_bottom_sheet_get_sheet_height", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetGetShowDragHandle, libs, "adw_bottom_sheet_get_show_drag_handle", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetAlign, libs, "adw_bottom_sheet_set_align", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetBottomBar, libs, "adw_bottom_sheet_set_bottom_bar", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetCanClose, libs, "adw_bottom_sheet_set_can_close", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetCanOpen, libs, "adw_bottom_sheet_set_can_open", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetContent, libs, "adw_bottom_sheet_set_content", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetFullWidth, libs, "adw_bottom_sheet_set_full_width", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetModal, libs, "adw_bottom_sheet_set_modal", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetOpen, libs, "adw_bottom_sheet_set_open", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetRevealBottomBar, libs, "adw_bottom_sheet_set_reveal_bottom_bar", "ADW", "1.7")
+	core.PuregoRegisterSince(&xBottomSheetSetSheet, libs, "adw_bottom_sheet_set_sheet", "ADW", "1.6")
+	core.PuregoRegisterSince(&xBottomSheetSetShowDragHandle, libs, "adw_bottom_sheet_set_show_drag_handle", "ADW", "1.6")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,30 +25,23 @@ func (x *BreakpointBinClass) GoPointer() uintptr {
 
 // A widget that changes layout based on available size.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="breakpoint-bin-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="breakpoint-bin.png" alt="breakpoint-bin"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwBreakpointBin` provides a way to use breakpoints without [class@Window],
-// [class@ApplicationWindow] or [class@Dialog]. It can be useful for limiting
+// `AdwBreakpointBin` provides a way to use breakpoints without Window,
+// ApplicationWindow or Dialog. It can be useful for limiting
 // breakpoints to a single page and similar purposes. Most applications
 // shouldn't need it.
 //
-// `AdwBreakpointBin` is similar to [class@Bin]. It has one child, set via the
-// [property@BreakpointBin:child] property.
+// `AdwBreakpointBin` is similar to Bin. It has one child, set via the
+// BreakpointBin:child property.
 //
 // When `AdwBreakpointBin` is resized, its child widget can rearrange its layout
 // at specific thresholds.
 //
-// The thresholds and layout changes are defined via [class@Breakpoint] objects.
-// They can be added using [method@BreakpointBin.add_breakpoint].
+// The thresholds and layout changes are defined via Breakpoint objects.
+// They can be added using add_breakpoint.
 //
 // Each breakpoint has a condition, specifying the bin's size and/or aspect
 // ratio, and setters that automatically set object properties when that
-// happens. The [signal@Breakpoint::apply] and [signal@Breakpoint::unapply] can
+// happens. The Breakpoint::apply and Breakpoint::unapply can
 // be used instead for more complex scenarios.
 //
 // Breakpoints are only allowed to modify widgets inside the `AdwBreakpointBin`,
@@ -57,16 +49,16 @@ func (x *BreakpointBinClass) GoPointer() uintptr {
 //
 // If multiple breakpoints can be used for the current size, the last one is
 // always picked. The current breakpoint can be tracked using the
-// [property@BreakpointBin:current-breakpoint] property.
+// BreakpointBin:current-breakpoint property.
 //
 // If none of the breakpoints can be used, that property will be set to `NULL`,
 // and the original property values will be used instead.
 //
-// ## Minimum Size
+// # Minimum Size
 //
 // Adding a breakpoint to `AdwBreakpointBin` will result in it having no minimum
-// size. The [property@Gtk.Widget:width-request] and
-// [property@Gtk.Widget:height-request] properties must always be set when using
+// size. The [gtk.Widget.GetPropertyWidthRequest] and
+// [gtk.Widget.GetPropertyHeightRequest] properties must always be set when using
 // breakpoints, indicating the smallest size you want to support.
 //
 // The minimum size and breakpoint conditions must be carefully selected so that
@@ -77,70 +69,62 @@ func (x *BreakpointBinClass) GoPointer() uintptr {
 // changes. Make sure to leave enough space for text labels, and enable
 // ellipsizing or wrapping if they might not fit.
 //
-// For [class@Gtk.Label] this can be done via [property@Gtk.Label:ellipsize], or
-// via [property@Gtk.Label:wrap] together with [property@Gtk.Label:wrap-mode].
-//
-// For buttons, use [property@Gtk.Button:can-shrink],
-// [property@Gtk.MenuButton:can-shrink], [property@Adw.SplitButton:can-shrink],
-// or [property@Adw.ButtonContent:can-shrink].
+// For [gtk.Label] this can be done via Label:ellipsize, or
+// via [gtk.Label.GetPropertyWrap] together with Label:wrap-mode.
 //
-// ## Example
+// For buttons, use [gtk.Button.GetPropertyCanShrink],
+// [gtk.MenuButton.GetPropertyCanShrink], [SplitButton.GetPropertyCanShrink],
+// or [ButtonContent.GetPropertyCanShrink].
 //
-// ```c
-// GtkWidget *bin, *child;
-// AdwBreakpoint *breakpoint;
+// # Example
 //
-// bin = adw_breakpoint_bin_new ();
-// gtk_widget_set_size_request (bin, 150, 150);
+//	GtkWidget *bin, *child;
+//	AdwBreakpoint *breakpoint;
 //
-// child = gtk_label_new ("Wide");
-// gtk_label_set_ellipsize (GTK_LABEL (label), PANGO_ELLIPSIZE_END);
-// gtk_widget_add_css_class (child, "title-1");
-// adw_breakpoint_bin_set_child (ADW_BREAKPOINT_BIN (bin), child);
+//	bin = adw_breakpoint_bin_new ();
+//	gtk_widget_set_size_request (bin, 150, 150);
 //
-// breakpoint = adw_breakpoint_new (adw_breakpoint_condition_parse ("max-width: 200px"));
-// adw_breakpoint_add_setters (breakpoint,
+//	child = gtk_label_new ("Wide");
+//	gtk_label_set_ellipsize (GTK_LABEL (label), PANGO_ELLIPSIZE_END);
+//	gtk_widget_add_css_class (child, "title-1");
+//	adw_breakpoint_bin_set_child (ADW_BREAKPOINT_BIN (bin), child);
 //
-//	G_OBJECT (child), "label", "Narrow",
-//	NULL);
-//
-// adw_breakpoint_bin_add_breakpoint (ADW_BREAKPOINT_BIN (bin), breakpoint);
-// ```
+//	breakpoint = adw_breakpoint_new (adw_breakpoint_condition_parse ("max-width: 200px"));
+//	adw_breakpoint_add_setters (breakpoint,
+//	                            G_OBJECT (child), "label", "Narrow",
+//	                            NULL);
+//	adw_breakpoint_bin_add_breakpoint (ADW_BREAKPOINT_BIN (bin), breakpoint);
 //
 // The bin has a single label inside it, displaying "Wide". When the bin's width
 // is smaller than or equal to 200px, it changes to "Narrow".
 //
-// ## `AdwBreakpointBin` as `GtkBuildable`
+// # `AdwBreakpointBin` as `GtkBuildable`
 //
 // `AdwBreakpointBin` allows adding `AdwBreakpoint` objects as children.
 //
 // Example of an `AdwBreakpointBin` UI definition:
 //
-// ```xml
-// &lt;object class="AdwBreakpointBin"&gt;
-//
-//	&lt;property name="width-request"&gt;150&lt;/property&gt;
-//	&lt;property name="height-request"&gt;150&lt;/property&gt;
-//	&lt;property name="child"&gt;
-//	  &lt;object class="GtkLabel" id="child"&gt;
-//	    &lt;property name="label"&gt;Wide&lt;/property&gt;
-//	    &lt;property name="ellipsize"&gt;end&lt;/property&gt;
-//	    &lt;style&gt;
-//	      &lt;class name="title-1"/&gt;
-//	    &lt;/style&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 200px&lt;/condition&gt;
-//	    &lt;setter object="child" property="label"&gt;Narrow&lt;/setter&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// See [class@Breakpoint] documentation for details.
+//	<object class="AdwBreakpointBin">
+//	  <property name="width-request">150</property>
+//	  <property name="height-request">150</property>
+//	  <property name="child">
+//	    <object class="GtkLabel" id="child">
+//	      <property name="label">Wide</property>
+//	      <property name="ellipsize">end</property>
+//	      <style>
+//	        <class name="title-1"/>
+//	      </style>
+//	    </object>
+//	  </property>
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 200px</condition>
+//	      <setter object="child" property="label">Narrow</setter>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// See Breakpoint documentation for details.
 type BreakpointBin struct {
 	gtk.Widget
 }
@@ -176,7 +160,7 @@ func NewBreakpointBin() *BreakpointBin {
 
 var xBreakpointBinAddBreakpoint func(uintptr, uintptr)
 
-// Adds @breakpoint to @self.
+// Adds breakpoint to self.
 func (x *BreakpointBin) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 	xBreakpointBinAddBreakpoint(x.GoPointer(), BreakpointVar.GoPointer())
@@ -185,7 +169,7 @@ func (x *BreakpointBin) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 var xBreakpointBinGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *BreakpointBin) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -219,7 +203,7 @@ func (x *BreakpointBin) GetCurrentBreakpoint() *Breakpoint {
 
 var xBreakpointBinRemoveBreakpoint func(uintptr, uintptr)
 
-// Removes @breakpoint from @self.
+// Removes breakpoint from self.
 func (x *BreakpointBin) RemoveBreakpoint(BreakpointVar *Breakpoint) {
 
 	xBreakpointBinRemoveBreakpoint(x.GoPointer(), BreakpointVar.GoPointer())
@@ -228,7 +212,7 @@ func (x *BreakpointBin) RemoveBreakpoint(BreakpointVar *Breakpoint) {
 
 var xBreakpointBinSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *BreakpointBin) SetChild(ChildVar *gtk.Widget) {
 
 	xBreakpointBinSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -407,7 +391,7 @@ func (x *BreakpointBin) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -415,14 +399,13 @@ func (x *BreakpointBin) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *BreakpointBin) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -446,19 +429,17 @@ func (x *BreakpointBin) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *BreakpointBin) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -479,7 +460,7 @@ func (x *BreakpointBin) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -488,14 +469,12 @@ func (x *BreakpointBin) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *BreakpointBin) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -514,10 +493,10 @@ func (x *BreakpointBin) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *BreakpointBin) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -529,7 +508,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -538,12 +517,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xBreakpointBinGLibType, libs, "adw_breakpoint_bin_get_type")
 
-	core.PuregoSafeRegister(&xNewBreakpointBin, libs, "adw_breakpoint_bin_new")
+	gobject.CheckClassSize("AdwBreakpointBinClass", xBreakpointBinGLibType, unsafe.Sizeof(BreakpointBinClass{}))
+
+	core.PuregoRegisterSince(&xNewBreakpointBin, libs, "adw_breakpoint_bin_new", "ADW", "1.4")
 
-	core.PuregoSafeRegister(&xBreakpointBinAddBreakpoint, libs, "adw_breakpoint_bin_add_breakpoint")
-	core.PuregoSafeRegister(&xBreakpointBinGetChild, libs, "adw_breakpoint_bin_get_child")
-	core.PuregoSafeRegister(&xBreakpointBinGetCurrentBreakpoint, libs, "adw_breakpoint_bin_get_current_breakpoint")
-	core.PuregoSafeRegister(&xBreakpointBinRemoveBreakpoint, libs, "adw_breakpoint_bin_remove_breakpoint")
-	core.PuregoSafeRegister(&xBreakpointBinSetChild, libs, "adw_breakpoint_bin_set_child")
+	core.PuregoRegisterSince(&xBreakpointBinAddBreakpoint, libs, "adw_breakpoint_bin_add_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointBinGetChild, libs, "adw_breakpoint_bin_get_child", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointBinGetCurrentBreakpoint, libs, "adw_breakpoint_bin_get_current_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointBinRemoveBreakpoint, libs, "adw_breakpoint_bin_remove_breakpoint", "ADW", "1.5")
+	core.PuregoRegisterSince(&xBreakpointBinSetChild, libs, "adw_breakpoint_bin_set_child", "ADW", "1.4")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +23,7 @@ func (x *BreakpointClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes condition for an [class@Breakpoint].
+// Describes condition for an Breakpoint.
 type BreakpointCondition struct {
 	_ structs.HostLayout
 }
@@ -38,13 +38,13 @@ func (x *BreakpointCondition) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xNewBreakpointConditionAnd func(*BreakpointCondition, *BreakpointCondition) *BreakpointCondition
+var xNewBreakpointConditionAnd func(uintptr, uintptr) *BreakpointCondition
 
-// Creates a condition that triggers when @condition_1 and @condition_2 are both
+// Creates a condition that triggers when condition_1 and condition_2 are both
 // true.
 func NewBreakpointConditionAnd(Condition1Var *BreakpointCondition, Condition2Var *BreakpointCondition) *BreakpointCondition {
 
-	cret := xNewBreakpointConditionAnd(Condition1Var, Condition2Var)
+	cret := xNewBreakpointConditionAnd(gobject.BoxedCopy(BreakpointConditionGLibType(), Condition1Var.GoPointer()), gobject.BoxedCopy(BreakpointConditionGLibType(), Condition2Var.GoPointer()))
 	return cret
 }
 
@@ -57,13 +57,13 @@ func NewBreakpointConditionLength(TypeVar BreakpointConditionLengthType, ValueVa
 	return cret
 }
 
-var xNewBreakpointConditionOr func(*BreakpointCondition, *BreakpointCondition) *BreakpointCondition
+var xNewBreakpointConditionOr func(uintptr, uintptr) *BreakpointCondition
 
-// Creates a condition that triggers when either @condition_1 or @condition_2 is
+// Creates a condition that triggers when either condition_1 or condition_2 is
 // true.
 func NewBreakpointConditionOr(Condition1Var *BreakpointCondition, Condition2Var *BreakpointCondition) *BreakpointCondition {
 
-	cret := xNewBreakpointConditionOr(Condition1Var, Condition2Var)
+	cret := xNewBreakpointConditionOr(gobject.BoxedCopy(BreakpointConditionGLibType(), Condition1Var.GoPointer()), gobject.BoxedCopy(BreakpointConditionGLibType(), Condition2Var.GoPointer()))
 	return cret
 }
 
@@ -71,7 +71,7 @@ var xNewBreakpointConditionRatio func(BreakpointConditionRatioType, int, int) *B
 
 // Creates a condition that triggers on ratio changes.
 //
-// The ratio is represented as @width divided by @height.
+// The ratio is represented as width divided by height.
 func NewBreakpointConditionRatio(TypeVar BreakpointConditionRatioType, WidthVar int, HeightVar int) *BreakpointCondition {
 
 	cret := xNewBreakpointConditionRatio(TypeVar, WidthVar, HeightVar)
@@ -80,7 +80,7 @@ func NewBreakpointConditionRatio(TypeVar BreakpointConditionRatioType, WidthVar
 
 var xBreakpointConditionCopy func(uintptr) *BreakpointCondition
 
-// Copies @self.
+// Copies self.
 func (x *BreakpointCondition) Copy() *BreakpointCondition {
 
 	cret := xBreakpointConditionCopy(x.GoPointer())
@@ -89,7 +89,7 @@ func (x *BreakpointCondition) Copy() *BreakpointCondition {
 
 var xBreakpointConditionFree func(uintptr)
 
-// Frees @self.
+// Frees self.
 func (x *BreakpointCondition) Free() {
 
 	xBreakpointConditionFree(x.GoPointer())
@@ -98,18 +98,18 @@ func (x *BreakpointCondition) Free() {
 
 var xBreakpointConditionToString func(uintptr) string
 
-// Returns a textual representation of @self.
+// Returns a textual representation of self.
 //
-// The returned string can be parsed by [func@BreakpointCondition.parse].
+// The returned string can be parsed by parse.
 func (x *BreakpointCondition) ToString() string {
 
 	cret := xBreakpointConditionToString(x.GoPointer())
 	return cret
 }
 
-// Describes length types for [struct@BreakpointCondition].
+// Describes length types for BreakpointCondition.
 //
-// See [ctor@BreakpointCondition.new_length].
+// See new_length.
 //
 // New values may be added to this enumeration over time.
 type BreakpointConditionLengthType int
@@ -136,9 +136,54 @@ const (
 	BreakpointConditionMaxHeightValue BreakpointConditionLengthType = 3
 )
 
-// Describes ratio types for [struct@BreakpointCondition].
+// String returns the C name of the value, e.g. for debugging output
+func (x BreakpointConditionLengthType) String() string {
+	switch x {
+	case BreakpointConditionMinWidthValue:
+		return "ADW_BREAKPOINT_CONDITION_MIN_WIDTH"
+	case BreakpointConditionMaxWidthValue:
+		return "ADW_BREAKPOINT_CONDITION_MAX_WIDTH"
+	case BreakpointConditionMinHeightValue:
+		return "ADW_BREAKPOINT_CONDITION_MIN_HEIGHT"
+	case BreakpointConditionMaxHeightValue:
+		return "ADW_BREAKPOINT_CONDITION_MAX_HEIGHT"
+	}
+	return fmt.Sprintf("BreakpointConditionLengthType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BreakpointConditionLengthType) Nick() string {
+	switch x {
+	case BreakpointConditionMinWidthValue:
+		return "min-width"
+	case BreakpointConditionMaxWidthValue:
+		return "max-width"
+	case BreakpointConditionMinHeightValue:
+		return "min-height"
+	case BreakpointConditionMaxHeightValue:
+		return "max-height"
+	}
+	return ""
+}
+
+// BreakpointConditionLengthTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BreakpointConditionLengthTypeFromString(s string) (BreakpointConditionLengthType, bool) {
+	switch s {
+	case "ADW_BREAKPOINT_CONDITION_MIN_WIDTH", "min-width":
+		return BreakpointConditionMinWidthValue, true
+	case "ADW_BREAKPOINT_CONDITION_MAX_WIDTH", "max-width":
+		return BreakpointConditionMaxWidthValue, true
+	case "ADW_BREAKPOINT_CONDITION_MIN_HEIGHT", "min-height":
+		return BreakpointConditionMinHeightValue, true
+	case "ADW_BREAKPOINT_CONDITION_MAX_HEIGHT", "max-height":
+		return BreakpointConditionMaxHeightValue, true
+	}
+	return 0, false
+}
+
+// Describes ratio types for BreakpointCondition.
 //
-// See [ctor@BreakpointCondition.new_ratio].
+// See new_ratio.
 //
 // New values may be added to this enumeration over time.
 type BreakpointConditionRatioType int
@@ -159,19 +204,52 @@ const (
 	BreakpointConditionMaxAspectRatioValue BreakpointConditionRatioType = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x BreakpointConditionRatioType) String() string {
+	switch x {
+	case BreakpointConditionMinAspectRatioValue:
+		return "ADW_BREAKPOINT_CONDITION_MIN_ASPECT_RATIO"
+	case BreakpointConditionMaxAspectRatioValue:
+		return "ADW_BREAKPOINT_CONDITION_MAX_ASPECT_RATIO"
+	}
+	return fmt.Sprintf("BreakpointConditionRatioType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BreakpointConditionRatioType) Nick() string {
+	switch x {
+	case BreakpointConditionMinAspectRatioValue:
+		return "min-aspect-ratio"
+	case BreakpointConditionMaxAspectRatioValue:
+		return "max-aspect-ratio"
+	}
+	return ""
+}
+
+// BreakpointConditionRatioTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BreakpointConditionRatioTypeFromString(s string) (BreakpointConditionRatioType, bool) {
+	switch s {
+	case "ADW_BREAKPOINT_CONDITION_MIN_ASPECT_RATIO", "min-aspect-ratio":
+		return BreakpointConditionMinAspectRatioValue, true
+	case "ADW_BREAKPOINT_CONDITION_MAX_ASPECT_RATIO", "max-aspect-ratio":
+		return BreakpointConditionMaxAspectRatioValue, true
+	}
+	return 0, false
+}
+
 var xBreakpointConditionParse func(string) *BreakpointCondition
 
 // Parses a condition from a string.
 //
-// Length conditions are specified as `&lt;type&gt;: &lt;value&gt;[&lt;unit&gt;]`, where:
+// Length conditions are specified as `<type>: <value>[<unit>]`, where:
 //
-// - `&lt;type&gt;` can be `min-width`, `max-width`, `min-height` or `max-height`
-// - `&lt;value&gt;` is a fractional number
-// - `&lt;unit&gt;` can be `px`, `pt` or `sp`
+// - `<type>` can be `min-width`, `max-width`, `min-height` or `max-height`
+// - `<value>` is a fractional number
+// - `<unit>` can be `px`, `pt` or `sp`
 //
 // If the unit is omitted, `px` is assumed.
 //
-// See [ctor@BreakpointCondition.new_length].
+// See new_length.
 //
 // Examples:
 //
@@ -180,16 +258,16 @@ var xBreakpointConditionParse func(string) *BreakpointCondition
 // - `max-width: 100sp`
 // - `max-height: 500`
 //
-// Ratio conditions are specified as `&lt;type&gt;: &lt;width&gt;[/&lt;height&gt;]`, where:
+// Ratio conditions are specified as `<type>: <width>[/<height>]`, where:
 //
-// - `&lt;type&gt;` can be `min-aspect-ratio` or `max-aspect-ratio`
-// - `&lt;width&gt;` and `&lt;height&gt;` are integer numbers
+// - `<type>` can be `min-aspect-ratio` or `max-aspect-ratio`
+// - `<width>` and `<height>` are integer numbers
 //
-// See [ctor@BreakpointCondition.new_ratio].
+// See new_ratio.
 //
-// The ratio is represented as `&lt;width&gt;` divided by `&lt;height&gt;`.
+// The ratio is represented as `<width>` divided by `<height>`.
 //
-// If `&lt;height&gt;` is omitted, it's assumed to be 1.
+// If `<height>` is omitted, it's assumed to be 1.
 //
 // Examples:
 //
@@ -199,12 +277,12 @@ var xBreakpointConditionParse func(string) *BreakpointCondition
 // The logical operators `and`, `or` can be used to compose a complex condition
 // as follows:
 //
-//   - `&lt;condition&gt; and &lt;condition&gt;`: the condition is true when both
-//     `&lt;condition&gt;`s are true, same as when using
-//     [ctor@BreakpointCondition.new_and]
-//   - `&lt;condition&gt; or &lt;condition&gt;`: the condition is true when either of the
-//     `&lt;condition&gt;`s is true, same as when using
-//     [ctor@BreakpointCondition.new_or]
+//   - `<condition> and <condition>`: the condition is true when both
+//     `<condition>`s are true, same as when using
+//     new_and
+//   - `<condition> or <condition>`: the condition is true when either of the
+//     `<condition>`s is true, same as when using
+//     new_or
 //
 // Examples:
 //
@@ -222,7 +300,7 @@ func BreakpointConditionParse(StrVar string) *BreakpointCondition {
 	return cret
 }
 
-// Describes a breakpoint for [class@Window] or [class@Dialog].
+// Describes a breakpoint for Window or Dialog.
 //
 // Breakpoints are used to create adaptive UI, allowing to change the layout
 // depending on available size.
@@ -235,19 +313,19 @@ func BreakpointConditionParse(StrVar string) *BreakpointCondition {
 // the specified value, and reset it back to the original value when it's
 // unapplied.
 //
-// For more complicated scenarios, [signal@Breakpoint::apply] and
-// [signal@Breakpoint::unapply] can be used instead.
+// For more complicated scenarios, Breakpoint::apply and
+// Breakpoint::unapply can be used instead.
 //
-// Breakpoints can be used within [class@Window], [class@ApplicationWindow],
-// [class@Dialog] or [class@BreakpointBin].
+// Breakpoints can be used within Window, ApplicationWindow,
+// Dialog or BreakpointBin.
 //
-// ## `AdwBreakpoint` as `GtkBuildable`:
+// # `AdwBreakpoint` as `GtkBuildable`:
 //
-// `AdwBreakpoint` supports specifying its condition via the `&lt;condition&gt;`
+// `AdwBreakpoint` supports specifying its condition via the `<condition>`
 // element. The contents of the element must be a string in a format accepted by
-// [func@BreakpointCondition.parse].
+// parse.
 //
-// It also supports adding setters via the `&lt;setter&gt;` element. Each `&lt;setter&gt;`
+// It also supports adding setters via the `<setter>` element. Each `<setter>`
 // element must have the `object` attribute specifying the target object, and
 // the `property` attribute specifying the property name. The contents of the
 // element are used as the setter value.
@@ -260,16 +338,12 @@ func BreakpointConditionParse(StrVar string) *BreakpointCondition {
 //
 // Example of an `AdwBreakpoint` UI definition:
 //
-// ```xml
-// &lt;object class="AdwBreakpoint"&gt;
-//
-//	&lt;condition&gt;max-width: 400px&lt;/condition&gt;
-//	&lt;setter object="button" property="visible"&gt;True&lt;/setter&gt;
-//	&lt;setter object="box" property="orientation"&gt;vertical&lt;/setter&gt;
-//	&lt;setter object="page" property="title" translatable="yes"&gt;Example&lt;/setter&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwBreakpoint">
+//	  <condition>max-width: 400px</condition>
+//	  <setter object="button" property="visible">True</setter>
+//	  <setter object="box" property="orientation">vertical</setter>
+//	  <setter object="page" property="title" translatable="yes">Example</setter>
+//	</object>
 type Breakpoint struct {
 	gobject.Object
 }
@@ -286,13 +360,13 @@ func BreakpointNewFromInternalPtr(ptr uintptr) *Breakpoint {
 	return cls
 }
 
-var xNewBreakpoint func(*BreakpointCondition) uintptr
+var xNewBreakpoint func(uintptr) uintptr
 
-// Creates a new `AdwBreakpoint` with @condition.
+// Creates a new `AdwBreakpoint` with condition.
 func NewBreakpoint(ConditionVar *BreakpointCondition) *Breakpoint {
 	var cls *Breakpoint
 
-	cret := xNewBreakpoint(ConditionVar)
+	cret := xNewBreakpoint(gobject.BoxedCopy(BreakpointConditionGLibType(), ConditionVar.GoPointer()))
 
 	if cret == 0 {
 		return nil
@@ -304,9 +378,9 @@ func NewBreakpoint(ConditionVar *BreakpointCondition) *Breakpoint {
 
 var xBreakpointAddSetter func(uintptr, uintptr, string, *gobject.Value)
 
-// Adds a setter to @self.
+// Adds a setter to self.
 //
-// The setter will automatically set @property on @object to @value when
+// The setter will automatically set property on object to value when
 // applying the breakpoint, and set it back to its original value upon
 // unapplying it.
 //
@@ -314,39 +388,31 @@ var xBreakpointAddSetter func(uintptr, uintptr, string, *gobject.Value)
 //
 //	Setting properties to their original values does not work for properties
 //	that have irreversible side effects. For example, changing
-//	[property@Gtk.Button:label] while [property@Gtk.Button:icon-name] is set
+//	[gtk.Button.GetPropertyLabel] while [gtk.Button.GetPropertyIconName] is set
 //	will reset the icon. However, resetting the label will not set
 //	`icon-name` to its original value.
 //
-// Use the [signal@Breakpoint::apply] and [signal@Breakpoint::unapply] signals
+// Use the Breakpoint::apply and Breakpoint::unapply signals
 // for those properties instead, as follows:
 //
-// ```c
-// static void
-// breakpoint_apply_cb (MyWidget *self)
-//
+//	static void
+//	breakpoint_apply_cb (MyWidget *self)
 //	{
-//	  gtk_button_set_icon_name (self-&gt;button, "go-previous-symbolic");
+//	  gtk_button_set_icon_name (self->button, "go-previous-symbolic");
 //	}
 //
-// static void
-// breakpoint_apply_cb (MyWidget *self)
-//
+//	static void
+//	breakpoint_apply_cb (MyWidget *self)
 //	{
-//	  gtk_button_set_label (self-&gt;button, _("_Back"));
+//	  gtk_button_set_label (self->button, _("_Back"));
 //	}
 //
-// // ...
-//
-// g_signal_connect_swapped (breakpoint, "apply",
-//
-//	G_CALLBACK (breakpoint_apply_cb), self);
-//
-// g_signal_connect_swapped (breakpoint, "unapply",
+//	// ...
 //
-//	G_CALLBACK (breakpoint_unapply_cb), self);
-//
-// ```
+//	g_signal_connect_swapped (breakpoint, "apply",
+//	                          G_CALLBACK (breakpoint_apply_cb), self);
+//	g_signal_connect_swapped (breakpoint, "unapply",
+//	                          G_CALLBACK (breakpoint_unapply_cb), self);
 func (x *Breakpoint) AddSetter(ObjectVar *gobject.Object, PropertyVar string, ValueVar *gobject.Value) {
 
 	xBreakpointAddSetter(x.GoPointer(), ObjectVar.GoPointer(), PropertyVar, ValueVar)
@@ -355,21 +421,19 @@ func (x *Breakpoint) AddSetter(ObjectVar *gobject.Object, PropertyVar string, Va
 
 var xBreakpointAddSetters func(uintptr, uintptr, string, ...interface{})
 
-// Adds multiple setters to @self.
+// Adds multiple setters to self.
 //
-// See [method@Breakpoint.add_setter].
+// See add_setter.
 //
 // Example:
 //
-// ```c
-// adw_breakpoint_add_setters (breakpoint,
-//
-//	G_OBJECT (box), "orientation", GTK_ORIENTATION_VERTICAL,
-//	G_OBJECT (button), "halign", GTK_ALIGN_FILL,
-//	G_OBJECT (button), "valign", GTK_ALIGN_END,
-//	NULL);
+//	adw_breakpoint_add_setters (breakpoint,
+//	                            G_OBJECT (box), "orientation", GTK_ORIENTATION_VERTICAL,
+//	                            G_OBJECT (button), "halign", GTK_ALIGN_FILL,
+//	                            G_OBJECT (button), "valign", GTK_ALIGN_END,
+//	                            NULL);
 //
-// ```
+// Deprecated: use [AddSettersv], the version of this function that language bindings are meant to use.
 func (x *Breakpoint) AddSetters(FirstObjectVar *gobject.Object, FirstPropertyVar string, varArgs ...interface{}) {
 
 	xBreakpointAddSetters(x.GoPointer(), FirstObjectVar.GoPointer(), FirstPropertyVar, varArgs...)
@@ -378,9 +442,9 @@ func (x *Breakpoint) AddSetters(FirstObjectVar *gobject.Object, FirstPropertyVar
 
 var xBreakpointAddSettersValist func(uintptr, uintptr, string, []interface{})
 
-// Adds multiple setters to @self.
+// Adds multiple setters to self.
 //
-// See [method@Breakpoint.add_setters].
+// See add_setters.
 func (x *Breakpoint) AddSettersValist(FirstObjectVar *gobject.Object, FirstPropertyVar string, ArgsVar []interface{}) {
 
 	xBreakpointAddSettersValist(x.GoPointer(), FirstObjectVar.GoPointer(), FirstPropertyVar, ArgsVar)
@@ -389,11 +453,11 @@ func (x *Breakpoint) AddSettersValist(FirstObjectVar *gobject.Object, FirstPrope
 
 var xBreakpointAddSettersv func(uintptr, int, uintptr, []string, uintptr)
 
-// Adds @n_setters setters to @self.
+// Adds n_setters setters to self.
 //
 // This is a convenience function for adding multiple setters at once.
 //
-// See [method@Breakpoint.add_setter].
+// See add_setter.
 //
 // This function is meant to be used by language bindings.
 func (x *Breakpoint) AddSettersv(NSettersVar int, ObjectsVar uintptr, NamesVar []string, ValuesVar uintptr) {
@@ -404,7 +468,7 @@ func (x *Breakpoint) AddSettersv(NSettersVar int, ObjectsVar uintptr, NamesVar [
 
 var xBreakpointGetCondition func(uintptr) *BreakpointCondition
 
-// Gets the condition for @self.
+// Gets the condition for self.
 func (x *Breakpoint) GetCondition() *BreakpointCondition {
 
 	cret := xBreakpointGetCondition(x.GoPointer())
@@ -413,7 +477,7 @@ func (x *Breakpoint) GetCondition() *BreakpointCondition {
 
 var xBreakpointSetCondition func(uintptr, *BreakpointCondition)
 
-// Sets the condition for @self.
+// Sets the condition for self.
 func (x *Breakpoint) SetCondition(ConditionVar *BreakpointCondition) {
 
 	xBreakpointSetCondition(x.GoPointer(), ConditionVar)
@@ -451,59 +515,103 @@ func (x *Breakpoint) GetPropertyCondition() uintptr {
 // Emitted when the breakpoint is applied.
 //
 // This signal is emitted after the setters have been applied.
+//
+// Deprecated: use ConnectApplyFunc, which also accepts method values and closures.
 func (x *Breakpoint) ConnectApply(cb *func(Breakpoint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Breakpoint{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "apply", cbRefPtr, cbPtr)
+}
+
+var xBreakpointApplyTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xBreakpointApplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Breakpoint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Breakpoint{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplyFunc connects cb to the "apply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Breakpoint) ConnectApplyFunc(cb func(Breakpoint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "apply", &xBreakpointApplyTrampoline, xBreakpointApplyNewTrampoline, cb)
 }
 
 // Emitted when the breakpoint is unapplied.
 //
 // This signal is emitted before resetting the setter values.
+//
+// Deprecated: use ConnectUnapplyFunc, which also accepts method values and closures.
 func (x *Breakpoint) ConnectUnapply(cb *func(Breakpoint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unapply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Breakpoint{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unapply", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xBreakpointUnapplyTrampoline uintptr
+
+func xBreakpointUnapplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Breakpoint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Breakpoint{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unapply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the ID of the @buildable object.
+// ConnectUnapplyFunc connects cb to the "unapply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Breakpoint) ConnectUnapplyFunc(cb func(Breakpoint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unapply", &xBreakpointUnapplyTrampoline, xBreakpointUnapplyNewTrampoline, cb)
+}
+
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Breakpoint) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -515,7 +623,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -526,28 +634,30 @@ func init() {
 
 	core.PuregoSafeRegister(&xBreakpointConditionRatioTypeGLibType, libs, "adw_breakpoint_condition_ratio_type_get_type")
 
-	core.PuregoSafeRegister(&xBreakpointConditionParse, libs, "adw_breakpoint_condition_parse")
+	core.PuregoRegisterSince(&xBreakpointConditionParse, libs, "adw_breakpoint_condition_parse", "ADW", "1.4")
 
 	core.PuregoSafeRegister(&xBreakpointConditionGLibType, libs, "adw_breakpoint_condition_get_type")
 
-	core.PuregoSafeRegister(&xNewBreakpointConditionAnd, libs, "adw_breakpoint_condition_new_and")
-	core.PuregoSafeRegister(&xNewBreakpointConditionLength, libs, "adw_breakpoint_condition_new_length")
-	core.PuregoSafeRegister(&xNewBreakpointConditionOr, libs, "adw_breakpoint_condition_new_or")
-	core.PuregoSafeRegister(&xNewBreakpointConditionRatio, libs, "adw_breakpoint_condition_new_ratio")
+	core.PuregoRegisterSince(&xNewBreakpointConditionAnd, libs, "adw_breakpoint_condition_new_and", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNewBreakpointConditionLength, libs, "adw_breakpoint_condition_new_length", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNewBreakpointConditionOr, libs, "adw_breakpoint_condition_new_or", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNewBreakpointConditionRatio, libs, "adw_breakpoint_condition_new_ratio", "ADW", "1.4")
 
-	core.PuregoSafeRegister(&xBreakpointConditionCopy, libs, "adw_breakpoint_condition_copy")
-	core.PuregoSafeRegister(&xBreakpointConditionFree, libs, "adw_breakpoint_condition_free")
-	core.PuregoSafeRegister(&xBreakpointConditionToString, libs, "adw_breakpoint_condition_to_string")
+	core.PuregoRegisterSince(&xBreakpointConditionCopy, libs, "adw_breakpoint_condition_copy", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointConditionFree, libs, "adw_breakpoint_condition_free", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointConditionToString, libs, "adw_breakpoint_condition_to_string", "ADW", "1.4")
 
 	core.PuregoSafeRegister(&xBreakpointGLibType, libs, "adw_breakpoint_get_type")
 
-	core.PuregoSafeRegister(&xNewBreakpoint, libs, "adw_breakpoint_new")
+	gobject.CheckClassSize("AdwBreakpointClass", xBreakpointGLibType, unsafe.Sizeof(BreakpointClass{}))
+
+	core.PuregoRegisterSince(&xNewBreakpoint, libs, "adw_breakpoint_new", "ADW", "1.4")
 
-	core.PuregoSafeRegister(&xBreakpointAddSetter, libs, "adw_breakpoint_add_setter")
-	core.PuregoSafeRegister(&xBreakpointAddSetters, libs, "adw_breakpoint_add_setters")
-	core.PuregoSafeRegister(&xBreakpointAddSettersValist, libs, "adw_breakpoint_add_setters_valist")
-	core.PuregoSafeRegister(&xBreakpointAddSettersv, libs, "adw_breakpoint_add_settersv")
-	core.PuregoSafeRegister(&xBreakpointGetCondition, libs, "adw_breakpoint_get_condition")
-	core.PuregoSafeRegister(&xBreakpointSetCondition, libs, "adw_breakpoint_set_condition")
+	core.PuregoRegisterSince(&xBreakpointAddSetter, libs, "adw_breakpoint_add_setter", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointAddSetters, libs, "adw_breakpoint_add_setters", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointAddSettersValist, libs, "adw_breakpoint_add_setters_valist", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointAddSettersv, libs, "adw_breakpoint_add_settersv", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointGetCondition, libs, "adw_breakpoint_get_condition", "ADW", "1.4")
+	core.PuregoRegisterSince(&xBreakpointSetCondition, libs, "adw_breakpoint_set_condition", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,46 +23,31 @@ func (x *ButtonContentClass) GoPointer() uintptr {
 
 // A helper widget for creating buttons.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="button-content-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="button-content.png" alt="button-content"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwButtonContent` is a box-like widget with an icon and a label.
 //
-// It's intended to be used as a direct child of [class@Gtk.Button],
-// [class@Gtk.MenuButton] or [class@SplitButton], when they need to have both an
+// It's intended to be used as a direct child of [gtk.Button],
+// [gtk.MenuButton] or SplitButton, when they need to have both an
 // icon and a label, as follows:
 //
-// ```xml
-// &lt;object class="GtkButton"&gt;
-//
-//	&lt;property name="child"&gt;
-//	  &lt;object class="AdwButtonContent"&gt;
-//	    &lt;property name="icon-name"&gt;document-open-symbolic&lt;/property&gt;
-//	    &lt;property name="label" translatable="yes"&gt;_Open&lt;/property&gt;
-//	    &lt;property name="use-underline"&gt;True&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkButton">
+//	  <property name="child">
+//	    <object class="AdwButtonContent">
+//	      <property name="icon-name">document-open-symbolic</property>
+//	      <property name="label" translatable="yes">_Open</property>
+//	      <property name="use-underline">True</property>
+//	    </object>
+//	  </property>
+//	</object>
 //
 // `AdwButtonContent` handles style classes and connecting the mnemonic to the
 // button automatically.
 //
-// ## CSS nodes
+// # CSS nodes
 //
-// ```
-// buttoncontent
-// ╰── box
-//
-//	├── image
-//	╰── label
-//
-// ```
+//	buttoncontent
+//	╰── box
+//	    ├── image
+//	    ╰── label
 //
 // `AdwButtonContent`'s CSS node is called `buttoncontent`. It contains a `box`
 // subnode that serves as a container for the  `image` and `label` nodes.
@@ -72,7 +56,7 @@ func (x *ButtonContentClass) GoPointer() uintptr {
 // `.image-text-button` style class. When inside a `GtkMenuButton`, the
 // internal `GtkButton` will receive it instead.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwButtonContent` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type ButtonContent struct {
@@ -150,7 +134,7 @@ var xButtonContentSetCanShrink func(uintptr, bool)
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [method@Gtk.Button.set_can_shrink].
+// See [gtk.Button.SetCanShrink].
 func (x *ButtonContent) SetCanShrink(CanShrinkVar bool) {
 
 	xButtonContentSetCanShrink(x.GoPointer(), CanShrinkVar)
@@ -183,7 +167,7 @@ var xButtonContentSetUseUnderline func(uintptr, bool)
 //
 // The mnemonic can be used to activate the parent button.
 //
-// See [property@ButtonContent:label].
+// See ButtonContent:label.
 func (x *ButtonContent) SetUseUnderline(UseUnderlineVar bool) {
 
 	xButtonContentSetUseUnderline(x.GoPointer(), UseUnderlineVar)
@@ -206,7 +190,7 @@ func (c *ButtonContent) SetGoPointer(ptr uintptr) {
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink].
 func (x *ButtonContent) SetPropertyCanShrink(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -219,7 +203,7 @@ func (x *ButtonContent) SetPropertyCanShrink(value bool) {
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink].
 func (x *ButtonContent) GetPropertyCanShrink() bool {
 	var v gobject.Value
 	x.GetProperty("can-shrink", &v)
@@ -269,7 +253,7 @@ func (x *ButtonContent) GetPropertyLabel() string {
 //
 // The mnemonic can be used to activate the parent button.
 //
-// See [property@ButtonContent:label].
+// See ButtonContent:label.
 func (x *ButtonContent) SetPropertyUseUnderline(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -282,7 +266,7 @@ func (x *ButtonContent) SetPropertyUseUnderline(value bool) {
 //
 // The mnemonic can be used to activate the parent button.
 //
-// See [property@ButtonContent:label].
+// See ButtonContent:label.
 func (x *ButtonContent) GetPropertyUseUnderline() bool {
 	var v gobject.Value
 	x.GetProperty("use-underline", &v)
@@ -450,7 +434,7 @@ func (x *ButtonContent) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -458,14 +442,13 @@ func (x *ButtonContent) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ButtonContent) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -489,19 +472,17 @@ func (x *ButtonContent) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ButtonContent) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -522,7 +503,7 @@ func (x *ButtonContent) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -531,14 +512,12 @@ func (x *ButtonContent) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ButtonContent) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -557,10 +536,10 @@ func (x *ButtonContent) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ButtonContent) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -572,7 +551,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -581,15 +560,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xButtonContentGLibType, libs, "adw_button_content_get_type")
 
-	core.PuregoSafeRegister(&xNewButtonContent, libs, "adw_button_content_new")
+	gobject.CheckClassSize("AdwButtonContentClass", xButtonContentGLibType, unsafe.Sizeof(ButtonContentClass{}))
+
+	core.PuregoRegisterSince(&xNewButtonContent, libs, "adw_button_content_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xButtonContentGetCanShrink, libs, "adw_button_content_get_can_shrink")
-	core.PuregoSafeRegister(&xButtonContentGetIconName, libs, "adw_button_content_get_icon_name")
-	core.PuregoSafeRegister(&xButtonContentGetLabel, libs, "adw_button_content_get_label")
-	core.PuregoSafeRegister(&xButtonContentGetUseUnderline, libs, "adw_button_content_get_use_underline")
-	core.PuregoSafeRegister(&xButtonContentSetCanShrink, libs, "adw_button_content_set_can_shrink")
-	core.PuregoSafeRegister(&xButtonContentSetIconName, libs, "adw_button_content_set_icon_name")
-	core.PuregoSafeRegister(&xButtonContentSetLabel, libs, "adw_button_content_set_label")
-	core.PuregoSafeRegister(&xButtonContentSetUseUnderline, libs, "adw_button_content_set_use_underline")
+	core.PuregoRegisterSince(&xButtonContentGetCanShrink, libs, "adw_button_content_get_can_shrink", "ADW", "1.4")
+	core.PuregoRegisterSince(&xButtonContentGetIconName, libs, "adw_button_content_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xButtonContentGetLabel, libs, "adw_button_content_get_label", "ADW", "")
+	core.PuregoRegisterSince(&xButtonContentGetUseUnderline, libs, "adw_button_content_get_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xButtonContentSetCanShrink, libs, "adw_button_content_set_can_shrink", "ADW", "1.4")
+	core.PuregoRegisterSince(&xButtonContentSetIconName, libs, "adw_button_content_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xButtonContentSetLabel, libs, "adw_button_content_set_label", "ADW", "")
+	core.PuregoRegisterSince(&xButtonContentSetUseUnderline, libs, "adw_button_content_set_use_underline", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,14 +22,7 @@ func (x *ButtonRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] that looks like a button.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="button-rows-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="button-rows.png" alt="button-rows"&gt;
-//
-// &lt;/picture&gt;
+// A [gtk.ListBoxRow] that looks like a button.
 //
 // The `AdwButtonRow` widget has a title and two icons: before and after the
 // title.
@@ -40,7 +32,7 @@ func (x *ButtonRowClass) GoPointer() uintptr {
 //
 // `AdwButtonRow` is always activatable.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwButtonRow` has a main CSS node with name `row` and the style class
 // `.button`.
@@ -49,30 +41,16 @@ func (x *ButtonRowClass) GoPointer() uintptr {
 // nodes: `image.icon.start` for the start icon, `label.title` for the title,
 // and `image.icon.end` for the end icon.
 //
-// ## Style classes
+// # Style classes
 //
-// The [`.suggested-action`](style-classes.html#suggested-action) style class
+// The .suggested-action style class
 // makes `AdwButtonRow` use accent color for its background. It should be used
 // very sparingly to denote important buttons.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="button-row-suggested-action-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="button-row-suggested-action.png" alt="button-row-suggested-action"&gt;
-//
-// &lt;/picture&gt;
-//
-// The [`.destructive-action`](style-classes.html#destructive-action) style
+// The .destructive-action style
 // makes the row use destructive colors. It can be used to draw attention to the
 // potentially damaging consequences of using it. This style acts as a warning
 // to the user.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="button-row-destructive-action-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="button-row-destructive-action.png" alt="button-row-destructive-action"&gt;
-//
-// &lt;/picture&gt;
 type ButtonRow struct {
 	PreferencesRow
 }
@@ -108,7 +86,7 @@ func NewButtonRow() *ButtonRow {
 
 var xButtonRowGetEndIconName func(uintptr) string
 
-// Gets the end icon name for @self.
+// Gets the end icon name for self.
 func (x *ButtonRow) GetEndIconName() string {
 
 	cret := xButtonRowGetEndIconName(x.GoPointer())
@@ -117,7 +95,7 @@ func (x *ButtonRow) GetEndIconName() string {
 
 var xButtonRowGetStartIconName func(uintptr) string
 
-// Gets the start icon name for @self.
+// Gets the start icon name for self.
 func (x *ButtonRow) GetStartIconName() string {
 
 	cret := xButtonRowGetStartIconName(x.GoPointer())
@@ -126,7 +104,7 @@ func (x *ButtonRow) GetStartIconName() string {
 
 var xButtonRowSetEndIconName func(uintptr, uintptr)
 
-// Sets the end icon name for @self.
+// Sets the end icon name for self.
 func (x *ButtonRow) SetEndIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -138,7 +116,7 @@ func (x *ButtonRow) SetEndIconName(IconNameVar *string) {
 
 var xButtonRowSetStartIconName func(uintptr, uintptr)
 
-// Sets the start icon name for @self.
+// Sets the start icon name for self.
 func (x *ButtonRow) SetStartIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -194,27 +172,49 @@ func (x *ButtonRow) GetPropertyStartIconName() string {
 }
 
 // This signal is emitted after the row has been activated.
+//
+// Deprecated: use ConnectActivatedFunc, which also accepts method values and closures.
 func (x *ButtonRow) ConnectActivated(cb *func(ButtonRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ButtonRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activated", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xButtonRowActivatedTrampoline uintptr
+
+func xButtonRowActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ButtonRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ButtonRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivatedFunc connects cb to the "activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ButtonRow) ConnectActivatedFunc(cb func(ButtonRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activated", &xButtonRowActivatedTrampoline, xButtonRowActivatedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -378,7 +378,7 @@ func (x *ButtonRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -386,14 +386,13 @@ func (x *ButtonRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ButtonRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -417,19 +416,17 @@ func (x *ButtonRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ButtonRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -450,7 +447,7 @@ func (x *ButtonRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -459,14 +456,12 @@ func (x *ButtonRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ButtonRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -485,14 +480,14 @@ func (x *ButtonRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ButtonRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ButtonRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -502,15 +497,15 @@ func (x *ButtonRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ButtonRow) SetActionName(ActionNameVar *string) {
 
@@ -523,13 +518,13 @@ func (x *ButtonRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *ButtonRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -538,14 +533,14 @@ func (x *ButtonRow) SetActionTarget(FormatStringVar string, varArgs ...interface
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -563,18 +558,18 @@ func (x *ButtonRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ButtonRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ButtonRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -586,7 +581,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -595,11 +590,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xButtonRowGLibType, libs, "adw_button_row_get_type")
 
-	core.PuregoSafeRegister(&xNewButtonRow, libs, "adw_button_row_new")
+	gobject.CheckClassSize("AdwButtonRowClass", xButtonRowGLibType, unsafe.Sizeof(ButtonRowClass{}))
+
+	core.PuregoRegisterSince(&xNewButtonRow, libs, "adw_button_row_new", "ADW", "1.6")
 
-	core.PuregoSafeRegister(&xButtonRowGetEndIconName, libs, "adw_button_row_get_end_icon_name")
-	core.PuregoSafeRegister(&xButtonRowGetStartIconName, libs, "adw_button_row_get_start_icon_name")
-	core.PuregoSafeRegister(&xButtonRowSetEndIconName, libs, "adw_button_row_set_end_icon_name")
-	core.PuregoSafeRegister(&xButtonRowSetStartIconName, libs, "adw_button_row_set_start_icon_name")
+	core.PuregoRegisterSince(&xButtonRowGetEndIconName, libs, "adw_button_row_get_end_icon_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xButtonRowGetStartIconName, libs, "adw_button_row_get_start_icon_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xButtonRowSetEndIconName, libs, "adw_button_row_set_end_icon_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xButtonRowSetStartIconName, libs, "adw_button_row_set_start_icon_name", "ADW", "1.6")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,23 +21,16 @@ func (x *CarouselIndicatorDotsClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A dots indicator for [class@Carousel].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="carousel-indicator-dots-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="carousel-indicator-dots.png" alt="carousel-indicator-dots"&gt;
-//
-// &lt;/picture&gt;
+// A dots indicator for Carousel.
 //
 // The `AdwCarouselIndicatorDots` widget shows a set of dots for each page of a
-// given [class@Carousel]. The dot representing the carousel's active page is
+// given Carousel. The dot representing the carousel's active page is
 // larger and more opaque than the others, the transition to the active and
 // inactive state is gradual to match the carousel's position.
 //
-// See also [class@CarouselIndicatorLines].
+// See also CarouselIndicatorLines.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwCarouselIndicatorDots` has a single CSS node with name
 // `carouselindicatordots`.
@@ -273,7 +265,7 @@ func (x *CarouselIndicatorDots) UpdatePlatformState(StateVar gtk.AccessiblePlatf
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -281,14 +273,13 @@ func (x *CarouselIndicatorDots) UpdatePlatformState(StateVar gtk.AccessiblePlatf
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorDots) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -312,19 +303,17 @@ func (x *CarouselIndicatorDots) UpdatePropertyValue(NPropertiesVar int, Properti
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorDots) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -345,7 +334,7 @@ func (x *CarouselIndicatorDots) UpdateRelationValue(NRelationsVar int, Relations
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -354,14 +343,12 @@ func (x *CarouselIndicatorDots) UpdateRelationValue(NRelationsVar int, Relations
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorDots) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -380,24 +367,24 @@ func (x *CarouselIndicatorDots) UpdateStateValue(NStatesVar int, StatesVar []gtk
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *CarouselIndicatorDots) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *CarouselIndicatorDots) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *CarouselIndicatorDots) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -409,7 +396,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -418,9 +405,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xCarouselIndicatorDotsGLibType, libs, "adw_carousel_indicator_dots_get_type")
 
-	core.PuregoSafeRegister(&xNewCarouselIndicatorDots, libs, "adw_carousel_indicator_dots_new")
+	gobject.CheckClassSize("AdwCarouselIndicatorDotsClass", xCarouselIndicatorDotsGLibType, unsafe.Sizeof(CarouselIndicatorDotsClass{}))
+
+	core.PuregoRegisterSince(&xNewCarouselIndicatorDots, libs, "adw_carousel_indicator_dots_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xCarouselIndicatorDotsGetCarousel, libs, "adw_carousel_indicator_dots_get_carousel")
-	core.PuregoSafeRegister(&xCarouselIndicatorDotsSetCarousel, libs, "adw_carousel_indicator_dots_set_carousel")
+	core.PuregoRegisterSince(&xCarouselIndicatorDotsGetCarousel, libs, "adw_carousel_indicator_dots_get_carousel", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselIndicatorDotsSetCarousel, libs, "adw_carousel_indicator_dots_set_carousel", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,22 +21,15 @@ func (x *CarouselIndicatorLinesClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A lines indicator for [class@Carousel].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="carousel-indicator-lines-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="carousel-indicator-lines.png" alt="carousel-indicator-lines"&gt;
-//
-// &lt;/picture&gt;
+// A lines indicator for Carousel.
 //
 // The `AdwCarouselIndicatorLines` widget shows a set of lines for each page of
-// a given [class@Carousel]. The carousel's active page is shown as another line
+// a given Carousel. The carousel's active page is shown as another line
 // that moves between them to match the carousel's position.
 //
-// See also [class@CarouselIndicatorDots].
+// See also CarouselIndicatorDots.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwCarouselIndicatorLines` has a single CSS node with name
 // `carouselindicatorlines`.
@@ -272,7 +264,7 @@ func (x *CarouselIndicatorLines) UpdatePlatformState(StateVar gtk.AccessiblePlat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -280,14 +272,13 @@ func (x *CarouselIndicatorLines) UpdatePlatformState(StateVar gtk.AccessiblePlat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorLines) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -311,19 +302,17 @@ func (x *CarouselIndicatorLines) UpdatePropertyValue(NPropertiesVar int, Propert
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorLines) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -344,7 +333,7 @@ func (x *CarouselIndicatorLines) UpdateRelationValue(NRelationsVar int, Relation
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -353,14 +342,12 @@ func (x *CarouselIndicatorLines) UpdateRelationValue(NRelationsVar int, Relation
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *CarouselIndicatorLines) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -379,24 +366,24 @@ func (x *CarouselIndicatorLines) UpdateStateValue(NStatesVar int, StatesVar []gt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *CarouselIndicatorLines) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *CarouselIndicatorLines) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *CarouselIndicatorLines) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -408,7 +395,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -417,9 +404,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xCarouselIndicatorLinesGLibType, libs, "adw_carousel_indicator_lines_get_type")
 
-	core.PuregoSafeRegister(&xNewCarouselIndicatorLines, libs, "adw_carousel_indicator_lines_new")
+	gobject.CheckClassSize("AdwCarouselIndicatorLinesClass", xCarouselIndicatorLinesGLibType, unsafe.Sizeof(CarouselIndicatorLinesClass{}))
+
+	core.PuregoRegisterSince(&xNewCarouselIndicatorLines, libs, "adw_carousel_indicator_lines_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xCarouselIndicatorLinesGetCarousel, libs, "adw_carousel_indicator_lines_get_carousel")
-	core.PuregoSafeRegister(&xCarouselIndicatorLinesSetCarousel, libs, "adw_carousel_indicator_lines_set_carousel")
+	core.PuregoRegisterSince(&xCarouselIndicatorLinesGetCarousel, libs, "adw_carousel_indicator_lines_get_carousel", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselIndicatorLinesSetCarousel, libs, "adw_carousel_indicator_lines_set_carousel", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -26,20 +25,13 @@ func (x *CarouselClass) GoPointer() uintptr {
 
 // A paginated scrolling widget.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="carousel-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="carousel.png" alt="carousel"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwCarousel` widget can be used to display a set of pages with
 // swipe-based navigation between them.
 //
-// [class@CarouselIndicatorDots] and [class@CarouselIndicatorLines] can be used
+// CarouselIndicatorDots and CarouselIndicatorLines can be used
 // to provide page indicators for `AdwCarousel`.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwCarousel` has a single CSS node with name `carousel`.
 type Carousel struct {
@@ -77,7 +69,7 @@ func NewCarousel() *Carousel {
 
 var xCarouselAppend func(uintptr, uintptr)
 
-// Appends @child to @self.
+// Appends child to self.
 func (x *Carousel) Append(ChildVar *gtk.Widget) {
 
 	xCarouselAppend(x.GoPointer(), ChildVar.GoPointer())
@@ -95,7 +87,7 @@ func (x *Carousel) GetAllowLongSwipes() bool {
 
 var xCarouselGetAllowMouseDrag func(uintptr) bool
 
-// Sets whether @self can be dragged with mouse pointer.
+// Sets whether self can be dragged with mouse pointer.
 func (x *Carousel) GetAllowMouseDrag() bool {
 
 	cret := xCarouselGetAllowMouseDrag(x.GoPointer())
@@ -104,7 +96,7 @@ func (x *Carousel) GetAllowMouseDrag() bool {
 
 var xCarouselGetAllowScrollWheel func(uintptr) bool
 
-// Gets whether @self will respond to scroll wheel events.
+// Gets whether self will respond to scroll wheel events.
 func (x *Carousel) GetAllowScrollWheel() bool {
 
 	cret := xCarouselGetAllowScrollWheel(x.GoPointer())
@@ -113,7 +105,7 @@ func (x *Carousel) GetAllowScrollWheel() bool {
 
 var xCarouselGetInteractive func(uintptr) bool
 
-// Gets whether @self can be navigated.
+// Gets whether self can be navigated.
 func (x *Carousel) GetInteractive() bool {
 
 	cret := xCarouselGetInteractive(x.GoPointer())
@@ -122,7 +114,7 @@ func (x *Carousel) GetInteractive() bool {
 
 var xCarouselGetNPages func(uintptr) uint
 
-// Gets the number of pages in @self.
+// Gets the number of pages in self.
 func (x *Carousel) GetNPages() uint {
 
 	cret := xCarouselGetNPages(x.GoPointer())
@@ -131,7 +123,7 @@ func (x *Carousel) GetNPages() uint {
 
 var xCarouselGetNthPage func(uintptr, uint) uintptr
 
-// Gets the page at position @n.
+// Gets the page at position n.
 func (x *Carousel) GetNthPage(NVar uint) *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -148,9 +140,9 @@ func (x *Carousel) GetNthPage(NVar uint) *gtk.Widget {
 
 var xCarouselGetPosition func(uintptr) float64
 
-// Gets current scroll position in @self, unitless.
+// Gets current scroll position in self, unitless.
 //
-// 1 matches 1 page. Use [method@Carousel.scroll_to] for changing it.
+// 1 matches 1 page. Use scroll_to for changing it.
 func (x *Carousel) GetPosition() float64 {
 
 	cret := xCarouselGetPosition(x.GoPointer())
@@ -168,7 +160,7 @@ func (x *Carousel) GetRevealDuration() uint {
 
 var xCarouselGetScrollParams func(uintptr) *SpringParams
 
-// Gets the scroll animation spring parameters for @self.
+// Gets the scroll animation spring parameters for self.
 func (x *Carousel) GetScrollParams() *SpringParams {
 
 	cret := xCarouselGetScrollParams(x.GoPointer())
@@ -186,10 +178,10 @@ func (x *Carousel) GetSpacing() uint {
 
 var xCarouselInsert func(uintptr, uintptr, int)
 
-// Inserts @child into @self at position @position.
+// Inserts child into self at position position.
 //
 // If position is -1, or larger than the number of pages,
-// @child will be appended to the end.
+// child will be appended to the end.
 func (x *Carousel) Insert(ChildVar *gtk.Widget, PositionVar int) {
 
 	xCarouselInsert(x.GoPointer(), ChildVar.GoPointer(), PositionVar)
@@ -198,7 +190,7 @@ func (x *Carousel) Insert(ChildVar *gtk.Widget, PositionVar int) {
 
 var xCarouselPrepend func(uintptr, uintptr)
 
-// Prepends @child to @self.
+// Prepends child to self.
 func (x *Carousel) Prepend(ChildVar *gtk.Widget) {
 
 	xCarouselPrepend(x.GoPointer(), ChildVar.GoPointer())
@@ -207,7 +199,7 @@ func (x *Carousel) Prepend(ChildVar *gtk.Widget) {
 
 var xCarouselRemove func(uintptr, uintptr)
 
-// Removes @child from @self.
+// Removes child from self.
 func (x *Carousel) Remove(ChildVar *gtk.Widget) {
 
 	xCarouselRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -216,9 +208,9 @@ func (x *Carousel) Remove(ChildVar *gtk.Widget) {
 
 var xCarouselReorder func(uintptr, uintptr, int)
 
-// Moves @child into position @position.
+// Moves child into position position.
 //
-// If position is -1, or larger than the number of pages, @child will be moved
+// If position is -1, or larger than the number of pages, child will be moved
 // at the end.
 func (x *Carousel) Reorder(ChildVar *gtk.Widget, PositionVar int) {
 
@@ -228,9 +220,9 @@ func (x *Carousel) Reorder(ChildVar *gtk.Widget, PositionVar int) {
 
 var xCarouselScrollTo func(uintptr, uintptr, bool)
 
-// Scrolls to @widget.
+// Scrolls to widget.
 //
-// If @animate is `TRUE`, the transition will be animated.
+// If animate is `TRUE`, the transition will be animated.
 func (x *Carousel) ScrollTo(WidgetVar *gtk.Widget, AnimateVar bool) {
 
 	xCarouselScrollTo(x.GoPointer(), WidgetVar.GoPointer(), AnimateVar)
@@ -241,7 +233,7 @@ var xCarouselSetAllowLongSwipes func(uintptr, bool)
 
 // Sets whether to allow swiping for more than one page at a time.
 //
-// If @allow_long_swipes is `FALSE`, each swipe can only move to the adjacent
+// If allow_long_swipes is `FALSE`, each swipe can only move to the adjacent
 // pages.
 func (x *Carousel) SetAllowLongSwipes(AllowLongSwipesVar bool) {
 
@@ -251,9 +243,9 @@ func (x *Carousel) SetAllowLongSwipes(AllowLongSwipesVar bool) {
 
 var xCarouselSetAllowMouseDrag func(uintptr, bool)
 
-// Sets whether @self can be dragged with mouse pointer.
+// Sets whether self can be dragged with mouse pointer.
 //
-// If @allow_mouse_drag is `FALSE`, dragging is only available on touch.
+// If allow_mouse_drag is `FALSE`, dragging is only available on touch.
 func (x *Carousel) SetAllowMouseDrag(AllowMouseDragVar bool) {
 
 	xCarouselSetAllowMouseDrag(x.GoPointer(), AllowMouseDragVar)
@@ -262,9 +254,9 @@ func (x *Carousel) SetAllowMouseDrag(AllowMouseDragVar bool) {
 
 var xCarouselSetAllowScrollWheel func(uintptr, bool)
 
-// Sets whether @self will respond to scroll wheel events.
+// Sets whether self will respond to scroll wheel events.
 //
-// If @allow_scroll_wheel is `FALSE`, wheel events will be ignored.
+// If allow_scroll_wheel is `FALSE`, wheel events will be ignored.
 func (x *Carousel) SetAllowScrollWheel(AllowScrollWheelVar bool) {
 
 	xCarouselSetAllowScrollWheel(x.GoPointer(), AllowScrollWheelVar)
@@ -273,7 +265,7 @@ func (x *Carousel) SetAllowScrollWheel(AllowScrollWheelVar bool) {
 
 var xCarouselSetInteractive func(uintptr, bool)
 
-// Sets whether @self can be navigated.
+// Sets whether self can be navigated.
 //
 // This can be used to temporarily disable the carousel to only allow navigating
 // it in a certain state.
@@ -296,13 +288,11 @@ func (x *Carousel) SetRevealDuration(RevealDurationVar uint) {
 
 var xCarouselSetScrollParams func(uintptr, *SpringParams)
 
-// Sets the scroll animation spring parameters for @self.
+// Sets the scroll animation spring parameters for self.
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
 func (x *Carousel) SetScrollParams(ParamsVar *SpringParams) {
 
 	xCarouselSetScrollParams(x.GoPointer(), ParamsVar)
@@ -426,7 +416,7 @@ func (x *Carousel) GetPropertyNPages() uint {
 // GetPropertyPosition gets the "position" property.
 // Current scrolling position, unitless.
 //
-// 1 matches 1 page. Use [method@Carousel.scroll_to] for changing it.
+// 1 matches 1 page. Use scroll_to for changing it.
 func (x *Carousel) GetPropertyPosition() float64 {
 	var v gobject.Value
 	x.GetProperty("position", &v)
@@ -459,9 +449,7 @@ func (x *Carousel) GetPropertyRevealDuration() uint {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
 func (x *Carousel) SetPropertyScrollParams(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -474,9 +462,7 @@ func (x *Carousel) SetPropertyScrollParams(value uintptr) {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
 func (x *Carousel) GetPropertyScrollParams() uintptr {
 	var v gobject.Value
 	x.GetProperty("scroll-params", &v)
@@ -508,37 +494,59 @@ func (x *Carousel) GetPropertySpacing() uint {
 // ::: note
 //
 //	An empty carousel is indicated by `(int)index == -1`.
+//
+// Deprecated: use ConnectPageChangedFunc, which also accepts method values and closures.
 func (x *Carousel) ConnectPageChanged(cb *func(Carousel, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, IndexVarp uint) {
+			defer core.RecoverPanic()
+			fa := Carousel{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, IndexVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-changed", cbRefPtr, cbPtr)
+}
+
+var xCarouselPageChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, IndexVarp uint) {
+func xCarouselPageChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, IndexVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Carousel, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Carousel{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, IndexVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// ConnectPageChangedFunc connects cb to the "page-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Carousel) ConnectPageChangedFunc(cb func(Carousel, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-changed", &xCarouselPageChangedTrampoline, xCarouselPageChangedNewTrampoline, cb)
+}
+
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *Carousel) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *Carousel) GetDistance() float64 {
@@ -547,14 +555,14 @@ func (x *Carousel) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *Carousel) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -564,7 +572,7 @@ func (x *Carousel) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -572,7 +580,7 @@ func (x *Carousel) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *Carousel) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -740,7 +748,7 @@ func (x *Carousel) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -748,14 +756,13 @@ func (x *Carousel) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Carousel) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -779,19 +786,17 @@ func (x *Carousel) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Carousel) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -812,7 +817,7 @@ func (x *Carousel) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -821,14 +826,12 @@ func (x *Carousel) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Carousel) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -847,24 +850,24 @@ func (x *Carousel) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Carousel) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Carousel) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Carousel) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -876,7 +879,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -885,30 +888,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xCarouselGLibType, libs, "adw_carousel_get_type")
 
-	core.PuregoSafeRegister(&xNewCarousel, libs, "adw_carousel_new")
-
-	core.PuregoSafeRegister(&xCarouselAppend, libs, "adw_carousel_append")
-	core.PuregoSafeRegister(&xCarouselGetAllowLongSwipes, libs, "adw_carousel_get_allow_long_swipes")
-	core.PuregoSafeRegister(&xCarouselGetAllowMouseDrag, libs, "adw_carousel_get_allow_mouse_drag")
-	core.PuregoSafeRegister(&xCarouselGetAllowScrollWheel, libs, "adw_carousel_get_allow_scroll_wheel")
-	core.PuregoSafeRegister(&xCarouselGetInteractive, libs, "adw_carousel_get_interactive")
-	core.PuregoSafeRegister(&xCarouselGetNPages, libs, "adw_carousel_get_n_pages")
-	core.PuregoSafeRegister(&xCarouselGetNthPage, libs, "adw_carousel_get_nth_page")
-	core.PuregoSafeRegister(&xCarouselGetPosition, libs, "adw_carousel_get_position")
-	core.PuregoSafeRegister(&xCarouselGetRevealDuration, libs, "adw_carousel_get_reveal_duration")
-	core.PuregoSafeRegister(&xCarouselGetScrollParams, libs, "adw_carousel_get_scroll_params")
-	core.PuregoSafeRegister(&xCarouselGetSpacing, libs, "adw_carousel_get_spacing")
-	core.PuregoSafeRegister(&xCarouselInsert, libs, "adw_carousel_insert")
-	core.PuregoSafeRegister(&xCarouselPrepend, libs, "adw_carousel_prepend")
-	core.PuregoSafeRegister(&xCarouselRemove, libs, "adw_carousel_remove")
-	core.PuregoSafeRegister(&xCarouselReorder, libs, "adw_carousel_reorder")
-	core.PuregoSafeRegister(&xCarouselScrollTo, libs, "adw_carousel_scroll_to")
-	core.PuregoSafeRegister(&xCarouselSetAllowLongSwipes, libs, "adw_carousel_set_allow_long_swipes")
-	core.PuregoSafeRegister(&xCarouselSetAllowMouseDrag, libs, "adw_carousel_set_allow_mouse_drag")
-	core.PuregoSafeRegister(&xCarouselSetAllowScrollWheel, libs, "adw_carousel_set_allow_scroll_wheel")
-	core.PuregoSafeRegister(&xCarouselSetInteractive, libs, "adw_carousel_set_interactive")
-	core.PuregoSafeRegister(&xCarouselSetRevealDuration, libs, "adw_carousel_set_reveal_duration")
-	core.PuregoSafeRegister(&xCarouselSetScrollParams, libs, "adw_carousel_set_scroll_params")
-	core.PuregoSafeRegister(&xCarouselSetSpacing, libs, "adw_carousel_set_spacing")
+	gobject.CheckClassSize("AdwCarouselClass", xCarouselGLibType, unsafe.Sizeof(CarouselClass{}))
+
+	core.PuregoRegisterSince(&xNewCarousel, libs, "adw_carousel_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xCarouselAppend, libs, "adw_carousel_append", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetAllowLongSwipes, libs, "adw_carousel_get_allow_long_swipes", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetAllowMouseDrag, libs, "adw_carousel_get_allow_mouse_drag", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetAllowScrollWheel, libs, "adw_carousel_get_allow_scroll_wheel", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetInteractive, libs, "adw_carousel_get_interactive", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetNPages, libs, "adw_carousel_get_n_pages", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetNthPage, libs, "adw_carousel_get_nth_page", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetPosition, libs, "adw_carousel_get_position", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetRevealDuration, libs, "adw_carousel_get_reveal_duration", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetScrollParams, libs, "adw_carousel_get_scroll_params", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselGetSpacing, libs, "adw_carousel_get_spacing", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselInsert, libs, "adw_carousel_insert", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselPrepend, libs, "adw_carousel_prepend", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselRemove, libs, "adw_carousel_remove", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselReorder, libs, "adw_carousel_reorder", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselScrollTo, libs, "adw_carousel_scroll_to", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetAllowLongSwipes, libs, "adw_carousel_set_allow_long_swipes", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetAllowMouseDrag, libs, "adw_carousel_set_allow_mouse_drag", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetAllowScrollWheel, libs, "adw_carousel_set_allow_scroll_wheel", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetInteractive, libs, "adw_carousel_set_interactive", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetRevealDuration, libs, "adw_carousel_set_reveal_duration", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetScrollParams, libs, "adw_carousel_set_scroll_params", "ADW", "")
+	core.PuregoRegisterSince(&xCarouselSetSpacing, libs, "adw_carousel_set_spacing", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,19 +23,6 @@ func (x *ClampLayoutClass) GoPointer() uintptr {
 
 // A layout manager constraining its children to a given size.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="clamp-wide-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="clamp-wide.png" alt="clamp-wide"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="clamp-narrow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="clamp-narrow.png" alt="clamp-narrow"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwClampLayout` constraints the size of the widgets it contains to a given
 // maximum size. It will constrain the width if it is horizontal, or the height
 // if it is vertical. The expansion of the children from their minimum to their
@@ -46,9 +32,9 @@ func (x *ClampLayoutClass) GoPointer() uintptr {
 // allocated the minimum size it can fit in instead.
 //
 // `AdwClampLayout` can scale with the text scale factor, use the
-// [property@ClampLayout:unit] property to enable that behavior.
+// ClampLayout:unit property to enable that behavior.
 //
-// See also: [class@Clamp], [class@ClampScrollable].
+// See also: Clamp, ClampScrollable.
 type ClampLayout struct {
 	gtk.LayoutManager
 }
@@ -229,14 +215,14 @@ func (x *ClampLayout) GetPropertyTighteningThreshold() int {
 	return v.GetInt()
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ClampLayout) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ClampLayout) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -248,7 +234,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -257,13 +243,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xClampLayoutGLibType, libs, "adw_clamp_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewClampLayout, libs, "adw_clamp_layout_new")
+	gobject.CheckClassSize("AdwClampLayoutClass", xClampLayoutGLibType, unsafe.Sizeof(ClampLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewClampLayout, libs, "adw_clamp_layout_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xClampLayoutGetMaximumSize, libs, "adw_clamp_layout_get_maximum_size")
-	core.PuregoSafeRegister(&xClampLayoutGetTighteningThreshold, libs, "adw_clamp_layout_get_tightening_threshold")
-	core.PuregoSafeRegister(&xClampLayoutGetUnit, libs, "adw_clamp_layout_get_unit")
-	core.PuregoSafeRegister(&xClampLayoutSetMaximumSize, libs, "adw_clamp_layout_set_maximum_size")
-	core.PuregoSafeRegister(&xClampLayoutSetTighteningThreshold, libs, "adw_clamp_layout_set_tightening_threshold")
-	core.PuregoSafeRegister(&xClampLayoutSetUnit, libs, "adw_clamp_layout_set_unit")
+	core.PuregoRegisterSince(&xClampLayoutGetMaximumSize, libs, "adw_clamp_layout_get_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampLayoutGetTighteningThreshold, libs, "adw_clamp_layout_get_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampLayoutGetUnit, libs, "adw_clamp_layout_get_unit", "ADW", "1.4")
+	core.PuregoRegisterSince(&xClampLayoutSetMaximumSize, libs, "adw_clamp_layout_set_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampLayoutSetTighteningThreshold, libs, "adw_clamp_layout_set_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampLayoutSetUnit, libs, "adw_clamp_layout_set_unit", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,15 +21,15 @@ func (x *ClampScrollableClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A scrollable [class@Clamp].
+// A scrollable Clamp.
 //
-// `AdwClampScrollable` is a variant of [class@Clamp] that implements the
-// [iface@Gtk.Scrollable] interface.
+// `AdwClampScrollable` is a variant of Clamp that implements the
+// [gtk.Scrollable] interface.
 //
 // The primary use case for `AdwClampScrollable` is clamping
-// [class@Gtk.ListView].
+// [gtk.ListView].
 //
-// See also: [class@ClampLayout].
+// See also: ClampLayout.
 type ClampScrollable struct {
 	gtk.Widget
 }
@@ -66,7 +65,7 @@ func NewClampScrollable() *ClampScrollable {
 
 var xClampScrollableGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *ClampScrollable) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -110,7 +109,7 @@ func (x *ClampScrollable) GetUnit() LengthUnit {
 
 var xClampScrollableSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *ClampScrollable) SetChild(ChildVar *gtk.Widget) {
 
 	xClampScrollableSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -397,7 +396,7 @@ func (x *ClampScrollable) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -405,14 +404,13 @@ func (x *ClampScrollable) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ClampScrollable) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -436,19 +434,17 @@ func (x *ClampScrollable) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ClampScrollable) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -469,7 +465,7 @@ func (x *ClampScrollable) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -478,14 +474,12 @@ func (x *ClampScrollable) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ClampScrollable) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -504,24 +498,24 @@ func (x *ClampScrollable) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ClampScrollable) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ClampScrollable) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ClampScrollable) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -623,7 +617,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -632,15 +626,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xClampScrollableGLibType, libs, "adw_clamp_scrollable_get_type")
 
-	core.PuregoSafeRegister(&xNewClampScrollable, libs, "adw_clamp_scrollable_new")
+	gobject.CheckClassSize("AdwClampScrollableClass", xClampScrollableGLibType, unsafe.Sizeof(ClampScrollableClass{}))
+
+	core.PuregoRegisterSince(&xNewClampScrollable, libs, "adw_clamp_scrollable_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xClampScrollableGetChild, libs, "adw_clamp_scrollable_get_child")
-	core.PuregoSafeRegister(&xClampScrollableGetMaximumSize, libs, "adw_clamp_scrollable_get_maximum_size")
-	core.PuregoSafeRegister(&xClampScrollableGetTighteningThreshold, libs, "adw_clamp_scrollable_get_tightening_threshold")
-	core.PuregoSafeRegister(&xClampScrollableGetUnit, libs, "adw_clamp_scrollable_get_unit")
-	core.PuregoSafeRegister(&xClampScrollableSetChild, libs, "adw_clamp_scrollable_set_child")
-	core.PuregoSafeRegister(&xClampScrollableSetMaximumSize, libs, "adw_clamp_scrollable_set_maximum_size")
-	core.PuregoSafeRegister(&xClampScrollableSetTighteningThreshold, libs, "adw_clamp_scrollable_set_tightening_threshold")
-	core.PuregoSafeRegister(&xClampScrollableSetUnit, libs, "adw_clamp_scrollable_set_unit")
+	core.PuregoRegisterSince(&xClampScrollableGetChild, libs, "adw_clamp_scrollable_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableGetMaximumSize, libs, "adw_clamp_scrollable_get_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableGetTighteningThreshold, libs, "adw_clamp_scrollable_get_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableGetUnit, libs, "adw_clamp_scrollable_get_unit", "ADW", "1.4")
+	core.PuregoRegisterSince(&xClampScrollableSetChild, libs, "adw_clamp_scrollable_set_child", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableSetMaximumSize, libs, "adw_clamp_scrollable_set_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableSetTighteningThreshold, libs, "adw_clamp_scrollable_set_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampScrollableSetUnit, libs, "adw_clamp_scrollable_set_unit", "ADW", "1.4")
 
 }
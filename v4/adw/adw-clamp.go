@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,19 +23,6 @@ func (x *ClampClass) GoPointer() uintptr {
 
 // A widget constraining its child to a given size.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="clamp-wide-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="clamp-wide.png" alt="clamp-wide"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="clamp-narrow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="clamp-narrow.png" alt="clamp-narrow"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwClamp` widget constrains the size of the widget it contains to a
 // given maximum size. It will constrain the width if it is horizontal, or the
 // height if it is vertical. The expansion of the child from its minimum to its
@@ -46,11 +32,11 @@ func (x *ClampClass) GoPointer() uintptr {
 // allocated the minimum size it can fit in instead.
 //
 // `AdwClamp` can scale with the text scale factor, use the
-// [property@Clamp:unit] property to enable that behavior.
+// Clamp:unit property to enable that behavior.
 //
-// See also: [class@ClampLayout], [class@ClampScrollable].
+// See also: ClampLayout, ClampScrollable.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwClamp` has a single CSS node with name `clamp`.
 type Clamp struct {
@@ -88,7 +74,7 @@ func NewClamp() *Clamp {
 
 var xClampGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *Clamp) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -132,7 +118,7 @@ func (x *Clamp) GetUnit() LengthUnit {
 
 var xClampSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *Clamp) SetChild(ChildVar *gtk.Widget) {
 
 	xClampSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -419,7 +405,7 @@ func (x *Clamp) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -427,14 +413,13 @@ func (x *Clamp) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Clamp) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -458,19 +443,17 @@ func (x *Clamp) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Clamp) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -491,7 +474,7 @@ func (x *Clamp) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -500,14 +483,12 @@ func (x *Clamp) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Clamp) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -526,24 +507,24 @@ func (x *Clamp) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Clamp) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Clamp) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Clamp) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -555,7 +536,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -564,15 +545,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xClampGLibType, libs, "adw_clamp_get_type")
 
-	core.PuregoSafeRegister(&xNewClamp, libs, "adw_clamp_new")
+	gobject.CheckClassSize("AdwClampClass", xClampGLibType, unsafe.Sizeof(ClampClass{}))
+
+	core.PuregoRegisterSince(&xNewClamp, libs, "adw_clamp_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xClampGetChild, libs, "adw_clamp_get_child")
-	core.PuregoSafeRegister(&xClampGetMaximumSize, libs, "adw_clamp_get_maximum_size")
-	core.PuregoSafeRegister(&xClampGetTighteningThreshold, libs, "adw_clamp_get_tightening_threshold")
-	core.PuregoSafeRegister(&xClampGetUnit, libs, "adw_clamp_get_unit")
-	core.PuregoSafeRegister(&xClampSetChild, libs, "adw_clamp_set_child")
-	core.PuregoSafeRegister(&xClampSetMaximumSize, libs, "adw_clamp_set_maximum_size")
-	core.PuregoSafeRegister(&xClampSetTighteningThreshold, libs, "adw_clamp_set_tightening_threshold")
-	core.PuregoSafeRegister(&xClampSetUnit, libs, "adw_clamp_set_unit")
+	core.PuregoRegisterSince(&xClampGetChild, libs, "adw_clamp_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xClampGetMaximumSize, libs, "adw_clamp_get_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampGetTighteningThreshold, libs, "adw_clamp_get_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampGetUnit, libs, "adw_clamp_get_unit", "ADW", "1.4")
+	core.PuregoRegisterSince(&xClampSetChild, libs, "adw_clamp_set_child", "ADW", "")
+	core.PuregoRegisterSince(&xClampSetMaximumSize, libs, "adw_clamp_set_maximum_size", "ADW", "")
+	core.PuregoRegisterSince(&xClampSetTighteningThreshold, libs, "adw_clamp_set_tightening_threshold", "ADW", "")
+	core.PuregoRegisterSince(&xClampSetUnit, libs, "adw_clamp_set_unit", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -26,55 +25,45 @@ func (x *ComboRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] used to choose from a list of items.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="combo-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="combo-row.png" alt="combo-row"&gt;
-//
-// &lt;/picture&gt;
+// A [gtk.ListBoxRow] used to choose from a list of items.
 //
 // The `AdwComboRow` widget allows the user to choose from a list of valid
 // choices. The row displays the selected choice. When activated, the row
 // displays a popover which allows the user to make a new choice.
 //
 // Example of an `AdwComboRow` UI definition:
-// ```xml
-// &lt;object class="AdwComboRow"&gt;
-//
-//	&lt;property name="title" translatable="yes"&gt;Combo Row&lt;/property&gt;
-//	&lt;property name="model"&gt;
-//	  &lt;object class="GtkStringList"&gt;
-//	    &lt;items&gt;
-//	      &lt;item translatable="yes"&gt;Foo&lt;/item&gt;
-//	      &lt;item translatable="yes"&gt;Bar&lt;/item&gt;
-//	      &lt;item translatable="yes"&gt;Baz&lt;/item&gt;
-//	    &lt;/items&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// The [property@ComboRow:selected] and [property@ComboRow:selected-item]
+//
+//	<object class="AdwComboRow">
+//	  <property name="title" translatable="yes">Combo Row</property>
+//	  <property name="model">
+//	    <object class="GtkStringList">
+//	      <items>
+//	        <item translatable="yes">Foo</item>
+//	        <item translatable="yes">Bar</item>
+//	        <item translatable="yes">Baz</item>
+//	      </items>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// The ComboRow:selected and ComboRow:selected-item
 // properties can be used to keep track of the selected item and react to their
 // changes.
 //
-// `AdwComboRow` mirrors [class@Gtk.DropDown], see that widget for details.
+// `AdwComboRow` mirrors [gtk.DropDown], see that widget for details.
 //
-// `AdwComboRow` is [property@Gtk.ListBoxRow:activatable] if a model is set.
+// `AdwComboRow` is [gtk.ListBoxRow.GetPropertyActivatable] if a model is set.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwComboRow` has a main CSS node with name `row` and the `.combo` style
 // class.
 //
 // Its popover has the node named `popover` with the `.menu` style class, it
-// contains a [class@Gtk.ScrolledWindow], which in turn contains a
-// [class@Gtk.ListView], both are accessible via their regular nodes.
+// contains a [gtk.ScrolledWindow], which in turn contains a
+// [gtk.ListView], both are accessible via their regular nodes.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwComboRow` uses the `GTK_ACCESSIBLE_ROLE_COMBO_BOX` role.
 type ComboRow struct {
@@ -117,7 +106,7 @@ var xComboRowGetEnableSearch func(uintptr) bool
 // If set to `TRUE`, a search entry will be shown in the popup that
 // allows to search for items in the list.
 //
-// Search requires [property@ComboRow:expression] to be set.
+// Search requires ComboRow:expression to be set.
 func (x *ComboRow) GetEnableSearch() bool {
 
 	cret := xComboRowGetEnableSearch(x.GoPointer())
@@ -260,7 +249,7 @@ var xComboRowSetEnableSearch func(uintptr, bool)
 // If set to `TRUE`, a search entry will be shown in the popup that
 // allows to search for items in the list.
 //
-// Search requires [property@ComboRow:expression] to be set.
+// Search requires ComboRow:expression to be set.
 func (x *ComboRow) SetEnableSearch(EnableSearchVar bool) {
 
 	xComboRowSetEnableSearch(x.GoPointer(), EnableSearchVar)
@@ -274,8 +263,8 @@ var xComboRowSetExpression func(uintptr, uintptr)
 // The expression must have a value type of `G_TYPE_STRING`.
 //
 // It's used to bind strings to labels produced by the default factory if
-// [property@ComboRow:factory] is not set, or when
-// [property@ComboRow:use-subtitle] is set to `TRUE`.
+// ComboRow:factory is not set, or when
+// ComboRow:use-subtitle is set to `TRUE`.
 func (x *ComboRow) SetExpression(ExpressionVar *gtk.Expression) {
 
 	xComboRowSetExpression(x.GoPointer(), ExpressionVar.GoPointer())
@@ -287,7 +276,7 @@ var xComboRowSetFactory func(uintptr, uintptr)
 // Sets the factory for populating list items.
 //
 // This factory is always used for the item in the row. It is also used for
-// items in the popup unless [property@ComboRow:list-factory] is set.
+// items in the popup unless ComboRow:list-factory is set.
 func (x *ComboRow) SetFactory(FactoryVar *gtk.ListItemFactory) {
 
 	xComboRowSetFactory(x.GoPointer(), FactoryVar.GoPointer())
@@ -307,7 +296,7 @@ var xComboRowSetListFactory func(uintptr, uintptr)
 
 // Sets the factory for populating list items in the popup.
 //
-// If this is not set, [property@ComboRow:factory] is used.
+// If this is not set, ComboRow:factory is used.
 func (x *ComboRow) SetListFactory(FactoryVar *gtk.ListItemFactory) {
 
 	xComboRowSetListFactory(x.GoPointer(), FactoryVar.GoPointer())
@@ -346,12 +335,12 @@ var xComboRowSetUseSubtitle func(uintptr, bool)
 // Sets whether to use the current value as the subtitle.
 //
 // If you use a custom list item factory, you will need to give the row a
-// name conversion expression with [property@ComboRow:expression].
+// name conversion expression with ComboRow:expression.
 //
-// If set to `TRUE`, you should not access [property@ActionRow:subtitle].
+// If set to `TRUE`, you should not access ActionRow:subtitle.
 //
 // The subtitle is interpreted as Pango markup if
-// [property@PreferencesRow:use-markup] is set to `TRUE`.
+// PreferencesRow:use-markup is set to `TRUE`.
 func (x *ComboRow) SetUseSubtitle(UseSubtitleVar bool) {
 
 	xComboRowSetUseSubtitle(x.GoPointer(), UseSubtitleVar)
@@ -375,7 +364,7 @@ func (c *ComboRow) SetGoPointer(ptr uintptr) {
 // If set to `TRUE`, a search entry will be shown in the popup that
 // allows to search for items in the list.
 //
-// Search requires [property@ComboRow:expression] to be set.
+// Search requires ComboRow:expression to be set.
 func (x *ComboRow) SetPropertyEnableSearch(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -389,7 +378,7 @@ func (x *ComboRow) SetPropertyEnableSearch(value bool) {
 // If set to `TRUE`, a search entry will be shown in the popup that
 // allows to search for items in the list.
 //
-// Search requires [property@ComboRow:expression] to be set.
+// Search requires ComboRow:expression to be set.
 func (x *ComboRow) GetPropertyEnableSearch() bool {
 	var v gobject.Value
 	x.GetProperty("enable-search", &v)
@@ -400,7 +389,7 @@ func (x *ComboRow) GetPropertyEnableSearch() bool {
 // The position of the selected item.
 //
 // If no item is selected, the property has the value
-// [const@Gtk.INVALID_LIST_POSITION]
+// [gtk.INVALID_LIST_POSITION]
 func (x *ComboRow) SetPropertySelected(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -412,7 +401,7 @@ func (x *ComboRow) SetPropertySelected(value uint) {
 // The position of the selected item.
 //
 // If no item is selected, the property has the value
-// [const@Gtk.INVALID_LIST_POSITION]
+// [gtk.INVALID_LIST_POSITION]
 func (x *ComboRow) GetPropertySelected() uint {
 	var v gobject.Value
 	x.GetProperty("selected", &v)
@@ -423,12 +412,12 @@ func (x *ComboRow) GetPropertySelected() uint {
 // Whether to use the current value as the subtitle.
 //
 // If you use a custom list item factory, you will need to give the row a
-// name conversion expression with [property@ComboRow:expression].
+// name conversion expression with ComboRow:expression.
 //
-// If set to `TRUE`, you should not access [property@ActionRow:subtitle].
+// If set to `TRUE`, you should not access ActionRow:subtitle.
 //
 // The subtitle is interpreted as Pango markup if
-// [property@PreferencesRow:use-markup] is set to `TRUE`.
+// PreferencesRow:use-markup is set to `TRUE`.
 func (x *ComboRow) SetPropertyUseSubtitle(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -440,12 +429,12 @@ func (x *ComboRow) SetPropertyUseSubtitle(value bool) {
 // Whether to use the current value as the subtitle.
 //
 // If you use a custom list item factory, you will need to give the row a
-// name conversion expression with [property@ComboRow:expression].
+// name conversion expression with ComboRow:expression.
 //
-// If set to `TRUE`, you should not access [property@ActionRow:subtitle].
+// If set to `TRUE`, you should not access ActionRow:subtitle.
 //
 // The subtitle is interpreted as Pango markup if
-// [property@PreferencesRow:use-markup] is set to `TRUE`.
+// PreferencesRow:use-markup is set to `TRUE`.
 func (x *ComboRow) GetPropertyUseSubtitle() bool {
 	var v gobject.Value
 	x.GetProperty("use-subtitle", &v)
@@ -613,7 +602,7 @@ func (x *ComboRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -621,14 +610,13 @@ func (x *ComboRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ComboRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -652,19 +640,17 @@ func (x *ComboRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ComboRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -685,7 +671,7 @@ func (x *ComboRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -694,14 +680,12 @@ func (x *ComboRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ComboRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -720,14 +704,14 @@ func (x *ComboRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSt
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ComboRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ComboRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -737,15 +721,15 @@ func (x *ComboRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ComboRow) SetActionName(ActionNameVar *string) {
 
@@ -758,13 +742,13 @@ func (x *ComboRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *ComboRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -773,14 +757,14 @@ func (x *ComboRow) SetActionTarget(FormatStringVar string, varArgs ...interface{
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -798,18 +782,18 @@ func (x *ComboRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ComboRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ComboRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -821,7 +805,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -830,26 +814,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xComboRowGLibType, libs, "adw_combo_row_get_type")
 
-	core.PuregoSafeRegister(&xNewComboRow, libs, "adw_combo_row_new")
-
-	core.PuregoSafeRegister(&xComboRowGetEnableSearch, libs, "adw_combo_row_get_enable_search")
-	core.PuregoSafeRegister(&xComboRowGetExpression, libs, "adw_combo_row_get_expression")
-	core.PuregoSafeRegister(&xComboRowGetFactory, libs, "adw_combo_row_get_factory")
-	core.PuregoSafeRegister(&xComboRowGetHeaderFactory, libs, "adw_combo_row_get_header_factory")
-	core.PuregoSafeRegister(&xComboRowGetListFactory, libs, "adw_combo_row_get_list_factory")
-	core.PuregoSafeRegister(&xComboRowGetModel, libs, "adw_combo_row_get_model")
-	core.PuregoSafeRegister(&xComboRowGetSearchMatchMode, libs, "adw_combo_row_get_search_match_mode")
-	core.PuregoSafeRegister(&xComboRowGetSelected, libs, "adw_combo_row_get_selected")
-	core.PuregoSafeRegister(&xComboRowGetSelectedItem, libs, "adw_combo_row_get_selected_item")
-	core.PuregoSafeRegister(&xComboRowGetUseSubtitle, libs, "adw_combo_row_get_use_subtitle")
-	core.PuregoSafeRegister(&xComboRowSetEnableSearch, libs, "adw_combo_row_set_enable_search")
-	core.PuregoSafeRegister(&xComboRowSetExpression, libs, "adw_combo_row_set_expression")
-	core.PuregoSafeRegister(&xComboRowSetFactory, libs, "adw_combo_row_set_factory")
-	core.PuregoSafeRegister(&xComboRowSetHeaderFactory, libs, "adw_combo_row_set_header_factory")
-	core.PuregoSafeRegister(&xComboRowSetListFactory, libs, "adw_combo_row_set_list_factory")
-	core.PuregoSafeRegister(&xComboRowSetModel, libs, "adw_combo_row_set_model")
-	core.PuregoSafeRegister(&xComboRowSetSearchMatchMode, libs, "adw_combo_row_set_search_match_mode")
-	core.PuregoSafeRegister(&xComboRowSetSelected, libs, "adw_combo_row_set_selected")
-	core.PuregoSafeRegister(&xComboRowSetUseSubtitle, libs, "adw_combo_row_set_use_subtitle")
+	gobject.CheckClassSize("AdwComboRowClass", xComboRowGLibType, unsafe.Sizeof(ComboRowClass{}))
+
+	core.PuregoRegisterSince(&xNewComboRow, libs, "adw_combo_row_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xComboRowGetEnableSearch, libs, "adw_combo_row_get_enable_search", "ADW", "1.4")
+	core.PuregoRegisterSince(&xComboRowGetExpression, libs, "adw_combo_row_get_expression", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetFactory, libs, "adw_combo_row_get_factory", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetHeaderFactory, libs, "adw_combo_row_get_header_factory", "ADW", "1.6")
+	core.PuregoRegisterSince(&xComboRowGetListFactory, libs, "adw_combo_row_get_list_factory", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetModel, libs, "adw_combo_row_get_model", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetSearchMatchMode, libs, "adw_combo_row_get_search_match_mode", "ADW", "1.6")
+	core.PuregoRegisterSince(&xComboRowGetSelected, libs, "adw_combo_row_get_selected", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetSelectedItem, libs, "adw_combo_row_get_selected_item", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowGetUseSubtitle, libs, "adw_combo_row_get_use_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetEnableSearch, libs, "adw_combo_row_set_enable_search", "ADW", "1.4")
+	core.PuregoRegisterSince(&xComboRowSetExpression, libs, "adw_combo_row_set_expression", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetFactory, libs, "adw_combo_row_set_factory", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetHeaderFactory, libs, "adw_combo_row_set_header_factory", "ADW", "1.6")
+	core.PuregoRegisterSince(&xComboRowSetListFactory, libs, "adw_combo_row_set_list_factory", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetModel, libs, "adw_combo_row_set_model", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetSearchMatchMode, libs, "adw_combo_row_set_search_match_mode", "ADW", "1.6")
+	core.PuregoRegisterSince(&xComboRowSetSelected, libs, "adw_combo_row_set_selected", "ADW", "")
+	core.PuregoRegisterSince(&xComboRowSetUseSubtitle, libs, "adw_combo_row_set_use_subtitle", "ADW", "")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -34,7 +34,8 @@ func (x *DialogClass) OverrideCloseAttempt(cb func(*Dialog)) {
 	if cb == nil {
 		x.xCloseAttempt = 0
 	} else {
-		x.xCloseAttempt = purego.NewCallback(func(DialogVarp uintptr) {
+		x.xCloseAttempt = core.NewCallback(func(DialogVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(DialogNewFromInternalPtr(DialogVarp))
 		})
 	}
@@ -46,7 +47,7 @@ func (x *DialogClass) GetCloseAttempt() func(*Dialog) {
 		return nil
 	}
 	var rawCallback func(DialogVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCloseAttempt)
+	core.RegisterFunc(&rawCallback, x.xCloseAttempt)
 	return func(DialogVar *Dialog) {
 		rawCallback(DialogVar.GoPointer())
 	}
@@ -57,7 +58,8 @@ func (x *DialogClass) OverrideClosed(cb func(*Dialog)) {
 	if cb == nil {
 		x.xClosed = 0
 	} else {
-		x.xClosed = purego.NewCallback(func(DialogVarp uintptr) {
+		x.xClosed = core.NewCallback(func(DialogVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(DialogNewFromInternalPtr(DialogVarp))
 		})
 	}
@@ -69,17 +71,17 @@ func (x *DialogClass) GetClosed() func(*Dialog) {
 		return nil
 	}
 	var rawCallback func(DialogVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClosed)
+	core.RegisterFunc(&rawCallback, x.xClosed)
 	return func(DialogVar *Dialog) {
 		rawCallback(DialogVar.GoPointer())
 	}
 }
 
-// Describes the available presentation modes for [class@Dialog].
+// Describes the available presentation modes for Dialog.
 //
 // New values may be added to this enumeration over time.
 //
-// See [property@Dialog:presentation-mode].
+// See Dialog:presentation-mode.
 type DialogPresentationMode int
 
 var xDialogPresentationModeGLibType func() types.GType
@@ -99,67 +101,93 @@ const (
 	DialogBottomSheetValue DialogPresentationMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DialogPresentationMode) String() string {
+	switch x {
+	case DialogAutoValue:
+		return "ADW_DIALOG_AUTO"
+	case DialogFloatingValue:
+		return "ADW_DIALOG_FLOATING"
+	case DialogBottomSheetValue:
+		return "ADW_DIALOG_BOTTOM_SHEET"
+	}
+	return fmt.Sprintf("DialogPresentationMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DialogPresentationMode) Nick() string {
+	switch x {
+	case DialogAutoValue:
+		return "auto"
+	case DialogFloatingValue:
+		return "floating"
+	case DialogBottomSheetValue:
+		return "bottom-sheet"
+	}
+	return ""
+}
+
+// DialogPresentationModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DialogPresentationModeFromString(s string) (DialogPresentationMode, bool) {
+	switch s {
+	case "ADW_DIALOG_AUTO", "auto":
+		return DialogAutoValue, true
+	case "ADW_DIALOG_FLOATING", "floating":
+		return DialogFloatingValue, true
+	case "ADW_DIALOG_BOTTOM_SHEET", "bottom-sheet":
+		return DialogBottomSheetValue, true
+	}
+	return 0, false
+}
+
 // An adaptive dialog container.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="dialog-floating-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="dialog-floating.png" alt="dialog-floating"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="dialog-bottom-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="dialog-bottom.png" alt="dialog-bottom"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwDialog` is similar to a window, but is shown within another window. It
-// can be used with [class@Window] and [class@ApplicationWindow], use
-// [method@Dialog.present] to show it.
+// can be used with Window and ApplicationWindow, use
+// present to show it.
 //
-// `AdwDialog` is not resizable. Use the [property@Dialog:content-width] and
-// [property@Dialog:content-height] properties to set its size, or set
-// [property@Dialog:follows-content-size] to `TRUE` to make the dialog track the
+// `AdwDialog` is not resizable. Use the Dialog:content-width and
+// Dialog:content-height properties to set its size, or set
+// Dialog:follows-content-size to `TRUE` to make the dialog track the
 // content's size as it changes. `AdwDialog` can never be larger than its parent
 // window.
 //
 // `AdwDialog` can be presented as a centered floating window or a bottom sheet.
 // By default it's automatic depending on the available size.
-// [property@Dialog:presentation-mode] can be used to change that.
+// Dialog:presentation-mode can be used to change that.
 //
-// `AdwDialog` can be closed via [method@Dialog.close].
+// `AdwDialog` can be closed via close.
 //
 // When presented as a bottom sheet, `AdwDialog` can also be closed via swiping
 // it down.
 //
-// The [property@Dialog:can-close] can be used to prevent closing. In that case,
-// [signal@Dialog::close-attempt] gets emitted instead.
+// The Dialog:can-close can be used to prevent closing. In that case,
+// Dialog::close-attempt gets emitted instead.
 //
-// Use [method@Dialog.force_close] to close the dialog even when `can-close` is set to
+// Use force_close to close the dialog even when `can-close` is set to
 // `FALSE`.
 //
 // `AdwDialog` is transient and doesn't integrate with the window below it, for
 // example it's not possible to collapse it into a bottom bar. See
-// [class@BottomSheet] for persistent and more tightly integrated bottom sheets.
+// BottomSheet for persistent and more tightly integrated bottom sheets.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When placed inside an `AdwDialog`, [class@HeaderBar] will display the dialog
+// When placed inside an `AdwDialog`, HeaderBar will display the dialog
 // title instead of window title. It will also adjust the decoration layout to
 // ensure it always has a close button and nothing else. Set
-// [property@HeaderBar:show-start-title-buttons] and
-// [property@HeaderBar:show-end-title-buttons] to `FALSE` to remove it if it's
+// HeaderBar:show-start-title-buttons and
+// HeaderBar:show-end-title-buttons to `FALSE` to remove it if it's
 // unwanted.
 //
-// ## Breakpoints
+// # Breakpoints
 //
-// `AdwDialog` can be used with [class@Breakpoint] the same way as
-// [class@BreakpointBin]. Refer to that widget's documentation for details.
+// `AdwDialog` can be used with Breakpoint the same way as
+// BreakpointBin. Refer to that widget's documentation for details.
 //
 // Like `AdwBreakpointBin`, if breakpoints are used, `AdwDialog` doesn't have a
-// minimum size, and [property@Gtk.Widget:width-request] and
-// [property@Gtk.Widget:height-request] properties must be set manually.
+// minimum size, and [gtk.Widget.GetPropertyWidthRequest] and
+// [gtk.Widget.GetPropertyHeightRequest] properties must be set manually.
 type Dialog struct {
 	gtk.Widget
 }
@@ -195,7 +223,7 @@ func NewDialog() *Dialog {
 
 var xDialogAddBreakpoint func(uintptr, uintptr)
 
-// Adds @breakpoint to @self.
+// Adds breakpoint to self.
 func (x *Dialog) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 	xDialogAddBreakpoint(x.GoPointer(), BreakpointVar.GoPointer())
@@ -204,12 +232,12 @@ func (x *Dialog) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 var xDialogClose func(uintptr) bool
 
-// Attempts to close @self.
+// Attempts to close self.
 //
-// If the [property@Dialog:can-close] property is set to `FALSE`, the
-// [signal@Dialog::close-attempt] signal is emitted.
+// If the Dialog:can-close property is set to `FALSE`, the
+// Dialog::close-attempt signal is emitted.
 //
-// See also: [method@Dialog.force_close].
+// See also: force_close.
 func (x *Dialog) Close() bool {
 
 	cret := xDialogClose(x.GoPointer())
@@ -218,9 +246,9 @@ func (x *Dialog) Close() bool {
 
 var xDialogForceClose func(uintptr)
 
-// Closes @self.
+// Closes self.
 //
-// Unlike [method@Dialog.close], it succeeds even if [property@Dialog:can-close]
+// Unlike close, it succeeds even if Dialog:can-close
 // is set to `FALSE`.
 func (x *Dialog) ForceClose() {
 
@@ -230,7 +258,7 @@ func (x *Dialog) ForceClose() {
 
 var xDialogGetCanClose func(uintptr) bool
 
-// Gets whether @self can be closed.
+// Gets whether self can be closed.
 func (x *Dialog) GetCanClose() bool {
 
 	cret := xDialogGetCanClose(x.GoPointer())
@@ -239,7 +267,7 @@ func (x *Dialog) GetCanClose() bool {
 
 var xDialogGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *Dialog) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -291,7 +319,7 @@ func (x *Dialog) GetCurrentBreakpoint() *Breakpoint {
 
 var xDialogGetDefaultWidget func(uintptr) uintptr
 
-// Gets the default widget for @self.
+// Gets the default widget for self.
 func (x *Dialog) GetDefaultWidget() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -308,7 +336,7 @@ func (x *Dialog) GetDefaultWidget() *gtk.Widget {
 
 var xDialogGetFocus func(uintptr) uintptr
 
-// Gets the focus widget for @self.
+// Gets the focus widget for self.
 func (x *Dialog) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -325,7 +353,7 @@ func (x *Dialog) GetFocus() *gtk.Widget {
 
 var xDialogGetFollowsContentSize func(uintptr) bool
 
-// Gets whether to size content of @self automatically.
+// Gets whether to size content of self automatically.
 func (x *Dialog) GetFollowsContentSize() bool {
 
 	cret := xDialogGetFollowsContentSize(x.GoPointer())
@@ -334,7 +362,7 @@ func (x *Dialog) GetFollowsContentSize() bool {
 
 var xDialogGetPresentationMode func(uintptr) DialogPresentationMode
 
-// Gets presentation mode for @self.
+// Gets presentation mode for self.
 func (x *Dialog) GetPresentationMode() DialogPresentationMode {
 
 	cret := xDialogGetPresentationMode(x.GoPointer())
@@ -343,7 +371,7 @@ func (x *Dialog) GetPresentationMode() DialogPresentationMode {
 
 var xDialogGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *Dialog) GetTitle() string {
 
 	cret := xDialogGetTitle(x.GoPointer())
@@ -352,11 +380,11 @@ func (x *Dialog) GetTitle() string {
 
 var xDialogPresent func(uintptr, uintptr)
 
-// Presents @self within @parent's window.
+// Presents self within parent's window.
 //
-// If @self is already shown, raises it to the top instead.
+// If self is already shown, raises it to the top instead.
 //
-// If the window is an [class@Window] or [class@ApplicationWindow], the dialog
+// If the window is an Window or ApplicationWindow, the dialog
 // will be shown within it. Otherwise, it will be a separate window.
 func (x *Dialog) Present(ParentVar *gtk.Widget) {
 
@@ -366,12 +394,12 @@ func (x *Dialog) Present(ParentVar *gtk.Widget) {
 
 var xDialogSetCanClose func(uintptr, bool)
 
-// Sets whether @self can be closed.
+// Sets whether self can be closed.
 //
 // If set to `FALSE`, the close button, shortcuts and
-// [method@Dialog.close] will result in [signal@Dialog::close-attempt] being
+// close will result in Dialog::close-attempt being
 // emitted instead, and bottom sheet close swipe will be disabled.
-// [method@Dialog.force_close] still works.
+// force_close still works.
 func (x *Dialog) SetCanClose(CanCloseVar bool) {
 
 	xDialogSetCanClose(x.GoPointer(), CanCloseVar)
@@ -380,7 +408,7 @@ func (x *Dialog) SetCanClose(CanCloseVar bool) {
 
 var xDialogSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *Dialog) SetChild(ChildVar *gtk.Widget) {
 
 	xDialogSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -393,7 +421,7 @@ var xDialogSetContentHeight func(uintptr, int)
 //
 // Set it to -1 to reset it to the content's natural height.
 //
-// See also: [property@Gtk.Window:default-height]
+// See also: [gtk.Window.GetPropertyDefaultHeight]
 func (x *Dialog) SetContentHeight(ContentHeightVar int) {
 
 	xDialogSetContentHeight(x.GoPointer(), ContentHeightVar)
@@ -406,7 +434,7 @@ var xDialogSetContentWidth func(uintptr, int)
 //
 // Set it to -1 to reset it to the content's natural width.
 //
-// See also: [property@Gtk.Window:default-width]
+// See also: [gtk.Window.GetPropertyDefaultWidth]
 func (x *Dialog) SetContentWidth(ContentWidthVar int) {
 
 	xDialogSetContentWidth(x.GoPointer(), ContentWidthVar)
@@ -415,7 +443,7 @@ func (x *Dialog) SetContentWidth(ContentWidthVar int) {
 
 var xDialogSetDefaultWidget func(uintptr, uintptr)
 
-// Sets the default widget for @self.
+// Sets the default widget for self.
 //
 // It's activated when the user presses Enter.
 func (x *Dialog) SetDefaultWidget(DefaultWidgetVar *gtk.Widget) {
@@ -426,14 +454,14 @@ func (x *Dialog) SetDefaultWidget(DefaultWidgetVar *gtk.Widget) {
 
 var xDialogSetFocus func(uintptr, uintptr)
 
-// Sets the focus widget for @self.
+// Sets the focus widget for self.
 //
-// If @focus is not the current focus widget, and is focusable, sets it as the
+// If focus is not the current focus widget, and is focusable, sets it as the
 // focus widget for the dialog.
 //
 // If focus is `NULL`, unsets the focus widget for this dialog. To set the focus
 // to a particular widget in the dialog, it is usually more convenient to use
-// [method@Gtk.Widget.grab_focus] instead of this function.
+// [gtk.Widget.GrabFocus] instead of this function.
 func (x *Dialog) SetFocus(FocusVar *gtk.Widget) {
 
 	xDialogSetFocus(x.GoPointer(), FocusVar.GoPointer())
@@ -442,13 +470,13 @@ func (x *Dialog) SetFocus(FocusVar *gtk.Widget) {
 
 var xDialogSetFollowsContentSize func(uintptr, bool)
 
-// Sets whether to size content of @self automatically.
+// Sets whether to size content of self automatically.
 //
 // If set to `TRUE`, always use the content's natural size instead of
-// [property@Dialog:content-width] and [property@Dialog:content-height]. If
+// Dialog:content-width and Dialog:content-height. If
 // the content resizes, the dialog will immediately resize as well.
 //
-// See also: [property@Gtk.Window:resizable]
+// See also: [gtk.Window.GetPropertyResizable]
 func (x *Dialog) SetFollowsContentSize(FollowsContentSizeVar bool) {
 
 	xDialogSetFollowsContentSize(x.GoPointer(), FollowsContentSizeVar)
@@ -457,7 +485,7 @@ func (x *Dialog) SetFollowsContentSize(FollowsContentSizeVar bool) {
 
 var xDialogSetPresentationMode func(uintptr, DialogPresentationMode)
 
-// Sets presentation mode for @self.
+// Sets presentation mode for self.
 //
 // When set to `ADW_DIALOG_AUTO`, the dialog appears as a bottom sheet when the
 // following condition is met: `max-width: 450px or max-height: 360px`, and as a
@@ -476,7 +504,7 @@ func (x *Dialog) SetPresentationMode(PresentationModeVar DialogPresentationMode)
 
 var xDialogSetTitle func(uintptr, string)
 
-// Sets the title of @self.
+// Sets the title of self.
 func (x *Dialog) SetTitle(TitleVar string) {
 
 	xDialogSetTitle(x.GoPointer(), TitleVar)
@@ -498,9 +526,9 @@ func (c *Dialog) SetGoPointer(ptr uintptr) {
 // Whether the dialog can be closed.
 //
 // If set to `FALSE`, the close button, shortcuts and
-// [method@Dialog.close] will result in [signal@Dialog::close-attempt] being
+// close will result in Dialog::close-attempt being
 // emitted instead, and bottom sheet close swipe will be disabled.
-// [method@Dialog.force_close] still works.
+// force_close still works.
 func (x *Dialog) SetPropertyCanClose(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -512,9 +540,9 @@ func (x *Dialog) SetPropertyCanClose(value bool) {
 // Whether the dialog can be closed.
 //
 // If set to `FALSE`, the close button, shortcuts and
-// [method@Dialog.close] will result in [signal@Dialog::close-attempt] being
+// close will result in Dialog::close-attempt being
 // emitted instead, and bottom sheet close swipe will be disabled.
-// [method@Dialog.force_close] still works.
+// force_close still works.
 func (x *Dialog) GetPropertyCanClose() bool {
 	var v gobject.Value
 	x.GetProperty("can-close", &v)
@@ -526,7 +554,7 @@ func (x *Dialog) GetPropertyCanClose() bool {
 //
 // Set it to -1 to reset it to the content's natural height.
 //
-// See also: [property@Gtk.Window:default-height]
+// See also: [gtk.Window.GetPropertyDefaultHeight]
 func (x *Dialog) SetPropertyContentHeight(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -539,7 +567,7 @@ func (x *Dialog) SetPropertyContentHeight(value int) {
 //
 // Set it to -1 to reset it to the content's natural height.
 //
-// See also: [property@Gtk.Window:default-height]
+// See also: [gtk.Window.GetPropertyDefaultHeight]
 func (x *Dialog) GetPropertyContentHeight() int {
 	var v gobject.Value
 	x.GetProperty("content-height", &v)
@@ -551,7 +579,7 @@ func (x *Dialog) GetPropertyContentHeight() int {
 //
 // Set it to -1 to reset it to the content's natural width.
 //
-// See also: [property@Gtk.Window:default-width]
+// See also: [gtk.Window.GetPropertyDefaultWidth]
 func (x *Dialog) SetPropertyContentWidth(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -564,7 +592,7 @@ func (x *Dialog) SetPropertyContentWidth(value int) {
 //
 // Set it to -1 to reset it to the content's natural width.
 //
-// See also: [property@Gtk.Window:default-width]
+// See also: [gtk.Window.GetPropertyDefaultWidth]
 func (x *Dialog) GetPropertyContentWidth() int {
 	var v gobject.Value
 	x.GetProperty("content-width", &v)
@@ -575,10 +603,10 @@ func (x *Dialog) GetPropertyContentWidth() int {
 // Whether to size content automatically.
 //
 // If set to `TRUE`, always use the content's natural size instead of
-// [property@Dialog:content-width] and [property@Dialog:content-height]. If
+// Dialog:content-width and Dialog:content-height. If
 // the content resizes, the dialog will immediately resize as well.
 //
-// See also: [property@Gtk.Window:resizable]
+// See also: [gtk.Window.GetPropertyResizable]
 func (x *Dialog) SetPropertyFollowsContentSize(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -590,10 +618,10 @@ func (x *Dialog) SetPropertyFollowsContentSize(value bool) {
 // Whether to size content automatically.
 //
 // If set to `TRUE`, always use the content's natural size instead of
-// [property@Dialog:content-width] and [property@Dialog:content-height]. If
+// Dialog:content-width and Dialog:content-height. If
 // the content resizes, the dialog will immediately resize as well.
 //
-// See also: [property@Gtk.Window:resizable]
+// See also: [gtk.Window.GetPropertyResizable]
 func (x *Dialog) GetPropertyFollowsContentSize() bool {
 	var v gobject.Value
 	x.GetProperty("follows-content-size", &v)
@@ -618,53 +646,97 @@ func (x *Dialog) GetPropertyTitle() string {
 }
 
 // Emitted when the close button or shortcut is used, or
-// [method@Dialog.close] is called while [property@Dialog:can-close] is set to
+// close is called while Dialog:can-close is set to
 // `FALSE`.
+//
+// Deprecated: use ConnectCloseAttemptFunc, which also accepts method values and closures.
 func (x *Dialog) ConnectCloseAttempt(cb *func(Dialog)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close-attempt", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Dialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close-attempt", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDialogCloseAttemptTrampoline uintptr
+
+func xDialogCloseAttemptNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Dialog))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Dialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close-attempt", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCloseAttemptFunc connects cb to the "close-attempt" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Dialog) ConnectCloseAttemptFunc(cb func(Dialog)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close-attempt", &xDialogCloseAttemptTrampoline, xDialogCloseAttemptNewTrampoline, cb)
 }
 
 // Emitted when the dialog is successfully closed.
+//
+// Deprecated: use ConnectClosedFunc, which also accepts method values and closures.
 func (x *Dialog) ConnectClosed(cb *func(Dialog)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Dialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "closed", cbRefPtr, cbPtr)
+}
+
+var xDialogClosedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xDialogClosedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Dialog))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Dialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClosedFunc connects cb to the "closed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Dialog) ConnectClosedFunc(cb func(Dialog)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "closed", &xDialogClosedTrampoline, xDialogClosedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -828,7 +900,7 @@ func (x *Dialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -836,14 +908,13 @@ func (x *Dialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -867,19 +938,17 @@ func (x *Dialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -900,7 +969,7 @@ func (x *Dialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -909,14 +978,12 @@ func (x *Dialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -935,10 +1002,10 @@ func (x *Dialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Dialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -950,7 +1017,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -961,30 +1028,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xDialogGLibType, libs, "adw_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewDialog, libs, "adw_dialog_new")
-
-	core.PuregoSafeRegister(&xDialogAddBreakpoint, libs, "adw_dialog_add_breakpoint")
-	core.PuregoSafeRegister(&xDialogClose, libs, "adw_dialog_close")
-	core.PuregoSafeRegister(&xDialogForceClose, libs, "adw_dialog_force_close")
-	core.PuregoSafeRegister(&xDialogGetCanClose, libs, "adw_dialog_get_can_close")
-	core.PuregoSafeRegister(&xDialogGetChild, libs, "adw_dialog_get_child")
-	core.PuregoSafeRegister(&xDialogGetContentHeight, libs, "adw_dialog_get_content_height")
-	core.PuregoSafeRegister(&xDialogGetContentWidth, libs, "adw_dialog_get_content_width")
-	core.PuregoSafeRegister(&xDialogGetCurrentBreakpoint, libs, "adw_dialog_get_current_breakpoint")
-	core.PuregoSafeRegister(&xDialogGetDefaultWidget, libs, "adw_dialog_get_default_widget")
-	core.PuregoSafeRegister(&xDialogGetFocus, libs, "adw_dialog_get_focus")
-	core.PuregoSafeRegister(&xDialogGetFollowsContentSize, libs, "adw_dialog_get_follows_content_size")
-	core.PuregoSafeRegister(&xDialogGetPresentationMode, libs, "adw_dialog_get_presentation_mode")
-	core.PuregoSafeRegister(&xDialogGetTitle, libs, "adw_dialog_get_title")
-	core.PuregoSafeRegister(&xDialogPresent, libs, "adw_dialog_present")
-	core.PuregoSafeRegister(&xDialogSetCanClose, libs, "adw_dialog_set_can_close")
-	core.PuregoSafeRegister(&xDialogSetChild, libs, "adw_dialog_set_child")
-	core.PuregoSafeRegister(&xDialogSetContentHeight, libs, "adw_dialog_set_content_height")
-	core.PuregoSafeRegister(&xDialogSetContentWidth, libs, "adw_dialog_set_content_width")
-	core.PuregoSafeRegister(&xDialogSetDefaultWidget, libs, "adw_dialog_set_default_widget")
-	core.PuregoSafeRegister(&xDialogSetFocus, libs, "adw_dialog_set_focus")
-	core.PuregoSafeRegister(&xDialogSetFollowsContentSize, libs, "adw_dialog_set_follows_content_size")
-	core.PuregoSafeRegister(&xDialogSetPresentationMode, libs, "adw_dialog_set_presentation_mode")
-	core.PuregoSafeRegister(&xDialogSetTitle, libs, "adw_dialog_set_title")
+	gobject.CheckClassSize("AdwDialogClass", xDialogGLibType, unsafe.Sizeof(DialogClass{}))
+
+	core.PuregoRegisterSince(&xNewDialog, libs, "adw_dialog_new", "ADW", "1.5")
+
+	core.PuregoRegisterSince(&xDialogAddBreakpoint, libs, "adw_dialog_add_breakpoint", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogClose, libs, "adw_dialog_close", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogForceClose, libs, "adw_dialog_force_close", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetCanClose, libs, "adw_dialog_get_can_close", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetChild, libs, "adw_dialog_get_child", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetContentHeight, libs, "adw_dialog_get_content_height", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetContentWidth, libs, "adw_dialog_get_content_width", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetCurrentBreakpoint, libs, "adw_dialog_get_current_breakpoint", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetDefaultWidget, libs, "adw_dialog_get_default_widget", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetFocus, libs, "adw_dialog_get_focus", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetFollowsContentSize, libs, "adw_dialog_get_follows_content_size", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetPresentationMode, libs, "adw_dialog_get_presentation_mode", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogGetTitle, libs, "adw_dialog_get_title", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogPresent, libs, "adw_dialog_present", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetCanClose, libs, "adw_dialog_set_can_close", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetChild, libs, "adw_dialog_set_child", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetContentHeight, libs, "adw_dialog_set_content_height", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetContentWidth, libs, "adw_dialog_set_content_width", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetDefaultWidget, libs, "adw_dialog_set_default_widget", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetFocus, libs, "adw_dialog_set_focus", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetFollowsContentSize, libs, "adw_dialog_set_follows_content_size", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetPresentationMode, libs, "adw_dialog_set_presentation_mode", "ADW", "1.5")
+	core.PuregoRegisterSince(&xDialogSetTitle, libs, "adw_dialog_set_title", "ADW", "1.5")
 
 }
@@ -2,13 +2,14 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Describes the available easing functions for use with
-// [class@TimedAnimation].
+// TimedAnimation.
 //
 // New values may be added to this enumeration over time.
 type Easing int
@@ -117,11 +118,242 @@ const (
 	EaseInOutValue Easing = 34
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Easing) String() string {
+	switch x {
+	case LinearValue:
+		return "ADW_LINEAR"
+	case EaseInQuadValue:
+		return "ADW_EASE_IN_QUAD"
+	case EaseOutQuadValue:
+		return "ADW_EASE_OUT_QUAD"
+	case EaseInOutQuadValue:
+		return "ADW_EASE_IN_OUT_QUAD"
+	case EaseInCubicValue:
+		return "ADW_EASE_IN_CUBIC"
+	case EaseOutCubicValue:
+		return "ADW_EASE_OUT_CUBIC"
+	case EaseInOutCubicValue:
+		return "ADW_EASE_IN_OUT_CUBIC"
+	case EaseInQuartValue:
+		return "ADW_EASE_IN_QUART"
+	case EaseOutQuartValue:
+		return "ADW_EASE_OUT_QUART"
+	case EaseInOutQuartValue:
+		return "ADW_EASE_IN_OUT_QUART"
+	case EaseInQuintValue:
+		return "ADW_EASE_IN_QUINT"
+	case EaseOutQuintValue:
+		return "ADW_EASE_OUT_QUINT"
+	case EaseInOutQuintValue:
+		return "ADW_EASE_IN_OUT_QUINT"
+	case EaseInSineValue:
+		return "ADW_EASE_IN_SINE"
+	case EaseOutSineValue:
+		return "ADW_EASE_OUT_SINE"
+	case EaseInOutSineValue:
+		return "ADW_EASE_IN_OUT_SINE"
+	case EaseInExpoValue:
+		return "ADW_EASE_IN_EXPO"
+	case EaseOutExpoValue:
+		return "ADW_EASE_OUT_EXPO"
+	case EaseInOutExpoValue:
+		return "ADW_EASE_IN_OUT_EXPO"
+	case EaseInCircValue:
+		return "ADW_EASE_IN_CIRC"
+	case EaseOutCircValue:
+		return "ADW_EASE_OUT_CIRC"
+	case EaseInOutCircValue:
+		return "ADW_EASE_IN_OUT_CIRC"
+	case EaseInElasticValue:
+		return "ADW_EASE_IN_ELASTIC"
+	case EaseOutElasticValue:
+		return "ADW_EASE_OUT_ELASTIC"
+	case EaseInOutElasticValue:
+		return "ADW_EASE_IN_OUT_ELASTIC"
+	case EaseInBackValue:
+		return "ADW_EASE_IN_BACK"
+	case EaseOutBackValue:
+		return "ADW_EASE_OUT_BACK"
+	case EaseInOutBackValue:
+		return "ADW_EASE_IN_OUT_BACK"
+	case EaseInBounceValue:
+		return "ADW_EASE_IN_BOUNCE"
+	case EaseOutBounceValue:
+		return "ADW_EASE_OUT_BOUNCE"
+	case EaseInOutBounceValue:
+		return "ADW_EASE_IN_OUT_BOUNCE"
+	case EaseValue:
+		return "ADW_EASE"
+	case EaseInValue:
+		return "ADW_EASE_IN"
+	case EaseOutValue:
+		return "ADW_EASE_OUT"
+	case EaseInOutValue:
+		return "ADW_EASE_IN_OUT"
+	}
+	return fmt.Sprintf("Easing(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Easing) Nick() string {
+	switch x {
+	case LinearValue:
+		return "linear"
+	case EaseInQuadValue:
+		return "ease-in-quad"
+	case EaseOutQuadValue:
+		return "ease-out-quad"
+	case EaseInOutQuadValue:
+		return "ease-in-out-quad"
+	case EaseInCubicValue:
+		return "ease-in-cubic"
+	case EaseOutCubicValue:
+		return "ease-out-cubic"
+	case EaseInOutCubicValue:
+		return "ease-in-out-cubic"
+	case EaseInQuartValue:
+		return "ease-in-quart"
+	case EaseOutQuartValue:
+		return "ease-out-quart"
+	case EaseInOutQuartValue:
+		return "ease-in-out-quart"
+	case EaseInQuintValue:
+		return "ease-in-quint"
+	case EaseOutQuintValue:
+		return "ease-out-quint"
+	case EaseInOutQuintValue:
+		return "ease-in-out-quint"
+	case EaseInSineValue:
+		return "ease-in-sine"
+	case EaseOutSineValue:
+		return "ease-out-sine"
+	case EaseInOutSineValue:
+		return "ease-in-out-sine"
+	case EaseInExpoValue:
+		return "ease-in-expo"
+	case EaseOutExpoValue:
+		return "ease-out-expo"
+	case EaseInOutExpoValue:
+		return "ease-in-out-expo"
+	case EaseInCircValue:
+		return "ease-in-circ"
+	case EaseOutCircValue:
+		return "ease-out-circ"
+	case EaseInOutCircValue:
+		return "ease-in-out-circ"
+	case EaseInElasticValue:
+		return "ease-in-elastic"
+	case EaseOutElasticValue:
+		return "ease-out-elastic"
+	case EaseInOutElasticValue:
+		return "ease-in-out-elastic"
+	case EaseInBackValue:
+		return "ease-in-back"
+	case EaseOutBackValue:
+		return "ease-out-back"
+	case EaseInOutBackValue:
+		return "ease-in-out-back"
+	case EaseInBounceValue:
+		return "ease-in-bounce"
+	case EaseOutBounceValue:
+		return "ease-out-bounce"
+	case EaseInOutBounceValue:
+		return "ease-in-out-bounce"
+	case EaseValue:
+		return "ease"
+	case EaseInValue:
+		return "ease-in"
+	case EaseOutValue:
+		return "ease-out"
+	case EaseInOutValue:
+		return "ease-in-out"
+	}
+	return ""
+}
+
+// EasingFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EasingFromString(s string) (Easing, bool) {
+	switch s {
+	case "ADW_LINEAR", "linear":
+		return LinearValue, true
+	case "ADW_EASE_IN_QUAD", "ease-in-quad":
+		return EaseInQuadValue, true
+	case "ADW_EASE_OUT_QUAD", "ease-out-quad":
+		return EaseOutQuadValue, true
+	case "ADW_EASE_IN_OUT_QUAD", "ease-in-out-quad":
+		return EaseInOutQuadValue, true
+	case "ADW_EASE_IN_CUBIC", "ease-in-cubic":
+		return EaseInCubicValue, true
+	case "ADW_EASE_OUT_CUBIC", "ease-out-cubic":
+		return EaseOutCubicValue, true
+	case "ADW_EASE_IN_OUT_CUBIC", "ease-in-out-cubic":
+		return EaseInOutCubicValue, true
+	case "ADW_EASE_IN_QUART", "ease-in-quart":
+		return EaseInQuartValue, true
+	case "ADW_EASE_OUT_QUART", "ease-out-quart":
+		return EaseOutQuartValue, true
+	case "ADW_EASE_IN_OUT_QUART", "ease-in-out-quart":
+		return EaseInOutQuartValue, true
+	case "ADW_EASE_IN_QUINT", "ease-in-quint":
+		return EaseInQuintValue, true
+	case "ADW_EASE_OUT_QUINT", "ease-out-quint":
+		return EaseOutQuintValue, true
+	case "ADW_EASE_IN_OUT_QUINT", "ease-in-out-quint":
+		return EaseInOutQuintValue, true
+	case "ADW_EASE_IN_SINE", "ease-in-sine":
+		return EaseInSineValue, true
+	case "ADW_EASE_OUT_SINE", "ease-out-sine":
+		return EaseOutSineValue, true
+	case "ADW_EASE_IN_OUT_SINE", "ease-in-out-sine":
+		return EaseInOutSineValue, true
+	case "ADW_EASE_IN_EXPO", "ease-in-expo":
+		return EaseInExpoValue, true
+	case "ADW_EASE_OUT_EXPO", "ease-out-expo":
+		return EaseOutExpoValue, true
+	case "ADW_EASE_IN_OUT_EXPO", "ease-in-out-expo":
+		return EaseInOutExpoValue, true
+	case "ADW_EASE_IN_CIRC", "ease-in-circ":
+		return EaseInCircValue, true
+	case "ADW_EASE_OUT_CIRC", "ease-out-circ":
+		return EaseOutCircValue, true
+	case "ADW_EASE_IN_OUT_CIRC", "ease-in-out-circ":
+		return EaseInOutCircValue, true
+	case "ADW_EASE_IN_ELASTIC", "ease-in-elastic":
+		return EaseInElasticValue, true
+	case "ADW_EASE_OUT_ELASTIC", "ease-out-elastic":
+		return EaseOutElasticValue, true
+	case "ADW_EASE_IN_OUT_ELASTIC", "ease-in-out-elastic":
+		return EaseInOutElasticValue, true
+	case "ADW_EASE_IN_BACK", "ease-in-back":
+		return EaseInBackValue, true
+	case "ADW_EASE_OUT_BACK", "ease-out-back":
+		return EaseOutBackValue, true
+	case "ADW_EASE_IN_OUT_BACK", "ease-in-out-back":
+		return EaseInOutBackValue, true
+	case "ADW_EASE_IN_BOUNCE", "ease-in-bounce":
+		return EaseInBounceValue, true
+	case "ADW_EASE_OUT_BOUNCE", "ease-out-bounce":
+		return EaseOutBounceValue, true
+	case "ADW_EASE_IN_OUT_BOUNCE", "ease-in-out-bounce":
+		return EaseInOutBounceValue, true
+	case "ADW_EASE", "ease":
+		return EaseValue, true
+	case "ADW_EASE_IN", "ease-in":
+		return EaseInValue, true
+	case "ADW_EASE_OUT", "ease-out":
+		return EaseOutValue, true
+	case "ADW_EASE_IN_OUT", "ease-in-out":
+		return EaseInOutValue, true
+	}
+	return 0, false
+}
+
 var xEasingEase func(Easing, float64) float64
 
-// Computes easing with @easing for @value.
+// Computes easing with easing for value.
 //
-// @value should generally be in the [0, 1] range.
+// value should generally be in the [0, 1] range.
 func EasingEase(SelfVar Easing, ValueVar float64) float64 {
 
 	cret := xEasingEase(SelfVar, ValueVar)
@@ -133,7 +365,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -142,6 +374,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xEasingGLibType, libs, "adw_easing_get_type")
 
-	core.PuregoSafeRegister(&xEasingEase, libs, "adw_easing_ease")
+	core.PuregoRegisterSince(&xEasingEase, libs, "adw_easing_ease", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,39 +23,32 @@ func (x *EntryRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] with an embedded text entry.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="entry-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="entry-row.png" alt="entry-row"&gt;
-//
-// &lt;/picture&gt;
+// A [gtk.ListBoxRow] with an embedded text entry.
 //
 // `AdwEntryRow` has a title that doubles as placeholder text. It shows an icon
 // indicating that it's editable and can receive additional widgets before or
 // after the editable part.
 //
-// If [property@EntryRow:show-apply-button] is set to `TRUE`, `AdwEntryRow` can
+// If EntryRow:show-apply-button is set to `TRUE`, `AdwEntryRow` can
 // show an apply button when editing its contents. This can be useful if
 // changing its contents can result in an expensive operation, such as network
 // activity.
 //
 // `AdwEntryRow` provides only minimal API and should be used with the
-// [iface@Gtk.Editable] API.
+// [gtk.Editable] API.
 //
-// See also [class@PasswordEntryRow].
+// See also PasswordEntryRow.
 //
-// ## AdwEntryRow as GtkBuildable
+// # AdwEntryRow as GtkBuildable
 //
-// The `AdwEntryRow` implementation of the [iface@Gtk.Buildable] interface
+// The `AdwEntryRow` implementation of the [gtk.Buildable] interface
 // supports adding a child at its end by specifying “suffix” or omitting the
-// “type” attribute of a &lt;child&gt; element.
+// “type” attribute of a <child> element.
 //
 // It also supports adding a child as a prefix widget by specifying “prefix” as
-// the “type” attribute of a &lt;child&gt; element.
+// the “type” attribute of a <child> element.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwEntryRow` has a single CSS node with name `row` and the `.entry` style
 // class.
@@ -95,7 +87,7 @@ func NewEntryRow() *EntryRow {
 
 var xEntryRowAddPrefix func(uintptr, uintptr)
 
-// Adds a prefix widget to @self.
+// Adds a prefix widget to self.
 func (x *EntryRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 	xEntryRowAddPrefix(x.GoPointer(), WidgetVar.GoPointer())
@@ -104,7 +96,7 @@ func (x *EntryRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 var xEntryRowAddSuffix func(uintptr, uintptr)
 
-// Adds a suffix widget to @self.
+// Adds a suffix widget to self.
 func (x *EntryRow) AddSuffix(WidgetVar *gtk.Widget) {
 
 	xEntryRowAddSuffix(x.GoPointer(), WidgetVar.GoPointer())
@@ -131,7 +123,7 @@ func (x *EntryRow) GetAttributes() *pango.AttrList {
 
 var xEntryRowGetEnableEmojiCompletion func(uintptr) bool
 
-// Gets whether to suggest emoji replacements on @self.
+// Gets whether to suggest emoji replacements on self.
 func (x *EntryRow) GetEnableEmojiCompletion() bool {
 
 	cret := xEntryRowGetEnableEmojiCompletion(x.GoPointer())
@@ -140,7 +132,7 @@ func (x *EntryRow) GetEnableEmojiCompletion() bool {
 
 var xEntryRowGetInputHints func(uintptr) gtk.InputHints
 
-// Gets the additional input hints of @self.
+// Gets the additional input hints of self.
 func (x *EntryRow) GetInputHints() gtk.InputHints {
 
 	cret := xEntryRowGetInputHints(x.GoPointer())
@@ -149,7 +141,7 @@ func (x *EntryRow) GetInputHints() gtk.InputHints {
 
 var xEntryRowGetInputPurpose func(uintptr) gtk.InputPurpose
 
-// Gets the input purpose of @self.
+// Gets the input purpose of self.
 func (x *EntryRow) GetInputPurpose() gtk.InputPurpose {
 
 	cret := xEntryRowGetInputPurpose(x.GoPointer())
@@ -167,7 +159,7 @@ func (x *EntryRow) GetMaxLength() int {
 
 var xEntryRowGetShowApplyButton func(uintptr) bool
 
-// Gets whether @self can show the apply button.
+// Gets whether self can show the apply button.
 func (x *EntryRow) GetShowApplyButton() bool {
 
 	cret := xEntryRowGetShowApplyButton(x.GoPointer())
@@ -176,7 +168,7 @@ func (x *EntryRow) GetShowApplyButton() bool {
 
 var xEntryRowGetTextLength func(uintptr) uint
 
-// Retrieves the current length of the text in @self.
+// Retrieves the current length of the text in self.
 func (x *EntryRow) GetTextLength() uint {
 
 	cret := xEntryRowGetTextLength(x.GoPointer())
@@ -185,9 +177,9 @@ func (x *EntryRow) GetTextLength() uint {
 
 var xEntryRowGrabFocusWithoutSelecting func(uintptr) bool
 
-// Causes @self to have keyboard focus without selecting the text.
+// Causes self to have keyboard focus without selecting the text.
 //
-// See [method@Gtk.Text.grab_focus_without_selecting] for more information.
+// See [gtk.Text.GrabFocusWithoutSelecting] for more information.
 func (x *EntryRow) GrabFocusWithoutSelecting() bool {
 
 	cret := xEntryRowGrabFocusWithoutSelecting(x.GoPointer())
@@ -196,7 +188,7 @@ func (x *EntryRow) GrabFocusWithoutSelecting() bool {
 
 var xEntryRowRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 func (x *EntryRow) Remove(WidgetVar *gtk.Widget) {
 
 	xEntryRowRemove(x.GoPointer(), WidgetVar.GoPointer())
@@ -216,8 +208,8 @@ var xEntryRowSetAttributes func(uintptr, *pango.AttrList)
 
 // Sets Pango attributes to apply to the text of the embedded entry.
 //
-// The [struct@Pango.Attribute]'s `start_index` and `end_index` must refer to
-// the [class@Gtk.EntryBuffer] text, i.e. without the preedit string.
+// The [pango.Attribute]'s `start_index` and `end_index` must refer to
+// the [gtk.EntryBuffer] text, i.e. without the preedit string.
 func (x *EntryRow) SetAttributes(AttributesVar *pango.AttrList) {
 
 	xEntryRowSetAttributes(x.GoPointer(), AttributesVar)
@@ -226,7 +218,7 @@ func (x *EntryRow) SetAttributes(AttributesVar *pango.AttrList) {
 
 var xEntryRowSetEnableEmojiCompletion func(uintptr, bool)
 
-// Sets whether to suggest emoji replacements on @self.
+// Sets whether to suggest emoji replacements on self.
 //
 // Emoji replacement is done with :-delimited names, like `:heart:`.
 func (x *EntryRow) SetEnableEmojiCompletion(EnableEmojiCompletionVar bool) {
@@ -237,11 +229,11 @@ func (x *EntryRow) SetEnableEmojiCompletion(EnableEmojiCompletionVar bool) {
 
 var xEntryRowSetInputHints func(uintptr, gtk.InputHints)
 
-// Set additional input hints for @self.
+// Set additional input hints for self.
 //
 // Input hints allow input methods to fine-tune their behavior.
 //
-// See also: [property@AdwEntryRow:input-purpose]
+// See also: AdwEntryRow:input-purpose
 func (x *EntryRow) SetInputHints(HintsVar gtk.InputHints) {
 
 	xEntryRowSetInputHints(x.GoPointer(), HintsVar)
@@ -250,7 +242,7 @@ func (x *EntryRow) SetInputHints(HintsVar gtk.InputHints) {
 
 var xEntryRowSetInputPurpose func(uintptr, gtk.InputPurpose)
 
-// Sets the input purpose of @self.
+// Sets the input purpose of self.
 //
 // The input purpose can be used by input methods to adjust their behavior.
 func (x *EntryRow) SetInputPurpose(PurposeVar gtk.InputPurpose) {
@@ -270,11 +262,11 @@ func (x *EntryRow) SetMaxLength(MaxLengthVar int) {
 
 var xEntryRowSetShowApplyButton func(uintptr, bool)
 
-// Sets whether @self can show the apply button.
+// Sets whether self can show the apply button.
 //
 // When set to `TRUE`, typing text in the entry will reveal an apply button.
-// Clicking it or pressing the &lt;kbd&gt;Enter&lt;/kbd&gt; key will hide the button and
-// emit the [signal@EntryRow::apply] signal.
+// Clicking it or pressing the Enter key will hide the button and
+// emit the EntryRow::apply signal.
 //
 // This is useful if changing the entry contents can trigger an expensive
 // operation, e.g. network activity, to avoid triggering it after typing every
@@ -316,8 +308,8 @@ func (x *EntryRow) GetPropertyActivatesDefault() bool {
 // SetPropertyAttributes sets the "attributes" property.
 // A list of Pango attributes to apply to the text of the embedded entry.
 //
-// The [struct@Pango.Attribute]'s `start_index` and `end_index` must refer to
-// the [class@Gtk.EntryBuffer] text, i.e. without the preedit string.
+// The [pango.Attribute]'s `start_index` and `end_index` must refer to
+// the [gtk.EntryBuffer] text, i.e. without the preedit string.
 func (x *EntryRow) SetPropertyAttributes(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -328,8 +320,8 @@ func (x *EntryRow) SetPropertyAttributes(value uintptr) {
 // GetPropertyAttributes gets the "attributes" property.
 // A list of Pango attributes to apply to the text of the embedded entry.
 //
-// The [struct@Pango.Attribute]'s `start_index` and `end_index` must refer to
-// the [class@Gtk.EntryBuffer] text, i.e. without the preedit string.
+// The [pango.Attribute]'s `start_index` and `end_index` must refer to
+// the [gtk.EntryBuffer] text, i.e. without the preedit string.
 func (x *EntryRow) GetPropertyAttributes() uintptr {
 	var v gobject.Value
 	x.GetProperty("attributes", &v)
@@ -378,8 +370,8 @@ func (x *EntryRow) GetPropertyMaxLength() int {
 // Whether to show the apply button.
 //
 // When set to `TRUE`, typing text in the entry will reveal an apply button.
-// Clicking it or pressing the &lt;kbd&gt;Enter&lt;/kbd&gt; key will hide the button and
-// emit the [signal@EntryRow::apply] signal.
+// Clicking it or pressing the Enter key will hide the button and
+// emit the EntryRow::apply signal.
 //
 // This is useful if changing the entry contents can trigger an expensive
 // operation, e.g. network activity, to avoid triggering it after typing every
@@ -395,8 +387,8 @@ func (x *EntryRow) SetPropertyShowApplyButton(value bool) {
 // Whether to show the apply button.
 //
 // When set to `TRUE`, typing text in the entry will reveal an apply button.
-// Clicking it or pressing the &lt;kbd&gt;Enter&lt;/kbd&gt; key will hide the button and
-// emit the [signal@EntryRow::apply] signal.
+// Clicking it or pressing the Enter key will hide the button and
+// emit the EntryRow::apply signal.
 //
 // This is useful if changing the entry contents can trigger an expensive
 // operation, e.g. network activity, to avoid triggering it after typing every
@@ -417,52 +409,96 @@ func (x *EntryRow) GetPropertyTextLength() uint {
 
 // Emitted when the apply button is pressed.
 //
-// See [property@EntryRow:show-apply-button].
+// See EntryRow:show-apply-button.
+//
+// Deprecated: use ConnectApplyFunc, which also accepts method values and closures.
 func (x *EntryRow) ConnectApply(cb *func(EntryRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EntryRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "apply", cbRefPtr, cbPtr)
+}
+
+var xEntryRowApplyTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xEntryRowApplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EntryRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EntryRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplyFunc connects cb to the "apply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EntryRow) ConnectApplyFunc(cb func(EntryRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "apply", &xEntryRowApplyTrampoline, xEntryRowApplyNewTrampoline, cb)
 }
 
 // Emitted when the embedded entry is activated.
+//
+// Deprecated: use ConnectEntryActivatedFunc, which also accepts method values and closures.
 func (x *EntryRow) ConnectEntryActivated(cb *func(EntryRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "entry-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EntryRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "entry-activated", cbRefPtr, cbPtr)
+}
+
+var xEntryRowEntryActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xEntryRowEntryActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EntryRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EntryRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "entry-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEntryActivatedFunc connects cb to the "entry-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EntryRow) ConnectEntryActivatedFunc(cb func(EntryRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "entry-activated", &xEntryRowEntryActivatedTrampoline, xEntryRowEntryActivatedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -626,7 +662,7 @@ func (x *EntryRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -634,14 +670,13 @@ func (x *EntryRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *EntryRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -665,19 +700,17 @@ func (x *EntryRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *EntryRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -698,7 +731,7 @@ func (x *EntryRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -707,14 +740,12 @@ func (x *EntryRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *EntryRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -733,14 +764,14 @@ func (x *EntryRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSt
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *EntryRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *EntryRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -750,15 +781,15 @@ func (x *EntryRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *EntryRow) SetActionName(ActionNameVar *string) {
 
@@ -771,13 +802,13 @@ func (x *EntryRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *EntryRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -786,14 +817,14 @@ func (x *EntryRow) SetActionTarget(FormatStringVar string, varArgs ...interface{
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -811,18 +842,18 @@ func (x *EntryRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *EntryRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *EntryRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -835,35 +866,29 @@ func (x *EntryRow) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [gtk.AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *EntryRow) DelegateGetAccessiblePlatformState(StateVar gtk.AccessiblePlatformState) bool {
 
@@ -883,8 +908,8 @@ func (x *EntryRow) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -894,7 +919,7 @@ func (x *EntryRow) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [gtk.Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -914,8 +939,8 @@ func (x *EntryRow) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -925,10 +950,10 @@ func (x *EntryRow) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [gtk.Text] widget.
 func (x *EntryRow) GetDelegate() *gtk.EditableBase {
 	var cls *gtk.EditableBase
 
@@ -943,21 +968,21 @@ func (x *EntryRow) GetDelegate() *gtk.EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *EntryRow) GetEditable() bool {
 
 	cret := gtk.XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *EntryRow) GetEnableUndo() bool {
 
 	cret := gtk.XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *EntryRow) GetMaxWidthChars() int {
 
 	cret := gtk.XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -976,9 +1001,9 @@ func (x *EntryRow) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EntryRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -987,7 +1012,7 @@ func (x *EntryRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *EntryRow) GetText() string {
@@ -1007,7 +1032,7 @@ func (x *EntryRow) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -1017,11 +1042,11 @@ func (x *EntryRow) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *EntryRow) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -1032,9 +1057,9 @@ func (x *EntryRow) InsertText(TextVar string, LengthVar int, PositionVar int) {
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EntryRow) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -1060,19 +1085,19 @@ func (x *EntryRow) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [gtk.Text.GetPropertyVisibility] is set to false.
 func (x *EntryRow) SetEnableUndo(EnableUndoVar bool) {
 
 	gtk.XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *EntryRow) SetMaxWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -1085,7 +1110,7 @@ func (x *EntryRow) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *EntryRow) SetPosition(PositionVar int) {
 
 	gtk.XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -1102,11 +1127,11 @@ func (x *EntryRow) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *EntryRow) SetWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -1118,7 +1143,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1127,26 +1152,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xEntryRowGLibType, libs, "adw_entry_row_get_type")
 
-	core.PuregoSafeRegister(&xNewEntryRow, libs, "adw_entry_row_new")
-
-	core.PuregoSafeRegister(&xEntryRowAddPrefix, libs, "adw_entry_row_add_prefix")
-	core.PuregoSafeRegister(&xEntryRowAddSuffix, libs, "adw_entry_row_add_suffix")
-	core.PuregoSafeRegister(&xEntryRowGetActivatesDefault, libs, "adw_entry_row_get_activates_default")
-	core.PuregoSafeRegister(&xEntryRowGetAttributes, libs, "adw_entry_row_get_attributes")
-	core.PuregoSafeRegister(&xEntryRowGetEnableEmojiCompletion, libs, "adw_entry_row_get_enable_emoji_completion")
-	core.PuregoSafeRegister(&xEntryRowGetInputHints, libs, "adw_entry_row_get_input_hints")
-	core.PuregoSafeRegister(&xEntryRowGetInputPurpose, libs, "adw_entry_row_get_input_purpose")
-	core.PuregoSafeRegister(&xEntryRowGetMaxLength, libs, "adw_entry_row_get_max_length")
-	core.PuregoSafeRegister(&xEntryRowGetShowApplyButton, libs, "adw_entry_row_get_show_apply_button")
-	core.PuregoSafeRegister(&xEntryRowGetTextLength, libs, "adw_entry_row_get_text_length")
-	core.PuregoSafeRegister(&xEntryRowGrabFocusWithoutSelecting, libs, "adw_entry_row_grab_focus_without_selecting")
-	core.PuregoSafeRegister(&xEntryRowRemove, libs, "adw_entry_row_remove")
-	core.PuregoSafeRegister(&xEntryRowSetActivatesDefault, libs, "adw_entry_row_set_activates_default")
-	core.PuregoSafeRegister(&xEntryRowSetAttributes, libs, "adw_entry_row_set_attributes")
-	core.PuregoSafeRegister(&xEntryRowSetEnableEmojiCompletion, libs, "adw_entry_row_set_enable_emoji_completion")
-	core.PuregoSafeRegister(&xEntryRowSetInputHints, libs, "adw_entry_row_set_input_hints")
-	core.PuregoSafeRegister(&xEntryRowSetInputPurpose, libs, "adw_entry_row_set_input_purpose")
-	core.PuregoSafeRegister(&xEntryRowSetMaxLength, libs, "adw_entry_row_set_max_length")
-	core.PuregoSafeRegister(&xEntryRowSetShowApplyButton, libs, "adw_entry_row_set_show_apply_button")
+	gobject.CheckClassSize("AdwEntryRowClass", xEntryRowGLibType, unsafe.Sizeof(EntryRowClass{}))
+
+	core.PuregoRegisterSince(&xNewEntryRow, libs, "adw_entry_row_new", "ADW", "1.2")
+
+	core.PuregoRegisterSince(&xEntryRowAddPrefix, libs, "adw_entry_row_add_prefix", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowAddSuffix, libs, "adw_entry_row_add_suffix", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetActivatesDefault, libs, "adw_entry_row_get_activates_default", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetAttributes, libs, "adw_entry_row_get_attributes", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetEnableEmojiCompletion, libs, "adw_entry_row_get_enable_emoji_completion", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetInputHints, libs, "adw_entry_row_get_input_hints", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetInputPurpose, libs, "adw_entry_row_get_input_purpose", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetMaxLength, libs, "adw_entry_row_get_max_length", "ADW", "1.6")
+	core.PuregoRegisterSince(&xEntryRowGetShowApplyButton, libs, "adw_entry_row_get_show_apply_button", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowGetTextLength, libs, "adw_entry_row_get_text_length", "ADW", "1.5")
+	core.PuregoRegisterSince(&xEntryRowGrabFocusWithoutSelecting, libs, "adw_entry_row_grab_focus_without_selecting", "ADW", "1.3")
+	core.PuregoRegisterSince(&xEntryRowRemove, libs, "adw_entry_row_remove", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetActivatesDefault, libs, "adw_entry_row_set_activates_default", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetAttributes, libs, "adw_entry_row_set_attributes", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetEnableEmojiCompletion, libs, "adw_entry_row_set_enable_emoji_completion", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetInputHints, libs, "adw_entry_row_set_input_hints", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetInputPurpose, libs, "adw_entry_row_set_input_purpose", "ADW", "1.2")
+	core.PuregoRegisterSince(&xEntryRowSetMaxLength, libs, "adw_entry_row_set_max_length", "ADW", "1.6")
+	core.PuregoRegisterSince(&xEntryRowSetShowApplyButton, libs, "adw_entry_row_set_show_apply_button", "ADW", "1.2")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -32,7 +31,7 @@ func (x *EnumListModelClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `AdwEnumListItem` is the type of items in a [class@EnumListModel].
+// `AdwEnumListItem` is the type of items in a EnumListModel.
 type EnumListItem struct {
 	gobject.Object
 }
@@ -111,9 +110,9 @@ func (x *EnumListItem) GetPropertyValue() int {
 	return v.GetInt()
 }
 
-// A [iface@Gio.ListModel] representing values of a given enum.
+// A [gio.ListModel] representing values of a given enum.
 //
-// `AdwEnumListModel` contains objects of type [class@EnumListItem].
+// `AdwEnumListModel` contains objects of type EnumListItem.
 type EnumListModel struct {
 	gobject.Object
 }
@@ -132,7 +131,7 @@ func EnumListModelNewFromInternalPtr(ptr uintptr) *EnumListModel {
 
 var xNewEnumListModel func(types.GType) uintptr
 
-// Creates a new `AdwEnumListModel` for @enum_type.
+// Creates a new `AdwEnumListModel` for enum_type.
 func NewEnumListModel(EnumTypeVar types.GType) *EnumListModel {
 	var cls *EnumListModel
 
@@ -148,7 +147,7 @@ func NewEnumListModel(EnumTypeVar types.GType) *EnumListModel {
 
 var xEnumListModelFindPosition func(uintptr, int) uint
 
-// Finds the position of a given enum value in @self.
+// Finds the position of a given enum value in self.
 //
 // If the value is not found, `GTK_INVALID_LIST_POSITION` is returned.
 func (x *EnumListModel) FindPosition(ValueVar int) uint {
@@ -159,7 +158,7 @@ func (x *EnumListModel) FindPosition(ValueVar int) uint {
 
 var xEnumListModelGetEnumType func(uintptr) types.GType
 
-// Gets the type of the enum represented by @self.
+// Gets the type of the enum represented by self.
 func (x *EnumListModel) GetEnumType() types.GType {
 
 	cret := xEnumListModelGetEnumType(x.GoPointer())
@@ -177,28 +176,30 @@ func (c *EnumListModel) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *EnumListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *EnumListModel) GetItemType() types.GType {
 
@@ -206,29 +207,29 @@ func (x *EnumListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *EnumListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *EnumListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -242,20 +243,20 @@ func (x *EnumListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -273,7 +274,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -282,15 +283,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xEnumListItemGLibType, libs, "adw_enum_list_item_get_type")
 
-	core.PuregoSafeRegister(&xEnumListItemGetName, libs, "adw_enum_list_item_get_name")
-	core.PuregoSafeRegister(&xEnumListItemGetNick, libs, "adw_enum_list_item_get_nick")
-	core.PuregoSafeRegister(&xEnumListItemGetValue, libs, "adw_enum_list_item_get_value")
+	gobject.CheckClassSize("AdwEnumListItemClass", xEnumListItemGLibType, unsafe.Sizeof(EnumListItemClass{}))
+
+	core.PuregoRegisterSince(&xEnumListItemGetName, libs, "adw_enum_list_item_get_name", "ADW", "")
+	core.PuregoRegisterSince(&xEnumListItemGetNick, libs, "adw_enum_list_item_get_nick", "ADW", "")
+	core.PuregoRegisterSince(&xEnumListItemGetValue, libs, "adw_enum_list_item_get_value", "ADW", "")
 
 	core.PuregoSafeRegister(&xEnumListModelGLibType, libs, "adw_enum_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewEnumListModel, libs, "adw_enum_list_model_new")
+	gobject.CheckClassSize("AdwEnumListModelClass", xEnumListModelGLibType, unsafe.Sizeof(EnumListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewEnumListModel, libs, "adw_enum_list_model_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xEnumListModelFindPosition, libs, "adw_enum_list_model_find_position")
-	core.PuregoSafeRegister(&xEnumListModelGetEnumType, libs, "adw_enum_list_model_get_enum_type")
+	core.PuregoRegisterSince(&xEnumListModelFindPosition, libs, "adw_enum_list_model_find_position", "ADW", "")
+	core.PuregoRegisterSince(&xEnumListModelGetEnumType, libs, "adw_enum_list_model_get_enum_type", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,29 +24,22 @@ func (x *ExpanderRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] used to reveal widgets.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="expander-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="expander-row.png" alt="expander-row"&gt;
-//
-// &lt;/picture&gt;
+// A [gtk.ListBoxRow] used to reveal widgets.
 //
 // The `AdwExpanderRow` widget allows the user to reveal or hide widgets below
 // it. It also allows the user to enable the expansion of the row, allowing to
 // disable all that the row contains.
 //
-// ## AdwExpanderRow as GtkBuildable
+// # AdwExpanderRow as GtkBuildable
 //
-// The `AdwExpanderRow` implementation of the [iface@Gtk.Buildable] interface
+// The `AdwExpanderRow` implementation of the [gtk.Buildable] interface
 // supports adding a child as an suffix widget by specifying “suffix” as the
-// “type” attribute of a &lt;child&gt; element.
+// “type” attribute of a <child> element.
 //
 // It also supports adding it as a prefix widget by specifying “prefix” as the
-// “type” attribute of a &lt;child&gt; element.
+// “type” attribute of a <child> element.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwExpanderRow` has a main CSS node with name `row` and the `.expander`
 // style class. It has the `.empty` style class when it contains no children.
@@ -56,9 +48,9 @@ func (x *ExpanderRowClass) GoPointer() uintptr {
 // `list.nested` for the list it can expand, and `image.expander-row-arrow` for
 // its arrow.
 //
-// ## Style classes
+// # Style classes
 //
-// `AdwExpanderRow` can use the [`.`](style-classes.html#property-rows)
+// `AdwExpanderRow` can use the .
 // style class to emphasize the row subtitle instead of the row title, which is
 // useful for displaying read-only properties.
 //
@@ -97,18 +89,9 @@ func NewExpanderRow() *ExpanderRow {
 	return cls
 }
 
-var xExpanderRowAddAction func(uintptr, uintptr)
-
-// Adds an action widget to @self.
-func (x *ExpanderRow) AddAction(WidgetVar *gtk.Widget) {
-
-	xExpanderRowAddAction(x.GoPointer(), WidgetVar.GoPointer())
-
-}
-
 var xExpanderRowAddPrefix func(uintptr, uintptr)
 
-// Adds a prefix widget to @self.
+// Adds a prefix widget to self.
 func (x *ExpanderRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 	xExpanderRowAddPrefix(x.GoPointer(), WidgetVar.GoPointer())
@@ -117,9 +100,9 @@ func (x *ExpanderRow) AddPrefix(WidgetVar *gtk.Widget) {
 
 var xExpanderRowAddRow func(uintptr, uintptr)
 
-// Adds a widget to @self.
+// Adds a widget to self.
 //
-// The widget will appear in the expanding list below @self.
+// The widget will appear in the expanding list below self.
 func (x *ExpanderRow) AddRow(ChildVar *gtk.Widget) {
 
 	xExpanderRowAddRow(x.GoPointer(), ChildVar.GoPointer())
@@ -128,7 +111,7 @@ func (x *ExpanderRow) AddRow(ChildVar *gtk.Widget) {
 
 var xExpanderRowAddSuffix func(uintptr, uintptr)
 
-// Adds an suffix widget to @self.
+// Adds an suffix widget to self.
 func (x *ExpanderRow) AddSuffix(WidgetVar *gtk.Widget) {
 
 	xExpanderRowAddSuffix(x.GoPointer(), WidgetVar.GoPointer())
@@ -137,7 +120,7 @@ func (x *ExpanderRow) AddSuffix(WidgetVar *gtk.Widget) {
 
 var xExpanderRowGetEnableExpansion func(uintptr) bool
 
-// Gets whether the expansion of @self is enabled.
+// Gets whether the expansion of self is enabled.
 func (x *ExpanderRow) GetEnableExpansion() bool {
 
 	cret := xExpanderRowGetEnableExpansion(x.GoPointer())
@@ -146,25 +129,16 @@ func (x *ExpanderRow) GetEnableExpansion() bool {
 
 var xExpanderRowGetExpanded func(uintptr) bool
 
-// Gets whether @self is expanded.
+// Gets whether self is expanded.
 func (x *ExpanderRow) GetExpanded() bool {
 
 	cret := xExpanderRowGetExpanded(x.GoPointer())
 	return cret
 }
 
-var xExpanderRowGetIconName func(uintptr) string
-
-// Gets the icon name for @self.
-func (x *ExpanderRow) GetIconName() string {
-
-	cret := xExpanderRowGetIconName(x.GoPointer())
-	return cret
-}
-
 var xExpanderRowGetShowEnableSwitch func(uintptr) bool
 
-// Gets whether the switch enabling the expansion of @self is visible.
+// Gets whether the switch enabling the expansion of self is visible.
 func (x *ExpanderRow) GetShowEnableSwitch() bool {
 
 	cret := xExpanderRowGetShowEnableSwitch(x.GoPointer())
@@ -173,7 +147,7 @@ func (x *ExpanderRow) GetShowEnableSwitch() bool {
 
 var xExpanderRowGetSubtitle func(uintptr) string
 
-// Gets the subtitle for @self.
+// Gets the subtitle for self.
 func (x *ExpanderRow) GetSubtitle() string {
 
 	cret := xExpanderRowGetSubtitle(x.GoPointer())
@@ -202,7 +176,7 @@ func (x *ExpanderRow) GetTitleLines() int {
 
 var xExpanderRowRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 func (x *ExpanderRow) Remove(ChildVar *gtk.Widget) {
 
 	xExpanderRowRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -211,7 +185,7 @@ func (x *ExpanderRow) Remove(ChildVar *gtk.Widget) {
 
 var xExpanderRowSetEnableExpansion func(uintptr, bool)
 
-// Sets whether the expansion of @self is enabled.
+// Sets whether the expansion of self is enabled.
 func (x *ExpanderRow) SetEnableExpansion(EnableExpansionVar bool) {
 
 	xExpanderRowSetEnableExpansion(x.GoPointer(), EnableExpansionVar)
@@ -220,28 +194,16 @@ func (x *ExpanderRow) SetEnableExpansion(EnableExpansionVar bool) {
 
 var xExpanderRowSetExpanded func(uintptr, bool)
 
-// Sets whether @self is expanded.
+// Sets whether self is expanded.
 func (x *ExpanderRow) SetExpanded(ExpandedVar bool) {
 
 	xExpanderRowSetExpanded(x.GoPointer(), ExpandedVar)
 
 }
 
-var xExpanderRowSetIconName func(uintptr, uintptr)
-
-// Sets the icon name for @self.
-func (x *ExpanderRow) SetIconName(IconNameVar *string) {
-
-	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
-	defer core.GFreeNullable(IconNameVarPtr)
-
-	xExpanderRowSetIconName(x.GoPointer(), IconNameVarPtr)
-
-}
-
 var xExpanderRowSetShowEnableSwitch func(uintptr, bool)
 
-// Sets whether the switch enabling the expansion of @self is visible.
+// Sets whether the switch enabling the expansion of self is visible.
 func (x *ExpanderRow) SetShowEnableSwitch(ShowEnableSwitchVar bool) {
 
 	xExpanderRowSetShowEnableSwitch(x.GoPointer(), ShowEnableSwitchVar)
@@ -250,10 +212,10 @@ func (x *ExpanderRow) SetShowEnableSwitch(ShowEnableSwitchVar bool) {
 
 var xExpanderRowSetSubtitle func(uintptr, string)
 
-// Sets the subtitle for @self.
+// Sets the subtitle for self.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ExpanderRow) SetSubtitle(SubtitleVar string) {
 
 	xExpanderRowSetSubtitle(x.GoPointer(), SubtitleVar)
@@ -331,6 +293,8 @@ func (x *ExpanderRow) GetPropertyExpanded() bool {
 
 // SetPropertyIconName sets the "icon-name" property.
 // The icon name for this row.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
 func (x *ExpanderRow) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -340,6 +304,8 @@ func (x *ExpanderRow) SetPropertyIconName(value string) {
 
 // GetPropertyIconName gets the "icon-name" property.
 // The icon name for this row.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
 func (x *ExpanderRow) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -367,7 +333,7 @@ func (x *ExpanderRow) GetPropertyShowEnableSwitch() bool {
 // The subtitle for this row.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ExpanderRow) SetPropertySubtitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -379,7 +345,7 @@ func (x *ExpanderRow) SetPropertySubtitle(value string) {
 // The subtitle for this row.
 //
 // The subtitle is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *ExpanderRow) GetPropertySubtitle() string {
 	var v gobject.Value
 	x.GetProperty("subtitle", &v)
@@ -591,7 +557,7 @@ func (x *ExpanderRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -599,14 +565,13 @@ func (x *ExpanderRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ExpanderRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -630,19 +595,17 @@ func (x *ExpanderRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ExpanderRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -663,7 +626,7 @@ func (x *ExpanderRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -672,14 +635,12 @@ func (x *ExpanderRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ExpanderRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -698,14 +659,14 @@ func (x *ExpanderRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ExpanderRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ExpanderRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -715,15 +676,15 @@ func (x *ExpanderRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ExpanderRow) SetActionName(ActionNameVar *string) {
 
@@ -736,13 +697,13 @@ func (x *ExpanderRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *ExpanderRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -751,14 +712,14 @@ func (x *ExpanderRow) SetActionTarget(FormatStringVar string, varArgs ...interfa
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -776,18 +737,18 @@ func (x *ExpanderRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ExpanderRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ExpanderRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -799,7 +760,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -808,26 +769,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xExpanderRowGLibType, libs, "adw_expander_row_get_type")
 
-	core.PuregoSafeRegister(&xNewExpanderRow, libs, "adw_expander_row_new")
-
-	core.PuregoSafeRegister(&xExpanderRowAddAction, libs, "adw_expander_row_add_action")
-	core.PuregoSafeRegister(&xExpanderRowAddPrefix, libs, "adw_expander_row_add_prefix")
-	core.PuregoSafeRegister(&xExpanderRowAddRow, libs, "adw_expander_row_add_row")
-	core.PuregoSafeRegister(&xExpanderRowAddSuffix, libs, "adw_expander_row_add_suffix")
-	core.PuregoSafeRegister(&xExpanderRowGetEnableExpansion, libs, "adw_expander_row_get_enable_expansion")
-	core.PuregoSafeRegister(&xExpanderRowGetExpanded, libs, "adw_expander_row_get_expanded")
-	core.PuregoSafeRegister(&xExpanderRowGetIconName, libs, "adw_expander_row_get_icon_name")
-	core.PuregoSafeRegister(&xExpanderRowGetShowEnableSwitch, libs, "adw_expander_row_get_show_enable_switch")
-	core.PuregoSafeRegister(&xExpanderRowGetSubtitle, libs, "adw_expander_row_get_subtitle")
-	core.PuregoSafeRegister(&xExpanderRowGetSubtitleLines, libs, "adw_expander_row_get_subtitle_lines")
-	core.PuregoSafeRegister(&xExpanderRowGetTitleLines, libs, "adw_expander_row_get_title_lines")
-	core.PuregoSafeRegister(&xExpanderRowRemove, libs, "adw_expander_row_remove")
-	core.PuregoSafeRegister(&xExpanderRowSetEnableExpansion, libs, "adw_expander_row_set_enable_expansion")
-	core.PuregoSafeRegister(&xExpanderRowSetExpanded, libs, "adw_expander_row_set_expanded")
-	core.PuregoSafeRegister(&xExpanderRowSetIconName, libs, "adw_expander_row_set_icon_name")
-	core.PuregoSafeRegister(&xExpanderRowSetShowEnableSwitch, libs, "adw_expander_row_set_show_enable_switch")
-	core.PuregoSafeRegister(&xExpanderRowSetSubtitle, libs, "adw_expander_row_set_subtitle")
-	core.PuregoSafeRegister(&xExpanderRowSetSubtitleLines, libs, "adw_expander_row_set_subtitle_lines")
-	core.PuregoSafeRegister(&xExpanderRowSetTitleLines, libs, "adw_expander_row_set_title_lines")
+	gobject.CheckClassSize("AdwExpanderRowClass", xExpanderRowGLibType, unsafe.Sizeof(ExpanderRowClass{}))
+
+	core.PuregoRegisterSince(&xNewExpanderRow, libs, "adw_expander_row_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xExpanderRowAddPrefix, libs, "adw_expander_row_add_prefix", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowAddRow, libs, "adw_expander_row_add_row", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowAddSuffix, libs, "adw_expander_row_add_suffix", "ADW", "1.4")
+	core.PuregoRegisterSince(&xExpanderRowGetEnableExpansion, libs, "adw_expander_row_get_enable_expansion", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowGetExpanded, libs, "adw_expander_row_get_expanded", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowGetShowEnableSwitch, libs, "adw_expander_row_get_show_enable_switch", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowGetSubtitle, libs, "adw_expander_row_get_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowGetSubtitleLines, libs, "adw_expander_row_get_subtitle_lines", "ADW", "1.3")
+	core.PuregoRegisterSince(&xExpanderRowGetTitleLines, libs, "adw_expander_row_get_title_lines", "ADW", "1.3")
+	core.PuregoRegisterSince(&xExpanderRowRemove, libs, "adw_expander_row_remove", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetEnableExpansion, libs, "adw_expander_row_set_enable_expansion", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetExpanded, libs, "adw_expander_row_set_expanded", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetShowEnableSwitch, libs, "adw_expander_row_set_show_enable_switch", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetSubtitle, libs, "adw_expander_row_set_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetSubtitleLines, libs, "adw_expander_row_set_subtitle_lines", "ADW", "1.3")
+	core.PuregoRegisterSince(&xExpanderRowSetTitleLines, libs, "adw_expander_row_set_title_lines", "ADW", "1.3")
 
 }
@@ -0,0 +1,63 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xExpanderRowAddAction func(uintptr, uintptr)
+
+// Adds an action widget to self.
+//
+// Deprecated: since 1.4. Use add_suffix to add a suffix.
+func (x *ExpanderRow) AddAction(WidgetVar *gtk.Widget) {
+
+	xExpanderRowAddAction(x.GoPointer(), WidgetVar.GoPointer())
+
+}
+
+var xExpanderRowGetIconName func(uintptr) string
+
+// Gets the icon name for self.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
+func (x *ExpanderRow) GetIconName() string {
+
+	cret := xExpanderRowGetIconName(x.GoPointer())
+	return cret
+}
+
+var xExpanderRowSetIconName func(uintptr, uintptr)
+
+// Sets the icon name for self.
+//
+// Deprecated: since 1.3. Use add_prefix to add an icon.
+func (x *ExpanderRow) SetIconName(IconNameVar *string) {
+
+	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
+	defer core.GFreeNullable(IconNameVarPtr)
+
+	xExpanderRowSetIconName(x.GoPointer(), IconNameVarPtr)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xExpanderRowAddAction, libs, "adw_expander_row_add_action", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowGetIconName, libs, "adw_expander_row_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xExpanderRowSetIconName, libs, "adw_expander_row_set_icon_name", "ADW", "")
+
+}
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +23,9 @@ func (x *FlapClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the possible folding behavior of a [class@Flap] widget.
+// Describes the possible folding behavior of a Flap widget.
+//
+// Deprecated: since 1.4. See the migration guide
 type FlapFoldPolicy int
 
 var xFlapFoldPolicyGLibType func() types.GType
@@ -44,11 +46,52 @@ const (
 	FlapFoldPolicyAutoValue FlapFoldPolicy = 2
 )
 
-// Describes transitions types of a [class@Flap] widget.
+// String returns the C name of the value, e.g. for debugging output
+func (x FlapFoldPolicy) String() string {
+	switch x {
+	case FlapFoldPolicyNeverValue:
+		return "ADW_FLAP_FOLD_POLICY_NEVER"
+	case FlapFoldPolicyAlwaysValue:
+		return "ADW_FLAP_FOLD_POLICY_ALWAYS"
+	case FlapFoldPolicyAutoValue:
+		return "ADW_FLAP_FOLD_POLICY_AUTO"
+	}
+	return fmt.Sprintf("FlapFoldPolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FlapFoldPolicy) Nick() string {
+	switch x {
+	case FlapFoldPolicyNeverValue:
+		return "never"
+	case FlapFoldPolicyAlwaysValue:
+		return "always"
+	case FlapFoldPolicyAutoValue:
+		return "auto"
+	}
+	return ""
+}
+
+// FlapFoldPolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FlapFoldPolicyFromString(s string) (FlapFoldPolicy, bool) {
+	switch s {
+	case "ADW_FLAP_FOLD_POLICY_NEVER", "never":
+		return FlapFoldPolicyNeverValue, true
+	case "ADW_FLAP_FOLD_POLICY_ALWAYS", "always":
+		return FlapFoldPolicyAlwaysValue, true
+	case "ADW_FLAP_FOLD_POLICY_AUTO", "auto":
+		return FlapFoldPolicyAutoValue, true
+	}
+	return 0, false
+}
+
+// Describes transitions types of a Flap widget.
 //
 // It determines the type of animation when transitioning between children in a
-// [class@Flap] widget, as well as which areas can be swiped via
-// [property@Flap:swipe-to-open] and [property@Flap:swipe-to-close].
+// Flap widget, as well as which areas can be swiped via
+// Flap:swipe-to-open and Flap:swipe-to-close.
+//
+// Deprecated: since 1.4. See the migration guide
 type FlapTransitionType int
 
 var xFlapTransitionTypeGLibType func() types.GType
@@ -71,67 +114,95 @@ const (
 	FlapTransitionTypeSlideValue FlapTransitionType = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FlapTransitionType) String() string {
+	switch x {
+	case FlapTransitionTypeOverValue:
+		return "ADW_FLAP_TRANSITION_TYPE_OVER"
+	case FlapTransitionTypeUnderValue:
+		return "ADW_FLAP_TRANSITION_TYPE_UNDER"
+	case FlapTransitionTypeSlideValue:
+		return "ADW_FLAP_TRANSITION_TYPE_SLIDE"
+	}
+	return fmt.Sprintf("FlapTransitionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FlapTransitionType) Nick() string {
+	switch x {
+	case FlapTransitionTypeOverValue:
+		return "over"
+	case FlapTransitionTypeUnderValue:
+		return "under"
+	case FlapTransitionTypeSlideValue:
+		return "slide"
+	}
+	return ""
+}
+
+// FlapTransitionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FlapTransitionTypeFromString(s string) (FlapTransitionType, bool) {
+	switch s {
+	case "ADW_FLAP_TRANSITION_TYPE_OVER", "over":
+		return FlapTransitionTypeOverValue, true
+	case "ADW_FLAP_TRANSITION_TYPE_UNDER", "under":
+		return FlapTransitionTypeUnderValue, true
+	case "ADW_FLAP_TRANSITION_TYPE_SLIDE", "slide":
+		return FlapTransitionTypeSlideValue, true
+	}
+	return 0, false
+}
+
 // An adaptive container acting like a box or an overlay.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="flap-wide-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="flap-wide.png" alt="flap-wide"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="flap-narrow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="flap-narrow.png" alt="flap-narrow"&gt;
+// The `AdwFlap` widget can display its children like a [gtk.Box] does or
+// like a [gtk.Overlay] does, according to the
+// Flap:fold-policy value.
 //
-// &lt;/picture&gt;
-//
-// The `AdwFlap` widget can display its children like a [class@Gtk.Box] does or
-// like a [class@Gtk.Overlay] does, according to the
-// [property@Flap:fold-policy] value.
-//
-// `AdwFlap` has at most three children: [property@Flap:content],
-// [property@Flap:flap] and [property@Flap:separator]. Content is the primary
+// `AdwFlap` has at most three children: Flap:content,
+// Flap:flap and Flap:separator. Content is the primary
 // child, flap is displayed next to it when unfolded, or overlays it when
 // folded. Flap can be shown or hidden by changing the
-// [property@Flap:reveal-flap] value, as well as via swipe gestures if
-// [property@Flap:swipe-to-open] and/or [property@Flap:swipe-to-close] are set
+// Flap:reveal-flap value, as well as via swipe gestures if
+// Flap:swipe-to-open and/or Flap:swipe-to-close are set
 // to `TRUE`.
 //
 // Optionally, a separator can be provided, which would be displayed between
 // the content and the flap when there's no shadow to separate them, depending
 // on the transition type.
 //
-// [property@Flap:flap] is transparent by default; add the
-// [`.background`](style-classes.html#background) style class to it if this is
+// Flap:flap is transparent by default; add the
+// .background style class to it if this is
 // unwanted.
 //
-// If [property@Flap:modal] is set to `TRUE`, content becomes completely
+// If Flap:modal is set to `TRUE`, content becomes completely
 // inaccessible when the flap is revealed while folded.
 //
 // The position of the flap and separator children relative to the content is
-// determined by orientation, as well as the [property@Flap:flap-position]
+// determined by orientation, as well as the Flap:flap-position
 // value.
 //
 // Folding the flap will automatically hide the flap widget, and unfolding it
 // will automatically reveal it. If this behavior is not desired, the
-// [property@Flap:locked] property can be used to override it.
+// Flap:locked property can be used to override it.
 //
 // Common use cases include sidebars, header bars that need to be able to
 // overlap the window content (for example, in fullscreen mode) and bottom
 // sheets.
 //
-// ## AdwFlap as GtkBuildable
+// # AdwFlap as GtkBuildable
 //
-// The `AdwFlap` implementation of the [iface@Gtk.Buildable] interface supports
+// The `AdwFlap` implementation of the [gtk.Buildable] interface supports
 // setting the flap child by specifying “flap” as the “type” attribute of a
-// `&lt;child&gt;` element, and separator by specifying “separator”. Specifying
+// `<child>` element, and separator by specifying “separator”. Specifying
 // “content” child type or omitting it results in setting the content child.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwFlap` has a single CSS node with name `flap`. The node will get the style
 // classes `.folded` when it is folded, and `.unfolded` when it's not.
+//
+// Deprecated: since 1.4. See the migration guide
 type Flap struct {
 	gtk.Widget
 }
@@ -148,366 +219,6 @@ func FlapNewFromInternalPtr(ptr uintptr) *Flap {
 	return cls
 }
 
-var xNewFlap func() uintptr
-
-// Creates a new `AdwFlap`.
-func NewFlap() *Flap {
-	var cls *Flap
-
-	cret := xNewFlap()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Flap{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFlapGetContent func(uintptr) uintptr
-
-// Gets the content widget for @self.
-func (x *Flap) GetContent() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xFlapGetContent(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFlapGetFlap func(uintptr) uintptr
-
-// Gets the flap widget for @self.
-func (x *Flap) GetFlap() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xFlapGetFlap(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFlapGetFlapPosition func(uintptr) gtk.PackType
-
-// Gets the flap position for @self.
-func (x *Flap) GetFlapPosition() gtk.PackType {
-
-	cret := xFlapGetFlapPosition(x.GoPointer())
-	return cret
-}
-
-var xFlapGetFoldDuration func(uintptr) uint
-
-// Gets the fold transition animation duration for @self, in milliseconds.
-func (x *Flap) GetFoldDuration() uint {
-
-	cret := xFlapGetFoldDuration(x.GoPointer())
-	return cret
-}
-
-var xFlapGetFoldPolicy func(uintptr) FlapFoldPolicy
-
-// Gets the fold policy for @self.
-func (x *Flap) GetFoldPolicy() FlapFoldPolicy {
-
-	cret := xFlapGetFoldPolicy(x.GoPointer())
-	return cret
-}
-
-var xFlapGetFoldThresholdPolicy func(uintptr) FoldThresholdPolicy
-
-// Gets the fold threshold policy for @self.
-func (x *Flap) GetFoldThresholdPolicy() FoldThresholdPolicy {
-
-	cret := xFlapGetFoldThresholdPolicy(x.GoPointer())
-	return cret
-}
-
-var xFlapGetFolded func(uintptr) bool
-
-// Gets whether @self is currently folded.
-//
-// See [property@Flap:fold-policy].
-func (x *Flap) GetFolded() bool {
-
-	cret := xFlapGetFolded(x.GoPointer())
-	return cret
-}
-
-var xFlapGetLocked func(uintptr) bool
-
-// Gets whether @self is locked.
-func (x *Flap) GetLocked() bool {
-
-	cret := xFlapGetLocked(x.GoPointer())
-	return cret
-}
-
-var xFlapGetModal func(uintptr) bool
-
-// Gets whether @self is modal.
-func (x *Flap) GetModal() bool {
-
-	cret := xFlapGetModal(x.GoPointer())
-	return cret
-}
-
-var xFlapGetRevealFlap func(uintptr) bool
-
-// Gets whether the flap widget is revealed for @self.
-func (x *Flap) GetRevealFlap() bool {
-
-	cret := xFlapGetRevealFlap(x.GoPointer())
-	return cret
-}
-
-var xFlapGetRevealParams func(uintptr) *SpringParams
-
-// Gets the reveal animation spring parameters for @self.
-func (x *Flap) GetRevealParams() *SpringParams {
-
-	cret := xFlapGetRevealParams(x.GoPointer())
-	return cret
-}
-
-var xFlapGetRevealProgress func(uintptr) float64
-
-// Gets the current reveal progress for @self.
-//
-// 0 means fully hidden, 1 means fully revealed.
-//
-// See [property@Flap:reveal-flap].
-func (x *Flap) GetRevealProgress() float64 {
-
-	cret := xFlapGetRevealProgress(x.GoPointer())
-	return cret
-}
-
-var xFlapGetSeparator func(uintptr) uintptr
-
-// Gets the separator widget for @self.
-func (x *Flap) GetSeparator() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xFlapGetSeparator(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFlapGetSwipeToClose func(uintptr) bool
-
-// Gets whether @self can be closed with a swipe gesture.
-func (x *Flap) GetSwipeToClose() bool {
-
-	cret := xFlapGetSwipeToClose(x.GoPointer())
-	return cret
-}
-
-var xFlapGetSwipeToOpen func(uintptr) bool
-
-// Gets whether @self can be opened with a swipe gesture.
-func (x *Flap) GetSwipeToOpen() bool {
-
-	cret := xFlapGetSwipeToOpen(x.GoPointer())
-	return cret
-}
-
-var xFlapGetTransitionType func(uintptr) FlapTransitionType
-
-// Gets the type of animation used for reveal and fold transitions in @self.
-func (x *Flap) GetTransitionType() FlapTransitionType {
-
-	cret := xFlapGetTransitionType(x.GoPointer())
-	return cret
-}
-
-var xFlapSetContent func(uintptr, uintptr)
-
-// Sets the content widget for @self.
-//
-// It's always displayed when unfolded, and partially visible when folded.
-func (x *Flap) SetContent(ContentVar *gtk.Widget) {
-
-	xFlapSetContent(x.GoPointer(), ContentVar.GoPointer())
-
-}
-
-var xFlapSetFlap func(uintptr, uintptr)
-
-// Sets the flap widget for @self.
-//
-// It's only visible when [property@Flap:reveal-progress] is greater than 0.
-func (x *Flap) SetFlap(FlapVar *gtk.Widget) {
-
-	xFlapSetFlap(x.GoPointer(), FlapVar.GoPointer())
-
-}
-
-var xFlapSetFlapPosition func(uintptr, gtk.PackType)
-
-// Sets the flap position for @self.
-//
-// If it's set to `GTK_PACK_START`, the flap is displayed before the content,
-// if `GTK_PACK_END`, it's displayed after the content.
-func (x *Flap) SetFlapPosition(PositionVar gtk.PackType) {
-
-	xFlapSetFlapPosition(x.GoPointer(), PositionVar)
-
-}
-
-var xFlapSetFoldDuration func(uintptr, uint)
-
-// Sets the fold transition animation duration for @self, in milliseconds.
-func (x *Flap) SetFoldDuration(DurationVar uint) {
-
-	xFlapSetFoldDuration(x.GoPointer(), DurationVar)
-
-}
-
-var xFlapSetFoldPolicy func(uintptr, FlapFoldPolicy)
-
-// Sets the fold policy for @self.
-func (x *Flap) SetFoldPolicy(PolicyVar FlapFoldPolicy) {
-
-	xFlapSetFoldPolicy(x.GoPointer(), PolicyVar)
-
-}
-
-var xFlapSetFoldThresholdPolicy func(uintptr, FoldThresholdPolicy)
-
-// Sets the fold threshold policy for @self.
-//
-// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, flap will only fold when the
-// children cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`, it
-// will fold as soon as children don't get their natural size.
-//
-// This can be useful if you have a long ellipsizing label and want to let it
-// ellipsize instead of immediately folding.
-func (x *Flap) SetFoldThresholdPolicy(PolicyVar FoldThresholdPolicy) {
-
-	xFlapSetFoldThresholdPolicy(x.GoPointer(), PolicyVar)
-
-}
-
-var xFlapSetLocked func(uintptr, bool)
-
-// Sets whether @self is locked.
-//
-// If `FALSE`, folding when the flap is revealed automatically closes it, and
-// unfolding it when the flap is not revealed opens it. If `TRUE`,
-// [property@Flap:reveal-flap] value never changes on its own.
-func (x *Flap) SetLocked(LockedVar bool) {
-
-	xFlapSetLocked(x.GoPointer(), LockedVar)
-
-}
-
-var xFlapSetModal func(uintptr, bool)
-
-// Sets whether @self is modal.
-//
-// If `TRUE`, clicking the content widget while flap is revealed, as well as
-// pressing the &lt;kbd&gt;Esc&lt;/kbd&gt; key, will close the flap. If `FALSE`, clicks are
-// passed through to the content widget.
-func (x *Flap) SetModal(ModalVar bool) {
-
-	xFlapSetModal(x.GoPointer(), ModalVar)
-
-}
-
-var xFlapSetRevealFlap func(uintptr, bool)
-
-// Sets whether the flap widget is revealed for @self.
-func (x *Flap) SetRevealFlap(RevealFlapVar bool) {
-
-	xFlapSetRevealFlap(x.GoPointer(), RevealFlapVar)
-
-}
-
-var xFlapSetRevealParams func(uintptr, *SpringParams)
-
-// Sets the reveal animation spring parameters for @self.
-//
-// The default value is equivalent to:
-//
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
-func (x *Flap) SetRevealParams(ParamsVar *SpringParams) {
-
-	xFlapSetRevealParams(x.GoPointer(), ParamsVar)
-
-}
-
-var xFlapSetSeparator func(uintptr, uintptr)
-
-// Sets the separator widget for @self.
-//
-// It's displayed between content and flap when there's no shadow to display.
-// When exactly it's visible depends on the [property@Flap:transition-type]
-// value.
-func (x *Flap) SetSeparator(SeparatorVar *gtk.Widget) {
-
-	xFlapSetSeparator(x.GoPointer(), SeparatorVar.GoPointer())
-
-}
-
-var xFlapSetSwipeToClose func(uintptr, bool)
-
-// Sets whether @self can be closed with a swipe gesture.
-//
-// The area that can be swiped depends on the [property@Flap:transition-type]
-// value.
-func (x *Flap) SetSwipeToClose(SwipeToCloseVar bool) {
-
-	xFlapSetSwipeToClose(x.GoPointer(), SwipeToCloseVar)
-
-}
-
-var xFlapSetSwipeToOpen func(uintptr, bool)
-
-// Sets whether @self can be opened with a swipe gesture.
-//
-// The area that can be swiped depends on the [property@Flap:transition-type]
-// value.
-func (x *Flap) SetSwipeToOpen(SwipeToOpenVar bool) {
-
-	xFlapSetSwipeToOpen(x.GoPointer(), SwipeToOpenVar)
-
-}
-
-var xFlapSetTransitionType func(uintptr, FlapTransitionType)
-
-// Sets the type of animation used for reveal and fold transitions in @self.
-//
-// [property@Flap:flap] is transparent by default, which means the content will
-// be seen through it with `ADW_FLAP_TRANSITION_TYPE_OVER` transitions; add the
-// [`.background`](style-classes.html#background) style class to it if this is
-// unwanted.
-func (x *Flap) SetTransitionType(TransitionTypeVar FlapTransitionType) {
-
-	xFlapSetTransitionType(x.GoPointer(), TransitionTypeVar)
-
-}
-
 func (c *Flap) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -521,6 +232,8 @@ func (c *Flap) SetGoPointer(ptr uintptr) {
 
 // SetPropertyFoldDuration sets the "fold-duration" property.
 // The fold transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertyFoldDuration(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -530,6 +243,8 @@ func (x *Flap) SetPropertyFoldDuration(value uint) {
 
 // GetPropertyFoldDuration gets the "fold-duration" property.
 // The fold transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyFoldDuration() uint {
 	var v gobject.Value
 	x.GetProperty("fold-duration", &v)
@@ -539,7 +254,9 @@ func (x *Flap) GetPropertyFoldDuration() uint {
 // GetPropertyFolded gets the "folded" property.
 // Whether the flap is currently folded.
 //
-// See [property@Flap:fold-policy].
+// See Flap:fold-policy.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyFolded() bool {
 	var v gobject.Value
 	x.GetProperty("folded", &v)
@@ -551,7 +268,9 @@ func (x *Flap) GetPropertyFolded() bool {
 //
 // If `FALSE`, folding when the flap is revealed automatically closes it, and
 // unfolding it when the flap is not revealed opens it. If `TRUE`,
-// [property@Flap:reveal-flap] value never changes on its own.
+// Flap:reveal-flap value never changes on its own.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertyLocked(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -564,7 +283,9 @@ func (x *Flap) SetPropertyLocked(value bool) {
 //
 // If `FALSE`, folding when the flap is revealed automatically closes it, and
 // unfolding it when the flap is not revealed opens it. If `TRUE`,
-// [property@Flap:reveal-flap] value never changes on its own.
+// Flap:reveal-flap value never changes on its own.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyLocked() bool {
 	var v gobject.Value
 	x.GetProperty("locked", &v)
@@ -575,8 +296,10 @@ func (x *Flap) GetPropertyLocked() bool {
 // Whether the flap is modal.
 //
 // If `TRUE`, clicking the content widget while flap is revealed, as well as
-// pressing the &lt;kbd&gt;Esc&lt;/kbd&gt; key, will close the flap. If `FALSE`, clicks
+// pressing the Esc key, will close the flap. If `FALSE`, clicks
 // are passed through to the content widget.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertyModal(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -588,8 +311,10 @@ func (x *Flap) SetPropertyModal(value bool) {
 // Whether the flap is modal.
 //
 // If `TRUE`, clicking the content widget while flap is revealed, as well as
-// pressing the &lt;kbd&gt;Esc&lt;/kbd&gt; key, will close the flap. If `FALSE`, clicks
+// pressing the Esc key, will close the flap. If `FALSE`, clicks
 // are passed through to the content widget.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyModal() bool {
 	var v gobject.Value
 	x.GetProperty("modal", &v)
@@ -598,6 +323,8 @@ func (x *Flap) GetPropertyModal() bool {
 
 // SetPropertyRevealFlap sets the "reveal-flap" property.
 // Whether the flap widget is revealed.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertyRevealFlap(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -607,6 +334,8 @@ func (x *Flap) SetPropertyRevealFlap(value bool) {
 
 // GetPropertyRevealFlap gets the "reveal-flap" property.
 // Whether the flap widget is revealed.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyRevealFlap() bool {
 	var v gobject.Value
 	x.GetProperty("reveal-flap", &v)
@@ -618,9 +347,9 @@ func (x *Flap) GetPropertyRevealFlap() bool {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertyRevealParams(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -633,9 +362,9 @@ func (x *Flap) SetPropertyRevealParams(value uintptr) {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyRevealParams() uintptr {
 	var v gobject.Value
 	x.GetProperty("reveal-params", &v)
@@ -647,7 +376,9 @@ func (x *Flap) GetPropertyRevealParams() uintptr {
 //
 // 0 means fully hidden, 1 means fully revealed.
 //
-// See [property@Flap:reveal-flap].
+// See Flap:reveal-flap.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertyRevealProgress() float64 {
 	var v gobject.Value
 	x.GetProperty("reveal-progress", &v)
@@ -657,8 +388,10 @@ func (x *Flap) GetPropertyRevealProgress() float64 {
 // SetPropertySwipeToClose sets the "swipe-to-close" property.
 // Whether the flap can be closed with a swipe gesture.
 //
-// The area that can be swiped depends on the [property@Flap:transition-type]
+// The area that can be swiped depends on the Flap:transition-type
 // value.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertySwipeToClose(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -669,8 +402,10 @@ func (x *Flap) SetPropertySwipeToClose(value bool) {
 // GetPropertySwipeToClose gets the "swipe-to-close" property.
 // Whether the flap can be closed with a swipe gesture.
 //
-// The area that can be swiped depends on the [property@Flap:transition-type]
+// The area that can be swiped depends on the Flap:transition-type
 // value.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertySwipeToClose() bool {
 	var v gobject.Value
 	x.GetProperty("swipe-to-close", &v)
@@ -680,8 +415,10 @@ func (x *Flap) GetPropertySwipeToClose() bool {
 // SetPropertySwipeToOpen sets the "swipe-to-open" property.
 // Whether the flap can be opened with a swipe gesture.
 //
-// The area that can be swiped depends on the [property@Flap:transition-type]
+// The area that can be swiped depends on the Flap:transition-type
 // value.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) SetPropertySwipeToOpen(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -692,22 +429,24 @@ func (x *Flap) SetPropertySwipeToOpen(value bool) {
 // GetPropertySwipeToOpen gets the "swipe-to-open" property.
 // Whether the flap can be opened with a swipe gesture.
 //
-// The area that can be swiped depends on the [property@Flap:transition-type]
+// The area that can be swiped depends on the Flap:transition-type
 // value.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Flap) GetPropertySwipeToOpen() bool {
 	var v gobject.Value
 	x.GetProperty("swipe-to-open", &v)
 	return v.GetBoolean()
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *Flap) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *Flap) GetDistance() float64 {
@@ -716,14 +455,14 @@ func (x *Flap) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *Flap) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -733,7 +472,7 @@ func (x *Flap) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -741,7 +480,7 @@ func (x *Flap) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *Flap) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -909,7 +648,7 @@ func (x *Flap) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -917,14 +656,13 @@ func (x *Flap) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Flap) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -948,19 +686,17 @@ func (x *Flap) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Flap) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -981,7 +717,7 @@ func (x *Flap) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -990,14 +726,12 @@ func (x *Flap) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Flap) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1016,24 +750,24 @@ func (x *Flap) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Flap) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Flap) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Flap) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -1045,7 +779,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1058,37 +792,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xFlapGLibType, libs, "adw_flap_get_type")
 
-	core.PuregoSafeRegister(&xNewFlap, libs, "adw_flap_new")
-
-	core.PuregoSafeRegister(&xFlapGetContent, libs, "adw_flap_get_content")
-	core.PuregoSafeRegister(&xFlapGetFlap, libs, "adw_flap_get_flap")
-	core.PuregoSafeRegister(&xFlapGetFlapPosition, libs, "adw_flap_get_flap_position")
-	core.PuregoSafeRegister(&xFlapGetFoldDuration, libs, "adw_flap_get_fold_duration")
-	core.PuregoSafeRegister(&xFlapGetFoldPolicy, libs, "adw_flap_get_fold_policy")
-	core.PuregoSafeRegister(&xFlapGetFoldThresholdPolicy, libs, "adw_flap_get_fold_threshold_policy")
-	core.PuregoSafeRegister(&xFlapGetFolded, libs, "adw_flap_get_folded")
-	core.PuregoSafeRegister(&xFlapGetLocked, libs, "adw_flap_get_locked")
-	core.PuregoSafeRegister(&xFlapGetModal, libs, "adw_flap_get_modal")
-	core.PuregoSafeRegister(&xFlapGetRevealFlap, libs, "adw_flap_get_reveal_flap")
-	core.PuregoSafeRegister(&xFlapGetRevealParams, libs, "adw_flap_get_reveal_params")
-	core.PuregoSafeRegister(&xFlapGetRevealProgress, libs, "adw_flap_get_reveal_progress")
-	core.PuregoSafeRegister(&xFlapGetSeparator, libs, "adw_flap_get_separator")
-	core.PuregoSafeRegister(&xFlapGetSwipeToClose, libs, "adw_flap_get_swipe_to_close")
-	core.PuregoSafeRegister(&xFlapGetSwipeToOpen, libs, "adw_flap_get_swipe_to_open")
-	core.PuregoSafeRegister(&xFlapGetTransitionType, libs, "adw_flap_get_transition_type")
-	core.PuregoSafeRegister(&xFlapSetContent, libs, "adw_flap_set_content")
-	core.PuregoSafeRegister(&xFlapSetFlap, libs, "adw_flap_set_flap")
-	core.PuregoSafeRegister(&xFlapSetFlapPosition, libs, "adw_flap_set_flap_position")
-	core.PuregoSafeRegister(&xFlapSetFoldDuration, libs, "adw_flap_set_fold_duration")
-	core.PuregoSafeRegister(&xFlapSetFoldPolicy, libs, "adw_flap_set_fold_policy")
-	core.PuregoSafeRegister(&xFlapSetFoldThresholdPolicy, libs, "adw_flap_set_fold_threshold_policy")
-	core.PuregoSafeRegister(&xFlapSetLocked, libs, "adw_flap_set_locked")
-	core.PuregoSafeRegister(&xFlapSetModal, libs, "adw_flap_set_modal")
-	core.PuregoSafeRegister(&xFlapSetRevealFlap, libs, "adw_flap_set_reveal_flap")
-	core.PuregoSafeRegister(&xFlapSetRevealParams, libs, "adw_flap_set_reveal_params")
-	core.PuregoSafeRegister(&xFlapSetSeparator, libs, "adw_flap_set_separator")
-	core.PuregoSafeRegister(&xFlapSetSwipeToClose, libs, "adw_flap_set_swipe_to_close")
-	core.PuregoSafeRegister(&xFlapSetSwipeToOpen, libs, "adw_flap_set_swipe_to_open")
-	core.PuregoSafeRegister(&xFlapSetTransitionType, libs, "adw_flap_set_transition_type")
+	gobject.CheckClassSize("AdwFlapClass", xFlapGLibType, unsafe.Sizeof(FlapClass{}))
 
 }
@@ -0,0 +1,477 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xNewFlap func() uintptr
+
+// Creates a new `AdwFlap`.
+//
+// Deprecated: since 1.4. See the migration guide
+func NewFlap() *Flap {
+	var cls *Flap
+
+	cret := xNewFlap()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Flap{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFlapGetContent func(uintptr) uintptr
+
+// Gets the content widget for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetContent() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xFlapGetContent(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFlapGetFlap func(uintptr) uintptr
+
+// Gets the flap widget for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFlap() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xFlapGetFlap(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFlapGetFlapPosition func(uintptr) gtk.PackType
+
+// Gets the flap position for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFlapPosition() gtk.PackType {
+
+	cret := xFlapGetFlapPosition(x.GoPointer())
+	return cret
+}
+
+var xFlapGetFoldDuration func(uintptr) uint
+
+// Gets the fold transition animation duration for self, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFoldDuration() uint {
+
+	cret := xFlapGetFoldDuration(x.GoPointer())
+	return cret
+}
+
+var xFlapGetFoldPolicy func(uintptr) FlapFoldPolicy
+
+// Gets the fold policy for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFoldPolicy() FlapFoldPolicy {
+
+	cret := xFlapGetFoldPolicy(x.GoPointer())
+	return cret
+}
+
+var xFlapGetFoldThresholdPolicy func(uintptr) FoldThresholdPolicy
+
+// Gets the fold threshold policy for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFoldThresholdPolicy() FoldThresholdPolicy {
+
+	cret := xFlapGetFoldThresholdPolicy(x.GoPointer())
+	return cret
+}
+
+var xFlapGetFolded func(uintptr) bool
+
+// Gets whether self is currently folded.
+//
+// See Flap:fold-policy.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetFolded() bool {
+
+	cret := xFlapGetFolded(x.GoPointer())
+	return cret
+}
+
+var xFlapGetLocked func(uintptr) bool
+
+// Gets whether self is locked.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetLocked() bool {
+
+	cret := xFlapGetLocked(x.GoPointer())
+	return cret
+}
+
+var xFlapGetModal func(uintptr) bool
+
+// Gets whether self is modal.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetModal() bool {
+
+	cret := xFlapGetModal(x.GoPointer())
+	return cret
+}
+
+var xFlapGetRevealFlap func(uintptr) bool
+
+// Gets whether the flap widget is revealed for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetRevealFlap() bool {
+
+	cret := xFlapGetRevealFlap(x.GoPointer())
+	return cret
+}
+
+var xFlapGetRevealParams func(uintptr) *SpringParams
+
+// Gets the reveal animation spring parameters for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetRevealParams() *SpringParams {
+
+	cret := xFlapGetRevealParams(x.GoPointer())
+	return cret
+}
+
+var xFlapGetRevealProgress func(uintptr) float64
+
+// Gets the current reveal progress for self.
+//
+// 0 means fully hidden, 1 means fully revealed.
+//
+// See Flap:reveal-flap.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetRevealProgress() float64 {
+
+	cret := xFlapGetRevealProgress(x.GoPointer())
+	return cret
+}
+
+var xFlapGetSeparator func(uintptr) uintptr
+
+// Gets the separator widget for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetSeparator() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xFlapGetSeparator(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFlapGetSwipeToClose func(uintptr) bool
+
+// Gets whether self can be closed with a swipe gesture.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetSwipeToClose() bool {
+
+	cret := xFlapGetSwipeToClose(x.GoPointer())
+	return cret
+}
+
+var xFlapGetSwipeToOpen func(uintptr) bool
+
+// Gets whether self can be opened with a swipe gesture.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetSwipeToOpen() bool {
+
+	cret := xFlapGetSwipeToOpen(x.GoPointer())
+	return cret
+}
+
+var xFlapGetTransitionType func(uintptr) FlapTransitionType
+
+// Gets the type of animation used for reveal and fold transitions in self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) GetTransitionType() FlapTransitionType {
+
+	cret := xFlapGetTransitionType(x.GoPointer())
+	return cret
+}
+
+var xFlapSetContent func(uintptr, uintptr)
+
+// Sets the content widget for self.
+//
+// It's always displayed when unfolded, and partially visible when folded.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetContent(ContentVar *gtk.Widget) {
+
+	xFlapSetContent(x.GoPointer(), ContentVar.GoPointer())
+
+}
+
+var xFlapSetFlap func(uintptr, uintptr)
+
+// Sets the flap widget for self.
+//
+// It's only visible when Flap:reveal-progress is greater than 0.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetFlap(FlapVar *gtk.Widget) {
+
+	xFlapSetFlap(x.GoPointer(), FlapVar.GoPointer())
+
+}
+
+var xFlapSetFlapPosition func(uintptr, gtk.PackType)
+
+// Sets the flap position for self.
+//
+// If it's set to `GTK_PACK_START`, the flap is displayed before the content,
+// if `GTK_PACK_END`, it's displayed after the content.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetFlapPosition(PositionVar gtk.PackType) {
+
+	xFlapSetFlapPosition(x.GoPointer(), PositionVar)
+
+}
+
+var xFlapSetFoldDuration func(uintptr, uint)
+
+// Sets the fold transition animation duration for self, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetFoldDuration(DurationVar uint) {
+
+	xFlapSetFoldDuration(x.GoPointer(), DurationVar)
+
+}
+
+var xFlapSetFoldPolicy func(uintptr, FlapFoldPolicy)
+
+// Sets the fold policy for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetFoldPolicy(PolicyVar FlapFoldPolicy) {
+
+	xFlapSetFoldPolicy(x.GoPointer(), PolicyVar)
+
+}
+
+var xFlapSetFoldThresholdPolicy func(uintptr, FoldThresholdPolicy)
+
+// Sets the fold threshold policy for self.
+//
+// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, flap will only fold when the
+// children cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`, it
+// will fold as soon as children don't get their natural size.
+//
+// This can be useful if you have a long ellipsizing label and want to let it
+// ellipsize instead of immediately folding.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetFoldThresholdPolicy(PolicyVar FoldThresholdPolicy) {
+
+	xFlapSetFoldThresholdPolicy(x.GoPointer(), PolicyVar)
+
+}
+
+var xFlapSetLocked func(uintptr, bool)
+
+// Sets whether self is locked.
+//
+// If `FALSE`, folding when the flap is revealed automatically closes it, and
+// unfolding it when the flap is not revealed opens it. If `TRUE`,
+// Flap:reveal-flap value never changes on its own.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetLocked(LockedVar bool) {
+
+	xFlapSetLocked(x.GoPointer(), LockedVar)
+
+}
+
+var xFlapSetModal func(uintptr, bool)
+
+// Sets whether self is modal.
+//
+// If `TRUE`, clicking the content widget while flap is revealed, as well as
+// pressing the Esc key, will close the flap. If `FALSE`, clicks are
+// passed through to the content widget.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetModal(ModalVar bool) {
+
+	xFlapSetModal(x.GoPointer(), ModalVar)
+
+}
+
+var xFlapSetRevealFlap func(uintptr, bool)
+
+// Sets whether the flap widget is revealed for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetRevealFlap(RevealFlapVar bool) {
+
+	xFlapSetRevealFlap(x.GoPointer(), RevealFlapVar)
+
+}
+
+var xFlapSetRevealParams func(uintptr, *SpringParams)
+
+// Sets the reveal animation spring parameters for self.
+//
+// The default value is equivalent to:
+//
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetRevealParams(ParamsVar *SpringParams) {
+
+	xFlapSetRevealParams(x.GoPointer(), ParamsVar)
+
+}
+
+var xFlapSetSeparator func(uintptr, uintptr)
+
+// Sets the separator widget for self.
+//
+// It's displayed between content and flap when there's no shadow to display.
+// When exactly it's visible depends on the Flap:transition-type
+// value.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetSeparator(SeparatorVar *gtk.Widget) {
+
+	xFlapSetSeparator(x.GoPointer(), SeparatorVar.GoPointer())
+
+}
+
+var xFlapSetSwipeToClose func(uintptr, bool)
+
+// Sets whether self can be closed with a swipe gesture.
+//
+// The area that can be swiped depends on the Flap:transition-type
+// value.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetSwipeToClose(SwipeToCloseVar bool) {
+
+	xFlapSetSwipeToClose(x.GoPointer(), SwipeToCloseVar)
+
+}
+
+var xFlapSetSwipeToOpen func(uintptr, bool)
+
+// Sets whether self can be opened with a swipe gesture.
+//
+// The area that can be swiped depends on the Flap:transition-type
+// value.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetSwipeToOpen(SwipeToOpenVar bool) {
+
+	xFlapSetSwipeToOpen(x.GoPointer(), SwipeToOpenVar)
+
+}
+
+var xFlapSetTransitionType func(uintptr, FlapTransitionType)
+
+// Sets the type of animation used for reveal and fold transitions in self.
+//
+// Flap:flap is transparent by default, which means the content will
+// be seen through it with `ADW_FLAP_TRANSITION_TYPE_OVER` transitions; add the
+// .background style class to it if this is
+// unwanted.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Flap) SetTransitionType(TransitionTypeVar FlapTransitionType) {
+
+	xFlapSetTransitionType(x.GoPointer(), TransitionTypeVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFlap, libs, "adw_flap_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xFlapGetContent, libs, "adw_flap_get_content", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFlap, libs, "adw_flap_get_flap", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFlapPosition, libs, "adw_flap_get_flap_position", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFoldDuration, libs, "adw_flap_get_fold_duration", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFoldPolicy, libs, "adw_flap_get_fold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFoldThresholdPolicy, libs, "adw_flap_get_fold_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetFolded, libs, "adw_flap_get_folded", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetLocked, libs, "adw_flap_get_locked", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetModal, libs, "adw_flap_get_modal", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetRevealFlap, libs, "adw_flap_get_reveal_flap", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetRevealParams, libs, "adw_flap_get_reveal_params", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetRevealProgress, libs, "adw_flap_get_reveal_progress", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetSeparator, libs, "adw_flap_get_separator", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetSwipeToClose, libs, "adw_flap_get_swipe_to_close", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetSwipeToOpen, libs, "adw_flap_get_swipe_to_open", "ADW", "")
+	core.PuregoRegisterSince(&xFlapGetTransitionType, libs, "adw_flap_get_transition_type", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetContent, libs, "adw_flap_set_content", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetFlap, libs, "adw_flap_set_flap", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetFlapPosition, libs, "adw_flap_set_flap_position", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetFoldDuration, libs, "adw_flap_set_fold_duration", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetFoldPolicy, libs, "adw_flap_set_fold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetFoldThresholdPolicy, libs, "adw_flap_set_fold_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetLocked, libs, "adw_flap_set_locked", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetModal, libs, "adw_flap_set_modal", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetRevealFlap, libs, "adw_flap_set_reveal_flap", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetRevealParams, libs, "adw_flap_set_reveal_params", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetSeparator, libs, "adw_flap_set_separator", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetSwipeToClose, libs, "adw_flap_set_swipe_to_close", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetSwipeToOpen, libs, "adw_flap_set_swipe_to_open", "ADW", "")
+	core.PuregoRegisterSince(&xFlapSetTransitionType, libs, "adw_flap_set_transition_type", "ADW", "")
+
+}
@@ -1,9 +1,16 @@
 // Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package adw
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
 
-// Determines when [class@Flap] and [class@Leaflet] will fold.
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// Determines when Flap and Leaflet will fold.
+//
+// Deprecated: since 1.4. Stop using `AdwLeaflet` and `AdwFlap`
 type FoldThresholdPolicy int
 
 var xFoldThresholdPolicyGLibType func() types.GType
@@ -19,3 +26,52 @@ const (
 	// Folding is based on the natural size
 	FoldThresholdPolicyNaturalValue FoldThresholdPolicy = 1
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x FoldThresholdPolicy) String() string {
+	switch x {
+	case FoldThresholdPolicyMinimumValue:
+		return "ADW_FOLD_THRESHOLD_POLICY_MINIMUM"
+	case FoldThresholdPolicyNaturalValue:
+		return "ADW_FOLD_THRESHOLD_POLICY_NATURAL"
+	}
+	return fmt.Sprintf("FoldThresholdPolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FoldThresholdPolicy) Nick() string {
+	switch x {
+	case FoldThresholdPolicyMinimumValue:
+		return "minimum"
+	case FoldThresholdPolicyNaturalValue:
+		return "natural"
+	}
+	return ""
+}
+
+// FoldThresholdPolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FoldThresholdPolicyFromString(s string) (FoldThresholdPolicy, bool) {
+	switch s {
+	case "ADW_FOLD_THRESHOLD_POLICY_MINIMUM", "minimum":
+		return FoldThresholdPolicyMinimumValue, true
+	case "ADW_FOLD_THRESHOLD_POLICY_NATURAL", "natural":
+		return FoldThresholdPolicyNaturalValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xFoldThresholdPolicyGLibType, libs, "adw_fold_threshold_policy_get_type")
+
+}
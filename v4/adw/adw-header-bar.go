@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,7 +22,7 @@ func (x *HeaderBarClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes title centering behavior of a [class@HeaderBar] widget.
+// Describes title centering behavior of a HeaderBar widget.
 type CenteringPolicy int
 
 var xCenteringPolicyGLibType func() types.GType
@@ -39,87 +39,109 @@ const (
 	CenteringPolicyStrictValue CenteringPolicy = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CenteringPolicy) String() string {
+	switch x {
+	case CenteringPolicyLooseValue:
+		return "ADW_CENTERING_POLICY_LOOSE"
+	case CenteringPolicyStrictValue:
+		return "ADW_CENTERING_POLICY_STRICT"
+	}
+	return fmt.Sprintf("CenteringPolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CenteringPolicy) Nick() string {
+	switch x {
+	case CenteringPolicyLooseValue:
+		return "loose"
+	case CenteringPolicyStrictValue:
+		return "strict"
+	}
+	return ""
+}
+
+// CenteringPolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CenteringPolicyFromString(s string) (CenteringPolicy, bool) {
+	switch s {
+	case "ADW_CENTERING_POLICY_LOOSE", "loose":
+		return CenteringPolicyLooseValue, true
+	case "ADW_CENTERING_POLICY_STRICT", "strict":
+		return CenteringPolicyStrictValue, true
+	}
+	return 0, false
+}
+
 // A title bar widget.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="header-bar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="header-bar.png" alt="header-bar"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwHeaderBar` is similar to [class@Gtk.HeaderBar], but provides additional
+// `AdwHeaderBar` is similar to [gtk.HeaderBar], but provides additional
 // features compared to it. Refer to `GtkHeaderBar` for details. It is typically
-// used as a top bar within [class@ToolbarView].
+// used as a top bar within ToolbarView.
 //
-// ## Dialog Integration
+// # Dialog Integration
 //
-// When placed inside an [class@Dialog], `AdwHeaderBar` will display the dialog
+// When placed inside an Dialog, `AdwHeaderBar` will display the dialog
 // title instead of window title. It will also adjust the decoration layout to
 // ensure it always has a close button and nothing else. Set
-// [property@HeaderBar:show-start-title-buttons] and
-// [property@HeaderBar:show-end-title-buttons] to `FALSE` to remove it if it's
+// HeaderBar:show-start-title-buttons and
+// HeaderBar:show-end-title-buttons to `FALSE` to remove it if it's
 // unwanted.
 //
-// ## Navigation View Integration
+// # Navigation View Integration
 //
-// When placed inside an [class@NavigationPage], `AdwHeaderBar` will display the
+// When placed inside an NavigationPage, `AdwHeaderBar` will display the
 // page title instead of window title.
 //
-// When used together with [class@NavigationView] or [class@NavigationSplitView],
+// When used together with NavigationView or NavigationSplitView,
 // it will also display a back button that can be used to go back to the previous
 // page. The button also has a context menu, allowing to pop multiple pages at
 // once, potentially across multiple navigation views.
 //
-// Set [property@HeaderBar:show-back-button] to `FALSE` to disable this behavior
+// Set HeaderBar:show-back-button to `FALSE` to disable this behavior
 // in rare scenarios where it's unwanted.
 //
-// ## Split View Integration
+// # Split View Integration
 //
-// When placed inside [class@NavigationSplitView] or [class@OverlaySplitView],
+// When placed inside NavigationSplitView or OverlaySplitView,
 // `AdwHeaderBar` will automatically hide the title buttons other than at the
 // edges of the window.
 //
-// ## Bottom Sheet Integration
+// # Bottom Sheet Integration
 //
-// When played inside [class@BottomSheet], `AdwHeaderBar` will not show the title
-// unless [property@BottomSheet:show-drag-handle] is set to `FALSE`, regardless
-// of [property@HeaderBar:show-title]. This only applies to the default title,
-// titles set with [property@HeaderBar:title-widget] will still be shown.
+// When played inside BottomSheet, `AdwHeaderBar` will not show the title
+// unless BottomSheet:show-drag-handle is set to `FALSE`, regardless
+// of HeaderBar:show-title. This only applies to the default title,
+// titles set with HeaderBar:title-widget will still be shown.
 //
-// ## Centering Policy
+// # Centering Policy
 //
-// [property@HeaderBar:centering-policy] allows to enforce strict centering of
-// the title widget. This can be useful for entries inside [class@Clamp].
+// HeaderBar:centering-policy allows to enforce strict centering of
+// the title widget. This can be useful for entries inside Clamp.
 //
-// ## Title Buttons
+// # Title Buttons
 //
 // Unlike `GtkHeaderBar`, `AdwHeaderBar` allows to toggle title button
 // visibility for each side individually, using the
-// [property@HeaderBar:show-start-title-buttons] and
-// [property@HeaderBar:show-end-title-buttons] properties.
-//
-// ## CSS nodes
-//
-// ```
-// headerbar
-// ╰── windowhandle
-//
-//	╰── box
-//	    ├── widget
-//	    │   ╰── box.start
-//	    │       ├── windowcontrols.start
-//	    │       ├── widget
-//	    │       │   ╰── [button.back]
-//	    │       ╰── [other children]
-//	    ├── widget
-//	    │   ╰── [Title Widget]
-//	    ╰── widget
-//	        ╰── box.end
-//	            ├── [other children]
-//	            ╰── windowcontrols.end
-//
-// ```
+// HeaderBar:show-start-title-buttons and
+// HeaderBar:show-end-title-buttons properties.
+//
+// # CSS nodes
+//
+//	headerbar
+//	╰── windowhandle
+//	    ╰── box
+//	        ├── widget
+//	        │   ╰── box.start
+//	        │       ├── windowcontrols.start
+//	        │       ├── widget
+//	        │       │   ╰── [button.back]
+//	        │       ╰── [other children]
+//	        ├── widget
+//	        │   ╰── [Title Widget]
+//	        ╰── widget
+//	            ╰── box.end
+//	                ├── [other children]
+//	                ╰── windowcontrols.end
 //
 // `AdwHeaderBar`'s CSS node is called `headerbar`. It contains a `windowhandle`
 // subnode, which contains a `box` subnode, which contains three `widget`
@@ -128,13 +150,13 @@ const (
 // respectively, and the center node contains a node that represents the title.
 //
 // Each of the boxes contains a `windowcontrols` subnode, see
-// [class@Gtk.WindowControls] for details, as well as other children.
+// [gtk.WindowControls] for details, as well as other children.
 //
-// When [property@HeaderBar:show-back-button] is `TRUE`, the start box also
+// When HeaderBar:show-back-button is `TRUE`, the start box also
 // contains a node with the name `widget` that contains a node with the name
 // `button` and `.back` style class.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwHeaderBar` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type HeaderBar struct {
@@ -181,7 +203,7 @@ func (x *HeaderBar) GetCenteringPolicy() CenteringPolicy {
 
 var xHeaderBarGetDecorationLayout func(uintptr) string
 
-// Gets the decoration layout for @self.
+// Gets the decoration layout for self.
 func (x *HeaderBar) GetDecorationLayout() string {
 
 	cret := xHeaderBarGetDecorationLayout(x.GoPointer())
@@ -190,7 +212,7 @@ func (x *HeaderBar) GetDecorationLayout() string {
 
 var xHeaderBarGetShowBackButton func(uintptr) bool
 
-// Gets whether @self can show the back button.
+// Gets whether self can show the back button.
 func (x *HeaderBar) GetShowBackButton() bool {
 
 	cret := xHeaderBarGetShowBackButton(x.GoPointer())
@@ -199,7 +221,7 @@ func (x *HeaderBar) GetShowBackButton() bool {
 
 var xHeaderBarGetShowEndTitleButtons func(uintptr) bool
 
-// Gets whether to show title buttons at the end of @self.
+// Gets whether to show title buttons at the end of self.
 func (x *HeaderBar) GetShowEndTitleButtons() bool {
 
 	cret := xHeaderBarGetShowEndTitleButtons(x.GoPointer())
@@ -208,7 +230,7 @@ func (x *HeaderBar) GetShowEndTitleButtons() bool {
 
 var xHeaderBarGetShowStartTitleButtons func(uintptr) bool
 
-// Gets whether to show title buttons at the start of @self.
+// Gets whether to show title buttons at the start of self.
 func (x *HeaderBar) GetShowStartTitleButtons() bool {
 
 	cret := xHeaderBarGetShowStartTitleButtons(x.GoPointer())
@@ -226,7 +248,7 @@ func (x *HeaderBar) GetShowTitle() bool {
 
 var xHeaderBarGetTitleWidget func(uintptr) uintptr
 
-// Gets the title widget widget of @self.
+// Gets the title widget widget of self.
 func (x *HeaderBar) GetTitleWidget() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -243,7 +265,7 @@ func (x *HeaderBar) GetTitleWidget() *gtk.Widget {
 
 var xHeaderBarPackEnd func(uintptr, uintptr)
 
-// Adds @child to @self, packed with reference to the end of @self.
+// Adds child to self, packed with reference to the end of self.
 func (x *HeaderBar) PackEnd(ChildVar *gtk.Widget) {
 
 	xHeaderBarPackEnd(x.GoPointer(), ChildVar.GoPointer())
@@ -252,7 +274,7 @@ func (x *HeaderBar) PackEnd(ChildVar *gtk.Widget) {
 
 var xHeaderBarPackStart func(uintptr, uintptr)
 
-// Adds @child to @self, packed with reference to the start of the @self.
+// Adds child to self, packed with reference to the start of the self.
 func (x *HeaderBar) PackStart(ChildVar *gtk.Widget) {
 
 	xHeaderBarPackStart(x.GoPointer(), ChildVar.GoPointer())
@@ -261,10 +283,10 @@ func (x *HeaderBar) PackStart(ChildVar *gtk.Widget) {
 
 var xHeaderBarRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 //
-// The child must have been added with [method@HeaderBar.pack_start],
-// [method@HeaderBar.pack_end] or [property@HeaderBar:title-widget].
+// The child must have been added with pack_start,
+// pack_end or HeaderBar:title-widget.
 func (x *HeaderBar) Remove(ChildVar *gtk.Widget) {
 
 	xHeaderBarRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -282,10 +304,10 @@ func (x *HeaderBar) SetCenteringPolicy(CenteringPolicyVar CenteringPolicy) {
 
 var xHeaderBarSetDecorationLayout func(uintptr, uintptr)
 
-// Sets the decoration layout for @self.
+// Sets the decoration layout for self.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [gtk.Settings.GetPropertyGtkDecorationLayout] setting is used.
 //
 // The format of the string is button names, separated by commas. A colon
 // separates the buttons that should appear at the start from those at the end.
@@ -305,10 +327,10 @@ func (x *HeaderBar) SetDecorationLayout(LayoutVar *string) {
 
 var xHeaderBarSetShowBackButton func(uintptr, bool)
 
-// Sets whether @self can show the back button.
+// Sets whether self can show the back button.
 //
 // The back button will never be shown unless the header bar is placed inside an
-// [class@NavigationView]. Usually, there is no reason to set it to `FALSE`.
+// NavigationView. Usually, there is no reason to set it to `FALSE`.
 func (x *HeaderBar) SetShowBackButton(ShowBackButtonVar bool) {
 
 	xHeaderBarSetShowBackButton(x.GoPointer(), ShowBackButtonVar)
@@ -317,12 +339,12 @@ func (x *HeaderBar) SetShowBackButton(ShowBackButtonVar bool) {
 
 var xHeaderBarSetShowEndTitleButtons func(uintptr, bool)
 
-// Sets whether to show title buttons at the end of @self.
+// Sets whether to show title buttons at the end of self.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be closed).
 func (x *HeaderBar) SetShowEndTitleButtons(SettingVar bool) {
 
@@ -332,12 +354,12 @@ func (x *HeaderBar) SetShowEndTitleButtons(SettingVar bool) {
 
 var xHeaderBarSetShowStartTitleButtons func(uintptr, bool)
 
-// Sets whether to show title buttons at the start of @self.
+// Sets whether to show title buttons at the start of self.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be closed).
 func (x *HeaderBar) SetShowStartTitleButtons(SettingVar bool) {
 
@@ -356,24 +378,20 @@ func (x *HeaderBar) SetShowTitle(ShowTitleVar bool) {
 
 var xHeaderBarSetTitleWidget func(uintptr, uintptr)
 
-// Sets the title widget for @self.
+// Sets the title widget for self.
 //
 // When set to `NULL`, the header bar will display the title of the window it
 // is contained in.
 //
-// To use a different title, use [class@WindowTitle]:
+// To use a different title, use WindowTitle:
 //
-// ```xml
-// &lt;object class="AdwHeaderBar"&gt;
-//
-//	&lt;property name="title-widget"&gt;
-//	  &lt;object class="AdwWindowTitle"&gt;
-//	    &lt;property name="title" translatable="yes"&gt;Title&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwHeaderBar">
+//	  <property name="title-widget">
+//	    <object class="AdwWindowTitle">
+//	      <property name="title" translatable="yes">Title</property>
+//	    </object>
+//	  </property>
+//	</object>
 func (x *HeaderBar) SetTitleWidget(TitleWidgetVar *gtk.Widget) {
 
 	xHeaderBarSetTitleWidget(x.GoPointer(), TitleWidgetVar.GoPointer())
@@ -395,7 +413,7 @@ func (c *HeaderBar) SetGoPointer(ptr uintptr) {
 // The decoration layout for buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [gtk.Settings.GetPropertyGtkDecorationLayout] setting is used.
 //
 // The format of the string is button names, separated by commas. A colon
 // separates the buttons that should appear at the start from those at the
@@ -415,7 +433,7 @@ func (x *HeaderBar) SetPropertyDecorationLayout(value string) {
 // The decoration layout for buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [gtk.Settings.GetPropertyGtkDecorationLayout] setting is used.
 //
 // The format of the string is button names, separated by commas. A colon
 // separates the buttons that should appear at the start from those at the
@@ -434,7 +452,7 @@ func (x *HeaderBar) GetPropertyDecorationLayout() string {
 // Whether the header bar can show the back button.
 //
 // The back button will never be shown unless the header bar is placed inside an
-// [class@NavigationView]. Usually, there is no reason to set this to `FALSE`.
+// NavigationView. Usually, there is no reason to set this to `FALSE`.
 func (x *HeaderBar) SetPropertyShowBackButton(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -446,7 +464,7 @@ func (x *HeaderBar) SetPropertyShowBackButton(value bool) {
 // Whether the header bar can show the back button.
 //
 // The back button will never be shown unless the header bar is placed inside an
-// [class@NavigationView]. Usually, there is no reason to set this to `FALSE`.
+// NavigationView. Usually, there is no reason to set this to `FALSE`.
 func (x *HeaderBar) GetPropertyShowBackButton() bool {
 	var v gobject.Value
 	x.GetProperty("show-back-button", &v)
@@ -456,10 +474,10 @@ func (x *HeaderBar) GetPropertyShowBackButton() bool {
 // SetPropertyShowEndTitleButtons sets the "show-end-title-buttons" property.
 // Whether to show title buttons at the end of the header bar.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be
 // closed).
 func (x *HeaderBar) SetPropertyShowEndTitleButtons(value bool) {
@@ -472,10 +490,10 @@ func (x *HeaderBar) SetPropertyShowEndTitleButtons(value bool) {
 // GetPropertyShowEndTitleButtons gets the "show-end-title-buttons" property.
 // Whether to show title buttons at the end of the header bar.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be
 // closed).
 func (x *HeaderBar) GetPropertyShowEndTitleButtons() bool {
@@ -487,10 +505,10 @@ func (x *HeaderBar) GetPropertyShowEndTitleButtons() bool {
 // SetPropertyShowStartTitleButtons sets the "show-start-title-buttons" property.
 // Whether to show title buttons at the start of the header bar.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be
 // closed).
 func (x *HeaderBar) SetPropertyShowStartTitleButtons(value bool) {
@@ -503,10 +521,10 @@ func (x *HeaderBar) SetPropertyShowStartTitleButtons(value bool) {
 // GetPropertyShowStartTitleButtons gets the "show-start-title-buttons" property.
 // Whether to show title buttons at the start of the header bar.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 //
 // Which buttons are actually shown and where is determined by the
-// [property@HeaderBar:decoration-layout] property, and by the state of the
+// HeaderBar:decoration-layout property, and by the state of the
 // window (e.g. a close button will not be shown if the window can't be
 // closed).
 func (x *HeaderBar) GetPropertyShowStartTitleButtons() bool {
@@ -693,7 +711,7 @@ func (x *HeaderBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -701,14 +719,13 @@ func (x *HeaderBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -732,19 +749,17 @@ func (x *HeaderBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -765,7 +780,7 @@ func (x *HeaderBar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -774,14 +789,12 @@ func (x *HeaderBar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -800,10 +813,10 @@ func (x *HeaderBar) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *HeaderBar) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -815,7 +828,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -826,24 +839,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xHeaderBarGLibType, libs, "adw_header_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewHeaderBar, libs, "adw_header_bar_new")
-
-	core.PuregoSafeRegister(&xHeaderBarGetCenteringPolicy, libs, "adw_header_bar_get_centering_policy")
-	core.PuregoSafeRegister(&xHeaderBarGetDecorationLayout, libs, "adw_header_bar_get_decoration_layout")
-	core.PuregoSafeRegister(&xHeaderBarGetShowBackButton, libs, "adw_header_bar_get_show_back_button")
-	core.PuregoSafeRegister(&xHeaderBarGetShowEndTitleButtons, libs, "adw_header_bar_get_show_end_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarGetShowStartTitleButtons, libs, "adw_header_bar_get_show_start_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarGetShowTitle, libs, "adw_header_bar_get_show_title")
-	core.PuregoSafeRegister(&xHeaderBarGetTitleWidget, libs, "adw_header_bar_get_title_widget")
-	core.PuregoSafeRegister(&xHeaderBarPackEnd, libs, "adw_header_bar_pack_end")
-	core.PuregoSafeRegister(&xHeaderBarPackStart, libs, "adw_header_bar_pack_start")
-	core.PuregoSafeRegister(&xHeaderBarRemove, libs, "adw_header_bar_remove")
-	core.PuregoSafeRegister(&xHeaderBarSetCenteringPolicy, libs, "adw_header_bar_set_centering_policy")
-	core.PuregoSafeRegister(&xHeaderBarSetDecorationLayout, libs, "adw_header_bar_set_decoration_layout")
-	core.PuregoSafeRegister(&xHeaderBarSetShowBackButton, libs, "adw_header_bar_set_show_back_button")
-	core.PuregoSafeRegister(&xHeaderBarSetShowEndTitleButtons, libs, "adw_header_bar_set_show_end_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarSetShowStartTitleButtons, libs, "adw_header_bar_set_show_start_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarSetShowTitle, libs, "adw_header_bar_set_show_title")
-	core.PuregoSafeRegister(&xHeaderBarSetTitleWidget, libs, "adw_header_bar_set_title_widget")
+	gobject.CheckClassSize("AdwHeaderBarClass", xHeaderBarGLibType, unsafe.Sizeof(HeaderBarClass{}))
+
+	core.PuregoRegisterSince(&xNewHeaderBar, libs, "adw_header_bar_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xHeaderBarGetCenteringPolicy, libs, "adw_header_bar_get_centering_policy", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarGetDecorationLayout, libs, "adw_header_bar_get_decoration_layout", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarGetShowBackButton, libs, "adw_header_bar_get_show_back_button", "ADW", "1.4")
+	core.PuregoRegisterSince(&xHeaderBarGetShowEndTitleButtons, libs, "adw_header_bar_get_show_end_title_buttons", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarGetShowStartTitleButtons, libs, "adw_header_bar_get_show_start_title_buttons", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarGetShowTitle, libs, "adw_header_bar_get_show_title", "ADW", "1.4")
+	core.PuregoRegisterSince(&xHeaderBarGetTitleWidget, libs, "adw_header_bar_get_title_widget", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarPackEnd, libs, "adw_header_bar_pack_end", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarPackStart, libs, "adw_header_bar_pack_start", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarRemove, libs, "adw_header_bar_remove", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarSetCenteringPolicy, libs, "adw_header_bar_set_centering_policy", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarSetDecorationLayout, libs, "adw_header_bar_set_decoration_layout", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarSetShowBackButton, libs, "adw_header_bar_set_show_back_button", "ADW", "1.4")
+	core.PuregoRegisterSince(&xHeaderBarSetShowEndTitleButtons, libs, "adw_header_bar_set_show_end_title_buttons", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarSetShowStartTitleButtons, libs, "adw_header_bar_set_show_start_title_buttons", "ADW", "")
+	core.PuregoRegisterSince(&xHeaderBarSetShowTitle, libs, "adw_header_bar_set_show_title", "ADW", "1.4")
+	core.PuregoRegisterSince(&xHeaderBarSetTitleWidget, libs, "adw_header_bar_set_title_widget", "ADW", "")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,14 +22,7 @@ func (x *InlineViewSwitcherClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes what [class@InlineViewSwitcher] toggles display.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="inline-view-switcher-display-modes-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="inline-view-switcher-display-modes.png" alt="inline-view-switcher-display-modes"&gt;
-//
-// &lt;/picture&gt;
+// Describes what InlineViewSwitcher toggles display.
 type InlineViewSwitcherDisplayMode int
 
 var xInlineViewSwitcherDisplayModeGLibType func() types.GType
@@ -48,46 +41,64 @@ const (
 	InlineViewSwitcherBothValue InlineViewSwitcherDisplayMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x InlineViewSwitcherDisplayMode) String() string {
+	switch x {
+	case InlineViewSwitcherLabelsValue:
+		return "ADW_INLINE_VIEW_SWITCHER_LABELS"
+	case InlineViewSwitcherIconsValue:
+		return "ADW_INLINE_VIEW_SWITCHER_ICONS"
+	case InlineViewSwitcherBothValue:
+		return "ADW_INLINE_VIEW_SWITCHER_BOTH"
+	}
+	return fmt.Sprintf("InlineViewSwitcherDisplayMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InlineViewSwitcherDisplayMode) Nick() string {
+	switch x {
+	case InlineViewSwitcherLabelsValue:
+		return "labels"
+	case InlineViewSwitcherIconsValue:
+		return "icons"
+	case InlineViewSwitcherBothValue:
+		return "both"
+	}
+	return ""
+}
+
+// InlineViewSwitcherDisplayModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InlineViewSwitcherDisplayModeFromString(s string) (InlineViewSwitcherDisplayMode, bool) {
+	switch s {
+	case "ADW_INLINE_VIEW_SWITCHER_LABELS", "labels":
+		return InlineViewSwitcherLabelsValue, true
+	case "ADW_INLINE_VIEW_SWITCHER_ICONS", "icons":
+		return InlineViewSwitcherIconsValue, true
+	case "ADW_INLINE_VIEW_SWITCHER_BOTH", "both":
+		return InlineViewSwitcherBothValue, true
+	}
+	return 0, false
+}
+
 // A view switcher that uses a toggle group.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="inline-view-switcher-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="inline-view-switcher.png" alt="inline-view-switcher"&gt;
-//
-// &lt;/picture&gt;
-//
-// A view switcher showing pages of an [class@ViewStack] within an
-// [class@ToggleGroup], similar to [class@ViewSwitcher].
+// A view switcher showing pages of an ViewStack within an
+// ToggleGroup, similar to ViewSwitcher.
 //
 // The toggles can display either an icon, a label or both. Use the
-// [property@InlineViewSwitcher:display-mode] to control this.
-//
-// &lt;picture&gt;
+// InlineViewSwitcher:display-mode to control this.
 //
-//	&lt;source srcset="inline-view-switcher-display-modes-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="inline-view-switcher-display-modes.png" alt="inline-view-switcher-display-modes"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwInlineViewSwitcher` has a single CSS node with the name
 // `inline-view-switcher`.
 //
-// ## Style classes
-//
-// Like `AdwToggleGroup`, it can accept the [`.flat`](style-classes.html#flat_1)
-// and [`.round`](style-classes.html#round) style classes.
+// # Style classes
 //
-// &lt;picture&gt;
+// Like `AdwToggleGroup`, it can accept the .flat
+// and .round style classes.
 //
-//	&lt;source srcset="inline-view-switcher-style-classes-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="inline-view-switcher-style-classes.png" alt="inline-view-switcher-style-classes"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Accessibility
+// # Accessibility
 //
 // The internal toggle group uses the `GTK_ACCESSIBLE_ROLE_TAB_LIST` role. Its
 // toggles use the `GTK_ACCESSIBLE_ROLE_TAB` role.
@@ -136,7 +147,7 @@ func (x *InlineViewSwitcher) GetCanShrink() bool {
 
 var xInlineViewSwitcherGetDisplayMode func(uintptr) InlineViewSwitcherDisplayMode
 
-// Gets the display mode of @self.
+// Gets the display mode of self.
 func (x *InlineViewSwitcher) GetDisplayMode() InlineViewSwitcherDisplayMode {
 
 	cret := xInlineViewSwitcherGetDisplayMode(x.GoPointer())
@@ -145,7 +156,7 @@ func (x *InlineViewSwitcher) GetDisplayMode() InlineViewSwitcherDisplayMode {
 
 var xInlineViewSwitcherGetHomogeneous func(uintptr) bool
 
-// Gets whether all toggles within @self take the same size.
+// Gets whether all toggles within self take the same size.
 func (x *InlineViewSwitcher) GetHomogeneous() bool {
 
 	cret := xInlineViewSwitcherGetHomogeneous(x.GoPointer())
@@ -154,7 +165,7 @@ func (x *InlineViewSwitcher) GetHomogeneous() bool {
 
 var xInlineViewSwitcherGetStack func(uintptr) uintptr
 
-// Gets the stack @self controls.
+// Gets the stack self controls.
 func (x *InlineViewSwitcher) GetStack() *ViewStack {
 	var cls *ViewStack
 
@@ -174,9 +185,9 @@ var xInlineViewSwitcherSetCanShrink func(uintptr, bool)
 // Sets whether the toggles can be smaller than the natural size of their
 // contents.
 //
-// If @can_shrink is `TRUE`, the toggle labels will ellipsize.
+// If can_shrink is `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@ToggleGroup:can-shrink].
+// See ToggleGroup:can-shrink.
 func (x *InlineViewSwitcher) SetCanShrink(CanShrinkVar bool) {
 
 	xInlineViewSwitcherSetCanShrink(x.GoPointer(), CanShrinkVar)
@@ -185,16 +196,9 @@ func (x *InlineViewSwitcher) SetCanShrink(CanShrinkVar bool) {
 
 var xInlineViewSwitcherSetDisplayMode func(uintptr, InlineViewSwitcherDisplayMode)
 
-// Sets the display mode of @self.
+// Sets the display mode of self.
 //
 // Determines what the toggles display: a label, an icon or both.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="inline-view-switcher-display-modes-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="inline-view-switcher-display-modes.png" alt="inline-view-switcher-display-modes"&gt;
-//
-// &lt;/picture&gt;
 func (x *InlineViewSwitcher) SetDisplayMode(ModeVar InlineViewSwitcherDisplayMode) {
 
 	xInlineViewSwitcherSetDisplayMode(x.GoPointer(), ModeVar)
@@ -203,7 +207,7 @@ func (x *InlineViewSwitcher) SetDisplayMode(ModeVar InlineViewSwitcherDisplayMod
 
 var xInlineViewSwitcherSetHomogeneous func(uintptr, bool)
 
-// Sets whether all toggles within @self take the same size.
+// Sets whether all toggles within self take the same size.
 func (x *InlineViewSwitcher) SetHomogeneous(HomogeneousVar bool) {
 
 	xInlineViewSwitcherSetHomogeneous(x.GoPointer(), HomogeneousVar)
@@ -235,7 +239,7 @@ func (c *InlineViewSwitcher) SetGoPointer(ptr uintptr) {
 //
 // If set to `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@ToggleGroup:can-shrink].
+// See ToggleGroup:can-shrink.
 func (x *InlineViewSwitcher) SetPropertyCanShrink(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -248,7 +252,7 @@ func (x *InlineViewSwitcher) SetPropertyCanShrink(value bool) {
 //
 // If set to `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@ToggleGroup:can-shrink].
+// See ToggleGroup:can-shrink.
 func (x *InlineViewSwitcher) GetPropertyCanShrink() bool {
 	var v gobject.Value
 	x.GetProperty("can-shrink", &v)
@@ -433,7 +437,7 @@ func (x *InlineViewSwitcher) UpdatePlatformState(StateVar gtk.AccessiblePlatform
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -441,14 +445,13 @@ func (x *InlineViewSwitcher) UpdatePlatformState(StateVar gtk.AccessiblePlatform
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *InlineViewSwitcher) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -472,19 +475,17 @@ func (x *InlineViewSwitcher) UpdatePropertyValue(NPropertiesVar int, PropertiesV
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *InlineViewSwitcher) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -505,7 +506,7 @@ func (x *InlineViewSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -514,14 +515,12 @@ func (x *InlineViewSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *InlineViewSwitcher) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -540,24 +539,24 @@ func (x *InlineViewSwitcher) UpdateStateValue(NStatesVar int, StatesVar []gtk.Ac
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *InlineViewSwitcher) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *InlineViewSwitcher) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *InlineViewSwitcher) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -569,7 +568,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -580,15 +579,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xInlineViewSwitcherGLibType, libs, "adw_inline_view_switcher_get_type")
 
-	core.PuregoSafeRegister(&xNewInlineViewSwitcher, libs, "adw_inline_view_switcher_new")
+	gobject.CheckClassSize("AdwInlineViewSwitcherClass", xInlineViewSwitcherGLibType, unsafe.Sizeof(InlineViewSwitcherClass{}))
+
+	core.PuregoRegisterSince(&xNewInlineViewSwitcher, libs, "adw_inline_view_switcher_new", "ADW", "1.7")
 
-	core.PuregoSafeRegister(&xInlineViewSwitcherGetCanShrink, libs, "adw_inline_view_switcher_get_can_shrink")
-	core.PuregoSafeRegister(&xInlineViewSwitcherGetDisplayMode, libs, "adw_inline_view_switcher_get_display_mode")
-	core.PuregoSafeRegister(&xInlineViewSwitcherGetHomogeneous, libs, "adw_inline_view_switcher_get_homogeneous")
-	core.PuregoSafeRegister(&xInlineViewSwitcherGetStack, libs, "adw_inline_view_switcher_get_stack")
-	core.PuregoSafeRegister(&xInlineViewSwitcherSetCanShrink, libs, "adw_inline_view_switcher_set_can_shrink")
-	core.PuregoSafeRegister(&xInlineViewSwitcherSetDisplayMode, libs, "adw_inline_view_switcher_set_display_mode")
-	core.PuregoSafeRegister(&xInlineViewSwitcherSetHomogeneous, libs, "adw_inline_view_switcher_set_homogeneous")
-	core.PuregoSafeRegister(&xInlineViewSwitcherSetStack, libs, "adw_inline_view_switcher_set_stack")
+	core.PuregoRegisterSince(&xInlineViewSwitcherGetCanShrink, libs, "adw_inline_view_switcher_get_can_shrink", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherGetDisplayMode, libs, "adw_inline_view_switcher_get_display_mode", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherGetHomogeneous, libs, "adw_inline_view_switcher_get_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherGetStack, libs, "adw_inline_view_switcher_get_stack", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherSetCanShrink, libs, "adw_inline_view_switcher_set_can_shrink", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherSetDisplayMode, libs, "adw_inline_view_switcher_set_display_mode", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherSetHomogeneous, libs, "adw_inline_view_switcher_set_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xInlineViewSwitcherSetStack, libs, "adw_inline_view_switcher_set_stack", "ADW", "1.7")
 
 }
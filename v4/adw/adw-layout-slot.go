@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,12 +21,12 @@ func (x *LayoutSlotClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A child slot within [class@Layout].
+// A child slot within Layout.
 //
-// While it contains a layout child, the [property@Gtk.Widget:visible] property
+// While it contains a layout child, the [gtk.Widget.GetPropertyVisible] property
 // of the slot is updated to match that of the layout child.
 //
-// See [class@MultiLayoutView].
+// See MultiLayoutView.
 type LayoutSlot struct {
 	gtk.Widget
 }
@@ -46,7 +45,7 @@ func LayoutSlotNewFromInternalPtr(ptr uintptr) *LayoutSlot {
 
 var xNewLayoutSlot func(string) uintptr
 
-// Creates a new `AdwLayoutSlot` with its ID set to @id.
+// Creates a new `AdwLayoutSlot` with its ID set to id.
 func NewLayoutSlot(IdVar string) *LayoutSlot {
 	var cls *LayoutSlot
 
@@ -63,7 +62,7 @@ func NewLayoutSlot(IdVar string) *LayoutSlot {
 
 var xLayoutSlotGetSlotId func(uintptr) string
 
-// Gets the slot id of @self.
+// Gets the slot id of self.
 func (x *LayoutSlot) GetSlotId() string {
 
 	cret := xLayoutSlotGetSlotId(x.GoPointer())
@@ -84,7 +83,7 @@ func (c *LayoutSlot) SetGoPointer(ptr uintptr) {
 // SetPropertyId sets the "id" property.
 // The slot ID.
 //
-// See [method@MultiLayoutView.set_child].
+// See set_child.
 func (x *LayoutSlot) SetPropertyId(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -95,7 +94,7 @@ func (x *LayoutSlot) SetPropertyId(value string) {
 // GetPropertyId gets the "id" property.
 // The slot ID.
 //
-// See [method@MultiLayoutView.set_child].
+// See set_child.
 func (x *LayoutSlot) GetPropertyId() string {
 	var v gobject.Value
 	x.GetProperty("id", &v)
@@ -263,7 +262,7 @@ func (x *LayoutSlot) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -271,14 +270,13 @@ func (x *LayoutSlot) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *LayoutSlot) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -302,19 +300,17 @@ func (x *LayoutSlot) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *LayoutSlot) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -335,7 +331,7 @@ func (x *LayoutSlot) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -344,14 +340,12 @@ func (x *LayoutSlot) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *LayoutSlot) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -370,10 +364,10 @@ func (x *LayoutSlot) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *LayoutSlot) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -385,7 +379,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -394,8 +388,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xLayoutSlotGLibType, libs, "adw_layout_slot_get_type")
 
-	core.PuregoSafeRegister(&xNewLayoutSlot, libs, "adw_layout_slot_new")
+	gobject.CheckClassSize("AdwLayoutSlotClass", xLayoutSlotGLibType, unsafe.Sizeof(LayoutSlotClass{}))
+
+	core.PuregoRegisterSince(&xNewLayoutSlot, libs, "adw_layout_slot_new", "ADW", "1.6")
 
-	core.PuregoSafeRegister(&xLayoutSlotGetSlotId, libs, "adw_layout_slot_get_slot_id")
+	core.PuregoRegisterSince(&xLayoutSlotGetSlotId, libs, "adw_layout_slot_get_slot_id", "ADW", "1.6")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,7 +21,7 @@ func (x *LayoutClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// An individual layout in [class@MultiLayoutView].
+// An individual layout in MultiLayoutView.
 type Layout struct {
 	gobject.Object
 }
@@ -41,7 +40,7 @@ func LayoutNewFromInternalPtr(ptr uintptr) *Layout {
 
 var xNewLayout func(uintptr) uintptr
 
-// Creates a new `AdwLayout` that contains @content.
+// Creates a new `AdwLayout` that contains content.
 func NewLayout(ContentVar *gtk.Widget) *Layout {
 	var cls *Layout
 
@@ -121,10 +120,10 @@ func (x *Layout) GetPropertyName() string {
 	return v.GetString()
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Layout) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -136,7 +135,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -145,10 +144,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xLayoutGLibType, libs, "adw_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewLayout, libs, "adw_layout_new")
+	gobject.CheckClassSize("AdwLayoutClass", xLayoutGLibType, unsafe.Sizeof(LayoutClass{}))
 
-	core.PuregoSafeRegister(&xLayoutGetContent, libs, "adw_layout_get_content")
-	core.PuregoSafeRegister(&xLayoutGetName, libs, "adw_layout_get_name")
-	core.PuregoSafeRegister(&xLayoutSetName, libs, "adw_layout_set_name")
+	core.PuregoRegisterSince(&xNewLayout, libs, "adw_layout_new", "ADW", "1.6")
+
+	core.PuregoRegisterSince(&xLayoutGetContent, libs, "adw_layout_get_content", "ADW", "1.6")
+	core.PuregoRegisterSince(&xLayoutGetName, libs, "adw_layout_get_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xLayoutSetName, libs, "adw_layout_set_name", "ADW", "1.6")
 
 }
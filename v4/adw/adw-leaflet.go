@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -33,9 +33,11 @@ func (x *LeafletPageClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the possible transitions in a [class@Leaflet] widget.
+// Describes the possible transitions in a Leaflet widget.
 //
 // New values may be added to this enumeration over time.
+//
+// Deprecated: since 1.4. See the migration guide
 type LeafletTransitionType int
 
 var xLeafletTransitionTypeGLibType func() types.GType
@@ -54,23 +56,49 @@ const (
 	LeafletTransitionTypeSlideValue LeafletTransitionType = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LeafletTransitionType) String() string {
+	switch x {
+	case LeafletTransitionTypeOverValue:
+		return "ADW_LEAFLET_TRANSITION_TYPE_OVER"
+	case LeafletTransitionTypeUnderValue:
+		return "ADW_LEAFLET_TRANSITION_TYPE_UNDER"
+	case LeafletTransitionTypeSlideValue:
+		return "ADW_LEAFLET_TRANSITION_TYPE_SLIDE"
+	}
+	return fmt.Sprintf("LeafletTransitionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LeafletTransitionType) Nick() string {
+	switch x {
+	case LeafletTransitionTypeOverValue:
+		return "over"
+	case LeafletTransitionTypeUnderValue:
+		return "under"
+	case LeafletTransitionTypeSlideValue:
+		return "slide"
+	}
+	return ""
+}
+
+// LeafletTransitionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LeafletTransitionTypeFromString(s string) (LeafletTransitionType, bool) {
+	switch s {
+	case "ADW_LEAFLET_TRANSITION_TYPE_OVER", "over":
+		return LeafletTransitionTypeOverValue, true
+	case "ADW_LEAFLET_TRANSITION_TYPE_UNDER", "under":
+		return LeafletTransitionTypeUnderValue, true
+	case "ADW_LEAFLET_TRANSITION_TYPE_SLIDE", "slide":
+		return LeafletTransitionTypeSlideValue, true
+	}
+	return 0, false
+}
+
 // An adaptive container acting like a box or a stack.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="leaflet-wide-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="leaflet-wide.png" alt="leaflet-wide"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="leaflet-narrow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="leaflet-narrow.png" alt="leaflet-narrow"&gt;
-//
-// &lt;/picture&gt;
-//
-// The `AdwLeaflet` widget can display its children like a [class@Gtk.Box] does
-// or like a [class@Gtk.Stack] does, adapting to size changes by switching
+// The `AdwLeaflet` widget can display its children like a [gtk.Box] does
+// or like a [gtk.Stack] does, adapting to size changes by switching
 // between the two modes.
 //
 // When there is enough space the children are displayed side by side, otherwise
@@ -84,11 +112,13 @@ const (
 // current child away, navigating to an upper child requires dragging it from
 // the edge where it resides. This doesn't affect non-dragging swipes.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwLeaflet` has a single CSS node with name `leaflet`. The node will get the
 // style classes `.folded` when it is folded, `.unfolded` when it's not, or none
 // if it hasn't computed its fold yet.
+//
+// Deprecated: since 1.4. See the migration guide
 type Leaflet struct {
 	gtk.Widget
 }
@@ -105,462 +135,6 @@ func LeafletNewFromInternalPtr(ptr uintptr) *Leaflet {
 	return cls
 }
 
-var xNewLeaflet func() uintptr
-
-// Creates a new `AdwLeaflet`.
-func NewLeaflet() *Leaflet {
-	var cls *Leaflet
-
-	cret := xNewLeaflet()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Leaflet{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletAppend func(uintptr, uintptr) uintptr
-
-// Adds a child to @self.
-func (x *Leaflet) Append(ChildVar *gtk.Widget) *LeafletPage {
-	var cls *LeafletPage
-
-	cret := xLeafletAppend(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &LeafletPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetAdjacentChild func(uintptr, NavigationDirection) uintptr
-
-// Finds the previous or next navigatable child.
-//
-// This will be the same child [method@Leaflet.navigate] or swipe gestures will
-// navigate to.
-//
-// If there's no child to navigate to, `NULL` will be returned instead.
-//
-// See [property@LeafletPage:navigatable].
-func (x *Leaflet) GetAdjacentChild(DirectionVar NavigationDirection) *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xLeafletGetAdjacentChild(x.GoPointer(), DirectionVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetCanNavigateBack func(uintptr) bool
-
-// Gets whether gestures and shortcuts for navigating backward are enabled.
-func (x *Leaflet) GetCanNavigateBack() bool {
-
-	cret := xLeafletGetCanNavigateBack(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetCanNavigateForward func(uintptr) bool
-
-// Gets whether gestures and shortcuts for navigating forward are enabled.
-func (x *Leaflet) GetCanNavigateForward() bool {
-
-	cret := xLeafletGetCanNavigateForward(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetCanUnfold func(uintptr) bool
-
-// Gets whether @self can unfold.
-func (x *Leaflet) GetCanUnfold() bool {
-
-	cret := xLeafletGetCanUnfold(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetChildByName func(uintptr, string) uintptr
-
-// Finds the child of @self with @name.
-//
-// Returns `NULL` if there is no child with this name.
-//
-// See [property@LeafletPage:name].
-func (x *Leaflet) GetChildByName(NameVar string) *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xLeafletGetChildByName(x.GoPointer(), NameVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetChildTransitionParams func(uintptr) *SpringParams
-
-// Gets the child transition spring parameters for @self.
-func (x *Leaflet) GetChildTransitionParams() *SpringParams {
-
-	cret := xLeafletGetChildTransitionParams(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetChildTransitionRunning func(uintptr) bool
-
-// Gets whether a child transition is currently running for @self.
-func (x *Leaflet) GetChildTransitionRunning() bool {
-
-	cret := xLeafletGetChildTransitionRunning(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetFoldThresholdPolicy func(uintptr) FoldThresholdPolicy
-
-// Gets the fold threshold policy for @self.
-func (x *Leaflet) GetFoldThresholdPolicy() FoldThresholdPolicy {
-
-	cret := xLeafletGetFoldThresholdPolicy(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetFolded func(uintptr) bool
-
-// Gets whether @self is folded.
-//
-// The leaflet will be folded if the size allocated to it is smaller than the
-// sum of the minimum or natural sizes of the children (see
-// [property@Leaflet:fold-threshold-policy]), it will be unfolded otherwise.
-func (x *Leaflet) GetFolded() bool {
-
-	cret := xLeafletGetFolded(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetHomogeneous func(uintptr) bool
-
-// Gets whether @self is homogeneous.
-func (x *Leaflet) GetHomogeneous() bool {
-
-	cret := xLeafletGetHomogeneous(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetModeTransitionDuration func(uintptr) uint
-
-// Gets the mode transition animation duration for @self.
-func (x *Leaflet) GetModeTransitionDuration() uint {
-
-	cret := xLeafletGetModeTransitionDuration(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetPage func(uintptr, uintptr) uintptr
-
-// Returns the [class@LeafletPage] object for @child.
-func (x *Leaflet) GetPage(ChildVar *gtk.Widget) *LeafletPage {
-	var cls *LeafletPage
-
-	cret := xLeafletGetPage(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &LeafletPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetPages func(uintptr) uintptr
-
-// Returns a [iface@Gio.ListModel] that contains the pages of the leaflet.
-//
-// This can be used to keep an up-to-date view. The model also implements
-// [iface@Gtk.SelectionModel] and can be used to track and change the visible
-// page.
-func (x *Leaflet) GetPages() *gtk.SelectionModelBase {
-	var cls *gtk.SelectionModelBase
-
-	cret := xLeafletGetPages(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &gtk.SelectionModelBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetTransitionType func(uintptr) LeafletTransitionType
-
-// Gets the type of animation used for transitions between modes and children.
-func (x *Leaflet) GetTransitionType() LeafletTransitionType {
-
-	cret := xLeafletGetTransitionType(x.GoPointer())
-	return cret
-}
-
-var xLeafletGetVisibleChild func(uintptr) uintptr
-
-// Gets the widget currently visible when the leaflet is folded.
-func (x *Leaflet) GetVisibleChild() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xLeafletGetVisibleChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletGetVisibleChildName func(uintptr) string
-
-// Gets the name of the currently visible child widget.
-func (x *Leaflet) GetVisibleChildName() string {
-
-	cret := xLeafletGetVisibleChildName(x.GoPointer())
-	return cret
-}
-
-var xLeafletInsertChildAfter func(uintptr, uintptr, uintptr) uintptr
-
-// Inserts @child in the position after @sibling in the list of children.
-//
-// If @sibling is `NULL`, inserts @child at the first position.
-func (x *Leaflet) InsertChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) *LeafletPage {
-	var cls *LeafletPage
-
-	cret := xLeafletInsertChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &LeafletPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletNavigate func(uintptr, NavigationDirection) bool
-
-// Navigates to the previous or next child.
-//
-// The child must have the [property@LeafletPage:navigatable] property set to
-// `TRUE`, otherwise it will be skipped.
-//
-// This will be the same child as returned by
-// [method@Leaflet.get_adjacent_child] or navigated to via swipe gestures.
-func (x *Leaflet) Navigate(DirectionVar NavigationDirection) bool {
-
-	cret := xLeafletNavigate(x.GoPointer(), DirectionVar)
-	return cret
-}
-
-var xLeafletPrepend func(uintptr, uintptr) uintptr
-
-// Inserts @child at the first position in @self.
-func (x *Leaflet) Prepend(ChildVar *gtk.Widget) *LeafletPage {
-	var cls *LeafletPage
-
-	cret := xLeafletPrepend(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &LeafletPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletRemove func(uintptr, uintptr)
-
-// Removes a child widget from @self.
-func (x *Leaflet) Remove(ChildVar *gtk.Widget) {
-
-	xLeafletRemove(x.GoPointer(), ChildVar.GoPointer())
-
-}
-
-var xLeafletReorderChildAfter func(uintptr, uintptr, uintptr)
-
-// Moves @child to the position after @sibling in the list of children.
-//
-// If @sibling is `NULL`, moves @child to the first position.
-func (x *Leaflet) ReorderChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) {
-
-	xLeafletReorderChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
-
-}
-
-var xLeafletSetCanNavigateBack func(uintptr, bool)
-
-// Sets whether gestures and shortcuts for navigating backward are enabled.
-//
-// The supported gestures are:
-//
-// - One-finger swipe on touchscreens
-// - Horizontal scrolling on touchpads (usually two-finger swipe)
-// - Back/forward mouse buttons
-//
-// The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt; for vertical orientation.
-//
-// If the orientation is horizontal, for right-to-left locales, gestures and
-// shortcuts are reversed.
-//
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE` can
-// be navigated to.
-func (x *Leaflet) SetCanNavigateBack(CanNavigateBackVar bool) {
-
-	xLeafletSetCanNavigateBack(x.GoPointer(), CanNavigateBackVar)
-
-}
-
-var xLeafletSetCanNavigateForward func(uintptr, bool)
-
-// Sets whether gestures and shortcuts for navigating forward are enabled.
-//
-// The supported gestures are:
-//
-// - One-finger swipe on touchscreens
-// - Horizontal scrolling on touchpads (usually two-finger swipe)
-// - Back/forward mouse buttons
-//
-// The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt; for vertical orientation.
-//
-// If the orientation is horizontal, for right-to-left locales, gestures and
-// shortcuts are reversed.
-//
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE` can
-// be navigated to.
-func (x *Leaflet) SetCanNavigateForward(CanNavigateForwardVar bool) {
-
-	xLeafletSetCanNavigateForward(x.GoPointer(), CanNavigateForwardVar)
-
-}
-
-var xLeafletSetCanUnfold func(uintptr, bool)
-
-// Sets whether @self can unfold.
-func (x *Leaflet) SetCanUnfold(CanUnfoldVar bool) {
-
-	xLeafletSetCanUnfold(x.GoPointer(), CanUnfoldVar)
-
-}
-
-var xLeafletSetChildTransitionParams func(uintptr, *SpringParams)
-
-// Sets the child transition spring parameters for @self.
-//
-// The default value is equivalent to:
-//
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
-func (x *Leaflet) SetChildTransitionParams(ParamsVar *SpringParams) {
-
-	xLeafletSetChildTransitionParams(x.GoPointer(), ParamsVar)
-
-}
-
-var xLeafletSetFoldThresholdPolicy func(uintptr, FoldThresholdPolicy)
-
-// Sets the fold threshold policy for @self.
-//
-// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, it will only fold when the
-// children cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`, it
-// will fold as soon as children don't get their natural size.
-//
-// This can be useful if you have a long ellipsizing label and want to let it
-// ellipsize instead of immediately folding.
-func (x *Leaflet) SetFoldThresholdPolicy(PolicyVar FoldThresholdPolicy) {
-
-	xLeafletSetFoldThresholdPolicy(x.GoPointer(), PolicyVar)
-
-}
-
-var xLeafletSetHomogeneous func(uintptr, bool)
-
-// Sets @self to be homogeneous or not.
-//
-// If set to `FALSE`, different children can have different size along the
-// opposite orientation.
-func (x *Leaflet) SetHomogeneous(HomogeneousVar bool) {
-
-	xLeafletSetHomogeneous(x.GoPointer(), HomogeneousVar)
-
-}
-
-var xLeafletSetModeTransitionDuration func(uintptr, uint)
-
-// Sets the mode transition animation duration for @self.
-func (x *Leaflet) SetModeTransitionDuration(DurationVar uint) {
-
-	xLeafletSetModeTransitionDuration(x.GoPointer(), DurationVar)
-
-}
-
-var xLeafletSetTransitionType func(uintptr, LeafletTransitionType)
-
-// Sets the type of animation used for transitions between modes and children.
-//
-// The transition type can be changed without problems at runtime, so it is
-// possible to change the animation based on the mode or child that is about to
-// become current.
-func (x *Leaflet) SetTransitionType(TransitionVar LeafletTransitionType) {
-
-	xLeafletSetTransitionType(x.GoPointer(), TransitionVar)
-
-}
-
-var xLeafletSetVisibleChild func(uintptr, uintptr)
-
-// Sets the widget currently visible when the leaflet is folded.
-//
-// The transition is determined by [property@Leaflet:transition-type] and
-// [property@Leaflet:child-transition-params]. The transition can be cancelled
-// by the user, in which case visible child will change back to the previously
-// visible child.
-func (x *Leaflet) SetVisibleChild(VisibleChildVar *gtk.Widget) {
-
-	xLeafletSetVisibleChild(x.GoPointer(), VisibleChildVar.GoPointer())
-
-}
-
-var xLeafletSetVisibleChildName func(uintptr, string)
-
-// Makes the child with the name @name visible.
-//
-// See [property@Leaflet:visible-child].
-func (x *Leaflet) SetVisibleChildName(NameVar string) {
-
-	xLeafletSetVisibleChildName(x.GoPointer(), NameVar)
-
-}
-
 func (c *Leaflet) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -582,14 +156,16 @@ func (c *Leaflet) SetGoPointer(ptr uintptr) {
 // - Back/forward mouse buttons
 //
 // The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt; for vertical orientation.
+// Alt+← shortcut for horizontal orientation, or
+// Alt+↑ for vertical orientation.
 //
 // If the orientation is horizontal, for right-to-left locales, gestures and
 // shortcuts are reversed.
 //
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE`
+// Only children that have LeafletPage:navigatable set to `TRUE`
 // can be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyCanNavigateBack(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -607,14 +183,16 @@ func (x *Leaflet) SetPropertyCanNavigateBack(value bool) {
 // - Back/forward mouse buttons
 //
 // The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt; for vertical orientation.
+// Alt+← shortcut for horizontal orientation, or
+// Alt+↑ for vertical orientation.
 //
 // If the orientation is horizontal, for right-to-left locales, gestures and
 // shortcuts are reversed.
 //
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE`
+// Only children that have LeafletPage:navigatable set to `TRUE`
 // can be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyCanNavigateBack() bool {
 	var v gobject.Value
 	x.GetProperty("can-navigate-back", &v)
@@ -631,14 +209,16 @@ func (x *Leaflet) GetPropertyCanNavigateBack() bool {
 // - Back/forward mouse buttons
 //
 // The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt; for vertical orientation.
+// Alt+→ shortcut for horizontal orientation, or
+// Alt+↓ for vertical orientation.
 //
 // If the orientation is horizontal, for right-to-left locales, gestures and
 // shortcuts are reversed.
 //
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE`
+// Only children that have LeafletPage:navigatable set to `TRUE`
 // can be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyCanNavigateForward(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -656,14 +236,16 @@ func (x *Leaflet) SetPropertyCanNavigateForward(value bool) {
 // - Back/forward mouse buttons
 //
 // The keyboard back/forward keys are also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt; shortcut for horizontal orientation, or
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt; for vertical orientation.
+// Alt+→ shortcut for horizontal orientation, or
+// Alt+↓ for vertical orientation.
 //
 // If the orientation is horizontal, for right-to-left locales, gestures and
 // shortcuts are reversed.
 //
-// Only children that have [property@LeafletPage:navigatable] set to `TRUE`
+// Only children that have LeafletPage:navigatable set to `TRUE`
 // can be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyCanNavigateForward() bool {
 	var v gobject.Value
 	x.GetProperty("can-navigate-forward", &v)
@@ -672,6 +254,8 @@ func (x *Leaflet) GetPropertyCanNavigateForward() bool {
 
 // SetPropertyCanUnfold sets the "can-unfold" property.
 // Whether or not the leaflet can unfold.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyCanUnfold(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -681,6 +265,8 @@ func (x *Leaflet) SetPropertyCanUnfold(value bool) {
 
 // GetPropertyCanUnfold gets the "can-unfold" property.
 // Whether or not the leaflet can unfold.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyCanUnfold() bool {
 	var v gobject.Value
 	x.GetProperty("can-unfold", &v)
@@ -692,9 +278,9 @@ func (x *Leaflet) GetPropertyCanUnfold() bool {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyChildTransitionParams(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -707,9 +293,9 @@ func (x *Leaflet) SetPropertyChildTransitionParams(value uintptr) {
 //
 // The default value is equivalent to:
 //
-// ```c
-// adw_spring_params_new (1, 0.5, 500)
-// ```
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyChildTransitionParams() uintptr {
 	var v gobject.Value
 	x.GetProperty("child-transition-params", &v)
@@ -718,6 +304,8 @@ func (x *Leaflet) GetPropertyChildTransitionParams() uintptr {
 
 // GetPropertyChildTransitionRunning gets the "child-transition-running" property.
 // Whether a child transition is currently running.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyChildTransitionRunning() bool {
 	var v gobject.Value
 	x.GetProperty("child-transition-running", &v)
@@ -729,7 +317,9 @@ func (x *Leaflet) GetPropertyChildTransitionRunning() bool {
 //
 // The leaflet will be folded if the size allocated to it is smaller than the
 // sum of the minimum or natural sizes of the children (see
-// [property@Leaflet:fold-threshold-policy]), it will be unfolded otherwise.
+// Leaflet:fold-threshold-policy), it will be unfolded otherwise.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyFolded() bool {
 	var v gobject.Value
 	x.GetProperty("folded", &v)
@@ -741,6 +331,8 @@ func (x *Leaflet) GetPropertyFolded() bool {
 //
 // If set to `FALSE`, different children can have different size along the
 // opposite orientation.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyHomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -753,6 +345,8 @@ func (x *Leaflet) SetPropertyHomogeneous(value bool) {
 //
 // If set to `FALSE`, different children can have different size along the
 // opposite orientation.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyHomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("homogeneous", &v)
@@ -761,6 +355,8 @@ func (x *Leaflet) GetPropertyHomogeneous() bool {
 
 // SetPropertyModeTransitionDuration sets the "mode-transition-duration" property.
 // The mode transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyModeTransitionDuration(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -770,6 +366,8 @@ func (x *Leaflet) SetPropertyModeTransitionDuration(value uint) {
 
 // GetPropertyModeTransitionDuration gets the "mode-transition-duration" property.
 // The mode transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyModeTransitionDuration() uint {
 	var v gobject.Value
 	x.GetProperty("mode-transition-duration", &v)
@@ -779,7 +377,9 @@ func (x *Leaflet) GetPropertyModeTransitionDuration() uint {
 // SetPropertyVisibleChildName sets the "visible-child-name" property.
 // The name of the widget currently visible when the leaflet is folded.
 //
-// See [property@Leaflet:visible-child].
+// See Leaflet:visible-child.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) SetPropertyVisibleChildName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -790,21 +390,23 @@ func (x *Leaflet) SetPropertyVisibleChildName(value string) {
 // GetPropertyVisibleChildName gets the "visible-child-name" property.
 // The name of the widget currently visible when the leaflet is folded.
 //
-// See [property@Leaflet:visible-child].
+// See Leaflet:visible-child.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Leaflet) GetPropertyVisibleChildName() string {
 	var v gobject.Value
 	x.GetProperty("visible-child-name", &v)
 	return v.GetString()
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *Leaflet) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *Leaflet) GetDistance() float64 {
@@ -813,14 +415,14 @@ func (x *Leaflet) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *Leaflet) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -830,7 +432,7 @@ func (x *Leaflet) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -838,7 +440,7 @@ func (x *Leaflet) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *Leaflet) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -1006,7 +608,7 @@ func (x *Leaflet) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1014,14 +616,13 @@ func (x *Leaflet) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Leaflet) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1045,19 +646,17 @@ func (x *Leaflet) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Leaflet) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1078,7 +677,7 @@ func (x *Leaflet) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1087,14 +686,12 @@ func (x *Leaflet) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Leaflet) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1113,31 +710,33 @@ func (x *Leaflet) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Leaflet) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Leaflet) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Leaflet) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
 
-// An auxiliary class used by [class@Leaflet].
+// An auxiliary class used by Leaflet.
+//
+// Deprecated: since 1.4. See the migration guide
 type LeafletPage struct {
 	gobject.Object
 }
@@ -1154,67 +753,6 @@ func LeafletPageNewFromInternalPtr(ptr uintptr) *LeafletPage {
 	return cls
 }
 
-var xLeafletPageGetChild func(uintptr) uintptr
-
-// Gets the leaflet child to which @self belongs.
-func (x *LeafletPage) GetChild() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xLeafletPageGetChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLeafletPageGetName func(uintptr) string
-
-// Gets the name of @self.
-func (x *LeafletPage) GetName() string {
-
-	cret := xLeafletPageGetName(x.GoPointer())
-	return cret
-}
-
-var xLeafletPageGetNavigatable func(uintptr) bool
-
-// Gets whether the child can be navigated to when folded.
-func (x *LeafletPage) GetNavigatable() bool {
-
-	cret := xLeafletPageGetNavigatable(x.GoPointer())
-	return cret
-}
-
-var xLeafletPageSetName func(uintptr, uintptr)
-
-// Sets the name of the @self.
-func (x *LeafletPage) SetName(NameVar *string) {
-
-	NameVarPtr := core.GStrdupNullable(NameVar)
-	defer core.GFreeNullable(NameVarPtr)
-
-	xLeafletPageSetName(x.GoPointer(), NameVarPtr)
-
-}
-
-var xLeafletPageSetNavigatable func(uintptr, bool)
-
-// Sets whether @self can be navigated to when folded.
-//
-// If `FALSE`, the child will be ignored by [method@Leaflet.get_adjacent_child],
-// [method@Leaflet.navigate], and swipe gestures.
-//
-// This can be used used to prevent switching to widgets like separators.
-func (x *LeafletPage) SetNavigatable(NavigatableVar bool) {
-
-	xLeafletPageSetNavigatable(x.GoPointer(), NavigatableVar)
-
-}
-
 func (c *LeafletPage) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -1228,6 +766,8 @@ func (c *LeafletPage) SetGoPointer(ptr uintptr) {
 
 // SetPropertyName sets the "name" property.
 // The name of the child page.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *LeafletPage) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1237,6 +777,8 @@ func (x *LeafletPage) SetPropertyName(value string) {
 
 // GetPropertyName gets the "name" property.
 // The name of the child page.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *LeafletPage) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -1247,10 +789,12 @@ func (x *LeafletPage) GetPropertyName() string {
 // Whether the child can be navigated to when folded.
 //
 // If `FALSE`, the child will be ignored by
-// [method@Leaflet.get_adjacent_child], [method@Leaflet.navigate], and swipe
+// get_adjacent_child, navigate, and swipe
 // gestures.
 //
 // This can be used used to prevent switching to widgets like separators.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *LeafletPage) SetPropertyNavigatable(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1262,10 +806,12 @@ func (x *LeafletPage) SetPropertyNavigatable(value bool) {
 // Whether the child can be navigated to when folded.
 //
 // If `FALSE`, the child will be ignored by
-// [method@Leaflet.get_adjacent_child], [method@Leaflet.navigate], and swipe
+// get_adjacent_child, navigate, and swipe
 // gestures.
 //
 // This can be used used to prevent switching to widgets like separators.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *LeafletPage) GetPropertyNavigatable() bool {
 	var v gobject.Value
 	x.GetProperty("navigatable", &v)
@@ -1277,7 +823,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1288,47 +834,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xLeafletGLibType, libs, "adw_leaflet_get_type")
 
-	core.PuregoSafeRegister(&xNewLeaflet, libs, "adw_leaflet_new")
-
-	core.PuregoSafeRegister(&xLeafletAppend, libs, "adw_leaflet_append")
-	core.PuregoSafeRegister(&xLeafletGetAdjacentChild, libs, "adw_leaflet_get_adjacent_child")
-	core.PuregoSafeRegister(&xLeafletGetCanNavigateBack, libs, "adw_leaflet_get_can_navigate_back")
-	core.PuregoSafeRegister(&xLeafletGetCanNavigateForward, libs, "adw_leaflet_get_can_navigate_forward")
-	core.PuregoSafeRegister(&xLeafletGetCanUnfold, libs, "adw_leaflet_get_can_unfold")
-	core.PuregoSafeRegister(&xLeafletGetChildByName, libs, "adw_leaflet_get_child_by_name")
-	core.PuregoSafeRegister(&xLeafletGetChildTransitionParams, libs, "adw_leaflet_get_child_transition_params")
-	core.PuregoSafeRegister(&xLeafletGetChildTransitionRunning, libs, "adw_leaflet_get_child_transition_running")
-	core.PuregoSafeRegister(&xLeafletGetFoldThresholdPolicy, libs, "adw_leaflet_get_fold_threshold_policy")
-	core.PuregoSafeRegister(&xLeafletGetFolded, libs, "adw_leaflet_get_folded")
-	core.PuregoSafeRegister(&xLeafletGetHomogeneous, libs, "adw_leaflet_get_homogeneous")
-	core.PuregoSafeRegister(&xLeafletGetModeTransitionDuration, libs, "adw_leaflet_get_mode_transition_duration")
-	core.PuregoSafeRegister(&xLeafletGetPage, libs, "adw_leaflet_get_page")
-	core.PuregoSafeRegister(&xLeafletGetPages, libs, "adw_leaflet_get_pages")
-	core.PuregoSafeRegister(&xLeafletGetTransitionType, libs, "adw_leaflet_get_transition_type")
-	core.PuregoSafeRegister(&xLeafletGetVisibleChild, libs, "adw_leaflet_get_visible_child")
-	core.PuregoSafeRegister(&xLeafletGetVisibleChildName, libs, "adw_leaflet_get_visible_child_name")
-	core.PuregoSafeRegister(&xLeafletInsertChildAfter, libs, "adw_leaflet_insert_child_after")
-	core.PuregoSafeRegister(&xLeafletNavigate, libs, "adw_leaflet_navigate")
-	core.PuregoSafeRegister(&xLeafletPrepend, libs, "adw_leaflet_prepend")
-	core.PuregoSafeRegister(&xLeafletRemove, libs, "adw_leaflet_remove")
-	core.PuregoSafeRegister(&xLeafletReorderChildAfter, libs, "adw_leaflet_reorder_child_after")
-	core.PuregoSafeRegister(&xLeafletSetCanNavigateBack, libs, "adw_leaflet_set_can_navigate_back")
-	core.PuregoSafeRegister(&xLeafletSetCanNavigateForward, libs, "adw_leaflet_set_can_navigate_forward")
-	core.PuregoSafeRegister(&xLeafletSetCanUnfold, libs, "adw_leaflet_set_can_unfold")
-	core.PuregoSafeRegister(&xLeafletSetChildTransitionParams, libs, "adw_leaflet_set_child_transition_params")
-	core.PuregoSafeRegister(&xLeafletSetFoldThresholdPolicy, libs, "adw_leaflet_set_fold_threshold_policy")
-	core.PuregoSafeRegister(&xLeafletSetHomogeneous, libs, "adw_leaflet_set_homogeneous")
-	core.PuregoSafeRegister(&xLeafletSetModeTransitionDuration, libs, "adw_leaflet_set_mode_transition_duration")
-	core.PuregoSafeRegister(&xLeafletSetTransitionType, libs, "adw_leaflet_set_transition_type")
-	core.PuregoSafeRegister(&xLeafletSetVisibleChild, libs, "adw_leaflet_set_visible_child")
-	core.PuregoSafeRegister(&xLeafletSetVisibleChildName, libs, "adw_leaflet_set_visible_child_name")
+	gobject.CheckClassSize("AdwLeafletClass", xLeafletGLibType, unsafe.Sizeof(LeafletClass{}))
 
 	core.PuregoSafeRegister(&xLeafletPageGLibType, libs, "adw_leaflet_page_get_type")
 
-	core.PuregoSafeRegister(&xLeafletPageGetChild, libs, "adw_leaflet_page_get_child")
-	core.PuregoSafeRegister(&xLeafletPageGetName, libs, "adw_leaflet_page_get_name")
-	core.PuregoSafeRegister(&xLeafletPageGetNavigatable, libs, "adw_leaflet_page_get_navigatable")
-	core.PuregoSafeRegister(&xLeafletPageSetName, libs, "adw_leaflet_page_set_name")
-	core.PuregoSafeRegister(&xLeafletPageSetNavigatable, libs, "adw_leaflet_page_set_navigatable")
+	gobject.CheckClassSize("AdwLeafletPageClass", xLeafletPageGLibType, unsafe.Sizeof(LeafletPageClass{}))
 
 }
@@ -0,0 +1,656 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xNewLeaflet func() uintptr
+
+// Creates a new `AdwLeaflet`.
+//
+// Deprecated: since 1.4. See the migration guide
+func NewLeaflet() *Leaflet {
+	var cls *Leaflet
+
+	cret := xNewLeaflet()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Leaflet{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletAppend func(uintptr, uintptr) uintptr
+
+// Adds a child to self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) Append(ChildVar *gtk.Widget) *LeafletPage {
+	var cls *LeafletPage
+
+	cret := xLeafletAppend(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &LeafletPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetAdjacentChild func(uintptr, NavigationDirection) uintptr
+
+// Finds the previous or next navigatable child.
+//
+// This will be the same child navigate or swipe gestures will
+// navigate to.
+//
+// If there's no child to navigate to, `NULL` will be returned instead.
+//
+// See LeafletPage:navigatable.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetAdjacentChild(DirectionVar NavigationDirection) *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xLeafletGetAdjacentChild(x.GoPointer(), DirectionVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetCanNavigateBack func(uintptr) bool
+
+// Gets whether gestures and shortcuts for navigating backward are enabled.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetCanNavigateBack() bool {
+
+	cret := xLeafletGetCanNavigateBack(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetCanNavigateForward func(uintptr) bool
+
+// Gets whether gestures and shortcuts for navigating forward are enabled.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetCanNavigateForward() bool {
+
+	cret := xLeafletGetCanNavigateForward(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetCanUnfold func(uintptr) bool
+
+// Gets whether self can unfold.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetCanUnfold() bool {
+
+	cret := xLeafletGetCanUnfold(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetChildByName func(uintptr, string) uintptr
+
+// Finds the child of self with name.
+//
+// Returns `NULL` if there is no child with this name.
+//
+// See LeafletPage:name.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetChildByName(NameVar string) *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xLeafletGetChildByName(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetChildTransitionParams func(uintptr) *SpringParams
+
+// Gets the child transition spring parameters for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetChildTransitionParams() *SpringParams {
+
+	cret := xLeafletGetChildTransitionParams(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetChildTransitionRunning func(uintptr) bool
+
+// Gets whether a child transition is currently running for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetChildTransitionRunning() bool {
+
+	cret := xLeafletGetChildTransitionRunning(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetFoldThresholdPolicy func(uintptr) FoldThresholdPolicy
+
+// Gets the fold threshold policy for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetFoldThresholdPolicy() FoldThresholdPolicy {
+
+	cret := xLeafletGetFoldThresholdPolicy(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetFolded func(uintptr) bool
+
+// Gets whether self is folded.
+//
+// The leaflet will be folded if the size allocated to it is smaller than the
+// sum of the minimum or natural sizes of the children (see
+// Leaflet:fold-threshold-policy), it will be unfolded otherwise.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetFolded() bool {
+
+	cret := xLeafletGetFolded(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetHomogeneous func(uintptr) bool
+
+// Gets whether self is homogeneous.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetHomogeneous() bool {
+
+	cret := xLeafletGetHomogeneous(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetModeTransitionDuration func(uintptr) uint
+
+// Gets the mode transition animation duration for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetModeTransitionDuration() uint {
+
+	cret := xLeafletGetModeTransitionDuration(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetPage func(uintptr, uintptr) uintptr
+
+// Returns the LeafletPage object for child.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetPage(ChildVar *gtk.Widget) *LeafletPage {
+	var cls *LeafletPage
+
+	cret := xLeafletGetPage(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &LeafletPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetPages func(uintptr) uintptr
+
+// Returns a [gio.ListModel] that contains the pages of the leaflet.
+//
+// This can be used to keep an up-to-date view. The model also implements
+// [gtk.SelectionModel] and can be used to track and change the visible
+// page.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetPages() *gtk.SelectionModelBase {
+	var cls *gtk.SelectionModelBase
+
+	cret := xLeafletGetPages(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.SelectionModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetTransitionType func(uintptr) LeafletTransitionType
+
+// Gets the type of animation used for transitions between modes and children.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetTransitionType() LeafletTransitionType {
+
+	cret := xLeafletGetTransitionType(x.GoPointer())
+	return cret
+}
+
+var xLeafletGetVisibleChild func(uintptr) uintptr
+
+// Gets the widget currently visible when the leaflet is folded.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetVisibleChild() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xLeafletGetVisibleChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletGetVisibleChildName func(uintptr) string
+
+// Gets the name of the currently visible child widget.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) GetVisibleChildName() string {
+
+	cret := xLeafletGetVisibleChildName(x.GoPointer())
+	return cret
+}
+
+var xLeafletInsertChildAfter func(uintptr, uintptr, uintptr) uintptr
+
+// Inserts child in the position after sibling in the list of children.
+//
+// If sibling is `NULL`, inserts child at the first position.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) InsertChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) *LeafletPage {
+	var cls *LeafletPage
+
+	cret := xLeafletInsertChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &LeafletPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletNavigate func(uintptr, NavigationDirection) bool
+
+// Navigates to the previous or next child.
+//
+// The child must have the LeafletPage:navigatable property set to
+// `TRUE`, otherwise it will be skipped.
+//
+// This will be the same child as returned by
+// get_adjacent_child or navigated to via swipe gestures.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) Navigate(DirectionVar NavigationDirection) bool {
+
+	cret := xLeafletNavigate(x.GoPointer(), DirectionVar)
+	return cret
+}
+
+var xLeafletPrepend func(uintptr, uintptr) uintptr
+
+// Inserts child at the first position in self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) Prepend(ChildVar *gtk.Widget) *LeafletPage {
+	var cls *LeafletPage
+
+	cret := xLeafletPrepend(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &LeafletPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletRemove func(uintptr, uintptr)
+
+// Removes a child widget from self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) Remove(ChildVar *gtk.Widget) {
+
+	xLeafletRemove(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xLeafletReorderChildAfter func(uintptr, uintptr, uintptr)
+
+// Moves child to the position after sibling in the list of children.
+//
+// If sibling is `NULL`, moves child to the first position.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) ReorderChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) {
+
+	xLeafletReorderChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
+
+}
+
+var xLeafletSetCanNavigateBack func(uintptr, bool)
+
+// Sets whether gestures and shortcuts for navigating backward are enabled.
+//
+// The supported gestures are:
+//
+// - One-finger swipe on touchscreens
+// - Horizontal scrolling on touchpads (usually two-finger swipe)
+// - Back/forward mouse buttons
+//
+// The keyboard back/forward keys are also supported, as well as the
+// Alt+← shortcut for horizontal orientation, or
+// Alt+↑ for vertical orientation.
+//
+// If the orientation is horizontal, for right-to-left locales, gestures and
+// shortcuts are reversed.
+//
+// Only children that have LeafletPage:navigatable set to `TRUE` can
+// be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetCanNavigateBack(CanNavigateBackVar bool) {
+
+	xLeafletSetCanNavigateBack(x.GoPointer(), CanNavigateBackVar)
+
+}
+
+var xLeafletSetCanNavigateForward func(uintptr, bool)
+
+// Sets whether gestures and shortcuts for navigating forward are enabled.
+//
+// The supported gestures are:
+//
+// - One-finger swipe on touchscreens
+// - Horizontal scrolling on touchpads (usually two-finger swipe)
+// - Back/forward mouse buttons
+//
+// The keyboard back/forward keys are also supported, as well as the
+// Alt+→ shortcut for horizontal orientation, or
+// Alt+↓ for vertical orientation.
+//
+// If the orientation is horizontal, for right-to-left locales, gestures and
+// shortcuts are reversed.
+//
+// Only children that have LeafletPage:navigatable set to `TRUE` can
+// be navigated to.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetCanNavigateForward(CanNavigateForwardVar bool) {
+
+	xLeafletSetCanNavigateForward(x.GoPointer(), CanNavigateForwardVar)
+
+}
+
+var xLeafletSetCanUnfold func(uintptr, bool)
+
+// Sets whether self can unfold.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetCanUnfold(CanUnfoldVar bool) {
+
+	xLeafletSetCanUnfold(x.GoPointer(), CanUnfoldVar)
+
+}
+
+var xLeafletSetChildTransitionParams func(uintptr, *SpringParams)
+
+// Sets the child transition spring parameters for self.
+//
+// The default value is equivalent to:
+//
+//	adw_spring_params_new (1, 0.5, 500)
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetChildTransitionParams(ParamsVar *SpringParams) {
+
+	xLeafletSetChildTransitionParams(x.GoPointer(), ParamsVar)
+
+}
+
+var xLeafletSetFoldThresholdPolicy func(uintptr, FoldThresholdPolicy)
+
+// Sets the fold threshold policy for self.
+//
+// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, it will only fold when the
+// children cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`, it
+// will fold as soon as children don't get their natural size.
+//
+// This can be useful if you have a long ellipsizing label and want to let it
+// ellipsize instead of immediately folding.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetFoldThresholdPolicy(PolicyVar FoldThresholdPolicy) {
+
+	xLeafletSetFoldThresholdPolicy(x.GoPointer(), PolicyVar)
+
+}
+
+var xLeafletSetHomogeneous func(uintptr, bool)
+
+// Sets self to be homogeneous or not.
+//
+// If set to `FALSE`, different children can have different size along the
+// opposite orientation.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetHomogeneous(HomogeneousVar bool) {
+
+	xLeafletSetHomogeneous(x.GoPointer(), HomogeneousVar)
+
+}
+
+var xLeafletSetModeTransitionDuration func(uintptr, uint)
+
+// Sets the mode transition animation duration for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetModeTransitionDuration(DurationVar uint) {
+
+	xLeafletSetModeTransitionDuration(x.GoPointer(), DurationVar)
+
+}
+
+var xLeafletSetTransitionType func(uintptr, LeafletTransitionType)
+
+// Sets the type of animation used for transitions between modes and children.
+//
+// The transition type can be changed without problems at runtime, so it is
+// possible to change the animation based on the mode or child that is about to
+// become current.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetTransitionType(TransitionVar LeafletTransitionType) {
+
+	xLeafletSetTransitionType(x.GoPointer(), TransitionVar)
+
+}
+
+var xLeafletSetVisibleChild func(uintptr, uintptr)
+
+// Sets the widget currently visible when the leaflet is folded.
+//
+// The transition is determined by Leaflet:transition-type and
+// Leaflet:child-transition-params. The transition can be cancelled
+// by the user, in which case visible child will change back to the previously
+// visible child.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetVisibleChild(VisibleChildVar *gtk.Widget) {
+
+	xLeafletSetVisibleChild(x.GoPointer(), VisibleChildVar.GoPointer())
+
+}
+
+var xLeafletSetVisibleChildName func(uintptr, string)
+
+// Makes the child with the name name visible.
+//
+// See Leaflet:visible-child.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Leaflet) SetVisibleChildName(NameVar string) {
+
+	xLeafletSetVisibleChildName(x.GoPointer(), NameVar)
+
+}
+
+var xLeafletPageGetChild func(uintptr) uintptr
+
+// Gets the leaflet child to which self belongs.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *LeafletPage) GetChild() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xLeafletPageGetChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLeafletPageGetName func(uintptr) string
+
+// Gets the name of self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *LeafletPage) GetName() string {
+
+	cret := xLeafletPageGetName(x.GoPointer())
+	return cret
+}
+
+var xLeafletPageGetNavigatable func(uintptr) bool
+
+// Gets whether the child can be navigated to when folded.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *LeafletPage) GetNavigatable() bool {
+
+	cret := xLeafletPageGetNavigatable(x.GoPointer())
+	return cret
+}
+
+var xLeafletPageSetName func(uintptr, uintptr)
+
+// Sets the name of the self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *LeafletPage) SetName(NameVar *string) {
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	xLeafletPageSetName(x.GoPointer(), NameVarPtr)
+
+}
+
+var xLeafletPageSetNavigatable func(uintptr, bool)
+
+// Sets whether self can be navigated to when folded.
+//
+// If `FALSE`, the child will be ignored by get_adjacent_child,
+// navigate, and swipe gestures.
+//
+// This can be used used to prevent switching to widgets like separators.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *LeafletPage) SetNavigatable(NavigatableVar bool) {
+
+	xLeafletPageSetNavigatable(x.GoPointer(), NavigatableVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewLeaflet, libs, "adw_leaflet_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xLeafletAppend, libs, "adw_leaflet_append", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetAdjacentChild, libs, "adw_leaflet_get_adjacent_child", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetCanNavigateBack, libs, "adw_leaflet_get_can_navigate_back", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetCanNavigateForward, libs, "adw_leaflet_get_can_navigate_forward", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetCanUnfold, libs, "adw_leaflet_get_can_unfold", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetChildByName, libs, "adw_leaflet_get_child_by_name", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetChildTransitionParams, libs, "adw_leaflet_get_child_transition_params", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetChildTransitionRunning, libs, "adw_leaflet_get_child_transition_running", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetFoldThresholdPolicy, libs, "adw_leaflet_get_fold_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetFolded, libs, "adw_leaflet_get_folded", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetHomogeneous, libs, "adw_leaflet_get_homogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetModeTransitionDuration, libs, "adw_leaflet_get_mode_transition_duration", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetPage, libs, "adw_leaflet_get_page", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetPages, libs, "adw_leaflet_get_pages", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetTransitionType, libs, "adw_leaflet_get_transition_type", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetVisibleChild, libs, "adw_leaflet_get_visible_child", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletGetVisibleChildName, libs, "adw_leaflet_get_visible_child_name", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletInsertChildAfter, libs, "adw_leaflet_insert_child_after", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletNavigate, libs, "adw_leaflet_navigate", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletPrepend, libs, "adw_leaflet_prepend", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletRemove, libs, "adw_leaflet_remove", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletReorderChildAfter, libs, "adw_leaflet_reorder_child_after", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetCanNavigateBack, libs, "adw_leaflet_set_can_navigate_back", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetCanNavigateForward, libs, "adw_leaflet_set_can_navigate_forward", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetCanUnfold, libs, "adw_leaflet_set_can_unfold", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetChildTransitionParams, libs, "adw_leaflet_set_child_transition_params", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetFoldThresholdPolicy, libs, "adw_leaflet_set_fold_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetHomogeneous, libs, "adw_leaflet_set_homogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetModeTransitionDuration, libs, "adw_leaflet_set_mode_transition_duration", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetTransitionType, libs, "adw_leaflet_set_transition_type", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetVisibleChild, libs, "adw_leaflet_set_visible_child", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletSetVisibleChildName, libs, "adw_leaflet_set_visible_child_name", "ADW", "")
+
+	core.PuregoRegisterSince(&xLeafletPageGetChild, libs, "adw_leaflet_page_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletPageGetName, libs, "adw_leaflet_page_get_name", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletPageGetNavigatable, libs, "adw_leaflet_page_get_navigatable", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletPageSetName, libs, "adw_leaflet_page_set_name", "ADW", "")
+	core.PuregoRegisterSince(&xLeafletPageSetNavigatable, libs, "adw_leaflet_page_set_navigatable", "ADW", "")
+
+}
@@ -2,7 +2,8 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
@@ -35,9 +36,48 @@ const (
 	LengthUnitSpValue LengthUnit = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LengthUnit) String() string {
+	switch x {
+	case LengthUnitPxValue:
+		return "ADW_LENGTH_UNIT_PX"
+	case LengthUnitPtValue:
+		return "ADW_LENGTH_UNIT_PT"
+	case LengthUnitSpValue:
+		return "ADW_LENGTH_UNIT_SP"
+	}
+	return fmt.Sprintf("LengthUnit(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LengthUnit) Nick() string {
+	switch x {
+	case LengthUnitPxValue:
+		return "px"
+	case LengthUnitPtValue:
+		return "pt"
+	case LengthUnitSpValue:
+		return "sp"
+	}
+	return ""
+}
+
+// LengthUnitFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LengthUnitFromString(s string) (LengthUnit, bool) {
+	switch s {
+	case "ADW_LENGTH_UNIT_PX", "px":
+		return LengthUnitPxValue, true
+	case "ADW_LENGTH_UNIT_PT", "pt":
+		return LengthUnitPtValue, true
+	case "ADW_LENGTH_UNIT_SP", "sp":
+		return LengthUnitSpValue, true
+	}
+	return 0, false
+}
+
 var xLengthUnitFromPx func(LengthUnit, float64, uintptr) float64
 
-// Converts @value from pixels to @unit.
+// Converts value from pixels to unit.
 func LengthUnitFromPx(UnitVar LengthUnit, ValueVar float64, SettingsVar *gtk.Settings) float64 {
 
 	cret := xLengthUnitFromPx(UnitVar, ValueVar, SettingsVar.GoPointer())
@@ -46,7 +86,7 @@ func LengthUnitFromPx(UnitVar LengthUnit, ValueVar float64, SettingsVar *gtk.Set
 
 var xLengthUnitToPx func(LengthUnit, float64, uintptr) float64
 
-// Converts @value from @unit to pixels.
+// Converts value from unit to pixels.
 func LengthUnitToPx(UnitVar LengthUnit, ValueVar float64, SettingsVar *gtk.Settings) float64 {
 
 	cret := xLengthUnitToPx(UnitVar, ValueVar, SettingsVar.GoPointer())
@@ -58,7 +98,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -67,7 +107,7 @@ func init() {
 
 	core.PuregoSafeRegister(&xLengthUnitGLibType, libs, "adw_length_unit_get_type")
 
-	core.PuregoSafeRegister(&xLengthUnitFromPx, libs, "adw_length_unit_from_px")
-	core.PuregoSafeRegister(&xLengthUnitToPx, libs, "adw_length_unit_to_px")
+	core.PuregoRegisterSince(&xLengthUnitFromPx, libs, "adw_length_unit_from_px", "ADW", "1.4")
+	core.PuregoRegisterSince(&xLengthUnitToPx, libs, "adw_length_unit_to_px", "ADW", "1.4")
 
 }
@@ -2,7 +2,6 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -10,10 +9,10 @@ var xInit func()
 
 // Initializes Libadwaita.
 //
-// This function can be used instead of [func@Gtk.init] as it initializes GTK
+// This function can be used instead of [gtk.Init] as it initializes GTK
 // implicitly.
 //
-// There's no need to call this function if you're using [class@Application].
+// There's no need to call this function if you're using Application.
 //
 // If Libadwaita has already been initialized, the function will simply return.
 //
@@ -28,7 +27,7 @@ func Init() {
 var xIsInitialized func() bool
 
 // Use this function to check if libadwaita has been initialized with
-// [func@init].
+// init.
 func IsInitialized() bool {
 
 	cret := xIsInitialized()
@@ -40,14 +39,14 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xInit, libs, "adw_init")
-	core.PuregoSafeRegister(&xIsInitialized, libs, "adw_is_initialized")
+	core.PuregoRegisterSince(&xInit, libs, "adw_init", "ADW", "")
+	core.PuregoRegisterSince(&xIsInitialized, libs, "adw_is_initialized", "ADW", "")
 
 }
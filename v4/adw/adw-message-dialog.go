@@ -6,10 +6,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
-	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -36,7 +34,8 @@ func (x *MessageDialogClass) OverrideResponse(cb func(*MessageDialog, string)) {
 	if cb == nil {
 		x.xResponse = 0
 	} else {
-		x.xResponse = purego.NewCallback(func(SelfVarp uintptr, ResponseVarp string) {
+		x.xResponse = core.NewCallback(func(SelfVarp uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
 			cb(MessageDialogNewFromInternalPtr(SelfVarp), ResponseVarp)
 		})
 	}
@@ -48,7 +47,7 @@ func (x *MessageDialogClass) GetResponse() func(*MessageDialog, string) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResponseVarp string)
-	purego.RegisterFunc(&rawCallback, x.xResponse)
+	core.RegisterFunc(&rawCallback, x.xResponse)
 	return func(SelfVar *MessageDialog, ResponseVar string) {
 		rawCallback(SelfVar.GoPointer(), ResponseVar)
 	}
@@ -56,13 +55,6 @@ func (x *MessageDialogClass) GetResponse() func(*MessageDialog, string) {
 
 // A dialog presenting a message or a question.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="message-dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="message-dialog.png" alt="message-dialog"&gt;
-//
-// &lt;/picture&gt;
-//
 // Message dialogs have a heading, a body, an optional child widget, and one or
 // multiple responses, each presented as a button.
 //
@@ -71,10 +63,10 @@ func (x *MessageDialogClass) GetResponse() func(*MessageDialog, string) {
 // appearance.
 //
 // When one of the responses is activated, or the dialog is closed, the
-// [signal@MessageDialog::response] signal will be emitted. This signal is
+// MessageDialog::response signal will be emitted. This signal is
 // detailed, and the detail, as well as the `response` parameter will be set to
 // the ID of the activated response, or to the value of the
-// [property@MessageDialog:close-response] property if the dialog had been
+// MessageDialog:close-response property if the dialog had been
 // closed without activating any of the responses.
 //
 // Response buttons can be presented horizontally or vertically depending on
@@ -84,53 +76,45 @@ func (x *MessageDialogClass) GetResponse() func(*MessageDialog, string) {
 //
 // An example of using a message dialog:
 //
-// ```c
-// GtkWidget *dialog;
-//
-// dialog = adw_message_dialog_new (parent, _("Replace File?"), NULL);
-//
-// adw_message_dialog_format_body (ADW_MESSAGE_DIALOG (dialog),
+//	GtkWidget *dialog;
 //
-//	_("A file named “%s” already exists. Do you want to replace it?"),
-//	filename);
+//	dialog = adw_message_dialog_new (parent, _("Replace File?"), NULL);
 //
-// adw_message_dialog_add_responses (ADW_MESSAGE_DIALOG (dialog),
+//	adw_message_dialog_format_body (ADW_MESSAGE_DIALOG (dialog),
+//	                                _("A file named “%s” already exists. Do you want to replace it?"),
+//	                                filename);
 //
-//	"cancel",  _("_Cancel"),
-//	"replace", _("_Replace"),
-//	NULL);
+//	adw_message_dialog_add_responses (ADW_MESSAGE_DIALOG (dialog),
+//	                                  "cancel",  _("_Cancel"),
+//	                                  "replace", _("_Replace"),
+//	                                  NULL);
 //
-// adw_message_dialog_set_response_appearance (ADW_MESSAGE_DIALOG (dialog), "replace", ADW_RESPONSE_DESTRUCTIVE);
+//	adw_message_dialog_set_response_appearance (ADW_MESSAGE_DIALOG (dialog), "replace", ADW_RESPONSE_DESTRUCTIVE);
 //
-// adw_message_dialog_set_default_response (ADW_MESSAGE_DIALOG (dialog), "cancel");
-// adw_message_dialog_set_close_response (ADW_MESSAGE_DIALOG (dialog), "cancel");
+//	adw_message_dialog_set_default_response (ADW_MESSAGE_DIALOG (dialog), "cancel");
+//	adw_message_dialog_set_close_response (ADW_MESSAGE_DIALOG (dialog), "cancel");
 //
-// g_signal_connect (dialog, "response", G_CALLBACK (response_cb), self);
+//	g_signal_connect (dialog, "response", G_CALLBACK (response_cb), self);
 //
-// gtk_window_present (GTK_WINDOW (dialog));
-// ```
+//	gtk_window_present (GTK_WINDOW (dialog));
 //
-// ## Async API
+// # Async API
 //
-// `AdwMessageDialog` can also be used via the [method@MessageDialog.choose]
+// `AdwMessageDialog` can also be used via the choose
 // method. This API follows the GIO async pattern, for example:
 //
-// ```c
-// static void
-// dialog_cb (AdwMessageDialog *dialog,
-//
-//	GAsyncResult     *result,
-//	MyWindow         *self)
-//
+//	static void
+//	dialog_cb (AdwMessageDialog *dialog,
+//	           GAsyncResult     *result,
+//	           MyWindow         *self)
 //	{
 //	  const char *response = adw_message_dialog_choose_finish (dialog, result);
 //
 //	  // ...
 //	}
 //
-// static void
-// show_dialog (MyWindow *self)
-//
+//	static void
+//	show_dialog (MyWindow *self)
 //	{
 //	  GtkWidget *dialog;
 //
@@ -153,46 +137,42 @@ func (x *MessageDialogClass) GetResponse() func(*MessageDialog, string) {
 //	  adw_message_dialog_choose (ADW_MESSAGE_DIALOG (dialog), NULL, (GAsyncReadyCallback) dialog_cb, self);
 //	}
 //
-// ```
-//
-// ## AdwMessageDialog as GtkBuildable
+// # AdwMessageDialog as GtkBuildable
 //
 // `AdwMessageDialog` supports adding responses in UI definitions by via the
-// `&lt;responses&gt;` element that may contain multiple `&lt;response&gt;` elements, each
+// `<responses>` element that may contain multiple `<response>` elements, each
 // representing a response.
 //
-// Each of the `&lt;response&gt;` elements must have the `id` attribute specifying the
+// Each of the `<response>` elements must have the `id` attribute specifying the
 // response ID. The contents of the element are used as the response label.
 //
 // Response labels can be translated with the usual `translatable`, `context`
 // and `comments` attributes.
 //
-// The `&lt;response&gt;` elements can also have `enabled` and/or `appearance`
-// attributes. See [method@MessageDialog.set_response_enabled] and
-// [method@MessageDialog.set_response_appearance] for details.
+// The `<response>` elements can also have `enabled` and/or `appearance`
+// attributes. See set_response_enabled and
+// set_response_appearance for details.
 //
 // Example of an `AdwMessageDialog` UI definition:
 //
-// ```xml
-// &lt;object class="AdwMessageDialog" id="dialog"&gt;
-//
-//	&lt;property name="heading" translatable="yes"&gt;Save Changes?&lt;/property&gt;
-//	&lt;property name="body" translatable="yes"&gt;Open documents contain unsaved changes. Changes which are not saved will be permanently lost.&lt;/property&gt;
-//	&lt;property name="default-response"&gt;save&lt;/property&gt;
-//	&lt;property name="close-response"&gt;cancel&lt;/property&gt;
-//	&lt;signal name="response" handler="response_cb"/&gt;
-//	&lt;responses&gt;
-//	  &lt;response id="cancel" translatable="yes"&gt;_Cancel&lt;/response&gt;
-//	  &lt;response id="discard" translatable="yes" appearance="destructive"&gt;_Discard&lt;/response&gt;
-//	  &lt;response id="save" translatable="yes" appearance="suggested" enabled="false"&gt;_Save&lt;/response&gt;
-//	&lt;/responses&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ## Accessibility
+//	<object class="AdwMessageDialog" id="dialog">
+//	  <property name="heading" translatable="yes">Save Changes?</property>
+//	  <property name="body" translatable="yes">Open documents contain unsaved changes. Changes which are not saved will be permanently lost.</property>
+//	  <property name="default-response">save</property>
+//	  <property name="close-response">cancel</property>
+//	  <signal name="response" handler="response_cb"/>
+//	  <responses>
+//	    <response id="cancel" translatable="yes">_Cancel</response>
+//	    <response id="discard" translatable="yes" appearance="destructive">_Discard</response>
+//	    <response id="save" translatable="yes" appearance="suggested" enabled="false">_Save</response>
+//	  </responses>
+//	</object>
+//
+// # Accessibility
 //
 // `AdwMessageDialog` uses the `GTK_ACCESSIBLE_ROLE_DIALOG` role.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 type MessageDialog struct {
 	gtk.Window
 }
@@ -209,462 +189,6 @@ func MessageDialogNewFromInternalPtr(ptr uintptr) *MessageDialog {
 	return cls
 }
 
-var xNewMessageDialog func(uintptr, uintptr, uintptr) uintptr
-
-// Creates a new `AdwMessageDialog`.
-//
-// @heading and @body can be set to `NULL`. This can be useful if they need to
-// be formatted or use markup. In that case, set them to `NULL` and call
-// [method@MessageDialog.format_body] or similar methods afterwards:
-//
-// ```c
-// GtkWidget *dialog;
-//
-// dialog = adw_message_dialog_new (parent, _("Replace File?"), NULL);
-// adw_message_dialog_format_body (ADW_MESSAGE_DIALOG (dialog),
-//
-//	_("A file named “%s” already exists.  Do you want to replace it?"),
-//	filename);
-//
-// ```
-func NewMessageDialog(ParentVar *gtk.Window, HeadingVar *string, BodyVar *string) *MessageDialog {
-	var cls *MessageDialog
-
-	HeadingVarPtr := core.GStrdupNullable(HeadingVar)
-	defer core.GFreeNullable(HeadingVarPtr)
-
-	BodyVarPtr := core.GStrdupNullable(BodyVar)
-	defer core.GFreeNullable(BodyVarPtr)
-
-	cret := xNewMessageDialog(ParentVar.GoPointer(), HeadingVarPtr, BodyVarPtr)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &MessageDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xMessageDialogAddResponse func(uintptr, string, string)
-
-// Adds a response with @id and @label to @self.
-//
-// Responses are represented as buttons in the dialog.
-//
-// Response ID must be unique. It will be used in
-// [signal@MessageDialog::response] to tell which response had been activated,
-// as well as to inspect and modify the response later.
-//
-// An embedded underline in @label indicates a mnemonic.
-//
-// [method@MessageDialog.set_response_label] can be used to change the response
-// label after it had been added.
-//
-// [method@MessageDialog.set_response_enabled] and
-// [method@MessageDialog.set_response_appearance] can be used to customize the
-// responses further.
-func (x *MessageDialog) AddResponse(IdVar string, LabelVar string) {
-
-	xMessageDialogAddResponse(x.GoPointer(), IdVar, LabelVar)
-
-}
-
-var xMessageDialogAddResponses func(uintptr, string, ...interface{})
-
-// Adds multiple responses to @self.
-//
-// This is the same as calling [method@MessageDialog.add_response] repeatedly.
-// The variable argument list should be `NULL`-terminated list of response IDs
-// and labels.
-//
-// Example:
-//
-// ```c
-// adw_message_dialog_add_responses (dialog,
-//
-//	"cancel",  _("_Cancel"),
-//	"discard", _("_Discard"),
-//	"save",    _("_Save"),
-//	NULL);
-//
-// ```
-func (x *MessageDialog) AddResponses(FirstIdVar string, varArgs ...interface{}) {
-
-	xMessageDialogAddResponses(x.GoPointer(), FirstIdVar, varArgs...)
-
-}
-
-var xMessageDialogChoose func(uintptr, uintptr, uintptr, uintptr)
-
-// This function shows @self to the user.
-func (x *MessageDialog) Choose(CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
-
-	var CallbackVarRef uintptr
-	if CallbackVar != nil {
-		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
-		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
-			CallbackVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
-				cbFn := *CallbackVar
-				cbFn(arg0, arg1, arg2)
-			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
-		}
-	}
-
-	xMessageDialogChoose(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
-
-}
-
-var xMessageDialogChooseFinish func(uintptr, uintptr) string
-
-// Finishes the [method@MessageDialog.choose] call and returns the response ID.
-func (x *MessageDialog) ChooseFinish(ResultVar gio.AsyncResult) string {
-
-	cret := xMessageDialogChooseFinish(x.GoPointer(), ResultVar.GoPointer())
-	return cret
-}
-
-var xMessageDialogFormatBody func(uintptr, string, ...interface{})
-
-// Sets the formatted body text of @self.
-//
-// See [property@MessageDialog:body].
-func (x *MessageDialog) FormatBody(FormatVar string, varArgs ...interface{}) {
-
-	xMessageDialogFormatBody(x.GoPointer(), FormatVar, varArgs...)
-
-}
-
-var xMessageDialogFormatBodyMarkup func(uintptr, string, ...interface{})
-
-// Sets the formatted body text of @self with Pango markup.
-//
-// The @format is assumed to contain Pango markup.
-//
-// Special XML characters in the `printf()` arguments passed to this function
-// will  automatically be escaped as necessary, see
-// [func@GLib.markup_printf_escaped].
-//
-// See [property@MessageDialog:body].
-func (x *MessageDialog) FormatBodyMarkup(FormatVar string, varArgs ...interface{}) {
-
-	xMessageDialogFormatBodyMarkup(x.GoPointer(), FormatVar, varArgs...)
-
-}
-
-var xMessageDialogFormatHeading func(uintptr, string, ...interface{})
-
-// Sets the formatted heading of @self.
-//
-// See [property@MessageDialog:heading].
-func (x *MessageDialog) FormatHeading(FormatVar string, varArgs ...interface{}) {
-
-	xMessageDialogFormatHeading(x.GoPointer(), FormatVar, varArgs...)
-
-}
-
-var xMessageDialogFormatHeadingMarkup func(uintptr, string, ...interface{})
-
-// Sets the formatted heading of @self with Pango markup.
-//
-// The @format is assumed to contain Pango markup.
-//
-// Special XML characters in the `printf()` arguments passed to this function
-// will automatically be escaped as necessary, see
-// [func@GLib.markup_printf_escaped].
-//
-// See [property@MessageDialog:heading].
-func (x *MessageDialog) FormatHeadingMarkup(FormatVar string, varArgs ...interface{}) {
-
-	xMessageDialogFormatHeadingMarkup(x.GoPointer(), FormatVar, varArgs...)
-
-}
-
-var xMessageDialogGetBody func(uintptr) string
-
-// Gets the body text of @self.
-func (x *MessageDialog) GetBody() string {
-
-	cret := xMessageDialogGetBody(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetBodyUseMarkup func(uintptr) bool
-
-// Gets whether the body text of @self includes Pango markup.
-func (x *MessageDialog) GetBodyUseMarkup() bool {
-
-	cret := xMessageDialogGetBodyUseMarkup(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetCloseResponse func(uintptr) string
-
-// Gets the ID of the close response of @self.
-func (x *MessageDialog) GetCloseResponse() string {
-
-	cret := xMessageDialogGetCloseResponse(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetDefaultResponse func(uintptr) string
-
-// Gets the ID of the default response of @self.
-func (x *MessageDialog) GetDefaultResponse() string {
-
-	cret := xMessageDialogGetDefaultResponse(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetExtraChild func(uintptr) uintptr
-
-// Gets the child widget of @self.
-func (x *MessageDialog) GetExtraChild() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xMessageDialogGetExtraChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xMessageDialogGetHeading func(uintptr) string
-
-// Gets the heading of @self.
-func (x *MessageDialog) GetHeading() string {
-
-	cret := xMessageDialogGetHeading(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetHeadingUseMarkup func(uintptr) bool
-
-// Gets whether the heading of @self includes Pango markup.
-func (x *MessageDialog) GetHeadingUseMarkup() bool {
-
-	cret := xMessageDialogGetHeadingUseMarkup(x.GoPointer())
-	return cret
-}
-
-var xMessageDialogGetResponseAppearance func(uintptr, string) ResponseAppearance
-
-// Gets the appearance of @response.
-//
-// See [method@MessageDialog.set_response_appearance].
-func (x *MessageDialog) GetResponseAppearance(ResponseVar string) ResponseAppearance {
-
-	cret := xMessageDialogGetResponseAppearance(x.GoPointer(), ResponseVar)
-	return cret
-}
-
-var xMessageDialogGetResponseEnabled func(uintptr, string) bool
-
-// Gets whether @response is enabled.
-//
-// See [method@MessageDialog.set_response_enabled].
-func (x *MessageDialog) GetResponseEnabled(ResponseVar string) bool {
-
-	cret := xMessageDialogGetResponseEnabled(x.GoPointer(), ResponseVar)
-	return cret
-}
-
-var xMessageDialogGetResponseLabel func(uintptr, string) string
-
-// Gets the label of @response.
-//
-// See [method@MessageDialog.set_response_label].
-func (x *MessageDialog) GetResponseLabel(ResponseVar string) string {
-
-	cret := xMessageDialogGetResponseLabel(x.GoPointer(), ResponseVar)
-	return cret
-}
-
-var xMessageDialogHasResponse func(uintptr, string) bool
-
-// Gets whether @self has a response with the ID @response.
-func (x *MessageDialog) HasResponse(ResponseVar string) bool {
-
-	cret := xMessageDialogHasResponse(x.GoPointer(), ResponseVar)
-	return cret
-}
-
-var xMessageDialogRemoveResponse func(uintptr, string)
-
-// Removes a response from @self.
-func (x *MessageDialog) RemoveResponse(IdVar string) {
-
-	xMessageDialogRemoveResponse(x.GoPointer(), IdVar)
-
-}
-
-var xMessageDialogResponse func(uintptr, string)
-
-// Emits the [signal@MessageDialog::response] signal with the given response ID.
-//
-// Used to indicate that the user has responded to the dialog in some way.
-func (x *MessageDialog) Response(ResponseVar string) {
-
-	xMessageDialogResponse(x.GoPointer(), ResponseVar)
-
-}
-
-var xMessageDialogSetBody func(uintptr, string)
-
-// Sets the body text of @self.
-func (x *MessageDialog) SetBody(BodyVar string) {
-
-	xMessageDialogSetBody(x.GoPointer(), BodyVar)
-
-}
-
-var xMessageDialogSetBodyUseMarkup func(uintptr, bool)
-
-// Sets whether the body text of @self includes Pango markup.
-//
-// See [func@Pango.parse_markup].
-func (x *MessageDialog) SetBodyUseMarkup(UseMarkupVar bool) {
-
-	xMessageDialogSetBodyUseMarkup(x.GoPointer(), UseMarkupVar)
-
-}
-
-var xMessageDialogSetCloseResponse func(uintptr, string)
-
-// Sets the ID of the close response of @self.
-//
-// It will be passed to [signal@MessageDialog::response] if the window is
-// closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
-//
-// It doesn't have to correspond to any of the responses in the dialog.
-//
-// The default close response is `close`.
-func (x *MessageDialog) SetCloseResponse(ResponseVar string) {
-
-	xMessageDialogSetCloseResponse(x.GoPointer(), ResponseVar)
-
-}
-
-var xMessageDialogSetDefaultResponse func(uintptr, uintptr)
-
-// Sets the ID of the default response of @self.
-//
-// The button corresponding to this response will be set as the default widget
-// of @self.
-//
-// If not set, the default widget will not be set, and the last added response
-// will be focused by default.
-//
-// See [property@Gtk.Window:default-widget].
-func (x *MessageDialog) SetDefaultResponse(ResponseVar *string) {
-
-	ResponseVarPtr := core.GStrdupNullable(ResponseVar)
-	defer core.GFreeNullable(ResponseVarPtr)
-
-	xMessageDialogSetDefaultResponse(x.GoPointer(), ResponseVarPtr)
-
-}
-
-var xMessageDialogSetExtraChild func(uintptr, uintptr)
-
-// Sets the child widget of @self.
-//
-// The child widget is displayed below the heading and body.
-func (x *MessageDialog) SetExtraChild(ChildVar *gtk.Widget) {
-
-	xMessageDialogSetExtraChild(x.GoPointer(), ChildVar.GoPointer())
-
-}
-
-var xMessageDialogSetHeading func(uintptr, uintptr)
-
-// Sets the heading of @self.
-func (x *MessageDialog) SetHeading(HeadingVar *string) {
-
-	HeadingVarPtr := core.GStrdupNullable(HeadingVar)
-	defer core.GFreeNullable(HeadingVarPtr)
-
-	xMessageDialogSetHeading(x.GoPointer(), HeadingVarPtr)
-
-}
-
-var xMessageDialogSetHeadingUseMarkup func(uintptr, bool)
-
-// Sets whether the heading of @self includes Pango markup.
-//
-// See [func@Pango.parse_markup].
-func (x *MessageDialog) SetHeadingUseMarkup(UseMarkupVar bool) {
-
-	xMessageDialogSetHeadingUseMarkup(x.GoPointer(), UseMarkupVar)
-
-}
-
-var xMessageDialogSetResponseAppearance func(uintptr, string, ResponseAppearance)
-
-// Sets the appearance for @response.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="message-dialog-appearance-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="message-dialog-appearance.png" alt="message-dialog-appearance"&gt;
-//
-// &lt;/picture&gt;
-//
-// Use `ADW_RESPONSE_SUGGESTED` to mark important responses such as the
-// affirmative action, like the Save button in the example.
-//
-// Use `ADW_RESPONSE_DESTRUCTIVE` to draw attention to the potentially damaging
-// consequences of using @response. This appearance acts as a warning to the
-// user. The Discard button in the example is using this appearance.
-//
-// The default appearance is `ADW_RESPONSE_DEFAULT`.
-//
-// Negative responses like Cancel or Close should use the default appearance.
-func (x *MessageDialog) SetResponseAppearance(ResponseVar string, AppearanceVar ResponseAppearance) {
-
-	xMessageDialogSetResponseAppearance(x.GoPointer(), ResponseVar, AppearanceVar)
-
-}
-
-var xMessageDialogSetResponseEnabled func(uintptr, string, bool)
-
-// Sets whether @response is enabled.
-//
-// If @response is not enabled, the corresponding button will have
-// [property@Gtk.Widget:sensitive] set to `FALSE` and it can't be activated as
-// a default response.
-//
-// @response can still be used as [property@MessageDialog:close-response] while
-// it's not enabled.
-//
-// Responses are enabled by default.
-func (x *MessageDialog) SetResponseEnabled(ResponseVar string, EnabledVar bool) {
-
-	xMessageDialogSetResponseEnabled(x.GoPointer(), ResponseVar, EnabledVar)
-
-}
-
-var xMessageDialogSetResponseLabel func(uintptr, string, string)
-
-// Sets the label of @response to @label.
-//
-// Labels are displayed on the dialog buttons. An embedded underline in @label
-// indicates a mnemonic.
-func (x *MessageDialog) SetResponseLabel(ResponseVar string, LabelVar string) {
-
-	xMessageDialogSetResponseLabel(x.GoPointer(), ResponseVar, LabelVar)
-
-}
-
 func (c *MessageDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -678,6 +202,8 @@ func (c *MessageDialog) SetGoPointer(ptr uintptr) {
 
 // SetPropertyBody sets the "body" property.
 // The body text of the dialog.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyBody(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -687,6 +213,8 @@ func (x *MessageDialog) SetPropertyBody(value string) {
 
 // GetPropertyBody gets the "body" property.
 // The body text of the dialog.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyBody() string {
 	var v gobject.Value
 	x.GetProperty("body", &v)
@@ -696,7 +224,9 @@ func (x *MessageDialog) GetPropertyBody() string {
 // SetPropertyBodyUseMarkup sets the "body-use-markup" property.
 // Whether the body text includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyBodyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -707,7 +237,9 @@ func (x *MessageDialog) SetPropertyBodyUseMarkup(value bool) {
 // GetPropertyBodyUseMarkup gets the "body-use-markup" property.
 // Whether the body text includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyBodyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("body-use-markup", &v)
@@ -717,12 +249,14 @@ func (x *MessageDialog) GetPropertyBodyUseMarkup() bool {
 // SetPropertyCloseResponse sets the "close-response" property.
 // The ID of the close response.
 //
-// It will be passed to [signal@MessageDialog::response] if the window is
-// closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
+// It will be passed to MessageDialog::response if the window is
+// closed by pressing Escape or with a system action.
 //
 // It doesn't have to correspond to any of the responses in the dialog.
 //
 // The default close response is `close`.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyCloseResponse(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -733,12 +267,14 @@ func (x *MessageDialog) SetPropertyCloseResponse(value string) {
 // GetPropertyCloseResponse gets the "close-response" property.
 // The ID of the close response.
 //
-// It will be passed to [signal@MessageDialog::response] if the window is
-// closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system action.
+// It will be passed to MessageDialog::response if the window is
+// closed by pressing Escape or with a system action.
 //
 // It doesn't have to correspond to any of the responses in the dialog.
 //
 // The default close response is `close`.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyCloseResponse() string {
 	var v gobject.Value
 	x.GetProperty("close-response", &v)
@@ -754,7 +290,9 @@ func (x *MessageDialog) GetPropertyCloseResponse() string {
 // If not set, the default widget will not be set, and the last added response
 // will be focused by default.
 //
-// See [property@Gtk.Window:default-widget].
+// See Window:default-widget.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyDefaultResponse(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -771,7 +309,9 @@ func (x *MessageDialog) SetPropertyDefaultResponse(value string) {
 // If not set, the default widget will not be set, and the last added response
 // will be focused by default.
 //
-// See [property@Gtk.Window:default-widget].
+// See Window:default-widget.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyDefaultResponse() string {
 	var v gobject.Value
 	x.GetProperty("default-response", &v)
@@ -780,6 +320,8 @@ func (x *MessageDialog) GetPropertyDefaultResponse() string {
 
 // SetPropertyHeading sets the "heading" property.
 // The heading of the dialog.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyHeading(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -789,6 +331,8 @@ func (x *MessageDialog) SetPropertyHeading(value string) {
 
 // GetPropertyHeading gets the "heading" property.
 // The heading of the dialog.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyHeading() string {
 	var v gobject.Value
 	x.GetProperty("heading", &v)
@@ -798,7 +342,9 @@ func (x *MessageDialog) GetPropertyHeading() string {
 // SetPropertyHeadingUseMarkup sets the "heading-use-markup" property.
 // Whether the heading includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) SetPropertyHeadingUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -809,7 +355,9 @@ func (x *MessageDialog) SetPropertyHeadingUseMarkup(value bool) {
 // GetPropertyHeadingUseMarkup gets the "heading-use-markup" property.
 // Whether the heading includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) GetPropertyHeadingUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("heading-use-markup", &v)
@@ -818,59 +366,86 @@ func (x *MessageDialog) GetPropertyHeadingUseMarkup() bool {
 
 // This signal is emitted when the dialog is closed.
 //
-// @response will be set to the response ID of the button that had been
+// response will be set to the response ID of the button that had been
 // activated.
 //
-// if the dialog was closed by pressing &lt;kbd&gt;Escape&lt;/kbd&gt; or with a system
-// action, @response will be set to the value of
-// [property@MessageDialog:close-response].
+// if the dialog was closed by pressing Escape or with a system
+// action, response will be set to the value of
+// MessageDialog:close-response.
+//
+// Deprecated: since 1.6. Use AlertDialog.
 func (x *MessageDialog) ConnectResponse(cb *func(MessageDialog, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
+			fa := MessageDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-	fcb := func(clsPtr uintptr, ResponseVarp string) {
-		fa := MessageDialog{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
-
-		cbFn(fa, ResponseVarp)
+			cbFn(fa, ResponseVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "response", cbRefPtr, cbPtr)
 }
 
 // ConnectResponseWithDetail connects to the "response" signal with a detail string.
 // The detail is appended as "response::<detail>".
+//
+// Deprecated: use ConnectResponseWithDetailFunc, which also accepts method values and closures.
 func (x *MessageDialog) ConnectResponseWithDetail(detail string, cb *func(MessageDialog, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("response::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseVarp string) {
+			defer core.RecoverPanic()
+			fa := MessageDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResponseVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xMessageDialogResponseTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ResponseVarp string) {
+func xMessageDialogResponseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResponseVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MessageDialog, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MessageDialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResponseVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResponseFunc connects cb to the "response" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "response" signal is deprecated, see ConnectResponse.
+func (x *MessageDialog) ConnectResponseFunc(cb func(MessageDialog, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "response", &xMessageDialogResponseTrampoline, xMessageDialogResponseNewTrampoline, cb)
+}
+
+// ConnectResponseWithDetailFunc connects cb to the "response" signal with a detail string, see ConnectResponseFunc.
+// The detail is appended as "response::<detail>".
+func (x *MessageDialog) ConnectResponseWithDetailFunc(detail string, cb func(MessageDialog, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("response::%s", detail), &xMessageDialogResponseTrampoline, xMessageDialogResponseNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1034,7 +609,7 @@ func (x *MessageDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1042,14 +617,13 @@ func (x *MessageDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1073,19 +647,17 @@ func (x *MessageDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1106,7 +678,7 @@ func (x *MessageDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1115,14 +687,12 @@ func (x *MessageDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1141,10 +711,10 @@ func (x *MessageDialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MessageDialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1181,10 +751,10 @@ func (x *MessageDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *MessageDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	gtk.XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -1228,7 +798,7 @@ func (x *MessageDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *MessageDialog) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
@@ -1244,66 +814,16 @@ func (x *MessageDialog) GetFocus() *gtk.Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [gtk.Widget.GrabFocus] instead of
 // this function.
 func (x *MessageDialog) SetFocus(FocusVar *gtk.Widget) {
 
 	gtk.XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("ADW", "libadwaita-1")
-	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xMessageDialogGLibType, libs, "adw_message_dialog_get_type")
-
-	core.PuregoSafeRegister(&xNewMessageDialog, libs, "adw_message_dialog_new")
-
-	core.PuregoSafeRegister(&xMessageDialogAddResponse, libs, "adw_message_dialog_add_response")
-	core.PuregoSafeRegister(&xMessageDialogAddResponses, libs, "adw_message_dialog_add_responses")
-	core.PuregoSafeRegister(&xMessageDialogChoose, libs, "adw_message_dialog_choose")
-	core.PuregoSafeRegister(&xMessageDialogChooseFinish, libs, "adw_message_dialog_choose_finish")
-	core.PuregoSafeRegister(&xMessageDialogFormatBody, libs, "adw_message_dialog_format_body")
-	core.PuregoSafeRegister(&xMessageDialogFormatBodyMarkup, libs, "adw_message_dialog_format_body_markup")
-	core.PuregoSafeRegister(&xMessageDialogFormatHeading, libs, "adw_message_dialog_format_heading")
-	core.PuregoSafeRegister(&xMessageDialogFormatHeadingMarkup, libs, "adw_message_dialog_format_heading_markup")
-	core.PuregoSafeRegister(&xMessageDialogGetBody, libs, "adw_message_dialog_get_body")
-	core.PuregoSafeRegister(&xMessageDialogGetBodyUseMarkup, libs, "adw_message_dialog_get_body_use_markup")
-	core.PuregoSafeRegister(&xMessageDialogGetCloseResponse, libs, "adw_message_dialog_get_close_response")
-	core.PuregoSafeRegister(&xMessageDialogGetDefaultResponse, libs, "adw_message_dialog_get_default_response")
-	core.PuregoSafeRegister(&xMessageDialogGetExtraChild, libs, "adw_message_dialog_get_extra_child")
-	core.PuregoSafeRegister(&xMessageDialogGetHeading, libs, "adw_message_dialog_get_heading")
-	core.PuregoSafeRegister(&xMessageDialogGetHeadingUseMarkup, libs, "adw_message_dialog_get_heading_use_markup")
-	core.PuregoSafeRegister(&xMessageDialogGetResponseAppearance, libs, "adw_message_dialog_get_response_appearance")
-	core.PuregoSafeRegister(&xMessageDialogGetResponseEnabled, libs, "adw_message_dialog_get_response_enabled")
-	core.PuregoSafeRegister(&xMessageDialogGetResponseLabel, libs, "adw_message_dialog_get_response_label")
-	core.PuregoSafeRegister(&xMessageDialogHasResponse, libs, "adw_message_dialog_has_response")
-	core.PuregoSafeRegister(&xMessageDialogRemoveResponse, libs, "adw_message_dialog_remove_response")
-	core.PuregoSafeRegister(&xMessageDialogResponse, libs, "adw_message_dialog_response")
-	core.PuregoSafeRegister(&xMessageDialogSetBody, libs, "adw_message_dialog_set_body")
-	core.PuregoSafeRegister(&xMessageDialogSetBodyUseMarkup, libs, "adw_message_dialog_set_body_use_markup")
-	core.PuregoSafeRegister(&xMessageDialogSetCloseResponse, libs, "adw_message_dialog_set_close_response")
-	core.PuregoSafeRegister(&xMessageDialogSetDefaultResponse, libs, "adw_message_dialog_set_default_response")
-	core.PuregoSafeRegister(&xMessageDialogSetExtraChild, libs, "adw_message_dialog_set_extra_child")
-	core.PuregoSafeRegister(&xMessageDialogSetHeading, libs, "adw_message_dialog_set_heading")
-	core.PuregoSafeRegister(&xMessageDialogSetHeadingUseMarkup, libs, "adw_message_dialog_set_heading_use_markup")
-	core.PuregoSafeRegister(&xMessageDialogSetResponseAppearance, libs, "adw_message_dialog_set_response_appearance")
-	core.PuregoSafeRegister(&xMessageDialogSetResponseEnabled, libs, "adw_message_dialog_set_response_enabled")
-	core.PuregoSafeRegister(&xMessageDialogSetResponseLabel, libs, "adw_message_dialog_set_response_label")
-
-}
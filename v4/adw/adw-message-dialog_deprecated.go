@@ -0,0 +1,568 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xNewMessageDialog func(uintptr, uintptr, uintptr) uintptr
+
+// Creates a new `AdwMessageDialog`.
+//
+// heading and body can be set to `NULL`. This can be useful if they need to
+// be formatted or use markup. In that case, set them to `NULL` and call
+// format_body or similar methods afterwards:
+//
+//	GtkWidget *dialog;
+//
+//	dialog = adw_message_dialog_new (parent, _("Replace File?"), NULL);
+//	adw_message_dialog_format_body (ADW_MESSAGE_DIALOG (dialog),
+//	                                _("A file named “%s” already exists.  Do you want to replace it?"),
+//	                                filename);
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func NewMessageDialog(ParentVar *gtk.Window, HeadingVar *string, BodyVar *string) *MessageDialog {
+	var cls *MessageDialog
+
+	HeadingVarPtr := core.GStrdupNullable(HeadingVar)
+	defer core.GFreeNullable(HeadingVarPtr)
+
+	BodyVarPtr := core.GStrdupNullable(BodyVar)
+	defer core.GFreeNullable(BodyVarPtr)
+
+	cret := xNewMessageDialog(ParentVar.GoPointer(), HeadingVarPtr, BodyVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &MessageDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xMessageDialogAddResponse func(uintptr, string, string)
+
+// Adds a response with id and label to self.
+//
+// Responses are represented as buttons in the dialog.
+//
+// Response ID must be unique. It will be used in
+// MessageDialog::response to tell which response had been activated,
+// as well as to inspect and modify the response later.
+//
+// An embedded underline in label indicates a mnemonic.
+//
+// set_response_label can be used to change the response
+// label after it had been added.
+//
+// set_response_enabled and
+// set_response_appearance can be used to customize the
+// responses further.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) AddResponse(IdVar string, LabelVar string) {
+
+	xMessageDialogAddResponse(x.GoPointer(), IdVar, LabelVar)
+
+}
+
+var xMessageDialogAddResponses func(uintptr, string, ...interface{})
+
+// Adds multiple responses to self.
+//
+// This is the same as calling add_response repeatedly.
+// The variable argument list should be `NULL`-terminated list of response IDs
+// and labels.
+//
+// Example:
+//
+//	adw_message_dialog_add_responses (dialog,
+//	                                  "cancel",  _("_Cancel"),
+//	                                  "discard", _("_Discard"),
+//	                                  "save",    _("_Save"),
+//	                                  NULL);
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) AddResponses(FirstIdVar string, varArgs ...interface{}) {
+
+	xMessageDialogAddResponses(x.GoPointer(), FirstIdVar, varArgs...)
+
+}
+
+var xMessageDialogChoose func(uintptr, uintptr, uintptr, uintptr)
+
+// This function shows self to the user.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) Choose(CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xMessageDialogChoose(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
+
+}
+
+var xMessageDialogChooseFinish func(uintptr, uintptr) string
+
+// Finishes the choose call and returns the response ID.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) ChooseFinish(ResultVar gio.AsyncResult) string {
+
+	cret := xMessageDialogChooseFinish(x.GoPointer(), ResultVar.GoPointer())
+	return cret
+}
+
+var xMessageDialogFormatBody func(uintptr, string, ...interface{})
+
+// Sets the formatted body text of self.
+//
+// See MessageDialog:body.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) FormatBody(FormatVar string, varArgs ...interface{}) {
+
+	xMessageDialogFormatBody(x.GoPointer(), FormatVar, varArgs...)
+
+}
+
+var xMessageDialogFormatBodyMarkup func(uintptr, string, ...interface{})
+
+// Sets the formatted body text of self with Pango markup.
+//
+// The format is assumed to contain Pango markup.
+//
+// Special XML characters in the `printf()` arguments passed to this function
+// will  automatically be escaped as necessary, see
+// [glib.MarkupPrintfEscaped].
+//
+// See MessageDialog:body.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) FormatBodyMarkup(FormatVar string, varArgs ...interface{}) {
+
+	xMessageDialogFormatBodyMarkup(x.GoPointer(), FormatVar, varArgs...)
+
+}
+
+var xMessageDialogFormatHeading func(uintptr, string, ...interface{})
+
+// Sets the formatted heading of self.
+//
+// See MessageDialog:heading.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) FormatHeading(FormatVar string, varArgs ...interface{}) {
+
+	xMessageDialogFormatHeading(x.GoPointer(), FormatVar, varArgs...)
+
+}
+
+var xMessageDialogFormatHeadingMarkup func(uintptr, string, ...interface{})
+
+// Sets the formatted heading of self with Pango markup.
+//
+// The format is assumed to contain Pango markup.
+//
+// Special XML characters in the `printf()` arguments passed to this function
+// will automatically be escaped as necessary, see
+// [glib.MarkupPrintfEscaped].
+//
+// See MessageDialog:heading.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) FormatHeadingMarkup(FormatVar string, varArgs ...interface{}) {
+
+	xMessageDialogFormatHeadingMarkup(x.GoPointer(), FormatVar, varArgs...)
+
+}
+
+var xMessageDialogGetBody func(uintptr) string
+
+// Gets the body text of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetBody() string {
+
+	cret := xMessageDialogGetBody(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetBodyUseMarkup func(uintptr) bool
+
+// Gets whether the body text of self includes Pango markup.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetBodyUseMarkup() bool {
+
+	cret := xMessageDialogGetBodyUseMarkup(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetCloseResponse func(uintptr) string
+
+// Gets the ID of the close response of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetCloseResponse() string {
+
+	cret := xMessageDialogGetCloseResponse(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetDefaultResponse func(uintptr) string
+
+// Gets the ID of the default response of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetDefaultResponse() string {
+
+	cret := xMessageDialogGetDefaultResponse(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetExtraChild func(uintptr) uintptr
+
+// Gets the child widget of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetExtraChild() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xMessageDialogGetExtraChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xMessageDialogGetHeading func(uintptr) string
+
+// Gets the heading of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetHeading() string {
+
+	cret := xMessageDialogGetHeading(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetHeadingUseMarkup func(uintptr) bool
+
+// Gets whether the heading of self includes Pango markup.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetHeadingUseMarkup() bool {
+
+	cret := xMessageDialogGetHeadingUseMarkup(x.GoPointer())
+	return cret
+}
+
+var xMessageDialogGetResponseAppearance func(uintptr, string) ResponseAppearance
+
+// Gets the appearance of response.
+//
+// See set_response_appearance.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetResponseAppearance(ResponseVar string) ResponseAppearance {
+
+	cret := xMessageDialogGetResponseAppearance(x.GoPointer(), ResponseVar)
+	return cret
+}
+
+var xMessageDialogGetResponseEnabled func(uintptr, string) bool
+
+// Gets whether response is enabled.
+//
+// See set_response_enabled.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetResponseEnabled(ResponseVar string) bool {
+
+	cret := xMessageDialogGetResponseEnabled(x.GoPointer(), ResponseVar)
+	return cret
+}
+
+var xMessageDialogGetResponseLabel func(uintptr, string) string
+
+// Gets the label of response.
+//
+// See set_response_label.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) GetResponseLabel(ResponseVar string) string {
+
+	cret := xMessageDialogGetResponseLabel(x.GoPointer(), ResponseVar)
+	return cret
+}
+
+var xMessageDialogHasResponse func(uintptr, string) bool
+
+// Gets whether self has a response with the ID response.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) HasResponse(ResponseVar string) bool {
+
+	cret := xMessageDialogHasResponse(x.GoPointer(), ResponseVar)
+	return cret
+}
+
+var xMessageDialogRemoveResponse func(uintptr, string)
+
+// Removes a response from self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) RemoveResponse(IdVar string) {
+
+	xMessageDialogRemoveResponse(x.GoPointer(), IdVar)
+
+}
+
+var xMessageDialogResponse func(uintptr, string)
+
+// Emits the MessageDialog::response signal with the given response ID.
+//
+// Used to indicate that the user has responded to the dialog in some way.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) Response(ResponseVar string) {
+
+	xMessageDialogResponse(x.GoPointer(), ResponseVar)
+
+}
+
+var xMessageDialogSetBody func(uintptr, string)
+
+// Sets the body text of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetBody(BodyVar string) {
+
+	xMessageDialogSetBody(x.GoPointer(), BodyVar)
+
+}
+
+var xMessageDialogSetBodyUseMarkup func(uintptr, bool)
+
+// Sets whether the body text of self includes Pango markup.
+//
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetBodyUseMarkup(UseMarkupVar bool) {
+
+	xMessageDialogSetBodyUseMarkup(x.GoPointer(), UseMarkupVar)
+
+}
+
+var xMessageDialogSetCloseResponse func(uintptr, string)
+
+// Sets the ID of the close response of self.
+//
+// It will be passed to MessageDialog::response if the window is
+// closed by pressing Escape or with a system action.
+//
+// It doesn't have to correspond to any of the responses in the dialog.
+//
+// The default close response is `close`.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetCloseResponse(ResponseVar string) {
+
+	xMessageDialogSetCloseResponse(x.GoPointer(), ResponseVar)
+
+}
+
+var xMessageDialogSetDefaultResponse func(uintptr, uintptr)
+
+// Sets the ID of the default response of self.
+//
+// The button corresponding to this response will be set as the default widget
+// of self.
+//
+// If not set, the default widget will not be set, and the last added response
+// will be focused by default.
+//
+// See Window:default-widget.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetDefaultResponse(ResponseVar *string) {
+
+	ResponseVarPtr := core.GStrdupNullable(ResponseVar)
+	defer core.GFreeNullable(ResponseVarPtr)
+
+	xMessageDialogSetDefaultResponse(x.GoPointer(), ResponseVarPtr)
+
+}
+
+var xMessageDialogSetExtraChild func(uintptr, uintptr)
+
+// Sets the child widget of self.
+//
+// The child widget is displayed below the heading and body.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetExtraChild(ChildVar *gtk.Widget) {
+
+	xMessageDialogSetExtraChild(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xMessageDialogSetHeading func(uintptr, uintptr)
+
+// Sets the heading of self.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetHeading(HeadingVar *string) {
+
+	HeadingVarPtr := core.GStrdupNullable(HeadingVar)
+	defer core.GFreeNullable(HeadingVarPtr)
+
+	xMessageDialogSetHeading(x.GoPointer(), HeadingVarPtr)
+
+}
+
+var xMessageDialogSetHeadingUseMarkup func(uintptr, bool)
+
+// Sets whether the heading of self includes Pango markup.
+//
+// See [pango.ParseMarkup].
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetHeadingUseMarkup(UseMarkupVar bool) {
+
+	xMessageDialogSetHeadingUseMarkup(x.GoPointer(), UseMarkupVar)
+
+}
+
+var xMessageDialogSetResponseAppearance func(uintptr, string, ResponseAppearance)
+
+// Sets the appearance for response.
+//
+// Use `ADW_RESPONSE_SUGGESTED` to mark important responses such as the
+// affirmative action, like the Save button in the example.
+//
+// Use `ADW_RESPONSE_DESTRUCTIVE` to draw attention to the potentially damaging
+// consequences of using response. This appearance acts as a warning to the
+// user. The Discard button in the example is using this appearance.
+//
+// The default appearance is `ADW_RESPONSE_DEFAULT`.
+//
+// Negative responses like Cancel or Close should use the default appearance.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetResponseAppearance(ResponseVar string, AppearanceVar ResponseAppearance) {
+
+	xMessageDialogSetResponseAppearance(x.GoPointer(), ResponseVar, AppearanceVar)
+
+}
+
+var xMessageDialogSetResponseEnabled func(uintptr, string, bool)
+
+// Sets whether response is enabled.
+//
+// If response is not enabled, the corresponding button will have
+// [gtk.Widget.GetPropertySensitive] set to `FALSE` and it can't be activated as
+// a default response.
+//
+// response can still be used as MessageDialog:close-response while
+// it's not enabled.
+//
+// Responses are enabled by default.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetResponseEnabled(ResponseVar string, EnabledVar bool) {
+
+	xMessageDialogSetResponseEnabled(x.GoPointer(), ResponseVar, EnabledVar)
+
+}
+
+var xMessageDialogSetResponseLabel func(uintptr, string, string)
+
+// Sets the label of response to label.
+//
+// Labels are displayed on the dialog buttons. An embedded underline in label
+// indicates a mnemonic.
+//
+// Deprecated: since 1.6. Use AlertDialog.
+func (x *MessageDialog) SetResponseLabel(ResponseVar string, LabelVar string) {
+
+	xMessageDialogSetResponseLabel(x.GoPointer(), ResponseVar, LabelVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewMessageDialog, libs, "adw_message_dialog_new", "ADW", "1.2")
+
+	core.PuregoRegisterSince(&xMessageDialogAddResponse, libs, "adw_message_dialog_add_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogAddResponses, libs, "adw_message_dialog_add_responses", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogChoose, libs, "adw_message_dialog_choose", "ADW", "1.3")
+	core.PuregoRegisterSince(&xMessageDialogChooseFinish, libs, "adw_message_dialog_choose_finish", "ADW", "1.3")
+	core.PuregoRegisterSince(&xMessageDialogFormatBody, libs, "adw_message_dialog_format_body", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogFormatBodyMarkup, libs, "adw_message_dialog_format_body_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogFormatHeading, libs, "adw_message_dialog_format_heading", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogFormatHeadingMarkup, libs, "adw_message_dialog_format_heading_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetBody, libs, "adw_message_dialog_get_body", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetBodyUseMarkup, libs, "adw_message_dialog_get_body_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetCloseResponse, libs, "adw_message_dialog_get_close_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetDefaultResponse, libs, "adw_message_dialog_get_default_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetExtraChild, libs, "adw_message_dialog_get_extra_child", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetHeading, libs, "adw_message_dialog_get_heading", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetHeadingUseMarkup, libs, "adw_message_dialog_get_heading_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetResponseAppearance, libs, "adw_message_dialog_get_response_appearance", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetResponseEnabled, libs, "adw_message_dialog_get_response_enabled", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogGetResponseLabel, libs, "adw_message_dialog_get_response_label", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogHasResponse, libs, "adw_message_dialog_has_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogRemoveResponse, libs, "adw_message_dialog_remove_response", "ADW", "1.5")
+	core.PuregoRegisterSince(&xMessageDialogResponse, libs, "adw_message_dialog_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetBody, libs, "adw_message_dialog_set_body", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetBodyUseMarkup, libs, "adw_message_dialog_set_body_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetCloseResponse, libs, "adw_message_dialog_set_close_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetDefaultResponse, libs, "adw_message_dialog_set_default_response", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetExtraChild, libs, "adw_message_dialog_set_extra_child", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetHeading, libs, "adw_message_dialog_set_heading", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetHeadingUseMarkup, libs, "adw_message_dialog_set_heading_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetResponseAppearance, libs, "adw_message_dialog_set_response_appearance", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetResponseEnabled, libs, "adw_message_dialog_set_response_enabled", "ADW", "1.2")
+	core.PuregoRegisterSince(&xMessageDialogSetResponseLabel, libs, "adw_message_dialog_set_response_label", "ADW", "1.2")
+
+}
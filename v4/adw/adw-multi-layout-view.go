@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -27,81 +26,77 @@ func (x *MultiLayoutViewClass) GoPointer() uintptr {
 // `AdwMultiLayoutView` contains layouts and children. Each child has
 // an ID, each layout has slots inside it, each slot also has an ID. When
 // switching layouts, children are inserted into slots with matching IDs. The
-// [property@Gtk.Widget:visible] property of each slot is updated to match
+// [gtk.Widget.GetPropertyVisible] property of each slot is updated to match
 // that of the inserted child.
 //
 // This can be useful for rearranging children when it's difficult to do so
 // otherwise, for example to move a child from a sidebar to a bottom bar.
 //
 // The currently used layout can be switched using the
-// [property@MultiLayoutView:layout] or [property@MultiLayoutView:layout-name]
-// properties. For example, it can be done via a [class@Adw.Breakpoint] setter
+// MultiLayoutView:layout or MultiLayoutView:layout-name
+// properties. For example, it can be done via a [Breakpoint] setter
 // to change layouts depending on the window size.
 //
-// ## AdwMultiLayoutView as GtkBuildable
+// # AdwMultiLayoutView as GtkBuildable
 //
-// The `AdwMultiLayoutView` implementation of the [iface@Gtk.Buildable]
-// interface supports adding layouts via `&lt;child&gt;` element with the `type`
+// The `AdwMultiLayoutView` implementation of the [gtk.Buildable]
+// interface supports adding layouts via `<child>` element with the `type`
 // attribute omitted.
 //
-// It also supports setting children via `&lt;child type="ID"&gt;`.
+// It also supports setting children via `<child type="ID">`.
 //
 // Example of an `AdwMultiLayoutView` UI definition that can display a secondary
 // child as either a sidebar or a bottom sheet.
 //
-// ```xml
-// &lt;object class="AdwMultiLayoutView"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="AdwLayout"&gt;
-//	    &lt;property name="name"&gt;sidebar&lt;/property&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwOverlaySplitView"&gt;
-//	        &lt;property name="sidebar"&gt;
-//	          &lt;object class="AdwLayoutSlot"&gt;
-//	            &lt;property name="id"&gt;secondary&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	        &lt;property name="content"&gt;
-//	          &lt;object class="AdwLayoutSlot"&gt;
-//	            &lt;property name="id"&gt;primary&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwLayout"&gt;
-//	    &lt;property name="name"&gt;bottom-sheet&lt;/property&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwBottomSheet"&gt;
-//	        &lt;property name="open"&gt;True&lt;/property&gt;
-//	        &lt;property name="content"&gt;
-//	          &lt;object class="AdwLayoutSlot"&gt;
-//	            &lt;property name="id"&gt;primary&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	        &lt;property name="sheet"&gt;
-//	          &lt;object class="AdwLayoutSlot"&gt;
-//	            &lt;property name="id"&gt;secondary&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child type="primary"&gt;
-//	  &lt;!-- ... --&gt;
-//	&lt;/child&gt;
-//	&lt;child type="secondary"&gt;
-//	  &lt;!-- ... --&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ## CSS nodes
+//	<object class="AdwMultiLayoutView">
+//	  <child>
+//	    <object class="AdwLayout">
+//	      <property name="name">sidebar</property>
+//	      <property name="content">
+//	        <object class="AdwOverlaySplitView">
+//	          <property name="sidebar">
+//	            <object class="AdwLayoutSlot">
+//	              <property name="id">secondary</property>
+//	            </object>
+//	          </property>
+//	          <property name="content">
+//	            <object class="AdwLayoutSlot">
+//	              <property name="id">primary</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="AdwLayout">
+//	      <property name="name">bottom-sheet</property>
+//	      <property name="content">
+//	        <object class="AdwBottomSheet">
+//	          <property name="open">True</property>
+//	          <property name="content">
+//	            <object class="AdwLayoutSlot">
+//	              <property name="id">primary</property>
+//	            </object>
+//	          </property>
+//	          <property name="sheet">
+//	            <object class="AdwLayoutSlot">
+//	              <property name="id">secondary</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
+//	  <child type="primary">
+//	    <!-- ... -->
+//	  </child>
+//	  <child type="secondary">
+//	    <!-- ... -->
+//	  </child>
+//	</object>
+//
+// # CSS nodes
 //
 // `AdwMultiLayoutView` has a single CSS node with name `multi-layout-view`.
 type MultiLayoutView struct {
@@ -139,7 +134,7 @@ func NewMultiLayoutView() *MultiLayoutView {
 
 var xMultiLayoutViewAddLayout func(uintptr, uintptr)
 
-// Adds @layout to @self.
+// Adds layout to self.
 func (x *MultiLayoutView) AddLayout(LayoutVar *Layout) {
 
 	xMultiLayoutViewAddLayout(x.GoPointer(), LayoutVar.GoPointer())
@@ -148,7 +143,7 @@ func (x *MultiLayoutView) AddLayout(LayoutVar *Layout) {
 
 var xMultiLayoutViewGetChild func(uintptr, string) uintptr
 
-// Gets the child for @id to @self.
+// Gets the child for id to self.
 func (x *MultiLayoutView) GetChild(IdVar string) *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -165,7 +160,7 @@ func (x *MultiLayoutView) GetChild(IdVar string) *gtk.Widget {
 
 var xMultiLayoutViewGetLayout func(uintptr) uintptr
 
-// Gets the currently used layout of @self.
+// Gets the currently used layout of self.
 func (x *MultiLayoutView) GetLayout() *Layout {
 	var cls *Layout
 
@@ -182,9 +177,9 @@ func (x *MultiLayoutView) GetLayout() *Layout {
 
 var xMultiLayoutViewGetLayoutByName func(uintptr, string) uintptr
 
-// Gets layout with the name @name from @self, or `NULL` if it doesn't exist.
+// Gets layout with the name name from self, or `NULL` if it doesn't exist.
 //
-// See [property@Layout:name].
+// See Layout:name.
 func (x *MultiLayoutView) GetLayoutByName(NameVar string) *Layout {
 	var cls *Layout
 
@@ -201,7 +196,7 @@ func (x *MultiLayoutView) GetLayoutByName(NameVar string) *Layout {
 
 var xMultiLayoutViewGetLayoutName func(uintptr) string
 
-// Returns the name of the currently used layout of @self.
+// Returns the name of the currently used layout of self.
 func (x *MultiLayoutView) GetLayoutName() string {
 
 	cret := xMultiLayoutViewGetLayoutName(x.GoPointer())
@@ -210,7 +205,7 @@ func (x *MultiLayoutView) GetLayoutName() string {
 
 var xMultiLayoutViewRemoveLayout func(uintptr, uintptr)
 
-// Removes @layout from @self.
+// Removes layout from self.
 func (x *MultiLayoutView) RemoveLayout(LayoutVar *Layout) {
 
 	xMultiLayoutViewRemoveLayout(x.GoPointer(), LayoutVar.GoPointer())
@@ -219,9 +214,9 @@ func (x *MultiLayoutView) RemoveLayout(LayoutVar *Layout) {
 
 var xMultiLayoutViewSetChild func(uintptr, string, uintptr)
 
-// Sets @child as the child for @id in @self.
+// Sets child as the child for id in self.
 //
-// When changing layouts, it will be inserted into the slot with @id.
+// When changing layouts, it will be inserted into the slot with id.
 func (x *MultiLayoutView) SetChild(IdVar string, ChildVar *gtk.Widget) {
 
 	xMultiLayoutViewSetChild(x.GoPointer(), IdVar, ChildVar.GoPointer())
@@ -230,7 +225,7 @@ func (x *MultiLayoutView) SetChild(IdVar string, ChildVar *gtk.Widget) {
 
 var xMultiLayoutViewSetLayout func(uintptr, uintptr)
 
-// Makes @layout the current layout of @self.
+// Makes layout the current layout of self.
 func (x *MultiLayoutView) SetLayout(LayoutVar *Layout) {
 
 	xMultiLayoutViewSetLayout(x.GoPointer(), LayoutVar.GoPointer())
@@ -239,9 +234,9 @@ func (x *MultiLayoutView) SetLayout(LayoutVar *Layout) {
 
 var xMultiLayoutViewSetLayoutName func(uintptr, string)
 
-// Makes the layout with @name the current layout of @self.
+// Makes the layout with name the current layout of self.
 //
-// See [property@Layout:name].
+// See Layout:name.
 func (x *MultiLayoutView) SetLayoutName(NameVar string) {
 
 	xMultiLayoutViewSetLayoutName(x.GoPointer(), NameVar)
@@ -262,7 +257,7 @@ func (c *MultiLayoutView) SetGoPointer(ptr uintptr) {
 // SetPropertyLayoutName sets the "layout-name" property.
 // The name of the currently used layout.
 //
-// See [property@Layout:name].
+// See Layout:name.
 func (x *MultiLayoutView) SetPropertyLayoutName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -273,7 +268,7 @@ func (x *MultiLayoutView) SetPropertyLayoutName(value string) {
 // GetPropertyLayoutName gets the "layout-name" property.
 // The name of the currently used layout.
 //
-// See [property@Layout:name].
+// See Layout:name.
 func (x *MultiLayoutView) GetPropertyLayoutName() string {
 	var v gobject.Value
 	x.GetProperty("layout-name", &v)
@@ -441,7 +436,7 @@ func (x *MultiLayoutView) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -449,14 +444,13 @@ func (x *MultiLayoutView) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *MultiLayoutView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -480,19 +474,17 @@ func (x *MultiLayoutView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *MultiLayoutView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -513,7 +505,7 @@ func (x *MultiLayoutView) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -522,14 +514,12 @@ func (x *MultiLayoutView) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *MultiLayoutView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -548,10 +538,10 @@ func (x *MultiLayoutView) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MultiLayoutView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -563,7 +553,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -572,16 +562,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xMultiLayoutViewGLibType, libs, "adw_multi_layout_view_get_type")
 
-	core.PuregoSafeRegister(&xNewMultiLayoutView, libs, "adw_multi_layout_view_new")
-
-	core.PuregoSafeRegister(&xMultiLayoutViewAddLayout, libs, "adw_multi_layout_view_add_layout")
-	core.PuregoSafeRegister(&xMultiLayoutViewGetChild, libs, "adw_multi_layout_view_get_child")
-	core.PuregoSafeRegister(&xMultiLayoutViewGetLayout, libs, "adw_multi_layout_view_get_layout")
-	core.PuregoSafeRegister(&xMultiLayoutViewGetLayoutByName, libs, "adw_multi_layout_view_get_layout_by_name")
-	core.PuregoSafeRegister(&xMultiLayoutViewGetLayoutName, libs, "adw_multi_layout_view_get_layout_name")
-	core.PuregoSafeRegister(&xMultiLayoutViewRemoveLayout, libs, "adw_multi_layout_view_remove_layout")
-	core.PuregoSafeRegister(&xMultiLayoutViewSetChild, libs, "adw_multi_layout_view_set_child")
-	core.PuregoSafeRegister(&xMultiLayoutViewSetLayout, libs, "adw_multi_layout_view_set_layout")
-	core.PuregoSafeRegister(&xMultiLayoutViewSetLayoutName, libs, "adw_multi_layout_view_set_layout_name")
+	gobject.CheckClassSize("AdwMultiLayoutViewClass", xMultiLayoutViewGLibType, unsafe.Sizeof(MultiLayoutViewClass{}))
+
+	core.PuregoRegisterSince(&xNewMultiLayoutView, libs, "adw_multi_layout_view_new", "ADW", "1.6")
+
+	core.PuregoRegisterSince(&xMultiLayoutViewAddLayout, libs, "adw_multi_layout_view_add_layout", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewGetChild, libs, "adw_multi_layout_view_get_child", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewGetLayout, libs, "adw_multi_layout_view_get_layout", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewGetLayoutByName, libs, "adw_multi_layout_view_get_layout_by_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewGetLayoutName, libs, "adw_multi_layout_view_get_layout_name", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewRemoveLayout, libs, "adw_multi_layout_view_remove_layout", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewSetChild, libs, "adw_multi_layout_view_set_child", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewSetLayout, libs, "adw_multi_layout_view_set_layout", "ADW", "1.6")
+	core.PuregoRegisterSince(&xMultiLayoutViewSetLayoutName, libs, "adw_multi_layout_view_set_layout_name", "ADW", "1.6")
 
 }
@@ -1,7 +1,12 @@
 // Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package adw
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 // Describes the direction of a swipe navigation gesture.
 type NavigationDirection int
@@ -19,3 +24,52 @@ const (
 	// Corresponds to end or bottom, depending on orientation and text direction
 	NavigationDirectionForwardValue NavigationDirection = 1
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x NavigationDirection) String() string {
+	switch x {
+	case NavigationDirectionBackValue:
+		return "ADW_NAVIGATION_DIRECTION_BACK"
+	case NavigationDirectionForwardValue:
+		return "ADW_NAVIGATION_DIRECTION_FORWARD"
+	}
+	return fmt.Sprintf("NavigationDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NavigationDirection) Nick() string {
+	switch x {
+	case NavigationDirectionBackValue:
+		return "back"
+	case NavigationDirectionForwardValue:
+		return "forward"
+	}
+	return ""
+}
+
+// NavigationDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NavigationDirectionFromString(s string) (NavigationDirection, bool) {
+	switch s {
+	case "ADW_NAVIGATION_DIRECTION_BACK", "back":
+		return NavigationDirectionBackValue, true
+	case "ADW_NAVIGATION_DIRECTION_FORWARD", "forward":
+		return NavigationDirectionForwardValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xNavigationDirectionGLibType, libs, "adw_navigation_direction_get_type")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,115 +23,98 @@ func (x *NavigationSplitViewClass) GoPointer() uintptr {
 
 // A widget presenting sidebar and content side by side or as a navigation view.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="navigation-split-view-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="navigation-split-view.png" alt="navigation-split-view"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="navigation-split-view-collapsed-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="navigation-split-view-collapsed.png" alt="navigation-split-view-collapsed"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwNavigationSplitView` has two [class@NavigationPage] children: sidebar and
+// `AdwNavigationSplitView` has two NavigationPage children: sidebar and
 // content, and displays them side by side.
 //
-// When [property@NavigationSplitView:collapsed] is set to `TRUE`, it instead
-// puts both children inside an [class@NavigationView]. The
-// [property@NavigationSplitView:show-content] controls which child is visible
+// When NavigationSplitView:collapsed is set to `TRUE`, it instead
+// puts both children inside an NavigationView. The
+// NavigationSplitView:show-content controls which child is visible
 // while collapsed.
 //
-// See also [class@OverlaySplitView].
+// See also OverlaySplitView.
 //
-// `AdwNavigationSplitView` is typically used together with an [class@Breakpoint]
+// `AdwNavigationSplitView` is typically used together with an Breakpoint
 // setting the `collapsed` property to `TRUE` on small widths, as follows:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;property name="width-request"&gt;280&lt;/property&gt;
-//	&lt;property name="height-request"&gt;200&lt;/property&gt;
-//	&lt;property name="default-width"&gt;800&lt;/property&gt;
-//	&lt;property name="default-height"&gt;800&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 400sp&lt;/condition&gt;
-//	    &lt;setter object="split_view" property="collapsed"&gt;True&lt;/setter&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwNavigationSplitView" id="split_view"&gt;
-//	    &lt;property name="sidebar"&gt;
-//	      &lt;object class="AdwNavigationPage"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Sidebar&lt;/property&gt;
-//	        &lt;property name="child"&gt;
-//	          &lt;!-- ... --&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwNavigationPage"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Content&lt;/property&gt;
-//	        &lt;property name="child"&gt;
-//	          &lt;!-- ... --&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ## Sizing
+//	<object class="AdwWindow">
+//	  <property name="width-request">280</property>
+//	  <property name="height-request">200</property>
+//	  <property name="default-width">800</property>
+//	  <property name="default-height">800</property>
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 400sp</condition>
+//	      <setter object="split_view" property="collapsed">True</setter>
+//	    </object>
+//	  </child>
+//	  <property name="content">
+//	    <object class="AdwNavigationSplitView" id="split_view">
+//	      <property name="sidebar">
+//	        <object class="AdwNavigationPage">
+//	          <property name="title" translatable="yes">Sidebar</property>
+//	          <property name="child">
+//	            <!-- ... -->
+//	          </property>
+//	        </object>
+//	      </property>
+//	      <property name="content">
+//	        <object class="AdwNavigationPage">
+//	          <property name="title" translatable="yes">Content</property>
+//	          <property name="child">
+//	            <!-- ... -->
+//	          </property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// # Sizing
 //
 // When not collapsed, `AdwNavigationSplitView` changes the sidebar width
 // depending on its own width.
 //
 // If possible, it tries to allocate a fraction of the total width, controlled
-// with the [property@NavigationSplitView:sidebar-width-fraction] property.
+// with the NavigationSplitView:sidebar-width-fraction property.
 //
 // The sidebar also has minimum and maximum sizes, controlled with the
-// [property@NavigationSplitView:min-sidebar-width] and
-// [property@NavigationSplitView:max-sidebar-width] properties.
+// NavigationSplitView:min-sidebar-width and
+// NavigationSplitView:max-sidebar-width properties.
 //
 // The minimum and maximum sizes are using the length unit specified with the
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // By default, sidebar is using 25% of the total width, with 180sp as the
 // minimum size and 280sp as the maximum size.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When used inside `AdwNavigationSplitView`, [class@HeaderBar] will
+// When used inside `AdwNavigationSplitView`, HeaderBar will
 // automatically hide the window buttons in the middle.
 //
 // When collapsed, it also displays a back button for the content widget, as
-// well as the page titles. See [class@NavigationView] documentation for details.
+// well as the page titles. See NavigationView documentation for details.
 //
-// ## Actions
+// # Actions
 //
 // `AdwNavigationSplitView` defines the same actions as `AdwNavigationView`, but
 // they can be used even when the split view is not collapsed:
 //
 // - `navigation.push` takes a string parameter specifying the tag of the page
 // to push. If it matches the tag of the content widget, it sets
-// [property@NavigationSplitView:show-content] to `TRUE`.
+// NavigationSplitView:show-content to `TRUE`.
 //
 // - `navigation.pop` doesn't take any parameters and sets
-// [property@NavigationSplitView:show-content] to `FALSE`.
+// NavigationSplitView:show-content to `FALSE`.
 //
-// ## `AdwNavigationSplitView` as `GtkBuildable`
+// # `AdwNavigationSplitView` as `GtkBuildable`
 //
-// The `AdwNavigationSplitView` implementation of the [iface@Gtk.Buildable]
+// The `AdwNavigationSplitView` implementation of the [gtk.Buildable]
 // interface supports setting the sidebar widget by specifying “sidebar” as the
-// “type” attribute of a `&lt;child&gt;` element, Specifying “content” child type or
+// “type” attribute of a `<child>` element, Specifying “content” child type or
 // omitting it results in setting the content widget.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwNavigationSplitView` has a single CSS node with the name
 // `navigation-split-view`.
@@ -140,30 +122,22 @@ func (x *NavigationSplitViewClass) GoPointer() uintptr {
 // When collapsed, it contains a child node with the name `navigation-view`
 // containing both children.
 //
-// ```
-// navigation-split-view
-// ╰── navigation-view
-//
-//	├── [sidebar child]
-//	╰── [content child]
-//
-// ```
+//	navigation-split-view
+//	╰── navigation-view
+//	    ├── [sidebar child]
+//	    ╰── [content child]
 //
 // When not collapsed, it contains two nodes with the name `widget`, one with
 // the `.sidebar-pane` style class, the other one with `.content-view` style
 // class, containing the sidebar and content children respectively.
 //
-// ```
-// navigation-split-view
-// ├── widget.sidebar-pane
-// │   ╰── [sidebar child]
-// ╰── widget.content-pane
-//
-//	╰── [content child]
-//
-// ```
+//	navigation-split-view
+//	├── widget.sidebar-pane
+//	│   ╰── [sidebar child]
+//	╰── widget.content-pane
+//	    ╰── [content child]
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwNavigationSplitView` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type NavigationSplitView struct {
@@ -201,7 +175,7 @@ func NewNavigationSplitView() *NavigationSplitView {
 
 var xNavigationSplitViewGetCollapsed func(uintptr) bool
 
-// Gets whether @self is collapsed.
+// Gets whether self is collapsed.
 func (x *NavigationSplitView) GetCollapsed() bool {
 
 	cret := xNavigationSplitViewGetCollapsed(x.GoPointer())
@@ -210,7 +184,7 @@ func (x *NavigationSplitView) GetCollapsed() bool {
 
 var xNavigationSplitViewGetContent func(uintptr) uintptr
 
-// Sets the content widget for @self.
+// Sets the content widget for self.
 func (x *NavigationSplitView) GetContent() *NavigationPage {
 	var cls *NavigationPage
 
@@ -227,7 +201,7 @@ func (x *NavigationSplitView) GetContent() *NavigationPage {
 
 var xNavigationSplitViewGetMaxSidebarWidth func(uintptr) float64
 
-// Gets the maximum sidebar width for @self.
+// Gets the maximum sidebar width for self.
 func (x *NavigationSplitView) GetMaxSidebarWidth() float64 {
 
 	cret := xNavigationSplitViewGetMaxSidebarWidth(x.GoPointer())
@@ -236,7 +210,7 @@ func (x *NavigationSplitView) GetMaxSidebarWidth() float64 {
 
 var xNavigationSplitViewGetMinSidebarWidth func(uintptr) float64
 
-// Gets the minimum sidebar width for @self.
+// Gets the minimum sidebar width for self.
 func (x *NavigationSplitView) GetMinSidebarWidth() float64 {
 
 	cret := xNavigationSplitViewGetMinSidebarWidth(x.GoPointer())
@@ -245,7 +219,7 @@ func (x *NavigationSplitView) GetMinSidebarWidth() float64 {
 
 var xNavigationSplitViewGetShowContent func(uintptr) bool
 
-// Gets which page is visible when @self is collapsed.
+// Gets which page is visible when self is collapsed.
 func (x *NavigationSplitView) GetShowContent() bool {
 
 	cret := xNavigationSplitViewGetShowContent(x.GoPointer())
@@ -254,7 +228,7 @@ func (x *NavigationSplitView) GetShowContent() bool {
 
 var xNavigationSplitViewGetSidebar func(uintptr) uintptr
 
-// Gets the sidebar widget for @self.
+// Gets the sidebar widget for self.
 func (x *NavigationSplitView) GetSidebar() *NavigationPage {
 	var cls *NavigationPage
 
@@ -271,7 +245,7 @@ func (x *NavigationSplitView) GetSidebar() *NavigationPage {
 
 var xNavigationSplitViewGetSidebarPosition func(uintptr) gtk.PackType
 
-// Gets the sidebar position for @self.
+// Gets the sidebar position for self.
 func (x *NavigationSplitView) GetSidebarPosition() gtk.PackType {
 
 	cret := xNavigationSplitViewGetSidebarPosition(x.GoPointer())
@@ -280,7 +254,7 @@ func (x *NavigationSplitView) GetSidebarPosition() gtk.PackType {
 
 var xNavigationSplitViewGetSidebarWidthFraction func(uintptr) float64
 
-// Gets the preferred sidebar width fraction for @self.
+// Gets the preferred sidebar width fraction for self.
 func (x *NavigationSplitView) GetSidebarWidthFraction() float64 {
 
 	cret := xNavigationSplitViewGetSidebarWidthFraction(x.GoPointer())
@@ -298,12 +272,12 @@ func (x *NavigationSplitView) GetSidebarWidthUnit() LengthUnit {
 
 var xNavigationSplitViewSetCollapsed func(uintptr, bool)
 
-// Sets whether @self is collapsed.
+// Sets whether self is collapsed.
 //
-// When collapsed, the children are put inside an [class@NavigationView],
+// When collapsed, the children are put inside an NavigationView,
 // otherwise they are displayed side by side.
 //
-// The [property@NavigationSplitView:show-content] controls which child is
+// The NavigationSplitView:show-content controls which child is
 // visible while collapsed.
 func (x *NavigationSplitView) SetCollapsed(CollapsedVar bool) {
 
@@ -313,7 +287,7 @@ func (x *NavigationSplitView) SetCollapsed(CollapsedVar bool) {
 
 var xNavigationSplitViewSetContent func(uintptr, uintptr)
 
-// Sets the content widget for @self.
+// Sets the content widget for self.
 func (x *NavigationSplitView) SetContent(ContentVar *NavigationPage) {
 
 	xNavigationSplitViewSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -322,10 +296,10 @@ func (x *NavigationSplitView) SetContent(ContentVar *NavigationPage) {
 
 var xNavigationSplitViewSetMaxSidebarWidth func(uintptr, float64)
 
-// Sets the maximum sidebar width for @self.
+// Sets the maximum sidebar width for self.
 //
 // Maximum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -337,10 +311,10 @@ func (x *NavigationSplitView) SetMaxSidebarWidth(WidthVar float64) {
 
 var xNavigationSplitViewSetMinSidebarWidth func(uintptr, float64)
 
-// Sets the minimum sidebar width for @self.
+// Sets the minimum sidebar width for self.
 //
 // Minimum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -352,10 +326,10 @@ func (x *NavigationSplitView) SetMinSidebarWidth(WidthVar float64) {
 
 var xNavigationSplitViewSetShowContent func(uintptr, bool)
 
-// Sets which page is visible when @self is collapsed.
+// Sets which page is visible when self is collapsed.
 //
 // If set to `TRUE`, the content widget will be the visible page when
-// [property@NavigationSplitView:collapsed] is `TRUE`; otherwise the sidebar
+// NavigationSplitView:collapsed is `TRUE`; otherwise the sidebar
 // widget will be visible.
 //
 // If the split view is already collapsed, the visible page changes immediately.
@@ -367,7 +341,7 @@ func (x *NavigationSplitView) SetShowContent(ShowContentVar bool) {
 
 var xNavigationSplitViewSetSidebar func(uintptr, uintptr)
 
-// Sets the sidebar widget for @self.
+// Sets the sidebar widget for self.
 func (x *NavigationSplitView) SetSidebar(SidebarVar *NavigationPage) {
 
 	xNavigationSplitViewSetSidebar(x.GoPointer(), SidebarVar.GoPointer())
@@ -376,7 +350,7 @@ func (x *NavigationSplitView) SetSidebar(SidebarVar *NavigationPage) {
 
 var xNavigationSplitViewSetSidebarPosition func(uintptr, gtk.PackType)
 
-// Sets the sidebar position for @self.
+// Sets the sidebar position for self.
 //
 // If set to `GTK_PACK_START`, the sidebar is displayed before the content,
 // and the sidebar will be the root page when collapsed.
@@ -391,11 +365,11 @@ func (x *NavigationSplitView) SetSidebarPosition(PositionVar gtk.PackType) {
 
 var xNavigationSplitViewSetSidebarWidthFraction func(uintptr, float64)
 
-// Sets the preferred sidebar width as a fraction of the total width of @self.
+// Sets the preferred sidebar width as a fraction of the total width of self.
 //
 // The preferred width is additionally limited by
-// [property@NavigationSplitView:min-sidebar-width] and
-// [property@NavigationSplitView:max-sidebar-width].
+// NavigationSplitView:min-sidebar-width and
+// NavigationSplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -409,8 +383,8 @@ var xNavigationSplitViewSetSidebarWidthUnit func(uintptr, LengthUnit)
 
 // Sets the length unit for minimum and maximum sidebar widths.
 //
-// See [property@NavigationSplitView:min-sidebar-width] and
-// [property@NavigationSplitView:max-sidebar-width].
+// See NavigationSplitView:min-sidebar-width and
+// NavigationSplitView:max-sidebar-width.
 func (x *NavigationSplitView) SetSidebarWidthUnit(UnitVar LengthUnit) {
 
 	xNavigationSplitViewSetSidebarWidthUnit(x.GoPointer(), UnitVar)
@@ -431,10 +405,10 @@ func (c *NavigationSplitView) SetGoPointer(ptr uintptr) {
 // SetPropertyCollapsed sets the "collapsed" property.
 // Whether the split view is collapsed.
 //
-// When collapsed, the children are put inside an [class@NavigationView],
+// When collapsed, the children are put inside an NavigationView,
 // otherwise they are displayed side by side.
 //
-// The [property@NavigationSplitView:show-content] controls which child is
+// The NavigationSplitView:show-content controls which child is
 // visible while collapsed.
 func (x *NavigationSplitView) SetPropertyCollapsed(value bool) {
 	var v gobject.Value
@@ -446,10 +420,10 @@ func (x *NavigationSplitView) SetPropertyCollapsed(value bool) {
 // GetPropertyCollapsed gets the "collapsed" property.
 // Whether the split view is collapsed.
 //
-// When collapsed, the children are put inside an [class@NavigationView],
+// When collapsed, the children are put inside an NavigationView,
 // otherwise they are displayed side by side.
 //
-// The [property@NavigationSplitView:show-content] controls which child is
+// The NavigationSplitView:show-content controls which child is
 // visible while collapsed.
 func (x *NavigationSplitView) GetPropertyCollapsed() bool {
 	var v gobject.Value
@@ -461,7 +435,7 @@ func (x *NavigationSplitView) GetPropertyCollapsed() bool {
 // The maximum sidebar width.
 //
 // Maximum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -476,7 +450,7 @@ func (x *NavigationSplitView) SetPropertyMaxSidebarWidth(value float64) {
 // The maximum sidebar width.
 //
 // Maximum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -490,7 +464,7 @@ func (x *NavigationSplitView) GetPropertyMaxSidebarWidth() float64 {
 // The minimum sidebar width.
 //
 // Minimum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -505,7 +479,7 @@ func (x *NavigationSplitView) SetPropertyMinSidebarWidth(value float64) {
 // The minimum sidebar width.
 //
 // Minimum width is affected by
-// [property@NavigationSplitView:sidebar-width-unit].
+// NavigationSplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -519,7 +493,7 @@ func (x *NavigationSplitView) GetPropertyMinSidebarWidth() float64 {
 // Determines the visible page when collapsed.
 //
 // If set to `TRUE`, the content widget will be the visible page when
-// [property@NavigationSplitView:collapsed] is `TRUE`; otherwise the sidebar
+// NavigationSplitView:collapsed is `TRUE`; otherwise the sidebar
 // widget will be visible.
 //
 // If the split view is already collapsed, the visible page changes
@@ -535,7 +509,7 @@ func (x *NavigationSplitView) SetPropertyShowContent(value bool) {
 // Determines the visible page when collapsed.
 //
 // If set to `TRUE`, the content widget will be the visible page when
-// [property@NavigationSplitView:collapsed] is `TRUE`; otherwise the sidebar
+// NavigationSplitView:collapsed is `TRUE`; otherwise the sidebar
 // widget will be visible.
 //
 // If the split view is already collapsed, the visible page changes
@@ -550,8 +524,8 @@ func (x *NavigationSplitView) GetPropertyShowContent() bool {
 // The preferred sidebar width as a fraction of the total width.
 //
 // The preferred width is additionally limited by
-// [property@NavigationSplitView:min-sidebar-width] and
-// [property@NavigationSplitView:max-sidebar-width].
+// NavigationSplitView:min-sidebar-width and
+// NavigationSplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -566,8 +540,8 @@ func (x *NavigationSplitView) SetPropertySidebarWidthFraction(value float64) {
 // The preferred sidebar width as a fraction of the total width.
 //
 // The preferred width is additionally limited by
-// [property@NavigationSplitView:min-sidebar-width] and
-// [property@NavigationSplitView:max-sidebar-width].
+// NavigationSplitView:min-sidebar-width and
+// NavigationSplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -738,7 +712,7 @@ func (x *NavigationSplitView) UpdatePlatformState(StateVar gtk.AccessiblePlatfor
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -746,14 +720,13 @@ func (x *NavigationSplitView) UpdatePlatformState(StateVar gtk.AccessiblePlatfor
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *NavigationSplitView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -777,19 +750,17 @@ func (x *NavigationSplitView) UpdatePropertyValue(NPropertiesVar int, Properties
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *NavigationSplitView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -810,7 +781,7 @@ func (x *NavigationSplitView) UpdateRelationValue(NRelationsVar int, RelationsVa
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -819,14 +790,12 @@ func (x *NavigationSplitView) UpdateRelationValue(NRelationsVar int, RelationsVa
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *NavigationSplitView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -845,10 +814,10 @@ func (x *NavigationSplitView) UpdateStateValue(NStatesVar int, StatesVar []gtk.A
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *NavigationSplitView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -860,7 +829,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -869,25 +838,27 @@ func init() {
 
 	core.PuregoSafeRegister(&xNavigationSplitViewGLibType, libs, "adw_navigation_split_view_get_type")
 
-	core.PuregoSafeRegister(&xNewNavigationSplitView, libs, "adw_navigation_split_view_new")
-
-	core.PuregoSafeRegister(&xNavigationSplitViewGetCollapsed, libs, "adw_navigation_split_view_get_collapsed")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetContent, libs, "adw_navigation_split_view_get_content")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetMaxSidebarWidth, libs, "adw_navigation_split_view_get_max_sidebar_width")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetMinSidebarWidth, libs, "adw_navigation_split_view_get_min_sidebar_width")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetShowContent, libs, "adw_navigation_split_view_get_show_content")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetSidebar, libs, "adw_navigation_split_view_get_sidebar")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetSidebarPosition, libs, "adw_navigation_split_view_get_sidebar_position")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetSidebarWidthFraction, libs, "adw_navigation_split_view_get_sidebar_width_fraction")
-	core.PuregoSafeRegister(&xNavigationSplitViewGetSidebarWidthUnit, libs, "adw_navigation_split_view_get_sidebar_width_unit")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetCollapsed, libs, "adw_navigation_split_view_set_collapsed")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetContent, libs, "adw_navigation_split_view_set_content")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetMaxSidebarWidth, libs, "adw_navigation_split_view_set_max_sidebar_width")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetMinSidebarWidth, libs, "adw_navigation_split_view_set_min_sidebar_width")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetShowContent, libs, "adw_navigation_split_view_set_show_content")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetSidebar, libs, "adw_navigation_split_view_set_sidebar")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetSidebarPosition, libs, "adw_navigation_split_view_set_sidebar_position")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetSidebarWidthFraction, libs, "adw_navigation_split_view_set_sidebar_width_fraction")
-	core.PuregoSafeRegister(&xNavigationSplitViewSetSidebarWidthUnit, libs, "adw_navigation_split_view_set_sidebar_width_unit")
+	gobject.CheckClassSize("AdwNavigationSplitViewClass", xNavigationSplitViewGLibType, unsafe.Sizeof(NavigationSplitViewClass{}))
+
+	core.PuregoRegisterSince(&xNewNavigationSplitView, libs, "adw_navigation_split_view_new", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xNavigationSplitViewGetCollapsed, libs, "adw_navigation_split_view_get_collapsed", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetContent, libs, "adw_navigation_split_view_get_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetMaxSidebarWidth, libs, "adw_navigation_split_view_get_max_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetMinSidebarWidth, libs, "adw_navigation_split_view_get_min_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetShowContent, libs, "adw_navigation_split_view_get_show_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetSidebar, libs, "adw_navigation_split_view_get_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetSidebarPosition, libs, "adw_navigation_split_view_get_sidebar_position", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetSidebarWidthFraction, libs, "adw_navigation_split_view_get_sidebar_width_fraction", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewGetSidebarWidthUnit, libs, "adw_navigation_split_view_get_sidebar_width_unit", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetCollapsed, libs, "adw_navigation_split_view_set_collapsed", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetContent, libs, "adw_navigation_split_view_set_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetMaxSidebarWidth, libs, "adw_navigation_split_view_set_max_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetMinSidebarWidth, libs, "adw_navigation_split_view_set_min_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetShowContent, libs, "adw_navigation_split_view_set_show_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetSidebar, libs, "adw_navigation_split_view_set_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetSidebarPosition, libs, "adw_navigation_split_view_set_sidebar_position", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetSidebarWidthFraction, libs, "adw_navigation_split_view_set_sidebar_width_fraction", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationSplitViewSetSidebarWidthUnit, libs, "adw_navigation_split_view_set_sidebar_width_unit", "ADW", "1.4")
 
 }
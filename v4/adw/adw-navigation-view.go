@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -40,7 +39,8 @@ func (x *NavigationPageClass) OverrideShowing(cb func(*NavigationPage)) {
 	if cb == nil {
 		x.xShowing = 0
 	} else {
-		x.xShowing = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xShowing = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NavigationPageNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -52,7 +52,7 @@ func (x *NavigationPageClass) GetShowing() func(*NavigationPage) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShowing)
+	core.RegisterFunc(&rawCallback, x.xShowing)
 	return func(SelfVar *NavigationPage) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -63,7 +63,8 @@ func (x *NavigationPageClass) OverrideShown(cb func(*NavigationPage)) {
 	if cb == nil {
 		x.xShown = 0
 	} else {
-		x.xShown = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xShown = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NavigationPageNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -75,7 +76,7 @@ func (x *NavigationPageClass) GetShown() func(*NavigationPage) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShown)
+	core.RegisterFunc(&rawCallback, x.xShown)
 	return func(SelfVar *NavigationPage) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -86,7 +87,8 @@ func (x *NavigationPageClass) OverrideHiding(cb func(*NavigationPage)) {
 	if cb == nil {
 		x.xHiding = 0
 	} else {
-		x.xHiding = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xHiding = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NavigationPageNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -98,7 +100,7 @@ func (x *NavigationPageClass) GetHiding() func(*NavigationPage) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHiding)
+	core.RegisterFunc(&rawCallback, x.xHiding)
 	return func(SelfVar *NavigationPage) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -109,7 +111,8 @@ func (x *NavigationPageClass) OverrideHidden(cb func(*NavigationPage)) {
 	if cb == nil {
 		x.xHidden = 0
 	} else {
-		x.xHidden = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xHidden = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NavigationPageNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -121,7 +124,7 @@ func (x *NavigationPageClass) GetHidden() func(*NavigationPage) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHidden)
+	core.RegisterFunc(&rawCallback, x.xHidden)
 	return func(SelfVar *NavigationPage) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -137,30 +140,30 @@ func (x *NavigationViewClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A page within [class@NavigationView] or [class@NavigationSplitView].
+// A page within NavigationView or NavigationSplitView.
 //
 // Each page has a child widget, a title and optionally a tag.
 //
-// The [signal@NavigationPage::showing], [signal@NavigationPage::shown],
-// [signal@NavigationPage::hiding] and [signal@NavigationPage::hidden] signals
+// The NavigationPage::showing, NavigationPage::shown,
+// NavigationPage::hiding and NavigationPage::hidden signals
 // can be used to track the page's visibility within its `AdwNavigationView`.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When placed inside `AdwNavigationPage`, [class@HeaderBar] will display the
+// When placed inside `AdwNavigationPage`, HeaderBar will display the
 // page title instead of window title.
 //
-// When used together with [class@NavigationView], it will also display a back
+// When used together with NavigationView, it will also display a back
 // button that can be used to go back to the previous page. Set
-// [property@HeaderBar:show-back-button] to `FALSE` to disable that behavior if
+// HeaderBar:show-back-button to `FALSE` to disable that behavior if
 // it's unwanted.
 //
-// ## CSS Nodes
+// # CSS Nodes
 //
 // `AdwNavigationPage` has a single CSS node with name
 // `navigation-view-page`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwNavigationPage` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type NavigationPage struct {
@@ -215,7 +218,7 @@ func NewNavigationPageWithTag(ChildVar *gtk.Widget, TitleVar string, TagVar stri
 
 var xNavigationPageGetCanPop func(uintptr) bool
 
-// Gets whether @self can be popped from navigation stack.
+// Gets whether self can be popped from navigation stack.
 func (x *NavigationPage) GetCanPop() bool {
 
 	cret := xNavigationPageGetCanPop(x.GoPointer())
@@ -224,7 +227,7 @@ func (x *NavigationPage) GetCanPop() bool {
 
 var xNavigationPageGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *NavigationPage) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -241,7 +244,7 @@ func (x *NavigationPage) GetChild() *gtk.Widget {
 
 var xNavigationPageGetTag func(uintptr) string
 
-// Gets the tag of @self.
+// Gets the tag of self.
 func (x *NavigationPage) GetTag() string {
 
 	cret := xNavigationPageGetTag(x.GoPointer())
@@ -250,7 +253,7 @@ func (x *NavigationPage) GetTag() string {
 
 var xNavigationPageGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *NavigationPage) GetTitle() string {
 
 	cret := xNavigationPageGetTitle(x.GoPointer())
@@ -259,15 +262,15 @@ func (x *NavigationPage) GetTitle() string {
 
 var xNavigationPageSetCanPop func(uintptr, bool)
 
-// Sets whether @self can be popped from navigation stack.
+// Sets whether self can be popped from navigation stack.
 //
 // Set it to `FALSE` to disable shortcuts and gestures, as well as remove the
-// back button from [class@HeaderBar].
+// back button from HeaderBar.
 //
-// Manually calling [method@NavigationView.pop] or using the `navigation.pop`
+// Manually calling pop or using the `navigation.pop`
 // action will still work.
 //
-// See [property@HeaderBar:show-back-button] for removing only the back button,
+// See HeaderBar:show-back-button for removing only the back button,
 // but not shortcuts.
 func (x *NavigationPage) SetCanPop(CanPopVar bool) {
 
@@ -277,7 +280,7 @@ func (x *NavigationPage) SetCanPop(CanPopVar bool) {
 
 var xNavigationPageSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *NavigationPage) SetChild(ChildVar *gtk.Widget) {
 
 	xNavigationPageSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -286,14 +289,14 @@ func (x *NavigationPage) SetChild(ChildVar *gtk.Widget) {
 
 var xNavigationPageSetTag func(uintptr, uintptr)
 
-// Sets the tag for @self.
+// Sets the tag for self.
 //
 // The tag can be used to retrieve the page with
-// [method@NavigationView.find_page], as well as with
-// [method@NavigationView.push_by_tag], [method@NavigationView.pop_to_tag] or
-// [method@NavigationView.replace_with_tags].
+// find_page, as well as with
+// push_by_tag, pop_to_tag or
+// replace_with_tags.
 //
-// Tags must be unique within each [class@NavigationView].
+// Tags must be unique within each NavigationView.
 //
 // The tag also must be set to use the `navigation.push` action.
 func (x *NavigationPage) SetTag(TagVar *string) {
@@ -307,9 +310,9 @@ func (x *NavigationPage) SetTag(TagVar *string) {
 
 var xNavigationPageSetTitle func(uintptr, string)
 
-// Sets the title of @self.
+// Sets the title of self.
 //
-// It's displayed in [class@HeaderBar] instead of the window title, and used as
+// It's displayed in HeaderBar instead of the window title, and used as
 // the tooltip on the next page's back button, as well as by screen reader.
 func (x *NavigationPage) SetTitle(TitleVar string) {
 
@@ -332,12 +335,12 @@ func (c *NavigationPage) SetGoPointer(ptr uintptr) {
 // Whether the page can be popped from navigation stack.
 //
 // Set it to `FALSE` to disable shortcuts and gestures, as well as remove the
-// back button from [class@HeaderBar].
+// back button from HeaderBar.
 //
-// Manually calling [method@NavigationView.pop] or using the `navigation.pop`
+// Manually calling pop or using the `navigation.pop`
 // action will still work.
 //
-// See [property@HeaderBar:show-back-button] for removing only the back
+// See HeaderBar:show-back-button for removing only the back
 // button, but not shortcuts.
 func (x *NavigationPage) SetPropertyCanPop(value bool) {
 	var v gobject.Value
@@ -350,12 +353,12 @@ func (x *NavigationPage) SetPropertyCanPop(value bool) {
 // Whether the page can be popped from navigation stack.
 //
 // Set it to `FALSE` to disable shortcuts and gestures, as well as remove the
-// back button from [class@HeaderBar].
+// back button from HeaderBar.
 //
-// Manually calling [method@NavigationView.pop] or using the `navigation.pop`
+// Manually calling pop or using the `navigation.pop`
 // action will still work.
 //
-// See [property@HeaderBar:show-back-button] for removing only the back
+// See HeaderBar:show-back-button for removing only the back
 // button, but not shortcuts.
 func (x *NavigationPage) GetPropertyCanPop() bool {
 	var v gobject.Value
@@ -367,11 +370,11 @@ func (x *NavigationPage) GetPropertyCanPop() bool {
 // The page tag.
 //
 // The tag can be used to retrieve the page with
-// [method@NavigationView.find_page], as well as with
-// [method@NavigationView.push_by_tag], [method@NavigationView.pop_to_tag] or
-// [method@NavigationView.replace_with_tags].
+// find_page, as well as with
+// push_by_tag, pop_to_tag or
+// replace_with_tags.
 //
-// Tags must be unique within each [class@NavigationView].
+// Tags must be unique within each NavigationView.
 //
 // The tag also must be set to use the `navigation.push` action.
 func (x *NavigationPage) SetPropertyTag(value string) {
@@ -385,11 +388,11 @@ func (x *NavigationPage) SetPropertyTag(value string) {
 // The page tag.
 //
 // The tag can be used to retrieve the page with
-// [method@NavigationView.find_page], as well as with
-// [method@NavigationView.push_by_tag], [method@NavigationView.pop_to_tag] or
-// [method@NavigationView.replace_with_tags].
+// find_page, as well as with
+// push_by_tag, pop_to_tag or
+// replace_with_tags.
 //
-// Tags must be unique within each [class@NavigationView].
+// Tags must be unique within each NavigationView.
 //
 // The tag also must be set to use the `navigation.push` action.
 func (x *NavigationPage) GetPropertyTag() string {
@@ -401,7 +404,7 @@ func (x *NavigationPage) GetPropertyTag() string {
 // SetPropertyTitle sets the "title" property.
 // The page title.
 //
-// It's displayed in [class@HeaderBar] instead of the window title, and used
+// It's displayed in HeaderBar instead of the window title, and used
 // as the tooltip on the next page's back button, as well as by screen reader.
 func (x *NavigationPage) SetPropertyTitle(value string) {
 	var v gobject.Value
@@ -413,7 +416,7 @@ func (x *NavigationPage) SetPropertyTitle(value string) {
 // GetPropertyTitle gets the "title" property.
 // The page title.
 //
-// It's displayed in [class@HeaderBar] instead of the window title, and used
+// It's displayed in HeaderBar instead of the window title, and used
 // as the tooltip on the next page's back button, as well as by screen reader.
 func (x *NavigationPage) GetPropertyTitle() string {
 	var v gobject.Value
@@ -424,113 +427,201 @@ func (x *NavigationPage) GetPropertyTitle() string {
 // Emitted when the navigation view transition has been completed and the page
 // is fully hidden.
 //
-// It will always be preceded by [signal@NavigationPage::hiding] or
-// [signal@NavigationPage::showing].
+// It will always be preceded by NavigationPage::hiding or
+// NavigationPage::showing.
+//
+// Deprecated: use ConnectHiddenFunc, which also accepts method values and closures.
 func (x *NavigationPage) ConnectHidden(cb *func(NavigationPage)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "hidden", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationPage{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "hidden", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xNavigationPageHiddenTrampoline uintptr
+
+func xNavigationPageHiddenNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationPage))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationPage{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "hidden", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectHiddenFunc connects cb to the "hidden" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationPage) ConnectHiddenFunc(cb func(NavigationPage)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "hidden", &xNavigationPageHiddenTrampoline, xNavigationPageHiddenNewTrampoline, cb)
 }
 
 // Emitted when the page starts hiding at the beginning of the navigation view
 // transition.
 //
-// It will always be followed by [signal@NavigationPage::hidden] or
-// [signal@NavigationPage::shown].
+// It will always be followed by NavigationPage::hidden or
+// NavigationPage::shown.
+//
+// Deprecated: use ConnectHidingFunc, which also accepts method values and closures.
 func (x *NavigationPage) ConnectHiding(cb *func(NavigationPage)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "hiding", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationPage{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "hiding", cbRefPtr, cbPtr)
+}
+
+var xNavigationPageHidingTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xNavigationPageHidingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationPage))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationPage{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "hiding", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectHidingFunc connects cb to the "hiding" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationPage) ConnectHidingFunc(cb func(NavigationPage)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "hiding", &xNavigationPageHidingTrampoline, xNavigationPageHidingNewTrampoline, cb)
 }
 
 // Emitted when the page shows at the beginning of the navigation view
 // transition.
 //
-// It will always be followed by [signal@NavigationPage::shown] or
-// [signal@NavigationPage::hidden].
+// It will always be followed by NavigationPage::shown or
+// NavigationPage::hidden.
+//
+// Deprecated: use ConnectShowingFunc, which also accepts method values and closures.
 func (x *NavigationPage) ConnectShowing(cb *func(NavigationPage)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "showing", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationPage{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "showing", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xNavigationPageShowingTrampoline uintptr
+
+func xNavigationPageShowingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationPage))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationPage{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "showing", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShowingFunc connects cb to the "showing" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationPage) ConnectShowingFunc(cb func(NavigationPage)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "showing", &xNavigationPageShowingTrampoline, xNavigationPageShowingNewTrampoline, cb)
 }
 
 // Emitted when the navigation view transition has been completed and the page
 // is fully shown.
 //
-// It will always be preceded by [signal@NavigationPage::showing] or
-// [signal@NavigationPage::hiding].
+// It will always be preceded by NavigationPage::showing or
+// NavigationPage::hiding.
+//
+// Deprecated: use ConnectShownFunc, which also accepts method values and closures.
 func (x *NavigationPage) ConnectShown(cb *func(NavigationPage)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "shown", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationPage{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "shown", cbRefPtr, cbPtr)
+}
+
+var xNavigationPageShownTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xNavigationPageShownNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationPage))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationPage{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "shown", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShownFunc connects cb to the "shown" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationPage) ConnectShownFunc(cb func(NavigationPage)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "shown", &xNavigationPageShownTrampoline, xNavigationPageShownNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -694,7 +785,7 @@ func (x *NavigationPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -702,14 +793,13 @@ func (x *NavigationPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *NavigationPage) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -733,19 +823,17 @@ func (x *NavigationPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *NavigationPage) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -766,7 +854,7 @@ func (x *NavigationPage) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -775,14 +863,12 @@ func (x *NavigationPage) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *NavigationPage) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -801,10 +887,10 @@ func (x *NavigationPage) UpdateStateValue(NStatesVar int, StatesVar []gtk.Access
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *NavigationPage) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -813,65 +899,58 @@ func (x *NavigationPage) GetBuildableId() string {
 
 // A page-based navigation container.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="navigation-view-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="navigation-view.png" alt="navigation-view"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwNavigationView` presents one child at a time, similar to
-// [class@Gtk.Stack].
+// [gtk.Stack].
 //
-// `AdwNavigationView` can only contain [class@NavigationPage] children.
+// `AdwNavigationView` can only contain NavigationPage children.
 //
 // It maintains a navigation stack that can be controlled with
-// [method@NavigationView.push] and [method@NavigationView.pop]. The whole
-// navigation stack can also be replaced using [method@NavigationView.replace].
+// push and pop. The whole
+// navigation stack can also be replaced using replace.
 //
 // `AdwNavigationView` allows to manage pages statically or dynamically.
 //
-// Static pages can be added using the [method@NavigationView.add] method. The
+// Static pages can be added using the add method. The
 // `AdwNavigationView` will keep a reference to these pages, but they aren't
-// accessible to the user until [method@NavigationView.push] is called (except
+// accessible to the user until push is called (except
 // for the first page, which is pushed automatically). Use the
-// [method@NavigationView.remove] method to remove them. This is useful for
+// remove method to remove them. This is useful for
 // applications that have a small number of unique pages and just need
 // navigation between them.
 //
 // Dynamic pages are automatically destroyed once they are popped off the
 // navigation stack. To add a page like this, push it using the
-// [method@NavigationView.push] method without calling
-// [method@NavigationView.add] first.
+// push method without calling
+// add first.
 //
-// ## Tags
+// # Tags
 //
 // Static pages, as well as any pages in the navigation stack, can be accessed
-// by their [property@NavigationPage:tag]. For example,
-// [method@NavigationView.push_by_tag] can be used to push a static page that's
+// by their NavigationPage:tag. For example,
+// push_by_tag can be used to push a static page that's
 // not in the navigation stack without having to keep a reference to it manually.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When used inside `AdwNavigationView`, [class@HeaderBar] will automatically
+// When used inside `AdwNavigationView`, HeaderBar will automatically
 // display a back button that can be used to go back to the previous page when
 // possible. The button also has a context menu, allowing to pop multiple pages
 // at once, potentially across multiple navigation views.
 //
-// Set [property@HeaderBar:show-back-button] to `FALSE` to disable this behavior
+// Set HeaderBar:show-back-button to `FALSE` to disable this behavior
 // in rare scenarios where it's unwanted.
 //
 // `AdwHeaderBar` will also display the title of the `AdwNavigationPage` it's
 // placed into, so most applications shouldn't need to customize it at all.
 //
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // `AdwNavigationView` supports the following shortcuts for going to the
 // previous page:
 //
-//   - &lt;kbd&gt;Escape&lt;/kbd&gt; (unless [property@NavigationView:pop-on-escape] is set to
+//   - Escape (unless NavigationView:pop-on-escape is set to
 //     `FALSE`)
-//   - &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;
+//   - Alt+←
 //   - Back mouse button
 //
 // Additionally, it supports interactive gestures:
@@ -880,98 +959,87 @@ func (x *NavigationPage) GetBuildableId() string {
 // - Scrolling towards the right on touchpads (usually two-finger swipe)
 //
 // These gestures have transitions enabled regardless of the
-// [property@NavigationView:animate-transitions] value.
+// NavigationView:animate-transitions value.
 //
 // Applications can also enable shortcuts for pushing another page onto the
-// navigation stack via connecting to the [signal@NavigationView::get-next-page]
+// navigation stack via connecting to the NavigationView::get-next-page
 // signal, in that case the following shortcuts are supported:
 //
-// - &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt;
+// - Alt+→
 // - Forward mouse button
 // - Swipe/scrolling towards the left
 //
 // For right-to-left locales, the gestures and shortcuts are reversed.
 //
-// [property@NavigationPage:can-pop] can be used to disable them, along with the
+// NavigationPage:can-pop can be used to disable them, along with the
 // header bar back buttons.
 //
-// ## Actions
+// # Actions
 //
 // `AdwNavigationView` defines actions for controlling the navigation stack.
 // actions for controlling the navigation stack:
 //
 // - `navigation.push` takes a string parameter specifying the tag of the page to
-// push, and is equivalent to calling [method@NavigationView.push_by_tag].
+// push, and is equivalent to calling push_by_tag.
 //
 // - `navigation.pop` doesn't take any parameters and pops the current page from
-// the navigation stack, equivalent to calling [method@NavigationView.pop].
+// the navigation stack, equivalent to calling pop.
 //
-// ## `AdwNavigationView` as `GtkBuildable`
+// # `AdwNavigationView` as `GtkBuildable`
 //
 // `AdwNavigationView` allows to add pages as children, equivalent to using the
-// [method@NavigationView.add] method.
+// add method.
 //
 // Example of an `AdwNavigationView` UI definition:
 //
-// ```xml
-// &lt;object class="AdwNavigationView"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="AdwNavigationPage"&gt;
-//	    &lt;property name="title" translatable="yes"&gt;Page 1&lt;/property&gt;
-//	    &lt;property name="child"&gt;
-//	      &lt;object class="AdwToolbarView"&gt;
-//	        &lt;child type="top"&gt;
-//	          &lt;object class="AdwHeaderBar"/&gt;
-//	        &lt;/child&gt;
-//	        &lt;property name="content"&gt;
-//	          &lt;object class="GtkButton"&gt;
-//	            &lt;property name="label" translatable="yes"&gt;Open Page 2&lt;/property&gt;
-//	            &lt;property name="halign"&gt;center&lt;/property&gt;
-//	            &lt;property name="valign"&gt;center&lt;/property&gt;
-//	            &lt;property name="action-name"&gt;navigation.push&lt;/property&gt;
-//	            &lt;property name="action-target"&gt;'page-2'&lt;/property&gt;
-//	            &lt;style&gt;
-//	              &lt;class name="pill"/&gt;
-//	             &lt;/style&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwNavigationPage"&gt;
-//	    &lt;property name="title" translatable="yes"&gt;Page 2&lt;/property&gt;
-//	    &lt;property name="tag"&gt;page-2&lt;/property&gt;
-//	    &lt;property name="child"&gt;
-//	      &lt;object class="AdwToolbarView"&gt;
-//	        &lt;child type="top"&gt;
-//	          &lt;object class="AdwHeaderBar"/&gt;
-//	        &lt;/child&gt;
-//	        &lt;property name="content"&gt;
-//	          &lt;!-- ... --&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="navigation-view-example-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="navigation-view-example.png" alt="navigation-view-example"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## CSS nodes
+//	<object class="AdwNavigationView">
+//	  <child>
+//	    <object class="AdwNavigationPage">
+//	      <property name="title" translatable="yes">Page 1</property>
+//	      <property name="child">
+//	        <object class="AdwToolbarView">
+//	          <child type="top">
+//	            <object class="AdwHeaderBar"/>
+//	          </child>
+//	          <property name="content">
+//	            <object class="GtkButton">
+//	              <property name="label" translatable="yes">Open Page 2</property>
+//	              <property name="halign">center</property>
+//	              <property name="valign">center</property>
+//	              <property name="action-name">navigation.push</property>
+//	              <property name="action-target">'page-2'</property>
+//	              <style>
+//	                <class name="pill"/>
+//	               </style>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="AdwNavigationPage">
+//	      <property name="title" translatable="yes">Page 2</property>
+//	      <property name="tag">page-2</property>
+//	      <property name="child">
+//	        <object class="AdwToolbarView">
+//	          <child type="top">
+//	            <object class="AdwHeaderBar"/>
+//	          </child>
+//	          <property name="content">
+//	            <!-- ... -->
+//	          </property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// # CSS nodes
 //
 // `AdwNavigationView` has a single CSS node with the name `navigation-view`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwNavigationView` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type NavigationView struct {
@@ -1009,15 +1077,15 @@ func NewNavigationView() *NavigationView {
 
 var xNavigationViewAdd func(uintptr, uintptr)
 
-// Permanently adds @page to @self.
+// Permanently adds page to self.
 //
-// Any page that has been added will stay in @self even after being popped from
+// Any page that has been added will stay in self even after being popped from
 // the navigation stack.
 //
 // Adding a page while no page is visible will automatically push it to the
 // navigation stack.
 //
-// See [method@NavigationView.remove].
+// See remove.
 func (x *NavigationView) Add(PageVar *NavigationPage) {
 
 	xNavigationViewAdd(x.GoPointer(), PageVar.GoPointer())
@@ -1026,9 +1094,9 @@ func (x *NavigationView) Add(PageVar *NavigationPage) {
 
 var xNavigationViewFindPage func(uintptr, string) uintptr
 
-// Finds a page in @self by its tag.
+// Finds a page in self by its tag.
 //
-// See [property@NavigationPage:tag].
+// See NavigationPage:tag.
 func (x *NavigationView) FindPage(TagVar string) *NavigationPage {
 	var cls *NavigationPage
 
@@ -1045,7 +1113,7 @@ func (x *NavigationView) FindPage(TagVar string) *NavigationPage {
 
 var xNavigationViewGetAnimateTransitions func(uintptr) bool
 
-// Gets whether @self animates page transitions.
+// Gets whether self animates page transitions.
 func (x *NavigationView) GetAnimateTransitions() bool {
 
 	cret := xNavigationViewGetAnimateTransitions(x.GoPointer())
@@ -1054,7 +1122,7 @@ func (x *NavigationView) GetAnimateTransitions() bool {
 
 var xNavigationViewGetHhomogeneous func(uintptr) bool
 
-// Gets whether @self is horizontally homogeneous.
+// Gets whether self is horizontally homogeneous.
 func (x *NavigationView) GetHhomogeneous() bool {
 
 	cret := xNavigationViewGetHhomogeneous(x.GoPointer())
@@ -1063,7 +1131,7 @@ func (x *NavigationView) GetHhomogeneous() bool {
 
 var xNavigationViewGetNavigationStack func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the pages in navigation stack.
+// Returns a [gio.ListModel] that contains the pages in navigation stack.
 //
 // The pages are sorted from root page to visible page.
 //
@@ -1083,7 +1151,7 @@ func (x *NavigationView) GetNavigationStack() *gio.ListModelBase {
 
 var xNavigationViewGetPopOnEscape func(uintptr) bool
 
-// Gets whether pressing Escape pops the current page on @self.
+// Gets whether pressing Escape pops the current page on self.
 func (x *NavigationView) GetPopOnEscape() bool {
 
 	cret := xNavigationViewGetPopOnEscape(x.GoPointer())
@@ -1092,12 +1160,12 @@ func (x *NavigationView) GetPopOnEscape() bool {
 
 var xNavigationViewGetPreviousPage func(uintptr, uintptr) uintptr
 
-// Gets the previous page for @page.
+// Gets the previous page for page.
 //
-// If @page is in the navigation stack, returns the page popping @page will
+// If page is in the navigation stack, returns the page popping page will
 // reveal.
 //
-// If @page is the root page or is not in the navigation stack, returns `NULL`.
+// If page is the root page or is not in the navigation stack, returns `NULL`.
 func (x *NavigationView) GetPreviousPage(PageVar *NavigationPage) *NavigationPage {
 	var cls *NavigationPage
 
@@ -1114,7 +1182,7 @@ func (x *NavigationView) GetPreviousPage(PageVar *NavigationPage) *NavigationPag
 
 var xNavigationViewGetVhomogeneous func(uintptr) bool
 
-// Gets whether @self is vertically homogeneous.
+// Gets whether self is vertically homogeneous.
 func (x *NavigationView) GetVhomogeneous() bool {
 
 	cret := xNavigationViewGetVhomogeneous(x.GoPointer())
@@ -1123,7 +1191,7 @@ func (x *NavigationView) GetVhomogeneous() bool {
 
 var xNavigationViewGetVisiblePage func(uintptr) uintptr
 
-// Gets the currently visible page in @self.
+// Gets the currently visible page in self.
 func (x *NavigationView) GetVisiblePage() *NavigationPage {
 	var cls *NavigationPage
 
@@ -1140,7 +1208,7 @@ func (x *NavigationView) GetVisiblePage() *NavigationPage {
 
 var xNavigationViewGetVisiblePageTag func(uintptr) string
 
-// Gets the tag of the currently visible page in @self.
+// Gets the tag of the currently visible page in self.
 func (x *NavigationView) GetVisiblePageTag() string {
 
 	cret := xNavigationViewGetVisiblePageTag(x.GoPointer())
@@ -1153,13 +1221,13 @@ var xNavigationViewPop func(uintptr) bool
 //
 // Does nothing if the navigation stack contains less than two pages.
 //
-// If [method@NavigationView.add] hasn't been called, the page is automatically
+// If add hasn't been called, the page is automatically
 // removed.
 //
-// [signal@NavigationView::popped] will be emitted for the current visible page.
+// NavigationView::popped will be emitted for the current visible page.
 //
-// See [method@NavigationView.pop_to_page] and
-// [method@NavigationView.pop_to_tag].
+// See pop_to_page and
+// pop_to_tag.
 func (x *NavigationView) Pop() bool {
 
 	cret := xNavigationViewPop(x.GoPointer())
@@ -1168,17 +1236,17 @@ func (x *NavigationView) Pop() bool {
 
 var xNavigationViewPopToPage func(uintptr, uintptr) bool
 
-// Pops pages from the navigation stack until @page is visible.
+// Pops pages from the navigation stack until page is visible.
 //
-// @page must be in the navigation stack.
+// page must be in the navigation stack.
 //
-// If [method@NavigationView.add] hasn't been called for any of the popped pages,
+// If add hasn't been called for any of the popped pages,
 // they are automatically removed.
 //
-// [signal@NavigationView::popped] will be be emitted for each of the popped
+// NavigationView::popped will be be emitted for each of the popped
 // pages.
 //
-// See [method@NavigationView.pop] and [method@NavigationView.pop_to_tag].
+// See pop and pop_to_tag.
 func (x *NavigationView) PopToPage(PageVar *NavigationPage) bool {
 
 	cret := xNavigationViewPopToPage(x.GoPointer(), PageVar.GoPointer())
@@ -1187,16 +1255,16 @@ func (x *NavigationView) PopToPage(PageVar *NavigationPage) bool {
 
 var xNavigationViewPopToTag func(uintptr, string) bool
 
-// Pops pages from the navigation stack until page with the tag @tag is visible.
+// Pops pages from the navigation stack until page with the tag tag is visible.
 //
 // The page must be in the navigation stack.
 //
-// If [method@NavigationView.add] hasn't been called for any of the popped pages,
+// If add hasn't been called for any of the popped pages,
 // they are automatically removed.
 //
-// [signal@NavigationView::popped] will be emitted for each of the popped pages.
+// NavigationView::popped will be emitted for each of the popped pages.
 //
-// See [method@NavigationView.pop_to_page] and [property@NavigationPage:tag].
+// See pop_to_page and NavigationPage:tag.
 func (x *NavigationView) PopToTag(TagVar string) bool {
 
 	cret := xNavigationViewPopToTag(x.GoPointer(), TagVar)
@@ -1205,14 +1273,14 @@ func (x *NavigationView) PopToTag(TagVar string) bool {
 
 var xNavigationViewPush func(uintptr, uintptr)
 
-// Pushes @page onto the navigation stack.
+// Pushes page onto the navigation stack.
 //
-// If [method@NavigationView.add] hasn't been called, the page is automatically
+// If add hasn't been called, the page is automatically
 // removed once it's popped.
 //
-// [signal@NavigationView::pushed] will be emitted for @page.
+// NavigationView::pushed will be emitted for page.
 //
-// See [method@NavigationView.push_by_tag].
+// See push_by_tag.
 func (x *NavigationView) Push(PageVar *NavigationPage) {
 
 	xNavigationViewPush(x.GoPointer(), PageVar.GoPointer())
@@ -1221,14 +1289,14 @@ func (x *NavigationView) Push(PageVar *NavigationPage) {
 
 var xNavigationViewPushByTag func(uintptr, string)
 
-// Pushes the page with the tag @tag onto the navigation stack.
+// Pushes the page with the tag tag onto the navigation stack.
 //
-// If [method@NavigationView.add] hasn't been called, the page is automatically
+// If add hasn't been called, the page is automatically
 // removed once it's popped.
 //
-// [signal@NavigationView::pushed] will be emitted for the page.
+// NavigationView::pushed will be emitted for the page.
 //
-// See [method@NavigationView.push] and [property@NavigationPage:tag].
+// See push and NavigationPage:tag.
 func (x *NavigationView) PushByTag(TagVar string) {
 
 	xNavigationViewPushByTag(x.GoPointer(), TagVar)
@@ -1237,12 +1305,12 @@ func (x *NavigationView) PushByTag(TagVar string) {
 
 var xNavigationViewRemove func(uintptr, uintptr)
 
-// Removes @page from @self.
+// Removes page from self.
 //
-// If @page is currently in the navigation stack, it will be removed once it's
+// If page is currently in the navigation stack, it will be removed once it's
 // popped. Otherwise, it's removed immediately.
 //
-// See [method@NavigationView.add].
+// See add.
 func (x *NavigationView) Remove(PageVar *NavigationPage) {
 
 	xNavigationViewRemove(x.GoPointer(), PageVar.GoPointer())
@@ -1251,21 +1319,21 @@ func (x *NavigationView) Remove(PageVar *NavigationPage) {
 
 var xNavigationViewReplace func(uintptr, uintptr, int)
 
-// Replaces the current navigation stack with @pages.
+// Replaces the current navigation stack with pages.
 //
 // The last page becomes the visible page.
 //
 // Replacing the navigation stack has no animation.
 //
-// If [method@NavigationView.add] hasn't been called for any pages that are no
+// If add hasn't been called for any pages that are no
 // longer in the navigation stack, they are automatically removed.
 //
-// @n_pages can be 0, in that case no page will be visible after calling this
-// method. This can be useful for removing all pages from @self.
+// n_pages can be 0, in that case no page will be visible after calling this
+// method. This can be useful for removing all pages from self.
 //
-// The [signal@NavigationView::replaced] signal will be emitted.
+// The NavigationView::replaced signal will be emitted.
 //
-// See [method@NavigationView.replace_with_tags].
+// See replace_with_tags.
 func (x *NavigationView) Replace(PagesVar uintptr, NPagesVar int) {
 
 	xNavigationViewReplace(x.GoPointer(), PagesVar, NPagesVar)
@@ -1274,21 +1342,21 @@ func (x *NavigationView) Replace(PagesVar uintptr, NPagesVar int) {
 
 var xNavigationViewReplaceWithTags func(uintptr, []string, int)
 
-// Replaces the current navigation stack with pages with the tags @tags.
+// Replaces the current navigation stack with pages with the tags tags.
 //
 // The last page becomes the visible page.
 //
 // Replacing the navigation stack has no animation.
 //
-// If [method@NavigationView.add] hasn't been called for any pages that are no
+// If add hasn't been called for any pages that are no
 // longer in the navigation stack, they are automatically removed.
 //
-// @n_tags can be 0, in that case no page will be visible after calling this
-// method. This can be useful for removing all pages from @self.
+// n_tags can be 0, in that case no page will be visible after calling this
+// method. This can be useful for removing all pages from self.
 //
-// The [signal@NavigationView::replaced] signal will be emitted.
+// The NavigationView::replaced signal will be emitted.
 //
-// See [method@NavigationView.replace] and [property@NavigationPage:tag].
+// See replace and NavigationPage:tag.
 func (x *NavigationView) ReplaceWithTags(TagsVar []string, NTagsVar int) {
 
 	xNavigationViewReplaceWithTags(x.GoPointer(), TagsVar, NTagsVar)
@@ -1297,7 +1365,7 @@ func (x *NavigationView) ReplaceWithTags(TagsVar []string, NTagsVar int) {
 
 var xNavigationViewSetAnimateTransitions func(uintptr, bool)
 
-// Sets whether @self should animate page transitions.
+// Sets whether self should animate page transitions.
 //
 // Gesture-based transitions are always animated.
 func (x *NavigationView) SetAnimateTransitions(AnimateTransitionsVar bool) {
@@ -1308,7 +1376,7 @@ func (x *NavigationView) SetAnimateTransitions(AnimateTransitionsVar bool) {
 
 var xNavigationViewSetHhomogeneous func(uintptr, bool)
 
-// Sets @self to be horizontally homogeneous or not.
+// Sets self to be horizontally homogeneous or not.
 //
 // If the view is horizontally homogeneous, it allocates the same width for
 // all pages.
@@ -1322,7 +1390,7 @@ func (x *NavigationView) SetHhomogeneous(HhomogeneousVar bool) {
 
 var xNavigationViewSetPopOnEscape func(uintptr, bool)
 
-// Sets whether pressing Escape pops the current page on @self.
+// Sets whether pressing Escape pops the current page on self.
 //
 // Applications using `AdwNavigationView` to implement a browser may want to
 // disable it.
@@ -1334,7 +1402,7 @@ func (x *NavigationView) SetPopOnEscape(PopOnEscapeVar bool) {
 
 var xNavigationViewSetVhomogeneous func(uintptr, bool)
 
-// Sets @self to be vertically homogeneous or not.
+// Sets self to be vertically homogeneous or not.
 //
 // If the view is vertically homogeneous, it allocates the same height for
 // all pages.
@@ -1478,121 +1546,210 @@ func (x *NavigationView) GetPropertyVisiblePageTag() string {
 // not make any irreversible changes in the handler, such as removing the page
 // from a forward stack.
 //
-// Instead, it should be done in the [signal@NavigationView::pushed] handler.
+// Instead, it should be done in the NavigationView::pushed handler.
+//
+// Deprecated: use ConnectGetNextPageFunc, which also accepts method values and closures.
 func (x *NavigationView) ConnectGetNextPage(cb *func(NavigationView) NavigationPage) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "get-next-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := NavigationView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			GetNextPageCls := cbFn(fa)
+			return GetNextPageCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "get-next-page", cbRefPtr, cbPtr)
+}
+
+var xNavigationViewGetNextPageTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) uintptr {
+func xNavigationViewGetNextPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationView) NavigationPage)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		GetNextPageCls := cbFn(fa)
 		return GetNextPageCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "get-next-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted after @page has been popped from the navigation stack.
+// ConnectGetNextPageFunc connects cb to the "get-next-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationView) ConnectGetNextPageFunc(cb func(NavigationView) NavigationPage) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "get-next-page", &xNavigationViewGetNextPageTrampoline, xNavigationViewGetNextPageNewTrampoline, cb)
+}
+
+// Emitted after page has been popped from the navigation stack.
 //
-// See [method@NavigationView.pop].
+// See pop.
 //
-// When using [method@NavigationView.pop_to_page] or
-// [method@NavigationView.pop_to_tag], this signal is emitted for each of the
+// When using pop_to_page or
+// pop_to_tag, this signal is emitted for each of the
 // popped pages.
+//
+// Deprecated: use ConnectPoppedFunc, which also accepts method values and closures.
 func (x *NavigationView) ConnectPopped(cb *func(NavigationView, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "popped", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "popped", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) {
+var xNavigationViewPoppedTrampoline uintptr
+
+func xNavigationViewPoppedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationView, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "popped", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPoppedFunc connects cb to the "popped" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationView) ConnectPoppedFunc(cb func(NavigationView, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "popped", &xNavigationViewPoppedTrampoline, xNavigationViewPoppedNewTrampoline, cb)
 }
 
 // Emitted after a page has been pushed to the navigation stack.
 //
-// See [method@NavigationView.push].
+// See push.
+//
+// Deprecated: use ConnectPushedFunc, which also accepts method values and closures.
 func (x *NavigationView) ConnectPushed(cb *func(NavigationView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "pushed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pushed", cbRefPtr, cbPtr)
+}
+
+var xNavigationViewPushedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xNavigationViewPushedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "pushed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPushedFunc connects cb to the "pushed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationView) ConnectPushedFunc(cb func(NavigationView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pushed", &xNavigationViewPushedTrampoline, xNavigationViewPushedNewTrampoline, cb)
 }
 
 // Emitted after the navigation stack has been replaced.
 //
-// See [method@NavigationView.replace].
+// See replace.
+//
+// Deprecated: use ConnectReplacedFunc, which also accepts method values and closures.
 func (x *NavigationView) ConnectReplaced(cb *func(NavigationView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "replaced", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := NavigationView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "replaced", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xNavigationViewReplacedTrampoline uintptr
+
+func xNavigationViewReplacedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NavigationView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NavigationView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "replaced", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// ConnectReplacedFunc connects cb to the "replaced" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NavigationView) ConnectReplacedFunc(cb func(NavigationView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "replaced", &xNavigationViewReplacedTrampoline, xNavigationViewReplacedNewTrampoline, cb)
+}
+
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *NavigationView) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *NavigationView) GetDistance() float64 {
@@ -1601,14 +1758,14 @@ func (x *NavigationView) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *NavigationView) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -1618,7 +1775,7 @@ func (x *NavigationView) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -1626,7 +1783,7 @@ func (x *NavigationView) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *NavigationView) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -1794,7 +1951,7 @@ func (x *NavigationView) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1802,14 +1959,13 @@ func (x *NavigationView) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *NavigationView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1833,19 +1989,17 @@ func (x *NavigationView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *NavigationView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1866,7 +2020,7 @@ func (x *NavigationView) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1875,14 +2029,12 @@ func (x *NavigationView) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *NavigationView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1901,10 +2053,10 @@ func (x *NavigationView) UpdateStateValue(NStatesVar int, StatesVar []gtk.Access
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *NavigationView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1916,7 +2068,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1925,43 +2077,47 @@ func init() {
 
 	core.PuregoSafeRegister(&xNavigationPageGLibType, libs, "adw_navigation_page_get_type")
 
-	core.PuregoSafeRegister(&xNewNavigationPage, libs, "adw_navigation_page_new")
-	core.PuregoSafeRegister(&xNewNavigationPageWithTag, libs, "adw_navigation_page_new_with_tag")
+	gobject.CheckClassSize("AdwNavigationPageClass", xNavigationPageGLibType, unsafe.Sizeof(NavigationPageClass{}))
+
+	core.PuregoRegisterSince(&xNewNavigationPage, libs, "adw_navigation_page_new", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNewNavigationPageWithTag, libs, "adw_navigation_page_new_with_tag", "ADW", "1.4")
 
-	core.PuregoSafeRegister(&xNavigationPageGetCanPop, libs, "adw_navigation_page_get_can_pop")
-	core.PuregoSafeRegister(&xNavigationPageGetChild, libs, "adw_navigation_page_get_child")
-	core.PuregoSafeRegister(&xNavigationPageGetTag, libs, "adw_navigation_page_get_tag")
-	core.PuregoSafeRegister(&xNavigationPageGetTitle, libs, "adw_navigation_page_get_title")
-	core.PuregoSafeRegister(&xNavigationPageSetCanPop, libs, "adw_navigation_page_set_can_pop")
-	core.PuregoSafeRegister(&xNavigationPageSetChild, libs, "adw_navigation_page_set_child")
-	core.PuregoSafeRegister(&xNavigationPageSetTag, libs, "adw_navigation_page_set_tag")
-	core.PuregoSafeRegister(&xNavigationPageSetTitle, libs, "adw_navigation_page_set_title")
+	core.PuregoRegisterSince(&xNavigationPageGetCanPop, libs, "adw_navigation_page_get_can_pop", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageGetChild, libs, "adw_navigation_page_get_child", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageGetTag, libs, "adw_navigation_page_get_tag", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageGetTitle, libs, "adw_navigation_page_get_title", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageSetCanPop, libs, "adw_navigation_page_set_can_pop", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageSetChild, libs, "adw_navigation_page_set_child", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageSetTag, libs, "adw_navigation_page_set_tag", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationPageSetTitle, libs, "adw_navigation_page_set_title", "ADW", "1.4")
 
 	core.PuregoSafeRegister(&xNavigationViewGLibType, libs, "adw_navigation_view_get_type")
 
-	core.PuregoSafeRegister(&xNewNavigationView, libs, "adw_navigation_view_new")
-
-	core.PuregoSafeRegister(&xNavigationViewAdd, libs, "adw_navigation_view_add")
-	core.PuregoSafeRegister(&xNavigationViewFindPage, libs, "adw_navigation_view_find_page")
-	core.PuregoSafeRegister(&xNavigationViewGetAnimateTransitions, libs, "adw_navigation_view_get_animate_transitions")
-	core.PuregoSafeRegister(&xNavigationViewGetHhomogeneous, libs, "adw_navigation_view_get_hhomogeneous")
-	core.PuregoSafeRegister(&xNavigationViewGetNavigationStack, libs, "adw_navigation_view_get_navigation_stack")
-	core.PuregoSafeRegister(&xNavigationViewGetPopOnEscape, libs, "adw_navigation_view_get_pop_on_escape")
-	core.PuregoSafeRegister(&xNavigationViewGetPreviousPage, libs, "adw_navigation_view_get_previous_page")
-	core.PuregoSafeRegister(&xNavigationViewGetVhomogeneous, libs, "adw_navigation_view_get_vhomogeneous")
-	core.PuregoSafeRegister(&xNavigationViewGetVisiblePage, libs, "adw_navigation_view_get_visible_page")
-	core.PuregoSafeRegister(&xNavigationViewGetVisiblePageTag, libs, "adw_navigation_view_get_visible_page_tag")
-	core.PuregoSafeRegister(&xNavigationViewPop, libs, "adw_navigation_view_pop")
-	core.PuregoSafeRegister(&xNavigationViewPopToPage, libs, "adw_navigation_view_pop_to_page")
-	core.PuregoSafeRegister(&xNavigationViewPopToTag, libs, "adw_navigation_view_pop_to_tag")
-	core.PuregoSafeRegister(&xNavigationViewPush, libs, "adw_navigation_view_push")
-	core.PuregoSafeRegister(&xNavigationViewPushByTag, libs, "adw_navigation_view_push_by_tag")
-	core.PuregoSafeRegister(&xNavigationViewRemove, libs, "adw_navigation_view_remove")
-	core.PuregoSafeRegister(&xNavigationViewReplace, libs, "adw_navigation_view_replace")
-	core.PuregoSafeRegister(&xNavigationViewReplaceWithTags, libs, "adw_navigation_view_replace_with_tags")
-	core.PuregoSafeRegister(&xNavigationViewSetAnimateTransitions, libs, "adw_navigation_view_set_animate_transitions")
-	core.PuregoSafeRegister(&xNavigationViewSetHhomogeneous, libs, "adw_navigation_view_set_hhomogeneous")
-	core.PuregoSafeRegister(&xNavigationViewSetPopOnEscape, libs, "adw_navigation_view_set_pop_on_escape")
-	core.PuregoSafeRegister(&xNavigationViewSetVhomogeneous, libs, "adw_navigation_view_set_vhomogeneous")
+	gobject.CheckClassSize("AdwNavigationViewClass", xNavigationViewGLibType, unsafe.Sizeof(NavigationViewClass{}))
+
+	core.PuregoRegisterSince(&xNewNavigationView, libs, "adw_navigation_view_new", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xNavigationViewAdd, libs, "adw_navigation_view_add", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewFindPage, libs, "adw_navigation_view_find_page", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetAnimateTransitions, libs, "adw_navigation_view_get_animate_transitions", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetHhomogeneous, libs, "adw_navigation_view_get_hhomogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationViewGetNavigationStack, libs, "adw_navigation_view_get_navigation_stack", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetPopOnEscape, libs, "adw_navigation_view_get_pop_on_escape", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetPreviousPage, libs, "adw_navigation_view_get_previous_page", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetVhomogeneous, libs, "adw_navigation_view_get_vhomogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationViewGetVisiblePage, libs, "adw_navigation_view_get_visible_page", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewGetVisiblePageTag, libs, "adw_navigation_view_get_visible_page_tag", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationViewPop, libs, "adw_navigation_view_pop", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewPopToPage, libs, "adw_navigation_view_pop_to_page", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewPopToTag, libs, "adw_navigation_view_pop_to_tag", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewPush, libs, "adw_navigation_view_push", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewPushByTag, libs, "adw_navigation_view_push_by_tag", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewRemove, libs, "adw_navigation_view_remove", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewReplace, libs, "adw_navigation_view_replace", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewReplaceWithTags, libs, "adw_navigation_view_replace_with_tags", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewSetAnimateTransitions, libs, "adw_navigation_view_set_animate_transitions", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewSetHhomogeneous, libs, "adw_navigation_view_set_hhomogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xNavigationViewSetPopOnEscape, libs, "adw_navigation_view_set_pop_on_escape", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNavigationViewSetVhomogeneous, libs, "adw_navigation_view_set_vhomogeneous", "ADW", "1.7")
 
 }
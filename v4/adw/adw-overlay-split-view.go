@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,109 +24,92 @@ func (x *OverlaySplitViewClass) GoPointer() uintptr {
 
 // A widget presenting sidebar and content side by side or as an overlay.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="overlay-split-view-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="overlay-split-view.png" alt="overlay-split-view"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="overlay-split-view-collapsed-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="overlay-split-view-collapsed.png" alt="overlay-split-view-collapsed"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwOverlaySplitView` has two children: sidebar and content, and displays
 // them side by side.
 //
-// When [property@OverlaySplitView:collapsed] is set to `TRUE`, the sidebar is
+// When OverlaySplitView:collapsed is set to `TRUE`, the sidebar is
 // instead shown as an overlay above the content widget.
 //
 // The sidebar can be hidden or shown using the
-// [property@OverlaySplitView:show-sidebar] property.
+// OverlaySplitView:show-sidebar property.
 //
 // Sidebar can be displayed before or after the content, this can be controlled
-// with the [property@OverlaySplitView:sidebar-position] property.
+// with the OverlaySplitView:sidebar-position property.
 //
 // Collapsing the split view automatically hides the sidebar widget, and
 // uncollapsing it shows the sidebar. If this behavior is not desired, the
-// [property@OverlaySplitView:pin-sidebar] property can be used to override it.
+// OverlaySplitView:pin-sidebar property can be used to override it.
 //
 // `AdwOverlaySplitView` supports an edge swipe gesture for showing the sidebar,
 // and a swipe from the sidebar for hiding it. Gestures are only supported on
 // touchscreen, but not touchpad. Gestures can be controlled with the
-// [property@OverlaySplitView:enable-show-gesture] and
-// [property@OverlaySplitView:enable-hide-gesture] properties.
+// OverlaySplitView:enable-show-gesture and
+// OverlaySplitView:enable-hide-gesture properties.
 //
-// See also [class@NavigationSplitView].
+// See also NavigationSplitView.
 //
-// `AdwOverlaySplitView` is typically used together with an [class@Breakpoint]
+// `AdwOverlaySplitView` is typically used together with an Breakpoint
 // setting the `collapsed` property to `TRUE` on small widths, as follows:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;property name="default-width"&gt;800&lt;/property&gt;
-//	&lt;property name="default-height"&gt;800&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 400sp&lt;/condition&gt;
-//	    &lt;setter object="split_view" property="collapsed"&gt;True&lt;/setter&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwOverlaySplitView" id="split_view"&gt;
-//	    &lt;property name="sidebar"&gt;
-//	      &lt;!-- ... --&gt;
-//	    &lt;/property&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;!-- ... --&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwWindow">
+//	  <property name="default-width">800</property>
+//	  <property name="default-height">800</property>
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 400sp</condition>
+//	      <setter object="split_view" property="collapsed">True</setter>
+//	    </object>
+//	  </child>
+//	  <property name="content">
+//	    <object class="AdwOverlaySplitView" id="split_view">
+//	      <property name="sidebar">
+//	        <!-- ... -->
+//	      </property>
+//	      <property name="content">
+//	        <!-- ... -->
+//	      </property>
+//	    </object>
+//	  </property>
+//	</object>
 //
 // `AdwOverlaySplitView` is often used for implementing the
-// [utility pane](https://developer.gnome.org/hig/patterns/containers/utility-panes.html)
+// [utility pane]
 // pattern.
 //
-// ## Sizing
+// # Sizing
 //
 // When not collapsed, `AdwOverlaySplitView` changes the sidebar width
 // depending on its own width.
 //
 // If possible, it tries to allocate a fraction of the total width, controlled
-// with the [property@OverlaySplitView:sidebar-width-fraction] property.
+// with the OverlaySplitView:sidebar-width-fraction property.
 //
 // The sidebar also has minimum and maximum sizes, controlled with the
-// [property@OverlaySplitView:min-sidebar-width] and
-// [property@OverlaySplitView:max-sidebar-width] properties.
+// OverlaySplitView:min-sidebar-width and
+// OverlaySplitView:max-sidebar-width properties.
 //
 // The minimum and maximum sizes are using the length unit specified with the
-// [property@OverlaySplitView:sidebar-width-unit].
+// OverlaySplitView:sidebar-width-unit.
 //
 // By default, sidebar is using 25% of the total width, with 180sp as the
 // minimum size and 280sp as the maximum size.
 //
 // When collapsed, the preferred width fraction is ignored and the sidebar uses
-// [property@OverlaySplitView:max-sidebar-width] when possible.
+// OverlaySplitView:max-sidebar-width when possible.
 //
-// ## Header Bar Integration
+// # Header Bar Integration
 //
-// When used inside `AdwOverlaySplitView`, [class@HeaderBar] will automatically
+// When used inside `AdwOverlaySplitView`, HeaderBar will automatically
 // hide the window buttons in the middle.
 //
-// ## `AdwOverlaySplitView` as `GtkBuildable`
+// # `AdwOverlaySplitView` as `GtkBuildable`
 //
-// The `AdwOverlaySplitView` implementation of the [iface@Gtk.Buildable]
+// The `AdwOverlaySplitView` implementation of the [gtk.Buildable]
 // interface supports setting the sidebar widget by specifying “sidebar” as the
-// “type” attribute of a `&lt;child&gt;` element, Specifying “content” child type or
+// “type” attribute of a `<child>` element, Specifying “content” child type or
 // omitting it results in setting the content widget.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwOverlaySplitView` has a single CSS node with the name
 // `overlay-split-view`.
@@ -138,32 +120,26 @@ func (x *OverlaySplitViewClass) GoPointer() uintptr {
 // When not collapsed, they have the `.sidebar-view` and `.content-view` style
 // classes respectively.
 //
-// ```
-// overlay-split-view
-// ├── widget.sidebar-pane
-// │   ╰── [sidebar child]
-// ╰── widget.content-pane
-//
-//	╰── [content child]
-//
-// ```
+//	overlay-split-view
+//	├── widget.sidebar-pane
+//	│   ╰── [sidebar child]
+//	╰── widget.content-pane
+//	    ╰── [content child]
 //
 // When collapsed, the one containing the sidebar child has the `.background`
 // style class and the other one has no style classes.
 //
-// ```
-// overlay-split-view
-// ├── widget.background
-// │   ╰── [sidebar child]
-// ╰── widget
-//
-//	╰── [content child]
-//
-// ```
+//	overlay-split-view
+//	├── widget.background
+//	│   ╰── [sidebar child]
+//	╰── widget
+//	    ╰── [content child]
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwOverlaySplitView` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
+//
+// [utility pane]: https://developer.gnome.org/hig/patterns/containers/utility-panes.html
 type OverlaySplitView struct {
 	gtk.Widget
 }
@@ -199,7 +175,7 @@ func NewOverlaySplitView() *OverlaySplitView {
 
 var xOverlaySplitViewGetCollapsed func(uintptr) bool
 
-// Gets whether @self is collapsed.
+// Gets whether self is collapsed.
 func (x *OverlaySplitView) GetCollapsed() bool {
 
 	cret := xOverlaySplitViewGetCollapsed(x.GoPointer())
@@ -208,7 +184,7 @@ func (x *OverlaySplitView) GetCollapsed() bool {
 
 var xOverlaySplitViewGetContent func(uintptr) uintptr
 
-// Gets the content widget for @self.
+// Gets the content widget for self.
 func (x *OverlaySplitView) GetContent() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -225,7 +201,7 @@ func (x *OverlaySplitView) GetContent() *gtk.Widget {
 
 var xOverlaySplitViewGetEnableHideGesture func(uintptr) bool
 
-// Gets whether @self can be closed with a swipe gesture.
+// Gets whether self can be closed with a swipe gesture.
 func (x *OverlaySplitView) GetEnableHideGesture() bool {
 
 	cret := xOverlaySplitViewGetEnableHideGesture(x.GoPointer())
@@ -234,7 +210,7 @@ func (x *OverlaySplitView) GetEnableHideGesture() bool {
 
 var xOverlaySplitViewGetEnableShowGesture func(uintptr) bool
 
-// Gets whether @self can be opened with an edge swipe gesture.
+// Gets whether self can be opened with an edge swipe gesture.
 func (x *OverlaySplitView) GetEnableShowGesture() bool {
 
 	cret := xOverlaySplitViewGetEnableShowGesture(x.GoPointer())
@@ -243,7 +219,7 @@ func (x *OverlaySplitView) GetEnableShowGesture() bool {
 
 var xOverlaySplitViewGetMaxSidebarWidth func(uintptr) float64
 
-// Gets the maximum sidebar width for @self.
+// Gets the maximum sidebar width for self.
 func (x *OverlaySplitView) GetMaxSidebarWidth() float64 {
 
 	cret := xOverlaySplitViewGetMaxSidebarWidth(x.GoPointer())
@@ -252,7 +228,7 @@ func (x *OverlaySplitView) GetMaxSidebarWidth() float64 {
 
 var xOverlaySplitViewGetMinSidebarWidth func(uintptr) float64
 
-// Gets the minimum sidebar width for @self.
+// Gets the minimum sidebar width for self.
 func (x *OverlaySplitView) GetMinSidebarWidth() float64 {
 
 	cret := xOverlaySplitViewGetMinSidebarWidth(x.GoPointer())
@@ -261,7 +237,7 @@ func (x *OverlaySplitView) GetMinSidebarWidth() float64 {
 
 var xOverlaySplitViewGetPinSidebar func(uintptr) bool
 
-// Gets whether the sidebar widget is pinned for @self.
+// Gets whether the sidebar widget is pinned for self.
 func (x *OverlaySplitView) GetPinSidebar() bool {
 
 	cret := xOverlaySplitViewGetPinSidebar(x.GoPointer())
@@ -270,7 +246,7 @@ func (x *OverlaySplitView) GetPinSidebar() bool {
 
 var xOverlaySplitViewGetShowSidebar func(uintptr) bool
 
-// Gets whether the sidebar widget is shown for @self.
+// Gets whether the sidebar widget is shown for self.
 func (x *OverlaySplitView) GetShowSidebar() bool {
 
 	cret := xOverlaySplitViewGetShowSidebar(x.GoPointer())
@@ -279,7 +255,7 @@ func (x *OverlaySplitView) GetShowSidebar() bool {
 
 var xOverlaySplitViewGetSidebar func(uintptr) uintptr
 
-// Gets the sidebar widget for @self.
+// Gets the sidebar widget for self.
 func (x *OverlaySplitView) GetSidebar() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -296,7 +272,7 @@ func (x *OverlaySplitView) GetSidebar() *gtk.Widget {
 
 var xOverlaySplitViewGetSidebarPosition func(uintptr) gtk.PackType
 
-// Gets the sidebar position for @self.
+// Gets the sidebar position for self.
 func (x *OverlaySplitView) GetSidebarPosition() gtk.PackType {
 
 	cret := xOverlaySplitViewGetSidebarPosition(x.GoPointer())
@@ -305,7 +281,7 @@ func (x *OverlaySplitView) GetSidebarPosition() gtk.PackType {
 
 var xOverlaySplitViewGetSidebarWidthFraction func(uintptr) float64
 
-// Gets the preferred sidebar width fraction for @self.
+// Gets the preferred sidebar width fraction for self.
 func (x *OverlaySplitView) GetSidebarWidthFraction() float64 {
 
 	cret := xOverlaySplitViewGetSidebarWidthFraction(x.GoPointer())
@@ -323,7 +299,7 @@ func (x *OverlaySplitView) GetSidebarWidthUnit() LengthUnit {
 
 var xOverlaySplitViewSetCollapsed func(uintptr, bool)
 
-// Sets whether @self view is collapsed.
+// Sets whether self view is collapsed.
 //
 // When collapsed, the sidebar widget is presented as an overlay above the
 // content widget, otherwise they are displayed side by side.
@@ -335,7 +311,7 @@ func (x *OverlaySplitView) SetCollapsed(CollapsedVar bool) {
 
 var xOverlaySplitViewSetContent func(uintptr, uintptr)
 
-// Sets the content widget for @self.
+// Sets the content widget for self.
 func (x *OverlaySplitView) SetContent(ContentVar *gtk.Widget) {
 
 	xOverlaySplitViewSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -344,7 +320,7 @@ func (x *OverlaySplitView) SetContent(ContentVar *gtk.Widget) {
 
 var xOverlaySplitViewSetEnableHideGesture func(uintptr, bool)
 
-// Sets whether @self can be closed with a swipe gesture.
+// Sets whether self can be closed with a swipe gesture.
 //
 // Only touchscreen swipes are supported.
 func (x *OverlaySplitView) SetEnableHideGesture(EnableHideGestureVar bool) {
@@ -355,7 +331,7 @@ func (x *OverlaySplitView) SetEnableHideGesture(EnableHideGestureVar bool) {
 
 var xOverlaySplitViewSetEnableShowGesture func(uintptr, bool)
 
-// Sets whether @self can be opened with an edge swipe gesture.
+// Sets whether self can be opened with an edge swipe gesture.
 //
 // Only touchscreen swipes are supported.
 func (x *OverlaySplitView) SetEnableShowGesture(EnableShowGestureVar bool) {
@@ -366,9 +342,9 @@ func (x *OverlaySplitView) SetEnableShowGesture(EnableShowGestureVar bool) {
 
 var xOverlaySplitViewSetMaxSidebarWidth func(uintptr, float64)
 
-// Sets the maximum sidebar width for @self.
+// Sets the maximum sidebar width for self.
 //
-// Maximum width is affected by [property@OverlaySplitView:sidebar-width-unit].
+// Maximum width is affected by OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -380,9 +356,9 @@ func (x *OverlaySplitView) SetMaxSidebarWidth(WidthVar float64) {
 
 var xOverlaySplitViewSetMinSidebarWidth func(uintptr, float64)
 
-// Sets the minimum sidebar width for @self.
+// Sets the minimum sidebar width for self.
 //
-// Minimum width is affected by [property@OverlaySplitView:sidebar-width-unit].
+// Minimum width is affected by OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -394,9 +370,9 @@ func (x *OverlaySplitView) SetMinSidebarWidth(WidthVar float64) {
 
 var xOverlaySplitViewSetPinSidebar func(uintptr, bool)
 
-// Sets whether the sidebar widget is pinned for @self.
+// Sets whether the sidebar widget is pinned for self.
 //
-// By default, collapsing @self automatically hides the sidebar widget, and
+// By default, collapsing self automatically hides the sidebar widget, and
 // uncollapsing it shows the sidebar. If set to `TRUE`, sidebar visibility never
 // changes on its own.
 func (x *OverlaySplitView) SetPinSidebar(PinSidebarVar bool) {
@@ -407,7 +383,7 @@ func (x *OverlaySplitView) SetPinSidebar(PinSidebarVar bool) {
 
 var xOverlaySplitViewSetShowSidebar func(uintptr, bool)
 
-// Sets whether the sidebar widget is shown for @self.
+// Sets whether the sidebar widget is shown for self.
 func (x *OverlaySplitView) SetShowSidebar(ShowSidebarVar bool) {
 
 	xOverlaySplitViewSetShowSidebar(x.GoPointer(), ShowSidebarVar)
@@ -416,7 +392,7 @@ func (x *OverlaySplitView) SetShowSidebar(ShowSidebarVar bool) {
 
 var xOverlaySplitViewSetSidebar func(uintptr, uintptr)
 
-// Sets the sidebar widget for @self.
+// Sets the sidebar widget for self.
 func (x *OverlaySplitView) SetSidebar(SidebarVar *gtk.Widget) {
 
 	xOverlaySplitViewSetSidebar(x.GoPointer(), SidebarVar.GoPointer())
@@ -425,7 +401,7 @@ func (x *OverlaySplitView) SetSidebar(SidebarVar *gtk.Widget) {
 
 var xOverlaySplitViewSetSidebarPosition func(uintptr, gtk.PackType)
 
-// Sets the sidebar position for @self.
+// Sets the sidebar position for self.
 //
 // If it's set to `GTK_PACK_START`, the sidebar is displayed before the content,
 // if `GTK_PACK_END`, it's displayed after the content.
@@ -437,11 +413,11 @@ func (x *OverlaySplitView) SetSidebarPosition(PositionVar gtk.PackType) {
 
 var xOverlaySplitViewSetSidebarWidthFraction func(uintptr, float64)
 
-// Sets the preferred sidebar width as a fraction of the total width of @self.
+// Sets the preferred sidebar width as a fraction of the total width of self.
 //
 // The preferred width is additionally limited by
-// [property@OverlaySplitView:min-sidebar-width] and
-// [property@OverlaySplitView:max-sidebar-width].
+// OverlaySplitView:min-sidebar-width and
+// OverlaySplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -455,8 +431,8 @@ var xOverlaySplitViewSetSidebarWidthUnit func(uintptr, LengthUnit)
 
 // Sets the length unit for minimum and maximum sidebar widths.
 //
-// See [property@OverlaySplitView:min-sidebar-width] and
-// [property@OverlaySplitView:max-sidebar-width].
+// See OverlaySplitView:min-sidebar-width and
+// OverlaySplitView:max-sidebar-width.
 func (x *OverlaySplitView) SetSidebarWidthUnit(UnitVar LengthUnit) {
 
 	xOverlaySplitViewSetSidebarWidthUnit(x.GoPointer(), UnitVar)
@@ -543,7 +519,7 @@ func (x *OverlaySplitView) GetPropertyEnableShowGesture() bool {
 // The maximum sidebar width.
 //
 // Maximum width is affected by
-// [property@OverlaySplitView:sidebar-width-unit].
+// OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -558,7 +534,7 @@ func (x *OverlaySplitView) SetPropertyMaxSidebarWidth(value float64) {
 // The maximum sidebar width.
 //
 // Maximum width is affected by
-// [property@OverlaySplitView:sidebar-width-unit].
+// OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -572,7 +548,7 @@ func (x *OverlaySplitView) GetPropertyMaxSidebarWidth() float64 {
 // The minimum sidebar width.
 //
 // Minimum width is affected by
-// [property@OverlaySplitView:sidebar-width-unit].
+// OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -587,7 +563,7 @@ func (x *OverlaySplitView) SetPropertyMinSidebarWidth(value float64) {
 // The minimum sidebar width.
 //
 // Minimum width is affected by
-// [property@OverlaySplitView:sidebar-width-unit].
+// OverlaySplitView:sidebar-width-unit.
 //
 // The sidebar widget can still be allocated with larger width if its own
 // minimum width exceeds it.
@@ -600,7 +576,7 @@ func (x *OverlaySplitView) GetPropertyMinSidebarWidth() float64 {
 // SetPropertyPinSidebar sets the "pin-sidebar" property.
 // Whether the sidebar widget is pinned.
 //
-// By default, collapsing @self automatically hides the sidebar widget, and
+// By default, collapsing self automatically hides the sidebar widget, and
 // uncollapsing it shows the sidebar. If set to `TRUE`, sidebar visibility
 // never changes on its own.
 func (x *OverlaySplitView) SetPropertyPinSidebar(value bool) {
@@ -613,7 +589,7 @@ func (x *OverlaySplitView) SetPropertyPinSidebar(value bool) {
 // GetPropertyPinSidebar gets the "pin-sidebar" property.
 // Whether the sidebar widget is pinned.
 //
-// By default, collapsing @self automatically hides the sidebar widget, and
+// By default, collapsing self automatically hides the sidebar widget, and
 // uncollapsing it shows the sidebar. If set to `TRUE`, sidebar visibility
 // never changes on its own.
 func (x *OverlaySplitView) GetPropertyPinSidebar() bool {
@@ -643,8 +619,8 @@ func (x *OverlaySplitView) GetPropertyShowSidebar() bool {
 // The preferred sidebar width as a fraction of the total width.
 //
 // The preferred width is additionally limited by
-// [property@OverlaySplitView:min-sidebar-width] and
-// [property@OverlaySplitView:max-sidebar-width].
+// OverlaySplitView:min-sidebar-width and
+// OverlaySplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -659,8 +635,8 @@ func (x *OverlaySplitView) SetPropertySidebarWidthFraction(value float64) {
 // The preferred sidebar width as a fraction of the total width.
 //
 // The preferred width is additionally limited by
-// [property@OverlaySplitView:min-sidebar-width] and
-// [property@OverlaySplitView:max-sidebar-width].
+// OverlaySplitView:min-sidebar-width and
+// OverlaySplitView:max-sidebar-width.
 //
 // The sidebar widget can be allocated with larger width if its own minimum
 // width exceeds the preferred width.
@@ -670,14 +646,14 @@ func (x *OverlaySplitView) GetPropertySidebarWidthFraction() float64 {
 	return v.GetDouble()
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *OverlaySplitView) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *OverlaySplitView) GetDistance() float64 {
@@ -686,14 +662,14 @@ func (x *OverlaySplitView) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *OverlaySplitView) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -703,7 +679,7 @@ func (x *OverlaySplitView) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -711,7 +687,7 @@ func (x *OverlaySplitView) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *OverlaySplitView) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -879,7 +855,7 @@ func (x *OverlaySplitView) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -887,14 +863,13 @@ func (x *OverlaySplitView) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *OverlaySplitView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -918,19 +893,17 @@ func (x *OverlaySplitView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *OverlaySplitView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -951,7 +924,7 @@ func (x *OverlaySplitView) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -960,14 +933,12 @@ func (x *OverlaySplitView) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *OverlaySplitView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -986,10 +957,10 @@ func (x *OverlaySplitView) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acce
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *OverlaySplitView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1001,7 +972,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1010,31 +981,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xOverlaySplitViewGLibType, libs, "adw_overlay_split_view_get_type")
 
-	core.PuregoSafeRegister(&xNewOverlaySplitView, libs, "adw_overlay_split_view_new")
-
-	core.PuregoSafeRegister(&xOverlaySplitViewGetCollapsed, libs, "adw_overlay_split_view_get_collapsed")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetContent, libs, "adw_overlay_split_view_get_content")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetEnableHideGesture, libs, "adw_overlay_split_view_get_enable_hide_gesture")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetEnableShowGesture, libs, "adw_overlay_split_view_get_enable_show_gesture")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetMaxSidebarWidth, libs, "adw_overlay_split_view_get_max_sidebar_width")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetMinSidebarWidth, libs, "adw_overlay_split_view_get_min_sidebar_width")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetPinSidebar, libs, "adw_overlay_split_view_get_pin_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetShowSidebar, libs, "adw_overlay_split_view_get_show_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetSidebar, libs, "adw_overlay_split_view_get_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetSidebarPosition, libs, "adw_overlay_split_view_get_sidebar_position")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetSidebarWidthFraction, libs, "adw_overlay_split_view_get_sidebar_width_fraction")
-	core.PuregoSafeRegister(&xOverlaySplitViewGetSidebarWidthUnit, libs, "adw_overlay_split_view_get_sidebar_width_unit")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetCollapsed, libs, "adw_overlay_split_view_set_collapsed")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetContent, libs, "adw_overlay_split_view_set_content")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetEnableHideGesture, libs, "adw_overlay_split_view_set_enable_hide_gesture")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetEnableShowGesture, libs, "adw_overlay_split_view_set_enable_show_gesture")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetMaxSidebarWidth, libs, "adw_overlay_split_view_set_max_sidebar_width")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetMinSidebarWidth, libs, "adw_overlay_split_view_set_min_sidebar_width")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetPinSidebar, libs, "adw_overlay_split_view_set_pin_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetShowSidebar, libs, "adw_overlay_split_view_set_show_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetSidebar, libs, "adw_overlay_split_view_set_sidebar")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetSidebarPosition, libs, "adw_overlay_split_view_set_sidebar_position")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetSidebarWidthFraction, libs, "adw_overlay_split_view_set_sidebar_width_fraction")
-	core.PuregoSafeRegister(&xOverlaySplitViewSetSidebarWidthUnit, libs, "adw_overlay_split_view_set_sidebar_width_unit")
+	gobject.CheckClassSize("AdwOverlaySplitViewClass", xOverlaySplitViewGLibType, unsafe.Sizeof(OverlaySplitViewClass{}))
+
+	core.PuregoRegisterSince(&xNewOverlaySplitView, libs, "adw_overlay_split_view_new", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xOverlaySplitViewGetCollapsed, libs, "adw_overlay_split_view_get_collapsed", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetContent, libs, "adw_overlay_split_view_get_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetEnableHideGesture, libs, "adw_overlay_split_view_get_enable_hide_gesture", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetEnableShowGesture, libs, "adw_overlay_split_view_get_enable_show_gesture", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetMaxSidebarWidth, libs, "adw_overlay_split_view_get_max_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetMinSidebarWidth, libs, "adw_overlay_split_view_get_min_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetPinSidebar, libs, "adw_overlay_split_view_get_pin_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetShowSidebar, libs, "adw_overlay_split_view_get_show_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetSidebar, libs, "adw_overlay_split_view_get_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetSidebarPosition, libs, "adw_overlay_split_view_get_sidebar_position", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetSidebarWidthFraction, libs, "adw_overlay_split_view_get_sidebar_width_fraction", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewGetSidebarWidthUnit, libs, "adw_overlay_split_view_get_sidebar_width_unit", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetCollapsed, libs, "adw_overlay_split_view_set_collapsed", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetContent, libs, "adw_overlay_split_view_set_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetEnableHideGesture, libs, "adw_overlay_split_view_set_enable_hide_gesture", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetEnableShowGesture, libs, "adw_overlay_split_view_set_enable_show_gesture", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetMaxSidebarWidth, libs, "adw_overlay_split_view_set_max_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetMinSidebarWidth, libs, "adw_overlay_split_view_set_min_sidebar_width", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetPinSidebar, libs, "adw_overlay_split_view_set_pin_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetShowSidebar, libs, "adw_overlay_split_view_set_show_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetSidebar, libs, "adw_overlay_split_view_set_sidebar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetSidebarPosition, libs, "adw_overlay_split_view_set_sidebar_position", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetSidebarWidthFraction, libs, "adw_overlay_split_view_set_sidebar_width_fraction", "ADW", "1.4")
+	core.PuregoRegisterSince(&xOverlaySplitViewSetSidebarWidthUnit, libs, "adw_overlay_split_view_set_sidebar_width_unit", "ADW", "1.4")
 
 }
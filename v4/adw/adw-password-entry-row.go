@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,14 +22,7 @@ func (x *PasswordEntryRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@EntryRow] tailored for entering secrets.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="password-entry-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="password-entry-row.png" alt="password-entry-row"&gt;
-//
-// &lt;/picture&gt;
+// A EntryRow tailored for entering secrets.
 //
 // It does not show its contents in clear text, does not allow to copy it to the
 // clipboard, and shows a warning when Caps Lock is engaged. If the underlying
@@ -40,7 +32,7 @@ func (x *PasswordEntryRowClass) GoPointer() uintptr {
 //
 // It offer a way to reveal the contents in clear text.
 //
-// ## CSS Nodes
+// # CSS Nodes
 //
 // `AdwPasswordEntryRow` has a single CSS node with name `row` that carries
 // `.entry` and `.password` style classes.
@@ -249,7 +241,7 @@ func (x *PasswordEntryRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -257,14 +249,13 @@ func (x *PasswordEntryRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntryRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -288,19 +279,17 @@ func (x *PasswordEntryRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntryRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -321,7 +310,7 @@ func (x *PasswordEntryRow) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -330,14 +319,12 @@ func (x *PasswordEntryRow) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntryRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -356,14 +343,14 @@ func (x *PasswordEntryRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acce
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *PasswordEntryRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *PasswordEntryRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -373,15 +360,15 @@ func (x *PasswordEntryRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *PasswordEntryRow) SetActionName(ActionNameVar *string) {
 
@@ -394,13 +381,13 @@ func (x *PasswordEntryRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *PasswordEntryRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -409,14 +396,14 @@ func (x *PasswordEntryRow) SetActionTarget(FormatStringVar string, varArgs ...in
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -434,18 +421,18 @@ func (x *PasswordEntryRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *PasswordEntryRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PasswordEntryRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -458,35 +445,29 @@ func (x *PasswordEntryRow) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [gtk.AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *PasswordEntryRow) DelegateGetAccessiblePlatformState(StateVar gtk.AccessiblePlatformState) bool {
 
@@ -506,8 +487,8 @@ func (x *PasswordEntryRow) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -517,7 +498,7 @@ func (x *PasswordEntryRow) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [gtk.Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -537,8 +518,8 @@ func (x *PasswordEntryRow) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -548,10 +529,10 @@ func (x *PasswordEntryRow) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [gtk.Text] widget.
 func (x *PasswordEntryRow) GetDelegate() *gtk.EditableBase {
 	var cls *gtk.EditableBase
 
@@ -566,21 +547,21 @@ func (x *PasswordEntryRow) GetDelegate() *gtk.EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *PasswordEntryRow) GetEditable() bool {
 
 	cret := gtk.XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *PasswordEntryRow) GetEnableUndo() bool {
 
 	cret := gtk.XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *PasswordEntryRow) GetMaxWidthChars() int {
 
 	cret := gtk.XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -599,9 +580,9 @@ func (x *PasswordEntryRow) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *PasswordEntryRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -610,7 +591,7 @@ func (x *PasswordEntryRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int)
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *PasswordEntryRow) GetText() string {
@@ -630,7 +611,7 @@ func (x *PasswordEntryRow) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -640,11 +621,11 @@ func (x *PasswordEntryRow) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *PasswordEntryRow) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -655,9 +636,9 @@ func (x *PasswordEntryRow) InsertText(TextVar string, LengthVar int, PositionVar
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *PasswordEntryRow) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -683,19 +664,19 @@ func (x *PasswordEntryRow) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [gtk.Text.GetPropertyVisibility] is set to false.
 func (x *PasswordEntryRow) SetEnableUndo(EnableUndoVar bool) {
 
 	gtk.XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *PasswordEntryRow) SetMaxWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -708,7 +689,7 @@ func (x *PasswordEntryRow) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *PasswordEntryRow) SetPosition(PositionVar int) {
 
 	gtk.XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -725,11 +706,11 @@ func (x *PasswordEntryRow) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *PasswordEntryRow) SetWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -741,7 +722,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -750,6 +731,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xPasswordEntryRowGLibType, libs, "adw_password_entry_row_get_type")
 
-	core.PuregoSafeRegister(&xNewPasswordEntryRow, libs, "adw_password_entry_row_new")
+	gobject.CheckClassSize("AdwPasswordEntryRowClass", xPasswordEntryRowGLibType, unsafe.Sizeof(PasswordEntryRowClass{}))
+
+	core.PuregoRegisterSince(&xNewPasswordEntryRow, libs, "adw_password_entry_row_new", "ADW", "1.2")
 
 }
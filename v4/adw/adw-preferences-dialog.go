@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,23 +25,16 @@ func (x *PreferencesDialogClass) GoPointer() uintptr {
 
 // A dialog showing application's preferences.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="preferences-dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="preferences-dialog.png" alt="preferences-dialog"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwPreferencesDialog` widget presents an application's preferences
 // gathered into pages and groups. The preferences are searchable by the user.
 //
-// ## Actions
+// # Actions
 //
 // `AdwPrefencesDialog` defines the `navigation.pop` action, it doesn't take any
 // parameters and pops the current subpage from the navigation stack, equivalent
-// to calling [method@PreferencesDialog.pop_subpage].
+// to calling pop_subpage.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwPreferencesDialog` has a main CSS node with the name `dialog` and the
 // style class `.preferences`.
@@ -81,7 +73,7 @@ func NewPreferencesDialog() *PreferencesDialog {
 
 var xPreferencesDialogAdd func(uintptr, uintptr)
 
-// Adds a preferences page to @self.
+// Adds a preferences page to self.
 func (x *PreferencesDialog) Add(PageVar *PreferencesPage) {
 
 	xPreferencesDialogAdd(x.GoPointer(), PageVar.GoPointer())
@@ -90,9 +82,9 @@ func (x *PreferencesDialog) Add(PageVar *PreferencesPage) {
 
 var xPreferencesDialogAddToast func(uintptr, uintptr)
 
-// Displays @toast.
+// Displays toast.
 //
-// See [method@ToastOverlay.add_toast].
+// See add_toast.
 func (x *PreferencesDialog) AddToast(ToastVar *Toast) {
 
 	xPreferencesDialogAddToast(x.GoPointer(), ToastVar.GoPointer())
@@ -101,7 +93,7 @@ func (x *PreferencesDialog) AddToast(ToastVar *Toast) {
 
 var xPreferencesDialogGetSearchEnabled func(uintptr) bool
 
-// Gets whether search is enabled for @self.
+// Gets whether search is enabled for self.
 func (x *PreferencesDialog) GetSearchEnabled() bool {
 
 	cret := xPreferencesDialogGetSearchEnabled(x.GoPointer())
@@ -110,7 +102,7 @@ func (x *PreferencesDialog) GetSearchEnabled() bool {
 
 var xPreferencesDialogGetVisiblePage func(uintptr) uintptr
 
-// Gets the currently visible page of @self.
+// Gets the currently visible page of self.
 func (x *PreferencesDialog) GetVisiblePage() *PreferencesPage {
 	var cls *PreferencesPage
 
@@ -127,7 +119,7 @@ func (x *PreferencesDialog) GetVisiblePage() *PreferencesPage {
 
 var xPreferencesDialogGetVisiblePageName func(uintptr) string
 
-// Gets the name of currently visible page of @self.
+// Gets the name of currently visible page of self.
 func (x *PreferencesDialog) GetVisiblePageName() string {
 
 	cret := xPreferencesDialogGetVisiblePageName(x.GoPointer())
@@ -136,7 +128,7 @@ func (x *PreferencesDialog) GetVisiblePageName() string {
 
 var xPreferencesDialogPopSubpage func(uintptr) bool
 
-// Pop the visible page from the subpage stack of @self.
+// Pop the visible page from the subpage stack of self.
 func (x *PreferencesDialog) PopSubpage() bool {
 
 	cret := xPreferencesDialogPopSubpage(x.GoPointer())
@@ -145,7 +137,7 @@ func (x *PreferencesDialog) PopSubpage() bool {
 
 var xPreferencesDialogPushSubpage func(uintptr, uintptr)
 
-// Pushes @page onto the subpage stack of @self.
+// Pushes page onto the subpage stack of self.
 //
 // The page will be automatically removed when popped.
 func (x *PreferencesDialog) PushSubpage(PageVar *NavigationPage) {
@@ -156,7 +148,7 @@ func (x *PreferencesDialog) PushSubpage(PageVar *NavigationPage) {
 
 var xPreferencesDialogRemove func(uintptr, uintptr)
 
-// Removes a page from @self.
+// Removes a page from self.
 func (x *PreferencesDialog) Remove(PageVar *PreferencesPage) {
 
 	xPreferencesDialogRemove(x.GoPointer(), PageVar.GoPointer())
@@ -165,7 +157,7 @@ func (x *PreferencesDialog) Remove(PageVar *PreferencesPage) {
 
 var xPreferencesDialogSetSearchEnabled func(uintptr, bool)
 
-// Sets whether search is enabled for @self.
+// Sets whether search is enabled for self.
 func (x *PreferencesDialog) SetSearchEnabled(SearchEnabledVar bool) {
 
 	xPreferencesDialogSetSearchEnabled(x.GoPointer(), SearchEnabledVar)
@@ -174,7 +166,7 @@ func (x *PreferencesDialog) SetSearchEnabled(SearchEnabledVar bool) {
 
 var xPreferencesDialogSetVisiblePage func(uintptr, uintptr)
 
-// Makes @page the visible page of @self.
+// Makes page the visible page of self.
 func (x *PreferencesDialog) SetVisiblePage(PageVar *PreferencesPage) {
 
 	xPreferencesDialogSetVisiblePage(x.GoPointer(), PageVar.GoPointer())
@@ -185,7 +177,7 @@ var xPreferencesDialogSetVisiblePageName func(uintptr, string)
 
 // Makes the page with the given name visible.
 //
-// See [property@PreferencesDialog:visible-page].
+// See PreferencesDialog:visible-page.
 func (x *PreferencesDialog) SetVisiblePageName(NameVar string) {
 
 	xPreferencesDialogSetVisiblePageName(x.GoPointer(), NameVar)
@@ -223,7 +215,7 @@ func (x *PreferencesDialog) GetPropertySearchEnabled() bool {
 // SetPropertyVisiblePageName sets the "visible-page-name" property.
 // The name of the currently visible page.
 //
-// See [property@AdwPreferencesDialog:visible-page].
+// See AdwPreferencesDialog:visible-page.
 func (x *PreferencesDialog) SetPropertyVisiblePageName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -234,7 +226,7 @@ func (x *PreferencesDialog) SetPropertyVisiblePageName(value string) {
 // GetPropertyVisiblePageName gets the "visible-page-name" property.
 // The name of the currently visible page.
 //
-// See [property@AdwPreferencesDialog:visible-page].
+// See AdwPreferencesDialog:visible-page.
 func (x *PreferencesDialog) GetPropertyVisiblePageName() string {
 	var v gobject.Value
 	x.GetProperty("visible-page-name", &v)
@@ -402,7 +394,7 @@ func (x *PreferencesDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -410,14 +402,13 @@ func (x *PreferencesDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesDialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -441,19 +432,17 @@ func (x *PreferencesDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesDialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -474,7 +463,7 @@ func (x *PreferencesDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -483,14 +472,12 @@ func (x *PreferencesDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesDialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -509,10 +496,10 @@ func (x *PreferencesDialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acc
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PreferencesDialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -524,7 +511,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -533,18 +520,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xPreferencesDialogGLibType, libs, "adw_preferences_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewPreferencesDialog, libs, "adw_preferences_dialog_new")
-
-	core.PuregoSafeRegister(&xPreferencesDialogAdd, libs, "adw_preferences_dialog_add")
-	core.PuregoSafeRegister(&xPreferencesDialogAddToast, libs, "adw_preferences_dialog_add_toast")
-	core.PuregoSafeRegister(&xPreferencesDialogGetSearchEnabled, libs, "adw_preferences_dialog_get_search_enabled")
-	core.PuregoSafeRegister(&xPreferencesDialogGetVisiblePage, libs, "adw_preferences_dialog_get_visible_page")
-	core.PuregoSafeRegister(&xPreferencesDialogGetVisiblePageName, libs, "adw_preferences_dialog_get_visible_page_name")
-	core.PuregoSafeRegister(&xPreferencesDialogPopSubpage, libs, "adw_preferences_dialog_pop_subpage")
-	core.PuregoSafeRegister(&xPreferencesDialogPushSubpage, libs, "adw_preferences_dialog_push_subpage")
-	core.PuregoSafeRegister(&xPreferencesDialogRemove, libs, "adw_preferences_dialog_remove")
-	core.PuregoSafeRegister(&xPreferencesDialogSetSearchEnabled, libs, "adw_preferences_dialog_set_search_enabled")
-	core.PuregoSafeRegister(&xPreferencesDialogSetVisiblePage, libs, "adw_preferences_dialog_set_visible_page")
-	core.PuregoSafeRegister(&xPreferencesDialogSetVisiblePageName, libs, "adw_preferences_dialog_set_visible_page_name")
+	gobject.CheckClassSize("AdwPreferencesDialogClass", xPreferencesDialogGLibType, unsafe.Sizeof(PreferencesDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewPreferencesDialog, libs, "adw_preferences_dialog_new", "ADW", "1.5")
+
+	core.PuregoRegisterSince(&xPreferencesDialogAdd, libs, "adw_preferences_dialog_add", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogAddToast, libs, "adw_preferences_dialog_add_toast", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogGetSearchEnabled, libs, "adw_preferences_dialog_get_search_enabled", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogGetVisiblePage, libs, "adw_preferences_dialog_get_visible_page", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogGetVisiblePageName, libs, "adw_preferences_dialog_get_visible_page_name", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogPopSubpage, libs, "adw_preferences_dialog_pop_subpage", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogPushSubpage, libs, "adw_preferences_dialog_push_subpage", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogRemove, libs, "adw_preferences_dialog_remove", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogSetSearchEnabled, libs, "adw_preferences_dialog_set_search_enabled", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogSetVisiblePage, libs, "adw_preferences_dialog_set_visible_page", "ADW", "1.5")
+	core.PuregoRegisterSince(&xPreferencesDialogSetVisiblePageName, libs, "adw_preferences_dialog_set_visible_page_name", "ADW", "1.5")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -28,40 +27,33 @@ func (x *PreferencesGroupClass) GoPointer() uintptr {
 
 // A group of preference rows.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="preferences-group-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="preferences-group.png" alt="preferences-group"&gt;
-//
-// &lt;/picture&gt;
-//
 // An `AdwPreferencesGroup` represents a group or tightly related preferences,
-// which in turn are represented by [class@PreferencesRow].
+// which in turn are represented by PreferencesRow.
 //
 // To summarize the role of the preferences it gathers, a group can have both a
-// title and a description. The title will be used by [class@PreferencesDialog]
+// title and a description. The title will be used by PreferencesDialog
 // to let the user look for a preference.
 //
-// The [property@PreferencesGroup:separate-rows] property can be used to
+// The PreferencesGroup:separate-rows property can be used to
 // separate the rows within the group, same as when using the
-// [`.boxed-list-separate`](style-classes.html#boxed-lists-cards) style class
+// .boxed-list-separate style class
 // instead of `.boxed-list`.
 //
-// ## AdwPreferencesGroup as GtkBuildable
+// # AdwPreferencesGroup as GtkBuildable
 //
-// The `AdwPreferencesGroup` implementation of the [iface@Gtk.Buildable] interface
-// supports adding [class@PreferencesRow]s to the list by omitting "type". If "type"
-// is omitted and the widget isn't a [class@PreferencesRow] the child is added to
+// The `AdwPreferencesGroup` implementation of the [gtk.Buildable] interface
+// supports adding PreferencesRows to the list by omitting "type". If "type"
+// is omitted and the widget isn't a PreferencesRow the child is added to
 // a box below the list.
 //
 // When the "type" attribute of a child is `header-suffix`, the child
 // is set as the suffix on the end of the title and description.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwPreferencesGroup` has a single CSS node with name `preferencesgroup`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwPreferencesGroup` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type PreferencesGroup struct {
@@ -99,7 +91,7 @@ func NewPreferencesGroup() *PreferencesGroup {
 
 var xPreferencesGroupAdd func(uintptr, uintptr)
 
-// Adds a child to @self.
+// Adds a child to self.
 func (x *PreferencesGroup) Add(ChildVar *gtk.Widget) {
 
 	xPreferencesGroupAdd(x.GoPointer(), ChildVar.GoPointer())
@@ -108,9 +100,9 @@ func (x *PreferencesGroup) Add(ChildVar *gtk.Widget) {
 
 var xPreferencesGroupBindModel func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Binds @model to @self.
+// Binds model to self.
 //
-// See [method@Gtk.ListBox.bind_model].
+// See [gtk.ListBox.BindModel].
 func (x *PreferencesGroup) BindModel(ModelVar gio.ListModel, CreateRowFuncVar *gtk.ListBoxCreateWidgetFunc, UserDataVar uintptr, UserDataFreeFuncVar *glib.DestroyNotify) {
 
 	var CreateRowFuncVarRef uintptr
@@ -120,11 +112,12 @@ func (x *PreferencesGroup) BindModel(ModelVar gio.ListModel, CreateRowFuncVar *g
 			CreateRowFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CreateRowFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CreateRowFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CreateRowFuncVarPtr, CreateRowFuncVarRef, CreateRowFuncVar)
+			CreateRowFuncVarRef = core.NewCallback(fcb)
+			CreateRowFuncVarRef = glib.SaveCallbackWithClosure(CreateRowFuncVarPtr, CreateRowFuncVarRef, CreateRowFuncVar)
 		}
 	}
 
@@ -135,11 +128,12 @@ func (x *PreferencesGroup) BindModel(ModelVar gio.ListModel, CreateRowFuncVar *g
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -149,7 +143,7 @@ func (x *PreferencesGroup) BindModel(ModelVar gio.ListModel, CreateRowFuncVar *g
 
 var xPreferencesGroupGetDescription func(uintptr) string
 
-// Gets the description of @self.
+// Gets the description of self.
 func (x *PreferencesGroup) GetDescription() string {
 
 	cret := xPreferencesGroupGetDescription(x.GoPointer())
@@ -158,7 +152,7 @@ func (x *PreferencesGroup) GetDescription() string {
 
 var xPreferencesGroupGetHeaderSuffix func(uintptr) uintptr
 
-// Gets the suffix for @self's header.
+// Gets the suffix for self's header.
 func (x *PreferencesGroup) GetHeaderSuffix() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -175,9 +169,9 @@ func (x *PreferencesGroup) GetHeaderSuffix() *gtk.Widget {
 
 var xPreferencesGroupGetRow func(uintptr, uint) uintptr
 
-// Gets the row at @index.
+// Gets the row at index.
 //
-// Can return `NULL` if @index is larger than the number of rows in the group.
+// Can return `NULL` if index is larger than the number of rows in the group.
 func (x *PreferencesGroup) GetRow(IndexVar uint) *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -194,7 +188,7 @@ func (x *PreferencesGroup) GetRow(IndexVar uint) *gtk.Widget {
 
 var xPreferencesGroupGetSeparateRows func(uintptr) bool
 
-// Gets whether @self's rows are separated.
+// Gets whether self's rows are separated.
 func (x *PreferencesGroup) GetSeparateRows() bool {
 
 	cret := xPreferencesGroupGetSeparateRows(x.GoPointer())
@@ -203,7 +197,7 @@ func (x *PreferencesGroup) GetSeparateRows() bool {
 
 var xPreferencesGroupGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *PreferencesGroup) GetTitle() string {
 
 	cret := xPreferencesGroupGetTitle(x.GoPointer())
@@ -212,7 +206,7 @@ func (x *PreferencesGroup) GetTitle() string {
 
 var xPreferencesGroupRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 func (x *PreferencesGroup) Remove(ChildVar *gtk.Widget) {
 
 	xPreferencesGroupRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -221,7 +215,7 @@ func (x *PreferencesGroup) Remove(ChildVar *gtk.Widget) {
 
 var xPreferencesGroupSetDescription func(uintptr, uintptr)
 
-// Sets the description for @self.
+// Sets the description for self.
 func (x *PreferencesGroup) SetDescription(DescriptionVar *string) {
 
 	DescriptionVarPtr := core.GStrdupNullable(DescriptionVar)
@@ -233,7 +227,7 @@ func (x *PreferencesGroup) SetDescription(DescriptionVar *string) {
 
 var xPreferencesGroupSetHeaderSuffix func(uintptr, uintptr)
 
-// Sets the suffix for @self's header.
+// Sets the suffix for self's header.
 //
 // Displayed above the list, next to the title and description.
 //
@@ -246,11 +240,11 @@ func (x *PreferencesGroup) SetHeaderSuffix(SuffixVar *gtk.Widget) {
 
 var xPreferencesGroupSetSeparateRows func(uintptr, bool)
 
-// Sets whether @self's rows are separated.
+// Sets whether self's rows are separated.
 //
 // Equivalent to using the
-// [`.boxed-list-separate`](style-classes.html#boxed-lists-cards) style class
-// on a [class@Gtk.ListBox] instead of `.boxed-list`.
+// .boxed-list-separate style class
+// on a [gtk.ListBox] instead of `.boxed-list`.
 func (x *PreferencesGroup) SetSeparateRows(SeparateRowsVar bool) {
 
 	xPreferencesGroupSetSeparateRows(x.GoPointer(), SeparateRowsVar)
@@ -259,7 +253,7 @@ func (x *PreferencesGroup) SetSeparateRows(SeparateRowsVar bool) {
 
 var xPreferencesGroupSetTitle func(uintptr, string)
 
-// Sets the title for @self.
+// Sets the title for self.
 func (x *PreferencesGroup) SetTitle(TitleVar string) {
 
 	xPreferencesGroupSetTitle(x.GoPointer(), TitleVar)
@@ -298,8 +292,8 @@ func (x *PreferencesGroup) GetPropertyDescription() string {
 // Whether to separate rows.
 //
 // Equivalent to using the
-// [`.boxed-list-separate`](style-classes.html#boxed-lists-cards) style class
-// on a [class@Gtk.ListBox] instead of `.boxed-list`.
+// .boxed-list-separate style class
+// on a [gtk.ListBox] instead of `.boxed-list`.
 func (x *PreferencesGroup) SetPropertySeparateRows(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -311,8 +305,8 @@ func (x *PreferencesGroup) SetPropertySeparateRows(value bool) {
 // Whether to separate rows.
 //
 // Equivalent to using the
-// [`.boxed-list-separate`](style-classes.html#boxed-lists-cards) style class
-// on a [class@Gtk.ListBox] instead of `.boxed-list`.
+// .boxed-list-separate style class
+// on a [gtk.ListBox] instead of `.boxed-list`.
 func (x *PreferencesGroup) GetPropertySeparateRows() bool {
 	var v gobject.Value
 	x.GetProperty("separate-rows", &v)
@@ -497,7 +491,7 @@ func (x *PreferencesGroup) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -505,14 +499,13 @@ func (x *PreferencesGroup) UpdatePlatformState(StateVar gtk.AccessiblePlatformSt
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesGroup) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -536,19 +529,17 @@ func (x *PreferencesGroup) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesGroup) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -569,7 +560,7 @@ func (x *PreferencesGroup) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -578,14 +569,12 @@ func (x *PreferencesGroup) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesGroup) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -604,10 +593,10 @@ func (x *PreferencesGroup) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acce
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PreferencesGroup) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -619,7 +608,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -628,19 +617,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xPreferencesGroupGLibType, libs, "adw_preferences_group_get_type")
 
-	core.PuregoSafeRegister(&xNewPreferencesGroup, libs, "adw_preferences_group_new")
-
-	core.PuregoSafeRegister(&xPreferencesGroupAdd, libs, "adw_preferences_group_add")
-	core.PuregoSafeRegister(&xPreferencesGroupBindModel, libs, "adw_preferences_group_bind_model")
-	core.PuregoSafeRegister(&xPreferencesGroupGetDescription, libs, "adw_preferences_group_get_description")
-	core.PuregoSafeRegister(&xPreferencesGroupGetHeaderSuffix, libs, "adw_preferences_group_get_header_suffix")
-	core.PuregoSafeRegister(&xPreferencesGroupGetRow, libs, "adw_preferences_group_get_row")
-	core.PuregoSafeRegister(&xPreferencesGroupGetSeparateRows, libs, "adw_preferences_group_get_separate_rows")
-	core.PuregoSafeRegister(&xPreferencesGroupGetTitle, libs, "adw_preferences_group_get_title")
-	core.PuregoSafeRegister(&xPreferencesGroupRemove, libs, "adw_preferences_group_remove")
-	core.PuregoSafeRegister(&xPreferencesGroupSetDescription, libs, "adw_preferences_group_set_description")
-	core.PuregoSafeRegister(&xPreferencesGroupSetHeaderSuffix, libs, "adw_preferences_group_set_header_suffix")
-	core.PuregoSafeRegister(&xPreferencesGroupSetSeparateRows, libs, "adw_preferences_group_set_separate_rows")
-	core.PuregoSafeRegister(&xPreferencesGroupSetTitle, libs, "adw_preferences_group_set_title")
+	gobject.CheckClassSize("AdwPreferencesGroupClass", xPreferencesGroupGLibType, unsafe.Sizeof(PreferencesGroupClass{}))
+
+	core.PuregoRegisterSince(&xNewPreferencesGroup, libs, "adw_preferences_group_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xPreferencesGroupAdd, libs, "adw_preferences_group_add", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesGroupBindModel, libs, "adw_preferences_group_bind_model", "ADW", "1.8")
+	core.PuregoRegisterSince(&xPreferencesGroupGetDescription, libs, "adw_preferences_group_get_description", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesGroupGetHeaderSuffix, libs, "adw_preferences_group_get_header_suffix", "ADW", "1.1")
+	core.PuregoRegisterSince(&xPreferencesGroupGetRow, libs, "adw_preferences_group_get_row", "ADW", "1.8")
+	core.PuregoRegisterSince(&xPreferencesGroupGetSeparateRows, libs, "adw_preferences_group_get_separate_rows", "ADW", "1.6")
+	core.PuregoRegisterSince(&xPreferencesGroupGetTitle, libs, "adw_preferences_group_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesGroupRemove, libs, "adw_preferences_group_remove", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesGroupSetDescription, libs, "adw_preferences_group_set_description", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesGroupSetHeaderSuffix, libs, "adw_preferences_group_set_header_suffix", "ADW", "1.1")
+	core.PuregoRegisterSince(&xPreferencesGroupSetSeparateRows, libs, "adw_preferences_group_set_separate_rows", "ADW", "1.6")
+	core.PuregoRegisterSince(&xPreferencesGroupSetTitle, libs, "adw_preferences_group_set_title", "ADW", "")
 
 }
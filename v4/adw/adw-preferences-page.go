@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,23 +23,16 @@ func (x *PreferencesPageClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A page from [class@PreferencesDialog].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="preferences-page-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="preferences-page.png" alt="preferences-page"&gt;
-//
-// &lt;/picture&gt;
+// A page from PreferencesDialog.
 //
 // The `AdwPreferencesPage` widget gathers preferences groups into a single page
 // of a preferences window.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwPreferencesPage` has a single CSS node with name `preferencespage`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwPreferencesPage` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type PreferencesPage struct {
@@ -78,7 +70,7 @@ func NewPreferencesPage() *PreferencesPage {
 
 var xPreferencesPageAdd func(uintptr, uintptr)
 
-// Adds a preferences group to @self.
+// Adds a preferences group to self.
 func (x *PreferencesPage) Add(GroupVar *PreferencesGroup) {
 
 	xPreferencesPageAdd(x.GoPointer(), GroupVar.GoPointer())
@@ -104,7 +96,7 @@ func (x *PreferencesPage) GetBanner() *Banner {
 
 var xPreferencesPageGetDescription func(uintptr) string
 
-// Gets the description of @self.
+// Gets the description of self.
 func (x *PreferencesPage) GetDescription() string {
 
 	cret := xPreferencesPageGetDescription(x.GoPointer())
@@ -122,9 +114,9 @@ func (x *PreferencesPage) GetDescriptionCentered() bool {
 
 var xPreferencesPageGetGroup func(uintptr, uint) uintptr
 
-// Gets the group at @index.
+// Gets the group at index.
 //
-// Can return `NULL` if @index is larger than the number of groups in the page.
+// Can return `NULL` if index is larger than the number of groups in the page.
 func (x *PreferencesPage) GetGroup(IndexVar uint) *PreferencesGroup {
 	var cls *PreferencesGroup
 
@@ -141,7 +133,7 @@ func (x *PreferencesPage) GetGroup(IndexVar uint) *PreferencesGroup {
 
 var xPreferencesPageGetIconName func(uintptr) string
 
-// Gets the icon name for @self.
+// Gets the icon name for self.
 func (x *PreferencesPage) GetIconName() string {
 
 	cret := xPreferencesPageGetIconName(x.GoPointer())
@@ -150,7 +142,7 @@ func (x *PreferencesPage) GetIconName() string {
 
 var xPreferencesPageGetName func(uintptr) string
 
-// Gets the name of @self.
+// Gets the name of self.
 func (x *PreferencesPage) GetName() string {
 
 	cret := xPreferencesPageGetName(x.GoPointer())
@@ -159,7 +151,7 @@ func (x *PreferencesPage) GetName() string {
 
 var xPreferencesPageGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *PreferencesPage) GetTitle() string {
 
 	cret := xPreferencesPageGetTitle(x.GoPointer())
@@ -177,10 +169,10 @@ func (x *PreferencesPage) GetUseUnderline() bool {
 
 var xPreferencesPageInsert func(uintptr, uintptr, int)
 
-// Inserts a preferences group to @self at @index.
+// Inserts a preferences group to self at index.
 //
-// If @index is negative or larger than the number of groups, appends the group,
-// same as [method@PreferencesPage.add].
+// If index is negative or larger than the number of groups, appends the group,
+// same as add.
 func (x *PreferencesPage) Insert(GroupVar *PreferencesGroup, IndexVar int) {
 
 	xPreferencesPageInsert(x.GoPointer(), GroupVar.GoPointer(), IndexVar)
@@ -189,7 +181,7 @@ func (x *PreferencesPage) Insert(GroupVar *PreferencesGroup, IndexVar int) {
 
 var xPreferencesPageRemove func(uintptr, uintptr)
 
-// Removes a group from @self.
+// Removes a group from self.
 func (x *PreferencesPage) Remove(GroupVar *PreferencesGroup) {
 
 	xPreferencesPageRemove(x.GoPointer(), GroupVar.GoPointer())
@@ -198,7 +190,7 @@ func (x *PreferencesPage) Remove(GroupVar *PreferencesGroup) {
 
 var xPreferencesPageScrollToTop func(uintptr)
 
-// Scrolls the scrolled window of @self to the top.
+// Scrolls the scrolled window of self to the top.
 func (x *PreferencesPage) ScrollToTop() {
 
 	xPreferencesPageScrollToTop(x.GoPointer())
@@ -216,7 +208,7 @@ func (x *PreferencesPage) SetBanner(BannerVar *Banner) {
 
 var xPreferencesPageSetDescription func(uintptr, string)
 
-// Sets the description of @self.
+// Sets the description of self.
 //
 // The description is displayed at the top of the page.
 func (x *PreferencesPage) SetDescription(DescriptionVar string) {
@@ -236,7 +228,7 @@ func (x *PreferencesPage) SetDescriptionCentered(CenteredVar bool) {
 
 var xPreferencesPageSetIconName func(uintptr, uintptr)
 
-// Sets the icon name for @self.
+// Sets the icon name for self.
 func (x *PreferencesPage) SetIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -248,7 +240,7 @@ func (x *PreferencesPage) SetIconName(IconNameVar *string) {
 
 var xPreferencesPageSetName func(uintptr, uintptr)
 
-// Sets the name of @self.
+// Sets the name of self.
 func (x *PreferencesPage) SetName(NameVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -260,7 +252,7 @@ func (x *PreferencesPage) SetName(NameVar *string) {
 
 var xPreferencesPageSetTitle func(uintptr, string)
 
-// Sets the title of @self.
+// Sets the title of self.
 func (x *PreferencesPage) SetTitle(TitleVar string) {
 
 	xPreferencesPageSetTitle(x.GoPointer(), TitleVar)
@@ -550,7 +542,7 @@ func (x *PreferencesPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -558,14 +550,13 @@ func (x *PreferencesPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesPage) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -589,19 +580,17 @@ func (x *PreferencesPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesPage) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -622,7 +611,7 @@ func (x *PreferencesPage) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -631,14 +620,12 @@ func (x *PreferencesPage) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesPage) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -657,10 +644,10 @@ func (x *PreferencesPage) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PreferencesPage) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -672,7 +659,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -681,26 +668,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xPreferencesPageGLibType, libs, "adw_preferences_page_get_type")
 
-	core.PuregoSafeRegister(&xNewPreferencesPage, libs, "adw_preferences_page_new")
-
-	core.PuregoSafeRegister(&xPreferencesPageAdd, libs, "adw_preferences_page_add")
-	core.PuregoSafeRegister(&xPreferencesPageGetBanner, libs, "adw_preferences_page_get_banner")
-	core.PuregoSafeRegister(&xPreferencesPageGetDescription, libs, "adw_preferences_page_get_description")
-	core.PuregoSafeRegister(&xPreferencesPageGetDescriptionCentered, libs, "adw_preferences_page_get_description_centered")
-	core.PuregoSafeRegister(&xPreferencesPageGetGroup, libs, "adw_preferences_page_get_group")
-	core.PuregoSafeRegister(&xPreferencesPageGetIconName, libs, "adw_preferences_page_get_icon_name")
-	core.PuregoSafeRegister(&xPreferencesPageGetName, libs, "adw_preferences_page_get_name")
-	core.PuregoSafeRegister(&xPreferencesPageGetTitle, libs, "adw_preferences_page_get_title")
-	core.PuregoSafeRegister(&xPreferencesPageGetUseUnderline, libs, "adw_preferences_page_get_use_underline")
-	core.PuregoSafeRegister(&xPreferencesPageInsert, libs, "adw_preferences_page_insert")
-	core.PuregoSafeRegister(&xPreferencesPageRemove, libs, "adw_preferences_page_remove")
-	core.PuregoSafeRegister(&xPreferencesPageScrollToTop, libs, "adw_preferences_page_scroll_to_top")
-	core.PuregoSafeRegister(&xPreferencesPageSetBanner, libs, "adw_preferences_page_set_banner")
-	core.PuregoSafeRegister(&xPreferencesPageSetDescription, libs, "adw_preferences_page_set_description")
-	core.PuregoSafeRegister(&xPreferencesPageSetDescriptionCentered, libs, "adw_preferences_page_set_description_centered")
-	core.PuregoSafeRegister(&xPreferencesPageSetIconName, libs, "adw_preferences_page_set_icon_name")
-	core.PuregoSafeRegister(&xPreferencesPageSetName, libs, "adw_preferences_page_set_name")
-	core.PuregoSafeRegister(&xPreferencesPageSetTitle, libs, "adw_preferences_page_set_title")
-	core.PuregoSafeRegister(&xPreferencesPageSetUseUnderline, libs, "adw_preferences_page_set_use_underline")
+	gobject.CheckClassSize("AdwPreferencesPageClass", xPreferencesPageGLibType, unsafe.Sizeof(PreferencesPageClass{}))
+
+	core.PuregoRegisterSince(&xNewPreferencesPage, libs, "adw_preferences_page_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xPreferencesPageAdd, libs, "adw_preferences_page_add", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageGetBanner, libs, "adw_preferences_page_get_banner", "ADW", "1.7")
+	core.PuregoRegisterSince(&xPreferencesPageGetDescription, libs, "adw_preferences_page_get_description", "ADW", "1.4")
+	core.PuregoRegisterSince(&xPreferencesPageGetDescriptionCentered, libs, "adw_preferences_page_get_description_centered", "ADW", "1.6")
+	core.PuregoRegisterSince(&xPreferencesPageGetGroup, libs, "adw_preferences_page_get_group", "ADW", "1.8")
+	core.PuregoRegisterSince(&xPreferencesPageGetIconName, libs, "adw_preferences_page_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageGetName, libs, "adw_preferences_page_get_name", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageGetTitle, libs, "adw_preferences_page_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageGetUseUnderline, libs, "adw_preferences_page_get_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageInsert, libs, "adw_preferences_page_insert", "ADW", "1.8")
+	core.PuregoRegisterSince(&xPreferencesPageRemove, libs, "adw_preferences_page_remove", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageScrollToTop, libs, "adw_preferences_page_scroll_to_top", "ADW", "1.3")
+	core.PuregoRegisterSince(&xPreferencesPageSetBanner, libs, "adw_preferences_page_set_banner", "ADW", "1.7")
+	core.PuregoRegisterSince(&xPreferencesPageSetDescription, libs, "adw_preferences_page_set_description", "ADW", "1.4")
+	core.PuregoRegisterSince(&xPreferencesPageSetDescriptionCentered, libs, "adw_preferences_page_set_description_centered", "ADW", "1.6")
+	core.PuregoRegisterSince(&xPreferencesPageSetIconName, libs, "adw_preferences_page_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageSetName, libs, "adw_preferences_page_set_name", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageSetTitle, libs, "adw_preferences_page_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesPageSetUseUnderline, libs, "adw_preferences_page_set_use_underline", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,13 +24,13 @@ func (x *PreferencesRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] used to present preferences.
+// A [gtk.ListBoxRow] used to present preferences.
 //
-// The `AdwPreferencesRow` widget has a title that [class@PreferencesDialog]
+// The `AdwPreferencesRow` widget has a title that PreferencesDialog
 // will use to let the user look for a preference. It doesn't present the title
 // in any way and lets you present the preference as you please.
 //
-// [class@ActionRow] and its derivatives are convenient to use as preference
+// ActionRow and its derivatives are convenient to use as preference
 // rows as they take care of presenting the preference's title while letting you
 // compose the inputs of the preference around it.
 type PreferencesRow struct {
@@ -69,7 +68,7 @@ func NewPreferencesRow() *PreferencesRow {
 
 var xPreferencesRowGetTitle func(uintptr) string
 
-// Gets the title of the preference represented by @self.
+// Gets the title of the preference represented by self.
 func (x *PreferencesRow) GetTitle() string {
 
 	cret := xPreferencesRowGetTitle(x.GoPointer())
@@ -105,10 +104,10 @@ func (x *PreferencesRow) GetUseUnderline() bool {
 
 var xPreferencesRowSetTitle func(uintptr, string)
 
-// Sets the title of the preference represented by @self.
+// Sets the title of the preference represented by self.
 //
 // The title is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *PreferencesRow) SetTitle(TitleVar string) {
 
 	xPreferencesRowSetTitle(x.GoPointer(), TitleVar)
@@ -119,7 +118,7 @@ var xPreferencesRowSetTitleSelectable func(uintptr, bool)
 
 // Sets whether the user can copy the title from the label
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *PreferencesRow) SetTitleSelectable(TitleSelectableVar bool) {
 
 	xPreferencesRowSetTitleSelectable(x.GoPointer(), TitleSelectableVar)
@@ -132,7 +131,7 @@ var xPreferencesRowSetUseMarkup func(uintptr, bool)
 //
 // Subclasses may also use it for other labels, such as subtitle.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *PreferencesRow) SetUseMarkup(UseMarkupVar bool) {
 
 	xPreferencesRowSetUseMarkup(x.GoPointer(), UseMarkupVar)
@@ -163,7 +162,7 @@ func (c *PreferencesRow) SetGoPointer(ptr uintptr) {
 // The title of the preference represented by this row.
 //
 // The title is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *PreferencesRow) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -175,7 +174,7 @@ func (x *PreferencesRow) SetPropertyTitle(value string) {
 // The title of the preference represented by this row.
 //
 // The title is interpreted as Pango markup unless
-// [property@PreferencesRow:use-markup] is set to `FALSE`.
+// PreferencesRow:use-markup is set to `FALSE`.
 func (x *PreferencesRow) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -185,7 +184,7 @@ func (x *PreferencesRow) GetPropertyTitle() string {
 // SetPropertyTitleSelectable sets the "title-selectable" property.
 // Whether the user can copy the title from the label.
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *PreferencesRow) SetPropertyTitleSelectable(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -196,7 +195,7 @@ func (x *PreferencesRow) SetPropertyTitleSelectable(value bool) {
 // GetPropertyTitleSelectable gets the "title-selectable" property.
 // Whether the user can copy the title from the label.
 //
-// See also [property@Gtk.Label:selectable].
+// See also [gtk.Label.GetPropertySelectable].
 func (x *PreferencesRow) GetPropertyTitleSelectable() bool {
 	var v gobject.Value
 	x.GetProperty("title-selectable", &v)
@@ -208,7 +207,7 @@ func (x *PreferencesRow) GetPropertyTitleSelectable() bool {
 //
 // Subclasses may also use it for other labels, such as subtitle.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *PreferencesRow) SetPropertyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -221,7 +220,7 @@ func (x *PreferencesRow) SetPropertyUseMarkup(value bool) {
 //
 // Subclasses may also use it for other labels, such as subtitle.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *PreferencesRow) GetPropertyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("use-markup", &v)
@@ -406,7 +405,7 @@ func (x *PreferencesRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -414,14 +413,13 @@ func (x *PreferencesRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformStat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -445,19 +443,17 @@ func (x *PreferencesRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -478,7 +474,7 @@ func (x *PreferencesRow) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -487,14 +483,12 @@ func (x *PreferencesRow) UpdateRelationValue(NRelationsVar int, RelationsVar []g
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -513,14 +507,14 @@ func (x *PreferencesRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Access
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *PreferencesRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *PreferencesRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -530,15 +524,15 @@ func (x *PreferencesRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *PreferencesRow) SetActionName(ActionNameVar *string) {
 
@@ -551,13 +545,13 @@ func (x *PreferencesRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *PreferencesRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -566,14 +560,14 @@ func (x *PreferencesRow) SetActionTarget(FormatStringVar string, varArgs ...inte
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -591,18 +585,18 @@ func (x *PreferencesRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *PreferencesRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PreferencesRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -614,7 +608,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -623,15 +617,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xPreferencesRowGLibType, libs, "adw_preferences_row_get_type")
 
-	core.PuregoSafeRegister(&xNewPreferencesRow, libs, "adw_preferences_row_new")
+	gobject.CheckClassSize("AdwPreferencesRowClass", xPreferencesRowGLibType, unsafe.Sizeof(PreferencesRowClass{}))
+
+	core.PuregoRegisterSince(&xNewPreferencesRow, libs, "adw_preferences_row_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xPreferencesRowGetTitle, libs, "adw_preferences_row_get_title")
-	core.PuregoSafeRegister(&xPreferencesRowGetTitleSelectable, libs, "adw_preferences_row_get_title_selectable")
-	core.PuregoSafeRegister(&xPreferencesRowGetUseMarkup, libs, "adw_preferences_row_get_use_markup")
-	core.PuregoSafeRegister(&xPreferencesRowGetUseUnderline, libs, "adw_preferences_row_get_use_underline")
-	core.PuregoSafeRegister(&xPreferencesRowSetTitle, libs, "adw_preferences_row_set_title")
-	core.PuregoSafeRegister(&xPreferencesRowSetTitleSelectable, libs, "adw_preferences_row_set_title_selectable")
-	core.PuregoSafeRegister(&xPreferencesRowSetUseMarkup, libs, "adw_preferences_row_set_use_markup")
-	core.PuregoSafeRegister(&xPreferencesRowSetUseUnderline, libs, "adw_preferences_row_set_use_underline")
+	core.PuregoRegisterSince(&xPreferencesRowGetTitle, libs, "adw_preferences_row_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesRowGetTitleSelectable, libs, "adw_preferences_row_get_title_selectable", "ADW", "1.1")
+	core.PuregoRegisterSince(&xPreferencesRowGetUseMarkup, libs, "adw_preferences_row_get_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xPreferencesRowGetUseUnderline, libs, "adw_preferences_row_get_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesRowSetTitle, libs, "adw_preferences_row_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesRowSetTitleSelectable, libs, "adw_preferences_row_set_title_selectable", "ADW", "1.1")
+	core.PuregoRegisterSince(&xPreferencesRowSetUseMarkup, libs, "adw_preferences_row_set_use_markup", "ADW", "1.2")
+	core.PuregoRegisterSince(&xPreferencesRowSetUseUnderline, libs, "adw_preferences_row_set_use_underline", "ADW", "")
 
 }
@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -28,20 +26,15 @@ func (x *PreferencesWindowClass) GoPointer() uintptr {
 
 // A window to present an application's preferences.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="preferences-window-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="preferences-window.png" alt="preferences-window"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwPreferencesWindow` widget presents an application's preferences
 // gathered into pages and groups. The preferences are searchable by the user.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwPreferencesWindow` has a main CSS node with the name `window` and the
 // style class `.preferences`.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
 type PreferencesWindow struct {
 	Window
 }
@@ -58,190 +51,6 @@ func PreferencesWindowNewFromInternalPtr(ptr uintptr) *PreferencesWindow {
 	return cls
 }
 
-var xNewPreferencesWindow func() uintptr
-
-// Creates a new `AdwPreferencesWindow`.
-func NewPreferencesWindow() *PreferencesWindow {
-	var cls *PreferencesWindow
-
-	cret := xNewPreferencesWindow()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &PreferencesWindow{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xPreferencesWindowAdd func(uintptr, uintptr)
-
-// Adds a preferences page to @self.
-func (x *PreferencesWindow) Add(PageVar *PreferencesPage) {
-
-	xPreferencesWindowAdd(x.GoPointer(), PageVar.GoPointer())
-
-}
-
-var xPreferencesWindowAddToast func(uintptr, uintptr)
-
-// Displays @toast.
-//
-// See [method@ToastOverlay.add_toast].
-func (x *PreferencesWindow) AddToast(ToastVar *Toast) {
-
-	xPreferencesWindowAddToast(x.GoPointer(), ToastVar.GoPointer())
-
-}
-
-var xPreferencesWindowCloseSubpage func(uintptr)
-
-// Closes the current subpage.
-//
-// If there is no presented subpage, this does nothing.
-func (x *PreferencesWindow) CloseSubpage() {
-
-	xPreferencesWindowCloseSubpage(x.GoPointer())
-
-}
-
-var xPreferencesWindowGetCanNavigateBack func(uintptr) bool
-
-// Gets whether gestures and shortcuts for closing subpages are enabled.
-func (x *PreferencesWindow) GetCanNavigateBack() bool {
-
-	cret := xPreferencesWindowGetCanNavigateBack(x.GoPointer())
-	return cret
-}
-
-var xPreferencesWindowGetSearchEnabled func(uintptr) bool
-
-// Gets whether search is enabled for @self.
-func (x *PreferencesWindow) GetSearchEnabled() bool {
-
-	cret := xPreferencesWindowGetSearchEnabled(x.GoPointer())
-	return cret
-}
-
-var xPreferencesWindowGetVisiblePage func(uintptr) uintptr
-
-// Gets the currently visible page of @self.
-func (x *PreferencesWindow) GetVisiblePage() *PreferencesPage {
-	var cls *PreferencesPage
-
-	cret := xPreferencesWindowGetVisiblePage(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &PreferencesPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xPreferencesWindowGetVisiblePageName func(uintptr) string
-
-// Gets the name of currently visible page of @self.
-func (x *PreferencesWindow) GetVisiblePageName() string {
-
-	cret := xPreferencesWindowGetVisiblePageName(x.GoPointer())
-	return cret
-}
-
-var xPreferencesWindowPopSubpage func(uintptr) bool
-
-// Pop the visible page from the subpage stack of @self.
-func (x *PreferencesWindow) PopSubpage() bool {
-
-	cret := xPreferencesWindowPopSubpage(x.GoPointer())
-	return cret
-}
-
-var xPreferencesWindowPresentSubpage func(uintptr, uintptr)
-
-// Sets @subpage as the window's subpage and opens it.
-//
-// The transition can be cancelled by the user, in which case visible child will
-// change back to the previously visible child.
-func (x *PreferencesWindow) PresentSubpage(SubpageVar *gtk.Widget) {
-
-	xPreferencesWindowPresentSubpage(x.GoPointer(), SubpageVar.GoPointer())
-
-}
-
-var xPreferencesWindowPushSubpage func(uintptr, uintptr)
-
-// Pushes @page onto the subpage stack of @self.
-//
-// The page will be automatically removed when popped.
-func (x *PreferencesWindow) PushSubpage(PageVar *NavigationPage) {
-
-	xPreferencesWindowPushSubpage(x.GoPointer(), PageVar.GoPointer())
-
-}
-
-var xPreferencesWindowRemove func(uintptr, uintptr)
-
-// Removes a page from @self.
-func (x *PreferencesWindow) Remove(PageVar *PreferencesPage) {
-
-	xPreferencesWindowRemove(x.GoPointer(), PageVar.GoPointer())
-
-}
-
-var xPreferencesWindowSetCanNavigateBack func(uintptr, bool)
-
-// Sets whether gestures and shortcuts for closing subpages are enabled.
-//
-// The supported gestures are:
-//
-// - One-finger swipe on touchscreens
-// - Horizontal scrolling on touchpads (usually two-finger swipe)
-// - Back mouse button
-//
-// The keyboard back key is also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut.
-//
-// For right-to-left locales, gestures and shortcuts are reversed.
-//
-// Has no effect for subpages added with [method@PreferencesWindow.push_subpage].
-func (x *PreferencesWindow) SetCanNavigateBack(CanNavigateBackVar bool) {
-
-	xPreferencesWindowSetCanNavigateBack(x.GoPointer(), CanNavigateBackVar)
-
-}
-
-var xPreferencesWindowSetSearchEnabled func(uintptr, bool)
-
-// Sets whether search is enabled for @self.
-func (x *PreferencesWindow) SetSearchEnabled(SearchEnabledVar bool) {
-
-	xPreferencesWindowSetSearchEnabled(x.GoPointer(), SearchEnabledVar)
-
-}
-
-var xPreferencesWindowSetVisiblePage func(uintptr, uintptr)
-
-// Makes @page the visible page of @self.
-func (x *PreferencesWindow) SetVisiblePage(PageVar *PreferencesPage) {
-
-	xPreferencesWindowSetVisiblePage(x.GoPointer(), PageVar.GoPointer())
-
-}
-
-var xPreferencesWindowSetVisiblePageName func(uintptr, string)
-
-// Makes the page with the given name visible.
-//
-// See [property@PreferencesWindow:visible-page].
-func (x *PreferencesWindow) SetVisiblePageName(NameVar string) {
-
-	xPreferencesWindowSetVisiblePageName(x.GoPointer(), NameVar)
-
-}
-
 func (c *PreferencesWindow) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -263,12 +72,14 @@ func (c *PreferencesWindow) SetGoPointer(ptr uintptr) {
 // - Back mouse button
 //
 // The keyboard back key is also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut.
+// Alt+← shortcut.
 //
 // For right-to-left locales, gestures and shortcuts are reversed.
 //
 // Has no effect for subpages added with
-// [method@PreferencesWindow.push_subpage].
+// push_subpage.
+//
+// Deprecated: since 1.4. Use NavigationPage:can-pop instead.
 func (x *PreferencesWindow) SetPropertyCanNavigateBack(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -286,12 +97,14 @@ func (x *PreferencesWindow) SetPropertyCanNavigateBack(value bool) {
 // - Back mouse button
 //
 // The keyboard back key is also supported, as well as the
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt; shortcut.
+// Alt+← shortcut.
 //
 // For right-to-left locales, gestures and shortcuts are reversed.
 //
 // Has no effect for subpages added with
-// [method@PreferencesWindow.push_subpage].
+// push_subpage.
+//
+// Deprecated: since 1.4. Use NavigationPage:can-pop instead.
 func (x *PreferencesWindow) GetPropertyCanNavigateBack() bool {
 	var v gobject.Value
 	x.GetProperty("can-navigate-back", &v)
@@ -300,6 +113,8 @@ func (x *PreferencesWindow) GetPropertyCanNavigateBack() bool {
 
 // SetPropertySearchEnabled sets the "search-enabled" property.
 // Whether search is enabled.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
 func (x *PreferencesWindow) SetPropertySearchEnabled(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -309,6 +124,8 @@ func (x *PreferencesWindow) SetPropertySearchEnabled(value bool) {
 
 // GetPropertySearchEnabled gets the "search-enabled" property.
 // Whether search is enabled.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
 func (x *PreferencesWindow) GetPropertySearchEnabled() bool {
 	var v gobject.Value
 	x.GetProperty("search-enabled", &v)
@@ -318,7 +135,9 @@ func (x *PreferencesWindow) GetPropertySearchEnabled() bool {
 // SetPropertyVisiblePageName sets the "visible-page-name" property.
 // The name of the currently visible page.
 //
-// See [property@PreferencesWindow:visible-page].
+// See PreferencesWindow:visible-page.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
 func (x *PreferencesWindow) SetPropertyVisiblePageName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -329,7 +148,9 @@ func (x *PreferencesWindow) SetPropertyVisiblePageName(value string) {
 // GetPropertyVisiblePageName gets the "visible-page-name" property.
 // The name of the currently visible page.
 //
-// See [property@PreferencesWindow:visible-page].
+// See PreferencesWindow:visible-page.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
 func (x *PreferencesWindow) GetPropertyVisiblePageName() string {
 	var v gobject.Value
 	x.GetProperty("visible-page-name", &v)
@@ -497,7 +318,7 @@ func (x *PreferencesWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -505,14 +326,13 @@ func (x *PreferencesWindow) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesWindow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -536,19 +356,17 @@ func (x *PreferencesWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesWindow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -569,7 +387,7 @@ func (x *PreferencesWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -578,14 +396,12 @@ func (x *PreferencesWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PreferencesWindow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -604,10 +420,10 @@ func (x *PreferencesWindow) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acc
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PreferencesWindow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -644,10 +460,10 @@ func (x *PreferencesWindow) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *PreferencesWindow) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	gtk.XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -691,7 +507,7 @@ func (x *PreferencesWindow) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *PreferencesWindow) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
@@ -707,50 +523,16 @@ func (x *PreferencesWindow) GetFocus() *gtk.Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [gtk.Widget.GrabFocus] instead of
 // this function.
 func (x *PreferencesWindow) SetFocus(FocusVar *gtk.Widget) {
 
 	gtk.XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("ADW", "libadwaita-1")
-	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xPreferencesWindowGLibType, libs, "adw_preferences_window_get_type")
-
-	core.PuregoSafeRegister(&xNewPreferencesWindow, libs, "adw_preferences_window_new")
-
-	core.PuregoSafeRegister(&xPreferencesWindowAdd, libs, "adw_preferences_window_add")
-	core.PuregoSafeRegister(&xPreferencesWindowAddToast, libs, "adw_preferences_window_add_toast")
-	core.PuregoSafeRegister(&xPreferencesWindowCloseSubpage, libs, "adw_preferences_window_close_subpage")
-	core.PuregoSafeRegister(&xPreferencesWindowGetCanNavigateBack, libs, "adw_preferences_window_get_can_navigate_back")
-	core.PuregoSafeRegister(&xPreferencesWindowGetSearchEnabled, libs, "adw_preferences_window_get_search_enabled")
-	core.PuregoSafeRegister(&xPreferencesWindowGetVisiblePage, libs, "adw_preferences_window_get_visible_page")
-	core.PuregoSafeRegister(&xPreferencesWindowGetVisiblePageName, libs, "adw_preferences_window_get_visible_page_name")
-	core.PuregoSafeRegister(&xPreferencesWindowPopSubpage, libs, "adw_preferences_window_pop_subpage")
-	core.PuregoSafeRegister(&xPreferencesWindowPresentSubpage, libs, "adw_preferences_window_present_subpage")
-	core.PuregoSafeRegister(&xPreferencesWindowPushSubpage, libs, "adw_preferences_window_push_subpage")
-	core.PuregoSafeRegister(&xPreferencesWindowRemove, libs, "adw_preferences_window_remove")
-	core.PuregoSafeRegister(&xPreferencesWindowSetCanNavigateBack, libs, "adw_preferences_window_set_can_navigate_back")
-	core.PuregoSafeRegister(&xPreferencesWindowSetSearchEnabled, libs, "adw_preferences_window_set_search_enabled")
-	core.PuregoSafeRegister(&xPreferencesWindowSetVisiblePage, libs, "adw_preferences_window_set_visible_page")
-	core.PuregoSafeRegister(&xPreferencesWindowSetVisiblePageName, libs, "adw_preferences_window_set_visible_page_name")
-
-}
@@ -0,0 +1,258 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xNewPreferencesWindow func() uintptr
+
+// Creates a new `AdwPreferencesWindow`.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func NewPreferencesWindow() *PreferencesWindow {
+	var cls *PreferencesWindow
+
+	cret := xNewPreferencesWindow()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &PreferencesWindow{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPreferencesWindowAdd func(uintptr, uintptr)
+
+// Adds a preferences page to self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) Add(PageVar *PreferencesPage) {
+
+	xPreferencesWindowAdd(x.GoPointer(), PageVar.GoPointer())
+
+}
+
+var xPreferencesWindowAddToast func(uintptr, uintptr)
+
+// Displays toast.
+//
+// See add_toast.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) AddToast(ToastVar *Toast) {
+
+	xPreferencesWindowAddToast(x.GoPointer(), ToastVar.GoPointer())
+
+}
+
+var xPreferencesWindowCloseSubpage func(uintptr)
+
+// Closes the current subpage.
+//
+// If there is no presented subpage, this does nothing.
+//
+// Deprecated: since 1.4. Use pop_subpage instead.
+func (x *PreferencesWindow) CloseSubpage() {
+
+	xPreferencesWindowCloseSubpage(x.GoPointer())
+
+}
+
+var xPreferencesWindowGetCanNavigateBack func(uintptr) bool
+
+// Gets whether gestures and shortcuts for closing subpages are enabled.
+//
+// Deprecated: since 1.4. Use get_can_pop instead.
+func (x *PreferencesWindow) GetCanNavigateBack() bool {
+
+	cret := xPreferencesWindowGetCanNavigateBack(x.GoPointer())
+	return cret
+}
+
+var xPreferencesWindowGetSearchEnabled func(uintptr) bool
+
+// Gets whether search is enabled for self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) GetSearchEnabled() bool {
+
+	cret := xPreferencesWindowGetSearchEnabled(x.GoPointer())
+	return cret
+}
+
+var xPreferencesWindowGetVisiblePage func(uintptr) uintptr
+
+// Gets the currently visible page of self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) GetVisiblePage() *PreferencesPage {
+	var cls *PreferencesPage
+
+	cret := xPreferencesWindowGetVisiblePage(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &PreferencesPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPreferencesWindowGetVisiblePageName func(uintptr) string
+
+// Gets the name of currently visible page of self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) GetVisiblePageName() string {
+
+	cret := xPreferencesWindowGetVisiblePageName(x.GoPointer())
+	return cret
+}
+
+var xPreferencesWindowPopSubpage func(uintptr) bool
+
+// Pop the visible page from the subpage stack of self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) PopSubpage() bool {
+
+	cret := xPreferencesWindowPopSubpage(x.GoPointer())
+	return cret
+}
+
+var xPreferencesWindowPresentSubpage func(uintptr, uintptr)
+
+// Sets subpage as the window's subpage and opens it.
+//
+// The transition can be cancelled by the user, in which case visible child will
+// change back to the previously visible child.
+//
+// Deprecated: since 1.4. Use push_subpage instead.
+func (x *PreferencesWindow) PresentSubpage(SubpageVar *gtk.Widget) {
+
+	xPreferencesWindowPresentSubpage(x.GoPointer(), SubpageVar.GoPointer())
+
+}
+
+var xPreferencesWindowPushSubpage func(uintptr, uintptr)
+
+// Pushes page onto the subpage stack of self.
+//
+// The page will be automatically removed when popped.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) PushSubpage(PageVar *NavigationPage) {
+
+	xPreferencesWindowPushSubpage(x.GoPointer(), PageVar.GoPointer())
+
+}
+
+var xPreferencesWindowRemove func(uintptr, uintptr)
+
+// Removes a page from self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) Remove(PageVar *PreferencesPage) {
+
+	xPreferencesWindowRemove(x.GoPointer(), PageVar.GoPointer())
+
+}
+
+var xPreferencesWindowSetCanNavigateBack func(uintptr, bool)
+
+// Sets whether gestures and shortcuts for closing subpages are enabled.
+//
+// The supported gestures are:
+//
+// - One-finger swipe on touchscreens
+// - Horizontal scrolling on touchpads (usually two-finger swipe)
+// - Back mouse button
+//
+// The keyboard back key is also supported, as well as the
+// Alt+← shortcut.
+//
+// For right-to-left locales, gestures and shortcuts are reversed.
+//
+// Has no effect for subpages added with push_subpage.
+//
+// Deprecated: since 1.4. Use set_can_pop instead.
+func (x *PreferencesWindow) SetCanNavigateBack(CanNavigateBackVar bool) {
+
+	xPreferencesWindowSetCanNavigateBack(x.GoPointer(), CanNavigateBackVar)
+
+}
+
+var xPreferencesWindowSetSearchEnabled func(uintptr, bool)
+
+// Sets whether search is enabled for self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) SetSearchEnabled(SearchEnabledVar bool) {
+
+	xPreferencesWindowSetSearchEnabled(x.GoPointer(), SearchEnabledVar)
+
+}
+
+var xPreferencesWindowSetVisiblePage func(uintptr, uintptr)
+
+// Makes page the visible page of self.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) SetVisiblePage(PageVar *PreferencesPage) {
+
+	xPreferencesWindowSetVisiblePage(x.GoPointer(), PageVar.GoPointer())
+
+}
+
+var xPreferencesWindowSetVisiblePageName func(uintptr, string)
+
+// Makes the page with the given name visible.
+//
+// See PreferencesWindow:visible-page.
+//
+// Deprecated: since 1.6. Use PreferencesDialog.
+func (x *PreferencesWindow) SetVisiblePageName(NameVar string) {
+
+	xPreferencesWindowSetVisiblePageName(x.GoPointer(), NameVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewPreferencesWindow, libs, "adw_preferences_window_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xPreferencesWindowAdd, libs, "adw_preferences_window_add", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowAddToast, libs, "adw_preferences_window_add_toast", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowCloseSubpage, libs, "adw_preferences_window_close_subpage", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowGetCanNavigateBack, libs, "adw_preferences_window_get_can_navigate_back", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowGetSearchEnabled, libs, "adw_preferences_window_get_search_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowGetVisiblePage, libs, "adw_preferences_window_get_visible_page", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowGetVisiblePageName, libs, "adw_preferences_window_get_visible_page_name", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowPopSubpage, libs, "adw_preferences_window_pop_subpage", "ADW", "1.4")
+	core.PuregoRegisterSince(&xPreferencesWindowPresentSubpage, libs, "adw_preferences_window_present_subpage", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowPushSubpage, libs, "adw_preferences_window_push_subpage", "ADW", "1.4")
+	core.PuregoRegisterSince(&xPreferencesWindowRemove, libs, "adw_preferences_window_remove", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowSetCanNavigateBack, libs, "adw_preferences_window_set_can_navigate_back", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowSetSearchEnabled, libs, "adw_preferences_window_set_search_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowSetVisiblePage, libs, "adw_preferences_window_set_visible_page", "ADW", "")
+	core.PuregoRegisterSince(&xPreferencesWindowSetVisiblePageName, libs, "adw_preferences_window_set_visible_page_name", "ADW", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,71 +23,39 @@ func (x *ShortcutLabelClass) GoPointer() uintptr {
 
 // A widget that displays a keyboard shortcut.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="shortcut-label-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="shortcut-label.png" alt="shortcut-label"&gt;
-//
-// &lt;/picture&gt;
-//
-// The shown shortcut can be set using the [property@ShortcutLabel:accelerator]
+// The shown shortcut can be set using the ShortcutLabel:accelerator
 // property.
 //
 // Optionally, if no shortcut is set, `AdwShortcutLabel` will display a
-// placeholder set with the [property@ShortcutLabel:disabled-text] property.
+// placeholder set with the ShortcutLabel:disabled-text property.
 //
 // The following types of shortcuts can be displayed:
 //
-// - A single shortcut in [func@Gtk.accelerator_parse] format, e.g. `&lt;Control&gt;C`:
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="shortcut-label-single-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img src="shortcut-label-single.png" alt="shortcut-label-single"&gt;
-//	&lt;/picture&gt;
-//
-// - Multiple alternative shortcuts, separated with spaces, e.g. `&lt;Shift&gt;A Home`:
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="shortcut-label-alternative-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img src="shortcut-label-alternative.png" alt="shortcut-label-alternative"&gt;
-//	&lt;/picture&gt;
+// - A single shortcut in [gtk.AcceleratorParse] format, e.g. `<Control>C`:
 //
-// - A range of shortcuts, separated with `...`, e.g. `&lt;Alt&gt;1...9`:
+// - Multiple alternative shortcuts, separated with spaces, e.g. `<Shift>A Home`:
 //
-//	&lt;picture&gt;
-//	  &lt;source srcset="shortcut-label-range-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img src="shortcut-label-range.png" alt="shortcut-label-range"&gt;
-//	&lt;/picture&gt;
+// - A range of shortcuts, separated with `...`, e.g. `<Alt>1...9`:
 //
-// - Multiple keys pressed at once, separated with `&amp;`, e.g. `Control_L&amp;Control_R`:
+// - Multiple keys pressed at once, separated with `&`, e.g. `Control_L&Control_R`:
 //
-//	&lt;picture&gt;
-//	  &lt;source srcset="shortcut-label-multiple-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img src="shortcut-label-multiple.png" alt="shortcut-label-multiple"&gt;
-//	&lt;/picture&gt;
-//
-// - Multiple shortcuts or keys, pressed sequentially, separated with `+`, e.g. `&lt;Control&gt;C+&lt;Control&gt;X`:
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="shortcut-label-sequence-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img src="shortcut-label-sequence.png" alt="shortcut-label-sequence"&gt;
-//	&lt;/picture&gt;
+// - Multiple shortcuts or keys, pressed sequentially, separated with `+`, e.g. `<Control>C+<Control>X`:
 //
 // ::: note
 //
-//	`&lt;`, `&gt;` and `&amp;` need to be escaped as `&amp;lt;`, `&amp;gt;` and `&amp;amp;` when used in UI files.
+//	`<`, `>` and `&` need to be escaped as `&lt;`, `&gt;` and `&amp;` when used in UI files.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwShortcutLabel` has a single CSS node with name `shortcut-label`. The
 // individual keycap labels each have the `.keycap` style class, while the
 // labels separating them have the `.dimmed` style class.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwShortcutLabel` uses the `GTK_ACCESSIBLE_ROLE_GENERIC` role.
 //
-// See also: [class@ShortcutsDialog].
+// See also: ShortcutsDialog.
 type ShortcutLabel struct {
 	gtk.Widget
 }
@@ -107,7 +74,7 @@ func ShortcutLabelNewFromInternalPtr(ptr uintptr) *ShortcutLabel {
 
 var xNewShortcutLabel func(string) uintptr
 
-// Creates a new `AdwShortcutLabel` showing @accelerator.
+// Creates a new `AdwShortcutLabel` showing accelerator.
 func NewShortcutLabel(AcceleratorVar string) *ShortcutLabel {
 	var cls *ShortcutLabel
 
@@ -124,7 +91,7 @@ func NewShortcutLabel(AcceleratorVar string) *ShortcutLabel {
 
 var xShortcutLabelGetAccelerator func(uintptr) string
 
-// Gets the accelerator displayed by @self.
+// Gets the accelerator displayed by self.
 func (x *ShortcutLabel) GetAccelerator() string {
 
 	cret := xShortcutLabelGetAccelerator(x.GoPointer())
@@ -133,7 +100,7 @@ func (x *ShortcutLabel) GetAccelerator() string {
 
 var xShortcutLabelGetDisabledText func(uintptr) string
 
-// Gets the text displayed by @self when no accelerator is set.
+// Gets the text displayed by self when no accelerator is set.
 func (x *ShortcutLabel) GetDisabledText() string {
 
 	cret := xShortcutLabelGetDisabledText(x.GoPointer())
@@ -142,7 +109,7 @@ func (x *ShortcutLabel) GetDisabledText() string {
 
 var xShortcutLabelSetAccelerator func(uintptr, string)
 
-// Sets the accelerator to be displayed by @self.
+// Sets the accelerator to be displayed by self.
 func (x *ShortcutLabel) SetAccelerator(AcceleratorVar string) {
 
 	xShortcutLabelSetAccelerator(x.GoPointer(), AcceleratorVar)
@@ -151,7 +118,7 @@ func (x *ShortcutLabel) SetAccelerator(AcceleratorVar string) {
 
 var xShortcutLabelSetDisabledText func(uintptr, string)
 
-// Sets the text to be displayed by @self when no accelerator is set.
+// Sets the text to be displayed by self when no accelerator is set.
 func (x *ShortcutLabel) SetDisabledText(DisabledTextVar string) {
 
 	xShortcutLabelSetDisabledText(x.GoPointer(), DisabledTextVar)
@@ -364,7 +331,7 @@ func (x *ShortcutLabel) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -372,14 +339,13 @@ func (x *ShortcutLabel) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -403,19 +369,17 @@ func (x *ShortcutLabel) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -436,7 +400,7 @@ func (x *ShortcutLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -445,14 +409,12 @@ func (x *ShortcutLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -471,10 +433,10 @@ func (x *ShortcutLabel) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutLabel) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -486,7 +448,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -495,11 +457,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutLabelGLibType, libs, "adw_shortcut_label_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcutLabel, libs, "adw_shortcut_label_new")
+	gobject.CheckClassSize("AdwShortcutLabelClass", xShortcutLabelGLibType, unsafe.Sizeof(ShortcutLabelClass{}))
+
+	core.PuregoRegisterSince(&xNewShortcutLabel, libs, "adw_shortcut_label_new", "ADW", "1.8")
 
-	core.PuregoSafeRegister(&xShortcutLabelGetAccelerator, libs, "adw_shortcut_label_get_accelerator")
-	core.PuregoSafeRegister(&xShortcutLabelGetDisabledText, libs, "adw_shortcut_label_get_disabled_text")
-	core.PuregoSafeRegister(&xShortcutLabelSetAccelerator, libs, "adw_shortcut_label_set_accelerator")
-	core.PuregoSafeRegister(&xShortcutLabelSetDisabledText, libs, "adw_shortcut_label_set_disabled_text")
+	core.PuregoRegisterSince(&xShortcutLabelGetAccelerator, libs, "adw_shortcut_label_get_accelerator", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutLabelGetDisabledText, libs, "adw_shortcut_label_get_disabled_text", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutLabelSetAccelerator, libs, "adw_shortcut_label_set_accelerator", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutLabelSetDisabledText, libs, "adw_shortcut_label_set_disabled_text", "ADW", "1.8")
 
 }
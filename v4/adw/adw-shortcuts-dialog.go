@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,18 +23,11 @@ func (x *ShortcutsDialogClass) GoPointer() uintptr {
 
 // A dialog that displays application's keyboard shortcuts.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="shortcuts-dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="shortcuts-dialog.png" alt="shortcuts-dialog"&gt;
-//
-// &lt;/picture&gt;
-//
-// Shortcuts are grouped into sections, represented by [class@ShortcutsSection]
+// Shortcuts are grouped into sections, represented by ShortcutsSection
 // objects. Each section has one or more items, represented by
-// [class@ShortcutsItem] objects.
+// ShortcutsItem objects.
 //
-// To add a section to the dialog, use [method@ShortcutsDialog.add], or add it
+// To add a section to the dialog, use add, or add it
 // as a child when using UI files.
 //
 // Sections without titles can be used to further subdivide each section into
@@ -43,76 +35,65 @@ func (x *ShortcutsDialogClass) GoPointer() uintptr {
 //
 // Example of an `AdwShortcutsDialog` UI definition:
 //
-// ```xml
-// &lt;object class="AdwShortcutsDialog" id="shortcuts_dialog"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="AdwShortcutsSection"&gt;
-//	    &lt;property name="title" translatable="yes"&gt;General&lt;/property&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Open Menu&lt;/property&gt;
-//	        &lt;property name="accelerator"&gt;F10&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Quit&lt;/property&gt;
-//	        &lt;property name="action-name"&gt;app.quit&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwShortcutsSection"&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Move Tab Left&lt;/property&gt;
-//	        &lt;property name="accelerator"&gt;&amp;lt;Shift&amp;gt;&amp;lt;Ctrl&amp;gt;Page_Up&lt;/property&gt;
-//	        &lt;property name="direction"&gt;ltr&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Move Tab Right&lt;/property&gt;
-//	        &lt;property name="accelerator"&gt;&amp;lt;Shift&amp;gt;&amp;lt;Ctrl&amp;gt;Page_Down&lt;/property&gt;
-//	        &lt;property name="direction"&gt;ltr&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Move Tab Right&lt;/property&gt;
-//	        &lt;property name="accelerator"&gt;&amp;lt;Shift&amp;gt;&amp;lt;Ctrl&amp;gt;Page_Up&lt;/property&gt;
-//	        &lt;property name="direction"&gt;rtl&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;child&gt;
-//	      &lt;object class="AdwShortcutsItem"&gt;
-//	        &lt;property name="title" translatable="yes"&gt;Move Tab Left&lt;/property&gt;
-//	        &lt;property name="accelerator"&gt;&amp;lt;Shift&amp;gt;&amp;lt;Ctrl&amp;gt;Page_Down&lt;/property&gt;
-//	        &lt;property name="direction"&gt;rtl&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// If the `app.quit` action has the &lt;kbd&gt;Ctrl&lt;/kbd&gt;&lt;kbd&gt;Q&lt;/kbd&gt; accelerator
+//	<object class="AdwShortcutsDialog" id="shortcuts_dialog">
+//	  <child>
+//	    <object class="AdwShortcutsSection">
+//	      <property name="title" translatable="yes">General</property>
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Open Menu</property>
+//	          <property name="accelerator">F10</property>
+//	        </object>
+//	      </child>
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Quit</property>
+//	          <property name="action-name">app.quit</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="AdwShortcutsSection">
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Move Tab Left</property>
+//	          <property name="accelerator">&lt;Shift&gt;&lt;Ctrl&gt;Page_Up</property>
+//	          <property name="direction">ltr</property>
+//	        </object>
+//	      </child>
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Move Tab Right</property>
+//	          <property name="accelerator">&lt;Shift&gt;&lt;Ctrl&gt;Page_Down</property>
+//	          <property name="direction">ltr</property>
+//	        </object>
+//	      </child>
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Move Tab Right</property>
+//	          <property name="accelerator">&lt;Shift&gt;&lt;Ctrl&gt;Page_Up</property>
+//	          <property name="direction">rtl</property>
+//	        </object>
+//	      </child>
+//	      <child>
+//	        <object class="AdwShortcutsItem">
+//	          <property name="title" translatable="yes">Move Tab Left</property>
+//	          <property name="accelerator">&lt;Shift&gt;&lt;Ctrl&gt;Page_Down</property>
+//	          <property name="direction">rtl</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// If the `app.quit` action has the CtrlQ accelerator
 // associated with it, the result will look as follows:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="shortcuts-dialog-example-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="shortcuts-dialog-example.png" alt="shortcuts-dialog-example"&gt;
-//
-// &lt;/picture&gt;
-//
-// The recommended way to use `AdwShortcutsDialog` is via [class@Application]'s
+// The recommended way to use `AdwShortcutsDialog` is via Application's
 // automatic resource loading.
 //
-// See also: [class@ShortcutLabel].
+// See also: ShortcutLabel.
 type ShortcutsDialog struct {
 	Dialog
 }
@@ -148,7 +129,7 @@ func NewShortcutsDialog() *ShortcutsDialog {
 
 var xShortcutsDialogAdd func(uintptr, uintptr)
 
-// Adds @section to @self.
+// Adds section to self.
 func (x *ShortcutsDialog) Add(SectionVar *ShortcutsSection) {
 
 	xShortcutsDialogAdd(x.GoPointer(), SectionVar.GoPointer())
@@ -327,7 +308,7 @@ func (x *ShortcutsDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -335,14 +316,13 @@ func (x *ShortcutsDialog) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsDialog) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -366,19 +346,17 @@ func (x *ShortcutsDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsDialog) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -399,7 +377,7 @@ func (x *ShortcutsDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -408,14 +386,12 @@ func (x *ShortcutsDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsDialog) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -434,10 +410,10 @@ func (x *ShortcutsDialog) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsDialog) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -449,7 +425,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -458,8 +434,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutsDialogGLibType, libs, "adw_shortcuts_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcutsDialog, libs, "adw_shortcuts_dialog_new")
+	gobject.CheckClassSize("AdwShortcutsDialogClass", xShortcutsDialogGLibType, unsafe.Sizeof(ShortcutsDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewShortcutsDialog, libs, "adw_shortcuts_dialog_new", "ADW", "1.8")
 
-	core.PuregoSafeRegister(&xShortcutsDialogAdd, libs, "adw_shortcuts_dialog_add")
+	core.PuregoRegisterSince(&xShortcutsDialogAdd, libs, "adw_shortcuts_dialog_add", "ADW", "1.8")
 
 }
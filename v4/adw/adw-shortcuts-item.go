@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,20 +21,20 @@ func (x *ShortcutsItemClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// An object representing an individual shortcut in [class@ShortcutsSection].
+// An object representing an individual shortcut in ShortcutsSection.
 //
 // A shortcut has a title, an optional subtitle, and an accelerator.
 //
-// Accelerator must be specified in the format [class@ShortcutLabel] accepts.
+// Accelerator must be specified in the format ShortcutLabel accepts.
 //
-// Alternatively, the [property@ShortcutsItem:action-name] property can be used
+// Alternatively, the ShortcutsItem:action-name property can be used
 // to automatically get accelerator associated with the specified action, as set
-// via [method@Gtk.Application.set_accels_for_action].
+// via [gtk.Application.SetAccelsForAction].
 //
 // If both are specified, the accelerator will be used if the action couldn't
 // be found or doesn't have an accelerator associated for it.
 //
-// If [property@ShortcutsItem:direction] is set, the shortcut will only be
+// If ShortcutsItem:direction is set, the shortcut will only be
 // displayed for the specified text direction. This allows to display different
 // shortcuts for different text directions.
 type ShortcutsItem struct {
@@ -56,7 +55,7 @@ func ShortcutsItemNewFromInternalPtr(ptr uintptr) *ShortcutsItem {
 
 var xNewShortcutsItem func(string, string) uintptr
 
-// Creates a new `AdwShortcutsItem` with @title and @accelerator.
+// Creates a new `AdwShortcutsItem` with title and accelerator.
 func NewShortcutsItem(TitleVar string, AcceleratorVar string) *ShortcutsItem {
 	var cls *ShortcutsItem
 
@@ -72,7 +71,7 @@ func NewShortcutsItem(TitleVar string, AcceleratorVar string) *ShortcutsItem {
 
 var xNewShortcutsItemFromAction func(string, string) uintptr
 
-// Creates a new `AdwShortcutsItem` with @title and @action_name.
+// Creates a new `AdwShortcutsItem` with title and action_name.
 func NewShortcutsItemFromAction(TitleVar string, ActionNameVar string) *ShortcutsItem {
 	var cls *ShortcutsItem
 
@@ -88,7 +87,7 @@ func NewShortcutsItemFromAction(TitleVar string, ActionNameVar string) *Shortcut
 
 var xShortcutsItemGetAccelerator func(uintptr) string
 
-// Gets the accelerator of @self.
+// Gets the accelerator of self.
 func (x *ShortcutsItem) GetAccelerator() string {
 
 	cret := xShortcutsItemGetAccelerator(x.GoPointer())
@@ -106,7 +105,7 @@ func (x *ShortcutsItem) GetActionName() string {
 
 var xShortcutsItemGetDirection func(uintptr) gtk.TextDirection
 
-// Gets the direction of @self.
+// Gets the direction of self.
 func (x *ShortcutsItem) GetDirection() gtk.TextDirection {
 
 	cret := xShortcutsItemGetDirection(x.GoPointer())
@@ -115,7 +114,7 @@ func (x *ShortcutsItem) GetDirection() gtk.TextDirection {
 
 var xShortcutsItemGetSubtitle func(uintptr) string
 
-// Gets the subtitle of @self.
+// Gets the subtitle of self.
 func (x *ShortcutsItem) GetSubtitle() string {
 
 	cret := xShortcutsItemGetSubtitle(x.GoPointer())
@@ -124,7 +123,7 @@ func (x *ShortcutsItem) GetSubtitle() string {
 
 var xShortcutsItemGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *ShortcutsItem) GetTitle() string {
 
 	cret := xShortcutsItemGetTitle(x.GoPointer())
@@ -133,9 +132,9 @@ func (x *ShortcutsItem) GetTitle() string {
 
 var xShortcutsItemSetAccelerator func(uintptr, string)
 
-// Sets the accelerator of @self.
+// Sets the accelerator of self.
 //
-// @accelerator must be in the format [class@ShortcutLabel] accepts.
+// accelerator must be in the format ShortcutLabel accepts.
 func (x *ShortcutsItem) SetAccelerator(AcceleratorVar string) {
 
 	xShortcutsItemSetAccelerator(x.GoPointer(), AcceleratorVar)
@@ -153,9 +152,9 @@ func (x *ShortcutsItem) SetActionName(ActionNameVar string) {
 
 var xShortcutsItemSetDirection func(uintptr, gtk.TextDirection)
 
-// Sets the direction of @self.
+// Sets the direction of self.
 //
-// If set to [enum@Gtk.TextDirection.LTR] or [enum@Gtk.TextDirection.RTL], the
+// If set to TextDirection.LTR or TextDirection.RTL, the
 // shortcut will only be displayed for this direction.
 func (x *ShortcutsItem) SetDirection(DirectionVar gtk.TextDirection) {
 
@@ -165,7 +164,7 @@ func (x *ShortcutsItem) SetDirection(DirectionVar gtk.TextDirection) {
 
 var xShortcutsItemSetSubtitle func(uintptr, string)
 
-// Sets the subtitle of @self.
+// Sets the subtitle of self.
 func (x *ShortcutsItem) SetSubtitle(SubtitleVar string) {
 
 	xShortcutsItemSetSubtitle(x.GoPointer(), SubtitleVar)
@@ -174,7 +173,7 @@ func (x *ShortcutsItem) SetSubtitle(SubtitleVar string) {
 
 var xShortcutsItemSetTitle func(uintptr, string)
 
-// Sets the title of @self.
+// Sets the title of self.
 func (x *ShortcutsItem) SetTitle(TitleVar string) {
 
 	xShortcutsItemSetTitle(x.GoPointer(), TitleVar)
@@ -195,7 +194,7 @@ func (c *ShortcutsItem) SetGoPointer(ptr uintptr) {
 // SetPropertyAccelerator sets the "accelerator" property.
 // The shortcut accelerator.
 //
-// Accelerator must be in the format [class@ShortcutLabel] accepts.
+// Accelerator must be in the format ShortcutLabel accepts.
 func (x *ShortcutsItem) SetPropertyAccelerator(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -206,7 +205,7 @@ func (x *ShortcutsItem) SetPropertyAccelerator(value string) {
 // GetPropertyAccelerator gets the "accelerator" property.
 // The shortcut accelerator.
 //
-// Accelerator must be in the format [class@ShortcutLabel] accepts.
+// Accelerator must be in the format ShortcutLabel accepts.
 func (x *ShortcutsItem) GetPropertyAccelerator() string {
 	var v gobject.Value
 	x.GetProperty("accelerator", &v)
@@ -269,7 +268,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -278,18 +277,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutsItemGLibType, libs, "adw_shortcuts_item_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcutsItem, libs, "adw_shortcuts_item_new")
-	core.PuregoSafeRegister(&xNewShortcutsItemFromAction, libs, "adw_shortcuts_item_new_from_action")
-
-	core.PuregoSafeRegister(&xShortcutsItemGetAccelerator, libs, "adw_shortcuts_item_get_accelerator")
-	core.PuregoSafeRegister(&xShortcutsItemGetActionName, libs, "adw_shortcuts_item_get_action_name")
-	core.PuregoSafeRegister(&xShortcutsItemGetDirection, libs, "adw_shortcuts_item_get_direction")
-	core.PuregoSafeRegister(&xShortcutsItemGetSubtitle, libs, "adw_shortcuts_item_get_subtitle")
-	core.PuregoSafeRegister(&xShortcutsItemGetTitle, libs, "adw_shortcuts_item_get_title")
-	core.PuregoSafeRegister(&xShortcutsItemSetAccelerator, libs, "adw_shortcuts_item_set_accelerator")
-	core.PuregoSafeRegister(&xShortcutsItemSetActionName, libs, "adw_shortcuts_item_set_action_name")
-	core.PuregoSafeRegister(&xShortcutsItemSetDirection, libs, "adw_shortcuts_item_set_direction")
-	core.PuregoSafeRegister(&xShortcutsItemSetSubtitle, libs, "adw_shortcuts_item_set_subtitle")
-	core.PuregoSafeRegister(&xShortcutsItemSetTitle, libs, "adw_shortcuts_item_set_title")
+	gobject.CheckClassSize("AdwShortcutsItemClass", xShortcutsItemGLibType, unsafe.Sizeof(ShortcutsItemClass{}))
+
+	core.PuregoRegisterSince(&xNewShortcutsItem, libs, "adw_shortcuts_item_new", "ADW", "1.8")
+	core.PuregoRegisterSince(&xNewShortcutsItemFromAction, libs, "adw_shortcuts_item_new_from_action", "ADW", "1.8")
+
+	core.PuregoRegisterSince(&xShortcutsItemGetAccelerator, libs, "adw_shortcuts_item_get_accelerator", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemGetActionName, libs, "adw_shortcuts_item_get_action_name", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemGetDirection, libs, "adw_shortcuts_item_get_direction", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemGetSubtitle, libs, "adw_shortcuts_item_get_subtitle", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemGetTitle, libs, "adw_shortcuts_item_get_title", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemSetAccelerator, libs, "adw_shortcuts_item_set_accelerator", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemSetActionName, libs, "adw_shortcuts_item_set_action_name", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemSetDirection, libs, "adw_shortcuts_item_set_direction", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemSetSubtitle, libs, "adw_shortcuts_item_set_subtitle", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsItemSetTitle, libs, "adw_shortcuts_item_set_title", "ADW", "1.8")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,15 +22,15 @@ func (x *ShortcutsSectionClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// An object representing a section in [class@ShortcutsDialog].
+// An object representing a section in ShortcutsDialog.
 //
-// It contains [class@ShortcutsItem] objects, use [method@ShortcutsSection.add] to
+// It contains ShortcutsItem objects, use add to
 // add them.
 //
-// `AdwShortcutsSection` implements the [iface@Gio.ListModel] interface and
+// `AdwShortcutsSection` implements the [gio.ListModel] interface and
 // allows to access the added shortcut items through it.
 //
-// ## `AdwShortcutsSection` as `GtkBuildable`
+// # `AdwShortcutsSection` as `GtkBuildable`
 //
 // `AdwShortcutsSection` allows adding `AdwShortcutsItem` objects as children.
 type ShortcutsSection struct {
@@ -52,7 +51,7 @@ func ShortcutsSectionNewFromInternalPtr(ptr uintptr) *ShortcutsSection {
 
 var xNewShortcutsSection func(uintptr) uintptr
 
-// Creates a new `AdwShortcutsSection` with @title as its title if provided.
+// Creates a new `AdwShortcutsSection` with title as its title if provided.
 func NewShortcutsSection(TitleVar *string) *ShortcutsSection {
 	var cls *ShortcutsSection
 
@@ -71,7 +70,7 @@ func NewShortcutsSection(TitleVar *string) *ShortcutsSection {
 
 var xShortcutsSectionAdd func(uintptr, uintptr)
 
-// Adds @item to @self.
+// Adds item to self.
 func (x *ShortcutsSection) Add(ItemVar *ShortcutsItem) {
 
 	xShortcutsSectionAdd(x.GoPointer(), ItemVar.GoPointer())
@@ -80,7 +79,7 @@ func (x *ShortcutsSection) Add(ItemVar *ShortcutsItem) {
 
 var xShortcutsSectionGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *ShortcutsSection) GetTitle() string {
 
 	cret := xShortcutsSectionGetTitle(x.GoPointer())
@@ -89,7 +88,7 @@ func (x *ShortcutsSection) GetTitle() string {
 
 var xShortcutsSectionSetTitle func(uintptr, uintptr)
 
-// Sets the title of @self.
+// Sets the title of self.
 func (x *ShortcutsSection) SetTitle(TitleVar *string) {
 
 	TitleVarPtr := core.GStrdupNullable(TitleVar)
@@ -127,28 +126,30 @@ func (x *ShortcutsSection) GetPropertyTitle() string {
 	return v.GetString()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *ShortcutsSection) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *ShortcutsSection) GetItemType() types.GType {
 
@@ -156,29 +157,29 @@ func (x *ShortcutsSection) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *ShortcutsSection) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *ShortcutsSection) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -192,20 +193,20 @@ func (x *ShortcutsSection) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -218,10 +219,10 @@ func (x *ShortcutsSection) ItemsChanged(PositionVar uint, RemovedVar uint, Added
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsSection) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -233,7 +234,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -242,10 +243,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutsSectionGLibType, libs, "adw_shortcuts_section_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcutsSection, libs, "adw_shortcuts_section_new")
+	gobject.CheckClassSize("AdwShortcutsSectionClass", xShortcutsSectionGLibType, unsafe.Sizeof(ShortcutsSectionClass{}))
+
+	core.PuregoRegisterSince(&xNewShortcutsSection, libs, "adw_shortcuts_section_new", "ADW", "1.8")
 
-	core.PuregoSafeRegister(&xShortcutsSectionAdd, libs, "adw_shortcuts_section_add")
-	core.PuregoSafeRegister(&xShortcutsSectionGetTitle, libs, "adw_shortcuts_section_get_title")
-	core.PuregoSafeRegister(&xShortcutsSectionSetTitle, libs, "adw_shortcuts_section_set_title")
+	core.PuregoRegisterSince(&xShortcutsSectionAdd, libs, "adw_shortcuts_section_add", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsSectionGetTitle, libs, "adw_shortcuts_section_get_title", "ADW", "1.8")
+	core.PuregoRegisterSince(&xShortcutsSectionSetTitle, libs, "adw_shortcuts_section_set_title", "ADW", "1.8")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,42 +22,31 @@ func (x *SpinRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// An [class@ActionRow] with an embedded spin button.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="spin-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="spin-row.png" alt="spin-row"&gt;
-//
-// &lt;/picture&gt;
+// An ActionRow with an embedded spin button.
 //
 // Example of an `AdwSpinRow` UI definition:
 //
-// ```xml
-// &lt;object class="AdwSpinRow"&gt;
-//
-//	&lt;property name="title" translatable="yes"&gt;Spin Row&lt;/property&gt;
-//	&lt;property name="adjustment"&gt;
-//	  &lt;object class="GtkAdjustment"&gt;
-//	    &lt;property name="lower"&gt;0&lt;/property&gt;
-//	    &lt;property name="upper"&gt;100&lt;/property&gt;
-//	    &lt;property name="value"&gt;50&lt;/property&gt;
-//	    &lt;property name="page-increment"&gt;10&lt;/property&gt;
-//	    &lt;property name="step-increment"&gt;1&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwSpinRow">
+//	  <property name="title" translatable="yes">Spin Row</property>
+//	  <property name="adjustment">
+//	    <object class="GtkAdjustment">
+//	      <property name="lower">0</property>
+//	      <property name="upper">100</property>
+//	      <property name="value">50</property>
+//	      <property name="page-increment">10</property>
+//	      <property name="step-increment">1</property>
+//	    </object>
+//	  </property>
+//	</object>
 //
-// See [class@Gtk.SpinButton] for details.
+// See [gtk.SpinButton] for details.
 //
-// ## CSS nodes
+// # CSS nodes
 //
-// `AdwSpinRow` has the same structure as [class@ActionRow], as well as the
+// `AdwSpinRow` has the same structure as ActionRow, as well as the
 // `.spin` style class on the main node.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwSpinRow` uses an internal `GtkSpinButton` with the
 // `GTK_ACCESSIBLE_ROLE_SPIN_BUTTON` role.
@@ -101,14 +89,14 @@ var xNewSpinRowWithRange func(float64, float64, float64) uintptr
 //
 // This is a convenience constructor that allows creation of a numeric
 // `AdwSpinRow` without manually creating an adjustment. The value is initially
-// set to the minimum value and a page increment of 10 * @step is the default.
-// The precision of the spin row is equivalent to the precisions of @step.
+// set to the minimum value and a page increment of 10 * step is the default.
+// The precision of the spin row is equivalent to the precisions of step.
 //
 // ::: note
 //
-//	The way in which the precision is derived works best if @step is a power
+//	The way in which the precision is derived works best if step is a power
 //	of ten. If the resulting precision is not suitable for your needs, use
-//	[method@SpinRow.set_digits] to correct it.
+//	set_digits to correct it.
 func NewSpinRowWithRange(MinVar float64, MaxVar float64, StepVar float64) *SpinRow {
 	var cls *SpinRow
 
@@ -253,7 +241,7 @@ func (x *SpinRow) SetNumeric(NumericVar bool) {
 
 var xSpinRowSetRange func(uintptr, float64, float64)
 
-// Sets the minimum and maximum allowable values for @self.
+// Sets the minimum and maximum allowable values for self.
 //
 // If the current value is outside this range, it will be adjusted
 // to fit within the range, otherwise it will remain unchanged.
@@ -425,85 +413,151 @@ func (x *SpinRow) GetPropertyWrap() bool {
 
 // Emitted to convert the user's input into a double value.
 //
-// The signal handler is expected to use [method@Gtk.Editable.get_text] to
+// The signal handler is expected to use [gtk.Editable.GetText] to
 // retrieve the text of the spinbutton and set new_value to the new value.
 //
-// The default conversion uses [func@GLib.strtod].
+// The default conversion uses [glib.Strtod].
 //
-// See [signal@Gtk.SpinButton::input].
+// See [gtk.SpinButton.ConnectInputFunc].
+//
+// Deprecated: use ConnectInputFunc, which also accepts method values and closures.
 func (x *SpinRow) ConnectInput(cb *func(SpinRow, *float64) int) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "input", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NewValueVarp *float64) int {
+			defer core.RecoverPanic()
+			fa := SpinRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, NewValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "input", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, NewValueVarp *float64) int {
+var xSpinRowInputTrampoline uintptr
+
+func xSpinRowInputNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NewValueVarp *float64, data uintptr) (ret int) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinRow, *float64) int)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, NewValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "input", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInputFunc connects cb to the "input" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinRow) ConnectInputFunc(cb func(SpinRow, *float64) int) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "input", &xSpinRowInputTrampoline, xSpinRowInputNewTrampoline, cb)
 }
 
 // Emitted to tweak the formatting of the value for display.
 //
-// See [signal@Gtk.SpinButton::output].
+// See [gtk.SpinButton.ConnectOutputFunc].
+//
+// Deprecated: use ConnectOutputFunc, which also accepts method values and closures.
 func (x *SpinRow) ConnectOutput(cb *func(SpinRow) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "output", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := SpinRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "output", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xSpinRowOutputTrampoline uintptr
+
+func xSpinRowOutputNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinRow) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "output", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectOutputFunc connects cb to the "output" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinRow) ConnectOutputFunc(cb func(SpinRow) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "output", &xSpinRowOutputTrampoline, xSpinRowOutputNewTrampoline, cb)
 }
 
 // Emitted right after the spinbutton wraps.
 //
-// See [signal@Gtk.SpinButton::wrapped].
+// See [gtk.SpinButton.ConnectWrappedFunc].
+//
+// Deprecated: use ConnectWrappedFunc, which also accepts method values and closures.
 func (x *SpinRow) ConnectWrapped(cb *func(SpinRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "wrapped", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SpinRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "wrapped", cbRefPtr, cbPtr)
+}
+
+var xSpinRowWrappedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSpinRowWrappedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "wrapped", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectWrappedFunc connects cb to the "wrapped" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinRow) ConnectWrappedFunc(cb func(SpinRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "wrapped", &xSpinRowWrappedTrampoline, xSpinRowWrappedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -667,7 +721,7 @@ func (x *SpinRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -675,14 +729,13 @@ func (x *SpinRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SpinRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -706,19 +759,17 @@ func (x *SpinRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SpinRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -739,7 +790,7 @@ func (x *SpinRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -748,14 +799,12 @@ func (x *SpinRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SpinRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -774,14 +823,14 @@ func (x *SpinRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *SpinRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *SpinRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -791,15 +840,15 @@ func (x *SpinRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *SpinRow) SetActionName(ActionNameVar *string) {
 
@@ -812,13 +861,13 @@ func (x *SpinRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *SpinRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -827,14 +876,14 @@ func (x *SpinRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -852,18 +901,18 @@ func (x *SpinRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *SpinRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SpinRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -876,35 +925,29 @@ func (x *SpinRow) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [gtk.AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *SpinRow) DelegateGetAccessiblePlatformState(StateVar gtk.AccessiblePlatformState) bool {
 
@@ -924,8 +967,8 @@ func (x *SpinRow) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -935,7 +978,7 @@ func (x *SpinRow) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [gtk.Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -955,8 +998,8 @@ func (x *SpinRow) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -966,10 +1009,10 @@ func (x *SpinRow) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [gtk.Text] widget.
 func (x *SpinRow) GetDelegate() *gtk.EditableBase {
 	var cls *gtk.EditableBase
 
@@ -984,21 +1027,21 @@ func (x *SpinRow) GetDelegate() *gtk.EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *SpinRow) GetEditable() bool {
 
 	cret := gtk.XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *SpinRow) GetEnableUndo() bool {
 
 	cret := gtk.XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *SpinRow) GetMaxWidthChars() int {
 
 	cret := gtk.XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -1017,9 +1060,9 @@ func (x *SpinRow) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SpinRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -1028,7 +1071,7 @@ func (x *SpinRow) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *SpinRow) GetText() string {
@@ -1048,7 +1091,7 @@ func (x *SpinRow) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -1058,11 +1101,11 @@ func (x *SpinRow) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *SpinRow) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -1073,9 +1116,9 @@ func (x *SpinRow) InsertText(TextVar string, LengthVar int, PositionVar int) {
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SpinRow) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -1101,19 +1144,19 @@ func (x *SpinRow) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [gtk.Text.GetPropertyVisibility] is set to false.
 func (x *SpinRow) SetEnableUndo(EnableUndoVar bool) {
 
 	gtk.XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *SpinRow) SetMaxWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -1126,7 +1169,7 @@ func (x *SpinRow) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *SpinRow) SetPosition(PositionVar int) {
 
 	gtk.XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -1143,11 +1186,11 @@ func (x *SpinRow) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *SpinRow) SetWidthChars(NCharsVar int) {
 
 	gtk.XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -1159,7 +1202,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1168,27 +1211,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpinRowGLibType, libs, "adw_spin_row_get_type")
 
-	core.PuregoSafeRegister(&xNewSpinRow, libs, "adw_spin_row_new")
-	core.PuregoSafeRegister(&xNewSpinRowWithRange, libs, "adw_spin_row_new_with_range")
-
-	core.PuregoSafeRegister(&xSpinRowConfigure, libs, "adw_spin_row_configure")
-	core.PuregoSafeRegister(&xSpinRowGetAdjustment, libs, "adw_spin_row_get_adjustment")
-	core.PuregoSafeRegister(&xSpinRowGetClimbRate, libs, "adw_spin_row_get_climb_rate")
-	core.PuregoSafeRegister(&xSpinRowGetDigits, libs, "adw_spin_row_get_digits")
-	core.PuregoSafeRegister(&xSpinRowGetNumeric, libs, "adw_spin_row_get_numeric")
-	core.PuregoSafeRegister(&xSpinRowGetSnapToTicks, libs, "adw_spin_row_get_snap_to_ticks")
-	core.PuregoSafeRegister(&xSpinRowGetUpdatePolicy, libs, "adw_spin_row_get_update_policy")
-	core.PuregoSafeRegister(&xSpinRowGetValue, libs, "adw_spin_row_get_value")
-	core.PuregoSafeRegister(&xSpinRowGetWrap, libs, "adw_spin_row_get_wrap")
-	core.PuregoSafeRegister(&xSpinRowSetAdjustment, libs, "adw_spin_row_set_adjustment")
-	core.PuregoSafeRegister(&xSpinRowSetClimbRate, libs, "adw_spin_row_set_climb_rate")
-	core.PuregoSafeRegister(&xSpinRowSetDigits, libs, "adw_spin_row_set_digits")
-	core.PuregoSafeRegister(&xSpinRowSetNumeric, libs, "adw_spin_row_set_numeric")
-	core.PuregoSafeRegister(&xSpinRowSetRange, libs, "adw_spin_row_set_range")
-	core.PuregoSafeRegister(&xSpinRowSetSnapToTicks, libs, "adw_spin_row_set_snap_to_ticks")
-	core.PuregoSafeRegister(&xSpinRowSetUpdatePolicy, libs, "adw_spin_row_set_update_policy")
-	core.PuregoSafeRegister(&xSpinRowSetValue, libs, "adw_spin_row_set_value")
-	core.PuregoSafeRegister(&xSpinRowSetWrap, libs, "adw_spin_row_set_wrap")
-	core.PuregoSafeRegister(&xSpinRowUpdate, libs, "adw_spin_row_update")
+	gobject.CheckClassSize("AdwSpinRowClass", xSpinRowGLibType, unsafe.Sizeof(SpinRowClass{}))
+
+	core.PuregoRegisterSince(&xNewSpinRow, libs, "adw_spin_row_new", "ADW", "1.4")
+	core.PuregoRegisterSince(&xNewSpinRowWithRange, libs, "adw_spin_row_new_with_range", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xSpinRowConfigure, libs, "adw_spin_row_configure", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetAdjustment, libs, "adw_spin_row_get_adjustment", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetClimbRate, libs, "adw_spin_row_get_climb_rate", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetDigits, libs, "adw_spin_row_get_digits", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetNumeric, libs, "adw_spin_row_get_numeric", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetSnapToTicks, libs, "adw_spin_row_get_snap_to_ticks", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetUpdatePolicy, libs, "adw_spin_row_get_update_policy", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetValue, libs, "adw_spin_row_get_value", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowGetWrap, libs, "adw_spin_row_get_wrap", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetAdjustment, libs, "adw_spin_row_set_adjustment", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetClimbRate, libs, "adw_spin_row_set_climb_rate", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetDigits, libs, "adw_spin_row_set_digits", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetNumeric, libs, "adw_spin_row_set_numeric", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetRange, libs, "adw_spin_row_set_range", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetSnapToTicks, libs, "adw_spin_row_set_snap_to_ticks", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetUpdatePolicy, libs, "adw_spin_row_set_update_policy", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetValue, libs, "adw_spin_row_set_value", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowSetWrap, libs, "adw_spin_row_set_wrap", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSpinRowUpdate, libs, "adw_spin_row_update", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,13 +24,6 @@ func (x *SpinnerPaintableClass) GoPointer() uintptr {
 
 // A paintable showing a loading spinner.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="spinner-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="spinner.png" alt="spinner"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwSpinnerPaintable` size varies depending on the available space, but is
 // capped at 64×64 pixels.
 //
@@ -40,22 +32,18 @@ func (x *SpinnerPaintableClass) GoPointer() uintptr {
 // mapped. Ideally it should be the same widget the paintable is displayed in,
 // but that's not a requirement.
 //
-// Most applications should be using [class@Spinner] instead.
+// Most applications should be using Spinner instead.
 // `AdwSpinnerPaintable` is provided for the cases where using a widget is
-// impractical or impossible, such as [property@StatusPage:paintable]:
-//
-// ```xml
-// &lt;object class="AdwStatusPage" id="status_page"&gt;
-//
-//	&lt;property name="paintable"&gt;
-//	  &lt;object class="AdwSpinnerPaintable"&gt;
-//	    &lt;property name="widget"&gt;status_page&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//	&lt;!-- ... --&gt;
-//
-// &lt;/object&gt;
-// ```
+// impractical or impossible, such as StatusPage:paintable:
+//
+//	<object class="AdwStatusPage" id="status_page">
+//	  <property name="paintable">
+//	    <object class="AdwSpinnerPaintable">
+//	      <property name="widget">status_page</property>
+//	    </object>
+//	  </property>
+//	  <!-- ... -->
+//	</object>
 type SpinnerPaintable struct {
 	gobject.Object
 }
@@ -74,7 +62,7 @@ func SpinnerPaintableNewFromInternalPtr(ptr uintptr) *SpinnerPaintable {
 
 var xNewSpinnerPaintable func(uintptr) uintptr
 
-// Creates a new `AdwSpinnerPaintable` for @widget.
+// Creates a new `AdwSpinnerPaintable` for widget.
 func NewSpinnerPaintable(WidgetVar *gtk.Widget) *SpinnerPaintable {
 	var cls *SpinnerPaintable
 
@@ -128,25 +116,27 @@ func (c *SpinnerPaintable) SetGoPointer(ptr uintptr) {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *SpinnerPaintable) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	gdk.XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *SpinnerPaintable) GetCurrentImage() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -164,29 +154,29 @@ func (x *SpinnerPaintable) GetCurrentImage() *gdk.PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [gdk.PaintableFlags] for the flags and what they mean.
 func (x *SpinnerPaintable) GetFlags() gdk.PaintableFlags {
 
 	cret := gdk.XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [gdk.Paintable.GetIntrinsicWidth] and
+// [gdk.Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *SpinnerPaintable) GetIntrinsicAspectRatio() float64 {
 
@@ -194,15 +184,15 @@ func (x *SpinnerPaintable) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *SpinnerPaintable) GetIntrinsicHeight() int {
 
@@ -210,15 +200,15 @@ func (x *SpinnerPaintable) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *SpinnerPaintable) GetIntrinsicWidth() int {
 
@@ -229,12 +219,12 @@ func (x *SpinnerPaintable) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [gdk.Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [gdk.PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *SpinnerPaintable) InvalidateContents() {
 
@@ -244,13 +234,13 @@ func (x *SpinnerPaintable) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [gdk.PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *SpinnerPaintable) InvalidateSize() {
 
@@ -258,10 +248,10 @@ func (x *SpinnerPaintable) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *SpinnerPaintable) Snapshot(SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -284,7 +274,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -293,9 +283,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpinnerPaintableGLibType, libs, "adw_spinner_paintable_get_type")
 
-	core.PuregoSafeRegister(&xNewSpinnerPaintable, libs, "adw_spinner_paintable_new")
+	gobject.CheckClassSize("AdwSpinnerPaintableClass", xSpinnerPaintableGLibType, unsafe.Sizeof(SpinnerPaintableClass{}))
+
+	core.PuregoRegisterSince(&xNewSpinnerPaintable, libs, "adw_spinner_paintable_new", "ADW", "1.6")
 
-	core.PuregoSafeRegister(&xSpinnerPaintableGetWidget, libs, "adw_spinner_paintable_get_widget")
-	core.PuregoSafeRegister(&xSpinnerPaintableSetWidget, libs, "adw_spinner_paintable_set_widget")
+	core.PuregoRegisterSince(&xSpinnerPaintableGetWidget, libs, "adw_spinner_paintable_get_widget", "ADW", "1.6")
+	core.PuregoRegisterSince(&xSpinnerPaintableSetWidget, libs, "adw_spinner_paintable_set_widget", "ADW", "1.6")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,44 +23,33 @@ func (x *SpinnerClass) GoPointer() uintptr {
 
 // A widget showing a loading spinner.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="spinner-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="spinner.png" alt="spinner"&gt;
-//
-// &lt;/picture&gt;
-//
 // The size of the spinner depends on the available size, never smaller than
 // 16×16 pixels and never larger than 64×64 pixels.
 //
-// Use the [property@Gtk.Widget:halign] and [property@Gtk.Widget:valign]
-// properties in combination with [property@Gtk.Widget:width-request] and
-// [property@Gtk.Widget:height-request] for fine sizing control.
+// Use the Widget:halign and Widget:valign
+// properties in combination with [gtk.Widget.GetPropertyWidthRequest] and
+// [gtk.Widget.GetPropertyHeightRequest] for fine sizing control.
 //
 // For example, the following snippet shows the spinner at 48×48 pixels:
 //
-// ```xml
-// &lt;object class="AdwSpinner"&gt;
-//
-//	&lt;property name="halign"&gt;center&lt;/property&gt;
-//	&lt;property name="valign"&gt;center&lt;/property&gt;
-//	&lt;property name="width-request"&gt;48&lt;/property&gt;
-//	&lt;property name="height-request"&gt;48&lt;/property&gt;
+//	<object class="AdwSpinner">
+//	  <property name="halign">center</property>
+//	  <property name="valign">center</property>
+//	  <property name="width-request">48</property>
+//	  <property name="height-request">48</property>
+//	</object>
 //
-// &lt;/object&gt;
-// ```
+// See SpinnerPaintable for cases where using a widget is impractical or
+// impossible, such as StatusPage:paintable.
 //
-// See [class@SpinnerPaintable] for cases where using a widget is impractical or
-// impossible, such as [property@StatusPage:paintable].
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwSpinner` has a single node with the name `image` and the style class
 // `.spinner`.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `AdwSpinner` uses the [enum@Gtk.AccessibleRole.progress-bar] role.
+// `AdwSpinner` uses the AccessibleRole.progress-bar role.
 type Spinner struct {
 	gtk.Widget
 }
@@ -267,7 +255,7 @@ func (x *Spinner) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -275,14 +263,13 @@ func (x *Spinner) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -306,19 +293,17 @@ func (x *Spinner) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -339,7 +324,7 @@ func (x *Spinner) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -348,14 +333,12 @@ func (x *Spinner) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -374,10 +357,10 @@ func (x *Spinner) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Spinner) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -389,7 +372,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -398,6 +381,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpinnerGLibType, libs, "adw_spinner_get_type")
 
-	core.PuregoSafeRegister(&xNewSpinner, libs, "adw_spinner_new")
+	gobject.CheckClassSize("AdwSpinnerClass", xSpinnerGLibType, unsafe.Sizeof(SpinnerClass{}))
+
+	core.PuregoRegisterSince(&xNewSpinner, libs, "adw_spinner_new", "ADW", "1.6")
 
 }
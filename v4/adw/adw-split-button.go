@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -26,53 +25,42 @@ func (x *SplitButtonClass) GoPointer() uintptr {
 
 // A combined button and dropdown widget.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="split-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="split-button.png" alt="split-button"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwSplitButton` is typically used to present a set of actions in a menu,
 // but allow access to one of them with a single click.
 //
-// The API is very similar to [class@Gtk.Button] and [class@Gtk.MenuButton], see
+// The API is very similar to [gtk.Button] and [gtk.MenuButton], see
 // their documentation for details.
 //
-// ## CSS nodes
-//
-// ```
-// splitbutton[.image-button][.text-button]
-// ├── button
-// │   ╰── &lt;content&gt;
-// ├── separator
-// ╰── menubutton
+// # CSS nodes
 //
-//	╰── button.toggle
-//	    ╰── arrow
-//
-// ```
+//	splitbutton[.image-button][.text-button]
+//	├── button
+//	│   ╰── <content>
+//	├── separator
+//	╰── menubutton
+//	    ╰── button.toggle
+//	        ╰── arrow
 //
 // `AdwSplitButton`'s CSS node is called `splitbutton`. It contains the css
-// nodes: `button`, `separator`, `menubutton`. See [class@Gtk.MenuButton]
+// nodes: `button`, `separator`, `menubutton`. See [gtk.MenuButton]
 // documentation for the `menubutton` contents.
 //
 // The main CSS node will contain the `.image-button` or `.text-button` style
 // classes matching the button contents. The nested button nodes will never
 // contain them.
 //
-// ## Style classes
+// # Style classes
 //
-// `AdwSplitButton` can use some of the same style classes as [class@Gtk.Button]:
+// `AdwSplitButton` can use some of the same style classes as [gtk.Button]:
 //
-// - [`.suggested-action`](style-classes.html#suggested-action)
-// - [`.destructive-action`](style-classes.html#destructive-action)
-// - [`.flat`](style-classes.html#flat)
-// - [`.raised`](style-classes.html#raised)
+// - .suggested-action
+// - .destructive-action
+// - .flat
+// - .raised
 //
 // Other style classes, like `.pill`, cannot be used.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwSplitButton` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type SplitButton struct {
@@ -145,7 +133,7 @@ func (x *SplitButton) GetDirection() gtk.ArrowType {
 
 var xSplitButtonGetDropdownTooltip func(uintptr) string
 
-// Gets the tooltip of the dropdown button of @self.
+// Gets the tooltip of the dropdown button of self.
 func (x *SplitButton) GetDropdownTooltip() string {
 
 	cret := xSplitButtonGetDropdownTooltip(x.GoPointer())
@@ -163,7 +151,7 @@ func (x *SplitButton) GetIconName() string {
 
 var xSplitButtonGetLabel func(uintptr) string
 
-// Gets the label for @self.
+// Gets the label for self.
 func (x *SplitButton) GetLabel() string {
 
 	cret := xSplitButtonGetLabel(x.GoPointer())
@@ -237,8 +225,8 @@ var xSplitButtonSetCanShrink func(uintptr, bool)
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [method@Gtk.Button.set_can_shrink] and
-// [method@Gtk.MenuButton.set_can_shrink].
+// See [gtk.Button.SetCanShrink] and
+// [gtk.MenuButton.SetCanShrink].
 func (x *SplitButton) SetCanShrink(CanShrinkVar bool) {
 
 	xSplitButtonSetCanShrink(x.GoPointer(), CanShrinkVar)
@@ -249,8 +237,8 @@ var xSplitButtonSetChild func(uintptr, uintptr)
 
 // Sets the child widget.
 //
-// Setting the child widget will set [property@SplitButton:label] and
-// [property@SplitButton:icon-name] to `NULL`.
+// Setting the child widget will set SplitButton:label and
+// SplitButton:icon-name to `NULL`.
 func (x *SplitButton) SetChild(ChildVar *gtk.Widget) {
 
 	xSplitButtonSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -275,7 +263,7 @@ func (x *SplitButton) SetDirection(DirectionVar gtk.ArrowType) {
 
 var xSplitButtonSetDropdownTooltip func(uintptr, string)
 
-// Sets the tooltip of the dropdown button of @self.
+// Sets the tooltip of the dropdown button of self.
 //
 // The tooltip can be marked up with the Pango text markup language.
 func (x *SplitButton) SetDropdownTooltip(TooltipVar string) {
@@ -288,8 +276,8 @@ var xSplitButtonSetIconName func(uintptr, string)
 
 // Sets the name of the icon used to automatically populate the button.
 //
-// Setting the icon name will set [property@SplitButton:label] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the icon name will set SplitButton:label and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) SetIconName(IconNameVar string) {
 
 	xSplitButtonSetIconName(x.GoPointer(), IconNameVar)
@@ -298,10 +286,10 @@ func (x *SplitButton) SetIconName(IconNameVar string) {
 
 var xSplitButtonSetLabel func(uintptr, string)
 
-// Sets the label for @self.
+// Sets the label for self.
 //
-// Setting the label will set [property@SplitButton:icon-name] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the label will set SplitButton:icon-name and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) SetLabel(LabelVar string) {
 
 	xSplitButtonSetLabel(x.GoPointer(), LabelVar)
@@ -314,11 +302,11 @@ var xSplitButtonSetMenuModel func(uintptr, uintptr)
 //
 // If the menu model is `NULL`, the dropdown is disabled.
 //
-// A [class@Gtk.Popover] will be created from the menu model with
-// [ctor@Gtk.PopoverMenu.new_from_model]. Actions will be connected as
+// A [gtk.Popover] will be created from the menu model with
+// [gtk.NewPopoverMenuFromModel]. Actions will be connected as
 // documented for this function.
 //
-// If [property@SplitButton:popover] is already set, it will be dissociated from
+// If SplitButton:popover is already set, it will be dissociated from
 // the button, and the property is set to `NULL`.
 func (x *SplitButton) SetMenuModel(MenuModelVar *gio.MenuModel) {
 
@@ -332,7 +320,7 @@ var xSplitButtonSetPopover func(uintptr, uintptr)
 //
 // If the popover is `NULL`, the dropdown is disabled.
 //
-// If [property@SplitButton:menu-model] is set, the menu model is dissociated
+// If SplitButton:menu-model is set, the menu model is dissociated
 // from the button, and the property is set to `NULL`.
 func (x *SplitButton) SetPopover(PopoverVar *gtk.Popover) {
 
@@ -344,7 +332,7 @@ var xSplitButtonSetUseUnderline func(uintptr, bool)
 
 // Sets whether an underline in the text indicates a mnemonic.
 //
-// See [property@SplitButton:label].
+// See SplitButton:label.
 func (x *SplitButton) SetUseUnderline(UseUnderlineVar bool) {
 
 	xSplitButtonSetUseUnderline(x.GoPointer(), UseUnderlineVar)
@@ -367,8 +355,8 @@ func (c *SplitButton) SetGoPointer(ptr uintptr) {
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink] and
-// [property@Gtk.MenuButton:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink] and
+// [gtk.MenuButton.GetPropertyCanShrink].
 func (x *SplitButton) SetPropertyCanShrink(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -381,8 +369,8 @@ func (x *SplitButton) SetPropertyCanShrink(value bool) {
 //
 // If set to `TRUE`, the label will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink] and
-// [property@Gtk.MenuButton:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink] and
+// [gtk.MenuButton.GetPropertyCanShrink].
 func (x *SplitButton) GetPropertyCanShrink() bool {
 	var v gobject.Value
 	x.GetProperty("can-shrink", &v)
@@ -413,8 +401,8 @@ func (x *SplitButton) GetPropertyDropdownTooltip() string {
 // SetPropertyIconName sets the "icon-name" property.
 // The name of the icon used to automatically populate the button.
 //
-// Setting the icon name will set [property@SplitButton:label] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the icon name will set SplitButton:label and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -425,8 +413,8 @@ func (x *SplitButton) SetPropertyIconName(value string) {
 // GetPropertyIconName gets the "icon-name" property.
 // The name of the icon used to automatically populate the button.
 //
-// Setting the icon name will set [property@SplitButton:label] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the icon name will set SplitButton:label and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -436,8 +424,8 @@ func (x *SplitButton) GetPropertyIconName() string {
 // SetPropertyLabel sets the "label" property.
 // The label for the button.
 //
-// Setting the label will set [property@SplitButton:icon-name] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the label will set SplitButton:icon-name and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) SetPropertyLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -448,8 +436,8 @@ func (x *SplitButton) SetPropertyLabel(value string) {
 // GetPropertyLabel gets the "label" property.
 // The label for the button.
 //
-// Setting the label will set [property@SplitButton:icon-name] and
-// [property@SplitButton:child] to `NULL`.
+// Setting the label will set SplitButton:icon-name and
+// SplitButton:child to `NULL`.
 func (x *SplitButton) GetPropertyLabel() string {
 	var v gobject.Value
 	x.GetProperty("label", &v)
@@ -459,7 +447,7 @@ func (x *SplitButton) GetPropertyLabel() string {
 // SetPropertyUseUnderline sets the "use-underline" property.
 // Whether an underline in the text indicates a mnemonic.
 //
-// See [property@SplitButton:label].
+// See SplitButton:label.
 func (x *SplitButton) SetPropertyUseUnderline(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -470,7 +458,7 @@ func (x *SplitButton) SetPropertyUseUnderline(value bool) {
 // GetPropertyUseUnderline gets the "use-underline" property.
 // Whether an underline in the text indicates a mnemonic.
 //
-// See [property@SplitButton:label].
+// See SplitButton:label.
 func (x *SplitButton) GetPropertyUseUnderline() bool {
 	var v gobject.Value
 	x.GetProperty("use-underline", &v)
@@ -480,52 +468,96 @@ func (x *SplitButton) GetPropertyUseUnderline() bool {
 // Emitted to animate press then release.
 //
 // This is an action signal. Applications should never connect to this signal,
-// but use the [signal@SplitButton::clicked] signal.
+// but use the SplitButton::clicked signal.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *SplitButton) ConnectActivate(cb *func(SplitButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SplitButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSplitButtonActivateTrampoline uintptr
+
+func xSplitButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SplitButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SplitButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SplitButton) ConnectActivateFunc(cb func(SplitButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xSplitButtonActivateTrampoline, xSplitButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the button has been activated (pressed and released).
+//
+// Deprecated: use ConnectClickedFunc, which also accepts method values and closures.
 func (x *SplitButton) ConnectClicked(cb *func(SplitButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SplitButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "clicked", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSplitButtonClickedTrampoline uintptr
+
+func xSplitButtonClickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SplitButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SplitButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClickedFunc connects cb to the "clicked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SplitButton) ConnectClickedFunc(cb func(SplitButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "clicked", &xSplitButtonClickedTrampoline, xSplitButtonClickedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -689,7 +721,7 @@ func (x *SplitButton) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -697,14 +729,13 @@ func (x *SplitButton) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SplitButton) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -728,19 +759,17 @@ func (x *SplitButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SplitButton) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -761,7 +790,7 @@ func (x *SplitButton) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -770,14 +799,12 @@ func (x *SplitButton) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SplitButton) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -796,14 +823,14 @@ func (x *SplitButton) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *SplitButton) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *SplitButton) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -813,15 +840,15 @@ func (x *SplitButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *SplitButton) SetActionName(ActionNameVar *string) {
 
@@ -834,13 +861,13 @@ func (x *SplitButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *SplitButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -849,14 +876,14 @@ func (x *SplitButton) SetActionTarget(FormatStringVar string, varArgs ...interfa
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -874,18 +901,18 @@ func (x *SplitButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *SplitButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SplitButton) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -897,7 +924,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -906,27 +933,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xSplitButtonGLibType, libs, "adw_split_button_get_type")
 
-	core.PuregoSafeRegister(&xNewSplitButton, libs, "adw_split_button_new")
-
-	core.PuregoSafeRegister(&xSplitButtonGetCanShrink, libs, "adw_split_button_get_can_shrink")
-	core.PuregoSafeRegister(&xSplitButtonGetChild, libs, "adw_split_button_get_child")
-	core.PuregoSafeRegister(&xSplitButtonGetDirection, libs, "adw_split_button_get_direction")
-	core.PuregoSafeRegister(&xSplitButtonGetDropdownTooltip, libs, "adw_split_button_get_dropdown_tooltip")
-	core.PuregoSafeRegister(&xSplitButtonGetIconName, libs, "adw_split_button_get_icon_name")
-	core.PuregoSafeRegister(&xSplitButtonGetLabel, libs, "adw_split_button_get_label")
-	core.PuregoSafeRegister(&xSplitButtonGetMenuModel, libs, "adw_split_button_get_menu_model")
-	core.PuregoSafeRegister(&xSplitButtonGetPopover, libs, "adw_split_button_get_popover")
-	core.PuregoSafeRegister(&xSplitButtonGetUseUnderline, libs, "adw_split_button_get_use_underline")
-	core.PuregoSafeRegister(&xSplitButtonPopdown, libs, "adw_split_button_popdown")
-	core.PuregoSafeRegister(&xSplitButtonPopup, libs, "adw_split_button_popup")
-	core.PuregoSafeRegister(&xSplitButtonSetCanShrink, libs, "adw_split_button_set_can_shrink")
-	core.PuregoSafeRegister(&xSplitButtonSetChild, libs, "adw_split_button_set_child")
-	core.PuregoSafeRegister(&xSplitButtonSetDirection, libs, "adw_split_button_set_direction")
-	core.PuregoSafeRegister(&xSplitButtonSetDropdownTooltip, libs, "adw_split_button_set_dropdown_tooltip")
-	core.PuregoSafeRegister(&xSplitButtonSetIconName, libs, "adw_split_button_set_icon_name")
-	core.PuregoSafeRegister(&xSplitButtonSetLabel, libs, "adw_split_button_set_label")
-	core.PuregoSafeRegister(&xSplitButtonSetMenuModel, libs, "adw_split_button_set_menu_model")
-	core.PuregoSafeRegister(&xSplitButtonSetPopover, libs, "adw_split_button_set_popover")
-	core.PuregoSafeRegister(&xSplitButtonSetUseUnderline, libs, "adw_split_button_set_use_underline")
+	gobject.CheckClassSize("AdwSplitButtonClass", xSplitButtonGLibType, unsafe.Sizeof(SplitButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewSplitButton, libs, "adw_split_button_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xSplitButtonGetCanShrink, libs, "adw_split_button_get_can_shrink", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSplitButtonGetChild, libs, "adw_split_button_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetDirection, libs, "adw_split_button_get_direction", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetDropdownTooltip, libs, "adw_split_button_get_dropdown_tooltip", "ADW", "1.2")
+	core.PuregoRegisterSince(&xSplitButtonGetIconName, libs, "adw_split_button_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetLabel, libs, "adw_split_button_get_label", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetMenuModel, libs, "adw_split_button_get_menu_model", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetPopover, libs, "adw_split_button_get_popover", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonGetUseUnderline, libs, "adw_split_button_get_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonPopdown, libs, "adw_split_button_popdown", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonPopup, libs, "adw_split_button_popup", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetCanShrink, libs, "adw_split_button_set_can_shrink", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSplitButtonSetChild, libs, "adw_split_button_set_child", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetDirection, libs, "adw_split_button_set_direction", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetDropdownTooltip, libs, "adw_split_button_set_dropdown_tooltip", "ADW", "1.2")
+	core.PuregoRegisterSince(&xSplitButtonSetIconName, libs, "adw_split_button_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetLabel, libs, "adw_split_button_set_label", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetMenuModel, libs, "adw_split_button_set_menu_model", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetPopover, libs, "adw_split_button_set_popover", "ADW", "")
+	core.PuregoRegisterSince(&xSplitButtonSetUseUnderline, libs, "adw_split_button_set_use_underline", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -20,32 +19,32 @@ func (x *SpringAnimationClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A spring-based [class@Animation].
+// A spring-based Animation.
 //
 // `AdwSpringAnimation` implements an animation driven by a physical model of a
-// spring described by [struct@SpringParams], with a resting position in
-// [property@SpringAnimation:value-to], stretched to
-// [property@SpringAnimation:value-from].
+// spring described by SpringParams, with a resting position in
+// SpringAnimation:value-to, stretched to
+// SpringAnimation:value-from.
 //
 // Since the animation is physically simulated, spring animations don't have a
 // fixed duration. The animation will stop when the simulated spring comes to a
 // rest - when the amplitude of the oscillations becomes smaller than
-// [property@SpringAnimation:epsilon], or immediately when it reaches
-// [property@SpringAnimation:value-to] if
-// [property@SpringAnimation:clamp] is set to `TRUE`. The estimated duration can
-// be obtained with [property@SpringAnimation:estimated-duration].
+// SpringAnimation:epsilon, or immediately when it reaches
+// SpringAnimation:value-to if
+// SpringAnimation:clamp is set to `TRUE`. The estimated duration can
+// be obtained with SpringAnimation:estimated-duration.
 //
 // Due to the nature of spring-driven motion the animation can overshoot
-// [property@SpringAnimation:value-to] before coming to a rest. Whether the
+// SpringAnimation:value-to before coming to a rest. Whether the
 // animation will overshoot or not depends on the damping ratio of the spring.
-// See [struct@SpringParams] for more information about specific damping ratio
+// See SpringParams for more information about specific damping ratio
 // values.
 //
-// If [property@SpringAnimation:clamp] is `TRUE`, the animation will abruptly
+// If SpringAnimation:clamp is `TRUE`, the animation will abruptly
 // end as soon as it reaches the final value, preventing overshooting.
 //
 // Animations can have an initial velocity value, set via
-// [property@SpringAnimation:initial-velocity], which adjusts the curve without
+// SpringAnimation:initial-velocity, which adjusts the curve without
 // changing the duration. This makes spring animations useful for deceleration
 // at the end of gestures.
 //
@@ -67,16 +66,16 @@ func SpringAnimationNewFromInternalPtr(ptr uintptr) *SpringAnimation {
 	return cls
 }
 
-var xNewSpringAnimation func(uintptr, float64, float64, *SpringParams, uintptr) uintptr
+var xNewSpringAnimation func(uintptr, float64, float64, uintptr, uintptr) uintptr
 
-// Creates a new `AdwSpringAnimation` on @widget.
+// Creates a new `AdwSpringAnimation` on widget.
 //
-// The animation will animate @target from @from to @to with the dynamics of a
-// spring described by @spring_params.
+// The animation will animate target from from to to with the dynamics of a
+// spring described by spring_params.
 func NewSpringAnimation(WidgetVar *gtk.Widget, FromVar float64, ToVar float64, SpringParamsVar *SpringParams, TargetVar *AnimationTarget) *SpringAnimation {
 	var cls *SpringAnimation
 
-	cret := xNewSpringAnimation(WidgetVar.GoPointer(), FromVar, ToVar, SpringParamsVar, TargetVar.GoPointer())
+	cret := xNewSpringAnimation(WidgetVar.GoPointer(), FromVar, ToVar, gobject.BoxedCopy(SpringParamsGLibType(), SpringParamsVar.GoPointer()), TargetVar.GoPointer())
 
 	if cret == 0 {
 		return nil
@@ -89,12 +88,12 @@ func NewSpringAnimation(WidgetVar *gtk.Widget, FromVar float64, ToVar float64, S
 
 var xSpringAnimationCalculateValue func(uintptr, uint) float64
 
-// Calculates the value @self will have at @time.
+// Calculates the value self will have at time.
 //
 // The time starts at 0 and ends at
-// [property@SpringAnimation:estimated_duration].
+// SpringAnimation:estimated_duration.
 //
-// See also [method@SpringAnimation.calculate_velocity].
+// See also calculate_velocity.
 func (x *SpringAnimation) CalculateValue(TimeVar uint) float64 {
 
 	cret := xSpringAnimationCalculateValue(x.GoPointer(), TimeVar)
@@ -103,12 +102,12 @@ func (x *SpringAnimation) CalculateValue(TimeVar uint) float64 {
 
 var xSpringAnimationCalculateVelocity func(uintptr, uint) float64
 
-// Calculates the velocity @self will have at @time.
+// Calculates the velocity self will have at time.
 //
 // The time starts at 0 and ends at
-// [property@SpringAnimation:estimated_duration].
+// SpringAnimation:estimated_duration.
 //
-// See also [method@SpringAnimation.calculate_value].
+// See also calculate_value.
 func (x *SpringAnimation) CalculateVelocity(TimeVar uint) float64 {
 
 	cret := xSpringAnimationCalculateVelocity(x.GoPointer(), TimeVar)
@@ -117,7 +116,7 @@ func (x *SpringAnimation) CalculateVelocity(TimeVar uint) float64 {
 
 var xSpringAnimationGetClamp func(uintptr) bool
 
-// Gets whether @self should be clamped.
+// Gets whether self should be clamped.
 func (x *SpringAnimation) GetClamp() bool {
 
 	cret := xSpringAnimationGetClamp(x.GoPointer())
@@ -135,9 +134,9 @@ func (x *SpringAnimation) GetEpsilon() float64 {
 
 var xSpringAnimationGetEstimatedDuration func(uintptr) uint
 
-// Gets the estimated duration of @self, in milliseconds.
+// Gets the estimated duration of self, in milliseconds.
 //
-// Can be [const@DURATION_INFINITE] if the spring damping is set to 0.
+// Can be DURATION_INFINITE if the spring damping is set to 0.
 func (x *SpringAnimation) GetEstimatedDuration() uint {
 
 	cret := xSpringAnimationGetEstimatedDuration(x.GoPointer())
@@ -146,7 +145,7 @@ func (x *SpringAnimation) GetEstimatedDuration() uint {
 
 var xSpringAnimationGetInitialVelocity func(uintptr) float64
 
-// Gets the initial velocity of @self.
+// Gets the initial velocity of self.
 func (x *SpringAnimation) GetInitialVelocity() float64 {
 
 	cret := xSpringAnimationGetInitialVelocity(x.GoPointer())
@@ -155,7 +154,7 @@ func (x *SpringAnimation) GetInitialVelocity() float64 {
 
 var xSpringAnimationGetSpringParams func(uintptr) *SpringParams
 
-// Gets the physical parameters of the spring of @self.
+// Gets the physical parameters of the spring of self.
 func (x *SpringAnimation) GetSpringParams() *SpringParams {
 
 	cret := xSpringAnimationGetSpringParams(x.GoPointer())
@@ -164,7 +163,7 @@ func (x *SpringAnimation) GetSpringParams() *SpringParams {
 
 var xSpringAnimationGetValueFrom func(uintptr) float64
 
-// Gets the value @self will animate from.
+// Gets the value self will animate from.
 func (x *SpringAnimation) GetValueFrom() float64 {
 
 	cret := xSpringAnimationGetValueFrom(x.GoPointer())
@@ -173,7 +172,7 @@ func (x *SpringAnimation) GetValueFrom() float64 {
 
 var xSpringAnimationGetValueTo func(uintptr) float64
 
-// Gets the value @self will animate to.
+// Gets the value self will animate to.
 func (x *SpringAnimation) GetValueTo() float64 {
 
 	cret := xSpringAnimationGetValueTo(x.GoPointer())
@@ -182,7 +181,7 @@ func (x *SpringAnimation) GetValueTo() float64 {
 
 var xSpringAnimationGetVelocity func(uintptr) float64
 
-// Gets the current velocity of @self.
+// Gets the current velocity of self.
 func (x *SpringAnimation) GetVelocity() float64 {
 
 	cret := xSpringAnimationGetVelocity(x.GoPointer())
@@ -191,13 +190,13 @@ func (x *SpringAnimation) GetVelocity() float64 {
 
 var xSpringAnimationSetClamp func(uintptr, bool)
 
-// Sets whether @self should be clamped.
+// Sets whether self should be clamped.
 //
 // If set to `TRUE`, the animation will abruptly end as soon as it reaches the
 // final value, preventing overshooting.
 //
-// It won't prevent overshooting [property@SpringAnimation:value-from] if a
-// relative negative [property@SpringAnimation:initial-velocity] is set.
+// It won't prevent overshooting SpringAnimation:value-from if a
+// relative negative SpringAnimation:initial-velocity is set.
 func (x *SpringAnimation) SetClamp(ClampVar bool) {
 
 	xSpringAnimationSetClamp(x.GoPointer(), ClampVar)
@@ -226,7 +225,7 @@ func (x *SpringAnimation) SetEpsilon(EpsilonVar float64) {
 
 var xSpringAnimationSetInitialVelocity func(uintptr, float64)
 
-// Sets the initial velocity of @self.
+// Sets the initial velocity of self.
 //
 // Initial velocity affects only the animation curve, but not its duration.
 func (x *SpringAnimation) SetInitialVelocity(VelocityVar float64) {
@@ -237,7 +236,7 @@ func (x *SpringAnimation) SetInitialVelocity(VelocityVar float64) {
 
 var xSpringAnimationSetSpringParams func(uintptr, *SpringParams)
 
-// Sets the physical parameters of the spring of @self.
+// Sets the physical parameters of the spring of self.
 func (x *SpringAnimation) SetSpringParams(SpringParamsVar *SpringParams) {
 
 	xSpringAnimationSetSpringParams(x.GoPointer(), SpringParamsVar)
@@ -246,10 +245,10 @@ func (x *SpringAnimation) SetSpringParams(SpringParamsVar *SpringParams) {
 
 var xSpringAnimationSetValueFrom func(uintptr, float64)
 
-// Sets the value @self will animate from.
+// Sets the value self will animate from.
 //
 // The animation will start at this value and end at
-// [property@SpringAnimation:value-to].
+// SpringAnimation:value-to.
 func (x *SpringAnimation) SetValueFrom(ValueVar float64) {
 
 	xSpringAnimationSetValueFrom(x.GoPointer(), ValueVar)
@@ -258,9 +257,9 @@ func (x *SpringAnimation) SetValueFrom(ValueVar float64) {
 
 var xSpringAnimationSetValueTo func(uintptr, float64)
 
-// Sets the value @self will animate to.
+// Sets the value self will animate to.
 //
-// The animation will start at [property@SpringAnimation:value-from] and end at
+// The animation will start at SpringAnimation:value-from and end at
 // this value.
 func (x *SpringAnimation) SetValueTo(ValueVar float64) {
 
@@ -285,8 +284,8 @@ func (c *SpringAnimation) SetGoPointer(ptr uintptr) {
 // If set to `TRUE`, the animation will abruptly end as soon as it reaches the
 // final value, preventing overshooting.
 //
-// It won't prevent overshooting [property@SpringAnimation:value-from] if a
-// relative negative [property@SpringAnimation:initial-velocity] is set.
+// It won't prevent overshooting SpringAnimation:value-from if a
+// relative negative SpringAnimation:initial-velocity is set.
 func (x *SpringAnimation) SetPropertyClamp(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -300,8 +299,8 @@ func (x *SpringAnimation) SetPropertyClamp(value bool) {
 // If set to `TRUE`, the animation will abruptly end as soon as it reaches the
 // final value, preventing overshooting.
 //
-// It won't prevent overshooting [property@SpringAnimation:value-from] if a
-// relative negative [property@SpringAnimation:initial-velocity] is set.
+// It won't prevent overshooting SpringAnimation:value-from if a
+// relative negative SpringAnimation:initial-velocity is set.
 func (x *SpringAnimation) GetPropertyClamp() bool {
 	var v gobject.Value
 	x.GetProperty("clamp", &v)
@@ -350,7 +349,7 @@ func (x *SpringAnimation) GetPropertyEpsilon() float64 {
 // GetPropertyEstimatedDuration gets the "estimated-duration" property.
 // Estimated duration of the animation, in milliseconds.
 //
-// Can be [const@DURATION_INFINITE] if the spring damping is set to 0.
+// Can be DURATION_INFINITE if the spring damping is set to 0.
 func (x *SpringAnimation) GetPropertyEstimatedDuration() uint {
 	var v gobject.Value
 	x.GetProperty("estimated-duration", &v)
@@ -399,7 +398,7 @@ func (x *SpringAnimation) GetPropertySpringParams() uintptr {
 // The value to animate from.
 //
 // The animation will start at this value and end at
-// [property@SpringAnimation:value-to].
+// SpringAnimation:value-to.
 func (x *SpringAnimation) SetPropertyValueFrom(value float64) {
 	var v gobject.Value
 	v.Init(gobject.TypeDoubleVal)
@@ -411,7 +410,7 @@ func (x *SpringAnimation) SetPropertyValueFrom(value float64) {
 // The value to animate from.
 //
 // The animation will start at this value and end at
-// [property@SpringAnimation:value-to].
+// SpringAnimation:value-to.
 func (x *SpringAnimation) GetPropertyValueFrom() float64 {
 	var v gobject.Value
 	x.GetProperty("value-from", &v)
@@ -421,7 +420,7 @@ func (x *SpringAnimation) GetPropertyValueFrom() float64 {
 // SetPropertyValueTo sets the "value-to" property.
 // The value to animate to.
 //
-// The animation will start at [property@SpringAnimation:value-from] and end
+// The animation will start at SpringAnimation:value-from and end
 // at this value.
 func (x *SpringAnimation) SetPropertyValueTo(value float64) {
 	var v gobject.Value
@@ -433,7 +432,7 @@ func (x *SpringAnimation) SetPropertyValueTo(value float64) {
 // GetPropertyValueTo gets the "value-to" property.
 // The value to animate to.
 //
-// The animation will start at [property@SpringAnimation:value-from] and end
+// The animation will start at SpringAnimation:value-from and end
 // at this value.
 func (x *SpringAnimation) GetPropertyValueTo() float64 {
 	var v gobject.Value
@@ -454,7 +453,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -463,23 +462,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpringAnimationGLibType, libs, "adw_spring_animation_get_type")
 
-	core.PuregoSafeRegister(&xNewSpringAnimation, libs, "adw_spring_animation_new")
-
-	core.PuregoSafeRegister(&xSpringAnimationCalculateValue, libs, "adw_spring_animation_calculate_value")
-	core.PuregoSafeRegister(&xSpringAnimationCalculateVelocity, libs, "adw_spring_animation_calculate_velocity")
-	core.PuregoSafeRegister(&xSpringAnimationGetClamp, libs, "adw_spring_animation_get_clamp")
-	core.PuregoSafeRegister(&xSpringAnimationGetEpsilon, libs, "adw_spring_animation_get_epsilon")
-	core.PuregoSafeRegister(&xSpringAnimationGetEstimatedDuration, libs, "adw_spring_animation_get_estimated_duration")
-	core.PuregoSafeRegister(&xSpringAnimationGetInitialVelocity, libs, "adw_spring_animation_get_initial_velocity")
-	core.PuregoSafeRegister(&xSpringAnimationGetSpringParams, libs, "adw_spring_animation_get_spring_params")
-	core.PuregoSafeRegister(&xSpringAnimationGetValueFrom, libs, "adw_spring_animation_get_value_from")
-	core.PuregoSafeRegister(&xSpringAnimationGetValueTo, libs, "adw_spring_animation_get_value_to")
-	core.PuregoSafeRegister(&xSpringAnimationGetVelocity, libs, "adw_spring_animation_get_velocity")
-	core.PuregoSafeRegister(&xSpringAnimationSetClamp, libs, "adw_spring_animation_set_clamp")
-	core.PuregoSafeRegister(&xSpringAnimationSetEpsilon, libs, "adw_spring_animation_set_epsilon")
-	core.PuregoSafeRegister(&xSpringAnimationSetInitialVelocity, libs, "adw_spring_animation_set_initial_velocity")
-	core.PuregoSafeRegister(&xSpringAnimationSetSpringParams, libs, "adw_spring_animation_set_spring_params")
-	core.PuregoSafeRegister(&xSpringAnimationSetValueFrom, libs, "adw_spring_animation_set_value_from")
-	core.PuregoSafeRegister(&xSpringAnimationSetValueTo, libs, "adw_spring_animation_set_value_to")
+	core.PuregoRegisterSince(&xNewSpringAnimation, libs, "adw_spring_animation_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xSpringAnimationCalculateValue, libs, "adw_spring_animation_calculate_value", "ADW", "1.3")
+	core.PuregoRegisterSince(&xSpringAnimationCalculateVelocity, libs, "adw_spring_animation_calculate_velocity", "ADW", "1.3")
+	core.PuregoRegisterSince(&xSpringAnimationGetClamp, libs, "adw_spring_animation_get_clamp", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetEpsilon, libs, "adw_spring_animation_get_epsilon", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetEstimatedDuration, libs, "adw_spring_animation_get_estimated_duration", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetInitialVelocity, libs, "adw_spring_animation_get_initial_velocity", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetSpringParams, libs, "adw_spring_animation_get_spring_params", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetValueFrom, libs, "adw_spring_animation_get_value_from", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetValueTo, libs, "adw_spring_animation_get_value_to", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationGetVelocity, libs, "adw_spring_animation_get_velocity", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetClamp, libs, "adw_spring_animation_set_clamp", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetEpsilon, libs, "adw_spring_animation_set_epsilon", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetInitialVelocity, libs, "adw_spring_animation_set_initial_velocity", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetSpringParams, libs, "adw_spring_animation_set_spring_params", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetValueFrom, libs, "adw_spring_animation_set_value_from", "ADW", "")
+	core.PuregoRegisterSince(&xSpringAnimationSetValueTo, libs, "adw_spring_animation_set_value_to", "ADW", "")
 
 }
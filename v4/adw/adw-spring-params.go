@@ -5,12 +5,11 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Physical parameters of a spring for [class@SpringAnimation].
+// Physical parameters of a spring for SpringAnimation.
 //
 // Any spring can be described by three parameters: mass, stiffness and damping.
 //
@@ -56,21 +55,21 @@ func (x *SpringParams) GoPointer() uintptr {
 
 var xNewSpringParams func(float64, float64, float64) *SpringParams
 
-// Creates a new `AdwSpringParams` from @mass, @stiffness and @damping_ratio.
+// Creates a new `AdwSpringParams` from mass, stiffness and damping_ratio.
 //
-// The damping value is calculated from @damping_ratio and the other two
+// The damping value is calculated from damping_ratio and the other two
 // parameters.
 //
-//   - If @damping_ratio is 0, the spring will not be damped and will oscillate
+//   - If damping_ratio is 0, the spring will not be damped and will oscillate
 //     endlessly.
-//   - If @damping_ratio is between 0 and 1, the spring is underdamped and will
+//   - If damping_ratio is between 0 and 1, the spring is underdamped and will
 //     always overshoot.
-//   - If @damping_ratio is 1, the spring is critically damped and will reach its
+//   - If damping_ratio is 1, the spring is critically damped and will reach its
 //     resting position the quickest way possible.
-//   - If @damping_ratio is larger than 1, the spring is overdamped and will reach
+//   - If damping_ratio is larger than 1, the spring is overdamped and will reach
 //     its resting position faster than it can complete an oscillation.
 //
-// [ctor@SpringParams.new_full] allows to pass a raw damping value instead.
+// new_full allows to pass a raw damping value instead.
 func NewSpringParams(DampingRatioVar float64, MassVar float64, StiffnessVar float64) *SpringParams {
 
 	cret := xNewSpringParams(DampingRatioVar, MassVar, StiffnessVar)
@@ -79,10 +78,10 @@ func NewSpringParams(DampingRatioVar float64, MassVar float64, StiffnessVar floa
 
 var xNewSpringParamsFull func(float64, float64, float64) *SpringParams
 
-// Creates a new `AdwSpringParams` from @mass, @stiffness and @damping.
+// Creates a new `AdwSpringParams` from mass, stiffness and damping.
 //
-// See [ctor@SpringParams.new] for a simplified constructor using damping ratio
-// instead of @damping.
+// See new for a simplified constructor using damping ratio
+// instead of damping.
 func NewSpringParamsFull(DampingVar float64, MassVar float64, StiffnessVar float64) *SpringParams {
 
 	cret := xNewSpringParamsFull(DampingVar, MassVar, StiffnessVar)
@@ -91,7 +90,7 @@ func NewSpringParamsFull(DampingVar float64, MassVar float64, StiffnessVar float
 
 var xSpringParamsGetDamping func(uintptr) float64
 
-// Gets the damping of @self.
+// Gets the damping of self.
 func (x *SpringParams) GetDamping() float64 {
 
 	cret := xSpringParamsGetDamping(x.GoPointer())
@@ -100,7 +99,7 @@ func (x *SpringParams) GetDamping() float64 {
 
 var xSpringParamsGetDampingRatio func(uintptr) float64
 
-// Gets the damping ratio of @self.
+// Gets the damping ratio of self.
 func (x *SpringParams) GetDampingRatio() float64 {
 
 	cret := xSpringParamsGetDampingRatio(x.GoPointer())
@@ -109,7 +108,7 @@ func (x *SpringParams) GetDampingRatio() float64 {
 
 var xSpringParamsGetMass func(uintptr) float64
 
-// Gets the mass of @self.
+// Gets the mass of self.
 func (x *SpringParams) GetMass() float64 {
 
 	cret := xSpringParamsGetMass(x.GoPointer())
@@ -118,7 +117,7 @@ func (x *SpringParams) GetMass() float64 {
 
 var xSpringParamsGetStiffness func(uintptr) float64
 
-// Gets the stiffness of @self.
+// Gets the stiffness of self.
 func (x *SpringParams) GetStiffness() float64 {
 
 	cret := xSpringParamsGetStiffness(x.GoPointer())
@@ -127,7 +126,7 @@ func (x *SpringParams) GetStiffness() float64 {
 
 var xSpringParamsRef func(uintptr) *SpringParams
 
-// Increases the reference count of @self.
+// Increases the reference count of self.
 func (x *SpringParams) Ref() *SpringParams {
 
 	cret := xSpringParamsRef(x.GoPointer())
@@ -136,7 +135,7 @@ func (x *SpringParams) Ref() *SpringParams {
 
 var xSpringParamsUnref func(uintptr)
 
-// Decreases the reference count of @self.
+// Decreases the reference count of self.
 //
 // If the last reference is dropped, the structure is freed.
 func (x *SpringParams) Unref() {
@@ -150,7 +149,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -159,14 +158,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpringParamsGLibType, libs, "adw_spring_params_get_type")
 
-	core.PuregoSafeRegister(&xNewSpringParams, libs, "adw_spring_params_new")
-	core.PuregoSafeRegister(&xNewSpringParamsFull, libs, "adw_spring_params_new_full")
+	core.PuregoRegisterSince(&xNewSpringParams, libs, "adw_spring_params_new", "ADW", "")
+	core.PuregoRegisterSince(&xNewSpringParamsFull, libs, "adw_spring_params_new_full", "ADW", "")
 
-	core.PuregoSafeRegister(&xSpringParamsGetDamping, libs, "adw_spring_params_get_damping")
-	core.PuregoSafeRegister(&xSpringParamsGetDampingRatio, libs, "adw_spring_params_get_damping_ratio")
-	core.PuregoSafeRegister(&xSpringParamsGetMass, libs, "adw_spring_params_get_mass")
-	core.PuregoSafeRegister(&xSpringParamsGetStiffness, libs, "adw_spring_params_get_stiffness")
-	core.PuregoSafeRegister(&xSpringParamsRef, libs, "adw_spring_params_ref")
-	core.PuregoSafeRegister(&xSpringParamsUnref, libs, "adw_spring_params_unref")
+	core.PuregoRegisterSince(&xSpringParamsGetDamping, libs, "adw_spring_params_get_damping", "ADW", "")
+	core.PuregoRegisterSince(&xSpringParamsGetDampingRatio, libs, "adw_spring_params_get_damping_ratio", "ADW", "")
+	core.PuregoRegisterSince(&xSpringParamsGetMass, libs, "adw_spring_params_get_mass", "ADW", "")
+	core.PuregoRegisterSince(&xSpringParamsGetStiffness, libs, "adw_spring_params_get_stiffness", "ADW", "")
+	core.PuregoRegisterSince(&xSpringParamsRef, libs, "adw_spring_params_ref", "ADW", "")
+	core.PuregoRegisterSince(&xSpringParamsUnref, libs, "adw_spring_params_unref", "ADW", "")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -32,7 +32,9 @@ func (x *SqueezerPageClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the possible transitions in a [class@Squeezer] widget.
+// Describes the possible transitions in a Squeezer widget.
+//
+// Deprecated: since 1.4. See the migration guide
 type SqueezerTransitionType int
 
 var xSqueezerTransitionTypeGLibType func() types.GType
@@ -49,32 +51,54 @@ const (
 	SqueezerTransitionTypeCrossfadeValue SqueezerTransitionType = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SqueezerTransitionType) String() string {
+	switch x {
+	case SqueezerTransitionTypeNoneValue:
+		return "ADW_SQUEEZER_TRANSITION_TYPE_NONE"
+	case SqueezerTransitionTypeCrossfadeValue:
+		return "ADW_SQUEEZER_TRANSITION_TYPE_CROSSFADE"
+	}
+	return fmt.Sprintf("SqueezerTransitionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SqueezerTransitionType) Nick() string {
+	switch x {
+	case SqueezerTransitionTypeNoneValue:
+		return "none"
+	case SqueezerTransitionTypeCrossfadeValue:
+		return "crossfade"
+	}
+	return ""
+}
+
+// SqueezerTransitionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SqueezerTransitionTypeFromString(s string) (SqueezerTransitionType, bool) {
+	switch s {
+	case "ADW_SQUEEZER_TRANSITION_TYPE_NONE", "none":
+		return SqueezerTransitionTypeNoneValue, true
+	case "ADW_SQUEEZER_TRANSITION_TYPE_CROSSFADE", "crossfade":
+		return SqueezerTransitionTypeCrossfadeValue, true
+	}
+	return 0, false
+}
+
 // A best fit container.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="squeezer-wide-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="squeezer-wide.png" alt="squeezer-wide"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture&gt;
-//
-//	&lt;source srcset="squeezer-narrow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="squeezer-narrow.png" alt="squeezer-narrow"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwSqueezer` widget is a container which only shows the first of its
 // children that fits in the available size. It is convenient to offer different
 // widgets to represent the same data with different levels of detail, making
 // the widget seem to squeeze itself to fit in the available space.
 //
 // Transitions between children can be animated as fades. This can be controlled
-// with [property@Squeezer:transition-type].
+// with Squeezer:transition-type.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwSqueezer` has a single CSS node with name `squeezer`.
+//
+// Deprecated: since 1.4. See the migration guide
 type Squeezer struct {
 	gtk.Widget
 }
@@ -91,293 +115,6 @@ func SqueezerNewFromInternalPtr(ptr uintptr) *Squeezer {
 	return cls
 }
 
-var xNewSqueezer func() uintptr
-
-// Creates a new `AdwSqueezer`.
-func NewSqueezer() *Squeezer {
-	var cls *Squeezer
-
-	cret := xNewSqueezer()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Squeezer{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerAdd func(uintptr, uintptr) uintptr
-
-// Adds a child to @self.
-func (x *Squeezer) Add(ChildVar *gtk.Widget) *SqueezerPage {
-	var cls *SqueezerPage
-
-	cret := xSqueezerAdd(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &SqueezerPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerGetAllowNone func(uintptr) bool
-
-// Gets whether to allow squeezing beyond the last child's minimum size.
-func (x *Squeezer) GetAllowNone() bool {
-
-	cret := xSqueezerGetAllowNone(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetHomogeneous func(uintptr) bool
-
-// Gets whether all children have the same size for the opposite orientation.
-func (x *Squeezer) GetHomogeneous() bool {
-
-	cret := xSqueezerGetHomogeneous(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetInterpolateSize func(uintptr) bool
-
-// Gets whether @self interpolates its size when changing the visible child.
-func (x *Squeezer) GetInterpolateSize() bool {
-
-	cret := xSqueezerGetInterpolateSize(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetPage func(uintptr, uintptr) uintptr
-
-// Returns the [class@SqueezerPage] object for @child.
-func (x *Squeezer) GetPage(ChildVar *gtk.Widget) *SqueezerPage {
-	var cls *SqueezerPage
-
-	cret := xSqueezerGetPage(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &SqueezerPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerGetPages func(uintptr) uintptr
-
-// Returns a [iface@Gio.ListModel] that contains the pages of @self.
-//
-// This can be used to keep an up-to-date view. The model also implements
-// [iface@Gtk.SelectionModel] and can be used to track the visible page.
-func (x *Squeezer) GetPages() *gtk.SelectionModelBase {
-	var cls *gtk.SelectionModelBase
-
-	cret := xSqueezerGetPages(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &gtk.SelectionModelBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerGetSwitchThresholdPolicy func(uintptr) FoldThresholdPolicy
-
-// Gets the switch threshold policy for @self.
-func (x *Squeezer) GetSwitchThresholdPolicy() FoldThresholdPolicy {
-
-	cret := xSqueezerGetSwitchThresholdPolicy(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetTransitionDuration func(uintptr) uint
-
-// Gets the transition animation duration for @self.
-func (x *Squeezer) GetTransitionDuration() uint {
-
-	cret := xSqueezerGetTransitionDuration(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetTransitionRunning func(uintptr) bool
-
-// Gets whether a transition is currently running for @self.
-//
-// If a transition is impossible, the property value will be set to `TRUE` and
-// then immediately to `FALSE`, so it's possible to rely on its notifications
-// to know that a transition has happened.
-func (x *Squeezer) GetTransitionRunning() bool {
-
-	cret := xSqueezerGetTransitionRunning(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetTransitionType func(uintptr) SqueezerTransitionType
-
-// Gets the type of animation used for transitions between children in @self.
-func (x *Squeezer) GetTransitionType() SqueezerTransitionType {
-
-	cret := xSqueezerGetTransitionType(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetVisibleChild func(uintptr) uintptr
-
-// Gets the currently visible child of @self.
-func (x *Squeezer) GetVisibleChild() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xSqueezerGetVisibleChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerGetXalign func(uintptr) float32
-
-// Gets the horizontal alignment, from 0 (start) to 1 (end).
-func (x *Squeezer) GetXalign() float32 {
-
-	cret := xSqueezerGetXalign(x.GoPointer())
-	return cret
-}
-
-var xSqueezerGetYalign func(uintptr) float32
-
-// Gets the vertical alignment, from 0 (top) to 1 (bottom).
-func (x *Squeezer) GetYalign() float32 {
-
-	cret := xSqueezerGetYalign(x.GoPointer())
-	return cret
-}
-
-var xSqueezerRemove func(uintptr, uintptr)
-
-// Removes a child widget from @self.
-func (x *Squeezer) Remove(ChildVar *gtk.Widget) {
-
-	xSqueezerRemove(x.GoPointer(), ChildVar.GoPointer())
-
-}
-
-var xSqueezerSetAllowNone func(uintptr, bool)
-
-// Sets whether to allow squeezing beyond the last child's minimum size.
-//
-// If set to `TRUE`, the squeezer can shrink to the point where no child can be
-// shown. This is functionally equivalent to appending a widget with 0×0 minimum
-// size.
-func (x *Squeezer) SetAllowNone(AllowNoneVar bool) {
-
-	xSqueezerSetAllowNone(x.GoPointer(), AllowNoneVar)
-
-}
-
-var xSqueezerSetHomogeneous func(uintptr, bool)
-
-// Sets whether all children have the same size for the opposite orientation.
-//
-// For example, if a squeezer is horizontal and is homogeneous, it will request
-// the same height for all its children. If it isn't, the squeezer may change
-// size when a different child becomes visible.
-func (x *Squeezer) SetHomogeneous(HomogeneousVar bool) {
-
-	xSqueezerSetHomogeneous(x.GoPointer(), HomogeneousVar)
-
-}
-
-var xSqueezerSetInterpolateSize func(uintptr, bool)
-
-// Sets whether @self interpolates its size when changing the visible child.
-//
-// If `TRUE`, the squeezer will interpolate its size between the one of the
-// previous visible child and the one of the new visible child, according to the
-// set transition duration and the orientation, e.g. if the squeezer is
-// horizontal, it will interpolate the its height.
-func (x *Squeezer) SetInterpolateSize(InterpolateSizeVar bool) {
-
-	xSqueezerSetInterpolateSize(x.GoPointer(), InterpolateSizeVar)
-
-}
-
-var xSqueezerSetSwitchThresholdPolicy func(uintptr, FoldThresholdPolicy)
-
-// Sets the switch threshold policy for @self.
-//
-// Determines when the squeezer will switch children.
-//
-// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, it will only switch when the
-// visible child cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`,
-// it will switch as soon as the visible child doesn't get their natural size.
-//
-// This can be useful if you have a long ellipsizing label and want to let it
-// ellipsize instead of immediately switching.
-func (x *Squeezer) SetSwitchThresholdPolicy(PolicyVar FoldThresholdPolicy) {
-
-	xSqueezerSetSwitchThresholdPolicy(x.GoPointer(), PolicyVar)
-
-}
-
-var xSqueezerSetTransitionDuration func(uintptr, uint)
-
-// Sets the transition animation duration for @self.
-func (x *Squeezer) SetTransitionDuration(DurationVar uint) {
-
-	xSqueezerSetTransitionDuration(x.GoPointer(), DurationVar)
-
-}
-
-var xSqueezerSetTransitionType func(uintptr, SqueezerTransitionType)
-
-// Sets the type of animation used for transitions between children in @self.
-func (x *Squeezer) SetTransitionType(TransitionVar SqueezerTransitionType) {
-
-	xSqueezerSetTransitionType(x.GoPointer(), TransitionVar)
-
-}
-
-var xSqueezerSetXalign func(uintptr, float32)
-
-// Sets the horizontal alignment, from 0 (start) to 1 (end).
-//
-// This affects the children allocation during transitions, when they exceed the
-// size of the squeezer.
-//
-// For example, 0.5 means the child will be centered, 0 means it will keep the
-// start side aligned and overflow the end side, and 1 means the opposite.
-func (x *Squeezer) SetXalign(XalignVar float32) {
-
-	xSqueezerSetXalign(x.GoPointer(), XalignVar)
-
-}
-
-var xSqueezerSetYalign func(uintptr, float32)
-
-// Sets the vertical alignment, from 0 (top) to 1 (bottom).
-//
-// This affects the children allocation during transitions, when they exceed the
-// size of the squeezer.
-//
-// For example, 0.5 means the child will be centered, 0 means it will keep the
-// top side aligned and overflow the bottom side, and 1 means the opposite.
-func (x *Squeezer) SetYalign(YalignVar float32) {
-
-	xSqueezerSetYalign(x.GoPointer(), YalignVar)
-
-}
-
 func (c *Squeezer) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -395,6 +132,8 @@ func (c *Squeezer) SetGoPointer(ptr uintptr) {
 // If set to `TRUE`, the squeezer can shrink to the point where no child can
 // be shown. This is functionally equivalent to appending a widget with 0×0
 // minimum size.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyAllowNone(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -408,6 +147,8 @@ func (x *Squeezer) SetPropertyAllowNone(value bool) {
 // If set to `TRUE`, the squeezer can shrink to the point where no child can
 // be shown. This is functionally equivalent to appending a widget with 0×0
 // minimum size.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyAllowNone() bool {
 	var v gobject.Value
 	x.GetProperty("allow-none", &v)
@@ -420,6 +161,8 @@ func (x *Squeezer) GetPropertyAllowNone() bool {
 // For example, if a squeezer is horizontal and is homogeneous, it will
 // request the same height for all its children. If it isn't, the squeezer may
 // change size when a different child becomes visible.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyHomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -433,6 +176,8 @@ func (x *Squeezer) SetPropertyHomogeneous(value bool) {
 // For example, if a squeezer is horizontal and is homogeneous, it will
 // request the same height for all its children. If it isn't, the squeezer may
 // change size when a different child becomes visible.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyHomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("homogeneous", &v)
@@ -446,6 +191,8 @@ func (x *Squeezer) GetPropertyHomogeneous() bool {
 // previous visible child and the one of the new visible child, according to
 // the set transition duration and the orientation, e.g. if the squeezer is
 // horizontal, it will interpolate the its height.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyInterpolateSize(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -460,6 +207,8 @@ func (x *Squeezer) SetPropertyInterpolateSize(value bool) {
 // previous visible child and the one of the new visible child, according to
 // the set transition duration and the orientation, e.g. if the squeezer is
 // horizontal, it will interpolate the its height.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyInterpolateSize() bool {
 	var v gobject.Value
 	x.GetProperty("interpolate-size", &v)
@@ -468,6 +217,8 @@ func (x *Squeezer) GetPropertyInterpolateSize() bool {
 
 // SetPropertyTransitionDuration sets the "transition-duration" property.
 // The transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyTransitionDuration(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -477,6 +228,8 @@ func (x *Squeezer) SetPropertyTransitionDuration(value uint) {
 
 // GetPropertyTransitionDuration gets the "transition-duration" property.
 // The transition animation duration, in milliseconds.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyTransitionDuration() uint {
 	var v gobject.Value
 	x.GetProperty("transition-duration", &v)
@@ -489,6 +242,8 @@ func (x *Squeezer) GetPropertyTransitionDuration() uint {
 // If a transition is impossible, the property value will be set to `TRUE` and
 // then immediately to `FALSE`, so it's possible to rely on its notifications
 // to know that a transition has happened.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyTransitionRunning() bool {
 	var v gobject.Value
 	x.GetProperty("transition-running", &v)
@@ -503,6 +258,8 @@ func (x *Squeezer) GetPropertyTransitionRunning() bool {
 //
 // For example, 0.5 means the child will be centered, 0 means it will keep the
 // start side aligned and overflow the end side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyXalign(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -518,6 +275,8 @@ func (x *Squeezer) SetPropertyXalign(value float32) {
 //
 // For example, 0.5 means the child will be centered, 0 means it will keep the
 // start side aligned and overflow the end side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyXalign() float32 {
 	var v gobject.Value
 	x.GetProperty("xalign", &v)
@@ -532,6 +291,8 @@ func (x *Squeezer) GetPropertyXalign() float32 {
 //
 // For example, 0.5 means the child will be centered, 0 means it will keep the
 // top side aligned and overflow the bottom side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) SetPropertyYalign(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -547,6 +308,8 @@ func (x *Squeezer) SetPropertyYalign(value float32) {
 //
 // For example, 0.5 means the child will be centered, 0 means it will keep the
 // top side aligned and overflow the bottom side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *Squeezer) GetPropertyYalign() float32 {
 	var v gobject.Value
 	x.GetProperty("yalign", &v)
@@ -714,7 +477,7 @@ func (x *Squeezer) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -722,14 +485,13 @@ func (x *Squeezer) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Squeezer) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -753,19 +515,17 @@ func (x *Squeezer) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Squeezer) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -786,7 +546,7 @@ func (x *Squeezer) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -795,14 +555,12 @@ func (x *Squeezer) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Squeezer) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -821,31 +579,33 @@ func (x *Squeezer) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Squeezer) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Squeezer) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Squeezer) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
 
-// An auxiliary class used by [class@Squeezer].
+// An auxiliary class used by Squeezer.
+//
+// Deprecated: since 1.4. See the migration guide
 type SqueezerPage struct {
 	gobject.Object
 }
@@ -862,50 +622,6 @@ func SqueezerPageNewFromInternalPtr(ptr uintptr) *SqueezerPage {
 	return cls
 }
 
-var xSqueezerPageGetChild func(uintptr) uintptr
-
-// Returns the squeezer child to which @self belongs.
-func (x *SqueezerPage) GetChild() *gtk.Widget {
-	var cls *gtk.Widget
-
-	cret := xSqueezerPageGetChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gtk.Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSqueezerPageGetEnabled func(uintptr) bool
-
-// Gets whether @self is enabled.
-func (x *SqueezerPage) GetEnabled() bool {
-
-	cret := xSqueezerPageGetEnabled(x.GoPointer())
-	return cret
-}
-
-var xSqueezerPageSetEnabled func(uintptr, bool)
-
-// Sets whether @self is enabled.
-//
-// If a child is disabled, it will be ignored when looking for the child
-// fitting the available size best.
-//
-// This allows to programmatically and prematurely hide a child even if it fits
-// in the available space.
-//
-// This can be used e.g. to ensure a certain child is hidden below a certain
-// window width, or any other constraint you find suitable.
-func (x *SqueezerPage) SetEnabled(EnabledVar bool) {
-
-	xSqueezerPageSetEnabled(x.GoPointer(), EnabledVar)
-
-}
-
 func (c *SqueezerPage) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -928,6 +644,8 @@ func (c *SqueezerPage) SetGoPointer(ptr uintptr) {
 //
 // This can be used e.g. to ensure a certain child is hidden below a certain
 // window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *SqueezerPage) SetPropertyEnabled(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -946,6 +664,8 @@ func (x *SqueezerPage) SetPropertyEnabled(value bool) {
 //
 // This can be used e.g. to ensure a certain child is hidden below a certain
 // window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *SqueezerPage) GetPropertyEnabled() bool {
 	var v gobject.Value
 	x.GetProperty("enabled", &v)
@@ -957,7 +677,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -968,35 +688,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xSqueezerGLibType, libs, "adw_squeezer_get_type")
 
-	core.PuregoSafeRegister(&xNewSqueezer, libs, "adw_squeezer_new")
-
-	core.PuregoSafeRegister(&xSqueezerAdd, libs, "adw_squeezer_add")
-	core.PuregoSafeRegister(&xSqueezerGetAllowNone, libs, "adw_squeezer_get_allow_none")
-	core.PuregoSafeRegister(&xSqueezerGetHomogeneous, libs, "adw_squeezer_get_homogeneous")
-	core.PuregoSafeRegister(&xSqueezerGetInterpolateSize, libs, "adw_squeezer_get_interpolate_size")
-	core.PuregoSafeRegister(&xSqueezerGetPage, libs, "adw_squeezer_get_page")
-	core.PuregoSafeRegister(&xSqueezerGetPages, libs, "adw_squeezer_get_pages")
-	core.PuregoSafeRegister(&xSqueezerGetSwitchThresholdPolicy, libs, "adw_squeezer_get_switch_threshold_policy")
-	core.PuregoSafeRegister(&xSqueezerGetTransitionDuration, libs, "adw_squeezer_get_transition_duration")
-	core.PuregoSafeRegister(&xSqueezerGetTransitionRunning, libs, "adw_squeezer_get_transition_running")
-	core.PuregoSafeRegister(&xSqueezerGetTransitionType, libs, "adw_squeezer_get_transition_type")
-	core.PuregoSafeRegister(&xSqueezerGetVisibleChild, libs, "adw_squeezer_get_visible_child")
-	core.PuregoSafeRegister(&xSqueezerGetXalign, libs, "adw_squeezer_get_xalign")
-	core.PuregoSafeRegister(&xSqueezerGetYalign, libs, "adw_squeezer_get_yalign")
-	core.PuregoSafeRegister(&xSqueezerRemove, libs, "adw_squeezer_remove")
-	core.PuregoSafeRegister(&xSqueezerSetAllowNone, libs, "adw_squeezer_set_allow_none")
-	core.PuregoSafeRegister(&xSqueezerSetHomogeneous, libs, "adw_squeezer_set_homogeneous")
-	core.PuregoSafeRegister(&xSqueezerSetInterpolateSize, libs, "adw_squeezer_set_interpolate_size")
-	core.PuregoSafeRegister(&xSqueezerSetSwitchThresholdPolicy, libs, "adw_squeezer_set_switch_threshold_policy")
-	core.PuregoSafeRegister(&xSqueezerSetTransitionDuration, libs, "adw_squeezer_set_transition_duration")
-	core.PuregoSafeRegister(&xSqueezerSetTransitionType, libs, "adw_squeezer_set_transition_type")
-	core.PuregoSafeRegister(&xSqueezerSetXalign, libs, "adw_squeezer_set_xalign")
-	core.PuregoSafeRegister(&xSqueezerSetYalign, libs, "adw_squeezer_set_yalign")
+	gobject.CheckClassSize("AdwSqueezerClass", xSqueezerGLibType, unsafe.Sizeof(SqueezerClass{}))
 
 	core.PuregoSafeRegister(&xSqueezerPageGLibType, libs, "adw_squeezer_page_get_type")
 
-	core.PuregoSafeRegister(&xSqueezerPageGetChild, libs, "adw_squeezer_page_get_child")
-	core.PuregoSafeRegister(&xSqueezerPageGetEnabled, libs, "adw_squeezer_page_get_enabled")
-	core.PuregoSafeRegister(&xSqueezerPageSetEnabled, libs, "adw_squeezer_page_set_enabled")
+	gobject.CheckClassSize("AdwSqueezerPageClass", xSqueezerPageGLibType, unsafe.Sizeof(SqueezerPageClass{}))
 
 }
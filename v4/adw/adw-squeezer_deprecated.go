@@ -0,0 +1,436 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+var xNewSqueezer func() uintptr
+
+// Creates a new `AdwSqueezer`.
+//
+// Deprecated: since 1.4. See the migration guide
+func NewSqueezer() *Squeezer {
+	var cls *Squeezer
+
+	cret := xNewSqueezer()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Squeezer{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerAdd func(uintptr, uintptr) uintptr
+
+// Adds a child to self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) Add(ChildVar *gtk.Widget) *SqueezerPage {
+	var cls *SqueezerPage
+
+	cret := xSqueezerAdd(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &SqueezerPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerGetAllowNone func(uintptr) bool
+
+// Gets whether to allow squeezing beyond the last child's minimum size.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetAllowNone() bool {
+
+	cret := xSqueezerGetAllowNone(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetHomogeneous func(uintptr) bool
+
+// Gets whether all children have the same size for the opposite orientation.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetHomogeneous() bool {
+
+	cret := xSqueezerGetHomogeneous(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetInterpolateSize func(uintptr) bool
+
+// Gets whether self interpolates its size when changing the visible child.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetInterpolateSize() bool {
+
+	cret := xSqueezerGetInterpolateSize(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetPage func(uintptr, uintptr) uintptr
+
+// Returns the SqueezerPage object for child.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetPage(ChildVar *gtk.Widget) *SqueezerPage {
+	var cls *SqueezerPage
+
+	cret := xSqueezerGetPage(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &SqueezerPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerGetPages func(uintptr) uintptr
+
+// Returns a [gio.ListModel] that contains the pages of self.
+//
+// This can be used to keep an up-to-date view. The model also implements
+// [gtk.SelectionModel] and can be used to track the visible page.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetPages() *gtk.SelectionModelBase {
+	var cls *gtk.SelectionModelBase
+
+	cret := xSqueezerGetPages(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.SelectionModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerGetSwitchThresholdPolicy func(uintptr) FoldThresholdPolicy
+
+// Gets the switch threshold policy for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetSwitchThresholdPolicy() FoldThresholdPolicy {
+
+	cret := xSqueezerGetSwitchThresholdPolicy(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetTransitionDuration func(uintptr) uint
+
+// Gets the transition animation duration for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetTransitionDuration() uint {
+
+	cret := xSqueezerGetTransitionDuration(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetTransitionRunning func(uintptr) bool
+
+// Gets whether a transition is currently running for self.
+//
+// If a transition is impossible, the property value will be set to `TRUE` and
+// then immediately to `FALSE`, so it's possible to rely on its notifications
+// to know that a transition has happened.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetTransitionRunning() bool {
+
+	cret := xSqueezerGetTransitionRunning(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetTransitionType func(uintptr) SqueezerTransitionType
+
+// Gets the type of animation used for transitions between children in self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetTransitionType() SqueezerTransitionType {
+
+	cret := xSqueezerGetTransitionType(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetVisibleChild func(uintptr) uintptr
+
+// Gets the currently visible child of self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetVisibleChild() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xSqueezerGetVisibleChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerGetXalign func(uintptr) float32
+
+// Gets the horizontal alignment, from 0 (start) to 1 (end).
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetXalign() float32 {
+
+	cret := xSqueezerGetXalign(x.GoPointer())
+	return cret
+}
+
+var xSqueezerGetYalign func(uintptr) float32
+
+// Gets the vertical alignment, from 0 (top) to 1 (bottom).
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) GetYalign() float32 {
+
+	cret := xSqueezerGetYalign(x.GoPointer())
+	return cret
+}
+
+var xSqueezerRemove func(uintptr, uintptr)
+
+// Removes a child widget from self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) Remove(ChildVar *gtk.Widget) {
+
+	xSqueezerRemove(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xSqueezerSetAllowNone func(uintptr, bool)
+
+// Sets whether to allow squeezing beyond the last child's minimum size.
+//
+// If set to `TRUE`, the squeezer can shrink to the point where no child can be
+// shown. This is functionally equivalent to appending a widget with 0×0 minimum
+// size.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetAllowNone(AllowNoneVar bool) {
+
+	xSqueezerSetAllowNone(x.GoPointer(), AllowNoneVar)
+
+}
+
+var xSqueezerSetHomogeneous func(uintptr, bool)
+
+// Sets whether all children have the same size for the opposite orientation.
+//
+// For example, if a squeezer is horizontal and is homogeneous, it will request
+// the same height for all its children. If it isn't, the squeezer may change
+// size when a different child becomes visible.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetHomogeneous(HomogeneousVar bool) {
+
+	xSqueezerSetHomogeneous(x.GoPointer(), HomogeneousVar)
+
+}
+
+var xSqueezerSetInterpolateSize func(uintptr, bool)
+
+// Sets whether self interpolates its size when changing the visible child.
+//
+// If `TRUE`, the squeezer will interpolate its size between the one of the
+// previous visible child and the one of the new visible child, according to the
+// set transition duration and the orientation, e.g. if the squeezer is
+// horizontal, it will interpolate the its height.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetInterpolateSize(InterpolateSizeVar bool) {
+
+	xSqueezerSetInterpolateSize(x.GoPointer(), InterpolateSizeVar)
+
+}
+
+var xSqueezerSetSwitchThresholdPolicy func(uintptr, FoldThresholdPolicy)
+
+// Sets the switch threshold policy for self.
+//
+// Determines when the squeezer will switch children.
+//
+// If set to `ADW_FOLD_THRESHOLD_POLICY_MINIMUM`, it will only switch when the
+// visible child cannot fit anymore. With `ADW_FOLD_THRESHOLD_POLICY_NATURAL`,
+// it will switch as soon as the visible child doesn't get their natural size.
+//
+// This can be useful if you have a long ellipsizing label and want to let it
+// ellipsize instead of immediately switching.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetSwitchThresholdPolicy(PolicyVar FoldThresholdPolicy) {
+
+	xSqueezerSetSwitchThresholdPolicy(x.GoPointer(), PolicyVar)
+
+}
+
+var xSqueezerSetTransitionDuration func(uintptr, uint)
+
+// Sets the transition animation duration for self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetTransitionDuration(DurationVar uint) {
+
+	xSqueezerSetTransitionDuration(x.GoPointer(), DurationVar)
+
+}
+
+var xSqueezerSetTransitionType func(uintptr, SqueezerTransitionType)
+
+// Sets the type of animation used for transitions between children in self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetTransitionType(TransitionVar SqueezerTransitionType) {
+
+	xSqueezerSetTransitionType(x.GoPointer(), TransitionVar)
+
+}
+
+var xSqueezerSetXalign func(uintptr, float32)
+
+// Sets the horizontal alignment, from 0 (start) to 1 (end).
+//
+// This affects the children allocation during transitions, when they exceed the
+// size of the squeezer.
+//
+// For example, 0.5 means the child will be centered, 0 means it will keep the
+// start side aligned and overflow the end side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetXalign(XalignVar float32) {
+
+	xSqueezerSetXalign(x.GoPointer(), XalignVar)
+
+}
+
+var xSqueezerSetYalign func(uintptr, float32)
+
+// Sets the vertical alignment, from 0 (top) to 1 (bottom).
+//
+// This affects the children allocation during transitions, when they exceed the
+// size of the squeezer.
+//
+// For example, 0.5 means the child will be centered, 0 means it will keep the
+// top side aligned and overflow the bottom side, and 1 means the opposite.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *Squeezer) SetYalign(YalignVar float32) {
+
+	xSqueezerSetYalign(x.GoPointer(), YalignVar)
+
+}
+
+var xSqueezerPageGetChild func(uintptr) uintptr
+
+// Returns the squeezer child to which self belongs.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *SqueezerPage) GetChild() *gtk.Widget {
+	var cls *gtk.Widget
+
+	cret := xSqueezerPageGetChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gtk.Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSqueezerPageGetEnabled func(uintptr) bool
+
+// Gets whether self is enabled.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *SqueezerPage) GetEnabled() bool {
+
+	cret := xSqueezerPageGetEnabled(x.GoPointer())
+	return cret
+}
+
+var xSqueezerPageSetEnabled func(uintptr, bool)
+
+// Sets whether self is enabled.
+//
+// If a child is disabled, it will be ignored when looking for the child
+// fitting the available size best.
+//
+// This allows to programmatically and prematurely hide a child even if it fits
+// in the available space.
+//
+// This can be used e.g. to ensure a certain child is hidden below a certain
+// window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *SqueezerPage) SetEnabled(EnabledVar bool) {
+
+	xSqueezerPageSetEnabled(x.GoPointer(), EnabledVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewSqueezer, libs, "adw_squeezer_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xSqueezerAdd, libs, "adw_squeezer_add", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetAllowNone, libs, "adw_squeezer_get_allow_none", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetHomogeneous, libs, "adw_squeezer_get_homogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetInterpolateSize, libs, "adw_squeezer_get_interpolate_size", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetPage, libs, "adw_squeezer_get_page", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetPages, libs, "adw_squeezer_get_pages", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetSwitchThresholdPolicy, libs, "adw_squeezer_get_switch_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetTransitionDuration, libs, "adw_squeezer_get_transition_duration", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetTransitionRunning, libs, "adw_squeezer_get_transition_running", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetTransitionType, libs, "adw_squeezer_get_transition_type", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetVisibleChild, libs, "adw_squeezer_get_visible_child", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetXalign, libs, "adw_squeezer_get_xalign", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerGetYalign, libs, "adw_squeezer_get_yalign", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerRemove, libs, "adw_squeezer_remove", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetAllowNone, libs, "adw_squeezer_set_allow_none", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetHomogeneous, libs, "adw_squeezer_set_homogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetInterpolateSize, libs, "adw_squeezer_set_interpolate_size", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetSwitchThresholdPolicy, libs, "adw_squeezer_set_switch_threshold_policy", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetTransitionDuration, libs, "adw_squeezer_set_transition_duration", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetTransitionType, libs, "adw_squeezer_set_transition_type", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetXalign, libs, "adw_squeezer_set_xalign", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerSetYalign, libs, "adw_squeezer_set_yalign", "ADW", "")
+
+	core.PuregoRegisterSince(&xSqueezerPageGetChild, libs, "adw_squeezer_page_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerPageGetEnabled, libs, "adw_squeezer_page_get_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xSqueezerPageSetEnabled, libs, "adw_squeezer_page_set_enabled", "ADW", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,37 +24,23 @@ func (x *StatusPageClass) GoPointer() uintptr {
 
 // A page used for empty/error states and similar use-cases.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="status-page-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="status-page.png" alt="status-page"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `AdwStatusPage` widget can have an icon, a title, a description and a
 // custom widget which is displayed below them.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwStatusPage` has a main CSS node with name `statuspage`.
 //
-// When setting an [class@SpinnerPaintable] as [property@StatusPage:paintable],
+// When setting an SpinnerPaintable as StatusPage:paintable,
 // the main nodes gains the `.spinner` style class for a more compact
 // appearance.
 //
-// ## Style classes
+// # Style classes
 //
 // `AdwStatusPage` can use the
-// [`.compact`](style-classes.html#compact-status-page) style class for when it
+// .compact style class for when it
 // needs to fit into a small space such a sidebar or a popover, similar to when
 // using a spinner as the paintable.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="status-page-compact-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="status-page-compact.png" alt="status-page-compact"&gt;
-//
-// &lt;/picture&gt;
 type StatusPage struct {
 	gtk.Widget
 }
@@ -91,7 +76,7 @@ func NewStatusPage() *StatusPage {
 
 var xStatusPageGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *StatusPage) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -108,7 +93,7 @@ func (x *StatusPage) GetChild() *gtk.Widget {
 
 var xStatusPageGetDescription func(uintptr) string
 
-// Gets the description markup for @self.
+// Gets the description markup for self.
 func (x *StatusPage) GetDescription() string {
 
 	cret := xStatusPageGetDescription(x.GoPointer())
@@ -117,7 +102,7 @@ func (x *StatusPage) GetDescription() string {
 
 var xStatusPageGetIconName func(uintptr) string
 
-// Gets the icon name for @self.
+// Gets the icon name for self.
 func (x *StatusPage) GetIconName() string {
 
 	cret := xStatusPageGetIconName(x.GoPointer())
@@ -126,7 +111,7 @@ func (x *StatusPage) GetIconName() string {
 
 var xStatusPageGetPaintable func(uintptr) uintptr
 
-// Gets the paintable for @self.
+// Gets the paintable for self.
 func (x *StatusPage) GetPaintable() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -143,7 +128,7 @@ func (x *StatusPage) GetPaintable() *gdk.PaintableBase {
 
 var xStatusPageGetTitle func(uintptr) string
 
-// Gets the title for @self.
+// Gets the title for self.
 func (x *StatusPage) GetTitle() string {
 
 	cret := xStatusPageGetTitle(x.GoPointer())
@@ -152,7 +137,7 @@ func (x *StatusPage) GetTitle() string {
 
 var xStatusPageSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *StatusPage) SetChild(ChildVar *gtk.Widget) {
 
 	xStatusPageSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -161,7 +146,7 @@ func (x *StatusPage) SetChild(ChildVar *gtk.Widget) {
 
 var xStatusPageSetDescription func(uintptr, uintptr)
 
-// Sets the description markup for @self.
+// Sets the description markup for self.
 //
 // The description is displayed below the title. It is parsed as Pango markup.
 func (x *StatusPage) SetDescription(DescriptionVar *string) {
@@ -175,9 +160,9 @@ func (x *StatusPage) SetDescription(DescriptionVar *string) {
 
 var xStatusPageSetIconName func(uintptr, uintptr)
 
-// Sets the icon name for @self.
+// Sets the icon name for self.
 //
-// Changing this will set [property@StatusPage:paintable] to `NULL`.
+// Changing this will set StatusPage:paintable to `NULL`.
 func (x *StatusPage) SetIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -189,9 +174,9 @@ func (x *StatusPage) SetIconName(IconNameVar *string) {
 
 var xStatusPageSetPaintable func(uintptr, uintptr)
 
-// Sets the paintable for @self.
+// Sets the paintable for self.
 //
-// Changing this will set [property@StatusPage:icon-name] to `NULL`.
+// Changing this will set StatusPage:icon-name to `NULL`.
 func (x *StatusPage) SetPaintable(PaintableVar gdk.Paintable) {
 
 	xStatusPageSetPaintable(x.GoPointer(), PaintableVar.GoPointer())
@@ -200,7 +185,7 @@ func (x *StatusPage) SetPaintable(PaintableVar gdk.Paintable) {
 
 var xStatusPageSetTitle func(uintptr, string)
 
-// Sets the title for @self.
+// Sets the title for self.
 //
 // The title is displayed below the icon. It is not parsed as Pango markup.
 func (x *StatusPage) SetTitle(TitleVar string) {
@@ -240,7 +225,7 @@ func (x *StatusPage) GetPropertyDescription() string {
 // SetPropertyIconName sets the "icon-name" property.
 // The name of the icon to be used.
 //
-// Changing this will set [property@StatusPage:paintable] to `NULL`.
+// Changing this will set StatusPage:paintable to `NULL`.
 func (x *StatusPage) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -251,7 +236,7 @@ func (x *StatusPage) SetPropertyIconName(value string) {
 // GetPropertyIconName gets the "icon-name" property.
 // The name of the icon to be used.
 //
-// Changing this will set [property@StatusPage:paintable] to `NULL`.
+// Changing this will set StatusPage:paintable to `NULL`.
 func (x *StatusPage) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -440,7 +425,7 @@ func (x *StatusPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -448,14 +433,13 @@ func (x *StatusPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *StatusPage) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -479,19 +463,17 @@ func (x *StatusPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *StatusPage) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -512,7 +494,7 @@ func (x *StatusPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -521,14 +503,12 @@ func (x *StatusPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *StatusPage) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -547,10 +527,10 @@ func (x *StatusPage) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *StatusPage) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -562,7 +542,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -571,17 +551,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xStatusPageGLibType, libs, "adw_status_page_get_type")
 
-	core.PuregoSafeRegister(&xNewStatusPage, libs, "adw_status_page_new")
-
-	core.PuregoSafeRegister(&xStatusPageGetChild, libs, "adw_status_page_get_child")
-	core.PuregoSafeRegister(&xStatusPageGetDescription, libs, "adw_status_page_get_description")
-	core.PuregoSafeRegister(&xStatusPageGetIconName, libs, "adw_status_page_get_icon_name")
-	core.PuregoSafeRegister(&xStatusPageGetPaintable, libs, "adw_status_page_get_paintable")
-	core.PuregoSafeRegister(&xStatusPageGetTitle, libs, "adw_status_page_get_title")
-	core.PuregoSafeRegister(&xStatusPageSetChild, libs, "adw_status_page_set_child")
-	core.PuregoSafeRegister(&xStatusPageSetDescription, libs, "adw_status_page_set_description")
-	core.PuregoSafeRegister(&xStatusPageSetIconName, libs, "adw_status_page_set_icon_name")
-	core.PuregoSafeRegister(&xStatusPageSetPaintable, libs, "adw_status_page_set_paintable")
-	core.PuregoSafeRegister(&xStatusPageSetTitle, libs, "adw_status_page_set_title")
+	gobject.CheckClassSize("AdwStatusPageClass", xStatusPageGLibType, unsafe.Sizeof(StatusPageClass{}))
+
+	core.PuregoRegisterSince(&xNewStatusPage, libs, "adw_status_page_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xStatusPageGetChild, libs, "adw_status_page_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageGetDescription, libs, "adw_status_page_get_description", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageGetIconName, libs, "adw_status_page_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageGetPaintable, libs, "adw_status_page_get_paintable", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageGetTitle, libs, "adw_status_page_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageSetChild, libs, "adw_status_page_set_child", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageSetDescription, libs, "adw_status_page_set_description", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageSetIconName, libs, "adw_status_page_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageSetPaintable, libs, "adw_status_page_set_paintable", "ADW", "")
+	core.PuregoRegisterSince(&xStatusPageSetTitle, libs, "adw_status_page_set_title", "ADW", "")
 
 }
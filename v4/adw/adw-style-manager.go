@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,7 +22,7 @@ func (x *StyleManagerClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Application color schemes for [property@StyleManager:color-scheme].
+// Application color schemes for StyleManager:color-scheme.
 type ColorScheme int
 
 var xColorSchemeGLibType func() types.GType
@@ -34,7 +34,7 @@ func ColorSchemeGLibType() types.GType {
 const (
 
 	// Inherit the parent color-scheme. When set on the
-	//   `AdwStyleManager` returned by [func@StyleManager.get_default], it's
+	//   `AdwStyleManager` returned by get_default, it's
 	//   equivalent to `ADW_COLOR_SCHEME_PREFER_LIGHT`.
 	ColorSchemeDefaultValue ColorScheme = 0
 	// Always use light appearance.
@@ -49,6 +49,57 @@ const (
 	ColorSchemeForceDarkValue ColorScheme = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ColorScheme) String() string {
+	switch x {
+	case ColorSchemeDefaultValue:
+		return "ADW_COLOR_SCHEME_DEFAULT"
+	case ColorSchemeForceLightValue:
+		return "ADW_COLOR_SCHEME_FORCE_LIGHT"
+	case ColorSchemePreferLightValue:
+		return "ADW_COLOR_SCHEME_PREFER_LIGHT"
+	case ColorSchemePreferDarkValue:
+		return "ADW_COLOR_SCHEME_PREFER_DARK"
+	case ColorSchemeForceDarkValue:
+		return "ADW_COLOR_SCHEME_FORCE_DARK"
+	}
+	return fmt.Sprintf("ColorScheme(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ColorScheme) Nick() string {
+	switch x {
+	case ColorSchemeDefaultValue:
+		return "default"
+	case ColorSchemeForceLightValue:
+		return "force-light"
+	case ColorSchemePreferLightValue:
+		return "prefer-light"
+	case ColorSchemePreferDarkValue:
+		return "prefer-dark"
+	case ColorSchemeForceDarkValue:
+		return "force-dark"
+	}
+	return ""
+}
+
+// ColorSchemeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ColorSchemeFromString(s string) (ColorScheme, bool) {
+	switch s {
+	case "ADW_COLOR_SCHEME_DEFAULT", "default":
+		return ColorSchemeDefaultValue, true
+	case "ADW_COLOR_SCHEME_FORCE_LIGHT", "force-light":
+		return ColorSchemeForceLightValue, true
+	case "ADW_COLOR_SCHEME_PREFER_LIGHT", "prefer-light":
+		return ColorSchemePreferLightValue, true
+	case "ADW_COLOR_SCHEME_PREFER_DARK", "prefer-dark":
+		return ColorSchemePreferDarkValue, true
+	case "ADW_COLOR_SCHEME_FORCE_DARK", "force-dark":
+		return ColorSchemeForceDarkValue, true
+	}
+	return 0, false
+}
+
 // A class for managing application-wide styling.
 //
 // `AdwStyleManager` provides a way to query and influence the application
@@ -56,7 +107,7 @@ const (
 // contrast appearance.
 //
 // It allows to set the color scheme via the
-// [property@StyleManager:color-scheme] property, and to query the current
+// StyleManager:color-scheme property, and to query the current
 // appearance, as well as whether a system-wide color scheme and accent color
 // preferences exists.
 type StyleManager struct {
@@ -79,7 +130,7 @@ var xStyleManagerGetAccentColor func(uintptr) AccentColor
 
 // Gets the current system accent color.
 //
-// See also [property@StyleManager:accent-color-rgba].
+// See also StyleManager:accent-color-rgba.
 func (x *StyleManager) GetAccentColor() AccentColor {
 
 	cret := xStyleManagerGetAccentColor(x.GoPointer())
@@ -90,8 +141,8 @@ var xStyleManagerGetAccentColorRgba func(uintptr) *gdk.RGBA
 
 // Gets the current system accent color as a `GdkRGBA`.
 //
-// Equivalent to calling [func@AccentColor.to_rgba] on the value of
-// [property@StyleManager:accent-color].
+// Equivalent to calling to_rgba on the value of
+// StyleManager:accent-color.
 //
 // This is a background color. The matching foreground color is white.
 func (x *StyleManager) GetAccentColorRgba() *gdk.RGBA {
@@ -114,7 +165,7 @@ var xStyleManagerGetDark func(uintptr) bool
 // Gets whether the application is using dark appearance.
 //
 // This can be used to query the current appearance, as requested via
-// [property@StyleManager:color-scheme].
+// StyleManager:color-scheme.
 func (x *StyleManager) GetDark() bool {
 
 	cret := xStyleManagerGetDark(x.GoPointer())
@@ -126,7 +177,7 @@ var xStyleManagerGetDisplay func(uintptr) uintptr
 // Gets the display the style manager is associated with.
 //
 // The display will be `NULL` for the style manager returned by
-// [func@StyleManager.get_default].
+// get_default.
 func (x *StyleManager) GetDisplay() *gdk.Display {
 	var cls *gdk.Display
 
@@ -145,10 +196,10 @@ var xStyleManagerGetDocumentFontName func(uintptr) string
 
 // Gets the system document font.
 //
-// The font is in the same format as [property@Gtk.Settings:gtk-font-name],
+// The font is in the same format as [gtk.Settings.GetPropertyGtkFontName],
 // e.g. "Adwaita Sans 12".
 //
-// Use [func@Pango.FontDescription.from_string] to parse it.
+// Use [pango.FontDescriptionFromString] to parse it.
 func (x *StyleManager) GetDocumentFontName() string {
 
 	cret := xStyleManagerGetDocumentFontName(x.GoPointer())
@@ -170,10 +221,10 @@ var xStyleManagerGetMonospaceFontName func(uintptr) string
 
 // Gets the system monospace font.
 //
-// The font is in the same format as [property@Gtk.Settings:gtk-font-name],
+// The font is in the same format as [gtk.Settings.GetPropertyGtkFontName],
 // e.g. "Adwaita Mono 11".
 //
-// Use [func@Pango.FontDescription.from_string] to parse it.
+// Use [pango.FontDescriptionFromString] to parse it.
 func (x *StyleManager) GetMonospaceFontName() string {
 
 	cret := xStyleManagerGetMonospaceFontName(x.GoPointer())
@@ -188,7 +239,7 @@ var xStyleManagerGetSystemSupportsAccentColors func(uintptr) bool
 // preference. For example, applications might want to show a preference for
 // choosing accent color if it's set to `FALSE`.
 //
-// See [property@StyleManager:accent-color].
+// See StyleManager:accent-color.
 func (x *StyleManager) GetSystemSupportsAccentColors() bool {
 
 	cret := xStyleManagerGetSystemSupportsAccentColors(x.GoPointer())
@@ -214,7 +265,7 @@ var xStyleManagerSetColorScheme func(uintptr, ColorScheme)
 //
 // The effective appearance will be decided based on the application color
 // scheme and the system preferred color scheme. The
-// [property@StyleManager:dark] property can be used to query the current
+// StyleManager:dark property can be used to query the current
 // effective appearance.
 //
 // The `ADW_COLOR_SCHEME_PREFER_LIGHT` color scheme results in the application
@@ -231,14 +282,14 @@ var xStyleManagerSetColorScheme func(uintptr, ColorScheme)
 // wants to match its UI to its content or to provide a separate color scheme
 // switcher.
 //
-// If a per-[class@Gdk.Display] style manager has its color scheme set to
+// If a per-[gdk.Display] style manager has its color scheme set to
 // `ADW_COLOR_SCHEME_DEFAULT`, it will inherit the color scheme from the
 // default style manager.
 //
 // For the default style manager, `ADW_COLOR_SCHEME_DEFAULT` is equivalent to
 // `ADW_COLOR_SCHEME_PREFER_LIGHT`.
 //
-// The [property@StyleManager:system-supports-color-schemes] property can be
+// The StyleManager:system-supports-color-schemes property can be
 // used to check if the current environment provides a color scheme
 // preference.
 func (x *StyleManager) SetColorScheme(ColorSchemeVar ColorScheme) {
@@ -261,8 +312,8 @@ func (c *StyleManager) SetGoPointer(ptr uintptr) {
 // GetPropertyAccentColorRgba gets the "accent-color-rgba" property.
 // The current system accent color as a `GdkRGBA`.
 //
-// Equivalent to calling [func@AccentColor.to_rgba] on the value of
-// [property@StyleManager:accent-color].
+// Equivalent to calling to_rgba on the value of
+// StyleManager:accent-color.
 //
 // This is a background color. The matching foreground color is white.
 func (x *StyleManager) GetPropertyAccentColorRgba() uintptr {
@@ -275,7 +326,7 @@ func (x *StyleManager) GetPropertyAccentColorRgba() uintptr {
 // Whether the application is using dark appearance.
 //
 // This property can be used to query the current appearance, as requested via
-// [property@StyleManager:color-scheme].
+// StyleManager:color-scheme.
 func (x *StyleManager) GetPropertyDark() bool {
 	var v gobject.Value
 	x.GetProperty("dark", &v)
@@ -285,10 +336,10 @@ func (x *StyleManager) GetPropertyDark() bool {
 // GetPropertyDocumentFontName gets the "document-font-name" property.
 // The system document font.
 //
-// The font is in the same format as [property@Gtk.Settings:gtk-font-name],
+// The font is in the same format as [gtk.Settings.GetPropertyGtkFontName],
 // e.g. "Adwaita Sans 12".
 //
-// Use [func@Pango.FontDescription.from_string] to parse it.
+// Use [pango.FontDescriptionFromString] to parse it.
 func (x *StyleManager) GetPropertyDocumentFontName() string {
 	var v gobject.Value
 	x.GetProperty("document-font-name", &v)
@@ -308,10 +359,10 @@ func (x *StyleManager) GetPropertyHighContrast() bool {
 // GetPropertyMonospaceFontName gets the "monospace-font-name" property.
 // The system monospace font.
 //
-// The font is in the same format as [property@Gtk.Settings:gtk-font-name],
+// The font is in the same format as [gtk.Settings.GetPropertyGtkFontName],
 // e.g. "Adwaita Mono 11".
 //
-// Use [func@Pango.FontDescription.from_string] to parse it.
+// Use [pango.FontDescriptionFromString] to parse it.
 func (x *StyleManager) GetPropertyMonospaceFontName() string {
 	var v gobject.Value
 	x.GetProperty("monospace-font-name", &v)
@@ -325,7 +376,7 @@ func (x *StyleManager) GetPropertyMonospaceFontName() string {
 // accent color preference. For example, applications might want to show a
 // preference for choosing accent color if it's set to `FALSE`.
 //
-// See [property@StyleManager:accent-color].
+// See StyleManager:accent-color.
 func (x *StyleManager) GetPropertySystemSupportsAccentColors() bool {
 	var v gobject.Value
 	x.GetProperty("system-supports-accent-colors", &v)
@@ -339,7 +390,7 @@ func (x *StyleManager) GetPropertySystemSupportsAccentColors() bool {
 // color scheme preference. For example, applications might want to show a
 // separate appearance switcher if it's set to `FALSE`.
 //
-// See [property@StyleManager:color-scheme].
+// See StyleManager:color-scheme.
 func (x *StyleManager) GetPropertySystemSupportsColorSchemes() bool {
 	var v gobject.Value
 	x.GetProperty("system-supports-color-schemes", &v)
@@ -350,10 +401,10 @@ var xStyleManagerGetDefault func() uintptr
 
 // Gets the default `AdwStyleManager` instance.
 //
-// It manages all [class@Gdk.Display] instances unless the style manager for
+// It manages all [gdk.Display] instances unless the style manager for
 // that display has an override.
 //
-// See [func@StyleManager.get_for_display].
+// See get_for_display.
 func StyleManagerGetDefault() *StyleManager {
 	var cls *StyleManager
 
@@ -370,12 +421,12 @@ func StyleManagerGetDefault() *StyleManager {
 
 var xStyleManagerGetForDisplay func(uintptr) uintptr
 
-// Gets the `AdwStyleManager` instance managing @display.
+// Gets the `AdwStyleManager` instance managing display.
 //
 // It can be used to override styles for that specific display instead of the
 // whole application.
 //
-// Most applications should use [func@StyleManager.get_default] instead.
+// Most applications should use get_default instead.
 func StyleManagerGetForDisplay(DisplayVar *gdk.Display) *StyleManager {
 	var cls *StyleManager
 
@@ -395,7 +446,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -406,19 +457,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xStyleManagerGLibType, libs, "adw_style_manager_get_type")
 
-	core.PuregoSafeRegister(&xStyleManagerGetAccentColor, libs, "adw_style_manager_get_accent_color")
-	core.PuregoSafeRegister(&xStyleManagerGetAccentColorRgba, libs, "adw_style_manager_get_accent_color_rgba")
-	core.PuregoSafeRegister(&xStyleManagerGetColorScheme, libs, "adw_style_manager_get_color_scheme")
-	core.PuregoSafeRegister(&xStyleManagerGetDark, libs, "adw_style_manager_get_dark")
-	core.PuregoSafeRegister(&xStyleManagerGetDisplay, libs, "adw_style_manager_get_display")
-	core.PuregoSafeRegister(&xStyleManagerGetDocumentFontName, libs, "adw_style_manager_get_document_font_name")
-	core.PuregoSafeRegister(&xStyleManagerGetHighContrast, libs, "adw_style_manager_get_high_contrast")
-	core.PuregoSafeRegister(&xStyleManagerGetMonospaceFontName, libs, "adw_style_manager_get_monospace_font_name")
-	core.PuregoSafeRegister(&xStyleManagerGetSystemSupportsAccentColors, libs, "adw_style_manager_get_system_supports_accent_colors")
-	core.PuregoSafeRegister(&xStyleManagerGetSystemSupportsColorSchemes, libs, "adw_style_manager_get_system_supports_color_schemes")
-	core.PuregoSafeRegister(&xStyleManagerSetColorScheme, libs, "adw_style_manager_set_color_scheme")
-
-	core.PuregoSafeRegister(&xStyleManagerGetDefault, libs, "adw_style_manager_get_default")
-	core.PuregoSafeRegister(&xStyleManagerGetForDisplay, libs, "adw_style_manager_get_for_display")
+	gobject.CheckClassSize("AdwStyleManagerClass", xStyleManagerGLibType, unsafe.Sizeof(StyleManagerClass{}))
+
+	core.PuregoRegisterSince(&xStyleManagerGetAccentColor, libs, "adw_style_manager_get_accent_color", "ADW", "1.6")
+	core.PuregoRegisterSince(&xStyleManagerGetAccentColorRgba, libs, "adw_style_manager_get_accent_color_rgba", "ADW", "1.6")
+	core.PuregoRegisterSince(&xStyleManagerGetColorScheme, libs, "adw_style_manager_get_color_scheme", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerGetDark, libs, "adw_style_manager_get_dark", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerGetDisplay, libs, "adw_style_manager_get_display", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerGetDocumentFontName, libs, "adw_style_manager_get_document_font_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xStyleManagerGetHighContrast, libs, "adw_style_manager_get_high_contrast", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerGetMonospaceFontName, libs, "adw_style_manager_get_monospace_font_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xStyleManagerGetSystemSupportsAccentColors, libs, "adw_style_manager_get_system_supports_accent_colors", "ADW", "1.6")
+	core.PuregoRegisterSince(&xStyleManagerGetSystemSupportsColorSchemes, libs, "adw_style_manager_get_system_supports_color_schemes", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerSetColorScheme, libs, "adw_style_manager_set_color_scheme", "ADW", "")
+
+	core.PuregoRegisterSince(&xStyleManagerGetDefault, libs, "adw_style_manager_get_default", "ADW", "")
+	core.PuregoRegisterSince(&xStyleManagerGetForDisplay, libs, "adw_style_manager_get_for_display", "ADW", "")
 
 }
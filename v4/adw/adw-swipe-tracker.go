@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,16 +22,16 @@ func (x *SwipeTrackerClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A swipe tracker used in [class@Carousel], [class@NavigationView] and
-// [class@OverlaySplitView].
+// A swipe tracker used in Carousel, NavigationView and
+// OverlaySplitView.
 //
 // The `AdwSwipeTracker` object can be used for implementing widgets with swipe
 // gestures. It supports touch-based swipes, pointer dragging, and touchpad
 // scrolling.
 //
-// The widgets will probably want to expose the [property@SwipeTracker:enabled]
+// The widgets will probably want to expose the SwipeTracker:enabled
 // property. If they expect to use horizontal orientation,
-// [property@SwipeTracker:reversed] can be used for supporting RTL text
+// SwipeTracker:reversed can be used for supporting RTL text
 // direction.
 type SwipeTracker struct {
 	gobject.Object
@@ -52,7 +51,7 @@ func SwipeTrackerNewFromInternalPtr(ptr uintptr) *SwipeTracker {
 
 var xNewSwipeTracker func(uintptr) uintptr
 
-// Creates a new `AdwSwipeTracker` for @widget.
+// Creates a new `AdwSwipeTracker` for widget.
 func NewSwipeTracker(SwipeableVar Swipeable) *SwipeTracker {
 	var cls *SwipeTracker
 
@@ -77,7 +76,7 @@ func (x *SwipeTracker) GetAllowLongSwipes() bool {
 
 var xSwipeTrackerGetAllowMouseDrag func(uintptr) bool
 
-// Gets whether @self can be dragged with mouse pointer.
+// Gets whether self can be dragged with mouse pointer.
 func (x *SwipeTracker) GetAllowMouseDrag() bool {
 
 	cret := xSwipeTrackerGetAllowMouseDrag(x.GoPointer())
@@ -95,7 +94,7 @@ func (x *SwipeTracker) GetAllowWindowHandle() bool {
 
 var xSwipeTrackerGetEnabled func(uintptr) bool
 
-// Gets whether @self is enabled.
+// Gets whether self is enabled.
 func (x *SwipeTracker) GetEnabled() bool {
 
 	cret := xSwipeTrackerGetEnabled(x.GoPointer())
@@ -113,7 +112,7 @@ func (x *SwipeTracker) GetLowerOvershoot() bool {
 
 var xSwipeTrackerGetReversed func(uintptr) bool
 
-// Gets whether @self is reversing the swipe direction.
+// Gets whether self is reversing the swipe direction.
 func (x *SwipeTracker) GetReversed() bool {
 
 	cret := xSwipeTrackerGetReversed(x.GoPointer())
@@ -122,7 +121,7 @@ func (x *SwipeTracker) GetReversed() bool {
 
 var xSwipeTrackerGetSwipeable func(uintptr) uintptr
 
-// Get the widget @self is attached to.
+// Get the widget self is attached to.
 func (x *SwipeTracker) GetSwipeable() *SwipeableBase {
 	var cls *SwipeableBase
 
@@ -160,7 +159,7 @@ func (x *SwipeTracker) SetAllowLongSwipes(AllowLongSwipesVar bool) {
 
 var xSwipeTrackerSetAllowMouseDrag func(uintptr, bool)
 
-// Sets whether @self can be dragged with mouse pointer.
+// Sets whether self can be dragged with mouse pointer.
 func (x *SwipeTracker) SetAllowMouseDrag(AllowMouseDragVar bool) {
 
 	xSwipeTrackerSetAllowMouseDrag(x.GoPointer(), AllowMouseDragVar)
@@ -180,7 +179,7 @@ func (x *SwipeTracker) SetAllowWindowHandle(AllowWindowHandleVar bool) {
 
 var xSwipeTrackerSetEnabled func(uintptr, bool)
 
-// Sets whether @self is enabled.
+// Sets whether self is enabled.
 //
 // When it's not enabled, no events will be processed. Usually widgets will want
 // to expose this via a property.
@@ -222,7 +221,7 @@ func (x *SwipeTracker) SetUpperOvershoot(OvershootVar bool) {
 
 var xSwipeTrackerShiftPosition func(uintptr, float64)
 
-// Moves the current progress value by @delta.
+// Moves the current progress value by delta.
 //
 // This can be used to adjust the current position if snap points move during
 // the gesture.
@@ -386,117 +385,205 @@ func (x *SwipeTracker) GetPropertyUpperOvershoot() bool {
 
 // This signal is emitted right before a swipe will be started, after the
 // drag threshold has been passed.
+//
+// Deprecated: use ConnectBeginSwipeFunc, which also accepts method values and closures.
 func (x *SwipeTracker) ConnectBeginSwipe(cb *func(SwipeTracker)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "begin-swipe", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SwipeTracker{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "begin-swipe", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSwipeTrackerBeginSwipeTrampoline uintptr
+
+func xSwipeTrackerBeginSwipeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SwipeTracker))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SwipeTracker{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "begin-swipe", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBeginSwipeFunc connects cb to the "begin-swipe" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SwipeTracker) ConnectBeginSwipeFunc(cb func(SwipeTracker)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "begin-swipe", &xSwipeTrackerBeginSwipeTrampoline, xSwipeTrackerBeginSwipeNewTrampoline, cb)
 }
 
 // This signal is emitted as soon as the gesture has stopped.
 //
 // The user is expected to animate the deceleration from the current progress
-// value to @to with an animation using @velocity as the initial velocity,
-// provided in pixels per second. [class@SpringAnimation] is usually a good
+// value to to with an animation using velocity as the initial velocity,
+// provided in pixels per second. SpringAnimation is usually a good
 // fit for this.
+//
+// Deprecated: use ConnectEndSwipeFunc, which also accepts method values and closures.
 func (x *SwipeTracker) ConnectEndSwipe(cb *func(SwipeTracker, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "end-swipe", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VelocityVarp float64, ToVarp float64) {
+			defer core.RecoverPanic()
+			fa := SwipeTracker{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VelocityVarp, ToVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "end-swipe", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, VelocityVarp float64, ToVarp float64) {
+var xSwipeTrackerEndSwipeTrampoline uintptr
+
+func xSwipeTrackerEndSwipeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VelocityVarp float64, ToVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SwipeTracker, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SwipeTracker{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VelocityVarp, ToVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "end-swipe", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEndSwipeFunc connects cb to the "end-swipe" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SwipeTracker) ConnectEndSwipeFunc(cb func(SwipeTracker, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "end-swipe", &xSwipeTrackerEndSwipeTrampoline, xSwipeTrackerEndSwipeNewTrampoline, cb)
 }
 
 // This signal is emitted when a possible swipe is detected.
 //
-// The @direction value can be used to restrict the swipe to a certain
+// The direction value can be used to restrict the swipe to a certain
 // direction.
+//
+// Deprecated: use ConnectPrepareFunc, which also accepts method values and closures.
 func (x *SwipeTracker) ConnectPrepare(cb *func(SwipeTracker, NavigationDirection)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp NavigationDirection) {
+			defer core.RecoverPanic()
+			fa := SwipeTracker{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DirectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "prepare", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DirectionVarp NavigationDirection) {
+var xSwipeTrackerPrepareTrampoline uintptr
+
+func xSwipeTrackerPrepareNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp NavigationDirection, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SwipeTracker, NavigationDirection))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SwipeTracker{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DirectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPrepareFunc connects cb to the "prepare" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SwipeTracker) ConnectPrepareFunc(cb func(SwipeTracker, NavigationDirection)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "prepare", &xSwipeTrackerPrepareTrampoline, xSwipeTrackerPrepareNewTrampoline, cb)
 }
 
 // This signal is emitted every time the progress value changes.
+//
+// Deprecated: use ConnectUpdateSwipeFunc, which also accepts method values and closures.
 func (x *SwipeTracker) ConnectUpdateSwipe(cb *func(SwipeTracker, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "update-swipe", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ProgressVarp float64) {
+			defer core.RecoverPanic()
+			fa := SwipeTracker{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ProgressVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "update-swipe", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ProgressVarp float64) {
+var xSwipeTrackerUpdateSwipeTrampoline uintptr
+
+func xSwipeTrackerUpdateSwipeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ProgressVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SwipeTracker, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SwipeTracker{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ProgressVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "update-swipe", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Retrieves the orientation of the @orientable.
+// ConnectUpdateSwipeFunc connects cb to the "update-swipe" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SwipeTracker) ConnectUpdateSwipeFunc(cb func(SwipeTracker, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "update-swipe", &xSwipeTrackerUpdateSwipeTrampoline, xSwipeTrackerUpdateSwipeNewTrampoline, cb)
+}
+
+// Retrieves the orientation of the orientable.
 func (x *SwipeTracker) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *SwipeTracker) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -508,7 +595,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -517,23 +604,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xSwipeTrackerGLibType, libs, "adw_swipe_tracker_get_type")
 
-	core.PuregoSafeRegister(&xNewSwipeTracker, libs, "adw_swipe_tracker_new")
-
-	core.PuregoSafeRegister(&xSwipeTrackerGetAllowLongSwipes, libs, "adw_swipe_tracker_get_allow_long_swipes")
-	core.PuregoSafeRegister(&xSwipeTrackerGetAllowMouseDrag, libs, "adw_swipe_tracker_get_allow_mouse_drag")
-	core.PuregoSafeRegister(&xSwipeTrackerGetAllowWindowHandle, libs, "adw_swipe_tracker_get_allow_window_handle")
-	core.PuregoSafeRegister(&xSwipeTrackerGetEnabled, libs, "adw_swipe_tracker_get_enabled")
-	core.PuregoSafeRegister(&xSwipeTrackerGetLowerOvershoot, libs, "adw_swipe_tracker_get_lower_overshoot")
-	core.PuregoSafeRegister(&xSwipeTrackerGetReversed, libs, "adw_swipe_tracker_get_reversed")
-	core.PuregoSafeRegister(&xSwipeTrackerGetSwipeable, libs, "adw_swipe_tracker_get_swipeable")
-	core.PuregoSafeRegister(&xSwipeTrackerGetUpperOvershoot, libs, "adw_swipe_tracker_get_upper_overshoot")
-	core.PuregoSafeRegister(&xSwipeTrackerSetAllowLongSwipes, libs, "adw_swipe_tracker_set_allow_long_swipes")
-	core.PuregoSafeRegister(&xSwipeTrackerSetAllowMouseDrag, libs, "adw_swipe_tracker_set_allow_mouse_drag")
-	core.PuregoSafeRegister(&xSwipeTrackerSetAllowWindowHandle, libs, "adw_swipe_tracker_set_allow_window_handle")
-	core.PuregoSafeRegister(&xSwipeTrackerSetEnabled, libs, "adw_swipe_tracker_set_enabled")
-	core.PuregoSafeRegister(&xSwipeTrackerSetLowerOvershoot, libs, "adw_swipe_tracker_set_lower_overshoot")
-	core.PuregoSafeRegister(&xSwipeTrackerSetReversed, libs, "adw_swipe_tracker_set_reversed")
-	core.PuregoSafeRegister(&xSwipeTrackerSetUpperOvershoot, libs, "adw_swipe_tracker_set_upper_overshoot")
-	core.PuregoSafeRegister(&xSwipeTrackerShiftPosition, libs, "adw_swipe_tracker_shift_position")
+	gobject.CheckClassSize("AdwSwipeTrackerClass", xSwipeTrackerGLibType, unsafe.Sizeof(SwipeTrackerClass{}))
+
+	core.PuregoRegisterSince(&xNewSwipeTracker, libs, "adw_swipe_tracker_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xSwipeTrackerGetAllowLongSwipes, libs, "adw_swipe_tracker_get_allow_long_swipes", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerGetAllowMouseDrag, libs, "adw_swipe_tracker_get_allow_mouse_drag", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerGetAllowWindowHandle, libs, "adw_swipe_tracker_get_allow_window_handle", "ADW", "1.5")
+	core.PuregoRegisterSince(&xSwipeTrackerGetEnabled, libs, "adw_swipe_tracker_get_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerGetLowerOvershoot, libs, "adw_swipe_tracker_get_lower_overshoot", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSwipeTrackerGetReversed, libs, "adw_swipe_tracker_get_reversed", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerGetSwipeable, libs, "adw_swipe_tracker_get_swipeable", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerGetUpperOvershoot, libs, "adw_swipe_tracker_get_upper_overshoot", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSwipeTrackerSetAllowLongSwipes, libs, "adw_swipe_tracker_set_allow_long_swipes", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerSetAllowMouseDrag, libs, "adw_swipe_tracker_set_allow_mouse_drag", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerSetAllowWindowHandle, libs, "adw_swipe_tracker_set_allow_window_handle", "ADW", "1.5")
+	core.PuregoRegisterSince(&xSwipeTrackerSetEnabled, libs, "adw_swipe_tracker_set_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerSetLowerOvershoot, libs, "adw_swipe_tracker_set_lower_overshoot", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSwipeTrackerSetReversed, libs, "adw_swipe_tracker_set_reversed", "ADW", "")
+	core.PuregoRegisterSince(&xSwipeTrackerSetUpperOvershoot, libs, "adw_swipe_tracker_set_upper_overshoot", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSwipeTrackerShiftPosition, libs, "adw_swipe_tracker_shift_position", "ADW", "")
 
 }
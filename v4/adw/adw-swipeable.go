@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type SwipeableInterface struct {
 	_ structs.HostLayout
 
-	Parent uintptr
+	Parent gobject.TypeInterface
 
 	xGetDistance uintptr
 
@@ -40,7 +40,8 @@ func (x *SwipeableInterface) OverrideGetDistance(cb func(Swipeable) float64) {
 	if cb == nil {
 		x.xGetDistance = 0
 	} else {
-		x.xGetDistance = purego.NewCallback(func(SelfVarp uintptr) float64 {
+		x.xGetDistance = core.NewCallback(func(SelfVarp uintptr) float64 {
+			defer core.RecoverPanic()
 			return cb(&SwipeableBase{Ptr: SelfVarp})
 		})
 	}
@@ -53,7 +54,7 @@ func (x *SwipeableInterface) GetGetDistance() func(Swipeable) float64 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) float64
-	purego.RegisterFunc(&rawCallback, x.xGetDistance)
+	core.RegisterFunc(&rawCallback, x.xGetDistance)
 	return func(SelfVar Swipeable) float64 {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -65,7 +66,8 @@ func (x *SwipeableInterface) OverrideGetSnapPoints(cb func(Swipeable, *int) uint
 	if cb == nil {
 		x.xGetSnapPoints = 0
 	} else {
-		x.xGetSnapPoints = purego.NewCallback(func(SelfVarp uintptr, NSnapPointsVarp *int) uintptr {
+		x.xGetSnapPoints = core.NewCallback(func(SelfVarp uintptr, NSnapPointsVarp *int) uintptr {
+			defer core.RecoverPanic()
 			return cb(&SwipeableBase{Ptr: SelfVarp}, NSnapPointsVarp)
 		})
 	}
@@ -78,7 +80,7 @@ func (x *SwipeableInterface) GetGetSnapPoints() func(Swipeable, *int) uintptr {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, NSnapPointsVarp *int) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetSnapPoints)
+	core.RegisterFunc(&rawCallback, x.xGetSnapPoints)
 	return func(SelfVar Swipeable, NSnapPointsVar *int) uintptr {
 		return rawCallback(SelfVar.GoPointer(), NSnapPointsVar)
 	}
@@ -90,7 +92,8 @@ func (x *SwipeableInterface) OverrideGetProgress(cb func(Swipeable) float64) {
 	if cb == nil {
 		x.xGetProgress = 0
 	} else {
-		x.xGetProgress = purego.NewCallback(func(SelfVarp uintptr) float64 {
+		x.xGetProgress = core.NewCallback(func(SelfVarp uintptr) float64 {
+			defer core.RecoverPanic()
 			return cb(&SwipeableBase{Ptr: SelfVarp})
 		})
 	}
@@ -103,7 +106,7 @@ func (x *SwipeableInterface) GetGetProgress() func(Swipeable) float64 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) float64
-	purego.RegisterFunc(&rawCallback, x.xGetProgress)
+	core.RegisterFunc(&rawCallback, x.xGetProgress)
 	return func(SelfVar Swipeable) float64 {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -115,7 +118,8 @@ func (x *SwipeableInterface) OverrideGetCancelProgress(cb func(Swipeable) float6
 	if cb == nil {
 		x.xGetCancelProgress = 0
 	} else {
-		x.xGetCancelProgress = purego.NewCallback(func(SelfVarp uintptr) float64 {
+		x.xGetCancelProgress = core.NewCallback(func(SelfVarp uintptr) float64 {
+			defer core.RecoverPanic()
 			return cb(&SwipeableBase{Ptr: SelfVarp})
 		})
 	}
@@ -128,7 +132,7 @@ func (x *SwipeableInterface) GetGetCancelProgress() func(Swipeable) float64 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) float64
-	purego.RegisterFunc(&rawCallback, x.xGetCancelProgress)
+	core.RegisterFunc(&rawCallback, x.xGetCancelProgress)
 	return func(SelfVar Swipeable) float64 {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -140,7 +144,8 @@ func (x *SwipeableInterface) OverrideGetSwipeArea(cb func(Swipeable, NavigationD
 	if cb == nil {
 		x.xGetSwipeArea = 0
 	} else {
-		x.xGetSwipeArea = purego.NewCallback(func(SelfVarp uintptr, NavigationDirectionVarp NavigationDirection, IsDragVarp bool, RectVarp *gdk.Rectangle) {
+		x.xGetSwipeArea = core.NewCallback(func(SelfVarp uintptr, NavigationDirectionVarp NavigationDirection, IsDragVarp bool, RectVarp *gdk.Rectangle) {
+			defer core.RecoverPanic()
 			cb(&SwipeableBase{Ptr: SelfVarp}, NavigationDirectionVarp, IsDragVarp, RectVarp)
 		})
 	}
@@ -153,7 +158,7 @@ func (x *SwipeableInterface) GetGetSwipeArea() func(Swipeable, NavigationDirecti
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, NavigationDirectionVarp NavigationDirection, IsDragVarp bool, RectVarp *gdk.Rectangle)
-	purego.RegisterFunc(&rawCallback, x.xGetSwipeArea)
+	core.RegisterFunc(&rawCallback, x.xGetSwipeArea)
 	return func(SelfVar Swipeable, NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 		rawCallback(SelfVar.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
 	}
@@ -163,7 +168,7 @@ func (x *SwipeableInterface) GetGetSwipeArea() func(Swipeable, NavigationDirecti
 //
 // The `AdwSwipeable` interface is implemented by all swipeable widgets.
 //
-// See [class@SwipeTracker] for details about implementing it.
+// See SwipeTracker for details about implementing it.
 type Swipeable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -195,14 +200,66 @@ func (x *SwipeableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the progress @self will snap back to after the gesture is canceled.
+// ImplementSwipeable adds the Swipeable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetDistance() float64
+//   - GetSnapPoints(*int) uintptr
+//   - GetProgress() float64
+//   - GetCancelProgress() float64
+//   - GetSwipeArea(NavigationDirection, bool, *gdk.Rectangle)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SwipeableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSwipeable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SwipeableGLibType(), func(iface *SwipeableInterface) {
+		if _, ok := any(zero).(interface{ GetDistance() float64 }); ok {
+			iface.OverrideGetDistance(func(self Swipeable) float64 {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDistance() float64 })
+				return impl.GetDistance()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSnapPoints(*int) uintptr }); ok {
+			iface.OverrideGetSnapPoints(func(self Swipeable, arg0 *int) uintptr {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSnapPoints(*int) uintptr })
+				return impl.GetSnapPoints(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetProgress() float64 }); ok {
+			iface.OverrideGetProgress(func(self Swipeable) float64 {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetProgress() float64 })
+				return impl.GetProgress()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetCancelProgress() float64 }); ok {
+			iface.OverrideGetCancelProgress(func(self Swipeable) float64 {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetCancelProgress() float64 })
+				return impl.GetCancelProgress()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetSwipeArea(NavigationDirection, bool, *gdk.Rectangle)
+		}); ok {
+			iface.OverrideGetSwipeArea(func(self Swipeable, arg0 NavigationDirection, arg1 bool, arg2 *gdk.Rectangle) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetSwipeArea(NavigationDirection, bool, *gdk.Rectangle)
+				})
+				impl.GetSwipeArea(arg0, arg1, arg2)
+			})
+		}
+	})
+}
+
+// Gets the progress self will snap back to after the gesture is canceled.
 func (x *SwipeableBase) GetCancelProgress() float64 {
 
 	cret := XAdwSwipeableGetCancelProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the swipe distance of @self.
+// Gets the swipe distance of self.
 //
 // This corresponds to how many pixels 1 unit represents.
 func (x *SwipeableBase) GetDistance() float64 {
@@ -211,14 +268,14 @@ func (x *SwipeableBase) GetDistance() float64 {
 	return cret
 }
 
-// Gets the current progress of @self.
+// Gets the current progress of self.
 func (x *SwipeableBase) GetProgress() float64 {
 
 	cret := XAdwSwipeableGetProgress(x.GoPointer())
 	return cret
 }
 
-// Gets the snap points of @self.
+// Gets the snap points of self.
 //
 // Each snap point represents a progress value that is considered acceptable to
 // end the swipe on.
@@ -228,7 +285,7 @@ func (x *SwipeableBase) GetSnapPoints(NSnapPointsVar *int) uintptr {
 	return cret
 }
 
-// Gets the area @self can start a swipe from for the given direction and
+// Gets the area self can start a swipe from for the given direction and
 // gesture type.
 //
 // This can be used to restrict swipes to only be possible from a certain area,
@@ -236,7 +293,7 @@ func (x *SwipeableBase) GetSnapPoints(NSnapPointsVar *int) uintptr {
 // ignore swipes elsewhere.
 //
 // If not implemented, the default implementation returns the allocation of
-// @self, allowing swipes from anywhere.
+// self, allowing swipes from anywhere.
 func (x *SwipeableBase) GetSwipeArea(NavigationDirectionVar NavigationDirection, IsDragVar bool, RectVar *gdk.Rectangle) {
 
 	XAdwSwipeableGetSwipeArea(x.GoPointer(), NavigationDirectionVar, IsDragVar, RectVar)
@@ -254,7 +311,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -263,10 +320,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSwipeableGLibType, libs, "adw_swipeable_get_type")
 
-	core.PuregoSafeRegister(&XAdwSwipeableGetCancelProgress, libs, "adw_swipeable_get_cancel_progress")
-	core.PuregoSafeRegister(&XAdwSwipeableGetDistance, libs, "adw_swipeable_get_distance")
-	core.PuregoSafeRegister(&XAdwSwipeableGetProgress, libs, "adw_swipeable_get_progress")
-	core.PuregoSafeRegister(&XAdwSwipeableGetSnapPoints, libs, "adw_swipeable_get_snap_points")
-	core.PuregoSafeRegister(&XAdwSwipeableGetSwipeArea, libs, "adw_swipeable_get_swipe_area")
+	gobject.CheckClassSize("AdwSwipeableInterface", xSwipeableGLibType, unsafe.Sizeof(SwipeableInterface{}))
+
+	core.PuregoRegisterSince(&XAdwSwipeableGetCancelProgress, libs, "adw_swipeable_get_cancel_progress", "ADW", "")
+	core.PuregoRegisterSince(&XAdwSwipeableGetDistance, libs, "adw_swipeable_get_distance", "ADW", "")
+	core.PuregoRegisterSince(&XAdwSwipeableGetProgress, libs, "adw_swipeable_get_progress", "ADW", "")
+	core.PuregoRegisterSince(&XAdwSwipeableGetSnapPoints, libs, "adw_swipeable_get_snap_points", "ADW", "")
+	core.PuregoRegisterSince(&XAdwSwipeableGetSwipeArea, libs, "adw_swipeable_get_swipe_area", "ADW", "")
 
 }
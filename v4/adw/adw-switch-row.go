@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,38 +22,28 @@ func (x *SwitchRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gtk.ListBoxRow] used to represent two states.
+// A [gtk.ListBoxRow] used to represent two states.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="switch-row-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="switch-row.png" alt="switch-row"&gt;
-//
-// &lt;/picture&gt;
-//
-// The `AdwSwitchRow` widget contains a [class@Gtk.Switch] that allows the user
+// The `AdwSwitchRow` widget contains a [gtk.Switch] that allows the user
 // to select between two states: "on" or "off". When activated, the row will
 // invert its active state.
 //
 // The user can control the switch by activating the row or by dragging on the
 // switch handle.
 //
-// See [class@Gtk.Switch] for details.
+// See [gtk.Switch] for details.
 //
 // Example of an `AdwSwitchRow` UI definition:
-// ```xml
-// &lt;object class="AdwSwitchRow"&gt;
-//
-//	&lt;property name="title" translatable="yes"&gt;Switch Row&lt;/property&gt;
-//	&lt;signal name="notify::active" handler="switch_row_notify_active_cb"/&gt;
 //
-// &lt;/object&gt;
-// ```
+//	<object class="AdwSwitchRow">
+//	  <property name="title" translatable="yes">Switch Row</property>
+//	  <signal name="notify::active" handler="switch_row_notify_active_cb"/>
+//	</object>
 //
-// The [property@SwitchRow:active] property should be connected to in order to
+// The SwitchRow:active property should be connected to in order to
 // monitor changes to the active state.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwSwitchRow` uses the `GTK_ACCESSIBLE_ROLE_SWITCH` role.
 type SwitchRow struct {
@@ -92,7 +81,7 @@ func NewSwitchRow() *SwitchRow {
 
 var xSwitchRowGetActive func(uintptr) bool
 
-// Gets whether @self is in its "on" or "off" position.
+// Gets whether self is in its "on" or "off" position.
 func (x *SwitchRow) GetActive() bool {
 
 	cret := xSwitchRowGetActive(x.GoPointer())
@@ -101,7 +90,7 @@ func (x *SwitchRow) GetActive() bool {
 
 var xSwitchRowSetActive func(uintptr, bool)
 
-// Sets whether @self is in its "on" or "off" position
+// Sets whether self is in its "on" or "off" position
 func (x *SwitchRow) SetActive(IsActiveVar bool) {
 
 	xSwitchRowSetActive(x.GoPointer(), IsActiveVar)
@@ -297,7 +286,7 @@ func (x *SwitchRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -305,14 +294,13 @@ func (x *SwitchRow) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SwitchRow) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -336,19 +324,17 @@ func (x *SwitchRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SwitchRow) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -369,7 +355,7 @@ func (x *SwitchRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -378,14 +364,12 @@ func (x *SwitchRow) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SwitchRow) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -404,14 +388,14 @@ func (x *SwitchRow) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *SwitchRow) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *SwitchRow) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -421,15 +405,15 @@ func (x *SwitchRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *SwitchRow) SetActionName(ActionNameVar *string) {
 
@@ -442,13 +426,13 @@ func (x *SwitchRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *SwitchRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -457,14 +441,14 @@ func (x *SwitchRow) SetActionTarget(FormatStringVar string, varArgs ...interface
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -482,18 +466,18 @@ func (x *SwitchRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *SwitchRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SwitchRow) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -505,7 +489,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -514,9 +498,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSwitchRowGLibType, libs, "adw_switch_row_get_type")
 
-	core.PuregoSafeRegister(&xNewSwitchRow, libs, "adw_switch_row_new")
+	gobject.CheckClassSize("AdwSwitchRowClass", xSwitchRowGLibType, unsafe.Sizeof(SwitchRowClass{}))
+
+	core.PuregoRegisterSince(&xNewSwitchRow, libs, "adw_switch_row_new", "ADW", "1.4")
 
-	core.PuregoSafeRegister(&xSwitchRowGetActive, libs, "adw_switch_row_get_active")
-	core.PuregoSafeRegister(&xSwitchRowSetActive, libs, "adw_switch_row_set_active")
+	core.PuregoRegisterSince(&xSwitchRowGetActive, libs, "adw_switch_row_get_active", "ADW", "1.4")
+	core.PuregoRegisterSince(&xSwitchRowSetActive, libs, "adw_switch_row_set_active", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,17 +23,10 @@ func (x *TabBarClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A tab bar for [class@TabView].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="tab-bar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="tab-bar.png" alt="tab-bar"&gt;
-//
-// &lt;/picture&gt;
+// A tab bar for TabView.
 //
 // The `AdwTabBar` widget is a tab bar that can be used with conjunction with
-// `AdwTabView`. It is typically used as a top bar within [class@ToolbarView].
+// `AdwTabView`. It is typically used as a top bar within ToolbarView.
 //
 // `AdwTabBar` can autohide and can optionally contain action widgets on both
 // sides of the tabs.
@@ -43,23 +35,16 @@ func (x *TabBarClass) GoPointer() uintptr {
 // them. Pinned tabs always stay visible and aren't a part of the scrollable
 // area.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwTabBar` has a single CSS node with name `tabbar`.
 //
-// ## Style classes
+// # Style classes
 //
 // By default `AdwTabBar` look like a part of an `AdwHeaderBar` and is intended
-// to be used directly attached to one or used as a [class@ToolbarView] toolbar.
-// The [`.inline`](style-classes.html#inline) style class removes its background,
+// to be used directly attached to one or used as a ToolbarView toolbar.
+// The .inline style class removes its background,
 // so that it can be used in different contexts instead.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="tab-bar-inline-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="tab-bar-inline.png" alt="tab-bar-inline"&gt;
-//
-// &lt;/picture&gt;
 type TabBar struct {
 	gtk.Widget
 }
@@ -157,7 +142,7 @@ func (x *TabBar) GetInverted() bool {
 
 var xTabBarGetIsOverflowing func(uintptr) bool
 
-// Gets whether @self is overflowing.
+// Gets whether self is overflowing.
 //
 // If `TRUE`, all tabs cannot be displayed at once and require scrolling.
 func (x *TabBar) GetIsOverflowing() bool {
@@ -187,7 +172,7 @@ var xTabBarGetTabsRevealed func(uintptr) bool
 
 // Gets whether the tabs are currently revealed.
 //
-// See [property@TabBar:autohide].
+// See TabBar:autohide.
 func (x *TabBar) GetTabsRevealed() bool {
 
 	cret := xTabBarGetTabsRevealed(x.GoPointer())
@@ -196,7 +181,7 @@ func (x *TabBar) GetTabsRevealed() bool {
 
 var xTabBarGetView func(uintptr) uintptr
 
-// Gets the tab view @self controls.
+// Gets the tab view self controls.
 func (x *TabBar) GetView() *TabView {
 	var cls *TabView
 
@@ -215,10 +200,10 @@ var xTabBarSetAutohide func(uintptr, bool)
 
 // Sets whether the tabs automatically hide.
 //
-// If set to `TRUE`, the tab bar disappears when [property@TabBar:view] has 0
+// If set to `TRUE`, the tab bar disappears when TabBar:view has 0
 // or 1 tab, no pinned tabs, and no tab is being transferred.
 //
-// See [property@TabBar:tabs-revealed].
+// See TabBar:tabs-revealed.
 func (x *TabBar) SetAutohide(AutohideVar bool) {
 
 	xTabBarSetAutohide(x.GoPointer(), AutohideVar)
@@ -250,7 +235,7 @@ var xTabBarSetExtraDragPreload func(uintptr, bool)
 
 // Sets whether drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabBar) SetExtraDragPreload(PreloadVar bool) {
 
 	xTabBarSetExtraDragPreload(x.GoPointer(), PreloadVar)
@@ -280,7 +265,7 @@ func (x *TabBar) SetStartActionWidget(WidgetVar *gtk.Widget) {
 
 var xTabBarSetView func(uintptr, uintptr)
 
-// Sets the tab view @self controls.
+// Sets the tab view self controls.
 func (x *TabBar) SetView(ViewVar *TabView) {
 
 	xTabBarSetView(x.GoPointer(), ViewVar.GoPointer())
@@ -299,7 +284,7 @@ var xTabBarSetupExtraDropTarget func(uintptr, gdk.DragAction, []types.GType, uin
 // If a tab is hovered for a certain period of time while dragging the content,
 // it will be automatically selected.
 //
-// The [signal@TabBar::extra-drag-drop] signal can be used to handle the drop.
+// The TabBar::extra-drag-drop signal can be used to handle the drop.
 func (x *TabBar) SetupExtraDropTarget(ActionsVar gdk.DragAction, TypesVar []types.GType, NTypesVar uint) {
 
 	xTabBarSetupExtraDropTarget(x.GoPointer(), ActionsVar, TypesVar, NTypesVar)
@@ -320,10 +305,10 @@ func (c *TabBar) SetGoPointer(ptr uintptr) {
 // SetPropertyAutohide sets the "autohide" property.
 // Whether the tabs automatically hide.
 //
-// If set to `TRUE`, the tab bar disappears when [property@TabBar:view] has 0
+// If set to `TRUE`, the tab bar disappears when TabBar:view has 0
 // or 1 tab, no pinned tabs, and no tab is being transferred.
 //
-// See [property@TabBar:tabs-revealed].
+// See TabBar:tabs-revealed.
 func (x *TabBar) SetPropertyAutohide(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -334,10 +319,10 @@ func (x *TabBar) SetPropertyAutohide(value bool) {
 // GetPropertyAutohide gets the "autohide" property.
 // Whether the tabs automatically hide.
 //
-// If set to `TRUE`, the tab bar disappears when [property@TabBar:view] has 0
+// If set to `TRUE`, the tab bar disappears when TabBar:view has 0
 // or 1 tab, no pinned tabs, and no tab is being transferred.
 //
-// See [property@TabBar:tabs-revealed].
+// See TabBar:tabs-revealed.
 func (x *TabBar) GetPropertyAutohide() bool {
 	var v gobject.Value
 	x.GetProperty("autohide", &v)
@@ -370,7 +355,7 @@ func (x *TabBar) GetPropertyExpandTabs() bool {
 // SetPropertyExtraDragPreload sets the "extra-drag-preload" property.
 // Whether the drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabBar) SetPropertyExtraDragPreload(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -381,7 +366,7 @@ func (x *TabBar) SetPropertyExtraDragPreload(value bool) {
 // GetPropertyExtraDragPreload gets the "extra-drag-preload" property.
 // Whether the drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabBar) GetPropertyExtraDragPreload() bool {
 	var v gobject.Value
 	x.GetProperty("extra-drag-preload", &v)
@@ -424,7 +409,7 @@ func (x *TabBar) GetPropertyIsOverflowing() bool {
 // GetPropertyTabsRevealed gets the "tabs-revealed" property.
 // Whether the tabs are currently revealed.
 //
-// See [property@TabBar:autohide].
+// See TabBar:autohide.
 func (x *TabBar) GetPropertyTabsRevealed() bool {
 	var v gobject.Value
 	x.GetProperty("tabs-revealed", &v)
@@ -434,62 +419,106 @@ func (x *TabBar) GetPropertyTabsRevealed() bool {
 // This signal is emitted when content is dropped onto a tab.
 //
 // The content must be of one of the types set up via
-// [method@TabBar.setup_extra_drop_target].
+// setup_extra_drop_target.
+//
+// See [gtk.DropTarget.ConnectDropFunc].
 //
-// See [signal@Gtk.DropTarget::drop].
+// Deprecated: use ConnectExtraDragDropFunc, which also accepts method values and closures.
 func (x *TabBar) ConnectExtraDragDrop(cb *func(TabBar, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-drop", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := TabBar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "extra-drag-drop", cbRefPtr, cbPtr)
+}
+
+var xTabBarExtraDragDropTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) bool {
+func xTabBarExtraDragDropNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabBar, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabBar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-drop", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectExtraDragDropFunc connects cb to the "extra-drag-drop" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabBar) ConnectExtraDragDropFunc(cb func(TabBar, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "extra-drag-drop", &xTabBarExtraDragDropTrampoline, xTabBarExtraDragDropNewTrampoline, cb)
 }
 
 // This signal is emitted when the dropped content is preloaded.
 //
-// In order for data to be preloaded, [property@TabBar:extra-drag-preload]
+// In order for data to be preloaded, TabBar:extra-drag-preload
 // must be set to `TRUE`.
 //
 // The content must be of one of the types set up via
-// [method@TabBar.setup_extra_drop_target].
+// setup_extra_drop_target.
 //
-// See [property@Gtk.DropTarget:value].
+// See [gtk.DropTarget.GetPropertyValue].
+//
+// Deprecated: use ConnectExtraDragValueFunc, which also accepts method values and closures.
 func (x *TabBar) ConnectExtraDragValue(cb *func(TabBar, uintptr, uintptr) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-value", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := TabBar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "extra-drag-value", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) gdk.DragAction {
+var xTabBarExtraDragValueTrampoline uintptr
+
+func xTabBarExtraDragValueNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabBar, uintptr, uintptr) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabBar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-value", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectExtraDragValueFunc connects cb to the "extra-drag-value" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabBar) ConnectExtraDragValueFunc(cb func(TabBar, uintptr, uintptr) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "extra-drag-value", &xTabBarExtraDragValueTrampoline, xTabBarExtraDragValueNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -653,7 +682,7 @@ func (x *TabBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -661,14 +690,13 @@ func (x *TabBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TabBar) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -692,19 +720,17 @@ func (x *TabBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TabBar) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -725,7 +751,7 @@ func (x *TabBar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -734,14 +760,12 @@ func (x *TabBar) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TabBar) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -760,10 +784,10 @@ func (x *TabBar) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TabBar) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -775,7 +799,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -784,25 +808,27 @@ func init() {
 
 	core.PuregoSafeRegister(&xTabBarGLibType, libs, "adw_tab_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewTabBar, libs, "adw_tab_bar_new")
-
-	core.PuregoSafeRegister(&xTabBarGetAutohide, libs, "adw_tab_bar_get_autohide")
-	core.PuregoSafeRegister(&xTabBarGetEndActionWidget, libs, "adw_tab_bar_get_end_action_widget")
-	core.PuregoSafeRegister(&xTabBarGetExpandTabs, libs, "adw_tab_bar_get_expand_tabs")
-	core.PuregoSafeRegister(&xTabBarGetExtraDragPreferredAction, libs, "adw_tab_bar_get_extra_drag_preferred_action")
-	core.PuregoSafeRegister(&xTabBarGetExtraDragPreload, libs, "adw_tab_bar_get_extra_drag_preload")
-	core.PuregoSafeRegister(&xTabBarGetInverted, libs, "adw_tab_bar_get_inverted")
-	core.PuregoSafeRegister(&xTabBarGetIsOverflowing, libs, "adw_tab_bar_get_is_overflowing")
-	core.PuregoSafeRegister(&xTabBarGetStartActionWidget, libs, "adw_tab_bar_get_start_action_widget")
-	core.PuregoSafeRegister(&xTabBarGetTabsRevealed, libs, "adw_tab_bar_get_tabs_revealed")
-	core.PuregoSafeRegister(&xTabBarGetView, libs, "adw_tab_bar_get_view")
-	core.PuregoSafeRegister(&xTabBarSetAutohide, libs, "adw_tab_bar_set_autohide")
-	core.PuregoSafeRegister(&xTabBarSetEndActionWidget, libs, "adw_tab_bar_set_end_action_widget")
-	core.PuregoSafeRegister(&xTabBarSetExpandTabs, libs, "adw_tab_bar_set_expand_tabs")
-	core.PuregoSafeRegister(&xTabBarSetExtraDragPreload, libs, "adw_tab_bar_set_extra_drag_preload")
-	core.PuregoSafeRegister(&xTabBarSetInverted, libs, "adw_tab_bar_set_inverted")
-	core.PuregoSafeRegister(&xTabBarSetStartActionWidget, libs, "adw_tab_bar_set_start_action_widget")
-	core.PuregoSafeRegister(&xTabBarSetView, libs, "adw_tab_bar_set_view")
-	core.PuregoSafeRegister(&xTabBarSetupExtraDropTarget, libs, "adw_tab_bar_setup_extra_drop_target")
+	gobject.CheckClassSize("AdwTabBarClass", xTabBarGLibType, unsafe.Sizeof(TabBarClass{}))
+
+	core.PuregoRegisterSince(&xNewTabBar, libs, "adw_tab_bar_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xTabBarGetAutohide, libs, "adw_tab_bar_get_autohide", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetEndActionWidget, libs, "adw_tab_bar_get_end_action_widget", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetExpandTabs, libs, "adw_tab_bar_get_expand_tabs", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetExtraDragPreferredAction, libs, "adw_tab_bar_get_extra_drag_preferred_action", "ADW", "1.4")
+	core.PuregoRegisterSince(&xTabBarGetExtraDragPreload, libs, "adw_tab_bar_get_extra_drag_preload", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabBarGetInverted, libs, "adw_tab_bar_get_inverted", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetIsOverflowing, libs, "adw_tab_bar_get_is_overflowing", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetStartActionWidget, libs, "adw_tab_bar_get_start_action_widget", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetTabsRevealed, libs, "adw_tab_bar_get_tabs_revealed", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarGetView, libs, "adw_tab_bar_get_view", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetAutohide, libs, "adw_tab_bar_set_autohide", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetEndActionWidget, libs, "adw_tab_bar_set_end_action_widget", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetExpandTabs, libs, "adw_tab_bar_set_expand_tabs", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetExtraDragPreload, libs, "adw_tab_bar_set_extra_drag_preload", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabBarSetInverted, libs, "adw_tab_bar_set_inverted", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetStartActionWidget, libs, "adw_tab_bar_set_start_action_widget", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetView, libs, "adw_tab_bar_set_view", "ADW", "")
+	core.PuregoRegisterSince(&xTabBarSetupExtraDropTarget, libs, "adw_tab_bar_setup_extra_drop_target", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,32 +22,21 @@ func (x *TabButtonClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A button that displays the number of [class@TabView] pages.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="tab-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="tab-button.png" alt="tab-button"&gt;
-//
-// &lt;/picture&gt;
+// A button that displays the number of TabView pages.
 //
 // `AdwTabButton` is a button that displays the number of pages in a given
 // `AdwTabView`, as well as whether one of the inactive pages needs attention.
 //
 // It's intended to be used as a visible indicator when there's no visible tab
-// bar, typically opening an [class@TabOverview] on click, e.g. via the
+// bar, typically opening an TabOverview on click, e.g. via the
 // `overview.open` action name:
 //
-// ```xml
-// &lt;object class="AdwTabButton"&gt;
-//
-//	&lt;property name="view"&gt;view&lt;/property&gt;
-//	&lt;property name="action-name"&gt;overview.open&lt;/property&gt;
+//	<object class="AdwTabButton">
+//	  <property name="view">view</property>
+//	  <property name="action-name">overview.open</property>
+//	</object>
 //
-// &lt;/object&gt;
-// ```
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwTabButton` has a main CSS node with name `tabbutton`.
 //
@@ -90,7 +78,7 @@ func NewTabButton() *TabButton {
 
 var xTabButtonGetView func(uintptr) uintptr
 
-// Gets the tab view @self displays.
+// Gets the tab view self displays.
 func (x *TabButton) GetView() *TabView {
 	var cls *TabView
 
@@ -128,52 +116,96 @@ func (c *TabButton) SetGoPointer(ptr uintptr) {
 // Emitted to animate press then release.
 //
 // This is an action signal. Applications should never connect to this signal,
-// but use the [signal@TabButton::clicked] signal.
+// but use the TabButton::clicked signal.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *TabButton) ConnectActivate(cb *func(TabButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TabButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTabButtonActivateTrampoline uintptr
+
+func xTabButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabButton) ConnectActivateFunc(cb func(TabButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xTabButtonActivateTrampoline, xTabButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the button has been activated (pressed and released).
+//
+// Deprecated: use ConnectClickedFunc, which also accepts method values and closures.
 func (x *TabButton) ConnectClicked(cb *func(TabButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TabButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "clicked", cbRefPtr, cbPtr)
+}
+
+var xTabButtonClickedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTabButtonClickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClickedFunc connects cb to the "clicked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabButton) ConnectClickedFunc(cb func(TabButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "clicked", &xTabButtonClickedTrampoline, xTabButtonClickedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -337,7 +369,7 @@ func (x *TabButton) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -345,14 +377,13 @@ func (x *TabButton) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TabButton) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -376,19 +407,17 @@ func (x *TabButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TabButton) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -409,7 +438,7 @@ func (x *TabButton) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -418,14 +447,12 @@ func (x *TabButton) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TabButton) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -444,14 +471,14 @@ func (x *TabButton) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *TabButton) GetActionName() string {
 
 	cret := gtk.XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *TabButton) GetActionTargetValue() *glib.Variant {
 
 	cret := gtk.XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -461,15 +488,15 @@ func (x *TabButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *TabButton) SetActionName(ActionNameVar *string) {
 
@@ -482,13 +509,13 @@ func (x *TabButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [gtk.Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [gtk.Actionable.SetDetailedActionName].
 func (x *TabButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	gtk.XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -497,14 +524,14 @@ func (x *TabButton) SetActionTarget(FormatStringVar string, varArgs ...interface
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -522,18 +549,18 @@ func (x *TabButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *TabButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	gtk.XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TabButton) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -545,7 +572,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -554,9 +581,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xTabButtonGLibType, libs, "adw_tab_button_get_type")
 
-	core.PuregoSafeRegister(&xNewTabButton, libs, "adw_tab_button_new")
+	gobject.CheckClassSize("AdwTabButtonClass", xTabButtonGLibType, unsafe.Sizeof(TabButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewTabButton, libs, "adw_tab_button_new", "ADW", "1.3")
 
-	core.PuregoSafeRegister(&xTabButtonGetView, libs, "adw_tab_button_get_view")
-	core.PuregoSafeRegister(&xTabButtonSetView, libs, "adw_tab_button_set_view")
+	core.PuregoRegisterSince(&xTabButtonGetView, libs, "adw_tab_button_get_view", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabButtonSetView, libs, "adw_tab_button_set_view", "ADW", "1.3")
 
 }
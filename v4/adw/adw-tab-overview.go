@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -25,62 +24,55 @@ func (x *TabOverviewClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A tab overview for [class@TabView].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="tab-overview-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="tab-overview.png" alt="tab-overview"&gt;
-//
-// &lt;/picture&gt;
+// A tab overview for TabView.
 //
 // `AdwTabOverview` is a widget that can display tabs from an `AdwTabView` in a
 // grid.
 //
 // `AdwTabOverview` shows a thumbnail for each tab. By default thumbnails are
 // static for all pages except the selected one. They can be made always live
-// by setting [property@TabPage:live-thumbnail] to `TRUE`, or refreshed with
-// [method@TabPage.invalidate_thumbnail] or
-// [method@TabView.invalidate_thumbnails] otherwise.
+// by setting TabPage:live-thumbnail to `TRUE`, or refreshed with
+// invalidate_thumbnail or
+// invalidate_thumbnails otherwise.
 //
 // If the pages are too tall or too wide, the thumbnails will be cropped; use
-// [property@TabPage:thumbnail-xalign] and [property@TabPage:thumbnail-yalign] to
+// TabPage:thumbnail-xalign and TabPage:thumbnail-yalign to
 // control which part of the page should be visible in this case.
 //
 // Pinned tabs are shown as smaller cards without thumbnails above the other
-// tabs. Unlike in [class@TabBar], they still have titles, as well as an unpin
+// tabs. Unlike in TabBar, they still have titles, as well as an unpin
 // button.
 //
 // `AdwTabOverview` provides search in open tabs. It searches in tab titles and
-// tooltips, as well as [property@TabPage:keyword].
+// tooltips, as well as TabPage:keyword.
 //
-// If [property@TabOverview:enable-new-tab] is set to `TRUE`, a new tab button
-// will be shown. Connect to the [signal@TabOverview::create-tab] signal to use
+// If TabOverview:enable-new-tab is set to `TRUE`, a new tab button
+// will be shown. Connect to the TabOverview::create-tab signal to use
 // it.
 //
-// [property@TabOverview:secondary-menu] can be used to provide a secondary menu
+// TabOverview:secondary-menu can be used to provide a secondary menu
 // for the overview. Use it to add extra actions, e.g. to open a new window or
 // undo closed tab.
 //
 // `AdwTabOverview` is intended to be used as the direct child of the window,
-// with the rest of the window contents set as the [property@TabOverview:child].
-// The child is expected to contain an [class@TabView].
+// with the rest of the window contents set as the TabOverview:child.
+// The child is expected to contain an TabView.
 //
 // `AdwTabOverview` shows window buttons by default. They can be disabled by
-// setting [property@TabOverview:show-start-title-buttons] and/or
-// [property@TabOverview:show-start-title-buttons] and/or
-// [property@TabOverview:show-end-title-buttons] to `FALSE`.
+// setting TabOverview:show-start-title-buttons and/or
+// TabOverview:show-start-title-buttons and/or
+// TabOverview:show-end-title-buttons to `FALSE`.
 //
 // If search and window buttons are disabled, and secondary menu is not set, the
 // header bar will be hidden.
 //
-// ## Actions
+// # Actions
 //
 // `AdwTabOverview` defines the `overview.open` and `overview.close` actions for
 // opening and closing itself. They can be convenient when used together with
-// [class@TabButton].
+// TabButton.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwTabOverview` has a single CSS node with name `taboverview`.
 type TabOverview struct {
@@ -118,7 +110,7 @@ func NewTabOverview() *TabOverview {
 
 var xTabOverviewGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *TabOverview) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -135,7 +127,7 @@ func (x *TabOverview) GetChild() *gtk.Widget {
 
 var xTabOverviewGetEnableNewTab func(uintptr) bool
 
-// Gets whether to new tab button is enabled for @self.
+// Gets whether to new tab button is enabled for self.
 func (x *TabOverview) GetEnableNewTab() bool {
 
 	cret := xTabOverviewGetEnableNewTab(x.GoPointer())
@@ -144,7 +136,7 @@ func (x *TabOverview) GetEnableNewTab() bool {
 
 var xTabOverviewGetEnableSearch func(uintptr) bool
 
-// Gets whether search in tabs is enabled for @self.
+// Gets whether search in tabs is enabled for self.
 func (x *TabOverview) GetEnableSearch() bool {
 
 	cret := xTabOverviewGetEnableSearch(x.GoPointer())
@@ -180,7 +172,7 @@ func (x *TabOverview) GetInverted() bool {
 
 var xTabOverviewGetOpen func(uintptr) bool
 
-// Gets whether @self is open.
+// Gets whether self is open.
 func (x *TabOverview) GetOpen() bool {
 
 	cret := xTabOverviewGetOpen(x.GoPointer())
@@ -189,9 +181,9 @@ func (x *TabOverview) GetOpen() bool {
 
 var xTabOverviewGetSearchActive func(uintptr) bool
 
-// Gets whether search is currently active for @self.
+// Gets whether search is currently active for self.
 //
-// See [property@TabOverview:enable-search].
+// See TabOverview:enable-search.
 func (x *TabOverview) GetSearchActive() bool {
 
 	cret := xTabOverviewGetSearchActive(x.GoPointer())
@@ -200,7 +192,7 @@ func (x *TabOverview) GetSearchActive() bool {
 
 var xTabOverviewGetSecondaryMenu func(uintptr) uintptr
 
-// Gets the secondary menu model for @self.
+// Gets the secondary menu model for self.
 func (x *TabOverview) GetSecondaryMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -217,7 +209,7 @@ func (x *TabOverview) GetSecondaryMenu() *gio.MenuModel {
 
 var xTabOverviewGetShowEndTitleButtons func(uintptr) bool
 
-// Gets whether end title buttons are shown in @self's header bar.
+// Gets whether end title buttons are shown in self's header bar.
 func (x *TabOverview) GetShowEndTitleButtons() bool {
 
 	cret := xTabOverviewGetShowEndTitleButtons(x.GoPointer())
@@ -226,7 +218,7 @@ func (x *TabOverview) GetShowEndTitleButtons() bool {
 
 var xTabOverviewGetShowStartTitleButtons func(uintptr) bool
 
-// Gets whether start title buttons are shown in @self's header bar.
+// Gets whether start title buttons are shown in self's header bar.
 func (x *TabOverview) GetShowStartTitleButtons() bool {
 
 	cret := xTabOverviewGetShowStartTitleButtons(x.GoPointer())
@@ -235,7 +227,7 @@ func (x *TabOverview) GetShowStartTitleButtons() bool {
 
 var xTabOverviewGetView func(uintptr) uintptr
 
-// Gets the tab view @self controls.
+// Gets the tab view self controls.
 func (x *TabOverview) GetView() *TabView {
 	var cls *TabView
 
@@ -252,7 +244,7 @@ func (x *TabOverview) GetView() *TabView {
 
 var xTabOverviewSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *TabOverview) SetChild(ChildVar *gtk.Widget) {
 
 	xTabOverviewSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -261,9 +253,9 @@ func (x *TabOverview) SetChild(ChildVar *gtk.Widget) {
 
 var xTabOverviewSetEnableNewTab func(uintptr, bool)
 
-// Sets whether to enable new tab button for @self.
+// Sets whether to enable new tab button for self.
 //
-// Connect to the [signal@TabOverview::create-tab] signal to use it.
+// Connect to the TabOverview::create-tab signal to use it.
 func (x *TabOverview) SetEnableNewTab(EnableNewTabVar bool) {
 
 	xTabOverviewSetEnableNewTab(x.GoPointer(), EnableNewTabVar)
@@ -272,15 +264,15 @@ func (x *TabOverview) SetEnableNewTab(EnableNewTabVar bool) {
 
 var xTabOverviewSetEnableSearch func(uintptr, bool)
 
-// Sets whether to enable search in tabs for @self.
+// Sets whether to enable search in tabs for self.
 //
 // Search matches tab titles and tooltips, as well as keywords, set via
-// [property@TabPage:keyword]. Use keywords to search in e.g. page URLs in a web
+// TabPage:keyword. Use keywords to search in e.g. page URLs in a web
 // browser.
 //
 // During search, tab reordering and drag-n-drop are disabled.
 //
-// Use [property@TabOverview:search-active] to check out if search is currently
+// Use TabOverview:search-active to check out if search is currently
 // active.
 func (x *TabOverview) SetEnableSearch(EnableSearchVar bool) {
 
@@ -292,7 +284,7 @@ var xTabOverviewSetExtraDragPreload func(uintptr, bool)
 
 // Sets whether drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabOverview) SetExtraDragPreload(PreloadVar bool) {
 
 	xTabOverviewSetExtraDragPreload(x.GoPointer(), PreloadVar)
@@ -313,7 +305,7 @@ func (x *TabOverview) SetInverted(InvertedVar bool) {
 
 var xTabOverviewSetOpen func(uintptr, bool)
 
-// Sets whether the to open @self.
+// Sets whether the to open self.
 func (x *TabOverview) SetOpen(OpenVar bool) {
 
 	xTabOverviewSetOpen(x.GoPointer(), OpenVar)
@@ -322,7 +314,7 @@ func (x *TabOverview) SetOpen(OpenVar bool) {
 
 var xTabOverviewSetSecondaryMenu func(uintptr, uintptr)
 
-// Sets the secondary menu model for @self.
+// Sets the secondary menu model for self.
 //
 // Use it to add extra actions, e.g. to open a new window or undo closed tab.
 func (x *TabOverview) SetSecondaryMenu(SecondaryMenuVar *gio.MenuModel) {
@@ -333,9 +325,9 @@ func (x *TabOverview) SetSecondaryMenu(SecondaryMenuVar *gio.MenuModel) {
 
 var xTabOverviewSetShowEndTitleButtons func(uintptr, bool)
 
-// Sets whether to show end title buttons in @self's header bar.
+// Sets whether to show end title buttons in self's header bar.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 func (x *TabOverview) SetShowEndTitleButtons(ShowEndTitleButtonsVar bool) {
 
 	xTabOverviewSetShowEndTitleButtons(x.GoPointer(), ShowEndTitleButtonsVar)
@@ -344,9 +336,9 @@ func (x *TabOverview) SetShowEndTitleButtons(ShowEndTitleButtonsVar bool) {
 
 var xTabOverviewSetShowStartTitleButtons func(uintptr, bool)
 
-// Sets whether to show start title buttons in @self's header bar.
+// Sets whether to show start title buttons in self's header bar.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 func (x *TabOverview) SetShowStartTitleButtons(ShowStartTitleButtonsVar bool) {
 
 	xTabOverviewSetShowStartTitleButtons(x.GoPointer(), ShowStartTitleButtonsVar)
@@ -357,7 +349,7 @@ var xTabOverviewSetView func(uintptr, uintptr)
 
 // Sets the tab view to control.
 //
-// The view must be inside @self, see [property@TabOverview:child].
+// The view must be inside self, see TabOverview:child.
 func (x *TabOverview) SetView(ViewVar *TabView) {
 
 	xTabOverviewSetView(x.GoPointer(), ViewVar.GoPointer())
@@ -376,7 +368,7 @@ var xTabOverviewSetupExtraDropTarget func(uintptr, gdk.DragAction, []types.GType
 // If a tab is hovered for a certain period of time while dragging the content,
 // it will be automatically selected.
 //
-// The [signal@TabOverview::extra-drag-drop] signal can be used to handle the
+// The TabOverview::extra-drag-drop signal can be used to handle the
 // drop.
 func (x *TabOverview) SetupExtraDropTarget(ActionsVar gdk.DragAction, TypesVar []types.GType, NTypesVar uint) {
 
@@ -398,7 +390,7 @@ func (c *TabOverview) SetGoPointer(ptr uintptr) {
 // SetPropertyEnableNewTab sets the "enable-new-tab" property.
 // Whether to enable new tab button.
 //
-// Connect to the [signal@TabOverview::create-tab] signal to use it.
+// Connect to the TabOverview::create-tab signal to use it.
 func (x *TabOverview) SetPropertyEnableNewTab(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -409,7 +401,7 @@ func (x *TabOverview) SetPropertyEnableNewTab(value bool) {
 // GetPropertyEnableNewTab gets the "enable-new-tab" property.
 // Whether to enable new tab button.
 //
-// Connect to the [signal@TabOverview::create-tab] signal to use it.
+// Connect to the TabOverview::create-tab signal to use it.
 func (x *TabOverview) GetPropertyEnableNewTab() bool {
 	var v gobject.Value
 	x.GetProperty("enable-new-tab", &v)
@@ -420,12 +412,12 @@ func (x *TabOverview) GetPropertyEnableNewTab() bool {
 // Whether to enable search in tabs.
 //
 // Search matches tab titles and tooltips, as well as keywords, set via
-// [property@TabPage:keyword]. Use keywords to search in e.g. page URLs in a
+// TabPage:keyword. Use keywords to search in e.g. page URLs in a
 // web browser.
 //
 // During search, tab reordering and drag-n-drop are disabled.
 //
-// Use [property@TabOverview:search-active] to check out if search is
+// Use TabOverview:search-active to check out if search is
 // currently active.
 func (x *TabOverview) SetPropertyEnableSearch(value bool) {
 	var v gobject.Value
@@ -438,12 +430,12 @@ func (x *TabOverview) SetPropertyEnableSearch(value bool) {
 // Whether to enable search in tabs.
 //
 // Search matches tab titles and tooltips, as well as keywords, set via
-// [property@TabPage:keyword]. Use keywords to search in e.g. page URLs in a
+// TabPage:keyword. Use keywords to search in e.g. page URLs in a
 // web browser.
 //
 // During search, tab reordering and drag-n-drop are disabled.
 //
-// Use [property@TabOverview:search-active] to check out if search is
+// Use TabOverview:search-active to check out if search is
 // currently active.
 func (x *TabOverview) GetPropertyEnableSearch() bool {
 	var v gobject.Value
@@ -454,7 +446,7 @@ func (x *TabOverview) GetPropertyEnableSearch() bool {
 // SetPropertyExtraDragPreload sets the "extra-drag-preload" property.
 // Whether the drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabOverview) SetPropertyExtraDragPreload(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -465,7 +457,7 @@ func (x *TabOverview) SetPropertyExtraDragPreload(value bool) {
 // GetPropertyExtraDragPreload gets the "extra-drag-preload" property.
 // Whether the drop data should be preloaded on hover.
 //
-// See [property@Gtk.DropTarget:preload].
+// See [gtk.DropTarget.GetPropertyPreload].
 func (x *TabOverview) GetPropertyExtraDragPreload() bool {
 	var v gobject.Value
 	x.GetProperty("extra-drag-preload", &v)
@@ -515,7 +507,7 @@ func (x *TabOverview) GetPropertyOpen() bool {
 // GetPropertySearchActive gets the "search-active" property.
 // Whether search is currently active.
 //
-// See [property@TabOverview:enable-search].
+// See TabOverview:enable-search.
 func (x *TabOverview) GetPropertySearchActive() bool {
 	var v gobject.Value
 	x.GetProperty("search-active", &v)
@@ -525,7 +517,7 @@ func (x *TabOverview) GetPropertySearchActive() bool {
 // SetPropertyShowEndTitleButtons sets the "show-end-title-buttons" property.
 // Whether to show end title buttons in the overview's header bar.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 func (x *TabOverview) SetPropertyShowEndTitleButtons(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -536,7 +528,7 @@ func (x *TabOverview) SetPropertyShowEndTitleButtons(value bool) {
 // GetPropertyShowEndTitleButtons gets the "show-end-title-buttons" property.
 // Whether to show end title buttons in the overview's header bar.
 //
-// See [property@HeaderBar:show-start-title-buttons] for the other side.
+// See HeaderBar:show-start-title-buttons for the other side.
 func (x *TabOverview) GetPropertyShowEndTitleButtons() bool {
 	var v gobject.Value
 	x.GetProperty("show-end-title-buttons", &v)
@@ -546,7 +538,7 @@ func (x *TabOverview) GetPropertyShowEndTitleButtons() bool {
 // SetPropertyShowStartTitleButtons sets the "show-start-title-buttons" property.
 // Whether to show start title buttons in the overview's header bar.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 func (x *TabOverview) SetPropertyShowStartTitleButtons(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -557,7 +549,7 @@ func (x *TabOverview) SetPropertyShowStartTitleButtons(value bool) {
 // GetPropertyShowStartTitleButtons gets the "show-start-title-buttons" property.
 // Whether to show start title buttons in the overview's header bar.
 //
-// See [property@HeaderBar:show-end-title-buttons] for the other side.
+// See HeaderBar:show-end-title-buttons for the other side.
 func (x *TabOverview) GetPropertyShowStartTitleButtons() bool {
 	var v gobject.Value
 	x.GetProperty("show-start-title-buttons", &v)
@@ -567,93 +559,160 @@ func (x *TabOverview) GetPropertyShowStartTitleButtons() bool {
 // Emitted when a tab needs to be created.
 //
 // This can happen after the new tab button has been pressed, see
-// [property@TabOverview:enable-new-tab].
+// TabOverview:enable-new-tab.
 //
 // The signal handler is expected to create a new page in the corresponding
-// [class@TabView] and return it.
+// TabView and return it.
+//
+// Deprecated: use ConnectCreateTabFunc, which also accepts method values and closures.
 func (x *TabOverview) ConnectCreateTab(cb *func(TabOverview) TabPage) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "create-tab", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := TabOverview{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			CreateTabCls := cbFn(fa)
+			return CreateTabCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "create-tab", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) uintptr {
+var xTabOverviewCreateTabTrampoline uintptr
+
+func xTabOverviewCreateTabNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabOverview) TabPage)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabOverview{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		CreateTabCls := cbFn(fa)
 		return CreateTabCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "create-tab", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCreateTabFunc connects cb to the "create-tab" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabOverview) ConnectCreateTabFunc(cb func(TabOverview) TabPage) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "create-tab", &xTabOverviewCreateTabTrampoline, xTabOverviewCreateTabNewTrampoline, cb)
 }
 
 // This signal is emitted when content is dropped onto a tab.
 //
 // The content must be of one of the types set up via
-// [method@TabOverview.setup_extra_drop_target].
+// setup_extra_drop_target.
 //
-// See [signal@Gtk.DropTarget::drop].
+// See [gtk.DropTarget.ConnectDropFunc].
+//
+// Deprecated: use ConnectExtraDragDropFunc, which also accepts method values and closures.
 func (x *TabOverview) ConnectExtraDragDrop(cb *func(TabOverview, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-drop", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := TabOverview{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "extra-drag-drop", cbRefPtr, cbPtr)
+}
+
+var xTabOverviewExtraDragDropTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) bool {
+func xTabOverviewExtraDragDropNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabOverview, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabOverview{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-drop", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectExtraDragDropFunc connects cb to the "extra-drag-drop" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabOverview) ConnectExtraDragDropFunc(cb func(TabOverview, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "extra-drag-drop", &xTabOverviewExtraDragDropTrampoline, xTabOverviewExtraDragDropNewTrampoline, cb)
 }
 
 // This signal is emitted when the dropped content is preloaded.
 //
-// In order for data to be preloaded, [property@TabOverview:extra-drag-preload]
+// In order for data to be preloaded, TabOverview:extra-drag-preload
 // must be set to `TRUE`.
 //
 // The content must be of one of the types set up via
-// [method@TabOverview.setup_extra_drop_target].
+// setup_extra_drop_target.
+//
+// See [gtk.DropTarget.GetPropertyValue].
 //
-// See [property@Gtk.DropTarget:value].
+// Deprecated: use ConnectExtraDragValueFunc, which also accepts method values and closures.
 func (x *TabOverview) ConnectExtraDragValue(cb *func(TabOverview, uintptr, uintptr) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-value", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := TabOverview{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "extra-drag-value", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr) gdk.DragAction {
+var xTabOverviewExtraDragValueTrampoline uintptr
+
+func xTabOverviewExtraDragValueNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, ValueVarp uintptr, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabOverview, uintptr, uintptr) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabOverview{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "extra-drag-value", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectExtraDragValueFunc connects cb to the "extra-drag-value" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabOverview) ConnectExtraDragValueFunc(cb func(TabOverview, uintptr, uintptr) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "extra-drag-value", &xTabOverviewExtraDragValueTrampoline, xTabOverviewExtraDragValueNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -817,7 +876,7 @@ func (x *TabOverview) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -825,14 +884,13 @@ func (x *TabOverview) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TabOverview) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -856,19 +914,17 @@ func (x *TabOverview) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TabOverview) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -889,7 +945,7 @@ func (x *TabOverview) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -898,14 +954,12 @@ func (x *TabOverview) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TabOverview) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -924,10 +978,10 @@ func (x *TabOverview) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TabOverview) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -939,7 +993,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -948,30 +1002,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xTabOverviewGLibType, libs, "adw_tab_overview_get_type")
 
-	core.PuregoSafeRegister(&xNewTabOverview, libs, "adw_tab_overview_new")
-
-	core.PuregoSafeRegister(&xTabOverviewGetChild, libs, "adw_tab_overview_get_child")
-	core.PuregoSafeRegister(&xTabOverviewGetEnableNewTab, libs, "adw_tab_overview_get_enable_new_tab")
-	core.PuregoSafeRegister(&xTabOverviewGetEnableSearch, libs, "adw_tab_overview_get_enable_search")
-	core.PuregoSafeRegister(&xTabOverviewGetExtraDragPreferredAction, libs, "adw_tab_overview_get_extra_drag_preferred_action")
-	core.PuregoSafeRegister(&xTabOverviewGetExtraDragPreload, libs, "adw_tab_overview_get_extra_drag_preload")
-	core.PuregoSafeRegister(&xTabOverviewGetInverted, libs, "adw_tab_overview_get_inverted")
-	core.PuregoSafeRegister(&xTabOverviewGetOpen, libs, "adw_tab_overview_get_open")
-	core.PuregoSafeRegister(&xTabOverviewGetSearchActive, libs, "adw_tab_overview_get_search_active")
-	core.PuregoSafeRegister(&xTabOverviewGetSecondaryMenu, libs, "adw_tab_overview_get_secondary_menu")
-	core.PuregoSafeRegister(&xTabOverviewGetShowEndTitleButtons, libs, "adw_tab_overview_get_show_end_title_buttons")
-	core.PuregoSafeRegister(&xTabOverviewGetShowStartTitleButtons, libs, "adw_tab_overview_get_show_start_title_buttons")
-	core.PuregoSafeRegister(&xTabOverviewGetView, libs, "adw_tab_overview_get_view")
-	core.PuregoSafeRegister(&xTabOverviewSetChild, libs, "adw_tab_overview_set_child")
-	core.PuregoSafeRegister(&xTabOverviewSetEnableNewTab, libs, "adw_tab_overview_set_enable_new_tab")
-	core.PuregoSafeRegister(&xTabOverviewSetEnableSearch, libs, "adw_tab_overview_set_enable_search")
-	core.PuregoSafeRegister(&xTabOverviewSetExtraDragPreload, libs, "adw_tab_overview_set_extra_drag_preload")
-	core.PuregoSafeRegister(&xTabOverviewSetInverted, libs, "adw_tab_overview_set_inverted")
-	core.PuregoSafeRegister(&xTabOverviewSetOpen, libs, "adw_tab_overview_set_open")
-	core.PuregoSafeRegister(&xTabOverviewSetSecondaryMenu, libs, "adw_tab_overview_set_secondary_menu")
-	core.PuregoSafeRegister(&xTabOverviewSetShowEndTitleButtons, libs, "adw_tab_overview_set_show_end_title_buttons")
-	core.PuregoSafeRegister(&xTabOverviewSetShowStartTitleButtons, libs, "adw_tab_overview_set_show_start_title_buttons")
-	core.PuregoSafeRegister(&xTabOverviewSetView, libs, "adw_tab_overview_set_view")
-	core.PuregoSafeRegister(&xTabOverviewSetupExtraDropTarget, libs, "adw_tab_overview_setup_extra_drop_target")
+	gobject.CheckClassSize("AdwTabOverviewClass", xTabOverviewGLibType, unsafe.Sizeof(TabOverviewClass{}))
+
+	core.PuregoRegisterSince(&xNewTabOverview, libs, "adw_tab_overview_new", "ADW", "1.3")
+
+	core.PuregoRegisterSince(&xTabOverviewGetChild, libs, "adw_tab_overview_get_child", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetEnableNewTab, libs, "adw_tab_overview_get_enable_new_tab", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetEnableSearch, libs, "adw_tab_overview_get_enable_search", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetExtraDragPreferredAction, libs, "adw_tab_overview_get_extra_drag_preferred_action", "ADW", "1.4")
+	core.PuregoRegisterSince(&xTabOverviewGetExtraDragPreload, libs, "adw_tab_overview_get_extra_drag_preload", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetInverted, libs, "adw_tab_overview_get_inverted", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetOpen, libs, "adw_tab_overview_get_open", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetSearchActive, libs, "adw_tab_overview_get_search_active", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetSecondaryMenu, libs, "adw_tab_overview_get_secondary_menu", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetShowEndTitleButtons, libs, "adw_tab_overview_get_show_end_title_buttons", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetShowStartTitleButtons, libs, "adw_tab_overview_get_show_start_title_buttons", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewGetView, libs, "adw_tab_overview_get_view", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetChild, libs, "adw_tab_overview_set_child", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetEnableNewTab, libs, "adw_tab_overview_set_enable_new_tab", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetEnableSearch, libs, "adw_tab_overview_set_enable_search", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetExtraDragPreload, libs, "adw_tab_overview_set_extra_drag_preload", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetInverted, libs, "adw_tab_overview_set_inverted", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetOpen, libs, "adw_tab_overview_set_open", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetSecondaryMenu, libs, "adw_tab_overview_set_secondary_menu", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetShowEndTitleButtons, libs, "adw_tab_overview_set_show_end_title_buttons", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetShowStartTitleButtons, libs, "adw_tab_overview_set_show_start_title_buttons", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetView, libs, "adw_tab_overview_set_view", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabOverviewSetupExtraDropTarget, libs, "adw_tab_overview_setup_extra_drop_target", "ADW", "1.3")
 
 }
@@ -2,10 +2,11 @@
 package adw
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -34,14 +35,14 @@ func (x *TabViewClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes available shortcuts in an [class@TabView].
+// Describes available shortcuts in an TabView.
 //
-// Shortcuts can be set with [property@TabView:shortcuts], or added/removed
-// individually with [method@TabView.add_shortcuts] and
-// [method@TabView.remove_shortcuts].
+// Shortcuts can be set with TabView:shortcuts, or added/removed
+// individually with add_shortcuts and
+// remove_shortcuts.
 //
 // New values may be added to this enumeration over time.
-type TabViewShortcuts int
+type TabViewShortcuts uint32
 
 var xTabViewShortcutsGLibType func() types.GType
 
@@ -53,40 +54,125 @@ const (
 
 	// No shortcuts
 	TabViewShortcutNoneValue TabViewShortcuts = 0
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; - switch to the next page
+	// Ctrl+Tab - switch to the next page
 	TabViewShortcutControlTabValue TabViewShortcuts = 1
-	// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; - switch to the previous
+	// Shift+Ctrl+Tab - switch to the previous
 	//   page
 	TabViewShortcutControlShiftTabValue TabViewShortcuts = 2
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Page Up&lt;/kbd&gt; - switch to the previous page
+	// Ctrl+Page Up - switch to the previous page
 	TabViewShortcutControlPageUpValue TabViewShortcuts = 4
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Page Down&lt;/kbd&gt; - switch to the next page
+	// Ctrl+Page Down - switch to the next page
 	TabViewShortcutControlPageDownValue TabViewShortcuts = 8
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Home&lt;/kbd&gt; - switch to the first page
+	// Ctrl+Home - switch to the first page
 	TabViewShortcutControlHomeValue TabViewShortcuts = 16
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;End&lt;/kbd&gt; - switch to the last page
+	// Ctrl+End - switch to the last page
 	TabViewShortcutControlEndValue TabViewShortcuts = 32
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Page Up&lt;/kbd&gt; - move the selected
+	// Ctrl+Shift+Page Up - move the selected
 	//   page backward
 	TabViewShortcutControlShiftPageUpValue TabViewShortcuts = 64
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Page Down&lt;/kbd&gt; - move the selected
+	// Ctrl+Shift+Page Down - move the selected
 	//   page forward
 	TabViewShortcutControlShiftPageDownValue TabViewShortcuts = 128
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Home&lt;/kbd&gt; - move the selected page
+	// Ctrl+Shift+Home - move the selected page
 	//   at the start
 	TabViewShortcutControlShiftHomeValue TabViewShortcuts = 256
-	// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;End&lt;/kbd&gt; - move the current page at
+	// Ctrl+Shift+End - move the current page at
 	//   the end
 	TabViewShortcutControlShiftEndValue TabViewShortcuts = 512
-	// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;1&lt;/kbd&gt;⋯&lt;kbd&gt;9&lt;/kbd&gt; - switch to pages 1-9
+	// Alt+1⋯9 - switch to pages 1-9
 	TabViewShortcutAltDigitsValue TabViewShortcuts = 1024
-	// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;0&lt;/kbd&gt; - switch to page 10
+	// Alt+0 - switch to page 10
 	TabViewShortcutAltZeroValue TabViewShortcuts = 2048
 	// All of the shortcuts
 	TabViewShortcutAllShortcutsValue TabViewShortcuts = 4095
 )
 
-// An auxiliary class used by [class@TabView].
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TabViewShortcuts) GLibType() types.GType {
+	return xTabViewShortcutsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TabViewShortcuts) Has(flags TabViewShortcuts) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TabViewShortcuts) Set(flags TabViewShortcuts) TabViewShortcuts {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TabViewShortcuts) Clear(flags TabViewShortcuts) TabViewShortcuts {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TabViewShortcuts) String() string {
+	if x == 0 {
+		return "ADW_TAB_VIEW_SHORTCUT_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&TabViewShortcutControlTabValue != 0 && x&TabViewShortcutControlTabValue == TabViewShortcutControlTabValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_TAB")
+		rest &^= TabViewShortcutControlTabValue
+	}
+	if rest&TabViewShortcutControlShiftTabValue != 0 && x&TabViewShortcutControlShiftTabValue == TabViewShortcutControlShiftTabValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_SHIFT_TAB")
+		rest &^= TabViewShortcutControlShiftTabValue
+	}
+	if rest&TabViewShortcutControlPageUpValue != 0 && x&TabViewShortcutControlPageUpValue == TabViewShortcutControlPageUpValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_PAGE_UP")
+		rest &^= TabViewShortcutControlPageUpValue
+	}
+	if rest&TabViewShortcutControlPageDownValue != 0 && x&TabViewShortcutControlPageDownValue == TabViewShortcutControlPageDownValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_PAGE_DOWN")
+		rest &^= TabViewShortcutControlPageDownValue
+	}
+	if rest&TabViewShortcutControlHomeValue != 0 && x&TabViewShortcutControlHomeValue == TabViewShortcutControlHomeValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_HOME")
+		rest &^= TabViewShortcutControlHomeValue
+	}
+	if rest&TabViewShortcutControlEndValue != 0 && x&TabViewShortcutControlEndValue == TabViewShortcutControlEndValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_END")
+		rest &^= TabViewShortcutControlEndValue
+	}
+	if rest&TabViewShortcutControlShiftPageUpValue != 0 && x&TabViewShortcutControlShiftPageUpValue == TabViewShortcutControlShiftPageUpValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_SHIFT_PAGE_UP")
+		rest &^= TabViewShortcutControlShiftPageUpValue
+	}
+	if rest&TabViewShortcutControlShiftPageDownValue != 0 && x&TabViewShortcutControlShiftPageDownValue == TabViewShortcutControlShiftPageDownValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_SHIFT_PAGE_DOWN")
+		rest &^= TabViewShortcutControlShiftPageDownValue
+	}
+	if rest&TabViewShortcutControlShiftHomeValue != 0 && x&TabViewShortcutControlShiftHomeValue == TabViewShortcutControlShiftHomeValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_SHIFT_HOME")
+		rest &^= TabViewShortcutControlShiftHomeValue
+	}
+	if rest&TabViewShortcutControlShiftEndValue != 0 && x&TabViewShortcutControlShiftEndValue == TabViewShortcutControlShiftEndValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_CONTROL_SHIFT_END")
+		rest &^= TabViewShortcutControlShiftEndValue
+	}
+	if rest&TabViewShortcutAltDigitsValue != 0 && x&TabViewShortcutAltDigitsValue == TabViewShortcutAltDigitsValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_ALT_DIGITS")
+		rest &^= TabViewShortcutAltDigitsValue
+	}
+	if rest&TabViewShortcutAltZeroValue != 0 && x&TabViewShortcutAltZeroValue == TabViewShortcutAltZeroValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_ALT_ZERO")
+		rest &^= TabViewShortcutAltZeroValue
+	}
+	if rest&TabViewShortcutAllShortcutsValue != 0 && x&TabViewShortcutAllShortcutsValue == TabViewShortcutAllShortcutsValue {
+		names = append(names, "ADW_TAB_VIEW_SHORTCUT_ALL_SHORTCUTS")
+		rest &^= TabViewShortcutAllShortcutsValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// An auxiliary class used by TabView.
 type TabPage struct {
 	gobject.Object
 }
@@ -105,7 +191,7 @@ func TabPageNewFromInternalPtr(ptr uintptr) *TabPage {
 
 var xTabPageGetChild func(uintptr) uintptr
 
-// Gets the child of @self.
+// Gets the child of self.
 func (x *TabPage) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -122,7 +208,7 @@ func (x *TabPage) GetChild() *gtk.Widget {
 
 var xTabPageGetIcon func(uintptr) uintptr
 
-// Gets the icon of @self.
+// Gets the icon of self.
 func (x *TabPage) GetIcon() *gio.IconBase {
 	var cls *gio.IconBase
 
@@ -139,7 +225,7 @@ func (x *TabPage) GetIcon() *gio.IconBase {
 
 var xTabPageGetIndicatorActivatable func(uintptr) bool
 
-// Gets whether the indicator of @self is activatable.
+// Gets whether the indicator of self is activatable.
 func (x *TabPage) GetIndicatorActivatable() bool {
 
 	cret := xTabPageGetIndicatorActivatable(x.GoPointer())
@@ -148,7 +234,7 @@ func (x *TabPage) GetIndicatorActivatable() bool {
 
 var xTabPageGetIndicatorIcon func(uintptr) uintptr
 
-// Gets the indicator icon of @self.
+// Gets the indicator icon of self.
 func (x *TabPage) GetIndicatorIcon() *gio.IconBase {
 	var cls *gio.IconBase
 
@@ -165,7 +251,7 @@ func (x *TabPage) GetIndicatorIcon() *gio.IconBase {
 
 var xTabPageGetIndicatorTooltip func(uintptr) string
 
-// Gets the tooltip of the indicator icon of @self.
+// Gets the tooltip of the indicator icon of self.
 func (x *TabPage) GetIndicatorTooltip() string {
 
 	cret := xTabPageGetIndicatorTooltip(x.GoPointer())
@@ -174,7 +260,7 @@ func (x *TabPage) GetIndicatorTooltip() string {
 
 var xTabPageGetKeyword func(uintptr) string
 
-// Gets the search keyword of @self.
+// Gets the search keyword of self.
 func (x *TabPage) GetKeyword() string {
 
 	cret := xTabPageGetKeyword(x.GoPointer())
@@ -183,7 +269,7 @@ func (x *TabPage) GetKeyword() string {
 
 var xTabPageGetLiveThumbnail func(uintptr) bool
 
-// Gets whether to live thumbnail is enabled @self.
+// Gets whether to live thumbnail is enabled self.
 func (x *TabPage) GetLiveThumbnail() bool {
 
 	cret := xTabPageGetLiveThumbnail(x.GoPointer())
@@ -192,7 +278,7 @@ func (x *TabPage) GetLiveThumbnail() bool {
 
 var xTabPageGetLoading func(uintptr) bool
 
-// Gets whether @self is loading.
+// Gets whether self is loading.
 func (x *TabPage) GetLoading() bool {
 
 	cret := xTabPageGetLoading(x.GoPointer())
@@ -201,7 +287,7 @@ func (x *TabPage) GetLoading() bool {
 
 var xTabPageGetNeedsAttention func(uintptr) bool
 
-// Gets whether @self needs attention.
+// Gets whether self needs attention.
 func (x *TabPage) GetNeedsAttention() bool {
 
 	cret := xTabPageGetNeedsAttention(x.GoPointer())
@@ -210,9 +296,9 @@ func (x *TabPage) GetNeedsAttention() bool {
 
 var xTabPageGetParent func(uintptr) uintptr
 
-// Gets the parent page of @self.
+// Gets the parent page of self.
 //
-// See [method@TabView.add_page] and [method@TabView.close_page].
+// See add_page and close_page.
 func (x *TabPage) GetParent() *TabPage {
 	var cls *TabPage
 
@@ -229,9 +315,9 @@ func (x *TabPage) GetParent() *TabPage {
 
 var xTabPageGetPinned func(uintptr) bool
 
-// Gets whether @self is pinned.
+// Gets whether self is pinned.
 //
-// See [method@TabView.set_page_pinned].
+// See set_page_pinned.
 func (x *TabPage) GetPinned() bool {
 
 	cret := xTabPageGetPinned(x.GoPointer())
@@ -240,7 +326,7 @@ func (x *TabPage) GetPinned() bool {
 
 var xTabPageGetSelected func(uintptr) bool
 
-// Gets whether @self is selected.
+// Gets whether self is selected.
 func (x *TabPage) GetSelected() bool {
 
 	cret := xTabPageGetSelected(x.GoPointer())
@@ -249,7 +335,7 @@ func (x *TabPage) GetSelected() bool {
 
 var xTabPageGetThumbnailXalign func(uintptr) float32
 
-// Gets the horizontal alignment of the thumbnail for @self.
+// Gets the horizontal alignment of the thumbnail for self.
 func (x *TabPage) GetThumbnailXalign() float32 {
 
 	cret := xTabPageGetThumbnailXalign(x.GoPointer())
@@ -258,7 +344,7 @@ func (x *TabPage) GetThumbnailXalign() float32 {
 
 var xTabPageGetThumbnailYalign func(uintptr) float32
 
-// Gets the vertical alignment of the thumbnail for @self.
+// Gets the vertical alignment of the thumbnail for self.
 func (x *TabPage) GetThumbnailYalign() float32 {
 
 	cret := xTabPageGetThumbnailYalign(x.GoPointer())
@@ -267,7 +353,7 @@ func (x *TabPage) GetThumbnailYalign() float32 {
 
 var xTabPageGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *TabPage) GetTitle() string {
 
 	cret := xTabPageGetTitle(x.GoPointer())
@@ -276,7 +362,7 @@ func (x *TabPage) GetTitle() string {
 
 var xTabPageGetTooltip func(uintptr) string
 
-// Gets the tooltip of @self.
+// Gets the tooltip of self.
 func (x *TabPage) GetTooltip() string {
 
 	cret := xTabPageGetTooltip(x.GoPointer())
@@ -285,14 +371,14 @@ func (x *TabPage) GetTooltip() string {
 
 var xTabPageInvalidateThumbnail func(uintptr)
 
-// Invalidates thumbnail for @self.
+// Invalidates thumbnail for self.
 //
-// If an [class@TabOverview] is open, the thumbnail representing @self will be
+// If an TabOverview is open, the thumbnail representing self will be
 // immediately updated. Otherwise it will be update when opening the overview.
 //
-// Does nothing if [property@TabPage:live-thumbnail] is set to `TRUE`.
+// Does nothing if TabPage:live-thumbnail is set to `TRUE`.
 //
-// See also [method@TabView.invalidate_thumbnails].
+// See also invalidate_thumbnails.
 func (x *TabPage) InvalidateThumbnail() {
 
 	xTabPageInvalidateThumbnail(x.GoPointer())
@@ -301,10 +387,10 @@ func (x *TabPage) InvalidateThumbnail() {
 
 var xTabPageSetIcon func(uintptr, uintptr)
 
-// Sets the icon of @self.
+// Sets the icon of self.
 //
-// [class@TabBar] and [class@TabOverview] display the icon next to the title,
-// unless [property@TabPage:loading] is set to `TRUE`.
+// TabBar and TabOverview display the icon next to the title,
+// unless TabPage:loading is set to `TRUE`.
 //
 // `AdwTabBar` also won't show the icon if the page is pinned and
 // [propertyTabPage:indicator-icon] is set.
@@ -316,12 +402,12 @@ func (x *TabPage) SetIcon(IconVar gio.Icon) {
 
 var xTabPageSetIndicatorActivatable func(uintptr, bool)
 
-// Sets whether the indicator of @self is activatable.
+// Sets whether the indicator of self is activatable.
 //
-// If set to `TRUE`, [signal@TabView::indicator-activated] will be emitted
+// If set to `TRUE`, TabView::indicator-activated will be emitted
 // when the indicator icon is clicked.
 //
-// If [property@TabPage:indicator-icon] is not set, does nothing.
+// If TabPage:indicator-icon is not set, does nothing.
 func (x *TabPage) SetIndicatorActivatable(ActivatableVar bool) {
 
 	xTabPageSetIndicatorActivatable(x.GoPointer(), ActivatableVar)
@@ -330,22 +416,22 @@ func (x *TabPage) SetIndicatorActivatable(ActivatableVar bool) {
 
 var xTabPageSetIndicatorIcon func(uintptr, uintptr)
 
-// Sets the indicator icon of @self.
+// Sets the indicator icon of self.
 //
 // A common use case is an audio or camera indicator in a web browser.
 //
-// [class@TabBar] will show it at the beginning of the tab, alongside icon
-// representing [property@TabPage:icon] or loading spinner.
+// TabBar will show it at the beginning of the tab, alongside icon
+// representing TabPage:icon or loading spinner.
 //
 // If the page is pinned, the indicator will be shown instead of icon or
 // spinner.
 //
-// [class@TabOverview] will show it at the at the top part of the thumbnail.
+// TabOverview will show it at the at the top part of the thumbnail.
 //
-// [property@TabPage:indicator-tooltip] can be used to set the tooltip on the
+// TabPage:indicator-tooltip can be used to set the tooltip on the
 // indicator icon.
 //
-// If [property@TabPage:indicator-activatable] is set to `TRUE`, the
+// If TabPage:indicator-activatable is set to `TRUE`, the
 // indicator icon can act as a button.
 func (x *TabPage) SetIndicatorIcon(IndicatorIconVar gio.Icon) {
 
@@ -355,11 +441,11 @@ func (x *TabPage) SetIndicatorIcon(IndicatorIconVar gio.Icon) {
 
 var xTabPageSetIndicatorTooltip func(uintptr, string)
 
-// Sets the tooltip of the indicator icon of @self.
+// Sets the tooltip of the indicator icon of self.
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// See [property@TabPage:indicator-icon].
+// See TabPage:indicator-icon.
 func (x *TabPage) SetIndicatorTooltip(TooltipVar string) {
 
 	xTabPageSetIndicatorTooltip(x.GoPointer(), TooltipVar)
@@ -368,9 +454,9 @@ func (x *TabPage) SetIndicatorTooltip(TooltipVar string) {
 
 var xTabPageSetKeyword func(uintptr, string)
 
-// Sets the search keyword for @self.
+// Sets the search keyword for self.
 //
-// [class@TabOverview] can search pages by their keywords in addition to their
+// TabOverview can search pages by their keywords in addition to their
 // titles and tooltips.
 //
 // Keywords allow to include e.g. page URLs into tab search in a web browser.
@@ -382,15 +468,15 @@ func (x *TabPage) SetKeyword(KeywordVar string) {
 
 var xTabPageSetLiveThumbnail func(uintptr, bool)
 
-// Sets whether to enable live thumbnail for @self.
+// Sets whether to enable live thumbnail for self.
 //
-// When set to `TRUE`, @self's thumbnail in [class@TabOverview] will update
-// immediately when @self is redrawn or resized.
+// When set to `TRUE`, self's thumbnail in TabOverview will update
+// immediately when self is redrawn or resized.
 //
-// If it's set to `FALSE`, the thumbnail will only be live when the @self is
+// If it's set to `FALSE`, the thumbnail will only be live when the self is
 // selected, and otherwise it will be static and will only update when
-// [method@TabPage.invalidate_thumbnail] or
-// [method@TabView.invalidate_thumbnails] is called.
+// invalidate_thumbnail or
+// invalidate_thumbnails is called.
 func (x *TabPage) SetLiveThumbnail(LiveThumbnailVar bool) {
 
 	xTabPageSetLiveThumbnail(x.GoPointer(), LiveThumbnailVar)
@@ -399,12 +485,12 @@ func (x *TabPage) SetLiveThumbnail(LiveThumbnailVar bool) {
 
 var xTabPageSetLoading func(uintptr, bool)
 
-// Sets whether @self is loading.
+// Sets whether self is loading.
 //
-// If set to `TRUE`, [class@TabBar] and [class@TabOverview] will display a
+// If set to `TRUE`, TabBar and TabOverview will display a
 // spinner in place of icon.
 //
-// If the page is pinned and [property@TabPage:indicator-icon] is set, loading
+// If the page is pinned and TabPage:indicator-icon is set, loading
 // status will not be visible with `AdwTabBar`.
 func (x *TabPage) SetLoading(LoadingVar bool) {
 
@@ -414,17 +500,17 @@ func (x *TabPage) SetLoading(LoadingVar bool) {
 
 var xTabPageSetNeedsAttention func(uintptr, bool)
 
-// Sets whether @self needs attention.
+// Sets whether self needs attention.
 //
-// [class@TabBar] will display a line under the tab representing the page if
+// TabBar will display a line under the tab representing the page if
 // set to `TRUE`. If the tab is not visible, the corresponding edge of the tab
 // bar will be highlighted.
 //
-// [class@TabOverview] will display a dot in the corner of the thumbnail if set
+// TabOverview will display a dot in the corner of the thumbnail if set
 // to `TRUE`.
 //
-// [class@TabButton] will display a dot if any of the pages that aren't
-// selected have [property@TabPage:needs-attention] set to `TRUE`.
+// TabButton will display a dot if any of the pages that aren't
+// selected have TabPage:needs-attention set to `TRUE`.
 func (x *TabPage) SetNeedsAttention(NeedsAttentionVar bool) {
 
 	xTabPageSetNeedsAttention(x.GoPointer(), NeedsAttentionVar)
@@ -433,9 +519,9 @@ func (x *TabPage) SetNeedsAttention(NeedsAttentionVar bool) {
 
 var xTabPageSetThumbnailXalign func(uintptr, float32)
 
-// Sets the horizontal alignment of the thumbnail for @self.
+// Sets the horizontal alignment of the thumbnail for self.
 //
-// If the page is so wide that [class@TabOverview] can't display it completely
+// If the page is so wide that TabOverview can't display it completely
 // and has to crop it, horizontal alignment will determine which part of the
 // page will be visible.
 //
@@ -451,9 +537,9 @@ func (x *TabPage) SetThumbnailXalign(XalignVar float32) {
 
 var xTabPageSetThumbnailYalign func(uintptr, float32)
 
-// Sets the vertical alignment of the thumbnail for @self.
+// Sets the vertical alignment of the thumbnail for self.
 //
-// If the page is so tall that [class@TabOverview] can't display it completely
+// If the page is so tall that TabOverview can't display it completely
 // and has to crop it, vertical alignment will determine which part of the page
 // will be visible.
 //
@@ -469,14 +555,14 @@ func (x *TabPage) SetThumbnailYalign(YalignVar float32) {
 
 var xTabPageSetTitle func(uintptr, string)
 
-// [class@TabBar] will display it in the center of the tab unless it's pinned,
-// and will use it as a tooltip unless [property@TabPage:tooltip] is set.
+// TabBar will display it in the center of the tab unless it's pinned,
+// and will use it as a tooltip unless TabPage:tooltip is set.
 //
-// [class@TabOverview] will display it below the thumbnail unless it's pinned,
+// TabOverview will display it below the thumbnail unless it's pinned,
 // or inside the card otherwise, and will use it as a tooltip unless
-// [property@TabPage:tooltip] is set.
+// TabPage:tooltip is set.
 //
-// Sets the title of @self.
+// Sets the title of self.
 func (x *TabPage) SetTitle(TitleVar string) {
 
 	xTabPageSetTitle(x.GoPointer(), TitleVar)
@@ -485,12 +571,12 @@ func (x *TabPage) SetTitle(TitleVar string) {
 
 var xTabPageSetTooltip func(uintptr, string)
 
-// Sets the tooltip of @self.
+// Sets the tooltip of self.
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// If not set, [class@TabBar] and [class@TabOverview] will use
-// [property@TabPage:title] as a tooltip instead.
+// If not set, TabBar and TabOverview will use
+// TabPage:title as a tooltip instead.
 func (x *TabPage) SetTooltip(TooltipVar string) {
 
 	xTabPageSetTooltip(x.GoPointer(), TooltipVar)
@@ -511,10 +597,10 @@ func (c *TabPage) SetGoPointer(ptr uintptr) {
 // SetPropertyIndicatorActivatable sets the "indicator-activatable" property.
 // Whether the indicator icon is activatable.
 //
-// If set to `TRUE`, [signal@TabView::indicator-activated] will be emitted
+// If set to `TRUE`, TabView::indicator-activated will be emitted
 // when the indicator icon is clicked.
 //
-// If [property@TabPage:indicator-icon] is not set, does nothing.
+// If TabPage:indicator-icon is not set, does nothing.
 func (x *TabPage) SetPropertyIndicatorActivatable(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -525,10 +611,10 @@ func (x *TabPage) SetPropertyIndicatorActivatable(value bool) {
 // GetPropertyIndicatorActivatable gets the "indicator-activatable" property.
 // Whether the indicator icon is activatable.
 //
-// If set to `TRUE`, [signal@TabView::indicator-activated] will be emitted
+// If set to `TRUE`, TabView::indicator-activated will be emitted
 // when the indicator icon is clicked.
 //
-// If [property@TabPage:indicator-icon] is not set, does nothing.
+// If TabPage:indicator-icon is not set, does nothing.
 func (x *TabPage) GetPropertyIndicatorActivatable() bool {
 	var v gobject.Value
 	x.GetProperty("indicator-activatable", &v)
@@ -540,7 +626,7 @@ func (x *TabPage) GetPropertyIndicatorActivatable() bool {
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// See [property@TabPage:indicator-icon].
+// See TabPage:indicator-icon.
 func (x *TabPage) SetPropertyIndicatorTooltip(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -553,7 +639,7 @@ func (x *TabPage) SetPropertyIndicatorTooltip(value string) {
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// See [property@TabPage:indicator-icon].
+// See TabPage:indicator-icon.
 func (x *TabPage) GetPropertyIndicatorTooltip() string {
 	var v gobject.Value
 	x.GetProperty("indicator-tooltip", &v)
@@ -563,7 +649,7 @@ func (x *TabPage) GetPropertyIndicatorTooltip() string {
 // SetPropertyKeyword sets the "keyword" property.
 // The search keyboard of the page.
 //
-// [class@TabOverview] can search pages by their keywords in addition to their
+// TabOverview can search pages by their keywords in addition to their
 // titles and tooltips.
 //
 // Keywords allow to include e.g. page URLs into tab search in a web browser.
@@ -577,7 +663,7 @@ func (x *TabPage) SetPropertyKeyword(value string) {
 // GetPropertyKeyword gets the "keyword" property.
 // The search keyboard of the page.
 //
-// [class@TabOverview] can search pages by their keywords in addition to their
+// TabOverview can search pages by their keywords in addition to their
 // titles and tooltips.
 //
 // Keywords allow to include e.g. page URLs into tab search in a web browser.
@@ -590,13 +676,13 @@ func (x *TabPage) GetPropertyKeyword() string {
 // SetPropertyLiveThumbnail sets the "live-thumbnail" property.
 // Whether to enable live thumbnail for this page.
 //
-// When set to `TRUE`, the page's thumbnail in [class@TabOverview] will update
+// When set to `TRUE`, the page's thumbnail in TabOverview will update
 // immediately when the page is redrawn or resized.
 //
 // If it's set to `FALSE`, the thumbnail will only be live when the page is
 // selected, and otherwise it will be static and will only update when
-// [method@TabPage.invalidate_thumbnail] or
-// [method@TabView.invalidate_thumbnails] is called.
+// invalidate_thumbnail or
+// invalidate_thumbnails is called.
 func (x *TabPage) SetPropertyLiveThumbnail(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -607,13 +693,13 @@ func (x *TabPage) SetPropertyLiveThumbnail(value bool) {
 // GetPropertyLiveThumbnail gets the "live-thumbnail" property.
 // Whether to enable live thumbnail for this page.
 //
-// When set to `TRUE`, the page's thumbnail in [class@TabOverview] will update
+// When set to `TRUE`, the page's thumbnail in TabOverview will update
 // immediately when the page is redrawn or resized.
 //
 // If it's set to `FALSE`, the thumbnail will only be live when the page is
 // selected, and otherwise it will be static and will only update when
-// [method@TabPage.invalidate_thumbnail] or
-// [method@TabView.invalidate_thumbnails] is called.
+// invalidate_thumbnail or
+// invalidate_thumbnails is called.
 func (x *TabPage) GetPropertyLiveThumbnail() bool {
 	var v gobject.Value
 	x.GetProperty("live-thumbnail", &v)
@@ -623,10 +709,10 @@ func (x *TabPage) GetPropertyLiveThumbnail() bool {
 // SetPropertyLoading sets the "loading" property.
 // Whether the page is loading.
 //
-// If set to `TRUE`, [class@TabBar] and [class@TabOverview] will display a
+// If set to `TRUE`, TabBar and TabOverview will display a
 // spinner in place of icon.
 //
-// If the page is pinned and [property@TabPage:indicator-icon] is set,
+// If the page is pinned and TabPage:indicator-icon is set,
 // loading status will not be visible with `AdwTabBar`.
 func (x *TabPage) SetPropertyLoading(value bool) {
 	var v gobject.Value
@@ -638,10 +724,10 @@ func (x *TabPage) SetPropertyLoading(value bool) {
 // GetPropertyLoading gets the "loading" property.
 // Whether the page is loading.
 //
-// If set to `TRUE`, [class@TabBar] and [class@TabOverview] will display a
+// If set to `TRUE`, TabBar and TabOverview will display a
 // spinner in place of icon.
 //
-// If the page is pinned and [property@TabPage:indicator-icon] is set,
+// If the page is pinned and TabPage:indicator-icon is set,
 // loading status will not be visible with `AdwTabBar`.
 func (x *TabPage) GetPropertyLoading() bool {
 	var v gobject.Value
@@ -652,14 +738,14 @@ func (x *TabPage) GetPropertyLoading() bool {
 // SetPropertyNeedsAttention sets the "needs-attention" property.
 // Whether the page needs attention.
 //
-// [class@TabBar] will display a line under the tab representing the page if
+// TabBar will display a line under the tab representing the page if
 // set to `TRUE`. If the tab is not visible, the corresponding edge of the tab
 // bar will be highlighted.
 //
-// [class@TabOverview] will display a dot in the corner of the thumbnail if set
+// TabOverview will display a dot in the corner of the thumbnail if set
 // to `TRUE`.
 //
-// [class@TabButton] will display a dot if any of the pages that aren't
+// TabButton will display a dot if any of the pages that aren't
 // selected have this property set to `TRUE`.
 func (x *TabPage) SetPropertyNeedsAttention(value bool) {
 	var v gobject.Value
@@ -671,14 +757,14 @@ func (x *TabPage) SetPropertyNeedsAttention(value bool) {
 // GetPropertyNeedsAttention gets the "needs-attention" property.
 // Whether the page needs attention.
 //
-// [class@TabBar] will display a line under the tab representing the page if
+// TabBar will display a line under the tab representing the page if
 // set to `TRUE`. If the tab is not visible, the corresponding edge of the tab
 // bar will be highlighted.
 //
-// [class@TabOverview] will display a dot in the corner of the thumbnail if set
+// TabOverview will display a dot in the corner of the thumbnail if set
 // to `TRUE`.
 //
-// [class@TabButton] will display a dot if any of the pages that aren't
+// TabButton will display a dot if any of the pages that aren't
 // selected have this property set to `TRUE`.
 func (x *TabPage) GetPropertyNeedsAttention() bool {
 	var v gobject.Value
@@ -689,7 +775,7 @@ func (x *TabPage) GetPropertyNeedsAttention() bool {
 // GetPropertyPinned gets the "pinned" property.
 // Whether the page is pinned.
 //
-// See [method@TabView.set_page_pinned].
+// See set_page_pinned.
 func (x *TabPage) GetPropertyPinned() bool {
 	var v gobject.Value
 	x.GetProperty("pinned", &v)
@@ -707,7 +793,7 @@ func (x *TabPage) GetPropertySelected() bool {
 // SetPropertyThumbnailXalign sets the "thumbnail-xalign" property.
 // The horizontal alignment of the page thumbnail.
 //
-// If the page is so wide that [class@TabOverview] can't display it completely
+// If the page is so wide that TabOverview can't display it completely
 // and has to crop it, horizontal alignment will determine which part of the
 // page will be visible.
 //
@@ -725,7 +811,7 @@ func (x *TabPage) SetPropertyThumbnailXalign(value float32) {
 // GetPropertyThumbnailXalign gets the "thumbnail-xalign" property.
 // The horizontal alignment of the page thumbnail.
 //
-// If the page is so wide that [class@TabOverview] can't display it completely
+// If the page is so wide that TabOverview can't display it completely
 // and has to crop it, horizontal alignment will determine which part of the
 // page will be visible.
 //
@@ -742,7 +828,7 @@ func (x *TabPage) GetPropertyThumbnailXalign() float32 {
 // SetPropertyThumbnailYalign sets the "thumbnail-yalign" property.
 // The vertical alignment of the page thumbnail.
 //
-// If the page is so tall that [class@TabOverview] can't display it completely
+// If the page is so tall that TabOverview can't display it completely
 // and has to crop it, vertical alignment will determine which part of the
 // page will be visible.
 //
@@ -760,7 +846,7 @@ func (x *TabPage) SetPropertyThumbnailYalign(value float32) {
 // GetPropertyThumbnailYalign gets the "thumbnail-yalign" property.
 // The vertical alignment of the page thumbnail.
 //
-// If the page is so tall that [class@TabOverview] can't display it completely
+// If the page is so tall that TabOverview can't display it completely
 // and has to crop it, vertical alignment will determine which part of the
 // page will be visible.
 //
@@ -777,12 +863,12 @@ func (x *TabPage) GetPropertyThumbnailYalign() float32 {
 // SetPropertyTitle sets the "title" property.
 // The title of the page.
 //
-// [class@TabBar] will display it in the center of the tab unless it's pinned,
-// and will use it as a tooltip unless [property@TabPage:tooltip] is set.
+// TabBar will display it in the center of the tab unless it's pinned,
+// and will use it as a tooltip unless TabPage:tooltip is set.
 //
-// [class@TabOverview] will display it below the thumbnail unless it's pinned,
+// TabOverview will display it below the thumbnail unless it's pinned,
 // or inside the card otherwise, and will use it as a tooltip unless
-// [property@TabPage:tooltip] is set.
+// TabPage:tooltip is set.
 func (x *TabPage) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -793,12 +879,12 @@ func (x *TabPage) SetPropertyTitle(value string) {
 // GetPropertyTitle gets the "title" property.
 // The title of the page.
 //
-// [class@TabBar] will display it in the center of the tab unless it's pinned,
-// and will use it as a tooltip unless [property@TabPage:tooltip] is set.
+// TabBar will display it in the center of the tab unless it's pinned,
+// and will use it as a tooltip unless TabPage:tooltip is set.
 //
-// [class@TabOverview] will display it below the thumbnail unless it's pinned,
+// TabOverview will display it below the thumbnail unless it's pinned,
 // or inside the card otherwise, and will use it as a tooltip unless
-// [property@TabPage:tooltip] is set.
+// TabPage:tooltip is set.
 func (x *TabPage) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -810,8 +896,8 @@ func (x *TabPage) GetPropertyTitle() string {
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// If not set, [class@TabBar] and [class@TabOverview] will use
-// [property@TabPage:title] as a tooltip instead.
+// If not set, TabBar and TabOverview will use
+// TabPage:title as a tooltip instead.
 func (x *TabPage) SetPropertyTooltip(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -824,8 +910,8 @@ func (x *TabPage) SetPropertyTooltip(value string) {
 //
 // The tooltip can be marked up with the Pango text markup language.
 //
-// If not set, [class@TabBar] and [class@TabOverview] will use
-// [property@TabPage:title] as a tooltip instead.
+// If not set, TabBar and TabOverview will use
+// TabPage:title as a tooltip instead.
 func (x *TabPage) GetPropertyTooltip() string {
 	var v gobject.Value
 	x.GetProperty("tooltip", &v)
@@ -993,7 +1079,7 @@ func (x *TabPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1001,14 +1087,13 @@ func (x *TabPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TabPage) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1032,19 +1117,17 @@ func (x *TabPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TabPage) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1065,7 +1148,7 @@ func (x *TabPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1074,14 +1157,12 @@ func (x *TabPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TabPage) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1105,41 +1186,37 @@ func (x *TabPage) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 // `AdwTabView` is a container which shows one child at a time. While it
 // provides keyboard shortcuts for switching between pages, it does not provide
 // a visible tab switcher and relies on external widgets for that, such as
-// [class@TabBar], [class@TabOverview] and [class@TabButton].
+// TabBar, TabOverview and TabButton.
 //
-// `AdwTabView` maintains a [class@TabPage] object for each page, which holds
+// `AdwTabView` maintains a TabPage object for each page, which holds
 // additional per-page properties. You can obtain the `AdwTabPage` for a page
-// with [method@TabView.get_page], and as the return value for
-// [method@TabView.append] and other functions for adding children.
+// with get_page, and as the return value for
+// append and other functions for adding children.
 //
 // `AdwTabView` only aims to be useful for dynamic tabs in multi-window
 // document-based applications, such as web browsers, file managers, text
-// editors or terminals. It does not aim to replace [class@Gtk.Notebook] for use
+// editors or terminals. It does not aim to replace [gtk.Notebook] for use
 // cases such as tabbed dialogs.
 //
 // As such, it does not support disabling page reordering or detaching.
 //
 // `AdwTabView` adds a number of global page switching and reordering shortcuts.
-// The [property@TabView:shortcuts] property can be used to manage them.
+// The TabView:shortcuts property can be used to manage them.
 //
-// See [flags@TabViewShortcuts] for the list of the available shortcuts. All of
+// See TabViewShortcuts for the list of the available shortcuts. All of
 // the shortcuts are enabled by default.
 //
-// [method@TabView.add_shortcuts] and [method@TabView.remove_shortcuts] can be
+// add_shortcuts and remove_shortcuts can be
 // used to manage shortcuts in a convenient way, for example:
 //
-// ```c
-// adw_tab_view_remove_shortcuts (view, ADW_TAB_VIEW_SHORTCUT_CONTROL_HOME |
+//	adw_tab_view_remove_shortcuts (view, ADW_TAB_VIEW_SHORTCUT_CONTROL_HOME |
+//	                                     ADW_TAB_VIEW_SHORTCUT_CONTROL_END);
 //
-//	ADW_TAB_VIEW_SHORTCUT_CONTROL_END);
-//
-// ```
-//
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwTabView` has a main CSS node with the name `tabview`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwTabView` uses the `GTK_ACCESSIBLE_ROLE_TAB_PANEL` for the tab pages which
 // are the accessible parent objects of the child widgets.
@@ -1178,13 +1255,13 @@ func NewTabView() *TabView {
 
 var xTabViewAddPage func(uintptr, uintptr, uintptr) uintptr
 
-// Adds @child to @self with @parent as the parent.
+// Adds child to self with parent as the parent.
 //
 // This function can be used to automatically position new pages, and to select
 // the correct page when this page is closed while being selected (see
-// [method@TabView.close_page]).
+// close_page).
 //
-// If @parent is `NULL`, this function is equivalent to [method@TabView.append].
+// If parent is `NULL`, this function is equivalent to append.
 func (x *TabView) AddPage(ChildVar *gtk.Widget, ParentVar *TabPage) *TabPage {
 	var cls *TabPage
 
@@ -1201,9 +1278,9 @@ func (x *TabView) AddPage(ChildVar *gtk.Widget, ParentVar *TabPage) *TabPage {
 
 var xTabViewAddShortcuts func(uintptr, TabViewShortcuts)
 
-// Adds @shortcuts for @self.
+// Adds shortcuts for self.
 //
-// See [property@TabView:shortcuts] for details.
+// See TabView:shortcuts for details.
 func (x *TabView) AddShortcuts(ShortcutsVar TabViewShortcuts) {
 
 	xTabViewAddShortcuts(x.GoPointer(), ShortcutsVar)
@@ -1212,7 +1289,7 @@ func (x *TabView) AddShortcuts(ShortcutsVar TabViewShortcuts) {
 
 var xTabViewAppend func(uintptr, uintptr) uintptr
 
-// Inserts @child as the last non-pinned page.
+// Inserts child as the last non-pinned page.
 func (x *TabView) Append(ChildVar *gtk.Widget) *TabPage {
 	var cls *TabPage
 
@@ -1229,7 +1306,7 @@ func (x *TabView) Append(ChildVar *gtk.Widget) *TabPage {
 
 var xTabViewAppendPinned func(uintptr, uintptr) uintptr
 
-// Inserts @child as the last pinned page.
+// Inserts child as the last pinned page.
 func (x *TabView) AppendPinned(ChildVar *gtk.Widget) *TabPage {
 	var cls *TabPage
 
@@ -1246,7 +1323,7 @@ func (x *TabView) AppendPinned(ChildVar *gtk.Widget) *TabPage {
 
 var xTabViewCloseOtherPages func(uintptr, uintptr)
 
-// Requests to close all pages other than @page.
+// Requests to close all pages other than page.
 func (x *TabView) CloseOtherPages(PageVar *TabPage) {
 
 	xTabViewCloseOtherPages(x.GoPointer(), PageVar.GoPointer())
@@ -1255,22 +1332,22 @@ func (x *TabView) CloseOtherPages(PageVar *TabPage) {
 
 var xTabViewClosePage func(uintptr, uintptr)
 
-// Requests to close @page.
+// Requests to close page.
 //
-// Calling this function will result in the [signal@TabView::close-page] signal
-// being emitted for @page. Closing the page can then be confirmed or
-// denied via [method@TabView.close_page_finish].
+// Calling this function will result in the TabView::close-page signal
+// being emitted for page. Closing the page can then be confirmed or
+// denied via close_page_finish.
 //
-// If the page is waiting for a [method@TabView.close_page_finish] call, this
+// If the page is waiting for a close_page_finish call, this
 // function will do nothing.
 //
-// The default handler for [signal@TabView::close-page] will immediately confirm
+// The default handler for TabView::close-page will immediately confirm
 // closing the page if it's non-pinned, or reject it if it's pinned. This
 // behavior can be changed by registering your own handler for that signal.
 //
-// If @page was selected, another page will be selected instead:
+// If page was selected, another page will be selected instead:
 //
-// If the [property@TabPage:parent] value is `NULL`, the next page will be
+// If the TabPage:parent value is `NULL`, the next page will be
 // selected when possible, or if the page was already last, the previous page
 // will be selected instead.
 //
@@ -1285,14 +1362,14 @@ func (x *TabView) ClosePage(PageVar *TabPage) {
 
 var xTabViewClosePageFinish func(uintptr, uintptr, bool)
 
-// Completes a [method@TabView.close_page] call for @page.
+// Completes a close_page call for page.
 //
-// If @confirm is `TRUE`, @page will be closed. If it's `FALSE`, it will be
-// reverted to its previous state and [method@TabView.close_page] can be called
+// If confirm is `TRUE`, page will be closed. If it's `FALSE`, it will be
+// reverted to its previous state and close_page can be called
 // for it again.
 //
 // This function should not be called unless a custom handler for
-// [signal@TabView::close-page] is used.
+// TabView::close-page is used.
 func (x *TabView) ClosePageFinish(PageVar *TabPage, ConfirmVar bool) {
 
 	xTabViewClosePageFinish(x.GoPointer(), PageVar.GoPointer(), ConfirmVar)
@@ -1301,7 +1378,7 @@ func (x *TabView) ClosePageFinish(PageVar *TabPage, ConfirmVar bool) {
 
 var xTabViewClosePagesAfter func(uintptr, uintptr)
 
-// Requests to close all pages after @page.
+// Requests to close all pages after page.
 func (x *TabView) ClosePagesAfter(PageVar *TabPage) {
 
 	xTabViewClosePagesAfter(x.GoPointer(), PageVar.GoPointer())
@@ -1310,7 +1387,7 @@ func (x *TabView) ClosePagesAfter(PageVar *TabPage) {
 
 var xTabViewClosePagesBefore func(uintptr, uintptr)
 
-// Requests to close all pages before @page.
+// Requests to close all pages before page.
 func (x *TabView) ClosePagesBefore(PageVar *TabPage) {
 
 	xTabViewClosePagesBefore(x.GoPointer(), PageVar.GoPointer())
@@ -1319,7 +1396,7 @@ func (x *TabView) ClosePagesBefore(PageVar *TabPage) {
 
 var xTabViewGetDefaultIcon func(uintptr) uintptr
 
-// Gets the default icon of @self.
+// Gets the default icon of self.
 func (x *TabView) GetDefaultIcon() *gio.IconBase {
 	var cls *gio.IconBase
 
@@ -1351,7 +1428,7 @@ func (x *TabView) GetIsTransferringPage() bool {
 
 var xTabViewGetMenuModel func(uintptr) uintptr
 
-// Gets the tab context menu model for @self.
+// Gets the tab context menu model for self.
 func (x *TabView) GetMenuModel() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -1368,7 +1445,7 @@ func (x *TabView) GetMenuModel() *gio.MenuModel {
 
 var xTabViewGetNPages func(uintptr) int
 
-// Gets the number of pages in @self.
+// Gets the number of pages in self.
 func (x *TabView) GetNPages() int {
 
 	cret := xTabViewGetNPages(x.GoPointer())
@@ -1377,9 +1454,9 @@ func (x *TabView) GetNPages() int {
 
 var xTabViewGetNPinnedPages func(uintptr) int
 
-// Gets the number of pinned pages in @self.
+// Gets the number of pinned pages in self.
 //
-// See [method@TabView.set_page_pinned].
+// See set_page_pinned.
 func (x *TabView) GetNPinnedPages() int {
 
 	cret := xTabViewGetNPinnedPages(x.GoPointer())
@@ -1388,7 +1465,7 @@ func (x *TabView) GetNPinnedPages() int {
 
 var xTabViewGetNthPage func(uintptr, int) uintptr
 
-// Gets the [class@TabPage] representing the child at @position.
+// Gets the TabPage representing the child at position.
 func (x *TabView) GetNthPage(PositionVar int) *TabPage {
 	var cls *TabPage
 
@@ -1405,7 +1482,7 @@ func (x *TabView) GetNthPage(PositionVar int) *TabPage {
 
 var xTabViewGetPage func(uintptr, uintptr) uintptr
 
-// Gets the [class@TabPage] object representing @child.
+// Gets the TabPage object representing child.
 func (x *TabView) GetPage(ChildVar *gtk.Widget) *TabPage {
 	var cls *TabPage
 
@@ -1422,7 +1499,7 @@ func (x *TabView) GetPage(ChildVar *gtk.Widget) *TabPage {
 
 var xTabViewGetPagePosition func(uintptr, uintptr) int
 
-// Finds the position of @page in @self, starting from 0.
+// Finds the position of page in self, starting from 0.
 func (x *TabView) GetPagePosition(PageVar *TabPage) int {
 
 	cret := xTabViewGetPagePosition(x.GoPointer(), PageVar.GoPointer())
@@ -1431,10 +1508,10 @@ func (x *TabView) GetPagePosition(PageVar *TabPage) int {
 
 var xTabViewGetPages func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the pages of @self.
+// Returns a [gio.ListModel] that contains the pages of self.
 //
 // This can be used to keep an up-to-date view. The model also implements
-// [iface@Gtk.SelectionModel] and can be used to track and change the selected
+// [gtk.SelectionModel] and can be used to track and change the selected
 // page.
 func (x *TabView) GetPages() *gtk.SelectionModelBase {
 	var cls *gtk.SelectionModelBase
@@ -1451,7 +1528,7 @@ func (x *TabView) GetPages() *gtk.SelectionModelBase {
 
 var xTabViewGetSelectedPage func(uintptr) uintptr
 
-// Gets the currently selected page in @self.
+// Gets the currently selected page in self.
 func (x *TabView) GetSelectedPage() *TabPage {
 	var cls *TabPage
 
@@ -1468,7 +1545,7 @@ func (x *TabView) GetSelectedPage() *TabPage {
 
 var xTabViewGetShortcuts func(uintptr) TabViewShortcuts
 
-// Gets the enabled shortcuts for @self.
+// Gets the enabled shortcuts for self.
 func (x *TabView) GetShortcuts() TabViewShortcuts {
 
 	cret := xTabViewGetShortcuts(x.GoPointer())
@@ -1477,10 +1554,10 @@ func (x *TabView) GetShortcuts() TabViewShortcuts {
 
 var xTabViewInsert func(uintptr, uintptr, int) uintptr
 
-// Inserts a non-pinned page at @position.
+// Inserts a non-pinned page at position.
 //
 // It's an error to try to insert a page before a pinned page, in that case
-// [method@TabView.insert_pinned] should be used instead.
+// insert_pinned should be used instead.
 func (x *TabView) Insert(ChildVar *gtk.Widget, PositionVar int) *TabPage {
 	var cls *TabPage
 
@@ -1497,10 +1574,10 @@ func (x *TabView) Insert(ChildVar *gtk.Widget, PositionVar int) *TabPage {
 
 var xTabViewInsertPinned func(uintptr, uintptr, int) uintptr
 
-// Inserts a pinned page at @position.
+// Inserts a pinned page at position.
 //
 // It's an error to try to insert a pinned page after a non-pinned page, in
-// that case [method@TabView.insert] should be used instead.
+// that case insert should be used instead.
 func (x *TabView) InsertPinned(ChildVar *gtk.Widget, PositionVar int) *TabPage {
 	var cls *TabPage
 
@@ -1517,10 +1594,10 @@ func (x *TabView) InsertPinned(ChildVar *gtk.Widget, PositionVar int) *TabPage {
 
 var xTabViewInvalidateThumbnails func(uintptr)
 
-// Invalidates thumbnails for all pages in @self.
+// Invalidates thumbnails for all pages in self.
 //
 // This is a convenience method, equivalent to calling
-// [method@TabPage.invalidate_thumbnail] on each page.
+// invalidate_thumbnail on each page.
 func (x *TabView) InvalidateThumbnails() {
 
 	xTabViewInvalidateThumbnails(x.GoPointer())
@@ -1529,7 +1606,7 @@ func (x *TabView) InvalidateThumbnails() {
 
 var xTabViewPrepend func(uintptr, uintptr) uintptr
 
-// Inserts @child as the first non-pinned page.
+// Inserts child as the first non-pinned page.
 func (x *TabView) Prepend(ChildVar *gtk.Widget) *TabPage {
 	var cls *TabPage
 
@@ -1546,7 +1623,7 @@ func (x *TabView) Prepend(ChildVar *gtk.Widget) *TabPage {
 
 var xTabViewPrependPinned func(uintptr, uintptr) uintptr
 
-// Inserts @child as the first pinned page.
+// Inserts child as the first pinned page.
 func (x *TabView) PrependPinned(ChildVar *gtk.Widget) *TabPage {
 	var cls *TabPage
 
@@ -1563,9 +1640,9 @@ func (x *TabView) PrependPinned(ChildVar *gtk.Widget) *TabPage {
 
 var xTabViewRemoveShortcuts func(uintptr, TabViewShortcuts)
 
-// Removes @shortcuts from @self.
+// Removes shortcuts from self.
 //
-// See [property@TabView:shortcuts] for details.
+// See TabView:shortcuts for details.
 func (x *TabView) RemoveShortcuts(ShortcutsVar TabViewShortcuts) {
 
 	xTabViewRemoveShortcuts(x.GoPointer(), ShortcutsVar)
@@ -1574,7 +1651,7 @@ func (x *TabView) RemoveShortcuts(ShortcutsVar TabViewShortcuts) {
 
 var xTabViewReorderBackward func(uintptr, uintptr) bool
 
-// Reorders @page to before its previous page if possible.
+// Reorders page to before its previous page if possible.
 func (x *TabView) ReorderBackward(PageVar *TabPage) bool {
 
 	cret := xTabViewReorderBackward(x.GoPointer(), PageVar.GoPointer())
@@ -1583,7 +1660,7 @@ func (x *TabView) ReorderBackward(PageVar *TabPage) bool {
 
 var xTabViewReorderFirst func(uintptr, uintptr) bool
 
-// Reorders @page to the first possible position.
+// Reorders page to the first possible position.
 func (x *TabView) ReorderFirst(PageVar *TabPage) bool {
 
 	cret := xTabViewReorderFirst(x.GoPointer(), PageVar.GoPointer())
@@ -1592,7 +1669,7 @@ func (x *TabView) ReorderFirst(PageVar *TabPage) bool {
 
 var xTabViewReorderForward func(uintptr, uintptr) bool
 
-// Reorders @page to after its next page if possible.
+// Reorders page to after its next page if possible.
 func (x *TabView) ReorderForward(PageVar *TabPage) bool {
 
 	cret := xTabViewReorderForward(x.GoPointer(), PageVar.GoPointer())
@@ -1601,7 +1678,7 @@ func (x *TabView) ReorderForward(PageVar *TabPage) bool {
 
 var xTabViewReorderLast func(uintptr, uintptr) bool
 
-// Reorders @page to the last possible position.
+// Reorders page to the last possible position.
 func (x *TabView) ReorderLast(PageVar *TabPage) bool {
 
 	cret := xTabViewReorderLast(x.GoPointer(), PageVar.GoPointer())
@@ -1610,7 +1687,7 @@ func (x *TabView) ReorderLast(PageVar *TabPage) bool {
 
 var xTabViewReorderPage func(uintptr, uintptr, int) bool
 
-// Reorders @page to @position.
+// Reorders page to position.
 //
 // It's a programmer error to try to reorder a pinned page after a non-pinned
 // one, or a non-pinned page before a pinned one.
@@ -1644,16 +1721,16 @@ func (x *TabView) SelectPreviousPage() bool {
 
 var xTabViewSetDefaultIcon func(uintptr, uintptr)
 
-// Sets the default page icon for @self.
+// Sets the default page icon for self.
 //
-// If a page doesn't provide its own icon via [property@TabPage:icon], a default
+// If a page doesn't provide its own icon via TabPage:icon, a default
 // icon may be used instead for contexts where having an icon is necessary.
 //
-// [class@TabBar] will use default icon for pinned tabs in case the page is not
+// TabBar will use default icon for pinned tabs in case the page is not
 // loading, doesn't have an icon and an indicator. Default icon is never used
 // for tabs that aren't pinned.
 //
-// [class@TabOverview] will use default icon for pages with missing thumbnails.
+// TabOverview will use default icon for pages with missing thumbnails.
 //
 // By default, the `adw-tab-icon-missing-symbolic` icon is used.
 func (x *TabView) SetDefaultIcon(DefaultIconVar gio.Icon) {
@@ -1664,10 +1741,10 @@ func (x *TabView) SetDefaultIcon(DefaultIconVar gio.Icon) {
 
 var xTabViewSetMenuModel func(uintptr, uintptr)
 
-// Sets the tab context menu model for @self.
+// Sets the tab context menu model for self.
 //
 // When a context menu is shown for a tab, it will be constructed from the
-// provided menu model. Use the [signal@TabView::setup-menu] signal to set up
+// provided menu model. Use the TabView::setup-menu signal to set up
 // the menu actions for the particular tab.
 func (x *TabView) SetMenuModel(MenuModelVar *gio.MenuModel) {
 
@@ -1677,10 +1754,10 @@ func (x *TabView) SetMenuModel(MenuModelVar *gio.MenuModel) {
 
 var xTabViewSetPagePinned func(uintptr, uintptr, bool)
 
-// Pins or unpins @page.
+// Pins or unpins page.
 //
 // Pinned pages are guaranteed to be placed before all non-pinned pages; at any
-// given moment the first [property@TabView:n-pinned-pages] pages in @self are
+// given moment the first TabView:n-pinned-pages pages in self are
 // guaranteed to be pinned.
 //
 // When a page is pinned or unpinned, it's automatically reordered: pinning a
@@ -1689,23 +1766,23 @@ var xTabViewSetPagePinned func(uintptr, uintptr, bool)
 //
 // Pinned pages can still be reordered between each other.
 //
-// [class@TabBar] will display pinned pages in a compact form, never showing the
+// TabBar will display pinned pages in a compact form, never showing the
 // title or close button, and only showing a single icon, selected in the
 // following order:
 //
-// 1. [property@TabPage:indicator-icon]
-// 2. A spinner if [property@TabPage:loading] is `TRUE`
-// 3. [property@TabPage:icon]
-// 4. [property@TabView:default-icon]
+// 1. TabPage:indicator-icon
+// 2. A spinner if TabPage:loading is `TRUE`
+// 3. TabPage:icon
+// 4. TabView:default-icon
 //
-// [class@TabOverview] will not show a thumbnail for pinned pages, and replace
+// TabOverview will not show a thumbnail for pinned pages, and replace
 // the close button with an unpin button. Unlike `AdwTabBar`, it will still
 // display the page's title, icon and indicator separately.
 //
-// Pinned pages cannot be closed by default, see [signal@TabView::close-page]
+// Pinned pages cannot be closed by default, see TabView::close-page
 // for how to override that behavior.
 //
-// Changes the value of the [property@TabPage:pinned] property.
+// Changes the value of the TabPage:pinned property.
 func (x *TabView) SetPagePinned(PageVar *TabPage, PinnedVar bool) {
 
 	xTabViewSetPagePinned(x.GoPointer(), PageVar.GoPointer(), PinnedVar)
@@ -1714,7 +1791,7 @@ func (x *TabView) SetPagePinned(PageVar *TabPage, PinnedVar bool) {
 
 var xTabViewSetSelectedPage func(uintptr, uintptr)
 
-// Sets the currently selected page in @self.
+// Sets the currently selected page in self.
 func (x *TabView) SetSelectedPage(SelectedPageVar *TabPage) {
 
 	xTabViewSetSelectedPage(x.GoPointer(), SelectedPageVar.GoPointer())
@@ -1723,12 +1800,12 @@ func (x *TabView) SetSelectedPage(SelectedPageVar *TabPage) {
 
 var xTabViewSetShortcuts func(uintptr, TabViewShortcuts)
 
-// Sets the enabled shortcuts for @self.
+// Sets the enabled shortcuts for self.
 //
-// See [flags@TabViewShortcuts] for the list of the available shortcuts. All of
+// See TabViewShortcuts for the list of the available shortcuts. All of
 // the shortcuts are enabled by default.
 //
-// [method@TabView.add_shortcuts] and [method@TabView.remove_shortcuts] provide
+// add_shortcuts and remove_shortcuts provide
 // a convenient way to manage individual shortcuts.
 func (x *TabView) SetShortcuts(ShortcutsVar TabViewShortcuts) {
 
@@ -1738,9 +1815,9 @@ func (x *TabView) SetShortcuts(ShortcutsVar TabViewShortcuts) {
 
 var xTabViewTransferPage func(uintptr, uintptr, uintptr, int)
 
-// Transfers @page from @self to @other_view.
+// Transfers page from self to other_view.
 //
-// The @page object will be reused.
+// The page object will be reused.
 //
 // It's a programmer error to try to insert a pinned page after a non-pinned
 // one, or a non-pinned page before a pinned one.
@@ -1786,37 +1863,32 @@ func (x *TabView) GetPropertyNPages() int {
 // GetPropertyNPinnedPages gets the "n-pinned-pages" property.
 // The number of pinned pages in the tab view.
 //
-// See [method@TabView.set_page_pinned].
+// See set_page_pinned.
 func (x *TabView) GetPropertyNPinnedPages() int {
 	var v gobject.Value
 	x.GetProperty("n-pinned-pages", &v)
 	return v.GetInt()
 }
 
-// Emitted after [method@TabView.close_page] has been called for @page.
+// Emitted after close_page has been called for page.
 //
-// The handler is expected to call [method@TabView.close_page_finish] to
+// The handler is expected to call close_page_finish to
 // confirm or reject the closing.
 //
 // The default handler will immediately confirm closing for non-pinned pages,
 // or reject it for pinned pages, equivalent to the following example:
 //
-// ```c
-// static gboolean
-// close_page_cb (AdwTabView *view,
-//
-//	AdwTabPage *page,
-//	gpointer    user_data)
-//
+//	static gboolean
+//	close_page_cb (AdwTabView *view,
+//	               AdwTabPage *page,
+//	               gpointer    user_data)
 //	{
 //	  adw_tab_view_close_page_finish (view, page, !adw_tab_page_get_pinned (page));
 //
 //	  return GDK_EVENT_STOP;
 //	}
 //
-// ```
-//
-// The [method@TabView.close_page_finish] call doesn't have to happen inside
+// The close_page_finish call doesn't have to happen inside
 // the handler, so can be used to do asynchronous checks before confirming the
 // closing.
 //
@@ -1825,27 +1897,49 @@ func (x *TabView) GetPropertyNPinnedPages() int {
 //
 // The signal handler should return `GDK_EVENT_STOP` to stop propagation or
 // `GDK_EVENT_CONTINUE` to invoke the default handler.
+//
+// Deprecated: use ConnectClosePageFunc, which also accepts method values and closures.
 func (x *TabView) ConnectClosePage(cb *func(TabView, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close-page", cbRefPtr, cbPtr)
+}
+
+var xTabViewClosePageTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) bool {
+func xTabViewClosePageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClosePageFunc connects cb to the "close-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectClosePageFunc(cb func(TabView, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close-page", &xTabViewClosePageTrampoline, xTabViewClosePageNewTrampoline, cb)
 }
 
 // Emitted when a tab should be transferred into a new window.
@@ -1854,167 +1948,300 @@ func (x *TabView) ConnectClosePage(cb *func(TabView, uintptr) bool) uint {
 //
 // The signal handler is expected to create a new window, position it as
 // needed and return its `AdwTabView` that the page will be transferred into.
+//
+// Deprecated: use ConnectCreateWindowFunc, which also accepts method values and closures.
 func (x *TabView) ConnectCreateWindow(cb *func(TabView) TabView) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "create-window", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			CreateWindowCls := cbFn(fa)
+			return CreateWindowCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "create-window", cbRefPtr, cbPtr)
+}
+
+var xTabViewCreateWindowTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) uintptr {
+func xTabViewCreateWindowNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView) TabView)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		CreateWindowCls := cbFn(fa)
 		return CreateWindowCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "create-window", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted after the indicator icon on @page has been activated.
+// ConnectCreateWindowFunc connects cb to the "create-window" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectCreateWindowFunc(cb func(TabView) TabView) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "create-window", &xTabViewCreateWindowTrampoline, xTabViewCreateWindowNewTrampoline, cb)
+}
+
+// Emitted after the indicator icon on page has been activated.
 //
-// See [property@TabPage:indicator-icon] and
-// [property@TabPage:indicator-activatable].
+// See TabPage:indicator-icon and
+// TabPage:indicator-activatable.
+//
+// Deprecated: use ConnectIndicatorActivatedFunc, which also accepts method values and closures.
 func (x *TabView) ConnectIndicatorActivated(cb *func(TabView, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "indicator-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "indicator-activated", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) {
+var xTabViewIndicatorActivatedTrampoline uintptr
+
+func xTabViewIndicatorActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "indicator-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when a page has been created or transferred to @self.
+// ConnectIndicatorActivatedFunc connects cb to the "indicator-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectIndicatorActivatedFunc(cb func(TabView, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "indicator-activated", &xTabViewIndicatorActivatedTrampoline, xTabViewIndicatorActivatedNewTrampoline, cb)
+}
+
+// Emitted when a page has been created or transferred to self.
 //
 // A typical reason to connect to this signal would be to connect to page
 // signals for things such as updating window title.
+//
+// Deprecated: use ConnectPageAttachedFunc, which also accepts method values and closures.
 func (x *TabView) ConnectPageAttached(cb *func(TabView, uintptr, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-attached", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-attached", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+var xTabViewPageAttachedTrampoline uintptr
+
+func xTabViewPageAttachedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, PositionVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-attached", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPageAttachedFunc connects cb to the "page-attached" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectPageAttachedFunc(cb func(TabView, uintptr, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-attached", &xTabViewPageAttachedTrampoline, xTabViewPageAttachedNewTrampoline, cb)
 }
 
 // Emitted when a page has been removed or transferred to another view.
 //
 // A typical reason to connect to this signal would be to disconnect signal
-// handlers connected in the [signal@TabView::page-attached] handler.
+// handlers connected in the TabView::page-attached handler.
 //
 // It is important not to try and destroy the page child in the handler of
 // this function as the child might merely be moved to another window; use
 // child dispose handler for that or do it in sync with your
-// [method@TabView.close_page_finish] calls.
+// close_page_finish calls.
+//
+// Deprecated: use ConnectPageDetachedFunc, which also accepts method values and closures.
 func (x *TabView) ConnectPageDetached(cb *func(TabView, uintptr, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-detached", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-detached", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+var xTabViewPageDetachedTrampoline uintptr
+
+func xTabViewPageDetachedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, PositionVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-detached", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted after @page has been reordered to @position.
+// ConnectPageDetachedFunc connects cb to the "page-detached" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectPageDetachedFunc(cb func(TabView, uintptr, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-detached", &xTabViewPageDetachedTrampoline, xTabViewPageDetachedNewTrampoline, cb)
+}
+
+// Emitted after page has been reordered to position.
+//
+// Deprecated: use ConnectPageReorderedFunc, which also accepts method values and closures.
 func (x *TabView) ConnectPageReordered(cb *func(TabView, uintptr, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-reordered", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-reordered", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, PositionVarp int) {
+var xTabViewPageReorderedTrampoline uintptr
+
+func xTabViewPageReorderedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, PositionVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-reordered", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when a context menu is opened or closed for @page.
+// ConnectPageReorderedFunc connects cb to the "page-reordered" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectPageReorderedFunc(cb func(TabView, uintptr, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-reordered", &xTabViewPageReorderedTrampoline, xTabViewPageReorderedNewTrampoline, cb)
+}
+
+// Emitted when a context menu is opened or closed for page.
 //
-// If the menu has been closed, @page will be set to `NULL`.
+// If the menu has been closed, page will be set to `NULL`.
 //
 // It can be used to set up menu actions before showing the menu, for example
-// disable actions not applicable to @page.
+// disable actions not applicable to page.
+//
+// Deprecated: use ConnectSetupMenuFunc, which also accepts method values and closures.
 func (x *TabView) ConnectSetupMenu(cb *func(TabView, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "setup-menu", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TabView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "setup-menu", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) {
+var xTabViewSetupMenuTrampoline uintptr
+
+func xTabViewSetupMenuNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TabView, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TabView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "setup-menu", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSetupMenuFunc connects cb to the "setup-menu" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TabView) ConnectSetupMenuFunc(cb func(TabView, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "setup-menu", &xTabViewSetupMenuTrampoline, xTabViewSetupMenuNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -2178,7 +2405,7 @@ func (x *TabView) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -2186,14 +2413,13 @@ func (x *TabView) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TabView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -2217,19 +2443,17 @@ func (x *TabView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TabView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -2250,7 +2474,7 @@ func (x *TabView) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -2259,14 +2483,12 @@ func (x *TabView) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TabView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -2285,10 +2507,10 @@ func (x *TabView) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TabView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -2300,7 +2522,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2311,78 +2533,82 @@ func init() {
 
 	core.PuregoSafeRegister(&xTabPageGLibType, libs, "adw_tab_page_get_type")
 
-	core.PuregoSafeRegister(&xTabPageGetChild, libs, "adw_tab_page_get_child")
-	core.PuregoSafeRegister(&xTabPageGetIcon, libs, "adw_tab_page_get_icon")
-	core.PuregoSafeRegister(&xTabPageGetIndicatorActivatable, libs, "adw_tab_page_get_indicator_activatable")
-	core.PuregoSafeRegister(&xTabPageGetIndicatorIcon, libs, "adw_tab_page_get_indicator_icon")
-	core.PuregoSafeRegister(&xTabPageGetIndicatorTooltip, libs, "adw_tab_page_get_indicator_tooltip")
-	core.PuregoSafeRegister(&xTabPageGetKeyword, libs, "adw_tab_page_get_keyword")
-	core.PuregoSafeRegister(&xTabPageGetLiveThumbnail, libs, "adw_tab_page_get_live_thumbnail")
-	core.PuregoSafeRegister(&xTabPageGetLoading, libs, "adw_tab_page_get_loading")
-	core.PuregoSafeRegister(&xTabPageGetNeedsAttention, libs, "adw_tab_page_get_needs_attention")
-	core.PuregoSafeRegister(&xTabPageGetParent, libs, "adw_tab_page_get_parent")
-	core.PuregoSafeRegister(&xTabPageGetPinned, libs, "adw_tab_page_get_pinned")
-	core.PuregoSafeRegister(&xTabPageGetSelected, libs, "adw_tab_page_get_selected")
-	core.PuregoSafeRegister(&xTabPageGetThumbnailXalign, libs, "adw_tab_page_get_thumbnail_xalign")
-	core.PuregoSafeRegister(&xTabPageGetThumbnailYalign, libs, "adw_tab_page_get_thumbnail_yalign")
-	core.PuregoSafeRegister(&xTabPageGetTitle, libs, "adw_tab_page_get_title")
-	core.PuregoSafeRegister(&xTabPageGetTooltip, libs, "adw_tab_page_get_tooltip")
-	core.PuregoSafeRegister(&xTabPageInvalidateThumbnail, libs, "adw_tab_page_invalidate_thumbnail")
-	core.PuregoSafeRegister(&xTabPageSetIcon, libs, "adw_tab_page_set_icon")
-	core.PuregoSafeRegister(&xTabPageSetIndicatorActivatable, libs, "adw_tab_page_set_indicator_activatable")
-	core.PuregoSafeRegister(&xTabPageSetIndicatorIcon, libs, "adw_tab_page_set_indicator_icon")
-	core.PuregoSafeRegister(&xTabPageSetIndicatorTooltip, libs, "adw_tab_page_set_indicator_tooltip")
-	core.PuregoSafeRegister(&xTabPageSetKeyword, libs, "adw_tab_page_set_keyword")
-	core.PuregoSafeRegister(&xTabPageSetLiveThumbnail, libs, "adw_tab_page_set_live_thumbnail")
-	core.PuregoSafeRegister(&xTabPageSetLoading, libs, "adw_tab_page_set_loading")
-	core.PuregoSafeRegister(&xTabPageSetNeedsAttention, libs, "adw_tab_page_set_needs_attention")
-	core.PuregoSafeRegister(&xTabPageSetThumbnailXalign, libs, "adw_tab_page_set_thumbnail_xalign")
-	core.PuregoSafeRegister(&xTabPageSetThumbnailYalign, libs, "adw_tab_page_set_thumbnail_yalign")
-	core.PuregoSafeRegister(&xTabPageSetTitle, libs, "adw_tab_page_set_title")
-	core.PuregoSafeRegister(&xTabPageSetTooltip, libs, "adw_tab_page_set_tooltip")
+	gobject.CheckClassSize("AdwTabPageClass", xTabPageGLibType, unsafe.Sizeof(TabPageClass{}))
+
+	core.PuregoRegisterSince(&xTabPageGetChild, libs, "adw_tab_page_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetIcon, libs, "adw_tab_page_get_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetIndicatorActivatable, libs, "adw_tab_page_get_indicator_activatable", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetIndicatorIcon, libs, "adw_tab_page_get_indicator_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetIndicatorTooltip, libs, "adw_tab_page_get_indicator_tooltip", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabPageGetKeyword, libs, "adw_tab_page_get_keyword", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageGetLiveThumbnail, libs, "adw_tab_page_get_live_thumbnail", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageGetLoading, libs, "adw_tab_page_get_loading", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetNeedsAttention, libs, "adw_tab_page_get_needs_attention", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetParent, libs, "adw_tab_page_get_parent", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetPinned, libs, "adw_tab_page_get_pinned", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetSelected, libs, "adw_tab_page_get_selected", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetThumbnailXalign, libs, "adw_tab_page_get_thumbnail_xalign", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageGetThumbnailYalign, libs, "adw_tab_page_get_thumbnail_yalign", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageGetTitle, libs, "adw_tab_page_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageGetTooltip, libs, "adw_tab_page_get_tooltip", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageInvalidateThumbnail, libs, "adw_tab_page_invalidate_thumbnail", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageSetIcon, libs, "adw_tab_page_set_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetIndicatorActivatable, libs, "adw_tab_page_set_indicator_activatable", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetIndicatorIcon, libs, "adw_tab_page_set_indicator_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetIndicatorTooltip, libs, "adw_tab_page_set_indicator_tooltip", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabPageSetKeyword, libs, "adw_tab_page_set_keyword", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageSetLiveThumbnail, libs, "adw_tab_page_set_live_thumbnail", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageSetLoading, libs, "adw_tab_page_set_loading", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetNeedsAttention, libs, "adw_tab_page_set_needs_attention", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetThumbnailXalign, libs, "adw_tab_page_set_thumbnail_xalign", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageSetThumbnailYalign, libs, "adw_tab_page_set_thumbnail_yalign", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabPageSetTitle, libs, "adw_tab_page_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xTabPageSetTooltip, libs, "adw_tab_page_set_tooltip", "ADW", "")
 
 	core.PuregoSafeRegister(&xTabViewGLibType, libs, "adw_tab_view_get_type")
 
-	core.PuregoSafeRegister(&xNewTabView, libs, "adw_tab_view_new")
-
-	core.PuregoSafeRegister(&xTabViewAddPage, libs, "adw_tab_view_add_page")
-	core.PuregoSafeRegister(&xTabViewAddShortcuts, libs, "adw_tab_view_add_shortcuts")
-	core.PuregoSafeRegister(&xTabViewAppend, libs, "adw_tab_view_append")
-	core.PuregoSafeRegister(&xTabViewAppendPinned, libs, "adw_tab_view_append_pinned")
-	core.PuregoSafeRegister(&xTabViewCloseOtherPages, libs, "adw_tab_view_close_other_pages")
-	core.PuregoSafeRegister(&xTabViewClosePage, libs, "adw_tab_view_close_page")
-	core.PuregoSafeRegister(&xTabViewClosePageFinish, libs, "adw_tab_view_close_page_finish")
-	core.PuregoSafeRegister(&xTabViewClosePagesAfter, libs, "adw_tab_view_close_pages_after")
-	core.PuregoSafeRegister(&xTabViewClosePagesBefore, libs, "adw_tab_view_close_pages_before")
-	core.PuregoSafeRegister(&xTabViewGetDefaultIcon, libs, "adw_tab_view_get_default_icon")
-	core.PuregoSafeRegister(&xTabViewGetIsTransferringPage, libs, "adw_tab_view_get_is_transferring_page")
-	core.PuregoSafeRegister(&xTabViewGetMenuModel, libs, "adw_tab_view_get_menu_model")
-	core.PuregoSafeRegister(&xTabViewGetNPages, libs, "adw_tab_view_get_n_pages")
-	core.PuregoSafeRegister(&xTabViewGetNPinnedPages, libs, "adw_tab_view_get_n_pinned_pages")
-	core.PuregoSafeRegister(&xTabViewGetNthPage, libs, "adw_tab_view_get_nth_page")
-	core.PuregoSafeRegister(&xTabViewGetPage, libs, "adw_tab_view_get_page")
-	core.PuregoSafeRegister(&xTabViewGetPagePosition, libs, "adw_tab_view_get_page_position")
-	core.PuregoSafeRegister(&xTabViewGetPages, libs, "adw_tab_view_get_pages")
-	core.PuregoSafeRegister(&xTabViewGetSelectedPage, libs, "adw_tab_view_get_selected_page")
-	core.PuregoSafeRegister(&xTabViewGetShortcuts, libs, "adw_tab_view_get_shortcuts")
-	core.PuregoSafeRegister(&xTabViewInsert, libs, "adw_tab_view_insert")
-	core.PuregoSafeRegister(&xTabViewInsertPinned, libs, "adw_tab_view_insert_pinned")
-	core.PuregoSafeRegister(&xTabViewInvalidateThumbnails, libs, "adw_tab_view_invalidate_thumbnails")
-	core.PuregoSafeRegister(&xTabViewPrepend, libs, "adw_tab_view_prepend")
-	core.PuregoSafeRegister(&xTabViewPrependPinned, libs, "adw_tab_view_prepend_pinned")
-	core.PuregoSafeRegister(&xTabViewRemoveShortcuts, libs, "adw_tab_view_remove_shortcuts")
-	core.PuregoSafeRegister(&xTabViewReorderBackward, libs, "adw_tab_view_reorder_backward")
-	core.PuregoSafeRegister(&xTabViewReorderFirst, libs, "adw_tab_view_reorder_first")
-	core.PuregoSafeRegister(&xTabViewReorderForward, libs, "adw_tab_view_reorder_forward")
-	core.PuregoSafeRegister(&xTabViewReorderLast, libs, "adw_tab_view_reorder_last")
-	core.PuregoSafeRegister(&xTabViewReorderPage, libs, "adw_tab_view_reorder_page")
-	core.PuregoSafeRegister(&xTabViewSelectNextPage, libs, "adw_tab_view_select_next_page")
-	core.PuregoSafeRegister(&xTabViewSelectPreviousPage, libs, "adw_tab_view_select_previous_page")
-	core.PuregoSafeRegister(&xTabViewSetDefaultIcon, libs, "adw_tab_view_set_default_icon")
-	core.PuregoSafeRegister(&xTabViewSetMenuModel, libs, "adw_tab_view_set_menu_model")
-	core.PuregoSafeRegister(&xTabViewSetPagePinned, libs, "adw_tab_view_set_page_pinned")
-	core.PuregoSafeRegister(&xTabViewSetSelectedPage, libs, "adw_tab_view_set_selected_page")
-	core.PuregoSafeRegister(&xTabViewSetShortcuts, libs, "adw_tab_view_set_shortcuts")
-	core.PuregoSafeRegister(&xTabViewTransferPage, libs, "adw_tab_view_transfer_page")
+	gobject.CheckClassSize("AdwTabViewClass", xTabViewGLibType, unsafe.Sizeof(TabViewClass{}))
+
+	core.PuregoRegisterSince(&xNewTabView, libs, "adw_tab_view_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xTabViewAddPage, libs, "adw_tab_view_add_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewAddShortcuts, libs, "adw_tab_view_add_shortcuts", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabViewAppend, libs, "adw_tab_view_append", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewAppendPinned, libs, "adw_tab_view_append_pinned", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewCloseOtherPages, libs, "adw_tab_view_close_other_pages", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewClosePage, libs, "adw_tab_view_close_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewClosePageFinish, libs, "adw_tab_view_close_page_finish", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewClosePagesAfter, libs, "adw_tab_view_close_pages_after", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewClosePagesBefore, libs, "adw_tab_view_close_pages_before", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetDefaultIcon, libs, "adw_tab_view_get_default_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetIsTransferringPage, libs, "adw_tab_view_get_is_transferring_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetMenuModel, libs, "adw_tab_view_get_menu_model", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetNPages, libs, "adw_tab_view_get_n_pages", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetNPinnedPages, libs, "adw_tab_view_get_n_pinned_pages", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetNthPage, libs, "adw_tab_view_get_nth_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetPage, libs, "adw_tab_view_get_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetPagePosition, libs, "adw_tab_view_get_page_position", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetPages, libs, "adw_tab_view_get_pages", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetSelectedPage, libs, "adw_tab_view_get_selected_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewGetShortcuts, libs, "adw_tab_view_get_shortcuts", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabViewInsert, libs, "adw_tab_view_insert", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewInsertPinned, libs, "adw_tab_view_insert_pinned", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewInvalidateThumbnails, libs, "adw_tab_view_invalidate_thumbnails", "ADW", "1.3")
+	core.PuregoRegisterSince(&xTabViewPrepend, libs, "adw_tab_view_prepend", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewPrependPinned, libs, "adw_tab_view_prepend_pinned", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewRemoveShortcuts, libs, "adw_tab_view_remove_shortcuts", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabViewReorderBackward, libs, "adw_tab_view_reorder_backward", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewReorderFirst, libs, "adw_tab_view_reorder_first", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewReorderForward, libs, "adw_tab_view_reorder_forward", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewReorderLast, libs, "adw_tab_view_reorder_last", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewReorderPage, libs, "adw_tab_view_reorder_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSelectNextPage, libs, "adw_tab_view_select_next_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSelectPreviousPage, libs, "adw_tab_view_select_previous_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSetDefaultIcon, libs, "adw_tab_view_set_default_icon", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSetMenuModel, libs, "adw_tab_view_set_menu_model", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSetPagePinned, libs, "adw_tab_view_set_page_pinned", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSetSelectedPage, libs, "adw_tab_view_set_selected_page", "ADW", "")
+	core.PuregoRegisterSince(&xTabViewSetShortcuts, libs, "adw_tab_view_set_shortcuts", "ADW", "1.2")
+	core.PuregoRegisterSince(&xTabViewTransferPage, libs, "adw_tab_view_transfer_page", "ADW", "")
 
 }
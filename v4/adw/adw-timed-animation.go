@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -20,21 +19,21 @@ func (x *TimedAnimationClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A time-based [class@Animation].
+// A time-based Animation.
 //
 // `AdwTimedAnimation` implements a simple animation interpolating the given
-// value from [property@TimedAnimation:value-from] to
-// [property@TimedAnimation:value-to] over
-// [property@TimedAnimation:duration] milliseconds using the curve described by
-// [property@TimedAnimation:easing].
+// value from TimedAnimation:value-from to
+// TimedAnimation:value-to over
+// TimedAnimation:duration milliseconds using the curve described by
+// TimedAnimation:easing.
 //
-// If [property@TimedAnimation:reverse] is set to `TRUE`, `AdwTimedAnimation`
-// will instead animate from [property@TimedAnimation:value-to] to
-// [property@TimedAnimation:value-from], and the easing curve will be inverted.
+// If TimedAnimation:reverse is set to `TRUE`, `AdwTimedAnimation`
+// will instead animate from TimedAnimation:value-to to
+// TimedAnimation:value-from, and the easing curve will be inverted.
 //
 // The animation can repeat a certain amount of times, or endlessly, depending
-// on the [property@TimedAnimation:repeat-count] value. If
-// [property@TimedAnimation:alternate] is set to `TRUE`, it will also change the
+// on the TimedAnimation:repeat-count value. If
+// TimedAnimation:alternate is set to `TRUE`, it will also change the
 // direction every other iteration.
 type TimedAnimation struct {
 	Animation
@@ -54,8 +53,8 @@ func TimedAnimationNewFromInternalPtr(ptr uintptr) *TimedAnimation {
 
 var xNewTimedAnimation func(uintptr, float64, float64, uint, uintptr) uintptr
 
-// Creates a new `AdwTimedAnimation` on @widget to animate @target from @from
-// to @to.
+// Creates a new `AdwTimedAnimation` on widget to animate target from from
+// to to.
 func NewTimedAnimation(WidgetVar *gtk.Widget, FromVar float64, ToVar float64, DurationVar uint, TargetVar *AnimationTarget) *TimedAnimation {
 	var cls *TimedAnimation
 
@@ -72,7 +71,7 @@ func NewTimedAnimation(WidgetVar *gtk.Widget, FromVar float64, ToVar float64, Du
 
 var xTimedAnimationGetAlternate func(uintptr) bool
 
-// Gets whether @self changes direction on every iteration.
+// Gets whether self changes direction on every iteration.
 func (x *TimedAnimation) GetAlternate() bool {
 
 	cret := xTimedAnimationGetAlternate(x.GoPointer())
@@ -81,7 +80,7 @@ func (x *TimedAnimation) GetAlternate() bool {
 
 var xTimedAnimationGetDuration func(uintptr) uint
 
-// Gets the duration of @self.
+// Gets the duration of self.
 func (x *TimedAnimation) GetDuration() uint {
 
 	cret := xTimedAnimationGetDuration(x.GoPointer())
@@ -90,7 +89,7 @@ func (x *TimedAnimation) GetDuration() uint {
 
 var xTimedAnimationGetEasing func(uintptr) Easing
 
-// Gets the easing function @self uses.
+// Gets the easing function self uses.
 func (x *TimedAnimation) GetEasing() Easing {
 
 	cret := xTimedAnimationGetEasing(x.GoPointer())
@@ -99,7 +98,7 @@ func (x *TimedAnimation) GetEasing() Easing {
 
 var xTimedAnimationGetRepeatCount func(uintptr) uint
 
-// Gets the number of times @self will play.
+// Gets the number of times self will play.
 func (x *TimedAnimation) GetRepeatCount() uint {
 
 	cret := xTimedAnimationGetRepeatCount(x.GoPointer())
@@ -108,7 +107,7 @@ func (x *TimedAnimation) GetRepeatCount() uint {
 
 var xTimedAnimationGetReverse func(uintptr) bool
 
-// Gets whether @self plays backwards.
+// Gets whether self plays backwards.
 func (x *TimedAnimation) GetReverse() bool {
 
 	cret := xTimedAnimationGetReverse(x.GoPointer())
@@ -117,7 +116,7 @@ func (x *TimedAnimation) GetReverse() bool {
 
 var xTimedAnimationGetValueFrom func(uintptr) float64
 
-// Gets the value @self will animate from.
+// Gets the value self will animate from.
 func (x *TimedAnimation) GetValueFrom() float64 {
 
 	cret := xTimedAnimationGetValueFrom(x.GoPointer())
@@ -126,7 +125,7 @@ func (x *TimedAnimation) GetValueFrom() float64 {
 
 var xTimedAnimationGetValueTo func(uintptr) float64
 
-// Gets the value @self will animate to.
+// Gets the value self will animate to.
 func (x *TimedAnimation) GetValueTo() float64 {
 
 	cret := xTimedAnimationGetValueTo(x.GoPointer())
@@ -135,7 +134,7 @@ func (x *TimedAnimation) GetValueTo() float64 {
 
 var xTimedAnimationSetAlternate func(uintptr, bool)
 
-// Sets whether @self changes direction on every iteration.
+// Sets whether self changes direction on every iteration.
 func (x *TimedAnimation) SetAlternate(AlternateVar bool) {
 
 	xTimedAnimationSetAlternate(x.GoPointer(), AlternateVar)
@@ -144,7 +143,7 @@ func (x *TimedAnimation) SetAlternate(AlternateVar bool) {
 
 var xTimedAnimationSetDuration func(uintptr, uint)
 
-// Sets the duration of @self.
+// Sets the duration of self.
 //
 // If the animation repeats more than once, sets the duration of one iteration.
 func (x *TimedAnimation) SetDuration(DurationVar uint) {
@@ -155,9 +154,9 @@ func (x *TimedAnimation) SetDuration(DurationVar uint) {
 
 var xTimedAnimationSetEasing func(uintptr, Easing)
 
-// Sets the easing function @self will use.
+// Sets the easing function self will use.
 //
-// See [enum@Easing] for the description of specific easing functions.
+// See Easing for the description of specific easing functions.
 func (x *TimedAnimation) SetEasing(EasingVar Easing) {
 
 	xTimedAnimationSetEasing(x.GoPointer(), EasingVar)
@@ -166,9 +165,9 @@ func (x *TimedAnimation) SetEasing(EasingVar Easing) {
 
 var xTimedAnimationSetRepeatCount func(uintptr, uint)
 
-// Sets the number of times @self will play.
+// Sets the number of times self will play.
 //
-// If set to 0, @self will repeat endlessly.
+// If set to 0, self will repeat endlessly.
 func (x *TimedAnimation) SetRepeatCount(RepeatCountVar uint) {
 
 	xTimedAnimationSetRepeatCount(x.GoPointer(), RepeatCountVar)
@@ -177,7 +176,7 @@ func (x *TimedAnimation) SetRepeatCount(RepeatCountVar uint) {
 
 var xTimedAnimationSetReverse func(uintptr, bool)
 
-// Sets whether @self plays backwards.
+// Sets whether self plays backwards.
 func (x *TimedAnimation) SetReverse(ReverseVar bool) {
 
 	xTimedAnimationSetReverse(x.GoPointer(), ReverseVar)
@@ -186,12 +185,12 @@ func (x *TimedAnimation) SetReverse(ReverseVar bool) {
 
 var xTimedAnimationSetValueFrom func(uintptr, float64)
 
-// Sets the value @self will animate from.
+// Sets the value self will animate from.
 //
 // The animation will start at this value and end at
-// [property@TimedAnimation:value-to].
+// TimedAnimation:value-to.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will end at
+// If TimedAnimation:reverse is `TRUE`, the animation will end at
 // this value instead.
 func (x *TimedAnimation) SetValueFrom(ValueVar float64) {
 
@@ -201,12 +200,12 @@ func (x *TimedAnimation) SetValueFrom(ValueVar float64) {
 
 var xTimedAnimationSetValueTo func(uintptr, float64)
 
-// Sets the value @self will animate to.
+// Sets the value self will animate to.
 //
-// The animation will start at [property@TimedAnimation:value-from] and end at
+// The animation will start at TimedAnimation:value-from and end at
 // this value.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will start
+// If TimedAnimation:reverse is `TRUE`, the animation will start
 // at this value instead.
 func (x *TimedAnimation) SetValueTo(ValueVar float64) {
 
@@ -311,9 +310,9 @@ func (x *TimedAnimation) GetPropertyReverse() bool {
 // The value to animate from.
 //
 // The animation will start at this value and end at
-// [property@TimedAnimation:value-to].
+// TimedAnimation:value-to.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will end at
+// If TimedAnimation:reverse is `TRUE`, the animation will end at
 // this value instead.
 func (x *TimedAnimation) SetPropertyValueFrom(value float64) {
 	var v gobject.Value
@@ -326,9 +325,9 @@ func (x *TimedAnimation) SetPropertyValueFrom(value float64) {
 // The value to animate from.
 //
 // The animation will start at this value and end at
-// [property@TimedAnimation:value-to].
+// TimedAnimation:value-to.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will end at
+// If TimedAnimation:reverse is `TRUE`, the animation will end at
 // this value instead.
 func (x *TimedAnimation) GetPropertyValueFrom() float64 {
 	var v gobject.Value
@@ -339,10 +338,10 @@ func (x *TimedAnimation) GetPropertyValueFrom() float64 {
 // SetPropertyValueTo sets the "value-to" property.
 // The value to animate to.
 //
-// The animation will start at [property@TimedAnimation:value-from] and end at
+// The animation will start at TimedAnimation:value-from and end at
 // this value.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will start
+// If TimedAnimation:reverse is `TRUE`, the animation will start
 // at this value instead.
 func (x *TimedAnimation) SetPropertyValueTo(value float64) {
 	var v gobject.Value
@@ -354,10 +353,10 @@ func (x *TimedAnimation) SetPropertyValueTo(value float64) {
 // GetPropertyValueTo gets the "value-to" property.
 // The value to animate to.
 //
-// The animation will start at [property@TimedAnimation:value-from] and end at
+// The animation will start at TimedAnimation:value-from and end at
 // this value.
 //
-// If [property@TimedAnimation:reverse] is `TRUE`, the animation will start
+// If TimedAnimation:reverse is `TRUE`, the animation will start
 // at this value instead.
 func (x *TimedAnimation) GetPropertyValueTo() float64 {
 	var v gobject.Value
@@ -370,7 +369,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -379,21 +378,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xTimedAnimationGLibType, libs, "adw_timed_animation_get_type")
 
-	core.PuregoSafeRegister(&xNewTimedAnimation, libs, "adw_timed_animation_new")
-
-	core.PuregoSafeRegister(&xTimedAnimationGetAlternate, libs, "adw_timed_animation_get_alternate")
-	core.PuregoSafeRegister(&xTimedAnimationGetDuration, libs, "adw_timed_animation_get_duration")
-	core.PuregoSafeRegister(&xTimedAnimationGetEasing, libs, "adw_timed_animation_get_easing")
-	core.PuregoSafeRegister(&xTimedAnimationGetRepeatCount, libs, "adw_timed_animation_get_repeat_count")
-	core.PuregoSafeRegister(&xTimedAnimationGetReverse, libs, "adw_timed_animation_get_reverse")
-	core.PuregoSafeRegister(&xTimedAnimationGetValueFrom, libs, "adw_timed_animation_get_value_from")
-	core.PuregoSafeRegister(&xTimedAnimationGetValueTo, libs, "adw_timed_animation_get_value_to")
-	core.PuregoSafeRegister(&xTimedAnimationSetAlternate, libs, "adw_timed_animation_set_alternate")
-	core.PuregoSafeRegister(&xTimedAnimationSetDuration, libs, "adw_timed_animation_set_duration")
-	core.PuregoSafeRegister(&xTimedAnimationSetEasing, libs, "adw_timed_animation_set_easing")
-	core.PuregoSafeRegister(&xTimedAnimationSetRepeatCount, libs, "adw_timed_animation_set_repeat_count")
-	core.PuregoSafeRegister(&xTimedAnimationSetReverse, libs, "adw_timed_animation_set_reverse")
-	core.PuregoSafeRegister(&xTimedAnimationSetValueFrom, libs, "adw_timed_animation_set_value_from")
-	core.PuregoSafeRegister(&xTimedAnimationSetValueTo, libs, "adw_timed_animation_set_value_to")
+	core.PuregoRegisterSince(&xNewTimedAnimation, libs, "adw_timed_animation_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xTimedAnimationGetAlternate, libs, "adw_timed_animation_get_alternate", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetDuration, libs, "adw_timed_animation_get_duration", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetEasing, libs, "adw_timed_animation_get_easing", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetRepeatCount, libs, "adw_timed_animation_get_repeat_count", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetReverse, libs, "adw_timed_animation_get_reverse", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetValueFrom, libs, "adw_timed_animation_get_value_from", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationGetValueTo, libs, "adw_timed_animation_get_value_to", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetAlternate, libs, "adw_timed_animation_set_alternate", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetDuration, libs, "adw_timed_animation_set_duration", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetEasing, libs, "adw_timed_animation_set_easing", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetRepeatCount, libs, "adw_timed_animation_set_repeat_count", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetReverse, libs, "adw_timed_animation_set_reverse", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetValueFrom, libs, "adw_timed_animation_set_value_from", "ADW", "")
+	core.PuregoRegisterSince(&xTimedAnimationSetValueTo, libs, "adw_timed_animation_set_value_to", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,36 +23,25 @@ func (x *ToastOverlayClass) GoPointer() uintptr {
 
 // A widget showing toasts above its content.
 //
-// &lt;picture&gt;
+// Much like [gtk.Overlay], `AdwToastOverlay` is a container with a single
+// main child, on top of which it can display a Toast, overlaid.
+// Toasts can be shown with add_toast.
 //
-//	&lt;source srcset="toast-overlay-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toast-overlay.png" alt="toast-overlay"&gt;
+// Use dismiss_all to dismiss all toasts at once, or
+// dismiss to dismiss a single toast.
 //
-// &lt;/picture&gt;
+// See Toast for details.
 //
-// Much like [class@Gtk.Overlay], `AdwToastOverlay` is a container with a single
-// main child, on top of which it can display a [class@Toast], overlaid.
-// Toasts can be shown with [method@ToastOverlay.add_toast].
+// # CSS nodes
 //
-// Use [method@ToastOverlay.dismiss_all] to dismiss all toasts at once, or
-// [method@Toast.dismiss] to dismiss a single toast.
-//
-// See [class@Toast] for details.
-//
-// ## CSS nodes
-//
-// ```
-// toastoverlay
-// ├── [child]
-// ├── toast
-// ┊   ├── widget
-// ┊   │   ├── [label.heading]
-//
-//	│   ╰── [custom title]
-//	├── [button]
-//	╰── button.circular.flat
-//
-// ```
+//	toastoverlay
+//	├── [child]
+//	├── toast
+//	┊   ├── widget
+//	┊   │   ├── [label.heading]
+//	    │   ╰── [custom title]
+//	    ├── [button]
+//	    ╰── button.circular.flat
 //
 // `AdwToastOverlay`'s CSS node is called `toastoverlay`. It contains the child,
 // as well as zero or more `toast` subnodes.
@@ -65,9 +53,9 @@ func (x *ToastOverlayClass) GoPointer() uintptr {
 // The `widget` subnode contains a `label` subnode with the `.heading` style
 // class, or a custom widget provided by the application.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `AdwToastOverlay` uses the [enum@Gtk.AccessibleRole.GROUP] role.
+// `AdwToastOverlay` uses the AccessibleRole.GROUP role.
 type ToastOverlay struct {
 	gtk.Widget
 }
@@ -103,11 +91,11 @@ func NewToastOverlay() *ToastOverlay {
 
 var xToastOverlayAddToast func(uintptr, uintptr)
 
-// Displays @toast.
+// Displays toast.
 //
 // Only one toast can be shown at a time; if a toast is already being displayed,
-// either @toast or the original toast will be placed in a queue, depending on
-// the priority of @toast. See [property@Toast:priority].
+// either toast or the original toast will be placed in a queue, depending on
+// the priority of toast. See Toast:priority.
 //
 // If called on a toast that's already displayed, its timeout will be reset.
 //
@@ -123,7 +111,7 @@ var xToastOverlayDismissAll func(uintptr)
 
 // Dismisses all displayed toasts.
 //
-// Use [method@Toast.dismiss] to dismiss a single toast.
+// Use dismiss to dismiss a single toast.
 func (x *ToastOverlay) DismissAll() {
 
 	xToastOverlayDismissAll(x.GoPointer())
@@ -132,7 +120,7 @@ func (x *ToastOverlay) DismissAll() {
 
 var xToastOverlayGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *ToastOverlay) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -149,7 +137,7 @@ func (x *ToastOverlay) GetChild() *gtk.Widget {
 
 var xToastOverlaySetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *ToastOverlay) SetChild(ChildVar *gtk.Widget) {
 
 	xToastOverlaySetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -328,7 +316,7 @@ func (x *ToastOverlay) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -336,14 +324,13 @@ func (x *ToastOverlay) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ToastOverlay) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -367,19 +354,17 @@ func (x *ToastOverlay) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []g
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ToastOverlay) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -400,7 +385,7 @@ func (x *ToastOverlay) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -409,14 +394,12 @@ func (x *ToastOverlay) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ToastOverlay) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -435,10 +418,10 @@ func (x *ToastOverlay) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessib
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ToastOverlay) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -450,7 +433,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -459,11 +442,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xToastOverlayGLibType, libs, "adw_toast_overlay_get_type")
 
-	core.PuregoSafeRegister(&xNewToastOverlay, libs, "adw_toast_overlay_new")
+	gobject.CheckClassSize("AdwToastOverlayClass", xToastOverlayGLibType, unsafe.Sizeof(ToastOverlayClass{}))
+
+	core.PuregoRegisterSince(&xNewToastOverlay, libs, "adw_toast_overlay_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xToastOverlayAddToast, libs, "adw_toast_overlay_add_toast")
-	core.PuregoSafeRegister(&xToastOverlayDismissAll, libs, "adw_toast_overlay_dismiss_all")
-	core.PuregoSafeRegister(&xToastOverlayGetChild, libs, "adw_toast_overlay_get_child")
-	core.PuregoSafeRegister(&xToastOverlaySetChild, libs, "adw_toast_overlay_set_child")
+	core.PuregoRegisterSince(&xToastOverlayAddToast, libs, "adw_toast_overlay_add_toast", "ADW", "")
+	core.PuregoRegisterSince(&xToastOverlayDismissAll, libs, "adw_toast_overlay_dismiss_all", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToastOverlayGetChild, libs, "adw_toast_overlay_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xToastOverlaySetChild, libs, "adw_toast_overlay_set_child", "ADW", "")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +23,7 @@ func (x *ToastClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// [class@Toast] behavior when another toast is already displayed.
+// Toast behavior when another toast is already displayed.
 type ToastPriority int
 
 var xToastPriorityGLibType func() types.GType
@@ -42,57 +42,72 @@ const (
 	ToastPriorityHighValue ToastPriority = 1
 )
 
-// A helper object for [class@ToastOverlay].
+// String returns the C name of the value, e.g. for debugging output
+func (x ToastPriority) String() string {
+	switch x {
+	case ToastPriorityNormalValue:
+		return "ADW_TOAST_PRIORITY_NORMAL"
+	case ToastPriorityHighValue:
+		return "ADW_TOAST_PRIORITY_HIGH"
+	}
+	return fmt.Sprintf("ToastPriority(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ToastPriority) Nick() string {
+	switch x {
+	case ToastPriorityNormalValue:
+		return "normal"
+	case ToastPriorityHighValue:
+		return "high"
+	}
+	return ""
+}
+
+// ToastPriorityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ToastPriorityFromString(s string) (ToastPriority, bool) {
+	switch s {
+	case "ADW_TOAST_PRIORITY_NORMAL", "normal":
+		return ToastPriorityNormalValue, true
+	case "ADW_TOAST_PRIORITY_HIGH", "high":
+		return ToastPriorityHighValue, true
+	}
+	return 0, false
+}
+
+// A helper object for ToastOverlay.
 //
-// Toasts are meant to be passed into [method@ToastOverlay.add_toast] as
+// Toasts are meant to be passed into add_toast as
 // follows:
 //
-// ```c
-// adw_toast_overlay_add_toast (overlay, adw_toast_new (_("Simple Toast")));
-// ```
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toast-simple-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toast-simple.png" alt="toast-simple"&gt;
-//
-// &lt;/picture&gt;
+//	adw_toast_overlay_add_toast (overlay, adw_toast_new (_("Simple Toast")));
 //
-// Toasts always have a close button. They emit the [signal@Toast::dismissed]
+// Toasts always have a close button. They emit the Toast::dismissed
 // signal when disappearing.
 //
-// [property@Toast:timeout] determines how long the toast stays on screen, while
-// [property@Toast:priority] determines how it behaves if another toast is
+// Toast:timeout determines how long the toast stays on screen, while
+// Toast:priority determines how it behaves if another toast is
 // already being displayed.
 //
-// Toast titles use Pango markup by default, set [property@Toast:use-markup] to
+// Toast titles use Pango markup by default, set Toast:use-markup to
 // `FALSE` if this is unwanted.
 //
-// [property@Toast:custom-title] can be used to replace the title label with a
+// Toast:custom-title can be used to replace the title label with a
 // custom widget.
 //
-// ## Actions
+// # Actions
 //
 // Toasts can have one button on them, with a label and an attached
-// [iface@Gio.Action].
+// [gio.Action].
 //
-// ```c
-// AdwToast *toast = adw_toast_new (_("Toast with Action"));
+//	AdwToast *toast = adw_toast_new (_("Toast with Action"));
 //
-// adw_toast_set_button_label (toast, _("_Example"));
-// adw_toast_set_action_name (toast, "win.example");
+//	adw_toast_set_button_label (toast, _("_Example"));
+//	adw_toast_set_action_name (toast, "win.example");
 //
-// adw_toast_overlay_add_toast (overlay, toast);
-// ```
+//	adw_toast_overlay_add_toast (overlay, toast);
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toast-action-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toast-action.png" alt="toast-action"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Modifying toasts
+// # Modifying toasts
 //
 // Toasts can be modified after they have been shown. For this, an `AdwToast`
 // reference must be kept around while the toast is visible.
@@ -100,32 +115,25 @@ const (
 // A common use case for this is using toasts as undo prompts that stack with
 // each other, allowing to batch undo the last deleted items:
 //
-// ```c
-//
-// static void
-// toast_undo_cb (GtkWidget  *sender,
-//
-//	const char *action,
-//	GVariant   *param)
-//
+//	static void
+//	toast_undo_cb (GtkWidget  *sender,
+//	               const char *action,
+//	               GVariant   *param)
 //	{
 //	  // Undo the deletion
 //	}
 //
-// static void
-// dismissed_cb (MyWindow *self)
-//
+//	static void
+//	dismissed_cb (MyWindow *self)
 //	{
-//	  self-&gt;undo_toast = NULL;
+//	  self->undo_toast = NULL;
 //
 //	  // Permanently delete the items
 //	}
 //
-// static void
-// delete_item (MyWindow *self,
-//
-//	MyItem   *item)
-//
+//	static void
+//	delete_item (MyWindow *self,
+//	             MyItem   *item)
 //	{
 //	  g_autofree char *title = NULL;
 //	  int n_items;
@@ -133,49 +141,39 @@ const (
 //	  // Mark the item as waiting for deletion
 //	  n_items = ... // The number of waiting items
 //
-//	  if (!self-&gt;undo_toast) {
-//	    self-&gt;undo_toast = adw_toast_new_format (_("‘%s’ deleted"), ...);
+//	  if (!self->undo_toast) {
+//	    self->undo_toast = adw_toast_new_format (_("‘%s’ deleted"), ...);
 //
-//	    adw_toast_set_priority (self-&gt;undo_toast, ADW_TOAST_PRIORITY_HIGH);
-//	    adw_toast_set_button_label (self-&gt;undo_toast, _("_Undo"));
-//	    adw_toast_set_action_name (self-&gt;undo_toast, "toast.undo");
+//	    adw_toast_set_priority (self->undo_toast, ADW_TOAST_PRIORITY_HIGH);
+//	    adw_toast_set_button_label (self->undo_toast, _("_Undo"));
+//	    adw_toast_set_action_name (self->undo_toast, "toast.undo");
 //
-//	    g_signal_connect_swapped (self-&gt;undo_toast, "dismissed",
+//	    g_signal_connect_swapped (self->undo_toast, "dismissed",
 //	                              G_CALLBACK (dismissed_cb), self);
 //
-//	    adw_toast_overlay_add_toast (self-&gt;toast_overlay, self-&gt;undo_toast);
+//	    adw_toast_overlay_add_toast (self->toast_overlay, self->undo_toast);
 //
 //	    return;
 //	  }
 //
 //	  title =
-//	    g_strdup_printf (ngettext ("&lt;span font_features='tnum=1'&gt;%d&lt;/span&gt; item deleted",
-//	                               "&lt;span font_features='tnum=1'&gt;%d&lt;/span&gt; items deleted",
+//	    g_strdup_printf (ngettext ("<span font_features='tnum=1'>%d</span> item deleted",
+//	                               "<span font_features='tnum=1'>%d</span> items deleted",
 //	                               n_items), n_items);
 //
-//	  adw_toast_set_title (self-&gt;undo_toast, title);
+//	  adw_toast_set_title (self->undo_toast, title);
 //
 //	  // Bump the toast timeout
-//	  adw_toast_overlay_add_toast (self-&gt;toast_overlay, g_object_ref (self-&gt;undo_toast));
+//	  adw_toast_overlay_add_toast (self->toast_overlay, g_object_ref (self->undo_toast));
 //	}
 //
-// static void
-// my_window_class_init (MyWindowClass *klass)
-//
+//	static void
+//	my_window_class_init (MyWindowClass *klass)
 //	{
 //	  GtkWidgetClass *widget_class = GTK_WIDGET_CLASS (klass);
 //
 //	  gtk_widget_class_install_action (widget_class, "toast.undo", NULL, toast_undo_cb);
 //	}
-//
-// ```
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toast-undo-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toast-undo.png" alt="toast-undo"&gt;
-//
-// &lt;/picture&gt;
 type Toast struct {
 	gobject.Object
 }
@@ -196,9 +194,9 @@ var xNewToast func(string) uintptr
 
 // Creates a new `AdwToast`.
 //
-// The toast will use @title as its title.
+// The toast will use title as its title.
 //
-// @title can be marked up with the Pango text markup language.
+// title can be marked up with the Pango text markup language.
 func NewToast(TitleVar string) *Toast {
 	var cls *Toast
 
@@ -218,7 +216,7 @@ var xNewToastFormat func(string, ...interface{}) uintptr
 //
 // The toast will use the format string as its title.
 //
-// See also: [ctor@Toast.new]
+// See also: new
 func NewToastFormat(FormatVar string, varArgs ...interface{}) *Toast {
 	var cls *Toast
 
@@ -234,10 +232,10 @@ func NewToastFormat(FormatVar string, varArgs ...interface{}) *Toast {
 
 var xToastDismiss func(uintptr)
 
-// Dismisses @self.
+// Dismisses self.
 //
-// Does nothing if @self has already been dismissed, or hasn't been added to an
-// [class@ToastOverlay].
+// Does nothing if self has already been dismissed, or hasn't been added to an
+// ToastOverlay.
 func (x *Toast) Dismiss() {
 
 	xToastDismiss(x.GoPointer())
@@ -273,7 +271,7 @@ func (x *Toast) GetButtonLabel() string {
 
 var xToastGetCustomTitle func(uintptr) uintptr
 
-// Gets the custom title widget of @self.
+// Gets the custom title widget of self.
 func (x *Toast) GetCustomTitle() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -290,7 +288,7 @@ func (x *Toast) GetCustomTitle() *gtk.Widget {
 
 var xToastGetPriority func(uintptr) ToastPriority
 
-// Gets priority for @self.
+// Gets priority for self.
 func (x *Toast) GetPriority() ToastPriority {
 
 	cret := xToastGetPriority(x.GoPointer())
@@ -299,7 +297,7 @@ func (x *Toast) GetPriority() ToastPriority {
 
 var xToastGetTimeout func(uintptr) uint
 
-// Gets timeout for @self.
+// Gets timeout for self.
 func (x *Toast) GetTimeout() uint {
 
 	cret := xToastGetTimeout(x.GoPointer())
@@ -310,8 +308,8 @@ var xToastGetTitle func(uintptr) string
 
 // Gets the title that will be displayed on the toast.
 //
-// If a custom title has been set with [method@Adw.Toast.set_custom_title]
-// the return value will be %NULL.
+// If a custom title has been set with [Toast.SetCustomTitle]
+// the return value will be nil.
 func (x *Toast) GetTitle() string {
 
 	cret := xToastGetTitle(x.GoPointer())
@@ -333,7 +331,7 @@ var xToastSetActionName func(uintptr, uintptr)
 //
 // It will be activated when clicking the button.
 //
-// See [property@Toast:action-target].
+// See Toast:action-target.
 func (x *Toast) SetActionName(ActionNameVar *string) {
 
 	ActionNameVarPtr := core.GStrdupNullable(ActionNameVar)
@@ -347,13 +345,13 @@ var xToastSetActionTarget func(uintptr, uintptr, ...interface{})
 
 // Sets the parameter for action invocations.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Toast.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// set_action_target_value.
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Toast.set_detailed_action_name].
+// set_detailed_action_name.
 func (x *Toast) SetActionTarget(FormatStringVar *string, varArgs ...interface{}) {
 
 	FormatStringVarPtr := core.GStrdupNullable(FormatStringVar)
@@ -367,7 +365,7 @@ var xToastSetActionTargetValue func(uintptr, *glib.Variant)
 
 // Sets the parameter for action invocations.
 //
-// If the @action_target variant has a floating reference this function
+// If the action_target variant has a floating reference this function
 // will sink it.
 func (x *Toast) SetActionTargetValue(ActionTargetVar *glib.Variant) {
 
@@ -383,7 +381,7 @@ var xToastSetButtonLabel func(uintptr, uintptr)
 //
 // If set to `NULL`, the button won't be shown.
 //
-// See [property@Toast:action-name].
+// See Toast:action-name.
 func (x *Toast) SetButtonLabel(ButtonLabelVar *string) {
 
 	ButtonLabelVarPtr := core.GStrdupNullable(ButtonLabelVar)
@@ -395,12 +393,12 @@ func (x *Toast) SetButtonLabel(ButtonLabelVar *string) {
 
 var xToastSetCustomTitle func(uintptr, uintptr)
 
-// Sets the custom title widget of @self.
+// Sets the custom title widget of self.
 //
 // It will be displayed instead of the title if set. In this case,
-// [property@Toast:title] is ignored.
+// Toast:title is ignored.
 //
-// Setting a custom title will unset [property@Toast:title].
+// Setting a custom title will unset Toast:title.
 func (x *Toast) SetCustomTitle(WidgetVar *gtk.Widget) {
 
 	xToastSetCustomTitle(x.GoPointer(), WidgetVar.GoPointer())
@@ -411,8 +409,8 @@ var xToastSetDetailedActionName func(uintptr, uintptr)
 
 // Sets the action name and its parameter.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *Toast) SetDetailedActionName(DetailedActionNameVar *string) {
 
 	DetailedActionNameVarPtr := core.GStrdupNullable(DetailedActionNameVar)
@@ -424,14 +422,14 @@ func (x *Toast) SetDetailedActionName(DetailedActionNameVar *string) {
 
 var xToastSetPriority func(uintptr, ToastPriority)
 
-// Sets priority for @self.
+// Sets priority for self.
 //
 // Priority controls how the toast behaves when another toast is already
 // being displayed.
 //
-// If @priority is `ADW_TOAST_PRIORITY_NORMAL`, the toast will be queued.
+// If priority is `ADW_TOAST_PRIORITY_NORMAL`, the toast will be queued.
 //
-// If @priority is `ADW_TOAST_PRIORITY_HIGH`, the toast will be displayed
+// If priority is `ADW_TOAST_PRIORITY_HIGH`, the toast will be displayed
 // immediately, pushing the previous toast into the queue instead.
 func (x *Toast) SetPriority(PriorityVar ToastPriority) {
 
@@ -441,9 +439,9 @@ func (x *Toast) SetPriority(PriorityVar ToastPriority) {
 
 var xToastSetTimeout func(uintptr, uint)
 
-// Sets timeout for @self.
+// Sets timeout for self.
 //
-// If @timeout is 0, the toast is displayed indefinitely until manually
+// If timeout is 0, the toast is displayed indefinitely until manually
 // dismissed.
 //
 // Toasts cannot disappear while being hovered, pressed (on touchscreen), or
@@ -460,9 +458,9 @@ var xToastSetTitle func(uintptr, string)
 //
 // The title can be marked up with the Pango text markup language.
 //
-// Setting a title will unset [property@Toast:custom-title].
+// Setting a title will unset Toast:custom-title.
 //
-// If [property@Toast:custom-title] is set, it will be used instead.
+// If Toast:custom-title is set, it will be used instead.
 func (x *Toast) SetTitle(TitleVar string) {
 
 	xToastSetTitle(x.GoPointer(), TitleVar)
@@ -473,7 +471,7 @@ var xToastSetUseMarkup func(uintptr, bool)
 
 // Whether to use Pango markup for the toast title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Toast) SetUseMarkup(UseMarkupVar bool) {
 
 	xToastSetUseMarkup(x.GoPointer(), UseMarkupVar)
@@ -496,7 +494,7 @@ func (c *Toast) SetGoPointer(ptr uintptr) {
 //
 // It will be activated when clicking the button.
 //
-// See [property@Toast:action-target].
+// See Toast:action-target.
 func (x *Toast) SetPropertyActionName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -509,7 +507,7 @@ func (x *Toast) SetPropertyActionName(value string) {
 //
 // It will be activated when clicking the button.
 //
-// See [property@Toast:action-target].
+// See Toast:action-target.
 func (x *Toast) GetPropertyActionName() string {
 	var v gobject.Value
 	x.GetProperty("action-name", &v)
@@ -540,7 +538,7 @@ func (x *Toast) GetPropertyActionTarget() uintptr {
 //
 // If set to `NULL`, the button won't be shown.
 //
-// See [property@Toast:action-name].
+// See Toast:action-name.
 func (x *Toast) SetPropertyButtonLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -555,7 +553,7 @@ func (x *Toast) SetPropertyButtonLabel(value string) {
 //
 // If set to `NULL`, the button won't be shown.
 //
-// See [property@Toast:action-name].
+// See Toast:action-name.
 func (x *Toast) GetPropertyButtonLabel() string {
 	var v gobject.Value
 	x.GetProperty("button-label", &v)
@@ -596,9 +594,9 @@ func (x *Toast) GetPropertyTimeout() uint {
 //
 // The title can be marked up with the Pango text markup language.
 //
-// Setting a title will unset [property@Toast:custom-title].
+// Setting a title will unset Toast:custom-title.
 //
-// If [property@Toast:custom-title] is set, it will be used instead.
+// If Toast:custom-title is set, it will be used instead.
 func (x *Toast) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -611,9 +609,9 @@ func (x *Toast) SetPropertyTitle(value string) {
 //
 // The title can be marked up with the Pango text markup language.
 //
-// Setting a title will unset [property@Toast:custom-title].
+// Setting a title will unset Toast:custom-title.
 //
-// If [property@Toast:custom-title] is set, it will be used instead.
+// If Toast:custom-title is set, it will be used instead.
 func (x *Toast) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -623,7 +621,7 @@ func (x *Toast) GetPropertyTitle() string {
 // SetPropertyUseMarkup sets the "use-markup" property.
 // Whether to use Pango markup for the toast title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Toast) SetPropertyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -634,7 +632,7 @@ func (x *Toast) SetPropertyUseMarkup(value bool) {
 // GetPropertyUseMarkup gets the "use-markup" property.
 // Whether to use Pango markup for the toast title.
 //
-// See also [func@Pango.parse_markup].
+// See also [pango.ParseMarkup].
 func (x *Toast) GetPropertyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("use-markup", &v)
@@ -644,51 +642,95 @@ func (x *Toast) GetPropertyUseMarkup() bool {
 // Emitted after the button has been clicked.
 //
 // It can be used as an alternative to setting an action.
+//
+// Deprecated: use ConnectButtonClickedFunc, which also accepts method values and closures.
 func (x *Toast) ConnectButtonClicked(cb *func(Toast)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "button-clicked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Toast{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "button-clicked", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xToastButtonClickedTrampoline uintptr
+
+func xToastButtonClickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Toast))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Toast{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "button-clicked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectButtonClickedFunc connects cb to the "button-clicked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Toast) ConnectButtonClickedFunc(cb func(Toast)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "button-clicked", &xToastButtonClickedTrampoline, xToastButtonClickedNewTrampoline, cb)
 }
 
 // Emitted when the toast has been dismissed.
+//
+// Deprecated: use ConnectDismissedFunc, which also accepts method values and closures.
 func (x *Toast) ConnectDismissed(cb *func(Toast)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "dismissed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Toast{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "dismissed", cbRefPtr, cbPtr)
+}
+
+var xToastDismissedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xToastDismissedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Toast))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Toast{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "dismissed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDismissedFunc connects cb to the "dismissed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Toast) ConnectDismissedFunc(cb func(Toast)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "dismissed", &xToastDismissedTrampoline, xToastDismissedNewTrampoline, cb)
 }
 
 func init() {
@@ -696,7 +738,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -707,27 +749,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xToastGLibType, libs, "adw_toast_get_type")
 
-	core.PuregoSafeRegister(&xNewToast, libs, "adw_toast_new")
-	core.PuregoSafeRegister(&xNewToastFormat, libs, "adw_toast_new_format")
-
-	core.PuregoSafeRegister(&xToastDismiss, libs, "adw_toast_dismiss")
-	core.PuregoSafeRegister(&xToastGetActionName, libs, "adw_toast_get_action_name")
-	core.PuregoSafeRegister(&xToastGetActionTargetValue, libs, "adw_toast_get_action_target_value")
-	core.PuregoSafeRegister(&xToastGetButtonLabel, libs, "adw_toast_get_button_label")
-	core.PuregoSafeRegister(&xToastGetCustomTitle, libs, "adw_toast_get_custom_title")
-	core.PuregoSafeRegister(&xToastGetPriority, libs, "adw_toast_get_priority")
-	core.PuregoSafeRegister(&xToastGetTimeout, libs, "adw_toast_get_timeout")
-	core.PuregoSafeRegister(&xToastGetTitle, libs, "adw_toast_get_title")
-	core.PuregoSafeRegister(&xToastGetUseMarkup, libs, "adw_toast_get_use_markup")
-	core.PuregoSafeRegister(&xToastSetActionName, libs, "adw_toast_set_action_name")
-	core.PuregoSafeRegister(&xToastSetActionTarget, libs, "adw_toast_set_action_target")
-	core.PuregoSafeRegister(&xToastSetActionTargetValue, libs, "adw_toast_set_action_target_value")
-	core.PuregoSafeRegister(&xToastSetButtonLabel, libs, "adw_toast_set_button_label")
-	core.PuregoSafeRegister(&xToastSetCustomTitle, libs, "adw_toast_set_custom_title")
-	core.PuregoSafeRegister(&xToastSetDetailedActionName, libs, "adw_toast_set_detailed_action_name")
-	core.PuregoSafeRegister(&xToastSetPriority, libs, "adw_toast_set_priority")
-	core.PuregoSafeRegister(&xToastSetTimeout, libs, "adw_toast_set_timeout")
-	core.PuregoSafeRegister(&xToastSetTitle, libs, "adw_toast_set_title")
-	core.PuregoSafeRegister(&xToastSetUseMarkup, libs, "adw_toast_set_use_markup")
+	gobject.CheckClassSize("AdwToastClass", xToastGLibType, unsafe.Sizeof(ToastClass{}))
+
+	core.PuregoRegisterSince(&xNewToast, libs, "adw_toast_new", "ADW", "")
+	core.PuregoRegisterSince(&xNewToastFormat, libs, "adw_toast_new_format", "ADW", "1.2")
+
+	core.PuregoRegisterSince(&xToastDismiss, libs, "adw_toast_dismiss", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetActionName, libs, "adw_toast_get_action_name", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetActionTargetValue, libs, "adw_toast_get_action_target_value", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetButtonLabel, libs, "adw_toast_get_button_label", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetCustomTitle, libs, "adw_toast_get_custom_title", "ADW", "1.2")
+	core.PuregoRegisterSince(&xToastGetPriority, libs, "adw_toast_get_priority", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetTimeout, libs, "adw_toast_get_timeout", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetTitle, libs, "adw_toast_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xToastGetUseMarkup, libs, "adw_toast_get_use_markup", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToastSetActionName, libs, "adw_toast_set_action_name", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetActionTarget, libs, "adw_toast_set_action_target", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetActionTargetValue, libs, "adw_toast_set_action_target_value", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetButtonLabel, libs, "adw_toast_set_button_label", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetCustomTitle, libs, "adw_toast_set_custom_title", "ADW", "1.2")
+	core.PuregoRegisterSince(&xToastSetDetailedActionName, libs, "adw_toast_set_detailed_action_name", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetPriority, libs, "adw_toast_set_priority", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetTimeout, libs, "adw_toast_set_timeout", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetTitle, libs, "adw_toast_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xToastSetUseMarkup, libs, "adw_toast_set_use_markup", "ADW", "1.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -32,10 +31,10 @@ func (x *ToggleGroupClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A toggle within [class@ToggleGroup].
+// A toggle within ToggleGroup.
 //
-// `AdwToggle` can optionally have a name, set with [property@Toggle:name].
-// If the name is set, [property@ToggleGroup:active-name] can be used to access
+// `AdwToggle` can optionally have a name, set with Toggle:name.
+// If the name is set, ToggleGroup:active-name can be used to access
 // toggles instead of index.
 type Toggle struct {
 	gobject.Object
@@ -71,7 +70,7 @@ func NewToggle() *Toggle {
 
 var xToggleGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *Toggle) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -88,7 +87,7 @@ func (x *Toggle) GetChild() *gtk.Widget {
 
 var xToggleGetEnabled func(uintptr) bool
 
-// Gets whether @self is enabled.
+// Gets whether self is enabled.
 func (x *Toggle) GetEnabled() bool {
 
 	cret := xToggleGetEnabled(x.GoPointer())
@@ -97,7 +96,7 @@ func (x *Toggle) GetEnabled() bool {
 
 var xToggleGetIconName func(uintptr) string
 
-// Gets the icon name of @self.
+// Gets the icon name of self.
 func (x *Toggle) GetIconName() string {
 
 	cret := xToggleGetIconName(x.GoPointer())
@@ -106,7 +105,7 @@ func (x *Toggle) GetIconName() string {
 
 var xToggleGetIndex func(uintptr) uint
 
-// Gets the index of @self within its toggle group.
+// Gets the index of self within its toggle group.
 func (x *Toggle) GetIndex() uint {
 
 	cret := xToggleGetIndex(x.GoPointer())
@@ -115,7 +114,7 @@ func (x *Toggle) GetIndex() uint {
 
 var xToggleGetLabel func(uintptr) string
 
-// Gets the label of @self.
+// Gets the label of self.
 func (x *Toggle) GetLabel() string {
 
 	cret := xToggleGetLabel(x.GoPointer())
@@ -124,7 +123,7 @@ func (x *Toggle) GetLabel() string {
 
 var xToggleGetName func(uintptr) string
 
-// Gets the name of @self.
+// Gets the name of self.
 func (x *Toggle) GetName() string {
 
 	cret := xToggleGetName(x.GoPointer())
@@ -133,7 +132,7 @@ func (x *Toggle) GetName() string {
 
 var xToggleGetTooltip func(uintptr) string
 
-// Gets the tooltip of @self.
+// Gets the tooltip of self.
 func (x *Toggle) GetTooltip() string {
 
 	cret := xToggleGetTooltip(x.GoPointer())
@@ -142,7 +141,7 @@ func (x *Toggle) GetTooltip() string {
 
 var xToggleGetUseUnderline func(uintptr) bool
 
-// Gets whether @self uses underlines.
+// Gets whether self uses underlines.
 func (x *Toggle) GetUseUnderline() bool {
 
 	cret := xToggleGetUseUnderline(x.GoPointer())
@@ -151,7 +150,7 @@ func (x *Toggle) GetUseUnderline() bool {
 
 var xToggleSetChild func(uintptr, uintptr)
 
-// Sets the child of @self to @child.
+// Sets the child of self to child.
 //
 // When the child is set, icon and label are not displayed.
 //
@@ -165,7 +164,7 @@ func (x *Toggle) SetChild(ChildVar *gtk.Widget) {
 
 var xToggleSetEnabled func(uintptr, bool)
 
-// Sets whether @self is enabled.
+// Sets whether self is enabled.
 func (x *Toggle) SetEnabled(EnabledVar bool) {
 
 	xToggleSetEnabled(x.GoPointer(), EnabledVar)
@@ -174,10 +173,10 @@ func (x *Toggle) SetEnabled(EnabledVar bool) {
 
 var xToggleSetIconName func(uintptr, uintptr)
 
-// Sets the icon name of @self to @icon_name.
+// Sets the icon name of self to icon_name.
 //
 // The icon will be displayed alone or next to the label, unless
-// [property@Toggle:child] is set.
+// Toggle:child is set.
 func (x *Toggle) SetIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -189,10 +188,10 @@ func (x *Toggle) SetIconName(IconNameVar *string) {
 
 var xToggleSetLabel func(uintptr, uintptr)
 
-// Sets the label of @self to @label.
+// Sets the label of self to label.
 //
 // The label will be displayed alone or next to the icon, unless
-// [property@Toggle:child] is set, but will still be read out by the screen
+// Toggle:child is set, but will still be read out by the screen
 // reader.
 func (x *Toggle) SetLabel(LabelVar *string) {
 
@@ -205,11 +204,11 @@ func (x *Toggle) SetLabel(LabelVar *string) {
 
 var xToggleSetName func(uintptr, uintptr)
 
-// Sets the name of @self to @name.
+// Sets the name of self to name.
 //
-// Allows accessing @self by its name instead of index.
+// Allows accessing self by its name instead of index.
 //
-// See [property@ToggleGroup:active-name].
+// See ToggleGroup:active-name.
 func (x *Toggle) SetName(NameVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -221,9 +220,9 @@ func (x *Toggle) SetName(NameVar *string) {
 
 var xToggleSetTooltip func(uintptr, string)
 
-// Sets the tooltip of @self to @tooltip.
+// Sets the tooltip of self to tooltip.
 //
-// @tooltip can be marked up with the Pango text markup language.
+// tooltip can be marked up with the Pango text markup language.
 func (x *Toggle) SetTooltip(TooltipVar string) {
 
 	xToggleSetTooltip(x.GoPointer(), TooltipVar)
@@ -234,7 +233,7 @@ var xToggleSetUseUnderline func(uintptr, bool)
 
 // Sets whether an embedded underline in the label indicates a mnemonic.
 //
-// See [property@Toggle:label].
+// See Toggle:label.
 func (x *Toggle) SetUseUnderline(UseUnderlineVar bool) {
 
 	xToggleSetUseUnderline(x.GoPointer(), UseUnderlineVar)
@@ -273,7 +272,7 @@ func (x *Toggle) GetPropertyEnabled() bool {
 // The toggle icon name.
 //
 // The icon will be displayed alone or next to the label, unless
-// [property@Toggle:child] is set.
+// Toggle:child is set.
 func (x *Toggle) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -285,7 +284,7 @@ func (x *Toggle) SetPropertyIconName(value string) {
 // The toggle icon name.
 //
 // The icon will be displayed alone or next to the label, unless
-// [property@Toggle:child] is set.
+// Toggle:child is set.
 func (x *Toggle) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -296,7 +295,7 @@ func (x *Toggle) GetPropertyIconName() string {
 // The toggle label.
 //
 // The label will be displayed alone or next to the icon, unless
-// [property@Toggle:child] is set, but will still be read out by the screen
+// Toggle:child is set, but will still be read out by the screen
 // reader.
 func (x *Toggle) SetPropertyLabel(value string) {
 	var v gobject.Value
@@ -309,7 +308,7 @@ func (x *Toggle) SetPropertyLabel(value string) {
 // The toggle label.
 //
 // The label will be displayed alone or next to the icon, unless
-// [property@Toggle:child] is set, but will still be read out by the screen
+// Toggle:child is set, but will still be read out by the screen
 // reader.
 func (x *Toggle) GetPropertyLabel() string {
 	var v gobject.Value
@@ -322,7 +321,7 @@ func (x *Toggle) GetPropertyLabel() string {
 //
 // Allows accessing the toggle by its name instead of index.
 //
-// See [property@ToggleGroup:active-name].
+// See ToggleGroup:active-name.
 func (x *Toggle) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -335,7 +334,7 @@ func (x *Toggle) SetPropertyName(value string) {
 //
 // Allows accessing the toggle by its name instead of index.
 //
-// See [property@ToggleGroup:active-name].
+// See ToggleGroup:active-name.
 func (x *Toggle) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -366,7 +365,7 @@ func (x *Toggle) GetPropertyTooltip() string {
 // SetPropertyUseUnderline sets the "use-underline" property.
 // Whether an embedded underline in the label indicates a mnemonic.
 //
-// See [property@Toggle:label].
+// See Toggle:label.
 func (x *Toggle) SetPropertyUseUnderline(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -377,7 +376,7 @@ func (x *Toggle) SetPropertyUseUnderline(value bool) {
 // GetPropertyUseUnderline gets the "use-underline" property.
 // Whether an embedded underline in the label indicates a mnemonic.
 //
-// See [property@Toggle:label].
+// See Toggle:label.
 func (x *Toggle) GetPropertyUseUnderline() bool {
 	var v gobject.Value
 	x.GetProperty("use-underline", &v)
@@ -386,63 +385,52 @@ func (x *Toggle) GetPropertyUseUnderline() bool {
 
 // A group of exclusive toggles.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toggle-group-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toggle-group.png" alt="toggle-group"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwToggleGroup` presents a set of exclusive toggles, represented as
-// [class@Toggle] objects. Each toggle can display an icon, a label, an icon
+// Toggle objects. Each toggle can display an icon, a label, an icon
 // and a label, or a custom child.
 //
 // Toggles are indexed by their position, with the first toggle being equivalent
-// to 0, and so on. Use the [property@ToggleGroup:active] to get that position.
+// to 0, and so on. Use the ToggleGroup:active to get that position.
 //
-// Toggles can also have optional names, set via the [property@Toggle:name]
+// Toggles can also have optional names, set via the Toggle:name
 // property. The name of the active toggle can be accessed via the
-// [property@ToggleGroup:active-name] property.
+// ToggleGroup:active-name property.
 //
 // `AdwToggle` objects can be retrieved via their index or name, using
-// [method@ToggleGroup.get_toggle] or [method@ToggleGroup.get_toggle_by_name]
-// respectively. `AdwToggleGroup` also provides a [iface@Gtk.SelectionModel] of
-// its toggles via the [property@ToggleGroup:toggles] property.
+// get_toggle or get_toggle_by_name
+// respectively. `AdwToggleGroup` also provides a [gtk.SelectionModel] of
+// its toggles via the ToggleGroup:toggles property.
 //
 // `AdwToggleGroup` is orientable, and the toggles can be displayed horizontally
 // or vertically. This is mostly useful for icon-only toggles.
 //
-// Use the [property@ToggleGroup:homogeneous] property to make the toggles take
-// the same size, and the [property@ToggleGroup:can-shrink] to control whether
+// Use the ToggleGroup:homogeneous property to make the toggles take
+// the same size, and the ToggleGroup:can-shrink to control whether
 // the toggles can ellipsize.
 //
 // Example of an `AdwToggleGroup` UI definition:
 //
-// ```xml
-//
-//	&lt;object class="AdwToggleGroup"&gt;
-//	  &lt;property name="active-name"&gt;picture&lt;/property&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="AdwToggle"&gt;
-//	      &lt;property name="icon-name"&gt;camera-photo-symbolic&lt;/property&gt;
-//	      &lt;property name="tooltip" translatable="yes"&gt;Picture Mode&lt;/property&gt;
-//	      &lt;property name="name"&gt;picture&lt;/property&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="AdwToggle"&gt;
-//	      &lt;property name="icon-name"&gt;camera-video-symbolic&lt;/property&gt;
-//	      &lt;property name="tooltip" translatable="yes"&gt;Recording Mode&lt;/property&gt;
-//	      &lt;property name="name"&gt;recording&lt;/property&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	&lt;/object&gt;
-//
-// ```
-//
-// See also: [class@InlineViewSwitcher].
-//
-// ## CSS nodes
+//	<object class="AdwToggleGroup">
+//	  <property name="active-name">picture</property>
+//	  <child>
+//	    <object class="AdwToggle">
+//	      <property name="icon-name">camera-photo-symbolic</property>
+//	      <property name="tooltip" translatable="yes">Picture Mode</property>
+//	      <property name="name">picture</property>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="AdwToggle">
+//	      <property name="icon-name">camera-video-symbolic</property>
+//	      <property name="tooltip" translatable="yes">Recording Mode</property>
+//	      <property name="name">recording</property>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// See also: InlineViewSwitcher.
+//
+// # CSS nodes
 //
 // `AdwToggleGroup` has a main CSS node with the name `toggle-group`.
 //
@@ -455,38 +443,17 @@ func (x *Toggle) GetPropertyUseUnderline() bool {
 //
 // The hidden separators use the `.hidden` style class.
 //
-// ## Style classes
+// # Style classes
 //
-// `AdwToggleGroup` can use the [`.flat`](style-classes.html#flat_1) style class
+// `AdwToggleGroup` can use the .flat style class
 // to remove its background and make it look like a group of buttons.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toggle-group-flat-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toggle-group-flat.png" alt="toggle-group-flat"&gt;
-//
-// &lt;/picture&gt;
-//
-// It can also use the [`.round`](style-classes.html#round) style class to make
+// It can also use the .round style class to make
 // its toggles and the group itself rounded.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toggle-group-round-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toggle-group-round.png" alt="toggle-group-round"&gt;
-//
-// &lt;/picture&gt;
-//
 // They can also be combined with each other.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toggle-group-flat-round-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toggle-group-flat-round.png" alt="toggle-group-flat-round"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Accessibility
+// # Accessibility
 //
 // `AdwToggleGroup` uses the `GTK_ACCESSIBLE_ROLE_RADIO_GROUP` role. Its toggles
 // use the `GTK_ACCESSIBLE_ROLE_RADIO` role.
@@ -525,7 +492,7 @@ func NewToggleGroup() *ToggleGroup {
 
 var xToggleGroupAdd func(uintptr, uintptr)
 
-// Adds a toggle to @self.
+// Adds a toggle to self.
 func (x *ToggleGroup) Add(ToggleVar *Toggle) {
 
 	xToggleGroupAdd(x.GoPointer(), ToggleVar.GoPointer())
@@ -534,7 +501,7 @@ func (x *ToggleGroup) Add(ToggleVar *Toggle) {
 
 var xToggleGroupGetActive func(uintptr) uint
 
-// Gets the index of the active toggle in @self.
+// Gets the index of the active toggle in self.
 //
 // Returns `GTK_INVALID_LIST_POSITION` if no toggle is active.
 func (x *ToggleGroup) GetActive() uint {
@@ -545,11 +512,11 @@ func (x *ToggleGroup) GetActive() uint {
 
 var xToggleGroupGetActiveName func(uintptr) string
 
-// Gets the name of the active toggle in @self.
+// Gets the name of the active toggle in self.
 //
 // Can be `NULL` if the currently active toggle doesn't have a name.
 //
-// See [property@Toggle:name].
+// See Toggle:name.
 func (x *ToggleGroup) GetActiveName() string {
 
 	cret := xToggleGroupGetActiveName(x.GoPointer())
@@ -577,7 +544,7 @@ func (x *ToggleGroup) GetHomogeneous() bool {
 
 var xToggleGroupGetNToggles func(uintptr) uint
 
-// Gets the number of toggles within @self.
+// Gets the number of toggles within self.
 func (x *ToggleGroup) GetNToggles() uint {
 
 	cret := xToggleGroupGetNToggles(x.GoPointer())
@@ -586,7 +553,7 @@ func (x *ToggleGroup) GetNToggles() uint {
 
 var xToggleGroupGetToggle func(uintptr, uint) uintptr
 
-// Gets the toggle with @index from @self.
+// Gets the toggle with index from self.
 func (x *ToggleGroup) GetToggle(IndexVar uint) *Toggle {
 	var cls *Toggle
 
@@ -603,7 +570,7 @@ func (x *ToggleGroup) GetToggle(IndexVar uint) *Toggle {
 
 var xToggleGroupGetToggleByName func(uintptr, string) uintptr
 
-// Gets the toggle with the name @name from @self.
+// Gets the toggle with the name name from self.
 func (x *ToggleGroup) GetToggleByName(NameVar string) *Toggle {
 	var cls *Toggle
 
@@ -620,10 +587,10 @@ func (x *ToggleGroup) GetToggleByName(NameVar string) *Toggle {
 
 var xToggleGroupGetToggles func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the toggles of the group.
+// Returns a [gio.ListModel] that contains the toggles of the group.
 //
 // This can be used to keep an up-to-date view. The model also implements
-// [iface@Gtk.SelectionModel] and can be used to track and change the active
+// [gtk.SelectionModel] and can be used to track and change the active
 // toggle.
 func (x *ToggleGroup) GetToggles() *gtk.SelectionModelBase {
 	var cls *gtk.SelectionModelBase
@@ -640,7 +607,7 @@ func (x *ToggleGroup) GetToggles() *gtk.SelectionModelBase {
 
 var xToggleGroupRemove func(uintptr, uintptr)
 
-// Removes @toggle from @self.
+// Removes toggle from self.
 func (x *ToggleGroup) Remove(ToggleVar *Toggle) {
 
 	xToggleGroupRemove(x.GoPointer(), ToggleVar.GoPointer())
@@ -649,7 +616,7 @@ func (x *ToggleGroup) Remove(ToggleVar *Toggle) {
 
 var xToggleGroupRemoveAll func(uintptr)
 
-// Removes all toggles from @self.
+// Removes all toggles from self.
 func (x *ToggleGroup) RemoveAll() {
 
 	xToggleGroupRemoveAll(x.GoPointer())
@@ -658,9 +625,9 @@ func (x *ToggleGroup) RemoveAll() {
 
 var xToggleGroupSetActive func(uintptr, uint)
 
-// Sets the active toggle for @self.
+// Sets the active toggle for self.
 //
-// If the index is larger than the number of toggles in @self, unsets the
+// If the index is larger than the number of toggles in self, unsets the
 // current active toggle.
 func (x *ToggleGroup) SetActive(ActiveVar uint) {
 
@@ -670,11 +637,11 @@ func (x *ToggleGroup) SetActive(ActiveVar uint) {
 
 var xToggleGroupSetActiveName func(uintptr, uintptr)
 
-// Sets the active toggle for @self.
+// Sets the active toggle for self.
 //
-// The name can be set via [property@Toggle:name].
+// The name can be set via Toggle:name.
 //
-// If @name is `NULL`, unset the current active toggle instead.
+// If name is `NULL`, unset the current active toggle instead.
 func (x *ToggleGroup) SetActiveName(NameVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -689,9 +656,9 @@ var xToggleGroupSetCanShrink func(uintptr, bool)
 // Sets whether the toggles can be smaller than the natural size of their
 // contents.
 //
-// If @can_shrink is `TRUE`, the toggle labels will ellipsize.
+// If can_shrink is `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink].
 func (x *ToggleGroup) SetCanShrink(CanShrinkVar bool) {
 
 	xToggleGroupSetCanShrink(x.GoPointer(), CanShrinkVar)
@@ -725,7 +692,7 @@ func (c *ToggleGroup) SetGoPointer(ptr uintptr) {
 // unsets the current active toggle.
 //
 // If no toggle is active, the property will be set to
-// [const@Gtk.INVALID_LIST_POSITION].
+// [gtk.INVALID_LIST_POSITION].
 func (x *ToggleGroup) SetPropertyActive(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -740,7 +707,7 @@ func (x *ToggleGroup) SetPropertyActive(value uint) {
 // unsets the current active toggle.
 //
 // If no toggle is active, the property will be set to
-// [const@Gtk.INVALID_LIST_POSITION].
+// [gtk.INVALID_LIST_POSITION].
 func (x *ToggleGroup) GetPropertyActive() uint {
 	var v gobject.Value
 	x.GetProperty("active", &v)
@@ -750,7 +717,7 @@ func (x *ToggleGroup) GetPropertyActive() uint {
 // SetPropertyActiveName sets the "active-name" property.
 // The name of the active toggle.
 //
-// The name can be set via [property@Toggle:name]. If the currently active
+// The name can be set via Toggle:name. If the currently active
 // toggle doesn't have a name, the property will be set to `NULL`.
 //
 // Set it to `NULL` to unset the current active toggle.
@@ -764,7 +731,7 @@ func (x *ToggleGroup) SetPropertyActiveName(value string) {
 // GetPropertyActiveName gets the "active-name" property.
 // The name of the active toggle.
 //
-// The name can be set via [property@Toggle:name]. If the currently active
+// The name can be set via Toggle:name. If the currently active
 // toggle doesn't have a name, the property will be set to `NULL`.
 //
 // Set it to `NULL` to unset the current active toggle.
@@ -779,7 +746,7 @@ func (x *ToggleGroup) GetPropertyActiveName() string {
 //
 // If set to `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink].
 func (x *ToggleGroup) SetPropertyCanShrink(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -792,7 +759,7 @@ func (x *ToggleGroup) SetPropertyCanShrink(value bool) {
 //
 // If set to `TRUE`, the toggle labels will ellipsize.
 //
-// See [property@Gtk.Button:can-shrink].
+// See [gtk.Button.GetPropertyCanShrink].
 func (x *ToggleGroup) GetPropertyCanShrink() bool {
 	var v gobject.Value
 	x.GetProperty("can-shrink", &v)
@@ -985,7 +952,7 @@ func (x *ToggleGroup) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -993,14 +960,13 @@ func (x *ToggleGroup) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ToggleGroup) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1024,19 +990,17 @@ func (x *ToggleGroup) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ToggleGroup) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1057,7 +1021,7 @@ func (x *ToggleGroup) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1066,14 +1030,12 @@ func (x *ToggleGroup) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ToggleGroup) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1092,24 +1054,24 @@ func (x *ToggleGroup) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ToggleGroup) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ToggleGroup) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ToggleGroup) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -1121,7 +1083,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1130,42 +1092,46 @@ func init() {
 
 	core.PuregoSafeRegister(&xToggleGLibType, libs, "adw_toggle_get_type")
 
-	core.PuregoSafeRegister(&xNewToggle, libs, "adw_toggle_new")
-
-	core.PuregoSafeRegister(&xToggleGetChild, libs, "adw_toggle_get_child")
-	core.PuregoSafeRegister(&xToggleGetEnabled, libs, "adw_toggle_get_enabled")
-	core.PuregoSafeRegister(&xToggleGetIconName, libs, "adw_toggle_get_icon_name")
-	core.PuregoSafeRegister(&xToggleGetIndex, libs, "adw_toggle_get_index")
-	core.PuregoSafeRegister(&xToggleGetLabel, libs, "adw_toggle_get_label")
-	core.PuregoSafeRegister(&xToggleGetName, libs, "adw_toggle_get_name")
-	core.PuregoSafeRegister(&xToggleGetTooltip, libs, "adw_toggle_get_tooltip")
-	core.PuregoSafeRegister(&xToggleGetUseUnderline, libs, "adw_toggle_get_use_underline")
-	core.PuregoSafeRegister(&xToggleSetChild, libs, "adw_toggle_set_child")
-	core.PuregoSafeRegister(&xToggleSetEnabled, libs, "adw_toggle_set_enabled")
-	core.PuregoSafeRegister(&xToggleSetIconName, libs, "adw_toggle_set_icon_name")
-	core.PuregoSafeRegister(&xToggleSetLabel, libs, "adw_toggle_set_label")
-	core.PuregoSafeRegister(&xToggleSetName, libs, "adw_toggle_set_name")
-	core.PuregoSafeRegister(&xToggleSetTooltip, libs, "adw_toggle_set_tooltip")
-	core.PuregoSafeRegister(&xToggleSetUseUnderline, libs, "adw_toggle_set_use_underline")
+	gobject.CheckClassSize("AdwToggleClass", xToggleGLibType, unsafe.Sizeof(ToggleClass{}))
+
+	core.PuregoRegisterSince(&xNewToggle, libs, "adw_toggle_new", "ADW", "1.7")
+
+	core.PuregoRegisterSince(&xToggleGetChild, libs, "adw_toggle_get_child", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetEnabled, libs, "adw_toggle_get_enabled", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetIconName, libs, "adw_toggle_get_icon_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetIndex, libs, "adw_toggle_get_index", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetLabel, libs, "adw_toggle_get_label", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetName, libs, "adw_toggle_get_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetTooltip, libs, "adw_toggle_get_tooltip", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGetUseUnderline, libs, "adw_toggle_get_use_underline", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetChild, libs, "adw_toggle_set_child", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetEnabled, libs, "adw_toggle_set_enabled", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetIconName, libs, "adw_toggle_set_icon_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetLabel, libs, "adw_toggle_set_label", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetName, libs, "adw_toggle_set_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetTooltip, libs, "adw_toggle_set_tooltip", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleSetUseUnderline, libs, "adw_toggle_set_use_underline", "ADW", "1.7")
 
 	core.PuregoSafeRegister(&xToggleGroupGLibType, libs, "adw_toggle_group_get_type")
 
-	core.PuregoSafeRegister(&xNewToggleGroup, libs, "adw_toggle_group_new")
-
-	core.PuregoSafeRegister(&xToggleGroupAdd, libs, "adw_toggle_group_add")
-	core.PuregoSafeRegister(&xToggleGroupGetActive, libs, "adw_toggle_group_get_active")
-	core.PuregoSafeRegister(&xToggleGroupGetActiveName, libs, "adw_toggle_group_get_active_name")
-	core.PuregoSafeRegister(&xToggleGroupGetCanShrink, libs, "adw_toggle_group_get_can_shrink")
-	core.PuregoSafeRegister(&xToggleGroupGetHomogeneous, libs, "adw_toggle_group_get_homogeneous")
-	core.PuregoSafeRegister(&xToggleGroupGetNToggles, libs, "adw_toggle_group_get_n_toggles")
-	core.PuregoSafeRegister(&xToggleGroupGetToggle, libs, "adw_toggle_group_get_toggle")
-	core.PuregoSafeRegister(&xToggleGroupGetToggleByName, libs, "adw_toggle_group_get_toggle_by_name")
-	core.PuregoSafeRegister(&xToggleGroupGetToggles, libs, "adw_toggle_group_get_toggles")
-	core.PuregoSafeRegister(&xToggleGroupRemove, libs, "adw_toggle_group_remove")
-	core.PuregoSafeRegister(&xToggleGroupRemoveAll, libs, "adw_toggle_group_remove_all")
-	core.PuregoSafeRegister(&xToggleGroupSetActive, libs, "adw_toggle_group_set_active")
-	core.PuregoSafeRegister(&xToggleGroupSetActiveName, libs, "adw_toggle_group_set_active_name")
-	core.PuregoSafeRegister(&xToggleGroupSetCanShrink, libs, "adw_toggle_group_set_can_shrink")
-	core.PuregoSafeRegister(&xToggleGroupSetHomogeneous, libs, "adw_toggle_group_set_homogeneous")
+	gobject.CheckClassSize("AdwToggleGroupClass", xToggleGroupGLibType, unsafe.Sizeof(ToggleGroupClass{}))
+
+	core.PuregoRegisterSince(&xNewToggleGroup, libs, "adw_toggle_group_new", "ADW", "1.7")
+
+	core.PuregoRegisterSince(&xToggleGroupAdd, libs, "adw_toggle_group_add", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetActive, libs, "adw_toggle_group_get_active", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetActiveName, libs, "adw_toggle_group_get_active_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetCanShrink, libs, "adw_toggle_group_get_can_shrink", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetHomogeneous, libs, "adw_toggle_group_get_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetNToggles, libs, "adw_toggle_group_get_n_toggles", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetToggle, libs, "adw_toggle_group_get_toggle", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetToggleByName, libs, "adw_toggle_group_get_toggle_by_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupGetToggles, libs, "adw_toggle_group_get_toggles", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupRemove, libs, "adw_toggle_group_remove", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupRemoveAll, libs, "adw_toggle_group_remove_all", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupSetActive, libs, "adw_toggle_group_set_active", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupSetActiveName, libs, "adw_toggle_group_set_active_name", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupSetCanShrink, libs, "adw_toggle_group_set_can_shrink", "ADW", "1.7")
+	core.PuregoRegisterSince(&xToggleGroupSetHomogeneous, libs, "adw_toggle_group_set_homogeneous", "ADW", "1.7")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,54 +22,30 @@ func (x *ToolbarViewClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the possible top or bottom bar styles in an [class@ToolbarView]
+// Describes the possible top or bottom bar styles in an ToolbarView
 // widget.
 //
 // `ADW_TOOLBAR_FLAT` is suitable for simple content, such as
-// [class@StatusPage] or [class@PreferencesPage], where the background at the
+// StatusPage or PreferencesPage, where the background at the
 // top and bottom parts of the page is uniform. Additionally, windows with
 // sidebars should always use this style.
 //
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-flat-1-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-flat-1.png" alt="toolbar-view-flat-1"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-flat-2-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-flat-2.png" alt="toolbar-view-flat-2"&gt;
-//
-// &lt;/picture&gt;
-//
 // `ADW_TOOLBAR_RAISED` style is suitable for content such as
-// [utility panes](https://developer.gnome.org/hig/patterns/containers/utility-panes.html),
+// [utility panes],
 // where some elements are directly adjacent to the top/bottom bars, or
-// [class@TabView], where each page can have a different background.
+// TabView, where each page can have a different background.
 //
 // `ADW_TOOLBAR_RAISED_BORDER` style is similar to `ADW_TOOLBAR_RAISED`, but
 // with the shadow replaced with a more subtle border. It's intended to be used
 // in applications like image viewers, where a shadow over the content might be
 // undesired.
 //
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-raised-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-raised.png" alt="toolbar-view-raised"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-raised-border-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-raised-border.png" alt="toolbar-view-raised-border"&gt;
-//
-// &lt;/picture&gt;
-//
-// See [property@ToolbarView:top-bar-style] and
-// [property@ToolbarView:bottom-bar-style].
+// See ToolbarView:top-bar-style and
+// ToolbarView:bottom-bar-style.
 //
 // New values may be added to this enumeration over time.
+//
+// [utility panes]: https://developer.gnome.org/hig/patterns/containers/utility-panes.html
 type ToolbarStyle int
 
 var xToolbarStyleGLibType func() types.GType
@@ -88,118 +64,117 @@ const (
 	ToolbarRaisedBorderValue ToolbarStyle = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ToolbarStyle) String() string {
+	switch x {
+	case ToolbarFlatValue:
+		return "ADW_TOOLBAR_FLAT"
+	case ToolbarRaisedValue:
+		return "ADW_TOOLBAR_RAISED"
+	case ToolbarRaisedBorderValue:
+		return "ADW_TOOLBAR_RAISED_BORDER"
+	}
+	return fmt.Sprintf("ToolbarStyle(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ToolbarStyle) Nick() string {
+	switch x {
+	case ToolbarFlatValue:
+		return "flat"
+	case ToolbarRaisedValue:
+		return "raised"
+	case ToolbarRaisedBorderValue:
+		return "raised-border"
+	}
+	return ""
+}
+
+// ToolbarStyleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ToolbarStyleFromString(s string) (ToolbarStyle, bool) {
+	switch s {
+	case "ADW_TOOLBAR_FLAT", "flat":
+		return ToolbarFlatValue, true
+	case "ADW_TOOLBAR_RAISED", "raised":
+		return ToolbarRaisedValue, true
+	case "ADW_TOOLBAR_RAISED_BORDER", "raised-border":
+		return ToolbarRaisedBorderValue, true
+	}
+	return 0, false
+}
+
 // A widget containing a page, as well as top and/or bottom bars.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toolbar-view-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view.png" alt="toolbar-view"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwToolbarView` has a single content widget and one or multiple top and
 // bottom bars, shown at the top and bottom sides respectively.
 //
 // Example of an `AdwToolbarView` UI definition:
-// ```xml
-// &lt;object class="AdwToolbarView"&gt;
 //
-//	&lt;child type="top"&gt;
-//	  &lt;object class="AdwHeaderBar"/&gt;
-//	&lt;/child&gt;
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwPreferencesPage"&gt;
-//	    &lt;!-- ... --&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwToolbarView">
+//	  <child type="top">
+//	    <object class="AdwHeaderBar"/>
+//	  </child>
+//	  <property name="content">
+//	    <object class="AdwPreferencesPage">
+//	      <!-- ... -->
+//	    </object>
+//	  </property>
+//	</object>
 //
 // The following kinds of top and bottom bars are supported:
 //
-//   - [class@HeaderBar]
-//   - [class@TabBar]
-//   - [class@ViewSwitcherBar]
-//   - [class@Gtk.ActionBar]
-//   - [class@Gtk.HeaderBar]
-//   - [class@Gtk.PopoverMenuBar]
-//   - [class@Gtk.SearchBar]
-//   - Any [class@Gtk.Box] or a similar widget with the
-//     [`.toolbar`](style-classes.html#toolbars) style class
+//   - HeaderBar
+//   - TabBar
+//   - ViewSwitcherBar
+//   - [gtk.ActionBar]
+//   - [gtk.HeaderBar]
+//   - [gtk.PopoverMenuBar]
+//   - [gtk.SearchBar]
+//   - Any [gtk.Box] or a similar widget with the
+//     .toolbar style class
 //
 // By default, top and bottom bars are flat and scrolling content has a subtle
 // undershoot shadow, same as when using the
-// [`.undershoot-top`](style-classes.html#undershoot-indicators) and
-// [`.undershoot-bottom`](style-classes.html#undershoot-indicators) style
-// classes. This works well in most cases, e.g. with [class@StatusPage] or
-// [class@PreferencesPage], where the background at the top and bottom parts of
+// .undershoot-top and
+// .undershoot-bottom style
+// classes. This works well in most cases, e.g. with StatusPage or
+// PreferencesPage, where the background at the top and bottom parts of
 // the page is uniform. Additionally, windows with sidebars should always use
 // this style.
 //
-// [property@ToolbarView:top-bar-style] and
-// [property@ToolbarView:bottom-bar-style] properties can be used add an opaque
+// ToolbarView:top-bar-style and
+// ToolbarView:bottom-bar-style properties can be used add an opaque
 // background and a persistent shadow to top and bottom bars, this can be useful
-// for content such as [utility panes](https://developer.gnome.org/hig/patterns/containers/utility-panes.html),
-// where some elements are adjacent to the top/bottom bars, or [class@TabView],
+// for content such as [utility panes],
+// where some elements are adjacent to the top/bottom bars, or TabView,
 // where each page can have a different background.
 //
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-flat-1-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-flat-1.png" alt="toolbar-view-flat-1"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-flat-2-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-flat-2.png" alt="toolbar-view-flat-2"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture style="min-width: 33%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-raised-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-raised.png" alt="toolbar-view-raised"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwToolbarView` ensures the top and bottom bars have consistent backdrop
 // styles and vertical spacing. For comparison:
 //
-// &lt;picture style="min-width: 40%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-spacing-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-spacing.png" alt="toolbar-view-spacing"&gt;
-//
-// &lt;/picture&gt;
-// &lt;picture style="min-width: 40%; display: inline-block;"&gt;
-//
-//	&lt;source srcset="toolbar-view-spacing-box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="toolbar-view-spacing-box.png" alt="toolbar-view-spacing-box"&gt;
-//
-// &lt;/picture&gt;
-//
 // Any top and bottom bars can also be dragged to move the window, equivalent
-// to putting them into a [class@Gtk.WindowHandle].
+// to putting them into a [gtk.WindowHandle].
 //
 // Content is typically place between top and bottom bars, but can also extend
 // behind them. This is controlled with the
-// [property@ToolbarView:extend-content-to-top-edge] and
-// [property@ToolbarView:extend-content-to-bottom-edge] properties.
+// ToolbarView:extend-content-to-top-edge and
+// ToolbarView:extend-content-to-bottom-edge properties.
 //
 // Top and bottom bars can be hidden and revealed with an animation using the
-// [property@ToolbarView:reveal-top-bars] and
-// [property@ToolbarView:reveal-bottom-bars] properties.
+// ToolbarView:reveal-top-bars and
+// ToolbarView:reveal-bottom-bars properties.
 //
-// ## `AdwToolbarView` as `GtkBuildable`
+// # `AdwToolbarView` as `GtkBuildable`
 //
-// The `AdwToolbarView` implementation of the [iface@Gtk.Buildable] interface
+// The `AdwToolbarView` implementation of the [gtk.Buildable] interface
 // supports adding a top bar by specifying “top” as the “type” attribute of a
-// `&lt;child&gt;` element, or adding a bottom bar by specifying “bottom”.
+// `<child>` element, or adding a bottom bar by specifying “bottom”.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwToolbarView` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
+//
+// [utility panes]: https://developer.gnome.org/hig/patterns/containers/utility-panes.html
 type ToolbarView struct {
 	gtk.Widget
 }
@@ -235,7 +210,7 @@ func NewToolbarView() *ToolbarView {
 
 var xToolbarViewAddBottomBar func(uintptr, uintptr)
 
-// Adds a bottom bar to @self.
+// Adds a bottom bar to self.
 func (x *ToolbarView) AddBottomBar(WidgetVar *gtk.Widget) {
 
 	xToolbarViewAddBottomBar(x.GoPointer(), WidgetVar.GoPointer())
@@ -244,7 +219,7 @@ func (x *ToolbarView) AddBottomBar(WidgetVar *gtk.Widget) {
 
 var xToolbarViewAddTopBar func(uintptr, uintptr)
 
-// Adds a top bar to @self.
+// Adds a top bar to self.
 func (x *ToolbarView) AddTopBar(WidgetVar *gtk.Widget) {
 
 	xToolbarViewAddTopBar(x.GoPointer(), WidgetVar.GoPointer())
@@ -253,12 +228,12 @@ func (x *ToolbarView) AddTopBar(WidgetVar *gtk.Widget) {
 
 var xToolbarViewGetBottomBarHeight func(uintptr) int
 
-// Gets the current bottom bar height for @self.
+// Gets the current bottom bar height for self.
 //
 // Bottom bar height does change depending on
-// [property@ToolbarView:reveal-bottom-bars], including during the transition.
+// ToolbarView:reveal-bottom-bars, including during the transition.
 //
-// See [method@ToolbarView.get_top_bar_height].
+// See get_top_bar_height.
 func (x *ToolbarView) GetBottomBarHeight() int {
 
 	cret := xToolbarViewGetBottomBarHeight(x.GoPointer())
@@ -267,7 +242,7 @@ func (x *ToolbarView) GetBottomBarHeight() int {
 
 var xToolbarViewGetBottomBarStyle func(uintptr) ToolbarStyle
 
-// Gets appearance of the bottom bars for @self.
+// Gets appearance of the bottom bars for self.
 func (x *ToolbarView) GetBottomBarStyle() ToolbarStyle {
 
 	cret := xToolbarViewGetBottomBarStyle(x.GoPointer())
@@ -276,7 +251,7 @@ func (x *ToolbarView) GetBottomBarStyle() ToolbarStyle {
 
 var xToolbarViewGetContent func(uintptr) uintptr
 
-// Gets the content widget for @self.
+// Gets the content widget for self.
 func (x *ToolbarView) GetContent() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -311,7 +286,7 @@ func (x *ToolbarView) GetExtendContentToTopEdge() bool {
 
 var xToolbarViewGetRevealBottomBars func(uintptr) bool
 
-// Gets whether bottom bars are revealed for @self.
+// Gets whether bottom bars are revealed for self.
 func (x *ToolbarView) GetRevealBottomBars() bool {
 
 	cret := xToolbarViewGetRevealBottomBars(x.GoPointer())
@@ -320,7 +295,7 @@ func (x *ToolbarView) GetRevealBottomBars() bool {
 
 var xToolbarViewGetRevealTopBars func(uintptr) bool
 
-// Gets whether top bars are revealed for @self.
+// Gets whether top bars are revealed for self.
 func (x *ToolbarView) GetRevealTopBars() bool {
 
 	cret := xToolbarViewGetRevealTopBars(x.GoPointer())
@@ -329,12 +304,12 @@ func (x *ToolbarView) GetRevealTopBars() bool {
 
 var xToolbarViewGetTopBarHeight func(uintptr) int
 
-// Gets the current top bar height for @self.
+// Gets the current top bar height for self.
 //
 // Top bar height does change depending on
-// [property@ToolbarView:reveal-top-bars], including during the transition.
+// ToolbarView:reveal-top-bars, including during the transition.
 //
-// See [method@ToolbarView.get_bottom_bar_height].
+// See get_bottom_bar_height.
 func (x *ToolbarView) GetTopBarHeight() int {
 
 	cret := xToolbarViewGetTopBarHeight(x.GoPointer())
@@ -343,7 +318,7 @@ func (x *ToolbarView) GetTopBarHeight() int {
 
 var xToolbarViewGetTopBarStyle func(uintptr) ToolbarStyle
 
-// Gets appearance of the top bars for @self.
+// Gets appearance of the top bars for self.
 func (x *ToolbarView) GetTopBarStyle() ToolbarStyle {
 
 	cret := xToolbarViewGetTopBarStyle(x.GoPointer())
@@ -352,7 +327,7 @@ func (x *ToolbarView) GetTopBarStyle() ToolbarStyle {
 
 var xToolbarViewRemove func(uintptr, uintptr)
 
-// Removes a child from @self.
+// Removes a child from self.
 func (x *ToolbarView) Remove(WidgetVar *gtk.Widget) {
 
 	xToolbarViewRemove(x.GoPointer(), WidgetVar.GoPointer())
@@ -361,30 +336,32 @@ func (x *ToolbarView) Remove(WidgetVar *gtk.Widget) {
 
 var xToolbarViewSetBottomBarStyle func(uintptr, ToolbarStyle)
 
-// Sets appearance of the bottom bars for @self.
+// Sets appearance of the bottom bars for self.
 //
 // If set to `ADW_TOOLBAR_FLAT`, bottom bars are flat and scrolling content has
 // a subtle undershoot shadow when touching them, same as the
-// [`.undershoot-bottom`](style-classes.html#undershoot-indicators)
-// style class. This works well for simple content, e.g. [class@StatusPage] or
-// [class@PreferencesPage], where the background at the bottom of the page is
+// .undershoot-bottom
+// style class. This works well for simple content, e.g. StatusPage or
+// PreferencesPage, where the background at the bottom of the page is
 // uniform. Additionally, windows with sidebars should always use this style.
 //
 // Undershoot shadow is only present if a bottom bar is actually present and
 // visible. It is also never present if
-// [property@ToolbarView:extend-content-to-bottom-edge] is set to `TRUE`.
+// ToolbarView:extend-content-to-bottom-edge is set to `TRUE`.
 //
 // If set to `ADW_TOOLBAR_RAISED`, bottom bars have an opaque background and a
 // persistent shadow, this is suitable for content such as
-// [utility panes](https://developer.gnome.org/hig/patterns/containers/utility-panes.html),
+// [utility panes],
 // where some elements are directly adjacent to the bottom bars, or
-// [class@TabView], where each page can have a different background.
+// TabView, where each page can have a different background.
 //
 // `ADW_TOOLBAR_RAISED_BORDER` is similar to `ADW_TOOLBAR_RAISED`, but the
 // shadow is replaced with a more subtle border. This can be useful for
 // applications like image viewers.
 //
-// See also [method@ToolbarView.set_top_bar_style].
+// See also set_top_bar_style.
+//
+// [utility panes]: https://developer.gnome.org/hig/patterns/containers/utility-panes.html
 func (x *ToolbarView) SetBottomBarStyle(StyleVar ToolbarStyle) {
 
 	xToolbarViewSetBottomBarStyle(x.GoPointer(), StyleVar)
@@ -393,7 +370,7 @@ func (x *ToolbarView) SetBottomBarStyle(StyleVar ToolbarStyle) {
 
 var xToolbarViewSetContent func(uintptr, uintptr)
 
-// Sets the content widget for @self.
+// Sets the content widget for self.
 func (x *ToolbarView) SetContent(ContentVar *gtk.Widget) {
 
 	xToolbarViewSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -404,10 +381,10 @@ var xToolbarViewSetExtendContentToBottomEdge func(uintptr, bool)
 
 // Sets whether the content widget can extend behind bottom bars.
 //
-// This can be used in combination with [property@ToolbarView:reveal-bottom-bars]
+// This can be used in combination with ToolbarView:reveal-bottom-bars
 // to show and hide toolbars in fullscreen.
 //
-// See [method@ToolbarView.set_extend_content_to_top_edge].
+// See set_extend_content_to_top_edge.
 func (x *ToolbarView) SetExtendContentToBottomEdge(ExtendVar bool) {
 
 	xToolbarViewSetExtendContentToBottomEdge(x.GoPointer(), ExtendVar)
@@ -418,10 +395,10 @@ var xToolbarViewSetExtendContentToTopEdge func(uintptr, bool)
 
 // Sets whether the content widget can extend behind top bars.
 //
-// This can be used in combination with [property@ToolbarView:reveal-top-bars]
+// This can be used in combination with ToolbarView:reveal-top-bars
 // to show and hide toolbars in fullscreen.
 //
-// See [method@ToolbarView.set_extend_content_to_bottom_edge].
+// See set_extend_content_to_bottom_edge.
 func (x *ToolbarView) SetExtendContentToTopEdge(ExtendVar bool) {
 
 	xToolbarViewSetExtendContentToTopEdge(x.GoPointer(), ExtendVar)
@@ -430,15 +407,15 @@ func (x *ToolbarView) SetExtendContentToTopEdge(ExtendVar bool) {
 
 var xToolbarViewSetRevealBottomBars func(uintptr, bool)
 
-// Sets whether bottom bars are revealed for @self.
+// Sets whether bottom bars are revealed for self.
 //
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-bottom-edge] to show and hide
+// ToolbarView:extend-content-to-bottom-edge to show and hide
 // toolbars in fullscreen.
 //
-// See [method@ToolbarView.set_reveal_top_bars].
+// See set_reveal_top_bars.
 func (x *ToolbarView) SetRevealBottomBars(RevealVar bool) {
 
 	xToolbarViewSetRevealBottomBars(x.GoPointer(), RevealVar)
@@ -447,15 +424,15 @@ func (x *ToolbarView) SetRevealBottomBars(RevealVar bool) {
 
 var xToolbarViewSetRevealTopBars func(uintptr, bool)
 
-// Sets whether top bars are revealed for @self.
+// Sets whether top bars are revealed for self.
 //
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-top-edge] to show and hide toolbars
+// ToolbarView:extend-content-to-top-edge to show and hide toolbars
 // in fullscreen.
 //
-// See [method@ToolbarView.set_reveal_bottom_bars].
+// See set_reveal_bottom_bars.
 func (x *ToolbarView) SetRevealTopBars(RevealVar bool) {
 
 	xToolbarViewSetRevealTopBars(x.GoPointer(), RevealVar)
@@ -464,30 +441,32 @@ func (x *ToolbarView) SetRevealTopBars(RevealVar bool) {
 
 var xToolbarViewSetTopBarStyle func(uintptr, ToolbarStyle)
 
-// Sets appearance of the top bars for @self.
+// Sets appearance of the top bars for self.
 //
 // If set to `ADW_TOOLBAR_FLAT`, top bars are flat and scrolling content has a
 // subtle undershoot shadow when touching them, same as the
-// [`.undershoot-top`](style-classes.html#undershoot-indicators)
-// style class. This works well for simple content, e.g. [class@StatusPage] or
-// [class@PreferencesPage], where the background at the top of the page is
+// .undershoot-top
+// style class. This works well for simple content, e.g. StatusPage or
+// PreferencesPage, where the background at the top of the page is
 // uniform. Additionally, windows with sidebars should always use this style.
 //
 // Undershoot shadow is only present if a top bar is actually present and
 // visible. It is also never present if
-// [property@ToolbarView:extend-content-to-top-edge] is set to `TRUE`.
+// ToolbarView:extend-content-to-top-edge is set to `TRUE`.
 //
 // If set to `ADW_TOOLBAR_RAISED`, top bars have an opaque background and a
 // persistent shadow, this is suitable for content such as
-// [utility panes](https://developer.gnome.org/hig/patterns/containers/utility-panes.html),
+// [utility panes],
 // where some elements are directly adjacent to the top bars, or
-// [class@TabView], where each page can have a different background.
+// TabView, where each page can have a different background.
 //
 // `ADW_TOOLBAR_RAISED_BORDER` is similar to `ADW_TOOLBAR_RAISED`, but the
 // shadow is replaced with a more subtle border. This can be useful for
 // applications like image viewers.
 //
-// See also [method@ToolbarView.set_bottom_bar_style].
+// See also set_bottom_bar_style.
+//
+// [utility panes]: https://developer.gnome.org/hig/patterns/containers/utility-panes.html
 func (x *ToolbarView) SetTopBarStyle(StyleVar ToolbarStyle) {
 
 	xToolbarViewSetTopBarStyle(x.GoPointer(), StyleVar)
@@ -509,9 +488,9 @@ func (c *ToolbarView) SetGoPointer(ptr uintptr) {
 // The current bottom bar height.
 //
 // Bottom bar height does change depending on
-// [property@ToolbarView:reveal-bottom-bars], including during the transition.
+// ToolbarView:reveal-bottom-bars, including during the transition.
 //
-// See [property@ToolbarView:top-bar-height].
+// See ToolbarView:top-bar-height.
 func (x *ToolbarView) GetPropertyBottomBarHeight() int {
 	var v gobject.Value
 	x.GetProperty("bottom-bar-height", &v)
@@ -522,10 +501,10 @@ func (x *ToolbarView) GetPropertyBottomBarHeight() int {
 // Whether the content widget can extend behind bottom bars.
 //
 // This can be used in combination with
-// [property@ToolbarView:reveal-bottom-bars] to show and hide toolbars in
+// ToolbarView:reveal-bottom-bars to show and hide toolbars in
 // fullscreen.
 //
-// See [property@ToolbarView:extend-content-to-top-edge].
+// See ToolbarView:extend-content-to-top-edge.
 func (x *ToolbarView) SetPropertyExtendContentToBottomEdge(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -537,10 +516,10 @@ func (x *ToolbarView) SetPropertyExtendContentToBottomEdge(value bool) {
 // Whether the content widget can extend behind bottom bars.
 //
 // This can be used in combination with
-// [property@ToolbarView:reveal-bottom-bars] to show and hide toolbars in
+// ToolbarView:reveal-bottom-bars to show and hide toolbars in
 // fullscreen.
 //
-// See [property@ToolbarView:extend-content-to-top-edge].
+// See ToolbarView:extend-content-to-top-edge.
 func (x *ToolbarView) GetPropertyExtendContentToBottomEdge() bool {
 	var v gobject.Value
 	x.GetProperty("extend-content-to-bottom-edge", &v)
@@ -550,10 +529,10 @@ func (x *ToolbarView) GetPropertyExtendContentToBottomEdge() bool {
 // SetPropertyExtendContentToTopEdge sets the "extend-content-to-top-edge" property.
 // Whether the content widget can extend behind top bars.
 //
-// This can be used in combination with [property@ToolbarView:reveal-top-bars]
+// This can be used in combination with ToolbarView:reveal-top-bars
 // to show and hide toolbars in fullscreen.
 //
-// See [property@ToolbarView:extend-content-to-bottom-edge].
+// See ToolbarView:extend-content-to-bottom-edge.
 func (x *ToolbarView) SetPropertyExtendContentToTopEdge(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -564,10 +543,10 @@ func (x *ToolbarView) SetPropertyExtendContentToTopEdge(value bool) {
 // GetPropertyExtendContentToTopEdge gets the "extend-content-to-top-edge" property.
 // Whether the content widget can extend behind top bars.
 //
-// This can be used in combination with [property@ToolbarView:reveal-top-bars]
+// This can be used in combination with ToolbarView:reveal-top-bars
 // to show and hide toolbars in fullscreen.
 //
-// See [property@ToolbarView:extend-content-to-bottom-edge].
+// See ToolbarView:extend-content-to-bottom-edge.
 func (x *ToolbarView) GetPropertyExtendContentToTopEdge() bool {
 	var v gobject.Value
 	x.GetProperty("extend-content-to-top-edge", &v)
@@ -580,10 +559,10 @@ func (x *ToolbarView) GetPropertyExtendContentToTopEdge() bool {
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-bottom-edge] to show and hide
+// ToolbarView:extend-content-to-bottom-edge to show and hide
 // toolbars in fullscreen.
 //
-// See [property@ToolbarView:reveal-top-bars].
+// See ToolbarView:reveal-top-bars.
 func (x *ToolbarView) SetPropertyRevealBottomBars(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -597,10 +576,10 @@ func (x *ToolbarView) SetPropertyRevealBottomBars(value bool) {
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-bottom-edge] to show and hide
+// ToolbarView:extend-content-to-bottom-edge to show and hide
 // toolbars in fullscreen.
 //
-// See [property@ToolbarView:reveal-top-bars].
+// See ToolbarView:reveal-top-bars.
 func (x *ToolbarView) GetPropertyRevealBottomBars() bool {
 	var v gobject.Value
 	x.GetProperty("reveal-bottom-bars", &v)
@@ -613,10 +592,10 @@ func (x *ToolbarView) GetPropertyRevealBottomBars() bool {
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-top-edge] to show and hide toolbars
+// ToolbarView:extend-content-to-top-edge to show and hide toolbars
 // in fullscreen.
 //
-// See [property@ToolbarView:reveal-bottom-bars].
+// See ToolbarView:reveal-bottom-bars.
 func (x *ToolbarView) SetPropertyRevealTopBars(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -630,10 +609,10 @@ func (x *ToolbarView) SetPropertyRevealTopBars(value bool) {
 // The transition will be animated.
 //
 // This can be used in combination with
-// [property@ToolbarView:extend-content-to-top-edge] to show and hide toolbars
+// ToolbarView:extend-content-to-top-edge to show and hide toolbars
 // in fullscreen.
 //
-// See [property@ToolbarView:reveal-bottom-bars].
+// See ToolbarView:reveal-bottom-bars.
 func (x *ToolbarView) GetPropertyRevealTopBars() bool {
 	var v gobject.Value
 	x.GetProperty("reveal-top-bars", &v)
@@ -643,10 +622,10 @@ func (x *ToolbarView) GetPropertyRevealTopBars() bool {
 // GetPropertyTopBarHeight gets the "top-bar-height" property.
 // The current top bar height.
 //
-// Top bar height does change depending [property@ToolbarView:reveal-top-bars],
+// Top bar height does change depending ToolbarView:reveal-top-bars,
 // including during the transition.
 //
-// See [property@ToolbarView:bottom-bar-height].
+// See ToolbarView:bottom-bar-height.
 func (x *ToolbarView) GetPropertyTopBarHeight() int {
 	var v gobject.Value
 	x.GetProperty("top-bar-height", &v)
@@ -814,7 +793,7 @@ func (x *ToolbarView) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -822,14 +801,13 @@ func (x *ToolbarView) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ToolbarView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -853,19 +831,17 @@ func (x *ToolbarView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ToolbarView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -886,7 +862,7 @@ func (x *ToolbarView) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -895,14 +871,12 @@ func (x *ToolbarView) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ToolbarView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -921,10 +895,10 @@ func (x *ToolbarView) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ToolbarView) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -936,7 +910,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -947,26 +921,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xToolbarViewGLibType, libs, "adw_toolbar_view_get_type")
 
-	core.PuregoSafeRegister(&xNewToolbarView, libs, "adw_toolbar_view_new")
-
-	core.PuregoSafeRegister(&xToolbarViewAddBottomBar, libs, "adw_toolbar_view_add_bottom_bar")
-	core.PuregoSafeRegister(&xToolbarViewAddTopBar, libs, "adw_toolbar_view_add_top_bar")
-	core.PuregoSafeRegister(&xToolbarViewGetBottomBarHeight, libs, "adw_toolbar_view_get_bottom_bar_height")
-	core.PuregoSafeRegister(&xToolbarViewGetBottomBarStyle, libs, "adw_toolbar_view_get_bottom_bar_style")
-	core.PuregoSafeRegister(&xToolbarViewGetContent, libs, "adw_toolbar_view_get_content")
-	core.PuregoSafeRegister(&xToolbarViewGetExtendContentToBottomEdge, libs, "adw_toolbar_view_get_extend_content_to_bottom_edge")
-	core.PuregoSafeRegister(&xToolbarViewGetExtendContentToTopEdge, libs, "adw_toolbar_view_get_extend_content_to_top_edge")
-	core.PuregoSafeRegister(&xToolbarViewGetRevealBottomBars, libs, "adw_toolbar_view_get_reveal_bottom_bars")
-	core.PuregoSafeRegister(&xToolbarViewGetRevealTopBars, libs, "adw_toolbar_view_get_reveal_top_bars")
-	core.PuregoSafeRegister(&xToolbarViewGetTopBarHeight, libs, "adw_toolbar_view_get_top_bar_height")
-	core.PuregoSafeRegister(&xToolbarViewGetTopBarStyle, libs, "adw_toolbar_view_get_top_bar_style")
-	core.PuregoSafeRegister(&xToolbarViewRemove, libs, "adw_toolbar_view_remove")
-	core.PuregoSafeRegister(&xToolbarViewSetBottomBarStyle, libs, "adw_toolbar_view_set_bottom_bar_style")
-	core.PuregoSafeRegister(&xToolbarViewSetContent, libs, "adw_toolbar_view_set_content")
-	core.PuregoSafeRegister(&xToolbarViewSetExtendContentToBottomEdge, libs, "adw_toolbar_view_set_extend_content_to_bottom_edge")
-	core.PuregoSafeRegister(&xToolbarViewSetExtendContentToTopEdge, libs, "adw_toolbar_view_set_extend_content_to_top_edge")
-	core.PuregoSafeRegister(&xToolbarViewSetRevealBottomBars, libs, "adw_toolbar_view_set_reveal_bottom_bars")
-	core.PuregoSafeRegister(&xToolbarViewSetRevealTopBars, libs, "adw_toolbar_view_set_reveal_top_bars")
-	core.PuregoSafeRegister(&xToolbarViewSetTopBarStyle, libs, "adw_toolbar_view_set_top_bar_style")
+	gobject.CheckClassSize("AdwToolbarViewClass", xToolbarViewGLibType, unsafe.Sizeof(ToolbarViewClass{}))
+
+	core.PuregoRegisterSince(&xNewToolbarView, libs, "adw_toolbar_view_new", "ADW", "1.4")
+
+	core.PuregoRegisterSince(&xToolbarViewAddBottomBar, libs, "adw_toolbar_view_add_bottom_bar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewAddTopBar, libs, "adw_toolbar_view_add_top_bar", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetBottomBarHeight, libs, "adw_toolbar_view_get_bottom_bar_height", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetBottomBarStyle, libs, "adw_toolbar_view_get_bottom_bar_style", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetContent, libs, "adw_toolbar_view_get_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetExtendContentToBottomEdge, libs, "adw_toolbar_view_get_extend_content_to_bottom_edge", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetExtendContentToTopEdge, libs, "adw_toolbar_view_get_extend_content_to_top_edge", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetRevealBottomBars, libs, "adw_toolbar_view_get_reveal_bottom_bars", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetRevealTopBars, libs, "adw_toolbar_view_get_reveal_top_bars", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetTopBarHeight, libs, "adw_toolbar_view_get_top_bar_height", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewGetTopBarStyle, libs, "adw_toolbar_view_get_top_bar_style", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewRemove, libs, "adw_toolbar_view_remove", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetBottomBarStyle, libs, "adw_toolbar_view_set_bottom_bar_style", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetContent, libs, "adw_toolbar_view_set_content", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetExtendContentToBottomEdge, libs, "adw_toolbar_view_set_extend_content_to_bottom_edge", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetExtendContentToTopEdge, libs, "adw_toolbar_view_set_extend_content_to_top_edge", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetRevealBottomBars, libs, "adw_toolbar_view_set_reveal_bottom_bars", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetRevealTopBars, libs, "adw_toolbar_view_set_reveal_top_bars", "ADW", "1.4")
+	core.PuregoRegisterSince(&xToolbarViewSetTopBarStyle, libs, "adw_toolbar_view_set_top_bar_style", "ADW", "1.4")
 
 }
@@ -2,7 +2,6 @@
 package adw
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -25,7 +24,7 @@ var xGetMajorVersion func() uint
 // For example, in libadwaita version 1.2.3 this is 1.
 //
 // This function is in the library, so it represents the libadwaita library your
-// code is running against. Contrast with the [const@MAJOR_VERSION] constant,
+// code is running against. Contrast with the MAJOR_VERSION constant,
 // which represents the major version of the libadwaita headers you have
 // included when compiling your code.
 func GetMajorVersion() uint {
@@ -41,7 +40,7 @@ var xGetMicroVersion func() uint
 // For example, in libadwaita version 1.2.3 this is 3.
 //
 // This function is in the library, so it represents the libadwaita library your
-// code is running against. Contrast with the [const@MAJOR_VERSION] constant,
+// code is running against. Contrast with the MAJOR_VERSION constant,
 // which represents the micro version of the libadwaita headers you have
 // included when compiling your code.
 func GetMicroVersion() uint {
@@ -57,7 +56,7 @@ var xGetMinorVersion func() uint
 // For example, in libadwaita version 1.2.3 this is 2.
 //
 // This function is in the library, so it represents the libadwaita library your
-// code is running against. Contrast with the [const@MAJOR_VERSION] constant,
+// code is running against. Contrast with the MAJOR_VERSION constant,
 // which represents the minor version of the libadwaita headers you have
 // included when compiling your code.
 func GetMinorVersion() uint {
@@ -66,20 +65,32 @@ func GetMinorVersion() uint {
 	return cret
 }
 
+// CheckVersionAtLeast returns whether the loaded ADW library is version major.minor.micro or later,
+// calling a function that was added in a later version panics with a core.MissingSymbolError.
+func CheckVersionAtLeast(major, minor, micro uint) bool {
+	if v := GetMajorVersion(); v != major {
+		return v > major
+	}
+	if v := GetMinorVersion(); v != minor {
+		return v > minor
+	}
+	return GetMicroVersion() >= micro
+}
+
 func init() {
 	core.SetPackageName("ADW", "libadwaita-1")
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xGetMajorVersion, libs, "adw_get_major_version")
-	core.PuregoSafeRegister(&xGetMicroVersion, libs, "adw_get_micro_version")
-	core.PuregoSafeRegister(&xGetMinorVersion, libs, "adw_get_minor_version")
+	core.PuregoRegisterSince(&xGetMajorVersion, libs, "adw_get_major_version", "ADW", "")
+	core.PuregoRegisterSince(&xGetMicroVersion, libs, "adw_get_micro_version", "ADW", "")
+	core.PuregoRegisterSince(&xGetMinorVersion, libs, "adw_get_minor_version", "ADW", "")
 
 }
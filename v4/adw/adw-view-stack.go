@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -43,61 +42,57 @@ func (x *ViewStackPagesClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A view container for [class@ViewSwitcher].
+// A view container for ViewSwitcher.
 //
 // `AdwViewStack` is a container which only shows one page at a time.
 // It is typically used to hold an application's main views.
 //
 // It doesn't provide a way to transition between pages.
-// Instead, a separate widget such as [class@ViewSwitcher] can be used with
+// Instead, a separate widget such as ViewSwitcher can be used with
 // `AdwViewStack` to provide this functionality.
 //
 // `AdwViewStack` pages can have a title, an icon, an attention request, and a
-// numbered badge that [class@ViewSwitcher] will use to let users identify which
-// page is which. Set them using the [property@ViewStackPage:title],
-// [property@ViewStackPage:icon-name],
-// [property@ViewStackPage:needs-attention], and
-// [property@ViewStackPage:badge-number] properties.
+// numbered badge that ViewSwitcher will use to let users identify which
+// page is which. Set them using the ViewStackPage:title,
+// ViewStackPage:icon-name,
+// ViewStackPage:needs-attention, and
+// ViewStackPage:badge-number properties.
 //
-// Unlike [class@Gtk.Stack], transitions between views can only be animated via
+// Unlike [gtk.Stack], transitions between views can only be animated via
 // a crossfade and size changes are always interpolated. Animations are disabled
-// by default. Use [property@ViewStack:enable-transitions] to enable them.
+// by default. Use ViewStack:enable-transitions to enable them.
 //
-// `AdwViewStack` maintains a [class@ViewStackPage] object for each added child,
+// `AdwViewStack` maintains a ViewStackPage object for each added child,
 // which holds additional per-child properties. You obtain the
-// [class@ViewStackPage] for a child with [method@ViewStack.get_page] and you
-// can obtain a [iface@Gtk.SelectionModel] containing all the pages with
-// [method@ViewStack.get_pages].
+// ViewStackPage for a child with get_page and you
+// can obtain a [gtk.SelectionModel] containing all the pages with
+// get_pages.
 //
-// ## AdwViewStack as GtkBuildable
+// # AdwViewStack as GtkBuildable
 //
 // To set child-specific properties in a .ui file, create
-// [class@ViewStackPage] objects explicitly, and set the child widget as a
+// ViewStackPage objects explicitly, and set the child widget as a
 // property on it:
 //
-// ```xml
-//
-//	&lt;object class="AdwViewStack" id="stack"&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="AdwViewStackPage"&gt;
-//	      &lt;property name="name"&gt;overview&lt;/property&gt;
-//	      &lt;property name="title"&gt;Overview&lt;/property&gt;
-//	      &lt;property name="child"&gt;
-//	        &lt;object class="AdwStatusPage"&gt;
-//	          &lt;property name="title"&gt;Welcome!&lt;/property&gt;
-//	        &lt;/object&gt;
-//	      &lt;/property&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	&lt;/object&gt;
-//
-// ```
-//
-// ## CSS nodes
+//	<object class="AdwViewStack" id="stack">
+//	  <child>
+//	    <object class="AdwViewStackPage">
+//	      <property name="name">overview</property>
+//	      <property name="title">Overview</property>
+//	      <property name="child">
+//	        <object class="AdwStatusPage">
+//	          <property name="title">Welcome!</property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// # CSS nodes
 //
 // `AdwViewStack` has a single CSS node named `stack`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwViewStack` uses the `GTK_ACCESSIBLE_ROLE_TAB_PANEL` for the stack pages
 // which are the accessible parent objects of the child widgets.
@@ -136,7 +131,7 @@ func NewViewStack() *ViewStack {
 
 var xViewStackAdd func(uintptr, uintptr) uintptr
 
-// Adds a child to @self.
+// Adds a child to self.
 func (x *ViewStack) Add(ChildVar *gtk.Widget) *ViewStackPage {
 	var cls *ViewStackPage
 
@@ -153,9 +148,9 @@ func (x *ViewStack) Add(ChildVar *gtk.Widget) *ViewStackPage {
 
 var xViewStackAddNamed func(uintptr, uintptr, uintptr) uintptr
 
-// Adds a child to @self.
+// Adds a child to self.
 //
-// The child is identified by the @name.
+// The child is identified by the name.
 func (x *ViewStack) AddNamed(ChildVar *gtk.Widget, NameVar *string) *ViewStackPage {
 	var cls *ViewStackPage
 
@@ -175,10 +170,10 @@ func (x *ViewStack) AddNamed(ChildVar *gtk.Widget, NameVar *string) *ViewStackPa
 
 var xViewStackAddTitled func(uintptr, uintptr, uintptr, string) uintptr
 
-// Adds a child to @self.
+// Adds a child to self.
 //
-// The child is identified by the @name. The @title will be used by
-// [class@ViewSwitcher] to represent @child, so it should be short.
+// The child is identified by the name. The title will be used by
+// ViewSwitcher to represent child, so it should be short.
 func (x *ViewStack) AddTitled(ChildVar *gtk.Widget, NameVar *string, TitleVar string) *ViewStackPage {
 	var cls *ViewStackPage
 
@@ -198,10 +193,10 @@ func (x *ViewStack) AddTitled(ChildVar *gtk.Widget, NameVar *string, TitleVar st
 
 var xViewStackAddTitledWithIcon func(uintptr, uintptr, uintptr, string, string) uintptr
 
-// Adds a child to @self.
+// Adds a child to self.
 //
-// The child is identified by the @name. The @title and @icon_name will be used
-// by [class@ViewSwitcher] to represent @child.
+// The child is identified by the name. The title and icon_name will be used
+// by ViewSwitcher to represent child.
 func (x *ViewStack) AddTitledWithIcon(ChildVar *gtk.Widget, NameVar *string, TitleVar string, IconNameVar string) *ViewStackPage {
 	var cls *ViewStackPage
 
@@ -221,7 +216,7 @@ func (x *ViewStack) AddTitledWithIcon(ChildVar *gtk.Widget, NameVar *string, Tit
 
 var xViewStackGetChildByName func(uintptr, string) uintptr
 
-// Finds the child with @name in @self.
+// Finds the child with name in self.
 func (x *ViewStack) GetChildByName(NameVar string) *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -238,10 +233,10 @@ func (x *ViewStack) GetChildByName(NameVar string) *gtk.Widget {
 
 var xViewStackGetEnableTransitions func(uintptr) bool
 
-// Gets whether @self uses a crossfade transition between pages.
+// Gets whether self uses a crossfade transition between pages.
 //
-// Use [property@ViewStack:transition-duration] to control the duration, and
-// [property@ViewStack:transition-running] to know when the transition is
+// Use ViewStack:transition-duration to control the duration, and
+// ViewStack:transition-running to know when the transition is
 // running.
 func (x *ViewStack) GetEnableTransitions() bool {
 
@@ -251,7 +246,7 @@ func (x *ViewStack) GetEnableTransitions() bool {
 
 var xViewStackGetHhomogeneous func(uintptr) bool
 
-// Gets whether @self is horizontally homogeneous.
+// Gets whether self is horizontally homogeneous.
 func (x *ViewStack) GetHhomogeneous() bool {
 
 	cret := xViewStackGetHhomogeneous(x.GoPointer())
@@ -260,7 +255,7 @@ func (x *ViewStack) GetHhomogeneous() bool {
 
 var xViewStackGetPage func(uintptr, uintptr) uintptr
 
-// Gets the [class@ViewStackPage] object for @child.
+// Gets the ViewStackPage object for child.
 func (x *ViewStack) GetPage(ChildVar *gtk.Widget) *ViewStackPage {
 	var cls *ViewStackPage
 
@@ -277,10 +272,10 @@ func (x *ViewStack) GetPage(ChildVar *gtk.Widget) *ViewStackPage {
 
 var xViewStackGetPages func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the pages of the stack.
+// Returns a [gio.ListModel] that contains the pages of the stack.
 //
 // This can be used to keep an up-to-date view. The model also implements
-// [iface@Gtk.SelectionModel] and can be used to track and change the visible
+// [gtk.SelectionModel] and can be used to track and change the visible
 // page.
 func (x *ViewStack) GetPages() *gtk.SelectionModelBase {
 	var cls *gtk.SelectionModelBase
@@ -297,7 +292,7 @@ func (x *ViewStack) GetPages() *gtk.SelectionModelBase {
 
 var xViewStackGetTransitionDuration func(uintptr) uint
 
-// Gets the transition animation duration for @self.
+// Gets the transition animation duration for self.
 func (x *ViewStack) GetTransitionDuration() uint {
 
 	cret := xViewStackGetTransitionDuration(x.GoPointer())
@@ -306,7 +301,7 @@ func (x *ViewStack) GetTransitionDuration() uint {
 
 var xViewStackGetTransitionRunning func(uintptr) bool
 
-// Gets whether a transition is currently running for @self.
+// Gets whether a transition is currently running for self.
 //
 // If a transition is impossible, the property value will be set to `TRUE` and
 // then immediately to `FALSE`, so it's possible to rely on its notifications
@@ -319,7 +314,7 @@ func (x *ViewStack) GetTransitionRunning() bool {
 
 var xViewStackGetVhomogeneous func(uintptr) bool
 
-// Gets whether @self is vertically homogeneous.
+// Gets whether self is vertically homogeneous.
 func (x *ViewStack) GetVhomogeneous() bool {
 
 	cret := xViewStackGetVhomogeneous(x.GoPointer())
@@ -328,7 +323,7 @@ func (x *ViewStack) GetVhomogeneous() bool {
 
 var xViewStackGetVisibleChild func(uintptr) uintptr
 
-// Gets the currently visible child of @self.
+// Gets the currently visible child of self.
 func (x *ViewStack) GetVisibleChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -345,7 +340,7 @@ func (x *ViewStack) GetVisibleChild() *gtk.Widget {
 
 var xViewStackGetVisibleChildName func(uintptr) string
 
-// Returns the name of the currently visible child of @self.
+// Returns the name of the currently visible child of self.
 func (x *ViewStack) GetVisibleChildName() string {
 
 	cret := xViewStackGetVisibleChildName(x.GoPointer())
@@ -354,7 +349,7 @@ func (x *ViewStack) GetVisibleChildName() string {
 
 var xViewStackRemove func(uintptr, uintptr)
 
-// Removes a child widget from @self.
+// Removes a child widget from self.
 func (x *ViewStack) Remove(ChildVar *gtk.Widget) {
 
 	xViewStackRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -363,7 +358,7 @@ func (x *ViewStack) Remove(ChildVar *gtk.Widget) {
 
 var xViewStackSetEnableTransitions func(uintptr, bool)
 
-// Sets whether @self uses a crossfade transition between pages.
+// Sets whether self uses a crossfade transition between pages.
 func (x *ViewStack) SetEnableTransitions(EnableTransitionsVar bool) {
 
 	xViewStackSetEnableTransitions(x.GoPointer(), EnableTransitionsVar)
@@ -372,7 +367,7 @@ func (x *ViewStack) SetEnableTransitions(EnableTransitionsVar bool) {
 
 var xViewStackSetHhomogeneous func(uintptr, bool)
 
-// Sets @self to be horizontally homogeneous or not.
+// Sets self to be horizontally homogeneous or not.
 //
 // If the stack is horizontally homogeneous, it allocates the same width for
 // all children.
@@ -387,9 +382,9 @@ func (x *ViewStack) SetHhomogeneous(HhomogeneousVar bool) {
 
 var xViewStackSetTransitionDuration func(uintptr, uint)
 
-// Sets the transition animation duration for @self.
+// Sets the transition animation duration for self.
 //
-// Only used when [property@ViewStack:enable-transitions] is set to `TRUE`.
+// Only used when ViewStack:enable-transitions is set to `TRUE`.
 func (x *ViewStack) SetTransitionDuration(DurationVar uint) {
 
 	xViewStackSetTransitionDuration(x.GoPointer(), DurationVar)
@@ -398,7 +393,7 @@ func (x *ViewStack) SetTransitionDuration(DurationVar uint) {
 
 var xViewStackSetVhomogeneous func(uintptr, bool)
 
-// Sets @self to be vertically homogeneous or not.
+// Sets self to be vertically homogeneous or not.
 //
 // If the stack is vertically homogeneous, it allocates the same height for
 // all children.
@@ -413,7 +408,7 @@ func (x *ViewStack) SetVhomogeneous(VhomogeneousVar bool) {
 
 var xViewStackSetVisibleChild func(uintptr, uintptr)
 
-// Makes @child the visible child of @self.
+// Makes child the visible child of self.
 func (x *ViewStack) SetVisibleChild(ChildVar *gtk.Widget) {
 
 	xViewStackSetVisibleChild(x.GoPointer(), ChildVar.GoPointer())
@@ -422,9 +417,9 @@ func (x *ViewStack) SetVisibleChild(ChildVar *gtk.Widget) {
 
 var xViewStackSetVisibleChildName func(uintptr, string)
 
-// Makes the child with @name visible.
+// Makes the child with name visible.
 //
-// See [property@ViewStack:visible-child].
+// See ViewStack:visible-child.
 func (x *ViewStack) SetVisibleChildName(NameVar string) {
 
 	xViewStackSetVisibleChildName(x.GoPointer(), NameVar)
@@ -445,8 +440,8 @@ func (c *ViewStack) SetGoPointer(ptr uintptr) {
 // SetPropertyEnableTransitions sets the "enable-transitions" property.
 // Whether the stack uses a crossfade transition between pages.
 //
-// Use [property@ViewStack:transition-duration] to control the duration, and
-// [property@ViewStack:transition-running] to know when the transition is
+// Use ViewStack:transition-duration to control the duration, and
+// ViewStack:transition-running to know when the transition is
 // running.
 func (x *ViewStack) SetPropertyEnableTransitions(value bool) {
 	var v gobject.Value
@@ -458,8 +453,8 @@ func (x *ViewStack) SetPropertyEnableTransitions(value bool) {
 // GetPropertyEnableTransitions gets the "enable-transitions" property.
 // Whether the stack uses a crossfade transition between pages.
 //
-// Use [property@ViewStack:transition-duration] to control the duration, and
-// [property@ViewStack:transition-running] to know when the transition is
+// Use ViewStack:transition-duration to control the duration, and
+// ViewStack:transition-running to know when the transition is
 // running.
 func (x *ViewStack) GetPropertyEnableTransitions() bool {
 	var v gobject.Value
@@ -499,7 +494,7 @@ func (x *ViewStack) GetPropertyHhomogeneous() bool {
 // SetPropertyTransitionDuration sets the "transition-duration" property.
 // The transition animation duration, in milliseconds.
 //
-// Only used when [property@ViewStack:enable-transitions] is set to `TRUE`.
+// Only used when ViewStack:enable-transitions is set to `TRUE`.
 func (x *ViewStack) SetPropertyTransitionDuration(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -510,7 +505,7 @@ func (x *ViewStack) SetPropertyTransitionDuration(value uint) {
 // GetPropertyTransitionDuration gets the "transition-duration" property.
 // The transition animation duration, in milliseconds.
 //
-// Only used when [property@ViewStack:enable-transitions] is set to `TRUE`.
+// Only used when ViewStack:enable-transitions is set to `TRUE`.
 func (x *ViewStack) GetPropertyTransitionDuration() uint {
 	var v gobject.Value
 	x.GetProperty("transition-duration", &v)
@@ -561,7 +556,7 @@ func (x *ViewStack) GetPropertyVhomogeneous() bool {
 // SetPropertyVisibleChildName sets the "visible-child-name" property.
 // The name of the widget currently visible in the stack.
 //
-// See [property@ViewStack:visible-child].
+// See ViewStack:visible-child.
 func (x *ViewStack) SetPropertyVisibleChildName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -572,7 +567,7 @@ func (x *ViewStack) SetPropertyVisibleChildName(value string) {
 // GetPropertyVisibleChildName gets the "visible-child-name" property.
 // The name of the widget currently visible in the stack.
 //
-// See [property@ViewStack:visible-child].
+// See ViewStack:visible-child.
 func (x *ViewStack) GetPropertyVisibleChildName() string {
 	var v gobject.Value
 	x.GetProperty("visible-child-name", &v)
@@ -740,7 +735,7 @@ func (x *ViewStack) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -748,14 +743,13 @@ func (x *ViewStack) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ViewStack) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -779,19 +773,17 @@ func (x *ViewStack) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ViewStack) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -812,7 +804,7 @@ func (x *ViewStack) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -821,14 +813,12 @@ func (x *ViewStack) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ViewStack) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -847,17 +837,17 @@ func (x *ViewStack) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ViewStack) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// An auxiliary class used by [class@ViewStack].
+// An auxiliary class used by ViewStack.
 type ViewStackPage struct {
 	gobject.Object
 }
@@ -885,7 +875,7 @@ func (x *ViewStackPage) GetBadgeNumber() uint {
 
 var xViewStackPageGetChild func(uintptr) uintptr
 
-// Gets the stack child to which @self belongs.
+// Gets the stack child to which self belongs.
 func (x *ViewStackPage) GetChild() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -947,9 +937,9 @@ func (x *ViewStackPage) GetUseUnderline() bool {
 
 var xViewStackPageGetVisible func(uintptr) bool
 
-// Gets whether @self is visible in its `AdwViewStack`.
+// Gets whether self is visible in its `AdwViewStack`.
 //
-// This is independent from the [property@Gtk.Widget:visible]
+// This is independent from the [gtk.Widget.GetPropertyVisible]
 // property of its widget.
 func (x *ViewStackPage) GetVisible() bool {
 
@@ -961,7 +951,7 @@ var xViewStackPageSetBadgeNumber func(uintptr, uint)
 
 // Sets the badge number for this page.
 //
-// [class@ViewSwitcher] can display it as a badge next to the page icon. It is
+// ViewSwitcher can display it as a badge next to the page icon. It is
 // commonly used to display a number of unread items within the page.
 //
 // It can be used together with [property@ViewStack{age}:needs-attention].
@@ -999,7 +989,7 @@ var xViewStackPageSetNeedsAttention func(uintptr, bool)
 
 // Sets whether the page requires the user attention.
 //
-// [class@ViewSwitcher] will display it as a dot next to the page icon.
+// ViewSwitcher will display it as a dot next to the page icon.
 func (x *ViewStackPage) SetNeedsAttention(NeedsAttentionVar bool) {
 
 	xViewStackPageSetNeedsAttention(x.GoPointer(), NeedsAttentionVar)
@@ -1029,10 +1019,10 @@ func (x *ViewStackPage) SetUseUnderline(UseUnderlineVar bool) {
 
 var xViewStackPageSetVisible func(uintptr, bool)
 
-// Sets whether @page is visible in its `AdwViewStack`.
+// Sets whether page is visible in its `AdwViewStack`.
 //
-// This is independent from the [property@Gtk.Widget:visible] property of
-// [property@ViewStackPage:child].
+// This is independent from the [gtk.Widget.GetPropertyVisible] property of
+// ViewStackPage:child.
 func (x *ViewStackPage) SetVisible(VisibleVar bool) {
 
 	xViewStackPageSetVisible(x.GoPointer(), VisibleVar)
@@ -1053,7 +1043,7 @@ func (c *ViewStackPage) SetGoPointer(ptr uintptr) {
 // SetPropertyBadgeNumber sets the "badge-number" property.
 // The badge number for this page.
 //
-// [class@ViewSwitcher] can display it as a badge next to the page icon. It is
+// ViewSwitcher can display it as a badge next to the page icon. It is
 // commonly used to display a number of unread items within the page.
 //
 // It can be used together with [property@ViewStack{age}:needs-attention].
@@ -1067,7 +1057,7 @@ func (x *ViewStackPage) SetPropertyBadgeNumber(value uint) {
 // GetPropertyBadgeNumber gets the "badge-number" property.
 // The badge number for this page.
 //
-// [class@ViewSwitcher] can display it as a badge next to the page icon. It is
+// ViewSwitcher can display it as a badge next to the page icon. It is
 // commonly used to display a number of unread items within the page.
 //
 // It can be used together with [property@ViewStack{age}:needs-attention].
@@ -1114,7 +1104,7 @@ func (x *ViewStackPage) GetPropertyName() string {
 // SetPropertyNeedsAttention sets the "needs-attention" property.
 // Whether the page requires the user attention.
 //
-// [class@ViewSwitcher] will display it as a dot next to the page icon.
+// ViewSwitcher will display it as a dot next to the page icon.
 func (x *ViewStackPage) SetPropertyNeedsAttention(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1125,7 +1115,7 @@ func (x *ViewStackPage) SetPropertyNeedsAttention(value bool) {
 // GetPropertyNeedsAttention gets the "needs-attention" property.
 // Whether the page requires the user attention.
 //
-// [class@ViewSwitcher] will display it as a dot next to the page icon.
+// ViewSwitcher will display it as a dot next to the page icon.
 func (x *ViewStackPage) GetPropertyNeedsAttention() bool {
 	var v gobject.Value
 	x.GetProperty("needs-attention", &v)
@@ -1169,8 +1159,8 @@ func (x *ViewStackPage) GetPropertyUseUnderline() bool {
 // SetPropertyVisible sets the "visible" property.
 // Whether this page is visible.
 //
-// This is independent from the [property@Gtk.Widget:visible] property of
-// [property@ViewStackPage:child].
+// This is independent from the [gtk.Widget.GetPropertyVisible] property of
+// ViewStackPage:child.
 func (x *ViewStackPage) SetPropertyVisible(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1181,8 +1171,8 @@ func (x *ViewStackPage) SetPropertyVisible(value bool) {
 // GetPropertyVisible gets the "visible" property.
 // Whether this page is visible.
 //
-// This is independent from the [property@Gtk.Widget:visible] property of
-// [property@ViewStackPage:child].
+// This is independent from the [gtk.Widget.GetPropertyVisible] property of
+// ViewStackPage:child.
 func (x *ViewStackPage) GetPropertyVisible() bool {
 	var v gobject.Value
 	x.GetProperty("visible", &v)
@@ -1350,7 +1340,7 @@ func (x *ViewStackPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1358,14 +1348,13 @@ func (x *ViewStackPage) UpdatePlatformState(StateVar gtk.AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ViewStackPage) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1389,19 +1378,17 @@ func (x *ViewStackPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ViewStackPage) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1422,7 +1409,7 @@ func (x *ViewStackPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1431,14 +1418,12 @@ func (x *ViewStackPage) UpdateRelationValue(NRelationsVar int, RelationsVar []gt
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ViewStackPage) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1457,9 +1442,9 @@ func (x *ViewStackPage) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessi
 
 }
 
-// An auxiliary class used by [class@ViewStack].
+// An auxiliary class used by ViewStack.
 //
-// See [property@ViewStack:pages].
+// See ViewStack:pages.
 type ViewStackPages struct {
 	gobject.Object
 }
@@ -1478,9 +1463,9 @@ func ViewStackPagesNewFromInternalPtr(ptr uintptr) *ViewStackPages {
 
 var xViewStackPagesGetSelectedPage func(uintptr) uintptr
 
-// Gets the [class@ViewStackPage] for the visible child of a view stack
+// Gets the ViewStackPage for the visible child of a view stack
 //
-// Gets the [class@ViewStackPage] for the visible child of the associated stack.
+// Gets the ViewStackPage for the visible child of the associated stack.
 //
 // Returns `NULL` if there's no selected page.
 func (x *ViewStackPages) GetSelectedPage() *ViewStackPage {
@@ -1499,9 +1484,9 @@ func (x *ViewStackPages) GetSelectedPage() *ViewStackPage {
 
 var xViewStackPagesSetSelectedPage func(uintptr, uintptr)
 
-// Sets the visible child in the associated [class@ViewStack].
+// Sets the visible child in the associated ViewStack.
 //
-// See [property@ViewStack:visible-child].
+// See ViewStack:visible-child.
 func (x *ViewStackPages) SetSelectedPage(PageVar *ViewStackPage) {
 
 	xViewStackPagesSetSelectedPage(x.GoPointer(), PageVar.GoPointer())
@@ -1519,28 +1504,30 @@ func (c *ViewStackPages) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *ViewStackPages) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *ViewStackPages) GetItemType() types.GType {
 
@@ -1548,29 +1535,29 @@ func (x *ViewStackPages) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *ViewStackPages) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *ViewStackPages) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -1584,20 +1571,20 @@ func (x *ViewStackPages) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -1613,8 +1600,8 @@ func (x *ViewStackPages) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVa
 // Gets the set containing all currently selected items in the model.
 //
 // This function may be slow, so if you are only interested in single item,
-// consider using [method@Gtk.SelectionModel.is_selected] or if you are only
-// interested in a few, consider [method@Gtk.SelectionModel.get_selection_in_range].
+// consider using [gtk.SelectionModel.IsSelected] or if you are only
+// interested in a few, consider [gtk.SelectionModel.GetSelectionInRange].
 func (x *ViewStackPages) GetSelection() *gtk.Bitset {
 
 	cret := gtk.XGtkSelectionModelGetSelection(x.GoPointer())
@@ -1624,9 +1611,9 @@ func (x *ViewStackPages) GetSelection() *gtk.Bitset {
 // Gets the set of selected items in a range.
 //
 // This function is an optimization for
-// [method@Gtk.SelectionModel.get_selection] when you are only
+// [gtk.SelectionModel.GetSelection] when you are only
 // interested in part of the model's selected state. A common use
-// case is in response to the [signal@Gtk.SelectionModel::selection-changed]
+// case is in response to the SelectionModel::selection-changed
 // signal.
 func (x *ViewStackPages) GetSelectionInRange(PositionVar uint, NItemsVar uint) *gtk.Bitset {
 
@@ -1665,7 +1652,7 @@ func (x *ViewStackPages) SelectRange(PositionVar uint, NItemsVar uint, UnselectR
 // Helper function for implementations of `GtkSelectionModel`.
 //
 // Call this when the selection changes to emit the
-// [signal@Gtk.SelectionModel::selection-changed] signal.
+// SelectionModel::selection-changed signal.
 func (x *ViewStackPages) SelectionChanged(PositionVar uint, NItemsVar uint) {
 
 	gtk.XGtkSelectionModelSelectionChanged(x.GoPointer(), PositionVar, NItemsVar)
@@ -1679,33 +1666,28 @@ func (x *ViewStackPages) SelectionChanged(PositionVar uint, NItemsVar uint) {
 // you should try the simpler versions, as implementations are more
 // likely to implement support for those.
 //
-// Requests that the selection state of all positions set in @mask
-// be updated to the respective value in the @selected bitmask.
+// Requests that the selection state of all positions set in mask
+// be updated to the respective value in the selected bitmask.
 //
 // In pseudocode, it would look something like this:
 //
-// ```c
-// for (i = 0; i &lt; n_items; i++)
-//
-//	{
-//	  // don't change values not in the mask
-//	  if (!gtk_bitset_contains (mask, i))
-//	    continue;
+//	for (i = 0; i < n_items; i++)
+//	  {
+//	    // don't change values not in the mask
+//	    if (!gtk_bitset_contains (mask, i))
+//	      continue;
 //
-//	  if (gtk_bitset_contains (selected, i))
-//	    select_item (i);
-//	  else
-//	    unselect_item (i);
-//	}
+//	    if (gtk_bitset_contains (selected, i))
+//	      select_item (i);
+//	    else
+//	      unselect_item (i);
+//	  }
 //
-// gtk_selection_model_selection_changed (model,
+//	gtk_selection_model_selection_changed (model,
+//	                                       first_changed_item,
+//	                                       n_changed_items);
 //
-//	first_changed_item,
-//	n_changed_items);
-//
-// ```
-//
-// @mask and @selected must not be modified. They may refer to the
+// mask and selected must not be modified. They may refer to the
 // same bitset, which would mean that every item in the set should
 // be selected.
 func (x *ViewStackPages) SetSelection(SelectedVar *gtk.Bitset, MaskVar *gtk.Bitset) bool {
@@ -1740,7 +1722,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1749,51 +1731,57 @@ func init() {
 
 	core.PuregoSafeRegister(&xViewStackGLibType, libs, "adw_view_stack_get_type")
 
-	core.PuregoSafeRegister(&xNewViewStack, libs, "adw_view_stack_new")
-
-	core.PuregoSafeRegister(&xViewStackAdd, libs, "adw_view_stack_add")
-	core.PuregoSafeRegister(&xViewStackAddNamed, libs, "adw_view_stack_add_named")
-	core.PuregoSafeRegister(&xViewStackAddTitled, libs, "adw_view_stack_add_titled")
-	core.PuregoSafeRegister(&xViewStackAddTitledWithIcon, libs, "adw_view_stack_add_titled_with_icon")
-	core.PuregoSafeRegister(&xViewStackGetChildByName, libs, "adw_view_stack_get_child_by_name")
-	core.PuregoSafeRegister(&xViewStackGetEnableTransitions, libs, "adw_view_stack_get_enable_transitions")
-	core.PuregoSafeRegister(&xViewStackGetHhomogeneous, libs, "adw_view_stack_get_hhomogeneous")
-	core.PuregoSafeRegister(&xViewStackGetPage, libs, "adw_view_stack_get_page")
-	core.PuregoSafeRegister(&xViewStackGetPages, libs, "adw_view_stack_get_pages")
-	core.PuregoSafeRegister(&xViewStackGetTransitionDuration, libs, "adw_view_stack_get_transition_duration")
-	core.PuregoSafeRegister(&xViewStackGetTransitionRunning, libs, "adw_view_stack_get_transition_running")
-	core.PuregoSafeRegister(&xViewStackGetVhomogeneous, libs, "adw_view_stack_get_vhomogeneous")
-	core.PuregoSafeRegister(&xViewStackGetVisibleChild, libs, "adw_view_stack_get_visible_child")
-	core.PuregoSafeRegister(&xViewStackGetVisibleChildName, libs, "adw_view_stack_get_visible_child_name")
-	core.PuregoSafeRegister(&xViewStackRemove, libs, "adw_view_stack_remove")
-	core.PuregoSafeRegister(&xViewStackSetEnableTransitions, libs, "adw_view_stack_set_enable_transitions")
-	core.PuregoSafeRegister(&xViewStackSetHhomogeneous, libs, "adw_view_stack_set_hhomogeneous")
-	core.PuregoSafeRegister(&xViewStackSetTransitionDuration, libs, "adw_view_stack_set_transition_duration")
-	core.PuregoSafeRegister(&xViewStackSetVhomogeneous, libs, "adw_view_stack_set_vhomogeneous")
-	core.PuregoSafeRegister(&xViewStackSetVisibleChild, libs, "adw_view_stack_set_visible_child")
-	core.PuregoSafeRegister(&xViewStackSetVisibleChildName, libs, "adw_view_stack_set_visible_child_name")
+	gobject.CheckClassSize("AdwViewStackClass", xViewStackGLibType, unsafe.Sizeof(ViewStackClass{}))
+
+	core.PuregoRegisterSince(&xNewViewStack, libs, "adw_view_stack_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xViewStackAdd, libs, "adw_view_stack_add", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackAddNamed, libs, "adw_view_stack_add_named", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackAddTitled, libs, "adw_view_stack_add_titled", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackAddTitledWithIcon, libs, "adw_view_stack_add_titled_with_icon", "ADW", "1.2")
+	core.PuregoRegisterSince(&xViewStackGetChildByName, libs, "adw_view_stack_get_child_by_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetEnableTransitions, libs, "adw_view_stack_get_enable_transitions", "ADW", "1.7")
+	core.PuregoRegisterSince(&xViewStackGetHhomogeneous, libs, "adw_view_stack_get_hhomogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetPage, libs, "adw_view_stack_get_page", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetPages, libs, "adw_view_stack_get_pages", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetTransitionDuration, libs, "adw_view_stack_get_transition_duration", "ADW", "1.7")
+	core.PuregoRegisterSince(&xViewStackGetTransitionRunning, libs, "adw_view_stack_get_transition_running", "ADW", "1.7")
+	core.PuregoRegisterSince(&xViewStackGetVhomogeneous, libs, "adw_view_stack_get_vhomogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetVisibleChild, libs, "adw_view_stack_get_visible_child", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackGetVisibleChildName, libs, "adw_view_stack_get_visible_child_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackRemove, libs, "adw_view_stack_remove", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackSetEnableTransitions, libs, "adw_view_stack_set_enable_transitions", "ADW", "1.7")
+	core.PuregoRegisterSince(&xViewStackSetHhomogeneous, libs, "adw_view_stack_set_hhomogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackSetTransitionDuration, libs, "adw_view_stack_set_transition_duration", "ADW", "1.7")
+	core.PuregoRegisterSince(&xViewStackSetVhomogeneous, libs, "adw_view_stack_set_vhomogeneous", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackSetVisibleChild, libs, "adw_view_stack_set_visible_child", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackSetVisibleChildName, libs, "adw_view_stack_set_visible_child_name", "ADW", "")
 
 	core.PuregoSafeRegister(&xViewStackPageGLibType, libs, "adw_view_stack_page_get_type")
 
-	core.PuregoSafeRegister(&xViewStackPageGetBadgeNumber, libs, "adw_view_stack_page_get_badge_number")
-	core.PuregoSafeRegister(&xViewStackPageGetChild, libs, "adw_view_stack_page_get_child")
-	core.PuregoSafeRegister(&xViewStackPageGetIconName, libs, "adw_view_stack_page_get_icon_name")
-	core.PuregoSafeRegister(&xViewStackPageGetName, libs, "adw_view_stack_page_get_name")
-	core.PuregoSafeRegister(&xViewStackPageGetNeedsAttention, libs, "adw_view_stack_page_get_needs_attention")
-	core.PuregoSafeRegister(&xViewStackPageGetTitle, libs, "adw_view_stack_page_get_title")
-	core.PuregoSafeRegister(&xViewStackPageGetUseUnderline, libs, "adw_view_stack_page_get_use_underline")
-	core.PuregoSafeRegister(&xViewStackPageGetVisible, libs, "adw_view_stack_page_get_visible")
-	core.PuregoSafeRegister(&xViewStackPageSetBadgeNumber, libs, "adw_view_stack_page_set_badge_number")
-	core.PuregoSafeRegister(&xViewStackPageSetIconName, libs, "adw_view_stack_page_set_icon_name")
-	core.PuregoSafeRegister(&xViewStackPageSetName, libs, "adw_view_stack_page_set_name")
-	core.PuregoSafeRegister(&xViewStackPageSetNeedsAttention, libs, "adw_view_stack_page_set_needs_attention")
-	core.PuregoSafeRegister(&xViewStackPageSetTitle, libs, "adw_view_stack_page_set_title")
-	core.PuregoSafeRegister(&xViewStackPageSetUseUnderline, libs, "adw_view_stack_page_set_use_underline")
-	core.PuregoSafeRegister(&xViewStackPageSetVisible, libs, "adw_view_stack_page_set_visible")
+	gobject.CheckClassSize("AdwViewStackPageClass", xViewStackPageGLibType, unsafe.Sizeof(ViewStackPageClass{}))
+
+	core.PuregoRegisterSince(&xViewStackPageGetBadgeNumber, libs, "adw_view_stack_page_get_badge_number", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetChild, libs, "adw_view_stack_page_get_child", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetIconName, libs, "adw_view_stack_page_get_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetName, libs, "adw_view_stack_page_get_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetNeedsAttention, libs, "adw_view_stack_page_get_needs_attention", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetTitle, libs, "adw_view_stack_page_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetUseUnderline, libs, "adw_view_stack_page_get_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageGetVisible, libs, "adw_view_stack_page_get_visible", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetBadgeNumber, libs, "adw_view_stack_page_set_badge_number", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetIconName, libs, "adw_view_stack_page_set_icon_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetName, libs, "adw_view_stack_page_set_name", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetNeedsAttention, libs, "adw_view_stack_page_set_needs_attention", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetTitle, libs, "adw_view_stack_page_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetUseUnderline, libs, "adw_view_stack_page_set_use_underline", "ADW", "")
+	core.PuregoRegisterSince(&xViewStackPageSetVisible, libs, "adw_view_stack_page_set_visible", "ADW", "")
 
 	core.PuregoSafeRegister(&xViewStackPagesGLibType, libs, "adw_view_stack_pages_get_type")
 
-	core.PuregoSafeRegister(&xViewStackPagesGetSelectedPage, libs, "adw_view_stack_pages_get_selected_page")
-	core.PuregoSafeRegister(&xViewStackPagesSetSelectedPage, libs, "adw_view_stack_pages_set_selected_page")
+	gobject.CheckClassSize("AdwViewStackPagesClass", xViewStackPagesGLibType, unsafe.Sizeof(ViewStackPagesClass{}))
+
+	core.PuregoRegisterSince(&xViewStackPagesGetSelectedPage, libs, "adw_view_stack_pages_get_selected_page", "ADW", "1.4")
+	core.PuregoRegisterSince(&xViewStackPagesSetSelectedPage, libs, "adw_view_stack_pages_set_selected_page", "ADW", "1.4")
 
 }
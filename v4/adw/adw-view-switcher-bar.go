@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,65 +23,54 @@ func (x *ViewSwitcherBarClass) GoPointer() uintptr {
 
 // A view switcher action bar.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="view-switcher-bar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="view-switcher-bar.png" alt="view-switcher-bar"&gt;
-//
-// &lt;/picture&gt;
-//
 // An action bar letting you switch between multiple views contained in a
-// [class@ViewStack], via an [class@ViewSwitcher]. It is designed to be put at
+// ViewStack, via an ViewSwitcher. It is designed to be put at
 // the bottom of a window and to be revealed only on really narrow windows, e.g.
 // on mobile phones. It can't be revealed if there are less than two pages.
 //
 // `AdwViewSwitcherBar` is intended to be used together with
-// `AdwViewSwitcher` in a header bar, and a [class@Breakpoint] showing the view
+// `AdwViewSwitcher` in a header bar, and a Breakpoint showing the view
 // switcher bar on narrow sizes, while removing the view switcher from the
 // header bar, as follows:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 550sp&lt;/condition&gt;
-//	    &lt;setter object="switcher_bar" property="reveal"&gt;True&lt;/setter&gt;
-//	    &lt;setter object="header_bar" property="title-widget"/&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar" id="header_bar"&gt;
-//	        &lt;property name="title-widget"&gt;
-//	          &lt;object class="AdwViewSwitcher"&gt;
-//	            &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	            &lt;property name="policy"&gt;wide&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwViewStack" id="stack"/&gt;
-//	    &lt;/property&gt;
-//	    &lt;child type="bottom"&gt;
-//	      &lt;object class="AdwViewSwitcherBar" id="switcher_bar"&gt;
-//	        &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// It's recommended to set [property@ViewSwitcher:policy] to
+//	<object class="AdwWindow">
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 550sp</condition>
+//	      <setter object="switcher_bar" property="reveal">True</setter>
+//	      <setter object="header_bar" property="title-widget"/>
+//	    </object>
+//	  </child>
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar" id="header_bar">
+//	          <property name="title-widget">
+//	            <object class="AdwViewSwitcher">
+//	              <property name="stack">stack</property>
+//	              <property name="policy">wide</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </child>
+//	      <property name="content">
+//	        <object class="AdwViewStack" id="stack"/>
+//	      </property>
+//	      <child type="bottom">
+//	        <object class="AdwViewSwitcherBar" id="switcher_bar">
+//	          <property name="stack">stack</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// It's recommended to set ViewSwitcher:policy to
 // `ADW_VIEW_SWITCHER_POLICY_WIDE` in this case.
 //
 // You may have to adjust the breakpoint condition for your specific pages.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwViewSwitcherBar` has a single CSS node with name` viewswitcherbar`.
 type ViewSwitcherBar struct {
@@ -120,7 +108,7 @@ func NewViewSwitcherBar() *ViewSwitcherBar {
 
 var xViewSwitcherBarGetReveal func(uintptr) bool
 
-// Gets whether @self should be revealed or hidden.
+// Gets whether self should be revealed or hidden.
 func (x *ViewSwitcherBar) GetReveal() bool {
 
 	cret := xViewSwitcherBarGetReveal(x.GoPointer())
@@ -129,7 +117,7 @@ func (x *ViewSwitcherBar) GetReveal() bool {
 
 var xViewSwitcherBarGetStack func(uintptr) uintptr
 
-// Gets the stack controlled by @self.
+// Gets the stack controlled by self.
 func (x *ViewSwitcherBar) GetStack() *ViewStack {
 	var cls *ViewStack
 
@@ -146,7 +134,7 @@ func (x *ViewSwitcherBar) GetStack() *ViewStack {
 
 var xViewSwitcherBarSetReveal func(uintptr, bool)
 
-// Sets whether @self should be revealed or hidden.
+// Sets whether self should be revealed or hidden.
 func (x *ViewSwitcherBar) SetReveal(RevealVar bool) {
 
 	xViewSwitcherBarSetReveal(x.GoPointer(), RevealVar)
@@ -155,7 +143,7 @@ func (x *ViewSwitcherBar) SetReveal(RevealVar bool) {
 
 var xViewSwitcherBarSetStack func(uintptr, uintptr)
 
-// Sets the stack controlled by @self.
+// Sets the stack controlled by self.
 func (x *ViewSwitcherBar) SetStack(StackVar *ViewStack) {
 
 	xViewSwitcherBarSetStack(x.GoPointer(), StackVar.GoPointer())
@@ -351,7 +339,7 @@ func (x *ViewSwitcherBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -359,14 +347,13 @@ func (x *ViewSwitcherBar) UpdatePlatformState(StateVar gtk.AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherBar) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -390,19 +377,17 @@ func (x *ViewSwitcherBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherBar) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -423,7 +408,7 @@ func (x *ViewSwitcherBar) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -432,14 +417,12 @@ func (x *ViewSwitcherBar) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherBar) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -458,10 +441,10 @@ func (x *ViewSwitcherBar) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ViewSwitcherBar) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -473,7 +456,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -482,11 +465,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xViewSwitcherBarGLibType, libs, "adw_view_switcher_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewViewSwitcherBar, libs, "adw_view_switcher_bar_new")
+	gobject.CheckClassSize("AdwViewSwitcherBarClass", xViewSwitcherBarGLibType, unsafe.Sizeof(ViewSwitcherBarClass{}))
+
+	core.PuregoRegisterSince(&xNewViewSwitcherBar, libs, "adw_view_switcher_bar_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xViewSwitcherBarGetReveal, libs, "adw_view_switcher_bar_get_reveal")
-	core.PuregoSafeRegister(&xViewSwitcherBarGetStack, libs, "adw_view_switcher_bar_get_stack")
-	core.PuregoSafeRegister(&xViewSwitcherBarSetReveal, libs, "adw_view_switcher_bar_set_reveal")
-	core.PuregoSafeRegister(&xViewSwitcherBarSetStack, libs, "adw_view_switcher_bar_set_stack")
+	core.PuregoRegisterSince(&xViewSwitcherBarGetReveal, libs, "adw_view_switcher_bar_get_reveal", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherBarGetStack, libs, "adw_view_switcher_bar_get_stack", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherBarSetReveal, libs, "adw_view_switcher_bar_set_reveal", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherBarSetStack, libs, "adw_view_switcher_bar_set_stack", "ADW", "")
 
 }
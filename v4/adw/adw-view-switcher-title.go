@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
@@ -24,67 +22,58 @@ func (x *ViewSwitcherTitleClass) GoPointer() uintptr {
 
 // A view switcher title.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="view-switcher-title-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="view-switcher-title.png" alt="view-switcher-title"&gt;
-//
-// &lt;/picture&gt;
-//
 // A widget letting you switch between multiple views contained by a
-// [class@ViewStack] via an [class@ViewSwitcher].
+// ViewStack via an ViewSwitcher.
 //
-// It is designed to be used as the title widget of a [class@HeaderBar], and
+// It is designed to be used as the title widget of a HeaderBar, and
 // will display the window's title when the window is too narrow to fit the view
 // switcher e.g. on mobile phones, or if there are less than two views.
 //
 // In order to center the title in narrow windows, the header bar should have
-// [property@HeaderBar:centering-policy] set to
+// HeaderBar:centering-policy set to
 // `ADW_CENTERING_POLICY_STRICT`.
 //
 // `AdwViewSwitcherTitle` is intended to be used together with
-// [class@ViewSwitcherBar].
+// ViewSwitcherBar.
 //
-// A common use case is to bind the [property@ViewSwitcherBar:reveal] property
-// to [property@ViewSwitcherTitle:title-visible] to automatically reveal the
+// A common use case is to bind the ViewSwitcherBar:reveal property
+// to ViewSwitcherTitle:title-visible to automatically reveal the
 // view switcher bar when the title label is displayed in place of the view
 // switcher, as follows:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar"&gt;
-//	        &lt;property name="centering-policy"&gt;strict&lt;/property&gt;
-//	        &lt;property name="title-widget"&gt;
-//	          &lt;object class="AdwViewSwitcherTitle" id="title"&gt;
-//	            &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwViewStack" id="stack"/&gt;
-//	    &lt;/property&gt;
-//	    &lt;child type="bottom"&gt;
-//	      &lt;object class="AdwViewSwitcherBar"&gt;
-//	        &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	        &lt;binding name="reveal"&gt;
-//	          &lt;lookup name="title-visible"&gt;title&lt;/lookup&gt;
-//	        &lt;/binding&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ## CSS nodes
+//	<object class="AdwWindow">
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar">
+//	          <property name="centering-policy">strict</property>
+//	          <property name="title-widget">
+//	            <object class="AdwViewSwitcherTitle" id="title">
+//	              <property name="stack">stack</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </child>
+//	      <property name="content">
+//	        <object class="AdwViewStack" id="stack"/>
+//	      </property>
+//	      <child type="bottom">
+//	        <object class="AdwViewSwitcherBar">
+//	          <property name="stack">stack</property>
+//	          <binding name="reveal">
+//	            <lookup name="title-visible">title</lookup>
+//	          </binding>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// # CSS nodes
 //
 // `AdwViewSwitcherTitle` has a single CSS node with name `viewswitchertitle`.
+//
+// Deprecated: since 1.4. See the migration guide
 type ViewSwitcherTitle struct {
 	gtk.Widget
 }
@@ -101,127 +90,6 @@ func ViewSwitcherTitleNewFromInternalPtr(ptr uintptr) *ViewSwitcherTitle {
 	return cls
 }
 
-var xNewViewSwitcherTitle func() uintptr
-
-// Creates a new `AdwViewSwitcherTitle`.
-func NewViewSwitcherTitle() *ViewSwitcherTitle {
-	var cls *ViewSwitcherTitle
-
-	cret := xNewViewSwitcherTitle()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ViewSwitcherTitle{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xViewSwitcherTitleGetStack func(uintptr) uintptr
-
-// Gets the stack controlled by @self.
-func (x *ViewSwitcherTitle) GetStack() *ViewStack {
-	var cls *ViewStack
-
-	cret := xViewSwitcherTitleGetStack(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ViewStack{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xViewSwitcherTitleGetSubtitle func(uintptr) string
-
-// Gets the subtitle of @self.
-func (x *ViewSwitcherTitle) GetSubtitle() string {
-
-	cret := xViewSwitcherTitleGetSubtitle(x.GoPointer())
-	return cret
-}
-
-var xViewSwitcherTitleGetTitle func(uintptr) string
-
-// Gets the title of @self.
-func (x *ViewSwitcherTitle) GetTitle() string {
-
-	cret := xViewSwitcherTitleGetTitle(x.GoPointer())
-	return cret
-}
-
-var xViewSwitcherTitleGetTitleVisible func(uintptr) bool
-
-// Gets whether the title of @self is currently visible.
-//
-// If the title is visible, it means the view switcher is hidden an it may be
-// wanted to show an alternative switcher, e.g. a [class@ViewSwitcherBar].
-func (x *ViewSwitcherTitle) GetTitleVisible() bool {
-
-	cret := xViewSwitcherTitleGetTitleVisible(x.GoPointer())
-	return cret
-}
-
-var xViewSwitcherTitleGetViewSwitcherEnabled func(uintptr) bool
-
-// Gets whether @self's view switcher is enabled.
-func (x *ViewSwitcherTitle) GetViewSwitcherEnabled() bool {
-
-	cret := xViewSwitcherTitleGetViewSwitcherEnabled(x.GoPointer())
-	return cret
-}
-
-var xViewSwitcherTitleSetStack func(uintptr, uintptr)
-
-// Sets the stack controlled by @self.
-func (x *ViewSwitcherTitle) SetStack(StackVar *ViewStack) {
-
-	xViewSwitcherTitleSetStack(x.GoPointer(), StackVar.GoPointer())
-
-}
-
-var xViewSwitcherTitleSetSubtitle func(uintptr, string)
-
-// Sets the subtitle of @self.
-//
-// The subtitle should give the user additional details.
-func (x *ViewSwitcherTitle) SetSubtitle(SubtitleVar string) {
-
-	xViewSwitcherTitleSetSubtitle(x.GoPointer(), SubtitleVar)
-
-}
-
-var xViewSwitcherTitleSetTitle func(uintptr, string)
-
-// Sets the title of @self.
-//
-// The title typically identifies the current view or content item, and
-// generally does not use the application name.
-func (x *ViewSwitcherTitle) SetTitle(TitleVar string) {
-
-	xViewSwitcherTitleSetTitle(x.GoPointer(), TitleVar)
-
-}
-
-var xViewSwitcherTitleSetViewSwitcherEnabled func(uintptr, bool)
-
-// Sets whether @self's view switcher is enabled.
-//
-// If it is disabled, the title will be displayed instead. This allows to
-// programmatically hide the view switcher even if it fits in the available
-// space.
-//
-// This can be used e.g. to ensure the view switcher is hidden below a certain
-// window width, or any other constraint you find suitable.
-func (x *ViewSwitcherTitle) SetViewSwitcherEnabled(EnabledVar bool) {
-
-	xViewSwitcherTitleSetViewSwitcherEnabled(x.GoPointer(), EnabledVar)
-
-}
-
 func (c *ViewSwitcherTitle) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -237,6 +105,8 @@ func (c *ViewSwitcherTitle) SetGoPointer(ptr uintptr) {
 // The subtitle to display.
 //
 // The subtitle should give the user additional details.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) SetPropertySubtitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -248,6 +118,8 @@ func (x *ViewSwitcherTitle) SetPropertySubtitle(value string) {
 // The subtitle to display.
 //
 // The subtitle should give the user additional details.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) GetPropertySubtitle() string {
 	var v gobject.Value
 	x.GetProperty("subtitle", &v)
@@ -259,6 +131,8 @@ func (x *ViewSwitcherTitle) GetPropertySubtitle() string {
 //
 // The title typically identifies the current view or content item, and
 // generally does not use the application name.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -271,6 +145,8 @@ func (x *ViewSwitcherTitle) SetPropertyTitle(value string) {
 //
 // The title typically identifies the current view or content item, and
 // generally does not use the application name.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -281,7 +157,9 @@ func (x *ViewSwitcherTitle) GetPropertyTitle() string {
 // Whether the title is currently visible.
 //
 // If the title is visible, it means the view switcher is hidden an it may be
-// wanted to show an alternative switcher, e.g. a [class@ViewSwitcherBar].
+// wanted to show an alternative switcher, e.g. a ViewSwitcherBar.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) GetPropertyTitleVisible() bool {
 	var v gobject.Value
 	x.GetProperty("title-visible", &v)
@@ -297,6 +175,8 @@ func (x *ViewSwitcherTitle) GetPropertyTitleVisible() bool {
 //
 // This can be used e.g. to ensure the view switcher is hidden below a certain
 // window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) SetPropertyViewSwitcherEnabled(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -313,6 +193,8 @@ func (x *ViewSwitcherTitle) SetPropertyViewSwitcherEnabled(value bool) {
 //
 // This can be used e.g. to ensure the view switcher is hidden below a certain
 // window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
 func (x *ViewSwitcherTitle) GetPropertyViewSwitcherEnabled() bool {
 	var v gobject.Value
 	x.GetProperty("view-switcher-enabled", &v)
@@ -480,7 +362,7 @@ func (x *ViewSwitcherTitle) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -488,14 +370,13 @@ func (x *ViewSwitcherTitle) UpdatePlatformState(StateVar gtk.AccessiblePlatformS
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherTitle) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -519,19 +400,17 @@ func (x *ViewSwitcherTitle) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherTitle) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -552,7 +431,7 @@ func (x *ViewSwitcherTitle) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -561,14 +440,12 @@ func (x *ViewSwitcherTitle) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcherTitle) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -587,40 +464,12 @@ func (x *ViewSwitcherTitle) UpdateStateValue(NStatesVar int, StatesVar []gtk.Acc
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ViewSwitcherTitle) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("ADW", "libadwaita-1")
-	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xViewSwitcherTitleGLibType, libs, "adw_view_switcher_title_get_type")
-
-	core.PuregoSafeRegister(&xNewViewSwitcherTitle, libs, "adw_view_switcher_title_new")
-
-	core.PuregoSafeRegister(&xViewSwitcherTitleGetStack, libs, "adw_view_switcher_title_get_stack")
-	core.PuregoSafeRegister(&xViewSwitcherTitleGetSubtitle, libs, "adw_view_switcher_title_get_subtitle")
-	core.PuregoSafeRegister(&xViewSwitcherTitleGetTitle, libs, "adw_view_switcher_title_get_title")
-	core.PuregoSafeRegister(&xViewSwitcherTitleGetTitleVisible, libs, "adw_view_switcher_title_get_title_visible")
-	core.PuregoSafeRegister(&xViewSwitcherTitleGetViewSwitcherEnabled, libs, "adw_view_switcher_title_get_view_switcher_enabled")
-	core.PuregoSafeRegister(&xViewSwitcherTitleSetStack, libs, "adw_view_switcher_title_set_stack")
-	core.PuregoSafeRegister(&xViewSwitcherTitleSetSubtitle, libs, "adw_view_switcher_title_set_subtitle")
-	core.PuregoSafeRegister(&xViewSwitcherTitleSetTitle, libs, "adw_view_switcher_title_set_title")
-	core.PuregoSafeRegister(&xViewSwitcherTitleSetViewSwitcherEnabled, libs, "adw_view_switcher_title_set_view_switcher_enabled")
-
-}
@@ -0,0 +1,176 @@
+//go:build !puregotk_no_deprecated
+
+// Package adw was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewViewSwitcherTitle func() uintptr
+
+// Creates a new `AdwViewSwitcherTitle`.
+//
+// Deprecated: since 1.4. See the migration guide
+func NewViewSwitcherTitle() *ViewSwitcherTitle {
+	var cls *ViewSwitcherTitle
+
+	cret := xNewViewSwitcherTitle()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ViewSwitcherTitle{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xViewSwitcherTitleGetStack func(uintptr) uintptr
+
+// Gets the stack controlled by self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) GetStack() *ViewStack {
+	var cls *ViewStack
+
+	cret := xViewSwitcherTitleGetStack(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ViewStack{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xViewSwitcherTitleGetSubtitle func(uintptr) string
+
+// Gets the subtitle of self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) GetSubtitle() string {
+
+	cret := xViewSwitcherTitleGetSubtitle(x.GoPointer())
+	return cret
+}
+
+var xViewSwitcherTitleGetTitle func(uintptr) string
+
+// Gets the title of self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) GetTitle() string {
+
+	cret := xViewSwitcherTitleGetTitle(x.GoPointer())
+	return cret
+}
+
+var xViewSwitcherTitleGetTitleVisible func(uintptr) bool
+
+// Gets whether the title of self is currently visible.
+//
+// If the title is visible, it means the view switcher is hidden an it may be
+// wanted to show an alternative switcher, e.g. a ViewSwitcherBar.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) GetTitleVisible() bool {
+
+	cret := xViewSwitcherTitleGetTitleVisible(x.GoPointer())
+	return cret
+}
+
+var xViewSwitcherTitleGetViewSwitcherEnabled func(uintptr) bool
+
+// Gets whether self's view switcher is enabled.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) GetViewSwitcherEnabled() bool {
+
+	cret := xViewSwitcherTitleGetViewSwitcherEnabled(x.GoPointer())
+	return cret
+}
+
+var xViewSwitcherTitleSetStack func(uintptr, uintptr)
+
+// Sets the stack controlled by self.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) SetStack(StackVar *ViewStack) {
+
+	xViewSwitcherTitleSetStack(x.GoPointer(), StackVar.GoPointer())
+
+}
+
+var xViewSwitcherTitleSetSubtitle func(uintptr, string)
+
+// Sets the subtitle of self.
+//
+// The subtitle should give the user additional details.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) SetSubtitle(SubtitleVar string) {
+
+	xViewSwitcherTitleSetSubtitle(x.GoPointer(), SubtitleVar)
+
+}
+
+var xViewSwitcherTitleSetTitle func(uintptr, string)
+
+// Sets the title of self.
+//
+// The title typically identifies the current view or content item, and
+// generally does not use the application name.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) SetTitle(TitleVar string) {
+
+	xViewSwitcherTitleSetTitle(x.GoPointer(), TitleVar)
+
+}
+
+var xViewSwitcherTitleSetViewSwitcherEnabled func(uintptr, bool)
+
+// Sets whether self's view switcher is enabled.
+//
+// If it is disabled, the title will be displayed instead. This allows to
+// programmatically hide the view switcher even if it fits in the available
+// space.
+//
+// This can be used e.g. to ensure the view switcher is hidden below a certain
+// window width, or any other constraint you find suitable.
+//
+// Deprecated: since 1.4. See the migration guide
+func (x *ViewSwitcherTitle) SetViewSwitcherEnabled(EnabledVar bool) {
+
+	xViewSwitcherTitleSetViewSwitcherEnabled(x.GoPointer(), EnabledVar)
+
+}
+
+func init() {
+	core.SetPackageName("ADW", "libadwaita-1")
+	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("ADW") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewViewSwitcherTitle, libs, "adw_view_switcher_title_new", "ADW", "")
+
+	core.PuregoRegisterSince(&xViewSwitcherTitleGetStack, libs, "adw_view_switcher_title_get_stack", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleGetSubtitle, libs, "adw_view_switcher_title_get_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleGetTitle, libs, "adw_view_switcher_title_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleGetTitleVisible, libs, "adw_view_switcher_title_get_title_visible", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleGetViewSwitcherEnabled, libs, "adw_view_switcher_title_get_view_switcher_enabled", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleSetStack, libs, "adw_view_switcher_title_set_stack", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleSetSubtitle, libs, "adw_view_switcher_title_set_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleSetTitle, libs, "adw_view_switcher_title_set_title", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherTitleSetViewSwitcherEnabled, libs, "adw_view_switcher_title_set_view_switcher_enabled", "ADW", "")
+
+}
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,7 +22,7 @@ func (x *ViewSwitcherClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes the adaptive modes of [class@ViewSwitcher].
+// Describes the adaptive modes of ViewSwitcher.
 type ViewSwitcherPolicy int
 
 var xViewSwitcherPolicyGLibType func() types.GType
@@ -39,75 +39,97 @@ const (
 	ViewSwitcherPolicyWideValue ViewSwitcherPolicy = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ViewSwitcherPolicy) String() string {
+	switch x {
+	case ViewSwitcherPolicyNarrowValue:
+		return "ADW_VIEW_SWITCHER_POLICY_NARROW"
+	case ViewSwitcherPolicyWideValue:
+		return "ADW_VIEW_SWITCHER_POLICY_WIDE"
+	}
+	return fmt.Sprintf("ViewSwitcherPolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ViewSwitcherPolicy) Nick() string {
+	switch x {
+	case ViewSwitcherPolicyNarrowValue:
+		return "narrow"
+	case ViewSwitcherPolicyWideValue:
+		return "wide"
+	}
+	return ""
+}
+
+// ViewSwitcherPolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ViewSwitcherPolicyFromString(s string) (ViewSwitcherPolicy, bool) {
+	switch s {
+	case "ADW_VIEW_SWITCHER_POLICY_NARROW", "narrow":
+		return ViewSwitcherPolicyNarrowValue, true
+	case "ADW_VIEW_SWITCHER_POLICY_WIDE", "wide":
+		return ViewSwitcherPolicyWideValue, true
+	}
+	return 0, false
+}
+
 // An adaptive view switcher.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="view-switcher-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="view-switcher.png" alt="view-switcher"&gt;
-//
-// &lt;/picture&gt;
-//
 // An adaptive view switcher designed to switch between multiple views
-// contained in a [class@ViewStack] in a similar fashion to
-// [class@Gtk.StackSwitcher].
+// contained in a ViewStack in a similar fashion to
+// [gtk.StackSwitcher].
 //
 // `AdwViewSwitcher` buttons always have an icon and a label. They can be
 // displayed side by side, or icon on top of the label. This can be controlled
-// via the [property@ViewSwitcher:policy] property.
+// via the ViewSwitcher:policy property.
 //
 // `AdwViewSwitcher` is intended to be used in a header bar together with
-// [class@ViewSwitcherBar] at the bottom of the window, and a [class@Breakpoint]
+// ViewSwitcherBar at the bottom of the window, and a Breakpoint
 // showing the view switcher bar on narrow sizes, while removing the view
 // switcher from the header bar, as follows:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 550sp&lt;/condition&gt;
-//	    &lt;setter object="switcher_bar" property="reveal"&gt;True&lt;/setter&gt;
-//	    &lt;setter object="header_bar" property="title-widget"/&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar" id="header_bar"&gt;
-//	        &lt;property name="title-widget"&gt;
-//	          &lt;object class="AdwViewSwitcher"&gt;
-//	            &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	            &lt;property name="policy"&gt;wide&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;object class="AdwViewStack" id="stack"/&gt;
-//	    &lt;/property&gt;
-//	    &lt;child type="bottom"&gt;
-//	      &lt;object class="AdwViewSwitcherBar" id="switcher_bar"&gt;
-//	        &lt;property name="stack"&gt;stack&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// It's recommended to set [property@ViewSwitcher:policy] to
+//	<object class="AdwWindow">
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 550sp</condition>
+//	      <setter object="switcher_bar" property="reveal">True</setter>
+//	      <setter object="header_bar" property="title-widget"/>
+//	    </object>
+//	  </child>
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar" id="header_bar">
+//	          <property name="title-widget">
+//	            <object class="AdwViewSwitcher">
+//	              <property name="stack">stack</property>
+//	              <property name="policy">wide</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </child>
+//	      <property name="content">
+//	        <object class="AdwViewStack" id="stack"/>
+//	      </property>
+//	      <child type="bottom">
+//	        <object class="AdwViewSwitcherBar" id="switcher_bar">
+//	          <property name="stack">stack</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// It's recommended to set ViewSwitcher:policy to
 // `ADW_VIEW_SWITCHER_POLICY_WIDE` in this case.
 //
 // You may have to adjust the breakpoint condition for your specific pages.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwViewSwitcher` has a single CSS node with name `viewswitcher`. It can have
 // the style classes `.wide` and `.narrow`, matching its policy.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwViewSwitcher` uses the `GTK_ACCESSIBLE_ROLE_TAB_LIST` role and uses the
 // `GTK_ACCESSIBLE_ROLE_TAB` for its buttons.
@@ -146,7 +168,7 @@ func NewViewSwitcher() *ViewSwitcher {
 
 var xViewSwitcherGetPolicy func(uintptr) ViewSwitcherPolicy
 
-// Gets the policy of @self.
+// Gets the policy of self.
 func (x *ViewSwitcher) GetPolicy() ViewSwitcherPolicy {
 
 	cret := xViewSwitcherGetPolicy(x.GoPointer())
@@ -155,7 +177,7 @@ func (x *ViewSwitcher) GetPolicy() ViewSwitcherPolicy {
 
 var xViewSwitcherGetStack func(uintptr) uintptr
 
-// Gets the stack controlled by @self.
+// Gets the stack controlled by self.
 func (x *ViewSwitcher) GetStack() *ViewStack {
 	var cls *ViewStack
 
@@ -172,7 +194,7 @@ func (x *ViewSwitcher) GetStack() *ViewStack {
 
 var xViewSwitcherSetPolicy func(uintptr, ViewSwitcherPolicy)
 
-// Sets the policy of @self.
+// Sets the policy of self.
 func (x *ViewSwitcher) SetPolicy(PolicyVar ViewSwitcherPolicy) {
 
 	xViewSwitcherSetPolicy(x.GoPointer(), PolicyVar)
@@ -181,7 +203,7 @@ func (x *ViewSwitcher) SetPolicy(PolicyVar ViewSwitcherPolicy) {
 
 var xViewSwitcherSetStack func(uintptr, uintptr)
 
-// Sets the stack controlled by @self.
+// Sets the stack controlled by self.
 func (x *ViewSwitcher) SetStack(StackVar *ViewStack) {
 
 	xViewSwitcherSetStack(x.GoPointer(), StackVar.GoPointer())
@@ -360,7 +382,7 @@ func (x *ViewSwitcher) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -368,14 +390,13 @@ func (x *ViewSwitcher) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcher) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -399,19 +420,17 @@ func (x *ViewSwitcher) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []g
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcher) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -432,7 +451,7 @@ func (x *ViewSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -441,14 +460,12 @@ func (x *ViewSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ViewSwitcher) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -467,10 +484,10 @@ func (x *ViewSwitcher) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessib
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ViewSwitcher) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -482,7 +499,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -493,11 +510,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xViewSwitcherGLibType, libs, "adw_view_switcher_get_type")
 
-	core.PuregoSafeRegister(&xNewViewSwitcher, libs, "adw_view_switcher_new")
+	gobject.CheckClassSize("AdwViewSwitcherClass", xViewSwitcherGLibType, unsafe.Sizeof(ViewSwitcherClass{}))
+
+	core.PuregoRegisterSince(&xNewViewSwitcher, libs, "adw_view_switcher_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xViewSwitcherGetPolicy, libs, "adw_view_switcher_get_policy")
-	core.PuregoSafeRegister(&xViewSwitcherGetStack, libs, "adw_view_switcher_get_stack")
-	core.PuregoSafeRegister(&xViewSwitcherSetPolicy, libs, "adw_view_switcher_set_policy")
-	core.PuregoSafeRegister(&xViewSwitcherSetStack, libs, "adw_view_switcher_set_stack")
+	core.PuregoRegisterSince(&xViewSwitcherGetPolicy, libs, "adw_view_switcher_get_policy", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherGetStack, libs, "adw_view_switcher_get_stack", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherSetPolicy, libs, "adw_view_switcher_set_policy", "ADW", "")
+	core.PuregoRegisterSince(&xViewSwitcherSetStack, libs, "adw_view_switcher_set_stack", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,17 +23,10 @@ func (x *WindowTitleClass) GoPointer() uintptr {
 
 // A helper widget for setting a window's title and subtitle.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="window-title-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="window-title.png" alt="window-title"&gt;
-//
-// &lt;/picture&gt;
-//
 // `AdwWindowTitle` shows a title and subtitle. It's intended to be used as the
-// title child of [class@Gtk.HeaderBar] or [class@HeaderBar].
+// title child of [gtk.HeaderBar] or HeaderBar.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwWindowTitle` has a single CSS node with name `windowtitle`.
 type WindowTitle struct {
@@ -72,7 +64,7 @@ func NewWindowTitle(TitleVar string, SubtitleVar string) *WindowTitle {
 
 var xWindowTitleGetSubtitle func(uintptr) string
 
-// Gets the subtitle of @self.
+// Gets the subtitle of self.
 func (x *WindowTitle) GetSubtitle() string {
 
 	cret := xWindowTitleGetSubtitle(x.GoPointer())
@@ -81,7 +73,7 @@ func (x *WindowTitle) GetSubtitle() string {
 
 var xWindowTitleGetTitle func(uintptr) string
 
-// Gets the title of @self.
+// Gets the title of self.
 func (x *WindowTitle) GetTitle() string {
 
 	cret := xWindowTitleGetTitle(x.GoPointer())
@@ -90,7 +82,7 @@ func (x *WindowTitle) GetTitle() string {
 
 var xWindowTitleSetSubtitle func(uintptr, string)
 
-// Sets the subtitle of @self.
+// Sets the subtitle of self.
 //
 // The subtitle should give the user additional details.
 func (x *WindowTitle) SetSubtitle(SubtitleVar string) {
@@ -101,7 +93,7 @@ func (x *WindowTitle) SetSubtitle(SubtitleVar string) {
 
 var xWindowTitleSetTitle func(uintptr, string)
 
-// Sets the title of @self.
+// Sets the title of self.
 //
 // The title typically identifies the current view or content item, and
 // generally does not use the application name.
@@ -327,7 +319,7 @@ func (x *WindowTitle) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -335,14 +327,13 @@ func (x *WindowTitle) UpdatePlatformState(StateVar gtk.AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *WindowTitle) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -366,19 +357,17 @@ func (x *WindowTitle) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gt
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *WindowTitle) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -399,7 +388,7 @@ func (x *WindowTitle) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -408,14 +397,12 @@ func (x *WindowTitle) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *WindowTitle) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -434,10 +421,10 @@ func (x *WindowTitle) UpdateStateValue(NStatesVar int, StatesVar []gtk.Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *WindowTitle) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -449,7 +436,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -458,11 +445,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xWindowTitleGLibType, libs, "adw_window_title_get_type")
 
-	core.PuregoSafeRegister(&xNewWindowTitle, libs, "adw_window_title_new")
+	gobject.CheckClassSize("AdwWindowTitleClass", xWindowTitleGLibType, unsafe.Sizeof(WindowTitleClass{}))
+
+	core.PuregoRegisterSince(&xNewWindowTitle, libs, "adw_window_title_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xWindowTitleGetSubtitle, libs, "adw_window_title_get_subtitle")
-	core.PuregoSafeRegister(&xWindowTitleGetTitle, libs, "adw_window_title_get_title")
-	core.PuregoSafeRegister(&xWindowTitleSetSubtitle, libs, "adw_window_title_set_subtitle")
-	core.PuregoSafeRegister(&xWindowTitleSetTitle, libs, "adw_window_title_set_title")
+	core.PuregoRegisterSince(&xWindowTitleGetSubtitle, libs, "adw_window_title_get_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xWindowTitleGetTitle, libs, "adw_window_title_get_title", "ADW", "")
+	core.PuregoRegisterSince(&xWindowTitleSetSubtitle, libs, "adw_window_title_set_subtitle", "ADW", "")
+	core.PuregoRegisterSince(&xWindowTitleSetTitle, libs, "adw_window_title_set_title", "ADW", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -29,89 +28,74 @@ func (x *WindowClass) GoPointer() uintptr {
 
 // A freeform window.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="window-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="window.png" alt="window"&gt;
-//
-// &lt;/picture&gt;
-//
-// The `AdwWindow` widget is a subclass of [class@Gtk.Window] which has no
-// titlebar area. Instead, [class@ToolbarView] can be used together with
-// [class@HeaderBar] or [class@Gtk.HeaderBar] as follows:
-//
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar"/&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;!-- ... --&gt;
-//	    &lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// Using [property@Gtk.Window:titlebar] or [property@Gtk.Window:child]
-// is not supported and will result in a crash. Use [property@Window:content]
+// The `AdwWindow` widget is a subclass of [gtk.Window] which has no
+// titlebar area. Instead, ToolbarView can be used together with
+// HeaderBar or [gtk.HeaderBar] as follows:
+//
+//	<object class="AdwWindow">
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar"/>
+//	      </child>
+//	      <property name="content">
+//	        <!-- ... -->
+//	      </property>
+//	    </object>
+//	  </property>
+//	</object>
+//
+// Using Window:titlebar or Window:child
+// is not supported and will result in a crash. Use Window:content
 // instead.
 //
-// ## Dialogs
+// # Dialogs
 //
-// `AdwWindow` can contain [class@Dialog]. Use [method@Dialog.present] with the
+// `AdwWindow` can contain Dialog. Use present with the
 // window or a widget within a window to show a dialog.
 //
-// ## Breakpoints
+// # Breakpoints
 //
-// `AdwWindow` can be used with [class@Breakpoint] the same way as
-// [class@BreakpointBin]. Refer to that widget's documentation for details.
+// `AdwWindow` can be used with Breakpoint the same way as
+// BreakpointBin. Refer to that widget's documentation for details.
 //
 // Example:
 //
-// ```xml
-// &lt;object class="AdwWindow"&gt;
-//
-//	&lt;property name="content"&gt;
-//	  &lt;object class="AdwToolbarView"&gt;
-//	    &lt;child type="top"&gt;
-//	      &lt;object class="AdwHeaderBar"/&gt;
-//	    &lt;/child&gt;
-//	    &lt;property name="content"&gt;
-//	      &lt;!-- ... --&gt;
-//	    &lt;/property&gt;
-//	    &lt;child type="bottom"&gt;
-//	      &lt;object class="GtkActionBar" id="bottom_bar"&gt;
-//	        &lt;property name="revealed"&gt;True&lt;/property&gt;
-//	        &lt;property name="visible"&gt;False&lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="AdwBreakpoint"&gt;
-//	    &lt;condition&gt;max-width: 500px&lt;/condition&gt;
-//	    &lt;setter object="bottom_bar" property="visible"&gt;True&lt;/setter&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="AdwWindow">
+//	  <property name="content">
+//	    <object class="AdwToolbarView">
+//	      <child type="top">
+//	        <object class="AdwHeaderBar"/>
+//	      </child>
+//	      <property name="content">
+//	        <!-- ... -->
+//	      </property>
+//	      <child type="bottom">
+//	        <object class="GtkActionBar" id="bottom_bar">
+//	          <property name="revealed">True</property>
+//	          <property name="visible">False</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </property>
+//	  <child>
+//	    <object class="AdwBreakpoint">
+//	      <condition>max-width: 500px</condition>
+//	      <setter object="bottom_bar" property="visible">True</setter>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // When breakpoints are used, the minimum size must be larger than the smallest
 // UI state. `AdwWindow` defaults to the minimum size of 360×200 px. If that's
-// too small, set the [property@Gtk.Widget:width-request] and
-// [property@Gtk.Widget:height-request] properties manually.
+// too small, set the [gtk.Widget.GetPropertyWidthRequest] and
+// [gtk.Widget.GetPropertyHeightRequest] properties manually.
 //
-// ## Adaptive Preview
+// # Adaptive Preview
 //
 // `AdwWindow` has a debug tool called adaptive preview. It can be opened from
-// GTK Inspector or by pressing &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;M&lt;/kbd&gt;,
-// and controlled via the [property@Window:adaptive-preview] property.
+// GTK Inspector or by pressing Ctrl+Shift+M,
+// and controlled via the Window:adaptive-preview property.
 type Window struct {
 	gtk.Window
 }
@@ -147,7 +131,7 @@ func NewWindow() *Window {
 
 var xWindowAddBreakpoint func(uintptr, uintptr)
 
-// Adds @breakpoint to @self.
+// Adds breakpoint to self.
 func (x *Window) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 	xWindowAddBreakpoint(x.GoPointer(), BreakpointVar.GoPointer())
@@ -156,7 +140,7 @@ func (x *Window) AddBreakpoint(BreakpointVar *Breakpoint) {
 
 var xWindowGetAdaptivePreview func(uintptr) bool
 
-// Gets whether adaptive preview for @self is currently open.
+// Gets whether adaptive preview for self is currently open.
 func (x *Window) GetAdaptivePreview() bool {
 
 	cret := xWindowGetAdaptivePreview(x.GoPointer())
@@ -165,9 +149,9 @@ func (x *Window) GetAdaptivePreview() bool {
 
 var xWindowGetContent func(uintptr) uintptr
 
-// Gets the content widget of @self.
+// Gets the content widget of self.
 //
-// This method should always be used instead of [method@Gtk.Window.get_child].
+// This method should always be used instead of [gtk.Window.GetChild].
 func (x *Window) GetContent() *gtk.Widget {
 	var cls *gtk.Widget
 
@@ -201,7 +185,7 @@ func (x *Window) GetCurrentBreakpoint() *Breakpoint {
 
 var xWindowGetDialogs func(uintptr) uintptr
 
-// Returns a [iface@Gio.ListModel] that contains the open dialogs of @self.
+// Returns a [gio.ListModel] that contains the open dialogs of self.
 //
 // This can be used to keep an up-to-date view.
 func (x *Window) GetDialogs() *gio.ListModelBase {
@@ -219,7 +203,7 @@ func (x *Window) GetDialogs() *gio.ListModelBase {
 
 var xWindowGetVisibleDialog func(uintptr) uintptr
 
-// Returns the currently visible dialog in @self, if there's one.
+// Returns the currently visible dialog in self, if there's one.
 func (x *Window) GetVisibleDialog() *Dialog {
 	var cls *Dialog
 
@@ -236,7 +220,7 @@ func (x *Window) GetVisibleDialog() *Dialog {
 
 var xWindowSetAdaptivePreview func(uintptr, bool)
 
-// Sets whether adaptive preview for @self is currently open.
+// Sets whether adaptive preview for self is currently open.
 //
 // Adaptive preview is a debugging tool used for testing the window
 // contents at specific screen sizes, simulating mobile environment.
@@ -253,9 +237,9 @@ func (x *Window) SetAdaptivePreview(AdaptivePreviewVar bool) {
 
 var xWindowSetContent func(uintptr, uintptr)
 
-// Sets the content widget of @self.
+// Sets the content widget of self.
 //
-// This method should always be used instead of [method@Gtk.Window.set_child].
+// This method should always be used instead of [gtk.Window.SetChild].
 func (x *Window) SetContent(ContentVar *gtk.Widget) {
 
 	xWindowSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -467,7 +451,7 @@ func (x *Window) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -475,14 +459,13 @@ func (x *Window) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -506,19 +489,17 @@ func (x *Window) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -539,7 +520,7 @@ func (x *Window) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -548,14 +529,12 @@ func (x *Window) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -574,10 +553,10 @@ func (x *Window) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Window) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
@@ -614,10 +593,10 @@ func (x *Window) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *Window) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	gtk.XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -661,7 +640,7 @@ func (x *Window) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *Window) GetFocus() *gtk.Widget {
 	var cls *gtk.Widget
@@ -677,13 +656,13 @@ func (x *Window) GetFocus() *gtk.Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [gtk.Widget.GrabFocus] instead of
 // this function.
 func (x *Window) SetFocus(FocusVar *gtk.Widget) {
 
@@ -696,7 +675,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -705,15 +684,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xWindowGLibType, libs, "adw_window_get_type")
 
-	core.PuregoSafeRegister(&xNewWindow, libs, "adw_window_new")
+	gobject.CheckClassSize("AdwWindowClass", xWindowGLibType, unsafe.Sizeof(WindowClass{}))
+
+	core.PuregoRegisterSince(&xNewWindow, libs, "adw_window_new", "ADW", "")
 
-	core.PuregoSafeRegister(&xWindowAddBreakpoint, libs, "adw_window_add_breakpoint")
-	core.PuregoSafeRegister(&xWindowGetAdaptivePreview, libs, "adw_window_get_adaptive_preview")
-	core.PuregoSafeRegister(&xWindowGetContent, libs, "adw_window_get_content")
-	core.PuregoSafeRegister(&xWindowGetCurrentBreakpoint, libs, "adw_window_get_current_breakpoint")
-	core.PuregoSafeRegister(&xWindowGetDialogs, libs, "adw_window_get_dialogs")
-	core.PuregoSafeRegister(&xWindowGetVisibleDialog, libs, "adw_window_get_visible_dialog")
-	core.PuregoSafeRegister(&xWindowSetAdaptivePreview, libs, "adw_window_set_adaptive_preview")
-	core.PuregoSafeRegister(&xWindowSetContent, libs, "adw_window_set_content")
+	core.PuregoRegisterSince(&xWindowAddBreakpoint, libs, "adw_window_add_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xWindowGetAdaptivePreview, libs, "adw_window_get_adaptive_preview", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWindowGetContent, libs, "adw_window_get_content", "ADW", "")
+	core.PuregoRegisterSince(&xWindowGetCurrentBreakpoint, libs, "adw_window_get_current_breakpoint", "ADW", "1.4")
+	core.PuregoRegisterSince(&xWindowGetDialogs, libs, "adw_window_get_dialogs", "ADW", "1.5")
+	core.PuregoRegisterSince(&xWindowGetVisibleDialog, libs, "adw_window_get_visible_dialog", "ADW", "1.5")
+	core.PuregoRegisterSince(&xWindowSetAdaptivePreview, libs, "adw_window_set_adaptive_preview", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWindowSetContent, libs, "adw_window_set_content", "ADW", "")
 
 }
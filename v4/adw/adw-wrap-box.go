@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,62 +23,55 @@ func (x *WrapBoxClass) GoPointer() uintptr {
 
 // A box-like widget that can wrap into multiple lines.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="wrap-box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="wrap-box.png" alt="wrap-box"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwWrapBox` is similar to [class@Gtk.Box], but can wrap lines when the
-// widgets cannot fit otherwise. Unlike [class@Gtk.FlowBox], the children aren't
+// `AdwWrapBox` is similar to [gtk.Box], but can wrap lines when the
+// widgets cannot fit otherwise. Unlike [gtk.FlowBox], the children aren't
 // arranged into a grid and behave more like words in a wrapping label.
 //
 // Like `GtkBox`, `AdwWrapBox` is orientable and has spacing:
 //
-// - [property@WrapBox:child-spacing] between children in the same line;
-// - [property@WrapBox:line-spacing] between lines.
+// - WrapBox:child-spacing between children in the same line;
+// - WrapBox:line-spacing between lines.
 //
 // ::: note
 //
 //	Unlike `GtkBox`, `AdwWrapBox` cannot follow the CSS `border-spacing`
 //	property.
 //
-// Use the [property@WrapBox:natural-line-length] property to determine the
-// layout's natural size, e.g. when using it in a [class@Gtk.Popover].
+// Use the WrapBox:natural-line-length property to determine the
+// layout's natural size, e.g. when using it in a [gtk.Popover].
 //
 // Normally, a horizontal `AdwWrapBox` wraps left to right and top to bottom
 // for left-to-right languages. Both of these directions can be reversed, using
-// the [property@WrapBox:pack-direction] and [property@WrapBox:wrap-reverse]
+// the WrapBox:pack-direction and WrapBox:wrap-reverse
 // properties. Additionally, the alignment of each line can be controlled with
-// the [property@WrapBox:align] property.
+// the WrapBox:align property.
 //
-// Lines can be justified using the [property@WrapBox:justify] property, filling
+// Lines can be justified using the WrapBox:justify property, filling
 // the entire line by either increasing child size or spacing depending on the
-// value. Set [property@WrapBox:justify-last-line] to justify the last line as
+// value. Set WrapBox:justify-last-line to justify the last line as
 // well.
 //
 // By default, `AdwWrapBox` wraps as soon as the previous line cannot fit any
 // more children without shrinking them past their natural size. Set
-// [property@WrapBox:wrap-policy] to [enum@Adw.WrapPolicy.MINIMUM] to only wrap
+// WrapBox:wrap-policy to WrapPolicy.MINIMUM to only wrap
 // once all the children in the previous line have been shrunk to their minimum
 // size.
 //
 // To make each line take the same amount of space, set
-// [property@WrapBox:line-homogeneous] to `TRUE`.
+// WrapBox:line-homogeneous to `TRUE`.
 //
 // Spacing and natural line length can scale with the text scale factor, use the
-// [property@WrapBox:child-spacing-unit], [property@WrapBox:line-spacing-unit]
-// and/or [property@WrapBox:natural-line-length-unit] properties to enable that
+// WrapBox:child-spacing-unit, WrapBox:line-spacing-unit
+// and/or WrapBox:natural-line-length-unit properties to enable that
 // behavior.
 //
-// See [class@WrapLayout].
+// See WrapLayout.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `AdwWrapBox` uses a single CSS node with name `wrap-box`.
 //
-// ## Accessibility
+// # Accessibility
 //
 // `AdwWrapBox` uses the `GTK_ACCESSIBLE_ROLE_GROUP` role.
 type WrapBox struct {
@@ -117,7 +109,7 @@ func NewWrapBox() *WrapBox {
 
 var xWrapBoxAppend func(uintptr, uintptr)
 
-// Adds @child as the last child to @self.
+// Adds child as the last child to self.
 func (x *WrapBox) Append(ChildVar *gtk.Widget) {
 
 	xWrapBoxAppend(x.GoPointer(), ChildVar.GoPointer())
@@ -182,7 +174,7 @@ var xWrapBoxGetLineSpacing func(uintptr) int
 
 // Gets the spacing between lines.
 //
-// See [property@WrapBox:line-spacing-unit].
+// See WrapBox:line-spacing-unit.
 func (x *WrapBox) GetLineSpacing() int {
 
 	cret := xWrapBoxGetLineSpacing(x.GoPointer())
@@ -245,9 +237,9 @@ func (x *WrapBox) GetWrapReverse() bool {
 
 var xWrapBoxInsertChildAfter func(uintptr, uintptr, uintptr)
 
-// Inserts @child in the position after @sibling in the list of @self children.
+// Inserts child in the position after sibling in the list of self children.
 //
-// If @sibling is `NULL`, inserts @child at the first position.
+// If sibling is `NULL`, inserts child at the first position.
 func (x *WrapBox) InsertChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) {
 
 	xWrapBoxInsertChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
@@ -256,7 +248,7 @@ func (x *WrapBox) InsertChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget)
 
 var xWrapBoxPrepend func(uintptr, uintptr)
 
-// Adds @child as the first child to @self.
+// Adds child as the first child to self.
 func (x *WrapBox) Prepend(ChildVar *gtk.Widget) {
 
 	xWrapBoxPrepend(x.GoPointer(), ChildVar.GoPointer())
@@ -265,10 +257,10 @@ func (x *WrapBox) Prepend(ChildVar *gtk.Widget) {
 
 var xWrapBoxRemove func(uintptr, uintptr)
 
-// Removes a child widget from @self.
+// Removes a child widget from self.
 //
-// The child must have been added before with [method@Adw.WrapBox.append],
-// [method@Adw.WrapBox.prepend], or [method@Adw.WrapBox.insert_child_after].
+// The child must have been added before with [WrapBox.Append],
+// [WrapBox.Prepend], or [WrapBox.InsertChildAfter].
 func (x *WrapBox) Remove(ChildVar *gtk.Widget) {
 
 	xWrapBoxRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -277,7 +269,7 @@ func (x *WrapBox) Remove(ChildVar *gtk.Widget) {
 
 var xWrapBoxRemoveAll func(uintptr)
 
-// Removes all children from @self.
+// Removes all children from self.
 func (x *WrapBox) RemoveAll() {
 
 	xWrapBoxRemoveAll(x.GoPointer())
@@ -286,9 +278,9 @@ func (x *WrapBox) RemoveAll() {
 
 var xWrapBoxReorderChildAfter func(uintptr, uintptr, uintptr)
 
-// Moves @child to the position after @sibling in the list of @self children.
+// Moves child to the position after sibling in the list of self children.
 //
-// If @sibling is `NULL`, moves @child to the first position.
+// If sibling is `NULL`, moves child to the first position.
 func (x *WrapBox) ReorderChildAfter(ChildVar *gtk.Widget, SiblingVar *gtk.Widget) {
 
 	xWrapBoxReorderChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
@@ -303,9 +295,9 @@ var xWrapBoxSetAlign func(uintptr, float32)
 // placed at the end of the line. 0.5 means they are placed in the middle of the
 // line.
 //
-// Alignment is only used when [property@WrapBox:justify] is set to
+// Alignment is only used when WrapBox:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapBox:justify-last-line] is `FALSE`.
+// WrapBox:justify-last-line is `FALSE`.
 func (x *WrapBox) SetAlign(AlignVar float32) {
 
 	xWrapBoxSetAlign(x.GoPointer(), AlignVar)
@@ -316,7 +308,7 @@ var xWrapBoxSetChildSpacing func(uintptr, int)
 
 // Sets the spacing between widgets on the same line.
 //
-// See [property@WrapBox:child-spacing-unit].
+// See WrapBox:child-spacing-unit.
 func (x *WrapBox) SetChildSpacing(ChildSpacingVar int) {
 
 	xWrapBoxSetChildSpacing(x.GoPointer(), ChildSpacingVar)
@@ -329,7 +321,7 @@ var xWrapBoxSetChildSpacingUnit func(uintptr, LengthUnit)
 //
 // Allows the spacing to vary depending on the text scale factor.
 //
-// See [property@WrapBox:child-spacing].
+// See WrapBox:child-spacing.
 func (x *WrapBox) SetChildSpacingUnit(UnitVar LengthUnit) {
 
 	xWrapBoxSetChildSpacingUnit(x.GoPointer(), UnitVar)
@@ -351,10 +343,10 @@ var xWrapBoxSetJustify func(uintptr, JustifyMode)
 //
 // If set to `ADW_JUSTIFY_NONE`, the line will not be stretched and the children
 // will be placed together within the line, according to
-// [property@WrapBox:align].
+// WrapBox:align.
 //
 // By default this doesn't affect the last line, as it will be incomplete. Use
-// [property@WrapBox:justify-last-line] to justify it as well.
+// WrapBox:justify-last-line to justify it as well.
 func (x *WrapBox) SetJustify(JustifyVar JustifyMode) {
 
 	xWrapBoxSetJustify(x.GoPointer(), JustifyVar)
@@ -365,7 +357,7 @@ var xWrapBoxSetJustifyLastLine func(uintptr, bool)
 
 // Sets whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapBox:justify].
+// See WrapBox:justify.
 func (x *WrapBox) SetJustifyLastLine(JustifyLastLineVar bool) {
 
 	xWrapBoxSetJustifyLastLine(x.GoPointer(), JustifyLastLineVar)
@@ -396,7 +388,7 @@ var xWrapBoxSetLineSpacingUnit func(uintptr, LengthUnit)
 //
 // Allows the spacing to vary depending on the text scale factor.
 //
-// See [property@WrapBox:line-spacing].
+// See WrapBox:line-spacing.
 func (x *WrapBox) SetLineSpacingUnit(UnitVar LengthUnit) {
 
 	xWrapBoxSetLineSpacingUnit(x.GoPointer(), UnitVar)
@@ -410,7 +402,7 @@ var xWrapBoxSetNaturalLineLength func(uintptr, int)
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapBox:natural-line-length-unit].
+// See WrapBox:natural-line-length-unit.
 func (x *WrapBox) SetNaturalLineLength(NaturalLineLengthVar int) {
 
 	xWrapBoxSetNaturalLineLength(x.GoPointer(), NaturalLineLengthVar)
@@ -423,7 +415,7 @@ var xWrapBoxSetNaturalLineLengthUnit func(uintptr, LengthUnit)
 //
 // Allows the length to vary depending on the text scale factor.
 //
-// See [property@WrapBox:natural-line-length].
+// See WrapBox:natural-line-length.
 func (x *WrapBox) SetNaturalLineLengthUnit(UnitVar LengthUnit) {
 
 	xWrapBoxSetNaturalLineLengthUnit(x.GoPointer(), UnitVar)
@@ -487,9 +479,9 @@ func (c *WrapBox) SetGoPointer(ptr uintptr) {
 // placed at the end of the line. 0.5 means they are placed in the middle of
 // the line.
 //
-// Alignment is only used when [property@WrapBox:justify] is set to
+// Alignment is only used when WrapBox:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapBox:justify-last-line] is `FALSE`.
+// WrapBox:justify-last-line is `FALSE`.
 func (x *WrapBox) SetPropertyAlign(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -504,9 +496,9 @@ func (x *WrapBox) SetPropertyAlign(value float32) {
 // placed at the end of the line. 0.5 means they are placed in the middle of
 // the line.
 //
-// Alignment is only used when [property@WrapBox:justify] is set to
+// Alignment is only used when WrapBox:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapBox:justify-last-line] is `FALSE`.
+// WrapBox:justify-last-line is `FALSE`.
 func (x *WrapBox) GetPropertyAlign() float32 {
 	var v gobject.Value
 	x.GetProperty("align", &v)
@@ -516,7 +508,7 @@ func (x *WrapBox) GetPropertyAlign() float32 {
 // SetPropertyChildSpacing sets the "child-spacing" property.
 // The spacing between widgets on the same line.
 //
-// See [property@WrapBox:child-spacing-unit].
+// See WrapBox:child-spacing-unit.
 func (x *WrapBox) SetPropertyChildSpacing(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -527,7 +519,7 @@ func (x *WrapBox) SetPropertyChildSpacing(value int) {
 // GetPropertyChildSpacing gets the "child-spacing" property.
 // The spacing between widgets on the same line.
 //
-// See [property@WrapBox:child-spacing-unit].
+// See WrapBox:child-spacing-unit.
 func (x *WrapBox) GetPropertyChildSpacing() int {
 	var v gobject.Value
 	x.GetProperty("child-spacing", &v)
@@ -537,7 +529,7 @@ func (x *WrapBox) GetPropertyChildSpacing() int {
 // SetPropertyJustifyLastLine sets the "justify-last-line" property.
 // Whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapBox:justify].
+// See WrapBox:justify.
 func (x *WrapBox) SetPropertyJustifyLastLine(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -548,7 +540,7 @@ func (x *WrapBox) SetPropertyJustifyLastLine(value bool) {
 // GetPropertyJustifyLastLine gets the "justify-last-line" property.
 // Whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapBox:justify].
+// See WrapBox:justify.
 func (x *WrapBox) GetPropertyJustifyLastLine() bool {
 	var v gobject.Value
 	x.GetProperty("justify-last-line", &v)
@@ -575,7 +567,7 @@ func (x *WrapBox) GetPropertyLineHomogeneous() bool {
 // SetPropertyLineSpacing sets the "line-spacing" property.
 // The spacing between lines.
 //
-// See [property@WrapBox:line-spacing-unit].
+// See WrapBox:line-spacing-unit.
 func (x *WrapBox) SetPropertyLineSpacing(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -586,7 +578,7 @@ func (x *WrapBox) SetPropertyLineSpacing(value int) {
 // GetPropertyLineSpacing gets the "line-spacing" property.
 // The spacing between lines.
 //
-// See [property@WrapBox:line-spacing-unit].
+// See WrapBox:line-spacing-unit.
 func (x *WrapBox) GetPropertyLineSpacing() int {
 	var v gobject.Value
 	x.GetProperty("line-spacing", &v)
@@ -599,7 +591,7 @@ func (x *WrapBox) GetPropertyLineSpacing() int {
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapBox:natural-line-length-unit].
+// See WrapBox:natural-line-length-unit.
 func (x *WrapBox) SetPropertyNaturalLineLength(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -613,7 +605,7 @@ func (x *WrapBox) SetPropertyNaturalLineLength(value int) {
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapBox:natural-line-length-unit].
+// See WrapBox:natural-line-length-unit.
 func (x *WrapBox) GetPropertyNaturalLineLength() int {
 	var v gobject.Value
 	x.GetProperty("natural-line-length", &v)
@@ -806,7 +798,7 @@ func (x *WrapBox) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [gtk.AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -814,14 +806,13 @@ func (x *WrapBox) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *WrapBox) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -845,19 +836,17 @@ func (x *WrapBox) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [gtk.AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *WrapBox) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -878,7 +867,7 @@ func (x *WrapBox) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [gtk.AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -887,14 +876,12 @@ func (x *WrapBox) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *WrapBox) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
 
 	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -913,24 +900,24 @@ func (x *WrapBox) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *WrapBox) GetBuildableId() string {
 
 	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *WrapBox) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *WrapBox) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -942,7 +929,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -951,39 +938,41 @@ func init() {
 
 	core.PuregoSafeRegister(&xWrapBoxGLibType, libs, "adw_wrap_box_get_type")
 
-	core.PuregoSafeRegister(&xNewWrapBox, libs, "adw_wrap_box_new")
-
-	core.PuregoSafeRegister(&xWrapBoxAppend, libs, "adw_wrap_box_append")
-	core.PuregoSafeRegister(&xWrapBoxGetAlign, libs, "adw_wrap_box_get_align")
-	core.PuregoSafeRegister(&xWrapBoxGetChildSpacing, libs, "adw_wrap_box_get_child_spacing")
-	core.PuregoSafeRegister(&xWrapBoxGetChildSpacingUnit, libs, "adw_wrap_box_get_child_spacing_unit")
-	core.PuregoSafeRegister(&xWrapBoxGetJustify, libs, "adw_wrap_box_get_justify")
-	core.PuregoSafeRegister(&xWrapBoxGetJustifyLastLine, libs, "adw_wrap_box_get_justify_last_line")
-	core.PuregoSafeRegister(&xWrapBoxGetLineHomogeneous, libs, "adw_wrap_box_get_line_homogeneous")
-	core.PuregoSafeRegister(&xWrapBoxGetLineSpacing, libs, "adw_wrap_box_get_line_spacing")
-	core.PuregoSafeRegister(&xWrapBoxGetLineSpacingUnit, libs, "adw_wrap_box_get_line_spacing_unit")
-	core.PuregoSafeRegister(&xWrapBoxGetNaturalLineLength, libs, "adw_wrap_box_get_natural_line_length")
-	core.PuregoSafeRegister(&xWrapBoxGetNaturalLineLengthUnit, libs, "adw_wrap_box_get_natural_line_length_unit")
-	core.PuregoSafeRegister(&xWrapBoxGetPackDirection, libs, "adw_wrap_box_get_pack_direction")
-	core.PuregoSafeRegister(&xWrapBoxGetWrapPolicy, libs, "adw_wrap_box_get_wrap_policy")
-	core.PuregoSafeRegister(&xWrapBoxGetWrapReverse, libs, "adw_wrap_box_get_wrap_reverse")
-	core.PuregoSafeRegister(&xWrapBoxInsertChildAfter, libs, "adw_wrap_box_insert_child_after")
-	core.PuregoSafeRegister(&xWrapBoxPrepend, libs, "adw_wrap_box_prepend")
-	core.PuregoSafeRegister(&xWrapBoxRemove, libs, "adw_wrap_box_remove")
-	core.PuregoSafeRegister(&xWrapBoxRemoveAll, libs, "adw_wrap_box_remove_all")
-	core.PuregoSafeRegister(&xWrapBoxReorderChildAfter, libs, "adw_wrap_box_reorder_child_after")
-	core.PuregoSafeRegister(&xWrapBoxSetAlign, libs, "adw_wrap_box_set_align")
-	core.PuregoSafeRegister(&xWrapBoxSetChildSpacing, libs, "adw_wrap_box_set_child_spacing")
-	core.PuregoSafeRegister(&xWrapBoxSetChildSpacingUnit, libs, "adw_wrap_box_set_child_spacing_unit")
-	core.PuregoSafeRegister(&xWrapBoxSetJustify, libs, "adw_wrap_box_set_justify")
-	core.PuregoSafeRegister(&xWrapBoxSetJustifyLastLine, libs, "adw_wrap_box_set_justify_last_line")
-	core.PuregoSafeRegister(&xWrapBoxSetLineHomogeneous, libs, "adw_wrap_box_set_line_homogeneous")
-	core.PuregoSafeRegister(&xWrapBoxSetLineSpacing, libs, "adw_wrap_box_set_line_spacing")
-	core.PuregoSafeRegister(&xWrapBoxSetLineSpacingUnit, libs, "adw_wrap_box_set_line_spacing_unit")
-	core.PuregoSafeRegister(&xWrapBoxSetNaturalLineLength, libs, "adw_wrap_box_set_natural_line_length")
-	core.PuregoSafeRegister(&xWrapBoxSetNaturalLineLengthUnit, libs, "adw_wrap_box_set_natural_line_length_unit")
-	core.PuregoSafeRegister(&xWrapBoxSetPackDirection, libs, "adw_wrap_box_set_pack_direction")
-	core.PuregoSafeRegister(&xWrapBoxSetWrapPolicy, libs, "adw_wrap_box_set_wrap_policy")
-	core.PuregoSafeRegister(&xWrapBoxSetWrapReverse, libs, "adw_wrap_box_set_wrap_reverse")
+	gobject.CheckClassSize("AdwWrapBoxClass", xWrapBoxGLibType, unsafe.Sizeof(WrapBoxClass{}))
+
+	core.PuregoRegisterSince(&xNewWrapBox, libs, "adw_wrap_box_new", "ADW", "1.7")
+
+	core.PuregoRegisterSince(&xWrapBoxAppend, libs, "adw_wrap_box_append", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetAlign, libs, "adw_wrap_box_get_align", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetChildSpacing, libs, "adw_wrap_box_get_child_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetChildSpacingUnit, libs, "adw_wrap_box_get_child_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetJustify, libs, "adw_wrap_box_get_justify", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetJustifyLastLine, libs, "adw_wrap_box_get_justify_last_line", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetLineHomogeneous, libs, "adw_wrap_box_get_line_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetLineSpacing, libs, "adw_wrap_box_get_line_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetLineSpacingUnit, libs, "adw_wrap_box_get_line_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetNaturalLineLength, libs, "adw_wrap_box_get_natural_line_length", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetNaturalLineLengthUnit, libs, "adw_wrap_box_get_natural_line_length_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetPackDirection, libs, "adw_wrap_box_get_pack_direction", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetWrapPolicy, libs, "adw_wrap_box_get_wrap_policy", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxGetWrapReverse, libs, "adw_wrap_box_get_wrap_reverse", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxInsertChildAfter, libs, "adw_wrap_box_insert_child_after", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxPrepend, libs, "adw_wrap_box_prepend", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxRemove, libs, "adw_wrap_box_remove", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxRemoveAll, libs, "adw_wrap_box_remove_all", "ADW", "1.8")
+	core.PuregoRegisterSince(&xWrapBoxReorderChildAfter, libs, "adw_wrap_box_reorder_child_after", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetAlign, libs, "adw_wrap_box_set_align", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetChildSpacing, libs, "adw_wrap_box_set_child_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetChildSpacingUnit, libs, "adw_wrap_box_set_child_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetJustify, libs, "adw_wrap_box_set_justify", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetJustifyLastLine, libs, "adw_wrap_box_set_justify_last_line", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetLineHomogeneous, libs, "adw_wrap_box_set_line_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetLineSpacing, libs, "adw_wrap_box_set_line_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetLineSpacingUnit, libs, "adw_wrap_box_set_line_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetNaturalLineLength, libs, "adw_wrap_box_set_natural_line_length", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetNaturalLineLengthUnit, libs, "adw_wrap_box_set_natural_line_length_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetPackDirection, libs, "adw_wrap_box_set_pack_direction", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetWrapPolicy, libs, "adw_wrap_box_set_wrap_policy", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapBoxSetWrapReverse, libs, "adw_wrap_box_set_wrap_reverse", "ADW", "1.7")
 
 }
@@ -2,10 +2,10 @@
 package adw
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,9 +22,9 @@ func (x *WrapLayoutClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes line justify behaviors in a [class@WrapLayout] or [class@WrapBox].
+// Describes line justify behaviors in a WrapLayout or WrapBox.
 //
-// See [property@WrapLayout:justify] and [property@WrapBox:justify].
+// See WrapLayout:justify and WrapBox:justify.
 type JustifyMode int
 
 var xJustifyModeGLibType func() types.GType
@@ -47,10 +47,49 @@ const (
 	JustifySpreadValue JustifyMode = 2
 )
 
-// Describes child packing behavior in a [class@WrapLayout] or [class@WrapBox].
+// String returns the C name of the value, e.g. for debugging output
+func (x JustifyMode) String() string {
+	switch x {
+	case JustifyNoneValue:
+		return "ADW_JUSTIFY_NONE"
+	case JustifyFillValue:
+		return "ADW_JUSTIFY_FILL"
+	case JustifySpreadValue:
+		return "ADW_JUSTIFY_SPREAD"
+	}
+	return fmt.Sprintf("JustifyMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x JustifyMode) Nick() string {
+	switch x {
+	case JustifyNoneValue:
+		return "none"
+	case JustifyFillValue:
+		return "fill"
+	case JustifySpreadValue:
+		return "spread"
+	}
+	return ""
+}
+
+// JustifyModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func JustifyModeFromString(s string) (JustifyMode, bool) {
+	switch s {
+	case "ADW_JUSTIFY_NONE", "none":
+		return JustifyNoneValue, true
+	case "ADW_JUSTIFY_FILL", "fill":
+		return JustifyFillValue, true
+	case "ADW_JUSTIFY_SPREAD", "spread":
+		return JustifySpreadValue, true
+	}
+	return 0, false
+}
+
+// Describes child packing behavior in a WrapLayout or WrapBox.
 //
-// See [property@WrapLayout:pack-direction] and
-// [property@WrapBox:pack-direction].
+// See WrapLayout:pack-direction and
+// WrapBox:pack-direction.
 type PackDirection int
 
 var xPackDirectionGLibType func() types.GType
@@ -69,9 +108,42 @@ const (
 	PackEndToStartValue PackDirection = 1
 )
 
-// Describes line wrapping behavior in a [class@WrapLayout] or [class@WrapBox].
+// String returns the C name of the value, e.g. for debugging output
+func (x PackDirection) String() string {
+	switch x {
+	case PackStartToEndValue:
+		return "ADW_PACK_START_TO_END"
+	case PackEndToStartValue:
+		return "ADW_PACK_END_TO_START"
+	}
+	return fmt.Sprintf("PackDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PackDirection) Nick() string {
+	switch x {
+	case PackStartToEndValue:
+		return "start-to-end"
+	case PackEndToStartValue:
+		return "end-to-start"
+	}
+	return ""
+}
+
+// PackDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PackDirectionFromString(s string) (PackDirection, bool) {
+	switch s {
+	case "ADW_PACK_START_TO_END", "start-to-end":
+		return PackStartToEndValue, true
+	case "ADW_PACK_END_TO_START", "end-to-start":
+		return PackEndToStartValue, true
+	}
+	return 0, false
+}
+
+// Describes line wrapping behavior in a WrapLayout or WrapBox.
 //
-// See [property@WrapLayout:wrap-policy] and [property@WrapBox:wrap-policy].
+// See WrapLayout:wrap-policy and WrapBox:wrap-policy.
 type WrapPolicy int
 
 var xWrapPolicyGLibType func() types.GType
@@ -90,59 +162,85 @@ const (
 	WrapNaturalValue WrapPolicy = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x WrapPolicy) String() string {
+	switch x {
+	case WrapMinimumValue:
+		return "ADW_WRAP_MINIMUM"
+	case WrapNaturalValue:
+		return "ADW_WRAP_NATURAL"
+	}
+	return fmt.Sprintf("WrapPolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x WrapPolicy) Nick() string {
+	switch x {
+	case WrapMinimumValue:
+		return "minimum"
+	case WrapNaturalValue:
+		return "natural"
+	}
+	return ""
+}
+
+// WrapPolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func WrapPolicyFromString(s string) (WrapPolicy, bool) {
+	switch s {
+	case "ADW_WRAP_MINIMUM", "minimum":
+		return WrapMinimumValue, true
+	case "ADW_WRAP_NATURAL", "natural":
+		return WrapNaturalValue, true
+	}
+	return 0, false
+}
+
 // A box-like layout that can wrap into multiple lines.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="wrap-box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="wrap-box.png" alt="wrap-box"&gt;
-//
-// &lt;/picture&gt;
-//
-// `AdwWrapLayout` is similar to [class@Gtk.BoxLayout], but can wrap lines when
-// the widgets cannot fit otherwise. Unlike [class@Gtk.FlowBox], the children
+// `AdwWrapLayout` is similar to [gtk.BoxLayout], but can wrap lines when
+// the widgets cannot fit otherwise. Unlike [gtk.FlowBox], the children
 // aren't arranged into a grid and behave more like words in a wrapping label.
 //
 // Like `GtkBoxLayout`, `AdwWrapLayout` is orientable and has spacing:
 //
-// - [property@WrapLayout:child-spacing] between children in the same line;
-// - [property@WrapLayout:line-spacing] between lines.
+// - WrapLayout:child-spacing between children in the same line;
+// - WrapLayout:line-spacing between lines.
 //
 // ::: note
 //
 //	Unlike `GtkBoxLayout`, `AdwWrapLayout` cannot follow the CSS
 //	`border-spacing` property.
 //
-// Use the [property@WrapLayout:natural-line-length] property to determine the
-// layout's natural size, e.g. when using it in a [class@Gtk.Popover].
+// Use the WrapLayout:natural-line-length property to determine the
+// layout's natural size, e.g. when using it in a [gtk.Popover].
 //
 // Normally, a horizontal `AdwWrapLayout` wraps left to right and top to bottom
 // for left-to-right languages. Both of these directions can be reversed, using
-// the [property@WrapLayout:pack-direction] and
-// [property@WrapLayout:wrap-reverse] properties. Additionally, the alignment
-// of each line can be controlled with the [property@WrapLayout:align] property.
+// the WrapLayout:pack-direction and
+// WrapLayout:wrap-reverse properties. Additionally, the alignment
+// of each line can be controlled with the WrapLayout:align property.
 //
-// Lines can be justified using the [property@WrapLayout:justify] property,
+// Lines can be justified using the WrapLayout:justify property,
 // filling the entire line by either increasing child size or spacing depending
-// on the value. Set [property@WrapLayout:justify-last-line] to justify the last
+// on the value. Set WrapLayout:justify-last-line to justify the last
 // line as well.
 //
 // By default, `AdwWrapLayout` wraps as soon as the previous line cannot fit
 // any more children without shrinking them past their natural size. Set
-// [property@WrapLayout:wrap-policy] to [enum@Adw.WrapPolicy.MINIMUM] to only
+// WrapLayout:wrap-policy to WrapPolicy.MINIMUM to only
 // wrap once all the children in the previous line have been shrunk to their
 // minimum size.
 //
 // To make each line take the same amount of space, set
-// [property@WrapLayout:line-homogeneous] to `TRUE`.
+// WrapLayout:line-homogeneous to `TRUE`.
 //
 // Spacing and natural line length can scale with the text scale factor, use the
-// [property@WrapLayout:child-spacing-unit],
-// [property@WrapLayout:line-spacing-unit] and/or
-// [property@WrapLayout:natural-line-length-unit] properties to enable that
+// WrapLayout:child-spacing-unit,
+// WrapLayout:line-spacing-unit and/or
+// WrapLayout:natural-line-length-unit properties to enable that
 // behavior.
 //
-// See [class@WrapBox].
+// See WrapBox.
 type WrapLayout struct {
 	gtk.LayoutManager
 }
@@ -300,9 +398,9 @@ var xWrapLayoutSetAlign func(uintptr, float32)
 // placed at the end of the line. 0.5 means they are placed in the middle of the
 // line.
 //
-// Alignment is only used when [property@WrapLayout:justify] is set to
+// Alignment is only used when WrapLayout:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapLayout:justify-last-line] is `FALSE`.
+// WrapLayout:justify-last-line is `FALSE`.
 func (x *WrapLayout) SetAlign(AlignVar float32) {
 
 	xWrapLayoutSetAlign(x.GoPointer(), AlignVar)
@@ -313,7 +411,7 @@ var xWrapLayoutSetChildSpacing func(uintptr, int)
 
 // Sets the spacing between widgets on the same line.
 //
-// See [property@WrapLayout:child-spacing-unit].
+// See WrapLayout:child-spacing-unit.
 func (x *WrapLayout) SetChildSpacing(ChildSpacingVar int) {
 
 	xWrapLayoutSetChildSpacing(x.GoPointer(), ChildSpacingVar)
@@ -326,7 +424,7 @@ var xWrapLayoutSetChildSpacingUnit func(uintptr, LengthUnit)
 //
 // Allows the spacing to vary depending on the text scale factor.
 //
-// See [property@WrapLayout:child-spacing].
+// See WrapLayout:child-spacing.
 func (x *WrapLayout) SetChildSpacingUnit(UnitVar LengthUnit) {
 
 	xWrapLayoutSetChildSpacingUnit(x.GoPointer(), UnitVar)
@@ -348,10 +446,10 @@ var xWrapLayoutSetJustify func(uintptr, JustifyMode)
 //
 // If set to `ADW_JUSTIFY_NONE`, the line will not be stretched and the children
 // will be placed together within the line, according to
-// [property@WrapLayout:align].
+// WrapLayout:align.
 //
 // By default this doesn't affect the last line, as it will be incomplete. Use
-// [property@WrapLayout:justify-last-line] to justify it as well.
+// WrapLayout:justify-last-line to justify it as well.
 func (x *WrapLayout) SetJustify(JustifyVar JustifyMode) {
 
 	xWrapLayoutSetJustify(x.GoPointer(), JustifyVar)
@@ -362,7 +460,7 @@ var xWrapLayoutSetJustifyLastLine func(uintptr, bool)
 
 // Sets whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapLayout:justify].
+// See WrapLayout:justify.
 func (x *WrapLayout) SetJustifyLastLine(JustifyLastLineVar bool) {
 
 	xWrapLayoutSetJustifyLastLine(x.GoPointer(), JustifyLastLineVar)
@@ -382,7 +480,7 @@ var xWrapLayoutSetLineSpacing func(uintptr, int)
 
 // Sets the spacing between lines.
 //
-// See [property@WrapLayout:line-spacing-unit].
+// See WrapLayout:line-spacing-unit.
 func (x *WrapLayout) SetLineSpacing(LineSpacingVar int) {
 
 	xWrapLayoutSetLineSpacing(x.GoPointer(), LineSpacingVar)
@@ -395,7 +493,7 @@ var xWrapLayoutSetLineSpacingUnit func(uintptr, LengthUnit)
 //
 // Allows the spacing to vary depending on the text scale factor.
 //
-// See [property@WrapLayout:line-spacing].
+// See WrapLayout:line-spacing.
 func (x *WrapLayout) SetLineSpacingUnit(UnitVar LengthUnit) {
 
 	xWrapLayoutSetLineSpacingUnit(x.GoPointer(), UnitVar)
@@ -409,7 +507,7 @@ var xWrapLayoutSetNaturalLineLength func(uintptr, int)
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapLayout:natural-line-length-unit].
+// See WrapLayout:natural-line-length-unit.
 func (x *WrapLayout) SetNaturalLineLength(NaturalLineLengthVar int) {
 
 	xWrapLayoutSetNaturalLineLength(x.GoPointer(), NaturalLineLengthVar)
@@ -422,7 +520,7 @@ var xWrapLayoutSetNaturalLineLengthUnit func(uintptr, LengthUnit)
 //
 // Allows the length to vary depending on the text scale factor.
 //
-// See [property@WrapLayout:natural-line-length].
+// See WrapLayout:natural-line-length.
 func (x *WrapLayout) SetNaturalLineLengthUnit(UnitVar LengthUnit) {
 
 	xWrapLayoutSetNaturalLineLengthUnit(x.GoPointer(), UnitVar)
@@ -486,9 +584,9 @@ func (c *WrapLayout) SetGoPointer(ptr uintptr) {
 // placed at the end of the line. 0.5 means they are placed in the middle of
 // the line.
 //
-// Alignment is only used when [property@WrapLayout:justify] is set to
+// Alignment is only used when WrapLayout:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapLayout:justify-last-line] is `FALSE`.
+// WrapLayout:justify-last-line is `FALSE`.
 func (x *WrapLayout) SetPropertyAlign(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -503,9 +601,9 @@ func (x *WrapLayout) SetPropertyAlign(value float32) {
 // placed at the end of the line. 0.5 means they are placed in the middle of
 // the line.
 //
-// Alignment is only used when [property@WrapLayout:justify] is set to
+// Alignment is only used when WrapLayout:justify is set to
 // `ADW_JUSTIFY_NONE`, or on the last line when the
-// [property@WrapLayout:justify-last-line] is `FALSE`.
+// WrapLayout:justify-last-line is `FALSE`.
 func (x *WrapLayout) GetPropertyAlign() float32 {
 	var v gobject.Value
 	x.GetProperty("align", &v)
@@ -515,7 +613,7 @@ func (x *WrapLayout) GetPropertyAlign() float32 {
 // SetPropertyChildSpacing sets the "child-spacing" property.
 // The spacing between widgets on the same line.
 //
-// See [property@WrapLayout:child-spacing-unit].
+// See WrapLayout:child-spacing-unit.
 func (x *WrapLayout) SetPropertyChildSpacing(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -526,7 +624,7 @@ func (x *WrapLayout) SetPropertyChildSpacing(value int) {
 // GetPropertyChildSpacing gets the "child-spacing" property.
 // The spacing between widgets on the same line.
 //
-// See [property@WrapLayout:child-spacing-unit].
+// See WrapLayout:child-spacing-unit.
 func (x *WrapLayout) GetPropertyChildSpacing() int {
 	var v gobject.Value
 	x.GetProperty("child-spacing", &v)
@@ -536,7 +634,7 @@ func (x *WrapLayout) GetPropertyChildSpacing() int {
 // SetPropertyJustifyLastLine sets the "justify-last-line" property.
 // Whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapLayout:justify].
+// See WrapLayout:justify.
 func (x *WrapLayout) SetPropertyJustifyLastLine(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -547,7 +645,7 @@ func (x *WrapLayout) SetPropertyJustifyLastLine(value bool) {
 // GetPropertyJustifyLastLine gets the "justify-last-line" property.
 // Whether the last line should be stretched to fill the entire widget.
 //
-// See [property@WrapLayout:justify].
+// See WrapLayout:justify.
 func (x *WrapLayout) GetPropertyJustifyLastLine() bool {
 	var v gobject.Value
 	x.GetProperty("justify-last-line", &v)
@@ -574,7 +672,7 @@ func (x *WrapLayout) GetPropertyLineHomogeneous() bool {
 // SetPropertyLineSpacing sets the "line-spacing" property.
 // The spacing between lines.
 //
-// See [property@WrapLayout:line-spacing-unit].
+// See WrapLayout:line-spacing-unit.
 func (x *WrapLayout) SetPropertyLineSpacing(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -585,7 +683,7 @@ func (x *WrapLayout) SetPropertyLineSpacing(value int) {
 // GetPropertyLineSpacing gets the "line-spacing" property.
 // The spacing between lines.
 //
-// See [property@WrapLayout:line-spacing-unit].
+// See WrapLayout:line-spacing-unit.
 func (x *WrapLayout) GetPropertyLineSpacing() int {
 	var v gobject.Value
 	x.GetProperty("line-spacing", &v)
@@ -598,7 +696,7 @@ func (x *WrapLayout) GetPropertyLineSpacing() int {
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapLayout:natural-line-length-unit].
+// See WrapLayout:natural-line-length-unit.
 func (x *WrapLayout) SetPropertyNaturalLineLength(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -612,7 +710,7 @@ func (x *WrapLayout) SetPropertyNaturalLineLength(value int) {
 // It should be used to limit the line lengths, for example when used in
 // popovers.
 //
-// See [property@WrapLayout:natural-line-length-unit].
+// See WrapLayout:natural-line-length-unit.
 func (x *WrapLayout) GetPropertyNaturalLineLength() int {
 	var v gobject.Value
 	x.GetProperty("natural-line-length", &v)
@@ -644,14 +742,14 @@ func (x *WrapLayout) GetPropertyWrapReverse() bool {
 	return v.GetBoolean()
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *WrapLayout) GetOrientation() gtk.Orientation {
 
 	cret := gtk.XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *WrapLayout) SetOrientation(OrientationVar gtk.Orientation) {
 
 	gtk.XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -663,7 +761,7 @@ func init() {
 	core.SetSharedLibraries("ADW", []string{"libadwaita-1.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("ADW") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -678,33 +776,35 @@ func init() {
 
 	core.PuregoSafeRegister(&xWrapLayoutGLibType, libs, "adw_wrap_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewWrapLayout, libs, "adw_wrap_layout_new")
-
-	core.PuregoSafeRegister(&xWrapLayoutGetAlign, libs, "adw_wrap_layout_get_align")
-	core.PuregoSafeRegister(&xWrapLayoutGetChildSpacing, libs, "adw_wrap_layout_get_child_spacing")
-	core.PuregoSafeRegister(&xWrapLayoutGetChildSpacingUnit, libs, "adw_wrap_layout_get_child_spacing_unit")
-	core.PuregoSafeRegister(&xWrapLayoutGetJustify, libs, "adw_wrap_layout_get_justify")
-	core.PuregoSafeRegister(&xWrapLayoutGetJustifyLastLine, libs, "adw_wrap_layout_get_justify_last_line")
-	core.PuregoSafeRegister(&xWrapLayoutGetLineHomogeneous, libs, "adw_wrap_layout_get_line_homogeneous")
-	core.PuregoSafeRegister(&xWrapLayoutGetLineSpacing, libs, "adw_wrap_layout_get_line_spacing")
-	core.PuregoSafeRegister(&xWrapLayoutGetLineSpacingUnit, libs, "adw_wrap_layout_get_line_spacing_unit")
-	core.PuregoSafeRegister(&xWrapLayoutGetNaturalLineLength, libs, "adw_wrap_layout_get_natural_line_length")
-	core.PuregoSafeRegister(&xWrapLayoutGetNaturalLineLengthUnit, libs, "adw_wrap_layout_get_natural_line_length_unit")
-	core.PuregoSafeRegister(&xWrapLayoutGetPackDirection, libs, "adw_wrap_layout_get_pack_direction")
-	core.PuregoSafeRegister(&xWrapLayoutGetWrapPolicy, libs, "adw_wrap_layout_get_wrap_policy")
-	core.PuregoSafeRegister(&xWrapLayoutGetWrapReverse, libs, "adw_wrap_layout_get_wrap_reverse")
-	core.PuregoSafeRegister(&xWrapLayoutSetAlign, libs, "adw_wrap_layout_set_align")
-	core.PuregoSafeRegister(&xWrapLayoutSetChildSpacing, libs, "adw_wrap_layout_set_child_spacing")
-	core.PuregoSafeRegister(&xWrapLayoutSetChildSpacingUnit, libs, "adw_wrap_layout_set_child_spacing_unit")
-	core.PuregoSafeRegister(&xWrapLayoutSetJustify, libs, "adw_wrap_layout_set_justify")
-	core.PuregoSafeRegister(&xWrapLayoutSetJustifyLastLine, libs, "adw_wrap_layout_set_justify_last_line")
-	core.PuregoSafeRegister(&xWrapLayoutSetLineHomogeneous, libs, "adw_wrap_layout_set_line_homogeneous")
-	core.PuregoSafeRegister(&xWrapLayoutSetLineSpacing, libs, "adw_wrap_layout_set_line_spacing")
-	core.PuregoSafeRegister(&xWrapLayoutSetLineSpacingUnit, libs, "adw_wrap_layout_set_line_spacing_unit")
-	core.PuregoSafeRegister(&xWrapLayoutSetNaturalLineLength, libs, "adw_wrap_layout_set_natural_line_length")
-	core.PuregoSafeRegister(&xWrapLayoutSetNaturalLineLengthUnit, libs, "adw_wrap_layout_set_natural_line_length_unit")
-	core.PuregoSafeRegister(&xWrapLayoutSetPackDirection, libs, "adw_wrap_layout_set_pack_direction")
-	core.PuregoSafeRegister(&xWrapLayoutSetWrapPolicy, libs, "adw_wrap_layout_set_wrap_policy")
-	core.PuregoSafeRegister(&xWrapLayoutSetWrapReverse, libs, "adw_wrap_layout_set_wrap_reverse")
+	gobject.CheckClassSize("AdwWrapLayoutClass", xWrapLayoutGLibType, unsafe.Sizeof(WrapLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewWrapLayout, libs, "adw_wrap_layout_new", "ADW", "1.7")
+
+	core.PuregoRegisterSince(&xWrapLayoutGetAlign, libs, "adw_wrap_layout_get_align", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetChildSpacing, libs, "adw_wrap_layout_get_child_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetChildSpacingUnit, libs, "adw_wrap_layout_get_child_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetJustify, libs, "adw_wrap_layout_get_justify", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetJustifyLastLine, libs, "adw_wrap_layout_get_justify_last_line", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetLineHomogeneous, libs, "adw_wrap_layout_get_line_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetLineSpacing, libs, "adw_wrap_layout_get_line_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetLineSpacingUnit, libs, "adw_wrap_layout_get_line_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetNaturalLineLength, libs, "adw_wrap_layout_get_natural_line_length", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetNaturalLineLengthUnit, libs, "adw_wrap_layout_get_natural_line_length_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetPackDirection, libs, "adw_wrap_layout_get_pack_direction", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetWrapPolicy, libs, "adw_wrap_layout_get_wrap_policy", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutGetWrapReverse, libs, "adw_wrap_layout_get_wrap_reverse", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetAlign, libs, "adw_wrap_layout_set_align", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetChildSpacing, libs, "adw_wrap_layout_set_child_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetChildSpacingUnit, libs, "adw_wrap_layout_set_child_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetJustify, libs, "adw_wrap_layout_set_justify", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetJustifyLastLine, libs, "adw_wrap_layout_set_justify_last_line", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetLineHomogeneous, libs, "adw_wrap_layout_set_line_homogeneous", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetLineSpacing, libs, "adw_wrap_layout_set_line_spacing", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetLineSpacingUnit, libs, "adw_wrap_layout_set_line_spacing_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetNaturalLineLength, libs, "adw_wrap_layout_set_natural_line_length", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetNaturalLineLengthUnit, libs, "adw_wrap_layout_set_natural_line_length_unit", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetPackDirection, libs, "adw_wrap_layout_set_pack_direction", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetWrapPolicy, libs, "adw_wrap_layout_set_wrap_policy", "ADW", "1.7")
+	core.PuregoRegisterSince(&xWrapLayoutSetWrapReverse, libs, "adw_wrap_layout_set_wrap_reverse", "ADW", "1.7")
 
 }
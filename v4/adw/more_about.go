@@ -0,0 +1,96 @@
+package adw
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// aboutSetters are the setters shared by AboutDialog and AboutWindow
+type aboutSetters interface {
+	SetApplicationName(string)
+	SetApplicationIcon(string)
+	SetComments(string)
+	SetDeveloperName(string)
+	SetVersion(string)
+	SetReleaseNotes(string)
+	SetReleaseNotesVersion(string)
+	SetWebsite(string)
+	SetIssueUrl(string)
+	SetSupportUrl(string)
+	SetLicenseType(gtk.License)
+	SetLicense(string)
+	AddLink(string, string)
+}
+
+// metainfoLinks are the url types that are added as links, with their title
+var metainfoLinks = []struct{ urlType, title string }{
+	{"vcs-browser", "Source Code"},
+	{"translate", "Translate"},
+	{"contribute", "Contribute"},
+	{"donation", "Donate"},
+	{"contact", "Contact"},
+}
+
+func applyMetainfo(x aboutSetters, m *gtk.Metainfo) {
+	if m.Name != "" {
+		x.SetApplicationName(m.Name)
+	}
+	if m.ID != "" {
+		x.SetApplicationIcon(m.ID)
+	}
+	if m.Summary != "" {
+		x.SetComments(m.Summary)
+	}
+	if m.DeveloperName != "" {
+		x.SetDeveloperName(m.DeveloperName)
+	}
+	if r := m.LatestRelease(); r != nil {
+		if r.Version != "" {
+			x.SetVersion(r.Version)
+			x.SetReleaseNotesVersion(r.Version)
+		}
+		if r.Description != "" {
+			x.SetReleaseNotes(r.Description)
+		}
+	}
+	if u := m.URLs["homepage"]; u != "" {
+		x.SetWebsite(u)
+	}
+	if u := m.URLs["bugtracker"]; u != "" {
+		x.SetIssueUrl(u)
+	}
+	if u := m.URLs["help"]; u != "" {
+		x.SetSupportUrl(u)
+	}
+	for _, l := range metainfoLinks {
+		if u := m.URLs[l.urlType]; u != "" {
+			x.AddLink(l.title, u)
+		}
+	}
+	switch t := m.LicenseType(); t {
+	case gtk.LicenseUnknownValue:
+	case gtk.LicenseCustomValue:
+		x.SetLicenseType(t)
+		x.SetLicense(m.ProjectLicense)
+	default:
+		x.SetLicenseType(t)
+	}
+}
+
+// ApplyMetainfo fills the dialog from the AppStream metainfo of the application:
+// name, icon, summary, developer, license, the version and release notes of the latest release,
+// the homepage, bugtracker and help urls and links for the other url types.
+//
+//	//go:embed org.example.App.metainfo.xml
+//	var metainfo []byte
+//
+//	m, err := gtk.ParseMetainfo(metainfo)
+//	if err != nil {
+//		return err
+//	}
+//	dialog := adw.NewAboutDialog()
+//	dialog.ApplyMetainfo(m)
+//
+// Call Validate on the metainfo to find missing information during development.
+func (x *AboutDialog) ApplyMetainfo(m *gtk.Metainfo) {
+	applyMetainfo(x, m)
+}
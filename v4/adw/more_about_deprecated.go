@@ -0,0 +1,10 @@
+//go:build !puregotk_no_deprecated
+
+package adw
+
+import "github.com/jwijenbergh/puregotk/v4/gtk"
+
+// ApplyMetainfo fills the window from the AppStream metainfo of the application, see AboutDialog.ApplyMetainfo.
+func (x *AboutWindow) ApplyMetainfo(m *gtk.Metainfo) {
+	applyMetainfo(x, m)
+}
@@ -2,11 +2,12 @@
 package cairo
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -24,6 +25,21 @@ func (x *Context) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Context made with g_boxed_copy, release it with Free.
+func (x *Context) Copy() *Context {
+	cret := gobject.BoxedCopy(ContextGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Context)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Context with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Context) Free() {
+	gobject.BoxedFree(ContextGLibType(), x.GoPointer())
+}
+
 type Device struct {
 	_ structs.HostLayout
 }
@@ -38,6 +54,21 @@ func (x *Device) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Device made with g_boxed_copy, release it with Free.
+func (x *Device) Copy() *Device {
+	cret := gobject.BoxedCopy(DeviceGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Device)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Device with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Device) Free() {
+	gobject.BoxedFree(DeviceGLibType(), x.GoPointer())
+}
+
 type Surface struct {
 	_ structs.HostLayout
 }
@@ -52,6 +83,21 @@ func (x *Surface) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Surface made with g_boxed_copy, release it with Free.
+func (x *Surface) Copy() *Surface {
+	cret := gobject.BoxedCopy(SurfaceGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Surface)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Surface with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Surface) Free() {
+	gobject.BoxedFree(SurfaceGLibType(), x.GoPointer())
+}
+
 type Matrix struct {
 	_ structs.HostLayout
 }
@@ -74,6 +120,21 @@ func (x *Pattern) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Pattern made with g_boxed_copy, release it with Free.
+func (x *Pattern) Copy() *Pattern {
+	cret := gobject.BoxedCopy(PatternGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Pattern)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Pattern with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Pattern) Free() {
+	gobject.BoxedFree(PatternGLibType(), x.GoPointer())
+}
+
 type Region struct {
 	_ structs.HostLayout
 }
@@ -88,6 +149,21 @@ func (x *Region) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Region made with g_boxed_copy, release it with Free.
+func (x *Region) Copy() *Region {
+	cret := gobject.BoxedCopy(RegionGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Region)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Region with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Region) Free() {
+	gobject.BoxedFree(RegionGLibType(), x.GoPointer())
+}
+
 type FontOptions struct {
 	_ structs.HostLayout
 }
@@ -102,6 +178,21 @@ func (x *FontOptions) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the FontOptions made with g_boxed_copy, release it with Free.
+func (x *FontOptions) Copy() *FontOptions {
+	cret := gobject.BoxedCopy(FontOptionsGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*FontOptions)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the FontOptions with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *FontOptions) Free() {
+	gobject.BoxedFree(FontOptionsGLibType(), x.GoPointer())
+}
+
 type FontFace struct {
 	_ structs.HostLayout
 }
@@ -116,6 +207,21 @@ func (x *FontFace) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the FontFace made with g_boxed_copy, release it with Free.
+func (x *FontFace) Copy() *FontFace {
+	cret := gobject.BoxedCopy(FontFaceGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*FontFace)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the FontFace with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *FontFace) Free() {
+	gobject.BoxedFree(FontFaceGLibType(), x.GoPointer())
+}
+
 type ScaledFont struct {
 	_ structs.HostLayout
 }
@@ -130,6 +236,21 @@ func (x *ScaledFont) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the ScaledFont made with g_boxed_copy, release it with Free.
+func (x *ScaledFont) Copy() *ScaledFont {
+	cret := gobject.BoxedCopy(ScaledFontGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*ScaledFont)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the ScaledFont with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *ScaledFont) Free() {
+	gobject.BoxedFree(ScaledFontGLibType(), x.GoPointer())
+}
+
 type Path struct {
 	_ structs.HostLayout
 }
@@ -160,6 +281,21 @@ func (x *Rectangle) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Rectangle made with g_boxed_copy, release it with Free.
+func (x *Rectangle) Copy() *Rectangle {
+	cret := gobject.BoxedCopy(RectangleGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Rectangle)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Rectangle with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Rectangle) Free() {
+	gobject.BoxedFree(RectangleGLibType(), x.GoPointer())
+}
+
 type RectangleInt struct {
 	_ structs.HostLayout
 
@@ -182,10 +318,25 @@ func (x *RectangleInt) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the RectangleInt made with g_boxed_copy, release it with Free.
+func (x *RectangleInt) Copy() *RectangleInt {
+	cret := gobject.BoxedCopy(RectangleIntGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*RectangleInt)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the RectangleInt with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *RectangleInt) Free() {
+	gobject.BoxedFree(RectangleIntGLibType(), x.GoPointer())
+}
+
 type Glyph struct {
 	_ structs.HostLayout
 
-	Index uint
+	Index types.ULong
 
 	X float64
 
@@ -202,6 +353,21 @@ func (x *Glyph) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Glyph made with g_boxed_copy, release it with Free.
+func (x *Glyph) Copy() *Glyph {
+	cret := gobject.BoxedCopy(GlyphGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Glyph)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Glyph with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Glyph) Free() {
+	gobject.BoxedFree(GlyphGLibType(), x.GoPointer())
+}
+
 type TextCluster struct {
 	_ structs.HostLayout
 
@@ -220,6 +386,21 @@ func (x *TextCluster) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the TextCluster made with g_boxed_copy, release it with Free.
+func (x *TextCluster) Copy() *TextCluster {
+	cret := gobject.BoxedCopy(TextClusterGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*TextCluster)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the TextCluster with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *TextCluster) Free() {
+	gobject.BoxedFree(TextClusterGLibType(), x.GoPointer())
+}
+
 type Status int
 
 var xStatusGLibType func() types.GType
@@ -308,6 +489,261 @@ const (
 	StatusJbig2GlobalMissingValue Status = 38
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Status) String() string {
+	switch x {
+	case StatusSuccessValue:
+		return "CAIRO_STATUS_SUCCESS"
+	case StatusNoMemoryValue:
+		return "CAIRO_STATUS_NO_MEMORY"
+	case StatusInvalidRestoreValue:
+		return "CAIRO_STATUS_INVALID_RESTORE"
+	case StatusInvalidPopGroupValue:
+		return "CAIRO_STATUS_INVALID_POP_GROUP"
+	case StatusNoCurrentPointValue:
+		return "CAIRO_STATUS_NO_CURRENT_POINT"
+	case StatusInvalidMatrixValue:
+		return "CAIRO_STATUS_INVALID_MATRIX"
+	case StatusInvalidStatusValue:
+		return "CAIRO_STATUS_INVALID_STATUS"
+	case StatusNullPointerValue:
+		return "CAIRO_STATUS_NULL_POINTER"
+	case StatusInvalidStringValue:
+		return "CAIRO_STATUS_INVALID_STRING"
+	case StatusInvalidPathDataValue:
+		return "CAIRO_STATUS_INVALID_PATH_DATA"
+	case StatusReadErrorValue:
+		return "CAIRO_STATUS_READ_ERROR"
+	case StatusWriteErrorValue:
+		return "CAIRO_STATUS_WRITE_ERROR"
+	case StatusSurfaceFinishedValue:
+		return "CAIRO_STATUS_SURFACE_FINISHED"
+	case StatusSurfaceTypeMismatchValue:
+		return "CAIRO_STATUS_SURFACE_TYPE_MISMATCH"
+	case StatusPatternTypeMismatchValue:
+		return "CAIRO_STATUS_PATTERN_TYPE_MISMATCH"
+	case StatusInvalidContentValue:
+		return "CAIRO_STATUS_INVALID_CONTENT"
+	case StatusInvalidFormatValue:
+		return "CAIRO_STATUS_INVALID_FORMAT"
+	case StatusInvalidVisualValue:
+		return "CAIRO_STATUS_INVALID_VISUAL"
+	case StatusFileNotFoundValue:
+		return "CAIRO_STATUS_FILE_NOT_FOUND"
+	case StatusInvalidDashValue:
+		return "CAIRO_STATUS_INVALID_DASH"
+	case StatusInvalidDscCommentValue:
+		return "CAIRO_STATUS_INVALID_DSC_COMMENT"
+	case StatusInvalidIndexValue:
+		return "CAIRO_STATUS_INVALID_INDEX"
+	case StatusClipNotRepresentableValue:
+		return "CAIRO_STATUS_CLIP_NOT_REPRESENTABLE"
+	case StatusTempFileErrorValue:
+		return "CAIRO_STATUS_TEMP_FILE_ERROR"
+	case StatusInvalidStrideValue:
+		return "CAIRO_STATUS_INVALID_STRIDE"
+	case StatusFontTypeMismatchValue:
+		return "CAIRO_STATUS_FONT_TYPE_MISMATCH"
+	case StatusUserFontImmutableValue:
+		return "CAIRO_STATUS_USER_FONT_IMMUTABLE"
+	case StatusUserFontErrorValue:
+		return "CAIRO_STATUS_USER_FONT_ERROR"
+	case StatusNegativeCountValue:
+		return "CAIRO_STATUS_NEGATIVE_COUNT"
+	case StatusInvalidClustersValue:
+		return "CAIRO_STATUS_INVALID_CLUSTERS"
+	case StatusInvalidSlantValue:
+		return "CAIRO_STATUS_INVALID_SLANT"
+	case StatusInvalidWeightValue:
+		return "CAIRO_STATUS_INVALID_WEIGHT"
+	case StatusInvalidSizeValue:
+		return "CAIRO_STATUS_INVALID_SIZE"
+	case StatusUserFontNotImplementedValue:
+		return "CAIRO_STATUS_USER_FONT_NOT_IMPLEMENTED"
+	case StatusDeviceTypeMismatchValue:
+		return "CAIRO_STATUS_DEVICE_TYPE_MISMATCH"
+	case StatusDeviceErrorValue:
+		return "CAIRO_STATUS_DEVICE_ERROR"
+	case StatusInvalidMeshConstructionValue:
+		return "CAIRO_STATUS_INVALID_MESH_CONSTRUCTION"
+	case StatusDeviceFinishedValue:
+		return "CAIRO_STATUS_DEVICE_FINISHED"
+	case StatusJbig2GlobalMissingValue:
+		return "CAIRO_STATUS_JBIG2_GLOBAL_MISSING"
+	}
+	return fmt.Sprintf("Status(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Status) Nick() string {
+	switch x {
+	case StatusSuccessValue:
+		return "success"
+	case StatusNoMemoryValue:
+		return "no-memory"
+	case StatusInvalidRestoreValue:
+		return "invalid-restore"
+	case StatusInvalidPopGroupValue:
+		return "invalid-pop-group"
+	case StatusNoCurrentPointValue:
+		return "no-current-point"
+	case StatusInvalidMatrixValue:
+		return "invalid-matrix"
+	case StatusInvalidStatusValue:
+		return "invalid-status"
+	case StatusNullPointerValue:
+		return "null-pointer"
+	case StatusInvalidStringValue:
+		return "invalid-string"
+	case StatusInvalidPathDataValue:
+		return "invalid-path-data"
+	case StatusReadErrorValue:
+		return "read-error"
+	case StatusWriteErrorValue:
+		return "write-error"
+	case StatusSurfaceFinishedValue:
+		return "surface-finished"
+	case StatusSurfaceTypeMismatchValue:
+		return "surface-type-mismatch"
+	case StatusPatternTypeMismatchValue:
+		return "pattern-type-mismatch"
+	case StatusInvalidContentValue:
+		return "invalid-content"
+	case StatusInvalidFormatValue:
+		return "invalid-format"
+	case StatusInvalidVisualValue:
+		return "invalid-visual"
+	case StatusFileNotFoundValue:
+		return "file-not-found"
+	case StatusInvalidDashValue:
+		return "invalid-dash"
+	case StatusInvalidDscCommentValue:
+		return "invalid-dsc-comment"
+	case StatusInvalidIndexValue:
+		return "invalid-index"
+	case StatusClipNotRepresentableValue:
+		return "clip-not-representable"
+	case StatusTempFileErrorValue:
+		return "temp-file-error"
+	case StatusInvalidStrideValue:
+		return "invalid-stride"
+	case StatusFontTypeMismatchValue:
+		return "font-type-mismatch"
+	case StatusUserFontImmutableValue:
+		return "user-font-immutable"
+	case StatusUserFontErrorValue:
+		return "user-font-error"
+	case StatusNegativeCountValue:
+		return "negative-count"
+	case StatusInvalidClustersValue:
+		return "invalid-clusters"
+	case StatusInvalidSlantValue:
+		return "invalid-slant"
+	case StatusInvalidWeightValue:
+		return "invalid-weight"
+	case StatusInvalidSizeValue:
+		return "invalid-size"
+	case StatusUserFontNotImplementedValue:
+		return "user-font-not-implemented"
+	case StatusDeviceTypeMismatchValue:
+		return "device-type-mismatch"
+	case StatusDeviceErrorValue:
+		return "device-error"
+	case StatusInvalidMeshConstructionValue:
+		return "invalid-mesh-construction"
+	case StatusDeviceFinishedValue:
+		return "device-finished"
+	case StatusJbig2GlobalMissingValue:
+		return "jbig2-global-missing"
+	}
+	return ""
+}
+
+// StatusFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StatusFromString(s string) (Status, bool) {
+	switch s {
+	case "CAIRO_STATUS_SUCCESS", "success":
+		return StatusSuccessValue, true
+	case "CAIRO_STATUS_NO_MEMORY", "no-memory":
+		return StatusNoMemoryValue, true
+	case "CAIRO_STATUS_INVALID_RESTORE", "invalid-restore":
+		return StatusInvalidRestoreValue, true
+	case "CAIRO_STATUS_INVALID_POP_GROUP", "invalid-pop-group":
+		return StatusInvalidPopGroupValue, true
+	case "CAIRO_STATUS_NO_CURRENT_POINT", "no-current-point":
+		return StatusNoCurrentPointValue, true
+	case "CAIRO_STATUS_INVALID_MATRIX", "invalid-matrix":
+		return StatusInvalidMatrixValue, true
+	case "CAIRO_STATUS_INVALID_STATUS", "invalid-status":
+		return StatusInvalidStatusValue, true
+	case "CAIRO_STATUS_NULL_POINTER", "null-pointer":
+		return StatusNullPointerValue, true
+	case "CAIRO_STATUS_INVALID_STRING", "invalid-string":
+		return StatusInvalidStringValue, true
+	case "CAIRO_STATUS_INVALID_PATH_DATA", "invalid-path-data":
+		return StatusInvalidPathDataValue, true
+	case "CAIRO_STATUS_READ_ERROR", "read-error":
+		return StatusReadErrorValue, true
+	case "CAIRO_STATUS_WRITE_ERROR", "write-error":
+		return StatusWriteErrorValue, true
+	case "CAIRO_STATUS_SURFACE_FINISHED", "surface-finished":
+		return StatusSurfaceFinishedValue, true
+	case "CAIRO_STATUS_SURFACE_TYPE_MISMATCH", "surface-type-mismatch":
+		return StatusSurfaceTypeMismatchValue, true
+	case "CAIRO_STATUS_PATTERN_TYPE_MISMATCH", "pattern-type-mismatch":
+		return StatusPatternTypeMismatchValue, true
+	case "CAIRO_STATUS_INVALID_CONTENT", "invalid-content":
+		return StatusInvalidContentValue, true
+	case "CAIRO_STATUS_INVALID_FORMAT", "invalid-format":
+		return StatusInvalidFormatValue, true
+	case "CAIRO_STATUS_INVALID_VISUAL", "invalid-visual":
+		return StatusInvalidVisualValue, true
+	case "CAIRO_STATUS_FILE_NOT_FOUND", "file-not-found":
+		return StatusFileNotFoundValue, true
+	case "CAIRO_STATUS_INVALID_DASH", "invalid-dash":
+		return StatusInvalidDashValue, true
+	case "CAIRO_STATUS_INVALID_DSC_COMMENT", "invalid-dsc-comment":
+		return StatusInvalidDscCommentValue, true
+	case "CAIRO_STATUS_INVALID_INDEX", "invalid-index":
+		return StatusInvalidIndexValue, true
+	case "CAIRO_STATUS_CLIP_NOT_REPRESENTABLE", "clip-not-representable":
+		return StatusClipNotRepresentableValue, true
+	case "CAIRO_STATUS_TEMP_FILE_ERROR", "temp-file-error":
+		return StatusTempFileErrorValue, true
+	case "CAIRO_STATUS_INVALID_STRIDE", "invalid-stride":
+		return StatusInvalidStrideValue, true
+	case "CAIRO_STATUS_FONT_TYPE_MISMATCH", "font-type-mismatch":
+		return StatusFontTypeMismatchValue, true
+	case "CAIRO_STATUS_USER_FONT_IMMUTABLE", "user-font-immutable":
+		return StatusUserFontImmutableValue, true
+	case "CAIRO_STATUS_USER_FONT_ERROR", "user-font-error":
+		return StatusUserFontErrorValue, true
+	case "CAIRO_STATUS_NEGATIVE_COUNT", "negative-count":
+		return StatusNegativeCountValue, true
+	case "CAIRO_STATUS_INVALID_CLUSTERS", "invalid-clusters":
+		return StatusInvalidClustersValue, true
+	case "CAIRO_STATUS_INVALID_SLANT", "invalid-slant":
+		return StatusInvalidSlantValue, true
+	case "CAIRO_STATUS_INVALID_WEIGHT", "invalid-weight":
+		return StatusInvalidWeightValue, true
+	case "CAIRO_STATUS_INVALID_SIZE", "invalid-size":
+		return StatusInvalidSizeValue, true
+	case "CAIRO_STATUS_USER_FONT_NOT_IMPLEMENTED", "user-font-not-implemented":
+		return StatusUserFontNotImplementedValue, true
+	case "CAIRO_STATUS_DEVICE_TYPE_MISMATCH", "device-type-mismatch":
+		return StatusDeviceTypeMismatchValue, true
+	case "CAIRO_STATUS_DEVICE_ERROR", "device-error":
+		return StatusDeviceErrorValue, true
+	case "CAIRO_STATUS_INVALID_MESH_CONSTRUCTION", "invalid-mesh-construction":
+		return StatusInvalidMeshConstructionValue, true
+	case "CAIRO_STATUS_DEVICE_FINISHED", "device-finished":
+		return StatusDeviceFinishedValue, true
+	case "CAIRO_STATUS_JBIG2_GLOBAL_MISSING", "jbig2-global-missing":
+		return StatusJbig2GlobalMissingValue, true
+	}
+	return 0, false
+}
+
 type Content int
 
 var xContentGLibType func() types.GType
@@ -324,6 +760,45 @@ const (
 	ContentColorAlphaValue Content = 12288
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Content) String() string {
+	switch x {
+	case ContentColorValue:
+		return "CAIRO_CONTENT_COLOR"
+	case ContentAlphaValue:
+		return "CAIRO_CONTENT_ALPHA"
+	case ContentColorAlphaValue:
+		return "CAIRO_CONTENT_COLOR_ALPHA"
+	}
+	return fmt.Sprintf("Content(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Content) Nick() string {
+	switch x {
+	case ContentColorValue:
+		return "color"
+	case ContentAlphaValue:
+		return "alpha"
+	case ContentColorAlphaValue:
+		return "color-alpha"
+	}
+	return ""
+}
+
+// ContentFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ContentFromString(s string) (Content, bool) {
+	switch s {
+	case "CAIRO_CONTENT_COLOR", "color":
+		return ContentColorValue, true
+	case "CAIRO_CONTENT_ALPHA", "alpha":
+		return ContentAlphaValue, true
+	case "CAIRO_CONTENT_COLOR_ALPHA", "color-alpha":
+		return ContentColorAlphaValue, true
+	}
+	return 0, false
+}
+
 type Operator int
 
 var xOperatorGLibType func() types.GType
@@ -392,6 +867,201 @@ const (
 	OperatorHslLuminosityValue Operator = 28
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Operator) String() string {
+	switch x {
+	case OperatorClearValue:
+		return "CAIRO_OPERATOR_CLEAR"
+	case OperatorSourceValue:
+		return "CAIRO_OPERATOR_SOURCE"
+	case OperatorOverValue:
+		return "CAIRO_OPERATOR_OVER"
+	case OperatorInValue:
+		return "CAIRO_OPERATOR_IN"
+	case OperatorOutValue:
+		return "CAIRO_OPERATOR_OUT"
+	case OperatorAtopValue:
+		return "CAIRO_OPERATOR_ATOP"
+	case OperatorDestValue:
+		return "CAIRO_OPERATOR_DEST"
+	case OperatorDestOverValue:
+		return "CAIRO_OPERATOR_DEST_OVER"
+	case OperatorDestInValue:
+		return "CAIRO_OPERATOR_DEST_IN"
+	case OperatorDestOutValue:
+		return "CAIRO_OPERATOR_DEST_OUT"
+	case OperatorDestAtopValue:
+		return "CAIRO_OPERATOR_DEST_ATOP"
+	case OperatorXorValue:
+		return "CAIRO_OPERATOR_XOR"
+	case OperatorAddValue:
+		return "CAIRO_OPERATOR_ADD"
+	case OperatorSaturateValue:
+		return "CAIRO_OPERATOR_SATURATE"
+	case OperatorMultiplyValue:
+		return "CAIRO_OPERATOR_MULTIPLY"
+	case OperatorScreenValue:
+		return "CAIRO_OPERATOR_SCREEN"
+	case OperatorOverlayValue:
+		return "CAIRO_OPERATOR_OVERLAY"
+	case OperatorDarkenValue:
+		return "CAIRO_OPERATOR_DARKEN"
+	case OperatorLightenValue:
+		return "CAIRO_OPERATOR_LIGHTEN"
+	case OperatorColorDodgeValue:
+		return "CAIRO_OPERATOR_COLOR_DODGE"
+	case OperatorColorBurnValue:
+		return "CAIRO_OPERATOR_COLOR_BURN"
+	case OperatorHardLightValue:
+		return "CAIRO_OPERATOR_HARD_LIGHT"
+	case OperatorSoftLightValue:
+		return "CAIRO_OPERATOR_SOFT_LIGHT"
+	case OperatorDifferenceValue:
+		return "CAIRO_OPERATOR_DIFFERENCE"
+	case OperatorExclusionValue:
+		return "CAIRO_OPERATOR_EXCLUSION"
+	case OperatorHslHueValue:
+		return "CAIRO_OPERATOR_HSL_HUE"
+	case OperatorHslSaturationValue:
+		return "CAIRO_OPERATOR_HSL_SATURATION"
+	case OperatorHslColorValue:
+		return "CAIRO_OPERATOR_HSL_COLOR"
+	case OperatorHslLuminosityValue:
+		return "CAIRO_OPERATOR_HSL_LUMINOSITY"
+	}
+	return fmt.Sprintf("Operator(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Operator) Nick() string {
+	switch x {
+	case OperatorClearValue:
+		return "clear"
+	case OperatorSourceValue:
+		return "source"
+	case OperatorOverValue:
+		return "over"
+	case OperatorInValue:
+		return "in"
+	case OperatorOutValue:
+		return "out"
+	case OperatorAtopValue:
+		return "atop"
+	case OperatorDestValue:
+		return "dest"
+	case OperatorDestOverValue:
+		return "dest-over"
+	case OperatorDestInValue:
+		return "dest-in"
+	case OperatorDestOutValue:
+		return "dest-out"
+	case OperatorDestAtopValue:
+		return "dest-atop"
+	case OperatorXorValue:
+		return "xor"
+	case OperatorAddValue:
+		return "add"
+	case OperatorSaturateValue:
+		return "saturate"
+	case OperatorMultiplyValue:
+		return "multiply"
+	case OperatorScreenValue:
+		return "screen"
+	case OperatorOverlayValue:
+		return "overlay"
+	case OperatorDarkenValue:
+		return "darken"
+	case OperatorLightenValue:
+		return "lighten"
+	case OperatorColorDodgeValue:
+		return "color-dodge"
+	case OperatorColorBurnValue:
+		return "color-burn"
+	case OperatorHardLightValue:
+		return "hard-light"
+	case OperatorSoftLightValue:
+		return "soft-light"
+	case OperatorDifferenceValue:
+		return "difference"
+	case OperatorExclusionValue:
+		return "exclusion"
+	case OperatorHslHueValue:
+		return "hsl-hue"
+	case OperatorHslSaturationValue:
+		return "hsl-saturation"
+	case OperatorHslColorValue:
+		return "hsl-color"
+	case OperatorHslLuminosityValue:
+		return "hsl-luminosity"
+	}
+	return ""
+}
+
+// OperatorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OperatorFromString(s string) (Operator, bool) {
+	switch s {
+	case "CAIRO_OPERATOR_CLEAR", "clear":
+		return OperatorClearValue, true
+	case "CAIRO_OPERATOR_SOURCE", "source":
+		return OperatorSourceValue, true
+	case "CAIRO_OPERATOR_OVER", "over":
+		return OperatorOverValue, true
+	case "CAIRO_OPERATOR_IN", "in":
+		return OperatorInValue, true
+	case "CAIRO_OPERATOR_OUT", "out":
+		return OperatorOutValue, true
+	case "CAIRO_OPERATOR_ATOP", "atop":
+		return OperatorAtopValue, true
+	case "CAIRO_OPERATOR_DEST", "dest":
+		return OperatorDestValue, true
+	case "CAIRO_OPERATOR_DEST_OVER", "dest-over":
+		return OperatorDestOverValue, true
+	case "CAIRO_OPERATOR_DEST_IN", "dest-in":
+		return OperatorDestInValue, true
+	case "CAIRO_OPERATOR_DEST_OUT", "dest-out":
+		return OperatorDestOutValue, true
+	case "CAIRO_OPERATOR_DEST_ATOP", "dest-atop":
+		return OperatorDestAtopValue, true
+	case "CAIRO_OPERATOR_XOR", "xor":
+		return OperatorXorValue, true
+	case "CAIRO_OPERATOR_ADD", "add":
+		return OperatorAddValue, true
+	case "CAIRO_OPERATOR_SATURATE", "saturate":
+		return OperatorSaturateValue, true
+	case "CAIRO_OPERATOR_MULTIPLY", "multiply":
+		return OperatorMultiplyValue, true
+	case "CAIRO_OPERATOR_SCREEN", "screen":
+		return OperatorScreenValue, true
+	case "CAIRO_OPERATOR_OVERLAY", "overlay":
+		return OperatorOverlayValue, true
+	case "CAIRO_OPERATOR_DARKEN", "darken":
+		return OperatorDarkenValue, true
+	case "CAIRO_OPERATOR_LIGHTEN", "lighten":
+		return OperatorLightenValue, true
+	case "CAIRO_OPERATOR_COLOR_DODGE", "color-dodge":
+		return OperatorColorDodgeValue, true
+	case "CAIRO_OPERATOR_COLOR_BURN", "color-burn":
+		return OperatorColorBurnValue, true
+	case "CAIRO_OPERATOR_HARD_LIGHT", "hard-light":
+		return OperatorHardLightValue, true
+	case "CAIRO_OPERATOR_SOFT_LIGHT", "soft-light":
+		return OperatorSoftLightValue, true
+	case "CAIRO_OPERATOR_DIFFERENCE", "difference":
+		return OperatorDifferenceValue, true
+	case "CAIRO_OPERATOR_EXCLUSION", "exclusion":
+		return OperatorExclusionValue, true
+	case "CAIRO_OPERATOR_HSL_HUE", "hsl-hue":
+		return OperatorHslHueValue, true
+	case "CAIRO_OPERATOR_HSL_SATURATION", "hsl-saturation":
+		return OperatorHslSaturationValue, true
+	case "CAIRO_OPERATOR_HSL_COLOR", "hsl-color":
+		return OperatorHslColorValue, true
+	case "CAIRO_OPERATOR_HSL_LUMINOSITY", "hsl-luminosity":
+		return OperatorHslLuminosityValue, true
+	}
+	return 0, false
+}
+
 type Antialias int
 
 var xAntialiasGLibType func() types.GType
@@ -416,6 +1086,69 @@ const (
 	AntialiasBestValue Antialias = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Antialias) String() string {
+	switch x {
+	case AntialiasDefaultValue:
+		return "CAIRO_ANTIALIAS_DEFAULT"
+	case AntialiasNoneValue:
+		return "CAIRO_ANTIALIAS_NONE"
+	case AntialiasGrayValue:
+		return "CAIRO_ANTIALIAS_GRAY"
+	case AntialiasSubpixelValue:
+		return "CAIRO_ANTIALIAS_SUBPIXEL"
+	case AntialiasFastValue:
+		return "CAIRO_ANTIALIAS_FAST"
+	case AntialiasGoodValue:
+		return "CAIRO_ANTIALIAS_GOOD"
+	case AntialiasBestValue:
+		return "CAIRO_ANTIALIAS_BEST"
+	}
+	return fmt.Sprintf("Antialias(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Antialias) Nick() string {
+	switch x {
+	case AntialiasDefaultValue:
+		return "default"
+	case AntialiasNoneValue:
+		return "none"
+	case AntialiasGrayValue:
+		return "gray"
+	case AntialiasSubpixelValue:
+		return "subpixel"
+	case AntialiasFastValue:
+		return "fast"
+	case AntialiasGoodValue:
+		return "good"
+	case AntialiasBestValue:
+		return "best"
+	}
+	return ""
+}
+
+// AntialiasFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AntialiasFromString(s string) (Antialias, bool) {
+	switch s {
+	case "CAIRO_ANTIALIAS_DEFAULT", "default":
+		return AntialiasDefaultValue, true
+	case "CAIRO_ANTIALIAS_NONE", "none":
+		return AntialiasNoneValue, true
+	case "CAIRO_ANTIALIAS_GRAY", "gray":
+		return AntialiasGrayValue, true
+	case "CAIRO_ANTIALIAS_SUBPIXEL", "subpixel":
+		return AntialiasSubpixelValue, true
+	case "CAIRO_ANTIALIAS_FAST", "fast":
+		return AntialiasFastValue, true
+	case "CAIRO_ANTIALIAS_GOOD", "good":
+		return AntialiasGoodValue, true
+	case "CAIRO_ANTIALIAS_BEST", "best":
+		return AntialiasBestValue, true
+	}
+	return 0, false
+}
+
 type FillRule int
 
 var xFillRuleGLibType func() types.GType
@@ -430,6 +1163,39 @@ const (
 	FillRuleEvenOddValue FillRule = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FillRule) String() string {
+	switch x {
+	case FillRuleWindingValue:
+		return "CAIRO_FILL_RULE_WINDING"
+	case FillRuleEvenOddValue:
+		return "CAIRO_FILL_RULE_EVEN_ODD"
+	}
+	return fmt.Sprintf("FillRule(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FillRule) Nick() string {
+	switch x {
+	case FillRuleWindingValue:
+		return "winding"
+	case FillRuleEvenOddValue:
+		return "even-odd"
+	}
+	return ""
+}
+
+// FillRuleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FillRuleFromString(s string) (FillRule, bool) {
+	switch s {
+	case "CAIRO_FILL_RULE_WINDING", "winding":
+		return FillRuleWindingValue, true
+	case "CAIRO_FILL_RULE_EVEN_ODD", "even-odd":
+		return FillRuleEvenOddValue, true
+	}
+	return 0, false
+}
+
 type LineCap int
 
 var xLineCapGLibType func() types.GType
@@ -446,6 +1212,45 @@ const (
 	LineCapSquareValue LineCap = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LineCap) String() string {
+	switch x {
+	case LineCapButtValue:
+		return "CAIRO_LINE_CAP_BUTT"
+	case LineCapRoundValue:
+		return "CAIRO_LINE_CAP_ROUND"
+	case LineCapSquareValue:
+		return "CAIRO_LINE_CAP_SQUARE"
+	}
+	return fmt.Sprintf("LineCap(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LineCap) Nick() string {
+	switch x {
+	case LineCapButtValue:
+		return "butt"
+	case LineCapRoundValue:
+		return "round"
+	case LineCapSquareValue:
+		return "square"
+	}
+	return ""
+}
+
+// LineCapFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LineCapFromString(s string) (LineCap, bool) {
+	switch s {
+	case "CAIRO_LINE_CAP_BUTT", "butt":
+		return LineCapButtValue, true
+	case "CAIRO_LINE_CAP_ROUND", "round":
+		return LineCapRoundValue, true
+	case "CAIRO_LINE_CAP_SQUARE", "square":
+		return LineCapSquareValue, true
+	}
+	return 0, false
+}
+
 type LineJoin int
 
 var xLineJoinGLibType func() types.GType
@@ -462,6 +1267,45 @@ const (
 	LineJoinBevelValue LineJoin = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LineJoin) String() string {
+	switch x {
+	case LineJoinMiterValue:
+		return "CAIRO_LINE_JOIN_MITER"
+	case LineJoinRoundValue:
+		return "CAIRO_LINE_JOIN_ROUND"
+	case LineJoinBevelValue:
+		return "CAIRO_LINE_JOIN_BEVEL"
+	}
+	return fmt.Sprintf("LineJoin(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LineJoin) Nick() string {
+	switch x {
+	case LineJoinMiterValue:
+		return "miter"
+	case LineJoinRoundValue:
+		return "round"
+	case LineJoinBevelValue:
+		return "bevel"
+	}
+	return ""
+}
+
+// LineJoinFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LineJoinFromString(s string) (LineJoin, bool) {
+	switch s {
+	case "CAIRO_LINE_JOIN_MITER", "miter":
+		return LineJoinMiterValue, true
+	case "CAIRO_LINE_JOIN_ROUND", "round":
+		return LineJoinRoundValue, true
+	case "CAIRO_LINE_JOIN_BEVEL", "bevel":
+		return LineJoinBevelValue, true
+	}
+	return 0, false
+}
+
 type TextClusterFlags int
 
 var xTextClusterFlagsGLibType func() types.GType
@@ -474,6 +1318,33 @@ const (
 	TextClusterFlagBackwardValue TextClusterFlags = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TextClusterFlags) String() string {
+	switch x {
+	case TextClusterFlagBackwardValue:
+		return "CAIRO_TEXT_CLUSTER_FLAG_BACKWARD"
+	}
+	return fmt.Sprintf("TextClusterFlags(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextClusterFlags) Nick() string {
+	switch x {
+	case TextClusterFlagBackwardValue:
+		return "backward"
+	}
+	return ""
+}
+
+// TextClusterFlagsFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextClusterFlagsFromString(s string) (TextClusterFlags, bool) {
+	switch s {
+	case "CAIRO_TEXT_CLUSTER_FLAG_BACKWARD", "backward":
+		return TextClusterFlagBackwardValue, true
+	}
+	return 0, false
+}
+
 type FontSlant int
 
 var xFontSlantGLibType func() types.GType
@@ -490,6 +1361,45 @@ const (
 	FontSlantObliqueValue FontSlant = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontSlant) String() string {
+	switch x {
+	case FontSlantNormalValue:
+		return "CAIRO_FONT_SLANT_NORMAL"
+	case FontSlantItalicValue:
+		return "CAIRO_FONT_SLANT_ITALIC"
+	case FontSlantObliqueValue:
+		return "CAIRO_FONT_SLANT_OBLIQUE"
+	}
+	return fmt.Sprintf("FontSlant(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontSlant) Nick() string {
+	switch x {
+	case FontSlantNormalValue:
+		return "normal"
+	case FontSlantItalicValue:
+		return "italic"
+	case FontSlantObliqueValue:
+		return "oblique"
+	}
+	return ""
+}
+
+// FontSlantFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontSlantFromString(s string) (FontSlant, bool) {
+	switch s {
+	case "CAIRO_FONT_SLANT_NORMAL", "normal":
+		return FontSlantNormalValue, true
+	case "CAIRO_FONT_SLANT_ITALIC", "italic":
+		return FontSlantItalicValue, true
+	case "CAIRO_FONT_SLANT_OBLIQUE", "oblique":
+		return FontSlantObliqueValue, true
+	}
+	return 0, false
+}
+
 type FontWeight int
 
 var xFontWeightGLibType func() types.GType
@@ -504,6 +1414,39 @@ const (
 	FontWeightBoldValue FontWeight = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontWeight) String() string {
+	switch x {
+	case FontWeightNormalValue:
+		return "CAIRO_FONT_WEIGHT_NORMAL"
+	case FontWeightBoldValue:
+		return "CAIRO_FONT_WEIGHT_BOLD"
+	}
+	return fmt.Sprintf("FontWeight(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontWeight) Nick() string {
+	switch x {
+	case FontWeightNormalValue:
+		return "normal"
+	case FontWeightBoldValue:
+		return "bold"
+	}
+	return ""
+}
+
+// FontWeightFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontWeightFromString(s string) (FontWeight, bool) {
+	switch s {
+	case "CAIRO_FONT_WEIGHT_NORMAL", "normal":
+		return FontWeightNormalValue, true
+	case "CAIRO_FONT_WEIGHT_BOLD", "bold":
+		return FontWeightBoldValue, true
+	}
+	return 0, false
+}
+
 type SubpixelOrder int
 
 var xSubpixelOrderGLibType func() types.GType
@@ -524,6 +1467,57 @@ const (
 	SubpixelOrderVbgrValue SubpixelOrder = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SubpixelOrder) String() string {
+	switch x {
+	case SubpixelOrderDefaultValue:
+		return "CAIRO_SUBPIXEL_ORDER_DEFAULT"
+	case SubpixelOrderRgbValue:
+		return "CAIRO_SUBPIXEL_ORDER_RGB"
+	case SubpixelOrderBgrValue:
+		return "CAIRO_SUBPIXEL_ORDER_BGR"
+	case SubpixelOrderVrgbValue:
+		return "CAIRO_SUBPIXEL_ORDER_VRGB"
+	case SubpixelOrderVbgrValue:
+		return "CAIRO_SUBPIXEL_ORDER_VBGR"
+	}
+	return fmt.Sprintf("SubpixelOrder(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SubpixelOrder) Nick() string {
+	switch x {
+	case SubpixelOrderDefaultValue:
+		return "default"
+	case SubpixelOrderRgbValue:
+		return "rgb"
+	case SubpixelOrderBgrValue:
+		return "bgr"
+	case SubpixelOrderVrgbValue:
+		return "vrgb"
+	case SubpixelOrderVbgrValue:
+		return "vbgr"
+	}
+	return ""
+}
+
+// SubpixelOrderFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SubpixelOrderFromString(s string) (SubpixelOrder, bool) {
+	switch s {
+	case "CAIRO_SUBPIXEL_ORDER_DEFAULT", "default":
+		return SubpixelOrderDefaultValue, true
+	case "CAIRO_SUBPIXEL_ORDER_RGB", "rgb":
+		return SubpixelOrderRgbValue, true
+	case "CAIRO_SUBPIXEL_ORDER_BGR", "bgr":
+		return SubpixelOrderBgrValue, true
+	case "CAIRO_SUBPIXEL_ORDER_VRGB", "vrgb":
+		return SubpixelOrderVrgbValue, true
+	case "CAIRO_SUBPIXEL_ORDER_VBGR", "vbgr":
+		return SubpixelOrderVbgrValue, true
+	}
+	return 0, false
+}
+
 type HintStyle int
 
 var xHintStyleGLibType func() types.GType
@@ -544,6 +1538,57 @@ const (
 	HintStyleFullValue HintStyle = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x HintStyle) String() string {
+	switch x {
+	case HintStyleDefaultValue:
+		return "CAIRO_HINT_STYLE_DEFAULT"
+	case HintStyleNoneValue:
+		return "CAIRO_HINT_STYLE_NONE"
+	case HintStyleSlightValue:
+		return "CAIRO_HINT_STYLE_SLIGHT"
+	case HintStyleMediumValue:
+		return "CAIRO_HINT_STYLE_MEDIUM"
+	case HintStyleFullValue:
+		return "CAIRO_HINT_STYLE_FULL"
+	}
+	return fmt.Sprintf("HintStyle(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x HintStyle) Nick() string {
+	switch x {
+	case HintStyleDefaultValue:
+		return "default"
+	case HintStyleNoneValue:
+		return "none"
+	case HintStyleSlightValue:
+		return "slight"
+	case HintStyleMediumValue:
+		return "medium"
+	case HintStyleFullValue:
+		return "full"
+	}
+	return ""
+}
+
+// HintStyleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func HintStyleFromString(s string) (HintStyle, bool) {
+	switch s {
+	case "CAIRO_HINT_STYLE_DEFAULT", "default":
+		return HintStyleDefaultValue, true
+	case "CAIRO_HINT_STYLE_NONE", "none":
+		return HintStyleNoneValue, true
+	case "CAIRO_HINT_STYLE_SLIGHT", "slight":
+		return HintStyleSlightValue, true
+	case "CAIRO_HINT_STYLE_MEDIUM", "medium":
+		return HintStyleMediumValue, true
+	case "CAIRO_HINT_STYLE_FULL", "full":
+		return HintStyleFullValue, true
+	}
+	return 0, false
+}
+
 type HintMetrics int
 
 var xHintMetricsGLibType func() types.GType
@@ -560,6 +1605,45 @@ const (
 	HintMetricsOnValue HintMetrics = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x HintMetrics) String() string {
+	switch x {
+	case HintMetricsDefaultValue:
+		return "CAIRO_HINT_METRICS_DEFAULT"
+	case HintMetricsOffValue:
+		return "CAIRO_HINT_METRICS_OFF"
+	case HintMetricsOnValue:
+		return "CAIRO_HINT_METRICS_ON"
+	}
+	return fmt.Sprintf("HintMetrics(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x HintMetrics) Nick() string {
+	switch x {
+	case HintMetricsDefaultValue:
+		return "default"
+	case HintMetricsOffValue:
+		return "off"
+	case HintMetricsOnValue:
+		return "on"
+	}
+	return ""
+}
+
+// HintMetricsFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func HintMetricsFromString(s string) (HintMetrics, bool) {
+	switch s {
+	case "CAIRO_HINT_METRICS_DEFAULT", "default":
+		return HintMetricsDefaultValue, true
+	case "CAIRO_HINT_METRICS_OFF", "off":
+		return HintMetricsOffValue, true
+	case "CAIRO_HINT_METRICS_ON", "on":
+		return HintMetricsOnValue, true
+	}
+	return 0, false
+}
+
 type FontType int
 
 var xFontTypeGLibType func() types.GType
@@ -580,6 +1664,57 @@ const (
 	FontTypeUserValue FontType = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontType) String() string {
+	switch x {
+	case FontTypeToyValue:
+		return "CAIRO_FONT_TYPE_TOY"
+	case FontTypeFtValue:
+		return "CAIRO_FONT_TYPE_FT"
+	case FontTypeWin32Value:
+		return "CAIRO_FONT_TYPE_WIN32"
+	case FontTypeQuartzValue:
+		return "CAIRO_FONT_TYPE_QUARTZ"
+	case FontTypeUserValue:
+		return "CAIRO_FONT_TYPE_USER"
+	}
+	return fmt.Sprintf("FontType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontType) Nick() string {
+	switch x {
+	case FontTypeToyValue:
+		return "toy"
+	case FontTypeFtValue:
+		return "ft"
+	case FontTypeWin32Value:
+		return "win32"
+	case FontTypeQuartzValue:
+		return "quartz"
+	case FontTypeUserValue:
+		return "user"
+	}
+	return ""
+}
+
+// FontTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontTypeFromString(s string) (FontType, bool) {
+	switch s {
+	case "CAIRO_FONT_TYPE_TOY", "toy":
+		return FontTypeToyValue, true
+	case "CAIRO_FONT_TYPE_FT", "ft":
+		return FontTypeFtValue, true
+	case "CAIRO_FONT_TYPE_WIN32", "win32":
+		return FontTypeWin32Value, true
+	case "CAIRO_FONT_TYPE_QUARTZ", "quartz":
+		return FontTypeQuartzValue, true
+	case "CAIRO_FONT_TYPE_USER", "user":
+		return FontTypeUserValue, true
+	}
+	return 0, false
+}
+
 type PathDataType int
 
 var xPathDataTypeGLibType func() types.GType
@@ -598,6 +1733,51 @@ const (
 	PathClosePathValue PathDataType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PathDataType) String() string {
+	switch x {
+	case PathMoveToValue:
+		return "CAIRO_PATH_MOVE_TO"
+	case PathLineToValue:
+		return "CAIRO_PATH_LINE_TO"
+	case PathCurveToValue:
+		return "CAIRO_PATH_CURVE_TO"
+	case PathClosePathValue:
+		return "CAIRO_PATH_CLOSE_PATH"
+	}
+	return fmt.Sprintf("PathDataType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PathDataType) Nick() string {
+	switch x {
+	case PathMoveToValue:
+		return "move-to"
+	case PathLineToValue:
+		return "line-to"
+	case PathCurveToValue:
+		return "curve-to"
+	case PathClosePathValue:
+		return "close-path"
+	}
+	return ""
+}
+
+// PathDataTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PathDataTypeFromString(s string) (PathDataType, bool) {
+	switch s {
+	case "CAIRO_PATH_MOVE_TO", "move-to":
+		return PathMoveToValue, true
+	case "CAIRO_PATH_LINE_TO", "line-to":
+		return PathLineToValue, true
+	case "CAIRO_PATH_CURVE_TO", "curve-to":
+		return PathCurveToValue, true
+	case "CAIRO_PATH_CLOSE_PATH", "close-path":
+		return PathClosePathValue, true
+	}
+	return 0, false
+}
+
 type DeviceType int
 
 var xDeviceTypeGLibType func() types.GType
@@ -626,6 +1806,81 @@ const (
 	DeviceTypeInvalidValue DeviceType = -1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DeviceType) String() string {
+	switch x {
+	case DeviceTypeDrmValue:
+		return "CAIRO_DEVICE_TYPE_DRM"
+	case DeviceTypeGlValue:
+		return "CAIRO_DEVICE_TYPE_GL"
+	case DeviceTypeScriptValue:
+		return "CAIRO_DEVICE_TYPE_SCRIPT"
+	case DeviceTypeXcbValue:
+		return "CAIRO_DEVICE_TYPE_XCB"
+	case DeviceTypeXlibValue:
+		return "CAIRO_DEVICE_TYPE_XLIB"
+	case DeviceTypeXmlValue:
+		return "CAIRO_DEVICE_TYPE_XML"
+	case DeviceTypeCoglValue:
+		return "CAIRO_DEVICE_TYPE_COGL"
+	case DeviceTypeWin32Value:
+		return "CAIRO_DEVICE_TYPE_WIN32"
+	case DeviceTypeInvalidValue:
+		return "CAIRO_DEVICE_TYPE_INVALID"
+	}
+	return fmt.Sprintf("DeviceType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DeviceType) Nick() string {
+	switch x {
+	case DeviceTypeDrmValue:
+		return "drm"
+	case DeviceTypeGlValue:
+		return "gl"
+	case DeviceTypeScriptValue:
+		return "script"
+	case DeviceTypeXcbValue:
+		return "xcb"
+	case DeviceTypeXlibValue:
+		return "xlib"
+	case DeviceTypeXmlValue:
+		return "xml"
+	case DeviceTypeCoglValue:
+		return "cogl"
+	case DeviceTypeWin32Value:
+		return "win32"
+	case DeviceTypeInvalidValue:
+		return "invalid"
+	}
+	return ""
+}
+
+// DeviceTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DeviceTypeFromString(s string) (DeviceType, bool) {
+	switch s {
+	case "CAIRO_DEVICE_TYPE_DRM", "drm":
+		return DeviceTypeDrmValue, true
+	case "CAIRO_DEVICE_TYPE_GL", "gl":
+		return DeviceTypeGlValue, true
+	case "CAIRO_DEVICE_TYPE_SCRIPT", "script":
+		return DeviceTypeScriptValue, true
+	case "CAIRO_DEVICE_TYPE_XCB", "xcb":
+		return DeviceTypeXcbValue, true
+	case "CAIRO_DEVICE_TYPE_XLIB", "xlib":
+		return DeviceTypeXlibValue, true
+	case "CAIRO_DEVICE_TYPE_XML", "xml":
+		return DeviceTypeXmlValue, true
+	case "CAIRO_DEVICE_TYPE_COGL", "cogl":
+		return DeviceTypeCoglValue, true
+	case "CAIRO_DEVICE_TYPE_WIN32", "win32":
+		return DeviceTypeWin32Value, true
+	case "CAIRO_DEVICE_TYPE_INVALID", "invalid":
+		return DeviceTypeInvalidValue, true
+	}
+	return 0, false
+}
+
 type SurfaceType int
 
 var xSurfaceTypeGLibType func() types.GType
@@ -686,6 +1941,177 @@ const (
 	SurfaceTypeCoglValue SurfaceType = 24
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SurfaceType) String() string {
+	switch x {
+	case SurfaceTypeImageValue:
+		return "CAIRO_SURFACE_TYPE_IMAGE"
+	case SurfaceTypePdfValue:
+		return "CAIRO_SURFACE_TYPE_PDF"
+	case SurfaceTypePsValue:
+		return "CAIRO_SURFACE_TYPE_PS"
+	case SurfaceTypeXlibValue:
+		return "CAIRO_SURFACE_TYPE_XLIB"
+	case SurfaceTypeXcbValue:
+		return "CAIRO_SURFACE_TYPE_XCB"
+	case SurfaceTypeGlitzValue:
+		return "CAIRO_SURFACE_TYPE_GLITZ"
+	case SurfaceTypeQuartzValue:
+		return "CAIRO_SURFACE_TYPE_QUARTZ"
+	case SurfaceTypeWin32Value:
+		return "CAIRO_SURFACE_TYPE_WIN32"
+	case SurfaceTypeBeosValue:
+		return "CAIRO_SURFACE_TYPE_BEOS"
+	case SurfaceTypeDirectfbValue:
+		return "CAIRO_SURFACE_TYPE_DIRECTFB"
+	case SurfaceTypeSvgValue:
+		return "CAIRO_SURFACE_TYPE_SVG"
+	case SurfaceTypeOs2Value:
+		return "CAIRO_SURFACE_TYPE_OS2"
+	case SurfaceTypeWin32PrintingValue:
+		return "CAIRO_SURFACE_TYPE_WIN32_PRINTING"
+	case SurfaceTypeQuartzImageValue:
+		return "CAIRO_SURFACE_TYPE_QUARTZ_IMAGE"
+	case SurfaceTypeScriptValue:
+		return "CAIRO_SURFACE_TYPE_SCRIPT"
+	case SurfaceTypeQtValue:
+		return "CAIRO_SURFACE_TYPE_QT"
+	case SurfaceTypeRecordingValue:
+		return "CAIRO_SURFACE_TYPE_RECORDING"
+	case SurfaceTypeVgValue:
+		return "CAIRO_SURFACE_TYPE_VG"
+	case SurfaceTypeGlValue:
+		return "CAIRO_SURFACE_TYPE_GL"
+	case SurfaceTypeDrmValue:
+		return "CAIRO_SURFACE_TYPE_DRM"
+	case SurfaceTypeTeeValue:
+		return "CAIRO_SURFACE_TYPE_TEE"
+	case SurfaceTypeXmlValue:
+		return "CAIRO_SURFACE_TYPE_XML"
+	case SurfaceTypeSkiaValue:
+		return "CAIRO_SURFACE_TYPE_SKIA"
+	case SurfaceTypeSubsurfaceValue:
+		return "CAIRO_SURFACE_TYPE_SUBSURFACE"
+	case SurfaceTypeCoglValue:
+		return "CAIRO_SURFACE_TYPE_COGL"
+	}
+	return fmt.Sprintf("SurfaceType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SurfaceType) Nick() string {
+	switch x {
+	case SurfaceTypeImageValue:
+		return "image"
+	case SurfaceTypePdfValue:
+		return "pdf"
+	case SurfaceTypePsValue:
+		return "ps"
+	case SurfaceTypeXlibValue:
+		return "xlib"
+	case SurfaceTypeXcbValue:
+		return "xcb"
+	case SurfaceTypeGlitzValue:
+		return "glitz"
+	case SurfaceTypeQuartzValue:
+		return "quartz"
+	case SurfaceTypeWin32Value:
+		return "win32"
+	case SurfaceTypeBeosValue:
+		return "beos"
+	case SurfaceTypeDirectfbValue:
+		return "directfb"
+	case SurfaceTypeSvgValue:
+		return "svg"
+	case SurfaceTypeOs2Value:
+		return "os2"
+	case SurfaceTypeWin32PrintingValue:
+		return "win32-printing"
+	case SurfaceTypeQuartzImageValue:
+		return "quartz-image"
+	case SurfaceTypeScriptValue:
+		return "script"
+	case SurfaceTypeQtValue:
+		return "qt"
+	case SurfaceTypeRecordingValue:
+		return "recording"
+	case SurfaceTypeVgValue:
+		return "vg"
+	case SurfaceTypeGlValue:
+		return "gl"
+	case SurfaceTypeDrmValue:
+		return "drm"
+	case SurfaceTypeTeeValue:
+		return "tee"
+	case SurfaceTypeXmlValue:
+		return "xml"
+	case SurfaceTypeSkiaValue:
+		return "skia"
+	case SurfaceTypeSubsurfaceValue:
+		return "subsurface"
+	case SurfaceTypeCoglValue:
+		return "cogl"
+	}
+	return ""
+}
+
+// SurfaceTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SurfaceTypeFromString(s string) (SurfaceType, bool) {
+	switch s {
+	case "CAIRO_SURFACE_TYPE_IMAGE", "image":
+		return SurfaceTypeImageValue, true
+	case "CAIRO_SURFACE_TYPE_PDF", "pdf":
+		return SurfaceTypePdfValue, true
+	case "CAIRO_SURFACE_TYPE_PS", "ps":
+		return SurfaceTypePsValue, true
+	case "CAIRO_SURFACE_TYPE_XLIB", "xlib":
+		return SurfaceTypeXlibValue, true
+	case "CAIRO_SURFACE_TYPE_XCB", "xcb":
+		return SurfaceTypeXcbValue, true
+	case "CAIRO_SURFACE_TYPE_GLITZ", "glitz":
+		return SurfaceTypeGlitzValue, true
+	case "CAIRO_SURFACE_TYPE_QUARTZ", "quartz":
+		return SurfaceTypeQuartzValue, true
+	case "CAIRO_SURFACE_TYPE_WIN32", "win32":
+		return SurfaceTypeWin32Value, true
+	case "CAIRO_SURFACE_TYPE_BEOS", "beos":
+		return SurfaceTypeBeosValue, true
+	case "CAIRO_SURFACE_TYPE_DIRECTFB", "directfb":
+		return SurfaceTypeDirectfbValue, true
+	case "CAIRO_SURFACE_TYPE_SVG", "svg":
+		return SurfaceTypeSvgValue, true
+	case "CAIRO_SURFACE_TYPE_OS2", "os2":
+		return SurfaceTypeOs2Value, true
+	case "CAIRO_SURFACE_TYPE_WIN32_PRINTING", "win32-printing":
+		return SurfaceTypeWin32PrintingValue, true
+	case "CAIRO_SURFACE_TYPE_QUARTZ_IMAGE", "quartz-image":
+		return SurfaceTypeQuartzImageValue, true
+	case "CAIRO_SURFACE_TYPE_SCRIPT", "script":
+		return SurfaceTypeScriptValue, true
+	case "CAIRO_SURFACE_TYPE_QT", "qt":
+		return SurfaceTypeQtValue, true
+	case "CAIRO_SURFACE_TYPE_RECORDING", "recording":
+		return SurfaceTypeRecordingValue, true
+	case "CAIRO_SURFACE_TYPE_VG", "vg":
+		return SurfaceTypeVgValue, true
+	case "CAIRO_SURFACE_TYPE_GL", "gl":
+		return SurfaceTypeGlValue, true
+	case "CAIRO_SURFACE_TYPE_DRM", "drm":
+		return SurfaceTypeDrmValue, true
+	case "CAIRO_SURFACE_TYPE_TEE", "tee":
+		return SurfaceTypeTeeValue, true
+	case "CAIRO_SURFACE_TYPE_XML", "xml":
+		return SurfaceTypeXmlValue, true
+	case "CAIRO_SURFACE_TYPE_SKIA", "skia":
+		return SurfaceTypeSkiaValue, true
+	case "CAIRO_SURFACE_TYPE_SUBSURFACE", "subsurface":
+		return SurfaceTypeSubsurfaceValue, true
+	case "CAIRO_SURFACE_TYPE_COGL", "cogl":
+		return SurfaceTypeCoglValue, true
+	}
+	return 0, false
+}
+
 type Format int
 
 var xFormatGLibType func() types.GType
@@ -710,6 +2136,69 @@ const (
 	FormatRgb30Value Format = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Format) String() string {
+	switch x {
+	case FormatInvalidValue:
+		return "CAIRO_FORMAT_INVALID"
+	case FormatArgb32Value:
+		return "CAIRO_FORMAT_ARGB32"
+	case FormatRgb24Value:
+		return "CAIRO_FORMAT_RGB24"
+	case FormatA8Value:
+		return "CAIRO_FORMAT_A8"
+	case FormatA1Value:
+		return "CAIRO_FORMAT_A1"
+	case FormatRgb16565Value:
+		return "CAIRO_FORMAT_RGB16_565"
+	case FormatRgb30Value:
+		return "CAIRO_FORMAT_RGB30"
+	}
+	return fmt.Sprintf("Format(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Format) Nick() string {
+	switch x {
+	case FormatInvalidValue:
+		return "invalid"
+	case FormatArgb32Value:
+		return "argb32"
+	case FormatRgb24Value:
+		return "rgb24"
+	case FormatA8Value:
+		return "a8"
+	case FormatA1Value:
+		return "a1"
+	case FormatRgb16565Value:
+		return "rgb16-565"
+	case FormatRgb30Value:
+		return "rgb30"
+	}
+	return ""
+}
+
+// FormatFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FormatFromString(s string) (Format, bool) {
+	switch s {
+	case "CAIRO_FORMAT_INVALID", "invalid":
+		return FormatInvalidValue, true
+	case "CAIRO_FORMAT_ARGB32", "argb32":
+		return FormatArgb32Value, true
+	case "CAIRO_FORMAT_RGB24", "rgb24":
+		return FormatRgb24Value, true
+	case "CAIRO_FORMAT_A8", "a8":
+		return FormatA8Value, true
+	case "CAIRO_FORMAT_A1", "a1":
+		return FormatA1Value, true
+	case "CAIRO_FORMAT_RGB16_565", "rgb16-565":
+		return FormatRgb16565Value, true
+	case "CAIRO_FORMAT_RGB30", "rgb30":
+		return FormatRgb30Value, true
+	}
+	return 0, false
+}
+
 type PatternType int
 
 var xPatternTypeGLibType func() types.GType
@@ -732,6 +2221,63 @@ const (
 	PatternTypeRasterSourceValue PatternType = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PatternType) String() string {
+	switch x {
+	case PatternTypeSolidValue:
+		return "CAIRO_PATTERN_TYPE_SOLID"
+	case PatternTypeSurfaceValue:
+		return "CAIRO_PATTERN_TYPE_SURFACE"
+	case PatternTypeLinearValue:
+		return "CAIRO_PATTERN_TYPE_LINEAR"
+	case PatternTypeRadialValue:
+		return "CAIRO_PATTERN_TYPE_RADIAL"
+	case PatternTypeMeshValue:
+		return "CAIRO_PATTERN_TYPE_MESH"
+	case PatternTypeRasterSourceValue:
+		return "CAIRO_PATTERN_TYPE_RASTER_SOURCE"
+	}
+	return fmt.Sprintf("PatternType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PatternType) Nick() string {
+	switch x {
+	case PatternTypeSolidValue:
+		return "solid"
+	case PatternTypeSurfaceValue:
+		return "surface"
+	case PatternTypeLinearValue:
+		return "linear"
+	case PatternTypeRadialValue:
+		return "radial"
+	case PatternTypeMeshValue:
+		return "mesh"
+	case PatternTypeRasterSourceValue:
+		return "raster-source"
+	}
+	return ""
+}
+
+// PatternTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PatternTypeFromString(s string) (PatternType, bool) {
+	switch s {
+	case "CAIRO_PATTERN_TYPE_SOLID", "solid":
+		return PatternTypeSolidValue, true
+	case "CAIRO_PATTERN_TYPE_SURFACE", "surface":
+		return PatternTypeSurfaceValue, true
+	case "CAIRO_PATTERN_TYPE_LINEAR", "linear":
+		return PatternTypeLinearValue, true
+	case "CAIRO_PATTERN_TYPE_RADIAL", "radial":
+		return PatternTypeRadialValue, true
+	case "CAIRO_PATTERN_TYPE_MESH", "mesh":
+		return PatternTypeMeshValue, true
+	case "CAIRO_PATTERN_TYPE_RASTER_SOURCE", "raster-source":
+		return PatternTypeRasterSourceValue, true
+	}
+	return 0, false
+}
+
 type Extend int
 
 var xExtendGLibType func() types.GType
@@ -750,6 +2296,51 @@ const (
 	ExtendPadValue Extend = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Extend) String() string {
+	switch x {
+	case ExtendNoneValue:
+		return "CAIRO_EXTEND_NONE"
+	case ExtendRepeatValue:
+		return "CAIRO_EXTEND_REPEAT"
+	case ExtendReflectValue:
+		return "CAIRO_EXTEND_REFLECT"
+	case ExtendPadValue:
+		return "CAIRO_EXTEND_PAD"
+	}
+	return fmt.Sprintf("Extend(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Extend) Nick() string {
+	switch x {
+	case ExtendNoneValue:
+		return "none"
+	case ExtendRepeatValue:
+		return "repeat"
+	case ExtendReflectValue:
+		return "reflect"
+	case ExtendPadValue:
+		return "pad"
+	}
+	return ""
+}
+
+// ExtendFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ExtendFromString(s string) (Extend, bool) {
+	switch s {
+	case "CAIRO_EXTEND_NONE", "none":
+		return ExtendNoneValue, true
+	case "CAIRO_EXTEND_REPEAT", "repeat":
+		return ExtendRepeatValue, true
+	case "CAIRO_EXTEND_REFLECT", "reflect":
+		return ExtendReflectValue, true
+	case "CAIRO_EXTEND_PAD", "pad":
+		return ExtendPadValue, true
+	}
+	return 0, false
+}
+
 type Filter int
 
 var xFilterGLibType func() types.GType
@@ -772,6 +2363,63 @@ const (
 	FilterGaussianValue Filter = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Filter) String() string {
+	switch x {
+	case FilterFastValue:
+		return "CAIRO_FILTER_FAST"
+	case FilterGoodValue:
+		return "CAIRO_FILTER_GOOD"
+	case FilterBestValue:
+		return "CAIRO_FILTER_BEST"
+	case FilterNearestValue:
+		return "CAIRO_FILTER_NEAREST"
+	case FilterBilinearValue:
+		return "CAIRO_FILTER_BILINEAR"
+	case FilterGaussianValue:
+		return "CAIRO_FILTER_GAUSSIAN"
+	}
+	return fmt.Sprintf("Filter(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Filter) Nick() string {
+	switch x {
+	case FilterFastValue:
+		return "fast"
+	case FilterGoodValue:
+		return "good"
+	case FilterBestValue:
+		return "best"
+	case FilterNearestValue:
+		return "nearest"
+	case FilterBilinearValue:
+		return "bilinear"
+	case FilterGaussianValue:
+		return "gaussian"
+	}
+	return ""
+}
+
+// FilterFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FilterFromString(s string) (Filter, bool) {
+	switch s {
+	case "CAIRO_FILTER_FAST", "fast":
+		return FilterFastValue, true
+	case "CAIRO_FILTER_GOOD", "good":
+		return FilterGoodValue, true
+	case "CAIRO_FILTER_BEST", "best":
+		return FilterBestValue, true
+	case "CAIRO_FILTER_NEAREST", "nearest":
+		return FilterNearestValue, true
+	case "CAIRO_FILTER_BILINEAR", "bilinear":
+		return FilterBilinearValue, true
+	case "CAIRO_FILTER_GAUSSIAN", "gaussian":
+		return FilterGaussianValue, true
+	}
+	return 0, false
+}
+
 type RegionOverlap int
 
 var xRegionOverlapGLibType func() types.GType
@@ -788,6 +2436,45 @@ const (
 	RegionOverlapPartValue RegionOverlap = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x RegionOverlap) String() string {
+	switch x {
+	case RegionOverlapInValue:
+		return "CAIRO_REGION_OVERLAP_IN"
+	case RegionOverlapOutValue:
+		return "CAIRO_REGION_OVERLAP_OUT"
+	case RegionOverlapPartValue:
+		return "CAIRO_REGION_OVERLAP_PART"
+	}
+	return fmt.Sprintf("RegionOverlap(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RegionOverlap) Nick() string {
+	switch x {
+	case RegionOverlapInValue:
+		return "in"
+	case RegionOverlapOutValue:
+		return "out"
+	case RegionOverlapPartValue:
+		return "part"
+	}
+	return ""
+}
+
+// RegionOverlapFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RegionOverlapFromString(s string) (RegionOverlap, bool) {
+	switch s {
+	case "CAIRO_REGION_OVERLAP_IN", "in":
+		return RegionOverlapInValue, true
+	case "CAIRO_REGION_OVERLAP_OUT", "out":
+		return RegionOverlapOutValue, true
+	case "CAIRO_REGION_OVERLAP_PART", "part":
+		return RegionOverlapPartValue, true
+	}
+	return 0, false
+}
+
 var xImageSurfaceCreate func()
 
 func ImageSurfaceCreate() {
@@ -801,7 +2488,7 @@ func init() {
 	core.SetSharedLibraries("CAIRO", []string{"libcairo-gobject.so.2"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("CAIRO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -852,7 +2539,7 @@ func init() {
 
 	core.PuregoSafeRegister(&xRegionOverlapGLibType, libs, "cairo_gobject_region_overlap_get_type")
 
-	core.PuregoSafeRegister(&xImageSurfaceCreate, libs, "cairo_image_surface_create")
+	core.PuregoRegisterSince(&xImageSurfaceCreate, libs, "cairo_image_surface_create", "CAIRO", "")
 
 	core.PuregoSafeRegister(&xContextGLibType, libs, "cairo_gobject_context_get_type")
 
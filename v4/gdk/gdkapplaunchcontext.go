@@ -2,7 +2,6 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -14,21 +13,18 @@ import (
 // It is an implementation of `GAppLaunchContext` that provides startup
 // notification and allows to launch applications on a specific workspace.
 //
-// ## Launching an application
+// # Launching an application
 //
-// ```c
-// GdkAppLaunchContext *context;
+//	GdkAppLaunchContext *context;
 //
-// context = gdk_display_get_app_launch_context (display);
+//	context = gdk_display_get_app_launch_context (display);
 //
-// gdk_app_launch_context_set_timestamp (gdk_event_get_time (event));
+//	gdk_app_launch_context_set_timestamp (gdk_event_get_time (event));
 //
-// if (!g_app_info_launch_default_for_uri ("http://www.gtk.org", context, &amp;error))
+//	if (!g_app_info_launch_default_for_uri ("http://www.gtk.org", context, &error))
+//	  g_warning ("Launching failed: %s\n", error->message);
 //
-//	g_warning ("Launching failed: %s\n", error-&gt;message);
-//
-// g_object_unref (context);
-// ```
+//	g_object_unref (context);
 type AppLaunchContext struct {
 	gio.AppLaunchContext
 }
@@ -47,7 +43,7 @@ func AppLaunchContextNewFromInternalPtr(ptr uintptr) *AppLaunchContext {
 
 var xAppLaunchContextGetDisplay func(uintptr) uintptr
 
-// Gets the `GdkDisplay` that @context is for.
+// Gets the `GdkDisplay` that context is for.
 func (x *AppLaunchContext) GetDisplay() *Display {
 	var cls *Display
 
@@ -68,15 +64,17 @@ var xAppLaunchContextSetDesktop func(uintptr, int)
 //
 // This only works when running under a window manager that
 // supports multiple workspaces, as described in the
-// [Extended Window Manager Hints](http://www.freedesktop.org/Standards/wm-spec).
+// [Extended Window Manager Hints].
 // Specifically this sets the `_NET_WM_DESKTOP` property described
 // in that spec.
 //
 // This only works when using the X11 backend.
 //
-// When the workspace is not specified or @desktop is set to -1,
+// When the workspace is not specified or desktop is set to -1,
 // it is up to the window manager to pick one, typically it will
 // be the current workspace.
+//
+// [Extended Window Manager Hints]: http://www.freedesktop.org/Standards/wm-spec
 func (x *AppLaunchContext) SetDesktop(DesktopVar int) {
 
 	xAppLaunchContextSetDesktop(x.GoPointer(), DesktopVar)
@@ -91,7 +89,7 @@ var xAppLaunchContextSetIcon func(uintptr, uintptr)
 // Window Managers can use this information when displaying startup
 // notification.
 //
-// See also [method@Gdk.AppLaunchContext.set_icon_name].
+// See also [AppLaunchContext.SetIconName].
 func (x *AppLaunchContext) SetIcon(IconVar gio.Icon) {
 
 	xAppLaunchContextSetIcon(x.GoPointer(), IconVar.GoPointer())
@@ -102,11 +100,11 @@ var xAppLaunchContextSetIconName func(uintptr, uintptr)
 
 // Sets the icon for applications that are launched with this context.
 //
-// The @icon_name will be interpreted in the same way as the Icon field
-// in desktop files. See also [method@Gdk.AppLaunchContext.set_icon].
+// The icon_name will be interpreted in the same way as the Icon field
+// in desktop files. See also [AppLaunchContext.SetIcon].
 //
-// If both @icon and @icon_name are set, the @icon_name takes priority.
-// If neither @icon or @icon_name is set, the icon is taken from either
+// If both icon and icon_name are set, the icon_name takes priority.
+// If neither icon or icon_name is set, the icon is taken from either
 // the file that is passed to launched application or from the `GAppInfo`
 // for the launched application itself.
 func (x *AppLaunchContext) SetIconName(IconNameVar *string) {
@@ -120,7 +118,7 @@ func (x *AppLaunchContext) SetIconName(IconNameVar *string) {
 
 var xAppLaunchContextSetTimestamp func(uintptr, uint32)
 
-// Sets the timestamp of @context.
+// Sets the timestamp of context.
 //
 // The timestamp should ideally be taken from the event that
 // triggered the launch.
@@ -151,7 +149,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -160,10 +158,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xAppLaunchContextGLibType, libs, "gdk_app_launch_context_get_type")
 
-	core.PuregoSafeRegister(&xAppLaunchContextGetDisplay, libs, "gdk_app_launch_context_get_display")
-	core.PuregoSafeRegister(&xAppLaunchContextSetDesktop, libs, "gdk_app_launch_context_set_desktop")
-	core.PuregoSafeRegister(&xAppLaunchContextSetIcon, libs, "gdk_app_launch_context_set_icon")
-	core.PuregoSafeRegister(&xAppLaunchContextSetIconName, libs, "gdk_app_launch_context_set_icon_name")
-	core.PuregoSafeRegister(&xAppLaunchContextSetTimestamp, libs, "gdk_app_launch_context_set_timestamp")
+	core.PuregoRegisterSince(&xAppLaunchContextGetDisplay, libs, "gdk_app_launch_context_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xAppLaunchContextSetDesktop, libs, "gdk_app_launch_context_set_desktop", "GDK", "")
+	core.PuregoRegisterSince(&xAppLaunchContextSetIcon, libs, "gdk_app_launch_context_set_icon", "GDK", "")
+	core.PuregoRegisterSince(&xAppLaunchContextSetIconName, libs, "gdk_app_launch_context_set_icon_name", "GDK", "")
+	core.PuregoRegisterSince(&xAppLaunchContextSetTimestamp, libs, "gdk_app_launch_context_set_timestamp", "GDK", "")
 
 }
@@ -0,0 +1,74 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+)
+
+var xCairoDrawFromGl func(*cairo.Context, uintptr, int, int, int, int, int, int, int)
+
+// Draws GL content onto a cairo context.
+//
+// It takes a render buffer ID (source_type == GL_RENDERBUFFER) or a texture
+// id (source_type == GL_TEXTURE) and draws it onto cr with an OVER operation,
+// respecting the current clip. The top left corner of the rectangle specified
+// by x, y, width and height will be drawn at the current (0,0) position of
+// the `cairo_t`.
+//
+// This will work for *all* `cairo_t`, as long as surface is realized, but the
+// fallback implementation that reads back the pixels from the buffer may be
+// used in the general case. In the case of direct drawing to a surface with
+// no special effects applied to cr it will however use a more efficient
+// approach.
+//
+// For GL_RENDERBUFFER the code will always fall back to software for buffers
+// with alpha components, so make sure you use GL_TEXTURE if using alpha.
+//
+// Calling this may change the current GL context.
+//
+// Deprecated: since 4.6. The function is overly complex and produces broken output
+//
+//	in various combinations of arguments. If you want to draw with GL textures
+//	in GTK, use [NewGLTexture]; if you want to use that texture in
+//	Cairo, use [Texture.Download] to download the data into a Cairo
+//	image surface.
+func CairoDrawFromGl(CrVar *cairo.Context, SurfaceVar *Surface, SourceVar int, SourceTypeVar int, BufferScaleVar int, XVar int, YVar int, WidthVar int, HeightVar int) {
+
+	xCairoDrawFromGl(CrVar, SurfaceVar.GoPointer(), SourceVar, SourceTypeVar, BufferScaleVar, XVar, YVar, WidthVar, HeightVar)
+
+}
+
+var xCairoSetSourcePixbuf func(*cairo.Context, uintptr, float64, float64)
+
+// Sets the given pixbuf as the source pattern for cr.
+//
+// The pattern has an extend mode of [cairo.ExtendNoneValue] and is aligned
+// so that the origin of pixbuf is pixbuf_x, pixbuf_y.
+//
+// Deprecated: since 4.20. Use cairo_set_source_surface() and [Texture.Download]
+func CairoSetSourcePixbuf(CrVar *cairo.Context, PixbufVar *gdkpixbuf.Pixbuf, PixbufXVar float64, PixbufYVar float64) {
+
+	xCairoSetSourcePixbuf(CrVar, PixbufVar.GoPointer(), PixbufXVar, PixbufYVar)
+
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCairoDrawFromGl, libs, "gdk_cairo_draw_from_gl", "GDK", "")
+	core.PuregoRegisterSince(&xCairoSetSourcePixbuf, libs, "gdk_cairo_set_source_pixbuf", "GDK", "")
+
+}
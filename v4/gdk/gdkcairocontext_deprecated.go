@@ -0,0 +1,45 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+)
+
+var xCairoContextCairoCreate func(uintptr) *cairo.Context
+
+// Retrieves a Cairo context to be used to draw on the `GdkSurface`
+// of context.
+//
+// A call to [DrawContext.BeginFrame] with this
+// context must have been done or this function will return nil.
+//
+// The returned context is guaranteed to be valid until
+// [DrawContext.EndFrame] is called.
+//
+// Deprecated: since 4.18. Drawing content with Cairo should be done via
+//
+//	Cairo rendernodes, not by using renderers.
+func (x *CairoContext) CairoCreate() *cairo.Context {
+
+	cret := xCairoContextCairoCreate(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCairoContextCairoCreate, libs, "gdk_cairo_context_cairo_create", "GDK", "")
+
+}
@@ -2,10 +2,10 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -42,10 +42,43 @@ const (
 	CicpRangeFullValue CicpRange = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CicpRange) String() string {
+	switch x {
+	case CicpRangeNarrowValue:
+		return "GDK_CICP_RANGE_NARROW"
+	case CicpRangeFullValue:
+		return "GDK_CICP_RANGE_FULL"
+	}
+	return fmt.Sprintf("CicpRange(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CicpRange) Nick() string {
+	switch x {
+	case CicpRangeNarrowValue:
+		return "narrow"
+	case CicpRangeFullValue:
+		return "full"
+	}
+	return ""
+}
+
+// CicpRangeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CicpRangeFromString(s string) (CicpRange, bool) {
+	switch s {
+	case "GDK_CICP_RANGE_NARROW", "narrow":
+		return CicpRangeNarrowValue, true
+	case "GDK_CICP_RANGE_FULL", "full":
+		return CicpRangeFullValue, true
+	}
+	return 0, false
+}
+
 // Contains the parameters that define a colorstate with cicp parameters.
 //
 // Cicp parameters are specified in the ITU-T H.273
-// [specification](https://www.itu.int/rec/T-REC-H.273/en).
+// [specification].
 //
 // See the documentation of individual properties for supported values.
 //
@@ -53,14 +86,16 @@ const (
 // must be replaced by a different value before creating a color state.
 //
 // `GdkCicpParams` can be used as a builder object to construct a color
-// state from Cicp data with [method@Gdk.CicpParams.build_color_state].
+// state from Cicp data with [CicpParams.BuildColorState].
 // The function will return an error if the given parameters are not
 // supported.
 //
 // You can obtain a `GdkCicpParams` object from a color state with
-// [method@Gdk.ColorState.create_cicp_params]. This can be used to
+// [ColorState.CreateCicpParams]. This can be used to
 // create a variant of a color state, by changing just one of the cicp
 // parameters, or just to obtain information about the color state.
+//
+// [specification]: https://www.itu.int/rec/T-REC-H.273/en
 type CicpParams struct {
 	gobject.Object
 }
@@ -98,17 +133,17 @@ func NewCicpParams() *CicpParams {
 
 var xCicpParamsBuildColorState func(uintptr) *ColorState
 
-// Creates a new `GdkColorState` object for the cicp parameters in @self.
+// Creates a new `GdkColorState` object for the cicp parameters in self.
 //
-// Note that this may fail if the cicp parameters in @self are not
-// supported by GTK. In that case, `NULL` is returned, and @error is set
+// Note that this may fail if the cicp parameters in self are not
+// supported by GTK. In that case, `NULL` is returned, and error is set
 // with an error message that can be presented to the user.
 func (x *CicpParams) BuildColorState() (*ColorState, error) {
 	var cerr *glib.Error
 
 	cret := xCicpParamsBuildColorState(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -117,7 +152,7 @@ func (x *CicpParams) BuildColorState() (*ColorState, error) {
 var xCicpParamsGetColorPrimaries func(uintptr) uint
 
 // Returns the value of the color-primaries property
-// of @self.
+// of self.
 func (x *CicpParams) GetColorPrimaries() uint {
 
 	cret := xCicpParamsGetColorPrimaries(x.GoPointer())
@@ -126,7 +161,7 @@ func (x *CicpParams) GetColorPrimaries() uint {
 
 var xCicpParamsGetMatrixCoefficients func(uintptr) uint
 
-// Gets the matrix-coefficients property of @self.
+// Gets the matrix-coefficients property of self.
 func (x *CicpParams) GetMatrixCoefficients() uint {
 
 	cret := xCicpParamsGetMatrixCoefficients(x.GoPointer())
@@ -135,7 +170,7 @@ func (x *CicpParams) GetMatrixCoefficients() uint {
 
 var xCicpParamsGetRange func(uintptr) CicpRange
 
-// Gets the range property of @self.
+// Gets the range property of self.
 func (x *CicpParams) GetRange() CicpRange {
 
 	cret := xCicpParamsGetRange(x.GoPointer())
@@ -144,7 +179,7 @@ func (x *CicpParams) GetRange() CicpRange {
 
 var xCicpParamsGetTransferFunction func(uintptr) uint
 
-// Gets the transfer-function property of @self.
+// Gets the transfer-function property of self.
 func (x *CicpParams) GetTransferFunction() uint {
 
 	cret := xCicpParamsGetTransferFunction(x.GoPointer())
@@ -153,7 +188,7 @@ func (x *CicpParams) GetTransferFunction() uint {
 
 var xCicpParamsSetColorPrimaries func(uintptr, uint)
 
-// Sets the color-primaries property of @self.
+// Sets the color-primaries property of self.
 func (x *CicpParams) SetColorPrimaries(ColorPrimariesVar uint) {
 
 	xCicpParamsSetColorPrimaries(x.GoPointer(), ColorPrimariesVar)
@@ -162,8 +197,8 @@ func (x *CicpParams) SetColorPrimaries(ColorPrimariesVar uint) {
 
 var xCicpParamsSetMatrixCoefficients func(uintptr, uint)
 
-// @self a `GdkCicpParams`
-// Sets the matrix-coefficients property of @self.
+// self a `GdkCicpParams`
+// Sets the matrix-coefficients property of self.
 func (x *CicpParams) SetMatrixCoefficients(MatrixCoefficientsVar uint) {
 
 	xCicpParamsSetMatrixCoefficients(x.GoPointer(), MatrixCoefficientsVar)
@@ -172,7 +207,7 @@ func (x *CicpParams) SetMatrixCoefficients(MatrixCoefficientsVar uint) {
 
 var xCicpParamsSetRange func(uintptr, CicpRange)
 
-// Sets the range property of @self
+// Sets the range property of self
 func (x *CicpParams) SetRange(RangeVar CicpRange) {
 
 	xCicpParamsSetRange(x.GoPointer(), RangeVar)
@@ -181,7 +216,7 @@ func (x *CicpParams) SetRange(RangeVar CicpRange) {
 
 var xCicpParamsSetTransferFunction func(uintptr, uint)
 
-// Sets the transfer-function property of @self.
+// Sets the transfer-function property of self.
 func (x *CicpParams) SetTransferFunction(TransferFunctionVar uint) {
 
 	xCicpParamsSetTransferFunction(x.GoPointer(), TransferFunctionVar)
@@ -311,7 +346,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -322,16 +357,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xCicpParamsGLibType, libs, "gdk_cicp_params_get_type")
 
-	core.PuregoSafeRegister(&xNewCicpParams, libs, "gdk_cicp_params_new")
-
-	core.PuregoSafeRegister(&xCicpParamsBuildColorState, libs, "gdk_cicp_params_build_color_state")
-	core.PuregoSafeRegister(&xCicpParamsGetColorPrimaries, libs, "gdk_cicp_params_get_color_primaries")
-	core.PuregoSafeRegister(&xCicpParamsGetMatrixCoefficients, libs, "gdk_cicp_params_get_matrix_coefficients")
-	core.PuregoSafeRegister(&xCicpParamsGetRange, libs, "gdk_cicp_params_get_range")
-	core.PuregoSafeRegister(&xCicpParamsGetTransferFunction, libs, "gdk_cicp_params_get_transfer_function")
-	core.PuregoSafeRegister(&xCicpParamsSetColorPrimaries, libs, "gdk_cicp_params_set_color_primaries")
-	core.PuregoSafeRegister(&xCicpParamsSetMatrixCoefficients, libs, "gdk_cicp_params_set_matrix_coefficients")
-	core.PuregoSafeRegister(&xCicpParamsSetRange, libs, "gdk_cicp_params_set_range")
-	core.PuregoSafeRegister(&xCicpParamsSetTransferFunction, libs, "gdk_cicp_params_set_transfer_function")
+	core.PuregoRegisterSince(&xNewCicpParams, libs, "gdk_cicp_params_new", "GDK", "4.16")
+
+	core.PuregoRegisterSince(&xCicpParamsBuildColorState, libs, "gdk_cicp_params_build_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsGetColorPrimaries, libs, "gdk_cicp_params_get_color_primaries", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsGetMatrixCoefficients, libs, "gdk_cicp_params_get_matrix_coefficients", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsGetRange, libs, "gdk_cicp_params_get_range", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsGetTransferFunction, libs, "gdk_cicp_params_get_transfer_function", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsSetColorPrimaries, libs, "gdk_cicp_params_set_color_primaries", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsSetMatrixCoefficients, libs, "gdk_cicp_params_set_matrix_coefficients", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsSetRange, libs, "gdk_cicp_params_set_range", "GDK", "4.16")
+	core.PuregoRegisterSince(&xCicpParamsSetTransferFunction, libs, "gdk_cicp_params_set_transfer_function", "GDK", "4.16")
 
 }
@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,20 +13,20 @@ import (
 
 // Represents data shared between applications or inside an application.
 //
-// To get a `GdkClipboard` object, use [method@Gdk.Display.get_clipboard] or
-// [method@Gdk.Display.get_primary_clipboard]. You can find out about the data
+// To get a `GdkClipboard` object, use [Display.GetClipboard] or
+// [Display.GetPrimaryClipboard]. You can find out about the data
 // that is currently available in a clipboard using
-// [method@Gdk.Clipboard.get_formats].
+// [Clipboard.GetFormats].
 //
 // To make text or image data available in a clipboard, use
-// [method@Gdk.Clipboard.set_text] or [method@Gdk.Clipboard.set_texture].
-// For other data, you can use [method@Gdk.Clipboard.set_content], which
-// takes a [class@Gdk.ContentProvider] object.
+// [Clipboard.SetText] or [Clipboard.SetTexture].
+// For other data, you can use [Clipboard.SetContent], which
+// takes a [ContentProvider] object.
 //
 // To read textual or image data from a clipboard, use
-// [method@Gdk.Clipboard.read_text_async] or
-// [method@Gdk.Clipboard.read_texture_async]. For other data, use
-// [method@Gdk.Clipboard.read_async], which provides a `GInputStream` object.
+// [Clipboard.ReadTextAsync] or
+// [Clipboard.ReadTextureAsync]. For other data, use
+// [Clipboard.ReadAsync], which provides a `GInputStream` object.
 type Clipboard struct {
 	gobject.Object
 }
@@ -46,10 +45,10 @@ func ClipboardNewFromInternalPtr(ptr uintptr) *Clipboard {
 
 var xClipboardGetContent func(uintptr) uintptr
 
-// Returns the `GdkContentProvider` currently set on @clipboard.
+// Returns the `GdkContentProvider` currently set on clipboard.
 //
-// If the @clipboard is empty or its contents are not owned by the
-// current process, %NULL will be returned.
+// If the clipboard is empty or its contents are not owned by the
+// current process, nil will be returned.
 func (x *Clipboard) GetContent() *ContentProvider {
 	var cls *ContentProvider
 
@@ -97,7 +96,7 @@ var xClipboardIsLocal func(uintptr) bool
 // A clipboard is considered local if it was last claimed
 // by the running application.
 //
-// Note that [method@Gdk.Clipboard.get_content] may return %NULL
+// Note that [Clipboard.GetContent] may return nil
 // even on a local clipboard. In this case the clipboard is empty.
 func (x *Clipboard) IsLocal() bool {
 
@@ -107,7 +106,7 @@ func (x *Clipboard) IsLocal() bool {
 
 var xClipboardReadAsync func(uintptr, []string, int, uintptr, uintptr, uintptr)
 
-// Asynchronously requests an input stream to read the @clipboard's
+// Asynchronously requests an input stream to read the clipboard's
 // contents from.
 //
 // The clipboard will choose the most suitable mime type from the given list
@@ -121,11 +120,12 @@ func (x *Clipboard) ReadAsync(MimeTypesVar []string, IoPriorityVar int, Cancella
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -137,7 +137,7 @@ var xClipboardReadFinish func(uintptr, uintptr, *string, **glib.Error) uintptr
 
 // Finishes an asynchronous clipboard read.
 //
-// See [method@Gdk.Clipboard.read_async].
+// See [Clipboard.ReadAsync].
 func (x *Clipboard) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -145,12 +145,15 @@ func (x *Clipboard) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string
 	cret := xClipboardReadFinish(x.GoPointer(), ResultVar.GoPointer(), OutMimeTypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -158,10 +161,10 @@ func (x *Clipboard) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string
 
 var xClipboardReadTextAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously request the @clipboard contents converted to a string.
+// Asynchronously request the clipboard contents converted to a string.
 //
-// This is a simple wrapper around [method@Gdk.Clipboard.read_value_async].
-// Use that function or [method@Gdk.Clipboard.read_async] directly if you
+// This is a simple wrapper around [Clipboard.ReadValueAsync].
+// Use that function or [Clipboard.ReadAsync] directly if you
 // need more control over the operation.
 func (x *Clipboard) ReadTextAsync(CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -172,11 +175,12 @@ func (x *Clipboard) ReadTextAsync(CancellableVar *gio.Cancellable, CallbackVar *
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -188,13 +192,13 @@ var xClipboardReadTextFinish func(uintptr, uintptr, **glib.Error) string
 
 // Finishes an asynchronous clipboard read.
 //
-// See [method@Gdk.Clipboard.read_text_async].
+// See [Clipboard.ReadTextAsync].
 func (x *Clipboard) ReadTextFinish(ResultVar gio.AsyncResult) (string, error) {
 	var cerr *glib.Error
 
 	cret := xClipboardReadTextFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -202,10 +206,10 @@ func (x *Clipboard) ReadTextFinish(ResultVar gio.AsyncResult) (string, error) {
 
 var xClipboardReadTextureAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously request the @clipboard contents converted to a `GdkPixbuf`.
+// Asynchronously request the clipboard contents converted to a `GdkPixbuf`.
 //
-// This is a simple wrapper around [method@Gdk.Clipboard.read_value_async].
-// Use that function or [method@Gdk.Clipboard.read_async] directly if you
+// This is a simple wrapper around [Clipboard.ReadValueAsync].
+// Use that function or [Clipboard.ReadAsync] directly if you
 // need more control over the operation.
 func (x *Clipboard) ReadTextureAsync(CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -216,11 +220,12 @@ func (x *Clipboard) ReadTextureAsync(CancellableVar *gio.Cancellable, CallbackVa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -232,7 +237,7 @@ var xClipboardReadTextureFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finishes an asynchronous clipboard read.
 //
-// See [method@Gdk.Clipboard.read_texture_async].
+// See [Clipboard.ReadTextureAsync].
 func (x *Clipboard) ReadTextureFinish(ResultVar gio.AsyncResult) (*Texture, error) {
 	var cls *Texture
 	var cerr *glib.Error
@@ -240,12 +245,15 @@ func (x *Clipboard) ReadTextureFinish(ResultVar gio.AsyncResult) (*Texture, erro
 	cret := xClipboardReadTextureFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Texture{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -253,12 +261,12 @@ func (x *Clipboard) ReadTextureFinish(ResultVar gio.AsyncResult) (*Texture, erro
 
 var xClipboardReadValueAsync func(uintptr, types.GType, int, uintptr, uintptr, uintptr)
 
-// Asynchronously request the @clipboard contents converted to the given
-// @type.
+// Asynchronously request the clipboard contents converted to the given
+// type.
 //
 // For local clipboard contents that are available in the given `GType`,
 // the value will be copied directly. Otherwise, GDK will try to use
-// [func@content_deserialize_async] to convert the clipboard's data.
+// content_deserialize_async to convert the clipboard's data.
 func (x *Clipboard) ReadValueAsync(TypeVar types.GType, IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -268,11 +276,12 @@ func (x *Clipboard) ReadValueAsync(TypeVar types.GType, IoPriorityVar int, Cance
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -284,13 +293,13 @@ var xClipboardReadValueFinish func(uintptr, uintptr, **glib.Error) *gobject.Valu
 
 // Finishes an asynchronous clipboard read.
 //
-// See [method@Gdk.Clipboard.read_value_async].
+// See [Clipboard.ReadValueAsync].
 func (x *Clipboard) ReadValueFinish(ResultVar gio.AsyncResult) (*gobject.Value, error) {
 	var cerr *glib.Error
 
 	cret := xClipboardReadValueFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -301,14 +310,14 @@ var xClipboardSet func(uintptr, types.GType, ...interface{})
 // Sets the clipboard to contain the value collected from the given varargs.
 //
 // Values should be passed the same way they are passed to other value
-// collecting APIs, such as [method@GObject.Object.set] or
-// [func@GObject.signal_emit].
+// collecting APIs, such as [gobject.Object.Set] or
+// [gobject.SignalEmit].
+//
+//	gdk_clipboard_set (clipboard, G_TYPE_STRING, "Hello World");
 //
-// ```c
-// gdk_clipboard_set (clipboard, G_TYPE_STRING, "Hello World");
+//	gdk_clipboard_set (clipboard, GDK_TYPE_TEXTURE, some_texture);
 //
-// gdk_clipboard_set (clipboard, GDK_TYPE_TEXTURE, some_texture);
-// ```
+// Deprecated: use [SetValue], the version of this function that language bindings are meant to use.
 func (x *Clipboard) Set(TypeVar types.GType, varArgs ...interface{}) {
 
 	xClipboardSet(x.GoPointer(), TypeVar, varArgs...)
@@ -317,18 +326,18 @@ func (x *Clipboard) Set(TypeVar types.GType, varArgs ...interface{}) {
 
 var xClipboardSetContent func(uintptr, uintptr) bool
 
-// Sets a new content provider on @clipboard.
+// Sets a new content provider on clipboard.
 //
 // The clipboard will claim the `GdkDisplay`'s resources and advertise
 // these new contents to other applications.
 //
-// In the rare case of a failure, this function will return %FALSE. The
+// In the rare case of a failure, this function will return false. The
 // clipboard will then continue reporting its old contents and ignore
-// @provider.
+// provider.
 //
 // If the contents are read by either an external application or the
-// @clipboard's read functions, @clipboard will select the best format to
-// transfer the contents and then request that format from @provider.
+// clipboard's read functions, clipboard will select the best format to
+// transfer the contents and then request that format from provider.
 func (x *Clipboard) SetContent(ProviderVar *ContentProvider) bool {
 
 	cret := xClipboardSetContent(x.GoPointer(), ProviderVar.GoPointer())
@@ -337,7 +346,7 @@ func (x *Clipboard) SetContent(ProviderVar *ContentProvider) bool {
 
 var xClipboardSetText func(uintptr, string)
 
-// Puts the given @text into the clipboard.
+// Puts the given text into the clipboard.
 func (x *Clipboard) SetText(TextVar string) {
 
 	xClipboardSetText(x.GoPointer(), TextVar)
@@ -346,7 +355,7 @@ func (x *Clipboard) SetText(TextVar string) {
 
 var xClipboardSetTexture func(uintptr, uintptr)
 
-// Puts the given @texture into the clipboard.
+// Puts the given texture into the clipboard.
 func (x *Clipboard) SetTexture(TextureVar *Texture) {
 
 	xClipboardSetTexture(x.GoPointer(), TextureVar.GoPointer())
@@ -355,7 +364,7 @@ func (x *Clipboard) SetTexture(TextureVar *Texture) {
 
 var xClipboardSetValist func(uintptr, types.GType, []interface{})
 
-// Sets the clipboard to contain the value collected from the given @args.
+// Sets the clipboard to contain the value collected from the given args.
 func (x *Clipboard) SetValist(TypeVar types.GType, ArgsVar []interface{}) {
 
 	xClipboardSetValist(x.GoPointer(), TypeVar, ArgsVar)
@@ -364,7 +373,7 @@ func (x *Clipboard) SetValist(TypeVar types.GType, ArgsVar []interface{}) {
 
 var xClipboardSetValue func(uintptr, *gobject.Value)
 
-// Sets the @clipboard to contain the given @value.
+// Sets the clipboard to contain the given value.
 func (x *Clipboard) SetValue(ValueVar *gobject.Value) {
 
 	xClipboardSetValue(x.GoPointer(), ValueVar)
@@ -373,7 +382,7 @@ func (x *Clipboard) SetValue(ValueVar *gobject.Value) {
 
 var xClipboardStoreAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
-// Asynchronously instructs the @clipboard to store its contents remotely.
+// Asynchronously instructs the clipboard to store its contents remotely.
 //
 // If the clipboard is not local, this function does nothing but report success.
 //
@@ -383,7 +392,7 @@ var xClipboardStoreAsync func(uintptr, int, uintptr, uintptr, uintptr)
 // unless a "clipboard manager" is running.
 //
 // This function is called automatically when a
-// [GtkApplication](../gtk4/class.Application.html)
+// GtkApplication
 // is shut down, so you likely don't need to call it.
 func (x *Clipboard) StoreAsync(IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -394,11 +403,12 @@ func (x *Clipboard) StoreAsync(IoPriorityVar int, CancellableVar *gio.Cancellabl
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -410,13 +420,13 @@ var xClipboardStoreFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Finishes an asynchronous clipboard store.
 //
-// See [method@Gdk.Clipboard.store_async].
+// See [Clipboard.StoreAsync].
 func (x *Clipboard) StoreFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xClipboardStoreFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -442,7 +452,7 @@ func (x *Clipboard) GetPropertyFormats() uintptr {
 }
 
 // GetPropertyLocal gets the "local" property.
-// %TRUE if the contents of the clipboard are owned by this process.
+// true if the contents of the clipboard are owned by this process.
 func (x *Clipboard) GetPropertyLocal() bool {
 	var v gobject.Value
 	x.GetProperty("local", &v)
@@ -450,27 +460,49 @@ func (x *Clipboard) GetPropertyLocal() bool {
 }
 
 // Emitted when the clipboard changes ownership.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Clipboard) ConnectChanged(cb *func(Clipboard)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Clipboard{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
+
+var xClipboardChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xClipboardChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Clipboard))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Clipboard{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Clipboard) ConnectChangedFunc(cb func(Clipboard)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xClipboardChangedTrampoline, xClipboardChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -478,7 +510,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -487,25 +519,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xClipboardGLibType, libs, "gdk_clipboard_get_type")
 
-	core.PuregoSafeRegister(&xClipboardGetContent, libs, "gdk_clipboard_get_content")
-	core.PuregoSafeRegister(&xClipboardGetDisplay, libs, "gdk_clipboard_get_display")
-	core.PuregoSafeRegister(&xClipboardGetFormats, libs, "gdk_clipboard_get_formats")
-	core.PuregoSafeRegister(&xClipboardIsLocal, libs, "gdk_clipboard_is_local")
-	core.PuregoSafeRegister(&xClipboardReadAsync, libs, "gdk_clipboard_read_async")
-	core.PuregoSafeRegister(&xClipboardReadFinish, libs, "gdk_clipboard_read_finish")
-	core.PuregoSafeRegister(&xClipboardReadTextAsync, libs, "gdk_clipboard_read_text_async")
-	core.PuregoSafeRegister(&xClipboardReadTextFinish, libs, "gdk_clipboard_read_text_finish")
-	core.PuregoSafeRegister(&xClipboardReadTextureAsync, libs, "gdk_clipboard_read_texture_async")
-	core.PuregoSafeRegister(&xClipboardReadTextureFinish, libs, "gdk_clipboard_read_texture_finish")
-	core.PuregoSafeRegister(&xClipboardReadValueAsync, libs, "gdk_clipboard_read_value_async")
-	core.PuregoSafeRegister(&xClipboardReadValueFinish, libs, "gdk_clipboard_read_value_finish")
-	core.PuregoSafeRegister(&xClipboardSet, libs, "gdk_clipboard_set")
-	core.PuregoSafeRegister(&xClipboardSetContent, libs, "gdk_clipboard_set_content")
-	core.PuregoSafeRegister(&xClipboardSetText, libs, "gdk_clipboard_set_text")
-	core.PuregoSafeRegister(&xClipboardSetTexture, libs, "gdk_clipboard_set_texture")
-	core.PuregoSafeRegister(&xClipboardSetValist, libs, "gdk_clipboard_set_valist")
-	core.PuregoSafeRegister(&xClipboardSetValue, libs, "gdk_clipboard_set_value")
-	core.PuregoSafeRegister(&xClipboardStoreAsync, libs, "gdk_clipboard_store_async")
-	core.PuregoSafeRegister(&xClipboardStoreFinish, libs, "gdk_clipboard_store_finish")
+	core.PuregoRegisterSince(&xClipboardGetContent, libs, "gdk_clipboard_get_content", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardGetDisplay, libs, "gdk_clipboard_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardGetFormats, libs, "gdk_clipboard_get_formats", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardIsLocal, libs, "gdk_clipboard_is_local", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadAsync, libs, "gdk_clipboard_read_async", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadFinish, libs, "gdk_clipboard_read_finish", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadTextAsync, libs, "gdk_clipboard_read_text_async", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadTextFinish, libs, "gdk_clipboard_read_text_finish", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadTextureAsync, libs, "gdk_clipboard_read_texture_async", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadTextureFinish, libs, "gdk_clipboard_read_texture_finish", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadValueAsync, libs, "gdk_clipboard_read_value_async", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardReadValueFinish, libs, "gdk_clipboard_read_value_finish", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSet, libs, "gdk_clipboard_set", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSetContent, libs, "gdk_clipboard_set_content", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSetText, libs, "gdk_clipboard_set_text", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSetTexture, libs, "gdk_clipboard_set_texture", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSetValist, libs, "gdk_clipboard_set_valist", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardSetValue, libs, "gdk_clipboard_set_value", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardStoreAsync, libs, "gdk_clipboard_store_async", "GDK", "")
+	core.PuregoRegisterSince(&xClipboardStoreFinish, libs, "gdk_clipboard_store_finish", "GDK", "")
 
 }
@@ -2,7 +2,6 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -36,10 +35,12 @@ var xColorStateGetRec2100Linear func() *ColorState
 // This color state uses the primaries defined by BT.2020-2 and BT.2100-0 and a linear
 // transfer function.
 //
-// It is equivalent to the [Cicp](class.CicpParams.html) tuple 9/8/0/1.
+// It is equivalent to the Cicp tuple 9/8/0/1.
 //
-// See e.g. [the CSS HDR Module](https://drafts.csswg.org/css-color-hdr/#valdef-color-rec2100-linear)
+// See e.g. [the CSS HDR Module]
 // for details about this colorstate.
+//
+// [the CSS HDR Module]: https://drafts.csswg.org/css-color-hdr/#valdef-color-rec2100-linear
 func ColorStateGetRec2100Linear() *ColorState {
 
 	cret := xColorStateGetRec2100Linear()
@@ -53,10 +54,12 @@ var xColorStateGetRec2100Pq func() *ColorState
 // This color state uses the primaries defined by BT.2020-2 and BT.2100-0 and the transfer
 // function defined by SMPTE ST 2084 and BT.2100-2.
 //
-// It is equivalent to the [Cicp](class.CicpParams.html) tuple 9/16/0/1.
+// It is equivalent to the Cicp tuple 9/16/0/1.
 //
-// See e.g. [the CSS HDR Module](https://drafts.csswg.org/css-color-hdr/#valdef-color-rec2100-pq)
+// See e.g. [the CSS HDR Module]
 // for details about this colorstate.
+//
+// [the CSS HDR Module]: https://drafts.csswg.org/css-color-hdr/#valdef-color-rec2100-pq
 func ColorStateGetRec2100Pq() *ColorState {
 
 	cret := xColorStateGetRec2100Pq()
@@ -70,10 +73,12 @@ var xColorStateGetSrgb func() *ColorState
 // This color state uses the primaries defined by BT.709-6 and the transfer function
 // defined by IEC 61966-2-1.
 //
-// It is equivalent to the [Cicp](class.CicpParams.html) tuple 1/13/0/1.
+// It is equivalent to the Cicp tuple 1/13/0/1.
 //
-// See e.g. [the CSS Color Module](https://www.w3.org/TR/css-color-4/#predefined-sRGB)
+// See e.g. [the CSS Color Module]
 // for details about this colorstate.
+//
+// [the CSS Color Module]: https://www.w3.org/TR/css-color-4/#predefined-sRGB
 func ColorStateGetSrgb() *ColorState {
 
 	cret := xColorStateGetSrgb()
@@ -86,10 +91,12 @@ var xColorStateGetSrgbLinear func() *ColorState
 //
 // This color state uses the primaries defined by BT.709-6 and a linear transfer function.
 //
-// It is equivalent to the [Cicp](class.CicpParams.html) tuple 1/8/0/1.
+// It is equivalent to the Cicp tuple 1/8/0/1.
 //
-// See e.g. [the CSS Color Module](https://www.w3.org/TR/css-color-4/#predefined-sRGB-linear)
+// See e.g. [the CSS Color Module]
 // for details about this colorstate.
+//
+// [the CSS Color Module]: https://www.w3.org/TR/css-color-4/#predefined-sRGB-linear
 func ColorStateGetSrgbLinear() *ColorState {
 
 	cret := xColorStateGetSrgbLinear()
@@ -101,18 +108,18 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xColorStateGetOklab, libs, "gdk_color_state_get_oklab")
-	core.PuregoSafeRegister(&xColorStateGetOklch, libs, "gdk_color_state_get_oklch")
-	core.PuregoSafeRegister(&xColorStateGetRec2100Linear, libs, "gdk_color_state_get_rec2100_linear")
-	core.PuregoSafeRegister(&xColorStateGetRec2100Pq, libs, "gdk_color_state_get_rec2100_pq")
-	core.PuregoSafeRegister(&xColorStateGetSrgb, libs, "gdk_color_state_get_srgb")
-	core.PuregoSafeRegister(&xColorStateGetSrgbLinear, libs, "gdk_color_state_get_srgb_linear")
+	core.PuregoRegisterSince(&xColorStateGetOklab, libs, "gdk_color_state_get_oklab", "GDK", "4.18")
+	core.PuregoRegisterSince(&xColorStateGetOklch, libs, "gdk_color_state_get_oklch", "GDK", "4.18")
+	core.PuregoRegisterSince(&xColorStateGetRec2100Linear, libs, "gdk_color_state_get_rec2100_linear", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateGetRec2100Pq, libs, "gdk_color_state_get_rec2100_pq", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateGetSrgb, libs, "gdk_color_state_get_srgb", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateGetSrgbLinear, libs, "gdk_color_state_get_srgb_linear", "GDK", "4.16")
 
 }
@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -12,10 +11,10 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of a function that can be registered with gdk_content_register_deserializer().
+// The type of a function that can be registered with [ContentRegisterDeserializer].
 //
 // When the function gets called to operate on content, it can call functions on the
-// @deserializer object to obtain the mime type, input stream, user data, etc. for its
+// deserializer object to obtain the mime type, input stream, user data, etc. for its
 // operation.
 type ContentDeserializeFunc func(uintptr)
 
@@ -34,11 +33,12 @@ func ContentDeserializeAsync(StreamVar *gio.InputStream, MimeTypeVar string, Typ
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -54,7 +54,7 @@ func ContentDeserializeFinish(ResultVar gio.AsyncResult, ValueVar *gobject.Value
 
 	cret := xContentDeserializeFinish(ResultVar.GoPointer(), ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -76,11 +76,12 @@ func ContentRegisterDeserializer(MimeTypeVar string, TypeVar types.GType, Deseri
 			DeserializeVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DeserializeVar
 				cbFn(arg0)
 			}
-			DeserializeVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DeserializeVarPtr, DeserializeVarRef, DeserializeVar)
+			DeserializeVarRef = core.NewCallback(fcb)
+			DeserializeVarRef = glib.SaveCallbackWithClosure(DeserializeVarPtr, DeserializeVarRef, DeserializeVar)
 		}
 	}
 
@@ -91,11 +92,12 @@ func ContentRegisterDeserializer(MimeTypeVar string, TypeVar types.GType, Deseri
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -110,9 +112,9 @@ func ContentRegisterDeserializer(MimeTypeVar string, TypeVar types.GType, Deseri
 //
 // GTK provides serializers and deserializers for common data types
 // such as text, colors, images or file lists. To register your own
-// deserialization functions, use [func@content_register_deserializer].
+// deserialization functions, use content_register_deserializer.
 //
-// Also see [class@Gdk.ContentSerializer].
+// Also see [ContentSerializer].
 type ContentDeserializer struct {
 	gobject.Object
 }
@@ -133,7 +135,7 @@ var xContentDeserializerGetCancellable func(uintptr) uintptr
 
 // Gets the cancellable for the current operation.
 //
-// This is the `GCancellable` that was passed to [func@Gdk.content_deserialize_async].
+// This is the `GCancellable` that was passed to [ContentDeserializeAsync].
 func (x *ContentDeserializer) GetCancellable() *gio.Cancellable {
 	var cls *gio.Cancellable
 
@@ -161,7 +163,7 @@ var xContentDeserializerGetInputStream func(uintptr) uintptr
 
 // Gets the input stream for the current operation.
 //
-// This is the stream that was passed to [func@Gdk.content_deserialize_async].
+// This is the stream that was passed to [ContentDeserializeAsync].
 func (x *ContentDeserializer) GetInputStream() *gio.InputStream {
 	var cls *gio.InputStream
 
@@ -189,7 +191,7 @@ var xContentDeserializerGetPriority func(uintptr) int
 
 // Gets the I/O priority for the current operation.
 //
-// This is the priority that was passed to [func@Gdk.content_deserialize_async].
+// This is the priority that was passed to [ContentDeserializeAsync].
 func (x *ContentDeserializer) GetPriority() int {
 
 	cret := xContentDeserializerGetPriority(x.GoPointer())
@@ -200,7 +202,7 @@ var xContentDeserializerGetTaskData func(uintptr) uintptr
 
 // Gets the data that was associated with the current operation.
 //
-// See [method@Gdk.ContentDeserializer.set_task_data].
+// See [ContentDeserializer.SetTaskData].
 func (x *ContentDeserializer) GetTaskData() uintptr {
 
 	cret := xContentDeserializerGetTaskData(x.GoPointer())
@@ -225,14 +227,14 @@ func (x *ContentDeserializer) GetValue() *gobject.Value {
 	return cret
 }
 
-var xContentDeserializerReturnError func(uintptr, *glib.Error)
+var xContentDeserializerReturnError func(uintptr, uintptr)
 
 // Indicate that the deserialization has ended with an error.
 //
-// This function consumes @error.
+// This function consumes error.
 func (x *ContentDeserializer) ReturnError(ErrorVar *glib.Error) {
 
-	xContentDeserializerReturnError(x.GoPointer(), ErrorVar)
+	xContentDeserializerReturnError(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
@@ -257,11 +259,12 @@ func (x *ContentDeserializer) SetTaskData(DataVar uintptr, NotifyVar *glib.Destr
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -280,7 +283,7 @@ func (c *ContentDeserializer) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Gets the source object from a [iface@Gio.AsyncResult].
+// Gets the source object from a [gio.AsyncResult].
 func (x *ContentDeserializer) GetSourceObject() *gobject.Object {
 	var cls *gobject.Object
 
@@ -294,22 +297,22 @@ func (x *ContentDeserializer) GetSourceObject() *gobject.Object {
 	return cls
 }
 
-// Checks if @res has the given @source_tag (generally a function
-// pointer indicating the function @res was created by).
+// Checks if res has the given source_tag (generally a function
+// pointer indicating the function res was created by).
 func (x *ContentDeserializer) IsTagged(SourceTagVar uintptr) bool {
 
 	cret := gio.XGAsyncResultIsTagged(x.GoPointer(), SourceTagVar)
 	return cret
 }
 
-// If @res is a [class@Gio.SimpleAsyncResult], this is equivalent to
-// [method@Gio.SimpleAsyncResult.propagate_error]. Otherwise it returns
+// If res is a [gio.SimpleAsyncResult], this is equivalent to
+// [gio.SimpleAsyncResult.PropagateError]. Otherwise it returns
 // `FALSE`.
 //
 // This can be used for legacy error handling in async `*_finish()`
-// wrapper functions that traditionally handled [class@Gio.SimpleAsyncResult]
+// wrapper functions that traditionally handled [gio.SimpleAsyncResult]
 // error returns themselves rather than calling into the virtual method.
-// This should not be used in new code; [iface@Gio.AsyncResult] errors that are
+// This should not be used in new code; [gio.AsyncResult] errors that are
 // set by virtual methods should also be extracted by virtual methods,
 // to enable subclasses to chain up correctly.
 func (x *ContentDeserializer) LegacyPropagateError() (bool, error) {
@@ -317,7 +320,7 @@ func (x *ContentDeserializer) LegacyPropagateError() (bool, error) {
 
 	cret := gio.XGAsyncResultLegacyPropagateError(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -328,29 +331,29 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xContentDeserializeAsync, libs, "gdk_content_deserialize_async")
-	core.PuregoSafeRegister(&xContentDeserializeFinish, libs, "gdk_content_deserialize_finish")
-	core.PuregoSafeRegister(&xContentRegisterDeserializer, libs, "gdk_content_register_deserializer")
+	core.PuregoRegisterSince(&xContentDeserializeAsync, libs, "gdk_content_deserialize_async", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializeFinish, libs, "gdk_content_deserialize_finish", "GDK", "")
+	core.PuregoRegisterSince(&xContentRegisterDeserializer, libs, "gdk_content_register_deserializer", "GDK", "")
 
 	core.PuregoSafeRegister(&xContentDeserializerGLibType, libs, "gdk_content_deserializer_get_type")
 
-	core.PuregoSafeRegister(&xContentDeserializerGetCancellable, libs, "gdk_content_deserializer_get_cancellable")
-	core.PuregoSafeRegister(&xContentDeserializerGetGtype, libs, "gdk_content_deserializer_get_gtype")
-	core.PuregoSafeRegister(&xContentDeserializerGetInputStream, libs, "gdk_content_deserializer_get_input_stream")
-	core.PuregoSafeRegister(&xContentDeserializerGetMimeType, libs, "gdk_content_deserializer_get_mime_type")
-	core.PuregoSafeRegister(&xContentDeserializerGetPriority, libs, "gdk_content_deserializer_get_priority")
-	core.PuregoSafeRegister(&xContentDeserializerGetTaskData, libs, "gdk_content_deserializer_get_task_data")
-	core.PuregoSafeRegister(&xContentDeserializerGetUserData, libs, "gdk_content_deserializer_get_user_data")
-	core.PuregoSafeRegister(&xContentDeserializerGetValue, libs, "gdk_content_deserializer_get_value")
-	core.PuregoSafeRegister(&xContentDeserializerReturnError, libs, "gdk_content_deserializer_return_error")
-	core.PuregoSafeRegister(&xContentDeserializerReturnSuccess, libs, "gdk_content_deserializer_return_success")
-	core.PuregoSafeRegister(&xContentDeserializerSetTaskData, libs, "gdk_content_deserializer_set_task_data")
+	core.PuregoRegisterSince(&xContentDeserializerGetCancellable, libs, "gdk_content_deserializer_get_cancellable", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetGtype, libs, "gdk_content_deserializer_get_gtype", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetInputStream, libs, "gdk_content_deserializer_get_input_stream", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetMimeType, libs, "gdk_content_deserializer_get_mime_type", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetPriority, libs, "gdk_content_deserializer_get_priority", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetTaskData, libs, "gdk_content_deserializer_get_task_data", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetUserData, libs, "gdk_content_deserializer_get_user_data", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerGetValue, libs, "gdk_content_deserializer_get_value", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerReturnError, libs, "gdk_content_deserializer_return_error", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerReturnSuccess, libs, "gdk_content_deserializer_return_success", "GDK", "")
+	core.PuregoRegisterSince(&xContentDeserializerSetTaskData, libs, "gdk_content_deserializer_set_task_data", "GDK", "")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -40,7 +40,7 @@ func NewContentFormatsBuilder() *ContentFormatsBuilder {
 
 var xContentFormatsBuilderAddFormats func(uintptr, *ContentFormats)
 
-// Appends all formats from @formats to @builder, skipping those that
+// Appends all formats from formats to builder, skipping those that
 // already exist.
 func (x *ContentFormatsBuilder) AddFormats(FormatsVar *ContentFormats) {
 
@@ -50,7 +50,7 @@ func (x *ContentFormatsBuilder) AddFormats(FormatsVar *ContentFormats) {
 
 var xContentFormatsBuilderAddGtype func(uintptr, types.GType)
 
-// Appends @type to @builder if it has not already been added.
+// Appends type to builder if it has not already been added.
 func (x *ContentFormatsBuilder) AddGtype(TypeVar types.GType) {
 
 	xContentFormatsBuilderAddGtype(x.GoPointer(), TypeVar)
@@ -59,7 +59,7 @@ func (x *ContentFormatsBuilder) AddGtype(TypeVar types.GType) {
 
 var xContentFormatsBuilderAddMimeType func(uintptr, string)
 
-// Appends @mime_type to @builder if it has not already been added.
+// Appends mime_type to builder if it has not already been added.
 func (x *ContentFormatsBuilder) AddMimeType(MimeTypeVar string) {
 
 	xContentFormatsBuilderAddMimeType(x.GoPointer(), MimeTypeVar)
@@ -69,7 +69,7 @@ func (x *ContentFormatsBuilder) AddMimeType(MimeTypeVar string) {
 var xContentFormatsBuilderFreeToFormats func(uintptr) *ContentFormats
 
 // Creates a new `GdkContentFormats` from the current state of the
-// given @builder, and frees the @builder instance.
+// given builder, and frees the builder instance.
 func (x *ContentFormatsBuilder) FreeToFormats() *ContentFormats {
 
 	cret := xContentFormatsBuilderFreeToFormats(x.GoPointer())
@@ -78,7 +78,7 @@ func (x *ContentFormatsBuilder) FreeToFormats() *ContentFormats {
 
 var xContentFormatsBuilderRef func(uintptr) *ContentFormatsBuilder
 
-// Acquires a reference on the given @builder.
+// Acquires a reference on the given builder.
 //
 // This function is intended primarily for bindings.
 // `GdkContentFormatsBuilder` objects should not be kept around.
@@ -90,13 +90,13 @@ func (x *ContentFormatsBuilder) Ref() *ContentFormatsBuilder {
 
 var xContentFormatsBuilderToFormats func(uintptr) *ContentFormats
 
-// Creates a new `GdkContentFormats` from the given @builder.
+// Creates a new `GdkContentFormats` from the given builder.
 //
 // The given `GdkContentFormatsBuilder` is reset once this function returns;
-// you cannot call this function multiple times on the same @builder instance.
+// you cannot call this function multiple times on the same builder instance.
 //
 // This function is intended primarily for bindings. C code should use
-// [method@Gdk.ContentFormatsBuilder.free_to_formats].
+// [ContentFormatsBuilder.FreeToFormats].
 func (x *ContentFormatsBuilder) ToFormats() *ContentFormats {
 
 	cret := xContentFormatsBuilderToFormats(x.GoPointer())
@@ -105,7 +105,7 @@ func (x *ContentFormatsBuilder) ToFormats() *ContentFormats {
 
 var xContentFormatsBuilderUnref func(uintptr)
 
-// Releases a reference on the given @builder.
+// Releases a reference on the given builder.
 func (x *ContentFormatsBuilder) Unref() {
 
 	xContentFormatsBuilderUnref(x.GoPointer())
@@ -127,6 +127,21 @@ func (x *FileList) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the FileList made with g_boxed_copy, release it with Free.
+func (x *FileList) Copy() *FileList {
+	cret := gobject.BoxedCopy(FileListGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*FileList)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the FileList with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *FileList) Free() {
+	gobject.BoxedFree(FileListGLibType(), x.GoPointer())
+}
+
 var xNewFileListFromArray func(uintptr, uint) *FileList
 
 // Creates a new `GdkFileList` for the given array of files.
@@ -163,13 +178,13 @@ func (x *FileList) GetFiles() *glib.SList {
 
 var xContentFormatsParse func(string) *ContentFormats
 
-// Parses the given @string into `GdkContentFormats` and
+// Parses the given string into `GdkContentFormats` and
 // returns the formats.
 //
-// Strings printed via [method@Gdk.ContentFormats.to_string]
+// Strings printed via [ContentFormats.ToString]
 // can be read in again successfully using this function.
 //
-// If @string does not describe valid content formats, %NULL
+// If string does not describe valid content formats, nil
 // is returned.
 func ContentFormatsParse(StringVar string) *ContentFormats {
 
@@ -181,7 +196,7 @@ var xInternMimeType func(string) string
 
 // Canonicalizes the given mime type and interns the result.
 //
-// If @string is not a valid mime type, %NULL is returned instead.
+// If string is not a valid mime type, nil is returned instead.
 // See RFC 2048 for the syntax if mime types.
 func InternMimeType(StringVar string) string {
 
@@ -194,33 +209,33 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xContentFormatsParse, libs, "gdk_content_formats_parse")
-	core.PuregoSafeRegister(&xInternMimeType, libs, "gdk_intern_mime_type")
+	core.PuregoRegisterSince(&xContentFormatsParse, libs, "gdk_content_formats_parse", "GDK", "4.4")
+	core.PuregoRegisterSince(&xInternMimeType, libs, "gdk_intern_mime_type", "GDK", "")
 
 	core.PuregoSafeRegister(&xContentFormatsBuilderGLibType, libs, "gdk_content_formats_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewContentFormatsBuilder, libs, "gdk_content_formats_builder_new")
+	core.PuregoRegisterSince(&xNewContentFormatsBuilder, libs, "gdk_content_formats_builder_new", "GDK", "")
 
-	core.PuregoSafeRegister(&xContentFormatsBuilderAddFormats, libs, "gdk_content_formats_builder_add_formats")
-	core.PuregoSafeRegister(&xContentFormatsBuilderAddGtype, libs, "gdk_content_formats_builder_add_gtype")
-	core.PuregoSafeRegister(&xContentFormatsBuilderAddMimeType, libs, "gdk_content_formats_builder_add_mime_type")
-	core.PuregoSafeRegister(&xContentFormatsBuilderFreeToFormats, libs, "gdk_content_formats_builder_free_to_formats")
-	core.PuregoSafeRegister(&xContentFormatsBuilderRef, libs, "gdk_content_formats_builder_ref")
-	core.PuregoSafeRegister(&xContentFormatsBuilderToFormats, libs, "gdk_content_formats_builder_to_formats")
-	core.PuregoSafeRegister(&xContentFormatsBuilderUnref, libs, "gdk_content_formats_builder_unref")
+	core.PuregoRegisterSince(&xContentFormatsBuilderAddFormats, libs, "gdk_content_formats_builder_add_formats", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderAddGtype, libs, "gdk_content_formats_builder_add_gtype", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderAddMimeType, libs, "gdk_content_formats_builder_add_mime_type", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderFreeToFormats, libs, "gdk_content_formats_builder_free_to_formats", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderRef, libs, "gdk_content_formats_builder_ref", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderToFormats, libs, "gdk_content_formats_builder_to_formats", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsBuilderUnref, libs, "gdk_content_formats_builder_unref", "GDK", "")
 
 	core.PuregoSafeRegister(&xFileListGLibType, libs, "gdk_file_list_get_type")
 
-	core.PuregoSafeRegister(&xNewFileListFromArray, libs, "gdk_file_list_new_from_array")
-	core.PuregoSafeRegister(&xNewFileListFromList, libs, "gdk_file_list_new_from_list")
+	core.PuregoRegisterSince(&xNewFileListFromArray, libs, "gdk_file_list_new_from_array", "GDK", "4.8")
+	core.PuregoRegisterSince(&xNewFileListFromList, libs, "gdk_file_list_new_from_list", "GDK", "4.8")
 
-	core.PuregoSafeRegister(&xFileListGetFiles, libs, "gdk_file_list_get_files")
+	core.PuregoRegisterSince(&xFileListGetFiles, libs, "gdk_file_list_get_files", "GDK", "4.6")
 
 }
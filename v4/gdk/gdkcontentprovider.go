@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -48,7 +47,8 @@ func (x *ContentProviderClass) OverrideContentChanged(cb func(*ContentProvider))
 	if cb == nil {
 		x.xContentChanged = 0
 	} else {
-		x.xContentChanged = purego.NewCallback(func(ProviderVarp uintptr) {
+		x.xContentChanged = core.NewCallback(func(ProviderVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContentProviderNewFromInternalPtr(ProviderVarp))
 		})
 	}
@@ -61,7 +61,7 @@ func (x *ContentProviderClass) GetContentChanged() func(*ContentProvider) {
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xContentChanged)
+	core.RegisterFunc(&rawCallback, x.xContentChanged)
 	return func(ProviderVar *ContentProvider) {
 		rawCallback(ProviderVar.GoPointer())
 	}
@@ -72,7 +72,8 @@ func (x *ContentProviderClass) OverrideAttachClipboard(cb func(*ContentProvider,
 	if cb == nil {
 		x.xAttachClipboard = 0
 	} else {
-		x.xAttachClipboard = purego.NewCallback(func(ProviderVarp uintptr, ClipboardVarp uintptr) {
+		x.xAttachClipboard = core.NewCallback(func(ProviderVarp uintptr, ClipboardVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContentProviderNewFromInternalPtr(ProviderVarp), ClipboardNewFromInternalPtr(ClipboardVarp))
 		})
 	}
@@ -84,7 +85,7 @@ func (x *ContentProviderClass) GetAttachClipboard() func(*ContentProvider, *Clip
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr, ClipboardVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAttachClipboard)
+	core.RegisterFunc(&rawCallback, x.xAttachClipboard)
 	return func(ProviderVar *ContentProvider, ClipboardVar *Clipboard) {
 		rawCallback(ProviderVar.GoPointer(), ClipboardVar.GoPointer())
 	}
@@ -95,7 +96,8 @@ func (x *ContentProviderClass) OverrideDetachClipboard(cb func(*ContentProvider,
 	if cb == nil {
 		x.xDetachClipboard = 0
 	} else {
-		x.xDetachClipboard = purego.NewCallback(func(ProviderVarp uintptr, ClipboardVarp uintptr) {
+		x.xDetachClipboard = core.NewCallback(func(ProviderVarp uintptr, ClipboardVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContentProviderNewFromInternalPtr(ProviderVarp), ClipboardNewFromInternalPtr(ClipboardVarp))
 		})
 	}
@@ -107,7 +109,7 @@ func (x *ContentProviderClass) GetDetachClipboard() func(*ContentProvider, *Clip
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr, ClipboardVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDetachClipboard)
+	core.RegisterFunc(&rawCallback, x.xDetachClipboard)
 	return func(ProviderVar *ContentProvider, ClipboardVar *Clipboard) {
 		rawCallback(ProviderVar.GoPointer(), ClipboardVar.GoPointer())
 	}
@@ -118,7 +120,8 @@ func (x *ContentProviderClass) OverrideRefFormats(cb func(*ContentProvider) *Con
 	if cb == nil {
 		x.xRefFormats = 0
 	} else {
-		x.xRefFormats = purego.NewCallback(func(ProviderVarp uintptr) *ContentFormats {
+		x.xRefFormats = core.NewCallback(func(ProviderVarp uintptr) *ContentFormats {
+			defer core.RecoverPanic()
 			return cb(ContentProviderNewFromInternalPtr(ProviderVarp))
 		})
 	}
@@ -130,7 +133,7 @@ func (x *ContentProviderClass) GetRefFormats() func(*ContentProvider) *ContentFo
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr) *ContentFormats
-	purego.RegisterFunc(&rawCallback, x.xRefFormats)
+	core.RegisterFunc(&rawCallback, x.xRefFormats)
 	return func(ProviderVar *ContentProvider) *ContentFormats {
 		return rawCallback(ProviderVar.GoPointer())
 	}
@@ -141,7 +144,8 @@ func (x *ContentProviderClass) OverrideRefStorableFormats(cb func(*ContentProvid
 	if cb == nil {
 		x.xRefStorableFormats = 0
 	} else {
-		x.xRefStorableFormats = purego.NewCallback(func(ProviderVarp uintptr) *ContentFormats {
+		x.xRefStorableFormats = core.NewCallback(func(ProviderVarp uintptr) *ContentFormats {
+			defer core.RecoverPanic()
 			return cb(ContentProviderNewFromInternalPtr(ProviderVarp))
 		})
 	}
@@ -153,7 +157,7 @@ func (x *ContentProviderClass) GetRefStorableFormats() func(*ContentProvider) *C
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr) *ContentFormats
-	purego.RegisterFunc(&rawCallback, x.xRefStorableFormats)
+	core.RegisterFunc(&rawCallback, x.xRefStorableFormats)
 	return func(ProviderVar *ContentProvider) *ContentFormats {
 		return rawCallback(ProviderVar.GoPointer())
 	}
@@ -164,7 +168,8 @@ func (x *ContentProviderClass) OverrideWriteMimeTypeAsync(cb func(*ContentProvid
 	if cb == nil {
 		x.xWriteMimeTypeAsync = 0
 	} else {
-		x.xWriteMimeTypeAsync = purego.NewCallback(func(ProviderVarp uintptr, MimeTypeVarp string, StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xWriteMimeTypeAsync = core.NewCallback(func(ProviderVarp uintptr, MimeTypeVarp string, StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContentProviderNewFromInternalPtr(ProviderVarp), MimeTypeVarp, gio.OutputStreamNewFromInternalPtr(StreamVarp), IoPriorityVarp, gio.CancellableNewFromInternalPtr(CancellableVarp), (*gio.AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -176,7 +181,7 @@ func (x *ContentProviderClass) GetWriteMimeTypeAsync() func(*ContentProvider, st
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr, MimeTypeVarp string, StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xWriteMimeTypeAsync)
+	core.RegisterFunc(&rawCallback, x.xWriteMimeTypeAsync)
 	return func(ProviderVar *ContentProvider, MimeTypeVar string, StreamVar *gio.OutputStream, IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ProviderVar.GoPointer(), MimeTypeVar, StreamVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -187,7 +192,8 @@ func (x *ContentProviderClass) OverrideWriteMimeTypeFinish(cb func(*ContentProvi
 	if cb == nil {
 		x.xWriteMimeTypeFinish = 0
 	} else {
-		x.xWriteMimeTypeFinish = purego.NewCallback(func(ProviderVarp uintptr, ResultVarp uintptr) bool {
+		x.xWriteMimeTypeFinish = core.NewCallback(func(ProviderVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(ContentProviderNewFromInternalPtr(ProviderVarp), &gio.AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -199,7 +205,7 @@ func (x *ContentProviderClass) GetWriteMimeTypeFinish() func(*ContentProvider, g
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xWriteMimeTypeFinish)
+	core.RegisterFunc(&rawCallback, x.xWriteMimeTypeFinish)
 	return func(ProviderVar *ContentProvider, ResultVar gio.AsyncResult) bool {
 		return rawCallback(ProviderVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -210,7 +216,8 @@ func (x *ContentProviderClass) OverrideGetValue(cb func(*ContentProvider, *gobje
 	if cb == nil {
 		x.xGetValue = 0
 	} else {
-		x.xGetValue = purego.NewCallback(func(ProviderVarp uintptr, ValueVarp *gobject.Value) bool {
+		x.xGetValue = core.NewCallback(func(ProviderVarp uintptr, ValueVarp *gobject.Value) bool {
+			defer core.RecoverPanic()
 			return cb(ContentProviderNewFromInternalPtr(ProviderVarp), ValueVarp)
 		})
 	}
@@ -222,7 +229,7 @@ func (x *ContentProviderClass) GetGetValue() func(*ContentProvider, *gobject.Val
 		return nil
 	}
 	var rawCallback func(ProviderVarp uintptr, ValueVarp *gobject.Value) bool
-	purego.RegisterFunc(&rawCallback, x.xGetValue)
+	core.RegisterFunc(&rawCallback, x.xGetValue)
 	return func(ProviderVar *ContentProvider, ValueVar *gobject.Value) bool {
 		return rawCallback(ProviderVar.GoPointer(), ValueVar)
 	}
@@ -231,11 +238,11 @@ func (x *ContentProviderClass) GetGetValue() func(*ContentProvider, *gobject.Val
 // Provides content for the clipboard or for drag-and-drop operations
 // in a number of formats.
 //
-// To create a `GdkContentProvider`, use [ctor@Gdk.ContentProvider.new_for_value]
-// or [ctor@Gdk.ContentProvider.new_for_bytes].
+// To create a `GdkContentProvider`, use [NewContentProviderForValue]
+// or [NewContentProviderForBytes].
 //
 // GDK knows how to handle common text and image formats out-of-the-box. See
-// [class@Gdk.ContentSerializer] and [class@Gdk.ContentDeserializer] if you want
+// [ContentSerializer] and [ContentDeserializer] if you want
 // to add support for application-specific data formats.
 type ContentProvider struct {
 	gobject.Object
@@ -255,12 +262,15 @@ func ContentProviderNewFromInternalPtr(ptr uintptr) *ContentProvider {
 
 var xNewContentProviderForBytes func(string, *glib.Bytes) uintptr
 
-// Create a content provider that provides the given @bytes as data for
-// the given @mime_type.
-func NewContentProviderForBytes(MimeTypeVar string, BytesVar *glib.Bytes) *ContentProvider {
+// Create a content provider that provides the given bytes as data for
+// the given mime_type.
+func NewContentProviderForBytes(MimeTypeVar string, BytesVar []byte) *ContentProvider {
 	var cls *ContentProvider
 
-	cret := xNewContentProviderForBytes(MimeTypeVar, BytesVar)
+	BytesVarBytes := glib.NewBytesFromSlice(BytesVar)
+	defer BytesVarBytes.Unref()
+
+	cret := xNewContentProviderForBytes(MimeTypeVar, BytesVarBytes)
 
 	if cret == 0 {
 		return nil
@@ -272,7 +282,7 @@ func NewContentProviderForBytes(MimeTypeVar string, BytesVar *glib.Bytes) *Conte
 
 var xNewContentProviderForValue func(*gobject.Value) uintptr
 
-// Create a content provider that provides the given @value.
+// Create a content provider that provides the given value.
 func NewContentProviderForValue(ValueVar *gobject.Value) *ContentProvider {
 	var cls *ContentProvider
 
@@ -289,10 +299,10 @@ func NewContentProviderForValue(ValueVar *gobject.Value) *ContentProvider {
 var xNewContentProviderTyped func(types.GType, ...interface{}) uintptr
 
 // Create a content provider that provides the value of the given
-// @type.
+// type.
 //
 // The value is provided using G_VALUE_COLLECT(), so the same rules
-// apply as when calling g_object_new() or g_object_set().
+// apply as when calling [gobject.NewObject] or [gobject.Object.Set].
 func NewContentProviderTyped(TypeVar types.GType, varArgs ...interface{}) *ContentProvider {
 	var cls *ContentProvider
 
@@ -308,23 +318,20 @@ func NewContentProviderTyped(TypeVar types.GType, varArgs ...interface{}) *Conte
 
 var xNewContentProviderUnion func(uintptr, uint) uintptr
 
-// Creates a content provider that represents all the given @providers.
+// Creates a content provider that represents all the given providers.
 //
 // Whenever data needs to be written, the union provider will try the given
-// @providers in the given order and the first one supporting a format will
+// providers in the given order and the first one supporting a format will
 // be chosen to provide it.
 //
 // This allows an easy way to support providing data in different formats.
 // For example, an image may be provided by its file and by the image
 // contents with a call such as
-// ```c
 //
 //	gdk_content_provider_new_union ((GdkContentProvider *[2]) {
 //	                                  gdk_content_provider_new_typed (G_TYPE_FILE, file),
 //	                                  gdk_content_provider_new_typed (GDK_TYPE_TEXTURE, texture)
 //	                                }, 2);
-//
-// ```
 func NewContentProviderUnion(ProvidersVar uintptr, NProvidersVar uint) *ContentProvider {
 	var cls *ContentProvider
 
@@ -349,11 +356,11 @@ func (x *ContentProvider) ContentChanged() {
 
 var xContentProviderGetValue func(uintptr, *gobject.Value, **glib.Error) bool
 
-// Gets the contents of @provider stored in @value.
+// Gets the contents of provider stored in value.
 //
-// The @value will have been initialized to the `GType` the value should be
+// The value will have been initialized to the `GType` the value should be
 // provided in. This given `GType` does not need to be listed in the formats
-// returned by [method@Gdk.ContentProvider.ref_formats]. However, if the
+// returned by [ContentProvider.RefFormats]. However, if the
 // given `GType` is not supported, this operation can fail and
 // `G_IO_ERROR_NOT_SUPPORTED` will be reported.
 func (x *ContentProvider) GetValue(ValueVar *gobject.Value) (bool, error) {
@@ -361,7 +368,7 @@ func (x *ContentProvider) GetValue(ValueVar *gobject.Value) (bool, error) {
 
 	cret := xContentProviderGetValue(x.GoPointer(), ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -383,7 +390,7 @@ var xContentProviderRefStorableFormats func(uintptr) *ContentFormats
 //
 // An example of such an application would be a clipboard manager.
 //
-// This can be assumed to be a subset of [method@Gdk.ContentProvider.ref_formats].
+// This can be assumed to be a subset of [ContentProvider.RefFormats].
 func (x *ContentProvider) RefStorableFormats() *ContentFormats {
 
 	cret := xContentProviderRefStorableFormats(x.GoPointer())
@@ -392,14 +399,14 @@ func (x *ContentProvider) RefStorableFormats() *ContentFormats {
 
 var xContentProviderWriteMimeTypeAsync func(uintptr, string, uintptr, int, uintptr, uintptr, uintptr)
 
-// Asynchronously writes the contents of @provider to @stream in the given
-// @mime_type.
+// Asynchronously writes the contents of provider to stream in the given
+// mime_type.
 //
 // The given mime type does not need to be listed in the formats returned by
-// [method@Gdk.ContentProvider.ref_formats]. However, if the given `GType` is
+// [ContentProvider.RefFormats]. However, if the given `GType` is
 // not supported, `G_IO_ERROR_NOT_SUPPORTED` will be reported.
 //
-// The given @stream will not be closed.
+// The given stream will not be closed.
 func (x *ContentProvider) WriteMimeTypeAsync(MimeTypeVar string, StreamVar *gio.OutputStream, IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -409,11 +416,12 @@ func (x *ContentProvider) WriteMimeTypeAsync(MimeTypeVar string, StreamVar *gio.
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -425,13 +433,13 @@ var xContentProviderWriteMimeTypeFinish func(uintptr, uintptr, **glib.Error) boo
 
 // Finishes an asynchronous write operation.
 //
-// See [method@Gdk.ContentProvider.write_mime_type_async].
+// See [ContentProvider.WriteMimeTypeAsync].
 func (x *ContentProvider) WriteMimeTypeFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xContentProviderWriteMimeTypeFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -465,27 +473,49 @@ func (x *ContentProvider) GetPropertyStorableFormats() uintptr {
 }
 
 // Emitted whenever the content provided by this provider has changed.
+//
+// Deprecated: use ConnectContentChangedFunc, which also accepts method values and closures.
 func (x *ContentProvider) ConnectContentChanged(cb *func(ContentProvider)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "content-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ContentProvider{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "content-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xContentProviderContentChangedTrampoline uintptr
+
+func xContentProviderContentChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ContentProvider))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ContentProvider{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "content-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectContentChangedFunc connects cb to the "content-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ContentProvider) ConnectContentChangedFunc(cb func(ContentProvider)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "content-changed", &xContentProviderContentChangedTrampoline, xContentProviderContentChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -493,7 +523,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -502,16 +532,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xContentProviderGLibType, libs, "gdk_content_provider_get_type")
 
-	core.PuregoSafeRegister(&xNewContentProviderForBytes, libs, "gdk_content_provider_new_for_bytes")
-	core.PuregoSafeRegister(&xNewContentProviderForValue, libs, "gdk_content_provider_new_for_value")
-	core.PuregoSafeRegister(&xNewContentProviderTyped, libs, "gdk_content_provider_new_typed")
-	core.PuregoSafeRegister(&xNewContentProviderUnion, libs, "gdk_content_provider_new_union")
-
-	core.PuregoSafeRegister(&xContentProviderContentChanged, libs, "gdk_content_provider_content_changed")
-	core.PuregoSafeRegister(&xContentProviderGetValue, libs, "gdk_content_provider_get_value")
-	core.PuregoSafeRegister(&xContentProviderRefFormats, libs, "gdk_content_provider_ref_formats")
-	core.PuregoSafeRegister(&xContentProviderRefStorableFormats, libs, "gdk_content_provider_ref_storable_formats")
-	core.PuregoSafeRegister(&xContentProviderWriteMimeTypeAsync, libs, "gdk_content_provider_write_mime_type_async")
-	core.PuregoSafeRegister(&xContentProviderWriteMimeTypeFinish, libs, "gdk_content_provider_write_mime_type_finish")
+	gobject.CheckClassSize("GdkContentProviderClass", xContentProviderGLibType, unsafe.Sizeof(ContentProviderClass{}))
+
+	core.PuregoRegisterSince(&xNewContentProviderForBytes, libs, "gdk_content_provider_new_for_bytes", "GDK", "")
+	core.PuregoRegisterSince(&xNewContentProviderForValue, libs, "gdk_content_provider_new_for_value", "GDK", "")
+	core.PuregoRegisterSince(&xNewContentProviderTyped, libs, "gdk_content_provider_new_typed", "GDK", "")
+	core.PuregoRegisterSince(&xNewContentProviderUnion, libs, "gdk_content_provider_new_union", "GDK", "")
+
+	core.PuregoRegisterSince(&xContentProviderContentChanged, libs, "gdk_content_provider_content_changed", "GDK", "")
+	core.PuregoRegisterSince(&xContentProviderGetValue, libs, "gdk_content_provider_get_value", "GDK", "")
+	core.PuregoRegisterSince(&xContentProviderRefFormats, libs, "gdk_content_provider_ref_formats", "GDK", "")
+	core.PuregoRegisterSince(&xContentProviderRefStorableFormats, libs, "gdk_content_provider_ref_storable_formats", "GDK", "")
+	core.PuregoRegisterSince(&xContentProviderWriteMimeTypeAsync, libs, "gdk_content_provider_write_mime_type_async", "GDK", "")
+	core.PuregoRegisterSince(&xContentProviderWriteMimeTypeFinish, libs, "gdk_content_provider_write_mime_type_finish", "GDK", "")
 
 }
@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -12,10 +11,10 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of a function that can be registered with gdk_content_register_serializer().
+// The type of a function that can be registered with [ContentRegisterSerializer].
 //
 // When the function gets called to operate on content, it can call functions on the
-// @serializer object to obtain the mime type, output stream, user data, etc. for its
+// serializer object to obtain the mime type, output stream, user data, etc. for its
 // operation.
 type ContentSerializeFunc func(uintptr)
 
@@ -35,11 +34,12 @@ func ContentRegisterSerializer(TypeVar types.GType, MimeTypeVar string, Serializ
 			SerializeVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *SerializeVar
 				cbFn(arg0)
 			}
-			SerializeVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SerializeVarPtr, SerializeVarRef, SerializeVar)
+			SerializeVarRef = core.NewCallback(fcb)
+			SerializeVarRef = glib.SaveCallbackWithClosure(SerializeVarPtr, SerializeVarRef, SerializeVar)
 		}
 	}
 
@@ -50,11 +50,12 @@ func ContentRegisterSerializer(TypeVar types.GType, MimeTypeVar string, Serializ
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -66,7 +67,7 @@ var xContentSerializeAsync func(uintptr, string, *gobject.Value, int, uintptr, u
 
 // Serialize content and write it to the given output stream, asynchronously.
 //
-// The default I/O priority is %G_PRIORITY_DEFAULT (i.e. 0), and lower numbers
+// The default I/O priority is [glib.PRIORITY_DEFAULT] (i.e. 0), and lower numbers
 // indicate a higher priority.
 func ContentSerializeAsync(StreamVar *gio.OutputStream, MimeTypeVar string, ValueVar *gobject.Value, IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -77,11 +78,12 @@ func ContentSerializeAsync(StreamVar *gio.OutputStream, MimeTypeVar string, Valu
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -97,7 +99,7 @@ func ContentSerializeFinish(ResultVar gio.AsyncResult) (bool, error) {
 
 	cret := xContentSerializeFinish(ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -111,9 +113,9 @@ func ContentSerializeFinish(ResultVar gio.AsyncResult) (bool, error) {
 //
 // GTK provides serializers and deserializers for common data types
 // such as text, colors, images or file lists. To register your own
-// serialization functions, use [func@Gdk.content_register_serializer].
+// serialization functions, use [ContentRegisterSerializer].
 //
-// Also see [class@Gdk.ContentDeserializer].
+// Also see [ContentDeserializer].
 type ContentSerializer struct {
 	gobject.Object
 }
@@ -134,7 +136,7 @@ var xContentSerializerGetCancellable func(uintptr) uintptr
 
 // Gets the cancellable for the current operation.
 //
-// This is the `GCancellable` that was passed to [func@content_serialize_async].
+// This is the `GCancellable` that was passed to content_serialize_async.
 func (x *ContentSerializer) GetCancellable() *gio.Cancellable {
 	var cls *gio.Cancellable
 
@@ -171,7 +173,7 @@ var xContentSerializerGetOutputStream func(uintptr) uintptr
 
 // Gets the output stream for the current operation.
 //
-// This is the stream that was passed to [func@content_serialize_async].
+// This is the stream that was passed to content_serialize_async.
 func (x *ContentSerializer) GetOutputStream() *gio.OutputStream {
 	var cls *gio.OutputStream
 
@@ -190,7 +192,7 @@ var xContentSerializerGetPriority func(uintptr) int
 
 // Gets the I/O priority for the current operation.
 //
-// This is the priority that was passed to [func@content_serialize_async].
+// This is the priority that was passed to content_serialize_async.
 func (x *ContentSerializer) GetPriority() int {
 
 	cret := xContentSerializerGetPriority(x.GoPointer())
@@ -201,7 +203,7 @@ var xContentSerializerGetTaskData func(uintptr) uintptr
 
 // Gets the data that was associated with the current operation.
 //
-// See [method@Gdk.ContentSerializer.set_task_data].
+// See [ContentSerializer.SetTaskData].
 func (x *ContentSerializer) GetTaskData() uintptr {
 
 	cret := xContentSerializerGetTaskData(x.GoPointer())
@@ -226,14 +228,14 @@ func (x *ContentSerializer) GetValue() *gobject.Value {
 	return cret
 }
 
-var xContentSerializerReturnError func(uintptr, *glib.Error)
+var xContentSerializerReturnError func(uintptr, uintptr)
 
 // Indicate that the serialization has ended with an error.
 //
-// This function consumes @error.
+// This function consumes error.
 func (x *ContentSerializer) ReturnError(ErrorVar *glib.Error) {
 
-	xContentSerializerReturnError(x.GoPointer(), ErrorVar)
+	xContentSerializerReturnError(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
@@ -258,11 +260,12 @@ func (x *ContentSerializer) SetTaskData(DataVar uintptr, NotifyVar *glib.Destroy
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -281,7 +284,7 @@ func (c *ContentSerializer) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Gets the source object from a [iface@Gio.AsyncResult].
+// Gets the source object from a [gio.AsyncResult].
 func (x *ContentSerializer) GetSourceObject() *gobject.Object {
 	var cls *gobject.Object
 
@@ -295,22 +298,22 @@ func (x *ContentSerializer) GetSourceObject() *gobject.Object {
 	return cls
 }
 
-// Checks if @res has the given @source_tag (generally a function
-// pointer indicating the function @res was created by).
+// Checks if res has the given source_tag (generally a function
+// pointer indicating the function res was created by).
 func (x *ContentSerializer) IsTagged(SourceTagVar uintptr) bool {
 
 	cret := gio.XGAsyncResultIsTagged(x.GoPointer(), SourceTagVar)
 	return cret
 }
 
-// If @res is a [class@Gio.SimpleAsyncResult], this is equivalent to
-// [method@Gio.SimpleAsyncResult.propagate_error]. Otherwise it returns
+// If res is a [gio.SimpleAsyncResult], this is equivalent to
+// [gio.SimpleAsyncResult.PropagateError]. Otherwise it returns
 // `FALSE`.
 //
 // This can be used for legacy error handling in async `*_finish()`
-// wrapper functions that traditionally handled [class@Gio.SimpleAsyncResult]
+// wrapper functions that traditionally handled [gio.SimpleAsyncResult]
 // error returns themselves rather than calling into the virtual method.
-// This should not be used in new code; [iface@Gio.AsyncResult] errors that are
+// This should not be used in new code; [gio.AsyncResult] errors that are
 // set by virtual methods should also be extracted by virtual methods,
 // to enable subclasses to chain up correctly.
 func (x *ContentSerializer) LegacyPropagateError() (bool, error) {
@@ -318,7 +321,7 @@ func (x *ContentSerializer) LegacyPropagateError() (bool, error) {
 
 	cret := gio.XGAsyncResultLegacyPropagateError(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -329,29 +332,29 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xContentRegisterSerializer, libs, "gdk_content_register_serializer")
-	core.PuregoSafeRegister(&xContentSerializeAsync, libs, "gdk_content_serialize_async")
-	core.PuregoSafeRegister(&xContentSerializeFinish, libs, "gdk_content_serialize_finish")
+	core.PuregoRegisterSince(&xContentRegisterSerializer, libs, "gdk_content_register_serializer", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializeAsync, libs, "gdk_content_serialize_async", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializeFinish, libs, "gdk_content_serialize_finish", "GDK", "")
 
 	core.PuregoSafeRegister(&xContentSerializerGLibType, libs, "gdk_content_serializer_get_type")
 
-	core.PuregoSafeRegister(&xContentSerializerGetCancellable, libs, "gdk_content_serializer_get_cancellable")
-	core.PuregoSafeRegister(&xContentSerializerGetGtype, libs, "gdk_content_serializer_get_gtype")
-	core.PuregoSafeRegister(&xContentSerializerGetMimeType, libs, "gdk_content_serializer_get_mime_type")
-	core.PuregoSafeRegister(&xContentSerializerGetOutputStream, libs, "gdk_content_serializer_get_output_stream")
-	core.PuregoSafeRegister(&xContentSerializerGetPriority, libs, "gdk_content_serializer_get_priority")
-	core.PuregoSafeRegister(&xContentSerializerGetTaskData, libs, "gdk_content_serializer_get_task_data")
-	core.PuregoSafeRegister(&xContentSerializerGetUserData, libs, "gdk_content_serializer_get_user_data")
-	core.PuregoSafeRegister(&xContentSerializerGetValue, libs, "gdk_content_serializer_get_value")
-	core.PuregoSafeRegister(&xContentSerializerReturnError, libs, "gdk_content_serializer_return_error")
-	core.PuregoSafeRegister(&xContentSerializerReturnSuccess, libs, "gdk_content_serializer_return_success")
-	core.PuregoSafeRegister(&xContentSerializerSetTaskData, libs, "gdk_content_serializer_set_task_data")
+	core.PuregoRegisterSince(&xContentSerializerGetCancellable, libs, "gdk_content_serializer_get_cancellable", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetGtype, libs, "gdk_content_serializer_get_gtype", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetMimeType, libs, "gdk_content_serializer_get_mime_type", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetOutputStream, libs, "gdk_content_serializer_get_output_stream", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetPriority, libs, "gdk_content_serializer_get_priority", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetTaskData, libs, "gdk_content_serializer_get_task_data", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetUserData, libs, "gdk_content_serializer_get_user_data", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerGetValue, libs, "gdk_content_serializer_get_value", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerReturnError, libs, "gdk_content_serializer_return_error", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerReturnSuccess, libs, "gdk_content_serializer_return_success", "GDK", "")
+	core.PuregoRegisterSince(&xContentSerializerSetTaskData, libs, "gdk_content_serializer_set_task_data", "GDK", "")
 
 }
@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,14 +11,14 @@ import (
 )
 
 // The type of callback used by a dynamic `GdkCursor` to generate
-// a texture for the cursor image at the given @cursor_size
-// and @scale.
+// a texture for the cursor image at the given cursor_size
+// and scale.
 //
 // The actual cursor size in application pixels may be different
-// from @cursor_size x @cursor_size, and will be returned in
-// @width, @height. The returned texture should have a size that
+// from cursor_size x cursor_size, and will be returned in
+// width, height. The returned texture should have a size that
 // corresponds to the actual cursor size, in device pixels (i.e.
-// application pixels, multiplied by @scale).
+// application pixels, multiplied by scale).
 //
 // This function may fail and return `NULL`, in which case
 // the fallback cursor will be used.
@@ -32,29 +31,29 @@ type CursorGetTextureCallback func(uintptr, int, float64, *int, *int, *int, *int
 // something about it.
 //
 // Cursors by themselves are not very interesting: they must be bound to a
-// window for users to see them. This is done with [method@Gdk.Surface.set_cursor]
-// or [method@Gdk.Surface.set_device_cursor]. Applications will typically
-// use higher-level GTK functions such as [gtk_widget_set_cursor()](../gtk4/method.Widget.set_cursor.html)
+// window for users to see them. This is done with [Surface.SetCursor]
+// or [Surface.SetDeviceCursor]. Applications will typically
+// use higher-level GTK functions such as [[gtk.Widget.SetCursor]](../gtk4/method.Widget.set_cursor.html)
 // instead.
 //
-// Cursors are not bound to a given [class@Gdk.Display], so they can be shared.
+// Cursors are not bound to a given [Display], so they can be shared.
 // However, the appearance of cursors may vary when used on different
 // platforms.
 //
-// ## Named and texture cursors
+// # Named and texture cursors
 //
 // There are multiple ways to create cursors. The platform's own cursors
-// can be created with [ctor@Gdk.Cursor.new_from_name]. That function lists
+// can be created with [NewCursorFromName]. That function lists
 // the commonly available names that are shared with the CSS specification.
 // Other names may be available, depending on the platform in use. On some
 // platforms, what images are used for named cursors may be influenced by
 // the cursor theme.
 //
-// Another option to create a cursor is to use [ctor@Gdk.Cursor.new_from_texture]
+// Another option to create a cursor is to use [NewCursorFromTexture]
 // and provide an image to use for the cursor.
 //
 // To ease work with unsupported cursors, a fallback cursor can be provided.
-// If a [class@Gdk.Surface] cannot use a cursor because of the reasons mentioned
+// If a [Surface] cannot use a cursor because of the reasons mentioned
 // above, it will try the fallback cursor. Fallback cursors can themselves have
 // fallback cursors again, so it is possible to provide a chain of progressively
 // easier to support cursors. If none of the provided cursors can be supported,
@@ -80,7 +79,7 @@ var xNewCursorFromCallback func(uintptr, uintptr, uintptr, uintptr) uintptr
 // Creates a new callback-based cursor object.
 //
 // Cursors of this kind produce textures for the cursor
-// image on demand, when the @callback is called.
+// image on demand, when the callback is called.
 func NewCursorFromCallback(CallbackVar *CursorGetTextureCallback, DataVar uintptr, DestroyVar *glib.DestroyNotify, FallbackVar *Cursor) *Cursor {
 	var cls *Cursor
 
@@ -91,11 +90,12 @@ func NewCursorFromCallback(CallbackVar *CursorGetTextureCallback, DataVar uintpt
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 int, arg2 float64, arg3 *int, arg4 *int, arg5 *int, arg6 *int, arg7 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				return cbFn(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -106,11 +106,12 @@ func NewCursorFromCallback(CallbackVar *CursorGetTextureCallback, DataVar uintpt
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -126,7 +127,7 @@ func NewCursorFromCallback(CallbackVar *CursorGetTextureCallback, DataVar uintpt
 
 var xNewCursorFromName func(string, uintptr) uintptr
 
-// Creates a new cursor by looking up @name in the current cursor
+// Creates a new cursor by looking up name in the current cursor
 // theme.
 //
 // A recommended set of cursor names that will work across different
@@ -135,42 +136,42 @@ var xNewCursorFromName func(string, uintptr) uintptr
 // | | | |
 // | --- | --- | --- |
 // |                               | "none"          | No cursor |
-// | ![](default_cursor.png)       | "default"       | The default cursor |
-// | ![](help_cursor.png)          | "help"          | Help is available |
-// | ![](pointer_cursor.png)       | "pointer"       | Indicates a link or interactive element |
-// | ![](context_menu_cursor.png)  |"context-menu"   | A context menu is available |
-// | ![](progress_cursor.png)      | "progress"      | Progress indicator |
-// | ![](wait_cursor.png)          | "wait"          | Busy cursor |
-// | ![](cell_cursor.png)          | "cell"          | Cell(s) may be selected |
-// | ![](crosshair_cursor.png)     | "crosshair"     | Simple crosshair |
-// | ![](text_cursor.png)          | "text"          | Text may be selected |
-// | ![](vertical_text_cursor.png) | "vertical-text" | Vertical text may be selected |
-// | ![](alias_cursor.png)         | "alias"         | DND: Something will be linked |
-// | ![](copy_cursor.png)          | "copy"          | DND: Something will be copied |
-// | ![](move_cursor.png)          | "move"          | DND: Something will be moved |
-// | ![](dnd_ask_cursor.png)       | "dnd-ask"       | DND: User can choose action to be carried out |
-// | ![](no_drop_cursor.png)       | "no-drop"       | DND: Can't drop here |
-// | ![](not_allowed_cursor.png)   | "not-allowed"   | DND: Action will not be carried out |
-// | ![](grab_cursor.png)          | "grab"          | DND: Something can be grabbed |
-// | ![](grabbing_cursor.png)      | "grabbing"      | DND: Something is being grabbed |
-// | ![](n_resize_cursor.png)      | "n-resize"      | Resizing: Move north border |
-// | ![](e_resize_cursor.png)      | "e-resize"      | Resizing: Move east border |
-// | ![](s_resize_cursor.png)      | "s-resize"      | Resizing: Move south border |
-// | ![](w_resize_cursor.png)      | "w-resize"      | Resizing: Move west border |
-// | ![](ne_resize_cursor.png)     | "ne-resize"     | Resizing: Move north-east corner |
-// | ![](nw_resize_cursor.png)     | "nw-resize"     | Resizing: Move north-west corner |
-// | ![](sw_resize_cursor.png)     | "sw-resize"     | Resizing: Move south-west corner |
-// | ![](se_resize_cursor.png)     | "se-resize"     | Resizing: Move south-east corner |
-// | ![](col_resize_cursor.png)    | "col-resize"    | Resizing: Move an item or border horizontally |
-// | ![](row_resize_cursor.png)    | "row-resize"    | Resizing: Move an item or border vertically |
-// | ![](ew_resize_cursor.png)     | "ew-resize"     | Moving: Something can be moved horizontally |
-// | ![](ns_resize_cursor.png)     | "ns-resize"     | Moving: Something can be moved vertically |
-// | ![](nesw_resize_cursor.png)   | "nesw-resize"   | Moving: Something can be moved diagonally, north-east to south-west |
-// | ![](nwse_resize_cursor.png)   | "nwse-resize"   | Moving: something can be moved diagonally, north-west to south-east |
-// | ![](all_resize_cursor.png)    | "all-resize"    | Moving: Something can be moved in any direction |
-// | ![](all_scroll_cursor.png)    | "all-scroll"    | Can scroll in any direction |
-// | ![](zoom_in_cursor.png)       | "zoom-in"       | Zoom in |
-// | ![](zoom_out_cursor.png)      | "zoom-out"      | Zoom out |
+// |        | "default"       | The default cursor |
+// |           | "help"          | Help is available |
+// |        | "pointer"       | Indicates a link or interactive element |
+// |   |"context-menu"   | A context menu is available |
+// |       | "progress"      | Progress indicator |
+// |           | "wait"          | Busy cursor |
+// |           | "cell"          | Cell(s) may be selected |
+// |      | "crosshair"     | Simple crosshair |
+// |           | "text"          | Text may be selected |
+// |  | "vertical-text" | Vertical text may be selected |
+// |          | "alias"         | DND: Something will be linked |
+// |           | "copy"          | DND: Something will be copied |
+// |           | "move"          | DND: Something will be moved |
+// |        | "dnd-ask"       | DND: User can choose action to be carried out |
+// |        | "no-drop"       | DND: Can't drop here |
+// |    | "not-allowed"   | DND: Action will not be carried out |
+// |           | "grab"          | DND: Something can be grabbed |
+// |       | "grabbing"      | DND: Something is being grabbed |
+// |       | "n-resize"      | Resizing: Move north border |
+// |       | "e-resize"      | Resizing: Move east border |
+// |       | "s-resize"      | Resizing: Move south border |
+// |       | "w-resize"      | Resizing: Move west border |
+// |      | "ne-resize"     | Resizing: Move north-east corner |
+// |      | "nw-resize"     | Resizing: Move north-west corner |
+// |      | "sw-resize"     | Resizing: Move south-west corner |
+// |      | "se-resize"     | Resizing: Move south-east corner |
+// |     | "col-resize"    | Resizing: Move an item or border horizontally |
+// |     | "row-resize"    | Resizing: Move an item or border vertically |
+// |      | "ew-resize"     | Moving: Something can be moved horizontally |
+// |      | "ns-resize"     | Moving: Something can be moved vertically |
+// |    | "nesw-resize"   | Moving: Something can be moved diagonally, north-east to south-west |
+// |    | "nwse-resize"   | Moving: something can be moved diagonally, north-west to south-east |
+// |     | "all-resize"    | Moving: Something can be moved in any direction |
+// |     | "all-scroll"    | Can scroll in any direction |
+// |        | "zoom-in"       | Zoom in |
+// |       | "zoom-out"      | Zoom out |
 func NewCursorFromName(NameVar string, FallbackVar *Cursor) *Cursor {
 	var cls *Cursor
 
@@ -202,7 +203,7 @@ func NewCursorFromTexture(TextureVar *Texture, HotspotXVar int, HotspotYVar int,
 
 var xCursorGetFallback func(uintptr) uintptr
 
-// Returns the fallback for this @cursor.
+// Returns the fallback for this cursor.
 //
 // The fallback will be used if this cursor is not available on a given
 // `GdkDisplay`. For named cursors, this can happen when using nonstandard
@@ -231,7 +232,7 @@ var xCursorGetHotspotX func(uintptr) int
 //
 // Note that named cursors may have a nonzero hotspot, but this function
 // will only return the hotspot position for cursors created with
-// [ctor@Gdk.Cursor.new_from_texture].
+// [NewCursorFromTexture].
 func (x *Cursor) GetHotspotX() int {
 
 	cret := xCursorGetHotspotX(x.GoPointer())
@@ -246,7 +247,7 @@ var xCursorGetHotspotY func(uintptr) int
 //
 // Note that named cursors may have a nonzero hotspot, but this function
 // will only return the hotspot position for cursors created with
-// [ctor@Gdk.Cursor.new_from_texture].
+// [NewCursorFromTexture].
 func (x *Cursor) GetHotspotY() int {
 
 	cret := xCursorGetHotspotY(x.GoPointer())
@@ -257,7 +258,7 @@ var xCursorGetName func(uintptr) string
 
 // Returns the name of the cursor.
 //
-// If the cursor is not a named cursor, %NULL will be returned.
+// If the cursor is not a named cursor, nil will be returned.
 func (x *Cursor) GetName() string {
 
 	cret := xCursorGetName(x.GoPointer())
@@ -268,7 +269,7 @@ var xCursorGetTexture func(uintptr) uintptr
 
 // Returns the texture for the cursor.
 //
-// If the cursor is a named cursor, %NULL will be returned.
+// If the cursor is a named cursor, nil will be returned.
 func (x *Cursor) GetTexture() *Texture {
 	var cls *Texture
 
@@ -331,7 +332,7 @@ func (x *Cursor) GetPropertyHotspotY() int {
 // SetPropertyName sets the "name" property.
 // Name of this this cursor.
 //
-// The name will be %NULL if the cursor was created from a texture.
+// The name will be nil if the cursor was created from a texture.
 func (x *Cursor) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -342,7 +343,7 @@ func (x *Cursor) SetPropertyName(value string) {
 // GetPropertyName gets the "name" property.
 // Name of this this cursor.
 //
-// The name will be %NULL if the cursor was created from a texture.
+// The name will be nil if the cursor was created from a texture.
 func (x *Cursor) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -354,7 +355,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -363,14 +364,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xCursorGLibType, libs, "gdk_cursor_get_type")
 
-	core.PuregoSafeRegister(&xNewCursorFromCallback, libs, "gdk_cursor_new_from_callback")
-	core.PuregoSafeRegister(&xNewCursorFromName, libs, "gdk_cursor_new_from_name")
-	core.PuregoSafeRegister(&xNewCursorFromTexture, libs, "gdk_cursor_new_from_texture")
+	core.PuregoRegisterSince(&xNewCursorFromCallback, libs, "gdk_cursor_new_from_callback", "GDK", "4.16")
+	core.PuregoRegisterSince(&xNewCursorFromName, libs, "gdk_cursor_new_from_name", "GDK", "")
+	core.PuregoRegisterSince(&xNewCursorFromTexture, libs, "gdk_cursor_new_from_texture", "GDK", "")
 
-	core.PuregoSafeRegister(&xCursorGetFallback, libs, "gdk_cursor_get_fallback")
-	core.PuregoSafeRegister(&xCursorGetHotspotX, libs, "gdk_cursor_get_hotspot_x")
-	core.PuregoSafeRegister(&xCursorGetHotspotY, libs, "gdk_cursor_get_hotspot_y")
-	core.PuregoSafeRegister(&xCursorGetName, libs, "gdk_cursor_get_name")
-	core.PuregoSafeRegister(&xCursorGetTexture, libs, "gdk_cursor_get_texture")
+	core.PuregoRegisterSince(&xCursorGetFallback, libs, "gdk_cursor_get_fallback", "GDK", "")
+	core.PuregoRegisterSince(&xCursorGetHotspotX, libs, "gdk_cursor_get_hotspot_x", "GDK", "")
+	core.PuregoRegisterSince(&xCursorGetHotspotY, libs, "gdk_cursor_get_hotspot_y", "GDK", "")
+	core.PuregoRegisterSince(&xCursorGetName, libs, "gdk_cursor_get_name", "GDK", "")
+	core.PuregoRegisterSince(&xCursorGetTexture, libs, "gdk_cursor_get_texture", "GDK", "")
 
 }
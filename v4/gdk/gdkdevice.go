@@ -2,10 +2,10 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,9 +16,9 @@ import (
 // Stores a single event in a motion history.
 //
 // To check whether an axis is present, check whether the corresponding
-// flag from the [flags@Gdk.AxisFlags] enumeration is set in the @flags
+// flag from the [AxisFlags] enumeration is set in the flags
 // To access individual axis values, use the values of the values of
-// the [enum@Gdk.AxisUse] enumerations as indices.
+// the [AxisUse] enumerations as indices.
 type TimeCoord struct {
 	_ structs.HostLayout
 
@@ -64,9 +64,72 @@ const (
 	SourceTabletPadValue InputSource = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x InputSource) String() string {
+	switch x {
+	case SourceMouseValue:
+		return "GDK_SOURCE_MOUSE"
+	case SourcePenValue:
+		return "GDK_SOURCE_PEN"
+	case SourceKeyboardValue:
+		return "GDK_SOURCE_KEYBOARD"
+	case SourceTouchscreenValue:
+		return "GDK_SOURCE_TOUCHSCREEN"
+	case SourceTouchpadValue:
+		return "GDK_SOURCE_TOUCHPAD"
+	case SourceTrackpointValue:
+		return "GDK_SOURCE_TRACKPOINT"
+	case SourceTabletPadValue:
+		return "GDK_SOURCE_TABLET_PAD"
+	}
+	return fmt.Sprintf("InputSource(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InputSource) Nick() string {
+	switch x {
+	case SourceMouseValue:
+		return "mouse"
+	case SourcePenValue:
+		return "pen"
+	case SourceKeyboardValue:
+		return "keyboard"
+	case SourceTouchscreenValue:
+		return "touchscreen"
+	case SourceTouchpadValue:
+		return "touchpad"
+	case SourceTrackpointValue:
+		return "trackpoint"
+	case SourceTabletPadValue:
+		return "tablet-pad"
+	}
+	return ""
+}
+
+// InputSourceFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InputSourceFromString(s string) (InputSource, bool) {
+	switch s {
+	case "GDK_SOURCE_MOUSE", "mouse":
+		return SourceMouseValue, true
+	case "GDK_SOURCE_PEN", "pen":
+		return SourcePenValue, true
+	case "GDK_SOURCE_KEYBOARD", "keyboard":
+		return SourceKeyboardValue, true
+	case "GDK_SOURCE_TOUCHSCREEN", "touchscreen":
+		return SourceTouchscreenValue, true
+	case "GDK_SOURCE_TOUCHPAD", "touchpad":
+		return SourceTouchpadValue, true
+	case "GDK_SOURCE_TRACKPOINT", "trackpoint":
+		return SourceTrackpointValue, true
+	case "GDK_SOURCE_TABLET_PAD", "tablet-pad":
+		return SourceTabletPadValue, true
+	}
+	return 0, false
+}
+
 // Represents an input device, such as a keyboard, mouse or touchpad.
 //
-// See the [class@Gdk.Seat] documentation for more information
+// See the [Seat] documentation for more information
 // about the various kinds of devices, and their relationships.
 type Device struct {
 	gobject.Object
@@ -111,7 +174,7 @@ func (x *Device) GetCapsLockState() bool {
 
 var xDeviceGetDeviceTool func(uintptr) uintptr
 
-// Retrieves the current tool for @device.
+// Retrieves the current tool for device.
 func (x *Device) GetDeviceTool() *DeviceTool {
 	var cls *DeviceTool
 
@@ -133,7 +196,7 @@ var xDeviceGetDirection func(uintptr) pango.Direction
 // This is only relevant for keyboard devices.
 //
 // The direction of a layout is the direction of the majority
-// of its symbols. See [func@Pango.unichar_direction].
+// of its symbols. See [pango.UnicharDirection].
 func (x *Device) GetDirection() pango.Direction {
 
 	cret := xDeviceGetDirection(x.GoPointer())
@@ -142,7 +205,7 @@ func (x *Device) GetDirection() pango.Direction {
 
 var xDeviceGetDisplay func(uintptr) uintptr
 
-// Returns the `GdkDisplay` to which @device pertains.
+// Returns the `GdkDisplay` to which device pertains.
 func (x *Device) GetDisplay() *Display {
 	var cls *Display
 
@@ -169,7 +232,7 @@ func (x *Device) GetHasCursor() bool {
 	return cret
 }
 
-var xDeviceGetLayoutNames func(uintptr) []string
+var xDeviceGetLayoutNames func(uintptr) uintptr
 
 // Retrieves the names of the layouts of the keyboard.
 //
@@ -177,7 +240,7 @@ var xDeviceGetLayoutNames func(uintptr) []string
 func (x *Device) GetLayoutNames() []string {
 
 	cret := xDeviceGetLayoutNames(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xDeviceGetModifierState func(uintptr) ModifierType
@@ -213,7 +276,7 @@ func (x *Device) GetNumLockState() bool {
 
 var xDeviceGetNumTouches func(uintptr) uint
 
-// Retrieves the number of touch points associated to @device.
+// Retrieves the number of touch points associated to device.
 func (x *Device) GetNumTouches() uint {
 
 	cret := xDeviceGetNumTouches(x.GoPointer())
@@ -225,7 +288,7 @@ var xDeviceGetProductId func(uintptr) string
 // Returns the product ID of this device.
 //
 // This ID is retrieved from the device, and does not change.
-// See [method@Gdk.Device.get_vendor_id] for more information.
+// See [Device.GetVendorId] for more information.
 func (x *Device) GetProductId() string {
 
 	cret := xDeviceGetProductId(x.GoPointer())
@@ -271,10 +334,10 @@ func (x *Device) GetSource() InputSource {
 
 var xDeviceGetSurfaceAtPosition func(uintptr, *float64, *float64) uintptr
 
-// Obtains the surface underneath @device, returning the location of the
-// device in @win_x and @win_y.
+// Obtains the surface underneath device, returning the location of the
+// device in win_x and win_y.
 //
-// Returns %NULL if the surface tree under @device is not known to GDK
+// Returns nil if the surface tree under device is not known to GDK
 // (for example, belongs to another application).
 func (x *Device) GetSurfaceAtPosition(WinXVar *float64, WinYVar *float64) *Surface {
 	var cls *Surface
@@ -310,12 +373,10 @@ var xDeviceGetVendorId func(uintptr) string
 //
 // This ID is retrieved from the device, and does not change.
 //
-// This function, together with [method@Gdk.Device.get_product_id],
+// This function, together with [Device.GetProductId],
 // can be used to eg. compose `GSettings` paths to store settings
 // for this device.
 //
-// ```c
-//
 //	static GSettings *
 //	get_device_settings (GdkDevice *device)
 //	{
@@ -333,8 +394,6 @@ var xDeviceGetVendorId func(uintptr) string
 //
 //	  return settings;
 //	}
-//
-// ```
 func (x *Device) GetVendorId() string {
 
 	cret := xDeviceGetVendorId(x.GoPointer())
@@ -484,7 +543,7 @@ func (x *Device) GetPropertyNumTouches() uint {
 // SetPropertyProductId sets the "product-id" property.
 // Product ID of this device.
 //
-// See [method@Gdk.Device.get_product_id].
+// See [Device.GetProductId].
 func (x *Device) SetPropertyProductId(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -495,7 +554,7 @@ func (x *Device) SetPropertyProductId(value string) {
 // GetPropertyProductId gets the "product-id" property.
 // Product ID of this device.
 //
-// See [method@Gdk.Device.get_product_id].
+// See [Device.GetProductId].
 func (x *Device) GetPropertyProductId() string {
 	var v gobject.Value
 	x.GetProperty("product-id", &v)
@@ -515,7 +574,7 @@ func (x *Device) GetPropertyScrollLockState() bool {
 // SetPropertyVendorId sets the "vendor-id" property.
 // Vendor ID of this device.
 //
-// See [method@Gdk.Device.get_vendor_id].
+// See [Device.GetVendorId].
 func (x *Device) SetPropertyVendorId(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -526,7 +585,7 @@ func (x *Device) SetPropertyVendorId(value string) {
 // GetPropertyVendorId gets the "vendor-id" property.
 // Vendor ID of this device.
 //
-// See [method@Gdk.Device.get_vendor_id].
+// See [Device.GetVendorId].
 func (x *Device) GetPropertyVendorId() string {
 	var v gobject.Value
 	x.GetProperty("vendor-id", &v)
@@ -540,51 +599,95 @@ func (x *Device) GetPropertyVendorId() string {
 // example, user switches from the USB mouse to a tablet); in
 // that case the logical device will change to reflect the axes
 // and keys on the new physical device.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Device) ConnectChanged(cb *func(Device)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Device{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDeviceChangedTrampoline uintptr
+
+func xDeviceChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Device))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Device{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Device) ConnectChangedFunc(cb func(Device)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xDeviceChangedTrampoline, xDeviceChangedNewTrampoline, cb)
 }
 
 // Emitted on pen/eraser devices whenever tools enter or leave proximity.
+//
+// Deprecated: use ConnectToolChangedFunc, which also accepts method values and closures.
 func (x *Device) ConnectToolChanged(cb *func(Device, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tool-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Device{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ToolVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tool-changed", cbRefPtr, cbPtr)
+}
+
+var xDeviceToolChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+func xDeviceToolChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ToolVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Device, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Device{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ToolVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tool-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToolChangedFunc connects cb to the "tool-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Device) ConnectToolChangedFunc(cb func(Device, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tool-changed", &xDeviceToolChangedTrampoline, xDeviceToolChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -592,7 +695,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -603,24 +706,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xDeviceGLibType, libs, "gdk_device_get_type")
 
-	core.PuregoSafeRegister(&xDeviceGetActiveLayoutIndex, libs, "gdk_device_get_active_layout_index")
-	core.PuregoSafeRegister(&xDeviceGetCapsLockState, libs, "gdk_device_get_caps_lock_state")
-	core.PuregoSafeRegister(&xDeviceGetDeviceTool, libs, "gdk_device_get_device_tool")
-	core.PuregoSafeRegister(&xDeviceGetDirection, libs, "gdk_device_get_direction")
-	core.PuregoSafeRegister(&xDeviceGetDisplay, libs, "gdk_device_get_display")
-	core.PuregoSafeRegister(&xDeviceGetHasCursor, libs, "gdk_device_get_has_cursor")
-	core.PuregoSafeRegister(&xDeviceGetLayoutNames, libs, "gdk_device_get_layout_names")
-	core.PuregoSafeRegister(&xDeviceGetModifierState, libs, "gdk_device_get_modifier_state")
-	core.PuregoSafeRegister(&xDeviceGetName, libs, "gdk_device_get_name")
-	core.PuregoSafeRegister(&xDeviceGetNumLockState, libs, "gdk_device_get_num_lock_state")
-	core.PuregoSafeRegister(&xDeviceGetNumTouches, libs, "gdk_device_get_num_touches")
-	core.PuregoSafeRegister(&xDeviceGetProductId, libs, "gdk_device_get_product_id")
-	core.PuregoSafeRegister(&xDeviceGetScrollLockState, libs, "gdk_device_get_scroll_lock_state")
-	core.PuregoSafeRegister(&xDeviceGetSeat, libs, "gdk_device_get_seat")
-	core.PuregoSafeRegister(&xDeviceGetSource, libs, "gdk_device_get_source")
-	core.PuregoSafeRegister(&xDeviceGetSurfaceAtPosition, libs, "gdk_device_get_surface_at_position")
-	core.PuregoSafeRegister(&xDeviceGetTimestamp, libs, "gdk_device_get_timestamp")
-	core.PuregoSafeRegister(&xDeviceGetVendorId, libs, "gdk_device_get_vendor_id")
-	core.PuregoSafeRegister(&xDeviceHasBidiLayouts, libs, "gdk_device_has_bidi_layouts")
+	core.PuregoRegisterSince(&xDeviceGetActiveLayoutIndex, libs, "gdk_device_get_active_layout_index", "GDK", "4.18")
+	core.PuregoRegisterSince(&xDeviceGetCapsLockState, libs, "gdk_device_get_caps_lock_state", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetDeviceTool, libs, "gdk_device_get_device_tool", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetDirection, libs, "gdk_device_get_direction", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetDisplay, libs, "gdk_device_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetHasCursor, libs, "gdk_device_get_has_cursor", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetLayoutNames, libs, "gdk_device_get_layout_names", "GDK", "4.18")
+	core.PuregoRegisterSince(&xDeviceGetModifierState, libs, "gdk_device_get_modifier_state", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetName, libs, "gdk_device_get_name", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetNumLockState, libs, "gdk_device_get_num_lock_state", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetNumTouches, libs, "gdk_device_get_num_touches", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetProductId, libs, "gdk_device_get_product_id", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetScrollLockState, libs, "gdk_device_get_scroll_lock_state", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetSeat, libs, "gdk_device_get_seat", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetSource, libs, "gdk_device_get_source", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetSurfaceAtPosition, libs, "gdk_device_get_surface_at_position", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceGetTimestamp, libs, "gdk_device_get_timestamp", "GDK", "4.2")
+	core.PuregoRegisterSince(&xDeviceGetVendorId, libs, "gdk_device_get_vendor_id", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceHasBidiLayouts, libs, "gdk_device_has_bidi_layouts", "GDK", "")
 
 }
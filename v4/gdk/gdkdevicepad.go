@@ -2,10 +2,10 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -23,16 +23,16 @@ func (x *DevicePadInterface) GoPointer() uintptr {
 // It allows querying the features provided by the pad device.
 //
 // Tablet pads may contain one or more groups, each containing a subset
-// of the buttons/rings/strips available. [method@Gdk.DevicePad.get_n_groups]
-// can be used to obtain the number of groups, [method@Gdk.DevicePad.get_n_features]
-// and [method@Gdk.DevicePad.get_feature_group] can be combined to find out
+// of the buttons/rings/strips available. [DevicePad.GetNGroups]
+// can be used to obtain the number of groups, [DevicePad.GetNFeatures]
+// and [DevicePad.GetFeatureGroup] can be combined to find out
 // the number of buttons/rings/strips the device has, and how are they grouped.
 //
 // Each of those groups have different modes, which may be used to map each
 // individual pad feature to multiple actions. Only one mode is effective
 // (current) for each given group, different groups may have different
 // current modes. The number of available modes in a group can be found
-// out through [method@Gdk.DevicePad.get_group_n_modes], and the current mode
+// out through [DevicePad.GetGroupNModes], and the current mode
 // for a given group will be notified through events of type `GDK_PAD_GROUP_MODE`.
 type DevicePad interface {
 	GoPointer() uintptr
@@ -64,16 +64,16 @@ func (x *DevicePadBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Returns the group the given @feature and @idx belong to.
+// Returns the group the given feature and idx belong to.
 //
-// f the feature or index do not exist in @pad, -1 is returned.
+// f the feature or index do not exist in pad, -1 is returned.
 func (x *DevicePadBase) GetFeatureGroup(FeatureVar DevicePadFeature, FeatureIdxVar int) int {
 
 	cret := XGdkDevicePadGetFeatureGroup(x.GoPointer(), FeatureVar, FeatureIdxVar)
 	return cret
 }
 
-// Returns the number of modes that @group may have.
+// Returns the number of modes that group may have.
 func (x *DevicePadBase) GetGroupNModes(GroupIdxVar int) int {
 
 	cret := XGdkDevicePadGetGroupNModes(x.GoPointer(), GroupIdxVar)
@@ -122,12 +122,51 @@ const (
 	DevicePadFeatureStripValue DevicePadFeature = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DevicePadFeature) String() string {
+	switch x {
+	case DevicePadFeatureButtonValue:
+		return "GDK_DEVICE_PAD_FEATURE_BUTTON"
+	case DevicePadFeatureRingValue:
+		return "GDK_DEVICE_PAD_FEATURE_RING"
+	case DevicePadFeatureStripValue:
+		return "GDK_DEVICE_PAD_FEATURE_STRIP"
+	}
+	return fmt.Sprintf("DevicePadFeature(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DevicePadFeature) Nick() string {
+	switch x {
+	case DevicePadFeatureButtonValue:
+		return "button"
+	case DevicePadFeatureRingValue:
+		return "ring"
+	case DevicePadFeatureStripValue:
+		return "strip"
+	}
+	return ""
+}
+
+// DevicePadFeatureFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DevicePadFeatureFromString(s string) (DevicePadFeature, bool) {
+	switch s {
+	case "GDK_DEVICE_PAD_FEATURE_BUTTON", "button":
+		return DevicePadFeatureButtonValue, true
+	case "GDK_DEVICE_PAD_FEATURE_RING", "ring":
+		return DevicePadFeatureRingValue, true
+	case "GDK_DEVICE_PAD_FEATURE_STRIP", "strip":
+		return DevicePadFeatureStripValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GDK", "gtk4")
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -138,9 +177,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xDevicePadGLibType, libs, "gdk_device_pad_get_type")
 
-	core.PuregoSafeRegister(&XGdkDevicePadGetFeatureGroup, libs, "gdk_device_pad_get_feature_group")
-	core.PuregoSafeRegister(&XGdkDevicePadGetGroupNModes, libs, "gdk_device_pad_get_group_n_modes")
-	core.PuregoSafeRegister(&XGdkDevicePadGetNFeatures, libs, "gdk_device_pad_get_n_features")
-	core.PuregoSafeRegister(&XGdkDevicePadGetNGroups, libs, "gdk_device_pad_get_n_groups")
+	core.PuregoRegisterSince(&XGdkDevicePadGetFeatureGroup, libs, "gdk_device_pad_get_feature_group", "GDK", "")
+	core.PuregoRegisterSince(&XGdkDevicePadGetGroupNModes, libs, "gdk_device_pad_get_group_n_modes", "GDK", "")
+	core.PuregoRegisterSince(&XGdkDevicePadGetNFeatures, libs, "gdk_device_pad_get_n_features", "GDK", "")
+	core.PuregoRegisterSince(&XGdkDevicePadGetNGroups, libs, "gdk_device_pad_get_n_groups", "GDK", "")
 
 }
@@ -2,7 +2,8 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -38,6 +39,75 @@ const (
 	DeviceToolTypeLensValue DeviceToolType = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DeviceToolType) String() string {
+	switch x {
+	case DeviceToolTypeUnknownValue:
+		return "GDK_DEVICE_TOOL_TYPE_UNKNOWN"
+	case DeviceToolTypePenValue:
+		return "GDK_DEVICE_TOOL_TYPE_PEN"
+	case DeviceToolTypeEraserValue:
+		return "GDK_DEVICE_TOOL_TYPE_ERASER"
+	case DeviceToolTypeBrushValue:
+		return "GDK_DEVICE_TOOL_TYPE_BRUSH"
+	case DeviceToolTypePencilValue:
+		return "GDK_DEVICE_TOOL_TYPE_PENCIL"
+	case DeviceToolTypeAirbrushValue:
+		return "GDK_DEVICE_TOOL_TYPE_AIRBRUSH"
+	case DeviceToolTypeMouseValue:
+		return "GDK_DEVICE_TOOL_TYPE_MOUSE"
+	case DeviceToolTypeLensValue:
+		return "GDK_DEVICE_TOOL_TYPE_LENS"
+	}
+	return fmt.Sprintf("DeviceToolType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DeviceToolType) Nick() string {
+	switch x {
+	case DeviceToolTypeUnknownValue:
+		return "unknown"
+	case DeviceToolTypePenValue:
+		return "pen"
+	case DeviceToolTypeEraserValue:
+		return "eraser"
+	case DeviceToolTypeBrushValue:
+		return "brush"
+	case DeviceToolTypePencilValue:
+		return "pencil"
+	case DeviceToolTypeAirbrushValue:
+		return "airbrush"
+	case DeviceToolTypeMouseValue:
+		return "mouse"
+	case DeviceToolTypeLensValue:
+		return "lens"
+	}
+	return ""
+}
+
+// DeviceToolTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DeviceToolTypeFromString(s string) (DeviceToolType, bool) {
+	switch s {
+	case "GDK_DEVICE_TOOL_TYPE_UNKNOWN", "unknown":
+		return DeviceToolTypeUnknownValue, true
+	case "GDK_DEVICE_TOOL_TYPE_PEN", "pen":
+		return DeviceToolTypePenValue, true
+	case "GDK_DEVICE_TOOL_TYPE_ERASER", "eraser":
+		return DeviceToolTypeEraserValue, true
+	case "GDK_DEVICE_TOOL_TYPE_BRUSH", "brush":
+		return DeviceToolTypeBrushValue, true
+	case "GDK_DEVICE_TOOL_TYPE_PENCIL", "pencil":
+		return DeviceToolTypePencilValue, true
+	case "GDK_DEVICE_TOOL_TYPE_AIRBRUSH", "airbrush":
+		return DeviceToolTypeAirbrushValue, true
+	case "GDK_DEVICE_TOOL_TYPE_MOUSE", "mouse":
+		return DeviceToolTypeMouseValue, true
+	case "GDK_DEVICE_TOOL_TYPE_LENS", "lens":
+		return DeviceToolTypeLensValue, true
+	}
+	return 0, false
+}
+
 // A physical tool associated to a `GdkDevice`.
 type DeviceTool struct {
 	gobject.Object
@@ -69,12 +139,12 @@ var xDeviceToolGetHardwareId func(uintptr) uint64
 // Gets the hardware ID of this tool, or 0 if it's not known.
 //
 // When non-zero, the identifier is unique for the given tool model,
-// meaning that two identical tools will share the same @hardware_id,
+// meaning that two identical tools will share the same hardware_id,
 // but will have different serial numbers (see
-// [method@Gdk.DeviceTool.get_serial]).
+// [DeviceTool.GetSerial]).
 //
 // This is a more concrete (and device specific) method to identify
-// a `GdkDeviceTool` than [method@Gdk.DeviceTool.get_tool_type],
+// a `GdkDeviceTool` than [DeviceTool.GetToolType],
 // as a tablet may support multiple devices with the same
 // `GdkDeviceToolType`, but different hardware identifiers.
 func (x *DeviceTool) GetHardwareId() uint64 {
@@ -154,7 +224,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -165,9 +235,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xDeviceToolGLibType, libs, "gdk_device_tool_get_type")
 
-	core.PuregoSafeRegister(&xDeviceToolGetAxes, libs, "gdk_device_tool_get_axes")
-	core.PuregoSafeRegister(&xDeviceToolGetHardwareId, libs, "gdk_device_tool_get_hardware_id")
-	core.PuregoSafeRegister(&xDeviceToolGetSerial, libs, "gdk_device_tool_get_serial")
-	core.PuregoSafeRegister(&xDeviceToolGetToolType, libs, "gdk_device_tool_get_tool_type")
+	core.PuregoRegisterSince(&xDeviceToolGetAxes, libs, "gdk_device_tool_get_axes", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceToolGetHardwareId, libs, "gdk_device_tool_get_hardware_id", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceToolGetSerial, libs, "gdk_device_tool_get_serial", "GDK", "")
+	core.PuregoRegisterSince(&xDeviceToolGetToolType, libs, "gdk_device_tool_get_tool_type", "GDK", "")
 
 }
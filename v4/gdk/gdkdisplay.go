@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -20,12 +19,12 @@ import (
 // - To manage and provide information about output devices (monitors, projectors, etc)
 //
 // Most of the input device handling has been factored out into separate
-// [class@Gdk.Seat] objects. Every display has a one or more seats, which
-// can be accessed with [method@Gdk.Display.get_default_seat] and
-// [method@Gdk.Display.list_seats].
+// [Seat] objects. Every display has a one or more seats, which
+// can be accessed with [Display.GetDefaultSeat] and
+// [Display.ListSeats].
 //
-// Output devices are represented by [class@Gdk.Monitor] objects, which can
-// be accessed with [method@Gdk.Display.get_monitor_at_surface] and similar APIs.
+// Output devices are represented by [Monitor] objects, which can
+// be accessed with [Display.GetMonitorAtSurface] and similar APIs.
 type Display struct {
 	gobject.Object
 }
@@ -44,7 +43,7 @@ func DisplayNewFromInternalPtr(ptr uintptr) *Display {
 
 var xDisplayBeep func(uintptr)
 
-// Emits a short beep on @display
+// Emits a short beep on display
 func (x *Display) Beep() {
 
 	xDisplayBeep(x.GoPointer())
@@ -70,9 +69,9 @@ var xDisplayCreateGlContext func(uintptr) uintptr
 // and cannot be used to draw to any surface. It can only be used to
 // draw to non-surface framebuffers like textures.
 //
-// If the creation of the `GdkGLContext` failed, @error will be set.
+// If the creation of the `GdkGLContext` failed, error will be set.
 // Before using the returned `GdkGLContext`, you will need to
-// call [method@Gdk.GLContext.make_current] or [method@Gdk.GLContext.realize].
+// call [GLContext.MakeCurrent] or [GLContext.Realize].
 func (x *Display) CreateGlContext() (*GLContext, error) {
 	var cls *GLContext
 	var cerr *glib.Error
@@ -80,12 +79,15 @@ func (x *Display) CreateGlContext() (*GLContext, error) {
 	cret := xDisplayCreateGlContext(x.GoPointer())
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &GLContext{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -93,7 +95,7 @@ func (x *Display) CreateGlContext() (*GLContext, error) {
 
 var xDisplayDeviceIsGrabbed func(uintptr, uintptr) bool
 
-// Returns %TRUE if there is an ongoing grab on @device for @display.
+// Returns true if there is an ongoing grab on device for display.
 func (x *Display) DeviceIsGrabbed(DeviceVar *Device) bool {
 
 	cret := xDisplayDeviceIsGrabbed(x.GoPointer(), DeviceVar.GoPointer())
@@ -157,7 +159,7 @@ var xDisplayGetDefaultSeat func(uintptr) uintptr
 // Returns the default `GdkSeat` for this display.
 //
 // Note that a display may not have a seat. In this case,
-// this function will return %NULL.
+// this function will return nil.
 func (x *Display) GetDefaultSeat() *Seat {
 	var cls *Seat
 
@@ -182,7 +184,7 @@ var xDisplayGetDmabufFormats func(uintptr) *DmabufFormats
 // The formats returned by this function can be used for negotiating
 // buffer formats with producers such as v4l, pipewire or GStreamer.
 //
-// To learn more about dma-bufs, see [class@Gdk.DmabufTextureBuilder].
+// To learn more about dma-bufs, see [DmabufTextureBuilder].
 //
 // This function is threadsafe. It can be called from any thread.
 func (x *Display) GetDmabufFormats() *DmabufFormats {
@@ -193,7 +195,7 @@ func (x *Display) GetDmabufFormats() *DmabufFormats {
 
 var xDisplayGetMonitorAtSurface func(uintptr, uintptr) uintptr
 
-// Gets the monitor in which the largest area of @surface
+// Gets the monitor in which the largest area of surface
 // resides.
 func (x *Display) GetMonitorAtSurface(SurfaceVar *Surface) *Monitor {
 	var cls *Monitor
@@ -264,23 +266,13 @@ func (x *Display) GetPrimaryClipboard() *Clipboard {
 var xDisplayGetSetting func(uintptr, string, *gobject.Value) bool
 
 // Retrieves a desktop-wide setting such as double-click time
-// for the @display.
+// for the display.
 func (x *Display) GetSetting(NameVar string, ValueVar *gobject.Value) bool {
 
 	cret := xDisplayGetSetting(x.GoPointer(), NameVar, ValueVar)
 	return cret
 }
 
-var xDisplayGetStartupNotificationId func(uintptr) string
-
-// Gets the startup notification ID for a Wayland display, or %NULL
-// if no ID has been defined.
-func (x *Display) GetStartupNotificationId() string {
-
-	cret := xDisplayGetStartupNotificationId(x.GoPointer())
-	return cret
-}
-
 var xDisplayIsClosed func(uintptr) bool
 
 // Finds out if the display has been closed.
@@ -295,13 +287,13 @@ var xDisplayIsComposited func(uintptr) bool
 // Returns whether surfaces can reasonably be expected to have
 // their alpha channel drawn correctly on the screen.
 //
-// Check [method@Gdk.Display.is_rgba] for whether the display
+// Check [Display.IsRgba] for whether the display
 // supports an alpha channel.
 //
 // On X11 this function returns whether a compositing manager is
-// compositing on @display.
+// compositing on display.
 //
-// On modern displays, this value is always %TRUE.
+// On modern displays, this value is always true.
 func (x *Display) IsComposited() bool {
 
 	cret := xDisplayIsComposited(x.GoPointer())
@@ -310,17 +302,17 @@ func (x *Display) IsComposited() bool {
 
 var xDisplayIsRgba func(uintptr) bool
 
-// Returns whether surfaces on this @display are created with an
+// Returns whether surfaces on this display are created with an
 // alpha channel.
 //
-// Even if a %TRUE is returned, it is possible that the
+// Even if a true is returned, it is possible that the
 // surface’s alpha channel won’t be honored when displaying the
 // surface on the screen: in particular, for X an appropriate
 // windowing manager and compositing manager must be running to
-// provide appropriate display. Use [method@Gdk.Display.is_composited]
+// provide appropriate display. Use [Display.IsComposited]
 // to check if that is the case.
 //
-// On modern displays, this value is always %TRUE.
+// On modern displays, this value is always true.
 func (x *Display) IsRgba() bool {
 
 	cret := xDisplayIsRgba(x.GoPointer())
@@ -329,7 +321,7 @@ func (x *Display) IsRgba() bool {
 
 var xDisplayListSeats func(uintptr) *glib.List
 
-// Returns the list of seats known to @display.
+// Returns the list of seats known to display.
 func (x *Display) ListSeats() *glib.List {
 
 	cret := xDisplayListSeats(x.GoPointer())
@@ -338,15 +330,15 @@ func (x *Display) ListSeats() *glib.List {
 
 var xDisplayMapKeycode func(uintptr, uint, *uintptr, *[]uint, *int) bool
 
-// Returns the keyvals bound to @keycode.
+// Returns the keyvals bound to keycode.
 //
-// The Nth `GdkKeymapKey` in @keys is bound to the Nth keyval in @keyvals.
+// The Nth `GdkKeymapKey` in keys is bound to the Nth keyval in keyvals.
 //
 // When a keycode is pressed by the user, the keyval from
 // this list of entries is selected by considering the effective
 // keyboard group and level.
 //
-// Free the returned arrays with g_free().
+// Free the returned arrays with [glib.Free].
 func (x *Display) MapKeycode(KeycodeVar uint, KeysVar *uintptr, KeyvalsVar *[]uint, NEntriesVar *int) bool {
 
 	cret := xDisplayMapKeycode(x.GoPointer(), KeycodeVar, KeysVar, KeyvalsVar, NEntriesVar)
@@ -356,7 +348,7 @@ func (x *Display) MapKeycode(KeycodeVar uint, KeysVar *uintptr, KeyvalsVar *[]ui
 var xDisplayMapKeyval func(uintptr, uint, *uintptr, *int) bool
 
 // Obtains a list of keycode/group/level combinations that will
-// generate @keyval.
+// generate keyval.
 //
 // Groups and levels are two kinds of keyboard mode; in general, the level
 // determines whether the top or bottom symbol on a key is used, and the
@@ -369,34 +361,19 @@ var xDisplayMapKeyval func(uintptr, uint, *uintptr, *int) bool
 // `GdkEventKey` contains a %group field that indicates the active
 // keyboard group. The level is computed from the modifier mask.
 //
-// The returned array should be freed with g_free().
+// The returned array should be freed with [glib.Free].
 func (x *Display) MapKeyval(KeyvalVar uint, KeysVar *uintptr, NKeysVar *int) bool {
 
 	cret := xDisplayMapKeyval(x.GoPointer(), KeyvalVar, KeysVar, NKeysVar)
 	return cret
 }
 
-var xDisplayNotifyStartupComplete func(uintptr, string)
-
-// Indicates to the GUI environment that the application has
-// finished loading, using a given identifier.
-//
-// GTK will call this function automatically for [GtkWindow](../gtk4/class.Window.html)
-// with custom startup-notification identifier unless
-// [gtk_window_set_auto_startup_notification()](../gtk4/method.Window.set_auto_startup_notification.html)
-// is called to disable that feature.
-func (x *Display) NotifyStartupComplete(StartupIdVar string) {
-
-	xDisplayNotifyStartupComplete(x.GoPointer(), StartupIdVar)
-
-}
-
 var xDisplayPrepareGl func(uintptr) bool
 
-// Checks that OpenGL is available for @self and ensures that it is
+// Checks that OpenGL is available for self and ensures that it is
 // properly initialized.
-// When this fails, an @error will be set describing the error and this
-// function returns %FALSE.
+// When this fails, an error will be set describing the error and this
+// function returns false.
 //
 // Note that even if this function succeeds, creating a `GdkGLContext`
 // may still fail.
@@ -412,29 +389,20 @@ func (x *Display) PrepareGl() (bool, error) {
 
 	cret := xDisplayPrepareGl(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xDisplayPutEvent func(uintptr, uintptr)
-
-// Adds the given event to the event queue for @display.
-func (x *Display) PutEvent(EventVar *Event) {
-
-	xDisplayPutEvent(x.GoPointer(), EventVar.GoPointer())
-
-}
-
 var xDisplaySupportsInputShapes func(uintptr) bool
 
-// Returns %TRUE if the display supports input shapes.
+// Returns true if the display supports input shapes.
 //
-// This means that [method@Gdk.Surface.set_input_region] can
-// be used to modify the input shape of surfaces on @display.
+// This means that [Surface.SetInputRegion] can
+// be used to modify the input shape of surfaces on display.
 //
-// On modern displays, this value is always %TRUE.
+// On modern displays, this value is always true.
 func (x *Display) SupportsInputShapes() bool {
 
 	cret := xDisplaySupportsInputShapes(x.GoPointer())
@@ -445,8 +413,8 @@ var xDisplaySupportsShadowWidth func(uintptr) bool
 
 // Returns whether it's possible for a surface to draw outside of the window area.
 //
-// If %TRUE is returned the application decides if it wants to draw shadows.
-// If %FALSE is returned, the compositor decides if it wants to draw shadows.
+// If true is returned the application decides if it wants to draw shadows.
+// If false is returned, the compositor decides if it wants to draw shadows.
 func (x *Display) SupportsShadowWidth() bool {
 
 	cret := xDisplaySupportsShadowWidth(x.GoPointer())
@@ -459,8 +427,8 @@ var xDisplaySync func(uintptr)
 // requests have been handled.
 //
 // This is often used for making sure that the display is synchronized
-// with the current state of the program. Calling [method@Gdk.Display.sync]
-// before [method@GdkX11.Display.error_trap_pop] makes sure that any errors
+// with the current state of the program. Calling [Display.Sync]
+// before Display.error_trap_pop makes sure that any errors
 // generated from earlier requests are handled before the error trap is removed.
 //
 // This is most useful for X11. On windowing systems where requests are
@@ -477,16 +445,16 @@ var xDisplayTranslateKey func(uintptr, uint, ModifierType, int, *uint, *int, *in
 // and level.
 //
 // Modifiers that affected the translation and are thus unavailable for
-// application use are returned in @consumed_modifiers.
+// application use are returned in consumed_modifiers.
 //
-// The @effective_group is the group that was actually used for the
+// The effective_group is the group that was actually used for the
 // translation; some keys such as Enter are not affected by the active
-// keyboard group. The @level is derived from @state.
+// keyboard group. The level is derived from state.
 //
-// @consumed_modifiers gives modifiers that should be masked out
-// from @state when comparing this key press to a keyboard shortcut.
+// consumed_modifiers gives modifiers that should be masked out
+// from state when comparing this key press to a keyboard shortcut.
 // For instance, on a US keyboard, the `plus` symbol is shifted, so
-// when comparing a key press to a `&lt;Control&gt;plus` accelerator `&lt;Shift&gt;`
+// when comparing a key press to a `<Control>plus` accelerator `<Shift>`
 // should be masked out.
 //
 // This function should rarely be needed, since `GdkEventKey` already
@@ -510,7 +478,7 @@ func (c *Display) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyComposited gets the "composited" property.
-// %TRUE if the display properly composites the alpha channel.
+// true if the display properly composites the alpha channel.
 func (x *Display) GetPropertyComposited() bool {
 	var v gobject.Value
 	x.GetProperty("composited", &v)
@@ -526,7 +494,7 @@ func (x *Display) GetPropertyDmabufFormats() uintptr {
 }
 
 // GetPropertyInputShapes gets the "input-shapes" property.
-// %TRUE if the display supports input shapes.
+// true if the display supports input shapes.
 func (x *Display) GetPropertyInputShapes() bool {
 	var v gobject.Value
 	x.GetProperty("input-shapes", &v)
@@ -534,7 +502,7 @@ func (x *Display) GetPropertyInputShapes() bool {
 }
 
 // GetPropertyRgba gets the "rgba" property.
-// %TRUE if the display supports an alpha channel.
+// true if the display supports an alpha channel.
 func (x *Display) GetPropertyRgba() bool {
 	var v gobject.Value
 	x.GetProperty("rgba", &v)
@@ -542,131 +510,241 @@ func (x *Display) GetPropertyRgba() bool {
 }
 
 // GetPropertyShadowWidth gets the "shadow-width" property.
-// %TRUE if the display supports extensible frames.
+// true if the display supports extensible frames.
 func (x *Display) GetPropertyShadowWidth() bool {
 	var v gobject.Value
 	x.GetProperty("shadow-width", &v)
 	return v.GetBoolean()
 }
 
-// Emitted when the connection to the windowing system for @display is closed.
+// Emitted when the connection to the windowing system for display is closed.
+//
+// Deprecated: use ConnectClosedFunc, which also accepts method values and closures.
 func (x *Display) ConnectClosed(cb *func(Display, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, IsErrorVarp bool) {
+			defer core.RecoverPanic()
+			fa := Display{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, IsErrorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "closed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, IsErrorVarp bool) {
+var xDisplayClosedTrampoline uintptr
+
+func xDisplayClosedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, IsErrorVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Display, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Display{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, IsErrorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the connection to the windowing system for @display is opened.
+// ConnectClosedFunc connects cb to the "closed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Display) ConnectClosedFunc(cb func(Display, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "closed", &xDisplayClosedTrampoline, xDisplayClosedNewTrampoline, cb)
+}
+
+// Emitted when the connection to the windowing system for display is opened.
+//
+// Deprecated: use ConnectOpenedFunc, which also accepts method values and closures.
 func (x *Display) ConnectOpened(cb *func(Display)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "opened", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Display{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "opened", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDisplayOpenedTrampoline uintptr
+
+func xDisplayOpenedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Display))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Display{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "opened", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectOpenedFunc connects cb to the "opened" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Display) ConnectOpenedFunc(cb func(Display)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "opened", &xDisplayOpenedTrampoline, xDisplayOpenedNewTrampoline, cb)
 }
 
 // Emitted whenever a new seat is made known to the windowing system.
+//
+// Deprecated: use ConnectSeatAddedFunc, which also accepts method values and closures.
 func (x *Display) ConnectSeatAdded(cb *func(Display, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "seat-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SeatVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Display{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SeatVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "seat-added", cbRefPtr, cbPtr)
+}
+
+var xDisplaySeatAddedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, SeatVarp uintptr) {
+func xDisplaySeatAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SeatVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Display, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Display{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SeatVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "seat-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSeatAddedFunc connects cb to the "seat-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Display) ConnectSeatAddedFunc(cb func(Display, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "seat-added", &xDisplaySeatAddedTrampoline, xDisplaySeatAddedNewTrampoline, cb)
 }
 
 // Emitted whenever a seat is removed by the windowing system.
+//
+// Deprecated: use ConnectSeatRemovedFunc, which also accepts method values and closures.
 func (x *Display) ConnectSeatRemoved(cb *func(Display, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "seat-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SeatVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Display{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SeatVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "seat-removed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, SeatVarp uintptr) {
+var xDisplaySeatRemovedTrampoline uintptr
+
+func xDisplaySeatRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SeatVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Display, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Display{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SeatVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "seat-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSeatRemovedFunc connects cb to the "seat-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Display) ConnectSeatRemovedFunc(cb func(Display, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "seat-removed", &xDisplaySeatRemovedTrampoline, xDisplaySeatRemovedNewTrampoline, cb)
 }
 
 // Emitted whenever a setting changes its value.
+//
+// Deprecated: use ConnectSettingChangedFunc, which also accepts method values and closures.
 func (x *Display) ConnectSettingChanged(cb *func(Display, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "setting-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SettingVarp string) {
+			defer core.RecoverPanic()
+			fa := Display{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SettingVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "setting-changed", cbRefPtr, cbPtr)
+}
+
+var xDisplaySettingChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, SettingVarp string) {
+func xDisplaySettingChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SettingVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Display, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Display{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SettingVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "setting-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSettingChangedFunc connects cb to the "setting-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Display) ConnectSettingChangedFunc(cb func(Display, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "setting-changed", &xDisplaySettingChangedTrampoline, xDisplaySettingChangedNewTrampoline, cb)
 }
 
 var xDisplayGetDefault func() uintptr
@@ -717,7 +795,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -726,36 +804,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xDisplayGLibType, libs, "gdk_display_get_type")
 
-	core.PuregoSafeRegister(&xDisplayBeep, libs, "gdk_display_beep")
-	core.PuregoSafeRegister(&xDisplayClose, libs, "gdk_display_close")
-	core.PuregoSafeRegister(&xDisplayCreateGlContext, libs, "gdk_display_create_gl_context")
-	core.PuregoSafeRegister(&xDisplayDeviceIsGrabbed, libs, "gdk_display_device_is_grabbed")
-	core.PuregoSafeRegister(&xDisplayFlush, libs, "gdk_display_flush")
-	core.PuregoSafeRegister(&xDisplayGetAppLaunchContext, libs, "gdk_display_get_app_launch_context")
-	core.PuregoSafeRegister(&xDisplayGetClipboard, libs, "gdk_display_get_clipboard")
-	core.PuregoSafeRegister(&xDisplayGetDefaultSeat, libs, "gdk_display_get_default_seat")
-	core.PuregoSafeRegister(&xDisplayGetDmabufFormats, libs, "gdk_display_get_dmabuf_formats")
-	core.PuregoSafeRegister(&xDisplayGetMonitorAtSurface, libs, "gdk_display_get_monitor_at_surface")
-	core.PuregoSafeRegister(&xDisplayGetMonitors, libs, "gdk_display_get_monitors")
-	core.PuregoSafeRegister(&xDisplayGetName, libs, "gdk_display_get_name")
-	core.PuregoSafeRegister(&xDisplayGetPrimaryClipboard, libs, "gdk_display_get_primary_clipboard")
-	core.PuregoSafeRegister(&xDisplayGetSetting, libs, "gdk_display_get_setting")
-	core.PuregoSafeRegister(&xDisplayGetStartupNotificationId, libs, "gdk_display_get_startup_notification_id")
-	core.PuregoSafeRegister(&xDisplayIsClosed, libs, "gdk_display_is_closed")
-	core.PuregoSafeRegister(&xDisplayIsComposited, libs, "gdk_display_is_composited")
-	core.PuregoSafeRegister(&xDisplayIsRgba, libs, "gdk_display_is_rgba")
-	core.PuregoSafeRegister(&xDisplayListSeats, libs, "gdk_display_list_seats")
-	core.PuregoSafeRegister(&xDisplayMapKeycode, libs, "gdk_display_map_keycode")
-	core.PuregoSafeRegister(&xDisplayMapKeyval, libs, "gdk_display_map_keyval")
-	core.PuregoSafeRegister(&xDisplayNotifyStartupComplete, libs, "gdk_display_notify_startup_complete")
-	core.PuregoSafeRegister(&xDisplayPrepareGl, libs, "gdk_display_prepare_gl")
-	core.PuregoSafeRegister(&xDisplayPutEvent, libs, "gdk_display_put_event")
-	core.PuregoSafeRegister(&xDisplaySupportsInputShapes, libs, "gdk_display_supports_input_shapes")
-	core.PuregoSafeRegister(&xDisplaySupportsShadowWidth, libs, "gdk_display_supports_shadow_width")
-	core.PuregoSafeRegister(&xDisplaySync, libs, "gdk_display_sync")
-	core.PuregoSafeRegister(&xDisplayTranslateKey, libs, "gdk_display_translate_key")
-
-	core.PuregoSafeRegister(&xDisplayGetDefault, libs, "gdk_display_get_default")
-	core.PuregoSafeRegister(&xDisplayOpen, libs, "gdk_display_open")
+	core.PuregoRegisterSince(&xDisplayBeep, libs, "gdk_display_beep", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayClose, libs, "gdk_display_close", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayCreateGlContext, libs, "gdk_display_create_gl_context", "GDK", "4.6")
+	core.PuregoRegisterSince(&xDisplayDeviceIsGrabbed, libs, "gdk_display_device_is_grabbed", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayFlush, libs, "gdk_display_flush", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetAppLaunchContext, libs, "gdk_display_get_app_launch_context", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetClipboard, libs, "gdk_display_get_clipboard", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetDefaultSeat, libs, "gdk_display_get_default_seat", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetDmabufFormats, libs, "gdk_display_get_dmabuf_formats", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDisplayGetMonitorAtSurface, libs, "gdk_display_get_monitor_at_surface", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetMonitors, libs, "gdk_display_get_monitors", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetName, libs, "gdk_display_get_name", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetPrimaryClipboard, libs, "gdk_display_get_primary_clipboard", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayGetSetting, libs, "gdk_display_get_setting", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayIsClosed, libs, "gdk_display_is_closed", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayIsComposited, libs, "gdk_display_is_composited", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayIsRgba, libs, "gdk_display_is_rgba", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayListSeats, libs, "gdk_display_list_seats", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayMapKeycode, libs, "gdk_display_map_keycode", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayMapKeyval, libs, "gdk_display_map_keyval", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayPrepareGl, libs, "gdk_display_prepare_gl", "GDK", "4.4")
+	core.PuregoRegisterSince(&xDisplaySupportsInputShapes, libs, "gdk_display_supports_input_shapes", "GDK", "")
+	core.PuregoRegisterSince(&xDisplaySupportsShadowWidth, libs, "gdk_display_supports_shadow_width", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDisplaySync, libs, "gdk_display_sync", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayTranslateKey, libs, "gdk_display_translate_key", "GDK", "")
+
+	core.PuregoRegisterSince(&xDisplayGetDefault, libs, "gdk_display_get_default", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayOpen, libs, "gdk_display_open", "GDK", "")
 
 }
@@ -0,0 +1,67 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xDisplayGetStartupNotificationId func(uintptr) string
+
+// Gets the startup notification ID for a Wayland display, or nil
+// if no ID has been defined.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Display) GetStartupNotificationId() string {
+
+	cret := xDisplayGetStartupNotificationId(x.GoPointer())
+	return cret
+}
+
+var xDisplayNotifyStartupComplete func(uintptr, string)
+
+// Indicates to the GUI environment that the application has
+// finished loading, using a given identifier.
+//
+// GTK will call this function automatically for GtkWindow
+// with custom startup-notification identifier unless
+// [[gtk.WindowSetAutoStartupNotification]](../gtk4/method.Window.set_auto_startup_notification.html)
+// is called to disable that feature.
+//
+// Deprecated: since 4.10. Using [Toplevel.SetStartupId] is sufficient
+func (x *Display) NotifyStartupComplete(StartupIdVar string) {
+
+	xDisplayNotifyStartupComplete(x.GoPointer(), StartupIdVar)
+
+}
+
+var xDisplayPutEvent func(uintptr, uintptr)
+
+// Adds the given event to the event queue for display.
+//
+// Deprecated: since 4.10. This function is only useful in very
+// special situations and should not be used by applications.
+func (x *Display) PutEvent(EventVar *Event) {
+
+	xDisplayPutEvent(x.GoPointer(), EventVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xDisplayGetStartupNotificationId, libs, "gdk_display_get_startup_notification_id", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayNotifyStartupComplete, libs, "gdk_display_notify_startup_complete", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayPutEvent, libs, "gdk_display_put_event", "GDK", "")
+
+}
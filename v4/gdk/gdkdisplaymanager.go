@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,9 +21,7 @@ var xSetAllowedBackends func(string)
 //
 // For example:
 //
-// ```c
-// gdk_set_allowed_backends ("wayland,macos,*");
-// ```
+//	gdk_set_allowed_backends ("wayland,macos,*");
 //
 // instructs GDK to try the Wayland backend first, followed by the
 // MacOs backend, and then all others.
@@ -44,7 +41,7 @@ var xSetAllowedBackends func(string)
 // You can also include a `*` in the list to try all remaining backends.
 //
 // This call must happen prior to functions that open a display, such
-// as [func@Gdk.Display.open], `gtk_init()`, or `gtk_init_check()`
+// as [DisplayOpen], `[gtk.Init]`, or `[gtk.InitCheck]`
 // in order to take effect.
 func SetAllowedBackends(BackendsVar string) {
 
@@ -56,7 +53,7 @@ func SetAllowedBackends(BackendsVar string) {
 //
 // `GdkDisplayManager` is a singleton object.
 //
-// You can use [func@Gdk.DisplayManager.get] to obtain the `GdkDisplayManager`
+// You can use [DisplayManagerGet] to obtain the `GdkDisplayManager`
 // singleton, but that should be rarely necessary. Typically, initializing
 // GTK opens a display that you can work with without ever accessing the
 // `GdkDisplayManager`.
@@ -66,10 +63,10 @@ func SetAllowedBackends(BackendsVar string) {
 // at runtime.
 //
 // In the rare case that you need to influence which of the backends
-// is being used, you can use [func@Gdk.set_allowed_backends]. Note
+// is being used, you can use [SetAllowedBackends]. Note
 // that you need to call this function before initializing GTK.
 //
-// ## Backend-specific code
+// # Backend-specific code
 //
 // When writing backend-specific code that is supposed to work with
 // multiple GDK backends, you have to consider both compile time and
@@ -78,29 +75,21 @@ func SetAllowedBackends(BackendsVar string) {
 // you are building your application against. At runtime, use type-check
 // macros like GDK_IS_X11_DISPLAY() to find out which backend is in use:
 //
-// ```c
-// #ifdef GDK_WINDOWING_X11
-//
-//	if (GDK_IS_X11_DISPLAY (display))
-//	  {
-//	    // make X11-specific calls here
-//	  }
-//	else
-//
-// #endif
-// #ifdef GDK_WINDOWING_MACOS
-//
-//	if (GDK_IS_MACOS_DISPLAY (display))
-//	  {
-//	    // make Quartz-specific calls here
-//	  }
-//	else
-//
-// #endif
-//
-//	g_error ("Unsupported GDK backend");
-//
-// ```
+//	#ifdef GDK_WINDOWING_X11
+//	  if (GDK_IS_X11_DISPLAY (display))
+//	    {
+//	      // make X11-specific calls here
+//	    }
+//	  else
+//	#endif
+//	#ifdef GDK_WINDOWING_MACOS
+//	  if (GDK_IS_MACOS_DISPLAY (display))
+//	    {
+//	      // make Quartz-specific calls here
+//	    }
+//	  else
+//	#endif
+//	  g_error ("Unsupported GDK backend");
 type DisplayManager struct {
 	gobject.Object
 }
@@ -165,7 +154,7 @@ func (x *DisplayManager) OpenDisplay(NameVar *string) *Display {
 
 var xDisplayManagerSetDefaultDisplay func(uintptr, uintptr)
 
-// Sets @display as the default display.
+// Sets display as the default display.
 func (x *DisplayManager) SetDefaultDisplay(DisplayVar *Display) {
 
 	xDisplayManagerSetDefaultDisplay(x.GoPointer(), DisplayVar.GoPointer())
@@ -184,27 +173,49 @@ func (c *DisplayManager) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when a display is opened.
+//
+// Deprecated: use ConnectDisplayOpenedFunc, which also accepts method values and closures.
 func (x *DisplayManager) ConnectDisplayOpened(cb *func(DisplayManager, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "display-opened", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DisplayVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DisplayManager{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DisplayVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "display-opened", cbRefPtr, cbPtr)
+}
+
+var xDisplayManagerDisplayOpenedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DisplayVarp uintptr) {
+func xDisplayManagerDisplayOpenedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DisplayVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DisplayManager, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DisplayManager{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DisplayVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "display-opened", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDisplayOpenedFunc connects cb to the "display-opened" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DisplayManager) ConnectDisplayOpenedFunc(cb func(DisplayManager, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "display-opened", &xDisplayManagerDisplayOpenedTrampoline, xDisplayManagerDisplayOpenedNewTrampoline, cb)
 }
 
 var xDisplayManagerGet func() uintptr
@@ -216,7 +227,7 @@ var xDisplayManagerGet func() uintptr
 // supported GDK backends to use (in case GDK has been compiled
 // with multiple backends).
 //
-// Applications can use [func@set_allowed_backends] to limit what
+// Applications can use set_allowed_backends to limit what
 // backends will be used.
 func DisplayManagerGet() *DisplayManager {
 	var cls *DisplayManager
@@ -237,22 +248,22 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSetAllowedBackends, libs, "gdk_set_allowed_backends")
+	core.PuregoRegisterSince(&xSetAllowedBackends, libs, "gdk_set_allowed_backends", "GDK", "")
 
 	core.PuregoSafeRegister(&xDisplayManagerGLibType, libs, "gdk_display_manager_get_type")
 
-	core.PuregoSafeRegister(&xDisplayManagerGetDefaultDisplay, libs, "gdk_display_manager_get_default_display")
-	core.PuregoSafeRegister(&xDisplayManagerListDisplays, libs, "gdk_display_manager_list_displays")
-	core.PuregoSafeRegister(&xDisplayManagerOpenDisplay, libs, "gdk_display_manager_open_display")
-	core.PuregoSafeRegister(&xDisplayManagerSetDefaultDisplay, libs, "gdk_display_manager_set_default_display")
+	core.PuregoRegisterSince(&xDisplayManagerGetDefaultDisplay, libs, "gdk_display_manager_get_default_display", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayManagerListDisplays, libs, "gdk_display_manager_list_displays", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayManagerOpenDisplay, libs, "gdk_display_manager_open_display", "GDK", "")
+	core.PuregoRegisterSince(&xDisplayManagerSetDefaultDisplay, libs, "gdk_display_manager_set_default_display", "GDK", "")
 
-	core.PuregoSafeRegister(&xDisplayManagerGet, libs, "gdk_display_manager_get")
+	core.PuregoRegisterSince(&xDisplayManagerGet, libs, "gdk_display_manager_get", "GDK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -21,7 +20,7 @@ func (x *DmabufTextureBuilderClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Constructs [class@Gdk.Texture] objects from DMA buffers.
+// Constructs [Texture] objects from DMA buffers.
 //
 // DMA buffers are commonly called **_dma-bufs_**.
 //
@@ -58,7 +57,7 @@ func (x *DmabufTextureBuilderClass) GoPointer() uintptr {
 // GTK tries to accommodate this situation by accepting `DMA_FORMAT_MOD_INVALID` as modifier.
 //
 // The operation of `GdkDmabufTextureBuilder` is quite simple: Create a texture builder,
-// set all the necessary properties, and then call [method@Gdk.DmabufTextureBuilder.build]
+// set all the necessary properties, and then call [DmabufTextureBuilder.Build]
 // to create the new texture.
 //
 // The required properties for a dma-buf texture are
@@ -74,9 +73,12 @@ func (x *DmabufTextureBuilderClass) GoPointer() uintptr {
 //
 // # For further information, see
 //
-// * The Linux kernel [documentation](https://docs.kernel.org/driver-api/dma-buf.html)
+// * The Linux kernel [documentation]
 //
-// * The header file [drm_fourcc.h](https://gitlab.freedesktop.org/mesa/drm/-/blob/main/include/drm/drm_fourcc.h)
+// * The header file [drm_fourcc.h]
+//
+// [documentation]: https://docs.kernel.org/driver-api/dma-buf.html
+// [drm_fourcc.h]: https://gitlab.freedesktop.org/mesa/drm/-/blob/main/include/drm/drm_fourcc.h
 type DmabufTextureBuilder struct {
 	gobject.Object
 }
@@ -116,14 +118,14 @@ var xDmabufTextureBuilderBuild func(uintptr, uintptr, uintptr, **glib.Error) uin
 // It is a programming error to call this function if any mandatory property has not been set.
 //
 // Not all formats defined in the `drm_fourcc.h` header are supported. You can use
-// [method@Gdk.Display.get_dmabuf_formats] to get a list of supported formats. If the
-// format is not supported by GTK, %NULL will be returned and @error will be set.
+// [Display.GetDmabufFormats] to get a list of supported formats. If the
+// format is not supported by GTK, nil will be returned and error will be set.
 //
 // The `destroy` function gets called when the returned texture gets released.
 //
 // It is the responsibility of the caller to keep the file descriptors for the planes
 // open until the created texture is no longer used, and close them afterwards (possibly
-// using the @destroy notify).
+// using the destroy notify).
 //
 // It is possible to call this function multiple times to create multiple textures,
 // possibly with changing properties in between.
@@ -138,23 +140,27 @@ func (x *DmabufTextureBuilder) Build(DestroyVar *glib.DestroyNotify, DataVar uin
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
 	cret := xDmabufTextureBuilderBuild(x.GoPointer(), DestroyVarRef, DataVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Texture{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -162,7 +168,7 @@ func (x *DmabufTextureBuilder) Build(DestroyVar *glib.DestroyNotify, DataVar uin
 
 var xDmabufTextureBuilderGetColorState func(uintptr) *ColorState
 
-// Gets the color state previously set via gdk_dmabuf_texture_builder_set_color_state().
+// Gets the color state previously set via [DmabufTextureBuilder.SetColorState].
 func (x *DmabufTextureBuilder) GetColorState() *ColorState {
 
 	cret := xDmabufTextureBuilderGetColorState(x.GoPointer())
@@ -198,7 +204,7 @@ func (x *DmabufTextureBuilder) GetFd(PlaneVar uint) int {
 
 var xDmabufTextureBuilderGetFourcc func(uintptr) uint32
 
-// Gets the format previously set via gdk_dmabuf_texture_builder_set_fourcc()
+// Gets the format previously set via [DmabufTextureBuilder.SetFourcc]
 // or 0 if the format wasn't set.
 //
 // The format is specified as a fourcc code.
@@ -210,7 +216,7 @@ func (x *DmabufTextureBuilder) GetFourcc() uint32 {
 
 var xDmabufTextureBuilderGetHeight func(uintptr) uint
 
-// Gets the height previously set via gdk_dmabuf_texture_builder_set_height() or
+// Gets the height previously set via [DmabufTextureBuilder.SetHeight] or
 // 0 if the height wasn't set.
 func (x *DmabufTextureBuilder) GetHeight() uint {
 
@@ -265,8 +271,8 @@ func (x *DmabufTextureBuilder) GetStride(PlaneVar uint) uint {
 
 var xDmabufTextureBuilderGetUpdateRegion func(uintptr) *cairo.Region
 
-// Gets the region previously set via gdk_dmabuf_texture_builder_set_update_region() or
-// %NULL if none was set.
+// Gets the region previously set via [DmabufTextureBuilder.SetUpdateRegion] or
+// nil if none was set.
 func (x *DmabufTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 	cret := xDmabufTextureBuilderGetUpdateRegion(x.GoPointer())
@@ -275,8 +281,8 @@ func (x *DmabufTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 var xDmabufTextureBuilderGetUpdateTexture func(uintptr) uintptr
 
-// Gets the texture previously set via gdk_dmabuf_texture_builder_set_update_texture() or
-// %NULL if none was set.
+// Gets the texture previously set via [DmabufTextureBuilder.SetUpdateTexture] or
+// nil if none was set.
 func (x *DmabufTextureBuilder) GetUpdateTexture() *Texture {
 	var cls *Texture
 
@@ -293,7 +299,7 @@ func (x *DmabufTextureBuilder) GetUpdateTexture() *Texture {
 
 var xDmabufTextureBuilderGetWidth func(uintptr) uint
 
-// Gets the width previously set via gdk_dmabuf_texture_builder_set_width() or
+// Gets the width previously set via [DmabufTextureBuilder.SetWidth] or
 // 0 if the width wasn't set.
 func (x *DmabufTextureBuilder) GetWidth() uint {
 
@@ -342,7 +348,7 @@ var xDmabufTextureBuilderSetFourcc func(uintptr, uint32)
 //
 // The format is specified as a fourcc code.
 //
-// The format must be set before calling [method@Gdk.DmabufTextureBuilder.build].
+// The format must be set before calling [DmabufTextureBuilder.Build].
 func (x *DmabufTextureBuilder) SetFourcc(FourccVar uint32) {
 
 	xDmabufTextureBuilderSetFourcc(x.GoPointer(), FourccVar)
@@ -353,7 +359,7 @@ var xDmabufTextureBuilderSetHeight func(uintptr, uint)
 
 // Sets the height of the texture.
 //
-// The height must be set before calling [method@Gdk.DmabufTextureBuilder.build].
+// The height must be set before calling [DmabufTextureBuilder.Build].
 func (x *DmabufTextureBuilder) SetHeight(HeightVar uint) {
 
 	xDmabufTextureBuilderSetHeight(x.GoPointer(), HeightVar)
@@ -403,7 +409,7 @@ var xDmabufTextureBuilderSetStride func(uintptr, uint, uint)
 
 // Sets the stride for a plane.
 //
-// The stride must be set for all planes before calling [method@Gdk.DmabufTextureBuilder.build].
+// The stride must be set for all planes before calling [DmabufTextureBuilder.Build].
 func (x *DmabufTextureBuilder) SetStride(PlaneVar uint, StrideVar uint) {
 
 	xDmabufTextureBuilderSetStride(x.GoPointer(), PlaneVar, StrideVar)
@@ -413,7 +419,7 @@ func (x *DmabufTextureBuilder) SetStride(PlaneVar uint, StrideVar uint) {
 var xDmabufTextureBuilderSetUpdateRegion func(uintptr, *cairo.Region)
 
 // Sets the region to be updated by this texture. Together with
-// [property@Gdk.DmabufTextureBuilder:update-texture] this describes an
+// DmabufTextureBuilder:update-texture this describes an
 // update of a previous texture.
 //
 // When rendering animations of large textures, it is possible that
@@ -431,7 +437,7 @@ func (x *DmabufTextureBuilder) SetUpdateRegion(RegionVar *cairo.Region) {
 var xDmabufTextureBuilderSetUpdateTexture func(uintptr, uintptr)
 
 // Sets the texture to be updated by this texture. See
-// [method@Gdk.DmabufTextureBuilder.set_update_region] for an explanation.
+// [DmabufTextureBuilder.SetUpdateRegion] for an explanation.
 func (x *DmabufTextureBuilder) SetUpdateTexture(TextureVar *Texture) {
 
 	xDmabufTextureBuilderSetUpdateTexture(x.GoPointer(), TextureVar.GoPointer())
@@ -442,7 +448,7 @@ var xDmabufTextureBuilderSetWidth func(uintptr, uint)
 
 // Sets the width of the texture.
 //
-// The width must be set before calling [method@Gdk.DmabufTextureBuilder.build].
+// The width must be set before calling [DmabufTextureBuilder.Build].
 func (x *DmabufTextureBuilder) SetWidth(WidthVar uint) {
 
 	xDmabufTextureBuilderSetWidth(x.GoPointer(), WidthVar)
@@ -573,7 +579,7 @@ func (x *DmabufTextureBuilder) GetPropertyPremultiplied() bool {
 }
 
 // SetPropertyUpdateRegion sets the "update-region" property.
-// The update region for [property@Gdk.DmabufTextureBuilder:update-texture].
+// The update region for DmabufTextureBuilder:update-texture.
 func (x *DmabufTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -582,7 +588,7 @@ func (x *DmabufTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 }
 
 // GetPropertyUpdateRegion gets the "update-region" property.
-// The update region for [property@Gdk.DmabufTextureBuilder:update-texture].
+// The update region for DmabufTextureBuilder:update-texture.
 func (x *DmabufTextureBuilder) GetPropertyUpdateRegion() uintptr {
 	var v gobject.Value
 	x.GetProperty("update-region", &v)
@@ -611,7 +617,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -620,34 +626,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xDmabufTextureBuilderGLibType, libs, "gdk_dmabuf_texture_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewDmabufTextureBuilder, libs, "gdk_dmabuf_texture_builder_new")
-
-	core.PuregoSafeRegister(&xDmabufTextureBuilderBuild, libs, "gdk_dmabuf_texture_builder_build")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetColorState, libs, "gdk_dmabuf_texture_builder_get_color_state")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetDisplay, libs, "gdk_dmabuf_texture_builder_get_display")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetFd, libs, "gdk_dmabuf_texture_builder_get_fd")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetFourcc, libs, "gdk_dmabuf_texture_builder_get_fourcc")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetHeight, libs, "gdk_dmabuf_texture_builder_get_height")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetModifier, libs, "gdk_dmabuf_texture_builder_get_modifier")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetNPlanes, libs, "gdk_dmabuf_texture_builder_get_n_planes")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetOffset, libs, "gdk_dmabuf_texture_builder_get_offset")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetPremultiplied, libs, "gdk_dmabuf_texture_builder_get_premultiplied")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetStride, libs, "gdk_dmabuf_texture_builder_get_stride")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetUpdateRegion, libs, "gdk_dmabuf_texture_builder_get_update_region")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetUpdateTexture, libs, "gdk_dmabuf_texture_builder_get_update_texture")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderGetWidth, libs, "gdk_dmabuf_texture_builder_get_width")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetColorState, libs, "gdk_dmabuf_texture_builder_set_color_state")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetDisplay, libs, "gdk_dmabuf_texture_builder_set_display")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetFd, libs, "gdk_dmabuf_texture_builder_set_fd")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetFourcc, libs, "gdk_dmabuf_texture_builder_set_fourcc")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetHeight, libs, "gdk_dmabuf_texture_builder_set_height")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetModifier, libs, "gdk_dmabuf_texture_builder_set_modifier")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetNPlanes, libs, "gdk_dmabuf_texture_builder_set_n_planes")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetOffset, libs, "gdk_dmabuf_texture_builder_set_offset")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetPremultiplied, libs, "gdk_dmabuf_texture_builder_set_premultiplied")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetStride, libs, "gdk_dmabuf_texture_builder_set_stride")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetUpdateRegion, libs, "gdk_dmabuf_texture_builder_set_update_region")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetUpdateTexture, libs, "gdk_dmabuf_texture_builder_set_update_texture")
-	core.PuregoSafeRegister(&xDmabufTextureBuilderSetWidth, libs, "gdk_dmabuf_texture_builder_set_width")
+	core.PuregoRegisterSince(&xNewDmabufTextureBuilder, libs, "gdk_dmabuf_texture_builder_new", "GDK", "4.14")
+
+	core.PuregoRegisterSince(&xDmabufTextureBuilderBuild, libs, "gdk_dmabuf_texture_builder_build", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetColorState, libs, "gdk_dmabuf_texture_builder_get_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetDisplay, libs, "gdk_dmabuf_texture_builder_get_display", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetFd, libs, "gdk_dmabuf_texture_builder_get_fd", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetFourcc, libs, "gdk_dmabuf_texture_builder_get_fourcc", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetHeight, libs, "gdk_dmabuf_texture_builder_get_height", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetModifier, libs, "gdk_dmabuf_texture_builder_get_modifier", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetNPlanes, libs, "gdk_dmabuf_texture_builder_get_n_planes", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetOffset, libs, "gdk_dmabuf_texture_builder_get_offset", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetPremultiplied, libs, "gdk_dmabuf_texture_builder_get_premultiplied", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetStride, libs, "gdk_dmabuf_texture_builder_get_stride", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetUpdateRegion, libs, "gdk_dmabuf_texture_builder_get_update_region", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetUpdateTexture, libs, "gdk_dmabuf_texture_builder_get_update_texture", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderGetWidth, libs, "gdk_dmabuf_texture_builder_get_width", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetColorState, libs, "gdk_dmabuf_texture_builder_set_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetDisplay, libs, "gdk_dmabuf_texture_builder_set_display", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetFd, libs, "gdk_dmabuf_texture_builder_set_fd", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetFourcc, libs, "gdk_dmabuf_texture_builder_set_fourcc", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetHeight, libs, "gdk_dmabuf_texture_builder_set_height", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetModifier, libs, "gdk_dmabuf_texture_builder_set_modifier", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetNPlanes, libs, "gdk_dmabuf_texture_builder_set_n_planes", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetOffset, libs, "gdk_dmabuf_texture_builder_set_offset", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetPremultiplied, libs, "gdk_dmabuf_texture_builder_set_premultiplied", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetStride, libs, "gdk_dmabuf_texture_builder_set_stride", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetUpdateRegion, libs, "gdk_dmabuf_texture_builder_set_update_region", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetUpdateTexture, libs, "gdk_dmabuf_texture_builder_set_update_texture", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufTextureBuilderSetWidth, libs, "gdk_dmabuf_texture_builder_set_width", "GDK", "4.14")
 
 }
@@ -2,9 +2,9 @@
 package gdk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -30,12 +30,51 @@ const (
 	DragCancelErrorValue DragCancelReason = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DragCancelReason) String() string {
+	switch x {
+	case DragCancelNoTargetValue:
+		return "GDK_DRAG_CANCEL_NO_TARGET"
+	case DragCancelUserCancelledValue:
+		return "GDK_DRAG_CANCEL_USER_CANCELLED"
+	case DragCancelErrorValue:
+		return "GDK_DRAG_CANCEL_ERROR"
+	}
+	return fmt.Sprintf("DragCancelReason(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DragCancelReason) Nick() string {
+	switch x {
+	case DragCancelNoTargetValue:
+		return "no-target"
+	case DragCancelUserCancelledValue:
+		return "user-cancelled"
+	case DragCancelErrorValue:
+		return "error"
+	}
+	return ""
+}
+
+// DragCancelReasonFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DragCancelReasonFromString(s string) (DragCancelReason, bool) {
+	switch s {
+	case "GDK_DRAG_CANCEL_NO_TARGET", "no-target":
+		return DragCancelNoTargetValue, true
+	case "GDK_DRAG_CANCEL_USER_CANCELLED", "user-cancelled":
+		return DragCancelUserCancelledValue, true
+	case "GDK_DRAG_CANCEL_ERROR", "error":
+		return DragCancelErrorValue, true
+	}
+	return 0, false
+}
+
 var xDragActionIsUnique func(DragAction) bool
 
-// Checks if @action represents a single action or includes
+// Checks if action represents a single action or includes
 // multiple actions.
 //
-// When @action is `GDK_ACTION_NONE` - ie no action was given, `TRUE`
+// When action is `GDK_ACTION_NONE` - ie no action was given, `TRUE`
 // is returned.
 func DragActionIsUnique(ActionVar DragAction) bool {
 
@@ -46,7 +85,7 @@ func DragActionIsUnique(ActionVar DragAction) bool {
 // Represents the source of an ongoing DND operation.
 //
 // A `GdkDrag` is created when a drag is started, and stays alive for duration of
-// the DND operation. After a drag has been started with [func@Gdk.Drag.begin],
+// the DND operation. After a drag has been started with [DragBegin],
 // the caller gets informed about the status of the ongoing drag operation
 // with signals on the `GdkDrag` object.
 //
@@ -73,13 +112,13 @@ var xDragDropDone func(uintptr, bool)
 
 // Informs GDK that the drop ended.
 //
-// Passing %FALSE for @success may trigger a drag cancellation
+// Passing false for success may trigger a drag cancellation
 // animation.
 //
 // This function is called by the drag source, and should be the
-// last call before dropping the reference to the @drag.
+// last call before dropping the reference to the drag.
 //
-// The `GdkDrag` will only take the first [method@Gdk.Drag.drop_done]
+// The `GdkDrag` will only take the first [Drag.DropDone]
 // call as effective, if this function is called multiple times,
 // all subsequent calls will be ignored.
 func (x *Drag) DropDone(SuccessVar bool) {
@@ -155,7 +194,7 @@ var xDragGetDragSurface func(uintptr) uintptr
 //
 // Note that the surface may not be available until the drag operation
 // has begun. GDK will move the surface in accordance with the ongoing
-// drag operation. The surface is owned by @drag and will be destroyed
+// drag operation. The surface is owned by drag and will be destroyed
 // when the drag operation is over.
 func (x *Drag) GetDragSurface() *Surface {
 	var cls *Surface
@@ -247,77 +286,143 @@ func (x *Drag) GetPropertyFormats() uintptr {
 }
 
 // Emitted when the drag operation is cancelled.
+//
+// Deprecated: use ConnectCancelFunc, which also accepts method values and closures.
 func (x *Drag) ConnectCancel(cb *func(Drag, DragCancelReason)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cancel", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ReasonVarp DragCancelReason) {
+			defer core.RecoverPanic()
+			fa := Drag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ReasonVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cancel", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ReasonVarp DragCancelReason) {
+var xDragCancelTrampoline uintptr
+
+func xDragCancelNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ReasonVarp DragCancelReason, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Drag, DragCancelReason))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Drag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ReasonVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cancel", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCancelFunc connects cb to the "cancel" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Drag) ConnectCancelFunc(cb func(Drag, DragCancelReason)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cancel", &xDragCancelTrampoline, xDragCancelNewTrampoline, cb)
 }
 
 // Emitted when the destination side has finished reading all data.
 //
 // The drag object can now free all miscellaneous data.
+//
+// Deprecated: use ConnectDndFinishedFunc, which also accepts method values and closures.
 func (x *Drag) ConnectDndFinished(cb *func(Drag)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "dnd-finished", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Drag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "dnd-finished", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDragDndFinishedTrampoline uintptr
+
+func xDragDndFinishedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Drag))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Drag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "dnd-finished", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDndFinishedFunc connects cb to the "dnd-finished" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Drag) ConnectDndFinishedFunc(cb func(Drag)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "dnd-finished", &xDragDndFinishedTrampoline, xDragDndFinishedNewTrampoline, cb)
 }
 
 // Emitted when the drop operation is performed on an accepting client.
+//
+// Deprecated: use ConnectDropPerformedFunc, which also accepts method values and closures.
 func (x *Drag) ConnectDropPerformed(cb *func(Drag)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drop-performed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Drag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drop-performed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDragDropPerformedTrampoline uintptr
+
+func xDragDropPerformedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Drag))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Drag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drop-performed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDropPerformedFunc connects cb to the "drop-performed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Drag) ConnectDropPerformedFunc(cb func(Drag)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drop-performed", &xDragDropPerformedTrampoline, xDragDropPerformedNewTrampoline, cb)
 }
 
 var xDragBegin func(uintptr, uintptr, uintptr, DragAction, float64, float64) uintptr
@@ -326,16 +431,16 @@ var xDragBegin func(uintptr, uintptr, uintptr, DragAction, float64, float64) uin
 //
 // This function is called by the drag source. After this call, you
 // probably want to set up the drag icon using the surface returned
-// by [method@Gdk.Drag.get_drag_surface].
+// by [Drag.GetDragSurface].
 //
-// This function returns a reference to the [class@Gdk.Drag] object,
+// This function returns a reference to the [Drag] object,
 // but GTK keeps its own reference as well, as long as the DND operation
 // is going on.
 //
-// Note: if @actions include %GDK_ACTION_MOVE, you need to listen for
-// the [signal@Gdk.Drag::dnd-finished] signal and delete the data at
-// the source if [method@Gdk.Drag.get_selected_action] returns
-// %GDK_ACTION_MOVE.
+// Note: if actions include [ActionMoveValue], you need to listen for
+// the [Drag.ConnectDndFinishedFunc] signal and delete the data at
+// the source if [Drag.GetSelectedAction] returns
+// [ActionMoveValue].
 func DragBegin(SurfaceVar *Surface, DeviceVar *Device, ContentVar *ContentProvider, ActionsVar DragAction, DxVar float64, DyVar float64) *Drag {
 	var cls *Drag
 
@@ -354,7 +459,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -363,21 +468,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xDragCancelReasonGLibType, libs, "gdk_drag_cancel_reason_get_type")
 
-	core.PuregoSafeRegister(&xDragActionIsUnique, libs, "gdk_drag_action_is_unique")
+	core.PuregoRegisterSince(&xDragActionIsUnique, libs, "gdk_drag_action_is_unique", "GDK", "")
 
 	core.PuregoSafeRegister(&xDragGLibType, libs, "gdk_drag_get_type")
 
-	core.PuregoSafeRegister(&xDragDropDone, libs, "gdk_drag_drop_done")
-	core.PuregoSafeRegister(&xDragGetActions, libs, "gdk_drag_get_actions")
-	core.PuregoSafeRegister(&xDragGetContent, libs, "gdk_drag_get_content")
-	core.PuregoSafeRegister(&xDragGetDevice, libs, "gdk_drag_get_device")
-	core.PuregoSafeRegister(&xDragGetDisplay, libs, "gdk_drag_get_display")
-	core.PuregoSafeRegister(&xDragGetDragSurface, libs, "gdk_drag_get_drag_surface")
-	core.PuregoSafeRegister(&xDragGetFormats, libs, "gdk_drag_get_formats")
-	core.PuregoSafeRegister(&xDragGetSelectedAction, libs, "gdk_drag_get_selected_action")
-	core.PuregoSafeRegister(&xDragGetSurface, libs, "gdk_drag_get_surface")
-	core.PuregoSafeRegister(&xDragSetHotspot, libs, "gdk_drag_set_hotspot")
-
-	core.PuregoSafeRegister(&xDragBegin, libs, "gdk_drag_begin")
+	core.PuregoRegisterSince(&xDragDropDone, libs, "gdk_drag_drop_done", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetActions, libs, "gdk_drag_get_actions", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetContent, libs, "gdk_drag_get_content", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetDevice, libs, "gdk_drag_get_device", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetDisplay, libs, "gdk_drag_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetDragSurface, libs, "gdk_drag_get_drag_surface", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetFormats, libs, "gdk_drag_get_formats", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetSelectedAction, libs, "gdk_drag_get_selected_action", "GDK", "")
+	core.PuregoRegisterSince(&xDragGetSurface, libs, "gdk_drag_get_surface", "GDK", "")
+	core.PuregoRegisterSince(&xDragSetHotspot, libs, "gdk_drag_set_hotspot", "GDK", "")
+
+	core.PuregoRegisterSince(&xDragBegin, libs, "gdk_drag_begin", "GDK", "")
 
 }
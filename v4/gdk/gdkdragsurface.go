@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -47,7 +46,7 @@ func (x *DragSurfaceBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Present @drag_surface.
+// Present drag_surface.
 func (x *DragSurfaceBase) Present(WidthVar int, HeightVar int) bool {
 
 	cret := XGdkDragSurfacePresent(x.GoPointer(), WidthVar, HeightVar)
@@ -61,7 +60,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -70,6 +69,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDragSurfaceGLibType, libs, "gdk_drag_surface_get_type")
 
-	core.PuregoSafeRegister(&XGdkDragSurfacePresent, libs, "gdk_drag_surface_present")
+	core.PuregoRegisterSince(&XGdkDragSurfacePresent, libs, "gdk_drag_surface_present", "GDK", "")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -25,6 +25,21 @@ func (x *DragSurfaceSize) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the DragSurfaceSize made with g_boxed_copy, release it with Free.
+func (x *DragSurfaceSize) Copy() *DragSurfaceSize {
+	cret := gobject.BoxedCopy(DragSurfaceSizeGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*DragSurfaceSize)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the DragSurfaceSize with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *DragSurfaceSize) Free() {
+	gobject.BoxedFree(DragSurfaceSizeGLibType(), x.GoPointer())
+}
+
 var xDragSurfaceSizeSetSize func(uintptr, int, int)
 
 // Sets the size the drag surface prefers to be resized to.
@@ -39,7 +54,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -48,6 +63,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDragSurfaceSizeGLibType, libs, "gdk_drag_surface_size_get_type")
 
-	core.PuregoSafeRegister(&xDragSurfaceSizeSetSize, libs, "gdk_drag_surface_size_set_size")
+	core.PuregoRegisterSince(&xDragSurfaceSizeSetSize, libs, "gdk_drag_surface_size_set_size", "GDK", "4.12")
 
 }
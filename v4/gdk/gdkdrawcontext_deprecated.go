@@ -0,0 +1,121 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+)
+
+var xDrawContextBeginFrame func(uintptr, *cairo.Region)
+
+// Indicates that you are beginning the process of redrawing region
+// on the context's surface.
+//
+// Calling this function begins a drawing operation using context on the
+// surface that context was created from. The actual requirements and
+// guarantees for the drawing operation vary for different implementations
+// of drawing, so a [CairoContext] and a [GLContext]
+// need to be treated differently.
+//
+// A call to this function is a requirement for drawing and must be
+// followed by a call to [DrawContext.EndFrame], which will
+// complete the drawing operation and ensure the contents become visible
+// on screen.
+//
+// Note that the region passed to this function is the minimum region that
+// needs to be drawn and depending on implementation, windowing system and
+// hardware in use, it might be necessary to draw a larger region. Drawing
+// implementation must use [DrawContext.GetFrameRegion] to
+// query the region that must be drawn.
+//
+// When using GTK, the widget system automatically places calls to
+// [DrawContext.BeginFrame] and [DrawContext.EndFrame] via the
+// use of GskRenderers, so application code
+// does not need to call these functions explicitly.
+//
+// Deprecated: since 4.16. Drawing directly to the surface is no longer recommended.
+//
+//	Use `GskRenderNode` and `GskRenderer`.
+func (x *DrawContext) BeginFrame(RegionVar *cairo.Region) {
+
+	xDrawContextBeginFrame(x.GoPointer(), RegionVar)
+
+}
+
+var xDrawContextEndFrame func(uintptr)
+
+// Ends a drawing operation started with [DrawContext.BeginFrame].
+//
+// This makes the drawing available on screen.
+// See [DrawContext.BeginFrame] for more details about drawing.
+//
+// When using a [GLContext], this function may call `glFlush()`
+// implicitly before returning; it is not recommended to call `glFlush()`
+// explicitly before calling this function.
+//
+// Deprecated: since 4.16. Drawing directly to the surface is no longer recommended.
+//
+//	Use `GskRenderNode` and `GskRenderer`.
+func (x *DrawContext) EndFrame() {
+
+	xDrawContextEndFrame(x.GoPointer())
+
+}
+
+var xDrawContextGetFrameRegion func(uintptr) *cairo.Region
+
+// Retrieves the region that is currently being repainted.
+//
+// After a call to [DrawContext.BeginFrame] this function will
+// return a union of the region passed to that function and the area of the
+// surface that the context determined needs to be repainted.
+//
+// If context is not in between calls to [DrawContext.BeginFrame]
+// and [DrawContext.EndFrame], nil will be returned.
+//
+// Deprecated: since 4.16. Drawing directly to the surface is no longer recommended.
+//
+//	Use `GskRenderNode` and `GskRenderer`.
+func (x *DrawContext) GetFrameRegion() *cairo.Region {
+
+	cret := xDrawContextGetFrameRegion(x.GoPointer())
+	return cret
+}
+
+var xDrawContextIsInFrame func(uintptr) bool
+
+// Returns true if context is in the process of drawing to its surface.
+//
+// This is the case between calls to [DrawContext.BeginFrame]
+// and [DrawContext.EndFrame]. In this situation, drawing commands
+// may be effecting the contents of the context's surface.
+//
+// Deprecated: since 4.16. Drawing directly to the surface is no longer recommended.
+//
+//	Use `GskRenderNode` and `GskRenderer`.
+func (x *DrawContext) IsInFrame() bool {
+
+	cret := xDrawContextIsInFrame(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xDrawContextBeginFrame, libs, "gdk_draw_context_begin_frame", "GDK", "")
+	core.PuregoRegisterSince(&xDrawContextEndFrame, libs, "gdk_draw_context_end_frame", "GDK", "")
+	core.PuregoRegisterSince(&xDrawContextGetFrameRegion, libs, "gdk_draw_context_get_frame_region", "GDK", "")
+	core.PuregoRegisterSince(&xDrawContextIsInFrame, libs, "gdk_draw_context_is_in_frame", "GDK", "")
+
+}
@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -17,11 +16,11 @@ import (
 // Possible drop sites get informed about the status of the ongoing drag
 // operation with events of type `GDK_DRAG_ENTER`, `GDK_DRAG_LEAVE`,
 // `GDK_DRAG_MOTION` and `GDK_DROP_START`. The `GdkDrop` object can be obtained
-// from these [class@Gdk.Event] types using [method@Gdk.DNDEvent.get_drop].
+// from these [Event] types using [DNDEvent.GetDrop].
 //
 // The actual data transfer is initiated from the target side via an async
 // read, using one of the `GdkDrop` methods for this purpose:
-// [method@Gdk.Drop.read_async] or [method@Gdk.Drop.read_value_async].
+// [Drop.ReadAsync] or [Drop.ReadValueAsync].
 //
 // GTK provides a higher level abstraction based on top of these functions,
 // and so they are not normally needed in GTK applications. See the
@@ -46,8 +45,8 @@ var xDropFinish func(uintptr, DragAction)
 
 // Ends the drag operation after a drop.
 //
-// The @action must be a single action selected from the actions
-// available via [method@Gdk.Drop.get_actions].
+// The action must be a single action selected from the actions
+// available via [Drop.GetActions].
 func (x *Drop) Finish(ActionVar DragAction) {
 
 	xDropFinish(x.GoPointer(), ActionVar)
@@ -59,16 +58,16 @@ var xDropGetActions func(uintptr) DragAction
 // Returns the possible actions for this `GdkDrop`.
 //
 // If this value contains multiple actions - i.e.
-// [func@Gdk.DragAction.is_unique] returns false for the result -
-// [method@Gdk.Drop.finish] must choose the action to use when
+// [DragActionIsUnique] returns false for the result -
+// [Drop.Finish] must choose the action to use when
 // accepting the drop. This will only happen if you passed
 // `GDK_ACTION_ASK` as one of the possible actions in
-// [method@Gdk.Drop.status]. `GDK_ACTION_ASK` itself will not
+// [Drop.Status]. `GDK_ACTION_ASK` itself will not
 // be included in the actions returned by this function.
 //
-// This value may change over the lifetime of the [class@Gdk.Drop]
+// This value may change over the lifetime of the [Drop]
 // both as a response to source side actions as well as to calls to
-// [method@Gdk.Drop.status] or [method@Gdk.Drop.finish]. The source
+// [Drop.Status] or [Drop.Finish]. The source
 // side will not change this value anymore once a drop has started.
 func (x *Drop) GetActions() DragAction {
 
@@ -95,7 +94,7 @@ func (x *Drop) GetDevice() *Device {
 
 var xDropGetDisplay func(uintptr) uintptr
 
-// Gets the `GdkDisplay` that @self was created for.
+// Gets the `GdkDisplay` that self was created for.
 func (x *Drop) GetDisplay() *Display {
 	var cls *Display
 
@@ -170,11 +169,12 @@ func (x *Drop) ReadAsync(MimeTypesVar []string, IoPriorityVar int, CancellableVa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -189,9 +189,9 @@ var xDropReadFinish func(uintptr, uintptr, *string, **glib.Error) uintptr
 // Note that you must not use blocking read calls on the returned stream
 // in the GTK thread, since some platforms might require communication with
 // GTK to complete the data transfer. You can use async APIs such as
-// g_input_stream_read_bytes_async().
+// [gio.InputStream.ReadBytesAsync].
 //
-// See [method@Gdk.Drop.read_async].
+// See [Drop.ReadAsync].
 func (x *Drop) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -199,12 +199,15 @@ func (x *Drop) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string) (*g
 	cret := xDropReadFinish(x.GoPointer(), ResultVar.GoPointer(), OutMimeTypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -213,11 +216,11 @@ func (x *Drop) ReadFinish(ResultVar gio.AsyncResult, OutMimeTypeVar *string) (*g
 var xDropReadValueAsync func(uintptr, types.GType, int, uintptr, uintptr, uintptr)
 
 // Asynchronously request the drag operation's contents converted
-// to the given @type.
+// to the given type.
 //
 // For local drag-and-drop operations that are available in the given
 // `GType`, the value will be copied directly. Otherwise, GDK will
-// try to use [func@Gdk.content_deserialize_async] to convert the data.
+// try to use [ContentDeserializeAsync] to convert the data.
 func (x *Drop) ReadValueAsync(TypeVar types.GType, IoPriorityVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -227,11 +230,12 @@ func (x *Drop) ReadValueAsync(TypeVar types.GType, IoPriorityVar int, Cancellabl
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -243,13 +247,13 @@ var xDropReadValueFinish func(uintptr, uintptr, **glib.Error) *gobject.Value
 
 // Finishes an async drop read.
 //
-// See [method@Gdk.Drop.read_value_async].
+// See [Drop.ReadValueAsync].
 func (x *Drop) ReadValueFinish(ResultVar gio.AsyncResult) (*gobject.Value, error) {
 	var cerr *glib.Error
 
 	cret := xDropReadValueFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -260,10 +264,10 @@ var xDropStatus func(uintptr, DragAction, DragAction)
 // Selects all actions that are potentially supported by the destination.
 //
 // When calling this function, do not restrict the passed in actions to
-// the ones provided by [method@Gdk.Drop.get_actions]. Those actions may
+// the ones provided by [Drop.GetActions]. Those actions may
 // change in the future, even depending on the actions you provide here.
 //
-// The @preferred action is a hint to the drag-and-drop mechanism about which
+// The preferred action is a hint to the drag-and-drop mechanism about which
 // action to use when multiple actions are possible.
 //
 // This function should be called by drag destinations in response to
@@ -309,7 +313,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -318,17 +322,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xDropGLibType, libs, "gdk_drop_get_type")
 
-	core.PuregoSafeRegister(&xDropFinish, libs, "gdk_drop_finish")
-	core.PuregoSafeRegister(&xDropGetActions, libs, "gdk_drop_get_actions")
-	core.PuregoSafeRegister(&xDropGetDevice, libs, "gdk_drop_get_device")
-	core.PuregoSafeRegister(&xDropGetDisplay, libs, "gdk_drop_get_display")
-	core.PuregoSafeRegister(&xDropGetDrag, libs, "gdk_drop_get_drag")
-	core.PuregoSafeRegister(&xDropGetFormats, libs, "gdk_drop_get_formats")
-	core.PuregoSafeRegister(&xDropGetSurface, libs, "gdk_drop_get_surface")
-	core.PuregoSafeRegister(&xDropReadAsync, libs, "gdk_drop_read_async")
-	core.PuregoSafeRegister(&xDropReadFinish, libs, "gdk_drop_read_finish")
-	core.PuregoSafeRegister(&xDropReadValueAsync, libs, "gdk_drop_read_value_async")
-	core.PuregoSafeRegister(&xDropReadValueFinish, libs, "gdk_drop_read_value_finish")
-	core.PuregoSafeRegister(&xDropStatus, libs, "gdk_drop_status")
+	core.PuregoRegisterSince(&xDropFinish, libs, "gdk_drop_finish", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetActions, libs, "gdk_drop_get_actions", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetDevice, libs, "gdk_drop_get_device", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetDisplay, libs, "gdk_drop_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetDrag, libs, "gdk_drop_get_drag", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetFormats, libs, "gdk_drop_get_formats", "GDK", "")
+	core.PuregoRegisterSince(&xDropGetSurface, libs, "gdk_drop_get_surface", "GDK", "")
+	core.PuregoRegisterSince(&xDropReadAsync, libs, "gdk_drop_read_async", "GDK", "")
+	core.PuregoRegisterSince(&xDropReadFinish, libs, "gdk_drop_read_finish", "GDK", "")
+	core.PuregoRegisterSince(&xDropReadValueAsync, libs, "gdk_drop_read_value_async", "GDK", "")
+	core.PuregoRegisterSince(&xDropReadValueFinish, libs, "gdk_drop_read_value_finish", "GDK", "")
+	core.PuregoRegisterSince(&xDropStatus, libs, "gdk_drop_status", "GDK", "")
 
 }
@@ -1,12 +1,18 @@
 // Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gdk
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 const (
 	// Defines all possible DND actions.
 	//
-	// This can be used in [method@Gdk.Drop.status] messages when any drop
+	// This can be used in [Drop.Status] messages when any drop
 	// can be accepted or a more specific drop method is not yet known.
 	ACTION_ALL int = 7
 	// A mask covering all entries in `GdkModifierType`.
@@ -14,7 +20,7 @@ const (
 )
 
 // Flags describing the current capabilities of a device/tool.
-type AxisFlags int
+type AxisFlags uint32
 
 var xAxisFlagsGLibType func() types.GType
 
@@ -48,9 +54,86 @@ const (
 	AxisFlagSliderValue AxisFlags = 2048
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (AxisFlags) GLibType() types.GType {
+	return xAxisFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x AxisFlags) Has(flags AxisFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x AxisFlags) Set(flags AxisFlags) AxisFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x AxisFlags) Clear(flags AxisFlags) AxisFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x AxisFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&AxisFlagXValue != 0 && x&AxisFlagXValue == AxisFlagXValue {
+		names = append(names, "GDK_AXIS_FLAG_X")
+		rest &^= AxisFlagXValue
+	}
+	if rest&AxisFlagYValue != 0 && x&AxisFlagYValue == AxisFlagYValue {
+		names = append(names, "GDK_AXIS_FLAG_Y")
+		rest &^= AxisFlagYValue
+	}
+	if rest&AxisFlagDeltaXValue != 0 && x&AxisFlagDeltaXValue == AxisFlagDeltaXValue {
+		names = append(names, "GDK_AXIS_FLAG_DELTA_X")
+		rest &^= AxisFlagDeltaXValue
+	}
+	if rest&AxisFlagDeltaYValue != 0 && x&AxisFlagDeltaYValue == AxisFlagDeltaYValue {
+		names = append(names, "GDK_AXIS_FLAG_DELTA_Y")
+		rest &^= AxisFlagDeltaYValue
+	}
+	if rest&AxisFlagPressureValue != 0 && x&AxisFlagPressureValue == AxisFlagPressureValue {
+		names = append(names, "GDK_AXIS_FLAG_PRESSURE")
+		rest &^= AxisFlagPressureValue
+	}
+	if rest&AxisFlagXtiltValue != 0 && x&AxisFlagXtiltValue == AxisFlagXtiltValue {
+		names = append(names, "GDK_AXIS_FLAG_XTILT")
+		rest &^= AxisFlagXtiltValue
+	}
+	if rest&AxisFlagYtiltValue != 0 && x&AxisFlagYtiltValue == AxisFlagYtiltValue {
+		names = append(names, "GDK_AXIS_FLAG_YTILT")
+		rest &^= AxisFlagYtiltValue
+	}
+	if rest&AxisFlagWheelValue != 0 && x&AxisFlagWheelValue == AxisFlagWheelValue {
+		names = append(names, "GDK_AXIS_FLAG_WHEEL")
+		rest &^= AxisFlagWheelValue
+	}
+	if rest&AxisFlagDistanceValue != 0 && x&AxisFlagDistanceValue == AxisFlagDistanceValue {
+		names = append(names, "GDK_AXIS_FLAG_DISTANCE")
+		rest &^= AxisFlagDistanceValue
+	}
+	if rest&AxisFlagRotationValue != 0 && x&AxisFlagRotationValue == AxisFlagRotationValue {
+		names = append(names, "GDK_AXIS_FLAG_ROTATION")
+		rest &^= AxisFlagRotationValue
+	}
+	if rest&AxisFlagSliderValue != 0 && x&AxisFlagSliderValue == AxisFlagSliderValue {
+		names = append(names, "GDK_AXIS_FLAG_SLIDER")
+		rest &^= AxisFlagSliderValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Used in `GdkDrop` and `GdkDrag` to indicate the actions that the
 // destination can and should do with the dropped data.
-type DragAction int
+type DragAction uint32
 
 var xDragActionGLibType func() types.GType
 
@@ -75,8 +158,57 @@ const (
 	ActionAskValue DragAction = 8
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DragAction) GLibType() types.GType {
+	return xDragActionGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DragAction) Has(flags DragAction) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DragAction) Set(flags DragAction) DragAction {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DragAction) Clear(flags DragAction) DragAction {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DragAction) String() string {
+	if x == 0 {
+		return "GDK_ACTION_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&ActionCopyValue != 0 && x&ActionCopyValue == ActionCopyValue {
+		names = append(names, "GDK_ACTION_COPY")
+		rest &^= ActionCopyValue
+	}
+	if rest&ActionMoveValue != 0 && x&ActionMoveValue == ActionMoveValue {
+		names = append(names, "GDK_ACTION_MOVE")
+		rest &^= ActionMoveValue
+	}
+	if rest&ActionLinkValue != 0 && x&ActionLinkValue == ActionLinkValue {
+		names = append(names, "GDK_ACTION_LINK")
+		rest &^= ActionLinkValue
+	}
+	if rest&ActionAskValue != 0 && x&ActionAskValue == ActionAskValue {
+		names = append(names, "GDK_ACTION_ASK")
+		rest &^= ActionAskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The list of the different APIs that GdkGLContext can potentially support.
-type GLAPI int
+type GLAPI uint32
 
 var xGLAPIGLibType func() types.GType
 
@@ -92,6 +224,47 @@ const (
 	GlApiGlesValue GLAPI = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (GLAPI) GLibType() types.GType {
+	return xGLAPIGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x GLAPI) Has(flags GLAPI) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x GLAPI) Set(flags GLAPI) GLAPI {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x GLAPI) Clear(flags GLAPI) GLAPI {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x GLAPI) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GlApiGlValue != 0 && x&GlApiGlValue == GlApiGlValue {
+		names = append(names, "GDK_GL_API_GL")
+		rest &^= GlApiGlValue
+	}
+	if rest&GlApiGlesValue != 0 && x&GlApiGlesValue == GlApiGlesValue {
+		names = append(names, "GDK_GL_API_GLES")
+		rest &^= GlApiGlesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags to indicate the state of modifier keys and mouse buttons
 // in events.
 //
@@ -100,8 +273,8 @@ const (
 //
 // Note that GDK may add internal values to events which include values outside
 // of this enumeration. Your code should preserve and ignore them. You can use
-// %GDK_MODIFIER_MASK to remove all private values.
-type ModifierType int
+// [MODIFIER_MASK] to remove all private values.
+type ModifierType uint32
 
 var xModifierTypeGLibType func() types.GType
 
@@ -116,13 +289,13 @@ const (
 	// the Shift key.
 	ShiftMaskValue ModifierType = 1
 	// a Lock key (depending on the Windowing System configuration,
-	//    this may either be &lt;kbd&gt;CapsLock&lt;/kbd&gt; or &lt;kbd&gt;ShiftLock&lt;/kbd&gt;).
+	//    this may either be CapsLock or ShiftLock).
 	LockMaskValue ModifierType = 2
 	// the Control key.
 	ControlMaskValue ModifierType = 4
 	// the fourth modifier key (it depends on the Windowing System
 	//    configuration which key is interpreted as this modifier, but normally it
-	//    is the &lt;kbd&gt;Alt&lt;/kbd&gt; key).
+	//    is the Alt key).
 	AltMaskValue ModifierType = 8
 	// the first mouse button.
 	Button1MaskValue ModifierType = 256
@@ -142,6 +315,87 @@ const (
 	MetaMaskValue ModifierType = 268435456
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ModifierType) GLibType() types.GType {
+	return xModifierTypeGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ModifierType) Has(flags ModifierType) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ModifierType) Set(flags ModifierType) ModifierType {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ModifierType) Clear(flags ModifierType) ModifierType {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ModifierType) String() string {
+	if x == 0 {
+		return "GDK_NO_MODIFIER_MASK"
+	}
+	var names []string
+	rest := x
+	if rest&ShiftMaskValue != 0 && x&ShiftMaskValue == ShiftMaskValue {
+		names = append(names, "GDK_SHIFT_MASK")
+		rest &^= ShiftMaskValue
+	}
+	if rest&LockMaskValue != 0 && x&LockMaskValue == LockMaskValue {
+		names = append(names, "GDK_LOCK_MASK")
+		rest &^= LockMaskValue
+	}
+	if rest&ControlMaskValue != 0 && x&ControlMaskValue == ControlMaskValue {
+		names = append(names, "GDK_CONTROL_MASK")
+		rest &^= ControlMaskValue
+	}
+	if rest&AltMaskValue != 0 && x&AltMaskValue == AltMaskValue {
+		names = append(names, "GDK_ALT_MASK")
+		rest &^= AltMaskValue
+	}
+	if rest&Button1MaskValue != 0 && x&Button1MaskValue == Button1MaskValue {
+		names = append(names, "GDK_BUTTON1_MASK")
+		rest &^= Button1MaskValue
+	}
+	if rest&Button2MaskValue != 0 && x&Button2MaskValue == Button2MaskValue {
+		names = append(names, "GDK_BUTTON2_MASK")
+		rest &^= Button2MaskValue
+	}
+	if rest&Button3MaskValue != 0 && x&Button3MaskValue == Button3MaskValue {
+		names = append(names, "GDK_BUTTON3_MASK")
+		rest &^= Button3MaskValue
+	}
+	if rest&Button4MaskValue != 0 && x&Button4MaskValue == Button4MaskValue {
+		names = append(names, "GDK_BUTTON4_MASK")
+		rest &^= Button4MaskValue
+	}
+	if rest&Button5MaskValue != 0 && x&Button5MaskValue == Button5MaskValue {
+		names = append(names, "GDK_BUTTON5_MASK")
+		rest &^= Button5MaskValue
+	}
+	if rest&SuperMaskValue != 0 && x&SuperMaskValue == SuperMaskValue {
+		names = append(names, "GDK_SUPER_MASK")
+		rest &^= SuperMaskValue
+	}
+	if rest&HyperMaskValue != 0 && x&HyperMaskValue == HyperMaskValue {
+		names = append(names, "GDK_HYPER_MASK")
+		rest &^= HyperMaskValue
+	}
+	if rest&MetaMaskValue != 0 && x&MetaMaskValue == MetaMaskValue {
+		names = append(names, "GDK_META_MASK")
+		rest &^= MetaMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Defines how device axes are interpreted by GTK.
 //
 // Note that the X and Y axes are not really needed; pointer devices
@@ -185,6 +439,105 @@ const (
 	AxisLastValue AxisUse = 12
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AxisUse) String() string {
+	switch x {
+	case AxisIgnoreValue:
+		return "GDK_AXIS_IGNORE"
+	case AxisXValue:
+		return "GDK_AXIS_X"
+	case AxisYValue:
+		return "GDK_AXIS_Y"
+	case AxisDeltaXValue:
+		return "GDK_AXIS_DELTA_X"
+	case AxisDeltaYValue:
+		return "GDK_AXIS_DELTA_Y"
+	case AxisPressureValue:
+		return "GDK_AXIS_PRESSURE"
+	case AxisXtiltValue:
+		return "GDK_AXIS_XTILT"
+	case AxisYtiltValue:
+		return "GDK_AXIS_YTILT"
+	case AxisWheelValue:
+		return "GDK_AXIS_WHEEL"
+	case AxisDistanceValue:
+		return "GDK_AXIS_DISTANCE"
+	case AxisRotationValue:
+		return "GDK_AXIS_ROTATION"
+	case AxisSliderValue:
+		return "GDK_AXIS_SLIDER"
+	case AxisLastValue:
+		return "GDK_AXIS_LAST"
+	}
+	return fmt.Sprintf("AxisUse(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AxisUse) Nick() string {
+	switch x {
+	case AxisIgnoreValue:
+		return "ignore"
+	case AxisXValue:
+		return "x"
+	case AxisYValue:
+		return "y"
+	case AxisDeltaXValue:
+		return "delta-x"
+	case AxisDeltaYValue:
+		return "delta-y"
+	case AxisPressureValue:
+		return "pressure"
+	case AxisXtiltValue:
+		return "xtilt"
+	case AxisYtiltValue:
+		return "ytilt"
+	case AxisWheelValue:
+		return "wheel"
+	case AxisDistanceValue:
+		return "distance"
+	case AxisRotationValue:
+		return "rotation"
+	case AxisSliderValue:
+		return "slider"
+	case AxisLastValue:
+		return "last"
+	}
+	return ""
+}
+
+// AxisUseFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AxisUseFromString(s string) (AxisUse, bool) {
+	switch s {
+	case "GDK_AXIS_IGNORE", "ignore":
+		return AxisIgnoreValue, true
+	case "GDK_AXIS_X", "x":
+		return AxisXValue, true
+	case "GDK_AXIS_Y", "y":
+		return AxisYValue, true
+	case "GDK_AXIS_DELTA_X", "delta-x":
+		return AxisDeltaXValue, true
+	case "GDK_AXIS_DELTA_Y", "delta-y":
+		return AxisDeltaYValue, true
+	case "GDK_AXIS_PRESSURE", "pressure":
+		return AxisPressureValue, true
+	case "GDK_AXIS_XTILT", "xtilt":
+		return AxisXtiltValue, true
+	case "GDK_AXIS_YTILT", "ytilt":
+		return AxisYtiltValue, true
+	case "GDK_AXIS_WHEEL", "wheel":
+		return AxisWheelValue, true
+	case "GDK_AXIS_DISTANCE", "distance":
+		return AxisDistanceValue, true
+	case "GDK_AXIS_ROTATION", "rotation":
+		return AxisRotationValue, true
+	case "GDK_AXIS_SLIDER", "slider":
+		return AxisSliderValue, true
+	case "GDK_AXIS_LAST", "last":
+		return AxisLastValue, true
+	}
+	return 0, false
+}
+
 // Error enumeration for `GdkDmabufTexture`.
 type DmabufError int
 
@@ -206,6 +559,63 @@ const (
 	DmabufErrorCreationFailedValue DmabufError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (DmabufError) ErrorDomain() string {
+	return "gdk-dmabuf-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x DmabufError) Error() string {
+	switch x {
+	case DmabufErrorNotAvailableValue:
+		return "GDK_DMABUF_ERROR_NOT_AVAILABLE"
+	case DmabufErrorUnsupportedFormatValue:
+		return "GDK_DMABUF_ERROR_UNSUPPORTED_FORMAT"
+	case DmabufErrorCreationFailedValue:
+		return "GDK_DMABUF_ERROR_CREATION_FAILED"
+	}
+	return fmt.Sprintf("gdk-dmabuf-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x DmabufError) String() string {
+	switch x {
+	case DmabufErrorNotAvailableValue:
+		return "GDK_DMABUF_ERROR_NOT_AVAILABLE"
+	case DmabufErrorUnsupportedFormatValue:
+		return "GDK_DMABUF_ERROR_UNSUPPORTED_FORMAT"
+	case DmabufErrorCreationFailedValue:
+		return "GDK_DMABUF_ERROR_CREATION_FAILED"
+	}
+	return fmt.Sprintf("DmabufError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DmabufError) Nick() string {
+	switch x {
+	case DmabufErrorNotAvailableValue:
+		return "not-available"
+	case DmabufErrorUnsupportedFormatValue:
+		return "unsupported-format"
+	case DmabufErrorCreationFailedValue:
+		return "creation-failed"
+	}
+	return ""
+}
+
+// DmabufErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DmabufErrorFromString(s string) (DmabufError, bool) {
+	switch s {
+	case "GDK_DMABUF_ERROR_NOT_AVAILABLE", "not-available":
+		return DmabufErrorNotAvailableValue, true
+	case "GDK_DMABUF_ERROR_UNSUPPORTED_FORMAT", "unsupported-format":
+		return DmabufErrorUnsupportedFormatValue, true
+	case "GDK_DMABUF_ERROR_CREATION_FAILED", "creation-failed":
+		return DmabufErrorCreationFailedValue, true
+	}
+	return 0, false
+}
+
 // Error enumeration for `GdkGLContext`.
 type GLError int
 
@@ -229,6 +639,79 @@ const (
 	GlErrorLinkFailedValue GLError = 4
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (GLError) ErrorDomain() string {
+	return "gdk-gl-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x GLError) Error() string {
+	switch x {
+	case GlErrorNotAvailableValue:
+		return "GDK_GL_ERROR_NOT_AVAILABLE"
+	case GlErrorUnsupportedFormatValue:
+		return "GDK_GL_ERROR_UNSUPPORTED_FORMAT"
+	case GlErrorUnsupportedProfileValue:
+		return "GDK_GL_ERROR_UNSUPPORTED_PROFILE"
+	case GlErrorCompilationFailedValue:
+		return "GDK_GL_ERROR_COMPILATION_FAILED"
+	case GlErrorLinkFailedValue:
+		return "GDK_GL_ERROR_LINK_FAILED"
+	}
+	return fmt.Sprintf("gdk-gl-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x GLError) String() string {
+	switch x {
+	case GlErrorNotAvailableValue:
+		return "GDK_GL_ERROR_NOT_AVAILABLE"
+	case GlErrorUnsupportedFormatValue:
+		return "GDK_GL_ERROR_UNSUPPORTED_FORMAT"
+	case GlErrorUnsupportedProfileValue:
+		return "GDK_GL_ERROR_UNSUPPORTED_PROFILE"
+	case GlErrorCompilationFailedValue:
+		return "GDK_GL_ERROR_COMPILATION_FAILED"
+	case GlErrorLinkFailedValue:
+		return "GDK_GL_ERROR_LINK_FAILED"
+	}
+	return fmt.Sprintf("GLError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x GLError) Nick() string {
+	switch x {
+	case GlErrorNotAvailableValue:
+		return "not-available"
+	case GlErrorUnsupportedFormatValue:
+		return "unsupported-format"
+	case GlErrorUnsupportedProfileValue:
+		return "unsupported-profile"
+	case GlErrorCompilationFailedValue:
+		return "compilation-failed"
+	case GlErrorLinkFailedValue:
+		return "link-failed"
+	}
+	return ""
+}
+
+// GLErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GLErrorFromString(s string) (GLError, bool) {
+	switch s {
+	case "GDK_GL_ERROR_NOT_AVAILABLE", "not-available":
+		return GlErrorNotAvailableValue, true
+	case "GDK_GL_ERROR_UNSUPPORTED_FORMAT", "unsupported-format":
+		return GlErrorUnsupportedFormatValue, true
+	case "GDK_GL_ERROR_UNSUPPORTED_PROFILE", "unsupported-profile":
+		return GlErrorUnsupportedProfileValue, true
+	case "GDK_GL_ERROR_COMPILATION_FAILED", "compilation-failed":
+		return GlErrorCompilationFailedValue, true
+	case "GDK_GL_ERROR_LINK_FAILED", "link-failed":
+		return GlErrorLinkFailedValue, true
+	}
+	return 0, false
+}
+
 // Defines the reference point of a surface and is used in `GdkPopupLayout`.
 type Gravity int
 
@@ -263,6 +746,87 @@ const (
 	GravityStaticValue Gravity = 10
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Gravity) String() string {
+	switch x {
+	case GravityNorthWestValue:
+		return "GDK_GRAVITY_NORTH_WEST"
+	case GravityNorthValue:
+		return "GDK_GRAVITY_NORTH"
+	case GravityNorthEastValue:
+		return "GDK_GRAVITY_NORTH_EAST"
+	case GravityWestValue:
+		return "GDK_GRAVITY_WEST"
+	case GravityCenterValue:
+		return "GDK_GRAVITY_CENTER"
+	case GravityEastValue:
+		return "GDK_GRAVITY_EAST"
+	case GravitySouthWestValue:
+		return "GDK_GRAVITY_SOUTH_WEST"
+	case GravitySouthValue:
+		return "GDK_GRAVITY_SOUTH"
+	case GravitySouthEastValue:
+		return "GDK_GRAVITY_SOUTH_EAST"
+	case GravityStaticValue:
+		return "GDK_GRAVITY_STATIC"
+	}
+	return fmt.Sprintf("Gravity(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Gravity) Nick() string {
+	switch x {
+	case GravityNorthWestValue:
+		return "north-west"
+	case GravityNorthValue:
+		return "north"
+	case GravityNorthEastValue:
+		return "north-east"
+	case GravityWestValue:
+		return "west"
+	case GravityCenterValue:
+		return "center"
+	case GravityEastValue:
+		return "east"
+	case GravitySouthWestValue:
+		return "south-west"
+	case GravitySouthValue:
+		return "south"
+	case GravitySouthEastValue:
+		return "south-east"
+	case GravityStaticValue:
+		return "static"
+	}
+	return ""
+}
+
+// GravityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GravityFromString(s string) (Gravity, bool) {
+	switch s {
+	case "GDK_GRAVITY_NORTH_WEST", "north-west":
+		return GravityNorthWestValue, true
+	case "GDK_GRAVITY_NORTH", "north":
+		return GravityNorthValue, true
+	case "GDK_GRAVITY_NORTH_EAST", "north-east":
+		return GravityNorthEastValue, true
+	case "GDK_GRAVITY_WEST", "west":
+		return GravityWestValue, true
+	case "GDK_GRAVITY_CENTER", "center":
+		return GravityCenterValue, true
+	case "GDK_GRAVITY_EAST", "east":
+		return GravityEastValue, true
+	case "GDK_GRAVITY_SOUTH_WEST", "south-west":
+		return GravitySouthWestValue, true
+	case "GDK_GRAVITY_SOUTH", "south":
+		return GravitySouthValue, true
+	case "GDK_GRAVITY_SOUTH_EAST", "south-east":
+		return GravitySouthEastValue, true
+	case "GDK_GRAVITY_STATIC", "static":
+		return GravityStaticValue, true
+	}
+	return 0, false
+}
+
 // Describes formats that image data can have in memory.
 //
 // It describes formats by listing the contents of the memory passed to it.
@@ -272,8 +836,10 @@ const (
 // on architectures with different endiannesses.
 //
 // Its naming is modelled after
-// [VkFormat](https://www.khronos.org/registry/vulkan/specs/1.0/html/vkspec.html#VkFormat)
+// [VkFormat]
 // for details).
+//
+// [VkFormat]: https://www.khronos.org/registry/vulkan/specs/1.0/html/vkspec.html#VkFormat
 type MemoryFormat int
 
 var xMemoryFormatGLibType func() types.GType
@@ -812,6 +1378,423 @@ const (
 	MemoryNFormatsValue MemoryFormat = 65
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x MemoryFormat) String() string {
+	switch x {
+	case MemoryB8g8r8a8PremultipliedValue:
+		return "GDK_MEMORY_B8G8R8A8_PREMULTIPLIED"
+	case MemoryA8r8g8b8PremultipliedValue:
+		return "GDK_MEMORY_A8R8G8B8_PREMULTIPLIED"
+	case MemoryR8g8b8a8PremultipliedValue:
+		return "GDK_MEMORY_R8G8B8A8_PREMULTIPLIED"
+	case MemoryB8g8r8a8Value:
+		return "GDK_MEMORY_B8G8R8A8"
+	case MemoryA8r8g8b8Value:
+		return "GDK_MEMORY_A8R8G8B8"
+	case MemoryR8g8b8a8Value:
+		return "GDK_MEMORY_R8G8B8A8"
+	case MemoryA8b8g8r8Value:
+		return "GDK_MEMORY_A8B8G8R8"
+	case MemoryR8g8b8Value:
+		return "GDK_MEMORY_R8G8B8"
+	case MemoryB8g8r8Value:
+		return "GDK_MEMORY_B8G8R8"
+	case MemoryR16g16b16Value:
+		return "GDK_MEMORY_R16G16B16"
+	case MemoryR16g16b16a16PremultipliedValue:
+		return "GDK_MEMORY_R16G16B16A16_PREMULTIPLIED"
+	case MemoryR16g16b16a16Value:
+		return "GDK_MEMORY_R16G16B16A16"
+	case MemoryR16g16b16FloatValue:
+		return "GDK_MEMORY_R16G16B16_FLOAT"
+	case MemoryR16g16b16a16FloatPremultipliedValue:
+		return "GDK_MEMORY_R16G16B16A16_FLOAT_PREMULTIPLIED"
+	case MemoryR16g16b16a16FloatValue:
+		return "GDK_MEMORY_R16G16B16A16_FLOAT"
+	case MemoryR32g32b32FloatValue:
+		return "GDK_MEMORY_R32G32B32_FLOAT"
+	case MemoryR32g32b32a32FloatPremultipliedValue:
+		return "GDK_MEMORY_R32G32B32A32_FLOAT_PREMULTIPLIED"
+	case MemoryR32g32b32a32FloatValue:
+		return "GDK_MEMORY_R32G32B32A32_FLOAT"
+	case MemoryG8a8PremultipliedValue:
+		return "GDK_MEMORY_G8A8_PREMULTIPLIED"
+	case MemoryG8a8Value:
+		return "GDK_MEMORY_G8A8"
+	case MemoryG8Value:
+		return "GDK_MEMORY_G8"
+	case MemoryG16a16PremultipliedValue:
+		return "GDK_MEMORY_G16A16_PREMULTIPLIED"
+	case MemoryG16a16Value:
+		return "GDK_MEMORY_G16A16"
+	case MemoryG16Value:
+		return "GDK_MEMORY_G16"
+	case MemoryA8Value:
+		return "GDK_MEMORY_A8"
+	case MemoryA16Value:
+		return "GDK_MEMORY_A16"
+	case MemoryA16FloatValue:
+		return "GDK_MEMORY_A16_FLOAT"
+	case MemoryA32FloatValue:
+		return "GDK_MEMORY_A32_FLOAT"
+	case MemoryA8b8g8r8PremultipliedValue:
+		return "GDK_MEMORY_A8B8G8R8_PREMULTIPLIED"
+	case MemoryB8g8r8x8Value:
+		return "GDK_MEMORY_B8G8R8X8"
+	case MemoryX8r8g8b8Value:
+		return "GDK_MEMORY_X8R8G8B8"
+	case MemoryR8g8b8x8Value:
+		return "GDK_MEMORY_R8G8B8X8"
+	case MemoryX8b8g8r8Value:
+		return "GDK_MEMORY_X8B8G8R8"
+	case MemoryG8B8r8420Value:
+		return "GDK_MEMORY_G8_B8R8_420"
+	case MemoryG8R8b8420Value:
+		return "GDK_MEMORY_G8_R8B8_420"
+	case MemoryG8B8r8422Value:
+		return "GDK_MEMORY_G8_B8R8_422"
+	case MemoryG8R8b8422Value:
+		return "GDK_MEMORY_G8_R8B8_422"
+	case MemoryG8B8r8444Value:
+		return "GDK_MEMORY_G8_B8R8_444"
+	case MemoryG8R8b8444Value:
+		return "GDK_MEMORY_G8_R8B8_444"
+	case MemoryG10x6B10x6r10x6420Value:
+		return "GDK_MEMORY_G10X6_B10X6R10X6_420"
+	case MemoryG12x4B12x4r12x4420Value:
+		return "GDK_MEMORY_G12X4_B12X4R12X4_420"
+	case MemoryG16B16r16420Value:
+		return "GDK_MEMORY_G16_B16R16_420"
+	case MemoryG8B8R8410Value:
+		return "GDK_MEMORY_G8_B8_R8_410"
+	case MemoryG8R8B8410Value:
+		return "GDK_MEMORY_G8_R8_B8_410"
+	case MemoryG8B8R8411Value:
+		return "GDK_MEMORY_G8_B8_R8_411"
+	case MemoryG8R8B8411Value:
+		return "GDK_MEMORY_G8_R8_B8_411"
+	case MemoryG8B8R8420Value:
+		return "GDK_MEMORY_G8_B8_R8_420"
+	case MemoryG8R8B8420Value:
+		return "GDK_MEMORY_G8_R8_B8_420"
+	case MemoryG8B8R8422Value:
+		return "GDK_MEMORY_G8_B8_R8_422"
+	case MemoryG8R8B8422Value:
+		return "GDK_MEMORY_G8_R8_B8_422"
+	case MemoryG8B8R8444Value:
+		return "GDK_MEMORY_G8_B8_R8_444"
+	case MemoryG8R8B8444Value:
+		return "GDK_MEMORY_G8_R8_B8_444"
+	case MemoryG8b8g8r8422Value:
+		return "GDK_MEMORY_G8B8G8R8_422"
+	case MemoryG8r8g8b8422Value:
+		return "GDK_MEMORY_G8R8G8B8_422"
+	case MemoryR8g8b8g8422Value:
+		return "GDK_MEMORY_R8G8B8G8_422"
+	case MemoryB8g8r8g8422Value:
+		return "GDK_MEMORY_B8G8R8G8_422"
+	case MemoryX6g10X6b10X6r10420Value:
+		return "GDK_MEMORY_X6G10_X6B10_X6R10_420"
+	case MemoryX6g10X6b10X6r10422Value:
+		return "GDK_MEMORY_X6G10_X6B10_X6R10_422"
+	case MemoryX6g10X6b10X6r10444Value:
+		return "GDK_MEMORY_X6G10_X6B10_X6R10_444"
+	case MemoryX4g12X4b12X4r12420Value:
+		return "GDK_MEMORY_X4G12_X4B12_X4R12_420"
+	case MemoryX4g12X4b12X4r12422Value:
+		return "GDK_MEMORY_X4G12_X4B12_X4R12_422"
+	case MemoryX4g12X4b12X4r12444Value:
+		return "GDK_MEMORY_X4G12_X4B12_X4R12_444"
+	case MemoryG16B16R16420Value:
+		return "GDK_MEMORY_G16_B16_R16_420"
+	case MemoryG16B16R16422Value:
+		return "GDK_MEMORY_G16_B16_R16_422"
+	case MemoryG16B16R16444Value:
+		return "GDK_MEMORY_G16_B16_R16_444"
+	case MemoryNFormatsValue:
+		return "GDK_MEMORY_N_FORMATS"
+	}
+	return fmt.Sprintf("MemoryFormat(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MemoryFormat) Nick() string {
+	switch x {
+	case MemoryB8g8r8a8PremultipliedValue:
+		return "b8g8r8a8-premultiplied"
+	case MemoryA8r8g8b8PremultipliedValue:
+		return "a8r8g8b8-premultiplied"
+	case MemoryR8g8b8a8PremultipliedValue:
+		return "r8g8b8a8-premultiplied"
+	case MemoryB8g8r8a8Value:
+		return "b8g8r8a8"
+	case MemoryA8r8g8b8Value:
+		return "a8r8g8b8"
+	case MemoryR8g8b8a8Value:
+		return "r8g8b8a8"
+	case MemoryA8b8g8r8Value:
+		return "a8b8g8r8"
+	case MemoryR8g8b8Value:
+		return "r8g8b8"
+	case MemoryB8g8r8Value:
+		return "b8g8r8"
+	case MemoryR16g16b16Value:
+		return "r16g16b16"
+	case MemoryR16g16b16a16PremultipliedValue:
+		return "r16g16b16a16-premultiplied"
+	case MemoryR16g16b16a16Value:
+		return "r16g16b16a16"
+	case MemoryR16g16b16FloatValue:
+		return "r16g16b16-float"
+	case MemoryR16g16b16a16FloatPremultipliedValue:
+		return "r16g16b16a16-float-premultiplied"
+	case MemoryR16g16b16a16FloatValue:
+		return "r16g16b16a16-float"
+	case MemoryR32g32b32FloatValue:
+		return "r32g32b32-float"
+	case MemoryR32g32b32a32FloatPremultipliedValue:
+		return "r32g32b32a32-float-premultiplied"
+	case MemoryR32g32b32a32FloatValue:
+		return "r32g32b32a32-float"
+	case MemoryG8a8PremultipliedValue:
+		return "g8a8-premultiplied"
+	case MemoryG8a8Value:
+		return "g8a8"
+	case MemoryG8Value:
+		return "g8"
+	case MemoryG16a16PremultipliedValue:
+		return "g16a16-premultiplied"
+	case MemoryG16a16Value:
+		return "g16a16"
+	case MemoryG16Value:
+		return "g16"
+	case MemoryA8Value:
+		return "a8"
+	case MemoryA16Value:
+		return "a16"
+	case MemoryA16FloatValue:
+		return "a16-float"
+	case MemoryA32FloatValue:
+		return "a32-float"
+	case MemoryA8b8g8r8PremultipliedValue:
+		return "a8b8g8r8-premultiplied"
+	case MemoryB8g8r8x8Value:
+		return "b8g8r8x8"
+	case MemoryX8r8g8b8Value:
+		return "x8r8g8b8"
+	case MemoryR8g8b8x8Value:
+		return "r8g8b8x8"
+	case MemoryX8b8g8r8Value:
+		return "x8b8g8r8"
+	case MemoryG8B8r8420Value:
+		return "g8-b8r8-420"
+	case MemoryG8R8b8420Value:
+		return "g8-r8b8-420"
+	case MemoryG8B8r8422Value:
+		return "g8-b8r8-422"
+	case MemoryG8R8b8422Value:
+		return "g8-r8b8-422"
+	case MemoryG8B8r8444Value:
+		return "g8-b8r8-444"
+	case MemoryG8R8b8444Value:
+		return "g8-r8b8-444"
+	case MemoryG10x6B10x6r10x6420Value:
+		return "g10x6-b10x6r10x6-420"
+	case MemoryG12x4B12x4r12x4420Value:
+		return "g12x4-b12x4r12x4-420"
+	case MemoryG16B16r16420Value:
+		return "g16-b16r16-420"
+	case MemoryG8B8R8410Value:
+		return "g8-b8-r8-410"
+	case MemoryG8R8B8410Value:
+		return "g8-r8-b8-410"
+	case MemoryG8B8R8411Value:
+		return "g8-b8-r8-411"
+	case MemoryG8R8B8411Value:
+		return "g8-r8-b8-411"
+	case MemoryG8B8R8420Value:
+		return "g8-b8-r8-420"
+	case MemoryG8R8B8420Value:
+		return "g8-r8-b8-420"
+	case MemoryG8B8R8422Value:
+		return "g8-b8-r8-422"
+	case MemoryG8R8B8422Value:
+		return "g8-r8-b8-422"
+	case MemoryG8B8R8444Value:
+		return "g8-b8-r8-444"
+	case MemoryG8R8B8444Value:
+		return "g8-r8-b8-444"
+	case MemoryG8b8g8r8422Value:
+		return "g8b8g8r8-422"
+	case MemoryG8r8g8b8422Value:
+		return "g8r8g8b8-422"
+	case MemoryR8g8b8g8422Value:
+		return "r8g8b8g8-422"
+	case MemoryB8g8r8g8422Value:
+		return "b8g8r8g8-422"
+	case MemoryX6g10X6b10X6r10420Value:
+		return "x6g10-x6b10-x6r10-420"
+	case MemoryX6g10X6b10X6r10422Value:
+		return "x6g10-x6b10-x6r10-422"
+	case MemoryX6g10X6b10X6r10444Value:
+		return "x6g10-x6b10-x6r10-444"
+	case MemoryX4g12X4b12X4r12420Value:
+		return "x4g12-x4b12-x4r12-420"
+	case MemoryX4g12X4b12X4r12422Value:
+		return "x4g12-x4b12-x4r12-422"
+	case MemoryX4g12X4b12X4r12444Value:
+		return "x4g12-x4b12-x4r12-444"
+	case MemoryG16B16R16420Value:
+		return "g16-b16-r16-420"
+	case MemoryG16B16R16422Value:
+		return "g16-b16-r16-422"
+	case MemoryG16B16R16444Value:
+		return "g16-b16-r16-444"
+	case MemoryNFormatsValue:
+		return "n-formats"
+	}
+	return ""
+}
+
+// MemoryFormatFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MemoryFormatFromString(s string) (MemoryFormat, bool) {
+	switch s {
+	case "GDK_MEMORY_B8G8R8A8_PREMULTIPLIED", "b8g8r8a8-premultiplied":
+		return MemoryB8g8r8a8PremultipliedValue, true
+	case "GDK_MEMORY_A8R8G8B8_PREMULTIPLIED", "a8r8g8b8-premultiplied":
+		return MemoryA8r8g8b8PremultipliedValue, true
+	case "GDK_MEMORY_R8G8B8A8_PREMULTIPLIED", "r8g8b8a8-premultiplied":
+		return MemoryR8g8b8a8PremultipliedValue, true
+	case "GDK_MEMORY_B8G8R8A8", "b8g8r8a8":
+		return MemoryB8g8r8a8Value, true
+	case "GDK_MEMORY_A8R8G8B8", "a8r8g8b8":
+		return MemoryA8r8g8b8Value, true
+	case "GDK_MEMORY_R8G8B8A8", "r8g8b8a8":
+		return MemoryR8g8b8a8Value, true
+	case "GDK_MEMORY_A8B8G8R8", "a8b8g8r8":
+		return MemoryA8b8g8r8Value, true
+	case "GDK_MEMORY_R8G8B8", "r8g8b8":
+		return MemoryR8g8b8Value, true
+	case "GDK_MEMORY_B8G8R8", "b8g8r8":
+		return MemoryB8g8r8Value, true
+	case "GDK_MEMORY_R16G16B16", "r16g16b16":
+		return MemoryR16g16b16Value, true
+	case "GDK_MEMORY_R16G16B16A16_PREMULTIPLIED", "r16g16b16a16-premultiplied":
+		return MemoryR16g16b16a16PremultipliedValue, true
+	case "GDK_MEMORY_R16G16B16A16", "r16g16b16a16":
+		return MemoryR16g16b16a16Value, true
+	case "GDK_MEMORY_R16G16B16_FLOAT", "r16g16b16-float":
+		return MemoryR16g16b16FloatValue, true
+	case "GDK_MEMORY_R16G16B16A16_FLOAT_PREMULTIPLIED", "r16g16b16a16-float-premultiplied":
+		return MemoryR16g16b16a16FloatPremultipliedValue, true
+	case "GDK_MEMORY_R16G16B16A16_FLOAT", "r16g16b16a16-float":
+		return MemoryR16g16b16a16FloatValue, true
+	case "GDK_MEMORY_R32G32B32_FLOAT", "r32g32b32-float":
+		return MemoryR32g32b32FloatValue, true
+	case "GDK_MEMORY_R32G32B32A32_FLOAT_PREMULTIPLIED", "r32g32b32a32-float-premultiplied":
+		return MemoryR32g32b32a32FloatPremultipliedValue, true
+	case "GDK_MEMORY_R32G32B32A32_FLOAT", "r32g32b32a32-float":
+		return MemoryR32g32b32a32FloatValue, true
+	case "GDK_MEMORY_G8A8_PREMULTIPLIED", "g8a8-premultiplied":
+		return MemoryG8a8PremultipliedValue, true
+	case "GDK_MEMORY_G8A8", "g8a8":
+		return MemoryG8a8Value, true
+	case "GDK_MEMORY_G8", "g8":
+		return MemoryG8Value, true
+	case "GDK_MEMORY_G16A16_PREMULTIPLIED", "g16a16-premultiplied":
+		return MemoryG16a16PremultipliedValue, true
+	case "GDK_MEMORY_G16A16", "g16a16":
+		return MemoryG16a16Value, true
+	case "GDK_MEMORY_G16", "g16":
+		return MemoryG16Value, true
+	case "GDK_MEMORY_A8", "a8":
+		return MemoryA8Value, true
+	case "GDK_MEMORY_A16", "a16":
+		return MemoryA16Value, true
+	case "GDK_MEMORY_A16_FLOAT", "a16-float":
+		return MemoryA16FloatValue, true
+	case "GDK_MEMORY_A32_FLOAT", "a32-float":
+		return MemoryA32FloatValue, true
+	case "GDK_MEMORY_A8B8G8R8_PREMULTIPLIED", "a8b8g8r8-premultiplied":
+		return MemoryA8b8g8r8PremultipliedValue, true
+	case "GDK_MEMORY_B8G8R8X8", "b8g8r8x8":
+		return MemoryB8g8r8x8Value, true
+	case "GDK_MEMORY_X8R8G8B8", "x8r8g8b8":
+		return MemoryX8r8g8b8Value, true
+	case "GDK_MEMORY_R8G8B8X8", "r8g8b8x8":
+		return MemoryR8g8b8x8Value, true
+	case "GDK_MEMORY_X8B8G8R8", "x8b8g8r8":
+		return MemoryX8b8g8r8Value, true
+	case "GDK_MEMORY_G8_B8R8_420", "g8-b8r8-420":
+		return MemoryG8B8r8420Value, true
+	case "GDK_MEMORY_G8_R8B8_420", "g8-r8b8-420":
+		return MemoryG8R8b8420Value, true
+	case "GDK_MEMORY_G8_B8R8_422", "g8-b8r8-422":
+		return MemoryG8B8r8422Value, true
+	case "GDK_MEMORY_G8_R8B8_422", "g8-r8b8-422":
+		return MemoryG8R8b8422Value, true
+	case "GDK_MEMORY_G8_B8R8_444", "g8-b8r8-444":
+		return MemoryG8B8r8444Value, true
+	case "GDK_MEMORY_G8_R8B8_444", "g8-r8b8-444":
+		return MemoryG8R8b8444Value, true
+	case "GDK_MEMORY_G10X6_B10X6R10X6_420", "g10x6-b10x6r10x6-420":
+		return MemoryG10x6B10x6r10x6420Value, true
+	case "GDK_MEMORY_G12X4_B12X4R12X4_420", "g12x4-b12x4r12x4-420":
+		return MemoryG12x4B12x4r12x4420Value, true
+	case "GDK_MEMORY_G16_B16R16_420", "g16-b16r16-420":
+		return MemoryG16B16r16420Value, true
+	case "GDK_MEMORY_G8_B8_R8_410", "g8-b8-r8-410":
+		return MemoryG8B8R8410Value, true
+	case "GDK_MEMORY_G8_R8_B8_410", "g8-r8-b8-410":
+		return MemoryG8R8B8410Value, true
+	case "GDK_MEMORY_G8_B8_R8_411", "g8-b8-r8-411":
+		return MemoryG8B8R8411Value, true
+	case "GDK_MEMORY_G8_R8_B8_411", "g8-r8-b8-411":
+		return MemoryG8R8B8411Value, true
+	case "GDK_MEMORY_G8_B8_R8_420", "g8-b8-r8-420":
+		return MemoryG8B8R8420Value, true
+	case "GDK_MEMORY_G8_R8_B8_420", "g8-r8-b8-420":
+		return MemoryG8R8B8420Value, true
+	case "GDK_MEMORY_G8_B8_R8_422", "g8-b8-r8-422":
+		return MemoryG8B8R8422Value, true
+	case "GDK_MEMORY_G8_R8_B8_422", "g8-r8-b8-422":
+		return MemoryG8R8B8422Value, true
+	case "GDK_MEMORY_G8_B8_R8_444", "g8-b8-r8-444":
+		return MemoryG8B8R8444Value, true
+	case "GDK_MEMORY_G8_R8_B8_444", "g8-r8-b8-444":
+		return MemoryG8R8B8444Value, true
+	case "GDK_MEMORY_G8B8G8R8_422", "g8b8g8r8-422":
+		return MemoryG8b8g8r8422Value, true
+	case "GDK_MEMORY_G8R8G8B8_422", "g8r8g8b8-422":
+		return MemoryG8r8g8b8422Value, true
+	case "GDK_MEMORY_R8G8B8G8_422", "r8g8b8g8-422":
+		return MemoryR8g8b8g8422Value, true
+	case "GDK_MEMORY_B8G8R8G8_422", "b8g8r8g8-422":
+		return MemoryB8g8r8g8422Value, true
+	case "GDK_MEMORY_X6G10_X6B10_X6R10_420", "x6g10-x6b10-x6r10-420":
+		return MemoryX6g10X6b10X6r10420Value, true
+	case "GDK_MEMORY_X6G10_X6B10_X6R10_422", "x6g10-x6b10-x6r10-422":
+		return MemoryX6g10X6b10X6r10422Value, true
+	case "GDK_MEMORY_X6G10_X6B10_X6R10_444", "x6g10-x6b10-x6r10-444":
+		return MemoryX6g10X6b10X6r10444Value, true
+	case "GDK_MEMORY_X4G12_X4B12_X4R12_420", "x4g12-x4b12-x4r12-420":
+		return MemoryX4g12X4b12X4r12420Value, true
+	case "GDK_MEMORY_X4G12_X4B12_X4R12_422", "x4g12-x4b12-x4r12-422":
+		return MemoryX4g12X4b12X4r12422Value, true
+	case "GDK_MEMORY_X4G12_X4B12_X4R12_444", "x4g12-x4b12-x4r12-444":
+		return MemoryX4g12X4b12X4r12444Value, true
+	case "GDK_MEMORY_G16_B16_R16_420", "g16-b16-r16-420":
+		return MemoryG16B16R16420Value, true
+	case "GDK_MEMORY_G16_B16_R16_422", "g16-b16-r16-422":
+		return MemoryG16B16R16422Value, true
+	case "GDK_MEMORY_G16_B16_R16_444", "g16-b16-r16-444":
+		return MemoryG16B16R16444Value, true
+	case "GDK_MEMORY_N_FORMATS", "n-formats":
+		return MemoryNFormatsValue, true
+	}
+	return 0, false
+}
+
 // Used in scroll events, to announce the direction relative
 // to physical motion.
 type ScrollRelativeDirection int
@@ -832,6 +1815,45 @@ const (
 	ScrollRelativeDirectionUnknownValue ScrollRelativeDirection = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollRelativeDirection) String() string {
+	switch x {
+	case ScrollRelativeDirectionIdenticalValue:
+		return "GDK_SCROLL_RELATIVE_DIRECTION_IDENTICAL"
+	case ScrollRelativeDirectionInvertedValue:
+		return "GDK_SCROLL_RELATIVE_DIRECTION_INVERTED"
+	case ScrollRelativeDirectionUnknownValue:
+		return "GDK_SCROLL_RELATIVE_DIRECTION_UNKNOWN"
+	}
+	return fmt.Sprintf("ScrollRelativeDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollRelativeDirection) Nick() string {
+	switch x {
+	case ScrollRelativeDirectionIdenticalValue:
+		return "identical"
+	case ScrollRelativeDirectionInvertedValue:
+		return "inverted"
+	case ScrollRelativeDirectionUnknownValue:
+		return "unknown"
+	}
+	return ""
+}
+
+// ScrollRelativeDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollRelativeDirectionFromString(s string) (ScrollRelativeDirection, bool) {
+	switch s {
+	case "GDK_SCROLL_RELATIVE_DIRECTION_IDENTICAL", "identical":
+		return ScrollRelativeDirectionIdenticalValue, true
+	case "GDK_SCROLL_RELATIVE_DIRECTION_INVERTED", "inverted":
+		return ScrollRelativeDirectionInvertedValue, true
+	case "GDK_SCROLL_RELATIVE_DIRECTION_UNKNOWN", "unknown":
+		return ScrollRelativeDirectionUnknownValue, true
+	}
+	return 0, false
+}
+
 // Error enumeration for `GdkVulkanContext`.
 type VulkanError int
 
@@ -849,3 +1871,88 @@ const (
 	// Vulkan support is not available on this Surface
 	VulkanErrorNotAvailableValue VulkanError = 1
 )
+
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (VulkanError) ErrorDomain() string {
+	return "gdk-vulkan-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x VulkanError) Error() string {
+	switch x {
+	case VulkanErrorUnsupportedValue:
+		return "GDK_VULKAN_ERROR_UNSUPPORTED"
+	case VulkanErrorNotAvailableValue:
+		return "GDK_VULKAN_ERROR_NOT_AVAILABLE"
+	}
+	return fmt.Sprintf("gdk-vulkan-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x VulkanError) String() string {
+	switch x {
+	case VulkanErrorUnsupportedValue:
+		return "GDK_VULKAN_ERROR_UNSUPPORTED"
+	case VulkanErrorNotAvailableValue:
+		return "GDK_VULKAN_ERROR_NOT_AVAILABLE"
+	}
+	return fmt.Sprintf("VulkanError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x VulkanError) Nick() string {
+	switch x {
+	case VulkanErrorUnsupportedValue:
+		return "unsupported"
+	case VulkanErrorNotAvailableValue:
+		return "not-available"
+	}
+	return ""
+}
+
+// VulkanErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func VulkanErrorFromString(s string) (VulkanError, bool) {
+	switch s {
+	case "GDK_VULKAN_ERROR_UNSUPPORTED", "unsupported":
+		return VulkanErrorUnsupportedValue, true
+	case "GDK_VULKAN_ERROR_NOT_AVAILABLE", "not-available":
+		return VulkanErrorNotAvailableValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xAxisFlagsGLibType, libs, "gdk_axis_flags_get_type")
+
+	core.PuregoSafeRegister(&xDragActionGLibType, libs, "gdk_drag_action_get_type")
+
+	core.PuregoSafeRegister(&xGLAPIGLibType, libs, "gdk_gl_api_get_type")
+
+	core.PuregoSafeRegister(&xModifierTypeGLibType, libs, "gdk_modifier_type_get_type")
+
+	core.PuregoSafeRegister(&xAxisUseGLibType, libs, "gdk_axis_use_get_type")
+
+	core.PuregoSafeRegister(&xDmabufErrorGLibType, libs, "gdk_dmabuf_error_get_type")
+
+	core.PuregoSafeRegister(&xGLErrorGLibType, libs, "gdk_gl_error_get_type")
+
+	core.PuregoSafeRegister(&xGravityGLibType, libs, "gdk_gravity_get_type")
+
+	core.PuregoSafeRegister(&xMemoryFormatGLibType, libs, "gdk_memory_format_get_type")
+
+	core.PuregoSafeRegister(&xScrollRelativeDirectionGLibType, libs, "gdk_scroll_relative_direction_get_type")
+
+	core.PuregoSafeRegister(&xVulkanErrorGLibType, libs, "gdk_vulkan_error_get_type")
+
+}
@@ -2,10 +2,10 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,6 +26,21 @@ func (x *EventSequence) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the EventSequence made with g_boxed_copy, release it with Free.
+func (x *EventSequence) Copy() *EventSequence {
+	cret := gobject.BoxedCopy(EventSequenceGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*EventSequence)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the EventSequence with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *EventSequence) Free() {
+	gobject.BoxedFree(EventSequenceGLibType(), x.GoPointer())
+}
+
 const (
 	// The middle button.
 	BUTTON_MIDDLE int = 2
@@ -82,6 +97,81 @@ const (
 	CrossingDeviceSwitchValue CrossingMode = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CrossingMode) String() string {
+	switch x {
+	case CrossingNormalValue:
+		return "GDK_CROSSING_NORMAL"
+	case CrossingGrabValue:
+		return "GDK_CROSSING_GRAB"
+	case CrossingUngrabValue:
+		return "GDK_CROSSING_UNGRAB"
+	case CrossingGtkGrabValue:
+		return "GDK_CROSSING_GTK_GRAB"
+	case CrossingGtkUngrabValue:
+		return "GDK_CROSSING_GTK_UNGRAB"
+	case CrossingStateChangedValue:
+		return "GDK_CROSSING_STATE_CHANGED"
+	case CrossingTouchBeginValue:
+		return "GDK_CROSSING_TOUCH_BEGIN"
+	case CrossingTouchEndValue:
+		return "GDK_CROSSING_TOUCH_END"
+	case CrossingDeviceSwitchValue:
+		return "GDK_CROSSING_DEVICE_SWITCH"
+	}
+	return fmt.Sprintf("CrossingMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CrossingMode) Nick() string {
+	switch x {
+	case CrossingNormalValue:
+		return "normal"
+	case CrossingGrabValue:
+		return "grab"
+	case CrossingUngrabValue:
+		return "ungrab"
+	case CrossingGtkGrabValue:
+		return "gtk-grab"
+	case CrossingGtkUngrabValue:
+		return "gtk-ungrab"
+	case CrossingStateChangedValue:
+		return "state-changed"
+	case CrossingTouchBeginValue:
+		return "touch-begin"
+	case CrossingTouchEndValue:
+		return "touch-end"
+	case CrossingDeviceSwitchValue:
+		return "device-switch"
+	}
+	return ""
+}
+
+// CrossingModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CrossingModeFromString(s string) (CrossingMode, bool) {
+	switch s {
+	case "GDK_CROSSING_NORMAL", "normal":
+		return CrossingNormalValue, true
+	case "GDK_CROSSING_GRAB", "grab":
+		return CrossingGrabValue, true
+	case "GDK_CROSSING_UNGRAB", "ungrab":
+		return CrossingUngrabValue, true
+	case "GDK_CROSSING_GTK_GRAB", "gtk-grab":
+		return CrossingGtkGrabValue, true
+	case "GDK_CROSSING_GTK_UNGRAB", "gtk-ungrab":
+		return CrossingGtkUngrabValue, true
+	case "GDK_CROSSING_STATE_CHANGED", "state-changed":
+		return CrossingStateChangedValue, true
+	case "GDK_CROSSING_TOUCH_BEGIN", "touch-begin":
+		return CrossingTouchBeginValue, true
+	case "GDK_CROSSING_TOUCH_END", "touch-end":
+		return CrossingTouchEndValue, true
+	case "GDK_CROSSING_DEVICE_SWITCH", "device-switch":
+		return CrossingDeviceSwitchValue, true
+	}
+	return 0, false
+}
+
 // Specifies the type of the event.
 type EventType int
 
@@ -165,9 +255,216 @@ const (
 	EventLastValue EventType = 30
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x EventType) String() string {
+	switch x {
+	case DeleteValue:
+		return "GDK_DELETE"
+	case MotionNotifyValue:
+		return "GDK_MOTION_NOTIFY"
+	case ButtonPressValue:
+		return "GDK_BUTTON_PRESS"
+	case ButtonReleaseValue:
+		return "GDK_BUTTON_RELEASE"
+	case KeyPressValue:
+		return "GDK_KEY_PRESS"
+	case KeyReleaseValue:
+		return "GDK_KEY_RELEASE"
+	case EnterNotifyValue:
+		return "GDK_ENTER_NOTIFY"
+	case LeaveNotifyValue:
+		return "GDK_LEAVE_NOTIFY"
+	case FocusChangeValue:
+		return "GDK_FOCUS_CHANGE"
+	case ProximityInValue:
+		return "GDK_PROXIMITY_IN"
+	case ProximityOutValue:
+		return "GDK_PROXIMITY_OUT"
+	case DragEnterValue:
+		return "GDK_DRAG_ENTER"
+	case DragLeaveValue:
+		return "GDK_DRAG_LEAVE"
+	case DragMotionValue:
+		return "GDK_DRAG_MOTION"
+	case DropStartValue:
+		return "GDK_DROP_START"
+	case ScrollValue:
+		return "GDK_SCROLL"
+	case GrabBrokenValue:
+		return "GDK_GRAB_BROKEN"
+	case TouchBeginValue:
+		return "GDK_TOUCH_BEGIN"
+	case TouchUpdateValue:
+		return "GDK_TOUCH_UPDATE"
+	case TouchEndValue:
+		return "GDK_TOUCH_END"
+	case TouchCancelValue:
+		return "GDK_TOUCH_CANCEL"
+	case TouchpadSwipeValue:
+		return "GDK_TOUCHPAD_SWIPE"
+	case TouchpadPinchValue:
+		return "GDK_TOUCHPAD_PINCH"
+	case PadButtonPressValue:
+		return "GDK_PAD_BUTTON_PRESS"
+	case PadButtonReleaseValue:
+		return "GDK_PAD_BUTTON_RELEASE"
+	case PadRingValue:
+		return "GDK_PAD_RING"
+	case PadStripValue:
+		return "GDK_PAD_STRIP"
+	case PadGroupModeValue:
+		return "GDK_PAD_GROUP_MODE"
+	case TouchpadHoldValue:
+		return "GDK_TOUCHPAD_HOLD"
+	case PadDialValue:
+		return "GDK_PAD_DIAL"
+	case EventLastValue:
+		return "GDK_EVENT_LAST"
+	}
+	return fmt.Sprintf("EventType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EventType) Nick() string {
+	switch x {
+	case DeleteValue:
+		return "delete"
+	case MotionNotifyValue:
+		return "motion-notify"
+	case ButtonPressValue:
+		return "button-press"
+	case ButtonReleaseValue:
+		return "button-release"
+	case KeyPressValue:
+		return "key-press"
+	case KeyReleaseValue:
+		return "key-release"
+	case EnterNotifyValue:
+		return "enter-notify"
+	case LeaveNotifyValue:
+		return "leave-notify"
+	case FocusChangeValue:
+		return "focus-change"
+	case ProximityInValue:
+		return "proximity-in"
+	case ProximityOutValue:
+		return "proximity-out"
+	case DragEnterValue:
+		return "drag-enter"
+	case DragLeaveValue:
+		return "drag-leave"
+	case DragMotionValue:
+		return "drag-motion"
+	case DropStartValue:
+		return "drop-start"
+	case ScrollValue:
+		return "scroll"
+	case GrabBrokenValue:
+		return "grab-broken"
+	case TouchBeginValue:
+		return "touch-begin"
+	case TouchUpdateValue:
+		return "touch-update"
+	case TouchEndValue:
+		return "touch-end"
+	case TouchCancelValue:
+		return "touch-cancel"
+	case TouchpadSwipeValue:
+		return "touchpad-swipe"
+	case TouchpadPinchValue:
+		return "touchpad-pinch"
+	case PadButtonPressValue:
+		return "pad-button-press"
+	case PadButtonReleaseValue:
+		return "pad-button-release"
+	case PadRingValue:
+		return "pad-ring"
+	case PadStripValue:
+		return "pad-strip"
+	case PadGroupModeValue:
+		return "pad-group-mode"
+	case TouchpadHoldValue:
+		return "touchpad-hold"
+	case PadDialValue:
+		return "pad-dial"
+	case EventLastValue:
+		return "event-last"
+	}
+	return ""
+}
+
+// EventTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EventTypeFromString(s string) (EventType, bool) {
+	switch s {
+	case "GDK_DELETE", "delete":
+		return DeleteValue, true
+	case "GDK_MOTION_NOTIFY", "motion-notify":
+		return MotionNotifyValue, true
+	case "GDK_BUTTON_PRESS", "button-press":
+		return ButtonPressValue, true
+	case "GDK_BUTTON_RELEASE", "button-release":
+		return ButtonReleaseValue, true
+	case "GDK_KEY_PRESS", "key-press":
+		return KeyPressValue, true
+	case "GDK_KEY_RELEASE", "key-release":
+		return KeyReleaseValue, true
+	case "GDK_ENTER_NOTIFY", "enter-notify":
+		return EnterNotifyValue, true
+	case "GDK_LEAVE_NOTIFY", "leave-notify":
+		return LeaveNotifyValue, true
+	case "GDK_FOCUS_CHANGE", "focus-change":
+		return FocusChangeValue, true
+	case "GDK_PROXIMITY_IN", "proximity-in":
+		return ProximityInValue, true
+	case "GDK_PROXIMITY_OUT", "proximity-out":
+		return ProximityOutValue, true
+	case "GDK_DRAG_ENTER", "drag-enter":
+		return DragEnterValue, true
+	case "GDK_DRAG_LEAVE", "drag-leave":
+		return DragLeaveValue, true
+	case "GDK_DRAG_MOTION", "drag-motion":
+		return DragMotionValue, true
+	case "GDK_DROP_START", "drop-start":
+		return DropStartValue, true
+	case "GDK_SCROLL", "scroll":
+		return ScrollValue, true
+	case "GDK_GRAB_BROKEN", "grab-broken":
+		return GrabBrokenValue, true
+	case "GDK_TOUCH_BEGIN", "touch-begin":
+		return TouchBeginValue, true
+	case "GDK_TOUCH_UPDATE", "touch-update":
+		return TouchUpdateValue, true
+	case "GDK_TOUCH_END", "touch-end":
+		return TouchEndValue, true
+	case "GDK_TOUCH_CANCEL", "touch-cancel":
+		return TouchCancelValue, true
+	case "GDK_TOUCHPAD_SWIPE", "touchpad-swipe":
+		return TouchpadSwipeValue, true
+	case "GDK_TOUCHPAD_PINCH", "touchpad-pinch":
+		return TouchpadPinchValue, true
+	case "GDK_PAD_BUTTON_PRESS", "pad-button-press":
+		return PadButtonPressValue, true
+	case "GDK_PAD_BUTTON_RELEASE", "pad-button-release":
+		return PadButtonReleaseValue, true
+	case "GDK_PAD_RING", "pad-ring":
+		return PadRingValue, true
+	case "GDK_PAD_STRIP", "pad-strip":
+		return PadStripValue, true
+	case "GDK_PAD_GROUP_MODE", "pad-group-mode":
+		return PadGroupModeValue, true
+	case "GDK_TOUCHPAD_HOLD", "touchpad-hold":
+		return TouchpadHoldValue, true
+	case "GDK_PAD_DIAL", "pad-dial":
+		return PadDialValue, true
+	case "GDK_EVENT_LAST", "event-last":
+		return EventLastValue, true
+	}
+	return 0, false
+}
+
 // Describes how well an event matches a given keyval and modifiers.
 //
-// `GdkKeyMatch` values are returned by [method@Gdk.KeyEvent.matches].
+// `GdkKeyMatch` values are returned by [KeyEvent.Matches].
 type KeyMatch int
 
 var xKeyMatchGLibType func() types.GType
@@ -187,6 +484,45 @@ const (
 	KeyMatchExactValue KeyMatch = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x KeyMatch) String() string {
+	switch x {
+	case KeyMatchNoneValue:
+		return "GDK_KEY_MATCH_NONE"
+	case KeyMatchPartialValue:
+		return "GDK_KEY_MATCH_PARTIAL"
+	case KeyMatchExactValue:
+		return "GDK_KEY_MATCH_EXACT"
+	}
+	return fmt.Sprintf("KeyMatch(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x KeyMatch) Nick() string {
+	switch x {
+	case KeyMatchNoneValue:
+		return "none"
+	case KeyMatchPartialValue:
+		return "partial"
+	case KeyMatchExactValue:
+		return "exact"
+	}
+	return ""
+}
+
+// KeyMatchFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func KeyMatchFromString(s string) (KeyMatch, bool) {
+	switch s {
+	case "GDK_KEY_MATCH_NONE", "none":
+		return KeyMatchNoneValue, true
+	case "GDK_KEY_MATCH_PARTIAL", "partial":
+		return KeyMatchPartialValue, true
+	case "GDK_KEY_MATCH_EXACT", "exact":
+		return KeyMatchExactValue, true
+	}
+	return 0, false
+}
+
 // Specifies the kind of crossing for enter and leave events.
 //
 // See the X11 protocol specification of LeaveNotify for
@@ -222,6 +558,63 @@ const (
 	NotifyUnknownValue NotifyType = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x NotifyType) String() string {
+	switch x {
+	case NotifyAncestorValue:
+		return "GDK_NOTIFY_ANCESTOR"
+	case NotifyVirtualValue:
+		return "GDK_NOTIFY_VIRTUAL"
+	case NotifyInferiorValue:
+		return "GDK_NOTIFY_INFERIOR"
+	case NotifyNonlinearValue:
+		return "GDK_NOTIFY_NONLINEAR"
+	case NotifyNonlinearVirtualValue:
+		return "GDK_NOTIFY_NONLINEAR_VIRTUAL"
+	case NotifyUnknownValue:
+		return "GDK_NOTIFY_UNKNOWN"
+	}
+	return fmt.Sprintf("NotifyType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NotifyType) Nick() string {
+	switch x {
+	case NotifyAncestorValue:
+		return "ancestor"
+	case NotifyVirtualValue:
+		return "virtual"
+	case NotifyInferiorValue:
+		return "inferior"
+	case NotifyNonlinearValue:
+		return "nonlinear"
+	case NotifyNonlinearVirtualValue:
+		return "nonlinear-virtual"
+	case NotifyUnknownValue:
+		return "unknown"
+	}
+	return ""
+}
+
+// NotifyTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NotifyTypeFromString(s string) (NotifyType, bool) {
+	switch s {
+	case "GDK_NOTIFY_ANCESTOR", "ancestor":
+		return NotifyAncestorValue, true
+	case "GDK_NOTIFY_VIRTUAL", "virtual":
+		return NotifyVirtualValue, true
+	case "GDK_NOTIFY_INFERIOR", "inferior":
+		return NotifyInferiorValue, true
+	case "GDK_NOTIFY_NONLINEAR", "nonlinear":
+		return NotifyNonlinearValue, true
+	case "GDK_NOTIFY_NONLINEAR_VIRTUAL", "nonlinear-virtual":
+		return NotifyNonlinearVirtualValue, true
+	case "GDK_NOTIFY_UNKNOWN", "unknown":
+		return NotifyUnknownValue, true
+	}
+	return 0, false
+}
+
 // Specifies the direction for scroll events.
 type ScrollDirection int
 
@@ -242,22 +635,73 @@ const (
 	// the surface is scrolled to the right.
 	ScrollRightValue ScrollDirection = 3
 	// the scrolling is determined by the delta values
-	//   in scroll events. See gdk_scroll_event_get_deltas()
+	//   in scroll events. See [ScrollEvent.GetDeltas]
 	ScrollSmoothValue ScrollDirection = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollDirection) String() string {
+	switch x {
+	case ScrollUpValue:
+		return "GDK_SCROLL_UP"
+	case ScrollDownValue:
+		return "GDK_SCROLL_DOWN"
+	case ScrollLeftValue:
+		return "GDK_SCROLL_LEFT"
+	case ScrollRightValue:
+		return "GDK_SCROLL_RIGHT"
+	case ScrollSmoothValue:
+		return "GDK_SCROLL_SMOOTH"
+	}
+	return fmt.Sprintf("ScrollDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollDirection) Nick() string {
+	switch x {
+	case ScrollUpValue:
+		return "up"
+	case ScrollDownValue:
+		return "down"
+	case ScrollLeftValue:
+		return "left"
+	case ScrollRightValue:
+		return "right"
+	case ScrollSmoothValue:
+		return "smooth"
+	}
+	return ""
+}
+
+// ScrollDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollDirectionFromString(s string) (ScrollDirection, bool) {
+	switch s {
+	case "GDK_SCROLL_UP", "up":
+		return ScrollUpValue, true
+	case "GDK_SCROLL_DOWN", "down":
+		return ScrollDownValue, true
+	case "GDK_SCROLL_LEFT", "left":
+		return ScrollLeftValue, true
+	case "GDK_SCROLL_RIGHT", "right":
+		return ScrollRightValue, true
+	case "GDK_SCROLL_SMOOTH", "smooth":
+		return ScrollSmoothValue, true
+	}
+	return 0, false
+}
+
 // Specifies the unit of scroll deltas.
 //
-// When you get %GDK_SCROLL_UNIT_WHEEL, a delta of 1.0 means 1 wheel detent
+// When you get [ScrollUnitWheelValue], a delta of 1.0 means 1 wheel detent
 // click in the south direction, 2.0 means 2 wheel detent clicks in the south
 // direction... This is the same logic for negative values but in the north
 // direction.
 //
-// If you get %GDK_SCROLL_UNIT_SURFACE, are managing a scrollable view and get a
+// If you get [ScrollUnitSurfaceValue], are managing a scrollable view and get a
 // value of 123, you have to scroll 123 surface logical pixels right if it's
-// @delta_x or down if it's @delta_y. This is the same logic for negative values
-// but you have to scroll left instead of right if it's @delta_x and up instead
-// of down if it's @delta_y.
+// delta_x or down if it's delta_y. This is the same logic for negative values
+// but you have to scroll left instead of right if it's delta_x and up instead
+// of down if it's delta_y.
 //
 // 1 surface logical pixel is equal to 1 real screen pixel multiplied by the
 // final scale factor of your graphical interface (the product of the desktop
@@ -279,14 +723,47 @@ const (
 	ScrollUnitSurfaceValue ScrollUnit = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollUnit) String() string {
+	switch x {
+	case ScrollUnitWheelValue:
+		return "GDK_SCROLL_UNIT_WHEEL"
+	case ScrollUnitSurfaceValue:
+		return "GDK_SCROLL_UNIT_SURFACE"
+	}
+	return fmt.Sprintf("ScrollUnit(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollUnit) Nick() string {
+	switch x {
+	case ScrollUnitWheelValue:
+		return "wheel"
+	case ScrollUnitSurfaceValue:
+		return "surface"
+	}
+	return ""
+}
+
+// ScrollUnitFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollUnitFromString(s string) (ScrollUnit, bool) {
+	switch s {
+	case "GDK_SCROLL_UNIT_WHEEL", "wheel":
+		return ScrollUnitWheelValue, true
+	case "GDK_SCROLL_UNIT_SURFACE", "surface":
+		return ScrollUnitSurfaceValue, true
+	}
+	return 0, false
+}
+
 // Specifies the current state of a touchpad gesture.
 //
 // All gestures are guaranteed to begin with an event with phase
-// %GDK_TOUCHPAD_GESTURE_PHASE_BEGIN, followed by 0 or several events
-// with phase %GDK_TOUCHPAD_GESTURE_PHASE_UPDATE.
+// [TouchpadGesturePhaseBeginValue], followed by 0 or several events
+// with phase [TouchpadGesturePhaseUpdateValue].
 //
 // A finished gesture may have 2 possible outcomes, an event with phase
-// %GDK_TOUCHPAD_GESTURE_PHASE_END will be emitted when the gesture is
+// [TouchpadGesturePhaseEndValue] will be emitted when the gesture is
 // considered successful, this should be used as the hint to perform any
 // permanent changes.
 //
@@ -294,7 +771,7 @@ const (
 // or the compositor, or due to the gesture recognition layers hinting the
 // gesture did not finish resolutely (eg. a 3rd finger being added during
 // a pinch gesture). In these cases, the last event will report the phase
-// %GDK_TOUCHPAD_GESTURE_PHASE_CANCEL, this should be used as a hint
+// [TouchpadGesturePhaseCancelValue], this should be used as a hint
 // to undo any visible/permanent changes that were done throughout the
 // progress of the gesture.
 type TouchpadGesturePhase int
@@ -319,16 +796,61 @@ const (
 	TouchpadGesturePhaseCancelValue TouchpadGesturePhase = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TouchpadGesturePhase) String() string {
+	switch x {
+	case TouchpadGesturePhaseBeginValue:
+		return "GDK_TOUCHPAD_GESTURE_PHASE_BEGIN"
+	case TouchpadGesturePhaseUpdateValue:
+		return "GDK_TOUCHPAD_GESTURE_PHASE_UPDATE"
+	case TouchpadGesturePhaseEndValue:
+		return "GDK_TOUCHPAD_GESTURE_PHASE_END"
+	case TouchpadGesturePhaseCancelValue:
+		return "GDK_TOUCHPAD_GESTURE_PHASE_CANCEL"
+	}
+	return fmt.Sprintf("TouchpadGesturePhase(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TouchpadGesturePhase) Nick() string {
+	switch x {
+	case TouchpadGesturePhaseBeginValue:
+		return "begin"
+	case TouchpadGesturePhaseUpdateValue:
+		return "update"
+	case TouchpadGesturePhaseEndValue:
+		return "end"
+	case TouchpadGesturePhaseCancelValue:
+		return "cancel"
+	}
+	return ""
+}
+
+// TouchpadGesturePhaseFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TouchpadGesturePhaseFromString(s string) (TouchpadGesturePhase, bool) {
+	switch s {
+	case "GDK_TOUCHPAD_GESTURE_PHASE_BEGIN", "begin":
+		return TouchpadGesturePhaseBeginValue, true
+	case "GDK_TOUCHPAD_GESTURE_PHASE_UPDATE", "update":
+		return TouchpadGesturePhaseUpdateValue, true
+	case "GDK_TOUCHPAD_GESTURE_PHASE_END", "end":
+		return TouchpadGesturePhaseEndValue, true
+	case "GDK_TOUCHPAD_GESTURE_PHASE_CANCEL", "cancel":
+		return TouchpadGesturePhaseCancelValue, true
+	}
+	return 0, false
+}
+
 var xEventsGetAngle func(uintptr, uintptr, *float64) bool
 
-// Returns the relative angle from @event1 to @event2.
+// Returns the relative angle from event1 to event2.
 //
 // The relative angle is the angle between the X axis and the line
 // through both events' positions. The rotation direction for positive
 // angles is from the positive X axis towards the positive Y axis.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
 func EventsGetAngle(Event1Var *Event, Event2Var *Event, AngleVar *float64) bool {
 
 	cret := xEventsGetAngle(Event1Var.GoPointer(), Event2Var.GoPointer(), AngleVar)
@@ -340,7 +862,7 @@ var xEventsGetCenter func(uintptr, uintptr, *float64, *float64) bool
 // Returns the point halfway between the events' positions.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
 func EventsGetCenter(Event1Var *Event, Event2Var *Event, XVar *float64, YVar *float64) bool {
 
 	cret := xEventsGetCenter(Event1Var.GoPointer(), Event2Var.GoPointer(), XVar, YVar)
@@ -352,7 +874,7 @@ var xEventsGetDistance func(uintptr, uintptr, *float64) bool
 // Returns the distance between the event locations.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
 func EventsGetDistance(Event1Var *Event, Event2Var *Event, DistanceVar *float64) bool {
 
 	cret := xEventsGetDistance(Event1Var.GoPointer(), Event2Var.GoPointer(), DistanceVar)
@@ -424,7 +946,7 @@ func (x *CrossingEvent) GetDetail() NotifyType {
 
 var xCrossingEventGetFocus func(uintptr) bool
 
-// Checks if the @event surface is the focus surface.
+// Checks if the event surface is the focus surface.
 func (x *CrossingEvent) GetFocus() bool {
 
 	cret := xCrossingEventGetFocus(x.GoPointer())
@@ -547,52 +1069,46 @@ func EventNewFromInternalPtr(ptr uintptr) *Event {
 	return cls
 }
 
-var xEventGetAngle func(uintptr, uintptr, *float64) bool
-
-// Returns the relative angle from @event1 to @event2.
+// Returns the relative angle from event1 to event2.
 //
 // The relative angle is the angle between the X axis and the line
 // through both events' positions. The rotation direction for positive
 // angles is from the positive X axis towards the positive Y axis.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
+//
+// Deprecated: use EventsGetAngle, this name of gdk_events_get_angle is kept for compatibility.
 func (x *Event) GetAngle(Event2Var *Event, AngleVar *float64) bool {
-
-	cret := xEventGetAngle(x.GoPointer(), Event2Var.GoPointer(), AngleVar)
-	return cret
+	return EventsGetAngle(x, Event2Var, AngleVar)
 }
 
-var xEventGetCenter func(uintptr, uintptr, *float64, *float64) bool
-
 // Returns the point halfway between the events' positions.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
+//
+// Deprecated: use EventsGetCenter, this name of gdk_events_get_center is kept for compatibility.
 func (x *Event) GetCenter(Event2Var *Event, XVar *float64, YVar *float64) bool {
-
-	cret := xEventGetCenter(x.GoPointer(), Event2Var.GoPointer(), XVar, YVar)
-	return cret
+	return EventsGetCenter(x, Event2Var, XVar, YVar)
 }
 
-var xEventGetDistance func(uintptr, uintptr, *float64) bool
-
 // Returns the distance between the event locations.
 //
 // This assumes that both events have X/Y information.
-// If not, this function returns %FALSE.
+// If not, this function returns false.
+//
+// Deprecated: use EventsGetDistance, this name of gdk_events_get_distance is kept for compatibility.
 func (x *Event) GetDistance(Event2Var *Event, DistanceVar *float64) bool {
-
-	cret := xEventGetDistance(x.GoPointer(), Event2Var.GoPointer(), DistanceVar)
-	return cret
+	return EventsGetDistance(x, Event2Var, DistanceVar)
 }
 
 var xEventGetAxes func(uintptr, *[]float64, *uint) bool
 
 // Extracts all axis values from an event.
 //
-// To find out which axes are used, use [method@Gdk.DeviceTool.get_axes]
-// on the device tool returned by [method@Gdk.Event.get_device_tool].
+// To find out which axes are used, use [DeviceTool.GetAxes]
+// on the device tool returned by [Event.GetDeviceTool].
 func (x *Event) GetAxes(AxesVar *[]float64, NAxesVar *uint) bool {
 
 	cret := xEventGetAxes(x.GoPointer(), AxesVar, NAxesVar)
@@ -604,8 +1120,8 @@ var xEventGetAxis func(uintptr, AxisUse, *float64) bool
 // Extract the axis value for a particular axis use from
 // an event structure.
 //
-// To find out which axes are used, use [method@Gdk.DeviceTool.get_axes]
-// on the device tool returned by [method@Gdk.Event.get_device_tool].
+// To find out which axes are used, use [DeviceTool.GetAxes]
+// on the device tool returned by [Event.GetDeviceTool].
 func (x *Event) GetAxis(AxisUseVar AxisUse, ValueVar *float64) bool {
 
 	cret := xEventGetAxis(x.GoPointer(), AxisUseVar, ValueVar)
@@ -636,11 +1152,11 @@ var xEventGetDeviceTool func(uintptr) uintptr
 //
 // If the was not generated by a device that supports
 // different tools (such as a tablet), this function will
-// return %NULL.
+// return nil.
 //
 // Note: the `GdkDeviceTool` will be constant during
 // the application lifetime, if settings must be stored
-// persistently across runs, see [method@Gdk.DeviceTool.get_serial].
+// persistently across runs, see [DeviceTool.GetSerial].
 func (x *Event) GetDeviceTool() *DeviceTool {
 	var cls *DeviceTool
 
@@ -657,7 +1173,7 @@ func (x *Event) GetDeviceTool() *DeviceTool {
 
 var xEventGetDisplay func(uintptr) uintptr
 
-// Retrieves the display associated to the @event.
+// Retrieves the display associated to the event.
 func (x *Event) GetDisplay() *Display {
 	var cls *Display
 
@@ -695,11 +1211,11 @@ func (x *Event) GetEventType() EventType {
 
 var xEventGetHistory func(uintptr, *uint) uintptr
 
-// Retrieves the history of the device that @event is for, as a list of
+// Retrieves the history of the device that event is for, as a list of
 // time and coordinates.
 //
 // The history includes positions that are not delivered as separate events
-// to the application because they occurred in the same frame as @event.
+// to the application because they occurred in the same frame as event.
 //
 // Note that only motion and scroll events record history, and motion
 // events do it only if one of the mouse buttons is down, or the device
@@ -734,7 +1250,7 @@ var xEventGetPosition func(uintptr, *float64, *float64) bool
 
 // Extract the event surface relative x/y coordinates from an event.
 //
-// This position is in [surface coordinates](coordinates.html).
+// This position is in surface coordinates.
 func (x *Event) GetPosition(XVar *float64, YVar *float64) bool {
 
 	cret := xEventGetPosition(x.GoPointer(), XVar, YVar)
@@ -777,10 +1293,10 @@ func (x *Event) GetSurface() *Surface {
 
 var xEventGetTime func(uintptr) uint32
 
-// Returns the timestamp of @event.
+// Returns the timestamp of event.
 //
 // Not all events have timestamps. In that case, this function
-// returns %GDK_CURRENT_TIME.
+// returns [CURRENT_TIME].
 func (x *Event) GetTime() uint32 {
 
 	cret := xEventGetTime(x.GoPointer())
@@ -789,7 +1305,7 @@ func (x *Event) GetTime() uint32 {
 
 var xEventRef func(uintptr) uintptr
 
-// Increase the ref count of @event.
+// Increase the ref count of event.
 func (x *Event) Ref() *Event {
 	var cls *Event
 
@@ -811,11 +1327,9 @@ var xEventTriggersContextMenu func(uintptr) bool
 // The right mouse button typically triggers context menus.
 // On macOS, Control+left mouse button also triggers.
 //
-// # This function should always be used instead of simply checking for
+// This function should always be used instead of simply checking for
 //
-// ```c
-// event-&gt;button == GDK_BUTTON_SECONDARY
-// ```
+//	event->button == GDK_BUTTON_SECONDARY
 func (x *Event) TriggersContextMenu() bool {
 
 	cret := xEventTriggersContextMenu(x.GoPointer())
@@ -824,7 +1338,7 @@ func (x *Event) TriggersContextMenu() bool {
 
 var xEventUnref func(uintptr)
 
-// Decrease the ref count of @event.
+// Decrease the ref count of event.
 //
 // If the last reference is dropped, the structure is freed.
 func (x *Event) Unref() {
@@ -1003,7 +1517,7 @@ var xKeyEventGetMatch func(uintptr, *uint, *ModifierType) bool
 // Gets a keyval and modifier combination that will match
 // the event.
 //
-// See [method@Gdk.KeyEvent.matches].
+// See [KeyEvent.Matches].
 func (x *KeyEvent) GetMatch(KeyvalVar *uint, ModifiersVar *ModifierType) bool {
 
 	cret := xKeyEventGetMatch(x.GoPointer(), KeyvalVar, ModifiersVar)
@@ -1180,10 +1694,10 @@ var xScrollEventGetDeltas func(uintptr, *float64, *float64)
 // Extracts the scroll deltas of a scroll event.
 //
 // The deltas will be zero unless the scroll direction
-// is %GDK_SCROLL_SMOOTH.
+// is [ScrollSmoothValue].
 //
 // For the representation unit of these deltas, see
-// [method@Gdk.ScrollEvent.get_unit].
+// [ScrollEvent.GetUnit].
 func (x *ScrollEvent) GetDeltas(DeltaXVar *float64, DeltaYVar *float64) {
 
 	xScrollEventGetDeltas(x.GoPointer(), DeltaXVar, DeltaYVar)
@@ -1203,8 +1717,8 @@ var xScrollEventGetUnit func(uintptr) ScrollUnit
 
 // Extracts the scroll delta unit of a scroll event.
 //
-// The unit will always be %GDK_SCROLL_UNIT_WHEEL if the scroll direction is not
-// %GDK_SCROLL_SMOOTH.
+// The unit will always be [ScrollUnitWheelValue] if the scroll direction is not
+// [ScrollSmoothValue].
 func (x *ScrollEvent) GetUnit() ScrollUnit {
 
 	cret := xScrollEventGetUnit(x.GoPointer())
@@ -1367,7 +1881,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1388,100 +1902,97 @@ func init() {
 
 	core.PuregoSafeRegister(&xTouchpadGesturePhaseGLibType, libs, "gdk_touchpad_gesture_phase_get_type")
 
-	core.PuregoSafeRegister(&xEventsGetAngle, libs, "gdk_events_get_angle")
-	core.PuregoSafeRegister(&xEventsGetCenter, libs, "gdk_events_get_center")
-	core.PuregoSafeRegister(&xEventsGetDistance, libs, "gdk_events_get_distance")
+	core.PuregoRegisterSince(&xEventsGetAngle, libs, "gdk_events_get_angle", "GDK", "")
+	core.PuregoRegisterSince(&xEventsGetCenter, libs, "gdk_events_get_center", "GDK", "")
+	core.PuregoRegisterSince(&xEventsGetDistance, libs, "gdk_events_get_distance", "GDK", "")
 
 	core.PuregoSafeRegister(&xEventSequenceGLibType, libs, "gdk_event_sequence_get_type")
 
 	core.PuregoSafeRegister(&xButtonEventGLibType, libs, "gdk_button_event_get_type")
 
-	core.PuregoSafeRegister(&xButtonEventGetButton, libs, "gdk_button_event_get_button")
+	core.PuregoRegisterSince(&xButtonEventGetButton, libs, "gdk_button_event_get_button", "GDK", "")
 
 	core.PuregoSafeRegister(&xCrossingEventGLibType, libs, "gdk_crossing_event_get_type")
 
-	core.PuregoSafeRegister(&xCrossingEventGetDetail, libs, "gdk_crossing_event_get_detail")
-	core.PuregoSafeRegister(&xCrossingEventGetFocus, libs, "gdk_crossing_event_get_focus")
-	core.PuregoSafeRegister(&xCrossingEventGetMode, libs, "gdk_crossing_event_get_mode")
+	core.PuregoRegisterSince(&xCrossingEventGetDetail, libs, "gdk_crossing_event_get_detail", "GDK", "")
+	core.PuregoRegisterSince(&xCrossingEventGetFocus, libs, "gdk_crossing_event_get_focus", "GDK", "")
+	core.PuregoRegisterSince(&xCrossingEventGetMode, libs, "gdk_crossing_event_get_mode", "GDK", "")
 
 	core.PuregoSafeRegister(&xDNDEventGLibType, libs, "gdk_dnd_event_get_type")
 
-	core.PuregoSafeRegister(&xDNDEventGetDrop, libs, "gdk_dnd_event_get_drop")
+	core.PuregoRegisterSince(&xDNDEventGetDrop, libs, "gdk_dnd_event_get_drop", "GDK", "")
 
 	core.PuregoSafeRegister(&xDeleteEventGLibType, libs, "gdk_delete_event_get_type")
 
 	core.PuregoSafeRegister(&xEventGLibType, libs, "gdk_event_get_type")
 
-	core.PuregoSafeRegister(&xEventGetAngle, libs, "gdk_events_get_angle")
-	core.PuregoSafeRegister(&xEventGetCenter, libs, "gdk_events_get_center")
-	core.PuregoSafeRegister(&xEventGetDistance, libs, "gdk_events_get_distance")
-	core.PuregoSafeRegister(&xEventGetAxes, libs, "gdk_event_get_axes")
-	core.PuregoSafeRegister(&xEventGetAxis, libs, "gdk_event_get_axis")
-	core.PuregoSafeRegister(&xEventGetDevice, libs, "gdk_event_get_device")
-	core.PuregoSafeRegister(&xEventGetDeviceTool, libs, "gdk_event_get_device_tool")
-	core.PuregoSafeRegister(&xEventGetDisplay, libs, "gdk_event_get_display")
-	core.PuregoSafeRegister(&xEventGetEventSequence, libs, "gdk_event_get_event_sequence")
-	core.PuregoSafeRegister(&xEventGetEventType, libs, "gdk_event_get_event_type")
-	core.PuregoSafeRegister(&xEventGetHistory, libs, "gdk_event_get_history")
-	core.PuregoSafeRegister(&xEventGetModifierState, libs, "gdk_event_get_modifier_state")
-	core.PuregoSafeRegister(&xEventGetPointerEmulated, libs, "gdk_event_get_pointer_emulated")
-	core.PuregoSafeRegister(&xEventGetPosition, libs, "gdk_event_get_position")
-	core.PuregoSafeRegister(&xEventGetSeat, libs, "gdk_event_get_seat")
-	core.PuregoSafeRegister(&xEventGetSurface, libs, "gdk_event_get_surface")
-	core.PuregoSafeRegister(&xEventGetTime, libs, "gdk_event_get_time")
-	core.PuregoSafeRegister(&xEventRef, libs, "gdk_event_ref")
-	core.PuregoSafeRegister(&xEventTriggersContextMenu, libs, "gdk_event_triggers_context_menu")
-	core.PuregoSafeRegister(&xEventUnref, libs, "gdk_event_unref")
+	core.PuregoRegisterSince(&xEventGetAxes, libs, "gdk_event_get_axes", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetAxis, libs, "gdk_event_get_axis", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetDevice, libs, "gdk_event_get_device", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetDeviceTool, libs, "gdk_event_get_device_tool", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetDisplay, libs, "gdk_event_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetEventSequence, libs, "gdk_event_get_event_sequence", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetEventType, libs, "gdk_event_get_event_type", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetHistory, libs, "gdk_event_get_history", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetModifierState, libs, "gdk_event_get_modifier_state", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetPointerEmulated, libs, "gdk_event_get_pointer_emulated", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetPosition, libs, "gdk_event_get_position", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetSeat, libs, "gdk_event_get_seat", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetSurface, libs, "gdk_event_get_surface", "GDK", "")
+	core.PuregoRegisterSince(&xEventGetTime, libs, "gdk_event_get_time", "GDK", "")
+	core.PuregoRegisterSince(&xEventRef, libs, "gdk_event_ref", "GDK", "")
+	core.PuregoRegisterSince(&xEventTriggersContextMenu, libs, "gdk_event_triggers_context_menu", "GDK", "")
+	core.PuregoRegisterSince(&xEventUnref, libs, "gdk_event_unref", "GDK", "")
 
 	core.PuregoSafeRegister(&xFocusEventGLibType, libs, "gdk_focus_event_get_type")
 
-	core.PuregoSafeRegister(&xFocusEventGetIn, libs, "gdk_focus_event_get_in")
+	core.PuregoRegisterSince(&xFocusEventGetIn, libs, "gdk_focus_event_get_in", "GDK", "")
 
 	core.PuregoSafeRegister(&xGrabBrokenEventGLibType, libs, "gdk_grab_broken_event_get_type")
 
-	core.PuregoSafeRegister(&xGrabBrokenEventGetGrabSurface, libs, "gdk_grab_broken_event_get_grab_surface")
-	core.PuregoSafeRegister(&xGrabBrokenEventGetImplicit, libs, "gdk_grab_broken_event_get_implicit")
+	core.PuregoRegisterSince(&xGrabBrokenEventGetGrabSurface, libs, "gdk_grab_broken_event_get_grab_surface", "GDK", "")
+	core.PuregoRegisterSince(&xGrabBrokenEventGetImplicit, libs, "gdk_grab_broken_event_get_implicit", "GDK", "")
 
 	core.PuregoSafeRegister(&xKeyEventGLibType, libs, "gdk_key_event_get_type")
 
-	core.PuregoSafeRegister(&xKeyEventGetConsumedModifiers, libs, "gdk_key_event_get_consumed_modifiers")
-	core.PuregoSafeRegister(&xKeyEventGetKeycode, libs, "gdk_key_event_get_keycode")
-	core.PuregoSafeRegister(&xKeyEventGetKeyval, libs, "gdk_key_event_get_keyval")
-	core.PuregoSafeRegister(&xKeyEventGetLayout, libs, "gdk_key_event_get_layout")
-	core.PuregoSafeRegister(&xKeyEventGetLevel, libs, "gdk_key_event_get_level")
-	core.PuregoSafeRegister(&xKeyEventGetMatch, libs, "gdk_key_event_get_match")
-	core.PuregoSafeRegister(&xKeyEventIsModifier, libs, "gdk_key_event_is_modifier")
-	core.PuregoSafeRegister(&xKeyEventMatches, libs, "gdk_key_event_matches")
+	core.PuregoRegisterSince(&xKeyEventGetConsumedModifiers, libs, "gdk_key_event_get_consumed_modifiers", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventGetKeycode, libs, "gdk_key_event_get_keycode", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventGetKeyval, libs, "gdk_key_event_get_keyval", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventGetLayout, libs, "gdk_key_event_get_layout", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventGetLevel, libs, "gdk_key_event_get_level", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventGetMatch, libs, "gdk_key_event_get_match", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventIsModifier, libs, "gdk_key_event_is_modifier", "GDK", "")
+	core.PuregoRegisterSince(&xKeyEventMatches, libs, "gdk_key_event_matches", "GDK", "")
 
 	core.PuregoSafeRegister(&xMotionEventGLibType, libs, "gdk_motion_event_get_type")
 
 	core.PuregoSafeRegister(&xPadEventGLibType, libs, "gdk_pad_event_get_type")
 
-	core.PuregoSafeRegister(&xPadEventGetAxisValue, libs, "gdk_pad_event_get_axis_value")
-	core.PuregoSafeRegister(&xPadEventGetButton, libs, "gdk_pad_event_get_button")
-	core.PuregoSafeRegister(&xPadEventGetGroupMode, libs, "gdk_pad_event_get_group_mode")
+	core.PuregoRegisterSince(&xPadEventGetAxisValue, libs, "gdk_pad_event_get_axis_value", "GDK", "")
+	core.PuregoRegisterSince(&xPadEventGetButton, libs, "gdk_pad_event_get_button", "GDK", "")
+	core.PuregoRegisterSince(&xPadEventGetGroupMode, libs, "gdk_pad_event_get_group_mode", "GDK", "")
 
 	core.PuregoSafeRegister(&xProximityEventGLibType, libs, "gdk_proximity_event_get_type")
 
 	core.PuregoSafeRegister(&xScrollEventGLibType, libs, "gdk_scroll_event_get_type")
 
-	core.PuregoSafeRegister(&xScrollEventGetDeltas, libs, "gdk_scroll_event_get_deltas")
-	core.PuregoSafeRegister(&xScrollEventGetDirection, libs, "gdk_scroll_event_get_direction")
-	core.PuregoSafeRegister(&xScrollEventGetUnit, libs, "gdk_scroll_event_get_unit")
-	core.PuregoSafeRegister(&xScrollEventIsStop, libs, "gdk_scroll_event_is_stop")
+	core.PuregoRegisterSince(&xScrollEventGetDeltas, libs, "gdk_scroll_event_get_deltas", "GDK", "")
+	core.PuregoRegisterSince(&xScrollEventGetDirection, libs, "gdk_scroll_event_get_direction", "GDK", "")
+	core.PuregoRegisterSince(&xScrollEventGetUnit, libs, "gdk_scroll_event_get_unit", "GDK", "4.8")
+	core.PuregoRegisterSince(&xScrollEventIsStop, libs, "gdk_scroll_event_is_stop", "GDK", "")
 
-	core.PuregoSafeRegister(&xScrollEventGetRelativeDirection, libs, "gdk_scroll_event_get_relative_direction")
+	core.PuregoRegisterSince(&xScrollEventGetRelativeDirection, libs, "gdk_scroll_event_get_relative_direction", "GDK", "")
 
 	core.PuregoSafeRegister(&xTouchEventGLibType, libs, "gdk_touch_event_get_type")
 
-	core.PuregoSafeRegister(&xTouchEventGetEmulatingPointer, libs, "gdk_touch_event_get_emulating_pointer")
+	core.PuregoRegisterSince(&xTouchEventGetEmulatingPointer, libs, "gdk_touch_event_get_emulating_pointer", "GDK", "")
 
 	core.PuregoSafeRegister(&xTouchpadEventGLibType, libs, "gdk_touchpad_event_get_type")
 
-	core.PuregoSafeRegister(&xTouchpadEventGetDeltas, libs, "gdk_touchpad_event_get_deltas")
-	core.PuregoSafeRegister(&xTouchpadEventGetGesturePhase, libs, "gdk_touchpad_event_get_gesture_phase")
-	core.PuregoSafeRegister(&xTouchpadEventGetNFingers, libs, "gdk_touchpad_event_get_n_fingers")
-	core.PuregoSafeRegister(&xTouchpadEventGetPinchAngleDelta, libs, "gdk_touchpad_event_get_pinch_angle_delta")
-	core.PuregoSafeRegister(&xTouchpadEventGetPinchScale, libs, "gdk_touchpad_event_get_pinch_scale")
+	core.PuregoRegisterSince(&xTouchpadEventGetDeltas, libs, "gdk_touchpad_event_get_deltas", "GDK", "")
+	core.PuregoRegisterSince(&xTouchpadEventGetGesturePhase, libs, "gdk_touchpad_event_get_gesture_phase", "GDK", "")
+	core.PuregoRegisterSince(&xTouchpadEventGetNFingers, libs, "gdk_touchpad_event_get_n_fingers", "GDK", "")
+	core.PuregoRegisterSince(&xTouchpadEventGetPinchAngleDelta, libs, "gdk_touchpad_event_get_pinch_angle_delta", "GDK", "")
+	core.PuregoRegisterSince(&xTouchpadEventGetPinchScale, libs, "gdk_touchpad_event_get_pinch_scale", "GDK", "")
 
 }
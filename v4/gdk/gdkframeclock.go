@@ -2,10 +2,11 @@
 package gdk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +32,7 @@ func (x *FrameClockPrivate) GoPointer() uintptr {
 // Used to represent the different paint clock phases that can be requested.
 //
 // The elements of the enumeration correspond to the signals of `GdkFrameClock`.
-type FrameClockPhase int
+type FrameClockPhase uint32
 
 var xFrameClockPhaseGLibType func() types.GType
 
@@ -59,6 +60,67 @@ const (
 	FrameClockPhaseAfterPaintValue FrameClockPhase = 64
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FrameClockPhase) GLibType() types.GType {
+	return xFrameClockPhaseGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FrameClockPhase) Has(flags FrameClockPhase) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FrameClockPhase) Set(flags FrameClockPhase) FrameClockPhase {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FrameClockPhase) Clear(flags FrameClockPhase) FrameClockPhase {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FrameClockPhase) String() string {
+	if x == 0 {
+		return "GDK_FRAME_CLOCK_PHASE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&FrameClockPhaseFlushEventsValue != 0 && x&FrameClockPhaseFlushEventsValue == FrameClockPhaseFlushEventsValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_FLUSH_EVENTS")
+		rest &^= FrameClockPhaseFlushEventsValue
+	}
+	if rest&FrameClockPhaseBeforePaintValue != 0 && x&FrameClockPhaseBeforePaintValue == FrameClockPhaseBeforePaintValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_BEFORE_PAINT")
+		rest &^= FrameClockPhaseBeforePaintValue
+	}
+	if rest&FrameClockPhaseUpdateValue != 0 && x&FrameClockPhaseUpdateValue == FrameClockPhaseUpdateValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_UPDATE")
+		rest &^= FrameClockPhaseUpdateValue
+	}
+	if rest&FrameClockPhaseLayoutValue != 0 && x&FrameClockPhaseLayoutValue == FrameClockPhaseLayoutValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_LAYOUT")
+		rest &^= FrameClockPhaseLayoutValue
+	}
+	if rest&FrameClockPhasePaintValue != 0 && x&FrameClockPhasePaintValue == FrameClockPhasePaintValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_PAINT")
+		rest &^= FrameClockPhasePaintValue
+	}
+	if rest&FrameClockPhaseResumeEventsValue != 0 && x&FrameClockPhaseResumeEventsValue == FrameClockPhaseResumeEventsValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_RESUME_EVENTS")
+		rest &^= FrameClockPhaseResumeEventsValue
+	}
+	if rest&FrameClockPhaseAfterPaintValue != 0 && x&FrameClockPhaseAfterPaintValue == FrameClockPhaseAfterPaintValue {
+		names = append(names, "GDK_FRAME_CLOCK_PHASE_AFTER_PAINT")
+		rest &^= FrameClockPhaseAfterPaintValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Tells the application when to update and repaint a surface.
 //
 // This may be synced to the vertical refresh rate of the monitor, for example.
@@ -73,23 +135,23 @@ const (
 // or with mozRequestAnimationFrame in Firefox, for example.
 //
 // A frame clock is idle until someone requests a frame with
-// [method@Gdk.FrameClock.request_phase]. At some later point that makes sense
+// [FrameClock.RequestPhase]. At some later point that makes sense
 // for the synchronization being implemented, the clock will process a frame and
 // emit signals for each phase that has been requested. (See the signals of the
 // `GdkFrameClock` class for documentation of the phases.
-// %GDK_FRAME_CLOCK_PHASE_UPDATE and the [signal@Gdk.FrameClock::update] signal
+// [FrameClockPhaseUpdateValue] and the [FrameClock.ConnectUpdateFunc] signal
 // are most interesting for application writers, and are used to update the
-// animations, using the frame time given by [method@Gdk.FrameClock.get_frame_time].
+// animations, using the frame time given by [FrameClock.GetFrameTime].
 //
 // The frame time is reported in microseconds and generally in the same
-// timescale as g_get_monotonic_time(), however, it is not the same
-// as g_get_monotonic_time(). The frame time does not advance during
+// timescale as [glib.GetMonotonicTime], however, it is not the same
+// as [glib.GetMonotonicTime]. The frame time does not advance during
 // the time a frame is being painted, and outside of a frame, an attempt
-// is made so that all calls to [method@Gdk.FrameClock.get_frame_time] that
+// is made so that all calls to [FrameClock.GetFrameTime] that
 // are called at a “similar” time get the same value. This means that
 // if different animations are timed by looking at the difference in
-// time between an initial value from [method@Gdk.FrameClock.get_frame_time]
-// and the value inside the [signal@Gdk.FrameClock::update] signal of the clock,
+// time between an initial value from [FrameClock.GetFrameTime]
+// and the value inside the [FrameClock.ConnectUpdateFunc] signal of the clock,
 // they will stay exactly synchronized.
 type FrameClock struct {
 	gobject.Object
@@ -111,10 +173,10 @@ var xFrameClockBeginUpdating func(uintptr)
 
 // Starts updates for an animation.
 //
-// Until a matching call to [method@Gdk.FrameClock.end_updating] is made,
+// Until a matching call to [FrameClock.EndUpdating] is made,
 // the frame clock will continually request a new frame with the
-// %GDK_FRAME_CLOCK_PHASE_UPDATE phase. This function may be called multiple
-// times and frames will be requested until gdk_frame_clock_end_updating()
+// [FrameClockPhaseUpdateValue] phase. This function may be called multiple
+// times and frames will be requested until [FrameClock.EndUpdating]
 // is called the same number of times.
 func (x *FrameClock) BeginUpdating() {
 
@@ -126,7 +188,7 @@ var xFrameClockEndUpdating func(uintptr)
 
 // Stops updates for an animation.
 //
-// See the documentation for [method@Gdk.FrameClock.begin_updating].
+// See the documentation for [FrameClock.BeginUpdating].
 func (x *FrameClock) EndUpdating() {
 
 	xFrameClockEndUpdating(x.GoPointer())
@@ -145,7 +207,7 @@ func (x *FrameClock) GetCurrentTimings() *FrameTimings {
 var xFrameClockGetFps func(uintptr) float64
 
 // Calculates the current frames-per-second, based on the
-// frame timings of @frame_clock.
+// frame timings of frame_clock.
 func (x *FrameClock) GetFps() float64 {
 
 	cret := xFrameClockGetFps(x.GoPointer())
@@ -183,10 +245,10 @@ var xFrameClockGetHistoryStart func(uintptr) int64
 //
 // `GdkFrameClock` internally keeps a history of `GdkFrameTimings`
 // objects for recent frames that can be retrieved with
-// [method@Gdk.FrameClock.get_timings]. The set of stored frames
+// [FrameClock.GetTimings]. The set of stored frames
 // is the set from the counter values given by
-// [method@Gdk.FrameClock.get_history_start] and
-// [method@Gdk.FrameClock.get_frame_counter], inclusive.
+// [FrameClock.GetHistoryStart] and
+// [FrameClock.GetFrameCounter], inclusive.
 func (x *FrameClock) GetHistoryStart() int64 {
 
 	cret := xFrameClockGetHistoryStart(x.GoPointer())
@@ -201,7 +263,7 @@ var xFrameClockGetRefreshInfo func(uintptr, int64, *int64, *int64)
 // known presentation time and refresh interval, and assuming that
 // presentation times are separated by the refresh interval,
 // predicts a presentation time that is a multiple of the refresh
-// interval after the last presentation time, and later than @base_time.
+// interval after the last presentation time, and later than base_time.
 func (x *FrameClock) GetRefreshInfo(BaseTimeVar int64, RefreshIntervalReturnVar *int64, PresentationTimeReturnVar *int64) {
 
 	xFrameClockGetRefreshInfo(x.GoPointer(), BaseTimeVar, RefreshIntervalReturnVar, PresentationTimeReturnVar)
@@ -214,8 +276,8 @@ var xFrameClockGetTimings func(uintptr, int64) *FrameTimings
 // for the current frame or a recent frame.
 //
 // The `GdkFrameTimings` object may not yet be complete: see
-// [method@Gdk.FrameTimings.get_complete] and
-// [method@Gdk.FrameClock.get_history_start].
+// [FrameTimings.GetComplete] and
+// [FrameClock.GetHistoryStart].
 func (x *FrameClock) GetTimings(FrameCounterVar int64) *FrameTimings {
 
 	cret := xFrameClockGetTimings(x.GoPointer(), FrameCounterVar)
@@ -228,11 +290,11 @@ var xFrameClockRequestPhase func(uintptr, FrameClockPhase)
 //
 // The signal corresponding the requested phase will be emitted the next
 // time the frame clock processes. Multiple calls to
-// gdk_frame_clock_request_phase() will be combined together
+// [FrameClock.RequestPhase] will be combined together
 // and only one frame processed. If you are displaying animated
 // content and want to continually request the
-// %GDK_FRAME_CLOCK_PHASE_UPDATE phase for a period of time,
-// you should use [method@Gdk.FrameClock.begin_updating] instead,
+// [FrameClockPhaseUpdateValue] phase for a period of time,
+// you should use [FrameClock.BeginUpdating] instead,
 // since this allows GTK to adjust system parameters to get maximally
 // smooth animations.
 func (x *FrameClock) RequestPhase(PhaseVar FrameClockPhase) {
@@ -255,80 +317,146 @@ func (c *FrameClock) SetGoPointer(ptr uintptr) {
 // This signal ends processing of the frame.
 //
 // Applications should generally not handle this signal.
+//
+// Deprecated: use ConnectAfterPaintFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectAfterPaint(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "after-paint", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "after-paint", cbRefPtr, cbPtr)
+}
+
+var xFrameClockAfterPaintTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockAfterPaintNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "after-paint", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAfterPaintFunc connects cb to the "after-paint" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectAfterPaintFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "after-paint", &xFrameClockAfterPaintTrampoline, xFrameClockAfterPaintNewTrampoline, cb)
 }
 
 // Begins processing of the frame.
 //
 // Applications should generally not handle this signal.
+//
+// Deprecated: use ConnectBeforePaintFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectBeforePaint(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "before-paint", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "before-paint", cbRefPtr, cbPtr)
+}
+
+var xFrameClockBeforePaintTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockBeforePaintNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "before-paint", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBeforePaintFunc connects cb to the "before-paint" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectBeforePaintFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "before-paint", &xFrameClockBeforePaintTrampoline, xFrameClockBeforePaintNewTrampoline, cb)
 }
 
 // Used to flush pending motion events that are being batched up and
 // compressed together.
 //
 // Applications should not handle this signal.
+//
+// Deprecated: use ConnectFlushEventsFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectFlushEvents(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "flush-events", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "flush-events", cbRefPtr, cbPtr)
+}
+
+var xFrameClockFlushEventsTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockFlushEventsNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "flush-events", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectFlushEventsFunc connects cb to the "flush-events" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectFlushEventsFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "flush-events", &xFrameClockFlushEventsTrampoline, xFrameClockFlushEventsNewTrampoline, cb)
 }
 
 // Emitted as the second step of toolkit and application processing
@@ -336,114 +464,202 @@ func (x *FrameClock) ConnectFlushEvents(cb *func(FrameClock)) uint {
 //
 // Any work to update sizes and positions of application elements
 // should be performed. GTK normally handles this internally.
+//
+// Deprecated: use ConnectLayoutFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectLayout(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "layout", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "layout", cbRefPtr, cbPtr)
+}
+
+var xFrameClockLayoutTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockLayoutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "layout", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLayoutFunc connects cb to the "layout" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectLayoutFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "layout", &xFrameClockLayoutTrampoline, xFrameClockLayoutNewTrampoline, cb)
 }
 
 // Emitted as the third step of toolkit and application processing
 // of the frame.
 //
 // The frame is repainted. GDK normally handles this internally and
-// emits [signal@Gdk.Surface::render] signals which are turned into
-// [GtkWidget::snapshot](../gtk4/signal.Widget.snapshot.html) signals
+// emits [Surface.ConnectRenderFunc] signals which are turned into
+// GtkWidget::snapshot signals
 // by GTK.
+//
+// Deprecated: use ConnectPaintFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectPaint(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "paint", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "paint", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFrameClockPaintTrampoline uintptr
+
+func xFrameClockPaintNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "paint", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPaintFunc connects cb to the "paint" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectPaintFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "paint", &xFrameClockPaintTrampoline, xFrameClockPaintNewTrampoline, cb)
 }
 
 // Emitted after processing of the frame is finished.
 //
 // This signal is handled internally by GTK to resume normal
 // event processing. Applications should not handle this signal.
+//
+// Deprecated: use ConnectResumeEventsFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectResumeEvents(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "resume-events", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "resume-events", cbRefPtr, cbPtr)
+}
+
+var xFrameClockResumeEventsTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockResumeEventsNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "resume-events", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResumeEventsFunc connects cb to the "resume-events" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectResumeEventsFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "resume-events", &xFrameClockResumeEventsTrampoline, xFrameClockResumeEventsNewTrampoline, cb)
 }
 
 // Emitted as the first step of toolkit and application processing
 // of the frame.
 //
-// Animations should be updated using [method@Gdk.FrameClock.get_frame_time].
+// Animations should be updated using [FrameClock.GetFrameTime].
 // Applications can connect directly to this signal, or use
-// [gtk_widget_add_tick_callback()](../gtk4/method.Widget.add_tick_callback.html)
+// [[gtk.Widget.AddTickCallback]](../gtk4/method.Widget.add_tick_callback.html)
 // as a more convenient interface.
+//
+// Deprecated: use ConnectUpdateFunc, which also accepts method values and closures.
 func (x *FrameClock) ConnectUpdate(cb *func(FrameClock)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "update", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FrameClock{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "update", cbRefPtr, cbPtr)
+}
+
+var xFrameClockUpdateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFrameClockUpdateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FrameClock))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FrameClock{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "update", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUpdateFunc connects cb to the "update" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FrameClock) ConnectUpdateFunc(cb func(FrameClock)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "update", &xFrameClockUpdateTrampoline, xFrameClockUpdateNewTrampoline, cb)
 }
 
 func init() {
@@ -451,7 +667,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -462,15 +678,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xFrameClockGLibType, libs, "gdk_frame_clock_get_type")
 
-	core.PuregoSafeRegister(&xFrameClockBeginUpdating, libs, "gdk_frame_clock_begin_updating")
-	core.PuregoSafeRegister(&xFrameClockEndUpdating, libs, "gdk_frame_clock_end_updating")
-	core.PuregoSafeRegister(&xFrameClockGetCurrentTimings, libs, "gdk_frame_clock_get_current_timings")
-	core.PuregoSafeRegister(&xFrameClockGetFps, libs, "gdk_frame_clock_get_fps")
-	core.PuregoSafeRegister(&xFrameClockGetFrameCounter, libs, "gdk_frame_clock_get_frame_counter")
-	core.PuregoSafeRegister(&xFrameClockGetFrameTime, libs, "gdk_frame_clock_get_frame_time")
-	core.PuregoSafeRegister(&xFrameClockGetHistoryStart, libs, "gdk_frame_clock_get_history_start")
-	core.PuregoSafeRegister(&xFrameClockGetRefreshInfo, libs, "gdk_frame_clock_get_refresh_info")
-	core.PuregoSafeRegister(&xFrameClockGetTimings, libs, "gdk_frame_clock_get_timings")
-	core.PuregoSafeRegister(&xFrameClockRequestPhase, libs, "gdk_frame_clock_request_phase")
+	core.PuregoRegisterSince(&xFrameClockBeginUpdating, libs, "gdk_frame_clock_begin_updating", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockEndUpdating, libs, "gdk_frame_clock_end_updating", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetCurrentTimings, libs, "gdk_frame_clock_get_current_timings", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetFps, libs, "gdk_frame_clock_get_fps", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetFrameCounter, libs, "gdk_frame_clock_get_frame_counter", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetFrameTime, libs, "gdk_frame_clock_get_frame_time", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetHistoryStart, libs, "gdk_frame_clock_get_history_start", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetRefreshInfo, libs, "gdk_frame_clock_get_refresh_info", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockGetTimings, libs, "gdk_frame_clock_get_timings", "GDK", "")
+	core.PuregoRegisterSince(&xFrameClockRequestPhase, libs, "gdk_frame_clock_request_phase", "GDK", "")
 
 }
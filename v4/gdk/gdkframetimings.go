@@ -5,15 +5,14 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Holds timing information for a single frame of the application’s displays.
 //
-// To retrieve `GdkFrameTimings` objects, use [method@Gdk.FrameClock.get_timings]
-// or [method@Gdk.FrameClock.get_current_timings]. The information in
+// To retrieve `GdkFrameTimings` objects, use [FrameClock.GetTimings]
+// or [FrameClock.GetCurrentTimings]. The information in
 // `GdkFrameTimings` is useful for precise synchronization of video with
 // the event or audio streams, and for measuring quality metrics for the
 // application’s display, such as latency and jitter.
@@ -33,7 +32,7 @@ func (x *FrameTimings) GoPointer() uintptr {
 
 var xFrameTimingsGetComplete func(uintptr) bool
 
-// Returns whether @timings are complete.
+// Returns whether timings are complete.
 //
 // The timing information in a `GdkFrameTimings` is filled in
 // incrementally as the frame as drawn and passed off to the
@@ -43,7 +42,7 @@ var xFrameTimingsGetComplete func(uintptr) bool
 // the information is not yet available, or because it isn't
 // available at all.
 //
-// Once this function returns %TRUE for a frame, you can be
+// Once this function returns true for a frame, you can be
 // certain that no further values will become available and be
 // stored in the `GdkFrameTimings`.
 func (x *FrameTimings) GetComplete() bool {
@@ -67,7 +66,7 @@ var xFrameTimingsGetFrameTime func(uintptr) int64
 // Returns the frame time for the frame.
 //
 // This is the time value that is typically used to time
-// animations for the frame. See [method@Gdk.FrameClock.get_frame_time].
+// animations for the frame. See [FrameClock.GetFrameTime].
 func (x *FrameTimings) GetFrameTime() int64 {
 
 	cret := xFrameTimingsGetFrameTime(x.GoPointer())
@@ -80,11 +79,11 @@ var xFrameTimingsGetPredictedPresentationTime func(uintptr) int64
 //
 // Although no predicted time may be available, if one is available,
 // it will be available while the frame is being generated, in contrast
-// to [method@Gdk.FrameTimings.get_presentation_time], which is only
+// to [FrameTimings.GetPresentationTime], which is only
 // available after the frame has been presented.
 //
 // In general, if you are simply animating, you should use
-// [method@Gdk.FrameClock.get_frame_time] rather than this function,
+// [FrameClock.GetFrameTime] rather than this function,
 // but this function is useful for applications that want exact control
 // over latency. For example, a movie player may want this information
 // for Audio/Video synchronization.
@@ -120,7 +119,7 @@ func (x *FrameTimings) GetRefreshInterval() int64 {
 
 var xFrameTimingsRef func(uintptr) *FrameTimings
 
-// Increases the reference count of @timings.
+// Increases the reference count of timings.
 func (x *FrameTimings) Ref() *FrameTimings {
 
 	cret := xFrameTimingsRef(x.GoPointer())
@@ -129,9 +128,9 @@ func (x *FrameTimings) Ref() *FrameTimings {
 
 var xFrameTimingsUnref func(uintptr)
 
-// Decreases the reference count of @timings.
+// Decreases the reference count of timings.
 //
-// If @timings is no longer referenced, it will be freed.
+// If timings is no longer referenced, it will be freed.
 func (x *FrameTimings) Unref() {
 
 	xFrameTimingsUnref(x.GoPointer())
@@ -143,7 +142,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -152,13 +151,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xFrameTimingsGLibType, libs, "gdk_frame_timings_get_type")
 
-	core.PuregoSafeRegister(&xFrameTimingsGetComplete, libs, "gdk_frame_timings_get_complete")
-	core.PuregoSafeRegister(&xFrameTimingsGetFrameCounter, libs, "gdk_frame_timings_get_frame_counter")
-	core.PuregoSafeRegister(&xFrameTimingsGetFrameTime, libs, "gdk_frame_timings_get_frame_time")
-	core.PuregoSafeRegister(&xFrameTimingsGetPredictedPresentationTime, libs, "gdk_frame_timings_get_predicted_presentation_time")
-	core.PuregoSafeRegister(&xFrameTimingsGetPresentationTime, libs, "gdk_frame_timings_get_presentation_time")
-	core.PuregoSafeRegister(&xFrameTimingsGetRefreshInterval, libs, "gdk_frame_timings_get_refresh_interval")
-	core.PuregoSafeRegister(&xFrameTimingsRef, libs, "gdk_frame_timings_ref")
-	core.PuregoSafeRegister(&xFrameTimingsUnref, libs, "gdk_frame_timings_unref")
+	core.PuregoRegisterSince(&xFrameTimingsGetComplete, libs, "gdk_frame_timings_get_complete", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsGetFrameCounter, libs, "gdk_frame_timings_get_frame_counter", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsGetFrameTime, libs, "gdk_frame_timings_get_frame_time", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsGetPredictedPresentationTime, libs, "gdk_frame_timings_get_predicted_presentation_time", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsGetPresentationTime, libs, "gdk_frame_timings_get_presentation_time", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsGetRefreshInterval, libs, "gdk_frame_timings_get_refresh_interval", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsRef, libs, "gdk_frame_timings_ref", "GDK", "")
+	core.PuregoRegisterSince(&xFrameTimingsUnref, libs, "gdk_frame_timings_unref", "GDK", "")
 
 }
@@ -2,70 +2,74 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-var xGlErrorQuark func() glib.Quark
+var xGLErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gdk.GLContext] errors.
-func GlErrorQuark() glib.Quark {
+// Registers an error quark for [GLContext] errors.
+func GLErrorQuark() glib.Quark {
 
-	cret := xGlErrorQuark()
+	cret := xGLErrorQuark()
 	return cret
 }
 
+// Registers an error quark for [GLContext] errors.
+//
+// Deprecated: use GLErrorQuark, this name of gdk_gl_error_quark is kept for compatibility.
+func GlErrorQuark() glib.Quark {
+	return GLErrorQuark()
+}
+
 // Represents a platform-specific OpenGL draw context.
 //
 // `GdkGLContext`s are created for a surface using
-// [method@Gdk.Surface.create_gl_context], and the context will match
+// [Surface.CreateGlContext], and the context will match
 // the characteristics of the surface.
 //
 // A `GdkGLContext` is not tied to any particular normal framebuffer.
 // For instance, it cannot draw to the surface back buffer. The GDK
 // repaint system is in full control of the painting to that. Instead,
-// you can create render buffers or textures and use [func@cairo_draw_from_gl]
+// you can create render buffers or textures and use cairo_draw_from_gl
 // in the draw function of your widget to draw them. Then GDK will handle
 // the integration of your rendering with that of other widgets.
 //
 // Support for `GdkGLContext` is platform-specific and context creation
-// can fail, returning %NULL context.
+// can fail, returning nil context.
 //
 // A `GdkGLContext` has to be made "current" in order to start using
 // it, otherwise any OpenGL call will be ignored.
 //
-// ## Creating a new OpenGL context
+// # Creating a new OpenGL context
 //
 // In order to create a new `GdkGLContext` instance you need a `GdkSurface`,
 // which you typically get during the realize call of a widget.
 //
-// A `GdkGLContext` is not realized until either [method@Gdk.GLContext.make_current]
-// or [method@Gdk.GLContext.realize] is called. It is possible to specify
+// A `GdkGLContext` is not realized until either [GLContext.MakeCurrent]
+// or [GLContext.Realize] is called. It is possible to specify
 // details of the GL context like the OpenGL version to be used, or whether
 // the GL context should have extra state validation enabled after calling
-// [method@Gdk.Surface.create_gl_context] by calling [method@Gdk.GLContext.realize].
+// [Surface.CreateGlContext] by calling [GLContext.Realize].
 // If the realization fails you have the option to change the settings of
 // the `GdkGLContext` and try again.
 //
-// ## Using a GdkGLContext
+// # Using a GdkGLContext
 //
 // You will need to make the `GdkGLContext` the current context before issuing
 // OpenGL calls; the system sends OpenGL commands to whichever context is current.
 // It is possible to have multiple contexts, so you always need to ensure that
 // the one which you want to draw with is the current one before issuing commands:
 //
-// ```c
-// gdk_gl_context_make_current (context);
-// ```
+//	gdk_gl_context_make_current (context);
 //
 // You can now perform your drawing using OpenGL commands.
 //
 // You can check which `GdkGLContext` is the current one by using
-// [func@Gdk.GLContext.get_current]; you can also unset any `GdkGLContext`
-// that is currently set by calling [func@Gdk.GLContext.clear_current].
+// [GLContextGetCurrent]; you can also unset any `GdkGLContext`
+// that is currently set by calling [GLContextClearCurrent].
 type GLContext struct {
 	DrawContext
 }
@@ -84,7 +88,7 @@ func GLContextNewFromInternalPtr(ptr uintptr) *GLContext {
 
 var xGLContextGetAllowedApis func(uintptr) GLAPI
 
-// Gets the allowed APIs set via gdk_gl_context_set_allowed_apis().
+// Gets the allowed APIs set via [GLContext.SetAllowedApis].
 func (x *GLContext) GetAllowedApis() GLAPI {
 
 	cret := xGLContextGetAllowedApis(x.GoPointer())
@@ -106,7 +110,7 @@ var xGLContextGetDebugEnabled func(uintptr) bool
 
 // Retrieves whether the context is doing extra validations and runtime checking.
 //
-// See [method@Gdk.GLContext.set_debug_enabled].
+// See [GLContext.SetDebugEnabled].
 func (x *GLContext) GetDebugEnabled() bool {
 
 	cret := xGLContextGetDebugEnabled(x.GoPointer())
@@ -115,7 +119,7 @@ func (x *GLContext) GetDebugEnabled() bool {
 
 var xGLContextGetDisplay func(uintptr) uintptr
 
-// Retrieves the display the @context is created for
+// Retrieves the display the context is created for
 func (x *GLContext) GetDisplay() *Display {
 	var cls *Display
 
@@ -134,7 +138,7 @@ var xGLContextGetForwardCompatible func(uintptr) bool
 
 // Retrieves whether the context is forward-compatible.
 //
-// See [method@Gdk.GLContext.set_forward_compatible].
+// See [GLContext.SetForwardCompatible].
 func (x *GLContext) GetForwardCompatible() bool {
 
 	cret := xGLContextGetForwardCompatible(x.GoPointer())
@@ -143,41 +147,21 @@ func (x *GLContext) GetForwardCompatible() bool {
 
 var xGLContextGetRequiredVersion func(uintptr, *int, *int)
 
-// Retrieves required OpenGL version set as a requirement for the @context
+// Retrieves required OpenGL version set as a requirement for the context
 // realization. It will not change even if a greater OpenGL version is supported
-// and used after the @context is realized. See
-// [method@Gdk.GLContext.get_version] for the real version in use.
+// and used after the context is realized. See
+// [GLContext.GetVersion] for the real version in use.
 //
-// See [method@Gdk.GLContext.set_required_version].
+// See [GLContext.SetRequiredVersion].
 func (x *GLContext) GetRequiredVersion(MajorVar *int, MinorVar *int) {
 
 	xGLContextGetRequiredVersion(x.GoPointer(), MajorVar, MinorVar)
 
 }
 
-var xGLContextGetSharedContext func(uintptr) uintptr
-
-// Used to retrieves the `GdkGLContext` that this @context share data with.
-//
-// As many contexts can share data now and no single shared context exists
-// anymore, this function has been deprecated and now always returns %NULL.
-func (x *GLContext) GetSharedContext() *GLContext {
-	var cls *GLContext
-
-	cret := xGLContextGetSharedContext(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &GLContext{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xGLContextGetSurface func(uintptr) uintptr
 
-// Retrieves the surface used by the @context.
+// Retrieves the surface used by the context.
 func (x *GLContext) GetSurface() *Surface {
 	var cls *Surface
 
@@ -194,7 +178,7 @@ func (x *GLContext) GetSurface() *Surface {
 
 var xGLContextGetUseEs func(uintptr) bool
 
-// Checks whether the @context is using an OpenGL or OpenGL ES profile.
+// Checks whether the context is using an OpenGL or OpenGL ES profile.
 func (x *GLContext) GetUseEs() bool {
 
 	cret := xGLContextGetUseEs(x.GoPointer())
@@ -203,9 +187,9 @@ func (x *GLContext) GetUseEs() bool {
 
 var xGLContextGetVersion func(uintptr, *int, *int)
 
-// Retrieves the OpenGL version of the @context.
+// Retrieves the OpenGL version of the context.
 //
-// The @context must be realized prior to calling this function.
+// The context must be realized prior to calling this function.
 func (x *GLContext) GetVersion(MajorVar *int, MinorVar *int) {
 
 	xGLContextGetVersion(x.GoPointer(), MajorVar, MinorVar)
@@ -221,11 +205,11 @@ var xGLContextIsLegacy func(uintptr) bool
 // When realizing a GL context, GDK will try to use the OpenGL 3.2 core
 // profile; this profile removes all the OpenGL API that was deprecated
 // prior to the 3.2 version of the specification. If the realization is
-// successful, this function will return %FALSE.
+// successful, this function will return false.
 //
 // If the underlying OpenGL implementation does not support core profiles,
 // GDK will fall back to a pre-3.2 compatibility profile, and this function
-// will return %TRUE.
+// will return true.
 //
 // You can use the value returned by this function to decide which kind
 // of OpenGL API to use, or whether to do extension discovery, or what
@@ -240,7 +224,7 @@ var xGLContextIsShared func(uintptr, uintptr) bool
 
 // Checks if the two GL contexts can share resources.
 //
-// When they can, the texture IDs from @other can be used in @self. This
+// When they can, the texture IDs from other can be used in self. This
 // is particularly useful when passing `GdkGLTexture` objects between
 // different contexts.
 //
@@ -249,7 +233,7 @@ var xGLContextIsShared func(uintptr, uintptr) bool
 // For other contexts it depends on the GL backend.
 //
 // Both contexts must be realized for this check to succeed. If either one
-// is not, this function will return %FALSE.
+// is not, this function will return false.
 func (x *GLContext) IsShared(OtherVar *GLContext) bool {
 
 	cret := xGLContextIsShared(x.GoPointer(), OtherVar.GoPointer())
@@ -258,7 +242,7 @@ func (x *GLContext) IsShared(OtherVar *GLContext) bool {
 
 var xGLContextMakeCurrent func(uintptr)
 
-// Makes the @context the current one.
+// Makes the context the current one.
 func (x *GLContext) MakeCurrent() {
 
 	xGLContextMakeCurrent(x.GoPointer())
@@ -275,7 +259,7 @@ func (x *GLContext) Realize() (bool, error) {
 
 	cret := xGLContextRealize(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -283,11 +267,11 @@ func (x *GLContext) Realize() (bool, error) {
 
 var xGLContextSetAllowedApis func(uintptr, GLAPI)
 
-// Sets the allowed APIs. When gdk_gl_context_realize() is called, only the
+// Sets the allowed APIs. When [GLContext.Realize] is called, only the
 // allowed APIs will be tried. If you set this to 0, realizing will always fail.
 //
 // If you set it on a realized context, the property will not have any effect.
-// It is only relevant during gdk_gl_context_realize().
+// It is only relevant during [GLContext.Realize].
 //
 // By default, all APIs are allowed.
 func (x *GLContext) SetAllowedApis(ApisVar GLAPI) {
@@ -332,12 +316,12 @@ var xGLContextSetRequiredVersion func(uintptr, int, int)
 
 // Sets the major and minor version of OpenGL to request.
 //
-// Setting @major and @minor to zero will use the default values.
+// Setting major and minor to zero will use the default values.
 //
-// Setting @major and @minor lower than the minimum versions required
+// Setting major and minor lower than the minimum versions required
 // by GTK will result in the context choosing the minimum version.
 //
-// The @context must not be realized or made current prior to calling
+// The context must not be realized or made current prior to calling
 // this function.
 func (x *GLContext) SetRequiredVersion(MajorVar int, MinorVar int) {
 
@@ -351,14 +335,14 @@ var xGLContextSetUseEs func(uintptr, int)
 //
 // Not all platforms support OpenGL ES.
 //
-// The @context must not have been realized.
+// The context must not have been realized.
 //
 // By default, GDK will attempt to automatically detect whether the
-// underlying GL implementation is OpenGL or OpenGL ES once the @context
+// underlying GL implementation is OpenGL or OpenGL ES once the context
 // is realized.
 //
-// You should check the return value of [method@Gdk.GLContext.get_use_es]
-// after calling [method@Gdk.GLContext.realize] to decide whether to use
+// You should check the return value of [GLContext.GetUseEs]
+// after calling [GLContext.Realize] to decide whether to use
 // the OpenGL or OpenGL ES API, extensions, or shaders.
 func (x *GLContext) SetUseEs(UseEsVar int) {
 
@@ -382,7 +366,7 @@ var xGLContextClearCurrent func()
 // Clears the current `GdkGLContext`.
 //
 // Any OpenGL call after this function returns will be ignored
-// until [method@Gdk.GLContext.make_current] is called.
+// until [GLContext.MakeCurrent] is called.
 func GLContextClearCurrent() {
 
 	xGLContextClearCurrent()
@@ -411,38 +395,37 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xGlErrorQuark, libs, "gdk_gl_error_quark")
+	core.PuregoRegisterSince(&xGLErrorQuark, libs, "gdk_gl_error_quark", "GDK", "")
 
 	core.PuregoSafeRegister(&xGLContextGLibType, libs, "gdk_gl_context_get_type")
 
-	core.PuregoSafeRegister(&xGLContextGetAllowedApis, libs, "gdk_gl_context_get_allowed_apis")
-	core.PuregoSafeRegister(&xGLContextGetApi, libs, "gdk_gl_context_get_api")
-	core.PuregoSafeRegister(&xGLContextGetDebugEnabled, libs, "gdk_gl_context_get_debug_enabled")
-	core.PuregoSafeRegister(&xGLContextGetDisplay, libs, "gdk_gl_context_get_display")
-	core.PuregoSafeRegister(&xGLContextGetForwardCompatible, libs, "gdk_gl_context_get_forward_compatible")
-	core.PuregoSafeRegister(&xGLContextGetRequiredVersion, libs, "gdk_gl_context_get_required_version")
-	core.PuregoSafeRegister(&xGLContextGetSharedContext, libs, "gdk_gl_context_get_shared_context")
-	core.PuregoSafeRegister(&xGLContextGetSurface, libs, "gdk_gl_context_get_surface")
-	core.PuregoSafeRegister(&xGLContextGetUseEs, libs, "gdk_gl_context_get_use_es")
-	core.PuregoSafeRegister(&xGLContextGetVersion, libs, "gdk_gl_context_get_version")
-	core.PuregoSafeRegister(&xGLContextIsLegacy, libs, "gdk_gl_context_is_legacy")
-	core.PuregoSafeRegister(&xGLContextIsShared, libs, "gdk_gl_context_is_shared")
-	core.PuregoSafeRegister(&xGLContextMakeCurrent, libs, "gdk_gl_context_make_current")
-	core.PuregoSafeRegister(&xGLContextRealize, libs, "gdk_gl_context_realize")
-	core.PuregoSafeRegister(&xGLContextSetAllowedApis, libs, "gdk_gl_context_set_allowed_apis")
-	core.PuregoSafeRegister(&xGLContextSetDebugEnabled, libs, "gdk_gl_context_set_debug_enabled")
-	core.PuregoSafeRegister(&xGLContextSetForwardCompatible, libs, "gdk_gl_context_set_forward_compatible")
-	core.PuregoSafeRegister(&xGLContextSetRequiredVersion, libs, "gdk_gl_context_set_required_version")
-	core.PuregoSafeRegister(&xGLContextSetUseEs, libs, "gdk_gl_context_set_use_es")
-
-	core.PuregoSafeRegister(&xGLContextClearCurrent, libs, "gdk_gl_context_clear_current")
-	core.PuregoSafeRegister(&xGLContextGetCurrent, libs, "gdk_gl_context_get_current")
+	core.PuregoRegisterSince(&xGLContextGetAllowedApis, libs, "gdk_gl_context_get_allowed_apis", "GDK", "4.6")
+	core.PuregoRegisterSince(&xGLContextGetApi, libs, "gdk_gl_context_get_api", "GDK", "4.6")
+	core.PuregoRegisterSince(&xGLContextGetDebugEnabled, libs, "gdk_gl_context_get_debug_enabled", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetDisplay, libs, "gdk_gl_context_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetForwardCompatible, libs, "gdk_gl_context_get_forward_compatible", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetRequiredVersion, libs, "gdk_gl_context_get_required_version", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetSurface, libs, "gdk_gl_context_get_surface", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetUseEs, libs, "gdk_gl_context_get_use_es", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetVersion, libs, "gdk_gl_context_get_version", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextIsLegacy, libs, "gdk_gl_context_is_legacy", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextIsShared, libs, "gdk_gl_context_is_shared", "GDK", "4.4")
+	core.PuregoRegisterSince(&xGLContextMakeCurrent, libs, "gdk_gl_context_make_current", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextRealize, libs, "gdk_gl_context_realize", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextSetAllowedApis, libs, "gdk_gl_context_set_allowed_apis", "GDK", "4.6")
+	core.PuregoRegisterSince(&xGLContextSetDebugEnabled, libs, "gdk_gl_context_set_debug_enabled", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextSetForwardCompatible, libs, "gdk_gl_context_set_forward_compatible", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextSetRequiredVersion, libs, "gdk_gl_context_set_required_version", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextSetUseEs, libs, "gdk_gl_context_set_use_es", "GDK", "")
+
+	core.PuregoRegisterSince(&xGLContextClearCurrent, libs, "gdk_gl_context_clear_current", "GDK", "")
+	core.PuregoRegisterSince(&xGLContextGetCurrent, libs, "gdk_gl_context_get_current", "GDK", "")
 
 }
@@ -0,0 +1,49 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xGLContextGetSharedContext func(uintptr) uintptr
+
+// Used to retrieves the `GdkGLContext` that this context share data with.
+//
+// As many contexts can share data now and no single shared context exists
+// anymore, this function has been deprecated and now always returns nil.
+//
+// Deprecated: since 4.4. Use [GLContext.IsShared] to check if contexts
+//
+//	can be shared.
+func (x *GLContext) GetSharedContext() *GLContext {
+	var cls *GLContext
+
+	cret := xGLContextGetSharedContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &GLContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xGLContextGetSharedContext, libs, "gdk_gl_context_get_shared_context", "GDK", "")
+
+}
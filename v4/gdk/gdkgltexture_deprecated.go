@@ -0,0 +1,67 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xNewGLTexture func(uintptr, uint, int, int, uintptr, uintptr) uintptr
+
+// Creates a new texture for an existing GL texture.
+//
+// Note that the GL texture must not be modified until destroy is called,
+// which will happen when the GdkTexture object is finalized, or due to
+// an explicit call of [GLTexture.Release].
+//
+// Deprecated: since 4.12. [GLTextureBuilder] supersedes this function
+//
+//	and provides extended functionality for creating GL textures.
+func NewGLTexture(ContextVar *GLContext, IdVar uint, WidthVar int, HeightVar int, DestroyVar *glib.DestroyNotify, DataVar uintptr) *GLTexture {
+	var cls *GLTexture
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	cret := xNewGLTexture(ContextVar.GoPointer(), IdVar, WidthVar, HeightVar, DestroyVarRef, DataVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &GLTexture{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewGLTexture, libs, "gdk_gl_texture_new", "GDK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -21,13 +20,13 @@ func (x *GLTextureBuilderClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Constructs [class@Gdk.Texture] objects from GL textures.
+// Constructs [Texture] objects from GL textures.
 //
 // The operation is quite simple: Create a texture builder, set all the necessary
-// properties - keep in mind that the properties [property@Gdk.GLTextureBuilder:context],
-// [property@Gdk.GLTextureBuilder:id], [property@Gdk.GLTextureBuilder:width], and
-// [property@Gdk.GLTextureBuilder:height] are mandatory - and then call
-// [method@Gdk.GLTextureBuilder.build] to create the new texture.
+// properties - keep in mind that the properties GLTextureBuilder:context,
+// [GLTextureBuilder.GetPropertyId], [GLTextureBuilder.GetPropertyWidth], and
+// [GLTextureBuilder.GetPropertyHeight] are mandatory - and then call
+// [GLTextureBuilder.Build] to create the new texture.
 //
 // `GdkGLTextureBuilder` can be used for quick one-shot construction of
 // textures as well as kept around and reused to construct multiple textures.
@@ -69,9 +68,9 @@ var xGLTextureBuilderBuild func(uintptr, uintptr, uintptr) uintptr
 //
 // The `destroy` function gets called when the returned texture gets released;
 // either when the texture is finalized or by an explicit call to
-// [method@Gdk.GLTexture.release]. It should release all GL resources associated
-// with the texture, such as the [property@Gdk.GLTextureBuilder:id] and the
-// [property@Gdk.GLTextureBuilder:sync].
+// [GLTexture.Release]. It should release all GL resources associated
+// with the texture, such as the [GLTextureBuilder.GetPropertyId] and the
+// [GLTextureBuilder.GetPropertySync].
 //
 // Note that it is a programming error to call this function if any mandatory
 // property has not been set.
@@ -88,11 +87,12 @@ func (x *GLTextureBuilder) Build(DestroyVar *glib.DestroyNotify, DataVar uintptr
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -108,7 +108,7 @@ func (x *GLTextureBuilder) Build(DestroyVar *glib.DestroyNotify, DataVar uintptr
 
 var xGLTextureBuilderGetColorState func(uintptr) *ColorState
 
-// Gets the color state previously set via gdk_gl_texture_builder_set_color_state().
+// Gets the color state previously set via [GLTextureBuilder.SetColorState].
 func (x *GLTextureBuilder) GetColorState() *ColorState {
 
 	cret := xGLTextureBuilderGetColorState(x.GoPointer())
@@ -117,8 +117,8 @@ func (x *GLTextureBuilder) GetColorState() *ColorState {
 
 var xGLTextureBuilderGetContext func(uintptr) uintptr
 
-// Gets the context previously set via gdk_gl_texture_builder_set_context() or
-// %NULL if none was set.
+// Gets the context previously set via [GLTextureBuilder.SetContext] or
+// nil if none was set.
 func (x *GLTextureBuilder) GetContext() *GLContext {
 	var cls *GLContext
 
@@ -135,7 +135,7 @@ func (x *GLTextureBuilder) GetContext() *GLContext {
 
 var xGLTextureBuilderGetFormat func(uintptr) MemoryFormat
 
-// Gets the format previously set via gdk_gl_texture_builder_set_format().
+// Gets the format previously set via [GLTextureBuilder.SetFormat].
 func (x *GLTextureBuilder) GetFormat() MemoryFormat {
 
 	cret := xGLTextureBuilderGetFormat(x.GoPointer())
@@ -153,7 +153,7 @@ func (x *GLTextureBuilder) GetHasMipmap() bool {
 
 var xGLTextureBuilderGetHeight func(uintptr) int
 
-// Gets the height previously set via gdk_gl_texture_builder_set_height() or
+// Gets the height previously set via [GLTextureBuilder.SetHeight] or
 // 0 if the height wasn't set.
 func (x *GLTextureBuilder) GetHeight() int {
 
@@ -163,7 +163,7 @@ func (x *GLTextureBuilder) GetHeight() int {
 
 var xGLTextureBuilderGetId func(uintptr) uint
 
-// Gets the texture id previously set via gdk_gl_texture_builder_set_id() or
+// Gets the texture id previously set via [GLTextureBuilder.SetId] or
 // 0 if the id wasn't set.
 func (x *GLTextureBuilder) GetId() uint {
 
@@ -173,7 +173,7 @@ func (x *GLTextureBuilder) GetId() uint {
 
 var xGLTextureBuilderGetSync func(uintptr) uintptr
 
-// Gets the `GLsync` previously set via gdk_gl_texture_builder_set_sync().
+// Gets the `GLsync` previously set via [GLTextureBuilder.SetSync].
 func (x *GLTextureBuilder) GetSync() uintptr {
 
 	cret := xGLTextureBuilderGetSync(x.GoPointer())
@@ -182,8 +182,8 @@ func (x *GLTextureBuilder) GetSync() uintptr {
 
 var xGLTextureBuilderGetUpdateRegion func(uintptr) *cairo.Region
 
-// Gets the region previously set via gdk_gl_texture_builder_set_update_region() or
-// %NULL if none was set.
+// Gets the region previously set via [GLTextureBuilder.SetUpdateRegion] or
+// nil if none was set.
 func (x *GLTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 	cret := xGLTextureBuilderGetUpdateRegion(x.GoPointer())
@@ -192,8 +192,8 @@ func (x *GLTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 var xGLTextureBuilderGetUpdateTexture func(uintptr) uintptr
 
-// Gets the texture previously set via gdk_gl_texture_builder_set_update_texture() or
-// %NULL if none was set.
+// Gets the texture previously set via [GLTextureBuilder.SetUpdateTexture] or
+// nil if none was set.
 func (x *GLTextureBuilder) GetUpdateTexture() *Texture {
 	var cls *Texture
 
@@ -210,7 +210,7 @@ func (x *GLTextureBuilder) GetUpdateTexture() *Texture {
 
 var xGLTextureBuilderGetWidth func(uintptr) int
 
-// Gets the width previously set via gdk_gl_texture_builder_set_width() or
+// Gets the width previously set via [GLTextureBuilder.SetWidth] or
 // 0 if the width wasn't set.
 func (x *GLTextureBuilder) GetWidth() int {
 
@@ -235,7 +235,7 @@ var xGLTextureBuilderSetContext func(uintptr, uintptr)
 // Sets the context to be used for the texture. This is the context that owns
 // the texture.
 //
-// The context must be set before calling [method@Gdk.GLTextureBuilder.build].
+// The context must be set before calling [GLTextureBuilder.Build].
 func (x *GLTextureBuilder) SetContext(ContextVar *GLContext) {
 
 	xGLTextureBuilderSetContext(x.GoPointer(), ContextVar.GoPointer())
@@ -247,7 +247,7 @@ var xGLTextureBuilderSetFormat func(uintptr, MemoryFormat)
 // Sets the format of the texture. The default is `GDK_MEMORY_R8G8B8A8_PREMULTIPLIED`.
 //
 // The format is the preferred format the texture data should be downloaded to. The
-// format must be supported by the GL version of [property@Gdk.GLTextureBuilder:context].
+// format must be supported by the GL version of GLTextureBuilder:context.
 //
 // GDK's texture download code assumes that the format corresponds to the storage
 // parameters of the GL texture in an obvious way. For example, a format of
@@ -282,7 +282,7 @@ var xGLTextureBuilderSetHeight func(uintptr, int)
 
 // Sets the height of the texture.
 //
-// The height must be set before calling [method@Gdk.GLTextureBuilder.build].
+// The height must be set before calling [GLTextureBuilder.Build].
 func (x *GLTextureBuilder) SetHeight(HeightVar int) {
 
 	xGLTextureBuilderSetHeight(x.GoPointer(), HeightVar)
@@ -292,10 +292,10 @@ func (x *GLTextureBuilder) SetHeight(HeightVar int) {
 var xGLTextureBuilderSetId func(uintptr, uint)
 
 // Sets the texture id of the texture. The texture id must remain unmodified
-// until the texture was finalized. See [method@Gdk.GLTextureBuilder.build]
+// until the texture was finalized. See [GLTextureBuilder.Build]
 // for a longer discussion.
 //
-// The id must be set before calling [method@Gdk.GLTextureBuilder.build].
+// The id must be set before calling [GLTextureBuilder.Build].
 func (x *GLTextureBuilder) SetId(IdVar uint) {
 
 	xGLTextureBuilderSetId(x.GoPointer(), IdVar)
@@ -308,7 +308,7 @@ var xGLTextureBuilderSetSync func(uintptr, uintptr)
 //
 // GTK will wait on this object before using the created `GdkTexture`.
 //
-// The `destroy` function that is passed to [method@Gdk.GLTextureBuilder.build]
+// The `destroy` function that is passed to [GLTextureBuilder.Build]
 // is responsible for freeing the sync object when it is no longer needed.
 // The texture builder does not destroy it and it is the callers
 // responsibility to make sure it doesn't leak.
@@ -321,7 +321,7 @@ func (x *GLTextureBuilder) SetSync(SyncVar uintptr) {
 var xGLTextureBuilderSetUpdateRegion func(uintptr, *cairo.Region)
 
 // Sets the region to be updated by this texture. Together with
-// [property@Gdk.GLTextureBuilder:update-texture] this describes an
+// GLTextureBuilder:update-texture this describes an
 // update of a previous texture.
 //
 // When rendering animations of large textures, it is possible that
@@ -339,7 +339,7 @@ func (x *GLTextureBuilder) SetUpdateRegion(RegionVar *cairo.Region) {
 var xGLTextureBuilderSetUpdateTexture func(uintptr, uintptr)
 
 // Sets the texture to be updated by this texture. See
-// [method@Gdk.GLTextureBuilder.set_update_region] for an explanation.
+// [GLTextureBuilder.SetUpdateRegion] for an explanation.
 func (x *GLTextureBuilder) SetUpdateTexture(TextureVar *Texture) {
 
 	xGLTextureBuilderSetUpdateTexture(x.GoPointer(), TextureVar.GoPointer())
@@ -350,7 +350,7 @@ var xGLTextureBuilderSetWidth func(uintptr, int)
 
 // Sets the width of the texture.
 //
-// The width must be set before calling [method@Gdk.GLTextureBuilder.build].
+// The width must be set before calling [GLTextureBuilder.Build].
 func (x *GLTextureBuilder) SetWidth(WidthVar int) {
 
 	xGLTextureBuilderSetWidth(x.GoPointer(), WidthVar)
@@ -458,7 +458,7 @@ func (x *GLTextureBuilder) GetPropertySync() uintptr {
 }
 
 // SetPropertyUpdateRegion sets the "update-region" property.
-// The update region for [property@Gdk.GLTextureBuilder:update-texture].
+// The update region for GLTextureBuilder:update-texture.
 func (x *GLTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -467,7 +467,7 @@ func (x *GLTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 }
 
 // GetPropertyUpdateRegion gets the "update-region" property.
-// The update region for [property@Gdk.GLTextureBuilder:update-texture].
+// The update region for GLTextureBuilder:update-texture.
 func (x *GLTextureBuilder) GetPropertyUpdateRegion() uintptr {
 	var v gobject.Value
 	x.GetProperty("update-region", &v)
@@ -496,7 +496,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -505,28 +505,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xGLTextureBuilderGLibType, libs, "gdk_gl_texture_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewGLTextureBuilder, libs, "gdk_gl_texture_builder_new")
-
-	core.PuregoSafeRegister(&xGLTextureBuilderBuild, libs, "gdk_gl_texture_builder_build")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetColorState, libs, "gdk_gl_texture_builder_get_color_state")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetContext, libs, "gdk_gl_texture_builder_get_context")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetFormat, libs, "gdk_gl_texture_builder_get_format")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetHasMipmap, libs, "gdk_gl_texture_builder_get_has_mipmap")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetHeight, libs, "gdk_gl_texture_builder_get_height")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetId, libs, "gdk_gl_texture_builder_get_id")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetSync, libs, "gdk_gl_texture_builder_get_sync")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetUpdateRegion, libs, "gdk_gl_texture_builder_get_update_region")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetUpdateTexture, libs, "gdk_gl_texture_builder_get_update_texture")
-	core.PuregoSafeRegister(&xGLTextureBuilderGetWidth, libs, "gdk_gl_texture_builder_get_width")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetColorState, libs, "gdk_gl_texture_builder_set_color_state")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetContext, libs, "gdk_gl_texture_builder_set_context")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetFormat, libs, "gdk_gl_texture_builder_set_format")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetHasMipmap, libs, "gdk_gl_texture_builder_set_has_mipmap")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetHeight, libs, "gdk_gl_texture_builder_set_height")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetId, libs, "gdk_gl_texture_builder_set_id")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetSync, libs, "gdk_gl_texture_builder_set_sync")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetUpdateRegion, libs, "gdk_gl_texture_builder_set_update_region")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetUpdateTexture, libs, "gdk_gl_texture_builder_set_update_texture")
-	core.PuregoSafeRegister(&xGLTextureBuilderSetWidth, libs, "gdk_gl_texture_builder_set_width")
+	core.PuregoRegisterSince(&xNewGLTextureBuilder, libs, "gdk_gl_texture_builder_new", "GDK", "4.12")
+
+	core.PuregoRegisterSince(&xGLTextureBuilderBuild, libs, "gdk_gl_texture_builder_build", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetColorState, libs, "gdk_gl_texture_builder_get_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetContext, libs, "gdk_gl_texture_builder_get_context", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetFormat, libs, "gdk_gl_texture_builder_get_format", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetHasMipmap, libs, "gdk_gl_texture_builder_get_has_mipmap", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetHeight, libs, "gdk_gl_texture_builder_get_height", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetId, libs, "gdk_gl_texture_builder_get_id", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetSync, libs, "gdk_gl_texture_builder_get_sync", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetUpdateRegion, libs, "gdk_gl_texture_builder_get_update_region", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetUpdateTexture, libs, "gdk_gl_texture_builder_get_update_texture", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderGetWidth, libs, "gdk_gl_texture_builder_get_width", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetColorState, libs, "gdk_gl_texture_builder_set_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetContext, libs, "gdk_gl_texture_builder_set_context", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetFormat, libs, "gdk_gl_texture_builder_set_format", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetHasMipmap, libs, "gdk_gl_texture_builder_set_has_mipmap", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetHeight, libs, "gdk_gl_texture_builder_set_height", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetId, libs, "gdk_gl_texture_builder_set_id", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetSync, libs, "gdk_gl_texture_builder_set_sync", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetUpdateRegion, libs, "gdk_gl_texture_builder_set_update_region", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetUpdateTexture, libs, "gdk_gl_texture_builder_set_update_texture", "GDK", "4.12")
+	core.PuregoRegisterSince(&xGLTextureBuilderSetWidth, libs, "gdk_gl_texture_builder_set_width", "GDK", "4.12")
 
 }
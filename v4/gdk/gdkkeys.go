@@ -2,13 +2,12 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xKeyvalConvertCase func(uint, *uint, *uint)
 
-// Obtains the upper- and lower-case versions of the keyval @symbol.
+// Obtains the upper- and lower-case versions of the keyval symbol.
 //
 // Examples of keyvals are `GDK_KEY_a`, `GDK_KEY_Enter`, `GDK_KEY_F1`, etc.
 func KeyvalConvertCase(SymbolVar uint, LowerVar *uint, UpperVar *uint) {
@@ -107,21 +106,21 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xKeyvalConvertCase, libs, "gdk_keyval_convert_case")
-	core.PuregoSafeRegister(&xKeyvalFromName, libs, "gdk_keyval_from_name")
-	core.PuregoSafeRegister(&xKeyvalIsLower, libs, "gdk_keyval_is_lower")
-	core.PuregoSafeRegister(&xKeyvalIsUpper, libs, "gdk_keyval_is_upper")
-	core.PuregoSafeRegister(&xKeyvalName, libs, "gdk_keyval_name")
-	core.PuregoSafeRegister(&xKeyvalToLower, libs, "gdk_keyval_to_lower")
-	core.PuregoSafeRegister(&xKeyvalToUnicode, libs, "gdk_keyval_to_unicode")
-	core.PuregoSafeRegister(&xKeyvalToUpper, libs, "gdk_keyval_to_upper")
-	core.PuregoSafeRegister(&xUnicodeToKeyval, libs, "gdk_unicode_to_keyval")
+	core.PuregoRegisterSince(&xKeyvalConvertCase, libs, "gdk_keyval_convert_case", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalFromName, libs, "gdk_keyval_from_name", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalIsLower, libs, "gdk_keyval_is_lower", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalIsUpper, libs, "gdk_keyval_is_upper", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalName, libs, "gdk_keyval_name", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalToLower, libs, "gdk_keyval_to_lower", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalToUnicode, libs, "gdk_keyval_to_unicode", "GDK", "")
+	core.PuregoRegisterSince(&xKeyvalToUpper, libs, "gdk_keyval_to_upper", "GDK", "")
+	core.PuregoRegisterSince(&xUnicodeToKeyval, libs, "gdk_unicode_to_keyval", "GDK", "")
 
 }
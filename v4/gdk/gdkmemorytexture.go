@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -41,7 +40,7 @@ var xNewMemoryTexture func(int, int, MemoryFormat, *glib.Bytes, uint) uintptr
 
 // Creates a new texture for a blob of image data.
 //
-// The `GBytes` must contain @stride × @height pixels
+// The `GBytes` must contain stride × height pixels
 // in the given format.
 func NewMemoryTexture(WidthVar int, HeightVar int, FormatVar MemoryFormat, BytesVar *glib.Bytes, StrideVar uint) *MemoryTexture {
 	var cls *MemoryTexture
@@ -70,25 +69,27 @@ func (c *MemoryTexture) SetGoPointer(ptr uintptr) {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *MemoryTexture) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *MemoryTexture) GetCurrentImage() *PaintableBase {
 	var cls *PaintableBase
 
@@ -106,29 +107,29 @@ func (x *MemoryTexture) GetCurrentImage() *PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [PaintableFlags] for the flags and what they mean.
 func (x *MemoryTexture) GetFlags() PaintableFlags {
 
 	cret := XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [Paintable.GetIntrinsicWidth] and
+// [Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *MemoryTexture) GetIntrinsicAspectRatio() float64 {
 
@@ -136,15 +137,15 @@ func (x *MemoryTexture) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *MemoryTexture) GetIntrinsicHeight() int {
 
@@ -152,15 +153,15 @@ func (x *MemoryTexture) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *MemoryTexture) GetIntrinsicWidth() int {
 
@@ -171,12 +172,12 @@ func (x *MemoryTexture) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *MemoryTexture) InvalidateContents() {
 
@@ -186,13 +187,13 @@ func (x *MemoryTexture) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *MemoryTexture) InvalidateSize() {
 
@@ -200,10 +201,10 @@ func (x *MemoryTexture) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *MemoryTexture) Snapshot(SnapshotVar *Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -225,10 +226,10 @@ func (x *MemoryTexture) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [gio.Icon] into a [glib.Variant]. An equivalent [gio.Icon] can be retrieved
+// back by calling [gio.IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *MemoryTexture) Serialize() *glib.Variant {
 
@@ -236,21 +237,21 @@ func (x *MemoryTexture) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [gio.IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [gio.Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [gio.FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [gio.File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [gio.File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [gio.ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *MemoryTexture) ToString() string {
 
@@ -259,7 +260,7 @@ func (x *MemoryTexture) ToString() string {
 }
 
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [gio.LoadableIcon.LoadAsync].
 func (x *MemoryTexture) Load(SizeVar int, TypeVar *string, CancellableVar *gio.Cancellable) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -267,27 +268,30 @@ func (x *MemoryTexture) Load(SizeVar int, TypeVar *string, CancellableVar *gio.C
 	cret := gio.XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [gio.LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [gio.LoadableIcon.Load].
 func (x *MemoryTexture) LoadAsync(SizeVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	gio.XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [gio.LoadableIcon.LoadAsync].
 func (x *MemoryTexture) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -295,12 +299,15 @@ func (x *MemoryTexture) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gi
 	cret := gio.XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -311,7 +318,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -320,6 +327,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xMemoryTextureGLibType, libs, "gdk_memory_texture_get_type")
 
-	core.PuregoSafeRegister(&xNewMemoryTexture, libs, "gdk_memory_texture_new")
+	core.PuregoRegisterSince(&xNewMemoryTexture, libs, "gdk_memory_texture_new", "GDK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -21,14 +20,14 @@ func (x *MemoryTextureBuilderClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Constructs [class@Gdk.Texture] objects from system memory provided
-// via [struct@GLib.Bytes].
+// Constructs [Texture] objects from system memory provided
+// via [glib.Bytes].
 //
 // The operation is quite simple: Create a texture builder, set all the necessary
-// properties - keep in mind that the properties [property@Gdk.MemoryTextureBuilder:bytes],
-// [property@Gdk.MemoryTextureBuilder:stride], [property@Gdk.MemoryTextureBuilder:width],
-// and [property@Gdk.MemoryTextureBuilder:height] are mandatory - and then call
-// [method@Gdk.MemoryTextureBuilder.build] to create the new texture.
+// properties - keep in mind that the properties [MemoryTextureBuilder.GetPropertyBytes],
+// [MemoryTextureBuilder.GetPropertyStride], [MemoryTextureBuilder.GetPropertyWidth],
+// and [MemoryTextureBuilder.GetPropertyHeight] are mandatory - and then call
+// [MemoryTextureBuilder.Build] to create the new texture.
 //
 // `GdkMemoryTextureBuilder` can be used for quick one-shot construction of
 // textures as well as kept around and reused to construct multiple textures.
@@ -88,8 +87,8 @@ func (x *MemoryTextureBuilder) Build() *Texture {
 
 var xMemoryTextureBuilderGetBytes func(uintptr) *glib.Bytes
 
-// Gets the bytes previously set via gdk_memory_texture_builder_set_bytes()
-// or %NULL if none was set.
+// Gets the bytes previously set via [MemoryTextureBuilder.SetBytes]
+// or nil if none was set.
 func (x *MemoryTextureBuilder) GetBytes() *glib.Bytes {
 
 	cret := xMemoryTextureBuilderGetBytes(x.GoPointer())
@@ -98,7 +97,7 @@ func (x *MemoryTextureBuilder) GetBytes() *glib.Bytes {
 
 var xMemoryTextureBuilderGetColorState func(uintptr) *ColorState
 
-// Gets the colorstate previously set via gdk_memory_texture_builder_set_color_state().
+// Gets the colorstate previously set via [MemoryTextureBuilder.SetColorState].
 func (x *MemoryTextureBuilder) GetColorState() *ColorState {
 
 	cret := xMemoryTextureBuilderGetColorState(x.GoPointer())
@@ -107,7 +106,7 @@ func (x *MemoryTextureBuilder) GetColorState() *ColorState {
 
 var xMemoryTextureBuilderGetFormat func(uintptr) MemoryFormat
 
-// Gets the format previously set via gdk_memory_texture_builder_set_format().
+// Gets the format previously set via [MemoryTextureBuilder.SetFormat].
 func (x *MemoryTextureBuilder) GetFormat() MemoryFormat {
 
 	cret := xMemoryTextureBuilderGetFormat(x.GoPointer())
@@ -116,7 +115,7 @@ func (x *MemoryTextureBuilder) GetFormat() MemoryFormat {
 
 var xMemoryTextureBuilderGetHeight func(uintptr) int
 
-// Gets the height previously set via gdk_memory_texture_builder_set_height()
+// Gets the height previously set via [MemoryTextureBuilder.SetHeight]
 // or 0 if the height wasn't set.
 func (x *MemoryTextureBuilder) GetHeight() int {
 
@@ -126,7 +125,7 @@ func (x *MemoryTextureBuilder) GetHeight() int {
 
 var xMemoryTextureBuilderGetOffset func(uintptr, uint) uint
 
-// Gets the offset previously set via gdk_memory_texture_builder_set_offset().
+// Gets the offset previously set via [MemoryTextureBuilder.SetOffset].
 func (x *MemoryTextureBuilder) GetOffset(PlaneVar uint) uint {
 
 	cret := xMemoryTextureBuilderGetOffset(x.GoPointer(), PlaneVar)
@@ -135,7 +134,7 @@ func (x *MemoryTextureBuilder) GetOffset(PlaneVar uint) uint {
 
 var xMemoryTextureBuilderGetStride func(uintptr) uint
 
-// Gets the stride previously set via gdk_memory_texture_builder_set_stride().
+// Gets the stride previously set via [MemoryTextureBuilder.SetStride].
 func (x *MemoryTextureBuilder) GetStride() uint {
 
 	cret := xMemoryTextureBuilderGetStride(x.GoPointer())
@@ -144,7 +143,7 @@ func (x *MemoryTextureBuilder) GetStride() uint {
 
 var xMemoryTextureBuilderGetStrideForPlane func(uintptr, uint) uint
 
-// Gets the stride previously set via gdk_memory_texture_builder_set_stride_for_plane().
+// Gets the stride previously set via [MemoryTextureBuilder.SetStrideForPlane].
 func (x *MemoryTextureBuilder) GetStrideForPlane(PlaneVar uint) uint {
 
 	cret := xMemoryTextureBuilderGetStrideForPlane(x.GoPointer(), PlaneVar)
@@ -153,8 +152,8 @@ func (x *MemoryTextureBuilder) GetStrideForPlane(PlaneVar uint) uint {
 
 var xMemoryTextureBuilderGetUpdateRegion func(uintptr) *cairo.Region
 
-// Gets the region previously set via gdk_memory_texture_builder_set_update_region()
-// or %NULL if none was set.
+// Gets the region previously set via [MemoryTextureBuilder.SetUpdateRegion]
+// or nil if none was set.
 func (x *MemoryTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 	cret := xMemoryTextureBuilderGetUpdateRegion(x.GoPointer())
@@ -163,8 +162,8 @@ func (x *MemoryTextureBuilder) GetUpdateRegion() *cairo.Region {
 
 var xMemoryTextureBuilderGetUpdateTexture func(uintptr) uintptr
 
-// Gets the texture previously set via gdk_memory_texture_builder_set_update_texture()
-// or %NULL if none was set.
+// Gets the texture previously set via [MemoryTextureBuilder.SetUpdateTexture]
+// or nil if none was set.
 func (x *MemoryTextureBuilder) GetUpdateTexture() *Texture {
 	var cls *Texture
 
@@ -181,7 +180,7 @@ func (x *MemoryTextureBuilder) GetUpdateTexture() *Texture {
 
 var xMemoryTextureBuilderGetWidth func(uintptr) int
 
-// Gets the width previously set via gdk_memory_texture_builder_set_width()
+// Gets the width previously set via [MemoryTextureBuilder.SetWidth]
 // or 0 if the width wasn't set.
 func (x *MemoryTextureBuilder) GetWidth() int {
 
@@ -193,7 +192,7 @@ var xMemoryTextureBuilderSetBytes func(uintptr, *glib.Bytes)
 
 // Sets the data to be shown but the texture.
 //
-// The bytes must be set before calling [method@Gdk.MemoryTextureBuilder.build].
+// The bytes must be set before calling [MemoryTextureBuilder.Build].
 func (x *MemoryTextureBuilder) SetBytes(BytesVar *glib.Bytes) {
 
 	xMemoryTextureBuilderSetBytes(x.GoPointer(), BytesVar)
@@ -227,7 +226,7 @@ var xMemoryTextureBuilderSetHeight func(uintptr, int)
 
 // Sets the height of the texture.
 //
-// The height must be set before calling [method@Gdk.MemoryTextureBuilder.build]
+// The height must be set before calling [MemoryTextureBuilder.Build]
 // and conform to size requirements of the provided format.
 func (x *MemoryTextureBuilder) SetHeight(HeightVar int) {
 
@@ -237,7 +236,7 @@ func (x *MemoryTextureBuilder) SetHeight(HeightVar int) {
 
 var xMemoryTextureBuilderSetOffset func(uintptr, uint, uint)
 
-// Sets the offset of the texture for @plane.
+// Sets the offset of the texture for plane.
 func (x *MemoryTextureBuilder) SetOffset(PlaneVar uint, OffsetVar uint) {
 
 	xMemoryTextureBuilderSetOffset(x.GoPointer(), PlaneVar, OffsetVar)
@@ -248,7 +247,7 @@ var xMemoryTextureBuilderSetStride func(uintptr, uint)
 
 // Sets the rowstride of the bytes used.
 //
-// The rowstride must be set before calling [method@Gdk.MemoryTextureBuilder.build].
+// The rowstride must be set before calling [MemoryTextureBuilder.Build].
 func (x *MemoryTextureBuilder) SetStride(StrideVar uint) {
 
 	xMemoryTextureBuilderSetStride(x.GoPointer(), StrideVar)
@@ -257,7 +256,7 @@ func (x *MemoryTextureBuilder) SetStride(StrideVar uint) {
 
 var xMemoryTextureBuilderSetStrideForPlane func(uintptr, uint, uint)
 
-// Sets the stride of the texture for @plane.
+// Sets the stride of the texture for plane.
 func (x *MemoryTextureBuilder) SetStrideForPlane(PlaneVar uint, StrideVar uint) {
 
 	xMemoryTextureBuilderSetStrideForPlane(x.GoPointer(), PlaneVar, StrideVar)
@@ -268,7 +267,7 @@ var xMemoryTextureBuilderSetUpdateRegion func(uintptr, *cairo.Region)
 
 // Sets the region to be updated by this texture.
 //
-// Together with [property@Gdk.MemoryTextureBuilder:update-texture],
+// Together with MemoryTextureBuilder:update-texture,
 // this describes an update of a previous texture.
 //
 // When rendering animations of large textures, it is possible that
@@ -287,7 +286,7 @@ var xMemoryTextureBuilderSetUpdateTexture func(uintptr, uintptr)
 
 // Sets the texture to be updated by this texture.
 //
-// See [method@Gdk.MemoryTextureBuilder.set_update_region] for an explanation.
+// See [MemoryTextureBuilder.SetUpdateRegion] for an explanation.
 func (x *MemoryTextureBuilder) SetUpdateTexture(TextureVar *Texture) {
 
 	xMemoryTextureBuilderSetUpdateTexture(x.GoPointer(), TextureVar.GoPointer())
@@ -298,7 +297,7 @@ var xMemoryTextureBuilderSetWidth func(uintptr, int)
 
 // Sets the width of the texture.
 //
-// The width must be set before calling [method@Gdk.MemoryTextureBuilder.build]
+// The width must be set before calling [MemoryTextureBuilder.Build]
 // and conform to size requirements of the provided format.
 func (x *MemoryTextureBuilder) SetWidth(WidthVar int) {
 
@@ -392,7 +391,7 @@ func (x *MemoryTextureBuilder) GetPropertyStride() uint64 {
 }
 
 // SetPropertyUpdateRegion sets the "update-region" property.
-// The update region for [property@Gdk.MemoryTextureBuilder:update-texture].
+// The update region for MemoryTextureBuilder:update-texture.
 func (x *MemoryTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -401,7 +400,7 @@ func (x *MemoryTextureBuilder) SetPropertyUpdateRegion(value uintptr) {
 }
 
 // GetPropertyUpdateRegion gets the "update-region" property.
-// The update region for [property@Gdk.MemoryTextureBuilder:update-texture].
+// The update region for MemoryTextureBuilder:update-texture.
 func (x *MemoryTextureBuilder) GetPropertyUpdateRegion() uintptr {
 	var v gobject.Value
 	x.GetProperty("update-region", &v)
@@ -430,7 +429,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -439,28 +438,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xMemoryTextureBuilderGLibType, libs, "gdk_memory_texture_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewMemoryTextureBuilder, libs, "gdk_memory_texture_builder_new")
-
-	core.PuregoSafeRegister(&xMemoryTextureBuilderBuild, libs, "gdk_memory_texture_builder_build")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetBytes, libs, "gdk_memory_texture_builder_get_bytes")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetColorState, libs, "gdk_memory_texture_builder_get_color_state")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetFormat, libs, "gdk_memory_texture_builder_get_format")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetHeight, libs, "gdk_memory_texture_builder_get_height")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetOffset, libs, "gdk_memory_texture_builder_get_offset")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetStride, libs, "gdk_memory_texture_builder_get_stride")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetStrideForPlane, libs, "gdk_memory_texture_builder_get_stride_for_plane")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetUpdateRegion, libs, "gdk_memory_texture_builder_get_update_region")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetUpdateTexture, libs, "gdk_memory_texture_builder_get_update_texture")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderGetWidth, libs, "gdk_memory_texture_builder_get_width")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetBytes, libs, "gdk_memory_texture_builder_set_bytes")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetColorState, libs, "gdk_memory_texture_builder_set_color_state")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetFormat, libs, "gdk_memory_texture_builder_set_format")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetHeight, libs, "gdk_memory_texture_builder_set_height")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetOffset, libs, "gdk_memory_texture_builder_set_offset")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetStride, libs, "gdk_memory_texture_builder_set_stride")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetStrideForPlane, libs, "gdk_memory_texture_builder_set_stride_for_plane")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetUpdateRegion, libs, "gdk_memory_texture_builder_set_update_region")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetUpdateTexture, libs, "gdk_memory_texture_builder_set_update_texture")
-	core.PuregoSafeRegister(&xMemoryTextureBuilderSetWidth, libs, "gdk_memory_texture_builder_set_width")
+	core.PuregoRegisterSince(&xNewMemoryTextureBuilder, libs, "gdk_memory_texture_builder_new", "GDK", "4.16")
+
+	core.PuregoRegisterSince(&xMemoryTextureBuilderBuild, libs, "gdk_memory_texture_builder_build", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetBytes, libs, "gdk_memory_texture_builder_get_bytes", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetColorState, libs, "gdk_memory_texture_builder_get_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetFormat, libs, "gdk_memory_texture_builder_get_format", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetHeight, libs, "gdk_memory_texture_builder_get_height", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetOffset, libs, "gdk_memory_texture_builder_get_offset", "GDK", "4.20")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetStride, libs, "gdk_memory_texture_builder_get_stride", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetStrideForPlane, libs, "gdk_memory_texture_builder_get_stride_for_plane", "GDK", "4.20")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetUpdateRegion, libs, "gdk_memory_texture_builder_get_update_region", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetUpdateTexture, libs, "gdk_memory_texture_builder_get_update_texture", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderGetWidth, libs, "gdk_memory_texture_builder_get_width", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetBytes, libs, "gdk_memory_texture_builder_set_bytes", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetColorState, libs, "gdk_memory_texture_builder_set_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetFormat, libs, "gdk_memory_texture_builder_set_format", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetHeight, libs, "gdk_memory_texture_builder_set_height", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetOffset, libs, "gdk_memory_texture_builder_set_offset", "GDK", "4.20")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetStride, libs, "gdk_memory_texture_builder_set_stride", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetStrideForPlane, libs, "gdk_memory_texture_builder_set_stride_for_plane", "GDK", "4.20")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetUpdateRegion, libs, "gdk_memory_texture_builder_set_update_region", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetUpdateTexture, libs, "gdk_memory_texture_builder_set_update_texture", "GDK", "4.16")
+	core.PuregoRegisterSince(&xMemoryTextureBuilderSetWidth, libs, "gdk_memory_texture_builder_set_width", "GDK", "4.16")
 
 }
@@ -2,10 +2,10 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -46,11 +46,68 @@ const (
 	SubpixelLayoutVerticalBgrValue SubpixelLayout = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SubpixelLayout) String() string {
+	switch x {
+	case SubpixelLayoutUnknownValue:
+		return "GDK_SUBPIXEL_LAYOUT_UNKNOWN"
+	case SubpixelLayoutNoneValue:
+		return "GDK_SUBPIXEL_LAYOUT_NONE"
+	case SubpixelLayoutHorizontalRgbValue:
+		return "GDK_SUBPIXEL_LAYOUT_HORIZONTAL_RGB"
+	case SubpixelLayoutHorizontalBgrValue:
+		return "GDK_SUBPIXEL_LAYOUT_HORIZONTAL_BGR"
+	case SubpixelLayoutVerticalRgbValue:
+		return "GDK_SUBPIXEL_LAYOUT_VERTICAL_RGB"
+	case SubpixelLayoutVerticalBgrValue:
+		return "GDK_SUBPIXEL_LAYOUT_VERTICAL_BGR"
+	}
+	return fmt.Sprintf("SubpixelLayout(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SubpixelLayout) Nick() string {
+	switch x {
+	case SubpixelLayoutUnknownValue:
+		return "unknown"
+	case SubpixelLayoutNoneValue:
+		return "none"
+	case SubpixelLayoutHorizontalRgbValue:
+		return "horizontal-rgb"
+	case SubpixelLayoutHorizontalBgrValue:
+		return "horizontal-bgr"
+	case SubpixelLayoutVerticalRgbValue:
+		return "vertical-rgb"
+	case SubpixelLayoutVerticalBgrValue:
+		return "vertical-bgr"
+	}
+	return ""
+}
+
+// SubpixelLayoutFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SubpixelLayoutFromString(s string) (SubpixelLayout, bool) {
+	switch s {
+	case "GDK_SUBPIXEL_LAYOUT_UNKNOWN", "unknown":
+		return SubpixelLayoutUnknownValue, true
+	case "GDK_SUBPIXEL_LAYOUT_NONE", "none":
+		return SubpixelLayoutNoneValue, true
+	case "GDK_SUBPIXEL_LAYOUT_HORIZONTAL_RGB", "horizontal-rgb":
+		return SubpixelLayoutHorizontalRgbValue, true
+	case "GDK_SUBPIXEL_LAYOUT_HORIZONTAL_BGR", "horizontal-bgr":
+		return SubpixelLayoutHorizontalBgrValue, true
+	case "GDK_SUBPIXEL_LAYOUT_VERTICAL_RGB", "vertical-rgb":
+		return SubpixelLayoutVerticalRgbValue, true
+	case "GDK_SUBPIXEL_LAYOUT_VERTICAL_BGR", "vertical-bgr":
+		return SubpixelLayoutVerticalBgrValue, true
+	}
+	return 0, false
+}
+
 // Represents the individual outputs that are associated with a `GdkDisplay`.
 //
 // `GdkDisplay` keeps a `GListModel` to enumerate and monitor
-// monitors with [method@Gdk.Display.get_monitors]. You can use
-// [method@Gdk.Display.get_monitor_at_surface] to find a particular
+// monitors with [Display.GetMonitors]. You can use
+// [Display.GetMonitorAtSurface] to find a particular
 // monitor.
 type Monitor struct {
 	gobject.Object
@@ -115,7 +172,7 @@ var xMonitorGetGeometry func(uintptr, *Rectangle)
 // display coordinate space.
 //
 // The returned geometry is in  ”application pixels”, not in
-// ”device pixels” (see [method@Gdk.Monitor.get_scale]).
+// ”device pixels” (see [Monitor.GetScale]).
 func (x *Monitor) GetGeometry(GeometryVar *Rectangle) {
 
 	xMonitorGetGeometry(x.GoPointer(), GeometryVar)
@@ -139,7 +196,9 @@ var xMonitorGetManufacturer func(uintptr) string
 // display backend.
 //
 // The PNP ID registry is located at
-// [https://uefi.org/pnp_id_list](https://uefi.org/pnp_id_list).
+// [https://uefi.org/pnp_id_list].
+//
+// [https://uefi.org/pnp_id_list]: https://uefi.org/pnp_id_list
 func (x *Monitor) GetManufacturer() string {
 
 	cret := xMonitorGetManufacturer(x.GoPointer())
@@ -174,7 +233,7 @@ var xMonitorGetScale func(uintptr) float64
 //
 // This can be used if you want to create pixel based data for a
 // particular monitor, but most of the time you’re drawing to a surface
-// where it is better to use [method@Gdk.Surface.get_scale] instead.
+// where it is better to use [Surface.GetScale] instead.
 func (x *Monitor) GetScale() float64 {
 
 	cret := xMonitorGetScale(x.GoPointer())
@@ -191,7 +250,7 @@ var xMonitorGetScaleFactor func(uintptr) int
 //
 // This can be used if you want to create pixel based data for a
 // particular monitor, but most of the time you’re drawing to a surface
-// where it is better to use [method@Gdk.Surface.get_scale_factor] instead.
+// where it is better to use [Surface.GetScaleFactor] instead.
 func (x *Monitor) GetScaleFactor() int {
 
 	cret := xMonitorGetScaleFactor(x.GoPointer())
@@ -219,10 +278,10 @@ func (x *Monitor) GetWidthMm() int {
 
 var xMonitorIsValid func(uintptr) bool
 
-// Returns %TRUE if the @monitor object corresponds to a
+// Returns true if the monitor object corresponds to a
 // physical monitor.
 //
-// The @monitor becomes invalid when the physical monitor
+// The monitor becomes invalid when the physical monitor
 // is unplugged or removed.
 func (x *Monitor) IsValid() bool {
 
@@ -309,7 +368,7 @@ func (x *Monitor) GetPropertyScale() float64 {
 // The scale factor.
 //
 // The scale factor is the next larger integer,
-// compared to [property@Gdk.Surface:scale].
+// compared to [Surface.GetPropertyScale].
 func (x *Monitor) GetPropertyScaleFactor() int {
 	var v gobject.Value
 	x.GetProperty("scale-factor", &v)
@@ -332,28 +391,50 @@ func (x *Monitor) GetPropertyWidthMm() int {
 	return v.GetInt()
 }
 
-// Emitted when the output represented by @monitor gets disconnected.
+// Emitted when the output represented by monitor gets disconnected.
+//
+// Deprecated: use ConnectInvalidateFunc, which also accepts method values and closures.
 func (x *Monitor) ConnectInvalidate(cb *func(Monitor)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "invalidate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Monitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "invalidate", cbRefPtr, cbPtr)
+}
+
+var xMonitorInvalidateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xMonitorInvalidateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Monitor))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Monitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "invalidate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInvalidateFunc connects cb to the "invalidate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Monitor) ConnectInvalidateFunc(cb func(Monitor)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "invalidate", &xMonitorInvalidateTrampoline, xMonitorInvalidateNewTrampoline, cb)
 }
 
 func init() {
@@ -361,7 +442,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -372,18 +453,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xMonitorGLibType, libs, "gdk_monitor_get_type")
 
-	core.PuregoSafeRegister(&xMonitorGetConnector, libs, "gdk_monitor_get_connector")
-	core.PuregoSafeRegister(&xMonitorGetDescription, libs, "gdk_monitor_get_description")
-	core.PuregoSafeRegister(&xMonitorGetDisplay, libs, "gdk_monitor_get_display")
-	core.PuregoSafeRegister(&xMonitorGetGeometry, libs, "gdk_monitor_get_geometry")
-	core.PuregoSafeRegister(&xMonitorGetHeightMm, libs, "gdk_monitor_get_height_mm")
-	core.PuregoSafeRegister(&xMonitorGetManufacturer, libs, "gdk_monitor_get_manufacturer")
-	core.PuregoSafeRegister(&xMonitorGetModel, libs, "gdk_monitor_get_model")
-	core.PuregoSafeRegister(&xMonitorGetRefreshRate, libs, "gdk_monitor_get_refresh_rate")
-	core.PuregoSafeRegister(&xMonitorGetScale, libs, "gdk_monitor_get_scale")
-	core.PuregoSafeRegister(&xMonitorGetScaleFactor, libs, "gdk_monitor_get_scale_factor")
-	core.PuregoSafeRegister(&xMonitorGetSubpixelLayout, libs, "gdk_monitor_get_subpixel_layout")
-	core.PuregoSafeRegister(&xMonitorGetWidthMm, libs, "gdk_monitor_get_width_mm")
-	core.PuregoSafeRegister(&xMonitorIsValid, libs, "gdk_monitor_is_valid")
+	core.PuregoRegisterSince(&xMonitorGetConnector, libs, "gdk_monitor_get_connector", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetDescription, libs, "gdk_monitor_get_description", "GDK", "4.10")
+	core.PuregoRegisterSince(&xMonitorGetDisplay, libs, "gdk_monitor_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetGeometry, libs, "gdk_monitor_get_geometry", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetHeightMm, libs, "gdk_monitor_get_height_mm", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetManufacturer, libs, "gdk_monitor_get_manufacturer", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetModel, libs, "gdk_monitor_get_model", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetRefreshRate, libs, "gdk_monitor_get_refresh_rate", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetScale, libs, "gdk_monitor_get_scale", "GDK", "4.14")
+	core.PuregoRegisterSince(&xMonitorGetScaleFactor, libs, "gdk_monitor_get_scale_factor", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetSubpixelLayout, libs, "gdk_monitor_get_subpixel_layout", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorGetWidthMm, libs, "gdk_monitor_get_width_mm", "GDK", "")
+	core.PuregoRegisterSince(&xMonitorIsValid, libs, "gdk_monitor_is_valid", "GDK", "")
 
 }
@@ -2,27 +2,29 @@
 package gdk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // The list of functions that can be implemented for the `GdkPaintable`
 // interface.
 //
-// Note that apart from the [vfunc@Gdk.Paintable.snapshot] function,
+// Note that apart from the Paintable.snapshot function,
 // no virtual function of this interface is mandatory to implement, though it
-// is a good idea to implement [vfunc@Gdk.Paintable.get_current_image]
-// for non-static paintables and [vfunc@Gdk.Paintable.get_flags] if the
+// is a good idea to implement Paintable.get_current_image
+// for non-static paintables and Paintable.get_flags if the
 // image is not dynamic as the default implementation returns no flags and
 // that will make the implementation likely quite slow.
 type PaintableInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSnapshot uintptr
 
@@ -42,7 +44,7 @@ func (x *PaintableInterface) GoPointer() uintptr {
 }
 
 // OverrideSnapshot sets the "snapshot" callback function.
-// Snapshot the paintable. The given @width and @height are
+// Snapshot the paintable. The given width and height are
 //
 //	guaranteed to be larger than 0.0. The resulting snapshot must modify
 //	only the area in the rectangle from (0,0) to (width, height).
@@ -51,14 +53,15 @@ func (x *PaintableInterface) OverrideSnapshot(cb func(Paintable, *Snapshot, floa
 	if cb == nil {
 		x.xSnapshot = 0
 	} else {
-		x.xSnapshot = purego.NewCallback(func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64) {
+		x.xSnapshot = core.NewCallback(func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64) {
+			defer core.RecoverPanic()
 			cb(&PaintableBase{Ptr: PaintableVarp}, SnapshotNewFromInternalPtr(SnapshotVarp), WidthVarp, HeightVarp)
 		})
 	}
 }
 
 // GetSnapshot gets the "snapshot" callback function.
-// Snapshot the paintable. The given @width and @height are
+// Snapshot the paintable. The given width and height are
 //
 //	guaranteed to be larger than 0.0. The resulting snapshot must modify
 //	only the area in the rectangle from (0,0) to (width, height).
@@ -68,7 +71,7 @@ func (x *PaintableInterface) GetSnapshot() func(Paintable, *Snapshot, float64, f
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64)
-	purego.RegisterFunc(&rawCallback, x.xSnapshot)
+	core.RegisterFunc(&rawCallback, x.xSnapshot)
 	return func(PaintableVar Paintable, SnapshotVar *Snapshot, WidthVar float64, HeightVar float64) {
 		rawCallback(PaintableVar.GoPointer(), SnapshotVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -83,7 +86,8 @@ func (x *PaintableInterface) OverrideGetCurrentImage(cb func(Paintable) *Paintab
 	if cb == nil {
 		x.xGetCurrentImage = 0
 	} else {
-		x.xGetCurrentImage = purego.NewCallback(func(PaintableVarp uintptr) uintptr {
+		x.xGetCurrentImage = core.NewCallback(func(PaintableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&PaintableBase{Ptr: PaintableVarp})
 			if ret == nil {
 				return 0
@@ -103,7 +107,7 @@ func (x *PaintableInterface) GetGetCurrentImage() func(Paintable) *PaintableBase
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetCurrentImage)
+	core.RegisterFunc(&rawCallback, x.xGetCurrentImage)
 	return func(PaintableVar Paintable) *PaintableBase {
 		rawRet := rawCallback(PaintableVar.GoPointer())
 		if rawRet == 0 {
@@ -116,21 +120,22 @@ func (x *PaintableInterface) GetGetCurrentImage() func(Paintable) *PaintableBase
 }
 
 // OverrideGetFlags sets the "get_flags" callback function.
-// Get the flags for this instance. See [flags@Gdk.PaintableFlags]
+// Get the flags for this instance. See [PaintableFlags]
 //
 //	for details.
 func (x *PaintableInterface) OverrideGetFlags(cb func(Paintable) PaintableFlags) {
 	if cb == nil {
 		x.xGetFlags = 0
 	} else {
-		x.xGetFlags = purego.NewCallback(func(PaintableVarp uintptr) PaintableFlags {
+		x.xGetFlags = core.NewCallback(func(PaintableVarp uintptr) PaintableFlags {
+			defer core.RecoverPanic()
 			return cb(&PaintableBase{Ptr: PaintableVarp})
 		})
 	}
 }
 
 // GetGetFlags gets the "get_flags" callback function.
-// Get the flags for this instance. See [flags@Gdk.PaintableFlags]
+// Get the flags for this instance. See [PaintableFlags]
 //
 //	for details.
 func (x *PaintableInterface) GetGetFlags() func(Paintable) PaintableFlags {
@@ -138,7 +143,7 @@ func (x *PaintableInterface) GetGetFlags() func(Paintable) PaintableFlags {
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr) PaintableFlags
-	purego.RegisterFunc(&rawCallback, x.xGetFlags)
+	core.RegisterFunc(&rawCallback, x.xGetFlags)
 	return func(PaintableVar Paintable) PaintableFlags {
 		return rawCallback(PaintableVar.GoPointer())
 	}
@@ -153,7 +158,8 @@ func (x *PaintableInterface) OverrideGetIntrinsicWidth(cb func(Paintable) int) {
 	if cb == nil {
 		x.xGetIntrinsicWidth = 0
 	} else {
-		x.xGetIntrinsicWidth = purego.NewCallback(func(PaintableVarp uintptr) int {
+		x.xGetIntrinsicWidth = core.NewCallback(func(PaintableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&PaintableBase{Ptr: PaintableVarp})
 		})
 	}
@@ -169,7 +175,7 @@ func (x *PaintableInterface) GetGetIntrinsicWidth() func(Paintable) int {
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetIntrinsicWidth)
+	core.RegisterFunc(&rawCallback, x.xGetIntrinsicWidth)
 	return func(PaintableVar Paintable) int {
 		return rawCallback(PaintableVar.GoPointer())
 	}
@@ -184,7 +190,8 @@ func (x *PaintableInterface) OverrideGetIntrinsicHeight(cb func(Paintable) int)
 	if cb == nil {
 		x.xGetIntrinsicHeight = 0
 	} else {
-		x.xGetIntrinsicHeight = purego.NewCallback(func(PaintableVarp uintptr) int {
+		x.xGetIntrinsicHeight = core.NewCallback(func(PaintableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&PaintableBase{Ptr: PaintableVarp})
 		})
 	}
@@ -200,7 +207,7 @@ func (x *PaintableInterface) GetGetIntrinsicHeight() func(Paintable) int {
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetIntrinsicHeight)
+	core.RegisterFunc(&rawCallback, x.xGetIntrinsicHeight)
 	return func(PaintableVar Paintable) int {
 		return rawCallback(PaintableVar.GoPointer())
 	}
@@ -209,14 +216,15 @@ func (x *PaintableInterface) GetGetIntrinsicHeight() func(Paintable) int {
 // OverrideGetIntrinsicAspectRatio sets the "get_intrinsic_aspect_ratio" callback function.
 // The preferred aspect ratio for this object
 //
-//	or 0 if none. If both [vfunc@Gdk.Paintable.get_intrinsic_width]
-//	and [vfunc@Gdk.Paintable.get_intrinsic_height] return non-zero
+//	or 0 if none. If both Paintable.get_intrinsic_width
+//	and Paintable.get_intrinsic_height return non-zero
 //	values, this function should return the aspect ratio computed from those.
 func (x *PaintableInterface) OverrideGetIntrinsicAspectRatio(cb func(Paintable) float64) {
 	if cb == nil {
 		x.xGetIntrinsicAspectRatio = 0
 	} else {
-		x.xGetIntrinsicAspectRatio = purego.NewCallback(func(PaintableVarp uintptr) float64 {
+		x.xGetIntrinsicAspectRatio = core.NewCallback(func(PaintableVarp uintptr) float64 {
+			defer core.RecoverPanic()
 			return cb(&PaintableBase{Ptr: PaintableVarp})
 		})
 	}
@@ -225,15 +233,15 @@ func (x *PaintableInterface) OverrideGetIntrinsicAspectRatio(cb func(Paintable)
 // GetGetIntrinsicAspectRatio gets the "get_intrinsic_aspect_ratio" callback function.
 // The preferred aspect ratio for this object
 //
-//	or 0 if none. If both [vfunc@Gdk.Paintable.get_intrinsic_width]
-//	and [vfunc@Gdk.Paintable.get_intrinsic_height] return non-zero
+//	or 0 if none. If both Paintable.get_intrinsic_width
+//	and Paintable.get_intrinsic_height return non-zero
 //	values, this function should return the aspect ratio computed from those.
 func (x *PaintableInterface) GetGetIntrinsicAspectRatio() func(Paintable) float64 {
 	if x.xGetIntrinsicAspectRatio == 0 {
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr) float64
-	purego.RegisterFunc(&rawCallback, x.xGetIntrinsicAspectRatio)
+	core.RegisterFunc(&rawCallback, x.xGetIntrinsicAspectRatio)
 	return func(PaintableVar Paintable) float64 {
 		return rawCallback(PaintableVar.GoPointer())
 	}
@@ -244,45 +252,49 @@ func (x *PaintableInterface) GetGetIntrinsicAspectRatio() func(Paintable) float6
 // The content of a `GdkPaintable` can be painted anywhere at any size
 // without requiring any sort of layout. The interface is inspired by
 // similar concepts elsewhere, such as
-// [ClutterContent](https://developer.gnome.org/clutter/stable/ClutterContent.html),
-// [HTML/CSS Paint Sources](https://www.w3.org/TR/css-images-4/#paint-source),
-// or [SVG Paint Servers](https://www.w3.org/TR/SVG2/pservers.html).
+// [ClutterContent],
+// [HTML/CSS Paint Sources],
+// or [SVG Paint Servers].
 //
 // A `GdkPaintable` can be snapshot at any time and size using
-// [method@Gdk.Paintable.snapshot]. How the paintable interprets that size and
+// [Paintable.Snapshot]. How the paintable interprets that size and
 // if it scales or centers itself into the given rectangle is implementation
 // defined, though if you are implementing a `GdkPaintable` and don't know what
 // to do, it is suggested that you scale your paintable ignoring any potential
 // aspect ratio.
 //
-// The contents that a `GdkPaintable` produces may depend on the [class@Gdk.Snapshot]
+// The contents that a `GdkPaintable` produces may depend on the [Snapshot]
 // passed to it. For example, paintables may decide to use more detailed images
 // on higher resolution screens or when OpenGL is available. A `GdkPaintable`
 // will however always produce the same output for the same snapshot.
 //
 // A `GdkPaintable` may change its contents, meaning that it will now produce
 // a different output with the same snapshot. Once that happens, it will call
-// [method@Gdk.Paintable.invalidate_contents] which will emit the
-// [signal@Gdk.Paintable::invalidate-contents] signal. If a paintable is known
-// to never change its contents, it will set the %GDK_PAINTABLE_STATIC_CONTENTS
+// [Paintable.InvalidateContents] which will emit the
+// Paintable::invalidate-contents signal. If a paintable is known
+// to never change its contents, it will set the [PaintableStaticContentsValue]
 // flag. If a consumer cannot deal with changing contents, it may call
-// [method@Gdk.Paintable.get_current_image] which will return a static
+// [Paintable.GetCurrentImage] which will return a static
 // paintable and use that.
 //
 // A paintable can report an intrinsic (or preferred) size or aspect ratio it
 // wishes to be rendered at, though it doesn't have to. Consumers of the interface
 // can use this information to layout thepaintable appropriately. Just like the
 // contents, the size of a paintable can change. A paintable will indicate this
-// by calling [method@Gdk.Paintable.invalidate_size] which will emit the
-// [signal@Gdk.Paintable::invalidate-size] signal. And just like for contents,
+// by calling [Paintable.InvalidateSize] which will emit the
+// Paintable::invalidate-size signal. And just like for contents,
 // if a paintable is known to never change its size, it will set the
-// %GDK_PAINTABLE_STATIC_SIZE flag.
+// [PaintableStaticSizeValue] flag.
 //
 // Besides API for applications, there are some functions that are only
 // useful for implementing subclasses and should not be used by applications:
-// [method@Gdk.Paintable.invalidate_contents],
-// [method@Gdk.Paintable.invalidate_size],
-// [func@Gdk.Paintable.new_empty].
+// [Paintable.InvalidateContents],
+// [Paintable.InvalidateSize],
+// [PaintableNewEmpty].
+//
+// [ClutterContent]: https://developer.gnome.org/clutter/stable/ClutterContent.html
+// [HTML/CSS Paint Sources]: https://www.w3.org/TR/css-images-4/#paint-source
+// [SVG Paint Servers]: https://www.w3.org/TR/SVG2/pservers.html
 type Paintable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -318,28 +330,89 @@ func (x *PaintableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementPaintable adds the Paintable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Snapshot(*Snapshot, float64, float64)
+//   - GetCurrentImage() *PaintableBase
+//   - GetFlags() PaintableFlags
+//   - GetIntrinsicWidth() int
+//   - GetIntrinsicHeight() int
+//   - GetIntrinsicAspectRatio() float64
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see PaintableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementPaintable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, PaintableGLibType(), func(iface *PaintableInterface) {
+		if _, ok := any(zero).(interface {
+			Snapshot(*Snapshot, float64, float64)
+		}); ok {
+			iface.OverrideSnapshot(func(self Paintable, arg0 *Snapshot, arg1 float64, arg2 float64) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Snapshot(*Snapshot, float64, float64)
+				})
+				impl.Snapshot(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetCurrentImage() *PaintableBase }); ok {
+			iface.OverrideGetCurrentImage(func(self Paintable) *PaintableBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetCurrentImage() *PaintableBase })
+				return impl.GetCurrentImage()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFlags() PaintableFlags }); ok {
+			iface.OverrideGetFlags(func(self Paintable) PaintableFlags {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFlags() PaintableFlags })
+				return impl.GetFlags()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIntrinsicWidth() int }); ok {
+			iface.OverrideGetIntrinsicWidth(func(self Paintable) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIntrinsicWidth() int })
+				return impl.GetIntrinsicWidth()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIntrinsicHeight() int }); ok {
+			iface.OverrideGetIntrinsicHeight(func(self Paintable) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIntrinsicHeight() int })
+				return impl.GetIntrinsicHeight()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIntrinsicAspectRatio() float64 }); ok {
+			iface.OverrideGetIntrinsicAspectRatio(func(self Paintable) float64 {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIntrinsicAspectRatio() float64 })
+				return impl.GetIntrinsicAspectRatio()
+			})
+		}
+	})
+}
+
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *PaintableBase) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *PaintableBase) GetCurrentImage() *PaintableBase {
 	var cls *PaintableBase
 
@@ -357,29 +430,29 @@ func (x *PaintableBase) GetCurrentImage() *PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [PaintableFlags] for the flags and what they mean.
 func (x *PaintableBase) GetFlags() PaintableFlags {
 
 	cret := XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [Paintable.GetIntrinsicWidth] and
+// [Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *PaintableBase) GetIntrinsicAspectRatio() float64 {
 
@@ -387,15 +460,15 @@ func (x *PaintableBase) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *PaintableBase) GetIntrinsicHeight() int {
 
@@ -403,15 +476,15 @@ func (x *PaintableBase) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *PaintableBase) GetIntrinsicWidth() int {
 
@@ -422,12 +495,12 @@ func (x *PaintableBase) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *PaintableBase) InvalidateContents() {
 
@@ -437,13 +510,13 @@ func (x *PaintableBase) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *PaintableBase) InvalidateSize() {
 
@@ -451,10 +524,10 @@ func (x *PaintableBase) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *PaintableBase) Snapshot(SnapshotVar *Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -475,7 +548,7 @@ var XGdkPaintableSnapshot func(uintptr, uintptr, float64, float64)
 // Flags about a paintable object.
 //
 // Implementations use these for optimizations such as caching.
-type PaintableFlags int
+type PaintableFlags uint32
 
 var xPaintableFlagsGLibType func() types.GType
 
@@ -486,23 +559,64 @@ func PaintableFlagsGLibType() types.GType {
 const (
 
 	// The size is immutable.
-	//   The [signal@Gdk.Paintable::invalidate-size] signal will never be
+	//   The Paintable::invalidate-size signal will never be
 	//   emitted.
 	PaintableStaticSizeValue PaintableFlags = 1
 	// The content is immutable.
-	//   The [signal@Gdk.Paintable::invalidate-contents] signal will never be
+	//   The Paintable::invalidate-contents signal will never be
 	//   emitted.
 	PaintableStaticContentsValue PaintableFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (PaintableFlags) GLibType() types.GType {
+	return xPaintableFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x PaintableFlags) Has(flags PaintableFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PaintableFlags) Set(flags PaintableFlags) PaintableFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PaintableFlags) Clear(flags PaintableFlags) PaintableFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PaintableFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&PaintableStaticSizeValue != 0 && x&PaintableStaticSizeValue == PaintableStaticSizeValue {
+		names = append(names, "GDK_PAINTABLE_STATIC_SIZE")
+		rest &^= PaintableStaticSizeValue
+	}
+	if rest&PaintableStaticContentsValue != 0 && x&PaintableStaticContentsValue == PaintableStaticContentsValue {
+		names = append(names, "GDK_PAINTABLE_STATIC_CONTENTS")
+		rest &^= PaintableStaticContentsValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xPaintableNewEmpty func(int, int) uintptr
 
 // Returns a paintable that has the given intrinsic size and draws nothing.
 //
 // This is often useful for implementing the
-// [vfunc@Gdk.Paintable.get_current_image] virtual function
+// Paintable.get_current_image virtual function
 // when the paintable is in an incomplete state (like a
-// [GtkMediaStream](../gtk4/class.MediaStream.html) before receiving
+// GtkMediaStream before receiving
 // the first frame).
 func PaintableNewEmpty(IntrinsicWidthVar int, IntrinsicHeightVar int) *PaintableBase {
 	var cls *PaintableBase
@@ -522,7 +636,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -531,18 +645,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xPaintableFlagsGLibType, libs, "gdk_paintable_flags_get_type")
 
-	core.PuregoSafeRegister(&xPaintableNewEmpty, libs, "gdk_paintable_new_empty")
+	core.PuregoRegisterSince(&xPaintableNewEmpty, libs, "gdk_paintable_new_empty", "GDK", "")
 
 	core.PuregoSafeRegister(&xPaintableGLibType, libs, "gdk_paintable_get_type")
 
-	core.PuregoSafeRegister(&XGdkPaintableComputeConcreteSize, libs, "gdk_paintable_compute_concrete_size")
-	core.PuregoSafeRegister(&XGdkPaintableGetCurrentImage, libs, "gdk_paintable_get_current_image")
-	core.PuregoSafeRegister(&XGdkPaintableGetFlags, libs, "gdk_paintable_get_flags")
-	core.PuregoSafeRegister(&XGdkPaintableGetIntrinsicAspectRatio, libs, "gdk_paintable_get_intrinsic_aspect_ratio")
-	core.PuregoSafeRegister(&XGdkPaintableGetIntrinsicHeight, libs, "gdk_paintable_get_intrinsic_height")
-	core.PuregoSafeRegister(&XGdkPaintableGetIntrinsicWidth, libs, "gdk_paintable_get_intrinsic_width")
-	core.PuregoSafeRegister(&XGdkPaintableInvalidateContents, libs, "gdk_paintable_invalidate_contents")
-	core.PuregoSafeRegister(&XGdkPaintableInvalidateSize, libs, "gdk_paintable_invalidate_size")
-	core.PuregoSafeRegister(&XGdkPaintableSnapshot, libs, "gdk_paintable_snapshot")
+	gobject.CheckClassSize("GdkPaintableInterface", xPaintableGLibType, unsafe.Sizeof(PaintableInterface{}))
+
+	core.PuregoRegisterSince(&XGdkPaintableComputeConcreteSize, libs, "gdk_paintable_compute_concrete_size", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableGetCurrentImage, libs, "gdk_paintable_get_current_image", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableGetFlags, libs, "gdk_paintable_get_flags", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableGetIntrinsicAspectRatio, libs, "gdk_paintable_get_intrinsic_aspect_ratio", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableGetIntrinsicHeight, libs, "gdk_paintable_get_intrinsic_height", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableGetIntrinsicWidth, libs, "gdk_paintable_get_intrinsic_width", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableInvalidateContents, libs, "gdk_paintable_invalidate_contents", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableInvalidateSize, libs, "gdk_paintable_invalidate_size", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPaintableSnapshot, libs, "gdk_paintable_snapshot", "GDK", "")
 
 }
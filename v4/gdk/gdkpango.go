@@ -2,7 +2,6 @@
 package gdk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/pango"
@@ -13,8 +12,8 @@ var xPangoLayoutGetClipRegion func(uintptr, int, int, int, int) *cairo.Region
 // Obtains a clip region which contains the areas where the given ranges
 // of text would be drawn.
 //
-// @x_origin and @y_origin are the top left point to center the layout.
-// @index_ranges should contain ranges of bytes in the layout’s text.
+// x_origin and y_origin are the top left point to center the layout.
+// index_ranges should contain ranges of bytes in the layout’s text.
 //
 // Note that the regions returned correspond to logical extents of the text
 // ranges, not ink extents. So the drawn layout may in fact touch areas out of
@@ -31,8 +30,8 @@ var xPangoLayoutLineGetClipRegion func(*pango.LayoutLine, int, int, []int, int)
 // Obtains a clip region which contains the areas where the given
 // ranges of text would be drawn.
 //
-// @x_origin and @y_origin are the top left position of the layout.
-// @index_ranges should contain ranges of bytes in the layout’s text.
+// x_origin and y_origin are the top left position of the layout.
+// index_ranges should contain ranges of bytes in the layout’s text.
 // The clip region will include space to the left or right of the line
 // (to the layout bounding box) if you have indexes above or below the
 // indexes contained inside the line. This is to draw the selection all
@@ -54,14 +53,14 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPangoLayoutGetClipRegion, libs, "gdk_pango_layout_get_clip_region")
-	core.PuregoSafeRegister(&xPangoLayoutLineGetClipRegion, libs, "gdk_pango_layout_line_get_clip_region")
+	core.PuregoRegisterSince(&xPangoLayoutGetClipRegion, libs, "gdk_pango_layout_get_clip_region", "GDK", "")
+	core.PuregoRegisterSince(&xPangoLayoutLineGetClipRegion, libs, "gdk_pango_layout_line_get_clip_region", "GDK", "")
 
 }
@@ -0,0 +1,77 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+)
+
+var xPixbufGetFromSurface func(*cairo.Surface, int, int, int, int) uintptr
+
+// Transfers image data from a `cairo_surface_t` and converts it
+// to a `GdkPixbuf`.
+//
+// This allows you to efficiently read individual pixels from cairo surfaces.
+//
+// This function will create an RGB pixbuf with 8 bits per channel.
+// The pixbuf will contain an alpha channel if the surface contains one.
+//
+// Deprecated: since 4.12. Use [Texture] and subclasses instead
+//
+//	cairo surfaces and pixbufs
+func PixbufGetFromSurface(SurfaceVar *cairo.Surface, SrcXVar int, SrcYVar int, WidthVar int, HeightVar int) *gdkpixbuf.Pixbuf {
+	var cls *gdkpixbuf.Pixbuf
+
+	cret := xPixbufGetFromSurface(SurfaceVar, SrcXVar, SrcYVar, WidthVar, HeightVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gdkpixbuf.Pixbuf{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPixbufGetFromTexture func(uintptr) uintptr
+
+// Creates a new pixbuf from texture.
+//
+// This should generally not be used in newly written code as later
+// stages will almost certainly convert the pixbuf back into a texture
+// to draw it on screen.
+//
+// Deprecated: since 4.12. Use [Texture] and subclasses instead
+//
+//	cairo surfaces and pixbufs
+func PixbufGetFromTexture(TextureVar *Texture) *gdkpixbuf.Pixbuf {
+	var cls *gdkpixbuf.Pixbuf
+
+	cret := xPixbufGetFromTexture(TextureVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gdkpixbuf.Pixbuf{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xPixbufGetFromSurface, libs, "gdk_pixbuf_get_from_surface", "GDK", "")
+	core.PuregoRegisterSince(&xPixbufGetFromTexture, libs, "gdk_pixbuf_get_from_texture", "GDK", "")
+
+}
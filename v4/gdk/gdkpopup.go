@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,7 +23,7 @@ func (x *PopupInterface) GoPointer() uintptr {
 // The `GdkPopup` is positioned relative to its parent surface.
 //
 // `GdkPopup`s are typically used to implement menus and similar popups.
-// They can be modal, which is indicated by the [property@Gdk.Popup:autohide]
+// They can be modal, which is indicated by the [PopupBase.GetPropertyAutohide]
 // property.
 type Popup interface {
 	GoPointer() uintptr
@@ -97,8 +96,8 @@ func (x *PopupBase) GetPositionY() int {
 
 // Gets the current popup rectangle anchor.
 //
-// The value returned may change after calling [method@Gdk.Popup.present],
-// or after the [signal@Gdk.Surface::layout] signal is emitted.
+// The value returned may change after calling [Popup.Present],
+// or after the [Surface.ConnectLayoutFunc] signal is emitted.
 func (x *PopupBase) GetRectAnchor() Gravity {
 
 	cret := XGdkPopupGetRectAnchor(x.GoPointer())
@@ -107,29 +106,29 @@ func (x *PopupBase) GetRectAnchor() Gravity {
 
 // Gets the current popup surface anchor.
 //
-// The value returned may change after calling [method@Gdk.Popup.present],
-// or after the [signal@Gdk.Surface::layout] signal is emitted.
+// The value returned may change after calling [Popup.Present],
+// or after the [Surface.ConnectLayoutFunc] signal is emitted.
 func (x *PopupBase) GetSurfaceAnchor() Gravity {
 
 	cret := XGdkPopupGetSurfaceAnchor(x.GoPointer())
 	return cret
 }
 
-// Present @popup after having processed the `GdkPopupLayout` rules.
+// Present popup after having processed the `GdkPopupLayout` rules.
 //
 // If the popup was previously not showing, it will be shown,
-// otherwise it will change position according to @layout.
+// otherwise it will change position according to layout.
 //
 // After calling this function, the result should be handled in response
-// to the [signal@Gdk.Surface::layout] signal being emitted. The resulting
-// popup position can be queried using [method@Gdk.Popup.get_position_x],
-// [method@Gdk.Popup.get_position_y], and the resulting size will be sent as
-// parameters in the layout signal. Use [method@Gdk.Popup.get_rect_anchor]
-// and [method@Gdk.Popup.get_surface_anchor] to get the resulting anchors.
+// to the [Surface.ConnectLayoutFunc] signal being emitted. The resulting
+// popup position can be queried using [Popup.GetPositionX],
+// [Popup.GetPositionY], and the resulting size will be sent as
+// parameters in the layout signal. Use [Popup.GetRectAnchor]
+// and [Popup.GetSurfaceAnchor] to get the resulting anchors.
 //
-// Presenting may fail, for example if the @popup is set to autohide
+// Presenting may fail, for example if the popup is set to autohide
 // and is immediately hidden upon being presented. If presenting failed,
-// the [signal@Gdk.Surface::layout] signal will not me emitted.
+// the [Surface.ConnectLayoutFunc] signal will not me emitted.
 func (x *PopupBase) Present(WidthVar int, HeightVar int, LayoutVar *PopupLayout) bool {
 
 	cret := XGdkPopupPresent(x.GoPointer(), WidthVar, HeightVar, LayoutVar)
@@ -170,7 +169,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -179,12 +178,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xPopupGLibType, libs, "gdk_popup_get_type")
 
-	core.PuregoSafeRegister(&XGdkPopupGetAutohide, libs, "gdk_popup_get_autohide")
-	core.PuregoSafeRegister(&XGdkPopupGetParent, libs, "gdk_popup_get_parent")
-	core.PuregoSafeRegister(&XGdkPopupGetPositionX, libs, "gdk_popup_get_position_x")
-	core.PuregoSafeRegister(&XGdkPopupGetPositionY, libs, "gdk_popup_get_position_y")
-	core.PuregoSafeRegister(&XGdkPopupGetRectAnchor, libs, "gdk_popup_get_rect_anchor")
-	core.PuregoSafeRegister(&XGdkPopupGetSurfaceAnchor, libs, "gdk_popup_get_surface_anchor")
-	core.PuregoSafeRegister(&XGdkPopupPresent, libs, "gdk_popup_present")
+	core.PuregoRegisterSince(&XGdkPopupGetAutohide, libs, "gdk_popup_get_autohide", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupGetParent, libs, "gdk_popup_get_parent", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupGetPositionX, libs, "gdk_popup_get_position_x", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupGetPositionY, libs, "gdk_popup_get_position_y", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupGetRectAnchor, libs, "gdk_popup_get_rect_anchor", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupGetSurfaceAnchor, libs, "gdk_popup_get_surface_anchor", "GDK", "")
+	core.PuregoRegisterSince(&XGdkPopupPresent, libs, "gdk_popup_present", "GDK", "")
 
 }
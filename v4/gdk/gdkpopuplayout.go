@@ -2,15 +2,16 @@
 package gdk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Contains information that is necessary position a [iface@Gdk.Popup]
+// Contains information that is necessary position a [Popup]
 // relative to its parent.
 //
 // The positioning requires a negotiation with the windowing system,
@@ -21,26 +22,20 @@ import (
 // and the anchor on both that rectangle and the popup. The anchors
 // specify a side or corner to place next to each other.
 //
-// ![Popup anchors](popup-anchors.png)
-//
 // For cases where placing the anchors next to each other would make
 // the popup extend offscreen, the layout includes some hints for how
 // to resolve this problem. The hints may suggest to flip the anchor
 // position to the other side, or to 'slide' the popup along a side,
 // or to resize it.
 //
-// ![Flipping popups](popup-flip.png)
-//
-// ![Sliding popups](popup-slide.png)
-//
 // These hints may be combined.
 //
 // Ultimatively, it is up to the windowing system to determine the position
 // and size of the popup. You can learn about the result by calling
-// [method@Gdk.Popup.get_position_x], [method@Gdk.Popup.get_position_y],
-// [method@Gdk.Popup.get_rect_anchor] and [method@Gdk.Popup.get_surface_anchor]
+// [Popup.GetPositionX], [Popup.GetPositionY],
+// [Popup.GetRectAnchor] and [Popup.GetSurfaceAnchor]
 // after the popup has been presented. This can be used to adjust the rendering.
-// For example, [GtkPopover](../gtk4/class.Popover.html) changes its arrow position
+// For example, GtkPopover changes its arrow position
 // accordingly. But you have to be careful avoid changing the size of the popover,
 // or it has to be presented again.
 type PopupLayout struct {
@@ -61,15 +56,15 @@ var xNewPopupLayout func(*Rectangle, Gravity, Gravity) *PopupLayout
 
 // Create a popup layout description.
 //
-// Used together with [method@Gdk.Popup.present] to describe how a popup
+// Used together with [Popup.Present] to describe how a popup
 // surface should be placed and behave on-screen.
 //
-// @anchor_rect is relative to the top-left corner of the surface's parent.
-// @rect_anchor and @surface_anchor determine anchor points on @anchor_rect
+// anchor_rect is relative to the top-left corner of the surface's parent.
+// rect_anchor and surface_anchor determine anchor points on anchor_rect
 // and surface to pin together.
 //
-// The position of @anchor_rect's anchor point can optionally be offset using
-// [method@Gdk.PopupLayout.set_offset], which is equivalent to offsetting the
+// The position of anchor_rect's anchor point can optionally be offset using
+// [PopupLayout.SetOffset], which is equivalent to offsetting the
 // position of surface.
 func NewPopupLayout(AnchorRectVar *Rectangle, RectAnchorVar Gravity, SurfaceAnchorVar Gravity) *PopupLayout {
 
@@ -79,7 +74,7 @@ func NewPopupLayout(AnchorRectVar *Rectangle, RectAnchorVar Gravity, SurfaceAnch
 
 var xPopupLayoutCopy func(uintptr) *PopupLayout
 
-// Makes a copy of @layout.
+// Makes a copy of layout.
 func (x *PopupLayout) Copy() *PopupLayout {
 
 	cret := xPopupLayoutCopy(x.GoPointer())
@@ -88,7 +83,7 @@ func (x *PopupLayout) Copy() *PopupLayout {
 
 var xPopupLayoutEqual func(uintptr, *PopupLayout) bool
 
-// Check whether @layout and @other has identical layout properties.
+// Check whether layout and other has identical layout properties.
 func (x *PopupLayout) Equal(OtherVar *PopupLayout) bool {
 
 	cret := xPopupLayoutEqual(x.GoPointer(), OtherVar)
@@ -151,7 +146,7 @@ func (x *PopupLayout) GetSurfaceAnchor() Gravity {
 
 var xPopupLayoutRef func(uintptr) *PopupLayout
 
-// Increases the reference count of @value.
+// Increases the reference count of value.
 func (x *PopupLayout) Ref() *PopupLayout {
 
 	cret := xPopupLayoutRef(x.GoPointer())
@@ -162,10 +157,10 @@ var xPopupLayoutSetAnchorHints func(uintptr, AnchorHints)
 
 // Set new anchor hints.
 //
-// The set @anchor_hints determines how @surface will be moved
+// The set anchor_hints determines how surface will be moved
 // if the anchor points cause it to move off-screen. For example,
 // `GDK_ANCHOR_FLIP_X` will replace `GDK_GRAVITY_NORTH_WEST` with
-// `GDK_GRAVITY_NORTH_EAST` and vice versa if @surface extends
+// `GDK_GRAVITY_NORTH_EAST` and vice versa if surface extends
 // beyond the left or right edges of the monitor.
 func (x *PopupLayout) SetAnchorHints(AnchorHintsVar AnchorHints) {
 
@@ -224,7 +219,7 @@ func (x *PopupLayout) SetSurfaceAnchor(AnchorVar Gravity) {
 
 var xPopupLayoutUnref func(uintptr)
 
-// Decreases the reference count of @value.
+// Decreases the reference count of value.
 func (x *PopupLayout) Unref() {
 
 	xPopupLayoutUnref(x.GoPointer())
@@ -236,17 +231,17 @@ func (x *PopupLayout) Unref() {
 // These hints determine how the surface should be positioned in the case that
 // the surface would fall off-screen if placed in its ideal position.
 //
-// For example, %GDK_ANCHOR_FLIP_X will replace %GDK_GRAVITY_NORTH_WEST with
-// %GDK_GRAVITY_NORTH_EAST and vice versa if the surface extends beyond the left
+// For example, [AnchorFlipXValue] will replace [GravityNorthWestValue] with
+// [GravityNorthEastValue] and vice versa if the surface extends beyond the left
 // or right edges of the monitor.
 //
-// If %GDK_ANCHOR_SLIDE_X is set, the surface can be shifted horizontally to fit
-// on-screen. If %GDK_ANCHOR_RESIZE_X is set, the surface can be shrunken
+// If [AnchorSlideXValue] is set, the surface can be shifted horizontally to fit
+// on-screen. If [AnchorResizeXValue] is set, the surface can be shrunken
 // horizontally to fit.
 //
 // In general, when multiple flags are set, flipping should take precedence over
 // sliding, which should take precedence over resizing.
-type AnchorHints int
+type AnchorHints uint32
 
 var xAnchorHintsGLibType func() types.GType
 
@@ -276,12 +271,81 @@ const (
 	AnchorResizeValue AnchorHints = 48
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (AnchorHints) GLibType() types.GType {
+	return xAnchorHintsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x AnchorHints) Has(flags AnchorHints) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x AnchorHints) Set(flags AnchorHints) AnchorHints {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x AnchorHints) Clear(flags AnchorHints) AnchorHints {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x AnchorHints) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&AnchorFlipXValue != 0 && x&AnchorFlipXValue == AnchorFlipXValue {
+		names = append(names, "GDK_ANCHOR_FLIP_X")
+		rest &^= AnchorFlipXValue
+	}
+	if rest&AnchorFlipYValue != 0 && x&AnchorFlipYValue == AnchorFlipYValue {
+		names = append(names, "GDK_ANCHOR_FLIP_Y")
+		rest &^= AnchorFlipYValue
+	}
+	if rest&AnchorSlideXValue != 0 && x&AnchorSlideXValue == AnchorSlideXValue {
+		names = append(names, "GDK_ANCHOR_SLIDE_X")
+		rest &^= AnchorSlideXValue
+	}
+	if rest&AnchorSlideYValue != 0 && x&AnchorSlideYValue == AnchorSlideYValue {
+		names = append(names, "GDK_ANCHOR_SLIDE_Y")
+		rest &^= AnchorSlideYValue
+	}
+	if rest&AnchorResizeXValue != 0 && x&AnchorResizeXValue == AnchorResizeXValue {
+		names = append(names, "GDK_ANCHOR_RESIZE_X")
+		rest &^= AnchorResizeXValue
+	}
+	if rest&AnchorResizeYValue != 0 && x&AnchorResizeYValue == AnchorResizeYValue {
+		names = append(names, "GDK_ANCHOR_RESIZE_Y")
+		rest &^= AnchorResizeYValue
+	}
+	if rest&AnchorFlipValue != 0 && x&AnchorFlipValue == AnchorFlipValue {
+		names = append(names, "GDK_ANCHOR_FLIP")
+		rest &^= AnchorFlipValue
+	}
+	if rest&AnchorSlideValue != 0 && x&AnchorSlideValue == AnchorSlideValue {
+		names = append(names, "GDK_ANCHOR_SLIDE")
+		rest &^= AnchorSlideValue
+	}
+	if rest&AnchorResizeValue != 0 && x&AnchorResizeValue == AnchorResizeValue {
+		names = append(names, "GDK_ANCHOR_RESIZE")
+		rest &^= AnchorResizeValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 func init() {
 	core.SetPackageName("GDK", "gtk4")
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -292,23 +356,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xPopupLayoutGLibType, libs, "gdk_popup_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewPopupLayout, libs, "gdk_popup_layout_new")
-
-	core.PuregoSafeRegister(&xPopupLayoutCopy, libs, "gdk_popup_layout_copy")
-	core.PuregoSafeRegister(&xPopupLayoutEqual, libs, "gdk_popup_layout_equal")
-	core.PuregoSafeRegister(&xPopupLayoutGetAnchorHints, libs, "gdk_popup_layout_get_anchor_hints")
-	core.PuregoSafeRegister(&xPopupLayoutGetAnchorRect, libs, "gdk_popup_layout_get_anchor_rect")
-	core.PuregoSafeRegister(&xPopupLayoutGetOffset, libs, "gdk_popup_layout_get_offset")
-	core.PuregoSafeRegister(&xPopupLayoutGetRectAnchor, libs, "gdk_popup_layout_get_rect_anchor")
-	core.PuregoSafeRegister(&xPopupLayoutGetShadowWidth, libs, "gdk_popup_layout_get_shadow_width")
-	core.PuregoSafeRegister(&xPopupLayoutGetSurfaceAnchor, libs, "gdk_popup_layout_get_surface_anchor")
-	core.PuregoSafeRegister(&xPopupLayoutRef, libs, "gdk_popup_layout_ref")
-	core.PuregoSafeRegister(&xPopupLayoutSetAnchorHints, libs, "gdk_popup_layout_set_anchor_hints")
-	core.PuregoSafeRegister(&xPopupLayoutSetAnchorRect, libs, "gdk_popup_layout_set_anchor_rect")
-	core.PuregoSafeRegister(&xPopupLayoutSetOffset, libs, "gdk_popup_layout_set_offset")
-	core.PuregoSafeRegister(&xPopupLayoutSetRectAnchor, libs, "gdk_popup_layout_set_rect_anchor")
-	core.PuregoSafeRegister(&xPopupLayoutSetShadowWidth, libs, "gdk_popup_layout_set_shadow_width")
-	core.PuregoSafeRegister(&xPopupLayoutSetSurfaceAnchor, libs, "gdk_popup_layout_set_surface_anchor")
-	core.PuregoSafeRegister(&xPopupLayoutUnref, libs, "gdk_popup_layout_unref")
+	core.PuregoRegisterSince(&xNewPopupLayout, libs, "gdk_popup_layout_new", "GDK", "")
+
+	core.PuregoRegisterSince(&xPopupLayoutCopy, libs, "gdk_popup_layout_copy", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutEqual, libs, "gdk_popup_layout_equal", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutGetAnchorHints, libs, "gdk_popup_layout_get_anchor_hints", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutGetAnchorRect, libs, "gdk_popup_layout_get_anchor_rect", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutGetOffset, libs, "gdk_popup_layout_get_offset", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutGetRectAnchor, libs, "gdk_popup_layout_get_rect_anchor", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutGetShadowWidth, libs, "gdk_popup_layout_get_shadow_width", "GDK", "4.2")
+	core.PuregoRegisterSince(&xPopupLayoutGetSurfaceAnchor, libs, "gdk_popup_layout_get_surface_anchor", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutRef, libs, "gdk_popup_layout_ref", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutSetAnchorHints, libs, "gdk_popup_layout_set_anchor_hints", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutSetAnchorRect, libs, "gdk_popup_layout_set_anchor_rect", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutSetOffset, libs, "gdk_popup_layout_set_offset", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutSetRectAnchor, libs, "gdk_popup_layout_set_rect_anchor", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutSetShadowWidth, libs, "gdk_popup_layout_set_shadow_width", "GDK", "4.2")
+	core.PuregoRegisterSince(&xPopupLayoutSetSurfaceAnchor, libs, "gdk_popup_layout_set_surface_anchor", "GDK", "")
+	core.PuregoRegisterSince(&xPopupLayoutUnref, libs, "gdk_popup_layout_unref", "GDK", "")
 
 }
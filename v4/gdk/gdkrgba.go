@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -40,11 +39,19 @@ func (x *RGBA) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewRGBAAlloc returns a zeroed RGBA allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated RGBA. Release it with Free.
+func NewRGBAAlloc() *RGBA {
+	cret := core.GMalloc0(max(unsafe.Sizeof(RGBA{}), 16))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*RGBA)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xRGBACopy func(uintptr) *RGBA
 
 // Makes a copy of a `GdkRGBA`.
 //
-// The result must be freed through [method@Gdk.RGBA.free].
+// The result must be freed through [RGBA.Free].
 func (x *RGBA) Copy() *RGBA {
 
 	cret := xRGBACopy(x.GoPointer())
@@ -81,7 +88,7 @@ func (x *RGBA) Hash() uint {
 
 var xRGBAIsClear func(uintptr) bool
 
-// Checks if an @rgba value is transparent.
+// Checks if an rgba value is transparent.
 //
 // That is, drawing with the value would not produce any change.
 func (x *RGBA) IsClear() bool {
@@ -92,7 +99,7 @@ func (x *RGBA) IsClear() bool {
 
 var xRGBAIsOpaque func(uintptr) bool
 
-// Checks if an @rgba value is opaque.
+// Checks if an rgba value is opaque.
 //
 // That is, drawing with the value will not retain any results
 // from previous contents.
@@ -132,7 +139,7 @@ func (x *RGBA) Parse(SpecVar string) bool {
 
 var xRGBAToString func(uintptr) string
 
-// Returns a textual specification of @rgba in the form
+// Returns a textual specification of rgba in the form
 // `rgb(r,g,b)` or `rgba(r,g,b,a)`, where “r”, “g”, “b” and
 // “a” represent the red, green, blue and alpha values
 // respectively. “r”, “g”, and “b” are represented as integers
@@ -140,7 +147,7 @@ var xRGBAToString func(uintptr) string
 // point value in the range 0 to 1.
 //
 // These string forms are string forms that are supported by
-// the CSS3 colors module, and can be parsed by [method@Gdk.RGBA.parse].
+// the CSS3 colors module, and can be parsed by [RGBA.Parse].
 //
 // Note that this string representation may lose some precision,
 // since “r”, “g” and “b” are represented as 8-bit integers. If
@@ -156,7 +163,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -165,13 +172,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xRGBAGLibType, libs, "gdk_rgba_get_type")
 
-	core.PuregoSafeRegister(&xRGBACopy, libs, "gdk_rgba_copy")
-	core.PuregoSafeRegister(&xRGBAEqual, libs, "gdk_rgba_equal")
-	core.PuregoSafeRegister(&xRGBAFree, libs, "gdk_rgba_free")
-	core.PuregoSafeRegister(&xRGBAHash, libs, "gdk_rgba_hash")
-	core.PuregoSafeRegister(&xRGBAIsClear, libs, "gdk_rgba_is_clear")
-	core.PuregoSafeRegister(&xRGBAIsOpaque, libs, "gdk_rgba_is_opaque")
-	core.PuregoSafeRegister(&xRGBAParse, libs, "gdk_rgba_parse")
-	core.PuregoSafeRegister(&xRGBAToString, libs, "gdk_rgba_to_string")
+	core.PuregoRegisterSince(&xRGBACopy, libs, "gdk_rgba_copy", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAEqual, libs, "gdk_rgba_equal", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAFree, libs, "gdk_rgba_free", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAHash, libs, "gdk_rgba_hash", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAIsClear, libs, "gdk_rgba_is_clear", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAIsOpaque, libs, "gdk_rgba_is_opaque", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAParse, libs, "gdk_rgba_parse", "GDK", "")
+	core.PuregoRegisterSince(&xRGBAToString, libs, "gdk_rgba_to_string", "GDK", "")
 
 }
@@ -2,9 +2,10 @@
 package gdk
 
 import (
+	"fmt"
+	"strings"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,7 +13,7 @@ import (
 )
 
 // Flags describing the seat capabilities.
-type SeatCapabilities int
+type SeatCapabilities uint32
 
 var xSeatCapabilitiesGLibType func() types.GType
 
@@ -40,6 +41,67 @@ const (
 	SeatCapabilityAllValue SeatCapabilities = 31
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SeatCapabilities) GLibType() types.GType {
+	return xSeatCapabilitiesGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SeatCapabilities) Has(flags SeatCapabilities) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SeatCapabilities) Set(flags SeatCapabilities) SeatCapabilities {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SeatCapabilities) Clear(flags SeatCapabilities) SeatCapabilities {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SeatCapabilities) String() string {
+	if x == 0 {
+		return "GDK_SEAT_CAPABILITY_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&SeatCapabilityPointerValue != 0 && x&SeatCapabilityPointerValue == SeatCapabilityPointerValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_POINTER")
+		rest &^= SeatCapabilityPointerValue
+	}
+	if rest&SeatCapabilityTouchValue != 0 && x&SeatCapabilityTouchValue == SeatCapabilityTouchValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_TOUCH")
+		rest &^= SeatCapabilityTouchValue
+	}
+	if rest&SeatCapabilityTabletStylusValue != 0 && x&SeatCapabilityTabletStylusValue == SeatCapabilityTabletStylusValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_TABLET_STYLUS")
+		rest &^= SeatCapabilityTabletStylusValue
+	}
+	if rest&SeatCapabilityKeyboardValue != 0 && x&SeatCapabilityKeyboardValue == SeatCapabilityKeyboardValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_KEYBOARD")
+		rest &^= SeatCapabilityKeyboardValue
+	}
+	if rest&SeatCapabilityTabletPadValue != 0 && x&SeatCapabilityTabletPadValue == SeatCapabilityTabletPadValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_TABLET_PAD")
+		rest &^= SeatCapabilityTabletPadValue
+	}
+	if rest&SeatCapabilityAllPointingValue != 0 && x&SeatCapabilityAllPointingValue == SeatCapabilityAllPointingValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_ALL_POINTING")
+		rest &^= SeatCapabilityAllPointingValue
+	}
+	if rest&SeatCapabilityAllValue != 0 && x&SeatCapabilityAllValue == SeatCapabilityAllValue {
+		names = append(names, "GDK_SEAT_CAPABILITY_ALL")
+		rest &^= SeatCapabilityAllValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Represents a collection of input devices that belong to a user.
 type Seat struct {
 	gobject.Object
@@ -147,105 +209,193 @@ func (c *Seat) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when a new input device is related to this seat.
+//
+// Deprecated: use ConnectDeviceAddedFunc, which also accepts method values and closures.
 func (x *Seat) ConnectDeviceAdded(cb *func(Seat, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "device-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DeviceVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Seat{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DeviceVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "device-added", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DeviceVarp uintptr) {
+var xSeatDeviceAddedTrampoline uintptr
+
+func xSeatDeviceAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DeviceVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Seat, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Seat{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DeviceVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "device-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeviceAddedFunc connects cb to the "device-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Seat) ConnectDeviceAddedFunc(cb func(Seat, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "device-added", &xSeatDeviceAddedTrampoline, xSeatDeviceAddedNewTrampoline, cb)
 }
 
 // Emitted when an input device is removed (e.g. unplugged).
+//
+// Deprecated: use ConnectDeviceRemovedFunc, which also accepts method values and closures.
 func (x *Seat) ConnectDeviceRemoved(cb *func(Seat, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "device-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DeviceVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Seat{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DeviceVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "device-removed", cbRefPtr, cbPtr)
+}
+
+var xSeatDeviceRemovedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DeviceVarp uintptr) {
+func xSeatDeviceRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DeviceVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Seat, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Seat{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DeviceVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "device-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeviceRemovedFunc connects cb to the "device-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Seat) ConnectDeviceRemovedFunc(cb func(Seat, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "device-removed", &xSeatDeviceRemovedTrampoline, xSeatDeviceRemovedNewTrampoline, cb)
 }
 
 // Emitted whenever a new tool is made known to the seat.
 //
 // The tool may later be assigned to a device (i.e. on
 // proximity with a tablet). The device will emit the
-// [signal@Gdk.Device::tool-changed] signal accordingly.
+// [Device.ConnectToolChangedFunc] signal accordingly.
 //
 // A same tool may be used by several devices.
+//
+// Deprecated: use ConnectToolAddedFunc, which also accepts method values and closures.
 func (x *Seat) ConnectToolAdded(cb *func(Seat, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tool-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Seat{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ToolVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tool-added", cbRefPtr, cbPtr)
+}
+
+var xSeatToolAddedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+func xSeatToolAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ToolVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Seat, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Seat{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ToolVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tool-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted whenever a tool is no longer known to this @seat.
+// ConnectToolAddedFunc connects cb to the "tool-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Seat) ConnectToolAddedFunc(cb func(Seat, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tool-added", &xSeatToolAddedTrampoline, xSeatToolAddedNewTrampoline, cb)
+}
+
+// Emitted whenever a tool is no longer known to this seat.
+//
+// Deprecated: use ConnectToolRemovedFunc, which also accepts method values and closures.
 func (x *Seat) ConnectToolRemoved(cb *func(Seat, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tool-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Seat{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ToolVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tool-removed", cbRefPtr, cbPtr)
+}
+
+var xSeatToolRemovedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ToolVarp uintptr) {
+func xSeatToolRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ToolVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Seat, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Seat{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ToolVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tool-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToolRemovedFunc connects cb to the "tool-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Seat) ConnectToolRemovedFunc(cb func(Seat, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tool-removed", &xSeatToolRemovedTrampoline, xSeatToolRemovedNewTrampoline, cb)
 }
 
 func init() {
@@ -253,7 +403,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -264,11 +414,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSeatGLibType, libs, "gdk_seat_get_type")
 
-	core.PuregoSafeRegister(&xSeatGetCapabilities, libs, "gdk_seat_get_capabilities")
-	core.PuregoSafeRegister(&xSeatGetDevices, libs, "gdk_seat_get_devices")
-	core.PuregoSafeRegister(&xSeatGetDisplay, libs, "gdk_seat_get_display")
-	core.PuregoSafeRegister(&xSeatGetKeyboard, libs, "gdk_seat_get_keyboard")
-	core.PuregoSafeRegister(&xSeatGetPointer, libs, "gdk_seat_get_pointer")
-	core.PuregoSafeRegister(&xSeatGetTools, libs, "gdk_seat_get_tools")
+	core.PuregoRegisterSince(&xSeatGetCapabilities, libs, "gdk_seat_get_capabilities", "GDK", "")
+	core.PuregoRegisterSince(&xSeatGetDevices, libs, "gdk_seat_get_devices", "GDK", "")
+	core.PuregoRegisterSince(&xSeatGetDisplay, libs, "gdk_seat_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xSeatGetKeyboard, libs, "gdk_seat_get_keyboard", "GDK", "")
+	core.PuregoRegisterSince(&xSeatGetPointer, libs, "gdk_seat_get_pointer", "GDK", "")
+	core.PuregoRegisterSince(&xSeatGetTools, libs, "gdk_seat_get_tools", "GDK", "")
 
 }
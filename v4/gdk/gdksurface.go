@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,10 +23,10 @@ func (x *SurfaceClass) GoPointer() uintptr {
 // Represents a rectangular region on the screen.
 //
 // It’s a low-level object, used to implement high-level objects
-// such as [GtkWindow](../gtk4/class.Window.html).
+// such as GtkWindow.
 //
-// The surfaces you see in practice are either [iface@Gdk.Toplevel] or
-// [iface@Gdk.Popup], and those interfaces provide much of the required
+// The surfaces you see in practice are either [Toplevel] or
+// [Popup], and those interfaces provide much of the required
 // API to interact with these surfaces. Other, more specialized surface
 // types exist, but you will rarely interact with them directly.
 type Surface struct {
@@ -50,8 +49,8 @@ var xNewSurfacePopup func(uintptr, bool) uintptr
 
 // Create a new popup surface.
 //
-// The surface will be attached to @parent and can be positioned
-// relative to it using [method@Gdk.Popup.present].
+// The surface will be attached to parent and can be positioned
+// relative to it using [Popup.Present].
 func NewSurfacePopup(ParentVar *Surface, AutohideVar bool) *Surface {
 	var cls *Surface
 
@@ -83,40 +82,24 @@ func NewSurfaceToplevel(DisplayVar *Display) *Surface {
 
 var xSurfaceBeep func(uintptr)
 
-// Emits a short beep associated to @surface.
+// Emits a short beep associated to surface.
 //
-// If the display of @surface does not support per-surface beeps,
-// emits a short beep on the display just as [method@Gdk.Display.beep].
+// If the display of surface does not support per-surface beeps,
+// emits a short beep on the display just as [Display.Beep].
 func (x *Surface) Beep() {
 
 	xSurfaceBeep(x.GoPointer())
 
 }
 
-var xSurfaceCreateCairoContext func(uintptr) uintptr
-
-// Creates a new `GdkCairoContext` for rendering on @surface.
-func (x *Surface) CreateCairoContext() *CairoContext {
-	var cls *CairoContext
-
-	cret := xSurfaceCreateCairoContext(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &CairoContext{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xSurfaceCreateGlContext func(uintptr) uintptr
 
 // Creates a new `GdkGLContext` for the `GdkSurface`.
 //
 // The context is disconnected from any particular surface or surface.
-// If the creation of the `GdkGLContext` failed, @error will be set.
+// If the creation of the `GdkGLContext` failed, error will be set.
 // Before using the returned `GdkGLContext`, you will need to
-// call [method@Gdk.GLContext.make_current] or [method@Gdk.GLContext.realize].
+// call [GLContext.MakeCurrent] or [GLContext.Realize].
 func (x *Surface) CreateGlContext() (*GLContext, error) {
 	var cls *GLContext
 	var cerr *glib.Error
@@ -124,56 +107,15 @@ func (x *Surface) CreateGlContext() (*GLContext, error) {
 	cret := xSurfaceCreateGlContext(x.GoPointer())
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &GLContext{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
-	}
-	return cls, cerr
-
-}
-
-var xSurfaceCreateSimilarSurface func(uintptr, cairo.Content, int, int) *cairo.Surface
-
-// Create a new Cairo surface that is as compatible as possible with the
-// given @surface.
-//
-// For example the new surface will have the same fallback resolution
-// and font options as @surface. Generally, the new surface will also
-// use the same backend as @surface, unless that is not possible for
-// some reason. The type of the returned surface may be examined with
-// cairo_surface_get_type().
-//
-// Initially the surface contents are all 0 (transparent if contents
-// have transparency, black otherwise.)
-//
-// This function always returns a valid pointer, but it will return a
-// pointer to a “nil” surface if @other is already in an error state
-// or any other error occurs.
-func (x *Surface) CreateSimilarSurface(ContentVar cairo.Content, WidthVar int, HeightVar int) *cairo.Surface {
-
-	cret := xSurfaceCreateSimilarSurface(x.GoPointer(), ContentVar, WidthVar, HeightVar)
-	return cret
-}
-
-var xSurfaceCreateVulkanContext func(uintptr) uintptr
-
-// Sets an error and returns %NULL.
-func (x *Surface) CreateVulkanContext() (*VulkanContext, error) {
-	var cls *VulkanContext
-	var cerr *glib.Error
-
-	cret := xSurfaceCreateVulkanContext(x.GoPointer())
-
-	if cret == 0 {
-		return nil, cerr
-	}
-	cls = &VulkanContext{}
-	cls.Ptr = cret
-	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -181,10 +123,10 @@ func (x *Surface) CreateVulkanContext() (*VulkanContext, error) {
 
 var xSurfaceDestroy func(uintptr)
 
-// Destroys the window system resources associated with @surface and
-// decrements @surface's reference count.
+// Destroys the window system resources associated with surface and
+// decrements surface's reference count.
 //
-// The window system resources for all children of @surface are also
+// The window system resources for all children of surface are also
 // destroyed, but the children’s reference counts are not decremented.
 //
 // Note that a surface will not be destroyed automatically when its
@@ -201,10 +143,10 @@ var xSurfaceGetCursor func(uintptr) uintptr
 // Retrieves a `GdkCursor` pointer for the cursor currently set on the
 // `GdkSurface`.
 //
-// If the return value is %NULL then there is no custom cursor set on
+// If the return value is nil then there is no custom cursor set on
 // the surface, and it is using the cursor for its parent surface.
 //
-// Use [method@Gdk.Surface.set_cursor] to unset the cursor of the surface.
+// Use [Surface.SetCursor] to unset the cursor of the surface.
 func (x *Surface) GetCursor() *Cursor {
 	var cls *Cursor
 
@@ -221,13 +163,13 @@ func (x *Surface) GetCursor() *Cursor {
 
 var xSurfaceGetDeviceCursor func(uintptr, uintptr) uintptr
 
-// Retrieves a `GdkCursor` pointer for the @device currently set on the
+// Retrieves a `GdkCursor` pointer for the device currently set on the
 // specified `GdkSurface`.
 //
-// If the return value is %NULL then there is no custom cursor set on the
+// If the return value is nil then there is no custom cursor set on the
 // specified surface, and it is using the cursor for its parent surface.
 //
-// Use [method@Gdk.Surface.set_cursor] to unset the cursor of the surface.
+// Use [Surface.SetCursor] to unset the cursor of the surface.
 func (x *Surface) GetDeviceCursor(DeviceVar *Device) *Cursor {
 	var cls *Cursor
 
@@ -247,7 +189,7 @@ var xSurfaceGetDevicePosition func(uintptr, uintptr, *float64, *float64, *Modifi
 // Obtains the current device position and modifier state.
 //
 // The position is given in coordinates relative to the upper
-// left corner of @surface.
+// left corner of surface.
 func (x *Surface) GetDevicePosition(DeviceVar *Device, XVar *float64, YVar *float64, MaskVar *ModifierType) bool {
 
 	cret := xSurfaceGetDevicePosition(x.GoPointer(), DeviceVar.GoPointer(), XVar, YVar, MaskVar)
@@ -293,10 +235,10 @@ func (x *Surface) GetFrameClock() *FrameClock {
 
 var xSurfaceGetHeight func(uintptr) int
 
-// Returns the height of the given @surface.
+// Returns the height of the given surface.
 //
 // Surface size is reported in ”application pixels”, not
-// ”device pixels” (see [method@Gdk.Surface.get_scale_factor]).
+// ”device pixels” (see [Surface.GetScaleFactor]).
 func (x *Surface) GetHeight() int {
 
 	cret := xSurfaceGetHeight(x.GoPointer())
@@ -307,8 +249,8 @@ var xSurfaceGetMapped func(uintptr) bool
 
 // Checks whether the surface has been mapped.
 //
-// A surface is mapped with [method@Gdk.Toplevel.present]
-// or [method@Gdk.Popup.present].
+// A surface is mapped with [Toplevel.Present]
+// or [Popup.Present].
 func (x *Surface) GetMapped() bool {
 
 	cret := xSurfaceGetMapped(x.GoPointer())
@@ -324,7 +266,7 @@ var xSurfaceGetScale func(uintptr) float64
 // buffers with a resolution that is bigger than the surface size (e.g.
 // to show the surface on a high-resolution display, or in a magnifier).
 //
-// Compare with [method@Gdk.Surface.get_scale_factor], which returns the
+// Compare with [Surface.GetScaleFactor], which returns the
 // next larger integer.
 //
 // The scale may change during the lifetime of the surface.
@@ -355,10 +297,10 @@ func (x *Surface) GetScaleFactor() int {
 
 var xSurfaceGetWidth func(uintptr) int
 
-// Returns the width of the given @surface.
+// Returns the width of the given surface.
 //
 // Surface size is reported in ”application pixels”, not
-// ”device pixels” (see [method@Gdk.Surface.get_scale_factor]).
+// ”device pixels” (see [Surface.GetScaleFactor]).
 func (x *Surface) GetWidth() int {
 
 	cret := xSurfaceGetWidth(x.GoPointer())
@@ -372,7 +314,7 @@ var xSurfaceHide func(uintptr)
 // For toplevel surfaces, withdraws them, so they will no longer be
 // known to the window manager; for all surfaces, unmaps them, so
 // they won’t be displayed. Normally done automatically as
-// part of [gtk_widget_hide()](../gtk4/method.Widget.hide.html).
+// part of [[gtk.Widget.Hide]](../gtk4/method.Widget.hide.html).
 func (x *Surface) Hide() {
 
 	xSurfaceHide(x.GoPointer())
@@ -390,7 +332,7 @@ func (x *Surface) IsDestroyed() bool {
 
 var xSurfaceQueueRender func(uintptr)
 
-// Forces a [signal@Gdk.Surface::render] signal emission for @surface
+// Forces a [Surface.ConnectRenderFunc] signal emission for surface
 // to be scheduled.
 //
 // This function is useful for implementations that track invalid
@@ -405,7 +347,7 @@ var xSurfaceRequestLayout func(uintptr)
 
 // Request a layout phase from the surface's frame clock.
 //
-// See [method@Gdk.FrameClock.request_phase].
+// See [FrameClock.RequestPhase].
 func (x *Surface) RequestLayout() {
 
 	xSurfaceRequestLayout(x.GoPointer())
@@ -416,12 +358,12 @@ var xSurfaceSetCursor func(uintptr, uintptr)
 
 // Sets the default mouse pointer for a `GdkSurface`.
 //
-// Passing %NULL for the @cursor argument means that @surface will use
+// Passing nil for the cursor argument means that surface will use
 // the cursor of its parent surface. Most surfaces should use this default.
-// Note that @cursor must be for the same display as @surface.
+// Note that cursor must be for the same display as surface.
 //
-// Use [ctor@Gdk.Cursor.new_from_name] or [ctor@Gdk.Cursor.new_from_texture]
-// to create the cursor. To make the cursor invisible, use %GDK_BLANK_CURSOR.
+// Use [NewCursorFromName] or [NewCursorFromTexture]
+// to create the cursor. To make the cursor invisible, use GDK_BLANK_CURSOR.
 func (x *Surface) SetCursor(CursorVar *Cursor) {
 
 	xSurfaceSetCursor(x.GoPointer(), CursorVar.GoPointer())
@@ -430,13 +372,13 @@ func (x *Surface) SetCursor(CursorVar *Cursor) {
 
 var xSurfaceSetDeviceCursor func(uintptr, uintptr, uintptr)
 
-// Sets a specific `GdkCursor` for a given device when it gets inside @surface.
+// Sets a specific `GdkCursor` for a given device when it gets inside surface.
 //
-// Passing %NULL for the @cursor argument means that @surface will use the
+// Passing nil for the cursor argument means that surface will use the
 // cursor of its parent surface. Most surfaces should use this default.
 //
-// Use [ctor@Gdk.Cursor.new_from_name] or [ctor@Gdk.Cursor.new_from_texture]
-// to create the cursor. To make the cursor invisible, use %GDK_BLANK_CURSOR.
+// Use [NewCursorFromName] or [NewCursorFromTexture]
+// to create the cursor. To make the cursor invisible, use GDK_BLANK_CURSOR.
 func (x *Surface) SetDeviceCursor(DeviceVar *Device, CursorVar *Cursor) {
 
 	xSurfaceSetDeviceCursor(x.GoPointer(), DeviceVar.GoPointer(), CursorVar.GoPointer())
@@ -450,14 +392,14 @@ var xSurfaceSetInputRegion func(uintptr, *cairo.Region)
 //
 // Mouse events which happen while the pointer position corresponds
 // to an unset bit in the mask will be passed on the surface below
-// @surface.
+// surface.
 //
 // An input region is typically used with RGBA surfaces. The alpha
 // channel of the surface defines which pixels are invisible and
 // allows for nicely antialiased borders, and the input region
 // controls where the surface is “clickable”.
 //
-// Use [method@Gdk.Display.supports_input_shapes] to find out if
+// Use [Display.SupportsInputShapes] to find out if
 // a particular backend supports input regions.
 func (x *Surface) SetInputRegion(RegionVar *cairo.Region) {
 
@@ -465,34 +407,11 @@ func (x *Surface) SetInputRegion(RegionVar *cairo.Region) {
 
 }
 
-var xSurfaceSetOpaqueRegion func(uintptr, *cairo.Region)
-
-// Marks a region of the `GdkSurface` as opaque.
-//
-// For optimisation purposes, compositing window managers may
-// like to not draw obscured regions of surfaces, or turn off blending
-// during for these regions. With RGB windows with no transparency,
-// this is just the shape of the window, but with ARGB32 windows, the
-// compositor does not know what regions of the window are transparent
-// or not.
-//
-// This function only works for toplevel surfaces.
-//
-// GTK will update this property automatically if the @surface background
-// is opaque, as we know where the opaque regions are. If your surface
-// background is not opaque, please update this property in your
-// [GtkWidgetClass.css_changed](../gtk4/vfunc.Widget.css_changed.html) handler.
-func (x *Surface) SetOpaqueRegion(RegionVar *cairo.Region) {
-
-	xSurfaceSetOpaqueRegion(x.GoPointer(), RegionVar)
-
-}
-
 var xSurfaceTranslateCoordinates func(uintptr, uintptr, float64, float64) bool
 
 // Translates coordinates between two surfaces.
 //
-// Note that this only works if @to and @from are popups or
+// Note that this only works if to and from are popups or
 // transient-for to the same toplevel (directly or indirectly).
 func (x *Surface) TranslateCoordinates(ToVar *Surface, XVar float64, YVar float64) bool {
 
@@ -539,7 +458,7 @@ func (x *Surface) GetPropertyScale() float64 {
 // The scale factor of the surface.
 //
 // The scale factor is the next larger integer,
-// compared to [property@Gdk.Surface:scale].
+// compared to [Surface.GetPropertyScale].
 func (x *Surface) GetPropertyScaleFactor() int {
 	var v gobject.Value
 	x.GetProperty("scale-factor", &v)
@@ -554,128 +473,238 @@ func (x *Surface) GetPropertyWidth() int {
 	return v.GetInt()
 }
 
-// Emitted when @surface starts being present on the monitor.
+// Emitted when surface starts being present on the monitor.
+//
+// Deprecated: use ConnectEnterMonitorFunc, which also accepts method values and closures.
 func (x *Surface) ConnectEnterMonitor(cb *func(Surface, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enter-monitor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MonitorVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Surface{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MonitorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enter-monitor", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, MonitorVarp uintptr) {
+var xSurfaceEnterMonitorTrampoline uintptr
+
+func xSurfaceEnterMonitorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MonitorVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Surface, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Surface{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MonitorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enter-monitor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when GDK receives an input event for @surface.
+// ConnectEnterMonitorFunc connects cb to the "enter-monitor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Surface) ConnectEnterMonitorFunc(cb func(Surface, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enter-monitor", &xSurfaceEnterMonitorTrampoline, xSurfaceEnterMonitorNewTrampoline, cb)
+}
+
+// Emitted when GDK receives an input event for surface.
+//
+// Deprecated: use ConnectEventFunc, which also accepts method values and closures.
 func (x *Surface) ConnectEvent(cb *func(Surface, *Event) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, EventVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Surface{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, EventNewFromInternalPtr(EventVarp))
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "event", cbRefPtr, cbPtr)
+}
+
+var xSurfaceEventTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, EventVarp uintptr) bool {
+func xSurfaceEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, EventVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Surface, *Event) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Surface{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, EventNewFromInternalPtr(EventVarp))
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the size of @surface is changed, or when relayout should
+// ConnectEventFunc connects cb to the "event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Surface) ConnectEventFunc(cb func(Surface, *Event) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "event", &xSurfaceEventTrampoline, xSurfaceEventNewTrampoline, cb)
+}
+
+// Emitted when the size of surface is changed, or when relayout should
 // be performed.
 //
 // Surface size is reported in ”application pixels”, not
-// ”device pixels” (see gdk_surface_get_scale_factor()).
+// ”device pixels” (see [Surface.GetScaleFactor]).
+//
+// Deprecated: use ConnectLayoutFunc, which also accepts method values and closures.
 func (x *Surface) ConnectLayout(cb *func(Surface, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "layout", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
+			fa := Surface{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidthVarp, HeightVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "layout", cbRefPtr, cbPtr)
+}
+
+var xSurfaceLayoutTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+func xSurfaceLayoutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidthVarp int, HeightVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Surface, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Surface{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidthVarp, HeightVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "layout", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @surface stops being present on the monitor.
+// ConnectLayoutFunc connects cb to the "layout" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Surface) ConnectLayoutFunc(cb func(Surface, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "layout", &xSurfaceLayoutTrampoline, xSurfaceLayoutNewTrampoline, cb)
+}
+
+// Emitted when surface stops being present on the monitor.
+//
+// Deprecated: use ConnectLeaveMonitorFunc, which also accepts method values and closures.
 func (x *Surface) ConnectLeaveMonitor(cb *func(Surface, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "leave-monitor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MonitorVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Surface{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MonitorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "leave-monitor", cbRefPtr, cbPtr)
+}
+
+var xSurfaceLeaveMonitorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MonitorVarp uintptr) {
+func xSurfaceLeaveMonitorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MonitorVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Surface, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Surface{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MonitorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "leave-monitor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLeaveMonitorFunc connects cb to the "leave-monitor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Surface) ConnectLeaveMonitorFunc(cb func(Surface, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "leave-monitor", &xSurfaceLeaveMonitorTrampoline, xSurfaceLeaveMonitorNewTrampoline, cb)
 }
 
 // Emitted when part of the surface needs to be redrawn.
+//
+// Deprecated: use ConnectRenderFunc, which also accepts method values and closures.
 func (x *Surface) ConnectRender(cb *func(Surface, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "render", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, RegionVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Surface{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, RegionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "render", cbRefPtr, cbPtr)
+}
+
+var xSurfaceRenderTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, RegionVarp uintptr) bool {
+func xSurfaceRenderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, RegionVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Surface, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Surface{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, RegionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "render", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRenderFunc connects cb to the "render" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Surface) ConnectRenderFunc(cb func(Surface, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "render", &xSurfaceRenderTrampoline, xSurfaceRenderNewTrampoline, cb)
 }
 
 func init() {
@@ -683,7 +712,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -692,33 +721,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xSurfaceGLibType, libs, "gdk_surface_get_type")
 
-	core.PuregoSafeRegister(&xNewSurfacePopup, libs, "gdk_surface_new_popup")
-	core.PuregoSafeRegister(&xNewSurfaceToplevel, libs, "gdk_surface_new_toplevel")
-
-	core.PuregoSafeRegister(&xSurfaceBeep, libs, "gdk_surface_beep")
-	core.PuregoSafeRegister(&xSurfaceCreateCairoContext, libs, "gdk_surface_create_cairo_context")
-	core.PuregoSafeRegister(&xSurfaceCreateGlContext, libs, "gdk_surface_create_gl_context")
-	core.PuregoSafeRegister(&xSurfaceCreateSimilarSurface, libs, "gdk_surface_create_similar_surface")
-	core.PuregoSafeRegister(&xSurfaceCreateVulkanContext, libs, "gdk_surface_create_vulkan_context")
-	core.PuregoSafeRegister(&xSurfaceDestroy, libs, "gdk_surface_destroy")
-	core.PuregoSafeRegister(&xSurfaceGetCursor, libs, "gdk_surface_get_cursor")
-	core.PuregoSafeRegister(&xSurfaceGetDeviceCursor, libs, "gdk_surface_get_device_cursor")
-	core.PuregoSafeRegister(&xSurfaceGetDevicePosition, libs, "gdk_surface_get_device_position")
-	core.PuregoSafeRegister(&xSurfaceGetDisplay, libs, "gdk_surface_get_display")
-	core.PuregoSafeRegister(&xSurfaceGetFrameClock, libs, "gdk_surface_get_frame_clock")
-	core.PuregoSafeRegister(&xSurfaceGetHeight, libs, "gdk_surface_get_height")
-	core.PuregoSafeRegister(&xSurfaceGetMapped, libs, "gdk_surface_get_mapped")
-	core.PuregoSafeRegister(&xSurfaceGetScale, libs, "gdk_surface_get_scale")
-	core.PuregoSafeRegister(&xSurfaceGetScaleFactor, libs, "gdk_surface_get_scale_factor")
-	core.PuregoSafeRegister(&xSurfaceGetWidth, libs, "gdk_surface_get_width")
-	core.PuregoSafeRegister(&xSurfaceHide, libs, "gdk_surface_hide")
-	core.PuregoSafeRegister(&xSurfaceIsDestroyed, libs, "gdk_surface_is_destroyed")
-	core.PuregoSafeRegister(&xSurfaceQueueRender, libs, "gdk_surface_queue_render")
-	core.PuregoSafeRegister(&xSurfaceRequestLayout, libs, "gdk_surface_request_layout")
-	core.PuregoSafeRegister(&xSurfaceSetCursor, libs, "gdk_surface_set_cursor")
-	core.PuregoSafeRegister(&xSurfaceSetDeviceCursor, libs, "gdk_surface_set_device_cursor")
-	core.PuregoSafeRegister(&xSurfaceSetInputRegion, libs, "gdk_surface_set_input_region")
-	core.PuregoSafeRegister(&xSurfaceSetOpaqueRegion, libs, "gdk_surface_set_opaque_region")
-	core.PuregoSafeRegister(&xSurfaceTranslateCoordinates, libs, "gdk_surface_translate_coordinates")
+	core.PuregoRegisterSince(&xNewSurfacePopup, libs, "gdk_surface_new_popup", "GDK", "")
+	core.PuregoRegisterSince(&xNewSurfaceToplevel, libs, "gdk_surface_new_toplevel", "GDK", "")
+
+	core.PuregoRegisterSince(&xSurfaceBeep, libs, "gdk_surface_beep", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceCreateGlContext, libs, "gdk_surface_create_gl_context", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceDestroy, libs, "gdk_surface_destroy", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetCursor, libs, "gdk_surface_get_cursor", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetDeviceCursor, libs, "gdk_surface_get_device_cursor", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetDevicePosition, libs, "gdk_surface_get_device_position", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetDisplay, libs, "gdk_surface_get_display", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetFrameClock, libs, "gdk_surface_get_frame_clock", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetHeight, libs, "gdk_surface_get_height", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetMapped, libs, "gdk_surface_get_mapped", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetScale, libs, "gdk_surface_get_scale", "GDK", "4.12")
+	core.PuregoRegisterSince(&xSurfaceGetScaleFactor, libs, "gdk_surface_get_scale_factor", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceGetWidth, libs, "gdk_surface_get_width", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceHide, libs, "gdk_surface_hide", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceIsDestroyed, libs, "gdk_surface_is_destroyed", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceQueueRender, libs, "gdk_surface_queue_render", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceRequestLayout, libs, "gdk_surface_request_layout", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceSetCursor, libs, "gdk_surface_set_cursor", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceSetDeviceCursor, libs, "gdk_surface_set_device_cursor", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceSetInputRegion, libs, "gdk_surface_set_input_region", "GDK", "")
+	core.PuregoRegisterSince(&xSurfaceTranslateCoordinates, libs, "gdk_surface_translate_coordinates", "GDK", "")
 
 }
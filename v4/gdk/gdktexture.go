@@ -2,12 +2,11 @@
 package gdk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
-	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -44,9 +43,74 @@ const (
 	TextureErrorUnsupportedFormatValue TextureError = 3
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (TextureError) ErrorDomain() string {
+	return "gdk-texture-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x TextureError) Error() string {
+	switch x {
+	case TextureErrorTooLargeValue:
+		return "GDK_TEXTURE_ERROR_TOO_LARGE"
+	case TextureErrorCorruptImageValue:
+		return "GDK_TEXTURE_ERROR_CORRUPT_IMAGE"
+	case TextureErrorUnsupportedContentValue:
+		return "GDK_TEXTURE_ERROR_UNSUPPORTED_CONTENT"
+	case TextureErrorUnsupportedFormatValue:
+		return "GDK_TEXTURE_ERROR_UNSUPPORTED_FORMAT"
+	}
+	return fmt.Sprintf("gdk-texture-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x TextureError) String() string {
+	switch x {
+	case TextureErrorTooLargeValue:
+		return "GDK_TEXTURE_ERROR_TOO_LARGE"
+	case TextureErrorCorruptImageValue:
+		return "GDK_TEXTURE_ERROR_CORRUPT_IMAGE"
+	case TextureErrorUnsupportedContentValue:
+		return "GDK_TEXTURE_ERROR_UNSUPPORTED_CONTENT"
+	case TextureErrorUnsupportedFormatValue:
+		return "GDK_TEXTURE_ERROR_UNSUPPORTED_FORMAT"
+	}
+	return fmt.Sprintf("TextureError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextureError) Nick() string {
+	switch x {
+	case TextureErrorTooLargeValue:
+		return "too-large"
+	case TextureErrorCorruptImageValue:
+		return "corrupt-image"
+	case TextureErrorUnsupportedContentValue:
+		return "unsupported-content"
+	case TextureErrorUnsupportedFormatValue:
+		return "unsupported-format"
+	}
+	return ""
+}
+
+// TextureErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextureErrorFromString(s string) (TextureError, bool) {
+	switch s {
+	case "GDK_TEXTURE_ERROR_TOO_LARGE", "too-large":
+		return TextureErrorTooLargeValue, true
+	case "GDK_TEXTURE_ERROR_CORRUPT_IMAGE", "corrupt-image":
+		return TextureErrorCorruptImageValue, true
+	case "GDK_TEXTURE_ERROR_UNSUPPORTED_CONTENT", "unsupported-content":
+		return TextureErrorUnsupportedContentValue, true
+	case "GDK_TEXTURE_ERROR_UNSUPPORTED_FORMAT", "unsupported-format":
+		return TextureErrorUnsupportedFormatValue, true
+	}
+	return 0, false
+}
+
 var xTextureErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gdk.Texture] errors.
+// Registers an error quark for [Texture] errors.
 func TextureErrorQuark() glib.Quark {
 
 	cret := xTextureErrorQuark()
@@ -59,26 +123,28 @@ func TextureErrorQuark() glib.Quark {
 // multiple frames, and will be used for a long time.
 //
 // There are various ways to create `GdkTexture` objects from a
-// [class@GdkPixbuf.Pixbuf], or from bytes stored in memory, a file, or a
-// [struct@Gio.Resource].
+// [gdkpixbuf.Pixbuf], or from bytes stored in memory, a file, or a
+// [gio.Resource].
 //
 // The ownership of the pixel data is transferred to the `GdkTexture`
-// instance; you can only make a copy of it, via [method@Gdk.Texture.download].
+// instance; you can only make a copy of it, via [Texture.Download].
 //
 // `GdkTexture` is an immutable object: That means you cannot change
 // anything about it other than increasing the reference count via
-// [method@GObject.Object.ref], and consequently, it is a threadsafe object.
+// [gobject.Object.Ref], and consequently, it is a threadsafe object.
 //
 // GDK provides a number of threadsafe texture loading functions:
-// [ctor@Gdk.Texture.new_from_resource],
-// [ctor@Gdk.Texture.new_from_bytes],
-// [ctor@Gdk.Texture.new_from_file],
-// [ctor@Gdk.Texture.new_from_filename],
-// [ctor@Gdk.Texture.new_for_pixbuf]. Note that these are meant for loading
+// [NewTextureFromResource],
+// [NewTextureFromBytes],
+// [NewTextureFromFile],
+// [NewTextureFromFilename],
+// [NewTextureForPixbuf]. Note that these are meant for loading
 // icons and resources that are shipped with the toolkit or application. It
 // is recommended that you use a dedicated image loading framework such as
-// [glycin](https://lib.rs/crates/glycin), if you need to load untrusted image
+// [glycin], if you need to load untrusted image
 // data.
+//
+// [glycin]: https://lib.rs/crates/glycin
 type Texture struct {
 	gobject.Object
 }
@@ -95,26 +161,6 @@ func TextureNewFromInternalPtr(ptr uintptr) *Texture {
 	return cls
 }
 
-var xNewTextureForPixbuf func(uintptr) uintptr
-
-// Creates a new texture object representing the `GdkPixbuf`.
-//
-// This function is threadsafe, so that you can e.g. use GTask
-// and [method@Gio.Task.run_in_thread] to avoid blocking the main
-// thread while loading a big image.
-func NewTextureForPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Texture {
-	var cls *Texture
-
-	cret := xNewTextureForPixbuf(PixbufVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Texture{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xNewTextureFromBytes func(*glib.Bytes, **glib.Error) uintptr
 
 // Creates a new texture by loading an image from memory,
@@ -122,10 +168,10 @@ var xNewTextureFromBytes func(*glib.Bytes, **glib.Error) uintptr
 // The file format is detected automatically. The supported formats
 // are PNG, JPEG and TIFF, though more formats might be available.
 //
-// If `NULL` is returned, then @error will be set.
+// If `NULL` is returned, then error will be set.
 //
 // This function is threadsafe, so that you can e.g. use GTask
-// and [method@Gio.Task.run_in_thread] to avoid blocking the main thread
+// and [gio.Task.RunInThread] to avoid blocking the main thread
 // while loading a big image.
 //
 // ::: warning
@@ -133,19 +179,25 @@ var xNewTextureFromBytes func(*glib.Bytes, **glib.Error) uintptr
 //	Note that this function should not be used with untrusted data.
 //	Use a proper image loading framework such as libglycin, which can
 //	load many image formats into a `GdkTexture`.
-func NewTextureFromBytes(BytesVar *glib.Bytes) (*Texture, error) {
+func NewTextureFromBytes(BytesVar []byte) (*Texture, error) {
 	var cls *Texture
 	var cerr *glib.Error
 
-	cret := xNewTextureFromBytes(BytesVar, &cerr)
+	BytesVarBytes := glib.NewBytesFromSlice(BytesVar)
+	defer BytesVarBytes.Unref()
+
+	cret := xNewTextureFromBytes(BytesVarBytes, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Texture{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -158,10 +210,10 @@ var xNewTextureFromFile func(uintptr, **glib.Error) uintptr
 // The file format is detected automatically. The supported formats
 // are PNG, JPEG and TIFF, though more formats might be available.
 //
-// If `NULL` is returned, then @error will be set.
+// If `NULL` is returned, then error will be set.
 //
 // This function is threadsafe, so that you can e.g. use GTask
-// and [method@Gio.Task.run_in_thread] to avoid blocking the main thread
+// and [gio.Task.RunInThread] to avoid blocking the main thread
 // while loading a big image.
 //
 // ::: warning
@@ -176,12 +228,15 @@ func NewTextureFromFile(FileVar gio.File) (*Texture, error) {
 	cret := xNewTextureFromFile(FileVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Texture{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -194,10 +249,10 @@ var xNewTextureFromFilename func(string, **glib.Error) uintptr
 // The file format is detected automatically. The supported formats
 // are PNG, JPEG and TIFF, though more formats might be available.
 //
-// If `NULL` is returned, then @error will be set.
+// If `NULL` is returned, then error will be set.
 //
 // This function is threadsafe, so that you can e.g. use GTask
-// and [method@Gio.Task.run_in_thread] to avoid blocking the main thread
+// and [gio.Task.RunInThread] to avoid blocking the main thread
 // while loading a big image.
 //
 // ::: warning
@@ -212,12 +267,15 @@ func NewTextureFromFilename(PathVar string) (*Texture, error) {
 	cret := xNewTextureFromFilename(PathVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Texture{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -230,13 +288,13 @@ var xNewTextureFromResource func(string) uintptr
 // The file format is detected automatically. The supported formats
 // are PNG, JPEG and TIFF, though more formats might be available.
 //
-// It is a fatal error if @resource_path does not specify a valid
+// It is a fatal error if resource_path does not specify a valid
 // image resource and the program will abort if that happens.
 // If you are unsure about the validity of a resource, use
-// [ctor@Gdk.Texture.new_from_file] to load it.
+// [NewTextureFromFile] to load it.
 //
 // This function is threadsafe, so that you can e.g. use GTask
-// and [method@Gio.Task.run_in_thread] to avoid blocking the main thread
+// and [gio.Task.RunInThread] to avoid blocking the main thread
 // while loading a big image.
 func NewTextureFromResource(ResourcePathVar string) *Texture {
 	var cls *Texture
@@ -253,32 +311,27 @@ func NewTextureFromResource(ResourcePathVar string) *Texture {
 
 var xTextureDownload func(uintptr, []byte, uint)
 
-// Downloads the @texture into local memory.
+// Downloads the texture into local memory.
 //
 // This may be an expensive operation, as the actual texture data
 // may reside on a GPU or on a remote display server.
 //
 // The data format of the downloaded data is equivalent to
-// %CAIRO_FORMAT_ARGB32, so every downloaded pixel requires
+// [cairo.FormatArgb32Value], so every downloaded pixel requires
 // 4 bytes of memory.
 //
 // Downloading a texture into a Cairo image surface:
-// ```c
-// surface = cairo_image_surface_create (CAIRO_FORMAT_ARGB32,
 //
-//	gdk_texture_get_width (texture),
-//	gdk_texture_get_height (texture));
-//
-// gdk_texture_download (texture,
-//
-//	cairo_image_surface_get_data (surface),
-//	cairo_image_surface_get_stride (surface));
-//
-// cairo_surface_mark_dirty (surface);
-// ```
+//	surface = cairo_image_surface_create (CAIRO_FORMAT_ARGB32,
+//	                                      gdk_texture_get_width (texture),
+//	                                      gdk_texture_get_height (texture));
+//	gdk_texture_download (texture,
+//	                      cairo_image_surface_get_data (surface),
+//	                      cairo_image_surface_get_stride (surface));
+//	cairo_surface_mark_dirty (surface);
 //
 // For more flexible download capabilities, see
-// [struct@Gdk.TextureDownloader].
+// [TextureDownloader].
 func (x *Texture) Download(DataVar []byte, StrideVar uint) {
 
 	xTextureDownload(x.GoPointer(), DataVar, StrideVar)
@@ -313,7 +366,7 @@ func (x *Texture) GetFormat() MemoryFormat {
 
 var xTextureGetHeight func(uintptr) int
 
-// Returns the height of the @texture, in pixels.
+// Returns the height of the texture, in pixels.
 func (x *Texture) GetHeight() int {
 
 	cret := xTextureGetHeight(x.GoPointer())
@@ -322,7 +375,7 @@ func (x *Texture) GetHeight() int {
 
 var xTextureGetWidth func(uintptr) int
 
-// Returns the width of @texture, in pixels.
+// Returns the width of texture, in pixels.
 func (x *Texture) GetWidth() int {
 
 	cret := xTextureGetWidth(x.GoPointer())
@@ -331,12 +384,12 @@ func (x *Texture) GetWidth() int {
 
 var xTextureSaveToPng func(uintptr, string) bool
 
-// Store the given @texture to the @filename as a PNG file.
+// Store the given texture to the filename as a PNG file.
 //
 // This is a utility function intended for debugging and testing.
 // If you want more control over formats, proper error handling or
-// want to store to a [iface@Gio.File] or other location, you might
-// want to use [method@Gdk.Texture.save_to_png_bytes] or look into
+// want to store to a [gio.File] or other location, you might
+// want to use [Texture.SaveToPngBytes] or look into
 // the libglycin library.
 func (x *Texture) SaveToPng(FilenameVar string) bool {
 
@@ -346,9 +399,9 @@ func (x *Texture) SaveToPng(FilenameVar string) bool {
 
 var xTextureSaveToPngBytes func(uintptr) *glib.Bytes
 
-// Store the given @texture in memory as a PNG file.
+// Store the given texture in memory as a PNG file.
 //
-// Use [ctor@Gdk.Texture.new_from_bytes] to read it back.
+// Use [NewTextureFromBytes] to read it back.
 //
 // If you want to serialize a texture, this is a convenient and
 // portable way to do that.
@@ -358,17 +411,18 @@ var xTextureSaveToPngBytes func(uintptr) *glib.Bytes
 // library such as the libglycin library.
 //
 // If you are dealing with high dynamic range float data, you
-// might also want to consider [method@Gdk.Texture.save_to_tiff_bytes]
+// might also want to consider [Texture.SaveToTiffBytes]
 // instead.
-func (x *Texture) SaveToPngBytes() *glib.Bytes {
+func (x *Texture) SaveToPngBytes() []byte {
 
 	cret := xTextureSaveToPngBytes(x.GoPointer())
-	return cret
+	defer cret.Unref()
+	return cret.Data()
 }
 
 var xTextureSaveToTiff func(uintptr, string) bool
 
-// Store the given @texture to the @filename as a TIFF file.
+// Store the given texture to the filename as a TIFF file.
 //
 // GTK will attempt to store data without loss.
 func (x *Texture) SaveToTiff(FilenameVar string) bool {
@@ -379,9 +433,9 @@ func (x *Texture) SaveToTiff(FilenameVar string) bool {
 
 var xTextureSaveToTiffBytes func(uintptr) *glib.Bytes
 
-// Store the given @texture in memory as a TIFF file.
+// Store the given texture in memory as a TIFF file.
 //
-// Use [ctor@Gdk.Texture.new_from_bytes] to read it back.
+// Use [NewTextureFromBytes] to read it back.
 //
 // This function is intended to store a representation of the
 // texture's data that is as accurate as possible. This is
@@ -390,11 +444,12 @@ var xTextureSaveToTiffBytes func(uintptr) *glib.Bytes
 //
 // If that is not your concern and you are interested in a
 // smaller size and a more portable format, you might want to
-// use [method@Gdk.Texture.save_to_png_bytes].
-func (x *Texture) SaveToTiffBytes() *glib.Bytes {
+// use [Texture.SaveToPngBytes].
+func (x *Texture) SaveToTiffBytes() []byte {
 
 	cret := xTextureSaveToTiffBytes(x.GoPointer())
-	return cret
+	defer cret.Unref()
+	return cret.Data()
 }
 
 func (c *Texture) GoPointer() uintptr {
@@ -462,25 +517,27 @@ func (x *Texture) GetPropertyWidth() int {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *Texture) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *Texture) GetCurrentImage() *PaintableBase {
 	var cls *PaintableBase
 
@@ -498,29 +555,29 @@ func (x *Texture) GetCurrentImage() *PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [PaintableFlags] for the flags and what they mean.
 func (x *Texture) GetFlags() PaintableFlags {
 
 	cret := XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [Paintable.GetIntrinsicWidth] and
+// [Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *Texture) GetIntrinsicAspectRatio() float64 {
 
@@ -528,15 +585,15 @@ func (x *Texture) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *Texture) GetIntrinsicHeight() int {
 
@@ -544,15 +601,15 @@ func (x *Texture) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *Texture) GetIntrinsicWidth() int {
 
@@ -563,12 +620,12 @@ func (x *Texture) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *Texture) InvalidateContents() {
 
@@ -578,13 +635,13 @@ func (x *Texture) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *Texture) InvalidateSize() {
 
@@ -592,10 +649,10 @@ func (x *Texture) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *Texture) Snapshot(SnapshotVar *Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -617,10 +674,10 @@ func (x *Texture) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [gio.Icon] into a [glib.Variant]. An equivalent [gio.Icon] can be retrieved
+// back by calling [gio.IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *Texture) Serialize() *glib.Variant {
 
@@ -628,21 +685,21 @@ func (x *Texture) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [gio.IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [gio.Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [gio.FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [gio.File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [gio.File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [gio.ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *Texture) ToString() string {
 
@@ -651,7 +708,7 @@ func (x *Texture) ToString() string {
 }
 
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [gio.LoadableIcon.LoadAsync].
 func (x *Texture) Load(SizeVar int, TypeVar *string, CancellableVar *gio.Cancellable) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -659,27 +716,30 @@ func (x *Texture) Load(SizeVar int, TypeVar *string, CancellableVar *gio.Cancell
 	cret := gio.XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [gio.LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [gio.LoadableIcon.Load].
 func (x *Texture) LoadAsync(SizeVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	gio.XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [gio.LoadableIcon.LoadAsync].
 func (x *Texture) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -687,12 +747,15 @@ func (x *Texture) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gio.Inpu
 	cret := gio.XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -703,7 +766,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -712,24 +775,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextureErrorGLibType, libs, "gdk_texture_error_get_type")
 
-	core.PuregoSafeRegister(&xTextureErrorQuark, libs, "gdk_texture_error_quark")
+	core.PuregoRegisterSince(&xTextureErrorQuark, libs, "gdk_texture_error_quark", "GDK", "")
 
 	core.PuregoSafeRegister(&xTextureGLibType, libs, "gdk_texture_get_type")
 
-	core.PuregoSafeRegister(&xNewTextureForPixbuf, libs, "gdk_texture_new_for_pixbuf")
-	core.PuregoSafeRegister(&xNewTextureFromBytes, libs, "gdk_texture_new_from_bytes")
-	core.PuregoSafeRegister(&xNewTextureFromFile, libs, "gdk_texture_new_from_file")
-	core.PuregoSafeRegister(&xNewTextureFromFilename, libs, "gdk_texture_new_from_filename")
-	core.PuregoSafeRegister(&xNewTextureFromResource, libs, "gdk_texture_new_from_resource")
-
-	core.PuregoSafeRegister(&xTextureDownload, libs, "gdk_texture_download")
-	core.PuregoSafeRegister(&xTextureGetColorState, libs, "gdk_texture_get_color_state")
-	core.PuregoSafeRegister(&xTextureGetFormat, libs, "gdk_texture_get_format")
-	core.PuregoSafeRegister(&xTextureGetHeight, libs, "gdk_texture_get_height")
-	core.PuregoSafeRegister(&xTextureGetWidth, libs, "gdk_texture_get_width")
-	core.PuregoSafeRegister(&xTextureSaveToPng, libs, "gdk_texture_save_to_png")
-	core.PuregoSafeRegister(&xTextureSaveToPngBytes, libs, "gdk_texture_save_to_png_bytes")
-	core.PuregoSafeRegister(&xTextureSaveToTiff, libs, "gdk_texture_save_to_tiff")
-	core.PuregoSafeRegister(&xTextureSaveToTiffBytes, libs, "gdk_texture_save_to_tiff_bytes")
+	core.PuregoRegisterSince(&xNewTextureFromBytes, libs, "gdk_texture_new_from_bytes", "GDK", "4.6")
+	core.PuregoRegisterSince(&xNewTextureFromFile, libs, "gdk_texture_new_from_file", "GDK", "")
+	core.PuregoRegisterSince(&xNewTextureFromFilename, libs, "gdk_texture_new_from_filename", "GDK", "4.6")
+	core.PuregoRegisterSince(&xNewTextureFromResource, libs, "gdk_texture_new_from_resource", "GDK", "")
+
+	core.PuregoRegisterSince(&xTextureDownload, libs, "gdk_texture_download", "GDK", "")
+	core.PuregoRegisterSince(&xTextureGetColorState, libs, "gdk_texture_get_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xTextureGetFormat, libs, "gdk_texture_get_format", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureGetHeight, libs, "gdk_texture_get_height", "GDK", "")
+	core.PuregoRegisterSince(&xTextureGetWidth, libs, "gdk_texture_get_width", "GDK", "")
+	core.PuregoRegisterSince(&xTextureSaveToPng, libs, "gdk_texture_save_to_png", "GDK", "")
+	core.PuregoRegisterSince(&xTextureSaveToPngBytes, libs, "gdk_texture_save_to_png_bytes", "GDK", "4.6")
+	core.PuregoRegisterSince(&xTextureSaveToTiff, libs, "gdk_texture_save_to_tiff", "GDK", "4.6")
+	core.PuregoRegisterSince(&xTextureSaveToTiffBytes, libs, "gdk_texture_save_to_tiff_bytes", "GDK", "4.6")
 
 }
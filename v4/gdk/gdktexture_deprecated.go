@@ -0,0 +1,49 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+)
+
+var xNewTextureForPixbuf func(uintptr) uintptr
+
+// Creates a new texture object representing the `GdkPixbuf`.
+//
+// This function is threadsafe, so that you can e.g. use GTask
+// and [gio.Task.RunInThread] to avoid blocking the main
+// thread while loading a big image.
+//
+// Deprecated: since 4.20. Use e.g. libglycin, which can load many image
+//
+//	formats into a `GdkTexture`
+func NewTextureForPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Texture {
+	var cls *Texture
+
+	cret := xNewTextureForPixbuf(PixbufVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Texture{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GDK", "gtk4")
+	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewTextureForPixbuf, libs, "gdk_texture_new_for_pixbuf", "GDK", "")
+
+}
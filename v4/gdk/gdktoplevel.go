@@ -2,10 +2,11 @@
 package gdk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -91,10 +92,10 @@ func (x *ToplevelBase) BeginResize(EdgeVar SurfaceEdge, DeviceVar *Device, Butto
 
 }
 
-// Sets keyboard focus to @surface.
+// Sets keyboard focus to surface.
 //
-// In most cases, [gtk_window_present_with_time()](../gtk4/method.Window.present_with_time.html)
-// should be used on a [GtkWindow](../gtk4/class.Window.html), rather than
+// In most cases, [[gtk.Window.PresentWithTime]](../gtk4/method.Window.present_with_time.html)
+// should be used on a GtkWindow, rather than
 // calling this function.
 func (x *ToplevelBase) Focus(TimestampVar uint32) {
 
@@ -125,14 +126,14 @@ func (x *ToplevelBase) GetState() ToplevelState {
 	return cret
 }
 
-// Requests that the @toplevel inhibit the system shortcuts.
+// Requests that the toplevel inhibit the system shortcuts.
 //
 // This is asking the desktop environment/windowing system to let all
 // keyboard events reach the surface, as long as it is focused, instead
 // of triggering system actions.
 //
 // If granted, the rerouting remains active until the default shortcuts
-// processing is restored with [method@Gdk.Toplevel.restore_system_shortcuts],
+// processing is restored with [Toplevel.RestoreSystemShortcuts],
 // or the request is revoked by the desktop environment, windowing system
 // or the user.
 //
@@ -145,14 +146,14 @@ func (x *ToplevelBase) GetState() ToplevelState {
 // or deny the request or even choose to ignore the request entirely.
 //
 // The caller can be notified whenever the request is granted or revoked
-// by listening to the [property@Gdk.Toplevel:shortcuts-inhibited] property.
+// by listening to the [ToplevelBase.GetPropertyShortcutsInhibited] property.
 func (x *ToplevelBase) InhibitSystemShortcuts(EventVar *Event) {
 
 	XGdkToplevelInhibitSystemShortcuts(x.GoPointer(), EventVar.GoPointer())
 
 }
 
-// Asks to lower the @toplevel below other windows.
+// Asks to lower the toplevel below other windows.
 //
 // The windowing system may choose to ignore the request.
 func (x *ToplevelBase) Lower() bool {
@@ -161,7 +162,7 @@ func (x *ToplevelBase) Lower() bool {
 	return cret
 }
 
-// Asks to minimize the @toplevel.
+// Asks to minimize the toplevel.
 //
 // The windowing system may choose to ignore the request.
 func (x *ToplevelBase) Minimize() bool {
@@ -170,12 +171,12 @@ func (x *ToplevelBase) Minimize() bool {
 	return cret
 }
 
-// Present @toplevel after having processed the `GdkToplevelLayout` rules.
+// Present toplevel after having processed the `GdkToplevelLayout` rules.
 //
 // If the toplevel was previously not showing, it will be showed,
-// otherwise it will change layout according to @layout.
+// otherwise it will change layout according to layout.
 //
-// GDK may emit the [signal@Gdk.Toplevel::compute-size] signal to let
+// GDK may emit the Toplevel::compute-size signal to let
 // the user of this toplevel compute the preferred size of the toplevel
 // surface.
 //
@@ -190,7 +191,7 @@ func (x *ToplevelBase) Present(LayoutVar *ToplevelLayout) {
 // Restore default system keyboard shortcuts which were previously
 // inhibited.
 //
-// This undoes the effect of [method@Gdk.Toplevel.inhibit_system_shortcuts].
+// This undoes the effect of [Toplevel.InhibitSystemShortcuts].
 func (x *ToplevelBase) RestoreSystemShortcuts() {
 
 	XGdkToplevelRestoreSystemShortcuts(x.GoPointer())
@@ -199,7 +200,7 @@ func (x *ToplevelBase) RestoreSystemShortcuts() {
 
 // Sets the toplevel to be decorated.
 //
-// Setting @decorated to %FALSE hints the desktop environment
+// Setting decorated to false hints the desktop environment
 // that the surface has its own, client-side decorations and
 // does not need to have window decorations added.
 func (x *ToplevelBase) SetDecorated(DecoratedVar bool) {
@@ -210,7 +211,7 @@ func (x *ToplevelBase) SetDecorated(DecoratedVar bool) {
 
 // Sets the toplevel to be deletable.
 //
-// Setting @deletable to %TRUE hints the desktop environment
+// Setting deletable to true hints the desktop environment
 // that it should offer the user a way to close the surface.
 func (x *ToplevelBase) SetDeletable(DeletableVar bool) {
 
@@ -249,7 +250,7 @@ func (x *ToplevelBase) SetIconList(SurfacesVar *glib.List) {
 // to handle modal surfaces in a special way.
 //
 // You should only use this on surfaces for which you have
-// previously called [method@Gdk.Toplevel.set_transient_for].
+// previously called [Toplevel.SetTransientFor].
 func (x *ToplevelBase) SetModal(ModalVar bool) {
 
 	XGdkToplevelSetModal(x.GoPointer(), ModalVar)
@@ -259,7 +260,7 @@ func (x *ToplevelBase) SetModal(ModalVar bool) {
 // Sets the startup notification ID.
 //
 // When using GTK, typically you should use
-// [gtk_window_set_startup_id()](../gtk4/method.Window.set_startup_id.html)
+// [[gtk.Window.SetStartupId]](../gtk4/method.Window.set_startup_id.html)
 // instead of this low-level function.
 func (x *ToplevelBase) SetStartupId(StartupIdVar string) {
 
@@ -279,13 +280,13 @@ func (x *ToplevelBase) SetTitle(TitleVar string) {
 
 // Sets a transient-for parent.
 //
-// Indicates to the window manager that @surface is a transient
-// dialog associated with the application surface @parent. This
-// allows the window manager to do things like center @surface
-// on @parent and keep @surface above @parent.
+// Indicates to the window manager that surface is a transient
+// dialog associated with the application surface parent. This
+// allows the window manager to do things like center surface
+// on parent and keep surface above parent.
 //
-// See [gtk_window_set_transient_for()](../gtk4/method.Window.set_transient_for.html)
-// if you’re using [GtkWindow](../gtk4/class.Window.html).
+// See [[gtk.Window.SetTransientFor]](../gtk4/method.Window.set_transient_for.html)
+// if you’re using GtkWindow.
 func (x *ToplevelBase) SetTransientFor(ParentVar *Surface) {
 
 	XGdkToplevelSetTransientFor(x.GoPointer(), ParentVar.GoPointer())
@@ -416,7 +417,7 @@ func (x *ToplevelBase) GetPropertyShortcutsInhibited() bool {
 // SetPropertyStartupId sets the "startup-id" property.
 // The startup ID of the surface.
 //
-// See [class@Gdk.AppLaunchContext] for more information about
+// See [AppLaunchContext] for more information about
 // startup feedback.
 func (x *ToplevelBase) SetPropertyStartupId(value string) {
 	obj := gobject.Object{}
@@ -430,7 +431,7 @@ func (x *ToplevelBase) SetPropertyStartupId(value string) {
 // GetPropertyStartupId gets the "startup-id" property.
 // The startup ID of the surface.
 //
-// See [class@Gdk.AppLaunchContext] for more information about
+// See [AppLaunchContext] for more information about
 // startup feedback.
 func (x *ToplevelBase) GetPropertyStartupId() string {
 	obj := gobject.Object{}
@@ -485,7 +486,7 @@ var XGdkToplevelSupportsEdgeConstraints func(uintptr) bool
 var XGdkToplevelTitlebarGesture func(uintptr, TitlebarGesture) bool
 
 // Reflects what features a `GdkToplevel` supports.
-type ToplevelCapabilities int
+type ToplevelCapabilities uint32
 
 var xToplevelCapabilitiesGLibType func() types.GType
 
@@ -498,34 +499,99 @@ const (
 	// Whether tiled window states are supported.
 	ToplevelCapabilitiesEdgeConstraintsValue ToplevelCapabilities = 1
 	// Whether inhibiting system shortcuts is supported.
-	// See [method@Gdk.Toplevel.inhibit_system_shortcuts].
+	// See [Toplevel.InhibitSystemShortcuts].
 	ToplevelCapabilitiesInhibitShortcutsValue ToplevelCapabilities = 2
 	// Whether titlebar gestures are supported.
-	// See [method@Gdk.Toplevel.titlebar_gesture].
+	// See [Toplevel.TitlebarGesture].
 	ToplevelCapabilitiesTitlebarGesturesValue ToplevelCapabilities = 4
 	// Whether showing the window menu is supported.
-	// See [method@Gdk.Toplevel.show_window_menu].
+	// See [Toplevel.ShowWindowMenu].
 	ToplevelCapabilitiesWindowMenuValue ToplevelCapabilities = 8
 	// Whether the toplevel can be maximized.
 	ToplevelCapabilitiesMaximizeValue ToplevelCapabilities = 16
 	// Whether the toplevel can be made fullscreen.
 	ToplevelCapabilitiesFullscreenValue ToplevelCapabilities = 32
 	// Whether the toplevel can be minimized.
-	// See [method@Gdk.Toplevel.minimize].
+	// See [Toplevel.Minimize].
 	ToplevelCapabilitiesMinimizeValue ToplevelCapabilities = 64
 	// Whether the toplevel can be lowered.
-	// See [method@Gdk.Toplevel.lower].
+	// See [Toplevel.Lower].
 	ToplevelCapabilitiesLowerValue ToplevelCapabilities = 128
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ToplevelCapabilities) GLibType() types.GType {
+	return xToplevelCapabilitiesGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ToplevelCapabilities) Has(flags ToplevelCapabilities) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ToplevelCapabilities) Set(flags ToplevelCapabilities) ToplevelCapabilities {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ToplevelCapabilities) Clear(flags ToplevelCapabilities) ToplevelCapabilities {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ToplevelCapabilities) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&ToplevelCapabilitiesEdgeConstraintsValue != 0 && x&ToplevelCapabilitiesEdgeConstraintsValue == ToplevelCapabilitiesEdgeConstraintsValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_EDGE_CONSTRAINTS")
+		rest &^= ToplevelCapabilitiesEdgeConstraintsValue
+	}
+	if rest&ToplevelCapabilitiesInhibitShortcutsValue != 0 && x&ToplevelCapabilitiesInhibitShortcutsValue == ToplevelCapabilitiesInhibitShortcutsValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_INHIBIT_SHORTCUTS")
+		rest &^= ToplevelCapabilitiesInhibitShortcutsValue
+	}
+	if rest&ToplevelCapabilitiesTitlebarGesturesValue != 0 && x&ToplevelCapabilitiesTitlebarGesturesValue == ToplevelCapabilitiesTitlebarGesturesValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_TITLEBAR_GESTURES")
+		rest &^= ToplevelCapabilitiesTitlebarGesturesValue
+	}
+	if rest&ToplevelCapabilitiesWindowMenuValue != 0 && x&ToplevelCapabilitiesWindowMenuValue == ToplevelCapabilitiesWindowMenuValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_WINDOW_MENU")
+		rest &^= ToplevelCapabilitiesWindowMenuValue
+	}
+	if rest&ToplevelCapabilitiesMaximizeValue != 0 && x&ToplevelCapabilitiesMaximizeValue == ToplevelCapabilitiesMaximizeValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_MAXIMIZE")
+		rest &^= ToplevelCapabilitiesMaximizeValue
+	}
+	if rest&ToplevelCapabilitiesFullscreenValue != 0 && x&ToplevelCapabilitiesFullscreenValue == ToplevelCapabilitiesFullscreenValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_FULLSCREEN")
+		rest &^= ToplevelCapabilitiesFullscreenValue
+	}
+	if rest&ToplevelCapabilitiesMinimizeValue != 0 && x&ToplevelCapabilitiesMinimizeValue == ToplevelCapabilitiesMinimizeValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_MINIMIZE")
+		rest &^= ToplevelCapabilitiesMinimizeValue
+	}
+	if rest&ToplevelCapabilitiesLowerValue != 0 && x&ToplevelCapabilitiesLowerValue == ToplevelCapabilitiesLowerValue {
+		names = append(names, "GDK_TOPLEVEL_CAPABILITIES_LOWER")
+		rest &^= ToplevelCapabilitiesLowerValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Specifies the state of a toplevel surface.
 //
 // On platforms that support information about individual edges, the
-// %GDK_TOPLEVEL_STATE_TILED state will be set whenever any of the individual
+// [ToplevelStateTiledValue] state will be set whenever any of the individual
 // tiled states is set. On platforms that lack that support, the tiled state
 // will give an indication of tiledness without any of the per-edge states
 // being set.
-type ToplevelState int
+type ToplevelState uint32
 
 var xToplevelStateGLibType func() types.GType
 
@@ -571,6 +637,107 @@ const (
 	ToplevelStateSuspendedValue ToplevelState = 65536
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ToplevelState) GLibType() types.GType {
+	return xToplevelStateGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ToplevelState) Has(flags ToplevelState) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ToplevelState) Set(flags ToplevelState) ToplevelState {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ToplevelState) Clear(flags ToplevelState) ToplevelState {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ToplevelState) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&ToplevelStateMinimizedValue != 0 && x&ToplevelStateMinimizedValue == ToplevelStateMinimizedValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_MINIMIZED")
+		rest &^= ToplevelStateMinimizedValue
+	}
+	if rest&ToplevelStateMaximizedValue != 0 && x&ToplevelStateMaximizedValue == ToplevelStateMaximizedValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_MAXIMIZED")
+		rest &^= ToplevelStateMaximizedValue
+	}
+	if rest&ToplevelStateStickyValue != 0 && x&ToplevelStateStickyValue == ToplevelStateStickyValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_STICKY")
+		rest &^= ToplevelStateStickyValue
+	}
+	if rest&ToplevelStateFullscreenValue != 0 && x&ToplevelStateFullscreenValue == ToplevelStateFullscreenValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_FULLSCREEN")
+		rest &^= ToplevelStateFullscreenValue
+	}
+	if rest&ToplevelStateAboveValue != 0 && x&ToplevelStateAboveValue == ToplevelStateAboveValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_ABOVE")
+		rest &^= ToplevelStateAboveValue
+	}
+	if rest&ToplevelStateBelowValue != 0 && x&ToplevelStateBelowValue == ToplevelStateBelowValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_BELOW")
+		rest &^= ToplevelStateBelowValue
+	}
+	if rest&ToplevelStateFocusedValue != 0 && x&ToplevelStateFocusedValue == ToplevelStateFocusedValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_FOCUSED")
+		rest &^= ToplevelStateFocusedValue
+	}
+	if rest&ToplevelStateTiledValue != 0 && x&ToplevelStateTiledValue == ToplevelStateTiledValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_TILED")
+		rest &^= ToplevelStateTiledValue
+	}
+	if rest&ToplevelStateTopTiledValue != 0 && x&ToplevelStateTopTiledValue == ToplevelStateTopTiledValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_TOP_TILED")
+		rest &^= ToplevelStateTopTiledValue
+	}
+	if rest&ToplevelStateTopResizableValue != 0 && x&ToplevelStateTopResizableValue == ToplevelStateTopResizableValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_TOP_RESIZABLE")
+		rest &^= ToplevelStateTopResizableValue
+	}
+	if rest&ToplevelStateRightTiledValue != 0 && x&ToplevelStateRightTiledValue == ToplevelStateRightTiledValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_RIGHT_TILED")
+		rest &^= ToplevelStateRightTiledValue
+	}
+	if rest&ToplevelStateRightResizableValue != 0 && x&ToplevelStateRightResizableValue == ToplevelStateRightResizableValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_RIGHT_RESIZABLE")
+		rest &^= ToplevelStateRightResizableValue
+	}
+	if rest&ToplevelStateBottomTiledValue != 0 && x&ToplevelStateBottomTiledValue == ToplevelStateBottomTiledValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_BOTTOM_TILED")
+		rest &^= ToplevelStateBottomTiledValue
+	}
+	if rest&ToplevelStateBottomResizableValue != 0 && x&ToplevelStateBottomResizableValue == ToplevelStateBottomResizableValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_BOTTOM_RESIZABLE")
+		rest &^= ToplevelStateBottomResizableValue
+	}
+	if rest&ToplevelStateLeftTiledValue != 0 && x&ToplevelStateLeftTiledValue == ToplevelStateLeftTiledValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_LEFT_TILED")
+		rest &^= ToplevelStateLeftTiledValue
+	}
+	if rest&ToplevelStateLeftResizableValue != 0 && x&ToplevelStateLeftResizableValue == ToplevelStateLeftResizableValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_LEFT_RESIZABLE")
+		rest &^= ToplevelStateLeftResizableValue
+	}
+	if rest&ToplevelStateSuspendedValue != 0 && x&ToplevelStateSuspendedValue == ToplevelStateSuspendedValue {
+		names = append(names, "GDK_TOPLEVEL_STATE_SUSPENDED")
+		rest &^= ToplevelStateSuspendedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Indicates which monitor a surface should span over when in fullscreen mode.
 type FullscreenMode int
 
@@ -588,6 +755,39 @@ const (
 	FullscreenOnAllMonitorsValue FullscreenMode = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FullscreenMode) String() string {
+	switch x {
+	case FullscreenOnCurrentMonitorValue:
+		return "GDK_FULLSCREEN_ON_CURRENT_MONITOR"
+	case FullscreenOnAllMonitorsValue:
+		return "GDK_FULLSCREEN_ON_ALL_MONITORS"
+	}
+	return fmt.Sprintf("FullscreenMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FullscreenMode) Nick() string {
+	switch x {
+	case FullscreenOnCurrentMonitorValue:
+		return "current-monitor"
+	case FullscreenOnAllMonitorsValue:
+		return "all-monitors"
+	}
+	return ""
+}
+
+// FullscreenModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FullscreenModeFromString(s string) (FullscreenMode, bool) {
+	switch s {
+	case "GDK_FULLSCREEN_ON_CURRENT_MONITOR", "current-monitor":
+		return FullscreenOnCurrentMonitorValue, true
+	case "GDK_FULLSCREEN_ON_ALL_MONITORS", "all-monitors":
+		return FullscreenOnAllMonitorsValue, true
+	}
+	return 0, false
+}
+
 // Determines a surface edge or corner.
 type SurfaceEdge int
 
@@ -617,8 +817,77 @@ const (
 	SurfaceEdgeSouthEastValue SurfaceEdge = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SurfaceEdge) String() string {
+	switch x {
+	case SurfaceEdgeNorthWestValue:
+		return "GDK_SURFACE_EDGE_NORTH_WEST"
+	case SurfaceEdgeNorthValue:
+		return "GDK_SURFACE_EDGE_NORTH"
+	case SurfaceEdgeNorthEastValue:
+		return "GDK_SURFACE_EDGE_NORTH_EAST"
+	case SurfaceEdgeWestValue:
+		return "GDK_SURFACE_EDGE_WEST"
+	case SurfaceEdgeEastValue:
+		return "GDK_SURFACE_EDGE_EAST"
+	case SurfaceEdgeSouthWestValue:
+		return "GDK_SURFACE_EDGE_SOUTH_WEST"
+	case SurfaceEdgeSouthValue:
+		return "GDK_SURFACE_EDGE_SOUTH"
+	case SurfaceEdgeSouthEastValue:
+		return "GDK_SURFACE_EDGE_SOUTH_EAST"
+	}
+	return fmt.Sprintf("SurfaceEdge(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SurfaceEdge) Nick() string {
+	switch x {
+	case SurfaceEdgeNorthWestValue:
+		return "north-west"
+	case SurfaceEdgeNorthValue:
+		return "north"
+	case SurfaceEdgeNorthEastValue:
+		return "north-east"
+	case SurfaceEdgeWestValue:
+		return "west"
+	case SurfaceEdgeEastValue:
+		return "east"
+	case SurfaceEdgeSouthWestValue:
+		return "south-west"
+	case SurfaceEdgeSouthValue:
+		return "south"
+	case SurfaceEdgeSouthEastValue:
+		return "south-east"
+	}
+	return ""
+}
+
+// SurfaceEdgeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SurfaceEdgeFromString(s string) (SurfaceEdge, bool) {
+	switch s {
+	case "GDK_SURFACE_EDGE_NORTH_WEST", "north-west":
+		return SurfaceEdgeNorthWestValue, true
+	case "GDK_SURFACE_EDGE_NORTH", "north":
+		return SurfaceEdgeNorthValue, true
+	case "GDK_SURFACE_EDGE_NORTH_EAST", "north-east":
+		return SurfaceEdgeNorthEastValue, true
+	case "GDK_SURFACE_EDGE_WEST", "west":
+		return SurfaceEdgeWestValue, true
+	case "GDK_SURFACE_EDGE_EAST", "east":
+		return SurfaceEdgeEastValue, true
+	case "GDK_SURFACE_EDGE_SOUTH_WEST", "south-west":
+		return SurfaceEdgeSouthWestValue, true
+	case "GDK_SURFACE_EDGE_SOUTH", "south":
+		return SurfaceEdgeSouthValue, true
+	case "GDK_SURFACE_EDGE_SOUTH_EAST", "south-east":
+		return SurfaceEdgeSouthEastValue, true
+	}
+	return 0, false
+}
+
 // The kind of title bar gesture to emit with
-// [method@Gdk.Toplevel.titlebar_gesture].
+// [Toplevel.TitlebarGesture].
 type TitlebarGesture int
 
 var xTitlebarGestureGLibType func() types.GType
@@ -637,12 +906,51 @@ const (
 	TitlebarGestureMiddleClickValue TitlebarGesture = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TitlebarGesture) String() string {
+	switch x {
+	case TitlebarGestureDoubleClickValue:
+		return "GDK_TITLEBAR_GESTURE_DOUBLE_CLICK"
+	case TitlebarGestureRightClickValue:
+		return "GDK_TITLEBAR_GESTURE_RIGHT_CLICK"
+	case TitlebarGestureMiddleClickValue:
+		return "GDK_TITLEBAR_GESTURE_MIDDLE_CLICK"
+	}
+	return fmt.Sprintf("TitlebarGesture(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TitlebarGesture) Nick() string {
+	switch x {
+	case TitlebarGestureDoubleClickValue:
+		return "double-click"
+	case TitlebarGestureRightClickValue:
+		return "right-click"
+	case TitlebarGestureMiddleClickValue:
+		return "middle-click"
+	}
+	return ""
+}
+
+// TitlebarGestureFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TitlebarGestureFromString(s string) (TitlebarGesture, bool) {
+	switch s {
+	case "GDK_TITLEBAR_GESTURE_DOUBLE_CLICK", "double-click":
+		return TitlebarGestureDoubleClickValue, true
+	case "GDK_TITLEBAR_GESTURE_RIGHT_CLICK", "right-click":
+		return TitlebarGestureRightClickValue, true
+	case "GDK_TITLEBAR_GESTURE_MIDDLE_CLICK", "middle-click":
+		return TitlebarGestureMiddleClickValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GDK", "gtk4")
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -661,27 +969,27 @@ func init() {
 
 	core.PuregoSafeRegister(&xToplevelGLibType, libs, "gdk_toplevel_get_type")
 
-	core.PuregoSafeRegister(&XGdkToplevelBeginMove, libs, "gdk_toplevel_begin_move")
-	core.PuregoSafeRegister(&XGdkToplevelBeginResize, libs, "gdk_toplevel_begin_resize")
-	core.PuregoSafeRegister(&XGdkToplevelFocus, libs, "gdk_toplevel_focus")
-	core.PuregoSafeRegister(&XGdkToplevelGetCapabilities, libs, "gdk_toplevel_get_capabilities")
-	core.PuregoSafeRegister(&XGdkToplevelGetGravity, libs, "gdk_toplevel_get_gravity")
-	core.PuregoSafeRegister(&XGdkToplevelGetState, libs, "gdk_toplevel_get_state")
-	core.PuregoSafeRegister(&XGdkToplevelInhibitSystemShortcuts, libs, "gdk_toplevel_inhibit_system_shortcuts")
-	core.PuregoSafeRegister(&XGdkToplevelLower, libs, "gdk_toplevel_lower")
-	core.PuregoSafeRegister(&XGdkToplevelMinimize, libs, "gdk_toplevel_minimize")
-	core.PuregoSafeRegister(&XGdkToplevelPresent, libs, "gdk_toplevel_present")
-	core.PuregoSafeRegister(&XGdkToplevelRestoreSystemShortcuts, libs, "gdk_toplevel_restore_system_shortcuts")
-	core.PuregoSafeRegister(&XGdkToplevelSetDecorated, libs, "gdk_toplevel_set_decorated")
-	core.PuregoSafeRegister(&XGdkToplevelSetDeletable, libs, "gdk_toplevel_set_deletable")
-	core.PuregoSafeRegister(&XGdkToplevelSetGravity, libs, "gdk_toplevel_set_gravity")
-	core.PuregoSafeRegister(&XGdkToplevelSetIconList, libs, "gdk_toplevel_set_icon_list")
-	core.PuregoSafeRegister(&XGdkToplevelSetModal, libs, "gdk_toplevel_set_modal")
-	core.PuregoSafeRegister(&XGdkToplevelSetStartupId, libs, "gdk_toplevel_set_startup_id")
-	core.PuregoSafeRegister(&XGdkToplevelSetTitle, libs, "gdk_toplevel_set_title")
-	core.PuregoSafeRegister(&XGdkToplevelSetTransientFor, libs, "gdk_toplevel_set_transient_for")
-	core.PuregoSafeRegister(&XGdkToplevelShowWindowMenu, libs, "gdk_toplevel_show_window_menu")
-	core.PuregoSafeRegister(&XGdkToplevelSupportsEdgeConstraints, libs, "gdk_toplevel_supports_edge_constraints")
-	core.PuregoSafeRegister(&XGdkToplevelTitlebarGesture, libs, "gdk_toplevel_titlebar_gesture")
+	core.PuregoRegisterSince(&XGdkToplevelBeginMove, libs, "gdk_toplevel_begin_move", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelBeginResize, libs, "gdk_toplevel_begin_resize", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelFocus, libs, "gdk_toplevel_focus", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelGetCapabilities, libs, "gdk_toplevel_get_capabilities", "GDK", "4.20")
+	core.PuregoRegisterSince(&XGdkToplevelGetGravity, libs, "gdk_toplevel_get_gravity", "GDK", "4.20")
+	core.PuregoRegisterSince(&XGdkToplevelGetState, libs, "gdk_toplevel_get_state", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelInhibitSystemShortcuts, libs, "gdk_toplevel_inhibit_system_shortcuts", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelLower, libs, "gdk_toplevel_lower", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelMinimize, libs, "gdk_toplevel_minimize", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelPresent, libs, "gdk_toplevel_present", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelRestoreSystemShortcuts, libs, "gdk_toplevel_restore_system_shortcuts", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetDecorated, libs, "gdk_toplevel_set_decorated", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetDeletable, libs, "gdk_toplevel_set_deletable", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetGravity, libs, "gdk_toplevel_set_gravity", "GDK", "4.20")
+	core.PuregoRegisterSince(&XGdkToplevelSetIconList, libs, "gdk_toplevel_set_icon_list", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetModal, libs, "gdk_toplevel_set_modal", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetStartupId, libs, "gdk_toplevel_set_startup_id", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetTitle, libs, "gdk_toplevel_set_title", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSetTransientFor, libs, "gdk_toplevel_set_transient_for", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelShowWindowMenu, libs, "gdk_toplevel_show_window_menu", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelSupportsEdgeConstraints, libs, "gdk_toplevel_supports_edge_constraints", "GDK", "")
+	core.PuregoRegisterSince(&XGdkToplevelTitlebarGesture, libs, "gdk_toplevel_titlebar_gesture", "GDK", "4.4")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -15,7 +14,7 @@ import (
 // window on screen.
 //
 // The `GdkToplevelLayout` struct is necessary for using
-// [method@Gdk.Toplevel.present].
+// [Toplevel.Present].
 //
 // Toplevel surfaces are sovereign windows that can be presented
 // to the user in various states (maximized, on all workspaces,
@@ -38,11 +37,11 @@ var xNewToplevelLayout func() *ToplevelLayout
 
 // Create a toplevel layout description.
 //
-// Used together with [method@Gdk.Toplevel.present] to describe
+// Used together with [Toplevel.Present] to describe
 // how a toplevel surface should be placed and behave on-screen.
 //
 // The size is in ”application pixels”, not
-// ”device pixels” (see [method@Gdk.Surface.get_scale]).
+// ”device pixels” (see [Surface.GetScale]).
 func NewToplevelLayout() *ToplevelLayout {
 
 	cret := xNewToplevelLayout()
@@ -51,7 +50,7 @@ func NewToplevelLayout() *ToplevelLayout {
 
 var xToplevelLayoutCopy func(uintptr) *ToplevelLayout
 
-// Create a new `GdkToplevelLayout` and copy the contents of @layout into it.
+// Create a new `GdkToplevelLayout` and copy the contents of layout into it.
 func (x *ToplevelLayout) Copy() *ToplevelLayout {
 
 	cret := xToplevelLayoutCopy(x.GoPointer())
@@ -60,7 +59,7 @@ func (x *ToplevelLayout) Copy() *ToplevelLayout {
 
 var xToplevelLayoutEqual func(uintptr, *ToplevelLayout) bool
 
-// Check whether @layout and @other has identical layout properties.
+// Check whether layout and other has identical layout properties.
 func (x *ToplevelLayout) Equal(OtherVar *ToplevelLayout) bool {
 
 	cret := xToplevelLayoutEqual(x.GoPointer(), OtherVar)
@@ -70,7 +69,7 @@ func (x *ToplevelLayout) Equal(OtherVar *ToplevelLayout) bool {
 var xToplevelLayoutGetFullscreen func(uintptr, *bool) bool
 
 // If the layout specifies whether to the toplevel should go fullscreen,
-// the value pointed to by @fullscreen is set to true if it should go
+// the value pointed to by fullscreen is set to true if it should go
 // fullscreen, or false, if it should go unfullscreen.
 func (x *ToplevelLayout) GetFullscreen(FullscreenVar *bool) bool {
 
@@ -99,7 +98,7 @@ func (x *ToplevelLayout) GetFullscreenMonitor() *Monitor {
 var xToplevelLayoutGetMaximized func(uintptr, *bool) bool
 
 // If the layout specifies whether to the toplevel should go maximized,
-// the value pointed to by @maximized is set to true if it should go
+// the value pointed to by maximized is set to true if it should go
 // maximized, or false, if it should go unmaximized.
 func (x *ToplevelLayout) GetMaximized(MaximizedVar *bool) bool {
 
@@ -119,7 +118,7 @@ func (x *ToplevelLayout) GetResizable() bool {
 
 var xToplevelLayoutRef func(uintptr) *ToplevelLayout
 
-// Increases the reference count of @layout.
+// Increases the reference count of layout.
 func (x *ToplevelLayout) Ref() *ToplevelLayout {
 
 	cret := xToplevelLayoutRef(x.GoPointer())
@@ -158,7 +157,7 @@ func (x *ToplevelLayout) SetResizable(ResizableVar bool) {
 
 var xToplevelLayoutUnref func(uintptr)
 
-// Decreases the reference count of @layout.
+// Decreases the reference count of layout.
 func (x *ToplevelLayout) Unref() {
 
 	xToplevelLayoutUnref(x.GoPointer())
@@ -170,7 +169,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -179,18 +178,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xToplevelLayoutGLibType, libs, "gdk_toplevel_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewToplevelLayout, libs, "gdk_toplevel_layout_new")
-
-	core.PuregoSafeRegister(&xToplevelLayoutCopy, libs, "gdk_toplevel_layout_copy")
-	core.PuregoSafeRegister(&xToplevelLayoutEqual, libs, "gdk_toplevel_layout_equal")
-	core.PuregoSafeRegister(&xToplevelLayoutGetFullscreen, libs, "gdk_toplevel_layout_get_fullscreen")
-	core.PuregoSafeRegister(&xToplevelLayoutGetFullscreenMonitor, libs, "gdk_toplevel_layout_get_fullscreen_monitor")
-	core.PuregoSafeRegister(&xToplevelLayoutGetMaximized, libs, "gdk_toplevel_layout_get_maximized")
-	core.PuregoSafeRegister(&xToplevelLayoutGetResizable, libs, "gdk_toplevel_layout_get_resizable")
-	core.PuregoSafeRegister(&xToplevelLayoutRef, libs, "gdk_toplevel_layout_ref")
-	core.PuregoSafeRegister(&xToplevelLayoutSetFullscreen, libs, "gdk_toplevel_layout_set_fullscreen")
-	core.PuregoSafeRegister(&xToplevelLayoutSetMaximized, libs, "gdk_toplevel_layout_set_maximized")
-	core.PuregoSafeRegister(&xToplevelLayoutSetResizable, libs, "gdk_toplevel_layout_set_resizable")
-	core.PuregoSafeRegister(&xToplevelLayoutUnref, libs, "gdk_toplevel_layout_unref")
+	core.PuregoRegisterSince(&xNewToplevelLayout, libs, "gdk_toplevel_layout_new", "GDK", "")
+
+	core.PuregoRegisterSince(&xToplevelLayoutCopy, libs, "gdk_toplevel_layout_copy", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutEqual, libs, "gdk_toplevel_layout_equal", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutGetFullscreen, libs, "gdk_toplevel_layout_get_fullscreen", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutGetFullscreenMonitor, libs, "gdk_toplevel_layout_get_fullscreen_monitor", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutGetMaximized, libs, "gdk_toplevel_layout_get_maximized", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutGetResizable, libs, "gdk_toplevel_layout_get_resizable", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutRef, libs, "gdk_toplevel_layout_ref", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutSetFullscreen, libs, "gdk_toplevel_layout_set_fullscreen", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutSetMaximized, libs, "gdk_toplevel_layout_set_maximized", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutSetResizable, libs, "gdk_toplevel_layout_set_resizable", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelLayoutUnref, libs, "gdk_toplevel_layout_unref", "GDK", "")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -25,6 +25,21 @@ func (x *ToplevelSize) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the ToplevelSize made with g_boxed_copy, release it with Free.
+func (x *ToplevelSize) Copy() *ToplevelSize {
+	cret := gobject.BoxedCopy(ToplevelSizeGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*ToplevelSize)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the ToplevelSize with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *ToplevelSize) Free() {
+	gobject.BoxedFree(ToplevelSizeGLibType(), x.GoPointer())
+}
+
 var xToplevelSizeGetBounds func(uintptr, *int, *int)
 
 // Retrieves the bounds the toplevel is placed within.
@@ -50,7 +65,7 @@ var xToplevelSizeSetMinSize func(uintptr, int, int)
 // the `GdkToplevelSize` object.
 //
 // The minimum size should be within the bounds (see
-// [method@Gdk.ToplevelSize.get_bounds]).
+// [ToplevelSize.GetBounds]).
 func (x *ToplevelSize) SetMinSize(MinWidthVar int, MinHeightVar int) {
 
 	xToplevelSizeSetMinSize(x.GoPointer(), MinWidthVar, MinHeightVar)
@@ -66,7 +81,7 @@ var xToplevelSizeSetShadowWidth func(uintptr, int, int, int, int)
 // there be any.
 //
 // Shadow width should only be set if
-// [method@Gtk.Display.supports_shadow_width] is %TRUE.
+// Display.supports_shadow_width is true.
 func (x *ToplevelSize) SetShadowWidth(LeftVar int, RightVar int, TopVar int, BottomVar int) {
 
 	xToplevelSizeSetShadowWidth(x.GoPointer(), LeftVar, RightVar, TopVar, BottomVar)
@@ -78,7 +93,7 @@ var xToplevelSizeSetSize func(uintptr, int, int)
 // Sets the size the toplevel prefers to be resized to.
 //
 // The size should be within the bounds (see
-// [method@Gdk.ToplevelSize.get_bounds]). The set size should
+// [ToplevelSize.GetBounds]). The set size should
 // be considered as a hint, and should not be assumed to be
 // respected by the windowing system, or backend.
 func (x *ToplevelSize) SetSize(WidthVar int, HeightVar int) {
@@ -92,7 +107,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -101,9 +116,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xToplevelSizeGLibType, libs, "gdk_toplevel_size_get_type")
 
-	core.PuregoSafeRegister(&xToplevelSizeGetBounds, libs, "gdk_toplevel_size_get_bounds")
-	core.PuregoSafeRegister(&xToplevelSizeSetMinSize, libs, "gdk_toplevel_size_set_min_size")
-	core.PuregoSafeRegister(&xToplevelSizeSetShadowWidth, libs, "gdk_toplevel_size_set_shadow_width")
-	core.PuregoSafeRegister(&xToplevelSizeSetSize, libs, "gdk_toplevel_size_set_size")
+	core.PuregoRegisterSince(&xToplevelSizeGetBounds, libs, "gdk_toplevel_size_get_bounds", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelSizeSetMinSize, libs, "gdk_toplevel_size_set_min_size", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelSizeSetShadowWidth, libs, "gdk_toplevel_size_set_shadow_width", "GDK", "")
+	core.PuregoRegisterSince(&xToplevelSizeSetSize, libs, "gdk_toplevel_size_set_size", "GDK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,12 +14,14 @@ import (
 // Provides information to interpret colors and pixels in a variety of ways.
 //
 // They are also known as
-// [*color spaces*](https://en.wikipedia.org/wiki/Color_space).
+// [*color spaces*].
 //
 // Crucially, GTK knows how to convert colors from one color
 // state to another.
 //
 // `GdkColorState` objects are immutable and therefore threadsafe.
+//
+// [*color spaces*]: https://en.wikipedia.org/wiki/Color_space
 type ColorState struct {
 	_ structs.HostLayout
 }
@@ -37,7 +38,7 @@ func (x *ColorState) GoPointer() uintptr {
 
 var xColorStateCreateCicpParams func(uintptr) uintptr
 
-// Create a [class@Gdk.CicpParams] representing the colorstate.
+// Create a [CicpParams] representing the colorstate.
 //
 // It is not guaranteed that every `GdkColorState` can be
 // represented with Cicp parameters. If that is the case,
@@ -73,7 +74,7 @@ var xColorStateEquivalent func(uintptr, *ColorState) bool
 // Compares two `GdkColorStates` for equivalence.
 //
 // Two objects that represent the same color state should be equivalent,
-// even though they may not be equal in the sense of [method@Gdk.ColorState.equal].
+// even though they may not be equal in the sense of [ColorState.Equal].
 func (x *ColorState) Equivalent(OtherVar *ColorState) bool {
 
 	cret := xColorStateEquivalent(x.GoPointer(), OtherVar)
@@ -82,7 +83,7 @@ func (x *ColorState) Equivalent(OtherVar *ColorState) bool {
 
 var xColorStateRef func(uintptr) *ColorState
 
-// Increase the reference count of @self.
+// Increase the reference count of self.
 func (x *ColorState) Ref() *ColorState {
 
 	cret := xColorStateRef(x.GoPointer())
@@ -91,9 +92,9 @@ func (x *ColorState) Ref() *ColorState {
 
 var xColorStateUnref func(uintptr)
 
-// Decrease the reference count of @self.
+// Decrease the reference count of self.
 //
-// Unless @self is static, it will be freed
+// Unless self is static, it will be freed
 // when the reference count reaches zero.
 func (x *ColorState) Unref() {
 
@@ -105,32 +106,32 @@ func (x *ColorState) Unref() {
 //
 // You will encounter `GdkContentFormats` when interacting with objects
 // controlling operations that pass data between different widgets, window
-// or application, like [class@Gdk.Drag], [class@Gdk.Drop],
-// [class@Gdk.Clipboard] or [class@Gdk.ContentProvider].
+// or application, like [Drag], [Drop],
+// [Clipboard] or [ContentProvider].
 //
 // GDK supports content in 2 forms: `GType` and mime type.
 // Using `GTypes` is meant only for in-process content transfers. Mime types
 // are meant to be used for data passing both in-process and out-of-process.
 // The details of how data is passed is described in the documentation of
 // the actual implementations. To transform between the two forms,
-// [class@Gdk.ContentSerializer] and [class@Gdk.ContentDeserializer] are used.
+// [ContentSerializer] and [ContentDeserializer] are used.
 //
 // A `GdkContentFormats` describes a set of possible formats content can be
 // exchanged in. It is assumed that this set is ordered. `GTypes` are more
 // important than mime types. Order between different `GTypes` or mime types
 // is the order they were added in, most important first. Functions that
-// care about order, such as [method@Gdk.ContentFormats.union], will describe
+// care about order, such as [ContentFormats.Union], will describe
 // in their documentation how they interpret that order, though in general the
 // order of the first argument is considered the primary order of the result,
 // followed by the order of further arguments.
 //
-// For debugging purposes, the function [method@Gdk.ContentFormats.to_string]
+// For debugging purposes, the function [ContentFormats.ToString]
 // exists. It will print a comma-separated list of formats from most important
 // to least important.
 //
 // `GdkContentFormats` is an immutable struct. After creation, you cannot change
 // the types it represents. Instead, new `GdkContentFormats` have to be created.
-// The [struct@Gdk.ContentFormatsBuilder] structure is meant to help in this
+// The [ContentFormatsBuilder] structure is meant to help in this
 // endeavor.
 type ContentFormats struct {
 	_ structs.HostLayout
@@ -152,7 +153,7 @@ var xNewContentFormats func([]string, uint) *ContentFormats
 //
 // The mime types must be valid and different from each other or the
 // behavior of the return value is undefined. If you cannot guarantee
-// this, use [struct@Gdk.ContentFormatsBuilder] instead.
+// this, use [ContentFormatsBuilder] instead.
 func NewContentFormats(MimeTypesVar []string, NMimeTypesVar uint) *ContentFormats {
 
 	cret := xNewContentFormats(MimeTypesVar, NMimeTypesVar)
@@ -170,7 +171,7 @@ func NewContentFormatsForGtype(TypeVar types.GType) *ContentFormats {
 
 var xContentFormatsContainGtype func(uintptr, types.GType) bool
 
-// Checks if a given `GType` is part of the given @formats.
+// Checks if a given `GType` is part of the given formats.
 func (x *ContentFormats) ContainGtype(TypeVar types.GType) bool {
 
 	cret := xContentFormatsContainGtype(x.GoPointer(), TypeVar)
@@ -179,7 +180,7 @@ func (x *ContentFormats) ContainGtype(TypeVar types.GType) bool {
 
 var xContentFormatsContainMimeType func(uintptr, string) bool
 
-// Checks if a given mime type is part of the given @formats.
+// Checks if a given mime type is part of the given formats.
 func (x *ContentFormats) ContainMimeType(MimeTypeVar string) bool {
 
 	cret := xContentFormatsContainMimeType(x.GoPointer(), MimeTypeVar)
@@ -188,10 +189,10 @@ func (x *ContentFormats) ContainMimeType(MimeTypeVar string) bool {
 
 var xContentFormatsGetGtypes func(uintptr, *uint) uintptr
 
-// Gets the `GType`s included in @formats.
+// Gets the `GType`s included in formats.
 //
-// Note that @formats may not contain any `GType`s, in particular when
-// they are empty. In that case %NULL will be returned.
+// Note that formats may not contain any `GType`s, in particular when
+// they are empty. In that case nil will be returned.
 func (x *ContentFormats) GetGtypes(NGtypesVar *uint) uintptr {
 
 	cret := xContentFormatsGetGtypes(x.GoPointer(), NGtypesVar)
@@ -200,10 +201,10 @@ func (x *ContentFormats) GetGtypes(NGtypesVar *uint) uintptr {
 
 var xContentFormatsGetMimeTypes func(uintptr, *uint) []string
 
-// Gets the mime types included in @formats.
+// Gets the mime types included in formats.
 //
-// Note that @formats may not contain any mime types, in particular
-// when they are empty. In that case %NULL will be returned.
+// Note that formats may not contain any mime types, in particular
+// when they are empty. In that case nil will be returned.
 func (x *ContentFormats) GetMimeTypes(NMimeTypesVar *uint) []string {
 
 	cret := xContentFormatsGetMimeTypes(x.GoPointer(), NMimeTypesVar)
@@ -221,7 +222,7 @@ func (x *ContentFormats) IsEmpty() bool {
 
 var xContentFormatsMatch func(uintptr, *ContentFormats) bool
 
-// Checks if @first and @second have any matching formats.
+// Checks if first and second have any matching formats.
 func (x *ContentFormats) Match(SecondVar *ContentFormats) bool {
 
 	cret := xContentFormatsMatch(x.GoPointer(), SecondVar)
@@ -230,10 +231,10 @@ func (x *ContentFormats) Match(SecondVar *ContentFormats) bool {
 
 var xContentFormatsMatchGtype func(uintptr, *ContentFormats) types.GType
 
-// Finds the first `GType` from @first that is also contained
-// in @second.
+// Finds the first `GType` from first that is also contained
+// in second.
 //
-// If no matching `GType` is found, %G_TYPE_INVALID is returned.
+// If no matching `GType` is found, G_TYPE_INVALID is returned.
 func (x *ContentFormats) MatchGtype(SecondVar *ContentFormats) types.GType {
 
 	cret := xContentFormatsMatchGtype(x.GoPointer(), SecondVar)
@@ -242,10 +243,10 @@ func (x *ContentFormats) MatchGtype(SecondVar *ContentFormats) types.GType {
 
 var xContentFormatsMatchMimeType func(uintptr, *ContentFormats) string
 
-// Finds the first mime type from @first that is also contained
-// in @second.
+// Finds the first mime type from first that is also contained
+// in second.
 //
-// If no matching mime type is found, %NULL is returned.
+// If no matching mime type is found, nil is returned.
 func (x *ContentFormats) MatchMimeType(SecondVar *ContentFormats) string {
 
 	cret := xContentFormatsMatchMimeType(x.GoPointer(), SecondVar)
@@ -254,10 +255,10 @@ func (x *ContentFormats) MatchMimeType(SecondVar *ContentFormats) string {
 
 var xContentFormatsPrint func(uintptr, *glib.String)
 
-// Prints the given @formats into a string for human consumption.
+// Prints the given formats into a string for human consumption.
 //
 // The result of this function can later be parsed with
-// [func@Gdk.ContentFormats.parse].
+// [ContentFormatsParse].
 func (x *ContentFormats) Print(StringVar *glib.String) {
 
 	xContentFormatsPrint(x.GoPointer(), StringVar)
@@ -275,11 +276,11 @@ func (x *ContentFormats) Ref() *ContentFormats {
 
 var xContentFormatsToString func(uintptr) string
 
-// Prints the given @formats into a human-readable string.
+// Prints the given formats into a human-readable string.
 //
-// The resulting string can be parsed with [func@Gdk.ContentFormats.parse].
+// The resulting string can be parsed with [ContentFormatsParse].
 //
-// This is a small wrapper around [method@Gdk.ContentFormats.print]
+// This is a small wrapper around [ContentFormats.Print]
 // to help when debugging.
 func (x *ContentFormats) ToString() string {
 
@@ -289,8 +290,8 @@ func (x *ContentFormats) ToString() string {
 
 var xContentFormatsUnion func(uintptr, *ContentFormats) *ContentFormats
 
-// Append all missing types from @second to @first, in the order
-// they had in @second.
+// Append all missing types from second to first, in the order
+// they had in second.
 func (x *ContentFormats) Union(SecondVar *ContentFormats) *ContentFormats {
 
 	cret := xContentFormatsUnion(x.GoPointer(), SecondVar)
@@ -299,7 +300,7 @@ func (x *ContentFormats) Union(SecondVar *ContentFormats) *ContentFormats {
 
 var xContentFormatsUnionDeserializeGtypes func(uintptr) *ContentFormats
 
-// Add GTypes for mime types in @formats for which deserializers are
+// Add GTypes for mime types in formats for which deserializers are
 // registered.
 func (x *ContentFormats) UnionDeserializeGtypes() *ContentFormats {
 
@@ -309,7 +310,7 @@ func (x *ContentFormats) UnionDeserializeGtypes() *ContentFormats {
 
 var xContentFormatsUnionDeserializeMimeTypes func(uintptr) *ContentFormats
 
-// Add mime types for GTypes in @formats for which deserializers are
+// Add mime types for GTypes in formats for which deserializers are
 // registered.
 func (x *ContentFormats) UnionDeserializeMimeTypes() *ContentFormats {
 
@@ -319,7 +320,7 @@ func (x *ContentFormats) UnionDeserializeMimeTypes() *ContentFormats {
 
 var xContentFormatsUnionSerializeGtypes func(uintptr) *ContentFormats
 
-// Add GTypes for the mime types in @formats for which serializers are
+// Add GTypes for the mime types in formats for which serializers are
 // registered.
 func (x *ContentFormats) UnionSerializeGtypes() *ContentFormats {
 
@@ -329,7 +330,7 @@ func (x *ContentFormats) UnionSerializeGtypes() *ContentFormats {
 
 var xContentFormatsUnionSerializeMimeTypes func(uintptr) *ContentFormats
 
-// Add mime types for GTypes in @formats for which serializers are
+// Add mime types for GTypes in formats for which serializers are
 // registered.
 func (x *ContentFormats) UnionSerializeMimeTypes() *ContentFormats {
 
@@ -351,10 +352,10 @@ func (x *ContentFormats) Unref() {
 // Provides information about supported DMA buffer formats.
 //
 // You can query whether a given format is supported with
-// [method@Gdk.DmabufFormats.contains] and you can iterate
+// [DmabufFormats.Contains] and you can iterate
 // over the list of all supported formats with
-// [method@Gdk.DmabufFormats.get_n_formats] and
-// [method@Gdk.DmabufFormats.get_format].
+// [DmabufFormats.GetNFormats] and
+// [DmabufFormats.GetFormat].
 //
 // The list of supported formats is sorted by preference,
 // with the best formats coming first.
@@ -363,7 +364,7 @@ func (x *ContentFormats) Unref() {
 // is `DMA_FORMAT_MOD_INVALID`, indicating that **_implicit modifiers_**
 // may be used with this format.
 //
-// See [class@Gdk.DmabufTextureBuilder] for more information
+// See [DmabufTextureBuilder] for more information
 // about DMA buffers.
 //
 // Note that DMA buffers only exist on Linux.
@@ -383,7 +384,7 @@ func (x *DmabufFormats) GoPointer() uintptr {
 
 var xDmabufFormatsContains func(uintptr, uint32, uint64) bool
 
-// Returns whether a given format is contained in @formats.
+// Returns whether a given format is contained in formats.
 func (x *DmabufFormats) Contains(FourccVar uint32, ModifierVar uint64) bool {
 
 	cret := xDmabufFormatsContains(x.GoPointer(), FourccVar, ModifierVar)
@@ -392,7 +393,7 @@ func (x *DmabufFormats) Contains(FourccVar uint32, ModifierVar uint64) bool {
 
 var xDmabufFormatsEqual func(uintptr, *DmabufFormats) bool
 
-// Returns whether @formats1 and @formats2 contain the
+// Returns whether formats1 and formats2 contain the
 // same dmabuf formats, in the same order.
 func (x *DmabufFormats) Equal(Formats2Var *DmabufFormats) bool {
 
@@ -403,7 +404,7 @@ func (x *DmabufFormats) Equal(Formats2Var *DmabufFormats) bool {
 var xDmabufFormatsGetFormat func(uintptr, uint, *uint32, *uint64)
 
 // Gets the fourcc code and modifier for a format
-// that is contained in @formats.
+// that is contained in formats.
 func (x *DmabufFormats) GetFormat(IdxVar uint, FourccVar *uint32, ModifierVar *uint64) {
 
 	xDmabufFormatsGetFormat(x.GoPointer(), IdxVar, FourccVar, ModifierVar)
@@ -412,11 +413,11 @@ func (x *DmabufFormats) GetFormat(IdxVar uint, FourccVar *uint32, ModifierVar *u
 
 var xDmabufFormatsGetNFormats func(uintptr) uint
 
-// Returns the number of formats that the @formats object
+// Returns the number of formats that the formats object
 // contains.
 //
 // Note that DMA buffers are a Linux concept, so on other
-// platforms, [method@Gdk.DmabufFormats.get_n_formats] will
+// platforms, [DmabufFormats.GetNFormats] will
 // always return zero.
 func (x *DmabufFormats) GetNFormats() uint {
 
@@ -426,7 +427,7 @@ func (x *DmabufFormats) GetNFormats() uint {
 
 var xDmabufFormatsRef func(uintptr) *DmabufFormats
 
-// Increases the reference count of @formats.
+// Increases the reference count of formats.
 func (x *DmabufFormats) Ref() *DmabufFormats {
 
 	cret := xDmabufFormatsRef(x.GoPointer())
@@ -435,7 +436,7 @@ func (x *DmabufFormats) Ref() *DmabufFormats {
 
 var xDmabufFormatsUnref func(uintptr)
 
-// Decreases the reference count of @formats.
+// Decreases the reference count of formats.
 //
 // When the reference count reaches zero,
 // the object is freed.
@@ -467,8 +468,8 @@ func (x *KeymapKey) GoPointer() uintptr {
 // sets of pixels.
 //
 // The intersection of two rectangles can be computed with
-// [method@Gdk.Rectangle.intersect]; to find the union of two rectangles use
-// [method@Gdk.Rectangle.union].
+// [Rectangle.Intersect]; to find the union of two rectangles use
+// [Rectangle.Union].
 //
 // The `cairo_region_t` type provided by Cairo is usually used for managing
 // non-rectangular clipping of graphical operations.
@@ -497,9 +498,32 @@ func (x *Rectangle) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Rectangle made with g_boxed_copy, release it with Free.
+func (x *Rectangle) Copy() *Rectangle {
+	cret := gobject.BoxedCopy(RectangleGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Rectangle)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Rectangle with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Rectangle) Free() {
+	gobject.BoxedFree(RectangleGLibType(), x.GoPointer())
+}
+
+// NewRectangleAlloc returns a zeroed Rectangle allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated Rectangle. Release it with Free.
+func NewRectangleAlloc() *Rectangle {
+	cret := core.GMalloc0(max(unsafe.Sizeof(Rectangle{}), 16))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Rectangle)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xRectangleContainsPoint func(uintptr, int, int) bool
 
-// Returns %TRUE if @rect contains the point described by @x and @y.
+// Returns true if rect contains the point described by x and y.
 func (x *Rectangle) ContainsPoint(XVar int, YVar int) bool {
 
 	cret := xRectangleContainsPoint(x.GoPointer(), XVar, YVar)
@@ -519,11 +543,11 @@ var xRectangleIntersect func(uintptr, *Rectangle, *Rectangle) bool
 
 // Calculates the intersection of two rectangles.
 //
-// It is allowed for @dest to be the same as either @src1 or @src2.
-// If the rectangles do not intersect, @dest’s width and height is set
+// It is allowed for dest to be the same as either src1 or src2.
+// If the rectangles do not intersect, dest’s width and height is set
 // to 0 and its x and y values are undefined. If you are only interested
 // in whether the rectangles intersect, but not in the intersecting area
-// itself, pass %NULL for @dest.
+// itself, pass nil for dest.
 func (x *Rectangle) Intersect(Src2Var *Rectangle, DestVar *Rectangle) bool {
 
 	cret := xRectangleIntersect(x.GoPointer(), Src2Var, DestVar)
@@ -534,9 +558,9 @@ var xRectangleUnion func(uintptr, *Rectangle, *Rectangle)
 
 // Calculates the union of two rectangles.
 //
-// The union of rectangles @src1 and @src2 is the smallest rectangle which
-// includes both @src1 and @src2 within it. It is allowed for @dest to be
-// the same as either @src1 or @src2.
+// The union of rectangles src1 and src2 is the smallest rectangle which
+// includes both src1 and src2 within it. It is allowed for dest to be
+// the same as either src1 or src2.
 //
 // Note that this function does not ignore 'empty' rectangles (ie. with
 // zero width or height).
@@ -546,7 +570,7 @@ func (x *Rectangle) Union(Src2Var *Rectangle, DestVar *Rectangle) {
 
 }
 
-// Used to download the contents of a [class@Gdk.Texture].
+// Used to download the contents of a [Texture].
 //
 // It is intended to be created as a short-term object for a single download,
 // but can be used for multiple downloads of different textures or with different
@@ -571,7 +595,7 @@ func (x *TextureDownloader) GoPointer() uintptr {
 
 var xNewTextureDownloader func(uintptr) *TextureDownloader
 
-// Creates a new texture downloader for @texture.
+// Creates a new texture downloader for texture.
 //
 // By default, the downloader will convert the data to
 // the default memory format, and to the sRGB color state.
@@ -599,11 +623,11 @@ var xTextureDownloaderDownloadBytes func(uintptr, *uint) *glib.Bytes
 //
 // This function will abort if it tries to download a large texture and
 // fails to allocate memory. If you think that may happen, you should handle
-// memory allocation yourself and use [method@Gdk.TextureDownloader.download_into]
+// memory allocation yourself and use [TextureDownloader.DownloadInto]
 // once allocation succeeded.
 //
 // This function cannot be used with a multiplanar format. Use
-// [method@Gdk.TextureDownloader.download_bytes_with_planes] for that purpose.
+// [TextureDownloader.DownloadBytesWithPlanes] for that purpose.
 func (x *TextureDownloader) DownloadBytes(OutStrideVar *uint) *glib.Bytes {
 
 	cret := xTextureDownloaderDownloadBytes(x.GoPointer(), OutStrideVar)
@@ -625,7 +649,7 @@ func (x *TextureDownloader) DownloadBytesWithPlanes(OutOffsetsVar *[4]uint, OutS
 
 var xTextureDownloaderDownloadInto func(uintptr, []byte, uint)
 
-// Downloads the @texture into local memory.
+// Downloads the texture into local memory.
 //
 // This function cannot be used with a multiplanar format.
 func (x *TextureDownloader) DownloadInto(DataVar []byte, StrideVar uint) {
@@ -682,7 +706,7 @@ var xTextureDownloaderSetColorState func(uintptr, *ColorState)
 
 // Sets the color state the downloader will convert the data to.
 //
-// By default, the sRGB colorstate returned by [func@ColorState.get_srgb]
+// By default, the sRGB colorstate returned by get_srgb
 // is used.
 func (x *TextureDownloader) SetColorState(ColorStateVar *ColorState) {
 
@@ -720,7 +744,7 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -729,65 +753,65 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorStateGLibType, libs, "gdk_color_state_get_type")
 
-	core.PuregoSafeRegister(&xColorStateCreateCicpParams, libs, "gdk_color_state_create_cicp_params")
-	core.PuregoSafeRegister(&xColorStateEqual, libs, "gdk_color_state_equal")
-	core.PuregoSafeRegister(&xColorStateEquivalent, libs, "gdk_color_state_equivalent")
-	core.PuregoSafeRegister(&xColorStateRef, libs, "gdk_color_state_ref")
-	core.PuregoSafeRegister(&xColorStateUnref, libs, "gdk_color_state_unref")
+	core.PuregoRegisterSince(&xColorStateCreateCicpParams, libs, "gdk_color_state_create_cicp_params", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateEqual, libs, "gdk_color_state_equal", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateEquivalent, libs, "gdk_color_state_equivalent", "GDK", "4.20")
+	core.PuregoRegisterSince(&xColorStateRef, libs, "gdk_color_state_ref", "GDK", "4.16")
+	core.PuregoRegisterSince(&xColorStateUnref, libs, "gdk_color_state_unref", "GDK", "4.16")
 
 	core.PuregoSafeRegister(&xContentFormatsGLibType, libs, "gdk_content_formats_get_type")
 
-	core.PuregoSafeRegister(&xNewContentFormats, libs, "gdk_content_formats_new")
-	core.PuregoSafeRegister(&xNewContentFormatsForGtype, libs, "gdk_content_formats_new_for_gtype")
-
-	core.PuregoSafeRegister(&xContentFormatsContainGtype, libs, "gdk_content_formats_contain_gtype")
-	core.PuregoSafeRegister(&xContentFormatsContainMimeType, libs, "gdk_content_formats_contain_mime_type")
-	core.PuregoSafeRegister(&xContentFormatsGetGtypes, libs, "gdk_content_formats_get_gtypes")
-	core.PuregoSafeRegister(&xContentFormatsGetMimeTypes, libs, "gdk_content_formats_get_mime_types")
-	core.PuregoSafeRegister(&xContentFormatsIsEmpty, libs, "gdk_content_formats_is_empty")
-	core.PuregoSafeRegister(&xContentFormatsMatch, libs, "gdk_content_formats_match")
-	core.PuregoSafeRegister(&xContentFormatsMatchGtype, libs, "gdk_content_formats_match_gtype")
-	core.PuregoSafeRegister(&xContentFormatsMatchMimeType, libs, "gdk_content_formats_match_mime_type")
-	core.PuregoSafeRegister(&xContentFormatsPrint, libs, "gdk_content_formats_print")
-	core.PuregoSafeRegister(&xContentFormatsRef, libs, "gdk_content_formats_ref")
-	core.PuregoSafeRegister(&xContentFormatsToString, libs, "gdk_content_formats_to_string")
-	core.PuregoSafeRegister(&xContentFormatsUnion, libs, "gdk_content_formats_union")
-	core.PuregoSafeRegister(&xContentFormatsUnionDeserializeGtypes, libs, "gdk_content_formats_union_deserialize_gtypes")
-	core.PuregoSafeRegister(&xContentFormatsUnionDeserializeMimeTypes, libs, "gdk_content_formats_union_deserialize_mime_types")
-	core.PuregoSafeRegister(&xContentFormatsUnionSerializeGtypes, libs, "gdk_content_formats_union_serialize_gtypes")
-	core.PuregoSafeRegister(&xContentFormatsUnionSerializeMimeTypes, libs, "gdk_content_formats_union_serialize_mime_types")
-	core.PuregoSafeRegister(&xContentFormatsUnref, libs, "gdk_content_formats_unref")
+	core.PuregoRegisterSince(&xNewContentFormats, libs, "gdk_content_formats_new", "GDK", "")
+	core.PuregoRegisterSince(&xNewContentFormatsForGtype, libs, "gdk_content_formats_new_for_gtype", "GDK", "")
+
+	core.PuregoRegisterSince(&xContentFormatsContainGtype, libs, "gdk_content_formats_contain_gtype", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsContainMimeType, libs, "gdk_content_formats_contain_mime_type", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsGetGtypes, libs, "gdk_content_formats_get_gtypes", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsGetMimeTypes, libs, "gdk_content_formats_get_mime_types", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsIsEmpty, libs, "gdk_content_formats_is_empty", "GDK", "4.18")
+	core.PuregoRegisterSince(&xContentFormatsMatch, libs, "gdk_content_formats_match", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsMatchGtype, libs, "gdk_content_formats_match_gtype", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsMatchMimeType, libs, "gdk_content_formats_match_mime_type", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsPrint, libs, "gdk_content_formats_print", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsRef, libs, "gdk_content_formats_ref", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsToString, libs, "gdk_content_formats_to_string", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnion, libs, "gdk_content_formats_union", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnionDeserializeGtypes, libs, "gdk_content_formats_union_deserialize_gtypes", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnionDeserializeMimeTypes, libs, "gdk_content_formats_union_deserialize_mime_types", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnionSerializeGtypes, libs, "gdk_content_formats_union_serialize_gtypes", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnionSerializeMimeTypes, libs, "gdk_content_formats_union_serialize_mime_types", "GDK", "")
+	core.PuregoRegisterSince(&xContentFormatsUnref, libs, "gdk_content_formats_unref", "GDK", "")
 
 	core.PuregoSafeRegister(&xDmabufFormatsGLibType, libs, "gdk_dmabuf_formats_get_type")
 
-	core.PuregoSafeRegister(&xDmabufFormatsContains, libs, "gdk_dmabuf_formats_contains")
-	core.PuregoSafeRegister(&xDmabufFormatsEqual, libs, "gdk_dmabuf_formats_equal")
-	core.PuregoSafeRegister(&xDmabufFormatsGetFormat, libs, "gdk_dmabuf_formats_get_format")
-	core.PuregoSafeRegister(&xDmabufFormatsGetNFormats, libs, "gdk_dmabuf_formats_get_n_formats")
-	core.PuregoSafeRegister(&xDmabufFormatsRef, libs, "gdk_dmabuf_formats_ref")
-	core.PuregoSafeRegister(&xDmabufFormatsUnref, libs, "gdk_dmabuf_formats_unref")
+	core.PuregoRegisterSince(&xDmabufFormatsContains, libs, "gdk_dmabuf_formats_contains", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufFormatsEqual, libs, "gdk_dmabuf_formats_equal", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufFormatsGetFormat, libs, "gdk_dmabuf_formats_get_format", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufFormatsGetNFormats, libs, "gdk_dmabuf_formats_get_n_formats", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufFormatsRef, libs, "gdk_dmabuf_formats_ref", "GDK", "4.14")
+	core.PuregoRegisterSince(&xDmabufFormatsUnref, libs, "gdk_dmabuf_formats_unref", "GDK", "4.14")
 
 	core.PuregoSafeRegister(&xRectangleGLibType, libs, "gdk_rectangle_get_type")
 
-	core.PuregoSafeRegister(&xRectangleContainsPoint, libs, "gdk_rectangle_contains_point")
-	core.PuregoSafeRegister(&xRectangleEqual, libs, "gdk_rectangle_equal")
-	core.PuregoSafeRegister(&xRectangleIntersect, libs, "gdk_rectangle_intersect")
-	core.PuregoSafeRegister(&xRectangleUnion, libs, "gdk_rectangle_union")
+	core.PuregoRegisterSince(&xRectangleContainsPoint, libs, "gdk_rectangle_contains_point", "GDK", "")
+	core.PuregoRegisterSince(&xRectangleEqual, libs, "gdk_rectangle_equal", "GDK", "")
+	core.PuregoRegisterSince(&xRectangleIntersect, libs, "gdk_rectangle_intersect", "GDK", "")
+	core.PuregoRegisterSince(&xRectangleUnion, libs, "gdk_rectangle_union", "GDK", "")
 
 	core.PuregoSafeRegister(&xTextureDownloaderGLibType, libs, "gdk_texture_downloader_get_type")
 
-	core.PuregoSafeRegister(&xNewTextureDownloader, libs, "gdk_texture_downloader_new")
-
-	core.PuregoSafeRegister(&xTextureDownloaderCopy, libs, "gdk_texture_downloader_copy")
-	core.PuregoSafeRegister(&xTextureDownloaderDownloadBytes, libs, "gdk_texture_downloader_download_bytes")
-	core.PuregoSafeRegister(&xTextureDownloaderDownloadBytesWithPlanes, libs, "gdk_texture_downloader_download_bytes_with_planes")
-	core.PuregoSafeRegister(&xTextureDownloaderDownloadInto, libs, "gdk_texture_downloader_download_into")
-	core.PuregoSafeRegister(&xTextureDownloaderFree, libs, "gdk_texture_downloader_free")
-	core.PuregoSafeRegister(&xTextureDownloaderGetColorState, libs, "gdk_texture_downloader_get_color_state")
-	core.PuregoSafeRegister(&xTextureDownloaderGetFormat, libs, "gdk_texture_downloader_get_format")
-	core.PuregoSafeRegister(&xTextureDownloaderGetTexture, libs, "gdk_texture_downloader_get_texture")
-	core.PuregoSafeRegister(&xTextureDownloaderSetColorState, libs, "gdk_texture_downloader_set_color_state")
-	core.PuregoSafeRegister(&xTextureDownloaderSetFormat, libs, "gdk_texture_downloader_set_format")
-	core.PuregoSafeRegister(&xTextureDownloaderSetTexture, libs, "gdk_texture_downloader_set_texture")
+	core.PuregoRegisterSince(&xNewTextureDownloader, libs, "gdk_texture_downloader_new", "GDK", "4.10")
+
+	core.PuregoRegisterSince(&xTextureDownloaderCopy, libs, "gdk_texture_downloader_copy", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderDownloadBytes, libs, "gdk_texture_downloader_download_bytes", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderDownloadBytesWithPlanes, libs, "gdk_texture_downloader_download_bytes_with_planes", "GDK", "4.20")
+	core.PuregoRegisterSince(&xTextureDownloaderDownloadInto, libs, "gdk_texture_downloader_download_into", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderFree, libs, "gdk_texture_downloader_free", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderGetColorState, libs, "gdk_texture_downloader_get_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xTextureDownloaderGetFormat, libs, "gdk_texture_downloader_get_format", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderGetTexture, libs, "gdk_texture_downloader_get_texture", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderSetColorState, libs, "gdk_texture_downloader_set_color_state", "GDK", "4.16")
+	core.PuregoRegisterSince(&xTextureDownloaderSetFormat, libs, "gdk_texture_downloader_set_format", "GDK", "4.10")
+	core.PuregoRegisterSince(&xTextureDownloaderSetTexture, libs, "gdk_texture_downloader_set_texture", "GDK", "4.10")
 
 }
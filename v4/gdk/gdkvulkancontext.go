@@ -4,7 +4,6 @@ package gdk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,7 +12,7 @@ import (
 
 var xVulkanErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gdk.VulkanContext] errors.
+// Registers an error quark for [VulkanContext] errors.
 func VulkanErrorQuark() glib.Quark {
 
 	cret := xVulkanErrorQuark()
@@ -23,11 +22,15 @@ func VulkanErrorQuark() glib.Quark {
 // Represents the platform-specific Vulkan draw context.
 //
 // `GdkVulkanContext`s are created for a surface using
-// [method@Gdk.Surface.create_vulkan_context], and the context will match
+// [Surface.CreateVulkanContext], and the context will match
 // the characteristics of the surface.
 //
 // Support for `GdkVulkanContext` is platform-specific and context creation
-// can fail, returning %NULL context.
+// can fail, returning nil context.
+//
+// Deprecated: since 4.14. GTK does not expose any Vulkan internals. This
+//
+//	struct is a leftover that was accidentally exposed.
 type VulkanContext struct {
 	DrawContext
 }
@@ -59,27 +62,49 @@ func (c *VulkanContext) SetGoPointer(ptr uintptr) {
 //
 // Usually this means that the swapchain had to be recreated,
 // for example in response to a change of the surface size.
+//
+// Deprecated: use ConnectImagesUpdatedFunc, which also accepts method values and closures.
 func (x *VulkanContext) ConnectImagesUpdated(cb *func(VulkanContext)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "images-updated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := VulkanContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "images-updated", cbRefPtr, cbPtr)
+}
+
+var xVulkanContextImagesUpdatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xVulkanContextImagesUpdatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VulkanContext))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VulkanContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "images-updated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectImagesUpdatedFunc connects cb to the "images-updated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VulkanContext) ConnectImagesUpdatedFunc(cb func(VulkanContext)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "images-updated", &xVulkanContextImagesUpdatedTrampoline, xVulkanContextImagesUpdatedNewTrampoline, cb)
 }
 
 func init() {
@@ -87,14 +112,14 @@ func init() {
 	core.SetSharedLibraries("GDK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVulkanErrorQuark, libs, "gdk_vulkan_error_quark")
+	core.PuregoRegisterSince(&xVulkanErrorQuark, libs, "gdk_vulkan_error_quark", "GDK", "")
 
 	core.PuregoSafeRegister(&xVulkanContextGLibType, libs, "gdk_vulkan_context_get_type")
 
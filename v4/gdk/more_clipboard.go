@@ -0,0 +1,136 @@
+package gdk
+
+import (
+	"errors"
+	"image"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// SetImage puts a copy of img on the clipboard.
+func (x *Clipboard) SetImage(img image.Image) {
+	texture := NewTextureFromImage(img)
+	defer texture.Unref()
+	x.SetTexture(texture)
+}
+
+// SetBytes puts data on the clipboard with the mime type, e.g. "text/uri-list" or "application/json".
+func (x *Clipboard) SetBytes(mimeType string, data []byte) bool {
+	provider := NewContentProviderForBytes(mimeType, data)
+	defer provider.Unref()
+	return x.SetContent(provider)
+}
+
+// clipboardReadyTrampoline is the single callback given to every read
+// the Go function is looked up by the user data such that only one callback is allocated
+var clipboardReadyTrampoline gio.AsyncReadyCallback = func(_ uintptr, result uintptr, id uintptr) {
+	if fn, ok := glib.TakeUserData[func(result *gio.AsyncResultBase)](id); ok {
+		fn(&gio.AsyncResultBase{Ptr: result})
+	}
+}
+
+// clipboardRead stores fn until the read finishes and returns the user data to pass to the read
+func clipboardRead(fn func(result *gio.AsyncResultBase)) uintptr {
+	return glib.RegisterUserData(fn)
+}
+
+// ReadTextGo reads the clipboard as text and calls fn with it on the main loop.
+func (x *Clipboard) ReadTextGo(cancellable *gio.Cancellable, fn func(text string, err error)) {
+	x.ReadTextAsync(cancellable, &clipboardReadyTrampoline, clipboardRead(func(result *gio.AsyncResultBase) {
+		fn(x.ReadTextFinish(result))
+	}))
+}
+
+// ClipboardText is the result of ReadTextChan.
+type ClipboardText struct {
+	Text string
+	Err  error
+}
+
+// ReadTextChan reads the clipboard as text and sends the result on the returned channel.
+// The main loop must run for the read to finish, so do not block on the channel on the main thread.
+func (x *Clipboard) ReadTextChan(cancellable *gio.Cancellable) <-chan ClipboardText {
+	ch := make(chan ClipboardText, 1)
+	x.ReadTextGo(cancellable, func(text string, err error) {
+		ch <- ClipboardText{Text: text, Err: err}
+	})
+	return ch
+}
+
+// ReadTextureGo reads an image from the clipboard and calls fn with it on the main loop.
+func (x *Clipboard) ReadTextureGo(cancellable *gio.Cancellable, fn func(texture *Texture, err error)) {
+	x.ReadTextureAsync(cancellable, &clipboardReadyTrampoline, clipboardRead(func(result *gio.AsyncResultBase) {
+		fn(x.ReadTextureFinish(result))
+	}))
+}
+
+// ReadImageGo reads an image from the clipboard and calls fn with a copy of its pixels on the main loop.
+func (x *Clipboard) ReadImageGo(cancellable *gio.Cancellable, fn func(img *image.NRGBA, err error)) {
+	x.ReadTextureGo(cancellable, func(texture *Texture, err error) {
+		if err != nil {
+			fn(nil, err)
+			return
+		}
+		defer texture.Unref()
+		data, stride := texture.DownloadBytesFormat(MemoryR8g8b8a8Value)
+		fn(&image.NRGBA{
+			Pix:    data,
+			Stride: stride,
+			Rect:   image.Rect(0, 0, texture.GetWidth(), texture.GetHeight()),
+		}, nil)
+	})
+}
+
+// xClipboardReadFinishMime is gdk_clipboard_read_finish registered with the mime type as a C string
+var xClipboardReadFinishMime func(uintptr, uintptr, *uintptr, **glib.Error) uintptr
+
+// ReadBytesGo reads the clipboard in the first of the mime types that is offered
+// and calls fn on the main loop with the data and the mime type that was read.
+func (x *Clipboard) ReadBytesGo(mimeTypes []string, cancellable *gio.Cancellable, fn func(data []byte, mimeType string, err error)) {
+	x.ReadAsync(mimeTypes, glib.PRIORITY_DEFAULT, cancellable, &clipboardReadyTrampoline, clipboardRead(func(result *gio.AsyncResultBase) {
+		var mime uintptr
+		var cerr *glib.Error
+		ptr := xClipboardReadFinishMime(x.GoPointer(), result.Ptr, &mime, &cerr)
+		if cerr != nil {
+			fn(nil, "", cerr)
+			return
+		}
+		if ptr == 0 {
+			fn(nil, "", errors.New("gdk: clipboard read failed"))
+			return
+		}
+		stream := &gio.InputStream{}
+		stream.Ptr = ptr
+		readStreamBytes(stream, core.GoString(mime), cancellable, fn)
+	}))
+}
+
+// readStreamBytes reads the stream to its end on the main loop and calls fn with the data, it takes the reference to the stream
+func readStreamBytes(stream *gio.InputStream, mimeType string, cancellable *gio.Cancellable, fn func(data []byte, mimeType string, err error)) {
+	out := gio.NewMemoryOutputStreamResizable()
+	flags := gio.GOutputStreamSpliceCloseSourceValue | gio.GOutputStreamSpliceCloseTargetValue
+	out.SpliceAsync(stream, flags, glib.PRIORITY_DEFAULT, cancellable, &clipboardReadyTrampoline, clipboardRead(func(result *gio.AsyncResultBase) {
+		defer stream.Unref()
+		defer out.Unref()
+		if _, err := out.SpliceFinish(result); err != nil {
+			fn(nil, mimeType, err)
+			return
+		}
+		fn(out.StealAsBytes(), mimeType, nil)
+	}))
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xClipboardReadFinishMime, libs, "gdk_clipboard_read_finish")
+}
@@ -0,0 +1,146 @@
+package gdk
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// DefaultClipboard returns the regular clipboard of the default display, or nil if no display is open.
+func DefaultClipboard() *Clipboard {
+	d := DisplayGetDefault()
+	if d == nil {
+		return nil
+	}
+	defer d.Unref()
+	c := d.GetClipboard()
+	// the display keeps the clipboard alive
+	c.Unref()
+	return c
+}
+
+// PrimaryClipboard returns the primary selection of the default display, or nil if no display is open.
+// The primary selection holds the text that was selected last and is pasted with the middle mouse button.
+// On backends without a primary selection, GDK emulates it within the application.
+func PrimaryClipboard() *Clipboard {
+	d := DisplayGetDefault()
+	if d == nil {
+		return nil
+	}
+	defer d.Unref()
+	c := d.GetPrimaryClipboard()
+	c.Unref()
+	return c
+}
+
+// WatchText calls fn on the main loop with the text on the clipboard whenever the contents change, and once for the current contents.
+// Contents that cannot be read as text are ignored. local is true if the application itself put the text on the clipboard.
+// If the contents change while the previous contents are read, only the newest text is passed to fn.
+// It returns the handler id to stop watching with DisconnectSignal.
+func (x *Clipboard) WatchText(fn func(text string, local bool)) uint {
+	// the number of the newest read, reads and changes both happen on the main loop
+	var seq uint64
+	read := func() {
+		formats := x.GetFormats()
+		if formats == nil || !formats.ContainGtype(gobject.TypeStringVal) {
+			return
+		}
+		seq++
+		cur := seq
+		local := x.IsLocal()
+		x.ReadTextGo(nil, func(text string, err error) {
+			if err != nil || cur != seq {
+				return
+			}
+			fn(text, local)
+		})
+	}
+	read()
+	return x.ConnectChangedFunc(func(Clipboard) {
+		read()
+	})
+}
+
+// ClipboardHistory remembers the texts that were put on a clipboard while the application runs, newest first,
+// e.g. for a paste history in an editor or terminal.
+type ClipboardHistory struct {
+	clipboard *Clipboard
+	size      int
+	handler   uint
+
+	mu       sync.Mutex
+	entries  []string
+	onChange func(entries []string)
+}
+
+// NewClipboardHistory starts recording the texts on the clipboard, keeping at most size entries.
+// A text that is already in the history moves to the front instead of being added again.
+// Use PrimaryClipboard to record the selections instead.
+func NewClipboardHistory(clipboard *Clipboard, size int) *ClipboardHistory {
+	h := &ClipboardHistory{clipboard: clipboard, size: size}
+	h.handler = clipboard.WatchText(func(text string, _ bool) {
+		if text != "" {
+			h.add(text)
+		}
+	})
+	return h
+}
+
+// add moves text to the front of the history
+func (h *ClipboardHistory) add(text string) {
+	h.mu.Lock()
+	entries := make([]string, 0, len(h.entries)+1)
+	entries = append(entries, text)
+	for _, e := range h.entries {
+		if e != text && len(entries) < h.size {
+			entries = append(entries, e)
+		}
+	}
+	changed := len(h.entries) == 0 || h.entries[0] != text
+	h.entries = entries
+	fn := h.onChange
+	h.mu.Unlock()
+	if changed && fn != nil {
+		fn(h.Entries())
+	}
+}
+
+// Entries returns the texts in the history, newest first.
+func (h *ClipboardHistory) Entries() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.entries...)
+}
+
+// OnChange calls fn with the entries whenever a text is added to the history or moves to its front.
+func (h *ClipboardHistory) OnChange(fn func(entries []string)) {
+	h.mu.Lock()
+	h.onChange = fn
+	h.mu.Unlock()
+}
+
+// Restore puts entry i of the history back on the clipboard, which moves it to the front.
+// It returns false if there is no such entry.
+func (h *ClipboardHistory) Restore(i int) bool {
+	h.mu.Lock()
+	if i < 0 || i >= len(h.entries) {
+		h.mu.Unlock()
+		return false
+	}
+	text := h.entries[i]
+	h.mu.Unlock()
+	h.clipboard.SetText(text)
+	return true
+}
+
+// Clear removes all entries from the history.
+func (h *ClipboardHistory) Clear() {
+	h.mu.Lock()
+	h.entries = nil
+	h.mu.Unlock()
+}
+
+// Close stops recording the clipboard.
+func (h *ClipboardHistory) Close() {
+	h.clipboard.DisconnectSignal(h.handler)
+}
@@ -0,0 +1,141 @@
+package gdk
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// MimeBytes is data of a mime type for NewContentProviderGo, e.g. MimeBytes{"application/json", data}.
+type MimeBytes struct {
+	MimeType string
+	Data     []byte
+}
+
+// NewContentProviderGo creates a content provider for drag sources or the clipboard from Go values.
+// The values are converted by their type:
+//
+//   - string is provided as text
+//   - []byte is provided as application/octet-stream, use MimeBytes for other mime types
+//   - *gio.FileBase and []*gio.FileBase are provided as file list, which includes text/uri-list
+//   - image.Image is provided as texture
+//   - *ContentProvider is used as is
+//   - any other object with a GoPointer method, e.g. *Texture or a widget, is provided as value of its GType
+//
+// Several values are offered together, e.g. a file list and its text for applications that only accept text.
+func NewContentProviderGo(values ...interface{}) (*ContentProvider, error) {
+	if len(values) == 0 {
+		return nil, errors.New("gdk: no content")
+	}
+	providers := make([]uintptr, 0, len(values))
+	for _, v := range values {
+		p, err := contentProviderGo(v)
+		if err != nil {
+			for _, ptr := range providers {
+				(&gobject.Object{Ptr: ptr}).Unref()
+			}
+			return nil, err
+		}
+		providers = append(providers, p.Ptr)
+	}
+	if len(providers) == 1 {
+		return ContentProviderNewFromInternalPtr(providers[0]), nil
+	}
+	// the union takes the references of the providers and copies the array
+	return NewContentProviderUnion(uintptr(unsafe.Pointer(&providers[0])), uint(len(providers))), nil
+}
+
+// contentValueProvider creates a provider for the value of type t that set initializes
+func contentValueProvider(t types.GType, set func(v *gobject.Value)) *ContentProvider {
+	var v gobject.Value
+	v.Init(t)
+	defer v.Unset()
+	set(&v)
+	return NewContentProviderForValue(&v)
+}
+
+func contentProviderGo(value interface{}) (*ContentProvider, error) {
+	switch v := value.(type) {
+	case *ContentProvider:
+		v.Ref()
+		return v, nil
+	case string:
+		return contentValueProvider(gobject.TypeStringVal, func(gv *gobject.Value) {
+			gv.SetString(&v)
+		}), nil
+	case []byte:
+		return contentProviderGo(MimeBytes{MimeType: "application/octet-stream", Data: v})
+	case MimeBytes:
+		return NewContentProviderForBytes(v.MimeType, v.Data), nil
+	case *gio.FileBase:
+		return contentProviderGo([]*gio.FileBase{v})
+	case []*gio.FileBase:
+		files := make([]uintptr, len(v)+1)
+		for i, f := range v {
+			files[i] = f.GoPointer()
+		}
+		list := NewFileListFromArray(uintptr(unsafe.Pointer(&files[0])), uint(len(v)))
+		return contentValueProvider(FileListGLibType(), func(gv *gobject.Value) {
+			gv.TakeBoxed(list.GoPointer())
+		}), nil
+	case image.Image:
+		texture := NewTextureFromImage(v)
+		defer texture.Unref()
+		return contentProviderGo(texture)
+	case interface{ GoPointer() uintptr }:
+		ptr := v.GoPointer()
+		if ptr == 0 {
+			return nil, errors.New("gdk: nil content")
+		}
+		instance := (*gobject.TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
+		return contentValueProvider(instance.GClass.GType, func(gv *gobject.Value) {
+			gv.SetObject(&gobject.Object{Ptr: ptr})
+		}), nil
+	}
+	return nil, fmt.Errorf("gdk: unsupported content type %T", value)
+}
+
+// xDropReadFinishMime is gdk_drop_read_finish registered with the mime type as a C string
+var xDropReadFinishMime func(uintptr, uintptr, *uintptr, **glib.Error) uintptr
+
+// ReadBytesGo reads the drop in the first of the mime types that is offered
+// and calls fn on the main loop with the data and the mime type that was read.
+// The drop must still be finished with Finish.
+func (x *Drop) ReadBytesGo(mimeTypes []string, cancellable *gio.Cancellable, fn func(data []byte, mimeType string, err error)) {
+	x.ReadAsync(mimeTypes, glib.PRIORITY_DEFAULT, cancellable, &clipboardReadyTrampoline, clipboardRead(func(result *gio.AsyncResultBase) {
+		var mime uintptr
+		var cerr *glib.Error
+		ptr := xDropReadFinishMime(x.GoPointer(), result.Ptr, &mime, &cerr)
+		if cerr != nil {
+			fn(nil, "", cerr)
+			return
+		}
+		if ptr == 0 {
+			fn(nil, "", errors.New("gdk: drop read failed"))
+			return
+		}
+		stream := &gio.InputStream{}
+		stream.Ptr = ptr
+		readStreamBytes(stream, core.GoString(mime), cancellable, fn)
+	}))
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xDropReadFinishMime, libs, "gdk_drop_read_finish")
+}
@@ -0,0 +1,256 @@
+package gdk
+
+// Downcast returns the event as its concrete type based on the event type,
+// e.g. a *KeyEvent for KeyPressValue and KeyReleaseValue.
+// The returned value shares the reference of x.
+func (x *Event) Downcast() interface{} {
+	switch x.GetEventType() {
+	case KeyPressValue, KeyReleaseValue:
+		return KeyEventNewFromInternalPtr(x.Ptr)
+	case ButtonPressValue, ButtonReleaseValue:
+		return ButtonEventNewFromInternalPtr(x.Ptr)
+	case ScrollValue:
+		return ScrollEventNewFromInternalPtr(x.Ptr)
+	case MotionNotifyValue:
+		return MotionEventNewFromInternalPtr(x.Ptr)
+	case EnterNotifyValue, LeaveNotifyValue:
+		return CrossingEventNewFromInternalPtr(x.Ptr)
+	case FocusChangeValue:
+		return FocusEventNewFromInternalPtr(x.Ptr)
+	case ProximityInValue, ProximityOutValue:
+		return ProximityEventNewFromInternalPtr(x.Ptr)
+	case DragEnterValue, DragLeaveValue, DragMotionValue, DropStartValue:
+		return DNDEventNewFromInternalPtr(x.Ptr)
+	case GrabBrokenValue:
+		return GrabBrokenEventNewFromInternalPtr(x.Ptr)
+	case TouchBeginValue, TouchUpdateValue, TouchEndValue, TouchCancelValue:
+		return TouchEventNewFromInternalPtr(x.Ptr)
+	case TouchpadSwipeValue, TouchpadPinchValue, TouchpadHoldValue:
+		return TouchpadEventNewFromInternalPtr(x.Ptr)
+	case PadButtonPressValue, PadButtonReleaseValue, PadRingValue, PadStripValue, PadGroupModeValue, PadDialValue:
+		return PadEventNewFromInternalPtr(x.Ptr)
+	case DeleteValue:
+		return DeleteEventNewFromInternalPtr(x.Ptr)
+	}
+	return x
+}
+
+// DecodedEvent is an event of which the fields are read into Go values, see (*Event).Decode.
+type DecodedEvent interface {
+	// Common returns the fields that all events have
+	Common() *EventData
+}
+
+// EventData are the fields that all events have.
+type EventData struct {
+	Type EventType
+	// Time is the timestamp of the event in milliseconds
+	Time uint32
+	// X and Y are the position of the event relative to the surface, HasPosition is false if the event has no position
+	X, Y        float64
+	HasPosition bool
+	Modifiers   ModifierType
+	// PointerEmulated is true for pointer events that are emulated from touch events
+	PointerEmulated bool
+}
+
+// Common returns the fields that all events have.
+func (d *EventData) Common() *EventData {
+	return d
+}
+
+// KeyEventData is a decoded key press or release.
+type KeyEventData struct {
+	EventData
+	Pressed           bool
+	Keyval            uint
+	Keycode           uint
+	Layout            uint
+	Level             uint
+	ConsumedModifiers ModifierType
+	IsModifier        bool
+}
+
+// ButtonEventData is a decoded button press or release.
+type ButtonEventData struct {
+	EventData
+	Pressed bool
+	Button  uint
+}
+
+// ScrollEventData is a decoded scroll event.
+// DeltaX and DeltaY are only set for ScrollSmoothValue.
+type ScrollEventData struct {
+	EventData
+	Direction      ScrollDirection
+	DeltaX, DeltaY float64
+	Unit           ScrollUnit
+	IsStop         bool
+}
+
+// MotionEventData is a decoded pointer motion.
+type MotionEventData struct {
+	EventData
+}
+
+// CrossingEventData is a decoded enter or leave event.
+type CrossingEventData struct {
+	EventData
+	Enter  bool
+	Mode   CrossingMode
+	Detail NotifyType
+	Focus  bool
+}
+
+// FocusEventData is a decoded keyboard focus change.
+type FocusEventData struct {
+	EventData
+	In bool
+}
+
+// TouchEventData is a decoded touch event.
+type TouchEventData struct {
+	EventData
+	EmulatingPointer bool
+}
+
+// TouchpadEventData is a decoded touchpad gesture.
+// PinchAngleDelta and PinchScale are only set for TouchpadPinchValue.
+type TouchpadEventData struct {
+	EventData
+	Phase           TouchpadGesturePhase
+	NFingers        uint
+	DeltaX, DeltaY  float64
+	PinchAngleDelta float64
+	PinchScale      float64
+}
+
+// PadEventData is a decoded tablet pad event.
+// Button is set for button events, Group and Mode for all pad events
+// and AxisIndex and AxisValue for ring, strip and dial events.
+type PadEventData struct {
+	EventData
+	Button    uint
+	Group     uint
+	Mode      uint
+	AxisIndex uint
+	AxisValue float64
+}
+
+// GrabBrokenEventData is a decoded broken grab.
+type GrabBrokenEventData struct {
+	EventData
+	Implicit bool
+}
+
+// Decode reads all fields of the event into a DecodedEvent based on the event type.
+// The concrete type is one of *KeyEventData, *ButtonEventData, *ScrollEventData, *MotionEventData,
+// *CrossingEventData, *FocusEventData, *TouchEventData, *TouchpadEventData, *PadEventData,
+// *GrabBrokenEventData or *EventData for events without specific fields.
+// The result does not reference the event, so it can be kept after the event is freed, e.g. for recording.
+func (x *Event) Decode() DecodedEvent {
+	t := x.GetEventType()
+	common := EventData{
+		Type:            t,
+		Time:            x.GetTime(),
+		Modifiers:       x.GetModifierState(),
+		PointerEmulated: x.GetPointerEmulated(),
+	}
+	common.HasPosition = x.GetPosition(&common.X, &common.Y)
+
+	switch e := x.Downcast().(type) {
+	case *KeyEvent:
+		return &KeyEventData{
+			EventData:         common,
+			Pressed:           t == KeyPressValue,
+			Keyval:            e.GetKeyval(),
+			Keycode:           e.GetKeycode(),
+			Layout:            e.GetLayout(),
+			Level:             e.GetLevel(),
+			ConsumedModifiers: e.GetConsumedModifiers(),
+			IsModifier:        e.IsModifier(),
+		}
+	case *ButtonEvent:
+		return &ButtonEventData{
+			EventData: common,
+			Pressed:   t == ButtonPressValue,
+			Button:    e.GetButton(),
+		}
+	case *ScrollEvent:
+		d := &ScrollEventData{
+			EventData: common,
+			Direction: e.GetDirection(),
+			Unit:      e.GetUnit(),
+			IsStop:    e.IsStop(),
+		}
+		if d.Direction == ScrollSmoothValue {
+			e.GetDeltas(&d.DeltaX, &d.DeltaY)
+		}
+		return d
+	case *MotionEvent:
+		return &MotionEventData{EventData: common}
+	case *CrossingEvent:
+		return &CrossingEventData{
+			EventData: common,
+			Enter:     t == EnterNotifyValue,
+			Mode:      e.GetMode(),
+			Detail:    e.GetDetail(),
+			Focus:     e.GetFocus(),
+		}
+	case *FocusEvent:
+		return &FocusEventData{
+			EventData: common,
+			In:        e.GetIn(),
+		}
+	case *TouchEvent:
+		return &TouchEventData{
+			EventData:        common,
+			EmulatingPointer: e.GetEmulatingPointer(),
+		}
+	case *TouchpadEvent:
+		d := &TouchpadEventData{
+			EventData: common,
+			Phase:     e.GetGesturePhase(),
+			NFingers:  e.GetNFingers(),
+		}
+		if t != TouchpadHoldValue {
+			e.GetDeltas(&d.DeltaX, &d.DeltaY)
+		}
+		if t == TouchpadPinchValue {
+			d.PinchAngleDelta = e.GetPinchAngleDelta()
+			d.PinchScale = e.GetPinchScale()
+		}
+		return d
+	case *PadEvent:
+		d := &PadEventData{EventData: common}
+		e.GetGroupMode(&d.Group, &d.Mode)
+		switch t {
+		case PadButtonPressValue, PadButtonReleaseValue:
+			d.Button = e.GetButton()
+		case PadRingValue, PadStripValue, PadDialValue:
+			e.GetAxisValue(&d.AxisIndex, &d.AxisValue)
+		}
+		return d
+	case *GrabBrokenEvent:
+		return &GrabBrokenEventData{
+			EventData: common,
+			Implicit:  e.GetImplicit(),
+		}
+	}
+	return &common
+}
+
+// KeyvalToRune returns the character that keyval types, or 0 if it has none, e.g. for the arrow keys.
+func KeyvalToRune(keyval uint) rune {
+	return rune(KeyvalToUnicode(keyval))
+}
+
+// RuneToKeyval returns the keyval that types r.
+func RuneToKeyval(r rune) uint {
+	return UnicodeToKeyval(uint32(r))
+}
+
+// Rune returns the character that the key types, or 0 if it has none.
+func (d *KeyEventData) Rune() rune {
+	return KeyvalToRune(d.Keyval)
+}
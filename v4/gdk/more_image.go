@@ -0,0 +1,71 @@
+package gdk
+
+import (
+	"image"
+	"image/draw"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// NewTextureFromImage creates a new texture with a copy of the pixels of img.
+// Images with a color model other than non premultiplied RGBA are converted.
+func NewTextureFromImage(img image.Image) *Texture {
+	b := img.Bounds()
+	n, ok := img.(*image.NRGBA)
+	if !ok || b.Min != (image.Point{}) {
+		n = image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(n, n.Bounds(), img, b.Min, draw.Src)
+	}
+	// the texture reads height rows of stride bytes, without the unused tail of a subimage
+	size := n.Stride*(n.Rect.Dy()-1) + 4*n.Rect.Dx()
+	if n.Rect.Empty() {
+		size = 0
+	}
+	return &NewMemoryTextureFromBytes(n.Rect.Dx(), n.Rect.Dy(), MemoryR8g8b8a8Value, n.Pix[:size], n.Stride).Texture
+}
+
+// NewMemoryTextureFromBytes creates a texture of width x height pixels in format
+// from a copy of data, in which rows start every stride bytes.
+// The copy is owned by the texture, so data can be modified or reused after the call.
+func NewMemoryTextureFromBytes(width, height int, format MemoryFormat, data []byte, stride int) *MemoryTexture {
+	bytes := glib.NewBytes(data, uint(len(data)))
+	defer bytes.Unref()
+	return NewMemoryTexture(width, height, format, bytes, uint(stride))
+}
+
+// DownloadBytes copies the pixels of the texture into Go memory
+// and returns them with the stride of a row in bytes and their format.
+// The pixels keep the format of the texture, except for textures with
+// multiplanar or subsampled formats such as YUV which are converted to MemoryR8g8b8a8PremultipliedValue.
+func (x *Texture) DownloadBytes() ([]byte, int, MemoryFormat) {
+	format := x.GetFormat()
+	if format >= MemoryG8B8r8420Value {
+		format = MemoryR8g8b8a8PremultipliedValue
+	}
+	data, stride := x.DownloadBytesFormat(format)
+	return data, stride, format
+}
+
+// DownloadBytesFormat copies the pixels of the texture converted to format into Go memory
+// and returns them with the stride of a row in bytes.
+// format must not be a multiplanar format.
+func (x *Texture) DownloadBytesFormat(format MemoryFormat) ([]byte, int) {
+	downloader := NewTextureDownloader(x)
+	defer downloader.Free()
+	downloader.SetFormat(format)
+
+	var stride uint
+	bytes := downloader.DownloadBytes(&stride)
+	defer bytes.Unref()
+	var size uint
+	ptr := bytes.GetData(&size)
+	if size == 0 {
+		return nil, int(stride)
+	}
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	src := unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), size)
+	data := make([]byte, size)
+	copy(data, src)
+	return data, int(stride)
+}
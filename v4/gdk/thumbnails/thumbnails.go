@@ -0,0 +1,318 @@
+// Package thumbnails looks up and generates thumbnails of image files in the thumbnail cache of the freedesktop.org
+// thumbnail specification, which file managers such as Nautilus share, for file browsers and galleries:
+//
+//	thumbnails.LoadAsync(path, thumbnails.Large, func(texture *gdk.Texture, err error) {
+//		if err != nil {
+//			return
+//		}
+//		picture.SetPaintable(texture)
+//		texture.Unref()
+//	})
+//
+// Thumbnails are generated with the image loaders of GdkPixbuf, which decode large images at the thumbnail size
+// where the format allows it, and are stored as PNG files in $XDG_CACHE_HOME/thumbnails with the URI and the
+// modification time of the file, such that they are generated again when the file changes.
+// See https://specifications.freedesktop.org/thumbnail-spec/latest/ for the specification.
+package thumbnails
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// Size is the largest width and height of a thumbnail in pixels, the specification defines a cache directory per size.
+type Size int
+
+const (
+	Normal  Size = 128
+	Large   Size = 256
+	XLarge  Size = 512
+	XXLarge Size = 1024
+)
+
+// dir returns the name of the cache directory of the size, sizes in between use the directory of the next larger size
+func (s Size) dir() string {
+	switch {
+	case s <= Normal:
+		return "normal"
+	case s <= Large:
+		return "large"
+	case s <= XLarge:
+		return "x-large"
+	}
+	return "xx-large"
+}
+
+// pixels returns the size of the thumbnails in the cache directory of the size
+func (s Size) pixels() int {
+	switch s.dir() {
+	case "normal":
+		return int(Normal)
+	case "large":
+		return int(Large)
+	case "x-large":
+		return int(XLarge)
+	}
+	return int(XXLarge)
+}
+
+// Software is stored in the thumbnails as the program that created them,
+// and names the directory of the failure cache, set it to the name and version of the application.
+var Software = "puregotk"
+
+// ErrFailed is returned when a thumbnail of the file could not be generated before and the file did not change since.
+var ErrFailed = errors.New("thumbnails: generating the thumbnail failed before")
+
+// ErrStale is returned by Lookup when the cached thumbnail belongs to an older version of the file.
+var ErrStale = errors.New("thumbnails: the thumbnail is out of date")
+
+// URI returns the URI of the local file path, which identifies the file in the cache.
+func URI(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	// GLib escapes the URI like the other implementations of the specification, the hash depends on it
+	return glib.FilenameToUri(abs, nil)
+}
+
+func cacheDir() string {
+	return filepath.Join(glib.GetUserCacheDir(), "thumbnails")
+}
+
+func fileName(uri string) string {
+	sum := md5.Sum([]byte(uri))
+	return hex.EncodeToString(sum[:]) + ".png"
+}
+
+// Path returns the path of the thumbnail of the file with uri in the cache, the thumbnail may not exist.
+func Path(uri string, size Size) string {
+	return filepath.Join(cacheDir(), size.dir(), fileName(uri))
+}
+
+// failPath returns the path of the file that records that the thumbnail of uri could not be generated
+func failPath(uri string) string {
+	return filepath.Join(cacheDir(), "fail", Software, fileName(uri))
+}
+
+// Lookup returns the cached thumbnail of the local file path.
+// It returns an error wrapping os.ErrNotExist if there is none, ErrStale if the file changed since and ErrFailed
+// if the thumbnail could not be generated before. It can be called from any goroutine.
+func Lookup(path string, size Size) (image.Image, error) {
+	uri, err := URI(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return lookup(uri, info, size)
+}
+
+func lookup(uri string, info os.FileInfo, size Size) (image.Image, error) {
+	mtime := strconv.FormatInt(info.ModTime().Unix(), 10)
+	data, err := os.ReadFile(Path(uri, size))
+	if err != nil {
+		if fail, ferr := os.ReadFile(failPath(uri)); ferr == nil && valid(textChunks(fail), uri, mtime) {
+			return nil, ErrFailed
+		}
+		return nil, err
+	}
+	if !valid(textChunks(data), uri, mtime) {
+		return nil, ErrStale
+	}
+	return png.Decode(bytes.NewReader(data))
+}
+
+// valid reports whether the text of a thumbnail belongs to the file with uri that was last modified at mtime
+func valid(text map[string]string, uri, mtime string) bool {
+	return text["Thumb::URI"] == uri && text["Thumb::MTime"] == mtime
+}
+
+// Generate creates the thumbnail of the local file path with the image loaders of GdkPixbuf,
+// stores it in the cache and returns it. Images that are smaller than the size are not scaled up.
+// If the file cannot be loaded, the failure is recorded so that Lookup and Load do not try again until the file changes.
+// It can be called from any goroutine.
+func Generate(path string, size Size) (image.Image, error) {
+	uri, err := URI(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return generate(path, uri, info, size)
+}
+
+func generate(path, uri string, info os.FileInfo, size Size) (image.Image, error) {
+	text := []string{
+		"Thumb::URI", uri,
+		"Thumb::MTime", strconv.FormatInt(info.ModTime().Unix(), 10),
+		"Thumb::Size", strconv.FormatInt(info.Size(), 10),
+		"Software", Software,
+	}
+	img, err := load(path, size.pixels())
+	if err != nil {
+		// an empty image with the text of the file marks the failure, as in other implementations
+		_ = store(failPath(uri), image.NewNRGBA(image.Rect(0, 0, 1, 1)), text)
+		return nil, err
+	}
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	text = append(text, "Thumb::Image::Width", strconv.Itoa(width), "Thumb::Image::Height", strconv.Itoa(height))
+	if err := store(Path(uri, size), img, text); err != nil {
+		return img, err
+	}
+	return img, nil
+}
+
+// load decodes the image scaled down to fit into size pixels, turned as its EXIF orientation says
+func load(path string, size int) (image.Image, error) {
+	var width, height int
+	if gdkpixbuf.PixbufGetFileInfo(path, &width, &height) == nil {
+		return nil, fmt.Errorf("thumbnails: %s is not an image that GdkPixbuf can load", path)
+	}
+	var pixbuf *gdkpixbuf.Pixbuf
+	var err error
+	if width <= size && height <= size {
+		pixbuf, err = gdkpixbuf.NewPixbufFromFile(path)
+	} else {
+		pixbuf, err = gdkpixbuf.NewPixbufFromFileAtScale(path, size, size, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer pixbuf.Unref()
+	if oriented := pixbuf.ApplyEmbeddedOrientation(); oriented != nil {
+		defer oriented.Unref()
+		pixbuf = oriented
+	}
+	img := pixbuf.ToImage()
+	if img == nil {
+		return nil, fmt.Errorf("thumbnails: %s has an unsupported pixel format", path)
+	}
+	return img, nil
+}
+
+// store writes the thumbnail with the text chunks, which are pairs of keys and values,
+// to a temporary file that is renamed, so other programs never read a partial thumbnail
+func store(path string, img image.Image, text []string) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	data := withText(buf.Bytes(), text)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".thumbnail-*.png")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// pngHeader is the length of the signature and the IHDR chunk, after which the text chunks are inserted
+const pngHeader = 8 + 4 + 4 + 13 + 4
+
+// withText returns the encoded PNG data with a tEXt chunk per key and value pair
+func withText(data []byte, text []string) []byte {
+	var out bytes.Buffer
+	out.Write(data[:pngHeader])
+	for i := 0; i+1 < len(text); i += 2 {
+		chunk := append([]byte("tEXt"+text[i]+"\x00"), text[i+1]...)
+		binary.Write(&out, binary.BigEndian, uint32(len(chunk)-4))
+		out.Write(chunk)
+		binary.Write(&out, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	}
+	out.Write(data[pngHeader:])
+	return out.Bytes()
+}
+
+// textChunks returns the keys and values of the tEXt chunks of the PNG data, which come before the image data
+func textChunks(data []byte) map[string]string {
+	text := make(map[string]string)
+	if len(data) < 8 {
+		return text
+	}
+	for p := 8; p+12 <= len(data); {
+		n := int(binary.BigEndian.Uint32(data[p:]))
+		typ := string(data[p+4 : p+8])
+		if typ == "IDAT" || n < 0 || p+12+n > len(data) {
+			break
+		}
+		if typ == "tEXt" {
+			if k, v, ok := bytes.Cut(data[p+8:p+8+n], []byte{0}); ok {
+				text[string(k)] = string(v)
+			}
+		}
+		p += 12 + n
+	}
+	return text
+}
+
+// Load returns the thumbnail of the local file path from the cache, and generates it if it is missing or stale.
+// It can be called from any goroutine, but blocks while the image is decoded, use LoadAsync in the main loop.
+func Load(path string, size Size) (image.Image, error) {
+	uri, err := URI(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	img, err := lookup(uri, info, size)
+	if err == nil || errors.Is(err, ErrFailed) {
+		return img, err
+	}
+	return generate(path, uri, info, size)
+}
+
+// workers limits the number of thumbnails that LoadAsync decodes at the same time,
+// e.g. when a gallery requests the thumbnails of a whole folder
+var workers = make(chan struct{}, runtime.NumCPU())
+
+// LoadAsync loads the thumbnail of the local file path like Load in a goroutine
+// and calls fn on the main loop with a texture of it, which fn releases with Unref.
+func LoadAsync(path string, size Size, fn func(texture *gdk.Texture, err error)) {
+	go func() {
+		workers <- struct{}{}
+		img, err := Load(path, size)
+		<-workers
+		done := glib.SourceOnceFunc(func(uintptr) {
+			if err != nil {
+				fn(nil, err)
+				return
+			}
+			fn(gdk.NewTextureFromImage(img), nil)
+		})
+		glib.IdleAddOnce(&done, 0)
+	}()
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,7 +13,7 @@ import (
 )
 
 // Modules supporting animations must derive a type from
-// #GdkPixbufAnimation, providing suitable implementations of the
+// [PixbufAnimation], providing suitable implementations of the
 // virtual functions.
 type PixbufAnimationClass struct {
 	_ structs.HostLayout
@@ -40,7 +39,8 @@ func (x *PixbufAnimationClass) OverrideIsStaticImage(cb func(*PixbufAnimation) b
 	if cb == nil {
 		x.xIsStaticImage = 0
 	} else {
-		x.xIsStaticImage = purego.NewCallback(func(AnimationVarp uintptr) bool {
+		x.xIsStaticImage = core.NewCallback(func(AnimationVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PixbufAnimationNewFromInternalPtr(AnimationVarp))
 		})
 	}
@@ -53,7 +53,7 @@ func (x *PixbufAnimationClass) GetIsStaticImage() func(*PixbufAnimation) bool {
 		return nil
 	}
 	var rawCallback func(AnimationVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsStaticImage)
+	core.RegisterFunc(&rawCallback, x.xIsStaticImage)
 	return func(AnimationVar *PixbufAnimation) bool {
 		return rawCallback(AnimationVar.GoPointer())
 	}
@@ -65,7 +65,8 @@ func (x *PixbufAnimationClass) OverrideGetStaticImage(cb func(*PixbufAnimation)
 	if cb == nil {
 		x.xGetStaticImage = 0
 	} else {
-		x.xGetStaticImage = purego.NewCallback(func(AnimationVarp uintptr) uintptr {
+		x.xGetStaticImage = core.NewCallback(func(AnimationVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(PixbufAnimationNewFromInternalPtr(AnimationVarp))
 			if ret == nil {
 				return 0
@@ -82,7 +83,7 @@ func (x *PixbufAnimationClass) GetGetStaticImage() func(*PixbufAnimation) *Pixbu
 		return nil
 	}
 	var rawCallback func(AnimationVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetStaticImage)
+	core.RegisterFunc(&rawCallback, x.xGetStaticImage)
 	return func(AnimationVar *PixbufAnimation) *Pixbuf {
 		rawRet := rawCallback(AnimationVar.GoPointer())
 		if rawRet == 0 {
@@ -95,25 +96,26 @@ func (x *PixbufAnimationClass) GetGetStaticImage() func(*PixbufAnimation) *Pixbu
 }
 
 // OverrideGetSize sets the "get_size" callback function.
-// fills @width and @height with the frame size of the animation.
+// fills width and height with the frame size of the animation.
 func (x *PixbufAnimationClass) OverrideGetSize(cb func(*PixbufAnimation, int, int)) {
 	if cb == nil {
 		x.xGetSize = 0
 	} else {
-		x.xGetSize = purego.NewCallback(func(AnimationVarp uintptr, WidthVarp int, HeightVarp int) {
+		x.xGetSize = core.NewCallback(func(AnimationVarp uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(PixbufAnimationNewFromInternalPtr(AnimationVarp), WidthVarp, HeightVarp)
 		})
 	}
 }
 
 // GetGetSize gets the "get_size" callback function.
-// fills @width and @height with the frame size of the animation.
+// fills width and height with the frame size of the animation.
 func (x *PixbufAnimationClass) GetGetSize() func(*PixbufAnimation, int, int) {
 	if x.xGetSize == 0 {
 		return nil
 	}
 	var rawCallback func(AnimationVarp uintptr, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xGetSize)
+	core.RegisterFunc(&rawCallback, x.xGetSize)
 	return func(AnimationVar *PixbufAnimation, WidthVar int, HeightVar int) {
 		rawCallback(AnimationVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -125,7 +127,8 @@ func (x *PixbufAnimationClass) OverrideGetIter(cb func(*PixbufAnimation, *glib.T
 	if cb == nil {
 		x.xGetIter = 0
 	} else {
-		x.xGetIter = purego.NewCallback(func(AnimationVarp uintptr, StartTimeVarp *glib.TimeVal) uintptr {
+		x.xGetIter = core.NewCallback(func(AnimationVarp uintptr, StartTimeVarp *glib.TimeVal) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(PixbufAnimationNewFromInternalPtr(AnimationVarp), StartTimeVarp)
 			if ret == nil {
 				return 0
@@ -142,7 +145,7 @@ func (x *PixbufAnimationClass) GetGetIter() func(*PixbufAnimation, *glib.TimeVal
 		return nil
 	}
 	var rawCallback func(AnimationVarp uintptr, StartTimeVarp *glib.TimeVal) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetIter)
+	core.RegisterFunc(&rawCallback, x.xGetIter)
 	return func(AnimationVar *PixbufAnimation, StartTimeVar *glib.TimeVal) *PixbufAnimationIter {
 		rawRet := rawCallback(AnimationVar.GoPointer(), StartTimeVar)
 		if rawRet == 0 {
@@ -155,7 +158,7 @@ func (x *PixbufAnimationClass) GetGetIter() func(*PixbufAnimation, *glib.TimeVal
 }
 
 // Modules supporting animations must derive a type from
-// #GdkPixbufAnimationIter, providing suitable implementations of the
+// [PixbufAnimationIter], providing suitable implementations of the
 // virtual functions.
 type PixbufAnimationIterClass struct {
 	_ structs.HostLayout
@@ -183,7 +186,8 @@ func (x *PixbufAnimationIterClass) OverrideGetDelayTime(cb func(*PixbufAnimation
 	if cb == nil {
 		x.xGetDelayTime = 0
 	} else {
-		x.xGetDelayTime = purego.NewCallback(func(IterVarp uintptr) int {
+		x.xGetDelayTime = core.NewCallback(func(IterVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(PixbufAnimationIterNewFromInternalPtr(IterVarp))
 		})
 	}
@@ -198,7 +202,7 @@ func (x *PixbufAnimationIterClass) GetGetDelayTime() func(*PixbufAnimationIter)
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetDelayTime)
+	core.RegisterFunc(&rawCallback, x.xGetDelayTime)
 	return func(IterVar *PixbufAnimationIter) int {
 		return rawCallback(IterVar.GoPointer())
 	}
@@ -210,7 +214,8 @@ func (x *PixbufAnimationIterClass) OverrideGetPixbuf(cb func(*PixbufAnimationIte
 	if cb == nil {
 		x.xGetPixbuf = 0
 	} else {
-		x.xGetPixbuf = purego.NewCallback(func(IterVarp uintptr) uintptr {
+		x.xGetPixbuf = core.NewCallback(func(IterVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(PixbufAnimationIterNewFromInternalPtr(IterVarp))
 			if ret == nil {
 				return 0
@@ -227,7 +232,7 @@ func (x *PixbufAnimationIterClass) GetGetPixbuf() func(*PixbufAnimationIter) *Pi
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetPixbuf)
+	core.RegisterFunc(&rawCallback, x.xGetPixbuf)
 	return func(IterVar *PixbufAnimationIter) *Pixbuf {
 		rawRet := rawCallback(IterVar.GoPointer())
 		if rawRet == 0 {
@@ -240,21 +245,22 @@ func (x *PixbufAnimationIterClass) GetGetPixbuf() func(*PixbufAnimationIter) *Pi
 }
 
 // OverrideOnCurrentlyLoadingFrame sets the "on_currently_loading_frame" callback function.
-// returns whether the current frame of @iter is
+// returns whether the current frame of iter is
 //
 //	being loaded.
 func (x *PixbufAnimationIterClass) OverrideOnCurrentlyLoadingFrame(cb func(*PixbufAnimationIter) bool) {
 	if cb == nil {
 		x.xOnCurrentlyLoadingFrame = 0
 	} else {
-		x.xOnCurrentlyLoadingFrame = purego.NewCallback(func(IterVarp uintptr) bool {
+		x.xOnCurrentlyLoadingFrame = core.NewCallback(func(IterVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PixbufAnimationIterNewFromInternalPtr(IterVarp))
 		})
 	}
 }
 
 // GetOnCurrentlyLoadingFrame gets the "on_currently_loading_frame" callback function.
-// returns whether the current frame of @iter is
+// returns whether the current frame of iter is
 //
 //	being loaded.
 func (x *PixbufAnimationIterClass) GetOnCurrentlyLoadingFrame() func(*PixbufAnimationIter) bool {
@@ -262,28 +268,29 @@ func (x *PixbufAnimationIterClass) GetOnCurrentlyLoadingFrame() func(*PixbufAnim
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xOnCurrentlyLoadingFrame)
+	core.RegisterFunc(&rawCallback, x.xOnCurrentlyLoadingFrame)
 	return func(IterVar *PixbufAnimationIter) bool {
 		return rawCallback(IterVar.GoPointer())
 	}
 }
 
 // OverrideAdvance sets the "advance" callback function.
-// advances the iterator to @current_time, possibly changing the
+// advances the iterator to current_time, possibly changing the
 //
 //	current frame.
 func (x *PixbufAnimationIterClass) OverrideAdvance(cb func(*PixbufAnimationIter, *glib.TimeVal) bool) {
 	if cb == nil {
 		x.xAdvance = 0
 	} else {
-		x.xAdvance = purego.NewCallback(func(IterVarp uintptr, CurrentTimeVarp *glib.TimeVal) bool {
+		x.xAdvance = core.NewCallback(func(IterVarp uintptr, CurrentTimeVarp *glib.TimeVal) bool {
+			defer core.RecoverPanic()
 			return cb(PixbufAnimationIterNewFromInternalPtr(IterVarp), CurrentTimeVarp)
 		})
 	}
 }
 
 // GetAdvance gets the "advance" callback function.
-// advances the iterator to @current_time, possibly changing the
+// advances the iterator to current_time, possibly changing the
 //
 //	current frame.
 func (x *PixbufAnimationIterClass) GetAdvance() func(*PixbufAnimationIter, *glib.TimeVal) bool {
@@ -291,7 +298,7 @@ func (x *PixbufAnimationIterClass) GetAdvance() func(*PixbufAnimationIter, *glib
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr, CurrentTimeVarp *glib.TimeVal) bool
-	purego.RegisterFunc(&rawCallback, x.xAdvance)
+	core.RegisterFunc(&rawCallback, x.xAdvance)
 	return func(IterVar *PixbufAnimationIter, CurrentTimeVar *glib.TimeVal) bool {
 		return rawCallback(IterVar.GoPointer(), CurrentTimeVar)
 	}
@@ -343,12 +350,15 @@ func NewPixbufAnimationFromFile(FilenameVar string) (*PixbufAnimation, error) {
 	cret := xNewPixbufAnimationFromFile(FilenameVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufAnimation{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -359,7 +369,7 @@ var xNewPixbufAnimationFromResource func(string, **glib.Error) uintptr
 // Creates a new pixbuf animation by loading an image from an resource.
 //
 // The file format is detected automatically. If `NULL` is returned, then
-// @error will be set.
+// error will be set.
 func NewPixbufAnimationFromResource(ResourcePathVar string) (*PixbufAnimation, error) {
 	var cls *PixbufAnimation
 	var cerr *glib.Error
@@ -367,12 +377,15 @@ func NewPixbufAnimationFromResource(ResourcePathVar string) (*PixbufAnimation, e
 	cret := xNewPixbufAnimationFromResource(ResourcePathVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufAnimation{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -384,9 +397,9 @@ var xNewPixbufAnimationFromStream func(uintptr, uintptr, **glib.Error) uintptr
 //
 // The file format is detected automatically.
 //
-// If `NULL` is returned, then @error will be set.
+// If `NULL` is returned, then error will be set.
 //
-// The @cancellable can be used to abort the operation from another thread.
+// The cancellable can be used to abort the operation from another thread.
 // If the operation was cancelled, the error `G_IO_ERROR_CANCELLED` will be
 // returned. Other possible errors are in the `GDK_PIXBUF_ERROR` and
 // `G_IO_ERROR` domains.
@@ -399,12 +412,15 @@ func NewPixbufAnimationFromStream(StreamVar *gio.InputStream, CancellableVar *gi
 	cret := xNewPixbufAnimationFromStream(StreamVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufAnimation{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -413,7 +429,7 @@ func NewPixbufAnimationFromStream(StreamVar *gio.InputStream, CancellableVar *gi
 var xNewPixbufAnimationFromStreamFinish func(uintptr, **glib.Error) uintptr
 
 // Finishes an asynchronous pixbuf animation creation operation started with
-// [func@GdkPixbuf.PixbufAnimation.new_from_stream_async].
+// [PixbufAnimationNewFromStreamAsync].
 func NewPixbufAnimationFromStreamFinish(AsyncResultVar gio.AsyncResult) (*PixbufAnimation, error) {
 	var cls *PixbufAnimation
 	var cerr *glib.Error
@@ -421,12 +437,15 @@ func NewPixbufAnimationFromStreamFinish(AsyncResultVar gio.AsyncResult) (*Pixbuf
 	cret := xNewPixbufAnimationFromStreamFinish(AsyncResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufAnimation{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -448,27 +467,27 @@ var xPixbufAnimationGetIter func(uintptr, *glib.TimeVal) uintptr
 // The iterator provides the frames that should be displayed at a
 // given time.
 //
-// @start_time would normally come from g_get_current_time(), and marks
+// start_time would normally come from [glib.GetCurrentTime], and marks
 // the beginning of animation playback. After creating an iterator, you
 // should immediately display the pixbuf returned by
-// gdk_pixbuf_animation_iter_get_pixbuf(). Then, you should install
-// a timeout (with g_timeout_add()) or by some other mechanism ensure
+// [PixbufAnimationIter.GetPixbuf]. Then, you should install
+// a timeout (with [glib.TimeoutAdd]) or by some other mechanism ensure
 // that you'll update the image after
-// gdk_pixbuf_animation_iter_get_delay_time() milliseconds. Each time
+// [PixbufAnimationIter.GetDelayTime] milliseconds. Each time
 // the image is updated, you should reinstall the timeout with the new,
 // possibly-changed delay time.
 //
-// As a shortcut, if @start_time is `NULL`, the result of
-// g_get_current_time() will be used automatically.
+// As a shortcut, if start_time is `NULL`, the result of
+// [glib.GetCurrentTime] will be used automatically.
 //
 // To update the image (i.e. possibly change the result of
-// gdk_pixbuf_animation_iter_get_pixbuf() to a new frame of the animation),
-// call gdk_pixbuf_animation_iter_advance().
+// [PixbufAnimationIter.GetPixbuf] to a new frame of the animation),
+// call [PixbufAnimationIter.Advance].
 //
-// If you're using #GdkPixbufLoader, in addition to updating the image
+// If you're using [PixbufLoader], in addition to updating the image
 // after the delay time, you should also update it whenever you
 // receive the area_updated signal and
-// gdk_pixbuf_animation_iter_on_currently_loading_frame() returns
+// [PixbufAnimationIter.OnCurrentlyLoadingFrame] returns
 // `TRUE`. In this case, the frame currently being fed into the loader
 // has received new data, so needs to be refreshed. The delay time for
 // a frame may also be modified after an area_updated signal, for
@@ -530,9 +549,9 @@ var xPixbufAnimationIsStaticImage func(uintptr) bool
 
 // Checks whether the animation is a static image.
 //
-// If you load a file with gdk_pixbuf_animation_new_from_file() and it
+// If you load a file with [NewPixbufAnimationFromFile] and it
 // turns out to be a plain, unanimated image, then this function will
-// return `TRUE`. Use gdk_pixbuf_animation_get_static_image() to retrieve
+// return `TRUE`. Use [PixbufAnimation.GetStaticImage] to retrieve
 // the image.
 func (x *PixbufAnimation) IsStaticImage() bool {
 
@@ -540,31 +559,6 @@ func (x *PixbufAnimation) IsStaticImage() bool {
 	return cret
 }
 
-var xPixbufAnimationRef func(uintptr) uintptr
-
-// Adds a reference to an animation.
-func (x *PixbufAnimation) Ref() *PixbufAnimation {
-	var cls *PixbufAnimation
-
-	cret := xPixbufAnimationRef(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &PixbufAnimation{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xPixbufAnimationUnref func(uintptr)
-
-// Removes a reference from an animation.
-func (x *PixbufAnimation) Unref() {
-
-	xPixbufAnimationUnref(x.GoPointer())
-
-}
-
 func (c *PixbufAnimation) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -580,11 +574,11 @@ var xPixbufAnimationNewFromStreamAsync func(uintptr, uintptr, uintptr, uintptr)
 
 // Creates a new animation by asynchronously loading an image from an input stream.
 //
-// For more details see gdk_pixbuf_new_from_stream(), which is the synchronous
+// For more details see [NewPixbufFromStream], which is the synchronous
 // version of this function.
 //
 // When the operation is finished, `callback` will be called in the main thread.
-// You can then call gdk_pixbuf_animation_new_from_stream_finish() to get the
+// You can then call [NewPixbufAnimationFromStreamFinish] to get the
 // result of the operation.
 func PixbufAnimationNewFromStreamAsync(StreamVar *gio.InputStream, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -595,11 +589,12 @@ func PixbufAnimationNewFromStreamAsync(StreamVar *gio.InputStream, CancellableVa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -630,23 +625,23 @@ var xPixbufAnimationIterAdvance func(uintptr, *glib.TimeVal) bool
 // Possibly advances an animation to a new frame.
 //
 // Chooses the frame based on the start time passed to
-// gdk_pixbuf_animation_get_iter().
+// [PixbufAnimation.GetIter].
 //
-// @current_time would normally come from g_get_current_time(), and
+// current_time would normally come from [glib.GetCurrentTime], and
 // must be greater than or equal to the time passed to
-// gdk_pixbuf_animation_get_iter(), and must increase or remain
-// unchanged each time gdk_pixbuf_animation_iter_get_pixbuf() is
+// [PixbufAnimation.GetIter], and must increase or remain
+// unchanged each time [PixbufAnimationIter.GetPixbuf] is
 // called. That is, you can't go backward in time; animations only
 // play forward.
 //
-// As a shortcut, pass `NULL` for the current time and g_get_current_time()
+// As a shortcut, pass `NULL` for the current time and [glib.GetCurrentTime]
 // will be invoked on your behalf. So you only need to explicitly pass
-// @current_time if you're doing something odd like playing the animation
+// current_time if you're doing something odd like playing the animation
 // at double speed.
 //
 // If this function returns `FALSE`, there's no need to update the animation
 // display, assuming the display had been rendered prior to advancing;
-// if `TRUE`, you need to call gdk_pixbuf_animation_iter_get_pixbuf()
+// if `TRUE`, you need to call [PixbufAnimationIter.GetPixbuf]
 // and update the display with the new pixbuf.
 func (x *PixbufAnimationIter) Advance(CurrentTimeVar *glib.TimeVal) bool {
 
@@ -659,7 +654,7 @@ var xPixbufAnimationIterGetDelayTime func(uintptr) int
 // Gets the number of milliseconds the current pixbuf should be displayed,
 // or -1 if the current pixbuf should be displayed forever.
 //
-// The `g_timeout_add()` function conveniently takes a timeout in milliseconds,
+// The `[glib.TimeoutAdd]` function conveniently takes a timeout in milliseconds,
 // so you can use a timeout to schedule the next update.
 //
 // Note that some formats, like GIF, might clamp the timeout values in the
@@ -676,15 +671,15 @@ var xPixbufAnimationIterGetPixbuf func(uintptr) uintptr
 // Gets the current pixbuf which should be displayed.
 //
 // The pixbuf might not be the same size as the animation itself
-// (gdk_pixbuf_animation_get_width(), gdk_pixbuf_animation_get_height()).
+// ([PixbufAnimation.GetWidth], [PixbufAnimation.GetHeight]).
 //
-// This pixbuf should be displayed for gdk_pixbuf_animation_iter_get_delay_time()
+// This pixbuf should be displayed for [PixbufAnimationIter.GetDelayTime]
 // milliseconds.
 //
 // The caller of this function does not own a reference to the returned
 // pixbuf; the returned pixbuf will become invalid when the iterator
 // advances to the next frame, which may happen anytime you call
-// gdk_pixbuf_animation_iter_advance().
+// [PixbufAnimationIter.Advance].
 //
 // Copy the pixbuf to keep it (don't just add a reference), as it may get
 // recycled as you advance the iterator.
@@ -705,7 +700,7 @@ func (x *PixbufAnimationIter) GetPixbuf() *Pixbuf {
 var xPixbufAnimationIterOnCurrentlyLoadingFrame func(uintptr) bool
 
 // Used to determine how to respond to the area_updated signal on
-// #GdkPixbufLoader when loading an animation.
+// [PixbufLoader] when loading an animation.
 //
 // The `::area_updated` signal is emitted for an area of the frame currently
 // streaming in to the loader. So if you're on the currently loading frame,
@@ -732,7 +727,7 @@ func init() {
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -741,26 +736,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xPixbufAnimationGLibType, libs, "gdk_pixbuf_animation_get_type")
 
-	core.PuregoSafeRegister(&xNewPixbufAnimationFromFile, libs, "gdk_pixbuf_animation_new_from_file")
-	core.PuregoSafeRegister(&xNewPixbufAnimationFromResource, libs, "gdk_pixbuf_animation_new_from_resource")
-	core.PuregoSafeRegister(&xNewPixbufAnimationFromStream, libs, "gdk_pixbuf_animation_new_from_stream")
-	core.PuregoSafeRegister(&xNewPixbufAnimationFromStreamFinish, libs, "gdk_pixbuf_animation_new_from_stream_finish")
+	gobject.CheckClassSize("GdkPixbufAnimationClass", xPixbufAnimationGLibType, unsafe.Sizeof(PixbufAnimationClass{}))
 
-	core.PuregoSafeRegister(&xPixbufAnimationGetHeight, libs, "gdk_pixbuf_animation_get_height")
-	core.PuregoSafeRegister(&xPixbufAnimationGetIter, libs, "gdk_pixbuf_animation_get_iter")
-	core.PuregoSafeRegister(&xPixbufAnimationGetStaticImage, libs, "gdk_pixbuf_animation_get_static_image")
-	core.PuregoSafeRegister(&xPixbufAnimationGetWidth, libs, "gdk_pixbuf_animation_get_width")
-	core.PuregoSafeRegister(&xPixbufAnimationIsStaticImage, libs, "gdk_pixbuf_animation_is_static_image")
-	core.PuregoSafeRegister(&xPixbufAnimationRef, libs, "gdk_pixbuf_animation_ref")
-	core.PuregoSafeRegister(&xPixbufAnimationUnref, libs, "gdk_pixbuf_animation_unref")
+	core.PuregoRegisterSince(&xNewPixbufAnimationFromFile, libs, "gdk_pixbuf_animation_new_from_file", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufAnimationFromResource, libs, "gdk_pixbuf_animation_new_from_resource", "GDKPIXBUF", "2.28")
+	core.PuregoRegisterSince(&xNewPixbufAnimationFromStream, libs, "gdk_pixbuf_animation_new_from_stream", "GDKPIXBUF", "2.28")
+	core.PuregoRegisterSince(&xNewPixbufAnimationFromStreamFinish, libs, "gdk_pixbuf_animation_new_from_stream_finish", "GDKPIXBUF", "2.28")
 
-	core.PuregoSafeRegister(&xPixbufAnimationNewFromStreamAsync, libs, "gdk_pixbuf_animation_new_from_stream_async")
+	core.PuregoRegisterSince(&xPixbufAnimationGetHeight, libs, "gdk_pixbuf_animation_get_height", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationGetIter, libs, "gdk_pixbuf_animation_get_iter", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationGetStaticImage, libs, "gdk_pixbuf_animation_get_static_image", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationGetWidth, libs, "gdk_pixbuf_animation_get_width", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationIsStaticImage, libs, "gdk_pixbuf_animation_is_static_image", "GDKPIXBUF", "")
+
+	core.PuregoRegisterSince(&xPixbufAnimationNewFromStreamAsync, libs, "gdk_pixbuf_animation_new_from_stream_async", "GDKPIXBUF", "2.28")
 
 	core.PuregoSafeRegister(&xPixbufAnimationIterGLibType, libs, "gdk_pixbuf_animation_iter_get_type")
 
-	core.PuregoSafeRegister(&xPixbufAnimationIterAdvance, libs, "gdk_pixbuf_animation_iter_advance")
-	core.PuregoSafeRegister(&xPixbufAnimationIterGetDelayTime, libs, "gdk_pixbuf_animation_iter_get_delay_time")
-	core.PuregoSafeRegister(&xPixbufAnimationIterGetPixbuf, libs, "gdk_pixbuf_animation_iter_get_pixbuf")
-	core.PuregoSafeRegister(&xPixbufAnimationIterOnCurrentlyLoadingFrame, libs, "gdk_pixbuf_animation_iter_on_currently_loading_frame")
+	gobject.CheckClassSize("GdkPixbufAnimationIterClass", xPixbufAnimationIterGLibType, unsafe.Sizeof(PixbufAnimationIterClass{}))
+
+	core.PuregoRegisterSince(&xPixbufAnimationIterAdvance, libs, "gdk_pixbuf_animation_iter_advance", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationIterGetDelayTime, libs, "gdk_pixbuf_animation_iter_get_delay_time", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationIterGetPixbuf, libs, "gdk_pixbuf_animation_iter_get_pixbuf", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationIterOnCurrentlyLoadingFrame, libs, "gdk_pixbuf_animation_iter_on_currently_loading_frame", "GDKPIXBUF", "")
 
 }
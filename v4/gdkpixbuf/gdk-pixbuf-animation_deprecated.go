@@ -0,0 +1,54 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdkpixbuf was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdkpixbuf
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xPixbufAnimationRef func(uintptr) uintptr
+
+// Adds a reference to an animation.
+//
+// Deprecated: since 2.0. Use [gobject.Object.Ref].
+func (x *PixbufAnimation) Ref() *PixbufAnimation {
+	var cls *PixbufAnimation
+
+	cret := xPixbufAnimationRef(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &PixbufAnimation{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPixbufAnimationUnref func(uintptr)
+
+// Removes a reference from an animation.
+//
+// Deprecated: since 2.0. Use [gobject.Object.Unref].
+func (x *PixbufAnimation) Unref() {
+
+	xPixbufAnimationUnref(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GDKPIXBUF", "gdk-pixbuf-2.0")
+	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDKPIXBUF") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xPixbufAnimationRef, libs, "gdk_pixbuf_animation_ref", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufAnimationUnref, libs, "gdk_pixbuf_animation_unref", "GDKPIXBUF", "")
+
+}
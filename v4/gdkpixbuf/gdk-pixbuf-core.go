@@ -2,6 +2,9 @@
 package gdkpixbuf
 
 import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -9,19 +12,19 @@ import (
 // A function of this type is responsible for freeing the pixel array
 // of a pixbuf.
 //
-// The gdk_pixbuf_new_from_data() function lets you pass in a pre-allocated
+// The [NewPixbufFromData] function lets you pass in a pre-allocated
 // pixel array so that a pixbuf can be created from it; in this case you
 // will need to pass in a function of type `GdkPixbufDestroyNotify` so that
 // the pixel data can be freed when the pixbuf is finalized.
 type PixbufDestroyNotify func([]byte, uintptr)
 
-// Save functions used by [method@GdkPixbuf.Pixbuf.save_to_callback].
+// Save functions used by [Pixbuf.SaveToCallback].
 //
 // This function is called once for each block of bytes that is "written"
-// by `gdk_pixbuf_save_to_callback()`.
+// by `[Pixbuf.SaveToCallback]`.
 //
 // If successful it should return `TRUE`; if an error occurs it should set
-// `error` and return `FALSE`, in which case `gdk_pixbuf_save_to_callback()`
+// `error` and return `FALSE`, in which case `[Pixbuf.SaveToCallback]`
 // will fail with the same error.
 type PixbufSaveFunc func([]byte, uint, **glib.Error, uintptr) bool
 
@@ -43,6 +46,33 @@ const (
 	GdkColorspaceRgbValue Colorspace = 0
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Colorspace) String() string {
+	switch x {
+	case GdkColorspaceRgbValue:
+		return "GDK_COLORSPACE_RGB"
+	}
+	return fmt.Sprintf("Colorspace(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Colorspace) Nick() string {
+	switch x {
+	case GdkColorspaceRgbValue:
+		return "rgb"
+	}
+	return ""
+}
+
+// ColorspaceFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ColorspaceFromString(s string) (Colorspace, bool) {
+	switch s {
+	case "GDK_COLORSPACE_RGB", "rgb":
+		return GdkColorspaceRgbValue, true
+	}
+	return 0, false
+}
+
 // Control the alpha channel for drawables.
 //
 // These values can be passed to gdk_pixbuf_xlib_render_to_drawable_alpha()
@@ -55,6 +85,11 @@ const (
 // In the future, when the X Window System gets an alpha channel extension,
 // it will be possible to do full alpha compositing onto arbitrary drawables.
 // For now both cases fall back to a bilevel clipping mask.
+//
+// Deprecated: since 2.42. There is no user of GdkPixbufAlphaMode in GdkPixbuf,
+//
+//	and the Xlib utility functions have been split out to their own
+//	library, gdk-pixbuf-xlib
 type PixbufAlphaMode int
 
 var xPixbufAlphaModeGLibType func() types.GType
@@ -70,11 +105,44 @@ const (
 	//  will be considered fully transparent, and all others will be
 	//  considered fully opaque.
 	GdkPixbufAlphaBilevelValue PixbufAlphaMode = 0
-	// For now falls back to #GDK_PIXBUF_ALPHA_BILEVEL.
+	// For now falls back to GDK_PIXBUF_ALPHA_BILEVEL.
 	//  In the future it will do full alpha compositing.
 	GdkPixbufAlphaFullValue PixbufAlphaMode = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PixbufAlphaMode) String() string {
+	switch x {
+	case GdkPixbufAlphaBilevelValue:
+		return "GDK_PIXBUF_ALPHA_BILEVEL"
+	case GdkPixbufAlphaFullValue:
+		return "GDK_PIXBUF_ALPHA_FULL"
+	}
+	return fmt.Sprintf("PixbufAlphaMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PixbufAlphaMode) Nick() string {
+	switch x {
+	case GdkPixbufAlphaBilevelValue:
+		return "bilevel"
+	case GdkPixbufAlphaFullValue:
+		return "full"
+	}
+	return ""
+}
+
+// PixbufAlphaModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PixbufAlphaModeFromString(s string) (PixbufAlphaMode, bool) {
+	switch s {
+	case "GDK_PIXBUF_ALPHA_BILEVEL", "bilevel":
+		return GdkPixbufAlphaBilevelValue, true
+	case "GDK_PIXBUF_ALPHA_FULL", "full":
+		return GdkPixbufAlphaFullValue, true
+	}
+	return 0, false
+}
+
 // An error code in the `GDK_PIXBUF_ERROR` domain.
 //
 // Many gdk-pixbuf operations can cause errors in this domain, or in
@@ -105,3 +173,112 @@ const (
 	// Only part of the animation was loaded.
 	GdkPixbufErrorIncompleteAnimationValue PixbufError = 6
 )
+
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (PixbufError) ErrorDomain() string {
+	return "gdk-pixbuf-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x PixbufError) Error() string {
+	switch x {
+	case GdkPixbufErrorCorruptImageValue:
+		return "GDK_PIXBUF_ERROR_CORRUPT_IMAGE"
+	case GdkPixbufErrorInsufficientMemoryValue:
+		return "GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY"
+	case GdkPixbufErrorBadOptionValue:
+		return "GDK_PIXBUF_ERROR_BAD_OPTION"
+	case GdkPixbufErrorUnknownTypeValue:
+		return "GDK_PIXBUF_ERROR_UNKNOWN_TYPE"
+	case GdkPixbufErrorUnsupportedOperationValue:
+		return "GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION"
+	case GdkPixbufErrorFailedValue:
+		return "GDK_PIXBUF_ERROR_FAILED"
+	case GdkPixbufErrorIncompleteAnimationValue:
+		return "GDK_PIXBUF_ERROR_INCOMPLETE_ANIMATION"
+	}
+	return fmt.Sprintf("gdk-pixbuf-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x PixbufError) String() string {
+	switch x {
+	case GdkPixbufErrorCorruptImageValue:
+		return "GDK_PIXBUF_ERROR_CORRUPT_IMAGE"
+	case GdkPixbufErrorInsufficientMemoryValue:
+		return "GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY"
+	case GdkPixbufErrorBadOptionValue:
+		return "GDK_PIXBUF_ERROR_BAD_OPTION"
+	case GdkPixbufErrorUnknownTypeValue:
+		return "GDK_PIXBUF_ERROR_UNKNOWN_TYPE"
+	case GdkPixbufErrorUnsupportedOperationValue:
+		return "GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION"
+	case GdkPixbufErrorFailedValue:
+		return "GDK_PIXBUF_ERROR_FAILED"
+	case GdkPixbufErrorIncompleteAnimationValue:
+		return "GDK_PIXBUF_ERROR_INCOMPLETE_ANIMATION"
+	}
+	return fmt.Sprintf("PixbufError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PixbufError) Nick() string {
+	switch x {
+	case GdkPixbufErrorCorruptImageValue:
+		return "corrupt-image"
+	case GdkPixbufErrorInsufficientMemoryValue:
+		return "insufficient-memory"
+	case GdkPixbufErrorBadOptionValue:
+		return "bad-option"
+	case GdkPixbufErrorUnknownTypeValue:
+		return "unknown-type"
+	case GdkPixbufErrorUnsupportedOperationValue:
+		return "unsupported-operation"
+	case GdkPixbufErrorFailedValue:
+		return "failed"
+	case GdkPixbufErrorIncompleteAnimationValue:
+		return "incomplete-animation"
+	}
+	return ""
+}
+
+// PixbufErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PixbufErrorFromString(s string) (PixbufError, bool) {
+	switch s {
+	case "GDK_PIXBUF_ERROR_CORRUPT_IMAGE", "corrupt-image":
+		return GdkPixbufErrorCorruptImageValue, true
+	case "GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY", "insufficient-memory":
+		return GdkPixbufErrorInsufficientMemoryValue, true
+	case "GDK_PIXBUF_ERROR_BAD_OPTION", "bad-option":
+		return GdkPixbufErrorBadOptionValue, true
+	case "GDK_PIXBUF_ERROR_UNKNOWN_TYPE", "unknown-type":
+		return GdkPixbufErrorUnknownTypeValue, true
+	case "GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION", "unsupported-operation":
+		return GdkPixbufErrorUnsupportedOperationValue, true
+	case "GDK_PIXBUF_ERROR_FAILED", "failed":
+		return GdkPixbufErrorFailedValue, true
+	case "GDK_PIXBUF_ERROR_INCOMPLETE_ANIMATION", "incomplete-animation":
+		return GdkPixbufErrorIncompleteAnimationValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("GDKPIXBUF", "gdk-pixbuf-2.0")
+	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDKPIXBUF") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xColorspaceGLibType, libs, "gdk_colorspace_get_type")
+
+	core.PuregoSafeRegister(&xPixbufAlphaModeGLibType, libs, "gdk_pixbuf_alpha_mode_get_type")
+
+	core.PuregoSafeRegister(&xPixbufErrorGLibType, libs, "gdk_pixbuf_error_get_type")
+
+}
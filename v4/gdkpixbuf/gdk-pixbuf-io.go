@@ -2,10 +2,11 @@
 package gdkpixbuf
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gmodule"
@@ -19,16 +20,16 @@ import (
 //
 // The image loader should set up an internal state object, and return it
 // from this function; the state object will then be updated from the
-// [callback@GdkPixbuf.PixbufModuleIncrementLoadFunc] callback, and will be freed
-// by [callback@GdkPixbuf.PixbufModuleStopLoadFunc] callback.
+// [PixbufModuleIncrementLoadFunc] callback, and will be freed
+// by [PixbufModuleStopLoadFunc] callback.
 type PixbufModuleBeginLoadFunc func(uintptr, uintptr, uintptr, uintptr, **glib.Error) uintptr
 
 // Defines the type of the function used to fill a
-// #GdkPixbufFormat structure with information about a module.
+// [PixbufFormat] structure with information about a module.
 type PixbufModuleFillInfoFunc func(*PixbufFormat)
 
 // Defines the type of the function used to set the vtable of a
-// #GdkPixbufModule when it is loaded.
+// [PixbufModule] when it is loaded.
 type PixbufModuleFillVtableFunc func(*PixbufModule)
 
 // Incrementally loads a buffer into the image data.
@@ -48,10 +49,10 @@ type PixbufModuleLoadFunc func(uintptr, **glib.Error) uintptr
 type PixbufModuleLoadXpmDataFunc func([]string) uintptr
 
 // Defines the type of the function that gets called once the initial
-// setup of @pixbuf is done.
+// setup of pixbuf is done.
 //
-// #GdkPixbufLoader uses a function of this type to emit the
-// "&lt;link linkend="GdkPixbufLoader-area-prepared"&gt;area_prepared&lt;/link&gt;"
+// [PixbufLoader] uses a function of this type to emit the
+// "<link linkend="GdkPixbufLoader-area-prepared">area_prepared</link>"
 // signal.
 type PixbufModulePreparedFunc func(uintptr, uintptr, uintptr)
 
@@ -75,15 +76,15 @@ type PixbufModuleSaveOptionSupportedFunc func(string) bool
 // Defines the type of the function that gets called once the size
 // of the loaded image is known.
 //
-// The function is expected to set @width and @height to the desired
+// The function is expected to set width and height to the desired
 // size to which the image should be scaled. If a module has no efficient
 // way to achieve the desired scaling during the loading of the image, it may
 // either ignore the size request, or only approximate it - gdk-pixbuf will
 // then perform the required scaling on the completely loaded image.
 //
-// If the function sets @width or @height to zero, the module should interpret
+// If the function sets width or height to zero, the module should interpret
 // this as a hint that it will be closed soon and shouldn't allocate further
-// resources. This convention is used to implement gdk_pixbuf_get_file_info()
+// resources. This convention is used to implement [PixbufGetFileInfo]
 // efficiently.
 type PixbufModuleSizeFunc func(int, int, uintptr)
 
@@ -93,10 +94,10 @@ type PixbufModuleSizeFunc func(int, int, uintptr)
 type PixbufModuleStopLoadFunc func(uintptr, **glib.Error) bool
 
 // Defines the type of the function that gets called every time a region
-// of @pixbuf is updated.
+// of pixbuf is updated.
 //
-// #GdkPixbufLoader uses a function of this type to emit the
-// "&lt;link linkend="GdkPixbufLoader-area-updated"&gt;area_updated&lt;/link&gt;"
+// [PixbufLoader] uses a function of this type to emit the
+// "<link linkend="GdkPixbufLoader-area-updated">area_updated</link>"
 // signal.
 type PixbufModuleUpdatedFunc func(uintptr, int, int, int, int, uintptr)
 
@@ -149,7 +150,7 @@ func (x *PixbufFormat) Copy() *PixbufFormat {
 var xPixbufFormatFree func(uintptr)
 
 // Frees the resources allocated when copying a `GdkPixbufFormat`
-// using gdk_pixbuf_format_copy()
+// using [PixbufFormat.Copy]
 func (x *PixbufFormat) Free() {
 
 	xPixbufFormatFree(x.GoPointer())
@@ -165,14 +166,14 @@ func (x *PixbufFormat) GetDescription() string {
 	return cret
 }
 
-var xPixbufFormatGetExtensions func(uintptr) []string
+var xPixbufFormatGetExtensions func(uintptr) uintptr
 
 // Returns the filename extensions typically used for files in the
 // given format.
 func (x *PixbufFormat) GetExtensions() []string {
 
 	cret := xPixbufFormatGetExtensions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xPixbufFormatGetLicense func(uintptr) string
@@ -187,13 +188,13 @@ func (x *PixbufFormat) GetLicense() string {
 	return cret
 }
 
-var xPixbufFormatGetMimeTypes func(uintptr) []string
+var xPixbufFormatGetMimeTypes func(uintptr) uintptr
 
 // Returns the mime types supported by the format.
 func (x *PixbufFormat) GetMimeTypes() []string {
 
 	cret := xPixbufFormatGetMimeTypes(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xPixbufFormatGetName func(uintptr) string
@@ -209,7 +210,7 @@ var xPixbufFormatIsDisabled func(uintptr) bool
 
 // Returns whether this image format is disabled.
 //
-// See gdk_pixbuf_format_set_disabled().
+// See [PixbufFormat.SetDisabled].
 func (x *PixbufFormat) IsDisabled() bool {
 
 	cret := xPixbufFormatIsDisabled(x.GoPointer())
@@ -218,10 +219,10 @@ func (x *PixbufFormat) IsDisabled() bool {
 
 var xPixbufFormatIsSaveOptionSupported func(uintptr, string) bool
 
-// Returns `TRUE` if the save option specified by @option_key is supported when
-// saving a pixbuf using the module implementing @format.
+// Returns `TRUE` if the save option specified by option_key is supported when
+// saving a pixbuf using the module implementing format.
 //
-// See gdk_pixbuf_save() for more information about option keys.
+// See [Pixbuf.Save] for more information about option keys.
 func (x *PixbufFormat) IsSaveOptionSupported(OptionKeyVar string) bool {
 
 	cret := xPixbufFormatIsSaveOptionSupported(x.GoPointer(), OptionKeyVar)
@@ -258,7 +259,7 @@ var xPixbufFormatSetDisabled func(uintptr, bool)
 // this format to load images.
 //
 // Applications can use this to avoid using image loaders with an
-// inappropriate license, see gdk_pixbuf_format_get_license().
+// inappropriate license, see [PixbufFormat.GetLicense].
 func (x *PixbufFormat) SetDisabled(DisabledVar bool) {
 
 	xPixbufFormatSetDisabled(x.GoPointer(), DisabledVar)
@@ -272,7 +273,7 @@ func (x *PixbufFormat) SetDisabled(DisabledVar bool) {
 // by modules which can load (and perhaps also save) new image and animation
 // formats.
 //
-// ## Implementing modules
+// # Implementing modules
 //
 // The `GdkPixbuf` interfaces needed for implementing modules are contained in
 // `gdk-pixbuf-io.h` (and `gdk-pixbuf-animation.h` if the module supports
@@ -300,7 +301,7 @@ func (x *PixbufFormat) SetDisabled(DisabledVar bool) {
 // `load_increment`), it doesn't have to implement `load`, since `GdkPixbuf`
 // can supply a generic `load` implementation wrapping the incremental loading.
 //
-// ## Installing modules
+// # Installing modules
 //
 // Installing a module is a two-step process:
 //
@@ -357,7 +358,8 @@ func (x *PixbufModule) OverrideReserved1(cb func()) {
 	if cb == nil {
 		x.xReserved1 = 0
 	} else {
-		x.xReserved1 = purego.NewCallback(func() {
+		x.xReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -369,7 +371,7 @@ func (x *PixbufModule) GetReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved1)
+	core.RegisterFunc(&rawCallback, x.xReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -380,7 +382,8 @@ func (x *PixbufModule) OverrideReserved2(cb func()) {
 	if cb == nil {
 		x.xReserved2 = 0
 	} else {
-		x.xReserved2 = purego.NewCallback(func() {
+		x.xReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -392,7 +395,7 @@ func (x *PixbufModule) GetReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved2)
+	core.RegisterFunc(&rawCallback, x.xReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -403,7 +406,8 @@ func (x *PixbufModule) OverrideReserved3(cb func()) {
 	if cb == nil {
 		x.xReserved3 = 0
 	} else {
-		x.xReserved3 = purego.NewCallback(func() {
+		x.xReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -415,7 +419,7 @@ func (x *PixbufModule) GetReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved3)
+	core.RegisterFunc(&rawCallback, x.xReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -426,7 +430,8 @@ func (x *PixbufModule) OverrideReserved4(cb func()) {
 	if cb == nil {
 		x.xReserved4 = 0
 	} else {
-		x.xReserved4 = purego.NewCallback(func() {
+		x.xReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -438,7 +443,7 @@ func (x *PixbufModule) GetReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved4)
+	core.RegisterFunc(&rawCallback, x.xReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -464,16 +469,12 @@ func (x *PixbufModule) GetReserved4() func() {
 // `GdkPixbufModulePatterns`. The array is terminated by a pattern
 // where the `prefix` is `NULL`.
 //
-// ```c
-//
 //	GdkPixbufModulePattern *signature[] = {
 //	  { "abcdx", " !x z", 100 },
 //	  { "bla", NULL,  90 },
 //	  { NULL, NULL, 0 }
 //	};
 //
-// ```
-//
 // In the example above, the signature matches e.g. "auud\0" with
 // relevance 100, and "blau" with relevance 90.
 type PixbufModulePattern struct {
@@ -492,7 +493,7 @@ func (x *PixbufModulePattern) GoPointer() uintptr {
 
 // Flags which allow a module to specify further details about the supported
 // operations.
-type PixbufFormatFlags int
+type PixbufFormatFlags uint32
 
 const (
 
@@ -505,12 +506,52 @@ const (
 	GdkPixbufFormatThreadsafeValue PixbufFormatFlags = 4
 )
 
+// Has reports whether all bits of flags are set in x
+func (x PixbufFormatFlags) Has(flags PixbufFormatFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PixbufFormatFlags) Set(flags PixbufFormatFlags) PixbufFormatFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PixbufFormatFlags) Clear(flags PixbufFormatFlags) PixbufFormatFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PixbufFormatFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GdkPixbufFormatWritableValue != 0 && x&GdkPixbufFormatWritableValue == GdkPixbufFormatWritableValue {
+		names = append(names, "GDK_PIXBUF_FORMAT_WRITABLE")
+		rest &^= GdkPixbufFormatWritableValue
+	}
+	if rest&GdkPixbufFormatScalableValue != 0 && x&GdkPixbufFormatScalableValue == GdkPixbufFormatScalableValue {
+		names = append(names, "GDK_PIXBUF_FORMAT_SCALABLE")
+		rest &^= GdkPixbufFormatScalableValue
+	}
+	if rest&GdkPixbufFormatThreadsafeValue != 0 && x&GdkPixbufFormatThreadsafeValue == GdkPixbufFormatThreadsafeValue {
+		names = append(names, "GDK_PIXBUF_FORMAT_THREADSAFE")
+		rest &^= GdkPixbufFormatThreadsafeValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 func init() {
 	core.SetPackageName("GDKPIXBUF", "gdk-pixbuf-2.0")
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -519,17 +560,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xPixbufFormatGLibType, libs, "gdk_pixbuf_format_get_type")
 
-	core.PuregoSafeRegister(&xPixbufFormatCopy, libs, "gdk_pixbuf_format_copy")
-	core.PuregoSafeRegister(&xPixbufFormatFree, libs, "gdk_pixbuf_format_free")
-	core.PuregoSafeRegister(&xPixbufFormatGetDescription, libs, "gdk_pixbuf_format_get_description")
-	core.PuregoSafeRegister(&xPixbufFormatGetExtensions, libs, "gdk_pixbuf_format_get_extensions")
-	core.PuregoSafeRegister(&xPixbufFormatGetLicense, libs, "gdk_pixbuf_format_get_license")
-	core.PuregoSafeRegister(&xPixbufFormatGetMimeTypes, libs, "gdk_pixbuf_format_get_mime_types")
-	core.PuregoSafeRegister(&xPixbufFormatGetName, libs, "gdk_pixbuf_format_get_name")
-	core.PuregoSafeRegister(&xPixbufFormatIsDisabled, libs, "gdk_pixbuf_format_is_disabled")
-	core.PuregoSafeRegister(&xPixbufFormatIsSaveOptionSupported, libs, "gdk_pixbuf_format_is_save_option_supported")
-	core.PuregoSafeRegister(&xPixbufFormatIsScalable, libs, "gdk_pixbuf_format_is_scalable")
-	core.PuregoSafeRegister(&xPixbufFormatIsWritable, libs, "gdk_pixbuf_format_is_writable")
-	core.PuregoSafeRegister(&xPixbufFormatSetDisabled, libs, "gdk_pixbuf_format_set_disabled")
+	core.PuregoRegisterSince(&xPixbufFormatCopy, libs, "gdk_pixbuf_format_copy", "GDKPIXBUF", "2.22")
+	core.PuregoRegisterSince(&xPixbufFormatFree, libs, "gdk_pixbuf_format_free", "GDKPIXBUF", "2.22")
+	core.PuregoRegisterSince(&xPixbufFormatGetDescription, libs, "gdk_pixbuf_format_get_description", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufFormatGetExtensions, libs, "gdk_pixbuf_format_get_extensions", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufFormatGetLicense, libs, "gdk_pixbuf_format_get_license", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xPixbufFormatGetMimeTypes, libs, "gdk_pixbuf_format_get_mime_types", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufFormatGetName, libs, "gdk_pixbuf_format_get_name", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufFormatIsDisabled, libs, "gdk_pixbuf_format_is_disabled", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xPixbufFormatIsSaveOptionSupported, libs, "gdk_pixbuf_format_is_save_option_supported", "GDKPIXBUF", "2.36")
+	core.PuregoRegisterSince(&xPixbufFormatIsScalable, libs, "gdk_pixbuf_format_is_scalable", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xPixbufFormatIsWritable, libs, "gdk_pixbuf_format_is_writable", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufFormatSetDisabled, libs, "gdk_pixbuf_format_set_disabled", "GDKPIXBUF", "2.6")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -35,7 +34,8 @@ func (x *PixbufLoaderClass) OverrideSizePrepared(cb func(*PixbufLoader, int, int
 	if cb == nil {
 		x.xSizePrepared = 0
 	} else {
-		x.xSizePrepared = purego.NewCallback(func(LoaderVarp uintptr, WidthVarp int, HeightVarp int) {
+		x.xSizePrepared = core.NewCallback(func(LoaderVarp uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(PixbufLoaderNewFromInternalPtr(LoaderVarp), WidthVarp, HeightVarp)
 		})
 	}
@@ -47,7 +47,7 @@ func (x *PixbufLoaderClass) GetSizePrepared() func(*PixbufLoader, int, int) {
 		return nil
 	}
 	var rawCallback func(LoaderVarp uintptr, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xSizePrepared)
+	core.RegisterFunc(&rawCallback, x.xSizePrepared)
 	return func(LoaderVar *PixbufLoader, WidthVar int, HeightVar int) {
 		rawCallback(LoaderVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -58,7 +58,8 @@ func (x *PixbufLoaderClass) OverrideAreaPrepared(cb func(*PixbufLoader)) {
 	if cb == nil {
 		x.xAreaPrepared = 0
 	} else {
-		x.xAreaPrepared = purego.NewCallback(func(LoaderVarp uintptr) {
+		x.xAreaPrepared = core.NewCallback(func(LoaderVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PixbufLoaderNewFromInternalPtr(LoaderVarp))
 		})
 	}
@@ -70,7 +71,7 @@ func (x *PixbufLoaderClass) GetAreaPrepared() func(*PixbufLoader) {
 		return nil
 	}
 	var rawCallback func(LoaderVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAreaPrepared)
+	core.RegisterFunc(&rawCallback, x.xAreaPrepared)
 	return func(LoaderVar *PixbufLoader) {
 		rawCallback(LoaderVar.GoPointer())
 	}
@@ -81,7 +82,8 @@ func (x *PixbufLoaderClass) OverrideAreaUpdated(cb func(*PixbufLoader, int, int,
 	if cb == nil {
 		x.xAreaUpdated = 0
 	} else {
-		x.xAreaUpdated = purego.NewCallback(func(LoaderVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+		x.xAreaUpdated = core.NewCallback(func(LoaderVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(PixbufLoaderNewFromInternalPtr(LoaderVarp), XVarp, YVarp, WidthVarp, HeightVarp)
 		})
 	}
@@ -93,7 +95,7 @@ func (x *PixbufLoaderClass) GetAreaUpdated() func(*PixbufLoader, int, int, int,
 		return nil
 	}
 	var rawCallback func(LoaderVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xAreaUpdated)
+	core.RegisterFunc(&rawCallback, x.xAreaUpdated)
 	return func(LoaderVar *PixbufLoader, XVar int, YVar int, WidthVar int, HeightVar int) {
 		rawCallback(LoaderVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
 	}
@@ -104,7 +106,8 @@ func (x *PixbufLoaderClass) OverrideClosed(cb func(*PixbufLoader)) {
 	if cb == nil {
 		x.xClosed = 0
 	} else {
-		x.xClosed = purego.NewCallback(func(LoaderVarp uintptr) {
+		x.xClosed = core.NewCallback(func(LoaderVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PixbufLoaderNewFromInternalPtr(LoaderVarp))
 		})
 	}
@@ -116,7 +119,7 @@ func (x *PixbufLoaderClass) GetClosed() func(*PixbufLoader) {
 		return nil
 	}
 	var rawCallback func(LoaderVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClosed)
+	core.RegisterFunc(&rawCallback, x.xClosed)
 	return func(LoaderVar *PixbufLoader) {
 		rawCallback(LoaderVar.GoPointer())
 	}
@@ -128,44 +131,44 @@ func (x *PixbufLoaderClass) GetClosed() func(*PixbufLoader) {
 // process of loading an image, by letting them send the image data
 // directly to the loader instead of having the loader read the data
 // from a file. Applications can use this functionality instead of
-// `gdk_pixbuf_new_from_file()` or `gdk_pixbuf_animation_new_from_file()`
+// `[NewPixbufFromFile]` or `[NewPixbufAnimationFromFile]`
 // when they need to parse image data in small chunks. For example,
 // it should be used when reading an image from a (potentially) slow
 // network connection, or when loading an extremely large file.
 //
 // To use `GdkPixbufLoader` to load an image, create a new instance,
-// and call [method@GdkPixbuf.PixbufLoader.write] to send the data
-// to it. When done, [method@GdkPixbuf.PixbufLoader.close] should be
+// and call [PixbufLoader.Write] to send the data
+// to it. When done, [PixbufLoader.Close] should be
 // called to end the stream and finalize everything.
 //
 // The loader will emit three important signals throughout the process:
 //
-//   - [signal@GdkPixbuf.PixbufLoader::size-prepared] will be emitted as
+//   - [PixbufLoader.ConnectSizePreparedFunc] will be emitted as
 //     soon as the image has enough information to determine the size of
 //     the image to be used. If you want to scale the image while loading
-//     it, you can call [method@GdkPixbuf.PixbufLoader.set_size] in
+//     it, you can call [PixbufLoader.SetSize] in
 //     response to this signal.
-//   - [signal@GdkPixbuf.PixbufLoader::area-prepared] will be emitted as
+//   - [PixbufLoader.ConnectAreaPreparedFunc] will be emitted as
 //     soon as the pixbuf of the desired has been allocated. You can obtain
-//     the `GdkPixbuf` instance by calling [method@GdkPixbuf.PixbufLoader.get_pixbuf].
+//     the `GdkPixbuf` instance by calling [PixbufLoader.GetPixbuf].
 //     If you want to use it, simply acquire a reference to it. You can
-//     also call `gdk_pixbuf_loader_get_pixbuf()` later to get the same
+//     also call `[PixbufLoader.GetPixbuf]` later to get the same
 //     pixbuf.
-//   - [signal@GdkPixbuf.PixbufLoader::area-updated] will be emitted every
+//   - [PixbufLoader.ConnectAreaUpdatedFunc] will be emitted every
 //     time a region is updated. This way you can update a partially
 //     completed image. Note that you do not know anything about the
 //     completeness of an image from the updated area. For example, in an
 //     interlaced image you will need to make several passes before the
 //     image is done loading.
 //
-// ## Loading an animation
+// # Loading an animation
 //
 // Loading an animation is almost as easy as loading an image. Once the
-// first [signal@GdkPixbuf.PixbufLoader::area-prepared] signal has been
-// emitted, you can call [method@GdkPixbuf.PixbufLoader.get_animation] to
-// get the [class@GdkPixbuf.PixbufAnimation] instance, and then call
-// and [method@GdkPixbuf.PixbufAnimation.get_iter] to get a
-// [class@GdkPixbuf.PixbufAnimationIter] to retrieve the pixbuf for the
+// first [PixbufLoader.ConnectAreaPreparedFunc] signal has been
+// emitted, you can call [PixbufLoader.GetAnimation] to
+// get the [PixbufAnimation] instance, and then call
+// and [PixbufAnimation.GetIter] to get a
+// [PixbufAnimationIter] to retrieve the pixbuf for the
 // desired time stamp.
 type PixbufLoader struct {
 	gobject.Object
@@ -202,7 +205,7 @@ func NewPixbufLoader() *PixbufLoader {
 var xNewPixbufLoaderWithMimeType func(string, **glib.Error) uintptr
 
 // Creates a new pixbuf loader object that always attempts to parse
-// image data as if it were an image of MIME type @mime_type, instead of
+// image data as if it were an image of MIME type mime_type, instead of
 // identifying the type automatically.
 //
 // This function is useful if you want an error if the image isn't the
@@ -214,8 +217,8 @@ var xNewPixbufLoaderWithMimeType func(string, **glib.Error) uintptr
 // are installed, but typically "image/png", "image/jpeg", "image/gif",
 // "image/tiff" and "image/x-xpixmap" are among the supported mime types.
 // To obtain the full list of supported mime types, call
-// gdk_pixbuf_format_get_mime_types() on each of the #GdkPixbufFormat
-// structs returned by gdk_pixbuf_get_formats().
+// [PixbufFormat.GetMimeTypes] on each of the [PixbufFormat]
+// structs returned by [PixbufGetFormats].
 func NewPixbufLoaderWithMimeType(MimeTypeVar string) (*PixbufLoader, error) {
 	var cls *PixbufLoader
 	var cerr *glib.Error
@@ -223,12 +226,15 @@ func NewPixbufLoaderWithMimeType(MimeTypeVar string) (*PixbufLoader, error) {
 	cret := xNewPixbufLoaderWithMimeType(MimeTypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufLoader{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -237,7 +243,7 @@ func NewPixbufLoaderWithMimeType(MimeTypeVar string) (*PixbufLoader, error) {
 var xNewPixbufLoaderWithType func(string, **glib.Error) uintptr
 
 // Creates a new pixbuf loader object that always attempts to parse
-// image data as if it were an image of type @image_type, instead of
+// image data as if it were an image of type image_type, instead of
 // identifying the type automatically.
 //
 // This function is useful if you want an error if the image isn't the
@@ -248,8 +254,8 @@ var xNewPixbufLoaderWithType func(string, **glib.Error) uintptr
 // The list of supported image formats depends on what image loaders
 // are installed, but typically "png", "jpeg", "gif", "tiff" and
 // "xpm" are among the supported formats. To obtain the full list of
-// supported image formats, call gdk_pixbuf_format_get_name() on each
-// of the #GdkPixbufFormat structs returned by gdk_pixbuf_get_formats().
+// supported image formats, call [PixbufFormat.GetName] on each
+// of the [PixbufFormat] structs returned by [PixbufGetFormats].
 func NewPixbufLoaderWithType(ImageTypeVar string) (*PixbufLoader, error) {
 	var cls *PixbufLoader
 	var cerr *glib.Error
@@ -257,12 +263,15 @@ func NewPixbufLoaderWithType(ImageTypeVar string) (*PixbufLoader, error) {
 	cret := xNewPixbufLoaderWithType(ImageTypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PixbufLoader{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -271,7 +280,7 @@ func NewPixbufLoaderWithType(ImageTypeVar string) (*PixbufLoader, error) {
 var xPixbufLoaderClose func(uintptr) bool
 
 // Informs a pixbuf loader that no further writes with
-// gdk_pixbuf_loader_write() will occur, so that it can free its
+// [PixbufLoader.Write] will occur, so that it can free its
 // internal loading structures.
 //
 // This function also tries to parse any data that hasn't yet been parsed;
@@ -290,7 +299,7 @@ func (x *PixbufLoader) Close() (bool, error) {
 
 	cret := xPixbufLoaderClose(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -298,10 +307,10 @@ func (x *PixbufLoader) Close() (bool, error) {
 
 var xPixbufLoaderGetAnimation func(uintptr) uintptr
 
-// Queries the #GdkPixbufAnimation that a pixbuf loader is currently creating.
+// Queries the [PixbufAnimation] that a pixbuf loader is currently creating.
 //
 // In general it only makes sense to call this function after the
-// [signal@GdkPixbuf.PixbufLoader::area-prepared] signal has been emitted by
+// [PixbufLoader.ConnectAreaPreparedFunc] signal has been emitted by
 // the loader.
 //
 // If the loader doesn't have enough bytes yet, and hasn't emitted the `area-prepared`
@@ -332,21 +341,21 @@ func (x *PixbufLoader) GetFormat() *PixbufFormat {
 
 var xPixbufLoaderGetPixbuf func(uintptr) uintptr
 
-// Queries the #GdkPixbuf that a pixbuf loader is currently creating.
+// Queries the [Pixbuf] that a pixbuf loader is currently creating.
 //
 // In general it only makes sense to call this function after the
-// [signal@GdkPixbuf.PixbufLoader::area-prepared] signal has been
+// [PixbufLoader.ConnectAreaPreparedFunc] signal has been
 // emitted by the loader; this means that enough data has been read
 // to know the size of the image that will be allocated.
 //
-// If the loader has not received enough data via gdk_pixbuf_loader_write(),
+// If the loader has not received enough data via [PixbufLoader.Write],
 // then this function returns `NULL`.
 //
 // The returned pixbuf will be the same in all future calls to the loader,
 // so if you want to keep using it, you should acquire a reference to it.
 //
 // Additionally, if the loader is an animation, it will return the "static
-// image" of the animation (see gdk_pixbuf_animation_get_static_image()).
+// image" of the animation (see [PixbufAnimation.GetStaticImage]).
 func (x *PixbufLoader) GetPixbuf() *Pixbuf {
 	var cls *Pixbuf
 
@@ -366,7 +375,7 @@ var xPixbufLoaderSetSize func(uintptr, int, int)
 // Causes the image to be scaled while it is loaded.
 //
 // The desired image size can be determined relative to the original
-// size of the image by calling gdk_pixbuf_loader_set_size() from a
+// size of the image by calling [PixbufLoader.SetSize] from a
 // signal handler for the ::size-prepared signal.
 //
 // Attempts to set the desired image size  are ignored after the
@@ -385,7 +394,7 @@ func (x *PixbufLoader) Write(BufVar []byte, CountVar uint) (bool, error) {
 
 	cret := xPixbufLoaderWrite(x.GoPointer(), BufVar, CountVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -399,7 +408,7 @@ func (x *PixbufLoader) WriteBytes(BufferVar *glib.Bytes) (bool, error) {
 
 	cret := xPixbufLoaderWriteBytes(x.GoPointer(), BufferVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -420,29 +429,51 @@ func (c *PixbufLoader) SetGoPointer(ptr uintptr) {
 // pixbuf in the desired size.
 //
 // After this signal is emitted, applications can call
-// gdk_pixbuf_loader_get_pixbuf() to fetch the partially-loaded
+// [PixbufLoader.GetPixbuf] to fetch the partially-loaded
 // pixbuf.
+//
+// Deprecated: use ConnectAreaPreparedFunc, which also accepts method values and closures.
 func (x *PixbufLoader) ConnectAreaPrepared(cb *func(PixbufLoader)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "area-prepared", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := PixbufLoader{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "area-prepared", cbRefPtr, cbPtr)
+}
+
+var xPixbufLoaderAreaPreparedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xPixbufLoaderAreaPreparedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PixbufLoader))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PixbufLoader{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "area-prepared", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAreaPreparedFunc connects cb to the "area-prepared" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PixbufLoader) ConnectAreaPreparedFunc(cb func(PixbufLoader)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "area-prepared", &xPixbufLoaderAreaPreparedTrampoline, xPixbufLoaderAreaPreparedNewTrampoline, cb)
 }
 
 // This signal is emitted when a significant area of the image being
@@ -453,85 +484,151 @@ func (x *PixbufLoader) ConnectAreaPrepared(cb *func(PixbufLoader)) uint {
 //
 // Applications can use this signal to know when to repaint
 // areas of an image that is being loaded.
+//
+// Deprecated: use ConnectAreaUpdatedFunc, which also accepts method values and closures.
 func (x *PixbufLoader) ConnectAreaUpdated(cb *func(PixbufLoader, int, int, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "area-updated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
+			fa := PixbufLoader{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp, WidthVarp, HeightVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "area-updated", cbRefPtr, cbPtr)
+}
+
+var xPixbufLoaderAreaUpdatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+func xPixbufLoaderAreaUpdatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PixbufLoader, int, int, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PixbufLoader{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp, WidthVarp, HeightVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "area-updated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// This signal is emitted when gdk_pixbuf_loader_close() is called.
+// ConnectAreaUpdatedFunc connects cb to the "area-updated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PixbufLoader) ConnectAreaUpdatedFunc(cb func(PixbufLoader, int, int, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "area-updated", &xPixbufLoaderAreaUpdatedTrampoline, xPixbufLoaderAreaUpdatedNewTrampoline, cb)
+}
+
+// This signal is emitted when [PixbufLoader.Close] is called.
 //
 // It can be used by different parts of an application to receive
 // notification when an image loader is closed by the code that
 // drives it.
+//
+// Deprecated: use ConnectClosedFunc, which also accepts method values and closures.
 func (x *PixbufLoader) ConnectClosed(cb *func(PixbufLoader)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := PixbufLoader{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "closed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xPixbufLoaderClosedTrampoline uintptr
+
+func xPixbufLoaderClosedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PixbufLoader))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PixbufLoader{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClosedFunc connects cb to the "closed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PixbufLoader) ConnectClosedFunc(cb func(PixbufLoader)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "closed", &xPixbufLoaderClosedTrampoline, xPixbufLoaderClosedNewTrampoline, cb)
 }
 
 // This signal is emitted when the pixbuf loader has been fed the
 // initial amount of data that is required to figure out the size
 // of the image that it will create.
 //
-// Applications can call gdk_pixbuf_loader_set_size() in response
+// Applications can call [PixbufLoader.SetSize] in response
 // to this signal to set the desired size to which the image
 // should be scaled.
+//
+// Deprecated: use ConnectSizePreparedFunc, which also accepts method values and closures.
 func (x *PixbufLoader) ConnectSizePrepared(cb *func(PixbufLoader, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "size-prepared", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
+			fa := PixbufLoader{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidthVarp, HeightVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "size-prepared", cbRefPtr, cbPtr)
+}
+
+var xPixbufLoaderSizePreparedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+func xPixbufLoaderSizePreparedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidthVarp int, HeightVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PixbufLoader, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PixbufLoader{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidthVarp, HeightVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "size-prepared", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSizePreparedFunc connects cb to the "size-prepared" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PixbufLoader) ConnectSizePreparedFunc(cb func(PixbufLoader, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "size-prepared", &xPixbufLoaderSizePreparedTrampoline, xPixbufLoaderSizePreparedNewTrampoline, cb)
 }
 
 func init() {
@@ -539,7 +636,7 @@ func init() {
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -548,16 +645,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xPixbufLoaderGLibType, libs, "gdk_pixbuf_loader_get_type")
 
-	core.PuregoSafeRegister(&xNewPixbufLoader, libs, "gdk_pixbuf_loader_new")
-	core.PuregoSafeRegister(&xNewPixbufLoaderWithMimeType, libs, "gdk_pixbuf_loader_new_with_mime_type")
-	core.PuregoSafeRegister(&xNewPixbufLoaderWithType, libs, "gdk_pixbuf_loader_new_with_type")
-
-	core.PuregoSafeRegister(&xPixbufLoaderClose, libs, "gdk_pixbuf_loader_close")
-	core.PuregoSafeRegister(&xPixbufLoaderGetAnimation, libs, "gdk_pixbuf_loader_get_animation")
-	core.PuregoSafeRegister(&xPixbufLoaderGetFormat, libs, "gdk_pixbuf_loader_get_format")
-	core.PuregoSafeRegister(&xPixbufLoaderGetPixbuf, libs, "gdk_pixbuf_loader_get_pixbuf")
-	core.PuregoSafeRegister(&xPixbufLoaderSetSize, libs, "gdk_pixbuf_loader_set_size")
-	core.PuregoSafeRegister(&xPixbufLoaderWrite, libs, "gdk_pixbuf_loader_write")
-	core.PuregoSafeRegister(&xPixbufLoaderWriteBytes, libs, "gdk_pixbuf_loader_write_bytes")
+	gobject.CheckClassSize("GdkPixbufLoaderClass", xPixbufLoaderGLibType, unsafe.Sizeof(PixbufLoaderClass{}))
+
+	core.PuregoRegisterSince(&xNewPixbufLoader, libs, "gdk_pixbuf_loader_new", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufLoaderWithMimeType, libs, "gdk_pixbuf_loader_new_with_mime_type", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xNewPixbufLoaderWithType, libs, "gdk_pixbuf_loader_new_with_type", "GDKPIXBUF", "")
+
+	core.PuregoRegisterSince(&xPixbufLoaderClose, libs, "gdk_pixbuf_loader_close", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufLoaderGetAnimation, libs, "gdk_pixbuf_loader_get_animation", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufLoaderGetFormat, libs, "gdk_pixbuf_loader_get_format", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufLoaderGetPixbuf, libs, "gdk_pixbuf_loader_get_pixbuf", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufLoaderSetSize, libs, "gdk_pixbuf_loader_set_size", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufLoaderWrite, libs, "gdk_pixbuf_loader_write", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufLoaderWriteBytes, libs, "gdk_pixbuf_loader_write_bytes", "GDKPIXBUF", "2.30")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -54,7 +53,7 @@ func NewPixbufSimpleAnim(WidthVar int, HeightVar int, RateVar float32) *PixbufSi
 
 var xPixbufSimpleAnimAddFrame func(uintptr, uintptr)
 
-// Adds a new frame to @animation. The @pixbuf must
+// Adds a new frame to animation. The pixbuf must
 // have the dimensions specified when the animation
 // was constructed.
 func (x *PixbufSimpleAnim) AddFrame(PixbufVar *Pixbuf) {
@@ -65,7 +64,7 @@ func (x *PixbufSimpleAnim) AddFrame(PixbufVar *Pixbuf) {
 
 var xPixbufSimpleAnimGetLoop func(uintptr) bool
 
-// Gets whether @animation should loop indefinitely when it reaches the end.
+// Gets whether animation should loop indefinitely when it reaches the end.
 func (x *PixbufSimpleAnim) GetLoop() bool {
 
 	cret := xPixbufSimpleAnimGetLoop(x.GoPointer())
@@ -74,7 +73,7 @@ func (x *PixbufSimpleAnim) GetLoop() bool {
 
 var xPixbufSimpleAnimSetLoop func(uintptr, bool)
 
-// Sets whether @animation should loop indefinitely when it reaches the end.
+// Sets whether animation should loop indefinitely when it reaches the end.
 func (x *PixbufSimpleAnim) SetLoop(LoopVar bool) {
 
 	xPixbufSimpleAnimSetLoop(x.GoPointer(), LoopVar)
@@ -114,7 +113,7 @@ func init() {
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -123,10 +122,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xPixbufSimpleAnimGLibType, libs, "gdk_pixbuf_simple_anim_get_type")
 
-	core.PuregoSafeRegister(&xNewPixbufSimpleAnim, libs, "gdk_pixbuf_simple_anim_new")
+	core.PuregoRegisterSince(&xNewPixbufSimpleAnim, libs, "gdk_pixbuf_simple_anim_new", "GDKPIXBUF", "2.8")
 
-	core.PuregoSafeRegister(&xPixbufSimpleAnimAddFrame, libs, "gdk_pixbuf_simple_anim_add_frame")
-	core.PuregoSafeRegister(&xPixbufSimpleAnimGetLoop, libs, "gdk_pixbuf_simple_anim_get_loop")
-	core.PuregoSafeRegister(&xPixbufSimpleAnimSetLoop, libs, "gdk_pixbuf_simple_anim_set_loop")
+	core.PuregoRegisterSince(&xPixbufSimpleAnimAddFrame, libs, "gdk_pixbuf_simple_anim_add_frame", "GDKPIXBUF", "2.8")
+	core.PuregoRegisterSince(&xPixbufSimpleAnimGetLoop, libs, "gdk_pixbuf_simple_anim_get_loop", "GDKPIXBUF", "2.18")
+	core.PuregoRegisterSince(&xPixbufSimpleAnimSetLoop, libs, "gdk_pixbuf_simple_anim_set_loop", "GDKPIXBUF", "2.18")
 
 }
@@ -1,7 +1,12 @@
 // Package gdkpixbuf was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gdkpixbuf
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 // Interpolation modes for scaling functions.
 //
@@ -49,7 +54,52 @@ const (
 	GdkInterpHyperValue InterpType = 3
 )
 
-// The possible rotations which can be passed to gdk_pixbuf_rotate_simple().
+// String returns the C name of the value, e.g. for debugging output
+func (x InterpType) String() string {
+	switch x {
+	case GdkInterpNearestValue:
+		return "GDK_INTERP_NEAREST"
+	case GdkInterpTilesValue:
+		return "GDK_INTERP_TILES"
+	case GdkInterpBilinearValue:
+		return "GDK_INTERP_BILINEAR"
+	case GdkInterpHyperValue:
+		return "GDK_INTERP_HYPER"
+	}
+	return fmt.Sprintf("InterpType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InterpType) Nick() string {
+	switch x {
+	case GdkInterpNearestValue:
+		return "nearest"
+	case GdkInterpTilesValue:
+		return "tiles"
+	case GdkInterpBilinearValue:
+		return "bilinear"
+	case GdkInterpHyperValue:
+		return "hyper"
+	}
+	return ""
+}
+
+// InterpTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InterpTypeFromString(s string) (InterpType, bool) {
+	switch s {
+	case "GDK_INTERP_NEAREST", "nearest":
+		return GdkInterpNearestValue, true
+	case "GDK_INTERP_TILES", "tiles":
+		return GdkInterpTilesValue, true
+	case "GDK_INTERP_BILINEAR", "bilinear":
+		return GdkInterpBilinearValue, true
+	case "GDK_INTERP_HYPER", "hyper":
+		return GdkInterpHyperValue, true
+	}
+	return 0, false
+}
+
+// The possible rotations which can be passed to [Pixbuf.RotateSimple].
 //
 // To make them easier to use, their numerical values are the actual degrees.
 type PixbufRotation int
@@ -71,3 +121,66 @@ const (
 	// Rotate by 270 degrees.
 	GdkPixbufRotateClockwiseValue PixbufRotation = 270
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x PixbufRotation) String() string {
+	switch x {
+	case GdkPixbufRotateNoneValue:
+		return "GDK_PIXBUF_ROTATE_NONE"
+	case GdkPixbufRotateCounterclockwiseValue:
+		return "GDK_PIXBUF_ROTATE_COUNTERCLOCKWISE"
+	case GdkPixbufRotateUpsidedownValue:
+		return "GDK_PIXBUF_ROTATE_UPSIDEDOWN"
+	case GdkPixbufRotateClockwiseValue:
+		return "GDK_PIXBUF_ROTATE_CLOCKWISE"
+	}
+	return fmt.Sprintf("PixbufRotation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PixbufRotation) Nick() string {
+	switch x {
+	case GdkPixbufRotateNoneValue:
+		return "none"
+	case GdkPixbufRotateCounterclockwiseValue:
+		return "counterclockwise"
+	case GdkPixbufRotateUpsidedownValue:
+		return "upsidedown"
+	case GdkPixbufRotateClockwiseValue:
+		return "clockwise"
+	}
+	return ""
+}
+
+// PixbufRotationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PixbufRotationFromString(s string) (PixbufRotation, bool) {
+	switch s {
+	case "GDK_PIXBUF_ROTATE_NONE", "none":
+		return GdkPixbufRotateNoneValue, true
+	case "GDK_PIXBUF_ROTATE_COUNTERCLOCKWISE", "counterclockwise":
+		return GdkPixbufRotateCounterclockwiseValue, true
+	case "GDK_PIXBUF_ROTATE_UPSIDEDOWN", "upsidedown":
+		return GdkPixbufRotateUpsidedownValue, true
+	case "GDK_PIXBUF_ROTATE_CLOCKWISE", "clockwise":
+		return GdkPixbufRotateClockwiseValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("GDKPIXBUF", "gdk-pixbuf-2.0")
+	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDKPIXBUF") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xInterpTypeGLibType, libs, "gdk_interp_type_get_type")
+
+	core.PuregoSafeRegister(&xPixbufRotationGLibType, libs, "gdk_pixbuf_rotation_get_type")
+
+}
@@ -4,7 +4,6 @@ package gdkpixbuf
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -19,10 +18,10 @@ import (
 // rowstride (the number of bytes between the start of one row
 // and the start of the next).
 //
-// ## Creating new `GdkPixbuf`
+// # Creating new `GdkPixbuf`
 //
 // The most basic way to create a pixbuf is to wrap an existing pixel
-// buffer with a [class@GdkPixbuf.Pixbuf] instance. You can use the
+// buffer with a [Pixbuf] instance. You can use the
 // [`ctor@GdkPixbuf.Pixbuf.new_from_data`] function to do this.
 //
 // Every time you create a new `GdkPixbuf` instance for some data, you
@@ -36,29 +35,29 @@ import (
 // The [`ctor@GdkPixbuf.Pixbuf.new`] constructor function can be used
 // as a convenience to create a pixbuf with an empty buffer; this is
 // equivalent to allocating a data buffer using `malloc()` and then
-// wrapping it with `gdk_pixbuf_new_from_data()`. The `gdk_pixbuf_new()`
+// wrapping it with `[NewPixbufFromData]`. The `[NewPixbuf]`
 // function will compute an optimal rowstride so that rendering can be
 // performed with an efficient algorithm.
 //
-// You can also copy an existing pixbuf with the [method@Pixbuf.copy]
+// You can also copy an existing pixbuf with the copy
 // function. This is not the same as just acquiring a reference to
 // the old pixbuf instance: the copy function will actually duplicate
-// the pixel data in memory and create a new [class@Pixbuf] instance
+// the pixel data in memory and create a new Pixbuf instance
 // for it.
 //
-// ## Reference counting
+// # Reference counting
 //
 // `GdkPixbuf` structures are reference counted. This means that an
 // application can share a single pixbuf among many parts of the
 // code. When a piece of the program needs to use a pixbuf, it should
-// acquire a reference to it by calling `g_object_ref()`; when it no
+// acquire a reference to it by calling `[gobject.Object.Ref]`; when it no
 // longer needs the pixbuf, it should release the reference it acquired
-// by calling `g_object_unref()`. The resources associated with a
+// by calling `[gobject.Object.Unref]`. The resources associated with a
 // `GdkPixbuf` will be freed when its reference count drops to zero.
 // Newly-created `GdkPixbuf` instances start with a reference count
 // of one.
 //
-// ## Image Data
+// # Image Data
 //
 // Image data in a pixbuf is stored in memory in an uncompressed,
 // packed format. Rows in the image are stored top to bottom, and
@@ -73,12 +72,10 @@ import (
 // last row in the pixbuf may not be as wide as the full rowstride, but rather
 // just as wide as the pixel data needs to be; that is: it is unsafe to do
 // `memcpy (dest, pixels, rowstride * height)` to copy a whole pixbuf. Use
-// [method@GdkPixbuf.Pixbuf.copy] instead, or compute the width in bytes of the
+// [Pixbuf.Copy] instead, or compute the width in bytes of the
 // last row as:
 //
-// ```c
-// last_row = width * ((n_channels * bits_per_sample + 7) / 8);
-// ```
+//	last_row = width * ((n_channels * bits_per_sample + 7) / 8);
 //
 // The same rule applies when iterating over each row of a `GdkPixbuf` pixels
 // array.
@@ -87,17 +84,14 @@ import (
 // function for RGB pixbufs with 8 bits per channel with an alpha
 // channel.
 //
-// ```c
-// static void
-// put_pixel (GdkPixbuf *pixbuf,
-//
+//	static void
+//	put_pixel (GdkPixbuf *pixbuf,
 //	           int x,
 //		   int y,
 //		   guchar red,
 //		   guchar green,
 //		   guchar blue,
 //		   guchar alpha)
-//
 //	{
 //	  int n_channels = gdk_pixbuf_get_n_channels (pixbuf);
 //
@@ -111,8 +105,8 @@ import (
 //	  int height = gdk_pixbuf_get_height (pixbuf);
 //
 //	  // Ensure that the coordinates are in a valid range
-//	  g_assert (x &gt;= 0 &amp;&amp; x &lt; width);
-//	  g_assert (y &gt;= 0 &amp;&amp; y &lt; height);
+//	  g_assert (x >= 0 && x < width);
+//	  g_assert (y >= 0 && y < height);
 //
 //	  int rowstride = gdk_pixbuf_get_rowstride (pixbuf);
 //
@@ -127,9 +121,7 @@ import (
 //	  p[3] = alpha;
 //	}
 //
-// ```
-//
-// ## Loading images
+// # Loading images
 //
 // The `GdkPixBuf` class provides a simple mechanism for loading
 // an image from a file in synchronous and asynchronous fashion.
@@ -140,7 +132,7 @@ import (
 // Additionally, `GdkPixbuf` provides the [class@GdkPixbuf.PixbufLoader`]
 // API for progressive image loading.
 //
-// ## Saving images
+// # Saving images
 //
 // The `GdkPixbuf` class provides methods for saving image data in
 // a number of file formats. The formatted data can be written to a
@@ -186,11 +178,11 @@ func NewPixbuf(ColorspaceVar Colorspace, HasAlphaVar bool, BitsPerSampleVar int,
 
 var xNewPixbufFromBytes func(*glib.Bytes, Colorspace, bool, int, int, int, int) uintptr
 
-// Creates a new #GdkPixbuf out of in-memory readonly image data.
+// Creates a new [Pixbuf] out of in-memory readonly image data.
 //
 // Currently only RGB images with 8 bits per sample are supported.
 //
-// This is the `GBytes` variant of gdk_pixbuf_new_from_data(), useful
+// This is the `GBytes` variant of [NewPixbufFromData], useful
 // for language bindings.
 func NewPixbufFromBytes(DataVar *glib.Bytes, ColorspaceVar Colorspace, HasAlphaVar bool, BitsPerSampleVar int, WidthVar int, HeightVar int, RowstrideVar int) *Pixbuf {
 	var cls *Pixbuf
@@ -207,7 +199,7 @@ func NewPixbufFromBytes(DataVar *glib.Bytes, ColorspaceVar Colorspace, HasAlphaV
 
 var xNewPixbufFromData func([]byte, Colorspace, bool, int, int, int, int, uintptr, uintptr) uintptr
 
-// Creates a new #GdkPixbuf out of in-memory image data.
+// Creates a new [Pixbuf] out of in-memory image data.
 //
 // Currently only RGB images with 8 bits per sample are supported.
 //
@@ -217,7 +209,7 @@ var xNewPixbufFromData func([]byte, Colorspace, bool, int, int, int, int, uintpt
 // finalized, your destroy notification function will be called, and
 // it is its responsibility to free the pixel array.
 //
-// See also: [ctor@GdkPixbuf.Pixbuf.new_from_bytes]
+// See also: [NewPixbufFromBytes]
 func NewPixbufFromData(DataVar []byte, ColorspaceVar Colorspace, HasAlphaVar bool, BitsPerSampleVar int, WidthVar int, HeightVar int, RowstrideVar int, DestroyFnVar *PixbufDestroyNotify, DestroyFnDataVar uintptr) *Pixbuf {
 	var cls *Pixbuf
 
@@ -228,11 +220,12 @@ func NewPixbufFromData(DataVar []byte, ColorspaceVar Colorspace, HasAlphaVar boo
 			DestroyFnVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 []byte, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFnVar
 				cbFn(arg0, arg1)
 			}
-			DestroyFnVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyFnVarPtr, DestroyFnVarRef, DestroyFnVar)
+			DestroyFnVarRef = core.NewCallback(fcb)
+			DestroyFnVarRef = glib.SaveCallbackWithClosure(DestroyFnVarPtr, DestroyFnVarRef, DestroyFnVar)
 		}
 	}
 
@@ -252,7 +245,7 @@ var xNewPixbufFromFile func(string, **glib.Error) uintptr
 //
 // The file format is detected automatically.
 //
-// If `NULL` is returned, then @error will be set. Possible errors are:
+// If `NULL` is returned, then error will be set. Possible errors are:
 //
 //   - the file could not be opened
 //   - there is no loader for the file's format
@@ -267,12 +260,15 @@ func NewPixbufFromFile(FilenameVar string) (*Pixbuf, error) {
 	cret := xNewPixbufFromFile(FilenameVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -284,7 +280,7 @@ var xNewPixbufFromFileAtScale func(string, int, int, bool, **glib.Error) uintptr
 //
 // The file format is detected automatically.
 //
-// If `NULL` is returned, then @error will be set. Possible errors are:
+// If `NULL` is returned, then error will be set. Possible errors are:
 //
 //   - the file could not be opened
 //   - there is no loader for the file's format
@@ -309,12 +305,15 @@ func NewPixbufFromFileAtScale(FilenameVar string, WidthVar int, HeightVar int, P
 	cret := xNewPixbufFromFileAtScale(FilenameVar, WidthVar, HeightVar, PreserveAspectRatioVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -326,7 +325,7 @@ var xNewPixbufFromFileAtSize func(string, int, int, **glib.Error) uintptr
 //
 // The file format is detected automatically.
 //
-// If `NULL` is returned, then @error will be set. Possible errors are:
+// If `NULL` is returned, then error will be set. Possible errors are:
 //
 //   - the file could not be opened
 //   - there is no loader for the file's format
@@ -339,7 +338,7 @@ var xNewPixbufFromFileAtSize func(string, int, int, **glib.Error) uintptr
 // the image's aspect ratio. Note that the returned pixbuf may be smaller
 // than `width` x `height`, if the aspect ratio requires it. To load
 // and image at the requested size, regardless of aspect ratio, use
-// [ctor@GdkPixbuf.Pixbuf.new_from_file_at_scale].
+// [NewPixbufFromFileAtScale].
 func NewPixbufFromFileAtSize(FilenameVar string, WidthVar int, HeightVar int) (*Pixbuf, error) {
 	var cls *Pixbuf
 	var cerr *glib.Error
@@ -347,65 +346,15 @@ func NewPixbufFromFileAtSize(FilenameVar string, WidthVar int, HeightVar int) (*
 	cret := xNewPixbufFromFileAtSize(FilenameVar, WidthVar, HeightVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
-	}
-	return cls, cerr
-
-}
-
-var xNewPixbufFromInline func(int, []byte, bool, **glib.Error) uintptr
-
-// Creates a `GdkPixbuf` from a flat representation that is suitable for
-// storing as inline data in a program.
-//
-// This is useful if you want to ship a program with images, but don't want
-// to depend on any external files.
-//
-// GdkPixbuf ships with a program called `gdk-pixbuf-csource`, which allows
-// for conversion of `GdkPixbuf`s into such a inline representation.
-//
-// In almost all cases, you should pass the `--raw` option to
-// `gdk-pixbuf-csource`. A sample invocation would be:
-//
-// ```
-// gdk-pixbuf-csource --raw --name=myimage_inline myimage.png
-// ```
-//
-// For the typical case where the inline pixbuf is read-only static data,
-// you don't need to copy the pixel data unless you intend to write to
-// it, so you can pass `FALSE` for `copy_pixels`. If you pass `--rle` to
-// `gdk-pixbuf-csource`, a copy will be made even if `copy_pixels` is `FALSE`,
-// so using this option is generally a bad idea.
-//
-// If you create a pixbuf from const inline data compiled into your
-// program, it's probably safe to ignore errors and disable length checks,
-// since things will always succeed:
-//
-// ```c
-// pixbuf = gdk_pixbuf_new_from_inline (-1, myimage_inline, FALSE, NULL);
-// ```
-//
-// For non-const inline data, you could get out of memory. For untrusted
-// inline data located at runtime, you could have corrupt inline data in
-// addition.
-func NewPixbufFromInline(DataLengthVar int, DataVar []byte, CopyPixelsVar bool) (*Pixbuf, error) {
-	var cls *Pixbuf
-	var cerr *glib.Error
-
-	cret := xNewPixbufFromInline(DataLengthVar, DataVar, CopyPixelsVar, &cerr)
-
-	if cret == 0 {
-		return nil, cerr
-	}
-	cls = &Pixbuf{}
-	cls.Ptr = cret
-	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -416,7 +365,7 @@ var xNewPixbufFromResource func(string, **glib.Error) uintptr
 // Creates a new pixbuf by loading an image from an resource.
 //
 // The file format is detected automatically. If `NULL` is returned, then
-// @error will be set.
+// error will be set.
 func NewPixbufFromResource(ResourcePathVar string) (*Pixbuf, error) {
 	var cls *Pixbuf
 	var cerr *glib.Error
@@ -424,12 +373,15 @@ func NewPixbufFromResource(ResourcePathVar string) (*Pixbuf, error) {
 	cret := xNewPixbufFromResource(ResourcePathVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -440,14 +392,14 @@ var xNewPixbufFromResourceAtScale func(string, int, int, bool, **glib.Error) uin
 // Creates a new pixbuf by loading an image from an resource.
 //
 // The file format is detected automatically. If `NULL` is returned, then
-// @error will be set.
+// error will be set.
 //
 // The image will be scaled to fit in the requested size, optionally
 // preserving the image's aspect ratio. When preserving the aspect ratio,
-// a @width of -1 will cause the image to be scaled to the exact given
-// height, and a @height of -1 will cause the image to be scaled to the
-// exact given width. When not preserving aspect ratio, a @width or
-// @height of -1 means to not scale the image at all in that dimension.
+// a width of -1 will cause the image to be scaled to the exact given
+// height, and a height of -1 will cause the image to be scaled to the
+// exact given width. When not preserving aspect ratio, a width or
+// height of -1 means to not scale the image at all in that dimension.
 //
 // The stream is not closed.
 func NewPixbufFromResourceAtScale(ResourcePathVar string, WidthVar int, HeightVar int, PreserveAspectRatioVar bool) (*Pixbuf, error) {
@@ -457,12 +409,15 @@ func NewPixbufFromResourceAtScale(ResourcePathVar string, WidthVar int, HeightVa
 	cret := xNewPixbufFromResourceAtScale(ResourcePathVar, WidthVar, HeightVar, PreserveAspectRatioVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -489,12 +444,15 @@ func NewPixbufFromStream(StreamVar *gio.InputStream, CancellableVar *gio.Cancell
 	cret := xNewPixbufFromStream(StreamVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -505,7 +463,7 @@ var xNewPixbufFromStreamAtScale func(uintptr, int, int, bool, uintptr, **glib.Er
 // Creates a new pixbuf by loading an image from an input stream.
 //
 // The file format is detected automatically. If `NULL` is returned, then
-// @error will be set. The @cancellable can be used to abort the operation
+// error will be set. The cancellable can be used to abort the operation
 // from another thread. If the operation was cancelled, the error
 // `G_IO_ERROR_CANCELLED` will be returned. Other possible errors are in
 // the `GDK_PIXBUF_ERROR` and `G_IO_ERROR` domains.
@@ -530,12 +488,15 @@ func NewPixbufFromStreamAtScale(StreamVar *gio.InputStream, WidthVar int, Height
 	cret := xNewPixbufFromStreamAtScale(StreamVar.GoPointer(), WidthVar, HeightVar, PreserveAspectRatioVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -544,7 +505,7 @@ func NewPixbufFromStreamAtScale(StreamVar *gio.InputStream, WidthVar int, Height
 var xNewPixbufFromStreamFinish func(uintptr, **glib.Error) uintptr
 
 // Finishes an asynchronous pixbuf creation operation started with
-// gdk_pixbuf_new_from_stream_async().
+// [PixbufNewFromStreamAsync].
 func NewPixbufFromStreamFinish(AsyncResultVar gio.AsyncResult) (*Pixbuf, error) {
 	var cls *Pixbuf
 	var cerr *glib.Error
@@ -552,36 +513,20 @@ func NewPixbufFromStreamFinish(AsyncResultVar gio.AsyncResult) (*Pixbuf, error)
 	cret := xNewPixbufFromStreamFinish(AsyncResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Pixbuf{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-var xNewPixbufFromXpmData func([]string) uintptr
-
-// Creates a new pixbuf by parsing XPM data in memory.
-//
-// This data is commonly the result of including an XPM file into a
-// program's C source.
-func NewPixbufFromXpmData(DataVar []string) *Pixbuf {
-	var cls *Pixbuf
-
-	cret := xNewPixbufFromXpmData(DataVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Pixbuf{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xPixbufAddAlpha func(uintptr, bool, byte, byte, byte) uintptr
 
 // Takes an existing pixbuf and adds an alpha channel to it.
@@ -637,19 +582,17 @@ func (x *Pixbuf) ApplyEmbeddedOrientation() *Pixbuf {
 
 var xPixbufComposite func(uintptr, uintptr, int, int, int, int, float64, float64, float64, float64, InterpType, int)
 
-// Creates a transformation of the source image @src by scaling by
-// @scale_x and @scale_y then translating by @offset_x and @offset_y.
+// Creates a transformation of the source image src by scaling by
+// scale_x and scale_y then translating by offset_x and offset_y.
 //
 // This gives an image in the coordinates of the destination pixbuf.
-// The rectangle (@dest_x, @dest_y, @dest_width, @dest_height)
+// The rectangle (dest_x, dest_y, dest_width, dest_height)
 // is then alpha blended onto the corresponding rectangle of the
 // original destination image.
 //
 // When the destination rectangle contains parts not in the source
 // image, the data at the edges of the source image is replicated
 // to infinity.
-//
-// ![](composite.png)
 func (x *Pixbuf) Composite(DestVar *Pixbuf, DestXVar int, DestYVar int, DestWidthVar int, DestHeightVar int, OffsetXVar float64, OffsetYVar float64, ScaleXVar float64, ScaleYVar float64, InterpTypeVar InterpType, OverallAlphaVar int) {
 
 	xPixbufComposite(x.GoPointer(), DestVar.GoPointer(), DestXVar, DestYVar, DestWidthVar, DestHeightVar, OffsetXVar, OffsetYVar, ScaleXVar, ScaleYVar, InterpTypeVar, OverallAlphaVar)
@@ -658,17 +601,17 @@ func (x *Pixbuf) Composite(DestVar *Pixbuf, DestXVar int, DestYVar int, DestWidt
 
 var xPixbufCompositeColor func(uintptr, uintptr, int, int, int, int, float64, float64, float64, float64, InterpType, int, int, int, int, uint32, uint32)
 
-// Creates a transformation of the source image @src by scaling by
-// @scale_x and @scale_y then translating by @offset_x and @offset_y,
-// then alpha blends the rectangle (@dest_x ,@dest_y, @dest_width,
-// @dest_height) of the resulting image with a checkboard of the
-// colors @color1 and @color2 and renders it onto the destination
+// Creates a transformation of the source image src by scaling by
+// scale_x and scale_y then translating by offset_x and offset_y,
+// then alpha blends the rectangle (dest_x ,dest_y, dest_width,
+// dest_height) of the resulting image with a checkboard of the
+// colors color1 and color2 and renders it onto the destination
 // image.
 //
-// If the source image has no alpha channel, and @overall_alpha is 255, a fast
+// If the source image has no alpha channel, and overall_alpha is 255, a fast
 // path is used which omits the alpha blending and just performs the scaling.
 //
-// See gdk_pixbuf_composite_color_simple() for a simpler variant of this
+// See [Pixbuf.CompositeColorSimple] for a simpler variant of this
 // function suitable for many tasks.
 func (x *Pixbuf) CompositeColor(DestVar *Pixbuf, DestXVar int, DestYVar int, DestWidthVar int, DestHeightVar int, OffsetXVar float64, OffsetYVar float64, ScaleXVar float64, ScaleYVar float64, InterpTypeVar InterpType, OverallAlphaVar int, CheckXVar int, CheckYVar int, CheckSizeVar int, Color1Var uint32, Color2Var uint32) {
 
@@ -700,7 +643,7 @@ var xPixbufCopy func(uintptr) uintptr
 // `pixbuf`.
 //
 // Note that this does not copy the options set on the original `GdkPixbuf`,
-// use gdk_pixbuf_copy_options() for this.
+// use [Pixbuf.CopyOptions] for this.
 func (x *Pixbuf) Copy() *Pixbuf {
 	var cls *Pixbuf
 
@@ -829,9 +772,9 @@ func (x *Pixbuf) GetNChannels() int {
 
 var xPixbufGetOption func(uintptr, string) string
 
-// Looks up @key in the list of options that may have been attached to the
-// @pixbuf when it was loaded, or that may have been attached by another
-// function using gdk_pixbuf_set_option().
+// Looks up key in the list of options that may have been attached to the
+// pixbuf when it was loaded, or that may have been attached by another
+// function using [Pixbuf.SetOption].
 //
 // For instance, the ANI loader provides "Title" and "Artist" options.
 // The ICO, XBM, and XPM loaders provide "x_hot" and "y_hot" hot-spot
@@ -854,7 +797,7 @@ var xPixbufGetOptions func(uintptr) *glib.HashTable
 
 // Returns a `GHashTable` with a list of all the options that may have been
 // attached to the `pixbuf` when it was loaded, or that may have been
-// attached by another function using [method@GdkPixbuf.Pixbuf.set_option].
+// attached by another function using [Pixbuf.SetOption].
 func (x *Pixbuf) GetOptions() *glib.HashTable {
 
 	cret := xPixbufGetOptions(x.GoPointer())
@@ -868,8 +811,10 @@ var xPixbufGetPixels func(uintptr) uintptr
 // This function will cause an implicit copy of the pixbuf data if the
 // pixbuf was created from read-only data.
 //
-// Please see the section on [image data](class.Pixbuf.html#image-data) for information
+// Please see the section on image data for information
 // about how the pixel data is stored in memory.
+//
+// Deprecated: use [GetPixelsWithLength], the version of this function that language bindings are meant to use.
 func (x *Pixbuf) GetPixels() uintptr {
 
 	cret := xPixbufGetPixels(x.GoPointer())
@@ -883,7 +828,7 @@ var xPixbufGetPixelsWithLength func(uintptr, *uint) uintptr
 // This function will cause an implicit copy of the pixbuf data if the
 // pixbuf was created from read-only data.
 //
-// Please see the section on [image data](class.Pixbuf.html#image-data) for information
+// Please see the section on image data for information
 // about how the pixel data is stored in memory.
 func (x *Pixbuf) GetPixelsWithLength(LengthVar *uint) uintptr {
 
@@ -936,11 +881,11 @@ func (x *Pixbuf) NewSubpixbuf(SrcXVar int, SrcYVar int, WidthVar int, HeightVar
 
 var xPixbufReadPixelBytes func(uintptr) *glib.Bytes
 
-// Provides a #GBytes buffer containing the raw pixel data; the data
+// Provides a [glib.Bytes] buffer containing the raw pixel data; the data
 // must not be modified.
 //
 // This function allows skipping the implicit copy that must be made
-// if gdk_pixbuf_get_pixels() is called on a read-only pixbuf.
+// if [Pixbuf.GetPixels] is called on a read-only pixbuf.
 func (x *Pixbuf) ReadPixelBytes() *glib.Bytes {
 
 	cret := xPixbufReadPixelBytes(x.GoPointer())
@@ -952,29 +897,13 @@ var xPixbufReadPixels func(uintptr) byte
 // Provides a read-only pointer to the raw pixel data.
 //
 // This function allows skipping the implicit copy that must be made
-// if gdk_pixbuf_get_pixels() is called on a read-only pixbuf.
+// if [Pixbuf.GetPixels] is called on a read-only pixbuf.
 func (x *Pixbuf) ReadPixels() byte {
 
 	cret := xPixbufReadPixels(x.GoPointer())
 	return cret
 }
 
-var xPixbufRef func(uintptr) uintptr
-
-// Adds a reference to a pixbuf.
-func (x *Pixbuf) Ref() *Pixbuf {
-	var cls *Pixbuf
-
-	cret := xPixbufRef(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Pixbuf{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xPixbufRemoveOption func(uintptr, string) bool
 
 // Removes the key/value pair option attached to a `GdkPixbuf`.
@@ -1027,24 +956,21 @@ func (x *Pixbuf) SaturateAndPixelate(DestVar *Pixbuf, SaturationVar float32, Pix
 
 var xPixbufSave func(uintptr, string, string, **glib.Error, ...interface{}) bool
 
-// Saves pixbuf to a file in format @type. By default, "jpeg", "png", "ico"
+// Saves pixbuf to a file in format type. By default, "jpeg", "png", "ico"
 // and "bmp" are possible file formats to save in, but more formats may be
 // installed. The list of all writable formats can be determined in the
 // following way:
 //
-// ```c
-// void add_if_writable (GdkPixbufFormat *data, GSList **list)
-//
+//	void add_if_writable (GdkPixbufFormat *data, GSList **list)
 //	{
 //	  if (gdk_pixbuf_format_is_writable (data))
 //	    *list = g_slist_prepend (*list, data);
 //	}
 //
-// GSList *formats = gdk_pixbuf_get_formats ();
-// GSList *writable_formats = NULL;
-// g_slist_foreach (formats, add_if_writable, &amp;writable_formats);
-// g_slist_free (formats);
-// ```
+//	GSList *formats = gdk_pixbuf_get_formats ();
+//	GSList *writable_formats = NULL;
+//	g_slist_foreach (formats, add_if_writable, &writable_formats);
+//	g_slist_free (formats);
 //
 // If `error` is set, `FALSE` will be returned. Possible errors include
 // those in the `GDK_PIXBUF_ERROR` domain and those in the `G_FILE_ERROR`
@@ -1054,9 +980,7 @@ var xPixbufSave func(uintptr, string, string, **glib.Error, ...interface{}) bool
 // it should contain pairs of strings that modify the save
 // parameters. For example:
 //
-// ```c
-// gdk_pixbuf_save (pixbuf, handle, "jpeg", &amp;error, "quality", "100", NULL);
-// ```
+//	gdk_pixbuf_save (pixbuf, handle, "jpeg", &error, "quality", "100", NULL);
 //
 // Currently only few parameters exist.
 //
@@ -1074,17 +998,15 @@ var xPixbufSave func(uintptr, string, string, **glib.Error, ...interface{}) bool
 // The "icc-profile" value should be the complete ICC profile encoded
 // into base64.
 //
-// ```c
-// char *contents;
-// gsize length;
+//	char *contents;
+//	gsize length;
 //
-// // icm_path is set elsewhere
-// g_file_get_contents (icm_path, &amp;contents, &amp;length, NULL);
+//	// icm_path is set elsewhere
+//	g_file_get_contents (icm_path, &contents, &length, NULL);
 //
-// char *contents_encode = g_base64_encode ((const guchar *) contents, length);
+//	char *contents_encode = g_base64_encode ((const guchar *) contents, length);
 //
-// gdk_pixbuf_save (pixbuf, handle, "png", &amp;error, "icc-profile", contents_encode, NULL);
-// ```
+//	gdk_pixbuf_save (pixbuf, handle, "png", &error, "icc-profile", contents_encode, NULL);
 //
 // TIFF images recognize:
 //
@@ -1110,17 +1032,17 @@ var xPixbufSaveToBuffer func(uintptr, *[]byte, *uint, string, **glib.Error, ...i
 // Saves pixbuf to a new buffer in format `type`, which is currently "jpeg",
 // "png", "tiff", "ico" or "bmp".
 //
-// This is a convenience function that uses `gdk_pixbuf_save_to_callback()`
+// This is a convenience function that uses `[Pixbuf.SaveToCallback]`
 // to do the real work.
 //
 // Note that the buffer is not `NUL`-terminated and may contain embedded `NUL`
 // characters.
 //
-// If @error is set, `FALSE` will be returned and @buffer will be set to
+// If error is set, `FALSE` will be returned and buffer will be set to
 // `NULL`. Possible errors include those in the `GDK_PIXBUF_ERROR`
 // domain.
 //
-// See `gdk_pixbuf_save()` for more details.
+// See `[Pixbuf.Save]` for more details.
 func (x *Pixbuf) SaveToBuffer(BufferVar *[]byte, BufferSizeVar *uint, TypeVar string, ErrorVar **glib.Error, varArgs ...interface{}) bool {
 
 	cret := xPixbufSaveToBuffer(x.GoPointer(), BufferVar, BufferSizeVar, TypeVar, ErrorVar, varArgs...)
@@ -1129,18 +1051,18 @@ func (x *Pixbuf) SaveToBuffer(BufferVar *[]byte, BufferSizeVar *uint, TypeVar st
 
 var xPixbufSaveToBufferv func(uintptr, *[]byte, *uint, string, []string, []string, **glib.Error) bool
 
-// Vector version of `gdk_pixbuf_save_to_buffer()`.
+// Vector version of `[Pixbuf.SaveToBuffer]`.
 //
-// Saves pixbuf to a new buffer in format @type, which is currently "jpeg",
+// Saves pixbuf to a new buffer in format type, which is currently "jpeg",
 // "tiff", "png", "ico" or "bmp".
 //
-// See [method@GdkPixbuf.Pixbuf.save_to_buffer] for more details.
+// See [Pixbuf.SaveToBuffer] for more details.
 func (x *Pixbuf) SaveToBufferv(BufferVar *[]byte, BufferSizeVar *uint, TypeVar string, OptionKeysVar []string, OptionValuesVar []string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPixbufSaveToBufferv(x.GoPointer(), BufferVar, BufferSizeVar, TypeVar, OptionKeysVar, OptionValuesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1154,11 +1076,11 @@ var xPixbufSaveToCallback func(uintptr, uintptr, uintptr, string, **glib.Error,
 // This function can be used when you want to store the image to something
 // other than a file, such as an in-memory buffer or a socket.
 //
-// If @error is set, `FALSE` will be returned. Possible errors
+// If error is set, `FALSE` will be returned. Possible errors
 // include those in the `GDK_PIXBUF_ERROR` domain and whatever the save
 // function generates.
 //
-// See [method@GdkPixbuf.Pixbuf.save] for more details.
+// See [Pixbuf.Save] for more details.
 func (x *Pixbuf) SaveToCallback(SaveFuncVar *PixbufSaveFunc, UserDataVar uintptr, TypeVar string, ErrorVar **glib.Error, varArgs ...interface{}) bool {
 
 	var SaveFuncVarRef uintptr
@@ -1168,11 +1090,12 @@ func (x *Pixbuf) SaveToCallback(SaveFuncVar *PixbufSaveFunc, UserDataVar uintptr
 			SaveFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 []byte, arg1 uint, arg2 **glib.Error, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *SaveFuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			SaveFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SaveFuncVarPtr, SaveFuncVarRef, SaveFuncVar)
+			SaveFuncVarRef = core.NewCallback(fcb)
+			SaveFuncVarRef = glib.SaveCallbackWithClosure(SaveFuncVarPtr, SaveFuncVarRef, SaveFuncVar)
 		}
 	}
 
@@ -1182,14 +1105,14 @@ func (x *Pixbuf) SaveToCallback(SaveFuncVar *PixbufSaveFunc, UserDataVar uintptr
 
 var xPixbufSaveToCallbackv func(uintptr, uintptr, uintptr, string, []string, []string, **glib.Error) bool
 
-// Vector version of `gdk_pixbuf_save_to_callback()`.
+// Vector version of `[Pixbuf.SaveToCallback]`.
 //
-// Saves pixbuf to a callback in format @type, which is currently "jpeg",
+// Saves pixbuf to a callback in format type, which is currently "jpeg",
 // "png", "tiff", "ico" or "bmp".
 //
-// If @error is set, `FALSE` will be returned.
+// If error is set, `FALSE` will be returned.
 //
-// See [method@GdkPixbuf.Pixbuf.save_to_callback] for more details.
+// See [Pixbuf.SaveToCallback] for more details.
 func (x *Pixbuf) SaveToCallbackv(SaveFuncVar *PixbufSaveFunc, UserDataVar uintptr, TypeVar string, OptionKeysVar []string, OptionValuesVar []string) (bool, error) {
 	var cerr *glib.Error
 
@@ -1200,17 +1123,18 @@ func (x *Pixbuf) SaveToCallbackv(SaveFuncVar *PixbufSaveFunc, UserDataVar uintpt
 			SaveFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 []byte, arg1 uint, arg2 **glib.Error, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *SaveFuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			SaveFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SaveFuncVarPtr, SaveFuncVarRef, SaveFuncVar)
+			SaveFuncVarRef = core.NewCallback(fcb)
+			SaveFuncVarRef = glib.SaveCallbackWithClosure(SaveFuncVarPtr, SaveFuncVarRef, SaveFuncVar)
 		}
 	}
 
 	cret := xPixbufSaveToCallbackv(x.GoPointer(), SaveFuncVarRef, UserDataVar, TypeVar, OptionKeysVar, OptionValuesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1221,7 +1145,7 @@ var xPixbufSaveToStream func(uintptr, uintptr, string, uintptr, **glib.Error, ..
 // Saves `pixbuf` to an output stream.
 //
 // Supported file formats are currently "jpeg", "tiff", "png", "ico" or
-// "bmp". See `gdk_pixbuf_save_to_buffer()` for more details.
+// "bmp". See `[Pixbuf.SaveToBuffer]` for more details.
 //
 // The `cancellable` can be used to abort the operation from another
 // thread. If the operation was cancelled, the error `G_IO_ERROR_CANCELLED`
@@ -1239,12 +1163,12 @@ var xPixbufSaveToStreamAsync func(uintptr, uintptr, string, uintptr, uintptr, ui
 
 // Saves `pixbuf` to an output stream asynchronously.
 //
-// For more details see gdk_pixbuf_save_to_stream(), which is the synchronous
+// For more details see [Pixbuf.SaveToStream], which is the synchronous
 // version of this function.
 //
 // When the operation is finished, `callback` will be called in the main thread.
 //
-// You can then call gdk_pixbuf_save_to_stream_finish() to get the result of
+// You can then call [PixbufSaveToStreamFinish] to get the result of
 // the operation.
 func (x *Pixbuf) SaveToStreamAsync(StreamVar *gio.OutputStream, TypeVar string, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr, varArgs ...interface{}) {
 
@@ -1255,11 +1179,12 @@ func (x *Pixbuf) SaveToStreamAsync(StreamVar *gio.OutputStream, TypeVar string,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1274,13 +1199,13 @@ var xPixbufSaveToStreamv func(uintptr, uintptr, string, []string, []string, uint
 // Supported file formats are currently "jpeg", "tiff", "png", "ico" or
 // "bmp".
 //
-// See [method@GdkPixbuf.Pixbuf.save_to_stream] for more details.
+// See [Pixbuf.SaveToStream] for more details.
 func (x *Pixbuf) SaveToStreamv(StreamVar *gio.OutputStream, TypeVar string, OptionKeysVar []string, OptionValuesVar []string, CancellableVar *gio.Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPixbufSaveToStreamv(x.GoPointer(), StreamVar.GoPointer(), TypeVar, OptionKeysVar, OptionValuesVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1290,12 +1215,12 @@ var xPixbufSaveToStreamvAsync func(uintptr, uintptr, string, []string, []string,
 
 // Saves `pixbuf` to an output stream asynchronously.
 //
-// For more details see gdk_pixbuf_save_to_streamv(), which is the synchronous
+// For more details see [Pixbuf.SaveToStreamv], which is the synchronous
 // version of this function.
 //
 // When the operation is finished, `callback` will be called in the main thread.
 //
-// You can then call gdk_pixbuf_save_to_stream_finish() to get the result of
+// You can then call [PixbufSaveToStreamFinish] to get the result of
 // the operation.
 func (x *Pixbuf) SaveToStreamvAsync(StreamVar *gio.OutputStream, TypeVar string, OptionKeysVar []string, OptionValuesVar []string, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1306,11 +1231,12 @@ func (x *Pixbuf) SaveToStreamvAsync(StreamVar *gio.OutputStream, TypeVar string,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1320,19 +1246,19 @@ func (x *Pixbuf) SaveToStreamvAsync(StreamVar *gio.OutputStream, TypeVar string,
 
 var xPixbufSavev func(uintptr, string, string, []string, []string, **glib.Error) bool
 
-// Vector version of `gdk_pixbuf_save()`.
+// Vector version of `[Pixbuf.Save]`.
 //
 // Saves pixbuf to a file in `type`, which is currently "jpeg", "png", "tiff", "ico" or "bmp".
 //
-// If @error is set, `FALSE` will be returned.
+// If error is set, `FALSE` will be returned.
 //
-// See [method@GdkPixbuf.Pixbuf.save] for more details.
+// See [Pixbuf.Save] for more details.
 func (x *Pixbuf) Savev(FilenameVar string, TypeVar string, OptionKeysVar []string, OptionValuesVar []string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPixbufSavev(x.GoPointer(), FilenameVar, TypeVar, OptionKeysVar, OptionValuesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1340,15 +1266,15 @@ func (x *Pixbuf) Savev(FilenameVar string, TypeVar string, OptionKeysVar []strin
 
 var xPixbufScale func(uintptr, uintptr, int, int, int, int, float64, float64, float64, float64, InterpType)
 
-// Creates a transformation of the source image @src by scaling by
-// @scale_x and @scale_y then translating by @offset_x and @offset_y,
-// then renders the rectangle (@dest_x, @dest_y, @dest_width,
-// @dest_height) of the resulting image onto the destination image
+// Creates a transformation of the source image src by scaling by
+// scale_x and scale_y then translating by offset_x and offset_y,
+// then renders the rectangle (dest_x, dest_y, dest_width,
+// dest_height) of the resulting image onto the destination image
 // replacing the previous contents.
 //
-// Try to use gdk_pixbuf_scale_simple() first; this function is
+// Try to use [Pixbuf.ScaleSimple] first; this function is
 // the industrial-strength power tool you can fall back to, if
-// gdk_pixbuf_scale_simple() isn't powerful enough.
+// [Pixbuf.ScaleSimple] isn't powerful enough.
 //
 // If the source rectangle overlaps the destination rectangle on the
 // same pixbuf, it will be overwritten during the scaling which
@@ -1372,13 +1298,13 @@ var xPixbufScaleSimple func(uintptr, int, int, InterpType) uintptr
 // offers reasonable quality and speed.
 //
 // You can scale a sub-portion of `src` by creating a sub-pixbuf
-// pointing into `src`; see [method@GdkPixbuf.Pixbuf.new_subpixbuf].
+// pointing into `src`; see [Pixbuf.NewSubpixbuf].
 //
 // If `dest_width` and `dest_height` are equal to the width and height of
 // `src`, this function will return an unscaled copy of `src`.
 //
-// For more complicated scaling/alpha blending see [method@GdkPixbuf.Pixbuf.scale]
-// and [method@GdkPixbuf.Pixbuf.composite].
+// For more complicated scaling/alpha blending see [Pixbuf.Scale]
+// and [Pixbuf.Composite].
 func (x *Pixbuf) ScaleSimple(DestWidthVar int, DestHeightVar int, InterpTypeVar InterpType) *Pixbuf {
 	var cls *Pixbuf
 
@@ -1404,15 +1330,6 @@ func (x *Pixbuf) SetOption(KeyVar string, ValueVar string) bool {
 	return cret
 }
 
-var xPixbufUnref func(uintptr)
-
-// Removes a reference from a pixbuf.
-func (x *Pixbuf) Unref() {
-
-	xPixbufUnref(x.GoPointer())
-
-}
-
 func (c *Pixbuf) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -1588,10 +1505,10 @@ func (x *Pixbuf) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [gio.Icon] into a [glib.Variant]. An equivalent [gio.Icon] can be retrieved
+// back by calling [gio.IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *Pixbuf) Serialize() *glib.Variant {
 
@@ -1599,21 +1516,21 @@ func (x *Pixbuf) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [gio.IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [gio.Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [gio.FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [gio.File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [gio.File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [gio.ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *Pixbuf) ToString() string {
 
@@ -1622,7 +1539,7 @@ func (x *Pixbuf) ToString() string {
 }
 
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [gio.LoadableIcon.LoadAsync].
 func (x *Pixbuf) Load(SizeVar int, TypeVar *string, CancellableVar *gio.Cancellable) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -1630,27 +1547,30 @@ func (x *Pixbuf) Load(SizeVar int, TypeVar *string, CancellableVar *gio.Cancella
 	cret := gio.XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [gio.LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [gio.LoadableIcon.Load].
 func (x *Pixbuf) LoadAsync(SizeVar int, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	gio.XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [gio.LoadableIcon.LoadAsync].
 func (x *Pixbuf) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gio.InputStream, error) {
 	var cls *gio.InputStream
 	var cerr *glib.Error
@@ -1658,12 +1578,15 @@ func (x *Pixbuf) LoadFinish(ResVar gio.AsyncResult, TypeVar *string) (*gio.Input
 	cret := gio.XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1696,11 +1619,11 @@ var xPixbufGetFileInfoAsync func(string, uintptr, uintptr, uintptr)
 // Asynchronously parses an image file far enough to determine its
 // format and size.
 //
-// For more details see gdk_pixbuf_get_file_info(), which is the synchronous
+// For more details see [PixbufGetFileInfo], which is the synchronous
 // version of this function.
 //
-// When the operation is finished, @callback will be called in the
-// main thread. You can then call gdk_pixbuf_get_file_info_finish() to
+// When the operation is finished, callback will be called in the
+// main thread. You can then call [PixbufGetFileInfoFinish] to
 // get the result of the operation.
 func PixbufGetFileInfoAsync(FilenameVar string, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1711,11 +1634,12 @@ func PixbufGetFileInfoAsync(FilenameVar string, CancellableVar *gio.Cancellable,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1726,13 +1650,13 @@ func PixbufGetFileInfoAsync(FilenameVar string, CancellableVar *gio.Cancellable,
 var xPixbufGetFileInfoFinish func(uintptr, *int, *int, **glib.Error) *PixbufFormat
 
 // Finishes an asynchronous pixbuf parsing operation started with
-// gdk_pixbuf_get_file_info_async().
+// [PixbufGetFileInfoAsync].
 func PixbufGetFileInfoFinish(AsyncResultVar gio.AsyncResult, WidthVar *int, HeightVar *int) (*PixbufFormat, error) {
 	var cerr *glib.Error
 
 	cret := xPixbufGetFileInfoFinish(AsyncResultVar.GoPointer(), WidthVar, HeightVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1768,7 +1692,7 @@ func PixbufInitModules(PathVar string) (bool, error) {
 
 	cret := xPixbufInitModules(PathVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1778,11 +1702,11 @@ var xPixbufNewFromStreamAsync func(uintptr, uintptr, uintptr, uintptr)
 
 // Creates a new pixbuf by asynchronously loading an image from an input stream.
 //
-// For more details see gdk_pixbuf_new_from_stream(), which is the synchronous
+// For more details see [NewPixbufFromStream], which is the synchronous
 // version of this function.
 //
-// When the operation is finished, @callback will be called in the main thread.
-// You can then call gdk_pixbuf_new_from_stream_finish() to get the result of
+// When the operation is finished, callback will be called in the main thread.
+// You can then call [NewPixbufFromStreamFinish] to get the result of
 // the operation.
 func PixbufNewFromStreamAsync(StreamVar *gio.InputStream, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1793,11 +1717,12 @@ func PixbufNewFromStreamAsync(StreamVar *gio.InputStream, CancellableVar *gio.Ca
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1809,11 +1734,11 @@ var xPixbufNewFromStreamAtScaleAsync func(uintptr, int, int, bool, uintptr, uint
 
 // Creates a new pixbuf by asynchronously loading an image from an input stream.
 //
-// For more details see gdk_pixbuf_new_from_stream_at_scale(), which is the synchronous
+// For more details see [NewPixbufFromStreamAtScale], which is the synchronous
 // version of this function.
 //
-// When the operation is finished, @callback will be called in the main thread.
-// You can then call gdk_pixbuf_new_from_stream_finish() to get the result of the operation.
+// When the operation is finished, callback will be called in the main thread.
+// You can then call [NewPixbufFromStreamFinish] to get the result of the operation.
 func PixbufNewFromStreamAtScaleAsync(StreamVar *gio.InputStream, WidthVar int, HeightVar int, PreserveAspectRatioVar bool, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1823,11 +1748,12 @@ func PixbufNewFromStreamAtScaleAsync(StreamVar *gio.InputStream, WidthVar int, H
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1838,13 +1764,13 @@ func PixbufNewFromStreamAtScaleAsync(StreamVar *gio.InputStream, WidthVar int, H
 var xPixbufSaveToStreamFinish func(uintptr, **glib.Error) bool
 
 // Finishes an asynchronous pixbuf save operation started with
-// gdk_pixbuf_save_to_stream_async().
+// [Pixbuf.SaveToStreamAsync].
 func PixbufSaveToStreamFinish(AsyncResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPixbufSaveToStreamFinish(AsyncResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1855,7 +1781,7 @@ func init() {
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1864,72 +1790,68 @@ func init() {
 
 	core.PuregoSafeRegister(&xPixbufGLibType, libs, "gdk_pixbuf_get_type")
 
-	core.PuregoSafeRegister(&xNewPixbuf, libs, "gdk_pixbuf_new")
-	core.PuregoSafeRegister(&xNewPixbufFromBytes, libs, "gdk_pixbuf_new_from_bytes")
-	core.PuregoSafeRegister(&xNewPixbufFromData, libs, "gdk_pixbuf_new_from_data")
-	core.PuregoSafeRegister(&xNewPixbufFromFile, libs, "gdk_pixbuf_new_from_file")
-	core.PuregoSafeRegister(&xNewPixbufFromFileAtScale, libs, "gdk_pixbuf_new_from_file_at_scale")
-	core.PuregoSafeRegister(&xNewPixbufFromFileAtSize, libs, "gdk_pixbuf_new_from_file_at_size")
-	core.PuregoSafeRegister(&xNewPixbufFromInline, libs, "gdk_pixbuf_new_from_inline")
-	core.PuregoSafeRegister(&xNewPixbufFromResource, libs, "gdk_pixbuf_new_from_resource")
-	core.PuregoSafeRegister(&xNewPixbufFromResourceAtScale, libs, "gdk_pixbuf_new_from_resource_at_scale")
-	core.PuregoSafeRegister(&xNewPixbufFromStream, libs, "gdk_pixbuf_new_from_stream")
-	core.PuregoSafeRegister(&xNewPixbufFromStreamAtScale, libs, "gdk_pixbuf_new_from_stream_at_scale")
-	core.PuregoSafeRegister(&xNewPixbufFromStreamFinish, libs, "gdk_pixbuf_new_from_stream_finish")
-	core.PuregoSafeRegister(&xNewPixbufFromXpmData, libs, "gdk_pixbuf_new_from_xpm_data")
-
-	core.PuregoSafeRegister(&xPixbufAddAlpha, libs, "gdk_pixbuf_add_alpha")
-	core.PuregoSafeRegister(&xPixbufApplyEmbeddedOrientation, libs, "gdk_pixbuf_apply_embedded_orientation")
-	core.PuregoSafeRegister(&xPixbufComposite, libs, "gdk_pixbuf_composite")
-	core.PuregoSafeRegister(&xPixbufCompositeColor, libs, "gdk_pixbuf_composite_color")
-	core.PuregoSafeRegister(&xPixbufCompositeColorSimple, libs, "gdk_pixbuf_composite_color_simple")
-	core.PuregoSafeRegister(&xPixbufCopy, libs, "gdk_pixbuf_copy")
-	core.PuregoSafeRegister(&xPixbufCopyArea, libs, "gdk_pixbuf_copy_area")
-	core.PuregoSafeRegister(&xPixbufCopyOptions, libs, "gdk_pixbuf_copy_options")
-	core.PuregoSafeRegister(&xPixbufFill, libs, "gdk_pixbuf_fill")
-	core.PuregoSafeRegister(&xPixbufFlip, libs, "gdk_pixbuf_flip")
-	core.PuregoSafeRegister(&xPixbufGetBitsPerSample, libs, "gdk_pixbuf_get_bits_per_sample")
-	core.PuregoSafeRegister(&xPixbufGetByteLength, libs, "gdk_pixbuf_get_byte_length")
-	core.PuregoSafeRegister(&xPixbufGetColorspace, libs, "gdk_pixbuf_get_colorspace")
-	core.PuregoSafeRegister(&xPixbufGetHasAlpha, libs, "gdk_pixbuf_get_has_alpha")
-	core.PuregoSafeRegister(&xPixbufGetHeight, libs, "gdk_pixbuf_get_height")
-	core.PuregoSafeRegister(&xPixbufGetNChannels, libs, "gdk_pixbuf_get_n_channels")
-	core.PuregoSafeRegister(&xPixbufGetOption, libs, "gdk_pixbuf_get_option")
-	core.PuregoSafeRegister(&xPixbufGetOptions, libs, "gdk_pixbuf_get_options")
-	core.PuregoSafeRegister(&xPixbufGetPixels, libs, "gdk_pixbuf_get_pixels")
-	core.PuregoSafeRegister(&xPixbufGetPixelsWithLength, libs, "gdk_pixbuf_get_pixels_with_length")
-	core.PuregoSafeRegister(&xPixbufGetRowstride, libs, "gdk_pixbuf_get_rowstride")
-	core.PuregoSafeRegister(&xPixbufGetWidth, libs, "gdk_pixbuf_get_width")
-	core.PuregoSafeRegister(&xPixbufNewSubpixbuf, libs, "gdk_pixbuf_new_subpixbuf")
-	core.PuregoSafeRegister(&xPixbufReadPixelBytes, libs, "gdk_pixbuf_read_pixel_bytes")
-	core.PuregoSafeRegister(&xPixbufReadPixels, libs, "gdk_pixbuf_read_pixels")
-	core.PuregoSafeRegister(&xPixbufRef, libs, "gdk_pixbuf_ref")
-	core.PuregoSafeRegister(&xPixbufRemoveOption, libs, "gdk_pixbuf_remove_option")
-	core.PuregoSafeRegister(&xPixbufRotateSimple, libs, "gdk_pixbuf_rotate_simple")
-	core.PuregoSafeRegister(&xPixbufSaturateAndPixelate, libs, "gdk_pixbuf_saturate_and_pixelate")
-	core.PuregoSafeRegister(&xPixbufSave, libs, "gdk_pixbuf_save")
-	core.PuregoSafeRegister(&xPixbufSaveToBuffer, libs, "gdk_pixbuf_save_to_buffer")
-	core.PuregoSafeRegister(&xPixbufSaveToBufferv, libs, "gdk_pixbuf_save_to_bufferv")
-	core.PuregoSafeRegister(&xPixbufSaveToCallback, libs, "gdk_pixbuf_save_to_callback")
-	core.PuregoSafeRegister(&xPixbufSaveToCallbackv, libs, "gdk_pixbuf_save_to_callbackv")
-	core.PuregoSafeRegister(&xPixbufSaveToStream, libs, "gdk_pixbuf_save_to_stream")
-	core.PuregoSafeRegister(&xPixbufSaveToStreamAsync, libs, "gdk_pixbuf_save_to_stream_async")
-	core.PuregoSafeRegister(&xPixbufSaveToStreamv, libs, "gdk_pixbuf_save_to_streamv")
-	core.PuregoSafeRegister(&xPixbufSaveToStreamvAsync, libs, "gdk_pixbuf_save_to_streamv_async")
-	core.PuregoSafeRegister(&xPixbufSavev, libs, "gdk_pixbuf_savev")
-	core.PuregoSafeRegister(&xPixbufScale, libs, "gdk_pixbuf_scale")
-	core.PuregoSafeRegister(&xPixbufScaleSimple, libs, "gdk_pixbuf_scale_simple")
-	core.PuregoSafeRegister(&xPixbufSetOption, libs, "gdk_pixbuf_set_option")
-	core.PuregoSafeRegister(&xPixbufUnref, libs, "gdk_pixbuf_unref")
-
-	core.PuregoSafeRegister(&xPixbufCalculateRowstride, libs, "gdk_pixbuf_calculate_rowstride")
-	core.PuregoSafeRegister(&xPixbufGetFileInfo, libs, "gdk_pixbuf_get_file_info")
-	core.PuregoSafeRegister(&xPixbufGetFileInfoAsync, libs, "gdk_pixbuf_get_file_info_async")
-	core.PuregoSafeRegister(&xPixbufGetFileInfoFinish, libs, "gdk_pixbuf_get_file_info_finish")
-	core.PuregoSafeRegister(&xPixbufGetFormats, libs, "gdk_pixbuf_get_formats")
-	core.PuregoSafeRegister(&xPixbufInitModules, libs, "gdk_pixbuf_init_modules")
-	core.PuregoSafeRegister(&xPixbufNewFromStreamAsync, libs, "gdk_pixbuf_new_from_stream_async")
-	core.PuregoSafeRegister(&xPixbufNewFromStreamAtScaleAsync, libs, "gdk_pixbuf_new_from_stream_at_scale_async")
-	core.PuregoSafeRegister(&xPixbufSaveToStreamFinish, libs, "gdk_pixbuf_save_to_stream_finish")
+	core.PuregoRegisterSince(&xNewPixbuf, libs, "gdk_pixbuf_new", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufFromBytes, libs, "gdk_pixbuf_new_from_bytes", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xNewPixbufFromData, libs, "gdk_pixbuf_new_from_data", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufFromFile, libs, "gdk_pixbuf_new_from_file", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufFromFileAtScale, libs, "gdk_pixbuf_new_from_file_at_scale", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xNewPixbufFromFileAtSize, libs, "gdk_pixbuf_new_from_file_at_size", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xNewPixbufFromResource, libs, "gdk_pixbuf_new_from_resource", "GDKPIXBUF", "2.26")
+	core.PuregoRegisterSince(&xNewPixbufFromResourceAtScale, libs, "gdk_pixbuf_new_from_resource_at_scale", "GDKPIXBUF", "2.26")
+	core.PuregoRegisterSince(&xNewPixbufFromStream, libs, "gdk_pixbuf_new_from_stream", "GDKPIXBUF", "2.14")
+	core.PuregoRegisterSince(&xNewPixbufFromStreamAtScale, libs, "gdk_pixbuf_new_from_stream_at_scale", "GDKPIXBUF", "2.14")
+	core.PuregoRegisterSince(&xNewPixbufFromStreamFinish, libs, "gdk_pixbuf_new_from_stream_finish", "GDKPIXBUF", "2.24")
+
+	core.PuregoRegisterSince(&xPixbufAddAlpha, libs, "gdk_pixbuf_add_alpha", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufApplyEmbeddedOrientation, libs, "gdk_pixbuf_apply_embedded_orientation", "GDKPIXBUF", "2.12")
+	core.PuregoRegisterSince(&xPixbufComposite, libs, "gdk_pixbuf_composite", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufCompositeColor, libs, "gdk_pixbuf_composite_color", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufCompositeColorSimple, libs, "gdk_pixbuf_composite_color_simple", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufCopy, libs, "gdk_pixbuf_copy", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufCopyArea, libs, "gdk_pixbuf_copy_area", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufCopyOptions, libs, "gdk_pixbuf_copy_options", "GDKPIXBUF", "2.36")
+	core.PuregoRegisterSince(&xPixbufFill, libs, "gdk_pixbuf_fill", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufFlip, libs, "gdk_pixbuf_flip", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xPixbufGetBitsPerSample, libs, "gdk_pixbuf_get_bits_per_sample", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetByteLength, libs, "gdk_pixbuf_get_byte_length", "GDKPIXBUF", "2.26")
+	core.PuregoRegisterSince(&xPixbufGetColorspace, libs, "gdk_pixbuf_get_colorspace", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetHasAlpha, libs, "gdk_pixbuf_get_has_alpha", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetHeight, libs, "gdk_pixbuf_get_height", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetNChannels, libs, "gdk_pixbuf_get_n_channels", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetOption, libs, "gdk_pixbuf_get_option", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetOptions, libs, "gdk_pixbuf_get_options", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xPixbufGetPixels, libs, "gdk_pixbuf_get_pixels", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetPixelsWithLength, libs, "gdk_pixbuf_get_pixels_with_length", "GDKPIXBUF", "2.26")
+	core.PuregoRegisterSince(&xPixbufGetRowstride, libs, "gdk_pixbuf_get_rowstride", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufGetWidth, libs, "gdk_pixbuf_get_width", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufNewSubpixbuf, libs, "gdk_pixbuf_new_subpixbuf", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufReadPixelBytes, libs, "gdk_pixbuf_read_pixel_bytes", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xPixbufReadPixels, libs, "gdk_pixbuf_read_pixels", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xPixbufRemoveOption, libs, "gdk_pixbuf_remove_option", "GDKPIXBUF", "2.36")
+	core.PuregoRegisterSince(&xPixbufRotateSimple, libs, "gdk_pixbuf_rotate_simple", "GDKPIXBUF", "2.6")
+	core.PuregoRegisterSince(&xPixbufSaturateAndPixelate, libs, "gdk_pixbuf_saturate_and_pixelate", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufSave, libs, "gdk_pixbuf_save", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufSaveToBuffer, libs, "gdk_pixbuf_save_to_buffer", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xPixbufSaveToBufferv, libs, "gdk_pixbuf_save_to_bufferv", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xPixbufSaveToCallback, libs, "gdk_pixbuf_save_to_callback", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xPixbufSaveToCallbackv, libs, "gdk_pixbuf_save_to_callbackv", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xPixbufSaveToStream, libs, "gdk_pixbuf_save_to_stream", "GDKPIXBUF", "2.14")
+	core.PuregoRegisterSince(&xPixbufSaveToStreamAsync, libs, "gdk_pixbuf_save_to_stream_async", "GDKPIXBUF", "2.24")
+	core.PuregoRegisterSince(&xPixbufSaveToStreamv, libs, "gdk_pixbuf_save_to_streamv", "GDKPIXBUF", "2.36")
+	core.PuregoRegisterSince(&xPixbufSaveToStreamvAsync, libs, "gdk_pixbuf_save_to_streamv_async", "GDKPIXBUF", "2.36")
+	core.PuregoRegisterSince(&xPixbufSavev, libs, "gdk_pixbuf_savev", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufScale, libs, "gdk_pixbuf_scale", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufScaleSimple, libs, "gdk_pixbuf_scale_simple", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufSetOption, libs, "gdk_pixbuf_set_option", "GDKPIXBUF", "2.2")
+
+	core.PuregoRegisterSince(&xPixbufCalculateRowstride, libs, "gdk_pixbuf_calculate_rowstride", "GDKPIXBUF", "2.36.8")
+	core.PuregoRegisterSince(&xPixbufGetFileInfo, libs, "gdk_pixbuf_get_file_info", "GDKPIXBUF", "2.4")
+	core.PuregoRegisterSince(&xPixbufGetFileInfoAsync, libs, "gdk_pixbuf_get_file_info_async", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xPixbufGetFileInfoFinish, libs, "gdk_pixbuf_get_file_info_finish", "GDKPIXBUF", "2.32")
+	core.PuregoRegisterSince(&xPixbufGetFormats, libs, "gdk_pixbuf_get_formats", "GDKPIXBUF", "2.2")
+	core.PuregoRegisterSince(&xPixbufInitModules, libs, "gdk_pixbuf_init_modules", "GDKPIXBUF", "2.40")
+	core.PuregoRegisterSince(&xPixbufNewFromStreamAsync, libs, "gdk_pixbuf_new_from_stream_async", "GDKPIXBUF", "2.24")
+	core.PuregoRegisterSince(&xPixbufNewFromStreamAtScaleAsync, libs, "gdk_pixbuf_new_from_stream_at_scale_async", "GDKPIXBUF", "2.24")
+	core.PuregoRegisterSince(&xPixbufSaveToStreamFinish, libs, "gdk_pixbuf_save_to_stream_finish", "GDKPIXBUF", "2.24")
 
 }
@@ -0,0 +1,136 @@
+//go:build !puregotk_no_deprecated
+
+// Package gdkpixbuf was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gdkpixbuf
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xNewPixbufFromInline func(int, []byte, bool, **glib.Error) uintptr
+
+// Creates a `GdkPixbuf` from a flat representation that is suitable for
+// storing as inline data in a program.
+//
+// This is useful if you want to ship a program with images, but don't want
+// to depend on any external files.
+//
+// GdkPixbuf ships with a program called `gdk-pixbuf-csource`, which allows
+// for conversion of `GdkPixbuf`s into such a inline representation.
+//
+// In almost all cases, you should pass the `--raw` option to
+// `gdk-pixbuf-csource`. A sample invocation would be:
+//
+//	gdk-pixbuf-csource --raw --name=myimage_inline myimage.png
+//
+// For the typical case where the inline pixbuf is read-only static data,
+// you don't need to copy the pixel data unless you intend to write to
+// it, so you can pass `FALSE` for `copy_pixels`. If you pass `--rle` to
+// `gdk-pixbuf-csource`, a copy will be made even if `copy_pixels` is `FALSE`,
+// so using this option is generally a bad idea.
+//
+// If you create a pixbuf from const inline data compiled into your
+// program, it's probably safe to ignore errors and disable length checks,
+// since things will always succeed:
+//
+//	pixbuf = gdk_pixbuf_new_from_inline (-1, myimage_inline, FALSE, NULL);
+//
+// For non-const inline data, you could get out of memory. For untrusted
+// inline data located at runtime, you could have corrupt inline data in
+// addition.
+//
+// Deprecated: since 2.32. Use `GResource` instead.
+func NewPixbufFromInline(DataLengthVar int, DataVar []byte, CopyPixelsVar bool) (*Pixbuf, error) {
+	var cls *Pixbuf
+	var cerr *glib.Error
+
+	cret := xNewPixbufFromInline(DataLengthVar, DataVar, CopyPixelsVar, &cerr)
+
+	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
+		return nil, cerr
+	}
+	cls = &Pixbuf{}
+	cls.Ptr = cret
+	if cerr == nil {
+		return cls, core.PlatformError
+	}
+	return cls, cerr
+
+}
+
+var xNewPixbufFromXpmData func([]string) uintptr
+
+// Creates a new pixbuf by parsing XPM data in memory.
+//
+// This data is commonly the result of including an XPM file into a
+// program's C source.
+//
+// Deprecated: since 2.44. Use [NewPixbufFromStream] with
+//
+//	a [gio.MemoryInputStream], making sure to handle errors in
+//	case the XPM format loader is not available
+func NewPixbufFromXpmData(DataVar []string) *Pixbuf {
+	var cls *Pixbuf
+
+	cret := xNewPixbufFromXpmData(DataVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Pixbuf{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPixbufRef func(uintptr) uintptr
+
+// Adds a reference to a pixbuf.
+//
+// Deprecated: since 2.0. Use [gobject.Object.Ref].
+func (x *Pixbuf) Ref() *Pixbuf {
+	var cls *Pixbuf
+
+	cret := xPixbufRef(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Pixbuf{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPixbufUnref func(uintptr)
+
+// Removes a reference from a pixbuf.
+//
+// Deprecated: since 2.0. Use [gobject.Object.Unref].
+func (x *Pixbuf) Unref() {
+
+	xPixbufUnref(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GDKPIXBUF", "gdk-pixbuf-2.0")
+	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GDKPIXBUF") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewPixbufFromInline, libs, "gdk_pixbuf_new_from_inline", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xNewPixbufFromXpmData, libs, "gdk_pixbuf_new_from_xpm_data", "GDKPIXBUF", "")
+
+	core.PuregoRegisterSince(&xPixbufRef, libs, "gdk_pixbuf_ref", "GDKPIXBUF", "")
+	core.PuregoRegisterSince(&xPixbufUnref, libs, "gdk_pixbuf_unref", "GDKPIXBUF", "")
+
+}
@@ -2,7 +2,6 @@
 package gdkpixbuf
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -90,18 +89,18 @@ func init() {
 	core.SetSharedLibraries("GDKPIXBUF", []string{"libgdk_pixbuf-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GDKPIXBUF") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPixbufErrorQuark, libs, "gdk_pixbuf_error_quark")
+	core.PuregoRegisterSince(&xPixbufErrorQuark, libs, "gdk_pixbuf_error_quark", "GDKPIXBUF", "")
 
 	core.PuregoSafeRegister(&xPixbufNonAnimGLibType, libs, "gdk_pixbuf_non_anim_get_type")
 
-	core.PuregoSafeRegister(&xNewPixbufNonAnim, libs, "gdk_pixbuf_non_anim_new")
+	core.PuregoRegisterSince(&xNewPixbufNonAnim, libs, "gdk_pixbuf_non_anim_new", "GDKPIXBUF", "")
 
 	core.PuregoSafeRegister(&xPixbufSimpleAnimIterGLibType, libs, "gdk_pixbuf_simple_anim_iter_get_type")
 
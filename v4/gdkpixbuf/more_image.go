@@ -0,0 +1,71 @@
+package gdkpixbuf
+
+import (
+	"image"
+	"image/draw"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// imageToNRGBA returns img as non premultiplied RGBA with the origin at 0,0 and without row padding
+// the pixels of img are used directly if it already has that layout
+func imageToNRGBA(img image.Image) *image.NRGBA {
+	b := img.Bounds()
+	if n, ok := img.(*image.NRGBA); ok && b.Min == (image.Point{}) && n.Stride == 4*b.Dx() {
+		return n
+	}
+	n := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(n, n.Bounds(), img, b.Min, draw.Src)
+	return n
+}
+
+// NewPixbufFromImage creates a new pixbuf with a copy of the pixels of img.
+// The pixbuf is always 8 bits per sample RGBA, images with other color models are converted.
+func NewPixbufFromImage(img image.Image) *Pixbuf {
+	n := imageToNRGBA(img)
+	w, h := n.Rect.Dx(), n.Rect.Dy()
+	bytes := glib.NewBytes(n.Pix, uint(len(n.Pix)))
+	defer bytes.Unref()
+	return NewPixbufFromBytes(bytes, GdkColorspaceRgbValue, true, 8, w, h, n.Stride)
+}
+
+// ToImage copies the pixels of the pixbuf into a new image.
+// Pixbufs without alpha channel are converted to opaque pixels.
+// It returns nil for pixbufs that do not have 8 bits per sample, which GdkPixbuf does not create at the moment.
+func (x *Pixbuf) ToImage() *image.NRGBA {
+	if x.GetBitsPerSample() != 8 {
+		return nil
+	}
+	w, h := x.GetWidth(), x.GetHeight()
+	channels := x.GetNChannels()
+	stride := x.GetRowstride()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	if w == 0 || h == 0 {
+		return img
+	}
+
+	bytes := x.ReadPixelBytes()
+	defer bytes.Unref()
+	var size uint
+	ptr := bytes.GetData(&size)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	src := unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), size)
+
+	for y := 0; y < h; y++ {
+		// the last row is not padded to the rowstride
+		row := src[y*stride : y*stride+w*channels]
+		dst := img.Pix[y*img.Stride : y*img.Stride+4*w]
+		if channels == 4 {
+			copy(dst, row)
+			continue
+		}
+		for i := 0; i < w; i++ {
+			dst[4*i] = row[channels*i]
+			dst[4*i+1] = row[channels*i+1]
+			dst[4*i+2] = row[channels*i+2]
+			dst[4*i+3] = 0xff
+		}
+	}
+	return img
+}
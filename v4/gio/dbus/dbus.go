@@ -0,0 +1,197 @@
+// Package dbus provides a high level D-Bus client on top of gio.DBusConnection.
+//
+// Arguments and return values are converted between Go values and GVariants
+// with glib.NewVariantFromGo and (*glib.Variant).GoValue.
+package dbus
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+const propertiesInterface = "org.freedesktop.DBus.Properties"
+
+// Conn is a connection to a message bus.
+type Conn struct {
+	// Conn is the underlying connection.
+	// It can be used for everything that has no helper here, e.g. asynchronous calls.
+	Conn *gio.DBusConnection
+}
+
+// SessionBus returns a connection to the session message bus.
+// The connection is shared with the rest of the process.
+func SessionBus() (*Conn, error) {
+	return bus(gio.GBusTypeSessionValue)
+}
+
+// SystemBus returns a connection to the system message bus.
+// The connection is shared with the rest of the process.
+func SystemBus() (*Conn, error) {
+	return bus(gio.GBusTypeSystemValue)
+}
+
+func bus(t gio.BusType) (*Conn, error) {
+	c, err := gio.BusGetSync(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{Conn: c}, nil
+}
+
+// NewConn wraps an existing connection.
+func NewConn(conn *gio.DBusConnection) *Conn {
+	return &Conn{Conn: conn}
+}
+
+// Unref drops the reference to the underlying connection.
+func (c *Conn) Unref() {
+	c.Conn.Unref()
+}
+
+// UniqueName returns the unique name of the connection on the bus, e.g. ":1.42".
+func (c *Conn) UniqueName() string {
+	return c.Conn.GetUniqueName()
+}
+
+// Call synchronously calls method on the object at path of dest and returns the out arguments.
+// The GVariant type of every argument is derived from its Go type with glib.VariantSignatureOf,
+// use CallSignature when the method expects types that cannot be derived, e.g. an object path.
+func (c *Conn) Call(dest, path, iface, method string, args ...interface{}) ([]interface{}, error) {
+	params, err := glib.NewVariantTupleFromGo(args...)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(dest, path, iface, method, params)
+}
+
+// CallSignature is like Call but converts args according to signature,
+// the D-Bus signature of all the in arguments of the method, e.g. "sa{sv}".
+func (c *Conn) CallSignature(dest, path, iface, method, signature string, args ...interface{}) ([]interface{}, error) {
+	params, err := glib.NewVariantFromGoWithType("("+signature+")", args)
+	if err != nil {
+		return nil, err
+	}
+	return c.call(dest, path, iface, method, params)
+}
+
+func (c *Conn) call(dest, path, iface, method string, params *glib.Variant) ([]interface{}, error) {
+	var d *string
+	if dest != "" {
+		d = &dest
+	}
+	reply, err := c.Conn.CallSync(d, path, iface, method, params, nil, gio.GDbusCallFlagsNoneValue, -1, nil)
+	if err != nil {
+		return nil, remoteError(err)
+	}
+	defer reply.Unref()
+	ret, err := reply.GoValue()
+	if err != nil {
+		return nil, err
+	}
+	values, ok := ret.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("dbus: reply has type %T, expected a tuple", ret)
+	}
+	return values, nil
+}
+
+// GetProperty gets the property name of iface on the object at path of dest.
+func (c *Conn) GetProperty(dest, path, iface, name string) (interface{}, error) {
+	ret, err := c.Call(dest, path, propertiesInterface, "Get", iface, name)
+	if err != nil {
+		return nil, err
+	}
+	return Arg[interface{}](ret, 0)
+}
+
+// GetAllProperties gets all properties of iface on the object at path of dest.
+func (c *Conn) GetAllProperties(dest, path, iface string) (map[string]interface{}, error) {
+	ret, err := c.Call(dest, path, propertiesInterface, "GetAll", iface)
+	if err != nil {
+		return nil, err
+	}
+	return Arg[map[string]interface{}](ret, 0)
+}
+
+// SetProperty sets the property name of iface on the object at path of dest.
+// The GVariant type of value is derived from its Go type, pass a *glib.Variant to use a specific type.
+func (c *Conn) SetProperty(dest, path, iface, name string, value interface{}) error {
+	_, err := c.CallSignature(dest, path, propertiesInterface, "Set", "ssv", iface, name, value)
+	return err
+}
+
+// Signal is a received D-Bus signal.
+type Signal struct {
+	// Sender is the unique name of the sender
+	Sender string
+	// Path is the object path the signal was emitted on
+	Path string
+	// Interface is the interface of the signal
+	Interface string
+	// Member is the name of the signal
+	Member string
+	// Body are the arguments of the signal
+	Body []interface{}
+}
+
+// signalTrampoline is the single callback given to every subscription
+// the subscription is looked up by the user data such that only one callback is allocated
+var signalTrampoline gio.DBusSignalCallback = func(_ uintptr, sender, path, iface, member string, params *glib.Variant, id uintptr) {
+	fn, ok := glib.LookupUserData[func(Signal)](id)
+	if !ok {
+		return
+	}
+	sig := Signal{
+		Sender:    sender,
+		Path:      path,
+		Interface: iface,
+		Member:    member,
+	}
+	if params != nil {
+		body, err := params.GoValue()
+		if err != nil {
+			return
+		}
+		sig.Body, _ = body.([]interface{})
+	}
+	fn(sig)
+}
+
+// Subscribe calls fn for every signal that matches sender, path, iface and member.
+// An empty string matches everything.
+// fn is called from the main context that was the thread default when subscribing,
+// so a running main loop is needed to receive signals.
+// Call the returned function to unsubscribe.
+func (c *Conn) Subscribe(sender, path, iface, member string, fn func(Signal)) func() {
+	nullable := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	// the subscription is released by GIO once it is unsubscribed
+	handle := c.Conn.SignalSubscribe(nullable(sender), nullable(iface), nullable(member), nullable(path), nil, gio.GDbusSignalFlagsNoneValue, &signalTrampoline, glib.RegisterUserData(fn), glib.UserDataDestroyNotify())
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.Conn.SignalUnsubscribe(handle)
+		})
+	}
+}
+
+// Arg returns the value at index i of the out arguments ret as type T.
+// It is used by generated proxies and returns an error instead of panicking on a malformed reply.
+func Arg[T any](ret []interface{}, i int) (T, error) {
+	var zero T
+	if i >= len(ret) {
+		return zero, fmt.Errorf("dbus: reply has %d values, expected at least %d", len(ret), i+1)
+	}
+	v, ok := ret[i].(T)
+	if !ok {
+		return zero, fmt.Errorf("dbus: reply value %d has type %T, expected %T", i, ret[i], zero)
+	}
+	return v, nil
+}
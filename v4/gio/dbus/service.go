@@ -0,0 +1,425 @@
+package dbus
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/pkg/dbusproxy"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// Error is a D-Bus error with a name, e.g. org.freedesktop.DBus.Error.InvalidArgs.
+// Calls return an *Error for errors replied by the peer
+// and method handlers can return an *Error to reply with a specific error name.
+type Error struct {
+	Name    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Name + ": " + e.Message
+}
+
+// ErrorFailed is the name of the generic D-Bus error that is replied for errors that are not an *Error.
+const ErrorFailed = "org.freedesktop.DBus.Error.Failed"
+
+// remoteError converts an error replied by the peer to an *Error
+func remoteError(err error) error {
+	gerr, ok := err.(*glib.Error)
+	if !ok || !gio.DBusErrorIsRemoteError(gerr) {
+		return err
+	}
+	name := gio.DBusErrorGetRemoteError(gerr)
+	gio.DBusErrorStripRemoteError(gerr)
+	return &Error{Name: name, Message: gerr.MessageGo()}
+}
+
+func asError(err error) *Error {
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+	return &Error{Name: ErrorFailed, Message: err.Error()}
+}
+
+// Call is an incoming method call on an exported object.
+type Call struct {
+	// Sender is the unique name of the caller
+	Sender string
+	// Path is the object path the method was called on
+	Path string
+	// Interface is the interface of the method
+	Interface string
+	// Method is the name of the method
+	Method string
+	// Args are the in arguments of the method
+	Args []interface{}
+}
+
+// MethodFunc handles a method call and returns the out arguments.
+// The out arguments are converted according to the signature in the introspection data.
+type MethodFunc func(call Call) ([]interface{}, error)
+
+// Property is a property of an exported interface.
+// Get is used for readable properties and Set for writable properties.
+type Property struct {
+	Get func() (interface{}, error)
+	Set func(value interface{}) error
+}
+
+// Interface implements an exported D-Bus interface.
+// Methods and Properties are keyed by their D-Bus name.
+type Interface struct {
+	Methods    map[string]MethodFunc
+	Properties map[string]Property
+}
+
+// registration is the state of a single interface registered on an object path
+type registration struct {
+	impl Interface
+	// out are the signatures of the out arguments per method
+	out map[string]string
+	// props are the signatures of the properties
+	props map[string]string
+}
+
+func lookupRegistration(id uintptr) (*registration, bool) {
+	return glib.LookupUserData[*registration](id)
+}
+
+// setError sets a GError for a **GError out parameter
+func setError(ptr uintptr, err error) {
+	if ptr == 0 {
+		return
+	}
+	e := asError(err)
+	gerr := gio.DBusErrorNewForDbusError(e.Name, e.Message)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	*(**glib.Error)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))) = gerr
+}
+
+func methodCall(_, sender, path, iface, method, params, invocation, id uintptr) {
+	defer core.RecoverPanic()
+	inv := gio.DBusMethodInvocationNewFromInternalPtr(invocation)
+	r, ok := lookupRegistration(id)
+	if !ok {
+		inv.ReturnDbusError("org.freedesktop.DBus.Error.UnknownObject", "object is not exported anymore")
+		return
+	}
+	name := core.GoString(method)
+	fn, ok := r.impl.Methods[name]
+	if !ok {
+		inv.ReturnDbusError("org.freedesktop.DBus.Error.UnknownMethod", fmt.Sprintf("method %s is not implemented", name))
+		return
+	}
+	call := Call{
+		Sender:    core.GoString(sender),
+		Path:      core.GoString(path),
+		Interface: core.GoString(iface),
+		Method:    name,
+	}
+	if v := glib.VariantNewFromInternalPtr(params); v != nil {
+		args, err := v.GoValue()
+		if err != nil {
+			inv.ReturnDbusError("org.freedesktop.DBus.Error.InvalidArgs", err.Error())
+			return
+		}
+		call.Args, _ = args.([]interface{})
+	}
+	out, err := fn(call)
+	if err != nil {
+		e := asError(err)
+		inv.ReturnDbusError(e.Name, e.Message)
+		return
+	}
+	ret, err := glib.NewVariantFromGoWithType("("+r.out[name]+")", out)
+	if err != nil {
+		inv.ReturnDbusError(ErrorFailed, err.Error())
+		return
+	}
+	inv.ReturnValue(ret)
+}
+
+func getProperty(_, _, _, _, prop, errPtr, id uintptr) uintptr {
+	defer core.RecoverPanic()
+	r, ok := lookupRegistration(id)
+	if !ok {
+		setError(errPtr, &Error{Name: "org.freedesktop.DBus.Error.UnknownObject", Message: "object is not exported anymore"})
+		return 0
+	}
+	name := core.GoString(prop)
+	p, ok := r.impl.Properties[name]
+	if !ok || p.Get == nil {
+		setError(errPtr, &Error{Name: "org.freedesktop.DBus.Error.UnknownProperty", Message: fmt.Sprintf("property %s is not readable", name)})
+		return 0
+	}
+	value, err := p.Get()
+	if err != nil {
+		setError(errPtr, err)
+		return 0
+	}
+	v, err := glib.NewVariantFromGoWithType(r.props[name], value)
+	if err != nil {
+		setError(errPtr, err)
+		return 0
+	}
+	return uintptr(unsafe.Pointer(v))
+}
+
+func setProperty(_, _, _, _, prop, value, errPtr, id uintptr) uintptr {
+	defer core.RecoverPanic()
+	r, ok := lookupRegistration(id)
+	if !ok {
+		setError(errPtr, &Error{Name: "org.freedesktop.DBus.Error.UnknownObject", Message: "object is not exported anymore"})
+		return 0
+	}
+	name := core.GoString(prop)
+	p, ok := r.impl.Properties[name]
+	if !ok || p.Set == nil {
+		setError(errPtr, &Error{Name: "org.freedesktop.DBus.Error.PropertyReadOnly", Message: fmt.Sprintf("property %s is not writable", name)})
+		return 0
+	}
+	v, err := glib.VariantNewFromInternalPtr(value).GoValue()
+	if err == nil {
+		err = p.Set(v)
+	}
+	if err != nil {
+		setError(errPtr, err)
+		return 0
+	}
+	return 1
+}
+
+// vtable is the C layout of GDBusInterfaceVTable
+type vtable struct {
+	methodCall  uintptr
+	getProperty uintptr
+	setProperty uintptr
+	padding     [8]uintptr
+}
+
+var (
+	serviceVTableOnce sync.Once
+	// serviceVTable is shared by every registration
+	// the registration is looked up by the user data such that the callbacks are only allocated once
+	serviceVTable *vtable
+)
+
+// xRegisterObject is g_dbus_connection_register_object,
+// which the generated gio.DBusConnection.RegisterObject deprecates in favour of the variant with closures that shadows it
+var xRegisterObject func(uintptr, string, *gio.DBusInterfaceInfo, *gio.DBusInterfaceVTable, uintptr, uintptr, **glib.Error) uint
+
+// registerObject registers the interface on path with the shared vtable, key is the key of the registration
+func registerObject(conn *gio.DBusConnection, path string, info *gio.DBusInterfaceInfo, key uintptr) (uint, error) {
+	var cerr *glib.Error
+	id := xRegisterObject(conn.GoPointer(), path, info, sharedVTable(), key, 0, &cerr)
+	if id == 0 {
+		if cerr == nil {
+			return 0, core.PlatformError
+		}
+		return 0, cerr
+	}
+	return id, nil
+}
+
+func sharedVTable() *gio.DBusInterfaceVTable {
+	serviceVTableOnce.Do(func() {
+		serviceVTable = &vtable{
+			methodCall:  core.NewCallback(methodCall),
+			getProperty: core.NewCallback(getProperty),
+			setProperty: core.NewCallback(setProperty),
+		}
+	})
+	return (*gio.DBusInterfaceVTable)(unsafe.Pointer(serviceVTable))
+}
+
+// Object is a Go object exported on the bus.
+type Object struct {
+	conn *Conn
+	path string
+	node *gio.DBusNodeInfo
+	// signals are the signatures of the signals per interface and signal name
+	signals map[string]map[string]string
+	// regs are the registrations per interface
+	regs map[string]*registration
+	ids  []uint
+	keys []uintptr
+}
+
+// Export exports an object on path that implements the interfaces described by introspectionXML.
+// ifaces maps the name of every interface in introspectionXML to its implementation.
+// Method handlers and properties are called from the main context that was the thread default when exporting.
+func (c *Conn) Export(path string, introspectionXML string, ifaces map[string]Interface) (*Object, error) {
+	parsed, err := dbusproxy.Parse([]byte(introspectionXML))
+	if err != nil {
+		return nil, err
+	}
+	node, err := gio.NewDBusNodeInfoForXml(introspectionXML)
+	if err != nil {
+		return nil, err
+	}
+	o := &Object{
+		conn:    c,
+		path:    path,
+		node:    node,
+		signals: make(map[string]map[string]string),
+		regs:    make(map[string]*registration),
+	}
+	described := make(map[string]bool)
+	for _, iface := range parsed.Interfaces {
+		described[iface.Name] = true
+		impl, ok := ifaces[iface.Name]
+		if !ok {
+			o.Unexport()
+			return nil, fmt.Errorf("dbus: no implementation for interface %s", iface.Name)
+		}
+		r := &registration{
+			impl:  impl,
+			out:   make(map[string]string),
+			props: make(map[string]string),
+		}
+		for _, m := range iface.Methods {
+			var sig strings.Builder
+			for _, a := range m.Args {
+				if a.Direction == "out" {
+					sig.WriteString(a.Type)
+				}
+			}
+			r.out[m.Name] = sig.String()
+		}
+		for _, p := range iface.Properties {
+			r.props[p.Name] = p.Type
+		}
+		o.regs[iface.Name] = r
+		o.signals[iface.Name] = make(map[string]string)
+		for _, s := range iface.Signals {
+			var sig strings.Builder
+			for _, a := range s.Args {
+				sig.WriteString(a.Type)
+			}
+			o.signals[iface.Name][s.Name] = sig.String()
+		}
+
+		key := glib.RegisterUserData(r)
+		o.keys = append(o.keys, key)
+
+		id, err := registerObject(c.Conn, path, node.LookupInterface(iface.Name), key)
+		if err != nil {
+			o.Unexport()
+			return nil, err
+		}
+		o.ids = append(o.ids, id)
+	}
+	for name := range ifaces {
+		if !described[name] {
+			o.Unexport()
+			return nil, fmt.Errorf("dbus: interface %s is not described by the introspection data", name)
+		}
+	}
+	return o, nil
+}
+
+// Path returns the object path the object is exported on.
+func (o *Object) Path() string {
+	return o.path
+}
+
+// Emit emits signal of iface from the object to all listeners.
+// args are converted according to the signature in the introspection data.
+func (o *Object) Emit(iface, signal string, args ...interface{}) error {
+	sig, ok := o.signals[iface][signal]
+	if !ok {
+		return fmt.Errorf("dbus: signal %s.%s is not described by the introspection data", iface, signal)
+	}
+	params, err := glib.NewVariantFromGoWithType("("+sig+")", args)
+	if err != nil {
+		return err
+	}
+	_, err = o.conn.Conn.EmitSignal(nil, o.path, iface, signal, params)
+	return err
+}
+
+// EmitPropertiesChanged emits org.freedesktop.DBus.Properties.PropertiesChanged with the current values of the properties names of iface.
+func (o *Object) EmitPropertiesChanged(iface string, names ...string) error {
+	changed := make(map[string]interface{}, len(names))
+	r, ok := o.regs[iface]
+	if !ok {
+		return fmt.Errorf("dbus: interface %s is not exported", iface)
+	}
+	for _, name := range names {
+		p, ok := r.impl.Properties[name]
+		if !ok || p.Get == nil {
+			return fmt.Errorf("dbus: property %s.%s is not readable", iface, name)
+		}
+		value, err := p.Get()
+		if err != nil {
+			return err
+		}
+		v, err := glib.NewVariantFromGoWithType(r.props[name], value)
+		if err != nil {
+			return err
+		}
+		changed[name] = v
+	}
+	params, err := glib.NewVariantFromGoWithType("(sa{sv}as)", []interface{}{iface, changed, []string{}})
+	if err != nil {
+		return err
+	}
+	_, err = o.conn.Conn.EmitSignal(nil, o.path, propertiesInterface, "PropertiesChanged", params)
+	return err
+}
+
+// Unexport removes the object from the bus.
+func (o *Object) Unexport() {
+	for _, id := range o.ids {
+		o.conn.Conn.UnregisterObject(id)
+	}
+	for _, key := range o.keys {
+		glib.UnregisterUserData(key)
+	}
+	o.ids, o.keys = nil, nil
+	if o.node != nil {
+		o.node.Unref()
+		o.node = nil
+	}
+}
+
+// RequestName requests the well known name on the bus such that peers can reach exported objects by it.
+// It fails if the name is already owned by another connection.
+func (c *Conn) RequestName(name string) error {
+	// DBUS_NAME_FLAG_DO_NOT_QUEUE
+	ret, err := c.CallSignature("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "RequestName", "su", name, 4)
+	if err != nil {
+		return err
+	}
+	reply, err := Arg[uint32](ret, 0)
+	if err != nil {
+		return err
+	}
+	// DBUS_REQUEST_NAME_REPLY_PRIMARY_OWNER and DBUS_REQUEST_NAME_REPLY_ALREADY_OWNER
+	if reply != 1 && reply != 4 {
+		return fmt.Errorf("dbus: name %s is already owned", name)
+	}
+	return nil
+}
+
+// ReleaseName releases a well known name that was requested with RequestName.
+func (c *Conn) ReleaseName(name string) error {
+	_, err := c.Call("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "ReleaseName", name)
+	return err
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xRegisterObject, libs, "g_dbus_connection_register_object")
+}
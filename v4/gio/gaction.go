@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for [type@Gio.Action].
+// The virtual function table for [Action].
 type ActionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetName uintptr
 
@@ -40,196 +39,204 @@ func (x *ActionInterface) GoPointer() uintptr {
 }
 
 // OverrideGetName sets the "get_name" callback function.
-// the virtual function pointer for [method@Gio.Action.get_name]
+// the virtual function pointer for [Action.GetName]
 func (x *ActionInterface) OverrideGetName(cb func(Action) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(ActionVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(ActionVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetName gets the "get_name" callback function.
-// the virtual function pointer for [method@Gio.Action.get_name]
+// the virtual function pointer for [Action.GetName]
 func (x *ActionInterface) GetGetName() func(Action) string {
 	if x.xGetName == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(ActionVar Action) string {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideGetParameterType sets the "get_parameter_type" callback function.
-// the virtual function pointer for [method@Gio.Action.get_parameter_type]
+// the virtual function pointer for [Action.GetParameterType]
 func (x *ActionInterface) OverrideGetParameterType(cb func(Action) *glib.VariantType) {
 	if cb == nil {
 		x.xGetParameterType = 0
 	} else {
-		x.xGetParameterType = purego.NewCallback(func(ActionVarp uintptr) *glib.VariantType {
+		x.xGetParameterType = core.NewCallback(func(ActionVarp uintptr) *glib.VariantType {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetParameterType gets the "get_parameter_type" callback function.
-// the virtual function pointer for [method@Gio.Action.get_parameter_type]
+// the virtual function pointer for [Action.GetParameterType]
 func (x *ActionInterface) GetGetParameterType() func(Action) *glib.VariantType {
 	if x.xGetParameterType == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) *glib.VariantType
-	purego.RegisterFunc(&rawCallback, x.xGetParameterType)
+	core.RegisterFunc(&rawCallback, x.xGetParameterType)
 	return func(ActionVar Action) *glib.VariantType {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideGetStateType sets the "get_state_type" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state_type]
+// the virtual function pointer for [Action.GetStateType]
 func (x *ActionInterface) OverrideGetStateType(cb func(Action) *glib.VariantType) {
 	if cb == nil {
 		x.xGetStateType = 0
 	} else {
-		x.xGetStateType = purego.NewCallback(func(ActionVarp uintptr) *glib.VariantType {
+		x.xGetStateType = core.NewCallback(func(ActionVarp uintptr) *glib.VariantType {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetStateType gets the "get_state_type" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state_type]
+// the virtual function pointer for [Action.GetStateType]
 func (x *ActionInterface) GetGetStateType() func(Action) *glib.VariantType {
 	if x.xGetStateType == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) *glib.VariantType
-	purego.RegisterFunc(&rawCallback, x.xGetStateType)
+	core.RegisterFunc(&rawCallback, x.xGetStateType)
 	return func(ActionVar Action) *glib.VariantType {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideGetStateHint sets the "get_state_hint" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state_hint]
+// the virtual function pointer for [Action.GetStateHint]
 func (x *ActionInterface) OverrideGetStateHint(cb func(Action) *glib.Variant) {
 	if cb == nil {
 		x.xGetStateHint = 0
 	} else {
-		x.xGetStateHint = purego.NewCallback(func(ActionVarp uintptr) *glib.Variant {
+		x.xGetStateHint = core.NewCallback(func(ActionVarp uintptr) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetStateHint gets the "get_state_hint" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state_hint]
+// the virtual function pointer for [Action.GetStateHint]
 func (x *ActionInterface) GetGetStateHint() func(Action) *glib.Variant {
 	if x.xGetStateHint == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetStateHint)
+	core.RegisterFunc(&rawCallback, x.xGetStateHint)
 	return func(ActionVar Action) *glib.Variant {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideGetEnabled sets the "get_enabled" callback function.
-// the virtual function pointer for [method@Gio.Action.get_enabled]
+// the virtual function pointer for [Action.GetEnabled]
 func (x *ActionInterface) OverrideGetEnabled(cb func(Action) bool) {
 	if cb == nil {
 		x.xGetEnabled = 0
 	} else {
-		x.xGetEnabled = purego.NewCallback(func(ActionVarp uintptr) bool {
+		x.xGetEnabled = core.NewCallback(func(ActionVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetEnabled gets the "get_enabled" callback function.
-// the virtual function pointer for [method@Gio.Action.get_enabled]
+// the virtual function pointer for [Action.GetEnabled]
 func (x *ActionInterface) GetGetEnabled() func(Action) bool {
 	if x.xGetEnabled == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xGetEnabled)
+	core.RegisterFunc(&rawCallback, x.xGetEnabled)
 	return func(ActionVar Action) bool {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideGetState sets the "get_state" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state]
+// the virtual function pointer for [Action.GetState]
 func (x *ActionInterface) OverrideGetState(cb func(Action) *glib.Variant) {
 	if cb == nil {
 		x.xGetState = 0
 	} else {
-		x.xGetState = purego.NewCallback(func(ActionVarp uintptr) *glib.Variant {
+		x.xGetState = core.NewCallback(func(ActionVarp uintptr) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&ActionBase{Ptr: ActionVarp})
 		})
 	}
 }
 
 // GetGetState gets the "get_state" callback function.
-// the virtual function pointer for [method@Gio.Action.get_state]
+// the virtual function pointer for [Action.GetState]
 func (x *ActionInterface) GetGetState() func(Action) *glib.Variant {
 	if x.xGetState == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetState)
+	core.RegisterFunc(&rawCallback, x.xGetState)
 	return func(ActionVar Action) *glib.Variant {
 		return rawCallback(ActionVar.GoPointer())
 	}
 }
 
 // OverrideChangeState sets the "change_state" callback function.
-// the virtual function pointer for [method@Gio.Action.change_state]
+// the virtual function pointer for [Action.ChangeState]
 func (x *ActionInterface) OverrideChangeState(cb func(Action, *glib.Variant)) {
 	if cb == nil {
 		x.xChangeState = 0
 	} else {
-		x.xChangeState = purego.NewCallback(func(ActionVarp uintptr, ValueVarp *glib.Variant) {
+		x.xChangeState = core.NewCallback(func(ActionVarp uintptr, ValueVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionBase{Ptr: ActionVarp}, ValueVarp)
 		})
 	}
 }
 
 // GetChangeState gets the "change_state" callback function.
-// the virtual function pointer for [method@Gio.Action.change_state]
+// the virtual function pointer for [Action.ChangeState]
 func (x *ActionInterface) GetChangeState() func(Action, *glib.Variant) {
 	if x.xChangeState == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr, ValueVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xChangeState)
+	core.RegisterFunc(&rawCallback, x.xChangeState)
 	return func(ActionVar Action, ValueVar *glib.Variant) {
 		rawCallback(ActionVar.GoPointer(), ValueVar)
 	}
 }
 
 // OverrideActivate sets the "activate" callback function.
-// the virtual function pointer for [method@Gio.Action.activate].  Note that [type@Gio.Action] does not have an
+// the virtual function pointer for [Action.Activate].  Note that [Action] does not have an
 //
 //	'activate' signal but that implementations of it may have one.
 func (x *ActionInterface) OverrideActivate(cb func(Action, *glib.Variant)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(ActionVarp uintptr, ParameterVarp *glib.Variant) {
+		x.xActivate = core.NewCallback(func(ActionVarp uintptr, ParameterVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionBase{Ptr: ActionVarp}, ParameterVarp)
 		})
 	}
 }
 
 // GetActivate gets the "activate" callback function.
-// the virtual function pointer for [method@Gio.Action.activate].  Note that [type@Gio.Action] does not have an
+// the virtual function pointer for [Action.Activate].  Note that [Action] does not have an
 //
 //	'activate' signal but that implementations of it may have one.
 func (x *ActionInterface) GetActivate() func(Action, *glib.Variant) {
@@ -237,7 +244,7 @@ func (x *ActionInterface) GetActivate() func(Action, *glib.Variant) {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr, ParameterVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(ActionVar Action, ParameterVar *glib.Variant) {
 		rawCallback(ActionVar.GoPointer(), ParameterVar)
 	}
@@ -246,13 +253,13 @@ func (x *ActionInterface) GetActivate() func(Action, *glib.Variant) {
 // `GAction` represents a single named action.
 //
 // The main interface to an action is that it can be activated with
-// [method@Gio.Action.activate]. This results in the 'activate' signal being
+// [Action.Activate]. This results in the 'activate' signal being
 // emitted. An activation has a `GVariant` parameter (which may be
 // `NULL`). The correct type for the parameter is determined by a static
 // parameter type (which is given at construction time).
 //
 // An action may optionally have a state, in which case the state may be
-// set with [method@Gio.Action.change_state]. This call takes a [type@GLib.Variant]. The
+// set with [Action.ChangeState]. This call takes a [glib.Variant]. The
 // correct type for the state is determined by a static state type
 // (which is given at construction time).
 //
@@ -261,17 +268,17 @@ func (x *ActionInterface) GetActivate() func(Action, *glib.Variant) {
 //
 // `GAction` is merely the interface to the concept of an action, as
 // described above.  Various implementations of actions exist, including
-// [class@Gio.SimpleAction].
+// [SimpleAction].
 //
 // In all cases, the implementing class is responsible for storing the
 // name of the action, the parameter type, the enabled state, the optional
 // state type and the state and emitting the appropriate signals when these
 // change. The implementor is responsible for filtering calls to
-// [method@Gio.Action.activate] and [method@Gio.Action.change_state]
+// [Action.Activate] and [Action.ChangeState]
 // for type safety and for the state being enabled.
 //
 // Probably the only useful thing to do with a `GAction` is to put it
-// inside of a [class@Gio.SimpleActionGroup].
+// inside of a [SimpleActionGroup].
 type Action interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -306,36 +313,105 @@ func (x *ActionBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementAction adds the Action interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetName() string
+//   - GetParameterType() *glib.VariantType
+//   - GetStateType() *glib.VariantType
+//   - GetStateHint() *glib.Variant
+//   - GetEnabled() bool
+//   - GetState() *glib.Variant
+//   - ChangeState(*glib.Variant)
+//   - Activate(*glib.Variant)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ActionInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAction[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ActionGLibType(), func(iface *ActionInterface) {
+		if _, ok := any(zero).(interface{ GetName() string }); ok {
+			iface.OverrideGetName(func(self Action) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetName() string })
+				return impl.GetName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetParameterType() *glib.VariantType }); ok {
+			iface.OverrideGetParameterType(func(self Action) *glib.VariantType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetParameterType() *glib.VariantType })
+				return impl.GetParameterType()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetStateType() *glib.VariantType }); ok {
+			iface.OverrideGetStateType(func(self Action) *glib.VariantType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetStateType() *glib.VariantType })
+				return impl.GetStateType()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetStateHint() *glib.Variant }); ok {
+			iface.OverrideGetStateHint(func(self Action) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetStateHint() *glib.Variant })
+				return impl.GetStateHint()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetEnabled() bool }); ok {
+			iface.OverrideGetEnabled(func(self Action) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetEnabled() bool })
+				return impl.GetEnabled()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetState() *glib.Variant }); ok {
+			iface.OverrideGetState(func(self Action) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetState() *glib.Variant })
+				return impl.GetState()
+			})
+		}
+		if _, ok := any(zero).(interface{ ChangeState(*glib.Variant) }); ok {
+			iface.OverrideChangeState(func(self Action, arg0 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ChangeState(*glib.Variant) })
+				impl.ChangeState(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ Activate(*glib.Variant) }); ok {
+			iface.OverrideActivate(func(self Action, arg0 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Activate(*glib.Variant) })
+				impl.Activate(arg0)
+			})
+		}
+	})
+}
+
 // Activates the action.
 //
-// @parameter must be the correct type of parameter for the action (ie:
+// parameter must be the correct type of parameter for the action (ie:
 // the parameter type given at construction time).  If the parameter
-// type was `NULL` then @parameter must also be `NULL`.
+// type was `NULL` then parameter must also be `NULL`.
 //
-// If the @parameter [type@GLib.Variant] is floating, it is consumed.
+// If the parameter [glib.Variant] is floating, it is consumed.
 func (x *ActionBase) Activate(ParameterVar *glib.Variant) {
 
 	XGActionActivate(x.GoPointer(), ParameterVar)
 
 }
 
-// Request for the state of @action to be changed to @value.
+// Request for the state of action to be changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.Action.get_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [Action.GetStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.Action.get_state_hint].
+// its state or may change its state to something other than value.
+// See [Action.GetStateHint].
 //
-// If the @value [type@GLib.Variant] is floating, it is consumed.
+// If the value [glib.Variant] is floating, it is consumed.
 func (x *ActionBase) ChangeState(ValueVar *glib.Variant) {
 
 	XGActionChangeState(x.GoPointer(), ValueVar)
 
 }
 
-// Checks if @action is currently enabled.
+// Checks if action is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -345,7 +421,7 @@ func (x *ActionBase) GetEnabled() bool {
 	return cret
 }
 
-// Queries the name of @action.
+// Queries the name of action.
 func (x *ActionBase) GetName() string {
 
 	cret := XGActionGetName(x.GoPointer())
@@ -353,28 +429,28 @@ func (x *ActionBase) GetName() string {
 }
 
 // Queries the type of the parameter that must be given when activating
-// @action.
+// action.
 //
-// When activating the action using [method@Gio.Action.activate], the
-// [type@GLib.Variant] given to that function must be of the type returned by
+// When activating the action using [Action.Activate], the
+// [glib.Variant] given to that function must be of the type returned by
 // this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 func (x *ActionBase) GetParameterType() *glib.VariantType {
 
 	cret := XGActionGetParameterType(x.GoPointer())
 	return cret
 }
 
-// Queries the current state of @action.
+// Queries the current state of action.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.Action.get_state_type].
+// given by [Action.GetStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ActionBase) GetState() *glib.Variant {
 
 	cret := XGActionGetState(x.GoPointer())
@@ -382,14 +458,14 @@ func (x *ActionBase) GetState() *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of
-// @action.
+// action.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -398,25 +474,25 @@ func (x *ActionBase) GetState() *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ActionBase) GetStateHint() *glib.Variant {
 
 	cret := XGActionGetStateHint(x.GoPointer())
 	return cret
 }
 
-// Queries the type of the state of @action.
+// Queries the type of the state of action.
 //
 // If the action is stateful (e.g. created with
-// [ctor@Gio.SimpleAction.new_stateful]) then this function returns the
-// [type@GLib.VariantType] of the state.  This is the type of the initial value
-// given as the state. All calls to [method@Gio.Action.change_state] must give a
-// [type@GLib.Variant] of this type and [method@Gio.Action.get_state] will return a
-// [type@GLib.Variant] of the same type.
-//
-// If the action is not stateful (e.g. created with [ctor@Gio.SimpleAction.new])
-// then this function will return `NULL`. In that case, [method@Gio.Action.get_state]
-// will return `NULL` and you must not call [method@Gio.Action.change_state].
+// [NewSimpleActionStateful]) then this function returns the
+// [glib.VariantType] of the state.  This is the type of the initial value
+// given as the state. All calls to [Action.ChangeState] must give a
+// [glib.Variant] of this type and [Action.GetState] will return a
+// [glib.Variant] of the same type.
+//
+// If the action is not stateful (e.g. created with [NewSimpleAction])
+// then this function will return `NULL`. In that case, [Action.GetState]
+// will return `NULL` and you must not call [Action.ChangeState].
 func (x *ActionBase) GetStateType() *glib.VariantType {
 
 	cret := XGActionGetStateType(x.GoPointer())
@@ -424,10 +500,10 @@ func (x *ActionBase) GetStateType() *glib.VariantType {
 }
 
 // GetPropertyEnabled gets the "enabled" property.
-// If @action is currently enabled.
+// If action is currently enabled.
 //
-// If the action is disabled then calls to [method@Gio.Action.activate] and
-// [method@Gio.Action.change_state] have no effect.
+// If the action is disabled then calls to [Action.Activate] and
+// [Action.ChangeState] have no effect.
 func (x *ActionBase) GetPropertyEnabled() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -438,7 +514,7 @@ func (x *ActionBase) GetPropertyEnabled() bool {
 
 // GetPropertyName gets the "name" property.
 // The name of the action.  This is mostly meaningful for identifying
-// the action once it has been added to a [type@Gio.ActionGroup]. It is immutable.
+// the action once it has been added to a [ActionGroup]. It is immutable.
 func (x *ActionBase) GetPropertyName() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -470,7 +546,7 @@ func (x *ActionBase) GetPropertyState() uintptr {
 }
 
 // GetPropertyStateType gets the "state-type" property.
-// The [type@GLib.VariantType] of the state that the action has, or `NULL` if the
+// The [glib.VariantType] of the state that the action has, or `NULL` if the
 // action is stateless. This is immutable.
 func (x *ActionBase) GetPropertyStateType() uintptr {
 	obj := gobject.Object{}
@@ -491,13 +567,13 @@ var XGActionGetStateType func(uintptr) *glib.VariantType
 
 var xActionNameIsValid func(string) bool
 
-// Checks if @action_name is valid.
+// Checks if action_name is valid.
 //
-// @action_name is valid if it consists only of alphanumeric characters,
+// action_name is valid if it consists only of alphanumeric characters,
 // plus `-` and `.`.  The empty string is not a valid action name.
 //
-// It is an error to call this function with a non-UTF-8 @action_name.
-// @action_name must not be `NULL`.
+// It is an error to call this function with a non-UTF-8 action_name.
+// action_name must not be `NULL`.
 func ActionNameIsValid(ActionNameVar string) bool {
 
 	cret := xActionNameIsValid(ActionNameVar)
@@ -524,7 +600,7 @@ var xActionParseDetailedName func(string, *string, **glib.Variant, **glib.Error)
 // The third format is used to represent an action with any type of
 // target value, including strings.  The target value follows the action
 // name, surrounded in parens.  For example: `app.action(42)`.  The
-// target value is parsed using [func@GLib.Variant.parse].  If a tuple-typed
+// target value is parsed using [glib.VariantParse].  If a tuple-typed
 // value is desired, it must be specified in the same way, resulting in
 // two sets of parens, for example: `app.action((1,2,3))`.  A string
 // target can be specified this way as well: `app.action('target')`.
@@ -532,16 +608,16 @@ var xActionParseDetailedName func(string, *string, **glib.Variant, **glib.Error)
 // empty or contains characters other than alphanumerics, `-` and `.`.
 //
 // If this function returns `TRUE`, a non-`NULL` value is guaranteed to be returned
-// in @action_name (if a pointer is passed in). A `NULL` value may still be
-// returned in @target_value, as the @detailed_name may not contain a target.
+// in action_name (if a pointer is passed in). A `NULL` value may still be
+// returned in target_value, as the detailed_name may not contain a target.
 //
-// If returned, the [type@GLib.Variant] in @target_value is guaranteed to not be floating.
+// If returned, the [glib.Variant] in target_value is guaranteed to not be floating.
 func ActionParseDetailedName(DetailedNameVar string, ActionNameVar *string, TargetValueVar **glib.Variant) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xActionParseDetailedName(DetailedNameVar, ActionNameVar, TargetValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -549,13 +625,13 @@ func ActionParseDetailedName(DetailedNameVar string, ActionNameVar *string, Targ
 
 var xActionPrintDetailedName func(string, *glib.Variant) string
 
-// Formats a detailed action name from @action_name and @target_value.
+// Formats a detailed action name from action_name and target_value.
 //
 // It is an error to call this function with an invalid action name.
 //
-// This function is the opposite of [func@Gio.Action.parse_detailed_name].
-// It will produce a string that can be parsed back to the @action_name
-// and @target_value by that function.
+// This function is the opposite of [ActionParseDetailedName].
+// It will produce a string that can be parsed back to the action_name
+// and target_value by that function.
 //
 // See that function for the types of strings that will be printed by
 // this function.
@@ -570,26 +646,28 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xActionNameIsValid, libs, "g_action_name_is_valid")
-	core.PuregoSafeRegister(&xActionParseDetailedName, libs, "g_action_parse_detailed_name")
-	core.PuregoSafeRegister(&xActionPrintDetailedName, libs, "g_action_print_detailed_name")
+	core.PuregoRegisterSince(&xActionNameIsValid, libs, "g_action_name_is_valid", "GIO", "2.38")
+	core.PuregoRegisterSince(&xActionParseDetailedName, libs, "g_action_parse_detailed_name", "GIO", "2.38")
+	core.PuregoRegisterSince(&xActionPrintDetailedName, libs, "g_action_print_detailed_name", "GIO", "2.38")
 
 	core.PuregoSafeRegister(&xActionGLibType, libs, "g_action_get_type")
 
-	core.PuregoSafeRegister(&XGActionActivate, libs, "g_action_activate")
-	core.PuregoSafeRegister(&XGActionChangeState, libs, "g_action_change_state")
-	core.PuregoSafeRegister(&XGActionGetEnabled, libs, "g_action_get_enabled")
-	core.PuregoSafeRegister(&XGActionGetName, libs, "g_action_get_name")
-	core.PuregoSafeRegister(&XGActionGetParameterType, libs, "g_action_get_parameter_type")
-	core.PuregoSafeRegister(&XGActionGetState, libs, "g_action_get_state")
-	core.PuregoSafeRegister(&XGActionGetStateHint, libs, "g_action_get_state_hint")
-	core.PuregoSafeRegister(&XGActionGetStateType, libs, "g_action_get_state_type")
+	gobject.CheckClassSize("GActionInterface", xActionGLibType, unsafe.Sizeof(ActionInterface{}))
+
+	core.PuregoRegisterSince(&XGActionActivate, libs, "g_action_activate", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionChangeState, libs, "g_action_change_state", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGActionGetEnabled, libs, "g_action_get_enabled", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGetName, libs, "g_action_get_name", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGetParameterType, libs, "g_action_get_parameter_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGetState, libs, "g_action_get_state", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGetStateHint, libs, "g_action_get_state_hint", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGetStateType, libs, "g_action_get_state_type", "GIO", "2.28")
 
 }
@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for [type@Gio.ActionGroup].
+// The virtual function table for [ActionGroup].
 type ActionGroupInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xHasAction uintptr
 
@@ -51,350 +51,364 @@ func (x *ActionGroupInterface) GoPointer() uintptr {
 }
 
 // OverrideHasAction sets the "has_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.has_action]
+// the virtual function pointer for [ActionGroup.HasAction]
 func (x *ActionGroupInterface) OverrideHasAction(cb func(ActionGroup, string) bool) {
 	if cb == nil {
 		x.xHasAction = 0
 	} else {
-		x.xHasAction = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) bool {
+		x.xHasAction = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetHasAction gets the "has_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.has_action]
+// the virtual function pointer for [ActionGroup.HasAction]
 func (x *ActionGroupInterface) GetHasAction() func(ActionGroup, string) bool {
 	if x.xHasAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xHasAction)
+	core.RegisterFunc(&rawCallback, x.xHasAction)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) bool {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideListActions sets the "list_actions" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.list_actions]
+// the virtual function pointer for [ActionGroup.ListActions]
 func (x *ActionGroupInterface) OverrideListActions(cb func(ActionGroup) []string) {
 	if cb == nil {
 		x.xListActions = 0
 	} else {
-		x.xListActions = purego.NewCallback(func(ActionGroupVarp uintptr) []string {
+		x.xListActions = core.NewCallback(func(ActionGroupVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp})
 		})
 	}
 }
 
 // GetListActions gets the "list_actions" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.list_actions]
+// the virtual function pointer for [ActionGroup.ListActions]
 func (x *ActionGroupInterface) GetListActions() func(ActionGroup) []string {
 	if x.xListActions == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xListActions)
+	core.RegisterFunc(&rawCallback, x.xListActions)
 	return func(ActionGroupVar ActionGroup) []string {
 		return rawCallback(ActionGroupVar.GoPointer())
 	}
 }
 
 // OverrideGetActionEnabled sets the "get_action_enabled" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_enabled]
+// the virtual function pointer for [ActionGroup.GetActionEnabled]
 func (x *ActionGroupInterface) OverrideGetActionEnabled(cb func(ActionGroup, string) bool) {
 	if cb == nil {
 		x.xGetActionEnabled = 0
 	} else {
-		x.xGetActionEnabled = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) bool {
+		x.xGetActionEnabled = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetGetActionEnabled gets the "get_action_enabled" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_enabled]
+// the virtual function pointer for [ActionGroup.GetActionEnabled]
 func (x *ActionGroupInterface) GetGetActionEnabled() func(ActionGroup, string) bool {
 	if x.xGetActionEnabled == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xGetActionEnabled)
+	core.RegisterFunc(&rawCallback, x.xGetActionEnabled)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) bool {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideGetActionParameterType sets the "get_action_parameter_type" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_parameter_type]
+// the virtual function pointer for [ActionGroup.GetActionParameterType]
 func (x *ActionGroupInterface) OverrideGetActionParameterType(cb func(ActionGroup, string) *glib.VariantType) {
 	if cb == nil {
 		x.xGetActionParameterType = 0
 	} else {
-		x.xGetActionParameterType = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType {
+		x.xGetActionParameterType = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetGetActionParameterType gets the "get_action_parameter_type" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_parameter_type]
+// the virtual function pointer for [ActionGroup.GetActionParameterType]
 func (x *ActionGroupInterface) GetGetActionParameterType() func(ActionGroup, string) *glib.VariantType {
 	if x.xGetActionParameterType == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType
-	purego.RegisterFunc(&rawCallback, x.xGetActionParameterType)
+	core.RegisterFunc(&rawCallback, x.xGetActionParameterType)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) *glib.VariantType {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideGetActionStateType sets the "get_action_state_type" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state_type]
+// the virtual function pointer for [ActionGroup.GetActionStateType]
 func (x *ActionGroupInterface) OverrideGetActionStateType(cb func(ActionGroup, string) *glib.VariantType) {
 	if cb == nil {
 		x.xGetActionStateType = 0
 	} else {
-		x.xGetActionStateType = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType {
+		x.xGetActionStateType = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetGetActionStateType gets the "get_action_state_type" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state_type]
+// the virtual function pointer for [ActionGroup.GetActionStateType]
 func (x *ActionGroupInterface) GetGetActionStateType() func(ActionGroup, string) *glib.VariantType {
 	if x.xGetActionStateType == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.VariantType
-	purego.RegisterFunc(&rawCallback, x.xGetActionStateType)
+	core.RegisterFunc(&rawCallback, x.xGetActionStateType)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) *glib.VariantType {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideGetActionStateHint sets the "get_action_state_hint" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state_hint]
+// the virtual function pointer for [ActionGroup.GetActionStateHint]
 func (x *ActionGroupInterface) OverrideGetActionStateHint(cb func(ActionGroup, string) *glib.Variant) {
 	if cb == nil {
 		x.xGetActionStateHint = 0
 	} else {
-		x.xGetActionStateHint = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant {
+		x.xGetActionStateHint = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetGetActionStateHint gets the "get_action_state_hint" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state_hint]
+// the virtual function pointer for [ActionGroup.GetActionStateHint]
 func (x *ActionGroupInterface) GetGetActionStateHint() func(ActionGroup, string) *glib.Variant {
 	if x.xGetActionStateHint == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetActionStateHint)
+	core.RegisterFunc(&rawCallback, x.xGetActionStateHint)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) *glib.Variant {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideGetActionState sets the "get_action_state" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state]
+// the virtual function pointer for [ActionGroup.GetActionState]
 func (x *ActionGroupInterface) OverrideGetActionState(cb func(ActionGroup, string) *glib.Variant) {
 	if cb == nil {
 		x.xGetActionState = 0
 	} else {
-		x.xGetActionState = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant {
+		x.xGetActionState = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetGetActionState gets the "get_action_state" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.get_action_state]
+// the virtual function pointer for [ActionGroup.GetActionState]
 func (x *ActionGroupInterface) GetGetActionState() func(ActionGroup, string) *glib.Variant {
 	if x.xGetActionState == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetActionState)
+	core.RegisterFunc(&rawCallback, x.xGetActionState)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) *glib.Variant {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideChangeActionState sets the "change_action_state" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.change_action_state]
+// the virtual function pointer for [ActionGroup.ChangeActionState]
 func (x *ActionGroupInterface) OverrideChangeActionState(cb func(ActionGroup, string, *glib.Variant)) {
 	if cb == nil {
 		x.xChangeActionState = 0
 	} else {
-		x.xChangeActionState = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant) {
+		x.xChangeActionState = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp, ValueVarp)
 		})
 	}
 }
 
 // GetChangeActionState gets the "change_action_state" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.change_action_state]
+// the virtual function pointer for [ActionGroup.ChangeActionState]
 func (x *ActionGroupInterface) GetChangeActionState() func(ActionGroup, string, *glib.Variant) {
 	if x.xChangeActionState == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xChangeActionState)
+	core.RegisterFunc(&rawCallback, x.xChangeActionState)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string, ValueVar *glib.Variant) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar, ValueVar)
 	}
 }
 
 // OverrideActivateAction sets the "activate_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.activate_action]
+// the virtual function pointer for [ActionGroup.ActivateAction]
 func (x *ActionGroupInterface) OverrideActivateAction(cb func(ActionGroup, string, *glib.Variant)) {
 	if cb == nil {
 		x.xActivateAction = 0
 	} else {
-		x.xActivateAction = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant) {
+		x.xActivateAction = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp, ParameterVarp)
 		})
 	}
 }
 
 // GetActivateAction gets the "activate_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.activate_action]
+// the virtual function pointer for [ActionGroup.ActivateAction]
 func (x *ActionGroupInterface) GetActivateAction() func(ActionGroup, string, *glib.Variant) {
 	if x.xActivateAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xActivateAction)
+	core.RegisterFunc(&rawCallback, x.xActivateAction)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string, ParameterVar *glib.Variant) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar, ParameterVar)
 	}
 }
 
 // OverrideActionAdded sets the "action_added" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-added] signal
+// the class closure for the ActionGroup::action-added signal
 func (x *ActionGroupInterface) OverrideActionAdded(cb func(ActionGroup, string)) {
 	if cb == nil {
 		x.xActionAdded = 0
 	} else {
-		x.xActionAdded = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) {
+		x.xActionAdded = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetActionAdded gets the "action_added" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-added] signal
+// the class closure for the ActionGroup::action-added signal
 func (x *ActionGroupInterface) GetActionAdded() func(ActionGroup, string) {
 	if x.xActionAdded == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xActionAdded)
+	core.RegisterFunc(&rawCallback, x.xActionAdded)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideActionRemoved sets the "action_removed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-removed] signal
+// the class closure for the ActionGroup::action-removed signal
 func (x *ActionGroupInterface) OverrideActionRemoved(cb func(ActionGroup, string)) {
 	if cb == nil {
 		x.xActionRemoved = 0
 	} else {
-		x.xActionRemoved = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) {
+		x.xActionRemoved = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetActionRemoved gets the "action_removed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-removed] signal
+// the class closure for the ActionGroup::action-removed signal
 func (x *ActionGroupInterface) GetActionRemoved() func(ActionGroup, string) {
 	if x.xActionRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xActionRemoved)
+	core.RegisterFunc(&rawCallback, x.xActionRemoved)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideActionEnabledChanged sets the "action_enabled_changed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-enabled-changed] signal
+// the class closure for the ActionGroup::action-enabled-changed signal
 func (x *ActionGroupInterface) OverrideActionEnabledChanged(cb func(ActionGroup, string, bool)) {
 	if cb == nil {
 		x.xActionEnabledChanged = 0
 	} else {
-		x.xActionEnabledChanged = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp bool) {
+		x.xActionEnabledChanged = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp bool) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp, EnabledVarp)
 		})
 	}
 }
 
 // GetActionEnabledChanged gets the "action_enabled_changed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-enabled-changed] signal
+// the class closure for the ActionGroup::action-enabled-changed signal
 func (x *ActionGroupInterface) GetActionEnabledChanged() func(ActionGroup, string, bool) {
 	if x.xActionEnabledChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xActionEnabledChanged)
+	core.RegisterFunc(&rawCallback, x.xActionEnabledChanged)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string, EnabledVar bool) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar, EnabledVar)
 	}
 }
 
 // OverrideActionStateChanged sets the "action_state_changed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-enabled-changed] signal
+// the class closure for the ActionGroup::action-enabled-changed signal
 func (x *ActionGroupInterface) OverrideActionStateChanged(cb func(ActionGroup, string, *glib.Variant)) {
 	if cb == nil {
 		x.xActionStateChanged = 0
 	} else {
-		x.xActionStateChanged = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, StateVarp *glib.Variant) {
+		x.xActionStateChanged = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, StateVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp, StateVarp)
 		})
 	}
 }
 
 // GetActionStateChanged gets the "action_state_changed" callback function.
-// the class closure for the [signal@Gio.ActionGroup::action-enabled-changed] signal
+// the class closure for the ActionGroup::action-enabled-changed signal
 func (x *ActionGroupInterface) GetActionStateChanged() func(ActionGroup, string, *glib.Variant) {
 	if x.xActionStateChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string, StateVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xActionStateChanged)
+	core.RegisterFunc(&rawCallback, x.xActionStateChanged)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string, StateVar *glib.Variant) {
 		rawCallback(ActionGroupVar.GoPointer(), ActionNameVar, StateVar)
 	}
 }
 
 // OverrideQueryAction sets the "query_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.query_action]
+// the virtual function pointer for [ActionGroup.QueryAction]
 func (x *ActionGroupInterface) OverrideQueryAction(cb func(ActionGroup, string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool) {
 	if cb == nil {
 		x.xQueryAction = 0
 	} else {
-		x.xQueryAction = purego.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp *bool, ParameterTypeVarp **glib.VariantType, StateTypeVarp **glib.VariantType, StateHintVarp **glib.Variant, StateVarp **glib.Variant) bool {
+		x.xQueryAction = core.NewCallback(func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp *bool, ParameterTypeVarp **glib.VariantType, StateTypeVarp **glib.VariantType, StateHintVarp **glib.Variant, StateVarp **glib.Variant) bool {
+			defer core.RecoverPanic()
 			return cb(&ActionGroupBase{Ptr: ActionGroupVarp}, ActionNameVarp, EnabledVarp, ParameterTypeVarp, StateTypeVarp, StateHintVarp, StateVarp)
 		})
 	}
 }
 
 // GetQueryAction gets the "query_action" callback function.
-// the virtual function pointer for [method@Gio.ActionGroup.query_action]
+// the virtual function pointer for [ActionGroup.QueryAction]
 func (x *ActionGroupInterface) GetQueryAction() func(ActionGroup, string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool {
 	if x.xQueryAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionGroupVarp uintptr, ActionNameVarp string, EnabledVarp *bool, ParameterTypeVarp **glib.VariantType, StateTypeVarp **glib.VariantType, StateHintVarp **glib.Variant, StateVarp **glib.Variant) bool
-	purego.RegisterFunc(&rawCallback, x.xQueryAction)
+	core.RegisterFunc(&rawCallback, x.xQueryAction)
 	return func(ActionGroupVar ActionGroup, ActionNameVar string, EnabledVar *bool, ParameterTypeVar **glib.VariantType, StateTypeVar **glib.VariantType, StateHintVar **glib.Variant, StateVar **glib.Variant) bool {
 		return rawCallback(ActionGroupVar.GoPointer(), ActionNameVar, EnabledVar, ParameterTypeVar, StateTypeVar, StateHintVar, StateVar)
 	}
@@ -404,20 +418,20 @@ func (x *ActionGroupInterface) GetQueryAction() func(ActionGroup, string, *bool,
 //
 // Actions can be used to expose functionality in a structured way, either
 // from one part of a program to another, or to the outside world. Action
-// groups are often used together with a [type@Gio.MenuModel] that provides additional
+// groups are often used together with a [MenuModel] that provides additional
 // representation data for displaying the actions to the user, e.g. in a menu.
 //
 // The main way to interact with the actions in a `GActionGroup` is to
-// activate them with [method@Gio.ActionGroup.activate_action]. Activating an
-// action may require a [type@GLib.Variant] parameter. The required type of the
-// parameter can be inquired with [method@Gio.ActionGroup.get_action_parameter_type].
-// Actions may be disabled, see [method@Gio.ActionGroup.get_action_enabled].
+// activate them with [ActionGroup.ActivateAction]. Activating an
+// action may require a [glib.Variant] parameter. The required type of the
+// parameter can be inquired with [ActionGroup.GetActionParameterType].
+// Actions may be disabled, see [ActionGroup.GetActionEnabled].
 // Activating a disabled action has no effect.
 //
-// Actions may optionally have a state in the form of a [type@GLib.Variant]. The current
-// state of an action can be inquired with [method@Gio.ActionGroup.get_action_state].
+// Actions may optionally have a state in the form of a [glib.Variant]. The current
+// state of an action can be inquired with [ActionGroup.GetActionState].
 // Activating a stateful action may change its state, but it is also possible to
-// set the state by calling [method@Gio.ActionGroup.change_action_state].
+// set the state by calling [ActionGroup.ChangeActionState].
 //
 // As typical example, consider a text editing application which has an
 // option to change the current font to ‘bold’. A good way to represent
@@ -425,7 +439,7 @@ func (x *ActionGroupInterface) GetQueryAction() func(ActionGroup, string, *bool,
 // action would toggle the state.
 //
 // Each action in the group has a unique name (which is a string).  All
-// method calls, except [method@Gio.ActionGroup.list_actions] take the name of
+// method calls, except [ActionGroup.ListActions] take the name of
 // an action as an argument.
 //
 // The `GActionGroup` API is meant to be the ‘public’ API to the action
@@ -433,17 +447,17 @@ func (x *ActionGroupInterface) GetQueryAction() func(ActionGroup, string, *bool,
 // forces’ (eg: UI, incoming D-Bus messages, etc.) are supposed to have
 // with actions. ‘Internal’ APIs (ie: ones meant only to be accessed by
 // the action group implementation) are found on subclasses. This is
-// why you will find – for example – [method@Gio.ActionGroup.get_action_enabled]
+// why you will find – for example – [ActionGroup.GetActionEnabled]
 // but not an equivalent `set_action_enabled()` method.
 //
 // Signals are emitted on the action group in response to state changes
 // on individual actions.
 //
 // Implementations of `GActionGroup` should provide implementations for
-// the virtual functions [method@Gio.ActionGroup.list_actions] and
-// [method@Gio.ActionGroup.query_action]. The other virtual functions should
+// the virtual functions [ActionGroup.ListActions] and
+// [ActionGroup.QueryAction]. The other virtual functions should
 // not be implemented — their ‘wrappers’ are actually implemented with
-// calls to [method@Gio.ActionGroup.query_action].
+// calls to [ActionGroup.QueryAction].
 type ActionGroup interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -484,99 +498,220 @@ func (x *ActionGroupBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// ImplementActionGroup adds the ActionGroup interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - HasAction(string) bool
+//   - ListActions() []string
+//   - GetActionEnabled(string) bool
+//   - GetActionParameterType(string) *glib.VariantType
+//   - GetActionStateType(string) *glib.VariantType
+//   - GetActionStateHint(string) *glib.Variant
+//   - GetActionState(string) *glib.Variant
+//   - ChangeActionState(string, *glib.Variant)
+//   - ActivateAction(string, *glib.Variant)
+//   - ActionAdded(string)
+//   - ActionRemoved(string)
+//   - ActionEnabledChanged(string, bool)
+//   - ActionStateChanged(string, *glib.Variant)
+//   - QueryAction(string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ActionGroupInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementActionGroup[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ActionGroupGLibType(), func(iface *ActionGroupInterface) {
+		if _, ok := any(zero).(interface{ HasAction(string) bool }); ok {
+			iface.OverrideHasAction(func(self ActionGroup, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HasAction(string) bool })
+				return impl.HasAction(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ListActions() []string }); ok {
+			iface.OverrideListActions(func(self ActionGroup) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ListActions() []string })
+				return impl.ListActions()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetActionEnabled(string) bool }); ok {
+			iface.OverrideGetActionEnabled(func(self ActionGroup, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActionEnabled(string) bool })
+				return impl.GetActionEnabled(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetActionParameterType(string) *glib.VariantType
+		}); ok {
+			iface.OverrideGetActionParameterType(func(self ActionGroup, arg0 string) *glib.VariantType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetActionParameterType(string) *glib.VariantType
+				})
+				return impl.GetActionParameterType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetActionStateType(string) *glib.VariantType
+		}); ok {
+			iface.OverrideGetActionStateType(func(self ActionGroup, arg0 string) *glib.VariantType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetActionStateType(string) *glib.VariantType
+				})
+				return impl.GetActionStateType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetActionStateHint(string) *glib.Variant }); ok {
+			iface.OverrideGetActionStateHint(func(self ActionGroup, arg0 string) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActionStateHint(string) *glib.Variant })
+				return impl.GetActionStateHint(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetActionState(string) *glib.Variant }); ok {
+			iface.OverrideGetActionState(func(self ActionGroup, arg0 string) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActionState(string) *glib.Variant })
+				return impl.GetActionState(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ChangeActionState(string, *glib.Variant) }); ok {
+			iface.OverrideChangeActionState(func(self ActionGroup, arg0 string, arg1 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ChangeActionState(string, *glib.Variant) })
+				impl.ChangeActionState(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ ActivateAction(string, *glib.Variant) }); ok {
+			iface.OverrideActivateAction(func(self ActionGroup, arg0 string, arg1 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ActivateAction(string, *glib.Variant) })
+				impl.ActivateAction(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ ActionAdded(string) }); ok {
+			iface.OverrideActionAdded(func(self ActionGroup, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ActionAdded(string) })
+				impl.ActionAdded(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ActionRemoved(string) }); ok {
+			iface.OverrideActionRemoved(func(self ActionGroup, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ActionRemoved(string) })
+				impl.ActionRemoved(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ActionEnabledChanged(string, bool) }); ok {
+			iface.OverrideActionEnabledChanged(func(self ActionGroup, arg0 string, arg1 bool) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ActionEnabledChanged(string, bool) })
+				impl.ActionEnabledChanged(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ ActionStateChanged(string, *glib.Variant) }); ok {
+			iface.OverrideActionStateChanged(func(self ActionGroup, arg0 string, arg1 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ActionStateChanged(string, *glib.Variant) })
+				impl.ActionStateChanged(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryAction(string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool
+		}); ok {
+			iface.OverrideQueryAction(func(self ActionGroup, arg0 string, arg1 *bool, arg2 **glib.VariantType, arg3 **glib.VariantType, arg4 **glib.Variant, arg5 **glib.Variant) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryAction(string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool
+				})
+				return impl.QueryAction(arg0, arg1, arg2, arg3, arg4, arg5)
+			})
+		}
+	})
+}
+
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *ActionGroupBase) ActionAdded(ActionNameVar string) {
 
 	XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *ActionGroupBase) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *ActionGroupBase) ActionRemoved(ActionNameVar string) {
 
 	XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *ActionGroupBase) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [DBusConnection.Flush] is called
+// before `exit()`. Without `[DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *ActionGroupBase) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *ActionGroupBase) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -587,14 +722,14 @@ func (x *ActionGroupBase) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -605,14 +740,14 @@ func (x *ActionGroupBase) GetActionParameterType(ActionNameVar string) *glib.Var
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ActionGroupBase) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -620,14 +755,14 @@ func (x *ActionGroupBase) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -636,7 +771,7 @@ func (x *ActionGroupBase) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ActionGroupBase) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -644,17 +779,17 @@ func (x *ActionGroupBase) GetActionStateHint(ActionNameVar string) *glib.Variant
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [ActionGroup.GetActionState] will return `NULL`
+// and you must not call [ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -665,38 +800,38 @@ func (x *ActionGroupBase) GetActionStateType(ActionNameVar string) *glib.Variant
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *ActionGroupBase) HasAction(ActionNameVar string) bool {
 
 	cret := XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *ActionGroupBase) ListActions() []string {
 
 	cret := XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [ActionGroup.HasAction], [ActionGroup.GetActionEnabled],
+// [ActionGroup.GetActionParameterType],
+// [ActionGroup.GetActionStateType],
+// [ActionGroup.GetActionStateHint] and
+// [ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -727,7 +862,7 @@ var XGActionGroupGetActionState func(uintptr, string) *glib.Variant
 var XGActionGroupGetActionStateHint func(uintptr, string) *glib.Variant
 var XGActionGroupGetActionStateType func(uintptr, string) *glib.VariantType
 var XGActionGroupHasAction func(uintptr, string) bool
-var XGActionGroupListActions func(uintptr) []string
+var XGActionGroupListActions func(uintptr) uintptr
 var XGActionGroupQueryAction func(uintptr, string, *bool, **glib.VariantType, **glib.VariantType, **glib.Variant, **glib.Variant) bool
 
 func init() {
@@ -735,7 +870,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -744,19 +879,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xActionGroupGLibType, libs, "g_action_group_get_type")
 
-	core.PuregoSafeRegister(&XGActionGroupActionAdded, libs, "g_action_group_action_added")
-	core.PuregoSafeRegister(&XGActionGroupActionEnabledChanged, libs, "g_action_group_action_enabled_changed")
-	core.PuregoSafeRegister(&XGActionGroupActionRemoved, libs, "g_action_group_action_removed")
-	core.PuregoSafeRegister(&XGActionGroupActionStateChanged, libs, "g_action_group_action_state_changed")
-	core.PuregoSafeRegister(&XGActionGroupActivateAction, libs, "g_action_group_activate_action")
-	core.PuregoSafeRegister(&XGActionGroupChangeActionState, libs, "g_action_group_change_action_state")
-	core.PuregoSafeRegister(&XGActionGroupGetActionEnabled, libs, "g_action_group_get_action_enabled")
-	core.PuregoSafeRegister(&XGActionGroupGetActionParameterType, libs, "g_action_group_get_action_parameter_type")
-	core.PuregoSafeRegister(&XGActionGroupGetActionState, libs, "g_action_group_get_action_state")
-	core.PuregoSafeRegister(&XGActionGroupGetActionStateHint, libs, "g_action_group_get_action_state_hint")
-	core.PuregoSafeRegister(&XGActionGroupGetActionStateType, libs, "g_action_group_get_action_state_type")
-	core.PuregoSafeRegister(&XGActionGroupHasAction, libs, "g_action_group_has_action")
-	core.PuregoSafeRegister(&XGActionGroupListActions, libs, "g_action_group_list_actions")
-	core.PuregoSafeRegister(&XGActionGroupQueryAction, libs, "g_action_group_query_action")
+	gobject.CheckClassSize("GActionGroupInterface", xActionGroupGLibType, unsafe.Sizeof(ActionGroupInterface{}))
+
+	core.PuregoRegisterSince(&XGActionGroupActionAdded, libs, "g_action_group_action_added", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupActionEnabledChanged, libs, "g_action_group_action_enabled_changed", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupActionRemoved, libs, "g_action_group_action_removed", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupActionStateChanged, libs, "g_action_group_action_state_changed", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupActivateAction, libs, "g_action_group_activate_action", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupChangeActionState, libs, "g_action_group_change_action_state", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupGetActionEnabled, libs, "g_action_group_get_action_enabled", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupGetActionParameterType, libs, "g_action_group_get_action_parameter_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupGetActionState, libs, "g_action_group_get_action_state", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupGetActionStateHint, libs, "g_action_group_get_action_state_hint", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupGetActionStateType, libs, "g_action_group_get_action_state_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupHasAction, libs, "g_action_group_has_action", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupListActions, libs, "g_action_group_list_actions", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGActionGroupQueryAction, libs, "g_action_group_query_action", "GIO", "2.32")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,15 +12,15 @@ import (
 )
 
 // This struct defines a single action.  It is for use with
-// [method@Gio.ActionMap.add_action_entries].
+// [ActionMap.AddActionEntries].
 //
 // The order of the items in the structure are intended to reflect
 // frequency of use.  It is permissible to use an incomplete initialiser
 // in order to leave some of the later values as `NULL`.  All values
-// after @name are optional.  Additional optional fields may be added in
+// after name are optional.  Additional optional fields may be added in
 // the future.
 //
-// See [method@Gio.ActionMap.add_action_entries] for an example.
+// See [ActionMap.AddActionEntries] for an example.
 type ActionEntry struct {
 	_ structs.HostLayout
 
@@ -49,12 +48,13 @@ func (x *ActionEntry) GoPointer() uintptr {
 //	the default handler is used. For boolean-stated actions with no
 //	parameter, this is a toggle. For other state types (and parameter type
 //	equal to the state type) this will be a function that just calls
-//	@change_state (which you should provide).
+//	change_state (which you should provide).
 func (x *ActionEntry) OverrideActivate(cb func(*SimpleAction, *glib.Variant, uintptr)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(ActionVarp uintptr, ParameterVarp *glib.Variant, UserDataVarp uintptr) {
+		x.xActivate = core.NewCallback(func(ActionVarp uintptr, ParameterVarp *glib.Variant, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SimpleActionNewFromInternalPtr(ActionVarp), ParameterVarp, UserDataVarp)
 		})
 	}
@@ -67,13 +67,13 @@ func (x *ActionEntry) OverrideActivate(cb func(*SimpleAction, *glib.Variant, uin
 //	the default handler is used. For boolean-stated actions with no
 //	parameter, this is a toggle. For other state types (and parameter type
 //	equal to the state type) this will be a function that just calls
-//	@change_state (which you should provide).
+//	change_state (which you should provide).
 func (x *ActionEntry) GetActivate() func(*SimpleAction, *glib.Variant, uintptr) {
 	if x.xActivate == 0 {
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr, ParameterVarp *glib.Variant, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(ActionVar *SimpleAction, ParameterVar *glib.Variant, UserDataVar uintptr) {
 		rawCallback(ActionVar.GoPointer(), ParameterVar, UserDataVar)
 	}
@@ -88,7 +88,8 @@ func (x *ActionEntry) OverrideChangeState(cb func(*SimpleAction, *glib.Variant,
 	if cb == nil {
 		x.xChangeState = 0
 	} else {
-		x.xChangeState = purego.NewCallback(func(ActionVarp uintptr, ValueVarp *glib.Variant, UserDataVarp uintptr) {
+		x.xChangeState = core.NewCallback(func(ActionVarp uintptr, ValueVarp *glib.Variant, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SimpleActionNewFromInternalPtr(ActionVarp), ValueVarp, UserDataVarp)
 		})
 	}
@@ -104,17 +105,17 @@ func (x *ActionEntry) GetChangeState() func(*SimpleAction, *glib.Variant, uintpt
 		return nil
 	}
 	var rawCallback func(ActionVarp uintptr, ValueVarp *glib.Variant, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChangeState)
+	core.RegisterFunc(&rawCallback, x.xChangeState)
 	return func(ActionVar *SimpleAction, ValueVar *glib.Variant, UserDataVar uintptr) {
 		rawCallback(ActionVar.GoPointer(), ValueVar, UserDataVar)
 	}
 }
 
-// The virtual function table for [iface@Gio.ActionMap].
+// The virtual function table for [ActionMap].
 type ActionMapInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xLookupAction uintptr
 
@@ -130,12 +131,13 @@ func (x *ActionMapInterface) GoPointer() uintptr {
 // OverrideLookupAction sets the "lookup_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.lookup_action]
+//	[ActionMap.LookupAction]
 func (x *ActionMapInterface) OverrideLookupAction(cb func(ActionMap, string) *ActionBase) {
 	if cb == nil {
 		x.xLookupAction = 0
 	} else {
-		x.xLookupAction = purego.NewCallback(func(ActionMapVarp uintptr, ActionNameVarp string) uintptr {
+		x.xLookupAction = core.NewCallback(func(ActionMapVarp uintptr, ActionNameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&ActionMapBase{Ptr: ActionMapVarp}, ActionNameVarp)
 			if ret == nil {
 				return 0
@@ -148,13 +150,13 @@ func (x *ActionMapInterface) OverrideLookupAction(cb func(ActionMap, string) *Ac
 // GetLookupAction gets the "lookup_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.lookup_action]
+//	[ActionMap.LookupAction]
 func (x *ActionMapInterface) GetLookupAction() func(ActionMap, string) *ActionBase {
 	if x.xLookupAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionMapVarp uintptr, ActionNameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLookupAction)
+	core.RegisterFunc(&rawCallback, x.xLookupAction)
 	return func(ActionMapVar ActionMap, ActionNameVar string) *ActionBase {
 		rawRet := rawCallback(ActionMapVar.GoPointer(), ActionNameVar)
 		if rawRet == 0 {
@@ -169,12 +171,13 @@ func (x *ActionMapInterface) GetLookupAction() func(ActionMap, string) *ActionBa
 // OverrideAddAction sets the "add_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.add_action]
+//	[ActionMap.AddAction]
 func (x *ActionMapInterface) OverrideAddAction(cb func(ActionMap, Action)) {
 	if cb == nil {
 		x.xAddAction = 0
 	} else {
-		x.xAddAction = purego.NewCallback(func(ActionMapVarp uintptr, ActionVarp uintptr) {
+		x.xAddAction = core.NewCallback(func(ActionMapVarp uintptr, ActionVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ActionMapBase{Ptr: ActionMapVarp}, &ActionBase{Ptr: ActionVarp})
 		})
 	}
@@ -183,13 +186,13 @@ func (x *ActionMapInterface) OverrideAddAction(cb func(ActionMap, Action)) {
 // GetAddAction gets the "add_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.add_action]
+//	[ActionMap.AddAction]
 func (x *ActionMapInterface) GetAddAction() func(ActionMap, Action) {
 	if x.xAddAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionMapVarp uintptr, ActionVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAddAction)
+	core.RegisterFunc(&rawCallback, x.xAddAction)
 	return func(ActionMapVar ActionMap, ActionVar Action) {
 		rawCallback(ActionMapVar.GoPointer(), ActionVar.GoPointer())
 	}
@@ -198,12 +201,13 @@ func (x *ActionMapInterface) GetAddAction() func(ActionMap, Action) {
 // OverrideRemoveAction sets the "remove_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.remove_action]
+//	[ActionMap.RemoveAction]
 func (x *ActionMapInterface) OverrideRemoveAction(cb func(ActionMap, string)) {
 	if cb == nil {
 		x.xRemoveAction = 0
 	} else {
-		x.xRemoveAction = purego.NewCallback(func(ActionMapVarp uintptr, ActionNameVarp string) {
+		x.xRemoveAction = core.NewCallback(func(ActionMapVarp uintptr, ActionNameVarp string) {
+			defer core.RecoverPanic()
 			cb(&ActionMapBase{Ptr: ActionMapVarp}, ActionNameVarp)
 		})
 	}
@@ -212,13 +216,13 @@ func (x *ActionMapInterface) OverrideRemoveAction(cb func(ActionMap, string)) {
 // GetRemoveAction gets the "remove_action" callback function.
 // the virtual function pointer for
 //
-//	[method@Gio.ActionMap.remove_action]
+//	[ActionMap.RemoveAction]
 func (x *ActionMapInterface) GetRemoveAction() func(ActionMap, string) {
 	if x.xRemoveAction == 0 {
 		return nil
 	}
 	var rawCallback func(ActionMapVarp uintptr, ActionNameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xRemoveAction)
+	core.RegisterFunc(&rawCallback, x.xRemoveAction)
 	return func(ActionMapVar ActionMap, ActionNameVar string) {
 		rawCallback(ActionMapVar.GoPointer(), ActionNameVar)
 	}
@@ -226,9 +230,9 @@ func (x *ActionMapInterface) GetRemoveAction() func(ActionMap, string) {
 
 // `GActionMap` is an interface for action containers.
 //
-// The `GActionMap` interface is implemented by [iface@Gio.ActionGroup]
+// The `GActionMap` interface is implemented by [ActionGroup]
 // implementations that operate by containing a number of named
-// [iface@Gio.Action] instances, such as [class@Gio.SimpleActionGroup].
+// [Action] instances, such as [SimpleActionGroup].
 //
 // One useful application of this interface is to map the
 // names of actions from various action groups to unique,
@@ -266,47 +270,75 @@ func (x *ActionMapBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Adds an action to the @action_map.
+// ImplementActionMap adds the ActionMap interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - LookupAction(string) *ActionBase
+//   - AddAction(Action)
+//   - RemoveAction(string)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ActionMapInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementActionMap[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ActionMapGLibType(), func(iface *ActionMapInterface) {
+		if _, ok := any(zero).(interface{ LookupAction(string) *ActionBase }); ok {
+			iface.OverrideLookupAction(func(self ActionMap, arg0 string) *ActionBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ LookupAction(string) *ActionBase })
+				return impl.LookupAction(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ AddAction(Action) }); ok {
+			iface.OverrideAddAction(func(self ActionMap, arg0 Action) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ AddAction(Action) })
+				impl.AddAction(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ RemoveAction(string) }); ok {
+			iface.OverrideRemoveAction(func(self ActionMap, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RemoveAction(string) })
+				impl.RemoveAction(arg0)
+			})
+		}
+	})
+}
+
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *ActionMapBase) AddAction(ActionVar Action) {
 
 	XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
+// A convenience function for creating multiple [SimpleAction]
+// instances and adding them to a [ActionMap].
 //
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -319,15 +351,13 @@ func (x *ActionMapBase) AddAction(ActionVar Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *ActionMapBase) AddActionEntries(EntriesVar []ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *ActionMapBase) LookupAction(ActionNameVar string) *ActionBase {
@@ -353,31 +383,25 @@ func (x *ActionMapBase) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [ActionMap]. This is meant as the reverse of
+// [ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *ActionMapBase) RemoveActionEntries(EntriesVar []ActionEntry, NEntriesVar int) {
 
 	XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -395,7 +419,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -404,10 +428,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xActionMapGLibType, libs, "g_action_map_get_type")
 
-	core.PuregoSafeRegister(&XGActionMapAddAction, libs, "g_action_map_add_action")
-	core.PuregoSafeRegister(&XGActionMapAddActionEntries, libs, "g_action_map_add_action_entries")
-	core.PuregoSafeRegister(&XGActionMapLookupAction, libs, "g_action_map_lookup_action")
-	core.PuregoSafeRegister(&XGActionMapRemoveAction, libs, "g_action_map_remove_action")
-	core.PuregoSafeRegister(&XGActionMapRemoveActionEntries, libs, "g_action_map_remove_action_entries")
+	gobject.CheckClassSize("GActionMapInterface", xActionMapGLibType, unsafe.Sizeof(ActionMapInterface{}))
+
+	core.PuregoRegisterSince(&XGActionMapAddAction, libs, "g_action_map_add_action", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGActionMapAddActionEntries, libs, "g_action_map_add_action_entries", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGActionMapLookupAction, libs, "g_action_map_lookup_action", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGActionMapRemoveAction, libs, "g_action_map_remove_action", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGActionMapRemoveActionEntries, libs, "g_action_map_remove_action_entries", "GIO", "2.78")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,7 +15,7 @@ import (
 type AppInfoIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xDup uintptr
 
@@ -74,12 +73,13 @@ func (x *AppInfoIface) GoPointer() uintptr {
 }
 
 // OverrideDup sets the "dup" callback function.
-// Copies a [iface@Gio.AppInfo].
+// Copies a [AppInfo].
 func (x *AppInfoIface) OverrideDup(cb func(AppInfo) *AppInfoBase) {
 	if cb == nil {
 		x.xDup = 0
 	} else {
-		x.xDup = purego.NewCallback(func(AppinfoVarp uintptr) uintptr {
+		x.xDup = core.NewCallback(func(AppinfoVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AppInfoBase{Ptr: AppinfoVarp})
 			if ret == nil {
 				return 0
@@ -90,13 +90,13 @@ func (x *AppInfoIface) OverrideDup(cb func(AppInfo) *AppInfoBase) {
 }
 
 // GetDup gets the "dup" callback function.
-// Copies a [iface@Gio.AppInfo].
+// Copies a [AppInfo].
 func (x *AppInfoIface) GetDup() func(AppInfo) *AppInfoBase {
 	if x.xDup == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDup)
+	core.RegisterFunc(&rawCallback, x.xDup)
 	return func(AppinfoVar AppInfo) *AppInfoBase {
 		rawRet := rawCallback(AppinfoVar.GoPointer())
 		if rawRet == 0 {
@@ -109,75 +109,78 @@ func (x *AppInfoIface) GetDup() func(AppInfo) *AppInfoBase {
 }
 
 // OverrideEqual sets the "equal" callback function.
-// Checks two [iface@Gio.AppInfo]s for equality.
+// Checks two [AppInfo]s for equality.
 func (x *AppInfoIface) OverrideEqual(cb func(AppInfo, AppInfo) bool) {
 	if cb == nil {
 		x.xEqual = 0
 	} else {
-		x.xEqual = purego.NewCallback(func(Appinfo1Varp uintptr, Appinfo2Varp uintptr) bool {
+		x.xEqual = core.NewCallback(func(Appinfo1Varp uintptr, Appinfo2Varp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: Appinfo1Varp}, &AppInfoBase{Ptr: Appinfo2Varp})
 		})
 	}
 }
 
 // GetEqual gets the "equal" callback function.
-// Checks two [iface@Gio.AppInfo]s for equality.
+// Checks two [AppInfo]s for equality.
 func (x *AppInfoIface) GetEqual() func(AppInfo, AppInfo) bool {
 	if x.xEqual == 0 {
 		return nil
 	}
 	var rawCallback func(Appinfo1Varp uintptr, Appinfo2Varp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEqual)
+	core.RegisterFunc(&rawCallback, x.xEqual)
 	return func(Appinfo1Var AppInfo, Appinfo2Var AppInfo) bool {
 		return rawCallback(Appinfo1Var.GoPointer(), Appinfo2Var.GoPointer())
 	}
 }
 
 // OverrideGetId sets the "get_id" callback function.
-// Gets a string identifier for a [iface@Gio.AppInfo].
+// Gets a string identifier for a [AppInfo].
 func (x *AppInfoIface) OverrideGetId(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetId = 0
 	} else {
-		x.xGetId = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetId = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetId gets the "get_id" callback function.
-// Gets a string identifier for a [iface@Gio.AppInfo].
+// Gets a string identifier for a [AppInfo].
 func (x *AppInfoIface) GetGetId() func(AppInfo) string {
 	if x.xGetId == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetId)
+	core.RegisterFunc(&rawCallback, x.xGetId)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideGetName sets the "get_name" callback function.
-// Gets the name of the application for a [iface@Gio.AppInfo].
+// Gets the name of the application for a [AppInfo].
 func (x *AppInfoIface) OverrideGetName(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetName gets the "get_name" callback function.
-// Gets the name of the application for a [iface@Gio.AppInfo].
+// Gets the name of the application for a [AppInfo].
 func (x *AppInfoIface) GetGetName() func(AppInfo) string {
 	if x.xGetName == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -186,12 +189,13 @@ func (x *AppInfoIface) GetGetName() func(AppInfo) string {
 // OverrideGetDescription sets the "get_description" callback function.
 // Gets a short description for the application described by
 //
-//	the [iface@Gio.AppInfo].
+//	the [AppInfo].
 func (x *AppInfoIface) OverrideGetDescription(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetDescription = 0
 	} else {
-		x.xGetDescription = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetDescription = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
@@ -200,50 +204,52 @@ func (x *AppInfoIface) OverrideGetDescription(cb func(AppInfo) string) {
 // GetGetDescription gets the "get_description" callback function.
 // Gets a short description for the application described by
 //
-//	the [iface@Gio.AppInfo].
+//	the [AppInfo].
 func (x *AppInfoIface) GetGetDescription() func(AppInfo) string {
 	if x.xGetDescription == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetDescription)
+	core.RegisterFunc(&rawCallback, x.xGetDescription)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideGetExecutable sets the "get_executable" callback function.
-// Gets the executable name for the [iface@Gio.AppInfo].
+// Gets the executable name for the [AppInfo].
 func (x *AppInfoIface) OverrideGetExecutable(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetExecutable = 0
 	} else {
-		x.xGetExecutable = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetExecutable = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetExecutable gets the "get_executable" callback function.
-// Gets the executable name for the [iface@Gio.AppInfo].
+// Gets the executable name for the [AppInfo].
 func (x *AppInfoIface) GetGetExecutable() func(AppInfo) string {
 	if x.xGetExecutable == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetExecutable)
+	core.RegisterFunc(&rawCallback, x.xGetExecutable)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideGetIcon sets the "get_icon" callback function.
-// Gets the [iface@Gio.Icon] for the [iface@Gio.AppInfo].
+// Gets the [Icon] for the [AppInfo].
 func (x *AppInfoIface) OverrideGetIcon(cb func(AppInfo) *IconBase) {
 	if cb == nil {
 		x.xGetIcon = 0
 	} else {
-		x.xGetIcon = purego.NewCallback(func(AppinfoVarp uintptr) uintptr {
+		x.xGetIcon = core.NewCallback(func(AppinfoVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AppInfoBase{Ptr: AppinfoVarp})
 			if ret == nil {
 				return 0
@@ -254,13 +260,13 @@ func (x *AppInfoIface) OverrideGetIcon(cb func(AppInfo) *IconBase) {
 }
 
 // GetGetIcon gets the "get_icon" callback function.
-// Gets the [iface@Gio.Icon] for the [iface@Gio.AppInfo].
+// Gets the [Icon] for the [AppInfo].
 func (x *AppInfoIface) GetGetIcon() func(AppInfo) *IconBase {
 	if x.xGetIcon == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetIcon)
+	core.RegisterFunc(&rawCallback, x.xGetIcon)
 	return func(AppinfoVar AppInfo) *IconBase {
 		rawRet := rawCallback(AppinfoVar.GoPointer())
 		if rawRet == 0 {
@@ -273,25 +279,26 @@ func (x *AppInfoIface) GetGetIcon() func(AppInfo) *IconBase {
 }
 
 // OverrideLaunch sets the "launch" callback function.
-// Launches an application specified by the [iface@Gio.AppInfo].
+// Launches an application specified by the [AppInfo].
 func (x *AppInfoIface) OverrideLaunch(cb func(AppInfo, *glib.List, *AppLaunchContext) bool) {
 	if cb == nil {
 		x.xLaunch = 0
 	} else {
-		x.xLaunch = purego.NewCallback(func(AppinfoVarp uintptr, FilesVarp *glib.List, ContextVarp uintptr) bool {
+		x.xLaunch = core.NewCallback(func(AppinfoVarp uintptr, FilesVarp *glib.List, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, FilesVarp, AppLaunchContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetLaunch gets the "launch" callback function.
-// Launches an application specified by the [iface@Gio.AppInfo].
+// Launches an application specified by the [AppInfo].
 func (x *AppInfoIface) GetLaunch() func(AppInfo, *glib.List, *AppLaunchContext) bool {
 	if x.xLaunch == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, FilesVarp *glib.List, ContextVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xLaunch)
+	core.RegisterFunc(&rawCallback, x.xLaunch)
 	return func(AppinfoVar AppInfo, FilesVar *glib.List, ContextVar *AppLaunchContext) bool {
 		return rawCallback(AppinfoVar.GoPointer(), FilesVar, ContextVar.GoPointer())
 	}
@@ -305,7 +312,8 @@ func (x *AppInfoIface) OverrideSupportsUris(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xSupportsUris = 0
 	} else {
-		x.xSupportsUris = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xSupportsUris = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
@@ -320,7 +328,7 @@ func (x *AppInfoIface) GetSupportsUris() func(AppInfo) bool {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSupportsUris)
+	core.RegisterFunc(&rawCallback, x.xSupportsUris)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -334,7 +342,8 @@ func (x *AppInfoIface) OverrideSupportsFiles(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xSupportsFiles = 0
 	} else {
-		x.xSupportsFiles = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xSupportsFiles = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
@@ -349,7 +358,7 @@ func (x *AppInfoIface) GetSupportsFiles() func(AppInfo) bool {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSupportsFiles)
+	core.RegisterFunc(&rawCallback, x.xSupportsFiles)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -361,7 +370,8 @@ func (x *AppInfoIface) OverrideLaunchUris(cb func(AppInfo, *glib.List, *AppLaunc
 	if cb == nil {
 		x.xLaunchUris = 0
 	} else {
-		x.xLaunchUris = purego.NewCallback(func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr) bool {
+		x.xLaunchUris = core.NewCallback(func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, UrisVarp, AppLaunchContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -374,7 +384,7 @@ func (x *AppInfoIface) GetLaunchUris() func(AppInfo, *glib.List, *AppLaunchConte
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xLaunchUris)
+	core.RegisterFunc(&rawCallback, x.xLaunchUris)
 	return func(AppinfoVar AppInfo, UrisVar *glib.List, ContextVar *AppLaunchContext) bool {
 		return rawCallback(AppinfoVar.GoPointer(), UrisVar, ContextVar.GoPointer())
 	}
@@ -384,12 +394,15 @@ func (x *AppInfoIface) GetLaunchUris() func(AppInfo, *glib.List, *AppLaunchConte
 // Returns whether an application should be shown (e.g. when
 //
 //	getting a list of installed applications).
-//	[FreeDesktop.Org Startup Notification Specification](http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt).
+//	[FreeDesktop.Org Startup Notification Specification].
+//
+// [FreeDesktop.Org Startup Notification Specification]: http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt
 func (x *AppInfoIface) OverrideShouldShow(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xShouldShow = 0
 	} else {
-		x.xShouldShow = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xShouldShow = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
@@ -399,13 +412,15 @@ func (x *AppInfoIface) OverrideShouldShow(cb func(AppInfo) bool) {
 // Returns whether an application should be shown (e.g. when
 //
 //	getting a list of installed applications).
-//	[FreeDesktop.Org Startup Notification Specification](http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt).
+//	[FreeDesktop.Org Startup Notification Specification].
+//
+// [FreeDesktop.Org Startup Notification Specification]: http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt
 func (x *AppInfoIface) GetShouldShow() func(AppInfo) bool {
 	if x.xShouldShow == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xShouldShow)
+	core.RegisterFunc(&rawCallback, x.xShouldShow)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -419,7 +434,8 @@ func (x *AppInfoIface) OverrideSetAsDefaultForType(cb func(AppInfo, string) bool
 	if cb == nil {
 		x.xSetAsDefaultForType = 0
 	} else {
-		x.xSetAsDefaultForType = purego.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+		x.xSetAsDefaultForType = core.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, ContentTypeVarp)
 		})
 	}
@@ -434,7 +450,7 @@ func (x *AppInfoIface) GetSetAsDefaultForType() func(AppInfo, string) bool {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ContentTypeVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAsDefaultForType)
+	core.RegisterFunc(&rawCallback, x.xSetAsDefaultForType)
 	return func(AppinfoVar AppInfo, ContentTypeVar string) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ContentTypeVar)
 	}
@@ -448,7 +464,8 @@ func (x *AppInfoIface) OverrideSetAsDefaultForExtension(cb func(AppInfo, string)
 	if cb == nil {
 		x.xSetAsDefaultForExtension = 0
 	} else {
-		x.xSetAsDefaultForExtension = purego.NewCallback(func(AppinfoVarp uintptr, ExtensionVarp string) bool {
+		x.xSetAsDefaultForExtension = core.NewCallback(func(AppinfoVarp uintptr, ExtensionVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, ExtensionVarp)
 		})
 	}
@@ -463,28 +480,29 @@ func (x *AppInfoIface) GetSetAsDefaultForExtension() func(AppInfo, string) bool
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ExtensionVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAsDefaultForExtension)
+	core.RegisterFunc(&rawCallback, x.xSetAsDefaultForExtension)
 	return func(AppinfoVar AppInfo, ExtensionVar string) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ExtensionVar)
 	}
 }
 
 // OverrideAddSupportsType sets the "add_supports_type" callback function.
-// Adds to the [iface@Gio.AppInfo] information about
+// Adds to the [AppInfo] information about
 //
 //	supported file types.
 func (x *AppInfoIface) OverrideAddSupportsType(cb func(AppInfo, string) bool) {
 	if cb == nil {
 		x.xAddSupportsType = 0
 	} else {
-		x.xAddSupportsType = purego.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+		x.xAddSupportsType = core.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, ContentTypeVarp)
 		})
 	}
 }
 
 // GetAddSupportsType gets the "add_supports_type" callback function.
-// Adds to the [iface@Gio.AppInfo] information about
+// Adds to the [AppInfo] information about
 //
 //	supported file types.
 func (x *AppInfoIface) GetAddSupportsType() func(AppInfo, string) bool {
@@ -492,7 +510,7 @@ func (x *AppInfoIface) GetAddSupportsType() func(AppInfo, string) bool {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ContentTypeVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xAddSupportsType)
+	core.RegisterFunc(&rawCallback, x.xAddSupportsType)
 	return func(AppinfoVar AppInfo, ContentTypeVar string) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ContentTypeVar)
 	}
@@ -501,12 +519,13 @@ func (x *AppInfoIface) GetAddSupportsType() func(AppInfo, string) bool {
 // OverrideCanRemoveSupportsType sets the "can_remove_supports_type" callback function.
 // Checks for support for removing supported file
 //
-//	types from a [iface@Gio.AppInfo].
+//	types from a [AppInfo].
 func (x *AppInfoIface) OverrideCanRemoveSupportsType(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xCanRemoveSupportsType = 0
 	} else {
-		x.xCanRemoveSupportsType = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xCanRemoveSupportsType = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
@@ -515,13 +534,13 @@ func (x *AppInfoIface) OverrideCanRemoveSupportsType(cb func(AppInfo) bool) {
 // GetCanRemoveSupportsType gets the "can_remove_supports_type" callback function.
 // Checks for support for removing supported file
 //
-//	types from a [iface@Gio.AppInfo].
+//	types from a [AppInfo].
 func (x *AppInfoIface) GetCanRemoveSupportsType() func(AppInfo) bool {
 	if x.xCanRemoveSupportsType == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanRemoveSupportsType)
+	core.RegisterFunc(&rawCallback, x.xCanRemoveSupportsType)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -530,12 +549,13 @@ func (x *AppInfoIface) GetCanRemoveSupportsType() func(AppInfo) bool {
 // OverrideRemoveSupportsType sets the "remove_supports_type" callback function.
 // Removes a supported application type from a
 //
-//	[iface@Gio.AppInfo].
+//	[AppInfo].
 func (x *AppInfoIface) OverrideRemoveSupportsType(cb func(AppInfo, string) bool) {
 	if cb == nil {
 		x.xRemoveSupportsType = 0
 	} else {
-		x.xRemoveSupportsType = purego.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+		x.xRemoveSupportsType = core.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, ContentTypeVarp)
 		})
 	}
@@ -544,84 +564,87 @@ func (x *AppInfoIface) OverrideRemoveSupportsType(cb func(AppInfo, string) bool)
 // GetRemoveSupportsType gets the "remove_supports_type" callback function.
 // Removes a supported application type from a
 //
-//	[iface@Gio.AppInfo].
+//	[AppInfo].
 func (x *AppInfoIface) GetRemoveSupportsType() func(AppInfo, string) bool {
 	if x.xRemoveSupportsType == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ContentTypeVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xRemoveSupportsType)
+	core.RegisterFunc(&rawCallback, x.xRemoveSupportsType)
 	return func(AppinfoVar AppInfo, ContentTypeVar string) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ContentTypeVar)
 	}
 }
 
 // OverrideCanDelete sets the "can_delete" callback function.
-// Checks if a [iface@Gio.AppInfo] can be deleted. (Since 2.20)
+// Checks if a [AppInfo] can be deleted. (Since 2.20)
 func (x *AppInfoIface) OverrideCanDelete(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xCanDelete = 0
 	} else {
-		x.xCanDelete = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xCanDelete = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetCanDelete gets the "can_delete" callback function.
-// Checks if a [iface@Gio.AppInfo] can be deleted. (Since 2.20)
+// Checks if a [AppInfo] can be deleted. (Since 2.20)
 func (x *AppInfoIface) GetCanDelete() func(AppInfo) bool {
 	if x.xCanDelete == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanDelete)
+	core.RegisterFunc(&rawCallback, x.xCanDelete)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideDoDelete sets the "do_delete" callback function.
-// Deletes a [iface@Gio.AppInfo]. (Since 2.20)
+// Deletes a [AppInfo]. (Since 2.20)
 func (x *AppInfoIface) OverrideDoDelete(cb func(AppInfo) bool) {
 	if cb == nil {
 		x.xDoDelete = 0
 	} else {
-		x.xDoDelete = purego.NewCallback(func(AppinfoVarp uintptr) bool {
+		x.xDoDelete = core.NewCallback(func(AppinfoVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetDoDelete gets the "do_delete" callback function.
-// Deletes a [iface@Gio.AppInfo]. (Since 2.20)
+// Deletes a [AppInfo]. (Since 2.20)
 func (x *AppInfoIface) GetDoDelete() func(AppInfo) bool {
 	if x.xDoDelete == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xDoDelete)
+	core.RegisterFunc(&rawCallback, x.xDoDelete)
 	return func(AppinfoVar AppInfo) bool {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideGetCommandline sets the "get_commandline" callback function.
-// Gets the commandline for the [iface@Gio.AppInfo].
+// Gets the commandline for the [AppInfo].
 //
 //	(Since 2.20)
 func (x *AppInfoIface) OverrideGetCommandline(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetCommandline = 0
 	} else {
-		x.xGetCommandline = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetCommandline = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetCommandline gets the "get_commandline" callback function.
-// Gets the commandline for the [iface@Gio.AppInfo].
+// Gets the commandline for the [AppInfo].
 //
 //	(Since 2.20)
 func (x *AppInfoIface) GetGetCommandline() func(AppInfo) string {
@@ -629,28 +652,29 @@ func (x *AppInfoIface) GetGetCommandline() func(AppInfo) string {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetCommandline)
+	core.RegisterFunc(&rawCallback, x.xGetCommandline)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
 }
 
 // OverrideGetDisplayName sets the "get_display_name" callback function.
-// Gets the display name for the [iface@Gio.AppInfo].
+// Gets the display name for the [AppInfo].
 //
 //	(Since 2.24)
 func (x *AppInfoIface) OverrideGetDisplayName(cb func(AppInfo) string) {
 	if cb == nil {
 		x.xGetDisplayName = 0
 	} else {
-		x.xGetDisplayName = purego.NewCallback(func(AppinfoVarp uintptr) string {
+		x.xGetDisplayName = core.NewCallback(func(AppinfoVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetDisplayName gets the "get_display_name" callback function.
-// Gets the display name for the [iface@Gio.AppInfo].
+// Gets the display name for the [AppInfo].
 //
 //	(Since 2.24)
 func (x *AppInfoIface) GetGetDisplayName() func(AppInfo) string {
@@ -658,7 +682,7 @@ func (x *AppInfoIface) GetGetDisplayName() func(AppInfo) string {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetDisplayName)
+	core.RegisterFunc(&rawCallback, x.xGetDisplayName)
 	return func(AppinfoVar AppInfo) string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -667,12 +691,13 @@ func (x *AppInfoIface) GetGetDisplayName() func(AppInfo) string {
 // OverrideSetAsLastUsedForType sets the "set_as_last_used_for_type" callback function.
 // Sets the application as the last used. See
 //
-//	[method@Gio.AppInfo.set_as_last_used_for_type].
+//	[AppInfo.SetAsLastUsedForType].
 func (x *AppInfoIface) OverrideSetAsLastUsedForType(cb func(AppInfo, string) bool) {
 	if cb == nil {
 		x.xSetAsLastUsedForType = 0
 	} else {
-		x.xSetAsLastUsedForType = purego.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+		x.xSetAsLastUsedForType = core.NewCallback(func(AppinfoVarp uintptr, ContentTypeVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, ContentTypeVarp)
 		})
 	}
@@ -681,34 +706,35 @@ func (x *AppInfoIface) OverrideSetAsLastUsedForType(cb func(AppInfo, string) boo
 // GetSetAsLastUsedForType gets the "set_as_last_used_for_type" callback function.
 // Sets the application as the last used. See
 //
-//	[method@Gio.AppInfo.set_as_last_used_for_type].
+//	[AppInfo.SetAsLastUsedForType].
 func (x *AppInfoIface) GetSetAsLastUsedForType() func(AppInfo, string) bool {
 	if x.xSetAsLastUsedForType == 0 {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ContentTypeVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAsLastUsedForType)
+	core.RegisterFunc(&rawCallback, x.xSetAsLastUsedForType)
 	return func(AppinfoVar AppInfo, ContentTypeVar string) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ContentTypeVar)
 	}
 }
 
 // OverrideGetSupportedTypes sets the "get_supported_types" callback function.
-// Retrieves the list of content types that @app_info
+// Retrieves the list of content types that app_info
 //
 //	claims to support.
 func (x *AppInfoIface) OverrideGetSupportedTypes(cb func(AppInfo) []string) {
 	if cb == nil {
 		x.xGetSupportedTypes = 0
 	} else {
-		x.xGetSupportedTypes = purego.NewCallback(func(AppinfoVarp uintptr) []string {
+		x.xGetSupportedTypes = core.NewCallback(func(AppinfoVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp})
 		})
 	}
 }
 
 // GetGetSupportedTypes gets the "get_supported_types" callback function.
-// Retrieves the list of content types that @app_info
+// Retrieves the list of content types that app_info
 //
 //	claims to support.
 func (x *AppInfoIface) GetGetSupportedTypes() func(AppInfo) []string {
@@ -716,7 +742,7 @@ func (x *AppInfoIface) GetGetSupportedTypes() func(AppInfo) []string {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xGetSupportedTypes)
+	core.RegisterFunc(&rawCallback, x.xGetSupportedTypes)
 	return func(AppinfoVar AppInfo) []string {
 		return rawCallback(AppinfoVar.GoPointer())
 	}
@@ -730,7 +756,8 @@ func (x *AppInfoIface) OverrideLaunchUrisAsync(cb func(AppInfo, *glib.List, *App
 	if cb == nil {
 		x.xLaunchUrisAsync = 0
 	} else {
-		x.xLaunchUrisAsync = purego.NewCallback(func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLaunchUrisAsync = core.NewCallback(func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&AppInfoBase{Ptr: AppinfoVarp}, UrisVarp, AppLaunchContextNewFromInternalPtr(ContextVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -745,28 +772,29 @@ func (x *AppInfoIface) GetLaunchUrisAsync() func(AppInfo, *glib.List, *AppLaunch
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, UrisVarp *glib.List, ContextVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLaunchUrisAsync)
+	core.RegisterFunc(&rawCallback, x.xLaunchUrisAsync)
 	return func(AppinfoVar AppInfo, UrisVar *glib.List, ContextVar *AppLaunchContext, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(AppinfoVar.GoPointer(), UrisVar, ContextVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideLaunchUrisFinish sets the "launch_uris_finish" callback function.
-// Finishes an operation started with @launch_uris_async.
+// Finishes an operation started with launch_uris_async.
 //
 //	(Since: 2.60)
 func (x *AppInfoIface) OverrideLaunchUrisFinish(cb func(AppInfo, AsyncResult) bool) {
 	if cb == nil {
 		x.xLaunchUrisFinish = 0
 	} else {
-		x.xLaunchUrisFinish = purego.NewCallback(func(AppinfoVarp uintptr, ResultVarp uintptr) bool {
+		x.xLaunchUrisFinish = core.NewCallback(func(AppinfoVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AppInfoBase{Ptr: AppinfoVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetLaunchUrisFinish gets the "launch_uris_finish" callback function.
-// Finishes an operation started with @launch_uris_async.
+// Finishes an operation started with launch_uris_async.
 //
 //	(Since: 2.60)
 func (x *AppInfoIface) GetLaunchUrisFinish() func(AppInfo, AsyncResult) bool {
@@ -774,7 +802,7 @@ func (x *AppInfoIface) GetLaunchUrisFinish() func(AppInfo, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(AppinfoVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xLaunchUrisFinish)
+	core.RegisterFunc(&rawCallback, x.xLaunchUrisFinish)
 	return func(AppinfoVar AppInfo, ResultVar AsyncResult) bool {
 		return rawCallback(AppinfoVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -811,7 +839,8 @@ func (x *AppLaunchContextClass) OverrideGetDisplay(cb func(*AppLaunchContext, Ap
 	if cb == nil {
 		x.xGetDisplay = 0
 	} else {
-		x.xGetDisplay = purego.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string {
+		x.xGetDisplay = core.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string {
+			defer core.RecoverPanic()
 			return cb(AppLaunchContextNewFromInternalPtr(ContextVarp), &AppInfoBase{Ptr: InfoVarp}, FilesVarp)
 		})
 	}
@@ -823,7 +852,7 @@ func (x *AppLaunchContextClass) GetGetDisplay() func(*AppLaunchContext, AppInfo,
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string
-	purego.RegisterFunc(&rawCallback, x.xGetDisplay)
+	core.RegisterFunc(&rawCallback, x.xGetDisplay)
 	return func(ContextVar *AppLaunchContext, InfoVar AppInfo, FilesVar *glib.List) string {
 		return rawCallback(ContextVar.GoPointer(), InfoVar.GoPointer(), FilesVar)
 	}
@@ -834,7 +863,8 @@ func (x *AppLaunchContextClass) OverrideGetStartupNotifyId(cb func(*AppLaunchCon
 	if cb == nil {
 		x.xGetStartupNotifyId = 0
 	} else {
-		x.xGetStartupNotifyId = purego.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string {
+		x.xGetStartupNotifyId = core.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string {
+			defer core.RecoverPanic()
 			return cb(AppLaunchContextNewFromInternalPtr(ContextVarp), &AppInfoBase{Ptr: InfoVarp}, FilesVarp)
 		})
 	}
@@ -846,7 +876,7 @@ func (x *AppLaunchContextClass) GetGetStartupNotifyId() func(*AppLaunchContext,
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, InfoVarp uintptr, FilesVarp *glib.List) string
-	purego.RegisterFunc(&rawCallback, x.xGetStartupNotifyId)
+	core.RegisterFunc(&rawCallback, x.xGetStartupNotifyId)
 	return func(ContextVar *AppLaunchContext, InfoVar AppInfo, FilesVar *glib.List) string {
 		return rawCallback(ContextVar.GoPointer(), InfoVar.GoPointer(), FilesVar)
 	}
@@ -857,7 +887,8 @@ func (x *AppLaunchContextClass) OverrideLaunchFailed(cb func(*AppLaunchContext,
 	if cb == nil {
 		x.xLaunchFailed = 0
 	} else {
-		x.xLaunchFailed = purego.NewCallback(func(ContextVarp uintptr, StartupNotifyIdVarp string) {
+		x.xLaunchFailed = core.NewCallback(func(ContextVarp uintptr, StartupNotifyIdVarp string) {
+			defer core.RecoverPanic()
 			cb(AppLaunchContextNewFromInternalPtr(ContextVarp), StartupNotifyIdVarp)
 		})
 	}
@@ -869,7 +900,7 @@ func (x *AppLaunchContextClass) GetLaunchFailed() func(*AppLaunchContext, string
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, StartupNotifyIdVarp string)
-	purego.RegisterFunc(&rawCallback, x.xLaunchFailed)
+	core.RegisterFunc(&rawCallback, x.xLaunchFailed)
 	return func(ContextVar *AppLaunchContext, StartupNotifyIdVar string) {
 		rawCallback(ContextVar.GoPointer(), StartupNotifyIdVar)
 	}
@@ -880,7 +911,8 @@ func (x *AppLaunchContextClass) OverrideLaunched(cb func(*AppLaunchContext, AppI
 	if cb == nil {
 		x.xLaunched = 0
 	} else {
-		x.xLaunched = purego.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant) {
+		x.xLaunched = core.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(AppLaunchContextNewFromInternalPtr(ContextVarp), &AppInfoBase{Ptr: InfoVarp}, PlatformDataVarp)
 		})
 	}
@@ -892,7 +924,7 @@ func (x *AppLaunchContextClass) GetLaunched() func(*AppLaunchContext, AppInfo, *
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xLaunched)
+	core.RegisterFunc(&rawCallback, x.xLaunched)
 	return func(ContextVar *AppLaunchContext, InfoVar AppInfo, PlatformDataVar *glib.Variant) {
 		rawCallback(ContextVar.GoPointer(), InfoVar.GoPointer(), PlatformDataVar)
 	}
@@ -903,7 +935,8 @@ func (x *AppLaunchContextClass) OverrideLaunchStarted(cb func(*AppLaunchContext,
 	if cb == nil {
 		x.xLaunchStarted = 0
 	} else {
-		x.xLaunchStarted = purego.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant) {
+		x.xLaunchStarted = core.NewCallback(func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(AppLaunchContextNewFromInternalPtr(ContextVarp), &AppInfoBase{Ptr: InfoVarp}, PlatformDataVarp)
 		})
 	}
@@ -915,7 +948,7 @@ func (x *AppLaunchContextClass) GetLaunchStarted() func(*AppLaunchContext, AppIn
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, InfoVarp uintptr, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xLaunchStarted)
+	core.RegisterFunc(&rawCallback, x.xLaunchStarted)
 	return func(ContextVar *AppLaunchContext, InfoVar AppInfo, PlatformDataVar *glib.Variant) {
 		rawCallback(ContextVar.GoPointer(), InfoVar.GoPointer(), PlatformDataVar)
 	}
@@ -926,7 +959,8 @@ func (x *AppLaunchContextClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -938,7 +972,7 @@ func (x *AppLaunchContextClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -949,7 +983,8 @@ func (x *AppLaunchContextClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -961,7 +996,7 @@ func (x *AppLaunchContextClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -972,7 +1007,8 @@ func (x *AppLaunchContextClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -984,7 +1020,7 @@ func (x *AppLaunchContextClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -1005,13 +1041,11 @@ func (x *AppLaunchContextPrivate) GoPointer() uintptr {
 // applications installed on the system.
 //
 // As of GLib 2.20, URIs will always be converted to POSIX paths
-// (using [method@Gio.File.get_path]) when using [method@Gio.AppInfo.launch]
+// (using [File.GetPath]) when using [AppInfo.Launch]
 // even if the application requested an URI and not a POSIX path. For example
 // for a desktop-file based application with the following Exec key:
 //
-// ```
-// Exec=totem %U
-// ```
+//	Exec=totem %U
 //
 // and a single URI, `sftp://foo/file.avi`, then
 // `/home/user/.gvfs/sftp on foo/file.avi` will be passed. This will only work
@@ -1022,32 +1056,28 @@ func (x *AppLaunchContextPrivate) GoPointer() uintptr {
 // for it); such URIs will be passed unmodified to the application.
 //
 // Specifically for GVfs 2.26 and later, the POSIX URI will be mapped
-// back to the GIO URI in the [iface@Gio.File] constructors (since GVfs
+// back to the GIO URI in the [File] constructors (since GVfs
 // implements the GVfs extension point). As such, if the application
-// needs to examine the URI, it needs to use [method@Gio.File.get_uri]
-// or similar on [iface@Gio.File]. In other words, an application cannot
-// assume that the URI passed to e.g. [func@Gio.File.new_for_commandline_arg]
-// is equal to the result of [method@Gio.File.get_uri]. The following snippet
+// needs to examine the URI, it needs to use [File.GetUri]
+// or similar on [File]. In other words, an application cannot
+// assume that the URI passed to e.g. [FileNewForCommandlineArg]
+// is equal to the result of [File.GetUri]. The following snippet
 // illustrates this:
 //
-// ```c
-// GFile *f;
-// char *uri;
-//
-// file = g_file_new_for_commandline_arg (uri_from_commandline);
+//	GFile *f;
+//	char *uri;
 //
-// uri = g_file_get_uri (file);
-// strcmp (uri, uri_from_commandline) == 0;
-// g_free (uri);
+//	file = g_file_new_for_commandline_arg (uri_from_commandline);
 //
-// if (g_file_has_uri_scheme (file, "cdda"))
+//	uri = g_file_get_uri (file);
+//	strcmp (uri, uri_from_commandline) == 0;
+//	g_free (uri);
 //
-//	{
-//	  // do something special with uri
-//	}
-//
-// g_object_unref (file);
-// ```
+//	if (g_file_has_uri_scheme (file, "cdda"))
+//	  {
+//	    // do something special with uri
+//	  }
+//	g_object_unref (file);
 //
 // This code will work when both `cdda://sr0/Track 1.wav` and
 // `/home/user/.gvfs/cdda on sr0/Track 1.wav` is passed to the
@@ -1106,6 +1136,206 @@ func (x *AppInfoBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementAppInfo adds the AppInfo interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Dup() *AppInfoBase
+//   - Equal(AppInfo) bool
+//   - GetId() string
+//   - GetName() string
+//   - GetDescription() string
+//   - GetExecutable() string
+//   - GetIcon() *IconBase
+//   - Launch(*glib.List, *AppLaunchContext) bool
+//   - SupportsUris() bool
+//   - SupportsFiles() bool
+//   - LaunchUris(*glib.List, *AppLaunchContext) bool
+//   - ShouldShow() bool
+//   - SetAsDefaultForType(string) bool
+//   - SetAsDefaultForExtension(string) bool
+//   - AddSupportsType(string) bool
+//   - CanRemoveSupportsType() bool
+//   - RemoveSupportsType(string) bool
+//   - CanDelete() bool
+//   - DoDelete() bool
+//   - GetCommandline() string
+//   - GetDisplayName() string
+//   - SetAsLastUsedForType(string) bool
+//   - GetSupportedTypes() []string
+//   - LaunchUrisAsync(*glib.List, *AppLaunchContext, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - LaunchUrisFinish(AsyncResult) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AppInfoIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAppInfo[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AppInfoGLibType(), func(iface *AppInfoIface) {
+		if _, ok := any(zero).(interface{ Dup() *AppInfoBase }); ok {
+			iface.OverrideDup(func(self AppInfo) *AppInfoBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Dup() *AppInfoBase })
+				return impl.Dup()
+			})
+		}
+		if _, ok := any(zero).(interface{ Equal(AppInfo) bool }); ok {
+			iface.OverrideEqual(func(self AppInfo, arg0 AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Equal(AppInfo) bool })
+				return impl.Equal(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetId() string }); ok {
+			iface.OverrideGetId(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetId() string })
+				return impl.GetId()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetName() string }); ok {
+			iface.OverrideGetName(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetName() string })
+				return impl.GetName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDescription() string }); ok {
+			iface.OverrideGetDescription(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDescription() string })
+				return impl.GetDescription()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetExecutable() string }); ok {
+			iface.OverrideGetExecutable(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetExecutable() string })
+				return impl.GetExecutable()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIcon() *IconBase }); ok {
+			iface.OverrideGetIcon(func(self AppInfo) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIcon() *IconBase })
+				return impl.GetIcon()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Launch(*glib.List, *AppLaunchContext) bool
+		}); ok {
+			iface.OverrideLaunch(func(self AppInfo, arg0 *glib.List, arg1 *AppLaunchContext) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Launch(*glib.List, *AppLaunchContext) bool
+				})
+				return impl.Launch(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ SupportsUris() bool }); ok {
+			iface.OverrideSupportsUris(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SupportsUris() bool })
+				return impl.SupportsUris()
+			})
+		}
+		if _, ok := any(zero).(interface{ SupportsFiles() bool }); ok {
+			iface.OverrideSupportsFiles(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SupportsFiles() bool })
+				return impl.SupportsFiles()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			LaunchUris(*glib.List, *AppLaunchContext) bool
+		}); ok {
+			iface.OverrideLaunchUris(func(self AppInfo, arg0 *glib.List, arg1 *AppLaunchContext) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LaunchUris(*glib.List, *AppLaunchContext) bool
+				})
+				return impl.LaunchUris(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ ShouldShow() bool }); ok {
+			iface.OverrideShouldShow(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ShouldShow() bool })
+				return impl.ShouldShow()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetAsDefaultForType(string) bool }); ok {
+			iface.OverrideSetAsDefaultForType(func(self AppInfo, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetAsDefaultForType(string) bool })
+				return impl.SetAsDefaultForType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetAsDefaultForExtension(string) bool }); ok {
+			iface.OverrideSetAsDefaultForExtension(func(self AppInfo, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetAsDefaultForExtension(string) bool })
+				return impl.SetAsDefaultForExtension(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ AddSupportsType(string) bool }); ok {
+			iface.OverrideAddSupportsType(func(self AppInfo, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ AddSupportsType(string) bool })
+				return impl.AddSupportsType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ CanRemoveSupportsType() bool }); ok {
+			iface.OverrideCanRemoveSupportsType(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanRemoveSupportsType() bool })
+				return impl.CanRemoveSupportsType()
+			})
+		}
+		if _, ok := any(zero).(interface{ RemoveSupportsType(string) bool }); ok {
+			iface.OverrideRemoveSupportsType(func(self AppInfo, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RemoveSupportsType(string) bool })
+				return impl.RemoveSupportsType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ CanDelete() bool }); ok {
+			iface.OverrideCanDelete(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanDelete() bool })
+				return impl.CanDelete()
+			})
+		}
+		if _, ok := any(zero).(interface{ DoDelete() bool }); ok {
+			iface.OverrideDoDelete(func(self AppInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DoDelete() bool })
+				return impl.DoDelete()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetCommandline() string }); ok {
+			iface.OverrideGetCommandline(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetCommandline() string })
+				return impl.GetCommandline()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDisplayName() string }); ok {
+			iface.OverrideGetDisplayName(func(self AppInfo) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDisplayName() string })
+				return impl.GetDisplayName()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetAsLastUsedForType(string) bool }); ok {
+			iface.OverrideSetAsLastUsedForType(func(self AppInfo, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetAsLastUsedForType(string) bool })
+				return impl.SetAsLastUsedForType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSupportedTypes() []string }); ok {
+			iface.OverrideGetSupportedTypes(func(self AppInfo) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSupportedTypes() []string })
+				return impl.GetSupportedTypes()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			LaunchUrisAsync(*glib.List, *AppLaunchContext, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideLaunchUrisAsync(func(self AppInfo, arg0 *glib.List, arg1 *AppLaunchContext, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LaunchUrisAsync(*glib.List, *AppLaunchContext, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.LaunchUrisAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ LaunchUrisFinish(AsyncResult) bool }); ok {
+			iface.OverrideLaunchUrisFinish(func(self AppInfo, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ LaunchUrisFinish(AsyncResult) bool })
+				return impl.LaunchUrisFinish(arg0)
+			})
+		}
+	})
+}
+
 // Adds a content type to the application information to indicate the
 // application is capable of opening files with the given content type.
 func (x *AppInfoBase) AddSupportsType(ContentTypeVar string) (bool, error) {
@@ -1113,14 +1343,14 @@ func (x *AppInfoBase) AddSupportsType(ContentTypeVar string) (bool, error) {
 
 	cret := XGAppInfoAddSupportsType(x.GoPointer(), ContentTypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Obtains the information whether the [iface@Gio.AppInfo] can be deleted.
-// See [method@Gio.AppInfo.delete].
+// Obtains the information whether the [AppInfo] can be deleted.
+// See [AppInfo.Delete].
 func (x *AppInfoBase) CanDelete() bool {
 
 	cret := XGAppInfoCanDelete(x.GoPointer())
@@ -1134,18 +1364,18 @@ func (x *AppInfoBase) CanRemoveSupportsType() bool {
 	return cret
 }
 
-// Tries to delete a [iface@Gio.AppInfo].
+// Tries to delete a [AppInfo].
 //
 // On some platforms, there may be a difference between user-defined
-// [iface@Gio.AppInfo]s which can be deleted, and system-wide ones which cannot.
-// See [method@Gio.AppInfo.can_delete].
+// [AppInfo]s which can be deleted, and system-wide ones which cannot.
+// See [AppInfo.CanDelete].
 func (x *AppInfoBase) Delete() bool {
 
 	cret := XGAppInfoDelete(x.GoPointer())
 	return cret
 }
 
-// Creates a duplicate of a [iface@Gio.AppInfo].
+// Creates a duplicate of a [AppInfo].
 func (x *AppInfoBase) Dup() *AppInfoBase {
 	var cls *AppInfoBase
 
@@ -1159,7 +1389,7 @@ func (x *AppInfoBase) Dup() *AppInfoBase {
 	return cls
 }
 
-// Checks if two [iface@Gio.AppInfo]s are equal.
+// Checks if two [AppInfo]s are equal.
 //
 // Note that the check *may not* compare each individual field, and only does
 // an identity check. In case detecting changes in the contents is needed,
@@ -1196,7 +1426,7 @@ func (x *AppInfoBase) GetDisplayName() string {
 // Gets the executable’s name for the installed application.
 //
 // This is intended to be used for debugging or labelling what program is going
-// to be run. To launch the executable, use [method@Gio.AppInfo.launch] and related
+// to be run. To launch the executable, use [AppInfo.Launch] and related
 // functions, rather than spawning the return value from this function.
 func (x *AppInfoBase) GetExecutable() string {
 
@@ -1223,7 +1453,7 @@ func (x *AppInfoBase) GetIcon() *IconBase {
 // application. The exact format of the id is platform dependent. For instance,
 // on Unix this is the desktop file id from the xdg menu specification.
 //
-// Note that the returned ID may be `NULL`, depending on how the @appinfo has
+// Note that the returned ID may be `NULL`, depending on how the appinfo has
 // been constructed.
 func (x *AppInfoBase) GetId() string {
 
@@ -1238,12 +1468,12 @@ func (x *AppInfoBase) GetName() string {
 	return cret
 }
 
-// Retrieves the list of content types that @app_info claims to support.
+// Retrieves the list of content types that app_info claims to support.
 // If this information is not provided by the environment, this function
 // will return `NULL`.
 //
 // This function does not take in consideration associations added with
-// [method@Gio.AppInfo.add_supports_type], but only those exported directly by
+// [AppInfo.AddSupportsType], but only those exported directly by
 // the application.
 func (x *AppInfoBase) GetSupportedTypes() []string {
 
@@ -1251,12 +1481,12 @@ func (x *AppInfoBase) GetSupportedTypes() []string {
 	return cret
 }
 
-// Launches the application. Passes @files to the launched application
-// as arguments, using the optional @context to get information
+// Launches the application. Passes files to the launched application
+// as arguments, using the optional context to get information
 // about the details of the launcher (like what screen it is on).
-// On error, @error will be set accordingly.
+// On error, error will be set accordingly.
 //
-// To launch the application without arguments pass a `NULL` @files list.
+// To launch the application without arguments pass a `NULL` files list.
 //
 // Note that even if the launch is successful the application launched
 // can fail to start if it runs into problems during startup. There is
@@ -1265,11 +1495,11 @@ func (x *AppInfoBase) GetSupportedTypes() []string {
 // Some URIs can be changed when passed through a GFile (for instance
 // unsupported URIs with strange formats like mailto:), so if you have
 // a textual URI you want to pass in as argument, consider using
-// [method@Gio.AppInfo.launch_uris] instead.
+// [AppInfo.LaunchUris] instead.
 //
 // The launched application inherits the environment of the launching
-// process, but it can be modified with [method@Gio.AppLaunchContext.setenv]
-// and [method@Gio.AppLaunchContext.unsetenv].
+// process, but it can be modified with [AppLaunchContext.Setenv]
+// and [AppLaunchContext.Unsetenv].
 //
 // On UNIX, this function sets the `GIO_LAUNCHED_DESKTOP_FILE`
 // environment variable with the path of the launched desktop file and
@@ -1277,26 +1507,26 @@ func (x *AppInfoBase) GetSupportedTypes() []string {
 // process. This can be used to ignore `GIO_LAUNCHED_DESKTOP_FILE`,
 // should it be inherited by further processes. The `DISPLAY`,
 // `XDG_ACTIVATION_TOKEN` and `DESKTOP_STARTUP_ID` environment
-// variables are also set, based on information provided in @context.
+// variables are also set, based on information provided in context.
 func (x *AppInfoBase) Launch(FilesVar *glib.List, ContextVar *AppLaunchContext) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAppInfoLaunch(x.GoPointer(), FilesVar, ContextVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Launches the application. This passes the @uris to the launched application
-// as arguments, using the optional @context to get information
+// Launches the application. This passes the uris to the launched application
+// as arguments, using the optional context to get information
 // about the details of the launcher (like what screen it is on).
-// On error, @error will be set accordingly. If the application only supports
+// On error, error will be set accordingly. If the application only supports
 // one URI per invocation as part of their command-line, multiple instances
 // of the application will be spawned.
 //
-// To launch the application without arguments pass a `NULL` @uris list.
+// To launch the application without arguments pass a `NULL` uris list.
 //
 // Note that even if the launch is successful the application launched
 // can fail to start if it runs into problems during startup. There is
@@ -1306,31 +1536,47 @@ func (x *AppInfoBase) LaunchUris(UrisVar *glib.List, ContextVar *AppLaunchContex
 
 	cret := XGAppInfoLaunchUris(x.GoPointer(), UrisVar, ContextVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Async version of [method@Gio.AppInfo.launch_uris].
+// Async version of [AppInfo.LaunchUris].
 //
-// The @callback is invoked immediately after the application launch, but it
+// The callback is invoked immediately after the application launch, but it
 // waits for activation in case of D-Bus–activated applications and also provides
 // extended error information for sandboxed applications, see notes for
-// [func@Gio.AppInfo.launch_default_for_uri_async].
+// [AppInfoLaunchDefaultForUriAsync].
 func (x *AppInfoBase) LaunchUrisAsync(UrisVar *glib.List, ContextVar *AppLaunchContext, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGAppInfoLaunchUrisAsync(x.GoPointer(), UrisVar, ContextVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGAppInfoLaunchUrisAsync(x.GoPointer(), UrisVar, ContextVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a [method@Gio.AppInfo.launch_uris_async] operation.
+// Finishes a [AppInfo.LaunchUrisAsync] operation.
 func (x *AppInfoBase) LaunchUrisFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAppInfoLaunchUrisFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1342,7 +1588,7 @@ func (x *AppInfoBase) RemoveSupportsType(ContentTypeVar string) (bool, error) {
 
 	cret := XGAppInfoRemoveSupportsType(x.GoPointer(), ContentTypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1354,7 +1600,7 @@ func (x *AppInfoBase) SetAsDefaultForExtension(ExtensionVar string) (bool, error
 
 	cret := XGAppInfoSetAsDefaultForExtension(x.GoPointer(), ExtensionVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1366,7 +1612,7 @@ func (x *AppInfoBase) SetAsDefaultForType(ContentTypeVar string) (bool, error) {
 
 	cret := XGAppInfoSetAsDefaultForType(x.GoPointer(), ContentTypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1374,14 +1620,14 @@ func (x *AppInfoBase) SetAsDefaultForType(ContentTypeVar string) (bool, error) {
 
 // Sets the application as the last used application for a given type. This
 // will make the application appear as first in the list returned by
-// [func@Gio.AppInfo.get_recommended_for_type], regardless of the default
+// [AppInfoGetRecommendedForType], regardless of the default
 // application for that content type.
 func (x *AppInfoBase) SetAsLastUsedForType(ContentTypeVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAppInfoSetAsLastUsedForType(x.GoPointer(), ContentTypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1437,15 +1683,18 @@ var XGAppInfoSupportsUris func(uintptr) bool
 
 var xAppInfoCreateFromCommandline func(string, uintptr, AppInfoCreateFlags, **glib.Error) uintptr
 
-// Creates a new [iface@Gio.AppInfo] from the given information.
+// Creates a new [AppInfo] from the given information.
 //
-// Note that for @commandline, the quoting rules of the `Exec` key of the
-// [freedesktop.org Desktop Entry Specification](http://freedesktop.org/Standards/desktop-entry-spec)
-// are applied. For example, if the @commandline contains
+// Note that for commandline, the quoting rules of the `Exec` key of the
+// [freedesktop.org Desktop Entry Specification]
+// are applied. For example, if the commandline contains
 // percent-encoded URIs, the percent-character must be doubled in order to prevent it from
 // being swallowed by `Exec` key unquoting. See
-// [the specification](https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s07.html)
+// [the specification]
 // for exact quoting rules.
+//
+// [freedesktop.org Desktop Entry Specification]: http://freedesktop.org/Standards/desktop-entry-spec
+// [the specification]: https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s07.html
 func AppInfoCreateFromCommandline(CommandlineVar string, ApplicationNameVar *string, FlagsVar AppInfoCreateFlags) (*AppInfoBase, error) {
 	var cls *AppInfoBase
 	var cerr *glib.Error
@@ -1456,12 +1705,15 @@ func AppInfoCreateFromCommandline(CommandlineVar string, ApplicationNameVar *str
 	cret := xAppInfoCreateFromCommandline(CommandlineVar, ApplicationNameVarPtr, FlagsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1473,15 +1725,20 @@ var xAppInfoGetAll func() *glib.List
 // on this system.
 //
 // For desktop files, this includes applications that have
-// [`NoDisplay=true`](https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-nodisplay)
+// [NoDisplay=true]
 // set or are excluded from display by means of
-// [`OnlyShowIn`](https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-onlyshowin)
-// or [`NotShowIn`](https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-notshowin).
-// See [method@Gio.AppInfo.should_show].
+// [OnlyShowIn]
+// or [NotShowIn].
+// See [AppInfo.ShouldShow].
 //
 // The returned list does not include applications which have the
-// [`Hidden` key](https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-hidden)
+// [Hidden key]
 // set.
+//
+// [NoDisplay=true]: https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-nodisplay
+// [OnlyShowIn]: https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-onlyshowin
+// [NotShowIn]: https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-notshowin
+// [Hidden key]: https://specifications.freedesktop.org/desktop-entry-spec/latest/ar01s06.html#key-hidden
 func AppInfoGetAll() *glib.List {
 
 	cret := xAppInfoGetAll()
@@ -1490,10 +1747,10 @@ func AppInfoGetAll() *glib.List {
 
 var xAppInfoGetAllForType func(string) *glib.List
 
-// Gets a list of all [iface@Gio.AppInfo]s for a given content type,
-// including the recommended and fallback [iface@Gio.AppInfo]s. See
-// [func@Gio.AppInfo.get_recommended_for_type] and
-// [func@Gio.AppInfo.get_fallback_for_type].
+// Gets a list of all [AppInfo]s for a given content type,
+// including the recommended and fallback [AppInfo]s. See
+// [AppInfoGetRecommendedForType] and
+// [AppInfoGetFallbackForType].
 func AppInfoGetAllForType(ContentTypeVar string) *glib.List {
 
 	cret := xAppInfoGetAllForType(ContentTypeVar)
@@ -1502,7 +1759,7 @@ func AppInfoGetAllForType(ContentTypeVar string) *glib.List {
 
 var xAppInfoGetDefaultForType func(string, bool) uintptr
 
-// Gets the default [iface@Gio.AppInfo] for a given content type.
+// Gets the default [AppInfo] for a given content type.
 func AppInfoGetDefaultForType(ContentTypeVar string, MustSupportUrisVar bool) *AppInfoBase {
 	var cls *AppInfoBase
 
@@ -1518,7 +1775,7 @@ func AppInfoGetDefaultForType(ContentTypeVar string, MustSupportUrisVar bool) *A
 
 var xAppInfoGetDefaultForTypeAsync func(string, bool, uintptr, uintptr, uintptr)
 
-// Asynchronously gets the default [iface@Gio.AppInfo] for a given content
+// Asynchronously gets the default [AppInfo] for a given content
 // type.
 func AppInfoGetDefaultForTypeAsync(ContentTypeVar string, MustSupportUrisVar bool, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1529,11 +1786,12 @@ func AppInfoGetDefaultForTypeAsync(ContentTypeVar string, MustSupportUrisVar boo
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1543,11 +1801,11 @@ func AppInfoGetDefaultForTypeAsync(ContentTypeVar string, MustSupportUrisVar boo
 
 var xAppInfoGetDefaultForTypeFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes a default [iface@Gio.AppInfo] lookup started by
-// [func@Gio.AppInfo.get_default_for_type_async].
+// Finishes a default [AppInfo] lookup started by
+// [AppInfoGetDefaultForTypeAsync].
 //
-// If no #[iface@Gio.AppInfo] is found, then @error will be set to
-// [error@Gio.IOErrorEnum.NOT_FOUND].
+// If no #[AppInfo] is found, then error will be set to
+// IOErrorEnum.NOT_FOUND.
 func AppInfoGetDefaultForTypeFinish(ResultVar AsyncResult) (*AppInfoBase, error) {
 	var cls *AppInfoBase
 	var cerr *glib.Error
@@ -1555,12 +1813,15 @@ func AppInfoGetDefaultForTypeFinish(ResultVar AsyncResult) (*AppInfoBase, error)
 	cret := xAppInfoGetDefaultForTypeFinish(ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1600,11 +1861,12 @@ func AppInfoGetDefaultForUriSchemeAsync(UriSchemeVar string, CancellableVar *Can
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1614,11 +1876,11 @@ func AppInfoGetDefaultForUriSchemeAsync(UriSchemeVar string, CancellableVar *Can
 
 var xAppInfoGetDefaultForUriSchemeFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes a default [iface@Gio.AppInfo] lookup started by
-// [func@Gio.AppInfo.get_default_for_uri_scheme_async].
+// Finishes a default [AppInfo] lookup started by
+// [AppInfoGetDefaultForUriSchemeAsync].
 //
-// If no [iface@Gio.AppInfo] is found, then @error will be set to
-// [error@Gio.IOErrorEnum.NOT_FOUND].
+// If no [AppInfo] is found, then error will be set to
+// IOErrorEnum.NOT_FOUND.
 func AppInfoGetDefaultForUriSchemeFinish(ResultVar AsyncResult) (*AppInfoBase, error) {
 	var cls *AppInfoBase
 	var cerr *glib.Error
@@ -1626,12 +1888,15 @@ func AppInfoGetDefaultForUriSchemeFinish(ResultVar AsyncResult) (*AppInfoBase, e
 	cret := xAppInfoGetDefaultForUriSchemeFinish(ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1639,7 +1904,7 @@ func AppInfoGetDefaultForUriSchemeFinish(ResultVar AsyncResult) (*AppInfoBase, e
 
 var xAppInfoGetFallbackForType func(string) *glib.List
 
-// Gets a list of fallback [iface@Gio.AppInfo]s for a given content type, i.e.
+// Gets a list of fallback [AppInfo]s for a given content type, i.e.
 // those applications which claim to support the given content type by MIME
 // type subclassing and not directly.
 func AppInfoGetFallbackForType(ContentTypeVar string) *glib.List {
@@ -1650,12 +1915,12 @@ func AppInfoGetFallbackForType(ContentTypeVar string) *glib.List {
 
 var xAppInfoGetRecommendedForType func(string) *glib.List
 
-// Gets a list of recommended [iface@Gio.AppInfo]s for a given content type,
+// Gets a list of recommended [AppInfo]s for a given content type,
 // i.e. those applications which claim to support the given content type
 // exactly, and not by MIME type subclassing.
 //
 // Note that the first application of the list is the last used one, i.e.
-// the last one for which [method@Gio.AppInfo.set_as_last_used_for_type] has
+// the last one for which [AppInfo.SetAsLastUsedForType] has
 // been called.
 func AppInfoGetRecommendedForType(ContentTypeVar string) *glib.List {
 
@@ -1671,13 +1936,13 @@ var xAppInfoLaunchDefaultForUri func(string, uintptr, **glib.Error) bool
 //
 // The D-Bus–activated applications don’t have to be started if your application
 // terminates too soon after this function. To prevent this, use
-// [func@Gio.AppInfo.launch_default_for_uri_async] instead.
+// [AppInfoLaunchDefaultForUriAsync] instead.
 func AppInfoLaunchDefaultForUri(UriVar string, ContextVar *AppLaunchContext) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xAppInfoLaunchDefaultForUri(UriVar, ContextVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1685,7 +1950,7 @@ func AppInfoLaunchDefaultForUri(UriVar string, ContextVar *AppLaunchContext) (bo
 
 var xAppInfoLaunchDefaultForUriAsync func(string, uintptr, uintptr, uintptr, uintptr)
 
-// Async version of [func@Gio.AppInfo.launch_default_for_uri].
+// Async version of [AppInfoLaunchDefaultForUri].
 //
 // This version is useful if you are interested in receiving error information
 // in the case where the application is sandboxed and the portal may present an
@@ -1703,11 +1968,12 @@ func AppInfoLaunchDefaultForUriAsync(UriVar string, ContextVar *AppLaunchContext
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1723,7 +1989,7 @@ func AppInfoLaunchDefaultForUriFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := xAppInfoLaunchDefaultForUriFinish(ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1732,10 +1998,10 @@ func AppInfoLaunchDefaultForUriFinish(ResultVar AsyncResult) (bool, error) {
 var xAppInfoResetTypeAssociations func(string)
 
 // Removes all changes to the type associations done by
-// [method@Gio.AppInfo.set_as_default_for_type],
-// [method@Gio.AppInfo.set_as_default_for_extension],
-// [method@Gio.AppInfo.add_supports_type] or
-// [method@Gio.AppInfo.remove_supports_type].
+// [AppInfo.SetAsDefaultForType],
+// [AppInfo.SetAsDefaultForExtension],
+// [AppInfo.AddSupportsType] or
+// [AppInfo.RemoveSupportsType].
 func AppInfoResetTypeAssociations(ContentTypeVar string) {
 
 	xAppInfoResetTypeAssociations(ContentTypeVar)
@@ -1747,28 +2013,28 @@ func AppInfoResetTypeAssociations(ContentTypeVar string) {
 // `GAppInfoMonitor` is a very simple object used for monitoring the app
 // info database for changes (newly installed or removed applications).
 //
-// Call [func@Gio.AppInfoMonitor.get] to get a `GAppInfoMonitor` and connect
-// to the [signal@Gio.AppInfoMonitor::changed] signal. The signal will be emitted once when
+// Call [AppInfoMonitorGet] to get a `GAppInfoMonitor` and connect
+// to the [AppInfoMonitor.ConnectChangedFunc] signal. The signal will be emitted once when
 // the app info database changes, and will not be emitted again until after the
-// next call to [func@Gio.AppInfo.get_all] or another `g_app_info_*()` function.
+// next call to [AppInfoGetAll] or another `g_app_info_*()` function.
 // This is because monitoring the app info database for changes is expensive.
 //
-// The following functions will re-arm the [signal@Gio.AppInfoMonitor::changed]
+// The following functions will re-arm the [AppInfoMonitor.ConnectChangedFunc]
 // signal so it can be emitted again:
 //
-//   - [func@Gio.AppInfo.get_all]
-//   - [func@Gio.AppInfo.get_all_for_type]
-//   - [func@Gio.AppInfo.get_default_for_type]
-//   - [func@Gio.AppInfo.get_fallback_for_type]
-//   - [func@Gio.AppInfo.get_recommended_for_type]
-//   - [`g_desktop_app_info_get_implementations()`](../gio-unix/type_func.DesktopAppInfo.get_implementation.html)
-//   - [`g_desktop_app_info_new()`](../gio-unix/ctor.DesktopAppInfo.new.html)
-//   - [`g_desktop_app_info_new_from_filename()`](../gio-unix/ctor.DesktopAppInfo.new_from_filename.html)
-//   - [`g_desktop_app_info_new_from_keyfile()`](../gio-unix/ctor.DesktopAppInfo.new_from_keyfile.html)
-//   - [`g_desktop_app_info_search()`](../gio-unix/type_func.DesktopAppInfo.search.html)
+//   - [AppInfoGetAll]
+//   - [AppInfoGetAllForType]
+//   - [AppInfoGetDefaultForType]
+//   - [AppInfoGetFallbackForType]
+//   - [AppInfoGetRecommendedForType]
+//   - g_desktop_app_info_get_implementations()
+//   - g_desktop_app_info_new()
+//   - g_desktop_app_info_new_from_filename()
+//   - g_desktop_app_info_new_from_keyfile()
+//   - g_desktop_app_info_search()
 //
 // The latter functions are available if using
-// [`GDesktopAppInfo`](../gio-unix/class.DesktopAppInfo.html) from
+// GDesktopAppInfo from
 // `gio-unix-2.0.pc` (GIR namespace `GioUnix-2.0`).
 //
 // In the usual case, applications should try to make note of the change
@@ -1810,43 +2076,65 @@ func (c *AppInfoMonitor) SetGoPointer(ptr uintptr) {
 
 // Signal emitted when the app info database changes, when applications are
 // installed or removed.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *AppInfoMonitor) ConnectChanged(cb *func(AppInfoMonitor)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := AppInfoMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xAppInfoMonitorChangedTrampoline uintptr
+
+func xAppInfoMonitorChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppInfoMonitor))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppInfoMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppInfoMonitor) ConnectChangedFunc(cb func(AppInfoMonitor)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xAppInfoMonitorChangedTrampoline, xAppInfoMonitorChangedNewTrampoline, cb)
 }
 
 var xAppInfoMonitorGet func() uintptr
 
-// Gets the #GAppInfoMonitor for the current thread-default main
+// Gets the [AppInfoMonitor] for the current thread-default main
 // context.
 //
-// The #GAppInfoMonitor will emit a “changed” signal in the
+// The [AppInfoMonitor] will emit a “changed” signal in the
 // thread-default main context whenever the list of installed
-// applications (as reported by g_app_info_get_all()) may have changed.
+// applications (as reported by [AppInfoGetAll]) may have changed.
 //
-// The #GAppInfoMonitor::changed signal will only be emitted once until
-// g_app_info_get_all() (or another `g_app_info_*()` function) is called. Doing
+// The [AppInfoMonitor.ConnectChangedFunc] signal will only be emitted once until
+// [AppInfoGetAll] (or another `g_app_info_*()` function) is called. Doing
 // so will re-arm the signal ready to notify about the next change.
 //
-// You must only call g_object_unref() on the return value from under
+// You must only call [gobject.Object.Unref] on the return value from under
 // the same main context as you created it.
 func AppInfoMonitorGet() *AppInfoMonitor {
 	var cls *AppInfoMonitor
@@ -1884,7 +2172,9 @@ var xNewAppLaunchContext func() uintptr
 
 // Creates a new application launch context. This is not normally used,
 // instead you instantiate a subclass of this, such as
-// [`GdkAppLaunchContext`](https://docs.gtk.org/gdk4/class.AppLaunchContext.html).
+// [GdkAppLaunchContext].
+//
+// [GdkAppLaunchContext]: https://docs.gtk.org/gdk4/class.AppLaunchContext.html
 func NewAppLaunchContext() *AppLaunchContext {
 	var cls *AppLaunchContext
 
@@ -1900,7 +2190,7 @@ func NewAppLaunchContext() *AppLaunchContext {
 
 var xAppLaunchContextGetDisplay func(uintptr, uintptr, *glib.List) string
 
-// Gets the display string for the @context. This is used to ensure new
+// Gets the display string for the context. This is used to ensure new
 // applications are started on the same display as the launching
 // application, by setting the `DISPLAY` environment variable.
 func (x *AppLaunchContext) GetDisplay(InfoVar AppInfo, FilesVar *glib.List) string {
@@ -1909,16 +2199,16 @@ func (x *AppLaunchContext) GetDisplay(InfoVar AppInfo, FilesVar *glib.List) stri
 	return cret
 }
 
-var xAppLaunchContextGetEnvironment func(uintptr) []string
+var xAppLaunchContextGetEnvironment func(uintptr) uintptr
 
 // Gets the complete environment variable list to be passed to
-// the child process when @context is used to launch an application.
+// the child process when context is used to launch an application.
 // This is a `NULL`-terminated array of strings, where each string has
 // the form `KEY=VALUE`.
 func (x *AppLaunchContext) GetEnvironment() []string {
 
 	cret := xAppLaunchContextGetEnvironment(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xAppLaunchContextGetStartupNotifyId func(uintptr, uintptr, *glib.List) string
@@ -1929,15 +2219,19 @@ var xAppLaunchContextGetStartupNotifyId func(uintptr, uintptr, *glib.List) strin
 //
 // The returned token may be referred to equivalently as an ‘activation token’
 // (using Wayland terminology) or a ‘startup sequence ID’ (using X11 terminology).
-// The two [are interoperable](https://gitlab.freedesktop.org/wayland/wayland-protocols/-/blob/main/staging/xdg-activation/x11-interoperation.rst).
+// The two [are interoperable].
 //
-// Activation tokens are defined in the [XDG Activation Protocol](https://wayland.app/protocols/xdg-activation-v1),
+// Activation tokens are defined in the [XDG Activation Protocol],
 // and startup notification IDs are defined in the
-// [freedesktop.org Startup Notification Protocol](http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt).
+// [freedesktop.org Startup Notification Protocol].
 //
 // Support for the XDG Activation Protocol was added in GLib 2.76.
-// Since GLib 2.82 @info and @files can be `NULL`. If that’s not supported by the backend,
+// Since GLib 2.82 info and files can be `NULL`. If that’s not supported by the backend,
 // the returned token will be `NULL`.
+//
+// [are interoperable]: https://gitlab.freedesktop.org/wayland/wayland-protocols/-/blob/main/staging/xdg-activation/x11-interoperation.rst
+// [XDG Activation Protocol]: https://wayland.app/protocols/xdg-activation-v1
+// [freedesktop.org Startup Notification Protocol]: http://standards.freedesktop.org/startup-notification-spec/startup-notification-latest.txt
 func (x *AppLaunchContext) GetStartupNotifyId(InfoVar AppInfo, FilesVar *glib.List) string {
 
 	cret := xAppLaunchContextGetStartupNotifyId(x.GoPointer(), InfoVar.GoPointer(), FilesVar)
@@ -1948,7 +2242,7 @@ var xAppLaunchContextLaunchFailed func(uintptr, string)
 
 // Called when an application has failed to launch, so that it can cancel
 // the application startup notification started in
-// [method@Gio.AppLaunchContext.get_startup_notify_id].
+// [AppLaunchContext.GetStartupNotifyId].
 func (x *AppLaunchContext) LaunchFailed(StartupNotifyIdVar string) {
 
 	xAppLaunchContextLaunchFailed(x.GoPointer(), StartupNotifyIdVar)
@@ -1957,8 +2251,8 @@ func (x *AppLaunchContext) LaunchFailed(StartupNotifyIdVar string) {
 
 var xAppLaunchContextSetenv func(uintptr, string, string)
 
-// Arranges for @variable to be set to @value in the child’s environment when
-// @context is used to launch an application.
+// Arranges for variable to be set to value in the child’s environment when
+// context is used to launch an application.
 func (x *AppLaunchContext) Setenv(VariableVar string, ValueVar string) {
 
 	xAppLaunchContextSetenv(x.GoPointer(), VariableVar, ValueVar)
@@ -1967,7 +2261,7 @@ func (x *AppLaunchContext) Setenv(VariableVar string, ValueVar string) {
 
 var xAppLaunchContextUnsetenv func(uintptr, string)
 
-// Arranges for @variable to be unset in the child’s environment when @context
+// Arranges for variable to be unset in the child’s environment when context
 // is used to launch an application.
 func (x *AppLaunchContext) Unsetenv(VariableVar string) {
 
@@ -1986,39 +2280,61 @@ func (c *AppLaunchContext) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// The [signal@Gio.AppLaunchContext::launch-failed] signal is emitted when a
-// [iface@Gio.AppInfo] launch fails. The startup notification id is provided,
+// The [AppLaunchContext.ConnectLaunchFailedFunc] signal is emitted when a
+// [AppInfo] launch fails. The startup notification id is provided,
 // so that the launcher can cancel the startup notification.
 //
 // Because a launch operation may involve spawning multiple instances of the
 // target application, you should expect this signal to be emitted multiple
 // times, one for each spawned instance.
+//
+// Deprecated: use ConnectLaunchFailedFunc, which also accepts method values and closures.
 func (x *AppLaunchContext) ConnectLaunchFailed(cb *func(AppLaunchContext, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "launch-failed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StartupNotifyIdVarp string) {
+			defer core.RecoverPanic()
+			fa := AppLaunchContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StartupNotifyIdVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "launch-failed", cbRefPtr, cbPtr)
+}
+
+var xAppLaunchContextLaunchFailedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StartupNotifyIdVarp string) {
+func xAppLaunchContextLaunchFailedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StartupNotifyIdVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppLaunchContext, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppLaunchContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StartupNotifyIdVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "launch-failed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// The [signal@Gio.AppLaunchContext::launch-started] signal is emitted when a
-// [iface@Gio.AppInfo] is about to be launched. If non-null the
-// @platform_data is an GVariant dictionary mapping strings to variants
+// ConnectLaunchFailedFunc connects cb to the "launch-failed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppLaunchContext) ConnectLaunchFailedFunc(cb func(AppLaunchContext, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "launch-failed", &xAppLaunchContextLaunchFailedTrampoline, xAppLaunchContextLaunchFailedNewTrampoline, cb)
+}
+
+// The [AppLaunchContext.ConnectLaunchStartedFunc] signal is emitted when a
+// [AppInfo] is about to be launched. If non-null the
+// platform_data is an GVariant dictionary mapping strings to variants
 // (ie `a{sv}`), which contains additional, platform-specific data about this
 // launch. On UNIX, at least the `startup-notification-id` keys will be
 // present.
@@ -2029,43 +2345,65 @@ func (x *AppLaunchContext) ConnectLaunchFailed(cb *func(AppLaunchContext, string
 // It allows tracking the progress of the launchee through startup.
 //
 // It is guaranteed that this signal is followed by either a
-// [signal@Gio.AppLaunchContext::launched] or
-// [signal@Gio.AppLaunchContext::launch-failed] signal.
+// [AppLaunchContext.ConnectLaunchedFunc] or
+// [AppLaunchContext.ConnectLaunchFailedFunc] signal.
 //
 // Because a launch operation may involve spawning multiple instances of the
 // target application, you should expect this signal to be emitted multiple
 // times, one for each spawned instance.
+//
+// Deprecated: use ConnectLaunchStartedFunc, which also accepts method values and closures.
 func (x *AppLaunchContext) ConnectLaunchStarted(cb *func(AppLaunchContext, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "launch-started", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := AppLaunchContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, InfoVarp, PlatformDataVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "launch-started", cbRefPtr, cbPtr)
+}
+
+var xAppLaunchContextLaunchStartedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr) {
+func xAppLaunchContextLaunchStartedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppLaunchContext, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppLaunchContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, InfoVarp, PlatformDataVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "launch-started", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// The [signal@Gio.AppLaunchContext::launched] signal is emitted when a
-// [iface@Gio.AppInfo] is successfully launched.
+// ConnectLaunchStartedFunc connects cb to the "launch-started" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppLaunchContext) ConnectLaunchStartedFunc(cb func(AppLaunchContext, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "launch-started", &xAppLaunchContextLaunchStartedTrampoline, xAppLaunchContextLaunchStartedNewTrampoline, cb)
+}
+
+// The [AppLaunchContext.ConnectLaunchedFunc] signal is emitted when a
+// [AppInfo] is successfully launched.
 //
 // Because a launch operation may involve spawning multiple instances of the
 // target application, you should expect this signal to be emitted multiple
 // times, one time for each spawned instance.
 //
-// The @platform_data is an GVariant dictionary mapping
+// The platform_data is an GVariant dictionary mapping
 // strings to variants (ie `a{sv}`), which contains additional,
 // platform-specific data about this launch. On UNIX, at least the
 // `pid` and `startup-notification-id` keys will be present.
@@ -2075,31 +2413,53 @@ func (x *AppLaunchContext) ConnectLaunchStarted(cb *func(AppLaunchContext, uintp
 // set at all in subsequent releases.
 //
 // On Windows, `pid` is guaranteed to be valid only for the duration of the
-// [signal@Gio.AppLaunchContext::launched] signal emission; after the signal
-// is emitted, GLib will call [func@GLib.spawn_close_pid]. If you need to
-// keep the [alias@GLib.Pid] after the signal has been emitted, then you can
+// [AppLaunchContext.ConnectLaunchedFunc] signal emission; after the signal
+// is emitted, GLib will call [glib.SpawnClosePid]. If you need to
+// keep the [glib.Pid] after the signal has been emitted, then you can
 // duplicate `pid` using `DuplicateHandle()`.
+//
+// Deprecated: use ConnectLaunchedFunc, which also accepts method values and closures.
 func (x *AppLaunchContext) ConnectLaunched(cb *func(AppLaunchContext, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "launched", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := AppLaunchContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, InfoVarp, PlatformDataVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "launched", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr) {
+var xAppLaunchContextLaunchedTrampoline uintptr
+
+func xAppLaunchContextLaunchedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InfoVarp uintptr, PlatformDataVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppLaunchContext, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppLaunchContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, InfoVarp, PlatformDataVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "launched", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLaunchedFunc connects cb to the "launched" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppLaunchContext) ConnectLaunchedFunc(cb func(AppLaunchContext, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "launched", &xAppLaunchContextLaunchedTrampoline, xAppLaunchContextLaunchedNewTrampoline, cb)
 }
 
 func init() {
@@ -2107,70 +2467,74 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAppInfoCreateFromCommandline, libs, "g_app_info_create_from_commandline")
-	core.PuregoSafeRegister(&xAppInfoGetAll, libs, "g_app_info_get_all")
-	core.PuregoSafeRegister(&xAppInfoGetAllForType, libs, "g_app_info_get_all_for_type")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForType, libs, "g_app_info_get_default_for_type")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForTypeAsync, libs, "g_app_info_get_default_for_type_async")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForTypeFinish, libs, "g_app_info_get_default_for_type_finish")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForUriScheme, libs, "g_app_info_get_default_for_uri_scheme")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForUriSchemeAsync, libs, "g_app_info_get_default_for_uri_scheme_async")
-	core.PuregoSafeRegister(&xAppInfoGetDefaultForUriSchemeFinish, libs, "g_app_info_get_default_for_uri_scheme_finish")
-	core.PuregoSafeRegister(&xAppInfoGetFallbackForType, libs, "g_app_info_get_fallback_for_type")
-	core.PuregoSafeRegister(&xAppInfoGetRecommendedForType, libs, "g_app_info_get_recommended_for_type")
-	core.PuregoSafeRegister(&xAppInfoLaunchDefaultForUri, libs, "g_app_info_launch_default_for_uri")
-	core.PuregoSafeRegister(&xAppInfoLaunchDefaultForUriAsync, libs, "g_app_info_launch_default_for_uri_async")
-	core.PuregoSafeRegister(&xAppInfoLaunchDefaultForUriFinish, libs, "g_app_info_launch_default_for_uri_finish")
-	core.PuregoSafeRegister(&xAppInfoResetTypeAssociations, libs, "g_app_info_reset_type_associations")
+	core.PuregoRegisterSince(&xAppInfoCreateFromCommandline, libs, "g_app_info_create_from_commandline", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoGetAll, libs, "g_app_info_get_all", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoGetAllForType, libs, "g_app_info_get_all_for_type", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForType, libs, "g_app_info_get_default_for_type", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForTypeAsync, libs, "g_app_info_get_default_for_type_async", "GIO", "2.74")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForTypeFinish, libs, "g_app_info_get_default_for_type_finish", "GIO", "2.74")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForUriScheme, libs, "g_app_info_get_default_for_uri_scheme", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForUriSchemeAsync, libs, "g_app_info_get_default_for_uri_scheme_async", "GIO", "2.74")
+	core.PuregoRegisterSince(&xAppInfoGetDefaultForUriSchemeFinish, libs, "g_app_info_get_default_for_uri_scheme_finish", "GIO", "2.74")
+	core.PuregoRegisterSince(&xAppInfoGetFallbackForType, libs, "g_app_info_get_fallback_for_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&xAppInfoGetRecommendedForType, libs, "g_app_info_get_recommended_for_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&xAppInfoLaunchDefaultForUri, libs, "g_app_info_launch_default_for_uri", "GIO", "")
+	core.PuregoRegisterSince(&xAppInfoLaunchDefaultForUriAsync, libs, "g_app_info_launch_default_for_uri_async", "GIO", "2.50")
+	core.PuregoRegisterSince(&xAppInfoLaunchDefaultForUriFinish, libs, "g_app_info_launch_default_for_uri_finish", "GIO", "2.50")
+	core.PuregoRegisterSince(&xAppInfoResetTypeAssociations, libs, "g_app_info_reset_type_associations", "GIO", "2.20")
 
 	core.PuregoSafeRegister(&xAppInfoMonitorGLibType, libs, "g_app_info_monitor_get_type")
 
-	core.PuregoSafeRegister(&xAppInfoMonitorGet, libs, "g_app_info_monitor_get")
+	core.PuregoRegisterSince(&xAppInfoMonitorGet, libs, "g_app_info_monitor_get", "GIO", "2.40")
 
 	core.PuregoSafeRegister(&xAppLaunchContextGLibType, libs, "g_app_launch_context_get_type")
 
-	core.PuregoSafeRegister(&xNewAppLaunchContext, libs, "g_app_launch_context_new")
+	gobject.CheckClassSize("GAppLaunchContextClass", xAppLaunchContextGLibType, unsafe.Sizeof(AppLaunchContextClass{}))
+
+	core.PuregoRegisterSince(&xNewAppLaunchContext, libs, "g_app_launch_context_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xAppLaunchContextGetDisplay, libs, "g_app_launch_context_get_display")
-	core.PuregoSafeRegister(&xAppLaunchContextGetEnvironment, libs, "g_app_launch_context_get_environment")
-	core.PuregoSafeRegister(&xAppLaunchContextGetStartupNotifyId, libs, "g_app_launch_context_get_startup_notify_id")
-	core.PuregoSafeRegister(&xAppLaunchContextLaunchFailed, libs, "g_app_launch_context_launch_failed")
-	core.PuregoSafeRegister(&xAppLaunchContextSetenv, libs, "g_app_launch_context_setenv")
-	core.PuregoSafeRegister(&xAppLaunchContextUnsetenv, libs, "g_app_launch_context_unsetenv")
+	core.PuregoRegisterSince(&xAppLaunchContextGetDisplay, libs, "g_app_launch_context_get_display", "GIO", "")
+	core.PuregoRegisterSince(&xAppLaunchContextGetEnvironment, libs, "g_app_launch_context_get_environment", "GIO", "2.32")
+	core.PuregoRegisterSince(&xAppLaunchContextGetStartupNotifyId, libs, "g_app_launch_context_get_startup_notify_id", "GIO", "")
+	core.PuregoRegisterSince(&xAppLaunchContextLaunchFailed, libs, "g_app_launch_context_launch_failed", "GIO", "")
+	core.PuregoRegisterSince(&xAppLaunchContextSetenv, libs, "g_app_launch_context_setenv", "GIO", "2.32")
+	core.PuregoRegisterSince(&xAppLaunchContextUnsetenv, libs, "g_app_launch_context_unsetenv", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xAppInfoGLibType, libs, "g_app_info_get_type")
 
-	core.PuregoSafeRegister(&XGAppInfoAddSupportsType, libs, "g_app_info_add_supports_type")
-	core.PuregoSafeRegister(&XGAppInfoCanDelete, libs, "g_app_info_can_delete")
-	core.PuregoSafeRegister(&XGAppInfoCanRemoveSupportsType, libs, "g_app_info_can_remove_supports_type")
-	core.PuregoSafeRegister(&XGAppInfoDelete, libs, "g_app_info_delete")
-	core.PuregoSafeRegister(&XGAppInfoDup, libs, "g_app_info_dup")
-	core.PuregoSafeRegister(&XGAppInfoEqual, libs, "g_app_info_equal")
-	core.PuregoSafeRegister(&XGAppInfoGetCommandline, libs, "g_app_info_get_commandline")
-	core.PuregoSafeRegister(&XGAppInfoGetDescription, libs, "g_app_info_get_description")
-	core.PuregoSafeRegister(&XGAppInfoGetDisplayName, libs, "g_app_info_get_display_name")
-	core.PuregoSafeRegister(&XGAppInfoGetExecutable, libs, "g_app_info_get_executable")
-	core.PuregoSafeRegister(&XGAppInfoGetIcon, libs, "g_app_info_get_icon")
-	core.PuregoSafeRegister(&XGAppInfoGetId, libs, "g_app_info_get_id")
-	core.PuregoSafeRegister(&XGAppInfoGetName, libs, "g_app_info_get_name")
-	core.PuregoSafeRegister(&XGAppInfoGetSupportedTypes, libs, "g_app_info_get_supported_types")
-	core.PuregoSafeRegister(&XGAppInfoLaunch, libs, "g_app_info_launch")
-	core.PuregoSafeRegister(&XGAppInfoLaunchUris, libs, "g_app_info_launch_uris")
-	core.PuregoSafeRegister(&XGAppInfoLaunchUrisAsync, libs, "g_app_info_launch_uris_async")
-	core.PuregoSafeRegister(&XGAppInfoLaunchUrisFinish, libs, "g_app_info_launch_uris_finish")
-	core.PuregoSafeRegister(&XGAppInfoRemoveSupportsType, libs, "g_app_info_remove_supports_type")
-	core.PuregoSafeRegister(&XGAppInfoSetAsDefaultForExtension, libs, "g_app_info_set_as_default_for_extension")
-	core.PuregoSafeRegister(&XGAppInfoSetAsDefaultForType, libs, "g_app_info_set_as_default_for_type")
-	core.PuregoSafeRegister(&XGAppInfoSetAsLastUsedForType, libs, "g_app_info_set_as_last_used_for_type")
-	core.PuregoSafeRegister(&XGAppInfoShouldShow, libs, "g_app_info_should_show")
-	core.PuregoSafeRegister(&XGAppInfoSupportsFiles, libs, "g_app_info_supports_files")
-	core.PuregoSafeRegister(&XGAppInfoSupportsUris, libs, "g_app_info_supports_uris")
+	gobject.CheckClassSize("GAppInfoIface", xAppInfoGLibType, unsafe.Sizeof(AppInfoIface{}))
+
+	core.PuregoRegisterSince(&XGAppInfoAddSupportsType, libs, "g_app_info_add_supports_type", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoCanDelete, libs, "g_app_info_can_delete", "GIO", "2.20")
+	core.PuregoRegisterSince(&XGAppInfoCanRemoveSupportsType, libs, "g_app_info_can_remove_supports_type", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoDelete, libs, "g_app_info_delete", "GIO", "2.20")
+	core.PuregoRegisterSince(&XGAppInfoDup, libs, "g_app_info_dup", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoEqual, libs, "g_app_info_equal", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetCommandline, libs, "g_app_info_get_commandline", "GIO", "2.20")
+	core.PuregoRegisterSince(&XGAppInfoGetDescription, libs, "g_app_info_get_description", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetDisplayName, libs, "g_app_info_get_display_name", "GIO", "2.24")
+	core.PuregoRegisterSince(&XGAppInfoGetExecutable, libs, "g_app_info_get_executable", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetIcon, libs, "g_app_info_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetId, libs, "g_app_info_get_id", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetName, libs, "g_app_info_get_name", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoGetSupportedTypes, libs, "g_app_info_get_supported_types", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGAppInfoLaunch, libs, "g_app_info_launch", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoLaunchUris, libs, "g_app_info_launch_uris", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoLaunchUrisAsync, libs, "g_app_info_launch_uris_async", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGAppInfoLaunchUrisFinish, libs, "g_app_info_launch_uris_finish", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGAppInfoRemoveSupportsType, libs, "g_app_info_remove_supports_type", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoSetAsDefaultForExtension, libs, "g_app_info_set_as_default_for_extension", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoSetAsDefaultForType, libs, "g_app_info_set_as_default_for_type", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoSetAsLastUsedForType, libs, "g_app_info_set_as_last_used_for_type", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoShouldShow, libs, "g_app_info_should_show", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoSupportsFiles, libs, "g_app_info_supports_files", "GIO", "")
+	core.PuregoRegisterSince(&XGAppInfoSupportsUris, libs, "g_app_info_supports_uris", "GIO", "")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Virtual function table for #GApplication.
+// Virtual function table for [Application].
 type ApplicationClass struct {
 	_ structs.HostLayout
 
@@ -61,7 +60,8 @@ func (x *ApplicationClass) OverrideStartup(cb func(*Application)) {
 	if cb == nil {
 		x.xStartup = 0
 	} else {
-		x.xStartup = purego.NewCallback(func(ApplicationVarp uintptr) {
+		x.xStartup = core.NewCallback(func(ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -74,7 +74,7 @@ func (x *ApplicationClass) GetStartup() func(*Application) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStartup)
+	core.RegisterFunc(&rawCallback, x.xStartup)
 	return func(ApplicationVar *Application) {
 		rawCallback(ApplicationVar.GoPointer())
 	}
@@ -86,7 +86,8 @@ func (x *ApplicationClass) OverrideActivate(cb func(*Application)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(ApplicationVarp uintptr) {
+		x.xActivate = core.NewCallback(func(ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -99,7 +100,7 @@ func (x *ApplicationClass) GetActivate() func(*Application) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(ApplicationVar *Application) {
 		rawCallback(ApplicationVar.GoPointer())
 	}
@@ -111,7 +112,8 @@ func (x *ApplicationClass) OverrideOpen(cb func(*Application, uintptr, int, stri
 	if cb == nil {
 		x.xOpen = 0
 	} else {
-		x.xOpen = purego.NewCallback(func(ApplicationVarp uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string) {
+		x.xOpen = core.NewCallback(func(ApplicationVarp uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), FilesVarp, NFilesVarp, HintVarp)
 		})
 	}
@@ -124,7 +126,7 @@ func (x *ApplicationClass) GetOpen() func(*Application, uintptr, int, string) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string)
-	purego.RegisterFunc(&rawCallback, x.xOpen)
+	core.RegisterFunc(&rawCallback, x.xOpen)
 	return func(ApplicationVar *Application, FilesVar uintptr, NFilesVar int, HintVar string) {
 		rawCallback(ApplicationVar.GoPointer(), FilesVar, NFilesVar, HintVar)
 	}
@@ -138,7 +140,8 @@ func (x *ApplicationClass) OverrideCommandLine(cb func(*Application, *Applicatio
 	if cb == nil {
 		x.xCommandLine = 0
 	} else {
-		x.xCommandLine = purego.NewCallback(func(ApplicationVarp uintptr, CommandLineVarp uintptr) int {
+		x.xCommandLine = core.NewCallback(func(ApplicationVarp uintptr, CommandLineVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(ApplicationNewFromInternalPtr(ApplicationVarp), ApplicationCommandLineNewFromInternalPtr(CommandLineVarp))
 		})
 	}
@@ -153,7 +156,7 @@ func (x *ApplicationClass) GetCommandLine() func(*Application, *ApplicationComma
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, CommandLineVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xCommandLine)
+	core.RegisterFunc(&rawCallback, x.xCommandLine)
 	return func(ApplicationVar *Application, CommandLineVar *ApplicationCommandLine) int {
 		return rawCallback(ApplicationVar.GoPointer(), CommandLineVar.GoPointer())
 	}
@@ -163,14 +166,15 @@ func (x *ApplicationClass) GetCommandLine() func(*Application, *ApplicationComma
 // invoked (locally). The virtual function has the chance
 //
 //	to inspect (and possibly replace) command line arguments. See
-//	g_application_run() for more information. Also see the
-//	#GApplication::handle-local-options signal, which is a simpler
+//	[Application.Run] for more information. Also see the
+//	[Application.ConnectHandleLocalOptionsFunc] signal, which is a simpler
 //	alternative to handling some commandline options locally
 func (x *ApplicationClass) OverrideLocalCommandLine(cb func(*Application, []string, *int) bool) {
 	if cb == nil {
 		x.xLocalCommandLine = 0
 	} else {
-		x.xLocalCommandLine = purego.NewCallback(func(ApplicationVarp uintptr, ArgumentsVarp []string, ExitStatusVarp *int) bool {
+		x.xLocalCommandLine = core.NewCallback(func(ApplicationVarp uintptr, ArgumentsVarp []string, ExitStatusVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(ApplicationNewFromInternalPtr(ApplicationVarp), ArgumentsVarp, ExitStatusVarp)
 		})
 	}
@@ -180,15 +184,15 @@ func (x *ApplicationClass) OverrideLocalCommandLine(cb func(*Application, []stri
 // invoked (locally). The virtual function has the chance
 //
 //	to inspect (and possibly replace) command line arguments. See
-//	g_application_run() for more information. Also see the
-//	#GApplication::handle-local-options signal, which is a simpler
+//	[Application.Run] for more information. Also see the
+//	[Application.ConnectHandleLocalOptionsFunc] signal, which is a simpler
 //	alternative to handling some commandline options locally
 func (x *ApplicationClass) GetLocalCommandLine() func(*Application, []string, *int) bool {
 	if x.xLocalCommandLine == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, ArgumentsVarp []string, ExitStatusVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xLocalCommandLine)
+	core.RegisterFunc(&rawCallback, x.xLocalCommandLine)
 	return func(ApplicationVar *Application, ArgumentsVar []string, ExitStatusVar *int) bool {
 		return rawCallback(ApplicationVar.GoPointer(), ArgumentsVar, ExitStatusVar)
 	}
@@ -203,7 +207,8 @@ func (x *ApplicationClass) OverrideBeforeEmit(cb func(*Application, *glib.Varian
 	if cb == nil {
 		x.xBeforeEmit = 0
 	} else {
-		x.xBeforeEmit = purego.NewCallback(func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant) {
+		x.xBeforeEmit = core.NewCallback(func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), PlatformDataVarp)
 		})
 	}
@@ -219,7 +224,7 @@ func (x *ApplicationClass) GetBeforeEmit() func(*Application, *glib.Variant) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xBeforeEmit)
+	core.RegisterFunc(&rawCallback, x.xBeforeEmit)
 	return func(ApplicationVar *Application, PlatformDataVar *glib.Variant) {
 		rawCallback(ApplicationVar.GoPointer(), PlatformDataVar)
 	}
@@ -234,7 +239,8 @@ func (x *ApplicationClass) OverrideAfterEmit(cb func(*Application, *glib.Variant
 	if cb == nil {
 		x.xAfterEmit = 0
 	} else {
-		x.xAfterEmit = purego.NewCallback(func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant) {
+		x.xAfterEmit = core.NewCallback(func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), PlatformDataVarp)
 		})
 	}
@@ -250,7 +256,7 @@ func (x *ApplicationClass) GetAfterEmit() func(*Application, *glib.Variant) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xAfterEmit)
+	core.RegisterFunc(&rawCallback, x.xAfterEmit)
 	return func(ApplicationVar *Application, PlatformDataVar *glib.Variant) {
 		rawCallback(ApplicationVar.GoPointer(), PlatformDataVar)
 	}
@@ -264,7 +270,8 @@ func (x *ApplicationClass) OverrideAddPlatformData(cb func(*Application, *glib.V
 	if cb == nil {
 		x.xAddPlatformData = 0
 	} else {
-		x.xAddPlatformData = purego.NewCallback(func(ApplicationVarp uintptr, BuilderVarp *glib.VariantBuilder) {
+		x.xAddPlatformData = core.NewCallback(func(ApplicationVarp uintptr, BuilderVarp *glib.VariantBuilder) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), BuilderVarp)
 		})
 	}
@@ -279,7 +286,7 @@ func (x *ApplicationClass) GetAddPlatformData() func(*Application, *glib.Variant
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, BuilderVarp *glib.VariantBuilder)
-	purego.RegisterFunc(&rawCallback, x.xAddPlatformData)
+	core.RegisterFunc(&rawCallback, x.xAddPlatformData)
 	return func(ApplicationVar *Application, BuilderVar *glib.VariantBuilder) {
 		rawCallback(ApplicationVar.GoPointer(), BuilderVar)
 	}
@@ -294,7 +301,8 @@ func (x *ApplicationClass) OverrideQuitMainloop(cb func(*Application)) {
 	if cb == nil {
 		x.xQuitMainloop = 0
 	} else {
-		x.xQuitMainloop = purego.NewCallback(func(ApplicationVarp uintptr) {
+		x.xQuitMainloop = core.NewCallback(func(ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -310,7 +318,7 @@ func (x *ApplicationClass) GetQuitMainloop() func(*Application) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQuitMainloop)
+	core.RegisterFunc(&rawCallback, x.xQuitMainloop)
 	return func(ApplicationVar *Application) {
 		rawCallback(ApplicationVar.GoPointer())
 	}
@@ -319,14 +327,15 @@ func (x *ApplicationClass) GetQuitMainloop() func(*Application) {
 // OverrideRunMainloop sets the "run_mainloop" callback function.
 // Used to be invoked on the primary instance from
 //
-//	g_application_run() if the use-count is non-zero. Since 2.32,
+//	[Application.Run] if the use-count is non-zero. Since 2.32,
 //	GApplication is iterating the main context directly and is not
-//	using @run_mainloop anymore
+//	using run_mainloop anymore
 func (x *ApplicationClass) OverrideRunMainloop(cb func(*Application)) {
 	if cb == nil {
 		x.xRunMainloop = 0
 	} else {
-		x.xRunMainloop = purego.NewCallback(func(ApplicationVarp uintptr) {
+		x.xRunMainloop = core.NewCallback(func(ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -335,15 +344,15 @@ func (x *ApplicationClass) OverrideRunMainloop(cb func(*Application)) {
 // GetRunMainloop gets the "run_mainloop" callback function.
 // Used to be invoked on the primary instance from
 //
-//	g_application_run() if the use-count is non-zero. Since 2.32,
+//	[Application.Run] if the use-count is non-zero. Since 2.32,
 //	GApplication is iterating the main context directly and is not
-//	using @run_mainloop anymore
+//	using run_mainloop anymore
 func (x *ApplicationClass) GetRunMainloop() func(*Application) {
 	if x.xRunMainloop == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRunMainloop)
+	core.RegisterFunc(&rawCallback, x.xRunMainloop)
 	return func(ApplicationVar *Application) {
 		rawCallback(ApplicationVar.GoPointer())
 	}
@@ -357,7 +366,8 @@ func (x *ApplicationClass) OverrideShutdown(cb func(*Application)) {
 	if cb == nil {
 		x.xShutdown = 0
 	} else {
-		x.xShutdown = purego.NewCallback(func(ApplicationVarp uintptr) {
+		x.xShutdown = core.NewCallback(func(ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -372,7 +382,7 @@ func (x *ApplicationClass) GetShutdown() func(*Application) {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShutdown)
+	core.RegisterFunc(&rawCallback, x.xShutdown)
 	return func(ApplicationVar *Application) {
 		rawCallback(ApplicationVar.GoPointer())
 	}
@@ -383,15 +393,16 @@ func (x *ApplicationClass) GetShutdown() func(*Application) {
 //
 //	using its D-Bus backend. You can use this to export extra objects on the
 //	bus, that need to exist before the application tries to own the bus name.
-//	The function is passed the #GDBusConnection to to session bus, and the
-//	object path that #GApplication will use to export its D-Bus API.
-//	If this function returns %TRUE, registration will proceed; otherwise
+//	The function is passed the [DBusConnection] to to session bus, and the
+//	object path that [Application] will use to export its D-Bus API.
+//	If this function returns true, registration will proceed; otherwise
 //	registration will abort. Since: 2.34
 func (x *ApplicationClass) OverrideDbusRegister(cb func(*Application, *DBusConnection, string) bool) {
 	if cb == nil {
 		x.xDbusRegister = 0
 	} else {
-		x.xDbusRegister = purego.NewCallback(func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string) bool {
+		x.xDbusRegister = core.NewCallback(func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(ApplicationNewFromInternalPtr(ApplicationVarp), DBusConnectionNewFromInternalPtr(ConnectionVarp), ObjectPathVarp)
 		})
 	}
@@ -402,16 +413,16 @@ func (x *ApplicationClass) OverrideDbusRegister(cb func(*Application, *DBusConne
 //
 //	using its D-Bus backend. You can use this to export extra objects on the
 //	bus, that need to exist before the application tries to own the bus name.
-//	The function is passed the #GDBusConnection to to session bus, and the
-//	object path that #GApplication will use to export its D-Bus API.
-//	If this function returns %TRUE, registration will proceed; otherwise
+//	The function is passed the [DBusConnection] to to session bus, and the
+//	object path that [Application] will use to export its D-Bus API.
+//	If this function returns true, registration will proceed; otherwise
 //	registration will abort. Since: 2.34
 func (x *ApplicationClass) GetDbusRegister() func(*Application, *DBusConnection, string) bool {
 	if x.xDbusRegister == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xDbusRegister)
+	core.RegisterFunc(&rawCallback, x.xDbusRegister)
 	return func(ApplicationVar *Application, ConnectionVar *DBusConnection, ObjectPathVar string) bool {
 		return rawCallback(ApplicationVar.GoPointer(), ConnectionVar.GoPointer(), ObjectPathVar)
 	}
@@ -421,12 +432,13 @@ func (x *ApplicationClass) GetDbusRegister() func(*Application, *DBusConnection,
 // invoked locally during unregistration, if the application
 //
 //	is using its D-Bus backend. Use this to undo anything done by
-//	the @dbus_register vfunc. Since: 2.34
+//	the dbus_register vfunc. Since: 2.34
 func (x *ApplicationClass) OverrideDbusUnregister(cb func(*Application, *DBusConnection, string)) {
 	if cb == nil {
 		x.xDbusUnregister = 0
 	} else {
-		x.xDbusUnregister = purego.NewCallback(func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string) {
+		x.xDbusUnregister = core.NewCallback(func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), DBusConnectionNewFromInternalPtr(ConnectionVarp), ObjectPathVarp)
 		})
 	}
@@ -436,13 +448,13 @@ func (x *ApplicationClass) OverrideDbusUnregister(cb func(*Application, *DBusCon
 // invoked locally during unregistration, if the application
 //
 //	is using its D-Bus backend. Use this to undo anything done by
-//	the @dbus_register vfunc. Since: 2.34
+//	the dbus_register vfunc. Since: 2.34
 func (x *ApplicationClass) GetDbusUnregister() func(*Application, *DBusConnection, string) {
 	if x.xDbusUnregister == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, ConnectionVarp uintptr, ObjectPathVarp string)
-	purego.RegisterFunc(&rawCallback, x.xDbusUnregister)
+	core.RegisterFunc(&rawCallback, x.xDbusUnregister)
 	return func(ApplicationVar *Application, ConnectionVar *DBusConnection, ObjectPathVar string) {
 		rawCallback(ApplicationVar.GoPointer(), ConnectionVar.GoPointer(), ObjectPathVar)
 	}
@@ -456,7 +468,8 @@ func (x *ApplicationClass) OverrideHandleLocalOptions(cb func(*Application, *gli
 	if cb == nil {
 		x.xHandleLocalOptions = 0
 	} else {
-		x.xHandleLocalOptions = purego.NewCallback(func(ApplicationVarp uintptr, OptionsVarp *glib.VariantDict) int {
+		x.xHandleLocalOptions = core.NewCallback(func(ApplicationVarp uintptr, OptionsVarp *glib.VariantDict) int {
+			defer core.RecoverPanic()
 			return cb(ApplicationNewFromInternalPtr(ApplicationVarp), OptionsVarp)
 		})
 	}
@@ -471,7 +484,7 @@ func (x *ApplicationClass) GetHandleLocalOptions() func(*Application, *glib.Vari
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, OptionsVarp *glib.VariantDict) int
-	purego.RegisterFunc(&rawCallback, x.xHandleLocalOptions)
+	core.RegisterFunc(&rawCallback, x.xHandleLocalOptions)
 	return func(ApplicationVar *Application, OptionsVar *glib.VariantDict) int {
 		return rawCallback(ApplicationVar.GoPointer(), OptionsVar)
 	}
@@ -483,7 +496,8 @@ func (x *ApplicationClass) OverrideNameLost(cb func(*Application) bool) {
 	if cb == nil {
 		x.xNameLost = 0
 	} else {
-		x.xNameLost = purego.NewCallback(func(ApplicationVarp uintptr) bool {
+		x.xNameLost = core.NewCallback(func(ApplicationVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(ApplicationNewFromInternalPtr(ApplicationVarp))
 		})
 	}
@@ -496,7 +510,7 @@ func (x *ApplicationClass) GetNameLost() func(*Application) bool {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xNameLost)
+	core.RegisterFunc(&rawCallback, x.xNameLost)
 	return func(ApplicationVar *Application) bool {
 		return rawCallback(ApplicationVar.GoPointer())
 	}
@@ -520,8 +534,8 @@ func (x *ApplicationPrivate) GoPointer() uintptr {
 //
 // `GApplication` provides convenient life-cycle management by maintaining
 // a "use count" for the primary application instance. The use count can
-// be changed using [method@Gio.Application.hold] and
-// [method@Gio.Application.release]. If it drops to zero, the application
+// be changed using [Application.Hold] and
+// [Application.Release]. If it drops to zero, the application
 // exits. Higher-level classes such as `GtkApplication` employ the use count
 // to ensure that the application stays alive as long as it has any opened
 // windows.
@@ -544,19 +558,19 @@ func (x *ApplicationPrivate) GoPointer() uintptr {
 // if it is the primary instance. Instead, the main() function of a
 // `GApplication` should do very little more than instantiating the
 // application instance, possibly connecting signal handlers, then
-// calling [method@Gio.Application.run]. All checks for uniqueness are done
+// calling [Application.Run]. All checks for uniqueness are done
 // internally. If the application is the primary instance then the
 // startup signal is emitted and the mainloop runs. If the application
 // is not the primary instance then a signal is sent to the primary
-// instance and [method@Gio.Application.run] promptly returns. See the code
+// instance and [Application.Run] promptly returns. See the code
 // examples below.
 //
 // If used, the expected form of an application identifier is the
 // same as that of a
-// [D-Bus well-known bus name](https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-names-bus).
+// [D-Bus well-known bus name].
 // Examples include: `com.example.MyApp`, `org.example.internal_apps.Calculator`,
 // `org._7_zip.Archiver`.
-// For details on valid application identifiers, see [func@Gio.Application.id_is_valid].
+// For details on valid application identifiers, see [ApplicationIdIsValid].
 //
 // On Linux, the application identifier is claimed as a well-known bus name
 // on the user's session bus. This means that the uniqueness of your
@@ -566,20 +580,20 @@ func (x *ApplicationPrivate) GoPointer() uintptr {
 // should be done with the shared GDBus session bus. Note that due to the
 // internal architecture of GDBus, method calls can be dispatched at any time
 // (even if a main loop is not running). For this reason, you must ensure that
-// any object paths that you wish to register are registered before #GApplication
+// any object paths that you wish to register are registered before [Application]
 // attempts to acquire the bus name of your application (which happens in
-// [method@Gio.Application.register]). Unfortunately, this means that you cannot
-// use [property@Gio.Application:is-remote] to decide if you want to register
+// [Application.Register]). Unfortunately, this means that you cannot
+// use [Application.GetPropertyIsRemote] to decide if you want to register
 // object paths.
 //
-// `GApplication` also implements the [iface@Gio.ActionGroup] and [iface@Gio.ActionMap]
+// `GApplication` also implements the [ActionGroup] and [ActionMap]
 // interfaces and lets you easily export actions by adding them with
-// [method@Gio.ActionMap.add_action]. When invoking an action by calling
-// [method@Gio.ActionGroup.activate_action] on the application, it is always
+// [ActionMap.AddAction]. When invoking an action by calling
+// [ActionGroup.ActivateAction] on the application, it is always
 // invoked in the primary instance. The actions are also exported on
-// the session bus, and GIO provides the [class@Gio.DBusActionGroup] wrapper to
-// conveniently access them remotely. GIO provides a [class@Gio.DBusMenuModel] wrapper
-// for remote access to exported [class@Gio.MenuModel]s.
+// the session bus, and GIO provides the [DBusActionGroup] wrapper to
+// conveniently access them remotely. GIO provides a [DBusMenuModel] wrapper
+// for remote access to exported [MenuModel]s.
 //
 // Note: Due to the fact that actions are exported on the session bus,
 // using `maybe` parameters is not supported, since D-Bus does not support
@@ -595,20 +609,20 @@ func (x *ApplicationPrivate) GoPointer() uintptr {
 //
 // - via activating an action
 //
-// The [signal@Gio.Application::startup] signal lets you handle the application
+// The [Application.ConnectStartupFunc] signal lets you handle the application
 // initialization for all of these in a single place.
 //
 // Regardless of which of these entry points is used to start the
 // application, `GApplication` passes some ‘platform data’ from the
 // launching instance to the primary instance, in the form of a
-// [struct@GLib.Variant] dictionary mapping strings to variants. To use platform
-// data, override the [vfunc@Gio.Application.before_emit] or
-// [vfunc@Gio.Application.after_emit] virtual functions
+// [glib.Variant] dictionary mapping strings to variants. To use platform
+// data, override the Application.before_emit or
+// Application.after_emit virtual functions
 // in your `GApplication` subclass. When dealing with
-// [class@Gio.ApplicationCommandLine] objects, the platform data is
-// directly available via [method@Gio.ApplicationCommandLine.get_cwd],
-// [method@Gio.ApplicationCommandLine.get_environ] and
-// [method@Gio.ApplicationCommandLine.get_platform_data].
+// [ApplicationCommandLine] objects, the platform data is
+// directly available via [ApplicationCommandLine.GetCwd],
+// [ApplicationCommandLine.GetEnviron] and
+// [ApplicationCommandLine.GetPlatformData].
 //
 // As the name indicates, the platform data may vary depending on the
 // operating system, but it always includes the current directory (key
@@ -617,22 +631,27 @@ func (x *ApplicationPrivate) GoPointer() uintptr {
 // The environment is only added to the platform data if the
 // `G_APPLICATION_SEND_ENVIRONMENT` flag is set. `GApplication` subclasses
 // can add their own platform data by overriding the
-// [vfunc@Gio.Application.add_platform_data] virtual function. For instance,
+// Application.add_platform_data virtual function. For instance,
 // `GtkApplication` adds startup notification data in this way.
 //
 // To parse commandline arguments you may handle the
-// [signal@Gio.Application::command-line] signal or override the
-// [vfunc@Gio.Application.local_command_line] virtual function, to parse them in
+// [Application.ConnectCommandLineFunc] signal or override the
+// Application.local_command_line virtual function, to parse them in
 // either the primary instance or the local instance, respectively.
 //
 // For an example of opening files with a `GApplication`, see
-// [gapplication-example-open.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-open.c).
+// [gapplication-example-open.c].
 //
 // For an example of using actions with `GApplication`, see
-// [gapplication-example-actions.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-actions.c).
+// [gapplication-example-actions.c].
 //
 // For an example of using extra D-Bus hooks with `GApplication`, see
-// [gapplication-example-dbushooks.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-dbushooks.c).
+// [gapplication-example-dbushooks.c].
+//
+// [D-Bus well-known bus name]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-names-bus
+// [gapplication-example-open.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-open.c
+// [gapplication-example-actions.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-actions.c
+// [gapplication-example-dbushooks.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-dbushooks.c
 type Application struct {
 	gobject.Object
 }
@@ -651,12 +670,12 @@ func ApplicationNewFromInternalPtr(ptr uintptr) *Application {
 
 var xNewApplication func(uintptr, ApplicationFlags) uintptr
 
-// Creates a new #GApplication instance.
+// Creates a new [Application] instance.
 //
-// If non-%NULL, the application id must be valid.  See
-// g_application_id_is_valid().
+// If non-nil, the application id must be valid.  See
+// [ApplicationIdIsValid].
 //
-// If no application ID is given then some features of #GApplication
+// If no application ID is given then some features of [Application]
 // (most notably application uniqueness) will be disabled.
 func NewApplication(ApplicationIdVar *string, FlagsVar ApplicationFlags) *Application {
 	var cls *Application
@@ -678,7 +697,7 @@ var xApplicationActivate func(uintptr)
 
 // Activates the application.
 //
-// In essence, this results in the #GApplication::activate signal being
+// In essence, this results in the [Application.ConnectActivateFunc] signal being
 // emitted in the primary instance.
 //
 // The application must be registered before calling this function.
@@ -690,19 +709,19 @@ func (x *Application) Activate() {
 
 var xApplicationAddMainOption func(uintptr, string, byte, glib.OptionFlags, glib.OptionArg, string, uintptr)
 
-// Add an option to be handled by @application.
+// Add an option to be handled by application.
 //
 // Calling this function is the equivalent of calling
-// g_application_add_main_option_entries() with a single #GOptionEntry
-// that has its arg_data member set to %NULL.
+// [Application.AddMainOptionEntries] with a single [glib.OptionEntry]
+// that has its arg_data member set to nil.
 //
-// The parsed arguments will be packed into a #GVariantDict which
-// is passed to #GApplication::handle-local-options. If
-// %G_APPLICATION_HANDLES_COMMAND_LINE is set, then it will also
+// The parsed arguments will be packed into a [glib.VariantDict] which
+// is passed to [Application.ConnectHandleLocalOptionsFunc]. If
+// [GApplicationHandlesCommandLineValue] is set, then it will also
 // be sent to the primary instance. See
-// g_application_add_main_option_entries() for more details.
+// [Application.AddMainOptionEntries] for more details.
 //
-// See #GOptionEntry for more documentation of the arguments.
+// See [glib.OptionEntry] for more documentation of the arguments.
 func (x *Application) AddMainOption(LongNameVar string, ShortNameVar byte, FlagsVar glib.OptionFlags, ArgVar glib.OptionArg, DescriptionVar string, ArgDescriptionVar *string) {
 
 	ArgDescriptionVarPtr := core.GStrdupNullable(ArgDescriptionVar)
@@ -714,87 +733,87 @@ func (x *Application) AddMainOption(LongNameVar string, ShortNameVar byte, Flags
 
 var xApplicationAddMainOptionEntries func(uintptr, []glib.OptionEntry)
 
-// Adds main option entries to be handled by @application.
+// Adds main option entries to be handled by application.
 //
-// This function is comparable to g_option_context_add_main_entries().
+// This function is comparable to [glib.OptionContext.AddMainEntries].
 //
 // After the commandline arguments are parsed, the
-// #GApplication::handle-local-options signal will be emitted.  At this
-// point, the application can inspect the values pointed to by @arg_data
-// in the given #GOptionEntrys.
-//
-// Unlike #GOptionContext, #GApplication supports giving a %NULL
-// @arg_data for a non-callback #GOptionEntry.  This results in the
-// argument in question being packed into a #GVariantDict which is also
-// passed to #GApplication::handle-local-options, where it can be
-// inspected and modified.  If %G_APPLICATION_HANDLES_COMMAND_LINE is
+// [Application.ConnectHandleLocalOptionsFunc] signal will be emitted.  At this
+// point, the application can inspect the values pointed to by arg_data
+// in the given GOptionEntrys.
+//
+// Unlike [glib.OptionContext], [Application] supports giving a nil
+// arg_data for a non-callback [glib.OptionEntry].  This results in the
+// argument in question being packed into a [glib.VariantDict] which is also
+// passed to [Application.ConnectHandleLocalOptionsFunc], where it can be
+// inspected and modified.  If [GApplicationHandlesCommandLineValue] is
 // set, then the resulting dictionary is sent to the primary instance,
-// where g_application_command_line_get_options_dict() will return it.
+// where [ApplicationCommandLine.GetOptionsDict] will return it.
 // As it has been passed outside the process at this point, the types of all
 // values in the options dict must be checked before being used.
 // This "packing" is done according to the type of the argument --
 // booleans for normal flags, strings for strings, bytestrings for
 // filenames, etc.  The packing only occurs if the flag is given (ie: we
-// do not pack a "false" #GVariant in the case that a flag is missing).
+// do not pack a "false" [glib.Variant] in the case that a flag is missing).
 //
 // In general, it is recommended that all commandline arguments are
 // parsed locally.  The options dictionary should then be used to
 // transmit the result of the parsing to the primary instance, where
-// g_variant_dict_lookup() can be used.  For local options, it is
-// possible to either use @arg_data in the usual way, or to consult (and
+// [glib.VariantDict.Lookup] can be used.  For local options, it is
+// possible to either use arg_data in the usual way, or to consult (and
 // potentially remove) the option from the options dictionary.
 //
 // This function is new in GLib 2.40.  Before then, the only real choice
 // was to send all of the commandline arguments (options and all) to the
-// primary instance for handling.  #GApplication ignored them completely
+// primary instance for handling.  [Application] ignored them completely
 // on the local side.  Calling this function "opts in" to the new
 // behaviour, and in particular, means that unrecognized options will be
 // treated as errors.  Unrecognized options have never been ignored when
-// %G_APPLICATION_HANDLES_COMMAND_LINE is unset.
+// [GApplicationHandlesCommandLineValue] is unset.
 //
-// If #GApplication::handle-local-options needs to see the list of
-// filenames, then the use of %G_OPTION_REMAINING is recommended.  If
-// @arg_data is %NULL then %G_OPTION_REMAINING can be used as a key into
-// the options dictionary.  If you do use %G_OPTION_REMAINING then you
+// If [Application.ConnectHandleLocalOptionsFunc] needs to see the list of
+// filenames, then the use of [glib.OPTION_REMAINING] is recommended.  If
+// arg_data is nil then [glib.OPTION_REMAINING] can be used as a key into
+// the options dictionary.  If you do use [glib.OPTION_REMAINING] then you
 // need to handle these arguments for yourself because once they are
 // consumed, they will no longer be visible to the default handling
 // (which treats them as filenames to be opened).
 //
 // It is important to use the proper GVariant format when retrieving
-// the options with g_variant_dict_lookup():
-// - for %G_OPTION_ARG_NONE, use `b`
-// - for %G_OPTION_ARG_STRING, use `&amp;s`
-// - for %G_OPTION_ARG_INT, use `i`
-// - for %G_OPTION_ARG_INT64, use `x`
-// - for %G_OPTION_ARG_DOUBLE, use `d`
-// - for %G_OPTION_ARG_FILENAME, use `^&amp;ay`
-// - for %G_OPTION_ARG_STRING_ARRAY, use `^a&amp;s`
-// - for %G_OPTION_ARG_FILENAME_ARRAY, use `^a&amp;ay`
+// the options with [glib.VariantDict.Lookup]:
+// - for [glib.GOptionArgNoneValue], use `b`
+// - for [glib.GOptionArgStringValue], use `&s`
+// - for [glib.GOptionArgIntValue], use `i`
+// - for [glib.GOptionArgInt64Value], use `x`
+// - for [glib.GOptionArgDoubleValue], use `d`
+// - for [glib.GOptionArgFilenameValue], use `^&ay`
+// - for [glib.GOptionArgStringArrayValue], use `^a&s`
+// - for [glib.GOptionArgFilenameArrayValue], use `^a&ay`
 func (x *Application) AddMainOptionEntries(EntriesVar []glib.OptionEntry) {
 
 	xApplicationAddMainOptionEntries(x.GoPointer(), EntriesVar)
 
 }
 
-var xApplicationAddOptionGroup func(uintptr, *glib.OptionGroup)
+var xApplicationAddOptionGroup func(uintptr, uintptr)
 
-// Adds a #GOptionGroup to the commandline handling of @application.
+// Adds a [glib.OptionGroup] to the commandline handling of application.
 //
-// This function is comparable to g_option_context_add_group().
+// This function is comparable to [glib.OptionContext.AddGroup].
 //
-// Unlike g_application_add_main_option_entries(), this function does
-// not deal with %NULL @arg_data and never transmits options to the
+// Unlike [Application.AddMainOptionEntries], this function does
+// not deal with nil arg_data and never transmits options to the
 // primary instance.
 //
 // The reason for that is because, by the time the options arrive at the
 // primary instance, it is typically too late to do anything with them.
 // Taking the GTK option group as an example: GTK will already have been
-// initialised by the time the #GApplication::command-line handler runs.
+// initialised by the time the [Application.ConnectCommandLineFunc] handler runs.
 // In the case that this is not the first-running instance of the
 // application, the existing instance may already have been running for
 // a very long time.
 //
-// This means that the options from #GOptionGroup are only really usable
+// This means that the options from [glib.OptionGroup] are only really usable
 // in the case that the instance of the application being run is the
 // first instance.  Passing options like `--display=` or `--gdk-debug=`
 // on future runs will have no effect on the existing primary instance.
@@ -802,20 +821,20 @@ var xApplicationAddOptionGroup func(uintptr, *glib.OptionGroup)
 // Calling this function will cause the options in the supplied option
 // group to be parsed, but it does not cause you to be "opted in" to the
 // new functionality whereby unrecognized options are rejected even if
-// %G_APPLICATION_HANDLES_COMMAND_LINE was given.
+// [GApplicationHandlesCommandLineValue] was given.
 func (x *Application) AddOptionGroup(GroupVar *glib.OptionGroup) {
 
-	xApplicationAddOptionGroup(x.GoPointer(), GroupVar)
+	xApplicationAddOptionGroup(x.GoPointer(), gobject.BoxedCopy(glib.OptionGroupGLibType(), GroupVar.GoPointer()))
 
 }
 
 var xApplicationBindBusyProperty func(uintptr, uintptr, string)
 
-// Marks @application as busy (see g_application_mark_busy()) while
-// @property on @object is %TRUE.
+// Marks application as busy (see [Application.MarkBusy]) while
+// property on object is true.
 //
-// The binding holds a reference to @application while it is active, but
-// not to @object. Instead, the binding is destroyed when @object is
+// The binding holds a reference to application while it is active, but
+// not to object. Instead, the binding is destroyed when object is
 // finalized.
 func (x *Application) BindBusyProperty(ObjectVar *gobject.Object, PropertyVar string) {
 
@@ -825,7 +844,7 @@ func (x *Application) BindBusyProperty(ObjectVar *gobject.Object, PropertyVar st
 
 var xApplicationGetApplicationId func(uintptr) string
 
-// Gets the unique identifier for @application.
+// Gets the unique identifier for application.
 func (x *Application) GetApplicationId() string {
 
 	cret := xApplicationGetApplicationId(x.GoPointer())
@@ -834,19 +853,19 @@ func (x *Application) GetApplicationId() string {
 
 var xApplicationGetDbusConnection func(uintptr) uintptr
 
-// Gets the #GDBusConnection being used by the application, or %NULL.
+// Gets the [DBusConnection] being used by the application, or nil.
 //
-// If #GApplication is using its D-Bus backend then this function will
-// return the #GDBusConnection being used for uniqueness and
+// If [Application] is using its D-Bus backend then this function will
+// return the [DBusConnection] being used for uniqueness and
 // communication with the desktop environment and other instances of the
 // application.
 //
-// If #GApplication is not using D-Bus then this function will return
-// %NULL.  This includes the situation where the D-Bus backend would
+// If [Application] is not using D-Bus then this function will return
+// nil.  This includes the situation where the D-Bus backend would
 // normally be in use but we were unable to connect to the bus.
 //
 // This function must not be called before the application has been
-// registered.  See g_application_get_is_registered().
+// registered.  See [Application.GetIsRegistered].
 func (x *Application) GetDbusConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -863,20 +882,20 @@ func (x *Application) GetDbusConnection() *DBusConnection {
 
 var xApplicationGetDbusObjectPath func(uintptr) string
 
-// Gets the D-Bus object path being used by the application, or %NULL.
+// Gets the D-Bus object path being used by the application, or nil.
 //
-// If #GApplication is using its D-Bus backend then this function will
-// return the D-Bus object path that #GApplication is using.  If the
+// If [Application] is using its D-Bus backend then this function will
+// return the D-Bus object path that [Application] is using.  If the
 // application is the primary instance then there is an object published
 // at this path.  If the application is not the primary instance then
 // the result of this function is undefined.
 //
-// If #GApplication is not using D-Bus then this function will return
-// %NULL.  This includes the situation where the D-Bus backend would
+// If [Application] is not using D-Bus then this function will return
+// nil.  This includes the situation where the D-Bus backend would
 // normally be in use but we were unable to connect to the bus.
 //
 // This function must not be called before the application has been
-// registered.  See g_application_get_is_registered().
+// registered.  See [Application.GetIsRegistered].
 func (x *Application) GetDbusObjectPath() string {
 
 	cret := xApplicationGetDbusObjectPath(x.GoPointer())
@@ -885,9 +904,9 @@ func (x *Application) GetDbusObjectPath() string {
 
 var xApplicationGetFlags func(uintptr) ApplicationFlags
 
-// Gets the flags for @application.
+// Gets the flags for application.
 //
-// See #GApplicationFlags.
+// See [ApplicationFlags].
 func (x *Application) GetFlags() ApplicationFlags {
 
 	cret := xApplicationGetFlags(x.GoPointer())
@@ -899,7 +918,7 @@ var xApplicationGetInactivityTimeout func(uintptr) uint
 // Gets the current inactivity timeout for the application.
 //
 // This is the amount of time (in milliseconds) after the last call to
-// g_application_release() before the application stops running.
+// [Application.Release] before the application stops running.
 func (x *Application) GetInactivityTimeout() uint {
 
 	cret := xApplicationGetInactivityTimeout(x.GoPointer())
@@ -909,7 +928,7 @@ func (x *Application) GetInactivityTimeout() uint {
 var xApplicationGetIsBusy func(uintptr) bool
 
 // Gets the application's current busy state, as set through
-// g_application_mark_busy() or g_application_bind_busy_property().
+// [Application.MarkBusy] or [Application.BindBusyProperty].
 func (x *Application) GetIsBusy() bool {
 
 	cret := xApplicationGetIsBusy(x.GoPointer())
@@ -918,9 +937,9 @@ func (x *Application) GetIsBusy() bool {
 
 var xApplicationGetIsRegistered func(uintptr) bool
 
-// Checks if @application is registered.
+// Checks if application is registered.
 //
-// An application is registered if g_application_register() has been
+// An application is registered if [Application.Register] has been
 // successfully called.
 func (x *Application) GetIsRegistered() bool {
 
@@ -930,16 +949,16 @@ func (x *Application) GetIsRegistered() bool {
 
 var xApplicationGetIsRemote func(uintptr) bool
 
-// Checks if @application is remote.
+// Checks if application is remote.
 //
-// If @application is remote then it means that another instance of
+// If application is remote then it means that another instance of
 // application already exists (the 'primary' instance).  Calls to
-// perform actions on @application will result in the actions being
+// perform actions on application will result in the actions being
 // performed by the primary instance.
 //
 // The value of this property cannot be accessed before
-// g_application_register() has been called.  See
-// g_application_get_is_registered().
+// [Application.Register] has been called.  See
+// [Application.GetIsRegistered].
 func (x *Application) GetIsRemote() bool {
 
 	cret := xApplicationGetIsRemote(x.GoPointer())
@@ -948,9 +967,9 @@ func (x *Application) GetIsRemote() bool {
 
 var xApplicationGetResourceBasePath func(uintptr) string
 
-// Gets the resource base path of @application.
+// Gets the resource base path of application.
 //
-// See g_application_set_resource_base_path() for more information.
+// See [Application.SetResourceBasePath] for more information.
 func (x *Application) GetResourceBasePath() string {
 
 	cret := xApplicationGetResourceBasePath(x.GoPointer())
@@ -959,7 +978,7 @@ func (x *Application) GetResourceBasePath() string {
 
 var xApplicationGetVersion func(uintptr) string
 
-// Gets the version of @application.
+// Gets the version of application.
 func (x *Application) GetVersion() string {
 
 	cret := xApplicationGetVersion(x.GoPointer())
@@ -968,13 +987,13 @@ func (x *Application) GetVersion() string {
 
 var xApplicationHold func(uintptr)
 
-// Increases the use count of @application.
+// Increases the use count of application.
 //
 // Use this function to indicate that the application has a reason to
-// continue to run.  For example, g_application_hold() is called by GTK
+// continue to run.  For example, [Application.Hold] is called by GTK
 // when a toplevel window is on the screen.
 //
-// To cancel the hold, call g_application_release().
+// To cancel the hold, call [Application.Release].
 func (x *Application) Hold() {
 
 	xApplicationHold(x.GoPointer())
@@ -983,7 +1002,7 @@ func (x *Application) Hold() {
 
 var xApplicationMarkBusy func(uintptr)
 
-// Increases the busy count of @application.
+// Increases the busy count of application.
 //
 // Use this function to indicate that the application is busy, for instance
 // while a long running operation is pending.
@@ -992,7 +1011,7 @@ var xApplicationMarkBusy func(uintptr)
 // use that information to indicate the state to the user (e.g. with a
 // spinner).
 //
-// To cancel the busy indication, use g_application_unmark_busy().
+// To cancel the busy indication, use [Application.UnmarkBusy].
 //
 // The application must be registered before calling this function.
 func (x *Application) MarkBusy() {
@@ -1005,18 +1024,18 @@ var xApplicationOpen func(uintptr, uintptr, int, string)
 
 // Opens the given files.
 //
-// In essence, this results in the #GApplication::open signal being emitted
+// In essence, this results in the [Application.ConnectOpenFunc] signal being emitted
 // in the primary instance.
 //
-// @n_files must be greater than zero.
+// n_files must be greater than zero.
 //
-// @hint is simply passed through to the ::open signal.  It is
+// hint is simply passed through to the ::open signal.  It is
 // intended to be used by applications that have multiple modes for
 // opening files (eg: "view" vs "edit", etc).  Unless you have a need
 // for this functionality, you should use "".
 //
 // The application must be registered before calling this function
-// and it must have the %G_APPLICATION_HANDLES_OPEN flag set.
+// and it must have the [GApplicationHandlesOpenValue] flag set.
 func (x *Application) Open(FilesVar uintptr, NFilesVar int, HintVar string) {
 
 	xApplicationOpen(x.GoPointer(), FilesVar, NFilesVar, HintVar)
@@ -1027,16 +1046,16 @@ var xApplicationQuit func(uintptr)
 
 // Immediately quits the application.
 //
-// Upon return to the mainloop, g_application_run() will return,
+// Upon return to the mainloop, [Application.Run] will return,
 // calling only the 'shutdown' function before doing so.
 //
 // The hold count is ignored.
-// Take care if your code has called g_application_hold() on the application and
+// Take care if your code has called [Application.Hold] on the application and
 // is therefore still expecting it to exist.
-// (Note that you may have called g_application_hold() indirectly, for example
-// through gtk_application_add_window().)
+// (Note that you may have called [Application.Hold] indirectly, for example
+// through [gtk.Application.AddWindow].)
 //
-// The result of calling g_application_run() again after it returns is
+// The result of calling [Application.Run] again after it returns is
 // unspecified.
 func (x *Application) Quit() {
 
@@ -1054,7 +1073,7 @@ var xApplicationRegister func(uintptr, uintptr, **glib.Error) bool
 // application identifier as a unique bus name on the session bus using
 // GDBus.
 //
-// If there is no application ID or if %G_APPLICATION_NON_UNIQUE was
+// If there is no application ID or if [GApplicationNonUniqueValue] was
 // given, then this process will always become the primary instance.
 //
 // Due to the internal architecture of GDBus, method calls can be
@@ -1062,26 +1081,26 @@ var xApplicationRegister func(uintptr, uintptr, **glib.Error) bool
 // this reason, you must ensure that any object paths that you wish to
 // register are registered before calling this function.
 //
-// If the application has already been registered then %TRUE is
+// If the application has already been registered then true is
 // returned with no work performed.
 //
-// The #GApplication::startup signal is emitted if registration succeeds
-// and @application is the primary instance (including the non-unique
+// The [Application.ConnectStartupFunc] signal is emitted if registration succeeds
+// and application is the primary instance (including the non-unique
 // case).
 //
-// In the event of an error (such as @cancellable being cancelled, or a
-// failure to connect to the session bus), %FALSE is returned and @error
+// In the event of an error (such as cancellable being cancelled, or a
+// failure to connect to the session bus), false is returned and error
 // is set appropriately.
 //
 // Note: the return value of this function is not an indicator that this
 // instance is or is not the primary instance of the application.  See
-// g_application_get_is_remote() for that.
+// [Application.GetIsRemote] for that.
 func (x *Application) Register(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xApplicationRegister(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1089,12 +1108,12 @@ func (x *Application) Register(CancellableVar *Cancellable) (bool, error) {
 
 var xApplicationRelease func(uintptr)
 
-// Decrease the use count of @application.
+// Decrease the use count of application.
 //
 // When the use count reaches zero, the application will stop running.
 //
 // Never call this function except to cancel the effect of a previous
-// call to g_application_hold().
+// call to [Application.Hold].
 func (x *Application) Release() {
 
 	xApplicationRelease(x.GoPointer())
@@ -1107,39 +1126,39 @@ var xApplicationRun func(uintptr, int, []string) int
 //
 // This function is intended to be run from main() and its return value
 // is intended to be returned by main(). Although you are expected to pass
-// the @argc, @argv parameters from main() to this function, it is possible
-// to pass %NULL if @argv is not available or commandline handling is not
-// required.  Note that on Windows, @argc and @argv are ignored, and
+// the argc, argv parameters from main() to this function, it is possible
+// to pass nil if argv is not available or commandline handling is not
+// required.  Note that on Windows, argc and argv are ignored, and
 // g_win32_get_command_line() is called internally (for proper support
 // of Unicode commandline arguments).
 //
-// #GApplication will attempt to parse the commandline arguments.  You
+// [Application] will attempt to parse the commandline arguments.  You
 // can add commandline flags to the list of recognised options by way of
-// g_application_add_main_option_entries().  After this, the
-// #GApplication::handle-local-options signal is emitted, from which the
-// application can inspect the values of its #GOptionEntrys.
+// [Application.AddMainOptionEntries].  After this, the
+// [Application.ConnectHandleLocalOptionsFunc] signal is emitted, from which the
+// application can inspect the values of its GOptionEntrys.
 //
-// #GApplication::handle-local-options is a good place to handle options
+// [Application.ConnectHandleLocalOptionsFunc] is a good place to handle options
 // such as `--version`, where an immediate reply from the local process is
 // desired (instead of communicating with an already-running instance).
-// A #GApplication::handle-local-options handler can stop further processing
+// A [Application.ConnectHandleLocalOptionsFunc] handler can stop further processing
 // by returning a non-negative value, which then becomes the exit status of
 // the process.
 //
 // What happens next depends on the flags: if
-// %G_APPLICATION_HANDLES_COMMAND_LINE was specified then the remaining
+// [GApplicationHandlesCommandLineValue] was specified then the remaining
 // commandline arguments are sent to the primary instance, where a
-// #GApplication::command-line signal is emitted.  Otherwise, the
+// [Application.ConnectCommandLineFunc] signal is emitted.  Otherwise, the
 // remaining commandline arguments are assumed to be a list of files.
 // If there are no files listed, the application is activated via the
-// #GApplication::activate signal.  If there are one or more files, and
-// %G_APPLICATION_HANDLES_OPEN was specified then the files are opened
-// via the #GApplication::open signal.
+// [Application.ConnectActivateFunc] signal.  If there are one or more files, and
+// [GApplicationHandlesOpenValue] was specified then the files are opened
+// via the [Application.ConnectOpenFunc] signal.
 //
 // If you are interested in doing more complicated local handling of the
-// commandline then you should implement your own #GApplication subclass
+// commandline then you should implement your own [Application] subclass
 // and override local_command_line(). In this case, you most likely want
-// to return %TRUE from your local_command_line() implementation to
+// to return true from your local_command_line() implementation to
 // suppress the default handling. See
 // [gapplication-example-cmdline2.c][https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline2.c]
 // for an example.
@@ -1149,26 +1168,26 @@ var xApplicationRun func(uintptr, int, []string) int
 // non-zero then the default main context is iterated until the use count
 // falls to zero, at which point 0 is returned.
 //
-// If the %G_APPLICATION_IS_SERVICE flag is set, then the service will
+// If the [GApplicationIsServiceValue] flag is set, then the service will
 // run for as much as 10 seconds with a use count of zero while waiting
 // for the message that caused the activation to arrive.  After that,
 // if the use count falls to zero the application will exit immediately,
-// except in the case that g_application_set_inactivity_timeout() is in
+// except in the case that [Application.SetInactivityTimeout] is in
 // use.
 //
-// This function sets the prgname (g_set_prgname()), if not already set,
+// This function sets the prgname ([glib.SetPrgname]), if not already set,
 // to the basename of argv[0].
 //
-// Much like g_main_loop_run(), this function will acquire the main context
+// Much like [glib.MainLoop.Run], this function will acquire the main context
 // for the duration that the application is running.
 //
 // Since 2.40, applications that are not explicitly flagged as services
-// or launchers (ie: neither %G_APPLICATION_IS_SERVICE or
-// %G_APPLICATION_IS_LAUNCHER are given as flags) will check (from the
+// or launchers (ie: neither [GApplicationIsServiceValue] or
+// [GApplicationIsLauncherValue] are given as flags) will check (from the
 // default handler for local_command_line) if "--gapplication-service"
 // was given in the command line.  If this flag is present then normal
 // commandline processing is interrupted and the
-// %G_APPLICATION_IS_SERVICE flag is set.  This provides a "compromise"
+// [GApplicationIsServiceValue] flag is set.  This provides a "compromise"
 // solution whereby running an application directly from the commandline
 // will invoke it in the normal way (which can be useful for debugging)
 // while still allowing applications to be D-Bus activated in service
@@ -1186,7 +1205,7 @@ func (x *Application) Run(ArgcVar int, ArgvVar []string) int {
 
 var xApplicationSendNotification func(uintptr, uintptr, uintptr)
 
-// Sends a notification on behalf of @application to the desktop shell.
+// Sends a notification on behalf of application to the desktop shell.
 // There is no guarantee that the notification is displayed immediately,
 // or even at all.
 //
@@ -1194,26 +1213,26 @@ var xApplicationSendNotification func(uintptr, uintptr, uintptr)
 // D-Bus-activated when the notification or one of its actions is
 // activated.
 //
-// Modifying @notification after this call has no effect. However, the
+// Modifying notification after this call has no effect. However, the
 // object can be reused for a later call to this function.
 //
-// @id may be any string that uniquely identifies the event for the
+// id may be any string that uniquely identifies the event for the
 // application. It does not need to be in any special format. For
 // example, "new-message" might be appropriate for a notification about
 // new messages.
 //
-// If a previous notification was sent with the same @id, it will be
-// replaced with @notification and shown again as if it was a new
+// If a previous notification was sent with the same id, it will be
+// replaced with notification and shown again as if it was a new
 // notification. This works even for notifications sent from a previous
-// execution of the application, as long as @id is the same string.
+// execution of the application, as long as id is the same string.
 //
-// @id may be `NULL`, but it is impossible to replace or withdraw
+// id may be `NULL`, but it is impossible to replace or withdraw
 // notifications without an id.
 //
-// If @notification is no longer relevant, it can be withdrawn with
-// [method@Gio.Application.withdraw_notification].
+// If notification is no longer relevant, it can be withdrawn with
+// [Application.WithdrawNotification].
 //
-// It is an error to call this function if @application has no
+// It is an error to call this function if application has no
 // application ID.
 func (x *Application) SendNotification(IdVar *string, NotificationVar *Notification) {
 
@@ -1224,25 +1243,15 @@ func (x *Application) SendNotification(IdVar *string, NotificationVar *Notificat
 
 }
 
-var xApplicationSetActionGroup func(uintptr, uintptr)
-
-// This used to be how actions were associated with a #GApplication.
-// Now there is #GActionMap for that.
-func (x *Application) SetActionGroup(ActionGroupVar ActionGroup) {
-
-	xApplicationSetActionGroup(x.GoPointer(), ActionGroupVar.GoPointer())
-
-}
-
 var xApplicationSetApplicationId func(uintptr, uintptr)
 
-// Sets the unique identifier for @application.
+// Sets the unique identifier for application.
 //
-// The application id can only be modified if @application has not yet
+// The application id can only be modified if application has not yet
 // been registered.
 //
-// If non-%NULL, the application id must be valid.  See
-// g_application_id_is_valid().
+// If non-nil, the application id must be valid.  See
+// [ApplicationIdIsValid].
 func (x *Application) SetApplicationId(ApplicationIdVar *string) {
 
 	ApplicationIdVarPtr := core.GStrdupNullable(ApplicationIdVar)
@@ -1255,11 +1264,11 @@ func (x *Application) SetApplicationId(ApplicationIdVar *string) {
 var xApplicationSetDefault func(uintptr)
 
 // Sets or unsets the default application for the process, as returned
-// by g_application_get_default().
+// by [ApplicationGetDefault].
 //
-// This function does not take its own reference on @application.  If
-// @application is destroyed then the default application will revert
-// back to %NULL.
+// This function does not take its own reference on application.  If
+// application is destroyed then the default application will revert
+// back to nil.
 func (x *Application) SetDefault() {
 
 	xApplicationSetDefault(x.GoPointer())
@@ -1268,12 +1277,12 @@ func (x *Application) SetDefault() {
 
 var xApplicationSetFlags func(uintptr, ApplicationFlags)
 
-// Sets the flags for @application.
+// Sets the flags for application.
 //
-// The flags can only be modified if @application has not yet been
+// The flags can only be modified if application has not yet been
 // registered.
 //
-// See #GApplicationFlags.
+// See [ApplicationFlags].
 func (x *Application) SetFlags(FlagsVar ApplicationFlags) {
 
 	xApplicationSetFlags(x.GoPointer(), FlagsVar)
@@ -1285,10 +1294,10 @@ var xApplicationSetInactivityTimeout func(uintptr, uint)
 // Sets the current inactivity timeout for the application.
 //
 // This is the amount of time (in milliseconds) after the last call to
-// g_application_release() before the application stops running.
+// [Application.Release] before the application stops running.
 //
 // This call has no side effects of its own.  The value set here is only
-// used for next time g_application_release() drops the use count to
+// used for next time [Application.Release] drops the use count to
 // zero.  Any timeouts currently in progress are not impacted.
 func (x *Application) SetInactivityTimeout(InactivityTimeoutVar uint) {
 
@@ -1298,9 +1307,9 @@ func (x *Application) SetInactivityTimeout(InactivityTimeoutVar uint) {
 
 var xApplicationSetOptionContextDescription func(uintptr, uintptr)
 
-// Adds a description to the @application option context.
+// Adds a description to the application option context.
 //
-// See g_option_context_set_description() for more information.
+// See [glib.OptionContext.SetDescription] for more information.
 func (x *Application) SetOptionContextDescription(DescriptionVar *string) {
 
 	DescriptionVarPtr := core.GStrdupNullable(DescriptionVar)
@@ -1312,12 +1321,12 @@ func (x *Application) SetOptionContextDescription(DescriptionVar *string) {
 
 var xApplicationSetOptionContextParameterString func(uintptr, uintptr)
 
-// Sets the parameter string to be used by the commandline handling of @application.
+// Sets the parameter string to be used by the commandline handling of application.
 //
 // This function registers the argument to be passed to g_option_context_new()
-// when the internal #GOptionContext of @application is created.
+// when the internal [glib.OptionContext] of application is created.
 //
-// See g_option_context_new() for more information about @parameter_string.
+// See g_option_context_new() for more information about parameter_string.
 func (x *Application) SetOptionContextParameterString(ParameterStringVar *string) {
 
 	ParameterStringVarPtr := core.GStrdupNullable(ParameterStringVar)
@@ -1329,9 +1338,9 @@ func (x *Application) SetOptionContextParameterString(ParameterStringVar *string
 
 var xApplicationSetOptionContextSummary func(uintptr, uintptr)
 
-// Adds a summary to the @application option context.
+// Adds a summary to the application option context.
 //
-// See g_option_context_set_summary() for more information.
+// See [glib.OptionContext.SetSummary] for more information.
 func (x *Application) SetOptionContextSummary(SummaryVar *string) {
 
 	SummaryVarPtr := core.GStrdupNullable(SummaryVar)
@@ -1343,38 +1352,38 @@ func (x *Application) SetOptionContextSummary(SummaryVar *string) {
 
 var xApplicationSetResourceBasePath func(uintptr, uintptr)
 
-// Sets (or unsets) the base resource path of @application.
+// Sets (or unsets) the base resource path of application.
 //
 // The path is used to automatically load various
-// [application resources][struct@Gio.Resource] such as menu layouts and
+// [application resources][Resource] such as menu layouts and
 // action descriptions. The various types of resources will be found at
 // fixed names relative to the given base path.
 //
 // By default, the resource base path is determined from the application
 // ID by prefixing '/' and replacing each '.' with '/'.  This is done at
-// the time that the #GApplication object is constructed.  Changes to
+// the time that the [Application] object is constructed.  Changes to
 // the application ID after that point will not have an impact on the
 // resource base path.
 //
 // As an example, if the application has an ID of "org.example.app" then
 // the default resource base path will be "/org/example/app".  If this
-// is a #GtkApplication (and you have not manually changed the path)
+// is a [gtk.Application] (and you have not manually changed the path)
 // then Gtk will then search for the menus of the application at
 // "/org/example/app/gtk/menus.ui".
 //
-// See #GResource for more information about adding resources to your
+// See [Resource] for more information about adding resources to your
 // application.
 //
 // You can disable automatic resource loading functionality by setting
-// the path to %NULL.
+// the path to nil.
 //
 // Changing the resource base path once the application is running is
 // not recommended.  The point at which the resource path is consulted
 // for forming paths for various purposes is unspecified.  When writing
-// a sub-class of #GApplication you should either set the
-// #GApplication:resource-base-path property at construction time, or call
+// a sub-class of [Application] you should either set the
+// [Application.GetPropertyResourceBasePath] property at construction time, or call
 // this function during the instance initialization. Alternatively, you
-// can call this function in the #GApplicationClass.startup virtual function,
+// can call this function in the [ApplicationClass].startup virtual function,
 // before chaining up to the parent implementation.
 func (x *Application) SetResourceBasePath(ResourcePathVar *string) {
 
@@ -1387,10 +1396,10 @@ func (x *Application) SetResourceBasePath(ResourcePathVar *string) {
 
 var xApplicationSetVersion func(uintptr, string)
 
-// Sets the version number of @application. This will be used to implement
+// Sets the version number of application. This will be used to implement
 // a `--version` command line argument
 //
-// The application version can only be modified if @application has not yet
+// The application version can only be modified if application has not yet
 // been registered.
 func (x *Application) SetVersion(VersionVar string) {
 
@@ -1400,9 +1409,9 @@ func (x *Application) SetVersion(VersionVar string) {
 
 var xApplicationUnbindBusyProperty func(uintptr, uintptr, string)
 
-// Destroys a binding between @property and the busy state of
-// @application that was previously created with
-// g_application_bind_busy_property().
+// Destroys a binding between property and the busy state of
+// application that was previously created with
+// [Application.BindBusyProperty].
 func (x *Application) UnbindBusyProperty(ObjectVar *gobject.Object, PropertyVar string) {
 
 	xApplicationUnbindBusyProperty(x.GoPointer(), ObjectVar.GoPointer(), PropertyVar)
@@ -1411,13 +1420,13 @@ func (x *Application) UnbindBusyProperty(ObjectVar *gobject.Object, PropertyVar
 
 var xApplicationUnmarkBusy func(uintptr)
 
-// Decreases the busy count of @application.
+// Decreases the busy count of application.
 //
 // When the busy count reaches zero, the new state will be propagated
 // to other processes.
 //
 // This function must only be called to cancel the effect of a previous
-// call to g_application_mark_busy().
+// call to [Application.MarkBusy].
 func (x *Application) UnmarkBusy() {
 
 	xApplicationUnmarkBusy(x.GoPointer())
@@ -1427,13 +1436,13 @@ func (x *Application) UnmarkBusy() {
 var xApplicationWithdrawNotification func(uintptr, string)
 
 // Withdraws a notification that was sent with
-// g_application_send_notification().
+// [Application.SendNotification].
 //
-// This call does nothing if a notification with @id doesn't exist or
+// This call does nothing if a notification with id doesn't exist or
 // the notification was never sent.
 //
 // This function works even for notifications sent in previous
-// executions of this application, as long @id is the same as it was for
+// executions of this application, as long id is the same as it was for
 // the sent notification.
 //
 // Note that notifications are dismissed when the user clicks on one
@@ -1492,7 +1501,7 @@ func (x *Application) GetPropertyInactivityTimeout() uint {
 
 // GetPropertyIsBusy gets the "is-busy" property.
 // Whether the application is currently marked as busy through
-// g_application_mark_busy() or g_application_bind_busy_property().
+// [Application.MarkBusy] or [Application.BindBusyProperty].
 func (x *Application) GetPropertyIsBusy() bool {
 	var v gobject.Value
 	x.GetProperty("is-busy", &v)
@@ -1500,7 +1509,7 @@ func (x *Application) GetPropertyIsBusy() bool {
 }
 
 // GetPropertyIsRegistered gets the "is-registered" property.
-// Whether [method@Gio.Application.register] has been called.
+// Whether [Application.Register] has been called.
 func (x *Application) GetPropertyIsRegistered() bool {
 	var v gobject.Value
 	x.GetProperty("is-registered", &v)
@@ -1550,86 +1559,130 @@ func (x *Application) GetPropertyVersion() string {
 }
 
 // The ::activate signal is emitted on the primary instance when an
-// activation occurs. See g_application_activate().
+// activation occurs. See [Application.Activate].
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Application) ConnectActivate(cb *func(Application)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xApplicationActivateTrampoline uintptr
+
+func xApplicationActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectActivateFunc(cb func(Application)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xApplicationActivateTrampoline, xApplicationActivateNewTrampoline, cb)
 }
 
 // The ::command-line signal is emitted on the primary instance when
-// a commandline is not handled locally. See g_application_run() and
-// the #GApplicationCommandLine documentation for more information.
+// a commandline is not handled locally. See [Application.Run] and
+// the [ApplicationCommandLine] documentation for more information.
+//
+// Deprecated: use ConnectCommandLineFunc, which also accepts method values and closures.
 func (x *Application) ConnectCommandLine(cb *func(Application, uintptr) int) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "command-line", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, CommandLineVarp uintptr) int {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, CommandLineVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "command-line", cbRefPtr, cbPtr)
+}
+
+var xApplicationCommandLineTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, CommandLineVarp uintptr) int {
+func xApplicationCommandLineNewTrampoline() interface{} {
+	return func(clsPtr uintptr, CommandLineVarp uintptr, data uintptr) (ret int) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application, uintptr) int)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, CommandLineVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "command-line", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCommandLineFunc connects cb to the "command-line" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectCommandLineFunc(cb func(Application, uintptr) int) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "command-line", &xApplicationCommandLineTrampoline, xApplicationCommandLineNewTrampoline, cb)
 }
 
 // The ::handle-local-options signal is emitted on the local instance
 // after the parsing of the commandline options has occurred.
 //
 // You can add options to be recognised during commandline option
-// parsing using g_application_add_main_option_entries() and
-// g_application_add_option_group().
+// parsing using [Application.AddMainOptionEntries] and
+// [Application.AddOptionGroup].
 //
-// Signal handlers can inspect @options (along with values pointed to
-// from the @arg_data of an installed #GOptionEntrys) in order to
+// Signal handlers can inspect options (along with values pointed to
+// from the arg_data of an installed GOptionEntrys) in order to
 // decide to perform certain actions, including direct local handling
 // (which may be useful for options like --version).
 //
 // In the event that the application is marked
-// %G_APPLICATION_HANDLES_COMMAND_LINE the "normal processing" will
-// send the @options dictionary to the primary instance where it can be
-// read with g_application_command_line_get_options_dict().  The signal
+// [GApplicationHandlesCommandLineValue] the "normal processing" will
+// send the options dictionary to the primary instance where it can be
+// read with [ApplicationCommandLine.GetOptionsDict].  The signal
 // handler can modify the dictionary before returning, and the
 // modified dictionary will be sent.
 //
-// In the event that %G_APPLICATION_HANDLES_COMMAND_LINE is not set,
+// In the event that [GApplicationHandlesCommandLineValue] is not set,
 // "normal processing" will treat the remaining uncollected command
 // line arguments as filenames or URIs.  If there are no arguments,
-// the application is activated by g_application_activate().  One or
-// more arguments results in a call to g_application_open().
+// the application is activated by [Application.Activate].  One or
+// more arguments results in a call to [Application.Open].
 //
 // If you want to handle the local commandline arguments for yourself
-// by converting them to calls to g_application_open() or
-// g_action_group_activate_action() then you must be sure to register
+// by converting them to calls to [Application.Open] or
+// [ActionGroup.ActivateAction] then you must be sure to register
 // the application first.  You should probably not call
-// g_application_activate() for yourself, however: just return -1 and
+// [Application.Activate] for yourself, however: just return -1 and
 // allow the default handler to do it for you.  This will ensure that
 // the `--gapplication-service` switch works properly (i.e. no activation
 // in that case).
@@ -1641,225 +1694,333 @@ func (x *Application) ConnectCommandLine(cb *func(Application, uintptr) int) uin
 // You can override local_command_line() if you need more powerful
 // capabilities than what is provided here, but this should not
 // normally be required.
+//
+// Deprecated: use ConnectHandleLocalOptionsFunc, which also accepts method values and closures.
 func (x *Application) ConnectHandleLocalOptions(cb *func(Application, uintptr) int) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "handle-local-options", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OptionsVarp uintptr) int {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, OptionsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "handle-local-options", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, OptionsVarp uintptr) int {
+var xApplicationHandleLocalOptionsTrampoline uintptr
+
+func xApplicationHandleLocalOptionsNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OptionsVarp uintptr, data uintptr) (ret int) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application, uintptr) int)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, OptionsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "handle-local-options", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectHandleLocalOptionsFunc connects cb to the "handle-local-options" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectHandleLocalOptionsFunc(cb func(Application, uintptr) int) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "handle-local-options", &xApplicationHandleLocalOptionsTrampoline, xApplicationHandleLocalOptionsNewTrampoline, cb)
 }
 
 // The ::name-lost signal is emitted only on the registered primary instance
 // when a new instance has taken over. This can only happen if the application
-// is using the %G_APPLICATION_ALLOW_REPLACEMENT flag.
+// is using the [GApplicationAllowReplacementValue] flag.
+//
+// The default handler for this signal calls [Application.Quit].
 //
-// The default handler for this signal calls g_application_quit().
+// Deprecated: use ConnectNameLostFunc, which also accepts method values and closures.
 func (x *Application) ConnectNameLost(cb *func(Application) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "name-lost", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "name-lost", cbRefPtr, cbPtr)
+}
+
+var xApplicationNameLostTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) bool {
+func xApplicationNameLostNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "name-lost", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNameLostFunc connects cb to the "name-lost" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectNameLostFunc(cb func(Application) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "name-lost", &xApplicationNameLostTrampoline, xApplicationNameLostNewTrampoline, cb)
 }
 
 // The ::open signal is emitted on the primary instance when there are
-// files to open. See g_application_open() for more information.
+// files to open. See [Application.Open] for more information.
+//
+// Deprecated: use ConnectOpenFunc, which also accepts method values and closures.
 func (x *Application) ConnectOpen(cb *func(Application, uintptr, int, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "open", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, FilesVarp, NFilesVarp, HintVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "open", cbRefPtr, cbPtr)
+}
+
+var xApplicationOpenTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string) {
+func xApplicationOpenNewTrampoline() interface{} {
+	return func(clsPtr uintptr, FilesVarp uintptr, NFilesVarp int, HintVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application, uintptr, int, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, FilesVarp, NFilesVarp, HintVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "open", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectOpenFunc connects cb to the "open" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectOpenFunc(cb func(Application, uintptr, int, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "open", &xApplicationOpenTrampoline, xApplicationOpenNewTrampoline, cb)
 }
 
 // The ::shutdown signal is emitted only on the registered primary instance
 // immediately after the main loop terminates.
+//
+// Deprecated: use ConnectShutdownFunc, which also accepts method values and closures.
 func (x *Application) ConnectShutdown(cb *func(Application)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "shutdown", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "shutdown", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xApplicationShutdownTrampoline uintptr
+
+func xApplicationShutdownNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "shutdown", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShutdownFunc connects cb to the "shutdown" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectShutdownFunc(cb func(Application)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "shutdown", &xApplicationShutdownTrampoline, xApplicationShutdownNewTrampoline, cb)
 }
 
 // The ::startup signal is emitted on the primary instance immediately
-// after registration. See g_application_register().
+// after registration. See [Application.Register].
+//
+// Deprecated: use ConnectStartupFunc, which also accepts method values and closures.
 func (x *Application) ConnectStartup(cb *func(Application)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "startup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "startup", cbRefPtr, cbPtr)
+}
+
+var xApplicationStartupTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xApplicationStartupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "startup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// ConnectStartupFunc connects cb to the "startup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectStartupFunc(cb func(Application)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "startup", &xApplicationStartupTrampoline, xApplicationStartupNewTrampoline, cb)
+}
+
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *Application) ActionAdded(ActionNameVar string) {
 
 	XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *Application) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *Application) ActionRemoved(ActionNameVar string) {
 
 	XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *Application) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [DBusConnection.Flush] is called
+// before `exit()`. Without `[DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *Application) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *Application) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -1870,14 +2031,14 @@ func (x *Application) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -1888,14 +2049,14 @@ func (x *Application) GetActionParameterType(ActionNameVar string) *glib.Variant
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -1903,14 +2064,14 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -1919,7 +2080,7 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -1927,17 +2088,17 @@ func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [ActionGroup.GetActionState] will return `NULL`
+// and you must not call [ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -1948,38 +2109,38 @@ func (x *Application) GetActionStateType(ActionNameVar string) *glib.VariantType
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *Application) HasAction(ActionNameVar string) bool {
 
 	cret := XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *Application) ListActions() []string {
 
 	cret := XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [ActionGroup.HasAction], [ActionGroup.GetActionEnabled],
+// [ActionGroup.GetActionParameterType],
+// [ActionGroup.GetActionStateType],
+// [ActionGroup.GetActionStateHint] and
+// [ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -1998,47 +2159,41 @@ func (x *Application) QueryAction(ActionNameVar string, EnabledVar *bool, Parame
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *Application) AddAction(ActionVar Action) {
 
 	XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
+// A convenience function for creating multiple [SimpleAction]
+// instances and adding them to a [ActionMap].
 //
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -2051,15 +2206,13 @@ func (x *Application) AddAction(ActionVar Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *Application) AddActionEntries(EntriesVar []ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *Application) LookupAction(ActionNameVar string) *ActionBase {
@@ -2085,31 +2238,25 @@ func (x *Application) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [ActionMap]. This is meant as the reverse of
+// [ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *Application) RemoveActionEntries(EntriesVar []ActionEntry, NEntriesVar int) {
 
 	XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -2118,13 +2265,13 @@ func (x *Application) RemoveActionEntries(EntriesVar []ActionEntry, NEntriesVar
 
 var xApplicationGetDefault func() uintptr
 
-// Returns the default #GApplication instance for this process.
+// Returns the default [Application] instance for this process.
 //
-// Normally there is only one #GApplication per process and it becomes
+// Normally there is only one [Application] per process and it becomes
 // the default when it is created.  You can exercise more control over
-// this by using g_application_set_default().
+// this by using [Application.SetDefault].
 //
-// If there is no default application then %NULL is returned.
+// If there is no default application then nil is returned.
 func ApplicationGetDefault() *Application {
 	var cls *Application
 
@@ -2141,13 +2288,13 @@ func ApplicationGetDefault() *Application {
 
 var xApplicationIdIsValid func(string) bool
 
-// Checks if @application_id is a valid application identifier.
+// Checks if application_id is a valid application identifier.
 //
-// A valid ID is required for calls to g_application_new() and
-// g_application_set_application_id().
+// A valid ID is required for calls to [NewApplication] and
+// [Application.SetApplicationId].
 //
 // Application identifiers follow the same format as
-// [D-Bus well-known bus names](https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-names-bus).
+// [D-Bus well-known bus names].
 // For convenience, the restrictions on application identifiers are
 // reproduced here:
 //
@@ -2168,9 +2315,9 @@ var xApplicationIdIsValid func(string) bool
 // Note that the hyphen (`-`) character is allowed in application identifiers,
 // but is problematic or not allowed in various specifications and APIs that
 // refer to D-Bus, such as
-// [Flatpak application IDs](http://docs.flatpak.org/en/latest/introduction.html#identifiers),
+// [Flatpak application IDs],
 // the
-// [`DBusActivatable` interface in the Desktop Entry Specification](https://specifications.freedesktop.org/desktop-entry-spec/desktop-entry-spec-latest.html#dbus),
+// [DBusActivatable interface in the Desktop Entry Specification],
 // and the convention that an application's "main" interface and object path
 // resemble its application identifier and bus name. To avoid situations that
 // require special-case handling, it is recommended that new application
@@ -2186,6 +2333,10 @@ var xApplicationIdIsValid func(string) bool
 // contains leading digits they should be escaped by prepending an underscore.
 // For example, if the owner of 7-zip.org used an application identifier for an
 // archiving application, it might be named `org._7_zip.Archiver`.
+//
+// [D-Bus well-known bus names]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-names-bus
+// [Flatpak application IDs]: http://docs.flatpak.org/en/latest/introduction.html#identifiers
+// [DBusActivatable interface in the Desktop Entry Specification]: https://specifications.freedesktop.org/desktop-entry-spec/desktop-entry-spec-latest.html#dbus
 func ApplicationIdIsValid(ApplicationIdVar string) bool {
 
 	cret := xApplicationIdIsValid(ApplicationIdVar)
@@ -2197,7 +2348,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2206,46 +2357,47 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationGLibType, libs, "g_application_get_type")
 
-	core.PuregoSafeRegister(&xNewApplication, libs, "g_application_new")
-
-	core.PuregoSafeRegister(&xApplicationActivate, libs, "g_application_activate")
-	core.PuregoSafeRegister(&xApplicationAddMainOption, libs, "g_application_add_main_option")
-	core.PuregoSafeRegister(&xApplicationAddMainOptionEntries, libs, "g_application_add_main_option_entries")
-	core.PuregoSafeRegister(&xApplicationAddOptionGroup, libs, "g_application_add_option_group")
-	core.PuregoSafeRegister(&xApplicationBindBusyProperty, libs, "g_application_bind_busy_property")
-	core.PuregoSafeRegister(&xApplicationGetApplicationId, libs, "g_application_get_application_id")
-	core.PuregoSafeRegister(&xApplicationGetDbusConnection, libs, "g_application_get_dbus_connection")
-	core.PuregoSafeRegister(&xApplicationGetDbusObjectPath, libs, "g_application_get_dbus_object_path")
-	core.PuregoSafeRegister(&xApplicationGetFlags, libs, "g_application_get_flags")
-	core.PuregoSafeRegister(&xApplicationGetInactivityTimeout, libs, "g_application_get_inactivity_timeout")
-	core.PuregoSafeRegister(&xApplicationGetIsBusy, libs, "g_application_get_is_busy")
-	core.PuregoSafeRegister(&xApplicationGetIsRegistered, libs, "g_application_get_is_registered")
-	core.PuregoSafeRegister(&xApplicationGetIsRemote, libs, "g_application_get_is_remote")
-	core.PuregoSafeRegister(&xApplicationGetResourceBasePath, libs, "g_application_get_resource_base_path")
-	core.PuregoSafeRegister(&xApplicationGetVersion, libs, "g_application_get_version")
-	core.PuregoSafeRegister(&xApplicationHold, libs, "g_application_hold")
-	core.PuregoSafeRegister(&xApplicationMarkBusy, libs, "g_application_mark_busy")
-	core.PuregoSafeRegister(&xApplicationOpen, libs, "g_application_open")
-	core.PuregoSafeRegister(&xApplicationQuit, libs, "g_application_quit")
-	core.PuregoSafeRegister(&xApplicationRegister, libs, "g_application_register")
-	core.PuregoSafeRegister(&xApplicationRelease, libs, "g_application_release")
-	core.PuregoSafeRegister(&xApplicationRun, libs, "g_application_run")
-	core.PuregoSafeRegister(&xApplicationSendNotification, libs, "g_application_send_notification")
-	core.PuregoSafeRegister(&xApplicationSetActionGroup, libs, "g_application_set_action_group")
-	core.PuregoSafeRegister(&xApplicationSetApplicationId, libs, "g_application_set_application_id")
-	core.PuregoSafeRegister(&xApplicationSetDefault, libs, "g_application_set_default")
-	core.PuregoSafeRegister(&xApplicationSetFlags, libs, "g_application_set_flags")
-	core.PuregoSafeRegister(&xApplicationSetInactivityTimeout, libs, "g_application_set_inactivity_timeout")
-	core.PuregoSafeRegister(&xApplicationSetOptionContextDescription, libs, "g_application_set_option_context_description")
-	core.PuregoSafeRegister(&xApplicationSetOptionContextParameterString, libs, "g_application_set_option_context_parameter_string")
-	core.PuregoSafeRegister(&xApplicationSetOptionContextSummary, libs, "g_application_set_option_context_summary")
-	core.PuregoSafeRegister(&xApplicationSetResourceBasePath, libs, "g_application_set_resource_base_path")
-	core.PuregoSafeRegister(&xApplicationSetVersion, libs, "g_application_set_version")
-	core.PuregoSafeRegister(&xApplicationUnbindBusyProperty, libs, "g_application_unbind_busy_property")
-	core.PuregoSafeRegister(&xApplicationUnmarkBusy, libs, "g_application_unmark_busy")
-	core.PuregoSafeRegister(&xApplicationWithdrawNotification, libs, "g_application_withdraw_notification")
-
-	core.PuregoSafeRegister(&xApplicationGetDefault, libs, "g_application_get_default")
-	core.PuregoSafeRegister(&xApplicationIdIsValid, libs, "g_application_id_is_valid")
+	gobject.CheckClassSize("GApplicationClass", xApplicationGLibType, unsafe.Sizeof(ApplicationClass{}))
+
+	core.PuregoRegisterSince(&xNewApplication, libs, "g_application_new", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xApplicationActivate, libs, "g_application_activate", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationAddMainOption, libs, "g_application_add_main_option", "GIO", "2.42")
+	core.PuregoRegisterSince(&xApplicationAddMainOptionEntries, libs, "g_application_add_main_option_entries", "GIO", "2.40")
+	core.PuregoRegisterSince(&xApplicationAddOptionGroup, libs, "g_application_add_option_group", "GIO", "2.40")
+	core.PuregoRegisterSince(&xApplicationBindBusyProperty, libs, "g_application_bind_busy_property", "GIO", "2.44")
+	core.PuregoRegisterSince(&xApplicationGetApplicationId, libs, "g_application_get_application_id", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationGetDbusConnection, libs, "g_application_get_dbus_connection", "GIO", "2.34")
+	core.PuregoRegisterSince(&xApplicationGetDbusObjectPath, libs, "g_application_get_dbus_object_path", "GIO", "2.34")
+	core.PuregoRegisterSince(&xApplicationGetFlags, libs, "g_application_get_flags", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationGetInactivityTimeout, libs, "g_application_get_inactivity_timeout", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationGetIsBusy, libs, "g_application_get_is_busy", "GIO", "2.44")
+	core.PuregoRegisterSince(&xApplicationGetIsRegistered, libs, "g_application_get_is_registered", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationGetIsRemote, libs, "g_application_get_is_remote", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationGetResourceBasePath, libs, "g_application_get_resource_base_path", "GIO", "2.42")
+	core.PuregoRegisterSince(&xApplicationGetVersion, libs, "g_application_get_version", "GIO", "2.80")
+	core.PuregoRegisterSince(&xApplicationHold, libs, "g_application_hold", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationMarkBusy, libs, "g_application_mark_busy", "GIO", "2.38")
+	core.PuregoRegisterSince(&xApplicationOpen, libs, "g_application_open", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationQuit, libs, "g_application_quit", "GIO", "2.32")
+	core.PuregoRegisterSince(&xApplicationRegister, libs, "g_application_register", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationRelease, libs, "g_application_release", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationRun, libs, "g_application_run", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationSendNotification, libs, "g_application_send_notification", "GIO", "2.40")
+	core.PuregoRegisterSince(&xApplicationSetApplicationId, libs, "g_application_set_application_id", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationSetDefault, libs, "g_application_set_default", "GIO", "2.32")
+	core.PuregoRegisterSince(&xApplicationSetFlags, libs, "g_application_set_flags", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationSetInactivityTimeout, libs, "g_application_set_inactivity_timeout", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationSetOptionContextDescription, libs, "g_application_set_option_context_description", "GIO", "2.56")
+	core.PuregoRegisterSince(&xApplicationSetOptionContextParameterString, libs, "g_application_set_option_context_parameter_string", "GIO", "2.56")
+	core.PuregoRegisterSince(&xApplicationSetOptionContextSummary, libs, "g_application_set_option_context_summary", "GIO", "2.56")
+	core.PuregoRegisterSince(&xApplicationSetResourceBasePath, libs, "g_application_set_resource_base_path", "GIO", "2.42")
+	core.PuregoRegisterSince(&xApplicationSetVersion, libs, "g_application_set_version", "GIO", "2.80")
+	core.PuregoRegisterSince(&xApplicationUnbindBusyProperty, libs, "g_application_unbind_busy_property", "GIO", "2.44")
+	core.PuregoRegisterSince(&xApplicationUnmarkBusy, libs, "g_application_unmark_busy", "GIO", "2.38")
+	core.PuregoRegisterSince(&xApplicationWithdrawNotification, libs, "g_application_withdraw_notification", "GIO", "2.40")
+
+	core.PuregoRegisterSince(&xApplicationGetDefault, libs, "g_application_get_default", "GIO", "2.32")
+	core.PuregoRegisterSince(&xApplicationIdIsValid, libs, "g_application_id_is_valid", "GIO", "2.28")
 
 }
@@ -0,0 +1,40 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xApplicationSetActionGroup func(uintptr, uintptr)
+
+// This used to be how actions were associated with a [Application].
+// Now there is [ActionMap] for that.
+//
+// Deprecated: since 2.32. Use the [ActionMap] interface instead.  Never ever
+// mix use of this API with use of [ActionMap] on the same application
+// or things will go very badly wrong.  This function is known to
+// introduce buggy behaviour (ie: signals not emitted on changes to the
+// action group), so you should really use [ActionMap] instead.
+func (x *Application) SetActionGroup(ActionGroupVar ActionGroup) {
+
+	xApplicationSetActionGroup(x.GoPointer(), ActionGroupVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xApplicationSetActionGroup, libs, "g_application_set_action_group", "GIO", "2.28")
+
+}
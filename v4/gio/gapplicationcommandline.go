@@ -2,17 +2,17 @@
 package gio
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The #GApplicationCommandLineClass-struct
+// The [ApplicationCommandLineClass]-struct
 // contains private data only.
 type ApplicationCommandLineClass struct {
 	_ structs.HostLayout
@@ -39,7 +39,8 @@ func (x *ApplicationCommandLineClass) OverridePrintLiteral(cb func(*ApplicationC
 	if cb == nil {
 		x.xPrintLiteral = 0
 	} else {
-		x.xPrintLiteral = purego.NewCallback(func(CmdlineVarp uintptr, MessageVarp string) {
+		x.xPrintLiteral = core.NewCallback(func(CmdlineVarp uintptr, MessageVarp string) {
+			defer core.RecoverPanic()
 			cb(ApplicationCommandLineNewFromInternalPtr(CmdlineVarp), MessageVarp)
 		})
 	}
@@ -51,7 +52,7 @@ func (x *ApplicationCommandLineClass) GetPrintLiteral() func(*ApplicationCommand
 		return nil
 	}
 	var rawCallback func(CmdlineVarp uintptr, MessageVarp string)
-	purego.RegisterFunc(&rawCallback, x.xPrintLiteral)
+	core.RegisterFunc(&rawCallback, x.xPrintLiteral)
 	return func(CmdlineVar *ApplicationCommandLine, MessageVar string) {
 		rawCallback(CmdlineVar.GoPointer(), MessageVar)
 	}
@@ -62,7 +63,8 @@ func (x *ApplicationCommandLineClass) OverridePrinterrLiteral(cb func(*Applicati
 	if cb == nil {
 		x.xPrinterrLiteral = 0
 	} else {
-		x.xPrinterrLiteral = purego.NewCallback(func(CmdlineVarp uintptr, MessageVarp string) {
+		x.xPrinterrLiteral = core.NewCallback(func(CmdlineVarp uintptr, MessageVarp string) {
+			defer core.RecoverPanic()
 			cb(ApplicationCommandLineNewFromInternalPtr(CmdlineVarp), MessageVarp)
 		})
 	}
@@ -74,7 +76,7 @@ func (x *ApplicationCommandLineClass) GetPrinterrLiteral() func(*ApplicationComm
 		return nil
 	}
 	var rawCallback func(CmdlineVarp uintptr, MessageVarp string)
-	purego.RegisterFunc(&rawCallback, x.xPrinterrLiteral)
+	core.RegisterFunc(&rawCallback, x.xPrinterrLiteral)
 	return func(CmdlineVar *ApplicationCommandLine, MessageVar string) {
 		rawCallback(CmdlineVar.GoPointer(), MessageVar)
 	}
@@ -85,7 +87,8 @@ func (x *ApplicationCommandLineClass) OverrideGetStdin(cb func(*ApplicationComma
 	if cb == nil {
 		x.xGetStdin = 0
 	} else {
-		x.xGetStdin = purego.NewCallback(func(CmdlineVarp uintptr) uintptr {
+		x.xGetStdin = core.NewCallback(func(CmdlineVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(ApplicationCommandLineNewFromInternalPtr(CmdlineVarp))
 			if ret == nil {
 				return 0
@@ -101,7 +104,7 @@ func (x *ApplicationCommandLineClass) GetGetStdin() func(*ApplicationCommandLine
 		return nil
 	}
 	var rawCallback func(CmdlineVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetStdin)
+	core.RegisterFunc(&rawCallback, x.xGetStdin)
 	return func(CmdlineVar *ApplicationCommandLine) *InputStream {
 		rawRet := rawCallback(CmdlineVar.GoPointer())
 		if rawRet == 0 {
@@ -118,7 +121,8 @@ func (x *ApplicationCommandLineClass) OverrideDone(cb func(*ApplicationCommandLi
 	if cb == nil {
 		x.xDone = 0
 	} else {
-		x.xDone = purego.NewCallback(func(CmdlineVarp uintptr) {
+		x.xDone = core.NewCallback(func(CmdlineVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationCommandLineNewFromInternalPtr(CmdlineVarp))
 		})
 	}
@@ -130,7 +134,7 @@ func (x *ApplicationCommandLineClass) GetDone() func(*ApplicationCommandLine) {
 		return nil
 	}
 	var rawCallback func(CmdlineVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDone)
+	core.RegisterFunc(&rawCallback, x.xDone)
 	return func(CmdlineVar *ApplicationCommandLine) {
 		rawCallback(CmdlineVar.GoPointer())
 	}
@@ -147,8 +151,8 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 // `GApplicationCommandLine` represents a command-line invocation of
 // an application.
 //
-// It is created by [class@Gio.Application] and emitted
-// in the [signal@Gio.Application::command-line] signal and virtual function.
+// It is created by [Application] and emitted
+// in the [Application.ConnectCommandLineFunc] signal and virtual function.
 //
 // The class contains the list of arguments that the program was invoked
 // with. It is also possible to query if the commandline invocation was
@@ -156,21 +160,21 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 // invocation) or remote (ie: some other process forwarded the
 // commandline to this process).
 //
-// The `GApplicationCommandLine` object can provide the @argc and @argv
-// parameters for use with the [struct@GLib.OptionContext] command-line parsing API,
-// with the [method@Gio.ApplicationCommandLine.get_arguments] function. See
-// [gapplication-example-cmdline3.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline3.c)
+// The `GApplicationCommandLine` object can provide the argc and argv
+// parameters for use with the [glib.OptionContext] command-line parsing API,
+// with the [ApplicationCommandLine.GetArguments] function. See
+// [gapplication-example-cmdline3.c]
 // for an example.
 //
 // The exit status of the originally-invoked process may be set and
 // messages can be printed to stdout or stderr of that process.
 //
 // For remote invocation, the originally-invoked process exits when
-// [method@Gio.ApplicationCommandLine.done] method is called. This method is
+// [ApplicationCommandLine.Done] method is called. This method is
 // also automatically called when the object is disposed.
 //
 // The main use for `GApplicationCommandLine` (and the
-// [signal@Gio.Application::command-line] signal) is 'Emacs server' like use cases:
+// [Application.ConnectCommandLineFunc] signal) is 'Emacs server' like use cases:
 // You can set the `EDITOR` environment variable to have e.g. git use
 // your favourite editor to edit commit messages, and if you already
 // have an instance of the editor running, the editing will happen
@@ -179,29 +183,26 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 // does not return until the editing is done.
 //
 // Normally, the commandline is completely handled in the
-// [signal@Gio.Application::command-line] handler. The launching instance exits
+// [Application.ConnectCommandLineFunc] handler. The launching instance exits
 // once the signal handler in the primary instance has returned, and
 // the return value of the signal handler becomes the exit status
 // of the launching instance.
 //
-// ```c
-// static int
-// command_line (GApplication            *application,
-//
-//	GApplicationCommandLine *cmdline)
-//
+//	static int
+//	command_line (GApplication            *application,
+//	              GApplicationCommandLine *cmdline)
 //	{
 //	  gchar **argv;
 //	  gint argc;
 //	  gint i;
 //
-//	  argv = g_application_command_line_get_arguments (cmdline, &amp;argc);
+//	  argv = g_application_command_line_get_arguments (cmdline, &argc);
 //
 //	  g_application_command_line_print (cmdline,
 //	                                    "This text is written back\n"
 //	                                    "to stdout of the caller\n");
 //
-//	  for (i = 0; i &lt; argc; i++)
+//	  for (i = 0; i < argc; i++)
 //	    g_print ("argument %d: %s\n", i, argv[i]);
 //
 //	  g_strfreev (argv);
@@ -209,21 +210,16 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 //	  return 0;
 //	}
 //
-// ```
-//
 // The complete example can be found here:
-// [gapplication-example-cmdline.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline.c)
+// [gapplication-example-cmdline.c]
 //
 // In more complicated cases, the handling of the commandline can be
 // split between the launcher and the primary instance.
 //
-// ```c
-// static gboolean
-//
-//	test_local_cmdline (GApplication   *application,
-//	                    gchar        ***arguments,
-//	                    gint           *exit_status)
-//
+//	static gboolean
+//	 test_local_cmdline (GApplication   *application,
+//	                     gchar        ***arguments,
+//	                     gint           *exit_status)
 //	{
 //	  gint i, j;
 //	  gchar **argv;
@@ -258,31 +254,26 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 //	  return FALSE;
 //	}
 //
-// static void
-// test_application_class_init (TestApplicationClass *class)
-//
+//	static void
+//	test_application_class_init (TestApplicationClass *class)
 //	{
-//	  G_APPLICATION_CLASS (class)-&gt;local_command_line = test_local_cmdline;
+//	  G_APPLICATION_CLASS (class)->local_command_line = test_local_cmdline;
 //
 //	  ...
 //	}
 //
-// ```
-//
 // In this example of split commandline handling, options that start
 // with `--local-` are handled locally, all other options are passed
-// to the [signal@Gio.Application::command-line] handler which runs in the primary
+// to the [Application.ConnectCommandLineFunc] handler which runs in the primary
 // instance.
 //
 // The complete example can be found here:
-// [gapplication-example-cmdline2.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline2.c)
+// [gapplication-example-cmdline2.c]
 //
 // If handling the commandline requires a lot of work, it may be better to defer it.
 //
-// ```c
-// static gboolean
-// my_cmdline_handler (gpointer data)
-//
+//	static gboolean
+//	my_cmdline_handler (gpointer data)
 //	{
 //	  GApplicationCommandLine *cmdline = data;
 //
@@ -294,11 +285,9 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 //	  return G_SOURCE_REMOVE;
 //	}
 //
-// static int
-// command_line (GApplication            *application,
-//
-//	GApplicationCommandLine *cmdline)
-//
+//	static int
+//	command_line (GApplication            *application,
+//	              GApplicationCommandLine *cmdline)
 //	{
 //	  // keep the application running until we are done with this commandline
 //	  g_application_hold (application);
@@ -313,16 +302,18 @@ func (x *ApplicationCommandLinePrivate) GoPointer() uintptr {
 //	  return 0;
 //	}
 //
-// ```
-//
 // In this example the commandline is not completely handled before
-// the [signal@Gio.Application::command-line] handler returns. Instead, we keep
+// the [Application.ConnectCommandLineFunc] handler returns. Instead, we keep
 // a reference to the `GApplicationCommandLine` object and handle it
 // later (in this example, in an idle). Note that it is necessary to
 // hold the application until you are done with the commandline.
 //
 // The complete example can be found here:
-// [gapplication-example-cmdline3.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline3.c)
+// [gapplication-example-cmdline3.c]
+//
+// [gapplication-example-cmdline3.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline3.c
+// [gapplication-example-cmdline.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline.c
+// [gapplication-example-cmdline2.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gapplication-example-cmdline2.c
 type ApplicationCommandLine struct {
 	gobject.Object
 }
@@ -341,10 +332,10 @@ func ApplicationCommandLineNewFromInternalPtr(ptr uintptr) *ApplicationCommandLi
 
 var xApplicationCommandLineCreateFileForArg func(uintptr, string) uintptr
 
-// Creates a #GFile corresponding to a filename that was given as part
-// of the invocation of @cmdline.
+// Creates a [File] corresponding to a filename that was given as part
+// of the invocation of cmdline.
 //
-// This differs from g_file_new_for_commandline_arg() in that it
+// This differs from [FileNewForCommandlineArg] in that it
 // resolves relative pathnames using the current working directory of
 // the invoking process rather than the local process.
 func (x *ApplicationCommandLine) CreateFileForArg(ArgVar string) *FileBase {
@@ -368,13 +359,13 @@ var xApplicationCommandLineDone func(uintptr)
 //
 // For local invocation, it does nothing.
 //
-// This method should be called in the [signal@Gio.Application::command-line]
+// This method should be called in the [Application.ConnectCommandLineFunc]
 // handler, after the exit status is set and all messages are printed.
 //
-// After this call, g_application_command_line_set_exit_status() has no effect.
+// After this call, [ApplicationCommandLine.SetExitStatus] has no effect.
 // Subsequent calls to this method are no-ops.
 //
-// This method is automatically called when the #GApplicationCommandLine
+// This method is automatically called when the [ApplicationCommandLine]
 // object is disposed — so you can omit the call in non-garbage collected
 // languages.
 func (x *ApplicationCommandLine) Done() {
@@ -391,11 +382,11 @@ var xApplicationCommandLineGetArguments func(uintptr, *int) []string
 // filenames or arguments given in the system locale) but are always in
 // UTF-8 on Windows.
 //
-// If you wish to use the return value with #GOptionContext, you must
-// use g_option_context_parse_strv().
+// If you wish to use the return value with [glib.OptionContext], you must
+// use [glib.OptionContext.ParseStrv].
 //
-// The return value is %NULL-terminated and should be freed using
-// g_strfreev().
+// The return value is nil-terminated and should be freed using
+// [glib.Strfreev].
 func (x *ApplicationCommandLine) GetArguments(ArgcVar *int) []string {
 
 	cret := xApplicationCommandLineGetArguments(x.GoPointer(), ArgcVar)
@@ -408,10 +399,10 @@ var xApplicationCommandLineGetCwd func(uintptr) string
 // The string may contain non-utf8 data.
 //
 // It is possible that the remote application did not send a working
-// directory, so this may be %NULL.
+// directory, so this may be nil.
 //
 // The return value should not be modified or freed and is valid for as
-// long as @cmdline exists.
+// long as cmdline exists.
 func (x *ApplicationCommandLine) GetCwd() string {
 
 	cret := xApplicationCommandLineGetCwd(x.GoPointer())
@@ -421,19 +412,19 @@ func (x *ApplicationCommandLine) GetCwd() string {
 var xApplicationCommandLineGetEnviron func(uintptr) []string
 
 // Gets the contents of the 'environ' variable of the command line
-// invocation, as would be returned by g_get_environ(), ie as a
-// %NULL-terminated list of strings in the form 'NAME=VALUE'.
+// invocation, as would be returned by [glib.GetEnviron], ie as a
+// nil-terminated list of strings in the form 'NAME=VALUE'.
 // The strings may contain non-utf8 data.
 //
 // The remote application usually does not send an environment.  Use
-// %G_APPLICATION_SEND_ENVIRONMENT to affect that.  Even with this flag
+// [GApplicationSendEnvironmentValue] to affect that.  Even with this flag
 // set it is possible that the environment is still not available (due
 // to invocation messages from other applications).
 //
 // The return value should not be modified or freed and is valid for as
-// long as @cmdline exists.
+// long as cmdline exists.
 //
-// See g_application_command_line_getenv() if you are only interested
+// See [ApplicationCommandLine.Getenv] if you are only interested
 // in the value of a single environment variable.
 func (x *ApplicationCommandLine) GetEnviron() []string {
 
@@ -443,8 +434,8 @@ func (x *ApplicationCommandLine) GetEnviron() []string {
 
 var xApplicationCommandLineGetExitStatus func(uintptr) int
 
-// Gets the exit status of @cmdline.  See
-// g_application_command_line_set_exit_status() for more information.
+// Gets the exit status of cmdline.  See
+// [ApplicationCommandLine.SetExitStatus] for more information.
 func (x *ApplicationCommandLine) GetExitStatus() int {
 
 	cret := xApplicationCommandLineGetExitStatus(x.GoPointer())
@@ -453,7 +444,7 @@ func (x *ApplicationCommandLine) GetExitStatus() int {
 
 var xApplicationCommandLineGetIsRemote func(uintptr) bool
 
-// Determines if @cmdline represents a remote invocation.
+// Determines if cmdline represents a remote invocation.
 func (x *ApplicationCommandLine) GetIsRemote() bool {
 
 	cret := xApplicationCommandLineGetIsRemote(x.GoPointer())
@@ -465,12 +456,12 @@ var xApplicationCommandLineGetOptionsDict func(uintptr) *glib.VariantDict
 // Gets the options that were passed to g_application_command_line().
 //
 // If you did not override local_command_line() then these are the same
-// options that were parsed according to the #GOptionEntrys added to the
-// application with g_application_add_main_option_entries() and possibly
+// options that were parsed according to the GOptionEntrys added to the
+// application with [Application.AddMainOptionEntries] and possibly
 // modified from your GApplication::handle-local-options handler.
 //
 // If no options were sent then an empty dictionary is returned so that
-// you don't need to check for %NULL.
+// you don't need to check for nil.
 //
 // The data has been passed via an untrusted external process, so the types of
 // all values must be checked before being used.
@@ -482,9 +473,9 @@ func (x *ApplicationCommandLine) GetOptionsDict() *glib.VariantDict {
 
 var xApplicationCommandLineGetPlatformData func(uintptr) *glib.Variant
 
-// Gets the platform data associated with the invocation of @cmdline.
+// Gets the platform data associated with the invocation of cmdline.
 //
-// This is a #GVariant dictionary containing information about the
+// This is a [glib.Variant] dictionary containing information about the
 // context in which the invocation occurred.  It typically contains
 // information like the current working directory and the startup
 // notification ID.
@@ -492,7 +483,7 @@ var xApplicationCommandLineGetPlatformData func(uintptr) *glib.Variant
 // It comes from an untrusted external process and hence the types of all
 // values must be validated before being used.
 //
-// For local invocation, it will be %NULL.
+// For local invocation, it will be nil.
 func (x *ApplicationCommandLine) GetPlatformData() *glib.Variant {
 
 	cret := xApplicationCommandLineGetPlatformData(x.GoPointer())
@@ -503,11 +494,11 @@ var xApplicationCommandLineGetStdin func(uintptr) uintptr
 
 // Gets the stdin of the invoking process.
 //
-// The #GInputStream can be used to read data passed to the standard
+// The [InputStream] can be used to read data passed to the standard
 // input of the invoking process.
 // This doesn't work on all platforms.  Presently, it is only available
 // on UNIX when using a D-Bus daemon capable of passing file descriptors.
-// If stdin is not available then %NULL will be returned.  In the
+// If stdin is not available then nil will be returned.  In the
 // future, support may be expanded to other platforms.
 //
 // You must only call this function once per commandline invocation.
@@ -527,16 +518,16 @@ func (x *ApplicationCommandLine) GetStdin() *InputStream {
 var xApplicationCommandLineGetenv func(uintptr, string) string
 
 // Gets the value of a particular environment variable of the command
-// line invocation, as would be returned by g_getenv().  The strings may
+// line invocation, as would be returned by [glib.Getenv].  The strings may
 // contain non-utf8 data.
 //
 // The remote application usually does not send an environment.  Use
-// %G_APPLICATION_SEND_ENVIRONMENT to affect that.  Even with this flag
+// [GApplicationSendEnvironmentValue] to affect that.  Even with this flag
 // set it is possible that the environment is still not available (due
 // to invocation messages from other applications).
 //
 // The return value should not be modified or freed and is valid for as
-// long as @cmdline exists.
+// long as cmdline exists.
 func (x *ApplicationCommandLine) Getenv(NameVar string) string {
 
 	cret := xApplicationCommandLineGetenv(x.GoPointer(), NameVar)
@@ -548,12 +539,14 @@ var xApplicationCommandLinePrint func(uintptr, string, ...interface{})
 // Formats a message and prints it using the stdout print handler in the
 // invoking process.
 //
-// If @cmdline is a local invocation then this is exactly equivalent to
-// g_print().  If @cmdline is remote then this is equivalent to calling
-// g_print() in the invoking process.
+// If cmdline is a local invocation then this is exactly equivalent to
+// [glib.Print].  If cmdline is remote then this is equivalent to calling
+// [glib.Print] in the invoking process.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *ApplicationCommandLine) Print(FormatVar string, varArgs ...interface{}) {
 
-	xApplicationCommandLinePrint(x.GoPointer(), FormatVar, varArgs...)
+	xApplicationCommandLinePrint(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
@@ -561,8 +554,8 @@ var xApplicationCommandLinePrintLiteral func(uintptr, string)
 
 // Prints a message using the stdout print handler in the invoking process.
 //
-// Unlike g_application_command_line_print(), @message is not a `printf()`-style
-// format string. Use this function if @message contains text you don't have
+// Unlike [ApplicationCommandLine.Print], message is not a `printf()`-style
+// format string. Use this function if message contains text you don't have
 // control over, that could include `printf()` escape sequences.
 func (x *ApplicationCommandLine) PrintLiteral(MessageVar string) {
 
@@ -575,12 +568,14 @@ var xApplicationCommandLinePrinterr func(uintptr, string, ...interface{})
 // Formats a message and prints it using the stderr print handler in the
 // invoking process.
 //
-// If @cmdline is a local invocation then this is exactly equivalent to
-// g_printerr().  If @cmdline is remote then this is equivalent to
-// calling g_printerr() in the invoking process.
+// If cmdline is a local invocation then this is exactly equivalent to
+// [glib.Printerr].  If cmdline is remote then this is equivalent to
+// calling [glib.Printerr] in the invoking process.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *ApplicationCommandLine) Printerr(FormatVar string, varArgs ...interface{}) {
 
-	xApplicationCommandLinePrinterr(x.GoPointer(), FormatVar, varArgs...)
+	xApplicationCommandLinePrinterr(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
@@ -588,8 +583,8 @@ var xApplicationCommandLinePrinterrLiteral func(uintptr, string)
 
 // Prints a message using the stderr print handler in the invoking process.
 //
-// Unlike g_application_command_line_printerr(), @message is not
-// a `printf()`-style format string. Use this function if @message contains text
+// Unlike [ApplicationCommandLine.Printerr], message is not
+// a `printf()`-style format string. Use this function if message contains text
 // you don't have control over, that could include `printf()` escape sequences.
 func (x *ApplicationCommandLine) PrinterrLiteral(MessageVar string) {
 
@@ -602,14 +597,14 @@ var xApplicationCommandLineSetExitStatus func(uintptr, int)
 // Sets the exit status that will be used when the invoking process
 // exits.
 //
-// The return value of the #GApplication::command-line signal is
+// The return value of the [Application.ConnectCommandLineFunc] signal is
 // passed to this function when the handler returns.  This is the usual
 // way of setting the exit status.
 //
 // In the event that you want the remote invocation to continue running
 // and want to decide on the exit status in the future, you can use this
 // call.  For the case of a remote invocation, the remote process will
-// typically exit when the last reference is dropped on @cmdline.  The
+// typically exit when the last reference is dropped on cmdline.  The
 // exit status of the remote process will be equal to the last value
 // that was set with this function.
 //
@@ -619,9 +614,9 @@ var xApplicationCommandLineSetExitStatus func(uintptr, int)
 // increased to a non-zero value) then the application is considered to
 // have been 'successful' in a certain sense, and the exit status is
 // always zero.  If the application use count is zero, though, the exit
-// status of the local #GApplicationCommandLine is used.
+// status of the local [ApplicationCommandLine] is used.
 //
-// This method is a no-op if g_application_command_line_done() has
+// This method is a no-op if [ApplicationCommandLine.Done] has
 // been called.
 func (x *ApplicationCommandLine) SetExitStatus(ExitStatusVar int) {
 
@@ -641,7 +636,7 @@ func (c *ApplicationCommandLine) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyArguments sets the "arguments" property.
-// The commandline that caused this [signal@Gio.Application::command-line]
+// The commandline that caused this [Application.ConnectCommandLineFunc]
 // signal emission.
 func (x *ApplicationCommandLine) SetPropertyArguments(value uintptr) {
 	var v gobject.Value
@@ -681,7 +676,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -690,21 +685,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationCommandLineGLibType, libs, "g_application_command_line_get_type")
 
-	core.PuregoSafeRegister(&xApplicationCommandLineCreateFileForArg, libs, "g_application_command_line_create_file_for_arg")
-	core.PuregoSafeRegister(&xApplicationCommandLineDone, libs, "g_application_command_line_done")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetArguments, libs, "g_application_command_line_get_arguments")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetCwd, libs, "g_application_command_line_get_cwd")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetEnviron, libs, "g_application_command_line_get_environ")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetExitStatus, libs, "g_application_command_line_get_exit_status")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetIsRemote, libs, "g_application_command_line_get_is_remote")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetOptionsDict, libs, "g_application_command_line_get_options_dict")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetPlatformData, libs, "g_application_command_line_get_platform_data")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetStdin, libs, "g_application_command_line_get_stdin")
-	core.PuregoSafeRegister(&xApplicationCommandLineGetenv, libs, "g_application_command_line_getenv")
-	core.PuregoSafeRegister(&xApplicationCommandLinePrint, libs, "g_application_command_line_print")
-	core.PuregoSafeRegister(&xApplicationCommandLinePrintLiteral, libs, "g_application_command_line_print_literal")
-	core.PuregoSafeRegister(&xApplicationCommandLinePrinterr, libs, "g_application_command_line_printerr")
-	core.PuregoSafeRegister(&xApplicationCommandLinePrinterrLiteral, libs, "g_application_command_line_printerr_literal")
-	core.PuregoSafeRegister(&xApplicationCommandLineSetExitStatus, libs, "g_application_command_line_set_exit_status")
+	gobject.CheckClassSize("GApplicationCommandLineClass", xApplicationCommandLineGLibType, unsafe.Sizeof(ApplicationCommandLineClass{}))
+
+	core.PuregoRegisterSince(&xApplicationCommandLineCreateFileForArg, libs, "g_application_command_line_create_file_for_arg", "GIO", "2.36")
+	core.PuregoRegisterSince(&xApplicationCommandLineDone, libs, "g_application_command_line_done", "GIO", "2.80")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetArguments, libs, "g_application_command_line_get_arguments", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetCwd, libs, "g_application_command_line_get_cwd", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetEnviron, libs, "g_application_command_line_get_environ", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetExitStatus, libs, "g_application_command_line_get_exit_status", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetIsRemote, libs, "g_application_command_line_get_is_remote", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetOptionsDict, libs, "g_application_command_line_get_options_dict", "GIO", "2.40")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetPlatformData, libs, "g_application_command_line_get_platform_data", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetStdin, libs, "g_application_command_line_get_stdin", "GIO", "2.34")
+	core.PuregoRegisterSince(&xApplicationCommandLineGetenv, libs, "g_application_command_line_getenv", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLinePrint, libs, "g_application_command_line_print", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLinePrintLiteral, libs, "g_application_command_line_print_literal", "GIO", "2.80")
+	core.PuregoRegisterSince(&xApplicationCommandLinePrinterr, libs, "g_application_command_line_printerr", "GIO", "2.28")
+	core.PuregoRegisterSince(&xApplicationCommandLinePrinterrLiteral, libs, "g_application_command_line_printerr_literal", "GIO", "2.80")
+	core.PuregoRegisterSince(&xApplicationCommandLineSetExitStatus, libs, "g_application_command_line_set_exit_status", "GIO", "2.28")
 
 }
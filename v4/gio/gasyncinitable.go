@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -17,7 +16,7 @@ import (
 type AsyncInitableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xInitAsync uintptr
 
@@ -34,7 +33,8 @@ func (x *AsyncInitableIface) OverrideInitAsync(cb func(AsyncInitable, int, *Canc
 	if cb == nil {
 		x.xInitAsync = 0
 	} else {
-		x.xInitAsync = purego.NewCallback(func(InitableVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xInitAsync = core.NewCallback(func(InitableVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&AsyncInitableBase{Ptr: InitableVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -47,7 +47,7 @@ func (x *AsyncInitableIface) GetInitAsync() func(AsyncInitable, int, *Cancellabl
 		return nil
 	}
 	var rawCallback func(InitableVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInitAsync)
+	core.RegisterFunc(&rawCallback, x.xInitAsync)
 	return func(InitableVar AsyncInitable, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(InitableVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -59,7 +59,8 @@ func (x *AsyncInitableIface) OverrideInitFinish(cb func(AsyncInitable, AsyncResu
 	if cb == nil {
 		x.xInitFinish = 0
 	} else {
-		x.xInitFinish = purego.NewCallback(func(InitableVarp uintptr, ResVarp uintptr) bool {
+		x.xInitFinish = core.NewCallback(func(InitableVarp uintptr, ResVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AsyncInitableBase{Ptr: InitableVarp}, &AsyncResultBase{Ptr: ResVarp})
 		})
 	}
@@ -72,7 +73,7 @@ func (x *AsyncInitableIface) GetInitFinish() func(AsyncInitable, AsyncResult) bo
 		return nil
 	}
 	var rawCallback func(InitableVarp uintptr, ResVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xInitFinish)
+	core.RegisterFunc(&rawCallback, x.xInitFinish)
 	return func(InitableVar AsyncInitable, ResVar AsyncResult) bool {
 		return rawCallback(InitableVar.GoPointer(), ResVar.GoPointer())
 	}
@@ -80,7 +81,7 @@ func (x *AsyncInitableIface) GetInitFinish() func(AsyncInitable, AsyncResult) bo
 
 // `GAsyncInitable` is an interface for asynchronously initializable objects.
 //
-// This is the asynchronous version of [iface@Gio.Initable]; it behaves the same
+// This is the asynchronous version of [Initable]; it behaves the same
 // in all ways except that initialization is asynchronous. For more details
 // see the descriptions on `GInitable`.
 //
@@ -88,52 +89,47 @@ func (x *AsyncInitableIface) GetInitFinish() func(AsyncInitable, AsyncResult) bo
 //
 // Users of objects implementing this are not intended to use the interface
 // method directly; instead it will be used automatically in various ways.
-// For C applications you generally just call [func@Gio.AsyncInitable.new_async]
+// For C applications you generally just call AsyncInitable.new_async
 // directly, or indirectly via a foo_thing_new_async() wrapper. This will call
-// [method@Gio.AsyncInitable.init_async] under the covers, calling back with `NULL`
+// [AsyncInitable.InitAsync] under the covers, calling back with `NULL`
 // and a set `GError` on failure.
 //
 // A typical implementation might look something like this:
 //
-// ```c
-//
 //	enum {
 //	   NOT_INITIALIZED,
 //	   INITIALIZING,
 //	   INITIALIZED
 //	};
 //
-// static void
-// _foo_ready_cb (Foo *self)
-//
+//	static void
+//	_foo_ready_cb (Foo *self)
 //	{
 //	  GList *l;
 //
-//	  self-&gt;priv-&gt;state = INITIALIZED;
+//	  self->priv->state = INITIALIZED;
 //
-//	  for (l = self-&gt;priv-&gt;init_results; l != NULL; l = l-&gt;next)
+//	  for (l = self->priv->init_results; l != NULL; l = l->next)
 //	    {
-//	      GTask *task = l-&gt;data;
+//	      GTask *task = l->data;
 //
-//	      if (self-&gt;priv-&gt;success)
+//	      if (self->priv->success)
 //	        g_task_return_boolean (task, TRUE);
 //	      else
 //	        g_task_return_new_error (task, ...);
 //	      g_object_unref (task);
 //	    }
 //
-//	  g_list_free (self-&gt;priv-&gt;init_results);
-//	  self-&gt;priv-&gt;init_results = NULL;
+//	  g_list_free (self->priv->init_results);
+//	  self->priv->init_results = NULL;
 //	}
 //
-// static void
-// foo_init_async (GAsyncInitable       *initable,
-//
-//	int                   io_priority,
-//	GCancellable         *cancellable,
-//	GAsyncReadyCallback   callback,
-//	gpointer              user_data)
-//
+//	static void
+//	foo_init_async (GAsyncInitable       *initable,
+//	                int                   io_priority,
+//	                GCancellable         *cancellable,
+//	                GAsyncReadyCallback   callback,
+//	                gpointer              user_data)
 //	{
 //	  Foo *self = FOO (initable);
 //	  GTask *task;
@@ -141,20 +137,20 @@ func (x *AsyncInitableIface) GetInitFinish() func(AsyncInitable, AsyncResult) bo
 //	  task = g_task_new (initable, cancellable, callback, user_data);
 //	  g_task_set_name (task, G_STRFUNC);
 //
-//	  switch (self-&gt;priv-&gt;state)
+//	  switch (self->priv->state)
 //	    {
 //	      case NOT_INITIALIZED:
 //	        _foo_get_ready (self);
-//	        self-&gt;priv-&gt;init_results = g_list_append (self-&gt;priv-&gt;init_results,
+//	        self->priv->init_results = g_list_append (self->priv->init_results,
 //	                                                  task);
-//	        self-&gt;priv-&gt;state = INITIALIZING;
+//	        self->priv->state = INITIALIZING;
 //	        break;
 //	      case INITIALIZING:
-//	        self-&gt;priv-&gt;init_results = g_list_append (self-&gt;priv-&gt;init_results,
+//	        self->priv->init_results = g_list_append (self->priv->init_results,
 //	                                                  task);
 //	        break;
 //	      case INITIALIZED:
-//	        if (!self-&gt;priv-&gt;success)
+//	        if (!self->priv->success)
 //	          g_task_return_new_error (task, ...);
 //	        else
 //	          g_task_return_boolean (task, TRUE);
@@ -163,31 +159,25 @@ func (x *AsyncInitableIface) GetInitFinish() func(AsyncInitable, AsyncResult) bo
 //	    }
 //	}
 //
-// static gboolean
-// foo_init_finish (GAsyncInitable       *initable,
-//
-//	GAsyncResult         *result,
-//	GError              **error)
-//
+//	static gboolean
+//	foo_init_finish (GAsyncInitable       *initable,
+//	                 GAsyncResult         *result,
+//	                 GError              **error)
 //	{
 //	  g_return_val_if_fail (g_task_is_valid (result, initable), FALSE);
 //
 //	  return g_task_propagate_boolean (G_TASK (result), error);
 //	}
 //
-// static void
-// foo_async_initable_iface_init (gpointer g_iface,
-//
-//	gpointer data)
-//
+//	static void
+//	foo_async_initable_iface_init (gpointer g_iface,
+//	                               gpointer data)
 //	{
 //	  GAsyncInitableIface *iface = g_iface;
 //
-//	  iface-&gt;init_async = foo_init_async;
-//	  iface-&gt;init_finish = foo_init_finish;
+//	  iface->init_async = foo_init_async;
+//	  iface->init_finish = foo_init_finish;
 //	}
-//
-// ```
 type AsyncInitable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -217,63 +207,110 @@ func (x *AsyncInitableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementAsyncInitable adds the AsyncInitable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - InitAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - InitFinish(AsyncResult) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AsyncInitableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAsyncInitable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AsyncInitableGLibType(), func(iface *AsyncInitableIface) {
+		if _, ok := any(zero).(interface {
+			InitAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideInitAsync(func(self AsyncInitable, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					InitAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.InitAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ InitFinish(AsyncResult) bool }); ok {
+			iface.OverrideInitFinish(func(self AsyncInitable, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ InitFinish(AsyncResult) bool })
+				return impl.InitFinish(arg0)
+			})
+		}
+	})
+}
+
 // Starts asynchronous initialization of the object implementing the
 // interface. This must be done before any real use of the object after
-// initial construction. If the object also implements #GInitable you can
-// optionally call g_initable_init() instead.
+// initial construction. If the object also implements [Initable] you can
+// optionally call [Initable.Init] instead.
 //
 // This method is intended for language bindings. If writing in C,
 // g_async_initable_new_async() should typically be used instead.
 //
-// When the initialization is finished, @callback will be called. You can
-// then call g_async_initable_init_finish() to get the result of the
+// When the initialization is finished, callback will be called. You can
+// then call [AsyncInitable.InitFinish] to get the result of the
 // initialization.
 //
-// Implementations may also support cancellation. If @cancellable is not
-// %NULL, then initialization can be cancelled by triggering the cancellable
+// Implementations may also support cancellation. If cancellable is not
+// nil, then initialization can be cancelled by triggering the cancellable
 // object from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL, and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil, and
 // the object doesn't support cancellable initialization, the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
-// As with #GInitable, if the object is not initialized, or initialization
+// As with [Initable], if the object is not initialized, or initialization
 // returns with an error, then all operations on the object except
-// g_object_ref() and g_object_unref() are considered to be invalid, and
+// [gobject.Object.Ref] and [gobject.Object.Unref] are considered to be invalid, and
 // have undefined behaviour. They will often fail with g_critical() or
 // g_warning(), but this must not be relied on.
 //
-// Callers should not assume that a class which implements #GAsyncInitable can
-// be initialized multiple times; for more information, see g_initable_init().
+// Callers should not assume that a class which implements [AsyncInitable] can
+// be initialized multiple times; for more information, see [Initable.Init].
 // If a class explicitly supports being initialized multiple times,
 // implementation requires yielding all subsequent calls to init_async() on the
 // results of the first call.
 //
-// For classes that also support the #GInitable interface, the default
-// implementation of this method will run the g_initable_init() function
+// For classes that also support the [Initable] interface, the default
+// implementation of this method will run the [Initable.Init] function
 // in a thread, so if you want to support asynchronous initialization via
-// threads, just implement the #GAsyncInitable interface without overriding
+// threads, just implement the [AsyncInitable] interface without overriding
 // any interface methods.
 func (x *AsyncInitableBase) InitAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGAsyncInitableInitAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGAsyncInitableInitAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes asynchronous initialization and returns the result.
-// See g_async_initable_init_async().
+// See [AsyncInitable.InitAsync].
 func (x *AsyncInitableBase) InitFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAsyncInitableInitFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Finishes the async construction for the various g_async_initable_new
-// calls, returning the created object or %NULL on error.
+// calls, returning the created object or nil on error.
 func (x *AsyncInitableBase) NewFinish(ResVar AsyncResult) (*gobject.Object, error) {
 	var cls *gobject.Object
 	var cerr *glib.Error
@@ -281,12 +318,15 @@ func (x *AsyncInitableBase) NewFinish(ResVar AsyncResult) (*gobject.Object, erro
 	cret := XGAsyncInitableNewFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gobject.Object{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -296,53 +336,24 @@ var XGAsyncInitableInitAsync func(uintptr, int, uintptr, uintptr, uintptr)
 var XGAsyncInitableInitFinish func(uintptr, uintptr, **glib.Error) bool
 var XGAsyncInitableNewFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-var xAsyncInitableNewvAsync func(types.GType, uint, *gobject.Parameter, int, uintptr, uintptr, uintptr)
-
-// Helper function for constructing #GAsyncInitable object. This is
-// similar to g_object_newv() but also initializes the object asynchronously.
-//
-// When the initialization is finished, @callback will be called. You can
-// then call g_async_initable_new_finish() to get the new object and check
-// for any errors.
-func AsyncInitableNewvAsync(ObjectTypeVar types.GType, NParametersVar uint, ParametersVar *gobject.Parameter, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
-
-	var CallbackVarRef uintptr
-	if CallbackVar != nil {
-		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
-		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
-			CallbackVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
-				cbFn := *CallbackVar
-				cbFn(arg0, arg1, arg2)
-			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
-		}
-	}
-
-	xAsyncInitableNewvAsync(ObjectTypeVar, NParametersVar, ParametersVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
-
-}
-
 func init() {
 	core.SetPackageName("GIO", "gio-2.0")
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAsyncInitableNewvAsync, libs, "g_async_initable_newv_async")
-
 	core.PuregoSafeRegister(&xAsyncInitableGLibType, libs, "g_async_initable_get_type")
 
-	core.PuregoSafeRegister(&XGAsyncInitableInitAsync, libs, "g_async_initable_init_async")
-	core.PuregoSafeRegister(&XGAsyncInitableInitFinish, libs, "g_async_initable_init_finish")
-	core.PuregoSafeRegister(&XGAsyncInitableNewFinish, libs, "g_async_initable_new_finish")
+	gobject.CheckClassSize("GAsyncInitableIface", xAsyncInitableGLibType, unsafe.Sizeof(AsyncInitableIface{}))
+
+	core.PuregoRegisterSince(&XGAsyncInitableInitAsync, libs, "g_async_initable_init_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGAsyncInitableInitFinish, libs, "g_async_initable_init_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGAsyncInitableNewFinish, libs, "g_async_initable_new_finish", "GIO", "2.22")
 
 }
@@ -0,0 +1,62 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xAsyncInitableNewvAsync func(types.GType, uint, *gobject.Parameter, int, uintptr, uintptr, uintptr)
+
+// Helper function for constructing [AsyncInitable] object. This is
+// similar to [gobject.NewObjectv] but also initializes the object asynchronously.
+//
+// When the initialization is finished, callback will be called. You can
+// then call [AsyncInitable.NewFinish] to get the new object and check
+// for any errors.
+//
+// Deprecated: since 2.54. Use [gobject.NewObjectWithProperties] and
+// [AsyncInitable.InitAsync] instead. See [gobject.Parameter] for more information.
+func AsyncInitableNewvAsync(ObjectTypeVar types.GType, NParametersVar uint, ParametersVar *gobject.Parameter, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xAsyncInitableNewvAsync(ObjectTypeVar, NParametersVar, ParametersVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xAsyncInitableNewvAsync, libs, "g_async_initable_newv_async", "GIO", "2.22")
+
+}
@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Interface definition for [iface@Gio.AsyncResult].
+// Interface definition for [AsyncResult].
 type AsyncResultIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetUserData uintptr
 
@@ -35,7 +34,8 @@ func (x *AsyncResultIface) OverrideGetUserData(cb func(AsyncResult) uintptr) {
 	if cb == nil {
 		x.xGetUserData = 0
 	} else {
-		x.xGetUserData = purego.NewCallback(func(ResVarp uintptr) uintptr {
+		x.xGetUserData = core.NewCallback(func(ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			return cb(&AsyncResultBase{Ptr: ResVarp})
 		})
 	}
@@ -48,7 +48,7 @@ func (x *AsyncResultIface) GetGetUserData() func(AsyncResult) uintptr {
 		return nil
 	}
 	var rawCallback func(ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetUserData)
+	core.RegisterFunc(&rawCallback, x.xGetUserData)
 	return func(ResVar AsyncResult) uintptr {
 		return rawCallback(ResVar.GoPointer())
 	}
@@ -60,7 +60,8 @@ func (x *AsyncResultIface) OverrideGetSourceObject(cb func(AsyncResult) *gobject
 	if cb == nil {
 		x.xGetSourceObject = 0
 	} else {
-		x.xGetSourceObject = purego.NewCallback(func(ResVarp uintptr) uintptr {
+		x.xGetSourceObject = core.NewCallback(func(ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -77,7 +78,7 @@ func (x *AsyncResultIface) GetGetSourceObject() func(AsyncResult) *gobject.Objec
 		return nil
 	}
 	var rawCallback func(ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetSourceObject)
+	core.RegisterFunc(&rawCallback, x.xGetSourceObject)
 	return func(ResVar AsyncResult) *gobject.Object {
 		rawRet := rawCallback(ResVar.GoPointer())
 		if rawRet == 0 {
@@ -95,7 +96,8 @@ func (x *AsyncResultIface) OverrideIsTagged(cb func(AsyncResult, uintptr) bool)
 	if cb == nil {
 		x.xIsTagged = 0
 	} else {
-		x.xIsTagged = purego.NewCallback(func(ResVarp uintptr, SourceTagVarp uintptr) bool {
+		x.xIsTagged = core.NewCallback(func(ResVarp uintptr, SourceTagVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AsyncResultBase{Ptr: ResVarp}, SourceTagVarp)
 		})
 	}
@@ -108,7 +110,7 @@ func (x *AsyncResultIface) GetIsTagged() func(AsyncResult, uintptr) bool {
 		return nil
 	}
 	var rawCallback func(ResVarp uintptr, SourceTagVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsTagged)
+	core.RegisterFunc(&rawCallback, x.xIsTagged)
 	return func(ResVar AsyncResult, SourceTagVar uintptr) bool {
 		return rawCallback(ResVar.GoPointer(), SourceTagVar)
 	}
@@ -122,18 +124,18 @@ func (x *AsyncResultIface) GetIsTagged() func(AsyncResult, uintptr) bool {
 // asynchronous function. This callback will be triggered when the
 // operation has completed, and must be run in a later iteration of
 // the thread-default main context (see
-// [method@GLib.MainContext.push_thread_default]) from where the operation was
+// [glib.MainContext.PushThreadDefault]) from where the operation was
 // initiated. It will be passed a `GAsyncResult` instance filled with the
 // details of the operation's success or failure, the object the asynchronous
 // function was started for and any error codes returned. The asynchronous
 // callback function is then expected to call the corresponding `_finish()`
 // function, passing the object the function was called for, the
-// `GAsyncResult` instance, and (optionally) an @error to grab any
+// `GAsyncResult` instance, and (optionally) an error to grab any
 // error conditions that may have occurred.
 //
 // The `_finish()` function for an operation takes the generic result
 // (of type `GAsyncResult`) and returns the specific result that the
-// operation in question yields (e.g. a [class@Gio.FileEnumerator] for a
+// operation in question yields (e.g. a [FileEnumerator] for a
 // "enumerate children" operation). If the result or error status of the
 // operation is not needed, there is no need to call the `_finish()`
 // function; GIO will take care of cleaning up the result and error
@@ -145,24 +147,19 @@ func (x *AsyncResultIface) GetIsTagged() func(AsyncResult, uintptr) bool {
 //
 // Example of a typical asynchronous operation flow:
 //
-// ```c
-// void _theoretical_frobnitz_async (Theoretical         *t,
+//	void _theoretical_frobnitz_async (Theoretical         *t,
+//	                                  GCancellable        *c,
+//	                                  GAsyncReadyCallback  cb,
+//	                                  gpointer             u);
 //
-//	GCancellable        *c,
-//	GAsyncReadyCallback  cb,
-//	gpointer             u);
-//
-// gboolean _theoretical_frobnitz_finish (Theoretical   *t,
-//
-//	GAsyncResult  *res,
-//	GError       **e);
-//
-// static void
-// frobnitz_result_func (GObject      *source_object,
-//
-//	GAsyncResult *res,
-//	gpointer      user_data)
+//	gboolean _theoretical_frobnitz_finish (Theoretical   *t,
+//	                                       GAsyncResult  *res,
+//	                                       GError       **e);
 //
+//	static void
+//	frobnitz_result_func (GObject      *source_object,
+//			 GAsyncResult *res,
+//			 gpointer      user_data)
 //	{
 //	  gboolean success = FALSE;
 //
@@ -175,10 +172,9 @@ func (x *AsyncResultIface) GetIsTagged() func(AsyncResult, uintptr) bool {
 //
 //	  ...
 //
-// }
-//
-// int main (int argc, void *argv[])
+//	}
 //
+//	int main (int argc, void *argv[])
 //	{
 //	   ...
 //
@@ -190,13 +186,11 @@ func (x *AsyncResultIface) GetIsTagged() func(AsyncResult, uintptr) bool {
 //	   ...
 //	}
 //
-// ```
-//
 // The callback for an asynchronous operation is called only once, and is
 // always called, even in the case of a cancelled operation. On cancellation
 // the result is a `G_IO_ERROR_CANCELLED` error.
 //
-// ## I/O Priority
+// # I/O Priority
 //
 // Many I/O-related asynchronous operations have a priority parameter,
 // which is used in certain cases to determine the order in which
@@ -235,7 +229,41 @@ func (x *AsyncResultBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the source object from a [iface@Gio.AsyncResult].
+// ImplementAsyncResult adds the AsyncResult interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetUserData() uintptr
+//   - GetSourceObject() *gobject.Object
+//   - IsTagged(uintptr) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AsyncResultIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAsyncResult[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AsyncResultGLibType(), func(iface *AsyncResultIface) {
+		if _, ok := any(zero).(interface{ GetUserData() uintptr }); ok {
+			iface.OverrideGetUserData(func(self AsyncResult) uintptr {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetUserData() uintptr })
+				return impl.GetUserData()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSourceObject() *gobject.Object }); ok {
+			iface.OverrideGetSourceObject(func(self AsyncResult) *gobject.Object {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSourceObject() *gobject.Object })
+				return impl.GetSourceObject()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsTagged(uintptr) bool }); ok {
+			iface.OverrideIsTagged(func(self AsyncResult, arg0 uintptr) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsTagged(uintptr) bool })
+				return impl.IsTagged(arg0)
+			})
+		}
+	})
+}
+
+// Gets the source object from a [AsyncResult].
 func (x *AsyncResultBase) GetSourceObject() *gobject.Object {
 	var cls *gobject.Object
 
@@ -249,29 +277,29 @@ func (x *AsyncResultBase) GetSourceObject() *gobject.Object {
 	return cls
 }
 
-// Gets the user data from a [iface@Gio.AsyncResult].
+// Gets the user data from a [AsyncResult].
 func (x *AsyncResultBase) GetUserData() uintptr {
 
 	cret := XGAsyncResultGetUserData(x.GoPointer())
 	return cret
 }
 
-// Checks if @res has the given @source_tag (generally a function
-// pointer indicating the function @res was created by).
+// Checks if res has the given source_tag (generally a function
+// pointer indicating the function res was created by).
 func (x *AsyncResultBase) IsTagged(SourceTagVar uintptr) bool {
 
 	cret := XGAsyncResultIsTagged(x.GoPointer(), SourceTagVar)
 	return cret
 }
 
-// If @res is a [class@Gio.SimpleAsyncResult], this is equivalent to
-// [method@Gio.SimpleAsyncResult.propagate_error]. Otherwise it returns
+// If res is a [SimpleAsyncResult], this is equivalent to
+// [SimpleAsyncResult.PropagateError]. Otherwise it returns
 // `FALSE`.
 //
 // This can be used for legacy error handling in async `*_finish()`
-// wrapper functions that traditionally handled [class@Gio.SimpleAsyncResult]
+// wrapper functions that traditionally handled [SimpleAsyncResult]
 // error returns themselves rather than calling into the virtual method.
-// This should not be used in new code; [iface@Gio.AsyncResult] errors that are
+// This should not be used in new code; [AsyncResult] errors that are
 // set by virtual methods should also be extracted by virtual methods,
 // to enable subclasses to chain up correctly.
 func (x *AsyncResultBase) LegacyPropagateError() (bool, error) {
@@ -279,7 +307,7 @@ func (x *AsyncResultBase) LegacyPropagateError() (bool, error) {
 
 	cret := XGAsyncResultLegacyPropagateError(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -295,7 +323,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -304,9 +332,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xAsyncResultGLibType, libs, "g_async_result_get_type")
 
-	core.PuregoSafeRegister(&XGAsyncResultGetSourceObject, libs, "g_async_result_get_source_object")
-	core.PuregoSafeRegister(&XGAsyncResultGetUserData, libs, "g_async_result_get_user_data")
-	core.PuregoSafeRegister(&XGAsyncResultIsTagged, libs, "g_async_result_is_tagged")
-	core.PuregoSafeRegister(&XGAsyncResultLegacyPropagateError, libs, "g_async_result_legacy_propagate_error")
+	gobject.CheckClassSize("GAsyncResultIface", xAsyncResultGLibType, unsafe.Sizeof(AsyncResultIface{}))
+
+	core.PuregoRegisterSince(&XGAsyncResultGetSourceObject, libs, "g_async_result_get_source_object", "GIO", "")
+	core.PuregoRegisterSince(&XGAsyncResultGetUserData, libs, "g_async_result_get_user_data", "GIO", "")
+	core.PuregoRegisterSince(&XGAsyncResultIsTagged, libs, "g_async_result_is_tagged", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGAsyncResultLegacyPropagateError, libs, "g_async_result_legacy_propagate_error", "GIO", "2.34")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -43,7 +42,8 @@ func (x *BufferedInputStreamClass) OverrideFill(cb func(*BufferedInputStream, in
 	if cb == nil {
 		x.xFill = 0
 	} else {
-		x.xFill = purego.NewCallback(func(StreamVarp uintptr, CountVarp int, CancellableVarp uintptr) int {
+		x.xFill = core.NewCallback(func(StreamVarp uintptr, CountVarp int, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(BufferedInputStreamNewFromInternalPtr(StreamVarp), CountVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -55,7 +55,7 @@ func (x *BufferedInputStreamClass) GetFill() func(*BufferedInputStream, int, *Ca
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CountVarp int, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xFill)
+	core.RegisterFunc(&rawCallback, x.xFill)
 	return func(StreamVar *BufferedInputStream, CountVar int, CancellableVar *Cancellable) int {
 		return rawCallback(StreamVar.GoPointer(), CountVar, CancellableVar.GoPointer())
 	}
@@ -66,7 +66,8 @@ func (x *BufferedInputStreamClass) OverrideFillAsync(cb func(*BufferedInputStrea
 	if cb == nil {
 		x.xFillAsync = 0
 	} else {
-		x.xFillAsync = purego.NewCallback(func(StreamVarp uintptr, CountVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xFillAsync = core.NewCallback(func(StreamVarp uintptr, CountVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(BufferedInputStreamNewFromInternalPtr(StreamVarp), CountVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -78,7 +79,7 @@ func (x *BufferedInputStreamClass) GetFillAsync() func(*BufferedInputStream, int
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CountVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFillAsync)
+	core.RegisterFunc(&rawCallback, x.xFillAsync)
 	return func(StreamVar *BufferedInputStream, CountVar int, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), CountVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -89,7 +90,8 @@ func (x *BufferedInputStreamClass) OverrideFillFinish(cb func(*BufferedInputStre
 	if cb == nil {
 		x.xFillFinish = 0
 	} else {
-		x.xFillFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+		x.xFillFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(BufferedInputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -101,7 +103,7 @@ func (x *BufferedInputStreamClass) GetFillFinish() func(*BufferedInputStream, As
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xFillFinish)
+	core.RegisterFunc(&rawCallback, x.xFillFinish)
 	return func(StreamVar *BufferedInputStream, ResultVar AsyncResult) int {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -112,7 +114,8 @@ func (x *BufferedInputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -124,7 +127,7 @@ func (x *BufferedInputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -135,7 +138,8 @@ func (x *BufferedInputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -147,7 +151,7 @@ func (x *BufferedInputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -158,7 +162,8 @@ func (x *BufferedInputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -170,7 +175,7 @@ func (x *BufferedInputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -181,7 +186,8 @@ func (x *BufferedInputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -193,7 +199,7 @@ func (x *BufferedInputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -204,7 +210,8 @@ func (x *BufferedInputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -216,7 +223,7 @@ func (x *BufferedInputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -230,18 +237,18 @@ func (x *BufferedInputStreamPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Buffered input stream implements [class@Gio.FilterInputStream] and provides
+// Buffered input stream implements [FilterInputStream] and provides
 // for buffered reads.
 //
 // By default, `GBufferedInputStream`'s buffer size is set at 4 kilobytes.
 //
-// To create a buffered input stream, use [ctor@Gio.BufferedInputStream.new],
-// or [ctor@Gio.BufferedInputStream.new_sized] to specify the buffer's size at
+// To create a buffered input stream, use [NewBufferedInputStream],
+// or [NewBufferedInputStreamSized] to specify the buffer's size at
 // construction.
 //
 // To get the size of a buffer within a buffered input stream, use
-// [method@Gio.BufferedInputStream.get_buffer_size]. To change the size of a
-// buffered input stream's buffer, use [method@Gio.BufferedInputStream.set_buffer_size].
+// [BufferedInputStream.GetBufferSize]. To change the size of a
+// buffered input stream's buffer, use [BufferedInputStream.SetBufferSize].
 // Note that the buffer's size cannot be reduced below the size of the data within the buffer.
 type BufferedInputStream struct {
 	FilterInputStream
@@ -261,7 +268,7 @@ func BufferedInputStreamNewFromInternalPtr(ptr uintptr) *BufferedInputStream {
 
 var xNewBufferedInputStream func(uintptr) uintptr
 
-// Creates a new [class@Gio.InputStream] from the given @base_stream, with
+// Creates a new [InputStream] from the given base_stream, with
 // a buffer set to the default size (4 kilobytes).
 func NewBufferedInputStream(BaseStreamVar *InputStream) *BufferedInputStream {
 	var cls *BufferedInputStream
@@ -278,8 +285,8 @@ func NewBufferedInputStream(BaseStreamVar *InputStream) *BufferedInputStream {
 
 var xNewBufferedInputStreamSized func(uintptr, uint) uintptr
 
-// Creates a new [class@Gio.BufferedInputStream] from the given @base_stream,
-// with a buffer set to @size.
+// Creates a new [BufferedInputStream] from the given base_stream,
+// with a buffer set to size.
 func NewBufferedInputStreamSized(BaseStreamVar *InputStream, SizeVar uint) *BufferedInputStream {
 	var cls *BufferedInputStream
 
@@ -295,37 +302,37 @@ func NewBufferedInputStreamSized(BaseStreamVar *InputStream, SizeVar uint) *Buff
 
 var xBufferedInputStreamFill func(uintptr, int, uintptr, **glib.Error) int
 
-// Tries to read @count bytes from the stream into the buffer.
+// Tries to read count bytes from the stream into the buffer.
 // Will block during this read.
 //
-// If @count is zero, returns zero and does nothing. A value of @count
+// If count is zero, returns zero and does nothing. A value of count
 // larger than `G_MAXSSIZE` will cause a
-// [error@Gio.IOErrorEnum.INVALID_ARGUMENT] error.
+// IOErrorEnum.INVALID_ARGUMENT error.
 //
 // On success, the number of bytes read into the buffer is returned.
 // It is not an error if this is not the same as the requested size, as it
 // can happen e.g. near the end of a file. Zero is returned on end of file
-// (or if @count is zero),  but never otherwise.
+// (or if count is zero),  but never otherwise.
 //
-// If @count is -1 then the attempted read size is equal to the number of
+// If count is -1 then the attempted read size is equal to the number of
 // bytes that are required to fill the buffer.
 //
-// If @cancellable is not `NULL`, then the operation can be cancelled by
+// If cancellable is not `NULL`, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error [error@Gio.IOErrorEnum.CANCELLED] will be returned.
+// was cancelled, the error IOErrorEnum.CANCELLED will be returned.
 // If an operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// On error `-1` is returned and @error is set accordingly.
+// On error `-1` is returned and error is set accordingly.
 //
 // For the asynchronous, non-blocking, version of this function, see
-// [method@Gio.BufferedInputStream.fill_async].
+// [BufferedInputStream.FillAsync].
 func (x *BufferedInputStream) Fill(CountVar int, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xBufferedInputStreamFill(x.GoPointer(), CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -333,11 +340,11 @@ func (x *BufferedInputStream) Fill(CountVar int, CancellableVar *Cancellable) (i
 
 var xBufferedInputStreamFillAsync func(uintptr, int, int, uintptr, uintptr, uintptr)
 
-// Reads data into @stream's buffer asynchronously, up to @count size.
-// @io_priority can be used to prioritize reads. For the synchronous
-// version of this function, see [method@Gio.BufferedInputStream.fill].
+// Reads data into stream's buffer asynchronously, up to count size.
+// io_priority can be used to prioritize reads. For the synchronous
+// version of this function, see [BufferedInputStream.Fill].
 //
-// If @count is `-1` then the attempted read size is equal to the number
+// If count is `-1` then the attempted read size is equal to the number
 // of bytes that are required to fill the buffer.
 func (x *BufferedInputStream) FillAsync(CountVar int, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -348,11 +355,12 @@ func (x *BufferedInputStream) FillAsync(CountVar int, IoPriorityVar int, Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -368,7 +376,7 @@ func (x *BufferedInputStream) FillFinish(ResultVar AsyncResult) (int, error) {
 
 	cret := xBufferedInputStreamFillFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -394,8 +402,8 @@ func (x *BufferedInputStream) GetBufferSize() uint {
 
 var xBufferedInputStreamPeek func(uintptr, []byte, uint, uint) uint
 
-// Peeks in the buffer, copying data of size @count into @buffer,
-// offset @offset bytes.
+// Peeks in the buffer, copying data of size count into buffer,
+// offset offset bytes.
 func (x *BufferedInputStream) Peek(BufferVar []byte, OffsetVar uint, CountVar uint) uint {
 
 	cret := xBufferedInputStreamPeek(x.GoPointer(), BufferVar, OffsetVar, CountVar)
@@ -419,21 +427,21 @@ var xBufferedInputStreamReadByte func(uintptr, uintptr, **glib.Error) int
 // during this read.
 //
 // On success, the byte read from the stream is returned. On end of stream
-// `-1` is returned but it's not an exceptional error and @error is not set.
+// `-1` is returned but it's not an exceptional error and error is not set.
 //
-// If @cancellable is not `NULL`, then the operation can be cancelled by
+// If cancellable is not `NULL`, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error [error@Gio.IOErrorEnum.CANCELLED] will be returned.
+// was cancelled, the error IOErrorEnum.CANCELLED will be returned.
 // If an operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// On error `-1` is returned and @error is set accordingly.
+// On error `-1` is returned and error is set accordingly.
 func (x *BufferedInputStream) ReadByte(CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xBufferedInputStreamReadByte(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -441,7 +449,7 @@ func (x *BufferedInputStream) ReadByte(CancellableVar *Cancellable) (int, error)
 
 var xBufferedInputStreamSetBufferSize func(uintptr, uint)
 
-// Sets the size of the internal buffer of @stream to @size, or to the
+// Sets the size of the internal buffer of stream to size, or to the
 // size of the contents of the buffer. The buffer can never be resized
 // smaller than its current contents.
 func (x *BufferedInputStream) SetBufferSize(SizeVar uint) {
@@ -478,7 +486,7 @@ func (x *BufferedInputStream) GetPropertyBufferSize() uint {
 	return v.GetUint()
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *BufferedInputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -486,14 +494,14 @@ func (x *BufferedInputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *BufferedInputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -504,15 +512,15 @@ func (x *BufferedInputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *BufferedInputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -525,13 +533,13 @@ func (x *BufferedInputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *BufferedInputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -539,7 +547,7 @@ func (x *BufferedInputStream) Truncate(OffsetVar int64, CancellableVar *Cancella
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -550,7 +558,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -559,17 +567,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xBufferedInputStreamGLibType, libs, "g_buffered_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewBufferedInputStream, libs, "g_buffered_input_stream_new")
-	core.PuregoSafeRegister(&xNewBufferedInputStreamSized, libs, "g_buffered_input_stream_new_sized")
-
-	core.PuregoSafeRegister(&xBufferedInputStreamFill, libs, "g_buffered_input_stream_fill")
-	core.PuregoSafeRegister(&xBufferedInputStreamFillAsync, libs, "g_buffered_input_stream_fill_async")
-	core.PuregoSafeRegister(&xBufferedInputStreamFillFinish, libs, "g_buffered_input_stream_fill_finish")
-	core.PuregoSafeRegister(&xBufferedInputStreamGetAvailable, libs, "g_buffered_input_stream_get_available")
-	core.PuregoSafeRegister(&xBufferedInputStreamGetBufferSize, libs, "g_buffered_input_stream_get_buffer_size")
-	core.PuregoSafeRegister(&xBufferedInputStreamPeek, libs, "g_buffered_input_stream_peek")
-	core.PuregoSafeRegister(&xBufferedInputStreamPeekBuffer, libs, "g_buffered_input_stream_peek_buffer")
-	core.PuregoSafeRegister(&xBufferedInputStreamReadByte, libs, "g_buffered_input_stream_read_byte")
-	core.PuregoSafeRegister(&xBufferedInputStreamSetBufferSize, libs, "g_buffered_input_stream_set_buffer_size")
+	gobject.CheckClassSize("GBufferedInputStreamClass", xBufferedInputStreamGLibType, unsafe.Sizeof(BufferedInputStreamClass{}))
+
+	core.PuregoRegisterSince(&xNewBufferedInputStream, libs, "g_buffered_input_stream_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewBufferedInputStreamSized, libs, "g_buffered_input_stream_new_sized", "GIO", "")
+
+	core.PuregoRegisterSince(&xBufferedInputStreamFill, libs, "g_buffered_input_stream_fill", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamFillAsync, libs, "g_buffered_input_stream_fill_async", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamFillFinish, libs, "g_buffered_input_stream_fill_finish", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamGetAvailable, libs, "g_buffered_input_stream_get_available", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamGetBufferSize, libs, "g_buffered_input_stream_get_buffer_size", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamPeek, libs, "g_buffered_input_stream_peek", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamPeekBuffer, libs, "g_buffered_input_stream_peek_buffer", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamReadByte, libs, "g_buffered_input_stream_read_byte", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedInputStreamSetBufferSize, libs, "g_buffered_input_stream_set_buffer_size", "GIO", "")
 
 }
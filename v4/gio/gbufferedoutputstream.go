@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +30,8 @@ func (x *BufferedOutputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -43,7 +43,7 @@ func (x *BufferedOutputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -54,7 +54,8 @@ func (x *BufferedOutputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -66,7 +67,7 @@ func (x *BufferedOutputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -80,18 +81,18 @@ func (x *BufferedOutputStreamPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Buffered output stream implements [class@Gio.FilterOutputStream] and provides
+// Buffered output stream implements [FilterOutputStream] and provides
 // for buffered writes.
 //
 // By default, `GBufferedOutputStream`'s buffer size is set at 4 kilobytes.
 //
-// To create a buffered output stream, use [ctor@Gio.BufferedOutputStream.new],
-// or [ctor@Gio.BufferedOutputStream.new_sized] to specify the buffer's size
+// To create a buffered output stream, use [NewBufferedOutputStream],
+// or [NewBufferedOutputStreamSized] to specify the buffer's size
 // at construction.
 //
 // To get the size of a buffer within a buffered input stream, use
-// [method@Gio.BufferedOutputStream.get_buffer_size]. To change the size of a
-// buffered output stream's buffer, use [method@Gio.BufferedOutputStream.set_buffer_size].
+// [BufferedOutputStream.GetBufferSize]. To change the size of a
+// buffered output stream's buffer, use [BufferedOutputStream.SetBufferSize].
 // Note that the buffer's size cannot be reduced below the size of the data within the buffer.
 type BufferedOutputStream struct {
 	FilterOutputStream
@@ -152,7 +153,7 @@ func (x *BufferedOutputStream) GetAutoGrow() bool {
 
 var xBufferedOutputStreamGetBufferSize func(uintptr) uint
 
-// Gets the size of the buffer in the @stream.
+// Gets the size of the buffer in the stream.
 func (x *BufferedOutputStream) GetBufferSize() uint {
 
 	cret := xBufferedOutputStreamGetBufferSize(x.GoPointer())
@@ -161,8 +162,8 @@ func (x *BufferedOutputStream) GetBufferSize() uint {
 
 var xBufferedOutputStreamSetAutoGrow func(uintptr, bool)
 
-// Sets whether or not the @stream's buffer should automatically grow.
-// If @auto_grow is true, then each write will just make the buffer
+// Sets whether or not the stream's buffer should automatically grow.
+// If auto_grow is true, then each write will just make the buffer
 // larger, and you must manually flush the buffer to actually write out
 // the data to the underlying stream.
 func (x *BufferedOutputStream) SetAutoGrow(AutoGrowVar bool) {
@@ -173,7 +174,7 @@ func (x *BufferedOutputStream) SetAutoGrow(AutoGrowVar bool) {
 
 var xBufferedOutputStreamSetBufferSize func(uintptr, uint)
 
-// Sets the size of the internal buffer to @size.
+// Sets the size of the internal buffer to size.
 func (x *BufferedOutputStream) SetBufferSize(SizeVar uint) {
 
 	xBufferedOutputStreamSetBufferSize(x.GoPointer(), SizeVar)
@@ -225,7 +226,7 @@ func (x *BufferedOutputStream) GetPropertyBufferSize() uint {
 	return v.GetUint()
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *BufferedOutputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -233,14 +234,14 @@ func (x *BufferedOutputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *BufferedOutputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -251,15 +252,15 @@ func (x *BufferedOutputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *BufferedOutputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -272,13 +273,13 @@ func (x *BufferedOutputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *BufferedOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -286,7 +287,7 @@ func (x *BufferedOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancell
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -297,7 +298,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -306,12 +307,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xBufferedOutputStreamGLibType, libs, "g_buffered_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewBufferedOutputStream, libs, "g_buffered_output_stream_new")
-	core.PuregoSafeRegister(&xNewBufferedOutputStreamSized, libs, "g_buffered_output_stream_new_sized")
+	gobject.CheckClassSize("GBufferedOutputStreamClass", xBufferedOutputStreamGLibType, unsafe.Sizeof(BufferedOutputStreamClass{}))
 
-	core.PuregoSafeRegister(&xBufferedOutputStreamGetAutoGrow, libs, "g_buffered_output_stream_get_auto_grow")
-	core.PuregoSafeRegister(&xBufferedOutputStreamGetBufferSize, libs, "g_buffered_output_stream_get_buffer_size")
-	core.PuregoSafeRegister(&xBufferedOutputStreamSetAutoGrow, libs, "g_buffered_output_stream_set_auto_grow")
-	core.PuregoSafeRegister(&xBufferedOutputStreamSetBufferSize, libs, "g_buffered_output_stream_set_buffer_size")
+	core.PuregoRegisterSince(&xNewBufferedOutputStream, libs, "g_buffered_output_stream_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewBufferedOutputStreamSized, libs, "g_buffered_output_stream_new_sized", "GIO", "")
+
+	core.PuregoRegisterSince(&xBufferedOutputStreamGetAutoGrow, libs, "g_buffered_output_stream_get_auto_grow", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedOutputStreamGetBufferSize, libs, "g_buffered_output_stream_get_buffer_size", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedOutputStreamSetAutoGrow, libs, "g_buffered_output_stream_set_auto_grow", "GIO", "")
+	core.PuregoRegisterSince(&xBufferedOutputStreamSetBufferSize, libs, "g_buffered_output_stream_set_buffer_size", "GIO", "")
 
 }
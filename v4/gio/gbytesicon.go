@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -32,11 +31,14 @@ var xNewBytesIcon func(*glib.Bytes) uintptr
 // Creates a new icon for a bytes.
 //
 // This cannot fail, but loading and interpreting the bytes may fail later on
-// (for example, if g_loadable_icon_load() is called) if the image is invalid.
-func NewBytesIcon(BytesVar *glib.Bytes) *BytesIcon {
+// (for example, if [LoadableIcon.Load] is called) if the image is invalid.
+func NewBytesIcon(BytesVar []byte) *BytesIcon {
 	var cls *BytesIcon
 
-	cret := xNewBytesIcon(BytesVar)
+	BytesVarBytes := glib.NewBytesFromSlice(BytesVar)
+	defer BytesVarBytes.Unref()
+
+	cret := xNewBytesIcon(BytesVarBytes)
 
 	if cret == 0 {
 		return nil
@@ -48,11 +50,11 @@ func NewBytesIcon(BytesVar *glib.Bytes) *BytesIcon {
 
 var xBytesIconGetBytes func(uintptr) *glib.Bytes
 
-// Gets the #GBytes associated with the given @icon.
-func (x *BytesIcon) GetBytes() *glib.Bytes {
+// Gets the [glib.Bytes] associated with the given icon.
+func (x *BytesIcon) GetBytes() []byte {
 
 	cret := xBytesIconGetBytes(x.GoPointer())
-	return cret
+	return cret.Data()
 }
 
 func (c *BytesIcon) GoPointer() uintptr {
@@ -97,10 +99,10 @@ func (x *BytesIcon) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *BytesIcon) Serialize() *glib.Variant {
 
@@ -108,21 +110,21 @@ func (x *BytesIcon) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *BytesIcon) ToString() string {
 
@@ -131,7 +133,7 @@ func (x *BytesIcon) ToString() string {
 }
 
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [LoadableIcon.LoadAsync].
 func (x *BytesIcon) Load(SizeVar int, TypeVar *string, CancellableVar *Cancellable) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -139,27 +141,30 @@ func (x *BytesIcon) Load(SizeVar int, TypeVar *string, CancellableVar *Cancellab
 	cret := XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [LoadableIcon.Load].
 func (x *BytesIcon) LoadAsync(SizeVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [LoadableIcon.LoadAsync].
 func (x *BytesIcon) LoadFinish(ResVar AsyncResult, TypeVar *string) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -167,12 +172,15 @@ func (x *BytesIcon) LoadFinish(ResVar AsyncResult, TypeVar *string) (*InputStrea
 	cret := XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -183,7 +191,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -192,8 +200,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xBytesIconGLibType, libs, "g_bytes_icon_get_type")
 
-	core.PuregoSafeRegister(&xNewBytesIcon, libs, "g_bytes_icon_new")
+	core.PuregoRegisterSince(&xNewBytesIcon, libs, "g_bytes_icon_new", "GIO", "2.38")
 
-	core.PuregoSafeRegister(&xBytesIconGetBytes, libs, "g_bytes_icon_get_bytes")
+	core.PuregoRegisterSince(&xBytesIconGetBytes, libs, "g_bytes_icon_get_bytes", "GIO", "2.38")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,7 +38,8 @@ func (x *CancellableClass) OverrideCancelled(cb func(*Cancellable)) {
 	if cb == nil {
 		x.xCancelled = 0
 	} else {
-		x.xCancelled = purego.NewCallback(func(CancellableVarp uintptr) {
+		x.xCancelled = core.NewCallback(func(CancellableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -51,7 +51,7 @@ func (x *CancellableClass) GetCancelled() func(*Cancellable) {
 		return nil
 	}
 	var rawCallback func(CancellableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCancelled)
+	core.RegisterFunc(&rawCallback, x.xCancelled)
 	return func(CancellableVar *Cancellable) {
 		rawCallback(CancellableVar.GoPointer())
 	}
@@ -62,7 +62,8 @@ func (x *CancellableClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -74,7 +75,7 @@ func (x *CancellableClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -85,7 +86,8 @@ func (x *CancellableClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -97,7 +99,7 @@ func (x *CancellableClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -108,7 +110,8 @@ func (x *CancellableClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -120,7 +123,7 @@ func (x *CancellableClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -131,7 +134,8 @@ func (x *CancellableClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -143,7 +147,7 @@ func (x *CancellableClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -154,7 +158,8 @@ func (x *CancellableClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -166,7 +171,7 @@ func (x *CancellableClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -203,13 +208,13 @@ func CancellableNewFromInternalPtr(ptr uintptr) *Cancellable {
 
 var xNewCancellable func() uintptr
 
-// Creates a new #GCancellable object.
+// Creates a new [Cancellable] object.
 //
 // Applications that want to start one or more operations
-// that should be cancellable should create a #GCancellable
+// that should be cancellable should create a [Cancellable]
 // and pass it to the operations.
 //
-// One #GCancellable can be used in multiple consecutive
+// One [Cancellable] can be used in multiple consecutive
 // operations or in multiple concurrent operations.
 func NewCancellable() *Cancellable {
 	var cls *Cancellable
@@ -226,69 +231,69 @@ func NewCancellable() *Cancellable {
 
 var xCancellableCancel func(uintptr)
 
-// Will set @cancellable to cancelled, and will emit the
-// #GCancellable::cancelled signal. (However, see the warning about
+// Will set cancellable to cancelled, and will emit the
+// [Cancellable.ConnectCancelledFunc] signal. (However, see the warning about
 // race conditions in the documentation for that signal if you are
 // planning to connect to it.)
 //
 // This function is thread-safe. In other words, you can safely call
 // it from a thread other than the one running the operation that was
-// passed the @cancellable.
+// passed the cancellable.
 //
-// If @cancellable is %NULL, this function returns immediately for convenience.
+// If cancellable is nil, this function returns immediately for convenience.
 //
 // The convention within GIO is that cancelling an asynchronous
 // operation causes it to complete asynchronously. That is, if you
 // cancel the operation from the same thread in which it is running,
-// then the operation's #GAsyncReadyCallback will not be invoked until
+// then the operation's [AsyncReadyCallback] will not be invoked until
 // the application returns to the main loop.
 //
 // It is safe (although useless, since it will be a no-op) to call
-// this function from a [signal@Gio.Cancellable::cancelled] signal handler.
+// this function from a [Cancellable.ConnectCancelledFunc] signal handler.
 func (x *Cancellable) Cancel() {
 
 	xCancellableCancel(x.GoPointer())
 
 }
 
-var xCancellableConnect func(uintptr, uintptr, uintptr, uintptr) uint
+var xCancellableConnect func(uintptr, uintptr, uintptr, uintptr) types.ULong
 
-// Convenience function to connect to the #GCancellable::cancelled
+// Convenience function to connect to the [Cancellable.ConnectCancelledFunc]
 // signal. Also handles the race condition that may happen
 // if the cancellable is cancelled right before connecting.
 //
-// @callback is called exactly once each time @cancellable is cancelled,
-// either directly at the time of the connect if @cancellable is already
-// cancelled, or when @cancellable is cancelled in some thread.
-// In case the cancellable is reset via [method@Gio.Cancellable.reset]
-// then the callback can be called again if the @cancellable is cancelled and
+// callback is called exactly once each time cancellable is cancelled,
+// either directly at the time of the connect if cancellable is already
+// cancelled, or when cancellable is cancelled in some thread.
+// In case the cancellable is reset via [Cancellable.Reset]
+// then the callback can be called again if the cancellable is cancelled and
 // if it had not been previously cancelled at the time
-// [method@Gio.Cancellable.connect] was called (e.g. if the connection actually
+// [Cancellable.Connect] was called (e.g. if the connection actually
 // took place, returning a non-zero value).
 //
-// @data_destroy_func will be called when the handler is
+// data_destroy_func will be called when the handler is
 // disconnected, or immediately if the cancellable is already
 // cancelled.
 //
-// See #GCancellable::cancelled for details on how to use this.
+// See [Cancellable.ConnectCancelledFunc] for details on how to use this.
 //
-// Since GLib 2.40, the lock protecting @cancellable is not held when
-// @callback is invoked. This lifts a restriction in place for
+// Since GLib 2.40, the lock protecting cancellable is not held when
+// callback is invoked. This lifts a restriction in place for
 // earlier GLib versions which now makes it easier to write cleanup
-// code that unconditionally invokes e.g. [method@Gio.Cancellable.cancel].
+// code that unconditionally invokes e.g. [Cancellable.Cancel].
 // Note that since 2.82 GLib still holds a lock during the callback but it’s
-// designed in a way that most of the [class@Gio.Cancellable] methods can be
-// called, including [method@Gio.Cancellable.cancel] or
-// [method@GObject.Object.unref].
+// designed in a way that most of the [Cancellable] methods can be
+// called, including [Cancellable.Cancel] or
+// [gobject.Object.Unref].
 //
 // There are still some methods that will deadlock (by design) when
-// called from the [signal@Gio.Cancellable::cancelled] callbacks:
-//   - [method@Gio.Cancellable.connect]
-//   - [method@Gio.Cancellable.disconnect]
-//   - [method@Gio.Cancellable.reset]
-//   - [method@Gio.Cancellable.make_pollfd]
-//   - [method@Gio.Cancellable.release_fd]
-func (x *Cancellable) Connect(CallbackVar *gobject.Callback, DataVar uintptr, DataDestroyFuncVar *glib.DestroyNotify) uint {
+// called from the [Cancellable.ConnectCancelledFunc] callbacks:
+//   - [Cancellable.Connect]
+//   - [Cancellable.Disconnect]
+//   - [Cancellable.Reset]
+//   - [Cancellable.MakePollfd]
+//   - [Cancellable.ReleaseFd]
+func (x *Cancellable) Connect(CallbackVar *gobject.Callback, DataVar uintptr, DataDestroyFuncVar *glib.DestroyNotify) types.ULong {
 
 	var CallbackVarRef uintptr
 	if CallbackVar != nil {
@@ -297,11 +302,12 @@ func (x *Cancellable) Connect(CallbackVar *gobject.Callback, DataVar uintptr, Da
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn()
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -312,11 +318,12 @@ func (x *Cancellable) Connect(CallbackVar *gobject.Callback, DataVar uintptr, Da
 			DataDestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataDestroyFuncVar
 				cbFn(arg0)
 			}
-			DataDestroyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DataDestroyFuncVarPtr, DataDestroyFuncVarRef, DataDestroyFuncVar)
+			DataDestroyFuncVarRef = core.NewCallback(fcb)
+			DataDestroyFuncVarRef = glib.SaveCallbackWithClosure(DataDestroyFuncVarPtr, DataDestroyFuncVarRef, DataDestroyFuncVar)
 		}
 	}
 
@@ -324,23 +331,23 @@ func (x *Cancellable) Connect(CallbackVar *gobject.Callback, DataVar uintptr, Da
 	return cret
 }
 
-var xCancellableDisconnect func(uintptr, uint)
+var xCancellableDisconnect func(uintptr, types.ULong)
 
 // Disconnects a handler from a cancellable instance similar to
-// g_signal_handler_disconnect().  Additionally, in the event that a
+// [gobject.SignalHandlerDisconnect].  Additionally, in the event that a
 // signal handler is currently running, this call will block until the
 // handler has finished.  Calling this function from a
-// #GCancellable::cancelled signal handler will therefore result in a
+// [Cancellable.ConnectCancelledFunc] signal handler will therefore result in a
 // deadlock.
 //
 // This avoids a race condition where a thread cancels at the
 // same time as the cancellable operation is finished and the
-// signal handler is removed. See #GCancellable::cancelled for
+// signal handler is removed. See [Cancellable.ConnectCancelledFunc] for
 // details on how to use this.
 //
-// If @cancellable is %NULL or @handler_id is `0` this function does
+// If cancellable is nil or handler_id is `0` this function does
 // nothing.
-func (x *Cancellable) Disconnect(HandlerIdVar uint) {
+func (x *Cancellable) Disconnect(HandlerIdVar types.ULong) {
 
 	xCancellableDisconnect(x.GoPointer(), HandlerIdVar)
 
@@ -350,17 +357,17 @@ var xCancellableGetFd func(uintptr) int
 
 // Gets the file descriptor for a cancellable job. This can be used to
 // implement cancellable operations on Unix systems. The returned fd will
-// turn readable when @cancellable is cancelled.
+// turn readable when cancellable is cancelled.
 //
 // You are not supposed to read from the fd yourself, just check for
 // readable status. Reading to unset the readable status is done
-// with g_cancellable_reset().
+// with [Cancellable.Reset].
 //
 // After a successful return from this function, you should use
-// g_cancellable_release_fd() to free up resources allocated for
+// [Cancellable.ReleaseFd] to free up resources allocated for
 // the returned file descriptor.
 //
-// See also g_cancellable_make_pollfd().
+// See also [Cancellable.MakePollfd].
 func (x *Cancellable) GetFd() int {
 
 	cret := xCancellableGetFd(x.GoPointer())
@@ -378,26 +385,26 @@ func (x *Cancellable) IsCancelled() bool {
 
 var xCancellableMakePollfd func(uintptr, *glib.PollFD) bool
 
-// Creates a #GPollFD corresponding to @cancellable; this can be passed
-// to g_poll() and used to poll for cancellation. This is useful both
+// Creates a [glib.PollFD] corresponding to cancellable; this can be passed
+// to [glib.Poll] and used to poll for cancellation. This is useful both
 // for unix systems without a native poll and for portability to
 // windows.
 //
-// When this function returns %TRUE, you should use
-// g_cancellable_release_fd() to free up resources allocated for the
-// @pollfd. After a %FALSE return, do not call g_cancellable_release_fd().
+// When this function returns true, you should use
+// [Cancellable.ReleaseFd] to free up resources allocated for the
+// pollfd. After a false return, do not call [Cancellable.ReleaseFd].
 //
-// If this function returns %FALSE, either no @cancellable was given or
+// If this function returns false, either no cancellable was given or
 // resource limits prevent this function from allocating the necessary
 // structures for polling. (On Linux, you will likely have reached
 // the maximum number of file descriptors.) The suggested way to handle
-// these cases is to ignore the @cancellable.
+// these cases is to ignore the cancellable.
 //
 // You are not supposed to read from the fd yourself, just check for
 // readable status. Reading to unset the readable status is done
-// with g_cancellable_reset().
+// with [Cancellable.Reset].
 //
-// Note that in the event that a [signal@Gio.Cancellable::cancelled] signal handler is
+// Note that in the event that a [Cancellable.ConnectCancelledFunc] signal handler is
 // currently running, this call will block until the handler has finished.
 // Calling this function from a signal handler will therefore result in a
 // deadlock.
@@ -409,7 +416,7 @@ func (x *Cancellable) MakePollfd(PollfdVar *glib.PollFD) bool {
 
 var xCancellablePopCurrent func(uintptr)
 
-// Pops @cancellable off the cancellable stack (verifying that @cancellable
+// Pops cancellable off the cancellable stack (verifying that cancellable
 // is on the top of the stack).
 func (x *Cancellable) PopCurrent() {
 
@@ -419,13 +426,13 @@ func (x *Cancellable) PopCurrent() {
 
 var xCancellablePushCurrent func(uintptr)
 
-// Pushes @cancellable onto the cancellable stack. The current
-// cancellable can then be received using g_cancellable_get_current().
+// Pushes cancellable onto the cancellable stack. The current
+// cancellable can then be received using [CancellableGetCurrent].
 //
 // This is useful when implementing cancellable operations in
 // code that does not allow you to pass down the cancellable object.
 //
-// This is typically called automatically by e.g. #GFile operations,
+// This is typically called automatically by e.g. [File] operations,
 // so you rarely have to call this yourself.
 func (x *Cancellable) PushCurrent() {
 
@@ -435,17 +442,17 @@ func (x *Cancellable) PushCurrent() {
 
 var xCancellableReleaseFd func(uintptr)
 
-// Releases a resources previously allocated by g_cancellable_get_fd()
-// or g_cancellable_make_pollfd().
+// Releases a resources previously allocated by [Cancellable.GetFd]
+// or [Cancellable.MakePollfd].
 //
 // For compatibility reasons with older releases, calling this function
 // is not strictly required, the resources will be automatically freed
-// when the @cancellable is finalized. However, the @cancellable will
+// when the cancellable is finalized. However, the cancellable will
 // block scarce file descriptors until it is finalized if this function
 // is not called. This can cause the application to run out of file
-// descriptors when many #GCancellables are used at the same time.
+// descriptors when many GCancellables are used at the same time.
 //
-// Note that in the event that a [signal@Gio.Cancellable::cancelled] signal handler is
+// Note that in the event that a [Cancellable.ConnectCancelledFunc] signal handler is
 // currently running, this call will block until the handler has finished.
 // Calling this function from a signal handler will therefore result in a
 // deadlock.
@@ -457,7 +464,7 @@ func (x *Cancellable) ReleaseFd() {
 
 var xCancellableReset func(uintptr)
 
-// Resets @cancellable to its uncancelled state.
+// Resets cancellable to its uncancelled state.
 //
 // If cancellable is currently in use by any cancellable operation
 // then the behavior of this function is undefined.
@@ -469,7 +476,7 @@ var xCancellableReset func(uintptr)
 // and let it die with the outstanding async operations. You should
 // create a fresh cancellable for further async operations.
 //
-// In the event that a [signal@Gio.Cancellable::cancelled] signal handler is currently
+// In the event that a [Cancellable.ConnectCancelledFunc] signal handler is currently
 // running, this call will block until the handler has finished.
 // Calling this function from a signal handler will therefore result in a
 // deadlock.
@@ -481,14 +488,14 @@ func (x *Cancellable) Reset() {
 
 var xCancellableSetErrorIfCancelled func(uintptr) bool
 
-// If the @cancellable is cancelled, sets the error to notify
+// If the cancellable is cancelled, sets the error to notify
 // that the operation was cancelled.
 func (x *Cancellable) SetErrorIfCancelled() (bool, error) {
 	var cerr *glib.Error
 
 	cret := xCancellableSetErrorIfCancelled(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -496,15 +503,15 @@ func (x *Cancellable) SetErrorIfCancelled() (bool, error) {
 
 var xCancellableSourceNew func(uintptr) *glib.Source
 
-// Creates a source that triggers if @cancellable is cancelled and
-// calls its callback of type #GCancellableSourceFunc. This is
+// Creates a source that triggers if cancellable is cancelled and
+// calls its callback of type [CancellableSourceFunc]. This is
 // primarily useful for attaching to another (non-cancellable) source
-// with g_source_add_child_source() to add cancellability to it.
+// with [glib.Source.AddChildSource] to add cancellability to it.
 //
-// For convenience, you can call this with a %NULL #GCancellable,
+// For convenience, you can call this with a nil [Cancellable],
 // in which case the source will never trigger.
 //
-// The new #GSource will hold a reference to the #GCancellable.
+// The new [glib.Source] will hold a reference to the [Cancellable].
 func (x *Cancellable) SourceNew() *glib.Source {
 
 	cret := xCancellableSourceNew(x.GoPointer())
@@ -532,7 +539,7 @@ func (c *Cancellable) SetGoPointer(ptr uintptr) {
 // Note that disconnecting from this signal (or any signal) in a
 // multi-threaded program is prone to race conditions. For instance
 // it is possible that a signal handler may be invoked even after
-// a call to g_signal_handler_disconnect() for that handler has
+// a call to [gobject.SignalHandlerDisconnect] for that handler has
 // already returned.
 //
 // There is also a problem when cancellation happens right before
@@ -541,12 +548,11 @@ func (c *Cancellable) SetGoPointer(ptr uintptr) {
 // the signal leaves a race condition where this is still happening.
 //
 // In order to make it safe and easy to connect handlers there
-// are two helper functions: g_cancellable_connect() and
-// g_cancellable_disconnect() which protect against problems
+// are two helper functions: [Cancellable.Connect] and
+// [Cancellable.Disconnect] which protect against problems
 // like this.
 //
 // An example of how to us this:
-// |[&lt;!-- language="C" --&gt;
 //
 //	// Make sure we don't do unnecessary work if already cancelled
 //	if (g_cancellable_set_error_if_cancelled (cancellable, error))
@@ -570,32 +576,52 @@ func (c *Cancellable) SetGoPointer(ptr uintptr) {
 //	// to free the data
 //	my_data_free (my_data);
 //
-// ]|
-//
 // Note that the cancelled signal is emitted in the thread that
 // the user cancelled from, which may be the main thread. So, the
 // cancellable signal should not do something that can block.
+//
+// Deprecated: use ConnectCancelledFunc, which also accepts method values and closures.
 func (x *Cancellable) ConnectCancelled(cb *func(Cancellable)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cancelled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Cancellable{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cancelled", cbRefPtr, cbPtr)
+}
+
+var xCancellableCancelledTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCancellableCancelledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Cancellable))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Cancellable{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cancelled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCancelledFunc connects cb to the "cancelled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Cancellable) ConnectCancelledFunc(cb func(Cancellable)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cancelled", &xCancellableCancelledTrampoline, xCancellableCancelledNewTrampoline, cb)
 }
 
 var xCancellableGetCurrent func() uintptr
@@ -620,7 +646,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -629,21 +655,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xCancellableGLibType, libs, "g_cancellable_get_type")
 
-	core.PuregoSafeRegister(&xNewCancellable, libs, "g_cancellable_new")
-
-	core.PuregoSafeRegister(&xCancellableCancel, libs, "g_cancellable_cancel")
-	core.PuregoSafeRegister(&xCancellableConnect, libs, "g_cancellable_connect")
-	core.PuregoSafeRegister(&xCancellableDisconnect, libs, "g_cancellable_disconnect")
-	core.PuregoSafeRegister(&xCancellableGetFd, libs, "g_cancellable_get_fd")
-	core.PuregoSafeRegister(&xCancellableIsCancelled, libs, "g_cancellable_is_cancelled")
-	core.PuregoSafeRegister(&xCancellableMakePollfd, libs, "g_cancellable_make_pollfd")
-	core.PuregoSafeRegister(&xCancellablePopCurrent, libs, "g_cancellable_pop_current")
-	core.PuregoSafeRegister(&xCancellablePushCurrent, libs, "g_cancellable_push_current")
-	core.PuregoSafeRegister(&xCancellableReleaseFd, libs, "g_cancellable_release_fd")
-	core.PuregoSafeRegister(&xCancellableReset, libs, "g_cancellable_reset")
-	core.PuregoSafeRegister(&xCancellableSetErrorIfCancelled, libs, "g_cancellable_set_error_if_cancelled")
-	core.PuregoSafeRegister(&xCancellableSourceNew, libs, "g_cancellable_source_new")
-
-	core.PuregoSafeRegister(&xCancellableGetCurrent, libs, "g_cancellable_get_current")
+	gobject.CheckClassSize("GCancellableClass", xCancellableGLibType, unsafe.Sizeof(CancellableClass{}))
+
+	core.PuregoRegisterSince(&xNewCancellable, libs, "g_cancellable_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xCancellableCancel, libs, "g_cancellable_cancel", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableConnect, libs, "g_cancellable_connect", "GIO", "2.22")
+	core.PuregoRegisterSince(&xCancellableDisconnect, libs, "g_cancellable_disconnect", "GIO", "2.22")
+	core.PuregoRegisterSince(&xCancellableGetFd, libs, "g_cancellable_get_fd", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableIsCancelled, libs, "g_cancellable_is_cancelled", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableMakePollfd, libs, "g_cancellable_make_pollfd", "GIO", "2.22")
+	core.PuregoRegisterSince(&xCancellablePopCurrent, libs, "g_cancellable_pop_current", "GIO", "")
+	core.PuregoRegisterSince(&xCancellablePushCurrent, libs, "g_cancellable_push_current", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableReleaseFd, libs, "g_cancellable_release_fd", "GIO", "2.22")
+	core.PuregoRegisterSince(&xCancellableReset, libs, "g_cancellable_reset", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableSetErrorIfCancelled, libs, "g_cancellable_set_error_if_cancelled", "GIO", "")
+	core.PuregoRegisterSince(&xCancellableSourceNew, libs, "g_cancellable_source_new", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xCancellableGetCurrent, libs, "g_cancellable_get_current", "GIO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,8 +21,8 @@ func (x *CharsetConverterClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GCharsetConverter` is an implementation of [iface@Gio.Converter] based on
-// [struct@GLib.IConv].
+// `GCharsetConverter` is an implementation of [Converter] based on
+// [glib.IConv].
 type CharsetConverter struct {
 	gobject.Object
 }
@@ -42,7 +41,7 @@ func CharsetConverterNewFromInternalPtr(ptr uintptr) *CharsetConverter {
 
 var xNewCharsetConverter func(string, string, **glib.Error) uintptr
 
-// Creates a new #GCharsetConverter.
+// Creates a new [CharsetConverter].
 func NewCharsetConverter(ToCharsetVar string, FromCharsetVar string) (*CharsetConverter, error) {
 	var cls *CharsetConverter
 	var cerr *glib.Error
@@ -50,12 +49,15 @@ func NewCharsetConverter(ToCharsetVar string, FromCharsetVar string) (*CharsetCo
 	cret := xNewCharsetConverter(ToCharsetVar, FromCharsetVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &CharsetConverter{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -63,7 +65,7 @@ func NewCharsetConverter(ToCharsetVar string, FromCharsetVar string) (*CharsetCo
 
 var xCharsetConverterGetNumFallbacks func(uintptr) uint
 
-// Gets the number of fallbacks that @converter has applied so far.
+// Gets the number of fallbacks that converter has applied so far.
 func (x *CharsetConverter) GetNumFallbacks() uint {
 
 	cret := xCharsetConverterGetNumFallbacks(x.GoPointer())
@@ -72,7 +74,7 @@ func (x *CharsetConverter) GetNumFallbacks() uint {
 
 var xCharsetConverterGetUseFallback func(uintptr) bool
 
-// Gets the #GCharsetConverter:use-fallback property.
+// Gets the [CharsetConverter.GetPropertyUseFallback] property.
 func (x *CharsetConverter) GetUseFallback() bool {
 
 	cret := xCharsetConverterGetUseFallback(x.GoPointer())
@@ -81,7 +83,7 @@ func (x *CharsetConverter) GetUseFallback() bool {
 
 var xCharsetConverterSetUseFallback func(uintptr, bool)
 
-// Sets the #GCharsetConverter:use-fallback property.
+// Sets the [CharsetConverter.GetPropertyUseFallback] property.
 func (x *CharsetConverter) SetUseFallback(UseFallbackVar bool) {
 
 	xCharsetConverterSetUseFallback(x.GoPointer(), UseFallbackVar)
@@ -134,7 +136,7 @@ func (x *CharsetConverter) GetPropertyToCharset() string {
 }
 
 // SetPropertyUseFallback sets the "use-fallback" property.
-// Use fallback (of form `\&lt;hexval&gt;`) for invalid bytes.
+// Use fallback (of form `\<hexval>`) for invalid bytes.
 func (x *CharsetConverter) SetPropertyUseFallback(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -143,7 +145,7 @@ func (x *CharsetConverter) SetPropertyUseFallback(value bool) {
 }
 
 // GetPropertyUseFallback gets the "use-fallback" property.
-// Use fallback (of form `\&lt;hexval&gt;`) for invalid bytes.
+// Use fallback (of form `\<hexval>`) for invalid bytes.
 func (x *CharsetConverter) GetPropertyUseFallback() bool {
 	var v gobject.Value
 	x.GetProperty("use-fallback", &v)
@@ -152,7 +154,7 @@ func (x *CharsetConverter) GetPropertyUseFallback() bool {
 
 // This is the main operation used when converting data. It is to be called
 // multiple times in a loop, and each time it will do some work, i.e.
-// producing some output (in @outbuf) or consuming some input (from @inbuf) or
+// producing some output (in outbuf) or consuming some input (from inbuf) or
 // both. If its not possible to do any work an error is returned.
 //
 // Note that a single call may not consume all input (or any input at all).
@@ -165,56 +167,56 @@ func (x *CharsetConverter) GetPropertyUseFallback() bool {
 //
 // A full conversion loop involves calling this method repeatedly, each time
 // giving it new input and space output space. When there is no more input
-// data after the data in @inbuf, the flag %G_CONVERTER_INPUT_AT_END must be set.
-// The loop will be (unless some error happens) returning %G_CONVERTER_CONVERTED
+// data after the data in inbuf, the flag [GConverterInputAtEndValue] must be set.
+// The loop will be (unless some error happens) returning [GConverterConvertedValue]
 // each time until all data is consumed and all output is produced, then
-// %G_CONVERTER_FINISHED is returned instead. Note, that %G_CONVERTER_FINISHED
-// may be returned even if %G_CONVERTER_INPUT_AT_END is not set, for instance
+// [GConverterFinishedValue] is returned instead. Note, that [GConverterFinishedValue]
+// may be returned even if [GConverterInputAtEndValue] is not set, for instance
 // in a decompression converter where the end of data is detectable from the
 // data (and there might even be other data after the end of the compressed data).
 //
-// When some data has successfully been converted @bytes_read and is set to
-// the number of bytes read from @inbuf, and @bytes_written is set to indicate
-// how many bytes was written to @outbuf. If there are more data to output
-// or consume (i.e. unless the %G_CONVERTER_INPUT_AT_END is specified) then
-// %G_CONVERTER_CONVERTED is returned, and if no more data is to be output
-// then %G_CONVERTER_FINISHED is returned.
+// When some data has successfully been converted bytes_read and is set to
+// the number of bytes read from inbuf, and bytes_written is set to indicate
+// how many bytes was written to outbuf. If there are more data to output
+// or consume (i.e. unless the [GConverterInputAtEndValue] is specified) then
+// [GConverterConvertedValue] is returned, and if no more data is to be output
+// then [GConverterFinishedValue] is returned.
 //
-// On error %G_CONVERTER_ERROR is returned and @error is set accordingly.
+// On error [GConverterErrorValue] is returned and error is set accordingly.
 // Some errors need special handling:
 //
-// %G_IO_ERROR_NO_SPACE is returned if there is not enough space
+// [GIoErrorNoSpaceValue] is returned if there is not enough space
 // to write the resulting converted data, the application should
-// call the function again with a larger @outbuf to continue.
+// call the function again with a larger outbuf to continue.
 //
-// %G_IO_ERROR_PARTIAL_INPUT is returned if there is not enough
+// [GIoErrorPartialInputValue] is returned if there is not enough
 // input to fully determine what the conversion should produce,
-// and the %G_CONVERTER_INPUT_AT_END flag is not set. This happens for
+// and the [GConverterInputAtEndValue] flag is not set. This happens for
 // example with an incomplete multibyte sequence when converting text,
 // or when a regexp matches up to the end of the input (and may match
-// further input). It may also happen when @inbuf_size is zero and
+// further input). It may also happen when inbuf_size is zero and
 // there is no more data to produce.
 //
 // When this happens the application should read more input and then
 // call the function again. If further input shows that there is no
 // more data call the function again with the same data but with
-// the %G_CONVERTER_INPUT_AT_END flag set. This may cause the conversion
+// the [GConverterInputAtEndValue] flag set. This may cause the conversion
 // to finish as e.g. in the regexp match case (or, to fail again with
-// %G_IO_ERROR_PARTIAL_INPUT in e.g. a charset conversion where the
+// [GIoErrorPartialInputValue] in e.g. a charset conversion where the
 // input is actually partial).
 //
-// After g_converter_convert() has returned %G_CONVERTER_FINISHED the
+// After [Converter.Convert] has returned [GConverterFinishedValue] the
 // converter object is in an invalid state where its not allowed
-// to call g_converter_convert() anymore. At this time you can only
-// free the object or call g_converter_reset() to reset it to the
+// to call [Converter.Convert] anymore. At this time you can only
+// free the object or call [Converter.Reset] to reset it to the
 // initial state.
 //
-// If the flag %G_CONVERTER_FLUSH is set then conversion is modified
+// If the flag [GConverterFlushValue] is set then conversion is modified
 // to try to write out all internal state to the output. The application
 // has to call the function multiple times with the flag set, and when
 // the available input has been consumed and all internal state has
-// been produced then %G_CONVERTER_FLUSHED (or %G_CONVERTER_FINISHED if
-// really at the end) is returned instead of %G_CONVERTER_CONVERTED.
+// been produced then [GConverterFlushedValue] (or [GConverterFinishedValue] if
+// really at the end) is returned instead of [GConverterConvertedValue].
 // This is somewhat similar to what happens at the end of the input stream,
 // but done in the middle of the data.
 //
@@ -231,25 +233,25 @@ func (x *CharsetConverter) GetPropertyUseFallback() bool {
 // Flushing is not always possible (like if a charset converter flushes
 // at a partial multibyte sequence). Converters are supposed to try
 // to produce as much output as possible and then return an error
-// (typically %G_IO_ERROR_PARTIAL_INPUT).
+// (typically [GIoErrorPartialInputValue]).
 func (x *CharsetConverter) Convert(InbufVar []byte, InbufSizeVar uint, OutbufVar []byte, OutbufSizeVar uint, FlagsVar ConverterFlags, BytesReadVar *uint, BytesWrittenVar *uint) (ConverterResult, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvert(x.GoPointer(), InbufVar, InbufSizeVar, OutbufVar, OutbufSizeVar, FlagsVar, BytesReadVar, BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Applies @converter to the data in @bytes.
+// Applies converter to the data in bytes.
 func (x *CharsetConverter) ConvertBytes(BytesVar *glib.Bytes) (*glib.Bytes, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvertBytes(x.GoPointer(), BytesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -270,25 +272,25 @@ func (x *CharsetConverter) Reset() {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -298,16 +300,16 @@ func (x *CharsetConverter) Reset() {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *CharsetConverter) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -318,7 +320,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -327,10 +329,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xCharsetConverterGLibType, libs, "g_charset_converter_get_type")
 
-	core.PuregoSafeRegister(&xNewCharsetConverter, libs, "g_charset_converter_new")
+	gobject.CheckClassSize("GCharsetConverterClass", xCharsetConverterGLibType, unsafe.Sizeof(CharsetConverterClass{}))
+
+	core.PuregoRegisterSince(&xNewCharsetConverter, libs, "g_charset_converter_new", "GIO", "2.24")
 
-	core.PuregoSafeRegister(&xCharsetConverterGetNumFallbacks, libs, "g_charset_converter_get_num_fallbacks")
-	core.PuregoSafeRegister(&xCharsetConverterGetUseFallback, libs, "g_charset_converter_get_use_fallback")
-	core.PuregoSafeRegister(&xCharsetConverterSetUseFallback, libs, "g_charset_converter_set_use_fallback")
+	core.PuregoRegisterSince(&xCharsetConverterGetNumFallbacks, libs, "g_charset_converter_get_num_fallbacks", "GIO", "2.24")
+	core.PuregoRegisterSince(&xCharsetConverterGetUseFallback, libs, "g_charset_converter_get_use_fallback", "GIO", "2.24")
+	core.PuregoRegisterSince(&xCharsetConverterSetUseFallback, libs, "g_charset_converter_set_use_fallback", "GIO", "2.24")
 
 }
@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
@@ -49,8 +48,10 @@ var xContentTypeGetGenericIconName func(string) string
 // Gets the generic icon name for a content type.
 //
 // See the
-// [shared-mime-info](http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec)
+// [shared-mime-info]
 // specification for more on the generic icon name.
+//
+// [shared-mime-info]: http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec
 func ContentTypeGetGenericIconName(TypeVar string) string {
 
 	cret := xContentTypeGetGenericIconName(TypeVar)
@@ -76,7 +77,7 @@ func ContentTypeGetIcon(TypeVar string) *IconBase {
 var xContentTypeGetMimeDirs func() []string
 
 // Get the list of directories which MIME data is loaded from. See
-// g_content_type_set_mime_dirs() for details.
+// [ContentTypeSetMimeDirs] for details.
 func ContentTypeGetMimeDirs() []string {
 
 	cret := xContentTypeGetMimeDirs()
@@ -111,8 +112,8 @@ func ContentTypeGetSymbolicIcon(TypeVar string) *IconBase {
 var xContentTypeGuess func(uintptr, []byte, uint, *bool) string
 
 // Guesses the content type based on example data. If the function is
-// uncertain, @result_uncertain will be set to %TRUE. Either @filename
-// or @data may be %NULL, in which case the guess will be based solely
+// uncertain, result_uncertain will be set to true. Either filename
+// or data may be nil, in which case the guess will be based solely
 // on the other argument.
 func ContentTypeGuess(FilenameVar *string, DataVar []byte, DataSizeVar uint, ResultUncertainVar *bool) string {
 
@@ -123,29 +124,31 @@ func ContentTypeGuess(FilenameVar *string, DataVar []byte, DataSizeVar uint, Res
 	return cret
 }
 
-var xContentTypeGuessForTree func(uintptr) []string
+var xContentTypeGuessForTree func(uintptr) uintptr
 
-// Tries to guess the type of the tree with root @root, by
+// Tries to guess the type of the tree with root root, by
 // looking at the files it contains. The result is an array
 // of content types, with the best guess coming first.
 //
 // The types returned all have the form x-content/foo, e.g.
 // x-content/audio-cdda (for audio CDs) or x-content/image-dcf
 // (for a camera memory card). See the
-// [shared-mime-info](http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec)
+// [shared-mime-info]
 // specification for more on x-content types.
 //
 // This function is useful in the implementation of
-// g_mount_guess_content_type().
+// [Mount.GuessContentType].
+//
+// [shared-mime-info]: http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec
 func ContentTypeGuessForTree(RootVar File) []string {
 
 	cret := xContentTypeGuessForTree(RootVar.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xContentTypeIsA func(string, string) bool
 
-// Determines if @type is a subset of @supertype.
+// Determines if type is a subset of supertype.
 func ContentTypeIsA(TypeVar string, SupertypeVar string) bool {
 
 	cret := xContentTypeIsA(TypeVar, SupertypeVar)
@@ -154,8 +157,8 @@ func ContentTypeIsA(TypeVar string, SupertypeVar string) bool {
 
 var xContentTypeIsMimeType func(string, string) bool
 
-// Determines if @type is a subset of @mime_type.
-// Convenience wrapper around g_content_type_is_a().
+// Determines if type is a subset of mime_type.
+// Convenience wrapper around [ContentTypeIsA].
 func ContentTypeIsMimeType(TypeVar string, MimeTypeVar string) bool {
 
 	cret := xContentTypeIsMimeType(TypeVar, MimeTypeVar)
@@ -177,7 +180,7 @@ func ContentTypeIsUnknown(TypeVar string) bool {
 var xContentTypeSetMimeDirs func([]string)
 
 // Set the list of directories used by GIO to load the MIME database.
-// If @dirs is %NULL, the directories used are the default:
+// If dirs is nil, the directories used are the default:
 //
 //   - the `mime` subdirectory of the directory in `$XDG_DATA_HOME`
 //   - the `mime` subdirectory of every directory in `$XDG_DATA_DIRS`
@@ -185,22 +188,18 @@ var xContentTypeSetMimeDirs func([]string)
 // This function is intended to be used when writing tests that depend on
 // information stored in the MIME database, in order to control the data.
 //
-// Typically, in case your tests use %G_TEST_OPTION_ISOLATE_DIRS, but they
+// Typically, in case your tests use [glib.TEST_OPTION_ISOLATE_DIRS], but they
 // depend on the system’s MIME database, you should call this function
-// with @dirs set to %NULL before calling g_test_init(), for instance:
-//
-// |[&lt;!-- language="C" --&gt;
+// with dirs set to nil before calling [glib.TestInit], for instance:
 //
 //	// Load MIME data from the system
 //	g_content_type_set_mime_dirs (NULL);
 //	// Isolate the environment
-//	g_test_init (&amp;argc, &amp;argv, G_TEST_OPTION_ISOLATE_DIRS, NULL);
+//	g_test_init (&argc, &argv, G_TEST_OPTION_ISOLATE_DIRS, NULL);
 //
 //	…
 //
 //	return g_test_run ();
-//
-// ]|
 func ContentTypeSetMimeDirs(DirsVar []string) {
 
 	xContentTypeSetMimeDirs(DirsVar)
@@ -223,28 +222,28 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xContentTypeCanBeExecutable, libs, "g_content_type_can_be_executable")
-	core.PuregoSafeRegister(&xContentTypeEquals, libs, "g_content_type_equals")
-	core.PuregoSafeRegister(&xContentTypeFromMimeType, libs, "g_content_type_from_mime_type")
-	core.PuregoSafeRegister(&xContentTypeGetDescription, libs, "g_content_type_get_description")
-	core.PuregoSafeRegister(&xContentTypeGetGenericIconName, libs, "g_content_type_get_generic_icon_name")
-	core.PuregoSafeRegister(&xContentTypeGetIcon, libs, "g_content_type_get_icon")
-	core.PuregoSafeRegister(&xContentTypeGetMimeDirs, libs, "g_content_type_get_mime_dirs")
-	core.PuregoSafeRegister(&xContentTypeGetMimeType, libs, "g_content_type_get_mime_type")
-	core.PuregoSafeRegister(&xContentTypeGetSymbolicIcon, libs, "g_content_type_get_symbolic_icon")
-	core.PuregoSafeRegister(&xContentTypeGuess, libs, "g_content_type_guess")
-	core.PuregoSafeRegister(&xContentTypeGuessForTree, libs, "g_content_type_guess_for_tree")
-	core.PuregoSafeRegister(&xContentTypeIsA, libs, "g_content_type_is_a")
-	core.PuregoSafeRegister(&xContentTypeIsMimeType, libs, "g_content_type_is_mime_type")
-	core.PuregoSafeRegister(&xContentTypeIsUnknown, libs, "g_content_type_is_unknown")
-	core.PuregoSafeRegister(&xContentTypeSetMimeDirs, libs, "g_content_type_set_mime_dirs")
-	core.PuregoSafeRegister(&xContentTypesGetRegistered, libs, "g_content_types_get_registered")
+	core.PuregoRegisterSince(&xContentTypeCanBeExecutable, libs, "g_content_type_can_be_executable", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeEquals, libs, "g_content_type_equals", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeFromMimeType, libs, "g_content_type_from_mime_type", "GIO", "2.18")
+	core.PuregoRegisterSince(&xContentTypeGetDescription, libs, "g_content_type_get_description", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeGetGenericIconName, libs, "g_content_type_get_generic_icon_name", "GIO", "2.34")
+	core.PuregoRegisterSince(&xContentTypeGetIcon, libs, "g_content_type_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeGetMimeDirs, libs, "g_content_type_get_mime_dirs", "GIO", "2.60")
+	core.PuregoRegisterSince(&xContentTypeGetMimeType, libs, "g_content_type_get_mime_type", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeGetSymbolicIcon, libs, "g_content_type_get_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&xContentTypeGuess, libs, "g_content_type_guess", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeGuessForTree, libs, "g_content_type_guess_for_tree", "GIO", "2.18")
+	core.PuregoRegisterSince(&xContentTypeIsA, libs, "g_content_type_is_a", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeIsMimeType, libs, "g_content_type_is_mime_type", "GIO", "2.52")
+	core.PuregoRegisterSince(&xContentTypeIsUnknown, libs, "g_content_type_is_unknown", "GIO", "")
+	core.PuregoRegisterSince(&xContentTypeSetMimeDirs, libs, "g_content_type_set_mime_dirs", "GIO", "2.60")
+	core.PuregoRegisterSince(&xContentTypesGetRegistered, libs, "g_content_types_get_registered", "GIO", "")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type ConverterIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xConvert uintptr
 
@@ -34,7 +34,8 @@ func (x *ConverterIface) OverrideConvert(cb func(Converter, []byte, uint, []byte
 	if cb == nil {
 		x.xConvert = 0
 	} else {
-		x.xConvert = purego.NewCallback(func(ConverterVarp uintptr, InbufVarp []byte, InbufSizeVarp uint, OutbufVarp []byte, OutbufSizeVarp uint, FlagsVarp ConverterFlags, BytesReadVarp *uint, BytesWrittenVarp *uint) ConverterResult {
+		x.xConvert = core.NewCallback(func(ConverterVarp uintptr, InbufVarp []byte, InbufSizeVarp uint, OutbufVarp []byte, OutbufSizeVarp uint, FlagsVarp ConverterFlags, BytesReadVarp *uint, BytesWrittenVarp *uint) ConverterResult {
+			defer core.RecoverPanic()
 			return cb(&ConverterBase{Ptr: ConverterVarp}, InbufVarp, InbufSizeVarp, OutbufVarp, OutbufSizeVarp, FlagsVarp, BytesReadVarp, BytesWrittenVarp)
 		})
 	}
@@ -47,7 +48,7 @@ func (x *ConverterIface) GetConvert() func(Converter, []byte, uint, []byte, uint
 		return nil
 	}
 	var rawCallback func(ConverterVarp uintptr, InbufVarp []byte, InbufSizeVarp uint, OutbufVarp []byte, OutbufSizeVarp uint, FlagsVarp ConverterFlags, BytesReadVarp *uint, BytesWrittenVarp *uint) ConverterResult
-	purego.RegisterFunc(&rawCallback, x.xConvert)
+	core.RegisterFunc(&rawCallback, x.xConvert)
 	return func(ConverterVar Converter, InbufVar []byte, InbufSizeVar uint, OutbufVar []byte, OutbufSizeVar uint, FlagsVar ConverterFlags, BytesReadVar *uint, BytesWrittenVar *uint) ConverterResult {
 		return rawCallback(ConverterVar.GoPointer(), InbufVar, InbufSizeVar, OutbufVar, OutbufSizeVar, FlagsVar, BytesReadVar, BytesWrittenVar)
 	}
@@ -59,7 +60,8 @@ func (x *ConverterIface) OverrideReset(cb func(Converter)) {
 	if cb == nil {
 		x.xReset = 0
 	} else {
-		x.xReset = purego.NewCallback(func(ConverterVarp uintptr) {
+		x.xReset = core.NewCallback(func(ConverterVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ConverterBase{Ptr: ConverterVarp})
 		})
 	}
@@ -72,7 +74,7 @@ func (x *ConverterIface) GetReset() func(Converter) {
 		return nil
 	}
 	var rawCallback func(ConverterVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReset)
+	core.RegisterFunc(&rawCallback, x.xReset)
 	return func(ConverterVar Converter) {
 		rawCallback(ConverterVar.GoPointer())
 	}
@@ -116,9 +118,40 @@ func (x *ConverterBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementConverter adds the Converter interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Convert([]byte, uint, []byte, uint, ConverterFlags, *uint, *uint) ConverterResult
+//   - Reset()
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ConverterIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementConverter[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ConverterGLibType(), func(iface *ConverterIface) {
+		if _, ok := any(zero).(interface {
+			Convert([]byte, uint, []byte, uint, ConverterFlags, *uint, *uint) ConverterResult
+		}); ok {
+			iface.OverrideConvert(func(self Converter, arg0 []byte, arg1 uint, arg2 []byte, arg3 uint, arg4 ConverterFlags, arg5 *uint, arg6 *uint) ConverterResult {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Convert([]byte, uint, []byte, uint, ConverterFlags, *uint, *uint) ConverterResult
+				})
+				return impl.Convert(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+			})
+		}
+		if _, ok := any(zero).(interface{ Reset() }); ok {
+			iface.OverrideReset(func(self Converter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Reset() })
+				impl.Reset()
+			})
+		}
+	})
+}
+
 // This is the main operation used when converting data. It is to be called
 // multiple times in a loop, and each time it will do some work, i.e.
-// producing some output (in @outbuf) or consuming some input (from @inbuf) or
+// producing some output (in outbuf) or consuming some input (from inbuf) or
 // both. If its not possible to do any work an error is returned.
 //
 // Note that a single call may not consume all input (or any input at all).
@@ -131,56 +164,56 @@ func (x *ConverterBase) SetGoPointer(ptr uintptr) {
 //
 // A full conversion loop involves calling this method repeatedly, each time
 // giving it new input and space output space. When there is no more input
-// data after the data in @inbuf, the flag %G_CONVERTER_INPUT_AT_END must be set.
-// The loop will be (unless some error happens) returning %G_CONVERTER_CONVERTED
+// data after the data in inbuf, the flag [GConverterInputAtEndValue] must be set.
+// The loop will be (unless some error happens) returning [GConverterConvertedValue]
 // each time until all data is consumed and all output is produced, then
-// %G_CONVERTER_FINISHED is returned instead. Note, that %G_CONVERTER_FINISHED
-// may be returned even if %G_CONVERTER_INPUT_AT_END is not set, for instance
+// [GConverterFinishedValue] is returned instead. Note, that [GConverterFinishedValue]
+// may be returned even if [GConverterInputAtEndValue] is not set, for instance
 // in a decompression converter where the end of data is detectable from the
 // data (and there might even be other data after the end of the compressed data).
 //
-// When some data has successfully been converted @bytes_read and is set to
-// the number of bytes read from @inbuf, and @bytes_written is set to indicate
-// how many bytes was written to @outbuf. If there are more data to output
-// or consume (i.e. unless the %G_CONVERTER_INPUT_AT_END is specified) then
-// %G_CONVERTER_CONVERTED is returned, and if no more data is to be output
-// then %G_CONVERTER_FINISHED is returned.
+// When some data has successfully been converted bytes_read and is set to
+// the number of bytes read from inbuf, and bytes_written is set to indicate
+// how many bytes was written to outbuf. If there are more data to output
+// or consume (i.e. unless the [GConverterInputAtEndValue] is specified) then
+// [GConverterConvertedValue] is returned, and if no more data is to be output
+// then [GConverterFinishedValue] is returned.
 //
-// On error %G_CONVERTER_ERROR is returned and @error is set accordingly.
+// On error [GConverterErrorValue] is returned and error is set accordingly.
 // Some errors need special handling:
 //
-// %G_IO_ERROR_NO_SPACE is returned if there is not enough space
+// [GIoErrorNoSpaceValue] is returned if there is not enough space
 // to write the resulting converted data, the application should
-// call the function again with a larger @outbuf to continue.
+// call the function again with a larger outbuf to continue.
 //
-// %G_IO_ERROR_PARTIAL_INPUT is returned if there is not enough
+// [GIoErrorPartialInputValue] is returned if there is not enough
 // input to fully determine what the conversion should produce,
-// and the %G_CONVERTER_INPUT_AT_END flag is not set. This happens for
+// and the [GConverterInputAtEndValue] flag is not set. This happens for
 // example with an incomplete multibyte sequence when converting text,
 // or when a regexp matches up to the end of the input (and may match
-// further input). It may also happen when @inbuf_size is zero and
+// further input). It may also happen when inbuf_size is zero and
 // there is no more data to produce.
 //
 // When this happens the application should read more input and then
 // call the function again. If further input shows that there is no
 // more data call the function again with the same data but with
-// the %G_CONVERTER_INPUT_AT_END flag set. This may cause the conversion
+// the [GConverterInputAtEndValue] flag set. This may cause the conversion
 // to finish as e.g. in the regexp match case (or, to fail again with
-// %G_IO_ERROR_PARTIAL_INPUT in e.g. a charset conversion where the
+// [GIoErrorPartialInputValue] in e.g. a charset conversion where the
 // input is actually partial).
 //
-// After g_converter_convert() has returned %G_CONVERTER_FINISHED the
+// After [Converter.Convert] has returned [GConverterFinishedValue] the
 // converter object is in an invalid state where its not allowed
-// to call g_converter_convert() anymore. At this time you can only
-// free the object or call g_converter_reset() to reset it to the
+// to call [Converter.Convert] anymore. At this time you can only
+// free the object or call [Converter.Reset] to reset it to the
 // initial state.
 //
-// If the flag %G_CONVERTER_FLUSH is set then conversion is modified
+// If the flag [GConverterFlushValue] is set then conversion is modified
 // to try to write out all internal state to the output. The application
 // has to call the function multiple times with the flag set, and when
 // the available input has been consumed and all internal state has
-// been produced then %G_CONVERTER_FLUSHED (or %G_CONVERTER_FINISHED if
-// really at the end) is returned instead of %G_CONVERTER_CONVERTED.
+// been produced then [GConverterFlushedValue] (or [GConverterFinishedValue] if
+// really at the end) is returned instead of [GConverterConvertedValue].
 // This is somewhat similar to what happens at the end of the input stream,
 // but done in the middle of the data.
 //
@@ -197,25 +230,25 @@ func (x *ConverterBase) SetGoPointer(ptr uintptr) {
 // Flushing is not always possible (like if a charset converter flushes
 // at a partial multibyte sequence). Converters are supposed to try
 // to produce as much output as possible and then return an error
-// (typically %G_IO_ERROR_PARTIAL_INPUT).
+// (typically [GIoErrorPartialInputValue]).
 func (x *ConverterBase) Convert(InbufVar []byte, InbufSizeVar uint, OutbufVar []byte, OutbufSizeVar uint, FlagsVar ConverterFlags, BytesReadVar *uint, BytesWrittenVar *uint) (ConverterResult, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvert(x.GoPointer(), InbufVar, InbufSizeVar, OutbufVar, OutbufSizeVar, FlagsVar, BytesReadVar, BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Applies @converter to the data in @bytes.
+// Applies converter to the data in bytes.
 func (x *ConverterBase) ConvertBytes(BytesVar *glib.Bytes) (*glib.Bytes, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvertBytes(x.GoPointer(), BytesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -239,7 +272,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -248,8 +281,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xConverterGLibType, libs, "g_converter_get_type")
 
-	core.PuregoSafeRegister(&XGConverterConvert, libs, "g_converter_convert")
-	core.PuregoSafeRegister(&XGConverterConvertBytes, libs, "g_converter_convert_bytes")
-	core.PuregoSafeRegister(&XGConverterReset, libs, "g_converter_reset")
+	gobject.CheckClassSize("GConverterIface", xConverterGLibType, unsafe.Sizeof(ConverterIface{}))
+
+	core.PuregoRegisterSince(&XGConverterConvert, libs, "g_converter_convert", "GIO", "2.24")
+	core.PuregoRegisterSince(&XGConverterConvertBytes, libs, "g_converter_convert_bytes", "GIO", "2.82")
+	core.PuregoRegisterSince(&XGConverterReset, libs, "g_converter_reset", "GIO", "2.24")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,8 @@ func (x *ConverterInputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -49,7 +49,7 @@ func (x *ConverterInputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -60,7 +60,8 @@ func (x *ConverterInputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -72,7 +73,7 @@ func (x *ConverterInputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -83,7 +84,8 @@ func (x *ConverterInputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -95,7 +97,7 @@ func (x *ConverterInputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -106,7 +108,8 @@ func (x *ConverterInputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -118,7 +121,7 @@ func (x *ConverterInputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -129,7 +132,8 @@ func (x *ConverterInputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -141,7 +145,7 @@ func (x *ConverterInputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -155,11 +159,11 @@ func (x *ConverterInputStreamPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Converter input stream implements [class@Gio.InputStream] and allows
+// Converter input stream implements [InputStream] and allows
 // conversion of data of various types during reading.
 //
 // As of GLib 2.34, `GConverterInputStream` implements
-// [iface@Gio.PollableInputStream].
+// [PollableInputStream].
 type ConverterInputStream struct {
 	FilterInputStream
 }
@@ -178,7 +182,7 @@ func ConverterInputStreamNewFromInternalPtr(ptr uintptr) *ConverterInputStream {
 
 var xNewConverterInputStream func(uintptr, uintptr) uintptr
 
-// Creates a new converter input stream for the @base_stream.
+// Creates a new converter input stream for the base_stream.
 func NewConverterInputStream(BaseStreamVar *InputStream, ConverterVar Converter) *ConverterInputStream {
 	var cls *ConverterInputStream
 
@@ -194,7 +198,7 @@ func NewConverterInputStream(BaseStreamVar *InputStream, ConverterVar Converter)
 
 var xConverterInputStreamGetConverter func(uintptr) uintptr
 
-// Gets the #GConverter that is used by @converter_stream.
+// Gets the [Converter] that is used by converter_stream.
 func (x *ConverterInputStream) GetConverter() *ConverterBase {
 	var cls *ConverterBase
 
@@ -220,10 +224,10 @@ func (c *ConverterInputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableInputStream but have only certain instances of that class
-// be pollable. If this method returns %FALSE, then the behavior of
-// other #GPollableInputStream methods is undefined.
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableInputStream] but have only certain instances of that class
+// be pollable. If this method returns false, then the behavior of
+// other [PollableInputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -233,60 +237,60 @@ func (x *ConverterInputStream) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be read, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be read, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_input_stream_is_readable(), it is possible that
+// As with [PollableInputStream.IsReadable], it is possible that
 // the stream may not actually be readable even after the source
-// triggers, so you should use g_pollable_input_stream_read_nonblocking()
-// rather than g_input_stream_read() from the callback.
+// triggers, so you should use [PollableInputStream.ReadNonblocking]
+// rather than [InputStream.Read] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *ConverterInputStream) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableInputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be read.
+// Checks if stream can be read.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_input_stream_read()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [InputStream.Read]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_input_stream_read_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableInputStream.ReadNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *ConverterInputStream) IsReadable() bool {
 
 	cret := XGPollableInputStreamIsReadable(x.GoPointer())
 	return cret
 }
 
-// Attempts to read up to @count bytes from @stream into @buffer, as
-// with g_input_stream_read(). If @stream is not currently readable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_input_stream_create_source() to create a #GSource
-// that will be triggered when @stream is readable.
+// Attempts to read up to count bytes from stream into buffer, as
+// with [InputStream.Read]. If stream is not currently readable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableInputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is readable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *ConverterInputStream) ReadNonblocking(BufferVar *[]byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableInputStreamReadNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -297,7 +301,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -306,8 +310,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xConverterInputStreamGLibType, libs, "g_converter_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewConverterInputStream, libs, "g_converter_input_stream_new")
+	gobject.CheckClassSize("GConverterInputStreamClass", xConverterInputStreamGLibType, unsafe.Sizeof(ConverterInputStreamClass{}))
 
-	core.PuregoSafeRegister(&xConverterInputStreamGetConverter, libs, "g_converter_input_stream_get_converter")
+	core.PuregoRegisterSince(&xNewConverterInputStream, libs, "g_converter_input_stream_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xConverterInputStreamGetConverter, libs, "g_converter_input_stream_get_converter", "GIO", "2.24")
 
 }
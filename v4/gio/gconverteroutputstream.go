@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,8 @@ func (x *ConverterOutputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -49,7 +49,7 @@ func (x *ConverterOutputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -60,7 +60,8 @@ func (x *ConverterOutputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -72,7 +73,7 @@ func (x *ConverterOutputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -83,7 +84,8 @@ func (x *ConverterOutputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -95,7 +97,7 @@ func (x *ConverterOutputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -106,7 +108,8 @@ func (x *ConverterOutputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -118,7 +121,7 @@ func (x *ConverterOutputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -129,7 +132,8 @@ func (x *ConverterOutputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -141,7 +145,7 @@ func (x *ConverterOutputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -155,11 +159,11 @@ func (x *ConverterOutputStreamPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Converter output stream implements [class@Gio.OutputStream] and allows
+// Converter output stream implements [OutputStream] and allows
 // conversion of data of various types during reading.
 //
 // As of GLib 2.34, `GConverterOutputStream` implements
-// [iface@Gio.PollableOutputStream].
+// [PollableOutputStream].
 type ConverterOutputStream struct {
 	FilterOutputStream
 }
@@ -178,7 +182,7 @@ func ConverterOutputStreamNewFromInternalPtr(ptr uintptr) *ConverterOutputStream
 
 var xNewConverterOutputStream func(uintptr, uintptr) uintptr
 
-// Creates a new converter output stream for the @base_stream.
+// Creates a new converter output stream for the base_stream.
 func NewConverterOutputStream(BaseStreamVar *OutputStream, ConverterVar Converter) *ConverterOutputStream {
 	var cls *ConverterOutputStream
 
@@ -194,7 +198,7 @@ func NewConverterOutputStream(BaseStreamVar *OutputStream, ConverterVar Converte
 
 var xConverterOutputStreamGetConverter func(uintptr) uintptr
 
-// Gets the #GConverter that is used by @converter_stream.
+// Gets the [Converter] that is used by converter_stream.
 func (x *ConverterOutputStream) GetConverter() *ConverterBase {
 	var cls *ConverterBase
 
@@ -220,10 +224,10 @@ func (c *ConverterOutputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableOutputStream but have only certain instances of that
-// class be pollable. If this method returns %FALSE, then the behavior
-// of other #GPollableOutputStream methods is undefined.
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableOutputStream] but have only certain instances of that
+// class be pollable. If this method returns false, then the behavior
+// of other [PollableOutputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -233,94 +237,94 @@ func (x *ConverterOutputStream) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be written, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be written, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_output_stream_is_writable(), it is possible that
+// As with [PollableOutputStream.IsWritable], it is possible that
 // the stream may not actually be writable even after the source
-// triggers, so you should use g_pollable_output_stream_write_nonblocking()
-// rather than g_output_stream_write() from the callback.
+// triggers, so you should use [PollableOutputStream.WriteNonblocking]
+// rather than [OutputStream.Write] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *ConverterOutputStream) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableOutputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be written.
+// Checks if stream can be written.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_output_stream_write()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [OutputStream.Write]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_output_stream_write_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableOutputStream.WriteNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *ConverterOutputStream) IsWritable() bool {
 
 	cret := XGPollableOutputStreamIsWritable(x.GoPointer())
 	return cret
 }
 
-// Attempts to write up to @count bytes from @buffer to @stream, as
-// with g_output_stream_write(). If @stream is not currently writable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable.
+// Attempts to write up to count bytes from buffer to stream, as
+// with [OutputStream.Write]. If stream is not currently writable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_IO_ERROR_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @buffer and
-// @count in the next write call.
+// Also note that if [GIoErrorWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same buffer and
+// count in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *ConverterOutputStream) WriteNonblocking(BufferVar []byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWriteNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Attempts to write the bytes contained in the @n_vectors @vectors to @stream,
-// as with g_output_stream_writev(). If @stream is not currently writable,
+// Attempts to write the bytes contained in the n_vectors vectors to stream,
+// as with [OutputStream.Writev]. If stream is not currently writable,
 // this will immediately return %@G_POLLABLE_RETURN_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable. @error will *not* be
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable. error will *not* be
 // set in that case.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_POLLABLE_RETURN_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @vectors and
-// @n_vectors in the next write call.
+// Also note that if [GPollableReturnWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same vectors and
+// n_vectors in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *ConverterOutputStream) WritevNonblocking(VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (PollableReturn, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWritevNonblocking(x.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -331,7 +335,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -340,8 +344,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xConverterOutputStreamGLibType, libs, "g_converter_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewConverterOutputStream, libs, "g_converter_output_stream_new")
+	gobject.CheckClassSize("GConverterOutputStreamClass", xConverterOutputStreamGLibType, unsafe.Sizeof(ConverterOutputStreamClass{}))
 
-	core.PuregoSafeRegister(&xConverterOutputStreamGetConverter, libs, "g_converter_output_stream_get_converter")
+	core.PuregoRegisterSince(&xNewConverterOutputStream, libs, "g_converter_output_stream_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xConverterOutputStreamGetConverter, libs, "g_converter_output_stream_get_converter", "GIO", "2.24")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GCredentials.
+// Class structure for [Credentials].
 type CredentialsClass struct {
 	_ structs.HostLayout
 }
@@ -28,15 +27,15 @@ func (x *CredentialsClass) GoPointer() uintptr {
 // authenticating and authorizing other processes.
 //
 // Some operating systems supports looking up the credentials of the remote
-// peer of a communication endpoint - see e.g. [method@Gio.Socket.get_credentials].
+// peer of a communication endpoint - see e.g. [Socket.GetCredentials].
 //
 // Some operating systems supports securely sending and receiving
-// credentials over a Unix Domain Socket, see [class@Gio.UnixCredentialsMessage],
-// [method@Gio.UnixConnection.send_credentials] and
-// [method@Gio.UnixConnection.receive_credentials] for details.
+// credentials over a Unix Domain Socket, see [UnixCredentialsMessage],
+// [UnixConnection.SendCredentials] and
+// [UnixConnection.ReceiveCredentials] for details.
 //
 // On Linux, the native credential type is a `struct ucred` - see the
-// [`unix(7)` man page](man:unix(7)) for details. This corresponds to
+// unix(7) man page) for details. This corresponds to
 // `G_CREDENTIALS_TYPE_LINUX_UCRED`.
 //
 // On Apple operating systems (including iOS, tvOS, and macOS), the native credential
@@ -74,7 +73,7 @@ func CredentialsNewFromInternalPtr(ptr uintptr) *Credentials {
 
 var xNewCredentials func() uintptr
 
-// Creates a new #GCredentials object with credentials matching the
+// Creates a new [Credentials] object with credentials matching the
 // the current process.
 func NewCredentials() *Credentials {
 	var cls *Credentials
@@ -91,12 +90,12 @@ func NewCredentials() *Credentials {
 
 var xCredentialsGetNative func(uintptr, CredentialsType) uintptr
 
-// Gets a pointer to native credentials of type @native_type from
-// @credentials.
+// Gets a pointer to native credentials of type native_type from
+// credentials.
 //
 // It is a programming error (which will cause a warning to be
-// logged) to use this method if there is no #GCredentials support for
-// the OS or if @native_type isn't supported by the OS.
+// logged) to use this method if there is no [Credentials] support for
+// the OS or if native_type isn't supported by the OS.
 func (x *Credentials) GetNative(NativeTypeVar CredentialsType) uintptr {
 
 	cret := xCredentialsGetNative(x.GoPointer(), NativeTypeVar)
@@ -105,10 +104,10 @@ func (x *Credentials) GetNative(NativeTypeVar CredentialsType) uintptr {
 
 var xCredentialsGetUnixPid func(uintptr) int
 
-// Tries to get the UNIX process identifier from @credentials. This
+// Tries to get the UNIX process identifier from credentials. This
 // method is only available on UNIX platforms.
 //
-// This operation can fail if #GCredentials is not supported on the
+// This operation can fail if [Credentials] is not supported on the
 // OS or if the native credentials type does not contain information
 // about the UNIX process ID.
 func (x *Credentials) GetUnixPid() (int, error) {
@@ -116,7 +115,7 @@ func (x *Credentials) GetUnixPid() (int, error) {
 
 	cret := xCredentialsGetUnixPid(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -124,10 +123,10 @@ func (x *Credentials) GetUnixPid() (int, error) {
 
 var xCredentialsGetUnixUser func(uintptr) uint
 
-// Tries to get the UNIX user identifier from @credentials. This
+// Tries to get the UNIX user identifier from credentials. This
 // method is only available on UNIX platforms.
 //
-// This operation can fail if #GCredentials is not supported on the
+// This operation can fail if [Credentials] is not supported on the
 // OS or if the native credentials type does not contain information
 // about the UNIX user.
 func (x *Credentials) GetUnixUser() (uint, error) {
@@ -135,7 +134,7 @@ func (x *Credentials) GetUnixUser() (uint, error) {
 
 	cret := xCredentialsGetUnixUser(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -143,16 +142,16 @@ func (x *Credentials) GetUnixUser() (uint, error) {
 
 var xCredentialsIsSameUser func(uintptr, uintptr, **glib.Error) bool
 
-// Checks if @credentials and @other_credentials is the same user.
+// Checks if credentials and other_credentials is the same user.
 //
-// This operation can fail if #GCredentials is not supported on the
+// This operation can fail if [Credentials] is not supported on the
 // the OS.
 func (x *Credentials) IsSameUser(OtherCredentialsVar *Credentials) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xCredentialsIsSameUser(x.GoPointer(), OtherCredentialsVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -160,12 +159,12 @@ func (x *Credentials) IsSameUser(OtherCredentialsVar *Credentials) (bool, error)
 
 var xCredentialsSetNative func(uintptr, CredentialsType, uintptr)
 
-// Copies the native credentials of type @native_type from @native
-// into @credentials.
+// Copies the native credentials of type native_type from native
+// into credentials.
 //
 // It is a programming error (which will cause a warning to be
-// logged) to use this method if there is no #GCredentials support for
-// the OS or if @native_type isn't supported by the OS.
+// logged) to use this method if there is no [Credentials] support for
+// the OS or if native_type isn't supported by the OS.
 func (x *Credentials) SetNative(NativeTypeVar CredentialsType, NativeVar uintptr) {
 
 	xCredentialsSetNative(x.GoPointer(), NativeTypeVar, NativeVar)
@@ -174,10 +173,10 @@ func (x *Credentials) SetNative(NativeTypeVar CredentialsType, NativeVar uintptr
 
 var xCredentialsSetUnixUser func(uintptr, uint, **glib.Error) bool
 
-// Tries to set the UNIX user identifier on @credentials. This method
+// Tries to set the UNIX user identifier on credentials. This method
 // is only available on UNIX platforms.
 //
-// This operation can fail if #GCredentials is not supported on the
+// This operation can fail if [Credentials] is not supported on the
 // OS or if the native credentials type does not contain information
 // about the UNIX user. It can also fail if the OS does not allow the
 // use of "spoofed" credentials.
@@ -186,7 +185,7 @@ func (x *Credentials) SetUnixUser(UidVar uint) (bool, error) {
 
 	cret := xCredentialsSetUnixUser(x.GoPointer(), UidVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -194,7 +193,7 @@ func (x *Credentials) SetUnixUser(UidVar uint) (bool, error) {
 
 var xCredentialsToString func(uintptr) string
 
-// Creates a human-readable textual representation of @credentials
+// Creates a human-readable textual representation of credentials
 // that can be used in logging and debug messages. The format of the
 // returned string may change in future GLib release.
 func (x *Credentials) ToString() string {
@@ -219,7 +218,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -228,14 +227,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xCredentialsGLibType, libs, "g_credentials_get_type")
 
-	core.PuregoSafeRegister(&xNewCredentials, libs, "g_credentials_new")
+	core.PuregoRegisterSince(&xNewCredentials, libs, "g_credentials_new", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xCredentialsGetNative, libs, "g_credentials_get_native")
-	core.PuregoSafeRegister(&xCredentialsGetUnixPid, libs, "g_credentials_get_unix_pid")
-	core.PuregoSafeRegister(&xCredentialsGetUnixUser, libs, "g_credentials_get_unix_user")
-	core.PuregoSafeRegister(&xCredentialsIsSameUser, libs, "g_credentials_is_same_user")
-	core.PuregoSafeRegister(&xCredentialsSetNative, libs, "g_credentials_set_native")
-	core.PuregoSafeRegister(&xCredentialsSetUnixUser, libs, "g_credentials_set_unix_user")
-	core.PuregoSafeRegister(&xCredentialsToString, libs, "g_credentials_to_string")
+	core.PuregoRegisterSince(&xCredentialsGetNative, libs, "g_credentials_get_native", "GIO", "2.26")
+	core.PuregoRegisterSince(&xCredentialsGetUnixPid, libs, "g_credentials_get_unix_pid", "GIO", "2.36")
+	core.PuregoRegisterSince(&xCredentialsGetUnixUser, libs, "g_credentials_get_unix_user", "GIO", "2.26")
+	core.PuregoRegisterSince(&xCredentialsIsSameUser, libs, "g_credentials_is_same_user", "GIO", "2.26")
+	core.PuregoRegisterSince(&xCredentialsSetNative, libs, "g_credentials_set_native", "GIO", "2.26")
+	core.PuregoRegisterSince(&xCredentialsSetUnixUser, libs, "g_credentials_set_unix_user", "GIO", "2.26")
+	core.PuregoRegisterSince(&xCredentialsToString, libs, "g_credentials_to_string", "GIO", "2.26")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -19,7 +19,7 @@ import (
 type DatagramBasedInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xReceiveMessages uintptr
 
@@ -37,100 +37,104 @@ func (x *DatagramBasedInterface) GoPointer() uintptr {
 }
 
 // OverrideReceiveMessages sets the "receive_messages" callback function.
-// Virtual method for g_datagram_based_receive_messages().
+// Virtual method for [DatagramBased.ReceiveMessages].
 func (x *DatagramBasedInterface) OverrideReceiveMessages(cb func(DatagramBased, []InputMessage, uint, int, int64, *Cancellable) int) {
 	if cb == nil {
 		x.xReceiveMessages = 0
 	} else {
-		x.xReceiveMessages = purego.NewCallback(func(DatagramBasedVarp uintptr, MessagesVarp []InputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int {
+		x.xReceiveMessages = core.NewCallback(func(DatagramBasedVarp uintptr, MessagesVarp []InputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&DatagramBasedBase{Ptr: DatagramBasedVarp}, MessagesVarp, NumMessagesVarp, FlagsVarp, TimeoutVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetReceiveMessages gets the "receive_messages" callback function.
-// Virtual method for g_datagram_based_receive_messages().
+// Virtual method for [DatagramBased.ReceiveMessages].
 func (x *DatagramBasedInterface) GetReceiveMessages() func(DatagramBased, []InputMessage, uint, int, int64, *Cancellable) int {
 	if x.xReceiveMessages == 0 {
 		return nil
 	}
 	var rawCallback func(DatagramBasedVarp uintptr, MessagesVarp []InputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xReceiveMessages)
+	core.RegisterFunc(&rawCallback, x.xReceiveMessages)
 	return func(DatagramBasedVar DatagramBased, MessagesVar []InputMessage, NumMessagesVar uint, FlagsVar int, TimeoutVar int64, CancellableVar *Cancellable) int {
 		return rawCallback(DatagramBasedVar.GoPointer(), MessagesVar, NumMessagesVar, FlagsVar, TimeoutVar, CancellableVar.GoPointer())
 	}
 }
 
 // OverrideSendMessages sets the "send_messages" callback function.
-// Virtual method for g_datagram_based_send_messages().
+// Virtual method for [DatagramBased.SendMessages].
 func (x *DatagramBasedInterface) OverrideSendMessages(cb func(DatagramBased, []OutputMessage, uint, int, int64, *Cancellable) int) {
 	if cb == nil {
 		x.xSendMessages = 0
 	} else {
-		x.xSendMessages = purego.NewCallback(func(DatagramBasedVarp uintptr, MessagesVarp []OutputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int {
+		x.xSendMessages = core.NewCallback(func(DatagramBasedVarp uintptr, MessagesVarp []OutputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&DatagramBasedBase{Ptr: DatagramBasedVarp}, MessagesVarp, NumMessagesVarp, FlagsVarp, TimeoutVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetSendMessages gets the "send_messages" callback function.
-// Virtual method for g_datagram_based_send_messages().
+// Virtual method for [DatagramBased.SendMessages].
 func (x *DatagramBasedInterface) GetSendMessages() func(DatagramBased, []OutputMessage, uint, int, int64, *Cancellable) int {
 	if x.xSendMessages == 0 {
 		return nil
 	}
 	var rawCallback func(DatagramBasedVarp uintptr, MessagesVarp []OutputMessage, NumMessagesVarp uint, FlagsVarp int, TimeoutVarp int64, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xSendMessages)
+	core.RegisterFunc(&rawCallback, x.xSendMessages)
 	return func(DatagramBasedVar DatagramBased, MessagesVar []OutputMessage, NumMessagesVar uint, FlagsVar int, TimeoutVar int64, CancellableVar *Cancellable) int {
 		return rawCallback(DatagramBasedVar.GoPointer(), MessagesVar, NumMessagesVar, FlagsVar, TimeoutVar, CancellableVar.GoPointer())
 	}
 }
 
 // OverrideCreateSource sets the "create_source" callback function.
-// Virtual method for g_datagram_based_create_source().
+// Virtual method for [DatagramBased.CreateSource].
 func (x *DatagramBasedInterface) OverrideCreateSource(cb func(DatagramBased, glib.IOCondition, *Cancellable) *glib.Source) {
 	if cb == nil {
 		x.xCreateSource = 0
 	} else {
-		x.xCreateSource = purego.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, CancellableVarp uintptr) *glib.Source {
+		x.xCreateSource = core.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, CancellableVarp uintptr) *glib.Source {
+			defer core.RecoverPanic()
 			return cb(&DatagramBasedBase{Ptr: DatagramBasedVarp}, ConditionVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetCreateSource gets the "create_source" callback function.
-// Virtual method for g_datagram_based_create_source().
+// Virtual method for [DatagramBased.CreateSource].
 func (x *DatagramBasedInterface) GetCreateSource() func(DatagramBased, glib.IOCondition, *Cancellable) *glib.Source {
 	if x.xCreateSource == 0 {
 		return nil
 	}
 	var rawCallback func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, CancellableVarp uintptr) *glib.Source
-	purego.RegisterFunc(&rawCallback, x.xCreateSource)
+	core.RegisterFunc(&rawCallback, x.xCreateSource)
 	return func(DatagramBasedVar DatagramBased, ConditionVar glib.IOCondition, CancellableVar *Cancellable) *glib.Source {
 		return rawCallback(DatagramBasedVar.GoPointer(), ConditionVar, CancellableVar.GoPointer())
 	}
 }
 
 // OverrideConditionCheck sets the "condition_check" callback function.
-// Virtual method for g_datagram_based_condition_check().
+// Virtual method for [DatagramBased.ConditionCheck].
 func (x *DatagramBasedInterface) OverrideConditionCheck(cb func(DatagramBased, glib.IOCondition) glib.IOCondition) {
 	if cb == nil {
 		x.xConditionCheck = 0
 	} else {
-		x.xConditionCheck = purego.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition) glib.IOCondition {
+		x.xConditionCheck = core.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition) glib.IOCondition {
+			defer core.RecoverPanic()
 			return cb(&DatagramBasedBase{Ptr: DatagramBasedVarp}, ConditionVarp)
 		})
 	}
 }
 
 // GetConditionCheck gets the "condition_check" callback function.
-// Virtual method for g_datagram_based_condition_check().
+// Virtual method for [DatagramBased.ConditionCheck].
 func (x *DatagramBasedInterface) GetConditionCheck() func(DatagramBased, glib.IOCondition) glib.IOCondition {
 	if x.xConditionCheck == 0 {
 		return nil
 	}
 	var rawCallback func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition) glib.IOCondition
-	purego.RegisterFunc(&rawCallback, x.xConditionCheck)
+	core.RegisterFunc(&rawCallback, x.xConditionCheck)
 	return func(DatagramBasedVar DatagramBased, ConditionVar glib.IOCondition) glib.IOCondition {
 		return rawCallback(DatagramBasedVar.GoPointer(), ConditionVar)
 	}
@@ -139,12 +143,13 @@ func (x *DatagramBasedInterface) GetConditionCheck() func(DatagramBased, glib.IO
 // OverrideConditionWait sets the "condition_wait" callback function.
 // Virtual method for
 //
-//	g_datagram_based_condition_wait().
+//	[DatagramBased.ConditionWait].
 func (x *DatagramBasedInterface) OverrideConditionWait(cb func(DatagramBased, glib.IOCondition, int64, *Cancellable) bool) {
 	if cb == nil {
 		x.xConditionWait = 0
 	} else {
-		x.xConditionWait = purego.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, TimeoutVarp int64, CancellableVarp uintptr) bool {
+		x.xConditionWait = core.NewCallback(func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, TimeoutVarp int64, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DatagramBasedBase{Ptr: DatagramBasedVarp}, ConditionVarp, TimeoutVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -153,13 +158,13 @@ func (x *DatagramBasedInterface) OverrideConditionWait(cb func(DatagramBased, gl
 // GetConditionWait gets the "condition_wait" callback function.
 // Virtual method for
 //
-//	g_datagram_based_condition_wait().
+//	[DatagramBased.ConditionWait].
 func (x *DatagramBasedInterface) GetConditionWait() func(DatagramBased, glib.IOCondition, int64, *Cancellable) bool {
 	if x.xConditionWait == 0 {
 		return nil
 	}
 	var rawCallback func(DatagramBasedVarp uintptr, ConditionVarp glib.IOCondition, TimeoutVarp int64, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xConditionWait)
+	core.RegisterFunc(&rawCallback, x.xConditionWait)
 	return func(DatagramBasedVar DatagramBased, ConditionVar glib.IOCondition, TimeoutVar int64, CancellableVar *Cancellable) bool {
 		return rawCallback(DatagramBasedVar.GoPointer(), ConditionVar, TimeoutVar, CancellableVar.GoPointer())
 	}
@@ -170,10 +175,10 @@ func (x *DatagramBasedInterface) GetConditionWait() func(DatagramBased, glib.IOC
 // A `GDatagramBased` is a networking interface for representing datagram-based
 // communications. It is a more or less direct mapping of the core parts of the
 // BSD socket API in a portable GObject interface. It is implemented by
-// [class@Gio.Socket], which wraps the UNIX socket API on UNIX and winsock2 on Windows.
+// [Socket], which wraps the UNIX socket API on UNIX and winsock2 on Windows.
 //
 // `GDatagramBased` is entirely platform independent, and is intended to be used
-// alongside higher-level networking APIs such as [class@Gio.IOStream].
+// alongside higher-level networking APIs such as [IOStream].
 //
 // It uses vectored scatter/gather I/O by default, allowing for many messages
 // to be sent or received in a single call. Where possible, implementations of
@@ -191,9 +196,9 @@ func (x *DatagramBasedInterface) GetConditionWait() func(DatagramBased, glib.IOC
 // will block until the operation is complete or the timeout expires; if the
 // timeout expires it will return what progress it made, or
 // `G_IO_ERROR_TIMED_OUT` if no progress was made. To know when a call would
-// successfully run you can call [method@Gio.DatagramBased.condition_check] or
-// [method@Gio.DatagramBased.condition_wait]. You can also use
-// [method@Gio.DatagramBased.create_source] and attach it to a [struct@GLib.MainContext]
+// successfully run you can call [DatagramBased.ConditionCheck] or
+// [DatagramBased.ConditionWait]. You can also use
+// [DatagramBased.CreateSource] and attach it to a [glib.MainContext]
 // to get callbacks when I/O is possible.
 //
 // When running a non-blocking operation applications should always be able to
@@ -245,40 +250,108 @@ func (x *DatagramBasedBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Checks on the readiness of @datagram_based to perform operations. The
-// operations specified in @condition are checked for and masked against the
-// currently-satisfied conditions on @datagram_based. The result is returned.
+// ImplementDatagramBased adds the DatagramBased interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - ReceiveMessages([]InputMessage, uint, int, int64, *Cancellable) int
+//   - SendMessages([]OutputMessage, uint, int, int64, *Cancellable) int
+//   - CreateSource(glib.IOCondition, *Cancellable) *glib.Source
+//   - ConditionCheck(glib.IOCondition) glib.IOCondition
+//   - ConditionWait(glib.IOCondition, int64, *Cancellable) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DatagramBasedInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDatagramBased[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DatagramBasedGLibType(), func(iface *DatagramBasedInterface) {
+		if _, ok := any(zero).(interface {
+			ReceiveMessages([]InputMessage, uint, int, int64, *Cancellable) int
+		}); ok {
+			iface.OverrideReceiveMessages(func(self DatagramBased, arg0 []InputMessage, arg1 uint, arg2 int, arg3 int64, arg4 *Cancellable) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReceiveMessages([]InputMessage, uint, int, int64, *Cancellable) int
+				})
+				return impl.ReceiveMessages(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SendMessages([]OutputMessage, uint, int, int64, *Cancellable) int
+		}); ok {
+			iface.OverrideSendMessages(func(self DatagramBased, arg0 []OutputMessage, arg1 uint, arg2 int, arg3 int64, arg4 *Cancellable) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SendMessages([]OutputMessage, uint, int, int64, *Cancellable) int
+				})
+				return impl.SendMessages(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateSource(glib.IOCondition, *Cancellable) *glib.Source
+		}); ok {
+			iface.OverrideCreateSource(func(self DatagramBased, arg0 glib.IOCondition, arg1 *Cancellable) *glib.Source {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateSource(glib.IOCondition, *Cancellable) *glib.Source
+				})
+				return impl.CreateSource(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ConditionCheck(glib.IOCondition) glib.IOCondition
+		}); ok {
+			iface.OverrideConditionCheck(func(self DatagramBased, arg0 glib.IOCondition) glib.IOCondition {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ConditionCheck(glib.IOCondition) glib.IOCondition
+				})
+				return impl.ConditionCheck(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ConditionWait(glib.IOCondition, int64, *Cancellable) bool
+		}); ok {
+			iface.OverrideConditionWait(func(self DatagramBased, arg0 glib.IOCondition, arg1 int64, arg2 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ConditionWait(glib.IOCondition, int64, *Cancellable) bool
+				})
+				return impl.ConditionWait(arg0, arg1, arg2)
+			})
+		}
+	})
+}
+
+// Checks on the readiness of datagram_based to perform operations. The
+// operations specified in condition are checked for and masked against the
+// currently-satisfied conditions on datagram_based. The result is returned.
 //
-// %G_IO_IN will be set in the return value if data is available to read with
-// g_datagram_based_receive_messages(), or if the connection is closed remotely
+// [glib.GIoInValue] will be set in the return value if data is available to read with
+// [DatagramBased.ReceiveMessages], or if the connection is closed remotely
 // (EOS); and if the datagram_based has not been closed locally using some
-// implementation-specific method (such as g_socket_close() or
-// g_socket_shutdown() with @shutdown_read set, if it’s a #GSocket).
+// implementation-specific method (such as [Socket.Close] or
+// [Socket.Shutdown] with shutdown_read set, if it’s a [Socket]).
 //
-// If the connection is shut down or closed (by calling g_socket_close() or
-// g_socket_shutdown() with @shutdown_read set, if it’s a #GSocket, for
-// example), all calls to this function will return %G_IO_ERROR_CLOSED.
+// If the connection is shut down or closed (by calling [Socket.Close] or
+// [Socket.Shutdown] with shutdown_read set, if it’s a [Socket], for
+// example), all calls to this function will return [GIoErrorClosedValue].
 //
-// %G_IO_OUT will be set if it is expected that at least one byte can be sent
-// using g_datagram_based_send_messages() without blocking. It will not be set
+// [glib.GIoOutValue] will be set if it is expected that at least one byte can be sent
+// using [DatagramBased.SendMessages] without blocking. It will not be set
 // if the datagram_based has been closed locally.
 //
-// %G_IO_HUP will be set if the connection has been closed locally.
+// [glib.GIoHupValue] will be set if the connection has been closed locally.
 //
-// %G_IO_ERR will be set if there was an asynchronous error in transmitting data
-// previously enqueued using g_datagram_based_send_messages().
+// [glib.GIoErrValue] will be set if there was an asynchronous error in transmitting data
+// previously enqueued using [DatagramBased.SendMessages].
 //
 // Note that on Windows, it is possible for an operation to return
-// %G_IO_ERROR_WOULD_BLOCK even immediately after
-// g_datagram_based_condition_check() has claimed that the #GDatagramBased is
-// ready for writing. Rather than calling g_datagram_based_condition_check() and
-// then writing to the #GDatagramBased if it succeeds, it is generally better to
+// [GIoErrorWouldBlockValue] even immediately after
+// [DatagramBased.ConditionCheck] has claimed that the [DatagramBased] is
+// ready for writing. Rather than calling [DatagramBased.ConditionCheck] and
+// then writing to the [DatagramBased] if it succeeds, it is generally better to
 // simply try writing right away, and try again later if the initial attempt
-// returns %G_IO_ERROR_WOULD_BLOCK.
+// returns [GIoErrorWouldBlockValue].
 //
-// It is meaningless to specify %G_IO_ERR or %G_IO_HUP in @condition; these
+// It is meaningless to specify [glib.GIoErrValue] or [glib.GIoHupValue] in condition; these
 // conditions will always be set in the output if they are true. Apart from
-// these flags, the output is guaranteed to be masked by @condition.
+// these flags, the output is guaranteed to be masked by condition.
 //
 // This call never blocks.
 func (x *DatagramBasedBase) ConditionCheck(ConditionVar glib.IOCondition) glib.IOCondition {
@@ -287,151 +360,151 @@ func (x *DatagramBasedBase) ConditionCheck(ConditionVar glib.IOCondition) glib.I
 	return cret
 }
 
-// Waits for up to @timeout microseconds for condition to become true on
-// @datagram_based. If the condition is met, %TRUE is returned.
+// Waits for up to timeout microseconds for condition to become true on
+// datagram_based. If the condition is met, true is returned.
 //
-// If @cancellable is cancelled before the condition is met, or if @timeout is
-// reached before the condition is met, then %FALSE is returned and @error is
-// set appropriately (%G_IO_ERROR_CANCELLED or %G_IO_ERROR_TIMED_OUT).
+// If cancellable is cancelled before the condition is met, or if timeout is
+// reached before the condition is met, then false is returned and error is
+// set appropriately ([GIoErrorCancelledValue] or [GIoErrorTimedOutValue]).
 func (x *DatagramBasedBase) ConditionWait(ConditionVar glib.IOCondition, TimeoutVar int64, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDatagramBasedConditionWait(x.GoPointer(), ConditionVar, TimeoutVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Creates a #GSource that can be attached to a #GMainContext to monitor for
-// the availability of the specified @condition on the #GDatagramBased. The
-// #GSource keeps a reference to the @datagram_based.
+// Creates a [glib.Source] that can be attached to a [glib.MainContext] to monitor for
+// the availability of the specified condition on the [DatagramBased]. The
+// [glib.Source] keeps a reference to the datagram_based.
 //
-// The callback on the source is of the #GDatagramBasedSourceFunc type.
+// The callback on the source is of the [DatagramBasedSourceFunc] type.
 //
-// It is meaningless to specify %G_IO_ERR or %G_IO_HUP in @condition; these
+// It is meaningless to specify [glib.GIoErrValue] or [glib.GIoHupValue] in condition; these
 // conditions will always be reported in the callback if they are true.
 //
-// If non-%NULL, @cancellable can be used to cancel the source, which will
+// If non-nil, cancellable can be used to cancel the source, which will
 // cause the source to trigger, reporting the current condition (which is
 // likely 0 unless cancellation happened at the same time as a condition
 // change). You can check for this in the callback using
-// g_cancellable_is_cancelled().
+// [Cancellable.IsCancelled].
 func (x *DatagramBasedBase) CreateSource(ConditionVar glib.IOCondition, CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGDatagramBasedCreateSource(x.GoPointer(), ConditionVar, CancellableVar.GoPointer())
 	return cret
 }
 
-// Receive one or more data messages from @datagram_based in one go.
+// Receive one or more data messages from datagram_based in one go.
 //
-// @messages must point to an array of #GInputMessage structs and
-// @num_messages must be the length of this array. Each #GInputMessage
-// contains a pointer to an array of #GInputVector structs describing the
+// messages must point to an array of [InputMessage] structs and
+// num_messages must be the length of this array. Each [InputMessage]
+// contains a pointer to an array of [InputVector] structs describing the
 // buffers that the data received in each message will be written to.
 //
-// @flags modify how all messages are received. The commonly available
-// arguments for this are available in the #GSocketMsgFlags enum, but the
+// flags modify how all messages are received. The commonly available
+// arguments for this are available in the [SocketMsgFlags] enum, but the
 // values there are the same as the system values, and the flags
 // are passed in as-is, so you can pass in system-specific flags too. These
 // flags affect the overall receive operation. Flags affecting individual
-// messages are returned in #GInputMessage.flags.
+// messages are returned in [InputMessage].flags.
 //
-// The other members of #GInputMessage are treated as described in its
+// The other members of [InputMessage] are treated as described in its
 // documentation.
 //
-// If @timeout is negative the call will block until @num_messages have been
-// received, the connection is closed remotely (EOS), @cancellable is cancelled,
+// If timeout is negative the call will block until num_messages have been
+// received, the connection is closed remotely (EOS), cancellable is cancelled,
 // or an error occurs.
 //
-// If @timeout is 0 the call will return up to @num_messages without blocking,
-// or %G_IO_ERROR_WOULD_BLOCK if no messages are queued in the operating system
+// If timeout is 0 the call will return up to num_messages without blocking,
+// or [GIoErrorWouldBlockValue] if no messages are queued in the operating system
 // to be received.
 //
-// If @timeout is positive the call will block on the same conditions as if
-// @timeout were negative. If the timeout is reached
-// before any messages are received, %G_IO_ERROR_TIMED_OUT is returned,
+// If timeout is positive the call will block on the same conditions as if
+// timeout were negative. If the timeout is reached
+// before any messages are received, [GIoErrorTimedOutValue] is returned,
 // otherwise it will return the number of messages received before timing out.
 // (Note: This is effectively the behaviour of `MSG_WAITFORONE` with
 // recvmmsg().)
 //
-// To be notified when messages are available, wait for the %G_IO_IN condition.
-// Note though that you may still receive %G_IO_ERROR_WOULD_BLOCK from
-// g_datagram_based_receive_messages() even if you were previously notified of a
-// %G_IO_IN condition.
+// To be notified when messages are available, wait for the [glib.GIoInValue] condition.
+// Note though that you may still receive [GIoErrorWouldBlockValue] from
+// [DatagramBased.ReceiveMessages] even if you were previously notified of a
+// [glib.GIoInValue] condition.
 //
 // If the remote peer closes the connection, any messages queued in the
 // underlying receive buffer will be returned, and subsequent calls to
-// g_datagram_based_receive_messages() will return 0 (with no error set).
+// [DatagramBased.ReceiveMessages] will return 0 (with no error set).
 //
-// If the connection is shut down or closed (by calling g_socket_close() or
-// g_socket_shutdown() with @shutdown_read set, if it’s a #GSocket, for
-// example), all calls to this function will return %G_IO_ERROR_CLOSED.
+// If the connection is shut down or closed (by calling [Socket.Close] or
+// [Socket.Shutdown] with shutdown_read set, if it’s a [Socket], for
+// example), all calls to this function will return [GIoErrorClosedValue].
 //
-// On error -1 is returned and @error is set accordingly. An error will only
+// On error -1 is returned and error is set accordingly. An error will only
 // be returned if zero messages could be received; otherwise the number of
 // messages successfully received before the error will be returned. If
-// @cancellable is cancelled, %G_IO_ERROR_CANCELLED is returned as with any
+// cancellable is cancelled, [GIoErrorCancelledValue] is returned as with any
 // other error.
 func (x *DatagramBasedBase) ReceiveMessages(MessagesVar []InputMessage, NumMessagesVar uint, FlagsVar int, TimeoutVar int64, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGDatagramBasedReceiveMessages(x.GoPointer(), MessagesVar, NumMessagesVar, FlagsVar, TimeoutVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Send one or more data messages from @datagram_based in one go.
+// Send one or more data messages from datagram_based in one go.
 //
-// @messages must point to an array of #GOutputMessage structs and
-// @num_messages must be the length of this array. Each #GOutputMessage
+// messages must point to an array of [OutputMessage] structs and
+// num_messages must be the length of this array. Each [OutputMessage]
 // contains an address to send the data to, and a pointer to an array of
-// #GOutputVector structs to describe the buffers that the data to be sent
+// [OutputVector] structs to describe the buffers that the data to be sent
 // for each message will be gathered from.
 //
-// @flags modify how the message is sent. The commonly available arguments
-// for this are available in the #GSocketMsgFlags enum, but the
+// flags modify how the message is sent. The commonly available arguments
+// for this are available in the [SocketMsgFlags] enum, but the
 // values there are the same as the system values, and the flags
 // are passed in as-is, so you can pass in system-specific flags too.
 //
-// The other members of #GOutputMessage are treated as described in its
+// The other members of [OutputMessage] are treated as described in its
 // documentation.
 //
-// If @timeout is negative the call will block until @num_messages have been
-// sent, @cancellable is cancelled, or an error occurs.
+// If timeout is negative the call will block until num_messages have been
+// sent, cancellable is cancelled, or an error occurs.
 //
-// If @timeout is 0 the call will send up to @num_messages without blocking,
-// or will return %G_IO_ERROR_WOULD_BLOCK if there is no space to send messages.
+// If timeout is 0 the call will send up to num_messages without blocking,
+// or will return [GIoErrorWouldBlockValue] if there is no space to send messages.
 //
-// If @timeout is positive the call will block on the same conditions as if
-// @timeout were negative. If the timeout is reached before any messages are
-// sent, %G_IO_ERROR_TIMED_OUT is returned, otherwise it will return the number
+// If timeout is positive the call will block on the same conditions as if
+// timeout were negative. If the timeout is reached before any messages are
+// sent, [GIoErrorTimedOutValue] is returned, otherwise it will return the number
 // of messages sent before timing out.
 //
-// To be notified when messages can be sent, wait for the %G_IO_OUT condition.
-// Note though that you may still receive %G_IO_ERROR_WOULD_BLOCK from
-// g_datagram_based_send_messages() even if you were previously notified of a
-// %G_IO_OUT condition. (On Windows in particular, this is very common due to
+// To be notified when messages can be sent, wait for the [glib.GIoOutValue] condition.
+// Note though that you may still receive [GIoErrorWouldBlockValue] from
+// [DatagramBased.SendMessages] even if you were previously notified of a
+// [glib.GIoOutValue] condition. (On Windows in particular, this is very common due to
 // the way the underlying APIs work.)
 //
-// If the connection is shut down or closed (by calling g_socket_close() or
-// g_socket_shutdown() with @shutdown_write set, if it’s a #GSocket, for
-// example), all calls to this function will return %G_IO_ERROR_CLOSED.
+// If the connection is shut down or closed (by calling [Socket.Close] or
+// [Socket.Shutdown] with shutdown_write set, if it’s a [Socket], for
+// example), all calls to this function will return [GIoErrorClosedValue].
 //
-// On error -1 is returned and @error is set accordingly. An error will only
+// On error -1 is returned and error is set accordingly. An error will only
 // be returned if zero messages could be sent; otherwise the number of messages
-// successfully sent before the error will be returned. If @cancellable is
-// cancelled, %G_IO_ERROR_CANCELLED is returned as with any other error.
+// successfully sent before the error will be returned. If cancellable is
+// cancelled, [GIoErrorCancelledValue] is returned as with any other error.
 func (x *DatagramBasedBase) SendMessages(MessagesVar []OutputMessage, NumMessagesVar uint, FlagsVar int, TimeoutVar int64, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGDatagramBasedSendMessages(x.GoPointer(), MessagesVar, NumMessagesVar, FlagsVar, TimeoutVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -448,7 +521,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -457,10 +530,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xDatagramBasedGLibType, libs, "g_datagram_based_get_type")
 
-	core.PuregoSafeRegister(&XGDatagramBasedConditionCheck, libs, "g_datagram_based_condition_check")
-	core.PuregoSafeRegister(&XGDatagramBasedConditionWait, libs, "g_datagram_based_condition_wait")
-	core.PuregoSafeRegister(&XGDatagramBasedCreateSource, libs, "g_datagram_based_create_source")
-	core.PuregoSafeRegister(&XGDatagramBasedReceiveMessages, libs, "g_datagram_based_receive_messages")
-	core.PuregoSafeRegister(&XGDatagramBasedSendMessages, libs, "g_datagram_based_send_messages")
+	gobject.CheckClassSize("GDatagramBasedInterface", xDatagramBasedGLibType, unsafe.Sizeof(DatagramBasedInterface{}))
+
+	core.PuregoRegisterSince(&XGDatagramBasedConditionCheck, libs, "g_datagram_based_condition_check", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDatagramBasedConditionWait, libs, "g_datagram_based_condition_wait", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDatagramBasedCreateSource, libs, "g_datagram_based_create_source", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDatagramBasedReceiveMessages, libs, "g_datagram_based_receive_messages", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDatagramBasedSendMessages, libs, "g_datagram_based_send_messages", "GIO", "2.48")
 
 }
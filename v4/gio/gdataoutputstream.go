@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -36,7 +36,8 @@ func (x *DataOutputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -48,7 +49,7 @@ func (x *DataOutputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -59,7 +60,8 @@ func (x *DataOutputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -71,7 +73,7 @@ func (x *DataOutputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -82,7 +84,8 @@ func (x *DataOutputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -94,7 +97,7 @@ func (x *DataOutputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -105,7 +108,8 @@ func (x *DataOutputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -117,7 +121,7 @@ func (x *DataOutputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -128,7 +132,8 @@ func (x *DataOutputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -140,7 +145,7 @@ func (x *DataOutputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -154,7 +159,7 @@ func (x *DataOutputStreamPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Data output stream implements [class@Gio.OutputStream] and includes functions
+// Data output stream implements [OutputStream] and includes functions
 // for writing data directly to an output stream.
 type DataOutputStream struct {
 	FilterOutputStream
@@ -174,7 +179,7 @@ func DataOutputStreamNewFromInternalPtr(ptr uintptr) *DataOutputStream {
 
 var xNewDataOutputStream func(uintptr) uintptr
 
-// Creates a new data output stream for @base_stream.
+// Creates a new data output stream for base_stream.
 func NewDataOutputStream(BaseStreamVar *OutputStream) *DataOutputStream {
 	var cls *DataOutputStream
 
@@ -205,7 +210,7 @@ func (x *DataOutputStream) PutByte(DataVar byte, CancellableVar *Cancellable) (b
 
 	cret := xDataOutputStreamPutByte(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -219,7 +224,7 @@ func (x *DataOutputStream) PutInt16(DataVar int16, CancellableVar *Cancellable)
 
 	cret := xDataOutputStreamPutInt16(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -233,7 +238,7 @@ func (x *DataOutputStream) PutInt32(DataVar int32, CancellableVar *Cancellable)
 
 	cret := xDataOutputStreamPutInt32(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -247,7 +252,7 @@ func (x *DataOutputStream) PutInt64(DataVar int64, CancellableVar *Cancellable)
 
 	cret := xDataOutputStreamPutInt64(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -261,7 +266,7 @@ func (x *DataOutputStream) PutString(StrVar string, CancellableVar *Cancellable)
 
 	cret := xDataOutputStreamPutString(x.GoPointer(), StrVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -275,7 +280,7 @@ func (x *DataOutputStream) PutUint16(DataVar uint16, CancellableVar *Cancellable
 
 	cret := xDataOutputStreamPutUint16(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -289,7 +294,7 @@ func (x *DataOutputStream) PutUint32(DataVar uint32, CancellableVar *Cancellable
 
 	cret := xDataOutputStreamPutUint32(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -303,7 +308,7 @@ func (x *DataOutputStream) PutUint64(DataVar uint64, CancellableVar *Cancellable
 
 	cret := xDataOutputStreamPutUint64(x.GoPointer(), DataVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -311,7 +316,7 @@ func (x *DataOutputStream) PutUint64(DataVar uint64, CancellableVar *Cancellable
 
 var xDataOutputStreamSetByteOrder func(uintptr, DataStreamByteOrder)
 
-// Sets the byte order of the data output stream to @order.
+// Sets the byte order of the data output stream to order.
 func (x *DataOutputStream) SetByteOrder(OrderVar DataStreamByteOrder) {
 
 	xDataOutputStreamSetByteOrder(x.GoPointer(), OrderVar)
@@ -329,7 +334,7 @@ func (c *DataOutputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *DataOutputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -337,14 +342,14 @@ func (x *DataOutputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *DataOutputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -355,15 +360,15 @@ func (x *DataOutputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *DataOutputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -376,13 +381,13 @@ func (x *DataOutputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *DataOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -390,7 +395,7 @@ func (x *DataOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -401,7 +406,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -410,17 +415,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xDataOutputStreamGLibType, libs, "g_data_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewDataOutputStream, libs, "g_data_output_stream_new")
-
-	core.PuregoSafeRegister(&xDataOutputStreamGetByteOrder, libs, "g_data_output_stream_get_byte_order")
-	core.PuregoSafeRegister(&xDataOutputStreamPutByte, libs, "g_data_output_stream_put_byte")
-	core.PuregoSafeRegister(&xDataOutputStreamPutInt16, libs, "g_data_output_stream_put_int16")
-	core.PuregoSafeRegister(&xDataOutputStreamPutInt32, libs, "g_data_output_stream_put_int32")
-	core.PuregoSafeRegister(&xDataOutputStreamPutInt64, libs, "g_data_output_stream_put_int64")
-	core.PuregoSafeRegister(&xDataOutputStreamPutString, libs, "g_data_output_stream_put_string")
-	core.PuregoSafeRegister(&xDataOutputStreamPutUint16, libs, "g_data_output_stream_put_uint16")
-	core.PuregoSafeRegister(&xDataOutputStreamPutUint32, libs, "g_data_output_stream_put_uint32")
-	core.PuregoSafeRegister(&xDataOutputStreamPutUint64, libs, "g_data_output_stream_put_uint64")
-	core.PuregoSafeRegister(&xDataOutputStreamSetByteOrder, libs, "g_data_output_stream_set_byte_order")
+	gobject.CheckClassSize("GDataOutputStreamClass", xDataOutputStreamGLibType, unsafe.Sizeof(DataOutputStreamClass{}))
+
+	core.PuregoRegisterSince(&xNewDataOutputStream, libs, "g_data_output_stream_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xDataOutputStreamGetByteOrder, libs, "g_data_output_stream_get_byte_order", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutByte, libs, "g_data_output_stream_put_byte", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutInt16, libs, "g_data_output_stream_put_int16", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutInt32, libs, "g_data_output_stream_put_int32", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutInt64, libs, "g_data_output_stream_put_int64", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutString, libs, "g_data_output_stream_put_string", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutUint16, libs, "g_data_output_stream_put_uint16", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutUint32, libs, "g_data_output_stream_put_uint32", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamPutUint64, libs, "g_data_output_stream_put_uint64", "GIO", "")
+	core.PuregoRegisterSince(&xDataOutputStreamSetByteOrder, libs, "g_data_output_stream_set_byte_order", "GIO", "")
 
 }
@@ -2,18 +2,17 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GDBusActionGroup` is an implementation of the [iface@Gio.ActionGroup]
+// `GDBusActionGroup` is an implementation of the [ActionGroup]
 // interface.
 //
 // `GDBusActionGroup` can be used as a proxy for an action group
-// that is exported over D-Bus with [method@Gio.DBusConnection.export_action_group].
+// that is exported over D-Bus with [DBusConnection.ExportActionGroup].
 type DBusActionGroup struct {
 	gobject.Object
 }
@@ -41,99 +40,97 @@ func (c *DBusActionGroup) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *DBusActionGroup) ActionAdded(ActionNameVar string) {
 
 	XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *DBusActionGroup) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *DBusActionGroup) ActionRemoved(ActionNameVar string) {
 
 	XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *DBusActionGroup) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [DBusConnection.Flush] is called
+// before `exit()`. Without `[DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *DBusActionGroup) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *DBusActionGroup) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -144,14 +141,14 @@ func (x *DBusActionGroup) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -162,14 +159,14 @@ func (x *DBusActionGroup) GetActionParameterType(ActionNameVar string) *glib.Var
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *DBusActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -177,14 +174,14 @@ func (x *DBusActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -193,7 +190,7 @@ func (x *DBusActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *DBusActionGroup) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -201,17 +198,17 @@ func (x *DBusActionGroup) GetActionStateHint(ActionNameVar string) *glib.Variant
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [ActionGroup.GetActionState] will return `NULL`
+// and you must not call [ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -222,38 +219,38 @@ func (x *DBusActionGroup) GetActionStateType(ActionNameVar string) *glib.Variant
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *DBusActionGroup) HasAction(ActionNameVar string) bool {
 
 	cret := XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *DBusActionGroup) ListActions() []string {
 
 	cret := XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [ActionGroup.HasAction], [ActionGroup.GetActionEnabled],
+// [ActionGroup.GetActionParameterType],
+// [ActionGroup.GetActionStateType],
+// [ActionGroup.GetActionStateHint] and
+// [ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -274,13 +271,13 @@ func (x *DBusActionGroup) QueryAction(ActionNameVar string, EnabledVar *bool, Pa
 
 // Activates the remote action.
 //
-// This is the same as g_action_group_activate_action() except that it
+// This is the same as [ActionGroup.ActivateAction] except that it
 // allows for provision of "platform data" to be sent along with the
 // activation request.  This typically contains details such as the user
 // interaction timestamp or startup notification information.
 //
-// @platform_data must be non-%NULL and must have the type
-// %G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
+// platform_data must be non-nil and must have the type
+// G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
 func (x *DBusActionGroup) ActivateActionFull(ActionNameVar string, ParameterVar *glib.Variant, PlatformDataVar *glib.Variant) {
 
 	XGRemoteActionGroupActivateActionFull(x.GoPointer(), ActionNameVar, ParameterVar, PlatformDataVar)
@@ -289,13 +286,13 @@ func (x *DBusActionGroup) ActivateActionFull(ActionNameVar string, ParameterVar
 
 // Changes the state of a remote action.
 //
-// This is the same as g_action_group_change_action_state() except that
+// This is the same as [ActionGroup.ChangeActionState] except that
 // it allows for provision of "platform data" to be sent along with the
 // state change request.  This typically contains details such as the
 // user interaction timestamp or startup notification information.
 //
-// @platform_data must be non-%NULL and must have the type
-// %G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
+// platform_data must be non-nil and must have the type
+// G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
 func (x *DBusActionGroup) ChangeActionStateFull(ActionNameVar string, ValueVar *glib.Variant, PlatformDataVar *glib.Variant) {
 
 	XGRemoteActionGroupChangeActionStateFull(x.GoPointer(), ActionNameVar, ValueVar, PlatformDataVar)
@@ -304,8 +301,8 @@ func (x *DBusActionGroup) ChangeActionStateFull(ActionNameVar string, ValueVar *
 
 var xDBusActionGroupGet func(uintptr, uintptr, string) uintptr
 
-// Obtains a #GDBusActionGroup for the action group which is exported at
-// the given @bus_name and @object_path.
+// Obtains a [DBusActionGroup] for the action group which is exported at
+// the given bus_name and object_path.
 //
 // The thread default main context is taken at the time of this call.
 // All signals on the menu model (and any linked models) are reported
@@ -316,7 +313,7 @@ var xDBusActionGroupGet func(uintptr, uintptr, string) uintptr
 // This call is non-blocking.  The returned action group may or may not
 // already be filled in.  The correct thing to do is connect the signals
 // for the action group to monitor for changes and then to call
-// g_action_group_list_actions() to get the initial list.
+// [ActionGroup.ListActions] to get the initial list.
 func DBusActionGroupGet(ConnectionVar *DBusConnection, BusNameVar *string, ObjectPathVar string) *DBusActionGroup {
 	var cls *DBusActionGroup
 
@@ -338,7 +335,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -347,6 +344,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusActionGroupGLibType, libs, "g_dbus_action_group_get_type")
 
-	core.PuregoSafeRegister(&xDBusActionGroupGet, libs, "g_dbus_action_group_get")
+	core.PuregoRegisterSince(&xDBusActionGroupGet, libs, "g_dbus_action_group_get", "GIO", "2.32")
 
 }
@@ -4,17 +4,16 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
 
 var xDbusAddressEscapeValue func(string) string
 
-// Escape @string so it can appear in a D-Bus address as the value
+// Escape string so it can appear in a D-Bus address as the value
 // part of a key-value pair.
 //
-// For instance, if @string is `/run/bus-for-:0`,
+// For instance, if string is `/run/bus-for-:0`,
 // this function would return `/run/bus-for-%3A0`,
 // which could be used in a D-Bus address like
 // `unix:nonce-tcp:host=127.0.0.1,port=42,noncefile=/run/bus-for-%3A0`.
@@ -27,17 +26,19 @@ func DbusAddressEscapeValue(StringVar string) string {
 var xDbusAddressGetForBusSync func(BusType, uintptr, **glib.Error) string
 
 // Synchronously looks up the D-Bus address for the well-known message
-// bus instance specified by @bus_type. This may involve using various
+// bus instance specified by bus_type. This may involve using various
 // platform specific mechanisms.
 //
 // The returned address will be in the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// [D-Bus address format].
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DbusAddressGetForBusSync(BusTypeVar BusType, CancellableVar *Cancellable) (string, error) {
 	var cerr *glib.Error
 
 	cret := xDbusAddressGetForBusSync(BusTypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -45,17 +46,19 @@ func DbusAddressGetForBusSync(BusTypeVar BusType, CancellableVar *Cancellable) (
 
 var xDbusAddressGetStream func(string, uintptr, uintptr, uintptr)
 
-// Asynchronously connects to an endpoint specified by @address and
+// Asynchronously connects to an endpoint specified by address and
 // sets up the connection so it is in a state to run the client-side
-// of the D-Bus authentication conversation. @address must be in the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// of the D-Bus authentication conversation. address must be in the
+// [D-Bus address format].
 //
-// When the operation is finished, @callback will be invoked. You can
-// then call g_dbus_address_get_stream_finish() to get the result of
+// When the operation is finished, callback will be invoked. You can
+// then call [DbusAddressGetStreamFinish] to get the result of
 // the operation.
 //
 // This is an asynchronous failable function. See
-// g_dbus_address_get_stream_sync() for the synchronous version.
+// [DbusAddressGetStreamSync] for the synchronous version.
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DbusAddressGetStream(AddressVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -65,11 +68,12 @@ func DbusAddressGetStream(AddressVar string, CancellableVar *Cancellable, Callba
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -79,9 +83,9 @@ func DbusAddressGetStream(AddressVar string, CancellableVar *Cancellable, Callba
 
 var xDbusAddressGetStreamFinish func(uintptr, *string, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_address_get_stream().
+// Finishes an operation started with [DbusAddressGetStream].
 //
-// A server is not required to set a GUID, so @out_guid may be set to %NULL
+// A server is not required to set a GUID, so out_guid may be set to nil
 // even on success.
 func DbusAddressGetStreamFinish(ResVar AsyncResult, OutGuidVar *string) (*IOStream, error) {
 	var cls *IOStream
@@ -90,12 +94,15 @@ func DbusAddressGetStreamFinish(ResVar AsyncResult, OutGuidVar *string) (*IOStre
 	cret := xDbusAddressGetStreamFinish(ResVar.GoPointer(), OutGuidVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &IOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -103,16 +110,18 @@ func DbusAddressGetStreamFinish(ResVar AsyncResult, OutGuidVar *string) (*IOStre
 
 var xDbusAddressGetStreamSync func(string, *string, uintptr, **glib.Error) uintptr
 
-// Synchronously connects to an endpoint specified by @address and
+// Synchronously connects to an endpoint specified by address and
 // sets up the connection so it is in a state to run the client-side
-// of the D-Bus authentication conversation. @address must be in the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// of the D-Bus authentication conversation. address must be in the
+// [D-Bus address format].
 //
-// A server is not required to set a GUID, so @out_guid may be set to %NULL
+// A server is not required to set a GUID, so out_guid may be set to nil
 // even on success.
 //
 // This is a synchronous failable function. See
-// g_dbus_address_get_stream() for the asynchronous version.
+// [DbusAddressGetStream] for the asynchronous version.
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DbusAddressGetStreamSync(AddressVar string, OutGuidVar *string, CancellableVar *Cancellable) (*IOStream, error) {
 	var cls *IOStream
 	var cerr *glib.Error
@@ -120,12 +129,15 @@ func DbusAddressGetStreamSync(AddressVar string, OutGuidVar *string, Cancellable
 	cret := xDbusAddressGetStreamSync(AddressVar, OutGuidVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &IOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -133,12 +145,14 @@ func DbusAddressGetStreamSync(AddressVar string, OutGuidVar *string, Cancellable
 
 var xDbusIsAddress func(string) bool
 
-// Checks if @string is a
-// [D-Bus address](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// Checks if string is a
+// [D-Bus address].
 //
-// This doesn't check if @string is actually supported by #GDBusServer
-// or #GDBusConnection - use g_dbus_is_supported_address() to do more
+// This doesn't check if string is actually supported by [DBusServer]
+// or [DBusConnection] - use [DbusIsSupportedAddress] to do more
 // checks.
+//
+// [D-Bus address]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DbusIsAddress(StringVar string) bool {
 
 	cret := xDbusIsAddress(StringVar)
@@ -147,16 +161,18 @@ func DbusIsAddress(StringVar string) bool {
 
 var xDbusIsSupportedAddress func(string, **glib.Error) bool
 
-// Like g_dbus_is_address() but also checks if the library supports the
-// transports in @string and that key/value pairs for each transport
+// Like [DbusIsAddress] but also checks if the library supports the
+// transports in string and that key/value pairs for each transport
 // are valid. See the specification of the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// [D-Bus address format].
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DbusIsSupportedAddress(StringVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDbusIsSupportedAddress(StringVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -167,19 +183,19 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDbusAddressEscapeValue, libs, "g_dbus_address_escape_value")
-	core.PuregoSafeRegister(&xDbusAddressGetForBusSync, libs, "g_dbus_address_get_for_bus_sync")
-	core.PuregoSafeRegister(&xDbusAddressGetStream, libs, "g_dbus_address_get_stream")
-	core.PuregoSafeRegister(&xDbusAddressGetStreamFinish, libs, "g_dbus_address_get_stream_finish")
-	core.PuregoSafeRegister(&xDbusAddressGetStreamSync, libs, "g_dbus_address_get_stream_sync")
-	core.PuregoSafeRegister(&xDbusIsAddress, libs, "g_dbus_is_address")
-	core.PuregoSafeRegister(&xDbusIsSupportedAddress, libs, "g_dbus_is_supported_address")
+	core.PuregoRegisterSince(&xDbusAddressEscapeValue, libs, "g_dbus_address_escape_value", "GIO", "2.36")
+	core.PuregoRegisterSince(&xDbusAddressGetForBusSync, libs, "g_dbus_address_get_for_bus_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusAddressGetStream, libs, "g_dbus_address_get_stream", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusAddressGetStreamFinish, libs, "g_dbus_address_get_stream_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusAddressGetStreamSync, libs, "g_dbus_address_get_stream_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsAddress, libs, "g_dbus_is_address", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsSupportedAddress, libs, "g_dbus_is_supported_address", "GIO", "2.26")
 
 }
@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,14 +11,14 @@ import (
 )
 
 // `GDBusAuthObserver` provides a mechanism for participating
-// in how a [class@Gio.DBusServer] (or a [class@Gio.DBusConnection])
+// in how a [DBusServer] (or a [DBusConnection])
 // authenticates remote peers.
 //
 // Simply instantiate a `GDBusAuthObserver` and connect to the
 // signals you are interested in. Note that new signals may be added
 // in the future.
 //
-// ## Controlling Authentication Mechanisms
+// # Controlling Authentication Mechanisms
 //
 // By default, a `GDBusServer` or server-side `GDBusConnection` will allow
 // any authentication mechanism to be used. If you only want to allow D-Bus
@@ -27,13 +26,10 @@ import (
 // passing and is the recommended mechanism for modern Unix platforms such
 // as Linux and the BSD family, you would use a signal handler like this:
 //
-// ```c
-// static gboolean
-// on_allow_mechanism (GDBusAuthObserver *observer,
-//
-//	const gchar       *mechanism,
-//	gpointer           user_data)
-//
+//	static gboolean
+//	on_allow_mechanism (GDBusAuthObserver *observer,
+//	                    const gchar       *mechanism,
+//	                    gpointer           user_data)
 //	{
 //	  if (g_strcmp0 (mechanism, "EXTERNAL") == 0)
 //	    {
@@ -43,9 +39,7 @@ import (
 //	  return FALSE;
 //	}
 //
-// ```
-//
-// ## Controlling Authorization
+// # Controlling Authorization
 //
 // By default, a `GDBusServer` or server-side `GDBusConnection` will accept
 // connections from any successfully authenticated user (but not from
@@ -55,14 +49,11 @@ import (
 // `G_DBUS_SERVER_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER` flag. It’s equivalent
 // to the following signal handler:
 //
-// ```c
-// static gboolean
-// on_authorize_authenticated_peer (GDBusAuthObserver *observer,
-//
-//	GIOStream         *stream,
-//	GCredentials      *credentials,
-//	gpointer           user_data)
-//
+//	static gboolean
+//	on_authorize_authenticated_peer (GDBusAuthObserver *observer,
+//	                                 GIOStream         *stream,
+//	                                 GCredentials      *credentials,
+//	                                 gpointer           user_data)
 //	{
 //	  gboolean authorized;
 //
@@ -78,8 +69,6 @@ import (
 //
 //	  return authorized;
 //	}
-//
-// ```
 type DBusAuthObserver struct {
 	gobject.Object
 }
@@ -98,7 +87,7 @@ func DBusAuthObserverNewFromInternalPtr(ptr uintptr) *DBusAuthObserver {
 
 var xNewDBusAuthObserver func() uintptr
 
-// Creates a new #GDBusAuthObserver object.
+// Creates a new [DBusAuthObserver] object.
 func NewDBusAuthObserver() *DBusAuthObserver {
 	var cls *DBusAuthObserver
 
@@ -114,7 +103,7 @@ func NewDBusAuthObserver() *DBusAuthObserver {
 
 var xDBusAuthObserverAllowMechanism func(uintptr, string) bool
 
-// Emits the #GDBusAuthObserver::allow-mechanism signal on @observer.
+// Emits the [DBusAuthObserver.ConnectAllowMechanismFunc] signal on observer.
 func (x *DBusAuthObserver) AllowMechanism(MechanismVar string) bool {
 
 	cret := xDBusAuthObserverAllowMechanism(x.GoPointer(), MechanismVar)
@@ -123,7 +112,7 @@ func (x *DBusAuthObserver) AllowMechanism(MechanismVar string) bool {
 
 var xDBusAuthObserverAuthorizeAuthenticatedPeer func(uintptr, uintptr, uintptr) bool
 
-// Emits the #GDBusAuthObserver::authorize-authenticated-peer signal on @observer.
+// Emits the [DBusAuthObserver.ConnectAuthorizeAuthenticatedPeerFunc] signal on observer.
 func (x *DBusAuthObserver) AuthorizeAuthenticatedPeer(StreamVar *IOStream, CredentialsVar *Credentials) bool {
 
 	cret := xDBusAuthObserverAuthorizeAuthenticatedPeer(x.GoPointer(), StreamVar.GoPointer(), CredentialsVar.GoPointer())
@@ -141,53 +130,97 @@ func (c *DBusAuthObserver) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Emitted to check if @mechanism is allowed to be used.
+// Emitted to check if mechanism is allowed to be used.
+//
+// Deprecated: use ConnectAllowMechanismFunc, which also accepts method values and closures.
 func (x *DBusAuthObserver) ConnectAllowMechanism(cb *func(DBusAuthObserver, string) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "allow-mechanism", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MechanismVarp string) bool {
+			defer core.RecoverPanic()
+			fa := DBusAuthObserver{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, MechanismVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "allow-mechanism", cbRefPtr, cbPtr)
+}
+
+var xDBusAuthObserverAllowMechanismTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MechanismVarp string) bool {
+func xDBusAuthObserverAllowMechanismNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MechanismVarp string, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusAuthObserver, string) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusAuthObserver{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, MechanismVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "allow-mechanism", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAllowMechanismFunc connects cb to the "allow-mechanism" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusAuthObserver) ConnectAllowMechanismFunc(cb func(DBusAuthObserver, string) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "allow-mechanism", &xDBusAuthObserverAllowMechanismTrampoline, xDBusAuthObserverAllowMechanismNewTrampoline, cb)
 }
 
 // Emitted to check if a peer that is successfully authenticated
 // is authorized.
+//
+// Deprecated: use ConnectAuthorizeAuthenticatedPeerFunc, which also accepts method values and closures.
 func (x *DBusAuthObserver) ConnectAuthorizeAuthenticatedPeer(cb *func(DBusAuthObserver, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "authorize-authenticated-peer", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StreamVarp uintptr, CredentialsVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DBusAuthObserver{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, StreamVarp, CredentialsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "authorize-authenticated-peer", cbRefPtr, cbPtr)
+}
+
+var xDBusAuthObserverAuthorizeAuthenticatedPeerTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StreamVarp uintptr, CredentialsVarp uintptr) bool {
+func xDBusAuthObserverAuthorizeAuthenticatedPeerNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StreamVarp uintptr, CredentialsVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusAuthObserver, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusAuthObserver{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, StreamVarp, CredentialsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "authorize-authenticated-peer", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAuthorizeAuthenticatedPeerFunc connects cb to the "authorize-authenticated-peer" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusAuthObserver) ConnectAuthorizeAuthenticatedPeerFunc(cb func(DBusAuthObserver, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "authorize-authenticated-peer", &xDBusAuthObserverAuthorizeAuthenticatedPeerTrampoline, xDBusAuthObserverAuthorizeAuthenticatedPeerNewTrampoline, cb)
 }
 
 func init() {
@@ -195,7 +228,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -204,9 +237,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusAuthObserverGLibType, libs, "g_dbus_auth_observer_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusAuthObserver, libs, "g_dbus_auth_observer_new")
+	core.PuregoRegisterSince(&xNewDBusAuthObserver, libs, "g_dbus_auth_observer_new", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xDBusAuthObserverAllowMechanism, libs, "g_dbus_auth_observer_allow_mechanism")
-	core.PuregoSafeRegister(&xDBusAuthObserverAuthorizeAuthenticatedPeer, libs, "g_dbus_auth_observer_authorize_authenticated_peer")
+	core.PuregoRegisterSince(&xDBusAuthObserverAllowMechanism, libs, "g_dbus_auth_observer_allow_mechanism", "GIO", "2.34")
+	core.PuregoRegisterSince(&xDBusAuthObserverAuthorizeAuthenticatedPeer, libs, "g_dbus_auth_observer_authorize_authenticated_peer", "GIO", "2.26")
 
 }
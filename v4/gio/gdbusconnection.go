@@ -5,57 +5,53 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of the @get_property function in #GDBusInterfaceVTable.
+// The type of the get_property function in [DBusInterfaceVTable].
 type DBusInterfaceGetPropertyFunc func(uintptr, string, string, string, string, **glib.Error, uintptr) *glib.Variant
 
-// The type of the @method_call function in #GDBusInterfaceVTable.
+// The type of the method_call function in [DBusInterfaceVTable].
 //
-// @interface_name may be `NULL` if not specified by the sender, although it’s
+// interface_name may be `NULL` if not specified by the sender, although it’s
 // encouraged for the sender to set it. If unset, and the object has only one
-// method (across all interfaces) matching @method_name, that method is invoked.
+// method (across all interfaces) matching method_name, that method is invoked.
 // Otherwise, behaviour is implementation defined. See the
-// [D-Bus specification](https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-types-method).
-// It is recommended to return [error@Gio.DBusError.UNKNOWN_METHOD].
+// [D-Bus specification].
+// It is recommended to return DBusError.UNKNOWN_METHOD.
+//
+// [D-Bus specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-types-method
 type DBusInterfaceMethodCallFunc func(uintptr, string, string, string, string, *glib.Variant, uintptr, uintptr)
 
-// The type of the @set_property function in #GDBusInterfaceVTable.
+// The type of the set_property function in [DBusInterfaceVTable].
 type DBusInterfaceSetPropertyFunc func(uintptr, string, string, string, string, *glib.Variant, **glib.Error, uintptr) bool
 
-// Signature for function used in g_dbus_connection_add_filter().
-//
-// A filter function is passed a #GDBusMessage and expected to return
-// a #GDBusMessage too. Passive filter functions that don't modify the
-// message can simply return the @message object:
-// |[
-// static GDBusMessage *
-// passive_filter (GDBusConnection *connection
+// Signature for function used in [DBusConnection.AddFilter].
 //
-//	GDBusMessage    *message,
-//	gboolean         incoming,
-//	gpointer         user_data)
+// A filter function is passed a [DBusMessage] and expected to return
+// a [DBusMessage] too. Passive filter functions that don't modify the
+// message can simply return the message object:
 //
+//	static GDBusMessage *
+//	passive_filter (GDBusConnection *connection
+//	                GDBusMessage    *message,
+//	                gboolean         incoming,
+//	                gpointer         user_data)
 //	{
 //	  // inspect @message
 //	  return message;
 //	}
 //
-// ]|
-// Filter functions that wants to drop a message can simply return %NULL:
-// |[
-// static GDBusMessage *
-// drop_filter (GDBusConnection *connection
-//
-//	GDBusMessage    *message,
-//	gboolean         incoming,
-//	gpointer         user_data)
+// Filter functions that wants to drop a message can simply return nil:
 //
+//	static GDBusMessage *
+//	drop_filter (GDBusConnection *connection
+//	             GDBusMessage    *message,
+//	             gboolean         incoming,
+//	             gpointer         user_data)
 //	{
 //	  if (should_drop_message)
 //	    {
@@ -65,22 +61,19 @@ type DBusInterfaceSetPropertyFunc func(uintptr, string, string, string, string,
 //	  return message;
 //	}
 //
-// ]|
 // Finally, a filter function may modify a message by copying it:
-// |[
-// static GDBusMessage *
-// modifying_filter (GDBusConnection *connection
-//
-//	GDBusMessage    *message,
-//	gboolean         incoming,
-//	gpointer         user_data)
 //
+//	static GDBusMessage *
+//	modifying_filter (GDBusConnection *connection
+//	                  GDBusMessage    *message,
+//	                  gboolean         incoming,
+//	                  gpointer         user_data)
 //	{
 //	  GDBusMessage *copy;
 //	  GError *error;
 //
 //	  error = NULL;
-//	  copy = g_dbus_message_copy (message, &amp;error);
+//	  copy = g_dbus_message_copy (message, &error);
 //	  // handle @error being set
 //	  g_object_unref (message);
 //
@@ -89,54 +82,53 @@ type DBusInterfaceSetPropertyFunc func(uintptr, string, string, string, string,
 //	  return copy;
 //	}
 //
-// ]|
-// If the returned #GDBusMessage is different from @message and cannot
-// be sent on @connection (it could use features, such as file
-// descriptors, not compatible with @connection), then a warning is
+// If the returned [DBusMessage] is different from message and cannot
+// be sent on connection (it could use features, such as file
+// descriptors, not compatible with connection), then a warning is
 // logged to standard error. Applications can
-// check this ahead of time using g_dbus_message_to_blob() passing a
-// #GDBusCapabilityFlags value obtained from @connection.
+// check this ahead of time using [DBusMessage.ToBlob] passing a
+// [DBusCapabilityFlags] value obtained from connection.
 type DBusMessageFilterFunction func(uintptr, uintptr, bool, uintptr) uintptr
 
-// Signature for callback function used in g_dbus_connection_signal_subscribe().
+// Signature for callback function used in [DBusConnection.SignalSubscribe].
 type DBusSignalCallback func(uintptr, string, string, string, string, *glib.Variant, uintptr)
 
-// The type of the @dispatch function in #GDBusSubtreeVTable.
+// The type of the dispatch function in [DBusSubtreeVTable].
 //
-// Subtrees are flat.  @node, if non-%NULL, is always exactly one
+// Subtrees are flat.  node, if non-nil, is always exactly one
 // segment of the object path (ie: it never contains a slash).
 type DBusSubtreeDispatchFunc func(uintptr, string, string, string, string, uintptr, uintptr) *DBusInterfaceVTable
 
-// The type of the @enumerate function in #GDBusSubtreeVTable.
+// The type of the enumerate function in [DBusSubtreeVTable].
 //
 // This function is called when generating introspection data and also
 // when preparing to dispatch incoming messages in the event that the
-// %G_DBUS_SUBTREE_FLAGS_DISPATCH_TO_UNENUMERATED_NODES flag is not
+// [GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue] flag is not
 // specified (ie: to verify that the object path is valid).
 //
 // Hierarchies are not supported; the items that you return should not
 // contain the `/` character.
 //
-// The return value will be freed with g_strfreev().
+// The return value will be freed with [glib.Strfreev].
 type DBusSubtreeEnumerateFunc func(uintptr, string, string, uintptr) []string
 
-// The type of the @introspect function in #GDBusSubtreeVTable.
+// The type of the introspect function in [DBusSubtreeVTable].
 //
-// Subtrees are flat.  @node, if non-%NULL, is always exactly one
+// Subtrees are flat.  node, if non-nil, is always exactly one
 // segment of the object path (ie: it never contains a slash).
 //
-// This function should return %NULL to indicate that there is no object
+// This function should return nil to indicate that there is no object
 // at this node.
 //
-// If this function returns non-%NULL, the return value is expected to
-// be a %NULL-terminated array of pointers to #GDBusInterfaceInfo
-// structures describing the interfaces implemented by @node.  This
-// array will have g_dbus_interface_info_unref() called on each item
-// before being freed with g_free().
+// If this function returns non-nil, the return value is expected to
+// be a nil-terminated array of pointers to [DBusInterfaceInfo]
+// structures describing the interfaces implemented by node.  This
+// array will have [DBusInterfaceInfo.Unref] called on each item
+// before being freed with [glib.Free].
 //
-// The difference between returning %NULL and an array containing zero
+// The difference between returning nil and an array containing zero
 // items is that the standard DBus interfaces will returned to the
-// remote introspector in the empty array case, but not in the %NULL
+// remote introspector in the empty array case, but not in the nil
 // case.
 type DBusSubtreeIntrospectFunc func(uintptr, string, string, string, uintptr) uintptr
 
@@ -144,13 +136,13 @@ type DBusSubtreeIntrospectFunc func(uintptr, string, string, string, uintptr) ui
 // interface.
 //
 // Since 2.38, if you want to handle getting/setting D-Bus properties
-// asynchronously, give %NULL as your get_property() or set_property()
-// function. The D-Bus call will be directed to your @method_call function,
-// with the provided @interface_name set to "org.freedesktop.DBus.Properties".
+// asynchronously, give nil as your get_property() or set_property()
+// function. The D-Bus call will be directed to your method_call function,
+// with the provided interface_name set to "org.freedesktop.DBus.Properties".
 //
-// Ownership of the #GDBusMethodInvocation object passed to the
+// Ownership of the [DBusMethodInvocation] object passed to the
 // method_call() function is transferred to your handler; you must
-// call one of the methods of #GDBusMethodInvocation to return a reply
+// call one of the methods of [DBusMethodInvocation] to return a reply
 // (possibly empty), or an error. These functions also take ownership
 // of the passed-in invocation object, so unless the invocation
 // object has otherwise been referenced, it will be then be freed.
@@ -164,23 +156,23 @@ type DBusSubtreeIntrospectFunc func(uintptr, string, string, string, uintptr) ui
 // performed for `Set` calls, and the provided value is also checked for
 // being the correct type.
 //
-// For both `Get` and `Set` calls, the #GDBusMethodInvocation
-// passed to the @method_call handler can be queried with
-// g_dbus_method_invocation_get_property_info() to get a pointer
-// to the #GDBusPropertyInfo of the property.
+// For both `Get` and `Set` calls, the [DBusMethodInvocation]
+// passed to the method_call handler can be queried with
+// [DBusMethodInvocation.GetPropertyInfo] to get a pointer
+// to the [DBusPropertyInfo] of the property.
 //
 // If you have readable properties specified in your interface info,
-// you must ensure that you either provide a non-%NULL @get_property()
+// you must ensure that you either provide a non-nil get_property()
 // function or provide implementations of both the `Get` and `GetAll`
-// methods on org.freedesktop.DBus.Properties interface in your @method_call
+// methods on org.freedesktop.DBus.Properties interface in your method_call
 // function. Note that the required return type of the `Get` call is
 // `(v)`, not the type of the property. `GetAll` expects a return value
 // of type `a{sv}`.
 //
 // If you have writable properties specified in your interface info,
-// you must ensure that you either provide a non-%NULL @set_property()
+// you must ensure that you either provide a non-nil set_property()
 // function or provide an implementation of the `Set` call. If implementing
-// the call, you must return the value of type %G_VARIANT_TYPE_UNIT.
+// the call, you must return the value of type G_VARIANT_TYPE_UNIT.
 type DBusInterfaceVTable struct {
 	_ structs.HostLayout
 
@@ -197,7 +189,7 @@ func (x *DBusInterfaceVTable) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Virtual table for handling subtrees registered with g_dbus_connection_register_subtree().
+// Virtual table for handling subtrees registered with [DBusConnection.RegisterSubtree].
 type DBusSubtreeVTable struct {
 	_ structs.HostLayout
 
@@ -216,12 +208,12 @@ func (x *DBusSubtreeVTable) GoPointer() uintptr {
 
 var xBusGet func(BusType, uintptr, uintptr, uintptr)
 
-// Asynchronously connects to the message bus specified by @bus_type.
+// Asynchronously connects to the message bus specified by bus_type.
 //
-// When the operation is finished, @callback will be invoked. You can
-// then call g_bus_get_finish() to get the result of the operation.
+// When the operation is finished, callback will be invoked. You can
+// then call [BusGetFinish] to get the result of the operation.
 //
-// This is an asynchronous failable function. See g_bus_get_sync() for
+// This is an asynchronous failable function. See [BusGetSync] for
 // the synchronous version.
 func BusGet(BusTypeVar BusType, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -232,11 +224,12 @@ func BusGet(BusTypeVar BusType, CancellableVar *Cancellable, CallbackVar *AsyncR
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -246,18 +239,18 @@ func BusGet(BusTypeVar BusType, CancellableVar *Cancellable, CallbackVar *AsyncR
 
 var xBusGetFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_bus_get().
+// Finishes an operation started with [BusGet].
 //
 // The returned object is a singleton, that is, shared with other
-// callers of g_bus_get() and g_bus_get_sync() for @bus_type. In the
+// callers of [BusGet] and [BusGetSync] for bus_type. In the
 // event that you need a private message bus connection, use
-// g_dbus_address_get_for_bus_sync() and
-// g_dbus_connection_new_for_address() with
+// [DbusAddressGetForBusSync] and
+// [DBusConnectionNewForAddress] with
 // G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT and
 // G_DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION flags.
 //
-// Note that the returned #GDBusConnection object will (usually) have
-// the #GDBusConnection:exit-on-close property set to %TRUE.
+// Note that the returned [DBusConnection] object will (usually) have
+// the [DBusConnection.GetPropertyExitOnClose] property set to true.
 func BusGetFinish(ResVar AsyncResult) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -265,12 +258,15 @@ func BusGetFinish(ResVar AsyncResult) (*DBusConnection, error) {
 	cret := xBusGetFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -278,24 +274,24 @@ func BusGetFinish(ResVar AsyncResult) (*DBusConnection, error) {
 
 var xBusGetSync func(BusType, uintptr, **glib.Error) uintptr
 
-// Synchronously connects to the message bus specified by @bus_type.
+// Synchronously connects to the message bus specified by bus_type.
 // Note that the returned object may shared with other callers,
 // e.g. if two separate parts of a process calls this function with
-// the same @bus_type, they will share the same object.
+// the same bus_type, they will share the same object.
 //
-// This is a synchronous failable function. See g_bus_get() and
-// g_bus_get_finish() for the asynchronous version.
+// This is a synchronous failable function. See [BusGet] and
+// [BusGetFinish] for the asynchronous version.
 //
 // The returned object is a singleton, that is, shared with other
-// callers of g_bus_get() and g_bus_get_sync() for @bus_type. In the
+// callers of [BusGet] and [BusGetSync] for bus_type. In the
 // event that you need a private message bus connection, use
-// g_dbus_address_get_for_bus_sync() and
-// g_dbus_connection_new_for_address() with
+// [DbusAddressGetForBusSync] and
+// [DBusConnectionNewForAddress] with
 // G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT and
 // G_DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION flags.
 //
-// Note that the returned #GDBusConnection object will (usually) have
-// the #GDBusConnection:exit-on-close property set to %TRUE.
+// Note that the returned [DBusConnection] object will (usually) have
+// the [DBusConnection.GetPropertyExitOnClose] property set to true.
 func BusGetSync(BusTypeVar BusType, CancellableVar *Cancellable) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -303,12 +299,15 @@ func BusGetSync(BusTypeVar BusType, CancellableVar *Cancellable) (*DBusConnectio
 	cret := xBusGetSync(BusTypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -319,53 +318,58 @@ func BusGetSync(BusTypeVar BusType, CancellableVar *Cancellable) (*DBusConnectio
 //
 // It is a low-level API that offers a lot of flexibility. For instance,
 // it lets you establish a connection over any transport that can by represented
-// as a [class@Gio.IOStream].
+// as a [IOStream].
 //
 // This class is rarely used directly in D-Bus clients. If you are writing
-// a D-Bus client, it is often easier to use the [func@Gio.bus_own_name],
-// [func@Gio.bus_watch_name] or [func@Gio.DBusProxy.new_for_bus] APIs.
+// a D-Bus client, it is often easier to use the [BusOwnName],
+// [BusWatchName] or [DBusProxyNewForBus] APIs.
 //
 // As an exception to the usual GLib rule that a particular object must not
 // be used by two threads at the same time, `GDBusConnection`s methods may be
-// called from any thread. This is so that [func@Gio.bus_get] and
-// [func@Gio.bus_get_sync] can safely return the same `GDBusConnection` when
+// called from any thread. This is so that [BusGet] and
+// [BusGetSync] can safely return the same `GDBusConnection` when
 // called from any thread.
 //
 // Most of the ways to obtain a `GDBusConnection` automatically initialize it
-// (i.e. connect to D-Bus): for instance, [func@Gio.DBusConnection.new] and
-// [func@Gio.bus_get], and the synchronous versions of those methods, give you
+// (i.e. connect to D-Bus): for instance, [DBusConnectionNew] and
+// [BusGet], and the synchronous versions of those methods, give you
 // an initialized connection. Language bindings for GIO should use
-// [func@Gio.Initable.new] or [func@Gio.AsyncInitable.new_async], which also
+// Initable.new or AsyncInitable.new_async, which also
 // initialize the connection.
 //
 // If you construct an uninitialized `GDBusConnection`, such as via
-// [ctor@GObject.Object.new], you must initialize it via [method@Gio.Initable.init] or
-// [method@Gio.AsyncInitable.init_async] before using its methods or properties.
+// [gobject.NewObject], you must initialize it via [Initable.Init] or
+// [AsyncInitable.InitAsync] before using its methods or properties.
 // Calling methods or accessing properties on a `GDBusConnection` that has not
 // completed initialization successfully is considered to be invalid, and leads
 // to undefined behaviour. In particular, if initialization fails with a
 // `GError`, the only valid thing you can do with that `GDBusConnection` is to
-// free it with [method@GObject.Object.unref].
+// free it with [gobject.Object.Unref].
 //
-// ## An example D-Bus server
+// # An example D-Bus server
 //
 // Here is an example for a D-Bus server:
-// [gdbus-example-server.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-server.c)
+// [gdbus-example-server.c]
 //
-// ## An example for exporting a subtree
+// # An example for exporting a subtree
 //
 // Here is an example for exporting a subtree:
-// [gdbus-example-subtree.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-subtree.c)
+// [gdbus-example-subtree.c]
 //
-// ## An example for file descriptor passing
+// # An example for file descriptor passing
 //
 // Here is an example for passing UNIX file descriptors:
-// [gdbus-unix-fd-client.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-unix-fd-client.c)
+// [gdbus-unix-fd-client.c]
+//
+// # An example for exporting a GObject
 //
-// ## An example for exporting a GObject
+// Here is an example for exporting a [gobject.Object]:
+// [gdbus-example-export.c]
 //
-// Here is an example for exporting a #GObject:
-// [gdbus-example-export.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-export.c)
+// [gdbus-example-server.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-server.c
+// [gdbus-example-subtree.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-subtree.c
+// [gdbus-unix-fd-client.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-unix-fd-client.c
+// [gdbus-example-export.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-export.c
 type DBusConnection struct {
 	gobject.Object
 }
@@ -384,7 +388,7 @@ func DBusConnectionNewFromInternalPtr(ptr uintptr) *DBusConnection {
 
 var xNewDBusConnectionFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_connection_new().
+// Finishes an operation started with [DBusConnectionNew].
 func NewDBusConnectionFinish(ResVar AsyncResult) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -392,12 +396,15 @@ func NewDBusConnectionFinish(ResVar AsyncResult) (*DBusConnection, error) {
 	cret := xNewDBusConnectionFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -405,7 +412,7 @@ func NewDBusConnectionFinish(ResVar AsyncResult) (*DBusConnection, error) {
 
 var xNewDBusConnectionForAddressFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_connection_new_for_address().
+// Finishes an operation started with [DBusConnectionNewForAddress].
 func NewDBusConnectionForAddressFinish(ResVar AsyncResult) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -413,12 +420,15 @@ func NewDBusConnectionForAddressFinish(ResVar AsyncResult) (*DBusConnection, err
 	cret := xNewDBusConnectionForAddressFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -427,22 +437,24 @@ func NewDBusConnectionForAddressFinish(ResVar AsyncResult) (*DBusConnection, err
 var xNewDBusConnectionForAddressSync func(string, DBusConnectionFlags, uintptr, uintptr, **glib.Error) uintptr
 
 // Synchronously connects and sets up a D-Bus client connection for
-// exchanging D-Bus messages with an endpoint specified by @address
+// exchanging D-Bus messages with an endpoint specified by address
 // which must be in the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// [D-Bus address format].
 //
 // This constructor can only be used to initiate client-side
-// connections - use g_dbus_connection_new_sync() if you need to act
-// as the server. In particular, @flags cannot contain the
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER,
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS or
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER flags.
+// connections - use [NewDBusConnectionSync] if you need to act
+// as the server. In particular, flags cannot contain the
+// [GDbusConnectionFlagsAuthenticationServerValue],
+// [GDbusConnectionFlagsAuthenticationAllowAnonymousValue] or
+// [GDbusConnectionFlagsAuthenticationRequireSameUserValue] flags.
 //
 // This is a synchronous failable constructor. See
-// g_dbus_connection_new_for_address() for the asynchronous version.
+// [DBusConnectionNewForAddress] for the asynchronous version.
 //
-// If @observer is not %NULL it may be used to control the
+// If observer is not nil it may be used to control the
 // authentication process.
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func NewDBusConnectionForAddressSync(AddressVar string, FlagsVar DBusConnectionFlags, ObserverVar *DBusAuthObserver, CancellableVar *Cancellable) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -450,12 +462,15 @@ func NewDBusConnectionForAddressSync(AddressVar string, FlagsVar DBusConnectionF
 	cret := xNewDBusConnectionForAddressSync(AddressVar, FlagsVar, ObserverVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -464,20 +479,20 @@ func NewDBusConnectionForAddressSync(AddressVar string, FlagsVar DBusConnectionF
 var xNewDBusConnectionSync func(uintptr, uintptr, DBusConnectionFlags, uintptr, uintptr, **glib.Error) uintptr
 
 // Synchronously sets up a D-Bus connection for exchanging D-Bus messages
-// with the end represented by @stream.
+// with the end represented by stream.
 //
-// If @stream is a #GSocketConnection, then the corresponding #GSocket
+// If stream is a [SocketConnection], then the corresponding [Socket]
 // will be put into non-blocking mode.
 //
-// The D-Bus connection will interact with @stream from a worker thread.
-// As a result, the caller should not interact with @stream after this
-// method has been called, except by calling g_object_unref() on it.
+// The D-Bus connection will interact with stream from a worker thread.
+// As a result, the caller should not interact with stream after this
+// method has been called, except by calling [gobject.Object.Unref] on it.
 //
-// If @observer is not %NULL it may be used to control the
+// If observer is not nil it may be used to control the
 // authentication process.
 //
 // This is a synchronous failable constructor. See
-// g_dbus_connection_new() for the asynchronous version.
+// [DBusConnectionNew] for the asynchronous version.
 func NewDBusConnectionSync(StreamVar *IOStream, GuidVar *string, FlagsVar DBusConnectionFlags, ObserverVar *DBusAuthObserver, CancellableVar *Cancellable) (*DBusConnection, error) {
 	var cls *DBusConnection
 	var cerr *glib.Error
@@ -488,12 +503,15 @@ func NewDBusConnectionSync(StreamVar *IOStream, GuidVar *string, FlagsVar DBusCo
 	cret := xNewDBusConnectionSync(StreamVar.GoPointer(), GuidVarPtr, FlagsVar, ObserverVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -512,21 +530,21 @@ var xDBusConnectionAddFilter func(uintptr, uintptr, uintptr, uintptr) uint
 // Note that filters are run in a dedicated message handling thread so
 // they can't block and, generally, can't do anything but signal a
 // worker thread. Also note that filters are rarely needed - use API
-// such as g_dbus_connection_send_message_with_reply(),
-// g_dbus_connection_signal_subscribe() or g_dbus_connection_call() instead.
+// such as [DBusConnection.SendMessageWithReply],
+// [DBusConnection.SignalSubscribe] or [DBusConnection.Call] instead.
 //
 // If a filter consumes an incoming message the message is not
 // dispatched anywhere else - not even the standard dispatch machinery
-// (that API such as g_dbus_connection_signal_subscribe() and
-// g_dbus_connection_send_message_with_reply() relies on) will see the
+// (that API such as [DBusConnection.SignalSubscribe] and
+// [DBusConnection.SendMessageWithReply] relies on) will see the
 // message. Similarly, if a filter consumes an outgoing message, the
 // message will not be sent to the other peer.
 //
-// If @user_data_free_func is non-%NULL, it will be called (in the
+// If user_data_free_func is non-nil, it will be called (in the
 // thread-default main context of the thread you are calling this
-// method from) at some point after @user_data is no longer
+// method from) at some point after user_data is no longer
 // needed. (It is not guaranteed to be called synchronously when the
-// filter is removed, and may be called after @connection has been
+// filter is removed, and may be called after connection has been
 // destroyed.)
 func (x *DBusConnection) AddFilter(FilterFunctionVar *DBusMessageFilterFunction, UserDataVar uintptr, UserDataFreeFuncVar *glib.DestroyNotify) uint {
 
@@ -537,11 +555,12 @@ func (x *DBusConnection) AddFilter(FilterFunctionVar *DBusMessageFilterFunction,
 			FilterFunctionVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 bool, arg3 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *FilterFunctionVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			FilterFunctionVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FilterFunctionVarPtr, FilterFunctionVarRef, FilterFunctionVar)
+			FilterFunctionVarRef = core.NewCallback(fcb)
+			FilterFunctionVarRef = glib.SaveCallbackWithClosure(FilterFunctionVarPtr, FilterFunctionVarRef, FilterFunctionVar)
 		}
 	}
 
@@ -552,11 +571,12 @@ func (x *DBusConnection) AddFilter(FilterFunctionVar *DBusMessageFilterFunction,
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -566,25 +586,24 @@ func (x *DBusConnection) AddFilter(FilterFunctionVar *DBusMessageFilterFunction,
 
 var xDBusConnectionCall func(uintptr, uintptr, string, string, string, *glib.Variant, *glib.VariantType, DBusCallFlags, int, uintptr, uintptr, uintptr)
 
-// Asynchronously invokes the @method_name method on the
-// @interface_name D-Bus interface on the remote object at
-// @object_path owned by @bus_name.
+// Asynchronously invokes the method_name method on the
+// interface_name D-Bus interface on the remote object at
+// object_path owned by bus_name.
 //
-// If @connection is closed then the operation will fail with
-// %G_IO_ERROR_CLOSED. If @cancellable is canceled, the operation will
-// fail with %G_IO_ERROR_CANCELLED. If @parameters contains a value
+// If connection is closed then the operation will fail with
+// [GIoErrorClosedValue]. If cancellable is canceled, the operation will
+// fail with [GIoErrorCancelledValue]. If parameters contains a value
 // not compatible with the D-Bus protocol, the operation fails with
-// %G_IO_ERROR_INVALID_ARGUMENT.
+// [GIoErrorInvalidArgumentValue].
 //
-// If @reply_type is non-%NULL then the reply will be checked for having this type and an
-// error will be raised if it does not match.  Said another way, if you give a @reply_type
-// then any non-%NULL return value will be of this type. Unless it’s
-// %G_VARIANT_TYPE_UNIT, the @reply_type will be a tuple containing one or more
+// If reply_type is non-nil then the reply will be checked for having this type and an
+// error will be raised if it does not match.  Said another way, if you give a reply_type
+// then any non-nil return value will be of this type. Unless it’s
+// G_VARIANT_TYPE_UNIT, the reply_type will be a tuple containing one or more
 // values.
 //
-// If the @parameters #GVariant is floating, it is consumed. This allows
-// convenient 'inline' use of g_variant_new(), e.g.:
-// |[&lt;!-- language="C" --&gt;
+// If the parameters [glib.Variant] is floating, it is consumed. This allows
+// convenient 'inline' use of [glib.NewVariant], e.g.:
 //
 //	g_dbus_connection_call (connection,
 //	                        "org.freedesktop.StringThings",
@@ -601,18 +620,16 @@ var xDBusConnectionCall func(uintptr, uintptr, string, string, string, *glib.Var
 //	                        (GAsyncReadyCallback) two_strings_done,
 //	                        NULL);
 //
-// ]|
-//
 // This is an asynchronous method. When the operation is finished,
-// @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can then call
-// g_dbus_connection_call_finish() to get the result of the operation.
-// See g_dbus_connection_call_sync() for the synchronous version of this
+// [DBusConnection.CallFinish] to get the result of the operation.
+// See [DBusConnection.CallSync] for the synchronous version of this
 // function.
 //
-// If @callback is %NULL then the D-Bus method call message will be sent with
-// the %G_DBUS_MESSAGE_FLAGS_NO_REPLY_EXPECTED flag set.
+// If callback is nil then the D-Bus method call message will be sent with
+// the [GDbusMessageFlagsNoReplyExpectedValue] flag set.
 func (x *DBusConnection) Call(BusNameVar *string, ObjectPathVar string, InterfaceNameVar string, MethodNameVar string, ParametersVar *glib.Variant, ReplyTypeVar *glib.VariantType, FlagsVar DBusCallFlags, TimeoutMsecVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -622,11 +639,12 @@ func (x *DBusConnection) Call(BusNameVar *string, ObjectPathVar string, Interfac
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -639,13 +657,13 @@ func (x *DBusConnection) Call(BusNameVar *string, ObjectPathVar string, Interfac
 
 var xDBusConnectionCallFinish func(uintptr, uintptr, **glib.Error) *glib.Variant
 
-// Finishes an operation started with g_dbus_connection_call().
+// Finishes an operation started with [DBusConnection.Call].
 func (x *DBusConnection) CallFinish(ResVar AsyncResult) (*glib.Variant, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionCallFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -653,24 +671,23 @@ func (x *DBusConnection) CallFinish(ResVar AsyncResult) (*glib.Variant, error) {
 
 var xDBusConnectionCallSync func(uintptr, uintptr, string, string, string, *glib.Variant, *glib.VariantType, DBusCallFlags, int, uintptr, **glib.Error) *glib.Variant
 
-// Synchronously invokes the @method_name method on the
-// @interface_name D-Bus interface on the remote object at
-// @object_path owned by @bus_name.
+// Synchronously invokes the method_name method on the
+// interface_name D-Bus interface on the remote object at
+// object_path owned by bus_name.
 //
-// If @connection is closed then the operation will fail with
-// %G_IO_ERROR_CLOSED. If @cancellable is canceled, the
-// operation will fail with %G_IO_ERROR_CANCELLED. If @parameters
+// If connection is closed then the operation will fail with
+// [GIoErrorClosedValue]. If cancellable is canceled, the
+// operation will fail with [GIoErrorCancelledValue]. If parameters
 // contains a value not compatible with the D-Bus protocol, the operation
-// fails with %G_IO_ERROR_INVALID_ARGUMENT.
+// fails with [GIoErrorInvalidArgumentValue].
 //
-// If @reply_type is non-%NULL then the reply will be checked for having
+// If reply_type is non-nil then the reply will be checked for having
 // this type and an error will be raised if it does not match.  Said
-// another way, if you give a @reply_type then any non-%NULL return
+// another way, if you give a reply_type then any non-nil return
 // value will be of this type.
 //
-// If the @parameters #GVariant is floating, it is consumed.
-// This allows convenient 'inline' use of g_variant_new(), e.g.:
-// |[&lt;!-- language="C" --&gt;
+// If the parameters [glib.Variant] is floating, it is consumed.
+// This allows convenient 'inline' use of [glib.NewVariant], e.g.:
 //
 //	g_dbus_connection_call_sync (connection,
 //	                             "org.freedesktop.StringThings",
@@ -684,12 +701,10 @@ var xDBusConnectionCallSync func(uintptr, uintptr, string, string, string, *glib
 //	                             G_DBUS_CALL_FLAGS_NONE,
 //	                             -1,
 //	                             NULL,
-//	                             &amp;error);
-//
-// ]|
+//	                             &error);
 //
 // The calling thread is blocked until a reply is received. See
-// g_dbus_connection_call() for the asynchronous version of
+// [DBusConnection.Call] for the asynchronous version of
 // this method.
 func (x *DBusConnection) CallSync(BusNameVar *string, ObjectPathVar string, InterfaceNameVar string, MethodNameVar string, ParametersVar *glib.Variant, ReplyTypeVar *glib.VariantType, FlagsVar DBusCallFlags, TimeoutMsecVar int, CancellableVar *Cancellable) (*glib.Variant, error) {
 	var cerr *glib.Error
@@ -699,7 +714,7 @@ func (x *DBusConnection) CallSync(BusNameVar *string, ObjectPathVar string, Inte
 
 	cret := xDBusConnectionCallSync(x.GoPointer(), BusNameVarPtr, ObjectPathVar, InterfaceNameVar, MethodNameVar, ParametersVar, ReplyTypeVar, FlagsVar, TimeoutMsecVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -707,11 +722,11 @@ func (x *DBusConnection) CallSync(BusNameVar *string, ObjectPathVar string, Inte
 
 var xDBusConnectionCallWithUnixFdList func(uintptr, uintptr, string, string, string, *glib.Variant, *glib.VariantType, DBusCallFlags, int, uintptr, uintptr, uintptr, uintptr)
 
-// Like g_dbus_connection_call() but also takes a #GUnixFDList object.
+// Like [DBusConnection.Call] but also takes a [UnixFDList] object.
 //
-// The file descriptors normally correspond to %G_VARIANT_TYPE_HANDLE
+// The file descriptors normally correspond to G_VARIANT_TYPE_HANDLE
 // values in the body of the message. For example, if a message contains
-// two file descriptors, @fd_list would have length 2, and
+// two file descriptors, fd_list would have length 2, and
 // `g_variant_new_handle (0)` and `g_variant_new_handle (1)` would appear
 // somewhere in the body of the message (not necessarily in that order!)
 // to represent the file descriptors at indexes 0 and 1 respectively.
@@ -719,7 +734,7 @@ var xDBusConnectionCallWithUnixFdList func(uintptr, uintptr, string, string, str
 // When designing D-Bus APIs that are intended to be interoperable,
 // please note that non-GDBus implementations of D-Bus can usually only
 // access file descriptors if they are referenced in this way by a
-// value of type %G_VARIANT_TYPE_HANDLE in the body of the message.
+// value of type G_VARIANT_TYPE_HANDLE in the body of the message.
 //
 // This method is only available on UNIX.
 func (x *DBusConnection) CallWithUnixFdList(BusNameVar *string, ObjectPathVar string, InterfaceNameVar string, MethodNameVar string, ParametersVar *glib.Variant, ReplyTypeVar *glib.VariantType, FlagsVar DBusCallFlags, TimeoutMsecVar int, FdListVar *UnixFDList, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
@@ -731,11 +746,12 @@ func (x *DBusConnection) CallWithUnixFdList(BusNameVar *string, ObjectPathVar st
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -748,24 +764,24 @@ func (x *DBusConnection) CallWithUnixFdList(BusNameVar *string, ObjectPathVar st
 
 var xDBusConnectionCallWithUnixFdListFinish func(uintptr, **UnixFDList, uintptr, **glib.Error) *glib.Variant
 
-// Finishes an operation started with g_dbus_connection_call_with_unix_fd_list().
+// Finishes an operation started with [DBusConnection.CallWithUnixFdList].
 //
-// The file descriptors normally correspond to %G_VARIANT_TYPE_HANDLE
+// The file descriptors normally correspond to G_VARIANT_TYPE_HANDLE
 // values in the body of the message. For example,
-// if g_variant_get_handle() returns 5, that is intended to be a reference
+// if [glib.Variant.GetHandle] returns 5, that is intended to be a reference
 // to the file descriptor that can be accessed by
 // `g_unix_fd_list_get (*out_fd_list, 5, ...)`.
 //
 // When designing D-Bus APIs that are intended to be interoperable,
 // please note that non-GDBus implementations of D-Bus can usually only
 // access file descriptors if they are referenced in this way by a
-// value of type %G_VARIANT_TYPE_HANDLE in the body of the message.
+// value of type G_VARIANT_TYPE_HANDLE in the body of the message.
 func (x *DBusConnection) CallWithUnixFdListFinish(OutFdListVar **UnixFDList, ResVar AsyncResult) (*glib.Variant, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionCallWithUnixFdListFinish(x.GoPointer(), OutFdListVar, ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -773,9 +789,9 @@ func (x *DBusConnection) CallWithUnixFdListFinish(OutFdListVar **UnixFDList, Res
 
 var xDBusConnectionCallWithUnixFdListSync func(uintptr, uintptr, string, string, string, *glib.Variant, *glib.VariantType, DBusCallFlags, int, uintptr, **UnixFDList, uintptr, **glib.Error) *glib.Variant
 
-// Like g_dbus_connection_call_sync() but also takes and returns #GUnixFDList objects.
-// See g_dbus_connection_call_with_unix_fd_list() and
-// g_dbus_connection_call_with_unix_fd_list_finish() for more details.
+// Like [DBusConnection.CallSync] but also takes and returns [UnixFDList] objects.
+// See [DBusConnection.CallWithUnixFdList] and
+// [DBusConnection.CallWithUnixFdListFinish] for more details.
 //
 // This method is only available on UNIX.
 func (x *DBusConnection) CallWithUnixFdListSync(BusNameVar *string, ObjectPathVar string, InterfaceNameVar string, MethodNameVar string, ParametersVar *glib.Variant, ReplyTypeVar *glib.VariantType, FlagsVar DBusCallFlags, TimeoutMsecVar int, FdListVar *UnixFDList, OutFdListVar **UnixFDList, CancellableVar *Cancellable) (*glib.Variant, error) {
@@ -786,7 +802,7 @@ func (x *DBusConnection) CallWithUnixFdListSync(BusNameVar *string, ObjectPathVa
 
 	cret := xDBusConnectionCallWithUnixFdListSync(x.GoPointer(), BusNameVarPtr, ObjectPathVar, InterfaceNameVar, MethodNameVar, ParametersVar, ReplyTypeVar, FlagsVar, TimeoutMsecVar, FdListVar.GoPointer(), OutFdListVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -794,29 +810,29 @@ func (x *DBusConnection) CallWithUnixFdListSync(BusNameVar *string, ObjectPathVa
 
 var xDBusConnectionClose func(uintptr, uintptr, uintptr, uintptr)
 
-// Closes @connection. Note that this never causes the process to
+// Closes connection. Note that this never causes the process to
 // exit (this might only happen if the other end of a shared message
-// bus connection disconnects, see #GDBusConnection:exit-on-close).
+// bus connection disconnects, see [DBusConnection.GetPropertyExitOnClose]).
 //
 // Once the connection is closed, operations such as sending a message
-// will return with the error %G_IO_ERROR_CLOSED. Closing a connection
+// will return with the error [GIoErrorClosedValue]. Closing a connection
 // will not automatically flush the connection so queued messages may
-// be lost. Use g_dbus_connection_flush() if you need such guarantees.
+// be lost. Use [DBusConnection.Flush] if you need such guarantees.
 //
-// If @connection is already closed, this method fails with
-// %G_IO_ERROR_CLOSED.
+// If connection is already closed, this method fails with
+// [GIoErrorClosedValue].
 //
-// When @connection has been closed, the #GDBusConnection::closed
+// When connection has been closed, the [DBusConnection.ConnectClosedFunc]
 // signal is emitted in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// of the thread that @connection was constructed in.
+// (see [glib.MainContext.PushThreadDefault])
+// of the thread that connection was constructed in.
 //
 // This is an asynchronous method. When the operation is finished,
-// @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can
-// then call g_dbus_connection_close_finish() to get the result of the
-// operation. See g_dbus_connection_close_sync() for the synchronous
+// then call [DBusConnection.CloseFinish] to get the result of the
+// operation. See [DBusConnection.CloseSync] for the synchronous
 // version.
 func (x *DBusConnection) Close(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -827,11 +843,12 @@ func (x *DBusConnection) Close(CancellableVar *Cancellable, CallbackVar *AsyncRe
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -841,13 +858,13 @@ func (x *DBusConnection) Close(CancellableVar *Cancellable, CallbackVar *AsyncRe
 
 var xDBusConnectionCloseFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes an operation started with g_dbus_connection_close().
+// Finishes an operation started with [DBusConnection.Close].
 func (x *DBusConnection) CloseFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionCloseFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -855,8 +872,8 @@ func (x *DBusConnection) CloseFinish(ResVar AsyncResult) (bool, error) {
 
 var xDBusConnectionCloseSync func(uintptr, uintptr, **glib.Error) bool
 
-// Synchronously closes @connection. The calling thread is blocked
-// until this is done. See g_dbus_connection_close() for the
+// Synchronously closes connection. The calling thread is blocked
+// until this is done. See [DBusConnection.Close] for the
 // asynchronous version of this method and more details about what it
 // does.
 func (x *DBusConnection) CloseSync(CancellableVar *Cancellable) (bool, error) {
@@ -864,7 +881,7 @@ func (x *DBusConnection) CloseSync(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xDBusConnectionCloseSync(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -876,9 +893,9 @@ var xDBusConnectionEmitSignal func(uintptr, uintptr, string, string, string, *gl
 //
 // If the parameters GVariant is floating, it is consumed.
 //
-// This can only fail if @parameters is not compatible with the D-Bus protocol
-// (%G_IO_ERROR_INVALID_ARGUMENT), or if @connection has been closed
-// (%G_IO_ERROR_CLOSED).
+// This can only fail if parameters is not compatible with the D-Bus protocol
+// ([GIoErrorInvalidArgumentValue]), or if connection has been closed
+// ([GIoErrorClosedValue]).
 func (x *DBusConnection) EmitSignal(DestinationBusNameVar *string, ObjectPathVar string, InterfaceNameVar string, SignalNameVar string, ParametersVar *glib.Variant) (bool, error) {
 	var cerr *glib.Error
 
@@ -887,7 +904,7 @@ func (x *DBusConnection) EmitSignal(DestinationBusNameVar *string, ObjectPathVar
 
 	cret := xDBusConnectionEmitSignal(x.GoPointer(), DestinationBusNameVarPtr, ObjectPathVar, InterfaceNameVar, SignalNameVar, ParametersVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -895,17 +912,17 @@ func (x *DBusConnection) EmitSignal(DestinationBusNameVar *string, ObjectPathVar
 
 var xDBusConnectionExportActionGroup func(uintptr, string, uintptr, **glib.Error) uint
 
-// Exports @action_group on @connection at @object_path.
+// Exports action_group on connection at object_path.
 //
 // The implemented D-Bus API should be considered private.  It is
 // subject to change in the future.
 //
 // A given object path can only have one action group exported on it.
 // If this constraint is violated, the export will fail and 0 will be
-// returned (with @error set accordingly).
+// returned (with error set accordingly).
 //
 // You can unexport the action group using
-// [method@Gio.DBusConnection.unexport_action_group] with the return value of
+// [DBusConnection.UnexportActionGroup] with the return value of
 // this function.
 //
 // The thread default main context is taken at the time of this call.
@@ -921,7 +938,7 @@ func (x *DBusConnection) ExportActionGroup(ObjectPathVar string, ActionGroupVar
 
 	cret := xDBusConnectionExportActionGroup(x.GoPointer(), ObjectPathVar, ActionGroupVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -929,28 +946,28 @@ func (x *DBusConnection) ExportActionGroup(ObjectPathVar string, ActionGroupVar
 
 var xDBusConnectionExportMenuModel func(uintptr, string, uintptr, **glib.Error) uint
 
-// Exports @menu on @connection at @object_path.
+// Exports menu on connection at object_path.
 //
 // The implemented D-Bus API should be considered private.
 // It is subject to change in the future.
 //
 // An object path can only have one menu model exported on it. If this
 // constraint is violated, the export will fail and 0 will be
-// returned (with @error set accordingly).
+// returned (with error set accordingly).
 //
 // Exporting menus with sections containing more than
-// %G_MENU_EXPORTER_MAX_SECTION_SIZE items is not supported and results in
+// [MENU_EXPORTER_MAX_SECTION_SIZE] items is not supported and results in
 // undefined behavior.
 //
 // You can unexport the menu model using
-// g_dbus_connection_unexport_menu_model() with the return value of
+// [DBusConnection.UnexportMenuModel] with the return value of
 // this function.
 func (x *DBusConnection) ExportMenuModel(ObjectPathVar string, MenuVar *MenuModel) (uint, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionExportMenuModel(x.GoPointer(), ObjectPathVar, MenuVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -958,19 +975,19 @@ func (x *DBusConnection) ExportMenuModel(ObjectPathVar string, MenuVar *MenuMode
 
 var xDBusConnectionFlush func(uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously flushes @connection, that is, writes all queued
+// Asynchronously flushes connection, that is, writes all queued
 // outgoing messages to the transport and then flushes the transport
-// (using g_output_stream_flush_async()). This is useful in programs
+// (using [OutputStream.FlushAsync]). This is useful in programs
 // that want to emit a D-Bus signal and then exit immediately. Without
 // flushing the connection, there is no guarantee that the message has
 // been sent to the networking buffers in the OS kernel.
 //
 // This is an asynchronous method. When the operation is finished,
-// @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can
-// then call g_dbus_connection_flush_finish() to get the result of the
-// operation. See g_dbus_connection_flush_sync() for the synchronous
+// then call [DBusConnection.FlushFinish] to get the result of the
+// operation. See [DBusConnection.FlushSync] for the synchronous
 // version.
 func (x *DBusConnection) Flush(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -981,11 +998,12 @@ func (x *DBusConnection) Flush(CancellableVar *Cancellable, CallbackVar *AsyncRe
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -995,13 +1013,13 @@ func (x *DBusConnection) Flush(CancellableVar *Cancellable, CallbackVar *AsyncRe
 
 var xDBusConnectionFlushFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes an operation started with g_dbus_connection_flush().
+// Finishes an operation started with [DBusConnection.Flush].
 func (x *DBusConnection) FlushFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionFlushFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1009,8 +1027,8 @@ func (x *DBusConnection) FlushFinish(ResVar AsyncResult) (bool, error) {
 
 var xDBusConnectionFlushSync func(uintptr, uintptr, **glib.Error) bool
 
-// Synchronously flushes @connection. The calling thread is blocked
-// until this is done. See g_dbus_connection_flush() for the
+// Synchronously flushes connection. The calling thread is blocked
+// until this is done. See [DBusConnection.Flush] for the
 // asynchronous version of this method and more details about what it
 // does.
 func (x *DBusConnection) FlushSync(CancellableVar *Cancellable) (bool, error) {
@@ -1018,7 +1036,7 @@ func (x *DBusConnection) FlushSync(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xDBusConnectionFlushSync(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1035,9 +1053,9 @@ func (x *DBusConnection) GetCapabilities() DBusCapabilityFlags {
 
 var xDBusConnectionGetExitOnClose func(uintptr) bool
 
-// Gets whether the process is terminated when @connection is
+// Gets whether the process is terminated when connection is
 // closed by the remote peer. See
-// #GDBusConnection:exit-on-close for more details.
+// [DBusConnection.GetPropertyExitOnClose] for more details.
 func (x *DBusConnection) GetExitOnClose() bool {
 
 	cret := xDBusConnectionGetExitOnClose(x.GoPointer())
@@ -1056,7 +1074,7 @@ func (x *DBusConnection) GetFlags() DBusConnectionFlags {
 var xDBusConnectionGetGuid func(uintptr) string
 
 // The GUID of the peer performing the role of server when
-// authenticating. See #GDBusConnection:guid for more details.
+// authenticating. See [DBusConnection.GetPropertyGuid] for more details.
 func (x *DBusConnection) GetGuid() string {
 
 	cret := xDBusConnectionGetGuid(x.GoPointer())
@@ -1065,11 +1083,11 @@ func (x *DBusConnection) GetGuid() string {
 
 var xDBusConnectionGetLastSerial func(uintptr) uint32
 
-// Retrieves the last serial number assigned to a #GDBusMessage on
+// Retrieves the last serial number assigned to a [DBusMessage] on
 // the current thread. This includes messages sent via both low-level
-// API such as g_dbus_connection_send_message() as well as
-// high-level API such as g_dbus_connection_emit_signal(),
-// g_dbus_connection_call() or g_dbus_proxy_call().
+// API such as [DBusConnection.SendMessage] as well as
+// high-level API such as [DBusConnection.EmitSignal],
+// [DBusConnection.Call] or [DBusProxy.Call].
 func (x *DBusConnection) GetLastSerial() uint32 {
 
 	cret := xDBusConnectionGetLastSerial(x.GoPointer())
@@ -1079,14 +1097,14 @@ func (x *DBusConnection) GetLastSerial() uint32 {
 var xDBusConnectionGetPeerCredentials func(uintptr) uintptr
 
 // Gets the credentials of the authenticated peer. This will always
-// return %NULL unless @connection acted as a server
-// (e.g. %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER was passed)
+// return nil unless connection acted as a server
+// (e.g. [GDbusConnectionFlagsAuthenticationServerValue] was passed)
 // when set up and the client passed credentials as part of the
 // authentication process.
 //
 // In a message bus setup, the message bus is always the server and
 // each application is a client. So this method will always return
-// %NULL for message bus clients.
+// nil for message bus clients.
 func (x *DBusConnection) GetPeerCredentials() *Credentials {
 	var cls *Credentials
 
@@ -1105,7 +1123,7 @@ var xDBusConnectionGetStream func(uintptr) uintptr
 
 // Gets the underlying stream used for IO.
 //
-// While the #GDBusConnection is active, it will interact with this
+// While the [DBusConnection] is active, it will interact with this
 // stream from a worker thread, so it is not safe to interact with
 // the stream directly.
 func (x *DBusConnection) GetStream() *IOStream {
@@ -1124,8 +1142,8 @@ func (x *DBusConnection) GetStream() *IOStream {
 
 var xDBusConnectionGetUniqueName func(uintptr) string
 
-// Gets the unique name of @connection as assigned by the message
-// bus. This can also be used to figure out if @connection is a
+// Gets the unique name of connection as assigned by the message
+// bus. This can also be used to figure out if connection is a
 // message bus connection.
 func (x *DBusConnection) GetUniqueName() string {
 
@@ -1135,7 +1153,7 @@ func (x *DBusConnection) GetUniqueName() string {
 
 var xDBusConnectionIsClosed func(uintptr) bool
 
-// Gets whether @connection is closed.
+// Gets whether connection is closed.
 func (x *DBusConnection) IsClosed() bool {
 
 	cret := xDBusConnectionIsClosed(x.GoPointer())
@@ -1144,31 +1162,31 @@ func (x *DBusConnection) IsClosed() bool {
 
 var xDBusConnectionRegisterObject func(uintptr, string, *DBusInterfaceInfo, *DBusInterfaceVTable, uintptr, uintptr, **glib.Error) uint
 
-// Registers callbacks for exported objects at @object_path with the
-// D-Bus interface that is described in @interface_info.
+// Registers callbacks for exported objects at object_path with the
+// D-Bus interface that is described in interface_info.
 //
-// Calls to functions in @vtable (and @user_data_free_func) will happen
+// Calls to functions in vtable (and user_data_free_func) will happen
 // in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from.
 //
-// Note that all #GVariant values passed to functions in @vtable will match
-// the signature given in @interface_info - if a remote caller passes
+// Note that all [glib.Variant] values passed to functions in vtable will match
+// the signature given in interface_info - if a remote caller passes
 // incorrect values, the `org.freedesktop.DBus.Error.InvalidArgs`
 // is returned to the remote caller.
 //
 // Additionally, if the remote caller attempts to invoke methods or
-// access properties not mentioned in @interface_info the
+// access properties not mentioned in interface_info the
 // `org.freedesktop.DBus.Error.UnknownMethod` resp.
 // `org.freedesktop.DBus.Error.InvalidArgs` errors
 // are returned to the caller.
 //
 // It is considered a programming error if the
-// #GDBusInterfaceGetPropertyFunc function in @vtable returns a
-// #GVariant of incorrect type.
+// [DBusInterfaceGetPropertyFunc] function in vtable returns a
+// [glib.Variant] of incorrect type.
 //
-// If an existing callback is already registered at @object_path and
-// @interface_name, then @error is set to %G_IO_ERROR_EXISTS.
+// If an existing callback is already registered at object_path and
+// interface_name, then error is set to [GIoErrorExistsValue].
 //
 // GDBus automatically implements the standard D-Bus interfaces
 // org.freedesktop.DBus.Properties, org.freedesktop.DBus.Introspectable
@@ -1176,13 +1194,15 @@ var xDBusConnectionRegisterObject func(uintptr, string, *DBusInterfaceInfo, *DBu
 // objects you export. You can implement org.freedesktop.DBus.Properties
 // yourself, e.g. to handle getting and setting of properties asynchronously.
 //
-// Note that the reference count on @interface_info will be
+// Note that the reference count on interface_info will be
 // incremented by 1 (unless allocated statically, e.g. if the
-// reference count is -1, see g_dbus_interface_info_ref()) for as long
-// as the object is exported. Also note that @vtable will be copied.
+// reference count is -1, see [DBusInterfaceInfo.Ref]) for as long
+// as the object is exported. Also note that vtable will be copied.
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // for an example of how to use this method.
+//
+// Deprecated: use [RegisterObjectWithClosures], the version of this function that language bindings are meant to use.
 func (x *DBusConnection) RegisterObject(ObjectPathVar string, InterfaceInfoVar *DBusInterfaceInfo, VtableVar *DBusInterfaceVTable, UserDataVar uintptr, UserDataFreeFuncVar *glib.DestroyNotify) (uint, error) {
 	var cerr *glib.Error
 
@@ -1193,37 +1213,18 @@ func (x *DBusConnection) RegisterObject(ObjectPathVar string, InterfaceInfoVar *
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
 	cret := xDBusConnectionRegisterObject(x.GoPointer(), ObjectPathVar, InterfaceInfoVar, VtableVar, UserDataVar, UserDataFreeFuncVarRef, &cerr)
 	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
-var xDBusConnectionRegisterObjectWithClosures func(uintptr, string, *DBusInterfaceInfo, *gobject.Closure, *gobject.Closure, *gobject.Closure, **glib.Error) uint
-
-// Version of g_dbus_connection_register_object() using closures instead of a
-// #GDBusInterfaceVTable for easier binding in other languages.
-//
-// Note that the reference counting semantics of the function wrapped by
-// @method_call_closure are the same as those of
-// [callback@Gio.DBusInterfaceMethodCallFunc]: ownership of a reference to the
-// [class@Gio.DBusMethodInvocation] is transferred to the function.
-func (x *DBusConnection) RegisterObjectWithClosures(ObjectPathVar string, InterfaceInfoVar *DBusInterfaceInfo, MethodCallClosureVar *gobject.Closure, GetPropertyClosureVar *gobject.Closure, SetPropertyClosureVar *gobject.Closure) (uint, error) {
-	var cerr *glib.Error
-
-	cret := xDBusConnectionRegisterObjectWithClosures(x.GoPointer(), ObjectPathVar, InterfaceInfoVar, MethodCallClosureVar, GetPropertyClosureVar, SetPropertyClosureVar, &cerr)
-	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1231,16 +1232,16 @@ func (x *DBusConnection) RegisterObjectWithClosures(ObjectPathVar string, Interf
 
 var xDBusConnectionRegisterObjectWithClosures2 func(uintptr, string, *DBusInterfaceInfo, *gobject.Closure, *gobject.Closure, *gobject.Closure, **glib.Error) uint
 
-// Version of [method@Gio.DBusConnection.register_object] using closures instead
-// of a [type@Gio.DBusInterfaceVTable] for easier binding in other languages.
+// Version of [DBusConnection.RegisterObject] using closures instead
+// of a [DBusInterfaceVTable] for easier binding in other languages.
 //
-// In contrast to [method@Gio.DBusConnection.register_object] and
-// [method@Gio.DBusConnection.register_object_with_closures], the reference
-// counting semantics of the function wrapped by @method_call_closure are *not*
-// the same as those of [callback@Gio.DBusInterfaceMethodCallFunc]. Ownership of
-// a reference to the [class@Gio.DBusMethodInvocation] is *not* transferred to
+// In contrast to [DBusConnection.RegisterObject] and
+// [DBusConnection.RegisterObjectWithClosures], the reference
+// counting semantics of the function wrapped by method_call_closure are *not*
+// the same as those of [DBusInterfaceMethodCallFunc]. Ownership of
+// a reference to the [DBusMethodInvocation] is *not* transferred to
 // the function. Bindings must ensure that they add a reference to the
-// [class@Gio.DBusMethodInvocation] before calling any
+// [DBusMethodInvocation] before calling any
 // `g_dbus_method_invocation_return_*()` methods on it. This should be automatic
 // as a result of the introspection annotations on those methods.
 func (x *DBusConnection) RegisterObjectWithClosures2(ObjectPathVar string, InterfaceInfoVar *DBusInterfaceInfo, MethodCallClosureVar *gobject.Closure, GetPropertyClosureVar *gobject.Closure, SetPropertyClosureVar *gobject.Closure) (uint, error) {
@@ -1248,7 +1249,7 @@ func (x *DBusConnection) RegisterObjectWithClosures2(ObjectPathVar string, Inter
 
 	cret := xDBusConnectionRegisterObjectWithClosures2(x.GoPointer(), ObjectPathVar, InterfaceInfoVar, MethodCallClosureVar, GetPropertyClosureVar, SetPropertyClosureVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1258,34 +1259,34 @@ var xDBusConnectionRegisterSubtree func(uintptr, string, *DBusSubtreeVTable, DBu
 
 // Registers a whole subtree of dynamic objects.
 //
-// The @enumerate and @introspection functions in @vtable are used to
+// The enumerate and introspection functions in vtable are used to
 // convey, to remote callers, what nodes exist in the subtree rooted
-// by @object_path.
+// by object_path.
 //
 // When handling remote calls into any node in the subtree, first the
-// @enumerate function is used to check if the node exists. If the node exists
-// or the %G_DBUS_SUBTREE_FLAGS_DISPATCH_TO_UNENUMERATED_NODES flag is set
-// the @introspection function is used to check if the node supports the
-// requested method. If so, the @dispatch function is used to determine
-// where to dispatch the call. The collected #GDBusInterfaceVTable and
+// enumerate function is used to check if the node exists. If the node exists
+// or the [GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue] flag is set
+// the introspection function is used to check if the node supports the
+// requested method. If so, the dispatch function is used to determine
+// where to dispatch the call. The collected [DBusInterfaceVTable] and
 // #gpointer will be used to call into the interface vtable for processing
 // the request.
 //
 // All calls into user-provided code will be invoked in the thread-default
-// main context (see [method@GLib.MainContext.push_thread_default])
+// main context (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from.
 //
-// If an existing subtree is already registered at @object_path or
-// then @error is set to %G_IO_ERROR_EXISTS.
+// If an existing subtree is already registered at object_path or
+// then error is set to [GIoErrorExistsValue].
 //
 // Note that it is valid to register regular objects (using
-// g_dbus_connection_register_object()) in a subtree registered with
-// g_dbus_connection_register_subtree() - if so, the subtree handler
+// [DBusConnection.RegisterObject]) in a subtree registered with
+// [DBusConnection.RegisterSubtree] - if so, the subtree handler
 // is tried as the last resort. One way to think about a subtree
 // handler is to consider it a fallback handler for object paths not
-// registered via g_dbus_connection_register_object() or other bindings.
+// registered via [DBusConnection.RegisterObject] or other bindings.
 //
-// Note that @vtable will be copied so you cannot change it after
+// Note that vtable will be copied so you cannot change it after
 // registration.
 //
 // See this [server][class@Gio.DBusConnection#an-example-for-exporting-a-subtree]
@@ -1300,17 +1301,18 @@ func (x *DBusConnection) RegisterSubtree(ObjectPathVar string, VtableVar *DBusSu
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
 	cret := xDBusConnectionRegisterSubtree(x.GoPointer(), ObjectPathVar, VtableVar, FlagsVar, UserDataVar, UserDataFreeFuncVarRef, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1322,9 +1324,9 @@ var xDBusConnectionRemoveFilter func(uintptr, uint)
 //
 // Note that since filters run in a different thread, there is a race
 // condition where it is possible that the filter will be running even
-// after calling g_dbus_connection_remove_filter(), so you cannot just
+// after calling [DBusConnection.RemoveFilter], so you cannot just
 // free data that the filter might be using. Instead, you should pass
-// a #GDestroyNotify to g_dbus_connection_add_filter(), which will be
+// a [glib.DestroyNotify] to [DBusConnection.AddFilter], which will be
 // called when it is guaranteed that the data is no longer needed.
 func (x *DBusConnection) RemoveFilter(FilterIdVar uint) {
 
@@ -1334,34 +1336,34 @@ func (x *DBusConnection) RemoveFilter(FilterIdVar uint) {
 
 var xDBusConnectionSendMessage func(uintptr, uintptr, DBusSendMessageFlags, *uint32, **glib.Error) bool
 
-// Asynchronously sends @message to the peer represented by @connection.
+// Asynchronously sends message to the peer represented by connection.
 //
-// Unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag, the serial number
-// will be assigned by @connection and set on @message via
-// g_dbus_message_set_serial(). If @out_serial is not %NULL, then the
+// Unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag, the serial number
+// will be assigned by connection and set on message via
+// [DBusMessage.SetSerial]. If out_serial is not nil, then the
 // serial number used will be written to this location prior to
 // submitting the message to the underlying transport. While it has a `volatile`
 // qualifier, this is a historical artifact and the argument passed to it should
 // not be `volatile`.
 //
-// If @connection is closed then the operation will fail with
-// %G_IO_ERROR_CLOSED. If @message is not well-formed,
-// the operation fails with %G_IO_ERROR_INVALID_ARGUMENT.
+// If connection is closed then the operation will fail with
+// [GIoErrorClosedValue]. If message is not well-formed,
+// the operation fails with [GIoErrorInvalidArgumentValue].
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // and [client][class@Gio.DBusConnection#an-example-for-file-descriptor-passing]
 // for an example of how to use this low-level API to send and receive
 // UNIX file descriptors.
 //
-// Note that @message must be unlocked, unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag.
+// Note that message must be unlocked, unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag.
 func (x *DBusConnection) SendMessage(MessageVar *DBusMessage, FlagsVar DBusSendMessageFlags, OutSerialVar *uint32) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDBusConnectionSendMessage(x.GoPointer(), MessageVar.GoPointer(), FlagsVar, OutSerialVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1369,31 +1371,31 @@ func (x *DBusConnection) SendMessage(MessageVar *DBusMessage, FlagsVar DBusSendM
 
 var xDBusConnectionSendMessageWithReply func(uintptr, uintptr, DBusSendMessageFlags, int, *uint32, uintptr, uintptr, uintptr)
 
-// Asynchronously sends @message to the peer represented by @connection.
+// Asynchronously sends message to the peer represented by connection.
 //
-// Unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag, the serial number
-// will be assigned by @connection and set on @message via
-// g_dbus_message_set_serial(). If @out_serial is not %NULL, then the
+// Unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag, the serial number
+// will be assigned by connection and set on message via
+// [DBusMessage.SetSerial]. If out_serial is not nil, then the
 // serial number used will be written to this location prior to
 // submitting the message to the underlying transport. While it has a `volatile`
 // qualifier, this is a historical artifact and the argument passed to it should
 // not be `volatile`.
 //
-// If @connection is closed then the operation will fail with
-// %G_IO_ERROR_CLOSED. If @cancellable is canceled, the operation will
-// fail with %G_IO_ERROR_CANCELLED. If @message is not well-formed,
-// the operation fails with %G_IO_ERROR_INVALID_ARGUMENT.
+// If connection is closed then the operation will fail with
+// [GIoErrorClosedValue]. If cancellable is canceled, the operation will
+// fail with [GIoErrorCancelledValue]. If message is not well-formed,
+// the operation fails with [GIoErrorInvalidArgumentValue].
 //
-// This is an asynchronous method. When the operation is finished, @callback
+// This is an asynchronous method. When the operation is finished, callback
 // will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can then call
-// g_dbus_connection_send_message_with_reply_finish() to get the result of the operation.
-// See g_dbus_connection_send_message_with_reply_sync() for the synchronous version.
+// [DBusConnection.SendMessageWithReplyFinish] to get the result of the operation.
+// See [DBusConnection.SendMessageWithReplySync] for the synchronous version.
 //
-// Note that @message must be unlocked, unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag.
+// Note that message must be unlocked, unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag.
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // and [client][class@Gio.DBusConnection#an-example-for-file-descriptor-passing]
@@ -1408,11 +1410,12 @@ func (x *DBusConnection) SendMessageWithReply(MessageVar *DBusMessage, FlagsVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1422,12 +1425,12 @@ func (x *DBusConnection) SendMessageWithReply(MessageVar *DBusMessage, FlagsVar
 
 var xDBusConnectionSendMessageWithReplyFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_connection_send_message_with_reply().
+// Finishes an operation started with [DBusConnection.SendMessageWithReply].
 //
-// Note that @error is only set if a local in-process error
-// occurred. That is to say that the returned #GDBusMessage object may
-// be of type %G_DBUS_MESSAGE_TYPE_ERROR. Use
-// g_dbus_message_to_gerror() to transcode this to a #GError.
+// Note that error is only set if a local in-process error
+// occurred. That is to say that the returned [DBusMessage] object may
+// be of type [GDbusMessageTypeErrorValue]. Use
+// [DBusMessage.ToGerror] to transcode this to a [glib.Error].
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // and [client][class@Gio.DBusConnection#an-example-for-file-descriptor-passing]
@@ -1440,12 +1443,15 @@ func (x *DBusConnection) SendMessageWithReplyFinish(ResVar AsyncResult) (*DBusMe
 	cret := xDBusConnectionSendMessageWithReplyFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusMessage{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1453,37 +1459,37 @@ func (x *DBusConnection) SendMessageWithReplyFinish(ResVar AsyncResult) (*DBusMe
 
 var xDBusConnectionSendMessageWithReplySync func(uintptr, uintptr, DBusSendMessageFlags, int, *uint32, uintptr, **glib.Error) uintptr
 
-// Synchronously sends @message to the peer represented by @connection
+// Synchronously sends message to the peer represented by connection
 // and blocks the calling thread until a reply is received or the
-// timeout is reached. See g_dbus_connection_send_message_with_reply()
+// timeout is reached. See [DBusConnection.SendMessageWithReply]
 // for the asynchronous version of this method.
 //
-// Unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag, the serial number
-// will be assigned by @connection and set on @message via
-// g_dbus_message_set_serial(). If @out_serial is not %NULL, then the
+// Unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag, the serial number
+// will be assigned by connection and set on message via
+// [DBusMessage.SetSerial]. If out_serial is not nil, then the
 // serial number used will be written to this location prior to
 // submitting the message to the underlying transport. While it has a `volatile`
 // qualifier, this is a historical artifact and the argument passed to it should
 // not be `volatile`.
 //
-// If @connection is closed then the operation will fail with
-// %G_IO_ERROR_CLOSED. If @cancellable is canceled, the operation will
-// fail with %G_IO_ERROR_CANCELLED. If @message is not well-formed,
-// the operation fails with %G_IO_ERROR_INVALID_ARGUMENT.
+// If connection is closed then the operation will fail with
+// [GIoErrorClosedValue]. If cancellable is canceled, the operation will
+// fail with [GIoErrorCancelledValue]. If message is not well-formed,
+// the operation fails with [GIoErrorInvalidArgumentValue].
 //
-// Note that @error is only set if a local in-process error
-// occurred. That is to say that the returned #GDBusMessage object may
-// be of type %G_DBUS_MESSAGE_TYPE_ERROR. Use
-// g_dbus_message_to_gerror() to transcode this to a #GError.
+// Note that error is only set if a local in-process error
+// occurred. That is to say that the returned [DBusMessage] object may
+// be of type [GDbusMessageTypeErrorValue]. Use
+// [DBusMessage.ToGerror] to transcode this to a [glib.Error].
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // and [client][class@Gio.DBusConnection#an-example-for-file-descriptor-passing]
 // for an example of how to use this low-level API to send and receive
 // UNIX file descriptors.
 //
-// Note that @message must be unlocked, unless @flags contain the
-// %G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL flag.
+// Note that message must be unlocked, unless flags contain the
+// [GDbusSendMessageFlagsPreserveSerialValue] flag.
 func (x *DBusConnection) SendMessageWithReplySync(MessageVar *DBusMessage, FlagsVar DBusSendMessageFlags, TimeoutMsecVar int, OutSerialVar *uint32, CancellableVar *Cancellable) (*DBusMessage, error) {
 	var cls *DBusMessage
 	var cerr *glib.Error
@@ -1491,12 +1497,15 @@ func (x *DBusConnection) SendMessageWithReplySync(MessageVar *DBusMessage, Flags
 	cret := xDBusConnectionSendMessageWithReplySync(x.GoPointer(), MessageVar.GoPointer(), FlagsVar, TimeoutMsecVar, OutSerialVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusMessage{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1504,14 +1513,14 @@ func (x *DBusConnection) SendMessageWithReplySync(MessageVar *DBusMessage, Flags
 
 var xDBusConnectionSetExitOnClose func(uintptr, bool)
 
-// Sets whether the process should be terminated when @connection is
-// closed by the remote peer. See #GDBusConnection:exit-on-close for
+// Sets whether the process should be terminated when connection is
+// closed by the remote peer. See [DBusConnection.GetPropertyExitOnClose] for
 // more details.
 //
 // Note that this function should be used with care. Most modern UNIX
 // desktops tie the notion of a user session with the session bus, and expect
 // all of a user's applications to quit when their bus connection goes away.
-// If you are setting @exit_on_close to %FALSE for the shared session
+// If you are setting exit_on_close to false for the shared session
 // bus connection, you should make sure that your application exits
 // when the user session ends.
 func (x *DBusConnection) SetExitOnClose(ExitOnCloseVar bool) {
@@ -1522,50 +1531,50 @@ func (x *DBusConnection) SetExitOnClose(ExitOnCloseVar bool) {
 
 var xDBusConnectionSignalSubscribe func(uintptr, uintptr, uintptr, uintptr, uintptr, uintptr, DBusSignalFlags, uintptr, uintptr, uintptr) uint
 
-// Subscribes to signals on @connection and invokes @callback whenever
-// the signal is received. Note that @callback will be invoked in the
-// thread-default main context (see [method@GLib.MainContext.push_thread_default])
+// Subscribes to signals on connection and invokes callback whenever
+// the signal is received. Note that callback will be invoked in the
+// thread-default main context (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from.
 //
-// If @connection is not a message bus connection, @sender must be
-// %NULL.
+// If connection is not a message bus connection, sender must be
+// nil.
 //
-// If @sender is a well-known name note that @callback is invoked with
-// the unique name for the owner of @sender, not the well-known name
+// If sender is a well-known name note that callback is invoked with
+// the unique name for the owner of sender, not the well-known name
 // as one would expect. This is because the message bus rewrites the
 // name. As such, to avoid certain race conditions, users should be
 // tracking the name owner of the well-known name and use that when
 // processing the received signal.
 //
-// If one of %G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE or
-// %G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH are given, @arg0 is
+// If one of [GDbusSignalFlagsMatchArg0NamespaceValue] or
+// [GDbusSignalFlagsMatchArg0PathValue] are given, arg0 is
 // interpreted as part of a namespace or path.  The first argument
 // of a signal is matched against that part as specified by D-Bus.
 //
-// If @user_data_free_func is non-%NULL, it will be called (in the
+// If user_data_free_func is non-nil, it will be called (in the
 // thread-default main context of the thread you are calling this
-// method from) at some point after @user_data is no longer
+// method from) at some point after user_data is no longer
 // needed. (It is not guaranteed to be called synchronously when the
-// signal is unsubscribed from, and may be called after @connection
+// signal is unsubscribed from, and may be called after connection
 // has been destroyed.)
 //
-// As @callback is potentially invoked in a different thread from where it’s
+// As callback is potentially invoked in a different thread from where it’s
 // emitted, it’s possible for this to happen after
-// g_dbus_connection_signal_unsubscribe() has been called in another thread.
-// Due to this, @user_data should have a strong reference which is freed with
-// @user_data_free_func, rather than pointing to data whose lifecycle is tied
-// to the signal subscription. For example, if a #GObject is used to store the
-// subscription ID from g_dbus_connection_signal_subscribe(), a strong reference
-// to that #GObject must be passed to @user_data, and g_object_unref() passed to
-// @user_data_free_func. You are responsible for breaking the resulting
+// [DBusConnection.SignalUnsubscribe] has been called in another thread.
+// Due to this, user_data should have a strong reference which is freed with
+// user_data_free_func, rather than pointing to data whose lifecycle is tied
+// to the signal subscription. For example, if a [gobject.Object] is used to store the
+// subscription ID from [DBusConnection.SignalSubscribe], a strong reference
+// to that [gobject.Object] must be passed to user_data, and [gobject.Object.Unref] passed to
+// user_data_free_func. You are responsible for breaking the resulting
 // reference count cycle by explicitly unsubscribing from the signal when
-// dropping the last external reference to the #GObject. Alternatively, a weak
+// dropping the last external reference to the [gobject.Object]. Alternatively, a weak
 // reference may be used.
 //
 // It is guaranteed that if you unsubscribe from a signal using
-// g_dbus_connection_signal_unsubscribe() from the same thread which made the
-// corresponding g_dbus_connection_signal_subscribe() call, @callback will not
-// be invoked after g_dbus_connection_signal_unsubscribe() returns.
+// [DBusConnection.SignalUnsubscribe] from the same thread which made the
+// corresponding [DBusConnection.SignalSubscribe] call, callback will not
+// be invoked after [DBusConnection.SignalUnsubscribe] returns.
 //
 // The returned subscription identifier is an opaque value which is guaranteed
 // to never be zero.
@@ -1580,11 +1589,12 @@ func (x *DBusConnection) SignalSubscribe(SenderVar *string, InterfaceNameVar *st
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr, arg4 uintptr, arg5 *glib.Variant, arg6 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, core.GoString(arg1), core.GoString(arg2), core.GoString(arg3), core.GoString(arg4), arg5, arg6)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1595,11 +1605,12 @@ func (x *DBusConnection) SignalSubscribe(SenderVar *string, InterfaceNameVar *st
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -1627,13 +1638,13 @@ var xDBusConnectionSignalUnsubscribe func(uintptr, uint)
 // Unsubscribes from signals.
 //
 // Note that there may still be D-Bus traffic to process (relating to this
-// signal subscription) in the current thread-default #GMainContext after this
-// function has returned. You should continue to iterate the #GMainContext
-// until the #GDestroyNotify function passed to
-// g_dbus_connection_signal_subscribe() is called, in order to avoid memory
-// leaks through callbacks queued on the #GMainContext after it’s stopped being
+// signal subscription) in the current thread-default [glib.MainContext] after this
+// function has returned. You should continue to iterate the [glib.MainContext]
+// until the [glib.DestroyNotify] function passed to
+// [DBusConnection.SignalSubscribe] is called, in order to avoid memory
+// leaks through callbacks queued on the [glib.MainContext] after it’s stopped being
 // iterated.
-// Alternatively, any idle source with a priority lower than %G_PRIORITY_DEFAULT
+// Alternatively, any idle source with a priority lower than [glib.PRIORITY_DEFAULT]
 // that was scheduled after unsubscription, also indicates that all resources
 // of this subscription are released.
 func (x *DBusConnection) SignalUnsubscribe(SubscriptionIdVar uint) {
@@ -1644,9 +1655,9 @@ func (x *DBusConnection) SignalUnsubscribe(SubscriptionIdVar uint) {
 
 var xDBusConnectionStartMessageProcessing func(uintptr)
 
-// If @connection was created with
-// %G_DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING, this method
-// starts processing messages. Does nothing on if @connection wasn't
+// If connection was created with
+// [GDbusConnectionFlagsDelayMessageProcessingValue], this method
+// starts processing messages. Does nothing on if connection wasn't
 // created with this flag or if the method has already been called.
 func (x *DBusConnection) StartMessageProcessing() {
 
@@ -1657,10 +1668,10 @@ func (x *DBusConnection) StartMessageProcessing() {
 var xDBusConnectionUnexportActionGroup func(uintptr, uint)
 
 // Reverses the effect of a previous call to
-// [method@Gio.DBusConnection.export_action_group].
+// [DBusConnection.ExportActionGroup].
 //
 // It is an error to call this function with an ID that wasn’t returned from
-// [method@Gio.DBusConnection.export_action_group] or to call it with the same
+// [DBusConnection.ExportActionGroup] or to call it with the same
 // ID more than once.
 func (x *DBusConnection) UnexportActionGroup(ExportIdVar uint) {
 
@@ -1671,10 +1682,10 @@ func (x *DBusConnection) UnexportActionGroup(ExportIdVar uint) {
 var xDBusConnectionUnexportMenuModel func(uintptr, uint)
 
 // Reverses the effect of a previous call to
-// g_dbus_connection_export_menu_model().
+// [DBusConnection.ExportMenuModel].
 //
 // It is an error to call this function with an ID that wasn't returned
-// from g_dbus_connection_export_menu_model() or to call it with the
+// from [DBusConnection.ExportMenuModel] or to call it with the
 // same ID more than once.
 func (x *DBusConnection) UnexportMenuModel(ExportIdVar uint) {
 
@@ -1734,8 +1745,8 @@ func (x *DBusConnection) GetPropertyClosed() bool {
 // calling `raise(SIGTERM)`) if the connection is closed by the
 // remote peer.
 //
-// Note that #GDBusConnection objects returned by g_bus_get_finish()
-// and g_bus_get_sync() will (usually) have this property set to %TRUE.
+// Note that [DBusConnection] objects returned by [BusGetFinish]
+// and [BusGetSync] will (usually) have this property set to true.
 func (x *DBusConnection) SetPropertyExitOnClose(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1748,8 +1759,8 @@ func (x *DBusConnection) SetPropertyExitOnClose(value bool) {
 // calling `raise(SIGTERM)`) if the connection is closed by the
 // remote peer.
 //
-// Note that #GDBusConnection objects returned by g_bus_get_finish()
-// and g_bus_get_sync() will (usually) have this property set to %TRUE.
+// Note that [DBusConnection] objects returned by [BusGetFinish]
+// and [BusGetSync] will (usually) have this property set to true.
 func (x *DBusConnection) GetPropertyExitOnClose() bool {
 	var v gobject.Value
 	x.GetProperty("exit-on-close", &v)
@@ -1760,25 +1771,28 @@ func (x *DBusConnection) GetPropertyExitOnClose() bool {
 // The GUID of the peer performing the role of server when
 // authenticating.
 //
-// If you are constructing a #GDBusConnection and pass
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER in the
-// #GDBusConnection:flags property then you **must** also set this
+// If you are constructing a [DBusConnection] and pass
+// [GDbusConnectionFlagsAuthenticationServerValue] in the
+// GDBusConnection:flags property then you **must** also set this
 // property to a valid guid.
 //
-// If you are constructing a #GDBusConnection and pass
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT in the
-// #GDBusConnection:flags property you will be able to read the GUID
+// If you are constructing a [DBusConnection] and pass
+// [GDbusConnectionFlagsAuthenticationClientValue] in the
+// GDBusConnection:flags property you will be able to read the GUID
 // of the other peer here after the connection has been successfully
 // initialized.
 //
 // Note that the
-// [D-Bus specification](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses)
+// [D-Bus specification]
 // uses the term ‘UUID’ to refer to this, whereas GLib consistently uses the
 // term ‘GUID’ for historical reasons.
 //
-// Despite its name, the format of #GDBusConnection:guid does not follow
-// [RFC 4122](https://datatracker.ietf.org/doc/html/rfc4122) or the Microsoft
+// Despite its name, the format of [DBusConnection.GetPropertyGuid] does not follow
+// [RFC 4122] or the Microsoft
 // GUID format.
+//
+// [D-Bus specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
+// [RFC 4122]: https://datatracker.ietf.org/doc/html/rfc4122
 func (x *DBusConnection) SetPropertyGuid(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1790,25 +1804,28 @@ func (x *DBusConnection) SetPropertyGuid(value string) {
 // The GUID of the peer performing the role of server when
 // authenticating.
 //
-// If you are constructing a #GDBusConnection and pass
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER in the
-// #GDBusConnection:flags property then you **must** also set this
+// If you are constructing a [DBusConnection] and pass
+// [GDbusConnectionFlagsAuthenticationServerValue] in the
+// GDBusConnection:flags property then you **must** also set this
 // property to a valid guid.
 //
-// If you are constructing a #GDBusConnection and pass
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT in the
-// #GDBusConnection:flags property you will be able to read the GUID
+// If you are constructing a [DBusConnection] and pass
+// [GDbusConnectionFlagsAuthenticationClientValue] in the
+// GDBusConnection:flags property you will be able to read the GUID
 // of the other peer here after the connection has been successfully
 // initialized.
 //
 // Note that the
-// [D-Bus specification](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses)
+// [D-Bus specification]
 // uses the term ‘UUID’ to refer to this, whereas GLib consistently uses the
 // term ‘GUID’ for historical reasons.
 //
-// Despite its name, the format of #GDBusConnection:guid does not follow
-// [RFC 4122](https://datatracker.ietf.org/doc/html/rfc4122) or the Microsoft
+// Despite its name, the format of [DBusConnection.GetPropertyGuid] does not follow
+// [RFC 4122] or the Microsoft
 // GUID format.
+//
+// [D-Bus specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
+// [RFC 4122]: https://datatracker.ietf.org/doc/html/rfc4122
 func (x *DBusConnection) GetPropertyGuid() string {
 	var v gobject.Value
 	x.GetProperty("guid", &v)
@@ -1816,7 +1833,7 @@ func (x *DBusConnection) GetPropertyGuid() string {
 }
 
 // GetPropertyUniqueName gets the "unique-name" property.
-// The unique name as assigned by the message bus or %NULL if the
+// The unique name as assigned by the message bus or nil if the
 // connection is not open or not a message bus connection.
 func (x *DBusConnection) GetPropertyUniqueName() string {
 	var v gobject.Value
@@ -1828,76 +1845,98 @@ func (x *DBusConnection) GetPropertyUniqueName() string {
 //
 // # The cause of this event can be
 //
-//   - If g_dbus_connection_close() is called. In this case
-//     @remote_peer_vanished is set to %FALSE and @error is %NULL.
+//   - If [DBusConnection.Close] is called. In this case
+//     remote_peer_vanished is set to false and error is nil.
 //
 //   - If the remote peer closes the connection. In this case
-//     @remote_peer_vanished is set to %TRUE and @error is set.
+//     remote_peer_vanished is set to true and error is set.
 //
 //   - If the remote peer sends invalid or malformed data. In this
-//     case @remote_peer_vanished is set to %FALSE and @error is set.
+//     case remote_peer_vanished is set to false and error is set.
 //
 // Upon receiving this signal, you should give up your reference to
-// @connection. You are guaranteed that this signal is emitted only
+// connection. You are guaranteed that this signal is emitted only
 // once.
+//
+// Deprecated: use ConnectClosedFunc, which also accepts method values and closures.
 func (x *DBusConnection) ConnectClosed(cb *func(DBusConnection, bool, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, RemotePeerVanishedVarp bool, ErrorVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DBusConnection{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, RemotePeerVanishedVarp, ErrorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "closed", cbRefPtr, cbPtr)
+}
+
+var xDBusConnectionClosedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, RemotePeerVanishedVarp bool, ErrorVarp uintptr) {
+func xDBusConnectionClosedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, RemotePeerVanishedVarp bool, ErrorVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusConnection, bool, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusConnection{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, RemotePeerVanishedVarp, ErrorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClosedFunc connects cb to the "closed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusConnection) ConnectClosedFunc(cb func(DBusConnection, bool, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "closed", &xDBusConnectionClosedTrampoline, xDBusConnectionClosedNewTrampoline, cb)
 }
 
 // Starts asynchronous initialization of the object implementing the
 // interface. This must be done before any real use of the object after
-// initial construction. If the object also implements #GInitable you can
-// optionally call g_initable_init() instead.
+// initial construction. If the object also implements [Initable] you can
+// optionally call [Initable.Init] instead.
 //
 // This method is intended for language bindings. If writing in C,
 // g_async_initable_new_async() should typically be used instead.
 //
-// When the initialization is finished, @callback will be called. You can
-// then call g_async_initable_init_finish() to get the result of the
+// When the initialization is finished, callback will be called. You can
+// then call [AsyncInitable.InitFinish] to get the result of the
 // initialization.
 //
-// Implementations may also support cancellation. If @cancellable is not
-// %NULL, then initialization can be cancelled by triggering the cancellable
+// Implementations may also support cancellation. If cancellable is not
+// nil, then initialization can be cancelled by triggering the cancellable
 // object from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL, and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil, and
 // the object doesn't support cancellable initialization, the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
-// As with #GInitable, if the object is not initialized, or initialization
+// As with [Initable], if the object is not initialized, or initialization
 // returns with an error, then all operations on the object except
-// g_object_ref() and g_object_unref() are considered to be invalid, and
+// [gobject.Object.Ref] and [gobject.Object.Unref] are considered to be invalid, and
 // have undefined behaviour. They will often fail with g_critical() or
 // g_warning(), but this must not be relied on.
 //
-// Callers should not assume that a class which implements #GAsyncInitable can
-// be initialized multiple times; for more information, see g_initable_init().
+// Callers should not assume that a class which implements [AsyncInitable] can
+// be initialized multiple times; for more information, see [Initable.Init].
 // If a class explicitly supports being initialized multiple times,
 // implementation requires yielding all subsequent calls to init_async() on the
 // results of the first call.
 //
-// For classes that also support the #GInitable interface, the default
-// implementation of this method will run the g_initable_init() function
+// For classes that also support the [Initable] interface, the default
+// implementation of this method will run the [Initable.Init] function
 // in a thread, so if you want to support asynchronous initialization via
-// threads, just implement the #GAsyncInitable interface without overriding
+// threads, just implement the [AsyncInitable] interface without overriding
 // any interface methods.
 func (x *DBusConnection) InitAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1906,20 +1945,20 @@ func (x *DBusConnection) InitAsync(IoPriorityVar int, CancellableVar *Cancellabl
 }
 
 // Finishes asynchronous initialization and returns the result.
-// See g_async_initable_init_async().
+// See [AsyncInitable.InitAsync].
 func (x *DBusConnection) InitFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAsyncInitableInitFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Finishes the async construction for the various g_async_initable_new
-// calls, returning the created object or %NULL on error.
+// calls, returning the created object or nil on error.
 func (x *DBusConnection) NewFinish(ResVar AsyncResult) (*gobject.Object, error) {
 	var cls *gobject.Object
 	var cerr *glib.Error
@@ -1927,12 +1966,15 @@ func (x *DBusConnection) NewFinish(ResVar AsyncResult) (*gobject.Object, error)
 	cret := XGAsyncInitableNewFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gobject.Object{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1944,25 +1986,25 @@ func (x *DBusConnection) NewFinish(ResVar AsyncResult) (*gobject.Object, error)
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -1972,16 +2014,16 @@ func (x *DBusConnection) NewFinish(ResVar AsyncResult) (*gobject.Object, error)
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *DBusConnection) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1990,24 +2032,24 @@ func (x *DBusConnection) Init(CancellableVar *Cancellable) (bool, error) {
 var xDBusConnectionNew func(uintptr, uintptr, DBusConnectionFlags, uintptr, uintptr, uintptr, uintptr)
 
 // Asynchronously sets up a D-Bus connection for exchanging D-Bus messages
-// with the end represented by @stream.
+// with the end represented by stream.
 //
-// If @stream is a #GSocketConnection, then the corresponding #GSocket
+// If stream is a [SocketConnection], then the corresponding [Socket]
 // will be put into non-blocking mode.
 //
-// The D-Bus connection will interact with @stream from a worker thread.
-// As a result, the caller should not interact with @stream after this
-// method has been called, except by calling g_object_unref() on it.
+// The D-Bus connection will interact with stream from a worker thread.
+// As a result, the caller should not interact with stream after this
+// method has been called, except by calling [gobject.Object.Unref] on it.
 //
-// If @observer is not %NULL it may be used to control the
+// If observer is not nil it may be used to control the
 // authentication process.
 //
-// When the operation is finished, @callback will be invoked. You can
-// then call g_dbus_connection_new_finish() to get the result of the
+// When the operation is finished, callback will be invoked. You can
+// then call [NewDBusConnectionFinish] to get the result of the
 // operation.
 //
 // This is an asynchronous failable constructor. See
-// g_dbus_connection_new_sync() for the synchronous
+// [NewDBusConnectionSync] for the synchronous
 // version.
 func DBusConnectionNew(StreamVar *IOStream, GuidVar *string, FlagsVar DBusConnectionFlags, ObserverVar *DBusAuthObserver, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -2018,11 +2060,12 @@ func DBusConnectionNew(StreamVar *IOStream, GuidVar *string, FlagsVar DBusConnec
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -2036,27 +2079,29 @@ func DBusConnectionNew(StreamVar *IOStream, GuidVar *string, FlagsVar DBusConnec
 var xDBusConnectionNewForAddress func(string, DBusConnectionFlags, uintptr, uintptr, uintptr, uintptr)
 
 // Asynchronously connects and sets up a D-Bus client connection for
-// exchanging D-Bus messages with an endpoint specified by @address
+// exchanging D-Bus messages with an endpoint specified by address
 // which must be in the
-// [D-Bus address format](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses).
+// [D-Bus address format].
 //
 // This constructor can only be used to initiate client-side
-// connections - use g_dbus_connection_new() if you need to act as the
-// server. In particular, @flags cannot contain the
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER,
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS or
-// %G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER flags.
-//
-// When the operation is finished, @callback will be invoked. You can
-// then call g_dbus_connection_new_for_address_finish() to get the result of
+// connections - use [DBusConnectionNew] if you need to act as the
+// server. In particular, flags cannot contain the
+// [GDbusConnectionFlagsAuthenticationServerValue],
+// [GDbusConnectionFlagsAuthenticationAllowAnonymousValue] or
+// [GDbusConnectionFlagsAuthenticationRequireSameUserValue] flags.
+//
+// When the operation is finished, callback will be invoked. You can
+// then call [NewDBusConnectionForAddressFinish] to get the result of
 // the operation.
 //
-// If @observer is not %NULL it may be used to control the
+// If observer is not nil it may be used to control the
 // authentication process.
 //
 // This is an asynchronous failable constructor. See
-// g_dbus_connection_new_for_address_sync() for the synchronous
+// [NewDBusConnectionForAddressSync] for the synchronous
 // version.
+//
+// [D-Bus address format]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func DBusConnectionNewForAddress(AddressVar string, FlagsVar DBusConnectionFlags, ObserverVar *DBusAuthObserver, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -2066,11 +2111,12 @@ func DBusConnectionNewForAddress(AddressVar string, FlagsVar DBusConnectionFlags
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -2083,68 +2129,67 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBusGet, libs, "g_bus_get")
-	core.PuregoSafeRegister(&xBusGetFinish, libs, "g_bus_get_finish")
-	core.PuregoSafeRegister(&xBusGetSync, libs, "g_bus_get_sync")
+	core.PuregoRegisterSince(&xBusGet, libs, "g_bus_get", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusGetFinish, libs, "g_bus_get_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusGetSync, libs, "g_bus_get_sync", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusConnectionGLibType, libs, "g_dbus_connection_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusConnectionFinish, libs, "g_dbus_connection_new_finish")
-	core.PuregoSafeRegister(&xNewDBusConnectionForAddressFinish, libs, "g_dbus_connection_new_for_address_finish")
-	core.PuregoSafeRegister(&xNewDBusConnectionForAddressSync, libs, "g_dbus_connection_new_for_address_sync")
-	core.PuregoSafeRegister(&xNewDBusConnectionSync, libs, "g_dbus_connection_new_sync")
-
-	core.PuregoSafeRegister(&xDBusConnectionAddFilter, libs, "g_dbus_connection_add_filter")
-	core.PuregoSafeRegister(&xDBusConnectionCall, libs, "g_dbus_connection_call")
-	core.PuregoSafeRegister(&xDBusConnectionCallFinish, libs, "g_dbus_connection_call_finish")
-	core.PuregoSafeRegister(&xDBusConnectionCallSync, libs, "g_dbus_connection_call_sync")
-	core.PuregoSafeRegister(&xDBusConnectionCallWithUnixFdList, libs, "g_dbus_connection_call_with_unix_fd_list")
-	core.PuregoSafeRegister(&xDBusConnectionCallWithUnixFdListFinish, libs, "g_dbus_connection_call_with_unix_fd_list_finish")
-	core.PuregoSafeRegister(&xDBusConnectionCallWithUnixFdListSync, libs, "g_dbus_connection_call_with_unix_fd_list_sync")
-	core.PuregoSafeRegister(&xDBusConnectionClose, libs, "g_dbus_connection_close")
-	core.PuregoSafeRegister(&xDBusConnectionCloseFinish, libs, "g_dbus_connection_close_finish")
-	core.PuregoSafeRegister(&xDBusConnectionCloseSync, libs, "g_dbus_connection_close_sync")
-	core.PuregoSafeRegister(&xDBusConnectionEmitSignal, libs, "g_dbus_connection_emit_signal")
-	core.PuregoSafeRegister(&xDBusConnectionExportActionGroup, libs, "g_dbus_connection_export_action_group")
-	core.PuregoSafeRegister(&xDBusConnectionExportMenuModel, libs, "g_dbus_connection_export_menu_model")
-	core.PuregoSafeRegister(&xDBusConnectionFlush, libs, "g_dbus_connection_flush")
-	core.PuregoSafeRegister(&xDBusConnectionFlushFinish, libs, "g_dbus_connection_flush_finish")
-	core.PuregoSafeRegister(&xDBusConnectionFlushSync, libs, "g_dbus_connection_flush_sync")
-	core.PuregoSafeRegister(&xDBusConnectionGetCapabilities, libs, "g_dbus_connection_get_capabilities")
-	core.PuregoSafeRegister(&xDBusConnectionGetExitOnClose, libs, "g_dbus_connection_get_exit_on_close")
-	core.PuregoSafeRegister(&xDBusConnectionGetFlags, libs, "g_dbus_connection_get_flags")
-	core.PuregoSafeRegister(&xDBusConnectionGetGuid, libs, "g_dbus_connection_get_guid")
-	core.PuregoSafeRegister(&xDBusConnectionGetLastSerial, libs, "g_dbus_connection_get_last_serial")
-	core.PuregoSafeRegister(&xDBusConnectionGetPeerCredentials, libs, "g_dbus_connection_get_peer_credentials")
-	core.PuregoSafeRegister(&xDBusConnectionGetStream, libs, "g_dbus_connection_get_stream")
-	core.PuregoSafeRegister(&xDBusConnectionGetUniqueName, libs, "g_dbus_connection_get_unique_name")
-	core.PuregoSafeRegister(&xDBusConnectionIsClosed, libs, "g_dbus_connection_is_closed")
-	core.PuregoSafeRegister(&xDBusConnectionRegisterObject, libs, "g_dbus_connection_register_object")
-	core.PuregoSafeRegister(&xDBusConnectionRegisterObjectWithClosures, libs, "g_dbus_connection_register_object_with_closures")
-	core.PuregoSafeRegister(&xDBusConnectionRegisterObjectWithClosures2, libs, "g_dbus_connection_register_object_with_closures2")
-	core.PuregoSafeRegister(&xDBusConnectionRegisterSubtree, libs, "g_dbus_connection_register_subtree")
-	core.PuregoSafeRegister(&xDBusConnectionRemoveFilter, libs, "g_dbus_connection_remove_filter")
-	core.PuregoSafeRegister(&xDBusConnectionSendMessage, libs, "g_dbus_connection_send_message")
-	core.PuregoSafeRegister(&xDBusConnectionSendMessageWithReply, libs, "g_dbus_connection_send_message_with_reply")
-	core.PuregoSafeRegister(&xDBusConnectionSendMessageWithReplyFinish, libs, "g_dbus_connection_send_message_with_reply_finish")
-	core.PuregoSafeRegister(&xDBusConnectionSendMessageWithReplySync, libs, "g_dbus_connection_send_message_with_reply_sync")
-	core.PuregoSafeRegister(&xDBusConnectionSetExitOnClose, libs, "g_dbus_connection_set_exit_on_close")
-	core.PuregoSafeRegister(&xDBusConnectionSignalSubscribe, libs, "g_dbus_connection_signal_subscribe")
-	core.PuregoSafeRegister(&xDBusConnectionSignalUnsubscribe, libs, "g_dbus_connection_signal_unsubscribe")
-	core.PuregoSafeRegister(&xDBusConnectionStartMessageProcessing, libs, "g_dbus_connection_start_message_processing")
-	core.PuregoSafeRegister(&xDBusConnectionUnexportActionGroup, libs, "g_dbus_connection_unexport_action_group")
-	core.PuregoSafeRegister(&xDBusConnectionUnexportMenuModel, libs, "g_dbus_connection_unexport_menu_model")
-	core.PuregoSafeRegister(&xDBusConnectionUnregisterObject, libs, "g_dbus_connection_unregister_object")
-	core.PuregoSafeRegister(&xDBusConnectionUnregisterSubtree, libs, "g_dbus_connection_unregister_subtree")
-
-	core.PuregoSafeRegister(&xDBusConnectionNew, libs, "g_dbus_connection_new")
-	core.PuregoSafeRegister(&xDBusConnectionNewForAddress, libs, "g_dbus_connection_new_for_address")
+	core.PuregoRegisterSince(&xNewDBusConnectionFinish, libs, "g_dbus_connection_new_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusConnectionForAddressFinish, libs, "g_dbus_connection_new_for_address_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusConnectionForAddressSync, libs, "g_dbus_connection_new_for_address_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusConnectionSync, libs, "g_dbus_connection_new_sync", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusConnectionAddFilter, libs, "g_dbus_connection_add_filter", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCall, libs, "g_dbus_connection_call", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCallFinish, libs, "g_dbus_connection_call_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCallSync, libs, "g_dbus_connection_call_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCallWithUnixFdList, libs, "g_dbus_connection_call_with_unix_fd_list", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusConnectionCallWithUnixFdListFinish, libs, "g_dbus_connection_call_with_unix_fd_list_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusConnectionCallWithUnixFdListSync, libs, "g_dbus_connection_call_with_unix_fd_list_sync", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusConnectionClose, libs, "g_dbus_connection_close", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCloseFinish, libs, "g_dbus_connection_close_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionCloseSync, libs, "g_dbus_connection_close_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionEmitSignal, libs, "g_dbus_connection_emit_signal", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionExportActionGroup, libs, "g_dbus_connection_export_action_group", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusConnectionExportMenuModel, libs, "g_dbus_connection_export_menu_model", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusConnectionFlush, libs, "g_dbus_connection_flush", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionFlushFinish, libs, "g_dbus_connection_flush_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionFlushSync, libs, "g_dbus_connection_flush_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetCapabilities, libs, "g_dbus_connection_get_capabilities", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetExitOnClose, libs, "g_dbus_connection_get_exit_on_close", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetFlags, libs, "g_dbus_connection_get_flags", "GIO", "2.60")
+	core.PuregoRegisterSince(&xDBusConnectionGetGuid, libs, "g_dbus_connection_get_guid", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetLastSerial, libs, "g_dbus_connection_get_last_serial", "GIO", "2.34")
+	core.PuregoRegisterSince(&xDBusConnectionGetPeerCredentials, libs, "g_dbus_connection_get_peer_credentials", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetStream, libs, "g_dbus_connection_get_stream", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionGetUniqueName, libs, "g_dbus_connection_get_unique_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionIsClosed, libs, "g_dbus_connection_is_closed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionRegisterObject, libs, "g_dbus_connection_register_object", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionRegisterObjectWithClosures2, libs, "g_dbus_connection_register_object_with_closures2", "GIO", "2.84")
+	core.PuregoRegisterSince(&xDBusConnectionRegisterSubtree, libs, "g_dbus_connection_register_subtree", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionRemoveFilter, libs, "g_dbus_connection_remove_filter", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSendMessage, libs, "g_dbus_connection_send_message", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSendMessageWithReply, libs, "g_dbus_connection_send_message_with_reply", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSendMessageWithReplyFinish, libs, "g_dbus_connection_send_message_with_reply_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSendMessageWithReplySync, libs, "g_dbus_connection_send_message_with_reply_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSetExitOnClose, libs, "g_dbus_connection_set_exit_on_close", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSignalSubscribe, libs, "g_dbus_connection_signal_subscribe", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionSignalUnsubscribe, libs, "g_dbus_connection_signal_unsubscribe", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionStartMessageProcessing, libs, "g_dbus_connection_start_message_processing", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionUnexportActionGroup, libs, "g_dbus_connection_unexport_action_group", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusConnectionUnexportMenuModel, libs, "g_dbus_connection_unexport_menu_model", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusConnectionUnregisterObject, libs, "g_dbus_connection_unregister_object", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionUnregisterSubtree, libs, "g_dbus_connection_unregister_subtree", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusConnectionNew, libs, "g_dbus_connection_new", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusConnectionNewForAddress, libs, "g_dbus_connection_new_for_address", "GIO", "2.26")
 
 }
@@ -0,0 +1,51 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xDBusConnectionRegisterObjectWithClosures func(uintptr, string, *DBusInterfaceInfo, *gobject.Closure, *gobject.Closure, *gobject.Closure, **glib.Error) uint
+
+// Version of [DBusConnection.RegisterObject] using closures instead of a
+// [DBusInterfaceVTable] for easier binding in other languages.
+//
+// Note that the reference counting semantics of the function wrapped by
+// method_call_closure are the same as those of
+// [DBusInterfaceMethodCallFunc]: ownership of a reference to the
+// [DBusMethodInvocation] is transferred to the function.
+//
+// Deprecated: since 2.84. Deprecated in favour of
+//
+//	[DBusConnection.RegisterObjectWithClosures2], which has more
+//	binding-friendly reference counting semantics.
+func (x *DBusConnection) RegisterObjectWithClosures(ObjectPathVar string, InterfaceInfoVar *DBusInterfaceInfo, MethodCallClosureVar *gobject.Closure, GetPropertyClosureVar *gobject.Closure, SetPropertyClosureVar *gobject.Closure) (uint, error) {
+	var cerr *glib.Error
+
+	cret := xDBusConnectionRegisterObjectWithClosures(x.GoPointer(), ObjectPathVar, InterfaceInfoVar, MethodCallClosureVar, GetPropertyClosureVar, SetPropertyClosureVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xDBusConnectionRegisterObjectWithClosures, libs, "g_dbus_connection_register_object_with_closures", "GIO", "2.46")
+
+}
@@ -5,12 +5,11 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
 
-// Struct used in [func@Gio.DBusError.register_error_domain].
+// Struct used in [DBusErrorRegisterErrorDomain].
 type DBusErrorEntry struct {
 	_ structs.HostLayout
 
@@ -23,161 +22,281 @@ func (x *DBusErrorEntry) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xDbusErrorEncodeGerror func(*glib.Error) string
+var xDBusErrorEncodeGerror func(*glib.Error) string
 
-// Creates a D-Bus error name to use for @error.
+// Creates a D-Bus error name to use for error.
 //
-// If @error matches a registered error (see
-// [func@Gio.DBusError.register_error]), the corresponding D-Bus error name
+// If error matches a registered error (see
+// [DBusErrorRegisterError]), the corresponding D-Bus error name
 // will be returned.
 //
 // Otherwise the a name of the form
 // `org.gtk.GDBus.UnmappedGError.Quark._ESCAPED_QUARK_NAME.Code_ERROR_CODE`
 // will be used. This allows other GDBus applications to map the error
-// on the wire back to a [type@GLib.Error] using
-// [func@Gio.DBusError.new_for_dbus_error].
+// on the wire back to a [glib.Error] using
+// [DBusErrorNewForDbusError].
 //
 // This function is typically only used in object mappings to put a
-// [type@GLib.Error] on the wire. Regular applications should not use it.
-func DbusErrorEncodeGerror(ErrorVar *glib.Error) string {
+// [glib.Error] on the wire. Regular applications should not use it.
+func DBusErrorEncodeGerror(ErrorVar *glib.Error) string {
 
-	cret := xDbusErrorEncodeGerror(ErrorVar)
+	cret := xDBusErrorEncodeGerror(ErrorVar)
 	return cret
 }
 
-var xDbusErrorGetRemoteError func(*glib.Error) string
+// Creates a D-Bus error name to use for error.
+//
+// If error matches a registered error (see
+// [DBusErrorRegisterError]), the corresponding D-Bus error name
+// will be returned.
+//
+// Otherwise the a name of the form
+// `org.gtk.GDBus.UnmappedGError.Quark._ESCAPED_QUARK_NAME.Code_ERROR_CODE`
+// will be used. This allows other GDBus applications to map the error
+// on the wire back to a [glib.Error] using
+// [DBusErrorNewForDbusError].
+//
+// This function is typically only used in object mappings to put a
+// [glib.Error] on the wire. Regular applications should not use it.
+//
+// Deprecated: use DBusErrorEncodeGerror, this name of g_dbus_error_encode_gerror is kept for compatibility.
+func DbusErrorEncodeGerror(ErrorVar *glib.Error) string {
+	return DBusErrorEncodeGerror(ErrorVar)
+}
+
+var xDBusErrorGetRemoteError func(*glib.Error) string
 
-// Gets the D-Bus error name used for @error, if any.
+// Gets the D-Bus error name used for error, if any.
 //
 // This function is guaranteed to return a D-Bus error name for all
-// [type@GLib.Error]s returned from functions handling remote method calls
-// (for example, [method@Gio.DBusConnection.call_finish]) unless
-// [func@Gio.DBusError.strip_remote_error] has already been used on @error.
-func DbusErrorGetRemoteError(ErrorVar *glib.Error) string {
+// [glib.Error]s returned from functions handling remote method calls
+// (for example, [DBusConnection.CallFinish]) unless
+// [DBusErrorStripRemoteError] has already been used on error.
+func DBusErrorGetRemoteError(ErrorVar *glib.Error) string {
 
-	cret := xDbusErrorGetRemoteError(ErrorVar)
+	cret := xDBusErrorGetRemoteError(ErrorVar)
 	return cret
 }
 
-var xDbusErrorIsRemoteError func(*glib.Error) bool
+// Gets the D-Bus error name used for error, if any.
+//
+// This function is guaranteed to return a D-Bus error name for all
+// [glib.Error]s returned from functions handling remote method calls
+// (for example, [DBusConnection.CallFinish]) unless
+// [DBusErrorStripRemoteError] has already been used on error.
+//
+// Deprecated: use DBusErrorGetRemoteError, this name of g_dbus_error_get_remote_error is kept for compatibility.
+func DbusErrorGetRemoteError(ErrorVar *glib.Error) string {
+	return DBusErrorGetRemoteError(ErrorVar)
+}
+
+var xDBusErrorIsRemoteError func(*glib.Error) bool
 
-// Checks if @error represents an error received via D-Bus from a remote peer.
+// Checks if error represents an error received via D-Bus from a remote peer.
 //
-// If so, use [func@Gio.DBusError.get_remote_error] to get the name of the error.
-func DbusErrorIsRemoteError(ErrorVar *glib.Error) bool {
+// If so, use [DBusErrorGetRemoteError] to get the name of the error.
+func DBusErrorIsRemoteError(ErrorVar *glib.Error) bool {
 
-	cret := xDbusErrorIsRemoteError(ErrorVar)
+	cret := xDBusErrorIsRemoteError(ErrorVar)
 	return cret
 }
 
-var xDbusErrorNewForDbusError func(string, string) *glib.Error
+// Checks if error represents an error received via D-Bus from a remote peer.
+//
+// If so, use [DBusErrorGetRemoteError] to get the name of the error.
+//
+// Deprecated: use DBusErrorIsRemoteError, this name of g_dbus_error_is_remote_error is kept for compatibility.
+func DbusErrorIsRemoteError(ErrorVar *glib.Error) bool {
+	return DBusErrorIsRemoteError(ErrorVar)
+}
+
+var xDBusErrorNewForDbusError func(string, string) *glib.Error
 
-// Creates a [type@GLib.Error] based on the contents of @dbus_error_name and
-// @dbus_error_message.
+// Creates a [glib.Error] based on the contents of dbus_error_name and
+// dbus_error_message.
 //
-// Errors registered with [func@Gio.DBusError.register_error] will be looked
-// up using @dbus_error_name and if a match is found, the error domain
-// and code is used. Applications can use [func@Gio.DBusError.get_remote_error]
-// to recover @dbus_error_name.
+// Errors registered with [DBusErrorRegisterError] will be looked
+// up using dbus_error_name and if a match is found, the error domain
+// and code is used. Applications can use [DBusErrorGetRemoteError]
+// to recover dbus_error_name.
 //
 // If a match against a registered error is not found and the D-Bus
-// error name is in a form as returned by [func@Gio.DBusError.encode_gerror]
+// error name is in a form as returned by [DBusErrorEncodeGerror]
 // the error domain and code encoded in the name is used to
-// create the [type@GLib.Error]. Also, @dbus_error_name is added to the error
+// create the [glib.Error]. Also, dbus_error_name is added to the error
 // message such that it can be recovered with
-// [func@Gio.DBusError.get_remote_error].
+// [DBusErrorGetRemoteError].
 //
-// Otherwise, a [type@GLib.Error] with the error code
-// [error@Gio.IOErrorEnum.DBUS_ERROR]
-// in the [error@Gio.IOErrorEnum] error domain is returned. Also, @dbus_error_name is
+// Otherwise, a [glib.Error] with the error code
+// IOErrorEnum.DBUS_ERROR
+// in the [IOErrorEnum] error domain is returned. Also, dbus_error_name is
 // added to the error message such that it can be recovered with
-// [func@Gio.DBusError.get_remote_error].
+// [DBusErrorGetRemoteError].
 //
-// In all three cases, @dbus_error_name can always be recovered from the
-// returned [type@GLib.Error] using the [func@Gio.DBusError.get_remote_error]
-// function (unless [func@Gio.DBusError.strip_remote_error] hasn’t been used on
+// In all three cases, dbus_error_name can always be recovered from the
+// returned [glib.Error] using the [DBusErrorGetRemoteError]
+// function (unless [DBusErrorStripRemoteError] hasn’t been used on
 // the returned error).
 //
 // This function is typically only used in object mappings to prepare
-// [type@GLib.Error] instances for applications. Regular applications should not
+// [glib.Error] instances for applications. Regular applications should not
 // use it.
-func DbusErrorNewForDbusError(DbusErrorNameVar string, DbusErrorMessageVar string) *glib.Error {
+func DBusErrorNewForDbusError(DbusErrorNameVar string, DbusErrorMessageVar string) *glib.Error {
 
-	cret := xDbusErrorNewForDbusError(DbusErrorNameVar, DbusErrorMessageVar)
+	cret := xDBusErrorNewForDbusError(DbusErrorNameVar, DbusErrorMessageVar)
 	return cret
 }
 
-var xDbusErrorRegisterError func(glib.Quark, int, string) bool
+// Creates a [glib.Error] based on the contents of dbus_error_name and
+// dbus_error_message.
+//
+// Errors registered with [DBusErrorRegisterError] will be looked
+// up using dbus_error_name and if a match is found, the error domain
+// and code is used. Applications can use [DBusErrorGetRemoteError]
+// to recover dbus_error_name.
+//
+// If a match against a registered error is not found and the D-Bus
+// error name is in a form as returned by [DBusErrorEncodeGerror]
+// the error domain and code encoded in the name is used to
+// create the [glib.Error]. Also, dbus_error_name is added to the error
+// message such that it can be recovered with
+// [DBusErrorGetRemoteError].
+//
+// Otherwise, a [glib.Error] with the error code
+// IOErrorEnum.DBUS_ERROR
+// in the [IOErrorEnum] error domain is returned. Also, dbus_error_name is
+// added to the error message such that it can be recovered with
+// [DBusErrorGetRemoteError].
+//
+// In all three cases, dbus_error_name can always be recovered from the
+// returned [glib.Error] using the [DBusErrorGetRemoteError]
+// function (unless [DBusErrorStripRemoteError] hasn’t been used on
+// the returned error).
+//
+// This function is typically only used in object mappings to prepare
+// [glib.Error] instances for applications. Regular applications should not
+// use it.
+//
+// Deprecated: use DBusErrorNewForDbusError, this name of g_dbus_error_new_for_dbus_error is kept for compatibility.
+func DbusErrorNewForDbusError(DbusErrorNameVar string, DbusErrorMessageVar string) *glib.Error {
+	return DBusErrorNewForDbusError(DbusErrorNameVar, DbusErrorMessageVar)
+}
+
+var xDBusErrorRegisterError func(glib.Quark, int, string) bool
 
-// Creates an association mapping between @dbus_error_name and
-// [type@GLib.Error]s specified by @error_domain and @error_code.
+// Creates an association mapping between dbus_error_name and
+// [glib.Error]s specified by error_domain and error_code.
 //
-// This is typically done in the function that returns the [type@GLib.Quark] for
+// This is typically done in the function that returns the [glib.Quark] for
 // an error domain.
-func DbusErrorRegisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
+func DBusErrorRegisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
 
-	cret := xDbusErrorRegisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
+	cret := xDBusErrorRegisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
 	return cret
 }
 
-var xDbusErrorRegisterErrorDomain func(string, uint, []DBusErrorEntry, uint)
+// Creates an association mapping between dbus_error_name and
+// [glib.Error]s specified by error_domain and error_code.
+//
+// This is typically done in the function that returns the [glib.Quark] for
+// an error domain.
+//
+// Deprecated: use DBusErrorRegisterError, this name of g_dbus_error_register_error is kept for compatibility.
+func DbusErrorRegisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
+	return DBusErrorRegisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
+}
+
+var xDBusErrorRegisterErrorDomain func(string, uint, []DBusErrorEntry, uint)
 
-// Helper function for associating a [type@GLib.Error] error domain with D-Bus
+// Helper function for associating a [glib.Error] error domain with D-Bus
 // error names.
 //
-// While @quark_volatile has a `volatile` qualifier, this is a historical
+// While quark_volatile has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
-func DbusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar string, QuarkVolatileVar uint, EntriesVar []DBusErrorEntry, NumEntriesVar uint) {
+func DBusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar string, QuarkVolatileVar uint, EntriesVar []DBusErrorEntry, NumEntriesVar uint) {
 
-	xDbusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar, QuarkVolatileVar, EntriesVar, NumEntriesVar)
+	xDBusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar, QuarkVolatileVar, EntriesVar, NumEntriesVar)
+
+}
 
+// Helper function for associating a [glib.Error] error domain with D-Bus
+// error names.
+//
+// While quark_volatile has a `volatile` qualifier, this is a historical
+// artifact and the argument passed to it should not be `volatile`.
+//
+// Deprecated: use DBusErrorRegisterErrorDomain, this name of g_dbus_error_register_error_domain is kept for compatibility.
+func DbusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar string, QuarkVolatileVar uint, EntriesVar []DBusErrorEntry, NumEntriesVar uint) {
+	DBusErrorRegisterErrorDomain(ErrorDomainQuarkNameVar, QuarkVolatileVar, EntriesVar, NumEntriesVar)
 }
 
-var xDbusErrorStripRemoteError func(*glib.Error) bool
+var xDBusErrorStripRemoteError func(*glib.Error) bool
 
 // Looks for extra information in the error message used to recover
 // the D-Bus error name and strips it if found.
 //
 // If stripped, the
-// message field in @error will correspond exactly to what was
+// message field in error will correspond exactly to what was
 // received on the wire.
 //
 // This is typically used when presenting errors to the end user.
-func DbusErrorStripRemoteError(ErrorVar *glib.Error) bool {
+func DBusErrorStripRemoteError(ErrorVar *glib.Error) bool {
 
-	cret := xDbusErrorStripRemoteError(ErrorVar)
+	cret := xDBusErrorStripRemoteError(ErrorVar)
 	return cret
 }
 
-var xDbusErrorUnregisterError func(glib.Quark, int, string) bool
+// Looks for extra information in the error message used to recover
+// the D-Bus error name and strips it if found.
+//
+// If stripped, the
+// message field in error will correspond exactly to what was
+// received on the wire.
+//
+// This is typically used when presenting errors to the end user.
+//
+// Deprecated: use DBusErrorStripRemoteError, this name of g_dbus_error_strip_remote_error is kept for compatibility.
+func DbusErrorStripRemoteError(ErrorVar *glib.Error) bool {
+	return DBusErrorStripRemoteError(ErrorVar)
+}
+
+var xDBusErrorUnregisterError func(glib.Quark, int, string) bool
 
 // Destroys an association previously set up with
-// [func@Gio.DBusError.register_error].
-func DbusErrorUnregisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
+// [DBusErrorRegisterError].
+func DBusErrorUnregisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
 
-	cret := xDbusErrorUnregisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
+	cret := xDBusErrorUnregisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
 	return cret
 }
 
+// Destroys an association previously set up with
+// [DBusErrorRegisterError].
+//
+// Deprecated: use DBusErrorUnregisterError, this name of g_dbus_error_unregister_error is kept for compatibility.
+func DbusErrorUnregisterError(ErrorDomainVar glib.Quark, ErrorCodeVar int, DbusErrorNameVar string) bool {
+	return DBusErrorUnregisterError(ErrorDomainVar, ErrorCodeVar, DbusErrorNameVar)
+}
+
 func init() {
 	core.SetPackageName("GIO", "gio-2.0")
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDbusErrorEncodeGerror, libs, "g_dbus_error_encode_gerror")
-	core.PuregoSafeRegister(&xDbusErrorGetRemoteError, libs, "g_dbus_error_get_remote_error")
-	core.PuregoSafeRegister(&xDbusErrorIsRemoteError, libs, "g_dbus_error_is_remote_error")
-	core.PuregoSafeRegister(&xDbusErrorNewForDbusError, libs, "g_dbus_error_new_for_dbus_error")
-	core.PuregoSafeRegister(&xDbusErrorRegisterError, libs, "g_dbus_error_register_error")
-	core.PuregoSafeRegister(&xDbusErrorRegisterErrorDomain, libs, "g_dbus_error_register_error_domain")
-	core.PuregoSafeRegister(&xDbusErrorStripRemoteError, libs, "g_dbus_error_strip_remote_error")
-	core.PuregoSafeRegister(&xDbusErrorUnregisterError, libs, "g_dbus_error_unregister_error")
+	core.PuregoRegisterSince(&xDBusErrorEncodeGerror, libs, "g_dbus_error_encode_gerror", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorGetRemoteError, libs, "g_dbus_error_get_remote_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorIsRemoteError, libs, "g_dbus_error_is_remote_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorNewForDbusError, libs, "g_dbus_error_new_for_dbus_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorRegisterError, libs, "g_dbus_error_register_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorRegisterErrorDomain, libs, "g_dbus_error_register_error_domain", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorStripRemoteError, libs, "g_dbus_error_strip_remote_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusErrorUnregisterError, libs, "g_dbus_error_unregister_error", "GIO", "2.26")
 
 }
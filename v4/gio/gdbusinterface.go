@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -15,7 +14,7 @@ import (
 type DBusInterfaceIface struct {
 	_ structs.HostLayout
 
-	ParentIface uintptr
+	ParentIface gobject.TypeInterface
 
 	xGetInfo uintptr
 
@@ -31,37 +30,39 @@ func (x *DBusInterfaceIface) GoPointer() uintptr {
 }
 
 // OverrideGetInfo sets the "get_info" callback function.
-// Returns a #GDBusInterfaceInfo. See g_dbus_interface_get_info().
+// Returns a [DBusInterfaceInfo]. See [DBusInterface.GetInfo].
 func (x *DBusInterfaceIface) OverrideGetInfo(cb func(DBusInterface) *DBusInterfaceInfo) {
 	if cb == nil {
 		x.xGetInfo = 0
 	} else {
-		x.xGetInfo = purego.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceInfo {
+		x.xGetInfo = core.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceInfo {
+			defer core.RecoverPanic()
 			return cb(&DBusInterfaceBase{Ptr: InterfaceVarp})
 		})
 	}
 }
 
 // GetGetInfo gets the "get_info" callback function.
-// Returns a #GDBusInterfaceInfo. See g_dbus_interface_get_info().
+// Returns a [DBusInterfaceInfo]. See [DBusInterface.GetInfo].
 func (x *DBusInterfaceIface) GetGetInfo() func(DBusInterface) *DBusInterfaceInfo {
 	if x.xGetInfo == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) *DBusInterfaceInfo
-	purego.RegisterFunc(&rawCallback, x.xGetInfo)
+	core.RegisterFunc(&rawCallback, x.xGetInfo)
 	return func(InterfaceVar DBusInterface) *DBusInterfaceInfo {
 		return rawCallback(InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideGetObject sets the "get_object" callback function.
-// Gets the enclosing #GDBusObject. See g_dbus_interface_get_object().
+// Gets the enclosing [DBusObject]. See [DBusInterface.GetObject].
 func (x *DBusInterfaceIface) OverrideGetObject(cb func(DBusInterface) *DBusObjectBase) {
 	if cb == nil {
 		x.xGetObject = 0
 	} else {
-		x.xGetObject = purego.NewCallback(func(InterfaceVarp uintptr) uintptr {
+		x.xGetObject = core.NewCallback(func(InterfaceVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DBusInterfaceBase{Ptr: InterfaceVarp})
 			if ret == nil {
 				return 0
@@ -72,13 +73,13 @@ func (x *DBusInterfaceIface) OverrideGetObject(cb func(DBusInterface) *DBusObjec
 }
 
 // GetGetObject gets the "get_object" callback function.
-// Gets the enclosing #GDBusObject. See g_dbus_interface_get_object().
+// Gets the enclosing [DBusObject]. See [DBusInterface.GetObject].
 func (x *DBusInterfaceIface) GetGetObject() func(DBusInterface) *DBusObjectBase {
 	if x.xGetObject == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetObject)
+	core.RegisterFunc(&rawCallback, x.xGetObject)
 	return func(InterfaceVar DBusInterface) *DBusObjectBase {
 		rawRet := rawCallback(InterfaceVar.GoPointer())
 		if rawRet == 0 {
@@ -91,37 +92,39 @@ func (x *DBusInterfaceIface) GetGetObject() func(DBusInterface) *DBusObjectBase
 }
 
 // OverrideSetObject sets the "set_object" callback function.
-// Sets the enclosing #GDBusObject. See g_dbus_interface_set_object().
+// Sets the enclosing [DBusObject]. See [DBusInterface.SetObject].
 func (x *DBusInterfaceIface) OverrideSetObject(cb func(DBusInterface, DBusObject)) {
 	if cb == nil {
 		x.xSetObject = 0
 	} else {
-		x.xSetObject = purego.NewCallback(func(InterfaceVarp uintptr, ObjectVarp uintptr) {
+		x.xSetObject = core.NewCallback(func(InterfaceVarp uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusInterfaceBase{Ptr: InterfaceVarp}, &DBusObjectBase{Ptr: ObjectVarp})
 		})
 	}
 }
 
 // GetSetObject gets the "set_object" callback function.
-// Sets the enclosing #GDBusObject. See g_dbus_interface_set_object().
+// Sets the enclosing [DBusObject]. See [DBusInterface.SetObject].
 func (x *DBusInterfaceIface) GetSetObject() func(DBusInterface, DBusObject) {
 	if x.xSetObject == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr, ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetObject)
+	core.RegisterFunc(&rawCallback, x.xSetObject)
 	return func(InterfaceVar DBusInterface, ObjectVar DBusObject) {
 		rawCallback(InterfaceVar.GoPointer(), ObjectVar.GoPointer())
 	}
 }
 
 // OverrideDupObject sets the "dup_object" callback function.
-// Gets a reference to the enclosing #GDBusObject. See g_dbus_interface_dup_object(). Added in 2.32.
+// Gets a reference to the enclosing [DBusObject]. See [DBusInterface.DupObject]. Added in 2.32.
 func (x *DBusInterfaceIface) OverrideDupObject(cb func(DBusInterface) *DBusObjectBase) {
 	if cb == nil {
 		x.xDupObject = 0
 	} else {
-		x.xDupObject = purego.NewCallback(func(InterfaceVarp uintptr) uintptr {
+		x.xDupObject = core.NewCallback(func(InterfaceVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DBusInterfaceBase{Ptr: InterfaceVarp})
 			if ret == nil {
 				return 0
@@ -132,13 +135,13 @@ func (x *DBusInterfaceIface) OverrideDupObject(cb func(DBusInterface) *DBusObjec
 }
 
 // GetDupObject gets the "dup_object" callback function.
-// Gets a reference to the enclosing #GDBusObject. See g_dbus_interface_dup_object(). Added in 2.32.
+// Gets a reference to the enclosing [DBusObject]. See [DBusInterface.DupObject]. Added in 2.32.
 func (x *DBusInterfaceIface) GetDupObject() func(DBusInterface) *DBusObjectBase {
 	if x.xDupObject == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDupObject)
+	core.RegisterFunc(&rawCallback, x.xDupObject)
 	return func(InterfaceVar DBusInterface) *DBusObjectBase {
 		rawRet := rawCallback(InterfaceVar.GoPointer())
 		if rawRet == 0 {
@@ -153,8 +156,8 @@ func (x *DBusInterfaceIface) GetDupObject() func(DBusInterface) *DBusObjectBase
 // Base type for D-Bus interfaces.
 //
 // The `GDBusInterface` type is the base type for D-Bus interfaces both
-// on the service side (see [class@Gio.DBusInterfaceSkeleton]) and client side
-// (see [class@Gio.DBusProxy]).
+// on the service side (see [DBusInterfaceSkeleton]) and client side
+// (see [DBusProxy]).
 type DBusInterface interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -185,7 +188,48 @@ func (x *DBusInterfaceBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// ImplementDBusInterface adds the DBusInterface interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetInfo() *DBusInterfaceInfo
+//   - GetObject() *DBusObjectBase
+//   - SetObject(DBusObject)
+//   - DupObject() *DBusObjectBase
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DBusInterfaceIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDBusInterface[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DBusInterfaceGLibType(), func(iface *DBusInterfaceIface) {
+		if _, ok := any(zero).(interface{ GetInfo() *DBusInterfaceInfo }); ok {
+			iface.OverrideGetInfo(func(self DBusInterface) *DBusInterfaceInfo {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetInfo() *DBusInterfaceInfo })
+				return impl.GetInfo()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetObject() *DBusObjectBase }); ok {
+			iface.OverrideGetObject(func(self DBusInterface) *DBusObjectBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetObject() *DBusObjectBase })
+				return impl.GetObject()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetObject(DBusObject) }); ok {
+			iface.OverrideSetObject(func(self DBusInterface, arg0 DBusObject) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetObject(DBusObject) })
+				impl.SetObject(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ DupObject() *DBusObjectBase }); ok {
+			iface.OverrideDupObject(func(self DBusInterface) *DBusObjectBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DupObject() *DBusObjectBase })
+				return impl.DupObject()
+			})
+		}
+	})
+}
+
+// Gets the [DBusObject] that interface_ belongs to, if any.
 func (x *DBusInterfaceBase) DupObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -200,18 +244,20 @@ func (x *DBusInterfaceBase) DupObject() *DBusObjectBase {
 }
 
 // Gets D-Bus introspection information for the D-Bus interface
-// implemented by @interface_.
+// implemented by interface_.
 func (x *DBusInterfaceBase) GetInfo() *DBusInterfaceInfo {
 
 	cret := XGDbusInterfaceGetInfo(x.GoPointer())
 	return cret
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// Gets the [DBusObject] that interface_ belongs to, if any.
 //
-// It is not safe to use the returned object if @interface_ or
+// It is not safe to use the returned object if interface_ or
 // the returned object is being used from other threads. See
-// g_dbus_interface_dup_object() for a thread-safe alternative.
+// [DBusInterface.DupObject] for a thread-safe alternative.
+//
+// Deprecated: use [DupObject], the version of this function that language bindings are meant to use.
 func (x *DBusInterfaceBase) GetObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -226,9 +272,9 @@ func (x *DBusInterfaceBase) GetObject() *DBusObjectBase {
 	return cls
 }
 
-// Sets the #GDBusObject for @interface_ to @object.
+// Sets the [DBusObject] for interface_ to object.
 //
-// Note that @interface_ will hold a weak reference to @object.
+// Note that interface_ will hold a weak reference to object.
 func (x *DBusInterfaceBase) SetObject(ObjectVar DBusObject) {
 
 	XGDbusInterfaceSetObject(x.GoPointer(), ObjectVar.GoPointer())
@@ -245,7 +291,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -254,9 +300,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusInterfaceGLibType, libs, "g_dbus_interface_get_type")
 
-	core.PuregoSafeRegister(&XGDbusInterfaceDupObject, libs, "g_dbus_interface_dup_object")
-	core.PuregoSafeRegister(&XGDbusInterfaceGetInfo, libs, "g_dbus_interface_get_info")
-	core.PuregoSafeRegister(&XGDbusInterfaceGetObject, libs, "g_dbus_interface_get_object")
-	core.PuregoSafeRegister(&XGDbusInterfaceSetObject, libs, "g_dbus_interface_set_object")
+	gobject.CheckClassSize("GDBusInterfaceIface", xDBusInterfaceGLibType, unsafe.Sizeof(DBusInterfaceIface{}))
+
+	core.PuregoRegisterSince(&XGDbusInterfaceDupObject, libs, "g_dbus_interface_dup_object", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGDbusInterfaceGetInfo, libs, "g_dbus_interface_get_info", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusInterfaceGetObject, libs, "g_dbus_interface_get_object", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusInterfaceSetObject, libs, "g_dbus_interface_set_object", "GIO", "2.30")
 
 }
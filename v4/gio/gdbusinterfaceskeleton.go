@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusInterfaceSkeleton.
+// Class structure for [DBusInterfaceSkeleton].
 type DBusInterfaceSkeletonClass struct {
 	_ structs.HostLayout
 
@@ -38,125 +37,130 @@ func (x *DBusInterfaceSkeletonClass) GoPointer() uintptr {
 }
 
 // OverrideGetInfo sets the "get_info" callback function.
-// Returns a #GDBusInterfaceInfo. See g_dbus_interface_skeleton_get_info() for details.
+// Returns a [DBusInterfaceInfo]. See [DBusInterfaceSkeleton.GetInfo] for details.
 func (x *DBusInterfaceSkeletonClass) OverrideGetInfo(cb func(*DBusInterfaceSkeleton) *DBusInterfaceInfo) {
 	if cb == nil {
 		x.xGetInfo = 0
 	} else {
-		x.xGetInfo = purego.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceInfo {
+		x.xGetInfo = core.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceInfo {
+			defer core.RecoverPanic()
 			return cb(DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp))
 		})
 	}
 }
 
 // GetGetInfo gets the "get_info" callback function.
-// Returns a #GDBusInterfaceInfo. See g_dbus_interface_skeleton_get_info() for details.
+// Returns a [DBusInterfaceInfo]. See [DBusInterfaceSkeleton.GetInfo] for details.
 func (x *DBusInterfaceSkeletonClass) GetGetInfo() func(*DBusInterfaceSkeleton) *DBusInterfaceInfo {
 	if x.xGetInfo == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) *DBusInterfaceInfo
-	purego.RegisterFunc(&rawCallback, x.xGetInfo)
+	core.RegisterFunc(&rawCallback, x.xGetInfo)
 	return func(InterfaceVar *DBusInterfaceSkeleton) *DBusInterfaceInfo {
 		return rawCallback(InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideGetVtable sets the "get_vtable" callback function.
-// Returns a #GDBusInterfaceVTable. See g_dbus_interface_skeleton_get_vtable() for details.
+// Returns a [DBusInterfaceVTable]. See [DBusInterfaceSkeleton.GetVtable] for details.
 func (x *DBusInterfaceSkeletonClass) OverrideGetVtable(cb func(*DBusInterfaceSkeleton) *DBusInterfaceVTable) {
 	if cb == nil {
 		x.xGetVtable = 0
 	} else {
-		x.xGetVtable = purego.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceVTable {
+		x.xGetVtable = core.NewCallback(func(InterfaceVarp uintptr) *DBusInterfaceVTable {
+			defer core.RecoverPanic()
 			return cb(DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp))
 		})
 	}
 }
 
 // GetGetVtable gets the "get_vtable" callback function.
-// Returns a #GDBusInterfaceVTable. See g_dbus_interface_skeleton_get_vtable() for details.
+// Returns a [DBusInterfaceVTable]. See [DBusInterfaceSkeleton.GetVtable] for details.
 func (x *DBusInterfaceSkeletonClass) GetGetVtable() func(*DBusInterfaceSkeleton) *DBusInterfaceVTable {
 	if x.xGetVtable == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) *DBusInterfaceVTable
-	purego.RegisterFunc(&rawCallback, x.xGetVtable)
+	core.RegisterFunc(&rawCallback, x.xGetVtable)
 	return func(InterfaceVar *DBusInterfaceSkeleton) *DBusInterfaceVTable {
 		return rawCallback(InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideGetProperties sets the "get_properties" callback function.
-// Returns a #GVariant with all properties. See g_dbus_interface_skeleton_get_properties().
+// Returns a [glib.Variant] with all properties. See [DBusInterfaceSkeleton.GetProperties].
 func (x *DBusInterfaceSkeletonClass) OverrideGetProperties(cb func(*DBusInterfaceSkeleton) *glib.Variant) {
 	if cb == nil {
 		x.xGetProperties = 0
 	} else {
-		x.xGetProperties = purego.NewCallback(func(InterfaceVarp uintptr) *glib.Variant {
+		x.xGetProperties = core.NewCallback(func(InterfaceVarp uintptr) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp))
 		})
 	}
 }
 
 // GetGetProperties gets the "get_properties" callback function.
-// Returns a #GVariant with all properties. See g_dbus_interface_skeleton_get_properties().
+// Returns a [glib.Variant] with all properties. See [DBusInterfaceSkeleton.GetProperties].
 func (x *DBusInterfaceSkeletonClass) GetGetProperties() func(*DBusInterfaceSkeleton) *glib.Variant {
 	if x.xGetProperties == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetProperties)
+	core.RegisterFunc(&rawCallback, x.xGetProperties)
 	return func(InterfaceVar *DBusInterfaceSkeleton) *glib.Variant {
 		return rawCallback(InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideFlush sets the "flush" callback function.
-// Emits outstanding changes, if any. See g_dbus_interface_skeleton_flush().
+// Emits outstanding changes, if any. See [DBusInterfaceSkeleton.Flush].
 func (x *DBusInterfaceSkeletonClass) OverrideFlush(cb func(*DBusInterfaceSkeleton)) {
 	if cb == nil {
 		x.xFlush = 0
 	} else {
-		x.xFlush = purego.NewCallback(func(InterfaceVarp uintptr) {
+		x.xFlush = core.NewCallback(func(InterfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp))
 		})
 	}
 }
 
 // GetFlush gets the "flush" callback function.
-// Emits outstanding changes, if any. See g_dbus_interface_skeleton_flush().
+// Emits outstanding changes, if any. See [DBusInterfaceSkeleton.Flush].
 func (x *DBusInterfaceSkeletonClass) GetFlush() func(*DBusInterfaceSkeleton) {
 	if x.xFlush == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFlush)
+	core.RegisterFunc(&rawCallback, x.xFlush)
 	return func(InterfaceVar *DBusInterfaceSkeleton) {
 		rawCallback(InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideGAuthorizeMethod sets the "g_authorize_method" callback function.
-// Signal class handler for the #GDBusInterfaceSkeleton::g-authorize-method signal.
+// Signal class handler for the [DBusInterfaceSkeleton.ConnectGAuthorizeMethodFunc] signal.
 func (x *DBusInterfaceSkeletonClass) OverrideGAuthorizeMethod(cb func(*DBusInterfaceSkeleton, *DBusMethodInvocation) bool) {
 	if cb == nil {
 		x.xGAuthorizeMethod = 0
 	} else {
-		x.xGAuthorizeMethod = purego.NewCallback(func(InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+		x.xGAuthorizeMethod = core.NewCallback(func(InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp), DBusMethodInvocationNewFromInternalPtr(InvocationVarp))
 		})
 	}
 }
 
 // GetGAuthorizeMethod gets the "g_authorize_method" callback function.
-// Signal class handler for the #GDBusInterfaceSkeleton::g-authorize-method signal.
+// Signal class handler for the [DBusInterfaceSkeleton.ConnectGAuthorizeMethodFunc] signal.
 func (x *DBusInterfaceSkeletonClass) GetGAuthorizeMethod() func(*DBusInterfaceSkeleton, *DBusMethodInvocation) bool {
 	if x.xGAuthorizeMethod == 0 {
 		return nil
 	}
 	var rawCallback func(InterfaceVarp uintptr, InvocationVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xGAuthorizeMethod)
+	core.RegisterFunc(&rawCallback, x.xGAuthorizeMethod)
 	return func(InterfaceVar *DBusInterfaceSkeleton, InvocationVar *DBusMethodInvocation) bool {
 		return rawCallback(InterfaceVar.GoPointer(), InvocationVar.GoPointer())
 	}
@@ -189,19 +193,19 @@ func DBusInterfaceSkeletonNewFromInternalPtr(ptr uintptr) *DBusInterfaceSkeleton
 
 var xDBusInterfaceSkeletonExport func(uintptr, uintptr, string, **glib.Error) bool
 
-// Exports @interface_ at @object_path on @connection.
+// Exports interface_ at object_path on connection.
 //
-// This can be called multiple times to export the same @interface_
-// onto multiple connections however the @object_path provided must be
+// This can be called multiple times to export the same interface_
+// onto multiple connections however the object_path provided must be
 // the same for all connections.
 //
-// Use g_dbus_interface_skeleton_unexport() to unexport the object.
+// Use [DBusInterfaceSkeleton.Unexport] to unexport the object.
 func (x *DBusInterfaceSkeleton) Export(ConnectionVar *DBusConnection, ObjectPathVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDBusInterfaceSkeletonExport(x.GoPointer(), ConnectionVar.GoPointer(), ObjectPathVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -209,7 +213,7 @@ func (x *DBusInterfaceSkeleton) Export(ConnectionVar *DBusConnection, ObjectPath
 
 var xDBusInterfaceSkeletonFlush func(uintptr)
 
-// If @interface_ has outstanding changes, request for these changes to be
+// If interface_ has outstanding changes, request for these changes to be
 // emitted immediately.
 //
 // For example, an exported D-Bus interface may queue up property
@@ -225,7 +229,7 @@ func (x *DBusInterfaceSkeleton) Flush() {
 
 var xDBusInterfaceSkeletonGetConnection func(uintptr) uintptr
 
-// Gets the first connection that @interface_ is exported on, if any.
+// Gets the first connection that interface_ is exported on, if any.
 func (x *DBusInterfaceSkeleton) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -242,7 +246,7 @@ func (x *DBusInterfaceSkeleton) GetConnection() *DBusConnection {
 
 var xDBusInterfaceSkeletonGetConnections func(uintptr) *glib.List
 
-// Gets a list of the connections that @interface_ is exported on.
+// Gets a list of the connections that interface_ is exported on.
 func (x *DBusInterfaceSkeleton) GetConnections() *glib.List {
 
 	cret := xDBusInterfaceSkeletonGetConnections(x.GoPointer())
@@ -251,8 +255,8 @@ func (x *DBusInterfaceSkeleton) GetConnections() *glib.List {
 
 var xDBusInterfaceSkeletonGetFlags func(uintptr) DBusInterfaceSkeletonFlags
 
-// Gets the #GDBusInterfaceSkeletonFlags that describes what the behavior
-// of @interface_
+// Gets the [DBusInterfaceSkeletonFlags] that describes what the behavior
+// of interface_
 func (x *DBusInterfaceSkeleton) GetFlags() DBusInterfaceSkeletonFlags {
 
 	cret := xDBusInterfaceSkeletonGetFlags(x.GoPointer())
@@ -262,7 +266,7 @@ func (x *DBusInterfaceSkeleton) GetFlags() DBusInterfaceSkeletonFlags {
 var xDBusInterfaceSkeletonGetInfo func(uintptr) *DBusInterfaceInfo
 
 // Gets D-Bus introspection information for the D-Bus interface
-// implemented by @interface_.
+// implemented by interface_.
 func (x *DBusInterfaceSkeleton) GetInfo() *DBusInterfaceInfo {
 
 	cret := xDBusInterfaceSkeletonGetInfo(x.GoPointer())
@@ -271,7 +275,7 @@ func (x *DBusInterfaceSkeleton) GetInfo() *DBusInterfaceInfo {
 
 var xDBusInterfaceSkeletonGetObjectPath func(uintptr) string
 
-// Gets the object path that @interface_ is exported on, if any.
+// Gets the object path that interface_ is exported on, if any.
 func (x *DBusInterfaceSkeleton) GetObjectPath() string {
 
 	cret := xDBusInterfaceSkeletonGetObjectPath(x.GoPointer())
@@ -280,7 +284,7 @@ func (x *DBusInterfaceSkeleton) GetObjectPath() string {
 
 var xDBusInterfaceSkeletonGetProperties func(uintptr) *glib.Variant
 
-// Gets all D-Bus properties for @interface_.
+// Gets all D-Bus properties for interface_.
 func (x *DBusInterfaceSkeleton) GetProperties() *glib.Variant {
 
 	cret := xDBusInterfaceSkeletonGetProperties(x.GoPointer())
@@ -290,8 +294,8 @@ func (x *DBusInterfaceSkeleton) GetProperties() *glib.Variant {
 var xDBusInterfaceSkeletonGetVtable func(uintptr) *DBusInterfaceVTable
 
 // Gets the interface vtable for the D-Bus interface implemented by
-// @interface_. The returned function pointers should expect @interface_
-// itself to be passed as @user_data.
+// interface_. The returned function pointers should expect interface_
+// itself to be passed as user_data.
 func (x *DBusInterfaceSkeleton) GetVtable() *DBusInterfaceVTable {
 
 	cret := xDBusInterfaceSkeletonGetVtable(x.GoPointer())
@@ -300,7 +304,7 @@ func (x *DBusInterfaceSkeleton) GetVtable() *DBusInterfaceVTable {
 
 var xDBusInterfaceSkeletonHasConnection func(uintptr, uintptr) bool
 
-// Checks if @interface_ is exported on @connection.
+// Checks if interface_ is exported on connection.
 func (x *DBusInterfaceSkeleton) HasConnection(ConnectionVar *DBusConnection) bool {
 
 	cret := xDBusInterfaceSkeletonHasConnection(x.GoPointer(), ConnectionVar.GoPointer())
@@ -309,7 +313,7 @@ func (x *DBusInterfaceSkeleton) HasConnection(ConnectionVar *DBusConnection) boo
 
 var xDBusInterfaceSkeletonSetFlags func(uintptr, DBusInterfaceSkeletonFlags)
 
-// Sets flags describing what the behavior of @skeleton should be.
+// Sets flags describing what the behavior of skeleton should be.
 func (x *DBusInterfaceSkeleton) SetFlags(FlagsVar DBusInterfaceSkeletonFlags) {
 
 	xDBusInterfaceSkeletonSetFlags(x.GoPointer(), FlagsVar)
@@ -318,10 +322,10 @@ func (x *DBusInterfaceSkeleton) SetFlags(FlagsVar DBusInterfaceSkeletonFlags) {
 
 var xDBusInterfaceSkeletonUnexport func(uintptr)
 
-// Stops exporting @interface_ on all connections it is exported on.
+// Stops exporting interface_ on all connections it is exported on.
 //
-// To unexport @interface_ from only a single connection, use
-// g_dbus_interface_skeleton_unexport_from_connection()
+// To unexport interface_ from only a single connection, use
+// [DBusInterfaceSkeleton.UnexportFromConnection]
 func (x *DBusInterfaceSkeleton) Unexport() {
 
 	xDBusInterfaceSkeletonUnexport(x.GoPointer())
@@ -330,10 +334,10 @@ func (x *DBusInterfaceSkeleton) Unexport() {
 
 var xDBusInterfaceSkeletonUnexportFromConnection func(uintptr, uintptr)
 
-// Stops exporting @interface_ on @connection.
+// Stops exporting interface_ on connection.
 //
 // To stop exporting on all connections the interface is exported on,
-// use g_dbus_interface_skeleton_unexport().
+// use [DBusInterfaceSkeleton.Unexport].
 func (x *DBusInterfaceSkeleton) UnexportFromConnection(ConnectionVar *DBusConnection) {
 
 	xDBusInterfaceSkeletonUnexportFromConnection(x.GoPointer(), ConnectionVar.GoPointer())
@@ -357,57 +361,82 @@ func (c *DBusInterfaceSkeleton) SetGoPointer(ptr uintptr) {
 // Note that this signal is emitted in a thread dedicated to
 // handling the method call so handlers are allowed to perform
 // blocking IO. This means that it is appropriate to call e.g.
-// [polkit_authority_check_authorization_sync()](http://hal.freedesktop.org/docs/polkit/PolkitAuthority.html#polkit-authority-check-authorization-sync)
+// [polkit_authority_check_authorization_sync()]
 // with the
-// [POLKIT_CHECK_AUTHORIZATION_FLAGS_ALLOW_USER_INTERACTION](http://hal.freedesktop.org/docs/polkit/PolkitAuthority.html#POLKIT-CHECK-AUTHORIZATION-FLAGS-ALLOW-USER-INTERACTION:CAPS)
+// [POLKIT_CHECK_AUTHORIZATION_FLAGS_ALLOW_USER_INTERACTION]
 // flag set.
 //
-// If %FALSE is returned then no further handlers are run and the
-// signal handler must take a reference to @invocation and finish
+// If false is returned then no further handlers are run and the
+// signal handler must take a reference to invocation and finish
 // handling the call (e.g. return an error via
-// g_dbus_method_invocation_return_error()).
+// [DBusMethodInvocation.ReturnError]).
 //
-// Otherwise, if %TRUE is returned, signal emission continues. If no
-// handlers return %FALSE, then the method is dispatched. If
-// @interface has an enclosing #GDBusObjectSkeleton, then the
-// #GDBusObjectSkeleton::authorize-method signal handlers run before
+// Otherwise, if true is returned, signal emission continues. If no
+// handlers return false, then the method is dispatched. If
+// interface has an enclosing [DBusObjectSkeleton], then the
+// [DBusObjectSkeleton.ConnectAuthorizeMethodFunc] signal handlers run before
 // the handlers for this signal.
 //
-// The default class handler just returns %TRUE.
+// The default class handler just returns true.
 //
 // Please note that the common case is optimized: if no signals
 // handlers are connected and the default class handler isn't
-// overridden (for both @interface and the enclosing
-// #GDBusObjectSkeleton, if any) and #GDBusInterfaceSkeleton:g-flags does
+// overridden (for both interface and the enclosing
+// [DBusObjectSkeleton], if any) and GDBusInterfaceSkeleton:g-flags does
 // not have the
-// %G_DBUS_INTERFACE_SKELETON_FLAGS_HANDLE_METHOD_INVOCATIONS_IN_THREAD
+// [GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue]
 // flags set, no dedicated thread is ever used and the call will be
-// handled in the same thread as the object that @interface belongs
+// handled in the same thread as the object that interface belongs
 // to was exported in.
+//
+// Deprecated: use ConnectGAuthorizeMethodFunc, which also accepts method values and closures.
+//
+// [polkit_authority_check_authorization_sync()]: http://hal.freedesktop.org/docs/polkit/PolkitAuthority.html#polkit-authority-check-authorization-sync
+// [POLKIT_CHECK_AUTHORIZATION_FLAGS_ALLOW_USER_INTERACTION]: http://hal.freedesktop.org/docs/polkit/PolkitAuthority.html#POLKIT-CHECK-AUTHORIZATION-FLAGS-ALLOW-USER-INTERACTION:CAPS
 func (x *DBusInterfaceSkeleton) ConnectGAuthorizeMethod(cb *func(DBusInterfaceSkeleton, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "g-authorize-method", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DBusInterfaceSkeleton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, InvocationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "g-authorize-method", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, InvocationVarp uintptr) bool {
+var xDBusInterfaceSkeletonGAuthorizeMethodTrampoline uintptr
+
+func xDBusInterfaceSkeletonGAuthorizeMethodNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InvocationVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusInterfaceSkeleton, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusInterfaceSkeleton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, InvocationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "g-authorize-method", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// ConnectGAuthorizeMethodFunc connects cb to the "g-authorize-method" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusInterfaceSkeleton) ConnectGAuthorizeMethodFunc(cb func(DBusInterfaceSkeleton, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "g-authorize-method", &xDBusInterfaceSkeletonGAuthorizeMethodTrampoline, xDBusInterfaceSkeletonGAuthorizeMethodNewTrampoline, cb)
+}
+
+// Gets the [DBusObject] that interface_ belongs to, if any.
 func (x *DBusInterfaceSkeleton) DupObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -421,11 +450,13 @@ func (x *DBusInterfaceSkeleton) DupObject() *DBusObjectBase {
 	return cls
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// Gets the [DBusObject] that interface_ belongs to, if any.
 //
-// It is not safe to use the returned object if @interface_ or
+// It is not safe to use the returned object if interface_ or
 // the returned object is being used from other threads. See
-// g_dbus_interface_dup_object() for a thread-safe alternative.
+// [DBusInterface.DupObject] for a thread-safe alternative.
+//
+// Deprecated: use [DupObject], the version of this function that language bindings are meant to use.
 func (x *DBusInterfaceSkeleton) GetObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -440,9 +471,9 @@ func (x *DBusInterfaceSkeleton) GetObject() *DBusObjectBase {
 	return cls
 }
 
-// Sets the #GDBusObject for @interface_ to @object.
+// Sets the [DBusObject] for interface_ to object.
 //
-// Note that @interface_ will hold a weak reference to @object.
+// Note that interface_ will hold a weak reference to object.
 func (x *DBusInterfaceSkeleton) SetObject(ObjectVar DBusObject) {
 
 	XGDbusInterfaceSetObject(x.GoPointer(), ObjectVar.GoPointer())
@@ -454,7 +485,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -463,18 +494,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGLibType, libs, "g_dbus_interface_skeleton_get_type")
 
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonExport, libs, "g_dbus_interface_skeleton_export")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonFlush, libs, "g_dbus_interface_skeleton_flush")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetConnection, libs, "g_dbus_interface_skeleton_get_connection")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetConnections, libs, "g_dbus_interface_skeleton_get_connections")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetFlags, libs, "g_dbus_interface_skeleton_get_flags")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetInfo, libs, "g_dbus_interface_skeleton_get_info")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetObjectPath, libs, "g_dbus_interface_skeleton_get_object_path")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetProperties, libs, "g_dbus_interface_skeleton_get_properties")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonGetVtable, libs, "g_dbus_interface_skeleton_get_vtable")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonHasConnection, libs, "g_dbus_interface_skeleton_has_connection")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonSetFlags, libs, "g_dbus_interface_skeleton_set_flags")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonUnexport, libs, "g_dbus_interface_skeleton_unexport")
-	core.PuregoSafeRegister(&xDBusInterfaceSkeletonUnexportFromConnection, libs, "g_dbus_interface_skeleton_unexport_from_connection")
+	gobject.CheckClassSize("GDBusInterfaceSkeletonClass", xDBusInterfaceSkeletonGLibType, unsafe.Sizeof(DBusInterfaceSkeletonClass{}))
+
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonExport, libs, "g_dbus_interface_skeleton_export", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonFlush, libs, "g_dbus_interface_skeleton_flush", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetConnection, libs, "g_dbus_interface_skeleton_get_connection", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetConnections, libs, "g_dbus_interface_skeleton_get_connections", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetFlags, libs, "g_dbus_interface_skeleton_get_flags", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetInfo, libs, "g_dbus_interface_skeleton_get_info", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetObjectPath, libs, "g_dbus_interface_skeleton_get_object_path", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetProperties, libs, "g_dbus_interface_skeleton_get_properties", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonGetVtable, libs, "g_dbus_interface_skeleton_get_vtable", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonHasConnection, libs, "g_dbus_interface_skeleton_has_connection", "GIO", "2.32")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonSetFlags, libs, "g_dbus_interface_skeleton_set_flags", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonUnexport, libs, "g_dbus_interface_skeleton_unexport", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceSkeletonUnexportFromConnection, libs, "g_dbus_interface_skeleton_unexport_from_connection", "GIO", "2.32")
 
 }
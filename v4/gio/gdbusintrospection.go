@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -36,7 +35,7 @@ func (x *DBusAnnotationInfo) GoPointer() uintptr {
 
 var xDBusAnnotationInfoRef func(uintptr) *DBusAnnotationInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusAnnotationInfo) Ref() *DBusAnnotationInfo {
 
@@ -46,8 +45,8 @@ func (x *DBusAnnotationInfo) Ref() *DBusAnnotationInfo {
 
 var xDBusAnnotationInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusAnnotationInfo) Unref() {
 
@@ -80,7 +79,7 @@ func (x *DBusArgInfo) GoPointer() uintptr {
 
 var xDBusArgInfoRef func(uintptr) *DBusArgInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusArgInfo) Ref() *DBusArgInfo {
 
@@ -90,8 +89,8 @@ func (x *DBusArgInfo) Ref() *DBusArgInfo {
 
 var xDBusArgInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusArgInfo) Unref() {
 
@@ -129,15 +128,15 @@ func (x *DBusInterfaceInfo) GoPointer() uintptr {
 var xDBusInterfaceInfoCacheBuild func(uintptr)
 
 // Builds a lookup-cache to speed up
-// g_dbus_interface_info_lookup_method(),
-// g_dbus_interface_info_lookup_signal() and
-// g_dbus_interface_info_lookup_property().
+// [DBusInterfaceInfo.LookupMethod],
+// [DBusInterfaceInfo.LookupSignal] and
+// [DBusInterfaceInfo.LookupProperty].
 //
-// If this has already been called with @info, the existing cache is
+// If this has already been called with info, the existing cache is
 // used and its use count is increased.
 //
-// Note that @info cannot be modified until
-// g_dbus_interface_info_cache_release() is called.
+// Note that info cannot be modified until
+// [DBusInterfaceInfo.CacheRelease] is called.
 func (x *DBusInterfaceInfo) CacheBuild() {
 
 	xDBusInterfaceInfoCacheBuild(x.GoPointer())
@@ -146,8 +145,8 @@ func (x *DBusInterfaceInfo) CacheBuild() {
 
 var xDBusInterfaceInfoCacheRelease func(uintptr)
 
-// Decrements the usage count for the cache for @info built by
-// g_dbus_interface_info_cache_build() (if any) and frees the
+// Decrements the usage count for the cache for info built by
+// [DBusInterfaceInfo.CacheBuild] (if any) and frees the
 // resources used by the cache if the usage count drops to zero.
 func (x *DBusInterfaceInfo) CacheRelease() {
 
@@ -157,7 +156,7 @@ func (x *DBusInterfaceInfo) CacheRelease() {
 
 var xDBusInterfaceInfoGenerateXml func(uintptr, uint, *glib.String)
 
-// Appends an XML representation of @info (and its children) to @string_builder.
+// Appends an XML representation of info (and its children) to string_builder.
 //
 // This function is typically used for generating introspection XML
 // documents at run-time for handling the
@@ -174,7 +173,7 @@ var xDBusInterfaceInfoLookupMethod func(uintptr, string) *DBusMethodInfo
 // Looks up information about a method.
 //
 // The cost of this function is O(n) in number of methods unless
-// g_dbus_interface_info_cache_build() has been used on @info.
+// [DBusInterfaceInfo.CacheBuild] has been used on info.
 func (x *DBusInterfaceInfo) LookupMethod(NameVar string) *DBusMethodInfo {
 
 	cret := xDBusInterfaceInfoLookupMethod(x.GoPointer(), NameVar)
@@ -186,7 +185,7 @@ var xDBusInterfaceInfoLookupProperty func(uintptr, string) *DBusPropertyInfo
 // Looks up information about a property.
 //
 // The cost of this function is O(n) in number of properties unless
-// g_dbus_interface_info_cache_build() has been used on @info.
+// [DBusInterfaceInfo.CacheBuild] has been used on info.
 func (x *DBusInterfaceInfo) LookupProperty(NameVar string) *DBusPropertyInfo {
 
 	cret := xDBusInterfaceInfoLookupProperty(x.GoPointer(), NameVar)
@@ -198,7 +197,7 @@ var xDBusInterfaceInfoLookupSignal func(uintptr, string) *DBusSignalInfo
 // Looks up information about a signal.
 //
 // The cost of this function is O(n) in number of signals unless
-// g_dbus_interface_info_cache_build() has been used on @info.
+// [DBusInterfaceInfo.CacheBuild] has been used on info.
 func (x *DBusInterfaceInfo) LookupSignal(NameVar string) *DBusSignalInfo {
 
 	cret := xDBusInterfaceInfoLookupSignal(x.GoPointer(), NameVar)
@@ -207,7 +206,7 @@ func (x *DBusInterfaceInfo) LookupSignal(NameVar string) *DBusSignalInfo {
 
 var xDBusInterfaceInfoRef func(uintptr) *DBusInterfaceInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusInterfaceInfo) Ref() *DBusInterfaceInfo {
 
@@ -217,8 +216,8 @@ func (x *DBusInterfaceInfo) Ref() *DBusInterfaceInfo {
 
 var xDBusInterfaceInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusInterfaceInfo) Unref() {
 
@@ -253,7 +252,7 @@ func (x *DBusMethodInfo) GoPointer() uintptr {
 
 var xDBusMethodInfoRef func(uintptr) *DBusMethodInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusMethodInfo) Ref() *DBusMethodInfo {
 
@@ -263,8 +262,8 @@ func (x *DBusMethodInfo) Ref() *DBusMethodInfo {
 
 var xDBusMethodInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusMethodInfo) Unref() {
 
@@ -299,20 +298,20 @@ func (x *DBusNodeInfo) GoPointer() uintptr {
 
 var xNewDBusNodeInfoForXml func(string, **glib.Error) *DBusNodeInfo
 
-// Parses @xml_data and returns a #GDBusNodeInfo representing the data.
+// Parses xml_data and returns a [DBusNodeInfo] representing the data.
 //
 // The introspection XML must contain exactly one top-level
-// `&lt;node&gt;` element.
+// `<node>` element.
 //
 // Note that this routine is using a
-// [GMarkup](../glib/markup.html)-based
+// GMarkup-based
 // parser that only accepts a subset of valid XML documents.
 func NewDBusNodeInfoForXml(XmlDataVar string) (*DBusNodeInfo, error) {
 	var cerr *glib.Error
 
 	cret := xNewDBusNodeInfoForXml(XmlDataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -320,7 +319,7 @@ func NewDBusNodeInfoForXml(XmlDataVar string) (*DBusNodeInfo, error) {
 
 var xDBusNodeInfoGenerateXml func(uintptr, uint, *glib.String)
 
-// Appends an XML representation of @info (and its children) to @string_builder.
+// Appends an XML representation of info (and its children) to string_builder.
 //
 // This function is typically used for generating introspection XML documents at run-time for
 // handling the `org.freedesktop.DBus.Introspectable.Introspect`  method.
@@ -343,7 +342,7 @@ func (x *DBusNodeInfo) LookupInterface(NameVar string) *DBusInterfaceInfo {
 
 var xDBusNodeInfoRef func(uintptr) *DBusNodeInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusNodeInfo) Ref() *DBusNodeInfo {
 
@@ -353,8 +352,8 @@ func (x *DBusNodeInfo) Ref() *DBusNodeInfo {
 
 var xDBusNodeInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusNodeInfo) Unref() {
 
@@ -389,7 +388,7 @@ func (x *DBusPropertyInfo) GoPointer() uintptr {
 
 var xDBusPropertyInfoRef func(uintptr) *DBusPropertyInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusPropertyInfo) Ref() *DBusPropertyInfo {
 
@@ -399,8 +398,8 @@ func (x *DBusPropertyInfo) Ref() *DBusPropertyInfo {
 
 var xDBusPropertyInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusPropertyInfo) Unref() {
 
@@ -433,7 +432,7 @@ func (x *DBusSignalInfo) GoPointer() uintptr {
 
 var xDBusSignalInfoRef func(uintptr) *DBusSignalInfo
 
-// If @info is statically allocated does nothing. Otherwise increases
+// If info is statically allocated does nothing. Otherwise increases
 // the reference count.
 func (x *DBusSignalInfo) Ref() *DBusSignalInfo {
 
@@ -443,8 +442,8 @@ func (x *DBusSignalInfo) Ref() *DBusSignalInfo {
 
 var xDBusSignalInfoUnref func(uintptr)
 
-// If @info is statically allocated, does nothing. Otherwise decreases
-// the reference count of @info. When its reference count drops to 0,
+// If info is statically allocated, does nothing. Otherwise decreases
+// the reference count of info. When its reference count drops to 0,
 // the memory used is freed.
 func (x *DBusSignalInfo) Unref() {
 
@@ -452,74 +451,83 @@ func (x *DBusSignalInfo) Unref() {
 
 }
 
-var xDbusAnnotationInfoLookup func(uintptr, string) string
+var xDBusAnnotationInfoLookup func(uintptr, string) string
 
 // Looks up the value of an annotation.
 //
 // The cost of this function is O(n) in number of annotations.
-func DbusAnnotationInfoLookup(AnnotationsVar uintptr, NameVar string) string {
+func DBusAnnotationInfoLookup(AnnotationsVar uintptr, NameVar string) string {
 
-	cret := xDbusAnnotationInfoLookup(AnnotationsVar, NameVar)
+	cret := xDBusAnnotationInfoLookup(AnnotationsVar, NameVar)
 	return cret
 }
 
+// Looks up the value of an annotation.
+//
+// The cost of this function is O(n) in number of annotations.
+//
+// Deprecated: use DBusAnnotationInfoLookup, this name of g_dbus_annotation_info_lookup is kept for compatibility.
+func DbusAnnotationInfoLookup(AnnotationsVar uintptr, NameVar string) string {
+	return DBusAnnotationInfoLookup(AnnotationsVar, NameVar)
+}
+
 func init() {
 	core.SetPackageName("GIO", "gio-2.0")
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDbusAnnotationInfoLookup, libs, "g_dbus_annotation_info_lookup")
+	core.PuregoRegisterSince(&xDBusAnnotationInfoLookup, libs, "g_dbus_annotation_info_lookup", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusAnnotationInfoGLibType, libs, "g_dbus_annotation_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusAnnotationInfoRef, libs, "g_dbus_annotation_info_ref")
-	core.PuregoSafeRegister(&xDBusAnnotationInfoUnref, libs, "g_dbus_annotation_info_unref")
+	core.PuregoRegisterSince(&xDBusAnnotationInfoRef, libs, "g_dbus_annotation_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusAnnotationInfoUnref, libs, "g_dbus_annotation_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusArgInfoGLibType, libs, "g_dbus_arg_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusArgInfoRef, libs, "g_dbus_arg_info_ref")
-	core.PuregoSafeRegister(&xDBusArgInfoUnref, libs, "g_dbus_arg_info_unref")
+	core.PuregoRegisterSince(&xDBusArgInfoRef, libs, "g_dbus_arg_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusArgInfoUnref, libs, "g_dbus_arg_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusInterfaceInfoGLibType, libs, "g_dbus_interface_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusInterfaceInfoCacheBuild, libs, "g_dbus_interface_info_cache_build")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoCacheRelease, libs, "g_dbus_interface_info_cache_release")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoGenerateXml, libs, "g_dbus_interface_info_generate_xml")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoLookupMethod, libs, "g_dbus_interface_info_lookup_method")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoLookupProperty, libs, "g_dbus_interface_info_lookup_property")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoLookupSignal, libs, "g_dbus_interface_info_lookup_signal")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoRef, libs, "g_dbus_interface_info_ref")
-	core.PuregoSafeRegister(&xDBusInterfaceInfoUnref, libs, "g_dbus_interface_info_unref")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoCacheBuild, libs, "g_dbus_interface_info_cache_build", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoCacheRelease, libs, "g_dbus_interface_info_cache_release", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoGenerateXml, libs, "g_dbus_interface_info_generate_xml", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoLookupMethod, libs, "g_dbus_interface_info_lookup_method", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoLookupProperty, libs, "g_dbus_interface_info_lookup_property", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoLookupSignal, libs, "g_dbus_interface_info_lookup_signal", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoRef, libs, "g_dbus_interface_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusInterfaceInfoUnref, libs, "g_dbus_interface_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusMethodInfoGLibType, libs, "g_dbus_method_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusMethodInfoRef, libs, "g_dbus_method_info_ref")
-	core.PuregoSafeRegister(&xDBusMethodInfoUnref, libs, "g_dbus_method_info_unref")
+	core.PuregoRegisterSince(&xDBusMethodInfoRef, libs, "g_dbus_method_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInfoUnref, libs, "g_dbus_method_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusNodeInfoGLibType, libs, "g_dbus_node_info_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusNodeInfoForXml, libs, "g_dbus_node_info_new_for_xml")
+	core.PuregoRegisterSince(&xNewDBusNodeInfoForXml, libs, "g_dbus_node_info_new_for_xml", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xDBusNodeInfoGenerateXml, libs, "g_dbus_node_info_generate_xml")
-	core.PuregoSafeRegister(&xDBusNodeInfoLookupInterface, libs, "g_dbus_node_info_lookup_interface")
-	core.PuregoSafeRegister(&xDBusNodeInfoRef, libs, "g_dbus_node_info_ref")
-	core.PuregoSafeRegister(&xDBusNodeInfoUnref, libs, "g_dbus_node_info_unref")
+	core.PuregoRegisterSince(&xDBusNodeInfoGenerateXml, libs, "g_dbus_node_info_generate_xml", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusNodeInfoLookupInterface, libs, "g_dbus_node_info_lookup_interface", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusNodeInfoRef, libs, "g_dbus_node_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusNodeInfoUnref, libs, "g_dbus_node_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusPropertyInfoGLibType, libs, "g_dbus_property_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusPropertyInfoRef, libs, "g_dbus_property_info_ref")
-	core.PuregoSafeRegister(&xDBusPropertyInfoUnref, libs, "g_dbus_property_info_unref")
+	core.PuregoRegisterSince(&xDBusPropertyInfoRef, libs, "g_dbus_property_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusPropertyInfoUnref, libs, "g_dbus_property_info_unref", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xDBusSignalInfoGLibType, libs, "g_dbus_signal_info_get_type")
 
-	core.PuregoSafeRegister(&xDBusSignalInfoRef, libs, "g_dbus_signal_info_ref")
-	core.PuregoSafeRegister(&xDBusSignalInfoUnref, libs, "g_dbus_signal_info_unref")
+	core.PuregoRegisterSince(&xDBusSignalInfoRef, libs, "g_dbus_signal_info_ref", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusSignalInfoUnref, libs, "g_dbus_signal_info_unref", "GIO", "2.26")
 
 }
@@ -2,14 +2,13 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GDBusMenuModel` is an implementation of [class@Gio.MenuModel] that can be
+// `GDBusMenuModel` is an implementation of [MenuModel] that can be
 // used as a proxy for a menu model that is exported over D-Bus with
-// [method@Gio.DBusConnection.export_menu_model].
+// [DBusConnection.ExportMenuModel].
 type DBusMenuModel struct {
 	MenuModel
 }
@@ -39,8 +38,8 @@ func (c *DBusMenuModel) SetGoPointer(ptr uintptr) {
 
 var xDBusMenuModelGet func(uintptr, uintptr, string) uintptr
 
-// Obtains a #GDBusMenuModel for the menu model which is exported
-// at the given @bus_name and @object_path.
+// Obtains a [DBusMenuModel] for the menu model which is exported
+// at the given bus_name and object_path.
 //
 // The thread default main context is taken at the time of this call.
 // All signals on the menu model (and any linked models) are reported
@@ -68,7 +67,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -77,6 +76,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusMenuModelGLibType, libs, "g_dbus_menu_model_get_type")
 
-	core.PuregoSafeRegister(&xDBusMenuModelGet, libs, "g_dbus_menu_model_get")
+	core.PuregoRegisterSince(&xDBusMenuModelGet, libs, "g_dbus_menu_model_get", "GIO", "2.32")
 
 }
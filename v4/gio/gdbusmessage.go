@@ -2,7 +2,8 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -10,7 +11,7 @@ import (
 )
 
 // A type for representing D-Bus messages that can be sent or received
-// on a [class@Gio.DBusConnection].
+// on a [DBusConnection].
 type DBusMessage struct {
 	gobject.Object
 }
@@ -29,7 +30,7 @@ func DBusMessageNewFromInternalPtr(ptr uintptr) *DBusMessage {
 
 var xNewDBusMessage func() uintptr
 
-// Creates a new empty #GDBusMessage.
+// Creates a new empty [DBusMessage].
 func NewDBusMessage() *DBusMessage {
 	var cls *DBusMessage
 
@@ -45,12 +46,12 @@ func NewDBusMessage() *DBusMessage {
 
 var xNewDBusMessageFromBlob func([]byte, uint, DBusCapabilityFlags, **glib.Error) uintptr
 
-// Creates a new #GDBusMessage from the data stored at @blob. The byte
+// Creates a new [DBusMessage] from the data stored at blob. The byte
 // order that the message was in can be retrieved using
-// g_dbus_message_get_byte_order().
+// [DBusMessage.GetByteOrder].
 //
-// If the @blob cannot be parsed, contains invalid fields, or contains invalid
-// headers, %G_IO_ERROR_INVALID_ARGUMENT will be returned.
+// If the blob cannot be parsed, contains invalid fields, or contains invalid
+// headers, [GIoErrorInvalidArgumentValue] will be returned.
 func NewDBusMessageFromBlob(BlobVar []byte, BlobLenVar uint, CapabilitiesVar DBusCapabilityFlags) (*DBusMessage, error) {
 	var cls *DBusMessage
 	var cerr *glib.Error
@@ -58,12 +59,15 @@ func NewDBusMessageFromBlob(BlobVar []byte, BlobLenVar uint, CapabilitiesVar DBu
 	cret := xNewDBusMessageFromBlob(BlobVar, BlobLenVar, CapabilitiesVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusMessage{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -71,7 +75,7 @@ func NewDBusMessageFromBlob(BlobVar []byte, BlobLenVar uint, CapabilitiesVar DBu
 
 var xNewDBusMessageMethodCall func(uintptr, string, uintptr, string) uintptr
 
-// Creates a new #GDBusMessage for a method call.
+// Creates a new [DBusMessage] for a method call.
 func NewDBusMessageMethodCall(NameVar *string, PathVar string, InterfaceVar *string, MethodVar string) *DBusMessage {
 	var cls *DBusMessage
 
@@ -93,7 +97,7 @@ func NewDBusMessageMethodCall(NameVar *string, PathVar string, InterfaceVar *str
 
 var xNewDBusMessageSignal func(string, string, string) uintptr
 
-// Creates a new #GDBusMessage for a signal emission.
+// Creates a new [DBusMessage] for a signal emission.
 func NewDBusMessageSignal(PathVar string, InterfaceVar string, SignalVar string) *DBusMessage {
 	var cls *DBusMessage
 
@@ -109,11 +113,11 @@ func NewDBusMessageSignal(PathVar string, InterfaceVar string, SignalVar string)
 
 var xDBusMessageCopy func(uintptr) uintptr
 
-// Copies @message. The copy is a deep copy and the returned
-// #GDBusMessage is completely identical except that it is guaranteed
+// Copies message. The copy is a deep copy and the returned
+// [DBusMessage] is completely identical except that it is guaranteed
 // to not be locked.
 //
-// This operation can fail if e.g. @message contains file descriptors
+// This operation can fail if e.g. message contains file descriptors
 // and the per-process or system-wide open files limit is reached.
 func (x *DBusMessage) Copy() (*DBusMessage, error) {
 	var cls *DBusMessage
@@ -122,12 +126,15 @@ func (x *DBusMessage) Copy() (*DBusMessage, error) {
 	cret := xDBusMessageCopy(x.GoPointer())
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusMessage{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -135,9 +142,9 @@ func (x *DBusMessage) Copy() (*DBusMessage, error) {
 
 var xDBusMessageGetArg0 func(uintptr) string
 
-// Convenience to get the first item in the body of @message.
+// Convenience to get the first item in the body of message.
 //
-// See [method@Gio.DBusMessage.get_arg0_path] for returning object-path-typed
+// See [DBusMessage.GetArg0Path] for returning object-path-typed
 // arg0 values.
 func (x *DBusMessage) GetArg0() string {
 
@@ -147,9 +154,9 @@ func (x *DBusMessage) GetArg0() string {
 
 var xDBusMessageGetArg0Path func(uintptr) string
 
-// Convenience to get the first item in the body of @message.
+// Convenience to get the first item in the body of message.
 //
-// See [method@Gio.DBusMessage.get_arg0] for returning string-typed arg0 values.
+// See [DBusMessage.GetArg0] for returning string-typed arg0 values.
 func (x *DBusMessage) GetArg0Path() string {
 
 	cret := xDBusMessageGetArg0Path(x.GoPointer())
@@ -167,7 +174,7 @@ func (x *DBusMessage) GetBody() *glib.Variant {
 
 var xDBusMessageGetByteOrder func(uintptr) DBusMessageByteOrder
 
-// Gets the byte order of @message.
+// Gets the byte order of message.
 func (x *DBusMessage) GetByteOrder() DBusMessageByteOrder {
 
 	cret := xDBusMessageGetByteOrder(x.GoPointer())
@@ -176,7 +183,7 @@ func (x *DBusMessage) GetByteOrder() DBusMessageByteOrder {
 
 var xDBusMessageGetDestination func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_DESTINATION header field.
+// Convenience getter for the [GDbusMessageHeaderFieldDestinationValue] header field.
 func (x *DBusMessage) GetDestination() string {
 
 	cret := xDBusMessageGetDestination(x.GoPointer())
@@ -185,7 +192,7 @@ func (x *DBusMessage) GetDestination() string {
 
 var xDBusMessageGetErrorName func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_ERROR_NAME header field.
+// Convenience getter for the [GDbusMessageHeaderFieldErrorNameValue] header field.
 func (x *DBusMessage) GetErrorName() string {
 
 	cret := xDBusMessageGetErrorName(x.GoPointer())
@@ -194,7 +201,7 @@ func (x *DBusMessage) GetErrorName() string {
 
 var xDBusMessageGetFlags func(uintptr) DBusMessageFlags
 
-// Gets the flags for @message.
+// Gets the flags for message.
 func (x *DBusMessage) GetFlags() DBusMessageFlags {
 
 	cret := xDBusMessageGetFlags(x.GoPointer())
@@ -203,9 +210,9 @@ func (x *DBusMessage) GetFlags() DBusMessageFlags {
 
 var xDBusMessageGetHeader func(uintptr, DBusMessageHeaderField) *glib.Variant
 
-// Gets a header field on @message.
+// Gets a header field on message.
 //
-// The caller is responsible for checking the type of the returned #GVariant
+// The caller is responsible for checking the type of the returned [glib.Variant]
 // matches what is expected.
 func (x *DBusMessage) GetHeader(HeaderFieldVar DBusMessageHeaderField) *glib.Variant {
 
@@ -215,7 +222,7 @@ func (x *DBusMessage) GetHeader(HeaderFieldVar DBusMessageHeaderField) *glib.Var
 
 var xDBusMessageGetHeaderFields func(uintptr) uintptr
 
-// Gets an array of all header fields on @message that are set.
+// Gets an array of all header fields on message that are set.
 func (x *DBusMessage) GetHeaderFields() uintptr {
 
 	cret := xDBusMessageGetHeaderFields(x.GoPointer())
@@ -224,7 +231,7 @@ func (x *DBusMessage) GetHeaderFields() uintptr {
 
 var xDBusMessageGetInterface func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_INTERFACE header field.
+// Convenience getter for the [GDbusMessageHeaderFieldInterfaceValue] header field.
 func (x *DBusMessage) GetInterface() string {
 
 	cret := xDBusMessageGetInterface(x.GoPointer())
@@ -233,9 +240,9 @@ func (x *DBusMessage) GetInterface() string {
 
 var xDBusMessageGetLocked func(uintptr) bool
 
-// Checks whether @message is locked. To monitor changes to this
-// value, connect to the #GObject::notify signal to listen for changes
-// on the #GDBusMessage:locked property.
+// Checks whether message is locked. To monitor changes to this
+// value, connect to the [gobject.Object.ConnectNotifyFunc] signal to listen for changes
+// on the [DBusMessage.GetPropertyLocked] property.
 func (x *DBusMessage) GetLocked() bool {
 
 	cret := xDBusMessageGetLocked(x.GoPointer())
@@ -244,7 +251,7 @@ func (x *DBusMessage) GetLocked() bool {
 
 var xDBusMessageGetMember func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_MEMBER header field.
+// Convenience getter for the [GDbusMessageHeaderFieldMemberValue] header field.
 func (x *DBusMessage) GetMember() string {
 
 	cret := xDBusMessageGetMember(x.GoPointer())
@@ -253,7 +260,7 @@ func (x *DBusMessage) GetMember() string {
 
 var xDBusMessageGetMessageType func(uintptr) DBusMessageType
 
-// Gets the type of @message.
+// Gets the type of message.
 func (x *DBusMessage) GetMessageType() DBusMessageType {
 
 	cret := xDBusMessageGetMessageType(x.GoPointer())
@@ -262,7 +269,7 @@ func (x *DBusMessage) GetMessageType() DBusMessageType {
 
 var xDBusMessageGetNumUnixFds func(uintptr) uint32
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_NUM_UNIX_FDS header field.
+// Convenience getter for the [GDbusMessageHeaderFieldNumUnixFdsValue] header field.
 func (x *DBusMessage) GetNumUnixFds() uint32 {
 
 	cret := xDBusMessageGetNumUnixFds(x.GoPointer())
@@ -271,7 +278,7 @@ func (x *DBusMessage) GetNumUnixFds() uint32 {
 
 var xDBusMessageGetPath func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_PATH header field.
+// Convenience getter for the [GDbusMessageHeaderFieldPathValue] header field.
 func (x *DBusMessage) GetPath() string {
 
 	cret := xDBusMessageGetPath(x.GoPointer())
@@ -280,7 +287,7 @@ func (x *DBusMessage) GetPath() string {
 
 var xDBusMessageGetReplySerial func(uintptr) uint32
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_REPLY_SERIAL header field.
+// Convenience getter for the [GDbusMessageHeaderFieldReplySerialValue] header field.
 func (x *DBusMessage) GetReplySerial() uint32 {
 
 	cret := xDBusMessageGetReplySerial(x.GoPointer())
@@ -289,7 +296,7 @@ func (x *DBusMessage) GetReplySerial() uint32 {
 
 var xDBusMessageGetSender func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_SENDER header field.
+// Convenience getter for the [GDbusMessageHeaderFieldSenderValue] header field.
 func (x *DBusMessage) GetSender() string {
 
 	cret := xDBusMessageGetSender(x.GoPointer())
@@ -298,7 +305,7 @@ func (x *DBusMessage) GetSender() string {
 
 var xDBusMessageGetSerial func(uintptr) uint32
 
-// Gets the serial for @message.
+// Gets the serial for message.
 func (x *DBusMessage) GetSerial() uint32 {
 
 	cret := xDBusMessageGetSerial(x.GoPointer())
@@ -307,9 +314,9 @@ func (x *DBusMessage) GetSerial() uint32 {
 
 var xDBusMessageGetSignature func(uintptr) string
 
-// Convenience getter for the %G_DBUS_MESSAGE_HEADER_FIELD_SIGNATURE header field.
+// Convenience getter for the [GDbusMessageHeaderFieldSignatureValue] header field.
 //
-// This will always be non-%NULL, but may be an empty string.
+// This will always be non-nil, but may be an empty string.
 func (x *DBusMessage) GetSignature() string {
 
 	cret := xDBusMessageGetSignature(x.GoPointer())
@@ -318,13 +325,13 @@ func (x *DBusMessage) GetSignature() string {
 
 var xDBusMessageGetUnixFdList func(uintptr) uintptr
 
-// Gets the UNIX file descriptors associated with @message, if any.
+// Gets the UNIX file descriptors associated with message, if any.
 //
 // This method is only available on UNIX.
 //
-// The file descriptors normally correspond to %G_VARIANT_TYPE_HANDLE
+// The file descriptors normally correspond to G_VARIANT_TYPE_HANDLE
 // values in the body of the message. For example,
-// if g_variant_get_handle() returns 5, that is intended to be a reference
+// if [glib.Variant.GetHandle] returns 5, that is intended to be a reference
 // to the file descriptor that can be accessed by
 // `g_unix_fd_list_get (list, 5, ...)`.
 func (x *DBusMessage) GetUnixFdList() *UnixFDList {
@@ -343,7 +350,7 @@ func (x *DBusMessage) GetUnixFdList() *UnixFDList {
 
 var xDBusMessageLock func(uintptr)
 
-// If @message is locked, does nothing. Otherwise locks the message.
+// If message is locked, does nothing. Otherwise locks the message.
 func (x *DBusMessage) Lock() {
 
 	xDBusMessageLock(x.GoPointer())
@@ -352,11 +359,13 @@ func (x *DBusMessage) Lock() {
 
 var xDBusMessageNewMethodError func(uintptr, string, string, ...interface{}) uintptr
 
-// Creates a new #GDBusMessage that is an error reply to @method_call_message.
+// Creates a new [DBusMessage] that is an error reply to method_call_message.
+//
+// ErrorMessageFormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *DBusMessage) NewMethodError(ErrorNameVar string, ErrorMessageFormatVar string, varArgs ...interface{}) *DBusMessage {
 	var cls *DBusMessage
 
-	cret := xDBusMessageNewMethodError(x.GoPointer(), ErrorNameVar, ErrorMessageFormatVar, varArgs...)
+	cret := xDBusMessageNewMethodError(x.GoPointer(), ErrorNameVar, "%s", fmt.Sprintf(ErrorMessageFormatVar, varArgs...))
 
 	if cret == 0 {
 		return nil
@@ -368,7 +377,7 @@ func (x *DBusMessage) NewMethodError(ErrorNameVar string, ErrorMessageFormatVar
 
 var xDBusMessageNewMethodErrorLiteral func(uintptr, string, string) uintptr
 
-// Creates a new #GDBusMessage that is an error reply to @method_call_message.
+// Creates a new [DBusMessage] that is an error reply to method_call_message.
 func (x *DBusMessage) NewMethodErrorLiteral(ErrorNameVar string, ErrorMessageVar string) *DBusMessage {
 	var cls *DBusMessage
 
@@ -384,7 +393,7 @@ func (x *DBusMessage) NewMethodErrorLiteral(ErrorNameVar string, ErrorMessageVar
 
 var xDBusMessageNewMethodErrorValist func(uintptr, string, string, []interface{}) uintptr
 
-// Like g_dbus_message_new_method_error() but intended for language bindings.
+// Like [DBusMessage.NewMethodError] but intended for language bindings.
 func (x *DBusMessage) NewMethodErrorValist(ErrorNameVar string, ErrorMessageFormatVar string, VarArgsVar []interface{}) *DBusMessage {
 	var cls *DBusMessage
 
@@ -400,7 +409,7 @@ func (x *DBusMessage) NewMethodErrorValist(ErrorNameVar string, ErrorMessageForm
 
 var xDBusMessageNewMethodReply func(uintptr) uintptr
 
-// Creates a new #GDBusMessage that is a reply to @method_call_message.
+// Creates a new [DBusMessage] that is a reply to method_call_message.
 func (x *DBusMessage) NewMethodReply() *DBusMessage {
 	var cls *DBusMessage
 
@@ -416,46 +425,37 @@ func (x *DBusMessage) NewMethodReply() *DBusMessage {
 
 var xDBusMessagePrint func(uintptr, uint) string
 
-// Produces a human-readable multi-line description of @message.
+// Produces a human-readable multi-line description of message.
 //
 // The contents of the description has no ABI guarantees, the contents
 // and formatting is subject to change at any time. Typical output
 // looks something like this:
-// ```
-// Flags:   none
-// Version: 0
-// Serial:  4
-// Headers:
-//
-//	path -&gt; objectpath '/org/gtk/GDBus/TestObject'
-//	interface -&gt; 'org.gtk.GDBus.TestInterface'
-//	member -&gt; 'GimmeStdout'
-//	destination -&gt; ':1.146'
 //
-// Body: ()
-// UNIX File Descriptors:
+//	Flags:   none
+//	Version: 0
+//	Serial:  4
+//	Headers:
+//	  path -> objectpath '/org/gtk/GDBus/TestObject'
+//	  interface -> 'org.gtk.GDBus.TestInterface'
+//	  member -> 'GimmeStdout'
+//	  destination -> ':1.146'
+//	Body: ()
+//	UNIX File Descriptors:
+//	  (none)
 //
-//	(none)
-//
-// ```
 // or
-// ```
-// Flags:   no-reply-expected
-// Version: 0
-// Serial:  477
-// Headers:
-//
-//	reply-serial -&gt; uint32 4
-//	destination -&gt; ':1.159'
-//	sender -&gt; ':1.146'
-//	num-unix-fds -&gt; uint32 1
-//
-// Body: ()
-// UNIX File Descriptors:
 //
-//	fd 12: dev=0:10,mode=020620,ino=5,uid=500,gid=5,rdev=136:2,size=0,atime=1273085037,mtime=1273085851,ctime=1272982635
-//
-// ```
+//	Flags:   no-reply-expected
+//	Version: 0
+//	Serial:  477
+//	Headers:
+//	  reply-serial -> uint32 4
+//	  destination -> ':1.159'
+//	  sender -> ':1.146'
+//	  num-unix-fds -> uint32 1
+//	Body: ()
+//	UNIX File Descriptors:
+//	  fd 12: dev=0:10,mode=020620,ino=5,uid=500,gid=5,rdev=136:2,size=0,atime=1273085037,mtime=1273085851,ctime=1272982635
 func (x *DBusMessage) Print(IndentVar uint) string {
 
 	cret := xDBusMessagePrint(x.GoPointer(), IndentVar)
@@ -464,11 +464,11 @@ func (x *DBusMessage) Print(IndentVar uint) string {
 
 var xDBusMessageSetBody func(uintptr, *glib.Variant)
 
-// Sets the body @message. As a side-effect the
-// %G_DBUS_MESSAGE_HEADER_FIELD_SIGNATURE header field is set to the
-// type string of @body (or cleared if @body is %NULL).
+// Sets the body message. As a side-effect the
+// [GDbusMessageHeaderFieldSignatureValue] header field is set to the
+// type string of body (or cleared if body is nil).
 //
-// If @body is floating, @message assumes ownership of @body.
+// If body is floating, message assumes ownership of body.
 func (x *DBusMessage) SetBody(BodyVar *glib.Variant) {
 
 	xDBusMessageSetBody(x.GoPointer(), BodyVar)
@@ -477,7 +477,7 @@ func (x *DBusMessage) SetBody(BodyVar *glib.Variant) {
 
 var xDBusMessageSetByteOrder func(uintptr, DBusMessageByteOrder)
 
-// Sets the byte order of @message.
+// Sets the byte order of message.
 func (x *DBusMessage) SetByteOrder(ByteOrderVar DBusMessageByteOrder) {
 
 	xDBusMessageSetByteOrder(x.GoPointer(), ByteOrderVar)
@@ -486,7 +486,7 @@ func (x *DBusMessage) SetByteOrder(ByteOrderVar DBusMessageByteOrder) {
 
 var xDBusMessageSetDestination func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_DESTINATION header field.
+// Convenience setter for the [GDbusMessageHeaderFieldDestinationValue] header field.
 func (x *DBusMessage) SetDestination(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -498,7 +498,7 @@ func (x *DBusMessage) SetDestination(ValueVar *string) {
 
 var xDBusMessageSetErrorName func(uintptr, string)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_ERROR_NAME header field.
+// Convenience setter for the [GDbusMessageHeaderFieldErrorNameValue] header field.
 func (x *DBusMessage) SetErrorName(ValueVar string) {
 
 	xDBusMessageSetErrorName(x.GoPointer(), ValueVar)
@@ -507,7 +507,7 @@ func (x *DBusMessage) SetErrorName(ValueVar string) {
 
 var xDBusMessageSetFlags func(uintptr, DBusMessageFlags)
 
-// Sets the flags to set on @message.
+// Sets the flags to set on message.
 func (x *DBusMessage) SetFlags(FlagsVar DBusMessageFlags) {
 
 	xDBusMessageSetFlags(x.GoPointer(), FlagsVar)
@@ -516,9 +516,9 @@ func (x *DBusMessage) SetFlags(FlagsVar DBusMessageFlags) {
 
 var xDBusMessageSetHeader func(uintptr, DBusMessageHeaderField, *glib.Variant)
 
-// Sets a header field on @message.
+// Sets a header field on message.
 //
-// If @value is floating, @message assumes ownership of @value.
+// If value is floating, message assumes ownership of value.
 func (x *DBusMessage) SetHeader(HeaderFieldVar DBusMessageHeaderField, ValueVar *glib.Variant) {
 
 	xDBusMessageSetHeader(x.GoPointer(), HeaderFieldVar, ValueVar)
@@ -527,7 +527,7 @@ func (x *DBusMessage) SetHeader(HeaderFieldVar DBusMessageHeaderField, ValueVar
 
 var xDBusMessageSetInterface func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_INTERFACE header field.
+// Convenience setter for the [GDbusMessageHeaderFieldInterfaceValue] header field.
 func (x *DBusMessage) SetInterface(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -539,7 +539,7 @@ func (x *DBusMessage) SetInterface(ValueVar *string) {
 
 var xDBusMessageSetMember func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_MEMBER header field.
+// Convenience setter for the [GDbusMessageHeaderFieldMemberValue] header field.
 func (x *DBusMessage) SetMember(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -551,7 +551,7 @@ func (x *DBusMessage) SetMember(ValueVar *string) {
 
 var xDBusMessageSetMessageType func(uintptr, DBusMessageType)
 
-// Sets @message to be of @type.
+// Sets message to be of type.
 func (x *DBusMessage) SetMessageType(TypeVar DBusMessageType) {
 
 	xDBusMessageSetMessageType(x.GoPointer(), TypeVar)
@@ -560,7 +560,7 @@ func (x *DBusMessage) SetMessageType(TypeVar DBusMessageType) {
 
 var xDBusMessageSetNumUnixFds func(uintptr, uint32)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_NUM_UNIX_FDS header field.
+// Convenience setter for the [GDbusMessageHeaderFieldNumUnixFdsValue] header field.
 func (x *DBusMessage) SetNumUnixFds(ValueVar uint32) {
 
 	xDBusMessageSetNumUnixFds(x.GoPointer(), ValueVar)
@@ -569,7 +569,7 @@ func (x *DBusMessage) SetNumUnixFds(ValueVar uint32) {
 
 var xDBusMessageSetPath func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_PATH header field.
+// Convenience setter for the [GDbusMessageHeaderFieldPathValue] header field.
 func (x *DBusMessage) SetPath(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -581,7 +581,7 @@ func (x *DBusMessage) SetPath(ValueVar *string) {
 
 var xDBusMessageSetReplySerial func(uintptr, uint32)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_REPLY_SERIAL header field.
+// Convenience setter for the [GDbusMessageHeaderFieldReplySerialValue] header field.
 func (x *DBusMessage) SetReplySerial(ValueVar uint32) {
 
 	xDBusMessageSetReplySerial(x.GoPointer(), ValueVar)
@@ -590,7 +590,7 @@ func (x *DBusMessage) SetReplySerial(ValueVar uint32) {
 
 var xDBusMessageSetSender func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_SENDER header field.
+// Convenience setter for the [GDbusMessageHeaderFieldSenderValue] header field.
 func (x *DBusMessage) SetSender(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -602,10 +602,12 @@ func (x *DBusMessage) SetSender(ValueVar *string) {
 
 var xDBusMessageSetSerial func(uintptr, uint32)
 
-// Sets the serial for @message.
+// Sets the serial for message.
+//
+// The [D-Bus specification]
+// does not allow the serial to be zero.
 //
-// The [D-Bus specification](https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-messages)
-// does not allow the @serial to be zero.
+// [D-Bus specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-messages
 func (x *DBusMessage) SetSerial(SerialVar uint32) {
 
 	xDBusMessageSetSerial(x.GoPointer(), SerialVar)
@@ -614,7 +616,7 @@ func (x *DBusMessage) SetSerial(SerialVar uint32) {
 
 var xDBusMessageSetSignature func(uintptr, uintptr)
 
-// Convenience setter for the %G_DBUS_MESSAGE_HEADER_FIELD_SIGNATURE header field.
+// Convenience setter for the [GDbusMessageHeaderFieldSignatureValue] header field.
 func (x *DBusMessage) SetSignature(ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -626,17 +628,17 @@ func (x *DBusMessage) SetSignature(ValueVar *string) {
 
 var xDBusMessageSetUnixFdList func(uintptr, uintptr)
 
-// Sets the UNIX file descriptors associated with @message. As a
-// side-effect the %G_DBUS_MESSAGE_HEADER_FIELD_NUM_UNIX_FDS header
-// field is set to the number of fds in @fd_list (or cleared if
-// @fd_list is %NULL).
+// Sets the UNIX file descriptors associated with message. As a
+// side-effect the [GDbusMessageHeaderFieldNumUnixFdsValue] header
+// field is set to the number of fds in fd_list (or cleared if
+// fd_list is nil).
 //
 // This method is only available on UNIX.
 //
 // When designing D-Bus APIs that are intended to be interoperable,
 // please note that non-GDBus implementations of D-Bus can usually only
 // access file descriptors if they are referenced by a value of type
-// %G_VARIANT_TYPE_HANDLE in the body of the message.
+// G_VARIANT_TYPE_HANDLE in the body of the message.
 func (x *DBusMessage) SetUnixFdList(FdListVar *UnixFDList) {
 
 	xDBusMessageSetUnixFdList(x.GoPointer(), FdListVar.GoPointer())
@@ -645,14 +647,14 @@ func (x *DBusMessage) SetUnixFdList(FdListVar *UnixFDList) {
 
 var xDBusMessageToBlob func(uintptr, *uint, DBusCapabilityFlags, **glib.Error) uintptr
 
-// Serializes @message to a blob. The byte order returned by
-// g_dbus_message_get_byte_order() will be used.
+// Serializes message to a blob. The byte order returned by
+// [DBusMessage.GetByteOrder] will be used.
 func (x *DBusMessage) ToBlob(OutSizeVar *uint, CapabilitiesVar DBusCapabilityFlags) (uintptr, error) {
 	var cerr *glib.Error
 
 	cret := xDBusMessageToBlob(x.GoPointer(), OutSizeVar, CapabilitiesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -660,19 +662,19 @@ func (x *DBusMessage) ToBlob(OutSizeVar *uint, CapabilitiesVar DBusCapabilityFla
 
 var xDBusMessageToGerror func(uintptr) bool
 
-// If @message is not of type %G_DBUS_MESSAGE_TYPE_ERROR does
-// nothing and returns %FALSE.
+// If message is not of type [GDbusMessageTypeErrorValue] does
+// nothing and returns false.
 //
-// Otherwise this method encodes the error in @message as a #GError
+// Otherwise this method encodes the error in message as a [glib.Error]
 // using g_dbus_error_set_dbus_error() using the information in the
-// %G_DBUS_MESSAGE_HEADER_FIELD_ERROR_NAME header field of @message as
-// well as the first string item in @message's body.
+// [GDbusMessageHeaderFieldErrorNameValue] header field of message as
+// well as the first string item in message's body.
 func (x *DBusMessage) ToGerror() (bool, error) {
 	var cerr *glib.Error
 
 	cret := xDBusMessageToGerror(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -699,13 +701,13 @@ func (x *DBusMessage) GetPropertyLocked() bool {
 var xDBusMessageBytesNeeded func([]byte, uint, **glib.Error) int
 
 // Utility function to calculate how many bytes are needed to
-// completely deserialize the D-Bus message stored at @blob.
+// completely deserialize the D-Bus message stored at blob.
 func DBusMessageBytesNeeded(BlobVar []byte, BlobLenVar uint) (int, error) {
 	var cerr *glib.Error
 
 	cret := xDBusMessageBytesNeeded(BlobVar, BlobLenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -716,7 +718,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -725,57 +727,57 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusMessageGLibType, libs, "g_dbus_message_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusMessage, libs, "g_dbus_message_new")
-	core.PuregoSafeRegister(&xNewDBusMessageFromBlob, libs, "g_dbus_message_new_from_blob")
-	core.PuregoSafeRegister(&xNewDBusMessageMethodCall, libs, "g_dbus_message_new_method_call")
-	core.PuregoSafeRegister(&xNewDBusMessageSignal, libs, "g_dbus_message_new_signal")
-
-	core.PuregoSafeRegister(&xDBusMessageCopy, libs, "g_dbus_message_copy")
-	core.PuregoSafeRegister(&xDBusMessageGetArg0, libs, "g_dbus_message_get_arg0")
-	core.PuregoSafeRegister(&xDBusMessageGetArg0Path, libs, "g_dbus_message_get_arg0_path")
-	core.PuregoSafeRegister(&xDBusMessageGetBody, libs, "g_dbus_message_get_body")
-	core.PuregoSafeRegister(&xDBusMessageGetByteOrder, libs, "g_dbus_message_get_byte_order")
-	core.PuregoSafeRegister(&xDBusMessageGetDestination, libs, "g_dbus_message_get_destination")
-	core.PuregoSafeRegister(&xDBusMessageGetErrorName, libs, "g_dbus_message_get_error_name")
-	core.PuregoSafeRegister(&xDBusMessageGetFlags, libs, "g_dbus_message_get_flags")
-	core.PuregoSafeRegister(&xDBusMessageGetHeader, libs, "g_dbus_message_get_header")
-	core.PuregoSafeRegister(&xDBusMessageGetHeaderFields, libs, "g_dbus_message_get_header_fields")
-	core.PuregoSafeRegister(&xDBusMessageGetInterface, libs, "g_dbus_message_get_interface")
-	core.PuregoSafeRegister(&xDBusMessageGetLocked, libs, "g_dbus_message_get_locked")
-	core.PuregoSafeRegister(&xDBusMessageGetMember, libs, "g_dbus_message_get_member")
-	core.PuregoSafeRegister(&xDBusMessageGetMessageType, libs, "g_dbus_message_get_message_type")
-	core.PuregoSafeRegister(&xDBusMessageGetNumUnixFds, libs, "g_dbus_message_get_num_unix_fds")
-	core.PuregoSafeRegister(&xDBusMessageGetPath, libs, "g_dbus_message_get_path")
-	core.PuregoSafeRegister(&xDBusMessageGetReplySerial, libs, "g_dbus_message_get_reply_serial")
-	core.PuregoSafeRegister(&xDBusMessageGetSender, libs, "g_dbus_message_get_sender")
-	core.PuregoSafeRegister(&xDBusMessageGetSerial, libs, "g_dbus_message_get_serial")
-	core.PuregoSafeRegister(&xDBusMessageGetSignature, libs, "g_dbus_message_get_signature")
-	core.PuregoSafeRegister(&xDBusMessageGetUnixFdList, libs, "g_dbus_message_get_unix_fd_list")
-	core.PuregoSafeRegister(&xDBusMessageLock, libs, "g_dbus_message_lock")
-	core.PuregoSafeRegister(&xDBusMessageNewMethodError, libs, "g_dbus_message_new_method_error")
-	core.PuregoSafeRegister(&xDBusMessageNewMethodErrorLiteral, libs, "g_dbus_message_new_method_error_literal")
-	core.PuregoSafeRegister(&xDBusMessageNewMethodErrorValist, libs, "g_dbus_message_new_method_error_valist")
-	core.PuregoSafeRegister(&xDBusMessageNewMethodReply, libs, "g_dbus_message_new_method_reply")
-	core.PuregoSafeRegister(&xDBusMessagePrint, libs, "g_dbus_message_print")
-	core.PuregoSafeRegister(&xDBusMessageSetBody, libs, "g_dbus_message_set_body")
-	core.PuregoSafeRegister(&xDBusMessageSetByteOrder, libs, "g_dbus_message_set_byte_order")
-	core.PuregoSafeRegister(&xDBusMessageSetDestination, libs, "g_dbus_message_set_destination")
-	core.PuregoSafeRegister(&xDBusMessageSetErrorName, libs, "g_dbus_message_set_error_name")
-	core.PuregoSafeRegister(&xDBusMessageSetFlags, libs, "g_dbus_message_set_flags")
-	core.PuregoSafeRegister(&xDBusMessageSetHeader, libs, "g_dbus_message_set_header")
-	core.PuregoSafeRegister(&xDBusMessageSetInterface, libs, "g_dbus_message_set_interface")
-	core.PuregoSafeRegister(&xDBusMessageSetMember, libs, "g_dbus_message_set_member")
-	core.PuregoSafeRegister(&xDBusMessageSetMessageType, libs, "g_dbus_message_set_message_type")
-	core.PuregoSafeRegister(&xDBusMessageSetNumUnixFds, libs, "g_dbus_message_set_num_unix_fds")
-	core.PuregoSafeRegister(&xDBusMessageSetPath, libs, "g_dbus_message_set_path")
-	core.PuregoSafeRegister(&xDBusMessageSetReplySerial, libs, "g_dbus_message_set_reply_serial")
-	core.PuregoSafeRegister(&xDBusMessageSetSender, libs, "g_dbus_message_set_sender")
-	core.PuregoSafeRegister(&xDBusMessageSetSerial, libs, "g_dbus_message_set_serial")
-	core.PuregoSafeRegister(&xDBusMessageSetSignature, libs, "g_dbus_message_set_signature")
-	core.PuregoSafeRegister(&xDBusMessageSetUnixFdList, libs, "g_dbus_message_set_unix_fd_list")
-	core.PuregoSafeRegister(&xDBusMessageToBlob, libs, "g_dbus_message_to_blob")
-	core.PuregoSafeRegister(&xDBusMessageToGerror, libs, "g_dbus_message_to_gerror")
-
-	core.PuregoSafeRegister(&xDBusMessageBytesNeeded, libs, "g_dbus_message_bytes_needed")
+	core.PuregoRegisterSince(&xNewDBusMessage, libs, "g_dbus_message_new", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusMessageFromBlob, libs, "g_dbus_message_new_from_blob", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusMessageMethodCall, libs, "g_dbus_message_new_method_call", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusMessageSignal, libs, "g_dbus_message_new_signal", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusMessageCopy, libs, "g_dbus_message_copy", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetArg0, libs, "g_dbus_message_get_arg0", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetArg0Path, libs, "g_dbus_message_get_arg0_path", "GIO", "2.80")
+	core.PuregoRegisterSince(&xDBusMessageGetBody, libs, "g_dbus_message_get_body", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetByteOrder, libs, "g_dbus_message_get_byte_order", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetDestination, libs, "g_dbus_message_get_destination", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetErrorName, libs, "g_dbus_message_get_error_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetFlags, libs, "g_dbus_message_get_flags", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetHeader, libs, "g_dbus_message_get_header", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetHeaderFields, libs, "g_dbus_message_get_header_fields", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetInterface, libs, "g_dbus_message_get_interface", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetLocked, libs, "g_dbus_message_get_locked", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetMember, libs, "g_dbus_message_get_member", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetMessageType, libs, "g_dbus_message_get_message_type", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetNumUnixFds, libs, "g_dbus_message_get_num_unix_fds", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetPath, libs, "g_dbus_message_get_path", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetReplySerial, libs, "g_dbus_message_get_reply_serial", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetSender, libs, "g_dbus_message_get_sender", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetSerial, libs, "g_dbus_message_get_serial", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetSignature, libs, "g_dbus_message_get_signature", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageGetUnixFdList, libs, "g_dbus_message_get_unix_fd_list", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageLock, libs, "g_dbus_message_lock", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageNewMethodError, libs, "g_dbus_message_new_method_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageNewMethodErrorLiteral, libs, "g_dbus_message_new_method_error_literal", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageNewMethodErrorValist, libs, "g_dbus_message_new_method_error_valist", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageNewMethodReply, libs, "g_dbus_message_new_method_reply", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessagePrint, libs, "g_dbus_message_print", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetBody, libs, "g_dbus_message_set_body", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetByteOrder, libs, "g_dbus_message_set_byte_order", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetDestination, libs, "g_dbus_message_set_destination", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetErrorName, libs, "g_dbus_message_set_error_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetFlags, libs, "g_dbus_message_set_flags", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetHeader, libs, "g_dbus_message_set_header", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetInterface, libs, "g_dbus_message_set_interface", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetMember, libs, "g_dbus_message_set_member", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetMessageType, libs, "g_dbus_message_set_message_type", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetNumUnixFds, libs, "g_dbus_message_set_num_unix_fds", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetPath, libs, "g_dbus_message_set_path", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetReplySerial, libs, "g_dbus_message_set_reply_serial", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetSender, libs, "g_dbus_message_set_sender", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetSerial, libs, "g_dbus_message_set_serial", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetSignature, libs, "g_dbus_message_set_signature", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageSetUnixFdList, libs, "g_dbus_message_set_unix_fd_list", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageToBlob, libs, "g_dbus_message_to_blob", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMessageToGerror, libs, "g_dbus_message_to_gerror", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusMessageBytesNeeded, libs, "g_dbus_message_bytes_needed", "GIO", "2.26")
 
 }
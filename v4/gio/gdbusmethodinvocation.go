@@ -2,7 +2,8 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,24 +13,22 @@ import (
 const (
 	// The value returned by handlers of the signals generated by
 	// the `gdbus-codegen` tool to indicate that a method call has been
-	// handled by an implementation. It is equal to %TRUE, but using
+	// handled by an implementation. It is equal to true, but using
 	// this macro is sometimes more readable.
 	//
 	// In code that needs to be backwards-compatible with older GLib,
-	// use %TRUE instead, often written like this:
+	// use true instead, often written like this:
 	//
-	// |[
-	//   g_dbus_method_invocation_return_error (invocation, ...);
-	//   return TRUE;    // handled
-	// ]|
+	// 	  g_dbus_method_invocation_return_error (invocation, ...);
+	// 	  return TRUE;    // handled
 	DBUS_METHOD_INVOCATION_HANDLED bool = true
 	// The value returned by handlers of the signals generated by
 	// the `gdbus-codegen` tool to indicate that a method call has not been
-	// handled by an implementation. It is equal to %FALSE, but using
+	// handled by an implementation. It is equal to false, but using
 	// this macro is sometimes more readable.
 	//
 	// In code that needs to be backwards-compatible with older GLib,
-	// use %FALSE instead.
+	// use false instead.
 	DBUS_METHOD_INVOCATION_UNHANDLED bool = false
 )
 
@@ -39,8 +38,8 @@ const (
 //
 // The normal way to obtain a `GDBusMethodInvocation` object is to receive
 // it as an argument to the `handle_method_call()` function in a
-// [type@Gio.DBusInterfaceVTable] that was passed to
-// [method@Gio.DBusConnection.register_object].
+// [DBusInterfaceVTable] that was passed to
+// [DBusConnection.RegisterObject].
 type DBusMethodInvocation struct {
 	gobject.Object
 }
@@ -59,7 +58,7 @@ func DBusMethodInvocationNewFromInternalPtr(ptr uintptr) *DBusMethodInvocation {
 
 var xDBusMethodInvocationGetConnection func(uintptr) uintptr
 
-// Gets the #GDBusConnection the method was invoked on.
+// Gets the [DBusConnection] the method was invoked on.
 func (x *DBusMethodInvocation) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -79,14 +78,16 @@ var xDBusMethodInvocationGetInterfaceName func(uintptr) string
 // Gets the name of the D-Bus interface the method was invoked on.
 //
 // This can be `NULL` if it was not specified by the sender. See
-// [callback@Gio.DBusInterfaceMethodCallFunc] or the
-// [D-Bus Specification](https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-types-method)
+// [DBusInterfaceMethodCallFunc] or the
+// [D-Bus Specification]
 // for details on when this can happen and how it should be handled.
 //
 // If this method call is a property Get, Set or GetAll call that has
 // been redirected to the method call handler then
 // "org.freedesktop.DBus.Properties" will be returned.  See
-// #GDBusInterfaceVTable for more information.
+// [DBusInterfaceVTable] for more information.
+//
+// [D-Bus Specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-protocol-types-method
 func (x *DBusMethodInvocation) GetInterfaceName() string {
 
 	cret := xDBusMethodInvocationGetInterfaceName(x.GoPointer())
@@ -95,10 +96,10 @@ func (x *DBusMethodInvocation) GetInterfaceName() string {
 
 var xDBusMethodInvocationGetMessage func(uintptr) uintptr
 
-// Gets the #GDBusMessage for the method invocation. This is useful if
+// Gets the [DBusMessage] for the method invocation. This is useful if
 // you need to use low-level protocol features, such as UNIX file
 // descriptor passing, that cannot be properly expressed in the
-// #GVariant API.
+// [glib.Variant] API.
 //
 // See this [server][class@Gio.DBusConnection#an-example-d-bus-server]
 // and [client][class@Gio.DBusConnection#an-example-for-file-descriptor-passing]
@@ -123,9 +124,9 @@ var xDBusMethodInvocationGetMethodInfo func(uintptr) *DBusMethodInfo
 // Gets information about the method call, if any.
 //
 // If this method invocation is a property Get, Set or GetAll call that
-// has been redirected to the method call handler then %NULL will be
-// returned.  See g_dbus_method_invocation_get_property_info() and
-// #GDBusInterfaceVTable for more information.
+// has been redirected to the method call handler then nil will be
+// returned.  See [DBusMethodInvocation.GetPropertyInfo] and
+// [DBusInterfaceVTable] for more information.
 func (x *DBusMethodInvocation) GetMethodInfo() *DBusMethodInfo {
 
 	cret := xDBusMethodInvocationGetMethodInfo(x.GoPointer())
@@ -170,9 +171,9 @@ var xDBusMethodInvocationGetPropertyInfo func(uintptr) *DBusPropertyInfo
 // handler for an object on account of its property_get() or
 // property_set() vtable pointers being unset.
 //
-// See #GDBusInterfaceVTable for more information.
+// See [DBusInterfaceVTable] for more information.
 //
-// If the call was GetAll, %NULL will be returned.
+// If the call was GetAll, nil will be returned.
 func (x *DBusMethodInvocation) GetPropertyInfo() *DBusPropertyInfo {
 
 	cret := xDBusMethodInvocationGetPropertyInfo(x.GoPointer())
@@ -183,7 +184,7 @@ var xDBusMethodInvocationGetSender func(uintptr) string
 
 // Gets the bus name that invoked the method.
 //
-// This can return %NULL if not specified by the caller, e.g. on peer-to-peer
+// This can return nil if not specified by the caller, e.g. on peer-to-peer
 // connections.
 func (x *DBusMethodInvocation) GetSender() string {
 
@@ -193,7 +194,7 @@ func (x *DBusMethodInvocation) GetSender() string {
 
 var xDBusMethodInvocationGetUserData func(uintptr) uintptr
 
-// Gets the @user_data #gpointer passed to g_dbus_connection_register_object().
+// Gets the user_data #gpointer passed to [DBusConnection.RegisterObject].
 func (x *DBusMethodInvocation) GetUserData() uintptr {
 
 	cret := xDBusMethodInvocationGetUserData(x.GoPointer())
@@ -204,9 +205,9 @@ var xDBusMethodInvocationReturnDbusError func(uintptr, string, string)
 
 // Finishes handling a D-Bus method call by returning an error.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) ReturnDbusError(ErrorNameVar string, ErrorMessageVar string) {
 
 	xDBusMethodInvocationReturnDbusError(x.GoPointer(), ErrorNameVar, ErrorMessageVar)
@@ -217,37 +218,39 @@ var xDBusMethodInvocationReturnError func(uintptr, glib.Quark, int, string, ...i
 
 // Finishes handling a D-Bus method call by returning an error.
 //
-// See g_dbus_error_encode_gerror() for details about what error name
+// See [DBusErrorEncodeGerror] for details about what error name
 // will be returned on the wire. In a nutshell, if the given error is
-// registered using g_dbus_error_register_error() the name given
+// registered using [DBusErrorRegisterError] the name given
 // during registration is used. Otherwise, a name of the form
 // `org.gtk.GDBus.UnmappedGError.Quark...` is used. This provides
-// transparent mapping of #GError between applications using GDBus.
+// transparent mapping of [glib.Error] between applications using GDBus.
 //
 // If you are writing an application intended to be portable,
-// always register errors with g_dbus_error_register_error()
-// or use g_dbus_method_invocation_return_dbus_error().
+// always register errors with [DBusErrorRegisterError]
+// or use [DBusMethodInvocation.ReturnDbusError].
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 //
 // Since 2.48, if the method call requested for a reply not to be sent
-// then this call will free @invocation but otherwise do nothing (as per
+// then this call will free invocation but otherwise do nothing (as per
 // the recommendations of the D-Bus specification).
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *DBusMethodInvocation) ReturnError(DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
 
-	xDBusMethodInvocationReturnError(x.GoPointer(), DomainVar, CodeVar, FormatVar, varArgs...)
+	xDBusMethodInvocationReturnError(x.GoPointer(), DomainVar, CodeVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xDBusMethodInvocationReturnErrorLiteral func(uintptr, glib.Quark, int, string)
 
-// Like g_dbus_method_invocation_return_error() but without printf()-style formatting.
+// Like [DBusMethodInvocation.ReturnError] but without printf()-style formatting.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) ReturnErrorLiteral(DomainVar glib.Quark, CodeVar int, MessageVar string) {
 
 	xDBusMethodInvocationReturnErrorLiteral(x.GoPointer(), DomainVar, CodeVar, MessageVar)
@@ -256,12 +259,12 @@ func (x *DBusMethodInvocation) ReturnErrorLiteral(DomainVar glib.Quark, CodeVar
 
 var xDBusMethodInvocationReturnErrorValist func(uintptr, glib.Quark, int, string, []interface{})
 
-// Like g_dbus_method_invocation_return_error() but intended for
+// Like [DBusMethodInvocation.ReturnError] but intended for
 // language bindings.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) ReturnErrorValist(DomainVar glib.Quark, CodeVar int, FormatVar string, VarArgsVar []interface{}) {
 
 	xDBusMethodInvocationReturnErrorValist(x.GoPointer(), DomainVar, CodeVar, FormatVar, VarArgsVar)
@@ -270,12 +273,12 @@ func (x *DBusMethodInvocation) ReturnErrorValist(DomainVar glib.Quark, CodeVar i
 
 var xDBusMethodInvocationReturnGerror func(uintptr, *glib.Error)
 
-// Like g_dbus_method_invocation_return_error() but takes a #GError
+// Like [DBusMethodInvocation.ReturnError] but takes a [glib.Error]
 // instead of the error domain, error code and message.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) ReturnGerror(ErrorVar *glib.Error) {
 
 	xDBusMethodInvocationReturnGerror(x.GoPointer(), ErrorVar)
@@ -284,39 +287,34 @@ func (x *DBusMethodInvocation) ReturnGerror(ErrorVar *glib.Error) {
 
 var xDBusMethodInvocationReturnValue func(uintptr, *glib.Variant)
 
-// Finishes handling a D-Bus method call by returning @parameters.
-// If the @parameters GVariant is floating, it is consumed.
+// Finishes handling a D-Bus method call by returning parameters.
+// If the parameters GVariant is floating, it is consumed.
 //
-// It is an error if @parameters is not of the right format: it must be a tuple
+// It is an error if parameters is not of the right format: it must be a tuple
 // containing the out-parameters of the D-Bus method. Even if the method has a
 // single out-parameter, it must be contained in a tuple. If the method has no
-// out-parameters, @parameters may be %NULL or an empty tuple.
-//
-// |[&lt;!-- language="C" --&gt;
-// GDBusMethodInvocation *invocation = some_invocation;
-// g_autofree gchar *result_string = NULL;
-// g_autoptr (GError) error = NULL;
-//
-// result_string = calculate_result (&amp;error);
-//
-// if (error != NULL)
+// out-parameters, parameters may be nil or an empty tuple.
 //
-//	g_dbus_method_invocation_return_gerror (invocation, error);
+//	GDBusMethodInvocation *invocation = some_invocation;
+//	g_autofree gchar *result_string = NULL;
+//	g_autoptr (GError) error = NULL;
 //
-// else
+//	result_string = calculate_result (&error);
 //
-//	g_dbus_method_invocation_return_value (invocation,
-//	                                       g_variant_new ("(s)", result_string));
+//	if (error != NULL)
+//	  g_dbus_method_invocation_return_gerror (invocation, error);
+//	else
+//	  g_dbus_method_invocation_return_value (invocation,
+//	                                         g_variant_new ("(s)", result_string));
 //
-// // Do not free @invocation here; returning a value does that
-// ]|
+//	// Do not free @invocation here; returning a value does that
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 //
 // Since 2.48, if the method call requested for a reply not to be sent
-// then this call will sink @parameters and free @invocation, but
+// then this call will sink parameters and free invocation, but
 // otherwise do nothing (as per the recommendations of the D-Bus
 // specification).
 func (x *DBusMethodInvocation) ReturnValue(ParametersVar *glib.Variant) {
@@ -327,30 +325,30 @@ func (x *DBusMethodInvocation) ReturnValue(ParametersVar *glib.Variant) {
 
 var xDBusMethodInvocationReturnValueWithUnixFdList func(uintptr, *glib.Variant, uintptr)
 
-// Like g_dbus_method_invocation_return_value() but also takes a #GUnixFDList.
+// Like [DBusMethodInvocation.ReturnValue] but also takes a [UnixFDList].
 //
 // This method is only available on UNIX.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) ReturnValueWithUnixFdList(ParametersVar *glib.Variant, FdListVar *UnixFDList) {
 
 	xDBusMethodInvocationReturnValueWithUnixFdList(x.GoPointer(), ParametersVar, FdListVar.GoPointer())
 
 }
 
-var xDBusMethodInvocationTakeError func(uintptr, *glib.Error)
+var xDBusMethodInvocationTakeError func(uintptr, uintptr)
 
-// Like g_dbus_method_invocation_return_gerror() but takes ownership
-// of @error so the caller does not need to free it.
+// Like [DBusMethodInvocation.ReturnGerror] but takes ownership
+// of error so the caller does not need to free it.
 //
-// This method will take ownership of @invocation. See
-// #GDBusInterfaceVTable for more information about the ownership of
-// @invocation.
+// This method will take ownership of invocation. See
+// [DBusInterfaceVTable] for more information about the ownership of
+// invocation.
 func (x *DBusMethodInvocation) TakeError(ErrorVar *glib.Error) {
 
-	xDBusMethodInvocationTakeError(x.GoPointer(), ErrorVar)
+	xDBusMethodInvocationTakeError(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
@@ -370,7 +368,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -379,23 +377,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusMethodInvocationGLibType, libs, "g_dbus_method_invocation_get_type")
 
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetConnection, libs, "g_dbus_method_invocation_get_connection")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetInterfaceName, libs, "g_dbus_method_invocation_get_interface_name")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetMessage, libs, "g_dbus_method_invocation_get_message")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetMethodInfo, libs, "g_dbus_method_invocation_get_method_info")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetMethodName, libs, "g_dbus_method_invocation_get_method_name")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetObjectPath, libs, "g_dbus_method_invocation_get_object_path")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetParameters, libs, "g_dbus_method_invocation_get_parameters")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetPropertyInfo, libs, "g_dbus_method_invocation_get_property_info")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetSender, libs, "g_dbus_method_invocation_get_sender")
-	core.PuregoSafeRegister(&xDBusMethodInvocationGetUserData, libs, "g_dbus_method_invocation_get_user_data")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnDbusError, libs, "g_dbus_method_invocation_return_dbus_error")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnError, libs, "g_dbus_method_invocation_return_error")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnErrorLiteral, libs, "g_dbus_method_invocation_return_error_literal")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnErrorValist, libs, "g_dbus_method_invocation_return_error_valist")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnGerror, libs, "g_dbus_method_invocation_return_gerror")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnValue, libs, "g_dbus_method_invocation_return_value")
-	core.PuregoSafeRegister(&xDBusMethodInvocationReturnValueWithUnixFdList, libs, "g_dbus_method_invocation_return_value_with_unix_fd_list")
-	core.PuregoSafeRegister(&xDBusMethodInvocationTakeError, libs, "g_dbus_method_invocation_take_error")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetConnection, libs, "g_dbus_method_invocation_get_connection", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetInterfaceName, libs, "g_dbus_method_invocation_get_interface_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetMessage, libs, "g_dbus_method_invocation_get_message", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetMethodInfo, libs, "g_dbus_method_invocation_get_method_info", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetMethodName, libs, "g_dbus_method_invocation_get_method_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetObjectPath, libs, "g_dbus_method_invocation_get_object_path", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetParameters, libs, "g_dbus_method_invocation_get_parameters", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetPropertyInfo, libs, "g_dbus_method_invocation_get_property_info", "GIO", "2.38")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetSender, libs, "g_dbus_method_invocation_get_sender", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationGetUserData, libs, "g_dbus_method_invocation_get_user_data", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnDbusError, libs, "g_dbus_method_invocation_return_dbus_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnError, libs, "g_dbus_method_invocation_return_error", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnErrorLiteral, libs, "g_dbus_method_invocation_return_error_literal", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnErrorValist, libs, "g_dbus_method_invocation_return_error_valist", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnGerror, libs, "g_dbus_method_invocation_return_gerror", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnValue, libs, "g_dbus_method_invocation_return_value", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusMethodInvocationReturnValueWithUnixFdList, libs, "g_dbus_method_invocation_return_value_with_unix_fd_list", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusMethodInvocationTakeError, libs, "g_dbus_method_invocation_take_error", "GIO", "2.30")
 
 }
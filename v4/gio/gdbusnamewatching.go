@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,9 +14,9 @@ type BusNameAppearedCallback func(uintptr, string, string, uintptr)
 
 // Invoked when the name being watched is known not to have to have an owner.
 //
-// This is also invoked when the #GDBusConnection on which the watch was
-// established has been closed.  In that case, @connection will be
-// %NULL.
+// This is also invoked when the [DBusConnection] on which the watch was
+// established has been closed.  In that case, connection will be
+// nil.
 type BusNameVanishedCallback func(uintptr, string, uintptr)
 
 var xBusUnwatchName func(uint)
@@ -25,10 +24,10 @@ var xBusUnwatchName func(uint)
 // Stops watching a name.
 //
 // Note that there may still be D-Bus traffic to process (relating to watching
-// and unwatching the name) in the current thread-default #GMainContext after
-// this function has returned. You should continue to iterate the #GMainContext
-// until the #GDestroyNotify function passed to g_bus_watch_name() is called, in
-// order to avoid memory leaks through callbacks queued on the #GMainContext
+// and unwatching the name) in the current thread-default [glib.MainContext] after
+// this function has returned. You should continue to iterate the [glib.MainContext]
+// until the [glib.DestroyNotify] function passed to [BusWatchName] is called, in
+// order to avoid memory leaks through callbacks queued on the [glib.MainContext]
 // after it’s stopped being iterated.
 func BusUnwatchName(WatcherIdVar uint) {
 
@@ -38,35 +37,37 @@ func BusUnwatchName(WatcherIdVar uint) {
 
 var xBusWatchName func(BusType, string, BusNameWatcherFlags, uintptr, uintptr, uintptr, uintptr) uint
 
-// Starts watching @name on the bus specified by @bus_type and calls
-// @name_appeared_handler and @name_vanished_handler when the name is
+// Starts watching name on the bus specified by bus_type and calls
+// name_appeared_handler and name_vanished_handler when the name is
 // known to have an owner respectively known to lose its
 // owner. Callbacks will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this function from.
 //
 // You are guaranteed that one of the handlers will be invoked after
 // calling this function. When you are done watching the name, just
-// call g_bus_unwatch_name() with the watcher id this function
+// call [BusUnwatchName] with the watcher id this function
 // returns.
 //
 // If the name vanishes or appears (for example the application owning
 // the name could restart), the handlers are also invoked. If the
-// #GDBusConnection that is used for watching the name disconnects, then
-// @name_vanished_handler is invoked since it is no longer
+// [DBusConnection] that is used for watching the name disconnects, then
+// name_vanished_handler is invoked since it is no longer
 // possible to access the name.
 //
-// Another guarantee is that invocations of @name_appeared_handler
-// and @name_vanished_handler are guaranteed to alternate; that
-// is, if @name_appeared_handler is invoked then you are
+// Another guarantee is that invocations of name_appeared_handler
+// and name_vanished_handler are guaranteed to alternate; that
+// is, if name_appeared_handler is invoked then you are
 // guaranteed that the next time one of the handlers is invoked, it
-// will be @name_vanished_handler. The reverse is also true.
+// will be name_vanished_handler. The reverse is also true.
 //
 // This behavior makes it very simple to write applications that want
-// to take action when a certain [name exists](dbus-name-watching.html#d-bus-name-watching).
+// to take action when a certain name exists.
 // Basically, the application should create object proxies in
-// @name_appeared_handler and destroy them again (if any) in
-// @name_vanished_handler.
+// name_appeared_handler and destroy them again (if any) in
+// name_vanished_handler.
+//
+// Deprecated: use [BusWatchNameWithClosures], the version of this function that language bindings are meant to use.
 func BusWatchName(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFlags, NameAppearedHandlerVar *BusNameAppearedCallback, NameVanishedHandlerVar *BusNameVanishedCallback, UserDataVar uintptr, UserDataFreeFuncVar *glib.DestroyNotify) uint {
 
 	var NameAppearedHandlerVarRef uintptr
@@ -76,11 +77,12 @@ func BusWatchName(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFla
 			NameAppearedHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NameAppearedHandlerVar
 				cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			NameAppearedHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NameAppearedHandlerVarPtr, NameAppearedHandlerVarRef, NameAppearedHandlerVar)
+			NameAppearedHandlerVarRef = core.NewCallback(fcb)
+			NameAppearedHandlerVarRef = glib.SaveCallbackWithClosure(NameAppearedHandlerVarPtr, NameAppearedHandlerVarRef, NameAppearedHandlerVar)
 		}
 	}
 
@@ -91,11 +93,12 @@ func BusWatchName(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFla
 			NameVanishedHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NameVanishedHandlerVar
 				cbFn(arg0, core.GoString(arg1), arg2)
 			}
-			NameVanishedHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NameVanishedHandlerVarPtr, NameVanishedHandlerVarRef, NameVanishedHandlerVar)
+			NameVanishedHandlerVarRef = core.NewCallback(fcb)
+			NameVanishedHandlerVarRef = glib.SaveCallbackWithClosure(NameVanishedHandlerVarPtr, NameVanishedHandlerVarRef, NameVanishedHandlerVar)
 		}
 	}
 
@@ -106,11 +109,12 @@ func BusWatchName(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFla
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -120,8 +124,10 @@ func BusWatchName(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFla
 
 var xBusWatchNameOnConnection func(uintptr, string, BusNameWatcherFlags, uintptr, uintptr, uintptr, uintptr) uint
 
-// Like g_bus_watch_name() but takes a #GDBusConnection instead of a
-// #GBusType.
+// Like [BusWatchName] but takes a [DBusConnection] instead of a
+// [BusType].
+//
+// Deprecated: use [BusWatchNameOnConnectionWithClosures], the version of this function that language bindings are meant to use.
 func BusWatchNameOnConnection(ConnectionVar *DBusConnection, NameVar string, FlagsVar BusNameWatcherFlags, NameAppearedHandlerVar *BusNameAppearedCallback, NameVanishedHandlerVar *BusNameVanishedCallback, UserDataVar uintptr, UserDataFreeFuncVar *glib.DestroyNotify) uint {
 
 	var NameAppearedHandlerVarRef uintptr
@@ -131,11 +137,12 @@ func BusWatchNameOnConnection(ConnectionVar *DBusConnection, NameVar string, Fla
 			NameAppearedHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NameAppearedHandlerVar
 				cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			NameAppearedHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NameAppearedHandlerVarPtr, NameAppearedHandlerVarRef, NameAppearedHandlerVar)
+			NameAppearedHandlerVarRef = core.NewCallback(fcb)
+			NameAppearedHandlerVarRef = glib.SaveCallbackWithClosure(NameAppearedHandlerVarPtr, NameAppearedHandlerVarRef, NameAppearedHandlerVar)
 		}
 	}
 
@@ -146,11 +153,12 @@ func BusWatchNameOnConnection(ConnectionVar *DBusConnection, NameVar string, Fla
 			NameVanishedHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NameVanishedHandlerVar
 				cbFn(arg0, core.GoString(arg1), arg2)
 			}
-			NameVanishedHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NameVanishedHandlerVarPtr, NameVanishedHandlerVarRef, NameVanishedHandlerVar)
+			NameVanishedHandlerVarRef = core.NewCallback(fcb)
+			NameVanishedHandlerVarRef = glib.SaveCallbackWithClosure(NameVanishedHandlerVarPtr, NameVanishedHandlerVarRef, NameVanishedHandlerVar)
 		}
 	}
 
@@ -161,11 +169,12 @@ func BusWatchNameOnConnection(ConnectionVar *DBusConnection, NameVar string, Fla
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -175,7 +184,7 @@ func BusWatchNameOnConnection(ConnectionVar *DBusConnection, NameVar string, Fla
 
 var xBusWatchNameOnConnectionWithClosures func(uintptr, string, BusNameWatcherFlags, *gobject.Closure, *gobject.Closure) uint
 
-// Version of g_bus_watch_name_on_connection() using closures instead of callbacks for
+// Version of [BusWatchNameOnConnection] using closures instead of callbacks for
 // easier binding in other languages.
 func BusWatchNameOnConnectionWithClosures(ConnectionVar *DBusConnection, NameVar string, FlagsVar BusNameWatcherFlags, NameAppearedClosureVar *gobject.Closure, NameVanishedClosureVar *gobject.Closure) uint {
 
@@ -185,7 +194,7 @@ func BusWatchNameOnConnectionWithClosures(ConnectionVar *DBusConnection, NameVar
 
 var xBusWatchNameWithClosures func(BusType, string, BusNameWatcherFlags, *gobject.Closure, *gobject.Closure) uint
 
-// Version of g_bus_watch_name() using closures instead of callbacks for
+// Version of [BusWatchName] using closures instead of callbacks for
 // easier binding in other languages.
 func BusWatchNameWithClosures(BusTypeVar BusType, NameVar string, FlagsVar BusNameWatcherFlags, NameAppearedClosureVar *gobject.Closure, NameVanishedClosureVar *gobject.Closure) uint {
 
@@ -198,17 +207,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBusUnwatchName, libs, "g_bus_unwatch_name")
-	core.PuregoSafeRegister(&xBusWatchName, libs, "g_bus_watch_name")
-	core.PuregoSafeRegister(&xBusWatchNameOnConnection, libs, "g_bus_watch_name_on_connection")
-	core.PuregoSafeRegister(&xBusWatchNameOnConnectionWithClosures, libs, "g_bus_watch_name_on_connection_with_closures")
-	core.PuregoSafeRegister(&xBusWatchNameWithClosures, libs, "g_bus_watch_name_with_closures")
+	core.PuregoRegisterSince(&xBusUnwatchName, libs, "g_bus_unwatch_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusWatchName, libs, "g_bus_watch_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusWatchNameOnConnection, libs, "g_bus_watch_name_on_connection", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusWatchNameOnConnectionWithClosures, libs, "g_bus_watch_name_on_connection_with_closures", "GIO", "2.26")
+	core.PuregoRegisterSince(&xBusWatchNameWithClosures, libs, "g_bus_watch_name_with_closures", "GIO", "2.26")
 
 }
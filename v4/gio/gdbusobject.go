@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type DBusObjectIface struct {
 	_ structs.HostLayout
 
-	ParentIface uintptr
+	ParentIface gobject.TypeInterface
 
 	xGetObjectPath uintptr
 
@@ -33,62 +33,65 @@ func (x *DBusObjectIface) GoPointer() uintptr {
 }
 
 // OverrideGetObjectPath sets the "get_object_path" callback function.
-// Returns the object path. See g_dbus_object_get_object_path().
+// Returns the object path. See [DBusObject.GetObjectPath].
 func (x *DBusObjectIface) OverrideGetObjectPath(cb func(DBusObject) string) {
 	if cb == nil {
 		x.xGetObjectPath = 0
 	} else {
-		x.xGetObjectPath = purego.NewCallback(func(ObjectVarp uintptr) string {
+		x.xGetObjectPath = core.NewCallback(func(ObjectVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&DBusObjectBase{Ptr: ObjectVarp})
 		})
 	}
 }
 
 // GetGetObjectPath gets the "get_object_path" callback function.
-// Returns the object path. See g_dbus_object_get_object_path().
+// Returns the object path. See [DBusObject.GetObjectPath].
 func (x *DBusObjectIface) GetGetObjectPath() func(DBusObject) string {
 	if x.xGetObjectPath == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetObjectPath)
+	core.RegisterFunc(&rawCallback, x.xGetObjectPath)
 	return func(ObjectVar DBusObject) string {
 		return rawCallback(ObjectVar.GoPointer())
 	}
 }
 
 // OverrideGetInterfaces sets the "get_interfaces" callback function.
-// Returns all interfaces. See g_dbus_object_get_interfaces().
+// Returns all interfaces. See [DBusObject.GetInterfaces].
 func (x *DBusObjectIface) OverrideGetInterfaces(cb func(DBusObject) *glib.List) {
 	if cb == nil {
 		x.xGetInterfaces = 0
 	} else {
-		x.xGetInterfaces = purego.NewCallback(func(ObjectVarp uintptr) *glib.List {
+		x.xGetInterfaces = core.NewCallback(func(ObjectVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(&DBusObjectBase{Ptr: ObjectVarp})
 		})
 	}
 }
 
 // GetGetInterfaces gets the "get_interfaces" callback function.
-// Returns all interfaces. See g_dbus_object_get_interfaces().
+// Returns all interfaces. See [DBusObject.GetInterfaces].
 func (x *DBusObjectIface) GetGetInterfaces() func(DBusObject) *glib.List {
 	if x.xGetInterfaces == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetInterfaces)
+	core.RegisterFunc(&rawCallback, x.xGetInterfaces)
 	return func(ObjectVar DBusObject) *glib.List {
 		return rawCallback(ObjectVar.GoPointer())
 	}
 }
 
 // OverrideGetInterface sets the "get_interface" callback function.
-// Returns an interface by name. See g_dbus_object_get_interface().
+// Returns an interface by name. See [DBusObject.GetInterface].
 func (x *DBusObjectIface) OverrideGetInterface(cb func(DBusObject, string) *DBusInterfaceBase) {
 	if cb == nil {
 		x.xGetInterface = 0
 	} else {
-		x.xGetInterface = purego.NewCallback(func(ObjectVarp uintptr, InterfaceNameVarp string) uintptr {
+		x.xGetInterface = core.NewCallback(func(ObjectVarp uintptr, InterfaceNameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DBusObjectBase{Ptr: ObjectVarp}, InterfaceNameVarp)
 			if ret == nil {
 				return 0
@@ -99,13 +102,13 @@ func (x *DBusObjectIface) OverrideGetInterface(cb func(DBusObject, string) *DBus
 }
 
 // GetGetInterface gets the "get_interface" callback function.
-// Returns an interface by name. See g_dbus_object_get_interface().
+// Returns an interface by name. See [DBusObject.GetInterface].
 func (x *DBusObjectIface) GetGetInterface() func(DBusObject, string) *DBusInterfaceBase {
 	if x.xGetInterface == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, InterfaceNameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetInterface)
+	core.RegisterFunc(&rawCallback, x.xGetInterface)
 	return func(ObjectVar DBusObject, InterfaceNameVar string) *DBusInterfaceBase {
 		rawRet := rawCallback(ObjectVar.GoPointer(), InterfaceNameVar)
 		if rawRet == 0 {
@@ -118,58 +121,60 @@ func (x *DBusObjectIface) GetGetInterface() func(DBusObject, string) *DBusInterf
 }
 
 // OverrideInterfaceAdded sets the "interface_added" callback function.
-// Signal handler for the #GDBusObject::interface-added signal.
+// Signal handler for the GDBusObject::interface-added signal.
 func (x *DBusObjectIface) OverrideInterfaceAdded(cb func(DBusObject, DBusInterface)) {
 	if cb == nil {
 		x.xInterfaceAdded = 0
 	} else {
-		x.xInterfaceAdded = purego.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr) {
+		x.xInterfaceAdded = core.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectBase{Ptr: ObjectVarp}, &DBusInterfaceBase{Ptr: InterfaceVarp})
 		})
 	}
 }
 
 // GetInterfaceAdded gets the "interface_added" callback function.
-// Signal handler for the #GDBusObject::interface-added signal.
+// Signal handler for the GDBusObject::interface-added signal.
 func (x *DBusObjectIface) GetInterfaceAdded() func(DBusObject, DBusInterface) {
 	if x.xInterfaceAdded == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, InterfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceAdded)
+	core.RegisterFunc(&rawCallback, x.xInterfaceAdded)
 	return func(ObjectVar DBusObject, InterfaceVar DBusInterface) {
 		rawCallback(ObjectVar.GoPointer(), InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideInterfaceRemoved sets the "interface_removed" callback function.
-// Signal handler for the #GDBusObject::interface-removed signal.
+// Signal handler for the GDBusObject::interface-removed signal.
 func (x *DBusObjectIface) OverrideInterfaceRemoved(cb func(DBusObject, DBusInterface)) {
 	if cb == nil {
 		x.xInterfaceRemoved = 0
 	} else {
-		x.xInterfaceRemoved = purego.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr) {
+		x.xInterfaceRemoved = core.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectBase{Ptr: ObjectVarp}, &DBusInterfaceBase{Ptr: InterfaceVarp})
 		})
 	}
 }
 
 // GetInterfaceRemoved gets the "interface_removed" callback function.
-// Signal handler for the #GDBusObject::interface-removed signal.
+// Signal handler for the GDBusObject::interface-removed signal.
 func (x *DBusObjectIface) GetInterfaceRemoved() func(DBusObject, DBusInterface) {
 	if x.xInterfaceRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, InterfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceRemoved)
+	core.RegisterFunc(&rawCallback, x.xInterfaceRemoved)
 	return func(ObjectVar DBusObject, InterfaceVar DBusInterface) {
 		rawCallback(ObjectVar.GoPointer(), InterfaceVar.GoPointer())
 	}
 }
 
 // The `GDBusObject` type is the base type for D-Bus objects on both
-// the service side (see [class@Gio.DBusObjectSkeleton]) and the client side
-// (see [class@Gio.DBusObjectProxy]). It is essentially just a container of
+// the service side (see [DBusObjectSkeleton]) and the client side
+// (see [DBusObjectProxy]). It is essentially just a container of
 // interfaces.
 type DBusObject interface {
 	GoPointer() uintptr
@@ -200,8 +205,60 @@ func (x *DBusObjectBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the D-Bus interface with name @interface_name associated with
-// @object, if any.
+// ImplementDBusObject adds the DBusObject interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetObjectPath() string
+//   - GetInterfaces() *glib.List
+//   - GetInterface(string) *DBusInterfaceBase
+//   - InterfaceAdded(DBusInterface)
+//   - InterfaceRemoved(DBusInterface)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DBusObjectIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDBusObject[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DBusObjectGLibType(), func(iface *DBusObjectIface) {
+		if _, ok := any(zero).(interface{ GetObjectPath() string }); ok {
+			iface.OverrideGetObjectPath(func(self DBusObject) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetObjectPath() string })
+				return impl.GetObjectPath()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetInterfaces() *glib.List }); ok {
+			iface.OverrideGetInterfaces(func(self DBusObject) *glib.List {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetInterfaces() *glib.List })
+				return impl.GetInterfaces()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetInterface(string) *DBusInterfaceBase
+		}); ok {
+			iface.OverrideGetInterface(func(self DBusObject, arg0 string) *DBusInterfaceBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetInterface(string) *DBusInterfaceBase
+				})
+				return impl.GetInterface(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ InterfaceAdded(DBusInterface) }); ok {
+			iface.OverrideInterfaceAdded(func(self DBusObject, arg0 DBusInterface) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ InterfaceAdded(DBusInterface) })
+				impl.InterfaceAdded(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ InterfaceRemoved(DBusInterface) }); ok {
+			iface.OverrideInterfaceRemoved(func(self DBusObject, arg0 DBusInterface) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ InterfaceRemoved(DBusInterface) })
+				impl.InterfaceRemoved(arg0)
+			})
+		}
+	})
+}
+
+// Gets the D-Bus interface with name interface_name associated with
+// object, if any.
 func (x *DBusObjectBase) GetInterface(InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
 
@@ -215,14 +272,14 @@ func (x *DBusObjectBase) GetInterface(InterfaceNameVar string) *DBusInterfaceBas
 	return cls
 }
 
-// Gets the D-Bus interfaces associated with @object.
+// Gets the D-Bus interfaces associated with object.
 func (x *DBusObjectBase) GetInterfaces() *glib.List {
 
 	cret := XGDbusObjectGetInterfaces(x.GoPointer())
 	return cret
 }
 
-// Gets the object path for @object.
+// Gets the object path for object.
 func (x *DBusObjectBase) GetObjectPath() string {
 
 	cret := XGDbusObjectGetObjectPath(x.GoPointer())
@@ -238,7 +295,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -247,8 +304,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectGLibType, libs, "g_dbus_object_get_type")
 
-	core.PuregoSafeRegister(&XGDbusObjectGetInterface, libs, "g_dbus_object_get_interface")
-	core.PuregoSafeRegister(&XGDbusObjectGetInterfaces, libs, "g_dbus_object_get_interfaces")
-	core.PuregoSafeRegister(&XGDbusObjectGetObjectPath, libs, "g_dbus_object_get_object_path")
+	gobject.CheckClassSize("GDBusObjectIface", xDBusObjectGLibType, unsafe.Sizeof(DBusObjectIface{}))
+
+	core.PuregoRegisterSince(&XGDbusObjectGetInterface, libs, "g_dbus_object_get_interface", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusObjectGetInterfaces, libs, "g_dbus_object_get_interfaces", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusObjectGetObjectPath, libs, "g_dbus_object_get_object_path", "GIO", "2.30")
 
 }
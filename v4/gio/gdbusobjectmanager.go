@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type DBusObjectManagerIface struct {
 	_ structs.HostLayout
 
-	ParentIface uintptr
+	ParentIface gobject.TypeInterface
 
 	xGetObjectPath uintptr
 
@@ -39,62 +39,65 @@ func (x *DBusObjectManagerIface) GoPointer() uintptr {
 }
 
 // OverrideGetObjectPath sets the "get_object_path" callback function.
-// Virtual function for g_dbus_object_manager_get_object_path().
+// Virtual function for [DBusObjectManager.GetObjectPath].
 func (x *DBusObjectManagerIface) OverrideGetObjectPath(cb func(DBusObjectManager) string) {
 	if cb == nil {
 		x.xGetObjectPath = 0
 	} else {
-		x.xGetObjectPath = purego.NewCallback(func(ManagerVarp uintptr) string {
+		x.xGetObjectPath = core.NewCallback(func(ManagerVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&DBusObjectManagerBase{Ptr: ManagerVarp})
 		})
 	}
 }
 
 // GetGetObjectPath gets the "get_object_path" callback function.
-// Virtual function for g_dbus_object_manager_get_object_path().
+// Virtual function for [DBusObjectManager.GetObjectPath].
 func (x *DBusObjectManagerIface) GetGetObjectPath() func(DBusObjectManager) string {
 	if x.xGetObjectPath == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetObjectPath)
+	core.RegisterFunc(&rawCallback, x.xGetObjectPath)
 	return func(ManagerVar DBusObjectManager) string {
 		return rawCallback(ManagerVar.GoPointer())
 	}
 }
 
 // OverrideGetObjects sets the "get_objects" callback function.
-// Virtual function for g_dbus_object_manager_get_objects().
+// Virtual function for [DBusObjectManager.GetObjects].
 func (x *DBusObjectManagerIface) OverrideGetObjects(cb func(DBusObjectManager) *glib.List) {
 	if cb == nil {
 		x.xGetObjects = 0
 	} else {
-		x.xGetObjects = purego.NewCallback(func(ManagerVarp uintptr) *glib.List {
+		x.xGetObjects = core.NewCallback(func(ManagerVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(&DBusObjectManagerBase{Ptr: ManagerVarp})
 		})
 	}
 }
 
 // GetGetObjects gets the "get_objects" callback function.
-// Virtual function for g_dbus_object_manager_get_objects().
+// Virtual function for [DBusObjectManager.GetObjects].
 func (x *DBusObjectManagerIface) GetGetObjects() func(DBusObjectManager) *glib.List {
 	if x.xGetObjects == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetObjects)
+	core.RegisterFunc(&rawCallback, x.xGetObjects)
 	return func(ManagerVar DBusObjectManager) *glib.List {
 		return rawCallback(ManagerVar.GoPointer())
 	}
 }
 
 // OverrideGetObject sets the "get_object" callback function.
-// Virtual function for g_dbus_object_manager_get_object().
+// Virtual function for [DBusObjectManager.GetObject].
 func (x *DBusObjectManagerIface) OverrideGetObject(cb func(DBusObjectManager, string) *DBusObjectBase) {
 	if cb == nil {
 		x.xGetObject = 0
 	} else {
-		x.xGetObject = purego.NewCallback(func(ManagerVarp uintptr, ObjectPathVarp string) uintptr {
+		x.xGetObject = core.NewCallback(func(ManagerVarp uintptr, ObjectPathVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, ObjectPathVarp)
 			if ret == nil {
 				return 0
@@ -105,13 +108,13 @@ func (x *DBusObjectManagerIface) OverrideGetObject(cb func(DBusObjectManager, st
 }
 
 // GetGetObject gets the "get_object" callback function.
-// Virtual function for g_dbus_object_manager_get_object().
+// Virtual function for [DBusObjectManager.GetObject].
 func (x *DBusObjectManagerIface) GetGetObject() func(DBusObjectManager, string) *DBusObjectBase {
 	if x.xGetObject == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectPathVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetObject)
+	core.RegisterFunc(&rawCallback, x.xGetObject)
 	return func(ManagerVar DBusObjectManager, ObjectPathVar string) *DBusObjectBase {
 		rawRet := rawCallback(ManagerVar.GoPointer(), ObjectPathVar)
 		if rawRet == 0 {
@@ -124,12 +127,13 @@ func (x *DBusObjectManagerIface) GetGetObject() func(DBusObjectManager, string)
 }
 
 // OverrideGetInterface sets the "get_interface" callback function.
-// Virtual function for g_dbus_object_manager_get_interface().
+// Virtual function for [DBusObjectManager.GetInterface].
 func (x *DBusObjectManagerIface) OverrideGetInterface(cb func(DBusObjectManager, string, string) *DBusInterfaceBase) {
 	if cb == nil {
 		x.xGetInterface = 0
 	} else {
-		x.xGetInterface = purego.NewCallback(func(ManagerVarp uintptr, ObjectPathVarp string, InterfaceNameVarp string) uintptr {
+		x.xGetInterface = core.NewCallback(func(ManagerVarp uintptr, ObjectPathVarp string, InterfaceNameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, ObjectPathVarp, InterfaceNameVarp)
 			if ret == nil {
 				return 0
@@ -140,13 +144,13 @@ func (x *DBusObjectManagerIface) OverrideGetInterface(cb func(DBusObjectManager,
 }
 
 // GetGetInterface gets the "get_interface" callback function.
-// Virtual function for g_dbus_object_manager_get_interface().
+// Virtual function for [DBusObjectManager.GetInterface].
 func (x *DBusObjectManagerIface) GetGetInterface() func(DBusObjectManager, string, string) *DBusInterfaceBase {
 	if x.xGetInterface == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectPathVarp string, InterfaceNameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetInterface)
+	core.RegisterFunc(&rawCallback, x.xGetInterface)
 	return func(ManagerVar DBusObjectManager, ObjectPathVar string, InterfaceNameVar string) *DBusInterfaceBase {
 		rawRet := rawCallback(ManagerVar.GoPointer(), ObjectPathVar, InterfaceNameVar)
 		if rawRet == 0 {
@@ -159,100 +163,104 @@ func (x *DBusObjectManagerIface) GetGetInterface() func(DBusObjectManager, strin
 }
 
 // OverrideObjectAdded sets the "object_added" callback function.
-// Signal handler for the #GDBusObjectManager::object-added signal.
+// Signal handler for the GDBusObjectManager::object-added signal.
 func (x *DBusObjectManagerIface) OverrideObjectAdded(cb func(DBusObjectManager, DBusObject)) {
 	if cb == nil {
 		x.xObjectAdded = 0
 	} else {
-		x.xObjectAdded = purego.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr) {
+		x.xObjectAdded = core.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, &DBusObjectBase{Ptr: ObjectVarp})
 		})
 	}
 }
 
 // GetObjectAdded gets the "object_added" callback function.
-// Signal handler for the #GDBusObjectManager::object-added signal.
+// Signal handler for the GDBusObjectManager::object-added signal.
 func (x *DBusObjectManagerIface) GetObjectAdded() func(DBusObjectManager, DBusObject) {
 	if x.xObjectAdded == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xObjectAdded)
+	core.RegisterFunc(&rawCallback, x.xObjectAdded)
 	return func(ManagerVar DBusObjectManager, ObjectVar DBusObject) {
 		rawCallback(ManagerVar.GoPointer(), ObjectVar.GoPointer())
 	}
 }
 
 // OverrideObjectRemoved sets the "object_removed" callback function.
-// Signal handler for the #GDBusObjectManager::object-removed signal.
+// Signal handler for the GDBusObjectManager::object-removed signal.
 func (x *DBusObjectManagerIface) OverrideObjectRemoved(cb func(DBusObjectManager, DBusObject)) {
 	if cb == nil {
 		x.xObjectRemoved = 0
 	} else {
-		x.xObjectRemoved = purego.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr) {
+		x.xObjectRemoved = core.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, &DBusObjectBase{Ptr: ObjectVarp})
 		})
 	}
 }
 
 // GetObjectRemoved gets the "object_removed" callback function.
-// Signal handler for the #GDBusObjectManager::object-removed signal.
+// Signal handler for the GDBusObjectManager::object-removed signal.
 func (x *DBusObjectManagerIface) GetObjectRemoved() func(DBusObjectManager, DBusObject) {
 	if x.xObjectRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xObjectRemoved)
+	core.RegisterFunc(&rawCallback, x.xObjectRemoved)
 	return func(ManagerVar DBusObjectManager, ObjectVar DBusObject) {
 		rawCallback(ManagerVar.GoPointer(), ObjectVar.GoPointer())
 	}
 }
 
 // OverrideInterfaceAdded sets the "interface_added" callback function.
-// Signal handler for the #GDBusObjectManager::interface-added signal.
+// Signal handler for the GDBusObjectManager::interface-added signal.
 func (x *DBusObjectManagerIface) OverrideInterfaceAdded(cb func(DBusObjectManager, DBusObject, DBusInterface)) {
 	if cb == nil {
 		x.xInterfaceAdded = 0
 	} else {
-		x.xInterfaceAdded = purego.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr) {
+		x.xInterfaceAdded = core.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, &DBusObjectBase{Ptr: ObjectVarp}, &DBusInterfaceBase{Ptr: InterfaceVarp})
 		})
 	}
 }
 
 // GetInterfaceAdded gets the "interface_added" callback function.
-// Signal handler for the #GDBusObjectManager::interface-added signal.
+// Signal handler for the GDBusObjectManager::interface-added signal.
 func (x *DBusObjectManagerIface) GetInterfaceAdded() func(DBusObjectManager, DBusObject, DBusInterface) {
 	if x.xInterfaceAdded == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceAdded)
+	core.RegisterFunc(&rawCallback, x.xInterfaceAdded)
 	return func(ManagerVar DBusObjectManager, ObjectVar DBusObject, InterfaceVar DBusInterface) {
 		rawCallback(ManagerVar.GoPointer(), ObjectVar.GoPointer(), InterfaceVar.GoPointer())
 	}
 }
 
 // OverrideInterfaceRemoved sets the "interface_removed" callback function.
-// Signal handler for the #GDBusObjectManager::interface-removed signal.
+// Signal handler for the GDBusObjectManager::interface-removed signal.
 func (x *DBusObjectManagerIface) OverrideInterfaceRemoved(cb func(DBusObjectManager, DBusObject, DBusInterface)) {
 	if cb == nil {
 		x.xInterfaceRemoved = 0
 	} else {
-		x.xInterfaceRemoved = purego.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr) {
+		x.xInterfaceRemoved = core.NewCallback(func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DBusObjectManagerBase{Ptr: ManagerVarp}, &DBusObjectBase{Ptr: ObjectVarp}, &DBusInterfaceBase{Ptr: InterfaceVarp})
 		})
 	}
 }
 
 // GetInterfaceRemoved gets the "interface_removed" callback function.
-// Signal handler for the #GDBusObjectManager::interface-removed signal.
+// Signal handler for the GDBusObjectManager::interface-removed signal.
 func (x *DBusObjectManagerIface) GetInterfaceRemoved() func(DBusObjectManager, DBusObject, DBusInterface) {
 	if x.xInterfaceRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectVarp uintptr, InterfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceRemoved)
+	core.RegisterFunc(&rawCallback, x.xInterfaceRemoved)
 	return func(ManagerVar DBusObjectManager, ObjectVar DBusObject, InterfaceVar DBusInterface) {
 		rawCallback(ManagerVar.GoPointer(), ObjectVar.GoPointer(), InterfaceVar.GoPointer())
 	}
@@ -260,11 +268,13 @@ func (x *DBusObjectManagerIface) GetInterfaceRemoved() func(DBusObjectManager, D
 
 // The `GDBusObjectManager` type is the base type for service- and
 // client-side implementations of the standardized
-// [`org.freedesktop.DBus.ObjectManager`](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager)
+// [org.freedesktop.DBus.ObjectManager]
 // interface.
 //
-// See [class@Gio.DBusObjectManagerClient] for the client-side implementation
-// and [class@Gio.DBusObjectManagerServer] for the service-side implementation.
+// See [DBusObjectManagerClient] for the client-side implementation
+// and [DBusObjectManagerServer] for the service-side implementation.
+//
+// [org.freedesktop.DBus.ObjectManager]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager
 type DBusObjectManager interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -295,7 +305,88 @@ func (x *DBusObjectManagerBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the interface proxy for @interface_name at @object_path, if
+// ImplementDBusObjectManager adds the DBusObjectManager interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetObjectPath() string
+//   - GetObjects() *glib.List
+//   - GetObject(string) *DBusObjectBase
+//   - GetInterface(string, string) *DBusInterfaceBase
+//   - ObjectAdded(DBusObject)
+//   - ObjectRemoved(DBusObject)
+//   - InterfaceAdded(DBusObject, DBusInterface)
+//   - InterfaceRemoved(DBusObject, DBusInterface)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DBusObjectManagerIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDBusObjectManager[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DBusObjectManagerGLibType(), func(iface *DBusObjectManagerIface) {
+		if _, ok := any(zero).(interface{ GetObjectPath() string }); ok {
+			iface.OverrideGetObjectPath(func(self DBusObjectManager) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetObjectPath() string })
+				return impl.GetObjectPath()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetObjects() *glib.List }); ok {
+			iface.OverrideGetObjects(func(self DBusObjectManager) *glib.List {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetObjects() *glib.List })
+				return impl.GetObjects()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetObject(string) *DBusObjectBase }); ok {
+			iface.OverrideGetObject(func(self DBusObjectManager, arg0 string) *DBusObjectBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetObject(string) *DBusObjectBase })
+				return impl.GetObject(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetInterface(string, string) *DBusInterfaceBase
+		}); ok {
+			iface.OverrideGetInterface(func(self DBusObjectManager, arg0 string, arg1 string) *DBusInterfaceBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetInterface(string, string) *DBusInterfaceBase
+				})
+				return impl.GetInterface(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ ObjectAdded(DBusObject) }); ok {
+			iface.OverrideObjectAdded(func(self DBusObjectManager, arg0 DBusObject) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ObjectAdded(DBusObject) })
+				impl.ObjectAdded(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ObjectRemoved(DBusObject) }); ok {
+			iface.OverrideObjectRemoved(func(self DBusObjectManager, arg0 DBusObject) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ObjectRemoved(DBusObject) })
+				impl.ObjectRemoved(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			InterfaceAdded(DBusObject, DBusInterface)
+		}); ok {
+			iface.OverrideInterfaceAdded(func(self DBusObjectManager, arg0 DBusObject, arg1 DBusInterface) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					InterfaceAdded(DBusObject, DBusInterface)
+				})
+				impl.InterfaceAdded(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			InterfaceRemoved(DBusObject, DBusInterface)
+		}); ok {
+			iface.OverrideInterfaceRemoved(func(self DBusObjectManager, arg0 DBusObject, arg1 DBusInterface) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					InterfaceRemoved(DBusObject, DBusInterface)
+				})
+				impl.InterfaceRemoved(arg0, arg1)
+			})
+		}
+	})
+}
+
+// Gets the interface proxy for interface_name at object_path, if
 // any.
 func (x *DBusObjectManagerBase) GetInterface(ObjectPathVar string, InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
@@ -310,7 +401,7 @@ func (x *DBusObjectManagerBase) GetInterface(ObjectPathVar string, InterfaceName
 	return cls
 }
 
-// Gets the #GDBusObject at @object_path, if any.
+// Gets the [DBusObject] at object_path, if any.
 func (x *DBusObjectManagerBase) GetObject(ObjectPathVar string) *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -324,14 +415,14 @@ func (x *DBusObjectManagerBase) GetObject(ObjectPathVar string) *DBusObjectBase
 	return cls
 }
 
-// Gets the object path that @manager is for.
+// Gets the object path that manager is for.
 func (x *DBusObjectManagerBase) GetObjectPath() string {
 
 	cret := XGDbusObjectManagerGetObjectPath(x.GoPointer())
 	return cret
 }
 
-// Gets all #GDBusObject objects known to @manager.
+// Gets all [DBusObject] objects known to manager.
 func (x *DBusObjectManagerBase) GetObjects() *glib.List {
 
 	cret := XGDbusObjectManagerGetObjects(x.GoPointer())
@@ -348,7 +439,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -357,9 +448,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectManagerGLibType, libs, "g_dbus_object_manager_get_type")
 
-	core.PuregoSafeRegister(&XGDbusObjectManagerGetInterface, libs, "g_dbus_object_manager_get_interface")
-	core.PuregoSafeRegister(&XGDbusObjectManagerGetObject, libs, "g_dbus_object_manager_get_object")
-	core.PuregoSafeRegister(&XGDbusObjectManagerGetObjectPath, libs, "g_dbus_object_manager_get_object_path")
-	core.PuregoSafeRegister(&XGDbusObjectManagerGetObjects, libs, "g_dbus_object_manager_get_objects")
+	gobject.CheckClassSize("GDBusObjectManagerIface", xDBusObjectManagerGLibType, unsafe.Sizeof(DBusObjectManagerIface{}))
+
+	core.PuregoRegisterSince(&XGDbusObjectManagerGetInterface, libs, "g_dbus_object_manager_get_interface", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusObjectManagerGetObject, libs, "g_dbus_object_manager_get_object", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusObjectManagerGetObjectPath, libs, "g_dbus_object_manager_get_object_path", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGDbusObjectManagerGetObjects, libs, "g_dbus_object_manager_get_objects", "GIO", "2.30")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusObjectManagerClient.
+// Class structure for [DBusObjectManagerClient].
 type DBusObjectManagerClientClass struct {
 	_ structs.HostLayout
 
@@ -30,50 +29,52 @@ func (x *DBusObjectManagerClientClass) GoPointer() uintptr {
 }
 
 // OverrideInterfaceProxySignal sets the "interface_proxy_signal" callback function.
-// Signal class handler for the #GDBusObjectManagerClient::interface-proxy-signal signal.
+// Signal class handler for the [DBusObjectManagerClient.ConnectInterfaceProxySignalFunc] signal.
 func (x *DBusObjectManagerClientClass) OverrideInterfaceProxySignal(cb func(*DBusObjectManagerClient, *DBusObjectProxy, *DBusProxy, string, string, *glib.Variant)) {
 	if cb == nil {
 		x.xInterfaceProxySignal = 0
 	} else {
-		x.xInterfaceProxySignal = purego.NewCallback(func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant) {
+		x.xInterfaceProxySignal = core.NewCallback(func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(DBusObjectManagerClientNewFromInternalPtr(ManagerVarp), DBusObjectProxyNewFromInternalPtr(ObjectProxyVarp), DBusProxyNewFromInternalPtr(InterfaceProxyVarp), SenderNameVarp, SignalNameVarp, ParametersVarp)
 		})
 	}
 }
 
 // GetInterfaceProxySignal gets the "interface_proxy_signal" callback function.
-// Signal class handler for the #GDBusObjectManagerClient::interface-proxy-signal signal.
+// Signal class handler for the [DBusObjectManagerClient.ConnectInterfaceProxySignalFunc] signal.
 func (x *DBusObjectManagerClientClass) GetInterfaceProxySignal() func(*DBusObjectManagerClient, *DBusObjectProxy, *DBusProxy, string, string, *glib.Variant) {
 	if x.xInterfaceProxySignal == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceProxySignal)
+	core.RegisterFunc(&rawCallback, x.xInterfaceProxySignal)
 	return func(ManagerVar *DBusObjectManagerClient, ObjectProxyVar *DBusObjectProxy, InterfaceProxyVar *DBusProxy, SenderNameVar string, SignalNameVar string, ParametersVar *glib.Variant) {
 		rawCallback(ManagerVar.GoPointer(), ObjectProxyVar.GoPointer(), InterfaceProxyVar.GoPointer(), SenderNameVar, SignalNameVar, ParametersVar)
 	}
 }
 
 // OverrideInterfaceProxyPropertiesChanged sets the "interface_proxy_properties_changed" callback function.
-// Signal class handler for the #GDBusObjectManagerClient::interface-proxy-properties-changed signal.
+// Signal class handler for the [DBusObjectManagerClient.ConnectInterfaceProxyPropertiesChangedFunc] signal.
 func (x *DBusObjectManagerClientClass) OverrideInterfaceProxyPropertiesChanged(cb func(*DBusObjectManagerClient, *DBusObjectProxy, *DBusProxy, *glib.Variant, string)) {
 	if cb == nil {
 		x.xInterfaceProxyPropertiesChanged = 0
 	} else {
-		x.xInterfaceProxyPropertiesChanged = purego.NewCallback(func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string) {
+		x.xInterfaceProxyPropertiesChanged = core.NewCallback(func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string) {
+			defer core.RecoverPanic()
 			cb(DBusObjectManagerClientNewFromInternalPtr(ManagerVarp), DBusObjectProxyNewFromInternalPtr(ObjectProxyVarp), DBusProxyNewFromInternalPtr(InterfaceProxyVarp), ChangedPropertiesVarp, InvalidatedPropertiesVarp)
 		})
 	}
 }
 
 // GetInterfaceProxyPropertiesChanged gets the "interface_proxy_properties_changed" callback function.
-// Signal class handler for the #GDBusObjectManagerClient::interface-proxy-properties-changed signal.
+// Signal class handler for the [DBusObjectManagerClient.ConnectInterfaceProxyPropertiesChangedFunc] signal.
 func (x *DBusObjectManagerClientClass) GetInterfaceProxyPropertiesChanged() func(*DBusObjectManagerClient, *DBusObjectProxy, *DBusProxy, *glib.Variant, string) {
 	if x.xInterfaceProxyPropertiesChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string)
-	purego.RegisterFunc(&rawCallback, x.xInterfaceProxyPropertiesChanged)
+	core.RegisterFunc(&rawCallback, x.xInterfaceProxyPropertiesChanged)
 	return func(ManagerVar *DBusObjectManagerClient, ObjectProxyVar *DBusObjectProxy, InterfaceProxyVar *DBusProxy, ChangedPropertiesVar *glib.Variant, InvalidatedPropertiesVar string) {
 		rawCallback(ManagerVar.GoPointer(), ObjectProxyVar.GoPointer(), InterfaceProxyVar.GoPointer(), ChangedPropertiesVar, InvalidatedPropertiesVar)
 	}
@@ -88,16 +89,16 @@ func (x *DBusObjectManagerClientPrivate) GoPointer() uintptr {
 }
 
 // `GDBusObjectManagerClient` is used to create, monitor and delete object
-// proxies for remote objects exported by a [class@Gio.DBusObjectManagerServer]
+// proxies for remote objects exported by a [DBusObjectManagerServer]
 // (or any code implementing the
-// [org.freedesktop.DBus.ObjectManager](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager)
+// [org.freedesktop.DBus.ObjectManager]
 // interface).
 //
 // Once an instance of this type has been created, you can connect to
-// the [signal@Gio.DBusObjectManager::object-added] and
+// the DBusObjectManager::object-added and
 // [signal@Gio.DBusObjectManager::object-removed signals] and inspect the
-// [class@Gio.DBusObjectProxy] objects returned by
-// [method@Gio.DBusObjectManager.get_objects].
+// [DBusObjectProxy] objects returned by
+// [DBusObjectManager.GetObjects].
 //
 // If the name for a `GDBusObjectManagerClient` is not owned by anyone at
 // object construction time, the default behavior is to request the
@@ -108,63 +109,65 @@ func (x *DBusObjectManagerClientPrivate) GoPointer() uintptr {
 // is not possible to launch an owner for the requested name. In this
 // case, `GDBusObjectManagerClient` object construction still succeeds but
 // there will be no object proxies
-// (e.g. [method@Gio.DBusObjectManager.get_objects] returns the empty list) and
-// the [property@Gio.DBusObjectManagerClient:name-owner] property is `NULL`.
+// (e.g. [DBusObjectManager.GetObjects] returns the empty list) and
+// the [DBusObjectManagerClient.GetPropertyNameOwner] property is `NULL`.
 //
 // The owner of the requested name can come and go (for example
 // consider a system service being restarted) – `GDBusObjectManagerClient`
-// handles this case too; simply connect to the [signal@GObject.Object::notify]
+// handles this case too; simply connect to the [gobject.Object.ConnectNotifyFunc]
 // signal to watch for changes on the
-// [property@Gio.DBusObjectManagerClient:name-owner] property. When the name
+// [DBusObjectManagerClient.GetPropertyNameOwner] property. When the name
 // owner vanishes, the behavior is that
-// [property@Gio.DBusObjectManagerClient:name-owner] is set to `NULL` (this
-// includes emission of the [signal@GObject.Object::notify] signal) and then
-// [signal@Gio.DBusObjectManager::object-removed] signals are synthesized
+// [DBusObjectManagerClient.GetPropertyNameOwner] is set to `NULL` (this
+// includes emission of the [gobject.Object.ConnectNotifyFunc] signal) and then
+// DBusObjectManager::object-removed signals are synthesized
 // for all currently existing object proxies. Since
-// [property@Gio.DBusObjectManagerClient:name-owner] is `NULL` when this
+// [DBusObjectManagerClient.GetPropertyNameOwner] is `NULL` when this
 // happens, you can use this information to disambiguate a synthesized signal
 // from a genuine signal caused by object removal on the remote
-// [iface@Gio.DBusObjectManager]. Similarly, when a new name owner appears,
-// [signal@Gio.DBusObjectManager::object-added] signals are synthesized
-// while [property@Gio.DBusObjectManagerClient:name-owner] is still `NULL`. Only
+// [DBusObjectManager]. Similarly, when a new name owner appears,
+// DBusObjectManager::object-added signals are synthesized
+// while [DBusObjectManagerClient.GetPropertyNameOwner] is still `NULL`. Only
 // when all object proxies have been added, the
-// [property@Gio.DBusObjectManagerClient:name-owner] is set to the new name
-// owner (this includes emission of the [signal@GObject.Object::notify] signal).
+// [DBusObjectManagerClient.GetPropertyNameOwner] is set to the new name
+// owner (this includes emission of the [gobject.Object.ConnectNotifyFunc] signal).
 // Furthermore, you are guaranteed that
-// [property@Gio.DBusObjectManagerClient:name-owner] will alternate between a
+// [DBusObjectManagerClient.GetPropertyNameOwner] will alternate between a
 // name owner (e.g. `:1.42`) and `NULL` even in the case where
 // the name of interest is atomically replaced
 //
 // Ultimately, `GDBusObjectManagerClient` is used to obtain
-// [class@Gio.DBusProxy] instances. All signals (including the
+// [DBusProxy] instances. All signals (including the
 // `org.freedesktop.DBus.Properties::PropertiesChanged` signal)
-// delivered to [class@Gio.DBusProxy] instances are guaranteed to originate
+// delivered to [DBusProxy] instances are guaranteed to originate
 // from the name owner. This guarantee along with the behavior
 // described above, means that certain race conditions including the
 // “half the proxy is from the old owner and the other half is from
 // the new owner” problem cannot happen.
 //
 // To avoid having the application connect to signals on the returned
-// [class@Gio.DBusObjectProxy] and [class@Gio.DBusProxy] objects, the
-// [signal@Gio.DBusObject::interface-added],
-// [signal@Gio.DBusObject::interface-removed],
-// [signal@Gio.DBusProxy::g-properties-changed] and
-// [signal@Gio.DBusProxy::g-signal] signals
+// [DBusObjectProxy] and [DBusProxy] objects, the
+// DBusObject::interface-added,
+// DBusObject::interface-removed,
+// [DBusProxy.ConnectGPropertiesChangedFunc] and
+// [DBusProxy.ConnectGSignalFunc] signals
 // are also emitted on the `GDBusObjectManagerClient` instance managing these
 // objects. The signals emitted are
-// [signal@Gio.DBusObjectManager::interface-added],
-// [signal@Gio.DBusObjectManager::interface-removed],
-// [signal@Gio.DBusObjectManagerClient::interface-proxy-properties-changed] and
-// [signal@Gio.DBusObjectManagerClient::interface-proxy-signal].
+// DBusObjectManager::interface-added,
+// DBusObjectManager::interface-removed,
+// [DBusObjectManagerClient.ConnectInterfaceProxyPropertiesChangedFunc] and
+// [DBusObjectManagerClient.ConnectInterfaceProxySignalFunc].
 //
 // Note that all callbacks and signals are emitted in the
 // thread-default main context (see
-// [method@GLib.MainContext.push_thread_default]) that the
+// [glib.MainContext.PushThreadDefault]) that the
 // `GDBusObjectManagerClient` object was constructed in. Additionally, the
-// [class@Gio.DBusObjectProxy] and [class@Gio.DBusProxy] objects
+// [DBusObjectProxy] and [DBusProxy] objects
 // originating from the `GDBusObjectManagerClient` object will be created in
 // the same context and, consequently, will deliver signals in the
 // same main loop.
+//
+// [org.freedesktop.DBus.ObjectManager]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager
 type DBusObjectManagerClient struct {
 	gobject.Object
 }
@@ -183,7 +186,7 @@ func DBusObjectManagerClientNewFromInternalPtr(ptr uintptr) *DBusObjectManagerCl
 
 var xNewDBusObjectManagerClientFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_object_manager_client_new().
+// Finishes an operation started with [DBusObjectManagerClientNew].
 func NewDBusObjectManagerClientFinish(ResVar AsyncResult) (*DBusObjectManagerClient, error) {
 	var cls *DBusObjectManagerClient
 	var cerr *glib.Error
@@ -191,12 +194,15 @@ func NewDBusObjectManagerClientFinish(ResVar AsyncResult) (*DBusObjectManagerCli
 	cret := xNewDBusObjectManagerClientFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusObjectManagerClient{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -204,7 +210,7 @@ func NewDBusObjectManagerClientFinish(ResVar AsyncResult) (*DBusObjectManagerCli
 
 var xNewDBusObjectManagerClientForBusFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes an operation started with g_dbus_object_manager_client_new_for_bus().
+// Finishes an operation started with [DBusObjectManagerClientNewForBus].
 func NewDBusObjectManagerClientForBusFinish(ResVar AsyncResult) (*DBusObjectManagerClient, error) {
 	var cls *DBusObjectManagerClient
 	var cerr *glib.Error
@@ -212,12 +218,15 @@ func NewDBusObjectManagerClientForBusFinish(ResVar AsyncResult) (*DBusObjectMana
 	cret := xNewDBusObjectManagerClientForBusFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusObjectManagerClient{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -225,11 +234,11 @@ func NewDBusObjectManagerClientForBusFinish(ResVar AsyncResult) (*DBusObjectMana
 
 var xNewDBusObjectManagerClientForBusSync func(BusType, DBusObjectManagerClientFlags, string, string, uintptr, uintptr, uintptr, uintptr, **glib.Error) uintptr
 
-// Like g_dbus_object_manager_client_new_sync() but takes a #GBusType instead
-// of a #GDBusConnection.
+// Like [NewDBusObjectManagerClientSync] but takes a [BusType] instead
+// of a [DBusConnection].
 //
 // This is a synchronous failable constructor - the calling thread is
-// blocked until a reply is received. See g_dbus_object_manager_client_new_for_bus()
+// blocked until a reply is received. See [DBusObjectManagerClientNewForBus]
 // for the asynchronous version.
 func NewDBusObjectManagerClientForBusSync(BusTypeVar BusType, FlagsVar DBusObjectManagerClientFlags, NameVar string, ObjectPathVar string, GetProxyTypeFuncVar *DBusProxyTypeFunc, GetProxyTypeUserDataVar uintptr, GetProxyTypeDestroyNotifyVar *glib.DestroyNotify, CancellableVar *Cancellable) (*DBusObjectManagerClient, error) {
 	var cls *DBusObjectManagerClient
@@ -242,11 +251,12 @@ func NewDBusObjectManagerClientForBusSync(BusTypeVar BusType, FlagsVar DBusObjec
 			GetProxyTypeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) types.GType {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeFuncVar
 				return cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			GetProxyTypeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
+			GetProxyTypeFuncVarRef = core.NewCallback(fcb)
+			GetProxyTypeFuncVarRef = glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
 		}
 	}
 
@@ -257,23 +267,27 @@ func NewDBusObjectManagerClientForBusSync(BusTypeVar BusType, FlagsVar DBusObjec
 			GetProxyTypeDestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeDestroyNotifyVar
 				cbFn(arg0)
 			}
-			GetProxyTypeDestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
+			GetProxyTypeDestroyNotifyVarRef = core.NewCallback(fcb)
+			GetProxyTypeDestroyNotifyVarRef = glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
 		}
 	}
 
 	cret := xNewDBusObjectManagerClientForBusSync(BusTypeVar, FlagsVar, NameVar, ObjectPathVar, GetProxyTypeFuncVarRef, GetProxyTypeUserDataVar, GetProxyTypeDestroyNotifyVarRef, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusObjectManagerClient{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -281,10 +295,10 @@ func NewDBusObjectManagerClientForBusSync(BusTypeVar BusType, FlagsVar DBusObjec
 
 var xNewDBusObjectManagerClientSync func(uintptr, DBusObjectManagerClientFlags, uintptr, string, uintptr, uintptr, uintptr, uintptr, **glib.Error) uintptr
 
-// Creates a new #GDBusObjectManagerClient object.
+// Creates a new [DBusObjectManagerClient] object.
 //
 // This is a synchronous failable constructor - the calling thread is
-// blocked until a reply is received. See g_dbus_object_manager_client_new()
+// blocked until a reply is received. See [DBusObjectManagerClientNew]
 // for the asynchronous version.
 func NewDBusObjectManagerClientSync(ConnectionVar *DBusConnection, FlagsVar DBusObjectManagerClientFlags, NameVar *string, ObjectPathVar string, GetProxyTypeFuncVar *DBusProxyTypeFunc, GetProxyTypeUserDataVar uintptr, GetProxyTypeDestroyNotifyVar *glib.DestroyNotify, CancellableVar *Cancellable) (*DBusObjectManagerClient, error) {
 	var cls *DBusObjectManagerClient
@@ -297,11 +311,12 @@ func NewDBusObjectManagerClientSync(ConnectionVar *DBusConnection, FlagsVar DBus
 			GetProxyTypeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) types.GType {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeFuncVar
 				return cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			GetProxyTypeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
+			GetProxyTypeFuncVarRef = core.NewCallback(fcb)
+			GetProxyTypeFuncVarRef = glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
 		}
 	}
 
@@ -312,11 +327,12 @@ func NewDBusObjectManagerClientSync(ConnectionVar *DBusConnection, FlagsVar DBus
 			GetProxyTypeDestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeDestroyNotifyVar
 				cbFn(arg0)
 			}
-			GetProxyTypeDestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
+			GetProxyTypeDestroyNotifyVarRef = core.NewCallback(fcb)
+			GetProxyTypeDestroyNotifyVarRef = glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
 		}
 	}
 
@@ -326,12 +342,15 @@ func NewDBusObjectManagerClientSync(ConnectionVar *DBusConnection, FlagsVar DBus
 	cret := xNewDBusObjectManagerClientSync(ConnectionVar.GoPointer(), FlagsVar, NameVarPtr, ObjectPathVar, GetProxyTypeFuncVarRef, GetProxyTypeUserDataVar, GetProxyTypeDestroyNotifyVarRef, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusObjectManagerClient{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -339,7 +358,7 @@ func NewDBusObjectManagerClientSync(ConnectionVar *DBusConnection, FlagsVar DBus
 
 var xDBusObjectManagerClientGetConnection func(uintptr) uintptr
 
-// Gets the #GDBusConnection used by @manager.
+// Gets the [DBusConnection] used by manager.
 func (x *DBusObjectManagerClient) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -356,7 +375,7 @@ func (x *DBusObjectManagerClient) GetConnection() *DBusConnection {
 
 var xDBusObjectManagerClientGetFlags func(uintptr) DBusObjectManagerClientFlags
 
-// Gets the flags that @manager was constructed with.
+// Gets the flags that manager was constructed with.
 func (x *DBusObjectManagerClient) GetFlags() DBusObjectManagerClientFlags {
 
 	cret := xDBusObjectManagerClientGetFlags(x.GoPointer())
@@ -365,7 +384,7 @@ func (x *DBusObjectManagerClient) GetFlags() DBusObjectManagerClientFlags {
 
 var xDBusObjectManagerClientGetName func(uintptr) string
 
-// Gets the name that @manager is for, or %NULL if not a message bus
+// Gets the name that manager is for, or nil if not a message bus
 // connection.
 func (x *DBusObjectManagerClient) GetName() string {
 
@@ -375,10 +394,10 @@ func (x *DBusObjectManagerClient) GetName() string {
 
 var xDBusObjectManagerClientGetNameOwner func(uintptr) string
 
-// The unique name that owns the name that @manager is for or %NULL if
+// The unique name that owns the name that manager is for or nil if
 // no-one currently owns that name. You can connect to the
-// #GObject::notify signal to track changes to the
-// #GDBusObjectManagerClient:name-owner property.
+// [gobject.Object.ConnectNotifyFunc] signal to track changes to the
+// [DBusObjectManagerClient.GetPropertyNameOwner] property.
 func (x *DBusObjectManagerClient) GetNameOwner() string {
 
 	cret := xDBusObjectManagerClientGetNameOwner(x.GoPointer())
@@ -397,7 +416,7 @@ func (c *DBusObjectManagerClient) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyGetProxyTypeDestroyNotify sets the "get-proxy-type-destroy-notify" property.
-// A #GDestroyNotify for the #gpointer user_data in #GDBusObjectManagerClient:get-proxy-type-user-data.
+// A [glib.DestroyNotify] for the #gpointer user_data in [DBusObjectManagerClient.GetPropertyGetProxyTypeUserData].
 func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeDestroyNotify(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -406,7 +425,7 @@ func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeDestroyNotify(value uin
 }
 
 // GetPropertyGetProxyTypeDestroyNotify gets the "get-proxy-type-destroy-notify" property.
-// A #GDestroyNotify for the #gpointer user_data in #GDBusObjectManagerClient:get-proxy-type-user-data.
+// A [glib.DestroyNotify] for the #gpointer user_data in [DBusObjectManagerClient.GetPropertyGetProxyTypeUserData].
 func (x *DBusObjectManagerClient) GetPropertyGetProxyTypeDestroyNotify() uintptr {
 	var v gobject.Value
 	x.GetProperty("get-proxy-type-destroy-notify", &v)
@@ -414,8 +433,8 @@ func (x *DBusObjectManagerClient) GetPropertyGetProxyTypeDestroyNotify() uintptr
 }
 
 // SetPropertyGetProxyTypeFunc sets the "get-proxy-type-func" property.
-// The #GDBusProxyTypeFunc to use when determining what #GType to
-// use for interface proxies or %NULL.
+// The [DBusProxyTypeFunc] to use when determining what [gobject.Type] to
+// use for interface proxies or nil.
 func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeFunc(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -424,8 +443,8 @@ func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeFunc(value uintptr) {
 }
 
 // GetPropertyGetProxyTypeFunc gets the "get-proxy-type-func" property.
-// The #GDBusProxyTypeFunc to use when determining what #GType to
-// use for interface proxies or %NULL.
+// The [DBusProxyTypeFunc] to use when determining what [gobject.Type] to
+// use for interface proxies or nil.
 func (x *DBusObjectManagerClient) GetPropertyGetProxyTypeFunc() uintptr {
 	var v gobject.Value
 	x.GetProperty("get-proxy-type-func", &v)
@@ -433,7 +452,7 @@ func (x *DBusObjectManagerClient) GetPropertyGetProxyTypeFunc() uintptr {
 }
 
 // SetPropertyGetProxyTypeUserData sets the "get-proxy-type-user-data" property.
-// The #gpointer user_data to pass to #GDBusObjectManagerClient:get-proxy-type-func.
+// The #gpointer user_data to pass to [DBusObjectManagerClient.GetPropertyGetProxyTypeFunc].
 func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeUserData(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -442,7 +461,7 @@ func (x *DBusObjectManagerClient) SetPropertyGetProxyTypeUserData(value uintptr)
 }
 
 // GetPropertyGetProxyTypeUserData gets the "get-proxy-type-user-data" property.
-// The #gpointer user_data to pass to #GDBusObjectManagerClient:get-proxy-type-func.
+// The #gpointer user_data to pass to [DBusObjectManagerClient.GetPropertyGetProxyTypeFunc].
 func (x *DBusObjectManagerClient) GetPropertyGetProxyTypeUserData() uintptr {
 	var v gobject.Value
 	x.GetProperty("get-proxy-type-user-data", &v)
@@ -467,9 +486,9 @@ func (x *DBusObjectManagerClient) GetPropertyName() string {
 }
 
 // GetPropertyNameOwner gets the "name-owner" property.
-// The unique name that owns #GDBusObjectManagerClient:name or %NULL if
+// The unique name that owns [DBusObjectManagerClient.GetPropertyName] or nil if
 // no-one is currently owning the name. Connect to the
-// #GObject::notify signal to track changes to this property.
+// [gobject.Object.ConnectNotifyFunc] signal to track changes to this property.
 func (x *DBusObjectManagerClient) GetPropertyNameOwner() string {
 	var v gobject.Value
 	x.GetProperty("name-owner", &v)
@@ -495,104 +514,148 @@ func (x *DBusObjectManagerClient) GetPropertyObjectPath() string {
 
 // Emitted when one or more D-Bus properties on proxy changes. The
 // local cache has already been updated when this signal fires. Note
-// that both @changed_properties and @invalidated_properties are
-// guaranteed to never be %NULL (either may be empty though).
+// that both changed_properties and invalidated_properties are
+// guaranteed to never be nil (either may be empty though).
 //
 // This signal exists purely as a convenience to avoid having to
-// connect signals to all interface proxies managed by @manager.
+// connect signals to all interface proxies managed by manager.
 //
 // This signal is emitted in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// that @manager was constructed in.
+// (see [glib.MainContext.PushThreadDefault])
+// that manager was constructed in.
+//
+// Deprecated: use ConnectInterfaceProxyPropertiesChangedFunc, which also accepts method values and closures.
 func (x *DBusObjectManagerClient) ConnectInterfaceProxyPropertiesChanged(cb *func(DBusObjectManagerClient, uintptr, uintptr, uintptr, []string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "interface-proxy-properties-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string) {
+			defer core.RecoverPanic()
+			fa := DBusObjectManagerClient{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectProxyVarp, InterfaceProxyVarp, ChangedPropertiesVarp, InvalidatedPropertiesVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "interface-proxy-properties-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string) {
+var xDBusObjectManagerClientInterfaceProxyPropertiesChangedTrampoline uintptr
+
+func xDBusObjectManagerClientInterfaceProxyPropertiesChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusObjectManagerClient, uintptr, uintptr, uintptr, []string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusObjectManagerClient{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectProxyVarp, InterfaceProxyVarp, ChangedPropertiesVarp, InvalidatedPropertiesVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "interface-proxy-properties-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when a D-Bus signal is received on @interface_proxy.
+// ConnectInterfaceProxyPropertiesChangedFunc connects cb to the "interface-proxy-properties-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusObjectManagerClient) ConnectInterfaceProxyPropertiesChangedFunc(cb func(DBusObjectManagerClient, uintptr, uintptr, uintptr, []string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "interface-proxy-properties-changed", &xDBusObjectManagerClientInterfaceProxyPropertiesChangedTrampoline, xDBusObjectManagerClientInterfaceProxyPropertiesChangedNewTrampoline, cb)
+}
+
+// Emitted when a D-Bus signal is received on interface_proxy.
 //
 // This signal exists purely as a convenience to avoid having to
-// connect signals to all interface proxies managed by @manager.
+// connect signals to all interface proxies managed by manager.
 //
 // This signal is emitted in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// that @manager was constructed in.
+// (see [glib.MainContext.PushThreadDefault])
+// that manager was constructed in.
+//
+// Deprecated: use ConnectInterfaceProxySignalFunc, which also accepts method values and closures.
 func (x *DBusObjectManagerClient) ConnectInterfaceProxySignal(cb *func(DBusObjectManagerClient, uintptr, uintptr, string, string, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "interface-proxy-signal", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DBusObjectManagerClient{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectProxyVarp, InterfaceProxyVarp, SenderNameVarp, SignalNameVarp, ParametersVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "interface-proxy-signal", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
+var xDBusObjectManagerClientInterfaceProxySignalTrampoline uintptr
+
+func xDBusObjectManagerClientInterfaceProxySignalNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectProxyVarp uintptr, InterfaceProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusObjectManagerClient, uintptr, uintptr, string, string, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusObjectManagerClient{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectProxyVarp, InterfaceProxyVarp, SenderNameVarp, SignalNameVarp, ParametersVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "interface-proxy-signal", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInterfaceProxySignalFunc connects cb to the "interface-proxy-signal" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusObjectManagerClient) ConnectInterfaceProxySignalFunc(cb func(DBusObjectManagerClient, uintptr, uintptr, string, string, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "interface-proxy-signal", &xDBusObjectManagerClientInterfaceProxySignalTrampoline, xDBusObjectManagerClientInterfaceProxySignalNewTrampoline, cb)
 }
 
 // Starts asynchronous initialization of the object implementing the
 // interface. This must be done before any real use of the object after
-// initial construction. If the object also implements #GInitable you can
-// optionally call g_initable_init() instead.
+// initial construction. If the object also implements [Initable] you can
+// optionally call [Initable.Init] instead.
 //
 // This method is intended for language bindings. If writing in C,
 // g_async_initable_new_async() should typically be used instead.
 //
-// When the initialization is finished, @callback will be called. You can
-// then call g_async_initable_init_finish() to get the result of the
+// When the initialization is finished, callback will be called. You can
+// then call [AsyncInitable.InitFinish] to get the result of the
 // initialization.
 //
-// Implementations may also support cancellation. If @cancellable is not
-// %NULL, then initialization can be cancelled by triggering the cancellable
+// Implementations may also support cancellation. If cancellable is not
+// nil, then initialization can be cancelled by triggering the cancellable
 // object from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL, and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil, and
 // the object doesn't support cancellable initialization, the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
-// As with #GInitable, if the object is not initialized, or initialization
+// As with [Initable], if the object is not initialized, or initialization
 // returns with an error, then all operations on the object except
-// g_object_ref() and g_object_unref() are considered to be invalid, and
+// [gobject.Object.Ref] and [gobject.Object.Unref] are considered to be invalid, and
 // have undefined behaviour. They will often fail with g_critical() or
 // g_warning(), but this must not be relied on.
 //
-// Callers should not assume that a class which implements #GAsyncInitable can
-// be initialized multiple times; for more information, see g_initable_init().
+// Callers should not assume that a class which implements [AsyncInitable] can
+// be initialized multiple times; for more information, see [Initable.Init].
 // If a class explicitly supports being initialized multiple times,
 // implementation requires yielding all subsequent calls to init_async() on the
 // results of the first call.
 //
-// For classes that also support the #GInitable interface, the default
-// implementation of this method will run the g_initable_init() function
+// For classes that also support the [Initable] interface, the default
+// implementation of this method will run the [Initable.Init] function
 // in a thread, so if you want to support asynchronous initialization via
-// threads, just implement the #GAsyncInitable interface without overriding
+// threads, just implement the [AsyncInitable] interface without overriding
 // any interface methods.
 func (x *DBusObjectManagerClient) InitAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -601,20 +664,20 @@ func (x *DBusObjectManagerClient) InitAsync(IoPriorityVar int, CancellableVar *C
 }
 
 // Finishes asynchronous initialization and returns the result.
-// See g_async_initable_init_async().
+// See [AsyncInitable.InitAsync].
 func (x *DBusObjectManagerClient) InitFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAsyncInitableInitFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Finishes the async construction for the various g_async_initable_new
-// calls, returning the created object or %NULL on error.
+// calls, returning the created object or nil on error.
 func (x *DBusObjectManagerClient) NewFinish(ResVar AsyncResult) (*gobject.Object, error) {
 	var cls *gobject.Object
 	var cerr *glib.Error
@@ -622,18 +685,21 @@ func (x *DBusObjectManagerClient) NewFinish(ResVar AsyncResult) (*gobject.Object
 	cret := XGAsyncInitableNewFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gobject.Object{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Gets the interface proxy for @interface_name at @object_path, if
+// Gets the interface proxy for interface_name at object_path, if
 // any.
 func (x *DBusObjectManagerClient) GetInterface(ObjectPathVar string, InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
@@ -648,7 +714,7 @@ func (x *DBusObjectManagerClient) GetInterface(ObjectPathVar string, InterfaceNa
 	return cls
 }
 
-// Gets the #GDBusObject at @object_path, if any.
+// Gets the [DBusObject] at object_path, if any.
 func (x *DBusObjectManagerClient) GetObject(ObjectPathVar string) *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -662,14 +728,14 @@ func (x *DBusObjectManagerClient) GetObject(ObjectPathVar string) *DBusObjectBas
 	return cls
 }
 
-// Gets the object path that @manager is for.
+// Gets the object path that manager is for.
 func (x *DBusObjectManagerClient) GetObjectPath() string {
 
 	cret := XGDbusObjectManagerGetObjectPath(x.GoPointer())
 	return cret
 }
 
-// Gets all #GDBusObject objects known to @manager.
+// Gets all [DBusObject] objects known to manager.
 func (x *DBusObjectManagerClient) GetObjects() *glib.List {
 
 	cret := XGDbusObjectManagerGetObjects(x.GoPointer())
@@ -682,25 +748,25 @@ func (x *DBusObjectManagerClient) GetObjects() *glib.List {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -710,16 +776,16 @@ func (x *DBusObjectManagerClient) GetObjects() *glib.List {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *DBusObjectManagerClient) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -727,14 +793,14 @@ func (x *DBusObjectManagerClient) Init(CancellableVar *Cancellable) (bool, error
 
 var xDBusObjectManagerClientNew func(uintptr, DBusObjectManagerClientFlags, string, string, uintptr, uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously creates a new #GDBusObjectManagerClient object.
+// Asynchronously creates a new [DBusObjectManagerClient] object.
 //
 // This is an asynchronous failable constructor. When the result is
-// ready, @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// ready, callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can then call
-// g_dbus_object_manager_client_new_finish() to get the result. See
-// g_dbus_object_manager_client_new_sync() for the synchronous version.
+// [NewDBusObjectManagerClientFinish] to get the result. See
+// [NewDBusObjectManagerClientSync] for the synchronous version.
 func DBusObjectManagerClientNew(ConnectionVar *DBusConnection, FlagsVar DBusObjectManagerClientFlags, NameVar string, ObjectPathVar string, GetProxyTypeFuncVar *DBusProxyTypeFunc, GetProxyTypeUserDataVar uintptr, GetProxyTypeDestroyNotifyVar *glib.DestroyNotify, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var GetProxyTypeFuncVarRef uintptr
@@ -744,11 +810,12 @@ func DBusObjectManagerClientNew(ConnectionVar *DBusConnection, FlagsVar DBusObje
 			GetProxyTypeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) types.GType {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeFuncVar
 				return cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			GetProxyTypeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
+			GetProxyTypeFuncVarRef = core.NewCallback(fcb)
+			GetProxyTypeFuncVarRef = glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
 		}
 	}
 
@@ -759,11 +826,12 @@ func DBusObjectManagerClientNew(ConnectionVar *DBusConnection, FlagsVar DBusObje
 			GetProxyTypeDestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeDestroyNotifyVar
 				cbFn(arg0)
 			}
-			GetProxyTypeDestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
+			GetProxyTypeDestroyNotifyVarRef = core.NewCallback(fcb)
+			GetProxyTypeDestroyNotifyVarRef = glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
 		}
 	}
 
@@ -774,11 +842,12 @@ func DBusObjectManagerClientNew(ConnectionVar *DBusConnection, FlagsVar DBusObje
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -788,15 +857,15 @@ func DBusObjectManagerClientNew(ConnectionVar *DBusConnection, FlagsVar DBusObje
 
 var xDBusObjectManagerClientNewForBus func(BusType, DBusObjectManagerClientFlags, string, string, uintptr, uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Like g_dbus_object_manager_client_new() but takes a #GBusType instead of a
-// #GDBusConnection.
+// Like [DBusObjectManagerClientNew] but takes a [BusType] instead of a
+// [DBusConnection].
 //
 // This is an asynchronous failable constructor. When the result is
-// ready, @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// ready, callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from. You can
-// then call g_dbus_object_manager_client_new_for_bus_finish() to get the result. See
-// g_dbus_object_manager_client_new_for_bus_sync() for the synchronous version.
+// then call [NewDBusObjectManagerClientForBusFinish] to get the result. See
+// [NewDBusObjectManagerClientForBusSync] for the synchronous version.
 func DBusObjectManagerClientNewForBus(BusTypeVar BusType, FlagsVar DBusObjectManagerClientFlags, NameVar string, ObjectPathVar string, GetProxyTypeFuncVar *DBusProxyTypeFunc, GetProxyTypeUserDataVar uintptr, GetProxyTypeDestroyNotifyVar *glib.DestroyNotify, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var GetProxyTypeFuncVarRef uintptr
@@ -806,11 +875,12 @@ func DBusObjectManagerClientNewForBus(BusTypeVar BusType, FlagsVar DBusObjectMan
 			GetProxyTypeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) types.GType {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeFuncVar
 				return cbFn(arg0, core.GoString(arg1), core.GoString(arg2), arg3)
 			}
-			GetProxyTypeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
+			GetProxyTypeFuncVarRef = core.NewCallback(fcb)
+			GetProxyTypeFuncVarRef = glib.SaveCallbackWithClosure(GetProxyTypeFuncVarPtr, GetProxyTypeFuncVarRef, GetProxyTypeFuncVar)
 		}
 	}
 
@@ -821,11 +891,12 @@ func DBusObjectManagerClientNewForBus(BusTypeVar BusType, FlagsVar DBusObjectMan
 			GetProxyTypeDestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *GetProxyTypeDestroyNotifyVar
 				cbFn(arg0)
 			}
-			GetProxyTypeDestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
+			GetProxyTypeDestroyNotifyVarRef = core.NewCallback(fcb)
+			GetProxyTypeDestroyNotifyVarRef = glib.SaveCallbackWithClosure(GetProxyTypeDestroyNotifyVarPtr, GetProxyTypeDestroyNotifyVarRef, GetProxyTypeDestroyNotifyVar)
 		}
 	}
 
@@ -836,11 +907,12 @@ func DBusObjectManagerClientNewForBus(BusTypeVar BusType, FlagsVar DBusObjectMan
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -853,7 +925,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -862,17 +934,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectManagerClientGLibType, libs, "g_dbus_object_manager_client_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusObjectManagerClientFinish, libs, "g_dbus_object_manager_client_new_finish")
-	core.PuregoSafeRegister(&xNewDBusObjectManagerClientForBusFinish, libs, "g_dbus_object_manager_client_new_for_bus_finish")
-	core.PuregoSafeRegister(&xNewDBusObjectManagerClientForBusSync, libs, "g_dbus_object_manager_client_new_for_bus_sync")
-	core.PuregoSafeRegister(&xNewDBusObjectManagerClientSync, libs, "g_dbus_object_manager_client_new_sync")
+	gobject.CheckClassSize("GDBusObjectManagerClientClass", xDBusObjectManagerClientGLibType, unsafe.Sizeof(DBusObjectManagerClientClass{}))
+
+	core.PuregoRegisterSince(&xNewDBusObjectManagerClientFinish, libs, "g_dbus_object_manager_client_new_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xNewDBusObjectManagerClientForBusFinish, libs, "g_dbus_object_manager_client_new_for_bus_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xNewDBusObjectManagerClientForBusSync, libs, "g_dbus_object_manager_client_new_for_bus_sync", "GIO", "2.30")
+	core.PuregoRegisterSince(&xNewDBusObjectManagerClientSync, libs, "g_dbus_object_manager_client_new_sync", "GIO", "2.30")
 
-	core.PuregoSafeRegister(&xDBusObjectManagerClientGetConnection, libs, "g_dbus_object_manager_client_get_connection")
-	core.PuregoSafeRegister(&xDBusObjectManagerClientGetFlags, libs, "g_dbus_object_manager_client_get_flags")
-	core.PuregoSafeRegister(&xDBusObjectManagerClientGetName, libs, "g_dbus_object_manager_client_get_name")
-	core.PuregoSafeRegister(&xDBusObjectManagerClientGetNameOwner, libs, "g_dbus_object_manager_client_get_name_owner")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientGetConnection, libs, "g_dbus_object_manager_client_get_connection", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientGetFlags, libs, "g_dbus_object_manager_client_get_flags", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientGetName, libs, "g_dbus_object_manager_client_get_name", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientGetNameOwner, libs, "g_dbus_object_manager_client_get_name_owner", "GIO", "2.30")
 
-	core.PuregoSafeRegister(&xDBusObjectManagerClientNew, libs, "g_dbus_object_manager_client_new")
-	core.PuregoSafeRegister(&xDBusObjectManagerClientNewForBus, libs, "g_dbus_object_manager_client_new_for_bus")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientNew, libs, "g_dbus_object_manager_client_new", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerClientNewForBus, libs, "g_dbus_object_manager_client_new_for_bus", "GIO", "2.30")
 
 }
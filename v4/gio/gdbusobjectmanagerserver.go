@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusObjectManagerServer.
+// Class structure for [DBusObjectManagerServer].
 type DBusObjectManagerServerClass struct {
 	_ structs.HostLayout
 
@@ -33,9 +32,9 @@ func (x *DBusObjectManagerServerPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GDBusObjectManagerServer` is used to export [iface@Gio.DBusObject] instances
+// `GDBusObjectManagerServer` is used to export [DBusObject] instances
 // using the standardized
-// [`org.freedesktop.DBus.ObjectManager`](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager)
+// [org.freedesktop.DBus.ObjectManager]
 // interface. For example, remote D-Bus clients can get all objects
 // and properties in a single call. Additionally, any change in the
 // object hierarchy is broadcast using signals. This means that D-Bus
@@ -51,9 +50,11 @@ func (x *DBusObjectManagerServerPrivate) GoPointer() uintptr {
 // It is supported, but not recommended, to export an object manager at the root
 // path, `/`.
 //
-// See [class@Gio.DBusObjectManagerClient] for the client-side code that is
+// See [DBusObjectManagerClient] for the client-side code that is
 // intended to be used with `GDBusObjectManagerServer` or any D-Bus
 // object implementing the `org.freedesktop.DBus.ObjectManager` interface.
+//
+// [org.freedesktop.DBus.ObjectManager]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager
 type DBusObjectManagerServer struct {
 	gobject.Object
 }
@@ -72,13 +73,15 @@ func DBusObjectManagerServerNewFromInternalPtr(ptr uintptr) *DBusObjectManagerSe
 
 var xNewDBusObjectManagerServer func(string) uintptr
 
-// Creates a new #GDBusObjectManagerServer object.
+// Creates a new [DBusObjectManagerServer] object.
 //
 // The returned server isn't yet exported on any connection. To do so,
-// use g_dbus_object_manager_server_set_connection(). Normally you
+// use [DBusObjectManagerServer.SetConnection]. Normally you
 // want to export all of your objects before doing so to avoid
-// [InterfacesAdded](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager)
+// [InterfacesAdded]
 // signals being emitted.
+//
+// [InterfacesAdded]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-objectmanager
 func NewDBusObjectManagerServer(ObjectPathVar string) *DBusObjectManagerServer {
 	var cls *DBusObjectManagerServer
 
@@ -94,15 +97,15 @@ func NewDBusObjectManagerServer(ObjectPathVar string) *DBusObjectManagerServer {
 
 var xDBusObjectManagerServerExport func(uintptr, uintptr)
 
-// Exports @object on @manager.
+// Exports object on manager.
 //
-// If there is already a #GDBusObject exported at the object path,
+// If there is already a [DBusObject] exported at the object path,
 // then the old object is removed.
 //
-// The object path for @object must be in the hierarchy rooted by the
-// object path for @manager.
+// The object path for object must be in the hierarchy rooted by the
+// object path for manager.
 //
-// Note that @manager will take a reference on @object for as long as
+// Note that manager will take a reference on object for as long as
 // it is exported.
 func (x *DBusObjectManagerServer) Export(ObjectVar *DBusObjectSkeleton) {
 
@@ -112,10 +115,10 @@ func (x *DBusObjectManagerServer) Export(ObjectVar *DBusObjectSkeleton) {
 
 var xDBusObjectManagerServerExportUniquely func(uintptr, uintptr)
 
-// Like g_dbus_object_manager_server_export() but appends a string of
-// the form _N (with N being a natural number) to @object's object path
+// Like [DBusObjectManagerServer.Export] but appends a string of
+// the form _N (with N being a natural number) to object's object path
 // if an object with the given path already exists. As such, the
-// #GDBusObjectProxy:g-object-path property of @object may be modified.
+// [DBusObjectProxy.GetPropertyGObjectPath] property of object may be modified.
 func (x *DBusObjectManagerServer) ExportUniquely(ObjectVar *DBusObjectSkeleton) {
 
 	xDBusObjectManagerServerExportUniquely(x.GoPointer(), ObjectVar.GoPointer())
@@ -124,7 +127,7 @@ func (x *DBusObjectManagerServer) ExportUniquely(ObjectVar *DBusObjectSkeleton)
 
 var xDBusObjectManagerServerGetConnection func(uintptr) uintptr
 
-// Gets the #GDBusConnection used by @manager.
+// Gets the [DBusConnection] used by manager.
 func (x *DBusObjectManagerServer) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -140,7 +143,7 @@ func (x *DBusObjectManagerServer) GetConnection() *DBusConnection {
 
 var xDBusObjectManagerServerIsExported func(uintptr, uintptr) bool
 
-// Returns whether @object is currently exported on @manager.
+// Returns whether object is currently exported on manager.
 func (x *DBusObjectManagerServer) IsExported(ObjectVar *DBusObjectSkeleton) bool {
 
 	cret := xDBusObjectManagerServerIsExported(x.GoPointer(), ObjectVar.GoPointer())
@@ -149,8 +152,8 @@ func (x *DBusObjectManagerServer) IsExported(ObjectVar *DBusObjectSkeleton) bool
 
 var xDBusObjectManagerServerSetConnection func(uintptr, uintptr)
 
-// Exports all objects managed by @manager on @connection. If
-// @connection is %NULL, stops exporting objects.
+// Exports all objects managed by manager on connection. If
+// connection is nil, stops exporting objects.
 func (x *DBusObjectManagerServer) SetConnection(ConnectionVar *DBusConnection) {
 
 	xDBusObjectManagerServerSetConnection(x.GoPointer(), ConnectionVar.GoPointer())
@@ -159,11 +162,11 @@ func (x *DBusObjectManagerServer) SetConnection(ConnectionVar *DBusConnection) {
 
 var xDBusObjectManagerServerUnexport func(uintptr, string) bool
 
-// If @manager has an object at @path, removes the object. Otherwise
+// If manager has an object at path, removes the object. Otherwise
 // does nothing.
 //
-// Note that @object_path must be in the hierarchy rooted by the
-// object path for @manager.
+// Note that object_path must be in the hierarchy rooted by the
+// object path for manager.
 func (x *DBusObjectManagerServer) Unexport(ObjectPathVar string) bool {
 
 	cret := xDBusObjectManagerServerUnexport(x.GoPointer(), ObjectPathVar)
@@ -198,7 +201,7 @@ func (x *DBusObjectManagerServer) GetPropertyObjectPath() string {
 	return v.GetString()
 }
 
-// Gets the interface proxy for @interface_name at @object_path, if
+// Gets the interface proxy for interface_name at object_path, if
 // any.
 func (x *DBusObjectManagerServer) GetInterface(ObjectPathVar string, InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
@@ -213,7 +216,7 @@ func (x *DBusObjectManagerServer) GetInterface(ObjectPathVar string, InterfaceNa
 	return cls
 }
 
-// Gets the #GDBusObject at @object_path, if any.
+// Gets the [DBusObject] at object_path, if any.
 func (x *DBusObjectManagerServer) GetObject(ObjectPathVar string) *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -227,14 +230,14 @@ func (x *DBusObjectManagerServer) GetObject(ObjectPathVar string) *DBusObjectBas
 	return cls
 }
 
-// Gets the object path that @manager is for.
+// Gets the object path that manager is for.
 func (x *DBusObjectManagerServer) GetObjectPath() string {
 
 	cret := XGDbusObjectManagerGetObjectPath(x.GoPointer())
 	return cret
 }
 
-// Gets all #GDBusObject objects known to @manager.
+// Gets all [DBusObject] objects known to manager.
 func (x *DBusObjectManagerServer) GetObjects() *glib.List {
 
 	cret := XGDbusObjectManagerGetObjects(x.GoPointer())
@@ -246,7 +249,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -255,13 +258,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectManagerServerGLibType, libs, "g_dbus_object_manager_server_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusObjectManagerServer, libs, "g_dbus_object_manager_server_new")
+	gobject.CheckClassSize("GDBusObjectManagerServerClass", xDBusObjectManagerServerGLibType, unsafe.Sizeof(DBusObjectManagerServerClass{}))
+
+	core.PuregoRegisterSince(&xNewDBusObjectManagerServer, libs, "g_dbus_object_manager_server_new", "GIO", "2.30")
 
-	core.PuregoSafeRegister(&xDBusObjectManagerServerExport, libs, "g_dbus_object_manager_server_export")
-	core.PuregoSafeRegister(&xDBusObjectManagerServerExportUniquely, libs, "g_dbus_object_manager_server_export_uniquely")
-	core.PuregoSafeRegister(&xDBusObjectManagerServerGetConnection, libs, "g_dbus_object_manager_server_get_connection")
-	core.PuregoSafeRegister(&xDBusObjectManagerServerIsExported, libs, "g_dbus_object_manager_server_is_exported")
-	core.PuregoSafeRegister(&xDBusObjectManagerServerSetConnection, libs, "g_dbus_object_manager_server_set_connection")
-	core.PuregoSafeRegister(&xDBusObjectManagerServerUnexport, libs, "g_dbus_object_manager_server_unexport")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerExport, libs, "g_dbus_object_manager_server_export", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerExportUniquely, libs, "g_dbus_object_manager_server_export_uniquely", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerGetConnection, libs, "g_dbus_object_manager_server_get_connection", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerIsExported, libs, "g_dbus_object_manager_server_is_exported", "GIO", "2.34")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerSetConnection, libs, "g_dbus_object_manager_server_set_connection", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectManagerServerUnexport, libs, "g_dbus_object_manager_server_unexport", "GIO", "2.30")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusObjectProxy.
+// Class structure for [DBusObjectProxy].
 type DBusObjectProxyClass struct {
 	_ structs.HostLayout
 
@@ -35,7 +34,7 @@ func (x *DBusObjectProxyPrivate) GoPointer() uintptr {
 
 // A `GDBusObjectProxy` is an object used to represent a remote object
 // with one or more D-Bus interfaces. Normally, you don’t instantiate
-// a `GDBusObjectProxy` yourself — typically [class@Gio.DBusObjectManagerClient]
+// a `GDBusObjectProxy` yourself — typically [DBusObjectManagerClient]
 // is used to obtain it.
 type DBusObjectProxy struct {
 	gobject.Object
@@ -55,7 +54,7 @@ func DBusObjectProxyNewFromInternalPtr(ptr uintptr) *DBusObjectProxy {
 
 var xNewDBusObjectProxy func(uintptr, string) uintptr
 
-// Creates a new #GDBusObjectProxy for the given connection and
+// Creates a new [DBusObjectProxy] for the given connection and
 // object path.
 func NewDBusObjectProxy(ConnectionVar *DBusConnection, ObjectPathVar string) *DBusObjectProxy {
 	var cls *DBusObjectProxy
@@ -72,7 +71,7 @@ func NewDBusObjectProxy(ConnectionVar *DBusConnection, ObjectPathVar string) *DB
 
 var xDBusObjectProxyGetConnection func(uintptr) uintptr
 
-// Gets the connection that @proxy is for.
+// Gets the connection that proxy is for.
 func (x *DBusObjectProxy) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -115,8 +114,8 @@ func (x *DBusObjectProxy) GetPropertyGObjectPath() string {
 	return v.GetString()
 }
 
-// Gets the D-Bus interface with name @interface_name associated with
-// @object, if any.
+// Gets the D-Bus interface with name interface_name associated with
+// object, if any.
 func (x *DBusObjectProxy) GetInterface(InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
 
@@ -130,14 +129,14 @@ func (x *DBusObjectProxy) GetInterface(InterfaceNameVar string) *DBusInterfaceBa
 	return cls
 }
 
-// Gets the D-Bus interfaces associated with @object.
+// Gets the D-Bus interfaces associated with object.
 func (x *DBusObjectProxy) GetInterfaces() *glib.List {
 
 	cret := XGDbusObjectGetInterfaces(x.GoPointer())
 	return cret
 }
 
-// Gets the object path for @object.
+// Gets the object path for object.
 func (x *DBusObjectProxy) GetObjectPath() string {
 
 	cret := XGDbusObjectGetObjectPath(x.GoPointer())
@@ -149,7 +148,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -158,8 +157,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectProxyGLibType, libs, "g_dbus_object_proxy_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusObjectProxy, libs, "g_dbus_object_proxy_new")
+	gobject.CheckClassSize("GDBusObjectProxyClass", xDBusObjectProxyGLibType, unsafe.Sizeof(DBusObjectProxyClass{}))
 
-	core.PuregoSafeRegister(&xDBusObjectProxyGetConnection, libs, "g_dbus_object_proxy_get_connection")
+	core.PuregoRegisterSince(&xNewDBusObjectProxy, libs, "g_dbus_object_proxy_new", "GIO", "2.30")
+
+	core.PuregoRegisterSince(&xDBusObjectProxyGetConnection, libs, "g_dbus_object_proxy_get_connection", "GIO", "2.30")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusObjectSkeleton.
+// Class structure for [DBusObjectSkeleton].
 type DBusObjectSkeletonClass struct {
 	_ structs.HostLayout
 
@@ -28,25 +27,26 @@ func (x *DBusObjectSkeletonClass) GoPointer() uintptr {
 }
 
 // OverrideAuthorizeMethod sets the "authorize_method" callback function.
-// Signal class handler for the #GDBusObjectSkeleton::authorize-method signal.
+// Signal class handler for the [DBusObjectSkeleton.ConnectAuthorizeMethodFunc] signal.
 func (x *DBusObjectSkeletonClass) OverrideAuthorizeMethod(cb func(*DBusObjectSkeleton, *DBusInterfaceSkeleton, *DBusMethodInvocation) bool) {
 	if cb == nil {
 		x.xAuthorizeMethod = 0
 	} else {
-		x.xAuthorizeMethod = purego.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+		x.xAuthorizeMethod = core.NewCallback(func(ObjectVarp uintptr, InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(DBusObjectSkeletonNewFromInternalPtr(ObjectVarp), DBusInterfaceSkeletonNewFromInternalPtr(InterfaceVarp), DBusMethodInvocationNewFromInternalPtr(InvocationVarp))
 		})
 	}
 }
 
 // GetAuthorizeMethod gets the "authorize_method" callback function.
-// Signal class handler for the #GDBusObjectSkeleton::authorize-method signal.
+// Signal class handler for the [DBusObjectSkeleton.ConnectAuthorizeMethodFunc] signal.
 func (x *DBusObjectSkeletonClass) GetAuthorizeMethod() func(*DBusObjectSkeleton, *DBusInterfaceSkeleton, *DBusMethodInvocation) bool {
 	if x.xAuthorizeMethod == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, InterfaceVarp uintptr, InvocationVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xAuthorizeMethod)
+	core.RegisterFunc(&rawCallback, x.xAuthorizeMethod)
 	return func(ObjectVar *DBusObjectSkeleton, InterfaceVar *DBusInterfaceSkeleton, InvocationVar *DBusMethodInvocation) bool {
 		return rawCallback(ObjectVar.GoPointer(), InterfaceVar.GoPointer(), InvocationVar.GoPointer())
 	}
@@ -64,7 +64,7 @@ func (x *DBusObjectSkeletonPrivate) GoPointer() uintptr {
 // interfaces. The set of exported interfaces on the object may be
 // dynamic and change at runtime.
 //
-// This type is intended to be used with [iface@Gio.DBusObjectManager].
+// This type is intended to be used with [DBusObjectManager].
 type DBusObjectSkeleton struct {
 	gobject.Object
 }
@@ -83,7 +83,7 @@ func DBusObjectSkeletonNewFromInternalPtr(ptr uintptr) *DBusObjectSkeleton {
 
 var xNewDBusObjectSkeleton func(string) uintptr
 
-// Creates a new #GDBusObjectSkeleton.
+// Creates a new [DBusObjectSkeleton].
 func NewDBusObjectSkeleton(ObjectPathVar string) *DBusObjectSkeleton {
 	var cls *DBusObjectSkeleton
 
@@ -99,12 +99,12 @@ func NewDBusObjectSkeleton(ObjectPathVar string) *DBusObjectSkeleton {
 
 var xDBusObjectSkeletonAddInterface func(uintptr, uintptr)
 
-// Adds @interface_ to @object.
+// Adds interface_ to object.
 //
-// If @object already contains a #GDBusInterfaceSkeleton with the same
-// interface name, it is removed before @interface_ is added.
+// If object already contains a [DBusInterfaceSkeleton] with the same
+// interface name, it is removed before interface_ is added.
 //
-// Note that @object takes its own reference on @interface_ and holds
+// Note that object takes its own reference on interface_ and holds
 // it until removed.
 func (x *DBusObjectSkeleton) AddInterface(InterfaceVar *DBusInterfaceSkeleton) {
 
@@ -114,8 +114,8 @@ func (x *DBusObjectSkeleton) AddInterface(InterfaceVar *DBusInterfaceSkeleton) {
 
 var xDBusObjectSkeletonFlush func(uintptr)
 
-// This method simply calls g_dbus_interface_skeleton_flush() on all
-// interfaces belonging to @object. See that method for when flushing
+// This method simply calls [DBusInterfaceSkeleton.Flush] on all
+// interfaces belonging to object. See that method for when flushing
 // is useful.
 func (x *DBusObjectSkeleton) Flush() {
 
@@ -125,7 +125,7 @@ func (x *DBusObjectSkeleton) Flush() {
 
 var xDBusObjectSkeletonRemoveInterface func(uintptr, uintptr)
 
-// Removes @interface_ from @object.
+// Removes interface_ from object.
 func (x *DBusObjectSkeleton) RemoveInterface(InterfaceVar *DBusInterfaceSkeleton) {
 
 	xDBusObjectSkeletonRemoveInterface(x.GoPointer(), InterfaceVar.GoPointer())
@@ -134,7 +134,7 @@ func (x *DBusObjectSkeleton) RemoveInterface(InterfaceVar *DBusInterfaceSkeleton
 
 var xDBusObjectSkeletonRemoveInterfaceByName func(uintptr, string)
 
-// Removes the #GDBusInterface with @interface_name from @object.
+// Removes the [DBusInterface] with interface_name from object.
 //
 // If no D-Bus interface of the given interface exists, this function
 // does nothing.
@@ -146,7 +146,7 @@ func (x *DBusObjectSkeleton) RemoveInterfaceByName(InterfaceNameVar string) {
 
 var xDBusObjectSkeletonSetObjectPath func(uintptr, string)
 
-// Sets the object path for @object.
+// Sets the object path for object.
 func (x *DBusObjectSkeleton) SetObjectPath(ObjectPathVar string) {
 
 	xDBusObjectSkeletonSetObjectPath(x.GoPointer(), ObjectPathVar)
@@ -184,36 +184,58 @@ func (x *DBusObjectSkeleton) GetPropertyGObjectPath() string {
 // Emitted when a method is invoked by a remote caller and used to
 // determine if the method call is authorized.
 //
-// This signal is like #GDBusInterfaceSkeleton's
-// #GDBusInterfaceSkeleton::g-authorize-method signal,
+// This signal is like [DBusInterfaceSkeleton]'s
+// [DBusInterfaceSkeleton.ConnectGAuthorizeMethodFunc] signal,
 // except that it is for the enclosing object.
 //
-// The default class handler just returns %TRUE.
+// The default class handler just returns true.
+//
+// Deprecated: use ConnectAuthorizeMethodFunc, which also accepts method values and closures.
 func (x *DBusObjectSkeleton) ConnectAuthorizeMethod(cb *func(DBusObjectSkeleton, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "authorize-method", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DBusObjectSkeleton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, InterfaceVarp, InvocationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "authorize-method", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, InterfaceVarp uintptr, InvocationVarp uintptr) bool {
+var xDBusObjectSkeletonAuthorizeMethodTrampoline uintptr
+
+func xDBusObjectSkeletonAuthorizeMethodNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InterfaceVarp uintptr, InvocationVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusObjectSkeleton, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusObjectSkeleton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, InterfaceVarp, InvocationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "authorize-method", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the D-Bus interface with name @interface_name associated with
-// @object, if any.
+// ConnectAuthorizeMethodFunc connects cb to the "authorize-method" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusObjectSkeleton) ConnectAuthorizeMethodFunc(cb func(DBusObjectSkeleton, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "authorize-method", &xDBusObjectSkeletonAuthorizeMethodTrampoline, xDBusObjectSkeletonAuthorizeMethodNewTrampoline, cb)
+}
+
+// Gets the D-Bus interface with name interface_name associated with
+// object, if any.
 func (x *DBusObjectSkeleton) GetInterface(InterfaceNameVar string) *DBusInterfaceBase {
 	var cls *DBusInterfaceBase
 
@@ -227,14 +249,14 @@ func (x *DBusObjectSkeleton) GetInterface(InterfaceNameVar string) *DBusInterfac
 	return cls
 }
 
-// Gets the D-Bus interfaces associated with @object.
+// Gets the D-Bus interfaces associated with object.
 func (x *DBusObjectSkeleton) GetInterfaces() *glib.List {
 
 	cret := XGDbusObjectGetInterfaces(x.GoPointer())
 	return cret
 }
 
-// Gets the object path for @object.
+// Gets the object path for object.
 func (x *DBusObjectSkeleton) GetObjectPath() string {
 
 	cret := XGDbusObjectGetObjectPath(x.GoPointer())
@@ -246,7 +268,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -255,12 +277,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusObjectSkeletonGLibType, libs, "g_dbus_object_skeleton_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusObjectSkeleton, libs, "g_dbus_object_skeleton_new")
+	gobject.CheckClassSize("GDBusObjectSkeletonClass", xDBusObjectSkeletonGLibType, unsafe.Sizeof(DBusObjectSkeletonClass{}))
+
+	core.PuregoRegisterSince(&xNewDBusObjectSkeleton, libs, "g_dbus_object_skeleton_new", "GIO", "2.30")
 
-	core.PuregoSafeRegister(&xDBusObjectSkeletonAddInterface, libs, "g_dbus_object_skeleton_add_interface")
-	core.PuregoSafeRegister(&xDBusObjectSkeletonFlush, libs, "g_dbus_object_skeleton_flush")
-	core.PuregoSafeRegister(&xDBusObjectSkeletonRemoveInterface, libs, "g_dbus_object_skeleton_remove_interface")
-	core.PuregoSafeRegister(&xDBusObjectSkeletonRemoveInterfaceByName, libs, "g_dbus_object_skeleton_remove_interface_by_name")
-	core.PuregoSafeRegister(&xDBusObjectSkeletonSetObjectPath, libs, "g_dbus_object_skeleton_set_object_path")
+	core.PuregoRegisterSince(&xDBusObjectSkeletonAddInterface, libs, "g_dbus_object_skeleton_add_interface", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectSkeletonFlush, libs, "g_dbus_object_skeleton_flush", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectSkeletonRemoveInterface, libs, "g_dbus_object_skeleton_remove_interface", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectSkeletonRemoveInterfaceByName, libs, "g_dbus_object_skeleton_remove_interface_by_name", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusObjectSkeletonSetObjectPath, libs, "g_dbus_object_skeleton_set_object_path", "GIO", "2.30")
 
 }
@@ -6,14 +6,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GDBusProxy.
+// Class structure for [DBusProxy].
 type DBusProxyClass struct {
 	_ structs.HostLayout
 
@@ -31,50 +30,52 @@ func (x *DBusProxyClass) GoPointer() uintptr {
 }
 
 // OverrideGPropertiesChanged sets the "g_properties_changed" callback function.
-// Signal class handler for the #GDBusProxy::g-properties-changed signal.
+// Signal class handler for the [DBusProxy.ConnectGPropertiesChangedFunc] signal.
 func (x *DBusProxyClass) OverrideGPropertiesChanged(cb func(*DBusProxy, *glib.Variant, string)) {
 	if cb == nil {
 		x.xGPropertiesChanged = 0
 	} else {
-		x.xGPropertiesChanged = purego.NewCallback(func(ProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string) {
+		x.xGPropertiesChanged = core.NewCallback(func(ProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string) {
+			defer core.RecoverPanic()
 			cb(DBusProxyNewFromInternalPtr(ProxyVarp), ChangedPropertiesVarp, InvalidatedPropertiesVarp)
 		})
 	}
 }
 
 // GetGPropertiesChanged gets the "g_properties_changed" callback function.
-// Signal class handler for the #GDBusProxy::g-properties-changed signal.
+// Signal class handler for the [DBusProxy.ConnectGPropertiesChangedFunc] signal.
 func (x *DBusProxyClass) GetGPropertiesChanged() func(*DBusProxy, *glib.Variant, string) {
 	if x.xGPropertiesChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr, ChangedPropertiesVarp *glib.Variant, InvalidatedPropertiesVarp string)
-	purego.RegisterFunc(&rawCallback, x.xGPropertiesChanged)
+	core.RegisterFunc(&rawCallback, x.xGPropertiesChanged)
 	return func(ProxyVar *DBusProxy, ChangedPropertiesVar *glib.Variant, InvalidatedPropertiesVar string) {
 		rawCallback(ProxyVar.GoPointer(), ChangedPropertiesVar, InvalidatedPropertiesVar)
 	}
 }
 
 // OverrideGSignal sets the "g_signal" callback function.
-// Signal class handler for the #GDBusProxy::g-signal signal.
+// Signal class handler for the [DBusProxy.ConnectGSignalFunc] signal.
 func (x *DBusProxyClass) OverrideGSignal(cb func(*DBusProxy, string, string, *glib.Variant)) {
 	if cb == nil {
 		x.xGSignal = 0
 	} else {
-		x.xGSignal = purego.NewCallback(func(ProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant) {
+		x.xGSignal = core.NewCallback(func(ProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(DBusProxyNewFromInternalPtr(ProxyVarp), SenderNameVarp, SignalNameVarp, ParametersVarp)
 		})
 	}
 }
 
 // GetGSignal gets the "g_signal" callback function.
-// Signal class handler for the #GDBusProxy::g-signal signal.
+// Signal class handler for the [DBusProxy.ConnectGSignalFunc] signal.
 func (x *DBusProxyClass) GetGSignal() func(*DBusProxy, string, string, *glib.Variant) {
 	if x.xGSignal == 0 {
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xGSignal)
+	core.RegisterFunc(&rawCallback, x.xGSignal)
 	return func(ProxyVar *DBusProxy, SenderNameVar string, SignalNameVar string, ParametersVar *glib.Variant) {
 		rawCallback(ProxyVar.GoPointer(), SenderNameVar, SignalNameVar, ParametersVar)
 	}
@@ -95,13 +96,13 @@ func (x *DBusProxyPrivate) GoPointer() uintptr {
 // By default, `GDBusProxy` will cache all properties (and listen to
 // changes) of the remote object, and proxy all signals that get
 // emitted. This behaviour can be changed by passing suitable
-// [flags@Gio.DBusProxyFlags] when the proxy is created. If the proxy is for a
+// [DBusProxyFlags] when the proxy is created. If the proxy is for a
 // well-known name, the property cache is flushed when the name owner
 // vanishes and reloaded when a name owner appears.
 //
 // The unique name owner of the proxy’s name is tracked and can be read from
-// [property@Gio.DBusProxy:g-name-owner]. Connect to the
-// [signal@GObject.Object::notify] signal to get notified of changes.
+// [DBusProxy.GetPropertyGNameOwner]. Connect to the
+// [gobject.Object.ConnectNotifyFunc] signal to get notified of changes.
 // Additionally, only signals and property changes emitted from the current name
 // owner are considered and calls are always sent to the current name owner.
 // This avoids a number of race conditions when the name is lost by one owner
@@ -112,29 +113,32 @@ func (x *DBusProxyPrivate) GoPointer() uintptr {
 //
 // If the proxy is for a stateless D-Bus service, where the name owner may
 // be started and stopped between calls, the
-// [property@Gio.DBusProxy:g-name-owner] tracking of `GDBusProxy` will cause the
+// [DBusProxy.GetPropertyGNameOwner] tracking of `GDBusProxy` will cause the
 // proxy to drop signal and property changes from the service after it has
 // restarted for the first time. When interacting with a stateless D-Bus
 // service, do not use `GDBusProxy` — use direct D-Bus method calls and signal
 // connections.
 //
-// The generic [signal@Gio.DBusProxy::g-properties-changed] and
-// [signal@Gio.DBusProxy::g-signal] signals are not very convenient to work
+// The generic [DBusProxy.ConnectGPropertiesChangedFunc] and
+// [DBusProxy.ConnectGSignalFunc] signals are not very convenient to work
 // with. Therefore, the recommended way of working with proxies is to subclass
 // `GDBusProxy`, and have more natural properties and signals in your derived
-// class. This [example](migrating-gdbus.html#using-gdbus-codegen) shows how
-// this can easily be done using the [`gdbus-codegen`](gdbus-codegen.html) tool.
+// class. This example shows how
+// this can easily be done using the gdbus-codegen tool.
 //
 // A `GDBusProxy` instance can be used from multiple threads but note
-// that all signals (e.g. [signal@Gio.DBusProxy::g-signal],
-// [signal@Gio.DBusProxy::g-properties-changed] and
-// [signal@GObject.Object::notify]) are emitted in the thread-default main
-// context (see [method@GLib.MainContext.push_thread_default]) of the thread
+// that all signals (e.g. [DBusProxy.ConnectGSignalFunc],
+// [DBusProxy.ConnectGPropertiesChangedFunc] and
+// [gobject.Object.ConnectNotifyFunc]) are emitted in the thread-default main
+// context (see [glib.MainContext.PushThreadDefault]) of the thread
 // where the instance was constructed.
 //
-// ## A watch proxy example
+// # A watch proxy example
+//
 // An example using a proxy for a well-known name can be found in
-// [`gdbus-example-watch-proxy.c`](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-watch-proxy.c).
+// [gdbus-example-watch-proxy.c].
+//
+// [gdbus-example-watch-proxy.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-watch-proxy.c
 type DBusProxy struct {
 	gobject.Object
 }
@@ -153,7 +157,7 @@ func DBusProxyNewFromInternalPtr(ptr uintptr) *DBusProxy {
 
 var xNewDBusProxyFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes creating a #GDBusProxy.
+// Finishes creating a [DBusProxy].
 func NewDBusProxyFinish(ResVar AsyncResult) (*DBusProxy, error) {
 	var cls *DBusProxy
 	var cerr *glib.Error
@@ -161,12 +165,15 @@ func NewDBusProxyFinish(ResVar AsyncResult) (*DBusProxy, error) {
 	cret := xNewDBusProxyFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusProxy{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -174,7 +181,7 @@ func NewDBusProxyFinish(ResVar AsyncResult) (*DBusProxy, error) {
 
 var xNewDBusProxyForBusFinish func(uintptr, **glib.Error) uintptr
 
-// Finishes creating a #GDBusProxy.
+// Finishes creating a [DBusProxy].
 func NewDBusProxyForBusFinish(ResVar AsyncResult) (*DBusProxy, error) {
 	var cls *DBusProxy
 	var cerr *glib.Error
@@ -182,12 +189,15 @@ func NewDBusProxyForBusFinish(ResVar AsyncResult) (*DBusProxy, error) {
 	cret := xNewDBusProxyForBusFinish(ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusProxy{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -195,9 +205,9 @@ func NewDBusProxyForBusFinish(ResVar AsyncResult) (*DBusProxy, error) {
 
 var xNewDBusProxyForBusSync func(BusType, DBusProxyFlags, *DBusInterfaceInfo, string, string, string, uintptr, **glib.Error) uintptr
 
-// Like g_dbus_proxy_new_sync() but takes a #GBusType instead of a #GDBusConnection.
+// Like [NewDBusProxySync] but takes a [BusType] instead of a [DBusConnection].
 //
-// #GDBusProxy is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
+// [DBusProxy] is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
 func NewDBusProxyForBusSync(BusTypeVar BusType, FlagsVar DBusProxyFlags, InfoVar *DBusInterfaceInfo, NameVar string, ObjectPathVar string, InterfaceNameVar string, CancellableVar *Cancellable) (*DBusProxy, error) {
 	var cls *DBusProxy
 	var cerr *glib.Error
@@ -205,12 +215,15 @@ func NewDBusProxyForBusSync(BusTypeVar BusType, FlagsVar DBusProxyFlags, InfoVar
 	cret := xNewDBusProxyForBusSync(BusTypeVar, FlagsVar, InfoVar, NameVar, ObjectPathVar, InterfaceNameVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusProxy{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -218,28 +231,28 @@ func NewDBusProxyForBusSync(BusTypeVar BusType, FlagsVar DBusProxyFlags, InfoVar
 
 var xNewDBusProxySync func(uintptr, DBusProxyFlags, *DBusInterfaceInfo, uintptr, string, string, uintptr, **glib.Error) uintptr
 
-// Creates a proxy for accessing @interface_name on the remote object
-// at @object_path owned by @name at @connection and synchronously
+// Creates a proxy for accessing interface_name on the remote object
+// at object_path owned by name at connection and synchronously
 // loads D-Bus properties unless the
-// %G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES flag is used.
+// [GDbusProxyFlagsDoNotLoadPropertiesValue] flag is used.
 //
-// If the %G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS flag is not set, also sets up
-// match rules for signals. Connect to the #GDBusProxy::g-signal signal
+// If the [GDbusProxyFlagsDoNotConnectSignalsValue] flag is not set, also sets up
+// match rules for signals. Connect to the [DBusProxy.ConnectGSignalFunc] signal
 // to handle signals from the remote object.
 //
-// If both %G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES and
-// %G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS are set, this constructor is
+// If both [GDbusProxyFlagsDoNotLoadPropertiesValue] and
+// [GDbusProxyFlagsDoNotConnectSignalsValue] are set, this constructor is
 // guaranteed to return immediately without blocking.
 //
-// If @name is a well-known name and the
-// %G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START and %G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START_AT_CONSTRUCTION
+// If name is a well-known name and the
+// [GDbusProxyFlagsDoNotAutoStartValue] and [GDbusProxyFlagsDoNotAutoStartAtConstructionValue]
 // flags aren't set and no name owner currently exists, the message bus
 // will be requested to launch a name owner for the name.
 //
-// This is a synchronous failable constructor. See g_dbus_proxy_new()
-// and g_dbus_proxy_new_finish() for the asynchronous version.
+// This is a synchronous failable constructor. See [DBusProxyNew]
+// and [NewDBusProxyFinish] for the asynchronous version.
 //
-// #GDBusProxy is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
+// [DBusProxy] is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
 func NewDBusProxySync(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, InfoVar *DBusInterfaceInfo, NameVar *string, ObjectPathVar string, InterfaceNameVar string, CancellableVar *Cancellable) (*DBusProxy, error) {
 	var cls *DBusProxy
 	var cerr *glib.Error
@@ -250,12 +263,15 @@ func NewDBusProxySync(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, In
 	cret := xNewDBusProxySync(ConnectionVar.GoPointer(), FlagsVar, InfoVar, NameVarPtr, ObjectPathVar, InterfaceNameVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusProxy{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -263,22 +279,21 @@ func NewDBusProxySync(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, In
 
 var xDBusProxyCall func(uintptr, string, *glib.Variant, DBusCallFlags, int, uintptr, uintptr, uintptr)
 
-// Asynchronously invokes the @method_name method on @proxy.
+// Asynchronously invokes the method_name method on proxy.
 //
-// If @method_name contains any dots, then @name is split into interface and
-// method name parts. This allows using @proxy for invoking methods on
+// If method_name contains any dots, then name is split into interface and
+// method name parts. This allows using proxy for invoking methods on
 // other interfaces.
 //
-// If the #GDBusConnection associated with @proxy is closed then
-// the operation will fail with %G_IO_ERROR_CLOSED. If
-// @cancellable is canceled, the operation will fail with
-// %G_IO_ERROR_CANCELLED. If @parameters contains a value not
+// If the [DBusConnection] associated with proxy is closed then
+// the operation will fail with [GIoErrorClosedValue]. If
+// cancellable is canceled, the operation will fail with
+// [GIoErrorCancelledValue]. If parameters contains a value not
 // compatible with the D-Bus protocol, the operation fails with
-// %G_IO_ERROR_INVALID_ARGUMENT.
+// [GIoErrorInvalidArgumentValue].
 //
-// If the @parameters #GVariant is floating, it is consumed. This allows
-// convenient 'inline' use of g_variant_new(), e.g.:
-// |[&lt;!-- language="C" --&gt;
+// If the parameters [glib.Variant] is floating, it is consumed. This allows
+// convenient 'inline' use of [glib.NewVariant], e.g.:
 //
 //	g_dbus_proxy_call (proxy,
 //	                   "TwoStrings",
@@ -289,24 +304,22 @@ var xDBusProxyCall func(uintptr, string, *glib.Variant, DBusCallFlags, int, uint
 //	                   -1,
 //	                   NULL,
 //	                   (GAsyncReadyCallback) two_strings_done,
-//	                   &amp;data);
+//	                   &data);
 //
-// ]|
-//
-// If @proxy has an expected interface (see
-// #GDBusProxy:g-interface-info) and @method_name is referenced by it,
+// If proxy has an expected interface (see
+// [DBusProxy.GetPropertyGInterfaceInfo]) and method_name is referenced by it,
 // then the return value is checked against the return type.
 //
 // This is an asynchronous method. When the operation is finished,
-// @callback will be invoked in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
+// callback will be invoked in the thread-default main context
+// (see [glib.MainContext.PushThreadDefault])
 // of the thread you are calling this method from.
-// You can then call g_dbus_proxy_call_finish() to get the result of
-// the operation. See g_dbus_proxy_call_sync() for the synchronous
+// You can then call [DBusProxy.CallFinish] to get the result of
+// the operation. See [DBusProxy.CallSync] for the synchronous
 // version of this method.
 //
-// If @callback is %NULL then the D-Bus method call message will be sent with
-// the %G_DBUS_MESSAGE_FLAGS_NO_REPLY_EXPECTED flag set.
+// If callback is nil then the D-Bus method call message will be sent with
+// the [GDbusMessageFlagsNoReplyExpectedValue] flag set.
 func (x *DBusProxy) Call(MethodNameVar string, ParametersVar *glib.Variant, FlagsVar DBusCallFlags, TimeoutMsecVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -316,11 +329,12 @@ func (x *DBusProxy) Call(MethodNameVar string, ParametersVar *glib.Variant, Flag
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -330,13 +344,13 @@ func (x *DBusProxy) Call(MethodNameVar string, ParametersVar *glib.Variant, Flag
 
 var xDBusProxyCallFinish func(uintptr, uintptr, **glib.Error) *glib.Variant
 
-// Finishes an operation started with g_dbus_proxy_call().
+// Finishes an operation started with [DBusProxy.Call].
 func (x *DBusProxy) CallFinish(ResVar AsyncResult) (*glib.Variant, error) {
 	var cerr *glib.Error
 
 	cret := xDBusProxyCallFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -344,22 +358,21 @@ func (x *DBusProxy) CallFinish(ResVar AsyncResult) (*glib.Variant, error) {
 
 var xDBusProxyCallSync func(uintptr, string, *glib.Variant, DBusCallFlags, int, uintptr, **glib.Error) *glib.Variant
 
-// Synchronously invokes the @method_name method on @proxy.
+// Synchronously invokes the method_name method on proxy.
 //
-// If @method_name contains any dots, then @name is split into interface and
-// method name parts. This allows using @proxy for invoking methods on
+// If method_name contains any dots, then name is split into interface and
+// method name parts. This allows using proxy for invoking methods on
 // other interfaces.
 //
-// If the #GDBusConnection associated with @proxy is disconnected then
-// the operation will fail with %G_IO_ERROR_CLOSED. If
-// @cancellable is canceled, the operation will fail with
-// %G_IO_ERROR_CANCELLED. If @parameters contains a value not
+// If the [DBusConnection] associated with proxy is disconnected then
+// the operation will fail with [GIoErrorClosedValue]. If
+// cancellable is canceled, the operation will fail with
+// [GIoErrorCancelledValue]. If parameters contains a value not
 // compatible with the D-Bus protocol, the operation fails with
-// %G_IO_ERROR_INVALID_ARGUMENT.
+// [GIoErrorInvalidArgumentValue].
 //
-// If the @parameters #GVariant is floating, it is consumed. This allows
-// convenient 'inline' use of g_variant_new(), e.g.:
-// |[&lt;!-- language="C" --&gt;
+// If the parameters [glib.Variant] is floating, it is consumed. This allows
+// convenient 'inline' use of [glib.NewVariant], e.g.:
 //
 //	g_dbus_proxy_call_sync (proxy,
 //	                        "TwoStrings",
@@ -369,23 +382,21 @@ var xDBusProxyCallSync func(uintptr, string, *glib.Variant, DBusCallFlags, int,
 //	                        G_DBUS_CALL_FLAGS_NONE,
 //	                        -1,
 //	                        NULL,
-//	                        &amp;error);
-//
-// ]|
+//	                        &error);
 //
 // The calling thread is blocked until a reply is received. See
-// g_dbus_proxy_call() for the asynchronous version of this
+// [DBusProxy.Call] for the asynchronous version of this
 // method.
 //
-// If @proxy has an expected interface (see
-// #GDBusProxy:g-interface-info) and @method_name is referenced by it,
+// If proxy has an expected interface (see
+// [DBusProxy.GetPropertyGInterfaceInfo]) and method_name is referenced by it,
 // then the return value is checked against the return type.
 func (x *DBusProxy) CallSync(MethodNameVar string, ParametersVar *glib.Variant, FlagsVar DBusCallFlags, TimeoutMsecVar int, CancellableVar *Cancellable) (*glib.Variant, error) {
 	var cerr *glib.Error
 
 	cret := xDBusProxyCallSync(x.GoPointer(), MethodNameVar, ParametersVar, FlagsVar, TimeoutMsecVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -393,7 +404,7 @@ func (x *DBusProxy) CallSync(MethodNameVar string, ParametersVar *glib.Variant,
 
 var xDBusProxyCallWithUnixFdList func(uintptr, string, *glib.Variant, DBusCallFlags, int, uintptr, uintptr, uintptr, uintptr)
 
-// Like g_dbus_proxy_call() but also takes a #GUnixFDList object.
+// Like [DBusProxy.Call] but also takes a [UnixFDList] object.
 //
 // This method is only available on UNIX.
 func (x *DBusProxy) CallWithUnixFdList(MethodNameVar string, ParametersVar *glib.Variant, FlagsVar DBusCallFlags, TimeoutMsecVar int, FdListVar *UnixFDList, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
@@ -405,11 +416,12 @@ func (x *DBusProxy) CallWithUnixFdList(MethodNameVar string, ParametersVar *glib
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -419,13 +431,13 @@ func (x *DBusProxy) CallWithUnixFdList(MethodNameVar string, ParametersVar *glib
 
 var xDBusProxyCallWithUnixFdListFinish func(uintptr, **UnixFDList, uintptr, **glib.Error) *glib.Variant
 
-// Finishes an operation started with g_dbus_proxy_call_with_unix_fd_list().
+// Finishes an operation started with [DBusProxy.CallWithUnixFdList].
 func (x *DBusProxy) CallWithUnixFdListFinish(OutFdListVar **UnixFDList, ResVar AsyncResult) (*glib.Variant, error) {
 	var cerr *glib.Error
 
 	cret := xDBusProxyCallWithUnixFdListFinish(x.GoPointer(), OutFdListVar, ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -433,7 +445,7 @@ func (x *DBusProxy) CallWithUnixFdListFinish(OutFdListVar **UnixFDList, ResVar A
 
 var xDBusProxyCallWithUnixFdListSync func(uintptr, string, *glib.Variant, DBusCallFlags, int, uintptr, **UnixFDList, uintptr, **glib.Error) *glib.Variant
 
-// Like g_dbus_proxy_call_sync() but also takes and returns #GUnixFDList objects.
+// Like [DBusProxy.CallSync] but also takes and returns [UnixFDList] objects.
 //
 // This method is only available on UNIX.
 func (x *DBusProxy) CallWithUnixFdListSync(MethodNameVar string, ParametersVar *glib.Variant, FlagsVar DBusCallFlags, TimeoutMsecVar int, FdListVar *UnixFDList, OutFdListVar **UnixFDList, CancellableVar *Cancellable) (*glib.Variant, error) {
@@ -441,7 +453,7 @@ func (x *DBusProxy) CallWithUnixFdListSync(MethodNameVar string, ParametersVar *
 
 	cret := xDBusProxyCallWithUnixFdListSync(x.GoPointer(), MethodNameVar, ParametersVar, FlagsVar, TimeoutMsecVar, FdListVar.GoPointer(), OutFdListVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -452,27 +464,27 @@ var xDBusProxyGetCachedProperty func(uintptr, string) *glib.Variant
 // Looks up the value for a property from the cache. This call does no
 // blocking IO.
 //
-// If @proxy has an expected interface (see
-// #GDBusProxy:g-interface-info) and @property_name is referenced by
-// it, then @value is checked against the type of the property.
+// If proxy has an expected interface (see
+// [DBusProxy.GetPropertyGInterfaceInfo]) and property_name is referenced by
+// it, then value is checked against the type of the property.
 func (x *DBusProxy) GetCachedProperty(PropertyNameVar string) *glib.Variant {
 
 	cret := xDBusProxyGetCachedProperty(x.GoPointer(), PropertyNameVar)
 	return cret
 }
 
-var xDBusProxyGetCachedPropertyNames func(uintptr) []string
+var xDBusProxyGetCachedPropertyNames func(uintptr) uintptr
 
-// Gets the names of all cached properties on @proxy.
+// Gets the names of all cached properties on proxy.
 func (x *DBusProxy) GetCachedPropertyNames() []string {
 
 	cret := xDBusProxyGetCachedPropertyNames(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xDBusProxyGetConnection func(uintptr) uintptr
 
-// Gets the connection @proxy is for.
+// Gets the connection proxy is for.
 func (x *DBusProxy) GetConnection() *DBusConnection {
 	var cls *DBusConnection
 
@@ -490,10 +502,10 @@ func (x *DBusProxy) GetConnection() *DBusConnection {
 var xDBusProxyGetDefaultTimeout func(uintptr) int
 
 // Gets the timeout to use if -1 (specifying default timeout) is
-// passed as @timeout_msec in the g_dbus_proxy_call() and
-// g_dbus_proxy_call_sync() functions.
+// passed as timeout_msec in the [DBusProxy.Call] and
+// [DBusProxy.CallSync] functions.
 //
-// See the #GDBusProxy:g-default-timeout property for more details.
+// See the [DBusProxy.GetPropertyGDefaultTimeout] property for more details.
 func (x *DBusProxy) GetDefaultTimeout() int {
 
 	cret := xDBusProxyGetDefaultTimeout(x.GoPointer())
@@ -502,7 +514,7 @@ func (x *DBusProxy) GetDefaultTimeout() int {
 
 var xDBusProxyGetFlags func(uintptr) DBusProxyFlags
 
-// Gets the flags that @proxy was constructed with.
+// Gets the flags that proxy was constructed with.
 func (x *DBusProxy) GetFlags() DBusProxyFlags {
 
 	cret := xDBusProxyGetFlags(x.GoPointer())
@@ -511,8 +523,8 @@ func (x *DBusProxy) GetFlags() DBusProxyFlags {
 
 var xDBusProxyGetInterfaceInfo func(uintptr) *DBusInterfaceInfo
 
-// Returns the #GDBusInterfaceInfo, if any, specifying the interface
-// that @proxy conforms to. See the #GDBusProxy:g-interface-info
+// Returns the [DBusInterfaceInfo], if any, specifying the interface
+// that proxy conforms to. See the [DBusProxy.GetPropertyGInterfaceInfo]
 // property for more details.
 func (x *DBusProxy) GetInterfaceInfo() *DBusInterfaceInfo {
 
@@ -522,7 +534,7 @@ func (x *DBusProxy) GetInterfaceInfo() *DBusInterfaceInfo {
 
 var xDBusProxyGetInterfaceName func(uintptr) string
 
-// Gets the D-Bus interface name @proxy is for.
+// Gets the D-Bus interface name proxy is for.
 func (x *DBusProxy) GetInterfaceName() string {
 
 	cret := xDBusProxyGetInterfaceName(x.GoPointer())
@@ -531,10 +543,10 @@ func (x *DBusProxy) GetInterfaceName() string {
 
 var xDBusProxyGetName func(uintptr) string
 
-// Gets the name that @proxy was constructed for.
+// Gets the name that proxy was constructed for.
 //
-// When connected to a message bus, this will usually be non-%NULL.
-// However, it may be %NULL for a proxy that communicates using a peer-to-peer
+// When connected to a message bus, this will usually be non-nil.
+// However, it may be nil for a proxy that communicates using a peer-to-peer
 // pattern.
 func (x *DBusProxy) GetName() string {
 
@@ -544,10 +556,10 @@ func (x *DBusProxy) GetName() string {
 
 var xDBusProxyGetNameOwner func(uintptr) string
 
-// The unique name that owns the name that @proxy is for or %NULL if
+// The unique name that owns the name that proxy is for or nil if
 // no-one currently owns that name. You may connect to the
-// #GObject::notify signal to track changes to the
-// #GDBusProxy:g-name-owner property.
+// [gobject.Object.ConnectNotifyFunc] signal to track changes to the
+// [DBusProxy.GetPropertyGNameOwner] property.
 func (x *DBusProxy) GetNameOwner() string {
 
 	cret := xDBusProxyGetNameOwner(x.GoPointer())
@@ -556,7 +568,7 @@ func (x *DBusProxy) GetNameOwner() string {
 
 var xDBusProxyGetObjectPath func(uintptr) string
 
-// Gets the object path @proxy is for.
+// Gets the object path proxy is for.
 func (x *DBusProxy) GetObjectPath() string {
 
 	cret := xDBusProxyGetObjectPath(x.GoPointer())
@@ -565,19 +577,18 @@ func (x *DBusProxy) GetObjectPath() string {
 
 var xDBusProxySetCachedProperty func(uintptr, string, *glib.Variant)
 
-// If @value is not %NULL, sets the cached value for the property with
-// name @property_name to the value in @value.
+// If value is not nil, sets the cached value for the property with
+// name property_name to the value in value.
 //
-// If @value is %NULL, then the cached value is removed from the
+// If value is nil, then the cached value is removed from the
 // property cache.
 //
-// If @proxy has an expected interface (see
-// #GDBusProxy:g-interface-info) and @property_name is referenced by
-// it, then @value is checked against the type of the property.
+// If proxy has an expected interface (see
+// [DBusProxy.GetPropertyGInterfaceInfo]) and property_name is referenced by
+// it, then value is checked against the type of the property.
 //
-// If the @value #GVariant is floating, it is consumed. This allows
-// convenient 'inline' use of g_variant_new(), e.g.
-// |[&lt;!-- language="C" --&gt;
+// If the value [glib.Variant] is floating, it is consumed. This allows
+// convenient 'inline' use of [glib.NewVariant], e.g.
 //
 //	g_dbus_proxy_set_cached_property (proxy,
 //	                                  "SomeProperty",
@@ -585,9 +596,7 @@ var xDBusProxySetCachedProperty func(uintptr, string, *glib.Variant)
 //	                                                "A String",
 //	                                                42));
 //
-// ]|
-//
-// Normally you will not need to use this method since @proxy
+// Normally you will not need to use this method since proxy
 // is tracking changes using the
 // `org.freedesktop.DBus.Properties.PropertiesChanged`
 // D-Bus signal. However, for performance reasons an object may
@@ -609,10 +618,10 @@ func (x *DBusProxy) SetCachedProperty(PropertyNameVar string, ValueVar *glib.Var
 var xDBusProxySetDefaultTimeout func(uintptr, int)
 
 // Sets the timeout to use if -1 (specifying default timeout) is
-// passed as @timeout_msec in the g_dbus_proxy_call() and
-// g_dbus_proxy_call_sync() functions.
+// passed as timeout_msec in the [DBusProxy.Call] and
+// [DBusProxy.CallSync] functions.
 //
-// See the #GDBusProxy:g-default-timeout property for more details.
+// See the [DBusProxy.GetPropertyGDefaultTimeout] property for more details.
 func (x *DBusProxy) SetDefaultTimeout(TimeoutMsecVar int) {
 
 	xDBusProxySetDefaultTimeout(x.GoPointer(), TimeoutMsecVar)
@@ -621,8 +630,8 @@ func (x *DBusProxy) SetDefaultTimeout(TimeoutMsecVar int) {
 
 var xDBusProxySetInterfaceInfo func(uintptr, *DBusInterfaceInfo)
 
-// Ensure that interactions with @proxy conform to the given
-// interface. See the #GDBusProxy:g-interface-info property for more
+// Ensure that interactions with proxy conform to the given
+// interface. See the [DBusProxy.GetPropertyGInterfaceInfo] property for more
 // details.
 func (x *DBusProxy) SetInterfaceInfo(InfoVar *DBusInterfaceInfo) {
 
@@ -643,13 +652,13 @@ func (c *DBusProxy) SetGoPointer(ptr uintptr) {
 
 // SetPropertyGDefaultTimeout sets the "g-default-timeout" property.
 // The timeout to use if -1 (specifying default timeout) is passed
-// as @timeout_msec in the g_dbus_proxy_call() and
-// g_dbus_proxy_call_sync() functions.
+// as timeout_msec in the [DBusProxy.Call] and
+// [DBusProxy.CallSync] functions.
 //
 // This allows applications to set a proxy-wide timeout for all
 // remote method invocations on the proxy. If this property is -1,
 // the default timeout (typically 25 seconds) is used. If set to
-// %G_MAXINT, then no timeout is used.
+// G_MAXINT, then no timeout is used.
 func (x *DBusProxy) SetPropertyGDefaultTimeout(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -659,13 +668,13 @@ func (x *DBusProxy) SetPropertyGDefaultTimeout(value int) {
 
 // GetPropertyGDefaultTimeout gets the "g-default-timeout" property.
 // The timeout to use if -1 (specifying default timeout) is passed
-// as @timeout_msec in the g_dbus_proxy_call() and
-// g_dbus_proxy_call_sync() functions.
+// as timeout_msec in the [DBusProxy.Call] and
+// [DBusProxy.CallSync] functions.
 //
 // This allows applications to set a proxy-wide timeout for all
 // remote method invocations on the proxy. If this property is -1,
 // the default timeout (typically 25 seconds) is used. If set to
-// %G_MAXINT, then no timeout is used.
+// G_MAXINT, then no timeout is used.
 func (x *DBusProxy) GetPropertyGDefaultTimeout() int {
 	var v gobject.Value
 	x.GetProperty("g-default-timeout", &v)
@@ -675,29 +684,31 @@ func (x *DBusProxy) GetPropertyGDefaultTimeout() int {
 // SetPropertyGInterfaceInfo sets the "g-interface-info" property.
 // Ensure that interactions with this proxy conform to the given
 // interface. This is mainly to ensure that malformed data received
-// from the other peer is ignored. The given #GDBusInterfaceInfo is
+// from the other peer is ignored. The given [DBusInterfaceInfo] is
 // said to be the "expected interface".
 //
 // The checks performed are:
 //
 //   - When completing a method call, if the type signature of
 //     the reply message isn't what's expected, the reply is
-//     discarded and the #GError is set to %G_IO_ERROR_INVALID_ARGUMENT.
+//     discarded and the [glib.Error] is set to [GIoErrorInvalidArgumentValue].
 //
 //   - Received signals that have a type signature mismatch are dropped and
 //     a warning is logged via g_warning().
 //
 //   - Properties received via the initial `GetAll()` call or via the
 //     `::PropertiesChanged` signal (on the
-//     [org.freedesktop.DBus.Properties](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties)
-//     interface) or set using g_dbus_proxy_set_cached_property()
+//     [org.freedesktop.DBus.Properties]
+//     interface) or set using [DBusProxy.SetCachedProperty]
 //     with a type signature mismatch are ignored and a warning is
 //     logged via g_warning().
 //
 // Note that these checks are never done on methods, signals and
 // properties that are not referenced in the given
-// #GDBusInterfaceInfo, since extending a D-Bus interface on the
+// [DBusInterfaceInfo], since extending a D-Bus interface on the
 // service-side is not considered an ABI break.
+//
+// [org.freedesktop.DBus.Properties]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties
 func (x *DBusProxy) SetPropertyGInterfaceInfo(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -708,29 +719,31 @@ func (x *DBusProxy) SetPropertyGInterfaceInfo(value uintptr) {
 // GetPropertyGInterfaceInfo gets the "g-interface-info" property.
 // Ensure that interactions with this proxy conform to the given
 // interface. This is mainly to ensure that malformed data received
-// from the other peer is ignored. The given #GDBusInterfaceInfo is
+// from the other peer is ignored. The given [DBusInterfaceInfo] is
 // said to be the "expected interface".
 //
 // The checks performed are:
 //
 //   - When completing a method call, if the type signature of
 //     the reply message isn't what's expected, the reply is
-//     discarded and the #GError is set to %G_IO_ERROR_INVALID_ARGUMENT.
+//     discarded and the [glib.Error] is set to [GIoErrorInvalidArgumentValue].
 //
 //   - Received signals that have a type signature mismatch are dropped and
 //     a warning is logged via g_warning().
 //
 //   - Properties received via the initial `GetAll()` call or via the
 //     `::PropertiesChanged` signal (on the
-//     [org.freedesktop.DBus.Properties](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties)
-//     interface) or set using g_dbus_proxy_set_cached_property()
+//     [org.freedesktop.DBus.Properties]
+//     interface) or set using [DBusProxy.SetCachedProperty]
 //     with a type signature mismatch are ignored and a warning is
 //     logged via g_warning().
 //
 // Note that these checks are never done on methods, signals and
 // properties that are not referenced in the given
-// #GDBusInterfaceInfo, since extending a D-Bus interface on the
+// [DBusInterfaceInfo], since extending a D-Bus interface on the
 // service-side is not considered an ABI break.
+//
+// [org.freedesktop.DBus.Properties]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties
 func (x *DBusProxy) GetPropertyGInterfaceInfo() uintptr {
 	var v gobject.Value
 	x.GetProperty("g-interface-info", &v)
@@ -772,8 +785,8 @@ func (x *DBusProxy) GetPropertyGName() string {
 }
 
 // GetPropertyGNameOwner gets the "g-name-owner" property.
-// The unique name that owns #GDBusProxy:g-name or %NULL if no-one
-// currently owns that name. You may connect to #GObject::notify signal to
+// The unique name that owns [DBusProxy.GetPropertyGName] or nil if no-one
+// currently owns that name. You may connect to [gobject.Object.ConnectNotifyFunc] signal to
 // track changes to this property.
 func (x *DBusProxy) GetPropertyGNameOwner() string {
 	var v gobject.Value
@@ -798,130 +811,177 @@ func (x *DBusProxy) GetPropertyGObjectPath() string {
 	return v.GetString()
 }
 
-// Emitted when one or more D-Bus properties on @proxy changes. The
+// Emitted when one or more D-Bus properties on proxy changes. The
 // local cache has already been updated when this signal fires. Note
-// that both @changed_properties and @invalidated_properties are
-// guaranteed to never be %NULL (either may be empty though).
+// that both changed_properties and invalidated_properties are
+// guaranteed to never be nil (either may be empty though).
 //
 // If the proxy has the flag
-// %G_DBUS_PROXY_FLAGS_GET_INVALIDATED_PROPERTIES set, then
-// @invalidated_properties will always be empty.
+// [GDbusProxyFlagsGetInvalidatedPropertiesValue] set, then
+// invalidated_properties will always be empty.
 //
 // This signal corresponds to the
 // `PropertiesChanged` D-Bus signal on the
 // `org.freedesktop.DBus.Properties` interface.
+//
+// Deprecated: use ConnectGPropertiesChangedFunc, which also accepts method values and closures.
 func (x *DBusProxy) ConnectGPropertiesChanged(cb *func(DBusProxy, uintptr, []string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "g-properties-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string) {
+			defer core.RecoverPanic()
+			fa := DBusProxy{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChangedPropertiesVarp, InvalidatedPropertiesVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "g-properties-changed", cbRefPtr, cbPtr)
+}
+
+var xDBusProxyGPropertiesChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string) {
+func xDBusProxyGPropertiesChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChangedPropertiesVarp uintptr, InvalidatedPropertiesVarp []string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusProxy, uintptr, []string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusProxy{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChangedPropertiesVarp, InvalidatedPropertiesVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "g-properties-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when a signal from the remote object and interface that @proxy is for, has been received.
+// ConnectGPropertiesChangedFunc connects cb to the "g-properties-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusProxy) ConnectGPropertiesChangedFunc(cb func(DBusProxy, uintptr, []string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "g-properties-changed", &xDBusProxyGPropertiesChangedTrampoline, xDBusProxyGPropertiesChangedNewTrampoline, cb)
+}
+
+// Emitted when a signal from the remote object and interface that proxy is for, has been received.
 //
 // Since 2.72 this signal supports detailed connections. You can connect to
 // the detailed signal `g-signal::x` in order to receive callbacks only when
 // signal `x` is received from the remote object.
+//
+// Deprecated: use ConnectGSignalFunc, which also accepts method values and closures.
 func (x *DBusProxy) ConnectGSignal(cb *func(DBusProxy, string, string, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "g-signal", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
-
-	fcb := func(clsPtr uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
-		fa := DBusProxy{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DBusProxy{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-		cbFn(fa, SenderNameVarp, SignalNameVarp, ParametersVarp)
+			cbFn(fa, SenderNameVarp, SignalNameVarp, ParametersVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "g-signal", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "g-signal", cbRefPtr, cbPtr)
 }
 
 // ConnectGSignalWithDetail connects to the "g-signal" signal with a detail string.
 // The detail is appended as "g-signal::<detail>".
+//
+// Deprecated: use ConnectGSignalWithDetailFunc, which also accepts method values and closures.
 func (x *DBusProxy) ConnectGSignalWithDetail(detail string, cb *func(DBusProxy, string, string, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("g-signal::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DBusProxy{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SenderNameVarp, SignalNameVarp, ParametersVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xDBusProxyGSignalTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr) {
+func xDBusProxyGSignalNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SenderNameVarp string, SignalNameVarp string, ParametersVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusProxy, string, string, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusProxy{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SenderNameVarp, SignalNameVarp, ParametersVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectGSignalFunc connects cb to the "g-signal" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusProxy) ConnectGSignalFunc(cb func(DBusProxy, string, string, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "g-signal", &xDBusProxyGSignalTrampoline, xDBusProxyGSignalNewTrampoline, cb)
+}
+
+// ConnectGSignalWithDetailFunc connects cb to the "g-signal" signal with a detail string, see ConnectGSignalFunc.
+// The detail is appended as "g-signal::<detail>".
+func (x *DBusProxy) ConnectGSignalWithDetailFunc(detail string, cb func(DBusProxy, string, string, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("g-signal::%s", detail), &xDBusProxyGSignalTrampoline, xDBusProxyGSignalNewTrampoline, cb)
 }
 
 // Starts asynchronous initialization of the object implementing the
 // interface. This must be done before any real use of the object after
-// initial construction. If the object also implements #GInitable you can
-// optionally call g_initable_init() instead.
+// initial construction. If the object also implements [Initable] you can
+// optionally call [Initable.Init] instead.
 //
 // This method is intended for language bindings. If writing in C,
 // g_async_initable_new_async() should typically be used instead.
 //
-// When the initialization is finished, @callback will be called. You can
-// then call g_async_initable_init_finish() to get the result of the
+// When the initialization is finished, callback will be called. You can
+// then call [AsyncInitable.InitFinish] to get the result of the
 // initialization.
 //
-// Implementations may also support cancellation. If @cancellable is not
-// %NULL, then initialization can be cancelled by triggering the cancellable
+// Implementations may also support cancellation. If cancellable is not
+// nil, then initialization can be cancelled by triggering the cancellable
 // object from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL, and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil, and
 // the object doesn't support cancellable initialization, the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
-// As with #GInitable, if the object is not initialized, or initialization
+// As with [Initable], if the object is not initialized, or initialization
 // returns with an error, then all operations on the object except
-// g_object_ref() and g_object_unref() are considered to be invalid, and
+// [gobject.Object.Ref] and [gobject.Object.Unref] are considered to be invalid, and
 // have undefined behaviour. They will often fail with g_critical() or
 // g_warning(), but this must not be relied on.
 //
-// Callers should not assume that a class which implements #GAsyncInitable can
-// be initialized multiple times; for more information, see g_initable_init().
+// Callers should not assume that a class which implements [AsyncInitable] can
+// be initialized multiple times; for more information, see [Initable.Init].
 // If a class explicitly supports being initialized multiple times,
 // implementation requires yielding all subsequent calls to init_async() on the
 // results of the first call.
 //
-// For classes that also support the #GInitable interface, the default
-// implementation of this method will run the g_initable_init() function
+// For classes that also support the [Initable] interface, the default
+// implementation of this method will run the [Initable.Init] function
 // in a thread, so if you want to support asynchronous initialization via
-// threads, just implement the #GAsyncInitable interface without overriding
+// threads, just implement the [AsyncInitable] interface without overriding
 // any interface methods.
 func (x *DBusProxy) InitAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -930,20 +990,20 @@ func (x *DBusProxy) InitAsync(IoPriorityVar int, CancellableVar *Cancellable, Ca
 }
 
 // Finishes asynchronous initialization and returns the result.
-// See g_async_initable_init_async().
+// See [AsyncInitable.InitAsync].
 func (x *DBusProxy) InitFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGAsyncInitableInitFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Finishes the async construction for the various g_async_initable_new
-// calls, returning the created object or %NULL on error.
+// calls, returning the created object or nil on error.
 func (x *DBusProxy) NewFinish(ResVar AsyncResult) (*gobject.Object, error) {
 	var cls *gobject.Object
 	var cerr *glib.Error
@@ -951,18 +1011,21 @@ func (x *DBusProxy) NewFinish(ResVar AsyncResult) (*gobject.Object, error) {
 	cret := XGAsyncInitableNewFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gobject.Object{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// Gets the [DBusObject] that interface_ belongs to, if any.
 func (x *DBusProxy) DupObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -977,18 +1040,20 @@ func (x *DBusProxy) DupObject() *DBusObjectBase {
 }
 
 // Gets D-Bus introspection information for the D-Bus interface
-// implemented by @interface_.
+// implemented by interface_.
 func (x *DBusProxy) GetInfo() *DBusInterfaceInfo {
 
 	cret := XGDbusInterfaceGetInfo(x.GoPointer())
 	return cret
 }
 
-// Gets the #GDBusObject that @interface_ belongs to, if any.
+// Gets the [DBusObject] that interface_ belongs to, if any.
 //
-// It is not safe to use the returned object if @interface_ or
+// It is not safe to use the returned object if interface_ or
 // the returned object is being used from other threads. See
-// g_dbus_interface_dup_object() for a thread-safe alternative.
+// [DBusInterface.DupObject] for a thread-safe alternative.
+//
+// Deprecated: use [DupObject], the version of this function that language bindings are meant to use.
 func (x *DBusProxy) GetObject() *DBusObjectBase {
 	var cls *DBusObjectBase
 
@@ -1003,9 +1068,9 @@ func (x *DBusProxy) GetObject() *DBusObjectBase {
 	return cls
 }
 
-// Sets the #GDBusObject for @interface_ to @object.
+// Sets the [DBusObject] for interface_ to object.
 //
-// Note that @interface_ will hold a weak reference to @object.
+// Note that interface_ will hold a weak reference to object.
 func (x *DBusProxy) SetObject(ObjectVar DBusObject) {
 
 	XGDbusInterfaceSetObject(x.GoPointer(), ObjectVar.GoPointer())
@@ -1018,25 +1083,25 @@ func (x *DBusProxy) SetObject(ObjectVar DBusObject) {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -1046,16 +1111,16 @@ func (x *DBusProxy) SetObject(ObjectVar DBusObject) {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *DBusProxy) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1063,33 +1128,33 @@ func (x *DBusProxy) Init(CancellableVar *Cancellable) (bool, error) {
 
 var xDBusProxyNew func(uintptr, DBusProxyFlags, *DBusInterfaceInfo, uintptr, string, string, uintptr, uintptr, uintptr)
 
-// Creates a proxy for accessing @interface_name on the remote object
-// at @object_path owned by @name at @connection and asynchronously
+// Creates a proxy for accessing interface_name on the remote object
+// at object_path owned by name at connection and asynchronously
 // loads D-Bus properties unless the
-// %G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES flag is used. Connect to
-// the #GDBusProxy::g-properties-changed signal to get notified about
+// [GDbusProxyFlagsDoNotLoadPropertiesValue] flag is used. Connect to
+// the [DBusProxy.ConnectGPropertiesChangedFunc] signal to get notified about
 // property changes.
 //
-// If the %G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS flag is not set, also sets up
-// match rules for signals. Connect to the #GDBusProxy::g-signal signal
+// If the [GDbusProxyFlagsDoNotConnectSignalsValue] flag is not set, also sets up
+// match rules for signals. Connect to the [DBusProxy.ConnectGSignalFunc] signal
 // to handle signals from the remote object.
 //
-// If both %G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES and
-// %G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS are set, this constructor is
+// If both [GDbusProxyFlagsDoNotLoadPropertiesValue] and
+// [GDbusProxyFlagsDoNotConnectSignalsValue] are set, this constructor is
 // guaranteed to complete immediately without blocking.
 //
-// If @name is a well-known name and the
-// %G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START and %G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START_AT_CONSTRUCTION
+// If name is a well-known name and the
+// [GDbusProxyFlagsDoNotAutoStartValue] and [GDbusProxyFlagsDoNotAutoStartAtConstructionValue]
 // flags aren't set and no name owner currently exists, the message bus
 // will be requested to launch a name owner for the name.
 //
 // This is a failable asynchronous constructor - when the proxy is
-// ready, @callback will be invoked and you can use
-// g_dbus_proxy_new_finish() to get the result.
+// ready, callback will be invoked and you can use
+// [NewDBusProxyFinish] to get the result.
 //
-// See g_dbus_proxy_new_sync() and for a synchronous version of this constructor.
+// See [NewDBusProxySync] and for a synchronous version of this constructor.
 //
-// #GDBusProxy is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
+// [DBusProxy] is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
 func DBusProxyNew(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, InfoVar *DBusInterfaceInfo, NameVar *string, ObjectPathVar string, InterfaceNameVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1099,11 +1164,12 @@ func DBusProxyNew(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, InfoVa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1116,9 +1182,9 @@ func DBusProxyNew(ConnectionVar *DBusConnection, FlagsVar DBusProxyFlags, InfoVa
 
 var xDBusProxyNewForBus func(BusType, DBusProxyFlags, *DBusInterfaceInfo, string, string, string, uintptr, uintptr, uintptr)
 
-// Like g_dbus_proxy_new() but takes a #GBusType instead of a #GDBusConnection.
+// Like [DBusProxyNew] but takes a [BusType] instead of a [DBusConnection].
 //
-// #GDBusProxy is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
+// [DBusProxy] is used in this [example][class@Gio.DBusProxy#a-watch-proxy-example].
 func DBusProxyNewForBus(BusTypeVar BusType, FlagsVar DBusProxyFlags, InfoVar *DBusInterfaceInfo, NameVar string, ObjectPathVar string, InterfaceNameVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1128,11 +1194,12 @@ func DBusProxyNewForBus(BusTypeVar BusType, FlagsVar DBusProxyFlags, InfoVar *DB
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1145,7 +1212,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1154,32 +1221,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusProxyGLibType, libs, "g_dbus_proxy_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusProxyFinish, libs, "g_dbus_proxy_new_finish")
-	core.PuregoSafeRegister(&xNewDBusProxyForBusFinish, libs, "g_dbus_proxy_new_for_bus_finish")
-	core.PuregoSafeRegister(&xNewDBusProxyForBusSync, libs, "g_dbus_proxy_new_for_bus_sync")
-	core.PuregoSafeRegister(&xNewDBusProxySync, libs, "g_dbus_proxy_new_sync")
-
-	core.PuregoSafeRegister(&xDBusProxyCall, libs, "g_dbus_proxy_call")
-	core.PuregoSafeRegister(&xDBusProxyCallFinish, libs, "g_dbus_proxy_call_finish")
-	core.PuregoSafeRegister(&xDBusProxyCallSync, libs, "g_dbus_proxy_call_sync")
-	core.PuregoSafeRegister(&xDBusProxyCallWithUnixFdList, libs, "g_dbus_proxy_call_with_unix_fd_list")
-	core.PuregoSafeRegister(&xDBusProxyCallWithUnixFdListFinish, libs, "g_dbus_proxy_call_with_unix_fd_list_finish")
-	core.PuregoSafeRegister(&xDBusProxyCallWithUnixFdListSync, libs, "g_dbus_proxy_call_with_unix_fd_list_sync")
-	core.PuregoSafeRegister(&xDBusProxyGetCachedProperty, libs, "g_dbus_proxy_get_cached_property")
-	core.PuregoSafeRegister(&xDBusProxyGetCachedPropertyNames, libs, "g_dbus_proxy_get_cached_property_names")
-	core.PuregoSafeRegister(&xDBusProxyGetConnection, libs, "g_dbus_proxy_get_connection")
-	core.PuregoSafeRegister(&xDBusProxyGetDefaultTimeout, libs, "g_dbus_proxy_get_default_timeout")
-	core.PuregoSafeRegister(&xDBusProxyGetFlags, libs, "g_dbus_proxy_get_flags")
-	core.PuregoSafeRegister(&xDBusProxyGetInterfaceInfo, libs, "g_dbus_proxy_get_interface_info")
-	core.PuregoSafeRegister(&xDBusProxyGetInterfaceName, libs, "g_dbus_proxy_get_interface_name")
-	core.PuregoSafeRegister(&xDBusProxyGetName, libs, "g_dbus_proxy_get_name")
-	core.PuregoSafeRegister(&xDBusProxyGetNameOwner, libs, "g_dbus_proxy_get_name_owner")
-	core.PuregoSafeRegister(&xDBusProxyGetObjectPath, libs, "g_dbus_proxy_get_object_path")
-	core.PuregoSafeRegister(&xDBusProxySetCachedProperty, libs, "g_dbus_proxy_set_cached_property")
-	core.PuregoSafeRegister(&xDBusProxySetDefaultTimeout, libs, "g_dbus_proxy_set_default_timeout")
-	core.PuregoSafeRegister(&xDBusProxySetInterfaceInfo, libs, "g_dbus_proxy_set_interface_info")
-
-	core.PuregoSafeRegister(&xDBusProxyNew, libs, "g_dbus_proxy_new")
-	core.PuregoSafeRegister(&xDBusProxyNewForBus, libs, "g_dbus_proxy_new_for_bus")
+	gobject.CheckClassSize("GDBusProxyClass", xDBusProxyGLibType, unsafe.Sizeof(DBusProxyClass{}))
+
+	core.PuregoRegisterSince(&xNewDBusProxyFinish, libs, "g_dbus_proxy_new_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusProxyForBusFinish, libs, "g_dbus_proxy_new_for_bus_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusProxyForBusSync, libs, "g_dbus_proxy_new_for_bus_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewDBusProxySync, libs, "g_dbus_proxy_new_sync", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusProxyCall, libs, "g_dbus_proxy_call", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyCallFinish, libs, "g_dbus_proxy_call_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyCallSync, libs, "g_dbus_proxy_call_sync", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyCallWithUnixFdList, libs, "g_dbus_proxy_call_with_unix_fd_list", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusProxyCallWithUnixFdListFinish, libs, "g_dbus_proxy_call_with_unix_fd_list_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusProxyCallWithUnixFdListSync, libs, "g_dbus_proxy_call_with_unix_fd_list_sync", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDBusProxyGetCachedProperty, libs, "g_dbus_proxy_get_cached_property", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetCachedPropertyNames, libs, "g_dbus_proxy_get_cached_property_names", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetConnection, libs, "g_dbus_proxy_get_connection", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetDefaultTimeout, libs, "g_dbus_proxy_get_default_timeout", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetFlags, libs, "g_dbus_proxy_get_flags", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetInterfaceInfo, libs, "g_dbus_proxy_get_interface_info", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetInterfaceName, libs, "g_dbus_proxy_get_interface_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetName, libs, "g_dbus_proxy_get_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetNameOwner, libs, "g_dbus_proxy_get_name_owner", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyGetObjectPath, libs, "g_dbus_proxy_get_object_path", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxySetCachedProperty, libs, "g_dbus_proxy_set_cached_property", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxySetDefaultTimeout, libs, "g_dbus_proxy_set_default_timeout", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxySetInterfaceInfo, libs, "g_dbus_proxy_set_interface_info", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xDBusProxyNew, libs, "g_dbus_proxy_new", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusProxyNewForBus, libs, "g_dbus_proxy_new_for_bus", "GIO", "2.26")
 
 }
@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,21 +15,24 @@ import (
 // peers to use the D-Bus protocol for their own specialized communication.
 // A server instance provided in this way will not perform message routing or
 // implement the
-// [`org.freedesktop.DBus` interface](https://dbus.freedesktop.org/doc/dbus-specification.html#message-bus-messages).
+// [org.freedesktop.DBus interface].
 //
 // To just export an object on a well-known name on a message bus, such as the
-// session or system bus, you should instead use [func@Gio.bus_own_name].
+// session or system bus, you should instead use [BusOwnName].
 //
 // An example of peer-to-peer communication with GDBus can be found
-// in [gdbus-example-peer.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-peer.c).
+// in [gdbus-example-peer.c].
 //
 // Note that a minimal `GDBusServer` will accept connections from any
 // peer. In many use-cases it will be necessary to add a
-// [class@Gio.DBusAuthObserver] that only accepts connections that have
+// [DBusAuthObserver] that only accepts connections that have
 // successfully authenticated as the same user that is running the
 // `GDBusServer`. Since GLib 2.68 this can be achieved more simply by passing
 // the `G_DBUS_SERVER_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER` flag to the
 // server.
+//
+// [org.freedesktop.DBus interface]: https://dbus.freedesktop.org/doc/dbus-specification.html#message-bus-messages
+// [gdbus-example-peer.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-peer.c
 type DBusServer struct {
 	gobject.Object
 }
@@ -50,25 +52,27 @@ func DBusServerNewFromInternalPtr(ptr uintptr) *DBusServer {
 var xNewDBusServerSync func(string, DBusServerFlags, string, uintptr, uintptr, **glib.Error) uintptr
 
 // Creates a new D-Bus server that listens on the first address in
-// @address that works.
+// address that works.
 //
-// Once constructed, you can use g_dbus_server_get_client_address() to
+// Once constructed, you can use [DBusServer.GetClientAddress] to
 // get a D-Bus address string that clients can use to connect.
 //
 // To have control over the available authentication mechanisms and
 // the users that are authorized to connect, it is strongly recommended
-// to provide a non-%NULL #GDBusAuthObserver.
+// to provide a non-nil [DBusAuthObserver].
 //
-// Connect to the #GDBusServer::new-connection signal to handle
+// Connect to the [DBusServer.ConnectNewConnectionFunc] signal to handle
 // incoming connections.
 //
-// The returned #GDBusServer isn't active - you have to start it with
-// g_dbus_server_start().
+// The returned [DBusServer] isn't active - you have to start it with
+// [DBusServer.Start].
 //
-// #GDBusServer is used in this [example](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-peer.c).
+// [DBusServer] is used in this [example].
 //
 // This is a synchronous failable constructor. There is currently no
 // asynchronous version.
+//
+// [example]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-example-peer.c
 func NewDBusServerSync(AddressVar string, FlagsVar DBusServerFlags, GuidVar string, ObserverVar *DBusAuthObserver, CancellableVar *Cancellable) (*DBusServer, error) {
 	var cls *DBusServer
 	var cerr *glib.Error
@@ -76,12 +80,15 @@ func NewDBusServerSync(AddressVar string, FlagsVar DBusServerFlags, GuidVar stri
 	cret := xNewDBusServerSync(AddressVar, FlagsVar, GuidVar, ObserverVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DBusServer{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -90,10 +97,12 @@ func NewDBusServerSync(AddressVar string, FlagsVar DBusServerFlags, GuidVar stri
 var xDBusServerGetClientAddress func(uintptr) string
 
 // Gets a
-// [D-Bus address](https://dbus.freedesktop.org/doc/dbus-specification.html#addresses)
-// string that can be used by clients to connect to @server.
+// [D-Bus address]
+// string that can be used by clients to connect to server.
+//
+// This is valid and non-empty if initializing the [DBusServer] succeeded.
 //
-// This is valid and non-empty if initializing the #GDBusServer succeeded.
+// [D-Bus address]: https://dbus.freedesktop.org/doc/dbus-specification.html#addresses
 func (x *DBusServer) GetClientAddress() string {
 
 	cret := xDBusServerGetClientAddress(x.GoPointer())
@@ -102,7 +111,7 @@ func (x *DBusServer) GetClientAddress() string {
 
 var xDBusServerGetFlags func(uintptr) DBusServerFlags
 
-// Gets the flags for @server.
+// Gets the flags for server.
 func (x *DBusServer) GetFlags() DBusServerFlags {
 
 	cret := xDBusServerGetFlags(x.GoPointer())
@@ -111,7 +120,7 @@ func (x *DBusServer) GetFlags() DBusServerFlags {
 
 var xDBusServerGetGuid func(uintptr) string
 
-// Gets the GUID for @server, as provided to g_dbus_server_new_sync().
+// Gets the GUID for server, as provided to [NewDBusServerSync].
 func (x *DBusServer) GetGuid() string {
 
 	cret := xDBusServerGetGuid(x.GoPointer())
@@ -120,7 +129,7 @@ func (x *DBusServer) GetGuid() string {
 
 var xDBusServerIsActive func(uintptr) bool
 
-// Gets whether @server is active.
+// Gets whether server is active.
 func (x *DBusServer) IsActive() bool {
 
 	cret := xDBusServerIsActive(x.GoPointer())
@@ -129,7 +138,7 @@ func (x *DBusServer) IsActive() bool {
 
 var xDBusServerStart func(uintptr)
 
-// Starts @server.
+// Starts server.
 func (x *DBusServer) Start() {
 
 	xDBusServerStart(x.GoPointer())
@@ -138,7 +147,7 @@ func (x *DBusServer) Start() {
 
 var xDBusServerStop func(uintptr)
 
-// Stops @server.
+// Stops server.
 func (x *DBusServer) Stop() {
 
 	xDBusServerStop(x.GoPointer())
@@ -192,7 +201,7 @@ func (x *DBusServer) GetPropertyClientAddress() string {
 // SetPropertyGuid sets the "guid" property.
 // The GUID of the server.
 //
-// See #GDBusConnection:guid for more details.
+// See [DBusConnection.GetPropertyGuid] for more details.
 func (x *DBusServer) SetPropertyGuid(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -203,7 +212,7 @@ func (x *DBusServer) SetPropertyGuid(value string) {
 // GetPropertyGuid gets the "guid" property.
 // The GUID of the server.
 //
-// See #GDBusConnection:guid for more details.
+// See [DBusConnection.GetPropertyGuid] for more details.
 func (x *DBusServer) GetPropertyGuid() string {
 	var v gobject.Value
 	x.GetProperty("guid", &v)
@@ -211,47 +220,69 @@ func (x *DBusServer) GetPropertyGuid() string {
 }
 
 // Emitted when a new authenticated connection has been made. Use
-// g_dbus_connection_get_peer_credentials() to figure out what
+// [DBusConnection.GetPeerCredentials] to figure out what
 // identity (if any), was authenticated.
 //
 // If you want to accept the connection, take a reference to the
-// @connection object and return %TRUE. When you are done with the
-// connection call g_dbus_connection_close() and give up your
+// connection object and return true. When you are done with the
+// connection call [DBusConnection.Close] and give up your
 // reference. Note that the other peer may disconnect at any time -
 // a typical thing to do when accepting a connection is to listen to
-// the #GDBusConnection::closed signal.
+// the [DBusConnection.ConnectClosedFunc] signal.
 //
-// If #GDBusServer:flags contains %G_DBUS_SERVER_FLAGS_RUN_IN_THREAD
+// If GDBusServer:flags contains [GDbusServerFlagsRunInThreadValue]
 // then the signal is emitted in a new thread dedicated to the
 // connection. Otherwise the signal is emitted in the thread-default
-// main context (see [method@GLib.MainContext.push_thread_default])
-// of the thread that @server was constructed in.
+// main context (see [glib.MainContext.PushThreadDefault])
+// of the thread that server was constructed in.
 //
 // You are guaranteed that signal handlers for this signal runs
-// before incoming messages on @connection are processed. This means
-// that it's suitable to call g_dbus_connection_register_object() or
+// before incoming messages on connection are processed. This means
+// that it's suitable to call [DBusConnection.RegisterObject] or
 // similar from the signal handler.
+//
+// Deprecated: use ConnectNewConnectionFunc, which also accepts method values and closures.
 func (x *DBusServer) ConnectNewConnection(cb *func(DBusServer, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "new-connection", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ConnectionVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DBusServer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ConnectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "new-connection", cbRefPtr, cbPtr)
+}
+
+var xDBusServerNewConnectionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ConnectionVarp uintptr) bool {
+func xDBusServerNewConnectionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ConnectionVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DBusServer, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DBusServer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ConnectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "new-connection", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNewConnectionFunc connects cb to the "new-connection" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DBusServer) ConnectNewConnectionFunc(cb func(DBusServer, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "new-connection", &xDBusServerNewConnectionTrampoline, xDBusServerNewConnectionNewTrampoline, cb)
 }
 
 // Initializes the object implementing the interface.
@@ -260,25 +291,25 @@ func (x *DBusServer) ConnectNewConnection(cb *func(DBusServer, uintptr) bool) ui
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -288,16 +319,16 @@ func (x *DBusServer) ConnectNewConnection(cb *func(DBusServer, uintptr) bool) ui
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *DBusServer) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -308,7 +339,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -317,13 +348,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xDBusServerGLibType, libs, "g_dbus_server_get_type")
 
-	core.PuregoSafeRegister(&xNewDBusServerSync, libs, "g_dbus_server_new_sync")
+	core.PuregoRegisterSince(&xNewDBusServerSync, libs, "g_dbus_server_new_sync", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xDBusServerGetClientAddress, libs, "g_dbus_server_get_client_address")
-	core.PuregoSafeRegister(&xDBusServerGetFlags, libs, "g_dbus_server_get_flags")
-	core.PuregoSafeRegister(&xDBusServerGetGuid, libs, "g_dbus_server_get_guid")
-	core.PuregoSafeRegister(&xDBusServerIsActive, libs, "g_dbus_server_is_active")
-	core.PuregoSafeRegister(&xDBusServerStart, libs, "g_dbus_server_start")
-	core.PuregoSafeRegister(&xDBusServerStop, libs, "g_dbus_server_stop")
+	core.PuregoRegisterSince(&xDBusServerGetClientAddress, libs, "g_dbus_server_get_client_address", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusServerGetFlags, libs, "g_dbus_server_get_flags", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusServerGetGuid, libs, "g_dbus_server_get_guid", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusServerIsActive, libs, "g_dbus_server_is_active", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusServerStart, libs, "g_dbus_server_start", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDBusServerStop, libs, "g_dbus_server_stop", "GIO", "2.26")
 
 }
@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -10,7 +9,7 @@ import (
 
 var xDbusEscapeObjectPath func(string) string
 
-// This is a language binding friendly version of g_dbus_escape_object_path_bytestring().
+// This is a language binding friendly version of [DbusEscapeObjectPathBytestring].
 func DbusEscapeObjectPath(SVar string) string {
 
 	cret := xDbusEscapeObjectPath(SVar)
@@ -19,8 +18,8 @@ func DbusEscapeObjectPath(SVar string) string {
 
 var xDbusEscapeObjectPathBytestring func([]byte) string
 
-// Escapes @bytes for use in a D-Bus object path component.
-// @bytes is an array of zero or more nonzero bytes in an
+// Escapes bytes for use in a D-Bus object path component.
+// bytes is an array of zero or more nonzero bytes in an
 // unspecified encoding, followed by a single zero byte.
 //
 // The escaping method consists of replacing all non-alphanumeric
@@ -34,7 +33,7 @@ var xDbusEscapeObjectPathBytestring func([]byte) string
 // Other escaping algorithms are also valid to use with
 // D-Bus object paths.
 //
-// This can be reversed with g_dbus_unescape_object_path().
+// This can be reversed with [DbusUnescapeObjectPath].
 func DbusEscapeObjectPathBytestring(BytesVar []byte) string {
 
 	cret := xDbusEscapeObjectPathBytestring(BytesVar)
@@ -44,16 +43,19 @@ func DbusEscapeObjectPathBytestring(BytesVar []byte) string {
 var xDbusGenerateGuid func() string
 
 // Generate a D-Bus GUID that can be used with
-// e.g. g_dbus_connection_new().
+// e.g. [DBusConnectionNew].
 //
 // See the
-// [D-Bus specification](https://dbus.freedesktop.org/doc/dbus-specification.html#uuids)
+// [D-Bus specification]
 // regarding what strings are valid D-Bus GUIDs. The specification refers to
 // these as ‘UUIDs’ whereas GLib (for historical reasons) refers to them as
 // ‘GUIDs’. The terms are interchangeable.
 //
 // Note that D-Bus GUIDs do not follow
-// [RFC 4122](https://datatracker.ietf.org/doc/html/rfc4122).
+// [RFC 4122].
+//
+// [D-Bus specification]: https://dbus.freedesktop.org/doc/dbus-specification.html#uuids
+// [RFC 4122]: https://datatracker.ietf.org/doc/html/rfc4122
 func DbusGenerateGuid() string {
 
 	cret := xDbusGenerateGuid()
@@ -62,7 +64,7 @@ func DbusGenerateGuid() string {
 
 var xDbusGvalueToGvariant func(*gobject.Value, *glib.VariantType) *glib.Variant
 
-// Converts a #GValue to a #GVariant of the type indicated by the @type
+// Converts a [gobject.Value] to a [glib.Variant] of the type indicated by the type
 // parameter.
 //
 // The conversion is using the following rules:
@@ -76,20 +78,20 @@ var xDbusGvalueToGvariant func(*gobject.Value, *glib.VariantType) *glib.Variant
 // - `G_TYPE_INT64`: 'x'
 // - `G_TYPE_UINT64`: 't'
 // - `G_TYPE_DOUBLE`: 'd'
-// - `G_TYPE_VARIANT`: Any #GVariantType
+// - `G_TYPE_VARIANT`: Any [glib.VariantType]
 //
-// This can fail if e.g. @gvalue is of type %G_TYPE_STRING and @type
-// is 'i', i.e. %G_VARIANT_TYPE_INT32. It will also fail for any #GType
-// (including e.g. %G_TYPE_OBJECT and %G_TYPE_BOXED derived-types) not
+// This can fail if e.g. gvalue is of type G_TYPE_STRING and type
+// is 'i', i.e. G_VARIANT_TYPE_INT32. It will also fail for any [gobject.Type]
+// (including e.g. G_TYPE_OBJECT and G_TYPE_BOXED derived-types) not
 // in the table above.
 //
-// Note that if @gvalue is of type %G_TYPE_VARIANT and its value is
-// %NULL, the empty #GVariant instance (never %NULL) for @type is
+// Note that if gvalue is of type G_TYPE_VARIANT and its value is
+// nil, the empty [glib.Variant] instance (never nil) for type is
 // returned (e.g. 0 for scalar types, the empty string for string types,
 // '/' for object path types, the empty array for any array type and so on).
 //
-// See the g_dbus_gvariant_to_gvalue() function for how to convert a
-// #GVariant to a #GValue.
+// See the [DbusGvariantToGvalue] function for how to convert a
+// [glib.Variant] to a [gobject.Value].
 func DbusGvalueToGvariant(GvalueVar *gobject.Value, TypeVar *glib.VariantType) *glib.Variant {
 
 	cret := xDbusGvalueToGvariant(GvalueVar, TypeVar)
@@ -98,17 +100,17 @@ func DbusGvalueToGvariant(GvalueVar *gobject.Value, TypeVar *glib.VariantType) *
 
 var xDbusGvariantToGvalue func(*glib.Variant, *gobject.Value)
 
-// Converts a #GVariant to a #GValue. If @value is floating, it is consumed.
+// Converts a [glib.Variant] to a [gobject.Value]. If value is floating, it is consumed.
 //
-// The rules specified in the g_dbus_gvalue_to_gvariant() function are
-// used - this function is essentially its reverse form. So, a #GVariant
-// containing any basic or string array type will be converted to a #GValue
-// containing a basic value or string array. Any other #GVariant (handle,
-// variant, tuple, dict entry) will be converted to a #GValue containing that
-// #GVariant.
+// The rules specified in the [DbusGvalueToGvariant] function are
+// used - this function is essentially its reverse form. So, a [glib.Variant]
+// containing any basic or string array type will be converted to a [gobject.Value]
+// containing a basic value or string array. Any other [glib.Variant] (handle,
+// variant, tuple, dict entry) will be converted to a [gobject.Value] containing that
+// [glib.Variant].
 //
-// The conversion never fails - a valid #GValue is always returned in
-// @out_gvalue.
+// The conversion never fails - a valid [gobject.Value] is always returned in
+// out_gvalue.
 func DbusGvariantToGvalue(ValueVar *glib.Variant, OutGvalueVar *gobject.Value) {
 
 	xDbusGvariantToGvalue(ValueVar, OutGvalueVar)
@@ -117,9 +119,9 @@ func DbusGvariantToGvalue(ValueVar *glib.Variant, OutGvalueVar *gobject.Value) {
 
 var xDbusIsErrorName func(string) bool
 
-// Check whether @string is a valid D-Bus error name.
+// Check whether string is a valid D-Bus error name.
 //
-// This function returns the same result as g_dbus_is_interface_name(),
+// This function returns the same result as [DbusIsInterfaceName],
 // because D-Bus error names are defined to have exactly the
 // same syntax as interface names.
 func DbusIsErrorName(StringVar string) bool {
@@ -130,9 +132,9 @@ func DbusIsErrorName(StringVar string) bool {
 
 var xDbusIsGuid func(string) bool
 
-// Checks if @string is a D-Bus GUID.
+// Checks if string is a D-Bus GUID.
 //
-// See the documentation for g_dbus_generate_guid() for more information about
+// See the documentation for [DbusGenerateGuid] for more information about
 // the format of a GUID.
 func DbusIsGuid(StringVar string) bool {
 
@@ -142,7 +144,7 @@ func DbusIsGuid(StringVar string) bool {
 
 var xDbusIsInterfaceName func(string) bool
 
-// Checks if @string is a valid D-Bus interface name.
+// Checks if string is a valid D-Bus interface name.
 func DbusIsInterfaceName(StringVar string) bool {
 
 	cret := xDbusIsInterfaceName(StringVar)
@@ -151,7 +153,7 @@ func DbusIsInterfaceName(StringVar string) bool {
 
 var xDbusIsMemberName func(string) bool
 
-// Checks if @string is a valid D-Bus member (e.g. signal or method) name.
+// Checks if string is a valid D-Bus member (e.g. signal or method) name.
 func DbusIsMemberName(StringVar string) bool {
 
 	cret := xDbusIsMemberName(StringVar)
@@ -160,7 +162,7 @@ func DbusIsMemberName(StringVar string) bool {
 
 var xDbusIsName func(string) bool
 
-// Checks if @string is a valid D-Bus bus name (either unique or well-known).
+// Checks if string is a valid D-Bus bus name (either unique or well-known).
 func DbusIsName(StringVar string) bool {
 
 	cret := xDbusIsName(StringVar)
@@ -169,7 +171,7 @@ func DbusIsName(StringVar string) bool {
 
 var xDbusIsUniqueName func(string) bool
 
-// Checks if @string is a valid D-Bus unique bus name.
+// Checks if string is a valid D-Bus unique bus name.
 func DbusIsUniqueName(StringVar string) bool {
 
 	cret := xDbusIsUniqueName(StringVar)
@@ -179,9 +181,9 @@ func DbusIsUniqueName(StringVar string) bool {
 var xDbusUnescapeObjectPath func(string) uintptr
 
 // Unescapes an string that was previously escaped with
-// g_dbus_escape_object_path(). If the string is in a format that could
-// not have been returned by g_dbus_escape_object_path(), this function
-// returns %NULL.
+// [DbusEscapeObjectPath]. If the string is in a format that could
+// not have been returned by [DbusEscapeObjectPath], this function
+// returns nil.
 //
 // Encoding alphanumeric characters which do not need to be
 // encoded is not allowed (e.g `_63` is not valid, the string
@@ -197,24 +199,24 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDbusEscapeObjectPath, libs, "g_dbus_escape_object_path")
-	core.PuregoSafeRegister(&xDbusEscapeObjectPathBytestring, libs, "g_dbus_escape_object_path_bytestring")
-	core.PuregoSafeRegister(&xDbusGenerateGuid, libs, "g_dbus_generate_guid")
-	core.PuregoSafeRegister(&xDbusGvalueToGvariant, libs, "g_dbus_gvalue_to_gvariant")
-	core.PuregoSafeRegister(&xDbusGvariantToGvalue, libs, "g_dbus_gvariant_to_gvalue")
-	core.PuregoSafeRegister(&xDbusIsErrorName, libs, "g_dbus_is_error_name")
-	core.PuregoSafeRegister(&xDbusIsGuid, libs, "g_dbus_is_guid")
-	core.PuregoSafeRegister(&xDbusIsInterfaceName, libs, "g_dbus_is_interface_name")
-	core.PuregoSafeRegister(&xDbusIsMemberName, libs, "g_dbus_is_member_name")
-	core.PuregoSafeRegister(&xDbusIsName, libs, "g_dbus_is_name")
-	core.PuregoSafeRegister(&xDbusIsUniqueName, libs, "g_dbus_is_unique_name")
-	core.PuregoSafeRegister(&xDbusUnescapeObjectPath, libs, "g_dbus_unescape_object_path")
+	core.PuregoRegisterSince(&xDbusEscapeObjectPath, libs, "g_dbus_escape_object_path", "GIO", "2.68")
+	core.PuregoRegisterSince(&xDbusEscapeObjectPathBytestring, libs, "g_dbus_escape_object_path_bytestring", "GIO", "2.68")
+	core.PuregoRegisterSince(&xDbusGenerateGuid, libs, "g_dbus_generate_guid", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusGvalueToGvariant, libs, "g_dbus_gvalue_to_gvariant", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDbusGvariantToGvalue, libs, "g_dbus_gvariant_to_gvalue", "GIO", "2.30")
+	core.PuregoRegisterSince(&xDbusIsErrorName, libs, "g_dbus_is_error_name", "GIO", "2.70")
+	core.PuregoRegisterSince(&xDbusIsGuid, libs, "g_dbus_is_guid", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsInterfaceName, libs, "g_dbus_is_interface_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsMemberName, libs, "g_dbus_is_member_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsName, libs, "g_dbus_is_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusIsUniqueName, libs, "g_dbus_is_unique_name", "GIO", "2.26")
+	core.PuregoRegisterSince(&xDbusUnescapeObjectPath, libs, "g_dbus_unescape_object_path", "GIO", "2.68")
 
 }
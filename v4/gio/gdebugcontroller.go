@@ -5,17 +5,16 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GDebugController.
+// The virtual function table for [DebugController].
 type DebugControllerInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 }
 
 func (x *DebugControllerInterface) GoPointer() uintptr {
@@ -25,14 +24,14 @@ func (x *DebugControllerInterface) GoPointer() uintptr {
 // `GDebugController` is an interface to expose control of debugging features and
 // debug output.
 //
-// It is implemented on Linux using [class@Gio.DebugControllerDBus], which
+// It is implemented on Linux using [DebugControllerDBus], which
 // exposes a D-Bus interface to allow authenticated peers to control debug
 // features in this process.
 //
 // Whether debug output is enabled is exposed as
-// [property@Gio.DebugController:debug-enabled]. This controls
-// [func@GLib.log_set_debug_enabled] by default. Application code may
-// connect to the [signal@GObject.Object::notify] signal for it
+// [DebugControllerBase.GetPropertyDebugEnabled]. This controls
+// [glib.LogSetDebugEnabled] by default. Application code may
+// connect to the [gobject.Object.ConnectNotifyFunc] signal for it
 // to control other parts of its debug infrastructure as necessary.
 //
 // If your application or service is using the default GLib log writer function,
@@ -66,14 +65,14 @@ func (x *DebugControllerBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Get the value of #GDebugController:debug-enabled.
+// Get the value of [DebugControllerBase.GetPropertyDebugEnabled].
 func (x *DebugControllerBase) GetDebugEnabled() bool {
 
 	cret := XGDebugControllerGetDebugEnabled(x.GoPointer())
 	return cret
 }
 
-// Set the value of #GDebugController:debug-enabled.
+// Set the value of [DebugControllerBase.GetPropertyDebugEnabled].
 func (x *DebugControllerBase) SetDebugEnabled(DebugEnabledVar bool) {
 
 	XGDebugControllerSetDebugEnabled(x.GoPointer(), DebugEnabledVar)
@@ -81,8 +80,8 @@ func (x *DebugControllerBase) SetDebugEnabled(DebugEnabledVar bool) {
 }
 
 // SetPropertyDebugEnabled sets the "debug-enabled" property.
-// %TRUE if debug output should be exposed (for example by forwarding it to
-// the journal), %FALSE otherwise.
+// true if debug output should be exposed (for example by forwarding it to
+// the journal), false otherwise.
 func (x *DebugControllerBase) SetPropertyDebugEnabled(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -93,8 +92,8 @@ func (x *DebugControllerBase) SetPropertyDebugEnabled(value bool) {
 }
 
 // GetPropertyDebugEnabled gets the "debug-enabled" property.
-// %TRUE if debug output should be exposed (for example by forwarding it to
-// the journal), %FALSE otherwise.
+// true if debug output should be exposed (for example by forwarding it to
+// the journal), false otherwise.
 func (x *DebugControllerBase) GetPropertyDebugEnabled() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -108,7 +107,7 @@ var XGDebugControllerSetDebugEnabled func(uintptr, bool)
 
 const (
 	// Extension point for debug control functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	DEBUG_CONTROLLER_EXTENSION_POINT_NAME string = "gio-debug-controller"
 )
 
@@ -117,7 +116,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -126,7 +125,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xDebugControllerGLibType, libs, "g_debug_controller_get_type")
 
-	core.PuregoSafeRegister(&XGDebugControllerGetDebugEnabled, libs, "g_debug_controller_get_debug_enabled")
-	core.PuregoSafeRegister(&XGDebugControllerSetDebugEnabled, libs, "g_debug_controller_set_debug_enabled")
+	gobject.CheckClassSize("GDebugControllerInterface", xDebugControllerGLibType, unsafe.Sizeof(DebugControllerInterface{}))
+
+	core.PuregoRegisterSince(&XGDebugControllerGetDebugEnabled, libs, "g_debug_controller_get_debug_enabled", "GIO", "2.72")
+	core.PuregoRegisterSince(&XGDebugControllerSetDebugEnabled, libs, "g_debug_controller_set_debug_enabled", "GIO", "2.72")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GDebugControllerDBus.
+// The virtual function table for [DebugControllerDBus].
 type DebugControllerDBusClass struct {
 	_ structs.HostLayout
 
@@ -28,47 +27,48 @@ func (x *DebugControllerDBusClass) GoPointer() uintptr {
 }
 
 // OverrideAuthorize sets the "authorize" callback function.
-// Default handler for the #GDebugControllerDBus::authorize signal.
+// Default handler for the [DebugControllerDBus.ConnectAuthorizeFunc] signal.
 func (x *DebugControllerDBusClass) OverrideAuthorize(cb func(*DebugControllerDBus, *DBusMethodInvocation) bool) {
 	if cb == nil {
 		x.xAuthorize = 0
 	} else {
-		x.xAuthorize = purego.NewCallback(func(ControllerVarp uintptr, InvocationVarp uintptr) bool {
+		x.xAuthorize = core.NewCallback(func(ControllerVarp uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(DebugControllerDBusNewFromInternalPtr(ControllerVarp), DBusMethodInvocationNewFromInternalPtr(InvocationVarp))
 		})
 	}
 }
 
 // GetAuthorize gets the "authorize" callback function.
-// Default handler for the #GDebugControllerDBus::authorize signal.
+// Default handler for the [DebugControllerDBus.ConnectAuthorizeFunc] signal.
 func (x *DebugControllerDBusClass) GetAuthorize() func(*DebugControllerDBus, *DBusMethodInvocation) bool {
 	if x.xAuthorize == 0 {
 		return nil
 	}
 	var rawCallback func(ControllerVarp uintptr, InvocationVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xAuthorize)
+	core.RegisterFunc(&rawCallback, x.xAuthorize)
 	return func(ControllerVar *DebugControllerDBus, InvocationVar *DBusMethodInvocation) bool {
 		return rawCallback(ControllerVar.GoPointer(), InvocationVar.GoPointer())
 	}
 }
 
-// `GDebugControllerDBus` is an implementation of [iface@Gio.DebugController]
+// `GDebugControllerDBus` is an implementation of [DebugController]
 // which exposes debug settings as a D-Bus object.
 //
-// It is a [iface@Gio.Initable] object, and will register an object at
+// It is a [Initable] object, and will register an object at
 // `/org/gtk/Debugging` on the bus given as
-// [property@Gio.DebugControllerDBus:connection] once it’s initialized. The
+// DebugControllerDBus:connection once it’s initialized. The
 // object will be unregistered when the last reference to the
 // `GDebugControllerDBus` is dropped.
 //
 // This D-Bus object can be used by remote processes to enable or disable debug
 // output in this process. Remote processes calling
 // `org.gtk.Debugging.SetDebugEnabled()` will affect the value of
-// [property@Gio.DebugController:debug-enabled] and, by default,
-// [func@GLib.log_get_debug_enabled].
+// [DebugControllerBase.GetPropertyDebugEnabled] and, by default,
+// [glib.LogGetDebugEnabled].
 //
 // By default, no processes are allowed to call `SetDebugEnabled()` unless a
-// [signal@Gio.DebugControllerDBus::authorize] signal handler is installed. This
+// [DebugControllerDBus.ConnectAuthorizeFunc] signal handler is installed. This
 // is because the process may be privileged, or might expose sensitive
 // information in its debug output. You may want to restrict the ability to
 // enable debug output to privileged users or processes.
@@ -77,23 +77,17 @@ func (x *DebugControllerDBusClass) GetAuthorize() func(*DebugControllerDBus, *DB
 // `SetDebugEnabled()`, installing something like the following in
 // `$datadir/dbus-1/system.d/`:
 //
-// ```xml
-// &lt;?xml version="1.0"?&gt; &lt;!--*-nxml-*--&gt;
-// &lt;!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-BUS Bus Configuration 1.0//EN"
-//
-//	"http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd"&gt;
-//
-// &lt;busconfig&gt;
-//
-//	&lt;policy user="root"&gt;
-//	  &lt;allow send_destination="com.example.MyService" send_interface="org.gtk.Debugging"/&gt;
-//	&lt;/policy&gt;
-//	&lt;policy context="default"&gt;
-//	  &lt;deny send_destination="com.example.MyService" send_interface="org.gtk.Debugging"/&gt;
-//	&lt;/policy&gt;
-//
-// &lt;/busconfig&gt;
-// ```
+//	<?xml version="1.0"?> <!--*-nxml-*-->
+//	<!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-BUS Bus Configuration 1.0//EN"
+//	     "http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd">
+//	<busconfig>
+//	  <policy user="root">
+//	    <allow send_destination="com.example.MyService" send_interface="org.gtk.Debugging"/>
+//	  </policy>
+//	  <policy context="default">
+//	    <deny send_destination="com.example.MyService" send_interface="org.gtk.Debugging"/>
+//	  </policy>
+//	</busconfig>
 //
 // This will prevent the `SetDebugEnabled()` method from being called by all
 // except root. It will not prevent the `DebugEnabled` property from being read,
@@ -101,21 +95,19 @@ func (x *DebugControllerDBusClass) GetAuthorize() func(*DebugControllerDBus, *DB
 //
 // Another option is to use polkit to allow or deny requests on a case-by-case
 // basis, allowing for the possibility of dynamic authorisation. To do this,
-// connect to the [signal@Gio.DebugControllerDBus::authorize] signal and query
+// connect to the [DebugControllerDBus.ConnectAuthorizeFunc] signal and query
 // polkit in it:
 //
-// ```c
-//
 //	g_autoptr(GError) child_error = NULL;
 //	g_autoptr(GDBusConnection) connection = g_bus_get_sync (G_BUS_TYPE_SYSTEM, NULL, NULL);
 //	gulong debug_controller_authorize_id = 0;
 //
 //	// Set up the debug controller.
-//	debug_controller = G_DEBUG_CONTROLLER (g_debug_controller_dbus_new (priv-&gt;connection, NULL, &amp;child_error));
+//	debug_controller = G_DEBUG_CONTROLLER (g_debug_controller_dbus_new (priv->connection, NULL, &child_error));
 //	if (debug_controller == NULL)
 //	  {
 //	    g_error ("Could not register debug controller on bus: %s",
-//	             child_error-&gt;message);
+//	             child_error->message);
 //	  }
 //
 //	debug_controller_authorize_id = g_signal_connect (debug_controller,
@@ -139,14 +131,14 @@ func (x *DebugControllerDBusClass) GetAuthorize() func(*DebugControllerDBus, *DB
 //	  message = g_dbus_method_invocation_get_message (invocation);
 //	  message_flags = g_dbus_message_get_flags (message);
 //
-//	  authority = polkit_authority_get_sync (NULL, &amp;local_error);
+//	  authority = polkit_authority_get_sync (NULL, &local_error);
 //	  if (authority == NULL)
 //	    {
-//	      g_warning ("Failed to get polkit authority: %s", local_error-&gt;message);
+//	      g_warning ("Failed to get polkit authority: %s", local_error->message);
 //	      return FALSE;
 //	    }
 //
-//	  if (message_flags &amp; G_DBUS_MESSAGE_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION)
+//	  if (message_flags & G_DBUS_MESSAGE_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION)
 //	    flags |= POLKIT_CHECK_AUTHORIZATION_FLAGS_ALLOW_USER_INTERACTION;
 //
 //	  subject = polkit_system_bus_name_new (g_dbus_method_invocation_get_sender (invocation));
@@ -157,17 +149,15 @@ func (x *DebugControllerDBusClass) GetAuthorize() func(*DebugControllerDBus, *DB
 //	                                                           NULL,
 //	                                                           flags,
 //	                                                           NULL,
-//	                                                           &amp;local_error);
+//	                                                           &local_error);
 //	  if (auth_result == NULL)
 //	    {
-//	      g_warning ("Failed to get check polkit authorization: %s", local_error-&gt;message);
+//	      g_warning ("Failed to get check polkit authorization: %s", local_error->message);
 //	      return FALSE;
 //	    }
 //
 //	  return polkit_authorization_result_get_is_authorized (auth_result);
 //	}
-//
-// ```
 type DebugControllerDBus struct {
 	gobject.Object
 }
@@ -186,13 +176,13 @@ func DebugControllerDBusNewFromInternalPtr(ptr uintptr) *DebugControllerDBus {
 
 var xNewDebugControllerDBus func(uintptr, uintptr, **glib.Error) uintptr
 
-// Create a new #GDebugControllerDBus and synchronously initialize it.
+// Create a new [DebugControllerDBus] and synchronously initialize it.
 //
-// Initializing the object will export the debug object on @connection. The
+// Initializing the object will export the debug object on connection. The
 // object will remain registered until the last reference to the
-// #GDebugControllerDBus is dropped.
+// [DebugControllerDBus] is dropped.
 //
-// Initialization may fail if registering the object on @connection fails.
+// Initialization may fail if registering the object on connection fails.
 func NewDebugControllerDBus(ConnectionVar *DBusConnection, CancellableVar *Cancellable) (*DebugControllerDBus, error) {
 	var cls *DebugControllerDBus
 	var cerr *glib.Error
@@ -200,12 +190,15 @@ func NewDebugControllerDBus(ConnectionVar *DBusConnection, CancellableVar *Cance
 	cret := xNewDebugControllerDBus(ConnectionVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DebugControllerDBus{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -217,17 +210,17 @@ var xDebugControllerDBusStop func(uintptr)
 //
 // Any pending method calls to the object will complete successfully, but new
 // ones will return an error. This method will block until all pending
-// #GDebugControllerDBus::authorize signals have been handled. This is expected
+// [DebugControllerDBus.ConnectAuthorizeFunc] signals have been handled. This is expected
 // to not take long, as it will just be waiting for threads to join. If any
-// #GDebugControllerDBus::authorize signal handlers are still executing in other
+// [DebugControllerDBus.ConnectAuthorizeFunc] signal handlers are still executing in other
 // threads, this will block until after they have returned.
 //
 // This method will be called automatically when the final reference to the
-// #GDebugControllerDBus is dropped. You may want to call it explicitly to know
+// [DebugControllerDBus] is dropped. You may want to call it explicitly to know
 // when the controller has been fully removed from the bus, or to break
 // reference count cycles.
 //
-// Calling this method from within a #GDebugControllerDBus::authorize signal
+// Calling this method from within a [DebugControllerDBus.ConnectAuthorizeFunc] signal
 // handler will cause a deadlock and must not be done.
 func (x *DebugControllerDBus) Stop() {
 
@@ -254,46 +247,68 @@ func (c *DebugControllerDBus) SetGoPointer(ptr uintptr) {
 // appropriate to call `polkit_authority_check_authorization_sync()` to check
 // authorization using polkit.
 //
-// If %FALSE is returned then no further handlers are run and the request to
+// If false is returned then no further handlers are run and the request to
 // change the debug settings is rejected.
 //
-// Otherwise, if %TRUE is returned, signal emission continues. If no handlers
-// return %FALSE, then the debug settings are allowed to be changed.
+// Otherwise, if true is returned, signal emission continues. If no handlers
+// return false, then the debug settings are allowed to be changed.
 //
-// Signal handlers must not modify @invocation, or cause it to return a value.
+// Signal handlers must not modify invocation, or cause it to return a value.
 //
-// The default class handler just returns %TRUE.
+// The default class handler just returns true.
+//
+// Deprecated: use ConnectAuthorizeFunc, which also accepts method values and closures.
 func (x *DebugControllerDBus) ConnectAuthorize(cb *func(DebugControllerDBus, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "authorize", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InvocationVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DebugControllerDBus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, InvocationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "authorize", cbRefPtr, cbPtr)
+}
+
+var xDebugControllerDBusAuthorizeTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, InvocationVarp uintptr) bool {
+func xDebugControllerDBusAuthorizeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InvocationVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DebugControllerDBus, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DebugControllerDBus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, InvocationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "authorize", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Get the value of #GDebugController:debug-enabled.
+// ConnectAuthorizeFunc connects cb to the "authorize" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DebugControllerDBus) ConnectAuthorizeFunc(cb func(DebugControllerDBus, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "authorize", &xDebugControllerDBusAuthorizeTrampoline, xDebugControllerDBusAuthorizeNewTrampoline, cb)
+}
+
+// Get the value of [DebugControllerBase.GetPropertyDebugEnabled].
 func (x *DebugControllerDBus) GetDebugEnabled() bool {
 
 	cret := XGDebugControllerGetDebugEnabled(x.GoPointer())
 	return cret
 }
 
-// Set the value of #GDebugController:debug-enabled.
+// Set the value of [DebugControllerBase.GetPropertyDebugEnabled].
 func (x *DebugControllerDBus) SetDebugEnabled(DebugEnabledVar bool) {
 
 	XGDebugControllerSetDebugEnabled(x.GoPointer(), DebugEnabledVar)
@@ -306,25 +321,25 @@ func (x *DebugControllerDBus) SetDebugEnabled(DebugEnabledVar bool) {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -334,16 +349,16 @@ func (x *DebugControllerDBus) SetDebugEnabled(DebugEnabledVar bool) {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *DebugControllerDBus) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -354,7 +369,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -363,8 +378,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xDebugControllerDBusGLibType, libs, "g_debug_controller_dbus_get_type")
 
-	core.PuregoSafeRegister(&xNewDebugControllerDBus, libs, "g_debug_controller_dbus_new")
+	gobject.CheckClassSize("GDebugControllerDBusClass", xDebugControllerDBusGLibType, unsafe.Sizeof(DebugControllerDBusClass{}))
+
+	core.PuregoRegisterSince(&xNewDebugControllerDBus, libs, "g_debug_controller_dbus_new", "GIO", "2.72")
 
-	core.PuregoSafeRegister(&xDebugControllerDBusStop, libs, "g_debug_controller_dbus_stop")
+	core.PuregoRegisterSince(&xDebugControllerDBusStop, libs, "g_debug_controller_dbus_stop", "GIO", "2.72")
 
 }
@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Interface for creating #GDrive implementations.
+// Interface for creating [Drive] implementations.
 type DriveIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xChanged uintptr
 
@@ -92,7 +92,8 @@ func (x *DriveIface) OverrideChanged(cb func(Drive)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(DriveVarp uintptr) {
+		x.xChanged = core.NewCallback(func(DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -105,32 +106,33 @@ func (x *DriveIface) GetChanged() func(Drive) {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(DriveVar Drive) {
 		rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideDisconnected sets the "disconnected" callback function.
-// The removed signal that is emitted when the #GDrive have been disconnected. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The removed signal that is emitted when the [Drive] have been disconnected. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *DriveIface) OverrideDisconnected(cb func(Drive)) {
 	if cb == nil {
 		x.xDisconnected = 0
 	} else {
-		x.xDisconnected = purego.NewCallback(func(DriveVarp uintptr) {
+		x.xDisconnected = core.NewCallback(func(DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetDisconnected gets the "disconnected" callback function.
-// The removed signal that is emitted when the #GDrive have been disconnected. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The removed signal that is emitted when the [Drive] have been disconnected. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *DriveIface) GetDisconnected() func(Drive) {
 	if x.xDisconnected == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDisconnected)
+	core.RegisterFunc(&rawCallback, x.xDisconnected)
 	return func(DriveVar Drive) {
 		rawCallback(DriveVar.GoPointer())
 	}
@@ -142,7 +144,8 @@ func (x *DriveIface) OverrideEjectButton(cb func(Drive)) {
 	if cb == nil {
 		x.xEjectButton = 0
 	} else {
-		x.xEjectButton = purego.NewCallback(func(DriveVarp uintptr) {
+		x.xEjectButton = core.NewCallback(func(DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -155,44 +158,46 @@ func (x *DriveIface) GetEjectButton() func(Drive) {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectButton)
+	core.RegisterFunc(&rawCallback, x.xEjectButton)
 	return func(DriveVar Drive) {
 		rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideGetName sets the "get_name" callback function.
-// Returns the name for the given #GDrive.
+// Returns the name for the given [Drive].
 func (x *DriveIface) OverrideGetName(cb func(Drive) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(DriveVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(DriveVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetGetName gets the "get_name" callback function.
-// Returns the name for the given #GDrive.
+// Returns the name for the given [Drive].
 func (x *DriveIface) GetGetName() func(Drive) string {
 	if x.xGetName == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(DriveVar Drive) string {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideGetIcon sets the "get_icon" callback function.
-// Returns a #GIcon for the given #GDrive.
+// Returns a [Icon] for the given [Drive].
 func (x *DriveIface) OverrideGetIcon(cb func(Drive) *IconBase) {
 	if cb == nil {
 		x.xGetIcon = 0
 	} else {
-		x.xGetIcon = purego.NewCallback(func(DriveVarp uintptr) uintptr {
+		x.xGetIcon = core.NewCallback(func(DriveVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DriveBase{Ptr: DriveVarp})
 			if ret == nil {
 				return 0
@@ -203,13 +208,13 @@ func (x *DriveIface) OverrideGetIcon(cb func(Drive) *IconBase) {
 }
 
 // GetGetIcon gets the "get_icon" callback function.
-// Returns a #GIcon for the given #GDrive.
+// Returns a [Icon] for the given [Drive].
 func (x *DriveIface) GetGetIcon() func(Drive) *IconBase {
 	if x.xGetIcon == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetIcon)
+	core.RegisterFunc(&rawCallback, x.xGetIcon)
 	return func(DriveVar Drive) *IconBase {
 		rawRet := rawCallback(DriveVar.GoPointer())
 		if rawRet == 0 {
@@ -222,200 +227,208 @@ func (x *DriveIface) GetGetIcon() func(Drive) *IconBase {
 }
 
 // OverrideHasVolumes sets the "has_volumes" callback function.
-// Returns %TRUE if the #GDrive has mountable volumes.
+// Returns true if the [Drive] has mountable volumes.
 func (x *DriveIface) OverrideHasVolumes(cb func(Drive) bool) {
 	if cb == nil {
 		x.xHasVolumes = 0
 	} else {
-		x.xHasVolumes = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xHasVolumes = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetHasVolumes gets the "has_volumes" callback function.
-// Returns %TRUE if the #GDrive has mountable volumes.
+// Returns true if the [Drive] has mountable volumes.
 func (x *DriveIface) GetHasVolumes() func(Drive) bool {
 	if x.xHasVolumes == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHasVolumes)
+	core.RegisterFunc(&rawCallback, x.xHasVolumes)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideGetVolumes sets the "get_volumes" callback function.
-// Returns a list #GList of #GVolume for the #GDrive.
+// Returns a list [glib.List] of [Volume] for the [Drive].
 func (x *DriveIface) OverrideGetVolumes(cb func(Drive) *glib.List) {
 	if cb == nil {
 		x.xGetVolumes = 0
 	} else {
-		x.xGetVolumes = purego.NewCallback(func(DriveVarp uintptr) *glib.List {
+		x.xGetVolumes = core.NewCallback(func(DriveVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetGetVolumes gets the "get_volumes" callback function.
-// Returns a list #GList of #GVolume for the #GDrive.
+// Returns a list [glib.List] of [Volume] for the [Drive].
 func (x *DriveIface) GetGetVolumes() func(Drive) *glib.List {
 	if x.xGetVolumes == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetVolumes)
+	core.RegisterFunc(&rawCallback, x.xGetVolumes)
 	return func(DriveVar Drive) *glib.List {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideIsMediaRemovable sets the "is_media_removable" callback function.
-// Returns %TRUE if the #GDrive supports removal and insertion of media.
+// Returns true if the [Drive] supports removal and insertion of media.
 func (x *DriveIface) OverrideIsMediaRemovable(cb func(Drive) bool) {
 	if cb == nil {
 		x.xIsMediaRemovable = 0
 	} else {
-		x.xIsMediaRemovable = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xIsMediaRemovable = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetIsMediaRemovable gets the "is_media_removable" callback function.
-// Returns %TRUE if the #GDrive supports removal and insertion of media.
+// Returns true if the [Drive] supports removal and insertion of media.
 func (x *DriveIface) GetIsMediaRemovable() func(Drive) bool {
 	if x.xIsMediaRemovable == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsMediaRemovable)
+	core.RegisterFunc(&rawCallback, x.xIsMediaRemovable)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideHasMedia sets the "has_media" callback function.
-// Returns %TRUE if the #GDrive has media inserted.
+// Returns true if the [Drive] has media inserted.
 func (x *DriveIface) OverrideHasMedia(cb func(Drive) bool) {
 	if cb == nil {
 		x.xHasMedia = 0
 	} else {
-		x.xHasMedia = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xHasMedia = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetHasMedia gets the "has_media" callback function.
-// Returns %TRUE if the #GDrive has media inserted.
+// Returns true if the [Drive] has media inserted.
 func (x *DriveIface) GetHasMedia() func(Drive) bool {
 	if x.xHasMedia == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHasMedia)
+	core.RegisterFunc(&rawCallback, x.xHasMedia)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideIsMediaCheckAutomatic sets the "is_media_check_automatic" callback function.
-// Returns %TRUE if the #GDrive is capable of automatically detecting media changes.
+// Returns true if the [Drive] is capable of automatically detecting media changes.
 func (x *DriveIface) OverrideIsMediaCheckAutomatic(cb func(Drive) bool) {
 	if cb == nil {
 		x.xIsMediaCheckAutomatic = 0
 	} else {
-		x.xIsMediaCheckAutomatic = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xIsMediaCheckAutomatic = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetIsMediaCheckAutomatic gets the "is_media_check_automatic" callback function.
-// Returns %TRUE if the #GDrive is capable of automatically detecting media changes.
+// Returns true if the [Drive] is capable of automatically detecting media changes.
 func (x *DriveIface) GetIsMediaCheckAutomatic() func(Drive) bool {
 	if x.xIsMediaCheckAutomatic == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsMediaCheckAutomatic)
+	core.RegisterFunc(&rawCallback, x.xIsMediaCheckAutomatic)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideCanEject sets the "can_eject" callback function.
-// Returns %TRUE if the #GDrive can eject media.
+// Returns true if the [Drive] can eject media.
 func (x *DriveIface) OverrideCanEject(cb func(Drive) bool) {
 	if cb == nil {
 		x.xCanEject = 0
 	} else {
-		x.xCanEject = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xCanEject = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetCanEject gets the "can_eject" callback function.
-// Returns %TRUE if the #GDrive can eject media.
+// Returns true if the [Drive] can eject media.
 func (x *DriveIface) GetCanEject() func(Drive) bool {
 	if x.xCanEject == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanEject)
+	core.RegisterFunc(&rawCallback, x.xCanEject)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideCanPollForMedia sets the "can_poll_for_media" callback function.
-// Returns %TRUE if the #GDrive is capable of manually polling for media change.
+// Returns true if the [Drive] is capable of manually polling for media change.
 func (x *DriveIface) OverrideCanPollForMedia(cb func(Drive) bool) {
 	if cb == nil {
 		x.xCanPollForMedia = 0
 	} else {
-		x.xCanPollForMedia = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xCanPollForMedia = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetCanPollForMedia gets the "can_poll_for_media" callback function.
-// Returns %TRUE if the #GDrive is capable of manually polling for media change.
+// Returns true if the [Drive] is capable of manually polling for media change.
 func (x *DriveIface) GetCanPollForMedia() func(Drive) bool {
 	if x.xCanPollForMedia == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanPollForMedia)
+	core.RegisterFunc(&rawCallback, x.xCanPollForMedia)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideEject sets the "eject" callback function.
-// Ejects a #GDrive.
+// Ejects a [Drive].
 func (x *DriveIface) OverrideEject(cb func(Drive, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEject = 0
 	} else {
-		x.xEject = purego.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEject = core.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEject gets the "eject" callback function.
-// Ejects a #GDrive.
+// Ejects a [Drive].
 func (x *DriveIface) GetEject() func(Drive, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEject == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEject)
+	core.RegisterFunc(&rawCallback, x.xEject)
 	return func(DriveVar Drive, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(DriveVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -427,7 +440,8 @@ func (x *DriveIface) OverrideEjectFinish(cb func(Drive, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectFinish = 0
 	} else {
-		x.xEjectFinish = purego.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectFinish = core.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -440,32 +454,33 @@ func (x *DriveIface) GetEjectFinish() func(Drive, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectFinish)
 	return func(DriveVar Drive, ResultVar AsyncResult) bool {
 		return rawCallback(DriveVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverridePollForMedia sets the "poll_for_media" callback function.
-// Poll for media insertion/removal on a #GDrive.
+// Poll for media insertion/removal on a [Drive].
 func (x *DriveIface) OverridePollForMedia(cb func(Drive, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xPollForMedia = 0
 	} else {
-		x.xPollForMedia = purego.NewCallback(func(DriveVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xPollForMedia = core.NewCallback(func(DriveVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp}, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetPollForMedia gets the "poll_for_media" callback function.
-// Poll for media insertion/removal on a #GDrive.
+// Poll for media insertion/removal on a [Drive].
 func (x *DriveIface) GetPollForMedia() func(Drive, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xPollForMedia == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPollForMedia)
+	core.RegisterFunc(&rawCallback, x.xPollForMedia)
 	return func(DriveVar Drive, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(DriveVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -477,7 +492,8 @@ func (x *DriveIface) OverridePollForMediaFinish(cb func(Drive, AsyncResult) bool
 	if cb == nil {
 		x.xPollForMediaFinish = 0
 	} else {
-		x.xPollForMediaFinish = purego.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+		x.xPollForMediaFinish = core.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -490,36 +506,37 @@ func (x *DriveIface) GetPollForMediaFinish() func(Drive, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPollForMediaFinish)
+	core.RegisterFunc(&rawCallback, x.xPollForMediaFinish)
 	return func(DriveVar Drive, ResultVar AsyncResult) bool {
 		return rawCallback(DriveVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGetIdentifier sets the "get_identifier" callback function.
-// Returns the identifier of the given kind, or %NULL if
+// Returns the identifier of the given kind, or nil if
 //
-//	the #GDrive doesn't have one.
+//	the [Drive] doesn't have one.
 func (x *DriveIface) OverrideGetIdentifier(cb func(Drive, string) string) {
 	if cb == nil {
 		x.xGetIdentifier = 0
 	} else {
-		x.xGetIdentifier = purego.NewCallback(func(DriveVarp uintptr, KindVarp string) string {
+		x.xGetIdentifier = core.NewCallback(func(DriveVarp uintptr, KindVarp string) string {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, KindVarp)
 		})
 	}
 }
 
 // GetGetIdentifier gets the "get_identifier" callback function.
-// Returns the identifier of the given kind, or %NULL if
+// Returns the identifier of the given kind, or nil if
 //
-//	the #GDrive doesn't have one.
+//	the [Drive] doesn't have one.
 func (x *DriveIface) GetGetIdentifier() func(Drive, string) string {
 	if x.xGetIdentifier == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, KindVarp string) string
-	purego.RegisterFunc(&rawCallback, x.xGetIdentifier)
+	core.RegisterFunc(&rawCallback, x.xGetIdentifier)
 	return func(DriveVar Drive, KindVar string) string {
 		return rawCallback(DriveVar.GoPointer(), KindVar)
 	}
@@ -528,12 +545,13 @@ func (x *DriveIface) GetGetIdentifier() func(Drive, string) string {
 // OverrideEnumerateIdentifiers sets the "enumerate_identifiers" callback function.
 // Returns an array strings listing the kinds
 //
-//	of identifiers which the #GDrive has.
+//	of identifiers which the [Drive] has.
 func (x *DriveIface) OverrideEnumerateIdentifiers(cb func(Drive) []string) {
 	if cb == nil {
 		x.xEnumerateIdentifiers = 0
 	} else {
-		x.xEnumerateIdentifiers = purego.NewCallback(func(DriveVarp uintptr) []string {
+		x.xEnumerateIdentifiers = core.NewCallback(func(DriveVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -542,113 +560,117 @@ func (x *DriveIface) OverrideEnumerateIdentifiers(cb func(Drive) []string) {
 // GetEnumerateIdentifiers gets the "enumerate_identifiers" callback function.
 // Returns an array strings listing the kinds
 //
-//	of identifiers which the #GDrive has.
+//	of identifiers which the [Drive] has.
 func (x *DriveIface) GetEnumerateIdentifiers() func(Drive) []string {
 	if x.xEnumerateIdentifiers == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xEnumerateIdentifiers)
+	core.RegisterFunc(&rawCallback, x.xEnumerateIdentifiers)
 	return func(DriveVar Drive) []string {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideGetStartStopType sets the "get_start_stop_type" callback function.
-// Gets a #GDriveStartStopType with details about starting/stopping the drive. Since 2.22.
+// Gets a [DriveStartStopType] with details about starting/stopping the drive. Since 2.22.
 func (x *DriveIface) OverrideGetStartStopType(cb func(Drive) DriveStartStopType) {
 	if cb == nil {
 		x.xGetStartStopType = 0
 	} else {
-		x.xGetStartStopType = purego.NewCallback(func(DriveVarp uintptr) DriveStartStopType {
+		x.xGetStartStopType = core.NewCallback(func(DriveVarp uintptr) DriveStartStopType {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetGetStartStopType gets the "get_start_stop_type" callback function.
-// Gets a #GDriveStartStopType with details about starting/stopping the drive. Since 2.22.
+// Gets a [DriveStartStopType] with details about starting/stopping the drive. Since 2.22.
 func (x *DriveIface) GetGetStartStopType() func(Drive) DriveStartStopType {
 	if x.xGetStartStopType == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) DriveStartStopType
-	purego.RegisterFunc(&rawCallback, x.xGetStartStopType)
+	core.RegisterFunc(&rawCallback, x.xGetStartStopType)
 	return func(DriveVar Drive) DriveStartStopType {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideCanStart sets the "can_start" callback function.
-// Returns %TRUE if a #GDrive can be started. Since 2.22.
+// Returns true if a [Drive] can be started. Since 2.22.
 func (x *DriveIface) OverrideCanStart(cb func(Drive) bool) {
 	if cb == nil {
 		x.xCanStart = 0
 	} else {
-		x.xCanStart = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xCanStart = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetCanStart gets the "can_start" callback function.
-// Returns %TRUE if a #GDrive can be started. Since 2.22.
+// Returns true if a [Drive] can be started. Since 2.22.
 func (x *DriveIface) GetCanStart() func(Drive) bool {
 	if x.xCanStart == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanStart)
+	core.RegisterFunc(&rawCallback, x.xCanStart)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideCanStartDegraded sets the "can_start_degraded" callback function.
-// Returns %TRUE if a #GDrive can be started degraded. Since 2.22.
+// Returns true if a [Drive] can be started degraded. Since 2.22.
 func (x *DriveIface) OverrideCanStartDegraded(cb func(Drive) bool) {
 	if cb == nil {
 		x.xCanStartDegraded = 0
 	} else {
-		x.xCanStartDegraded = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xCanStartDegraded = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetCanStartDegraded gets the "can_start_degraded" callback function.
-// Returns %TRUE if a #GDrive can be started degraded. Since 2.22.
+// Returns true if a [Drive] can be started degraded. Since 2.22.
 func (x *DriveIface) GetCanStartDegraded() func(Drive) bool {
 	if x.xCanStartDegraded == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanStartDegraded)
+	core.RegisterFunc(&rawCallback, x.xCanStartDegraded)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideStart sets the "start" callback function.
-// Starts a #GDrive. Since 2.22.
+// Starts a [Drive]. Since 2.22.
 func (x *DriveIface) OverrideStart(cb func(Drive, DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xStart = 0
 	} else {
-		x.xStart = purego.NewCallback(func(DriveVarp uintptr, FlagsVarp DriveStartFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xStart = core.NewCallback(func(DriveVarp uintptr, FlagsVarp DriveStartFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetStart gets the "start" callback function.
-// Starts a #GDrive. Since 2.22.
+// Starts a [Drive]. Since 2.22.
 func (x *DriveIface) GetStart() func(Drive, DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xStart == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, FlagsVarp DriveStartFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStart)
+	core.RegisterFunc(&rawCallback, x.xStart)
 	return func(DriveVar Drive, FlagsVar DriveStartFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(DriveVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -660,7 +682,8 @@ func (x *DriveIface) OverrideStartFinish(cb func(Drive, AsyncResult) bool) {
 	if cb == nil {
 		x.xStartFinish = 0
 	} else {
-		x.xStartFinish = purego.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+		x.xStartFinish = core.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -673,57 +696,59 @@ func (x *DriveIface) GetStartFinish() func(Drive, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xStartFinish)
+	core.RegisterFunc(&rawCallback, x.xStartFinish)
 	return func(DriveVar Drive, ResultVar AsyncResult) bool {
 		return rawCallback(DriveVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideCanStop sets the "can_stop" callback function.
-// Returns %TRUE if a #GDrive can be stopped. Since 2.22.
+// Returns true if a [Drive] can be stopped. Since 2.22.
 func (x *DriveIface) OverrideCanStop(cb func(Drive) bool) {
 	if cb == nil {
 		x.xCanStop = 0
 	} else {
-		x.xCanStop = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xCanStop = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetCanStop gets the "can_stop" callback function.
-// Returns %TRUE if a #GDrive can be stopped. Since 2.22.
+// Returns true if a [Drive] can be stopped. Since 2.22.
 func (x *DriveIface) GetCanStop() func(Drive) bool {
 	if x.xCanStop == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanStop)
+	core.RegisterFunc(&rawCallback, x.xCanStop)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideStop sets the "stop" callback function.
-// Stops a #GDrive. Since 2.22.
+// Stops a [Drive]. Since 2.22.
 func (x *DriveIface) OverrideStop(cb func(Drive, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xStop = 0
 	} else {
-		x.xStop = purego.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xStop = core.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetStop gets the "stop" callback function.
-// Stops a #GDrive. Since 2.22.
+// Stops a [Drive]. Since 2.22.
 func (x *DriveIface) GetStop() func(Drive, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xStop == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStop)
+	core.RegisterFunc(&rawCallback, x.xStop)
 	return func(DriveVar Drive, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(DriveVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -735,7 +760,8 @@ func (x *DriveIface) OverrideStopFinish(cb func(Drive, AsyncResult) bool) {
 	if cb == nil {
 		x.xStopFinish = 0
 	} else {
-		x.xStopFinish = purego.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+		x.xStopFinish = core.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -748,7 +774,7 @@ func (x *DriveIface) GetStopFinish() func(Drive, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xStopFinish)
+	core.RegisterFunc(&rawCallback, x.xStopFinish)
 	return func(DriveVar Drive, ResultVar AsyncResult) bool {
 		return rawCallback(DriveVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -760,7 +786,8 @@ func (x *DriveIface) OverrideStopButton(cb func(Drive)) {
 	if cb == nil {
 		x.xStopButton = 0
 	} else {
-		x.xStopButton = purego.NewCallback(func(DriveVarp uintptr) {
+		x.xStopButton = core.NewCallback(func(DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -773,94 +800,98 @@ func (x *DriveIface) GetStopButton() func(Drive) {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStopButton)
+	core.RegisterFunc(&rawCallback, x.xStopButton)
 	return func(DriveVar Drive) {
 		rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideEjectWithOperation sets the "eject_with_operation" callback function.
-// Starts ejecting a #GDrive using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Drive] using a [MountOperation]. Since 2.22.
 func (x *DriveIface) OverrideEjectWithOperation(cb func(Drive, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEjectWithOperation = 0
 	} else {
-		x.xEjectWithOperation = purego.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEjectWithOperation = core.NewCallback(func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DriveBase{Ptr: DriveVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEjectWithOperation gets the "eject_with_operation" callback function.
-// Starts ejecting a #GDrive using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Drive] using a [MountOperation]. Since 2.22.
 func (x *DriveIface) GetEjectWithOperation() func(Drive, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEjectWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperation)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperation)
 	return func(DriveVar Drive, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(DriveVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideEjectWithOperationFinish sets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *DriveIface) OverrideEjectWithOperationFinish(cb func(Drive, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectWithOperationFinish = 0
 	} else {
-		x.xEjectWithOperationFinish = purego.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectWithOperationFinish = core.NewCallback(func(DriveVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetEjectWithOperationFinish gets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *DriveIface) GetEjectWithOperationFinish() func(Drive, AsyncResult) bool {
 	if x.xEjectWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
 	return func(DriveVar Drive, ResultVar AsyncResult) bool {
 		return rawCallback(DriveVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGetSortKey sets the "get_sort_key" callback function.
-// Gets a key used for sorting #GDrive instances or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Drive] instances or nil if no such key exists. Since 2.32.
 func (x *DriveIface) OverrideGetSortKey(cb func(Drive) string) {
 	if cb == nil {
 		x.xGetSortKey = 0
 	} else {
-		x.xGetSortKey = purego.NewCallback(func(DriveVarp uintptr) string {
+		x.xGetSortKey = core.NewCallback(func(DriveVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetGetSortKey gets the "get_sort_key" callback function.
-// Gets a key used for sorting #GDrive instances or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Drive] instances or nil if no such key exists. Since 2.32.
 func (x *DriveIface) GetGetSortKey() func(Drive) string {
 	if x.xGetSortKey == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetSortKey)
+	core.RegisterFunc(&rawCallback, x.xGetSortKey)
 	return func(DriveVar Drive) string {
 		return rawCallback(DriveVar.GoPointer())
 	}
 }
 
 // OverrideGetSymbolicIcon sets the "get_symbolic_icon" callback function.
-// Returns a symbolic #GIcon for the given #GDrive. Since 2.34.
+// Returns a symbolic [Icon] for the given [Drive]. Since 2.34.
 func (x *DriveIface) OverrideGetSymbolicIcon(cb func(Drive) *IconBase) {
 	if cb == nil {
 		x.xGetSymbolicIcon = 0
 	} else {
-		x.xGetSymbolicIcon = purego.NewCallback(func(DriveVarp uintptr) uintptr {
+		x.xGetSymbolicIcon = core.NewCallback(func(DriveVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&DriveBase{Ptr: DriveVarp})
 			if ret == nil {
 				return 0
@@ -871,13 +902,13 @@ func (x *DriveIface) OverrideGetSymbolicIcon(cb func(Drive) *IconBase) {
 }
 
 // GetGetSymbolicIcon gets the "get_symbolic_icon" callback function.
-// Returns a symbolic #GIcon for the given #GDrive. Since 2.34.
+// Returns a symbolic [Icon] for the given [Drive]. Since 2.34.
 func (x *DriveIface) GetGetSymbolicIcon() func(Drive) *IconBase {
 	if x.xGetSymbolicIcon == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
+	core.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
 	return func(DriveVar Drive) *IconBase {
 		rawRet := rawCallback(DriveVar.GoPointer())
 		if rawRet == 0 {
@@ -890,25 +921,26 @@ func (x *DriveIface) GetGetSymbolicIcon() func(Drive) *IconBase {
 }
 
 // OverrideIsRemovable sets the "is_removable" callback function.
-// Returns %TRUE if the #GDrive and/or its media is considered removable by the user. Since 2.50.
+// Returns true if the [Drive] and/or its media is considered removable by the user. Since 2.50.
 func (x *DriveIface) OverrideIsRemovable(cb func(Drive) bool) {
 	if cb == nil {
 		x.xIsRemovable = 0
 	} else {
-		x.xIsRemovable = purego.NewCallback(func(DriveVarp uintptr) bool {
+		x.xIsRemovable = core.NewCallback(func(DriveVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DriveBase{Ptr: DriveVarp})
 		})
 	}
 }
 
 // GetIsRemovable gets the "is_removable" callback function.
-// Returns %TRUE if the #GDrive and/or its media is considered removable by the user. Since 2.50.
+// Returns true if the [Drive] and/or its media is considered removable by the user. Since 2.50.
 func (x *DriveIface) GetIsRemovable() func(Drive) bool {
 	if x.xIsRemovable == 0 {
 		return nil
 	}
 	var rawCallback func(DriveVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsRemovable)
+	core.RegisterFunc(&rawCallback, x.xIsRemovable)
 	return func(DriveVar Drive) bool {
 		return rawCallback(DriveVar.GoPointer())
 	}
@@ -918,7 +950,7 @@ func (x *DriveIface) GetIsRemovable() func(Drive) bool {
 // It’s generally only created for removable hardware or hardware with
 // removable media. For example, an optical disc drive, or a USB flash drive.
 //
-// `GDrive` is a container class for [iface@Gio.Volume] objects that stem from
+// `GDrive` is a container class for [Volume] objects that stem from
 // the same piece of media. As such, `GDrive` abstracts a drive with
 // (or without) removable media and provides operations for querying
 // whether media is available, determining whether media change is
@@ -936,9 +968,9 @@ func (x *DriveIface) GetIsRemovable() func(Drive) bool {
 // multi-disk devices such as RAID devices. Note that the actual
 // semantics and side-effects of starting/stopping a `GDrive` may vary
 // according to implementation. To choose the correct verbs in e.g. a
-// file manager, use [method@Gio.Drive.get_start_stop_type].
+// file manager, use [Drive.GetStartStopType].
 //
-// For [porting from GnomeVFS](migrating-gnome-vfs.html) note that there is no
+// For porting from GnomeVFS note that there is no
 // equivalent of `GDrive` in that API.
 type Drive interface {
 	GoPointer() uintptr
@@ -994,6 +1026,263 @@ func (x *DriveBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementDrive adds the Drive interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Changed()
+//   - Disconnected()
+//   - EjectButton()
+//   - GetName() string
+//   - GetIcon() *IconBase
+//   - HasVolumes() bool
+//   - GetVolumes() *glib.List
+//   - IsMediaRemovable() bool
+//   - HasMedia() bool
+//   - IsMediaCheckAutomatic() bool
+//   - CanEject() bool
+//   - CanPollForMedia() bool
+//   - Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectFinish(AsyncResult) bool
+//   - PollForMedia(*Cancellable, *AsyncReadyCallback, uintptr)
+//   - PollForMediaFinish(AsyncResult) bool
+//   - GetIdentifier(string) string
+//   - EnumerateIdentifiers() []string
+//   - GetStartStopType() DriveStartStopType
+//   - CanStart() bool
+//   - CanStartDegraded() bool
+//   - Start(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - StartFinish(AsyncResult) bool
+//   - CanStop() bool
+//   - Stop(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - StopFinish(AsyncResult) bool
+//   - StopButton()
+//   - EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectWithOperationFinish(AsyncResult) bool
+//   - GetSortKey() string
+//   - GetSymbolicIcon() *IconBase
+//   - IsRemovable() bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DriveIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDrive[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DriveGLibType(), func(iface *DriveIface) {
+		if _, ok := any(zero).(interface{ Changed() }); ok {
+			iface.OverrideChanged(func(self Drive) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Changed() })
+				impl.Changed()
+			})
+		}
+		if _, ok := any(zero).(interface{ Disconnected() }); ok {
+			iface.OverrideDisconnected(func(self Drive) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Disconnected() })
+				impl.Disconnected()
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectButton() }); ok {
+			iface.OverrideEjectButton(func(self Drive) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectButton() })
+				impl.EjectButton()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetName() string }); ok {
+			iface.OverrideGetName(func(self Drive) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetName() string })
+				return impl.GetName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIcon() *IconBase }); ok {
+			iface.OverrideGetIcon(func(self Drive) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIcon() *IconBase })
+				return impl.GetIcon()
+			})
+		}
+		if _, ok := any(zero).(interface{ HasVolumes() bool }); ok {
+			iface.OverrideHasVolumes(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HasVolumes() bool })
+				return impl.HasVolumes()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetVolumes() *glib.List }); ok {
+			iface.OverrideGetVolumes(func(self Drive) *glib.List {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetVolumes() *glib.List })
+				return impl.GetVolumes()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsMediaRemovable() bool }); ok {
+			iface.OverrideIsMediaRemovable(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsMediaRemovable() bool })
+				return impl.IsMediaRemovable()
+			})
+		}
+		if _, ok := any(zero).(interface{ HasMedia() bool }); ok {
+			iface.OverrideHasMedia(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HasMedia() bool })
+				return impl.HasMedia()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsMediaCheckAutomatic() bool }); ok {
+			iface.OverrideIsMediaCheckAutomatic(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsMediaCheckAutomatic() bool })
+				return impl.IsMediaCheckAutomatic()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanEject() bool }); ok {
+			iface.OverrideCanEject(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanEject() bool })
+				return impl.CanEject()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanPollForMedia() bool }); ok {
+			iface.OverrideCanPollForMedia(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanPollForMedia() bool })
+				return impl.CanPollForMedia()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEject(func(self Drive, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Eject(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectFinish(func(self Drive, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectFinish(AsyncResult) bool })
+				return impl.EjectFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			PollForMedia(*Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverridePollForMedia(func(self Drive, arg0 *Cancellable, arg1 *AsyncReadyCallback, arg2 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					PollForMedia(*Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.PollForMedia(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ PollForMediaFinish(AsyncResult) bool }); ok {
+			iface.OverridePollForMediaFinish(func(self Drive, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PollForMediaFinish(AsyncResult) bool })
+				return impl.PollForMediaFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIdentifier(string) string }); ok {
+			iface.OverrideGetIdentifier(func(self Drive, arg0 string) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIdentifier(string) string })
+				return impl.GetIdentifier(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ EnumerateIdentifiers() []string }); ok {
+			iface.OverrideEnumerateIdentifiers(func(self Drive) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EnumerateIdentifiers() []string })
+				return impl.EnumerateIdentifiers()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetStartStopType() DriveStartStopType }); ok {
+			iface.OverrideGetStartStopType(func(self Drive) DriveStartStopType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetStartStopType() DriveStartStopType })
+				return impl.GetStartStopType()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanStart() bool }); ok {
+			iface.OverrideCanStart(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanStart() bool })
+				return impl.CanStart()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanStartDegraded() bool }); ok {
+			iface.OverrideCanStartDegraded(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanStartDegraded() bool })
+				return impl.CanStartDegraded()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Start(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideStart(func(self Drive, arg0 DriveStartFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Start(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Start(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ StartFinish(AsyncResult) bool }); ok {
+			iface.OverrideStartFinish(func(self Drive, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StartFinish(AsyncResult) bool })
+				return impl.StartFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ CanStop() bool }); ok {
+			iface.OverrideCanStop(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanStop() bool })
+				return impl.CanStop()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Stop(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideStop(func(self Drive, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Stop(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Stop(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ StopFinish(AsyncResult) bool }); ok {
+			iface.OverrideStopFinish(func(self Drive, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StopFinish(AsyncResult) bool })
+				return impl.StopFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ StopButton() }); ok {
+			iface.OverrideStopButton(func(self Drive) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StopButton() })
+				impl.StopButton()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEjectWithOperation(func(self Drive, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EjectWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectWithOperationFinish(func(self Drive, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectWithOperationFinish(AsyncResult) bool })
+				return impl.EjectWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSortKey() string }); ok {
+			iface.OverrideGetSortKey(func(self Drive) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSortKey() string })
+				return impl.GetSortKey()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSymbolicIcon() *IconBase }); ok {
+			iface.OverrideGetSymbolicIcon(func(self Drive) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSymbolicIcon() *IconBase })
+				return impl.GetSymbolicIcon()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsRemovable() bool }); ok {
+			iface.OverrideIsRemovable(func(self Drive) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsRemovable() bool })
+				return impl.IsRemovable()
+			})
+		}
+	})
+}
+
 // Checks if a drive can be ejected.
 func (x *DriveBase) CanEject() bool {
 
@@ -1031,59 +1320,95 @@ func (x *DriveBase) CanStop() bool {
 
 // Asynchronously ejects a drive.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_drive_eject_finish() to obtain the
+// When the operation is finished, callback will be called.
+// You can then call [Drive.EjectFinish] to obtain the
 // result of the operation.
+//
+// Deprecated: since 2.22. Use [Drive.EjectWithOperation] instead.
 func (x *DriveBase) Eject(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDriveEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDriveEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a drive.
+//
+// Deprecated: since 2.22. Use [Drive.EjectWithOperationFinish] instead.
 func (x *DriveBase) EjectFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDriveEjectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Ejects a drive. This is an asynchronous operation, and is
-// finished by calling g_drive_eject_with_operation_finish() with the @drive
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Drive.EjectWithOperationFinish] with the drive
+// and [AsyncResult] data returned in the callback.
 func (x *DriveBase) EjectWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDriveEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDriveEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a drive. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 func (x *DriveBase) EjectWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDriveEjectWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Gets the kinds of identifiers that @drive has.
-// Use g_drive_get_identifier() to obtain the identifiers
+// Gets the kinds of identifiers that drive has.
+// Use [Drive.GetIdentifier] to obtain the identifiers
 // themselves.
 func (x *DriveBase) EnumerateIdentifiers() []string {
 
 	cret := XGDriveEnumerateIdentifiers(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Gets the icon for @drive.
+// Gets the icon for drive.
 func (x *DriveBase) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -1097,23 +1422,23 @@ func (x *DriveBase) GetIcon() *IconBase {
 	return cls
 }
 
-// Gets the identifier of the given kind for @drive. The only
+// Gets the identifier of the given kind for drive. The only
 // identifier currently available is
-// %G_DRIVE_IDENTIFIER_KIND_UNIX_DEVICE.
+// [DRIVE_IDENTIFIER_KIND_UNIX_DEVICE].
 func (x *DriveBase) GetIdentifier(KindVar string) string {
 
 	cret := XGDriveGetIdentifier(x.GoPointer(), KindVar)
 	return cret
 }
 
-// Gets the name of @drive.
+// Gets the name of drive.
 func (x *DriveBase) GetName() string {
 
 	cret := XGDriveGetName(x.GoPointer())
 	return cret
 }
 
-// Gets the sort key for @drive, if any.
+// Gets the sort key for drive, if any.
 func (x *DriveBase) GetSortKey() string {
 
 	cret := XGDriveGetSortKey(x.GoPointer())
@@ -1127,7 +1452,7 @@ func (x *DriveBase) GetStartStopType() DriveStartStopType {
 	return cret
 }
 
-// Gets the icon for @drive.
+// Gets the icon for drive.
 func (x *DriveBase) GetSymbolicIcon() *IconBase {
 	var cls *IconBase
 
@@ -1141,18 +1466,18 @@ func (x *DriveBase) GetSymbolicIcon() *IconBase {
 	return cls
 }
 
-// Get a list of mountable volumes for @drive.
+// Get a list of mountable volumes for drive.
 //
 // The returned list should be freed with g_list_free(), after
-// its elements have been unreffed with g_object_unref().
+// its elements have been unreffed with [gobject.Object.Unref].
 func (x *DriveBase) GetVolumes() *glib.List {
 
 	cret := XGDriveGetVolumes(x.GoPointer())
 	return cret
 }
 
-// Checks if the @drive has media. Note that the OS may not be polling
-// the drive for media changes; see g_drive_is_media_check_automatic()
+// Checks if the drive has media. Note that the OS may not be polling
+// the drive for media changes; see [Drive.IsMediaCheckAutomatic]
 // for more details.
 func (x *DriveBase) HasMedia() bool {
 
@@ -1160,53 +1485,69 @@ func (x *DriveBase) HasMedia() bool {
 	return cret
 }
 
-// Check if @drive has any mountable volumes.
+// Check if drive has any mountable volumes.
 func (x *DriveBase) HasVolumes() bool {
 
 	cret := XGDriveHasVolumes(x.GoPointer())
 	return cret
 }
 
-// Checks if @drive is capable of automatically detecting media changes.
+// Checks if drive is capable of automatically detecting media changes.
 func (x *DriveBase) IsMediaCheckAutomatic() bool {
 
 	cret := XGDriveIsMediaCheckAutomatic(x.GoPointer())
 	return cret
 }
 
-// Checks if the @drive supports removable media.
+// Checks if the drive supports removable media.
 func (x *DriveBase) IsMediaRemovable() bool {
 
 	cret := XGDriveIsMediaRemovable(x.GoPointer())
 	return cret
 }
 
-// Checks if the #GDrive and/or its media is considered removable by the user.
-// See g_drive_is_media_removable().
+// Checks if the [Drive] and/or its media is considered removable by the user.
+// See [Drive.IsMediaRemovable].
 func (x *DriveBase) IsRemovable() bool {
 
 	cret := XGDriveIsRemovable(x.GoPointer())
 	return cret
 }
 
-// Asynchronously polls @drive to see if media has been inserted or removed.
+// Asynchronously polls drive to see if media has been inserted or removed.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_drive_poll_for_media_finish() to obtain the
+// When the operation is finished, callback will be called.
+// You can then call [Drive.PollForMediaFinish] to obtain the
 // result of the operation.
 func (x *DriveBase) PollForMedia(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDrivePollForMedia(x.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDrivePollForMedia(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes an operation started with g_drive_poll_for_media() on a drive.
+// Finishes an operation started with [Drive.PollForMedia] on a drive.
 func (x *DriveBase) PollForMediaFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDrivePollForMediaFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1214,12 +1555,28 @@ func (x *DriveBase) PollForMediaFinish(ResultVar AsyncResult) (bool, error) {
 
 // Asynchronously starts a drive.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_drive_start_finish() to obtain the
+// When the operation is finished, callback will be called.
+// You can then call [Drive.StartFinish] to obtain the
 // result of the operation.
 func (x *DriveBase) Start(FlagsVar DriveStartFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDriveStart(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDriveStart(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
@@ -1229,7 +1586,7 @@ func (x *DriveBase) StartFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := XGDriveStartFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1237,12 +1594,28 @@ func (x *DriveBase) StartFinish(ResultVar AsyncResult) (bool, error) {
 
 // Asynchronously stops a drive.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_drive_stop_finish() to obtain the
+// When the operation is finished, callback will be called.
+// You can then call [Drive.StopFinish] to obtain the
 // result of the operation.
 func (x *DriveBase) Stop(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDriveStop(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDriveStop(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
@@ -1252,7 +1625,7 @@ func (x *DriveBase) StopFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := XGDriveStopFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1267,7 +1640,7 @@ var XGDriveEject func(uintptr, MountUnmountFlags, uintptr, uintptr, uintptr)
 var XGDriveEjectFinish func(uintptr, uintptr, **glib.Error) bool
 var XGDriveEjectWithOperation func(uintptr, MountUnmountFlags, uintptr, uintptr, uintptr, uintptr)
 var XGDriveEjectWithOperationFinish func(uintptr, uintptr, **glib.Error) bool
-var XGDriveEnumerateIdentifiers func(uintptr) []string
+var XGDriveEnumerateIdentifiers func(uintptr) uintptr
 var XGDriveGetIcon func(uintptr) uintptr
 var XGDriveGetIdentifier func(uintptr, string) string
 var XGDriveGetName func(uintptr) string
@@ -1288,7 +1661,7 @@ var XGDriveStop func(uintptr, MountUnmountFlags, uintptr, uintptr, uintptr, uint
 var XGDriveStopFinish func(uintptr, uintptr, **glib.Error) bool
 
 const (
-	// The string used to obtain a Unix device path with g_drive_get_identifier().
+	// The string used to obtain a Unix device path with [Drive.GetIdentifier].
 	DRIVE_IDENTIFIER_KIND_UNIX_DEVICE string = "unix-device"
 )
 
@@ -1297,7 +1670,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1306,33 +1679,35 @@ func init() {
 
 	core.PuregoSafeRegister(&xDriveGLibType, libs, "g_drive_get_type")
 
-	core.PuregoSafeRegister(&XGDriveCanEject, libs, "g_drive_can_eject")
-	core.PuregoSafeRegister(&XGDriveCanPollForMedia, libs, "g_drive_can_poll_for_media")
-	core.PuregoSafeRegister(&XGDriveCanStart, libs, "g_drive_can_start")
-	core.PuregoSafeRegister(&XGDriveCanStartDegraded, libs, "g_drive_can_start_degraded")
-	core.PuregoSafeRegister(&XGDriveCanStop, libs, "g_drive_can_stop")
-	core.PuregoSafeRegister(&XGDriveEject, libs, "g_drive_eject")
-	core.PuregoSafeRegister(&XGDriveEjectFinish, libs, "g_drive_eject_finish")
-	core.PuregoSafeRegister(&XGDriveEjectWithOperation, libs, "g_drive_eject_with_operation")
-	core.PuregoSafeRegister(&XGDriveEjectWithOperationFinish, libs, "g_drive_eject_with_operation_finish")
-	core.PuregoSafeRegister(&XGDriveEnumerateIdentifiers, libs, "g_drive_enumerate_identifiers")
-	core.PuregoSafeRegister(&XGDriveGetIcon, libs, "g_drive_get_icon")
-	core.PuregoSafeRegister(&XGDriveGetIdentifier, libs, "g_drive_get_identifier")
-	core.PuregoSafeRegister(&XGDriveGetName, libs, "g_drive_get_name")
-	core.PuregoSafeRegister(&XGDriveGetSortKey, libs, "g_drive_get_sort_key")
-	core.PuregoSafeRegister(&XGDriveGetStartStopType, libs, "g_drive_get_start_stop_type")
-	core.PuregoSafeRegister(&XGDriveGetSymbolicIcon, libs, "g_drive_get_symbolic_icon")
-	core.PuregoSafeRegister(&XGDriveGetVolumes, libs, "g_drive_get_volumes")
-	core.PuregoSafeRegister(&XGDriveHasMedia, libs, "g_drive_has_media")
-	core.PuregoSafeRegister(&XGDriveHasVolumes, libs, "g_drive_has_volumes")
-	core.PuregoSafeRegister(&XGDriveIsMediaCheckAutomatic, libs, "g_drive_is_media_check_automatic")
-	core.PuregoSafeRegister(&XGDriveIsMediaRemovable, libs, "g_drive_is_media_removable")
-	core.PuregoSafeRegister(&XGDriveIsRemovable, libs, "g_drive_is_removable")
-	core.PuregoSafeRegister(&XGDrivePollForMedia, libs, "g_drive_poll_for_media")
-	core.PuregoSafeRegister(&XGDrivePollForMediaFinish, libs, "g_drive_poll_for_media_finish")
-	core.PuregoSafeRegister(&XGDriveStart, libs, "g_drive_start")
-	core.PuregoSafeRegister(&XGDriveStartFinish, libs, "g_drive_start_finish")
-	core.PuregoSafeRegister(&XGDriveStop, libs, "g_drive_stop")
-	core.PuregoSafeRegister(&XGDriveStopFinish, libs, "g_drive_stop_finish")
+	gobject.CheckClassSize("GDriveIface", xDriveGLibType, unsafe.Sizeof(DriveIface{}))
+
+	core.PuregoRegisterSince(&XGDriveCanEject, libs, "g_drive_can_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveCanPollForMedia, libs, "g_drive_can_poll_for_media", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveCanStart, libs, "g_drive_can_start", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveCanStartDegraded, libs, "g_drive_can_start_degraded", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveCanStop, libs, "g_drive_can_stop", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveEject, libs, "g_drive_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveEjectFinish, libs, "g_drive_eject_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveEjectWithOperation, libs, "g_drive_eject_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveEjectWithOperationFinish, libs, "g_drive_eject_with_operation_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveEnumerateIdentifiers, libs, "g_drive_enumerate_identifiers", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveGetIcon, libs, "g_drive_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveGetIdentifier, libs, "g_drive_get_identifier", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveGetName, libs, "g_drive_get_name", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveGetSortKey, libs, "g_drive_get_sort_key", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGDriveGetStartStopType, libs, "g_drive_get_start_stop_type", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveGetSymbolicIcon, libs, "g_drive_get_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGDriveGetVolumes, libs, "g_drive_get_volumes", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveHasMedia, libs, "g_drive_has_media", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveHasVolumes, libs, "g_drive_has_volumes", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveIsMediaCheckAutomatic, libs, "g_drive_is_media_check_automatic", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveIsMediaRemovable, libs, "g_drive_is_media_removable", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveIsRemovable, libs, "g_drive_is_removable", "GIO", "2.50")
+	core.PuregoRegisterSince(&XGDrivePollForMedia, libs, "g_drive_poll_for_media", "GIO", "")
+	core.PuregoRegisterSince(&XGDrivePollForMediaFinish, libs, "g_drive_poll_for_media_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGDriveStart, libs, "g_drive_start", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveStartFinish, libs, "g_drive_start_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveStop, libs, "g_drive_stop", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGDriveStopFinish, libs, "g_drive_stop_finish", "GIO", "2.22")
 
 }
@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// vtable for a #GDtlsClientConnection implementation.
+// vtable for a [DtlsClientConnection] implementation.
 type DtlsClientConnectionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 }
 
 func (x *DtlsClientConnectionInterface) GoPointer() uintptr {
@@ -24,7 +23,7 @@ func (x *DtlsClientConnectionInterface) GoPointer() uintptr {
 }
 
 // `GDtlsClientConnection` is the client-side subclass of
-// [iface@Gio.DtlsConnection], representing a client-side DTLS connection.
+// [DtlsConnection], representing a client-side DTLS connection.
 type DtlsClientConnection interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -59,9 +58,9 @@ func (x *DtlsClientConnectionBase) SetGoPointer(ptr uintptr) {
 // Gets the list of distinguished names of the Certificate Authorities
 // that the server will accept certificates from. This will be set
 // during the TLS handshake if the server requests a certificate.
-// Otherwise, it will be %NULL.
+// Otherwise, it will be nil.
 //
-// Each item in the list is a #GByteArray which contains the complete
+// Each item in the list is a [glib.ByteArray] which contains the complete
 // subject DN of the certificate authority.
 func (x *DtlsClientConnectionBase) GetAcceptedCas() *glib.List {
 
@@ -69,7 +68,7 @@ func (x *DtlsClientConnectionBase) GetAcceptedCas() *glib.List {
 	return cret
 }
 
-// Gets @conn's expected server identity
+// Gets conn's expected server identity
 func (x *DtlsClientConnectionBase) GetServerIdentity() *SocketConnectableBase {
 	var cls *SocketConnectableBase
 
@@ -84,34 +83,38 @@ func (x *DtlsClientConnectionBase) GetServerIdentity() *SocketConnectableBase {
 	return cls
 }
 
-// Gets @conn's validation flags
+// Gets conn's validation flags
 //
 // This function does not work as originally designed and is impossible
-// to use correctly. See #GDtlsClientConnection:validation-flags for more
+// to use correctly. See GDtlsClientConnection:validation-flags for more
 // information.
+//
+// Deprecated: since 2.74. Do not attempt to ignore validation errors.
 func (x *DtlsClientConnectionBase) GetValidationFlags() TlsCertificateFlags {
 
 	cret := XGDtlsClientConnectionGetValidationFlags(x.GoPointer())
 	return cret
 }
 
-// Sets @conn's expected server identity, which is used both to tell
+// Sets conn's expected server identity, which is used both to tell
 // servers on virtual hosts which certificate to present, and also
-// to let @conn know what name to look for in the certificate when
-// performing %G_TLS_CERTIFICATE_BAD_IDENTITY validation, if enabled.
+// to let conn know what name to look for in the certificate when
+// performing [GTlsCertificateBadIdentityValue] validation, if enabled.
 func (x *DtlsClientConnectionBase) SetServerIdentity(IdentityVar SocketConnectable) {
 
 	XGDtlsClientConnectionSetServerIdentity(x.GoPointer(), IdentityVar.GoPointer())
 
 }
 
-// Sets @conn's validation flags, to override the default set of
+// Sets conn's validation flags, to override the default set of
 // checks performed when validating a server certificate. By default,
-// %G_TLS_CERTIFICATE_VALIDATE_ALL is used.
+// [GTlsCertificateValidateAllValue] is used.
 //
 // This function does not work as originally designed and is impossible
-// to use correctly. See #GDtlsClientConnection:validation-flags for more
+// to use correctly. See GDtlsClientConnection:validation-flags for more
 // information.
+//
+// Deprecated: since 2.74. Do not attempt to ignore validation errors.
 func (x *DtlsClientConnectionBase) SetValidationFlags(FlagsVar TlsCertificateFlags) {
 
 	XGDtlsClientConnectionSetValidationFlags(x.GoPointer(), FlagsVar)
@@ -126,8 +129,8 @@ var XGDtlsClientConnectionSetValidationFlags func(uintptr, TlsCertificateFlags)
 
 var xDtlsClientConnectionNew func(uintptr, uintptr, **glib.Error) uintptr
 
-// Creates a new #GDtlsClientConnection wrapping @base_socket which is
-// assumed to communicate with the server identified by @server_identity.
+// Creates a new [DtlsClientConnection] wrapping base_socket which is
+// assumed to communicate with the server identified by server_identity.
 func DtlsClientConnectionNew(BaseSocketVar DatagramBased, ServerIdentityVar SocketConnectable) (*DtlsClientConnectionBase, error) {
 	var cls *DtlsClientConnectionBase
 	var cerr *glib.Error
@@ -135,12 +138,15 @@ func DtlsClientConnectionNew(BaseSocketVar DatagramBased, ServerIdentityVar Sock
 	cret := xDtlsClientConnectionNew(BaseSocketVar.GoPointer(), ServerIdentityVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DtlsClientConnectionBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -151,21 +157,23 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDtlsClientConnectionNew, libs, "g_dtls_client_connection_new")
+	core.PuregoRegisterSince(&xDtlsClientConnectionNew, libs, "g_dtls_client_connection_new", "GIO", "2.48")
 
 	core.PuregoSafeRegister(&xDtlsClientConnectionGLibType, libs, "g_dtls_client_connection_get_type")
 
-	core.PuregoSafeRegister(&XGDtlsClientConnectionGetAcceptedCas, libs, "g_dtls_client_connection_get_accepted_cas")
-	core.PuregoSafeRegister(&XGDtlsClientConnectionGetServerIdentity, libs, "g_dtls_client_connection_get_server_identity")
-	core.PuregoSafeRegister(&XGDtlsClientConnectionGetValidationFlags, libs, "g_dtls_client_connection_get_validation_flags")
-	core.PuregoSafeRegister(&XGDtlsClientConnectionSetServerIdentity, libs, "g_dtls_client_connection_set_server_identity")
-	core.PuregoSafeRegister(&XGDtlsClientConnectionSetValidationFlags, libs, "g_dtls_client_connection_set_validation_flags")
+	gobject.CheckClassSize("GDtlsClientConnectionInterface", xDtlsClientConnectionGLibType, unsafe.Sizeof(DtlsClientConnectionInterface{}))
+
+	core.PuregoRegisterSince(&XGDtlsClientConnectionGetAcceptedCas, libs, "g_dtls_client_connection_get_accepted_cas", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsClientConnectionGetServerIdentity, libs, "g_dtls_client_connection_get_server_identity", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsClientConnectionGetValidationFlags, libs, "g_dtls_client_connection_get_validation_flags", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsClientConnectionSetServerIdentity, libs, "g_dtls_client_connection_set_server_identity", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsClientConnectionSetValidationFlags, libs, "g_dtls_client_connection_set_validation_flags", "GIO", "2.48")
 
 }
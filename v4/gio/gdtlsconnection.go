@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Virtual method table for a #GDtlsConnection implementation.
+// Virtual method table for a [DtlsConnection] implementation.
 type DtlsConnectionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xAcceptCertificate uintptr
 
@@ -49,7 +48,8 @@ func (x *DtlsConnectionInterface) OverrideAcceptCertificate(cb func(DtlsConnecti
 	if cb == nil {
 		x.xAcceptCertificate = 0
 	} else {
-		x.xAcceptCertificate = purego.NewCallback(func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+		x.xAcceptCertificate = core.NewCallback(func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnectionVarp}, TlsCertificateNewFromInternalPtr(PeerCertVarp), ErrorsVarp)
 		})
 	}
@@ -62,7 +62,7 @@ func (x *DtlsConnectionInterface) GetAcceptCertificate() func(DtlsConnection, *T
 		return nil
 	}
 	var rawCallback func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool
-	purego.RegisterFunc(&rawCallback, x.xAcceptCertificate)
+	core.RegisterFunc(&rawCallback, x.xAcceptCertificate)
 	return func(ConnectionVar DtlsConnection, PeerCertVar *TlsCertificate, ErrorsVar TlsCertificateFlags) bool {
 		return rawCallback(ConnectionVar.GoPointer(), PeerCertVar.GoPointer(), ErrorsVar)
 	}
@@ -74,7 +74,8 @@ func (x *DtlsConnectionInterface) OverrideHandshake(cb func(DtlsConnection, *Can
 	if cb == nil {
 		x.xHandshake = 0
 	} else {
-		x.xHandshake = purego.NewCallback(func(ConnVarp uintptr, CancellableVarp uintptr) bool {
+		x.xHandshake = core.NewCallback(func(ConnVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -87,7 +88,7 @@ func (x *DtlsConnectionInterface) GetHandshake() func(DtlsConnection, *Cancellab
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHandshake)
+	core.RegisterFunc(&rawCallback, x.xHandshake)
 	return func(ConnVar DtlsConnection, CancellableVar *Cancellable) bool {
 		return rawCallback(ConnVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -99,7 +100,8 @@ func (x *DtlsConnectionInterface) OverrideHandshakeAsync(cb func(DtlsConnection,
 	if cb == nil {
 		x.xHandshakeAsync = 0
 	} else {
-		x.xHandshakeAsync = purego.NewCallback(func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xHandshakeAsync = core.NewCallback(func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DtlsConnectionBase{Ptr: ConnVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -112,7 +114,7 @@ func (x *DtlsConnectionInterface) GetHandshakeAsync() func(DtlsConnection, int,
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHandshakeAsync)
+	core.RegisterFunc(&rawCallback, x.xHandshakeAsync)
 	return func(ConnVar DtlsConnection, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ConnVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -124,7 +126,8 @@ func (x *DtlsConnectionInterface) OverrideHandshakeFinish(cb func(DtlsConnection
 	if cb == nil {
 		x.xHandshakeFinish = 0
 	} else {
-		x.xHandshakeFinish = purego.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+		x.xHandshakeFinish = core.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -137,7 +140,7 @@ func (x *DtlsConnectionInterface) GetHandshakeFinish() func(DtlsConnection, Asyn
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHandshakeFinish)
+	core.RegisterFunc(&rawCallback, x.xHandshakeFinish)
 	return func(ConnVar DtlsConnection, ResultVar AsyncResult) bool {
 		return rawCallback(ConnVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -149,7 +152,8 @@ func (x *DtlsConnectionInterface) OverrideShutdown(cb func(DtlsConnection, bool,
 	if cb == nil {
 		x.xShutdown = 0
 	} else {
-		x.xShutdown = purego.NewCallback(func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, CancellableVarp uintptr) bool {
+		x.xShutdown = core.NewCallback(func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp}, ShutdownReadVarp, ShutdownWriteVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -162,7 +166,7 @@ func (x *DtlsConnectionInterface) GetShutdown() func(DtlsConnection, bool, bool,
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xShutdown)
+	core.RegisterFunc(&rawCallback, x.xShutdown)
 	return func(ConnVar DtlsConnection, ShutdownReadVar bool, ShutdownWriteVar bool, CancellableVar *Cancellable) bool {
 		return rawCallback(ConnVar.GoPointer(), ShutdownReadVar, ShutdownWriteVar, CancellableVar.GoPointer())
 	}
@@ -174,7 +178,8 @@ func (x *DtlsConnectionInterface) OverrideShutdownAsync(cb func(DtlsConnection,
 	if cb == nil {
 		x.xShutdownAsync = 0
 	} else {
-		x.xShutdownAsync = purego.NewCallback(func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xShutdownAsync = core.NewCallback(func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&DtlsConnectionBase{Ptr: ConnVarp}, ShutdownReadVarp, ShutdownWriteVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -187,7 +192,7 @@ func (x *DtlsConnectionInterface) GetShutdownAsync() func(DtlsConnection, bool,
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ShutdownReadVarp bool, ShutdownWriteVarp bool, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShutdownAsync)
+	core.RegisterFunc(&rawCallback, x.xShutdownAsync)
 	return func(ConnVar DtlsConnection, ShutdownReadVar bool, ShutdownWriteVar bool, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ConnVar.GoPointer(), ShutdownReadVar, ShutdownWriteVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -199,7 +204,8 @@ func (x *DtlsConnectionInterface) OverrideShutdownFinish(cb func(DtlsConnection,
 	if cb == nil {
 		x.xShutdownFinish = 0
 	} else {
-		x.xShutdownFinish = purego.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+		x.xShutdownFinish = core.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -212,7 +218,7 @@ func (x *DtlsConnectionInterface) GetShutdownFinish() func(DtlsConnection, Async
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xShutdownFinish)
+	core.RegisterFunc(&rawCallback, x.xShutdownFinish)
 	return func(ConnVar DtlsConnection, ResultVar AsyncResult) bool {
 		return rawCallback(ConnVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -224,7 +230,8 @@ func (x *DtlsConnectionInterface) OverrideSetAdvertisedProtocols(cb func(DtlsCon
 	if cb == nil {
 		x.xSetAdvertisedProtocols = 0
 	} else {
-		x.xSetAdvertisedProtocols = purego.NewCallback(func(ConnVarp uintptr, ProtocolsVarp []string) {
+		x.xSetAdvertisedProtocols = core.NewCallback(func(ConnVarp uintptr, ProtocolsVarp []string) {
+			defer core.RecoverPanic()
 			cb(&DtlsConnectionBase{Ptr: ConnVarp}, ProtocolsVarp)
 		})
 	}
@@ -237,7 +244,7 @@ func (x *DtlsConnectionInterface) GetSetAdvertisedProtocols() func(DtlsConnectio
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ProtocolsVarp []string)
-	purego.RegisterFunc(&rawCallback, x.xSetAdvertisedProtocols)
+	core.RegisterFunc(&rawCallback, x.xSetAdvertisedProtocols)
 	return func(ConnVar DtlsConnection, ProtocolsVar []string) {
 		rawCallback(ConnVar.GoPointer(), ProtocolsVar)
 	}
@@ -249,7 +256,8 @@ func (x *DtlsConnectionInterface) OverrideGetNegotiatedProtocol(cb func(DtlsConn
 	if cb == nil {
 		x.xGetNegotiatedProtocol = 0
 	} else {
-		x.xGetNegotiatedProtocol = purego.NewCallback(func(ConnVarp uintptr) string {
+		x.xGetNegotiatedProtocol = core.NewCallback(func(ConnVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp})
 		})
 	}
@@ -262,7 +270,7 @@ func (x *DtlsConnectionInterface) GetGetNegotiatedProtocol() func(DtlsConnection
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetNegotiatedProtocol)
+	core.RegisterFunc(&rawCallback, x.xGetNegotiatedProtocol)
 	return func(ConnVar DtlsConnection) string {
 		return rawCallback(ConnVar.GoPointer())
 	}
@@ -274,7 +282,8 @@ func (x *DtlsConnectionInterface) OverrideGetBindingData(cb func(DtlsConnection,
 	if cb == nil {
 		x.xGetBindingData = 0
 	} else {
-		x.xGetBindingData = purego.NewCallback(func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool {
+		x.xGetBindingData = core.NewCallback(func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool {
+			defer core.RecoverPanic()
 			return cb(&DtlsConnectionBase{Ptr: ConnVarp}, TypeVarp, DataVarp)
 		})
 	}
@@ -287,31 +296,31 @@ func (x *DtlsConnectionInterface) GetGetBindingData() func(DtlsConnection, TlsCh
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool
-	purego.RegisterFunc(&rawCallback, x.xGetBindingData)
+	core.RegisterFunc(&rawCallback, x.xGetBindingData)
 	return func(ConnVar DtlsConnection, TypeVar TlsChannelBindingType, DataVar []byte) bool {
 		return rawCallback(ConnVar.GoPointer(), TypeVar, DataVar)
 	}
 }
 
 // `GDtlsConnection` is the base DTLS connection class type, which wraps
-// a [iface@Gio.DatagramBased] and provides DTLS encryption on top of it. Its
-// subclasses, [iface@Gio.DtlsClientConnection] and
-// [iface@Gio.DtlsServerConnection], implement client-side and server-side DTLS,
+// a [DatagramBased] and provides DTLS encryption on top of it. Its
+// subclasses, [DtlsClientConnection] and
+// [DtlsServerConnection], implement client-side and server-side DTLS,
 // respectively.
 //
-// For TLS support, see [class@Gio.TlsConnection].
+// For TLS support, see [TlsConnection].
 //
 // As DTLS is datagram based, `GDtlsConnection` implements
-// [iface@Gio.DatagramBased], presenting a datagram-socket-like API for the
+// [DatagramBased], presenting a datagram-socket-like API for the
 // encrypted connection. This operates over a base datagram connection, which is
-// also a `GDatagramBased` ([property@Gio.DtlsConnection:base-socket]).
+// also a `GDatagramBased` (DtlsConnection:base-socket).
 //
-// To close a DTLS connection, use [method@Gio.DtlsConnection.close].
+// To close a DTLS connection, use [DtlsConnection.Close].
 //
-// Neither [iface@Gio.DtlsServerConnection] or [iface@Gio.DtlsClientConnection]
-// set the peer address on their base [iface@Gio.DatagramBased] if it is a
-// [class@Gio.Socket] — it is up to the caller to do that if they wish. If they
-// do not, and [method@Gio.Socket.close] is called on the base socket, the
+// Neither [DtlsServerConnection] or [DtlsClientConnection]
+// set the peer address on their base [DatagramBased] if it is a
+// [Socket] — it is up to the caller to do that if they wish. If they
+// do not, and [Socket.Close] is called on the base socket, the
 // `GDtlsConnection` will not raise a `G_IO_ERROR_NOT_CONNECTED` error on
 // further I/O.
 type DtlsConnection interface {
@@ -367,67 +376,186 @@ func (x *DtlsConnectionBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementDtlsConnection adds the DtlsConnection interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - AcceptCertificate(*TlsCertificate, TlsCertificateFlags) bool
+//   - Handshake(*Cancellable) bool
+//   - HandshakeAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - HandshakeFinish(AsyncResult) bool
+//   - Shutdown(bool, bool, *Cancellable) bool
+//   - ShutdownAsync(bool, bool, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - ShutdownFinish(AsyncResult) bool
+//   - SetAdvertisedProtocols([]string)
+//   - GetNegotiatedProtocol() string
+//   - GetBindingData(TlsChannelBindingType, []byte) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see DtlsConnectionInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementDtlsConnection[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, DtlsConnectionGLibType(), func(iface *DtlsConnectionInterface) {
+		if _, ok := any(zero).(interface {
+			AcceptCertificate(*TlsCertificate, TlsCertificateFlags) bool
+		}); ok {
+			iface.OverrideAcceptCertificate(func(self DtlsConnection, arg0 *TlsCertificate, arg1 TlsCertificateFlags) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AcceptCertificate(*TlsCertificate, TlsCertificateFlags) bool
+				})
+				return impl.AcceptCertificate(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ Handshake(*Cancellable) bool }); ok {
+			iface.OverrideHandshake(func(self DtlsConnection, arg0 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Handshake(*Cancellable) bool })
+				return impl.Handshake(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			HandshakeAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideHandshakeAsync(func(self DtlsConnection, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					HandshakeAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.HandshakeAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ HandshakeFinish(AsyncResult) bool }); ok {
+			iface.OverrideHandshakeFinish(func(self DtlsConnection, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HandshakeFinish(AsyncResult) bool })
+				return impl.HandshakeFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Shutdown(bool, bool, *Cancellable) bool
+		}); ok {
+			iface.OverrideShutdown(func(self DtlsConnection, arg0 bool, arg1 bool, arg2 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Shutdown(bool, bool, *Cancellable) bool
+				})
+				return impl.Shutdown(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ShutdownAsync(bool, bool, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideShutdownAsync(func(self DtlsConnection, arg0 bool, arg1 bool, arg2 int, arg3 *Cancellable, arg4 *AsyncReadyCallback, arg5 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ShutdownAsync(bool, bool, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.ShutdownAsync(arg0, arg1, arg2, arg3, arg4, arg5)
+			})
+		}
+		if _, ok := any(zero).(interface{ ShutdownFinish(AsyncResult) bool }); ok {
+			iface.OverrideShutdownFinish(func(self DtlsConnection, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ShutdownFinish(AsyncResult) bool })
+				return impl.ShutdownFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetAdvertisedProtocols([]string) }); ok {
+			iface.OverrideSetAdvertisedProtocols(func(self DtlsConnection, arg0 []string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetAdvertisedProtocols([]string) })
+				impl.SetAdvertisedProtocols(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetNegotiatedProtocol() string }); ok {
+			iface.OverrideGetNegotiatedProtocol(func(self DtlsConnection) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetNegotiatedProtocol() string })
+				return impl.GetNegotiatedProtocol()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetBindingData(TlsChannelBindingType, []byte) bool
+		}); ok {
+			iface.OverrideGetBindingData(func(self DtlsConnection, arg0 TlsChannelBindingType, arg1 []byte) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetBindingData(TlsChannelBindingType, []byte) bool
+				})
+				return impl.GetBindingData(arg0, arg1)
+			})
+		}
+	})
+}
+
 // Close the DTLS connection. This is equivalent to calling
-// g_dtls_connection_shutdown() to shut down both sides of the connection.
+// [DtlsConnection.Shutdown] to shut down both sides of the connection.
 //
-// Closing a #GDtlsConnection waits for all buffered but untransmitted data to
+// Closing a [DtlsConnection] waits for all buffered but untransmitted data to
 // be sent before it completes. It then sends a `close_notify` DTLS alert to the
 // peer and may wait for a `close_notify` to be received from the peer. It does
-// not close the underlying #GDtlsConnection:base-socket; that must be closed
+// not close the underlying GDtlsConnection:base-socket; that must be closed
 // separately.
 //
-// Once @conn is closed, all other operations will return %G_IO_ERROR_CLOSED.
-// Closing a #GDtlsConnection multiple times will not return an error.
+// Once conn is closed, all other operations will return [GIoErrorClosedValue].
+// Closing a [DtlsConnection] multiple times will not return an error.
 //
-// #GDtlsConnections will be automatically closed when the last reference is
+// GDtlsConnections will be automatically closed when the last reference is
 // dropped, but you might want to call this function to make sure resources are
 // released as early as possible.
 //
-// If @cancellable is cancelled, the #GDtlsConnection may be left
+// If cancellable is cancelled, the [DtlsConnection] may be left
 // partially-closed and any pending untransmitted data may be lost. Call
-// g_dtls_connection_close() again to complete closing the #GDtlsConnection.
+// [DtlsConnection.Close] again to complete closing the [DtlsConnection].
 func (x *DtlsConnectionBase) Close(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionClose(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously close the DTLS connection. See g_dtls_connection_close() for
+// Asynchronously close the DTLS connection. See [DtlsConnection.Close] for
 // more information.
 func (x *DtlsConnectionBase) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDtlsConnectionCloseAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDtlsConnectionCloseAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finish an asynchronous TLS close operation. See g_dtls_connection_close()
+// Finish an asynchronous TLS close operation. See [DtlsConnection.Close]
 // for more information.
 func (x *DtlsConnectionBase) CloseFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionCloseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Used by #GDtlsConnection implementations to emit the
-// #GDtlsConnection::accept-certificate signal.
+// Used by [DtlsConnection] implementations to emit the
+// GDtlsConnection::accept-certificate signal.
 func (x *DtlsConnectionBase) EmitAcceptCertificate(PeerCertVar *TlsCertificate, ErrorsVar TlsCertificateFlags) bool {
 
 	cret := XGDtlsConnectionEmitAcceptCertificate(x.GoPointer(), PeerCertVar.GoPointer(), ErrorsVar)
 	return cret
 }
 
-// Gets @conn's certificate, as set by
-// g_dtls_connection_set_certificate().
+// Gets conn's certificate, as set by
+// [DtlsConnection.SetCertificate].
 func (x *DtlsConnectionBase) GetCertificate() *TlsCertificate {
 	var cls *TlsCertificate
 
@@ -442,31 +570,34 @@ func (x *DtlsConnectionBase) GetCertificate() *TlsCertificate {
 	return cls
 }
 
-// Query the TLS backend for TLS channel binding data of @type for @conn.
+// Query the TLS backend for TLS channel binding data of type for conn.
 //
 // This call retrieves TLS channel binding data as specified in RFC
-// [5056](https://tools.ietf.org/html/rfc5056), RFC
-// [5929](https://tools.ietf.org/html/rfc5929), and related RFCs.  The
-// binding data is returned in @data.  The @data is resized by the callee
-// using #GByteArray buffer management and will be freed when the @data
-// is destroyed by g_byte_array_unref(). If @data is %NULL, it will only
-// check whether TLS backend is able to fetch the data (e.g. whether @type
+// [5056], RFC
+// [5929], and related RFCs.  The
+// binding data is returned in data.  The data is resized by the callee
+// using [glib.ByteArray] buffer management and will be freed when the data
+// is destroyed by [glib.ByteArrayUnref]. If data is nil, it will only
+// check whether TLS backend is able to fetch the data (e.g. whether type
 // is supported by the TLS backend). It does not guarantee that the data
 // will be available though.  That could happen if TLS connection does not
-// support @type or the binding data is not available yet due to additional
+// support type or the binding data is not available yet due to additional
 // negotiation or input required.
+//
+// [5056]: https://tools.ietf.org/html/rfc5056
+// [5929]: https://tools.ietf.org/html/rfc5929
 func (x *DtlsConnectionBase) GetChannelBindingData(TypeVar TlsChannelBindingType, DataVar *[]byte) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionGetChannelBindingData(x.GoPointer(), TypeVar, DataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Returns the name of the current DTLS ciphersuite, or %NULL if the
+// Returns the name of the current DTLS ciphersuite, or nil if the
 // connection has not handshaked or has been closed. Beware that the TLS
 // backend may use any of multiple different naming conventions, because
 // OpenSSL and GnuTLS have their own ciphersuite naming conventions that
@@ -480,8 +611,8 @@ func (x *DtlsConnectionBase) GetCiphersuiteName() string {
 	return cret
 }
 
-// Gets the certificate database that @conn uses to verify
-// peer certificates. See g_dtls_connection_set_database().
+// Gets the certificate database that conn uses to verify
+// peer certificates. See [DtlsConnection.SetDatabase].
 func (x *DtlsConnectionBase) GetDatabase() *TlsDatabase {
 	var cls *TlsDatabase
 
@@ -497,7 +628,7 @@ func (x *DtlsConnectionBase) GetDatabase() *TlsDatabase {
 }
 
 // Get the object that will be used to interact with the user. It will be used
-// for things like prompting the user for passwords. If %NULL is returned, then
+// for things like prompting the user for passwords. If nil is returned, then
 // no user interaction will occur for this connection.
 func (x *DtlsConnectionBase) GetInteraction() *TlsInteraction {
 	var cls *TlsInteraction
@@ -517,18 +648,18 @@ func (x *DtlsConnectionBase) GetInteraction() *TlsInteraction {
 // the handshake.
 //
 // If the peer did not use the ALPN extension, or did not advertise a
-// protocol that matched one of @conn's protocols, or the TLS backend
-// does not support ALPN, then this will be %NULL. See
-// g_dtls_connection_set_advertised_protocols().
+// protocol that matched one of conn's protocols, or the TLS backend
+// does not support ALPN, then this will be nil. See
+// [DtlsConnection.SetAdvertisedProtocols].
 func (x *DtlsConnectionBase) GetNegotiatedProtocol() string {
 
 	cret := XGDtlsConnectionGetNegotiatedProtocol(x.GoPointer())
 	return cret
 }
 
-// Gets @conn's peer's certificate after the handshake has completed
+// Gets conn's peer's certificate after the handshake has completed
 // or failed. (It is not set during the emission of
-// #GDtlsConnection::accept-certificate.)
+// GDtlsConnection::accept-certificate.)
 func (x *DtlsConnectionBase) GetPeerCertificate() *TlsCertificate {
 	var cls *TlsCertificate
 
@@ -543,9 +674,9 @@ func (x *DtlsConnectionBase) GetPeerCertificate() *TlsCertificate {
 	return cls
 }
 
-// Gets the errors associated with validating @conn's peer's
+// Gets the errors associated with validating conn's peer's
 // certificate, after the handshake has completed or failed. (It is
-// not set during the emission of #GDtlsConnection::accept-certificate.)
+// not set during the emission of GDtlsConnection::accept-certificate.)
 func (x *DtlsConnectionBase) GetPeerCertificateErrors() TlsCertificateFlags {
 
 	cret := XGDtlsConnectionGetPeerCertificateErrors(x.GoPointer())
@@ -553,41 +684,46 @@ func (x *DtlsConnectionBase) GetPeerCertificateErrors() TlsCertificateFlags {
 }
 
 // Returns the current DTLS protocol version, which may be
-// %G_TLS_PROTOCOL_VERSION_UNKNOWN if the connection has not handshaked, or
+// [GTlsProtocolVersionUnknownValue] if the connection has not handshaked, or
 // has been closed, or if the TLS backend has implemented a protocol version
-// that is not a recognized #GTlsProtocolVersion.
+// that is not a recognized [TlsProtocolVersion].
 func (x *DtlsConnectionBase) GetProtocolVersion() TlsProtocolVersion {
 
 	cret := XGDtlsConnectionGetProtocolVersion(x.GoPointer())
 	return cret
 }
 
-// Gets @conn rehandshaking mode. See
-// g_dtls_connection_set_rehandshake_mode() for details.
+// Gets conn rehandshaking mode. See
+// [DtlsConnection.SetRehandshakeMode] for details.
+//
+// Deprecated: since 2.64.. Changing the rehandshake mode is no longer
+//
+//	required for compatibility. Also, rehandshaking has been removed
+//	from the TLS protocol in TLS 1.3.
 func (x *DtlsConnectionBase) GetRehandshakeMode() TlsRehandshakeMode {
 
 	cret := XGDtlsConnectionGetRehandshakeMode(x.GoPointer())
 	return cret
 }
 
-// Tests whether or not @conn expects a proper TLS close notification
+// Tests whether or not conn expects a proper TLS close notification
 // when the connection is closed. See
-// g_dtls_connection_set_require_close_notify() for details.
+// [DtlsConnection.SetRequireCloseNotify] for details.
 func (x *DtlsConnectionBase) GetRequireCloseNotify() bool {
 
 	cret := XGDtlsConnectionGetRequireCloseNotify(x.GoPointer())
 	return cret
 }
 
-// Attempts a TLS handshake on @conn.
+// Attempts a TLS handshake on conn.
 //
 // On the client side, it is never necessary to call this method;
 // although the connection needs to perform a handshake after
-// connecting, #GDtlsConnection will handle this for you automatically
+// connecting, [DtlsConnection] will handle this for you automatically
 // when you try to send or receive data on the connection. You can call
-// g_dtls_connection_handshake() manually if you want to know whether
+// [DtlsConnection.Handshake] manually if you want to know whether
 // the initial handshake succeeded or failed (as opposed to just
-// immediately trying to use @conn to read or write, in which case,
+// immediately trying to use conn to read or write, in which case,
 // if it fails, it may not be possible to tell if it failed before
 // or after completing the handshake), but beware that servers may reject
 // client authentication after the handshake has completed, so a
@@ -597,41 +733,57 @@ func (x *DtlsConnectionBase) GetRequireCloseNotify() bool {
 // the beginning of the communication, you do not need to call this
 // function explicitly unless you want clearer error reporting.
 //
-// Previously, calling g_dtls_connection_handshake() after the initial
+// Previously, calling [DtlsConnection.Handshake] after the initial
 // handshake would trigger a rehandshake; however, this usage was
 // deprecated in GLib 2.60 because rehandshaking was removed from the
 // TLS protocol in TLS 1.3. Since GLib 2.64, calling this function after
 // the initial handshake will no longer do anything.
 //
-// #GDtlsConnection::accept_certificate may be emitted during the
+// GDtlsConnection::accept_certificate may be emitted during the
 // handshake.
 func (x *DtlsConnectionBase) Handshake(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionHandshake(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously performs a TLS handshake on @conn. See
-// g_dtls_connection_handshake() for more information.
+// Asynchronously performs a TLS handshake on conn. See
+// [DtlsConnection.Handshake] for more information.
 func (x *DtlsConnectionBase) HandshakeAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDtlsConnectionHandshakeAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDtlsConnectionHandshakeAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finish an asynchronous TLS handshake operation. See
-// g_dtls_connection_handshake() for more information.
+// [DtlsConnection.Handshake] for more information.
 func (x *DtlsConnectionBase) HandshakeFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionHandshakeFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -641,36 +793,38 @@ func (x *DtlsConnectionBase) HandshakeFinish(ResultVar AsyncResult) (bool, error
 // caller is willing to speak on this connection. The
 // Application-Layer Protocol Negotiation (ALPN) extension will be
 // used to negotiate a compatible protocol with the peer; use
-// g_dtls_connection_get_negotiated_protocol() to find the negotiated
-// protocol after the handshake.  Specifying %NULL for the the value
-// of @protocols will disable ALPN negotiation.
+// [DtlsConnection.GetNegotiatedProtocol] to find the negotiated
+// protocol after the handshake.  Specifying nil for the the value
+// of protocols will disable ALPN negotiation.
 //
-// See [IANA TLS ALPN Protocol IDs](https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids)
+// See [IANA TLS ALPN Protocol IDs]
 // for a list of registered protocol IDs.
+//
+// [IANA TLS ALPN Protocol IDs]: https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids
 func (x *DtlsConnectionBase) SetAdvertisedProtocols(ProtocolsVar []string) {
 
 	XGDtlsConnectionSetAdvertisedProtocols(x.GoPointer(), ProtocolsVar)
 
 }
 
-// This sets the certificate that @conn will present to its peer
-// during the TLS handshake. For a #GDtlsServerConnection, it is
+// This sets the certificate that conn will present to its peer
+// during the TLS handshake. For a [DtlsServerConnection], it is
 // mandatory to set this, and that will normally be done at construct
 // time.
 //
-// For a #GDtlsClientConnection, this is optional. If a handshake fails
-// with %G_TLS_ERROR_CERTIFICATE_REQUIRED, that means that the server
+// For a [DtlsClientConnection], this is optional. If a handshake fails
+// with [GTlsErrorCertificateRequiredValue], that means that the server
 // requires a certificate, and if you try connecting again, you should
 // call this method first. You can call
-// g_dtls_client_connection_get_accepted_cas() on the failed connection
+// [DtlsClientConnection.GetAcceptedCas] on the failed connection
 // to get a list of Certificate Authorities that the server will
 // accept certificates from.
 //
 // (It is also possible that a server will allow the connection with
 // or without a certificate; in that case, if you don't provide a
 // certificate, you can tell that the server requested one by the fact
-// that g_dtls_client_connection_get_accepted_cas() will return
-// non-%NULL.)
+// that [DtlsClientConnection.GetAcceptedCas] will return
+// non-nil.)
 func (x *DtlsConnectionBase) SetCertificate(CertificateVar *TlsCertificate) {
 
 	XGDtlsConnectionSetCertificate(x.GoPointer(), CertificateVar.GoPointer())
@@ -679,15 +833,15 @@ func (x *DtlsConnectionBase) SetCertificate(CertificateVar *TlsCertificate) {
 
 // Sets the certificate database that is used to verify peer certificates.
 // This is set to the default database by default. See
-// g_tls_backend_get_default_database(). If set to %NULL, then
+// [TlsBackend.GetDefaultDatabase]. If set to nil, then
 // peer certificate validation will always set the
-// %G_TLS_CERTIFICATE_UNKNOWN_CA error (meaning
-// #GDtlsConnection::accept-certificate will always be emitted on
+// [GTlsCertificateUnknownCaValue] error (meaning
+// GDtlsConnection::accept-certificate will always be emitted on
 // client-side connections, unless that bit is not set in
-// #GDtlsClientConnection:validation-flags).
+// GDtlsClientConnection:validation-flags).
 //
 // There are nonintuitive security implications when using a non-default
-// database. See #GDtlsConnection:database for details.
+// database. See GDtlsConnection:database for details.
 func (x *DtlsConnectionBase) SetDatabase(DatabaseVar *TlsDatabase) {
 
 	XGDtlsConnectionSetDatabase(x.GoPointer(), DatabaseVar.GoPointer())
@@ -697,8 +851,8 @@ func (x *DtlsConnectionBase) SetDatabase(DatabaseVar *TlsDatabase) {
 // Set the object that will be used to interact with the user. It will be used
 // for things like prompting the user for passwords.
 //
-// The @interaction argument will normally be a derived subclass of
-// #GTlsInteraction. %NULL can also be provided if no user interaction
+// The interaction argument will normally be a derived subclass of
+// [TlsInteraction]. nil can also be provided if no user interaction
 // should occur for this connection.
 func (x *DtlsConnectionBase) SetInteraction(InteractionVar *TlsInteraction) {
 
@@ -710,17 +864,22 @@ func (x *DtlsConnectionBase) SetInteraction(InteractionVar *TlsInteraction) {
 // and will have no effect. With TLS 1.3, rehandshaking has been removed from
 // the TLS protocol, replaced by separate post-handshake authentication and
 // rekey operations.
+//
+// Deprecated: since 2.60.. Changing the rehandshake mode is no longer
+//
+//	required for compatibility. Also, rehandshaking has been removed
+//	from the TLS protocol in TLS 1.3.
 func (x *DtlsConnectionBase) SetRehandshakeMode(ModeVar TlsRehandshakeMode) {
 
 	XGDtlsConnectionSetRehandshakeMode(x.GoPointer(), ModeVar)
 
 }
 
-// Sets whether or not @conn expects a proper TLS close notification
-// before the connection is closed. If this is %TRUE (the default),
-// then @conn will expect to receive a TLS close notification from its
+// Sets whether or not conn expects a proper TLS close notification
+// before the connection is closed. If this is true (the default),
+// then conn will expect to receive a TLS close notification from its
 // peer before the connection is closed, and will return a
-// %G_TLS_ERROR_EOF error if the connection is closed without proper
+// [GTlsErrorEofValue] error if the connection is closed without proper
 // notification (since this may indicate a network error, or
 // man-in-the-middle attack).
 //
@@ -729,18 +888,18 @@ func (x *DtlsConnectionBase) SetRehandshakeMode(ModeVar TlsRehandshakeMode) {
 // (because the application-level data includes a length field, or is
 // somehow self-delimiting); in this case, the close notify is
 // redundant and may be omitted. You
-// can use g_dtls_connection_set_require_close_notify() to tell @conn
+// can use [DtlsConnection.SetRequireCloseNotify] to tell conn
 // to allow an "unannounced" connection close, in which case the close
 // will show up as a 0-length read, as in a non-TLS
-// #GDatagramBased, and it is up to the application to check that
+// [DatagramBased], and it is up to the application to check that
 // the data has been fully received.
 //
 // Note that this only affects the behavior when the peer closes the
-// connection; when the application calls g_dtls_connection_close_async() on
-// @conn itself, this will send a close notification regardless of the
+// connection; when the application calls [DtlsConnection.CloseAsync] on
+// conn itself, this will send a close notification regardless of the
 // setting of this property. If you explicitly want to do an unclean
-// close, you can close @conn's #GDtlsConnection:base-socket rather
-// than closing @conn itself.
+// close, you can close conn's GDtlsConnection:base-socket rather
+// than closing conn itself.
 func (x *DtlsConnectionBase) SetRequireCloseNotify(RequireCloseNotifyVar bool) {
 
 	XGDtlsConnectionSetRequireCloseNotify(x.GoPointer(), RequireCloseNotifyVar)
@@ -749,47 +908,63 @@ func (x *DtlsConnectionBase) SetRequireCloseNotify(RequireCloseNotifyVar bool) {
 
 // Shut down part or all of a DTLS connection.
 //
-// If @shutdown_read is %TRUE then the receiving side of the connection is shut
+// If shutdown_read is true then the receiving side of the connection is shut
 // down, and further reading is disallowed. Subsequent calls to
-// g_datagram_based_receive_messages() will return %G_IO_ERROR_CLOSED.
+// [DatagramBased.ReceiveMessages] will return [GIoErrorClosedValue].
 //
-// If @shutdown_write is %TRUE then the sending side of the connection is shut
+// If shutdown_write is true then the sending side of the connection is shut
 // down, and further writing is disallowed. Subsequent calls to
-// g_datagram_based_send_messages() will return %G_IO_ERROR_CLOSED.
+// [DatagramBased.SendMessages] will return [GIoErrorClosedValue].
 //
-// It is allowed for both @shutdown_read and @shutdown_write to be TRUE — this
-// is equivalent to calling g_dtls_connection_close().
+// It is allowed for both shutdown_read and shutdown_write to be TRUE — this
+// is equivalent to calling [DtlsConnection.Close].
 //
-// If @cancellable is cancelled, the #GDtlsConnection may be left
+// If cancellable is cancelled, the [DtlsConnection] may be left
 // partially-closed and any pending untransmitted data may be lost. Call
-// g_dtls_connection_shutdown() again to complete closing the #GDtlsConnection.
+// [DtlsConnection.Shutdown] again to complete closing the [DtlsConnection].
 func (x *DtlsConnectionBase) Shutdown(ShutdownReadVar bool, ShutdownWriteVar bool, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionShutdown(x.GoPointer(), ShutdownReadVar, ShutdownWriteVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Asynchronously shut down part or all of the DTLS connection. See
-// g_dtls_connection_shutdown() for more information.
+// [DtlsConnection.Shutdown] for more information.
 func (x *DtlsConnectionBase) ShutdownAsync(ShutdownReadVar bool, ShutdownWriteVar bool, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGDtlsConnectionShutdownAsync(x.GoPointer(), ShutdownReadVar, ShutdownWriteVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGDtlsConnectionShutdownAsync(x.GoPointer(), ShutdownReadVar, ShutdownWriteVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finish an asynchronous TLS shutdown operation. See
-// g_dtls_connection_shutdown() for more information.
+// [DtlsConnection.Shutdown] for more information.
 func (x *DtlsConnectionBase) ShutdownFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGDtlsConnectionShutdownFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -798,7 +973,7 @@ func (x *DtlsConnectionBase) ShutdownFinish(ResultVar AsyncResult) (bool, error)
 // SetPropertyAdvertisedProtocols sets the "advertised-protocols" property.
 // The list of application-layer protocols that the connection
 // advertises that it is willing to speak. See
-// g_dtls_connection_set_advertised_protocols().
+// [DtlsConnection.SetAdvertisedProtocols].
 func (x *DtlsConnectionBase) SetPropertyAdvertisedProtocols(value []string) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -811,7 +986,7 @@ func (x *DtlsConnectionBase) SetPropertyAdvertisedProtocols(value []string) {
 // GetPropertyAdvertisedProtocols gets the "advertised-protocols" property.
 // The list of application-layer protocols that the connection
 // advertises that it is willing to speak. See
-// g_dtls_connection_set_advertised_protocols().
+// [DtlsConnection.SetAdvertisedProtocols].
 func (x *DtlsConnectionBase) GetPropertyAdvertisedProtocols() []string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -821,7 +996,7 @@ func (x *DtlsConnectionBase) GetPropertyAdvertisedProtocols() []string {
 }
 
 // GetPropertyCiphersuiteName gets the "ciphersuite-name" property.
-// The name of the DTLS ciphersuite in use. See g_dtls_connection_get_ciphersuite_name().
+// The name of the DTLS ciphersuite in use. See [DtlsConnection.GetCiphersuiteName].
 func (x *DtlsConnectionBase) GetPropertyCiphersuiteName() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -832,7 +1007,7 @@ func (x *DtlsConnectionBase) GetPropertyCiphersuiteName() string {
 
 // GetPropertyNegotiatedProtocol gets the "negotiated-protocol" property.
 // The application-layer protocol negotiated during the TLS
-// handshake. See g_dtls_connection_get_negotiated_protocol().
+// handshake. See [DtlsConnection.GetNegotiatedProtocol].
 func (x *DtlsConnectionBase) GetPropertyNegotiatedProtocol() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -843,7 +1018,7 @@ func (x *DtlsConnectionBase) GetPropertyNegotiatedProtocol() string {
 
 // SetPropertyRequireCloseNotify sets the "require-close-notify" property.
 // Whether or not proper TLS close notification is required.
-// See g_dtls_connection_set_require_close_notify().
+// See [DtlsConnection.SetRequireCloseNotify].
 func (x *DtlsConnectionBase) SetPropertyRequireCloseNotify(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -855,7 +1030,7 @@ func (x *DtlsConnectionBase) SetPropertyRequireCloseNotify(value bool) {
 
 // GetPropertyRequireCloseNotify gets the "require-close-notify" property.
 // Whether or not proper TLS close notification is required.
-// See g_dtls_connection_set_require_close_notify().
+// See [DtlsConnection.SetRequireCloseNotify].
 func (x *DtlsConnectionBase) GetPropertyRequireCloseNotify() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -897,7 +1072,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -906,32 +1081,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xDtlsConnectionGLibType, libs, "g_dtls_connection_get_type")
 
-	core.PuregoSafeRegister(&XGDtlsConnectionClose, libs, "g_dtls_connection_close")
-	core.PuregoSafeRegister(&XGDtlsConnectionCloseAsync, libs, "g_dtls_connection_close_async")
-	core.PuregoSafeRegister(&XGDtlsConnectionCloseFinish, libs, "g_dtls_connection_close_finish")
-	core.PuregoSafeRegister(&XGDtlsConnectionEmitAcceptCertificate, libs, "g_dtls_connection_emit_accept_certificate")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetCertificate, libs, "g_dtls_connection_get_certificate")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetChannelBindingData, libs, "g_dtls_connection_get_channel_binding_data")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetCiphersuiteName, libs, "g_dtls_connection_get_ciphersuite_name")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetDatabase, libs, "g_dtls_connection_get_database")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetInteraction, libs, "g_dtls_connection_get_interaction")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetNegotiatedProtocol, libs, "g_dtls_connection_get_negotiated_protocol")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetPeerCertificate, libs, "g_dtls_connection_get_peer_certificate")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetPeerCertificateErrors, libs, "g_dtls_connection_get_peer_certificate_errors")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetProtocolVersion, libs, "g_dtls_connection_get_protocol_version")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetRehandshakeMode, libs, "g_dtls_connection_get_rehandshake_mode")
-	core.PuregoSafeRegister(&XGDtlsConnectionGetRequireCloseNotify, libs, "g_dtls_connection_get_require_close_notify")
-	core.PuregoSafeRegister(&XGDtlsConnectionHandshake, libs, "g_dtls_connection_handshake")
-	core.PuregoSafeRegister(&XGDtlsConnectionHandshakeAsync, libs, "g_dtls_connection_handshake_async")
-	core.PuregoSafeRegister(&XGDtlsConnectionHandshakeFinish, libs, "g_dtls_connection_handshake_finish")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetAdvertisedProtocols, libs, "g_dtls_connection_set_advertised_protocols")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetCertificate, libs, "g_dtls_connection_set_certificate")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetDatabase, libs, "g_dtls_connection_set_database")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetInteraction, libs, "g_dtls_connection_set_interaction")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetRehandshakeMode, libs, "g_dtls_connection_set_rehandshake_mode")
-	core.PuregoSafeRegister(&XGDtlsConnectionSetRequireCloseNotify, libs, "g_dtls_connection_set_require_close_notify")
-	core.PuregoSafeRegister(&XGDtlsConnectionShutdown, libs, "g_dtls_connection_shutdown")
-	core.PuregoSafeRegister(&XGDtlsConnectionShutdownAsync, libs, "g_dtls_connection_shutdown_async")
-	core.PuregoSafeRegister(&XGDtlsConnectionShutdownFinish, libs, "g_dtls_connection_shutdown_finish")
+	gobject.CheckClassSize("GDtlsConnectionInterface", xDtlsConnectionGLibType, unsafe.Sizeof(DtlsConnectionInterface{}))
+
+	core.PuregoRegisterSince(&XGDtlsConnectionClose, libs, "g_dtls_connection_close", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionCloseAsync, libs, "g_dtls_connection_close_async", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionCloseFinish, libs, "g_dtls_connection_close_finish", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionEmitAcceptCertificate, libs, "g_dtls_connection_emit_accept_certificate", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetCertificate, libs, "g_dtls_connection_get_certificate", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetChannelBindingData, libs, "g_dtls_connection_get_channel_binding_data", "GIO", "2.66")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetCiphersuiteName, libs, "g_dtls_connection_get_ciphersuite_name", "GIO", "2.70")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetDatabase, libs, "g_dtls_connection_get_database", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetInteraction, libs, "g_dtls_connection_get_interaction", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetNegotiatedProtocol, libs, "g_dtls_connection_get_negotiated_protocol", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetPeerCertificate, libs, "g_dtls_connection_get_peer_certificate", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetPeerCertificateErrors, libs, "g_dtls_connection_get_peer_certificate_errors", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetProtocolVersion, libs, "g_dtls_connection_get_protocol_version", "GIO", "2.70")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetRehandshakeMode, libs, "g_dtls_connection_get_rehandshake_mode", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionGetRequireCloseNotify, libs, "g_dtls_connection_get_require_close_notify", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionHandshake, libs, "g_dtls_connection_handshake", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionHandshakeAsync, libs, "g_dtls_connection_handshake_async", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionHandshakeFinish, libs, "g_dtls_connection_handshake_finish", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetAdvertisedProtocols, libs, "g_dtls_connection_set_advertised_protocols", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetCertificate, libs, "g_dtls_connection_set_certificate", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetDatabase, libs, "g_dtls_connection_set_database", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetInteraction, libs, "g_dtls_connection_set_interaction", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetRehandshakeMode, libs, "g_dtls_connection_set_rehandshake_mode", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionSetRequireCloseNotify, libs, "g_dtls_connection_set_require_close_notify", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionShutdown, libs, "g_dtls_connection_shutdown", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionShutdownAsync, libs, "g_dtls_connection_shutdown_async", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGDtlsConnectionShutdownFinish, libs, "g_dtls_connection_shutdown_finish", "GIO", "2.48")
 
 }
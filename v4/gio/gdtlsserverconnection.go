@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// vtable for a #GDtlsServerConnection implementation.
+// vtable for a [DtlsServerConnection] implementation.
 type DtlsServerConnectionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 }
 
 func (x *DtlsServerConnectionInterface) GoPointer() uintptr {
@@ -23,7 +23,7 @@ func (x *DtlsServerConnectionInterface) GoPointer() uintptr {
 }
 
 // `GDtlsServerConnection` is the server-side subclass of
-// [iface@Gio.DtlsConnection], representing a server-side DTLS connection.
+// [DtlsConnection], representing a server-side DTLS connection.
 type DtlsServerConnection interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -52,7 +52,7 @@ func (x *DtlsServerConnectionBase) SetGoPointer(ptr uintptr) {
 
 var xDtlsServerConnectionNew func(uintptr, uintptr, **glib.Error) uintptr
 
-// Creates a new #GDtlsServerConnection wrapping @base_socket.
+// Creates a new [DtlsServerConnection] wrapping base_socket.
 func DtlsServerConnectionNew(BaseSocketVar DatagramBased, CertificateVar *TlsCertificate) (*DtlsServerConnectionBase, error) {
 	var cls *DtlsServerConnectionBase
 	var cerr *glib.Error
@@ -60,12 +60,15 @@ func DtlsServerConnectionNew(BaseSocketVar DatagramBased, CertificateVar *TlsCer
 	cret := xDtlsServerConnectionNew(BaseSocketVar.GoPointer(), CertificateVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &DtlsServerConnectionBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -76,15 +79,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDtlsServerConnectionNew, libs, "g_dtls_server_connection_new")
+	core.PuregoRegisterSince(&xDtlsServerConnectionNew, libs, "g_dtls_server_connection_new", "GIO", "2.48")
 
 	core.PuregoSafeRegister(&xDtlsServerConnectionGLibType, libs, "g_dtls_server_connection_get_type")
 
+	gobject.CheckClassSize("GDtlsServerConnectionInterface", xDtlsServerConnectionGLibType, unsafe.Sizeof(DtlsServerConnectionInterface{}))
+
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -20,9 +19,9 @@ func (x *EmblemClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GEmblem` is an implementation of [iface@Gio.Icon] that supports
+// `GEmblem` is an implementation of [Icon] that supports
 // having an emblem, which is an icon with additional properties.
-// It can than be added to a [class@Gio.EmblemedIcon].
+// It can than be added to a [EmblemedIcon].
 //
 // Currently, only metainformation about the emblem's origin is
 // supported. More may be added in the future.
@@ -44,7 +43,7 @@ func EmblemNewFromInternalPtr(ptr uintptr) *Emblem {
 
 var xNewEmblem func(uintptr) uintptr
 
-// Creates a new emblem for @icon.
+// Creates a new emblem for icon.
 func NewEmblem(IconVar Icon) *Emblem {
 	var cls *Emblem
 
@@ -60,7 +59,7 @@ func NewEmblem(IconVar Icon) *Emblem {
 
 var xNewEmblemWithOrigin func(uintptr, EmblemOrigin) uintptr
 
-// Creates a new emblem for @icon.
+// Creates a new emblem for icon.
 func NewEmblemWithOrigin(IconVar Icon, OriginVar EmblemOrigin) *Emblem {
 	var cls *Emblem
 
@@ -76,7 +75,7 @@ func NewEmblemWithOrigin(IconVar Icon, OriginVar EmblemOrigin) *Emblem {
 
 var xEmblemGetIcon func(uintptr) uintptr
 
-// Gives back the icon from @emblem.
+// Gives back the icon from emblem.
 func (x *Emblem) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -125,10 +124,10 @@ func (x *Emblem) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *Emblem) Serialize() *glib.Variant {
 
@@ -136,21 +135,21 @@ func (x *Emblem) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *Emblem) ToString() string {
 
@@ -163,7 +162,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -172,10 +171,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xEmblemGLibType, libs, "g_emblem_get_type")
 
-	core.PuregoSafeRegister(&xNewEmblem, libs, "g_emblem_new")
-	core.PuregoSafeRegister(&xNewEmblemWithOrigin, libs, "g_emblem_new_with_origin")
+	core.PuregoRegisterSince(&xNewEmblem, libs, "g_emblem_new", "GIO", "2.18")
+	core.PuregoRegisterSince(&xNewEmblemWithOrigin, libs, "g_emblem_new_with_origin", "GIO", "2.18")
 
-	core.PuregoSafeRegister(&xEmblemGetIcon, libs, "g_emblem_get_icon")
-	core.PuregoSafeRegister(&xEmblemGetOrigin, libs, "g_emblem_get_origin")
+	core.PuregoRegisterSince(&xEmblemGetIcon, libs, "g_emblem_get_icon", "GIO", "2.18")
+	core.PuregoRegisterSince(&xEmblemGetOrigin, libs, "g_emblem_get_origin", "GIO", "2.18")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -30,12 +29,12 @@ func (x *EmblemedIconPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GEmblemedIcon` is an implementation of [iface@Gio.Icon] that supports
+// `GEmblemedIcon` is an implementation of [Icon] that supports
 // adding an emblem to an icon. Adding multiple emblems to an
-// icon is ensured via [method@Gio.EmblemedIcon.add_emblem].
+// icon is ensured via [EmblemedIcon.AddEmblem].
 //
 // Note that `GEmblemedIcon` allows no control over the position
-// of the emblems. See also [class@Gio.Emblem] for more information.
+// of the emblems. See also [Emblem] for more information.
 type EmblemedIcon struct {
 	gobject.Object
 }
@@ -54,7 +53,7 @@ func EmblemedIconNewFromInternalPtr(ptr uintptr) *EmblemedIcon {
 
 var xNewEmblemedIcon func(uintptr, uintptr) uintptr
 
-// Creates a new emblemed icon for @icon with the emblem @emblem.
+// Creates a new emblemed icon for icon with the emblem emblem.
 func NewEmblemedIcon(IconVar Icon, EmblemVar *Emblem) *EmblemedIcon {
 	var cls *EmblemedIcon
 
@@ -70,7 +69,7 @@ func NewEmblemedIcon(IconVar Icon, EmblemVar *Emblem) *EmblemedIcon {
 
 var xEmblemedIconAddEmblem func(uintptr, uintptr)
 
-// Adds @emblem to the #GList of #GEmblems.
+// Adds emblem to the [glib.List] of GEmblems.
 func (x *EmblemedIcon) AddEmblem(EmblemVar *Emblem) {
 
 	xEmblemedIconAddEmblem(x.GoPointer(), EmblemVar.GoPointer())
@@ -79,7 +78,7 @@ func (x *EmblemedIcon) AddEmblem(EmblemVar *Emblem) {
 
 var xEmblemedIconClearEmblems func(uintptr)
 
-// Removes all the emblems from @icon.
+// Removes all the emblems from icon.
 func (x *EmblemedIcon) ClearEmblems() {
 
 	xEmblemedIconClearEmblems(x.GoPointer())
@@ -88,7 +87,7 @@ func (x *EmblemedIcon) ClearEmblems() {
 
 var xEmblemedIconGetEmblems func(uintptr) *glib.List
 
-// Gets the list of emblems for the @icon.
+// Gets the list of emblems for the icon.
 func (x *EmblemedIcon) GetEmblems() *glib.List {
 
 	cret := xEmblemedIconGetEmblems(x.GoPointer())
@@ -97,7 +96,7 @@ func (x *EmblemedIcon) GetEmblems() *glib.List {
 
 var xEmblemedIconGetIcon func(uintptr) uintptr
 
-// Gets the main icon for @emblemed.
+// Gets the main icon for emblemed.
 func (x *EmblemedIcon) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -137,10 +136,10 @@ func (x *EmblemedIcon) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *EmblemedIcon) Serialize() *glib.Variant {
 
@@ -148,21 +147,21 @@ func (x *EmblemedIcon) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *EmblemedIcon) ToString() string {
 
@@ -175,7 +174,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -184,11 +183,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xEmblemedIconGLibType, libs, "g_emblemed_icon_get_type")
 
-	core.PuregoSafeRegister(&xNewEmblemedIcon, libs, "g_emblemed_icon_new")
+	gobject.CheckClassSize("GEmblemedIconClass", xEmblemedIconGLibType, unsafe.Sizeof(EmblemedIconClass{}))
 
-	core.PuregoSafeRegister(&xEmblemedIconAddEmblem, libs, "g_emblemed_icon_add_emblem")
-	core.PuregoSafeRegister(&xEmblemedIconClearEmblems, libs, "g_emblemed_icon_clear_emblems")
-	core.PuregoSafeRegister(&xEmblemedIconGetEmblems, libs, "g_emblemed_icon_get_emblems")
-	core.PuregoSafeRegister(&xEmblemedIconGetIcon, libs, "g_emblemed_icon_get_icon")
+	core.PuregoRegisterSince(&xNewEmblemedIcon, libs, "g_emblemed_icon_new", "GIO", "2.18")
+
+	core.PuregoRegisterSince(&xEmblemedIconAddEmblem, libs, "g_emblemed_icon_add_emblem", "GIO", "2.18")
+	core.PuregoRegisterSince(&xEmblemedIconClearEmblems, libs, "g_emblemed_icon_clear_emblems", "GIO", "2.28")
+	core.PuregoRegisterSince(&xEmblemedIconGetEmblems, libs, "g_emblemed_icon_get_emblems", "GIO", "2.18")
+	core.PuregoRegisterSince(&xEmblemedIconGetIcon, libs, "g_emblemed_icon_get_icon", "GIO", "2.18")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,7 +15,7 @@ import (
 type FileIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xDup uintptr
 
@@ -232,12 +231,13 @@ func (x *FileIface) GoPointer() uintptr {
 }
 
 // OverrideDup sets the "dup" callback function.
-// Duplicates a #GFile.
+// Duplicates a [File].
 func (x *FileIface) OverrideDup(cb func(File) *FileBase) {
 	if cb == nil {
 		x.xDup = 0
 	} else {
-		x.xDup = purego.NewCallback(func(FileVarp uintptr) uintptr {
+		x.xDup = core.NewCallback(func(FileVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp})
 			if ret == nil {
 				return 0
@@ -248,13 +248,13 @@ func (x *FileIface) OverrideDup(cb func(File) *FileBase) {
 }
 
 // GetDup gets the "dup" callback function.
-// Duplicates a #GFile.
+// Duplicates a [File].
 func (x *FileIface) GetDup() func(File) *FileBase {
 	if x.xDup == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDup)
+	core.RegisterFunc(&rawCallback, x.xDup)
 	return func(FileVar File) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer())
 		if rawRet == 0 {
@@ -267,50 +267,52 @@ func (x *FileIface) GetDup() func(File) *FileBase {
 }
 
 // OverrideHash sets the "hash" callback function.
-// Creates a hash of a #GFile.
+// Creates a hash of a [File].
 func (x *FileIface) OverrideHash(cb func(File) uint) {
 	if cb == nil {
 		x.xHash = 0
 	} else {
-		x.xHash = purego.NewCallback(func(FileVarp uintptr) uint {
+		x.xHash = core.NewCallback(func(FileVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetHash gets the "hash" callback function.
-// Creates a hash of a #GFile.
+// Creates a hash of a [File].
 func (x *FileIface) GetHash() func(File) uint {
 	if x.xHash == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xHash)
+	core.RegisterFunc(&rawCallback, x.xHash)
 	return func(FileVar File) uint {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideEqual sets the "equal" callback function.
-// Checks equality of two given #GFiles.
+// Checks equality of two given GFiles.
 func (x *FileIface) OverrideEqual(cb func(File, File) bool) {
 	if cb == nil {
 		x.xEqual = 0
 	} else {
-		x.xEqual = purego.NewCallback(func(File1Varp uintptr, File2Varp uintptr) bool {
+		x.xEqual = core.NewCallback(func(File1Varp uintptr, File2Varp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: File1Varp}, &FileBase{Ptr: File2Varp})
 		})
 	}
 }
 
 // GetEqual gets the "equal" callback function.
-// Checks equality of two given #GFiles.
+// Checks equality of two given GFiles.
 func (x *FileIface) GetEqual() func(File, File) bool {
 	if x.xEqual == 0 {
 		return nil
 	}
 	var rawCallback func(File1Varp uintptr, File2Varp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEqual)
+	core.RegisterFunc(&rawCallback, x.xEqual)
 	return func(File1Var File, File2Var File) bool {
 		return rawCallback(File1Var.GoPointer(), File2Var.GoPointer())
 	}
@@ -322,7 +324,8 @@ func (x *FileIface) OverrideIsNative(cb func(File) bool) {
 	if cb == nil {
 		x.xIsNative = 0
 	} else {
-		x.xIsNative = purego.NewCallback(func(FileVarp uintptr) bool {
+		x.xIsNative = core.NewCallback(func(FileVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
@@ -335,169 +338,176 @@ func (x *FileIface) GetIsNative() func(File) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsNative)
+	core.RegisterFunc(&rawCallback, x.xIsNative)
 	return func(FileVar File) bool {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideHasUriScheme sets the "has_uri_scheme" callback function.
-// Checks to see if a #GFile has a given URI scheme.
+// Checks to see if a [File] has a given URI scheme.
 func (x *FileIface) OverrideHasUriScheme(cb func(File, string) bool) {
 	if cb == nil {
 		x.xHasUriScheme = 0
 	} else {
-		x.xHasUriScheme = purego.NewCallback(func(FileVarp uintptr, UriSchemeVarp string) bool {
+		x.xHasUriScheme = core.NewCallback(func(FileVarp uintptr, UriSchemeVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, UriSchemeVarp)
 		})
 	}
 }
 
 // GetHasUriScheme gets the "has_uri_scheme" callback function.
-// Checks to see if a #GFile has a given URI scheme.
+// Checks to see if a [File] has a given URI scheme.
 func (x *FileIface) GetHasUriScheme() func(File, string) bool {
 	if x.xHasUriScheme == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, UriSchemeVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xHasUriScheme)
+	core.RegisterFunc(&rawCallback, x.xHasUriScheme)
 	return func(FileVar File, UriSchemeVar string) bool {
 		return rawCallback(FileVar.GoPointer(), UriSchemeVar)
 	}
 }
 
 // OverrideGetUriScheme sets the "get_uri_scheme" callback function.
-// Gets the URI scheme for a #GFile.
+// Gets the URI scheme for a [File].
 func (x *FileIface) OverrideGetUriScheme(cb func(File) string) {
 	if cb == nil {
 		x.xGetUriScheme = 0
 	} else {
-		x.xGetUriScheme = purego.NewCallback(func(FileVarp uintptr) string {
+		x.xGetUriScheme = core.NewCallback(func(FileVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetGetUriScheme gets the "get_uri_scheme" callback function.
-// Gets the URI scheme for a #GFile.
+// Gets the URI scheme for a [File].
 func (x *FileIface) GetGetUriScheme() func(File) string {
 	if x.xGetUriScheme == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetUriScheme)
+	core.RegisterFunc(&rawCallback, x.xGetUriScheme)
 	return func(FileVar File) string {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideGetBasename sets the "get_basename" callback function.
-// Gets the basename for a given #GFile.
+// Gets the basename for a given [File].
 func (x *FileIface) OverrideGetBasename(cb func(File) string) {
 	if cb == nil {
 		x.xGetBasename = 0
 	} else {
-		x.xGetBasename = purego.NewCallback(func(FileVarp uintptr) string {
+		x.xGetBasename = core.NewCallback(func(FileVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetGetBasename gets the "get_basename" callback function.
-// Gets the basename for a given #GFile.
+// Gets the basename for a given [File].
 func (x *FileIface) GetGetBasename() func(File) string {
 	if x.xGetBasename == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetBasename)
+	core.RegisterFunc(&rawCallback, x.xGetBasename)
 	return func(FileVar File) string {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideGetPath sets the "get_path" callback function.
-// Gets the current path within a #GFile.
+// Gets the current path within a [File].
 func (x *FileIface) OverrideGetPath(cb func(File) string) {
 	if cb == nil {
 		x.xGetPath = 0
 	} else {
-		x.xGetPath = purego.NewCallback(func(FileVarp uintptr) string {
+		x.xGetPath = core.NewCallback(func(FileVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetGetPath gets the "get_path" callback function.
-// Gets the current path within a #GFile.
+// Gets the current path within a [File].
 func (x *FileIface) GetGetPath() func(File) string {
 	if x.xGetPath == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetPath)
+	core.RegisterFunc(&rawCallback, x.xGetPath)
 	return func(FileVar File) string {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideGetUri sets the "get_uri" callback function.
-// Gets a URI for the path within a #GFile.
+// Gets a URI for the path within a [File].
 func (x *FileIface) OverrideGetUri(cb func(File) string) {
 	if cb == nil {
 		x.xGetUri = 0
 	} else {
-		x.xGetUri = purego.NewCallback(func(FileVarp uintptr) string {
+		x.xGetUri = core.NewCallback(func(FileVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetGetUri gets the "get_uri" callback function.
-// Gets a URI for the path within a #GFile.
+// Gets a URI for the path within a [File].
 func (x *FileIface) GetGetUri() func(File) string {
 	if x.xGetUri == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetUri)
+	core.RegisterFunc(&rawCallback, x.xGetUri)
 	return func(FileVar File) string {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideGetParseName sets the "get_parse_name" callback function.
-// Gets the parsed name for the #GFile.
+// Gets the parsed name for the [File].
 func (x *FileIface) OverrideGetParseName(cb func(File) string) {
 	if cb == nil {
 		x.xGetParseName = 0
 	} else {
-		x.xGetParseName = purego.NewCallback(func(FileVarp uintptr) string {
+		x.xGetParseName = core.NewCallback(func(FileVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetGetParseName gets the "get_parse_name" callback function.
-// Gets the parsed name for the #GFile.
+// Gets the parsed name for the [File].
 func (x *FileIface) GetGetParseName() func(File) string {
 	if x.xGetParseName == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetParseName)
+	core.RegisterFunc(&rawCallback, x.xGetParseName)
 	return func(FileVar File) string {
 		return rawCallback(FileVar.GoPointer())
 	}
 }
 
 // OverrideGetParent sets the "get_parent" callback function.
-// Gets the parent directory for the #GFile.
+// Gets the parent directory for the [File].
 func (x *FileIface) OverrideGetParent(cb func(File) *FileBase) {
 	if cb == nil {
 		x.xGetParent = 0
 	} else {
-		x.xGetParent = purego.NewCallback(func(FileVarp uintptr) uintptr {
+		x.xGetParent = core.NewCallback(func(FileVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp})
 			if ret == nil {
 				return 0
@@ -508,13 +518,13 @@ func (x *FileIface) OverrideGetParent(cb func(File) *FileBase) {
 }
 
 // GetGetParent gets the "get_parent" callback function.
-// Gets the parent directory for the #GFile.
+// Gets the parent directory for the [File].
 func (x *FileIface) GetGetParent() func(File) *FileBase {
 	if x.xGetParent == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetParent)
+	core.RegisterFunc(&rawCallback, x.xGetParent)
 	return func(FileVar File) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer())
 		if rawRet == 0 {
@@ -527,62 +537,65 @@ func (x *FileIface) GetGetParent() func(File) *FileBase {
 }
 
 // OverridePrefixMatches sets the "prefix_matches" callback function.
-// Checks whether a #GFile contains a specified file.
+// Checks whether a [File] contains a specified file.
 func (x *FileIface) OverridePrefixMatches(cb func(File, File) bool) {
 	if cb == nil {
 		x.xPrefixMatches = 0
 	} else {
-		x.xPrefixMatches = purego.NewCallback(func(PrefixVarp uintptr, FileVarp uintptr) bool {
+		x.xPrefixMatches = core.NewCallback(func(PrefixVarp uintptr, FileVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: PrefixVarp}, &FileBase{Ptr: FileVarp})
 		})
 	}
 }
 
 // GetPrefixMatches gets the "prefix_matches" callback function.
-// Checks whether a #GFile contains a specified file.
+// Checks whether a [File] contains a specified file.
 func (x *FileIface) GetPrefixMatches() func(File, File) bool {
 	if x.xPrefixMatches == 0 {
 		return nil
 	}
 	var rawCallback func(PrefixVarp uintptr, FileVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPrefixMatches)
+	core.RegisterFunc(&rawCallback, x.xPrefixMatches)
 	return func(PrefixVar File, FileVar File) bool {
 		return rawCallback(PrefixVar.GoPointer(), FileVar.GoPointer())
 	}
 }
 
 // OverrideGetRelativePath sets the "get_relative_path" callback function.
-// Gets the path for a #GFile relative to a given path.
+// Gets the path for a [File] relative to a given path.
 func (x *FileIface) OverrideGetRelativePath(cb func(File, File) string) {
 	if cb == nil {
 		x.xGetRelativePath = 0
 	} else {
-		x.xGetRelativePath = purego.NewCallback(func(ParentVarp uintptr, DescendantVarp uintptr) string {
+		x.xGetRelativePath = core.NewCallback(func(ParentVarp uintptr, DescendantVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: ParentVarp}, &FileBase{Ptr: DescendantVarp})
 		})
 	}
 }
 
 // GetGetRelativePath gets the "get_relative_path" callback function.
-// Gets the path for a #GFile relative to a given path.
+// Gets the path for a [File] relative to a given path.
 func (x *FileIface) GetGetRelativePath() func(File, File) string {
 	if x.xGetRelativePath == 0 {
 		return nil
 	}
 	var rawCallback func(ParentVarp uintptr, DescendantVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetRelativePath)
+	core.RegisterFunc(&rawCallback, x.xGetRelativePath)
 	return func(ParentVar File, DescendantVar File) string {
 		return rawCallback(ParentVar.GoPointer(), DescendantVar.GoPointer())
 	}
 }
 
 // OverrideResolveRelativePath sets the "resolve_relative_path" callback function.
-// Resolves a relative path for a #GFile to an absolute path.
+// Resolves a relative path for a [File] to an absolute path.
 func (x *FileIface) OverrideResolveRelativePath(cb func(File, string) *FileBase) {
 	if cb == nil {
 		x.xResolveRelativePath = 0
 	} else {
-		x.xResolveRelativePath = purego.NewCallback(func(FileVarp uintptr, RelativePathVarp string) uintptr {
+		x.xResolveRelativePath = core.NewCallback(func(FileVarp uintptr, RelativePathVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, RelativePathVarp)
 			if ret == nil {
 				return 0
@@ -593,13 +606,13 @@ func (x *FileIface) OverrideResolveRelativePath(cb func(File, string) *FileBase)
 }
 
 // GetResolveRelativePath gets the "resolve_relative_path" callback function.
-// Resolves a relative path for a #GFile to an absolute path.
+// Resolves a relative path for a [File] to an absolute path.
 func (x *FileIface) GetResolveRelativePath() func(File, string) *FileBase {
 	if x.xResolveRelativePath == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, RelativePathVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xResolveRelativePath)
+	core.RegisterFunc(&rawCallback, x.xResolveRelativePath)
 	return func(FileVar File, RelativePathVar string) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer(), RelativePathVar)
 		if rawRet == 0 {
@@ -612,12 +625,13 @@ func (x *FileIface) GetResolveRelativePath() func(File, string) *FileBase {
 }
 
 // OverrideGetChildForDisplayName sets the "get_child_for_display_name" callback function.
-// Gets the child #GFile for a given display name.
+// Gets the child [File] for a given display name.
 func (x *FileIface) OverrideGetChildForDisplayName(cb func(File, string) *FileBase) {
 	if cb == nil {
 		x.xGetChildForDisplayName = 0
 	} else {
-		x.xGetChildForDisplayName = purego.NewCallback(func(FileVarp uintptr, DisplayNameVarp string) uintptr {
+		x.xGetChildForDisplayName = core.NewCallback(func(FileVarp uintptr, DisplayNameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, DisplayNameVarp)
 			if ret == nil {
 				return 0
@@ -628,13 +642,13 @@ func (x *FileIface) OverrideGetChildForDisplayName(cb func(File, string) *FileBa
 }
 
 // GetGetChildForDisplayName gets the "get_child_for_display_name" callback function.
-// Gets the child #GFile for a given display name.
+// Gets the child [File] for a given display name.
 func (x *FileIface) GetGetChildForDisplayName() func(File, string) *FileBase {
 	if x.xGetChildForDisplayName == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, DisplayNameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetChildForDisplayName)
+	core.RegisterFunc(&rawCallback, x.xGetChildForDisplayName)
 	return func(FileVar File, DisplayNameVar string) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer(), DisplayNameVar)
 		if rawRet == 0 {
@@ -647,12 +661,13 @@ func (x *FileIface) GetGetChildForDisplayName() func(File, string) *FileBase {
 }
 
 // OverrideEnumerateChildren sets the "enumerate_children" callback function.
-// Gets a #GFileEnumerator with the children of a #GFile.
+// Gets a [FileEnumerator] with the children of a [File].
 func (x *FileIface) OverrideEnumerateChildren(cb func(File, string, FileQueryInfoFlags, *Cancellable) *FileEnumerator) {
 	if cb == nil {
 		x.xEnumerateChildren = 0
 	} else {
-		x.xEnumerateChildren = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr {
+		x.xEnumerateChildren = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, AttributesVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -663,13 +678,13 @@ func (x *FileIface) OverrideEnumerateChildren(cb func(File, string, FileQueryInf
 }
 
 // GetEnumerateChildren gets the "enumerate_children" callback function.
-// Gets a #GFileEnumerator with the children of a #GFile.
+// Gets a [FileEnumerator] with the children of a [File].
 func (x *FileIface) GetEnumerateChildren() func(File, string, FileQueryInfoFlags, *Cancellable) *FileEnumerator {
 	if x.xEnumerateChildren == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xEnumerateChildren)
+	core.RegisterFunc(&rawCallback, x.xEnumerateChildren)
 	return func(FileVar File, AttributesVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) *FileEnumerator {
 		rawRet := rawCallback(FileVar.GoPointer(), AttributesVar, FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -682,25 +697,26 @@ func (x *FileIface) GetEnumerateChildren() func(File, string, FileQueryInfoFlags
 }
 
 // OverrideEnumerateChildrenAsync sets the "enumerate_children_async" callback function.
-// Asynchronously gets a #GFileEnumerator with the children of a #GFile.
+// Asynchronously gets a [FileEnumerator] with the children of a [File].
 func (x *FileIface) OverrideEnumerateChildrenAsync(cb func(File, string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEnumerateChildrenAsync = 0
 	} else {
-		x.xEnumerateChildrenAsync = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEnumerateChildrenAsync = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, AttributesVarp, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEnumerateChildrenAsync gets the "enumerate_children_async" callback function.
-// Asynchronously gets a #GFileEnumerator with the children of a #GFile.
+// Asynchronously gets a [FileEnumerator] with the children of a [File].
 func (x *FileIface) GetEnumerateChildrenAsync() func(File, string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEnumerateChildrenAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEnumerateChildrenAsync)
+	core.RegisterFunc(&rawCallback, x.xEnumerateChildrenAsync)
 	return func(FileVar File, AttributesVar string, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -712,7 +728,8 @@ func (x *FileIface) OverrideEnumerateChildrenFinish(cb func(File, AsyncResult) *
 	if cb == nil {
 		x.xEnumerateChildrenFinish = 0
 	} else {
-		x.xEnumerateChildrenFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xEnumerateChildrenFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -729,7 +746,7 @@ func (x *FileIface) GetEnumerateChildrenFinish() func(File, AsyncResult) *FileEn
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xEnumerateChildrenFinish)
+	core.RegisterFunc(&rawCallback, x.xEnumerateChildrenFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileEnumerator {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -742,12 +759,13 @@ func (x *FileIface) GetEnumerateChildrenFinish() func(File, AsyncResult) *FileEn
 }
 
 // OverrideQueryInfo sets the "query_info" callback function.
-// Gets the #GFileInfo for a #GFile.
+// Gets the [FileInfo] for a [File].
 func (x *FileIface) OverrideQueryInfo(cb func(File, string, FileQueryInfoFlags, *Cancellable) *FileInfo) {
 	if cb == nil {
 		x.xQueryInfo = 0
 	} else {
-		x.xQueryInfo = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr {
+		x.xQueryInfo = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, AttributesVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -758,13 +776,13 @@ func (x *FileIface) OverrideQueryInfo(cb func(File, string, FileQueryInfoFlags,
 }
 
 // GetQueryInfo gets the "query_info" callback function.
-// Gets the #GFileInfo for a #GFile.
+// Gets the [FileInfo] for a [File].
 func (x *FileIface) GetQueryInfo() func(File, string, FileQueryInfoFlags, *Cancellable) *FileInfo {
 	if x.xQueryInfo == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfo)
+	core.RegisterFunc(&rawCallback, x.xQueryInfo)
 	return func(FileVar File, AttributesVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(FileVar.GoPointer(), AttributesVar, FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -777,25 +795,26 @@ func (x *FileIface) GetQueryInfo() func(File, string, FileQueryInfoFlags, *Cance
 }
 
 // OverrideQueryInfoAsync sets the "query_info_async" callback function.
-// Asynchronously gets the #GFileInfo for a #GFile.
+// Asynchronously gets the [FileInfo] for a [File].
 func (x *FileIface) OverrideQueryInfoAsync(cb func(File, string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xQueryInfoAsync = 0
 	} else {
-		x.xQueryInfoAsync = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xQueryInfoAsync = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, AttributesVarp, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetQueryInfoAsync gets the "query_info_async" callback function.
-// Asynchronously gets the #GFileInfo for a #GFile.
+// Asynchronously gets the [FileInfo] for a [File].
 func (x *FileIface) GetQueryInfoAsync() func(File, string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xQueryInfoAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
 	return func(FileVar File, AttributesVar string, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -807,7 +826,8 @@ func (x *FileIface) OverrideQueryInfoFinish(cb func(File, AsyncResult) *FileInfo
 	if cb == nil {
 		x.xQueryInfoFinish = 0
 	} else {
-		x.xQueryInfoFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xQueryInfoFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -824,7 +844,7 @@ func (x *FileIface) GetQueryInfoFinish() func(File, AsyncResult) *FileInfo {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileInfo {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -837,12 +857,13 @@ func (x *FileIface) GetQueryInfoFinish() func(File, AsyncResult) *FileInfo {
 }
 
 // OverrideQueryFilesystemInfo sets the "query_filesystem_info" callback function.
-// Gets a #GFileInfo for the file system #GFile is on.
+// Gets a [FileInfo] for the file system [File] is on.
 func (x *FileIface) OverrideQueryFilesystemInfo(cb func(File, string, *Cancellable) *FileInfo) {
 	if cb == nil {
 		x.xQueryFilesystemInfo = 0
 	} else {
-		x.xQueryFilesystemInfo = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+		x.xQueryFilesystemInfo = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, AttributesVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -853,13 +874,13 @@ func (x *FileIface) OverrideQueryFilesystemInfo(cb func(File, string, *Cancellab
 }
 
 // GetQueryFilesystemInfo gets the "query_filesystem_info" callback function.
-// Gets a #GFileInfo for the file system #GFile is on.
+// Gets a [FileInfo] for the file system [File] is on.
 func (x *FileIface) GetQueryFilesystemInfo() func(File, string, *Cancellable) *FileInfo {
 	if x.xQueryFilesystemInfo == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryFilesystemInfo)
+	core.RegisterFunc(&rawCallback, x.xQueryFilesystemInfo)
 	return func(FileVar File, AttributesVar string, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(FileVar.GoPointer(), AttributesVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -872,25 +893,26 @@ func (x *FileIface) GetQueryFilesystemInfo() func(File, string, *Cancellable) *F
 }
 
 // OverrideQueryFilesystemInfoAsync sets the "query_filesystem_info_async" callback function.
-// Asynchronously gets a #GFileInfo for the file system #GFile is on.
+// Asynchronously gets a [FileInfo] for the file system [File] is on.
 func (x *FileIface) OverrideQueryFilesystemInfoAsync(cb func(File, string, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xQueryFilesystemInfoAsync = 0
 	} else {
-		x.xQueryFilesystemInfoAsync = purego.NewCallback(func(FileVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xQueryFilesystemInfoAsync = core.NewCallback(func(FileVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, AttributesVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetQueryFilesystemInfoAsync gets the "query_filesystem_info_async" callback function.
-// Asynchronously gets a #GFileInfo for the file system #GFile is on.
+// Asynchronously gets a [FileInfo] for the file system [File] is on.
 func (x *FileIface) GetQueryFilesystemInfoAsync() func(File, string, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xQueryFilesystemInfoAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQueryFilesystemInfoAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryFilesystemInfoAsync)
 	return func(FileVar File, AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -902,7 +924,8 @@ func (x *FileIface) OverrideQueryFilesystemInfoFinish(cb func(File, AsyncResult)
 	if cb == nil {
 		x.xQueryFilesystemInfoFinish = 0
 	} else {
-		x.xQueryFilesystemInfoFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xQueryFilesystemInfoFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -919,7 +942,7 @@ func (x *FileIface) GetQueryFilesystemInfoFinish() func(File, AsyncResult) *File
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryFilesystemInfoFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryFilesystemInfoFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileInfo {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -932,12 +955,13 @@ func (x *FileIface) GetQueryFilesystemInfoFinish() func(File, AsyncResult) *File
 }
 
 // OverrideFindEnclosingMount sets the "find_enclosing_mount" callback function.
-// Gets a #GMount for the #GFile.
+// Gets a [Mount] for the [File].
 func (x *FileIface) OverrideFindEnclosingMount(cb func(File, *Cancellable) *MountBase) {
 	if cb == nil {
 		x.xFindEnclosingMount = 0
 	} else {
-		x.xFindEnclosingMount = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xFindEnclosingMount = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -948,13 +972,13 @@ func (x *FileIface) OverrideFindEnclosingMount(cb func(File, *Cancellable) *Moun
 }
 
 // GetFindEnclosingMount gets the "find_enclosing_mount" callback function.
-// Gets a #GMount for the #GFile.
+// Gets a [Mount] for the [File].
 func (x *FileIface) GetFindEnclosingMount() func(File, *Cancellable) *MountBase {
 	if x.xFindEnclosingMount == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xFindEnclosingMount)
+	core.RegisterFunc(&rawCallback, x.xFindEnclosingMount)
 	return func(FileVar File, CancellableVar *Cancellable) *MountBase {
 		rawRet := rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -967,25 +991,26 @@ func (x *FileIface) GetFindEnclosingMount() func(File, *Cancellable) *MountBase
 }
 
 // OverrideFindEnclosingMountAsync sets the "find_enclosing_mount_async" callback function.
-// Asynchronously gets the #GMount for a #GFile.
+// Asynchronously gets the [Mount] for a [File].
 func (x *FileIface) OverrideFindEnclosingMountAsync(cb func(File, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xFindEnclosingMountAsync = 0
 	} else {
-		x.xFindEnclosingMountAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xFindEnclosingMountAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetFindEnclosingMountAsync gets the "find_enclosing_mount_async" callback function.
-// Asynchronously gets the #GMount for a #GFile.
+// Asynchronously gets the [Mount] for a [File].
 func (x *FileIface) GetFindEnclosingMountAsync() func(File, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xFindEnclosingMountAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFindEnclosingMountAsync)
+	core.RegisterFunc(&rawCallback, x.xFindEnclosingMountAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -997,7 +1022,8 @@ func (x *FileIface) OverrideFindEnclosingMountFinish(cb func(File, AsyncResult)
 	if cb == nil {
 		x.xFindEnclosingMountFinish = 0
 	} else {
-		x.xFindEnclosingMountFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xFindEnclosingMountFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1014,7 +1040,7 @@ func (x *FileIface) GetFindEnclosingMountFinish() func(File, AsyncResult) *Mount
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xFindEnclosingMountFinish)
+	core.RegisterFunc(&rawCallback, x.xFindEnclosingMountFinish)
 	return func(FileVar File, ResVar AsyncResult) *MountBase {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1027,12 +1053,13 @@ func (x *FileIface) GetFindEnclosingMountFinish() func(File, AsyncResult) *Mount
 }
 
 // OverrideSetDisplayName sets the "set_display_name" callback function.
-// Sets the display name for a #GFile.
+// Sets the display name for a [File].
 func (x *FileIface) OverrideSetDisplayName(cb func(File, string, *Cancellable) *FileBase) {
 	if cb == nil {
 		x.xSetDisplayName = 0
 	} else {
-		x.xSetDisplayName = purego.NewCallback(func(FileVarp uintptr, DisplayNameVarp string, CancellableVarp uintptr) uintptr {
+		x.xSetDisplayName = core.NewCallback(func(FileVarp uintptr, DisplayNameVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, DisplayNameVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -1043,13 +1070,13 @@ func (x *FileIface) OverrideSetDisplayName(cb func(File, string, *Cancellable) *
 }
 
 // GetSetDisplayName gets the "set_display_name" callback function.
-// Sets the display name for a #GFile.
+// Sets the display name for a [File].
 func (x *FileIface) GetSetDisplayName() func(File, string, *Cancellable) *FileBase {
 	if x.xSetDisplayName == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, DisplayNameVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xSetDisplayName)
+	core.RegisterFunc(&rawCallback, x.xSetDisplayName)
 	return func(FileVar File, DisplayNameVar string, CancellableVar *Cancellable) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer(), DisplayNameVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -1062,37 +1089,39 @@ func (x *FileIface) GetSetDisplayName() func(File, string, *Cancellable) *FileBa
 }
 
 // OverrideSetDisplayNameAsync sets the "set_display_name_async" callback function.
-// Asynchronously sets a #GFile's display name.
+// Asynchronously sets a [File]'s display name.
 func (x *FileIface) OverrideSetDisplayNameAsync(cb func(File, string, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xSetDisplayNameAsync = 0
 	} else {
-		x.xSetDisplayNameAsync = purego.NewCallback(func(FileVarp uintptr, DisplayNameVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xSetDisplayNameAsync = core.NewCallback(func(FileVarp uintptr, DisplayNameVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, DisplayNameVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetSetDisplayNameAsync gets the "set_display_name_async" callback function.
-// Asynchronously sets a #GFile's display name.
+// Asynchronously sets a [File]'s display name.
 func (x *FileIface) GetSetDisplayNameAsync() func(File, string, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xSetDisplayNameAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, DisplayNameVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetDisplayNameAsync)
+	core.RegisterFunc(&rawCallback, x.xSetDisplayNameAsync)
 	return func(FileVar File, DisplayNameVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), DisplayNameVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideSetDisplayNameFinish sets the "set_display_name_finish" callback function.
-// Finishes asynchronously setting a #GFile's display name.
+// Finishes asynchronously setting a [File]'s display name.
 func (x *FileIface) OverrideSetDisplayNameFinish(cb func(File, AsyncResult) *FileBase) {
 	if cb == nil {
 		x.xSetDisplayNameFinish = 0
 	} else {
-		x.xSetDisplayNameFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xSetDisplayNameFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1103,13 +1132,13 @@ func (x *FileIface) OverrideSetDisplayNameFinish(cb func(File, AsyncResult) *Fil
 }
 
 // GetSetDisplayNameFinish gets the "set_display_name_finish" callback function.
-// Finishes asynchronously setting a #GFile's display name.
+// Finishes asynchronously setting a [File]'s display name.
 func (x *FileIface) GetSetDisplayNameFinish() func(File, AsyncResult) *FileBase {
 	if x.xSetDisplayNameFinish == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xSetDisplayNameFinish)
+	core.RegisterFunc(&rawCallback, x.xSetDisplayNameFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1122,50 +1151,52 @@ func (x *FileIface) GetSetDisplayNameFinish() func(File, AsyncResult) *FileBase
 }
 
 // OverrideQuerySettableAttributes sets the "query_settable_attributes" callback function.
-// Returns a list of #GFileAttributeInfos that can be set.
+// Returns a list of GFileAttributeInfos that can be set.
 func (x *FileIface) OverrideQuerySettableAttributes(cb func(File, *Cancellable) *FileAttributeInfoList) {
 	if cb == nil {
 		x.xQuerySettableAttributes = 0
 	} else {
-		x.xQuerySettableAttributes = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList {
+		x.xQuerySettableAttributes = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetQuerySettableAttributes gets the "query_settable_attributes" callback function.
-// Returns a list of #GFileAttributeInfos that can be set.
+// Returns a list of GFileAttributeInfos that can be set.
 func (x *FileIface) GetQuerySettableAttributes() func(File, *Cancellable) *FileAttributeInfoList {
 	if x.xQuerySettableAttributes == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList
-	purego.RegisterFunc(&rawCallback, x.xQuerySettableAttributes)
+	core.RegisterFunc(&rawCallback, x.xQuerySettableAttributes)
 	return func(FileVar File, CancellableVar *Cancellable) *FileAttributeInfoList {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
 }
 
 // OverrideQuerySettableAttributesAsync sets the "_query_settable_attributes_async" callback function.
-// Asynchronously gets a list of #GFileAttributeInfos that can be set.
+// Asynchronously gets a list of GFileAttributeInfos that can be set.
 func (x *FileIface) OverrideQuerySettableAttributesAsync(cb func()) {
 	if cb == nil {
 		x.xQuerySettableAttributesAsync = 0
 	} else {
-		x.xQuerySettableAttributesAsync = purego.NewCallback(func() {
+		x.xQuerySettableAttributesAsync = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
 }
 
 // GetQuerySettableAttributesAsync gets the "_query_settable_attributes_async" callback function.
-// Asynchronously gets a list of #GFileAttributeInfos that can be set.
+// Asynchronously gets a list of GFileAttributeInfos that can be set.
 func (x *FileIface) GetQuerySettableAttributesAsync() func() {
 	if x.xQuerySettableAttributesAsync == 0 {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xQuerySettableAttributesAsync)
+	core.RegisterFunc(&rawCallback, x.xQuerySettableAttributesAsync)
 	return func() {
 		rawCallback()
 	}
@@ -1177,7 +1208,8 @@ func (x *FileIface) OverrideQuerySettableAttributesFinish(cb func()) {
 	if cb == nil {
 		x.xQuerySettableAttributesFinish = 0
 	} else {
-		x.xQuerySettableAttributesFinish = purego.NewCallback(func() {
+		x.xQuerySettableAttributesFinish = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -1190,57 +1222,59 @@ func (x *FileIface) GetQuerySettableAttributesFinish() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xQuerySettableAttributesFinish)
+	core.RegisterFunc(&rawCallback, x.xQuerySettableAttributesFinish)
 	return func() {
 		rawCallback()
 	}
 }
 
 // OverrideQueryWritableNamespaces sets the "query_writable_namespaces" callback function.
-// Returns a list of #GFileAttributeInfo namespaces that are writable.
+// Returns a list of [FileAttributeInfo] namespaces that are writable.
 func (x *FileIface) OverrideQueryWritableNamespaces(cb func(File, *Cancellable) *FileAttributeInfoList) {
 	if cb == nil {
 		x.xQueryWritableNamespaces = 0
 	} else {
-		x.xQueryWritableNamespaces = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList {
+		x.xQueryWritableNamespaces = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetQueryWritableNamespaces gets the "query_writable_namespaces" callback function.
-// Returns a list of #GFileAttributeInfo namespaces that are writable.
+// Returns a list of [FileAttributeInfo] namespaces that are writable.
 func (x *FileIface) GetQueryWritableNamespaces() func(File, *Cancellable) *FileAttributeInfoList {
 	if x.xQueryWritableNamespaces == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) *FileAttributeInfoList
-	purego.RegisterFunc(&rawCallback, x.xQueryWritableNamespaces)
+	core.RegisterFunc(&rawCallback, x.xQueryWritableNamespaces)
 	return func(FileVar File, CancellableVar *Cancellable) *FileAttributeInfoList {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
 }
 
 // OverrideQueryWritableNamespacesAsync sets the "_query_writable_namespaces_async" callback function.
-// Asynchronously gets a list of #GFileAttributeInfo namespaces that are writable.
+// Asynchronously gets a list of [FileAttributeInfo] namespaces that are writable.
 func (x *FileIface) OverrideQueryWritableNamespacesAsync(cb func()) {
 	if cb == nil {
 		x.xQueryWritableNamespacesAsync = 0
 	} else {
-		x.xQueryWritableNamespacesAsync = purego.NewCallback(func() {
+		x.xQueryWritableNamespacesAsync = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
 }
 
 // GetQueryWritableNamespacesAsync gets the "_query_writable_namespaces_async" callback function.
-// Asynchronously gets a list of #GFileAttributeInfo namespaces that are writable.
+// Asynchronously gets a list of [FileAttributeInfo] namespaces that are writable.
 func (x *FileIface) GetQueryWritableNamespacesAsync() func() {
 	if x.xQueryWritableNamespacesAsync == 0 {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xQueryWritableNamespacesAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryWritableNamespacesAsync)
 	return func() {
 		rawCallback()
 	}
@@ -1252,7 +1286,8 @@ func (x *FileIface) OverrideQueryWritableNamespacesFinish(cb func()) {
 	if cb == nil {
 		x.xQueryWritableNamespacesFinish = 0
 	} else {
-		x.xQueryWritableNamespacesFinish = purego.NewCallback(func() {
+		x.xQueryWritableNamespacesFinish = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -1265,57 +1300,59 @@ func (x *FileIface) GetQueryWritableNamespacesFinish() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xQueryWritableNamespacesFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryWritableNamespacesFinish)
 	return func() {
 		rawCallback()
 	}
 }
 
 // OverrideSetAttribute sets the "set_attribute" callback function.
-// Sets a #GFileAttributeInfo.
+// Sets a [FileAttributeInfo].
 func (x *FileIface) OverrideSetAttribute(cb func(File, string, FileAttributeType, uintptr, FileQueryInfoFlags, *Cancellable) bool) {
 	if cb == nil {
 		x.xSetAttribute = 0
 	} else {
-		x.xSetAttribute = purego.NewCallback(func(FileVarp uintptr, AttributeVarp string, TypeVarp FileAttributeType, ValuePVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+		x.xSetAttribute = core.NewCallback(func(FileVarp uintptr, AttributeVarp string, TypeVarp FileAttributeType, ValuePVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, AttributeVarp, TypeVarp, ValuePVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetSetAttribute gets the "set_attribute" callback function.
-// Sets a #GFileAttributeInfo.
+// Sets a [FileAttributeInfo].
 func (x *FileIface) GetSetAttribute() func(File, string, FileAttributeType, uintptr, FileQueryInfoFlags, *Cancellable) bool {
 	if x.xSetAttribute == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, AttributeVarp string, TypeVarp FileAttributeType, ValuePVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAttribute)
+	core.RegisterFunc(&rawCallback, x.xSetAttribute)
 	return func(FileVar File, AttributeVar string, TypeVar FileAttributeType, ValuePVar uintptr, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), AttributeVar, TypeVar, ValuePVar, FlagsVar, CancellableVar.GoPointer())
 	}
 }
 
 // OverrideSetAttributesFromInfo sets the "set_attributes_from_info" callback function.
-// Sets a #GFileAttributeInfo with information from a #GFileInfo.
+// Sets a [FileAttributeInfo] with information from a [FileInfo].
 func (x *FileIface) OverrideSetAttributesFromInfo(cb func(File, *FileInfo, FileQueryInfoFlags, *Cancellable) bool) {
 	if cb == nil {
 		x.xSetAttributesFromInfo = 0
 	} else {
-		x.xSetAttributesFromInfo = purego.NewCallback(func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+		x.xSetAttributesFromInfo = core.NewCallback(func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, FileInfoNewFromInternalPtr(InfoVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetSetAttributesFromInfo gets the "set_attributes_from_info" callback function.
-// Sets a #GFileAttributeInfo with information from a #GFileInfo.
+// Sets a [FileAttributeInfo] with information from a [FileInfo].
 func (x *FileIface) GetSetAttributesFromInfo() func(File, *FileInfo, FileQueryInfoFlags, *Cancellable) bool {
 	if x.xSetAttributesFromInfo == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAttributesFromInfo)
+	core.RegisterFunc(&rawCallback, x.xSetAttributesFromInfo)
 	return func(FileVar File, InfoVar *FileInfo, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), InfoVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -1327,7 +1364,8 @@ func (x *FileIface) OverrideSetAttributesAsync(cb func(File, *FileInfo, FileQuer
 	if cb == nil {
 		x.xSetAttributesAsync = 0
 	} else {
-		x.xSetAttributesAsync = purego.NewCallback(func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xSetAttributesAsync = core.NewCallback(func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FileInfoNewFromInternalPtr(InfoVarp), FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1340,7 +1378,7 @@ func (x *FileIface) GetSetAttributesAsync() func(File, *FileInfo, FileQueryInfoF
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetAttributesAsync)
+	core.RegisterFunc(&rawCallback, x.xSetAttributesAsync)
 	return func(FileVar File, InfoVar *FileInfo, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), InfoVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1352,7 +1390,8 @@ func (x *FileIface) OverrideSetAttributesFinish(cb func(File, AsyncResult, **Fil
 	if cb == nil {
 		x.xSetAttributesFinish = 0
 	} else {
-		x.xSetAttributesFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr, InfoVarp **FileInfo) bool {
+		x.xSetAttributesFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr, InfoVarp **FileInfo) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp}, InfoVarp)
 		})
 	}
@@ -1365,7 +1404,7 @@ func (x *FileIface) GetSetAttributesFinish() func(File, AsyncResult, **FileInfo)
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr, InfoVarp **FileInfo) bool
-	purego.RegisterFunc(&rawCallback, x.xSetAttributesFinish)
+	core.RegisterFunc(&rawCallback, x.xSetAttributesFinish)
 	return func(FileVar File, ResultVar AsyncResult, InfoVar **FileInfo) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer(), InfoVar)
 	}
@@ -1377,7 +1416,8 @@ func (x *FileIface) OverrideReadFn(cb func(File, *Cancellable) *FileInputStream)
 	if cb == nil {
 		x.xReadFn = 0
 	} else {
-		x.xReadFn = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xReadFn = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -1394,7 +1434,7 @@ func (x *FileIface) GetReadFn() func(File, *Cancellable) *FileInputStream {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReadFn)
+	core.RegisterFunc(&rawCallback, x.xReadFn)
 	return func(FileVar File, CancellableVar *Cancellable) *FileInputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -1412,7 +1452,8 @@ func (x *FileIface) OverrideReadAsync(cb func(File, int, *Cancellable, *AsyncRea
 	if cb == nil {
 		x.xReadAsync = 0
 	} else {
-		x.xReadAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xReadAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1425,7 +1466,7 @@ func (x *FileIface) GetReadAsync() func(File, int, *Cancellable, *AsyncReadyCall
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReadAsync)
+	core.RegisterFunc(&rawCallback, x.xReadAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1437,7 +1478,8 @@ func (x *FileIface) OverrideReadFinish(cb func(File, AsyncResult) *FileInputStre
 	if cb == nil {
 		x.xReadFinish = 0
 	} else {
-		x.xReadFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xReadFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1454,7 +1496,7 @@ func (x *FileIface) GetReadFinish() func(File, AsyncResult) *FileInputStream {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReadFinish)
+	core.RegisterFunc(&rawCallback, x.xReadFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileInputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1472,7 +1514,8 @@ func (x *FileIface) OverrideAppendTo(cb func(File, FileCreateFlags, *Cancellable
 	if cb == nil {
 		x.xAppendTo = 0
 	} else {
-		x.xAppendTo = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+		x.xAppendTo = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -1489,7 +1532,7 @@ func (x *FileIface) GetAppendTo() func(File, FileCreateFlags, *Cancellable) *Fil
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xAppendTo)
+	core.RegisterFunc(&rawCallback, x.xAppendTo)
 	return func(FileVar File, FlagsVar FileCreateFlags, CancellableVar *Cancellable) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -1507,7 +1550,8 @@ func (x *FileIface) OverrideAppendToAsync(cb func(File, FileCreateFlags, int, *C
 	if cb == nil {
 		x.xAppendToAsync = 0
 	} else {
-		x.xAppendToAsync = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xAppendToAsync = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1520,7 +1564,7 @@ func (x *FileIface) GetAppendToAsync() func(File, FileCreateFlags, int, *Cancell
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAppendToAsync)
+	core.RegisterFunc(&rawCallback, x.xAppendToAsync)
 	return func(FileVar File, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1532,7 +1576,8 @@ func (x *FileIface) OverrideAppendToFinish(cb func(File, AsyncResult) *FileOutpu
 	if cb == nil {
 		x.xAppendToFinish = 0
 	} else {
-		x.xAppendToFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xAppendToFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1549,7 +1594,7 @@ func (x *FileIface) GetAppendToFinish() func(File, AsyncResult) *FileOutputStrea
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xAppendToFinish)
+	core.RegisterFunc(&rawCallback, x.xAppendToFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1567,7 +1612,8 @@ func (x *FileIface) OverrideCreate(cb func(File, FileCreateFlags, *Cancellable)
 	if cb == nil {
 		x.xCreate = 0
 	} else {
-		x.xCreate = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+		x.xCreate = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -1584,7 +1630,7 @@ func (x *FileIface) GetCreate() func(File, FileCreateFlags, *Cancellable) *FileO
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreate)
+	core.RegisterFunc(&rawCallback, x.xCreate)
 	return func(FileVar File, FlagsVar FileCreateFlags, CancellableVar *Cancellable) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -1602,7 +1648,8 @@ func (x *FileIface) OverrideCreateAsync(cb func(File, FileCreateFlags, int, *Can
 	if cb == nil {
 		x.xCreateAsync = 0
 	} else {
-		x.xCreateAsync = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCreateAsync = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1615,7 +1662,7 @@ func (x *FileIface) GetCreateAsync() func(File, FileCreateFlags, int, *Cancellab
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCreateAsync)
+	core.RegisterFunc(&rawCallback, x.xCreateAsync)
 	return func(FileVar File, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1627,7 +1674,8 @@ func (x *FileIface) OverrideCreateFinish(cb func(File, AsyncResult) *FileOutputS
 	if cb == nil {
 		x.xCreateFinish = 0
 	} else {
-		x.xCreateFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xCreateFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1644,7 +1692,7 @@ func (x *FileIface) GetCreateFinish() func(File, AsyncResult) *FileOutputStream
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateFinish)
+	core.RegisterFunc(&rawCallback, x.xCreateFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1662,7 +1710,8 @@ func (x *FileIface) OverrideReplace(cb func(File, string, bool, FileCreateFlags,
 	if cb == nil {
 		x.xReplace = 0
 	} else {
-		x.xReplace = purego.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+		x.xReplace = core.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, EtagVarp, MakeBackupVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -1679,7 +1728,7 @@ func (x *FileIface) GetReplace() func(File, string, bool, FileCreateFlags, *Canc
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReplace)
+	core.RegisterFunc(&rawCallback, x.xReplace)
 	return func(FileVar File, EtagVar string, MakeBackupVar bool, FlagsVar FileCreateFlags, CancellableVar *Cancellable) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), EtagVar, MakeBackupVar, FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -1697,7 +1746,8 @@ func (x *FileIface) OverrideReplaceAsync(cb func(File, string, bool, FileCreateF
 	if cb == nil {
 		x.xReplaceAsync = 0
 	} else {
-		x.xReplaceAsync = purego.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xReplaceAsync = core.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, EtagVarp, MakeBackupVarp, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1710,7 +1760,7 @@ func (x *FileIface) GetReplaceAsync() func(File, string, bool, FileCreateFlags,
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReplaceAsync)
+	core.RegisterFunc(&rawCallback, x.xReplaceAsync)
 	return func(FileVar File, EtagVar string, MakeBackupVar bool, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), EtagVar, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1722,7 +1772,8 @@ func (x *FileIface) OverrideReplaceFinish(cb func(File, AsyncResult) *FileOutput
 	if cb == nil {
 		x.xReplaceFinish = 0
 	} else {
-		x.xReplaceFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xReplaceFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -1739,7 +1790,7 @@ func (x *FileIface) GetReplaceFinish() func(File, AsyncResult) *FileOutputStream
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReplaceFinish)
+	core.RegisterFunc(&rawCallback, x.xReplaceFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileOutputStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -1757,7 +1808,8 @@ func (x *FileIface) OverrideDeleteFile(cb func(File, *Cancellable) bool) {
 	if cb == nil {
 		x.xDeleteFile = 0
 	} else {
-		x.xDeleteFile = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+		x.xDeleteFile = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -1770,7 +1822,7 @@ func (x *FileIface) GetDeleteFile() func(File, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xDeleteFile)
+	core.RegisterFunc(&rawCallback, x.xDeleteFile)
 	return func(FileVar File, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -1782,7 +1834,8 @@ func (x *FileIface) OverrideDeleteFileAsync(cb func(File, int, *Cancellable, *As
 	if cb == nil {
 		x.xDeleteFileAsync = 0
 	} else {
-		x.xDeleteFileAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xDeleteFileAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1795,7 +1848,7 @@ func (x *FileIface) GetDeleteFileAsync() func(File, int, *Cancellable, *AsyncRea
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDeleteFileAsync)
+	core.RegisterFunc(&rawCallback, x.xDeleteFileAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1807,7 +1860,8 @@ func (x *FileIface) OverrideDeleteFileFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xDeleteFileFinish = 0
 	} else {
-		x.xDeleteFileFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xDeleteFileFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -1820,57 +1874,59 @@ func (x *FileIface) GetDeleteFileFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xDeleteFileFinish)
+	core.RegisterFunc(&rawCallback, x.xDeleteFileFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideTrash sets the "trash" callback function.
-// Sends a #GFile to the Trash location.
+// Sends a [File] to the Trash location.
 func (x *FileIface) OverrideTrash(cb func(File, *Cancellable) bool) {
 	if cb == nil {
 		x.xTrash = 0
 	} else {
-		x.xTrash = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+		x.xTrash = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetTrash gets the "trash" callback function.
-// Sends a #GFile to the Trash location.
+// Sends a [File] to the Trash location.
 func (x *FileIface) GetTrash() func(File, *Cancellable) bool {
 	if x.xTrash == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xTrash)
+	core.RegisterFunc(&rawCallback, x.xTrash)
 	return func(FileVar File, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
 }
 
 // OverrideTrashAsync sets the "trash_async" callback function.
-// Asynchronously sends a #GFile to the Trash location.
+// Asynchronously sends a [File] to the Trash location.
 func (x *FileIface) OverrideTrashAsync(cb func(File, int, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xTrashAsync = 0
 	} else {
-		x.xTrashAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xTrashAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetTrashAsync gets the "trash_async" callback function.
-// Asynchronously sends a #GFile to the Trash location.
+// Asynchronously sends a [File] to the Trash location.
 func (x *FileIface) GetTrashAsync() func(File, int, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xTrashAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xTrashAsync)
+	core.RegisterFunc(&rawCallback, x.xTrashAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1882,7 +1938,8 @@ func (x *FileIface) OverrideTrashFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xTrashFinish = 0
 	} else {
-		x.xTrashFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xTrashFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -1895,7 +1952,7 @@ func (x *FileIface) GetTrashFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xTrashFinish)
+	core.RegisterFunc(&rawCallback, x.xTrashFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -1907,7 +1964,8 @@ func (x *FileIface) OverrideMakeDirectory(cb func(File, *Cancellable) bool) {
 	if cb == nil {
 		x.xMakeDirectory = 0
 	} else {
-		x.xMakeDirectory = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+		x.xMakeDirectory = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -1920,7 +1978,7 @@ func (x *FileIface) GetMakeDirectory() func(File, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMakeDirectory)
+	core.RegisterFunc(&rawCallback, x.xMakeDirectory)
 	return func(FileVar File, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -1932,7 +1990,8 @@ func (x *FileIface) OverrideMakeDirectoryAsync(cb func(File, int, *Cancellable,
 	if cb == nil {
 		x.xMakeDirectoryAsync = 0
 	} else {
-		x.xMakeDirectoryAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMakeDirectoryAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -1945,7 +2004,7 @@ func (x *FileIface) GetMakeDirectoryAsync() func(File, int, *Cancellable, *Async
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMakeDirectoryAsync)
+	core.RegisterFunc(&rawCallback, x.xMakeDirectoryAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -1957,7 +2016,8 @@ func (x *FileIface) OverrideMakeDirectoryFinish(cb func(File, AsyncResult) bool)
 	if cb == nil {
 		x.xMakeDirectoryFinish = 0
 	} else {
-		x.xMakeDirectoryFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xMakeDirectoryFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -1970,28 +2030,29 @@ func (x *FileIface) GetMakeDirectoryFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMakeDirectoryFinish)
+	core.RegisterFunc(&rawCallback, x.xMakeDirectoryFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideMakeSymbolicLink sets the "make_symbolic_link" callback function.
-// Makes a symbolic link. %NULL if symbolic
+// Makes a symbolic link. nil if symbolic
 //
 //	links are unsupported.
 func (x *FileIface) OverrideMakeSymbolicLink(cb func(File, string, *Cancellable) bool) {
 	if cb == nil {
 		x.xMakeSymbolicLink = 0
 	} else {
-		x.xMakeSymbolicLink = purego.NewCallback(func(FileVarp uintptr, SymlinkValueVarp string, CancellableVarp uintptr) bool {
+		x.xMakeSymbolicLink = core.NewCallback(func(FileVarp uintptr, SymlinkValueVarp string, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, SymlinkValueVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetMakeSymbolicLink gets the "make_symbolic_link" callback function.
-// Makes a symbolic link. %NULL if symbolic
+// Makes a symbolic link. nil if symbolic
 //
 //	links are unsupported.
 func (x *FileIface) GetMakeSymbolicLink() func(File, string, *Cancellable) bool {
@@ -1999,7 +2060,7 @@ func (x *FileIface) GetMakeSymbolicLink() func(File, string, *Cancellable) bool
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, SymlinkValueVarp string, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMakeSymbolicLink)
+	core.RegisterFunc(&rawCallback, x.xMakeSymbolicLink)
 	return func(FileVar File, SymlinkValueVar string, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), SymlinkValueVar, CancellableVar.GoPointer())
 	}
@@ -2011,7 +2072,8 @@ func (x *FileIface) OverrideMakeSymbolicLinkAsync(cb func(File, string, int, *Ca
 	if cb == nil {
 		x.xMakeSymbolicLinkAsync = 0
 	} else {
-		x.xMakeSymbolicLinkAsync = purego.NewCallback(func(FileVarp uintptr, SymlinkValueVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMakeSymbolicLinkAsync = core.NewCallback(func(FileVarp uintptr, SymlinkValueVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, SymlinkValueVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2024,7 +2086,7 @@ func (x *FileIface) GetMakeSymbolicLinkAsync() func(File, string, int, *Cancella
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, SymlinkValueVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMakeSymbolicLinkAsync)
+	core.RegisterFunc(&rawCallback, x.xMakeSymbolicLinkAsync)
 	return func(FileVar File, SymlinkValueVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), SymlinkValueVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2036,7 +2098,8 @@ func (x *FileIface) OverrideMakeSymbolicLinkFinish(cb func(File, AsyncResult) bo
 	if cb == nil {
 		x.xMakeSymbolicLinkFinish = 0
 	} else {
-		x.xMakeSymbolicLinkFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xMakeSymbolicLinkFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2049,14 +2112,14 @@ func (x *FileIface) GetMakeSymbolicLinkFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMakeSymbolicLinkFinish)
+	core.RegisterFunc(&rawCallback, x.xMakeSymbolicLinkFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideCopy sets the "copy" callback function.
-// Copies a file. %NULL if copying is unsupported, which will
+// Copies a file. nil if copying is unsupported, which will
 //
 //	cause `GFile` to use a fallback copy method where it reads from the
 //	source and writes to the destination.
@@ -2064,14 +2127,15 @@ func (x *FileIface) OverrideCopy(cb func(File, File, FileCopyFlags, *Cancellable
 	if cb == nil {
 		x.xCopy = 0
 	} else {
-		x.xCopy = purego.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool {
+		x.xCopy = core.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: SourceVarp}, &FileBase{Ptr: DestinationVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressCallbackDataVarp)
 		})
 	}
 }
 
 // GetCopy gets the "copy" callback function.
-// Copies a file. %NULL if copying is unsupported, which will
+// Copies a file. nil if copying is unsupported, which will
 //
 //	cause `GFile` to use a fallback copy method where it reads from the
 //	source and writes to the destination.
@@ -2080,7 +2144,7 @@ func (x *FileIface) GetCopy() func(File, File, FileCopyFlags, *Cancellable, *Fil
 		return nil
 	}
 	var rawCallback func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCopy)
+	core.RegisterFunc(&rawCallback, x.xCopy)
 	return func(SourceVar File, DestinationVar File, FlagsVar FileCopyFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr) bool {
 		return rawCallback(SourceVar.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar)
 	}
@@ -2092,7 +2156,8 @@ func (x *FileIface) OverrideCopyAsync(cb func(File, File, FileCopyFlags, int, *C
 	if cb == nil {
 		x.xCopyAsync = 0
 	} else {
-		x.xCopyAsync = purego.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCopyAsync = core.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: SourceVarp}, &FileBase{Ptr: DestinationVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressCallbackDataVarp, (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2105,7 +2170,7 @@ func (x *FileIface) GetCopyAsync() func(File, File, FileCopyFlags, int, *Cancell
 		return nil
 	}
 	var rawCallback func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCopyAsync)
+	core.RegisterFunc(&rawCallback, x.xCopyAsync)
 	return func(SourceVar File, DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SourceVar.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2117,7 +2182,8 @@ func (x *FileIface) OverrideCopyFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xCopyFinish = 0
 	} else {
-		x.xCopyFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) bool {
+		x.xCopyFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 		})
 	}
@@ -2130,7 +2196,7 @@ func (x *FileIface) GetCopyFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCopyFinish)
+	core.RegisterFunc(&rawCallback, x.xCopyFinish)
 	return func(FileVar File, ResVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 	}
@@ -2142,7 +2208,8 @@ func (x *FileIface) OverrideMove(cb func(File, File, FileCopyFlags, *Cancellable
 	if cb == nil {
 		x.xMove = 0
 	} else {
-		x.xMove = purego.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool {
+		x.xMove = core.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: SourceVarp}, &FileBase{Ptr: DestinationVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressCallbackDataVarp)
 		})
 	}
@@ -2155,7 +2222,7 @@ func (x *FileIface) GetMove() func(File, File, FileCopyFlags, *Cancellable, *Fil
 		return nil
 	}
 	var rawCallback func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMove)
+	core.RegisterFunc(&rawCallback, x.xMove)
 	return func(SourceVar File, DestinationVar File, FlagsVar FileCopyFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr) bool {
 		return rawCallback(SourceVar.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar)
 	}
@@ -2167,7 +2234,8 @@ func (x *FileIface) OverrideMoveAsync(cb func(File, File, FileCopyFlags, int, *C
 	if cb == nil {
 		x.xMoveAsync = 0
 	} else {
-		x.xMoveAsync = purego.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMoveAsync = core.NewCallback(func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: SourceVarp}, &FileBase{Ptr: DestinationVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressCallbackDataVarp, (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2180,7 +2248,7 @@ func (x *FileIface) GetMoveAsync() func(File, File, FileCopyFlags, int, *Cancell
 		return nil
 	}
 	var rawCallback func(SourceVarp uintptr, DestinationVarp uintptr, FlagsVarp FileCopyFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressCallbackDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMoveAsync)
+	core.RegisterFunc(&rawCallback, x.xMoveAsync)
 	return func(SourceVar File, DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SourceVar.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2192,7 +2260,8 @@ func (x *FileIface) OverrideMoveFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xMoveFinish = 0
 	} else {
-		x.xMoveFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xMoveFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2205,7 +2274,7 @@ func (x *FileIface) GetMoveFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMoveFinish)
+	core.RegisterFunc(&rawCallback, x.xMoveFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -2217,7 +2286,8 @@ func (x *FileIface) OverrideMountMountable(cb func(File, MountMountFlags, *Mount
 	if cb == nil {
 		x.xMountMountable = 0
 	} else {
-		x.xMountMountable = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMountMountable = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2230,7 +2300,7 @@ func (x *FileIface) GetMountMountable() func(File, MountMountFlags, *MountOperat
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountMountable)
+	core.RegisterFunc(&rawCallback, x.xMountMountable)
 	return func(FileVar File, FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2242,7 +2312,8 @@ func (x *FileIface) OverrideMountMountableFinish(cb func(File, AsyncResult) *Fil
 	if cb == nil {
 		x.xMountMountableFinish = 0
 	} else {
-		x.xMountMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xMountMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -2259,7 +2330,7 @@ func (x *FileIface) GetMountMountableFinish() func(File, AsyncResult) *FileBase
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xMountMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xMountMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) *FileBase {
 		rawRet := rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -2277,7 +2348,8 @@ func (x *FileIface) OverrideUnmountMountable(cb func(File, MountUnmountFlags, *C
 	if cb == nil {
 		x.xUnmountMountable = 0
 	} else {
-		x.xUnmountMountable = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xUnmountMountable = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2290,7 +2362,7 @@ func (x *FileIface) GetUnmountMountable() func(File, MountUnmountFlags, *Cancell
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmountMountable)
+	core.RegisterFunc(&rawCallback, x.xUnmountMountable)
 	return func(FileVar File, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2302,7 +2374,8 @@ func (x *FileIface) OverrideUnmountMountableFinish(cb func(File, AsyncResult) bo
 	if cb == nil {
 		x.xUnmountMountableFinish = 0
 	} else {
-		x.xUnmountMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xUnmountMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2315,7 +2388,7 @@ func (x *FileIface) GetUnmountMountableFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xUnmountMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xUnmountMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -2327,7 +2400,8 @@ func (x *FileIface) OverrideEjectMountable(cb func(File, MountUnmountFlags, *Can
 	if cb == nil {
 		x.xEjectMountable = 0
 	} else {
-		x.xEjectMountable = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEjectMountable = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2340,7 +2414,7 @@ func (x *FileIface) GetEjectMountable() func(File, MountUnmountFlags, *Cancellab
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectMountable)
+	core.RegisterFunc(&rawCallback, x.xEjectMountable)
 	return func(FileVar File, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2352,7 +2426,8 @@ func (x *FileIface) OverrideEjectMountableFinish(cb func(File, AsyncResult) bool
 	if cb == nil {
 		x.xEjectMountableFinish = 0
 	} else {
-		x.xEjectMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2365,7 +2440,7 @@ func (x *FileIface) GetEjectMountableFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -2377,7 +2452,8 @@ func (x *FileIface) OverrideMountEnclosingVolume(cb func(File, MountMountFlags,
 	if cb == nil {
 		x.xMountEnclosingVolume = 0
 	} else {
-		x.xMountEnclosingVolume = purego.NewCallback(func(LocationVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMountEnclosingVolume = core.NewCallback(func(LocationVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: LocationVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2390,7 +2466,7 @@ func (x *FileIface) GetMountEnclosingVolume() func(File, MountMountFlags, *Mount
 		return nil
 	}
 	var rawCallback func(LocationVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountEnclosingVolume)
+	core.RegisterFunc(&rawCallback, x.xMountEnclosingVolume)
 	return func(LocationVar File, FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(LocationVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2402,7 +2478,8 @@ func (x *FileIface) OverrideMountEnclosingVolumeFinish(cb func(File, AsyncResult
 	if cb == nil {
 		x.xMountEnclosingVolumeFinish = 0
 	} else {
-		x.xMountEnclosingVolumeFinish = purego.NewCallback(func(LocationVarp uintptr, ResultVarp uintptr) bool {
+		x.xMountEnclosingVolumeFinish = core.NewCallback(func(LocationVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: LocationVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2415,19 +2492,20 @@ func (x *FileIface) GetMountEnclosingVolumeFinish() func(File, AsyncResult) bool
 		return nil
 	}
 	var rawCallback func(LocationVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMountEnclosingVolumeFinish)
+	core.RegisterFunc(&rawCallback, x.xMountEnclosingVolumeFinish)
 	return func(LocationVar File, ResultVar AsyncResult) bool {
 		return rawCallback(LocationVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideMonitorDir sets the "monitor_dir" callback function.
-// Creates a #GFileMonitor for the location.
+// Creates a [FileMonitor] for the location.
 func (x *FileIface) OverrideMonitorDir(cb func(File, FileMonitorFlags, *Cancellable) *FileMonitor) {
 	if cb == nil {
 		x.xMonitorDir = 0
 	} else {
-		x.xMonitorDir = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr {
+		x.xMonitorDir = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -2438,13 +2516,13 @@ func (x *FileIface) OverrideMonitorDir(cb func(File, FileMonitorFlags, *Cancella
 }
 
 // GetMonitorDir gets the "monitor_dir" callback function.
-// Creates a #GFileMonitor for the location.
+// Creates a [FileMonitor] for the location.
 func (x *FileIface) GetMonitorDir() func(File, FileMonitorFlags, *Cancellable) *FileMonitor {
 	if x.xMonitorDir == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xMonitorDir)
+	core.RegisterFunc(&rawCallback, x.xMonitorDir)
 	return func(FileVar File, FlagsVar FileMonitorFlags, CancellableVar *Cancellable) *FileMonitor {
 		rawRet := rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -2457,12 +2535,13 @@ func (x *FileIface) GetMonitorDir() func(File, FileMonitorFlags, *Cancellable) *
 }
 
 // OverrideMonitorFile sets the "monitor_file" callback function.
-// Creates a #GFileMonitor for the location.
+// Creates a [FileMonitor] for the location.
 func (x *FileIface) OverrideMonitorFile(cb func(File, FileMonitorFlags, *Cancellable) *FileMonitor) {
 	if cb == nil {
 		x.xMonitorFile = 0
 	} else {
-		x.xMonitorFile = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr {
+		x.xMonitorFile = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -2473,13 +2552,13 @@ func (x *FileIface) OverrideMonitorFile(cb func(File, FileMonitorFlags, *Cancell
 }
 
 // GetMonitorFile gets the "monitor_file" callback function.
-// Creates a #GFileMonitor for the location.
+// Creates a [FileMonitor] for the location.
 func (x *FileIface) GetMonitorFile() func(File, FileMonitorFlags, *Cancellable) *FileMonitor {
 	if x.xMonitorFile == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileMonitorFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xMonitorFile)
+	core.RegisterFunc(&rawCallback, x.xMonitorFile)
 	return func(FileVar File, FlagsVar FileMonitorFlags, CancellableVar *Cancellable) *FileMonitor {
 		rawRet := rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -2497,7 +2576,8 @@ func (x *FileIface) OverrideOpenReadwrite(cb func(File, *Cancellable) *FileIOStr
 	if cb == nil {
 		x.xOpenReadwrite = 0
 	} else {
-		x.xOpenReadwrite = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xOpenReadwrite = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -2514,7 +2594,7 @@ func (x *FileIface) GetOpenReadwrite() func(File, *Cancellable) *FileIOStream {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xOpenReadwrite)
+	core.RegisterFunc(&rawCallback, x.xOpenReadwrite)
 	return func(FileVar File, CancellableVar *Cancellable) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -2532,7 +2612,8 @@ func (x *FileIface) OverrideOpenReadwriteAsync(cb func(File, int, *Cancellable,
 	if cb == nil {
 		x.xOpenReadwriteAsync = 0
 	} else {
-		x.xOpenReadwriteAsync = purego.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xOpenReadwriteAsync = core.NewCallback(func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2545,7 +2626,7 @@ func (x *FileIface) GetOpenReadwriteAsync() func(File, int, *Cancellable, *Async
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xOpenReadwriteAsync)
+	core.RegisterFunc(&rawCallback, x.xOpenReadwriteAsync)
 	return func(FileVar File, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2557,7 +2638,8 @@ func (x *FileIface) OverrideOpenReadwriteFinish(cb func(File, AsyncResult) *File
 	if cb == nil {
 		x.xOpenReadwriteFinish = 0
 	} else {
-		x.xOpenReadwriteFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xOpenReadwriteFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -2574,7 +2656,7 @@ func (x *FileIface) GetOpenReadwriteFinish() func(File, AsyncResult) *FileIOStre
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xOpenReadwriteFinish)
+	core.RegisterFunc(&rawCallback, x.xOpenReadwriteFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -2592,7 +2674,8 @@ func (x *FileIface) OverrideCreateReadwrite(cb func(File, FileCreateFlags, *Canc
 	if cb == nil {
 		x.xCreateReadwrite = 0
 	} else {
-		x.xCreateReadwrite = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+		x.xCreateReadwrite = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -2609,7 +2692,7 @@ func (x *FileIface) GetCreateReadwrite() func(File, FileCreateFlags, *Cancellabl
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateReadwrite)
+	core.RegisterFunc(&rawCallback, x.xCreateReadwrite)
 	return func(FileVar File, FlagsVar FileCreateFlags, CancellableVar *Cancellable) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -2627,7 +2710,8 @@ func (x *FileIface) OverrideCreateReadwriteAsync(cb func(File, FileCreateFlags,
 	if cb == nil {
 		x.xCreateReadwriteAsync = 0
 	} else {
-		x.xCreateReadwriteAsync = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCreateReadwriteAsync = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2640,7 +2724,7 @@ func (x *FileIface) GetCreateReadwriteAsync() func(File, FileCreateFlags, int, *
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCreateReadwriteAsync)
+	core.RegisterFunc(&rawCallback, x.xCreateReadwriteAsync)
 	return func(FileVar File, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2652,7 +2736,8 @@ func (x *FileIface) OverrideCreateReadwriteFinish(cb func(File, AsyncResult) *Fi
 	if cb == nil {
 		x.xCreateReadwriteFinish = 0
 	} else {
-		x.xCreateReadwriteFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xCreateReadwriteFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -2669,7 +2754,7 @@ func (x *FileIface) GetCreateReadwriteFinish() func(File, AsyncResult) *FileIOSt
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateReadwriteFinish)
+	core.RegisterFunc(&rawCallback, x.xCreateReadwriteFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -2687,7 +2772,8 @@ func (x *FileIface) OverrideReplaceReadwrite(cb func(File, string, bool, FileCre
 	if cb == nil {
 		x.xReplaceReadwrite = 0
 	} else {
-		x.xReplaceReadwrite = purego.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+		x.xReplaceReadwrite = core.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, EtagVarp, MakeBackupVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -2704,7 +2790,7 @@ func (x *FileIface) GetReplaceReadwrite() func(File, string, bool, FileCreateFla
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReplaceReadwrite)
+	core.RegisterFunc(&rawCallback, x.xReplaceReadwrite)
 	return func(FileVar File, EtagVar string, MakeBackupVar bool, FlagsVar FileCreateFlags, CancellableVar *Cancellable) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), EtagVar, MakeBackupVar, FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -2722,7 +2808,8 @@ func (x *FileIface) OverrideReplaceReadwriteAsync(cb func(File, string, bool, Fi
 	if cb == nil {
 		x.xReplaceReadwriteAsync = 0
 	} else {
-		x.xReplaceReadwriteAsync = purego.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xReplaceReadwriteAsync = core.NewCallback(func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, EtagVarp, MakeBackupVarp, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2735,7 +2822,7 @@ func (x *FileIface) GetReplaceReadwriteAsync() func(File, string, bool, FileCrea
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, EtagVarp string, MakeBackupVarp bool, FlagsVarp FileCreateFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReplaceReadwriteAsync)
+	core.RegisterFunc(&rawCallback, x.xReplaceReadwriteAsync)
 	return func(FileVar File, EtagVar string, MakeBackupVar bool, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), EtagVar, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2747,7 +2834,8 @@ func (x *FileIface) OverrideReplaceReadwriteFinish(cb func(File, AsyncResult) *F
 	if cb == nil {
 		x.xReplaceReadwriteFinish = 0
 	} else {
-		x.xReplaceReadwriteFinish = purego.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+		x.xReplaceReadwriteFinish = core.NewCallback(func(FileVarp uintptr, ResVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResVarp})
 			if ret == nil {
 				return 0
@@ -2764,7 +2852,7 @@ func (x *FileIface) GetReplaceReadwriteFinish() func(File, AsyncResult) *FileIOS
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xReplaceReadwriteFinish)
+	core.RegisterFunc(&rawCallback, x.xReplaceReadwriteFinish)
 	return func(FileVar File, ResVar AsyncResult) *FileIOStream {
 		rawRet := rawCallback(FileVar.GoPointer(), ResVar.GoPointer())
 		if rawRet == 0 {
@@ -2782,7 +2870,8 @@ func (x *FileIface) OverrideStartMountable(cb func(File, DriveStartFlags, *Mount
 	if cb == nil {
 		x.xStartMountable = 0
 	} else {
-		x.xStartMountable = purego.NewCallback(func(FileVarp uintptr, FlagsVarp DriveStartFlags, StartOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xStartMountable = core.NewCallback(func(FileVarp uintptr, FlagsVarp DriveStartFlags, StartOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, MountOperationNewFromInternalPtr(StartOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2795,7 +2884,7 @@ func (x *FileIface) GetStartMountable() func(File, DriveStartFlags, *MountOperat
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp DriveStartFlags, StartOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStartMountable)
+	core.RegisterFunc(&rawCallback, x.xStartMountable)
 	return func(FileVar File, FlagsVar DriveStartFlags, StartOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, StartOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2807,7 +2896,8 @@ func (x *FileIface) OverrideStartMountableFinish(cb func(File, AsyncResult) bool
 	if cb == nil {
 		x.xStartMountableFinish = 0
 	} else {
-		x.xStartMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xStartMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2820,7 +2910,7 @@ func (x *FileIface) GetStartMountableFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xStartMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xStartMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -2832,7 +2922,8 @@ func (x *FileIface) OverrideStopMountable(cb func(File, MountUnmountFlags, *Moun
 	if cb == nil {
 		x.xStopMountable = 0
 	} else {
-		x.xStopMountable = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xStopMountable = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2845,7 +2936,7 @@ func (x *FileIface) GetStopMountable() func(File, MountUnmountFlags, *MountOpera
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStopMountable)
+	core.RegisterFunc(&rawCallback, x.xStopMountable)
 	return func(FileVar File, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -2857,7 +2948,8 @@ func (x *FileIface) OverrideStopMountableFinish(cb func(File, AsyncResult) bool)
 	if cb == nil {
 		x.xStopMountableFinish = 0
 	} else {
-		x.xStopMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xStopMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -2870,107 +2962,111 @@ func (x *FileIface) GetStopMountableFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xStopMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xStopMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideUnmountMountableWithOperation sets the "unmount_mountable_with_operation" callback function.
-// Unmounts a mountable object using a #GMountOperation. Since 2.22.
+// Unmounts a mountable object using a [MountOperation]. Since 2.22.
 func (x *FileIface) OverrideUnmountMountableWithOperation(cb func(File, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xUnmountMountableWithOperation = 0
 	} else {
-		x.xUnmountMountableWithOperation = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xUnmountMountableWithOperation = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetUnmountMountableWithOperation gets the "unmount_mountable_with_operation" callback function.
-// Unmounts a mountable object using a #GMountOperation. Since 2.22.
+// Unmounts a mountable object using a [MountOperation]. Since 2.22.
 func (x *FileIface) GetUnmountMountableWithOperation() func(File, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xUnmountMountableWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmountMountableWithOperation)
+	core.RegisterFunc(&rawCallback, x.xUnmountMountableWithOperation)
 	return func(FileVar File, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideUnmountMountableWithOperationFinish sets the "unmount_mountable_with_operation_finish" callback function.
-// Finishes an unmount operation using a #GMountOperation. Since 2.22.
+// Finishes an unmount operation using a [MountOperation]. Since 2.22.
 func (x *FileIface) OverrideUnmountMountableWithOperationFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xUnmountMountableWithOperationFinish = 0
 	} else {
-		x.xUnmountMountableWithOperationFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xUnmountMountableWithOperationFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetUnmountMountableWithOperationFinish gets the "unmount_mountable_with_operation_finish" callback function.
-// Finishes an unmount operation using a #GMountOperation. Since 2.22.
+// Finishes an unmount operation using a [MountOperation]. Since 2.22.
 func (x *FileIface) GetUnmountMountableWithOperationFinish() func(File, AsyncResult) bool {
 	if x.xUnmountMountableWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xUnmountMountableWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xUnmountMountableWithOperationFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideEjectMountableWithOperation sets the "eject_mountable_with_operation" callback function.
-// Ejects a mountable object using a #GMountOperation. Since 2.22.
+// Ejects a mountable object using a [MountOperation]. Since 2.22.
 func (x *FileIface) OverrideEjectMountableWithOperation(cb func(File, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEjectMountableWithOperation = 0
 	} else {
-		x.xEjectMountableWithOperation = purego.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEjectMountableWithOperation = core.NewCallback(func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEjectMountableWithOperation gets the "eject_mountable_with_operation" callback function.
-// Ejects a mountable object using a #GMountOperation. Since 2.22.
+// Ejects a mountable object using a [MountOperation]. Since 2.22.
 func (x *FileIface) GetEjectMountableWithOperation() func(File, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEjectMountableWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectMountableWithOperation)
+	core.RegisterFunc(&rawCallback, x.xEjectMountableWithOperation)
 	return func(FileVar File, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideEjectMountableWithOperationFinish sets the "eject_mountable_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *FileIface) OverrideEjectMountableWithOperationFinish(cb func(File, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectMountableWithOperationFinish = 0
 	} else {
-		x.xEjectMountableWithOperationFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectMountableWithOperationFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetEjectMountableWithOperationFinish gets the "eject_mountable_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *FileIface) GetEjectMountableWithOperationFinish() func(File, AsyncResult) bool {
 	if x.xEjectMountableWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectMountableWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectMountableWithOperationFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -2982,7 +3078,8 @@ func (x *FileIface) OverridePollMountable(cb func(File, *Cancellable, *AsyncRead
 	if cb == nil {
 		x.xPollMountable = 0
 	} else {
-		x.xPollMountable = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xPollMountable = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -2995,7 +3092,7 @@ func (x *FileIface) GetPollMountable() func(File, *Cancellable, *AsyncReadyCallb
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPollMountable)
+	core.RegisterFunc(&rawCallback, x.xPollMountable)
 	return func(FileVar File, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -3007,7 +3104,8 @@ func (x *FileIface) OverridePollMountableFinish(cb func(File, AsyncResult) bool)
 	if cb == nil {
 		x.xPollMountableFinish = 0
 	} else {
-		x.xPollMountableFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+		x.xPollMountableFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -3020,82 +3118,85 @@ func (x *FileIface) GetPollMountableFinish() func(File, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPollMountableFinish)
+	core.RegisterFunc(&rawCallback, x.xPollMountableFinish)
 	return func(FileVar File, ResultVar AsyncResult) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideMeasureDiskUsage sets the "measure_disk_usage" callback function.
-// Recursively measures the disk usage of @file. Since 2.38
+// Recursively measures the disk usage of file. Since 2.38
 func (x *FileIface) OverrideMeasureDiskUsage(cb func(File, FileMeasureFlags, *Cancellable, *FileMeasureProgressCallback, uintptr, *uint64, *uint64, *uint64) bool) {
 	if cb == nil {
 		x.xMeasureDiskUsage = 0
 	} else {
-		x.xMeasureDiskUsage = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileMeasureFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool {
+		x.xMeasureDiskUsage = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileMeasureFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileMeasureProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressDataVarp, DiskUsageVarp, NumDirsVarp, NumFilesVarp)
 		})
 	}
 }
 
 // GetMeasureDiskUsage gets the "measure_disk_usage" callback function.
-// Recursively measures the disk usage of @file. Since 2.38
+// Recursively measures the disk usage of file. Since 2.38
 func (x *FileIface) GetMeasureDiskUsage() func(File, FileMeasureFlags, *Cancellable, *FileMeasureProgressCallback, uintptr, *uint64, *uint64, *uint64) bool {
 	if x.xMeasureDiskUsage == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileMeasureFlags, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool
-	purego.RegisterFunc(&rawCallback, x.xMeasureDiskUsage)
+	core.RegisterFunc(&rawCallback, x.xMeasureDiskUsage)
 	return func(FileVar File, FlagsVar FileMeasureFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileMeasureProgressCallback, ProgressDataVar uintptr, DiskUsageVar *uint64, NumDirsVar *uint64, NumFilesVar *uint64) bool {
 		return rawCallback(FileVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressDataVar, DiskUsageVar, NumDirsVar, NumFilesVar)
 	}
 }
 
 // OverrideMeasureDiskUsageAsync sets the "measure_disk_usage_async" callback function.
-// Asynchronously recursively measures the disk usage of @file. Since 2.38
+// Asynchronously recursively measures the disk usage of file. Since 2.38
 func (x *FileIface) OverrideMeasureDiskUsageAsync(cb func(File, FileMeasureFlags, int, *Cancellable, *FileMeasureProgressCallback, uintptr, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xMeasureDiskUsageAsync = 0
 	} else {
-		x.xMeasureDiskUsageAsync = purego.NewCallback(func(FileVarp uintptr, FlagsVarp FileMeasureFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMeasureDiskUsageAsync = core.NewCallback(func(FileVarp uintptr, FlagsVarp FileMeasureFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FileBase{Ptr: FileVarp}, FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*FileMeasureProgressCallback)(unsafe.Pointer(ProgressCallbackVarp)), ProgressDataVarp, (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetMeasureDiskUsageAsync gets the "measure_disk_usage_async" callback function.
-// Asynchronously recursively measures the disk usage of @file. Since 2.38
+// Asynchronously recursively measures the disk usage of file. Since 2.38
 func (x *FileIface) GetMeasureDiskUsageAsync() func(File, FileMeasureFlags, int, *Cancellable, *FileMeasureProgressCallback, uintptr, *AsyncReadyCallback, uintptr) {
 	if x.xMeasureDiskUsageAsync == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, FlagsVarp FileMeasureFlags, IoPriorityVarp int, CancellableVarp uintptr, ProgressCallbackVarp uintptr, ProgressDataVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMeasureDiskUsageAsync)
+	core.RegisterFunc(&rawCallback, x.xMeasureDiskUsageAsync)
 	return func(FileVar File, FlagsVar FileMeasureFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileMeasureProgressCallback, ProgressDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(FileVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideMeasureDiskUsageFinish sets the "measure_disk_usage_finish" callback function.
-// Finishes an asynchronous recursive measurement of the disk usage of @file. Since 2.38
+// Finishes an asynchronous recursive measurement of the disk usage of file. Since 2.38
 func (x *FileIface) OverrideMeasureDiskUsageFinish(cb func(File, AsyncResult, *uint64, *uint64, *uint64) bool) {
 	if cb == nil {
 		x.xMeasureDiskUsageFinish = 0
 	} else {
-		x.xMeasureDiskUsageFinish = purego.NewCallback(func(FileVarp uintptr, ResultVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool {
+		x.xMeasureDiskUsageFinish = core.NewCallback(func(FileVarp uintptr, ResultVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, &AsyncResultBase{Ptr: ResultVarp}, DiskUsageVarp, NumDirsVarp, NumFilesVarp)
 		})
 	}
 }
 
 // GetMeasureDiskUsageFinish gets the "measure_disk_usage_finish" callback function.
-// Finishes an asynchronous recursive measurement of the disk usage of @file. Since 2.38
+// Finishes an asynchronous recursive measurement of the disk usage of file. Since 2.38
 func (x *FileIface) GetMeasureDiskUsageFinish() func(File, AsyncResult, *uint64, *uint64, *uint64) bool {
 	if x.xMeasureDiskUsageFinish == 0 {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, ResultVarp uintptr, DiskUsageVarp *uint64, NumDirsVarp *uint64, NumFilesVarp *uint64) bool
-	purego.RegisterFunc(&rawCallback, x.xMeasureDiskUsageFinish)
+	core.RegisterFunc(&rawCallback, x.xMeasureDiskUsageFinish)
 	return func(FileVar File, ResultVar AsyncResult, DiskUsageVar *uint64, NumDirsVar *uint64, NumFilesVar *uint64) bool {
 		return rawCallback(FileVar.GoPointer(), ResultVar.GoPointer(), DiskUsageVar, NumDirsVar, NumFilesVar)
 	}
@@ -3107,7 +3208,8 @@ func (x *FileIface) OverrideQueryExists(cb func(File, *Cancellable) bool) {
 	if cb == nil {
 		x.xQueryExists = 0
 	} else {
-		x.xQueryExists = purego.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+		x.xQueryExists = core.NewCallback(func(FileVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&FileBase{Ptr: FileVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -3120,7 +3222,7 @@ func (x *FileIface) GetQueryExists() func(File, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(FileVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xQueryExists)
+	core.RegisterFunc(&rawCallback, x.xQueryExists)
 	return func(FileVar File, CancellableVar *Cancellable) bool {
 		return rawCallback(FileVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -3131,22 +3233,22 @@ func (x *FileIface) GetQueryExists() func(File, *Cancellable) bool {
 // that do no I/O upon creation. It is necessary to understand that
 // `GFile` objects do not represent files, merely an identifier for a
 // file. All file content I/O is implemented as streaming operations
-// (see [class@Gio.InputStream] and [class@Gio.OutputStream]).
+// (see [InputStream] and [OutputStream]).
 //
 // To construct a `GFile`, you can use:
 //
-//   - [func@Gio.File.new_for_path] if you have a path.
-//   - [func@Gio.File.new_for_uri] if you have a URI.
-//   - [func@Gio.File.new_for_commandline_arg] or
-//     [func@Gio.File.new_for_commandline_arg_and_cwd] for a command line
+//   - [FileNewForPath] if you have a path.
+//   - [FileNewForUri] if you have a URI.
+//   - [FileNewForCommandlineArg] or
+//     [FileNewForCommandlineArgAndCwd] for a command line
 //     argument.
-//   - [func@Gio.File.new_tmp] to create a temporary file from a template.
-//   - [func@Gio.File.new_tmp_async] to asynchronously create a temporary file.
-//   - [func@Gio.File.new_tmp_dir_async] to asynchronously create a temporary
+//   - [FileNewTmp] to create a temporary file from a template.
+//   - [FileNewTmpAsync] to asynchronously create a temporary file.
+//   - [FileNewTmpDirAsync] to asynchronously create a temporary
 //     directory.
-//   - [func@Gio.File.parse_name] from a UTF-8 string gotten from
-//     [method@Gio.File.get_parse_name].
-//   - [func@Gio.File.new_build_filename] or [func@Gio.File.new_build_filenamev]
+//   - [FileParseName] from a UTF-8 string gotten from
+//     [File.GetParseName].
+//   - File.new_build_filename or [FileNewBuildFilenamev]
 //     to create a file from path elements.
 //
 // One way to think of a `GFile` is as an abstraction of a pathname. For
@@ -3156,21 +3258,21 @@ func (x *FileIface) GetQueryExists() func(File, *Cancellable) bool {
 //
 // `GFile`s make up hierarchies of directories and files that correspond to
 // the files on a filesystem. You can move through the file system with
-// `GFile` using [method@Gio.File.get_parent] to get an identifier for the
-// parent directory, [method@Gio.File.get_child] to get a child within a
-// directory, and [method@Gio.File.resolve_relative_path] to resolve a relative
+// `GFile` using [File.GetParent] to get an identifier for the
+// parent directory, [File.GetChild] to get a child within a
+// directory, and [File.ResolveRelativePath] to resolve a relative
 // path between two `GFile`s. There can be multiple hierarchies, so you may not
-// end up at the same root if you repeatedly call [method@Gio.File.get_parent]
+// end up at the same root if you repeatedly call [File.GetParent]
 // on two different files.
 //
-// All `GFile`s have a basename (get with [method@Gio.File.get_basename]). These
+// All `GFile`s have a basename (get with [File.GetBasename]). These
 // names are byte strings that are used to identify the file on the filesystem
 // (relative to its parent directory) and there is no guarantees that they
 // have any particular charset encoding or even make any sense at all. If
 // you want to use filenames in a user interface you should use the display
 // name that you can get by requesting the
 // `G_FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME` attribute with
-// [method@Gio.File.query_info]. This is guaranteed to be in UTF-8 and can be
+// [File.QueryInfo]. This is guaranteed to be in UTF-8 and can be
 // used in a user interface. But always store the real basename or the `GFile`
 // to use to actually access the file, because there is no way to go from a
 // display name to the actual name.
@@ -3189,34 +3291,36 @@ func (x *FileIface) GetQueryExists() func(File, *Cancellable) bool {
 // Many `GFile` operations have both synchronous and asynchronous versions
 // to suit your application. Asynchronous versions of synchronous functions
 // simply have `_async()` appended to their function names. The asynchronous
-// I/O functions call a [callback@Gio.AsyncReadyCallback] which is then used to
-// finalize the operation, producing a [iface@Gio.AsyncResult] which is then
+// I/O functions call a [AsyncReadyCallback] which is then used to
+// finalize the operation, producing a [AsyncResult] which is then
 // passed to the function’s matching `_finish()` operation.
 //
 // It is highly recommended to use asynchronous calls when running within a
 // shared main loop, such as in the main thread of an application. This avoids
 // I/O operations blocking other sources on the main loop from being dispatched.
 // Synchronous I/O operations should be performed from worker threads. See the
-// [introduction to asynchronous programming section](overview.html#asynchronous-programming)
+// introduction to asynchronous programming section
 // for more.
 //
 // Some `GFile` operations almost always take a noticeable amount of time, and
 // so do not have synchronous analogs. Notable cases include:
 //
-//   - [method@Gio.File.mount_mountable] to mount a mountable file.
-//   - [method@Gio.File.unmount_mountable_with_operation] to unmount a mountable
+//   - [File.MountMountable] to mount a mountable file.
+//   - [File.UnmountMountableWithOperation] to unmount a mountable
 //     file.
-//   - [method@Gio.File.eject_mountable_with_operation] to eject a mountable file.
+//   - [File.EjectMountableWithOperation] to eject a mountable file.
 //
-// ## Entity Tags
+// # Entity Tags
 //
 // One notable feature of `GFile`s are entity tags, or ‘etags’ for
 // short. Entity tags are somewhat like a more abstract version of the
 // traditional mtime, and can be used to quickly determine if the file
 // has been modified from the version on the file system. See the
 // HTTP 1.1
-// [specification](http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html)
+// [specification]
 // for HTTP `ETag` headers, which are a very similar concept.
+//
+// [specification]: http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html
 type File interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -3264,9 +3368,9 @@ type File interface {
 	HasUriScheme(UriSchemeVar string) bool
 	Hash() uint
 	IsNative() bool
-	LoadBytes(CancellableVar *Cancellable, EtagOutVar *string) (*glib.Bytes, error)
+	LoadBytes(CancellableVar *Cancellable, EtagOutVar *string) ([]byte, error)
 	LoadBytesAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr)
-	LoadBytesFinish(ResultVar AsyncResult, EtagOutVar *string) (*glib.Bytes, error)
+	LoadBytesFinish(ResultVar AsyncResult, EtagOutVar *string) ([]byte, error)
 	LoadContents(CancellableVar *Cancellable, ContentsVar *[]string, LengthVar *uint, EtagOutVar *string) (bool, error)
 	LoadContentsAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr)
 	LoadContentsFinish(ResVar AsyncResult, ContentsVar *[]string, LengthVar *uint, EtagOutVar *string) (bool, error)
@@ -3374,22 +3478,968 @@ func (x *FileBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementFile adds the File interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Dup() *FileBase
+//   - Hash() uint
+//   - Equal(File) bool
+//   - IsNative() bool
+//   - HasUriScheme(string) bool
+//   - GetUriScheme() string
+//   - GetBasename() string
+//   - GetPath() string
+//   - GetUri() string
+//   - GetParseName() string
+//   - GetParent() *FileBase
+//   - PrefixMatches(File) bool
+//   - GetRelativePath(File) string
+//   - ResolveRelativePath(string) *FileBase
+//   - GetChildForDisplayName(string) *FileBase
+//   - EnumerateChildren(string, FileQueryInfoFlags, *Cancellable) *FileEnumerator
+//   - EnumerateChildrenAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EnumerateChildrenFinish(AsyncResult) *FileEnumerator
+//   - QueryInfo(string, FileQueryInfoFlags, *Cancellable) *FileInfo
+//   - QueryInfoAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - QueryInfoFinish(AsyncResult) *FileInfo
+//   - QueryFilesystemInfo(string, *Cancellable) *FileInfo
+//   - QueryFilesystemInfoAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - QueryFilesystemInfoFinish(AsyncResult) *FileInfo
+//   - FindEnclosingMount(*Cancellable) *MountBase
+//   - FindEnclosingMountAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - FindEnclosingMountFinish(AsyncResult) *MountBase
+//   - SetDisplayName(string, *Cancellable) *FileBase
+//   - SetDisplayNameAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - SetDisplayNameFinish(AsyncResult) *FileBase
+//   - QuerySettableAttributes(*Cancellable) *FileAttributeInfoList
+//   - QueryWritableNamespaces(*Cancellable) *FileAttributeInfoList
+//   - SetAttribute(string, FileAttributeType, uintptr, FileQueryInfoFlags, *Cancellable) bool
+//   - SetAttributesFromInfo(*FileInfo, FileQueryInfoFlags, *Cancellable) bool
+//   - SetAttributesAsync(*FileInfo, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - SetAttributesFinish(AsyncResult, **FileInfo) bool
+//   - ReadFn(*Cancellable) *FileInputStream
+//   - ReadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - ReadFinish(AsyncResult) *FileInputStream
+//   - AppendTo(FileCreateFlags, *Cancellable) *FileOutputStream
+//   - AppendToAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - AppendToFinish(AsyncResult) *FileOutputStream
+//   - Create(FileCreateFlags, *Cancellable) *FileOutputStream
+//   - CreateAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - CreateFinish(AsyncResult) *FileOutputStream
+//   - Replace(string, bool, FileCreateFlags, *Cancellable) *FileOutputStream
+//   - ReplaceAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - ReplaceFinish(AsyncResult) *FileOutputStream
+//   - DeleteFile(*Cancellable) bool
+//   - DeleteFileAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - DeleteFileFinish(AsyncResult) bool
+//   - Trash(*Cancellable) bool
+//   - TrashAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - TrashFinish(AsyncResult) bool
+//   - MakeDirectory(*Cancellable) bool
+//   - MakeDirectoryAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - MakeDirectoryFinish(AsyncResult) bool
+//   - MakeSymbolicLink(string, *Cancellable) bool
+//   - MakeSymbolicLinkAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - MakeSymbolicLinkFinish(AsyncResult) bool
+//   - Copy(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+//   - CopyAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+//   - CopyFinish(AsyncResult) bool
+//   - Move(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+//   - MoveAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+//   - MoveFinish(AsyncResult) bool
+//   - MountMountable(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - MountMountableFinish(AsyncResult) *FileBase
+//   - UnmountMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - UnmountMountableFinish(AsyncResult) bool
+//   - EjectMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectMountableFinish(AsyncResult) bool
+//   - MountEnclosingVolume(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - MountEnclosingVolumeFinish(AsyncResult) bool
+//   - MonitorDir(FileMonitorFlags, *Cancellable) *FileMonitor
+//   - MonitorFile(FileMonitorFlags, *Cancellable) *FileMonitor
+//   - OpenReadwrite(*Cancellable) *FileIOStream
+//   - OpenReadwriteAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - OpenReadwriteFinish(AsyncResult) *FileIOStream
+//   - CreateReadwrite(FileCreateFlags, *Cancellable) *FileIOStream
+//   - CreateReadwriteAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - CreateReadwriteFinish(AsyncResult) *FileIOStream
+//   - ReplaceReadwrite(string, bool, FileCreateFlags, *Cancellable) *FileIOStream
+//   - ReplaceReadwriteAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - ReplaceReadwriteFinish(AsyncResult) *FileIOStream
+//   - StartMountable(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - StartMountableFinish(AsyncResult) bool
+//   - StopMountable(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - StopMountableFinish(AsyncResult) bool
+//   - UnmountMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - UnmountMountableWithOperationFinish(AsyncResult) bool
+//   - EjectMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectMountableWithOperationFinish(AsyncResult) bool
+//   - PollMountable(*Cancellable, *AsyncReadyCallback, uintptr)
+//   - PollMountableFinish(AsyncResult) bool
+//   - MeasureDiskUsage(FileMeasureFlags, *Cancellable, *FileMeasureProgressCallback, uintptr, *uint64, *uint64, *uint64) bool
+//   - MeasureDiskUsageAsync(FileMeasureFlags, int, *Cancellable, *FileMeasureProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+//   - MeasureDiskUsageFinish(AsyncResult, *uint64, *uint64, *uint64) bool
+//   - QueryExists(*Cancellable) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see FileIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementFile[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, FileGLibType(), func(iface *FileIface) {
+		if _, ok := any(zero).(interface{ Dup() *FileBase }); ok {
+			iface.OverrideDup(func(self File) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Dup() *FileBase })
+				return impl.Dup()
+			})
+		}
+		if _, ok := any(zero).(interface{ Hash() uint }); ok {
+			iface.OverrideHash(func(self File) uint {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Hash() uint })
+				return impl.Hash()
+			})
+		}
+		if _, ok := any(zero).(interface{ Equal(File) bool }); ok {
+			iface.OverrideEqual(func(self File, arg0 File) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Equal(File) bool })
+				return impl.Equal(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ IsNative() bool }); ok {
+			iface.OverrideIsNative(func(self File) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsNative() bool })
+				return impl.IsNative()
+			})
+		}
+		if _, ok := any(zero).(interface{ HasUriScheme(string) bool }); ok {
+			iface.OverrideHasUriScheme(func(self File, arg0 string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HasUriScheme(string) bool })
+				return impl.HasUriScheme(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetUriScheme() string }); ok {
+			iface.OverrideGetUriScheme(func(self File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetUriScheme() string })
+				return impl.GetUriScheme()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetBasename() string }); ok {
+			iface.OverrideGetBasename(func(self File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetBasename() string })
+				return impl.GetBasename()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetPath() string }); ok {
+			iface.OverrideGetPath(func(self File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetPath() string })
+				return impl.GetPath()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetUri() string }); ok {
+			iface.OverrideGetUri(func(self File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetUri() string })
+				return impl.GetUri()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetParseName() string }); ok {
+			iface.OverrideGetParseName(func(self File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetParseName() string })
+				return impl.GetParseName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetParent() *FileBase }); ok {
+			iface.OverrideGetParent(func(self File) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetParent() *FileBase })
+				return impl.GetParent()
+			})
+		}
+		if _, ok := any(zero).(interface{ PrefixMatches(File) bool }); ok {
+			iface.OverridePrefixMatches(func(self File, arg0 File) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PrefixMatches(File) bool })
+				return impl.PrefixMatches(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetRelativePath(File) string }); ok {
+			iface.OverrideGetRelativePath(func(self File, arg0 File) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetRelativePath(File) string })
+				return impl.GetRelativePath(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ResolveRelativePath(string) *FileBase }); ok {
+			iface.OverrideResolveRelativePath(func(self File, arg0 string) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ResolveRelativePath(string) *FileBase })
+				return impl.ResolveRelativePath(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetChildForDisplayName(string) *FileBase }); ok {
+			iface.OverrideGetChildForDisplayName(func(self File, arg0 string) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetChildForDisplayName(string) *FileBase })
+				return impl.GetChildForDisplayName(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EnumerateChildren(string, FileQueryInfoFlags, *Cancellable) *FileEnumerator
+		}); ok {
+			iface.OverrideEnumerateChildren(func(self File, arg0 string, arg1 FileQueryInfoFlags, arg2 *Cancellable) *FileEnumerator {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EnumerateChildren(string, FileQueryInfoFlags, *Cancellable) *FileEnumerator
+				})
+				return impl.EnumerateChildren(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EnumerateChildrenAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEnumerateChildrenAsync(func(self File, arg0 string, arg1 FileQueryInfoFlags, arg2 int, arg3 *Cancellable, arg4 *AsyncReadyCallback, arg5 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EnumerateChildrenAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EnumerateChildrenAsync(arg0, arg1, arg2, arg3, arg4, arg5)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EnumerateChildrenFinish(AsyncResult) *FileEnumerator
+		}); ok {
+			iface.OverrideEnumerateChildrenFinish(func(self File, arg0 AsyncResult) *FileEnumerator {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EnumerateChildrenFinish(AsyncResult) *FileEnumerator
+				})
+				return impl.EnumerateChildrenFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryInfo(string, FileQueryInfoFlags, *Cancellable) *FileInfo
+		}); ok {
+			iface.OverrideQueryInfo(func(self File, arg0 string, arg1 FileQueryInfoFlags, arg2 *Cancellable) *FileInfo {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryInfo(string, FileQueryInfoFlags, *Cancellable) *FileInfo
+				})
+				return impl.QueryInfo(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryInfoAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideQueryInfoAsync(func(self File, arg0 string, arg1 FileQueryInfoFlags, arg2 int, arg3 *Cancellable, arg4 *AsyncReadyCallback, arg5 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryInfoAsync(string, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.QueryInfoAsync(arg0, arg1, arg2, arg3, arg4, arg5)
+			})
+		}
+		if _, ok := any(zero).(interface{ QueryInfoFinish(AsyncResult) *FileInfo }); ok {
+			iface.OverrideQueryInfoFinish(func(self File, arg0 AsyncResult) *FileInfo {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ QueryInfoFinish(AsyncResult) *FileInfo })
+				return impl.QueryInfoFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryFilesystemInfo(string, *Cancellable) *FileInfo
+		}); ok {
+			iface.OverrideQueryFilesystemInfo(func(self File, arg0 string, arg1 *Cancellable) *FileInfo {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryFilesystemInfo(string, *Cancellable) *FileInfo
+				})
+				return impl.QueryFilesystemInfo(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryFilesystemInfoAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideQueryFilesystemInfoAsync(func(self File, arg0 string, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryFilesystemInfoAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.QueryFilesystemInfoAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ QueryFilesystemInfoFinish(AsyncResult) *FileInfo }); ok {
+			iface.OverrideQueryFilesystemInfoFinish(func(self File, arg0 AsyncResult) *FileInfo {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ QueryFilesystemInfoFinish(AsyncResult) *FileInfo })
+				return impl.QueryFilesystemInfoFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ FindEnclosingMount(*Cancellable) *MountBase }); ok {
+			iface.OverrideFindEnclosingMount(func(self File, arg0 *Cancellable) *MountBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ FindEnclosingMount(*Cancellable) *MountBase })
+				return impl.FindEnclosingMount(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			FindEnclosingMountAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideFindEnclosingMountAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					FindEnclosingMountAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.FindEnclosingMountAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ FindEnclosingMountFinish(AsyncResult) *MountBase }); ok {
+			iface.OverrideFindEnclosingMountFinish(func(self File, arg0 AsyncResult) *MountBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ FindEnclosingMountFinish(AsyncResult) *MountBase })
+				return impl.FindEnclosingMountFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetDisplayName(string, *Cancellable) *FileBase
+		}); ok {
+			iface.OverrideSetDisplayName(func(self File, arg0 string, arg1 *Cancellable) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetDisplayName(string, *Cancellable) *FileBase
+				})
+				return impl.SetDisplayName(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetDisplayNameAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideSetDisplayNameAsync(func(self File, arg0 string, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetDisplayNameAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.SetDisplayNameAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetDisplayNameFinish(AsyncResult) *FileBase }); ok {
+			iface.OverrideSetDisplayNameFinish(func(self File, arg0 AsyncResult) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetDisplayNameFinish(AsyncResult) *FileBase })
+				return impl.SetDisplayNameFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QuerySettableAttributes(*Cancellable) *FileAttributeInfoList
+		}); ok {
+			iface.OverrideQuerySettableAttributes(func(self File, arg0 *Cancellable) *FileAttributeInfoList {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QuerySettableAttributes(*Cancellable) *FileAttributeInfoList
+				})
+				return impl.QuerySettableAttributes(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			QueryWritableNamespaces(*Cancellable) *FileAttributeInfoList
+		}); ok {
+			iface.OverrideQueryWritableNamespaces(func(self File, arg0 *Cancellable) *FileAttributeInfoList {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					QueryWritableNamespaces(*Cancellable) *FileAttributeInfoList
+				})
+				return impl.QueryWritableNamespaces(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetAttribute(string, FileAttributeType, uintptr, FileQueryInfoFlags, *Cancellable) bool
+		}); ok {
+			iface.OverrideSetAttribute(func(self File, arg0 string, arg1 FileAttributeType, arg2 uintptr, arg3 FileQueryInfoFlags, arg4 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetAttribute(string, FileAttributeType, uintptr, FileQueryInfoFlags, *Cancellable) bool
+				})
+				return impl.SetAttribute(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetAttributesFromInfo(*FileInfo, FileQueryInfoFlags, *Cancellable) bool
+		}); ok {
+			iface.OverrideSetAttributesFromInfo(func(self File, arg0 *FileInfo, arg1 FileQueryInfoFlags, arg2 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetAttributesFromInfo(*FileInfo, FileQueryInfoFlags, *Cancellable) bool
+				})
+				return impl.SetAttributesFromInfo(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetAttributesAsync(*FileInfo, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideSetAttributesAsync(func(self File, arg0 *FileInfo, arg1 FileQueryInfoFlags, arg2 int, arg3 *Cancellable, arg4 *AsyncReadyCallback, arg5 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetAttributesAsync(*FileInfo, FileQueryInfoFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.SetAttributesAsync(arg0, arg1, arg2, arg3, arg4, arg5)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetAttributesFinish(AsyncResult, **FileInfo) bool
+		}); ok {
+			iface.OverrideSetAttributesFinish(func(self File, arg0 AsyncResult, arg1 **FileInfo) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetAttributesFinish(AsyncResult, **FileInfo) bool
+				})
+				return impl.SetAttributesFinish(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReadFn(*Cancellable) *FileInputStream
+		}); ok {
+			iface.OverrideReadFn(func(self File, arg0 *Cancellable) *FileInputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReadFn(*Cancellable) *FileInputStream
+				})
+				return impl.ReadFn(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideReadAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.ReadAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReadFinish(AsyncResult) *FileInputStream
+		}); ok {
+			iface.OverrideReadFinish(func(self File, arg0 AsyncResult) *FileInputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReadFinish(AsyncResult) *FileInputStream
+				})
+				return impl.ReadFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AppendTo(FileCreateFlags, *Cancellable) *FileOutputStream
+		}); ok {
+			iface.OverrideAppendTo(func(self File, arg0 FileCreateFlags, arg1 *Cancellable) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AppendTo(FileCreateFlags, *Cancellable) *FileOutputStream
+				})
+				return impl.AppendTo(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AppendToAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideAppendToAsync(func(self File, arg0 FileCreateFlags, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AppendToAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.AppendToAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AppendToFinish(AsyncResult) *FileOutputStream
+		}); ok {
+			iface.OverrideAppendToFinish(func(self File, arg0 AsyncResult) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AppendToFinish(AsyncResult) *FileOutputStream
+				})
+				return impl.AppendToFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Create(FileCreateFlags, *Cancellable) *FileOutputStream
+		}); ok {
+			iface.OverrideCreate(func(self File, arg0 FileCreateFlags, arg1 *Cancellable) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Create(FileCreateFlags, *Cancellable) *FileOutputStream
+				})
+				return impl.Create(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideCreateAsync(func(self File, arg0 FileCreateFlags, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.CreateAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateFinish(AsyncResult) *FileOutputStream
+		}); ok {
+			iface.OverrideCreateFinish(func(self File, arg0 AsyncResult) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateFinish(AsyncResult) *FileOutputStream
+				})
+				return impl.CreateFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Replace(string, bool, FileCreateFlags, *Cancellable) *FileOutputStream
+		}); ok {
+			iface.OverrideReplace(func(self File, arg0 string, arg1 bool, arg2 FileCreateFlags, arg3 *Cancellable) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Replace(string, bool, FileCreateFlags, *Cancellable) *FileOutputStream
+				})
+				return impl.Replace(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReplaceAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideReplaceAsync(func(self File, arg0 string, arg1 bool, arg2 FileCreateFlags, arg3 int, arg4 *Cancellable, arg5 *AsyncReadyCallback, arg6 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReplaceAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.ReplaceAsync(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReplaceFinish(AsyncResult) *FileOutputStream
+		}); ok {
+			iface.OverrideReplaceFinish(func(self File, arg0 AsyncResult) *FileOutputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReplaceFinish(AsyncResult) *FileOutputStream
+				})
+				return impl.ReplaceFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ DeleteFile(*Cancellable) bool }); ok {
+			iface.OverrideDeleteFile(func(self File, arg0 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DeleteFile(*Cancellable) bool })
+				return impl.DeleteFile(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			DeleteFileAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideDeleteFileAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					DeleteFileAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.DeleteFileAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ DeleteFileFinish(AsyncResult) bool }); ok {
+			iface.OverrideDeleteFileFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DeleteFileFinish(AsyncResult) bool })
+				return impl.DeleteFileFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ Trash(*Cancellable) bool }); ok {
+			iface.OverrideTrash(func(self File, arg0 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Trash(*Cancellable) bool })
+				return impl.Trash(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			TrashAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideTrashAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					TrashAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.TrashAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ TrashFinish(AsyncResult) bool }); ok {
+			iface.OverrideTrashFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ TrashFinish(AsyncResult) bool })
+				return impl.TrashFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ MakeDirectory(*Cancellable) bool }); ok {
+			iface.OverrideMakeDirectory(func(self File, arg0 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MakeDirectory(*Cancellable) bool })
+				return impl.MakeDirectory(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MakeDirectoryAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMakeDirectoryAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MakeDirectoryAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.MakeDirectoryAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ MakeDirectoryFinish(AsyncResult) bool }); ok {
+			iface.OverrideMakeDirectoryFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MakeDirectoryFinish(AsyncResult) bool })
+				return impl.MakeDirectoryFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MakeSymbolicLink(string, *Cancellable) bool
+		}); ok {
+			iface.OverrideMakeSymbolicLink(func(self File, arg0 string, arg1 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MakeSymbolicLink(string, *Cancellable) bool
+				})
+				return impl.MakeSymbolicLink(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MakeSymbolicLinkAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMakeSymbolicLinkAsync(func(self File, arg0 string, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MakeSymbolicLinkAsync(string, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.MakeSymbolicLinkAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ MakeSymbolicLinkFinish(AsyncResult) bool }); ok {
+			iface.OverrideMakeSymbolicLinkFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MakeSymbolicLinkFinish(AsyncResult) bool })
+				return impl.MakeSymbolicLinkFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Copy(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+		}); ok {
+			iface.OverrideCopy(func(self File, arg0 File, arg1 FileCopyFlags, arg2 *Cancellable, arg3 *FileProgressCallback, arg4 uintptr) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Copy(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+				})
+				return impl.Copy(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CopyAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideCopyAsync(func(self File, arg0 File, arg1 FileCopyFlags, arg2 int, arg3 *Cancellable, arg4 *FileProgressCallback, arg5 uintptr, arg6 *AsyncReadyCallback, arg7 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CopyAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+				})
+				impl.CopyAsync(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+			})
+		}
+		if _, ok := any(zero).(interface{ CopyFinish(AsyncResult) bool }); ok {
+			iface.OverrideCopyFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CopyFinish(AsyncResult) bool })
+				return impl.CopyFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Move(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+		}); ok {
+			iface.OverrideMove(func(self File, arg0 File, arg1 FileCopyFlags, arg2 *Cancellable, arg3 *FileProgressCallback, arg4 uintptr) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Move(File, FileCopyFlags, *Cancellable, *FileProgressCallback, uintptr) bool
+				})
+				return impl.Move(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MoveAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMoveAsync(func(self File, arg0 File, arg1 FileCopyFlags, arg2 int, arg3 *Cancellable, arg4 *FileProgressCallback, arg5 uintptr, arg6 *AsyncReadyCallback, arg7 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MoveAsync(File, FileCopyFlags, int, *Cancellable, *FileProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+				})
+				impl.MoveAsync(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+			})
+		}
+		if _, ok := any(zero).(interface{ MoveFinish(AsyncResult) bool }); ok {
+			iface.OverrideMoveFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MoveFinish(AsyncResult) bool })
+				return impl.MoveFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MountMountable(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMountMountable(func(self File, arg0 MountMountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MountMountable(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.MountMountable(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ MountMountableFinish(AsyncResult) *FileBase }); ok {
+			iface.OverrideMountMountableFinish(func(self File, arg0 AsyncResult) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MountMountableFinish(AsyncResult) *FileBase })
+				return impl.MountMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			UnmountMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideUnmountMountable(func(self File, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					UnmountMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.UnmountMountable(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnmountMountableFinish(AsyncResult) bool }); ok {
+			iface.OverrideUnmountMountableFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnmountMountableFinish(AsyncResult) bool })
+				return impl.UnmountMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EjectMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEjectMountable(func(self File, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EjectMountable(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EjectMountable(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectMountableFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectMountableFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectMountableFinish(AsyncResult) bool })
+				return impl.EjectMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MountEnclosingVolume(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMountEnclosingVolume(func(self File, arg0 MountMountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MountEnclosingVolume(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.MountEnclosingVolume(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ MountEnclosingVolumeFinish(AsyncResult) bool }); ok {
+			iface.OverrideMountEnclosingVolumeFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MountEnclosingVolumeFinish(AsyncResult) bool })
+				return impl.MountEnclosingVolumeFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MonitorDir(FileMonitorFlags, *Cancellable) *FileMonitor
+		}); ok {
+			iface.OverrideMonitorDir(func(self File, arg0 FileMonitorFlags, arg1 *Cancellable) *FileMonitor {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MonitorDir(FileMonitorFlags, *Cancellable) *FileMonitor
+				})
+				return impl.MonitorDir(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MonitorFile(FileMonitorFlags, *Cancellable) *FileMonitor
+		}); ok {
+			iface.OverrideMonitorFile(func(self File, arg0 FileMonitorFlags, arg1 *Cancellable) *FileMonitor {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MonitorFile(FileMonitorFlags, *Cancellable) *FileMonitor
+				})
+				return impl.MonitorFile(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			OpenReadwrite(*Cancellable) *FileIOStream
+		}); ok {
+			iface.OverrideOpenReadwrite(func(self File, arg0 *Cancellable) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					OpenReadwrite(*Cancellable) *FileIOStream
+				})
+				return impl.OpenReadwrite(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			OpenReadwriteAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideOpenReadwriteAsync(func(self File, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					OpenReadwriteAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.OpenReadwriteAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			OpenReadwriteFinish(AsyncResult) *FileIOStream
+		}); ok {
+			iface.OverrideOpenReadwriteFinish(func(self File, arg0 AsyncResult) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					OpenReadwriteFinish(AsyncResult) *FileIOStream
+				})
+				return impl.OpenReadwriteFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateReadwrite(FileCreateFlags, *Cancellable) *FileIOStream
+		}); ok {
+			iface.OverrideCreateReadwrite(func(self File, arg0 FileCreateFlags, arg1 *Cancellable) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateReadwrite(FileCreateFlags, *Cancellable) *FileIOStream
+				})
+				return impl.CreateReadwrite(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateReadwriteAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideCreateReadwriteAsync(func(self File, arg0 FileCreateFlags, arg1 int, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateReadwriteAsync(FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.CreateReadwriteAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateReadwriteFinish(AsyncResult) *FileIOStream
+		}); ok {
+			iface.OverrideCreateReadwriteFinish(func(self File, arg0 AsyncResult) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateReadwriteFinish(AsyncResult) *FileIOStream
+				})
+				return impl.CreateReadwriteFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReplaceReadwrite(string, bool, FileCreateFlags, *Cancellable) *FileIOStream
+		}); ok {
+			iface.OverrideReplaceReadwrite(func(self File, arg0 string, arg1 bool, arg2 FileCreateFlags, arg3 *Cancellable) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReplaceReadwrite(string, bool, FileCreateFlags, *Cancellable) *FileIOStream
+				})
+				return impl.ReplaceReadwrite(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReplaceReadwriteAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideReplaceReadwriteAsync(func(self File, arg0 string, arg1 bool, arg2 FileCreateFlags, arg3 int, arg4 *Cancellable, arg5 *AsyncReadyCallback, arg6 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReplaceReadwriteAsync(string, bool, FileCreateFlags, int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.ReplaceReadwriteAsync(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ReplaceReadwriteFinish(AsyncResult) *FileIOStream
+		}); ok {
+			iface.OverrideReplaceReadwriteFinish(func(self File, arg0 AsyncResult) *FileIOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ReplaceReadwriteFinish(AsyncResult) *FileIOStream
+				})
+				return impl.ReplaceReadwriteFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			StartMountable(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideStartMountable(func(self File, arg0 DriveStartFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					StartMountable(DriveStartFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.StartMountable(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ StartMountableFinish(AsyncResult) bool }); ok {
+			iface.OverrideStartMountableFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StartMountableFinish(AsyncResult) bool })
+				return impl.StartMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			StopMountable(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideStopMountable(func(self File, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					StopMountable(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.StopMountable(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ StopMountableFinish(AsyncResult) bool }); ok {
+			iface.OverrideStopMountableFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StopMountableFinish(AsyncResult) bool })
+				return impl.StopMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			UnmountMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideUnmountMountableWithOperation(func(self File, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					UnmountMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.UnmountMountableWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnmountMountableWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideUnmountMountableWithOperationFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnmountMountableWithOperationFinish(AsyncResult) bool })
+				return impl.UnmountMountableWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EjectMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEjectMountableWithOperation(func(self File, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EjectMountableWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EjectMountableWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectMountableWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectMountableWithOperationFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectMountableWithOperationFinish(AsyncResult) bool })
+				return impl.EjectMountableWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			PollMountable(*Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverridePollMountable(func(self File, arg0 *Cancellable, arg1 *AsyncReadyCallback, arg2 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					PollMountable(*Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.PollMountable(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ PollMountableFinish(AsyncResult) bool }); ok {
+			iface.OverridePollMountableFinish(func(self File, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PollMountableFinish(AsyncResult) bool })
+				return impl.PollMountableFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MeasureDiskUsage(FileMeasureFlags, *Cancellable, *FileMeasureProgressCallback, uintptr, *uint64, *uint64, *uint64) bool
+		}); ok {
+			iface.OverrideMeasureDiskUsage(func(self File, arg0 FileMeasureFlags, arg1 *Cancellable, arg2 *FileMeasureProgressCallback, arg3 uintptr, arg4 *uint64, arg5 *uint64, arg6 *uint64) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MeasureDiskUsage(FileMeasureFlags, *Cancellable, *FileMeasureProgressCallback, uintptr, *uint64, *uint64, *uint64) bool
+				})
+				return impl.MeasureDiskUsage(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MeasureDiskUsageAsync(FileMeasureFlags, int, *Cancellable, *FileMeasureProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMeasureDiskUsageAsync(func(self File, arg0 FileMeasureFlags, arg1 int, arg2 *Cancellable, arg3 *FileMeasureProgressCallback, arg4 uintptr, arg5 *AsyncReadyCallback, arg6 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MeasureDiskUsageAsync(FileMeasureFlags, int, *Cancellable, *FileMeasureProgressCallback, uintptr, *AsyncReadyCallback, uintptr)
+				})
+				impl.MeasureDiskUsageAsync(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MeasureDiskUsageFinish(AsyncResult, *uint64, *uint64, *uint64) bool
+		}); ok {
+			iface.OverrideMeasureDiskUsageFinish(func(self File, arg0 AsyncResult, arg1 *uint64, arg2 *uint64, arg3 *uint64) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MeasureDiskUsageFinish(AsyncResult, *uint64, *uint64, *uint64) bool
+				})
+				return impl.MeasureDiskUsageFinish(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ QueryExists(*Cancellable) bool }); ok {
+			iface.OverrideQueryExists(func(self File, arg0 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ QueryExists(*Cancellable) bool })
+				return impl.QueryExists(arg0)
+			})
+		}
+	})
+}
+
 // Gets an output stream for appending data to the file.
 // If the file doesn't already exist it is created.
 //
 // By default files created are generally readable by everyone,
-// but if you pass %G_FILE_CREATE_PRIVATE in @flags the file
+// but if you pass [GFileCreatePrivateValue] in flags the file
 // will be made readable only to the current user, to the level that
 // is supported on the target filesystem.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
 // Some file systems don't allow all file names, and may return an
-// %G_IO_ERROR_INVALID_FILENAME error. If the file is a directory the
-// %G_IO_ERROR_IS_DIRECTORY error will be returned. Other errors are
+// [GIoErrorInvalidFilenameValue] error. If the file is a directory the
+// [GIoErrorIsDirectoryValue] error will be returned. Other errors are
 // possible too, and depend on what kind of filesystem the file is on.
 func (x *FileBase) AppendTo(FlagsVar FileCreateFlags, CancellableVar *Cancellable) (*FileOutputStream, error) {
 	var cls *FileOutputStream
@@ -3398,33 +4448,52 @@ func (x *FileBase) AppendTo(FlagsVar FileCreateFlags, CancellableVar *Cancellabl
 	cret := XGFileAppendTo(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronously opens @file for appending.
+// Asynchronously opens file for appending.
 //
-// For more details, see g_file_append_to() which is
+// For more details, see [File.AppendTo] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_append_to_finish() to get the result
+// When the operation is finished, callback will be called.
+// You can then call [File.AppendToFinish] to get the result
 // of the operation.
 func (x *FileBase) AppendToAsync(FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileAppendToAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileAppendToAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file append operation started with
-// g_file_append_to_async().
+// [File.AppendToAsync].
 func (x *FileBase) AppendToFinish(ResVar AsyncResult) (*FileOutputStream, error) {
 	var cls *FileOutputStream
 	var cerr *glib.Error
@@ -3432,24 +4501,27 @@ func (x *FileBase) AppendToFinish(ResVar AsyncResult) (*FileOutputStream, error)
 	cret := XGFileAppendToFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Prepares the file attribute query string for copying to @file.
+// Prepares the file attribute query string for copying to file.
 //
 // This function prepares an attribute query string to be
-// passed to g_file_query_info() to get a list of attributes
-// normally copied with the file (see g_file_copy_attributes()
+// passed to [File.QueryInfo] to get a list of attributes
+// normally copied with the file (see [File.CopyAttributes]
 // for the detailed description). This function is used by the
-// implementation of g_file_copy_attributes() and is useful
+// implementation of [File.CopyAttributes] and is useful
 // when one needs to query and set the attributes in two
 // stages (e.g., for recursive move of a directory).
 func (x *FileBase) BuildAttributeListForCopy(FlagsVar FileCopyFlags, CancellableVar *Cancellable) (string, error) {
@@ -3457,80 +4529,130 @@ func (x *FileBase) BuildAttributeListForCopy(FlagsVar FileCopyFlags, Cancellable
 
 	cret := XGFileBuildAttributeListForCopy(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Copies the file @source to the location specified by @destination.
+// Copies the file source to the location specified by destination.
 // Can not handle recursive copies of directories.
 //
-// If the flag %G_FILE_COPY_OVERWRITE is specified an already
-// existing @destination file is overwritten.
+// If the flag [GFileCopyOverwriteValue] is specified an already
+// existing destination file is overwritten.
 //
-// If the flag %G_FILE_COPY_NOFOLLOW_SYMLINKS is specified then symlinks
+// If the flag [GFileCopyNofollowSymlinksValue] is specified then symlinks
 // will be copied as symlinks, otherwise the target of the
-// @source symlink will be copied.
+// source symlink will be copied.
 //
-// If the flag %G_FILE_COPY_ALL_METADATA is specified then all the metadata
+// If the flag [GFileCopyAllMetadataValue] is specified then all the metadata
 // that is possible to copy is copied, not just the default subset (which,
-// for instance, does not include the owner, see #GFileInfo).
+// for instance, does not include the owner, see [FileInfo]).
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// If @progress_callback is not %NULL, then the operation can be monitored
-// by setting this to a #GFileProgressCallback function.
-// @progress_callback_data will be passed to this function. It is guaranteed
+// If progress_callback is not nil, then the operation can be monitored
+// by setting this to a [FileProgressCallback] function.
+// progress_callback_data will be passed to this function. It is guaranteed
 // that this callback will be called after all data has been transferred with
 // the total number of bytes copied during the operation.
 //
-// If the @source file does not exist, then the %G_IO_ERROR_NOT_FOUND error
-// is returned, independent on the status of the @destination.
+// If the source file does not exist, then the [GIoErrorNotFoundValue] error
+// is returned, independent on the status of the destination.
 //
-// If %G_FILE_COPY_OVERWRITE is not specified and the target exists, then
-// the error %G_IO_ERROR_EXISTS is returned.
+// If [GFileCopyOverwriteValue] is not specified and the target exists, then
+// the error [GIoErrorExistsValue] is returned.
 //
-// If trying to overwrite a file over a directory, the %G_IO_ERROR_IS_DIRECTORY
+// If trying to overwrite a file over a directory, the [GIoErrorIsDirectoryValue]
 // error is returned. If trying to overwrite a directory with a directory the
-// %G_IO_ERROR_WOULD_MERGE error is returned.
+// [GIoErrorWouldMergeValue] error is returned.
 //
 // If the source is a directory and the target does not exist, or
-// %G_FILE_COPY_OVERWRITE is specified and the target is a file, then the
-// %G_IO_ERROR_WOULD_RECURSE error is returned.
+// [GFileCopyOverwriteValue] is specified and the target is a file, then the
+// [GIoErrorWouldRecurseValue] error is returned.
 //
-// If you are interested in copying the #GFile object itself (not the on-disk
-// file), see g_file_dup().
+// If you are interested in copying the [File] object itself (not the on-disk
+// file), see [File.Dup].
 func (x *FileBase) Copy(DestinationVar File, FlagsVar FileCopyFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr) (bool, error) {
 	var cerr *glib.Error
 
-	cret := XGFileCopy(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, &cerr)
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 int64, arg1 int64, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	cret := XGFileCopy(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressCallbackDataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Copies the file @source to the location specified by @destination
-// asynchronously. For details of the behaviour, see g_file_copy().
+// Copies the file source to the location specified by destination
+// asynchronously. For details of the behaviour, see [File.Copy].
 //
-// If @progress_callback is not %NULL, then that function that will be called
-// just like in g_file_copy(). The callback will run in the default main context
-// of the thread calling g_file_copy_async() — the same context as @callback is
+// If progress_callback is not nil, then that function that will be called
+// just like in [File.Copy]. The callback will run in the default main context
+// of the thread calling [File.CopyAsync] — the same context as callback is
 // run in.
 //
-// When the operation is finished, @callback will be called. You can then call
-// g_file_copy_finish() to get the result of the operation.
+// When the operation is finished, callback will be called. You can then call
+// [File.CopyFinish] to get the result of the operation.
+//
+// Deprecated: use [CopyAsyncWithClosures], the version of this function that language bindings are meant to use.
 func (x *FileBase) CopyAsync(DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileCopyAsync(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 int64, arg1 int64, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileCopyAsync(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressCallbackDataVar, CallbackVarRef, UserDataVar)
 
 }
 
-// Version of [method@Gio.File.copy_async] using closures instead of callbacks for
+// Version of [File.CopyAsync] using closures instead of callbacks for
 // easier binding in other languages.
 func (x *FileBase) CopyAsyncWithClosures(DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackClosureVar *gobject.Closure, ReadyCallbackClosureVar *gobject.Closure) {
 
@@ -3538,12 +4660,12 @@ func (x *FileBase) CopyAsyncWithClosures(DestinationVar File, FlagsVar FileCopyF
 
 }
 
-// Copies the file attributes from @source to @destination.
+// Copies the file attributes from source to destination.
 //
 // Normally only a subset of the file attributes are copied,
 // those that are copies in a normal file copy operation
 // (which for instance does not include e.g. owner). However
-// if %G_FILE_COPY_ALL_METADATA is specified in @flags, then
+// if [GFileCopyAllMetadataValue] is specified in flags, then
 // all the metadata that is possible to copy is copied. This
 // is useful when implementing move by copy + delete source.
 func (x *FileBase) CopyAttributes(DestinationVar File, FlagsVar FileCopyFlags, CancellableVar *Cancellable) (bool, error) {
@@ -3551,19 +4673,19 @@ func (x *FileBase) CopyAttributes(DestinationVar File, FlagsVar FileCopyFlags, C
 
 	cret := XGFileCopyAttributes(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Finishes copying the file started with g_file_copy_async().
+// Finishes copying the file started with [File.CopyAsync].
 func (x *FileBase) CopyFinish(ResVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileCopyFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -3573,19 +4695,19 @@ func (x *FileBase) CopyFinish(ResVar AsyncResult) (bool, error) {
 // The file must not already exist.
 //
 // By default files created are generally readable by everyone,
-// but if you pass %G_FILE_CREATE_PRIVATE in @flags the file
+// but if you pass [GFileCreatePrivateValue] in flags the file
 // will be made readable only to the current user, to the level
 // that is supported on the target filesystem.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
 // If a file or directory with this name already exists the
-// %G_IO_ERROR_EXISTS error will be returned. Some file systems don't
-// allow all file names, and may return an %G_IO_ERROR_INVALID_FILENAME
-// error, and if the name is to long %G_IO_ERROR_FILENAME_TOO_LONG will
+// [GIoErrorExistsValue] error will be returned. Some file systems don't
+// allow all file names, and may return an [GIoErrorInvalidFilenameValue]
+// error, and if the name is to long [GIoErrorFilenameTooLongValue] will
 // be returned. Other errors are possible too, and depend on what kind
 // of filesystem the file is on.
 func (x *FileBase) Create(FlagsVar FileCreateFlags, CancellableVar *Cancellable) (*FileOutputStream, error) {
@@ -3595,12 +4717,15 @@ func (x *FileBase) Create(FlagsVar FileCreateFlags, CancellableVar *Cancellable)
 	cret := XGFileCreate(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -3609,20 +4734,36 @@ func (x *FileBase) Create(FlagsVar FileCreateFlags, CancellableVar *Cancellable)
 // Asynchronously creates a new file and returns an output stream
 // for writing to it. The file must not already exist.
 //
-// For more details, see g_file_create() which is
+// For more details, see [File.Create] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_create_finish() to get the result
+// When the operation is finished, callback will be called.
+// You can then call [File.CreateFinish] to get the result
 // of the operation.
 func (x *FileBase) CreateAsync(FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileCreateAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileCreateAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file create operation started with
-// g_file_create_async().
+// [File.CreateAsync].
 func (x *FileBase) CreateFinish(ResVar AsyncResult) (*FileOutputStream, error) {
 	var cls *FileOutputStream
 	var cerr *glib.Error
@@ -3630,12 +4771,15 @@ func (x *FileBase) CreateFinish(ResVar AsyncResult) (*FileOutputStream, error) {
 	cret := XGFileCreateFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -3645,19 +4789,19 @@ func (x *FileBase) CreateFinish(ResVar AsyncResult) (*FileOutputStream, error) {
 // writing to it. The file must not already exist.
 //
 // By default files created are generally readable by everyone,
-// but if you pass %G_FILE_CREATE_PRIVATE in @flags the file
+// but if you pass [GFileCreatePrivateValue] in flags the file
 // will be made readable only to the current user, to the level
 // that is supported on the target filesystem.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
 // If a file or directory with this name already exists, the
-// %G_IO_ERROR_EXISTS error will be returned. Some file systems don't
-// allow all file names, and may return an %G_IO_ERROR_INVALID_FILENAME
-// error, and if the name is too long, %G_IO_ERROR_FILENAME_TOO_LONG
+// [GIoErrorExistsValue] error will be returned. Some file systems don't
+// allow all file names, and may return an [GIoErrorInvalidFilenameValue]
+// error, and if the name is too long, [GIoErrorFilenameTooLongValue]
 // will be returned. Other errors are possible too, and depend on what
 // kind of filesystem the file is on.
 //
@@ -3671,12 +4815,15 @@ func (x *FileBase) CreateReadwrite(FlagsVar FileCreateFlags, CancellableVar *Can
 	cret := XGFileCreateReadwrite(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -3685,20 +4832,36 @@ func (x *FileBase) CreateReadwrite(FlagsVar FileCreateFlags, CancellableVar *Can
 // Asynchronously creates a new file and returns a stream
 // for reading and writing to it. The file must not already exist.
 //
-// For more details, see g_file_create_readwrite() which is
+// For more details, see [File.CreateReadwrite] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_create_readwrite_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.CreateReadwriteFinish] to get
 // the result of the operation.
 func (x *FileBase) CreateReadwriteAsync(FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileCreateReadwriteAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileCreateReadwriteAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file create operation started with
-// g_file_create_readwrite_async().
+// [File.CreateReadwriteAsync].
 func (x *FileBase) CreateReadwriteFinish(ResVar AsyncResult) (*FileIOStream, error) {
 	var cls *FileIOStream
 	var cerr *glib.Error
@@ -3706,79 +4869,97 @@ func (x *FileBase) CreateReadwriteFinish(ResVar AsyncResult) (*FileIOStream, err
 	cret := XGFileCreateReadwriteFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Deletes a file. If the @file is a directory, it will only be
-// deleted if it is empty. This has the same semantics as g_unlink().
+// Deletes a file. If the file is a directory, it will only be
+// deleted if it is empty. This has the same semantics as [glib.Unlink].
 //
-// If @file doesn’t exist, %G_IO_ERROR_NOT_FOUND will be returned. This allows
+// If file doesn’t exist, [GIoErrorNotFoundValue] will be returned. This allows
 // for deletion to be implemented avoiding
-// [time-of-check to time-of-use races](https://en.wikipedia.org/wiki/Time-of-check_to_time-of-use):
-// |[
-// g_autoptr(GError) local_error = NULL;
-// if (!g_file_delete (my_file, my_cancellable, &amp;local_error) &amp;&amp;
-//
-//	  !g_error_matches (local_error, G_IO_ERROR, G_IO_ERROR_NOT_FOUND))
-//	{
-//	  // deletion failed for some reason other than the file not existing:
-//	  // so report the error
-//	  g_warning ("Failed to delete %s: %s",
-//	             g_file_peek_path (my_file), local_error-&gt;message);
-//	}
-//
-// ]|
-//
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// [time-of-check to time-of-use races]:
+//
+//	g_autoptr(GError) local_error = NULL;
+//	if (!g_file_delete (my_file, my_cancellable, &local_error) &&
+//	    !g_error_matches (local_error, G_IO_ERROR, G_IO_ERROR_NOT_FOUND))
+//	  {
+//	    // deletion failed for some reason other than the file not existing:
+//	    // so report the error
+//	    g_warning ("Failed to delete %s: %s",
+//	               g_file_peek_path (my_file), local_error->message);
+//	  }
+//
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
+//
+// [time-of-check to time-of-use races]: https://en.wikipedia.org/wiki/Time-of-check_to_time-of-use
 func (x *FileBase) Delete(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileDelete(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously delete a file. If the @file is a directory, it will
+// Asynchronously delete a file. If the file is a directory, it will
 // only be deleted if it is empty.  This has the same semantics as
-// g_unlink().
+// [glib.Unlink].
 func (x *FileBase) DeleteAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileDeleteAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileDeleteAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes deleting a file started with g_file_delete_async().
+// Finishes deleting a file started with [File.DeleteAsync].
 func (x *FileBase) DeleteFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileDeleteFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Duplicates a #GFile handle. This operation does not duplicate
-// the actual file or directory represented by the #GFile; see
-// g_file_copy() if attempting to copy a file.
+// Duplicates a [File] handle. This operation does not duplicate
+// the actual file or directory represented by the [File]; see
+// [File.Copy] if attempting to copy a file.
 //
-// g_file_dup() is useful when a second handle is needed to the same underlying
-// file, for use in a separate thread (#GFile is not thread-safe). For use
-// within the same thread, use g_object_ref() to increment the existing object’s
+// [File.Dup] is useful when a second handle is needed to the same underlying
+// file, for use in a separate thread ([File] is not thread-safe). For use
+// within the same thread, use [gobject.Object.Ref] to increment the existing object’s
 // reference count.
 //
 // This call does no blocking I/O.
@@ -3796,83 +4977,121 @@ func (x *FileBase) Dup() *FileBase {
 }
 
 // Starts an asynchronous eject on a mountable.
-// When this operation has completed, @callback will be called with
-// @user_user data, and the operation can be finalized with
-// g_file_eject_mountable_finish().
+// When this operation has completed, callback will be called with
+// user_user data, and the operation can be finalized with
+// [File.EjectMountableFinish].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
+//
+// Deprecated: since 2.22. Use [File.EjectMountableWithOperation] instead.
 func (x *FileBase) EjectMountable(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileEjectMountable(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileEjectMountable(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous eject operation started by
-// g_file_eject_mountable().
+// [File.EjectMountable].
+//
+// Deprecated: since 2.22. Use [File.EjectMountableWithOperationFinish]
+//
+//	instead.
 func (x *FileBase) EjectMountableFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileEjectMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Starts an asynchronous eject on a mountable.
-// When this operation has completed, @callback will be called with
-// @user_user data, and the operation can be finalized with
-// g_file_eject_mountable_with_operation_finish().
+// When this operation has completed, callback will be called with
+// user_user data, and the operation can be finalized with
+// [File.EjectMountableWithOperationFinish].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) EjectMountableWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileEjectMountableWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileEjectMountableWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous eject operation started by
-// g_file_eject_mountable_with_operation().
+// [File.EjectMountableWithOperation].
 func (x *FileBase) EjectMountableWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileEjectMountableWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets the requested information about the files in a directory.
-// The result is a #GFileEnumerator object that will give out
-// #GFileInfo objects for all the files in the directory.
+// The result is a [FileEnumerator] object that will give out
+// [FileInfo] objects for all the files in the directory.
 //
-// The @attributes value is a string that specifies the file
+// The attributes value is a string that specifies the file
 // attributes that should be gathered. It is not an error if
 // it's not possible to read a particular requested attribute
-// from a file - it just won't be set. @attributes should
+// from a file - it just won't be set. attributes should
 // be a comma-separated list of attributes or attribute wildcards.
 // The wildcard "*" means all attributes, and a wildcard like
 // "standard::*" means all attributes in the standard namespace.
 // An example attribute query be "standard::*,owner::user".
 // The standard attributes are available as defines, like
-// %G_FILE_ATTRIBUTE_STANDARD_NAME. %G_FILE_ATTRIBUTE_STANDARD_NAME should
-// always be specified if you plan to call g_file_enumerator_get_child() or
-// g_file_enumerator_iterate() on the returned enumerator.
+// [FILE_ATTRIBUTE_STANDARD_NAME]. [FILE_ATTRIBUTE_STANDARD_NAME] should
+// always be specified if you plan to call [FileEnumerator.GetChild] or
+// [FileEnumerator.Iterate] on the returned enumerator.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
-// If the file does not exist, the %G_IO_ERROR_NOT_FOUND error will
-// be returned. If the file is not a directory, the %G_IO_ERROR_NOT_DIRECTORY
+// If the file does not exist, the [GIoErrorNotFoundValue] error will
+// be returned. If the file is not a directory, the [GIoErrorNotDirectoryValue]
 // error will be returned. Other errors are possible too.
 func (x *FileBase) EnumerateChildren(AttributesVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (*FileEnumerator, error) {
 	var cls *FileEnumerator
@@ -3881,35 +5100,54 @@ func (x *FileBase) EnumerateChildren(AttributesVar string, FlagsVar FileQueryInf
 	cret := XGFileEnumerateChildren(x.GoPointer(), AttributesVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileEnumerator{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Asynchronously gets the requested information about the files
-// in a directory. The result is a #GFileEnumerator object that will
-// give out #GFileInfo objects for all the files in the directory.
+// in a directory. The result is a [FileEnumerator] object that will
+// give out [FileInfo] objects for all the files in the directory.
 //
-// For more details, see g_file_enumerate_children() which is
+// For more details, see [File.EnumerateChildren] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called. You can
-// then call g_file_enumerate_children_finish() to get the result of
+// When the operation is finished, callback will be called. You can
+// then call [File.EnumerateChildrenFinish] to get the result of
 // the operation.
 func (x *FileBase) EnumerateChildrenAsync(AttributesVar string, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileEnumerateChildrenAsync(x.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileEnumerateChildrenAsync(x.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an async enumerate children operation.
-// See g_file_enumerate_children_async().
+// See [File.EnumerateChildrenAsync].
 func (x *FileBase) EnumerateChildrenFinish(ResVar AsyncResult) (*FileEnumerator, error) {
 	var cls *FileEnumerator
 	var cerr *glib.Error
@@ -3917,20 +5155,23 @@ func (x *FileBase) EnumerateChildrenFinish(ResVar AsyncResult) (*FileEnumerator,
 	cret := XGFileEnumerateChildrenFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileEnumerator{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Checks if the two given #GFiles refer to the same file.
+// Checks if the two given GFiles refer to the same file.
 //
-// Note that two #GFiles that differ can still refer to the same
+// Note that two GFiles that differ can still refer to the same
 // file on the filesystem due to various forms of filename
 // aliasing.
 //
@@ -3941,15 +5182,15 @@ func (x *FileBase) Equal(File2Var File) bool {
 	return cret
 }
 
-// Gets a #GMount for the #GFile.
+// Gets a [Mount] for the [File].
 //
-// #GMount is returned only for user interesting locations, see
-// #GVolumeMonitor. If the #GFileIface for @file does not have a #mount,
-// @error will be set to %G_IO_ERROR_NOT_FOUND and %NULL #will be returned.
+// [Mount] is returned only for user interesting locations, see
+// [VolumeMonitor]. If the [FileIface] for file does not have a #mount,
+// error will be set to [GIoErrorNotFoundValue] and nil #will be returned.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) FindEnclosingMount(CancellableVar *Cancellable) (*MountBase, error) {
 	var cls *MountBase
 	var cerr *glib.Error
@@ -3957,12 +5198,15 @@ func (x *FileBase) FindEnclosingMount(CancellableVar *Cancellable) (*MountBase,
 	cret := XGFileFindEnclosingMount(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &MountBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -3970,20 +5214,36 @@ func (x *FileBase) FindEnclosingMount(CancellableVar *Cancellable) (*MountBase,
 
 // Asynchronously gets the mount for the file.
 //
-// For more details, see g_file_find_enclosing_mount() which is
+// For more details, see [File.FindEnclosingMount] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_find_enclosing_mount_finish() to
+// When the operation is finished, callback will be called.
+// You can then call [File.FindEnclosingMountFinish] to
 // get the result of the operation.
 func (x *FileBase) FindEnclosingMountAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileFindEnclosingMountAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileFindEnclosingMountAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous find mount request.
-// See g_file_find_enclosing_mount_async().
+// See [File.FindEnclosingMountAsync].
 func (x *FileBase) FindEnclosingMountFinish(ResVar AsyncResult) (*MountBase, error) {
 	var cls *MountBase
 	var cerr *glib.Error
@@ -3991,18 +5251,21 @@ func (x *FileBase) FindEnclosingMountFinish(ResVar AsyncResult) (*MountBase, err
 	cret := XGFileFindEnclosingMountFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &MountBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Gets the base name (the last component of the path) for a given #GFile.
+// Gets the base name (the last component of the path) for a given [File].
 //
 // If called for the top level of a system (such as the filesystem root
 // or a uri like sftp://host/) it will return a single directory separator
@@ -4011,8 +5274,8 @@ func (x *FileBase) FindEnclosingMountFinish(ResVar AsyncResult) (*MountBase, err
 // The base name is a byte string (not UTF-8). It has no defined encoding
 // or rules other than it may not contain zero bytes.  If you want to use
 // filenames in a user interface you should use the display name that you
-// can get by requesting the %G_FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME
-// attribute with g_file_query_info().
+// can get by requesting the [FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME]
+// attribute with [File.QueryInfo].
 //
 // This call does no blocking I/O.
 func (x *FileBase) GetBasename() string {
@@ -4021,10 +5284,10 @@ func (x *FileBase) GetBasename() string {
 	return cret
 }
 
-// Gets a child of @file with basename equal to @name.
+// Gets a child of file with basename equal to name.
 //
 // Note that the file with that specific name might not exist, but
-// you can still have a #GFile that points to it. You can use this
+// you can still have a [File] that points to it. You can use this
 // for instance to create that file.
 //
 // This call does no blocking I/O.
@@ -4041,10 +5304,10 @@ func (x *FileBase) GetChild(NameVar string) *FileBase {
 	return cls
 }
 
-// Gets the child of @file for a given @display_name (i.e. a UTF-8
-// version of the name). If this function fails, it returns %NULL
-// and @error will be set. This is very useful when constructing a
-// #GFile for a new file and the user entered the filename in the
+// Gets the child of file for a given display_name (i.e. a UTF-8
+// version of the name). If this function fails, it returns nil
+// and error will be set. This is very useful when constructing a
+// [File] for a new file and the user entered the filename in the
 // user interface, for instance when you select a directory and
 // type a filename in the file selector.
 //
@@ -4056,20 +5319,23 @@ func (x *FileBase) GetChildForDisplayName(DisplayNameVar string) (*FileBase, err
 	cret := XGFileGetChildForDisplayName(x.GoPointer(), DisplayNameVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Gets the parent directory for the @file.
-// If the @file represents the root directory of the
-// file system, then %NULL will be returned.
+// Gets the parent directory for the file.
+// If the file represents the root directory of the
+// file system, then nil will be returned.
 //
 // This call does no blocking I/O.
 func (x *FileBase) GetParent() *FileBase {
@@ -4085,12 +5351,12 @@ func (x *FileBase) GetParent() *FileBase {
 	return cls
 }
 
-// Gets the parse name of the @file.
+// Gets the parse name of the file.
 // A parse name is a UTF-8 string that describes the
-// file such that one can get the #GFile back using
-// g_file_parse_name().
+// file such that one can get the [File] back using
+// [FileParseName].
 //
-// This is generally used to show the #GFile as a nice
+// This is generally used to show the [File] as a nice
 // full-pathname kind of string in a user interface,
 // like in a location entry.
 //
@@ -4105,7 +5371,7 @@ func (x *FileBase) GetParseName() string {
 	return cret
 }
 
-// Gets the local pathname for #GFile, if one exists. If non-%NULL, this is
+// Gets the local pathname for [File], if one exists. If non-nil, this is
 // guaranteed to be an absolute, canonical path. It might contain symlinks.
 //
 // This call does no blocking I/O.
@@ -4115,7 +5381,7 @@ func (x *FileBase) GetPath() string {
 	return cret
 }
 
-// Gets the path for @descendant relative to @parent.
+// Gets the path for descendant relative to parent.
 //
 // This call does no blocking I/O.
 func (x *FileBase) GetRelativePath(DescendantVar File) string {
@@ -4124,7 +5390,7 @@ func (x *FileBase) GetRelativePath(DescendantVar File) string {
 	return cret
 }
 
-// Gets the URI for the @file.
+// Gets the URI for the file.
 //
 // This call does no blocking I/O.
 func (x *FileBase) GetUri() string {
@@ -4133,15 +5399,15 @@ func (x *FileBase) GetUri() string {
 	return cret
 }
 
-// Gets the URI scheme for a #GFile.
+// Gets the URI scheme for a [File].
 // RFC 3986 decodes the scheme as:
-// |[
-// URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
-// ]|
+//
+//	URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
+//
 // Common schemes include "file", "http", "ftp", etc.
 //
-// The scheme can be different from the one used to construct the #GFile,
-// in that it might be replaced with one that is logically equivalent to the #GFile.
+// The scheme can be different from the one used to construct the [File],
+// in that it might be replaced with one that is logically equivalent to the [File].
 //
 // This call does no blocking I/O.
 func (x *FileBase) GetUriScheme() string {
@@ -4150,38 +5416,38 @@ func (x *FileBase) GetUriScheme() string {
 	return cret
 }
 
-// Checks if @file has a parent, and optionally, if it is @parent.
+// Checks if file has a parent, and optionally, if it is parent.
 //
-// If @parent is %NULL then this function returns %TRUE if @file has any
-// parent at all.  If @parent is non-%NULL then %TRUE is only returned
-// if @file is an immediate child of @parent.
+// If parent is nil then this function returns true if file has any
+// parent at all.  If parent is non-nil then true is only returned
+// if file is an immediate child of parent.
 func (x *FileBase) HasParent(ParentVar File) bool {
 
 	cret := XGFileHasParent(x.GoPointer(), ParentVar.GoPointer())
 	return cret
 }
 
-// Checks whether @file has the prefix specified by @prefix.
+// Checks whether file has the prefix specified by prefix.
 //
-// In other words, if the names of initial elements of @file's
-// pathname match @prefix. Only full pathname elements are matched,
+// In other words, if the names of initial elements of file's
+// pathname match prefix. Only full pathname elements are matched,
 // so a path like /foo is not considered a prefix of /foobar, only
 // of /foo/bar.
 //
-// A #GFile is not a prefix of itself. If you want to check for
-// equality, use g_file_equal().
+// A [File] is not a prefix of itself. If you want to check for
+// equality, use [File.Equal].
 //
 // This call does no I/O, as it works purely on names. As such it can
-// sometimes return %FALSE even if @file is inside a @prefix (from a
-// filesystem point of view), because the prefix of @file is an alias
-// of @prefix.
+// sometimes return false even if file is inside a prefix (from a
+// filesystem point of view), because the prefix of file is an alias
+// of prefix.
 func (x *FileBase) HasPrefix(PrefixVar File) bool {
 
 	cret := XGFileHasPrefix(x.GoPointer(), PrefixVar.GoPointer())
 	return cret
 }
 
-// Checks to see if a #GFile has a given URI scheme.
+// Checks to see if a [File] has a given URI scheme.
 //
 // This call does no blocking I/O.
 func (x *FileBase) HasUriScheme(UriSchemeVar string) bool {
@@ -4190,7 +5456,7 @@ func (x *FileBase) HasUriScheme(UriSchemeVar string) bool {
 	return cret
 }
 
-// Creates a hash value for a #GFile.
+// Creates a hash value for a [File].
 //
 // This call does no blocking I/O.
 func (x *FileBase) Hash() uint {
@@ -4207,7 +5473,7 @@ func (x *FileBase) Hash() uint {
 //
 // On some systems non-native files may be available using the native
 // filesystem via a userspace filesystem (FUSE), in these cases this call
-// will return %FALSE, but g_file_get_path() will still return a native path.
+// will return false, but [File.GetPath] will still return a native path.
 //
 // This call does no blocking I/O.
 func (x *FileBase) IsNative() bool {
@@ -4216,146 +5482,212 @@ func (x *FileBase) IsNative() bool {
 	return cret
 }
 
-// Loads the contents of @file and returns it as #GBytes.
+// Loads the contents of file and returns it as [glib.Bytes].
 //
-// If @file is a resource:// based URI, the resulting bytes will reference the
+// If file is a resource:// based URI, the resulting bytes will reference the
 // embedded resource instead of a copy. Otherwise, this is equivalent to calling
-// g_file_load_contents() and g_bytes_new_take().
+// [File.LoadContents] and [glib.NewBytesTake].
 //
-// For resources, @etag_out will be set to %NULL.
+// For resources, etag_out will be set to nil.
 //
-// The data contained in the resulting #GBytes is always zero-terminated, but
-// this is not included in the #GBytes length. The resulting #GBytes should be
-// freed with g_bytes_unref() when no longer in use.
-func (x *FileBase) LoadBytes(CancellableVar *Cancellable, EtagOutVar *string) (*glib.Bytes, error) {
+// The data contained in the resulting [glib.Bytes] is always zero-terminated, but
+// this is not included in the [glib.Bytes] length. The resulting [glib.Bytes] should be
+// freed with [glib.Bytes.Unref] when no longer in use.
+func (x *FileBase) LoadBytes(CancellableVar *Cancellable, EtagOutVar *string) ([]byte, error) {
 	var cerr *glib.Error
 
 	cret := XGFileLoadBytes(x.GoPointer(), CancellableVar.GoPointer(), EtagOutVar, &cerr)
+	defer cret.Unref()
 	if cerr == nil {
-		return cret, nil
+		return cret.Data(), core.PlatformError
 	}
-	return cret, cerr
+	return cret.Data(), cerr
 
 }
 
-// Asynchronously loads the contents of @file as #GBytes.
+// Asynchronously loads the contents of file as [glib.Bytes].
 //
-// If @file is a resource:// based URI, the resulting bytes will reference the
+// If file is a resource:// based URI, the resulting bytes will reference the
 // embedded resource instead of a copy. Otherwise, this is equivalent to calling
-// g_file_load_contents_async() and g_bytes_new_take().
+// [File.LoadContentsAsync] and [glib.NewBytesTake].
 //
-// @callback should call g_file_load_bytes_finish() to get the result of this
+// callback should call [File.LoadBytesFinish] to get the result of this
 // asynchronous operation.
 //
-// See g_file_load_bytes() for more information.
+// See [File.LoadBytes] for more information.
 func (x *FileBase) LoadBytesAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileLoadBytesAsync(x.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileLoadBytesAsync(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Completes an asynchronous request to g_file_load_bytes_async().
+// Completes an asynchronous request to [File.LoadBytesAsync].
 //
-// For resources, @etag_out will be set to %NULL.
+// For resources, etag_out will be set to nil.
 //
-// The data contained in the resulting #GBytes is always zero-terminated, but
-// this is not included in the #GBytes length. The resulting #GBytes should be
-// freed with g_bytes_unref() when no longer in use.
+// The data contained in the resulting [glib.Bytes] is always zero-terminated, but
+// this is not included in the [glib.Bytes] length. The resulting [glib.Bytes] should be
+// freed with [glib.Bytes.Unref] when no longer in use.
 //
-// See g_file_load_bytes() for more information.
-func (x *FileBase) LoadBytesFinish(ResultVar AsyncResult, EtagOutVar *string) (*glib.Bytes, error) {
+// See [File.LoadBytes] for more information.
+func (x *FileBase) LoadBytesFinish(ResultVar AsyncResult, EtagOutVar *string) ([]byte, error) {
 	var cerr *glib.Error
 
 	cret := XGFileLoadBytesFinish(x.GoPointer(), ResultVar.GoPointer(), EtagOutVar, &cerr)
+	defer cret.Unref()
 	if cerr == nil {
-		return cret, nil
+		return cret.Data(), core.PlatformError
 	}
-	return cret, cerr
+	return cret.Data(), cerr
 
 }
 
 // Loads the content of the file into memory. The data is always
-// zero-terminated, but this is not included in the resultant @length.
-// The returned @contents should be freed with g_free() when no longer
+// zero-terminated, but this is not included in the resultant length.
+// The returned contents should be freed with [glib.Free] when no longer
 // needed.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) LoadContents(CancellableVar *Cancellable, ContentsVar *[]string, LengthVar *uint, EtagOutVar *string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileLoadContents(x.GoPointer(), CancellableVar.GoPointer(), ContentsVar, LengthVar, EtagOutVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Starts an asynchronous load of the @file's contents.
+// Starts an asynchronous load of the file's contents.
 //
-// For more details, see g_file_load_contents() which is
+// For more details, see [File.LoadContents] which is
 // the synchronous version of this call.
 //
-// When the load operation has completed, @callback will be called
-// with @user data. To finish the operation, call
-// g_file_load_contents_finish() with the #GAsyncResult returned by
-// the @callback.
+// When the load operation has completed, callback will be called
+// with user data. To finish the operation, call
+// [File.LoadContentsFinish] with the [AsyncResult] returned by
+// the callback.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) LoadContentsAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileLoadContentsAsync(x.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileLoadContentsAsync(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes an asynchronous load of the @file's contents.
-// The contents are placed in @contents, and @length is set to the
-// size of the @contents string. The @contents should be freed with
-// g_free() when no longer needed. If @etag_out is present, it will be
-// set to the new entity tag for the @file.
+// Finishes an asynchronous load of the file's contents.
+// The contents are placed in contents, and length is set to the
+// size of the contents string. The contents should be freed with
+// [glib.Free] when no longer needed. If etag_out is present, it will be
+// set to the new entity tag for the file.
 func (x *FileBase) LoadContentsFinish(ResVar AsyncResult, ContentsVar *[]string, LengthVar *uint, EtagOutVar *string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileLoadContentsFinish(x.GoPointer(), ResVar.GoPointer(), ContentsVar, LengthVar, EtagOutVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Reads the partial contents of a file. A #GFileReadMoreCallback should
+// Reads the partial contents of a file. A [FileReadMoreCallback] should
 // be used to stop reading from the file when appropriate, else this
-// function will behave exactly as g_file_load_contents_async(). This
-// operation can be finished by g_file_load_partial_contents_finish().
+// function will behave exactly as [File.LoadContentsAsync]. This
+// operation can be finished by [File.LoadPartialContentsFinish].
 //
-// Users of this function should be aware that @user_data is passed to
-// both the @read_more_callback and the @callback.
+// Users of this function should be aware that user_data is passed to
+// both the read_more_callback and the callback.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) LoadPartialContentsAsync(CancellableVar *Cancellable, ReadMoreCallbackVar *FileReadMoreCallback, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileLoadPartialContentsAsync(x.GoPointer(), CancellableVar.GoPointer(), glib.NewCallback(ReadMoreCallbackVar), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var ReadMoreCallbackVarRef uintptr
+	if ReadMoreCallbackVar != nil {
+		ReadMoreCallbackVarPtr := uintptr(unsafe.Pointer(ReadMoreCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ReadMoreCallbackVarPtr); ok {
+			ReadMoreCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 int64, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *ReadMoreCallbackVar
+				return cbFn(core.GoString(arg0), arg1, arg2)
+			}
+			ReadMoreCallbackVarRef = core.NewCallback(fcb)
+			ReadMoreCallbackVarRef = glib.SaveCallbackWithClosure(ReadMoreCallbackVarPtr, ReadMoreCallbackVarRef, ReadMoreCallbackVar)
+		}
+	}
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileLoadPartialContentsAsync(x.GoPointer(), CancellableVar.GoPointer(), ReadMoreCallbackVarRef, CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous partial load operation that was started
-// with g_file_load_partial_contents_async(). The data is always
-// zero-terminated, but this is not included in the resultant @length.
-// The returned @contents should be freed with g_free() when no longer
+// with [File.LoadPartialContentsAsync]. The data is always
+// zero-terminated, but this is not included in the resultant length.
+// The returned contents should be freed with [glib.Free] when no longer
 // needed.
 func (x *FileBase) LoadPartialContentsFinish(ResVar AsyncResult, ContentsVar *[]string, LengthVar *uint, EtagOutVar *string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileLoadPartialContentsFinish(x.GoPointer(), ResVar.GoPointer(), ContentsVar, LengthVar, EtagOutVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -4364,27 +5696,27 @@ func (x *FileBase) LoadPartialContentsFinish(ResVar AsyncResult, ContentsVar *[]
 // Creates a directory.
 //
 // Note that this will only create a child directory
-// of the immediate parent directory of the path or URI given by the #GFile.
-// To recursively create directories, see g_file_make_directory_with_parents().
+// of the immediate parent directory of the path or URI given by the [File].
+// To recursively create directories, see [File.MakeDirectoryWithParents].
 //
 // This function will fail if the parent directory does not exist, setting
-// @error to %G_IO_ERROR_NOT_FOUND. If the file system doesn't support
-// creating directories, this function will fail, setting @error to
-// %G_IO_ERROR_NOT_SUPPORTED. If the directory already exists,
-// [error@Gio.IOErrorEnum.EXISTS] will be returned.
+// error to [GIoErrorNotFoundValue]. If the file system doesn't support
+// creating directories, this function will fail, setting error to
+// [GIoErrorNotSupportedValue]. If the directory already exists,
+// IOErrorEnum.EXISTS will be returned.
 //
-// For a local #GFile the newly created directory will have the default
+// For a local [File] the newly created directory will have the default
 // (current) ownership and permissions of the current process.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) MakeDirectory(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMakeDirectory(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -4393,18 +5725,34 @@ func (x *FileBase) MakeDirectory(CancellableVar *Cancellable) (bool, error) {
 // Asynchronously creates a directory.
 func (x *FileBase) MakeDirectoryAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMakeDirectoryAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileMakeDirectoryAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous directory creation, started with
-// g_file_make_directory_async().
+// [File.MakeDirectoryAsync].
 func (x *FileBase) MakeDirectoryFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMakeDirectoryFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -4412,67 +5760,83 @@ func (x *FileBase) MakeDirectoryFinish(ResultVar AsyncResult) (bool, error) {
 
 // Creates a directory and any parent directories that may not
 // exist similar to 'mkdir -p'. If the file system does not support
-// creating directories, this function will fail, setting @error to
-// %G_IO_ERROR_NOT_SUPPORTED. If the directory itself already exists,
-// this function will fail setting @error to %G_IO_ERROR_EXISTS, unlike
-// the similar g_mkdir_with_parents().
+// creating directories, this function will fail, setting error to
+// [GIoErrorNotSupportedValue]. If the directory itself already exists,
+// this function will fail setting error to [GIoErrorExistsValue], unlike
+// the similar [glib.MkdirWithParents].
 //
-// For a local #GFile the newly created directories will have the default
+// For a local [File] the newly created directories will have the default
 // (current) ownership and permissions of the current process.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) MakeDirectoryWithParents(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMakeDirectoryWithParents(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Creates a symbolic link named @file which contains the string
-// @symlink_value.
+// Creates a symbolic link named file which contains the string
+// symlink_value.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) MakeSymbolicLink(SymlinkValueVar string, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMakeSymbolicLink(x.GoPointer(), SymlinkValueVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously creates a symbolic link named @file which contains the
-// string @symlink_value.
+// Asynchronously creates a symbolic link named file which contains the
+// string symlink_value.
 func (x *FileBase) MakeSymbolicLinkAsync(SymlinkValueVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMakeSymbolicLinkAsync(x.GoPointer(), SymlinkValueVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileMakeSymbolicLinkAsync(x.GoPointer(), SymlinkValueVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous symbolic link creation, started with
-// g_file_make_symbolic_link_async().
+// [File.MakeSymbolicLinkAsync].
 func (x *FileBase) MakeSymbolicLinkFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMakeSymbolicLinkFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Recursively measures the disk usage of @file.
+// Recursively measures the disk usage of file.
 //
 // This is essentially an analog of the 'du' command, but it also
 // reports the number of directories and non-directory files encountered
@@ -4480,46 +5844,94 @@ func (x *FileBase) MakeSymbolicLinkFinish(ResultVar AsyncResult) (bool, error) {
 //
 // By default, errors are only reported against the toplevel file
 // itself.  Errors found while recursing are silently ignored, unless
-// %G_FILE_MEASURE_REPORT_ANY_ERROR is given in @flags.
+// [GFileMeasureReportAnyErrorValue] is given in flags.
 //
-// The returned size, @disk_usage, is in bytes and should be formatted
-// with g_format_size() in order to get something reasonable for showing
+// The returned size, disk_usage, is in bytes and should be formatted
+// with [glib.FormatSize] in order to get something reasonable for showing
 // in a user interface.
 //
-// @progress_callback and @progress_data can be given to request
+// progress_callback and progress_data can be given to request
 // periodic progress updates while scanning.  See the documentation for
-// #GFileMeasureProgressCallback for information about when and how the
+// [FileMeasureProgressCallback] for information about when and how the
 // callback will be invoked.
 func (x *FileBase) MeasureDiskUsage(FlagsVar FileMeasureFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileMeasureProgressCallback, ProgressDataVar uintptr, DiskUsageVar *uint64, NumDirsVar *uint64, NumFilesVar *uint64) (bool, error) {
 	var cerr *glib.Error
 
-	cret := XGFileMeasureDiskUsage(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressDataVar, DiskUsageVar, NumDirsVar, NumFilesVar, &cerr)
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 bool, arg1 uint64, arg2 uint64, arg3 uint64, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	cret := XGFileMeasureDiskUsage(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressDataVar, DiskUsageVar, NumDirsVar, NumFilesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Recursively measures the disk usage of @file.
+// Recursively measures the disk usage of file.
 //
-// This is the asynchronous version of g_file_measure_disk_usage().  See
+// This is the asynchronous version of [File.MeasureDiskUsage].  See
 // there for more information.
 func (x *FileBase) MeasureDiskUsageAsync(FlagsVar FileMeasureFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileMeasureProgressCallback, ProgressDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMeasureDiskUsageAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 bool, arg1 uint64, arg2 uint64, arg3 uint64, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileMeasureDiskUsageAsync(x.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressDataVar, CallbackVarRef, UserDataVar)
 
 }
 
 // Collects the results from an earlier call to
-// g_file_measure_disk_usage_async().  See g_file_measure_disk_usage() for
+// [File.MeasureDiskUsageAsync].  See [File.MeasureDiskUsage] for
 // more information.
 func (x *FileBase) MeasureDiskUsageFinish(ResultVar AsyncResult, DiskUsageVar *uint64, NumDirsVar *uint64, NumFilesVar *uint64) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMeasureDiskUsageFinish(x.GoPointer(), ResultVar.GoPointer(), DiskUsageVar, NumDirsVar, NumFilesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -4528,9 +5940,9 @@ func (x *FileBase) MeasureDiskUsageFinish(ResultVar AsyncResult, DiskUsageVar *u
 // Obtains a file or directory monitor for the given file,
 // depending on the type of the file.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) Monitor(FlagsVar FileMonitorFlags, CancellableVar *Cancellable) (*FileMonitor, error) {
 	var cls *FileMonitor
 	var cerr *glib.Error
@@ -4538,12 +5950,15 @@ func (x *FileBase) Monitor(FlagsVar FileMonitorFlags, CancellableVar *Cancellabl
 	cret := XGFileMonitor(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileMonitor{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -4552,15 +5967,15 @@ func (x *FileBase) Monitor(FlagsVar FileMonitorFlags, CancellableVar *Cancellabl
 // Obtains a directory monitor for the given file.
 // This may fail if directory monitoring is not supported.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// It does not make sense for @flags to contain
-// %G_FILE_MONITOR_WATCH_HARD_LINKS, since hard links can not be made to
+// It does not make sense for flags to contain
+// [GFileMonitorWatchHardLinksValue], since hard links can not be made to
 // directories.  It is not possible to monitor all the files in a
 // directory for changes made via hard links; if you want to do this then
-// you must register individual watches with g_file_monitor().
+// you must register individual watches with [File.Monitor].
 func (x *FileBase) MonitorDirectory(FlagsVar FileMonitorFlags, CancellableVar *Cancellable) (*FileMonitor, error) {
 	var cls *FileMonitor
 	var cerr *glib.Error
@@ -4568,12 +5983,15 @@ func (x *FileBase) MonitorDirectory(FlagsVar FileMonitorFlags, CancellableVar *C
 	cret := XGFileMonitorDirectory(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileMonitor{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -4582,16 +6000,16 @@ func (x *FileBase) MonitorDirectory(FlagsVar FileMonitorFlags, CancellableVar *C
 // Obtains a file monitor for the given file. If no file notification
 // mechanism exists, then regular polling of the file is used.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// If @flags contains %G_FILE_MONITOR_WATCH_HARD_LINKS then the monitor
+// If flags contains [GFileMonitorWatchHardLinksValue] then the monitor
 // will also attempt to report changes made to the file via another
 // filename (ie, a hard link). Without this flag, you can only rely on
-// changes made through the filename contained in @file to be
+// changes made through the filename contained in file to be
 // reported. Using this flag may result in an increase in resource
-// usage, and may not have any effect depending on the #GFileMonitor
+// usage, and may not have any effect depending on the [FileMonitor]
 // backend and/or filesystem type.
 func (x *FileBase) MonitorFile(FlagsVar FileMonitorFlags, CancellableVar *Cancellable) (*FileMonitor, error) {
 	var cls *FileMonitor
@@ -4600,66 +6018,101 @@ func (x *FileBase) MonitorFile(FlagsVar FileMonitorFlags, CancellableVar *Cancel
 	cret := XGFileMonitorFile(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileMonitor{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Starts a @mount_operation, mounting the volume that contains
-// the file @location.
+// Starts a mount_operation, mounting the volume that contains
+// the file location.
 //
-// When this operation has completed, @callback will be called with
-// @user_user data, and the operation can be finalized with
-// g_file_mount_enclosing_volume_finish().
+// When this operation has completed, callback will be called with
+// user_user data, and the operation can be finalized with
+// [File.MountEnclosingVolumeFinish].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) MountEnclosingVolume(FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMountEnclosingVolume(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileMountEnclosingVolume(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a mount operation started by g_file_mount_enclosing_volume().
+// Finishes a mount operation started by [File.MountEnclosingVolume].
 func (x *FileBase) MountEnclosingVolumeFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMountEnclosingVolumeFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Mounts a file of type G_FILE_TYPE_MOUNTABLE.
-// Using @mount_operation, you can request callbacks when, for instance,
+// Using mount_operation, you can request callbacks when, for instance,
 // passwords are needed during authentication.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_mount_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.MountMountableFinish] to get
 // the result of the operation.
 func (x *FileBase) MountMountable(FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMountMountable(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileMountMountable(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a mount operation. See g_file_mount_mountable() for details.
+// Finishes a mount operation. See [File.MountMountable] for details.
 //
 // Finish an asynchronous mount operation that was started
-// with g_file_mount_mountable().
+// with [File.MountMountable].
 func (x *FileBase) MountMountableFinish(ResultVar AsyncResult) (*FileBase, error) {
 	var cls *FileBase
 	var cerr *glib.Error
@@ -4667,77 +6120,130 @@ func (x *FileBase) MountMountableFinish(ResultVar AsyncResult) (*FileBase, error
 	cret := XGFileMountMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Tries to move the file or directory @source to the location specified
-// by @destination. If native move operations are supported then this is
+// Tries to move the file or directory source to the location specified
+// by destination. If native move operations are supported then this is
 // used, otherwise a copy + delete fallback is used. The native
 // implementation may support moving directories (for instance on moves
 // inside the same filesystem), but the fallback code does not.
 //
-// If the flag %G_FILE_COPY_OVERWRITE is specified an already
-// existing @destination file is overwritten.
+// If the flag [GFileCopyOverwriteValue] is specified an already
+// existing destination file is overwritten.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// If @progress_callback is not %NULL, then the operation can be monitored
-// by setting this to a #GFileProgressCallback function.
-// @progress_callback_data will be passed to this function. It is
+// If progress_callback is not nil, then the operation can be monitored
+// by setting this to a [FileProgressCallback] function.
+// progress_callback_data will be passed to this function. It is
 // guaranteed that this callback will be called after all data has been
 // transferred with the total number of bytes copied during the operation.
 //
-// If the @source file does not exist, then the %G_IO_ERROR_NOT_FOUND
-// error is returned, independent on the status of the @destination.
+// If the source file does not exist, then the [GIoErrorNotFoundValue]
+// error is returned, independent on the status of the destination.
 //
-// If %G_FILE_COPY_OVERWRITE is not specified and the target exists,
-// then the error %G_IO_ERROR_EXISTS is returned.
+// If [GFileCopyOverwriteValue] is not specified and the target exists,
+// then the error [GIoErrorExistsValue] is returned.
 //
-// If trying to overwrite a file over a directory, the %G_IO_ERROR_IS_DIRECTORY
+// If trying to overwrite a file over a directory, the [GIoErrorIsDirectoryValue]
 // error is returned. If trying to overwrite a directory with a directory the
-// %G_IO_ERROR_WOULD_MERGE error is returned.
+// [GIoErrorWouldMergeValue] error is returned.
 //
 // If the source is a directory and the target does not exist, or
-// %G_FILE_COPY_OVERWRITE is specified and the target is a file, then
-// the %G_IO_ERROR_WOULD_RECURSE error may be returned (if the native
+// [GFileCopyOverwriteValue] is specified and the target is a file, then
+// the [GIoErrorWouldRecurseValue] error may be returned (if the native
 // move operation isn't available).
 func (x *FileBase) Move(DestinationVar File, FlagsVar FileCopyFlags, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr) (bool, error) {
 	var cerr *glib.Error
 
-	cret := XGFileMove(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, &cerr)
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 int64, arg1 int64, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	cret := XGFileMove(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressCallbackDataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+// Asynchronously moves a file source to the location of destination. For details of the behaviour, see [File.Move].
+//
+// If progress_callback is not nil, then that function that will be called
+// just like in [File.Move]. The callback will run in the default main context
+// of the thread calling [File.MoveAsync] — the same context as callback is
+// run in.
+//
+// When the operation is finished, callback will be called. You can then call
+// [File.MoveFinish] to get the result of the operation.
+//
+// Deprecated: use [MoveAsyncWithClosures], the version of this function that language bindings are meant to use.
+func (x *FileBase) MoveAsync(DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
+
+	var ProgressCallbackVarRef uintptr
+	if ProgressCallbackVar != nil {
+		ProgressCallbackVarPtr := uintptr(unsafe.Pointer(ProgressCallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(ProgressCallbackVarPtr); ok {
+			ProgressCallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 int64, arg1 int64, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ProgressCallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			ProgressCallbackVarRef = core.NewCallback(fcb)
+			ProgressCallbackVarRef = glib.SaveCallbackWithClosure(ProgressCallbackVarPtr, ProgressCallbackVarRef, ProgressCallbackVar)
+		}
+	}
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
 	}
-	return cret, cerr
-
-}
-
-// Asynchronously moves a file @source to the location of @destination. For details of the behaviour, see g_file_move().
-//
-// If @progress_callback is not %NULL, then that function that will be called
-// just like in g_file_move(). The callback will run in the default main context
-// of the thread calling g_file_move_async() — the same context as @callback is
-// run in.
-//
-// When the operation is finished, @callback will be called. You can then call
-// g_file_move_finish() to get the result of the operation.
-func (x *FileBase) MoveAsync(DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackVar *FileProgressCallback, ProgressCallbackDataVar uintptr, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileMoveAsync(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(ProgressCallbackVar), ProgressCallbackDataVar, glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	XGFileMoveAsync(x.GoPointer(), DestinationVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), ProgressCallbackVarRef, ProgressCallbackDataVar, CallbackVarRef, UserDataVar)
 
 }
 
-// Version of [method@Gio.File.move_async] using closures instead of callbacks for
+// Version of [File.MoveAsync] using closures instead of callbacks for
 // easier binding in other languages.
 func (x *FileBase) MoveAsyncWithClosures(DestinationVar File, FlagsVar FileCopyFlags, IoPriorityVar int, CancellableVar *Cancellable, ProgressCallbackClosureVar *gobject.Closure, ReadyCallbackClosureVar *gobject.Closure) {
 
@@ -4746,29 +6252,29 @@ func (x *FileBase) MoveAsyncWithClosures(DestinationVar File, FlagsVar FileCopyF
 }
 
 // Finishes an asynchronous file movement, started with
-// g_file_move_async().
+// [File.MoveAsync].
 func (x *FileBase) MoveFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileMoveFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Opens an existing file for reading and writing. The result is
-// a #GFileIOStream that can be used to read and write the contents
+// a [FileIOStream] that can be used to read and write the contents
 // of the file.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
-// If the file does not exist, the %G_IO_ERROR_NOT_FOUND error will
-// be returned. If the file is a directory, the %G_IO_ERROR_IS_DIRECTORY
+// If the file does not exist, the [GIoErrorNotFoundValue] error will
+// be returned. If the file is a directory, the [GIoErrorIsDirectoryValue]
 // error will be returned. Other errors are possible too, and depend on
 // what kind of filesystem the file is on. Note that in many non-local
 // file cases read and write streams are not supported, so make sure you
@@ -4781,33 +6287,52 @@ func (x *FileBase) OpenReadwrite(CancellableVar *Cancellable) (*FileIOStream, er
 	cret := XGFileOpenReadwrite(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronously opens @file for reading and writing.
+// Asynchronously opens file for reading and writing.
 //
-// For more details, see g_file_open_readwrite() which is
+// For more details, see [File.OpenReadwrite] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_open_readwrite_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.OpenReadwriteFinish] to get
 // the result of the operation.
 func (x *FileBase) OpenReadwriteAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileOpenReadwriteAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileOpenReadwriteAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file read operation started with
-// g_file_open_readwrite_async().
+// [File.OpenReadwriteAsync].
 func (x *FileBase) OpenReadwriteFinish(ResVar AsyncResult) (*FileIOStream, error) {
 	var cls *FileIOStream
 	var cerr *glib.Error
@@ -4815,19 +6340,22 @@ func (x *FileBase) OpenReadwriteFinish(ResVar AsyncResult) (*FileIOStream, error
 	cret := XGFileOpenReadwriteFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Exactly like g_file_get_path(), but caches the result via
-// g_object_set_qdata_full().  This is useful for example in C
+// Exactly like [File.GetPath], but caches the result via
+// [gobject.Object.SetQdataFull].  This is useful for example in C
 // applications which mix `g_file_*` APIs with native ones.  It
 // also avoids an extra duplicated string when possible, so will be
 // generally more efficient.
@@ -4839,42 +6367,58 @@ func (x *FileBase) PeekPath() string {
 	return cret
 }
 
-// Polls a file of type %G_FILE_TYPE_MOUNTABLE.
+// Polls a file of type [GFileTypeMountableValue].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_mount_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.MountMountableFinish] to get
 // the result of the operation.
 func (x *FileBase) PollMountable(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFilePollMountable(x.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFilePollMountable(x.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a poll operation. See g_file_poll_mountable() for details.
+// Finishes a poll operation. See [File.PollMountable] for details.
 //
 // Finish an asynchronous poll operation that was polled
-// with g_file_poll_mountable().
+// with [File.PollMountable].
 func (x *FileBase) PollMountableFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFilePollMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Returns the #GAppInfo that is registered as the default
-// application to handle the file specified by @file.
+// Returns the [AppInfo] that is registered as the default
+// application to handle the file specified by file.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) QueryDefaultHandler(CancellableVar *Cancellable) (*AppInfoBase, error) {
 	var cls *AppInfoBase
 	var cerr *glib.Error
@@ -4882,25 +6426,44 @@ func (x *FileBase) QueryDefaultHandler(CancellableVar *Cancellable) (*AppInfoBas
 	cret := XGFileQueryDefaultHandler(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Async version of g_file_query_default_handler().
+// Async version of [File.QueryDefaultHandler].
 func (x *FileBase) QueryDefaultHandlerAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileQueryDefaultHandlerAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileQueryDefaultHandlerAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a g_file_query_default_handler_async() operation.
+// Finishes a [File.QueryDefaultHandlerAsync] operation.
 func (x *FileBase) QueryDefaultHandlerFinish(ResultVar AsyncResult) (*AppInfoBase, error) {
 	var cls *AppInfoBase
 	var cerr *glib.Error
@@ -4908,12 +6471,15 @@ func (x *FileBase) QueryDefaultHandlerFinish(ResultVar AsyncResult) (*AppInfoBas
 	cret := XGFileQueryDefaultHandlerFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -4921,11 +6487,11 @@ func (x *FileBase) QueryDefaultHandlerFinish(ResultVar AsyncResult) (*AppInfoBas
 
 // Utility function to check if a particular file exists.
 //
-// The fallback implementation of this API is using [method@Gio.File.query_info]
+// The fallback implementation of this API is using [File.QueryInfo]
 // and therefore may do blocking I/O. To asynchronously query the existence
-// of a file, use [method@Gio.File.query_info_async].
+// of a file, use [File.QueryInfoAsync].
 //
-// Note that in many cases it is [racy to first check for file existence](https://en.wikipedia.org/wiki/Time_of_check_to_time_of_use)
+// Note that in many cases it is [racy to first check for file existence]
 // and then execute something based on the outcome of that, because the
 // file might have been created or removed in between the operations. The
 // general approach to handling that is to not check, but just do the
@@ -4936,22 +6502,24 @@ func (x *FileBase) QueryDefaultHandlerFinish(ResultVar AsyncResult) (*AppInfoBas
 // it, and on error create it; and: check if it exists, if not create it.
 // These can both result in two processes creating the file (with perhaps
 // a partially written file as the result). The correct approach is to
-// always try to create the file with g_file_create() which will either
-// atomically create the file or fail with a %G_IO_ERROR_EXISTS error.
+// always try to create the file with [File.Create] which will either
+// atomically create the file or fail with a [GIoErrorExistsValue] error.
 //
 // However, in many cases an existence check is useful in a user interface,
 // for instance to make a menu item sensitive/insensitive, so that you don't
 // have to fool users that something is possible and then just show an error
 // dialog. If you do this, you should make sure to also handle the errors
 // that can happen due to races when you execute the operation.
+//
+// [racy to first check for file existence]: https://en.wikipedia.org/wiki/Time_of_check_to_time_of_use
 func (x *FileBase) QueryExists(CancellableVar *Cancellable) bool {
 
 	cret := XGFileQueryExists(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Utility function to inspect the #GFileType of a file. This is
-// implemented using g_file_query_info() and as such does blocking I/O.
+// Utility function to inspect the [FileType] of a file. This is
+// implemented using [File.QueryInfo] and as such does blocking I/O.
 //
 // The primary use case of this method is to check if a file is
 // a regular file, directory, or symlink.
@@ -4961,29 +6529,29 @@ func (x *FileBase) QueryFileType(FlagsVar FileQueryInfoFlags, CancellableVar *Ca
 	return cret
 }
 
-// Similar to g_file_query_info(), but obtains information
-// about the filesystem the @file is on, rather than the file itself.
+// Similar to [File.QueryInfo], but obtains information
+// about the filesystem the file is on, rather than the file itself.
 // For instance the amount of space available and the type of
 // the filesystem.
 //
-// The @attributes value is a string that specifies the attributes
+// The attributes value is a string that specifies the attributes
 // that should be gathered. It is not an error if it's not possible
 // to read a particular requested attribute from a file - it just
-// won't be set. @attributes should be a comma-separated list of
+// won't be set. attributes should be a comma-separated list of
 // attributes or attribute wildcards. The wildcard "*" means all
 // attributes, and a wildcard like "filesystem::*" means all attributes
 // in the filesystem namespace. The standard namespace for filesystem
 // attributes is "filesystem". Common attributes of interest are
-// %G_FILE_ATTRIBUTE_FILESYSTEM_SIZE (the total size of the filesystem
-// in bytes), %G_FILE_ATTRIBUTE_FILESYSTEM_FREE (number of bytes available),
-// and %G_FILE_ATTRIBUTE_FILESYSTEM_TYPE (type of the filesystem).
+// [FILE_ATTRIBUTE_FILESYSTEM_SIZE] (the total size of the filesystem
+// in bytes), [FILE_ATTRIBUTE_FILESYSTEM_FREE] (number of bytes available),
+// and [FILE_ATTRIBUTE_FILESYSTEM_TYPE] (type of the filesystem).
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
-// If the file does not exist, the %G_IO_ERROR_NOT_FOUND error will
+// If the file does not exist, the [GIoErrorNotFoundValue] error will
 // be returned. Other errors are possible too, and depend on what
 // kind of filesystem the file is on.
 func (x *FileBase) QueryFilesystemInfo(AttributesVar string, CancellableVar *Cancellable) (*FileInfo, error) {
@@ -4993,36 +6561,55 @@ func (x *FileBase) QueryFilesystemInfo(AttributesVar string, CancellableVar *Can
 	cret := XGFileQueryFilesystemInfo(x.GoPointer(), AttributesVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Asynchronously gets the requested information about the filesystem
-// that the specified @file is on. The result is a #GFileInfo object
+// that the specified file is on. The result is a [FileInfo] object
 // that contains key-value attributes (such as type or size for the
 // file).
 //
-// For more details, see g_file_query_filesystem_info() which is the
+// For more details, see [File.QueryFilesystemInfo] which is the
 // synchronous version of this call.
 //
-// When the operation is finished, @callback will be called. You can
-// then call g_file_query_info_finish() to get the result of the
+// When the operation is finished, callback will be called. You can
+// then call [File.QueryInfoFinish] to get the result of the
 // operation.
 func (x *FileBase) QueryFilesystemInfoAsync(AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileQueryFilesystemInfoAsync(x.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileQueryFilesystemInfoAsync(x.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous filesystem info query.
-// See g_file_query_filesystem_info_async().
+// See [File.QueryFilesystemInfoAsync].
 func (x *FileBase) QueryFilesystemInfoFinish(ResVar AsyncResult) (*FileInfo, error) {
 	var cls *FileInfo
 	var cerr *glib.Error
@@ -5030,58 +6617,61 @@ func (x *FileBase) QueryFilesystemInfoFinish(ResVar AsyncResult) (*FileInfo, err
 	cret := XGFileQueryFilesystemInfoFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Gets the requested information about specified @file.
+// Gets the requested information about specified file.
 //
-// The result is a [class@Gio.FileInfo] object that contains key-value
+// The result is a [FileInfo] object that contains key-value
 // attributes (such as the type or size of the file).
 //
-// The @attributes value is a string that specifies the file
+// The attributes value is a string that specifies the file
 // attributes that should be gathered. It is not an error if
 // it’s not possible to read a particular requested attribute
 // from a file — it just won't be set. In particular this means that if a file
 // is inaccessible (due to being in a folder with restrictive permissions), for
-// example, you can expect the returned [class@Gio.FileInfo] to have very few
+// example, you can expect the returned [FileInfo] to have very few
 // attributes set. You should check whether an attribute is set using
-// [method@Gio.FileInfo.has_attribute] before trying to retrieve its value.
+// [FileInfo.HasAttribute] before trying to retrieve its value.
 //
 // It is guaranteed that if any of the following attributes are listed in
-// @attributes, they will always be set in the returned [class@Gio.FileInfo],
+// attributes, they will always be set in the returned [FileInfo],
 // even if the user doesn’t have permissions to access the file:
 //
-//   - [const@Gio.FILE_ATTRIBUTE_STANDARD_NAME]
-//   - [const@Gio.FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME]
+//   - [FILE_ATTRIBUTE_STANDARD_NAME]
+//   - [FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME]
 //
-// @attributes should be a comma-separated list of attributes or attribute
+// attributes should be a comma-separated list of attributes or attribute
 // wildcards. The wildcard `"*"` means all attributes, and a wildcard like
 // `"standard::*"` means all attributes in the standard namespace.
 // An example attribute query might be `"standard::*,owner::user"`.
 // The standard attributes are available as defines, like
-// [const@Gio.FILE_ATTRIBUTE_STANDARD_NAME].
+// [FILE_ATTRIBUTE_STANDARD_NAME].
 //
-// If @cancellable is not `NULL`, then the operation can be cancelled
+// If cancellable is not `NULL`, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error [error@Gio.IOErrorEnum.CANCELLED] will be
+// operation was cancelled, the error IOErrorEnum.CANCELLED will be
 // returned.
 //
 // For symlinks, normally the information about the target of the
 // symlink is returned, rather than information about the symlink
-// itself. However if you pass [flags@Gio.FileQueryInfoFlags.NOFOLLOW_SYMLINKS]
-// in @flags the information about the symlink itself will be returned.
+// itself. However if you pass FileQueryInfoFlags.NOFOLLOW_SYMLINKS
+// in flags the information about the symlink itself will be returned.
 // Also, for symlinks that point to non-existing files the information
 // about the symlink itself will be returned.
 //
-// If the file does not exist, the [error@Gio.IOErrorEnum.NOT_FOUND] error will be
+// If the file does not exist, the IOErrorEnum.NOT_FOUND error will be
 // returned. Other errors are possible too, and depend on what kind of
 // file system the file is on.
 func (x *FileBase) QueryInfo(AttributesVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (*FileInfo, error) {
@@ -5091,34 +6681,53 @@ func (x *FileBase) QueryInfo(AttributesVar string, FlagsVar FileQueryInfoFlags,
 	cret := XGFileQueryInfo(x.GoPointer(), AttributesVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronously gets the requested information about specified @file.
-// The result is a #GFileInfo object that contains key-value attributes
+// Asynchronously gets the requested information about specified file.
+// The result is a [FileInfo] object that contains key-value attributes
 // (such as type or size for the file).
 //
-// For more details, see g_file_query_info() which is the synchronous
+// For more details, see [File.QueryInfo] which is the synchronous
 // version of this call.
 //
-// When the operation is finished, @callback will be called. You can
-// then call g_file_query_info_finish() to get the result of the operation.
+// When the operation is finished, callback will be called. You can
+// then call [File.QueryInfoFinish] to get the result of the operation.
 func (x *FileBase) QueryInfoAsync(AttributesVar string, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileQueryInfoAsync(x.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileQueryInfoAsync(x.GoPointer(), AttributesVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file info query.
-// See g_file_query_info_async().
+// See [File.QueryInfoAsync].
 func (x *FileBase) QueryInfoFinish(ResVar AsyncResult) (*FileInfo, error) {
 	var cls *FileInfo
 	var cerr *glib.Error
@@ -5126,12 +6735,15 @@ func (x *FileBase) QueryInfoFinish(ResVar AsyncResult) (*FileInfo, error) {
 	cret := XGFileQueryInfoFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -5144,15 +6756,15 @@ func (x *FileBase) QueryInfoFinish(ResVar AsyncResult) (*FileInfo, error) {
 // always succeed though, you might get an access failure, or some
 // specific file may not support a specific attribute.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) QuerySettableAttributes(CancellableVar *Cancellable) (*FileAttributeInfoList, error) {
 	var cerr *glib.Error
 
 	cret := XGFileQuerySettableAttributes(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -5162,29 +6774,29 @@ func (x *FileBase) QuerySettableAttributes(CancellableVar *Cancellable) (*FileAt
 // can be created by a user. An example of this is extended
 // attributes (in the "xattr" namespace).
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) QueryWritableNamespaces(CancellableVar *Cancellable) (*FileAttributeInfoList, error) {
 	var cerr *glib.Error
 
 	cret := XGFileQueryWritableNamespaces(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Opens a file for reading. The result is a #GFileInputStream that
+// Opens a file for reading. The result is a [FileInputStream] that
 // can be used to read the contents of the file.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// If the file does not exist, the %G_IO_ERROR_NOT_FOUND error will be
-// returned. If the file is a directory, the %G_IO_ERROR_IS_DIRECTORY
+// If the file does not exist, the [GIoErrorNotFoundValue] error will be
+// returned. If the file is a directory, the [GIoErrorIsDirectoryValue]
 // error will be returned. Other errors are possible too, and depend
 // on what kind of filesystem the file is on.
 func (x *FileBase) Read(CancellableVar *Cancellable) (*FileInputStream, error) {
@@ -5194,33 +6806,52 @@ func (x *FileBase) Read(CancellableVar *Cancellable) (*FileInputStream, error) {
 	cret := XGFileRead(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronously opens @file for reading.
+// Asynchronously opens file for reading.
 //
-// For more details, see g_file_read() which is
+// For more details, see [File.Read] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_read_finish() to get the result
+// When the operation is finished, callback will be called.
+// You can then call [File.ReadFinish] to get the result
 // of the operation.
 func (x *FileBase) ReadAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileReadAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileReadAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file read operation started with
-// g_file_read_async().
+// [File.ReadAsync].
 func (x *FileBase) ReadFinish(ResVar AsyncResult) (*FileInputStream, error) {
 	var cls *FileInputStream
 	var cerr *glib.Error
@@ -5228,12 +6859,15 @@ func (x *FileBase) ReadFinish(ResVar AsyncResult) (*FileInputStream, error) {
 	cret := XGFileReadFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -5250,35 +6884,35 @@ func (x *FileBase) ReadFinish(ResVar AsyncResult) (*FileInputStream, error) {
 // the destination when the stream is closed.
 //
 // By default files created are generally readable by everyone,
-// but if you pass %G_FILE_CREATE_PRIVATE in @flags the file
+// but if you pass [GFileCreatePrivateValue] in flags the file
 // will be made readable only to the current user, to the level that
 // is supported on the target filesystem.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled
+// If cancellable is not nil, then the operation can be cancelled
 // by triggering the cancellable object from another thread. If the
-// operation was cancelled, the error %G_IO_ERROR_CANCELLED will be
+// operation was cancelled, the error [GIoErrorCancelledValue] will be
 // returned.
 //
-// If you pass in a non-%NULL @etag value and @file already exists, then
+// If you pass in a non-nil etag value and file already exists, then
 // this value is compared to the current entity tag of the file, and if
-// they differ an %G_IO_ERROR_WRONG_ETAG error is returned. This
+// they differ an [GIoErrorWrongEtagValue] error is returned. This
 // generally means that the file has been changed since you last read
-// it. You can get the new etag from g_file_output_stream_get_etag()
-// after you've finished writing and closed the #GFileOutputStream. When
-// you load a new file you can use g_file_input_stream_query_info() to
+// it. You can get the new etag from [FileOutputStream.GetEtag]
+// after you've finished writing and closed the [FileOutputStream]. When
+// you load a new file you can use [FileInputStream.QueryInfo] to
 // get the etag of the file.
 //
-// If @make_backup is %TRUE, this function will attempt to make a
+// If make_backup is true, this function will attempt to make a
 // backup of the current file before overwriting it. If this fails
-// a %G_IO_ERROR_CANT_CREATE_BACKUP error will be returned. If you
-// want to replace anyway, try again with @make_backup set to %FALSE.
+// a [GIoErrorCantCreateBackupValue] error will be returned. If you
+// want to replace anyway, try again with make_backup set to false.
 //
-// If the file is a directory the %G_IO_ERROR_IS_DIRECTORY error will
+// If the file is a directory the [GIoErrorIsDirectoryValue] error will
 // be returned, and if the file is some other form of non-regular file
-// then a %G_IO_ERROR_NOT_REGULAR_FILE error will be returned. Some
+// then a [GIoErrorNotRegularFileValue] error will be returned. Some
 // file systems don't allow all file names, and may return an
-// %G_IO_ERROR_INVALID_FILENAME error, and if the name is to long
-// %G_IO_ERROR_FILENAME_TOO_LONG will be returned. Other errors are
+// [GIoErrorInvalidFilenameValue] error, and if the name is to long
+// [GIoErrorFilenameTooLongValue] will be returned. Other errors are
 // possible too, and depend on what kind of filesystem the file is on.
 func (x *FileBase) Replace(EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, CancellableVar *Cancellable) (*FileOutputStream, error) {
 	var cls *FileOutputStream
@@ -5290,12 +6924,15 @@ func (x *FileBase) Replace(EtagVar *string, MakeBackupVar bool, FlagsVar FileCre
 	cret := XGFileReplace(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -5304,36 +6941,52 @@ func (x *FileBase) Replace(EtagVar *string, MakeBackupVar bool, FlagsVar FileCre
 // Asynchronously overwrites the file, replacing the contents,
 // possibly creating a backup copy of the file first.
 //
-// For more details, see g_file_replace() which is
+// For more details, see [File.Replace] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_replace_finish() to get the result
+// When the operation is finished, callback will be called.
+// You can then call [File.ReplaceFinish] to get the result
 // of the operation.
 func (x *FileBase) ReplaceAsync(EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
 	EtagVarPtr := core.GStrdupNullable(EtagVar)
 	defer core.GFreeNullable(EtagVarPtr)
 
-	XGFileReplaceAsync(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	XGFileReplaceAsync(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Replaces the contents of @file with @contents of @length bytes.
+// Replaces the contents of file with contents of length bytes.
 //
-// If @etag is specified (not %NULL), any existing file must have that etag,
-// or the error %G_IO_ERROR_WRONG_ETAG will be returned.
+// If etag is specified (not nil), any existing file must have that etag,
+// or the error [GIoErrorWrongEtagValue] will be returned.
 //
-// If @make_backup is %TRUE, this function will attempt to make a backup
-// of @file. Internally, it uses g_file_replace(), so will try to replace the
+// If make_backup is true, this function will attempt to make a backup
+// of file. Internally, it uses [File.Replace], so will try to replace the
 // file contents in the safest way possible. For example, atomic renames are
 // used when replacing local files’ contents.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// The returned @new_etag can be used to verify that the file hasn't
+// The returned new_etag can be used to verify that the file hasn't
 // changed the next time it is saved over.
 func (x *FileBase) ReplaceContents(ContentsVar string, LengthVar uint, EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, NewEtagVar *string, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
@@ -5343,73 +6996,105 @@ func (x *FileBase) ReplaceContents(ContentsVar string, LengthVar uint, EtagVar *
 
 	cret := XGFileReplaceContents(x.GoPointer(), ContentsVar, LengthVar, EtagVarPtr, MakeBackupVar, FlagsVar, NewEtagVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Starts an asynchronous replacement of @file with the given
-// @contents of @length bytes. @etag will replace the document's
+// Starts an asynchronous replacement of file with the given
+// contents of length bytes. etag will replace the document's
 // current entity tag.
 //
-// When this operation has completed, @callback will be called with
-// @user_user data, and the operation can be finalized with
-// g_file_replace_contents_finish().
+// When this operation has completed, callback will be called with
+// user_user data, and the operation can be finalized with
+// [File.ReplaceContentsFinish].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// If @make_backup is %TRUE, this function will attempt to
-// make a backup of @file.
+// If make_backup is true, this function will attempt to
+// make a backup of file.
 //
-// Note that no copy of @contents will be made, so it must stay valid
-// until @callback is called. See g_file_replace_contents_bytes_async()
-// for a #GBytes version that will automatically hold a reference to the
+// Note that no copy of contents will be made, so it must stay valid
+// until callback is called. See [File.ReplaceContentsBytesAsync]
+// for a [glib.Bytes] version that will automatically hold a reference to the
 // contents (without copying) for the duration of the call.
 func (x *FileBase) ReplaceContentsAsync(ContentsVar string, LengthVar uint, EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
 	EtagVarPtr := core.GStrdupNullable(EtagVar)
 	defer core.GFreeNullable(EtagVarPtr)
 
-	XGFileReplaceContentsAsync(x.GoPointer(), ContentsVar, LengthVar, EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	XGFileReplaceContentsAsync(x.GoPointer(), ContentsVar, LengthVar, EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Same as g_file_replace_contents_async() but takes a #GBytes input instead.
-// This function will keep a ref on @contents until the operation is done.
-// Unlike g_file_replace_contents_async() this allows forgetting about the
+// Same as [File.ReplaceContentsAsync] but takes a [glib.Bytes] input instead.
+// This function will keep a ref on contents until the operation is done.
+// Unlike [File.ReplaceContentsAsync] this allows forgetting about the
 // content without waiting for the callback.
 //
-// When this operation has completed, @callback will be called with
-// @user_user data, and the operation can be finalized with
-// g_file_replace_contents_finish().
+// When this operation has completed, callback will be called with
+// user_user data, and the operation can be finalized with
+// [File.ReplaceContentsFinish].
 func (x *FileBase) ReplaceContentsBytesAsync(ContentsVar *glib.Bytes, EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
 	EtagVarPtr := core.GStrdupNullable(EtagVar)
 	defer core.GFreeNullable(EtagVarPtr)
 
-	XGFileReplaceContentsBytesAsync(x.GoPointer(), ContentsVar, EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	XGFileReplaceContentsBytesAsync(x.GoPointer(), ContentsVar, EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes an asynchronous replace of the given @file. See
-// g_file_replace_contents_async(). Sets @new_etag to the new entity
+// Finishes an asynchronous replace of the given file. See
+// [File.ReplaceContentsAsync]. Sets new_etag to the new entity
 // tag for the document, if present.
 func (x *FileBase) ReplaceContentsFinish(ResVar AsyncResult, NewEtagVar *string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileReplaceContentsFinish(x.GoPointer(), ResVar.GoPointer(), NewEtagVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Finishes an asynchronous file replace operation started with
-// g_file_replace_async().
+// [File.ReplaceAsync].
 func (x *FileBase) ReplaceFinish(ResVar AsyncResult) (*FileOutputStream, error) {
 	var cls *FileOutputStream
 	var cerr *glib.Error
@@ -5417,12 +7102,15 @@ func (x *FileBase) ReplaceFinish(ResVar AsyncResult) (*FileOutputStream, error)
 	cret := XGFileReplaceFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileOutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -5432,7 +7120,7 @@ func (x *FileBase) ReplaceFinish(ResVar AsyncResult) (*FileOutputStream, error)
 // possibly creating a backup copy of the file first. If the file doesn't
 // exist, it will be created.
 //
-// For details about the behaviour, see g_file_replace() which does the
+// For details about the behaviour, see [File.Replace] which does the
 // same thing but returns an output stream only.
 //
 // Note that in many non-local file cases read and write streams are not
@@ -5448,12 +7136,15 @@ func (x *FileBase) ReplaceReadwrite(EtagVar *string, MakeBackupVar bool, FlagsVa
 	cret := XGFileReplaceReadwrite(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -5463,23 +7154,39 @@ func (x *FileBase) ReplaceReadwrite(EtagVar *string, MakeBackupVar bool, FlagsVa
 // replacing the contents, possibly creating a backup copy
 // of the file first.
 //
-// For more details, see g_file_replace_readwrite() which is
+// For more details, see [File.ReplaceReadwrite] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_replace_readwrite_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.ReplaceReadwriteFinish] to get
 // the result of the operation.
 func (x *FileBase) ReplaceReadwriteAsync(EtagVar *string, MakeBackupVar bool, FlagsVar FileCreateFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
 	EtagVarPtr := core.GStrdupNullable(EtagVar)
 	defer core.GFreeNullable(EtagVarPtr)
 
-	XGFileReplaceReadwriteAsync(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	XGFileReplaceReadwriteAsync(x.GoPointer(), EtagVarPtr, MakeBackupVar, FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file replace operation started with
-// g_file_replace_readwrite_async().
+// [File.ReplaceReadwriteAsync].
 func (x *FileBase) ReplaceReadwriteFinish(ResVar AsyncResult) (*FileIOStream, error) {
 	var cls *FileIOStream
 	var cerr *glib.Error
@@ -5487,23 +7194,26 @@ func (x *FileBase) ReplaceReadwriteFinish(ResVar AsyncResult) (*FileIOStream, er
 	cret := XGFileReplaceReadwriteFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileIOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Resolves a relative path for @file to an absolute path.
+// Resolves a relative path for file to an absolute path.
 //
 // This call does no blocking I/O.
 //
-// If the @relative_path is an absolute path name, the resolution
-// is done absolutely (without taking @file path as base).
+// If the relative_path is an absolute path name, the resolution
+// is done absolutely (without taking file path as base).
 func (x *FileBase) ResolveRelativePath(RelativePathVar string) *FileBase {
 	var cls *FileBase
 
@@ -5517,192 +7227,208 @@ func (x *FileBase) ResolveRelativePath(RelativePathVar string) *FileBase {
 	return cls
 }
 
-// Sets an attribute in the file with attribute name @attribute to @value_p.
+// Sets an attribute in the file with attribute name attribute to value_p.
 //
-// Some attributes can be unset by setting @type to
-// %G_FILE_ATTRIBUTE_TYPE_INVALID and @value_p to %NULL.
+// Some attributes can be unset by setting type to
+// [GFileAttributeTypeInvalidValue] and value_p to nil.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttribute(AttributeVar string, TypeVar FileAttributeType, ValuePVar uintptr, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttribute(x.GoPointer(), AttributeVar, TypeVar, ValuePVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING to @value.
-// If @attribute is of a different type, this operation will fail,
-// returning %FALSE.
+// Sets attribute of type [GFileAttributeTypeByteStringValue] to value.
+// If attribute is of a different type, this operation will fail,
+// returning false.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeByteString(AttributeVar string, ValueVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeByteString(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_INT32 to @value.
-// If @attribute is of a different type, this operation will fail.
+// Sets attribute of type [GFileAttributeTypeInt32Value] to value.
+// If attribute is of a different type, this operation will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeInt32(AttributeVar string, ValueVar int32, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeInt32(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_INT64 to @value.
-// If @attribute is of a different type, this operation will fail.
+// Sets attribute of type [GFileAttributeTypeInt64Value] to value.
+// If attribute is of a different type, this operation will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeInt64(AttributeVar string, ValueVar int64, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeInt64(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_STRING to @value.
-// If @attribute is of a different type, this operation will fail.
+// Sets attribute of type [GFileAttributeTypeStringValue] to value.
+// If attribute is of a different type, this operation will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeString(AttributeVar string, ValueVar string, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeString(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_UINT32 to @value.
-// If @attribute is of a different type, this operation will fail.
+// Sets attribute of type [GFileAttributeTypeUint32Value] to value.
+// If attribute is of a different type, this operation will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeUint32(AttributeVar string, ValueVar uint32, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeUint32(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Sets @attribute of type %G_FILE_ATTRIBUTE_TYPE_UINT64 to @value.
-// If @attribute is of a different type, this operation will fail.
+// Sets attribute of type [GFileAttributeTypeUint64Value] to value.
+// If attribute is of a different type, this operation will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributeUint64(AttributeVar string, ValueVar uint64, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributeUint64(x.GoPointer(), AttributeVar, ValueVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously sets the attributes of @file with @info.
+// Asynchronously sets the attributes of file with info.
 //
-// For more details, see g_file_set_attributes_from_info(),
+// For more details, see [File.SetAttributesFromInfo],
 // which is the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_set_attributes_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.SetAttributesFinish] to get
 // the result of the operation.
 func (x *FileBase) SetAttributesAsync(InfoVar *FileInfo, FlagsVar FileQueryInfoFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileSetAttributesAsync(x.GoPointer(), InfoVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileSetAttributesAsync(x.GoPointer(), InfoVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes setting an attribute started in g_file_set_attributes_async().
+// Finishes setting an attribute started in [File.SetAttributesAsync].
 func (x *FileBase) SetAttributesFinish(ResultVar AsyncResult, InfoVar **FileInfo) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributesFinish(x.GoPointer(), ResultVar.GoPointer(), InfoVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Tries to set all attributes in the #GFileInfo on the target
+// Tries to set all attributes in the [FileInfo] on the target
 // values, not stopping on the first error.
 //
-// If there is any error during this operation then @error will
+// If there is any error during this operation then error will
 // be set to the first error. Error on particular fields are flagged
 // by setting the "status" field in the attribute value to
-// %G_FILE_ATTRIBUTE_STATUS_ERROR_SETTING, which means you can
+// [GFileAttributeStatusErrorSettingValue], which means you can
 // also detect further errors.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetAttributesFromInfo(InfoVar *FileInfo, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileSetAttributesFromInfo(x.GoPointer(), InfoVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Renames @file to the specified display name.
+// Renames file to the specified display name.
 //
 // The display name is converted from UTF-8 to the correct encoding
-// for the target filesystem if possible and the @file is renamed to this.
+// for the target filesystem if possible and the file is renamed to this.
 //
 // If you want to implement a rename operation in the user interface the
-// edit name (%G_FILE_ATTRIBUTE_STANDARD_EDIT_NAME) should be used as the
+// edit name ([FILE_ATTRIBUTE_STANDARD_EDIT_NAME]) should be used as the
 // initial value in the rename widget, and then the result after editing
-// should be passed to g_file_set_display_name().
+// should be passed to [File.SetDisplayName].
 //
 // On success the resulting converted filename is returned.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) SetDisplayName(DisplayNameVar string, CancellableVar *Cancellable) (*FileBase, error) {
 	var cls *FileBase
 	var cerr *glib.Error
@@ -5710,33 +7436,52 @@ func (x *FileBase) SetDisplayName(DisplayNameVar string, CancellableVar *Cancell
 	cret := XGFileSetDisplayName(x.GoPointer(), DisplayNameVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronously sets the display name for a given #GFile.
+// Asynchronously sets the display name for a given [File].
 //
-// For more details, see g_file_set_display_name() which is
+// For more details, see [File.SetDisplayName] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_set_display_name_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.SetDisplayNameFinish] to get
 // the result of the operation.
 func (x *FileBase) SetDisplayNameAsync(DisplayNameVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileSetDisplayNameAsync(x.GoPointer(), DisplayNameVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileSetDisplayNameAsync(x.GoPointer(), DisplayNameVar, IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes setting a display name started with
-// g_file_set_display_name_async().
+// [File.SetDisplayNameAsync].
 func (x *FileBase) SetDisplayNameFinish(ResVar AsyncResult) (*FileBase, error) {
 	var cls *FileBase
 	var cerr *glib.Error
@@ -5744,128 +7489,179 @@ func (x *FileBase) SetDisplayNameFinish(ResVar AsyncResult) (*FileBase, error) {
 	cret := XGFileSetDisplayNameFinish(x.GoPointer(), ResVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Starts a file of type %G_FILE_TYPE_MOUNTABLE.
-// Using @start_operation, you can request callbacks when, for instance,
+// Starts a file of type [GFileTypeMountableValue].
+// Using start_operation, you can request callbacks when, for instance,
 // passwords are needed during authentication.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_mount_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.MountMountableFinish] to get
 // the result of the operation.
 func (x *FileBase) StartMountable(FlagsVar DriveStartFlags, StartOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileStartMountable(x.GoPointer(), FlagsVar, StartOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileStartMountable(x.GoPointer(), FlagsVar, StartOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a start operation. See g_file_start_mountable() for details.
+// Finishes a start operation. See [File.StartMountable] for details.
 //
 // Finish an asynchronous start operation that was started
-// with g_file_start_mountable().
+// with [File.StartMountable].
 func (x *FileBase) StartMountableFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileStartMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Stops a file of type %G_FILE_TYPE_MOUNTABLE.
+// Stops a file of type [GFileTypeMountableValue].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_stop_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.StopMountableFinish] to get
 // the result of the operation.
 func (x *FileBase) StopMountable(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileStopMountable(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileStopMountable(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes a stop operation, see g_file_stop_mountable() for details.
+// Finishes a stop operation, see [File.StopMountable] for details.
 //
 // Finish an asynchronous stop operation that was started
-// with g_file_stop_mountable().
+// with [File.StopMountable].
 func (x *FileBase) StopMountableFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileStopMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Checks if @file supports thread-default main contexts
-// (see [method@GLib.MainContext.push_thread_default])
-// If this returns %FALSE, you cannot perform asynchronous operations on
-// @file in a thread that has a thread-default context.
+// Checks if file supports thread-default main contexts
+// (see [glib.MainContext.PushThreadDefault])
+// If this returns false, you cannot perform asynchronous operations on
+// file in a thread that has a thread-default context.
 func (x *FileBase) SupportsThreadContexts() bool {
 
 	cret := XGFileSupportsThreadContexts(x.GoPointer())
 	return cret
 }
 
-// Sends @file to the "Trashcan", if possible. This is similar to
+// Sends file to the "Trashcan", if possible. This is similar to
 // deleting it, but the user can recover it before emptying the trashcan.
 // Trashing is disabled for system mounts by default (see
 // g_unix_mount_entry_is_system_internal()), so this call can return the
-// %G_IO_ERROR_NOT_SUPPORTED error. Since GLib 2.66, the `x-gvfs-notrash` unix
-// mount option can be used to disable g_file_trash() support for particular
-// mounts, the %G_IO_ERROR_NOT_SUPPORTED error will be returned in that case.
+// [GIoErrorNotSupportedValue] error. Since GLib 2.66, the `x-gvfs-notrash` unix
+// mount option can be used to disable [File.Trash] support for particular
+// mounts, the [GIoErrorNotSupportedValue] error will be returned in that case.
 // Since 2.82, the `x-gvfs-trash` unix mount option can be used to enable
-// g_file_trash() support for particular system mounts.
+// [File.Trash] support for particular system mounts.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileBase) Trash(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileTrash(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Asynchronously sends @file to the Trash location, if possible.
+// Asynchronously sends file to the Trash location, if possible.
 func (x *FileBase) TrashAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileTrashAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileTrashAsync(x.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an asynchronous file trashing operation, started with
-// g_file_trash_async().
+// [File.TrashAsync].
 func (x *FileBase) TrashFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileTrashFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -5873,60 +7669,98 @@ func (x *FileBase) TrashFinish(ResultVar AsyncResult) (bool, error) {
 
 // Unmounts a file of type G_FILE_TYPE_MOUNTABLE.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_unmount_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.UnmountMountableFinish] to get
 // the result of the operation.
+//
+// Deprecated: since 2.22. Use [File.UnmountMountableWithOperation] instead.
 func (x *FileBase) UnmountMountable(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileUnmountMountable(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileUnmountMountable(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes an unmount operation, see g_file_unmount_mountable() for details.
+// Finishes an unmount operation, see [File.UnmountMountable] for details.
 //
 // Finish an asynchronous unmount operation that was started
-// with g_file_unmount_mountable().
+// with [File.UnmountMountable].
+//
+// Deprecated: since 2.22. Use [File.UnmountMountableWithOperationFinish]
+//
+//	instead.
 func (x *FileBase) UnmountMountableFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileUnmountMountableFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Unmounts a file of type %G_FILE_TYPE_MOUNTABLE.
+// Unmounts a file of type [GFileTypeMountableValue].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_file_unmount_mountable_finish() to get
+// When the operation is finished, callback will be called.
+// You can then call [File.UnmountMountableFinish] to get
 // the result of the operation.
 func (x *FileBase) UnmountMountableWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGFileUnmountMountableWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGFileUnmountMountableWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an unmount operation,
-// see g_file_unmount_mountable_with_operation() for details.
+// see [File.UnmountMountableWithOperation] for details.
 //
 // Finish an asynchronous unmount operation that was started
-// with g_file_unmount_mountable_with_operation().
+// with [File.UnmountMountableWithOperation].
 func (x *FileBase) UnmountMountableWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGFileUnmountMountableWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -6066,11 +7900,11 @@ var XGFileUnmountMountableWithOperationFinish func(uintptr, uintptr, **glib.Erro
 
 var xFileNewBuildFilenamev func([]string) uintptr
 
-// Constructs a #GFile from a vector of elements using the correct
+// Constructs a [File] from a vector of elements using the correct
 // separator for filenames.
 //
-// Using this function is equivalent to calling g_build_filenamev(),
-// followed by g_file_new_for_path() on the result.
+// Using this function is equivalent to calling [glib.BuildFilenamev],
+// followed by [FileNewForPath] on the result.
 func FileNewBuildFilenamev(ArgsVar []string) *FileBase {
 	var cls *FileBase
 
@@ -6086,20 +7920,20 @@ func FileNewBuildFilenamev(ArgsVar []string) *FileBase {
 
 var xFileNewForCommandlineArg func(string) uintptr
 
-// Creates a #GFile with the given argument from the command line.
-// The value of @arg can be either a URI, an absolute path or a
+// Creates a [File] with the given argument from the command line.
+// The value of arg can be either a URI, an absolute path or a
 // relative path resolved relative to the current working directory.
 // This operation never fails, but the returned object might not
-// support any I/O operation if @arg points to a malformed path.
+// support any I/O operation if arg points to a malformed path.
 //
 // Note that on Windows, this function expects its argument to be in
 // UTF-8 -- not the system code page.  This means that you
 // should not use this function with string from argv as it is passed
 // to main().  g_win32_get_command_line() will return a UTF-8 version of
-// the commandline.  #GApplication also uses UTF-8 but
-// g_application_command_line_create_file_for_arg() may be more useful
+// the commandline.  [Application] also uses UTF-8 but
+// [ApplicationCommandLine.CreateFileForArg] may be more useful
 // for you there.  It is also always possible to use this function with
-// #GOptionContext arguments of type %G_OPTION_ARG_FILENAME.
+// [glib.OptionContext] arguments of type [glib.GOptionArgFilenameValue].
 func FileNewForCommandlineArg(ArgVar string) *FileBase {
 	var cls *FileBase
 
@@ -6115,9 +7949,9 @@ func FileNewForCommandlineArg(ArgVar string) *FileBase {
 
 var xFileNewForCommandlineArgAndCwd func(string, string) uintptr
 
-// Creates a #GFile with the given argument from the command line.
+// Creates a [File] with the given argument from the command line.
 //
-// This function is similar to g_file_new_for_commandline_arg() except
+// This function is similar to [FileNewForCommandlineArg] except
 // that it allows for passing the current working directory as an
 // argument instead of using the current working directory of the
 // process.
@@ -6125,7 +7959,7 @@ var xFileNewForCommandlineArgAndCwd func(string, string) uintptr
 // This is useful if the commandline argument was given in a context
 // other than the invocation of the current process.
 //
-// See also g_application_command_line_create_file_for_arg().
+// See also [ApplicationCommandLine.CreateFileForArg].
 func FileNewForCommandlineArgAndCwd(ArgVar string, CwdVar string) *FileBase {
 	var cls *FileBase
 
@@ -6141,9 +7975,9 @@ func FileNewForCommandlineArgAndCwd(ArgVar string, CwdVar string) *FileBase {
 
 var xFileNewForPath func(string) uintptr
 
-// Constructs a #GFile for a given path. This operation never
+// Constructs a [File] for a given path. This operation never
 // fails, but the returned object might not support any I/O
-// operation if @path is malformed.
+// operation if path is malformed.
 func FileNewForPath(PathVar string) *FileBase {
 	var cls *FileBase
 
@@ -6159,9 +7993,9 @@ func FileNewForPath(PathVar string) *FileBase {
 
 var xFileNewForUri func(string) uintptr
 
-// Constructs a #GFile for a given URI. This operation never
+// Constructs a [File] for a given URI. This operation never
 // fails, but the returned object might not support any I/O
-// operation if @uri is malformed or if the uri type is
+// operation if uri is malformed or if the uri type is
 // not supported.
 func FileNewForUri(UriVar string) *FileBase {
 	var cls *FileBase
@@ -6179,14 +8013,14 @@ func FileNewForUri(UriVar string) *FileBase {
 var xFileNewTmp func(uintptr, **FileIOStream, **glib.Error) uintptr
 
 // Opens a file in the preferred directory for temporary files (as
-// returned by g_get_tmp_dir()) and returns a #GFile and
-// #GFileIOStream pointing to it.
+// returned by [glib.GetTmpDir]) and returns a [File] and
+// [FileIOStream] pointing to it.
 //
-// @tmpl should be a string in the GLib file name encoding
+// tmpl should be a string in the GLib file name encoding
 // containing a sequence of six 'X' characters, and containing no
-// directory components. If it is %NULL, a default template is used.
+// directory components. If it is nil, a default template is used.
 //
-// Unlike the other #GFile constructors, this will return %NULL if
+// Unlike the other [File] constructors, this will return nil if
 // a temporary file could not be created.
 func FileNewTmp(TmplVar *string, IostreamVar **FileIOStream) (*FileBase, error) {
 	var cls *FileBase
@@ -6198,12 +8032,15 @@ func FileNewTmp(TmplVar *string, IostreamVar **FileIOStream) (*FileBase, error)
 	cret := xFileNewTmp(TmplVarPtr, IostreamVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -6213,11 +8050,11 @@ var xFileNewTmpAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Asynchronously opens a file in the preferred directory for temporary files
 //
-//	(as returned by g_get_tmp_dir()) as g_file_new_tmp().
+//	(as returned by [glib.GetTmpDir]) as [FileNewTmp].
 //
-// @tmpl should be a string in the GLib file name encoding
+// tmpl should be a string in the GLib file name encoding
 // containing a sequence of six 'X' characters, and containing no
-// directory components. If it is %NULL, a default template is used.
+// directory components. If it is nil, a default template is used.
 func FileNewTmpAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -6227,11 +8064,12 @@ func FileNewTmpAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -6245,11 +8083,11 @@ func FileNewTmpAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Cancell
 var xFileNewTmpDirAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Asynchronously creates a directory in the preferred directory for
-// temporary files (as returned by g_get_tmp_dir()) as g_dir_make_tmp().
+// temporary files (as returned by [glib.GetTmpDir]) as [glib.DirMakeTmp].
 //
-// @tmpl should be a string in the GLib file name encoding
+// tmpl should be a string in the GLib file name encoding
 // containing a sequence of six 'X' characters, and containing no
-// directory components. If it is %NULL, a default template is used.
+// directory components. If it is nil, a default template is used.
 func FileNewTmpDirAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -6259,11 +8097,12 @@ func FileNewTmpDirAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Canc
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -6277,7 +8116,7 @@ func FileNewTmpDirAsync(TmplVar *string, IoPriorityVar int, CancellableVar *Canc
 var xFileNewTmpDirFinish func(uintptr, **glib.Error) uintptr
 
 // Finishes a temporary directory creation started by
-// g_file_new_tmp_dir_async().
+// [FileNewTmpDirAsync].
 func FileNewTmpDirFinish(ResultVar AsyncResult) (*FileBase, error) {
 	var cls *FileBase
 	var cerr *glib.Error
@@ -6285,12 +8124,15 @@ func FileNewTmpDirFinish(ResultVar AsyncResult) (*FileBase, error) {
 	cret := xFileNewTmpDirFinish(ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -6298,7 +8140,7 @@ func FileNewTmpDirFinish(ResultVar AsyncResult) (*FileBase, error) {
 
 var xFileNewTmpFinish func(uintptr, **FileIOStream, **glib.Error) uintptr
 
-// Finishes a temporary file creation started by g_file_new_tmp_async().
+// Finishes a temporary file creation started by [FileNewTmpAsync].
 func FileNewTmpFinish(ResultVar AsyncResult, IostreamVar **FileIOStream) (*FileBase, error) {
 	var cls *FileBase
 	var cerr *glib.Error
@@ -6306,12 +8148,15 @@ func FileNewTmpFinish(ResultVar AsyncResult, IostreamVar **FileIOStream) (*FileB
 	cret := xFileNewTmpFinish(ResultVar.GoPointer(), IostreamVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -6319,10 +8164,10 @@ func FileNewTmpFinish(ResultVar AsyncResult, IostreamVar **FileIOStream) (*FileB
 
 var xFileParseName func(string) uintptr
 
-// Constructs a #GFile with the given @parse_name (i.e. something
-// given by g_file_get_parse_name()). This operation never fails,
+// Constructs a [File] with the given parse_name (i.e. something
+// given by [File.GetParseName]). This operation never fails,
 // but the returned object might not support any I/O operation if
-// the @parse_name cannot be parsed.
+// the parse_name cannot be parsed.
 func FileParseName(ParseNameVar string) *FileBase {
 	var cls *FileBase
 
@@ -6341,157 +8186,159 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xFileNewBuildFilenamev, libs, "g_file_new_build_filenamev")
-	core.PuregoSafeRegister(&xFileNewForCommandlineArg, libs, "g_file_new_for_commandline_arg")
-	core.PuregoSafeRegister(&xFileNewForCommandlineArgAndCwd, libs, "g_file_new_for_commandline_arg_and_cwd")
-	core.PuregoSafeRegister(&xFileNewForPath, libs, "g_file_new_for_path")
-	core.PuregoSafeRegister(&xFileNewForUri, libs, "g_file_new_for_uri")
-	core.PuregoSafeRegister(&xFileNewTmp, libs, "g_file_new_tmp")
-	core.PuregoSafeRegister(&xFileNewTmpAsync, libs, "g_file_new_tmp_async")
-	core.PuregoSafeRegister(&xFileNewTmpDirAsync, libs, "g_file_new_tmp_dir_async")
-	core.PuregoSafeRegister(&xFileNewTmpDirFinish, libs, "g_file_new_tmp_dir_finish")
-	core.PuregoSafeRegister(&xFileNewTmpFinish, libs, "g_file_new_tmp_finish")
-	core.PuregoSafeRegister(&xFileParseName, libs, "g_file_parse_name")
+	core.PuregoRegisterSince(&xFileNewBuildFilenamev, libs, "g_file_new_build_filenamev", "GIO", "2.78")
+	core.PuregoRegisterSince(&xFileNewForCommandlineArg, libs, "g_file_new_for_commandline_arg", "GIO", "")
+	core.PuregoRegisterSince(&xFileNewForCommandlineArgAndCwd, libs, "g_file_new_for_commandline_arg_and_cwd", "GIO", "2.36")
+	core.PuregoRegisterSince(&xFileNewForPath, libs, "g_file_new_for_path", "GIO", "")
+	core.PuregoRegisterSince(&xFileNewForUri, libs, "g_file_new_for_uri", "GIO", "")
+	core.PuregoRegisterSince(&xFileNewTmp, libs, "g_file_new_tmp", "GIO", "2.32")
+	core.PuregoRegisterSince(&xFileNewTmpAsync, libs, "g_file_new_tmp_async", "GIO", "2.74")
+	core.PuregoRegisterSince(&xFileNewTmpDirAsync, libs, "g_file_new_tmp_dir_async", "GIO", "2.74")
+	core.PuregoRegisterSince(&xFileNewTmpDirFinish, libs, "g_file_new_tmp_dir_finish", "GIO", "2.74")
+	core.PuregoRegisterSince(&xFileNewTmpFinish, libs, "g_file_new_tmp_finish", "GIO", "2.74")
+	core.PuregoRegisterSince(&xFileParseName, libs, "g_file_parse_name", "GIO", "")
 
 	core.PuregoSafeRegister(&xFileGLibType, libs, "g_file_get_type")
 
-	core.PuregoSafeRegister(&XGFileAppendTo, libs, "g_file_append_to")
-	core.PuregoSafeRegister(&XGFileAppendToAsync, libs, "g_file_append_to_async")
-	core.PuregoSafeRegister(&XGFileAppendToFinish, libs, "g_file_append_to_finish")
-	core.PuregoSafeRegister(&XGFileBuildAttributeListForCopy, libs, "g_file_build_attribute_list_for_copy")
-	core.PuregoSafeRegister(&XGFileCopy, libs, "g_file_copy")
-	core.PuregoSafeRegister(&XGFileCopyAsync, libs, "g_file_copy_async")
-	core.PuregoSafeRegister(&XGFileCopyAsyncWithClosures, libs, "g_file_copy_async_with_closures")
-	core.PuregoSafeRegister(&XGFileCopyAttributes, libs, "g_file_copy_attributes")
-	core.PuregoSafeRegister(&XGFileCopyFinish, libs, "g_file_copy_finish")
-	core.PuregoSafeRegister(&XGFileCreate, libs, "g_file_create")
-	core.PuregoSafeRegister(&XGFileCreateAsync, libs, "g_file_create_async")
-	core.PuregoSafeRegister(&XGFileCreateFinish, libs, "g_file_create_finish")
-	core.PuregoSafeRegister(&XGFileCreateReadwrite, libs, "g_file_create_readwrite")
-	core.PuregoSafeRegister(&XGFileCreateReadwriteAsync, libs, "g_file_create_readwrite_async")
-	core.PuregoSafeRegister(&XGFileCreateReadwriteFinish, libs, "g_file_create_readwrite_finish")
-	core.PuregoSafeRegister(&XGFileDelete, libs, "g_file_delete")
-	core.PuregoSafeRegister(&XGFileDeleteAsync, libs, "g_file_delete_async")
-	core.PuregoSafeRegister(&XGFileDeleteFinish, libs, "g_file_delete_finish")
-	core.PuregoSafeRegister(&XGFileDup, libs, "g_file_dup")
-	core.PuregoSafeRegister(&XGFileEjectMountable, libs, "g_file_eject_mountable")
-	core.PuregoSafeRegister(&XGFileEjectMountableFinish, libs, "g_file_eject_mountable_finish")
-	core.PuregoSafeRegister(&XGFileEjectMountableWithOperation, libs, "g_file_eject_mountable_with_operation")
-	core.PuregoSafeRegister(&XGFileEjectMountableWithOperationFinish, libs, "g_file_eject_mountable_with_operation_finish")
-	core.PuregoSafeRegister(&XGFileEnumerateChildren, libs, "g_file_enumerate_children")
-	core.PuregoSafeRegister(&XGFileEnumerateChildrenAsync, libs, "g_file_enumerate_children_async")
-	core.PuregoSafeRegister(&XGFileEnumerateChildrenFinish, libs, "g_file_enumerate_children_finish")
-	core.PuregoSafeRegister(&XGFileEqual, libs, "g_file_equal")
-	core.PuregoSafeRegister(&XGFileFindEnclosingMount, libs, "g_file_find_enclosing_mount")
-	core.PuregoSafeRegister(&XGFileFindEnclosingMountAsync, libs, "g_file_find_enclosing_mount_async")
-	core.PuregoSafeRegister(&XGFileFindEnclosingMountFinish, libs, "g_file_find_enclosing_mount_finish")
-	core.PuregoSafeRegister(&XGFileGetBasename, libs, "g_file_get_basename")
-	core.PuregoSafeRegister(&XGFileGetChild, libs, "g_file_get_child")
-	core.PuregoSafeRegister(&XGFileGetChildForDisplayName, libs, "g_file_get_child_for_display_name")
-	core.PuregoSafeRegister(&XGFileGetParent, libs, "g_file_get_parent")
-	core.PuregoSafeRegister(&XGFileGetParseName, libs, "g_file_get_parse_name")
-	core.PuregoSafeRegister(&XGFileGetPath, libs, "g_file_get_path")
-	core.PuregoSafeRegister(&XGFileGetRelativePath, libs, "g_file_get_relative_path")
-	core.PuregoSafeRegister(&XGFileGetUri, libs, "g_file_get_uri")
-	core.PuregoSafeRegister(&XGFileGetUriScheme, libs, "g_file_get_uri_scheme")
-	core.PuregoSafeRegister(&XGFileHasParent, libs, "g_file_has_parent")
-	core.PuregoSafeRegister(&XGFileHasPrefix, libs, "g_file_has_prefix")
-	core.PuregoSafeRegister(&XGFileHasUriScheme, libs, "g_file_has_uri_scheme")
-	core.PuregoSafeRegister(&XGFileHash, libs, "g_file_hash")
-	core.PuregoSafeRegister(&XGFileIsNative, libs, "g_file_is_native")
-	core.PuregoSafeRegister(&XGFileLoadBytes, libs, "g_file_load_bytes")
-	core.PuregoSafeRegister(&XGFileLoadBytesAsync, libs, "g_file_load_bytes_async")
-	core.PuregoSafeRegister(&XGFileLoadBytesFinish, libs, "g_file_load_bytes_finish")
-	core.PuregoSafeRegister(&XGFileLoadContents, libs, "g_file_load_contents")
-	core.PuregoSafeRegister(&XGFileLoadContentsAsync, libs, "g_file_load_contents_async")
-	core.PuregoSafeRegister(&XGFileLoadContentsFinish, libs, "g_file_load_contents_finish")
-	core.PuregoSafeRegister(&XGFileLoadPartialContentsAsync, libs, "g_file_load_partial_contents_async")
-	core.PuregoSafeRegister(&XGFileLoadPartialContentsFinish, libs, "g_file_load_partial_contents_finish")
-	core.PuregoSafeRegister(&XGFileMakeDirectory, libs, "g_file_make_directory")
-	core.PuregoSafeRegister(&XGFileMakeDirectoryAsync, libs, "g_file_make_directory_async")
-	core.PuregoSafeRegister(&XGFileMakeDirectoryFinish, libs, "g_file_make_directory_finish")
-	core.PuregoSafeRegister(&XGFileMakeDirectoryWithParents, libs, "g_file_make_directory_with_parents")
-	core.PuregoSafeRegister(&XGFileMakeSymbolicLink, libs, "g_file_make_symbolic_link")
-	core.PuregoSafeRegister(&XGFileMakeSymbolicLinkAsync, libs, "g_file_make_symbolic_link_async")
-	core.PuregoSafeRegister(&XGFileMakeSymbolicLinkFinish, libs, "g_file_make_symbolic_link_finish")
-	core.PuregoSafeRegister(&XGFileMeasureDiskUsage, libs, "g_file_measure_disk_usage")
-	core.PuregoSafeRegister(&XGFileMeasureDiskUsageAsync, libs, "g_file_measure_disk_usage_async")
-	core.PuregoSafeRegister(&XGFileMeasureDiskUsageFinish, libs, "g_file_measure_disk_usage_finish")
-	core.PuregoSafeRegister(&XGFileMonitor, libs, "g_file_monitor")
-	core.PuregoSafeRegister(&XGFileMonitorDirectory, libs, "g_file_monitor_directory")
-	core.PuregoSafeRegister(&XGFileMonitorFile, libs, "g_file_monitor_file")
-	core.PuregoSafeRegister(&XGFileMountEnclosingVolume, libs, "g_file_mount_enclosing_volume")
-	core.PuregoSafeRegister(&XGFileMountEnclosingVolumeFinish, libs, "g_file_mount_enclosing_volume_finish")
-	core.PuregoSafeRegister(&XGFileMountMountable, libs, "g_file_mount_mountable")
-	core.PuregoSafeRegister(&XGFileMountMountableFinish, libs, "g_file_mount_mountable_finish")
-	core.PuregoSafeRegister(&XGFileMove, libs, "g_file_move")
-	core.PuregoSafeRegister(&XGFileMoveAsync, libs, "g_file_move_async")
-	core.PuregoSafeRegister(&XGFileMoveAsyncWithClosures, libs, "g_file_move_async_with_closures")
-	core.PuregoSafeRegister(&XGFileMoveFinish, libs, "g_file_move_finish")
-	core.PuregoSafeRegister(&XGFileOpenReadwrite, libs, "g_file_open_readwrite")
-	core.PuregoSafeRegister(&XGFileOpenReadwriteAsync, libs, "g_file_open_readwrite_async")
-	core.PuregoSafeRegister(&XGFileOpenReadwriteFinish, libs, "g_file_open_readwrite_finish")
-	core.PuregoSafeRegister(&XGFilePeekPath, libs, "g_file_peek_path")
-	core.PuregoSafeRegister(&XGFilePollMountable, libs, "g_file_poll_mountable")
-	core.PuregoSafeRegister(&XGFilePollMountableFinish, libs, "g_file_poll_mountable_finish")
-	core.PuregoSafeRegister(&XGFileQueryDefaultHandler, libs, "g_file_query_default_handler")
-	core.PuregoSafeRegister(&XGFileQueryDefaultHandlerAsync, libs, "g_file_query_default_handler_async")
-	core.PuregoSafeRegister(&XGFileQueryDefaultHandlerFinish, libs, "g_file_query_default_handler_finish")
-	core.PuregoSafeRegister(&XGFileQueryExists, libs, "g_file_query_exists")
-	core.PuregoSafeRegister(&XGFileQueryFileType, libs, "g_file_query_file_type")
-	core.PuregoSafeRegister(&XGFileQueryFilesystemInfo, libs, "g_file_query_filesystem_info")
-	core.PuregoSafeRegister(&XGFileQueryFilesystemInfoAsync, libs, "g_file_query_filesystem_info_async")
-	core.PuregoSafeRegister(&XGFileQueryFilesystemInfoFinish, libs, "g_file_query_filesystem_info_finish")
-	core.PuregoSafeRegister(&XGFileQueryInfo, libs, "g_file_query_info")
-	core.PuregoSafeRegister(&XGFileQueryInfoAsync, libs, "g_file_query_info_async")
-	core.PuregoSafeRegister(&XGFileQueryInfoFinish, libs, "g_file_query_info_finish")
-	core.PuregoSafeRegister(&XGFileQuerySettableAttributes, libs, "g_file_query_settable_attributes")
-	core.PuregoSafeRegister(&XGFileQueryWritableNamespaces, libs, "g_file_query_writable_namespaces")
-	core.PuregoSafeRegister(&XGFileRead, libs, "g_file_read")
-	core.PuregoSafeRegister(&XGFileReadAsync, libs, "g_file_read_async")
-	core.PuregoSafeRegister(&XGFileReadFinish, libs, "g_file_read_finish")
-	core.PuregoSafeRegister(&XGFileReplace, libs, "g_file_replace")
-	core.PuregoSafeRegister(&XGFileReplaceAsync, libs, "g_file_replace_async")
-	core.PuregoSafeRegister(&XGFileReplaceContents, libs, "g_file_replace_contents")
-	core.PuregoSafeRegister(&XGFileReplaceContentsAsync, libs, "g_file_replace_contents_async")
-	core.PuregoSafeRegister(&XGFileReplaceContentsBytesAsync, libs, "g_file_replace_contents_bytes_async")
-	core.PuregoSafeRegister(&XGFileReplaceContentsFinish, libs, "g_file_replace_contents_finish")
-	core.PuregoSafeRegister(&XGFileReplaceFinish, libs, "g_file_replace_finish")
-	core.PuregoSafeRegister(&XGFileReplaceReadwrite, libs, "g_file_replace_readwrite")
-	core.PuregoSafeRegister(&XGFileReplaceReadwriteAsync, libs, "g_file_replace_readwrite_async")
-	core.PuregoSafeRegister(&XGFileReplaceReadwriteFinish, libs, "g_file_replace_readwrite_finish")
-	core.PuregoSafeRegister(&XGFileResolveRelativePath, libs, "g_file_resolve_relative_path")
-	core.PuregoSafeRegister(&XGFileSetAttribute, libs, "g_file_set_attribute")
-	core.PuregoSafeRegister(&XGFileSetAttributeByteString, libs, "g_file_set_attribute_byte_string")
-	core.PuregoSafeRegister(&XGFileSetAttributeInt32, libs, "g_file_set_attribute_int32")
-	core.PuregoSafeRegister(&XGFileSetAttributeInt64, libs, "g_file_set_attribute_int64")
-	core.PuregoSafeRegister(&XGFileSetAttributeString, libs, "g_file_set_attribute_string")
-	core.PuregoSafeRegister(&XGFileSetAttributeUint32, libs, "g_file_set_attribute_uint32")
-	core.PuregoSafeRegister(&XGFileSetAttributeUint64, libs, "g_file_set_attribute_uint64")
-	core.PuregoSafeRegister(&XGFileSetAttributesAsync, libs, "g_file_set_attributes_async")
-	core.PuregoSafeRegister(&XGFileSetAttributesFinish, libs, "g_file_set_attributes_finish")
-	core.PuregoSafeRegister(&XGFileSetAttributesFromInfo, libs, "g_file_set_attributes_from_info")
-	core.PuregoSafeRegister(&XGFileSetDisplayName, libs, "g_file_set_display_name")
-	core.PuregoSafeRegister(&XGFileSetDisplayNameAsync, libs, "g_file_set_display_name_async")
-	core.PuregoSafeRegister(&XGFileSetDisplayNameFinish, libs, "g_file_set_display_name_finish")
-	core.PuregoSafeRegister(&XGFileStartMountable, libs, "g_file_start_mountable")
-	core.PuregoSafeRegister(&XGFileStartMountableFinish, libs, "g_file_start_mountable_finish")
-	core.PuregoSafeRegister(&XGFileStopMountable, libs, "g_file_stop_mountable")
-	core.PuregoSafeRegister(&XGFileStopMountableFinish, libs, "g_file_stop_mountable_finish")
-	core.PuregoSafeRegister(&XGFileSupportsThreadContexts, libs, "g_file_supports_thread_contexts")
-	core.PuregoSafeRegister(&XGFileTrash, libs, "g_file_trash")
-	core.PuregoSafeRegister(&XGFileTrashAsync, libs, "g_file_trash_async")
-	core.PuregoSafeRegister(&XGFileTrashFinish, libs, "g_file_trash_finish")
-	core.PuregoSafeRegister(&XGFileUnmountMountable, libs, "g_file_unmount_mountable")
-	core.PuregoSafeRegister(&XGFileUnmountMountableFinish, libs, "g_file_unmount_mountable_finish")
-	core.PuregoSafeRegister(&XGFileUnmountMountableWithOperation, libs, "g_file_unmount_mountable_with_operation")
-	core.PuregoSafeRegister(&XGFileUnmountMountableWithOperationFinish, libs, "g_file_unmount_mountable_with_operation_finish")
+	gobject.CheckClassSize("GFileIface", xFileGLibType, unsafe.Sizeof(FileIface{}))
+
+	core.PuregoRegisterSince(&XGFileAppendTo, libs, "g_file_append_to", "GIO", "")
+	core.PuregoRegisterSince(&XGFileAppendToAsync, libs, "g_file_append_to_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileAppendToFinish, libs, "g_file_append_to_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileBuildAttributeListForCopy, libs, "g_file_build_attribute_list_for_copy", "GIO", "2.68")
+	core.PuregoRegisterSince(&XGFileCopy, libs, "g_file_copy", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCopyAsync, libs, "g_file_copy_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCopyAsyncWithClosures, libs, "g_file_copy_async_with_closures", "GIO", "2.82")
+	core.PuregoRegisterSince(&XGFileCopyAttributes, libs, "g_file_copy_attributes", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCopyFinish, libs, "g_file_copy_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCreate, libs, "g_file_create", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCreateAsync, libs, "g_file_create_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCreateFinish, libs, "g_file_create_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileCreateReadwrite, libs, "g_file_create_readwrite", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileCreateReadwriteAsync, libs, "g_file_create_readwrite_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileCreateReadwriteFinish, libs, "g_file_create_readwrite_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileDelete, libs, "g_file_delete", "GIO", "")
+	core.PuregoRegisterSince(&XGFileDeleteAsync, libs, "g_file_delete_async", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGFileDeleteFinish, libs, "g_file_delete_finish", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGFileDup, libs, "g_file_dup", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEjectMountable, libs, "g_file_eject_mountable", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEjectMountableFinish, libs, "g_file_eject_mountable_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEjectMountableWithOperation, libs, "g_file_eject_mountable_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileEjectMountableWithOperationFinish, libs, "g_file_eject_mountable_with_operation_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileEnumerateChildren, libs, "g_file_enumerate_children", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEnumerateChildrenAsync, libs, "g_file_enumerate_children_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEnumerateChildrenFinish, libs, "g_file_enumerate_children_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileEqual, libs, "g_file_equal", "GIO", "")
+	core.PuregoRegisterSince(&XGFileFindEnclosingMount, libs, "g_file_find_enclosing_mount", "GIO", "")
+	core.PuregoRegisterSince(&XGFileFindEnclosingMountAsync, libs, "g_file_find_enclosing_mount_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileFindEnclosingMountFinish, libs, "g_file_find_enclosing_mount_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetBasename, libs, "g_file_get_basename", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetChild, libs, "g_file_get_child", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetChildForDisplayName, libs, "g_file_get_child_for_display_name", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetParent, libs, "g_file_get_parent", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetParseName, libs, "g_file_get_parse_name", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetPath, libs, "g_file_get_path", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetRelativePath, libs, "g_file_get_relative_path", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetUri, libs, "g_file_get_uri", "GIO", "")
+	core.PuregoRegisterSince(&XGFileGetUriScheme, libs, "g_file_get_uri_scheme", "GIO", "")
+	core.PuregoRegisterSince(&XGFileHasParent, libs, "g_file_has_parent", "GIO", "2.24")
+	core.PuregoRegisterSince(&XGFileHasPrefix, libs, "g_file_has_prefix", "GIO", "")
+	core.PuregoRegisterSince(&XGFileHasUriScheme, libs, "g_file_has_uri_scheme", "GIO", "")
+	core.PuregoRegisterSince(&XGFileHash, libs, "g_file_hash", "GIO", "")
+	core.PuregoRegisterSince(&XGFileIsNative, libs, "g_file_is_native", "GIO", "")
+	core.PuregoRegisterSince(&XGFileLoadBytes, libs, "g_file_load_bytes", "GIO", "2.56")
+	core.PuregoRegisterSince(&XGFileLoadBytesAsync, libs, "g_file_load_bytes_async", "GIO", "2.56")
+	core.PuregoRegisterSince(&XGFileLoadBytesFinish, libs, "g_file_load_bytes_finish", "GIO", "2.56")
+	core.PuregoRegisterSince(&XGFileLoadContents, libs, "g_file_load_contents", "GIO", "")
+	core.PuregoRegisterSince(&XGFileLoadContentsAsync, libs, "g_file_load_contents_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileLoadContentsFinish, libs, "g_file_load_contents_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileLoadPartialContentsAsync, libs, "g_file_load_partial_contents_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileLoadPartialContentsFinish, libs, "g_file_load_partial_contents_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMakeDirectory, libs, "g_file_make_directory", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMakeDirectoryAsync, libs, "g_file_make_directory_async", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileMakeDirectoryFinish, libs, "g_file_make_directory_finish", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileMakeDirectoryWithParents, libs, "g_file_make_directory_with_parents", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGFileMakeSymbolicLink, libs, "g_file_make_symbolic_link", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMakeSymbolicLinkAsync, libs, "g_file_make_symbolic_link_async", "GIO", "2.74")
+	core.PuregoRegisterSince(&XGFileMakeSymbolicLinkFinish, libs, "g_file_make_symbolic_link_finish", "GIO", "2.74")
+	core.PuregoRegisterSince(&XGFileMeasureDiskUsage, libs, "g_file_measure_disk_usage", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileMeasureDiskUsageAsync, libs, "g_file_measure_disk_usage_async", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileMeasureDiskUsageFinish, libs, "g_file_measure_disk_usage_finish", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileMonitor, libs, "g_file_monitor", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGFileMonitorDirectory, libs, "g_file_monitor_directory", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMonitorFile, libs, "g_file_monitor_file", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMountEnclosingVolume, libs, "g_file_mount_enclosing_volume", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMountEnclosingVolumeFinish, libs, "g_file_mount_enclosing_volume_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMountMountable, libs, "g_file_mount_mountable", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMountMountableFinish, libs, "g_file_mount_mountable_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMove, libs, "g_file_move", "GIO", "")
+	core.PuregoRegisterSince(&XGFileMoveAsync, libs, "g_file_move_async", "GIO", "2.72")
+	core.PuregoRegisterSince(&XGFileMoveAsyncWithClosures, libs, "g_file_move_async_with_closures", "GIO", "2.82")
+	core.PuregoRegisterSince(&XGFileMoveFinish, libs, "g_file_move_finish", "GIO", "2.72")
+	core.PuregoRegisterSince(&XGFileOpenReadwrite, libs, "g_file_open_readwrite", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileOpenReadwriteAsync, libs, "g_file_open_readwrite_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileOpenReadwriteFinish, libs, "g_file_open_readwrite_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFilePeekPath, libs, "g_file_peek_path", "GIO", "2.56")
+	core.PuregoRegisterSince(&XGFilePollMountable, libs, "g_file_poll_mountable", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFilePollMountableFinish, libs, "g_file_poll_mountable_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileQueryDefaultHandler, libs, "g_file_query_default_handler", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryDefaultHandlerAsync, libs, "g_file_query_default_handler_async", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGFileQueryDefaultHandlerFinish, libs, "g_file_query_default_handler_finish", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGFileQueryExists, libs, "g_file_query_exists", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryFileType, libs, "g_file_query_file_type", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGFileQueryFilesystemInfo, libs, "g_file_query_filesystem_info", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryFilesystemInfoAsync, libs, "g_file_query_filesystem_info_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryFilesystemInfoFinish, libs, "g_file_query_filesystem_info_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryInfo, libs, "g_file_query_info", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryInfoAsync, libs, "g_file_query_info_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryInfoFinish, libs, "g_file_query_info_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQuerySettableAttributes, libs, "g_file_query_settable_attributes", "GIO", "")
+	core.PuregoRegisterSince(&XGFileQueryWritableNamespaces, libs, "g_file_query_writable_namespaces", "GIO", "")
+	core.PuregoRegisterSince(&XGFileRead, libs, "g_file_read", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReadAsync, libs, "g_file_read_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReadFinish, libs, "g_file_read_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplace, libs, "g_file_replace", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceAsync, libs, "g_file_replace_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceContents, libs, "g_file_replace_contents", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceContentsAsync, libs, "g_file_replace_contents_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceContentsBytesAsync, libs, "g_file_replace_contents_bytes_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&XGFileReplaceContentsFinish, libs, "g_file_replace_contents_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceFinish, libs, "g_file_replace_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileReplaceReadwrite, libs, "g_file_replace_readwrite", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileReplaceReadwriteAsync, libs, "g_file_replace_readwrite_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileReplaceReadwriteFinish, libs, "g_file_replace_readwrite_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileResolveRelativePath, libs, "g_file_resolve_relative_path", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttribute, libs, "g_file_set_attribute", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeByteString, libs, "g_file_set_attribute_byte_string", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeInt32, libs, "g_file_set_attribute_int32", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeInt64, libs, "g_file_set_attribute_int64", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeString, libs, "g_file_set_attribute_string", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeUint32, libs, "g_file_set_attribute_uint32", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributeUint64, libs, "g_file_set_attribute_uint64", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributesAsync, libs, "g_file_set_attributes_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributesFinish, libs, "g_file_set_attributes_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetAttributesFromInfo, libs, "g_file_set_attributes_from_info", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetDisplayName, libs, "g_file_set_display_name", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetDisplayNameAsync, libs, "g_file_set_display_name_async", "GIO", "")
+	core.PuregoRegisterSince(&XGFileSetDisplayNameFinish, libs, "g_file_set_display_name_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileStartMountable, libs, "g_file_start_mountable", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileStartMountableFinish, libs, "g_file_start_mountable_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileStopMountable, libs, "g_file_stop_mountable", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileStopMountableFinish, libs, "g_file_stop_mountable_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileSupportsThreadContexts, libs, "g_file_supports_thread_contexts", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileTrash, libs, "g_file_trash", "GIO", "")
+	core.PuregoRegisterSince(&XGFileTrashAsync, libs, "g_file_trash_async", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileTrashFinish, libs, "g_file_trash_finish", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGFileUnmountMountable, libs, "g_file_unmount_mountable", "GIO", "")
+	core.PuregoRegisterSince(&XGFileUnmountMountableFinish, libs, "g_file_unmount_mountable_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGFileUnmountMountableWithOperation, libs, "g_file_unmount_mountable_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGFileUnmountMountableWithOperationFinish, libs, "g_file_unmount_mountable_with_operation_finish", "GIO", "2.22")
 
 }
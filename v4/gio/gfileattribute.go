@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -26,7 +25,7 @@ func (x *FileAttributeInfo) GoPointer() uintptr {
 }
 
 // Acts as a lightweight registry for possible valid file attributes.
-// The registry stores Key-Value pair formats as #GFileAttributeInfos.
+// The registry stores Key-Value pair formats as GFileAttributeInfos.
 type FileAttributeInfoList struct {
 	_ structs.HostLayout
 
@@ -56,8 +55,8 @@ func NewFileAttributeInfoList() *FileAttributeInfoList {
 
 var xFileAttributeInfoListAdd func(uintptr, string, FileAttributeType, FileAttributeInfoFlags)
 
-// Adds a new attribute with @name to the @list, setting
-// its @type and @flags.
+// Adds a new attribute with name to the list, setting
+// its type and flags.
 func (x *FileAttributeInfoList) Add(NameVar string, TypeVar FileAttributeType, FlagsVar FileAttributeInfoFlags) {
 
 	xFileAttributeInfoListAdd(x.GoPointer(), NameVar, TypeVar, FlagsVar)
@@ -75,7 +74,7 @@ func (x *FileAttributeInfoList) Dup() *FileAttributeInfoList {
 
 var xFileAttributeInfoListLookup func(uintptr, string) *FileAttributeInfo
 
-// Gets the file attribute with the name @name from @list.
+// Gets the file attribute with the name name from list.
 func (x *FileAttributeInfoList) Lookup(NameVar string) *FileAttributeInfo {
 
 	cret := xFileAttributeInfoListLookup(x.GoPointer(), NameVar)
@@ -93,8 +92,8 @@ func (x *FileAttributeInfoList) Ref() *FileAttributeInfoList {
 
 var xFileAttributeInfoListUnref func(uintptr)
 
-// Removes a reference from the given @list. If the reference count
-// falls to zero, the @list is deleted.
+// Removes a reference from the given list. If the reference count
+// falls to zero, the list is deleted.
 func (x *FileAttributeInfoList) Unref() {
 
 	xFileAttributeInfoListUnref(x.GoPointer())
@@ -106,7 +105,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -115,12 +114,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileAttributeInfoListGLibType, libs, "g_file_attribute_info_list_get_type")
 
-	core.PuregoSafeRegister(&xNewFileAttributeInfoList, libs, "g_file_attribute_info_list_new")
+	core.PuregoRegisterSince(&xNewFileAttributeInfoList, libs, "g_file_attribute_info_list_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xFileAttributeInfoListAdd, libs, "g_file_attribute_info_list_add")
-	core.PuregoSafeRegister(&xFileAttributeInfoListDup, libs, "g_file_attribute_info_list_dup")
-	core.PuregoSafeRegister(&xFileAttributeInfoListLookup, libs, "g_file_attribute_info_list_lookup")
-	core.PuregoSafeRegister(&xFileAttributeInfoListRef, libs, "g_file_attribute_info_list_ref")
-	core.PuregoSafeRegister(&xFileAttributeInfoListUnref, libs, "g_file_attribute_info_list_unref")
+	core.PuregoRegisterSince(&xFileAttributeInfoListAdd, libs, "g_file_attribute_info_list_add", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeInfoListDup, libs, "g_file_attribute_info_list_dup", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeInfoListLookup, libs, "g_file_attribute_info_list_lookup", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeInfoListRef, libs, "g_file_attribute_info_list_ref", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeInfoListUnref, libs, "g_file_attribute_info_list_unref", "GIO", "")
 
 }
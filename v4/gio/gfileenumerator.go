@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -53,7 +52,8 @@ func (x *FileEnumeratorClass) OverrideNextFile(cb func(*FileEnumerator, *Cancell
 	if cb == nil {
 		x.xNextFile = 0
 	} else {
-		x.xNextFile = purego.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xNextFile = core.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -69,7 +69,7 @@ func (x *FileEnumeratorClass) GetNextFile() func(*FileEnumerator, *Cancellable)
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xNextFile)
+	core.RegisterFunc(&rawCallback, x.xNextFile)
 	return func(EnumeratorVar *FileEnumerator, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(EnumeratorVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -86,7 +86,8 @@ func (x *FileEnumeratorClass) OverrideCloseFn(cb func(*FileEnumerator, *Cancella
 	if cb == nil {
 		x.xCloseFn = 0
 	} else {
-		x.xCloseFn = purego.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) bool {
+		x.xCloseFn = core.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -98,7 +99,7 @@ func (x *FileEnumeratorClass) GetCloseFn() func(*FileEnumerator, *Cancellable) b
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFn)
+	core.RegisterFunc(&rawCallback, x.xCloseFn)
 	return func(EnumeratorVar *FileEnumerator, CancellableVar *Cancellable) bool {
 		return rawCallback(EnumeratorVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -109,7 +110,8 @@ func (x *FileEnumeratorClass) OverrideNextFilesAsync(cb func(*FileEnumerator, in
 	if cb == nil {
 		x.xNextFilesAsync = 0
 	} else {
-		x.xNextFilesAsync = purego.NewCallback(func(EnumeratorVarp uintptr, NumFilesVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xNextFilesAsync = core.NewCallback(func(EnumeratorVarp uintptr, NumFilesVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), NumFilesVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -121,7 +123,7 @@ func (x *FileEnumeratorClass) GetNextFilesAsync() func(*FileEnumerator, int, int
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, NumFilesVarp int, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xNextFilesAsync)
+	core.RegisterFunc(&rawCallback, x.xNextFilesAsync)
 	return func(EnumeratorVar *FileEnumerator, NumFilesVar int, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(EnumeratorVar.GoPointer(), NumFilesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -132,7 +134,8 @@ func (x *FileEnumeratorClass) OverrideNextFilesFinish(cb func(*FileEnumerator, A
 	if cb == nil {
 		x.xNextFilesFinish = 0
 	} else {
-		x.xNextFilesFinish = purego.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xNextFilesFinish = core.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -144,7 +147,7 @@ func (x *FileEnumeratorClass) GetNextFilesFinish() func(*FileEnumerator, AsyncRe
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xNextFilesFinish)
+	core.RegisterFunc(&rawCallback, x.xNextFilesFinish)
 	return func(EnumeratorVar *FileEnumerator, ResultVar AsyncResult) *glib.List {
 		return rawCallback(EnumeratorVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -155,7 +158,8 @@ func (x *FileEnumeratorClass) OverrideCloseAsync(cb func(*FileEnumerator, int, *
 	if cb == nil {
 		x.xCloseAsync = 0
 	} else {
-		x.xCloseAsync = purego.NewCallback(func(EnumeratorVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCloseAsync = core.NewCallback(func(EnumeratorVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -167,7 +171,7 @@ func (x *FileEnumeratorClass) GetCloseAsync() func(*FileEnumerator, int, *Cancel
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCloseAsync)
+	core.RegisterFunc(&rawCallback, x.xCloseAsync)
 	return func(EnumeratorVar *FileEnumerator, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(EnumeratorVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -178,7 +182,8 @@ func (x *FileEnumeratorClass) OverrideCloseFinish(cb func(*FileEnumerator, Async
 	if cb == nil {
 		x.xCloseFinish = 0
 	} else {
-		x.xCloseFinish = purego.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) bool {
+		x.xCloseFinish = core.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileEnumeratorNewFromInternalPtr(EnumeratorVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -190,7 +195,7 @@ func (x *FileEnumeratorClass) GetCloseFinish() func(*FileEnumerator, AsyncResult
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFinish)
+	core.RegisterFunc(&rawCallback, x.xCloseFinish)
 	return func(EnumeratorVar *FileEnumerator, ResultVar AsyncResult) bool {
 		return rawCallback(EnumeratorVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -201,7 +206,8 @@ func (x *FileEnumeratorClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -213,7 +219,7 @@ func (x *FileEnumeratorClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -224,7 +230,8 @@ func (x *FileEnumeratorClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -236,7 +243,7 @@ func (x *FileEnumeratorClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -247,7 +254,8 @@ func (x *FileEnumeratorClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -259,7 +267,7 @@ func (x *FileEnumeratorClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -270,7 +278,8 @@ func (x *FileEnumeratorClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -282,7 +291,7 @@ func (x *FileEnumeratorClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -293,7 +302,8 @@ func (x *FileEnumeratorClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -305,7 +315,7 @@ func (x *FileEnumeratorClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -316,7 +326,8 @@ func (x *FileEnumeratorClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -328,7 +339,7 @@ func (x *FileEnumeratorClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -339,7 +350,8 @@ func (x *FileEnumeratorClass) OverrideGReserved7(cb func()) {
 	if cb == nil {
 		x.xGReserved7 = 0
 	} else {
-		x.xGReserved7 = purego.NewCallback(func() {
+		x.xGReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -351,7 +363,7 @@ func (x *FileEnumeratorClass) GetGReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved7)
+	core.RegisterFunc(&rawCallback, x.xGReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -365,19 +377,19 @@ func (x *FileEnumeratorPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GFileEnumerator` allows you to operate on a set of [iface@Gio.File] objects,
-// returning a [class@Gio.FileInfo] structure for each file enumerated (e.g.
-// [method@Gio.File.enumerate_children] will return a `GFileEnumerator` for each
+// `GFileEnumerator` allows you to operate on a set of [File] objects,
+// returning a [FileInfo] structure for each file enumerated (e.g.
+// [File.EnumerateChildren] will return a `GFileEnumerator` for each
 // of the children within a directory).
 //
 // To get the next file's information from a `GFileEnumerator`, use
-// [method@Gio.FileEnumerator.next_file] or its asynchronous version,
-// [method@Gio.FileEnumerator.next_files_async]. Note that the asynchronous
-// version will return a list of [class@Gio.FileInfo] objects, whereas the
+// [FileEnumerator.NextFile] or its asynchronous version,
+// [FileEnumerator.NextFilesAsync]. Note that the asynchronous
+// version will return a list of [FileInfo] objects, whereas the
 // synchronous will only return the next file in the enumerator.
 //
 // The ordering of returned files is unspecified for non-Unix
-// platforms; for more information, see [method@GLib.Dir.read_name].  On Unix,
+// platforms; for more information, see [glib.Dir.ReadName].  On Unix,
 // when operating on local files, returned files will be sorted by
 // inode number.  Effectively you can assume that the ordering of
 // returned files will be stable between successive calls (and
@@ -387,10 +399,10 @@ func (x *FileEnumeratorPrivate) GoPointer() uintptr {
 // modification time, you will have to implement that in your
 // application code.
 //
-// To close a `GFileEnumerator`, use [method@Gio.FileEnumerator.close], or
-// its asynchronous version, [method@Gio.FileEnumerator.close_async]. Once
+// To close a `GFileEnumerator`, use [FileEnumerator.Close], or
+// its asynchronous version, [FileEnumerator.CloseAsync]. Once
 // a `GFileEnumerator` is closed, no further actions may be performed
-// on it, and it should be freed with [method@GObject.Object.unref].
+// on it, and it should be freed with [gobject.Object.Unref].
 type FileEnumerator struct {
 	gobject.Object
 }
@@ -410,7 +422,7 @@ func FileEnumeratorNewFromInternalPtr(ptr uintptr) *FileEnumerator {
 var xFileEnumeratorClose func(uintptr, uintptr, **glib.Error) bool
 
 // Releases all resources used by this enumerator, making the
-// enumerator return %G_IO_ERROR_CLOSED on all calls.
+// enumerator return [GIoErrorClosedValue] on all calls.
 //
 // This will be automatically called when the last reference
 // is dropped, but you might want to call this function to make
@@ -420,7 +432,7 @@ func (x *FileEnumerator) Close(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xFileEnumeratorClose(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -430,10 +442,10 @@ var xFileEnumeratorCloseAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Asynchronously closes the file enumerator.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned in
-// g_file_enumerator_close_finish().
+// was cancelled, the error [GIoErrorCancelledValue] will be returned in
+// [FileEnumerator.CloseFinish].
 func (x *FileEnumerator) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -443,11 +455,12 @@ func (x *FileEnumerator) CloseAsync(IoPriorityVar int, CancellableVar *Cancellab
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -457,22 +470,22 @@ func (x *FileEnumerator) CloseAsync(IoPriorityVar int, CancellableVar *Cancellab
 
 var xFileEnumeratorCloseFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes closing a file enumerator, started from g_file_enumerator_close_async().
+// Finishes closing a file enumerator, started from [FileEnumerator.CloseAsync].
 //
-// If the file enumerator was already closed when g_file_enumerator_close_async()
-// was called, then this function will report %G_IO_ERROR_CLOSED in @error, and
-// return %FALSE. If the file enumerator had pending operation when the close
-// operation was started, then this function will report %G_IO_ERROR_PENDING, and
-// return %FALSE.  If @cancellable was not %NULL, then the operation may have been
+// If the file enumerator was already closed when [FileEnumerator.CloseAsync]
+// was called, then this function will report [GIoErrorClosedValue] in error, and
+// return false. If the file enumerator had pending operation when the close
+// operation was started, then this function will report [GIoErrorPendingValue], and
+// return false.  If cancellable was not nil, then the operation may have been
 // cancelled by triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be set, and %FALSE will be
+// was cancelled, the error [GIoErrorCancelledValue] will be set, and false will be
 // returned.
 func (x *FileEnumerator) CloseFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xFileEnumeratorCloseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -480,21 +493,18 @@ func (x *FileEnumerator) CloseFinish(ResultVar AsyncResult) (bool, error) {
 
 var xFileEnumeratorGetChild func(uintptr, uintptr) uintptr
 
-// Return a new #GFile which refers to the file named by @info in the source
-// directory of @enumerator.  This function is primarily intended to be used
-// inside loops with g_file_enumerator_next_file().
+// Return a new [File] which refers to the file named by info in the source
+// directory of enumerator.  This function is primarily intended to be used
+// inside loops with [FileEnumerator.NextFile].
 //
-// To use this, %G_FILE_ATTRIBUTE_STANDARD_NAME must have been listed in the
-// attributes list used when creating the #GFileEnumerator.
+// To use this, [FILE_ATTRIBUTE_STANDARD_NAME] must have been listed in the
+// attributes list used when creating the [FileEnumerator].
 //
 // This is a convenience method that's equivalent to:
-// |[&lt;!-- language="C" --&gt;
 //
 //	gchar *name = g_file_info_get_name (info);
 //	GFile *child = g_file_get_child (g_file_enumerator_get_container (enumr),
 //	                                 name);
-//
-// ]|
 func (x *FileEnumerator) GetChild(InfoVar *FileInfo) *FileBase {
 	var cls *FileBase
 
@@ -510,7 +520,7 @@ func (x *FileEnumerator) GetChild(InfoVar *FileInfo) *FileBase {
 
 var xFileEnumeratorGetContainer func(uintptr) uintptr
 
-// Get the #GFile container which is being enumerated.
+// Get the [File] container which is being enumerated.
 func (x *FileEnumerator) GetContainer() *FileBase {
 	var cls *FileBase
 
@@ -545,53 +555,48 @@ func (x *FileEnumerator) IsClosed() bool {
 
 var xFileEnumeratorIterate func(uintptr, **FileInfo, **File, uintptr, **glib.Error) bool
 
-// This is a version of g_file_enumerator_next_file() that's easier to
-// use correctly from C programs.  With g_file_enumerator_next_file(),
+// This is a version of [FileEnumerator.NextFile] that's easier to
+// use correctly from C programs.  With [FileEnumerator.NextFile],
 // the gboolean return value signifies "end of iteration or error", which
-// requires allocation of a temporary #GError.
+// requires allocation of a temporary [glib.Error].
 //
-// In contrast, with this function, a %FALSE return from
-// g_file_enumerator_iterate() *always* means
-// "error".  End of iteration is signaled by @out_info or @out_child being %NULL.
+// In contrast, with this function, a false return from
+// [FileEnumerator.Iterate] *always* means
+// "error".  End of iteration is signaled by out_info or out_child being nil.
 //
-// Another crucial difference is that the references for @out_info and
-// @out_child are owned by @direnum (they are cached as hidden
+// Another crucial difference is that the references for out_info and
+// out_child are owned by direnum (they are cached as hidden
 // properties).  You must not unref them in your own code.  This makes
 // memory management significantly easier for C code in combination
 // with loops.
 //
-// Finally, this function optionally allows retrieving a #GFile as
+// Finally, this function optionally allows retrieving a [File] as
 // well.
 //
-// You must specify at least one of @out_info or @out_child.
+// You must specify at least one of out_info or out_child.
 //
-// The code pattern for correctly using g_file_enumerator_iterate() from C
+// The code pattern for correctly using [FileEnumerator.Iterate] from C
 // is:
 //
-// |[
-// direnum = g_file_enumerate_children (file, ...);
-// while (TRUE)
-//
-//	{
-//	  GFileInfo *info;
-//	  if (!g_file_enumerator_iterate (direnum, &amp;info, NULL, cancellable, error))
-//	    goto out;
-//	  if (!info)
-//	    break;
-//	  ... do stuff with "info"; do not unref it! ...
-//	}
-//
-// out:
-//
-//	g_object_unref (direnum); // Note: frees the last @info
-//
-// ]|
+//	direnum = g_file_enumerate_children (file, ...);
+//	while (TRUE)
+//	  {
+//	    GFileInfo *info;
+//	    if (!g_file_enumerator_iterate (direnum, &info, NULL, cancellable, error))
+//	      goto out;
+//	    if (!info)
+//	      break;
+//	    ... do stuff with "info"; do not unref it! ...
+//	  }
+//
+//	out:
+//	  g_object_unref (direnum); // Note: frees the last @info
 func (x *FileEnumerator) Iterate(OutInfoVar **FileInfo, OutChildVar **File, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xFileEnumeratorIterate(x.GoPointer(), OutInfoVar, OutChildVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -600,15 +605,15 @@ func (x *FileEnumerator) Iterate(OutInfoVar **FileInfo, OutChildVar **File, Canc
 var xFileEnumeratorNextFile func(uintptr, uintptr, **glib.Error) uintptr
 
 // Returns information for the next file in the enumerated object.
-// Will block until the information is available. The #GFileInfo
+// Will block until the information is available. The [FileInfo]
 // returned from this function will contain attributes that match the
-// attribute string that was passed when the #GFileEnumerator was created.
+// attribute string that was passed when the [FileEnumerator] was created.
 //
-// See the documentation of #GFileEnumerator for information about the
+// See the documentation of [FileEnumerator] for information about the
 // order of returned files.
 //
-// On error, returns %NULL and sets @error to the error. If the
-// enumerator is at the end, %NULL will be returned and @error will
+// On error, returns nil and sets error to the error. If the
+// enumerator is at the end, nil will be returned and error will
 // be unset.
 func (x *FileEnumerator) NextFile(CancellableVar *Cancellable) (*FileInfo, error) {
 	var cls *FileInfo
@@ -617,12 +622,15 @@ func (x *FileEnumerator) NextFile(CancellableVar *Cancellable) (*FileInfo, error
 	cret := xFileEnumeratorNextFile(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -631,76 +639,68 @@ func (x *FileEnumerator) NextFile(CancellableVar *Cancellable) (*FileInfo, error
 var xFileEnumeratorNextFilesAsync func(uintptr, int, int, uintptr, uintptr, uintptr)
 
 // Request information for a number of files from the enumerator asynchronously.
-// When all I/O for the operation is finished the @callback will be called with
+// When all I/O for the operation is finished the callback will be called with
 // the requested information.
 //
-// See the documentation of #GFileEnumerator for information about the
+// See the documentation of [FileEnumerator] for information about the
 // order of returned files.
 //
 // Once the end of the enumerator is reached, or if an error occurs, the
-// @callback will be called with an empty list. In this case, the previous call
-// to g_file_enumerator_next_files_async() will typically have returned fewer
-// than @num_files items.
+// callback will be called with an empty list. In this case, the previous call
+// to [FileEnumerator.NextFilesAsync] will typically have returned fewer
+// than num_files items.
 //
 // If a request is cancelled the callback will be called with
-// %G_IO_ERROR_CANCELLED.
+// [GIoErrorCancelledValue].
 //
 // This leads to the following pseudo-code usage:
-// |[
-// g_autoptr(GFile) dir = get_directory ();
-// g_autoptr(GFileEnumerator) enumerator = NULL;
-// g_autolist(GFileInfo) files = NULL;
-// g_autoptr(GError) local_error = NULL;
-//
-// enumerator = yield g_file_enumerate_children_async (dir,
-//
-//	G_FILE_ATTRIBUTE_STANDARD_NAME ","
-//	G_FILE_ATTRIBUTE_STANDARD_TYPE,
-//	G_FILE_QUERY_INFO_NONE,
-//	G_PRIORITY_DEFAULT,
-//	cancellable,
-//	…,
-//	&amp;local_error);
 //
-// if (enumerator == NULL)
+//	g_autoptr(GFile) dir = get_directory ();
+//	g_autoptr(GFileEnumerator) enumerator = NULL;
+//	g_autolist(GFileInfo) files = NULL;
+//	g_autoptr(GError) local_error = NULL;
 //
-//	g_error ("Error enumerating: %s", local_error-&gt;message);
-//
-// // Loop until no files are returned, either because the end of the enumerator
-// // has been reached, or an error was returned.
-// do
-//
-//	{
-//	  files = yield g_file_enumerator_next_files_async (enumerator,
-//	                                                    5,  // number of files to request
+//	enumerator = yield g_file_enumerate_children_async (dir,
+//	                                                    G_FILE_ATTRIBUTE_STANDARD_NAME ","
+//	                                                    G_FILE_ATTRIBUTE_STANDARD_TYPE,
+//	                                                    G_FILE_QUERY_INFO_NONE,
 //	                                                    G_PRIORITY_DEFAULT,
 //	                                                    cancellable,
 //	                                                    …,
-//	                                                    &amp;local_error);
-//
-//	  // Process the returned files, but don’t assume that exactly 5 were returned.
-//	  for (GList *l = files; l != NULL; l = l-&gt;next)
-//	    {
-//	      GFileInfo *info = l-&gt;data;
-//	      handle_file_info (info);
-//	    }
-//	}
-//
-// while (files != NULL);
-//
-// if (local_error != NULL &amp;&amp;
-//
-//	  !g_error_matches (local_error, G_IO_ERROR, G_IO_ERROR_CANCELLED))
-//	g_error ("Error while enumerating: %s", local_error-&gt;message);
-//
-// ]|
+//	                                                    &local_error);
+//	if (enumerator == NULL)
+//	  g_error ("Error enumerating: %s", local_error->message);
+//
+//	// Loop until no files are returned, either because the end of the enumerator
+//	// has been reached, or an error was returned.
+//	do
+//	  {
+//	    files = yield g_file_enumerator_next_files_async (enumerator,
+//	                                                      5,  // number of files to request
+//	                                                      G_PRIORITY_DEFAULT,
+//	                                                      cancellable,
+//	                                                      …,
+//	                                                      &local_error);
+//
+//	    // Process the returned files, but don’t assume that exactly 5 were returned.
+//	    for (GList *l = files; l != NULL; l = l->next)
+//	      {
+//	        GFileInfo *info = l->data;
+//	        handle_file_info (info);
+//	      }
+//	  }
+//	while (files != NULL);
+//
+//	if (local_error != NULL &&
+//	    !g_error_matches (local_error, G_IO_ERROR, G_IO_ERROR_CANCELLED))
+//	  g_error ("Error while enumerating: %s", local_error->message);
 //
 // During an async request no other sync and async calls are allowed, and will
-// result in %G_IO_ERROR_PENDING errors.
+// result in [GIoErrorPendingValue] errors.
 //
 // Any outstanding I/O request with higher priority (lower numerical value) will
 // be executed before an outstanding request with lower priority. Default
-// priority is %G_PRIORITY_DEFAULT.
+// priority is [glib.PRIORITY_DEFAULT].
 func (x *FileEnumerator) NextFilesAsync(NumFilesVar int, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -710,11 +710,12 @@ func (x *FileEnumerator) NextFilesAsync(NumFilesVar int, IoPriorityVar int, Canc
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -724,13 +725,13 @@ func (x *FileEnumerator) NextFilesAsync(NumFilesVar int, IoPriorityVar int, Canc
 
 var xFileEnumeratorNextFilesFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
-// Finishes the asynchronous operation started with g_file_enumerator_next_files_async().
+// Finishes the asynchronous operation started with [FileEnumerator.NextFilesAsync].
 func (x *FileEnumerator) NextFilesFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xFileEnumeratorNextFilesFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -761,7 +762,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -770,17 +771,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileEnumeratorGLibType, libs, "g_file_enumerator_get_type")
 
-	core.PuregoSafeRegister(&xFileEnumeratorClose, libs, "g_file_enumerator_close")
-	core.PuregoSafeRegister(&xFileEnumeratorCloseAsync, libs, "g_file_enumerator_close_async")
-	core.PuregoSafeRegister(&xFileEnumeratorCloseFinish, libs, "g_file_enumerator_close_finish")
-	core.PuregoSafeRegister(&xFileEnumeratorGetChild, libs, "g_file_enumerator_get_child")
-	core.PuregoSafeRegister(&xFileEnumeratorGetContainer, libs, "g_file_enumerator_get_container")
-	core.PuregoSafeRegister(&xFileEnumeratorHasPending, libs, "g_file_enumerator_has_pending")
-	core.PuregoSafeRegister(&xFileEnumeratorIsClosed, libs, "g_file_enumerator_is_closed")
-	core.PuregoSafeRegister(&xFileEnumeratorIterate, libs, "g_file_enumerator_iterate")
-	core.PuregoSafeRegister(&xFileEnumeratorNextFile, libs, "g_file_enumerator_next_file")
-	core.PuregoSafeRegister(&xFileEnumeratorNextFilesAsync, libs, "g_file_enumerator_next_files_async")
-	core.PuregoSafeRegister(&xFileEnumeratorNextFilesFinish, libs, "g_file_enumerator_next_files_finish")
-	core.PuregoSafeRegister(&xFileEnumeratorSetPending, libs, "g_file_enumerator_set_pending")
+	gobject.CheckClassSize("GFileEnumeratorClass", xFileEnumeratorGLibType, unsafe.Sizeof(FileEnumeratorClass{}))
+
+	core.PuregoRegisterSince(&xFileEnumeratorClose, libs, "g_file_enumerator_close", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorCloseAsync, libs, "g_file_enumerator_close_async", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorCloseFinish, libs, "g_file_enumerator_close_finish", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorGetChild, libs, "g_file_enumerator_get_child", "GIO", "2.36")
+	core.PuregoRegisterSince(&xFileEnumeratorGetContainer, libs, "g_file_enumerator_get_container", "GIO", "2.18")
+	core.PuregoRegisterSince(&xFileEnumeratorHasPending, libs, "g_file_enumerator_has_pending", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorIsClosed, libs, "g_file_enumerator_is_closed", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorIterate, libs, "g_file_enumerator_iterate", "GIO", "2.44")
+	core.PuregoRegisterSince(&xFileEnumeratorNextFile, libs, "g_file_enumerator_next_file", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorNextFilesAsync, libs, "g_file_enumerator_next_files_async", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorNextFilesFinish, libs, "g_file_enumerator_next_files_finish", "GIO", "")
+	core.PuregoRegisterSince(&xFileEnumeratorSetPending, libs, "g_file_enumerator_set_pending", "GIO", "")
 
 }
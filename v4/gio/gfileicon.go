@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +22,7 @@ func (x *FileIconClass) GoPointer() uintptr {
 // `GFileIcon` specifies an icon by pointing to an image file
 // to be used as icon.
 //
-// It implements [iface@Gio.LoadableIcon].
+// It implements [LoadableIcon].
 type FileIcon struct {
 	gobject.Object
 }
@@ -58,7 +57,7 @@ func NewFileIcon(FileVar File) *FileIcon {
 
 var xFileIconGetFile func(uintptr) uintptr
 
-// Gets the #GFile associated with the given @icon.
+// Gets the [File] associated with the given icon.
 func (x *FileIcon) GetFile() *FileBase {
 	var cls *FileBase
 
@@ -98,10 +97,10 @@ func (x *FileIcon) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *FileIcon) Serialize() *glib.Variant {
 
@@ -109,21 +108,21 @@ func (x *FileIcon) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *FileIcon) ToString() string {
 
@@ -132,7 +131,7 @@ func (x *FileIcon) ToString() string {
 }
 
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [LoadableIcon.LoadAsync].
 func (x *FileIcon) Load(SizeVar int, TypeVar *string, CancellableVar *Cancellable) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -140,27 +139,30 @@ func (x *FileIcon) Load(SizeVar int, TypeVar *string, CancellableVar *Cancellabl
 	cret := XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [LoadableIcon.Load].
 func (x *FileIcon) LoadAsync(SizeVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [LoadableIcon.LoadAsync].
 func (x *FileIcon) LoadFinish(ResVar AsyncResult, TypeVar *string) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -168,12 +170,15 @@ func (x *FileIcon) LoadFinish(ResVar AsyncResult, TypeVar *string) (*InputStream
 	cret := XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -184,7 +189,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -193,8 +198,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileIconGLibType, libs, "g_file_icon_get_type")
 
-	core.PuregoSafeRegister(&xNewFileIcon, libs, "g_file_icon_new")
+	core.PuregoRegisterSince(&xNewFileIcon, libs, "g_file_icon_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xFileIconGetFile, libs, "g_file_icon_get_file")
+	core.PuregoRegisterSince(&xFileIconGetFile, libs, "g_file_icon_get_file", "GIO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,137 +22,141 @@ func (x *FileInfoClass) GoPointer() uintptr {
 const (
 	// A key in the "access" namespace for checking deletion privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to delete the file.
+	// This attribute will be true if the user is able to delete the file.
 	FILE_ATTRIBUTE_ACCESS_CAN_DELETE string = "access::can-delete"
 	// A key in the "access" namespace for getting execution privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to execute the file.
+	// This attribute will be true if the user is able to execute the file.
 	FILE_ATTRIBUTE_ACCESS_CAN_EXECUTE string = "access::can-execute"
 	// A key in the "access" namespace for getting read privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to read the file.
+	// This attribute will be true if the user is able to read the file.
 	FILE_ATTRIBUTE_ACCESS_CAN_READ string = "access::can-read"
 	// A key in the "access" namespace for checking renaming privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to rename the file.
+	// This attribute will be true if the user is able to rename the file.
 	FILE_ATTRIBUTE_ACCESS_CAN_RENAME string = "access::can-rename"
 	// A key in the "access" namespace for checking trashing privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to move the file to
+	// This attribute will be true if the user is able to move the file to
 	// the trash.
 	FILE_ATTRIBUTE_ACCESS_CAN_TRASH string = "access::can-trash"
 	// A key in the "access" namespace for getting write privileges.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	//
-	// This attribute will be %TRUE if the user is able to write to the file.
+	// This attribute will be true if the user is able to write to the file.
 	FILE_ATTRIBUTE_ACCESS_CAN_WRITE string = "access::can-write"
 	// A key in the "dos" namespace for checking if the file's archive flag
 	// is set.
 	//
-	// This attribute is %TRUE if the archive flag is set.
+	// This attribute is true if the archive flag is set.
 	//
 	// This attribute is only available for DOS file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_DOS_IS_ARCHIVE string = "dos::is-archive"
 	// A key in the "dos" namespace for checking if the file is a NTFS mount point
 	// (a volume mount or a junction point).
 	//
-	// This attribute is %TRUE if file is a reparse point of type
-	// [IO_REPARSE_TAG_MOUNT_POINT](https://msdn.microsoft.com/en-us/library/dd541667.aspx).
+	// This attribute is true if file is a reparse point of type
+	// [IO_REPARSE_TAG_MOUNT_POINT].
 	//
 	// This attribute is only available for DOS file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
+	//
+	// [IO_REPARSE_TAG_MOUNT_POINT]: https://msdn.microsoft.com/en-us/library/dd541667.aspx
 	FILE_ATTRIBUTE_DOS_IS_MOUNTPOINT string = "dos::is-mountpoint"
 	// A key in the "dos" namespace for checking if the file's backup flag
 	// is set.
 	//
-	// This attribute is %TRUE if the backup flag is set.
+	// This attribute is true if the backup flag is set.
 	//
 	// This attribute is only available for DOS file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_DOS_IS_SYSTEM string = "dos::is-system"
 	// A key in the "dos" namespace for getting the file NTFS reparse tag.
 	//
 	// This value is 0 for files that are not reparse points.
 	//
-	// See the [Reparse Tags](https://msdn.microsoft.com/en-us/library/dd541667.aspx)
+	// See the [Reparse Tags]
 	// page for possible reparse tag values.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
+	//
+	// [Reparse Tags]: https://msdn.microsoft.com/en-us/library/dd541667.aspx
 	FILE_ATTRIBUTE_DOS_REPARSE_POINT_TAG string = "dos::reparse-point-tag"
 	// A key in the "etag" namespace for getting the value of the file's
 	// entity tag.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_ETAG_VALUE string = "etag::value"
 	// A key in the "filesystem" namespace for getting the number of bytes
 	// of free space left on the file system.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_FILESYSTEM_FREE string = "filesystem::free"
 	// A key in the "filesystem" namespace for checking if the file system
 	// is read only.
 	//
-	// Is set to %TRUE if the file system is read only.
+	// Is set to true if the file system is read only.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_FILESYSTEM_READONLY string = "filesystem::readonly"
 	// A key in the "filesystem" namespace for checking if the file system
 	// is remote.
 	//
-	// Is set to %TRUE if the file system is remote.
+	// Is set to true if the file system is remote.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_FILESYSTEM_REMOTE string = "filesystem::remote"
 	// A key in the "filesystem" namespace for getting the total size (in
-	// bytes) of the file system, used in g_file_query_filesystem_info().
+	// bytes) of the file system, used in [File.QueryFilesystemInfo].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_FILESYSTEM_SIZE string = "filesystem::size"
 	// A key in the "filesystem" namespace for getting the file system's type.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_FILESYSTEM_TYPE string = "filesystem::type"
 	// A key in the "filesystem" namespace for getting the number of bytes
 	// used by data on the file system.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_FILESYSTEM_USED string = "filesystem::used"
 	// A key in the "filesystem" namespace for hinting a file manager
 	// application whether it should preview (e.g. thumbnail) files on the
 	// file system.
 	//
-	// The value for this key contain a #GFilesystemPreviewType.
+	// The value for this key contain a [FilesystemPreviewType].
 	FILE_ATTRIBUTE_FILESYSTEM_USE_PREVIEW string = "filesystem::use-preview"
 	// A key in the "gvfs" namespace that gets the name of the current
 	// GVFS backend in use.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_GVFS_BACKEND string = "gvfs::backend"
 	// A key in the "id" namespace for getting a file identifier.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	//
 	// An example use would be during listing files, to avoid recursive
 	// directory scanning.
 	FILE_ATTRIBUTE_ID_FILE string = "id::file"
 	// A key in the "id" namespace for getting the file system identifier.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	//
 	// An example use would be during drag and drop to see if the source
 	// and target are on the same filesystem (default to move) or not (default
@@ -162,92 +165,92 @@ const (
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) can be ejected.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_EJECT string = "mountable::can-eject"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) is mountable.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_MOUNT string = "mountable::can-mount"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) can be polled.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_POLL string = "mountable::can-poll"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) can be started.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_START string = "mountable::can-start"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) can be started degraded.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_START_DEGRADED string = "mountable::can-start-degraded"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) can be stopped.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_STOP string = "mountable::can-stop"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE)  is unmountable.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_CAN_UNMOUNT string = "mountable::can-unmount"
 	// A key in the "mountable" namespace for getting the HAL UDI for the mountable
 	// file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_MOUNTABLE_HAL_UDI string = "mountable::hal-udi"
 	// A key in the "mountable" namespace for checking if a file (of
 	// type G_FILE_TYPE_MOUNTABLE) is automatically polled for media.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_MOUNTABLE_IS_MEDIA_CHECK_AUTOMATIC string = "mountable::is-media-check-automatic"
-	// A key in the "mountable" namespace for getting the #GDriveStartStopType.
+	// A key in the "mountable" namespace for getting the [DriveStartStopType].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_MOUNTABLE_START_STOP_TYPE string = "mountable::start-stop-type"
 	// A key in the "mountable" namespace for getting the unix device.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_MOUNTABLE_UNIX_DEVICE string = "mountable::unix-device"
 	// A key in the "mountable" namespace for getting the unix device file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_MOUNTABLE_UNIX_DEVICE_FILE string = "mountable::unix-device-file"
 	// A key in the "owner" namespace for getting the file owner's group.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_OWNER_GROUP string = "owner::group"
 	// A key in the "owner" namespace for getting the user name of the
 	// file's owner.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_OWNER_USER string = "owner::user"
 	// A key in the "owner" namespace for getting the real name of the
 	// user that owns the file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_OWNER_USER_REAL string = "owner::user-real"
-	// A key in the "preview" namespace for getting a #GIcon that can be
+	// A key in the "preview" namespace for getting a [Icon] that can be
 	// used to get preview of the file.
 	//
 	// For example, it may be a low resolution thumbnail without metadata.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_OBJECT.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeObjectValue].
 	//
-	// The value for this key should contain a #GIcon.
+	// The value for this key should contain a [Icon].
 	FILE_ATTRIBUTE_PREVIEW_ICON string = "preview::icon"
 	// A key in the "recent" namespace for getting time, when the metadata for the
 	// file in `recent:///` was last changed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_INT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeInt64Value].
 	FILE_ATTRIBUTE_RECENT_MODIFIED string = "recent::modified"
 	// A key in the "selinux" namespace for getting the file's SELinux
 	// context.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	//
 	// Note that this attribute is only available if GLib has been built
 	// with SELinux support.
@@ -258,11 +261,11 @@ const (
 	// This will generally be larger than the file size (due to block size
 	// overhead) but can occasionally be smaller (for example, for sparse files).
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_STANDARD_ALLOCATED_SIZE string = "standard::allocated-size"
 	// A key in the "standard" namespace for getting the content type of the file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	//
 	// The value for this key should contain a valid content type.
 	FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE string = "standard::content-type"
@@ -274,7 +277,7 @@ const (
 	// might have a different encoding. If the filename is not a valid string in the
 	// encoding selected for the filesystem it is in then the copy name will not be set.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_COPY_NAME string = "standard::copy-name"
 	// A key in the "standard" namespace for getting the description of the file.
 	//
@@ -284,14 +287,14 @@ const (
 	// for a file in the trash. This is useful for instance as the window title
 	// when displaying a directory or for a bookmarks menu.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_DESCRIPTION string = "standard::description"
 	// A key in the "standard" namespace for getting the display name of the file.
 	//
 	// A display name is guaranteed to be in UTF-8 and can thus be displayed in
 	// the UI. It is guaranteed to be set on every file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME string = "standard::display-name"
 	// A key in the "standard" namespace for edit name of the file.
 	//
@@ -300,7 +303,7 @@ const (
 	// might contain information you don't want in the new filename (such as
 	// "(invalid unicode)" if the filename was in an invalid encoding).
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_EDIT_NAME string = "standard::edit-name"
 	// A key in the "standard" namespace for getting the fast content type.
 	//
@@ -308,21 +311,21 @@ const (
 	// only uses the filename to guess it, but it is faster to calculate than the
 	// regular content type.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_FAST_CONTENT_TYPE string = "standard::fast-content-type"
 	// A key in the "standard" namespace for getting the icon for the file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_OBJECT.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeObjectValue].
 	//
-	// The value for this key should contain a #GIcon.
+	// The value for this key should contain a [Icon].
 	FILE_ATTRIBUTE_STANDARD_ICON string = "standard::icon"
 	// A key in the "standard" namespace for checking if a file is a backup file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_STANDARD_IS_BACKUP string = "standard::is-backup"
 	// A key in the "standard" namespace for checking if a file is hidden.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_STANDARD_IS_HIDDEN string = "standard::is-hidden"
 	// A key in the "standard" namespace for checking if the file is a symlink.
 	// Typically the actual type is something else, if we followed the symlink
@@ -330,18 +333,18 @@ const (
 	//
 	// On Windows NTFS mountpoints are considered to be symlinks as well.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_STANDARD_IS_SYMLINK string = "standard::is-symlink"
 	// A key in the "standard" namespace for checking if a file is virtual.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_STANDARD_IS_VIRTUAL string = "standard::is-virtual"
 	// A key in the "standard" namespace for checking if a file is
 	// volatile. This is meant for opaque, non-POSIX-like backends to
 	// indicate that the URI is not persistent. Applications should look
-	// at %G_FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET for the persistent URI.
+	// at [FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET] for the persistent URI.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_STANDARD_IS_VOLATILE string = "standard::is-volatile"
 	// A key in the "standard" namespace for getting the name of the file.
 	//
@@ -349,18 +352,18 @@ const (
 	// and can thus not be generally displayed as is. It is guaranteed to be set on
 	// every file.
 	//
-	// Use %G_FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME if you need to display the
+	// Use [FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME] if you need to display the
 	// name in a user interface.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_STANDARD_NAME string = "standard::name"
 	// A key in the "standard" namespace for getting the file's size (in bytes).
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_STANDARD_SIZE string = "standard::size"
 	// A key in the "standard" namespace for setting the sort order of a file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_INT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeInt32Value].
 	//
 	// An example use would be in file managers, which would use this key
 	// to set the order files are displayed. Files with smaller sort order
@@ -369,170 +372,170 @@ const (
 	FILE_ATTRIBUTE_STANDARD_SORT_ORDER string = "standard::sort-order"
 	// A key in the "standard" namespace for getting the symbolic icon for the file.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_OBJECT.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeObjectValue].
 	//
-	// The value for this key should contain a #GIcon.
+	// The value for this key should contain a [Icon].
 	FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON string = "standard::symbolic-icon"
 	// A key in the "standard" namespace for getting the symlink target, if the file
 	// is a symlink.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET string = "standard::symlink-target"
 	// A key in the "standard" namespace for getting the target URI for the file, in
-	// the case of %G_FILE_TYPE_SHORTCUT or %G_FILE_TYPE_MOUNTABLE files.
+	// the case of [GFileTypeShortcutValue] or [GFileTypeMountableValue] files.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_STANDARD_TARGET_URI string = "standard::target-uri"
 	// A key in the "standard" namespace for storing file types.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	//
-	// The value for this key should contain a #GFileType.
+	// The value for this key should contain a [FileType].
 	FILE_ATTRIBUTE_STANDARD_TYPE string = "standard::type"
 	// A key in the "thumbnail" namespace for checking if thumbnailing failed.
 	//
-	// This attribute is %TRUE if thumbnailing failed.
+	// This attribute is true if thumbnailing failed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAILING_FAILED string = "thumbnail::failed"
 	// A key in the "thumbnail" namespace for checking if thumbnailing failed
 	// for the large image.
 	//
-	// This attribute is %TRUE if thumbnailing failed.
+	// This attribute is true if thumbnailing failed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAILING_FAILED_LARGE string = "thumbnail::failed-large"
 	// A key in the "thumbnail" namespace for checking if thumbnailing failed
 	// for the normal image.
 	//
-	// This attribute is %TRUE if thumbnailing failed.
+	// This attribute is true if thumbnailing failed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAILING_FAILED_NORMAL string = "thumbnail::failed-normal"
 	// A key in the "thumbnail" namespace for checking if thumbnailing failed
 	// for the x-large image.
 	//
-	// This attribute is %TRUE if thumbnailing failed.
+	// This attribute is true if thumbnailing failed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAILING_FAILED_XLARGE string = "thumbnail::failed-xlarge"
 	// A key in the "thumbnail" namespace for checking if thumbnailing failed
 	// for the xx-large image.
 	//
-	// This attribute is %TRUE if thumbnailing failed.
+	// This attribute is true if thumbnailing failed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAILING_FAILED_XXLARGE string = "thumbnail::failed-xxlarge"
 	// A key in the "thumbnail" namespace for checking whether the thumbnail is outdated.
 	//
-	// This attribute is %TRUE if the thumbnail is up-to-date with the file it represents,
-	// and %FALSE if the file has been modified since the thumbnail was generated.
+	// This attribute is true if the thumbnail is up-to-date with the file it represents,
+	// and false if the file has been modified since the thumbnail was generated.
 	//
-	// If %G_FILE_ATTRIBUTE_THUMBNAILING_FAILED is %TRUE and this attribute is %FALSE,
+	// If [FILE_ATTRIBUTE_THUMBNAILING_FAILED] is true and this attribute is false,
 	// it indicates that thumbnailing may be attempted again and may succeed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAIL_IS_VALID string = "thumbnail::is-valid"
 	// A key in the "thumbnail" namespace for checking whether the large
 	// thumbnail is outdated.
 	//
-	// This attribute is %TRUE if the large thumbnail is up-to-date with the file
-	// it represents, and %FALSE if the file has been modified since the thumbnail
+	// This attribute is true if the large thumbnail is up-to-date with the file
+	// it represents, and false if the file has been modified since the thumbnail
 	// was generated.
 	//
-	// If %G_FILE_ATTRIBUTE_THUMBNAILING_FAILED_LARGE is %TRUE and this attribute
-	// is %FALSE, it indicates that thumbnailing may be attempted again and may
+	// If [FILE_ATTRIBUTE_THUMBNAILING_FAILED_LARGE] is true and this attribute
+	// is false, it indicates that thumbnailing may be attempted again and may
 	// succeed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAIL_IS_VALID_LARGE string = "thumbnail::is-valid-large"
 	// A key in the "thumbnail" namespace for checking whether the normal
 	// thumbnail is outdated.
 	//
-	// This attribute is %TRUE if the normal thumbnail is up-to-date with the file
-	// it represents, and %FALSE if the file has been modified since the thumbnail
+	// This attribute is true if the normal thumbnail is up-to-date with the file
+	// it represents, and false if the file has been modified since the thumbnail
 	// was generated.
 	//
-	// If %G_FILE_ATTRIBUTE_THUMBNAILING_FAILED_NORMAL is %TRUE and this attribute
-	// is %FALSE, it indicates that thumbnailing may be attempted again and may
+	// If [FILE_ATTRIBUTE_THUMBNAILING_FAILED_NORMAL] is true and this attribute
+	// is false, it indicates that thumbnailing may be attempted again and may
 	// succeed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAIL_IS_VALID_NORMAL string = "thumbnail::is-valid-normal"
 	// A key in the "thumbnail" namespace for checking whether the x-large
 	// thumbnail is outdated.
 	//
-	// This attribute is %TRUE if the x-large thumbnail is up-to-date with the file
-	// it represents, and %FALSE if the file has been modified since the thumbnail
+	// This attribute is true if the x-large thumbnail is up-to-date with the file
+	// it represents, and false if the file has been modified since the thumbnail
 	// was generated.
 	//
-	// If %G_FILE_ATTRIBUTE_THUMBNAILING_FAILED_XLARGE is %TRUE and this attribute
-	// is %FALSE, it indicates that thumbnailing may be attempted again and may
+	// If [FILE_ATTRIBUTE_THUMBNAILING_FAILED_XLARGE] is true and this attribute
+	// is false, it indicates that thumbnailing may be attempted again and may
 	// succeed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAIL_IS_VALID_XLARGE string = "thumbnail::is-valid-xlarge"
 	// A key in the "thumbnail" namespace for checking whether the xx-large
 	// thumbnail is outdated.
 	//
-	// This attribute is %TRUE if the x-large thumbnail is up-to-date with the file
-	// it represents, and %FALSE if the file has been modified since the thumbnail
+	// This attribute is true if the x-large thumbnail is up-to-date with the file
+	// it represents, and false if the file has been modified since the thumbnail
 	// was generated.
 	//
-	// If %G_FILE_ATTRIBUTE_THUMBNAILING_FAILED_XXLARGE is %TRUE and this attribute
-	// is %FALSE, it indicates that thumbnailing may be attempted again and may
+	// If [FILE_ATTRIBUTE_THUMBNAILING_FAILED_XXLARGE] is true and this attribute
+	// is false, it indicates that thumbnailing may be attempted again and may
 	// succeed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_THUMBNAIL_IS_VALID_XXLARGE string = "thumbnail::is-valid-xxlarge"
 	// A key in the "thumbnail" namespace for getting the path to the thumbnail
 	// image with the biggest size available.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_THUMBNAIL_PATH string = "thumbnail::path"
 	// A key in the "thumbnail" namespace for getting the path to the large
 	// thumbnail image.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_THUMBNAIL_PATH_LARGE string = "thumbnail::path-large"
 	// A key in the "thumbnail" namespace for getting the path to the normal
 	// thumbnail image.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_THUMBNAIL_PATH_NORMAL string = "thumbnail::path-normal"
 	// A key in the "thumbnail" namespace for getting the path to the x-large
 	// thumbnail image.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_THUMBNAIL_PATH_XLARGE string = "thumbnail::path-xlarge"
 	// A key in the "thumbnail" namespace for getting the path to the xx-large
 	// thumbnail image.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_THUMBNAIL_PATH_XXLARGE string = "thumbnail::path-xxlarge"
 	// A key in the "time" namespace for getting the time the file was last
 	// accessed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64, and
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value], and
 	// contains the time since the file was last accessed, in seconds since the
 	// UNIX epoch.
 	FILE_ATTRIBUTE_TIME_ACCESS string = "time::access"
 	// A key in the "time" namespace for getting the nanoseconds of the time
 	// the file was last accessed. This should be used in conjunction with
-	// #G_FILE_ATTRIBUTE_TIME_ACCESS. Corresponding #GFileAttributeType is
-	// %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// G_FILE_ATTRIBUTE_TIME_ACCESS. Corresponding [FileAttributeType] is
+	// [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_ACCESS_NSEC string = "time::access-nsec"
 	// A key in the "time" namespace for getting the microseconds of the time
 	// the file was last accessed.
 	//
-	// This should be used in conjunction with %G_FILE_ATTRIBUTE_TIME_ACCESS.
+	// This should be used in conjunction with [FILE_ATTRIBUTE_TIME_ACCESS].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_ACCESS_USEC string = "time::access-usec"
 	// A key in the "time" namespace for getting the time the file was last
 	// changed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64,
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value],
 	// and contains the time since the file was last changed, in seconds since
 	// the UNIX epoch.
 	//
@@ -540,19 +543,19 @@ const (
 	FILE_ATTRIBUTE_TIME_CHANGED string = "time::changed"
 	// A key in the "time" namespace for getting the nanoseconds of the time
 	// the file was last changed. This should be used in conjunction with
-	// #G_FILE_ATTRIBUTE_TIME_CHANGED. Corresponding #GFileAttributeType is
-	// %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// G_FILE_ATTRIBUTE_TIME_CHANGED. Corresponding [FileAttributeType] is
+	// [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_CHANGED_NSEC string = "time::changed-nsec"
 	// A key in the "time" namespace for getting the microseconds of the time
 	// the file was last changed.
 	//
-	// This should be used in conjunction with %G_FILE_ATTRIBUTE_TIME_CHANGED.
+	// This should be used in conjunction with [FILE_ATTRIBUTE_TIME_CHANGED].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_CHANGED_USEC string = "time::changed-usec"
 	// A key in the "time" namespace for getting the time the file was created.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64,
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value],
 	// and contains the time since the file was created, in seconds since the UNIX
 	// epoch.
 	//
@@ -561,95 +564,95 @@ const (
 	FILE_ATTRIBUTE_TIME_CREATED string = "time::created"
 	// A key in the "time" namespace for getting the nanoseconds of the time
 	// the file was created. This should be used in conjunction with
-	// #G_FILE_ATTRIBUTE_TIME_CREATED. Corresponding #GFileAttributeType is
-	// %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// G_FILE_ATTRIBUTE_TIME_CREATED. Corresponding [FileAttributeType] is
+	// [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_CREATED_NSEC string = "time::created-nsec"
 	// A key in the "time" namespace for getting the microseconds of the time
 	// the file was created.
 	//
-	// This should be used in conjunction with %G_FILE_ATTRIBUTE_TIME_CREATED.
+	// This should be used in conjunction with [FILE_ATTRIBUTE_TIME_CREATED].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_CREATED_USEC string = "time::created-usec"
 	// A key in the "time" namespace for getting the time the file was last
 	// modified.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64, and
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value], and
 	// contains the time since the file was modified, in seconds since the UNIX
 	// epoch.
 	FILE_ATTRIBUTE_TIME_MODIFIED string = "time::modified"
 	// A key in the "time" namespace for getting the nanoseconds of the time
 	// the file was last modified. This should be used in conjunction with
-	// #G_FILE_ATTRIBUTE_TIME_MODIFIED. Corresponding #GFileAttributeType is
-	// %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// G_FILE_ATTRIBUTE_TIME_MODIFIED. Corresponding [FileAttributeType] is
+	// [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_MODIFIED_NSEC string = "time::modified-nsec"
 	// A key in the "time" namespace for getting the microseconds of the time
 	// the file was last modified.
 	//
-	// This should be used in conjunction with %G_FILE_ATTRIBUTE_TIME_MODIFIED.
+	// This should be used in conjunction with [FILE_ATTRIBUTE_TIME_MODIFIED].
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TIME_MODIFIED_USEC string = "time::modified-usec"
 	// A key in the "trash" namespace for getting the deletion date and time
 	// of a file inside the `trash:///` folder.
 	//
 	// The format of the returned string is `YYYY-MM-DDThh:mm:ss`.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeStringValue].
 	FILE_ATTRIBUTE_TRASH_DELETION_DATE string = "trash::deletion-date"
 	// A key in the "trash" namespace for getting the number of (toplevel) items
 	// that are present in the `trash:///` folder.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_TRASH_ITEM_COUNT string = "trash::item-count"
 	// A key in the "trash" namespace for getting the original path of a file
 	// inside the `trash:///` folder before it was trashed.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BYTE_STRING.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeByteStringValue].
 	FILE_ATTRIBUTE_TRASH_ORIG_PATH string = "trash::orig-path"
 	// A key in the "unix" namespace for getting the number of blocks allocated
 	// for the file.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_UNIX_BLOCKS string = "unix::blocks"
 	// A key in the "unix" namespace for getting the block size for the file
 	// system.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_BLOCK_SIZE string = "unix::block-size"
 	// A key in the "unix" namespace for getting the device id of the device the
 	// file is located on (see stat() documentation).
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_DEVICE string = "unix::device"
 	// A key in the "unix" namespace for getting the group ID for the file.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_GID string = "unix::gid"
 	// A key in the "unix" namespace for getting the inode of the file.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT64.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint64Value].
 	FILE_ATTRIBUTE_UNIX_INODE string = "unix::inode"
 	// A key in the "unix" namespace for checking if the file represents a
 	// UNIX mount point.
 	//
-	// This attribute is %TRUE if the file is a UNIX mount point.
+	// This attribute is true if the file is a UNIX mount point.
 	//
 	// Since 2.58, `/` is considered to be a mount point.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_BOOLEAN.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeBooleanValue].
 	FILE_ATTRIBUTE_UNIX_IS_MOUNTPOINT string = "unix::is-mountpoint"
 	// A key in the "unix" namespace for getting the mode of the file
 	// (e.g. whether the file is a regular file, symlink, etc).
@@ -660,7 +663,7 @@ const (
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_MODE string = "unix::mode"
 	// A key in the "unix" namespace for getting the number of hard links
 	// for a file.
@@ -669,7 +672,7 @@ const (
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_NLINK string = "unix::nlink"
 	// A key in the "unix" namespace for getting the device ID for the file
 	// (if it is a special file).
@@ -678,51 +681,51 @@ const (
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_RDEV string = "unix::rdev"
 	// A key in the "unix" namespace for getting the user ID for the file.
 	//
 	// This attribute is only available for UNIX file systems.
 	//
-	// Corresponding #GFileAttributeType is %G_FILE_ATTRIBUTE_TYPE_UINT32.
+	// Corresponding [FileAttributeType] is [GFileAttributeTypeUint32Value].
 	FILE_ATTRIBUTE_UNIX_UID string = "unix::uid"
 )
 
-// Stores information about a file system object referenced by a [iface@Gio.File].
+// Stores information about a file system object referenced by a [File].
 //
 // Functionality for manipulating basic metadata for files. `GFileInfo`
 // implements methods for getting information that all files should
 // contain, and allows for manipulation of extended attributes.
 //
-// See the [file attributes](file-attributes.html) document for more
+// See the file attributes document for more
 // information on how GIO handles file attributes.
 //
-// To obtain a `GFileInfo` for a [iface@Gio.File], use
-// [method@Gio.File.query_info] (or its async variant). To obtain a `GFileInfo`
-// for a file input or output stream, use [method@Gio.FileInputStream.query_info]
-// or [method@Gio.FileOutputStream.query_info] (or their async variants).
+// To obtain a `GFileInfo` for a [File], use
+// [File.QueryInfo] (or its async variant). To obtain a `GFileInfo`
+// for a file input or output stream, use [FileInputStream.QueryInfo]
+// or [FileOutputStream.QueryInfo] (or their async variants).
 //
 // To change the actual attributes of a file, you should then set the
-// attribute in the `GFileInfo` and call [method@Gio.File.set_attributes_from_info]
-// or [method@Gio.File.set_attributes_async] on a `GFile`.
+// attribute in the `GFileInfo` and call [File.SetAttributesFromInfo]
+// or [File.SetAttributesAsync] on a `GFile`.
 //
 // However, not all attributes can be changed in the file. For instance,
-// the actual size of a file cannot be changed via [method@Gio.FileInfo.set_size].
-// You may call [method@Gio.File.query_settable_attributes] and
-// [method@Gio.File.query_writable_namespaces] to discover the settable attributes
+// the actual size of a file cannot be changed via [FileInfo.SetSize].
+// You may call [File.QuerySettableAttributes] and
+// [File.QueryWritableNamespaces] to discover the settable attributes
 // of a particular file at runtime.
 //
-// The direct accessors, such as [method@Gio.FileInfo.get_name], are slightly more
+// The direct accessors, such as [FileInfo.GetName], are slightly more
 // optimized than the generic attribute accessors, such as
-// [method@Gio.FileInfo.get_attribute_byte_string].This optimization will matter
+// [FileInfo.GetAttributeByteString].This optimization will matter
 // only if calling the API in a tight loop.
 //
 // It is an error to call these accessors without specifying their required file
 // attributes when creating the `GFileInfo`. Use
-// [method@Gio.FileInfo.has_attribute] or [method@Gio.FileInfo.list_attributes]
+// [FileInfo.HasAttribute] or [FileInfo.ListAttributes]
 // to check what attributes are specified for a `GFileInfo`.
 //
-// [struct@Gio.FileAttributeMatcher] allows for searching through a `GFileInfo`
+// [FileAttributeMatcher] allows for searching through a `GFileInfo`
 // for attributes.
 type FileInfo struct {
 	gobject.Object
@@ -758,7 +761,7 @@ func NewFileInfo() *FileInfo {
 
 var xFileInfoClearStatus func(uintptr)
 
-// Clears the status information from @info.
+// Clears the status information from info.
 func (x *FileInfo) ClearStatus() {
 
 	xFileInfoClearStatus(x.GoPointer())
@@ -767,8 +770,8 @@ func (x *FileInfo) ClearStatus() {
 
 var xFileInfoCopyInto func(uintptr, uintptr)
 
-// First clears all of the [GFileAttribute](file-attributes.html#file-attributes) of
-// @dest_info, and then copies all of the file attributes from @src_info to @dest_info.
+// First clears all of the GFileAttribute of
+// dest_info, and then copies all of the file attributes from src_info to dest_info.
 func (x *FileInfo) CopyInto(DestInfoVar *FileInfo) {
 
 	xFileInfoCopyInto(x.GoPointer(), DestInfoVar.GoPointer())
@@ -793,16 +796,16 @@ func (x *FileInfo) Dup() *FileInfo {
 
 var xFileInfoGetAccessDateTime func(uintptr) *glib.DateTime
 
-// Gets the access time of the current @info and returns it as a
-// #GDateTime.
+// Gets the access time of the current info and returns it as a
+// [glib.DateTime].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_TIME_ACCESS. If %G_FILE_ATTRIBUTE_TIME_ACCESS_USEC is
-// provided, the resulting #GDateTime will additionally have microsecond
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_TIME_ACCESS]. If [FILE_ATTRIBUTE_TIME_ACCESS_USEC] is
+// provided, the resulting [glib.DateTime] will additionally have microsecond
 // precision.
 //
-// If nanosecond precision is needed, %G_FILE_ATTRIBUTE_TIME_ACCESS_NSEC must
-// be queried separately using g_file_info_get_attribute_uint32().
+// If nanosecond precision is needed, [FILE_ATTRIBUTE_TIME_ACCESS_NSEC] must
+// be queried separately using [FileInfo.GetAttributeUint32].
 func (x *FileInfo) GetAccessDateTime() *glib.DateTime {
 
 	cret := xFileInfoGetAccessDateTime(x.GoPointer())
@@ -818,19 +821,16 @@ var xFileInfoGetAttributeAsString func(uintptr, string) string
 // format.
 //
 // To format file name attributes of type
-// [enum@Gio.FileAttributeType.BYTE_STRING] for output as UTF-8, use
-// [func@GLib.filename_to_utf8] instead:
-// ```c
-// const char *trash_orig_path_byte_string;
-// g_autofree char *trash_orig_path_utf8 = NULL;
+// FileAttributeType.BYTE_STRING for output as UTF-8, use
+// [glib.FilenameToUtf8] instead:
 //
-// trash_orig_path_byte_string = g_file_info_get_attribute_byte_string (info, G_FILE_ATTRIBUTE_TRASH_ORIG_PATH);
-// trash_orig_path_utf8 = g_filename_to_utf8 (trash_orig_path_byte_string, -1, NULL, NULL, NULL);
-// if (trash_orig_path_utf8 != NULL)
+//	const char *trash_orig_path_byte_string;
+//	g_autofree char *trash_orig_path_utf8 = NULL;
 //
-//	g_message ("Some larger UTF-8 string with filename embedded as %s", trash_orig_path_utf8);
-//
-// ```
+//	trash_orig_path_byte_string = g_file_info_get_attribute_byte_string (info, G_FILE_ATTRIBUTE_TRASH_ORIG_PATH);
+//	trash_orig_path_utf8 = g_filename_to_utf8 (trash_orig_path_byte_string, -1, NULL, NULL, NULL);
+//	if (trash_orig_path_utf8 != NULL)
+//	  g_message ("Some larger UTF-8 string with filename embedded as %s", trash_orig_path_utf8);
 func (x *FileInfo) GetAttributeAsString(AttributeVar string) string {
 
 	cret := xFileInfoGetAttributeAsString(x.GoPointer(), AttributeVar)
@@ -840,7 +840,7 @@ func (x *FileInfo) GetAttributeAsString(AttributeVar string) string {
 var xFileInfoGetAttributeBoolean func(uintptr, string) bool
 
 // Gets the value of a boolean attribute. If the attribute does not
-// contain a boolean value, %FALSE will be returned.
+// contain a boolean value, false will be returned.
 func (x *FileInfo) GetAttributeBoolean(AttributeVar string) bool {
 
 	cret := xFileInfoGetAttributeBoolean(x.GoPointer(), AttributeVar)
@@ -850,7 +850,7 @@ func (x *FileInfo) GetAttributeBoolean(AttributeVar string) bool {
 var xFileInfoGetAttributeByteString func(uintptr, string) string
 
 // Gets the value of a byte string attribute. If the attribute does
-// not contain a byte string, %NULL will be returned.
+// not contain a byte string, nil will be returned.
 func (x *FileInfo) GetAttributeByteString(AttributeVar string) string {
 
 	cret := xFileInfoGetAttributeByteString(x.GoPointer(), AttributeVar)
@@ -904,8 +904,8 @@ func (x *FileInfo) GetAttributeInt64(AttributeVar string) int64 {
 
 var xFileInfoGetAttributeObject func(uintptr, string) uintptr
 
-// Gets the value of a #GObject attribute. If the attribute does
-// not contain a #GObject, %NULL will be returned.
+// Gets the value of a [gobject.Object] attribute. If the attribute does
+// not contain a [gobject.Object], nil will be returned.
 func (x *FileInfo) GetAttributeObject(AttributeVar string) *gobject.Object {
 	var cls *gobject.Object
 
@@ -932,7 +932,7 @@ func (x *FileInfo) GetAttributeStatus(AttributeVar string) FileAttributeStatus {
 var xFileInfoGetAttributeString func(uintptr, string) string
 
 // Gets the value of a string attribute. If the attribute does
-// not contain a string, %NULL will be returned.
+// not contain a string, nil will be returned.
 func (x *FileInfo) GetAttributeString(AttributeVar string) string {
 
 	cret := xFileInfoGetAttributeString(x.GoPointer(), AttributeVar)
@@ -942,7 +942,7 @@ func (x *FileInfo) GetAttributeString(AttributeVar string) string {
 var xFileInfoGetAttributeStringv func(uintptr, string) []string
 
 // Gets the value of a stringv attribute. If the attribute does
-// not contain a stringv, %NULL will be returned.
+// not contain a stringv, nil will be returned.
 func (x *FileInfo) GetAttributeStringv(AttributeVar string) []string {
 
 	cret := xFileInfoGetAttributeStringv(x.GoPointer(), AttributeVar)
@@ -984,8 +984,8 @@ var xFileInfoGetContentType func(uintptr) string
 
 // Gets the file's content type.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE].
 func (x *FileInfo) GetContentType() string {
 
 	cret := xFileInfoGetContentType(x.GoPointer())
@@ -994,16 +994,16 @@ func (x *FileInfo) GetContentType() string {
 
 var xFileInfoGetCreationDateTime func(uintptr) *glib.DateTime
 
-// Gets the creation time of the current @info and returns it as a
-// #GDateTime.
+// Gets the creation time of the current info and returns it as a
+// [glib.DateTime].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_TIME_CREATED. If %G_FILE_ATTRIBUTE_TIME_CREATED_USEC is
-// provided, the resulting #GDateTime will additionally have microsecond
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_TIME_CREATED]. If [FILE_ATTRIBUTE_TIME_CREATED_USEC] is
+// provided, the resulting [glib.DateTime] will additionally have microsecond
 // precision.
 //
-// If nanosecond precision is needed, %G_FILE_ATTRIBUTE_TIME_CREATED_NSEC must
-// be queried separately using g_file_info_get_attribute_uint32().
+// If nanosecond precision is needed, [FILE_ATTRIBUTE_TIME_CREATED_NSEC] must
+// be queried separately using [FileInfo.GetAttributeUint32].
 func (x *FileInfo) GetCreationDateTime() *glib.DateTime {
 
 	cret := xFileInfoGetCreationDateTime(x.GoPointer())
@@ -1012,9 +1012,9 @@ func (x *FileInfo) GetCreationDateTime() *glib.DateTime {
 
 var xFileInfoGetDeletionDate func(uintptr) *glib.DateTime
 
-// Returns the #GDateTime representing the deletion date of the file, as
-// available in %G_FILE_ATTRIBUTE_TRASH_DELETION_DATE. If the
-// %G_FILE_ATTRIBUTE_TRASH_DELETION_DATE attribute is unset, %NULL is returned.
+// Returns the [glib.DateTime] representing the deletion date of the file, as
+// available in [FILE_ATTRIBUTE_TRASH_DELETION_DATE]. If the
+// [FILE_ATTRIBUTE_TRASH_DELETION_DATE] attribute is unset, nil is returned.
 func (x *FileInfo) GetDeletionDate() *glib.DateTime {
 
 	cret := xFileInfoGetDeletionDate(x.GoPointer())
@@ -1025,8 +1025,8 @@ var xFileInfoGetDisplayName func(uintptr) string
 
 // Gets a display name for a file. This is guaranteed to always be set.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME].
 func (x *FileInfo) GetDisplayName() string {
 
 	cret := xFileInfoGetDisplayName(x.GoPointer())
@@ -1037,8 +1037,8 @@ var xFileInfoGetEditName func(uintptr) string
 
 // Gets the edit name for a file.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_EDIT_NAME.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_EDIT_NAME].
 func (x *FileInfo) GetEditName() string {
 
 	cret := xFileInfoGetEditName(x.GoPointer())
@@ -1048,10 +1048,10 @@ func (x *FileInfo) GetEditName() string {
 var xFileInfoGetEtag func(uintptr) string
 
 // Gets the [entity tag][iface@Gio.File#entity-tags] for a given
-// #GFileInfo. See %G_FILE_ATTRIBUTE_ETAG_VALUE.
+// [FileInfo]. See [FILE_ATTRIBUTE_ETAG_VALUE].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_ETAG_VALUE.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_ETAG_VALUE].
 func (x *FileInfo) GetEtag() string {
 
 	cret := xFileInfoGetEtag(x.GoPointer())
@@ -1061,10 +1061,10 @@ func (x *FileInfo) GetEtag() string {
 var xFileInfoGetFileType func(uintptr) FileType
 
 // Gets a file's type (whether it is a regular file, symlink, etc).
-// This is different from the file's content type, see g_file_info_get_content_type().
+// This is different from the file's content type, see [FileInfo.GetContentType].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_TYPE.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_TYPE].
 func (x *FileInfo) GetFileType() FileType {
 
 	cret := xFileInfoGetFileType(x.GoPointer())
@@ -1075,8 +1075,8 @@ var xFileInfoGetIcon func(uintptr) uintptr
 
 // Gets the icon for a file.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_ICON.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_ICON].
 func (x *FileInfo) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -1095,8 +1095,8 @@ var xFileInfoGetIsBackup func(uintptr) bool
 
 // Checks if a file is a backup file.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_IS_BACKUP.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_IS_BACKUP].
 func (x *FileInfo) GetIsBackup() bool {
 
 	cret := xFileInfoGetIsBackup(x.GoPointer())
@@ -1107,8 +1107,8 @@ var xFileInfoGetIsHidden func(uintptr) bool
 
 // Checks if a file is hidden.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_IS_HIDDEN.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_IS_HIDDEN].
 func (x *FileInfo) GetIsHidden() bool {
 
 	cret := xFileInfoGetIsHidden(x.GoPointer())
@@ -1119,8 +1119,8 @@ var xFileInfoGetIsSymlink func(uintptr) bool
 
 // Checks if a file is a symlink.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_IS_SYMLINK.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_IS_SYMLINK].
 func (x *FileInfo) GetIsSymlink() bool {
 
 	cret := xFileInfoGetIsSymlink(x.GoPointer())
@@ -1129,42 +1129,28 @@ func (x *FileInfo) GetIsSymlink() bool {
 
 var xFileInfoGetModificationDateTime func(uintptr) *glib.DateTime
 
-// Gets the modification time of the current @info and returns it as a
-// #GDateTime.
+// Gets the modification time of the current info and returns it as a
+// [glib.DateTime].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED. If %G_FILE_ATTRIBUTE_TIME_MODIFIED_USEC is
-// provided, the resulting #GDateTime will additionally have microsecond
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_TIME_MODIFIED]. If [FILE_ATTRIBUTE_TIME_MODIFIED_USEC] is
+// provided, the resulting [glib.DateTime] will additionally have microsecond
 // precision.
 //
-// If nanosecond precision is needed, %G_FILE_ATTRIBUTE_TIME_MODIFIED_NSEC must
-// be queried separately using g_file_info_get_attribute_uint32().
+// If nanosecond precision is needed, [FILE_ATTRIBUTE_TIME_MODIFIED_NSEC] must
+// be queried separately using [FileInfo.GetAttributeUint32].
 func (x *FileInfo) GetModificationDateTime() *glib.DateTime {
 
 	cret := xFileInfoGetModificationDateTime(x.GoPointer())
 	return cret
 }
 
-var xFileInfoGetModificationTime func(uintptr, *glib.TimeVal)
-
-// Gets the modification time of the current @info and sets it
-// in @result.
-//
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED. If %G_FILE_ATTRIBUTE_TIME_MODIFIED_USEC is
-// provided it will be used too.
-func (x *FileInfo) GetModificationTime(ResultVar *glib.TimeVal) {
-
-	xFileInfoGetModificationTime(x.GoPointer(), ResultVar)
-
-}
-
 var xFileInfoGetName func(uintptr) string
 
 // Gets the name for a file. This is guaranteed to always be set.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_NAME.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_NAME].
 func (x *FileInfo) GetName() string {
 
 	cret := xFileInfoGetName(x.GoPointer())
@@ -1174,11 +1160,11 @@ func (x *FileInfo) GetName() string {
 var xFileInfoGetSize func(uintptr) int64
 
 // Gets the file's size (in bytes). The size is retrieved through the value of
-// the %G_FILE_ATTRIBUTE_STANDARD_SIZE attribute and is converted
+// the [FILE_ATTRIBUTE_STANDARD_SIZE] attribute and is converted
 // from #guint64 to #goffset before returning the result.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_SIZE.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_SIZE].
 func (x *FileInfo) GetSize() int64 {
 
 	cret := xFileInfoGetSize(x.GoPointer())
@@ -1187,11 +1173,11 @@ func (x *FileInfo) GetSize() int64 {
 
 var xFileInfoGetSortOrder func(uintptr) int32
 
-// Gets the value of the sort_order attribute from the #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_SORT_ORDER.
+// Gets the value of the sort_order attribute from the [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_SORT_ORDER].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_SORT_ORDER.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_SORT_ORDER].
 func (x *FileInfo) GetSortOrder() int32 {
 
 	cret := xFileInfoGetSortOrder(x.GoPointer())
@@ -1202,8 +1188,8 @@ var xFileInfoGetSymbolicIcon func(uintptr) uintptr
 
 // Gets the symbolic icon for a file.
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON].
 func (x *FileInfo) GetSymbolicIcon() *IconBase {
 	var cls *IconBase
 
@@ -1220,10 +1206,10 @@ func (x *FileInfo) GetSymbolicIcon() *IconBase {
 
 var xFileInfoGetSymlinkTarget func(uintptr) string
 
-// Gets the symlink target for a given #GFileInfo.
+// Gets the symlink target for a given [FileInfo].
 //
-// It is an error to call this if the #GFileInfo does not contain
-// %G_FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET.
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET].
 func (x *FileInfo) GetSymlinkTarget() string {
 
 	cret := xFileInfoGetSymlinkTarget(x.GoPointer())
@@ -1232,7 +1218,7 @@ func (x *FileInfo) GetSymlinkTarget() string {
 
 var xFileInfoHasAttribute func(uintptr, string) bool
 
-// Checks if a file info structure has an attribute named @attribute.
+// Checks if a file info structure has an attribute named attribute.
 func (x *FileInfo) HasAttribute(AttributeVar string) bool {
 
 	cret := xFileInfoHasAttribute(x.GoPointer(), AttributeVar)
@@ -1242,14 +1228,14 @@ func (x *FileInfo) HasAttribute(AttributeVar string) bool {
 var xFileInfoHasNamespace func(uintptr, string) bool
 
 // Checks if a file info structure has an attribute in the
-// specified @name_space.
+// specified name_space.
 func (x *FileInfo) HasNamespace(NameSpaceVar string) bool {
 
 	cret := xFileInfoHasNamespace(x.GoPointer(), NameSpaceVar)
 	return cret
 }
 
-var xFileInfoListAttributes func(uintptr, uintptr) []string
+var xFileInfoListAttributes func(uintptr, uintptr) uintptr
 
 // Lists the file info structure's attributes.
 func (x *FileInfo) ListAttributes(NameSpaceVar *string) []string {
@@ -1258,12 +1244,12 @@ func (x *FileInfo) ListAttributes(NameSpaceVar *string) []string {
 	defer core.GFreeNullable(NameSpaceVarPtr)
 
 	cret := xFileInfoListAttributes(x.GoPointer(), NameSpaceVarPtr)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xFileInfoRemoveAttribute func(uintptr, string)
 
-// Removes all cases of @attribute from @info if it exists.
+// Removes all cases of attribute from info if it exists.
 func (x *FileInfo) RemoveAttribute(AttributeVar string) {
 
 	xFileInfoRemoveAttribute(x.GoPointer(), AttributeVar)
@@ -1272,11 +1258,11 @@ func (x *FileInfo) RemoveAttribute(AttributeVar string) {
 
 var xFileInfoSetAccessDateTime func(uintptr, *glib.DateTime)
 
-// Sets the %G_FILE_ATTRIBUTE_TIME_ACCESS and
-// %G_FILE_ATTRIBUTE_TIME_ACCESS_USEC attributes in the file info to the
+// Sets the [FILE_ATTRIBUTE_TIME_ACCESS] and
+// [FILE_ATTRIBUTE_TIME_ACCESS_USEC] attributes in the file info to the
 // given date/time value.
 //
-// %G_FILE_ATTRIBUTE_TIME_ACCESS_NSEC will be cleared.
+// [FILE_ATTRIBUTE_TIME_ACCESS_NSEC] will be cleared.
 func (x *FileInfo) SetAccessDateTime(AtimeVar *glib.DateTime) {
 
 	xFileInfoSetAccessDateTime(x.GoPointer(), AtimeVar)
@@ -1285,8 +1271,8 @@ func (x *FileInfo) SetAccessDateTime(AtimeVar *glib.DateTime) {
 
 var xFileInfoSetAttribute func(uintptr, string, FileAttributeType, uintptr)
 
-// Sets the @attribute to contain the given value, if possible. To unset the
-// attribute, use %G_FILE_ATTRIBUTE_TYPE_INVALID for @type.
+// Sets the attribute to contain the given value, if possible. To unset the
+// attribute, use [GFileAttributeTypeInvalidValue] for type.
 func (x *FileInfo) SetAttribute(AttributeVar string, TypeVar FileAttributeType, ValuePVar uintptr) {
 
 	xFileInfoSetAttribute(x.GoPointer(), AttributeVar, TypeVar, ValuePVar)
@@ -1295,7 +1281,7 @@ func (x *FileInfo) SetAttribute(AttributeVar string, TypeVar FileAttributeType,
 
 var xFileInfoSetAttributeBoolean func(uintptr, string, bool)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeBoolean(AttributeVar string, AttrValueVar bool) {
 
@@ -1305,7 +1291,7 @@ func (x *FileInfo) SetAttributeBoolean(AttributeVar string, AttrValueVar bool) {
 
 var xFileInfoSetAttributeByteString func(uintptr, string, string)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeByteString(AttributeVar string, AttrValueVar string) {
 
@@ -1315,7 +1301,7 @@ func (x *FileInfo) SetAttributeByteString(AttributeVar string, AttrValueVar stri
 
 var xFileInfoSetAttributeFilePath func(uintptr, string, string)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 //
 // This function is meant to be used by language bindings that have specific
@@ -1328,7 +1314,7 @@ func (x *FileInfo) SetAttributeFilePath(AttributeVar string, AttrValueVar string
 
 var xFileInfoSetAttributeInt32 func(uintptr, string, int32)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeInt32(AttributeVar string, AttrValueVar int32) {
 
@@ -1338,7 +1324,7 @@ func (x *FileInfo) SetAttributeInt32(AttributeVar string, AttrValueVar int32) {
 
 var xFileInfoSetAttributeInt64 func(uintptr, string, int64)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeInt64(AttributeVar string, AttrValueVar int64) {
 
@@ -1348,7 +1334,7 @@ func (x *FileInfo) SetAttributeInt64(AttributeVar string, AttrValueVar int64) {
 
 var xFileInfoSetAttributeMask func(uintptr, *FileAttributeMatcher)
 
-// Sets @mask on @info to match specific attribute types.
+// Sets mask on info to match specific attribute types.
 func (x *FileInfo) SetAttributeMask(MaskVar *FileAttributeMatcher) {
 
 	xFileInfoSetAttributeMask(x.GoPointer(), MaskVar)
@@ -1357,7 +1343,7 @@ func (x *FileInfo) SetAttributeMask(MaskVar *FileAttributeMatcher) {
 
 var xFileInfoSetAttributeObject func(uintptr, string, uintptr)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeObject(AttributeVar string, AttrValueVar *gobject.Object) {
 
@@ -1368,11 +1354,11 @@ func (x *FileInfo) SetAttributeObject(AttributeVar string, AttrValueVar *gobject
 var xFileInfoSetAttributeStatus func(uintptr, string, FileAttributeStatus) bool
 
 // Sets the attribute status for an attribute key. This is only
-// needed by external code that implement g_file_set_attributes_from_info()
+// needed by external code that implement [File.SetAttributesFromInfo]
 // or similar functions.
 //
-// The attribute must exist in @info for this to work. Otherwise %FALSE
-// is returned and @info is unchanged.
+// The attribute must exist in info for this to work. Otherwise false
+// is returned and info is unchanged.
 func (x *FileInfo) SetAttributeStatus(AttributeVar string, StatusVar FileAttributeStatus) bool {
 
 	cret := xFileInfoSetAttributeStatus(x.GoPointer(), AttributeVar, StatusVar)
@@ -1381,7 +1367,7 @@ func (x *FileInfo) SetAttributeStatus(AttributeVar string, StatusVar FileAttribu
 
 var xFileInfoSetAttributeString func(uintptr, string, string)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeString(AttributeVar string, AttrValueVar string) {
 
@@ -1391,7 +1377,7 @@ func (x *FileInfo) SetAttributeString(AttributeVar string, AttrValueVar string)
 
 var xFileInfoSetAttributeStringv func(uintptr, string, []string)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 //
 // Sinze: 2.22
@@ -1403,7 +1389,7 @@ func (x *FileInfo) SetAttributeStringv(AttributeVar string, AttrValueVar []strin
 
 var xFileInfoSetAttributeUint32 func(uintptr, string, uint32)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeUint32(AttributeVar string, AttrValueVar uint32) {
 
@@ -1413,7 +1399,7 @@ func (x *FileInfo) SetAttributeUint32(AttributeVar string, AttrValueVar uint32)
 
 var xFileInfoSetAttributeUint64 func(uintptr, string, uint64)
 
-// Sets the @attribute to contain the given @attr_value,
+// Sets the attribute to contain the given attr_value,
 // if possible.
 func (x *FileInfo) SetAttributeUint64(AttributeVar string, AttrValueVar uint64) {
 
@@ -1423,8 +1409,8 @@ func (x *FileInfo) SetAttributeUint64(AttributeVar string, AttrValueVar uint64)
 
 var xFileInfoSetContentType func(uintptr, string)
 
-// Sets the content type attribute for a given #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE.
+// Sets the content type attribute for a given [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE].
 func (x *FileInfo) SetContentType(ContentTypeVar string) {
 
 	xFileInfoSetContentType(x.GoPointer(), ContentTypeVar)
@@ -1433,11 +1419,11 @@ func (x *FileInfo) SetContentType(ContentTypeVar string) {
 
 var xFileInfoSetCreationDateTime func(uintptr, *glib.DateTime)
 
-// Sets the %G_FILE_ATTRIBUTE_TIME_CREATED and
-// %G_FILE_ATTRIBUTE_TIME_CREATED_USEC attributes in the file info to the
+// Sets the [FILE_ATTRIBUTE_TIME_CREATED] and
+// [FILE_ATTRIBUTE_TIME_CREATED_USEC] attributes in the file info to the
 // given date/time value.
 //
-// %G_FILE_ATTRIBUTE_TIME_CREATED_NSEC will be cleared.
+// [FILE_ATTRIBUTE_TIME_CREATED_NSEC] will be cleared.
 func (x *FileInfo) SetCreationDateTime(CreationTimeVar *glib.DateTime) {
 
 	xFileInfoSetCreationDateTime(x.GoPointer(), CreationTimeVar)
@@ -1446,8 +1432,8 @@ func (x *FileInfo) SetCreationDateTime(CreationTimeVar *glib.DateTime) {
 
 var xFileInfoSetDisplayName func(uintptr, string)
 
-// Sets the display name for the current #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME.
+// Sets the display name for the current [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME].
 func (x *FileInfo) SetDisplayName(DisplayNameVar string) {
 
 	xFileInfoSetDisplayName(x.GoPointer(), DisplayNameVar)
@@ -1457,7 +1443,7 @@ func (x *FileInfo) SetDisplayName(DisplayNameVar string) {
 var xFileInfoSetEditName func(uintptr, string)
 
 // Sets the edit name for the current file.
-// See %G_FILE_ATTRIBUTE_STANDARD_EDIT_NAME.
+// See [FILE_ATTRIBUTE_STANDARD_EDIT_NAME].
 func (x *FileInfo) SetEditName(EditNameVar string) {
 
 	xFileInfoSetEditName(x.GoPointer(), EditNameVar)
@@ -1466,8 +1452,8 @@ func (x *FileInfo) SetEditName(EditNameVar string) {
 
 var xFileInfoSetFileType func(uintptr, FileType)
 
-// Sets the file type in a #GFileInfo to @type.
-// See %G_FILE_ATTRIBUTE_STANDARD_TYPE.
+// Sets the file type in a [FileInfo] to type.
+// See [FILE_ATTRIBUTE_STANDARD_TYPE].
 func (x *FileInfo) SetFileType(TypeVar FileType) {
 
 	xFileInfoSetFileType(x.GoPointer(), TypeVar)
@@ -1476,8 +1462,8 @@ func (x *FileInfo) SetFileType(TypeVar FileType) {
 
 var xFileInfoSetIcon func(uintptr, uintptr)
 
-// Sets the icon for a given #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_ICON.
+// Sets the icon for a given [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_ICON].
 func (x *FileInfo) SetIcon(IconVar Icon) {
 
 	xFileInfoSetIcon(x.GoPointer(), IconVar.GoPointer())
@@ -1486,8 +1472,8 @@ func (x *FileInfo) SetIcon(IconVar Icon) {
 
 var xFileInfoSetIsHidden func(uintptr, bool)
 
-// Sets the "is_hidden" attribute in a #GFileInfo according to @is_hidden.
-// See %G_FILE_ATTRIBUTE_STANDARD_IS_HIDDEN.
+// Sets the "is_hidden" attribute in a [FileInfo] according to is_hidden.
+// See [FILE_ATTRIBUTE_STANDARD_IS_HIDDEN].
 func (x *FileInfo) SetIsHidden(IsHiddenVar bool) {
 
 	xFileInfoSetIsHidden(x.GoPointer(), IsHiddenVar)
@@ -1496,8 +1482,8 @@ func (x *FileInfo) SetIsHidden(IsHiddenVar bool) {
 
 var xFileInfoSetIsSymlink func(uintptr, bool)
 
-// Sets the "is_symlink" attribute in a #GFileInfo according to @is_symlink.
-// See %G_FILE_ATTRIBUTE_STANDARD_IS_SYMLINK.
+// Sets the "is_symlink" attribute in a [FileInfo] according to is_symlink.
+// See [FILE_ATTRIBUTE_STANDARD_IS_SYMLINK].
 func (x *FileInfo) SetIsSymlink(IsSymlinkVar bool) {
 
 	xFileInfoSetIsSymlink(x.GoPointer(), IsSymlinkVar)
@@ -1506,34 +1492,21 @@ func (x *FileInfo) SetIsSymlink(IsSymlinkVar bool) {
 
 var xFileInfoSetModificationDateTime func(uintptr, *glib.DateTime)
 
-// Sets the %G_FILE_ATTRIBUTE_TIME_MODIFIED and
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED_USEC attributes in the file info to the
+// Sets the [FILE_ATTRIBUTE_TIME_MODIFIED] and
+// [FILE_ATTRIBUTE_TIME_MODIFIED_USEC] attributes in the file info to the
 // given date/time value.
 //
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED_NSEC will be cleared.
+// [FILE_ATTRIBUTE_TIME_MODIFIED_NSEC] will be cleared.
 func (x *FileInfo) SetModificationDateTime(MtimeVar *glib.DateTime) {
 
 	xFileInfoSetModificationDateTime(x.GoPointer(), MtimeVar)
 
 }
 
-var xFileInfoSetModificationTime func(uintptr, *glib.TimeVal)
-
-// Sets the %G_FILE_ATTRIBUTE_TIME_MODIFIED and
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED_USEC attributes in the file info to the
-// given time value.
-//
-// %G_FILE_ATTRIBUTE_TIME_MODIFIED_NSEC will be cleared.
-func (x *FileInfo) SetModificationTime(MtimeVar *glib.TimeVal) {
-
-	xFileInfoSetModificationTime(x.GoPointer(), MtimeVar)
-
-}
-
 var xFileInfoSetName func(uintptr, string)
 
-// Sets the name attribute for the current #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_NAME.
+// Sets the name attribute for the current [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_NAME].
 func (x *FileInfo) SetName(NameVar string) {
 
 	xFileInfoSetName(x.GoPointer(), NameVar)
@@ -1542,7 +1515,7 @@ func (x *FileInfo) SetName(NameVar string) {
 
 var xFileInfoSetSize func(uintptr, int64)
 
-// Sets the %G_FILE_ATTRIBUTE_STANDARD_SIZE attribute in the file info
+// Sets the [FILE_ATTRIBUTE_STANDARD_SIZE] attribute in the file info
 // to the given size.
 func (x *FileInfo) SetSize(SizeVar int64) {
 
@@ -1553,7 +1526,7 @@ func (x *FileInfo) SetSize(SizeVar int64) {
 var xFileInfoSetSortOrder func(uintptr, int32)
 
 // Sets the sort order attribute in the file info structure. See
-// %G_FILE_ATTRIBUTE_STANDARD_SORT_ORDER.
+// [FILE_ATTRIBUTE_STANDARD_SORT_ORDER].
 func (x *FileInfo) SetSortOrder(SortOrderVar int32) {
 
 	xFileInfoSetSortOrder(x.GoPointer(), SortOrderVar)
@@ -1562,8 +1535,8 @@ func (x *FileInfo) SetSortOrder(SortOrderVar int32) {
 
 var xFileInfoSetSymbolicIcon func(uintptr, uintptr)
 
-// Sets the symbolic icon for a given #GFileInfo.
-// See %G_FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON.
+// Sets the symbolic icon for a given [FileInfo].
+// See [FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON].
 func (x *FileInfo) SetSymbolicIcon(IconVar Icon) {
 
 	xFileInfoSetSymbolicIcon(x.GoPointer(), IconVar.GoPointer())
@@ -1572,7 +1545,7 @@ func (x *FileInfo) SetSymbolicIcon(IconVar Icon) {
 
 var xFileInfoSetSymlinkTarget func(uintptr, string)
 
-// Sets the %G_FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET attribute in the file info
+// Sets the [FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET] attribute in the file info
 // to the given symlink target.
 func (x *FileInfo) SetSymlinkTarget(SymlinkTargetVar string) {
 
@@ -1582,7 +1555,7 @@ func (x *FileInfo) SetSymlinkTarget(SymlinkTargetVar string) {
 
 var xFileInfoUnsetAttributeMask func(uintptr)
 
-// Unsets a mask set by g_file_info_set_attribute_mask(), if one
+// Unsets a mask set by [FileInfo.SetAttributeMask], if one
 // is set.
 func (x *FileInfo) UnsetAttributeMask() {
 
@@ -1606,7 +1579,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1615,77 +1588,75 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileInfoGLibType, libs, "g_file_info_get_type")
 
-	core.PuregoSafeRegister(&xNewFileInfo, libs, "g_file_info_new")
-
-	core.PuregoSafeRegister(&xFileInfoClearStatus, libs, "g_file_info_clear_status")
-	core.PuregoSafeRegister(&xFileInfoCopyInto, libs, "g_file_info_copy_into")
-	core.PuregoSafeRegister(&xFileInfoDup, libs, "g_file_info_dup")
-	core.PuregoSafeRegister(&xFileInfoGetAccessDateTime, libs, "g_file_info_get_access_date_time")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeAsString, libs, "g_file_info_get_attribute_as_string")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeBoolean, libs, "g_file_info_get_attribute_boolean")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeByteString, libs, "g_file_info_get_attribute_byte_string")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeData, libs, "g_file_info_get_attribute_data")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeFilePath, libs, "g_file_info_get_attribute_file_path")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeInt32, libs, "g_file_info_get_attribute_int32")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeInt64, libs, "g_file_info_get_attribute_int64")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeObject, libs, "g_file_info_get_attribute_object")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeStatus, libs, "g_file_info_get_attribute_status")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeString, libs, "g_file_info_get_attribute_string")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeStringv, libs, "g_file_info_get_attribute_stringv")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeType, libs, "g_file_info_get_attribute_type")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeUint32, libs, "g_file_info_get_attribute_uint32")
-	core.PuregoSafeRegister(&xFileInfoGetAttributeUint64, libs, "g_file_info_get_attribute_uint64")
-	core.PuregoSafeRegister(&xFileInfoGetContentType, libs, "g_file_info_get_content_type")
-	core.PuregoSafeRegister(&xFileInfoGetCreationDateTime, libs, "g_file_info_get_creation_date_time")
-	core.PuregoSafeRegister(&xFileInfoGetDeletionDate, libs, "g_file_info_get_deletion_date")
-	core.PuregoSafeRegister(&xFileInfoGetDisplayName, libs, "g_file_info_get_display_name")
-	core.PuregoSafeRegister(&xFileInfoGetEditName, libs, "g_file_info_get_edit_name")
-	core.PuregoSafeRegister(&xFileInfoGetEtag, libs, "g_file_info_get_etag")
-	core.PuregoSafeRegister(&xFileInfoGetFileType, libs, "g_file_info_get_file_type")
-	core.PuregoSafeRegister(&xFileInfoGetIcon, libs, "g_file_info_get_icon")
-	core.PuregoSafeRegister(&xFileInfoGetIsBackup, libs, "g_file_info_get_is_backup")
-	core.PuregoSafeRegister(&xFileInfoGetIsHidden, libs, "g_file_info_get_is_hidden")
-	core.PuregoSafeRegister(&xFileInfoGetIsSymlink, libs, "g_file_info_get_is_symlink")
-	core.PuregoSafeRegister(&xFileInfoGetModificationDateTime, libs, "g_file_info_get_modification_date_time")
-	core.PuregoSafeRegister(&xFileInfoGetModificationTime, libs, "g_file_info_get_modification_time")
-	core.PuregoSafeRegister(&xFileInfoGetName, libs, "g_file_info_get_name")
-	core.PuregoSafeRegister(&xFileInfoGetSize, libs, "g_file_info_get_size")
-	core.PuregoSafeRegister(&xFileInfoGetSortOrder, libs, "g_file_info_get_sort_order")
-	core.PuregoSafeRegister(&xFileInfoGetSymbolicIcon, libs, "g_file_info_get_symbolic_icon")
-	core.PuregoSafeRegister(&xFileInfoGetSymlinkTarget, libs, "g_file_info_get_symlink_target")
-	core.PuregoSafeRegister(&xFileInfoHasAttribute, libs, "g_file_info_has_attribute")
-	core.PuregoSafeRegister(&xFileInfoHasNamespace, libs, "g_file_info_has_namespace")
-	core.PuregoSafeRegister(&xFileInfoListAttributes, libs, "g_file_info_list_attributes")
-	core.PuregoSafeRegister(&xFileInfoRemoveAttribute, libs, "g_file_info_remove_attribute")
-	core.PuregoSafeRegister(&xFileInfoSetAccessDateTime, libs, "g_file_info_set_access_date_time")
-	core.PuregoSafeRegister(&xFileInfoSetAttribute, libs, "g_file_info_set_attribute")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeBoolean, libs, "g_file_info_set_attribute_boolean")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeByteString, libs, "g_file_info_set_attribute_byte_string")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeFilePath, libs, "g_file_info_set_attribute_file_path")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeInt32, libs, "g_file_info_set_attribute_int32")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeInt64, libs, "g_file_info_set_attribute_int64")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeMask, libs, "g_file_info_set_attribute_mask")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeObject, libs, "g_file_info_set_attribute_object")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeStatus, libs, "g_file_info_set_attribute_status")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeString, libs, "g_file_info_set_attribute_string")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeStringv, libs, "g_file_info_set_attribute_stringv")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeUint32, libs, "g_file_info_set_attribute_uint32")
-	core.PuregoSafeRegister(&xFileInfoSetAttributeUint64, libs, "g_file_info_set_attribute_uint64")
-	core.PuregoSafeRegister(&xFileInfoSetContentType, libs, "g_file_info_set_content_type")
-	core.PuregoSafeRegister(&xFileInfoSetCreationDateTime, libs, "g_file_info_set_creation_date_time")
-	core.PuregoSafeRegister(&xFileInfoSetDisplayName, libs, "g_file_info_set_display_name")
-	core.PuregoSafeRegister(&xFileInfoSetEditName, libs, "g_file_info_set_edit_name")
-	core.PuregoSafeRegister(&xFileInfoSetFileType, libs, "g_file_info_set_file_type")
-	core.PuregoSafeRegister(&xFileInfoSetIcon, libs, "g_file_info_set_icon")
-	core.PuregoSafeRegister(&xFileInfoSetIsHidden, libs, "g_file_info_set_is_hidden")
-	core.PuregoSafeRegister(&xFileInfoSetIsSymlink, libs, "g_file_info_set_is_symlink")
-	core.PuregoSafeRegister(&xFileInfoSetModificationDateTime, libs, "g_file_info_set_modification_date_time")
-	core.PuregoSafeRegister(&xFileInfoSetModificationTime, libs, "g_file_info_set_modification_time")
-	core.PuregoSafeRegister(&xFileInfoSetName, libs, "g_file_info_set_name")
-	core.PuregoSafeRegister(&xFileInfoSetSize, libs, "g_file_info_set_size")
-	core.PuregoSafeRegister(&xFileInfoSetSortOrder, libs, "g_file_info_set_sort_order")
-	core.PuregoSafeRegister(&xFileInfoSetSymbolicIcon, libs, "g_file_info_set_symbolic_icon")
-	core.PuregoSafeRegister(&xFileInfoSetSymlinkTarget, libs, "g_file_info_set_symlink_target")
-	core.PuregoSafeRegister(&xFileInfoUnsetAttributeMask, libs, "g_file_info_unset_attribute_mask")
+	core.PuregoRegisterSince(&xNewFileInfo, libs, "g_file_info_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xFileInfoClearStatus, libs, "g_file_info_clear_status", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoCopyInto, libs, "g_file_info_copy_into", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoDup, libs, "g_file_info_dup", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAccessDateTime, libs, "g_file_info_get_access_date_time", "GIO", "2.70")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeAsString, libs, "g_file_info_get_attribute_as_string", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeBoolean, libs, "g_file_info_get_attribute_boolean", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeByteString, libs, "g_file_info_get_attribute_byte_string", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeData, libs, "g_file_info_get_attribute_data", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeFilePath, libs, "g_file_info_get_attribute_file_path", "GIO", "2.78")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeInt32, libs, "g_file_info_get_attribute_int32", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeInt64, libs, "g_file_info_get_attribute_int64", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeObject, libs, "g_file_info_get_attribute_object", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeStatus, libs, "g_file_info_get_attribute_status", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeString, libs, "g_file_info_get_attribute_string", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeStringv, libs, "g_file_info_get_attribute_stringv", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeType, libs, "g_file_info_get_attribute_type", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeUint32, libs, "g_file_info_get_attribute_uint32", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetAttributeUint64, libs, "g_file_info_get_attribute_uint64", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetContentType, libs, "g_file_info_get_content_type", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetCreationDateTime, libs, "g_file_info_get_creation_date_time", "GIO", "2.70")
+	core.PuregoRegisterSince(&xFileInfoGetDeletionDate, libs, "g_file_info_get_deletion_date", "GIO", "2.36")
+	core.PuregoRegisterSince(&xFileInfoGetDisplayName, libs, "g_file_info_get_display_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetEditName, libs, "g_file_info_get_edit_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetEtag, libs, "g_file_info_get_etag", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetFileType, libs, "g_file_info_get_file_type", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetIcon, libs, "g_file_info_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetIsBackup, libs, "g_file_info_get_is_backup", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetIsHidden, libs, "g_file_info_get_is_hidden", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetIsSymlink, libs, "g_file_info_get_is_symlink", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetModificationDateTime, libs, "g_file_info_get_modification_date_time", "GIO", "2.62")
+	core.PuregoRegisterSince(&xFileInfoGetName, libs, "g_file_info_get_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetSize, libs, "g_file_info_get_size", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetSortOrder, libs, "g_file_info_get_sort_order", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoGetSymbolicIcon, libs, "g_file_info_get_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&xFileInfoGetSymlinkTarget, libs, "g_file_info_get_symlink_target", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoHasAttribute, libs, "g_file_info_has_attribute", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoHasNamespace, libs, "g_file_info_has_namespace", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileInfoListAttributes, libs, "g_file_info_list_attributes", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoRemoveAttribute, libs, "g_file_info_remove_attribute", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAccessDateTime, libs, "g_file_info_set_access_date_time", "GIO", "2.70")
+	core.PuregoRegisterSince(&xFileInfoSetAttribute, libs, "g_file_info_set_attribute", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeBoolean, libs, "g_file_info_set_attribute_boolean", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeByteString, libs, "g_file_info_set_attribute_byte_string", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeFilePath, libs, "g_file_info_set_attribute_file_path", "GIO", "2.78")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeInt32, libs, "g_file_info_set_attribute_int32", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeInt64, libs, "g_file_info_set_attribute_int64", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeMask, libs, "g_file_info_set_attribute_mask", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeObject, libs, "g_file_info_set_attribute_object", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeStatus, libs, "g_file_info_set_attribute_status", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeString, libs, "g_file_info_set_attribute_string", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeStringv, libs, "g_file_info_set_attribute_stringv", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeUint32, libs, "g_file_info_set_attribute_uint32", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetAttributeUint64, libs, "g_file_info_set_attribute_uint64", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetContentType, libs, "g_file_info_set_content_type", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetCreationDateTime, libs, "g_file_info_set_creation_date_time", "GIO", "2.70")
+	core.PuregoRegisterSince(&xFileInfoSetDisplayName, libs, "g_file_info_set_display_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetEditName, libs, "g_file_info_set_edit_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetFileType, libs, "g_file_info_set_file_type", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetIcon, libs, "g_file_info_set_icon", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetIsHidden, libs, "g_file_info_set_is_hidden", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetIsSymlink, libs, "g_file_info_set_is_symlink", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetModificationDateTime, libs, "g_file_info_set_modification_date_time", "GIO", "2.62")
+	core.PuregoRegisterSince(&xFileInfoSetName, libs, "g_file_info_set_name", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetSize, libs, "g_file_info_set_size", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetSortOrder, libs, "g_file_info_set_sort_order", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetSymbolicIcon, libs, "g_file_info_set_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&xFileInfoSetSymlinkTarget, libs, "g_file_info_set_symlink_target", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoUnsetAttributeMask, libs, "g_file_info_unset_attribute_mask", "GIO", "")
 
 }
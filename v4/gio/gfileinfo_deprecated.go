@@ -0,0 +1,61 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xFileInfoGetModificationTime func(uintptr, *glib.TimeVal)
+
+// Gets the modification time of the current info and sets it
+// in result.
+//
+// It is an error to call this if the [FileInfo] does not contain
+// [FILE_ATTRIBUTE_TIME_MODIFIED]. If [FILE_ATTRIBUTE_TIME_MODIFIED_USEC] is
+// provided it will be used too.
+//
+// Deprecated: since 2.62. Use [FileInfo.GetModificationDateTime] instead, as
+//
+//	[glib.TimeVal] is deprecated due to the year 2038 problem.
+func (x *FileInfo) GetModificationTime(ResultVar *glib.TimeVal) {
+
+	xFileInfoGetModificationTime(x.GoPointer(), ResultVar)
+
+}
+
+var xFileInfoSetModificationTime func(uintptr, *glib.TimeVal)
+
+// Sets the [FILE_ATTRIBUTE_TIME_MODIFIED] and
+// [FILE_ATTRIBUTE_TIME_MODIFIED_USEC] attributes in the file info to the
+// given time value.
+//
+// [FILE_ATTRIBUTE_TIME_MODIFIED_NSEC] will be cleared.
+//
+// Deprecated: since 2.62. Use [FileInfo.SetModificationDateTime] instead, as
+//
+//	[glib.TimeVal] is deprecated due to the year 2038 problem.
+func (x *FileInfo) SetModificationTime(MtimeVar *glib.TimeVal) {
+
+	xFileInfoSetModificationTime(x.GoPointer(), MtimeVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xFileInfoGetModificationTime, libs, "g_file_info_get_modification_time", "GIO", "")
+	core.PuregoRegisterSince(&xFileInfoSetModificationTime, libs, "g_file_info_set_modification_time", "GIO", "")
+
+}
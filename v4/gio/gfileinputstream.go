@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -48,7 +48,8 @@ func (x *FileInputStreamClass) OverrideTell(cb func(*FileInputStream) int64) {
 	if cb == nil {
 		x.xTell = 0
 	} else {
-		x.xTell = purego.NewCallback(func(StreamVarp uintptr) int64 {
+		x.xTell = core.NewCallback(func(StreamVarp uintptr) int64 {
+			defer core.RecoverPanic()
 			return cb(FileInputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -60,7 +61,7 @@ func (x *FileInputStreamClass) GetTell() func(*FileInputStream) int64 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) int64
-	purego.RegisterFunc(&rawCallback, x.xTell)
+	core.RegisterFunc(&rawCallback, x.xTell)
 	return func(StreamVar *FileInputStream) int64 {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -71,7 +72,8 @@ func (x *FileInputStreamClass) OverrideCanSeek(cb func(*FileInputStream) bool) {
 	if cb == nil {
 		x.xCanSeek = 0
 	} else {
-		x.xCanSeek = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanSeek = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileInputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -83,7 +85,7 @@ func (x *FileInputStreamClass) GetCanSeek() func(*FileInputStream) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanSeek)
+	core.RegisterFunc(&rawCallback, x.xCanSeek)
 	return func(StreamVar *FileInputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -94,7 +96,8 @@ func (x *FileInputStreamClass) OverrideSeek(cb func(*FileInputStream, int64, gli
 	if cb == nil {
 		x.xSeek = 0
 	} else {
-		x.xSeek = purego.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+		x.xSeek = core.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileInputStreamNewFromInternalPtr(StreamVarp), OffsetVarp, TypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -106,7 +109,7 @@ func (x *FileInputStreamClass) GetSeek() func(*FileInputStream, int64, glib.Seek
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSeek)
+	core.RegisterFunc(&rawCallback, x.xSeek)
 	return func(StreamVar *FileInputStream, OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer())
 	}
@@ -117,7 +120,8 @@ func (x *FileInputStreamClass) OverrideQueryInfo(cb func(*FileInputStream, strin
 	if cb == nil {
 		x.xQueryInfo = 0
 	} else {
-		x.xQueryInfo = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+		x.xQueryInfo = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileInputStreamNewFromInternalPtr(StreamVarp), AttributesVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -133,7 +137,7 @@ func (x *FileInputStreamClass) GetQueryInfo() func(*FileInputStream, string, *Ca
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfo)
+	core.RegisterFunc(&rawCallback, x.xQueryInfo)
 	return func(StreamVar *FileInputStream, AttributesVar string, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), AttributesVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -150,7 +154,8 @@ func (x *FileInputStreamClass) OverrideQueryInfoAsync(cb func(*FileInputStream,
 	if cb == nil {
 		x.xQueryInfoAsync = 0
 	} else {
-		x.xQueryInfoAsync = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xQueryInfoAsync = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FileInputStreamNewFromInternalPtr(StreamVarp), AttributesVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -162,7 +167,7 @@ func (x *FileInputStreamClass) GetQueryInfoAsync() func(*FileInputStream, string
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
 	return func(StreamVar *FileInputStream, AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -173,7 +178,8 @@ func (x *FileInputStreamClass) OverrideQueryInfoFinish(cb func(*FileInputStream,
 	if cb == nil {
 		x.xQueryInfoFinish = 0
 	} else {
-		x.xQueryInfoFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xQueryInfoFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileInputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -189,7 +195,7 @@ func (x *FileInputStreamClass) GetQueryInfoFinish() func(*FileInputStream, Async
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
 	return func(StreamVar *FileInputStream, ResultVar AsyncResult) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -206,7 +212,8 @@ func (x *FileInputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -218,7 +225,7 @@ func (x *FileInputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -229,7 +236,8 @@ func (x *FileInputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -241,7 +249,7 @@ func (x *FileInputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -252,7 +260,8 @@ func (x *FileInputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -264,7 +273,7 @@ func (x *FileInputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -275,7 +284,8 @@ func (x *FileInputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -287,7 +297,7 @@ func (x *FileInputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -298,7 +308,8 @@ func (x *FileInputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -310,7 +321,7 @@ func (x *FileInputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -327,12 +338,12 @@ func (x *FileInputStreamPrivate) GoPointer() uintptr {
 // `GFileInputStream` provides input streams that take their
 // content from a file.
 //
-// `GFileInputStream` implements [iface@Gio.Seekable], which allows the input
+// `GFileInputStream` implements [Seekable], which allows the input
 // stream to jump to arbitrary positions in the file, provided the
 // filesystem of the file allows it. To find the position of a file
-// input stream, use [method@Gio.Seekable.tell]. To find out if a file input
-// stream supports seeking, use [vfunc@Gio.Seekable.can_seek].
-// To position a file input stream, use [vfunc@Gio.Seekable.seek].
+// input stream, use [Seekable.Tell]. To find out if a file input
+// stream supports seeking, use Seekable.can_seek.
+// To position a file input stream, use Seekable.seek.
 type FileInputStream struct {
 	InputStream
 }
@@ -351,11 +362,11 @@ func FileInputStreamNewFromInternalPtr(ptr uintptr) *FileInputStream {
 
 var xFileInputStreamQueryInfo func(uintptr, string, uintptr, **glib.Error) uintptr
 
-// Queries a file input stream the given @attributes. This function blocks
+// Queries a file input stream the given attributes. This function blocks
 // while querying the stream. For the asynchronous (non-blocking) version
-// of this function, see g_file_input_stream_query_info_async(). While the
+// of this function, see [FileInputStream.QueryInfoAsync]. While the
 // stream is blocked, the stream will set the pending flag internally, and
-// any other operations on the stream will fail with %G_IO_ERROR_PENDING.
+// any other operations on the stream will fail with [GIoErrorPendingValue].
 func (x *FileInputStream) QueryInfo(AttributesVar string, CancellableVar *Cancellable) (*FileInfo, error) {
 	var cls *FileInfo
 	var cerr *glib.Error
@@ -363,12 +374,15 @@ func (x *FileInputStream) QueryInfo(AttributesVar string, CancellableVar *Cancel
 	cret := xFileInputStreamQueryInfo(x.GoPointer(), AttributesVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -377,16 +391,16 @@ func (x *FileInputStream) QueryInfo(AttributesVar string, CancellableVar *Cancel
 var xFileInputStreamQueryInfoAsync func(uintptr, string, int, uintptr, uintptr, uintptr)
 
 // Queries the stream information asynchronously.
-// When the operation is finished @callback will be called.
-// You can then call g_file_input_stream_query_info_finish()
+// When the operation is finished callback will be called.
+// You can then call [FileInputStream.QueryInfoFinish]
 // to get the result of the operation.
 //
 // For the synchronous version of this function,
-// see g_file_input_stream_query_info().
+// see [FileInputStream.QueryInfo].
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be set
+// was cancelled, the error [GIoErrorCancelledValue] will be set
 func (x *FileInputStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -396,11 +410,12 @@ func (x *FileInputStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -418,12 +433,15 @@ func (x *FileInputStream) QueryInfoFinish(ResultVar AsyncResult) (*FileInfo, err
 	cret := xFileInputStreamQueryInfoFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -440,7 +458,7 @@ func (c *FileInputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *FileInputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -448,14 +466,14 @@ func (x *FileInputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *FileInputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -466,15 +484,15 @@ func (x *FileInputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileInputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -487,13 +505,13 @@ func (x *FileInputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *FileInputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -501,7 +519,7 @@ func (x *FileInputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable)
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -512,7 +530,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -521,8 +539,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileInputStreamGLibType, libs, "g_file_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xFileInputStreamQueryInfo, libs, "g_file_input_stream_query_info")
-	core.PuregoSafeRegister(&xFileInputStreamQueryInfoAsync, libs, "g_file_input_stream_query_info_async")
-	core.PuregoSafeRegister(&xFileInputStreamQueryInfoFinish, libs, "g_file_input_stream_query_info_finish")
+	gobject.CheckClassSize("GFileInputStreamClass", xFileInputStreamGLibType, unsafe.Sizeof(FileInputStreamClass{}))
+
+	core.PuregoRegisterSince(&xFileInputStreamQueryInfo, libs, "g_file_input_stream_query_info", "GIO", "")
+	core.PuregoRegisterSince(&xFileInputStreamQueryInfoAsync, libs, "g_file_input_stream_query_info_async", "GIO", "")
+	core.PuregoRegisterSince(&xFileInputStreamQueryInfoFinish, libs, "g_file_input_stream_query_info_finish", "GIO", "")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -54,7 +54,8 @@ func (x *FileIOStreamClass) OverrideTell(cb func(*FileIOStream) int64) {
 	if cb == nil {
 		x.xTell = 0
 	} else {
-		x.xTell = purego.NewCallback(func(StreamVarp uintptr) int64 {
+		x.xTell = core.NewCallback(func(StreamVarp uintptr) int64 {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -66,7 +67,7 @@ func (x *FileIOStreamClass) GetTell() func(*FileIOStream) int64 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) int64
-	purego.RegisterFunc(&rawCallback, x.xTell)
+	core.RegisterFunc(&rawCallback, x.xTell)
 	return func(StreamVar *FileIOStream) int64 {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -77,7 +78,8 @@ func (x *FileIOStreamClass) OverrideCanSeek(cb func(*FileIOStream) bool) {
 	if cb == nil {
 		x.xCanSeek = 0
 	} else {
-		x.xCanSeek = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanSeek = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -89,7 +91,7 @@ func (x *FileIOStreamClass) GetCanSeek() func(*FileIOStream) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanSeek)
+	core.RegisterFunc(&rawCallback, x.xCanSeek)
 	return func(StreamVar *FileIOStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -100,7 +102,8 @@ func (x *FileIOStreamClass) OverrideSeek(cb func(*FileIOStream, int64, glib.Seek
 	if cb == nil {
 		x.xSeek = 0
 	} else {
-		x.xSeek = purego.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+		x.xSeek = core.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp), OffsetVarp, TypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -112,7 +115,7 @@ func (x *FileIOStreamClass) GetSeek() func(*FileIOStream, int64, glib.SeekType,
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSeek)
+	core.RegisterFunc(&rawCallback, x.xSeek)
 	return func(StreamVar *FileIOStream, OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer())
 	}
@@ -123,7 +126,8 @@ func (x *FileIOStreamClass) OverrideCanTruncate(cb func(*FileIOStream) bool) {
 	if cb == nil {
 		x.xCanTruncate = 0
 	} else {
-		x.xCanTruncate = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanTruncate = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -135,7 +139,7 @@ func (x *FileIOStreamClass) GetCanTruncate() func(*FileIOStream) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanTruncate)
+	core.RegisterFunc(&rawCallback, x.xCanTruncate)
 	return func(StreamVar *FileIOStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -146,7 +150,8 @@ func (x *FileIOStreamClass) OverrideTruncateFn(cb func(*FileIOStream, int64, *Ca
 	if cb == nil {
 		x.xTruncateFn = 0
 	} else {
-		x.xTruncateFn = purego.NewCallback(func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool {
+		x.xTruncateFn = core.NewCallback(func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp), SizeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -158,7 +163,7 @@ func (x *FileIOStreamClass) GetTruncateFn() func(*FileIOStream, int64, *Cancella
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xTruncateFn)
+	core.RegisterFunc(&rawCallback, x.xTruncateFn)
 	return func(StreamVar *FileIOStream, SizeVar int64, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), SizeVar, CancellableVar.GoPointer())
 	}
@@ -169,7 +174,8 @@ func (x *FileIOStreamClass) OverrideQueryInfo(cb func(*FileIOStream, string, *Ca
 	if cb == nil {
 		x.xQueryInfo = 0
 	} else {
-		x.xQueryInfo = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+		x.xQueryInfo = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileIOStreamNewFromInternalPtr(StreamVarp), AttributesVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -185,7 +191,7 @@ func (x *FileIOStreamClass) GetQueryInfo() func(*FileIOStream, string, *Cancella
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfo)
+	core.RegisterFunc(&rawCallback, x.xQueryInfo)
 	return func(StreamVar *FileIOStream, AttributesVar string, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), AttributesVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -202,7 +208,8 @@ func (x *FileIOStreamClass) OverrideQueryInfoAsync(cb func(*FileIOStream, string
 	if cb == nil {
 		x.xQueryInfoAsync = 0
 	} else {
-		x.xQueryInfoAsync = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xQueryInfoAsync = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FileIOStreamNewFromInternalPtr(StreamVarp), AttributesVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -214,7 +221,7 @@ func (x *FileIOStreamClass) GetQueryInfoAsync() func(*FileIOStream, string, int,
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
 	return func(StreamVar *FileIOStream, AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -225,7 +232,8 @@ func (x *FileIOStreamClass) OverrideQueryInfoFinish(cb func(*FileIOStream, Async
 	if cb == nil {
 		x.xQueryInfoFinish = 0
 	} else {
-		x.xQueryInfoFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xQueryInfoFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileIOStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -241,7 +249,7 @@ func (x *FileIOStreamClass) GetQueryInfoFinish() func(*FileIOStream, AsyncResult
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
 	return func(StreamVar *FileIOStream, ResultVar AsyncResult) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -258,7 +266,8 @@ func (x *FileIOStreamClass) OverrideGetEtag(cb func(*FileIOStream) string) {
 	if cb == nil {
 		x.xGetEtag = 0
 	} else {
-		x.xGetEtag = purego.NewCallback(func(StreamVarp uintptr) string {
+		x.xGetEtag = core.NewCallback(func(StreamVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(FileIOStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -270,7 +279,7 @@ func (x *FileIOStreamClass) GetGetEtag() func(*FileIOStream) string {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetEtag)
+	core.RegisterFunc(&rawCallback, x.xGetEtag)
 	return func(StreamVar *FileIOStream) string {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -281,7 +290,8 @@ func (x *FileIOStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -293,7 +303,7 @@ func (x *FileIOStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -304,7 +314,8 @@ func (x *FileIOStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -316,7 +327,7 @@ func (x *FileIOStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -327,7 +338,8 @@ func (x *FileIOStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -339,7 +351,7 @@ func (x *FileIOStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -350,7 +362,8 @@ func (x *FileIOStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -362,7 +375,7 @@ func (x *FileIOStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -373,7 +386,8 @@ func (x *FileIOStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -385,7 +399,7 @@ func (x *FileIOStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -402,21 +416,21 @@ func (x *FileIOStreamPrivate) GoPointer() uintptr {
 // `GFileIOStream` provides I/O streams that both read and write to the same
 // file handle.
 //
-// `GFileIOStream` implements [iface@Gio.Seekable], which allows the I/O
+// `GFileIOStream` implements [Seekable], which allows the I/O
 // stream to jump to arbitrary positions in the file and to truncate
 // the file, provided the filesystem of the file supports these
 // operations.
 //
-// To find the position of a file I/O stream, use [method@Gio.Seekable.tell].
+// To find the position of a file I/O stream, use [Seekable.Tell].
 //
 // To find out if a file I/O stream supports seeking, use
-// [method@Gio.Seekable.can_seek]. To position a file I/O stream, use
-// [method@Gio.Seekable.seek]. To find out if a file I/O stream supports
-// truncating, use [method@Gio.Seekable.can_truncate]. To truncate a file I/O
-// stream, use [method@Gio.Seekable.truncate].
+// [Seekable.CanSeek]. To position a file I/O stream, use
+// [Seekable.Seek]. To find out if a file I/O stream supports
+// truncating, use [Seekable.CanTruncate]. To truncate a file I/O
+// stream, use [Seekable.Truncate].
 //
 // The default implementation of all the `GFileIOStream` operations
-// and the implementation of [iface@Gio.Seekable] just call into the same
+// and the implementation of [Seekable] just call into the same
 // operations on the output stream.
 type FileIOStream struct {
 	IOStream
@@ -447,22 +461,22 @@ func (x *FileIOStream) GetEtag() string {
 
 var xFileIOStreamQueryInfo func(uintptr, string, uintptr, **glib.Error) uintptr
 
-// Queries a file io stream for the given @attributes.
+// Queries a file io stream for the given attributes.
 // This function blocks while querying the stream. For the asynchronous
-// version of this function, see g_file_io_stream_query_info_async().
+// version of this function, see [FileIOStream.QueryInfoAsync].
 // While the stream is blocked, the stream will set the pending flag
 // internally, and any other operations on the stream will fail with
-// %G_IO_ERROR_PENDING.
+// [GIoErrorPendingValue].
 //
-// Can fail if the stream was already closed (with @error being set to
-// %G_IO_ERROR_CLOSED), the stream has pending operations (with @error being
-// set to %G_IO_ERROR_PENDING), or if querying info is not supported for
-// the stream's interface (with @error being set to %G_IO_ERROR_NOT_SUPPORTED). I
-// all cases of failure, %NULL will be returned.
+// Can fail if the stream was already closed (with error being set to
+// [GIoErrorClosedValue]), the stream has pending operations (with error being
+// set to [GIoErrorPendingValue]), or if querying info is not supported for
+// the stream's interface (with error being set to [GIoErrorNotSupportedValue]). I
+// all cases of failure, nil will be returned.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be set, and %NULL will
+// was cancelled, the error [GIoErrorCancelledValue] will be set, and nil will
 // be returned.
 func (x *FileIOStream) QueryInfo(AttributesVar string, CancellableVar *Cancellable) (*FileInfo, error) {
 	var cls *FileInfo
@@ -471,12 +485,15 @@ func (x *FileIOStream) QueryInfo(AttributesVar string, CancellableVar *Cancellab
 	cret := xFileIOStreamQueryInfo(x.GoPointer(), AttributesVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -484,12 +501,12 @@ func (x *FileIOStream) QueryInfo(AttributesVar string, CancellableVar *Cancellab
 
 var xFileIOStreamQueryInfoAsync func(uintptr, string, int, uintptr, uintptr, uintptr)
 
-// Asynchronously queries the @stream for a #GFileInfo. When completed,
-// @callback will be called with a #GAsyncResult which can be used to
-// finish the operation with g_file_io_stream_query_info_finish().
+// Asynchronously queries the stream for a [FileInfo]. When completed,
+// callback will be called with a [AsyncResult] which can be used to
+// finish the operation with [FileIOStream.QueryInfoFinish].
 //
 // For the synchronous version of this function, see
-// g_file_io_stream_query_info().
+// [FileIOStream.QueryInfo].
 func (x *FileIOStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -499,11 +516,12 @@ func (x *FileIOStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int, C
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -514,7 +532,7 @@ func (x *FileIOStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int, C
 var xFileIOStreamQueryInfoFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finalizes the asynchronous query started
-// by g_file_io_stream_query_info_async().
+// by [FileIOStream.QueryInfoAsync].
 func (x *FileIOStream) QueryInfoFinish(ResultVar AsyncResult) (*FileInfo, error) {
 	var cls *FileInfo
 	var cerr *glib.Error
@@ -522,12 +540,15 @@ func (x *FileIOStream) QueryInfoFinish(ResultVar AsyncResult) (*FileInfo, error)
 	cret := xFileIOStreamQueryInfoFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -544,7 +565,7 @@ func (c *FileIOStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *FileIOStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -552,14 +573,14 @@ func (x *FileIOStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *FileIOStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -570,15 +591,15 @@ func (x *FileIOStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileIOStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -591,13 +612,13 @@ func (x *FileIOStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *FileIOStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -605,7 +626,7 @@ func (x *FileIOStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (b
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -616,7 +637,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -625,9 +646,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileIOStreamGLibType, libs, "g_file_io_stream_get_type")
 
-	core.PuregoSafeRegister(&xFileIOStreamGetEtag, libs, "g_file_io_stream_get_etag")
-	core.PuregoSafeRegister(&xFileIOStreamQueryInfo, libs, "g_file_io_stream_query_info")
-	core.PuregoSafeRegister(&xFileIOStreamQueryInfoAsync, libs, "g_file_io_stream_query_info_async")
-	core.PuregoSafeRegister(&xFileIOStreamQueryInfoFinish, libs, "g_file_io_stream_query_info_finish")
+	gobject.CheckClassSize("GFileIOStreamClass", xFileIOStreamGLibType, unsafe.Sizeof(FileIOStreamClass{}))
+
+	core.PuregoRegisterSince(&xFileIOStreamGetEtag, libs, "g_file_io_stream_get_etag", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileIOStreamQueryInfo, libs, "g_file_io_stream_query_info", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileIOStreamQueryInfoAsync, libs, "g_file_io_stream_query_info_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xFileIOStreamQueryInfoFinish, libs, "g_file_io_stream_query_info_finish", "GIO", "2.22")
 
 }
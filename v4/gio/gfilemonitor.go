@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -41,7 +40,8 @@ func (x *FileMonitorClass) OverrideChanged(cb func(*FileMonitor, File, File, Fil
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(MonitorVarp uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent) {
+		x.xChanged = core.NewCallback(func(MonitorVarp uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent) {
+			defer core.RecoverPanic()
 			cb(FileMonitorNewFromInternalPtr(MonitorVarp), &FileBase{Ptr: FileVarp}, &FileBase{Ptr: OtherFileVarp}, EventTypeVarp)
 		})
 	}
@@ -53,7 +53,7 @@ func (x *FileMonitorClass) GetChanged() func(*FileMonitor, File, File, FileMonit
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(MonitorVar *FileMonitor, FileVar File, OtherFileVar File, EventTypeVar FileMonitorEvent) {
 		rawCallback(MonitorVar.GoPointer(), FileVar.GoPointer(), OtherFileVar.GoPointer(), EventTypeVar)
 	}
@@ -64,7 +64,8 @@ func (x *FileMonitorClass) OverrideCancel(cb func(*FileMonitor) bool) {
 	if cb == nil {
 		x.xCancel = 0
 	} else {
-		x.xCancel = purego.NewCallback(func(MonitorVarp uintptr) bool {
+		x.xCancel = core.NewCallback(func(MonitorVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileMonitorNewFromInternalPtr(MonitorVarp))
 		})
 	}
@@ -76,7 +77,7 @@ func (x *FileMonitorClass) GetCancel() func(*FileMonitor) bool {
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCancel)
+	core.RegisterFunc(&rawCallback, x.xCancel)
 	return func(MonitorVar *FileMonitor) bool {
 		return rawCallback(MonitorVar.GoPointer())
 	}
@@ -87,7 +88,8 @@ func (x *FileMonitorClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -99,7 +101,7 @@ func (x *FileMonitorClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -110,7 +112,8 @@ func (x *FileMonitorClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -122,7 +125,7 @@ func (x *FileMonitorClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -133,7 +136,8 @@ func (x *FileMonitorClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -145,7 +149,7 @@ func (x *FileMonitorClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -156,7 +160,8 @@ func (x *FileMonitorClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -168,7 +173,7 @@ func (x *FileMonitorClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -179,7 +184,8 @@ func (x *FileMonitorClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -191,7 +197,7 @@ func (x *FileMonitorClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -208,13 +214,13 @@ func (x *FileMonitorPrivate) GoPointer() uintptr {
 // Monitors a file or directory for changes.
 //
 // To obtain a `GFileMonitor` for a file or directory, use
-// [method@Gio.File.monitor], [method@Gio.File.monitor_file], or
-// [method@Gio.File.monitor_directory].
+// [File.Monitor], [File.MonitorFile], or
+// [File.MonitorDirectory].
 //
 // To get informed about changes to the file or directory you are
-// monitoring, connect to the [signal@Gio.FileMonitor::changed] signal. The
+// monitoring, connect to the [FileMonitor.ConnectChangedFunc] signal. The
 // signal will be emitted in the thread-default main context (see
-// [method@GLib.MainContext.push_thread_default]) of the thread that the monitor
+// [glib.MainContext.PushThreadDefault]) of the thread that the monitor
 // was created in (though if the global default main context is blocked, this
 // may cause notifications to be blocked even if the thread-default
 // context is still running).
@@ -245,12 +251,12 @@ func (x *FileMonitor) Cancel() bool {
 
 var xFileMonitorEmitEvent func(uintptr, uintptr, uintptr, FileMonitorEvent)
 
-// Emits the #GFileMonitor::changed signal if a change
+// Emits the [FileMonitor.ConnectChangedFunc] signal if a change
 // has taken place. Should be called from file monitor
 // implementations only.
 //
 // Implementations are responsible to call this method from the
-// thread-default main context (see [method@GLib.MainContext.push_thread_default])
+// thread-default main context (see [glib.MainContext.PushThreadDefault])
 // of the thread that the monitor was created in.
 func (x *FileMonitor) EmitEvent(ChildVar File, OtherFileVar File, EventTypeVar FileMonitorEvent) {
 
@@ -269,7 +275,7 @@ func (x *FileMonitor) IsCancelled() bool {
 
 var xFileMonitorSetRateLimit func(uintptr, int)
 
-// Sets the rate limit to which the @monitor will report
+// Sets the rate limit to which the monitor will report
 // consecutive change events to the same file.
 func (x *FileMonitor) SetRateLimit(LimitMsecsVar int) {
 
@@ -313,55 +319,77 @@ func (x *FileMonitor) GetPropertyRateLimit() int {
 	return v.GetInt()
 }
 
-// Emitted when @file has been changed.
+// Emitted when file has been changed.
 //
-// If using %G_FILE_MONITOR_WATCH_MOVES on a directory monitor, and
+// If using [GFileMonitorWatchMovesValue] on a directory monitor, and
 // the information is available (and if supported by the backend),
-// @event_type may be %G_FILE_MONITOR_EVENT_RENAMED,
-// %G_FILE_MONITOR_EVENT_MOVED_IN or %G_FILE_MONITOR_EVENT_MOVED_OUT.
+// event_type may be [GFileMonitorEventRenamedValue],
+// [GFileMonitorEventMovedInValue] or [GFileMonitorEventMovedOutValue].
 //
-// In all cases @file will be a child of the monitored directory.  For
-// renames, @file will be the old name and @other_file is the new
-// name.  For "moved in" events, @file is the name of the file that
-// appeared and @other_file is the old name that it was moved from (in
-// another directory).  For "moved out" events, @file is the name of
-// the file that used to be in this directory and @other_file is the
+// In all cases file will be a child of the monitored directory.  For
+// renames, file will be the old name and other_file is the new
+// name.  For "moved in" events, file is the name of the file that
+// appeared and other_file is the old name that it was moved from (in
+// another directory).  For "moved out" events, file is the name of
+// the file that used to be in this directory and other_file is the
 // name of the file at its new location.
 //
-// It makes sense to treat %G_FILE_MONITOR_EVENT_MOVED_IN as
-// equivalent to %G_FILE_MONITOR_EVENT_CREATED and
-// %G_FILE_MONITOR_EVENT_MOVED_OUT as equivalent to
-// %G_FILE_MONITOR_EVENT_DELETED, with extra information.
-// %G_FILE_MONITOR_EVENT_RENAMED is equivalent to a delete/create
+// It makes sense to treat [GFileMonitorEventMovedInValue] as
+// equivalent to [GFileMonitorEventCreatedValue] and
+// [GFileMonitorEventMovedOutValue] as equivalent to
+// [GFileMonitorEventDeletedValue], with extra information.
+// [GFileMonitorEventRenamedValue] is equivalent to a delete/create
 // pair.  This is exactly how the events will be reported in the case
-// that the %G_FILE_MONITOR_WATCH_MOVES flag is not in use.
+// that the [GFileMonitorWatchMovesValue] flag is not in use.
 //
-// If using the deprecated flag %G_FILE_MONITOR_SEND_MOVED flag and @event_type is
-// %G_FILE_MONITOR_EVENT_MOVED, @file will be set to a #GFile containing the
-// old path, and @other_file will be set to a #GFile containing the new path.
+// If using the deprecated flag [GFileMonitorSendMovedValue] flag and event_type is
+// [GFileMonitorEventMovedValue], file will be set to a [File] containing the
+// old path, and other_file will be set to a [File] containing the new path.
 //
-// In all the other cases, @other_file will be set to #NULL.
+// In all the other cases, other_file will be set to NULL.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *FileMonitor) ConnectChanged(cb *func(FileMonitor, uintptr, uintptr, FileMonitorEvent)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent) {
+			defer core.RecoverPanic()
+			fa := FileMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, FileVarp, OtherFileVarp, EventTypeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent) {
+var xFileMonitorChangedTrampoline uintptr
+
+func xFileMonitorChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, FileVarp uintptr, OtherFileVarp uintptr, EventTypeVarp FileMonitorEvent, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileMonitor, uintptr, uintptr, FileMonitorEvent))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, FileVarp, OtherFileVarp, EventTypeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileMonitor) ConnectChangedFunc(cb func(FileMonitor, uintptr, uintptr, FileMonitorEvent)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xFileMonitorChangedTrampoline, xFileMonitorChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -369,7 +397,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -378,9 +406,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileMonitorGLibType, libs, "g_file_monitor_get_type")
 
-	core.PuregoSafeRegister(&xFileMonitorCancel, libs, "g_file_monitor_cancel")
-	core.PuregoSafeRegister(&xFileMonitorEmitEvent, libs, "g_file_monitor_emit_event")
-	core.PuregoSafeRegister(&xFileMonitorIsCancelled, libs, "g_file_monitor_is_cancelled")
-	core.PuregoSafeRegister(&xFileMonitorSetRateLimit, libs, "g_file_monitor_set_rate_limit")
+	gobject.CheckClassSize("GFileMonitorClass", xFileMonitorGLibType, unsafe.Sizeof(FileMonitorClass{}))
+
+	core.PuregoRegisterSince(&xFileMonitorCancel, libs, "g_file_monitor_cancel", "GIO", "")
+	core.PuregoRegisterSince(&xFileMonitorEmitEvent, libs, "g_file_monitor_emit_event", "GIO", "")
+	core.PuregoRegisterSince(&xFileMonitorIsCancelled, libs, "g_file_monitor_is_cancelled", "GIO", "")
+	core.PuregoRegisterSince(&xFileMonitorSetRateLimit, libs, "g_file_monitor_set_rate_limit", "GIO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -35,7 +34,8 @@ func (x *FilenameCompleterClass) OverrideGotCompletionData(cb func(*FilenameComp
 	if cb == nil {
 		x.xGotCompletionData = 0
 	} else {
-		x.xGotCompletionData = purego.NewCallback(func(FilenameCompleterVarp uintptr) {
+		x.xGotCompletionData = core.NewCallback(func(FilenameCompleterVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FilenameCompleterNewFromInternalPtr(FilenameCompleterVarp))
 		})
 	}
@@ -47,7 +47,7 @@ func (x *FilenameCompleterClass) GetGotCompletionData() func(*FilenameCompleter)
 		return nil
 	}
 	var rawCallback func(FilenameCompleterVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xGotCompletionData)
+	core.RegisterFunc(&rawCallback, x.xGotCompletionData)
 	return func(FilenameCompleterVar *FilenameCompleter) {
 		rawCallback(FilenameCompleterVar.GoPointer())
 	}
@@ -58,7 +58,8 @@ func (x *FilenameCompleterClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -70,7 +71,7 @@ func (x *FilenameCompleterClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -81,7 +82,8 @@ func (x *FilenameCompleterClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -93,7 +95,7 @@ func (x *FilenameCompleterClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -104,7 +106,8 @@ func (x *FilenameCompleterClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -116,7 +119,7 @@ func (x *FilenameCompleterClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -159,7 +162,7 @@ func NewFilenameCompleter() *FilenameCompleter {
 
 var xFilenameCompleterGetCompletionSuffix func(uintptr, string) string
 
-// Obtains a suffix completion for @initial_text from @completer.
+// Obtains a suffix completion for initial_text from completer.
 //
 // Suffix will be an empty string if there's no shared suffix among matching
 // completions. If there's no matching completions anyway, `NULL` is returned.
@@ -169,18 +172,18 @@ func (x *FilenameCompleter) GetCompletionSuffix(InitialTextVar string) string {
 	return cret
 }
 
-var xFilenameCompleterGetCompletions func(uintptr, string) []string
+var xFilenameCompleterGetCompletions func(uintptr, string) uintptr
 
 // Gets an array of completion strings for a given initial text.
 func (x *FilenameCompleter) GetCompletions(InitialTextVar string) []string {
 
 	cret := xFilenameCompleterGetCompletions(x.GoPointer(), InitialTextVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xFilenameCompleterSetDirsOnly func(uintptr, bool)
 
-// If @dirs_only is %TRUE, @completer will only
+// If dirs_only is true, completer will only
 // complete directory names, and not file names.
 //
 // This function needs to be called before waiting for results from the
@@ -203,27 +206,49 @@ func (c *FilenameCompleter) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when the file name completion information comes available.
+//
+// Deprecated: use ConnectGotCompletionDataFunc, which also accepts method values and closures.
 func (x *FilenameCompleter) ConnectGotCompletionData(cb *func(FilenameCompleter)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "got-completion-data", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FilenameCompleter{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "got-completion-data", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFilenameCompleterGotCompletionDataTrampoline uintptr
+
+func xFilenameCompleterGotCompletionDataNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FilenameCompleter))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FilenameCompleter{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "got-completion-data", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectGotCompletionDataFunc connects cb to the "got-completion-data" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FilenameCompleter) ConnectGotCompletionDataFunc(cb func(FilenameCompleter)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "got-completion-data", &xFilenameCompleterGotCompletionDataTrampoline, xFilenameCompleterGotCompletionDataNewTrampoline, cb)
 }
 
 func init() {
@@ -231,7 +256,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -240,10 +265,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xFilenameCompleterGLibType, libs, "g_filename_completer_get_type")
 
-	core.PuregoSafeRegister(&xNewFilenameCompleter, libs, "g_filename_completer_new")
+	gobject.CheckClassSize("GFilenameCompleterClass", xFilenameCompleterGLibType, unsafe.Sizeof(FilenameCompleterClass{}))
+
+	core.PuregoRegisterSince(&xNewFilenameCompleter, libs, "g_filename_completer_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xFilenameCompleterGetCompletionSuffix, libs, "g_filename_completer_get_completion_suffix")
-	core.PuregoSafeRegister(&xFilenameCompleterGetCompletions, libs, "g_filename_completer_get_completions")
-	core.PuregoSafeRegister(&xFilenameCompleterSetDirsOnly, libs, "g_filename_completer_set_dirs_only")
+	core.PuregoRegisterSince(&xFilenameCompleterGetCompletionSuffix, libs, "g_filename_completer_get_completion_suffix", "GIO", "")
+	core.PuregoRegisterSince(&xFilenameCompleterGetCompletions, libs, "g_filename_completer_get_completions", "GIO", "")
+	core.PuregoRegisterSince(&xFilenameCompleterSetDirsOnly, libs, "g_filename_completer_set_dirs_only", "GIO", "")
 
 }
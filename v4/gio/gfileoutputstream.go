@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -54,7 +54,8 @@ func (x *FileOutputStreamClass) OverrideTell(cb func(*FileOutputStream) int64) {
 	if cb == nil {
 		x.xTell = 0
 	} else {
-		x.xTell = purego.NewCallback(func(StreamVarp uintptr) int64 {
+		x.xTell = core.NewCallback(func(StreamVarp uintptr) int64 {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -66,7 +67,7 @@ func (x *FileOutputStreamClass) GetTell() func(*FileOutputStream) int64 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) int64
-	purego.RegisterFunc(&rawCallback, x.xTell)
+	core.RegisterFunc(&rawCallback, x.xTell)
 	return func(StreamVar *FileOutputStream) int64 {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -77,7 +78,8 @@ func (x *FileOutputStreamClass) OverrideCanSeek(cb func(*FileOutputStream) bool)
 	if cb == nil {
 		x.xCanSeek = 0
 	} else {
-		x.xCanSeek = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanSeek = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -89,7 +91,7 @@ func (x *FileOutputStreamClass) GetCanSeek() func(*FileOutputStream) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanSeek)
+	core.RegisterFunc(&rawCallback, x.xCanSeek)
 	return func(StreamVar *FileOutputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -100,7 +102,8 @@ func (x *FileOutputStreamClass) OverrideSeek(cb func(*FileOutputStream, int64, g
 	if cb == nil {
 		x.xSeek = 0
 	} else {
-		x.xSeek = purego.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+		x.xSeek = core.NewCallback(func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp), OffsetVarp, TypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -112,7 +115,7 @@ func (x *FileOutputStreamClass) GetSeek() func(*FileOutputStream, int64, glib.Se
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSeek)
+	core.RegisterFunc(&rawCallback, x.xSeek)
 	return func(StreamVar *FileOutputStream, OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer())
 	}
@@ -123,7 +126,8 @@ func (x *FileOutputStreamClass) OverrideCanTruncate(cb func(*FileOutputStream) b
 	if cb == nil {
 		x.xCanTruncate = 0
 	} else {
-		x.xCanTruncate = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanTruncate = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -135,7 +139,7 @@ func (x *FileOutputStreamClass) GetCanTruncate() func(*FileOutputStream) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanTruncate)
+	core.RegisterFunc(&rawCallback, x.xCanTruncate)
 	return func(StreamVar *FileOutputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -146,7 +150,8 @@ func (x *FileOutputStreamClass) OverrideTruncateFn(cb func(*FileOutputStream, in
 	if cb == nil {
 		x.xTruncateFn = 0
 	} else {
-		x.xTruncateFn = purego.NewCallback(func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool {
+		x.xTruncateFn = core.NewCallback(func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp), SizeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -158,7 +163,7 @@ func (x *FileOutputStreamClass) GetTruncateFn() func(*FileOutputStream, int64, *
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, SizeVarp int64, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xTruncateFn)
+	core.RegisterFunc(&rawCallback, x.xTruncateFn)
 	return func(StreamVar *FileOutputStream, SizeVar int64, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), SizeVar, CancellableVar.GoPointer())
 	}
@@ -169,7 +174,8 @@ func (x *FileOutputStreamClass) OverrideQueryInfo(cb func(*FileOutputStream, str
 	if cb == nil {
 		x.xQueryInfo = 0
 	} else {
-		x.xQueryInfo = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+		x.xQueryInfo = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileOutputStreamNewFromInternalPtr(StreamVarp), AttributesVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -185,7 +191,7 @@ func (x *FileOutputStreamClass) GetQueryInfo() func(*FileOutputStream, string, *
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfo)
+	core.RegisterFunc(&rawCallback, x.xQueryInfo)
 	return func(StreamVar *FileOutputStream, AttributesVar string, CancellableVar *Cancellable) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), AttributesVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -202,7 +208,8 @@ func (x *FileOutputStreamClass) OverrideQueryInfoAsync(cb func(*FileOutputStream
 	if cb == nil {
 		x.xQueryInfoAsync = 0
 	} else {
-		x.xQueryInfoAsync = purego.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xQueryInfoAsync = core.NewCallback(func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FileOutputStreamNewFromInternalPtr(StreamVarp), AttributesVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -214,7 +221,7 @@ func (x *FileOutputStreamClass) GetQueryInfoAsync() func(*FileOutputStream, stri
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, AttributesVarp string, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoAsync)
 	return func(StreamVar *FileOutputStream, AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), AttributesVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -225,7 +232,8 @@ func (x *FileOutputStreamClass) OverrideQueryInfoFinish(cb func(*FileOutputStrea
 	if cb == nil {
 		x.xQueryInfoFinish = 0
 	} else {
-		x.xQueryInfoFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xQueryInfoFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FileOutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -241,7 +249,7 @@ func (x *FileOutputStreamClass) GetQueryInfoFinish() func(*FileOutputStream, Asy
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
+	core.RegisterFunc(&rawCallback, x.xQueryInfoFinish)
 	return func(StreamVar *FileOutputStream, ResultVar AsyncResult) *FileInfo {
 		rawRet := rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -258,7 +266,8 @@ func (x *FileOutputStreamClass) OverrideGetEtag(cb func(*FileOutputStream) strin
 	if cb == nil {
 		x.xGetEtag = 0
 	} else {
-		x.xGetEtag = purego.NewCallback(func(StreamVarp uintptr) string {
+		x.xGetEtag = core.NewCallback(func(StreamVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(FileOutputStreamNewFromInternalPtr(StreamVarp))
 		})
 	}
@@ -270,7 +279,7 @@ func (x *FileOutputStreamClass) GetGetEtag() func(*FileOutputStream) string {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetEtag)
+	core.RegisterFunc(&rawCallback, x.xGetEtag)
 	return func(StreamVar *FileOutputStream) string {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -281,7 +290,8 @@ func (x *FileOutputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -293,7 +303,7 @@ func (x *FileOutputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -304,7 +314,8 @@ func (x *FileOutputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -316,7 +327,7 @@ func (x *FileOutputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -327,7 +338,8 @@ func (x *FileOutputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -339,7 +351,7 @@ func (x *FileOutputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -350,7 +362,8 @@ func (x *FileOutputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -362,7 +375,7 @@ func (x *FileOutputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -373,7 +386,8 @@ func (x *FileOutputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -385,7 +399,7 @@ func (x *FileOutputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -402,17 +416,17 @@ func (x *FileOutputStreamPrivate) GoPointer() uintptr {
 // `GFileOutputStream` provides output streams that write their
 // content to a file.
 //
-// `GFileOutputStream` implements [iface@Gio.Seekable], which allows the output
+// `GFileOutputStream` implements [Seekable], which allows the output
 // stream to jump to arbitrary positions in the file and to truncate
 // the file, provided the filesystem of the file supports these
 // operations.
 //
-// To find the position of a file output stream, use [method@Gio.Seekable.tell].
+// To find the position of a file output stream, use [Seekable.Tell].
 // To find out if a file output stream supports seeking, use
-// [method@Gio.Seekable.can_seek].To position a file output stream, use
-// [method@Gio.Seekable.seek]. To find out if a file output stream supports
-// truncating, use [method@Gio.Seekable.can_truncate]. To truncate a file output
-// stream, use [method@Gio.Seekable.truncate].
+// [Seekable.CanSeek].To position a file output stream, use
+// [Seekable.Seek]. To find out if a file output stream supports
+// truncating, use [Seekable.CanTruncate]. To truncate a file output
+// stream, use [Seekable.Truncate].
 type FileOutputStream struct {
 	OutputStream
 }
@@ -442,22 +456,22 @@ func (x *FileOutputStream) GetEtag() string {
 
 var xFileOutputStreamQueryInfo func(uintptr, string, uintptr, **glib.Error) uintptr
 
-// Queries a file output stream for the given @attributes.
+// Queries a file output stream for the given attributes.
 // This function blocks while querying the stream. For the asynchronous
-// version of this function, see g_file_output_stream_query_info_async().
+// version of this function, see [FileOutputStream.QueryInfoAsync].
 // While the stream is blocked, the stream will set the pending flag
 // internally, and any other operations on the stream will fail with
-// %G_IO_ERROR_PENDING.
+// [GIoErrorPendingValue].
 //
-// Can fail if the stream was already closed (with @error being set to
-// %G_IO_ERROR_CLOSED), the stream has pending operations (with @error being
-// set to %G_IO_ERROR_PENDING), or if querying info is not supported for
-// the stream's interface (with @error being set to %G_IO_ERROR_NOT_SUPPORTED). In
-// all cases of failure, %NULL will be returned.
+// Can fail if the stream was already closed (with error being set to
+// [GIoErrorClosedValue]), the stream has pending operations (with error being
+// set to [GIoErrorPendingValue]), or if querying info is not supported for
+// the stream's interface (with error being set to [GIoErrorNotSupportedValue]). In
+// all cases of failure, nil will be returned.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be set, and %NULL will
+// was cancelled, the error [GIoErrorCancelledValue] will be set, and nil will
 // be returned.
 func (x *FileOutputStream) QueryInfo(AttributesVar string, CancellableVar *Cancellable) (*FileInfo, error) {
 	var cls *FileInfo
@@ -466,12 +480,15 @@ func (x *FileOutputStream) QueryInfo(AttributesVar string, CancellableVar *Cance
 	cret := xFileOutputStreamQueryInfo(x.GoPointer(), AttributesVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -479,12 +496,12 @@ func (x *FileOutputStream) QueryInfo(AttributesVar string, CancellableVar *Cance
 
 var xFileOutputStreamQueryInfoAsync func(uintptr, string, int, uintptr, uintptr, uintptr)
 
-// Asynchronously queries the @stream for a #GFileInfo. When completed,
-// @callback will be called with a #GAsyncResult which can be used to
-// finish the operation with g_file_output_stream_query_info_finish().
+// Asynchronously queries the stream for a [FileInfo]. When completed,
+// callback will be called with a [AsyncResult] which can be used to
+// finish the operation with [FileOutputStream.QueryInfoFinish].
 //
 // For the synchronous version of this function, see
-// g_file_output_stream_query_info().
+// [FileOutputStream.QueryInfo].
 func (x *FileOutputStream) QueryInfoAsync(AttributesVar string, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -494,11 +511,12 @@ func (x *FileOutputStream) QueryInfoAsync(AttributesVar string, IoPriorityVar in
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -509,7 +527,7 @@ func (x *FileOutputStream) QueryInfoAsync(AttributesVar string, IoPriorityVar in
 var xFileOutputStreamQueryInfoFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finalizes the asynchronous query started
-// by g_file_output_stream_query_info_async().
+// by [FileOutputStream.QueryInfoAsync].
 func (x *FileOutputStream) QueryInfoFinish(ResultVar AsyncResult) (*FileInfo, error) {
 	var cls *FileInfo
 	var cerr *glib.Error
@@ -517,12 +535,15 @@ func (x *FileOutputStream) QueryInfoFinish(ResultVar AsyncResult) (*FileInfo, er
 	cret := xFileOutputStreamQueryInfoFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &FileInfo{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -539,7 +560,7 @@ func (c *FileOutputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *FileOutputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -547,14 +568,14 @@ func (x *FileOutputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *FileOutputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -565,15 +586,15 @@ func (x *FileOutputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *FileOutputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -586,13 +607,13 @@ func (x *FileOutputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *FileOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -600,7 +621,7 @@ func (x *FileOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -611,7 +632,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -620,9 +641,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileOutputStreamGLibType, libs, "g_file_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xFileOutputStreamGetEtag, libs, "g_file_output_stream_get_etag")
-	core.PuregoSafeRegister(&xFileOutputStreamQueryInfo, libs, "g_file_output_stream_query_info")
-	core.PuregoSafeRegister(&xFileOutputStreamQueryInfoAsync, libs, "g_file_output_stream_query_info_async")
-	core.PuregoSafeRegister(&xFileOutputStreamQueryInfoFinish, libs, "g_file_output_stream_query_info_finish")
+	gobject.CheckClassSize("GFileOutputStreamClass", xFileOutputStreamGLibType, unsafe.Sizeof(FileOutputStreamClass{}))
+
+	core.PuregoRegisterSince(&xFileOutputStreamGetEtag, libs, "g_file_output_stream_get_etag", "GIO", "")
+	core.PuregoRegisterSince(&xFileOutputStreamQueryInfo, libs, "g_file_output_stream_query_info", "GIO", "")
+	core.PuregoRegisterSince(&xFileOutputStreamQueryInfoAsync, libs, "g_file_output_stream_query_info_async", "GIO", "")
+	core.PuregoRegisterSince(&xFileOutputStreamQueryInfoFinish, libs, "g_file_output_stream_query_info_finish", "GIO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -32,7 +31,8 @@ func (x *FilterInputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -44,7 +44,7 @@ func (x *FilterInputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -55,7 +55,8 @@ func (x *FilterInputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -67,7 +68,7 @@ func (x *FilterInputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -78,7 +79,8 @@ func (x *FilterInputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -90,7 +92,7 @@ func (x *FilterInputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -135,7 +137,7 @@ func (x *FilterInputStream) GetBaseStream() *InputStream {
 
 var xFilterInputStreamGetCloseBaseStream func(uintptr) bool
 
-// Returns whether the base stream will be closed when @stream is
+// Returns whether the base stream will be closed when stream is
 // closed.
 func (x *FilterInputStream) GetCloseBaseStream() bool {
 
@@ -145,7 +147,7 @@ func (x *FilterInputStream) GetCloseBaseStream() bool {
 
 var xFilterInputStreamSetCloseBaseStream func(uintptr, bool)
 
-// Sets whether the base stream will be closed when @stream is closed.
+// Sets whether the base stream will be closed when stream is closed.
 func (x *FilterInputStream) SetCloseBaseStream(CloseBaseVar bool) {
 
 	xFilterInputStreamSetCloseBaseStream(x.GoPointer(), CloseBaseVar)
@@ -185,7 +187,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -194,8 +196,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xFilterInputStreamGLibType, libs, "g_filter_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xFilterInputStreamGetBaseStream, libs, "g_filter_input_stream_get_base_stream")
-	core.PuregoSafeRegister(&xFilterInputStreamGetCloseBaseStream, libs, "g_filter_input_stream_get_close_base_stream")
-	core.PuregoSafeRegister(&xFilterInputStreamSetCloseBaseStream, libs, "g_filter_input_stream_set_close_base_stream")
+	gobject.CheckClassSize("GFilterInputStreamClass", xFilterInputStreamGLibType, unsafe.Sizeof(FilterInputStreamClass{}))
+
+	core.PuregoRegisterSince(&xFilterInputStreamGetBaseStream, libs, "g_filter_input_stream_get_base_stream", "GIO", "")
+	core.PuregoRegisterSince(&xFilterInputStreamGetCloseBaseStream, libs, "g_filter_input_stream_get_close_base_stream", "GIO", "")
+	core.PuregoRegisterSince(&xFilterInputStreamSetCloseBaseStream, libs, "g_filter_input_stream_set_close_base_stream", "GIO", "")
 
 }
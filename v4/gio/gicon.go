@@ -5,19 +5,19 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // GIconIface is used to implement GIcon types for various
-// different systems. See #GThemedIcon and #GLoadableIcon for
+// different systems. See [ThemedIcon] and [LoadableIcon] for
 // examples of how to implement this interface.
 type IconIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xHash uintptr
 
@@ -35,93 +35,97 @@ func (x *IconIface) GoPointer() uintptr {
 }
 
 // OverrideHash sets the "hash" callback function.
-// A hash for a given #GIcon.
+// A hash for a given [Icon].
 func (x *IconIface) OverrideHash(cb func(Icon) uint) {
 	if cb == nil {
 		x.xHash = 0
 	} else {
-		x.xHash = purego.NewCallback(func(IconVarp uintptr) uint {
+		x.xHash = core.NewCallback(func(IconVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(&IconBase{Ptr: IconVarp})
 		})
 	}
 }
 
 // GetHash gets the "hash" callback function.
-// A hash for a given #GIcon.
+// A hash for a given [Icon].
 func (x *IconIface) GetHash() func(Icon) uint {
 	if x.xHash == 0 {
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xHash)
+	core.RegisterFunc(&rawCallback, x.xHash)
 	return func(IconVar Icon) uint {
 		return rawCallback(IconVar.GoPointer())
 	}
 }
 
 // OverrideEqual sets the "equal" callback function.
-// Checks if two #GIcons are equal.
+// Checks if two GIcons are equal.
 func (x *IconIface) OverrideEqual(cb func(Icon, Icon) bool) {
 	if cb == nil {
 		x.xEqual = 0
 	} else {
-		x.xEqual = purego.NewCallback(func(Icon1Varp uintptr, Icon2Varp uintptr) bool {
+		x.xEqual = core.NewCallback(func(Icon1Varp uintptr, Icon2Varp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&IconBase{Ptr: Icon1Varp}, &IconBase{Ptr: Icon2Varp})
 		})
 	}
 }
 
 // GetEqual gets the "equal" callback function.
-// Checks if two #GIcons are equal.
+// Checks if two GIcons are equal.
 func (x *IconIface) GetEqual() func(Icon, Icon) bool {
 	if x.xEqual == 0 {
 		return nil
 	}
 	var rawCallback func(Icon1Varp uintptr, Icon2Varp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEqual)
+	core.RegisterFunc(&rawCallback, x.xEqual)
 	return func(Icon1Var Icon, Icon2Var Icon) bool {
 		return rawCallback(Icon1Var.GoPointer(), Icon2Var.GoPointer())
 	}
 }
 
 // OverrideToTokens sets the "to_tokens" callback function.
-// Serializes a #GIcon into tokens. The tokens must not
-// contain any whitespace. Don't implement if the #GIcon can't be
+// Serializes a [Icon] into tokens. The tokens must not
+// contain any whitespace. Don't implement if the [Icon] can't be
 // serialized (Since 2.20).
 func (x *IconIface) OverrideToTokens(cb func(Icon, *[]string, *int) bool) {
 	if cb == nil {
 		x.xToTokens = 0
 	} else {
-		x.xToTokens = purego.NewCallback(func(IconVarp uintptr, TokensVarp *[]string, OutVersionVarp *int) bool {
+		x.xToTokens = core.NewCallback(func(IconVarp uintptr, TokensVarp *[]string, OutVersionVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(&IconBase{Ptr: IconVarp}, TokensVarp, OutVersionVarp)
 		})
 	}
 }
 
 // GetToTokens gets the "to_tokens" callback function.
-// Serializes a #GIcon into tokens. The tokens must not
-// contain any whitespace. Don't implement if the #GIcon can't be
+// Serializes a [Icon] into tokens. The tokens must not
+// contain any whitespace. Don't implement if the [Icon] can't be
 // serialized (Since 2.20).
 func (x *IconIface) GetToTokens() func(Icon, *[]string, *int) bool {
 	if x.xToTokens == 0 {
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr, TokensVarp *[]string, OutVersionVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xToTokens)
+	core.RegisterFunc(&rawCallback, x.xToTokens)
 	return func(IconVar Icon, TokensVar *[]string, OutVersionVar *int) bool {
 		return rawCallback(IconVar.GoPointer(), TokensVar, OutVersionVar)
 	}
 }
 
 // OverrideFromTokens sets the "from_tokens" callback function.
-// Constructs a #GIcon from tokens. Set the #GError if
-// the tokens are malformed. Don't implement if the #GIcon can't be
+// Constructs a [Icon] from tokens. Set the [glib.Error] if
+// the tokens are malformed. Don't implement if the [Icon] can't be
 // serialized (Since 2.20).
 func (x *IconIface) OverrideFromTokens(cb func(string, int, int) *IconBase) {
 	if cb == nil {
 		x.xFromTokens = 0
 	} else {
-		x.xFromTokens = purego.NewCallback(func(TokensVarp string, NumTokensVarp int, VersionVarp int) uintptr {
+		x.xFromTokens = core.NewCallback(func(TokensVarp string, NumTokensVarp int, VersionVarp int) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TokensVarp, NumTokensVarp, VersionVarp)
 			if ret == nil {
 				return 0
@@ -132,15 +136,15 @@ func (x *IconIface) OverrideFromTokens(cb func(string, int, int) *IconBase) {
 }
 
 // GetFromTokens gets the "from_tokens" callback function.
-// Constructs a #GIcon from tokens. Set the #GError if
-// the tokens are malformed. Don't implement if the #GIcon can't be
+// Constructs a [Icon] from tokens. Set the [glib.Error] if
+// the tokens are malformed. Don't implement if the [Icon] can't be
 // serialized (Since 2.20).
 func (x *IconIface) GetFromTokens() func(string, int, int) *IconBase {
 	if x.xFromTokens == 0 {
 		return nil
 	}
 	var rawCallback func(TokensVarp string, NumTokensVarp int, VersionVarp int) uintptr
-	purego.RegisterFunc(&rawCallback, x.xFromTokens)
+	core.RegisterFunc(&rawCallback, x.xFromTokens)
 	return func(TokensVar string, NumTokensVar int, VersionVar int) *IconBase {
 		rawRet := rawCallback(TokensVar, NumTokensVar, VersionVar)
 		if rawRet == 0 {
@@ -153,25 +157,26 @@ func (x *IconIface) GetFromTokens() func(string, int, int) *IconBase {
 }
 
 // OverrideSerialize sets the "serialize" callback function.
-// Serializes a #GIcon into a #GVariant. Since: 2.38
+// Serializes a [Icon] into a [glib.Variant]. Since: 2.38
 func (x *IconIface) OverrideSerialize(cb func(Icon) *glib.Variant) {
 	if cb == nil {
 		x.xSerialize = 0
 	} else {
-		x.xSerialize = purego.NewCallback(func(IconVarp uintptr) *glib.Variant {
+		x.xSerialize = core.NewCallback(func(IconVarp uintptr) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&IconBase{Ptr: IconVarp})
 		})
 	}
 }
 
 // GetSerialize gets the "serialize" callback function.
-// Serializes a #GIcon into a #GVariant. Since: 2.38
+// Serializes a [Icon] into a [glib.Variant]. Since: 2.38
 func (x *IconIface) GetSerialize() func(Icon) *glib.Variant {
 	if x.xSerialize == 0 {
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xSerialize)
+	core.RegisterFunc(&rawCallback, x.xSerialize)
 	return func(IconVar Icon) *glib.Variant {
 		return rawCallback(IconVar.GoPointer())
 	}
@@ -183,30 +188,32 @@ func (x *IconIface) GetSerialize() func(Icon) *glib.Variant {
 //
 // `GIcon` does not provide the actual pixmap for the icon as this is out
 // of GIO's scope, however implementations of `GIcon` may contain the name
-// of an icon (see [class@Gio.ThemedIcon]), or the path to an icon
-// (see [iface@Gio.LoadableIcon]).
+// of an icon (see [ThemedIcon]), or the path to an icon
+// (see [LoadableIcon]).
 //
-// To obtain a hash of a `GIcon`, see [method@Gio.Icon.hash].
+// To obtain a hash of a `GIcon`, see [Icon.Hash].
 //
-// To check if two `GIcon`s are equal, see [method@Gio.Icon.equal].
+// To check if two `GIcon`s are equal, see [Icon.Equal].
 //
-// For serializing a `GIcon`, use [method@Gio.Icon.serialize] and
-// [func@Gio.Icon.deserialize].
+// For serializing a `GIcon`, use [Icon.Serialize] and
+// [IconDeserialize].
 //
 // If you want to consume `GIcon` (for example, in a toolkit) you must
 // be prepared to handle at least the three following cases:
-// [iface@Gio.LoadableIcon], [class@Gio.ThemedIcon] and [class@Gio.EmblemedIcon].
+// [LoadableIcon], [ThemedIcon] and [EmblemedIcon].
 // It may also make sense to have fast-paths for other cases (like handling
-// [`GdkPixbuf`](https://docs.gtk.org/gdk-pixbuf/class.Pixbuf.html) directly,
+// [GdkPixbuf] directly,
 // for example) but all compliant `GIcon` implementations outside of GIO must
-// implement [iface@Gio.LoadableIcon].
+// implement [LoadableIcon].
 //
 // If your application or library provides one or more `GIcon`
 // implementations you need to ensure that your new implementation also
-// implements [iface@Gio.LoadableIcon].  Additionally, you must provide an
-// implementation of [method@Gio.Icon.serialize] that gives a result that is
-// understood by [func@Gio.Icon.deserialize], yielding one of the built-in
+// implements [LoadableIcon].  Additionally, you must provide an
+// implementation of [Icon.Serialize] that gives a result that is
+// understood by [IconDeserialize], yielding one of the built-in
 // icon types.
+//
+// [GdkPixbuf]: https://docs.gtk.org/gdk-pixbuf/class.Pixbuf.html
 type Icon interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -237,6 +244,47 @@ func (x *IconBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementIcon adds the Icon interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Hash() uint
+//   - Equal(Icon) bool
+//   - ToTokens(*[]string, *int) bool
+//   - Serialize() *glib.Variant
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see IconIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementIcon[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, IconGLibType(), func(iface *IconIface) {
+		if _, ok := any(zero).(interface{ Hash() uint }); ok {
+			iface.OverrideHash(func(self Icon) uint {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Hash() uint })
+				return impl.Hash()
+			})
+		}
+		if _, ok := any(zero).(interface{ Equal(Icon) bool }); ok {
+			iface.OverrideEqual(func(self Icon, arg0 Icon) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Equal(Icon) bool })
+				return impl.Equal(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ToTokens(*[]string, *int) bool }); ok {
+			iface.OverrideToTokens(func(self Icon, arg0 *[]string, arg1 *int) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ToTokens(*[]string, *int) bool })
+				return impl.ToTokens(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ Serialize() *glib.Variant }); ok {
+			iface.OverrideSerialize(func(self Icon) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Serialize() *glib.Variant })
+				return impl.Serialize()
+			})
+		}
+	})
+}
+
 // Checks if two icons are equal.
 func (x *IconBase) Equal(Icon2Var Icon) bool {
 
@@ -251,10 +299,10 @@ func (x *IconBase) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *IconBase) Serialize() *glib.Variant {
 
@@ -262,21 +310,21 @@ func (x *IconBase) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *IconBase) ToString() string {
 
@@ -291,7 +339,7 @@ var XGIconToString func(uintptr) string
 
 var xIconDeserialize func(*glib.Variant) uintptr
 
-// Deserializes a #GIcon previously serialized using g_icon_serialize().
+// Deserializes a [Icon] previously serialized using [Icon.Serialize].
 func IconDeserialize(ValueVar *glib.Variant) *IconBase {
 	var cls *IconBase
 
@@ -307,12 +355,12 @@ func IconDeserialize(ValueVar *glib.Variant) *IconBase {
 
 var xIconNewForString func(string, **glib.Error) uintptr
 
-// Generate a #GIcon instance from @str. This function can fail if
-// @str is not valid - see g_icon_to_string() for discussion.
+// Generate a [Icon] instance from str. This function can fail if
+// str is not valid - see [Icon.ToString] for discussion.
 //
-// If your application or library provides one or more #GIcon
-// implementations you need to ensure that each #GType is registered
-// with the type system prior to calling g_icon_new_for_string().
+// If your application or library provides one or more [Icon]
+// implementations you need to ensure that each [gobject.Type] is registered
+// with the type system prior to calling [IconNewForString].
 func IconNewForString(StrVar string) (*IconBase, error) {
 	var cls *IconBase
 	var cerr *glib.Error
@@ -320,12 +368,15 @@ func IconNewForString(StrVar string) (*IconBase, error) {
 	cret := xIconNewForString(StrVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &IconBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -336,21 +387,23 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xIconDeserialize, libs, "g_icon_deserialize")
-	core.PuregoSafeRegister(&xIconNewForString, libs, "g_icon_new_for_string")
+	core.PuregoRegisterSince(&xIconDeserialize, libs, "g_icon_deserialize", "GIO", "2.38")
+	core.PuregoRegisterSince(&xIconNewForString, libs, "g_icon_new_for_string", "GIO", "2.20")
 
 	core.PuregoSafeRegister(&xIconGLibType, libs, "g_icon_get_type")
 
-	core.PuregoSafeRegister(&XGIconEqual, libs, "g_icon_equal")
-	core.PuregoSafeRegister(&XGIconHash, libs, "g_icon_hash")
-	core.PuregoSafeRegister(&XGIconSerialize, libs, "g_icon_serialize")
-	core.PuregoSafeRegister(&XGIconToString, libs, "g_icon_to_string")
+	gobject.CheckClassSize("GIconIface", xIconGLibType, unsafe.Sizeof(IconIface{}))
+
+	core.PuregoRegisterSince(&XGIconEqual, libs, "g_icon_equal", "GIO", "")
+	core.PuregoRegisterSince(&XGIconHash, libs, "g_icon_hash", "GIO", "")
+	core.PuregoRegisterSince(&XGIconSerialize, libs, "g_icon_serialize", "GIO", "2.38")
+	core.PuregoRegisterSince(&XGIconToString, libs, "g_icon_to_string", "GIO", "2.20")
 
 }
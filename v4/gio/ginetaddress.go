@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -30,7 +29,8 @@ func (x *InetAddressClass) OverrideToString(cb func(*InetAddress) string) {
 	if cb == nil {
 		x.xToString = 0
 	} else {
-		x.xToString = purego.NewCallback(func(AddressVarp uintptr) string {
+		x.xToString = core.NewCallback(func(AddressVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(InetAddressNewFromInternalPtr(AddressVarp))
 		})
 	}
@@ -42,7 +42,7 @@ func (x *InetAddressClass) GetToString() func(*InetAddress) string {
 		return nil
 	}
 	var rawCallback func(AddressVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xToString)
+	core.RegisterFunc(&rawCallback, x.xToString)
 	return func(AddressVar *InetAddress) string {
 		return rawCallback(AddressVar.GoPointer())
 	}
@@ -53,7 +53,8 @@ func (x *InetAddressClass) OverrideToBytes(cb func(*InetAddress) byte) {
 	if cb == nil {
 		x.xToBytes = 0
 	} else {
-		x.xToBytes = purego.NewCallback(func(AddressVarp uintptr) byte {
+		x.xToBytes = core.NewCallback(func(AddressVarp uintptr) byte {
+			defer core.RecoverPanic()
 			return cb(InetAddressNewFromInternalPtr(AddressVarp))
 		})
 	}
@@ -65,7 +66,7 @@ func (x *InetAddressClass) GetToBytes() func(*InetAddress) byte {
 		return nil
 	}
 	var rawCallback func(AddressVarp uintptr) byte
-	purego.RegisterFunc(&rawCallback, x.xToBytes)
+	core.RegisterFunc(&rawCallback, x.xToBytes)
 	return func(AddressVar *InetAddress) byte {
 		return rawCallback(AddressVar.GoPointer())
 	}
@@ -80,14 +81,14 @@ func (x *InetAddressPrivate) GoPointer() uintptr {
 }
 
 // `GInetAddress` represents an IPv4 or IPv6 internet address. Use
-// [method@Gio.Resolver.lookup_by_name] or
-// [method@Gio.Resolver.lookup_by_name_async] to look up the `GInetAddress` for
-// a hostname. Use [method@Gio.Resolver.lookup_by_address] or
-// [method@Gio.Resolver.lookup_by_address_async] to look up the hostname for a
+// [Resolver.LookupByName] or
+// [Resolver.LookupByNameAsync] to look up the `GInetAddress` for
+// a hostname. Use [Resolver.LookupByAddress] or
+// [Resolver.LookupByAddressAsync] to look up the hostname for a
 // `GInetAddress`.
 //
 // To actually connect to a remote host, you will need a
-// [class@Gio.InetSocketAddress] (which includes a `GInetAddress` as well as a
+// [InetSocketAddress] (which includes a `GInetAddress` as well as a
 // port number).
 type InetAddress struct {
 	gobject.Object
@@ -107,8 +108,8 @@ func InetAddressNewFromInternalPtr(ptr uintptr) *InetAddress {
 
 var xNewInetAddressAny func(SocketFamily) uintptr
 
-// Creates a #GInetAddress for the "any" address (unassigned/"don't
-// care") for @family.
+// Creates a [InetAddress] for the "any" address (unassigned/"don't
+// care") for family.
 func NewInetAddressAny(FamilyVar SocketFamily) *InetAddress {
 	var cls *InetAddress
 
@@ -124,9 +125,9 @@ func NewInetAddressAny(FamilyVar SocketFamily) *InetAddress {
 
 var xNewInetAddressFromBytes func([]byte, SocketFamily) uintptr
 
-// Creates a new #GInetAddress from the given @family and @bytes.
-// @bytes should be 4 bytes for %G_SOCKET_FAMILY_IPV4 and 16 bytes for
-// %G_SOCKET_FAMILY_IPV6.
+// Creates a new [InetAddress] from the given family and bytes.
+// bytes should be 4 bytes for [GSocketFamilyIpv4Value] and 16 bytes for
+// [GSocketFamilyIpv6Value].
 func NewInetAddressFromBytes(BytesVar []byte, FamilyVar SocketFamily) *InetAddress {
 	var cls *InetAddress
 
@@ -142,11 +143,11 @@ func NewInetAddressFromBytes(BytesVar []byte, FamilyVar SocketFamily) *InetAddre
 
 var xNewInetAddressFromBytesWithIpv6Info func([]byte, SocketFamily, uint32, uint32) uintptr
 
-// Creates a new [class@Gio.InetAddress] from the given @family, @bytes
-// and @scope_id.
+// Creates a new [InetAddress] from the given family, bytes
+// and scope_id.
 //
-// @bytes must be 4 bytes for [enum@Gio.SocketFamily.IPV4] and 16 bytes for
-// [enum@Gio.SocketFamily.IPV6].
+// bytes must be 4 bytes for SocketFamily.IPV4 and 16 bytes for
+// SocketFamily.IPV6.
 func NewInetAddressFromBytesWithIpv6Info(BytesVar []byte, FamilyVar SocketFamily, FlowinfoVar uint32, ScopeIdVar uint32) *InetAddress {
 	var cls *InetAddress
 
@@ -162,9 +163,9 @@ func NewInetAddressFromBytesWithIpv6Info(BytesVar []byte, FamilyVar SocketFamily
 
 var xNewInetAddressFromString func(string) uintptr
 
-// Parses @string as an IP address and creates a new #GInetAddress.
+// Parses string as an IP address and creates a new [InetAddress].
 //
-// If @address is an IPv6 address, it can also contain a scope ID
+// If address is an IPv6 address, it can also contain a scope ID
 // (separated from the address by a `%`). Note that currently this
 // behavior is platform specific. This may change in a future release.
 func NewInetAddressFromString(StringVar string) *InetAddress {
@@ -182,7 +183,7 @@ func NewInetAddressFromString(StringVar string) *InetAddress {
 
 var xNewInetAddressLoopback func(SocketFamily) uintptr
 
-// Creates a #GInetAddress for the loopback address for @family.
+// Creates a [InetAddress] for the loopback address for family.
 func NewInetAddressLoopback(FamilyVar SocketFamily) *InetAddress {
 	var cls *InetAddress
 
@@ -198,7 +199,7 @@ func NewInetAddressLoopback(FamilyVar SocketFamily) *InetAddress {
 
 var xInetAddressEqual func(uintptr, uintptr) bool
 
-// Checks if two #GInetAddress instances are equal, e.g. the same address.
+// Checks if two [InetAddress] instances are equal, e.g. the same address.
 func (x *InetAddress) Equal(OtherAddressVar *InetAddress) bool {
 
 	cret := xInetAddressEqual(x.GoPointer(), OtherAddressVar.GoPointer())
@@ -207,7 +208,7 @@ func (x *InetAddress) Equal(OtherAddressVar *InetAddress) bool {
 
 var xInetAddressGetFamily func(uintptr) SocketFamily
 
-// Gets @address's family
+// Gets address's family
 func (x *InetAddress) GetFamily() SocketFamily {
 
 	cret := xInetAddressGetFamily(x.GoPointer())
@@ -216,7 +217,7 @@ func (x *InetAddress) GetFamily() SocketFamily {
 
 var xInetAddressGetFlowinfo func(uintptr) uint32
 
-// Gets the value of [property@Gio.InetAddress:flowinfo].
+// Gets the value of [InetAddress.GetPropertyFlowinfo].
 func (x *InetAddress) GetFlowinfo() uint32 {
 
 	cret := xInetAddressGetFlowinfo(x.GoPointer())
@@ -225,7 +226,7 @@ func (x *InetAddress) GetFlowinfo() uint32 {
 
 var xInetAddressGetIsAny func(uintptr) bool
 
-// Tests whether @address is the "any" address for its family.
+// Tests whether address is the "any" address for its family.
 func (x *InetAddress) GetIsAny() bool {
 
 	cret := xInetAddressGetIsAny(x.GoPointer())
@@ -234,7 +235,7 @@ func (x *InetAddress) GetIsAny() bool {
 
 var xInetAddressGetIsLinkLocal func(uintptr) bool
 
-// Tests whether @address is a link-local address (that is, if it
+// Tests whether address is a link-local address (that is, if it
 // identifies a host on a local network that is not connected to the
 // Internet).
 func (x *InetAddress) GetIsLinkLocal() bool {
@@ -245,7 +246,7 @@ func (x *InetAddress) GetIsLinkLocal() bool {
 
 var xInetAddressGetIsLoopback func(uintptr) bool
 
-// Tests whether @address is the loopback address for its family.
+// Tests whether address is the loopback address for its family.
 func (x *InetAddress) GetIsLoopback() bool {
 
 	cret := xInetAddressGetIsLoopback(x.GoPointer())
@@ -254,7 +255,7 @@ func (x *InetAddress) GetIsLoopback() bool {
 
 var xInetAddressGetIsMcGlobal func(uintptr) bool
 
-// Tests whether @address is a global multicast address.
+// Tests whether address is a global multicast address.
 func (x *InetAddress) GetIsMcGlobal() bool {
 
 	cret := xInetAddressGetIsMcGlobal(x.GoPointer())
@@ -263,7 +264,7 @@ func (x *InetAddress) GetIsMcGlobal() bool {
 
 var xInetAddressGetIsMcLinkLocal func(uintptr) bool
 
-// Tests whether @address is a link-local multicast address.
+// Tests whether address is a link-local multicast address.
 func (x *InetAddress) GetIsMcLinkLocal() bool {
 
 	cret := xInetAddressGetIsMcLinkLocal(x.GoPointer())
@@ -272,7 +273,7 @@ func (x *InetAddress) GetIsMcLinkLocal() bool {
 
 var xInetAddressGetIsMcNodeLocal func(uintptr) bool
 
-// Tests whether @address is a node-local multicast address.
+// Tests whether address is a node-local multicast address.
 func (x *InetAddress) GetIsMcNodeLocal() bool {
 
 	cret := xInetAddressGetIsMcNodeLocal(x.GoPointer())
@@ -281,7 +282,7 @@ func (x *InetAddress) GetIsMcNodeLocal() bool {
 
 var xInetAddressGetIsMcOrgLocal func(uintptr) bool
 
-// Tests whether @address is an organization-local multicast address.
+// Tests whether address is an organization-local multicast address.
 func (x *InetAddress) GetIsMcOrgLocal() bool {
 
 	cret := xInetAddressGetIsMcOrgLocal(x.GoPointer())
@@ -290,7 +291,7 @@ func (x *InetAddress) GetIsMcOrgLocal() bool {
 
 var xInetAddressGetIsMcSiteLocal func(uintptr) bool
 
-// Tests whether @address is a site-local multicast address.
+// Tests whether address is a site-local multicast address.
 func (x *InetAddress) GetIsMcSiteLocal() bool {
 
 	cret := xInetAddressGetIsMcSiteLocal(x.GoPointer())
@@ -299,7 +300,7 @@ func (x *InetAddress) GetIsMcSiteLocal() bool {
 
 var xInetAddressGetIsMulticast func(uintptr) bool
 
-// Tests whether @address is a multicast address.
+// Tests whether address is a multicast address.
 func (x *InetAddress) GetIsMulticast() bool {
 
 	cret := xInetAddressGetIsMulticast(x.GoPointer())
@@ -308,7 +309,7 @@ func (x *InetAddress) GetIsMulticast() bool {
 
 var xInetAddressGetIsSiteLocal func(uintptr) bool
 
-// Tests whether @address is a site-local address such as 10.0.0.1
+// Tests whether address is a site-local address such as 10.0.0.1
 // (that is, the address identifies a host on a local network that can
 // not be reached directly from the Internet, but which may have
 // outgoing Internet connectivity via a NAT or firewall).
@@ -320,8 +321,8 @@ func (x *InetAddress) GetIsSiteLocal() bool {
 
 var xInetAddressGetNativeSize func(uintptr) uint
 
-// Gets the size of the native raw binary address for @address. This
-// is the size of the data that you get from g_inet_address_to_bytes().
+// Gets the size of the native raw binary address for address. This
+// is the size of the data that you get from [InetAddress.ToBytes].
 func (x *InetAddress) GetNativeSize() uint {
 
 	cret := xInetAddressGetNativeSize(x.GoPointer())
@@ -330,7 +331,7 @@ func (x *InetAddress) GetNativeSize() uint {
 
 var xInetAddressGetScopeId func(uintptr) uint32
 
-// Gets the value of [property@Gio.InetAddress:scope-id].
+// Gets the value of [InetAddress.GetPropertyScopeId].
 func (x *InetAddress) GetScopeId() uint32 {
 
 	cret := xInetAddressGetScopeId(x.GoPointer())
@@ -339,7 +340,7 @@ func (x *InetAddress) GetScopeId() uint32 {
 
 var xInetAddressToBytes func(uintptr) byte
 
-// Gets the raw binary address data from @address.
+// Gets the raw binary address data from address.
 func (x *InetAddress) ToBytes() byte {
 
 	cret := xInetAddressToBytes(x.GoPointer())
@@ -348,7 +349,7 @@ func (x *InetAddress) ToBytes() byte {
 
 var xInetAddressToString func(uintptr) string
 
-// Converts @address to string form.
+// Converts address to string form.
 func (x *InetAddress) ToString() string {
 
 	cret := xInetAddressToString(x.GoPointer())
@@ -385,7 +386,7 @@ func (x *InetAddress) GetPropertyBytes() uintptr {
 
 // SetPropertyFlowinfo sets the "flowinfo" property.
 // The flowinfo for an IPv6 address.
-// See [method@Gio.InetAddress.get_flowinfo].
+// See [InetAddress.GetFlowinfo].
 func (x *InetAddress) SetPropertyFlowinfo(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -395,7 +396,7 @@ func (x *InetAddress) SetPropertyFlowinfo(value uint) {
 
 // GetPropertyFlowinfo gets the "flowinfo" property.
 // The flowinfo for an IPv6 address.
-// See [method@Gio.InetAddress.get_flowinfo].
+// See [InetAddress.GetFlowinfo].
 func (x *InetAddress) GetPropertyFlowinfo() uint {
 	var v gobject.Value
 	x.GetProperty("flowinfo", &v)
@@ -404,7 +405,7 @@ func (x *InetAddress) GetPropertyFlowinfo() uint {
 
 // GetPropertyIsAny gets the "is-any" property.
 // Whether this is the "any" address for its family.
-// See g_inet_address_get_is_any().
+// See [InetAddress.GetIsAny].
 func (x *InetAddress) GetPropertyIsAny() bool {
 	var v gobject.Value
 	x.GetProperty("is-any", &v)
@@ -413,7 +414,7 @@ func (x *InetAddress) GetPropertyIsAny() bool {
 
 // GetPropertyIsLinkLocal gets the "is-link-local" property.
 // Whether this is a link-local address.
-// See g_inet_address_get_is_link_local().
+// See [InetAddress.GetIsLinkLocal].
 func (x *InetAddress) GetPropertyIsLinkLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-link-local", &v)
@@ -422,7 +423,7 @@ func (x *InetAddress) GetPropertyIsLinkLocal() bool {
 
 // GetPropertyIsLoopback gets the "is-loopback" property.
 // Whether this is the loopback address for its family.
-// See g_inet_address_get_is_loopback().
+// See [InetAddress.GetIsLoopback].
 func (x *InetAddress) GetPropertyIsLoopback() bool {
 	var v gobject.Value
 	x.GetProperty("is-loopback", &v)
@@ -431,7 +432,7 @@ func (x *InetAddress) GetPropertyIsLoopback() bool {
 
 // GetPropertyIsMcGlobal gets the "is-mc-global" property.
 // Whether this is a global multicast address.
-// See g_inet_address_get_is_mc_global().
+// See [InetAddress.GetIsMcGlobal].
 func (x *InetAddress) GetPropertyIsMcGlobal() bool {
 	var v gobject.Value
 	x.GetProperty("is-mc-global", &v)
@@ -440,7 +441,7 @@ func (x *InetAddress) GetPropertyIsMcGlobal() bool {
 
 // GetPropertyIsMcLinkLocal gets the "is-mc-link-local" property.
 // Whether this is a link-local multicast address.
-// See g_inet_address_get_is_mc_link_local().
+// See [InetAddress.GetIsMcLinkLocal].
 func (x *InetAddress) GetPropertyIsMcLinkLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-mc-link-local", &v)
@@ -449,7 +450,7 @@ func (x *InetAddress) GetPropertyIsMcLinkLocal() bool {
 
 // GetPropertyIsMcNodeLocal gets the "is-mc-node-local" property.
 // Whether this is a node-local multicast address.
-// See g_inet_address_get_is_mc_node_local().
+// See [InetAddress.GetIsMcNodeLocal].
 func (x *InetAddress) GetPropertyIsMcNodeLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-mc-node-local", &v)
@@ -458,7 +459,7 @@ func (x *InetAddress) GetPropertyIsMcNodeLocal() bool {
 
 // GetPropertyIsMcOrgLocal gets the "is-mc-org-local" property.
 // Whether this is an organization-local multicast address.
-// See g_inet_address_get_is_mc_org_local().
+// See [InetAddress.GetIsMcOrgLocal].
 func (x *InetAddress) GetPropertyIsMcOrgLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-mc-org-local", &v)
@@ -467,7 +468,7 @@ func (x *InetAddress) GetPropertyIsMcOrgLocal() bool {
 
 // GetPropertyIsMcSiteLocal gets the "is-mc-site-local" property.
 // Whether this is a site-local multicast address.
-// See g_inet_address_get_is_mc_site_local().
+// See [InetAddress.GetIsMcSiteLocal].
 func (x *InetAddress) GetPropertyIsMcSiteLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-mc-site-local", &v)
@@ -476,7 +477,7 @@ func (x *InetAddress) GetPropertyIsMcSiteLocal() bool {
 
 // GetPropertyIsMulticast gets the "is-multicast" property.
 // Whether this is a multicast address.
-// See g_inet_address_get_is_multicast().
+// See [InetAddress.GetIsMulticast].
 func (x *InetAddress) GetPropertyIsMulticast() bool {
 	var v gobject.Value
 	x.GetProperty("is-multicast", &v)
@@ -485,7 +486,7 @@ func (x *InetAddress) GetPropertyIsMulticast() bool {
 
 // GetPropertyIsSiteLocal gets the "is-site-local" property.
 // Whether this is a site-local address.
-// See g_inet_address_get_is_loopback().
+// See [InetAddress.GetIsLoopback].
 func (x *InetAddress) GetPropertyIsSiteLocal() bool {
 	var v gobject.Value
 	x.GetProperty("is-site-local", &v)
@@ -494,7 +495,7 @@ func (x *InetAddress) GetPropertyIsSiteLocal() bool {
 
 // SetPropertyScopeId sets the "scope-id" property.
 // The scope-id for an IPv6 address.
-// See [method@Gio.InetAddress.get_scope_id].
+// See [InetAddress.GetScopeId].
 func (x *InetAddress) SetPropertyScopeId(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -504,7 +505,7 @@ func (x *InetAddress) SetPropertyScopeId(value uint) {
 
 // GetPropertyScopeId gets the "scope-id" property.
 // The scope-id for an IPv6 address.
-// See [method@Gio.InetAddress.get_scope_id].
+// See [InetAddress.GetScopeId].
 func (x *InetAddress) GetPropertyScopeId() uint {
 	var v gobject.Value
 	x.GetProperty("scope-id", &v)
@@ -516,7 +517,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -525,28 +526,30 @@ func init() {
 
 	core.PuregoSafeRegister(&xInetAddressGLibType, libs, "g_inet_address_get_type")
 
-	core.PuregoSafeRegister(&xNewInetAddressAny, libs, "g_inet_address_new_any")
-	core.PuregoSafeRegister(&xNewInetAddressFromBytes, libs, "g_inet_address_new_from_bytes")
-	core.PuregoSafeRegister(&xNewInetAddressFromBytesWithIpv6Info, libs, "g_inet_address_new_from_bytes_with_ipv6_info")
-	core.PuregoSafeRegister(&xNewInetAddressFromString, libs, "g_inet_address_new_from_string")
-	core.PuregoSafeRegister(&xNewInetAddressLoopback, libs, "g_inet_address_new_loopback")
-
-	core.PuregoSafeRegister(&xInetAddressEqual, libs, "g_inet_address_equal")
-	core.PuregoSafeRegister(&xInetAddressGetFamily, libs, "g_inet_address_get_family")
-	core.PuregoSafeRegister(&xInetAddressGetFlowinfo, libs, "g_inet_address_get_flowinfo")
-	core.PuregoSafeRegister(&xInetAddressGetIsAny, libs, "g_inet_address_get_is_any")
-	core.PuregoSafeRegister(&xInetAddressGetIsLinkLocal, libs, "g_inet_address_get_is_link_local")
-	core.PuregoSafeRegister(&xInetAddressGetIsLoopback, libs, "g_inet_address_get_is_loopback")
-	core.PuregoSafeRegister(&xInetAddressGetIsMcGlobal, libs, "g_inet_address_get_is_mc_global")
-	core.PuregoSafeRegister(&xInetAddressGetIsMcLinkLocal, libs, "g_inet_address_get_is_mc_link_local")
-	core.PuregoSafeRegister(&xInetAddressGetIsMcNodeLocal, libs, "g_inet_address_get_is_mc_node_local")
-	core.PuregoSafeRegister(&xInetAddressGetIsMcOrgLocal, libs, "g_inet_address_get_is_mc_org_local")
-	core.PuregoSafeRegister(&xInetAddressGetIsMcSiteLocal, libs, "g_inet_address_get_is_mc_site_local")
-	core.PuregoSafeRegister(&xInetAddressGetIsMulticast, libs, "g_inet_address_get_is_multicast")
-	core.PuregoSafeRegister(&xInetAddressGetIsSiteLocal, libs, "g_inet_address_get_is_site_local")
-	core.PuregoSafeRegister(&xInetAddressGetNativeSize, libs, "g_inet_address_get_native_size")
-	core.PuregoSafeRegister(&xInetAddressGetScopeId, libs, "g_inet_address_get_scope_id")
-	core.PuregoSafeRegister(&xInetAddressToBytes, libs, "g_inet_address_to_bytes")
-	core.PuregoSafeRegister(&xInetAddressToString, libs, "g_inet_address_to_string")
+	gobject.CheckClassSize("GInetAddressClass", xInetAddressGLibType, unsafe.Sizeof(InetAddressClass{}))
+
+	core.PuregoRegisterSince(&xNewInetAddressAny, libs, "g_inet_address_new_any", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewInetAddressFromBytes, libs, "g_inet_address_new_from_bytes", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewInetAddressFromBytesWithIpv6Info, libs, "g_inet_address_new_from_bytes_with_ipv6_info", "GIO", "2.86")
+	core.PuregoRegisterSince(&xNewInetAddressFromString, libs, "g_inet_address_new_from_string", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewInetAddressLoopback, libs, "g_inet_address_new_loopback", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xInetAddressEqual, libs, "g_inet_address_equal", "GIO", "2.30")
+	core.PuregoRegisterSince(&xInetAddressGetFamily, libs, "g_inet_address_get_family", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetFlowinfo, libs, "g_inet_address_get_flowinfo", "GIO", "2.86")
+	core.PuregoRegisterSince(&xInetAddressGetIsAny, libs, "g_inet_address_get_is_any", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsLinkLocal, libs, "g_inet_address_get_is_link_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsLoopback, libs, "g_inet_address_get_is_loopback", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMcGlobal, libs, "g_inet_address_get_is_mc_global", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMcLinkLocal, libs, "g_inet_address_get_is_mc_link_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMcNodeLocal, libs, "g_inet_address_get_is_mc_node_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMcOrgLocal, libs, "g_inet_address_get_is_mc_org_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMcSiteLocal, libs, "g_inet_address_get_is_mc_site_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsMulticast, libs, "g_inet_address_get_is_multicast", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetIsSiteLocal, libs, "g_inet_address_get_is_site_local", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetNativeSize, libs, "g_inet_address_get_native_size", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressGetScopeId, libs, "g_inet_address_get_scope_id", "GIO", "2.86")
+	core.PuregoRegisterSince(&xInetAddressToBytes, libs, "g_inet_address_to_bytes", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetAddressToString, libs, "g_inet_address_to_string", "GIO", "2.22")
 
 }
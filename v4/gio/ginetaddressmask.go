@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -52,8 +51,8 @@ func InetAddressMaskNewFromInternalPtr(ptr uintptr) *InetAddressMask {
 
 var xNewInetAddressMask func(uintptr, uint, **glib.Error) uintptr
 
-// Creates a new #GInetAddressMask representing all addresses whose
-// first @length bits match @addr.
+// Creates a new [InetAddressMask] representing all addresses whose
+// first length bits match addr.
 func NewInetAddressMask(AddrVar *InetAddress, LengthVar uint) (*InetAddressMask, error) {
 	var cls *InetAddressMask
 	var cerr *glib.Error
@@ -61,12 +60,15 @@ func NewInetAddressMask(AddrVar *InetAddress, LengthVar uint) (*InetAddressMask,
 	cret := xNewInetAddressMask(AddrVar.GoPointer(), LengthVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InetAddressMask{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -74,8 +76,8 @@ func NewInetAddressMask(AddrVar *InetAddress, LengthVar uint) (*InetAddressMask,
 
 var xNewInetAddressMaskFromString func(string, **glib.Error) uintptr
 
-// Parses @mask_string as an IP address and (optional) length, and
-// creates a new #GInetAddressMask. The length, if present, is
+// Parses mask_string as an IP address and (optional) length, and
+// creates a new [InetAddressMask]. The length, if present, is
 // delimited by a "/". If it is not present, then the length is
 // assumed to be the full length of the address.
 func NewInetAddressMaskFromString(MaskStringVar string) (*InetAddressMask, error) {
@@ -85,12 +87,15 @@ func NewInetAddressMaskFromString(MaskStringVar string) (*InetAddressMask, error
 	cret := xNewInetAddressMaskFromString(MaskStringVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InetAddressMask{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -98,7 +103,7 @@ func NewInetAddressMaskFromString(MaskStringVar string) (*InetAddressMask, error
 
 var xInetAddressMaskEqual func(uintptr, uintptr) bool
 
-// Tests if @mask and @mask2 are the same mask.
+// Tests if mask and mask2 are the same mask.
 func (x *InetAddressMask) Equal(Mask2Var *InetAddressMask) bool {
 
 	cret := xInetAddressMaskEqual(x.GoPointer(), Mask2Var.GoPointer())
@@ -107,7 +112,7 @@ func (x *InetAddressMask) Equal(Mask2Var *InetAddressMask) bool {
 
 var xInetAddressMaskGetAddress func(uintptr) uintptr
 
-// Gets @mask's base address
+// Gets mask's base address
 func (x *InetAddressMask) GetAddress() *InetAddress {
 	var cls *InetAddress
 
@@ -124,7 +129,7 @@ func (x *InetAddressMask) GetAddress() *InetAddress {
 
 var xInetAddressMaskGetFamily func(uintptr) SocketFamily
 
-// Gets the #GSocketFamily of @mask's address
+// Gets the [SocketFamily] of mask's address
 func (x *InetAddressMask) GetFamily() SocketFamily {
 
 	cret := xInetAddressMaskGetFamily(x.GoPointer())
@@ -133,7 +138,7 @@ func (x *InetAddressMask) GetFamily() SocketFamily {
 
 var xInetAddressMaskGetLength func(uintptr) uint
 
-// Gets @mask's length
+// Gets mask's length
 func (x *InetAddressMask) GetLength() uint {
 
 	cret := xInetAddressMaskGetLength(x.GoPointer())
@@ -142,7 +147,7 @@ func (x *InetAddressMask) GetLength() uint {
 
 var xInetAddressMaskMatches func(uintptr, uintptr) bool
 
-// Tests if @address falls within the range described by @mask.
+// Tests if address falls within the range described by mask.
 func (x *InetAddressMask) Matches(AddressVar *InetAddress) bool {
 
 	cret := xInetAddressMaskMatches(x.GoPointer(), AddressVar.GoPointer())
@@ -151,7 +156,7 @@ func (x *InetAddressMask) Matches(AddressVar *InetAddress) bool {
 
 var xInetAddressMaskToString func(uintptr) string
 
-// Converts @mask back to its corresponding string form.
+// Converts mask back to its corresponding string form.
 func (x *InetAddressMask) ToString() string {
 
 	cret := xInetAddressMaskToString(x.GoPointer())
@@ -192,25 +197,25 @@ func (x *InetAddressMask) GetPropertyLength() uint {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -220,16 +225,16 @@ func (x *InetAddressMask) GetPropertyLength() uint {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *InetAddressMask) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -240,7 +245,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -249,14 +254,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xInetAddressMaskGLibType, libs, "g_inet_address_mask_get_type")
 
-	core.PuregoSafeRegister(&xNewInetAddressMask, libs, "g_inet_address_mask_new")
-	core.PuregoSafeRegister(&xNewInetAddressMaskFromString, libs, "g_inet_address_mask_new_from_string")
+	gobject.CheckClassSize("GInetAddressMaskClass", xInetAddressMaskGLibType, unsafe.Sizeof(InetAddressMaskClass{}))
+
+	core.PuregoRegisterSince(&xNewInetAddressMask, libs, "g_inet_address_mask_new", "GIO", "2.32")
+	core.PuregoRegisterSince(&xNewInetAddressMaskFromString, libs, "g_inet_address_mask_new_from_string", "GIO", "2.32")
 
-	core.PuregoSafeRegister(&xInetAddressMaskEqual, libs, "g_inet_address_mask_equal")
-	core.PuregoSafeRegister(&xInetAddressMaskGetAddress, libs, "g_inet_address_mask_get_address")
-	core.PuregoSafeRegister(&xInetAddressMaskGetFamily, libs, "g_inet_address_mask_get_family")
-	core.PuregoSafeRegister(&xInetAddressMaskGetLength, libs, "g_inet_address_mask_get_length")
-	core.PuregoSafeRegister(&xInetAddressMaskMatches, libs, "g_inet_address_mask_matches")
-	core.PuregoSafeRegister(&xInetAddressMaskToString, libs, "g_inet_address_mask_to_string")
+	core.PuregoRegisterSince(&xInetAddressMaskEqual, libs, "g_inet_address_mask_equal", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetAddressMaskGetAddress, libs, "g_inet_address_mask_get_address", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetAddressMaskGetFamily, libs, "g_inet_address_mask_get_family", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetAddressMaskGetLength, libs, "g_inet_address_mask_get_length", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetAddressMaskMatches, libs, "g_inet_address_mask_matches", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetAddressMaskToString, libs, "g_inet_address_mask_to_string", "GIO", "2.32")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -30,10 +29,10 @@ func (x *InetSocketAddressPrivate) GoPointer() uintptr {
 }
 
 // An IPv4 or IPv6 socket address. That is, the combination of a
-// [class@Gio.InetAddress] and a port number.
+// [InetAddress] and a port number.
 //
 // In UNIX terms, `GInetSocketAddress` corresponds to a
-// [`struct sockaddr_in` or `struct sockaddr_in6`](man:sockaddr(3type)).
+// struct sockaddr_in or struct sockaddr_in6).
 type InetSocketAddress struct {
 	SocketAddress
 }
@@ -52,7 +51,7 @@ func InetSocketAddressNewFromInternalPtr(ptr uintptr) *InetSocketAddress {
 
 var xNewInetSocketAddress func(uintptr, uint16) uintptr
 
-// Creates a new #GInetSocketAddress for @address and @port.
+// Creates a new [InetSocketAddress] for address and port.
 func NewInetSocketAddress(AddressVar *InetAddress, PortVar uint16) *InetSocketAddress {
 	var cls *InetSocketAddress
 
@@ -68,9 +67,9 @@ func NewInetSocketAddress(AddressVar *InetAddress, PortVar uint16) *InetSocketAd
 
 var xNewInetSocketAddressFromString func(string, uint) uintptr
 
-// Creates a new #GInetSocketAddress for @address and @port.
+// Creates a new [InetSocketAddress] for address and port.
 //
-// If @address is an IPv6 address, it can also contain a scope ID
+// If address is an IPv6 address, it can also contain a scope ID
 // (separated from the address by a `%`). Note that currently this
 // behavior is platform specific. This may change in a future release.
 func NewInetSocketAddressFromString(AddressVar string, PortVar uint) *InetSocketAddress {
@@ -88,7 +87,7 @@ func NewInetSocketAddressFromString(AddressVar string, PortVar uint) *InetSocket
 
 var xInetSocketAddressGetAddress func(uintptr) uintptr
 
-// Gets @address's #GInetAddress.
+// Gets address's [InetAddress].
 func (x *InetSocketAddress) GetAddress() *InetAddress {
 	var cls *InetAddress
 
@@ -105,10 +104,10 @@ func (x *InetSocketAddress) GetAddress() *InetAddress {
 
 var xInetSocketAddressGetFlowinfo func(uintptr) uint32
 
-// Gets the `sin6_flowinfo` field from @address,
+// Gets the `sin6_flowinfo` field from address,
 // which must be an IPv6 address.
 //
-// If not overridden this value will be inherited from [property@Gio.InetSocketAddress:address].
+// If not overridden this value will be inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) GetFlowinfo() uint32 {
 
 	cret := xInetSocketAddressGetFlowinfo(x.GoPointer())
@@ -117,7 +116,7 @@ func (x *InetSocketAddress) GetFlowinfo() uint32 {
 
 var xInetSocketAddressGetPort func(uintptr) uint16
 
-// Gets @address's port.
+// Gets address's port.
 func (x *InetSocketAddress) GetPort() uint16 {
 
 	cret := xInetSocketAddressGetPort(x.GoPointer())
@@ -126,10 +125,10 @@ func (x *InetSocketAddress) GetPort() uint16 {
 
 var xInetSocketAddressGetScopeId func(uintptr) uint32
 
-// Gets the `sin6_scope_id` field from @address,
+// Gets the `sin6_scope_id` field from address,
 // which must be an IPv6 address.
 //
-// If not overridden this value will be inherited from [property@Gio.InetSocketAddress:address].
+// If not overridden this value will be inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) GetScopeId() uint32 {
 
 	cret := xInetSocketAddressGetScopeId(x.GoPointer())
@@ -150,7 +149,7 @@ func (c *InetSocketAddress) SetGoPointer(ptr uintptr) {
 // SetPropertyFlowinfo sets the "flowinfo" property.
 // The `sin6_flowinfo` field, for IPv6 addresses.
 //
-// If unset this property is inherited from [property@Gio.InetSocketAddress:address].
+// If unset this property is inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) SetPropertyFlowinfo(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -161,7 +160,7 @@ func (x *InetSocketAddress) SetPropertyFlowinfo(value uint) {
 // GetPropertyFlowinfo gets the "flowinfo" property.
 // The `sin6_flowinfo` field, for IPv6 addresses.
 //
-// If unset this property is inherited from [property@Gio.InetSocketAddress:address].
+// If unset this property is inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) GetPropertyFlowinfo() uint {
 	var v gobject.Value
 	x.GetProperty("flowinfo", &v)
@@ -188,7 +187,7 @@ func (x *InetSocketAddress) GetPropertyPort() uint {
 // SetPropertyScopeId sets the "scope-id" property.
 // The `sin6_scope_id` field, for IPv6 addresses.
 //
-// If unset this property is inherited from [property@Gio.InetSocketAddress:address].
+// If unset this property is inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) SetPropertyScopeId(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -199,14 +198,14 @@ func (x *InetSocketAddress) SetPropertyScopeId(value uint) {
 // GetPropertyScopeId gets the "scope-id" property.
 // The `sin6_scope_id` field, for IPv6 addresses.
 //
-// If unset this property is inherited from [property@Gio.InetSocketAddress:address].
+// If unset this property is inherited from InetSocketAddress:address.
 func (x *InetSocketAddress) GetPropertyScopeId() uint {
 	var v gobject.Value
 	x.GetProperty("scope-id", &v)
 	return v.GetUint()
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *InetSocketAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -220,13 +219,13 @@ func (x *InetSocketAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *InetSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -240,12 +239,12 @@ func (x *InetSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *InetSocketAddress) ToString() string {
 
@@ -258,7 +257,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -267,12 +266,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xInetSocketAddressGLibType, libs, "g_inet_socket_address_get_type")
 
-	core.PuregoSafeRegister(&xNewInetSocketAddress, libs, "g_inet_socket_address_new")
-	core.PuregoSafeRegister(&xNewInetSocketAddressFromString, libs, "g_inet_socket_address_new_from_string")
+	gobject.CheckClassSize("GInetSocketAddressClass", xInetSocketAddressGLibType, unsafe.Sizeof(InetSocketAddressClass{}))
 
-	core.PuregoSafeRegister(&xInetSocketAddressGetAddress, libs, "g_inet_socket_address_get_address")
-	core.PuregoSafeRegister(&xInetSocketAddressGetFlowinfo, libs, "g_inet_socket_address_get_flowinfo")
-	core.PuregoSafeRegister(&xInetSocketAddressGetPort, libs, "g_inet_socket_address_get_port")
-	core.PuregoSafeRegister(&xInetSocketAddressGetScopeId, libs, "g_inet_socket_address_get_scope_id")
+	core.PuregoRegisterSince(&xNewInetSocketAddress, libs, "g_inet_socket_address_new", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewInetSocketAddressFromString, libs, "g_inet_socket_address_new_from_string", "GIO", "2.40")
+
+	core.PuregoRegisterSince(&xInetSocketAddressGetAddress, libs, "g_inet_socket_address_get_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetSocketAddressGetFlowinfo, libs, "g_inet_socket_address_get_flowinfo", "GIO", "2.32")
+	core.PuregoRegisterSince(&xInetSocketAddressGetPort, libs, "g_inet_socket_address_get_port", "GIO", "2.22")
+	core.PuregoRegisterSince(&xInetSocketAddressGetScopeId, libs, "g_inet_socket_address_get_scope_id", "GIO", "2.32")
 
 }
@@ -0,0 +1,56 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xInitableNewv func(types.GType, uint, []gobject.Parameter, uintptr, **glib.Error) uintptr
+
+// Helper function for constructing [Initable] object. This is
+// similar to [gobject.NewObjectv] but also initializes the object
+// and returns nil, setting an error on failure.
+//
+// Deprecated: since 2.54. Use [gobject.NewObjectWithProperties] and
+// [Initable.Init] instead. See [gobject.Parameter] for more information.
+func InitableNewv(ObjectTypeVar types.GType, NParametersVar uint, ParametersVar []gobject.Parameter, CancellableVar *Cancellable) (*gobject.Object, error) {
+	var cls *gobject.Object
+	var cerr *glib.Error
+
+	cret := xInitableNewv(ObjectTypeVar, NParametersVar, ParametersVar, CancellableVar.GoPointer(), &cerr)
+
+	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
+		return nil, cerr
+	}
+	cls = &gobject.Object{}
+	cls.Ptr = cret
+	if cerr == nil {
+		return cls, core.PlatformError
+	}
+	return cls, cerr
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xInitableNewv, libs, "g_initable_newv", "GIO", "2.22")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -55,7 +54,8 @@ func (x *InputStreamClass) OverrideReadFn(cb func(*InputStream, uintptr, uint, *
 	if cb == nil {
 		x.xReadFn = 0
 	} else {
-		x.xReadFn = purego.NewCallback(func(StreamVarp uintptr, BufferVarp uintptr, CountVarp uint, CancellableVarp uintptr) int {
+		x.xReadFn = core.NewCallback(func(StreamVarp uintptr, BufferVarp uintptr, CountVarp uint, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), BufferVarp, CountVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -67,7 +67,7 @@ func (x *InputStreamClass) GetReadFn() func(*InputStream, uintptr, uint, *Cancel
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp uintptr, CountVarp uint, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xReadFn)
+	core.RegisterFunc(&rawCallback, x.xReadFn)
 	return func(StreamVar *InputStream, BufferVar uintptr, CountVar uint, CancellableVar *Cancellable) int {
 		return rawCallback(StreamVar.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer())
 	}
@@ -78,7 +78,8 @@ func (x *InputStreamClass) OverrideSkip(cb func(*InputStream, uint, *Cancellable
 	if cb == nil {
 		x.xSkip = 0
 	} else {
-		x.xSkip = purego.NewCallback(func(StreamVarp uintptr, CountVarp uint, CancellableVarp uintptr) int {
+		x.xSkip = core.NewCallback(func(StreamVarp uintptr, CountVarp uint, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), CountVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -90,7 +91,7 @@ func (x *InputStreamClass) GetSkip() func(*InputStream, uint, *Cancellable) int
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CountVarp uint, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xSkip)
+	core.RegisterFunc(&rawCallback, x.xSkip)
 	return func(StreamVar *InputStream, CountVar uint, CancellableVar *Cancellable) int {
 		return rawCallback(StreamVar.GoPointer(), CountVar, CancellableVar.GoPointer())
 	}
@@ -101,7 +102,8 @@ func (x *InputStreamClass) OverrideCloseFn(cb func(*InputStream, *Cancellable) b
 	if cb == nil {
 		x.xCloseFn = 0
 	} else {
-		x.xCloseFn = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+		x.xCloseFn = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -113,7 +115,7 @@ func (x *InputStreamClass) GetCloseFn() func(*InputStream, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFn)
+	core.RegisterFunc(&rawCallback, x.xCloseFn)
 	return func(StreamVar *InputStream, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -124,7 +126,8 @@ func (x *InputStreamClass) OverrideReadAsync(cb func(*InputStream, *[]byte, uint
 	if cb == nil {
 		x.xReadAsync = 0
 	} else {
-		x.xReadAsync = purego.NewCallback(func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xReadAsync = core.NewCallback(func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(InputStreamNewFromInternalPtr(StreamVarp), BufferVarp, CountVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -136,7 +139,7 @@ func (x *InputStreamClass) GetReadAsync() func(*InputStream, *[]byte, uint, int,
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReadAsync)
+	core.RegisterFunc(&rawCallback, x.xReadAsync)
 	return func(StreamVar *InputStream, BufferVar *[]byte, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), BufferVar, CountVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -147,7 +150,8 @@ func (x *InputStreamClass) OverrideReadFinish(cb func(*InputStream, AsyncResult)
 	if cb == nil {
 		x.xReadFinish = 0
 	} else {
-		x.xReadFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+		x.xReadFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -159,7 +163,7 @@ func (x *InputStreamClass) GetReadFinish() func(*InputStream, AsyncResult) int {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xReadFinish)
+	core.RegisterFunc(&rawCallback, x.xReadFinish)
 	return func(StreamVar *InputStream, ResultVar AsyncResult) int {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -170,7 +174,8 @@ func (x *InputStreamClass) OverrideSkipAsync(cb func(*InputStream, uint, int, *C
 	if cb == nil {
 		x.xSkipAsync = 0
 	} else {
-		x.xSkipAsync = purego.NewCallback(func(StreamVarp uintptr, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xSkipAsync = core.NewCallback(func(StreamVarp uintptr, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(InputStreamNewFromInternalPtr(StreamVarp), CountVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -182,7 +187,7 @@ func (x *InputStreamClass) GetSkipAsync() func(*InputStream, uint, int, *Cancell
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSkipAsync)
+	core.RegisterFunc(&rawCallback, x.xSkipAsync)
 	return func(StreamVar *InputStream, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), CountVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -193,7 +198,8 @@ func (x *InputStreamClass) OverrideSkipFinish(cb func(*InputStream, AsyncResult)
 	if cb == nil {
 		x.xSkipFinish = 0
 	} else {
-		x.xSkipFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+		x.xSkipFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -205,7 +211,7 @@ func (x *InputStreamClass) GetSkipFinish() func(*InputStream, AsyncResult) int {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xSkipFinish)
+	core.RegisterFunc(&rawCallback, x.xSkipFinish)
 	return func(StreamVar *InputStream, ResultVar AsyncResult) int {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -216,7 +222,8 @@ func (x *InputStreamClass) OverrideCloseAsync(cb func(*InputStream, int, *Cancel
 	if cb == nil {
 		x.xCloseAsync = 0
 	} else {
-		x.xCloseAsync = purego.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCloseAsync = core.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(InputStreamNewFromInternalPtr(StreamVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -228,7 +235,7 @@ func (x *InputStreamClass) GetCloseAsync() func(*InputStream, int, *Cancellable,
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCloseAsync)
+	core.RegisterFunc(&rawCallback, x.xCloseAsync)
 	return func(StreamVar *InputStream, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -239,7 +246,8 @@ func (x *InputStreamClass) OverrideCloseFinish(cb func(*InputStream, AsyncResult
 	if cb == nil {
 		x.xCloseFinish = 0
 	} else {
-		x.xCloseFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+		x.xCloseFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(InputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -251,7 +259,7 @@ func (x *InputStreamClass) GetCloseFinish() func(*InputStream, AsyncResult) bool
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFinish)
+	core.RegisterFunc(&rawCallback, x.xCloseFinish)
 	return func(StreamVar *InputStream, ResultVar AsyncResult) bool {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -262,7 +270,8 @@ func (x *InputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -274,7 +283,7 @@ func (x *InputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -285,7 +294,8 @@ func (x *InputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -297,7 +307,7 @@ func (x *InputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -308,7 +318,8 @@ func (x *InputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -320,7 +331,7 @@ func (x *InputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -331,7 +342,8 @@ func (x *InputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -343,7 +355,7 @@ func (x *InputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -354,7 +366,8 @@ func (x *InputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -366,7 +379,7 @@ func (x *InputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -382,14 +395,14 @@ func (x *InputStreamPrivate) GoPointer() uintptr {
 
 // `GInputStream` is a base class for implementing streaming input.
 //
-// It has functions to read from a stream ([method@Gio.InputStream.read]),
-// to close a stream ([method@Gio.InputStream.close]) and to skip some content
-// ([method@Gio.InputStream.skip]).
+// It has functions to read from a stream ([InputStream.Read]),
+// to close a stream ([InputStream.Close]) and to skip some content
+// ([InputStream.Skip]).
 //
 // To copy the content of an input stream to an output stream without
-// manually handling the reads and writes, use [method@Gio.OutputStream.splice].
+// manually handling the reads and writes, use [OutputStream.Splice].
 //
-// See the documentation for [class@Gio.IOStream] for details of thread safety
+// See the documentation for [IOStream] for details of thread safety
 // of streaming APIs.
 //
 // All of these functions have async variants too.
@@ -411,7 +424,7 @@ func InputStreamNewFromInternalPtr(ptr uintptr) *InputStream {
 
 var xInputStreamClearPending func(uintptr)
 
-// Clears the pending flag on @stream.
+// Clears the pending flag on stream.
 func (x *InputStream) ClearPending() {
 
 	xInputStreamClearPending(x.GoPointer())
@@ -422,7 +435,7 @@ var xInputStreamClose func(uintptr, uintptr, **glib.Error) bool
 
 // Closes the stream, releasing resources related to it.
 //
-// Once the stream is closed, all other operations will return %G_IO_ERROR_CLOSED.
+// Once the stream is closed, all other operations will return [GIoErrorClosedValue].
 // Closing a stream multiple times will not return an error.
 //
 // Streams will be automatically closed when the last reference
@@ -435,12 +448,12 @@ var xInputStreamClose func(uintptr, uintptr, **glib.Error) bool
 //
 // On failure the first error that happened will be reported, but the close
 // operation will finish as much as possible. A stream that failed to
-// close will still return %G_IO_ERROR_CLOSED for all operations. Still, it
+// close will still return [GIoErrorClosedValue] for all operations. Still, it
 // is important to check and report the error to the user.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 // Cancelling a close will still leave the stream closed, but some streams
 // can use a faster close that doesn't block to e.g. check errors.
 func (x *InputStream) Close(CancellableVar *Cancellable) (bool, error) {
@@ -448,7 +461,7 @@ func (x *InputStream) Close(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xInputStreamClose(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -457,11 +470,11 @@ func (x *InputStream) Close(CancellableVar *Cancellable) (bool, error) {
 var xInputStreamCloseAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Requests an asynchronous closes of the stream, releasing resources related to it.
-// When the operation is finished @callback will be called.
-// You can then call g_input_stream_close_finish() to get the result of the
+// When the operation is finished callback will be called.
+// You can then call [InputStream.CloseFinish] to get the result of the
 // operation.
 //
-// For behaviour details see g_input_stream_close().
+// For behaviour details see [InputStream.Close].
 //
 // The asynchronous methods have a default fallback that uses threads to implement
 // asynchronicity, so they are optional for inheriting classes. However, if you
@@ -475,11 +488,12 @@ func (x *InputStream) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -489,13 +503,13 @@ func (x *InputStream) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable,
 
 var xInputStreamCloseFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes closing a stream asynchronously, started from g_input_stream_close_async().
+// Finishes closing a stream asynchronously, started from [InputStream.CloseAsync].
 func (x *InputStream) CloseFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamCloseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -521,33 +535,33 @@ func (x *InputStream) IsClosed() bool {
 
 var xInputStreamRead func(uintptr, *[]byte, uint, uintptr, **glib.Error) int
 
-// Tries to read @count bytes from the stream into the buffer starting at
-// @buffer. Will block during this read.
+// Tries to read count bytes from the stream into the buffer starting at
+// buffer. Will block during this read.
 //
-// If count is zero returns zero and does nothing. A value of @count
-// larger than %G_MAXSSIZE will cause a %G_IO_ERROR_INVALID_ARGUMENT error.
+// If count is zero returns zero and does nothing. A value of count
+// larger than G_MAXSSIZE will cause a [GIoErrorInvalidArgumentValue] error.
 //
 // On success, the number of bytes read into the buffer is returned.
 // It is not an error if this is not the same as the requested size, as it
 // can happen e.g. near the end of a file. Zero is returned on end of file
-// (or if @count is zero),  but never otherwise.
+// (or if count is zero),  but never otherwise.
 //
-// The returned @buffer is not a nul-terminated string, it can contain nul bytes
-// at any position, and this function doesn't nul-terminate the @buffer.
+// The returned buffer is not a nul-terminated string, it can contain nul bytes
+// at any position, and this function doesn't nul-terminate the buffer.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// On error -1 is returned and @error is set accordingly.
+// On error -1 is returned and error is set accordingly.
 func (x *InputStream) Read(BufferVar *[]byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamRead(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -555,31 +569,31 @@ func (x *InputStream) Read(BufferVar *[]byte, CountVar uint, CancellableVar *Can
 
 var xInputStreamReadAll func(uintptr, *[]byte, uint, *uint, uintptr, **glib.Error) bool
 
-// Tries to read @count bytes from the stream into the buffer starting at
-// @buffer. Will block during this read.
+// Tries to read count bytes from the stream into the buffer starting at
+// buffer. Will block during this read.
 //
-// This function is similar to g_input_stream_read(), except it tries to
+// This function is similar to [InputStream.Read], except it tries to
 // read as many bytes as requested, only stopping on an error or end of stream.
 //
-// On a successful read of @count bytes, or if we reached the end of the
-// stream,  %TRUE is returned, and @bytes_read is set to the number of bytes
-// read into @buffer.
+// On a successful read of count bytes, or if we reached the end of the
+// stream,  true is returned, and bytes_read is set to the number of bytes
+// read into buffer.
 //
-// If there is an error during the operation %FALSE is returned and @error
+// If there is an error during the operation false is returned and error
 // is set to indicate the error status.
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_read will be set to the number of bytes that were successfully
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_read will be set to the number of bytes that were successfully
 // read before the error was encountered.  This functionality is only
 // available from C.  If you need it from another language then you must
-// write your own loop around g_input_stream_read().
+// write your own loop around [InputStream.Read].
 func (x *InputStream) ReadAll(BufferVar *[]byte, CountVar uint, BytesReadVar *uint, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamReadAll(x.GoPointer(), BufferVar, CountVar, BytesReadVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -587,16 +601,16 @@ func (x *InputStream) ReadAll(BufferVar *[]byte, CountVar uint, BytesReadVar *ui
 
 var xInputStreamReadAllAsync func(uintptr, *[]byte, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous read of @count bytes from the stream into the
-// buffer starting at @buffer.
+// Request an asynchronous read of count bytes from the stream into the
+// buffer starting at buffer.
 //
-// This is the asynchronous equivalent of [method@InputStream.read_all].
+// This is the asynchronous equivalent of read_all.
 //
-// Call [method@InputStream.read_all_finish] to collect the result.
+// Call read_all_finish to collect the result.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 func (x *InputStream) ReadAllAsync(BufferVar *[]byte, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -606,11 +620,12 @@ func (x *InputStream) ReadAllAsync(BufferVar *[]byte, CountVar uint, IoPriorityV
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -621,20 +636,20 @@ func (x *InputStream) ReadAllAsync(BufferVar *[]byte, CountVar uint, IoPriorityV
 var xInputStreamReadAllFinish func(uintptr, uintptr, *uint, **glib.Error) bool
 
 // Finishes an asynchronous stream read operation started with
-// [method@InputStream.read_all_async].
+// read_all_async.
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_read will be set to the number of bytes that were successfully
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_read will be set to the number of bytes that were successfully
 // read before the error was encountered.  This functionality is only
 // available from C.  If you need it from another language then you must
-// write your own loop around g_input_stream_read_async().
+// write your own loop around [InputStream.ReadAsync].
 func (x *InputStream) ReadAllFinish(ResultVar AsyncResult, BytesReadVar *uint) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamReadAllFinish(x.GoPointer(), ResultVar.GoPointer(), BytesReadVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -642,25 +657,25 @@ func (x *InputStream) ReadAllFinish(ResultVar AsyncResult, BytesReadVar *uint) (
 
 var xInputStreamReadAsync func(uintptr, *[]byte, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous read of @count bytes from the stream into the buffer
-// starting at @buffer. When the operation is finished @callback will be called.
-// You can then call g_input_stream_read_finish() to get the result of the
+// Request an asynchronous read of count bytes from the stream into the buffer
+// starting at buffer. When the operation is finished callback will be called.
+// You can then call [InputStream.ReadFinish] to get the result of the
 // operation.
 //
-// During an async request no other sync and async calls are allowed on @stream, and will
-// result in %G_IO_ERROR_PENDING errors.
+// During an async request no other sync and async calls are allowed on stream, and will
+// result in [GIoErrorPendingValue] errors.
 //
-// A value of @count larger than %G_MAXSSIZE will cause a %G_IO_ERROR_INVALID_ARGUMENT error.
+// A value of count larger than G_MAXSSIZE will cause a [GIoErrorInvalidArgumentValue] error.
 //
 // On success, the number of bytes read into the buffer will be passed to the
 // callback. It is not an error if this is not the same as the requested size, as it
 // can happen e.g. near the end of a file, but generally we try to read
 // as many bytes as requested. Zero is returned on end of file
-// (or if @count is zero),  but never otherwise.
+// (or if count is zero),  but never otherwise.
 //
 // Any outstanding i/o request with higher priority (lower numerical value) will
 // be executed before an outstanding request with lower priority. Default
-// priority is %G_PRIORITY_DEFAULT.
+// priority is [glib.PRIORITY_DEFAULT].
 //
 // The asynchronous methods have a default fallback that uses threads to implement
 // asynchronicity, so they are optional for inheriting classes. However, if you
@@ -674,11 +689,12 @@ func (x *InputStream) ReadAsync(BufferVar *[]byte, CountVar uint, IoPriorityVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -688,62 +704,63 @@ func (x *InputStream) ReadAsync(BufferVar *[]byte, CountVar uint, IoPriorityVar
 
 var xInputStreamReadBytes func(uintptr, uint, uintptr, **glib.Error) *glib.Bytes
 
-// Like g_input_stream_read(), this tries to read @count bytes from
+// Like [InputStream.Read], this tries to read count bytes from
 // the stream in a blocking fashion. However, rather than reading into
-// a user-supplied buffer, this will create a new #GBytes containing
+// a user-supplied buffer, this will create a new [glib.Bytes] containing
 // the data that was read. This may be easier to use from language
 // bindings.
 //
-// If count is zero, returns a zero-length #GBytes and does nothing. A
-// value of @count larger than %G_MAXSSIZE will cause a
-// %G_IO_ERROR_INVALID_ARGUMENT error.
+// If count is zero, returns a zero-length [glib.Bytes] and does nothing. A
+// value of count larger than G_MAXSSIZE will cause a
+// [GIoErrorInvalidArgumentValue] error.
 //
-// On success, a new #GBytes is returned. It is not an error if the
+// On success, a new [glib.Bytes] is returned. It is not an error if the
 // size of this object is not the same as the requested size, as it
-// can happen e.g. near the end of a file. A zero-length #GBytes is
-// returned on end of file (or if @count is zero), but never
+// can happen e.g. near the end of a file. A zero-length [glib.Bytes] is
+// returned on end of file (or if count is zero), but never
 // otherwise.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// On error %NULL is returned and @error is set accordingly.
-func (x *InputStream) ReadBytes(CountVar uint, CancellableVar *Cancellable) (*glib.Bytes, error) {
+// On error nil is returned and error is set accordingly.
+func (x *InputStream) ReadBytes(CountVar uint, CancellableVar *Cancellable) ([]byte, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamReadBytes(x.GoPointer(), CountVar, CancellableVar.GoPointer(), &cerr)
+	defer cret.Unref()
 	if cerr == nil {
-		return cret, nil
+		return cret.Data(), core.PlatformError
 	}
-	return cret, cerr
+	return cret.Data(), cerr
 
 }
 
 var xInputStreamReadBytesAsync func(uintptr, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous read of @count bytes from the stream into a
-// new #GBytes. When the operation is finished @callback will be
-// called. You can then call g_input_stream_read_bytes_finish() to get the
+// Request an asynchronous read of count bytes from the stream into a
+// new [glib.Bytes]. When the operation is finished callback will be
+// called. You can then call [InputStream.ReadBytesFinish] to get the
 // result of the operation.
 //
 // During an async request no other sync and async calls are allowed
-// on @stream, and will result in %G_IO_ERROR_PENDING errors.
+// on stream, and will result in [GIoErrorPendingValue] errors.
 //
-// A value of @count larger than %G_MAXSSIZE will cause a
-// %G_IO_ERROR_INVALID_ARGUMENT error.
+// A value of count larger than G_MAXSSIZE will cause a
+// [GIoErrorInvalidArgumentValue] error.
 //
-// On success, the new #GBytes will be passed to the callback. It is
+// On success, the new [glib.Bytes] will be passed to the callback. It is
 // not an error if this is smaller than the requested size, as it can
 // happen e.g. near the end of a file, but generally we try to read as
 // many bytes as requested. Zero is returned on end of file (or if
-// @count is zero), but never otherwise.
+// count is zero), but never otherwise.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 func (x *InputStream) ReadBytesAsync(CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -753,11 +770,12 @@ func (x *InputStream) ReadBytesAsync(CountVar uint, IoPriorityVar int, Cancellab
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -767,15 +785,16 @@ func (x *InputStream) ReadBytesAsync(CountVar uint, IoPriorityVar int, Cancellab
 
 var xInputStreamReadBytesFinish func(uintptr, uintptr, **glib.Error) *glib.Bytes
 
-// Finishes an asynchronous stream read-into-#GBytes operation.
-func (x *InputStream) ReadBytesFinish(ResultVar AsyncResult) (*glib.Bytes, error) {
+// Finishes an asynchronous stream read-into-[glib.Bytes] operation.
+func (x *InputStream) ReadBytesFinish(ResultVar AsyncResult) ([]byte, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamReadBytesFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	defer cret.Unref()
 	if cerr == nil {
-		return cret, nil
+		return cret.Data(), core.PlatformError
 	}
-	return cret, cerr
+	return cret.Data(), cerr
 
 }
 
@@ -787,7 +806,7 @@ func (x *InputStream) ReadFinish(ResultVar AsyncResult) (int, error) {
 
 	cret := xInputStreamReadFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -795,15 +814,15 @@ func (x *InputStream) ReadFinish(ResultVar AsyncResult) (int, error) {
 
 var xInputStreamSetPending func(uintptr) bool
 
-// Sets @stream to have actions pending. If the pending flag is
-// already set or @stream is closed, it will return %FALSE and set
-// @error.
+// Sets stream to have actions pending. If the pending flag is
+// already set or stream is closed, it will return false and set
+// error.
 func (x *InputStream) SetPending() (bool, error) {
 	var cerr *glib.Error
 
 	cret := xInputStreamSetPending(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -811,18 +830,18 @@ func (x *InputStream) SetPending() (bool, error) {
 
 var xInputStreamSkip func(uintptr, uint, uintptr, **glib.Error) int
 
-// Tries to skip @count bytes from the stream. Will block during the operation.
+// Tries to skip count bytes from the stream. Will block during the operation.
 //
-// This is identical to g_input_stream_read(), from a behaviour standpoint,
+// This is identical to [InputStream.Read], from a behaviour standpoint,
 // but the bytes that are skipped are not returned to the user. Some
 // streams have an implementation that is more efficient than reading the data.
 //
 // This function is optional for inherited classes, as the default implementation
 // emulates it using read.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *InputStream) Skip(CountVar uint, CancellableVar *Cancellable) (int, error) {
@@ -830,7 +849,7 @@ func (x *InputStream) Skip(CountVar uint, CancellableVar *Cancellable) (int, err
 
 	cret := xInputStreamSkip(x.GoPointer(), CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -838,25 +857,25 @@ func (x *InputStream) Skip(CountVar uint, CancellableVar *Cancellable) (int, err
 
 var xInputStreamSkipAsync func(uintptr, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous skip of @count bytes from the stream.
-// When the operation is finished @callback will be called.
-// You can then call g_input_stream_skip_finish() to get the result
+// Request an asynchronous skip of count bytes from the stream.
+// When the operation is finished callback will be called.
+// You can then call [InputStream.SkipFinish] to get the result
 // of the operation.
 //
 // During an async request no other sync and async calls are allowed,
-// and will result in %G_IO_ERROR_PENDING errors.
+// and will result in [GIoErrorPendingValue] errors.
 //
-// A value of @count larger than %G_MAXSSIZE will cause a %G_IO_ERROR_INVALID_ARGUMENT error.
+// A value of count larger than G_MAXSSIZE will cause a [GIoErrorInvalidArgumentValue] error.
 //
 // On success, the number of bytes skipped will be passed to the callback.
 // It is not an error if this is not the same as the requested size, as it
 // can happen e.g. near the end of a file, but generally we try to skip
 // as many bytes as requested. Zero is returned on end of file
-// (or if @count is zero), but never otherwise.
+// (or if count is zero), but never otherwise.
 //
 // Any outstanding i/o request with higher priority (lower numerical value)
 // will be executed before an outstanding request with lower priority.
-// Default priority is %G_PRIORITY_DEFAULT.
+// Default priority is [glib.PRIORITY_DEFAULT].
 //
 // The asynchronous methods have a default fallback that uses threads to
 // implement asynchronicity, so they are optional for inheriting classes.
@@ -870,11 +889,12 @@ func (x *InputStream) SkipAsync(CountVar uint, IoPriorityVar int, CancellableVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -890,7 +910,7 @@ func (x *InputStream) SkipFinish(ResultVar AsyncResult) (int, error) {
 
 	cret := xInputStreamSkipFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -912,7 +932,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -921,24 +941,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xInputStreamGLibType, libs, "g_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xInputStreamClearPending, libs, "g_input_stream_clear_pending")
-	core.PuregoSafeRegister(&xInputStreamClose, libs, "g_input_stream_close")
-	core.PuregoSafeRegister(&xInputStreamCloseAsync, libs, "g_input_stream_close_async")
-	core.PuregoSafeRegister(&xInputStreamCloseFinish, libs, "g_input_stream_close_finish")
-	core.PuregoSafeRegister(&xInputStreamHasPending, libs, "g_input_stream_has_pending")
-	core.PuregoSafeRegister(&xInputStreamIsClosed, libs, "g_input_stream_is_closed")
-	core.PuregoSafeRegister(&xInputStreamRead, libs, "g_input_stream_read")
-	core.PuregoSafeRegister(&xInputStreamReadAll, libs, "g_input_stream_read_all")
-	core.PuregoSafeRegister(&xInputStreamReadAllAsync, libs, "g_input_stream_read_all_async")
-	core.PuregoSafeRegister(&xInputStreamReadAllFinish, libs, "g_input_stream_read_all_finish")
-	core.PuregoSafeRegister(&xInputStreamReadAsync, libs, "g_input_stream_read_async")
-	core.PuregoSafeRegister(&xInputStreamReadBytes, libs, "g_input_stream_read_bytes")
-	core.PuregoSafeRegister(&xInputStreamReadBytesAsync, libs, "g_input_stream_read_bytes_async")
-	core.PuregoSafeRegister(&xInputStreamReadBytesFinish, libs, "g_input_stream_read_bytes_finish")
-	core.PuregoSafeRegister(&xInputStreamReadFinish, libs, "g_input_stream_read_finish")
-	core.PuregoSafeRegister(&xInputStreamSetPending, libs, "g_input_stream_set_pending")
-	core.PuregoSafeRegister(&xInputStreamSkip, libs, "g_input_stream_skip")
-	core.PuregoSafeRegister(&xInputStreamSkipAsync, libs, "g_input_stream_skip_async")
-	core.PuregoSafeRegister(&xInputStreamSkipFinish, libs, "g_input_stream_skip_finish")
+	gobject.CheckClassSize("GInputStreamClass", xInputStreamGLibType, unsafe.Sizeof(InputStreamClass{}))
+
+	core.PuregoRegisterSince(&xInputStreamClearPending, libs, "g_input_stream_clear_pending", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamClose, libs, "g_input_stream_close", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamCloseAsync, libs, "g_input_stream_close_async", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamCloseFinish, libs, "g_input_stream_close_finish", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamHasPending, libs, "g_input_stream_has_pending", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamIsClosed, libs, "g_input_stream_is_closed", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamRead, libs, "g_input_stream_read", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamReadAll, libs, "g_input_stream_read_all", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamReadAllAsync, libs, "g_input_stream_read_all_async", "GIO", "2.44")
+	core.PuregoRegisterSince(&xInputStreamReadAllFinish, libs, "g_input_stream_read_all_finish", "GIO", "2.44")
+	core.PuregoRegisterSince(&xInputStreamReadAsync, libs, "g_input_stream_read_async", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamReadBytes, libs, "g_input_stream_read_bytes", "GIO", "2.34")
+	core.PuregoRegisterSince(&xInputStreamReadBytesAsync, libs, "g_input_stream_read_bytes_async", "GIO", "2.34")
+	core.PuregoRegisterSince(&xInputStreamReadBytesFinish, libs, "g_input_stream_read_bytes_finish", "GIO", "2.34")
+	core.PuregoRegisterSince(&xInputStreamReadFinish, libs, "g_input_stream_read_finish", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamSetPending, libs, "g_input_stream_set_pending", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamSkip, libs, "g_input_stream_skip", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamSkipAsync, libs, "g_input_stream_skip_async", "GIO", "")
+	core.PuregoRegisterSince(&xInputStreamSkipFinish, libs, "g_input_stream_skip_finish", "GIO", "")
 
 }
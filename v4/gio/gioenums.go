@@ -1,10 +1,16 @@
 // Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gio
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+	"strings"
 
-// Flags used when creating a #GAppInfo.
-type AppInfoCreateFlags int
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// Flags used when creating a [AppInfo].
+type AppInfoCreateFlags uint32
 
 var xAppInfoCreateFlagsGLibType func() types.GType
 
@@ -24,8 +30,53 @@ const (
 	GAppInfoCreateSupportsStartupNotificationValue AppInfoCreateFlags = 4
 )
 
-// Flags used to define the behaviour of a #GApplication.
-type ApplicationFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (AppInfoCreateFlags) GLibType() types.GType {
+	return xAppInfoCreateFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x AppInfoCreateFlags) Has(flags AppInfoCreateFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x AppInfoCreateFlags) Set(flags AppInfoCreateFlags) AppInfoCreateFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x AppInfoCreateFlags) Clear(flags AppInfoCreateFlags) AppInfoCreateFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x AppInfoCreateFlags) String() string {
+	if x == 0 {
+		return "G_APP_INFO_CREATE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GAppInfoCreateNeedsTerminalValue != 0 && x&GAppInfoCreateNeedsTerminalValue == GAppInfoCreateNeedsTerminalValue {
+		names = append(names, "G_APP_INFO_CREATE_NEEDS_TERMINAL")
+		rest &^= GAppInfoCreateNeedsTerminalValue
+	}
+	if rest&GAppInfoCreateSupportsUrisValue != 0 && x&GAppInfoCreateSupportsUrisValue == GAppInfoCreateSupportsUrisValue {
+		names = append(names, "G_APP_INFO_CREATE_SUPPORTS_URIS")
+		rest &^= GAppInfoCreateSupportsUrisValue
+	}
+	if rest&GAppInfoCreateSupportsStartupNotificationValue != 0 && x&GAppInfoCreateSupportsStartupNotificationValue == GAppInfoCreateSupportsStartupNotificationValue {
+		names = append(names, "G_APP_INFO_CREATE_SUPPORTS_STARTUP_NOTIFICATION")
+		rest &^= GAppInfoCreateSupportsStartupNotificationValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used to define the behaviour of a [Application].
+type ApplicationFlags uint32
 
 var xApplicationFlagsGLibType func() types.GType
 
@@ -36,6 +87,8 @@ func ApplicationFlagsGLibType() types.GType {
 const (
 
 	// Default flags.
+	//
+	// Deprecated: since 2.74. Use ApplicationFlags.DEFAULT_FLAGS.
 	GApplicationFlagsNoneValue ApplicationFlags = 0
 	// Default flags.
 	GApplicationDefaultFlagsValue ApplicationFlags = 0
@@ -49,13 +102,13 @@ const (
 	// This application handles opening files (in
 	//     the primary instance). Note that this flag only affects the default
 	//     implementation of local_command_line(), and has no effect if
-	//     %G_APPLICATION_HANDLES_COMMAND_LINE is given.
-	//     See g_application_run() for details.
+	//     [GApplicationHandlesCommandLineValue] is given.
+	//     See [Application.Run] for details.
 	GApplicationHandlesOpenValue ApplicationFlags = 4
 	// This application handles command line
 	//     arguments (in the primary instance). Note that this flag only affect
 	//     the default implementation of local_command_line().
-	//     See g_application_run() for details.
+	//     See [Application.Run] for details.
 	GApplicationHandlesCommandLineValue ApplicationFlags = 8
 	// Send the environment of the
 	//     launching process to the primary instance. Set this flag if your
@@ -63,8 +116,8 @@ const (
 	//     environment variables. For instance, an editor might be expected
 	//     to use the `GIT_COMMITTER_NAME` environment variable
 	//     when editing a git commit message. The environment is available
-	//     to the #GApplication::command-line signal handler, via
-	//     g_application_command_line_getenv().
+	//     to the [Application.ConnectCommandLineFunc] signal handler, via
+	//     [ApplicationCommandLine.Getenv].
 	GApplicationSendEnvironmentValue ApplicationFlags = 16
 	// Make no attempts to do any of the typical
 	//     single-instance application negotiation, even if the application
@@ -86,10 +139,79 @@ const (
 	GApplicationReplaceValue ApplicationFlags = 256
 )
 
-// #GAskPasswordFlags are used to request specific information from the
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ApplicationFlags) GLibType() types.GType {
+	return xApplicationFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ApplicationFlags) Has(flags ApplicationFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ApplicationFlags) Set(flags ApplicationFlags) ApplicationFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ApplicationFlags) Clear(flags ApplicationFlags) ApplicationFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ApplicationFlags) String() string {
+	if x == 0 {
+		return "G_APPLICATION_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GApplicationIsServiceValue != 0 && x&GApplicationIsServiceValue == GApplicationIsServiceValue {
+		names = append(names, "G_APPLICATION_IS_SERVICE")
+		rest &^= GApplicationIsServiceValue
+	}
+	if rest&GApplicationIsLauncherValue != 0 && x&GApplicationIsLauncherValue == GApplicationIsLauncherValue {
+		names = append(names, "G_APPLICATION_IS_LAUNCHER")
+		rest &^= GApplicationIsLauncherValue
+	}
+	if rest&GApplicationHandlesOpenValue != 0 && x&GApplicationHandlesOpenValue == GApplicationHandlesOpenValue {
+		names = append(names, "G_APPLICATION_HANDLES_OPEN")
+		rest &^= GApplicationHandlesOpenValue
+	}
+	if rest&GApplicationHandlesCommandLineValue != 0 && x&GApplicationHandlesCommandLineValue == GApplicationHandlesCommandLineValue {
+		names = append(names, "G_APPLICATION_HANDLES_COMMAND_LINE")
+		rest &^= GApplicationHandlesCommandLineValue
+	}
+	if rest&GApplicationSendEnvironmentValue != 0 && x&GApplicationSendEnvironmentValue == GApplicationSendEnvironmentValue {
+		names = append(names, "G_APPLICATION_SEND_ENVIRONMENT")
+		rest &^= GApplicationSendEnvironmentValue
+	}
+	if rest&GApplicationNonUniqueValue != 0 && x&GApplicationNonUniqueValue == GApplicationNonUniqueValue {
+		names = append(names, "G_APPLICATION_NON_UNIQUE")
+		rest &^= GApplicationNonUniqueValue
+	}
+	if rest&GApplicationCanOverrideAppIdValue != 0 && x&GApplicationCanOverrideAppIdValue == GApplicationCanOverrideAppIdValue {
+		names = append(names, "G_APPLICATION_CAN_OVERRIDE_APP_ID")
+		rest &^= GApplicationCanOverrideAppIdValue
+	}
+	if rest&GApplicationAllowReplacementValue != 0 && x&GApplicationAllowReplacementValue == GApplicationAllowReplacementValue {
+		names = append(names, "G_APPLICATION_ALLOW_REPLACEMENT")
+		rest &^= GApplicationAllowReplacementValue
+	}
+	if rest&GApplicationReplaceValue != 0 && x&GApplicationReplaceValue == GApplicationReplaceValue {
+		names = append(names, "G_APPLICATION_REPLACE")
+		rest &^= GApplicationReplaceValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// [AskPasswordFlags] are used to request specific information from the
 // user, or to notify the user of their choices in an authentication
 // situation.
-type AskPasswordFlags int
+type AskPasswordFlags uint32
 
 var xAskPasswordFlagsGLibType func() types.GType
 
@@ -113,8 +235,65 @@ const (
 	GAskPasswordTcryptValue AskPasswordFlags = 32
 )
 
-// Flags used in g_bus_own_name().
-type BusNameOwnerFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (AskPasswordFlags) GLibType() types.GType {
+	return xAskPasswordFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x AskPasswordFlags) Has(flags AskPasswordFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x AskPasswordFlags) Set(flags AskPasswordFlags) AskPasswordFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x AskPasswordFlags) Clear(flags AskPasswordFlags) AskPasswordFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x AskPasswordFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GAskPasswordNeedPasswordValue != 0 && x&GAskPasswordNeedPasswordValue == GAskPasswordNeedPasswordValue {
+		names = append(names, "G_ASK_PASSWORD_NEED_PASSWORD")
+		rest &^= GAskPasswordNeedPasswordValue
+	}
+	if rest&GAskPasswordNeedUsernameValue != 0 && x&GAskPasswordNeedUsernameValue == GAskPasswordNeedUsernameValue {
+		names = append(names, "G_ASK_PASSWORD_NEED_USERNAME")
+		rest &^= GAskPasswordNeedUsernameValue
+	}
+	if rest&GAskPasswordNeedDomainValue != 0 && x&GAskPasswordNeedDomainValue == GAskPasswordNeedDomainValue {
+		names = append(names, "G_ASK_PASSWORD_NEED_DOMAIN")
+		rest &^= GAskPasswordNeedDomainValue
+	}
+	if rest&GAskPasswordSavingSupportedValue != 0 && x&GAskPasswordSavingSupportedValue == GAskPasswordSavingSupportedValue {
+		names = append(names, "G_ASK_PASSWORD_SAVING_SUPPORTED")
+		rest &^= GAskPasswordSavingSupportedValue
+	}
+	if rest&GAskPasswordAnonymousSupportedValue != 0 && x&GAskPasswordAnonymousSupportedValue == GAskPasswordAnonymousSupportedValue {
+		names = append(names, "G_ASK_PASSWORD_ANONYMOUS_SUPPORTED")
+		rest &^= GAskPasswordAnonymousSupportedValue
+	}
+	if rest&GAskPasswordTcryptValue != 0 && x&GAskPasswordTcryptValue == GAskPasswordTcryptValue {
+		names = append(names, "G_ASK_PASSWORD_TCRYPT")
+		rest &^= GAskPasswordTcryptValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used in [BusOwnName].
+type BusNameOwnerFlags uint32
 
 var xBusNameOwnerFlagsGLibType func() types.GType
 
@@ -129,16 +308,61 @@ const (
 	// Allow another message bus connection to claim the name.
 	GBusNameOwnerFlagsAllowReplacementValue BusNameOwnerFlags = 1
 	// If another message bus connection owns the name and have
-	// specified %G_BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT, then take the name from the other connection.
+	// specified [GBusNameOwnerFlagsAllowReplacementValue], then take the name from the other connection.
 	GBusNameOwnerFlagsReplaceValue BusNameOwnerFlags = 2
 	// If another message bus connection owns the name, immediately return an error
-	// from [func@Gio.bus_own_name] rather than entering the waiting queue for that
+	// from [BusOwnName] rather than entering the waiting queue for that
 	// name.
 	GBusNameOwnerFlagsDoNotQueueValue BusNameOwnerFlags = 4
 )
 
-// Flags used in g_bus_watch_name().
-type BusNameWatcherFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (BusNameOwnerFlags) GLibType() types.GType {
+	return xBusNameOwnerFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x BusNameOwnerFlags) Has(flags BusNameOwnerFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x BusNameOwnerFlags) Set(flags BusNameOwnerFlags) BusNameOwnerFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x BusNameOwnerFlags) Clear(flags BusNameOwnerFlags) BusNameOwnerFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x BusNameOwnerFlags) String() string {
+	if x == 0 {
+		return "G_BUS_NAME_OWNER_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GBusNameOwnerFlagsAllowReplacementValue != 0 && x&GBusNameOwnerFlagsAllowReplacementValue == GBusNameOwnerFlagsAllowReplacementValue {
+		names = append(names, "G_BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT")
+		rest &^= GBusNameOwnerFlagsAllowReplacementValue
+	}
+	if rest&GBusNameOwnerFlagsReplaceValue != 0 && x&GBusNameOwnerFlagsReplaceValue == GBusNameOwnerFlagsReplaceValue {
+		names = append(names, "G_BUS_NAME_OWNER_FLAGS_REPLACE")
+		rest &^= GBusNameOwnerFlagsReplaceValue
+	}
+	if rest&GBusNameOwnerFlagsDoNotQueueValue != 0 && x&GBusNameOwnerFlagsDoNotQueueValue == GBusNameOwnerFlagsDoNotQueueValue {
+		names = append(names, "G_BUS_NAME_OWNER_FLAGS_DO_NOT_QUEUE")
+		rest &^= GBusNameOwnerFlagsDoNotQueueValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used in [BusWatchName].
+type BusNameWatcherFlags uint32
 
 var xBusNameWatcherFlagsGLibType func() types.GType
 
@@ -156,8 +380,45 @@ const (
 	GBusNameWatcherFlagsAutoStartValue BusNameWatcherFlags = 1
 )
 
-// Flags used when calling a g_converter_convert().
-type ConverterFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (BusNameWatcherFlags) GLibType() types.GType {
+	return xBusNameWatcherFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x BusNameWatcherFlags) Has(flags BusNameWatcherFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x BusNameWatcherFlags) Set(flags BusNameWatcherFlags) BusNameWatcherFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x BusNameWatcherFlags) Clear(flags BusNameWatcherFlags) BusNameWatcherFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x BusNameWatcherFlags) String() string {
+	if x == 0 {
+		return "G_BUS_NAME_WATCHER_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GBusNameWatcherFlagsAutoStartValue != 0 && x&GBusNameWatcherFlagsAutoStartValue == GBusNameWatcherFlagsAutoStartValue {
+		names = append(names, "G_BUS_NAME_WATCHER_FLAGS_AUTO_START")
+		rest &^= GBusNameWatcherFlagsAutoStartValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when calling a [Converter.Convert].
+type ConverterFlags uint32
 
 var xConverterFlagsGLibType func() types.GType
 
@@ -175,8 +436,49 @@ const (
 	GConverterFlushValue ConverterFlags = 2
 )
 
-// Flags used in g_dbus_connection_call() and similar APIs.
-type DBusCallFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ConverterFlags) GLibType() types.GType {
+	return xConverterFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ConverterFlags) Has(flags ConverterFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ConverterFlags) Set(flags ConverterFlags) ConverterFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ConverterFlags) Clear(flags ConverterFlags) ConverterFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ConverterFlags) String() string {
+	if x == 0 {
+		return "G_CONVERTER_NO_FLAGS"
+	}
+	var names []string
+	rest := x
+	if rest&GConverterInputAtEndValue != 0 && x&GConverterInputAtEndValue == GConverterInputAtEndValue {
+		names = append(names, "G_CONVERTER_INPUT_AT_END")
+		rest &^= GConverterInputAtEndValue
+	}
+	if rest&GConverterFlushValue != 0 && x&GConverterFlushValue == GConverterFlushValue {
+		names = append(names, "G_CONVERTER_FLUSH")
+		rest &^= GConverterFlushValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used in [DBusConnection.Call] and similar APIs.
+type DBusCallFlags uint32
 
 var xDBusCallFlagsGLibType func() types.GType
 
@@ -197,8 +499,49 @@ const (
 	GDbusCallFlagsAllowInteractiveAuthorizationValue DBusCallFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusCallFlags) GLibType() types.GType {
+	return xDBusCallFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusCallFlags) Has(flags DBusCallFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusCallFlags) Set(flags DBusCallFlags) DBusCallFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusCallFlags) Clear(flags DBusCallFlags) DBusCallFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusCallFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_CALL_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusCallFlagsNoAutoStartValue != 0 && x&GDbusCallFlagsNoAutoStartValue == GDbusCallFlagsNoAutoStartValue {
+		names = append(names, "G_DBUS_CALL_FLAGS_NO_AUTO_START")
+		rest &^= GDbusCallFlagsNoAutoStartValue
+	}
+	if rest&GDbusCallFlagsAllowInteractiveAuthorizationValue != 0 && x&GDbusCallFlagsAllowInteractiveAuthorizationValue == GDbusCallFlagsAllowInteractiveAuthorizationValue {
+		names = append(names, "G_DBUS_CALL_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION")
+		rest &^= GDbusCallFlagsAllowInteractiveAuthorizationValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Capabilities negotiated with the remote peer.
-type DBusCapabilityFlags int
+type DBusCapabilityFlags uint32
 
 var xDBusCapabilityFlagsGLibType func() types.GType
 
@@ -215,8 +558,45 @@ const (
 	GDbusCapabilityFlagsUnixFdPassingValue DBusCapabilityFlags = 1
 )
 
-// Flags used when creating a new #GDBusConnection.
-type DBusConnectionFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusCapabilityFlags) GLibType() types.GType {
+	return xDBusCapabilityFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusCapabilityFlags) Has(flags DBusCapabilityFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusCapabilityFlags) Set(flags DBusCapabilityFlags) DBusCapabilityFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusCapabilityFlags) Clear(flags DBusCapabilityFlags) DBusCapabilityFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusCapabilityFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_CAPABILITY_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusCapabilityFlagsUnixFdPassingValue != 0 && x&GDbusCapabilityFlagsUnixFdPassingValue == GDbusCapabilityFlagsUnixFdPassingValue {
+		names = append(names, "G_DBUS_CAPABILITY_FLAGS_UNIX_FD_PASSING")
+		rest &^= GDbusCapabilityFlagsUnixFdPassingValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when creating a new [DBusConnection].
+type DBusConnectionFlags uint32
 
 var xDBusConnectionFlagsGLibType func() types.GType
 
@@ -240,7 +620,7 @@ const (
 	// message bus. This means that the Hello() method will be invoked as part of the connection setup.
 	GDbusConnectionFlagsMessageBusConnectionValue DBusConnectionFlags = 8
 	// If set, processing of D-Bus messages is
-	// delayed until g_dbus_connection_start_message_processing() is called.
+	// delayed until [DBusConnection.StartMessageProcessing] is called.
 	GDbusConnectionFlagsDelayMessageProcessingValue DBusConnectionFlags = 16
 	// When authenticating
 	// as a server, require the UID of the peer to be the same as the UID of the server. (Since: 2.68)
@@ -254,8 +634,69 @@ const (
 	GDbusConnectionFlagsCrossNamespaceValue DBusConnectionFlags = 64
 )
 
-// Flags describing the behavior of a #GDBusInterfaceSkeleton instance.
-type DBusInterfaceSkeletonFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusConnectionFlags) GLibType() types.GType {
+	return xDBusConnectionFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusConnectionFlags) Has(flags DBusConnectionFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusConnectionFlags) Set(flags DBusConnectionFlags) DBusConnectionFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusConnectionFlags) Clear(flags DBusConnectionFlags) DBusConnectionFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusConnectionFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_CONNECTION_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusConnectionFlagsAuthenticationClientValue != 0 && x&GDbusConnectionFlagsAuthenticationClientValue == GDbusConnectionFlagsAuthenticationClientValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT")
+		rest &^= GDbusConnectionFlagsAuthenticationClientValue
+	}
+	if rest&GDbusConnectionFlagsAuthenticationServerValue != 0 && x&GDbusConnectionFlagsAuthenticationServerValue == GDbusConnectionFlagsAuthenticationServerValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER")
+		rest &^= GDbusConnectionFlagsAuthenticationServerValue
+	}
+	if rest&GDbusConnectionFlagsAuthenticationAllowAnonymousValue != 0 && x&GDbusConnectionFlagsAuthenticationAllowAnonymousValue == GDbusConnectionFlagsAuthenticationAllowAnonymousValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS")
+		rest &^= GDbusConnectionFlagsAuthenticationAllowAnonymousValue
+	}
+	if rest&GDbusConnectionFlagsMessageBusConnectionValue != 0 && x&GDbusConnectionFlagsMessageBusConnectionValue == GDbusConnectionFlagsMessageBusConnectionValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION")
+		rest &^= GDbusConnectionFlagsMessageBusConnectionValue
+	}
+	if rest&GDbusConnectionFlagsDelayMessageProcessingValue != 0 && x&GDbusConnectionFlagsDelayMessageProcessingValue == GDbusConnectionFlagsDelayMessageProcessingValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING")
+		rest &^= GDbusConnectionFlagsDelayMessageProcessingValue
+	}
+	if rest&GDbusConnectionFlagsAuthenticationRequireSameUserValue != 0 && x&GDbusConnectionFlagsAuthenticationRequireSameUserValue == GDbusConnectionFlagsAuthenticationRequireSameUserValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER")
+		rest &^= GDbusConnectionFlagsAuthenticationRequireSameUserValue
+	}
+	if rest&GDbusConnectionFlagsCrossNamespaceValue != 0 && x&GDbusConnectionFlagsCrossNamespaceValue == GDbusConnectionFlagsCrossNamespaceValue {
+		names = append(names, "G_DBUS_CONNECTION_FLAGS_CROSS_NAMESPACE")
+		rest &^= GDbusConnectionFlagsCrossNamespaceValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags describing the behavior of a [DBusInterfaceSkeleton] instance.
+type DBusInterfaceSkeletonFlags uint32
 
 var xDBusInterfaceSkeletonFlagsGLibType func() types.GType
 
@@ -274,8 +715,45 @@ const (
 	GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue DBusInterfaceSkeletonFlags = 1
 )
 
-// Message flags used in #GDBusMessage.
-type DBusMessageFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusInterfaceSkeletonFlags) GLibType() types.GType {
+	return xDBusInterfaceSkeletonFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusInterfaceSkeletonFlags) Has(flags DBusInterfaceSkeletonFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusInterfaceSkeletonFlags) Set(flags DBusInterfaceSkeletonFlags) DBusInterfaceSkeletonFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusInterfaceSkeletonFlags) Clear(flags DBusInterfaceSkeletonFlags) DBusInterfaceSkeletonFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusInterfaceSkeletonFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_INTERFACE_SKELETON_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue != 0 && x&GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue == GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue {
+		names = append(names, "G_DBUS_INTERFACE_SKELETON_FLAGS_HANDLE_METHOD_INVOCATIONS_IN_THREAD")
+		rest &^= GDbusInterfaceSkeletonFlagsHandleMethodInvocationsInThreadValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Message flags used in [DBusMessage].
+type DBusMessageFlags uint32
 
 var xDBusMessageFlagsGLibType func() types.GType
 
@@ -298,8 +776,53 @@ const (
 	GDbusMessageFlagsAllowInteractiveAuthorizationValue DBusMessageFlags = 4
 )
 
-// Flags used when constructing a #GDBusObjectManagerClient.
-type DBusObjectManagerClientFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusMessageFlags) GLibType() types.GType {
+	return xDBusMessageFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusMessageFlags) Has(flags DBusMessageFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusMessageFlags) Set(flags DBusMessageFlags) DBusMessageFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusMessageFlags) Clear(flags DBusMessageFlags) DBusMessageFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusMessageFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_MESSAGE_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusMessageFlagsNoReplyExpectedValue != 0 && x&GDbusMessageFlagsNoReplyExpectedValue == GDbusMessageFlagsNoReplyExpectedValue {
+		names = append(names, "G_DBUS_MESSAGE_FLAGS_NO_REPLY_EXPECTED")
+		rest &^= GDbusMessageFlagsNoReplyExpectedValue
+	}
+	if rest&GDbusMessageFlagsNoAutoStartValue != 0 && x&GDbusMessageFlagsNoAutoStartValue == GDbusMessageFlagsNoAutoStartValue {
+		names = append(names, "G_DBUS_MESSAGE_FLAGS_NO_AUTO_START")
+		rest &^= GDbusMessageFlagsNoAutoStartValue
+	}
+	if rest&GDbusMessageFlagsAllowInteractiveAuthorizationValue != 0 && x&GDbusMessageFlagsAllowInteractiveAuthorizationValue == GDbusMessageFlagsAllowInteractiveAuthorizationValue {
+		names = append(names, "G_DBUS_MESSAGE_FLAGS_ALLOW_INTERACTIVE_AUTHORIZATION")
+		rest &^= GDbusMessageFlagsAllowInteractiveAuthorizationValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when constructing a [DBusObjectManagerClient].
+type DBusObjectManagerClientFlags uint32
 
 var xDBusObjectManagerClientFlagsGLibType func() types.GType
 
@@ -318,8 +841,45 @@ const (
 	GDbusObjectManagerClientFlagsDoNotAutoStartValue DBusObjectManagerClientFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusObjectManagerClientFlags) GLibType() types.GType {
+	return xDBusObjectManagerClientFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusObjectManagerClientFlags) Has(flags DBusObjectManagerClientFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusObjectManagerClientFlags) Set(flags DBusObjectManagerClientFlags) DBusObjectManagerClientFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusObjectManagerClientFlags) Clear(flags DBusObjectManagerClientFlags) DBusObjectManagerClientFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusObjectManagerClientFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_OBJECT_MANAGER_CLIENT_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusObjectManagerClientFlagsDoNotAutoStartValue != 0 && x&GDbusObjectManagerClientFlagsDoNotAutoStartValue == GDbusObjectManagerClientFlagsDoNotAutoStartValue {
+		names = append(names, "G_DBUS_OBJECT_MANAGER_CLIENT_FLAGS_DO_NOT_AUTO_START")
+		rest &^= GDbusObjectManagerClientFlagsDoNotAutoStartValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags describing the access control of a D-Bus property.
-type DBusPropertyInfoFlags int
+type DBusPropertyInfoFlags uint32
 
 var xDBusPropertyInfoFlagsGLibType func() types.GType
 
@@ -337,8 +897,49 @@ const (
 	GDbusPropertyInfoFlagsWritableValue DBusPropertyInfoFlags = 2
 )
 
-// Flags used when constructing an instance of a #GDBusProxy derived class.
-type DBusProxyFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusPropertyInfoFlags) GLibType() types.GType {
+	return xDBusPropertyInfoFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusPropertyInfoFlags) Has(flags DBusPropertyInfoFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusPropertyInfoFlags) Set(flags DBusPropertyInfoFlags) DBusPropertyInfoFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusPropertyInfoFlags) Clear(flags DBusPropertyInfoFlags) DBusPropertyInfoFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusPropertyInfoFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_PROPERTY_INFO_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusPropertyInfoFlagsReadableValue != 0 && x&GDbusPropertyInfoFlagsReadableValue == GDbusPropertyInfoFlagsReadableValue {
+		names = append(names, "G_DBUS_PROPERTY_INFO_FLAGS_READABLE")
+		rest &^= GDbusPropertyInfoFlagsReadableValue
+	}
+	if rest&GDbusPropertyInfoFlagsWritableValue != 0 && x&GDbusPropertyInfoFlagsWritableValue == GDbusPropertyInfoFlagsWritableValue {
+		names = append(names, "G_DBUS_PROPERTY_INFO_FLAGS_WRITABLE")
+		rest &^= GDbusPropertyInfoFlagsWritableValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when constructing an instance of a [DBusProxy] derived class.
+type DBusProxyFlags uint32
 
 var xDBusProxyFlagsGLibType func() types.GType
 
@@ -358,12 +959,14 @@ const (
 	// do not ask the bus to launch an owner during proxy initialization or a method call.
 	// This flag is only meaningful in proxies for well-known names.
 	GDbusProxyFlagsDoNotAutoStartValue DBusProxyFlags = 4
-	// If set, the property value for any __invalidated property__ will be (asynchronously) retrieved upon receiving the [`PropertiesChanged`](http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties) D-Bus signal and the property will not cause emission of the #GDBusProxy::g-properties-changed signal. When the value is received the #GDBusProxy::g-properties-changed signal is emitted for the property along with the retrieved value. Since 2.32.
+	// If set, the property value for any __invalidated property__ will be (asynchronously) retrieved upon receiving the [PropertiesChanged] D-Bus signal and the property will not cause emission of the [DBusProxy.ConnectGPropertiesChangedFunc] signal. When the value is received the [DBusProxy.ConnectGPropertiesChangedFunc] signal is emitted for the property along with the retrieved value. Since 2.32.
+	//
+	// [PropertiesChanged]: http://dbus.freedesktop.org/doc/dbus-specification.html#standard-interfaces-properties
 	GDbusProxyFlagsGetInvalidatedPropertiesValue DBusProxyFlags = 8
 	// If the proxy is for a well-known name,
 	// do not ask the bus to launch an owner during proxy initialization, but allow it to be
 	// autostarted by a method call. This flag is only meaningful in proxies for well-known names,
-	// and only if %G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START is not also specified.
+	// and only if [GDbusProxyFlagsDoNotAutoStartValue] is not also specified.
 	GDbusProxyFlagsDoNotAutoStartAtConstructionValue DBusProxyFlags = 16
 	// Don't actually send the AddMatch D-Bus
 	//    call for this signal subscription. This gives you more control
@@ -371,8 +974,65 @@ const (
 	GDbusProxyFlagsNoMatchRuleValue DBusProxyFlags = 32
 )
 
-// Flags used when sending #GDBusMessages on a #GDBusConnection.
-type DBusSendMessageFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusProxyFlags) GLibType() types.GType {
+	return xDBusProxyFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusProxyFlags) Has(flags DBusProxyFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusProxyFlags) Set(flags DBusProxyFlags) DBusProxyFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusProxyFlags) Clear(flags DBusProxyFlags) DBusProxyFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusProxyFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_PROXY_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusProxyFlagsDoNotLoadPropertiesValue != 0 && x&GDbusProxyFlagsDoNotLoadPropertiesValue == GDbusProxyFlagsDoNotLoadPropertiesValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_DO_NOT_LOAD_PROPERTIES")
+		rest &^= GDbusProxyFlagsDoNotLoadPropertiesValue
+	}
+	if rest&GDbusProxyFlagsDoNotConnectSignalsValue != 0 && x&GDbusProxyFlagsDoNotConnectSignalsValue == GDbusProxyFlagsDoNotConnectSignalsValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_DO_NOT_CONNECT_SIGNALS")
+		rest &^= GDbusProxyFlagsDoNotConnectSignalsValue
+	}
+	if rest&GDbusProxyFlagsDoNotAutoStartValue != 0 && x&GDbusProxyFlagsDoNotAutoStartValue == GDbusProxyFlagsDoNotAutoStartValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START")
+		rest &^= GDbusProxyFlagsDoNotAutoStartValue
+	}
+	if rest&GDbusProxyFlagsGetInvalidatedPropertiesValue != 0 && x&GDbusProxyFlagsGetInvalidatedPropertiesValue == GDbusProxyFlagsGetInvalidatedPropertiesValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_GET_INVALIDATED_PROPERTIES")
+		rest &^= GDbusProxyFlagsGetInvalidatedPropertiesValue
+	}
+	if rest&GDbusProxyFlagsDoNotAutoStartAtConstructionValue != 0 && x&GDbusProxyFlagsDoNotAutoStartAtConstructionValue == GDbusProxyFlagsDoNotAutoStartAtConstructionValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_DO_NOT_AUTO_START_AT_CONSTRUCTION")
+		rest &^= GDbusProxyFlagsDoNotAutoStartAtConstructionValue
+	}
+	if rest&GDbusProxyFlagsNoMatchRuleValue != 0 && x&GDbusProxyFlagsNoMatchRuleValue == GDbusProxyFlagsNoMatchRuleValue {
+		names = append(names, "G_DBUS_PROXY_FLAGS_NO_MATCH_RULE")
+		rest &^= GDbusProxyFlagsNoMatchRuleValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when sending GDBusMessages on a [DBusConnection].
+type DBusSendMessageFlags uint32
 
 var xDBusSendMessageFlagsGLibType func() types.GType
 
@@ -385,13 +1045,50 @@ const (
 	// No flags set.
 	GDbusSendMessageFlagsNoneValue DBusSendMessageFlags = 0
 	// Do not automatically
-	// assign a serial number from the #GDBusConnection object when
+	// assign a serial number from the [DBusConnection] object when
 	// sending a message.
 	GDbusSendMessageFlagsPreserveSerialValue DBusSendMessageFlags = 1
 )
 
-// Flags used when creating a #GDBusServer.
-type DBusServerFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusSendMessageFlags) GLibType() types.GType {
+	return xDBusSendMessageFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusSendMessageFlags) Has(flags DBusSendMessageFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusSendMessageFlags) Set(flags DBusSendMessageFlags) DBusSendMessageFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusSendMessageFlags) Clear(flags DBusSendMessageFlags) DBusSendMessageFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusSendMessageFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_SEND_MESSAGE_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusSendMessageFlagsPreserveSerialValue != 0 && x&GDbusSendMessageFlagsPreserveSerialValue == GDbusSendMessageFlagsPreserveSerialValue {
+		names = append(names, "G_DBUS_SEND_MESSAGE_FLAGS_PRESERVE_SERIAL")
+		rest &^= GDbusSendMessageFlagsPreserveSerialValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when creating a [DBusServer].
+type DBusServerFlags uint32
 
 var xDBusServerFlagsGLibType func() types.GType
 
@@ -403,7 +1100,7 @@ const (
 
 	// No flags set.
 	GDbusServerFlagsNoneValue DBusServerFlags = 0
-	// All #GDBusServer::new-connection
+	// All [DBusServer.ConnectNewConnectionFunc]
 	// signals will run in separated dedicated threads (see signal for
 	// details).
 	GDbusServerFlagsRunInThreadValue DBusServerFlags = 1
@@ -415,8 +1112,53 @@ const (
 	GDbusServerFlagsAuthenticationRequireSameUserValue DBusServerFlags = 4
 )
 
-// Flags used when subscribing to signals via g_dbus_connection_signal_subscribe().
-type DBusSignalFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusServerFlags) GLibType() types.GType {
+	return xDBusServerFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusServerFlags) Has(flags DBusServerFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusServerFlags) Set(flags DBusServerFlags) DBusServerFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusServerFlags) Clear(flags DBusServerFlags) DBusServerFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusServerFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_SERVER_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusServerFlagsRunInThreadValue != 0 && x&GDbusServerFlagsRunInThreadValue == GDbusServerFlagsRunInThreadValue {
+		names = append(names, "G_DBUS_SERVER_FLAGS_RUN_IN_THREAD")
+		rest &^= GDbusServerFlagsRunInThreadValue
+	}
+	if rest&GDbusServerFlagsAuthenticationAllowAnonymousValue != 0 && x&GDbusServerFlagsAuthenticationAllowAnonymousValue == GDbusServerFlagsAuthenticationAllowAnonymousValue {
+		names = append(names, "G_DBUS_SERVER_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS")
+		rest &^= GDbusServerFlagsAuthenticationAllowAnonymousValue
+	}
+	if rest&GDbusServerFlagsAuthenticationRequireSameUserValue != 0 && x&GDbusServerFlagsAuthenticationRequireSameUserValue == GDbusServerFlagsAuthenticationRequireSameUserValue {
+		names = append(names, "G_DBUS_SERVER_FLAGS_AUTHENTICATION_REQUIRE_SAME_USER")
+		rest &^= GDbusServerFlagsAuthenticationRequireSameUserValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when subscribing to signals via [DBusConnection.SignalSubscribe].
+type DBusSignalFlags uint32
 
 var xDBusSignalFlagsGLibType func() types.GType
 
@@ -441,8 +1183,53 @@ const (
 	GDbusSignalFlagsMatchArg0PathValue DBusSignalFlags = 4
 )
 
-// Flags passed to g_dbus_connection_register_subtree().
-type DBusSubtreeFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusSignalFlags) GLibType() types.GType {
+	return xDBusSignalFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusSignalFlags) Has(flags DBusSignalFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusSignalFlags) Set(flags DBusSignalFlags) DBusSignalFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusSignalFlags) Clear(flags DBusSignalFlags) DBusSignalFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusSignalFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_SIGNAL_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusSignalFlagsNoMatchRuleValue != 0 && x&GDbusSignalFlagsNoMatchRuleValue == GDbusSignalFlagsNoMatchRuleValue {
+		names = append(names, "G_DBUS_SIGNAL_FLAGS_NO_MATCH_RULE")
+		rest &^= GDbusSignalFlagsNoMatchRuleValue
+	}
+	if rest&GDbusSignalFlagsMatchArg0NamespaceValue != 0 && x&GDbusSignalFlagsMatchArg0NamespaceValue == GDbusSignalFlagsMatchArg0NamespaceValue {
+		names = append(names, "G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE")
+		rest &^= GDbusSignalFlagsMatchArg0NamespaceValue
+	}
+	if rest&GDbusSignalFlagsMatchArg0PathValue != 0 && x&GDbusSignalFlagsMatchArg0PathValue == GDbusSignalFlagsMatchArg0PathValue {
+		names = append(names, "G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH")
+		rest &^= GDbusSignalFlagsMatchArg0PathValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags passed to [DBusConnection.RegisterSubtree].
+type DBusSubtreeFlags uint32
 
 var xDBusSubtreeFlagsGLibType func() types.GType
 
@@ -460,8 +1247,45 @@ const (
 	GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue DBusSubtreeFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DBusSubtreeFlags) GLibType() types.GType {
+	return xDBusSubtreeFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DBusSubtreeFlags) Has(flags DBusSubtreeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DBusSubtreeFlags) Set(flags DBusSubtreeFlags) DBusSubtreeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DBusSubtreeFlags) Clear(flags DBusSubtreeFlags) DBusSubtreeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DBusSubtreeFlags) String() string {
+	if x == 0 {
+		return "G_DBUS_SUBTREE_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue != 0 && x&GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue == GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue {
+		names = append(names, "G_DBUS_SUBTREE_FLAGS_DISPATCH_TO_UNENUMERATED_NODES")
+		rest &^= GDbusSubtreeFlagsDispatchToUnenumeratedNodesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags used when starting a drive.
-type DriveStartFlags int
+type DriveStartFlags uint32
 
 var xDriveStartFlagsGLibType func() types.GType
 
@@ -475,8 +1299,41 @@ const (
 	GDriveStartNoneValue DriveStartFlags = 0
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DriveStartFlags) GLibType() types.GType {
+	return xDriveStartFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DriveStartFlags) Has(flags DriveStartFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DriveStartFlags) Set(flags DriveStartFlags) DriveStartFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DriveStartFlags) Clear(flags DriveStartFlags) DriveStartFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DriveStartFlags) String() string {
+	if x == 0 {
+		return "G_DRIVE_START_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags specifying the behaviour of an attribute.
-type FileAttributeInfoFlags int
+type FileAttributeInfoFlags uint32
 
 var xFileAttributeInfoFlagsGLibType func() types.GType
 
@@ -494,8 +1351,49 @@ const (
 	GFileAttributeInfoCopyWhenMovedValue FileAttributeInfoFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileAttributeInfoFlags) GLibType() types.GType {
+	return xFileAttributeInfoFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileAttributeInfoFlags) Has(flags FileAttributeInfoFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileAttributeInfoFlags) Set(flags FileAttributeInfoFlags) FileAttributeInfoFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileAttributeInfoFlags) Clear(flags FileAttributeInfoFlags) FileAttributeInfoFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileAttributeInfoFlags) String() string {
+	if x == 0 {
+		return "G_FILE_ATTRIBUTE_INFO_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileAttributeInfoCopyWithFileValue != 0 && x&GFileAttributeInfoCopyWithFileValue == GFileAttributeInfoCopyWithFileValue {
+		names = append(names, "G_FILE_ATTRIBUTE_INFO_COPY_WITH_FILE")
+		rest &^= GFileAttributeInfoCopyWithFileValue
+	}
+	if rest&GFileAttributeInfoCopyWhenMovedValue != 0 && x&GFileAttributeInfoCopyWhenMovedValue == GFileAttributeInfoCopyWhenMovedValue {
+		names = append(names, "G_FILE_ATTRIBUTE_INFO_COPY_WHEN_MOVED")
+		rest &^= GFileAttributeInfoCopyWhenMovedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags used when copying or moving files.
-type FileCopyFlags int
+type FileCopyFlags uint32
 
 var xFileCopyFlagsGLibType func() types.GType
 
@@ -513,7 +1411,7 @@ const (
 	GFileCopyBackupValue FileCopyFlags = 2
 	// Don't follow symlinks.
 	GFileCopyNofollowSymlinksValue FileCopyFlags = 4
-	// Copy all file metadata instead of just default set used for copy (see #GFileInfo).
+	// Copy all file metadata instead of just default set used for copy (see [FileInfo]).
 	GFileCopyAllMetadataValue FileCopyFlags = 8
 	// Don't use copy and delete fallback if native move not supported.
 	GFileCopyNoFallbackForMoveValue FileCopyFlags = 16
@@ -524,8 +1422,69 @@ const (
 	GFileCopyTargetDefaultModifiedTimeValue FileCopyFlags = 64
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileCopyFlags) GLibType() types.GType {
+	return xFileCopyFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileCopyFlags) Has(flags FileCopyFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileCopyFlags) Set(flags FileCopyFlags) FileCopyFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileCopyFlags) Clear(flags FileCopyFlags) FileCopyFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileCopyFlags) String() string {
+	if x == 0 {
+		return "G_FILE_COPY_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileCopyOverwriteValue != 0 && x&GFileCopyOverwriteValue == GFileCopyOverwriteValue {
+		names = append(names, "G_FILE_COPY_OVERWRITE")
+		rest &^= GFileCopyOverwriteValue
+	}
+	if rest&GFileCopyBackupValue != 0 && x&GFileCopyBackupValue == GFileCopyBackupValue {
+		names = append(names, "G_FILE_COPY_BACKUP")
+		rest &^= GFileCopyBackupValue
+	}
+	if rest&GFileCopyNofollowSymlinksValue != 0 && x&GFileCopyNofollowSymlinksValue == GFileCopyNofollowSymlinksValue {
+		names = append(names, "G_FILE_COPY_NOFOLLOW_SYMLINKS")
+		rest &^= GFileCopyNofollowSymlinksValue
+	}
+	if rest&GFileCopyAllMetadataValue != 0 && x&GFileCopyAllMetadataValue == GFileCopyAllMetadataValue {
+		names = append(names, "G_FILE_COPY_ALL_METADATA")
+		rest &^= GFileCopyAllMetadataValue
+	}
+	if rest&GFileCopyNoFallbackForMoveValue != 0 && x&GFileCopyNoFallbackForMoveValue == GFileCopyNoFallbackForMoveValue {
+		names = append(names, "G_FILE_COPY_NO_FALLBACK_FOR_MOVE")
+		rest &^= GFileCopyNoFallbackForMoveValue
+	}
+	if rest&GFileCopyTargetDefaultPermsValue != 0 && x&GFileCopyTargetDefaultPermsValue == GFileCopyTargetDefaultPermsValue {
+		names = append(names, "G_FILE_COPY_TARGET_DEFAULT_PERMS")
+		rest &^= GFileCopyTargetDefaultPermsValue
+	}
+	if rest&GFileCopyTargetDefaultModifiedTimeValue != 0 && x&GFileCopyTargetDefaultModifiedTimeValue == GFileCopyTargetDefaultModifiedTimeValue {
+		names = append(names, "G_FILE_COPY_TARGET_DEFAULT_MODIFIED_TIME")
+		rest &^= GFileCopyTargetDefaultModifiedTimeValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags used when an operation may create a file.
-type FileCreateFlags int
+type FileCreateFlags uint32
 
 var xFileCreateFlagsGLibType func() types.GType
 
@@ -548,13 +1507,54 @@ const (
 	//    You can think of it as "unlink destination" before
 	//    writing to it, although the implementation may not
 	//    be exactly like that. This flag can only be used with
-	//    g_file_replace() and its variants, including g_file_replace_contents().
+	//    [File.Replace] and its variants, including [File.ReplaceContents].
 	//    Since 2.20
 	GFileCreateReplaceDestinationValue FileCreateFlags = 2
 )
 
-// Flags that can be used with g_file_measure_disk_usage().
-type FileMeasureFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileCreateFlags) GLibType() types.GType {
+	return xFileCreateFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileCreateFlags) Has(flags FileCreateFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileCreateFlags) Set(flags FileCreateFlags) FileCreateFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileCreateFlags) Clear(flags FileCreateFlags) FileCreateFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileCreateFlags) String() string {
+	if x == 0 {
+		return "G_FILE_CREATE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileCreatePrivateValue != 0 && x&GFileCreatePrivateValue == GFileCreatePrivateValue {
+		names = append(names, "G_FILE_CREATE_PRIVATE")
+		rest &^= GFileCreatePrivateValue
+	}
+	if rest&GFileCreateReplaceDestinationValue != 0 && x&GFileCreateReplaceDestinationValue == GFileCreateReplaceDestinationValue {
+		names = append(names, "G_FILE_CREATE_REPLACE_DESTINATION")
+		rest &^= GFileCreateReplaceDestinationValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags that can be used with [File.MeasureDiskUsage].
+type FileMeasureFlags uint32
 
 var xFileMeasureFlagsGLibType func() types.GType
 
@@ -583,8 +1583,53 @@ const (
 	GFileMeasureNoXdevValue FileMeasureFlags = 8
 )
 
-// Flags used to set what a #GFileMonitor will watch for.
-type FileMonitorFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileMeasureFlags) GLibType() types.GType {
+	return xFileMeasureFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileMeasureFlags) Has(flags FileMeasureFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileMeasureFlags) Set(flags FileMeasureFlags) FileMeasureFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileMeasureFlags) Clear(flags FileMeasureFlags) FileMeasureFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileMeasureFlags) String() string {
+	if x == 0 {
+		return "G_FILE_MEASURE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileMeasureReportAnyErrorValue != 0 && x&GFileMeasureReportAnyErrorValue == GFileMeasureReportAnyErrorValue {
+		names = append(names, "G_FILE_MEASURE_REPORT_ANY_ERROR")
+		rest &^= GFileMeasureReportAnyErrorValue
+	}
+	if rest&GFileMeasureApparentSizeValue != 0 && x&GFileMeasureApparentSizeValue == GFileMeasureApparentSizeValue {
+		names = append(names, "G_FILE_MEASURE_APPARENT_SIZE")
+		rest &^= GFileMeasureApparentSizeValue
+	}
+	if rest&GFileMeasureNoXdevValue != 0 && x&GFileMeasureNoXdevValue == GFileMeasureNoXdevValue {
+		names = append(names, "G_FILE_MEASURE_NO_XDEV")
+		rest &^= GFileMeasureNoXdevValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used to set what a [FileMonitor] will watch for.
+type FileMonitorFlags uint32
 
 var xFileMonitorFlagsGLibType func() types.GType
 
@@ -603,37 +1648,123 @@ const (
 	//   event instead (NB: not supported on all backends; the default
 	//   behaviour -without specifying this flag- is to send single DELETED
 	//   and CREATED events).  Deprecated since 2.46: use
-	//   %G_FILE_MONITOR_WATCH_MOVES instead.
+	//   [GFileMonitorWatchMovesValue] instead.
 	GFileMonitorSendMovedValue FileMonitorFlags = 2
 	// Watch for changes to the file made
 	//   via another hard link. Since 2.36.
 	GFileMonitorWatchHardLinksValue FileMonitorFlags = 4
 	// Watch for rename operations on a
-	//   monitored directory.  This causes %G_FILE_MONITOR_EVENT_RENAMED,
-	//   %G_FILE_MONITOR_EVENT_MOVED_IN and %G_FILE_MONITOR_EVENT_MOVED_OUT
+	//   monitored directory.  This causes [GFileMonitorEventRenamedValue],
+	//   [GFileMonitorEventMovedInValue] and [GFileMonitorEventMovedOutValue]
 	//   events to be emitted when possible.  Since: 2.46.
 	GFileMonitorWatchMovesValue FileMonitorFlags = 8
 )
 
-// Flags used when querying a #GFileInfo.
-type FileQueryInfoFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileMonitorFlags) GLibType() types.GType {
+	return xFileMonitorFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileMonitorFlags) Has(flags FileMonitorFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileMonitorFlags) Set(flags FileMonitorFlags) FileMonitorFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileMonitorFlags) Clear(flags FileMonitorFlags) FileMonitorFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileMonitorFlags) String() string {
+	if x == 0 {
+		return "G_FILE_MONITOR_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileMonitorWatchMountsValue != 0 && x&GFileMonitorWatchMountsValue == GFileMonitorWatchMountsValue {
+		names = append(names, "G_FILE_MONITOR_WATCH_MOUNTS")
+		rest &^= GFileMonitorWatchMountsValue
+	}
+	if rest&GFileMonitorSendMovedValue != 0 && x&GFileMonitorSendMovedValue == GFileMonitorSendMovedValue {
+		names = append(names, "G_FILE_MONITOR_SEND_MOVED")
+		rest &^= GFileMonitorSendMovedValue
+	}
+	if rest&GFileMonitorWatchHardLinksValue != 0 && x&GFileMonitorWatchHardLinksValue == GFileMonitorWatchHardLinksValue {
+		names = append(names, "G_FILE_MONITOR_WATCH_HARD_LINKS")
+		rest &^= GFileMonitorWatchHardLinksValue
+	}
+	if rest&GFileMonitorWatchMovesValue != 0 && x&GFileMonitorWatchMovesValue == GFileMonitorWatchMovesValue {
+		names = append(names, "G_FILE_MONITOR_WATCH_MOVES")
+		rest &^= GFileMonitorWatchMovesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used when querying a [FileInfo].
+type FileQueryInfoFlags uint32
 
 var xFileQueryInfoFlagsGLibType func() types.GType
 
-func FileQueryInfoFlagsGLibType() types.GType {
-	return xFileQueryInfoFlagsGLibType()
+func FileQueryInfoFlagsGLibType() types.GType {
+	return xFileQueryInfoFlagsGLibType()
+}
+
+const (
+
+	// No flags set.
+	GFileQueryInfoNoneValue FileQueryInfoFlags = 0
+	// Don't follow symlinks.
+	GFileQueryInfoNofollowSymlinksValue FileQueryInfoFlags = 1
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FileQueryInfoFlags) GLibType() types.GType {
+	return xFileQueryInfoFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FileQueryInfoFlags) Has(flags FileQueryInfoFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileQueryInfoFlags) Set(flags FileQueryInfoFlags) FileQueryInfoFlags {
+	return x | flags
 }
 
-const (
+// Clear returns x with the bits of flags cleared
+func (x FileQueryInfoFlags) Clear(flags FileQueryInfoFlags) FileQueryInfoFlags {
+	return x &^ flags
+}
 
-	// No flags set.
-	GFileQueryInfoNoneValue FileQueryInfoFlags = 0
-	// Don't follow symlinks.
-	GFileQueryInfoNofollowSymlinksValue FileQueryInfoFlags = 1
-)
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileQueryInfoFlags) String() string {
+	if x == 0 {
+		return "G_FILE_QUERY_INFO_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileQueryInfoNofollowSymlinksValue != 0 && x&GFileQueryInfoNofollowSymlinksValue == GFileQueryInfoNofollowSymlinksValue {
+		names = append(names, "G_FILE_QUERY_INFO_NOFOLLOW_SYMLINKS")
+		rest &^= GFileQueryInfoNofollowSymlinksValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
 
 // GIOStreamSpliceFlags determine how streams should be spliced.
-type IOStreamSpliceFlags int
+type IOStreamSpliceFlags uint32
 
 var xIOStreamSpliceFlagsGLibType func() types.GType
 
@@ -656,8 +1787,53 @@ const (
 	GIoStreamSpliceWaitForBothValue IOStreamSpliceFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (IOStreamSpliceFlags) GLibType() types.GType {
+	return xIOStreamSpliceFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x IOStreamSpliceFlags) Has(flags IOStreamSpliceFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x IOStreamSpliceFlags) Set(flags IOStreamSpliceFlags) IOStreamSpliceFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x IOStreamSpliceFlags) Clear(flags IOStreamSpliceFlags) IOStreamSpliceFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x IOStreamSpliceFlags) String() string {
+	if x == 0 {
+		return "G_IO_STREAM_SPLICE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GIoStreamSpliceCloseStream1Value != 0 && x&GIoStreamSpliceCloseStream1Value == GIoStreamSpliceCloseStream1Value {
+		names = append(names, "G_IO_STREAM_SPLICE_CLOSE_STREAM1")
+		rest &^= GIoStreamSpliceCloseStream1Value
+	}
+	if rest&GIoStreamSpliceCloseStream2Value != 0 && x&GIoStreamSpliceCloseStream2Value == GIoStreamSpliceCloseStream2Value {
+		names = append(names, "G_IO_STREAM_SPLICE_CLOSE_STREAM2")
+		rest &^= GIoStreamSpliceCloseStream2Value
+	}
+	if rest&GIoStreamSpliceWaitForBothValue != 0 && x&GIoStreamSpliceWaitForBothValue == GIoStreamSpliceWaitForBothValue {
+		names = append(names, "G_IO_STREAM_SPLICE_WAIT_FOR_BOTH")
+		rest &^= GIoStreamSpliceWaitForBothValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags used when mounting a mount.
-type MountMountFlags int
+type MountMountFlags uint32
 
 var xMountMountFlagsGLibType func() types.GType
 
@@ -671,8 +1847,41 @@ const (
 	GMountMountNoneValue MountMountFlags = 0
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (MountMountFlags) GLibType() types.GType {
+	return xMountMountFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x MountMountFlags) Has(flags MountMountFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x MountMountFlags) Set(flags MountMountFlags) MountMountFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x MountMountFlags) Clear(flags MountMountFlags) MountMountFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x MountMountFlags) String() string {
+	if x == 0 {
+		return "G_MOUNT_MOUNT_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags used when an unmounting a mount.
-type MountUnmountFlags int
+type MountUnmountFlags uint32
 
 var xMountUnmountFlagsGLibType func() types.GType
 
@@ -689,8 +1898,45 @@ const (
 	GMountUnmountForceValue MountUnmountFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (MountUnmountFlags) GLibType() types.GType {
+	return xMountUnmountFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x MountUnmountFlags) Has(flags MountUnmountFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x MountUnmountFlags) Set(flags MountUnmountFlags) MountUnmountFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x MountUnmountFlags) Clear(flags MountUnmountFlags) MountUnmountFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x MountUnmountFlags) String() string {
+	if x == 0 {
+		return "G_MOUNT_UNMOUNT_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GMountUnmountForceValue != 0 && x&GMountUnmountForceValue == GMountUnmountForceValue {
+		names = append(names, "G_MOUNT_UNMOUNT_FORCE")
+		rest &^= GMountUnmountForceValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // GOutputStreamSpliceFlags determine how streams should be spliced.
-type OutputStreamSpliceFlags int
+type OutputStreamSpliceFlags uint32
 
 var xOutputStreamSpliceFlagsGLibType func() types.GType
 
@@ -710,9 +1956,50 @@ const (
 	GOutputStreamSpliceCloseTargetValue OutputStreamSpliceFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (OutputStreamSpliceFlags) GLibType() types.GType {
+	return xOutputStreamSpliceFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x OutputStreamSpliceFlags) Has(flags OutputStreamSpliceFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x OutputStreamSpliceFlags) Set(flags OutputStreamSpliceFlags) OutputStreamSpliceFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x OutputStreamSpliceFlags) Clear(flags OutputStreamSpliceFlags) OutputStreamSpliceFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x OutputStreamSpliceFlags) String() string {
+	if x == 0 {
+		return "G_OUTPUT_STREAM_SPLICE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GOutputStreamSpliceCloseSourceValue != 0 && x&GOutputStreamSpliceCloseSourceValue == GOutputStreamSpliceCloseSourceValue {
+		names = append(names, "G_OUTPUT_STREAM_SPLICE_CLOSE_SOURCE")
+		rest &^= GOutputStreamSpliceCloseSourceValue
+	}
+	if rest&GOutputStreamSpliceCloseTargetValue != 0 && x&GOutputStreamSpliceCloseTargetValue == GOutputStreamSpliceCloseTargetValue {
+		names = append(names, "G_OUTPUT_STREAM_SPLICE_CLOSE_TARGET")
+		rest &^= GOutputStreamSpliceCloseTargetValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // GResourceFlags give information about a particular file inside a resource
 // bundle.
-type ResourceFlags int
+type ResourceFlags uint32
 
 var xResourceFlagsGLibType func() types.GType
 
@@ -728,8 +2015,45 @@ const (
 	GResourceFlagsCompressedValue ResourceFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ResourceFlags) GLibType() types.GType {
+	return xResourceFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ResourceFlags) Has(flags ResourceFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ResourceFlags) Set(flags ResourceFlags) ResourceFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ResourceFlags) Clear(flags ResourceFlags) ResourceFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ResourceFlags) String() string {
+	if x == 0 {
+		return "G_RESOURCE_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GResourceFlagsCompressedValue != 0 && x&GResourceFlagsCompressedValue == GResourceFlagsCompressedValue {
+		names = append(names, "G_RESOURCE_FLAGS_COMPRESSED")
+		rest &^= GResourceFlagsCompressedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // GResourceLookupFlags determine how resource path lookups are handled.
-type ResourceLookupFlags int
+type ResourceLookupFlags uint32
 
 var xResourceLookupFlagsGLibType func() types.GType
 
@@ -743,12 +2067,45 @@ const (
 	GResourceLookupFlagsNoneValue ResourceLookupFlags = 0
 )
 
-// Flags used in g_socket_receive_message() and g_socket_send_message().
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ResourceLookupFlags) GLibType() types.GType {
+	return xResourceLookupFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ResourceLookupFlags) Has(flags ResourceLookupFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ResourceLookupFlags) Set(flags ResourceLookupFlags) ResourceLookupFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ResourceLookupFlags) Clear(flags ResourceLookupFlags) ResourceLookupFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ResourceLookupFlags) String() string {
+	if x == 0 {
+		return "G_RESOURCE_LOOKUP_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags used in [Socket.ReceiveMessage] and [Socket.SendMessage].
 // The flags listed in the enum are some commonly available flags, but the
 // values used for them are the same as on the platform, and any other flags
 // are passed in/out as is. So to use a platform specific flag, just include
 // the right system header and pass in the flag.
-type SocketMsgFlags int
+type SocketMsgFlags uint32
 
 var xSocketMsgFlagsGLibType func() types.GType
 
@@ -770,16 +2127,61 @@ const (
 	GSocketMsgDontrouteValue SocketMsgFlags = 4
 )
 
-// Flags to define the behaviour of a #GSubprocess.
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SocketMsgFlags) GLibType() types.GType {
+	return xSocketMsgFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SocketMsgFlags) Has(flags SocketMsgFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SocketMsgFlags) Set(flags SocketMsgFlags) SocketMsgFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SocketMsgFlags) Clear(flags SocketMsgFlags) SocketMsgFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SocketMsgFlags) String() string {
+	if x == 0 {
+		return "G_SOCKET_MSG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GSocketMsgOobValue != 0 && x&GSocketMsgOobValue == GSocketMsgOobValue {
+		names = append(names, "G_SOCKET_MSG_OOB")
+		rest &^= GSocketMsgOobValue
+	}
+	if rest&GSocketMsgPeekValue != 0 && x&GSocketMsgPeekValue == GSocketMsgPeekValue {
+		names = append(names, "G_SOCKET_MSG_PEEK")
+		rest &^= GSocketMsgPeekValue
+	}
+	if rest&GSocketMsgDontrouteValue != 0 && x&GSocketMsgDontrouteValue == GSocketMsgDontrouteValue {
+		names = append(names, "G_SOCKET_MSG_DONTROUTE")
+		rest &^= GSocketMsgDontrouteValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags to define the behaviour of a [Subprocess].
 //
 // Note that the default for stdin is to redirect from `/dev/null`.  For
 // stdout and stderr the default are for them to inherit the
 // corresponding descriptor from the calling process.
 //
 // Note that it is a programmer error to mix 'incompatible' flags.  For
-// example, you may not request both %G_SUBPROCESS_FLAGS_STDOUT_PIPE and
-// %G_SUBPROCESS_FLAGS_STDOUT_SILENCE.
-type SubprocessFlags int
+// example, you may not request both [GSubprocessFlagsStdoutPipeValue] and
+// [GSubprocessFlagsStdoutSilenceValue].
+type SubprocessFlags uint32
 
 var xSubprocessFlagsGLibType func() types.GType
 
@@ -793,21 +2195,21 @@ const (
 	GSubprocessFlagsNoneValue SubprocessFlags = 0
 	// create a pipe for the stdin of the
 	//   spawned process that can be accessed with
-	//   g_subprocess_get_stdin_pipe().
+	//   [Subprocess.GetStdinPipe].
 	GSubprocessFlagsStdinPipeValue SubprocessFlags = 1
 	// stdin is inherited from the
 	//   calling process.
 	GSubprocessFlagsStdinInheritValue SubprocessFlags = 2
 	// create a pipe for the stdout of the
 	//   spawned process that can be accessed with
-	//   g_subprocess_get_stdout_pipe().
+	//   [Subprocess.GetStdoutPipe].
 	GSubprocessFlagsStdoutPipeValue SubprocessFlags = 4
 	// silence the stdout of the spawned
 	//   process (ie: redirect to `/dev/null`).
 	GSubprocessFlagsStdoutSilenceValue SubprocessFlags = 8
 	// create a pipe for the stderr of the
 	//   spawned process that can be accessed with
-	//   g_subprocess_get_stderr_pipe().
+	//   [Subprocess.GetStderrPipe].
 	GSubprocessFlagsStderrPipeValue SubprocessFlags = 16
 	// silence the stderr of the spawned
 	//   process (ie: redirect to `/dev/null`).
@@ -827,8 +2229,77 @@ const (
 	GSubprocessFlagsSearchPathFromEnvpValue SubprocessFlags = 256
 )
 
-// Flags to define future #GTestDBus behaviour.
-type TestDBusFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SubprocessFlags) GLibType() types.GType {
+	return xSubprocessFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SubprocessFlags) Has(flags SubprocessFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SubprocessFlags) Set(flags SubprocessFlags) SubprocessFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SubprocessFlags) Clear(flags SubprocessFlags) SubprocessFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SubprocessFlags) String() string {
+	if x == 0 {
+		return "G_SUBPROCESS_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GSubprocessFlagsStdinPipeValue != 0 && x&GSubprocessFlagsStdinPipeValue == GSubprocessFlagsStdinPipeValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDIN_PIPE")
+		rest &^= GSubprocessFlagsStdinPipeValue
+	}
+	if rest&GSubprocessFlagsStdinInheritValue != 0 && x&GSubprocessFlagsStdinInheritValue == GSubprocessFlagsStdinInheritValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDIN_INHERIT")
+		rest &^= GSubprocessFlagsStdinInheritValue
+	}
+	if rest&GSubprocessFlagsStdoutPipeValue != 0 && x&GSubprocessFlagsStdoutPipeValue == GSubprocessFlagsStdoutPipeValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDOUT_PIPE")
+		rest &^= GSubprocessFlagsStdoutPipeValue
+	}
+	if rest&GSubprocessFlagsStdoutSilenceValue != 0 && x&GSubprocessFlagsStdoutSilenceValue == GSubprocessFlagsStdoutSilenceValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDOUT_SILENCE")
+		rest &^= GSubprocessFlagsStdoutSilenceValue
+	}
+	if rest&GSubprocessFlagsStderrPipeValue != 0 && x&GSubprocessFlagsStderrPipeValue == GSubprocessFlagsStderrPipeValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDERR_PIPE")
+		rest &^= GSubprocessFlagsStderrPipeValue
+	}
+	if rest&GSubprocessFlagsStderrSilenceValue != 0 && x&GSubprocessFlagsStderrSilenceValue == GSubprocessFlagsStderrSilenceValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDERR_SILENCE")
+		rest &^= GSubprocessFlagsStderrSilenceValue
+	}
+	if rest&GSubprocessFlagsStderrMergeValue != 0 && x&GSubprocessFlagsStderrMergeValue == GSubprocessFlagsStderrMergeValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_STDERR_MERGE")
+		rest &^= GSubprocessFlagsStderrMergeValue
+	}
+	if rest&GSubprocessFlagsInheritFdsValue != 0 && x&GSubprocessFlagsInheritFdsValue == GSubprocessFlagsInheritFdsValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_INHERIT_FDS")
+		rest &^= GSubprocessFlagsInheritFdsValue
+	}
+	if rest&GSubprocessFlagsSearchPathFromEnvpValue != 0 && x&GSubprocessFlagsSearchPathFromEnvpValue == GSubprocessFlagsSearchPathFromEnvpValue {
+		names = append(names, "G_SUBPROCESS_FLAGS_SEARCH_PATH_FROM_ENVP")
+		rest &^= GSubprocessFlagsSearchPathFromEnvpValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags to define future [TestDBus] behaviour.
+type TestDBusFlags uint32
 
 var xTestDBusFlagsGLibType func() types.GType
 
@@ -842,18 +2313,51 @@ const (
 	GTestDbusNoneValue TestDBusFlags = 0
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TestDBusFlags) GLibType() types.GType {
+	return xTestDBusFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TestDBusFlags) Has(flags TestDBusFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TestDBusFlags) Set(flags TestDBusFlags) TestDBusFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TestDBusFlags) Clear(flags TestDBusFlags) TestDBusFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TestDBusFlags) String() string {
+	if x == 0 {
+		return "G_TEST_DBUS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // A set of flags describing TLS certification validation. This can be
 // used to describe why a particular certificate was rejected (for
-// example, in #GTlsConnection::accept-certificate).
+// example, in [TlsConnection.ConnectAcceptCertificateFunc]).
 //
 // GLib guarantees that if certificate verification fails, at least one
 // flag will be set, but it does not guarantee that all possible flags
 // will be set. Accordingly, you may not safely decide to ignore any
 // particular type of error. For example, it would be incorrect to mask
-// %G_TLS_CERTIFICATE_EXPIRED if you want to allow expired certificates,
+// [GTlsCertificateExpiredValue] if you want to allow expired certificates,
 // because this could potentially be the only error flag set even if
 // other problems exist with the certificate.
-type TlsCertificateFlags int
+type TlsCertificateFlags uint32
 
 var xTlsCertificateFlagsGLibType func() types.GType
 
@@ -877,7 +2381,7 @@ const (
 	// The certificate has expired
 	GTlsCertificateExpiredValue TlsCertificateFlags = 8
 	// The certificate has been revoked
-	//   according to the #GTlsConnection's certificate revocation list.
+	//   according to the [TlsConnection]'s certificate revocation list.
 	GTlsCertificateRevokedValue TlsCertificateFlags = 16
 	// The certificate's algorithm is
 	//   considered insecure.
@@ -890,8 +2394,73 @@ const (
 	GTlsCertificateValidateAllValue TlsCertificateFlags = 127
 )
 
-// Flags for g_tls_database_verify_chain().
-type TlsDatabaseVerifyFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TlsCertificateFlags) GLibType() types.GType {
+	return xTlsCertificateFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TlsCertificateFlags) Has(flags TlsCertificateFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TlsCertificateFlags) Set(flags TlsCertificateFlags) TlsCertificateFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TlsCertificateFlags) Clear(flags TlsCertificateFlags) TlsCertificateFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TlsCertificateFlags) String() string {
+	if x == 0 {
+		return "G_TLS_CERTIFICATE_NO_FLAGS"
+	}
+	var names []string
+	rest := x
+	if rest&GTlsCertificateUnknownCaValue != 0 && x&GTlsCertificateUnknownCaValue == GTlsCertificateUnknownCaValue {
+		names = append(names, "G_TLS_CERTIFICATE_UNKNOWN_CA")
+		rest &^= GTlsCertificateUnknownCaValue
+	}
+	if rest&GTlsCertificateBadIdentityValue != 0 && x&GTlsCertificateBadIdentityValue == GTlsCertificateBadIdentityValue {
+		names = append(names, "G_TLS_CERTIFICATE_BAD_IDENTITY")
+		rest &^= GTlsCertificateBadIdentityValue
+	}
+	if rest&GTlsCertificateNotActivatedValue != 0 && x&GTlsCertificateNotActivatedValue == GTlsCertificateNotActivatedValue {
+		names = append(names, "G_TLS_CERTIFICATE_NOT_ACTIVATED")
+		rest &^= GTlsCertificateNotActivatedValue
+	}
+	if rest&GTlsCertificateExpiredValue != 0 && x&GTlsCertificateExpiredValue == GTlsCertificateExpiredValue {
+		names = append(names, "G_TLS_CERTIFICATE_EXPIRED")
+		rest &^= GTlsCertificateExpiredValue
+	}
+	if rest&GTlsCertificateRevokedValue != 0 && x&GTlsCertificateRevokedValue == GTlsCertificateRevokedValue {
+		names = append(names, "G_TLS_CERTIFICATE_REVOKED")
+		rest &^= GTlsCertificateRevokedValue
+	}
+	if rest&GTlsCertificateInsecureValue != 0 && x&GTlsCertificateInsecureValue == GTlsCertificateInsecureValue {
+		names = append(names, "G_TLS_CERTIFICATE_INSECURE")
+		rest &^= GTlsCertificateInsecureValue
+	}
+	if rest&GTlsCertificateGenericErrorValue != 0 && x&GTlsCertificateGenericErrorValue == GTlsCertificateGenericErrorValue {
+		names = append(names, "G_TLS_CERTIFICATE_GENERIC_ERROR")
+		rest &^= GTlsCertificateGenericErrorValue
+	}
+	if rest&GTlsCertificateValidateAllValue != 0 && x&GTlsCertificateValidateAllValue == GTlsCertificateValidateAllValue {
+		names = append(names, "G_TLS_CERTIFICATE_VALIDATE_ALL")
+		rest &^= GTlsCertificateValidateAllValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags for [TlsDatabase.VerifyChain].
+type TlsDatabaseVerifyFlags uint32
 
 var xTlsDatabaseVerifyFlagsGLibType func() types.GType
 
@@ -905,8 +2474,41 @@ const (
 	GTlsDatabaseVerifyNoneValue TlsDatabaseVerifyFlags = 0
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TlsDatabaseVerifyFlags) GLibType() types.GType {
+	return xTlsDatabaseVerifyFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TlsDatabaseVerifyFlags) Has(flags TlsDatabaseVerifyFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TlsDatabaseVerifyFlags) Set(flags TlsDatabaseVerifyFlags) TlsDatabaseVerifyFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TlsDatabaseVerifyFlags) Clear(flags TlsDatabaseVerifyFlags) TlsDatabaseVerifyFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TlsDatabaseVerifyFlags) String() string {
+	if x == 0 {
+		return "G_TLS_DATABASE_VERIFY_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Various flags for the password.
-type TlsPasswordFlags int
+type TlsPasswordFlags uint32
 
 var xTlsPasswordFlagsGLibType func() types.GType
 
@@ -937,6 +2539,63 @@ const (
 	GTlsPasswordPkcs11ContextSpecificValue TlsPasswordFlags = 64
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TlsPasswordFlags) GLibType() types.GType {
+	return xTlsPasswordFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TlsPasswordFlags) Has(flags TlsPasswordFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TlsPasswordFlags) Set(flags TlsPasswordFlags) TlsPasswordFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TlsPasswordFlags) Clear(flags TlsPasswordFlags) TlsPasswordFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TlsPasswordFlags) String() string {
+	if x == 0 {
+		return "G_TLS_PASSWORD_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GTlsPasswordRetryValue != 0 && x&GTlsPasswordRetryValue == GTlsPasswordRetryValue {
+		names = append(names, "G_TLS_PASSWORD_RETRY")
+		rest &^= GTlsPasswordRetryValue
+	}
+	if rest&GTlsPasswordManyTriesValue != 0 && x&GTlsPasswordManyTriesValue == GTlsPasswordManyTriesValue {
+		names = append(names, "G_TLS_PASSWORD_MANY_TRIES")
+		rest &^= GTlsPasswordManyTriesValue
+	}
+	if rest&GTlsPasswordFinalTryValue != 0 && x&GTlsPasswordFinalTryValue == GTlsPasswordFinalTryValue {
+		names = append(names, "G_TLS_PASSWORD_FINAL_TRY")
+		rest &^= GTlsPasswordFinalTryValue
+	}
+	if rest&GTlsPasswordPkcs11UserValue != 0 && x&GTlsPasswordPkcs11UserValue == GTlsPasswordPkcs11UserValue {
+		names = append(names, "G_TLS_PASSWORD_PKCS11_USER")
+		rest &^= GTlsPasswordPkcs11UserValue
+	}
+	if rest&GTlsPasswordPkcs11SecurityOfficerValue != 0 && x&GTlsPasswordPkcs11SecurityOfficerValue == GTlsPasswordPkcs11SecurityOfficerValue {
+		names = append(names, "G_TLS_PASSWORD_PKCS11_SECURITY_OFFICER")
+		rest &^= GTlsPasswordPkcs11SecurityOfficerValue
+	}
+	if rest&GTlsPasswordPkcs11ContextSpecificValue != 0 && x&GTlsPasswordPkcs11ContextSpecificValue == GTlsPasswordPkcs11ContextSpecificValue {
+		names = append(names, "G_TLS_PASSWORD_PKCS11_CONTEXT_SPECIFIC")
+		rest &^= GTlsPasswordPkcs11ContextSpecificValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // An enumeration for well-known message buses.
 type BusType int
 
@@ -958,7 +2617,52 @@ const (
 	GBusTypeSessionValue BusType = 2
 )
 
-// Results returned from g_converter_convert().
+// String returns the C name of the value, e.g. for debugging output
+func (x BusType) String() string {
+	switch x {
+	case GBusTypeStarterValue:
+		return "G_BUS_TYPE_STARTER"
+	case GBusTypeNoneValue:
+		return "G_BUS_TYPE_NONE"
+	case GBusTypeSystemValue:
+		return "G_BUS_TYPE_SYSTEM"
+	case GBusTypeSessionValue:
+		return "G_BUS_TYPE_SESSION"
+	}
+	return fmt.Sprintf("BusType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BusType) Nick() string {
+	switch x {
+	case GBusTypeStarterValue:
+		return "starter"
+	case GBusTypeNoneValue:
+		return "none"
+	case GBusTypeSystemValue:
+		return "system"
+	case GBusTypeSessionValue:
+		return "session"
+	}
+	return ""
+}
+
+// BusTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BusTypeFromString(s string) (BusType, bool) {
+	switch s {
+	case "G_BUS_TYPE_STARTER", "starter":
+		return GBusTypeStarterValue, true
+	case "G_BUS_TYPE_NONE", "none":
+		return GBusTypeNoneValue, true
+	case "G_BUS_TYPE_SYSTEM", "system":
+		return GBusTypeSystemValue, true
+	case "G_BUS_TYPE_SESSION", "session":
+		return GBusTypeSessionValue, true
+	}
+	return 0, false
+}
+
+// Results returned from [Converter.Convert].
 type ConverterResult int
 
 var xConverterResultGLibType func() types.GType
@@ -979,6 +2683,51 @@ const (
 	GConverterFlushedValue ConverterResult = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ConverterResult) String() string {
+	switch x {
+	case GConverterErrorValue:
+		return "G_CONVERTER_ERROR"
+	case GConverterConvertedValue:
+		return "G_CONVERTER_CONVERTED"
+	case GConverterFinishedValue:
+		return "G_CONVERTER_FINISHED"
+	case GConverterFlushedValue:
+		return "G_CONVERTER_FLUSHED"
+	}
+	return fmt.Sprintf("ConverterResult(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConverterResult) Nick() string {
+	switch x {
+	case GConverterErrorValue:
+		return "error"
+	case GConverterConvertedValue:
+		return "converted"
+	case GConverterFinishedValue:
+		return "finished"
+	case GConverterFlushedValue:
+		return "flushed"
+	}
+	return ""
+}
+
+// ConverterResultFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConverterResultFromString(s string) (ConverterResult, bool) {
+	switch s {
+	case "G_CONVERTER_ERROR", "error":
+		return GConverterErrorValue, true
+	case "G_CONVERTER_CONVERTED", "converted":
+		return GConverterConvertedValue, true
+	case "G_CONVERTER_FINISHED", "finished":
+		return GConverterFinishedValue, true
+	case "G_CONVERTER_FLUSHED", "flushed":
+		return GConverterFlushedValue, true
+	}
+	return 0, false
+}
+
 // Enumeration describing different kinds of native credential types.
 type CredentialsType int
 
@@ -1008,7 +2757,76 @@ const (
 	GCredentialsTypeWin32PidValue CredentialsType = 7
 )
 
-// Error codes for the %G_DBUS_ERROR error domain.
+// String returns the C name of the value, e.g. for debugging output
+func (x CredentialsType) String() string {
+	switch x {
+	case GCredentialsTypeInvalidValue:
+		return "G_CREDENTIALS_TYPE_INVALID"
+	case GCredentialsTypeLinuxUcredValue:
+		return "G_CREDENTIALS_TYPE_LINUX_UCRED"
+	case GCredentialsTypeFreebsdCmsgcredValue:
+		return "G_CREDENTIALS_TYPE_FREEBSD_CMSGCRED"
+	case GCredentialsTypeOpenbsdSockpeercredValue:
+		return "G_CREDENTIALS_TYPE_OPENBSD_SOCKPEERCRED"
+	case GCredentialsTypeSolarisUcredValue:
+		return "G_CREDENTIALS_TYPE_SOLARIS_UCRED"
+	case GCredentialsTypeNetbsdUnpcbidValue:
+		return "G_CREDENTIALS_TYPE_NETBSD_UNPCBID"
+	case GCredentialsTypeAppleXucredValue:
+		return "G_CREDENTIALS_TYPE_APPLE_XUCRED"
+	case GCredentialsTypeWin32PidValue:
+		return "G_CREDENTIALS_TYPE_WIN32_PID"
+	}
+	return fmt.Sprintf("CredentialsType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CredentialsType) Nick() string {
+	switch x {
+	case GCredentialsTypeInvalidValue:
+		return "invalid"
+	case GCredentialsTypeLinuxUcredValue:
+		return "linux-ucred"
+	case GCredentialsTypeFreebsdCmsgcredValue:
+		return "freebsd-cmsgcred"
+	case GCredentialsTypeOpenbsdSockpeercredValue:
+		return "openbsd-sockpeercred"
+	case GCredentialsTypeSolarisUcredValue:
+		return "solaris-ucred"
+	case GCredentialsTypeNetbsdUnpcbidValue:
+		return "netbsd-unpcbid"
+	case GCredentialsTypeAppleXucredValue:
+		return "apple-xucred"
+	case GCredentialsTypeWin32PidValue:
+		return "win32-pid"
+	}
+	return ""
+}
+
+// CredentialsTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CredentialsTypeFromString(s string) (CredentialsType, bool) {
+	switch s {
+	case "G_CREDENTIALS_TYPE_INVALID", "invalid":
+		return GCredentialsTypeInvalidValue, true
+	case "G_CREDENTIALS_TYPE_LINUX_UCRED", "linux-ucred":
+		return GCredentialsTypeLinuxUcredValue, true
+	case "G_CREDENTIALS_TYPE_FREEBSD_CMSGCRED", "freebsd-cmsgcred":
+		return GCredentialsTypeFreebsdCmsgcredValue, true
+	case "G_CREDENTIALS_TYPE_OPENBSD_SOCKPEERCRED", "openbsd-sockpeercred":
+		return GCredentialsTypeOpenbsdSockpeercredValue, true
+	case "G_CREDENTIALS_TYPE_SOLARIS_UCRED", "solaris-ucred":
+		return GCredentialsTypeSolarisUcredValue, true
+	case "G_CREDENTIALS_TYPE_NETBSD_UNPCBID", "netbsd-unpcbid":
+		return GCredentialsTypeNetbsdUnpcbidValue, true
+	case "G_CREDENTIALS_TYPE_APPLE_XUCRED", "apple-xucred":
+		return GCredentialsTypeAppleXucredValue, true
+	case "G_CREDENTIALS_TYPE_WIN32_PID", "win32-pid":
+		return GCredentialsTypeWin32PidValue, true
+	}
+	return 0, false
+}
+
+// Error codes for the G_DBUS_ERROR error domain.
 type DBusError int
 
 var xDBusErrorGLibType func() types.GType
@@ -1048,8 +2866,8 @@ const (
 	// socket).
 	GDbusErrorNoServerValue DBusError = 11
 	// Certain timeout errors, possibly ETIMEDOUT on a socket.  Note that
-	// %G_DBUS_ERROR_NO_REPLY is used for message reply timeouts. Warning:
-	// this is confusingly-named given that %G_DBUS_ERROR_TIMED_OUT also
+	// [GDbusErrorNoReplyValue] is used for message reply timeouts. Warning:
+	// this is confusingly-named given that [GDbusErrorTimedOutValue] also
 	// exists. We can't fix it for compatibility reasons so just be
 	// careful.
 	GDbusErrorTimeoutValue DBusError = 12
@@ -1068,7 +2886,7 @@ const (
 	// Method name you invoked isn't known by the object you invoked it on.
 	GDbusErrorUnknownMethodValue DBusError = 19
 	// Certain timeout errors, e.g. while starting a service. Warning: this is
-	// confusingly-named given that %G_DBUS_ERROR_TIMEOUT also exists. We
+	// confusingly-named given that [GDbusErrorTimeoutValue] also exists. We
 	// can't fix it for compatibility reasons so just be careful.
 	GDbusErrorTimedOutValue DBusError = 20
 	// Tried to remove or modify a match rule that didn't exist.
@@ -1121,6 +2939,399 @@ const (
 	GDbusErrorPropertyReadOnlyValue DBusError = 44
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (DBusError) ErrorDomain() string {
+	return "g-dbus-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x DBusError) Error() string {
+	switch x {
+	case GDbusErrorFailedValue:
+		return "G_DBUS_ERROR_FAILED"
+	case GDbusErrorNoMemoryValue:
+		return "G_DBUS_ERROR_NO_MEMORY"
+	case GDbusErrorServiceUnknownValue:
+		return "G_DBUS_ERROR_SERVICE_UNKNOWN"
+	case GDbusErrorNameHasNoOwnerValue:
+		return "G_DBUS_ERROR_NAME_HAS_NO_OWNER"
+	case GDbusErrorNoReplyValue:
+		return "G_DBUS_ERROR_NO_REPLY"
+	case GDbusErrorIoErrorValue:
+		return "G_DBUS_ERROR_IO_ERROR"
+	case GDbusErrorBadAddressValue:
+		return "G_DBUS_ERROR_BAD_ADDRESS"
+	case GDbusErrorNotSupportedValue:
+		return "G_DBUS_ERROR_NOT_SUPPORTED"
+	case GDbusErrorLimitsExceededValue:
+		return "G_DBUS_ERROR_LIMITS_EXCEEDED"
+	case GDbusErrorAccessDeniedValue:
+		return "G_DBUS_ERROR_ACCESS_DENIED"
+	case GDbusErrorAuthFailedValue:
+		return "G_DBUS_ERROR_AUTH_FAILED"
+	case GDbusErrorNoServerValue:
+		return "G_DBUS_ERROR_NO_SERVER"
+	case GDbusErrorTimeoutValue:
+		return "G_DBUS_ERROR_TIMEOUT"
+	case GDbusErrorNoNetworkValue:
+		return "G_DBUS_ERROR_NO_NETWORK"
+	case GDbusErrorAddressInUseValue:
+		return "G_DBUS_ERROR_ADDRESS_IN_USE"
+	case GDbusErrorDisconnectedValue:
+		return "G_DBUS_ERROR_DISCONNECTED"
+	case GDbusErrorInvalidArgsValue:
+		return "G_DBUS_ERROR_INVALID_ARGS"
+	case GDbusErrorFileNotFoundValue:
+		return "G_DBUS_ERROR_FILE_NOT_FOUND"
+	case GDbusErrorFileExistsValue:
+		return "G_DBUS_ERROR_FILE_EXISTS"
+	case GDbusErrorUnknownMethodValue:
+		return "G_DBUS_ERROR_UNKNOWN_METHOD"
+	case GDbusErrorTimedOutValue:
+		return "G_DBUS_ERROR_TIMED_OUT"
+	case GDbusErrorMatchRuleNotFoundValue:
+		return "G_DBUS_ERROR_MATCH_RULE_NOT_FOUND"
+	case GDbusErrorMatchRuleInvalidValue:
+		return "G_DBUS_ERROR_MATCH_RULE_INVALID"
+	case GDbusErrorSpawnExecFailedValue:
+		return "G_DBUS_ERROR_SPAWN_EXEC_FAILED"
+	case GDbusErrorSpawnForkFailedValue:
+		return "G_DBUS_ERROR_SPAWN_FORK_FAILED"
+	case GDbusErrorSpawnChildExitedValue:
+		return "G_DBUS_ERROR_SPAWN_CHILD_EXITED"
+	case GDbusErrorSpawnChildSignaledValue:
+		return "G_DBUS_ERROR_SPAWN_CHILD_SIGNALED"
+	case GDbusErrorSpawnFailedValue:
+		return "G_DBUS_ERROR_SPAWN_FAILED"
+	case GDbusErrorSpawnSetupFailedValue:
+		return "G_DBUS_ERROR_SPAWN_SETUP_FAILED"
+	case GDbusErrorSpawnConfigInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_CONFIG_INVALID"
+	case GDbusErrorSpawnServiceInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_SERVICE_INVALID"
+	case GDbusErrorSpawnServiceNotFoundValue:
+		return "G_DBUS_ERROR_SPAWN_SERVICE_NOT_FOUND"
+	case GDbusErrorSpawnPermissionsInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_PERMISSIONS_INVALID"
+	case GDbusErrorSpawnFileInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_FILE_INVALID"
+	case GDbusErrorSpawnNoMemoryValue:
+		return "G_DBUS_ERROR_SPAWN_NO_MEMORY"
+	case GDbusErrorUnixProcessIdUnknownValue:
+		return "G_DBUS_ERROR_UNIX_PROCESS_ID_UNKNOWN"
+	case GDbusErrorInvalidSignatureValue:
+		return "G_DBUS_ERROR_INVALID_SIGNATURE"
+	case GDbusErrorInvalidFileContentValue:
+		return "G_DBUS_ERROR_INVALID_FILE_CONTENT"
+	case GDbusErrorSelinuxSecurityContextUnknownValue:
+		return "G_DBUS_ERROR_SELINUX_SECURITY_CONTEXT_UNKNOWN"
+	case GDbusErrorAdtAuditDataUnknownValue:
+		return "G_DBUS_ERROR_ADT_AUDIT_DATA_UNKNOWN"
+	case GDbusErrorObjectPathInUseValue:
+		return "G_DBUS_ERROR_OBJECT_PATH_IN_USE"
+	case GDbusErrorUnknownObjectValue:
+		return "G_DBUS_ERROR_UNKNOWN_OBJECT"
+	case GDbusErrorUnknownInterfaceValue:
+		return "G_DBUS_ERROR_UNKNOWN_INTERFACE"
+	case GDbusErrorUnknownPropertyValue:
+		return "G_DBUS_ERROR_UNKNOWN_PROPERTY"
+	case GDbusErrorPropertyReadOnlyValue:
+		return "G_DBUS_ERROR_PROPERTY_READ_ONLY"
+	}
+	return fmt.Sprintf("g-dbus-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x DBusError) String() string {
+	switch x {
+	case GDbusErrorFailedValue:
+		return "G_DBUS_ERROR_FAILED"
+	case GDbusErrorNoMemoryValue:
+		return "G_DBUS_ERROR_NO_MEMORY"
+	case GDbusErrorServiceUnknownValue:
+		return "G_DBUS_ERROR_SERVICE_UNKNOWN"
+	case GDbusErrorNameHasNoOwnerValue:
+		return "G_DBUS_ERROR_NAME_HAS_NO_OWNER"
+	case GDbusErrorNoReplyValue:
+		return "G_DBUS_ERROR_NO_REPLY"
+	case GDbusErrorIoErrorValue:
+		return "G_DBUS_ERROR_IO_ERROR"
+	case GDbusErrorBadAddressValue:
+		return "G_DBUS_ERROR_BAD_ADDRESS"
+	case GDbusErrorNotSupportedValue:
+		return "G_DBUS_ERROR_NOT_SUPPORTED"
+	case GDbusErrorLimitsExceededValue:
+		return "G_DBUS_ERROR_LIMITS_EXCEEDED"
+	case GDbusErrorAccessDeniedValue:
+		return "G_DBUS_ERROR_ACCESS_DENIED"
+	case GDbusErrorAuthFailedValue:
+		return "G_DBUS_ERROR_AUTH_FAILED"
+	case GDbusErrorNoServerValue:
+		return "G_DBUS_ERROR_NO_SERVER"
+	case GDbusErrorTimeoutValue:
+		return "G_DBUS_ERROR_TIMEOUT"
+	case GDbusErrorNoNetworkValue:
+		return "G_DBUS_ERROR_NO_NETWORK"
+	case GDbusErrorAddressInUseValue:
+		return "G_DBUS_ERROR_ADDRESS_IN_USE"
+	case GDbusErrorDisconnectedValue:
+		return "G_DBUS_ERROR_DISCONNECTED"
+	case GDbusErrorInvalidArgsValue:
+		return "G_DBUS_ERROR_INVALID_ARGS"
+	case GDbusErrorFileNotFoundValue:
+		return "G_DBUS_ERROR_FILE_NOT_FOUND"
+	case GDbusErrorFileExistsValue:
+		return "G_DBUS_ERROR_FILE_EXISTS"
+	case GDbusErrorUnknownMethodValue:
+		return "G_DBUS_ERROR_UNKNOWN_METHOD"
+	case GDbusErrorTimedOutValue:
+		return "G_DBUS_ERROR_TIMED_OUT"
+	case GDbusErrorMatchRuleNotFoundValue:
+		return "G_DBUS_ERROR_MATCH_RULE_NOT_FOUND"
+	case GDbusErrorMatchRuleInvalidValue:
+		return "G_DBUS_ERROR_MATCH_RULE_INVALID"
+	case GDbusErrorSpawnExecFailedValue:
+		return "G_DBUS_ERROR_SPAWN_EXEC_FAILED"
+	case GDbusErrorSpawnForkFailedValue:
+		return "G_DBUS_ERROR_SPAWN_FORK_FAILED"
+	case GDbusErrorSpawnChildExitedValue:
+		return "G_DBUS_ERROR_SPAWN_CHILD_EXITED"
+	case GDbusErrorSpawnChildSignaledValue:
+		return "G_DBUS_ERROR_SPAWN_CHILD_SIGNALED"
+	case GDbusErrorSpawnFailedValue:
+		return "G_DBUS_ERROR_SPAWN_FAILED"
+	case GDbusErrorSpawnSetupFailedValue:
+		return "G_DBUS_ERROR_SPAWN_SETUP_FAILED"
+	case GDbusErrorSpawnConfigInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_CONFIG_INVALID"
+	case GDbusErrorSpawnServiceInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_SERVICE_INVALID"
+	case GDbusErrorSpawnServiceNotFoundValue:
+		return "G_DBUS_ERROR_SPAWN_SERVICE_NOT_FOUND"
+	case GDbusErrorSpawnPermissionsInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_PERMISSIONS_INVALID"
+	case GDbusErrorSpawnFileInvalidValue:
+		return "G_DBUS_ERROR_SPAWN_FILE_INVALID"
+	case GDbusErrorSpawnNoMemoryValue:
+		return "G_DBUS_ERROR_SPAWN_NO_MEMORY"
+	case GDbusErrorUnixProcessIdUnknownValue:
+		return "G_DBUS_ERROR_UNIX_PROCESS_ID_UNKNOWN"
+	case GDbusErrorInvalidSignatureValue:
+		return "G_DBUS_ERROR_INVALID_SIGNATURE"
+	case GDbusErrorInvalidFileContentValue:
+		return "G_DBUS_ERROR_INVALID_FILE_CONTENT"
+	case GDbusErrorSelinuxSecurityContextUnknownValue:
+		return "G_DBUS_ERROR_SELINUX_SECURITY_CONTEXT_UNKNOWN"
+	case GDbusErrorAdtAuditDataUnknownValue:
+		return "G_DBUS_ERROR_ADT_AUDIT_DATA_UNKNOWN"
+	case GDbusErrorObjectPathInUseValue:
+		return "G_DBUS_ERROR_OBJECT_PATH_IN_USE"
+	case GDbusErrorUnknownObjectValue:
+		return "G_DBUS_ERROR_UNKNOWN_OBJECT"
+	case GDbusErrorUnknownInterfaceValue:
+		return "G_DBUS_ERROR_UNKNOWN_INTERFACE"
+	case GDbusErrorUnknownPropertyValue:
+		return "G_DBUS_ERROR_UNKNOWN_PROPERTY"
+	case GDbusErrorPropertyReadOnlyValue:
+		return "G_DBUS_ERROR_PROPERTY_READ_ONLY"
+	}
+	return fmt.Sprintf("DBusError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DBusError) Nick() string {
+	switch x {
+	case GDbusErrorFailedValue:
+		return "failed"
+	case GDbusErrorNoMemoryValue:
+		return "no-memory"
+	case GDbusErrorServiceUnknownValue:
+		return "service-unknown"
+	case GDbusErrorNameHasNoOwnerValue:
+		return "name-has-no-owner"
+	case GDbusErrorNoReplyValue:
+		return "no-reply"
+	case GDbusErrorIoErrorValue:
+		return "io-error"
+	case GDbusErrorBadAddressValue:
+		return "bad-address"
+	case GDbusErrorNotSupportedValue:
+		return "not-supported"
+	case GDbusErrorLimitsExceededValue:
+		return "limits-exceeded"
+	case GDbusErrorAccessDeniedValue:
+		return "access-denied"
+	case GDbusErrorAuthFailedValue:
+		return "auth-failed"
+	case GDbusErrorNoServerValue:
+		return "no-server"
+	case GDbusErrorTimeoutValue:
+		return "timeout"
+	case GDbusErrorNoNetworkValue:
+		return "no-network"
+	case GDbusErrorAddressInUseValue:
+		return "address-in-use"
+	case GDbusErrorDisconnectedValue:
+		return "disconnected"
+	case GDbusErrorInvalidArgsValue:
+		return "invalid-args"
+	case GDbusErrorFileNotFoundValue:
+		return "file-not-found"
+	case GDbusErrorFileExistsValue:
+		return "file-exists"
+	case GDbusErrorUnknownMethodValue:
+		return "unknown-method"
+	case GDbusErrorTimedOutValue:
+		return "timed-out"
+	case GDbusErrorMatchRuleNotFoundValue:
+		return "match-rule-not-found"
+	case GDbusErrorMatchRuleInvalidValue:
+		return "match-rule-invalid"
+	case GDbusErrorSpawnExecFailedValue:
+		return "spawn-exec-failed"
+	case GDbusErrorSpawnForkFailedValue:
+		return "spawn-fork-failed"
+	case GDbusErrorSpawnChildExitedValue:
+		return "spawn-child-exited"
+	case GDbusErrorSpawnChildSignaledValue:
+		return "spawn-child-signaled"
+	case GDbusErrorSpawnFailedValue:
+		return "spawn-failed"
+	case GDbusErrorSpawnSetupFailedValue:
+		return "spawn-setup-failed"
+	case GDbusErrorSpawnConfigInvalidValue:
+		return "spawn-config-invalid"
+	case GDbusErrorSpawnServiceInvalidValue:
+		return "spawn-service-invalid"
+	case GDbusErrorSpawnServiceNotFoundValue:
+		return "spawn-service-not-found"
+	case GDbusErrorSpawnPermissionsInvalidValue:
+		return "spawn-permissions-invalid"
+	case GDbusErrorSpawnFileInvalidValue:
+		return "spawn-file-invalid"
+	case GDbusErrorSpawnNoMemoryValue:
+		return "spawn-no-memory"
+	case GDbusErrorUnixProcessIdUnknownValue:
+		return "unix-process-id-unknown"
+	case GDbusErrorInvalidSignatureValue:
+		return "invalid-signature"
+	case GDbusErrorInvalidFileContentValue:
+		return "invalid-file-content"
+	case GDbusErrorSelinuxSecurityContextUnknownValue:
+		return "selinux-security-context-unknown"
+	case GDbusErrorAdtAuditDataUnknownValue:
+		return "adt-audit-data-unknown"
+	case GDbusErrorObjectPathInUseValue:
+		return "object-path-in-use"
+	case GDbusErrorUnknownObjectValue:
+		return "unknown-object"
+	case GDbusErrorUnknownInterfaceValue:
+		return "unknown-interface"
+	case GDbusErrorUnknownPropertyValue:
+		return "unknown-property"
+	case GDbusErrorPropertyReadOnlyValue:
+		return "property-read-only"
+	}
+	return ""
+}
+
+// DBusErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DBusErrorFromString(s string) (DBusError, bool) {
+	switch s {
+	case "G_DBUS_ERROR_FAILED", "failed":
+		return GDbusErrorFailedValue, true
+	case "G_DBUS_ERROR_NO_MEMORY", "no-memory":
+		return GDbusErrorNoMemoryValue, true
+	case "G_DBUS_ERROR_SERVICE_UNKNOWN", "service-unknown":
+		return GDbusErrorServiceUnknownValue, true
+	case "G_DBUS_ERROR_NAME_HAS_NO_OWNER", "name-has-no-owner":
+		return GDbusErrorNameHasNoOwnerValue, true
+	case "G_DBUS_ERROR_NO_REPLY", "no-reply":
+		return GDbusErrorNoReplyValue, true
+	case "G_DBUS_ERROR_IO_ERROR", "io-error":
+		return GDbusErrorIoErrorValue, true
+	case "G_DBUS_ERROR_BAD_ADDRESS", "bad-address":
+		return GDbusErrorBadAddressValue, true
+	case "G_DBUS_ERROR_NOT_SUPPORTED", "not-supported":
+		return GDbusErrorNotSupportedValue, true
+	case "G_DBUS_ERROR_LIMITS_EXCEEDED", "limits-exceeded":
+		return GDbusErrorLimitsExceededValue, true
+	case "G_DBUS_ERROR_ACCESS_DENIED", "access-denied":
+		return GDbusErrorAccessDeniedValue, true
+	case "G_DBUS_ERROR_AUTH_FAILED", "auth-failed":
+		return GDbusErrorAuthFailedValue, true
+	case "G_DBUS_ERROR_NO_SERVER", "no-server":
+		return GDbusErrorNoServerValue, true
+	case "G_DBUS_ERROR_TIMEOUT", "timeout":
+		return GDbusErrorTimeoutValue, true
+	case "G_DBUS_ERROR_NO_NETWORK", "no-network":
+		return GDbusErrorNoNetworkValue, true
+	case "G_DBUS_ERROR_ADDRESS_IN_USE", "address-in-use":
+		return GDbusErrorAddressInUseValue, true
+	case "G_DBUS_ERROR_DISCONNECTED", "disconnected":
+		return GDbusErrorDisconnectedValue, true
+	case "G_DBUS_ERROR_INVALID_ARGS", "invalid-args":
+		return GDbusErrorInvalidArgsValue, true
+	case "G_DBUS_ERROR_FILE_NOT_FOUND", "file-not-found":
+		return GDbusErrorFileNotFoundValue, true
+	case "G_DBUS_ERROR_FILE_EXISTS", "file-exists":
+		return GDbusErrorFileExistsValue, true
+	case "G_DBUS_ERROR_UNKNOWN_METHOD", "unknown-method":
+		return GDbusErrorUnknownMethodValue, true
+	case "G_DBUS_ERROR_TIMED_OUT", "timed-out":
+		return GDbusErrorTimedOutValue, true
+	case "G_DBUS_ERROR_MATCH_RULE_NOT_FOUND", "match-rule-not-found":
+		return GDbusErrorMatchRuleNotFoundValue, true
+	case "G_DBUS_ERROR_MATCH_RULE_INVALID", "match-rule-invalid":
+		return GDbusErrorMatchRuleInvalidValue, true
+	case "G_DBUS_ERROR_SPAWN_EXEC_FAILED", "spawn-exec-failed":
+		return GDbusErrorSpawnExecFailedValue, true
+	case "G_DBUS_ERROR_SPAWN_FORK_FAILED", "spawn-fork-failed":
+		return GDbusErrorSpawnForkFailedValue, true
+	case "G_DBUS_ERROR_SPAWN_CHILD_EXITED", "spawn-child-exited":
+		return GDbusErrorSpawnChildExitedValue, true
+	case "G_DBUS_ERROR_SPAWN_CHILD_SIGNALED", "spawn-child-signaled":
+		return GDbusErrorSpawnChildSignaledValue, true
+	case "G_DBUS_ERROR_SPAWN_FAILED", "spawn-failed":
+		return GDbusErrorSpawnFailedValue, true
+	case "G_DBUS_ERROR_SPAWN_SETUP_FAILED", "spawn-setup-failed":
+		return GDbusErrorSpawnSetupFailedValue, true
+	case "G_DBUS_ERROR_SPAWN_CONFIG_INVALID", "spawn-config-invalid":
+		return GDbusErrorSpawnConfigInvalidValue, true
+	case "G_DBUS_ERROR_SPAWN_SERVICE_INVALID", "spawn-service-invalid":
+		return GDbusErrorSpawnServiceInvalidValue, true
+	case "G_DBUS_ERROR_SPAWN_SERVICE_NOT_FOUND", "spawn-service-not-found":
+		return GDbusErrorSpawnServiceNotFoundValue, true
+	case "G_DBUS_ERROR_SPAWN_PERMISSIONS_INVALID", "spawn-permissions-invalid":
+		return GDbusErrorSpawnPermissionsInvalidValue, true
+	case "G_DBUS_ERROR_SPAWN_FILE_INVALID", "spawn-file-invalid":
+		return GDbusErrorSpawnFileInvalidValue, true
+	case "G_DBUS_ERROR_SPAWN_NO_MEMORY", "spawn-no-memory":
+		return GDbusErrorSpawnNoMemoryValue, true
+	case "G_DBUS_ERROR_UNIX_PROCESS_ID_UNKNOWN", "unix-process-id-unknown":
+		return GDbusErrorUnixProcessIdUnknownValue, true
+	case "G_DBUS_ERROR_INVALID_SIGNATURE", "invalid-signature":
+		return GDbusErrorInvalidSignatureValue, true
+	case "G_DBUS_ERROR_INVALID_FILE_CONTENT", "invalid-file-content":
+		return GDbusErrorInvalidFileContentValue, true
+	case "G_DBUS_ERROR_SELINUX_SECURITY_CONTEXT_UNKNOWN", "selinux-security-context-unknown":
+		return GDbusErrorSelinuxSecurityContextUnknownValue, true
+	case "G_DBUS_ERROR_ADT_AUDIT_DATA_UNKNOWN", "adt-audit-data-unknown":
+		return GDbusErrorAdtAuditDataUnknownValue, true
+	case "G_DBUS_ERROR_OBJECT_PATH_IN_USE", "object-path-in-use":
+		return GDbusErrorObjectPathInUseValue, true
+	case "G_DBUS_ERROR_UNKNOWN_OBJECT", "unknown-object":
+		return GDbusErrorUnknownObjectValue, true
+	case "G_DBUS_ERROR_UNKNOWN_INTERFACE", "unknown-interface":
+		return GDbusErrorUnknownInterfaceValue, true
+	case "G_DBUS_ERROR_UNKNOWN_PROPERTY", "unknown-property":
+		return GDbusErrorUnknownPropertyValue, true
+	case "G_DBUS_ERROR_PROPERTY_READ_ONLY", "property-read-only":
+		return GDbusErrorPropertyReadOnlyValue, true
+	}
+	return 0, false
+}
+
 // Enumeration used to describe the byte order of a D-Bus message.
 type DBusMessageByteOrder int
 
@@ -1138,7 +3349,40 @@ const (
 	GDbusMessageByteOrderLittleEndianValue DBusMessageByteOrder = 108
 )
 
-// Header fields used in #GDBusMessage.
+// String returns the C name of the value, e.g. for debugging output
+func (x DBusMessageByteOrder) String() string {
+	switch x {
+	case GDbusMessageByteOrderBigEndianValue:
+		return "G_DBUS_MESSAGE_BYTE_ORDER_BIG_ENDIAN"
+	case GDbusMessageByteOrderLittleEndianValue:
+		return "G_DBUS_MESSAGE_BYTE_ORDER_LITTLE_ENDIAN"
+	}
+	return fmt.Sprintf("DBusMessageByteOrder(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DBusMessageByteOrder) Nick() string {
+	switch x {
+	case GDbusMessageByteOrderBigEndianValue:
+		return "big-endian"
+	case GDbusMessageByteOrderLittleEndianValue:
+		return "little-endian"
+	}
+	return ""
+}
+
+// DBusMessageByteOrderFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DBusMessageByteOrderFromString(s string) (DBusMessageByteOrder, bool) {
+	switch s {
+	case "G_DBUS_MESSAGE_BYTE_ORDER_BIG_ENDIAN", "big-endian":
+		return GDbusMessageByteOrderBigEndianValue, true
+	case "G_DBUS_MESSAGE_BYTE_ORDER_LITTLE_ENDIAN", "little-endian":
+		return GDbusMessageByteOrderLittleEndianValue, true
+	}
+	return 0, false
+}
+
+// Header fields used in [DBusMessage].
 type DBusMessageHeaderField int
 
 var xDBusMessageHeaderFieldGLibType func() types.GType
@@ -1171,7 +3415,88 @@ const (
 	GDbusMessageHeaderFieldNumUnixFdsValue DBusMessageHeaderField = 9
 )
 
-// Message types used in #GDBusMessage.
+// String returns the C name of the value, e.g. for debugging output
+func (x DBusMessageHeaderField) String() string {
+	switch x {
+	case GDbusMessageHeaderFieldInvalidValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_INVALID"
+	case GDbusMessageHeaderFieldPathValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_PATH"
+	case GDbusMessageHeaderFieldInterfaceValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_INTERFACE"
+	case GDbusMessageHeaderFieldMemberValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_MEMBER"
+	case GDbusMessageHeaderFieldErrorNameValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_ERROR_NAME"
+	case GDbusMessageHeaderFieldReplySerialValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_REPLY_SERIAL"
+	case GDbusMessageHeaderFieldDestinationValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_DESTINATION"
+	case GDbusMessageHeaderFieldSenderValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_SENDER"
+	case GDbusMessageHeaderFieldSignatureValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_SIGNATURE"
+	case GDbusMessageHeaderFieldNumUnixFdsValue:
+		return "G_DBUS_MESSAGE_HEADER_FIELD_NUM_UNIX_FDS"
+	}
+	return fmt.Sprintf("DBusMessageHeaderField(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DBusMessageHeaderField) Nick() string {
+	switch x {
+	case GDbusMessageHeaderFieldInvalidValue:
+		return "invalid"
+	case GDbusMessageHeaderFieldPathValue:
+		return "path"
+	case GDbusMessageHeaderFieldInterfaceValue:
+		return "interface"
+	case GDbusMessageHeaderFieldMemberValue:
+		return "member"
+	case GDbusMessageHeaderFieldErrorNameValue:
+		return "error-name"
+	case GDbusMessageHeaderFieldReplySerialValue:
+		return "reply-serial"
+	case GDbusMessageHeaderFieldDestinationValue:
+		return "destination"
+	case GDbusMessageHeaderFieldSenderValue:
+		return "sender"
+	case GDbusMessageHeaderFieldSignatureValue:
+		return "signature"
+	case GDbusMessageHeaderFieldNumUnixFdsValue:
+		return "num-unix-fds"
+	}
+	return ""
+}
+
+// DBusMessageHeaderFieldFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DBusMessageHeaderFieldFromString(s string) (DBusMessageHeaderField, bool) {
+	switch s {
+	case "G_DBUS_MESSAGE_HEADER_FIELD_INVALID", "invalid":
+		return GDbusMessageHeaderFieldInvalidValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_PATH", "path":
+		return GDbusMessageHeaderFieldPathValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_INTERFACE", "interface":
+		return GDbusMessageHeaderFieldInterfaceValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_MEMBER", "member":
+		return GDbusMessageHeaderFieldMemberValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_ERROR_NAME", "error-name":
+		return GDbusMessageHeaderFieldErrorNameValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_REPLY_SERIAL", "reply-serial":
+		return GDbusMessageHeaderFieldReplySerialValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_DESTINATION", "destination":
+		return GDbusMessageHeaderFieldDestinationValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_SENDER", "sender":
+		return GDbusMessageHeaderFieldSenderValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_SIGNATURE", "signature":
+		return GDbusMessageHeaderFieldSignatureValue, true
+	case "G_DBUS_MESSAGE_HEADER_FIELD_NUM_UNIX_FDS", "num-unix-fds":
+		return GDbusMessageHeaderFieldNumUnixFdsValue, true
+	}
+	return 0, false
+}
+
+// Message types used in [DBusMessage].
 type DBusMessageType int
 
 var xDBusMessageTypeGLibType func() types.GType
@@ -1194,7 +3519,58 @@ const (
 	GDbusMessageTypeSignalValue DBusMessageType = 4
 )
 
-// #GDataStreamByteOrder is used to ensure proper endianness of streaming data sources
+// String returns the C name of the value, e.g. for debugging output
+func (x DBusMessageType) String() string {
+	switch x {
+	case GDbusMessageTypeInvalidValue:
+		return "G_DBUS_MESSAGE_TYPE_INVALID"
+	case GDbusMessageTypeMethodCallValue:
+		return "G_DBUS_MESSAGE_TYPE_METHOD_CALL"
+	case GDbusMessageTypeMethodReturnValue:
+		return "G_DBUS_MESSAGE_TYPE_METHOD_RETURN"
+	case GDbusMessageTypeErrorValue:
+		return "G_DBUS_MESSAGE_TYPE_ERROR"
+	case GDbusMessageTypeSignalValue:
+		return "G_DBUS_MESSAGE_TYPE_SIGNAL"
+	}
+	return fmt.Sprintf("DBusMessageType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DBusMessageType) Nick() string {
+	switch x {
+	case GDbusMessageTypeInvalidValue:
+		return "invalid"
+	case GDbusMessageTypeMethodCallValue:
+		return "method-call"
+	case GDbusMessageTypeMethodReturnValue:
+		return "method-return"
+	case GDbusMessageTypeErrorValue:
+		return "error"
+	case GDbusMessageTypeSignalValue:
+		return "signal"
+	}
+	return ""
+}
+
+// DBusMessageTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DBusMessageTypeFromString(s string) (DBusMessageType, bool) {
+	switch s {
+	case "G_DBUS_MESSAGE_TYPE_INVALID", "invalid":
+		return GDbusMessageTypeInvalidValue, true
+	case "G_DBUS_MESSAGE_TYPE_METHOD_CALL", "method-call":
+		return GDbusMessageTypeMethodCallValue, true
+	case "G_DBUS_MESSAGE_TYPE_METHOD_RETURN", "method-return":
+		return GDbusMessageTypeMethodReturnValue, true
+	case "G_DBUS_MESSAGE_TYPE_ERROR", "error":
+		return GDbusMessageTypeErrorValue, true
+	case "G_DBUS_MESSAGE_TYPE_SIGNAL", "signal":
+		return GDbusMessageTypeSignalValue, true
+	}
+	return 0, false
+}
+
+// [DataStreamByteOrder] is used to ensure proper endianness of streaming data sources
 // across various machine architectures.
 type DataStreamByteOrder int
 
@@ -1214,7 +3590,46 @@ const (
 	GDataStreamByteOrderHostEndianValue DataStreamByteOrder = 2
 )
 
-// #GDataStreamNewlineType is used when checking for or setting the line endings for a given file.
+// String returns the C name of the value, e.g. for debugging output
+func (x DataStreamByteOrder) String() string {
+	switch x {
+	case GDataStreamByteOrderBigEndianValue:
+		return "G_DATA_STREAM_BYTE_ORDER_BIG_ENDIAN"
+	case GDataStreamByteOrderLittleEndianValue:
+		return "G_DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN"
+	case GDataStreamByteOrderHostEndianValue:
+		return "G_DATA_STREAM_BYTE_ORDER_HOST_ENDIAN"
+	}
+	return fmt.Sprintf("DataStreamByteOrder(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DataStreamByteOrder) Nick() string {
+	switch x {
+	case GDataStreamByteOrderBigEndianValue:
+		return "big-endian"
+	case GDataStreamByteOrderLittleEndianValue:
+		return "little-endian"
+	case GDataStreamByteOrderHostEndianValue:
+		return "host-endian"
+	}
+	return ""
+}
+
+// DataStreamByteOrderFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DataStreamByteOrderFromString(s string) (DataStreamByteOrder, bool) {
+	switch s {
+	case "G_DATA_STREAM_BYTE_ORDER_BIG_ENDIAN", "big-endian":
+		return GDataStreamByteOrderBigEndianValue, true
+	case "G_DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN", "little-endian":
+		return GDataStreamByteOrderLittleEndianValue, true
+	case "G_DATA_STREAM_BYTE_ORDER_HOST_ENDIAN", "host-endian":
+		return GDataStreamByteOrderHostEndianValue, true
+	}
+	return 0, false
+}
+
+// [DataStreamNewlineType] is used when checking for or setting the line endings for a given file.
 type DataStreamNewlineType int
 
 var xDataStreamNewlineTypeGLibType func() types.GType
@@ -1235,6 +3650,51 @@ const (
 	GDataStreamNewlineTypeAnyValue DataStreamNewlineType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DataStreamNewlineType) String() string {
+	switch x {
+	case GDataStreamNewlineTypeLfValue:
+		return "G_DATA_STREAM_NEWLINE_TYPE_LF"
+	case GDataStreamNewlineTypeCrValue:
+		return "G_DATA_STREAM_NEWLINE_TYPE_CR"
+	case GDataStreamNewlineTypeCrLfValue:
+		return "G_DATA_STREAM_NEWLINE_TYPE_CR_LF"
+	case GDataStreamNewlineTypeAnyValue:
+		return "G_DATA_STREAM_NEWLINE_TYPE_ANY"
+	}
+	return fmt.Sprintf("DataStreamNewlineType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DataStreamNewlineType) Nick() string {
+	switch x {
+	case GDataStreamNewlineTypeLfValue:
+		return "lf"
+	case GDataStreamNewlineTypeCrValue:
+		return "cr"
+	case GDataStreamNewlineTypeCrLfValue:
+		return "cr-lf"
+	case GDataStreamNewlineTypeAnyValue:
+		return "any"
+	}
+	return ""
+}
+
+// DataStreamNewlineTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DataStreamNewlineTypeFromString(s string) (DataStreamNewlineType, bool) {
+	switch s {
+	case "G_DATA_STREAM_NEWLINE_TYPE_LF", "lf":
+		return GDataStreamNewlineTypeLfValue, true
+	case "G_DATA_STREAM_NEWLINE_TYPE_CR", "cr":
+		return GDataStreamNewlineTypeCrValue, true
+	case "G_DATA_STREAM_NEWLINE_TYPE_CR_LF", "cr-lf":
+		return GDataStreamNewlineTypeCrLfValue, true
+	case "G_DATA_STREAM_NEWLINE_TYPE_ANY", "any":
+		return GDataStreamNewlineTypeAnyValue, true
+	}
+	return 0, false
+}
+
 // Enumeration describing how a drive can be started/stopped.
 type DriveStartStopType int
 
@@ -1266,8 +3726,59 @@ const (
 	GDriveStartStopTypePasswordValue DriveStartStopType = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DriveStartStopType) String() string {
+	switch x {
+	case GDriveStartStopTypeUnknownValue:
+		return "G_DRIVE_START_STOP_TYPE_UNKNOWN"
+	case GDriveStartStopTypeShutdownValue:
+		return "G_DRIVE_START_STOP_TYPE_SHUTDOWN"
+	case GDriveStartStopTypeNetworkValue:
+		return "G_DRIVE_START_STOP_TYPE_NETWORK"
+	case GDriveStartStopTypeMultidiskValue:
+		return "G_DRIVE_START_STOP_TYPE_MULTIDISK"
+	case GDriveStartStopTypePasswordValue:
+		return "G_DRIVE_START_STOP_TYPE_PASSWORD"
+	}
+	return fmt.Sprintf("DriveStartStopType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DriveStartStopType) Nick() string {
+	switch x {
+	case GDriveStartStopTypeUnknownValue:
+		return "unknown"
+	case GDriveStartStopTypeShutdownValue:
+		return "shutdown"
+	case GDriveStartStopTypeNetworkValue:
+		return "network"
+	case GDriveStartStopTypeMultidiskValue:
+		return "multidisk"
+	case GDriveStartStopTypePasswordValue:
+		return "password"
+	}
+	return ""
+}
+
+// DriveStartStopTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DriveStartStopTypeFromString(s string) (DriveStartStopType, bool) {
+	switch s {
+	case "G_DRIVE_START_STOP_TYPE_UNKNOWN", "unknown":
+		return GDriveStartStopTypeUnknownValue, true
+	case "G_DRIVE_START_STOP_TYPE_SHUTDOWN", "shutdown":
+		return GDriveStartStopTypeShutdownValue, true
+	case "G_DRIVE_START_STOP_TYPE_NETWORK", "network":
+		return GDriveStartStopTypeNetworkValue, true
+	case "G_DRIVE_START_STOP_TYPE_MULTIDISK", "multidisk":
+		return GDriveStartStopTypeMultidiskValue, true
+	case "G_DRIVE_START_STOP_TYPE_PASSWORD", "password":
+		return GDriveStartStopTypePasswordValue, true
+	}
+	return 0, false
+}
+
 // GEmblemOrigin is used to add information about the origin of the emblem
-// to #GEmblem.
+// to [Emblem].
 type EmblemOrigin int
 
 var xEmblemOriginGLibType func() types.GType
@@ -1288,7 +3799,52 @@ const (
 	GEmblemOriginTagValue EmblemOrigin = 3
 )
 
-// Used by g_file_set_attributes_from_info() when setting file attributes.
+// String returns the C name of the value, e.g. for debugging output
+func (x EmblemOrigin) String() string {
+	switch x {
+	case GEmblemOriginUnknownValue:
+		return "G_EMBLEM_ORIGIN_UNKNOWN"
+	case GEmblemOriginDeviceValue:
+		return "G_EMBLEM_ORIGIN_DEVICE"
+	case GEmblemOriginLivemetadataValue:
+		return "G_EMBLEM_ORIGIN_LIVEMETADATA"
+	case GEmblemOriginTagValue:
+		return "G_EMBLEM_ORIGIN_TAG"
+	}
+	return fmt.Sprintf("EmblemOrigin(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EmblemOrigin) Nick() string {
+	switch x {
+	case GEmblemOriginUnknownValue:
+		return "unknown"
+	case GEmblemOriginDeviceValue:
+		return "device"
+	case GEmblemOriginLivemetadataValue:
+		return "livemetadata"
+	case GEmblemOriginTagValue:
+		return "tag"
+	}
+	return ""
+}
+
+// EmblemOriginFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EmblemOriginFromString(s string) (EmblemOrigin, bool) {
+	switch s {
+	case "G_EMBLEM_ORIGIN_UNKNOWN", "unknown":
+		return GEmblemOriginUnknownValue, true
+	case "G_EMBLEM_ORIGIN_DEVICE", "device":
+		return GEmblemOriginDeviceValue, true
+	case "G_EMBLEM_ORIGIN_LIVEMETADATA", "livemetadata":
+		return GEmblemOriginLivemetadataValue, true
+	case "G_EMBLEM_ORIGIN_TAG", "tag":
+		return GEmblemOriginTagValue, true
+	}
+	return 0, false
+}
+
+// Used by [File.SetAttributesFromInfo] when setting file attributes.
 type FileAttributeStatus int
 
 var xFileAttributeStatusGLibType func() types.GType
@@ -1307,6 +3863,45 @@ const (
 	GFileAttributeStatusErrorSettingValue FileAttributeStatus = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FileAttributeStatus) String() string {
+	switch x {
+	case GFileAttributeStatusUnsetValue:
+		return "G_FILE_ATTRIBUTE_STATUS_UNSET"
+	case GFileAttributeStatusSetValue:
+		return "G_FILE_ATTRIBUTE_STATUS_SET"
+	case GFileAttributeStatusErrorSettingValue:
+		return "G_FILE_ATTRIBUTE_STATUS_ERROR_SETTING"
+	}
+	return fmt.Sprintf("FileAttributeStatus(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileAttributeStatus) Nick() string {
+	switch x {
+	case GFileAttributeStatusUnsetValue:
+		return "unset"
+	case GFileAttributeStatusSetValue:
+		return "set"
+	case GFileAttributeStatusErrorSettingValue:
+		return "error-setting"
+	}
+	return ""
+}
+
+// FileAttributeStatusFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileAttributeStatusFromString(s string) (FileAttributeStatus, bool) {
+	switch s {
+	case "G_FILE_ATTRIBUTE_STATUS_UNSET", "unset":
+		return GFileAttributeStatusUnsetValue, true
+	case "G_FILE_ATTRIBUTE_STATUS_SET", "set":
+		return GFileAttributeStatusSetValue, true
+	case "G_FILE_ATTRIBUTE_STATUS_ERROR_SETTING", "error-setting":
+		return GFileAttributeStatusErrorSettingValue, true
+	}
+	return 0, false
+}
+
 // The data types for file attributes.
 type FileAttributeType int
 
@@ -1334,12 +3929,93 @@ const (
 	GFileAttributeTypeUint64Value FileAttributeType = 6
 	// a signed 8-byte/64-bit integer.
 	GFileAttributeTypeInt64Value FileAttributeType = 7
-	// a #GObject.
+	// a [gobject.Object].
 	GFileAttributeTypeObjectValue FileAttributeType = 8
-	// a %NULL terminated char **. Since 2.22
+	// a nil terminated char **. Since 2.22
 	GFileAttributeTypeStringvValue FileAttributeType = 9
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FileAttributeType) String() string {
+	switch x {
+	case GFileAttributeTypeInvalidValue:
+		return "G_FILE_ATTRIBUTE_TYPE_INVALID"
+	case GFileAttributeTypeStringValue:
+		return "G_FILE_ATTRIBUTE_TYPE_STRING"
+	case GFileAttributeTypeByteStringValue:
+		return "G_FILE_ATTRIBUTE_TYPE_BYTE_STRING"
+	case GFileAttributeTypeBooleanValue:
+		return "G_FILE_ATTRIBUTE_TYPE_BOOLEAN"
+	case GFileAttributeTypeUint32Value:
+		return "G_FILE_ATTRIBUTE_TYPE_UINT32"
+	case GFileAttributeTypeInt32Value:
+		return "G_FILE_ATTRIBUTE_TYPE_INT32"
+	case GFileAttributeTypeUint64Value:
+		return "G_FILE_ATTRIBUTE_TYPE_UINT64"
+	case GFileAttributeTypeInt64Value:
+		return "G_FILE_ATTRIBUTE_TYPE_INT64"
+	case GFileAttributeTypeObjectValue:
+		return "G_FILE_ATTRIBUTE_TYPE_OBJECT"
+	case GFileAttributeTypeStringvValue:
+		return "G_FILE_ATTRIBUTE_TYPE_STRINGV"
+	}
+	return fmt.Sprintf("FileAttributeType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileAttributeType) Nick() string {
+	switch x {
+	case GFileAttributeTypeInvalidValue:
+		return "invalid"
+	case GFileAttributeTypeStringValue:
+		return "string"
+	case GFileAttributeTypeByteStringValue:
+		return "byte-string"
+	case GFileAttributeTypeBooleanValue:
+		return "boolean"
+	case GFileAttributeTypeUint32Value:
+		return "uint32"
+	case GFileAttributeTypeInt32Value:
+		return "int32"
+	case GFileAttributeTypeUint64Value:
+		return "uint64"
+	case GFileAttributeTypeInt64Value:
+		return "int64"
+	case GFileAttributeTypeObjectValue:
+		return "object"
+	case GFileAttributeTypeStringvValue:
+		return "stringv"
+	}
+	return ""
+}
+
+// FileAttributeTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileAttributeTypeFromString(s string) (FileAttributeType, bool) {
+	switch s {
+	case "G_FILE_ATTRIBUTE_TYPE_INVALID", "invalid":
+		return GFileAttributeTypeInvalidValue, true
+	case "G_FILE_ATTRIBUTE_TYPE_STRING", "string":
+		return GFileAttributeTypeStringValue, true
+	case "G_FILE_ATTRIBUTE_TYPE_BYTE_STRING", "byte-string":
+		return GFileAttributeTypeByteStringValue, true
+	case "G_FILE_ATTRIBUTE_TYPE_BOOLEAN", "boolean":
+		return GFileAttributeTypeBooleanValue, true
+	case "G_FILE_ATTRIBUTE_TYPE_UINT32", "uint32":
+		return GFileAttributeTypeUint32Value, true
+	case "G_FILE_ATTRIBUTE_TYPE_INT32", "int32":
+		return GFileAttributeTypeInt32Value, true
+	case "G_FILE_ATTRIBUTE_TYPE_UINT64", "uint64":
+		return GFileAttributeTypeUint64Value, true
+	case "G_FILE_ATTRIBUTE_TYPE_INT64", "int64":
+		return GFileAttributeTypeInt64Value, true
+	case "G_FILE_ATTRIBUTE_TYPE_OBJECT", "object":
+		return GFileAttributeTypeObjectValue, true
+	case "G_FILE_ATTRIBUTE_TYPE_STRINGV", "stringv":
+		return GFileAttributeTypeStringvValue, true
+	}
+	return 0, false
+}
+
 // Specifies what type of event a monitor event is.
 type FileMonitorEvent int
 
@@ -1366,32 +4042,119 @@ const (
 	// the file location was unmounted.
 	GFileMonitorEventUnmountedValue FileMonitorEvent = 6
 	// the file was moved -- only sent if the
-	//   (deprecated) %G_FILE_MONITOR_SEND_MOVED flag is set
+	//   (deprecated) [GFileMonitorSendMovedValue] flag is set
 	GFileMonitorEventMovedValue FileMonitorEvent = 7
 	// the file was renamed within the
-	//   current directory -- only sent if the %G_FILE_MONITOR_WATCH_MOVES
+	//   current directory -- only sent if the [GFileMonitorWatchMovesValue]
 	//   flag is set.  Since: 2.46.
 	GFileMonitorEventRenamedValue FileMonitorEvent = 8
 	// the file was moved into the
 	//   monitored directory from another location -- only sent if the
-	//   %G_FILE_MONITOR_WATCH_MOVES flag is set.  Since: 2.46.
+	//   [GFileMonitorWatchMovesValue] flag is set.  Since: 2.46.
 	GFileMonitorEventMovedInValue FileMonitorEvent = 9
 	// the file was moved out of the
 	//   monitored directory to another location -- only sent if the
-	//   %G_FILE_MONITOR_WATCH_MOVES flag is set.  Since: 2.46
+	//   [GFileMonitorWatchMovesValue] flag is set.  Since: 2.46
 	GFileMonitorEventMovedOutValue FileMonitorEvent = 10
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FileMonitorEvent) String() string {
+	switch x {
+	case GFileMonitorEventChangedValue:
+		return "G_FILE_MONITOR_EVENT_CHANGED"
+	case GFileMonitorEventChangesDoneHintValue:
+		return "G_FILE_MONITOR_EVENT_CHANGES_DONE_HINT"
+	case GFileMonitorEventDeletedValue:
+		return "G_FILE_MONITOR_EVENT_DELETED"
+	case GFileMonitorEventCreatedValue:
+		return "G_FILE_MONITOR_EVENT_CREATED"
+	case GFileMonitorEventAttributeChangedValue:
+		return "G_FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED"
+	case GFileMonitorEventPreUnmountValue:
+		return "G_FILE_MONITOR_EVENT_PRE_UNMOUNT"
+	case GFileMonitorEventUnmountedValue:
+		return "G_FILE_MONITOR_EVENT_UNMOUNTED"
+	case GFileMonitorEventMovedValue:
+		return "G_FILE_MONITOR_EVENT_MOVED"
+	case GFileMonitorEventRenamedValue:
+		return "G_FILE_MONITOR_EVENT_RENAMED"
+	case GFileMonitorEventMovedInValue:
+		return "G_FILE_MONITOR_EVENT_MOVED_IN"
+	case GFileMonitorEventMovedOutValue:
+		return "G_FILE_MONITOR_EVENT_MOVED_OUT"
+	}
+	return fmt.Sprintf("FileMonitorEvent(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileMonitorEvent) Nick() string {
+	switch x {
+	case GFileMonitorEventChangedValue:
+		return "changed"
+	case GFileMonitorEventChangesDoneHintValue:
+		return "changes-done-hint"
+	case GFileMonitorEventDeletedValue:
+		return "deleted"
+	case GFileMonitorEventCreatedValue:
+		return "created"
+	case GFileMonitorEventAttributeChangedValue:
+		return "attribute-changed"
+	case GFileMonitorEventPreUnmountValue:
+		return "pre-unmount"
+	case GFileMonitorEventUnmountedValue:
+		return "unmounted"
+	case GFileMonitorEventMovedValue:
+		return "moved"
+	case GFileMonitorEventRenamedValue:
+		return "renamed"
+	case GFileMonitorEventMovedInValue:
+		return "moved-in"
+	case GFileMonitorEventMovedOutValue:
+		return "moved-out"
+	}
+	return ""
+}
+
+// FileMonitorEventFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileMonitorEventFromString(s string) (FileMonitorEvent, bool) {
+	switch s {
+	case "G_FILE_MONITOR_EVENT_CHANGED", "changed":
+		return GFileMonitorEventChangedValue, true
+	case "G_FILE_MONITOR_EVENT_CHANGES_DONE_HINT", "changes-done-hint":
+		return GFileMonitorEventChangesDoneHintValue, true
+	case "G_FILE_MONITOR_EVENT_DELETED", "deleted":
+		return GFileMonitorEventDeletedValue, true
+	case "G_FILE_MONITOR_EVENT_CREATED", "created":
+		return GFileMonitorEventCreatedValue, true
+	case "G_FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED", "attribute-changed":
+		return GFileMonitorEventAttributeChangedValue, true
+	case "G_FILE_MONITOR_EVENT_PRE_UNMOUNT", "pre-unmount":
+		return GFileMonitorEventPreUnmountValue, true
+	case "G_FILE_MONITOR_EVENT_UNMOUNTED", "unmounted":
+		return GFileMonitorEventUnmountedValue, true
+	case "G_FILE_MONITOR_EVENT_MOVED", "moved":
+		return GFileMonitorEventMovedValue, true
+	case "G_FILE_MONITOR_EVENT_RENAMED", "renamed":
+		return GFileMonitorEventRenamedValue, true
+	case "G_FILE_MONITOR_EVENT_MOVED_IN", "moved-in":
+		return GFileMonitorEventMovedInValue, true
+	case "G_FILE_MONITOR_EVENT_MOVED_OUT", "moved-out":
+		return GFileMonitorEventMovedOutValue, true
+	}
+	return 0, false
+}
+
 // Indicates the file's on-disk type.
 //
-// On Windows systems a file will never have %G_FILE_TYPE_SYMBOLIC_LINK type;
-// use #GFileInfo and %G_FILE_ATTRIBUTE_STANDARD_IS_SYMLINK to determine
+// On Windows systems a file will never have [GFileTypeSymbolicLinkValue] type;
+// use [FileInfo] and [FILE_ATTRIBUTE_STANDARD_IS_SYMLINK] to determine
 // whether a file is a symlink or not. This is due to the fact that NTFS does
 // not have a single filesystem object type for symbolic links - it has
 // files that symlink to files, and directories that symlink to directories.
-// #GFileType enumeration cannot precisely represent this important distinction,
+// [FileType] enumeration cannot precisely represent this important distinction,
 // which is why all Windows symlinks will continue to be reported as
-// %G_FILE_TYPE_REGULAR or %G_FILE_TYPE_DIRECTORY.
+// [GFileTypeRegularValue] or [GFileTypeDirectoryValue].
 type FileType int
 
 var xFileTypeGLibType func() types.GType
@@ -1420,9 +4183,72 @@ const (
 	GFileTypeMountableValue FileType = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FileType) String() string {
+	switch x {
+	case GFileTypeUnknownValue:
+		return "G_FILE_TYPE_UNKNOWN"
+	case GFileTypeRegularValue:
+		return "G_FILE_TYPE_REGULAR"
+	case GFileTypeDirectoryValue:
+		return "G_FILE_TYPE_DIRECTORY"
+	case GFileTypeSymbolicLinkValue:
+		return "G_FILE_TYPE_SYMBOLIC_LINK"
+	case GFileTypeSpecialValue:
+		return "G_FILE_TYPE_SPECIAL"
+	case GFileTypeShortcutValue:
+		return "G_FILE_TYPE_SHORTCUT"
+	case GFileTypeMountableValue:
+		return "G_FILE_TYPE_MOUNTABLE"
+	}
+	return fmt.Sprintf("FileType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileType) Nick() string {
+	switch x {
+	case GFileTypeUnknownValue:
+		return "unknown"
+	case GFileTypeRegularValue:
+		return "regular"
+	case GFileTypeDirectoryValue:
+		return "directory"
+	case GFileTypeSymbolicLinkValue:
+		return "symbolic-link"
+	case GFileTypeSpecialValue:
+		return "special"
+	case GFileTypeShortcutValue:
+		return "shortcut"
+	case GFileTypeMountableValue:
+		return "mountable"
+	}
+	return ""
+}
+
+// FileTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileTypeFromString(s string) (FileType, bool) {
+	switch s {
+	case "G_FILE_TYPE_UNKNOWN", "unknown":
+		return GFileTypeUnknownValue, true
+	case "G_FILE_TYPE_REGULAR", "regular":
+		return GFileTypeRegularValue, true
+	case "G_FILE_TYPE_DIRECTORY", "directory":
+		return GFileTypeDirectoryValue, true
+	case "G_FILE_TYPE_SYMBOLIC_LINK", "symbolic-link":
+		return GFileTypeSymbolicLinkValue, true
+	case "G_FILE_TYPE_SPECIAL", "special":
+		return GFileTypeSpecialValue, true
+	case "G_FILE_TYPE_SHORTCUT", "shortcut":
+		return GFileTypeShortcutValue, true
+	case "G_FILE_TYPE_MOUNTABLE", "mountable":
+		return GFileTypeMountableValue, true
+	}
+	return 0, false
+}
+
 // Indicates a hint from the file system whether files should be
 // previewed in a file manager. Returned as the value of the key
-// %G_FILE_ATTRIBUTE_FILESYSTEM_USE_PREVIEW.
+// [FILE_ATTRIBUTE_FILESYSTEM_USE_PREVIEW].
 type FilesystemPreviewType int
 
 var xFilesystemPreviewTypeGLibType func() types.GType
@@ -1441,26 +4267,63 @@ const (
 	GFilesystemPreviewTypeNeverValue FilesystemPreviewType = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FilesystemPreviewType) String() string {
+	switch x {
+	case GFilesystemPreviewTypeIfAlwaysValue:
+		return "G_FILESYSTEM_PREVIEW_TYPE_IF_ALWAYS"
+	case GFilesystemPreviewTypeIfLocalValue:
+		return "G_FILESYSTEM_PREVIEW_TYPE_IF_LOCAL"
+	case GFilesystemPreviewTypeNeverValue:
+		return "G_FILESYSTEM_PREVIEW_TYPE_NEVER"
+	}
+	return fmt.Sprintf("FilesystemPreviewType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FilesystemPreviewType) Nick() string {
+	switch x {
+	case GFilesystemPreviewTypeIfAlwaysValue:
+		return "if-always"
+	case GFilesystemPreviewTypeIfLocalValue:
+		return "if-local"
+	case GFilesystemPreviewTypeNeverValue:
+		return "never"
+	}
+	return ""
+}
+
+// FilesystemPreviewTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FilesystemPreviewTypeFromString(s string) (FilesystemPreviewType, bool) {
+	switch s {
+	case "G_FILESYSTEM_PREVIEW_TYPE_IF_ALWAYS", "if-always":
+		return GFilesystemPreviewTypeIfAlwaysValue, true
+	case "G_FILESYSTEM_PREVIEW_TYPE_IF_LOCAL", "if-local":
+		return GFilesystemPreviewTypeIfLocalValue, true
+	case "G_FILESYSTEM_PREVIEW_TYPE_NEVER", "never":
+		return GFilesystemPreviewTypeNeverValue, true
+	}
+	return 0, false
+}
+
 // Error codes returned by GIO functions.
 //
 // Note that this domain may be extended in future GLib releases. In
 // general, new error codes either only apply to new APIs, or else
-// replace %G_IO_ERROR_FAILED in cases that were not explicitly
+// replace [GIoErrorFailedValue] in cases that were not explicitly
 // distinguished before. You should therefore avoid writing code like
-// |[&lt;!-- language="C" --&gt;
-// if (g_error_matches (error, G_IO_ERROR, G_IO_ERROR_FAILED))
 //
-//	{
-//	  // Assume that this is EPRINTERONFIRE
-//	  ...
-//	}
+//	if (g_error_matches (error, G_IO_ERROR, G_IO_ERROR_FAILED))
+//	  {
+//	    // Assume that this is EPRINTERONFIRE
+//	    ...
+//	  }
 //
-// ]|
 // but should instead treat all unrecognized error codes the same as
-// %G_IO_ERROR_FAILED.
+// [GIoErrorFailedValue].
 //
-// See also #GPollableReturn for a cheaper way of returning
-// %G_IO_ERROR_WOULD_BLOCK to callers without allocating a #GError.
+// See also [PollableReturn] for a cheaper way of returning
+// [GIoErrorWouldBlockValue] to callers without allocating a [glib.Error].
 type IOErrorEnum int
 
 var xIOErrorEnumGLibType func() types.GType
@@ -1472,7 +4335,7 @@ func IOErrorEnumGLibType() types.GType {
 const (
 
 	// Generic error condition for when an operation fails
-	//     and no more specific #GIOErrorEnum value is defined.
+	//     and no more specific [IOErrorEnum] value is defined.
 	GIoErrorFailedValue IOErrorEnum = 0
 	// File not found.
 	GIoErrorNotFoundValue IOErrorEnum = 1
@@ -1510,7 +4373,7 @@ const (
 	GIoErrorAlreadyMountedValue IOErrorEnum = 17
 	// File was closed.
 	GIoErrorClosedValue IOErrorEnum = 18
-	// Operation was cancelled. See #GCancellable.
+	// Operation was cancelled. See [Cancellable].
 	GIoErrorCancelledValue IOErrorEnum = 19
 	// Operations are still pending.
 	GIoErrorPendingValue IOErrorEnum = 20
@@ -1548,9 +4411,9 @@ const (
 	// The input data was invalid. Since 2.24
 	GIoErrorInvalidDataValue IOErrorEnum = 35
 	// A remote object generated an error that
-	//     doesn't correspond to a locally registered #GError error
-	//     domain. Use g_dbus_error_get_remote_error() to extract the D-Bus
-	//     error name and g_dbus_error_strip_remote_error() to fix up the
+	//     doesn't correspond to a locally registered [glib.Error] error
+	//     domain. Use [DBusErrorGetRemoteError] to extract the D-Bus
+	//     error name and [DBusErrorStripRemoteError] to fix up the
 	//     message so it matches what was received on the wire. Since 2.26.
 	GIoErrorDbusErrorValue IOErrorEnum = 36
 	// Host unreachable. Since 2.26
@@ -1571,9 +4434,9 @@ const (
 	// Broken pipe. Since 2.36
 	GIoErrorBrokenPipeValue IOErrorEnum = 44
 	// Connection closed by peer. Note that this
-	//     is the same code as %G_IO_ERROR_BROKEN_PIPE; before 2.44 some
-	//     "connection closed" errors returned %G_IO_ERROR_BROKEN_PIPE, but others
-	//     returned %G_IO_ERROR_FAILED. Now they should all return the same
+	//     is the same code as [GIoErrorBrokenPipeValue]; before 2.44 some
+	//     "connection closed" errors returned [GIoErrorBrokenPipeValue], but others
+	//     returned [GIoErrorFailedValue]. Now they should all return the same
 	//     value, which has this more logical name. Since 2.44.
 	GIoErrorConnectionClosedValue IOErrorEnum = 44
 	// Transport endpoint is not connected. Since 2.44
@@ -1586,6 +4449,433 @@ const (
 	GIoErrorDestinationUnsetValue IOErrorEnum = 48
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (IOErrorEnum) ErrorDomain() string {
+	return "g-io-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x IOErrorEnum) Error() string {
+	switch x {
+	case GIoErrorFailedValue:
+		return "G_IO_ERROR_FAILED"
+	case GIoErrorNotFoundValue:
+		return "G_IO_ERROR_NOT_FOUND"
+	case GIoErrorExistsValue:
+		return "G_IO_ERROR_EXISTS"
+	case GIoErrorIsDirectoryValue:
+		return "G_IO_ERROR_IS_DIRECTORY"
+	case GIoErrorNotDirectoryValue:
+		return "G_IO_ERROR_NOT_DIRECTORY"
+	case GIoErrorNotEmptyValue:
+		return "G_IO_ERROR_NOT_EMPTY"
+	case GIoErrorNotRegularFileValue:
+		return "G_IO_ERROR_NOT_REGULAR_FILE"
+	case GIoErrorNotSymbolicLinkValue:
+		return "G_IO_ERROR_NOT_SYMBOLIC_LINK"
+	case GIoErrorNotMountableFileValue:
+		return "G_IO_ERROR_NOT_MOUNTABLE_FILE"
+	case GIoErrorFilenameTooLongValue:
+		return "G_IO_ERROR_FILENAME_TOO_LONG"
+	case GIoErrorInvalidFilenameValue:
+		return "G_IO_ERROR_INVALID_FILENAME"
+	case GIoErrorTooManyLinksValue:
+		return "G_IO_ERROR_TOO_MANY_LINKS"
+	case GIoErrorNoSpaceValue:
+		return "G_IO_ERROR_NO_SPACE"
+	case GIoErrorInvalidArgumentValue:
+		return "G_IO_ERROR_INVALID_ARGUMENT"
+	case GIoErrorPermissionDeniedValue:
+		return "G_IO_ERROR_PERMISSION_DENIED"
+	case GIoErrorNotSupportedValue:
+		return "G_IO_ERROR_NOT_SUPPORTED"
+	case GIoErrorNotMountedValue:
+		return "G_IO_ERROR_NOT_MOUNTED"
+	case GIoErrorAlreadyMountedValue:
+		return "G_IO_ERROR_ALREADY_MOUNTED"
+	case GIoErrorClosedValue:
+		return "G_IO_ERROR_CLOSED"
+	case GIoErrorCancelledValue:
+		return "G_IO_ERROR_CANCELLED"
+	case GIoErrorPendingValue:
+		return "G_IO_ERROR_PENDING"
+	case GIoErrorReadOnlyValue:
+		return "G_IO_ERROR_READ_ONLY"
+	case GIoErrorCantCreateBackupValue:
+		return "G_IO_ERROR_CANT_CREATE_BACKUP"
+	case GIoErrorWrongEtagValue:
+		return "G_IO_ERROR_WRONG_ETAG"
+	case GIoErrorTimedOutValue:
+		return "G_IO_ERROR_TIMED_OUT"
+	case GIoErrorWouldRecurseValue:
+		return "G_IO_ERROR_WOULD_RECURSE"
+	case GIoErrorBusyValue:
+		return "G_IO_ERROR_BUSY"
+	case GIoErrorWouldBlockValue:
+		return "G_IO_ERROR_WOULD_BLOCK"
+	case GIoErrorHostNotFoundValue:
+		return "G_IO_ERROR_HOST_NOT_FOUND"
+	case GIoErrorWouldMergeValue:
+		return "G_IO_ERROR_WOULD_MERGE"
+	case GIoErrorFailedHandledValue:
+		return "G_IO_ERROR_FAILED_HANDLED"
+	case GIoErrorTooManyOpenFilesValue:
+		return "G_IO_ERROR_TOO_MANY_OPEN_FILES"
+	case GIoErrorNotInitializedValue:
+		return "G_IO_ERROR_NOT_INITIALIZED"
+	case GIoErrorAddressInUseValue:
+		return "G_IO_ERROR_ADDRESS_IN_USE"
+	case GIoErrorPartialInputValue:
+		return "G_IO_ERROR_PARTIAL_INPUT"
+	case GIoErrorInvalidDataValue:
+		return "G_IO_ERROR_INVALID_DATA"
+	case GIoErrorDbusErrorValue:
+		return "G_IO_ERROR_DBUS_ERROR"
+	case GIoErrorHostUnreachableValue:
+		return "G_IO_ERROR_HOST_UNREACHABLE"
+	case GIoErrorNetworkUnreachableValue:
+		return "G_IO_ERROR_NETWORK_UNREACHABLE"
+	case GIoErrorConnectionRefusedValue:
+		return "G_IO_ERROR_CONNECTION_REFUSED"
+	case GIoErrorProxyFailedValue:
+		return "G_IO_ERROR_PROXY_FAILED"
+	case GIoErrorProxyAuthFailedValue:
+		return "G_IO_ERROR_PROXY_AUTH_FAILED"
+	case GIoErrorProxyNeedAuthValue:
+		return "G_IO_ERROR_PROXY_NEED_AUTH"
+	case GIoErrorProxyNotAllowedValue:
+		return "G_IO_ERROR_PROXY_NOT_ALLOWED"
+	case GIoErrorBrokenPipeValue:
+		return "G_IO_ERROR_BROKEN_PIPE"
+	case GIoErrorNotConnectedValue:
+		return "G_IO_ERROR_NOT_CONNECTED"
+	case GIoErrorMessageTooLargeValue:
+		return "G_IO_ERROR_MESSAGE_TOO_LARGE"
+	case GIoErrorNoSuchDeviceValue:
+		return "G_IO_ERROR_NO_SUCH_DEVICE"
+	case GIoErrorDestinationUnsetValue:
+		return "G_IO_ERROR_DESTINATION_UNSET"
+	}
+	return fmt.Sprintf("g-io-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x IOErrorEnum) String() string {
+	switch x {
+	case GIoErrorFailedValue:
+		return "G_IO_ERROR_FAILED"
+	case GIoErrorNotFoundValue:
+		return "G_IO_ERROR_NOT_FOUND"
+	case GIoErrorExistsValue:
+		return "G_IO_ERROR_EXISTS"
+	case GIoErrorIsDirectoryValue:
+		return "G_IO_ERROR_IS_DIRECTORY"
+	case GIoErrorNotDirectoryValue:
+		return "G_IO_ERROR_NOT_DIRECTORY"
+	case GIoErrorNotEmptyValue:
+		return "G_IO_ERROR_NOT_EMPTY"
+	case GIoErrorNotRegularFileValue:
+		return "G_IO_ERROR_NOT_REGULAR_FILE"
+	case GIoErrorNotSymbolicLinkValue:
+		return "G_IO_ERROR_NOT_SYMBOLIC_LINK"
+	case GIoErrorNotMountableFileValue:
+		return "G_IO_ERROR_NOT_MOUNTABLE_FILE"
+	case GIoErrorFilenameTooLongValue:
+		return "G_IO_ERROR_FILENAME_TOO_LONG"
+	case GIoErrorInvalidFilenameValue:
+		return "G_IO_ERROR_INVALID_FILENAME"
+	case GIoErrorTooManyLinksValue:
+		return "G_IO_ERROR_TOO_MANY_LINKS"
+	case GIoErrorNoSpaceValue:
+		return "G_IO_ERROR_NO_SPACE"
+	case GIoErrorInvalidArgumentValue:
+		return "G_IO_ERROR_INVALID_ARGUMENT"
+	case GIoErrorPermissionDeniedValue:
+		return "G_IO_ERROR_PERMISSION_DENIED"
+	case GIoErrorNotSupportedValue:
+		return "G_IO_ERROR_NOT_SUPPORTED"
+	case GIoErrorNotMountedValue:
+		return "G_IO_ERROR_NOT_MOUNTED"
+	case GIoErrorAlreadyMountedValue:
+		return "G_IO_ERROR_ALREADY_MOUNTED"
+	case GIoErrorClosedValue:
+		return "G_IO_ERROR_CLOSED"
+	case GIoErrorCancelledValue:
+		return "G_IO_ERROR_CANCELLED"
+	case GIoErrorPendingValue:
+		return "G_IO_ERROR_PENDING"
+	case GIoErrorReadOnlyValue:
+		return "G_IO_ERROR_READ_ONLY"
+	case GIoErrorCantCreateBackupValue:
+		return "G_IO_ERROR_CANT_CREATE_BACKUP"
+	case GIoErrorWrongEtagValue:
+		return "G_IO_ERROR_WRONG_ETAG"
+	case GIoErrorTimedOutValue:
+		return "G_IO_ERROR_TIMED_OUT"
+	case GIoErrorWouldRecurseValue:
+		return "G_IO_ERROR_WOULD_RECURSE"
+	case GIoErrorBusyValue:
+		return "G_IO_ERROR_BUSY"
+	case GIoErrorWouldBlockValue:
+		return "G_IO_ERROR_WOULD_BLOCK"
+	case GIoErrorHostNotFoundValue:
+		return "G_IO_ERROR_HOST_NOT_FOUND"
+	case GIoErrorWouldMergeValue:
+		return "G_IO_ERROR_WOULD_MERGE"
+	case GIoErrorFailedHandledValue:
+		return "G_IO_ERROR_FAILED_HANDLED"
+	case GIoErrorTooManyOpenFilesValue:
+		return "G_IO_ERROR_TOO_MANY_OPEN_FILES"
+	case GIoErrorNotInitializedValue:
+		return "G_IO_ERROR_NOT_INITIALIZED"
+	case GIoErrorAddressInUseValue:
+		return "G_IO_ERROR_ADDRESS_IN_USE"
+	case GIoErrorPartialInputValue:
+		return "G_IO_ERROR_PARTIAL_INPUT"
+	case GIoErrorInvalidDataValue:
+		return "G_IO_ERROR_INVALID_DATA"
+	case GIoErrorDbusErrorValue:
+		return "G_IO_ERROR_DBUS_ERROR"
+	case GIoErrorHostUnreachableValue:
+		return "G_IO_ERROR_HOST_UNREACHABLE"
+	case GIoErrorNetworkUnreachableValue:
+		return "G_IO_ERROR_NETWORK_UNREACHABLE"
+	case GIoErrorConnectionRefusedValue:
+		return "G_IO_ERROR_CONNECTION_REFUSED"
+	case GIoErrorProxyFailedValue:
+		return "G_IO_ERROR_PROXY_FAILED"
+	case GIoErrorProxyAuthFailedValue:
+		return "G_IO_ERROR_PROXY_AUTH_FAILED"
+	case GIoErrorProxyNeedAuthValue:
+		return "G_IO_ERROR_PROXY_NEED_AUTH"
+	case GIoErrorProxyNotAllowedValue:
+		return "G_IO_ERROR_PROXY_NOT_ALLOWED"
+	case GIoErrorBrokenPipeValue:
+		return "G_IO_ERROR_BROKEN_PIPE"
+	case GIoErrorNotConnectedValue:
+		return "G_IO_ERROR_NOT_CONNECTED"
+	case GIoErrorMessageTooLargeValue:
+		return "G_IO_ERROR_MESSAGE_TOO_LARGE"
+	case GIoErrorNoSuchDeviceValue:
+		return "G_IO_ERROR_NO_SUCH_DEVICE"
+	case GIoErrorDestinationUnsetValue:
+		return "G_IO_ERROR_DESTINATION_UNSET"
+	}
+	return fmt.Sprintf("IOErrorEnum(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IOErrorEnum) Nick() string {
+	switch x {
+	case GIoErrorFailedValue:
+		return "failed"
+	case GIoErrorNotFoundValue:
+		return "not-found"
+	case GIoErrorExistsValue:
+		return "exists"
+	case GIoErrorIsDirectoryValue:
+		return "is-directory"
+	case GIoErrorNotDirectoryValue:
+		return "not-directory"
+	case GIoErrorNotEmptyValue:
+		return "not-empty"
+	case GIoErrorNotRegularFileValue:
+		return "not-regular-file"
+	case GIoErrorNotSymbolicLinkValue:
+		return "not-symbolic-link"
+	case GIoErrorNotMountableFileValue:
+		return "not-mountable-file"
+	case GIoErrorFilenameTooLongValue:
+		return "filename-too-long"
+	case GIoErrorInvalidFilenameValue:
+		return "invalid-filename"
+	case GIoErrorTooManyLinksValue:
+		return "too-many-links"
+	case GIoErrorNoSpaceValue:
+		return "no-space"
+	case GIoErrorInvalidArgumentValue:
+		return "invalid-argument"
+	case GIoErrorPermissionDeniedValue:
+		return "permission-denied"
+	case GIoErrorNotSupportedValue:
+		return "not-supported"
+	case GIoErrorNotMountedValue:
+		return "not-mounted"
+	case GIoErrorAlreadyMountedValue:
+		return "already-mounted"
+	case GIoErrorClosedValue:
+		return "closed"
+	case GIoErrorCancelledValue:
+		return "cancelled"
+	case GIoErrorPendingValue:
+		return "pending"
+	case GIoErrorReadOnlyValue:
+		return "read-only"
+	case GIoErrorCantCreateBackupValue:
+		return "cant-create-backup"
+	case GIoErrorWrongEtagValue:
+		return "wrong-etag"
+	case GIoErrorTimedOutValue:
+		return "timed-out"
+	case GIoErrorWouldRecurseValue:
+		return "would-recurse"
+	case GIoErrorBusyValue:
+		return "busy"
+	case GIoErrorWouldBlockValue:
+		return "would-block"
+	case GIoErrorHostNotFoundValue:
+		return "host-not-found"
+	case GIoErrorWouldMergeValue:
+		return "would-merge"
+	case GIoErrorFailedHandledValue:
+		return "failed-handled"
+	case GIoErrorTooManyOpenFilesValue:
+		return "too-many-open-files"
+	case GIoErrorNotInitializedValue:
+		return "not-initialized"
+	case GIoErrorAddressInUseValue:
+		return "address-in-use"
+	case GIoErrorPartialInputValue:
+		return "partial-input"
+	case GIoErrorInvalidDataValue:
+		return "invalid-data"
+	case GIoErrorDbusErrorValue:
+		return "dbus-error"
+	case GIoErrorHostUnreachableValue:
+		return "host-unreachable"
+	case GIoErrorNetworkUnreachableValue:
+		return "network-unreachable"
+	case GIoErrorConnectionRefusedValue:
+		return "connection-refused"
+	case GIoErrorProxyFailedValue:
+		return "proxy-failed"
+	case GIoErrorProxyAuthFailedValue:
+		return "proxy-auth-failed"
+	case GIoErrorProxyNeedAuthValue:
+		return "proxy-need-auth"
+	case GIoErrorProxyNotAllowedValue:
+		return "proxy-not-allowed"
+	case GIoErrorBrokenPipeValue:
+		return "broken-pipe"
+	case GIoErrorNotConnectedValue:
+		return "not-connected"
+	case GIoErrorMessageTooLargeValue:
+		return "message-too-large"
+	case GIoErrorNoSuchDeviceValue:
+		return "no-such-device"
+	case GIoErrorDestinationUnsetValue:
+		return "destination-unset"
+	}
+	return ""
+}
+
+// IOErrorEnumFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IOErrorEnumFromString(s string) (IOErrorEnum, bool) {
+	switch s {
+	case "G_IO_ERROR_FAILED", "failed":
+		return GIoErrorFailedValue, true
+	case "G_IO_ERROR_NOT_FOUND", "not-found":
+		return GIoErrorNotFoundValue, true
+	case "G_IO_ERROR_EXISTS", "exists":
+		return GIoErrorExistsValue, true
+	case "G_IO_ERROR_IS_DIRECTORY", "is-directory":
+		return GIoErrorIsDirectoryValue, true
+	case "G_IO_ERROR_NOT_DIRECTORY", "not-directory":
+		return GIoErrorNotDirectoryValue, true
+	case "G_IO_ERROR_NOT_EMPTY", "not-empty":
+		return GIoErrorNotEmptyValue, true
+	case "G_IO_ERROR_NOT_REGULAR_FILE", "not-regular-file":
+		return GIoErrorNotRegularFileValue, true
+	case "G_IO_ERROR_NOT_SYMBOLIC_LINK", "not-symbolic-link":
+		return GIoErrorNotSymbolicLinkValue, true
+	case "G_IO_ERROR_NOT_MOUNTABLE_FILE", "not-mountable-file":
+		return GIoErrorNotMountableFileValue, true
+	case "G_IO_ERROR_FILENAME_TOO_LONG", "filename-too-long":
+		return GIoErrorFilenameTooLongValue, true
+	case "G_IO_ERROR_INVALID_FILENAME", "invalid-filename":
+		return GIoErrorInvalidFilenameValue, true
+	case "G_IO_ERROR_TOO_MANY_LINKS", "too-many-links":
+		return GIoErrorTooManyLinksValue, true
+	case "G_IO_ERROR_NO_SPACE", "no-space":
+		return GIoErrorNoSpaceValue, true
+	case "G_IO_ERROR_INVALID_ARGUMENT", "invalid-argument":
+		return GIoErrorInvalidArgumentValue, true
+	case "G_IO_ERROR_PERMISSION_DENIED", "permission-denied":
+		return GIoErrorPermissionDeniedValue, true
+	case "G_IO_ERROR_NOT_SUPPORTED", "not-supported":
+		return GIoErrorNotSupportedValue, true
+	case "G_IO_ERROR_NOT_MOUNTED", "not-mounted":
+		return GIoErrorNotMountedValue, true
+	case "G_IO_ERROR_ALREADY_MOUNTED", "already-mounted":
+		return GIoErrorAlreadyMountedValue, true
+	case "G_IO_ERROR_CLOSED", "closed":
+		return GIoErrorClosedValue, true
+	case "G_IO_ERROR_CANCELLED", "cancelled":
+		return GIoErrorCancelledValue, true
+	case "G_IO_ERROR_PENDING", "pending":
+		return GIoErrorPendingValue, true
+	case "G_IO_ERROR_READ_ONLY", "read-only":
+		return GIoErrorReadOnlyValue, true
+	case "G_IO_ERROR_CANT_CREATE_BACKUP", "cant-create-backup":
+		return GIoErrorCantCreateBackupValue, true
+	case "G_IO_ERROR_WRONG_ETAG", "wrong-etag":
+		return GIoErrorWrongEtagValue, true
+	case "G_IO_ERROR_TIMED_OUT", "timed-out":
+		return GIoErrorTimedOutValue, true
+	case "G_IO_ERROR_WOULD_RECURSE", "would-recurse":
+		return GIoErrorWouldRecurseValue, true
+	case "G_IO_ERROR_BUSY", "busy":
+		return GIoErrorBusyValue, true
+	case "G_IO_ERROR_WOULD_BLOCK", "would-block":
+		return GIoErrorWouldBlockValue, true
+	case "G_IO_ERROR_HOST_NOT_FOUND", "host-not-found":
+		return GIoErrorHostNotFoundValue, true
+	case "G_IO_ERROR_WOULD_MERGE", "would-merge":
+		return GIoErrorWouldMergeValue, true
+	case "G_IO_ERROR_FAILED_HANDLED", "failed-handled":
+		return GIoErrorFailedHandledValue, true
+	case "G_IO_ERROR_TOO_MANY_OPEN_FILES", "too-many-open-files":
+		return GIoErrorTooManyOpenFilesValue, true
+	case "G_IO_ERROR_NOT_INITIALIZED", "not-initialized":
+		return GIoErrorNotInitializedValue, true
+	case "G_IO_ERROR_ADDRESS_IN_USE", "address-in-use":
+		return GIoErrorAddressInUseValue, true
+	case "G_IO_ERROR_PARTIAL_INPUT", "partial-input":
+		return GIoErrorPartialInputValue, true
+	case "G_IO_ERROR_INVALID_DATA", "invalid-data":
+		return GIoErrorInvalidDataValue, true
+	case "G_IO_ERROR_DBUS_ERROR", "dbus-error":
+		return GIoErrorDbusErrorValue, true
+	case "G_IO_ERROR_HOST_UNREACHABLE", "host-unreachable":
+		return GIoErrorHostUnreachableValue, true
+	case "G_IO_ERROR_NETWORK_UNREACHABLE", "network-unreachable":
+		return GIoErrorNetworkUnreachableValue, true
+	case "G_IO_ERROR_CONNECTION_REFUSED", "connection-refused":
+		return GIoErrorConnectionRefusedValue, true
+	case "G_IO_ERROR_PROXY_FAILED", "proxy-failed":
+		return GIoErrorProxyFailedValue, true
+	case "G_IO_ERROR_PROXY_AUTH_FAILED", "proxy-auth-failed":
+		return GIoErrorProxyAuthFailedValue, true
+	case "G_IO_ERROR_PROXY_NEED_AUTH", "proxy-need-auth":
+		return GIoErrorProxyNeedAuthValue, true
+	case "G_IO_ERROR_PROXY_NOT_ALLOWED", "proxy-not-allowed":
+		return GIoErrorProxyNotAllowedValue, true
+	case "G_IO_ERROR_BROKEN_PIPE", "broken-pipe":
+		return GIoErrorBrokenPipeValue, true
+	case "G_IO_ERROR_CONNECTION_CLOSED", "connection-closed":
+		return GIoErrorConnectionClosedValue, true
+	case "G_IO_ERROR_NOT_CONNECTED", "not-connected":
+		return GIoErrorNotConnectedValue, true
+	case "G_IO_ERROR_MESSAGE_TOO_LARGE", "message-too-large":
+		return GIoErrorMessageTooLargeValue, true
+	case "G_IO_ERROR_NO_SUCH_DEVICE", "no-such-device":
+		return GIoErrorNoSuchDeviceValue, true
+	case "G_IO_ERROR_DESTINATION_UNSET", "destination-unset":
+		return GIoErrorDestinationUnsetValue, true
+	}
+	return 0, false
+}
+
 // Flags for use with g_io_module_scope_new().
 type IOModuleScopeFlags int
 
@@ -1605,16 +4895,46 @@ const (
 	GIoModuleScopeBlockDuplicatesValue IOModuleScopeFlags = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x IOModuleScopeFlags) String() string {
+	switch x {
+	case GIoModuleScopeNoneValue:
+		return "G_IO_MODULE_SCOPE_NONE"
+	case GIoModuleScopeBlockDuplicatesValue:
+		return "G_IO_MODULE_SCOPE_BLOCK_DUPLICATES"
+	}
+	return fmt.Sprintf("IOModuleScopeFlags(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IOModuleScopeFlags) Nick() string {
+	switch x {
+	case GIoModuleScopeNoneValue:
+		return "none"
+	case GIoModuleScopeBlockDuplicatesValue:
+		return "block-duplicates"
+	}
+	return ""
+}
+
+// IOModuleScopeFlagsFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IOModuleScopeFlagsFromString(s string) (IOModuleScopeFlags, bool) {
+	switch s {
+	case "G_IO_MODULE_SCOPE_NONE", "none":
+		return GIoModuleScopeNoneValue, true
+	case "G_IO_MODULE_SCOPE_BLOCK_DUPLICATES", "block-duplicates":
+		return GIoModuleScopeBlockDuplicatesValue, true
+	}
+	return 0, false
+}
+
 // Memory availability warning levels.
 //
 // Note that because new values might be added, it is recommended that applications check
-// #GMemoryMonitorWarningLevel as ranges, for example:
-// |[&lt;!-- language="C" --&gt;
-// if (warning_level &gt; G_MEMORY_MONITOR_WARNING_LEVEL_LOW)
+// [MemoryMonitorWarningLevel] as ranges, for example:
 //
-//	drop_caches ();
-//
-// ]|
+//	if (warning_level > G_MEMORY_MONITOR_WARNING_LEVEL_LOW)
+//	  drop_caches ();
 type MemoryMonitorWarningLevel int
 
 var xMemoryMonitorWarningLevelGLibType func() types.GType
@@ -1639,7 +4959,46 @@ const (
 	GMemoryMonitorWarningLevelCriticalValue MemoryMonitorWarningLevel = 255
 )
 
-// #GMountOperationResult is returned as a result when a request for
+// String returns the C name of the value, e.g. for debugging output
+func (x MemoryMonitorWarningLevel) String() string {
+	switch x {
+	case GMemoryMonitorWarningLevelLowValue:
+		return "G_MEMORY_MONITOR_WARNING_LEVEL_LOW"
+	case GMemoryMonitorWarningLevelMediumValue:
+		return "G_MEMORY_MONITOR_WARNING_LEVEL_MEDIUM"
+	case GMemoryMonitorWarningLevelCriticalValue:
+		return "G_MEMORY_MONITOR_WARNING_LEVEL_CRITICAL"
+	}
+	return fmt.Sprintf("MemoryMonitorWarningLevel(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MemoryMonitorWarningLevel) Nick() string {
+	switch x {
+	case GMemoryMonitorWarningLevelLowValue:
+		return "low"
+	case GMemoryMonitorWarningLevelMediumValue:
+		return "medium"
+	case GMemoryMonitorWarningLevelCriticalValue:
+		return "critical"
+	}
+	return ""
+}
+
+// MemoryMonitorWarningLevelFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MemoryMonitorWarningLevelFromString(s string) (MemoryMonitorWarningLevel, bool) {
+	switch s {
+	case "G_MEMORY_MONITOR_WARNING_LEVEL_LOW", "low":
+		return GMemoryMonitorWarningLevelLowValue, true
+	case "G_MEMORY_MONITOR_WARNING_LEVEL_MEDIUM", "medium":
+		return GMemoryMonitorWarningLevelMediumValue, true
+	case "G_MEMORY_MONITOR_WARNING_LEVEL_CRITICAL", "critical":
+		return GMemoryMonitorWarningLevelCriticalValue, true
+	}
+	return 0, false
+}
+
+// [MountOperationResult] is returned as a result when a request for
 // information is send by the mounting operation.
 type MountOperationResult int
 
@@ -1662,7 +5021,46 @@ const (
 	GMountOperationUnhandledValue MountOperationResult = 2
 )
 
-// The host's network connectivity state, as reported by #GNetworkMonitor.
+// String returns the C name of the value, e.g. for debugging output
+func (x MountOperationResult) String() string {
+	switch x {
+	case GMountOperationHandledValue:
+		return "G_MOUNT_OPERATION_HANDLED"
+	case GMountOperationAbortedValue:
+		return "G_MOUNT_OPERATION_ABORTED"
+	case GMountOperationUnhandledValue:
+		return "G_MOUNT_OPERATION_UNHANDLED"
+	}
+	return fmt.Sprintf("MountOperationResult(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MountOperationResult) Nick() string {
+	switch x {
+	case GMountOperationHandledValue:
+		return "handled"
+	case GMountOperationAbortedValue:
+		return "aborted"
+	case GMountOperationUnhandledValue:
+		return "unhandled"
+	}
+	return ""
+}
+
+// MountOperationResultFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MountOperationResultFromString(s string) (MountOperationResult, bool) {
+	switch s {
+	case "G_MOUNT_OPERATION_HANDLED", "handled":
+		return GMountOperationHandledValue, true
+	case "G_MOUNT_OPERATION_ABORTED", "aborted":
+		return GMountOperationAbortedValue, true
+	case "G_MOUNT_OPERATION_UNHANDLED", "unhandled":
+		return GMountOperationUnhandledValue, true
+	}
+	return 0, false
+}
+
+// The host's network connectivity state, as reported by [NetworkMonitor].
 type NetworkConnectivity int
 
 var xNetworkConnectivityGLibType func() types.GType
@@ -1689,7 +5087,52 @@ const (
 	GNetworkConnectivityFullValue NetworkConnectivity = 4
 )
 
-// Priority levels for #GNotifications.
+// String returns the C name of the value, e.g. for debugging output
+func (x NetworkConnectivity) String() string {
+	switch x {
+	case GNetworkConnectivityLocalValue:
+		return "G_NETWORK_CONNECTIVITY_LOCAL"
+	case GNetworkConnectivityLimitedValue:
+		return "G_NETWORK_CONNECTIVITY_LIMITED"
+	case GNetworkConnectivityPortalValue:
+		return "G_NETWORK_CONNECTIVITY_PORTAL"
+	case GNetworkConnectivityFullValue:
+		return "G_NETWORK_CONNECTIVITY_FULL"
+	}
+	return fmt.Sprintf("NetworkConnectivity(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NetworkConnectivity) Nick() string {
+	switch x {
+	case GNetworkConnectivityLocalValue:
+		return "local"
+	case GNetworkConnectivityLimitedValue:
+		return "limited"
+	case GNetworkConnectivityPortalValue:
+		return "portal"
+	case GNetworkConnectivityFullValue:
+		return "full"
+	}
+	return ""
+}
+
+// NetworkConnectivityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NetworkConnectivityFromString(s string) (NetworkConnectivity, bool) {
+	switch s {
+	case "G_NETWORK_CONNECTIVITY_LOCAL", "local":
+		return GNetworkConnectivityLocalValue, true
+	case "G_NETWORK_CONNECTIVITY_LIMITED", "limited":
+		return GNetworkConnectivityLimitedValue, true
+	case "G_NETWORK_CONNECTIVITY_PORTAL", "portal":
+		return GNetworkConnectivityPortalValue, true
+	case "G_NETWORK_CONNECTIVITY_FULL", "full":
+		return GNetworkConnectivityFullValue, true
+	}
+	return 0, false
+}
+
+// Priority levels for GNotifications.
 type NotificationPriority int
 
 var xNotificationPriorityGLibType func() types.GType
@@ -1718,9 +5161,54 @@ const (
 	GNotificationPriorityUrgentValue NotificationPriority = 3
 )
 
-// #GPasswordSave is used to indicate the lifespan of a saved password.
+// String returns the C name of the value, e.g. for debugging output
+func (x NotificationPriority) String() string {
+	switch x {
+	case GNotificationPriorityNormalValue:
+		return "G_NOTIFICATION_PRIORITY_NORMAL"
+	case GNotificationPriorityLowValue:
+		return "G_NOTIFICATION_PRIORITY_LOW"
+	case GNotificationPriorityHighValue:
+		return "G_NOTIFICATION_PRIORITY_HIGH"
+	case GNotificationPriorityUrgentValue:
+		return "G_NOTIFICATION_PRIORITY_URGENT"
+	}
+	return fmt.Sprintf("NotificationPriority(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NotificationPriority) Nick() string {
+	switch x {
+	case GNotificationPriorityNormalValue:
+		return "normal"
+	case GNotificationPriorityLowValue:
+		return "low"
+	case GNotificationPriorityHighValue:
+		return "high"
+	case GNotificationPriorityUrgentValue:
+		return "urgent"
+	}
+	return ""
+}
+
+// NotificationPriorityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NotificationPriorityFromString(s string) (NotificationPriority, bool) {
+	switch s {
+	case "G_NOTIFICATION_PRIORITY_NORMAL", "normal":
+		return GNotificationPriorityNormalValue, true
+	case "G_NOTIFICATION_PRIORITY_LOW", "low":
+		return GNotificationPriorityLowValue, true
+	case "G_NOTIFICATION_PRIORITY_HIGH", "high":
+		return GNotificationPriorityHighValue, true
+	case "G_NOTIFICATION_PRIORITY_URGENT", "urgent":
+		return GNotificationPriorityUrgentValue, true
+	}
+	return 0, false
+}
+
+// [PasswordSave] is used to indicate the lifespan of a saved password.
 //
-// #Gvfs stores passwords in the Gnome keyring when this flag allows it
+// Gvfs stores passwords in the Gnome keyring when this flag allows it
 // to, and later retrieves it again from there.
 type PasswordSave int
 
@@ -1740,14 +5228,53 @@ const (
 	GPasswordSavePermanentlyValue PasswordSave = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PasswordSave) String() string {
+	switch x {
+	case GPasswordSaveNeverValue:
+		return "G_PASSWORD_SAVE_NEVER"
+	case GPasswordSaveForSessionValue:
+		return "G_PASSWORD_SAVE_FOR_SESSION"
+	case GPasswordSavePermanentlyValue:
+		return "G_PASSWORD_SAVE_PERMANENTLY"
+	}
+	return fmt.Sprintf("PasswordSave(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PasswordSave) Nick() string {
+	switch x {
+	case GPasswordSaveNeverValue:
+		return "never"
+	case GPasswordSaveForSessionValue:
+		return "for-session"
+	case GPasswordSavePermanentlyValue:
+		return "permanently"
+	}
+	return ""
+}
+
+// PasswordSaveFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PasswordSaveFromString(s string) (PasswordSave, bool) {
+	switch s {
+	case "G_PASSWORD_SAVE_NEVER", "never":
+		return GPasswordSaveNeverValue, true
+	case "G_PASSWORD_SAVE_FOR_SESSION", "for-session":
+		return GPasswordSaveForSessionValue, true
+	case "G_PASSWORD_SAVE_PERMANENTLY", "permanently":
+		return GPasswordSavePermanentlyValue, true
+	}
+	return 0, false
+}
+
 // Return value for various IO operations that signal errors via the
-// return value and not necessarily via a #GError.
+// return value and not necessarily via a [glib.Error].
 //
 // This enum exists to be able to return errors to callers without having to
-// allocate a #GError. Allocating #GErrors can be quite expensive for
-// regularly happening errors like %G_IO_ERROR_WOULD_BLOCK.
+// allocate a [glib.Error]. Allocating GErrors can be quite expensive for
+// regularly happening errors like [GIoErrorWouldBlockValue].
 //
-// In case of %G_POLLABLE_RETURN_FAILED a #GError should be set for the
+// In case of [GPollableReturnFailedValue] a [glib.Error] should be set for the
 // operation to give details about the error that happened.
 type PollableReturn int
 
@@ -1767,8 +5294,47 @@ const (
 	GPollableReturnWouldBlockValue PollableReturn = -27
 )
 
-// An error code used with %G_RESOLVER_ERROR in a #GError returned
-// from a #GResolver routine.
+// String returns the C name of the value, e.g. for debugging output
+func (x PollableReturn) String() string {
+	switch x {
+	case GPollableReturnFailedValue:
+		return "G_POLLABLE_RETURN_FAILED"
+	case GPollableReturnOkValue:
+		return "G_POLLABLE_RETURN_OK"
+	case GPollableReturnWouldBlockValue:
+		return "G_POLLABLE_RETURN_WOULD_BLOCK"
+	}
+	return fmt.Sprintf("PollableReturn(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PollableReturn) Nick() string {
+	switch x {
+	case GPollableReturnFailedValue:
+		return "failed"
+	case GPollableReturnOkValue:
+		return "ok"
+	case GPollableReturnWouldBlockValue:
+		return "would-block"
+	}
+	return ""
+}
+
+// PollableReturnFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PollableReturnFromString(s string) (PollableReturn, bool) {
+	switch s {
+	case "G_POLLABLE_RETURN_FAILED", "failed":
+		return GPollableReturnFailedValue, true
+	case "G_POLLABLE_RETURN_OK", "ok":
+		return GPollableReturnOkValue, true
+	case "G_POLLABLE_RETURN_WOULD_BLOCK", "would-block":
+		return GPollableReturnWouldBlockValue, true
+	}
+	return 0, false
+}
+
+// An error code used with G_RESOLVER_ERROR in a [glib.Error] returned
+// from a [Resolver] routine.
 type ResolverError int
 
 var xResolverErrorGLibType func() types.GType
@@ -1789,36 +5355,96 @@ const (
 	GResolverErrorInternalValue ResolverError = 2
 )
 
-// The type of record that g_resolver_lookup_records() or
-// g_resolver_lookup_records_async() should retrieve. The records are returned
-// as lists of #GVariant tuples. Each record type has different values in
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ResolverError) ErrorDomain() string {
+	return "g-resolver-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x ResolverError) Error() string {
+	switch x {
+	case GResolverErrorNotFoundValue:
+		return "G_RESOLVER_ERROR_NOT_FOUND"
+	case GResolverErrorTemporaryFailureValue:
+		return "G_RESOLVER_ERROR_TEMPORARY_FAILURE"
+	case GResolverErrorInternalValue:
+		return "G_RESOLVER_ERROR_INTERNAL"
+	}
+	return fmt.Sprintf("g-resolver-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ResolverError) String() string {
+	switch x {
+	case GResolverErrorNotFoundValue:
+		return "G_RESOLVER_ERROR_NOT_FOUND"
+	case GResolverErrorTemporaryFailureValue:
+		return "G_RESOLVER_ERROR_TEMPORARY_FAILURE"
+	case GResolverErrorInternalValue:
+		return "G_RESOLVER_ERROR_INTERNAL"
+	}
+	return fmt.Sprintf("ResolverError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ResolverError) Nick() string {
+	switch x {
+	case GResolverErrorNotFoundValue:
+		return "not-found"
+	case GResolverErrorTemporaryFailureValue:
+		return "temporary-failure"
+	case GResolverErrorInternalValue:
+		return "internal"
+	}
+	return ""
+}
+
+// ResolverErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ResolverErrorFromString(s string) (ResolverError, bool) {
+	switch s {
+	case "G_RESOLVER_ERROR_NOT_FOUND", "not-found":
+		return GResolverErrorNotFoundValue, true
+	case "G_RESOLVER_ERROR_TEMPORARY_FAILURE", "temporary-failure":
+		return GResolverErrorTemporaryFailureValue, true
+	case "G_RESOLVER_ERROR_INTERNAL", "internal":
+		return GResolverErrorInternalValue, true
+	}
+	return 0, false
+}
+
+// The type of record that [Resolver.LookupRecords] or
+// [Resolver.LookupRecordsAsync] should retrieve. The records are returned
+// as lists of [glib.Variant] tuples. Each record type has different values in
 // the variant tuples returned.
 //
-// %G_RESOLVER_RECORD_SRV records are returned as variants with the signature
+// [GResolverRecordSrvValue] records are returned as variants with the signature
 // `(qqqs)`, containing a `guint16` with the priority, a `guint16` with the
 // weight, a `guint16` with the port, and a string of the hostname.
 //
-// %G_RESOLVER_RECORD_MX records are returned as variants with the signature
+// [GResolverRecordMxValue] records are returned as variants with the signature
 // `(qs)`, representing a `guint16` with the preference, and a string containing
 // the mail exchanger hostname.
 //
-// %G_RESOLVER_RECORD_TXT records are returned as variants with the signature
+// [GResolverRecordTxtValue] records are returned as variants with the signature
 // `(as)`, representing an array of the strings in the text record. Note: Most TXT
 // records only contain a single string, but
-// [RFC 1035](https://tools.ietf.org/html/rfc1035#section-3.3.14) does allow a
+// [RFC 1035] does allow a
 // record to contain multiple strings. The RFC which defines the interpretation
 // of a specific TXT record will likely require concatenation of multiple
 // strings if they are present, as with
-// [RFC 7208](https://tools.ietf.org/html/rfc7208#section-3.3).
+// [RFC 7208].
 //
-// %G_RESOLVER_RECORD_SOA records are returned as variants with the signature
+// [GResolverRecordSoaValue] records are returned as variants with the signature
 // `(ssuuuuu)`, representing a string containing the primary name server, a
 // string containing the administrator, the serial as a `guint32`, the refresh
 // interval as a `guint32`, the retry interval as a `guint32`, the expire timeout
 // as a `guint32`, and the TTL as a `guint32`.
 //
-// %G_RESOLVER_RECORD_NS records are returned as variants with the signature
+// [GResolverRecordNsValue] records are returned as variants with the signature
 // `(s)`, representing a string of the hostname of the name server.
+//
+// [RFC 1035]: https://tools.ietf.org/html/rfc1035#section-3.3.14
+// [RFC 7208]: https://tools.ietf.org/html/rfc7208#section-3.3
 type ResolverRecordType int
 
 var xResolverRecordTypeGLibType func() types.GType
@@ -1841,8 +5467,59 @@ const (
 	GResolverRecordNsValue ResolverRecordType = 5
 )
 
-// An error code used with %G_RESOURCE_ERROR in a #GError returned
-// from a #GResource routine.
+// String returns the C name of the value, e.g. for debugging output
+func (x ResolverRecordType) String() string {
+	switch x {
+	case GResolverRecordSrvValue:
+		return "G_RESOLVER_RECORD_SRV"
+	case GResolverRecordMxValue:
+		return "G_RESOLVER_RECORD_MX"
+	case GResolverRecordTxtValue:
+		return "G_RESOLVER_RECORD_TXT"
+	case GResolverRecordSoaValue:
+		return "G_RESOLVER_RECORD_SOA"
+	case GResolverRecordNsValue:
+		return "G_RESOLVER_RECORD_NS"
+	}
+	return fmt.Sprintf("ResolverRecordType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ResolverRecordType) Nick() string {
+	switch x {
+	case GResolverRecordSrvValue:
+		return "srv"
+	case GResolverRecordMxValue:
+		return "mx"
+	case GResolverRecordTxtValue:
+		return "txt"
+	case GResolverRecordSoaValue:
+		return "soa"
+	case GResolverRecordNsValue:
+		return "ns"
+	}
+	return ""
+}
+
+// ResolverRecordTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ResolverRecordTypeFromString(s string) (ResolverRecordType, bool) {
+	switch s {
+	case "G_RESOLVER_RECORD_SRV", "srv":
+		return GResolverRecordSrvValue, true
+	case "G_RESOLVER_RECORD_MX", "mx":
+		return GResolverRecordMxValue, true
+	case "G_RESOLVER_RECORD_TXT", "txt":
+		return GResolverRecordTxtValue, true
+	case "G_RESOLVER_RECORD_SOA", "soa":
+		return GResolverRecordSoaValue, true
+	case "G_RESOLVER_RECORD_NS", "ns":
+		return GResolverRecordNsValue, true
+	}
+	return 0, false
+}
+
+// An error code used with G_RESOURCE_ERROR in a [glib.Error] returned
+// from a [Resource] routine.
 type ResourceError int
 
 var xResourceErrorGLibType func() types.GType
@@ -1859,8 +5536,57 @@ const (
 	GResourceErrorInternalValue ResourceError = 1
 )
 
-// Describes an event occurring on a #GSocketClient. See the
-// #GSocketClient::event signal for more details.
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ResourceError) ErrorDomain() string {
+	return "g-resource-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x ResourceError) Error() string {
+	switch x {
+	case GResourceErrorNotFoundValue:
+		return "G_RESOURCE_ERROR_NOT_FOUND"
+	case GResourceErrorInternalValue:
+		return "G_RESOURCE_ERROR_INTERNAL"
+	}
+	return fmt.Sprintf("g-resource-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ResourceError) String() string {
+	switch x {
+	case GResourceErrorNotFoundValue:
+		return "G_RESOURCE_ERROR_NOT_FOUND"
+	case GResourceErrorInternalValue:
+		return "G_RESOURCE_ERROR_INTERNAL"
+	}
+	return fmt.Sprintf("ResourceError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ResourceError) Nick() string {
+	switch x {
+	case GResourceErrorNotFoundValue:
+		return "not-found"
+	case GResourceErrorInternalValue:
+		return "internal"
+	}
+	return ""
+}
+
+// ResourceErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ResourceErrorFromString(s string) (ResourceError, bool) {
+	switch s {
+	case "G_RESOURCE_ERROR_NOT_FOUND", "not-found":
+		return GResourceErrorNotFoundValue, true
+	case "G_RESOURCE_ERROR_INTERNAL", "internal":
+		return GResourceErrorInternalValue, true
+	}
+	return 0, false
+}
+
+// Describes an event occurring on a [SocketClient]. See the
+// [SocketClient.ConnectEventFunc] signal for more details.
 //
 // Additional values may be added to this type in the future.
 type SocketClientEvent int
@@ -1896,12 +5622,87 @@ const (
 	//   TLS handshake.
 	GSocketClientTlsHandshakedValue SocketClientEvent = 7
 	// The client is done with a particular
-	//   #GSocketConnectable.
+	//   [SocketConnectable].
 	GSocketClientCompleteValue SocketClientEvent = 8
 )
 
-// The protocol family of a #GSocketAddress. (These values are
-// identical to the system defines %AF_INET, %AF_INET6 and %AF_UNIX,
+// String returns the C name of the value, e.g. for debugging output
+func (x SocketClientEvent) String() string {
+	switch x {
+	case GSocketClientResolvingValue:
+		return "G_SOCKET_CLIENT_RESOLVING"
+	case GSocketClientResolvedValue:
+		return "G_SOCKET_CLIENT_RESOLVED"
+	case GSocketClientConnectingValue:
+		return "G_SOCKET_CLIENT_CONNECTING"
+	case GSocketClientConnectedValue:
+		return "G_SOCKET_CLIENT_CONNECTED"
+	case GSocketClientProxyNegotiatingValue:
+		return "G_SOCKET_CLIENT_PROXY_NEGOTIATING"
+	case GSocketClientProxyNegotiatedValue:
+		return "G_SOCKET_CLIENT_PROXY_NEGOTIATED"
+	case GSocketClientTlsHandshakingValue:
+		return "G_SOCKET_CLIENT_TLS_HANDSHAKING"
+	case GSocketClientTlsHandshakedValue:
+		return "G_SOCKET_CLIENT_TLS_HANDSHAKED"
+	case GSocketClientCompleteValue:
+		return "G_SOCKET_CLIENT_COMPLETE"
+	}
+	return fmt.Sprintf("SocketClientEvent(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SocketClientEvent) Nick() string {
+	switch x {
+	case GSocketClientResolvingValue:
+		return "resolving"
+	case GSocketClientResolvedValue:
+		return "resolved"
+	case GSocketClientConnectingValue:
+		return "connecting"
+	case GSocketClientConnectedValue:
+		return "connected"
+	case GSocketClientProxyNegotiatingValue:
+		return "proxy-negotiating"
+	case GSocketClientProxyNegotiatedValue:
+		return "proxy-negotiated"
+	case GSocketClientTlsHandshakingValue:
+		return "tls-handshaking"
+	case GSocketClientTlsHandshakedValue:
+		return "tls-handshaked"
+	case GSocketClientCompleteValue:
+		return "complete"
+	}
+	return ""
+}
+
+// SocketClientEventFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SocketClientEventFromString(s string) (SocketClientEvent, bool) {
+	switch s {
+	case "G_SOCKET_CLIENT_RESOLVING", "resolving":
+		return GSocketClientResolvingValue, true
+	case "G_SOCKET_CLIENT_RESOLVED", "resolved":
+		return GSocketClientResolvedValue, true
+	case "G_SOCKET_CLIENT_CONNECTING", "connecting":
+		return GSocketClientConnectingValue, true
+	case "G_SOCKET_CLIENT_CONNECTED", "connected":
+		return GSocketClientConnectedValue, true
+	case "G_SOCKET_CLIENT_PROXY_NEGOTIATING", "proxy-negotiating":
+		return GSocketClientProxyNegotiatingValue, true
+	case "G_SOCKET_CLIENT_PROXY_NEGOTIATED", "proxy-negotiated":
+		return GSocketClientProxyNegotiatedValue, true
+	case "G_SOCKET_CLIENT_TLS_HANDSHAKING", "tls-handshaking":
+		return GSocketClientTlsHandshakingValue, true
+	case "G_SOCKET_CLIENT_TLS_HANDSHAKED", "tls-handshaked":
+		return GSocketClientTlsHandshakedValue, true
+	case "G_SOCKET_CLIENT_COMPLETE", "complete":
+		return GSocketClientCompleteValue, true
+	}
+	return 0, false
+}
+
+// The protocol family of a [SocketAddress]. (These values are
+// identical to the system defines AF_INET, AF_INET6 and AF_UNIX,
 // if available.)
 type SocketFamily int
 
@@ -1923,8 +5724,53 @@ const (
 	GSocketFamilyIpv6Value SocketFamily = 10
 )
 
-// Describes an event occurring on a #GSocketListener. See the
-// #GSocketListener::event signal for more details.
+// String returns the C name of the value, e.g. for debugging output
+func (x SocketFamily) String() string {
+	switch x {
+	case GSocketFamilyInvalidValue:
+		return "G_SOCKET_FAMILY_INVALID"
+	case GSocketFamilyUnixValue:
+		return "G_SOCKET_FAMILY_UNIX"
+	case GSocketFamilyIpv4Value:
+		return "G_SOCKET_FAMILY_IPV4"
+	case GSocketFamilyIpv6Value:
+		return "G_SOCKET_FAMILY_IPV6"
+	}
+	return fmt.Sprintf("SocketFamily(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SocketFamily) Nick() string {
+	switch x {
+	case GSocketFamilyInvalidValue:
+		return "invalid"
+	case GSocketFamilyUnixValue:
+		return "unix"
+	case GSocketFamilyIpv4Value:
+		return "ipv4"
+	case GSocketFamilyIpv6Value:
+		return "ipv6"
+	}
+	return ""
+}
+
+// SocketFamilyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SocketFamilyFromString(s string) (SocketFamily, bool) {
+	switch s {
+	case "G_SOCKET_FAMILY_INVALID", "invalid":
+		return GSocketFamilyInvalidValue, true
+	case "G_SOCKET_FAMILY_UNIX", "unix":
+		return GSocketFamilyUnixValue, true
+	case "G_SOCKET_FAMILY_IPV4", "ipv4":
+		return GSocketFamilyIpv4Value, true
+	case "G_SOCKET_FAMILY_IPV6", "ipv6":
+		return GSocketFamilyIpv6Value, true
+	}
+	return 0, false
+}
+
+// Describes an event occurring on a [SocketListener]. See the
+// [SocketListener.ConnectEventFunc] signal for more details.
 //
 // Additional values may be added to this type in the future.
 type SocketListenerEvent int
@@ -1949,7 +5795,52 @@ const (
 	GSocketListenerListenedValue SocketListenerEvent = 3
 )
 
-// A protocol identifier is specified when creating a #GSocket, which is a
+// String returns the C name of the value, e.g. for debugging output
+func (x SocketListenerEvent) String() string {
+	switch x {
+	case GSocketListenerBindingValue:
+		return "G_SOCKET_LISTENER_BINDING"
+	case GSocketListenerBoundValue:
+		return "G_SOCKET_LISTENER_BOUND"
+	case GSocketListenerListeningValue:
+		return "G_SOCKET_LISTENER_LISTENING"
+	case GSocketListenerListenedValue:
+		return "G_SOCKET_LISTENER_LISTENED"
+	}
+	return fmt.Sprintf("SocketListenerEvent(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SocketListenerEvent) Nick() string {
+	switch x {
+	case GSocketListenerBindingValue:
+		return "binding"
+	case GSocketListenerBoundValue:
+		return "bound"
+	case GSocketListenerListeningValue:
+		return "listening"
+	case GSocketListenerListenedValue:
+		return "listened"
+	}
+	return ""
+}
+
+// SocketListenerEventFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SocketListenerEventFromString(s string) (SocketListenerEvent, bool) {
+	switch s {
+	case "G_SOCKET_LISTENER_BINDING", "binding":
+		return GSocketListenerBindingValue, true
+	case "G_SOCKET_LISTENER_BOUND", "bound":
+		return GSocketListenerBoundValue, true
+	case "G_SOCKET_LISTENER_LISTENING", "listening":
+		return GSocketListenerListeningValue, true
+	case "G_SOCKET_LISTENER_LISTENED", "listened":
+		return GSocketListenerListenedValue, true
+	}
+	return 0, false
+}
+
+// A protocol identifier is specified when creating a [Socket], which is a
 // family/type specific identifier, where 0 means the default protocol for
 // the particular family/type.
 //
@@ -1978,7 +5869,58 @@ const (
 	GSocketProtocolSctpValue SocketProtocol = 132
 )
 
-// Flags used when creating a #GSocket. Some protocols may not implement
+// String returns the C name of the value, e.g. for debugging output
+func (x SocketProtocol) String() string {
+	switch x {
+	case GSocketProtocolUnknownValue:
+		return "G_SOCKET_PROTOCOL_UNKNOWN"
+	case GSocketProtocolDefaultValue:
+		return "G_SOCKET_PROTOCOL_DEFAULT"
+	case GSocketProtocolTcpValue:
+		return "G_SOCKET_PROTOCOL_TCP"
+	case GSocketProtocolUdpValue:
+		return "G_SOCKET_PROTOCOL_UDP"
+	case GSocketProtocolSctpValue:
+		return "G_SOCKET_PROTOCOL_SCTP"
+	}
+	return fmt.Sprintf("SocketProtocol(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SocketProtocol) Nick() string {
+	switch x {
+	case GSocketProtocolUnknownValue:
+		return "unknown"
+	case GSocketProtocolDefaultValue:
+		return "default"
+	case GSocketProtocolTcpValue:
+		return "tcp"
+	case GSocketProtocolUdpValue:
+		return "udp"
+	case GSocketProtocolSctpValue:
+		return "sctp"
+	}
+	return ""
+}
+
+// SocketProtocolFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SocketProtocolFromString(s string) (SocketProtocol, bool) {
+	switch s {
+	case "G_SOCKET_PROTOCOL_UNKNOWN", "unknown":
+		return GSocketProtocolUnknownValue, true
+	case "G_SOCKET_PROTOCOL_DEFAULT", "default":
+		return GSocketProtocolDefaultValue, true
+	case "G_SOCKET_PROTOCOL_TCP", "tcp":
+		return GSocketProtocolTcpValue, true
+	case "G_SOCKET_PROTOCOL_UDP", "udp":
+		return GSocketProtocolUdpValue, true
+	case "G_SOCKET_PROTOCOL_SCTP", "sctp":
+		return GSocketProtocolSctpValue, true
+	}
+	return 0, false
+}
+
+// Flags used when creating a [Socket]. Some protocols may not implement
 // all the socket types.
 type SocketType int
 
@@ -2002,7 +5944,52 @@ const (
 	GSocketTypeSeqpacketValue SocketType = 3
 )
 
-// The client authentication mode for a #GTlsServerConnection.
+// String returns the C name of the value, e.g. for debugging output
+func (x SocketType) String() string {
+	switch x {
+	case GSocketTypeInvalidValue:
+		return "G_SOCKET_TYPE_INVALID"
+	case GSocketTypeStreamValue:
+		return "G_SOCKET_TYPE_STREAM"
+	case GSocketTypeDatagramValue:
+		return "G_SOCKET_TYPE_DATAGRAM"
+	case GSocketTypeSeqpacketValue:
+		return "G_SOCKET_TYPE_SEQPACKET"
+	}
+	return fmt.Sprintf("SocketType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SocketType) Nick() string {
+	switch x {
+	case GSocketTypeInvalidValue:
+		return "invalid"
+	case GSocketTypeStreamValue:
+		return "stream"
+	case GSocketTypeDatagramValue:
+		return "datagram"
+	case GSocketTypeSeqpacketValue:
+		return "seqpacket"
+	}
+	return ""
+}
+
+// SocketTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SocketTypeFromString(s string) (SocketType, bool) {
+	switch s {
+	case "G_SOCKET_TYPE_INVALID", "invalid":
+		return GSocketTypeInvalidValue, true
+	case "G_SOCKET_TYPE_STREAM", "stream":
+		return GSocketTypeStreamValue, true
+	case "G_SOCKET_TYPE_DATAGRAM", "datagram":
+		return GSocketTypeDatagramValue, true
+	case "G_SOCKET_TYPE_SEQPACKET", "seqpacket":
+		return GSocketTypeSeqpacketValue, true
+	}
+	return 0, false
+}
+
+// The client authentication mode for a [TlsServerConnection].
 type TlsAuthenticationMode int
 
 var xTlsAuthenticationModeGLibType func() types.GType
@@ -2021,9 +6008,48 @@ const (
 	GTlsAuthenticationRequiredValue TlsAuthenticationMode = 2
 )
 
-// Flags for g_tls_interaction_request_certificate(),
-// g_tls_interaction_request_certificate_async(), and
-// g_tls_interaction_invoke_request_certificate().
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsAuthenticationMode) String() string {
+	switch x {
+	case GTlsAuthenticationNoneValue:
+		return "G_TLS_AUTHENTICATION_NONE"
+	case GTlsAuthenticationRequestedValue:
+		return "G_TLS_AUTHENTICATION_REQUESTED"
+	case GTlsAuthenticationRequiredValue:
+		return "G_TLS_AUTHENTICATION_REQUIRED"
+	}
+	return fmt.Sprintf("TlsAuthenticationMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsAuthenticationMode) Nick() string {
+	switch x {
+	case GTlsAuthenticationNoneValue:
+		return "none"
+	case GTlsAuthenticationRequestedValue:
+		return "requested"
+	case GTlsAuthenticationRequiredValue:
+		return "required"
+	}
+	return ""
+}
+
+// TlsAuthenticationModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsAuthenticationModeFromString(s string) (TlsAuthenticationMode, bool) {
+	switch s {
+	case "G_TLS_AUTHENTICATION_NONE", "none":
+		return GTlsAuthenticationNoneValue, true
+	case "G_TLS_AUTHENTICATION_REQUESTED", "requested":
+		return GTlsAuthenticationRequestedValue, true
+	case "G_TLS_AUTHENTICATION_REQUIRED", "required":
+		return GTlsAuthenticationRequiredValue, true
+	}
+	return 0, false
+}
+
+// Flags for [TlsInteraction.RequestCertificate],
+// [TlsInteraction.RequestCertificateAsync], and
+// [TlsInteraction.InvokeRequestCertificate].
 type TlsCertificateRequestFlags int
 
 var xTlsCertificateRequestFlagsGLibType func() types.GType
@@ -2038,7 +6064,34 @@ const (
 	GTlsCertificateRequestNoneValue TlsCertificateRequestFlags = 0
 )
 
-// An error code used with %G_TLS_CHANNEL_BINDING_ERROR in a #GError to
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsCertificateRequestFlags) String() string {
+	switch x {
+	case GTlsCertificateRequestNoneValue:
+		return "G_TLS_CERTIFICATE_REQUEST_NONE"
+	}
+	return fmt.Sprintf("TlsCertificateRequestFlags(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsCertificateRequestFlags) Nick() string {
+	switch x {
+	case GTlsCertificateRequestNoneValue:
+		return "none"
+	}
+	return ""
+}
+
+// TlsCertificateRequestFlagsFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsCertificateRequestFlagsFromString(s string) (TlsCertificateRequestFlags, bool) {
+	switch s {
+	case "G_TLS_CERTIFICATE_REQUEST_NONE", "none":
+		return GTlsCertificateRequestNoneValue, true
+	}
+	return 0, false
+}
+
+// An error code used with G_TLS_CHANNEL_BINDING_ERROR in a [glib.Error] to
 // indicate a TLS channel binding retrieval error.
 type TlsChannelBindingError int
 
@@ -2073,10 +6126,85 @@ const (
 	GTlsChannelBindingErrorGeneralErrorValue TlsChannelBindingError = 4
 )
 
-// The type of TLS channel binding data to retrieve from #GTlsConnection
-// or #GDtlsConnection, as documented by RFC 5929 or RFC 9266. The
-// [`tls-unique-for-telnet`](https://tools.ietf.org/html/rfc5929#section-5)
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (TlsChannelBindingError) ErrorDomain() string {
+	return "g-tls-channel-binding-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x TlsChannelBindingError) Error() string {
+	switch x {
+	case GTlsChannelBindingErrorNotImplementedValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_IMPLEMENTED"
+	case GTlsChannelBindingErrorInvalidStateValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_INVALID_STATE"
+	case GTlsChannelBindingErrorNotAvailableValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_AVAILABLE"
+	case GTlsChannelBindingErrorNotSupportedValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_SUPPORTED"
+	case GTlsChannelBindingErrorGeneralErrorValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_GENERAL_ERROR"
+	}
+	return fmt.Sprintf("g-tls-channel-binding-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsChannelBindingError) String() string {
+	switch x {
+	case GTlsChannelBindingErrorNotImplementedValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_IMPLEMENTED"
+	case GTlsChannelBindingErrorInvalidStateValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_INVALID_STATE"
+	case GTlsChannelBindingErrorNotAvailableValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_AVAILABLE"
+	case GTlsChannelBindingErrorNotSupportedValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_NOT_SUPPORTED"
+	case GTlsChannelBindingErrorGeneralErrorValue:
+		return "G_TLS_CHANNEL_BINDING_ERROR_GENERAL_ERROR"
+	}
+	return fmt.Sprintf("TlsChannelBindingError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsChannelBindingError) Nick() string {
+	switch x {
+	case GTlsChannelBindingErrorNotImplementedValue:
+		return "not-implemented"
+	case GTlsChannelBindingErrorInvalidStateValue:
+		return "invalid-state"
+	case GTlsChannelBindingErrorNotAvailableValue:
+		return "not-available"
+	case GTlsChannelBindingErrorNotSupportedValue:
+		return "not-supported"
+	case GTlsChannelBindingErrorGeneralErrorValue:
+		return "general-error"
+	}
+	return ""
+}
+
+// TlsChannelBindingErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsChannelBindingErrorFromString(s string) (TlsChannelBindingError, bool) {
+	switch s {
+	case "G_TLS_CHANNEL_BINDING_ERROR_NOT_IMPLEMENTED", "not-implemented":
+		return GTlsChannelBindingErrorNotImplementedValue, true
+	case "G_TLS_CHANNEL_BINDING_ERROR_INVALID_STATE", "invalid-state":
+		return GTlsChannelBindingErrorInvalidStateValue, true
+	case "G_TLS_CHANNEL_BINDING_ERROR_NOT_AVAILABLE", "not-available":
+		return GTlsChannelBindingErrorNotAvailableValue, true
+	case "G_TLS_CHANNEL_BINDING_ERROR_NOT_SUPPORTED", "not-supported":
+		return GTlsChannelBindingErrorNotSupportedValue, true
+	case "G_TLS_CHANNEL_BINDING_ERROR_GENERAL_ERROR", "general-error":
+		return GTlsChannelBindingErrorGeneralErrorValue, true
+	}
+	return 0, false
+}
+
+// The type of TLS channel binding data to retrieve from [TlsConnection]
+// or [DtlsConnection], as documented by RFC 5929 or RFC 9266. The
+// [tls-unique-for-telnet]
 // binding type is not currently implemented.
+//
+// [tls-unique-for-telnet]: https://tools.ietf.org/html/rfc5929#section-5
 type TlsChannelBindingType int
 
 var xTlsChannelBindingTypeGLibType func() types.GType
@@ -2087,20 +6215,65 @@ func TlsChannelBindingTypeGLibType() types.GType {
 
 const (
 
-	// [`tls-unique`](https://tools.ietf.org/html/rfc5929#section-3) binding
+	// [tls-unique] binding
 	//    type
+	//
+	// [tls-unique]: https://tools.ietf.org/html/rfc5929#section-3
 	GTlsChannelBindingTlsUniqueValue TlsChannelBindingType = 0
-	// [`tls-server-end-point`](https://tools.ietf.org/html/rfc5929#section-4)
+	// [tls-server-end-point]
 	//    binding type
+	//
+	// [tls-server-end-point]: https://tools.ietf.org/html/rfc5929#section-4
 	GTlsChannelBindingTlsServerEndPointValue TlsChannelBindingType = 1
-	// [`tls-exporter`](https://www.rfc-editor.org/rfc/rfc9266.html) binding
+	// [tls-exporter] binding
 	//    type. Since: 2.74
+	//
+	// [tls-exporter]: https://www.rfc-editor.org/rfc/rfc9266.html
 	GTlsChannelBindingTlsExporterValue TlsChannelBindingType = 2
 )
 
-// Flags for g_tls_database_lookup_certificate_for_handle(),
-// g_tls_database_lookup_certificate_issuer(),
-// and g_tls_database_lookup_certificates_issued_by().
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsChannelBindingType) String() string {
+	switch x {
+	case GTlsChannelBindingTlsUniqueValue:
+		return "G_TLS_CHANNEL_BINDING_TLS_UNIQUE"
+	case GTlsChannelBindingTlsServerEndPointValue:
+		return "G_TLS_CHANNEL_BINDING_TLS_SERVER_END_POINT"
+	case GTlsChannelBindingTlsExporterValue:
+		return "G_TLS_CHANNEL_BINDING_TLS_EXPORTER"
+	}
+	return fmt.Sprintf("TlsChannelBindingType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsChannelBindingType) Nick() string {
+	switch x {
+	case GTlsChannelBindingTlsUniqueValue:
+		return "unique"
+	case GTlsChannelBindingTlsServerEndPointValue:
+		return "server-end-point"
+	case GTlsChannelBindingTlsExporterValue:
+		return "exporter"
+	}
+	return ""
+}
+
+// TlsChannelBindingTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsChannelBindingTypeFromString(s string) (TlsChannelBindingType, bool) {
+	switch s {
+	case "G_TLS_CHANNEL_BINDING_TLS_UNIQUE", "unique":
+		return GTlsChannelBindingTlsUniqueValue, true
+	case "G_TLS_CHANNEL_BINDING_TLS_SERVER_END_POINT", "server-end-point":
+		return GTlsChannelBindingTlsServerEndPointValue, true
+	case "G_TLS_CHANNEL_BINDING_TLS_EXPORTER", "exporter":
+		return GTlsChannelBindingTlsExporterValue, true
+	}
+	return 0, false
+}
+
+// Flags for [TlsDatabase.LookupCertificateForHandle],
+// [TlsDatabase.LookupCertificateIssuer],
+// and [TlsDatabase.LookupCertificatesIssuedBy].
 type TlsDatabaseLookupFlags int
 
 var xTlsDatabaseLookupFlagsGLibType func() types.GType
@@ -2118,7 +6291,40 @@ const (
 	GTlsDatabaseLookupKeypairValue TlsDatabaseLookupFlags = 1
 )
 
-// An error code used with %G_TLS_ERROR in a #GError returned from a
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsDatabaseLookupFlags) String() string {
+	switch x {
+	case GTlsDatabaseLookupNoneValue:
+		return "G_TLS_DATABASE_LOOKUP_NONE"
+	case GTlsDatabaseLookupKeypairValue:
+		return "G_TLS_DATABASE_LOOKUP_KEYPAIR"
+	}
+	return fmt.Sprintf("TlsDatabaseLookupFlags(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsDatabaseLookupFlags) Nick() string {
+	switch x {
+	case GTlsDatabaseLookupNoneValue:
+		return "none"
+	case GTlsDatabaseLookupKeypairValue:
+		return "keypair"
+	}
+	return ""
+}
+
+// TlsDatabaseLookupFlagsFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsDatabaseLookupFlagsFromString(s string) (TlsDatabaseLookupFlags, bool) {
+	switch s {
+	case "G_TLS_DATABASE_LOOKUP_NONE", "none":
+		return GTlsDatabaseLookupNoneValue, true
+	case "G_TLS_DATABASE_LOOKUP_KEYPAIR", "keypair":
+		return GTlsDatabaseLookupKeypairValue, true
+	}
+	return 0, false
+}
+
+// An error code used with G_TLS_ERROR in a [glib.Error] returned from a
 // TLS-related routine.
 type TlsError int
 
@@ -2145,11 +6351,11 @@ const (
 	GTlsErrorHandshakeValue TlsError = 4
 	// The TLS handshake failed because
 	//   the server requested a client-side certificate, but none was
-	//   provided. See g_tls_connection_set_certificate().
+	//   provided. See [TlsConnection.SetCertificate].
 	GTlsErrorCertificateRequiredValue TlsError = 5
 	// The TLS connection was closed without proper
 	//   notice, which may indicate an attack. See
-	//   g_tls_connection_set_require_close_notify().
+	//   [TlsConnection.SetRequireCloseNotify].
 	GTlsErrorEofValue TlsError = 6
 	// The TLS handshake failed
 	//   because the client sent the fallback SCSV, indicating a protocol
@@ -2160,7 +6366,112 @@ const (
 	GTlsErrorBadCertificatePasswordValue TlsError = 8
 )
 
-// #GTlsInteractionResult is returned by various functions in #GTlsInteraction
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (TlsError) ErrorDomain() string {
+	return "g-tls-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x TlsError) Error() string {
+	switch x {
+	case GTlsErrorUnavailableValue:
+		return "G_TLS_ERROR_UNAVAILABLE"
+	case GTlsErrorMiscValue:
+		return "G_TLS_ERROR_MISC"
+	case GTlsErrorBadCertificateValue:
+		return "G_TLS_ERROR_BAD_CERTIFICATE"
+	case GTlsErrorNotTlsValue:
+		return "G_TLS_ERROR_NOT_TLS"
+	case GTlsErrorHandshakeValue:
+		return "G_TLS_ERROR_HANDSHAKE"
+	case GTlsErrorCertificateRequiredValue:
+		return "G_TLS_ERROR_CERTIFICATE_REQUIRED"
+	case GTlsErrorEofValue:
+		return "G_TLS_ERROR_EOF"
+	case GTlsErrorInappropriateFallbackValue:
+		return "G_TLS_ERROR_INAPPROPRIATE_FALLBACK"
+	case GTlsErrorBadCertificatePasswordValue:
+		return "G_TLS_ERROR_BAD_CERTIFICATE_PASSWORD"
+	}
+	return fmt.Sprintf("g-tls-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsError) String() string {
+	switch x {
+	case GTlsErrorUnavailableValue:
+		return "G_TLS_ERROR_UNAVAILABLE"
+	case GTlsErrorMiscValue:
+		return "G_TLS_ERROR_MISC"
+	case GTlsErrorBadCertificateValue:
+		return "G_TLS_ERROR_BAD_CERTIFICATE"
+	case GTlsErrorNotTlsValue:
+		return "G_TLS_ERROR_NOT_TLS"
+	case GTlsErrorHandshakeValue:
+		return "G_TLS_ERROR_HANDSHAKE"
+	case GTlsErrorCertificateRequiredValue:
+		return "G_TLS_ERROR_CERTIFICATE_REQUIRED"
+	case GTlsErrorEofValue:
+		return "G_TLS_ERROR_EOF"
+	case GTlsErrorInappropriateFallbackValue:
+		return "G_TLS_ERROR_INAPPROPRIATE_FALLBACK"
+	case GTlsErrorBadCertificatePasswordValue:
+		return "G_TLS_ERROR_BAD_CERTIFICATE_PASSWORD"
+	}
+	return fmt.Sprintf("TlsError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsError) Nick() string {
+	switch x {
+	case GTlsErrorUnavailableValue:
+		return "unavailable"
+	case GTlsErrorMiscValue:
+		return "misc"
+	case GTlsErrorBadCertificateValue:
+		return "bad-certificate"
+	case GTlsErrorNotTlsValue:
+		return "not-tls"
+	case GTlsErrorHandshakeValue:
+		return "handshake"
+	case GTlsErrorCertificateRequiredValue:
+		return "certificate-required"
+	case GTlsErrorEofValue:
+		return "eof"
+	case GTlsErrorInappropriateFallbackValue:
+		return "inappropriate-fallback"
+	case GTlsErrorBadCertificatePasswordValue:
+		return "bad-certificate-password"
+	}
+	return ""
+}
+
+// TlsErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsErrorFromString(s string) (TlsError, bool) {
+	switch s {
+	case "G_TLS_ERROR_UNAVAILABLE", "unavailable":
+		return GTlsErrorUnavailableValue, true
+	case "G_TLS_ERROR_MISC", "misc":
+		return GTlsErrorMiscValue, true
+	case "G_TLS_ERROR_BAD_CERTIFICATE", "bad-certificate":
+		return GTlsErrorBadCertificateValue, true
+	case "G_TLS_ERROR_NOT_TLS", "not-tls":
+		return GTlsErrorNotTlsValue, true
+	case "G_TLS_ERROR_HANDSHAKE", "handshake":
+		return GTlsErrorHandshakeValue, true
+	case "G_TLS_ERROR_CERTIFICATE_REQUIRED", "certificate-required":
+		return GTlsErrorCertificateRequiredValue, true
+	case "G_TLS_ERROR_EOF", "eof":
+		return GTlsErrorEofValue, true
+	case "G_TLS_ERROR_INAPPROPRIATE_FALLBACK", "inappropriate-fallback":
+		return GTlsErrorInappropriateFallbackValue, true
+	case "G_TLS_ERROR_BAD_CERTIFICATE_PASSWORD", "bad-certificate-password":
+		return GTlsErrorBadCertificatePasswordValue, true
+	}
+	return 0, false
+}
+
+// [TlsInteractionResult] is returned by various functions in [TlsInteraction]
 // when finishing an interaction request.
 type TlsInteractionResult int
 
@@ -2183,12 +6494,51 @@ const (
 	GTlsInteractionFailedValue TlsInteractionResult = 2
 )
 
-// The TLS or DTLS protocol version used by a #GTlsConnection or
-// #GDtlsConnection. The integer values of these versions are sequential
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsInteractionResult) String() string {
+	switch x {
+	case GTlsInteractionUnhandledValue:
+		return "G_TLS_INTERACTION_UNHANDLED"
+	case GTlsInteractionHandledValue:
+		return "G_TLS_INTERACTION_HANDLED"
+	case GTlsInteractionFailedValue:
+		return "G_TLS_INTERACTION_FAILED"
+	}
+	return fmt.Sprintf("TlsInteractionResult(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsInteractionResult) Nick() string {
+	switch x {
+	case GTlsInteractionUnhandledValue:
+		return "unhandled"
+	case GTlsInteractionHandledValue:
+		return "handled"
+	case GTlsInteractionFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// TlsInteractionResultFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsInteractionResultFromString(s string) (TlsInteractionResult, bool) {
+	switch s {
+	case "G_TLS_INTERACTION_UNHANDLED", "unhandled":
+		return GTlsInteractionUnhandledValue, true
+	case "G_TLS_INTERACTION_HANDLED", "handled":
+		return GTlsInteractionHandledValue, true
+	case "G_TLS_INTERACTION_FAILED", "failed":
+		return GTlsInteractionFailedValue, true
+	}
+	return 0, false
+}
+
+// The TLS or DTLS protocol version used by a [TlsConnection] or
+// [DtlsConnection]. The integer values of these versions are sequential
 // to ensure newer known protocol versions compare greater than older
 // known versions. Any known DTLS protocol version will compare greater
 // than any SSL or TLS protocol version. The protocol version may be
-// %G_TLS_PROTOCOL_VERSION_UNKNOWN if the TLS backend supports a newer
+// [GTlsProtocolVersionUnknownValue] if the TLS backend supports a newer
 // protocol version that GLib does not yet know about. This means that
 // it's possible for an unknown DTLS protocol version to compare less
 // than the TLS protocol versions.
@@ -2210,18 +6560,98 @@ const (
 	GTlsProtocolVersionTls10Value TlsProtocolVersion = 2
 	// TLS 1.1, which is insecure and should not be used
 	GTlsProtocolVersionTls11Value TlsProtocolVersion = 3
-	// TLS 1.2, defined by [RFC 5246](https://datatracker.ietf.org/doc/html/rfc5246)
+	// TLS 1.2, defined by [RFC 5246]
+	//
+	// [RFC 5246]: https://datatracker.ietf.org/doc/html/rfc5246
 	GTlsProtocolVersionTls12Value TlsProtocolVersion = 4
-	// TLS 1.3, defined by [RFC 8446](https://datatracker.ietf.org/doc/html/rfc8446)
+	// TLS 1.3, defined by [RFC 8446]
+	//
+	// [RFC 8446]: https://datatracker.ietf.org/doc/html/rfc8446
 	GTlsProtocolVersionTls13Value TlsProtocolVersion = 5
 	// DTLS 1.0, which is insecure and should not be used
 	GTlsProtocolVersionDtls10Value TlsProtocolVersion = 201
-	// DTLS 1.2, defined by [RFC 6347](https://datatracker.ietf.org/doc/html/rfc6347)
+	// DTLS 1.2, defined by [RFC 6347]
+	//
+	// [RFC 6347]: https://datatracker.ietf.org/doc/html/rfc6347
 	GTlsProtocolVersionDtls12Value TlsProtocolVersion = 202
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsProtocolVersion) String() string {
+	switch x {
+	case GTlsProtocolVersionUnknownValue:
+		return "G_TLS_PROTOCOL_VERSION_UNKNOWN"
+	case GTlsProtocolVersionSsl30Value:
+		return "G_TLS_PROTOCOL_VERSION_SSL_3_0"
+	case GTlsProtocolVersionTls10Value:
+		return "G_TLS_PROTOCOL_VERSION_TLS_1_0"
+	case GTlsProtocolVersionTls11Value:
+		return "G_TLS_PROTOCOL_VERSION_TLS_1_1"
+	case GTlsProtocolVersionTls12Value:
+		return "G_TLS_PROTOCOL_VERSION_TLS_1_2"
+	case GTlsProtocolVersionTls13Value:
+		return "G_TLS_PROTOCOL_VERSION_TLS_1_3"
+	case GTlsProtocolVersionDtls10Value:
+		return "G_TLS_PROTOCOL_VERSION_DTLS_1_0"
+	case GTlsProtocolVersionDtls12Value:
+		return "G_TLS_PROTOCOL_VERSION_DTLS_1_2"
+	}
+	return fmt.Sprintf("TlsProtocolVersion(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsProtocolVersion) Nick() string {
+	switch x {
+	case GTlsProtocolVersionUnknownValue:
+		return "unknown"
+	case GTlsProtocolVersionSsl30Value:
+		return "ssl-3-0"
+	case GTlsProtocolVersionTls10Value:
+		return "tls-1-0"
+	case GTlsProtocolVersionTls11Value:
+		return "tls-1-1"
+	case GTlsProtocolVersionTls12Value:
+		return "tls-1-2"
+	case GTlsProtocolVersionTls13Value:
+		return "tls-1-3"
+	case GTlsProtocolVersionDtls10Value:
+		return "dtls-1-0"
+	case GTlsProtocolVersionDtls12Value:
+		return "dtls-1-2"
+	}
+	return ""
+}
+
+// TlsProtocolVersionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsProtocolVersionFromString(s string) (TlsProtocolVersion, bool) {
+	switch s {
+	case "G_TLS_PROTOCOL_VERSION_UNKNOWN", "unknown":
+		return GTlsProtocolVersionUnknownValue, true
+	case "G_TLS_PROTOCOL_VERSION_SSL_3_0", "ssl-3-0":
+		return GTlsProtocolVersionSsl30Value, true
+	case "G_TLS_PROTOCOL_VERSION_TLS_1_0", "tls-1-0":
+		return GTlsProtocolVersionTls10Value, true
+	case "G_TLS_PROTOCOL_VERSION_TLS_1_1", "tls-1-1":
+		return GTlsProtocolVersionTls11Value, true
+	case "G_TLS_PROTOCOL_VERSION_TLS_1_2", "tls-1-2":
+		return GTlsProtocolVersionTls12Value, true
+	case "G_TLS_PROTOCOL_VERSION_TLS_1_3", "tls-1-3":
+		return GTlsProtocolVersionTls13Value, true
+	case "G_TLS_PROTOCOL_VERSION_DTLS_1_0", "dtls-1-0":
+		return GTlsProtocolVersionDtls10Value, true
+	case "G_TLS_PROTOCOL_VERSION_DTLS_1_2", "dtls-1-2":
+		return GTlsProtocolVersionDtls12Value, true
+	}
+	return 0, false
+}
+
 // When to allow rehandshaking. See
-// g_tls_connection_set_rehandshake_mode().
+// [TlsConnection.SetRehandshakeMode].
+//
+// Deprecated: since 2.60.. Changing the rehandshake mode is no longer
+//
+//	required for compatibility. Also, rehandshaking has been removed
+//	from the TLS protocol in TLS 1.3.
 type TlsRehandshakeMode int
 
 var xTlsRehandshakeModeGLibType func() types.GType
@@ -2240,19 +6670,58 @@ const (
 	GTlsRehandshakeUnsafelyValue TlsRehandshakeMode = 2
 )
 
-// The type of name used by a #GUnixSocketAddress.
-// %G_UNIX_SOCKET_ADDRESS_PATH indicates a traditional unix domain
-// socket bound to a filesystem path. %G_UNIX_SOCKET_ADDRESS_ANONYMOUS
+// String returns the C name of the value, e.g. for debugging output
+func (x TlsRehandshakeMode) String() string {
+	switch x {
+	case GTlsRehandshakeNeverValue:
+		return "G_TLS_REHANDSHAKE_NEVER"
+	case GTlsRehandshakeSafelyValue:
+		return "G_TLS_REHANDSHAKE_SAFELY"
+	case GTlsRehandshakeUnsafelyValue:
+		return "G_TLS_REHANDSHAKE_UNSAFELY"
+	}
+	return fmt.Sprintf("TlsRehandshakeMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TlsRehandshakeMode) Nick() string {
+	switch x {
+	case GTlsRehandshakeNeverValue:
+		return "never"
+	case GTlsRehandshakeSafelyValue:
+		return "safely"
+	case GTlsRehandshakeUnsafelyValue:
+		return "unsafely"
+	}
+	return ""
+}
+
+// TlsRehandshakeModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TlsRehandshakeModeFromString(s string) (TlsRehandshakeMode, bool) {
+	switch s {
+	case "G_TLS_REHANDSHAKE_NEVER", "never":
+		return GTlsRehandshakeNeverValue, true
+	case "G_TLS_REHANDSHAKE_SAFELY", "safely":
+		return GTlsRehandshakeSafelyValue, true
+	case "G_TLS_REHANDSHAKE_UNSAFELY", "unsafely":
+		return GTlsRehandshakeUnsafelyValue, true
+	}
+	return 0, false
+}
+
+// The type of name used by a [UnixSocketAddress].
+// [GUnixSocketAddressPathValue] indicates a traditional unix domain
+// socket bound to a filesystem path. [GUnixSocketAddressAnonymousValue]
 // indicates a socket not bound to any name (eg, a client-side socket,
 // or a socket created with socketpair()).
 //
 // For abstract sockets, there are two incompatible ways of naming
 // them; the man pages suggest using the entire `struct sockaddr_un`
 // as the name, padding the unused parts of the %sun_path field with
-// zeroes; this corresponds to %G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED.
+// zeroes; this corresponds to [GUnixSocketAddressAbstractPaddedValue].
 // However, many programs instead just use a portion of %sun_path, and
 // pass an appropriate smaller length to bind() or connect(). This is
-// %G_UNIX_SOCKET_ADDRESS_ABSTRACT.
+// [GUnixSocketAddressAbstractValue].
 type UnixSocketAddressType int
 
 var xUnixSocketAddressTypeGLibType func() types.GType
@@ -2276,8 +6745,59 @@ const (
 	GUnixSocketAddressAbstractPaddedValue UnixSocketAddressType = 4
 )
 
-// Used to select the type of data format to use for #GZlibDecompressor
-// and #GZlibCompressor.
+// String returns the C name of the value, e.g. for debugging output
+func (x UnixSocketAddressType) String() string {
+	switch x {
+	case GUnixSocketAddressInvalidValue:
+		return "G_UNIX_SOCKET_ADDRESS_INVALID"
+	case GUnixSocketAddressAnonymousValue:
+		return "G_UNIX_SOCKET_ADDRESS_ANONYMOUS"
+	case GUnixSocketAddressPathValue:
+		return "G_UNIX_SOCKET_ADDRESS_PATH"
+	case GUnixSocketAddressAbstractValue:
+		return "G_UNIX_SOCKET_ADDRESS_ABSTRACT"
+	case GUnixSocketAddressAbstractPaddedValue:
+		return "G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED"
+	}
+	return fmt.Sprintf("UnixSocketAddressType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UnixSocketAddressType) Nick() string {
+	switch x {
+	case GUnixSocketAddressInvalidValue:
+		return "invalid"
+	case GUnixSocketAddressAnonymousValue:
+		return "anonymous"
+	case GUnixSocketAddressPathValue:
+		return "path"
+	case GUnixSocketAddressAbstractValue:
+		return "abstract"
+	case GUnixSocketAddressAbstractPaddedValue:
+		return "abstract-padded"
+	}
+	return ""
+}
+
+// UnixSocketAddressTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnixSocketAddressTypeFromString(s string) (UnixSocketAddressType, bool) {
+	switch s {
+	case "G_UNIX_SOCKET_ADDRESS_INVALID", "invalid":
+		return GUnixSocketAddressInvalidValue, true
+	case "G_UNIX_SOCKET_ADDRESS_ANONYMOUS", "anonymous":
+		return GUnixSocketAddressAnonymousValue, true
+	case "G_UNIX_SOCKET_ADDRESS_PATH", "path":
+		return GUnixSocketAddressPathValue, true
+	case "G_UNIX_SOCKET_ADDRESS_ABSTRACT", "abstract":
+		return GUnixSocketAddressAbstractValue, true
+	case "G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED", "abstract-padded":
+		return GUnixSocketAddressAbstractPaddedValue, true
+	}
+	return 0, false
+}
+
+// Used to select the type of data format to use for [ZlibDecompressor]
+// and [ZlibCompressor].
 type ZlibCompressorFormat int
 
 var xZlibCompressorFormatGLibType func() types.GType
@@ -2295,3 +6815,216 @@ const (
 	// deflate compression with no header
 	GZlibCompressorFormatRawValue ZlibCompressorFormat = 2
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ZlibCompressorFormat) String() string {
+	switch x {
+	case GZlibCompressorFormatZlibValue:
+		return "G_ZLIB_COMPRESSOR_FORMAT_ZLIB"
+	case GZlibCompressorFormatGzipValue:
+		return "G_ZLIB_COMPRESSOR_FORMAT_GZIP"
+	case GZlibCompressorFormatRawValue:
+		return "G_ZLIB_COMPRESSOR_FORMAT_RAW"
+	}
+	return fmt.Sprintf("ZlibCompressorFormat(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ZlibCompressorFormat) Nick() string {
+	switch x {
+	case GZlibCompressorFormatZlibValue:
+		return "zlib"
+	case GZlibCompressorFormatGzipValue:
+		return "gzip"
+	case GZlibCompressorFormatRawValue:
+		return "raw"
+	}
+	return ""
+}
+
+// ZlibCompressorFormatFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ZlibCompressorFormatFromString(s string) (ZlibCompressorFormat, bool) {
+	switch s {
+	case "G_ZLIB_COMPRESSOR_FORMAT_ZLIB", "zlib":
+		return GZlibCompressorFormatZlibValue, true
+	case "G_ZLIB_COMPRESSOR_FORMAT_GZIP", "gzip":
+		return GZlibCompressorFormatGzipValue, true
+	case "G_ZLIB_COMPRESSOR_FORMAT_RAW", "raw":
+		return GZlibCompressorFormatRawValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xAppInfoCreateFlagsGLibType, libs, "g_app_info_create_flags_get_type")
+
+	core.PuregoSafeRegister(&xApplicationFlagsGLibType, libs, "g_application_flags_get_type")
+
+	core.PuregoSafeRegister(&xAskPasswordFlagsGLibType, libs, "g_ask_password_flags_get_type")
+
+	core.PuregoSafeRegister(&xBusNameOwnerFlagsGLibType, libs, "g_bus_name_owner_flags_get_type")
+
+	core.PuregoSafeRegister(&xBusNameWatcherFlagsGLibType, libs, "g_bus_name_watcher_flags_get_type")
+
+	core.PuregoSafeRegister(&xConverterFlagsGLibType, libs, "g_converter_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusCallFlagsGLibType, libs, "g_dbus_call_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusCapabilityFlagsGLibType, libs, "g_dbus_capability_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusConnectionFlagsGLibType, libs, "g_dbus_connection_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusInterfaceSkeletonFlagsGLibType, libs, "g_dbus_interface_skeleton_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusMessageFlagsGLibType, libs, "g_dbus_message_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusObjectManagerClientFlagsGLibType, libs, "g_dbus_object_manager_client_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusPropertyInfoFlagsGLibType, libs, "g_dbus_property_info_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusProxyFlagsGLibType, libs, "g_dbus_proxy_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusSendMessageFlagsGLibType, libs, "g_dbus_send_message_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusServerFlagsGLibType, libs, "g_dbus_server_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusSignalFlagsGLibType, libs, "g_dbus_signal_flags_get_type")
+
+	core.PuregoSafeRegister(&xDBusSubtreeFlagsGLibType, libs, "g_dbus_subtree_flags_get_type")
+
+	core.PuregoSafeRegister(&xDriveStartFlagsGLibType, libs, "g_drive_start_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileAttributeInfoFlagsGLibType, libs, "g_file_attribute_info_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileCopyFlagsGLibType, libs, "g_file_copy_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileCreateFlagsGLibType, libs, "g_file_create_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileMeasureFlagsGLibType, libs, "g_file_measure_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileMonitorFlagsGLibType, libs, "g_file_monitor_flags_get_type")
+
+	core.PuregoSafeRegister(&xFileQueryInfoFlagsGLibType, libs, "g_file_query_info_flags_get_type")
+
+	core.PuregoSafeRegister(&xIOStreamSpliceFlagsGLibType, libs, "g_io_stream_splice_flags_get_type")
+
+	core.PuregoSafeRegister(&xMountMountFlagsGLibType, libs, "g_mount_mount_flags_get_type")
+
+	core.PuregoSafeRegister(&xMountUnmountFlagsGLibType, libs, "g_mount_unmount_flags_get_type")
+
+	core.PuregoSafeRegister(&xOutputStreamSpliceFlagsGLibType, libs, "g_output_stream_splice_flags_get_type")
+
+	core.PuregoSafeRegister(&xResourceFlagsGLibType, libs, "g_resource_flags_get_type")
+
+	core.PuregoSafeRegister(&xResourceLookupFlagsGLibType, libs, "g_resource_lookup_flags_get_type")
+
+	core.PuregoSafeRegister(&xSocketMsgFlagsGLibType, libs, "g_socket_msg_flags_get_type")
+
+	core.PuregoSafeRegister(&xSubprocessFlagsGLibType, libs, "g_subprocess_flags_get_type")
+
+	core.PuregoSafeRegister(&xTestDBusFlagsGLibType, libs, "g_test_dbus_flags_get_type")
+
+	core.PuregoSafeRegister(&xTlsCertificateFlagsGLibType, libs, "g_tls_certificate_flags_get_type")
+
+	core.PuregoSafeRegister(&xTlsDatabaseVerifyFlagsGLibType, libs, "g_tls_database_verify_flags_get_type")
+
+	core.PuregoSafeRegister(&xTlsPasswordFlagsGLibType, libs, "g_tls_password_flags_get_type")
+
+	core.PuregoSafeRegister(&xBusTypeGLibType, libs, "g_bus_type_get_type")
+
+	core.PuregoSafeRegister(&xConverterResultGLibType, libs, "g_converter_result_get_type")
+
+	core.PuregoSafeRegister(&xCredentialsTypeGLibType, libs, "g_credentials_type_get_type")
+
+	core.PuregoSafeRegister(&xDBusErrorGLibType, libs, "g_dbus_error_get_type")
+
+	core.PuregoSafeRegister(&xDBusMessageByteOrderGLibType, libs, "g_dbus_message_byte_order_get_type")
+
+	core.PuregoSafeRegister(&xDBusMessageHeaderFieldGLibType, libs, "g_dbus_message_header_field_get_type")
+
+	core.PuregoSafeRegister(&xDBusMessageTypeGLibType, libs, "g_dbus_message_type_get_type")
+
+	core.PuregoSafeRegister(&xDataStreamByteOrderGLibType, libs, "g_data_stream_byte_order_get_type")
+
+	core.PuregoSafeRegister(&xDataStreamNewlineTypeGLibType, libs, "g_data_stream_newline_type_get_type")
+
+	core.PuregoSafeRegister(&xDriveStartStopTypeGLibType, libs, "g_drive_start_stop_type_get_type")
+
+	core.PuregoSafeRegister(&xEmblemOriginGLibType, libs, "g_emblem_origin_get_type")
+
+	core.PuregoSafeRegister(&xFileAttributeStatusGLibType, libs, "g_file_attribute_status_get_type")
+
+	core.PuregoSafeRegister(&xFileAttributeTypeGLibType, libs, "g_file_attribute_type_get_type")
+
+	core.PuregoSafeRegister(&xFileMonitorEventGLibType, libs, "g_file_monitor_event_get_type")
+
+	core.PuregoSafeRegister(&xFileTypeGLibType, libs, "g_file_type_get_type")
+
+	core.PuregoSafeRegister(&xFilesystemPreviewTypeGLibType, libs, "g_filesystem_preview_type_get_type")
+
+	core.PuregoSafeRegister(&xIOErrorEnumGLibType, libs, "g_io_error_enum_get_type")
+
+	core.PuregoSafeRegister(&xIOModuleScopeFlagsGLibType, libs, "g_io_module_scope_flags_get_type")
+
+	core.PuregoSafeRegister(&xMemoryMonitorWarningLevelGLibType, libs, "g_memory_monitor_warning_level_get_type")
+
+	core.PuregoSafeRegister(&xMountOperationResultGLibType, libs, "g_mount_operation_result_get_type")
+
+	core.PuregoSafeRegister(&xNetworkConnectivityGLibType, libs, "g_network_connectivity_get_type")
+
+	core.PuregoSafeRegister(&xNotificationPriorityGLibType, libs, "g_notification_priority_get_type")
+
+	core.PuregoSafeRegister(&xPasswordSaveGLibType, libs, "g_password_save_get_type")
+
+	core.PuregoSafeRegister(&xPollableReturnGLibType, libs, "g_pollable_return_get_type")
+
+	core.PuregoSafeRegister(&xResolverErrorGLibType, libs, "g_resolver_error_get_type")
+
+	core.PuregoSafeRegister(&xResolverRecordTypeGLibType, libs, "g_resolver_record_type_get_type")
+
+	core.PuregoSafeRegister(&xResourceErrorGLibType, libs, "g_resource_error_get_type")
+
+	core.PuregoSafeRegister(&xSocketClientEventGLibType, libs, "g_socket_client_event_get_type")
+
+	core.PuregoSafeRegister(&xSocketFamilyGLibType, libs, "g_socket_family_get_type")
+
+	core.PuregoSafeRegister(&xSocketListenerEventGLibType, libs, "g_socket_listener_event_get_type")
+
+	core.PuregoSafeRegister(&xSocketProtocolGLibType, libs, "g_socket_protocol_get_type")
+
+	core.PuregoSafeRegister(&xSocketTypeGLibType, libs, "g_socket_type_get_type")
+
+	core.PuregoSafeRegister(&xTlsAuthenticationModeGLibType, libs, "g_tls_authentication_mode_get_type")
+
+	core.PuregoSafeRegister(&xTlsCertificateRequestFlagsGLibType, libs, "g_tls_certificate_request_flags_get_type")
+
+	core.PuregoSafeRegister(&xTlsChannelBindingErrorGLibType, libs, "g_tls_channel_binding_error_get_type")
+
+	core.PuregoSafeRegister(&xTlsChannelBindingTypeGLibType, libs, "g_tls_channel_binding_type_get_type")
+
+	core.PuregoSafeRegister(&xTlsDatabaseLookupFlagsGLibType, libs, "g_tls_database_lookup_flags_get_type")
+
+	core.PuregoSafeRegister(&xTlsErrorGLibType, libs, "g_tls_error_get_type")
+
+	core.PuregoSafeRegister(&xTlsInteractionResultGLibType, libs, "g_tls_interaction_result_get_type")
+
+	core.PuregoSafeRegister(&xTlsProtocolVersionGLibType, libs, "g_tls_protocol_version_get_type")
+
+	core.PuregoSafeRegister(&xTlsRehandshakeModeGLibType, libs, "g_tls_rehandshake_mode_get_type")
+
+	core.PuregoSafeRegister(&xUnixSocketAddressTypeGLibType, libs, "g_unix_socket_address_type_get_type")
+
+	core.PuregoSafeRegister(&xZlibCompressorFormatGLibType, libs, "g_zlib_compressor_format_get_type")
+
+}
@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
@@ -11,7 +10,7 @@ var xIoErrorFromErrno func(int) IOErrorEnum
 
 // Converts `errno.h` error codes into GIO error codes.
 //
-// The fallback value %G_IO_ERROR_FAILED is returned for error codes not
+// The fallback value [GIoErrorFailedValue] is returned for error codes not
 // currently handled (but note that future GLib releases may return a more
 // specific value instead).
 //
@@ -19,16 +18,12 @@ var xIoErrorFromErrno func(int) IOErrorEnum
 // calls, you should save its value immediately after the call returns,
 // and use the saved value instead of `errno`:
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	int saved_errno;
 //
 //	ret = read (blah);
 //	saved_errno = errno;
 //
 //	g_io_error_from_errno (saved_errno);
-//
-// ]|
 func IoErrorFromErrno(ErrNoVar int) IOErrorEnum {
 
 	cret := xIoErrorFromErrno(ErrNoVar)
@@ -37,7 +32,7 @@ func IoErrorFromErrno(ErrNoVar int) IOErrorEnum {
 
 var xIoErrorFromFileError func(glib.FileError) IOErrorEnum
 
-// Converts #GFileError error codes into GIO error codes.
+// Converts [glib.FileError] error codes into GIO error codes.
 func IoErrorFromFileError(FileErrorVar glib.FileError) IOErrorEnum {
 
 	cret := xIoErrorFromFileError(FileErrorVar)
@@ -58,15 +53,15 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xIoErrorFromErrno, libs, "g_io_error_from_errno")
-	core.PuregoSafeRegister(&xIoErrorFromFileError, libs, "g_io_error_from_file_error")
-	core.PuregoSafeRegister(&xIoErrorQuark, libs, "g_io_error_quark")
+	core.PuregoRegisterSince(&xIoErrorFromErrno, libs, "g_io_error_from_errno", "GIO", "")
+	core.PuregoRegisterSince(&xIoErrorFromFileError, libs, "g_io_error_from_file_error", "GIO", "2.74")
+	core.PuregoRegisterSince(&xIoErrorQuark, libs, "g_io_error_quark", "GIO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,8 +22,8 @@ func (x *IOModuleClass) GoPointer() uintptr {
 // Represents a scope for loading IO modules. A scope can be used for blocking
 // duplicate modules, or blocking a module you don't want to load.
 //
-// The scope can be used with g_io_modules_load_all_in_directory_with_scope()
-// or g_io_modules_scan_all_in_directory_with_scope().
+// The scope can be used with [IoModulesLoadAllInDirectoryWithScope]
+// or [IoModulesScanAllInDirectoryWithScope].
 type IOModuleScope struct {
 	_ structs.HostLayout
 }
@@ -35,9 +34,9 @@ func (x *IOModuleScope) GoPointer() uintptr {
 
 var xIOModuleScopeBlock func(uintptr, string)
 
-// Block modules with the given @basename from being loaded when
-// this scope is used with g_io_modules_scan_all_in_directory_with_scope()
-// or g_io_modules_load_all_in_directory_with_scope().
+// Block modules with the given basename from being loaded when
+// this scope is used with [IoModulesScanAllInDirectoryWithScope]
+// or [IoModulesLoadAllInDirectoryWithScope].
 func (x *IOModuleScope) Block(BasenameVar string) {
 
 	xIOModuleScopeBlock(x.GoPointer(), BasenameVar)
@@ -53,43 +52,68 @@ func (x *IOModuleScope) Free() {
 
 }
 
-var xIoExtensionPointImplement func(string, types.GType, string, int) *IOExtension
+var xIOExtensionPointImplement func(string, types.GType, string, int) *IOExtension
 
-// Registers @type as extension for the extension point with name
-// @extension_point_name.
+// Registers type as extension for the extension point with name
+// extension_point_name.
 //
-// If @type has already been registered as an extension for this
-// extension point, the existing #GIOExtension object is returned.
-func IoExtensionPointImplement(ExtensionPointNameVar string, TypeVar types.GType, ExtensionNameVar string, PriorityVar int) *IOExtension {
+// If type has already been registered as an extension for this
+// extension point, the existing [IOExtension] object is returned.
+func IOExtensionPointImplement(ExtensionPointNameVar string, TypeVar types.GType, ExtensionNameVar string, PriorityVar int) *IOExtension {
 
-	cret := xIoExtensionPointImplement(ExtensionPointNameVar, TypeVar, ExtensionNameVar, PriorityVar)
+	cret := xIOExtensionPointImplement(ExtensionPointNameVar, TypeVar, ExtensionNameVar, PriorityVar)
 	return cret
 }
 
-var xIoExtensionPointLookup func(string) *IOExtensionPoint
+// Registers type as extension for the extension point with name
+// extension_point_name.
+//
+// If type has already been registered as an extension for this
+// extension point, the existing [IOExtension] object is returned.
+//
+// Deprecated: use IOExtensionPointImplement, this name of g_io_extension_point_implement is kept for compatibility.
+func IoExtensionPointImplement(ExtensionPointNameVar string, TypeVar types.GType, ExtensionNameVar string, PriorityVar int) *IOExtension {
+	return IOExtensionPointImplement(ExtensionPointNameVar, TypeVar, ExtensionNameVar, PriorityVar)
+}
+
+var xIOExtensionPointLookup func(string) *IOExtensionPoint
 
 // Looks up an existing extension point.
-func IoExtensionPointLookup(NameVar string) *IOExtensionPoint {
+func IOExtensionPointLookup(NameVar string) *IOExtensionPoint {
 
-	cret := xIoExtensionPointLookup(NameVar)
+	cret := xIOExtensionPointLookup(NameVar)
 	return cret
 }
 
-var xIoExtensionPointRegister func(string) *IOExtensionPoint
+// Looks up an existing extension point.
+//
+// Deprecated: use IOExtensionPointLookup, this name of g_io_extension_point_lookup is kept for compatibility.
+func IoExtensionPointLookup(NameVar string) *IOExtensionPoint {
+	return IOExtensionPointLookup(NameVar)
+}
+
+var xIOExtensionPointRegister func(string) *IOExtensionPoint
 
 // Registers an extension point.
-func IoExtensionPointRegister(NameVar string) *IOExtensionPoint {
+func IOExtensionPointRegister(NameVar string) *IOExtensionPoint {
 
-	cret := xIoExtensionPointRegister(NameVar)
+	cret := xIOExtensionPointRegister(NameVar)
 	return cret
 }
 
+// Registers an extension point.
+//
+// Deprecated: use IOExtensionPointRegister, this name of g_io_extension_point_register is kept for compatibility.
+func IoExtensionPointRegister(NameVar string) *IOExtensionPoint {
+	return IOExtensionPointRegister(NameVar)
+}
+
 var xIoModulesLoadAllInDirectory func(string) *glib.List
 
 // Loads all the modules in the specified directory.
 //
 // If don't require all modules to be initialized (and thus registering
-// all gtypes) then you can use g_io_modules_scan_all_in_directory()
+// all gtypes) then you can use [IoModulesScanAllInDirectory]
 // which allows delayed/lazy loading of modules.
 func IoModulesLoadAllInDirectory(DirnameVar string) *glib.List {
 
@@ -102,7 +126,7 @@ var xIoModulesLoadAllInDirectoryWithScope func(string, *IOModuleScope) *glib.Lis
 // Loads all the modules in the specified directory.
 //
 // If don't require all modules to be initialized (and thus registering
-// all gtypes) then you can use g_io_modules_scan_all_in_directory()
+// all gtypes) then you can use [IoModulesScanAllInDirectory]
 // which allows delayed/lazy loading of modules.
 func IoModulesLoadAllInDirectoryWithScope(DirnameVar string, ScopeVar *IOModuleScope) *glib.List {
 
@@ -118,11 +142,11 @@ var xIoModulesScanAllInDirectory func(string)
 // This may not actually load and initialize all the types in each
 // module, some modules may be lazily loaded and initialized when
 // an extension point it implements is used with e.g.
-// g_io_extension_point_get_extensions() or
-// g_io_extension_point_get_extension_by_name().
+// [IOExtensionPoint.GetExtensions] or
+// [IOExtensionPoint.GetExtensionByName].
 //
 // If you need to guarantee that all types are loaded in all the modules,
-// use g_io_modules_load_all_in_directory().
+// use [IoModulesLoadAllInDirectory].
 func IoModulesScanAllInDirectory(DirnameVar string) {
 
 	xIoModulesScanAllInDirectory(DirnameVar)
@@ -137,11 +161,11 @@ var xIoModulesScanAllInDirectoryWithScope func(string, *IOModuleScope)
 // This may not actually load and initialize all the types in each
 // module, some modules may be lazily loaded and initialized when
 // an extension point it implements is used with e.g.
-// g_io_extension_point_get_extensions() or
-// g_io_extension_point_get_extension_by_name().
+// [IOExtensionPoint.GetExtensions] or
+// [IOExtensionPoint.GetExtensionByName].
 //
 // If you need to guarantee that all types are loaded in all the modules,
-// use g_io_modules_load_all_in_directory().
+// use [IoModulesLoadAllInDirectory].
 func IoModulesScanAllInDirectoryWithScope(DirnameVar string, ScopeVar *IOModuleScope) {
 
 	xIoModulesScanAllInDirectoryWithScope(DirnameVar, ScopeVar)
@@ -190,9 +214,9 @@ var xIOModuleLoad func(uintptr)
 //
 // This function is run after the module has been loaded into GIO,
 // to initialize the module. Typically, this function will call
-// g_io_extension_point_implement().
+// [IOExtensionPointImplement].
 //
-// Since 2.56, this function should be named `g_io_&lt;modulename&gt;_load`, where
+// Since 2.56, this function should be named `g_io_<modulename>_load`, where
 // `modulename` is the plugin’s filename with the `lib` or `libgio` prefix and
 // everything after the first dot removed, and with `-` replaced with `_`
 // throughout. For example, `libgiognutls-helper.so` becomes `gnutls_helper`.
@@ -212,7 +236,7 @@ var xIOModuleUnload func(uintptr)
 // This function is run when the module is being unloaded from GIO,
 // to finalize the module.
 //
-// Since 2.56, this function should be named `g_io_&lt;modulename&gt;_unload`, where
+// Since 2.56, this function should be named `g_io_<modulename>_unload`, where
 // `modulename` is the plugin’s filename with the `lib` or `libgio` prefix and
 // everything after the first dot removed, and with `-` replaced with `_`
 // throughout. For example, `libgiognutls-helper.so` becomes `gnutls_helper`.
@@ -236,8 +260,8 @@ func (c *IOModule) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Calls the @complete_interface_info function from the
-// #GTypePluginClass of @plugin. There should be no need to use this
+// Calls the complete_interface_info function from the
+// [gobject.TypePluginClass] of plugin. There should be no need to use this
 // function outside of the GObject type system itself.
 func (x *IOModule) CompleteInterfaceInfo(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, InfoVar *gobject.InterfaceInfo) {
 
@@ -245,7 +269,7 @@ func (x *IOModule) CompleteInterfaceInfo(InstanceTypeVar types.GType, InterfaceT
 
 }
 
-// Calls the @complete_type_info function from the #GTypePluginClass of @plugin.
+// Calls the complete_type_info function from the [gobject.TypePluginClass] of plugin.
 // There should be no need to use this function outside of the GObject
 // type system itself.
 func (x *IOModule) CompleteTypeInfo(GTypeVar types.GType, InfoVar *gobject.TypeInfo, ValueTableVar *gobject.TypeValueTable) {
@@ -254,8 +278,8 @@ func (x *IOModule) CompleteTypeInfo(GTypeVar types.GType, InfoVar *gobject.TypeI
 
 }
 
-// Calls the @unuse_plugin function from the #GTypePluginClass of
-// @plugin.  There should be no need to use this function outside of
+// Calls the unuse_plugin function from the [gobject.TypePluginClass] of
+// plugin.  There should be no need to use this function outside of
 // the GObject type system itself.
 func (x *IOModule) Unuse() {
 
@@ -263,8 +287,8 @@ func (x *IOModule) Unuse() {
 
 }
 
-// Calls the @use_plugin function from the #GTypePluginClass of
-// @plugin.  There should be no need to use this function outside of
+// Calls the use_plugin function from the [gobject.TypePluginClass] of
+// plugin.  There should be no need to use this function outside of
 // the GObject type system itself.
 func (x *IOModule) Use() {
 
@@ -272,7 +296,7 @@ func (x *IOModule) Use() {
 
 }
 
-var xIOModuleQuery func() []string
+var xIOModuleQuery func() uintptr
 
 // Optional API for GIO modules to implement.
 //
@@ -289,16 +313,16 @@ var xIOModuleQuery func() []string
 // startup so that it can register its extension points during init.
 //
 // Note that a module need not actually implement all the extension
-// points that g_io_module_query() returns, since the exact list of
+// points that [IOModuleQuery] returns, since the exact list of
 // extension may depend on runtime issues. However all extension
-// points actually implemented must be returned by g_io_module_query()
+// points actually implemented must be returned by [IOModuleQuery]
 // (if defined).
 //
-// When installing a module that implements g_io_module_query() you must
+// When installing a module that implements [IOModuleQuery] you must
 // run gio-querymodules in order to build the cache files required for
 // lazy loading.
 //
-// Since 2.56, this function should be named `g_io_&lt;modulename&gt;_query`, where
+// Since 2.56, this function should be named `g_io_<modulename>_query`, where
 // `modulename` is the plugin’s filename with the `lib` or `libgio` prefix and
 // everything after the first dot removed, and with `-` replaced with `_`
 // throughout. For example, `libgiognutls-helper.so` becomes `gnutls_helper`.
@@ -308,7 +332,7 @@ var xIOModuleQuery func() []string
 func IOModuleQuery() []string {
 
 	cret := xIOModuleQuery()
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 func init() {
@@ -316,31 +340,31 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xIoExtensionPointImplement, libs, "g_io_extension_point_implement")
-	core.PuregoSafeRegister(&xIoExtensionPointLookup, libs, "g_io_extension_point_lookup")
-	core.PuregoSafeRegister(&xIoExtensionPointRegister, libs, "g_io_extension_point_register")
-	core.PuregoSafeRegister(&xIoModulesLoadAllInDirectory, libs, "g_io_modules_load_all_in_directory")
-	core.PuregoSafeRegister(&xIoModulesLoadAllInDirectoryWithScope, libs, "g_io_modules_load_all_in_directory_with_scope")
-	core.PuregoSafeRegister(&xIoModulesScanAllInDirectory, libs, "g_io_modules_scan_all_in_directory")
-	core.PuregoSafeRegister(&xIoModulesScanAllInDirectoryWithScope, libs, "g_io_modules_scan_all_in_directory_with_scope")
+	core.PuregoRegisterSince(&xIOExtensionPointImplement, libs, "g_io_extension_point_implement", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionPointLookup, libs, "g_io_extension_point_lookup", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionPointRegister, libs, "g_io_extension_point_register", "GIO", "")
+	core.PuregoRegisterSince(&xIoModulesLoadAllInDirectory, libs, "g_io_modules_load_all_in_directory", "GIO", "")
+	core.PuregoRegisterSince(&xIoModulesLoadAllInDirectoryWithScope, libs, "g_io_modules_load_all_in_directory_with_scope", "GIO", "2.30")
+	core.PuregoRegisterSince(&xIoModulesScanAllInDirectory, libs, "g_io_modules_scan_all_in_directory", "GIO", "2.24")
+	core.PuregoRegisterSince(&xIoModulesScanAllInDirectoryWithScope, libs, "g_io_modules_scan_all_in_directory_with_scope", "GIO", "2.30")
 
-	core.PuregoSafeRegister(&xIOModuleScopeBlock, libs, "g_io_module_scope_block")
-	core.PuregoSafeRegister(&xIOModuleScopeFree, libs, "g_io_module_scope_free")
+	core.PuregoRegisterSince(&xIOModuleScopeBlock, libs, "g_io_module_scope_block", "GIO", "2.30")
+	core.PuregoRegisterSince(&xIOModuleScopeFree, libs, "g_io_module_scope_free", "GIO", "2.30")
 
 	core.PuregoSafeRegister(&xIOModuleGLibType, libs, "g_io_module_get_type")
 
-	core.PuregoSafeRegister(&xNewIOModule, libs, "g_io_module_new")
+	core.PuregoRegisterSince(&xNewIOModule, libs, "g_io_module_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xIOModuleLoad, libs, "g_io_module_load")
-	core.PuregoSafeRegister(&xIOModuleUnload, libs, "g_io_module_unload")
+	core.PuregoRegisterSince(&xIOModuleLoad, libs, "g_io_module_load", "GIO", "")
+	core.PuregoRegisterSince(&xIOModuleUnload, libs, "g_io_module_unload", "GIO", "")
 
-	core.PuregoSafeRegister(&xIOModuleQuery, libs, "g_io_module_query")
+	core.PuregoRegisterSince(&xIOModuleQuery, libs, "g_io_module_query", "GIO", "2.24")
 
 }
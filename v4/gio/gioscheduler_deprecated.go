@@ -0,0 +1,94 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xIoSchedulerCancelAllJobs func()
+
+// Cancels all cancellable I/O jobs.
+//
+// A job is cancellable if a [Cancellable] was passed into
+// [IoSchedulerPushJob].
+//
+// Deprecated: since 2.36. You should never call this function, since you don't
+// know how other libraries in your program might be making use of
+// gioscheduler.
+func IoSchedulerCancelAllJobs() {
+
+	xIoSchedulerCancelAllJobs()
+
+}
+
+var xIoSchedulerPushJob func(uintptr, uintptr, uintptr, int, uintptr)
+
+// Schedules the I/O job to run in another thread.
+//
+// notify will be called on user_data after job_func has returned,
+// regardless whether the job was cancelled or has run to completion.
+//
+// If cancellable is not nil, it can be used to cancel the I/O job
+// by calling [Cancellable.Cancel] or by calling
+// [IoSchedulerCancelAllJobs].
+//
+// Deprecated: since 2.36. use [glib.ThreadPool] or [Task.RunInThread]
+func IoSchedulerPushJob(JobFuncVar *IOSchedulerJobFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify, IoPriorityVar int, CancellableVar *Cancellable) {
+
+	var JobFuncVarRef uintptr
+	if JobFuncVar != nil {
+		JobFuncVarPtr := uintptr(unsafe.Pointer(JobFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(JobFuncVarPtr); ok {
+			JobFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 *IOSchedulerJob, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *JobFuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			JobFuncVarRef = core.NewCallback(fcb)
+			JobFuncVarRef = glib.SaveCallbackWithClosure(JobFuncVarPtr, JobFuncVarRef, JobFuncVar)
+		}
+	}
+
+	var NotifyVarRef uintptr
+	if NotifyVar != nil {
+		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
+			NotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *NotifyVar
+				cbFn(arg0)
+			}
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+		}
+	}
+
+	xIoSchedulerPushJob(JobFuncVarRef, UserDataVar, NotifyVarRef, IoPriorityVar, CancellableVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIoSchedulerCancelAllJobs, libs, "g_io_scheduler_cancel_all_jobs", "GIO", "")
+	core.PuregoRegisterSince(&xIoSchedulerPushJob, libs, "g_io_scheduler_push_job", "GIO", "")
+
+}
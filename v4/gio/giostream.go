@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -57,7 +56,8 @@ func (x *IOStreamClass) OverrideGetInputStream(cb func(*IOStream) *InputStream)
 	if cb == nil {
 		x.xGetInputStream = 0
 	} else {
-		x.xGetInputStream = purego.NewCallback(func(StreamVarp uintptr) uintptr {
+		x.xGetInputStream = core.NewCallback(func(StreamVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(IOStreamNewFromInternalPtr(StreamVarp))
 			if ret == nil {
 				return 0
@@ -73,7 +73,7 @@ func (x *IOStreamClass) GetGetInputStream() func(*IOStream) *InputStream {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetInputStream)
+	core.RegisterFunc(&rawCallback, x.xGetInputStream)
 	return func(StreamVar *IOStream) *InputStream {
 		rawRet := rawCallback(StreamVar.GoPointer())
 		if rawRet == 0 {
@@ -90,7 +90,8 @@ func (x *IOStreamClass) OverrideGetOutputStream(cb func(*IOStream) *OutputStream
 	if cb == nil {
 		x.xGetOutputStream = 0
 	} else {
-		x.xGetOutputStream = purego.NewCallback(func(StreamVarp uintptr) uintptr {
+		x.xGetOutputStream = core.NewCallback(func(StreamVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(IOStreamNewFromInternalPtr(StreamVarp))
 			if ret == nil {
 				return 0
@@ -106,7 +107,7 @@ func (x *IOStreamClass) GetGetOutputStream() func(*IOStream) *OutputStream {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetOutputStream)
+	core.RegisterFunc(&rawCallback, x.xGetOutputStream)
 	return func(StreamVar *IOStream) *OutputStream {
 		rawRet := rawCallback(StreamVar.GoPointer())
 		if rawRet == 0 {
@@ -123,7 +124,8 @@ func (x *IOStreamClass) OverrideCloseFn(cb func(*IOStream, *Cancellable) bool) {
 	if cb == nil {
 		x.xCloseFn = 0
 	} else {
-		x.xCloseFn = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+		x.xCloseFn = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(IOStreamNewFromInternalPtr(StreamVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -135,7 +137,7 @@ func (x *IOStreamClass) GetCloseFn() func(*IOStream, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFn)
+	core.RegisterFunc(&rawCallback, x.xCloseFn)
 	return func(StreamVar *IOStream, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -146,7 +148,8 @@ func (x *IOStreamClass) OverrideCloseAsync(cb func(*IOStream, int, *Cancellable,
 	if cb == nil {
 		x.xCloseAsync = 0
 	} else {
-		x.xCloseAsync = purego.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCloseAsync = core.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IOStreamNewFromInternalPtr(StreamVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -158,7 +161,7 @@ func (x *IOStreamClass) GetCloseAsync() func(*IOStream, int, *Cancellable, *Asyn
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCloseAsync)
+	core.RegisterFunc(&rawCallback, x.xCloseAsync)
 	return func(StreamVar *IOStream, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -169,7 +172,8 @@ func (x *IOStreamClass) OverrideCloseFinish(cb func(*IOStream, AsyncResult) bool
 	if cb == nil {
 		x.xCloseFinish = 0
 	} else {
-		x.xCloseFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+		x.xCloseFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(IOStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -181,7 +185,7 @@ func (x *IOStreamClass) GetCloseFinish() func(*IOStream, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFinish)
+	core.RegisterFunc(&rawCallback, x.xCloseFinish)
 	return func(StreamVar *IOStream, ResultVar AsyncResult) bool {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -192,7 +196,8 @@ func (x *IOStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -204,7 +209,7 @@ func (x *IOStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -215,7 +220,8 @@ func (x *IOStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -227,7 +233,7 @@ func (x *IOStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -238,7 +244,8 @@ func (x *IOStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -250,7 +257,7 @@ func (x *IOStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -261,7 +268,8 @@ func (x *IOStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -273,7 +281,7 @@ func (x *IOStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -284,7 +292,8 @@ func (x *IOStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -296,7 +305,7 @@ func (x *IOStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -307,7 +316,8 @@ func (x *IOStreamClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -319,7 +329,7 @@ func (x *IOStreamClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -330,7 +340,8 @@ func (x *IOStreamClass) OverrideGReserved7(cb func()) {
 	if cb == nil {
 		x.xGReserved7 = 0
 	} else {
-		x.xGReserved7 = purego.NewCallback(func() {
+		x.xGReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -342,7 +353,7 @@ func (x *IOStreamClass) GetGReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved7)
+	core.RegisterFunc(&rawCallback, x.xGReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -353,7 +364,8 @@ func (x *IOStreamClass) OverrideGReserved8(cb func()) {
 	if cb == nil {
 		x.xGReserved8 = 0
 	} else {
-		x.xGReserved8 = purego.NewCallback(func() {
+		x.xGReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -365,7 +377,7 @@ func (x *IOStreamClass) GetGReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved8)
+	core.RegisterFunc(&rawCallback, x.xGReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -376,7 +388,8 @@ func (x *IOStreamClass) OverrideGReserved9(cb func()) {
 	if cb == nil {
 		x.xGReserved9 = 0
 	} else {
-		x.xGReserved9 = purego.NewCallback(func() {
+		x.xGReserved9 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -388,7 +401,7 @@ func (x *IOStreamClass) GetGReserved9() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved9)
+	core.RegisterFunc(&rawCallback, x.xGReserved9)
 	return func() {
 		rawCallback()
 	}
@@ -399,7 +412,8 @@ func (x *IOStreamClass) OverrideGReserved10(cb func()) {
 	if cb == nil {
 		x.xGReserved10 = 0
 	} else {
-		x.xGReserved10 = purego.NewCallback(func() {
+		x.xGReserved10 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -411,7 +425,7 @@ func (x *IOStreamClass) GetGReserved10() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved10)
+	core.RegisterFunc(&rawCallback, x.xGReserved10)
 	return func() {
 		rawCallback()
 	}
@@ -430,13 +444,13 @@ func (x *IOStreamPrivate) GoPointer() uintptr {
 // but they share some common resources and state. For instance, for
 // seekable streams, both streams may use the same position.
 //
-// Examples of `GIOStream` objects are [class@Gio.SocketConnection], which represents
-// a two-way network connection; and [class@Gio.FileIOStream], which represents a
+// Examples of `GIOStream` objects are [SocketConnection], which represents
+// a two-way network connection; and [FileIOStream], which represents a
 // file handle opened in read-write mode.
 //
 // To do the actual reading and writing you need to get the substreams
-// with [method@Gio.IOStream.get_input_stream] and
-// [method@Gio.IOStream.get_output_stream].
+// with [IOStream.GetInputStream] and
+// [IOStream.GetOutputStream].
 //
 // The `GIOStream` object owns the input and the output streams, not the other
 // way around, so keeping the substreams alive will not keep the `GIOStream`
@@ -444,7 +458,7 @@ func (x *IOStreamPrivate) GoPointer() uintptr {
 // closing the substreams, so even if the substreams stay alive they will
 // always return `G_IO_ERROR_CLOSED` for all operations.
 //
-// To close a stream use [method@Gio.IOStream.close] which will close the common
+// To close a stream use [IOStream.Close] which will close the common
 // stream object and also the individual substreams. You can also close
 // the substreams themselves. In most cases this only marks the
 // substream as closed, so further I/O on it fails but common state in the
@@ -453,8 +467,8 @@ func (x *IOStreamPrivate) GoPointer() uintptr {
 //
 // Operations on `GIOStream`s cannot be started while another operation on the
 // `GIOStream` or its substreams is in progress. Specifically, an application can
-// read from the [class@Gio.InputStream] and write to the
-// [class@Gio.OutputStream] simultaneously (either in separate threads, or as
+// read from the [InputStream] and write to the
+// [OutputStream] simultaneously (either in separate threads, or as
 // asynchronous operations in the same thread), but an application cannot start
 // any `GIOStream` operation while there is a `GIOStream`, `GInputStream` or
 // `GOutputStream` operation in progress, and an application can’t start any
@@ -462,13 +476,13 @@ func (x *IOStreamPrivate) GoPointer() uintptr {
 // operation in progress.
 //
 // This is a product of individual stream operations being associated with a
-// given [type@GLib.MainContext] (the thread-default context at the time the
+// given [glib.MainContext] (the thread-default context at the time the
 // operation was started), rather than entire streams being associated with a
 // single `GMainContext`.
 //
 // GIO may run operations on `GIOStream`s from other (worker) threads, and this
 // may be exposed to application code in the behaviour of wrapper streams, such
-// as [class@Gio.BufferedInputStream] or [class@Gio.TlsConnection]. With such
+// as [BufferedInputStream] or [TlsConnection]. With such
 // wrapper APIs, application code may only run operations on the base (wrapped)
 // stream when the wrapper stream is idle. Note that the semantics of such
 // operations may not be well-defined due to the state the wrapper stream leaves
@@ -491,7 +505,7 @@ func IOStreamNewFromInternalPtr(ptr uintptr) *IOStream {
 
 var xIOStreamClearPending func(uintptr)
 
-// Clears the pending flag on @stream.
+// Clears the pending flag on stream.
 func (x *IOStream) ClearPending() {
 
 	xIOStreamClearPending(x.GoPointer())
@@ -505,7 +519,7 @@ var xIOStreamClose func(uintptr, uintptr, **glib.Error) bool
 // closed.
 //
 // Once the stream is closed, all other operations will return
-// %G_IO_ERROR_CLOSED. Closing a stream multiple times will not
+// [GIoErrorClosedValue]. Closing a stream multiple times will not
 // return an error.
 //
 // Closing a stream will automatically flush any outstanding buffers
@@ -521,13 +535,13 @@ var xIOStreamClose func(uintptr, uintptr, **glib.Error) bool
 //
 // On failure the first error that happened will be reported, but the
 // close operation will finish as much as possible. A stream that failed
-// to close will still return %G_IO_ERROR_CLOSED for all operations.
+// to close will still return [GIoErrorClosedValue] for all operations.
 // Still, it is important to check and report the error to the user,
 // otherwise there might be a loss of data as all data might not be written.
 //
-// If @cancellable is not NULL, then the operation can be cancelled by
+// If cancellable is not NULL, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 // Cancelling a close will still leave the stream closed, but some streams
 // can use a faster close that doesn't block to e.g. check errors.
 //
@@ -538,7 +552,7 @@ func (x *IOStream) Close(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xIOStreamClose(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -547,11 +561,11 @@ func (x *IOStream) Close(CancellableVar *Cancellable) (bool, error) {
 var xIOStreamCloseAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Requests an asynchronous close of the stream, releasing resources
-// related to it. When the operation is finished @callback will be
-// called. You can then call g_io_stream_close_finish() to get
+// related to it. When the operation is finished callback will be
+// called. You can then call [IOStream.CloseFinish] to get
 // the result of the operation.
 //
-// For behaviour details see g_io_stream_close().
+// For behaviour details see [IOStream.Close].
 //
 // The asynchronous methods have a default fallback that uses threads
 // to implement asynchronicity, so they are optional for inheriting
@@ -565,11 +579,12 @@ func (x *IOStream) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable, Ca
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -585,7 +600,7 @@ func (x *IOStream) CloseFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := xIOStreamCloseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -647,15 +662,15 @@ func (x *IOStream) IsClosed() bool {
 
 var xIOStreamSetPending func(uintptr) bool
 
-// Sets @stream to have actions pending. If the pending flag is
-// already set or @stream is closed, it will return %FALSE and set
-// @error.
+// Sets stream to have actions pending. If the pending flag is
+// already set or stream is closed, it will return false and set
+// error.
 func (x *IOStream) SetPending() (bool, error) {
 	var cerr *glib.Error
 
 	cret := xIOStreamSetPending(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -663,12 +678,12 @@ func (x *IOStream) SetPending() (bool, error) {
 
 var xIOStreamSpliceAsync func(uintptr, uintptr, IOStreamSpliceFlags, int, uintptr, uintptr, uintptr)
 
-// Asynchronously splice the output stream of @stream1 to the input stream of
-// @stream2, and splice the output stream of @stream2 to the input stream of
-// @stream1.
+// Asynchronously splice the output stream of stream1 to the input stream of
+// stream2, and splice the output stream of stream2 to the input stream of
+// stream1.
 //
-// When the operation is finished @callback will be called.
-// You can then call g_io_stream_splice_finish() to get the
+// When the operation is finished callback will be called.
+// You can then call [IOStreamSpliceFinish] to get the
 // result of the operation.
 func (x *IOStream) SpliceAsync(Stream2Var *IOStream, FlagsVar IOStreamSpliceFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -679,11 +694,12 @@ func (x *IOStream) SpliceAsync(Stream2Var *IOStream, FlagsVar IOStreamSpliceFlag
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -718,7 +734,7 @@ func IOStreamSpliceFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := xIOStreamSpliceFinish(ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -729,7 +745,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -738,17 +754,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xIOStreamGLibType, libs, "g_io_stream_get_type")
 
-	core.PuregoSafeRegister(&xIOStreamClearPending, libs, "g_io_stream_clear_pending")
-	core.PuregoSafeRegister(&xIOStreamClose, libs, "g_io_stream_close")
-	core.PuregoSafeRegister(&xIOStreamCloseAsync, libs, "g_io_stream_close_async")
-	core.PuregoSafeRegister(&xIOStreamCloseFinish, libs, "g_io_stream_close_finish")
-	core.PuregoSafeRegister(&xIOStreamGetInputStream, libs, "g_io_stream_get_input_stream")
-	core.PuregoSafeRegister(&xIOStreamGetOutputStream, libs, "g_io_stream_get_output_stream")
-	core.PuregoSafeRegister(&xIOStreamHasPending, libs, "g_io_stream_has_pending")
-	core.PuregoSafeRegister(&xIOStreamIsClosed, libs, "g_io_stream_is_closed")
-	core.PuregoSafeRegister(&xIOStreamSetPending, libs, "g_io_stream_set_pending")
-	core.PuregoSafeRegister(&xIOStreamSpliceAsync, libs, "g_io_stream_splice_async")
-
-	core.PuregoSafeRegister(&xIOStreamSpliceFinish, libs, "g_io_stream_splice_finish")
+	gobject.CheckClassSize("GIOStreamClass", xIOStreamGLibType, unsafe.Sizeof(IOStreamClass{}))
+
+	core.PuregoRegisterSince(&xIOStreamClearPending, libs, "g_io_stream_clear_pending", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamClose, libs, "g_io_stream_close", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamCloseAsync, libs, "g_io_stream_close_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamCloseFinish, libs, "g_io_stream_close_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamGetInputStream, libs, "g_io_stream_get_input_stream", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamGetOutputStream, libs, "g_io_stream_get_output_stream", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamHasPending, libs, "g_io_stream_has_pending", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamIsClosed, libs, "g_io_stream_is_closed", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamSetPending, libs, "g_io_stream_set_pending", "GIO", "2.22")
+	core.PuregoRegisterSince(&xIOStreamSpliceAsync, libs, "g_io_stream_splice_async", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xIOStreamSpliceFinish, libs, "g_io_stream_splice_finish", "GIO", "2.28")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,57 +12,57 @@ import (
 )
 
 // Type definition for a function that will be called back when an asynchronous
-// operation within GIO has been completed. #GAsyncReadyCallback
-// callbacks from #GTask are guaranteed to be invoked in a later
+// operation within GIO has been completed. [AsyncReadyCallback]
+// callbacks from [Task] are guaranteed to be invoked in a later
 // iteration of the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// where the #GTask was created. All other users of
-// #GAsyncReadyCallback must likewise call it asynchronously in a
+// (see [glib.MainContext.PushThreadDefault])
+// where the [Task] was created. All other users of
+// [AsyncReadyCallback] must likewise call it asynchronously in a
 // later iteration of the main context.
 //
 // The asynchronous operation is guaranteed to have held a reference to
-// @source_object from the time when the `*_async()` function was called, until
+// source_object from the time when the `*_async()` function was called, until
 // after this callback returns.
 type AsyncReadyCallback func(uintptr, uintptr, uintptr)
 
-// This is the function type of the callback used for the #GSource
-// returned by g_cancellable_source_new().
+// This is the function type of the callback used for the [glib.Source]
+// returned by [Cancellable.SourceNew].
 type CancellableSourceFunc func(uintptr, uintptr) bool
 
-// Function signature for a function used to determine the #GType to
-// use for an interface proxy (if @interface_name is not %NULL) or
-// object proxy (if @interface_name is %NULL).
+// Function signature for a function used to determine the [gobject.Type] to
+// use for an interface proxy (if interface_name is not nil) or
+// object proxy (if interface_name is nil).
 //
 // This function is called in the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// that @manager was constructed in.
+// (see [glib.MainContext.PushThreadDefault])
+// that manager was constructed in.
 type DBusProxyTypeFunc func(uintptr, string, string, uintptr) types.GType
 
-// This is the function type of the callback used for the #GSource
-// returned by g_datagram_based_create_source().
+// This is the function type of the callback used for the [glib.Source]
+// returned by [DatagramBased.CreateSource].
 type DatagramBasedSourceFunc func(uintptr, glib.IOCondition, uintptr) bool
 
-// This callback type is used by g_file_measure_disk_usage() to make
+// This callback type is used by [File.MeasureDiskUsage] to make
 // periodic progress reports when measuring the amount of disk spaced
 // used by a directory.
 //
 // These calls are made on a best-effort basis and not all types of
-// #GFile will support them.  At the minimum, however, one call will
+// [File] will support them.  At the minimum, however, one call will
 // always be made immediately.
 //
-// In the case that there is no support, @reporting will be set to
-// %FALSE (and the other values undefined) and no further calls will be
-// made.  Otherwise, the @reporting will be %TRUE and the other values
+// In the case that there is no support, reporting will be set to
+// false (and the other values undefined) and no further calls will be
+// made.  Otherwise, the reporting will be true and the other values
 // all-zeros during the first (immediate) call.  In this way, you can
 // know which type of progress UI to show without a delay.
 //
-// For g_file_measure_disk_usage() the callback is made directly.  For
-// g_file_measure_disk_usage_async() the callback is made via the
+// For [File.MeasureDiskUsage] the callback is made directly.  For
+// [File.MeasureDiskUsageAsync] the callback is made via the
 // default main context of the calling thread (ie: the same way that the
 // final async result would be reported).
 //
-// @current_size is in the same units as requested by the operation (see
-// %G_FILE_MEASURE_APPARENT_SIZE).
+// current_size is in the same units as requested by the operation (see
+// [GFileMeasureApparentSizeValue]).
 //
 // The frequency of the updates is implementation defined, but is
 // ideally about once every 200ms.
@@ -77,29 +76,33 @@ type FileMeasureProgressCallback func(bool, uint64, uint64, uint64, uintptr)
 // far along that operation is to the application.
 type FileProgressCallback func(int64, int64, uintptr)
 
-// When loading the partial contents of a file with g_file_load_partial_contents_async(),
+// When loading the partial contents of a file with [File.LoadPartialContentsAsync],
 // it may become necessary to determine if any more data from the file should be loaded.
-// A #GFileReadMoreCallback function facilitates this by returning %TRUE if more data
-// should be read, or %FALSE otherwise.
+// A [FileReadMoreCallback] function facilitates this by returning true if more data
+// should be read, or false otherwise.
 type FileReadMoreCallback func(string, int64, uintptr) bool
 
 // I/O Job function.
 //
-// Long-running jobs should periodically check the @cancellable
+// Long-running jobs should periodically check the cancellable
 // to see if they have been cancelled.
+//
+// Deprecated: since 2.36. Use [glib.ThreadPool] or
+//
+//	[Task.RunInThread]
 type IOSchedulerJobFunc func(*IOSchedulerJob, uintptr, uintptr) bool
 
-// This is the function type of the callback used for the #GSource
-// returned by g_pollable_input_stream_create_source() and
-// g_pollable_output_stream_create_source().
+// This is the function type of the callback used for the [glib.Source]
+// returned by [PollableInputStream.CreateSource] and
+// [PollableOutputStream.CreateSource].
 type PollableSourceFunc func(uintptr, uintptr) bool
 
 // Simple thread function that runs an asynchronous operation and
 // checks for cancellation.
 type SimpleAsyncThreadFunc func(uintptr, uintptr, uintptr)
 
-// This is the function type of the callback used for the #GSource
-// returned by g_socket_create_source().
+// This is the function type of the callback used for the [glib.Source]
+// returned by [Socket.CreateSource].
 type SocketSourceFunc func(uintptr, glib.IOCondition, uintptr) bool
 
 // Determines if a string matches a file attribute.
@@ -120,18 +123,18 @@ func (x *FileAttributeMatcher) GoPointer() uintptr {
 var xNewFileAttributeMatcher func(string) *FileAttributeMatcher
 
 // Creates a new file attribute matcher, which matches attributes
-// against a given string. #GFileAttributeMatchers are reference
+// against a given string. GFileAttributeMatchers are reference
 // counted structures, and are created with a reference count of 1. If
-// the number of references falls to 0, the #GFileAttributeMatcher is
+// the number of references falls to 0, the [FileAttributeMatcher] is
 // automatically destroyed.
 //
-// The @attributes string should be formatted with specific keys separated
+// The attributes string should be formatted with specific keys separated
 // from namespaces with a double colon. Several "namespace::key" strings may be
 // concatenated with a single comma (e.g. "standard::type,standard::is-hidden").
 // The wildcard "*" may be used to match all keys and namespaces, or
 // "namespace::*" will match all keys in a given namespace.
 //
-// ## Examples of file attribute matcher strings and results
+// # Examples of file attribute matcher strings and results
 //
 //   - `"*"`: matches all attributes.
 //   - `"standard::is-hidden"`: matches only the key is-hidden in the
@@ -147,8 +150,8 @@ func NewFileAttributeMatcher(AttributesVar string) *FileAttributeMatcher {
 var xFileAttributeMatcherEnumerateNamespace func(uintptr, string) bool
 
 // Checks if the matcher will match all of the keys in a given namespace.
-// This will always return %TRUE if a wildcard character is in use (e.g. if
-// matcher was created with "standard::*" and @ns is "standard", or if matcher was created
+// This will always return true if a wildcard character is in use (e.g. if
+// matcher was created with "standard::*" and ns is "standard", or if matcher was created
 // using "*" and namespace is anything.)
 //
 // TODO: this is awkwardly worded.
@@ -160,7 +163,7 @@ func (x *FileAttributeMatcher) EnumerateNamespace(NsVar string) bool {
 
 var xFileAttributeMatcherEnumerateNext func(uintptr) string
 
-// Gets the next matched attribute from a #GFileAttributeMatcher.
+// Gets the next matched attribute from a [FileAttributeMatcher].
 func (x *FileAttributeMatcher) EnumerateNext() string {
 
 	cret := xFileAttributeMatcherEnumerateNext(x.GoPointer())
@@ -171,7 +174,7 @@ var xFileAttributeMatcherMatches func(uintptr, string) bool
 
 // Checks if an attribute will be matched by an attribute matcher. If
 // the matcher was created with the "*" matching string, this function
-// will always return %TRUE.
+// will always return true.
 func (x *FileAttributeMatcher) Matches(AttributeVar string) bool {
 
 	cret := xFileAttributeMatcherMatches(x.GoPointer(), AttributeVar)
@@ -181,7 +184,7 @@ func (x *FileAttributeMatcher) Matches(AttributeVar string) bool {
 var xFileAttributeMatcherMatchesOnly func(uintptr, string) bool
 
 // Checks if an attribute matcher only matches a given attribute. Always
-// returns %FALSE if "*" was used when creating the matcher.
+// returns false if "*" was used when creating the matcher.
 func (x *FileAttributeMatcher) MatchesOnly(AttributeVar string) bool {
 
 	cret := xFileAttributeMatcherMatchesOnly(x.GoPointer(), AttributeVar)
@@ -199,11 +202,11 @@ func (x *FileAttributeMatcher) Ref() *FileAttributeMatcher {
 
 var xFileAttributeMatcherSubtract func(uintptr, *FileAttributeMatcher) *FileAttributeMatcher
 
-// Subtracts all attributes of @subtract from @matcher and returns
+// Subtracts all attributes of subtract from matcher and returns
 // a matcher that supports those attributes.
 //
 // Note that currently it is not possible to remove a single
-// attribute when the @matcher matches the whole namespace - or remove
+// attribute when the matcher matches the whole namespace - or remove
 // a namespace or attribute when the matcher matches everything. This
 // is a limitation of the current implementation, but may be fixed
 // in the future.
@@ -216,7 +219,7 @@ func (x *FileAttributeMatcher) Subtract(SubtractVar *FileAttributeMatcher) *File
 var xFileAttributeMatcherToString func(uintptr) string
 
 // Prints what the matcher is matching against. The format will be
-// equal to the format passed to g_file_attribute_matcher_new().
+// equal to the format passed to [NewFileAttributeMatcher].
 // The output however, might not be identical, as the matcher may
 // decide to use a different order or omit needless parts.
 func (x *FileAttributeMatcher) ToString() string {
@@ -227,15 +230,15 @@ func (x *FileAttributeMatcher) ToString() string {
 
 var xFileAttributeMatcherUnref func(uintptr)
 
-// Unreferences @matcher. If the reference count falls below 1,
-// the @matcher is automatically freed.
+// Unreferences matcher. If the reference count falls below 1,
+// the matcher is automatically freed.
 func (x *FileAttributeMatcher) Unref() {
 
 	xFileAttributeMatcherUnref(x.GoPointer())
 
 }
 
-// #GIOExtension is an opaque data structure and can only be accessed
+// [IOExtension] is an opaque data structure and can only be accessed
 // using the following functions.
 type IOExtension struct {
 	_ structs.HostLayout
@@ -247,7 +250,7 @@ func (x *IOExtension) GoPointer() uintptr {
 
 var xIOExtensionGetName func(uintptr) string
 
-// Gets the name under which @extension was registered.
+// Gets the name under which extension was registered.
 //
 // Note that the same type may be registered as extension
 // for multiple extension points, under different names.
@@ -259,7 +262,7 @@ func (x *IOExtension) GetName() string {
 
 var xIOExtensionGetPriority func(uintptr) int
 
-// Gets the priority with which @extension was registered.
+// Gets the priority with which extension was registered.
 func (x *IOExtension) GetPriority() int {
 
 	cret := xIOExtensionGetPriority(x.GoPointer())
@@ -268,7 +271,7 @@ func (x *IOExtension) GetPriority() int {
 
 var xIOExtensionGetType func(uintptr) types.GType
 
-// Gets the type associated with @extension.
+// Gets the type associated with extension.
 func (x *IOExtension) GetType() types.GType {
 
 	cret := xIOExtensionGetType(x.GoPointer())
@@ -278,7 +281,7 @@ func (x *IOExtension) GetType() types.GType {
 var xIOExtensionRefClass func(uintptr) *gobject.TypeClass
 
 // Gets a reference to the class for the type that is
-// associated with @extension.
+// associated with extension.
 func (x *IOExtension) RefClass() *gobject.TypeClass {
 
 	cret := xIOExtensionRefClass(x.GoPointer())
@@ -291,53 +294,47 @@ func (x *IOExtension) RefClass() *gobject.TypeClass {
 //
 // An extension point is identified by a name, and it may optionally
 // require that any implementation must be of a certain type (or derived
-// thereof). Use [func@Gio.IOExtensionPoint.register] to register an
-// extension point, and [method@Gio.IOExtensionPoint.set_required_type] to
+// thereof). Use [IOExtensionPointRegister] to register an
+// extension point, and [IOExtensionPoint.SetRequiredType] to
 // set a required type.
 //
 // A module can implement an extension point by specifying the
-// [type@GObject.Type] that implements the functionality. Additionally, each
+// [gobject.Type] that implements the functionality. Additionally, each
 // implementation of an extension point has a name, and a priority. Use
-// [func@Gio.IOExtensionPoint.implement] to implement an extension point.
-//
-// ```c
-// GIOExtensionPoint *ep;
-//
-// // Register an extension point
-// ep = g_io_extension_point_register ("my-extension-point");
-// g_io_extension_point_set_required_type (ep, MY_TYPE_EXAMPLE);
-// ```
-//
-// ```c
-// // Implement an extension point
-// G_DEFINE_TYPE (MyExampleImpl, my_example_impl, MY_TYPE_EXAMPLE)
-// g_io_extension_point_implement ("my-extension-point",
-//
-//	my_example_impl_get_type (),
-//	"my-example",
-//	10);
-//
-// ```
-//
-//	It is up to the code that registered the extension point how
-//	it uses the implementations that have been associated with it.
-//	Depending on the use case, it may use all implementations, or
-//	only the one with the highest priority, or pick a specific
-//	one by name.
-//
-//	To avoid opening all modules just to find out what extension
-//	points they implement, GIO makes use of a caching mechanism,
-//	see [gio-querymodules](gio-querymodules.html).
-//	You are expected to run this command after installing a
-//	GIO module.
-//
-//	The `GIO_EXTRA_MODULES` environment variable can be used to
-//	specify additional directories to automatically load modules
-//	from. This environment variable has the same syntax as the
-//	`PATH`. If two modules have the same base name in different
-//	directories, then the latter one will be ignored. If additional
-//	directories are specified GIO will load modules from the built-in
-//	directory last.
+// [IOExtensionPointImplement] to implement an extension point.
+//
+//		GIOExtensionPoint *ep;
+//
+//		// Register an extension point
+//		ep = g_io_extension_point_register ("my-extension-point");
+//		g_io_extension_point_set_required_type (ep, MY_TYPE_EXAMPLE);
+//
+//		// Implement an extension point
+//		G_DEFINE_TYPE (MyExampleImpl, my_example_impl, MY_TYPE_EXAMPLE)
+//		g_io_extension_point_implement ("my-extension-point",
+//		                                my_example_impl_get_type (),
+//		                                "my-example",
+//		                                10);
+//
+//	 It is up to the code that registered the extension point how
+//	 it uses the implementations that have been associated with it.
+//	 Depending on the use case, it may use all implementations, or
+//	 only the one with the highest priority, or pick a specific
+//	 one by name.
+//
+//	 To avoid opening all modules just to find out what extension
+//	 points they implement, GIO makes use of a caching mechanism,
+//	 see gio-querymodules.
+//	 You are expected to run this command after installing a
+//	 GIO module.
+//
+//	 The `GIO_EXTRA_MODULES` environment variable can be used to
+//	 specify additional directories to automatically load modules
+//	 from. This environment variable has the same syntax as the
+//	 `PATH`. If two modules have the same base name in different
+//	 directories, then the latter one will be ignored. If additional
+//	 directories are specified GIO will load modules from the built-in
+//	 directory last.
 type IOExtensionPoint struct {
 	_ structs.HostLayout
 }
@@ -348,7 +345,7 @@ func (x *IOExtensionPoint) GoPointer() uintptr {
 
 var xIOExtensionPointGetExtensionByName func(uintptr, string) *IOExtension
 
-// Finds a #GIOExtension for an extension point by name.
+// Finds a [IOExtension] for an extension point by name.
 func (x *IOExtensionPoint) GetExtensionByName(NameVar string) *IOExtension {
 
 	cret := xIOExtensionPointGetExtensionByName(x.GoPointer(), NameVar)
@@ -367,7 +364,7 @@ func (x *IOExtensionPoint) GetExtensions() *glib.List {
 
 var xIOExtensionPointGetRequiredType func(uintptr) types.GType
 
-// Gets the required type for @extension_point.
+// Gets the required type for extension_point.
 func (x *IOExtensionPoint) GetRequiredType() types.GType {
 
 	cret := xIOExtensionPointGetRequiredType(x.GoPointer())
@@ -376,7 +373,7 @@ func (x *IOExtensionPoint) GetRequiredType() types.GType {
 
 var xIOExtensionPointSetRequiredType func(uintptr, types.GType)
 
-// Sets the required type for @extension_point to @type.
+// Sets the required type for extension_point to type.
 // All implementations must henceforth have this type.
 func (x *IOExtensionPoint) SetRequiredType(TypeVar types.GType) {
 
@@ -385,6 +382,10 @@ func (x *IOExtensionPoint) SetRequiredType(TypeVar types.GType) {
 }
 
 // Opaque class for defining and scheduling IO jobs.
+//
+// Deprecated: since 2.36. Use [glib.ThreadPool] or
+//
+//	[Task.RunInThread]
 type IOSchedulerJob struct {
 	_ structs.HostLayout
 }
@@ -393,94 +394,6 @@ func (x *IOSchedulerJob) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xIOSchedulerJobSendToMainloop func(uintptr, uintptr, uintptr, uintptr) bool
-
-// Used from an I/O job to send a callback to be run in the thread
-// that the job was started from, waiting for the result (and thus
-// blocking the I/O job).
-func (x *IOSchedulerJob) SendToMainloop(FuncVar *glib.SourceFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) bool {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) bool {
-				cbFn := *FuncVar
-				return cbFn(arg0)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	var NotifyVarRef uintptr
-	if NotifyVar != nil {
-		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
-		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
-			NotifyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *NotifyVar
-				cbFn(arg0)
-			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
-		}
-	}
-
-	cret := xIOSchedulerJobSendToMainloop(x.GoPointer(), FuncVarRef, UserDataVar, NotifyVarRef)
-	return cret
-}
-
-var xIOSchedulerJobSendToMainloopAsync func(uintptr, uintptr, uintptr, uintptr)
-
-// Used from an I/O job to send a callback to be run asynchronously in
-// the thread that the job was started from. The callback will be run
-// when the main loop is available, but at that time the I/O job might
-// have finished. The return value from the callback is ignored.
-//
-// Note that if you are passing the @user_data from g_io_scheduler_push_job()
-// on to this function you have to ensure that it is not freed before
-// @func is called, either by passing %NULL as @notify to
-// g_io_scheduler_push_job() or by using refcounting for @user_data.
-func (x *IOSchedulerJob) SendToMainloopAsync(FuncVar *glib.SourceFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) bool {
-				cbFn := *FuncVar
-				return cbFn(arg0)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	var NotifyVarRef uintptr
-	if NotifyVar != nil {
-		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
-		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
-			NotifyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *NotifyVar
-				cbFn(arg0)
-			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
-		}
-	}
-
-	xIOSchedulerJobSendToMainloopAsync(x.GoPointer(), FuncVarRef, UserDataVar, NotifyVarRef)
-
-}
-
 type IOStreamAdapter struct {
 	_ structs.HostLayout
 }
@@ -491,22 +404,22 @@ func (x *IOStreamAdapter) GoPointer() uintptr {
 
 // Structure used for scatter/gather data input when receiving multiple
 // messages or packets in one go. You generally pass in an array of empty
-// #GInputVectors and the operation will use all the buffers as if they
-// were one buffer, and will set @bytes_received to the total number of bytes
-// received across all #GInputVectors.
+// GInputVectors and the operation will use all the buffers as if they
+// were one buffer, and will set bytes_received to the total number of bytes
+// received across all GInputVectors.
 //
 // This structure closely mirrors `struct mmsghdr` and `struct msghdr` from
 // the POSIX sockets API (see `man 2 recvmmsg`).
 //
-// If @address is non-%NULL then it is set to the source address the message
+// If address is non-nil then it is set to the source address the message
 // was received from, and the caller must free it afterwards.
 //
-// If @control_messages is non-%NULL then it is set to an array of control
+// If control_messages is non-nil then it is set to an array of control
 // messages received with the message (if any), and the caller must free it
-// afterwards. @num_control_messages is set to the number of elements in
+// afterwards. num_control_messages is set to the number of elements in
 // this array, which may be zero.
 //
-// Flags relevant to this message will be returned in @flags. For example,
+// Flags relevant to this message will be returned in flags. For example,
 // `MSG_EOR` or `MSG_TRUNC`.
 type InputMessage struct {
 	_ structs.HostLayout
@@ -531,7 +444,7 @@ func (x *InputMessage) GoPointer() uintptr {
 }
 
 // Structure used for scatter/gather data input.
-// You generally pass in an array of #GInputVectors
+// You generally pass in an array of GInputVectors
 // and the operation will store the read data starting in the
 // first buffer, switching to the next as needed.
 type InputVector struct {
@@ -548,11 +461,11 @@ func (x *InputVector) GoPointer() uintptr {
 
 // Structure used for scatter/gather data output when sending multiple
 // messages or packets in one go. You generally pass in an array of
-// #GOutputVectors and the operation will use all the buffers as if they
+// GOutputVectors and the operation will use all the buffers as if they
 // were one buffer.
 //
-// If @address is %NULL then the message is sent to the default receiver
-// (as previously set by g_socket_connect()).
+// If address is nil then the message is sent to the default receiver
+// (as previously set by [Socket.Connect]).
 type OutputMessage struct {
 	_ structs.HostLayout
 
@@ -574,7 +487,7 @@ func (x *OutputMessage) GoPointer() uintptr {
 }
 
 // Structure used for scatter/gather data output.
-// You generally pass in an array of #GOutputVectors
+// You generally pass in an array of GOutputVectors
 // and the operation will use all the buffers as if they were
 // one buffer.
 type OutputVector struct {
@@ -591,13 +504,13 @@ func (x *OutputVector) GoPointer() uintptr {
 
 // Applications and libraries often contain binary or textual data that is
 // really part of the application, rather than user data. For instance
-// [`GtkBuilder`](https://docs.gtk.org/gtk4/class.Builder.html) `.ui` files,
-// splashscreen images, [class@Gio.Menu] markup XML, CSS files, icons, etc.
+// [GtkBuilder] `.ui` files,
+// splashscreen images, [Menu] markup XML, CSS files, icons, etc.
 // These are often shipped as files in `$datadir/appname`, or manually
 // included as literal strings in the code.
 //
 // The `GResource` API and the
-// [`glib-compile-resources`](glib-compile-resources.html) program provide a
+// glib-compile-resources program provide a
 // convenient and efficient alternative to this which has some nice properties.
 // You maintain the files as normal files, so it’s easy to edit them, but during
 // the build the files are combined into a binary bundle that is linked into the
@@ -615,14 +528,14 @@ func (x *OutputVector) GoPointer() uintptr {
 // `preprocess` attribute to a comma-separated list of preprocessing options.
 // The only options currently supported are:
 //
-//   - `xml-stripblanks` which will use the [`xmllint`](man:xmllint(1)) command
+//   - `xml-stripblanks` which will use the xmllint) command
 //     to strip ignorable whitespace from the XML file. For this to work,
 //     the `XMLLINT` environment variable must be set to the full path to
 //     the xmllint executable, or xmllint must be in the `PATH`; otherwise
 //     the preprocessing step is skipped.
 //
 //   - `to-pixdata` (deprecated since gdk-pixbuf 2.32) which will use the
-//     `gdk-pixbuf-pixdata` command to convert images to the [`GdkPixdata`](https://docs.gtk.org/gdk-pixbuf/class.Pixdata.html)
+//     `gdk-pixbuf-pixdata` command to convert images to the [GdkPixdata]
 //     format, which allows you to create pixbufs directly using the data inside
 //     the resource file, rather than an (uncompressed) copy of it. For this, the
 //     `gdk-pixbuf-pixdata` program must be in the `PATH`, or the
@@ -633,7 +546,7 @@ func (x *OutputVector) GoPointer() uintptr {
 //     of using it, embed a PNG or SVG file in your `GResource`.
 //
 //   - `json-stripblanks` which will use the
-//     [`json-glib-format`](man:json-glib-format(1)) command to strip ignorable
+//     json-glib-format) command to strip ignorable
 //     whitespace from the JSON file. For this to work, the `JSON_GLIB_FORMAT`
 //     environment variable must be set to the full path to the
 //     `json-glib-format` executable, or it must be in the `PATH`; otherwise the
@@ -648,56 +561,52 @@ func (x *OutputVector) GoPointer() uintptr {
 // directory in the resource namespace, as in the example below.
 //
 // Resource bundles are created by the
-// [`glib-compile-resources`](glib-compile-resources.html) program
+// glib-compile-resources program
 // which takes an XML file that describes the bundle, and a set of files that
 // the XML references. These are combined into a binary resource bundle.
 //
 // An example resource description:
-// ```xml
-// &lt;?xml version="1.0" encoding="UTF-8"?&gt;
-// &lt;gresources&gt;
-//
-//	&lt;gresource prefix="/org/gtk/Example"&gt;
-//	  &lt;file&gt;data/splashscreen.png&lt;/file&gt;
-//	  &lt;file compressed="true"&gt;dialog.ui&lt;/file&gt;
-//	  &lt;file preprocess="xml-stripblanks"&gt;menumarkup.xml&lt;/file&gt;
-//	  &lt;file alias="example.css"&gt;data/example.css&lt;/file&gt;
-//	&lt;/gresource&gt;
 //
-// &lt;/gresources&gt;
-// ```
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<gresources>
+//	  <gresource prefix="/org/gtk/Example">
+//	    <file>data/splashscreen.png</file>
+//	    <file compressed="true">dialog.ui</file>
+//	    <file preprocess="xml-stripblanks">menumarkup.xml</file>
+//	    <file alias="example.css">data/example.css</file>
+//	  </gresource>
+//	</gresources>
 //
 // This will create a resource bundle with the following files:
-// ```
-// /org/gtk/Example/data/splashscreen.png
-// /org/gtk/Example/dialog.ui
-// /org/gtk/Example/menumarkup.xml
-// /org/gtk/Example/example.css
-// ```
+//
+//	/org/gtk/Example/data/splashscreen.png
+//	/org/gtk/Example/dialog.ui
+//	/org/gtk/Example/menumarkup.xml
+//	/org/gtk/Example/example.css
 //
 // Note that all resources in the process share the same namespace, so use
 // Java-style path prefixes (like in the above example) to avoid conflicts.
 //
-// You can then use [`glib-compile-resources`](glib-compile-resources.html) to
+// You can then use glib-compile-resources to
 // compile the XML to a binary bundle that you can load with
-// [func@Gio.Resource.load]. However, it’s more common to use the
+// [ResourceLoad]. However, it’s more common to use the
 // `--generate-source` and `--generate-header` arguments to create a source file
 // and header to link directly into your application.
 // This will generate `get_resource()`, `register_resource()` and
 // `unregister_resource()` functions, prefixed by the `--c-name` argument passed
-// to [`glib-compile-resources`](glib-compile-resources.html). `get_resource()`
+// to glib-compile-resources. `get_resource()`
 // returns the generated `GResource` object. The register and unregister
 // functions register the resource so its files can be accessed using
-// [func@Gio.resources_lookup_data].
+// [ResourcesLookupData].
 //
 // Once a `GResource` has been created and registered all the data in it can be
 // accessed globally in the process by using API calls like
-// [func@Gio.resources_open_stream] to stream the data or
-// [func@Gio.resources_lookup_data] to get a direct pointer to the data. You can
+// [ResourcesOpenStream] to stream the data or
+// [ResourcesLookupData] to get a direct pointer to the data. You can
 // also use URIs like `resource:///org/gtk/Example/data/splashscreen.png` with
-// [iface@Gio.File] to access the resource data.
+// [File] to access the resource data.
 //
-// Some higher-level APIs, such as [`GtkApplication`](https://docs.gtk.org/gtk4/class.Application.html),
+// Some higher-level APIs, such as [GtkApplication],
 // will automatically load resources from certain well-known paths in the
 // resource namespace as a convenience. See the documentation for those APIs
 // for details.
@@ -729,11 +638,9 @@ func (x *OutputVector) GoPointer() uintptr {
 // to perform during resource lookups. It is ignored when running in a setuid
 // process.
 //
-// # A substitution has the form
+// A substitution has the form
 //
-// ```
-// /org/gtk/libgtk=/home/desrt/gtk-overlay
-// ```
+//	/org/gtk/libgtk=/home/desrt/gtk-overlay
 //
 // The part before the `=` is the resource subpath for which the overlay
 // applies.  The part after is a filesystem path which contains files and
@@ -752,6 +659,10 @@ func (x *OutputVector) GoPointer() uintptr {
 // before the `=`.  The path after the slash should ideally be absolute, but
 // this is not strictly required.  It is possible to overlay the location of a
 // single resource with an individual file.
+//
+// [GtkBuilder]: https://docs.gtk.org/gtk4/class.Builder.html
+// [GdkPixdata]: https://docs.gtk.org/gdk-pixbuf/class.Pixdata.html
+// [GtkApplication]: https://docs.gtk.org/gtk4/class.Application.html
 type Resource struct {
 	_ structs.HostLayout
 }
@@ -768,89 +679,85 @@ func (x *Resource) GoPointer() uintptr {
 
 var xNewResourceFromData func(*glib.Bytes, **glib.Error) *Resource
 
-// Creates a [struct@Gio.Resource] from a reference to the binary resource bundle.
+// Creates a [Resource] from a reference to the binary resource bundle.
 //
-// This will keep a reference to @data while the resource lives, so
+// This will keep a reference to data while the resource lives, so
 // the data should not be modified or freed.
 //
 // If you want to use this resource in the global resource namespace you need
-// to register it with [func@Gio.resources_register].
+// to register it with [ResourcesRegister].
 //
-// Note: @data must be backed by memory that is at least pointer aligned.
+// Note: data must be backed by memory that is at least pointer aligned.
 // Otherwise this function will internally create a copy of the memory since
 // GLib 2.56, or in older versions fail and exit the process.
 //
-// If @data is empty or corrupt, %G_RESOURCE_ERROR_INTERNAL will be returned.
+// If data is empty or corrupt, [GResourceErrorInternalValue] will be returned.
 func NewResourceFromData(DataVar *glib.Bytes) (*Resource, error) {
 	var cerr *glib.Error
 
 	cret := xNewResourceFromData(DataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xResourceRegister func(uintptr)
-
 // Registers the resource with the process-global set of resources.
 //
 // Once a resource is registered the files in it can be accessed
 // with the global resource lookup functions like
-// [func@Gio.resources_lookup_data].
+// [ResourcesLookupData].
+//
+// Deprecated: use ResourcesRegister, this name of g_resources_register is kept for compatibility.
 func (x *Resource) Register() {
-
-	xResourceRegister(x.GoPointer())
-
+	ResourcesRegister(x)
 }
 
-var xResourceUnregister func(uintptr)
-
 // Unregisters the resource from the process-global set of resources.
+//
+// Deprecated: use ResourcesUnregister, this name of g_resources_unregister is kept for compatibility.
 func (x *Resource) Unregister() {
-
-	xResourceUnregister(x.GoPointer())
-
+	ResourcesUnregister(x)
 }
 
-var xResourceEnumerateChildren func(uintptr, string, ResourceLookupFlags, **glib.Error) []string
+var xResourceEnumerateChildren func(uintptr, string, ResourceLookupFlags, **glib.Error) uintptr
 
-// Returns all the names of children at the specified @path in the resource.
+// Returns all the names of children at the specified path in the resource.
 //
 // The return result is a `NULL` terminated list of strings which should
-// be released with [func@GLib.strfreev].
+// be released with [glib.Strfreev].
 //
-// If @path is invalid or does not exist in the [struct@Gio.Resource],
-// %G_RESOURCE_ERROR_NOT_FOUND will be returned.
+// If path is invalid or does not exist in the [Resource],
+// [GResourceErrorNotFoundValue] will be returned.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 func (x *Resource) EnumerateChildren(PathVar string, LookupFlagsVar ResourceLookupFlags) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := xResourceEnumerateChildren(x.GoPointer(), PathVar, LookupFlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
 var xResourceGetInfo func(uintptr, string, ResourceLookupFlags, *uint, *uint32, **glib.Error) bool
 
-// Looks for a file at the specified @path in the resource and
+// Looks for a file at the specified path in the resource and
 // if found returns information about it.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 //
-// The only error this can return is %G_RESOURCE_ERROR_NOT_FOUND, if @path was
-// not found in @resource.
+// The only error this can return is [GResourceErrorNotFoundValue], if path was
+// not found in resource.
 func (x *Resource) GetInfo(PathVar string, LookupFlagsVar ResourceLookupFlags, SizeVar *uint, FlagsVar *uint32) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xResourceGetInfo(x.GoPointer(), PathVar, LookupFlagsVar, SizeVar, FlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -858,7 +765,7 @@ func (x *Resource) GetInfo(PathVar string, LookupFlagsVar ResourceLookupFlags, S
 
 var xResourceHasChildren func(uintptr, string) bool
 
-// Returns whether the specified @path in the resource
+// Returns whether the specified path in the resource
 // has children.
 func (x *Resource) HasChildren(PathVar string) bool {
 
@@ -868,30 +775,30 @@ func (x *Resource) HasChildren(PathVar string) bool {
 
 var xResourceLookupData func(uintptr, string, ResourceLookupFlags, **glib.Error) *glib.Bytes
 
-// Looks for a file at the specified @path in the resource and
-// returns a [struct@GLib.Bytes] that lets you directly access the data in
+// Looks for a file at the specified path in the resource and
+// returns a [glib.Bytes] that lets you directly access the data in
 // memory.
 //
 // The data is always followed by a zero byte, so you
 // can safely use the data as a C string. However, that byte
-// is not included in the size of the [struct@GLib.Bytes].
+// is not included in the size of the [glib.Bytes].
 //
 // For uncompressed resource files this is a pointer directly into
 // the resource bundle, which is typically in some read-only data section
 // in the program binary. For compressed files, memory is allocated on
 // the heap and the data is automatically uncompressed.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 //
-// This can return error %G_RESOURCE_ERROR_NOT_FOUND if @path was not found in
-// @resource, or %G_RESOURCE_ERROR_INTERNAL if decompression of a compressed
+// This can return error [GResourceErrorNotFoundValue] if path was not found in
+// resource, or [GResourceErrorInternalValue] if decompression of a compressed
 // resource failed.
 func (x *Resource) LookupData(PathVar string, LookupFlagsVar ResourceLookupFlags) (*glib.Bytes, error) {
 	var cerr *glib.Error
 
 	cret := xResourceLookupData(x.GoPointer(), PathVar, LookupFlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -899,13 +806,13 @@ func (x *Resource) LookupData(PathVar string, LookupFlagsVar ResourceLookupFlags
 
 var xResourceOpenStream func(uintptr, string, ResourceLookupFlags, **glib.Error) uintptr
 
-// Looks for a file at the specified @path in the resource and
-// returns a [class@Gio.InputStream] that lets you read the data.
+// Looks for a file at the specified path in the resource and
+// returns a [InputStream] that lets you read the data.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 //
-// The only error this can return is %G_RESOURCE_ERROR_NOT_FOUND, if @path was
-// not found in @resource.
+// The only error this can return is [GResourceErrorNotFoundValue], if path was
+// not found in resource.
 func (x *Resource) OpenStream(PathVar string, LookupFlagsVar ResourceLookupFlags) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -913,12 +820,15 @@ func (x *Resource) OpenStream(PathVar string, LookupFlagsVar ResourceLookupFlags
 	cret := xResourceOpenStream(x.GoPointer(), PathVar, LookupFlagsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -926,7 +836,7 @@ func (x *Resource) OpenStream(PathVar string, LookupFlagsVar ResourceLookupFlags
 
 var xResourceRef func(uintptr) *Resource
 
-// Atomically increments the reference count of @resource by one.
+// Atomically increments the reference count of resource by one.
 //
 // This function is threadsafe and may be called from any thread.
 func (x *Resource) Ref() *Resource {
@@ -937,7 +847,7 @@ func (x *Resource) Ref() *Resource {
 
 var xResourceUnref func(uintptr)
 
-// Atomically decrements the reference count of @resource by one.
+// Atomically decrements the reference count of resource by one.
 //
 // If the reference count drops to 0, all memory allocated by the resource is
 // released. This function is threadsafe and may be called from any
@@ -958,11 +868,11 @@ func (x *Resource) Unref() {
 // would look up the `xmpp-client` SRV record for ‘example.com’, and
 // then connect to whatever host was pointed to by that record.
 //
-// You can use [method@Gio.Resolver.lookup_service] or
-// [method@Gio.Resolver.lookup_service_async] to find the `GSrvTarget`s
+// You can use [Resolver.LookupService] or
+// [Resolver.LookupServiceAsync] to find the `GSrvTarget`s
 // for a given service. However, if you are simply planning to connect
-// to the remote service, you can use [class@Gio.NetworkService]’s
-// [iface@Gio.SocketConnectable] interface and not need to worry about
+// to the remote service, you can use [NetworkService]’s
+// [SocketConnectable] interface and not need to worry about
 // `GSrvTarget` at all.
 type SrvTarget struct {
 	_ structs.HostLayout
@@ -980,10 +890,10 @@ func (x *SrvTarget) GoPointer() uintptr {
 
 var xNewSrvTarget func(string, uint16, uint16, uint16) *SrvTarget
 
-// Creates a new #GSrvTarget with the given parameters.
+// Creates a new [SrvTarget] with the given parameters.
 //
-// You should not need to use this; normally #GSrvTargets are
-// created by #GResolver.
+// You should not need to use this; normally GSrvTargets are
+// created by [Resolver].
 func NewSrvTarget(HostnameVar string, PortVar uint16, PriorityVar uint16, WeightVar uint16) *SrvTarget {
 
 	cret := xNewSrvTarget(HostnameVar, PortVar, PriorityVar, WeightVar)
@@ -992,7 +902,7 @@ func NewSrvTarget(HostnameVar string, PortVar uint16, PriorityVar uint16, Weight
 
 var xSrvTargetCopy func(uintptr) *SrvTarget
 
-// Copies @target
+// Copies target
 func (x *SrvTarget) Copy() *SrvTarget {
 
 	cret := xSrvTargetCopy(x.GoPointer())
@@ -1001,7 +911,7 @@ func (x *SrvTarget) Copy() *SrvTarget {
 
 var xSrvTargetFree func(uintptr)
 
-// Frees @target
+// Frees target
 func (x *SrvTarget) Free() {
 
 	xSrvTargetFree(x.GoPointer())
@@ -1010,10 +920,10 @@ func (x *SrvTarget) Free() {
 
 var xSrvTargetGetHostname func(uintptr) string
 
-// Gets @target's hostname (in ASCII form; if you are going to present
-// this to the user, you should use g_hostname_is_ascii_encoded() to
+// Gets target's hostname (in ASCII form; if you are going to present
+// this to the user, you should use [glib.HostnameIsAsciiEncoded] to
 // check if it contains encoded Unicode segments, and use
-// g_hostname_to_unicode() to convert it if it does.)
+// [glib.HostnameToUnicode] to convert it if it does.)
 func (x *SrvTarget) GetHostname() string {
 
 	cret := xSrvTargetGetHostname(x.GoPointer())
@@ -1022,7 +932,7 @@ func (x *SrvTarget) GetHostname() string {
 
 var xSrvTargetGetPort func(uintptr) uint16
 
-// Gets @target's port
+// Gets target's port
 func (x *SrvTarget) GetPort() uint16 {
 
 	cret := xSrvTargetGetPort(x.GoPointer())
@@ -1031,8 +941,8 @@ func (x *SrvTarget) GetPort() uint16 {
 
 var xSrvTargetGetPriority func(uintptr) uint16
 
-// Gets @target's priority. You should not need to look at this;
-// #GResolver already sorts the targets according to the algorithm in
+// Gets target's priority. You should not need to look at this;
+// [Resolver] already sorts the targets according to the algorithm in
 // RFC 2782.
 func (x *SrvTarget) GetPriority() uint16 {
 
@@ -1042,8 +952,8 @@ func (x *SrvTarget) GetPriority() uint16 {
 
 var xSrvTargetGetWeight func(uintptr) uint16
 
-// Gets @target's weight. You should not need to look at this;
-// #GResolver already sorts the targets according to the algorithm in
+// Gets target's weight. You should not need to look at this;
+// [Resolver] already sorts the targets according to the algorithm in
 // RFC 2782.
 func (x *SrvTarget) GetWeight() uint16 {
 
@@ -1056,7 +966,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1065,53 +975,48 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileAttributeMatcherGLibType, libs, "g_file_attribute_matcher_get_type")
 
-	core.PuregoSafeRegister(&xNewFileAttributeMatcher, libs, "g_file_attribute_matcher_new")
-
-	core.PuregoSafeRegister(&xFileAttributeMatcherEnumerateNamespace, libs, "g_file_attribute_matcher_enumerate_namespace")
-	core.PuregoSafeRegister(&xFileAttributeMatcherEnumerateNext, libs, "g_file_attribute_matcher_enumerate_next")
-	core.PuregoSafeRegister(&xFileAttributeMatcherMatches, libs, "g_file_attribute_matcher_matches")
-	core.PuregoSafeRegister(&xFileAttributeMatcherMatchesOnly, libs, "g_file_attribute_matcher_matches_only")
-	core.PuregoSafeRegister(&xFileAttributeMatcherRef, libs, "g_file_attribute_matcher_ref")
-	core.PuregoSafeRegister(&xFileAttributeMatcherSubtract, libs, "g_file_attribute_matcher_subtract")
-	core.PuregoSafeRegister(&xFileAttributeMatcherToString, libs, "g_file_attribute_matcher_to_string")
-	core.PuregoSafeRegister(&xFileAttributeMatcherUnref, libs, "g_file_attribute_matcher_unref")
+	core.PuregoRegisterSince(&xNewFileAttributeMatcher, libs, "g_file_attribute_matcher_new", "GIO", "")
 
-	core.PuregoSafeRegister(&xIOExtensionGetName, libs, "g_io_extension_get_name")
-	core.PuregoSafeRegister(&xIOExtensionGetPriority, libs, "g_io_extension_get_priority")
-	core.PuregoSafeRegister(&xIOExtensionGetType, libs, "g_io_extension_get_type")
-	core.PuregoSafeRegister(&xIOExtensionRefClass, libs, "g_io_extension_ref_class")
+	core.PuregoRegisterSince(&xFileAttributeMatcherEnumerateNamespace, libs, "g_file_attribute_matcher_enumerate_namespace", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherEnumerateNext, libs, "g_file_attribute_matcher_enumerate_next", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherMatches, libs, "g_file_attribute_matcher_matches", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherMatchesOnly, libs, "g_file_attribute_matcher_matches_only", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherRef, libs, "g_file_attribute_matcher_ref", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherSubtract, libs, "g_file_attribute_matcher_subtract", "GIO", "")
+	core.PuregoRegisterSince(&xFileAttributeMatcherToString, libs, "g_file_attribute_matcher_to_string", "GIO", "2.32")
+	core.PuregoRegisterSince(&xFileAttributeMatcherUnref, libs, "g_file_attribute_matcher_unref", "GIO", "")
 
-	core.PuregoSafeRegister(&xIOExtensionPointGetExtensionByName, libs, "g_io_extension_point_get_extension_by_name")
-	core.PuregoSafeRegister(&xIOExtensionPointGetExtensions, libs, "g_io_extension_point_get_extensions")
-	core.PuregoSafeRegister(&xIOExtensionPointGetRequiredType, libs, "g_io_extension_point_get_required_type")
-	core.PuregoSafeRegister(&xIOExtensionPointSetRequiredType, libs, "g_io_extension_point_set_required_type")
+	core.PuregoRegisterSince(&xIOExtensionGetName, libs, "g_io_extension_get_name", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionGetPriority, libs, "g_io_extension_get_priority", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionGetType, libs, "g_io_extension_get_type", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionRefClass, libs, "g_io_extension_ref_class", "GIO", "")
 
-	core.PuregoSafeRegister(&xIOSchedulerJobSendToMainloop, libs, "g_io_scheduler_job_send_to_mainloop")
-	core.PuregoSafeRegister(&xIOSchedulerJobSendToMainloopAsync, libs, "g_io_scheduler_job_send_to_mainloop_async")
+	core.PuregoRegisterSince(&xIOExtensionPointGetExtensionByName, libs, "g_io_extension_point_get_extension_by_name", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionPointGetExtensions, libs, "g_io_extension_point_get_extensions", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionPointGetRequiredType, libs, "g_io_extension_point_get_required_type", "GIO", "")
+	core.PuregoRegisterSince(&xIOExtensionPointSetRequiredType, libs, "g_io_extension_point_set_required_type", "GIO", "")
 
 	core.PuregoSafeRegister(&xResourceGLibType, libs, "g_resource_get_type")
 
-	core.PuregoSafeRegister(&xNewResourceFromData, libs, "g_resource_new_from_data")
+	core.PuregoRegisterSince(&xNewResourceFromData, libs, "g_resource_new_from_data", "GIO", "2.32")
 
-	core.PuregoSafeRegister(&xResourceRegister, libs, "g_resources_register")
-	core.PuregoSafeRegister(&xResourceUnregister, libs, "g_resources_unregister")
-	core.PuregoSafeRegister(&xResourceEnumerateChildren, libs, "g_resource_enumerate_children")
-	core.PuregoSafeRegister(&xResourceGetInfo, libs, "g_resource_get_info")
-	core.PuregoSafeRegister(&xResourceHasChildren, libs, "g_resource_has_children")
-	core.PuregoSafeRegister(&xResourceLookupData, libs, "g_resource_lookup_data")
-	core.PuregoSafeRegister(&xResourceOpenStream, libs, "g_resource_open_stream")
-	core.PuregoSafeRegister(&xResourceRef, libs, "g_resource_ref")
-	core.PuregoSafeRegister(&xResourceUnref, libs, "g_resource_unref")
+	core.PuregoRegisterSince(&xResourceEnumerateChildren, libs, "g_resource_enumerate_children", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceGetInfo, libs, "g_resource_get_info", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceHasChildren, libs, "g_resource_has_children", "GIO", "2.84")
+	core.PuregoRegisterSince(&xResourceLookupData, libs, "g_resource_lookup_data", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceOpenStream, libs, "g_resource_open_stream", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceRef, libs, "g_resource_ref", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceUnref, libs, "g_resource_unref", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xSrvTargetGLibType, libs, "g_srv_target_get_type")
 
-	core.PuregoSafeRegister(&xNewSrvTarget, libs, "g_srv_target_new")
+	core.PuregoRegisterSince(&xNewSrvTarget, libs, "g_srv_target_new", "GIO", "2.22")
 
-	core.PuregoSafeRegister(&xSrvTargetCopy, libs, "g_srv_target_copy")
-	core.PuregoSafeRegister(&xSrvTargetFree, libs, "g_srv_target_free")
-	core.PuregoSafeRegister(&xSrvTargetGetHostname, libs, "g_srv_target_get_hostname")
-	core.PuregoSafeRegister(&xSrvTargetGetPort, libs, "g_srv_target_get_port")
-	core.PuregoSafeRegister(&xSrvTargetGetPriority, libs, "g_srv_target_get_priority")
-	core.PuregoSafeRegister(&xSrvTargetGetWeight, libs, "g_srv_target_get_weight")
+	core.PuregoRegisterSince(&xSrvTargetCopy, libs, "g_srv_target_copy", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSrvTargetFree, libs, "g_srv_target_free", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSrvTargetGetHostname, libs, "g_srv_target_get_hostname", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSrvTargetGetPort, libs, "g_srv_target_get_port", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSrvTargetGetPriority, libs, "g_srv_target_get_priority", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSrvTargetGetWeight, libs, "g_srv_target_get_weight", "GIO", "2.22")
 
 }
@@ -0,0 +1,124 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xIOSchedulerJobSendToMainloop func(uintptr, uintptr, uintptr, uintptr) bool
+
+// Used from an I/O job to send a callback to be run in the thread
+// that the job was started from, waiting for the result (and thus
+// blocking the I/O job).
+//
+// Deprecated: since 2.36. Use [glib.MainContext.Invoke].
+func (x *IOSchedulerJob) SendToMainloop(FuncVar *glib.SourceFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) bool {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var NotifyVarRef uintptr
+	if NotifyVar != nil {
+		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
+			NotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *NotifyVar
+				cbFn(arg0)
+			}
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+		}
+	}
+
+	cret := xIOSchedulerJobSendToMainloop(x.GoPointer(), FuncVarRef, UserDataVar, NotifyVarRef)
+	return cret
+}
+
+var xIOSchedulerJobSendToMainloopAsync func(uintptr, uintptr, uintptr, uintptr)
+
+// Used from an I/O job to send a callback to be run asynchronously in
+// the thread that the job was started from. The callback will be run
+// when the main loop is available, but at that time the I/O job might
+// have finished. The return value from the callback is ignored.
+//
+// Note that if you are passing the user_data from [IoSchedulerPushJob]
+// on to this function you have to ensure that it is not freed before
+// func is called, either by passing nil as notify to
+// [IoSchedulerPushJob] or by using refcounting for user_data.
+//
+// Deprecated: since 2.36. Use [glib.MainContext.Invoke].
+func (x *IOSchedulerJob) SendToMainloopAsync(FuncVar *glib.SourceFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var NotifyVarRef uintptr
+	if NotifyVar != nil {
+		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
+			NotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *NotifyVar
+				cbFn(arg0)
+			}
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+		}
+	}
+
+	xIOSchedulerJobSendToMainloopAsync(x.GoPointer(), FuncVarRef, UserDataVar, NotifyVarRef)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIOSchedulerJobSendToMainloop, libs, "g_io_scheduler_job_send_to_mainloop", "GIO", "")
+	core.PuregoRegisterSince(&xIOSchedulerJobSendToMainloopAsync, libs, "g_io_scheduler_job_send_to_mainloop_async", "GIO", "")
+
+}
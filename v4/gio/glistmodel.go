@@ -5,17 +5,16 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GListModel.
+// The virtual function table for [ListModel].
 type ListModelInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetItemType uintptr
 
@@ -29,62 +28,65 @@ func (x *ListModelInterface) GoPointer() uintptr {
 }
 
 // OverrideGetItemType sets the "get_item_type" callback function.
-// the virtual function pointer for g_list_model_get_item_type()
+// the virtual function pointer for [ListModel.GetItemType]
 func (x *ListModelInterface) OverrideGetItemType(cb func(ListModel) types.GType) {
 	if cb == nil {
 		x.xGetItemType = 0
 	} else {
-		x.xGetItemType = purego.NewCallback(func(ListVarp uintptr) types.GType {
+		x.xGetItemType = core.NewCallback(func(ListVarp uintptr) types.GType {
+			defer core.RecoverPanic()
 			return cb(&ListModelBase{Ptr: ListVarp})
 		})
 	}
 }
 
 // GetGetItemType gets the "get_item_type" callback function.
-// the virtual function pointer for g_list_model_get_item_type()
+// the virtual function pointer for [ListModel.GetItemType]
 func (x *ListModelInterface) GetGetItemType() func(ListModel) types.GType {
 	if x.xGetItemType == 0 {
 		return nil
 	}
 	var rawCallback func(ListVarp uintptr) types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetItemType)
+	core.RegisterFunc(&rawCallback, x.xGetItemType)
 	return func(ListVar ListModel) types.GType {
 		return rawCallback(ListVar.GoPointer())
 	}
 }
 
 // OverrideGetNItems sets the "get_n_items" callback function.
-// the virtual function pointer for g_list_model_get_n_items()
+// the virtual function pointer for [ListModel.GetNItems]
 func (x *ListModelInterface) OverrideGetNItems(cb func(ListModel) uint) {
 	if cb == nil {
 		x.xGetNItems = 0
 	} else {
-		x.xGetNItems = purego.NewCallback(func(ListVarp uintptr) uint {
+		x.xGetNItems = core.NewCallback(func(ListVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(&ListModelBase{Ptr: ListVarp})
 		})
 	}
 }
 
 // GetGetNItems gets the "get_n_items" callback function.
-// the virtual function pointer for g_list_model_get_n_items()
+// the virtual function pointer for [ListModel.GetNItems]
 func (x *ListModelInterface) GetGetNItems() func(ListModel) uint {
 	if x.xGetNItems == 0 {
 		return nil
 	}
 	var rawCallback func(ListVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xGetNItems)
+	core.RegisterFunc(&rawCallback, x.xGetNItems)
 	return func(ListVar ListModel) uint {
 		return rawCallback(ListVar.GoPointer())
 	}
 }
 
 // OverrideGetItem sets the "get_item" callback function.
-// the virtual function pointer for g_list_model_get_item()
+// the virtual function pointer for [ListModel.GetItem]
 func (x *ListModelInterface) OverrideGetItem(cb func(ListModel, uint) *gobject.Object) {
 	if cb == nil {
 		x.xGetItem = 0
 	} else {
-		x.xGetItem = purego.NewCallback(func(ListVarp uintptr, PositionVarp uint) uintptr {
+		x.xGetItem = core.NewCallback(func(ListVarp uintptr, PositionVarp uint) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&ListModelBase{Ptr: ListVarp}, PositionVarp)
 			if ret == nil {
 				return 0
@@ -95,13 +97,13 @@ func (x *ListModelInterface) OverrideGetItem(cb func(ListModel, uint) *gobject.O
 }
 
 // GetGetItem gets the "get_item" callback function.
-// the virtual function pointer for g_list_model_get_item()
+// the virtual function pointer for [ListModel.GetItem]
 func (x *ListModelInterface) GetGetItem() func(ListModel, uint) *gobject.Object {
 	if x.xGetItem == 0 {
 		return nil
 	}
 	var rawCallback func(ListVarp uintptr, PositionVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetItem)
+	core.RegisterFunc(&rawCallback, x.xGetItem)
 	return func(ListVar ListModel, PositionVar uint) *gobject.Object {
 		rawRet := rawCallback(ListVar.GoPointer(), PositionVar)
 		if rawRet == 0 {
@@ -114,14 +116,14 @@ func (x *ListModelInterface) GetGetItem() func(ListModel, uint) *gobject.Object
 }
 
 // `GListModel` is an interface that represents a mutable list of
-// [class@GObject.Object]. Its main intention is as a model for various widgets
+// [gobject.Object]. Its main intention is as a model for various widgets
 // in user interfaces, such as list views, but it can also be used as a
 // convenient method of returning lists of data, with support for
 // updates.
 //
 // Each object in the list may also report changes in itself via some
-// mechanism (normally the [signal@GObject.Object::notify] signal).  Taken
-// together with the [signal@Gio.ListModel::items-changed] signal, this provides
+// mechanism (normally the [gobject.Object.ConnectNotifyFunc] signal).  Taken
+// together with the ListModel::items-changed signal, this provides
 // for a list that can change its membership, and in which the members can
 // change their individual properties.
 //
@@ -136,15 +138,15 @@ func (x *ListModelInterface) GetGetItem() func(ListModel, uint) *gobject.Object
 // interested in.
 //
 // All items in a `GListModel` are of (or derived from) the same type.
-// [method@Gio.ListModel.get_item_type] returns that type.  The type may be an
+// [ListModel.GetItemType] returns that type.  The type may be an
 // interface, in which case all objects in the list must implement it.
 //
 // The semantics are close to that of an array:
-// [method@Gio.ListModel.get_n_items] returns the number of items in the list
-// and [method@Gio.ListModel.get_item] returns an item at a (0-based) position.
+// [ListModel.GetNItems] returns the number of items in the list
+// and [ListModel.GetItem] returns an item at a (0-based) position.
 // In order to allow implementations to calculate the list length lazily,
 // you can also iterate over items: starting from 0, repeatedly call
-// [method@Gio.ListModel.get_item] until it returns `NULL`.
+// [ListModel.GetItem] until it returns `NULL`.
 //
 // An implementation may create objects lazily, but must take care to
 // return the same object for a given position until all references to
@@ -159,28 +161,22 @@ func (x *ListModelInterface) GetGetItem() func(ListModel, uint) *gobject.Object
 // thread in which it is appropriate to use it depends on the particular
 // implementation, but typically it will be from the thread that owns
 // the thread-default main context (see
-// [method@GLib.MainContext.push_thread_default]) in effect at the time that the
+// [glib.MainContext.PushThreadDefault]) in effect at the time that the
 // model was created.
 //
 // Over time, it has established itself as good practice for list model
 // implementations to provide properties `item-type` and `n-items` to
 // ease working with them. While it is not required, it is recommended
 // that implementations provide these two properties. They should return
-// the values of [method@Gio.ListModel.get_item_type] and
-// [method@Gio.ListModel.get_n_items] respectively and be defined as such:
+// the values of [ListModel.GetItemType] and
+// [ListModel.GetNItems] respectively and be defined as such:
 //
-// ```c
-// properties[PROP_ITEM_TYPE] =
-//
-//	g_param_spec_gtype ("item-type", NULL, NULL, G_TYPE_OBJECT,
-//	                    G_PARAM_CONSTRUCT_ONLY | G_PARAM_READWRITE | G_PARAM_STATIC_STRINGS);
-//
-// properties[PROP_N_ITEMS] =
-//
-//	g_param_spec_uint ("n-items", NULL, NULL, 0, G_MAXUINT, 0,
-//	                   G_PARAM_READABLE | G_PARAM_STATIC_STRINGS);
-//
-// ```
+//	properties[PROP_ITEM_TYPE] =
+//	  g_param_spec_gtype ("item-type", NULL, NULL, G_TYPE_OBJECT,
+//	                      G_PARAM_CONSTRUCT_ONLY | G_PARAM_READWRITE | G_PARAM_STATIC_STRINGS);
+//	properties[PROP_N_ITEMS] =
+//	  g_param_spec_uint ("n-items", NULL, NULL, 0, G_MAXUINT, 0,
+//	                     G_PARAM_READABLE | G_PARAM_STATIC_STRINGS);
 type ListModel interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -212,28 +208,64 @@ func (x *ListModelBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Get the item at @position.
+// ImplementListModel adds the ListModel interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
 //
-// If @position is greater than the number of items in @list, %NULL is
+//   - GetItemType() types.GType
+//   - GetNItems() uint
+//   - GetItem(uint) *gobject.Object
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ListModelInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementListModel[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ListModelGLibType(), func(iface *ListModelInterface) {
+		if _, ok := any(zero).(interface{ GetItemType() types.GType }); ok {
+			iface.OverrideGetItemType(func(self ListModel) types.GType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetItemType() types.GType })
+				return impl.GetItemType()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetNItems() uint }); ok {
+			iface.OverrideGetNItems(func(self ListModel) uint {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetNItems() uint })
+				return impl.GetNItems()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetItem(uint) *gobject.Object }); ok {
+			iface.OverrideGetItem(func(self ListModel, arg0 uint) *gobject.Object {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetItem(uint) *gobject.Object })
+				return impl.GetItem(arg0)
+			})
+		}
+	})
+}
+
+// Get the item at position.
+//
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *ListModelBase) GetItem(PositionVar uint) uintptr {
 
 	cret := XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [ListModel] can not change during the life of the
 // model.
 func (x *ListModelBase) GetItemType() types.GType {
 
@@ -241,29 +273,29 @@ func (x *ListModelBase) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [ListModel.GetItem] returns nil.
 func (x *ListModelBase) GetNItems() uint {
 
 	cret := XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [ListModel.GetNItems]
 func (x *ListModelBase) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -277,20 +309,20 @@ func (x *ListModelBase) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -314,7 +346,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -323,10 +355,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xListModelGLibType, libs, "g_list_model_get_type")
 
-	core.PuregoSafeRegister(&XGListModelGetItem, libs, "g_list_model_get_item")
-	core.PuregoSafeRegister(&XGListModelGetItemType, libs, "g_list_model_get_item_type")
-	core.PuregoSafeRegister(&XGListModelGetNItems, libs, "g_list_model_get_n_items")
-	core.PuregoSafeRegister(&XGListModelGetObject, libs, "g_list_model_get_object")
-	core.PuregoSafeRegister(&XGListModelItemsChanged, libs, "g_list_model_items_changed")
+	gobject.CheckClassSize("GListModelInterface", xListModelGLibType, unsafe.Sizeof(ListModelInterface{}))
+
+	core.PuregoRegisterSince(&XGListModelGetItem, libs, "g_list_model_get_item", "GIO", "2.44")
+	core.PuregoRegisterSince(&XGListModelGetItemType, libs, "g_list_model_get_item_type", "GIO", "2.44")
+	core.PuregoRegisterSince(&XGListModelGetNItems, libs, "g_list_model_get_n_items", "GIO", "2.44")
+	core.PuregoRegisterSince(&XGListModelGetObject, libs, "g_list_model_get_object", "GIO", "2.44")
+	core.PuregoRegisterSince(&XGListModelItemsChanged, libs, "g_list_model_items_changed", "GIO", "2.44")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,7 +21,7 @@ func (x *ListStoreClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GListStore` is a simple implementation of [iface@Gio.ListModel] that stores
+// `GListStore` is a simple implementation of [ListModel] that stores
 // all items in memory.
 //
 // It provides insertions, deletions, and lookups in logarithmic time
@@ -45,8 +44,8 @@ func ListStoreNewFromInternalPtr(ptr uintptr) *ListStore {
 
 var xNewListStore func(types.GType) uintptr
 
-// Creates a new #GListStore with items of type @item_type. @item_type
-// must be a subclass of #GObject.
+// Creates a new [ListStore] with items of type item_type. item_type
+// must be a subclass of [gobject.Object].
 func NewListStore(ItemTypeVar types.GType) *ListStore {
 	var cls *ListStore
 
@@ -62,11 +61,11 @@ func NewListStore(ItemTypeVar types.GType) *ListStore {
 
 var xListStoreAppend func(uintptr, uintptr)
 
-// Appends @item to @store. @item must be of type #GListStore:item-type.
+// Appends item to store. item must be of type GListStore:item-type.
 //
-// This function takes a ref on @item.
+// This function takes a ref on item.
 //
-// Use g_list_store_splice() to append multiple items at the same time
+// Use [ListStore.Splice] to append multiple items at the same time
 // efficiently.
 func (x *ListStore) Append(ItemVar *gobject.Object) {
 
@@ -76,12 +75,12 @@ func (x *ListStore) Append(ItemVar *gobject.Object) {
 
 var xListStoreFind func(uintptr, uintptr, *uint) bool
 
-// Looks up the given @item in the list store by looping over the items until
-// the first occurrence of @item. If @item was not found, then @position will
-// not be set, and this method will return %FALSE.
+// Looks up the given item in the list store by looping over the items until
+// the first occurrence of item. If item was not found, then position will
+// not be set, and this method will return false.
 //
 // If you need to compare the two items with a custom comparison function, use
-// g_list_store_find_with_equal_func() with a custom #GEqualFunc instead.
+// [ListStore.FindWithEqualFunc] with a custom [glib.EqualFunc] instead.
 func (x *ListStore) Find(ItemVar *gobject.Object, PositionVar *uint) bool {
 
 	cret := xListStoreFind(x.GoPointer(), ItemVar.GoPointer(), PositionVar)
@@ -90,14 +89,14 @@ func (x *ListStore) Find(ItemVar *gobject.Object, PositionVar *uint) bool {
 
 var xListStoreFindWithEqualFunc func(uintptr, uintptr, uintptr, *uint) bool
 
-// Looks up the given @item in the list store by looping over the items and
-// comparing them with @equal_func until the first occurrence of @item which
-// matches. If @item was not found, then @position will not be set, and this
-// method will return %FALSE.
+// Looks up the given item in the list store by looping over the items and
+// comparing them with equal_func until the first occurrence of item which
+// matches. If item was not found, then position will not be set, and this
+// method will return false.
 //
-// @item is always passed as second parameter to @equal_func.
+// item is always passed as second parameter to equal_func.
 //
-// Since GLib 2.76 it is possible to pass `NULL` for @item.
+// Since GLib 2.76 it is possible to pass `NULL` for item.
 func (x *ListStore) FindWithEqualFunc(ItemVar *gobject.Object, EqualFuncVar *glib.EqualFunc, PositionVar *uint) bool {
 
 	var EqualFuncVarRef uintptr
@@ -107,11 +106,12 @@ func (x *ListStore) FindWithEqualFunc(ItemVar *gobject.Object, EqualFuncVar *gli
 			EqualFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *EqualFuncVar
 				return cbFn(arg0, arg1)
 			}
-			EqualFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
+			EqualFuncVarRef = core.NewCallback(fcb)
+			EqualFuncVarRef = glib.SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
 		}
 	}
 
@@ -121,12 +121,12 @@ func (x *ListStore) FindWithEqualFunc(ItemVar *gobject.Object, EqualFuncVar *gli
 
 var xListStoreFindWithEqualFuncFull func(uintptr, uintptr, uintptr, uintptr, *uint) bool
 
-// Like g_list_store_find_with_equal_func() but with an additional @user_data
-// that is passed to @equal_func.
+// Like [ListStore.FindWithEqualFunc] but with an additional user_data
+// that is passed to equal_func.
 //
-// @item is always passed as second parameter to @equal_func.
+// item is always passed as second parameter to equal_func.
 //
-// Since GLib 2.76 it is possible to pass `NULL` for @item.
+// Since GLib 2.76 it is possible to pass `NULL` for item.
 func (x *ListStore) FindWithEqualFuncFull(ItemVar *gobject.Object, EqualFuncVar *glib.EqualFuncFull, UserDataVar uintptr, PositionVar *uint) bool {
 
 	var EqualFuncVarRef uintptr
@@ -136,11 +136,12 @@ func (x *ListStore) FindWithEqualFuncFull(ItemVar *gobject.Object, EqualFuncVar
 			EqualFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *EqualFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			EqualFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
+			EqualFuncVarRef = core.NewCallback(fcb)
+			EqualFuncVarRef = glib.SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
 		}
 	}
 
@@ -150,13 +151,13 @@ func (x *ListStore) FindWithEqualFuncFull(ItemVar *gobject.Object, EqualFuncVar
 
 var xListStoreInsert func(uintptr, uint, uintptr)
 
-// Inserts @item into @store at @position. @item must be of type
-// #GListStore:item-type or derived from it. @position must be smaller
+// Inserts item into store at position. item must be of type
+// GListStore:item-type or derived from it. position must be smaller
 // than the length of the list, or equal to it to append.
 //
-// This function takes a ref on @item.
+// This function takes a ref on item.
 //
-// Use g_list_store_splice() to insert multiple items at the same time
+// Use [ListStore.Splice] to insert multiple items at the same time
 // efficiently.
 func (x *ListStore) Insert(PositionVar uint, ItemVar *gobject.Object) {
 
@@ -166,14 +167,14 @@ func (x *ListStore) Insert(PositionVar uint, ItemVar *gobject.Object) {
 
 var xListStoreInsertSorted func(uintptr, uintptr, uintptr, uintptr) uint
 
-// Inserts @item into @store at a position to be determined by the
-// @compare_func.
+// Inserts item into store at a position to be determined by the
+// compare_func.
 //
 // The list must already be sorted before calling this function or the
 // result is undefined.  Usually you would approach this by only ever
 // inserting items by way of this function.
 //
-// This function takes a ref on @item.
+// This function takes a ref on item.
 func (x *ListStore) InsertSorted(ItemVar *gobject.Object, CompareFuncVar *glib.CompareDataFunc, UserDataVar uintptr) uint {
 
 	var CompareFuncVarRef uintptr
@@ -183,11 +184,12 @@ func (x *ListStore) InsertSorted(ItemVar *gobject.Object, CompareFuncVar *glib.C
 			CompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CompareFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
 		}
 	}
 
@@ -197,10 +199,10 @@ func (x *ListStore) InsertSorted(ItemVar *gobject.Object, CompareFuncVar *glib.C
 
 var xListStoreRemove func(uintptr, uint)
 
-// Removes the item from @store that is at @position. @position must be
+// Removes the item from store that is at position. position must be
 // smaller than the current length of the list.
 //
-// Use g_list_store_splice() to remove multiple items at the same time
+// Use [ListStore.Splice] to remove multiple items at the same time
 // efficiently.
 func (x *ListStore) Remove(PositionVar uint) {
 
@@ -210,7 +212,7 @@ func (x *ListStore) Remove(PositionVar uint) {
 
 var xListStoreRemoveAll func(uintptr)
 
-// Removes all items from @store.
+// Removes all items from store.
 func (x *ListStore) RemoveAll() {
 
 	xListStoreRemoveAll(x.GoPointer())
@@ -219,7 +221,7 @@ func (x *ListStore) RemoveAll() {
 
 var xListStoreSort func(uintptr, uintptr, uintptr)
 
-// Sort the items in @store according to @compare_func.
+// Sort the items in store according to compare_func.
 func (x *ListStore) Sort(CompareFuncVar *glib.CompareDataFunc, UserDataVar uintptr) {
 
 	var CompareFuncVarRef uintptr
@@ -229,11 +231,12 @@ func (x *ListStore) Sort(CompareFuncVar *glib.CompareDataFunc, UserDataVar uintp
 			CompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CompareFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
 		}
 	}
 
@@ -243,18 +246,18 @@ func (x *ListStore) Sort(CompareFuncVar *glib.CompareDataFunc, UserDataVar uintp
 
 var xListStoreSplice func(uintptr, uint, uint, []gobject.Object, uint)
 
-// Changes @store by removing @n_removals items and adding @n_additions
-// items to it. @additions must contain @n_additions items of type
-// #GListStore:item-type.  %NULL is not permitted.
+// Changes store by removing n_removals items and adding n_additions
+// items to it. additions must contain n_additions items of type
+// GListStore:item-type.  nil is not permitted.
 //
-// This function is more efficient than g_list_store_insert() and
-// g_list_store_remove(), because it only emits
-// #GListModel::items-changed once for the change.
+// This function is more efficient than [ListStore.Insert] and
+// [ListStore.Remove], because it only emits
+// GListModel::items-changed once for the change.
 //
-// This function takes a ref on each item in @additions.
+// This function takes a ref on each item in additions.
 //
-// The parameters @position and @n_removals must be correct (ie:
-// @position + @n_removals must be less than or equal to the length of
+// The parameters position and n_removals must be correct (ie:
+// position + n_removals must be less than or equal to the length of
 // the list at the time this function is called).
 func (x *ListStore) Splice(PositionVar uint, NRemovalsVar uint, AdditionsVar []gobject.Object, NAdditionsVar uint) {
 
@@ -281,28 +284,30 @@ func (x *ListStore) GetPropertyNItems() uint {
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *ListStore) GetItem(PositionVar uint) uintptr {
 
 	cret := XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [ListModel] can not change during the life of the
 // model.
 func (x *ListStore) GetItemType() types.GType {
 
@@ -310,29 +315,29 @@ func (x *ListStore) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [ListModel.GetItem] returns nil.
 func (x *ListStore) GetNItems() uint {
 
 	cret := XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [ListModel.GetNItems]
 func (x *ListStore) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -346,20 +351,20 @@ func (x *ListStore) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -377,7 +382,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -386,17 +391,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xListStoreGLibType, libs, "g_list_store_get_type")
 
-	core.PuregoSafeRegister(&xNewListStore, libs, "g_list_store_new")
-
-	core.PuregoSafeRegister(&xListStoreAppend, libs, "g_list_store_append")
-	core.PuregoSafeRegister(&xListStoreFind, libs, "g_list_store_find")
-	core.PuregoSafeRegister(&xListStoreFindWithEqualFunc, libs, "g_list_store_find_with_equal_func")
-	core.PuregoSafeRegister(&xListStoreFindWithEqualFuncFull, libs, "g_list_store_find_with_equal_func_full")
-	core.PuregoSafeRegister(&xListStoreInsert, libs, "g_list_store_insert")
-	core.PuregoSafeRegister(&xListStoreInsertSorted, libs, "g_list_store_insert_sorted")
-	core.PuregoSafeRegister(&xListStoreRemove, libs, "g_list_store_remove")
-	core.PuregoSafeRegister(&xListStoreRemoveAll, libs, "g_list_store_remove_all")
-	core.PuregoSafeRegister(&xListStoreSort, libs, "g_list_store_sort")
-	core.PuregoSafeRegister(&xListStoreSplice, libs, "g_list_store_splice")
+	gobject.CheckClassSize("GListStoreClass", xListStoreGLibType, unsafe.Sizeof(ListStoreClass{}))
+
+	core.PuregoRegisterSince(&xNewListStore, libs, "g_list_store_new", "GIO", "2.44")
+
+	core.PuregoRegisterSince(&xListStoreAppend, libs, "g_list_store_append", "GIO", "2.44")
+	core.PuregoRegisterSince(&xListStoreFind, libs, "g_list_store_find", "GIO", "2.64")
+	core.PuregoRegisterSince(&xListStoreFindWithEqualFunc, libs, "g_list_store_find_with_equal_func", "GIO", "2.64")
+	core.PuregoRegisterSince(&xListStoreFindWithEqualFuncFull, libs, "g_list_store_find_with_equal_func_full", "GIO", "2.74")
+	core.PuregoRegisterSince(&xListStoreInsert, libs, "g_list_store_insert", "GIO", "2.44")
+	core.PuregoRegisterSince(&xListStoreInsertSorted, libs, "g_list_store_insert_sorted", "GIO", "2.44")
+	core.PuregoRegisterSince(&xListStoreRemove, libs, "g_list_store_remove", "GIO", "2.44")
+	core.PuregoRegisterSince(&xListStoreRemoveAll, libs, "g_list_store_remove_all", "GIO", "2.44")
+	core.PuregoRegisterSince(&xListStoreSort, libs, "g_list_store_sort", "GIO", "2.46")
+	core.PuregoRegisterSince(&xListStoreSplice, libs, "g_list_store_splice", "GIO", "2.44")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type LoadableIconIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xLoad uintptr
 
@@ -34,7 +34,8 @@ func (x *LoadableIconIface) OverrideLoad(cb func(LoadableIcon, int, *string, *Ca
 	if cb == nil {
 		x.xLoad = 0
 	} else {
-		x.xLoad = purego.NewCallback(func(IconVarp uintptr, SizeVarp int, TypeVarp *string, CancellableVarp uintptr) uintptr {
+		x.xLoad = core.NewCallback(func(IconVarp uintptr, SizeVarp int, TypeVarp *string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&LoadableIconBase{Ptr: IconVarp}, SizeVarp, TypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -51,7 +52,7 @@ func (x *LoadableIconIface) GetLoad() func(LoadableIcon, int, *string, *Cancella
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr, SizeVarp int, TypeVarp *string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLoad)
+	core.RegisterFunc(&rawCallback, x.xLoad)
 	return func(IconVar LoadableIcon, SizeVar int, TypeVar *string, CancellableVar *Cancellable) *InputStream {
 		rawRet := rawCallback(IconVar.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -69,7 +70,8 @@ func (x *LoadableIconIface) OverrideLoadAsync(cb func(LoadableIcon, int, *Cancel
 	if cb == nil {
 		x.xLoadAsync = 0
 	} else {
-		x.xLoadAsync = purego.NewCallback(func(IconVarp uintptr, SizeVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLoadAsync = core.NewCallback(func(IconVarp uintptr, SizeVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&LoadableIconBase{Ptr: IconVarp}, SizeVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -82,7 +84,7 @@ func (x *LoadableIconIface) GetLoadAsync() func(LoadableIcon, int, *Cancellable,
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr, SizeVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLoadAsync)
+	core.RegisterFunc(&rawCallback, x.xLoadAsync)
 	return func(IconVar LoadableIcon, SizeVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(IconVar.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -94,7 +96,8 @@ func (x *LoadableIconIface) OverrideLoadFinish(cb func(LoadableIcon, AsyncResult
 	if cb == nil {
 		x.xLoadFinish = 0
 	} else {
-		x.xLoadFinish = purego.NewCallback(func(IconVarp uintptr, ResVarp uintptr, TypeVarp *string) uintptr {
+		x.xLoadFinish = core.NewCallback(func(IconVarp uintptr, ResVarp uintptr, TypeVarp *string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&LoadableIconBase{Ptr: IconVarp}, &AsyncResultBase{Ptr: ResVarp}, TypeVarp)
 			if ret == nil {
 				return 0
@@ -111,7 +114,7 @@ func (x *LoadableIconIface) GetLoadFinish() func(LoadableIcon, AsyncResult, *str
 		return nil
 	}
 	var rawCallback func(IconVarp uintptr, ResVarp uintptr, TypeVarp *string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLoadFinish)
+	core.RegisterFunc(&rawCallback, x.xLoadFinish)
 	return func(IconVar LoadableIcon, ResVar AsyncResult, TypeVar *string) *InputStream {
 		rawRet := rawCallback(IconVar.GoPointer(), ResVar.GoPointer(), TypeVar)
 		if rawRet == 0 {
@@ -123,7 +126,7 @@ func (x *LoadableIconIface) GetLoadFinish() func(LoadableIcon, AsyncResult, *str
 	}
 }
 
-// `GLoadableIcon` extends the [iface@Gio.Icon] interface and adds the ability
+// `GLoadableIcon` extends the [Icon] interface and adds the ability
 // to load icons from streams.
 type LoadableIcon interface {
 	GoPointer() uintptr
@@ -154,8 +157,54 @@ func (x *LoadableIconBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementLoadableIcon adds the LoadableIcon interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Load(int, *string, *Cancellable) *InputStream
+//   - LoadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - LoadFinish(AsyncResult, *string) *InputStream
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see LoadableIconIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementLoadableIcon[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, LoadableIconGLibType(), func(iface *LoadableIconIface) {
+		if _, ok := any(zero).(interface {
+			Load(int, *string, *Cancellable) *InputStream
+		}); ok {
+			iface.OverrideLoad(func(self LoadableIcon, arg0 int, arg1 *string, arg2 *Cancellable) *InputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Load(int, *string, *Cancellable) *InputStream
+				})
+				return impl.Load(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			LoadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideLoadAsync(func(self LoadableIcon, arg0 int, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LoadAsync(int, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.LoadAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			LoadFinish(AsyncResult, *string) *InputStream
+		}); ok {
+			iface.OverrideLoadFinish(func(self LoadableIcon, arg0 AsyncResult, arg1 *string) *InputStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LoadFinish(AsyncResult, *string) *InputStream
+				})
+				return impl.LoadFinish(arg0, arg1)
+			})
+		}
+	})
+}
+
 // Loads a loadable icon. For the asynchronous version of this function,
-// see g_loadable_icon_load_async().
+// see [LoadableIcon.LoadAsync].
 func (x *LoadableIconBase) Load(SizeVar int, TypeVar *string, CancellableVar *Cancellable) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -163,27 +212,46 @@ func (x *LoadableIconBase) Load(SizeVar int, TypeVar *string, CancellableVar *Ca
 	cret := XGLoadableIconLoad(x.GoPointer(), SizeVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
 // Loads an icon asynchronously. To finish this function, see
-// g_loadable_icon_load_finish(). For the synchronous, blocking
-// version of this function, see g_loadable_icon_load().
+// [LoadableIcon.LoadFinish]. For the synchronous, blocking
+// version of this function, see [LoadableIcon.Load].
 func (x *LoadableIconBase) LoadAsync(SizeVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGLoadableIconLoadAsync(x.GoPointer(), SizeVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes an asynchronous icon load started in g_loadable_icon_load_async().
+// Finishes an asynchronous icon load started in [LoadableIcon.LoadAsync].
 func (x *LoadableIconBase) LoadFinish(ResVar AsyncResult, TypeVar *string) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -191,12 +259,15 @@ func (x *LoadableIconBase) LoadFinish(ResVar AsyncResult, TypeVar *string) (*Inp
 	cret := XGLoadableIconLoadFinish(x.GoPointer(), ResVar.GoPointer(), TypeVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -211,7 +282,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -220,8 +291,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xLoadableIconGLibType, libs, "g_loadable_icon_get_type")
 
-	core.PuregoSafeRegister(&XGLoadableIconLoad, libs, "g_loadable_icon_load")
-	core.PuregoSafeRegister(&XGLoadableIconLoadAsync, libs, "g_loadable_icon_load_async")
-	core.PuregoSafeRegister(&XGLoadableIconLoadFinish, libs, "g_loadable_icon_load_finish")
+	gobject.CheckClassSize("GLoadableIconIface", xLoadableIconGLibType, unsafe.Sizeof(LoadableIconIface{}))
+
+	core.PuregoRegisterSince(&XGLoadableIconLoad, libs, "g_loadable_icon_load", "GIO", "")
+	core.PuregoRegisterSince(&XGLoadableIconLoadAsync, libs, "g_loadable_icon_load_async", "GIO", "")
+	core.PuregoRegisterSince(&XGLoadableIconLoadFinish, libs, "g_loadable_icon_load_finish", "GIO", "")
 
 }
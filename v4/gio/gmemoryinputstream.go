@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -36,7 +36,8 @@ func (x *MemoryInputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -48,7 +49,7 @@ func (x *MemoryInputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -59,7 +60,8 @@ func (x *MemoryInputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -71,7 +73,7 @@ func (x *MemoryInputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -82,7 +84,8 @@ func (x *MemoryInputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -94,7 +97,7 @@ func (x *MemoryInputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -105,7 +108,8 @@ func (x *MemoryInputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -117,7 +121,7 @@ func (x *MemoryInputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -128,7 +132,8 @@ func (x *MemoryInputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -140,7 +145,7 @@ func (x *MemoryInputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -158,7 +163,7 @@ func (x *MemoryInputStreamPrivate) GoPointer() uintptr {
 // memory chunks as input for GIO streaming input operations.
 //
 // As of GLib 2.34, `GMemoryInputStream` implements
-// [iface@Gio.PollableInputStream].
+// [PollableInputStream].
 type MemoryInputStream struct {
 	InputStream
 }
@@ -177,7 +182,7 @@ func MemoryInputStreamNewFromInternalPtr(ptr uintptr) *MemoryInputStream {
 
 var xNewMemoryInputStream func() uintptr
 
-// Creates a new empty #GMemoryInputStream.
+// Creates a new empty [MemoryInputStream].
 func NewMemoryInputStream() *MemoryInputStream {
 	var cls *MemoryInputStream
 
@@ -193,11 +198,14 @@ func NewMemoryInputStream() *MemoryInputStream {
 
 var xNewMemoryInputStreamFromBytes func(*glib.Bytes) uintptr
 
-// Creates a new #GMemoryInputStream with data from the given @bytes.
-func NewMemoryInputStreamFromBytes(BytesVar *glib.Bytes) *MemoryInputStream {
+// Creates a new [MemoryInputStream] with data from the given bytes.
+func NewMemoryInputStreamFromBytes(BytesVar []byte) *MemoryInputStream {
 	var cls *MemoryInputStream
 
-	cret := xNewMemoryInputStreamFromBytes(BytesVar)
+	BytesVarBytes := glib.NewBytesFromSlice(BytesVar)
+	defer BytesVarBytes.Unref()
+
+	cret := xNewMemoryInputStreamFromBytes(BytesVarBytes)
 
 	if cret == 0 {
 		return nil
@@ -209,7 +217,7 @@ func NewMemoryInputStreamFromBytes(BytesVar *glib.Bytes) *MemoryInputStream {
 
 var xNewMemoryInputStreamFromData func([]byte, int, uintptr) uintptr
 
-// Creates a new #GMemoryInputStream with data in memory of a given size.
+// Creates a new [MemoryInputStream] with data in memory of a given size.
 func NewMemoryInputStreamFromData(DataVar []byte, LenVar int, DestroyVar *glib.DestroyNotify) *MemoryInputStream {
 	var cls *MemoryInputStream
 
@@ -220,11 +228,12 @@ func NewMemoryInputStreamFromData(DataVar []byte, LenVar int, DestroyVar *glib.D
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -240,7 +249,7 @@ func NewMemoryInputStreamFromData(DataVar []byte, LenVar int, DestroyVar *glib.D
 
 var xMemoryInputStreamAddBytes func(uintptr, *glib.Bytes)
 
-// Appends @bytes to data that can be read from the input stream.
+// Appends bytes to data that can be read from the input stream.
 func (x *MemoryInputStream) AddBytes(BytesVar *glib.Bytes) {
 
 	xMemoryInputStreamAddBytes(x.GoPointer(), BytesVar)
@@ -249,7 +258,7 @@ func (x *MemoryInputStream) AddBytes(BytesVar *glib.Bytes) {
 
 var xMemoryInputStreamAddData func(uintptr, []byte, int, uintptr)
 
-// Appends @data to data that can be read from the input stream
+// Appends data to data that can be read from the input stream
 func (x *MemoryInputStream) AddData(DataVar []byte, LenVar int, DestroyVar *glib.DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -259,11 +268,12 @@ func (x *MemoryInputStream) AddData(DataVar []byte, LenVar int, DestroyVar *glib
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -282,10 +292,10 @@ func (c *MemoryInputStream) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableInputStream but have only certain instances of that class
-// be pollable. If this method returns %FALSE, then the behavior of
-// other #GPollableInputStream methods is undefined.
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableInputStream] but have only certain instances of that class
+// be pollable. If this method returns false, then the behavior of
+// other [PollableInputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -295,66 +305,66 @@ func (x *MemoryInputStream) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be read, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be read, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_input_stream_is_readable(), it is possible that
+// As with [PollableInputStream.IsReadable], it is possible that
 // the stream may not actually be readable even after the source
-// triggers, so you should use g_pollable_input_stream_read_nonblocking()
-// rather than g_input_stream_read() from the callback.
+// triggers, so you should use [PollableInputStream.ReadNonblocking]
+// rather than [InputStream.Read] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *MemoryInputStream) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableInputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be read.
+// Checks if stream can be read.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_input_stream_read()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [InputStream.Read]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_input_stream_read_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableInputStream.ReadNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *MemoryInputStream) IsReadable() bool {
 
 	cret := XGPollableInputStreamIsReadable(x.GoPointer())
 	return cret
 }
 
-// Attempts to read up to @count bytes from @stream into @buffer, as
-// with g_input_stream_read(). If @stream is not currently readable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_input_stream_create_source() to create a #GSource
-// that will be triggered when @stream is readable.
+// Attempts to read up to count bytes from stream into buffer, as
+// with [InputStream.Read]. If stream is not currently readable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableInputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is readable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *MemoryInputStream) ReadNonblocking(BufferVar *[]byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableInputStreamReadNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *MemoryInputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -362,14 +372,14 @@ func (x *MemoryInputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *MemoryInputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -380,15 +390,15 @@ func (x *MemoryInputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *MemoryInputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -401,13 +411,13 @@ func (x *MemoryInputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *MemoryInputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -415,7 +425,7 @@ func (x *MemoryInputStream) Truncate(OffsetVar int64, CancellableVar *Cancellabl
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -426,7 +436,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -435,11 +445,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xMemoryInputStreamGLibType, libs, "g_memory_input_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewMemoryInputStream, libs, "g_memory_input_stream_new")
-	core.PuregoSafeRegister(&xNewMemoryInputStreamFromBytes, libs, "g_memory_input_stream_new_from_bytes")
-	core.PuregoSafeRegister(&xNewMemoryInputStreamFromData, libs, "g_memory_input_stream_new_from_data")
+	gobject.CheckClassSize("GMemoryInputStreamClass", xMemoryInputStreamGLibType, unsafe.Sizeof(MemoryInputStreamClass{}))
+
+	core.PuregoRegisterSince(&xNewMemoryInputStream, libs, "g_memory_input_stream_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewMemoryInputStreamFromBytes, libs, "g_memory_input_stream_new_from_bytes", "GIO", "2.34")
+	core.PuregoRegisterSince(&xNewMemoryInputStreamFromData, libs, "g_memory_input_stream_new_from_data", "GIO", "")
 
-	core.PuregoSafeRegister(&xMemoryInputStreamAddBytes, libs, "g_memory_input_stream_add_bytes")
-	core.PuregoSafeRegister(&xMemoryInputStreamAddData, libs, "g_memory_input_stream_add_data")
+	core.PuregoRegisterSince(&xMemoryInputStreamAddBytes, libs, "g_memory_input_stream_add_bytes", "GIO", "2.34")
+	core.PuregoRegisterSince(&xMemoryInputStreamAddData, libs, "g_memory_input_stream_add_data", "GIO", "")
 
 }
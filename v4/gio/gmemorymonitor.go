@@ -5,16 +5,16 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GMemoryMonitor.
+// The virtual function table for [MemoryMonitor].
 type MemoryMonitorInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xLowMemoryWarning uintptr
 }
@@ -26,12 +26,13 @@ func (x *MemoryMonitorInterface) GoPointer() uintptr {
 // OverrideLowMemoryWarning sets the "low_memory_warning" callback function.
 // the virtual function pointer for the
 //
-//	#GMemoryMonitor::low-memory-warning signal.
+//	GMemoryMonitor::low-memory-warning signal.
 func (x *MemoryMonitorInterface) OverrideLowMemoryWarning(cb func(MemoryMonitor, MemoryMonitorWarningLevel)) {
 	if cb == nil {
 		x.xLowMemoryWarning = 0
 	} else {
-		x.xLowMemoryWarning = purego.NewCallback(func(MonitorVarp uintptr, LevelVarp MemoryMonitorWarningLevel) {
+		x.xLowMemoryWarning = core.NewCallback(func(MonitorVarp uintptr, LevelVarp MemoryMonitorWarningLevel) {
+			defer core.RecoverPanic()
 			cb(&MemoryMonitorBase{Ptr: MonitorVarp}, LevelVarp)
 		})
 	}
@@ -40,13 +41,13 @@ func (x *MemoryMonitorInterface) OverrideLowMemoryWarning(cb func(MemoryMonitor,
 // GetLowMemoryWarning gets the "low_memory_warning" callback function.
 // the virtual function pointer for the
 //
-//	#GMemoryMonitor::low-memory-warning signal.
+//	GMemoryMonitor::low-memory-warning signal.
 func (x *MemoryMonitorInterface) GetLowMemoryWarning() func(MemoryMonitor, MemoryMonitorWarningLevel) {
 	if x.xLowMemoryWarning == 0 {
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, LevelVarp MemoryMonitorWarningLevel)
-	purego.RegisterFunc(&rawCallback, x.xLowMemoryWarning)
+	core.RegisterFunc(&rawCallback, x.xLowMemoryWarning)
 	return func(MonitorVar MemoryMonitor, LevelVar MemoryMonitorWarningLevel) {
 		rawCallback(MonitorVar.GoPointer(), LevelVar)
 	}
@@ -55,8 +56,8 @@ func (x *MemoryMonitorInterface) GetLowMemoryWarning() func(MemoryMonitor, Memor
 // `GMemoryMonitor` will monitor system memory and suggest to the application
 // when to free memory so as to leave more room for other applications.
 // It is implemented on Linux using the
-// [Low Memory Monitor](https://gitlab.freedesktop.org/hadess/low-memory-monitor/)
-// ([API documentation](https://hadess.pages.freedesktop.org/low-memory-monitor/)).
+// [Low Memory Monitor]
+// ([API documentation]).
 //
 // There is also an implementation for use inside Flatpak sandboxes.
 //
@@ -67,7 +68,7 @@ func (x *MemoryMonitorInterface) GetLowMemoryWarning() func(MemoryMonitor, Memor
 //   - Run a garbage collection cycle
 //   - Try and compress fragmented allocations
 //   - Exit on idle if the process has no reason to stay around
-//   - Call [`malloc_trim(3)`](man:malloc_trim(3)) to return cached heap pages to
+//   - Call malloc_trim(3)) to return cached heap pages to
 //     the kernel (if supported by your libc)
 //
 // Note that some actions may not always improve system performance, and so
@@ -75,22 +76,19 @@ func (x *MemoryMonitorInterface) GetLowMemoryWarning() func(MemoryMonitor, Memor
 // make future heap allocations slower (due to releasing cached heap pages back
 // to the kernel).
 //
-// See [type@Gio.MemoryMonitorWarningLevel] for details on the various warning
+// See [MemoryMonitorWarningLevel] for details on the various warning
 // levels.
 //
-// ```c
-// static void
-// warning_cb (GMemoryMonitor *m, GMemoryMonitorWarningLevel level)
-//
+//	static void
+//	warning_cb (GMemoryMonitor *m, GMemoryMonitorWarningLevel level)
 //	{
 //	  g_debug ("Warning level: %d", level);
-//	  if (warning_level &gt; G_MEMORY_MONITOR_WARNING_LEVEL_LOW)
+//	  if (warning_level > G_MEMORY_MONITOR_WARNING_LEVEL_LOW)
 //	    drop_caches ();
 //	}
 //
-// static GMemoryMonitor *
-// monitor_low_memory (void)
-//
+//	static GMemoryMonitor *
+//	monitor_low_memory (void)
 //	{
 //	  GMemoryMonitor *m;
 //	  m = g_memory_monitor_dup_default ();
@@ -99,10 +97,11 @@ func (x *MemoryMonitorInterface) GetLowMemoryWarning() func(MemoryMonitor, Memor
 //	  return m;
 //	}
 //
-// ```
-//
-// Don’t forget to disconnect the [signal@Gio.MemoryMonitor::low-memory-warning]
+// Don’t forget to disconnect the MemoryMonitor::low-memory-warning
 // signal, and unref the `GMemoryMonitor` itself when exiting.
+//
+// [Low Memory Monitor]: https://gitlab.freedesktop.org/hadess/low-memory-monitor/
+// [API documentation]: https://hadess.pages.freedesktop.org/low-memory-monitor/
 type MemoryMonitor interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -129,15 +128,39 @@ func (x *MemoryMonitorBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementMemoryMonitor adds the MemoryMonitor interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - LowMemoryWarning(MemoryMonitorWarningLevel)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see MemoryMonitorInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementMemoryMonitor[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, MemoryMonitorGLibType(), func(iface *MemoryMonitorInterface) {
+		if _, ok := any(zero).(interface {
+			LowMemoryWarning(MemoryMonitorWarningLevel)
+		}); ok {
+			iface.OverrideLowMemoryWarning(func(self MemoryMonitor, arg0 MemoryMonitorWarningLevel) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LowMemoryWarning(MemoryMonitorWarningLevel)
+				})
+				impl.LowMemoryWarning(arg0)
+			})
+		}
+	})
+}
+
 const (
 	// Extension point for memory usage monitoring functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	MEMORY_MONITOR_EXTENSION_POINT_NAME string = "gio-memory-monitor"
 )
 
 var xMemoryMonitorDupDefault func() uintptr
 
-// Gets a reference to the default #GMemoryMonitor for the system.
+// Gets a reference to the default [MemoryMonitor] for the system.
 func MemoryMonitorDupDefault() *MemoryMonitorBase {
 	var cls *MemoryMonitorBase
 
@@ -156,15 +179,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xMemoryMonitorDupDefault, libs, "g_memory_monitor_dup_default")
+	core.PuregoRegisterSince(&xMemoryMonitorDupDefault, libs, "g_memory_monitor_dup_default", "GIO", "2.64")
 
 	core.PuregoSafeRegister(&xMemoryMonitorGLibType, libs, "g_memory_monitor_get_type")
 
+	gobject.CheckClassSize("GMemoryMonitorInterface", xMemoryMonitorGLibType, unsafe.Sizeof(MemoryMonitorInterface{}))
+
 }
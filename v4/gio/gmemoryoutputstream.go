@@ -5,15 +5,14 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Changes the size of the memory block pointed to by @data to
-// @size bytes.
+// Changes the size of the memory block pointed to by data to
+// size bytes.
 //
 // The function should have the same semantics as realloc().
 type ReallocFunc func(uintptr, uint) uintptr
@@ -43,7 +42,8 @@ func (x *MemoryOutputStreamClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -55,7 +55,7 @@ func (x *MemoryOutputStreamClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -66,7 +66,8 @@ func (x *MemoryOutputStreamClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -78,7 +79,7 @@ func (x *MemoryOutputStreamClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -89,7 +90,8 @@ func (x *MemoryOutputStreamClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -101,7 +103,7 @@ func (x *MemoryOutputStreamClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -112,7 +114,8 @@ func (x *MemoryOutputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -124,7 +127,7 @@ func (x *MemoryOutputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -135,7 +138,8 @@ func (x *MemoryOutputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -147,7 +151,7 @@ func (x *MemoryOutputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -165,7 +169,7 @@ func (x *MemoryOutputStreamPrivate) GoPointer() uintptr {
 // memory chunks as output for GIO streaming output operations.
 //
 // As of GLib 2.34, `GMemoryOutputStream` trivially implements
-// [iface@Gio.PollableOutputStream]: it always polls as ready.
+// [PollableOutputStream]: it always polls as ready.
 type MemoryOutputStream struct {
 	OutputStream
 }
@@ -184,47 +188,45 @@ func MemoryOutputStreamNewFromInternalPtr(ptr uintptr) *MemoryOutputStream {
 
 var xNewMemoryOutputStream func(uintptr, uint, uintptr, uintptr) uintptr
 
-// Creates a new #GMemoryOutputStream.
+// Creates a new [MemoryOutputStream].
 //
 // In most cases this is not the function you want.  See
-// g_memory_output_stream_new_resizable() instead.
+// [NewMemoryOutputStreamResizable] instead.
 //
-// If @data is non-%NULL, the stream will use that for its internal storage.
+// If data is non-nil, the stream will use that for its internal storage.
 //
-// If @realloc_fn is non-%NULL, it will be used for resizing the internal
+// If realloc_fn is non-nil, it will be used for resizing the internal
 // storage when necessary and the stream will be considered resizable.
 // In that case, the stream will start out being (conceptually) empty.
-// @size is used only as a hint for how big @data is.  Specifically,
+// size is used only as a hint for how big data is.  Specifically,
 // seeking to the end of a newly-created stream will seek to zero, not
-// @size.  Seeking past the end of the stream and then writing will
+// size.  Seeking past the end of the stream and then writing will
 // introduce a zero-filled gap.
 //
-// If @realloc_fn is %NULL then the stream is fixed-sized.  Seeking to
-// the end will seek to @size exactly.  Writing past the end will give
+// If realloc_fn is nil then the stream is fixed-sized.  Seeking to
+// the end will seek to size exactly.  Writing past the end will give
 // an 'out of space' error.  Attempting to seek past the end will fail.
 // Unlike the resizable case, seeking to an offset within the stream and
-// writing will preserve the bytes passed in as @data before that point
-// and will return them as part of g_memory_output_stream_steal_data().
-// If you intend to seek you should probably therefore ensure that @data
+// writing will preserve the bytes passed in as data before that point
+// and will return them as part of [MemoryOutputStream.StealData].
+// If you intend to seek you should probably therefore ensure that data
 // is properly initialised.
 //
-// It is probably only meaningful to provide @data and @size in the case
-// that you want a fixed-sized stream.  Put another way: if @realloc_fn
-// is non-%NULL then it makes most sense to give @data as %NULL and
-// @size as 0 (allowing #GMemoryOutputStream to do the initial
+// It is probably only meaningful to provide data and size in the case
+// that you want a fixed-sized stream.  Put another way: if realloc_fn
+// is non-nil then it makes most sense to give data as nil and
+// size as 0 (allowing [MemoryOutputStream] to do the initial
 // allocation for itself).
 //
-// |[&lt;!-- language="C" --&gt;
-// // a stream that can grow
-// stream = g_memory_output_stream_new (NULL, 0, realloc, free);
+//	// a stream that can grow
+//	stream = g_memory_output_stream_new (NULL, 0, realloc, free);
 //
-// // another stream that can grow
-// stream2 = g_memory_output_stream_new (NULL, 0, g_realloc, g_free);
+//	// another stream that can grow
+//	stream2 = g_memory_output_stream_new (NULL, 0, g_realloc, g_free);
 //
-// // a fixed-size stream
-// data = malloc (200);
-// stream3 = g_memory_output_stream_new (data, 200, NULL, free);
-// ]|
+//	// a fixed-size stream
+//	data = malloc (200);
+//	stream3 = g_memory_output_stream_new (data, 200, NULL, free);
 func NewMemoryOutputStream(DataVar uintptr, SizeVar uint, ReallocFunctionVar *ReallocFunc, DestroyFunctionVar *glib.DestroyNotify) *MemoryOutputStream {
 	var cls *MemoryOutputStream
 
@@ -235,11 +237,12 @@ func NewMemoryOutputStream(DataVar uintptr, SizeVar uint, ReallocFunctionVar *Re
 			ReallocFunctionVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uint) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *ReallocFunctionVar
 				return cbFn(arg0, arg1)
 			}
-			ReallocFunctionVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ReallocFunctionVarPtr, ReallocFunctionVarRef, ReallocFunctionVar)
+			ReallocFunctionVarRef = core.NewCallback(fcb)
+			ReallocFunctionVarRef = glib.SaveCallbackWithClosure(ReallocFunctionVarPtr, ReallocFunctionVarRef, ReallocFunctionVar)
 		}
 	}
 
@@ -250,11 +253,12 @@ func NewMemoryOutputStream(DataVar uintptr, SizeVar uint, ReallocFunctionVar *Re
 			DestroyFunctionVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFunctionVar
 				cbFn(arg0)
 			}
-			DestroyFunctionVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyFunctionVarPtr, DestroyFunctionVarRef, DestroyFunctionVar)
+			DestroyFunctionVarRef = core.NewCallback(fcb)
+			DestroyFunctionVarRef = glib.SaveCallbackWithClosure(DestroyFunctionVarPtr, DestroyFunctionVarRef, DestroyFunctionVar)
 		}
 	}
 
@@ -270,7 +274,7 @@ func NewMemoryOutputStream(DataVar uintptr, SizeVar uint, ReallocFunctionVar *Re
 
 var xNewMemoryOutputStreamResizable func() uintptr
 
-// Creates a new #GMemoryOutputStream, using g_realloc() and g_free()
+// Creates a new [MemoryOutputStream], using [glib.Realloc] and [glib.Free]
 // for memory allocation.
 func NewMemoryOutputStreamResizable() *MemoryOutputStream {
 	var cls *MemoryOutputStream
@@ -287,7 +291,7 @@ func NewMemoryOutputStreamResizable() *MemoryOutputStream {
 
 var xMemoryOutputStreamGetData func(uintptr) uintptr
 
-// Gets any loaded data from the @ostream.
+// Gets any loaded data from the ostream.
 //
 // Note that the returned pointer may become invalid on the next
 // write or truncate operation on the stream.
@@ -310,20 +314,20 @@ func (x *MemoryOutputStream) GetDataSize() uint {
 var xMemoryOutputStreamGetSize func(uintptr) uint
 
 // Gets the size of the currently allocated data area (available from
-// g_memory_output_stream_get_data()).
+// [MemoryOutputStream.GetData]).
 //
 // You probably don't want to use this function on resizable streams.
-// See g_memory_output_stream_get_data_size() instead.  For resizable
+// See [MemoryOutputStream.GetDataSize] instead.  For resizable
 // streams the size returned by this function is an implementation
 // detail and may be change at any time in response to operations on the
 // stream.
 //
 // If the stream is fixed-sized (ie: no realloc was passed to
-// g_memory_output_stream_new()) then this is the maximum size of the
-// stream and further writes will return %G_IO_ERROR_NO_SPACE.
+// [NewMemoryOutputStream]) then this is the maximum size of the
+// stream and further writes will return [GIoErrorNoSpaceValue].
 //
 // In any case, if you want the number of bytes currently written to the
-// stream, use g_memory_output_stream_get_data_size().
+// stream, use [MemoryOutputStream.GetDataSize].
 func (x *MemoryOutputStream) GetSize() uint {
 
 	cret := xMemoryOutputStreamGetSize(x.GoPointer())
@@ -332,22 +336,23 @@ func (x *MemoryOutputStream) GetSize() uint {
 
 var xMemoryOutputStreamStealAsBytes func(uintptr) *glib.Bytes
 
-// Returns data from the @ostream as a #GBytes. @ostream must be
+// Returns data from the ostream as a [glib.Bytes]. ostream must be
 // closed before calling this function.
-func (x *MemoryOutputStream) StealAsBytes() *glib.Bytes {
+func (x *MemoryOutputStream) StealAsBytes() []byte {
 
 	cret := xMemoryOutputStreamStealAsBytes(x.GoPointer())
-	return cret
+	defer cret.Unref()
+	return cret.Data()
 }
 
 var xMemoryOutputStreamStealData func(uintptr) uintptr
 
-// Gets any loaded data from the @ostream. Ownership of the data
+// Gets any loaded data from the ostream. Ownership of the data
 // is transferred to the caller; when no longer needed it must be
-// freed using the free function set in @ostream's
-// #GMemoryOutputStream:destroy-function property.
+// freed using the free function set in ostream's
+// [MemoryOutputStream.GetPropertyDestroyFunction] property.
 //
-// @ostream must be closed before calling this function.
+// ostream must be closed before calling this function.
 func (x *MemoryOutputStream) StealData() uintptr {
 
 	cret := xMemoryOutputStreamStealData(x.GoPointer())
@@ -384,10 +389,10 @@ func (x *MemoryOutputStream) GetPropertyData() uintptr {
 
 // GetPropertyDataSize gets the "data-size" property.
 // Size of data written to the buffer.
-func (x *MemoryOutputStream) GetPropertyDataSize() uint {
+func (x *MemoryOutputStream) GetPropertyDataSize() types.ULong {
 	var v gobject.Value
 	x.GetProperty("data-size", &v)
-	return v.GetUint()
+	return v.GetUlong()
 }
 
 // SetPropertyDestroyFunction sets the "destroy-function" property.
@@ -426,25 +431,25 @@ func (x *MemoryOutputStream) GetPropertyReallocFunction() uintptr {
 
 // SetPropertySize sets the "size" property.
 // Current size of the data buffer.
-func (x *MemoryOutputStream) SetPropertySize(value uint) {
+func (x *MemoryOutputStream) SetPropertySize(value types.ULong) {
 	var v gobject.Value
-	v.Init(gobject.TypeUintVal)
-	v.SetUint(value)
+	v.Init(gobject.TypeUlongVal)
+	v.SetUlong(value)
 	x.SetProperty("size", &v)
 }
 
 // GetPropertySize gets the "size" property.
 // Current size of the data buffer.
-func (x *MemoryOutputStream) GetPropertySize() uint {
+func (x *MemoryOutputStream) GetPropertySize() types.ULong {
 	var v gobject.Value
 	x.GetProperty("size", &v)
-	return v.GetUint()
+	return v.GetUlong()
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableOutputStream but have only certain instances of that
-// class be pollable. If this method returns %FALSE, then the behavior
-// of other #GPollableOutputStream methods is undefined.
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableOutputStream] but have only certain instances of that
+// class be pollable. If this method returns false, then the behavior
+// of other [PollableOutputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -454,100 +459,100 @@ func (x *MemoryOutputStream) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be written, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be written, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_output_stream_is_writable(), it is possible that
+// As with [PollableOutputStream.IsWritable], it is possible that
 // the stream may not actually be writable even after the source
-// triggers, so you should use g_pollable_output_stream_write_nonblocking()
-// rather than g_output_stream_write() from the callback.
+// triggers, so you should use [PollableOutputStream.WriteNonblocking]
+// rather than [OutputStream.Write] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *MemoryOutputStream) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableOutputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be written.
+// Checks if stream can be written.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_output_stream_write()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [OutputStream.Write]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_output_stream_write_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableOutputStream.WriteNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *MemoryOutputStream) IsWritable() bool {
 
 	cret := XGPollableOutputStreamIsWritable(x.GoPointer())
 	return cret
 }
 
-// Attempts to write up to @count bytes from @buffer to @stream, as
-// with g_output_stream_write(). If @stream is not currently writable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable.
+// Attempts to write up to count bytes from buffer to stream, as
+// with [OutputStream.Write]. If stream is not currently writable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_IO_ERROR_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @buffer and
-// @count in the next write call.
+// Also note that if [GIoErrorWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same buffer and
+// count in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *MemoryOutputStream) WriteNonblocking(BufferVar []byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWriteNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Attempts to write the bytes contained in the @n_vectors @vectors to @stream,
-// as with g_output_stream_writev(). If @stream is not currently writable,
+// Attempts to write the bytes contained in the n_vectors vectors to stream,
+// as with [OutputStream.Writev]. If stream is not currently writable,
 // this will immediately return %@G_POLLABLE_RETURN_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable. @error will *not* be
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable. error will *not* be
 // set in that case.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_POLLABLE_RETURN_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @vectors and
-// @n_vectors in the next write call.
+// Also note that if [GPollableReturnWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same vectors and
+// n_vectors in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *MemoryOutputStream) WritevNonblocking(VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (PollableReturn, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWritevNonblocking(x.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// Tests if the stream supports the [SeekableIface].
 func (x *MemoryOutputStream) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -555,14 +560,14 @@ func (x *MemoryOutputStream) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *MemoryOutputStream) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -573,15 +578,15 @@ func (x *MemoryOutputStream) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *MemoryOutputStream) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -594,13 +599,13 @@ func (x *MemoryOutputStream) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *MemoryOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -608,7 +613,7 @@ func (x *MemoryOutputStream) Truncate(OffsetVar int64, CancellableVar *Cancellab
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -619,7 +624,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -628,13 +633,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xMemoryOutputStreamGLibType, libs, "g_memory_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewMemoryOutputStream, libs, "g_memory_output_stream_new")
-	core.PuregoSafeRegister(&xNewMemoryOutputStreamResizable, libs, "g_memory_output_stream_new_resizable")
+	gobject.CheckClassSize("GMemoryOutputStreamClass", xMemoryOutputStreamGLibType, unsafe.Sizeof(MemoryOutputStreamClass{}))
+
+	core.PuregoRegisterSince(&xNewMemoryOutputStream, libs, "g_memory_output_stream_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewMemoryOutputStreamResizable, libs, "g_memory_output_stream_new_resizable", "GIO", "2.36")
 
-	core.PuregoSafeRegister(&xMemoryOutputStreamGetData, libs, "g_memory_output_stream_get_data")
-	core.PuregoSafeRegister(&xMemoryOutputStreamGetDataSize, libs, "g_memory_output_stream_get_data_size")
-	core.PuregoSafeRegister(&xMemoryOutputStreamGetSize, libs, "g_memory_output_stream_get_size")
-	core.PuregoSafeRegister(&xMemoryOutputStreamStealAsBytes, libs, "g_memory_output_stream_steal_as_bytes")
-	core.PuregoSafeRegister(&xMemoryOutputStreamStealData, libs, "g_memory_output_stream_steal_data")
+	core.PuregoRegisterSince(&xMemoryOutputStreamGetData, libs, "g_memory_output_stream_get_data", "GIO", "")
+	core.PuregoRegisterSince(&xMemoryOutputStreamGetDataSize, libs, "g_memory_output_stream_get_data_size", "GIO", "2.18")
+	core.PuregoRegisterSince(&xMemoryOutputStreamGetSize, libs, "g_memory_output_stream_get_size", "GIO", "")
+	core.PuregoRegisterSince(&xMemoryOutputStreamStealAsBytes, libs, "g_memory_output_stream_steal_as_bytes", "GIO", "2.34")
+	core.PuregoRegisterSince(&xMemoryOutputStreamStealData, libs, "g_memory_output_stream_steal_data", "GIO", "2.26")
 
 }
@@ -2,21 +2,20 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GMenu` is a simple implementation of [class@Gio.MenuModel].
-// You populate a `GMenu` by adding [class@Gio.MenuItem] instances to it.
+// `GMenu` is a simple implementation of [MenuModel].
+// You populate a `GMenu` by adding [MenuItem] instances to it.
 //
 // There are some convenience functions to allow you to directly
-// add items (avoiding [class@Gio.MenuItem]) for the common cases. To add
-// a regular item, use [method@Gio.Menu.insert]. To add a section, use
-// [method@Gio.Menu.insert_section]. To add a submenu, use
-// [method@Gio.Menu.insert_submenu].
+// add items (avoiding [MenuItem]) for the common cases. To add
+// a regular item, use [Menu.Insert]. To add a section, use
+// [Menu.InsertSection]. To add a submenu, use
+// [Menu.InsertSubmenu].
 type Menu struct {
 	MenuModel
 }
@@ -35,7 +34,7 @@ func MenuNewFromInternalPtr(ptr uintptr) *Menu {
 
 var xNewMenu func() uintptr
 
-// Creates a new #GMenu.
+// Creates a new [Menu].
 //
 // The new menu has no items.
 func NewMenu() *Menu {
@@ -54,7 +53,7 @@ func NewMenu() *Menu {
 var xMenuAppend func(uintptr, uintptr, uintptr)
 
 // Convenience function for appending a normal menu item to the end of
-// @menu.  Combine g_menu_item_new() and g_menu_insert_item() for a more
+// menu.  Combine [NewMenuItem] and [Menu.InsertItem] for a more
 // flexible alternative.
 func (x *Menu) Append(LabelVar *string, DetailedActionVar *string) {
 
@@ -70,9 +69,9 @@ func (x *Menu) Append(LabelVar *string, DetailedActionVar *string) {
 
 var xMenuAppendItem func(uintptr, uintptr)
 
-// Appends @item to the end of @menu.
+// Appends item to the end of menu.
 //
-// See g_menu_insert_item() for more information.
+// See [Menu.InsertItem] for more information.
 func (x *Menu) AppendItem(ItemVar *MenuItem) {
 
 	xMenuAppendItem(x.GoPointer(), ItemVar.GoPointer())
@@ -82,7 +81,7 @@ func (x *Menu) AppendItem(ItemVar *MenuItem) {
 var xMenuAppendSection func(uintptr, uintptr, uintptr)
 
 // Convenience function for appending a section menu item to the end of
-// @menu.  Combine g_menu_item_new_section() and g_menu_insert_item() for a
+// menu.  Combine [NewMenuItemSection] and [Menu.InsertItem] for a
 // more flexible alternative.
 func (x *Menu) AppendSection(LabelVar *string, SectionVar *MenuModel) {
 
@@ -96,7 +95,7 @@ func (x *Menu) AppendSection(LabelVar *string, SectionVar *MenuModel) {
 var xMenuAppendSubmenu func(uintptr, uintptr, uintptr)
 
 // Convenience function for appending a submenu menu item to the end of
-// @menu.  Combine g_menu_item_new_submenu() and g_menu_insert_item() for a
+// menu.  Combine [NewMenuItemSubmenu] and [Menu.InsertItem] for a
 // more flexible alternative.
 func (x *Menu) AppendSubmenu(LabelVar *string, SubmenuVar *MenuModel) {
 
@@ -109,14 +108,14 @@ func (x *Menu) AppendSubmenu(LabelVar *string, SubmenuVar *MenuModel) {
 
 var xMenuFreeze func(uintptr)
 
-// Marks @menu as frozen.
+// Marks menu as frozen.
 //
 // After the menu is frozen, it is an error to attempt to make any
-// changes to it.  In effect this means that the #GMenu API must no
+// changes to it.  In effect this means that the [Menu] API must no
 // longer be used.
 //
-// This function causes g_menu_model_is_mutable() to begin returning
-// %FALSE, which has some positive performance implications.
+// This function causes [MenuModel.IsMutable] to begin returning
+// false, which has some positive performance implications.
 func (x *Menu) Freeze() {
 
 	xMenuFreeze(x.GoPointer())
@@ -125,8 +124,8 @@ func (x *Menu) Freeze() {
 
 var xMenuInsert func(uintptr, int, uintptr, uintptr)
 
-// Convenience function for inserting a normal menu item into @menu.
-// Combine g_menu_item_new() and g_menu_insert_item() for a more flexible
+// Convenience function for inserting a normal menu item into menu.
+// Combine [NewMenuItem] and [Menu.InsertItem] for a more flexible
 // alternative.
 func (x *Menu) Insert(PositionVar int, LabelVar *string, DetailedActionVar *string) {
 
@@ -142,22 +141,22 @@ func (x *Menu) Insert(PositionVar int, LabelVar *string, DetailedActionVar *stri
 
 var xMenuInsertItem func(uintptr, int, uintptr)
 
-// Inserts @item into @menu.
+// Inserts item into menu.
 //
 // The "insertion" is actually done by copying all of the attribute and
-// link values of @item and using them to form a new item within @menu.
-// As such, @item itself is not really inserted, but rather, a menu item
-// that is exactly the same as the one presently described by @item.
+// link values of item and using them to form a new item within menu.
+// As such, item itself is not really inserted, but rather, a menu item
+// that is exactly the same as the one presently described by item.
 //
-// This means that @item is essentially useless after the insertion
+// This means that item is essentially useless after the insertion
 // occurs.  Any changes you make to it are ignored unless it is inserted
 // again (at which point its updated values will be copied).
 //
-// You should probably just free @item once you're done.
+// You should probably just free item once you're done.
 //
 // There are many convenience functions to take care of common cases.
-// See g_menu_insert(), g_menu_insert_section() and
-// g_menu_insert_submenu() as well as "prepend" and "append" variants of
+// See [Menu.Insert], [Menu.InsertSection] and
+// [Menu.InsertSubmenu] as well as "prepend" and "append" variants of
 // each of these functions.
 func (x *Menu) InsertItem(PositionVar int, ItemVar *MenuItem) {
 
@@ -167,8 +166,8 @@ func (x *Menu) InsertItem(PositionVar int, ItemVar *MenuItem) {
 
 var xMenuInsertSection func(uintptr, int, uintptr, uintptr)
 
-// Convenience function for inserting a section menu item into @menu.
-// Combine g_menu_item_new_section() and g_menu_insert_item() for a more
+// Convenience function for inserting a section menu item into menu.
+// Combine [NewMenuItemSection] and [Menu.InsertItem] for a more
 // flexible alternative.
 func (x *Menu) InsertSection(PositionVar int, LabelVar *string, SectionVar *MenuModel) {
 
@@ -181,8 +180,8 @@ func (x *Menu) InsertSection(PositionVar int, LabelVar *string, SectionVar *Menu
 
 var xMenuInsertSubmenu func(uintptr, int, uintptr, uintptr)
 
-// Convenience function for inserting a submenu menu item into @menu.
-// Combine g_menu_item_new_submenu() and g_menu_insert_item() for a more
+// Convenience function for inserting a submenu menu item into menu.
+// Combine [NewMenuItemSubmenu] and [Menu.InsertItem] for a more
 // flexible alternative.
 func (x *Menu) InsertSubmenu(PositionVar int, LabelVar *string, SubmenuVar *MenuModel) {
 
@@ -196,7 +195,7 @@ func (x *Menu) InsertSubmenu(PositionVar int, LabelVar *string, SubmenuVar *Menu
 var xMenuPrepend func(uintptr, uintptr, uintptr)
 
 // Convenience function for prepending a normal menu item to the start
-// of @menu.  Combine g_menu_item_new() and g_menu_insert_item() for a more
+// of menu.  Combine [NewMenuItem] and [Menu.InsertItem] for a more
 // flexible alternative.
 func (x *Menu) Prepend(LabelVar *string, DetailedActionVar *string) {
 
@@ -212,9 +211,9 @@ func (x *Menu) Prepend(LabelVar *string, DetailedActionVar *string) {
 
 var xMenuPrependItem func(uintptr, uintptr)
 
-// Prepends @item to the start of @menu.
+// Prepends item to the start of menu.
 //
-// See g_menu_insert_item() for more information.
+// See [Menu.InsertItem] for more information.
 func (x *Menu) PrependItem(ItemVar *MenuItem) {
 
 	xMenuPrependItem(x.GoPointer(), ItemVar.GoPointer())
@@ -224,7 +223,7 @@ func (x *Menu) PrependItem(ItemVar *MenuItem) {
 var xMenuPrependSection func(uintptr, uintptr, uintptr)
 
 // Convenience function for prepending a section menu item to the start
-// of @menu.  Combine g_menu_item_new_section() and g_menu_insert_item() for
+// of menu.  Combine [NewMenuItemSection] and [Menu.InsertItem] for
 // a more flexible alternative.
 func (x *Menu) PrependSection(LabelVar *string, SectionVar *MenuModel) {
 
@@ -238,7 +237,7 @@ func (x *Menu) PrependSection(LabelVar *string, SectionVar *MenuModel) {
 var xMenuPrependSubmenu func(uintptr, uintptr, uintptr)
 
 // Convenience function for prepending a submenu menu item to the start
-// of @menu.  Combine g_menu_item_new_submenu() and g_menu_insert_item() for
+// of menu.  Combine [NewMenuItemSubmenu] and [Menu.InsertItem] for
 // a more flexible alternative.
 func (x *Menu) PrependSubmenu(LabelVar *string, SubmenuVar *MenuModel) {
 
@@ -253,7 +252,7 @@ var xMenuRemove func(uintptr, int)
 
 // Removes an item from the menu.
 //
-// @position gives the index of the item to remove.
+// position gives the index of the item to remove.
 //
 // It is an error if position is not in range the range from 0 to one
 // less than the number of items in the menu.
@@ -287,7 +286,7 @@ func (c *Menu) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// #GMenuItem is an opaque structure type.  You must access it using the
+// [MenuItem] is an opaque structure type.  You must access it using the
 // functions below.
 type MenuItem struct {
 	gobject.Object
@@ -307,14 +306,14 @@ func MenuItemNewFromInternalPtr(ptr uintptr) *MenuItem {
 
 var xNewMenuItem func(uintptr, uintptr) uintptr
 
-// Creates a new #GMenuItem.
+// Creates a new [MenuItem].
 //
-// If @label is non-%NULL it is used to set the "label" attribute of the
+// If label is non-nil it is used to set the "label" attribute of the
 // new item.
 //
-// If @detailed_action is non-%NULL it is used to set the "action" and
+// If detailed_action is non-nil it is used to set the "action" and
 // possibly the "target" attribute of the new item.  See
-// g_menu_item_set_detailed_action() for more information.
+// [MenuItem.SetDetailedAction] for more information.
 func NewMenuItem(LabelVar *string, DetailedActionVar *string) *MenuItem {
 	var cls *MenuItem
 
@@ -336,11 +335,11 @@ func NewMenuItem(LabelVar *string, DetailedActionVar *string) *MenuItem {
 
 var xNewMenuItemFromModel func(uintptr, int) uintptr
 
-// Creates a #GMenuItem as an exact copy of an existing menu item in a
-// #GMenuModel.
+// Creates a [MenuItem] as an exact copy of an existing menu item in a
+// [MenuModel].
 //
-// @item_index must be valid (ie: be sure to call
-// g_menu_model_get_n_items() first).
+// item_index must be valid (ie: be sure to call
+// [MenuModel.GetNItems] first).
 func NewMenuItemFromModel(ModelVar *MenuModel, ItemIndexVar int) *MenuItem {
 	var cls *MenuItem
 
@@ -356,17 +355,17 @@ func NewMenuItemFromModel(ModelVar *MenuModel, ItemIndexVar int) *MenuItem {
 
 var xNewMenuItemSection func(uintptr, uintptr) uintptr
 
-// Creates a new #GMenuItem representing a section.
+// Creates a new [MenuItem] representing a section.
 //
-// This is a convenience API around g_menu_item_new() and
-// g_menu_item_set_section().
+// This is a convenience API around [NewMenuItem] and
+// [MenuItem.SetSection].
 //
 // The effect of having one menu appear as a section of another is
-// exactly as it sounds: the items from @section become a direct part of
-// the menu that @menu_item is added to.
+// exactly as it sounds: the items from section become a direct part of
+// the menu that menu_item is added to.
 //
 // Visual separation is typically displayed between two non-empty
-// sections.  If @label is non-%NULL then it will be incorporated into
+// sections.  If label is non-nil then it will be incorporated into
 // this visual indication.  This allows for labeled subsections of a
 // menu.
 //
@@ -374,26 +373,23 @@ var xNewMenuItemSection func(uintptr, uintptr) uintptr
 // program.  It probably contains an "Undo" and "Redo" item, followed by
 // a separator, followed by "Cut", "Copy" and "Paste".
 //
-// This would be accomplished by creating three #GMenu instances.  The
+// This would be accomplished by creating three [Menu] instances.  The
 // first would be populated with the "Undo" and "Redo" items, and the
 // second with the "Cut", "Copy" and "Paste" items.  The first and
 // second menus would then be added as submenus of the third.  In XML
 // format, this would look something like the following:
-// |[
-// &lt;menu id='edit-menu'&gt;
-//
-//	&lt;section&gt;
-//	  &lt;item label='Undo'/&gt;
-//	  &lt;item label='Redo'/&gt;
-//	&lt;/section&gt;
-//	&lt;section&gt;
-//	  &lt;item label='Cut'/&gt;
-//	  &lt;item label='Copy'/&gt;
-//	  &lt;item label='Paste'/&gt;
-//	&lt;/section&gt;
-//
-// &lt;/menu&gt;
-// ]|
+//
+//	<menu id='edit-menu'>
+//	  <section>
+//	    <item label='Undo'/>
+//	    <item label='Redo'/>
+//	  </section>
+//	  <section>
+//	    <item label='Cut'/>
+//	    <item label='Copy'/>
+//	    <item label='Paste'/>
+//	  </section>
+//	</menu>
 //
 // The following example is exactly equivalent.  It is more illustrative
 // of the exact relationship between the menus and items (keeping in
@@ -401,25 +397,22 @@ var xNewMenuItemSection func(uintptr, uintptr) uintptr
 // containing one).  The style of the second example is more verbose and
 // difficult to read (and therefore not recommended except for the
 // purpose of understanding what is really going on).
-// |[
-// &lt;menu id='edit-menu'&gt;
-//
-//	&lt;item&gt;
-//	  &lt;link name='section'&gt;
-//	    &lt;item label='Undo'/&gt;
-//	    &lt;item label='Redo'/&gt;
-//	  &lt;/link&gt;
-//	&lt;/item&gt;
-//	&lt;item&gt;
-//	  &lt;link name='section'&gt;
-//	    &lt;item label='Cut'/&gt;
-//	    &lt;item label='Copy'/&gt;
-//	    &lt;item label='Paste'/&gt;
-//	  &lt;/link&gt;
-//	&lt;/item&gt;
-//
-// &lt;/menu&gt;
-// ]|
+//
+//	<menu id='edit-menu'>
+//	  <item>
+//	    <link name='section'>
+//	      <item label='Undo'/>
+//	      <item label='Redo'/>
+//	    </link>
+//	  </item>
+//	  <item>
+//	    <link name='section'>
+//	      <item label='Cut'/>
+//	      <item label='Copy'/>
+//	      <item label='Paste'/>
+//	    </link>
+//	  </item>
+//	</menu>
 func NewMenuItemSection(LabelVar *string, SectionVar *MenuModel) *MenuItem {
 	var cls *MenuItem
 
@@ -438,10 +431,10 @@ func NewMenuItemSection(LabelVar *string, SectionVar *MenuModel) *MenuItem {
 
 var xNewMenuItemSubmenu func(uintptr, uintptr) uintptr
 
-// Creates a new #GMenuItem representing a submenu.
+// Creates a new [MenuItem] representing a submenu.
 //
-// This is a convenience API around g_menu_item_new() and
-// g_menu_item_set_submenu().
+// This is a convenience API around [NewMenuItem] and
+// [MenuItem.SetSubmenu].
 func NewMenuItemSubmenu(LabelVar *string, SubmenuVar *MenuModel) *MenuItem {
 	var cls *MenuItem
 
@@ -460,14 +453,14 @@ func NewMenuItemSubmenu(LabelVar *string, SubmenuVar *MenuModel) *MenuItem {
 
 var xMenuItemGetAttribute func(uintptr, string, string, ...interface{}) bool
 
-// Queries the named @attribute on @menu_item.
+// Queries the named attribute on menu_item.
 //
-// If the attribute exists and matches the #GVariantType corresponding
-// to @format_string then @format_string is used to deconstruct the
-// value into the positional parameters and %TRUE is returned.
+// If the attribute exists and matches the [glib.VariantType] corresponding
+// to format_string then format_string is used to deconstruct the
+// value into the positional parameters and true is returned.
 //
 // If the attribute does not exist, or it does exist but has the wrong
-// type, then the positional parameters are ignored and %FALSE is
+// type, then the positional parameters are ignored and false is
 // returned.
 func (x *MenuItem) GetAttribute(AttributeVar string, FormatStringVar string, varArgs ...interface{}) bool {
 
@@ -477,10 +470,10 @@ func (x *MenuItem) GetAttribute(AttributeVar string, FormatStringVar string, var
 
 var xMenuItemGetAttributeValue func(uintptr, string, *glib.VariantType) *glib.Variant
 
-// Queries the named @attribute on @menu_item.
+// Queries the named attribute on menu_item.
 //
-// If @expected_type is specified and the attribute does not have this
-// type, %NULL is returned.  %NULL is also returned if the attribute
+// If expected_type is specified and the attribute does not have this
+// type, nil is returned.  nil is also returned if the attribute
 // simply does not exist.
 func (x *MenuItem) GetAttributeValue(AttributeVar string, ExpectedTypeVar *glib.VariantType) *glib.Variant {
 
@@ -490,7 +483,7 @@ func (x *MenuItem) GetAttributeValue(AttributeVar string, ExpectedTypeVar *glib.
 
 var xMenuItemGetLink func(uintptr, string) uintptr
 
-// Queries the named @link on @menu_item.
+// Queries the named link on menu_item.
 func (x *MenuItem) GetLink(LinkVar string) *MenuModel {
 	var cls *MenuModel
 
@@ -506,24 +499,24 @@ func (x *MenuItem) GetLink(LinkVar string) *MenuModel {
 
 var xMenuItemSetActionAndTarget func(uintptr, uintptr, uintptr, ...interface{})
 
-// Sets or unsets the "action" and "target" attributes of @menu_item.
+// Sets or unsets the "action" and "target" attributes of menu_item.
 //
-// If @action is %NULL then both the "action" and "target" attributes
-// are unset (and @format_string is ignored along with the positional
+// If action is nil then both the "action" and "target" attributes
+// are unset (and format_string is ignored along with the positional
 // parameters).
 //
-// If @action is non-%NULL then the "action" attribute is set.
-// @format_string is then inspected.  If it is non-%NULL then the proper
-// position parameters are collected to create a #GVariant instance to
-// use as the target value.  If it is %NULL then the positional
+// If action is non-nil then the "action" attribute is set.
+// format_string is then inspected.  If it is non-nil then the proper
+// position parameters are collected to create a [glib.Variant] instance to
+// use as the target value.  If it is nil then the positional
 // parameters are ignored and the "target" attribute is unset.
 //
-// See also g_menu_item_set_action_and_target_value() for an equivalent
-// call that directly accepts a #GVariant.  See
-// g_menu_item_set_detailed_action() for a more convenient version that
+// See also [MenuItem.SetActionAndTargetValue] for an equivalent
+// call that directly accepts a [glib.Variant].  See
+// [MenuItem.SetDetailedAction] for a more convenient version that
 // works with string-typed targets.
 //
-// See also g_menu_item_set_action_and_target_value() for a
+// See also [MenuItem.SetActionAndTargetValue] for a
 // description of the semantics of the action and target attributes.
 func (x *MenuItem) SetActionAndTarget(ActionVar *string, FormatStringVar *string, varArgs ...interface{}) {
 
@@ -539,20 +532,20 @@ func (x *MenuItem) SetActionAndTarget(ActionVar *string, FormatStringVar *string
 
 var xMenuItemSetActionAndTargetValue func(uintptr, uintptr, *glib.Variant)
 
-// Sets or unsets the "action" and "target" attributes of @menu_item.
+// Sets or unsets the "action" and "target" attributes of menu_item.
 //
-// If @action is %NULL then both the "action" and "target" attributes
-// are unset (and @target_value is ignored).
+// If action is nil then both the "action" and "target" attributes
+// are unset (and target_value is ignored).
 //
-// If @action is non-%NULL then the "action" attribute is set.  The
-// "target" attribute is then set to the value of @target_value if it is
-// non-%NULL or unset otherwise.
+// If action is non-nil then the "action" attribute is set.  The
+// "target" attribute is then set to the value of target_value if it is
+// non-nil or unset otherwise.
 //
 // Normal menu items (ie: not submenu, section or other custom item
 // types) are expected to have the "action" attribute set to identify
 // the action that they are associated with.  The state type of the
 // action help to determine the disposition of the menu item.  See
-// #GAction and #GActionGroup for an overview of actions.
+// [Action] and [ActionGroup] for an overview of actions.
 //
 // In general, clicking on the menu item will result in activation of
 // the named action with the "target" attribute given as the parameter
@@ -565,15 +558,15 @@ var xMenuItemSetActionAndTargetValue func(uintptr, uintptr, *glib.Variant)
 // If the action has a boolean state then the menu item is usually drawn
 // as a toggle menu item (ie: with a checkmark or equivalent
 // indication).  The item should be marked as 'toggled' or 'checked'
-// when the boolean state is %TRUE.
+// when the boolean state is true.
 //
 // If the action has a string state then the menu item is usually drawn
 // as a radio menu item (ie: with a radio bullet or equivalent
 // indication).  The item should be marked as 'selected' when the string
-// state is equal to the value of the @target property.
+// state is equal to the value of the target property.
 //
-// See g_menu_item_set_action_and_target() or
-// g_menu_item_set_detailed_action() for two equivalent calls that are
+// See [MenuItem.SetActionAndTarget] or
+// [MenuItem.SetDetailedAction] for two equivalent calls that are
 // probably more convenient for most uses.
 func (x *MenuItem) SetActionAndTargetValue(ActionVar *string, TargetValueVar *glib.Variant) {
 
@@ -586,23 +579,23 @@ func (x *MenuItem) SetActionAndTargetValue(ActionVar *string, TargetValueVar *gl
 
 var xMenuItemSetAttribute func(uintptr, string, uintptr, ...interface{})
 
-// Sets or unsets an attribute on @menu_item.
+// Sets or unsets an attribute on menu_item.
 //
-// The attribute to set or unset is specified by @attribute. This
-// can be one of the standard attribute names %G_MENU_ATTRIBUTE_LABEL,
-// %G_MENU_ATTRIBUTE_ACTION, %G_MENU_ATTRIBUTE_TARGET, or a custom
+// The attribute to set or unset is specified by attribute. This
+// can be one of the standard attribute names [MENU_ATTRIBUTE_LABEL],
+// [MENU_ATTRIBUTE_ACTION], [MENU_ATTRIBUTE_TARGET], or a custom
 // attribute name.
 // Attribute names are restricted to lowercase characters, numbers
 // and '-'. Furthermore, the names must begin with a lowercase character,
 // must not end with a '-', and must not contain consecutive dashes.
 //
-// If @format_string is non-%NULL then the proper position parameters
-// are collected to create a #GVariant instance to use as the attribute
-// value.  If it is %NULL then the positional parameterrs are ignored
+// If format_string is non-nil then the proper position parameters
+// are collected to create a [glib.Variant] instance to use as the attribute
+// value.  If it is nil then the positional parameterrs are ignored
 // and the named attribute is unset.
 //
-// See also g_menu_item_set_attribute_value() for an equivalent call
-// that directly accepts a #GVariant.
+// See also [MenuItem.SetAttributeValue] for an equivalent call
+// that directly accepts a [glib.Variant].
 func (x *MenuItem) SetAttribute(AttributeVar string, FormatStringVar *string, varArgs ...interface{}) {
 
 	FormatStringVarPtr := core.GStrdupNullable(FormatStringVar)
@@ -614,11 +607,11 @@ func (x *MenuItem) SetAttribute(AttributeVar string, FormatStringVar *string, va
 
 var xMenuItemSetAttributeValue func(uintptr, string, *glib.Variant)
 
-// Sets or unsets an attribute on @menu_item.
+// Sets or unsets an attribute on menu_item.
 //
-// The attribute to set or unset is specified by @attribute. This
-// can be one of the standard attribute names %G_MENU_ATTRIBUTE_LABEL,
-// %G_MENU_ATTRIBUTE_ACTION, %G_MENU_ATTRIBUTE_TARGET, or a custom
+// The attribute to set or unset is specified by attribute. This
+// can be one of the standard attribute names [MENU_ATTRIBUTE_LABEL],
+// [MENU_ATTRIBUTE_ACTION], [MENU_ATTRIBUTE_TARGET], or a custom
 // attribute name.
 // Attribute names are restricted to lowercase characters, numbers
 // and '-'. Furthermore, the names must begin with a lowercase character,
@@ -627,11 +620,11 @@ var xMenuItemSetAttributeValue func(uintptr, string, *glib.Variant)
 // must consist only of lowercase
 // ASCII characters, digits and '-'.
 //
-// If @value is non-%NULL then it is used as the new value for the
-// attribute.  If @value is %NULL then the attribute is unset. If
-// the @value #GVariant is floating, it is consumed.
+// If value is non-nil then it is used as the new value for the
+// attribute.  If value is nil then the attribute is unset. If
+// the value [glib.Variant] is floating, it is consumed.
 //
-// See also g_menu_item_set_attribute() for a more convenient way to do
+// See also [MenuItem.SetAttribute] for a more convenient way to do
 // the same.
 func (x *MenuItem) SetAttributeValue(AttributeVar string, ValueVar *glib.Variant) {
 
@@ -641,16 +634,16 @@ func (x *MenuItem) SetAttributeValue(AttributeVar string, ValueVar *glib.Variant
 
 var xMenuItemSetDetailedAction func(uintptr, string)
 
-// Sets the "action" and possibly the "target" attribute of @menu_item.
+// Sets the "action" and possibly the "target" attribute of menu_item.
 //
-// The format of @detailed_action is the same format parsed by
-// g_action_parse_detailed_name().
+// The format of detailed_action is the same format parsed by
+// [ActionParseDetailedName].
 //
-// See g_menu_item_set_action_and_target() or
-// g_menu_item_set_action_and_target_value() for more flexible (but
+// See [MenuItem.SetActionAndTarget] or
+// [MenuItem.SetActionAndTargetValue] for more flexible (but
 // slightly less convenient) alternatives.
 //
-// See also g_menu_item_set_action_and_target_value() for a description of
+// See also [MenuItem.SetActionAndTargetValue] for a description of
 // the semantics of the action and target attributes.
 func (x *MenuItem) SetDetailedAction(DetailedActionVar string) {
 
@@ -660,18 +653,18 @@ func (x *MenuItem) SetDetailedAction(DetailedActionVar string) {
 
 var xMenuItemSetIcon func(uintptr, uintptr)
 
-// Sets (or unsets) the icon on @menu_item.
+// Sets (or unsets) the icon on menu_item.
 //
-// This call is the same as calling g_icon_serialize() and using the
-// result as the value to g_menu_item_set_attribute_value() for
-// %G_MENU_ATTRIBUTE_ICON.
+// This call is the same as calling [Icon.Serialize] and using the
+// result as the value to [MenuItem.SetAttributeValue] for
+// [MENU_ATTRIBUTE_ICON].
 //
 // This API is only intended for use with "noun" menu items; things like
 // bookmarks or applications in an "Open With" menu.  Don't use it on
 // menu items corresponding to verbs (eg: stock icons for 'Save' or
 // 'Quit').
 //
-// If @icon is %NULL then the icon is unset.
+// If icon is nil then the icon is unset.
 func (x *MenuItem) SetIcon(IconVar Icon) {
 
 	xMenuItemSetIcon(x.GoPointer(), IconVar.GoPointer())
@@ -680,10 +673,10 @@ func (x *MenuItem) SetIcon(IconVar Icon) {
 
 var xMenuItemSetLabel func(uintptr, uintptr)
 
-// Sets or unsets the "label" attribute of @menu_item.
+// Sets or unsets the "label" attribute of menu_item.
 //
-// If @label is non-%NULL it is used as the label for the menu item.  If
-// it is %NULL then the label attribute is unset.
+// If label is non-nil it is used as the label for the menu item.  If
+// it is nil then the label attribute is unset.
 func (x *MenuItem) SetLabel(LabelVar *string) {
 
 	LabelVarPtr := core.GStrdupNullable(LabelVar)
@@ -695,11 +688,11 @@ func (x *MenuItem) SetLabel(LabelVar *string) {
 
 var xMenuItemSetLink func(uintptr, string, uintptr)
 
-// Creates a link from @menu_item to @model if non-%NULL, or unsets it.
+// Creates a link from menu_item to model if non-nil, or unsets it.
 //
 // Links are used to establish a relationship between a particular menu
-// item and another menu.  For example, %G_MENU_LINK_SUBMENU is used to
-// associate a submenu with a particular menu item, and %G_MENU_LINK_SECTION
+// item and another menu.  For example, [MENU_LINK_SUBMENU] is used to
+// associate a submenu with a particular menu item, and [MENU_LINK_SECTION]
 // is used to create a section. Other types of link can be used, but there
 // is no guarantee that clients will be able to make sense of them.
 // Link types are restricted to lowercase characters, numbers
@@ -713,11 +706,11 @@ func (x *MenuItem) SetLink(LinkVar string, ModelVar *MenuModel) {
 
 var xMenuItemSetSection func(uintptr, uintptr)
 
-// Sets or unsets the "section" link of @menu_item to @section.
+// Sets or unsets the "section" link of menu_item to section.
 //
 // The effect of having one menu appear as a section of another is
-// exactly as it sounds: the items from @section become a direct part of
-// the menu that @menu_item is added to.  See g_menu_item_new_section()
+// exactly as it sounds: the items from section become a direct part of
+// the menu that menu_item is added to.  See [NewMenuItemSection]
 // for more information about what it means for a menu item to be a
 // section.
 func (x *MenuItem) SetSection(SectionVar *MenuModel) {
@@ -728,9 +721,9 @@ func (x *MenuItem) SetSection(SectionVar *MenuModel) {
 
 var xMenuItemSetSubmenu func(uintptr, uintptr)
 
-// Sets or unsets the "submenu" link of @menu_item to @submenu.
+// Sets or unsets the "submenu" link of menu_item to submenu.
 //
-// If @submenu is non-%NULL, it is linked to.  If it is %NULL then the
+// If submenu is non-nil, it is linked to.  If it is nil then the
 // link is unset.
 //
 // The effect of having one menu appear as a submenu of another is
@@ -757,7 +750,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -766,43 +759,43 @@ func init() {
 
 	core.PuregoSafeRegister(&xMenuGLibType, libs, "g_menu_get_type")
 
-	core.PuregoSafeRegister(&xNewMenu, libs, "g_menu_new")
-
-	core.PuregoSafeRegister(&xMenuAppend, libs, "g_menu_append")
-	core.PuregoSafeRegister(&xMenuAppendItem, libs, "g_menu_append_item")
-	core.PuregoSafeRegister(&xMenuAppendSection, libs, "g_menu_append_section")
-	core.PuregoSafeRegister(&xMenuAppendSubmenu, libs, "g_menu_append_submenu")
-	core.PuregoSafeRegister(&xMenuFreeze, libs, "g_menu_freeze")
-	core.PuregoSafeRegister(&xMenuInsert, libs, "g_menu_insert")
-	core.PuregoSafeRegister(&xMenuInsertItem, libs, "g_menu_insert_item")
-	core.PuregoSafeRegister(&xMenuInsertSection, libs, "g_menu_insert_section")
-	core.PuregoSafeRegister(&xMenuInsertSubmenu, libs, "g_menu_insert_submenu")
-	core.PuregoSafeRegister(&xMenuPrepend, libs, "g_menu_prepend")
-	core.PuregoSafeRegister(&xMenuPrependItem, libs, "g_menu_prepend_item")
-	core.PuregoSafeRegister(&xMenuPrependSection, libs, "g_menu_prepend_section")
-	core.PuregoSafeRegister(&xMenuPrependSubmenu, libs, "g_menu_prepend_submenu")
-	core.PuregoSafeRegister(&xMenuRemove, libs, "g_menu_remove")
-	core.PuregoSafeRegister(&xMenuRemoveAll, libs, "g_menu_remove_all")
+	core.PuregoRegisterSince(&xNewMenu, libs, "g_menu_new", "GIO", "2.32")
+
+	core.PuregoRegisterSince(&xMenuAppend, libs, "g_menu_append", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAppendItem, libs, "g_menu_append_item", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAppendSection, libs, "g_menu_append_section", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAppendSubmenu, libs, "g_menu_append_submenu", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuFreeze, libs, "g_menu_freeze", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuInsert, libs, "g_menu_insert", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuInsertItem, libs, "g_menu_insert_item", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuInsertSection, libs, "g_menu_insert_section", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuInsertSubmenu, libs, "g_menu_insert_submenu", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuPrepend, libs, "g_menu_prepend", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuPrependItem, libs, "g_menu_prepend_item", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuPrependSection, libs, "g_menu_prepend_section", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuPrependSubmenu, libs, "g_menu_prepend_submenu", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuRemove, libs, "g_menu_remove", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuRemoveAll, libs, "g_menu_remove_all", "GIO", "2.38")
 
 	core.PuregoSafeRegister(&xMenuItemGLibType, libs, "g_menu_item_get_type")
 
-	core.PuregoSafeRegister(&xNewMenuItem, libs, "g_menu_item_new")
-	core.PuregoSafeRegister(&xNewMenuItemFromModel, libs, "g_menu_item_new_from_model")
-	core.PuregoSafeRegister(&xNewMenuItemSection, libs, "g_menu_item_new_section")
-	core.PuregoSafeRegister(&xNewMenuItemSubmenu, libs, "g_menu_item_new_submenu")
-
-	core.PuregoSafeRegister(&xMenuItemGetAttribute, libs, "g_menu_item_get_attribute")
-	core.PuregoSafeRegister(&xMenuItemGetAttributeValue, libs, "g_menu_item_get_attribute_value")
-	core.PuregoSafeRegister(&xMenuItemGetLink, libs, "g_menu_item_get_link")
-	core.PuregoSafeRegister(&xMenuItemSetActionAndTarget, libs, "g_menu_item_set_action_and_target")
-	core.PuregoSafeRegister(&xMenuItemSetActionAndTargetValue, libs, "g_menu_item_set_action_and_target_value")
-	core.PuregoSafeRegister(&xMenuItemSetAttribute, libs, "g_menu_item_set_attribute")
-	core.PuregoSafeRegister(&xMenuItemSetAttributeValue, libs, "g_menu_item_set_attribute_value")
-	core.PuregoSafeRegister(&xMenuItemSetDetailedAction, libs, "g_menu_item_set_detailed_action")
-	core.PuregoSafeRegister(&xMenuItemSetIcon, libs, "g_menu_item_set_icon")
-	core.PuregoSafeRegister(&xMenuItemSetLabel, libs, "g_menu_item_set_label")
-	core.PuregoSafeRegister(&xMenuItemSetLink, libs, "g_menu_item_set_link")
-	core.PuregoSafeRegister(&xMenuItemSetSection, libs, "g_menu_item_set_section")
-	core.PuregoSafeRegister(&xMenuItemSetSubmenu, libs, "g_menu_item_set_submenu")
+	core.PuregoRegisterSince(&xNewMenuItem, libs, "g_menu_item_new", "GIO", "2.32")
+	core.PuregoRegisterSince(&xNewMenuItemFromModel, libs, "g_menu_item_new_from_model", "GIO", "2.34")
+	core.PuregoRegisterSince(&xNewMenuItemSection, libs, "g_menu_item_new_section", "GIO", "2.32")
+	core.PuregoRegisterSince(&xNewMenuItemSubmenu, libs, "g_menu_item_new_submenu", "GIO", "2.32")
+
+	core.PuregoRegisterSince(&xMenuItemGetAttribute, libs, "g_menu_item_get_attribute", "GIO", "2.34")
+	core.PuregoRegisterSince(&xMenuItemGetAttributeValue, libs, "g_menu_item_get_attribute_value", "GIO", "2.34")
+	core.PuregoRegisterSince(&xMenuItemGetLink, libs, "g_menu_item_get_link", "GIO", "2.34")
+	core.PuregoRegisterSince(&xMenuItemSetActionAndTarget, libs, "g_menu_item_set_action_and_target", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetActionAndTargetValue, libs, "g_menu_item_set_action_and_target_value", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetAttribute, libs, "g_menu_item_set_attribute", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetAttributeValue, libs, "g_menu_item_set_attribute_value", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetDetailedAction, libs, "g_menu_item_set_detailed_action", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetIcon, libs, "g_menu_item_set_icon", "GIO", "2.38")
+	core.PuregoRegisterSince(&xMenuItemSetLabel, libs, "g_menu_item_set_label", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetLink, libs, "g_menu_item_set_link", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetSection, libs, "g_menu_item_set_section", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuItemSetSubmenu, libs, "g_menu_item_set_submenu", "GIO", "2.32")
 
 }
@@ -3,7 +3,7 @@ package gio
 
 const (
 	// The maximum number of entries in a menu section supported by
-	// g_dbus_connection_export_menu_model().
+	// [DBusConnection.ExportMenuModel].
 	//
 	// The exact value of the limit may change in future GLib versions.
 	MENU_EXPORTER_MAX_SECTION_SIZE int = 1000
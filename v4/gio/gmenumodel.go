@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -29,7 +28,8 @@ func (x *MenuAttributeIterClass) OverrideGetNext(cb func(*MenuAttributeIter, *st
 	if cb == nil {
 		x.xGetNext = 0
 	} else {
-		x.xGetNext = purego.NewCallback(func(IterVarp uintptr, OutNameVarp *string, ValueVarp **glib.Variant) bool {
+		x.xGetNext = core.NewCallback(func(IterVarp uintptr, OutNameVarp *string, ValueVarp **glib.Variant) bool {
+			defer core.RecoverPanic()
 			return cb(MenuAttributeIterNewFromInternalPtr(IterVarp), OutNameVarp, ValueVarp)
 		})
 	}
@@ -41,7 +41,7 @@ func (x *MenuAttributeIterClass) GetGetNext() func(*MenuAttributeIter, *string,
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr, OutNameVarp *string, ValueVarp **glib.Variant) bool
-	purego.RegisterFunc(&rawCallback, x.xGetNext)
+	core.RegisterFunc(&rawCallback, x.xGetNext)
 	return func(IterVar *MenuAttributeIter, OutNameVar *string, ValueVar **glib.Variant) bool {
 		return rawCallback(IterVar.GoPointer(), OutNameVar, ValueVar)
 	}
@@ -72,7 +72,8 @@ func (x *MenuLinkIterClass) OverrideGetNext(cb func(*MenuLinkIter, *string, **Me
 	if cb == nil {
 		x.xGetNext = 0
 	} else {
-		x.xGetNext = purego.NewCallback(func(IterVarp uintptr, OutLinkVarp *string, ValueVarp **MenuModel) bool {
+		x.xGetNext = core.NewCallback(func(IterVarp uintptr, OutLinkVarp *string, ValueVarp **MenuModel) bool {
+			defer core.RecoverPanic()
 			return cb(MenuLinkIterNewFromInternalPtr(IterVarp), OutLinkVarp, ValueVarp)
 		})
 	}
@@ -84,7 +85,7 @@ func (x *MenuLinkIterClass) GetGetNext() func(*MenuLinkIter, *string, **MenuMode
 		return nil
 	}
 	var rawCallback func(IterVarp uintptr, OutLinkVarp *string, ValueVarp **MenuModel) bool
-	purego.RegisterFunc(&rawCallback, x.xGetNext)
+	core.RegisterFunc(&rawCallback, x.xGetNext)
 	return func(IterVar *MenuLinkIter, OutLinkVar *string, ValueVar **MenuModel) bool {
 		return rawCallback(IterVar.GoPointer(), OutLinkVar, ValueVar)
 	}
@@ -129,7 +130,8 @@ func (x *MenuModelClass) OverrideIsMutable(cb func(*MenuModel) bool) {
 	if cb == nil {
 		x.xIsMutable = 0
 	} else {
-		x.xIsMutable = purego.NewCallback(func(ModelVarp uintptr) bool {
+		x.xIsMutable = core.NewCallback(func(ModelVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(MenuModelNewFromInternalPtr(ModelVarp))
 		})
 	}
@@ -141,7 +143,7 @@ func (x *MenuModelClass) GetIsMutable() func(*MenuModel) bool {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsMutable)
+	core.RegisterFunc(&rawCallback, x.xIsMutable)
 	return func(ModelVar *MenuModel) bool {
 		return rawCallback(ModelVar.GoPointer())
 	}
@@ -152,7 +154,8 @@ func (x *MenuModelClass) OverrideGetNItems(cb func(*MenuModel) int) {
 	if cb == nil {
 		x.xGetNItems = 0
 	} else {
-		x.xGetNItems = purego.NewCallback(func(ModelVarp uintptr) int {
+		x.xGetNItems = core.NewCallback(func(ModelVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(MenuModelNewFromInternalPtr(ModelVarp))
 		})
 	}
@@ -164,7 +167,7 @@ func (x *MenuModelClass) GetGetNItems() func(*MenuModel) int {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetNItems)
+	core.RegisterFunc(&rawCallback, x.xGetNItems)
 	return func(ModelVar *MenuModel) int {
 		return rawCallback(ModelVar.GoPointer())
 	}
@@ -175,7 +178,8 @@ func (x *MenuModelClass) OverrideGetItemAttributes(cb func(*MenuModel, int, **gl
 	if cb == nil {
 		x.xGetItemAttributes = 0
 	} else {
-		x.xGetItemAttributes = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, AttributesVarp **glib.HashTable) {
+		x.xGetItemAttributes = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, AttributesVarp **glib.HashTable) {
+			defer core.RecoverPanic()
 			cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp, AttributesVarp)
 		})
 	}
@@ -187,7 +191,7 @@ func (x *MenuModelClass) GetGetItemAttributes() func(*MenuModel, int, **glib.Has
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int, AttributesVarp **glib.HashTable)
-	purego.RegisterFunc(&rawCallback, x.xGetItemAttributes)
+	core.RegisterFunc(&rawCallback, x.xGetItemAttributes)
 	return func(ModelVar *MenuModel, ItemIndexVar int, AttributesVar **glib.HashTable) {
 		rawCallback(ModelVar.GoPointer(), ItemIndexVar, AttributesVar)
 	}
@@ -198,7 +202,8 @@ func (x *MenuModelClass) OverrideIterateItemAttributes(cb func(*MenuModel, int)
 	if cb == nil {
 		x.xIterateItemAttributes = 0
 	} else {
-		x.xIterateItemAttributes = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int) uintptr {
+		x.xIterateItemAttributes = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp)
 			if ret == nil {
 				return 0
@@ -214,7 +219,7 @@ func (x *MenuModelClass) GetIterateItemAttributes() func(*MenuModel, int) *MenuA
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int) uintptr
-	purego.RegisterFunc(&rawCallback, x.xIterateItemAttributes)
+	core.RegisterFunc(&rawCallback, x.xIterateItemAttributes)
 	return func(ModelVar *MenuModel, ItemIndexVar int) *MenuAttributeIter {
 		rawRet := rawCallback(ModelVar.GoPointer(), ItemIndexVar)
 		if rawRet == 0 {
@@ -231,7 +236,8 @@ func (x *MenuModelClass) OverrideGetItemAttributeValue(cb func(*MenuModel, int,
 	if cb == nil {
 		x.xGetItemAttributeValue = 0
 	} else {
-		x.xGetItemAttributeValue = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, AttributeVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant {
+		x.xGetItemAttributeValue = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, AttributeVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp, AttributeVarp, ExpectedTypeVarp)
 		})
 	}
@@ -243,7 +249,7 @@ func (x *MenuModelClass) GetGetItemAttributeValue() func(*MenuModel, int, string
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int, AttributeVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetItemAttributeValue)
+	core.RegisterFunc(&rawCallback, x.xGetItemAttributeValue)
 	return func(ModelVar *MenuModel, ItemIndexVar int, AttributeVar string, ExpectedTypeVar *glib.VariantType) *glib.Variant {
 		return rawCallback(ModelVar.GoPointer(), ItemIndexVar, AttributeVar, ExpectedTypeVar)
 	}
@@ -254,7 +260,8 @@ func (x *MenuModelClass) OverrideGetItemLinks(cb func(*MenuModel, int, **glib.Ha
 	if cb == nil {
 		x.xGetItemLinks = 0
 	} else {
-		x.xGetItemLinks = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, LinksVarp **glib.HashTable) {
+		x.xGetItemLinks = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, LinksVarp **glib.HashTable) {
+			defer core.RecoverPanic()
 			cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp, LinksVarp)
 		})
 	}
@@ -266,7 +273,7 @@ func (x *MenuModelClass) GetGetItemLinks() func(*MenuModel, int, **glib.HashTabl
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int, LinksVarp **glib.HashTable)
-	purego.RegisterFunc(&rawCallback, x.xGetItemLinks)
+	core.RegisterFunc(&rawCallback, x.xGetItemLinks)
 	return func(ModelVar *MenuModel, ItemIndexVar int, LinksVar **glib.HashTable) {
 		rawCallback(ModelVar.GoPointer(), ItemIndexVar, LinksVar)
 	}
@@ -277,7 +284,8 @@ func (x *MenuModelClass) OverrideIterateItemLinks(cb func(*MenuModel, int) *Menu
 	if cb == nil {
 		x.xIterateItemLinks = 0
 	} else {
-		x.xIterateItemLinks = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int) uintptr {
+		x.xIterateItemLinks = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp)
 			if ret == nil {
 				return 0
@@ -293,7 +301,7 @@ func (x *MenuModelClass) GetIterateItemLinks() func(*MenuModel, int) *MenuLinkIt
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int) uintptr
-	purego.RegisterFunc(&rawCallback, x.xIterateItemLinks)
+	core.RegisterFunc(&rawCallback, x.xIterateItemLinks)
 	return func(ModelVar *MenuModel, ItemIndexVar int) *MenuLinkIter {
 		rawRet := rawCallback(ModelVar.GoPointer(), ItemIndexVar)
 		if rawRet == 0 {
@@ -310,7 +318,8 @@ func (x *MenuModelClass) OverrideGetItemLink(cb func(*MenuModel, int, string) *M
 	if cb == nil {
 		x.xGetItemLink = 0
 	} else {
-		x.xGetItemLink = purego.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, LinkVarp string) uintptr {
+		x.xGetItemLink = core.NewCallback(func(ModelVarp uintptr, ItemIndexVarp int, LinkVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(MenuModelNewFromInternalPtr(ModelVarp), ItemIndexVarp, LinkVarp)
 			if ret == nil {
 				return 0
@@ -326,7 +335,7 @@ func (x *MenuModelClass) GetGetItemLink() func(*MenuModel, int, string) *MenuMod
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, ItemIndexVarp int, LinkVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetItemLink)
+	core.RegisterFunc(&rawCallback, x.xGetItemLink)
 	return func(ModelVar *MenuModel, ItemIndexVar int, LinkVar string) *MenuModel {
 		rawRet := rawCallback(ModelVar.GoPointer(), ItemIndexVar, LinkVar)
 		if rawRet == 0 {
@@ -352,14 +361,14 @@ const (
 	// action resides. For example, "win." for window-specific actions and "app."
 	// for application-wide actions.
 	//
-	// See also g_menu_model_get_item_attribute() and g_menu_item_set_attribute().
+	// See also [MenuModel.GetItemAttribute] and [MenuItem.SetAttribute].
 	MENU_ATTRIBUTE_ACTION string = "action"
 	// The menu item attribute that holds the namespace for all action names in
 	// menus that are linked from this item.
 	MENU_ATTRIBUTE_ACTION_NAMESPACE string = "action-namespace"
 	// The menu item attribute which holds the icon of the item.
 	//
-	// The icon is stored in the format returned by g_icon_serialize().
+	// The icon is stored in the format returned by [Icon.Serialize].
 	//
 	// This attribute is intended only to represent 'noun' icons such as
 	// favicons for a webpage, or application icons.  It should not be used
@@ -370,21 +379,21 @@ const (
 	// The menu item attribute which holds the target with which the item's action
 	// will be activated.
 	//
-	// See also g_menu_item_set_action_and_target()
+	// See also [MenuItem.SetActionAndTarget]
 	MENU_ATTRIBUTE_TARGET string = "target"
 	// The name of the link that associates a menu item with a section.  The linked
 	// menu will usually be shown in place of the menu item, using the item's label
 	// as a header.
 	//
-	// See also g_menu_item_set_link().
+	// See also [MenuItem.SetLink].
 	MENU_LINK_SECTION string = "section"
 	// The name of the link that associates a menu item with a submenu.
 	//
-	// See also g_menu_item_set_link().
+	// See also [MenuItem.SetLink].
 	MENU_LINK_SUBMENU string = "submenu"
 )
 
-// #GMenuAttributeIter is an opaque structure type.  You must access it
+// [MenuAttributeIter] is an opaque structure type.  You must access it
 // using the functions below.
 type MenuAttributeIter struct {
 	gobject.Object
@@ -416,21 +425,21 @@ func (x *MenuAttributeIter) GetName() string {
 
 var xMenuAttributeIterGetNext func(uintptr, *string, **glib.Variant) bool
 
-// This function combines g_menu_attribute_iter_next() with
-// g_menu_attribute_iter_get_name() and g_menu_attribute_iter_get_value().
+// This function combines [MenuAttributeIter.Next] with
+// [MenuAttributeIter.GetName] and [MenuAttributeIter.GetValue].
 //
 // First the iterator is advanced to the next (possibly first) attribute.
-// If that fails, then %FALSE is returned and there are no other
+// If that fails, then false is returned and there are no other
 // effects.
 //
-// If successful, @name and @value are set to the name and value of the
+// If successful, name and value are set to the name and value of the
 // attribute that has just been advanced to.  At this point,
-// g_menu_attribute_iter_get_name() and g_menu_attribute_iter_get_value() will
+// [MenuAttributeIter.GetName] and [MenuAttributeIter.GetValue] will
 // return the same values again.
 //
-// The value returned in @name remains valid for as long as the iterator
-// remains at the current position.  The value returned in @value must
-// be unreffed using g_variant_unref() when it is no longer in use.
+// The value returned in name remains valid for as long as the iterator
+// remains at the current position.  The value returned in value must
+// be unreffed using [glib.Variant.Unref] when it is no longer in use.
 func (x *MenuAttributeIter) GetNext(OutNameVar *string, ValueVar **glib.Variant) bool {
 
 	cret := xMenuAttributeIterGetNext(x.GoPointer(), OutNameVar, ValueVar)
@@ -453,7 +462,7 @@ var xMenuAttributeIterNext func(uintptr) bool
 // Attempts to advance the iterator to the next (possibly first)
 // attribute.
 //
-// %TRUE is returned on success, or %FALSE if there are no more
+// true is returned on success, or false if there are no more
 // attributes.
 //
 // You must call this function when you first acquire the iterator
@@ -476,7 +485,7 @@ func (c *MenuAttributeIter) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// #GMenuLinkIter is an opaque structure type.  You must access it using
+// [MenuLinkIter] is an opaque structure type.  You must access it using
 // the functions below.
 type MenuLinkIter struct {
 	gobject.Object
@@ -507,20 +516,20 @@ func (x *MenuLinkIter) GetName() string {
 
 var xMenuLinkIterGetNext func(uintptr, *string, **MenuModel) bool
 
-// This function combines g_menu_link_iter_next() with
-// g_menu_link_iter_get_name() and g_menu_link_iter_get_value().
+// This function combines [MenuLinkIter.Next] with
+// [MenuLinkIter.GetName] and [MenuLinkIter.GetValue].
 //
 // First the iterator is advanced to the next (possibly first) link.
-// If that fails, then %FALSE is returned and there are no other effects.
+// If that fails, then false is returned and there are no other effects.
 //
-// If successful, @out_link and @value are set to the name and #GMenuModel
+// If successful, out_link and value are set to the name and [MenuModel]
 // of the link that has just been advanced to.  At this point,
-// g_menu_link_iter_get_name() and g_menu_link_iter_get_value() will return the
+// [MenuLinkIter.GetName] and [MenuLinkIter.GetValue] will return the
 // same values again.
 //
-// The value returned in @out_link remains valid for as long as the iterator
-// remains at the current position.  The value returned in @value must
-// be unreffed using g_object_unref() when it is no longer in use.
+// The value returned in out_link remains valid for as long as the iterator
+// remains at the current position.  The value returned in value must
+// be unreffed using [gobject.Object.Unref] when it is no longer in use.
 func (x *MenuLinkIter) GetNext(OutLinkVar *string, ValueVar **MenuModel) bool {
 
 	cret := xMenuLinkIterGetNext(x.GoPointer(), OutLinkVar, ValueVar)
@@ -529,7 +538,7 @@ func (x *MenuLinkIter) GetNext(OutLinkVar *string, ValueVar **MenuModel) bool {
 
 var xMenuLinkIterGetValue func(uintptr) uintptr
 
-// Gets the linked #GMenuModel at the current iterator position.
+// Gets the linked [MenuModel] at the current iterator position.
 //
 // The iterator is not advanced.
 func (x *MenuLinkIter) GetValue() *MenuModel {
@@ -550,7 +559,7 @@ var xMenuLinkIterNext func(uintptr) bool
 // Attempts to advance the iterator to the next (possibly first)
 // link.
 //
-// %TRUE is returned on success, or %FALSE if there are no more links.
+// true is returned on success, or false if there are no more links.
 //
 // You must call this function when you first acquire the iterator to
 // advance it to the first link (and determine if the first link exists
@@ -589,9 +598,7 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 //
 // As an example, consider the visible portions of this menu:
 //
-// ## An example menu
-//
-// ![](menu-example.png)
+// # An example menu
 //
 // While this kind of deeply nested menu is no longer considered good UI
 // practice, it serves as a good example of the concepts in `GMenuModel`.
@@ -607,22 +614,15 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // - the Sources section (containing 2 items)
 // - the Markup section (containing 2 items)
 //
-// The [example](#a-menu-example) illustrates the conceptual connection between
+// The example illustrates the conceptual connection between
 // these 8 menus. Each large block in the figure represents a menu and the
 // smaller blocks within the large block represent items in that menu. Some
 // items contain references to other menus.
 //
-// ## A menu example
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="menu-model-dark.svg" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img src="menu-model-light.svg" alt="menu model"&gt;
+// # A menu example
 //
-// &lt;/picture&gt;
-//
-// Notice that the separators visible in the [example](#an-example-menu)
-// appear nowhere in the [menu model](#a-menu-example). This is because
+// Notice that the separators visible in the example
+// appear nowhere in the menu model. This is because
 // separators are not explicitly represented in the menu model. Instead,
 // a separator is inserted between any two non-empty sections of a menu.
 // Section items can have labels just like any other item. In that case,
@@ -634,14 +634,14 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // dash boards, etc. To support such uses, it is necessary to ‘export’
 // information about actions and their representation in menus, which
 // is exactly what the action group exporter and the menu model exporter do for
-// [iface@Gio.ActionGroup] and [class@Gio.MenuModel]. The client-side
+// [ActionGroup] and [MenuModel]. The client-side
 // counterparts to make use of the exported information are
-// [class@Gio.DBusActionGroup] and [class@Gio.DBusMenuModel].
+// [DBusActionGroup] and [DBusMenuModel].
 //
 // The API of `GMenuModel` is very generic, with iterators for the
 // attributes and links of an item, see
-// [method@Gio.MenuModel.iterate_item_attributes] and
-// [method@Gio.MenuModel.iterate_item_links]. The ‘standard’ attributes and
+// [MenuModel.IterateItemAttributes] and
+// [MenuModel.IterateItemLinks]. The ‘standard’ attributes and
 // link types have predefined names: `G_MENU_ATTRIBUTE_LABEL`,
 // `G_MENU_ATTRIBUTE_ACTION`, `G_MENU_ATTRIBUTE_TARGET`, `G_MENU_LINK_SECTION`
 // and `G_MENU_LINK_SUBMENU`.
@@ -653,7 +653,7 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // identifies an action in an action group. Which action group(s) provide
 // actions depends on the context in which the menu model is used.
 // E.g. when the model is exported as the application menu of a
-// [`GtkApplication`](https://docs.gtk.org/gtk4/class.Application.html),
+// [GtkApplication],
 // actions can be application-wide or window-specific (and thus come from
 // two different action groups). By convention, the application-wide actions
 // have names that start with `app.`, while the names of window-specific
@@ -666,13 +666,13 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // - an action with no parameter type and boolean state
 // - an action with string parameter type and string state
 //
-// ## Stateless
+// # Stateless
 //
 // A stateless action typically corresponds to an ordinary menu item.
 //
 // Selecting such a menu item will activate the action (with no parameter).
 //
-// ## Boolean State
+// # Boolean State
 //
 // An action with a boolean state will most typically be used with a ‘toggle’
 // or ‘switch’ menu item. The state can be set directly, but activating the
@@ -681,7 +681,7 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // Selecting a toggle menu item will activate the action. The menu item should
 // be rendered as ‘checked’ when the state is true.
 //
-// ## String Parameter and State
+// # String Parameter and State
 //
 // Actions with string parameters and state will most typically be used to
 // represent an enumerated choice over the items available for a group of
@@ -693,6 +693,8 @@ func (c *MenuLinkIter) SetGoPointer(ptr uintptr) {
 // of the action with the target value as the parameter. The menu item should
 // be rendered as ‘selected’ when the state of the action is equal to the
 // target value of the menu item.
+//
+// [GtkApplication]: https://docs.gtk.org/gtk4/class.Application.html
 type MenuModel struct {
 	gobject.Object
 }
@@ -711,22 +713,22 @@ func MenuModelNewFromInternalPtr(ptr uintptr) *MenuModel {
 
 var xMenuModelGetItemAttribute func(uintptr, int, string, string, ...interface{}) bool
 
-// Queries item at position @item_index in @model for the attribute
-// specified by @attribute.
+// Queries item at position item_index in model for the attribute
+// specified by attribute.
 //
-// If the attribute exists and matches the #GVariantType corresponding
-// to @format_string then @format_string is used to deconstruct the
-// value into the positional parameters and %TRUE is returned.
+// If the attribute exists and matches the [glib.VariantType] corresponding
+// to format_string then format_string is used to deconstruct the
+// value into the positional parameters and true is returned.
 //
 // If the attribute does not exist, or it does exist but has the wrong
-// type, then the positional parameters are ignored and %FALSE is
+// type, then the positional parameters are ignored and false is
 // returned.
 //
-// This function is a mix of g_menu_model_get_item_attribute_value() and
-// g_variant_get(), followed by a g_variant_unref().  As such,
-// @format_string must make a complete copy of the data (since the
-// #GVariant may go away after the call to g_variant_unref()).  In
-// particular, no '&amp;' characters are allowed in @format_string.
+// This function is a mix of [MenuModel.GetItemAttributeValue] and
+// [glib.Variant.Get], followed by a [glib.Variant.Unref].  As such,
+// format_string must make a complete copy of the data (since the
+// [glib.Variant] may go away after the call to [glib.Variant.Unref]).  In
+// particular, no '&' characters are allowed in format_string.
 func (x *MenuModel) GetItemAttribute(ItemIndexVar int, AttributeVar string, FormatStringVar string, varArgs ...interface{}) bool {
 
 	cret := xMenuModelGetItemAttribute(x.GoPointer(), ItemIndexVar, AttributeVar, FormatStringVar, varArgs...)
@@ -735,17 +737,17 @@ func (x *MenuModel) GetItemAttribute(ItemIndexVar int, AttributeVar string, Form
 
 var xMenuModelGetItemAttributeValue func(uintptr, int, string, *glib.VariantType) *glib.Variant
 
-// Queries the item at position @item_index in @model for the attribute
-// specified by @attribute.
+// Queries the item at position item_index in model for the attribute
+// specified by attribute.
 //
-// If @expected_type is non-%NULL then it specifies the expected type of
-// the attribute.  If it is %NULL then any type will be accepted.
+// If expected_type is non-nil then it specifies the expected type of
+// the attribute.  If it is nil then any type will be accepted.
 //
-// If the attribute exists and matches @expected_type (or if the
+// If the attribute exists and matches expected_type (or if the
 // expected type is unspecified) then the value is returned.
 //
 // If the attribute does not exist, or does not match the expected type
-// then %NULL is returned.
+// then nil is returned.
 func (x *MenuModel) GetItemAttributeValue(ItemIndexVar int, AttributeVar string, ExpectedTypeVar *glib.VariantType) *glib.Variant {
 
 	cret := xMenuModelGetItemAttributeValue(x.GoPointer(), ItemIndexVar, AttributeVar, ExpectedTypeVar)
@@ -754,11 +756,11 @@ func (x *MenuModel) GetItemAttributeValue(ItemIndexVar int, AttributeVar string,
 
 var xMenuModelGetItemLink func(uintptr, int, string) uintptr
 
-// Queries the item at position @item_index in @model for the link
-// specified by @link.
+// Queries the item at position item_index in model for the link
+// specified by link.
 //
-// If the link exists, the linked #GMenuModel is returned.  If the link
-// does not exist, %NULL is returned.
+// If the link exists, the linked [MenuModel] is returned.  If the link
+// does not exist, nil is returned.
 func (x *MenuModel) GetItemLink(ItemIndexVar int, LinkVar string) *MenuModel {
 	var cls *MenuModel
 
@@ -774,7 +776,7 @@ func (x *MenuModel) GetItemLink(ItemIndexVar int, LinkVar string) *MenuModel {
 
 var xMenuModelGetNItems func(uintptr) int
 
-// Query the number of items in @model.
+// Query the number of items in model.
 func (x *MenuModel) GetNItems() int {
 
 	cret := xMenuModelGetNItems(x.GoPointer())
@@ -783,9 +785,9 @@ func (x *MenuModel) GetNItems() int {
 
 var xMenuModelIsMutable func(uintptr) bool
 
-// Queries if @model is mutable.
+// Queries if model is mutable.
 //
-// An immutable #GMenuModel will never emit the #GMenuModel::items-changed
+// An immutable [MenuModel] will never emit the [MenuModel.ConnectItemsChangedFunc]
 // signal. Consumers of the model may make optimisations accordingly.
 func (x *MenuModel) IsMutable() bool {
 
@@ -795,9 +797,9 @@ func (x *MenuModel) IsMutable() bool {
 
 var xMenuModelItemsChanged func(uintptr, int, int, int)
 
-// Requests emission of the #GMenuModel::items-changed signal on @model.
+// Requests emission of the [MenuModel.ConnectItemsChangedFunc] signal on model.
 //
-// This function should never be called except by #GMenuModel
+// This function should never be called except by [MenuModel]
 // subclasses.  Any other calls to this function will very likely lead
 // to a violation of the interface of the model.
 //
@@ -808,7 +810,7 @@ var xMenuModelItemsChanged func(uintptr, int, int, int)
 //
 // The implementation must dispatch this call directly from a mainloop
 // entry and not in response to calls -- particularly those from the
-// #GMenuModel API.  Said another way: the menu must not change while
+// [MenuModel] API.  Said another way: the menu must not change while
 // user code is running without returning to the mainloop.
 func (x *MenuModel) ItemsChanged(PositionVar int, RemovedVar int, AddedVar int) {
 
@@ -818,10 +820,10 @@ func (x *MenuModel) ItemsChanged(PositionVar int, RemovedVar int, AddedVar int)
 
 var xMenuModelIterateItemAttributes func(uintptr, int) uintptr
 
-// Creates a #GMenuAttributeIter to iterate over the attributes of
-// the item at position @item_index in @model.
+// Creates a [MenuAttributeIter] to iterate over the attributes of
+// the item at position item_index in model.
 //
-// You must free the iterator with g_object_unref() when you are done.
+// You must free the iterator with [gobject.Object.Unref] when you are done.
 func (x *MenuModel) IterateItemAttributes(ItemIndexVar int) *MenuAttributeIter {
 	var cls *MenuAttributeIter
 
@@ -837,10 +839,10 @@ func (x *MenuModel) IterateItemAttributes(ItemIndexVar int) *MenuAttributeIter {
 
 var xMenuModelIterateItemLinks func(uintptr, int) uintptr
 
-// Creates a #GMenuLinkIter to iterate over the links of the item at
-// position @item_index in @model.
+// Creates a [MenuLinkIter] to iterate over the links of the item at
+// position item_index in model.
 //
-// You must free the iterator with g_object_unref() when you are done.
+// You must free the iterator with [gobject.Object.Unref] when you are done.
 func (x *MenuModel) IterateItemLinks(ItemIndexVar int) *MenuLinkIter {
 	var cls *MenuLinkIter
 
@@ -872,9 +874,9 @@ func (c *MenuModel) SetGoPointer(ptr uintptr) {
 // back in the same location).  This signal is capable of describing
 // both of those changes (at the same time).
 //
-// The signal means that starting at the index @position, @removed
-// items were removed and @added items were added in their place.  If
-// @removed is zero then only items were added.  If @added is zero
+// The signal means that starting at the index position, removed
+// items were removed and added items were added in their place.  If
+// removed is zero then only items were added.  If added is zero
 // then only items were removed.
 //
 // As an example, if the menu contains items a, b, c, d (in that
@@ -885,27 +887,49 @@ func (c *MenuModel) SetGoPointer(ptr uintptr) {
 // Signal handlers may query the model (particularly the added items)
 // and expect to see the results of the modification that is being
 // reported.  The signal is emitted after the modification.
+//
+// Deprecated: use ConnectItemsChangedFunc, which also accepts method values and closures.
 func (x *MenuModel) ConnectItemsChanged(cb *func(MenuModel, int, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "items-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp int, RemovedVarp int, AddedVarp int) {
+			defer core.RecoverPanic()
+			fa := MenuModel{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp, RemovedVarp, AddedVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "items-changed", cbRefPtr, cbPtr)
+}
+
+var xMenuModelItemsChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PositionVarp int, RemovedVarp int, AddedVarp int) {
+func xMenuModelItemsChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp int, RemovedVarp int, AddedVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MenuModel, int, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MenuModel{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp, RemovedVarp, AddedVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "items-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectItemsChangedFunc connects cb to the "items-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MenuModel) ConnectItemsChangedFunc(cb func(MenuModel, int, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "items-changed", &xMenuModelItemsChangedTrampoline, xMenuModelItemsChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -913,7 +937,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -922,27 +946,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xMenuAttributeIterGLibType, libs, "g_menu_attribute_iter_get_type")
 
-	core.PuregoSafeRegister(&xMenuAttributeIterGetName, libs, "g_menu_attribute_iter_get_name")
-	core.PuregoSafeRegister(&xMenuAttributeIterGetNext, libs, "g_menu_attribute_iter_get_next")
-	core.PuregoSafeRegister(&xMenuAttributeIterGetValue, libs, "g_menu_attribute_iter_get_value")
-	core.PuregoSafeRegister(&xMenuAttributeIterNext, libs, "g_menu_attribute_iter_next")
+	gobject.CheckClassSize("GMenuAttributeIterClass", xMenuAttributeIterGLibType, unsafe.Sizeof(MenuAttributeIterClass{}))
+
+	core.PuregoRegisterSince(&xMenuAttributeIterGetName, libs, "g_menu_attribute_iter_get_name", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAttributeIterGetNext, libs, "g_menu_attribute_iter_get_next", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAttributeIterGetValue, libs, "g_menu_attribute_iter_get_value", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuAttributeIterNext, libs, "g_menu_attribute_iter_next", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xMenuLinkIterGLibType, libs, "g_menu_link_iter_get_type")
 
-	core.PuregoSafeRegister(&xMenuLinkIterGetName, libs, "g_menu_link_iter_get_name")
-	core.PuregoSafeRegister(&xMenuLinkIterGetNext, libs, "g_menu_link_iter_get_next")
-	core.PuregoSafeRegister(&xMenuLinkIterGetValue, libs, "g_menu_link_iter_get_value")
-	core.PuregoSafeRegister(&xMenuLinkIterNext, libs, "g_menu_link_iter_next")
+	gobject.CheckClassSize("GMenuLinkIterClass", xMenuLinkIterGLibType, unsafe.Sizeof(MenuLinkIterClass{}))
+
+	core.PuregoRegisterSince(&xMenuLinkIterGetName, libs, "g_menu_link_iter_get_name", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuLinkIterGetNext, libs, "g_menu_link_iter_get_next", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuLinkIterGetValue, libs, "g_menu_link_iter_get_value", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuLinkIterNext, libs, "g_menu_link_iter_next", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xMenuModelGLibType, libs, "g_menu_model_get_type")
 
-	core.PuregoSafeRegister(&xMenuModelGetItemAttribute, libs, "g_menu_model_get_item_attribute")
-	core.PuregoSafeRegister(&xMenuModelGetItemAttributeValue, libs, "g_menu_model_get_item_attribute_value")
-	core.PuregoSafeRegister(&xMenuModelGetItemLink, libs, "g_menu_model_get_item_link")
-	core.PuregoSafeRegister(&xMenuModelGetNItems, libs, "g_menu_model_get_n_items")
-	core.PuregoSafeRegister(&xMenuModelIsMutable, libs, "g_menu_model_is_mutable")
-	core.PuregoSafeRegister(&xMenuModelItemsChanged, libs, "g_menu_model_items_changed")
-	core.PuregoSafeRegister(&xMenuModelIterateItemAttributes, libs, "g_menu_model_iterate_item_attributes")
-	core.PuregoSafeRegister(&xMenuModelIterateItemLinks, libs, "g_menu_model_iterate_item_links")
+	gobject.CheckClassSize("GMenuModelClass", xMenuModelGLibType, unsafe.Sizeof(MenuModelClass{}))
+
+	core.PuregoRegisterSince(&xMenuModelGetItemAttribute, libs, "g_menu_model_get_item_attribute", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelGetItemAttributeValue, libs, "g_menu_model_get_item_attribute_value", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelGetItemLink, libs, "g_menu_model_get_item_link", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelGetNItems, libs, "g_menu_model_get_n_items", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelIsMutable, libs, "g_menu_model_is_mutable", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelItemsChanged, libs, "g_menu_model_items_changed", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelIterateItemAttributes, libs, "g_menu_model_iterate_item_attributes", "GIO", "2.32")
+	core.PuregoRegisterSince(&xMenuModelIterateItemLinks, libs, "g_menu_model_iterate_item_links", "GIO", "2.32")
 
 }
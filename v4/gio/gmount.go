@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type MountIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xChanged uintptr
 
@@ -82,7 +82,8 @@ func (x *MountIface) OverrideChanged(cb func(Mount)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(MountVarp uintptr) {
+		x.xChanged = core.NewCallback(func(MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp})
 		})
 	}
@@ -95,44 +96,46 @@ func (x *MountIface) GetChanged() func(Mount) {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(MountVar Mount) {
 		rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideUnmounted sets the "unmounted" callback function.
-// The unmounted signal that is emitted when the #GMount have been unmounted. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The unmounted signal that is emitted when the [Mount] have been unmounted. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *MountIface) OverrideUnmounted(cb func(Mount)) {
 	if cb == nil {
 		x.xUnmounted = 0
 	} else {
-		x.xUnmounted = purego.NewCallback(func(MountVarp uintptr) {
+		x.xUnmounted = core.NewCallback(func(MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetUnmounted gets the "unmounted" callback function.
-// The unmounted signal that is emitted when the #GMount have been unmounted. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The unmounted signal that is emitted when the [Mount] have been unmounted. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *MountIface) GetUnmounted() func(Mount) {
 	if x.xUnmounted == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmounted)
+	core.RegisterFunc(&rawCallback, x.xUnmounted)
 	return func(MountVar Mount) {
 		rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideGetRoot sets the "get_root" callback function.
-// Gets a #GFile to the root directory of the #GMount.
+// Gets a [File] to the root directory of the [Mount].
 func (x *MountIface) OverrideGetRoot(cb func(Mount) *FileBase) {
 	if cb == nil {
 		x.xGetRoot = 0
 	} else {
-		x.xGetRoot = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetRoot = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -143,13 +146,13 @@ func (x *MountIface) OverrideGetRoot(cb func(Mount) *FileBase) {
 }
 
 // GetGetRoot gets the "get_root" callback function.
-// Gets a #GFile to the root directory of the #GMount.
+// Gets a [File] to the root directory of the [Mount].
 func (x *MountIface) GetGetRoot() func(Mount) *FileBase {
 	if x.xGetRoot == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetRoot)
+	core.RegisterFunc(&rawCallback, x.xGetRoot)
 	return func(MountVar Mount) *FileBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -162,37 +165,39 @@ func (x *MountIface) GetGetRoot() func(Mount) *FileBase {
 }
 
 // OverrideGetName sets the "get_name" callback function.
-// Gets a string containing the name of the #GMount.
+// Gets a string containing the name of the [Mount].
 func (x *MountIface) OverrideGetName(cb func(Mount) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(MountVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(MountVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetGetName gets the "get_name" callback function.
-// Gets a string containing the name of the #GMount.
+// Gets a string containing the name of the [Mount].
 func (x *MountIface) GetGetName() func(Mount) string {
 	if x.xGetName == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(MountVar Mount) string {
 		return rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideGetIcon sets the "get_icon" callback function.
-// Gets a #GIcon for the #GMount.
+// Gets a [Icon] for the [Mount].
 func (x *MountIface) OverrideGetIcon(cb func(Mount) *IconBase) {
 	if cb == nil {
 		x.xGetIcon = 0
 	} else {
-		x.xGetIcon = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetIcon = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -203,13 +208,13 @@ func (x *MountIface) OverrideGetIcon(cb func(Mount) *IconBase) {
 }
 
 // GetGetIcon gets the "get_icon" callback function.
-// Gets a #GIcon for the #GMount.
+// Gets a [Icon] for the [Mount].
 func (x *MountIface) GetGetIcon() func(Mount) *IconBase {
 	if x.xGetIcon == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetIcon)
+	core.RegisterFunc(&rawCallback, x.xGetIcon)
 	return func(MountVar Mount) *IconBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -222,37 +227,39 @@ func (x *MountIface) GetGetIcon() func(Mount) *IconBase {
 }
 
 // OverrideGetUuid sets the "get_uuid" callback function.
-// Gets the UUID for the #GMount. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns %NULL if there is no UUID available.
+// Gets the UUID for the [Mount]. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns nil if there is no UUID available.
 func (x *MountIface) OverrideGetUuid(cb func(Mount) string) {
 	if cb == nil {
 		x.xGetUuid = 0
 	} else {
-		x.xGetUuid = purego.NewCallback(func(MountVarp uintptr) string {
+		x.xGetUuid = core.NewCallback(func(MountVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetGetUuid gets the "get_uuid" callback function.
-// Gets the UUID for the #GMount. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns %NULL if there is no UUID available.
+// Gets the UUID for the [Mount]. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns nil if there is no UUID available.
 func (x *MountIface) GetGetUuid() func(Mount) string {
 	if x.xGetUuid == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetUuid)
+	core.RegisterFunc(&rawCallback, x.xGetUuid)
 	return func(MountVar Mount) string {
 		return rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideGetVolume sets the "get_volume" callback function.
-// Gets a #GVolume the mount is located on. Returns %NULL if the #GMount is not associated with a #GVolume.
+// Gets a [Volume] the mount is located on. Returns nil if the [Mount] is not associated with a [Volume].
 func (x *MountIface) OverrideGetVolume(cb func(Mount) *VolumeBase) {
 	if cb == nil {
 		x.xGetVolume = 0
 	} else {
-		x.xGetVolume = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetVolume = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -263,13 +270,13 @@ func (x *MountIface) OverrideGetVolume(cb func(Mount) *VolumeBase) {
 }
 
 // GetGetVolume gets the "get_volume" callback function.
-// Gets a #GVolume the mount is located on. Returns %NULL if the #GMount is not associated with a #GVolume.
+// Gets a [Volume] the mount is located on. Returns nil if the [Mount] is not associated with a [Volume].
 func (x *MountIface) GetGetVolume() func(Mount) *VolumeBase {
 	if x.xGetVolume == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetVolume)
+	core.RegisterFunc(&rawCallback, x.xGetVolume)
 	return func(MountVar Mount) *VolumeBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -282,12 +289,13 @@ func (x *MountIface) GetGetVolume() func(Mount) *VolumeBase {
 }
 
 // OverrideGetDrive sets the "get_drive" callback function.
-// Gets a #GDrive the volume of the mount is located on. Returns %NULL if the #GMount is not associated with a #GDrive or a #GVolume. This is convenience method for getting the #GVolume and using that to get the #GDrive.
+// Gets a [Drive] the volume of the mount is located on. Returns nil if the [Mount] is not associated with a [Drive] or a [Volume]. This is convenience method for getting the [Volume] and using that to get the [Drive].
 func (x *MountIface) OverrideGetDrive(cb func(Mount) *DriveBase) {
 	if cb == nil {
 		x.xGetDrive = 0
 	} else {
-		x.xGetDrive = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetDrive = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -298,13 +306,13 @@ func (x *MountIface) OverrideGetDrive(cb func(Mount) *DriveBase) {
 }
 
 // GetGetDrive gets the "get_drive" callback function.
-// Gets a #GDrive the volume of the mount is located on. Returns %NULL if the #GMount is not associated with a #GDrive or a #GVolume. This is convenience method for getting the #GVolume and using that to get the #GDrive.
+// Gets a [Drive] the volume of the mount is located on. Returns nil if the [Mount] is not associated with a [Drive] or a [Volume]. This is convenience method for getting the [Volume] and using that to get the [Drive].
 func (x *MountIface) GetGetDrive() func(Mount) *DriveBase {
 	if x.xGetDrive == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetDrive)
+	core.RegisterFunc(&rawCallback, x.xGetDrive)
 	return func(MountVar Mount) *DriveBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -317,75 +325,78 @@ func (x *MountIface) GetGetDrive() func(Mount) *DriveBase {
 }
 
 // OverrideCanUnmount sets the "can_unmount" callback function.
-// Checks if a #GMount can be unmounted.
+// Checks if a [Mount] can be unmounted.
 func (x *MountIface) OverrideCanUnmount(cb func(Mount) bool) {
 	if cb == nil {
 		x.xCanUnmount = 0
 	} else {
-		x.xCanUnmount = purego.NewCallback(func(MountVarp uintptr) bool {
+		x.xCanUnmount = core.NewCallback(func(MountVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetCanUnmount gets the "can_unmount" callback function.
-// Checks if a #GMount can be unmounted.
+// Checks if a [Mount] can be unmounted.
 func (x *MountIface) GetCanUnmount() func(Mount) bool {
 	if x.xCanUnmount == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanUnmount)
+	core.RegisterFunc(&rawCallback, x.xCanUnmount)
 	return func(MountVar Mount) bool {
 		return rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideCanEject sets the "can_eject" callback function.
-// Checks if a #GMount can be ejected.
+// Checks if a [Mount] can be ejected.
 func (x *MountIface) OverrideCanEject(cb func(Mount) bool) {
 	if cb == nil {
 		x.xCanEject = 0
 	} else {
-		x.xCanEject = purego.NewCallback(func(MountVarp uintptr) bool {
+		x.xCanEject = core.NewCallback(func(MountVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetCanEject gets the "can_eject" callback function.
-// Checks if a #GMount can be ejected.
+// Checks if a [Mount] can be ejected.
 func (x *MountIface) GetCanEject() func(Mount) bool {
 	if x.xCanEject == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanEject)
+	core.RegisterFunc(&rawCallback, x.xCanEject)
 	return func(MountVar Mount) bool {
 		return rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideUnmount sets the "unmount" callback function.
-// Starts unmounting a #GMount.
+// Starts unmounting a [Mount].
 func (x *MountIface) OverrideUnmount(cb func(Mount, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xUnmount = 0
 	} else {
-		x.xUnmount = purego.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xUnmount = core.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetUnmount gets the "unmount" callback function.
-// Starts unmounting a #GMount.
+// Starts unmounting a [Mount].
 func (x *MountIface) GetUnmount() func(Mount, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xUnmount == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmount)
+	core.RegisterFunc(&rawCallback, x.xUnmount)
 	return func(MountVar Mount, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -397,7 +408,8 @@ func (x *MountIface) OverrideUnmountFinish(cb func(Mount, AsyncResult) bool) {
 	if cb == nil {
 		x.xUnmountFinish = 0
 	} else {
-		x.xUnmountFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+		x.xUnmountFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -410,32 +422,33 @@ func (x *MountIface) GetUnmountFinish() func(Mount, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xUnmountFinish)
+	core.RegisterFunc(&rawCallback, x.xUnmountFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) bool {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideEject sets the "eject" callback function.
-// Starts ejecting a #GMount.
+// Starts ejecting a [Mount].
 func (x *MountIface) OverrideEject(cb func(Mount, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEject = 0
 	} else {
-		x.xEject = purego.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEject = core.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEject gets the "eject" callback function.
-// Starts ejecting a #GMount.
+// Starts ejecting a [Mount].
 func (x *MountIface) GetEject() func(Mount, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEject == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEject)
+	core.RegisterFunc(&rawCallback, x.xEject)
 	return func(MountVar Mount, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -447,7 +460,8 @@ func (x *MountIface) OverrideEjectFinish(cb func(Mount, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectFinish = 0
 	} else {
-		x.xEjectFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -460,32 +474,33 @@ func (x *MountIface) GetEjectFinish() func(Mount, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) bool {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideRemount sets the "remount" callback function.
-// Starts remounting a #GMount.
+// Starts remounting a [Mount].
 func (x *MountIface) OverrideRemount(cb func(Mount, MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xRemount = 0
 	} else {
-		x.xRemount = purego.NewCallback(func(MountVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xRemount = core.NewCallback(func(MountVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetRemount gets the "remount" callback function.
-// Starts remounting a #GMount.
+// Starts remounting a [Mount].
 func (x *MountIface) GetRemount() func(Mount, MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xRemount == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRemount)
+	core.RegisterFunc(&rawCallback, x.xRemount)
 	return func(MountVar Mount, FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -497,7 +512,8 @@ func (x *MountIface) OverrideRemountFinish(cb func(Mount, AsyncResult) bool) {
 	if cb == nil {
 		x.xRemountFinish = 0
 	} else {
-		x.xRemountFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+		x.xRemountFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -510,38 +526,39 @@ func (x *MountIface) GetRemountFinish() func(Mount, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xRemountFinish)
+	core.RegisterFunc(&rawCallback, x.xRemountFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) bool {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGuessContentType sets the "guess_content_type" callback function.
-// Starts guessing the type of the content of a #GMount.
+// Starts guessing the type of the content of a [Mount].
 //
-//	See g_mount_guess_content_type() for more information on content
+//	See [Mount.GuessContentType] for more information on content
 //	type guessing. This operation was added in 2.18.
 func (x *MountIface) OverrideGuessContentType(cb func(Mount, bool, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xGuessContentType = 0
 	} else {
-		x.xGuessContentType = purego.NewCallback(func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xGuessContentType = core.NewCallback(func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, ForceRescanVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetGuessContentType gets the "guess_content_type" callback function.
-// Starts guessing the type of the content of a #GMount.
+// Starts guessing the type of the content of a [Mount].
 //
-//	See g_mount_guess_content_type() for more information on content
+//	See [Mount.GuessContentType] for more information on content
 //	type guessing. This operation was added in 2.18.
 func (x *MountIface) GetGuessContentType() func(Mount, bool, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xGuessContentType == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xGuessContentType)
+	core.RegisterFunc(&rawCallback, x.xGuessContentType)
 	return func(MountVar Mount, ForceRescanVar bool, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), ForceRescanVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -553,7 +570,8 @@ func (x *MountIface) OverrideGuessContentTypeFinish(cb func(Mount, AsyncResult)
 	if cb == nil {
 		x.xGuessContentTypeFinish = 0
 	} else {
-		x.xGuessContentTypeFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) []string {
+		x.xGuessContentTypeFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -566,169 +584,176 @@ func (x *MountIface) GetGuessContentTypeFinish() func(Mount, AsyncResult) []stri
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xGuessContentTypeFinish)
+	core.RegisterFunc(&rawCallback, x.xGuessContentTypeFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) []string {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGuessContentTypeSync sets the "guess_content_type_sync" callback function.
-// Synchronous variant of @guess_content_type. Added in 2.18
+// Synchronous variant of guess_content_type. Added in 2.18
 func (x *MountIface) OverrideGuessContentTypeSync(cb func(Mount, bool, *Cancellable) []string) {
 	if cb == nil {
 		x.xGuessContentTypeSync = 0
 	} else {
-		x.xGuessContentTypeSync = purego.NewCallback(func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr) []string {
+		x.xGuessContentTypeSync = core.NewCallback(func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, ForceRescanVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetGuessContentTypeSync gets the "guess_content_type_sync" callback function.
-// Synchronous variant of @guess_content_type. Added in 2.18
+// Synchronous variant of guess_content_type. Added in 2.18
 func (x *MountIface) GetGuessContentTypeSync() func(Mount, bool, *Cancellable) []string {
 	if x.xGuessContentTypeSync == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ForceRescanVarp bool, CancellableVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xGuessContentTypeSync)
+	core.RegisterFunc(&rawCallback, x.xGuessContentTypeSync)
 	return func(MountVar Mount, ForceRescanVar bool, CancellableVar *Cancellable) []string {
 		return rawCallback(MountVar.GoPointer(), ForceRescanVar, CancellableVar.GoPointer())
 	}
 }
 
 // OverridePreUnmount sets the "pre_unmount" callback function.
-// The ::pre-unmount signal that is emitted when the #GMount will soon be emitted. If the recipient is somehow holding the mount open by keeping an open file on it it should close the file.
+// The ::pre-unmount signal that is emitted when the [Mount] will soon be emitted. If the recipient is somehow holding the mount open by keeping an open file on it it should close the file.
 func (x *MountIface) OverridePreUnmount(cb func(Mount)) {
 	if cb == nil {
 		x.xPreUnmount = 0
 	} else {
-		x.xPreUnmount = purego.NewCallback(func(MountVarp uintptr) {
+		x.xPreUnmount = core.NewCallback(func(MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetPreUnmount gets the "pre_unmount" callback function.
-// The ::pre-unmount signal that is emitted when the #GMount will soon be emitted. If the recipient is somehow holding the mount open by keeping an open file on it it should close the file.
+// The ::pre-unmount signal that is emitted when the [Mount] will soon be emitted. If the recipient is somehow holding the mount open by keeping an open file on it it should close the file.
 func (x *MountIface) GetPreUnmount() func(Mount) {
 	if x.xPreUnmount == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPreUnmount)
+	core.RegisterFunc(&rawCallback, x.xPreUnmount)
 	return func(MountVar Mount) {
 		rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideUnmountWithOperation sets the "unmount_with_operation" callback function.
-// Starts unmounting a #GMount using a #GMountOperation. Since 2.22.
+// Starts unmounting a [Mount] using a [MountOperation]. Since 2.22.
 func (x *MountIface) OverrideUnmountWithOperation(cb func(Mount, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xUnmountWithOperation = 0
 	} else {
-		x.xUnmountWithOperation = purego.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xUnmountWithOperation = core.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetUnmountWithOperation gets the "unmount_with_operation" callback function.
-// Starts unmounting a #GMount using a #GMountOperation. Since 2.22.
+// Starts unmounting a [Mount] using a [MountOperation]. Since 2.22.
 func (x *MountIface) GetUnmountWithOperation() func(Mount, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xUnmountWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmountWithOperation)
+	core.RegisterFunc(&rawCallback, x.xUnmountWithOperation)
 	return func(MountVar Mount, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideUnmountWithOperationFinish sets the "unmount_with_operation_finish" callback function.
-// Finishes an unmounting operation using a #GMountOperation. Since 2.22.
+// Finishes an unmounting operation using a [MountOperation]. Since 2.22.
 func (x *MountIface) OverrideUnmountWithOperationFinish(cb func(Mount, AsyncResult) bool) {
 	if cb == nil {
 		x.xUnmountWithOperationFinish = 0
 	} else {
-		x.xUnmountWithOperationFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+		x.xUnmountWithOperationFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetUnmountWithOperationFinish gets the "unmount_with_operation_finish" callback function.
-// Finishes an unmounting operation using a #GMountOperation. Since 2.22.
+// Finishes an unmounting operation using a [MountOperation]. Since 2.22.
 func (x *MountIface) GetUnmountWithOperationFinish() func(Mount, AsyncResult) bool {
 	if x.xUnmountWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xUnmountWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xUnmountWithOperationFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) bool {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideEjectWithOperation sets the "eject_with_operation" callback function.
-// Starts ejecting a #GMount using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Mount] using a [MountOperation]. Since 2.22.
 func (x *MountIface) OverrideEjectWithOperation(cb func(Mount, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEjectWithOperation = 0
 	} else {
-		x.xEjectWithOperation = purego.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEjectWithOperation = core.NewCallback(func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&MountBase{Ptr: MountVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEjectWithOperation gets the "eject_with_operation" callback function.
-// Starts ejecting a #GMount using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Mount] using a [MountOperation]. Since 2.22.
 func (x *MountIface) GetEjectWithOperation() func(Mount, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEjectWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperation)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperation)
 	return func(MountVar Mount, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MountVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideEjectWithOperationFinish sets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *MountIface) OverrideEjectWithOperationFinish(cb func(Mount, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectWithOperationFinish = 0
 	} else {
-		x.xEjectWithOperationFinish = purego.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectWithOperationFinish = core.NewCallback(func(MountVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetEjectWithOperationFinish gets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *MountIface) GetEjectWithOperationFinish() func(Mount, AsyncResult) bool {
 	if x.xEjectWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
 	return func(MountVar Mount, ResultVar AsyncResult) bool {
 		return rawCallback(MountVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGetDefaultLocation sets the "get_default_location" callback function.
-// Gets a #GFile indication a start location that can be use as the entry point for this mount. Since 2.24.
+// Gets a [File] indication a start location that can be use as the entry point for this mount. Since 2.24.
 func (x *MountIface) OverrideGetDefaultLocation(cb func(Mount) *FileBase) {
 	if cb == nil {
 		x.xGetDefaultLocation = 0
 	} else {
-		x.xGetDefaultLocation = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetDefaultLocation = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -739,13 +764,13 @@ func (x *MountIface) OverrideGetDefaultLocation(cb func(Mount) *FileBase) {
 }
 
 // GetGetDefaultLocation gets the "get_default_location" callback function.
-// Gets a #GFile indication a start location that can be use as the entry point for this mount. Since 2.24.
+// Gets a [File] indication a start location that can be use as the entry point for this mount. Since 2.24.
 func (x *MountIface) GetGetDefaultLocation() func(Mount) *FileBase {
 	if x.xGetDefaultLocation == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetDefaultLocation)
+	core.RegisterFunc(&rawCallback, x.xGetDefaultLocation)
 	return func(MountVar Mount) *FileBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -758,37 +783,39 @@ func (x *MountIface) GetGetDefaultLocation() func(Mount) *FileBase {
 }
 
 // OverrideGetSortKey sets the "get_sort_key" callback function.
-// Gets a key used for sorting #GMount instance or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Mount] instance or nil if no such key exists. Since 2.32.
 func (x *MountIface) OverrideGetSortKey(cb func(Mount) string) {
 	if cb == nil {
 		x.xGetSortKey = 0
 	} else {
-		x.xGetSortKey = purego.NewCallback(func(MountVarp uintptr) string {
+		x.xGetSortKey = core.NewCallback(func(MountVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&MountBase{Ptr: MountVarp})
 		})
 	}
 }
 
 // GetGetSortKey gets the "get_sort_key" callback function.
-// Gets a key used for sorting #GMount instance or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Mount] instance or nil if no such key exists. Since 2.32.
 func (x *MountIface) GetGetSortKey() func(Mount) string {
 	if x.xGetSortKey == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetSortKey)
+	core.RegisterFunc(&rawCallback, x.xGetSortKey)
 	return func(MountVar Mount) string {
 		return rawCallback(MountVar.GoPointer())
 	}
 }
 
 // OverrideGetSymbolicIcon sets the "get_symbolic_icon" callback function.
-// Gets a symbolic #GIcon for the #GMount. Since 2.34.
+// Gets a symbolic [Icon] for the [Mount]. Since 2.34.
 func (x *MountIface) OverrideGetSymbolicIcon(cb func(Mount) *IconBase) {
 	if cb == nil {
 		x.xGetSymbolicIcon = 0
 	} else {
-		x.xGetSymbolicIcon = purego.NewCallback(func(MountVarp uintptr) uintptr {
+		x.xGetSymbolicIcon = core.NewCallback(func(MountVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp})
 			if ret == nil {
 				return 0
@@ -799,13 +826,13 @@ func (x *MountIface) OverrideGetSymbolicIcon(cb func(Mount) *IconBase) {
 }
 
 // GetGetSymbolicIcon gets the "get_symbolic_icon" callback function.
-// Gets a symbolic #GIcon for the #GMount. Since 2.34.
+// Gets a symbolic [Icon] for the [Mount]. Since 2.34.
 func (x *MountIface) GetGetSymbolicIcon() func(Mount) *IconBase {
 	if x.xGetSymbolicIcon == 0 {
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
+	core.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
 	return func(MountVar Mount) *IconBase {
 		rawRet := rawCallback(MountVar.GoPointer())
 		if rawRet == 0 {
@@ -824,23 +851,23 @@ func (x *MountIface) GetGetSymbolicIcon() func(Mount) *IconBase {
 // quotes because it’s not the same as a UNIX mount, it might be a GVFS
 // mount, but you can still access the files on it if you use GIO.
 //
-// A `GMount` might be associated with a [iface@Gio.Volume] (such as a USB flash
+// A `GMount` might be associated with a [Volume] (such as a USB flash
 // drive) which hosts it.
 //
 // Unmounting a `GMount` instance is an asynchronous operation. For
-// more information about asynchronous operations, see [iface@Gio.AsyncResult]
-// and [class@Gio.Task]. To unmount a `GMount` instance, first call
-// [method@Gio.Mount.unmount_with_operation] with (at least) the `GMount`
-// instance and a [type@Gio.AsyncReadyCallback].  The callback will be fired
+// more information about asynchronous operations, see [AsyncResult]
+// and [Task]. To unmount a `GMount` instance, first call
+// [Mount.UnmountWithOperation] with (at least) the `GMount`
+// instance and a [AsyncReadyCallback].  The callback will be fired
 // when the operation has resolved (either with success or failure), and a
-// [iface@Gio.AsyncResult] structure will be passed to the callback.  That
-// callback should then call [method@Gio.Mount.unmount_with_operation_finish]
-// with the `GMount` and the [iface@Gio.AsyncResult] data to see if the
+// [AsyncResult] structure will be passed to the callback.  That
+// callback should then call [Mount.UnmountWithOperationFinish]
+// with the `GMount` and the [AsyncResult] data to see if the
 // operation was completed successfully.  If an `error` is present when
-// [method@Gio.Mount.unmount_with_operation_finish] is called, then it will be
+// [Mount.UnmountWithOperationFinish] is called, then it will be
 // filled with any error information.
 //
-// Note, when [porting from GnomeVFS](migrating-gnome-vfs.html), `GMount` is the
+// Note, when porting from GnomeVFS, `GMount` is the
 // moral equivalent of `GnomeVFSVolume`.
 type Mount interface {
 	GoPointer() uintptr
@@ -895,14 +922,244 @@ func (x *MountBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Checks if @mount can be ejected.
+// ImplementMount adds the Mount interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Changed()
+//   - Unmounted()
+//   - GetRoot() *FileBase
+//   - GetName() string
+//   - GetIcon() *IconBase
+//   - GetUuid() string
+//   - GetVolume() *VolumeBase
+//   - GetDrive() *DriveBase
+//   - CanUnmount() bool
+//   - CanEject() bool
+//   - Unmount(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - UnmountFinish(AsyncResult) bool
+//   - Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectFinish(AsyncResult) bool
+//   - Remount(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - RemountFinish(AsyncResult) bool
+//   - GuessContentType(bool, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - GuessContentTypeFinish(AsyncResult) []string
+//   - GuessContentTypeSync(bool, *Cancellable) []string
+//   - PreUnmount()
+//   - UnmountWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - UnmountWithOperationFinish(AsyncResult) bool
+//   - EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectWithOperationFinish(AsyncResult) bool
+//   - GetDefaultLocation() *FileBase
+//   - GetSortKey() string
+//   - GetSymbolicIcon() *IconBase
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see MountIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementMount[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, MountGLibType(), func(iface *MountIface) {
+		if _, ok := any(zero).(interface{ Changed() }); ok {
+			iface.OverrideChanged(func(self Mount) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Changed() })
+				impl.Changed()
+			})
+		}
+		if _, ok := any(zero).(interface{ Unmounted() }); ok {
+			iface.OverrideUnmounted(func(self Mount) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Unmounted() })
+				impl.Unmounted()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetRoot() *FileBase }); ok {
+			iface.OverrideGetRoot(func(self Mount) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetRoot() *FileBase })
+				return impl.GetRoot()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetName() string }); ok {
+			iface.OverrideGetName(func(self Mount) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetName() string })
+				return impl.GetName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIcon() *IconBase }); ok {
+			iface.OverrideGetIcon(func(self Mount) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIcon() *IconBase })
+				return impl.GetIcon()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetUuid() string }); ok {
+			iface.OverrideGetUuid(func(self Mount) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetUuid() string })
+				return impl.GetUuid()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetVolume() *VolumeBase }); ok {
+			iface.OverrideGetVolume(func(self Mount) *VolumeBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetVolume() *VolumeBase })
+				return impl.GetVolume()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDrive() *DriveBase }); ok {
+			iface.OverrideGetDrive(func(self Mount) *DriveBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDrive() *DriveBase })
+				return impl.GetDrive()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanUnmount() bool }); ok {
+			iface.OverrideCanUnmount(func(self Mount) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanUnmount() bool })
+				return impl.CanUnmount()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanEject() bool }); ok {
+			iface.OverrideCanEject(func(self Mount) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanEject() bool })
+				return impl.CanEject()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Unmount(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideUnmount(func(self Mount, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Unmount(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Unmount(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnmountFinish(AsyncResult) bool }); ok {
+			iface.OverrideUnmountFinish(func(self Mount, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnmountFinish(AsyncResult) bool })
+				return impl.UnmountFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEject(func(self Mount, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Eject(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectFinish(func(self Mount, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectFinish(AsyncResult) bool })
+				return impl.EjectFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Remount(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideRemount(func(self Mount, arg0 MountMountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Remount(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Remount(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ RemountFinish(AsyncResult) bool }); ok {
+			iface.OverrideRemountFinish(func(self Mount, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RemountFinish(AsyncResult) bool })
+				return impl.RemountFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GuessContentType(bool, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideGuessContentType(func(self Mount, arg0 bool, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GuessContentType(bool, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.GuessContentType(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ GuessContentTypeFinish(AsyncResult) []string }); ok {
+			iface.OverrideGuessContentTypeFinish(func(self Mount, arg0 AsyncResult) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GuessContentTypeFinish(AsyncResult) []string })
+				return impl.GuessContentTypeFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GuessContentTypeSync(bool, *Cancellable) []string
+		}); ok {
+			iface.OverrideGuessContentTypeSync(func(self Mount, arg0 bool, arg1 *Cancellable) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GuessContentTypeSync(bool, *Cancellable) []string
+				})
+				return impl.GuessContentTypeSync(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ PreUnmount() }); ok {
+			iface.OverridePreUnmount(func(self Mount) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PreUnmount() })
+				impl.PreUnmount()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			UnmountWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideUnmountWithOperation(func(self Mount, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					UnmountWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.UnmountWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnmountWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideUnmountWithOperationFinish(func(self Mount, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnmountWithOperationFinish(AsyncResult) bool })
+				return impl.UnmountWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEjectWithOperation(func(self Mount, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EjectWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectWithOperationFinish(func(self Mount, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectWithOperationFinish(AsyncResult) bool })
+				return impl.EjectWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDefaultLocation() *FileBase }); ok {
+			iface.OverrideGetDefaultLocation(func(self Mount) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDefaultLocation() *FileBase })
+				return impl.GetDefaultLocation()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSortKey() string }); ok {
+			iface.OverrideGetSortKey(func(self Mount) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSortKey() string })
+				return impl.GetSortKey()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSymbolicIcon() *IconBase }); ok {
+			iface.OverrideGetSymbolicIcon(func(self Mount) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSymbolicIcon() *IconBase })
+				return impl.GetSymbolicIcon()
+			})
+		}
+	})
+}
+
+// Checks if mount can be ejected.
 func (x *MountBase) CanEject() bool {
 
 	cret := XGMountCanEject(x.GoPointer())
 	return cret
 }
 
-// Checks if @mount can be unmounted.
+// Checks if mount can be unmounted.
 func (x *MountBase) CanUnmount() bool {
 
 	cret := XGMountCanUnmount(x.GoPointer())
@@ -910,51 +1167,87 @@ func (x *MountBase) CanUnmount() bool {
 }
 
 // Ejects a mount. This is an asynchronous operation, and is
-// finished by calling g_mount_eject_finish() with the @mount
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Mount.EjectFinish] with the mount
+// and [AsyncResult] data returned in the callback.
+//
+// Deprecated: since 2.22. Use [Mount.EjectWithOperation] instead.
 func (x *MountBase) Eject(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a mount. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
+//
+// Deprecated: since 2.22. Use [Mount.EjectWithOperationFinish] instead.
 func (x *MountBase) EjectFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGMountEjectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Ejects a mount. This is an asynchronous operation, and is
-// finished by calling g_mount_eject_with_operation_finish() with the @mount
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Mount.EjectWithOperationFinish] with the mount
+// and [AsyncResult] data returned in the callback.
 func (x *MountBase) EjectWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a mount. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 func (x *MountBase) EjectWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGMountEjectWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Gets the default location of @mount. The default location of the given
-// @mount is a path that reflects the main entry point for the user (e.g.
+// Gets the default location of mount. The default location of the given
+// mount is a path that reflects the main entry point for the user (e.g.
 // the home directory, or the root of the volume).
 func (x *MountBase) GetDefaultLocation() *FileBase {
 	var cls *FileBase
@@ -969,10 +1262,10 @@ func (x *MountBase) GetDefaultLocation() *FileBase {
 	return cls
 }
 
-// Gets the drive for the @mount.
+// Gets the drive for the mount.
 //
-// This is a convenience method for getting the #GVolume and then
-// using that object to get the #GDrive.
+// This is a convenience method for getting the [Volume] and then
+// using that object to get the [Drive].
 func (x *MountBase) GetDrive() *DriveBase {
 	var cls *DriveBase
 
@@ -986,7 +1279,7 @@ func (x *MountBase) GetDrive() *DriveBase {
 	return cls
 }
 
-// Gets the icon for @mount.
+// Gets the icon for mount.
 func (x *MountBase) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -1000,14 +1293,14 @@ func (x *MountBase) GetIcon() *IconBase {
 	return cls
 }
 
-// Gets the name of @mount.
+// Gets the name of mount.
 func (x *MountBase) GetName() string {
 
 	cret := XGMountGetName(x.GoPointer())
 	return cret
 }
 
-// Gets the root directory on @mount.
+// Gets the root directory on mount.
 func (x *MountBase) GetRoot() *FileBase {
 	var cls *FileBase
 
@@ -1021,14 +1314,14 @@ func (x *MountBase) GetRoot() *FileBase {
 	return cls
 }
 
-// Gets the sort key for @mount, if any.
+// Gets the sort key for mount, if any.
 func (x *MountBase) GetSortKey() string {
 
 	cret := XGMountGetSortKey(x.GoPointer())
 	return cret
 }
 
-// Gets the symbolic icon for @mount.
+// Gets the symbolic icon for mount.
 func (x *MountBase) GetSymbolicIcon() *IconBase {
 	var cls *IconBase
 
@@ -1042,9 +1335,9 @@ func (x *MountBase) GetSymbolicIcon() *IconBase {
 	return cls
 }
 
-// Gets the UUID for the @mount. The reference is typically based on
+// Gets the UUID for the mount. The reference is typically based on
 // the file system UUID for the mount in question and should be
-// considered an opaque string. Returns %NULL if there is no UUID
+// considered an opaque string. Returns nil if there is no UUID
 // available.
 func (x *MountBase) GetUuid() string {
 
@@ -1052,7 +1345,7 @@ func (x *MountBase) GetUuid() string {
 	return cret
 }
 
-// Gets the volume for the @mount.
+// Gets the volume for the mount.
 func (x *MountBase) GetVolume() *VolumeBase {
 	var cls *VolumeBase
 
@@ -1066,82 +1359,102 @@ func (x *MountBase) GetVolume() *VolumeBase {
 	return cls
 }
 
-// Tries to guess the type of content stored on @mount. Returns one or
+// Tries to guess the type of content stored on mount. Returns one or
 // more textual identifiers of well-known content types (typically
 // prefixed with "x-content/"), e.g. x-content/image-dcf for camera
 // memory cards. See the
-// [shared-mime-info](http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec)
+// [shared-mime-info]
 // specification for more on x-content types.
 //
 // This is an asynchronous operation (see
-// g_mount_guess_content_type_sync() for the synchronous version), and
-// is finished by calling g_mount_guess_content_type_finish() with the
-// @mount and #GAsyncResult data returned in the @callback.
+// [Mount.GuessContentTypeSync] for the synchronous version), and
+// is finished by calling [Mount.GuessContentTypeFinish] with the
+// mount and [AsyncResult] data returned in the callback.
+//
+// [shared-mime-info]: http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec
 func (x *MountBase) GuessContentType(ForceRescanVar bool, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountGuessContentType(x.GoPointer(), ForceRescanVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountGuessContentType(x.GoPointer(), ForceRescanVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// Finishes guessing content types of @mount. If any errors occurred
-// during the operation, @error will be set to contain the errors and
-// %FALSE will be returned. In particular, you may get an
-// %G_IO_ERROR_NOT_SUPPORTED if the mount does not support content
+// Finishes guessing content types of mount. If any errors occurred
+// during the operation, error will be set to contain the errors and
+// false will be returned. In particular, you may get an
+// [GIoErrorNotSupportedValue] if the mount does not support content
 // guessing.
 func (x *MountBase) GuessContentTypeFinish(ResultVar AsyncResult) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := XGMountGuessContentTypeFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
-// Tries to guess the type of content stored on @mount. Returns one or
+// Tries to guess the type of content stored on mount. Returns one or
 // more textual identifiers of well-known content types (typically
 // prefixed with "x-content/"), e.g. x-content/image-dcf for camera
 // memory cards. See the
-// [shared-mime-info](http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec)
+// [shared-mime-info]
 // specification for more on x-content types.
 //
 // This is a synchronous operation and as such may block doing IO;
-// see g_mount_guess_content_type() for the asynchronous version.
+// see [Mount.GuessContentType] for the asynchronous version.
+//
+// [shared-mime-info]: http://www.freedesktop.org/wiki/Specifications/shared-mime-info-spec
 func (x *MountBase) GuessContentTypeSync(ForceRescanVar bool, CancellableVar *Cancellable) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := XGMountGuessContentTypeSync(x.GoPointer(), ForceRescanVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
-// Determines if @mount is shadowed. Applications or libraries should
-// avoid displaying @mount in the user interface if it is shadowed.
+// Determines if mount is shadowed. Applications or libraries should
+// avoid displaying mount in the user interface if it is shadowed.
 //
 // A mount is said to be shadowed if there exists one or more user
-// visible objects (currently #GMount objects) with a root that is
-// inside the root of @mount.
+// visible objects (currently [Mount] objects) with a root that is
+// inside the root of mount.
 //
 // One application of shadow mounts is when exposing a single file
 // system that is used to address several logical volumes. In this
-// situation, a #GVolumeMonitor implementation would create two
-// #GVolume objects (for example, one for the camera functionality of
+// situation, a [VolumeMonitor] implementation would create two
+// [Volume] objects (for example, one for the camera functionality of
 // the device and one for a SD card reader on the device) with
 // activation URIs `gphoto2://[usb:001,002]/store1/`
 // and `gphoto2://[usb:001,002]/store2/`. When the
 // underlying mount (with root
 // `gphoto2://[usb:001,002]/`) is mounted, said
-// #GVolumeMonitor implementation would create two #GMount objects
+// [VolumeMonitor] implementation would create two [Mount] objects
 // (each with their root matching the corresponding volume activation
 // root) that would shadow the original mount.
 //
 // The proxy monitor in GVfs 2.26 and later, automatically creates and
 // manage shadow mounts (and shadows the underlying mount) if the
-// activation root on a #GVolume is set.
+// activation root on a [Volume] is set.
 func (x *MountBase) IsShadowed() bool {
 
 	cret := XGMountIsShadowed(x.GoPointer())
@@ -1149,8 +1462,8 @@ func (x *MountBase) IsShadowed() bool {
 }
 
 // Remounts a mount. This is an asynchronous operation, and is
-// finished by calling g_mount_remount_finish() with the @mount
-// and #GAsyncResults data returned in the @callback.
+// finished by calling [Mount.RemountFinish] with the mount
+// and GAsyncResults data returned in the callback.
 //
 // Remounting is useful when some setting affecting the operation
 // of the volume has been changed, as these may need a remount to
@@ -1159,27 +1472,43 @@ func (x *MountBase) IsShadowed() bool {
 // unmounted.
 func (x *MountBase) Remount(FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountRemount(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountRemount(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes remounting a mount. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 func (x *MountBase) RemountFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGMountRemountFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Increments the shadow count on @mount. Usually used by
-// #GVolumeMonitor implementations when creating a shadow mount for
-// @mount, see g_mount_is_shadowed() for more information. The caller
-// will need to emit the #GMount::changed signal on @mount manually.
+// Increments the shadow count on mount. Usually used by
+// [VolumeMonitor] implementations when creating a shadow mount for
+// mount, see [Mount.IsShadowed] for more information. The caller
+// will need to emit the GMount::changed signal on mount manually.
 func (x *MountBase) Shadow() {
 
 	XGMountShadow(x.GoPointer())
@@ -1187,53 +1516,89 @@ func (x *MountBase) Shadow() {
 }
 
 // Unmounts a mount. This is an asynchronous operation, and is
-// finished by calling g_mount_unmount_finish() with the @mount
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Mount.UnmountFinish] with the mount
+// and [AsyncResult] data returned in the callback.
+//
+// Deprecated: since 2.22. Use [Mount.UnmountWithOperation] instead.
 func (x *MountBase) Unmount(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountUnmount(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountUnmount(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes unmounting a mount. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
+//
+// Deprecated: since 2.22. Use [Mount.UnmountWithOperationFinish] instead.
 func (x *MountBase) UnmountFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGMountUnmountFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Unmounts a mount. This is an asynchronous operation, and is
-// finished by calling g_mount_unmount_with_operation_finish() with the @mount
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Mount.UnmountWithOperationFinish] with the mount
+// and [AsyncResult] data returned in the callback.
 func (x *MountBase) UnmountWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGMountUnmountWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGMountUnmountWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes unmounting a mount. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 func (x *MountBase) UnmountWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGMountUnmountWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Decrements the shadow count on @mount. Usually used by
-// #GVolumeMonitor implementations when destroying a shadow mount for
-// @mount, see g_mount_is_shadowed() for more information. The caller
-// will need to emit the #GMount::changed signal on @mount manually.
+// Decrements the shadow count on mount. Usually used by
+// [VolumeMonitor] implementations when destroying a shadow mount for
+// mount, see [Mount.IsShadowed] for more information. The caller
+// will need to emit the GMount::changed signal on mount manually.
 func (x *MountBase) Unshadow() {
 
 	XGMountUnshadow(x.GoPointer())
@@ -1256,8 +1621,8 @@ var XGMountGetSymbolicIcon func(uintptr) uintptr
 var XGMountGetUuid func(uintptr) string
 var XGMountGetVolume func(uintptr) uintptr
 var XGMountGuessContentType func(uintptr, bool, uintptr, uintptr, uintptr)
-var XGMountGuessContentTypeFinish func(uintptr, uintptr, **glib.Error) []string
-var XGMountGuessContentTypeSync func(uintptr, bool, uintptr, **glib.Error) []string
+var XGMountGuessContentTypeFinish func(uintptr, uintptr, **glib.Error) uintptr
+var XGMountGuessContentTypeSync func(uintptr, bool, uintptr, **glib.Error) uintptr
 var XGMountIsShadowed func(uintptr) bool
 var XGMountRemount func(uintptr, MountMountFlags, uintptr, uintptr, uintptr, uintptr)
 var XGMountRemountFinish func(uintptr, uintptr, **glib.Error) bool
@@ -1273,7 +1638,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1282,32 +1647,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xMountGLibType, libs, "g_mount_get_type")
 
-	core.PuregoSafeRegister(&XGMountCanEject, libs, "g_mount_can_eject")
-	core.PuregoSafeRegister(&XGMountCanUnmount, libs, "g_mount_can_unmount")
-	core.PuregoSafeRegister(&XGMountEject, libs, "g_mount_eject")
-	core.PuregoSafeRegister(&XGMountEjectFinish, libs, "g_mount_eject_finish")
-	core.PuregoSafeRegister(&XGMountEjectWithOperation, libs, "g_mount_eject_with_operation")
-	core.PuregoSafeRegister(&XGMountEjectWithOperationFinish, libs, "g_mount_eject_with_operation_finish")
-	core.PuregoSafeRegister(&XGMountGetDefaultLocation, libs, "g_mount_get_default_location")
-	core.PuregoSafeRegister(&XGMountGetDrive, libs, "g_mount_get_drive")
-	core.PuregoSafeRegister(&XGMountGetIcon, libs, "g_mount_get_icon")
-	core.PuregoSafeRegister(&XGMountGetName, libs, "g_mount_get_name")
-	core.PuregoSafeRegister(&XGMountGetRoot, libs, "g_mount_get_root")
-	core.PuregoSafeRegister(&XGMountGetSortKey, libs, "g_mount_get_sort_key")
-	core.PuregoSafeRegister(&XGMountGetSymbolicIcon, libs, "g_mount_get_symbolic_icon")
-	core.PuregoSafeRegister(&XGMountGetUuid, libs, "g_mount_get_uuid")
-	core.PuregoSafeRegister(&XGMountGetVolume, libs, "g_mount_get_volume")
-	core.PuregoSafeRegister(&XGMountGuessContentType, libs, "g_mount_guess_content_type")
-	core.PuregoSafeRegister(&XGMountGuessContentTypeFinish, libs, "g_mount_guess_content_type_finish")
-	core.PuregoSafeRegister(&XGMountGuessContentTypeSync, libs, "g_mount_guess_content_type_sync")
-	core.PuregoSafeRegister(&XGMountIsShadowed, libs, "g_mount_is_shadowed")
-	core.PuregoSafeRegister(&XGMountRemount, libs, "g_mount_remount")
-	core.PuregoSafeRegister(&XGMountRemountFinish, libs, "g_mount_remount_finish")
-	core.PuregoSafeRegister(&XGMountShadow, libs, "g_mount_shadow")
-	core.PuregoSafeRegister(&XGMountUnmount, libs, "g_mount_unmount")
-	core.PuregoSafeRegister(&XGMountUnmountFinish, libs, "g_mount_unmount_finish")
-	core.PuregoSafeRegister(&XGMountUnmountWithOperation, libs, "g_mount_unmount_with_operation")
-	core.PuregoSafeRegister(&XGMountUnmountWithOperationFinish, libs, "g_mount_unmount_with_operation_finish")
-	core.PuregoSafeRegister(&XGMountUnshadow, libs, "g_mount_unshadow")
+	gobject.CheckClassSize("GMountIface", xMountGLibType, unsafe.Sizeof(MountIface{}))
+
+	core.PuregoRegisterSince(&XGMountCanEject, libs, "g_mount_can_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGMountCanUnmount, libs, "g_mount_can_unmount", "GIO", "")
+	core.PuregoRegisterSince(&XGMountEject, libs, "g_mount_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGMountEjectFinish, libs, "g_mount_eject_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGMountEjectWithOperation, libs, "g_mount_eject_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGMountEjectWithOperationFinish, libs, "g_mount_eject_with_operation_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGMountGetDefaultLocation, libs, "g_mount_get_default_location", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetDrive, libs, "g_mount_get_drive", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetIcon, libs, "g_mount_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetName, libs, "g_mount_get_name", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetRoot, libs, "g_mount_get_root", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetSortKey, libs, "g_mount_get_sort_key", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGMountGetSymbolicIcon, libs, "g_mount_get_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGMountGetUuid, libs, "g_mount_get_uuid", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGetVolume, libs, "g_mount_get_volume", "GIO", "")
+	core.PuregoRegisterSince(&XGMountGuessContentType, libs, "g_mount_guess_content_type", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGMountGuessContentTypeFinish, libs, "g_mount_guess_content_type_finish", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGMountGuessContentTypeSync, libs, "g_mount_guess_content_type_sync", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGMountIsShadowed, libs, "g_mount_is_shadowed", "GIO", "2.20")
+	core.PuregoRegisterSince(&XGMountRemount, libs, "g_mount_remount", "GIO", "")
+	core.PuregoRegisterSince(&XGMountRemountFinish, libs, "g_mount_remount_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGMountShadow, libs, "g_mount_shadow", "GIO", "2.20")
+	core.PuregoRegisterSince(&XGMountUnmount, libs, "g_mount_unmount", "GIO", "")
+	core.PuregoRegisterSince(&XGMountUnmountFinish, libs, "g_mount_unmount_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGMountUnmountWithOperation, libs, "g_mount_unmount_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGMountUnmountWithOperationFinish, libs, "g_mount_unmount_with_operation_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGMountUnshadow, libs, "g_mount_unshadow", "GIO", "2.20")
 
 }
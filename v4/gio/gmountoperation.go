@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -57,7 +56,8 @@ func (x *MountOperationClass) OverrideAskPassword(cb func(*MountOperation, strin
 	if cb == nil {
 		x.xAskPassword = 0
 	} else {
-		x.xAskPassword = purego.NewCallback(func(OpVarp uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags) {
+		x.xAskPassword = core.NewCallback(func(OpVarp uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp), MessageVarp, DefaultUserVarp, DefaultDomainVarp, FlagsVarp)
 		})
 	}
@@ -69,7 +69,7 @@ func (x *MountOperationClass) GetAskPassword() func(*MountOperation, string, str
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags)
-	purego.RegisterFunc(&rawCallback, x.xAskPassword)
+	core.RegisterFunc(&rawCallback, x.xAskPassword)
 	return func(OpVar *MountOperation, MessageVar string, DefaultUserVar string, DefaultDomainVar string, FlagsVar AskPasswordFlags) {
 		rawCallback(OpVar.GoPointer(), MessageVar, DefaultUserVar, DefaultDomainVar, FlagsVar)
 	}
@@ -80,7 +80,8 @@ func (x *MountOperationClass) OverrideAskQuestion(cb func(*MountOperation, strin
 	if cb == nil {
 		x.xAskQuestion = 0
 	} else {
-		x.xAskQuestion = purego.NewCallback(func(OpVarp uintptr, MessageVarp string, ChoicesVarp []string) {
+		x.xAskQuestion = core.NewCallback(func(OpVarp uintptr, MessageVarp string, ChoicesVarp []string) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp), MessageVarp, ChoicesVarp)
 		})
 	}
@@ -92,7 +93,7 @@ func (x *MountOperationClass) GetAskQuestion() func(*MountOperation, string, []s
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr, MessageVarp string, ChoicesVarp []string)
-	purego.RegisterFunc(&rawCallback, x.xAskQuestion)
+	core.RegisterFunc(&rawCallback, x.xAskQuestion)
 	return func(OpVar *MountOperation, MessageVar string, ChoicesVar []string) {
 		rawCallback(OpVar.GoPointer(), MessageVar, ChoicesVar)
 	}
@@ -103,7 +104,8 @@ func (x *MountOperationClass) OverrideReply(cb func(*MountOperation, MountOperat
 	if cb == nil {
 		x.xReply = 0
 	} else {
-		x.xReply = purego.NewCallback(func(OpVarp uintptr, ResultVarp MountOperationResult) {
+		x.xReply = core.NewCallback(func(OpVarp uintptr, ResultVarp MountOperationResult) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp), ResultVarp)
 		})
 	}
@@ -115,7 +117,7 @@ func (x *MountOperationClass) GetReply() func(*MountOperation, MountOperationRes
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr, ResultVarp MountOperationResult)
-	purego.RegisterFunc(&rawCallback, x.xReply)
+	core.RegisterFunc(&rawCallback, x.xReply)
 	return func(OpVar *MountOperation, ResultVar MountOperationResult) {
 		rawCallback(OpVar.GoPointer(), ResultVar)
 	}
@@ -126,7 +128,8 @@ func (x *MountOperationClass) OverrideAborted(cb func(*MountOperation)) {
 	if cb == nil {
 		x.xAborted = 0
 	} else {
-		x.xAborted = purego.NewCallback(func(OpVarp uintptr) {
+		x.xAborted = core.NewCallback(func(OpVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp))
 		})
 	}
@@ -138,7 +141,7 @@ func (x *MountOperationClass) GetAborted() func(*MountOperation) {
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAborted)
+	core.RegisterFunc(&rawCallback, x.xAborted)
 	return func(OpVar *MountOperation) {
 		rawCallback(OpVar.GoPointer())
 	}
@@ -149,7 +152,8 @@ func (x *MountOperationClass) OverrideShowProcesses(cb func(*MountOperation, str
 	if cb == nil {
 		x.xShowProcesses = 0
 	} else {
-		x.xShowProcesses = purego.NewCallback(func(OpVarp uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string) {
+		x.xShowProcesses = core.NewCallback(func(OpVarp uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp), MessageVarp, ProcessesVarp, ChoicesVarp)
 		})
 	}
@@ -161,7 +165,7 @@ func (x *MountOperationClass) GetShowProcesses() func(*MountOperation, string, [
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string)
-	purego.RegisterFunc(&rawCallback, x.xShowProcesses)
+	core.RegisterFunc(&rawCallback, x.xShowProcesses)
 	return func(OpVar *MountOperation, MessageVar string, ProcessesVar []glib.Pid, ChoicesVar []string) {
 		rawCallback(OpVar.GoPointer(), MessageVar, ProcessesVar, ChoicesVar)
 	}
@@ -172,7 +176,8 @@ func (x *MountOperationClass) OverrideShowUnmountProgress(cb func(*MountOperatio
 	if cb == nil {
 		x.xShowUnmountProgress = 0
 	} else {
-		x.xShowUnmountProgress = purego.NewCallback(func(OpVarp uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64) {
+		x.xShowUnmountProgress = core.NewCallback(func(OpVarp uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64) {
+			defer core.RecoverPanic()
 			cb(MountOperationNewFromInternalPtr(OpVarp), MessageVarp, TimeLeftVarp, BytesLeftVarp)
 		})
 	}
@@ -184,7 +189,7 @@ func (x *MountOperationClass) GetShowUnmountProgress() func(*MountOperation, str
 		return nil
 	}
 	var rawCallback func(OpVarp uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64)
-	purego.RegisterFunc(&rawCallback, x.xShowUnmountProgress)
+	core.RegisterFunc(&rawCallback, x.xShowUnmountProgress)
 	return func(OpVar *MountOperation, MessageVar string, TimeLeftVar int64, BytesLeftVar int64) {
 		rawCallback(OpVar.GoPointer(), MessageVar, TimeLeftVar, BytesLeftVar)
 	}
@@ -195,7 +200,8 @@ func (x *MountOperationClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -207,7 +213,7 @@ func (x *MountOperationClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -218,7 +224,8 @@ func (x *MountOperationClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -230,7 +237,7 @@ func (x *MountOperationClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -241,7 +248,8 @@ func (x *MountOperationClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -253,7 +261,7 @@ func (x *MountOperationClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -264,7 +272,8 @@ func (x *MountOperationClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -276,7 +285,7 @@ func (x *MountOperationClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -287,7 +296,8 @@ func (x *MountOperationClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -299,7 +309,7 @@ func (x *MountOperationClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -310,7 +320,8 @@ func (x *MountOperationClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -322,7 +333,7 @@ func (x *MountOperationClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -333,7 +344,8 @@ func (x *MountOperationClass) OverrideGReserved7(cb func()) {
 	if cb == nil {
 		x.xGReserved7 = 0
 	} else {
-		x.xGReserved7 = purego.NewCallback(func() {
+		x.xGReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -345,7 +357,7 @@ func (x *MountOperationClass) GetGReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved7)
+	core.RegisterFunc(&rawCallback, x.xGReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -356,7 +368,8 @@ func (x *MountOperationClass) OverrideGReserved8(cb func()) {
 	if cb == nil {
 		x.xGReserved8 = 0
 	} else {
-		x.xGReserved8 = purego.NewCallback(func() {
+		x.xGReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -368,7 +381,7 @@ func (x *MountOperationClass) GetGReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved8)
+	core.RegisterFunc(&rawCallback, x.xGReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -379,7 +392,8 @@ func (x *MountOperationClass) OverrideGReserved9(cb func()) {
 	if cb == nil {
 		x.xGReserved9 = 0
 	} else {
-		x.xGReserved9 = purego.NewCallback(func() {
+		x.xGReserved9 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -391,7 +405,7 @@ func (x *MountOperationClass) GetGReserved9() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved9)
+	core.RegisterFunc(&rawCallback, x.xGReserved9)
 	return func() {
 		rawCallback()
 	}
@@ -411,22 +425,26 @@ func (x *MountOperationPrivate) GoPointer() uintptr {
 // also be used to ask the user questions or show a list of applications
 // preventing unmount or eject operations from completing.
 //
-// Note that `GMountOperation` is used for more than just [iface@Gio.Mount]
-// objects – for example it is also used in [method@Gio.Drive.start] and
-// [method@Gio.Drive.stop].
+// Note that `GMountOperation` is used for more than just [Mount]
+// objects – for example it is also used in [Drive.Start] and
+// [Drive.Stop].
 //
 // Users should instantiate a subclass of this that implements all the
 // various callbacks to show the required dialogs, such as
-// [`GtkMountOperation`](https://docs.gtk.org/gtk4/class.MountOperation.html).
+// [GtkMountOperation].
 // If no user interaction is desired (for example when automounting
 // filesystems at login time), usually `NULL` can be passed, see each method
 // taking a `GMountOperation` for details.
 //
 // Throughout the API, the term ‘TCRYPT’ is used to mean ‘compatible with TrueCrypt and VeraCrypt’.
-// [TrueCrypt](https://en.wikipedia.org/wiki/TrueCrypt) is a discontinued system for
+// [TrueCrypt] is a discontinued system for
 // encrypting file containers, partitions or whole disks, typically used with Windows.
-// [VeraCrypt](https://www.veracrypt.fr/) is a maintained fork of TrueCrypt with various
+// [VeraCrypt] is a maintained fork of TrueCrypt with various
 // improvements and auditing fixes.
+//
+// [GtkMountOperation]: https://docs.gtk.org/gtk4/class.MountOperation.html
+// [TrueCrypt]: https://en.wikipedia.org/wiki/TrueCrypt
+// [VeraCrypt]: https://www.veracrypt.fr/
 type MountOperation struct {
 	gobject.Object
 }
@@ -545,7 +563,7 @@ func (x *MountOperation) GetUsername() string {
 
 var xMountOperationReply func(uintptr, MountOperationResult)
 
-// Emits the #GMountOperation::reply signal.
+// Emits the [MountOperation.ConnectReplyFunc] signal.
 func (x *MountOperation) Reply(ResultVar MountOperationResult) {
 
 	xMountOperationReply(x.GoPointer(), ResultVar)
@@ -554,7 +572,7 @@ func (x *MountOperation) Reply(ResultVar MountOperationResult) {
 
 var xMountOperationSetAnonymous func(uintptr, bool)
 
-// Sets the mount operation to use an anonymous user if @anonymous is %TRUE.
+// Sets the mount operation to use an anonymous user if anonymous is true.
 func (x *MountOperation) SetAnonymous(AnonymousVar bool) {
 
 	xMountOperationSetAnonymous(x.GoPointer(), AnonymousVar)
@@ -584,7 +602,7 @@ func (x *MountOperation) SetDomain(DomainVar *string) {
 
 var xMountOperationSetIsTcryptHiddenVolume func(uintptr, bool)
 
-// Sets the mount operation to use a hidden volume if @hidden_volume is %TRUE.
+// Sets the mount operation to use a hidden volume if hidden_volume is true.
 func (x *MountOperation) SetIsTcryptHiddenVolume(HiddenVolumeVar bool) {
 
 	xMountOperationSetIsTcryptHiddenVolume(x.GoPointer(), HiddenVolumeVar)
@@ -593,7 +611,7 @@ func (x *MountOperation) SetIsTcryptHiddenVolume(HiddenVolumeVar bool) {
 
 var xMountOperationSetIsTcryptSystemVolume func(uintptr, bool)
 
-// Sets the mount operation to use a system volume if @system_volume is %TRUE.
+// Sets the mount operation to use a system volume if system_volume is true.
 func (x *MountOperation) SetIsTcryptSystemVolume(SystemVolumeVar bool) {
 
 	xMountOperationSetIsTcryptSystemVolume(x.GoPointer(), SystemVolumeVar)
@@ -602,7 +620,7 @@ func (x *MountOperation) SetIsTcryptSystemVolume(SystemVolumeVar bool) {
 
 var xMountOperationSetPassword func(uintptr, uintptr)
 
-// Sets the mount operation's password to @password.
+// Sets the mount operation's password to password.
 func (x *MountOperation) SetPassword(PasswordVar *string) {
 
 	PasswordVarPtr := core.GStrdupNullable(PasswordVar)
@@ -623,7 +641,7 @@ func (x *MountOperation) SetPasswordSave(SaveVar PasswordSave) {
 
 var xMountOperationSetPim func(uintptr, uint)
 
-// Sets the mount operation's PIM to @pim.
+// Sets the mount operation's PIM to pim.
 func (x *MountOperation) SetPim(PimVar uint) {
 
 	xMountOperationSetPim(x.GoPointer(), PimVar)
@@ -632,7 +650,7 @@ func (x *MountOperation) SetPim(PimVar uint) {
 
 var xMountOperationSetUsername func(uintptr, uintptr)
 
-// Sets the user name within @op to @username.
+// Sets the user name within op to username.
 func (x *MountOperation) SetUsername(UsernameVar *string) {
 
 	UsernameVarPtr := core.GStrdupNullable(UsernameVar)
@@ -672,7 +690,7 @@ func (x *MountOperation) GetPropertyAnonymous() bool {
 
 // SetPropertyChoice sets the "choice" property.
 // The index of the user's choice when a question is asked during the
-// mount operation. See the #GMountOperation::ask-question signal.
+// mount operation. See the [MountOperation.ConnectAskQuestionFunc] signal.
 func (x *MountOperation) SetPropertyChoice(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -682,7 +700,7 @@ func (x *MountOperation) SetPropertyChoice(value int) {
 
 // GetPropertyChoice gets the "choice" property.
 // The index of the user's choice when a question is asked during the
-// mount operation. See the #GMountOperation::ask-question signal.
+// mount operation. See the [MountOperation.ConnectAskQuestionFunc] signal.
 func (x *MountOperation) GetPropertyChoice() int {
 	var v gobject.Value
 	x.GetProperty("choice", &v)
@@ -708,7 +726,9 @@ func (x *MountOperation) GetPropertyDomain() string {
 
 // SetPropertyIsTcryptHiddenVolume sets the "is-tcrypt-hidden-volume" property.
 // Whether the device to be unlocked is a TCRYPT hidden volume.
-// See [the VeraCrypt documentation](https://www.veracrypt.fr/en/Hidden%20Volume.html).
+// See [the VeraCrypt documentation].
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/Hidden%20Volume.html
 func (x *MountOperation) SetPropertyIsTcryptHiddenVolume(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -718,7 +738,9 @@ func (x *MountOperation) SetPropertyIsTcryptHiddenVolume(value bool) {
 
 // GetPropertyIsTcryptHiddenVolume gets the "is-tcrypt-hidden-volume" property.
 // Whether the device to be unlocked is a TCRYPT hidden volume.
-// See [the VeraCrypt documentation](https://www.veracrypt.fr/en/Hidden%20Volume.html).
+// See [the VeraCrypt documentation].
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/Hidden%20Volume.html
 func (x *MountOperation) GetPropertyIsTcryptHiddenVolume() bool {
 	var v gobject.Value
 	x.GetProperty("is-tcrypt-hidden-volume", &v)
@@ -730,7 +752,9 @@ func (x *MountOperation) GetPropertyIsTcryptHiddenVolume() bool {
 // In this context, a system volume is a volume with a bootloader
 // and operating system installed. This is only supported for Windows
 // operating systems. For further documentation, see
-// [the VeraCrypt documentation](https://www.veracrypt.fr/en/System%20Encryption.html).
+// [the VeraCrypt documentation].
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/System%20Encryption.html
 func (x *MountOperation) SetPropertyIsTcryptSystemVolume(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -743,7 +767,9 @@ func (x *MountOperation) SetPropertyIsTcryptSystemVolume(value bool) {
 // In this context, a system volume is a volume with a bootloader
 // and operating system installed. This is only supported for Windows
 // operating systems. For further documentation, see
-// [the VeraCrypt documentation](https://www.veracrypt.fr/en/System%20Encryption.html).
+// [the VeraCrypt documentation].
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/System%20Encryption.html
 func (x *MountOperation) GetPropertyIsTcryptSystemVolume() bool {
 	var v gobject.Value
 	x.GetProperty("is-tcrypt-system-volume", &v)
@@ -771,7 +797,9 @@ func (x *MountOperation) GetPropertyPassword() string {
 
 // SetPropertyPim sets the "pim" property.
 // The VeraCrypt PIM value, when unlocking a VeraCrypt volume. See
-// [the VeraCrypt documentation](https://www.veracrypt.fr/en/Personal%20Iterations%20Multiplier%20(PIM).html).
+// [the VeraCrypt documentation].html).
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/Personal%20Iterations%20Multiplier%20(PIM
 func (x *MountOperation) SetPropertyPim(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -781,7 +809,9 @@ func (x *MountOperation) SetPropertyPim(value uint) {
 
 // GetPropertyPim gets the "pim" property.
 // The VeraCrypt PIM value, when unlocking a VeraCrypt volume. See
-// [the VeraCrypt documentation](https://www.veracrypt.fr/en/Personal%20Iterations%20Multiplier%20(PIM).html).
+// [the VeraCrypt documentation].html).
+//
+// [the VeraCrypt documentation]: https://www.veracrypt.fr/en/Personal%20Iterations%20Multiplier%20(PIM
 func (x *MountOperation) GetPropertyPim() uint {
 	var v gobject.Value
 	x.GetProperty("pim", &v)
@@ -812,55 +842,99 @@ func (x *MountOperation) GetPropertyUsername() string {
 //
 // Implementations of GMountOperation should handle this signal
 // by dismissing open password dialogs.
+//
+// Deprecated: use ConnectAbortedFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectAborted(cb *func(MountOperation)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "aborted", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "aborted", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xMountOperationAbortedTrampoline uintptr
+
+func xMountOperationAbortedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "aborted", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAbortedFunc connects cb to the "aborted" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectAbortedFunc(cb func(MountOperation)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "aborted", &xMountOperationAbortedTrampoline, xMountOperationAbortedNewTrampoline, cb)
 }
 
 // Emitted when a mount operation asks the user for a password.
 //
 // If the message contains a line break, the first line should be
 // presented as a heading. For example, it may be used as the
-// primary text in a #GtkMessageDialog.
+// primary text in a [gtk.MessageDialog].
+//
+// Deprecated: use ConnectAskPasswordFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectAskPassword(cb *func(MountOperation, string, string, string, AskPasswordFlags)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "ask-password", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp, DefaultUserVarp, DefaultDomainVarp, FlagsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "ask-password", cbRefPtr, cbPtr)
+}
+
+var xMountOperationAskPasswordTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags) {
+func xMountOperationAskPasswordNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp string, DefaultUserVarp string, DefaultDomainVarp string, FlagsVarp AskPasswordFlags, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation, string, string, string, AskPasswordFlags))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MessageVarp, DefaultUserVarp, DefaultDomainVarp, FlagsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "ask-password", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAskPasswordFunc connects cb to the "ask-password" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectAskPasswordFunc(cb func(MountOperation, string, string, string, AskPasswordFlags)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "ask-password", &xMountOperationAskPasswordTrampoline, xMountOperationAskPasswordNewTrampoline, cb)
 }
 
 // Emitted when asking the user a question and gives a list of
@@ -868,87 +942,153 @@ func (x *MountOperation) ConnectAskPassword(cb *func(MountOperation, string, str
 //
 // If the message contains a line break, the first line should be
 // presented as a heading. For example, it may be used as the
-// primary text in a #GtkMessageDialog.
+// primary text in a [gtk.MessageDialog].
+//
+// Deprecated: use ConnectAskQuestionFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectAskQuestion(cb *func(MountOperation, string, []string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "ask-question", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp string, ChoicesVarp []string) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp, ChoicesVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "ask-question", cbRefPtr, cbPtr)
+}
+
+var xMountOperationAskQuestionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MessageVarp string, ChoicesVarp []string) {
+func xMountOperationAskQuestionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp string, ChoicesVarp []string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation, string, []string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MessageVarp, ChoicesVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "ask-question", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAskQuestionFunc connects cb to the "ask-question" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectAskQuestionFunc(cb func(MountOperation, string, []string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "ask-question", &xMountOperationAskQuestionTrampoline, xMountOperationAskQuestionNewTrampoline, cb)
 }
 
 // Emitted when the user has replied to the mount operation.
+//
+// Deprecated: use ConnectReplyFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectReply(cb *func(MountOperation, MountOperationResult)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "reply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResultVarp MountOperationResult) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResultVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "reply", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ResultVarp MountOperationResult) {
+var xMountOperationReplyTrampoline uintptr
+
+func xMountOperationReplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResultVarp MountOperationResult, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation, MountOperationResult))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResultVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "reply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectReplyFunc connects cb to the "reply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectReplyFunc(cb func(MountOperation, MountOperationResult)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "reply", &xMountOperationReplyTrampoline, xMountOperationReplyNewTrampoline, cb)
 }
 
 // Emitted when one or more processes are blocking an operation
-// e.g. unmounting/ejecting a #GMount or stopping a #GDrive.
+// e.g. unmounting/ejecting a [Mount] or stopping a [Drive].
 //
 // Note that this signal may be emitted several times to update the
 // list of blocking processes as processes close files. The
-// application should only respond with g_mount_operation_reply() to
-// the latest signal (setting #GMountOperation:choice to the choice
+// application should only respond with [MountOperation.Reply] to
+// the latest signal (setting [MountOperation.GetPropertyChoice] to the choice
 // the user made).
 //
 // If the message contains a line break, the first line should be
 // presented as a heading. For example, it may be used as the
-// primary text in a #GtkMessageDialog.
+// primary text in a [gtk.MessageDialog].
+//
+// Deprecated: use ConnectShowProcessesFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectShowProcesses(cb *func(MountOperation, string, []glib.Pid, []string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "show-processes", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp, ProcessesVarp, ChoicesVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "show-processes", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string) {
+var xMountOperationShowProcessesTrampoline uintptr
+
+func xMountOperationShowProcessesNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp string, ProcessesVarp []glib.Pid, ChoicesVarp []string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation, string, []glib.Pid, []string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MessageVarp, ProcessesVarp, ChoicesVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "show-processes", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShowProcessesFunc connects cb to the "show-processes" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectShowProcessesFunc(cb func(MountOperation, string, []glib.Pid, []string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "show-processes", &xMountOperationShowProcessesTrampoline, xMountOperationShowProcessesNewTrampoline, cb)
 }
 
 // Emitted when an unmount operation has been busy for more than some time
@@ -958,36 +1098,58 @@ func (x *MountOperation) ConnectShowProcesses(cb *func(MountOperation, string, [
 // pending data in its buffers to the volume stable storage, and this operation
 // can take a considerable amount of time. This signal may be emitted several
 // times as long as the unmount operation is outstanding, and then one
-// last time when the operation is completed, with @bytes_left set to zero.
+// last time when the operation is completed, with bytes_left set to zero.
 //
 // Implementations of GMountOperation should handle this signal by
 // showing an UI notification, and then dismiss it, or show another notification
-// of completion, when @bytes_left reaches zero.
+// of completion, when bytes_left reaches zero.
 //
 // If the message contains a line break, the first line should be
 // presented as a heading. For example, it may be used as the
-// primary text in a #GtkMessageDialog.
+// primary text in a [gtk.MessageDialog].
+//
+// Deprecated: use ConnectShowUnmountProgressFunc, which also accepts method values and closures.
 func (x *MountOperation) ConnectShowUnmountProgress(cb *func(MountOperation, string, int64, int64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "show-unmount-progress", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64) {
+			defer core.RecoverPanic()
+			fa := MountOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp, TimeLeftVarp, BytesLeftVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "show-unmount-progress", cbRefPtr, cbPtr)
+}
+
+var xMountOperationShowUnmountProgressTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64) {
+func xMountOperationShowUnmountProgressNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp string, TimeLeftVarp int64, BytesLeftVarp int64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MountOperation, string, int64, int64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MountOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MessageVarp, TimeLeftVarp, BytesLeftVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "show-unmount-progress", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShowUnmountProgressFunc connects cb to the "show-unmount-progress" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MountOperation) ConnectShowUnmountProgressFunc(cb func(MountOperation, string, int64, int64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "show-unmount-progress", &xMountOperationShowUnmountProgressTrampoline, xMountOperationShowUnmountProgressNewTrampoline, cb)
 }
 
 func init() {
@@ -995,7 +1157,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1004,26 +1166,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xMountOperationGLibType, libs, "g_mount_operation_get_type")
 
-	core.PuregoSafeRegister(&xNewMountOperation, libs, "g_mount_operation_new")
-
-	core.PuregoSafeRegister(&xMountOperationGetAnonymous, libs, "g_mount_operation_get_anonymous")
-	core.PuregoSafeRegister(&xMountOperationGetChoice, libs, "g_mount_operation_get_choice")
-	core.PuregoSafeRegister(&xMountOperationGetDomain, libs, "g_mount_operation_get_domain")
-	core.PuregoSafeRegister(&xMountOperationGetIsTcryptHiddenVolume, libs, "g_mount_operation_get_is_tcrypt_hidden_volume")
-	core.PuregoSafeRegister(&xMountOperationGetIsTcryptSystemVolume, libs, "g_mount_operation_get_is_tcrypt_system_volume")
-	core.PuregoSafeRegister(&xMountOperationGetPassword, libs, "g_mount_operation_get_password")
-	core.PuregoSafeRegister(&xMountOperationGetPasswordSave, libs, "g_mount_operation_get_password_save")
-	core.PuregoSafeRegister(&xMountOperationGetPim, libs, "g_mount_operation_get_pim")
-	core.PuregoSafeRegister(&xMountOperationGetUsername, libs, "g_mount_operation_get_username")
-	core.PuregoSafeRegister(&xMountOperationReply, libs, "g_mount_operation_reply")
-	core.PuregoSafeRegister(&xMountOperationSetAnonymous, libs, "g_mount_operation_set_anonymous")
-	core.PuregoSafeRegister(&xMountOperationSetChoice, libs, "g_mount_operation_set_choice")
-	core.PuregoSafeRegister(&xMountOperationSetDomain, libs, "g_mount_operation_set_domain")
-	core.PuregoSafeRegister(&xMountOperationSetIsTcryptHiddenVolume, libs, "g_mount_operation_set_is_tcrypt_hidden_volume")
-	core.PuregoSafeRegister(&xMountOperationSetIsTcryptSystemVolume, libs, "g_mount_operation_set_is_tcrypt_system_volume")
-	core.PuregoSafeRegister(&xMountOperationSetPassword, libs, "g_mount_operation_set_password")
-	core.PuregoSafeRegister(&xMountOperationSetPasswordSave, libs, "g_mount_operation_set_password_save")
-	core.PuregoSafeRegister(&xMountOperationSetPim, libs, "g_mount_operation_set_pim")
-	core.PuregoSafeRegister(&xMountOperationSetUsername, libs, "g_mount_operation_set_username")
+	gobject.CheckClassSize("GMountOperationClass", xMountOperationGLibType, unsafe.Sizeof(MountOperationClass{}))
+
+	core.PuregoRegisterSince(&xNewMountOperation, libs, "g_mount_operation_new", "GIO", "")
+
+	core.PuregoRegisterSince(&xMountOperationGetAnonymous, libs, "g_mount_operation_get_anonymous", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationGetChoice, libs, "g_mount_operation_get_choice", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationGetDomain, libs, "g_mount_operation_get_domain", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationGetIsTcryptHiddenVolume, libs, "g_mount_operation_get_is_tcrypt_hidden_volume", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationGetIsTcryptSystemVolume, libs, "g_mount_operation_get_is_tcrypt_system_volume", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationGetPassword, libs, "g_mount_operation_get_password", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationGetPasswordSave, libs, "g_mount_operation_get_password_save", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationGetPim, libs, "g_mount_operation_get_pim", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationGetUsername, libs, "g_mount_operation_get_username", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationReply, libs, "g_mount_operation_reply", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetAnonymous, libs, "g_mount_operation_set_anonymous", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetChoice, libs, "g_mount_operation_set_choice", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetDomain, libs, "g_mount_operation_set_domain", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetIsTcryptHiddenVolume, libs, "g_mount_operation_set_is_tcrypt_hidden_volume", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationSetIsTcryptSystemVolume, libs, "g_mount_operation_set_is_tcrypt_system_volume", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationSetPassword, libs, "g_mount_operation_set_password", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetPasswordSave, libs, "g_mount_operation_set_password_save", "GIO", "")
+	core.PuregoRegisterSince(&xMountOperationSetPim, libs, "g_mount_operation_set_pim", "GIO", "2.58")
+	core.PuregoRegisterSince(&xMountOperationSetUsername, libs, "g_mount_operation_set_username", "GIO", "")
 
 }
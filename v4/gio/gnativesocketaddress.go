@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -50,7 +50,7 @@ func NativeSocketAddressNewFromInternalPtr(ptr uintptr) *NativeSocketAddress {
 
 var xNewNativeSocketAddress func(uintptr, uint) uintptr
 
-// Creates a new #GNativeSocketAddress for @native and @len.
+// Creates a new [NativeSocketAddress] for native and len.
 func NewNativeSocketAddress(NativeVar uintptr, LenVar uint) *NativeSocketAddress {
 	var cls *NativeSocketAddress
 
@@ -75,7 +75,7 @@ func (c *NativeSocketAddress) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *NativeSocketAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -89,13 +89,13 @@ func (x *NativeSocketAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *NativeSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -109,12 +109,12 @@ func (x *NativeSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *NativeSocketAddress) ToString() string {
 
@@ -127,7 +127,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -136,6 +136,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xNativeSocketAddressGLibType, libs, "g_native_socket_address_get_type")
 
-	core.PuregoSafeRegister(&xNewNativeSocketAddress, libs, "g_native_socket_address_new")
+	gobject.CheckClassSize("GNativeSocketAddressClass", xNativeSocketAddressGLibType, unsafe.Sizeof(NativeSocketAddressClass{}))
+
+	core.PuregoRegisterSince(&xNewNativeSocketAddress, libs, "g_native_socket_address_new", "GIO", "2.46")
 
 }
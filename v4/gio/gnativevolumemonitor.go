@@ -5,7 +5,7 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -26,7 +26,8 @@ func (x *NativeVolumeMonitorClass) OverrideGetMountForMountPath(cb func(string,
 	if cb == nil {
 		x.xGetMountForMountPath = 0
 	} else {
-		x.xGetMountForMountPath = purego.NewCallback(func(MountPathVarp string, CancellableVarp uintptr) uintptr {
+		x.xGetMountForMountPath = core.NewCallback(func(MountPathVarp string, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(MountPathVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -42,7 +43,7 @@ func (x *NativeVolumeMonitorClass) GetGetMountForMountPath() func(string, *Cance
 		return nil
 	}
 	var rawCallback func(MountPathVarp string, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetMountForMountPath)
+	core.RegisterFunc(&rawCallback, x.xGetMountForMountPath)
 	return func(MountPathVar string, CancellableVar *Cancellable) *MountBase {
 		rawRet := rawCallback(MountPathVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -38,7 +37,7 @@ func (x *NetworkAddressPrivate) GoPointer() uintptr {
 // as this object is kept alive which may have unexpected results if
 // alive for too long.
 //
-// See [iface@Gio.SocketConnectable] for an example of using the connectable
+// See [SocketConnectable] for an example of using the connectable
 // interface.
 type NetworkAddress struct {
 	gobject.Object
@@ -58,13 +57,13 @@ func NetworkAddressNewFromInternalPtr(ptr uintptr) *NetworkAddress {
 
 var xNewNetworkAddress func(string, uint16) uintptr
 
-// Creates a new #GSocketConnectable for connecting to the given
-// @hostname and @port.
+// Creates a new [SocketConnectable] for connecting to the given
+// hostname and port.
 //
 // Note that depending on the configuration of the machine, a
-// @hostname of `localhost` may refer to the IPv4 loopback address
+// hostname of `localhost` may refer to the IPv4 loopback address
 // only, or to both IPv4 and IPv6; use
-// g_network_address_new_loopback() to create a #GNetworkAddress that
+// [NewNetworkAddressLoopback] to create a [NetworkAddress] that
 // is guaranteed to resolve to both addresses.
 func NewNetworkAddress(HostnameVar string, PortVar uint16) *NetworkAddress {
 	var cls *NetworkAddress
@@ -81,18 +80,18 @@ func NewNetworkAddress(HostnameVar string, PortVar uint16) *NetworkAddress {
 
 var xNewNetworkAddressLoopback func(uint16) uintptr
 
-// Creates a new #GSocketConnectable for connecting to the local host
-// over a loopback connection to the given @port. This is intended for
+// Creates a new [SocketConnectable] for connecting to the local host
+// over a loopback connection to the given port. This is intended for
 // use in connecting to local services which may be running on IPv4 or
 // IPv6.
 //
 // The connectable will return IPv4 and IPv6 loopback addresses,
 // regardless of how the host resolves `localhost`. By contrast,
-// g_network_address_new() will often only return an IPv4 address when
+// [NewNetworkAddress] will often only return an IPv4 address when
 // resolving `localhost`, and an IPv6 address for `localhost6`.
 //
-// g_network_address_get_hostname() will always return `localhost` for
-// a #GNetworkAddress created with this constructor.
+// [NetworkAddress.GetHostname] will always return `localhost` for
+// a [NetworkAddress] created with this constructor.
 func NewNetworkAddressLoopback(PortVar uint16) *NetworkAddress {
 	var cls *NetworkAddress
 
@@ -108,8 +107,8 @@ func NewNetworkAddressLoopback(PortVar uint16) *NetworkAddress {
 
 var xNetworkAddressGetHostname func(uintptr) string
 
-// Gets @addr's hostname. This might be either UTF-8 or ASCII-encoded,
-// depending on what @addr was created with.
+// Gets addr's hostname. This might be either UTF-8 or ASCII-encoded,
+// depending on what addr was created with.
 func (x *NetworkAddress) GetHostname() string {
 
 	cret := xNetworkAddressGetHostname(x.GoPointer())
@@ -118,7 +117,7 @@ func (x *NetworkAddress) GetHostname() string {
 
 var xNetworkAddressGetPort func(uintptr) uint16
 
-// Gets @addr's port number
+// Gets addr's port number
 func (x *NetworkAddress) GetPort() uint16 {
 
 	cret := xNetworkAddressGetPort(x.GoPointer())
@@ -127,7 +126,7 @@ func (x *NetworkAddress) GetPort() uint16 {
 
 var xNetworkAddressGetScheme func(uintptr) string
 
-// Gets @addr's scheme
+// Gets addr's scheme
 func (x *NetworkAddress) GetScheme() string {
 
 	cret := xNetworkAddressGetScheme(x.GoPointer())
@@ -196,7 +195,7 @@ func (x *NetworkAddress) GetPropertyScheme() string {
 	return v.GetString()
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *NetworkAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -210,13 +209,13 @@ func (x *NetworkAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *NetworkAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -230,12 +229,12 @@ func (x *NetworkAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *NetworkAddress) ToString() string {
 
@@ -245,24 +244,24 @@ func (x *NetworkAddress) ToString() string {
 
 var xNetworkAddressParse func(string, uint16, **glib.Error) uintptr
 
-// Creates a new #GSocketConnectable for connecting to the given
-// @hostname and @port. May fail and return %NULL in case
-// parsing @host_and_port fails.
+// Creates a new [SocketConnectable] for connecting to the given
+// hostname and port. May fail and return nil in case
+// parsing host_and_port fails.
 //
-// @host_and_port may be in any of a number of recognised formats; an IPv6
+// host_and_port may be in any of a number of recognised formats; an IPv6
 // address, an IPv4 address, or a domain name (in which case a DNS
 // lookup is performed). Quoting with [] is supported for all address
 // types. A port override may be specified in the usual way with a
 // colon.
 //
-// If no port is specified in @host_and_port then @default_port will be
+// If no port is specified in host_and_port then default_port will be
 // used as the port number to connect to.
 //
-// In general, @host_and_port is expected to be provided by the user
+// In general, host_and_port is expected to be provided by the user
 // (allowing them to give the hostname, and a port override if necessary)
-// and @default_port is expected to be provided by the application.
+// and default_port is expected to be provided by the application.
 //
-// (The port component of @host_and_port can also be specified as a
+// (The port component of host_and_port can also be specified as a
 // service name rather than as a numeric port, but this functionality
 // is deprecated, because it depends on the contents of /etc/services,
 // which is generally quite sparse on platforms other than Linux.)
@@ -273,12 +272,15 @@ func NetworkAddressParse(HostAndPortVar string, DefaultPortVar uint16) (*Network
 	cret := xNetworkAddressParse(HostAndPortVar, DefaultPortVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &NetworkAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -286,11 +288,11 @@ func NetworkAddressParse(HostAndPortVar string, DefaultPortVar uint16) (*Network
 
 var xNetworkAddressParseUri func(string, uint16, **glib.Error) uintptr
 
-// Creates a new #GSocketConnectable for connecting to the given
-// @uri. May fail and return %NULL in case parsing @uri fails.
+// Creates a new [SocketConnectable] for connecting to the given
+// uri. May fail and return nil in case parsing uri fails.
 //
-// Using this rather than g_network_address_new() or
-// g_network_address_parse() allows #GSocketClient to determine
+// Using this rather than [NewNetworkAddress] or
+// [NetworkAddressParse] allows [SocketClient] to determine
 // when to use application-specific proxy protocols.
 func NetworkAddressParseUri(UriVar string, DefaultPortVar uint16) (*NetworkAddress, error) {
 	var cls *NetworkAddress
@@ -299,12 +301,15 @@ func NetworkAddressParseUri(UriVar string, DefaultPortVar uint16) (*NetworkAddre
 	cret := xNetworkAddressParseUri(UriVar, DefaultPortVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &NetworkAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -315,7 +320,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -324,14 +329,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xNetworkAddressGLibType, libs, "g_network_address_get_type")
 
-	core.PuregoSafeRegister(&xNewNetworkAddress, libs, "g_network_address_new")
-	core.PuregoSafeRegister(&xNewNetworkAddressLoopback, libs, "g_network_address_new_loopback")
+	gobject.CheckClassSize("GNetworkAddressClass", xNetworkAddressGLibType, unsafe.Sizeof(NetworkAddressClass{}))
+
+	core.PuregoRegisterSince(&xNewNetworkAddress, libs, "g_network_address_new", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewNetworkAddressLoopback, libs, "g_network_address_new_loopback", "GIO", "2.44")
 
-	core.PuregoSafeRegister(&xNetworkAddressGetHostname, libs, "g_network_address_get_hostname")
-	core.PuregoSafeRegister(&xNetworkAddressGetPort, libs, "g_network_address_get_port")
-	core.PuregoSafeRegister(&xNetworkAddressGetScheme, libs, "g_network_address_get_scheme")
+	core.PuregoRegisterSince(&xNetworkAddressGetHostname, libs, "g_network_address_get_hostname", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkAddressGetPort, libs, "g_network_address_get_port", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkAddressGetScheme, libs, "g_network_address_get_scheme", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xNetworkAddressParse, libs, "g_network_address_parse")
-	core.PuregoSafeRegister(&xNetworkAddressParseUri, libs, "g_network_address_parse_uri")
+	core.PuregoRegisterSince(&xNetworkAddressParse, libs, "g_network_address_parse", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkAddressParseUri, libs, "g_network_address_parse_uri", "GIO", "2.26")
 
 }
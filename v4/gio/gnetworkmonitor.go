@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GNetworkMonitor.
+// The virtual function table for [NetworkMonitor].
 type NetworkMonitorInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xNetworkChanged uintptr
 
@@ -39,7 +38,8 @@ func (x *NetworkMonitorInterface) OverrideNetworkChanged(cb func(NetworkMonitor,
 	if cb == nil {
 		x.xNetworkChanged = 0
 	} else {
-		x.xNetworkChanged = purego.NewCallback(func(MonitorVarp uintptr, NetworkAvailableVarp bool) {
+		x.xNetworkChanged = core.NewCallback(func(MonitorVarp uintptr, NetworkAvailableVarp bool) {
+			defer core.RecoverPanic()
 			cb(&NetworkMonitorBase{Ptr: MonitorVarp}, NetworkAvailableVarp)
 		})
 	}
@@ -54,32 +54,33 @@ func (x *NetworkMonitorInterface) GetNetworkChanged() func(NetworkMonitor, bool)
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, NetworkAvailableVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xNetworkChanged)
+	core.RegisterFunc(&rawCallback, x.xNetworkChanged)
 	return func(MonitorVar NetworkMonitor, NetworkAvailableVar bool) {
 		rawCallback(MonitorVar.GoPointer(), NetworkAvailableVar)
 	}
 }
 
 // OverrideCanReach sets the "can_reach" callback function.
-// the virtual function pointer for g_network_monitor_can_reach()
+// the virtual function pointer for [NetworkMonitor.CanReach]
 func (x *NetworkMonitorInterface) OverrideCanReach(cb func(NetworkMonitor, SocketConnectable, *Cancellable) bool) {
 	if cb == nil {
 		x.xCanReach = 0
 	} else {
-		x.xCanReach = purego.NewCallback(func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr) bool {
+		x.xCanReach = core.NewCallback(func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&NetworkMonitorBase{Ptr: MonitorVarp}, &SocketConnectableBase{Ptr: ConnectableVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetCanReach gets the "can_reach" callback function.
-// the virtual function pointer for g_network_monitor_can_reach()
+// the virtual function pointer for [NetworkMonitor.CanReach]
 func (x *NetworkMonitorInterface) GetCanReach() func(NetworkMonitor, SocketConnectable, *Cancellable) bool {
 	if x.xCanReach == 0 {
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanReach)
+	core.RegisterFunc(&rawCallback, x.xCanReach)
 	return func(MonitorVar NetworkMonitor, ConnectableVar SocketConnectable, CancellableVar *Cancellable) bool {
 		return rawCallback(MonitorVar.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -88,12 +89,13 @@ func (x *NetworkMonitorInterface) GetCanReach() func(NetworkMonitor, SocketConne
 // OverrideCanReachAsync sets the "can_reach_async" callback function.
 // the virtual function pointer for
 //
-//	g_network_monitor_can_reach_async()
+//	[NetworkMonitor.CanReachAsync]
 func (x *NetworkMonitorInterface) OverrideCanReachAsync(cb func(NetworkMonitor, SocketConnectable, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xCanReachAsync = 0
 	} else {
-		x.xCanReachAsync = purego.NewCallback(func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCanReachAsync = core.NewCallback(func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&NetworkMonitorBase{Ptr: MonitorVarp}, &SocketConnectableBase{Ptr: ConnectableVarp}, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -102,13 +104,13 @@ func (x *NetworkMonitorInterface) OverrideCanReachAsync(cb func(NetworkMonitor,
 // GetCanReachAsync gets the "can_reach_async" callback function.
 // the virtual function pointer for
 //
-//	g_network_monitor_can_reach_async()
+//	[NetworkMonitor.CanReachAsync]
 func (x *NetworkMonitorInterface) GetCanReachAsync() func(NetworkMonitor, SocketConnectable, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xCanReachAsync == 0 {
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, ConnectableVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCanReachAsync)
+	core.RegisterFunc(&rawCallback, x.xCanReachAsync)
 	return func(MonitorVar NetworkMonitor, ConnectableVar SocketConnectable, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(MonitorVar.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -117,12 +119,13 @@ func (x *NetworkMonitorInterface) GetCanReachAsync() func(NetworkMonitor, Socket
 // OverrideCanReachFinish sets the "can_reach_finish" callback function.
 // the virtual function pointer for
 //
-//	g_network_monitor_can_reach_finish()
+//	[NetworkMonitor.CanReachFinish]
 func (x *NetworkMonitorInterface) OverrideCanReachFinish(cb func(NetworkMonitor, AsyncResult) bool) {
 	if cb == nil {
 		x.xCanReachFinish = 0
 	} else {
-		x.xCanReachFinish = purego.NewCallback(func(MonitorVarp uintptr, ResultVarp uintptr) bool {
+		x.xCanReachFinish = core.NewCallback(func(MonitorVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&NetworkMonitorBase{Ptr: MonitorVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -131,13 +134,13 @@ func (x *NetworkMonitorInterface) OverrideCanReachFinish(cb func(NetworkMonitor,
 // GetCanReachFinish gets the "can_reach_finish" callback function.
 // the virtual function pointer for
 //
-//	g_network_monitor_can_reach_finish()
+//	[NetworkMonitor.CanReachFinish]
 func (x *NetworkMonitorInterface) GetCanReachFinish() func(NetworkMonitor, AsyncResult) bool {
 	if x.xCanReachFinish == 0 {
 		return nil
 	}
 	var rawCallback func(MonitorVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanReachFinish)
+	core.RegisterFunc(&rawCallback, x.xCanReachFinish)
 	return func(MonitorVar NetworkMonitor, ResultVar AsyncResult) bool {
 		return rawCallback(MonitorVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -181,78 +184,143 @@ func (x *NetworkMonitorBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementNetworkMonitor adds the NetworkMonitor interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - NetworkChanged(bool)
+//   - CanReach(SocketConnectable, *Cancellable) bool
+//   - CanReachAsync(SocketConnectable, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - CanReachFinish(AsyncResult) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see NetworkMonitorInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementNetworkMonitor[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, NetworkMonitorGLibType(), func(iface *NetworkMonitorInterface) {
+		if _, ok := any(zero).(interface{ NetworkChanged(bool) }); ok {
+			iface.OverrideNetworkChanged(func(self NetworkMonitor, arg0 bool) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ NetworkChanged(bool) })
+				impl.NetworkChanged(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CanReach(SocketConnectable, *Cancellable) bool
+		}); ok {
+			iface.OverrideCanReach(func(self NetworkMonitor, arg0 SocketConnectable, arg1 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CanReach(SocketConnectable, *Cancellable) bool
+				})
+				return impl.CanReach(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CanReachAsync(SocketConnectable, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideCanReachAsync(func(self NetworkMonitor, arg0 SocketConnectable, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CanReachAsync(SocketConnectable, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.CanReachAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ CanReachFinish(AsyncResult) bool }); ok {
+			iface.OverrideCanReachFinish(func(self NetworkMonitor, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanReachFinish(AsyncResult) bool })
+				return impl.CanReachFinish(arg0)
+			})
+		}
+	})
+}
+
 // Attempts to determine whether or not the host pointed to by
-// @connectable can be reached, without actually trying to connect to
+// connectable can be reached, without actually trying to connect to
 // it.
 //
-// This may return %TRUE even when #GNetworkMonitor:network-available
-// is %FALSE, if, for example, @monitor can determine that
-// @connectable refers to a host on a local network.
+// This may return true even when [NetworkMonitorBase.GetPropertyNetworkAvailable]
+// is false, if, for example, monitor can determine that
+// connectable refers to a host on a local network.
 //
-// If @monitor believes that an attempt to connect to @connectable
-// will succeed, it will return %TRUE. Otherwise, it will return
-// %FALSE and set @error to an appropriate error (such as
-// %G_IO_ERROR_HOST_UNREACHABLE).
+// If monitor believes that an attempt to connect to connectable
+// will succeed, it will return true. Otherwise, it will return
+// false and set error to an appropriate error (such as
+// [GIoErrorHostUnreachableValue]).
 //
 // Note that although this does not attempt to connect to
-// @connectable, it may still block for a brief period of time (eg,
+// connectable, it may still block for a brief period of time (eg,
 // trying to do multicast DNS on the local network), so if you do not
-// want to block, you should use g_network_monitor_can_reach_async().
+// want to block, you should use [NetworkMonitor.CanReachAsync].
 func (x *NetworkMonitorBase) CanReach(ConnectableVar SocketConnectable, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGNetworkMonitorCanReach(x.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Asynchronously attempts to determine whether or not the host
-// pointed to by @connectable can be reached, without actually
+// pointed to by connectable can be reached, without actually
 // trying to connect to it.
 //
-// For more details, see g_network_monitor_can_reach().
+// For more details, see [NetworkMonitor.CanReach].
 //
-// When the operation is finished, @callback will be called.
-// You can then call g_network_monitor_can_reach_finish()
+// When the operation is finished, callback will be called.
+// You can then call [NetworkMonitor.CanReachFinish]
 // to get the result of the operation.
 func (x *NetworkMonitorBase) CanReachAsync(ConnectableVar SocketConnectable, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGNetworkMonitorCanReachAsync(x.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGNetworkMonitorCanReachAsync(x.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes an async network connectivity test.
-// See g_network_monitor_can_reach_async().
+// See [NetworkMonitor.CanReachAsync].
 func (x *NetworkMonitorBase) CanReachFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGNetworkMonitorCanReachFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets a more detailed networking state than
-// g_network_monitor_get_network_available().
+// [NetworkMonitor.GetNetworkAvailable].
 //
-// If #GNetworkMonitor:network-available is %FALSE, then the
-// connectivity state will be %G_NETWORK_CONNECTIVITY_LOCAL.
+// If [NetworkMonitorBase.GetPropertyNetworkAvailable] is false, then the
+// connectivity state will be [GNetworkConnectivityLocalValue].
 //
-// If #GNetworkMonitor:network-available is %TRUE, then the
-// connectivity state will be %G_NETWORK_CONNECTIVITY_FULL (if there
-// is full Internet connectivity), %G_NETWORK_CONNECTIVITY_LIMITED (if
+// If [NetworkMonitorBase.GetPropertyNetworkAvailable] is true, then the
+// connectivity state will be [GNetworkConnectivityFullValue] (if there
+// is full Internet connectivity), [GNetworkConnectivityLimitedValue] (if
 // the host has a default route, but appears to be unable to actually
-// reach the full Internet), or %G_NETWORK_CONNECTIVITY_PORTAL (if the
+// reach the full Internet), or [GNetworkConnectivityPortalValue] (if the
 // host is trapped behind a "captive portal" that requires some sort
 // of login or acknowledgement before allowing full Internet access).
 //
-// Note that in the case of %G_NETWORK_CONNECTIVITY_LIMITED and
-// %G_NETWORK_CONNECTIVITY_PORTAL, it is possible that some sites are
+// Note that in the case of [GNetworkConnectivityLimitedValue] and
+// [GNetworkConnectivityPortalValue], it is possible that some sites are
 // reachable but others are not. In this case, applications can
 // attempt to connect to remote servers, but should gracefully fall
 // back to their "offline" behavior if the connection attempt fails.
@@ -265,7 +333,7 @@ func (x *NetworkMonitorBase) GetConnectivity() NetworkConnectivity {
 // Checks if the network is available. "Available" here means that the
 // system has a default route available for at least one of IPv4 or
 // IPv6. It does not necessarily imply that the public Internet is
-// reachable. See #GNetworkMonitor:network-available for more details.
+// reachable. See [NetworkMonitorBase.GetPropertyNetworkAvailable] for more details.
 func (x *NetworkMonitorBase) GetNetworkAvailable() bool {
 
 	cret := XGNetworkMonitorGetNetworkAvailable(x.GoPointer())
@@ -273,7 +341,7 @@ func (x *NetworkMonitorBase) GetNetworkAvailable() bool {
 }
 
 // Checks if the network is metered.
-// See #GNetworkMonitor:network-metered for more details.
+// See [NetworkMonitorBase.GetPropertyNetworkMetered] for more details.
 func (x *NetworkMonitorBase) GetNetworkMetered() bool {
 
 	cret := XGNetworkMonitorGetNetworkMetered(x.GoPointer())
@@ -290,14 +358,14 @@ func (x *NetworkMonitorBase) GetNetworkMetered() bool {
 // connected to a functioning router that has lost its own upstream
 // connectivity. Some hosts might only be accessible when a VPN is
 // active. Other hosts might only be accessible when the VPN is
-// not active. Thus, it is best to use g_network_monitor_can_reach()
-// or g_network_monitor_can_reach_async() to test for reachability
+// not active. Thus, it is best to use [NetworkMonitor.CanReach]
+// or [NetworkMonitor.CanReachAsync] to test for reachability
 // on a host-by-host basis. (On the other hand, when the property is
-// %FALSE, the application can reasonably expect that no remote
+// false, the application can reasonably expect that no remote
 // hosts at all are reachable, and should indicate this to the user
 // in its UI.)
 //
-// See also #GNetworkMonitor::network-changed.
+// See also GNetworkMonitor::network-changed.
 func (x *NetworkMonitorBase) GetPropertyNetworkAvailable() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -327,7 +395,7 @@ func (x *NetworkMonitorBase) GetPropertyNetworkAvailable() bool {
 // If this information is not available then no networks will be
 // marked as metered.
 //
-// See also #GNetworkMonitor:network-available.
+// See also [NetworkMonitorBase.GetPropertyNetworkAvailable].
 func (x *NetworkMonitorBase) GetPropertyNetworkMetered() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -345,13 +413,13 @@ var XGNetworkMonitorGetNetworkMetered func(uintptr) bool
 
 const (
 	// Extension point for network status monitoring functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	NETWORK_MONITOR_EXTENSION_POINT_NAME string = "gio-network-monitor"
 )
 
 var xNetworkMonitorGetDefault func() uintptr
 
-// Gets the default #GNetworkMonitor for the system.
+// Gets the default [NetworkMonitor] for the system.
 func NetworkMonitorGetDefault() *NetworkMonitorBase {
 	var cls *NetworkMonitorBase
 
@@ -371,22 +439,24 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xNetworkMonitorGetDefault, libs, "g_network_monitor_get_default")
+	core.PuregoRegisterSince(&xNetworkMonitorGetDefault, libs, "g_network_monitor_get_default", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xNetworkMonitorGLibType, libs, "g_network_monitor_get_type")
 
-	core.PuregoSafeRegister(&XGNetworkMonitorCanReach, libs, "g_network_monitor_can_reach")
-	core.PuregoSafeRegister(&XGNetworkMonitorCanReachAsync, libs, "g_network_monitor_can_reach_async")
-	core.PuregoSafeRegister(&XGNetworkMonitorCanReachFinish, libs, "g_network_monitor_can_reach_finish")
-	core.PuregoSafeRegister(&XGNetworkMonitorGetConnectivity, libs, "g_network_monitor_get_connectivity")
-	core.PuregoSafeRegister(&XGNetworkMonitorGetNetworkAvailable, libs, "g_network_monitor_get_network_available")
-	core.PuregoSafeRegister(&XGNetworkMonitorGetNetworkMetered, libs, "g_network_monitor_get_network_metered")
+	gobject.CheckClassSize("GNetworkMonitorInterface", xNetworkMonitorGLibType, unsafe.Sizeof(NetworkMonitorInterface{}))
+
+	core.PuregoRegisterSince(&XGNetworkMonitorCanReach, libs, "g_network_monitor_can_reach", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGNetworkMonitorCanReachAsync, libs, "g_network_monitor_can_reach_async", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGNetworkMonitorCanReachFinish, libs, "g_network_monitor_can_reach_finish", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGNetworkMonitorGetConnectivity, libs, "g_network_monitor_get_connectivity", "GIO", "2.44")
+	core.PuregoRegisterSince(&XGNetworkMonitorGetNetworkAvailable, libs, "g_network_monitor_get_network_available", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGNetworkMonitorGetNetworkMetered, libs, "g_network_monitor_get_network_metered", "GIO", "2.46")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -29,14 +28,14 @@ func (x *NetworkServicePrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Like [class@Gio.NetworkAddress] does with hostnames, `GNetworkService`
+// Like [NetworkAddress] does with hostnames, `GNetworkService`
 // provides an easy way to resolve a SRV record, and then attempt to
 // connect to one of the hosts that implements that service, handling
 // service priority/weighting, multiple IP addresses, and multiple
 // address families.
 //
-// See [struct@Gio.SrvTarget] for more information about SRV records, and see
-// [iface@Gio.SocketConnectable] for an example of using the connectable
+// See [SrvTarget] for more information about SRV records, and see
+// [SocketConnectable] for an example of using the connectable
 // interface.
 type NetworkService struct {
 	gobject.Object
@@ -56,9 +55,9 @@ func NetworkServiceNewFromInternalPtr(ptr uintptr) *NetworkService {
 
 var xNewNetworkService func(string, string, string) uintptr
 
-// Creates a new #GNetworkService representing the given @service,
-// @protocol, and @domain. This will initially be unresolved; use the
-// #GSocketConnectable interface to resolve it.
+// Creates a new [NetworkService] representing the given service,
+// protocol, and domain. This will initially be unresolved; use the
+// [SocketConnectable] interface to resolve it.
 func NewNetworkService(ServiceVar string, ProtocolVar string, DomainVar string) *NetworkService {
 	var cls *NetworkService
 
@@ -74,8 +73,8 @@ func NewNetworkService(ServiceVar string, ProtocolVar string, DomainVar string)
 
 var xNetworkServiceGetDomain func(uintptr) string
 
-// Gets the domain that @srv serves. This might be either UTF-8 or
-// ASCII-encoded, depending on what @srv was created with.
+// Gets the domain that srv serves. This might be either UTF-8 or
+// ASCII-encoded, depending on what srv was created with.
 func (x *NetworkService) GetDomain() string {
 
 	cret := xNetworkServiceGetDomain(x.GoPointer())
@@ -84,7 +83,7 @@ func (x *NetworkService) GetDomain() string {
 
 var xNetworkServiceGetProtocol func(uintptr) string
 
-// Gets @srv's protocol name (eg, "tcp").
+// Gets srv's protocol name (eg, "tcp").
 func (x *NetworkService) GetProtocol() string {
 
 	cret := xNetworkServiceGetProtocol(x.GoPointer())
@@ -103,7 +102,7 @@ func (x *NetworkService) GetScheme() string {
 
 var xNetworkServiceGetService func(uintptr) string
 
-// Gets @srv's service name (eg, "ldap").
+// Gets srv's service name (eg, "ldap").
 func (x *NetworkService) GetService() string {
 
 	cret := xNetworkServiceGetService(x.GoPointer())
@@ -199,7 +198,7 @@ func (x *NetworkService) GetPropertyService() string {
 	return v.GetString()
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *NetworkService) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -213,13 +212,13 @@ func (x *NetworkService) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *NetworkService) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -233,12 +232,12 @@ func (x *NetworkService) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *NetworkService) ToString() string {
 
@@ -251,7 +250,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -260,12 +259,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xNetworkServiceGLibType, libs, "g_network_service_get_type")
 
-	core.PuregoSafeRegister(&xNewNetworkService, libs, "g_network_service_new")
+	gobject.CheckClassSize("GNetworkServiceClass", xNetworkServiceGLibType, unsafe.Sizeof(NetworkServiceClass{}))
 
-	core.PuregoSafeRegister(&xNetworkServiceGetDomain, libs, "g_network_service_get_domain")
-	core.PuregoSafeRegister(&xNetworkServiceGetProtocol, libs, "g_network_service_get_protocol")
-	core.PuregoSafeRegister(&xNetworkServiceGetScheme, libs, "g_network_service_get_scheme")
-	core.PuregoSafeRegister(&xNetworkServiceGetService, libs, "g_network_service_get_service")
-	core.PuregoSafeRegister(&xNetworkServiceSetScheme, libs, "g_network_service_set_scheme")
+	core.PuregoRegisterSince(&xNewNetworkService, libs, "g_network_service_new", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xNetworkServiceGetDomain, libs, "g_network_service_get_domain", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkServiceGetProtocol, libs, "g_network_service_get_protocol", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkServiceGetScheme, libs, "g_network_service_get_scheme", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNetworkServiceGetService, libs, "g_network_service_get_service", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNetworkServiceSetScheme, libs, "g_network_service_set_scheme", "GIO", "2.26")
 
 }
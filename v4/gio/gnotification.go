@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -20,23 +19,22 @@ import (
 //
 // Since the user may click on a notification while the application is
 // not running, applications using `GNotification` should be able to be
-// started as a D-Bus service, using [class@Gio.Application].
+// started as a D-Bus service, using [Application].
 //
 // In order for `GNotification` to work, the application must have installed
 // a `.desktop` file. For example:
-// ```
-// [Desktop Entry]
-// Name=Test Application
-// Comment=Description of what Test Application does
-// Exec=gnome-test-application
-// Icon=org.gnome.TestApplication
-// Terminal=false
-// Type=Application
-// Categories=GNOME;GTK;TestApplication Category;
-// StartupNotify=true
-// DBusActivatable=true
-// X-GNOME-UsesNotifications=true
-// ```
+//
+//	[Desktop Entry]
+//	Name=Test Application
+//	Comment=Description of what Test Application does
+//	Exec=gnome-test-application
+//	Icon=org.gnome.TestApplication
+//	Terminal=false
+//	Type=Application
+//	Categories=GNOME;GTK;TestApplication Category;
+//	StartupNotify=true
+//	DBusActivatable=true
+//	X-GNOME-UsesNotifications=true
 //
 // The `X-GNOME-UsesNotifications` key indicates to GNOME Control Center
 // that this application uses notifications, so it can be listed in the
@@ -44,7 +42,7 @@ import (
 //
 // The `.desktop` file must be named as `org.gnome.TestApplication.desktop`,
 // where `org.gnome.TestApplication` is the ID passed to
-// [ctor@Gio.Application.new].
+// [NewApplication].
 //
 // User interaction with a notification (either the default action, or
 // buttons) must be associated with actions on the application (ie:
@@ -53,7 +51,7 @@ import (
 // the application is autostarted as a result of a notification being
 // clicked.
 //
-// A notification can be sent with [method@Gio.Application.send_notification].
+// A notification can be sent with [Application.SendNotification].
 type Notification struct {
 	gobject.Object
 }
@@ -72,12 +70,12 @@ func NotificationNewFromInternalPtr(ptr uintptr) *Notification {
 
 var xNewNotification func(string) uintptr
 
-// Creates a new #GNotification with @title as its title.
+// Creates a new [Notification] with title as its title.
 //
-// After populating @notification with more details, it can be sent to
-// the desktop shell with g_application_send_notification(). Changing
+// After populating notification with more details, it can be sent to
+// the desktop shell with [Application.SendNotification]. Changing
 // any properties after this call will not have any effect until
-// resending @notification.
+// resending notification.
 func NewNotification(TitleVar string) *Notification {
 	var cls *Notification
 
@@ -93,14 +91,14 @@ func NewNotification(TitleVar string) *Notification {
 
 var xNotificationAddButton func(uintptr, string, string)
 
-// Adds a button to @notification that activates the action in
-// @detailed_action when clicked. That action must be an
-// application-wide action (starting with "app."). If @detailed_action
+// Adds a button to notification that activates the action in
+// detailed_action when clicked. That action must be an
+// application-wide action (starting with "app."). If detailed_action
 // contains a target, the action will be activated with that target as
 // its parameter.
 //
-// See g_action_parse_detailed_name() for a description of the format
-// for @detailed_action.
+// See [ActionParseDetailedName] for a description of the format
+// for detailed_action.
 func (x *Notification) AddButton(LabelVar string, DetailedActionVar string) {
 
 	xNotificationAddButton(x.GoPointer(), LabelVar, DetailedActionVar)
@@ -109,13 +107,15 @@ func (x *Notification) AddButton(LabelVar string, DetailedActionVar string) {
 
 var xNotificationAddButtonWithTarget func(uintptr, string, string, uintptr, ...interface{})
 
-// Adds a button to @notification that activates @action when clicked.
-// @action must be an application-wide action (it must start with "app.").
+// Adds a button to notification that activates action when clicked.
+// action must be an application-wide action (it must start with "app.").
 //
-// If @target_format is given, it is used to collect remaining
-// positional parameters into a #GVariant instance, similar to
-// g_variant_new(). @action will be activated with that #GVariant as its
+// If target_format is given, it is used to collect remaining
+// positional parameters into a [glib.Variant] instance, similar to
+// [glib.NewVariant]. action will be activated with that [glib.Variant] as its
 // parameter.
+//
+// Deprecated: use [AddButtonWithTargetValue], the version of this function that language bindings are meant to use.
 func (x *Notification) AddButtonWithTarget(LabelVar string, ActionVar string, TargetFormatVar *string, varArgs ...interface{}) {
 
 	TargetFormatVarPtr := core.GStrdupNullable(TargetFormatVar)
@@ -127,10 +127,10 @@ func (x *Notification) AddButtonWithTarget(LabelVar string, ActionVar string, Ta
 
 var xNotificationAddButtonWithTargetValue func(uintptr, string, string, *glib.Variant)
 
-// Adds a button to @notification that activates @action when clicked.
-// @action must be an application-wide action (it must start with "app.").
+// Adds a button to notification that activates action when clicked.
+// action must be an application-wide action (it must start with "app.").
 //
-// If @target is non-%NULL, @action will be activated with @target as
+// If target is non-nil, action will be activated with target as
 // its parameter.
 func (x *Notification) AddButtonWithTargetValue(LabelVar string, ActionVar string, TargetVar *glib.Variant) {
 
@@ -140,7 +140,7 @@ func (x *Notification) AddButtonWithTargetValue(LabelVar string, ActionVar strin
 
 var xNotificationSetBody func(uintptr, uintptr)
 
-// Sets the body of @notification to @body.
+// Sets the body of notification to body.
 func (x *Notification) SetBody(BodyVar *string) {
 
 	BodyVarPtr := core.GStrdupNullable(BodyVar)
@@ -152,12 +152,14 @@ func (x *Notification) SetBody(BodyVar *string) {
 
 var xNotificationSetCategory func(uintptr, uintptr)
 
-// Sets the type of @notification to @category. Categories have a main
+// Sets the type of notification to category. Categories have a main
 // type like `email`, `im` or `device` and can have a detail separated
 // by a `.`, e.g. `im.received` or `email.arrived`. Setting the category
 // helps the notification server to select proper feedback to the user.
 //
-// Standard categories are [listed in the specification](https://specifications.freedesktop.org/notification-spec/latest/ar01s06.html).
+// Standard categories are [listed in the specification].
+//
+// [listed in the specification]: https://specifications.freedesktop.org/notification-spec/latest/ar01s06.html
 func (x *Notification) SetCategory(CategoryVar *string) {
 
 	CategoryVarPtr := core.GStrdupNullable(CategoryVar)
@@ -169,14 +171,14 @@ func (x *Notification) SetCategory(CategoryVar *string) {
 
 var xNotificationSetDefaultAction func(uintptr, string)
 
-// Sets the default action of @notification to @detailed_action. This
+// Sets the default action of notification to detailed_action. This
 // action is activated when the notification is clicked on.
 //
-// The action in @detailed_action must be an application-wide action (it
-// must start with "app."). If @detailed_action contains a target, the
+// The action in detailed_action must be an application-wide action (it
+// must start with "app."). If detailed_action contains a target, the
 // given action will be activated with that target as its parameter.
-// See g_action_parse_detailed_name() for a description of the format
-// for @detailed_action.
+// See [ActionParseDetailedName] for a description of the format
+// for detailed_action.
 //
 // When no default action is set, the application that the notification
 // was sent on is activated.
@@ -188,17 +190,19 @@ func (x *Notification) SetDefaultAction(DetailedActionVar string) {
 
 var xNotificationSetDefaultActionAndTarget func(uintptr, string, uintptr, ...interface{})
 
-// Sets the default action of @notification to @action. This action is
+// Sets the default action of notification to action. This action is
 // activated when the notification is clicked on. It must be an
 // application-wide action (it must start with "app.").
 //
-// If @target_format is given, it is used to collect remaining
-// positional parameters into a #GVariant instance, similar to
-// g_variant_new(). @action will be activated with that #GVariant as its
+// If target_format is given, it is used to collect remaining
+// positional parameters into a [glib.Variant] instance, similar to
+// [glib.NewVariant]. action will be activated with that [glib.Variant] as its
 // parameter.
 //
 // When no default action is set, the application that the notification
 // was sent on is activated.
+//
+// Deprecated: use [SetDefaultActionAndTargetValue], the version of this function that language bindings are meant to use.
 func (x *Notification) SetDefaultActionAndTarget(ActionVar string, TargetFormatVar *string, varArgs ...interface{}) {
 
 	TargetFormatVarPtr := core.GStrdupNullable(TargetFormatVar)
@@ -210,12 +214,12 @@ func (x *Notification) SetDefaultActionAndTarget(ActionVar string, TargetFormatV
 
 var xNotificationSetDefaultActionAndTargetValue func(uintptr, string, *glib.Variant)
 
-// Sets the default action of @notification to @action. This action is
+// Sets the default action of notification to action. This action is
 // activated when the notification is clicked on. It must be an
 // application-wide action (start with "app.").
 //
-// If @target is non-%NULL, @action will be activated with @target as
-// its parameter. If @target is floating, it will be consumed.
+// If target is non-nil, action will be activated with target as
+// its parameter. If target is floating, it will be consumed.
 //
 // When no default action is set, the application that the notification
 // was sent on is activated.
@@ -227,7 +231,7 @@ func (x *Notification) SetDefaultActionAndTargetValue(ActionVar string, TargetVa
 
 var xNotificationSetIcon func(uintptr, uintptr)
 
-// Sets the icon of @notification to @icon.
+// Sets the icon of notification to icon.
 func (x *Notification) SetIcon(IconVar Icon) {
 
 	xNotificationSetIcon(x.GoPointer(), IconVar.GoPointer())
@@ -236,8 +240,8 @@ func (x *Notification) SetIcon(IconVar Icon) {
 
 var xNotificationSetPriority func(uintptr, NotificationPriority)
 
-// Sets the priority of @notification to @priority. See
-// #GNotificationPriority for possible values.
+// Sets the priority of notification to priority. See
+// [NotificationPriority] for possible values.
 func (x *Notification) SetPriority(PriorityVar NotificationPriority) {
 
 	xNotificationSetPriority(x.GoPointer(), PriorityVar)
@@ -246,22 +250,13 @@ func (x *Notification) SetPriority(PriorityVar NotificationPriority) {
 
 var xNotificationSetTitle func(uintptr, string)
 
-// Sets the title of @notification to @title.
+// Sets the title of notification to title.
 func (x *Notification) SetTitle(TitleVar string) {
 
 	xNotificationSetTitle(x.GoPointer(), TitleVar)
 
 }
 
-var xNotificationSetUrgent func(uintptr, bool)
-
-// Deprecated in favor of g_notification_set_priority().
-func (x *Notification) SetUrgent(UrgentVar bool) {
-
-	xNotificationSetUrgent(x.GoPointer(), UrgentVar)
-
-}
-
 func (c *Notification) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -278,7 +273,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -287,19 +282,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xNotificationGLibType, libs, "g_notification_get_type")
 
-	core.PuregoSafeRegister(&xNewNotification, libs, "g_notification_new")
-
-	core.PuregoSafeRegister(&xNotificationAddButton, libs, "g_notification_add_button")
-	core.PuregoSafeRegister(&xNotificationAddButtonWithTarget, libs, "g_notification_add_button_with_target")
-	core.PuregoSafeRegister(&xNotificationAddButtonWithTargetValue, libs, "g_notification_add_button_with_target_value")
-	core.PuregoSafeRegister(&xNotificationSetBody, libs, "g_notification_set_body")
-	core.PuregoSafeRegister(&xNotificationSetCategory, libs, "g_notification_set_category")
-	core.PuregoSafeRegister(&xNotificationSetDefaultAction, libs, "g_notification_set_default_action")
-	core.PuregoSafeRegister(&xNotificationSetDefaultActionAndTarget, libs, "g_notification_set_default_action_and_target")
-	core.PuregoSafeRegister(&xNotificationSetDefaultActionAndTargetValue, libs, "g_notification_set_default_action_and_target_value")
-	core.PuregoSafeRegister(&xNotificationSetIcon, libs, "g_notification_set_icon")
-	core.PuregoSafeRegister(&xNotificationSetPriority, libs, "g_notification_set_priority")
-	core.PuregoSafeRegister(&xNotificationSetTitle, libs, "g_notification_set_title")
-	core.PuregoSafeRegister(&xNotificationSetUrgent, libs, "g_notification_set_urgent")
+	core.PuregoRegisterSince(&xNewNotification, libs, "g_notification_new", "GIO", "2.40")
+
+	core.PuregoRegisterSince(&xNotificationAddButton, libs, "g_notification_add_button", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationAddButtonWithTarget, libs, "g_notification_add_button_with_target", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationAddButtonWithTargetValue, libs, "g_notification_add_button_with_target_value", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetBody, libs, "g_notification_set_body", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetCategory, libs, "g_notification_set_category", "GIO", "2.70")
+	core.PuregoRegisterSince(&xNotificationSetDefaultAction, libs, "g_notification_set_default_action", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetDefaultActionAndTarget, libs, "g_notification_set_default_action_and_target", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetDefaultActionAndTargetValue, libs, "g_notification_set_default_action_and_target_value", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetIcon, libs, "g_notification_set_icon", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetPriority, libs, "g_notification_set_priority", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNotificationSetTitle, libs, "g_notification_set_title", "GIO", "2.40")
 
 }
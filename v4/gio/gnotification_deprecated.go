@@ -0,0 +1,37 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNotificationSetUrgent func(uintptr, bool)
+
+// Deprecated in favor of [Notification.SetPriority].
+//
+// Deprecated: since 2.42. Since 2.42, this has been deprecated in favour of
+//
+//	[Notification.SetPriority].
+func (x *Notification) SetUrgent(UrgentVar bool) {
+
+	xNotificationSetUrgent(x.GoPointer(), UrgentVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNotificationSetUrgent, libs, "g_notification_set_urgent", "GIO", "2.40")
+
+}
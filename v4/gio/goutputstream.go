@@ -2,10 +2,10 @@
 package gio
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -67,7 +67,8 @@ func (x *OutputStreamClass) OverrideWriteFn(cb func(*OutputStream, []byte, uint,
 	if cb == nil {
 		x.xWriteFn = 0
 	} else {
-		x.xWriteFn = purego.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, CancellableVarp uintptr) int {
+		x.xWriteFn = core.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), BufferVarp, CountVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -79,7 +80,7 @@ func (x *OutputStreamClass) GetWriteFn() func(*OutputStream, []byte, uint, *Canc
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xWriteFn)
+	core.RegisterFunc(&rawCallback, x.xWriteFn)
 	return func(StreamVar *OutputStream, BufferVar []byte, CountVar uint, CancellableVar *Cancellable) int {
 		return rawCallback(StreamVar.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer())
 	}
@@ -90,7 +91,8 @@ func (x *OutputStreamClass) OverrideSplice(cb func(*OutputStream, *InputStream,
 	if cb == nil {
 		x.xSplice = 0
 	} else {
-		x.xSplice = purego.NewCallback(func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, CancellableVarp uintptr) int {
+		x.xSplice = core.NewCallback(func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, CancellableVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), InputStreamNewFromInternalPtr(SourceVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -102,7 +104,7 @@ func (x *OutputStreamClass) GetSplice() func(*OutputStream, *InputStream, Output
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, CancellableVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xSplice)
+	core.RegisterFunc(&rawCallback, x.xSplice)
 	return func(StreamVar *OutputStream, SourceVar *InputStream, FlagsVar OutputStreamSpliceFlags, CancellableVar *Cancellable) int {
 		return rawCallback(StreamVar.GoPointer(), SourceVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -113,7 +115,8 @@ func (x *OutputStreamClass) OverrideFlush(cb func(*OutputStream, *Cancellable) b
 	if cb == nil {
 		x.xFlush = 0
 	} else {
-		x.xFlush = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+		x.xFlush = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -125,7 +128,7 @@ func (x *OutputStreamClass) GetFlush() func(*OutputStream, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xFlush)
+	core.RegisterFunc(&rawCallback, x.xFlush)
 	return func(StreamVar *OutputStream, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -136,7 +139,8 @@ func (x *OutputStreamClass) OverrideCloseFn(cb func(*OutputStream, *Cancellable)
 	if cb == nil {
 		x.xCloseFn = 0
 	} else {
-		x.xCloseFn = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+		x.xCloseFn = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -148,7 +152,7 @@ func (x *OutputStreamClass) GetCloseFn() func(*OutputStream, *Cancellable) bool
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFn)
+	core.RegisterFunc(&rawCallback, x.xCloseFn)
 	return func(StreamVar *OutputStream, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -159,7 +163,8 @@ func (x *OutputStreamClass) OverrideWriteAsync(cb func(*OutputStream, []byte, ui
 	if cb == nil {
 		x.xWriteAsync = 0
 	} else {
-		x.xWriteAsync = purego.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xWriteAsync = core.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(OutputStreamNewFromInternalPtr(StreamVarp), BufferVarp, CountVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -171,7 +176,7 @@ func (x *OutputStreamClass) GetWriteAsync() func(*OutputStream, []byte, uint, in
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xWriteAsync)
+	core.RegisterFunc(&rawCallback, x.xWriteAsync)
 	return func(StreamVar *OutputStream, BufferVar []byte, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), BufferVar, CountVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -182,7 +187,8 @@ func (x *OutputStreamClass) OverrideWriteFinish(cb func(*OutputStream, AsyncResu
 	if cb == nil {
 		x.xWriteFinish = 0
 	} else {
-		x.xWriteFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+		x.xWriteFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -194,7 +200,7 @@ func (x *OutputStreamClass) GetWriteFinish() func(*OutputStream, AsyncResult) in
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xWriteFinish)
+	core.RegisterFunc(&rawCallback, x.xWriteFinish)
 	return func(StreamVar *OutputStream, ResultVar AsyncResult) int {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -205,7 +211,8 @@ func (x *OutputStreamClass) OverrideSpliceAsync(cb func(*OutputStream, *InputStr
 	if cb == nil {
 		x.xSpliceAsync = 0
 	} else {
-		x.xSpliceAsync = purego.NewCallback(func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xSpliceAsync = core.NewCallback(func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(OutputStreamNewFromInternalPtr(StreamVarp), InputStreamNewFromInternalPtr(SourceVarp), FlagsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -217,7 +224,7 @@ func (x *OutputStreamClass) GetSpliceAsync() func(*OutputStream, *InputStream, O
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, SourceVarp uintptr, FlagsVarp OutputStreamSpliceFlags, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSpliceAsync)
+	core.RegisterFunc(&rawCallback, x.xSpliceAsync)
 	return func(StreamVar *OutputStream, SourceVar *InputStream, FlagsVar OutputStreamSpliceFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), SourceVar.GoPointer(), FlagsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -228,7 +235,8 @@ func (x *OutputStreamClass) OverrideSpliceFinish(cb func(*OutputStream, AsyncRes
 	if cb == nil {
 		x.xSpliceFinish = 0
 	} else {
-		x.xSpliceFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+		x.xSpliceFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -240,7 +248,7 @@ func (x *OutputStreamClass) GetSpliceFinish() func(*OutputStream, AsyncResult) i
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xSpliceFinish)
+	core.RegisterFunc(&rawCallback, x.xSpliceFinish)
 	return func(StreamVar *OutputStream, ResultVar AsyncResult) int {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -251,7 +259,8 @@ func (x *OutputStreamClass) OverrideFlushAsync(cb func(*OutputStream, int, *Canc
 	if cb == nil {
 		x.xFlushAsync = 0
 	} else {
-		x.xFlushAsync = purego.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xFlushAsync = core.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(OutputStreamNewFromInternalPtr(StreamVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -263,7 +272,7 @@ func (x *OutputStreamClass) GetFlushAsync() func(*OutputStream, int, *Cancellabl
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFlushAsync)
+	core.RegisterFunc(&rawCallback, x.xFlushAsync)
 	return func(StreamVar *OutputStream, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -274,7 +283,8 @@ func (x *OutputStreamClass) OverrideFlushFinish(cb func(*OutputStream, AsyncResu
 	if cb == nil {
 		x.xFlushFinish = 0
 	} else {
-		x.xFlushFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+		x.xFlushFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -286,7 +296,7 @@ func (x *OutputStreamClass) GetFlushFinish() func(*OutputStream, AsyncResult) bo
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xFlushFinish)
+	core.RegisterFunc(&rawCallback, x.xFlushFinish)
 	return func(StreamVar *OutputStream, ResultVar AsyncResult) bool {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -297,7 +307,8 @@ func (x *OutputStreamClass) OverrideCloseAsync(cb func(*OutputStream, int, *Canc
 	if cb == nil {
 		x.xCloseAsync = 0
 	} else {
-		x.xCloseAsync = purego.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xCloseAsync = core.NewCallback(func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(OutputStreamNewFromInternalPtr(StreamVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -309,7 +320,7 @@ func (x *OutputStreamClass) GetCloseAsync() func(*OutputStream, int, *Cancellabl
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCloseAsync)
+	core.RegisterFunc(&rawCallback, x.xCloseAsync)
 	return func(StreamVar *OutputStream, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -320,7 +331,8 @@ func (x *OutputStreamClass) OverrideCloseFinish(cb func(*OutputStream, AsyncResu
 	if cb == nil {
 		x.xCloseFinish = 0
 	} else {
-		x.xCloseFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+		x.xCloseFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -332,7 +344,7 @@ func (x *OutputStreamClass) GetCloseFinish() func(*OutputStream, AsyncResult) bo
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseFinish)
+	core.RegisterFunc(&rawCallback, x.xCloseFinish)
 	return func(StreamVar *OutputStream, ResultVar AsyncResult) bool {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -343,7 +355,8 @@ func (x *OutputStreamClass) OverrideWritevFn(cb func(*OutputStream, []OutputVect
 	if cb == nil {
 		x.xWritevFn = 0
 	} else {
-		x.xWritevFn = purego.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint, CancellableVarp uintptr) bool {
+		x.xWritevFn = core.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), VectorsVarp, NVectorsVarp, BytesWrittenVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -355,7 +368,7 @@ func (x *OutputStreamClass) GetWritevFn() func(*OutputStream, []OutputVector, ui
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xWritevFn)
+	core.RegisterFunc(&rawCallback, x.xWritevFn)
 	return func(StreamVar *OutputStream, VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) bool {
 		return rawCallback(StreamVar.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer())
 	}
@@ -366,7 +379,8 @@ func (x *OutputStreamClass) OverrideWritevAsync(cb func(*OutputStream, []OutputV
 	if cb == nil {
 		x.xWritevAsync = 0
 	} else {
-		x.xWritevAsync = purego.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xWritevAsync = core.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(OutputStreamNewFromInternalPtr(StreamVarp), VectorsVarp, NVectorsVarp, IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -378,7 +392,7 @@ func (x *OutputStreamClass) GetWritevAsync() func(*OutputStream, []OutputVector,
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xWritevAsync)
+	core.RegisterFunc(&rawCallback, x.xWritevAsync)
 	return func(StreamVar *OutputStream, VectorsVar []OutputVector, NVectorsVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(StreamVar.GoPointer(), VectorsVar, NVectorsVar, IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -389,7 +403,8 @@ func (x *OutputStreamClass) OverrideWritevFinish(cb func(*OutputStream, AsyncRes
 	if cb == nil {
 		x.xWritevFinish = 0
 	} else {
-		x.xWritevFinish = purego.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr, BytesWrittenVarp *uint) bool {
+		x.xWritevFinish = core.NewCallback(func(StreamVarp uintptr, ResultVarp uintptr, BytesWrittenVarp *uint) bool {
+			defer core.RecoverPanic()
 			return cb(OutputStreamNewFromInternalPtr(StreamVarp), &AsyncResultBase{Ptr: ResultVarp}, BytesWrittenVarp)
 		})
 	}
@@ -401,7 +416,7 @@ func (x *OutputStreamClass) GetWritevFinish() func(*OutputStream, AsyncResult, *
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, ResultVarp uintptr, BytesWrittenVarp *uint) bool
-	purego.RegisterFunc(&rawCallback, x.xWritevFinish)
+	core.RegisterFunc(&rawCallback, x.xWritevFinish)
 	return func(StreamVar *OutputStream, ResultVar AsyncResult, BytesWrittenVar *uint) bool {
 		return rawCallback(StreamVar.GoPointer(), ResultVar.GoPointer(), BytesWrittenVar)
 	}
@@ -412,7 +427,8 @@ func (x *OutputStreamClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -424,7 +440,7 @@ func (x *OutputStreamClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -435,7 +451,8 @@ func (x *OutputStreamClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -447,7 +464,7 @@ func (x *OutputStreamClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -458,7 +475,8 @@ func (x *OutputStreamClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -470,7 +488,7 @@ func (x *OutputStreamClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -481,7 +499,8 @@ func (x *OutputStreamClass) OverrideGReserved7(cb func()) {
 	if cb == nil {
 		x.xGReserved7 = 0
 	} else {
-		x.xGReserved7 = purego.NewCallback(func() {
+		x.xGReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -493,7 +512,7 @@ func (x *OutputStreamClass) GetGReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved7)
+	core.RegisterFunc(&rawCallback, x.xGReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -504,7 +523,8 @@ func (x *OutputStreamClass) OverrideGReserved8(cb func()) {
 	if cb == nil {
 		x.xGReserved8 = 0
 	} else {
-		x.xGReserved8 = purego.NewCallback(func() {
+		x.xGReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -516,7 +536,7 @@ func (x *OutputStreamClass) GetGReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved8)
+	core.RegisterFunc(&rawCallback, x.xGReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -532,14 +552,14 @@ func (x *OutputStreamPrivate) GoPointer() uintptr {
 
 // `GOutputStream` is a base class for implementing streaming output.
 //
-// It has functions to write to a stream ([method@Gio.OutputStream.write]),
-// to close a stream ([method@Gio.OutputStream.close]) and to flush pending
-// writes ([method@Gio.OutputStream.flush]).
+// It has functions to write to a stream ([OutputStream.Write]),
+// to close a stream ([OutputStream.Close]) and to flush pending
+// writes ([OutputStream.Flush]).
 //
 // To copy the content of an input stream to an output stream without
-// manually handling the reads and writes, use [method@Gio.OutputStream.splice].
+// manually handling the reads and writes, use [OutputStream.Splice].
 //
-// See the documentation for [class@Gio.IOStream] for details of thread safety
+// See the documentation for [IOStream] for details of thread safety
 // of streaming APIs.
 //
 // All of these functions have async variants too.
@@ -565,7 +585,7 @@ func OutputStreamNewFromInternalPtr(ptr uintptr) *OutputStream {
 
 var xOutputStreamClearPending func(uintptr)
 
-// Clears the pending flag on @stream.
+// Clears the pending flag on stream.
 func (x *OutputStream) ClearPending() {
 
 	xOutputStreamClearPending(x.GoPointer())
@@ -576,7 +596,7 @@ var xOutputStreamClose func(uintptr, uintptr, **glib.Error) bool
 
 // Closes the stream, releasing resources related to it.
 //
-// Once the stream is closed, all other operations will return %G_IO_ERROR_CLOSED.
+// Once the stream is closed, all other operations will return [GIoErrorClosedValue].
 // Closing a stream multiple times will not return an error.
 //
 // Closing a stream will automatically flush any outstanding buffers in the
@@ -592,13 +612,13 @@ var xOutputStreamClose func(uintptr, uintptr, **glib.Error) bool
 //
 // On failure the first error that happened will be reported, but the close
 // operation will finish as much as possible. A stream that failed to
-// close will still return %G_IO_ERROR_CLOSED for all operations. Still, it
+// close will still return [GIoErrorClosedValue] for all operations. Still, it
 // is important to check and report the error to the user, otherwise
 // there might be a loss of data as all data might not be written.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 // Cancelling a close will still leave the stream closed, but there some streams
 // can use a faster close that doesn't block to e.g. check errors. On
 // cancellation (as with any error) there is no guarantee that all written
@@ -608,7 +628,7 @@ func (x *OutputStream) Close(CancellableVar *Cancellable) (bool, error) {
 
 	cret := xOutputStreamClose(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -617,11 +637,11 @@ func (x *OutputStream) Close(CancellableVar *Cancellable) (bool, error) {
 var xOutputStreamCloseAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Requests an asynchronous close of the stream, releasing resources
-// related to it. When the operation is finished @callback will be
-// called. You can then call g_output_stream_close_finish() to get
+// related to it. When the operation is finished callback will be
+// called. You can then call [OutputStream.CloseFinish] to get
 // the result of the operation.
 //
-// For behaviour details see g_output_stream_close().
+// For behaviour details see [OutputStream.Close].
 //
 // The asynchronous methods have a default fallback that uses threads
 // to implement asynchronicity, so they are optional for inheriting
@@ -635,11 +655,12 @@ func (x *OutputStream) CloseAsync(IoPriorityVar int, CancellableVar *Cancellable
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -655,7 +676,7 @@ func (x *OutputStream) CloseFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := xOutputStreamCloseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -664,20 +685,20 @@ func (x *OutputStream) CloseFinish(ResultVar AsyncResult) (bool, error) {
 var xOutputStreamFlush func(uintptr, uintptr, **glib.Error) bool
 
 // Forces a write of all user-space buffered data for the given
-// @stream. Will block during the operation. Closing the stream will
+// stream. Will block during the operation. Closing the stream will
 // implicitly cause a flush.
 //
 // This function is optional for inherited classes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *OutputStream) Flush(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamFlush(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -686,11 +707,11 @@ func (x *OutputStream) Flush(CancellableVar *Cancellable) (bool, error) {
 var xOutputStreamFlushAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
 // Forces an asynchronous write of all user-space buffered data for
-// the given @stream.
-// For behaviour details see g_output_stream_flush().
+// the given stream.
+// For behaviour details see [OutputStream.Flush].
 //
-// When the operation is finished @callback will be
-// called. You can then call g_output_stream_flush_finish() to get the
+// When the operation is finished callback will be
+// called. You can then call [OutputStream.FlushFinish] to get the
 // result of the operation.
 func (x *OutputStream) FlushAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -701,11 +722,12 @@ func (x *OutputStream) FlushAsync(IoPriorityVar int, CancellableVar *Cancellable
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -721,7 +743,7 @@ func (x *OutputStream) FlushFinish(ResultVar AsyncResult) (bool, error) {
 
 	cret := xOutputStreamFlushFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -759,35 +781,37 @@ func (x *OutputStream) IsClosing() bool {
 
 var xOutputStreamPrintf func(uintptr, *uint, uintptr, **glib.Error, string, ...interface{}) bool
 
-// This is a utility function around g_output_stream_write_all(). It
-// uses g_strdup_vprintf() to turn @format and @... into a string that
-// is then written to @stream.
+// This is a utility function around [OutputStream.WriteAll]. It
+// uses [glib.StrdupVprintf] to turn format and @... into a string that
+// is then written to stream.
 //
-// See the documentation of g_output_stream_write_all() about the
+// See the documentation of [OutputStream.WriteAll] about the
 // behavior of the actual write operation.
 //
 // Note that partial writes cannot be properly checked with this
 // function due to the variable length of the written string, if you
 // need precise control over partial write failures, you need to
-// create you own printf()-like wrapper around g_output_stream_write()
-// or g_output_stream_write_all().
+// create you own printf()-like wrapper around [OutputStream.Write]
+// or [OutputStream.WriteAll].
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *OutputStream) Printf(BytesWrittenVar *uint, CancellableVar *Cancellable, ErrorVar **glib.Error, FormatVar string, varArgs ...interface{}) bool {
 
-	cret := xOutputStreamPrintf(x.GoPointer(), BytesWrittenVar, CancellableVar.GoPointer(), ErrorVar, FormatVar, varArgs...)
+	cret := xOutputStreamPrintf(x.GoPointer(), BytesWrittenVar, CancellableVar.GoPointer(), ErrorVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 	return cret
 }
 
 var xOutputStreamSetPending func(uintptr) bool
 
-// Sets @stream to have actions pending. If the pending flag is
-// already set or @stream is closed, it will return %FALSE and set
-// @error.
+// Sets stream to have actions pending. If the pending flag is
+// already set or stream is closed, it will return false and set
+// error.
 func (x *OutputStream) SetPending() (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamSetPending(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -801,7 +825,7 @@ func (x *OutputStream) Splice(SourceVar *InputStream, FlagsVar OutputStreamSplic
 
 	cret := xOutputStreamSplice(x.GoPointer(), SourceVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -810,12 +834,12 @@ func (x *OutputStream) Splice(SourceVar *InputStream, FlagsVar OutputStreamSplic
 var xOutputStreamSpliceAsync func(uintptr, uintptr, OutputStreamSpliceFlags, int, uintptr, uintptr, uintptr)
 
 // Splices a stream asynchronously.
-// When the operation is finished @callback will be called.
-// You can then call g_output_stream_splice_finish() to get the
+// When the operation is finished callback will be called.
+// You can then call [OutputStream.SpliceFinish] to get the
 // result of the operation.
 //
 // For the synchronous, blocking version of this function, see
-// g_output_stream_splice().
+// [OutputStream.Splice].
 func (x *OutputStream) SpliceAsync(SourceVar *InputStream, FlagsVar OutputStreamSpliceFlags, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -825,11 +849,12 @@ func (x *OutputStream) SpliceAsync(SourceVar *InputStream, FlagsVar OutputStream
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -845,7 +870,7 @@ func (x *OutputStream) SpliceFinish(ResultVar AsyncResult) (int, error) {
 
 	cret := xOutputStreamSpliceFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -853,18 +878,18 @@ func (x *OutputStream) SpliceFinish(ResultVar AsyncResult) (int, error) {
 
 var xOutputStreamVprintf func(uintptr, *uint, uintptr, **glib.Error, string, []interface{}) bool
 
-// This is a utility function around g_output_stream_write_all(). It
-// uses g_strdup_vprintf() to turn @format and @args into a string that
-// is then written to @stream.
+// This is a utility function around [OutputStream.WriteAll]. It
+// uses [glib.StrdupVprintf] to turn format and args into a string that
+// is then written to stream.
 //
-// See the documentation of g_output_stream_write_all() about the
+// See the documentation of [OutputStream.WriteAll] about the
 // behavior of the actual write operation.
 //
 // Note that partial writes cannot be properly checked with this
 // function due to the variable length of the written string, if you
 // need precise control over partial write failures, you need to
-// create you own printf()-like wrapper around g_output_stream_write()
-// or g_output_stream_write_all().
+// create you own printf()-like wrapper around [OutputStream.Write]
+// or [OutputStream.WriteAll].
 func (x *OutputStream) Vprintf(BytesWrittenVar *uint, CancellableVar *Cancellable, ErrorVar **glib.Error, FormatVar string, ArgsVar []interface{}) bool {
 
 	cret := xOutputStreamVprintf(x.GoPointer(), BytesWrittenVar, CancellableVar.GoPointer(), ErrorVar, FormatVar, ArgsVar)
@@ -873,32 +898,32 @@ func (x *OutputStream) Vprintf(BytesWrittenVar *uint, CancellableVar *Cancellabl
 
 var xOutputStreamWrite func(uintptr, []byte, uint, uintptr, **glib.Error) int
 
-// Tries to write @count bytes from @buffer into the stream. Will block
+// Tries to write count bytes from buffer into the stream. Will block
 // during the operation.
 //
-// If count is 0, returns 0 and does nothing. A value of @count
-// larger than %G_MAXSSIZE will cause a %G_IO_ERROR_INVALID_ARGUMENT error.
+// If count is 0, returns 0 and does nothing. A value of count
+// larger than G_MAXSSIZE will cause a [GIoErrorInvalidArgumentValue] error.
 //
 // On success, the number of bytes written to the stream is returned.
 // It is not an error if this is not the same as the requested size, as it
 // can happen e.g. on a partial I/O error, or if there is not enough
 // storage in the stream. All writes block until at least one byte
 // is written or an error occurs; 0 is never returned (unless
-// @count is 0).
+// count is 0).
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// On error -1 is returned and @error is set accordingly.
+// On error -1 is returned and error is set accordingly.
 func (x *OutputStream) Write(BufferVar []byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWrite(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -906,31 +931,31 @@ func (x *OutputStream) Write(BufferVar []byte, CountVar uint, CancellableVar *Ca
 
 var xOutputStreamWriteAll func(uintptr, []byte, uint, *uint, uintptr, **glib.Error) bool
 
-// Tries to write @count bytes from @buffer into the stream. Will block
+// Tries to write count bytes from buffer into the stream. Will block
 // during the operation.
 //
-// This function is similar to g_output_stream_write(), except it tries to
+// This function is similar to [OutputStream.Write], except it tries to
 // write as many bytes as requested, only stopping on an error.
 //
-// On a successful write of @count bytes, %TRUE is returned, and @bytes_written
-// is set to @count.
+// On a successful write of count bytes, true is returned, and bytes_written
+// is set to count.
 //
-// If there is an error during the operation %FALSE is returned and @error
+// If there is an error during the operation false is returned and error
 // is set to indicate the error status.
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_written will be set to the number of bytes that were
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_written will be set to the number of bytes that were
 // successfully written before the error was encountered.  This
 // functionality is only available from C.  If you need it from another
 // language then you must write your own loop around
-// g_output_stream_write().
+// [OutputStream.Write].
 func (x *OutputStream) WriteAll(BufferVar []byte, CountVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWriteAll(x.GoPointer(), BufferVar, CountVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -938,21 +963,21 @@ func (x *OutputStream) WriteAll(BufferVar []byte, CountVar uint, BytesWrittenVar
 
 var xOutputStreamWriteAllAsync func(uintptr, []byte, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous write of @count bytes from @buffer into
-// the stream. When the operation is finished @callback will be called.
-// You can then call g_output_stream_write_all_finish() to get the result of the
+// Request an asynchronous write of count bytes from buffer into
+// the stream. When the operation is finished callback will be called.
+// You can then call [OutputStream.WriteAllFinish] to get the result of the
 // operation.
 //
-// This is the asynchronous version of g_output_stream_write_all().
+// This is the asynchronous version of [OutputStream.WriteAll].
 //
-// Call g_output_stream_write_all_finish() to collect the result.
+// Call [OutputStream.WriteAllFinish] to collect the result.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 //
-// Note that no copy of @buffer will be made, so it must stay valid
-// until @callback is called.
+// Note that no copy of buffer will be made, so it must stay valid
+// until callback is called.
 func (x *OutputStream) WriteAllAsync(BufferVar []byte, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -962,11 +987,12 @@ func (x *OutputStream) WriteAllAsync(BufferVar []byte, CountVar uint, IoPriority
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -977,21 +1003,21 @@ func (x *OutputStream) WriteAllAsync(BufferVar []byte, CountVar uint, IoPriority
 var xOutputStreamWriteAllFinish func(uintptr, uintptr, *uint, **glib.Error) bool
 
 // Finishes an asynchronous stream write operation started with
-// g_output_stream_write_all_async().
+// [OutputStream.WriteAllAsync].
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_written will be set to the number of bytes that were
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_written will be set to the number of bytes that were
 // successfully written before the error was encountered.  This
 // functionality is only available from C.  If you need it from another
 // language then you must write your own loop around
-// g_output_stream_write_async().
+// [OutputStream.WriteAsync].
 func (x *OutputStream) WriteAllFinish(ResultVar AsyncResult, BytesWrittenVar *uint) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWriteAllFinish(x.GoPointer(), ResultVar.GoPointer(), BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -999,40 +1025,40 @@ func (x *OutputStream) WriteAllFinish(ResultVar AsyncResult, BytesWrittenVar *ui
 
 var xOutputStreamWriteAsync func(uintptr, []byte, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous write of @count bytes from @buffer into
-// the stream. When the operation is finished @callback will be called.
-// You can then call g_output_stream_write_finish() to get the result of the
+// Request an asynchronous write of count bytes from buffer into
+// the stream. When the operation is finished callback will be called.
+// You can then call [OutputStream.WriteFinish] to get the result of the
 // operation.
 //
 // During an async request no other sync and async calls are allowed,
-// and will result in %G_IO_ERROR_PENDING errors.
+// and will result in [GIoErrorPendingValue] errors.
 //
-// A value of @count larger than %G_MAXSSIZE will cause a
-// %G_IO_ERROR_INVALID_ARGUMENT error.
+// A value of count larger than G_MAXSSIZE will cause a
+// [GIoErrorInvalidArgumentValue] error.
 //
 // On success, the number of bytes written will be passed to the
-// @callback. It is not an error if this is not the same as the
+// callback. It is not an error if this is not the same as the
 // requested size, as it can happen e.g. on a partial I/O error,
 // but generally we try to write as many bytes as requested.
 //
 // You are guaranteed that this method will never fail with
-// %G_IO_ERROR_WOULD_BLOCK - if @stream can't accept more data, the
+// [GIoErrorWouldBlockValue] - if stream can't accept more data, the
 // method will just wait until this changes.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 //
 // The asynchronous methods have a default fallback that uses threads
 // to implement asynchronicity, so they are optional for inheriting
 // classes. However, if you override one you must override all.
 //
 // For the synchronous, blocking version of this function, see
-// g_output_stream_write().
+// [OutputStream.Write].
 //
-// Note that no copy of @buffer will be made, so it must stay valid
-// until @callback is called. See g_output_stream_write_bytes_async()
-// for a #GBytes version that will automatically hold a reference to
+// Note that no copy of buffer will be made, so it must stay valid
+// until callback is called. See [OutputStream.WriteBytesAsync]
+// for a [glib.Bytes] version that will automatically hold a reference to
 // the contents (without copying) for the duration of the call.
 func (x *OutputStream) WriteAsync(BufferVar []byte, CountVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -1043,11 +1069,12 @@ func (x *OutputStream) WriteAsync(BufferVar []byte, CountVar uint, IoPriorityVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1057,23 +1084,26 @@ func (x *OutputStream) WriteAsync(BufferVar []byte, CountVar uint, IoPriorityVar
 
 var xOutputStreamWriteBytes func(uintptr, *glib.Bytes, uintptr, **glib.Error) int
 
-// A wrapper function for g_output_stream_write() which takes a
-// #GBytes as input.  This can be more convenient for use by language
-// bindings or in other cases where the refcounted nature of #GBytes
+// A wrapper function for [OutputStream.Write] which takes a
+// [glib.Bytes] as input.  This can be more convenient for use by language
+// bindings or in other cases where the refcounted nature of [glib.Bytes]
 // is helpful over a bare pointer interface.
 //
 // However, note that this function may still perform partial writes,
-// just like g_output_stream_write().  If that occurs, to continue
-// writing, you will need to create a new #GBytes containing just the
-// remaining bytes, using g_bytes_new_from_bytes(). Passing the same
-// #GBytes instance multiple times potentially can result in duplicated
+// just like [OutputStream.Write].  If that occurs, to continue
+// writing, you will need to create a new [glib.Bytes] containing just the
+// remaining bytes, using [glib.Bytes.NewFromBytes]. Passing the same
+// [glib.Bytes] instance multiple times potentially can result in duplicated
 // data in the output stream.
-func (x *OutputStream) WriteBytes(BytesVar *glib.Bytes, CancellableVar *Cancellable) (int, error) {
+func (x *OutputStream) WriteBytes(BytesVar []byte, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
-	cret := xOutputStreamWriteBytes(x.GoPointer(), BytesVar, CancellableVar.GoPointer(), &cerr)
+	BytesVarBytes := glib.NewBytesFromSlice(BytesVar)
+	defer BytesVarBytes.Unref()
+
+	cret := xOutputStreamWriteBytes(x.GoPointer(), BytesVarBytes, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1081,19 +1111,19 @@ func (x *OutputStream) WriteBytes(BytesVar *glib.Bytes, CancellableVar *Cancella
 
 var xOutputStreamWriteBytesAsync func(uintptr, *glib.Bytes, int, uintptr, uintptr, uintptr)
 
-// This function is similar to g_output_stream_write_async(), but
-// takes a #GBytes as input.  Due to the refcounted nature of #GBytes,
+// This function is similar to [OutputStream.WriteAsync], but
+// takes a [glib.Bytes] as input.  Due to the refcounted nature of [glib.Bytes],
 // this allows the stream to avoid taking a copy of the data.
 //
 // However, note that this function may still perform partial writes,
-// just like g_output_stream_write_async(). If that occurs, to continue
-// writing, you will need to create a new #GBytes containing just the
-// remaining bytes, using g_bytes_new_from_bytes(). Passing the same
-// #GBytes instance multiple times potentially can result in duplicated
+// just like [OutputStream.WriteAsync]. If that occurs, to continue
+// writing, you will need to create a new [glib.Bytes] containing just the
+// remaining bytes, using [glib.Bytes.NewFromBytes]. Passing the same
+// [glib.Bytes] instance multiple times potentially can result in duplicated
 // data in the output stream.
 //
 // For the synchronous, blocking version of this function, see
-// g_output_stream_write_bytes().
+// [OutputStream.WriteBytes].
 func (x *OutputStream) WriteBytesAsync(BytesVar *glib.Bytes, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1103,11 +1133,12 @@ func (x *OutputStream) WriteBytesAsync(BytesVar *glib.Bytes, IoPriorityVar int,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1117,13 +1148,13 @@ func (x *OutputStream) WriteBytesAsync(BytesVar *glib.Bytes, IoPriorityVar int,
 
 var xOutputStreamWriteBytesFinish func(uintptr, uintptr, **glib.Error) int
 
-// Finishes a stream write-from-#GBytes operation.
+// Finishes a stream write-from-[glib.Bytes] operation.
 func (x *OutputStream) WriteBytesFinish(ResultVar AsyncResult) (int, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWriteBytesFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1137,7 +1168,7 @@ func (x *OutputStream) WriteFinish(ResultVar AsyncResult) (int, error) {
 
 	cret := xOutputStreamWriteFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1145,10 +1176,10 @@ func (x *OutputStream) WriteFinish(ResultVar AsyncResult) (int, error) {
 
 var xOutputStreamWritev func(uintptr, []OutputVector, uint, *uint, uintptr, **glib.Error) bool
 
-// Tries to write the bytes contained in the @n_vectors @vectors into the
+// Tries to write the bytes contained in the n_vectors vectors into the
 // stream. Will block during the operation.
 //
-// If @n_vectors is 0 or the sum of all bytes in @vectors is 0, returns 0 and
+// If n_vectors is 0 or the sum of all bytes in vectors is 0, returns 0 and
 // does nothing.
 //
 // On success, the number of bytes written to the stream is returned.
@@ -1156,24 +1187,24 @@ var xOutputStreamWritev func(uintptr, []OutputVector, uint, *uint, uintptr, **gl
 // can happen e.g. on a partial I/O error, or if there is not enough
 // storage in the stream. All writes block until at least one byte
 // is written or an error occurs; 0 is never returned (unless
-// @n_vectors is 0 or the sum of all bytes in @vectors is 0).
+// n_vectors is 0 or the sum of all bytes in vectors is 0).
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 //
-// Some implementations of g_output_stream_writev() may have limitations on the
-// aggregate buffer size, and will return %G_IO_ERROR_INVALID_ARGUMENT if these
+// Some implementations of [OutputStream.Writev] may have limitations on the
+// aggregate buffer size, and will return [GIoErrorInvalidArgumentValue] if these
 // are exceeded. For example, when writing to a local file on UNIX platforms,
-// the aggregate buffer size must not exceed %G_MAXSSIZE bytes.
+// the aggregate buffer size must not exceed G_MAXSSIZE bytes.
 func (x *OutputStream) Writev(VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWritev(x.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1181,34 +1212,34 @@ func (x *OutputStream) Writev(VectorsVar []OutputVector, NVectorsVar uint, Bytes
 
 var xOutputStreamWritevAll func(uintptr, []OutputVector, uint, *uint, uintptr, **glib.Error) bool
 
-// Tries to write the bytes contained in the @n_vectors @vectors into the
+// Tries to write the bytes contained in the n_vectors vectors into the
 // stream. Will block during the operation.
 //
-// This function is similar to g_output_stream_writev(), except it tries to
+// This function is similar to [OutputStream.Writev], except it tries to
 // write as many bytes as requested, only stopping on an error.
 //
-// On a successful write of all @n_vectors vectors, %TRUE is returned, and
-// @bytes_written is set to the sum of all the sizes of @vectors.
+// On a successful write of all n_vectors vectors, true is returned, and
+// bytes_written is set to the sum of all the sizes of vectors.
 //
-// If there is an error during the operation %FALSE is returned and @error
+// If there is an error during the operation false is returned and error
 // is set to indicate the error status.
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_written will be set to the number of bytes that were
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_written will be set to the number of bytes that were
 // successfully written before the error was encountered.  This
 // functionality is only available from C. If you need it from another
 // language then you must write your own loop around
-// g_output_stream_write().
+// [OutputStream.Write].
 //
-// The content of the individual elements of @vectors might be changed by this
+// The content of the individual elements of vectors might be changed by this
 // function.
 func (x *OutputStream) WritevAll(VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWritevAll(x.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1216,22 +1247,22 @@ func (x *OutputStream) WritevAll(VectorsVar []OutputVector, NVectorsVar uint, By
 
 var xOutputStreamWritevAllAsync func(uintptr, []OutputVector, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous write of the bytes contained in the @n_vectors @vectors into
-// the stream. When the operation is finished @callback will be called.
-// You can then call g_output_stream_writev_all_finish() to get the result of the
+// Request an asynchronous write of the bytes contained in the n_vectors vectors into
+// the stream. When the operation is finished callback will be called.
+// You can then call [OutputStream.WritevAllFinish] to get the result of the
 // operation.
 //
-// This is the asynchronous version of g_output_stream_writev_all().
+// This is the asynchronous version of [OutputStream.WritevAll].
 //
-// Call g_output_stream_writev_all_finish() to collect the result.
+// Call [OutputStream.WritevAllFinish] to collect the result.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 //
-// Note that no copy of @vectors will be made, so it must stay valid
-// until @callback is called. The content of the individual elements
-// of @vectors might be changed by this function.
+// Note that no copy of vectors will be made, so it must stay valid
+// until callback is called. The content of the individual elements
+// of vectors might be changed by this function.
 func (x *OutputStream) WritevAllAsync(VectorsVar []OutputVector, NVectorsVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1241,11 +1272,12 @@ func (x *OutputStream) WritevAllAsync(VectorsVar []OutputVector, NVectorsVar uin
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1256,21 +1288,21 @@ func (x *OutputStream) WritevAllAsync(VectorsVar []OutputVector, NVectorsVar uin
 var xOutputStreamWritevAllFinish func(uintptr, uintptr, *uint, **glib.Error) bool
 
 // Finishes an asynchronous stream write operation started with
-// g_output_stream_writev_all_async().
+// [OutputStream.WritevAllAsync].
 //
 // As a special exception to the normal conventions for functions that
-// use #GError, if this function returns %FALSE (and sets @error) then
-// @bytes_written will be set to the number of bytes that were
+// use [glib.Error], if this function returns false (and sets error) then
+// bytes_written will be set to the number of bytes that were
 // successfully written before the error was encountered.  This
 // functionality is only available from C.  If you need it from another
 // language then you must write your own loop around
-// g_output_stream_writev_async().
+// [OutputStream.WritevAsync].
 func (x *OutputStream) WritevAllFinish(ResultVar AsyncResult, BytesWrittenVar *uint) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xOutputStreamWritevAllFinish(x.GoPointer(), ResultVar.GoPointer(), BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1278,36 +1310,36 @@ func (x *OutputStream) WritevAllFinish(ResultVar AsyncResult, BytesWrittenVar *u
 
 var xOutputStreamWritevAsync func(uintptr, []OutputVector, uint, int, uintptr, uintptr, uintptr)
 
-// Request an asynchronous write of the bytes contained in @n_vectors @vectors into
-// the stream. When the operation is finished @callback will be called.
-// You can then call g_output_stream_writev_finish() to get the result of the
+// Request an asynchronous write of the bytes contained in n_vectors vectors into
+// the stream. When the operation is finished callback will be called.
+// You can then call [OutputStream.WritevFinish] to get the result of the
 // operation.
 //
 // During an async request no other sync and async calls are allowed,
-// and will result in %G_IO_ERROR_PENDING errors.
+// and will result in [GIoErrorPendingValue] errors.
 //
 // On success, the number of bytes written will be passed to the
-// @callback. It is not an error if this is not the same as the
+// callback. It is not an error if this is not the same as the
 // requested size, as it can happen e.g. on a partial I/O error,
 // but generally we try to write as many bytes as requested.
 //
 // You are guaranteed that this method will never fail with
-// %G_IO_ERROR_WOULD_BLOCK — if @stream can't accept more data, the
+// [GIoErrorWouldBlockValue] — if stream can't accept more data, the
 // method will just wait until this changes.
 //
 // Any outstanding I/O request with higher priority (lower numerical
 // value) will be executed before an outstanding request with lower
-// priority. Default priority is %G_PRIORITY_DEFAULT.
+// priority. Default priority is [glib.PRIORITY_DEFAULT].
 //
 // The asynchronous methods have a default fallback that uses threads
 // to implement asynchronicity, so they are optional for inheriting
 // classes. However, if you override one you must override all.
 //
 // For the synchronous, blocking version of this function, see
-// g_output_stream_writev().
+// [OutputStream.Writev].
 //
-// Note that no copy of @vectors will be made, so it must stay valid
-// until @callback is called.
+// Note that no copy of vectors will be made, so it must stay valid
+// until callback is called.
 func (x *OutputStream) WritevAsync(VectorsVar []OutputVector, NVectorsVar uint, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -1317,11 +1349,12 @@ func (x *OutputStream) WritevAsync(VectorsVar []OutputVector, NVectorsVar uint,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1337,7 +1370,7 @@ func (x *OutputStream) WritevFinish(ResultVar AsyncResult, BytesWrittenVar *uint
 
 	cret := xOutputStreamWritevFinish(x.GoPointer(), ResultVar.GoPointer(), BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1359,7 +1392,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1368,36 +1401,38 @@ func init() {
 
 	core.PuregoSafeRegister(&xOutputStreamGLibType, libs, "g_output_stream_get_type")
 
-	core.PuregoSafeRegister(&xOutputStreamClearPending, libs, "g_output_stream_clear_pending")
-	core.PuregoSafeRegister(&xOutputStreamClose, libs, "g_output_stream_close")
-	core.PuregoSafeRegister(&xOutputStreamCloseAsync, libs, "g_output_stream_close_async")
-	core.PuregoSafeRegister(&xOutputStreamCloseFinish, libs, "g_output_stream_close_finish")
-	core.PuregoSafeRegister(&xOutputStreamFlush, libs, "g_output_stream_flush")
-	core.PuregoSafeRegister(&xOutputStreamFlushAsync, libs, "g_output_stream_flush_async")
-	core.PuregoSafeRegister(&xOutputStreamFlushFinish, libs, "g_output_stream_flush_finish")
-	core.PuregoSafeRegister(&xOutputStreamHasPending, libs, "g_output_stream_has_pending")
-	core.PuregoSafeRegister(&xOutputStreamIsClosed, libs, "g_output_stream_is_closed")
-	core.PuregoSafeRegister(&xOutputStreamIsClosing, libs, "g_output_stream_is_closing")
-	core.PuregoSafeRegister(&xOutputStreamPrintf, libs, "g_output_stream_printf")
-	core.PuregoSafeRegister(&xOutputStreamSetPending, libs, "g_output_stream_set_pending")
-	core.PuregoSafeRegister(&xOutputStreamSplice, libs, "g_output_stream_splice")
-	core.PuregoSafeRegister(&xOutputStreamSpliceAsync, libs, "g_output_stream_splice_async")
-	core.PuregoSafeRegister(&xOutputStreamSpliceFinish, libs, "g_output_stream_splice_finish")
-	core.PuregoSafeRegister(&xOutputStreamVprintf, libs, "g_output_stream_vprintf")
-	core.PuregoSafeRegister(&xOutputStreamWrite, libs, "g_output_stream_write")
-	core.PuregoSafeRegister(&xOutputStreamWriteAll, libs, "g_output_stream_write_all")
-	core.PuregoSafeRegister(&xOutputStreamWriteAllAsync, libs, "g_output_stream_write_all_async")
-	core.PuregoSafeRegister(&xOutputStreamWriteAllFinish, libs, "g_output_stream_write_all_finish")
-	core.PuregoSafeRegister(&xOutputStreamWriteAsync, libs, "g_output_stream_write_async")
-	core.PuregoSafeRegister(&xOutputStreamWriteBytes, libs, "g_output_stream_write_bytes")
-	core.PuregoSafeRegister(&xOutputStreamWriteBytesAsync, libs, "g_output_stream_write_bytes_async")
-	core.PuregoSafeRegister(&xOutputStreamWriteBytesFinish, libs, "g_output_stream_write_bytes_finish")
-	core.PuregoSafeRegister(&xOutputStreamWriteFinish, libs, "g_output_stream_write_finish")
-	core.PuregoSafeRegister(&xOutputStreamWritev, libs, "g_output_stream_writev")
-	core.PuregoSafeRegister(&xOutputStreamWritevAll, libs, "g_output_stream_writev_all")
-	core.PuregoSafeRegister(&xOutputStreamWritevAllAsync, libs, "g_output_stream_writev_all_async")
-	core.PuregoSafeRegister(&xOutputStreamWritevAllFinish, libs, "g_output_stream_writev_all_finish")
-	core.PuregoSafeRegister(&xOutputStreamWritevAsync, libs, "g_output_stream_writev_async")
-	core.PuregoSafeRegister(&xOutputStreamWritevFinish, libs, "g_output_stream_writev_finish")
+	gobject.CheckClassSize("GOutputStreamClass", xOutputStreamGLibType, unsafe.Sizeof(OutputStreamClass{}))
+
+	core.PuregoRegisterSince(&xOutputStreamClearPending, libs, "g_output_stream_clear_pending", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamClose, libs, "g_output_stream_close", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamCloseAsync, libs, "g_output_stream_close_async", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamCloseFinish, libs, "g_output_stream_close_finish", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamFlush, libs, "g_output_stream_flush", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamFlushAsync, libs, "g_output_stream_flush_async", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamFlushFinish, libs, "g_output_stream_flush_finish", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamHasPending, libs, "g_output_stream_has_pending", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamIsClosed, libs, "g_output_stream_is_closed", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamIsClosing, libs, "g_output_stream_is_closing", "GIO", "2.24")
+	core.PuregoRegisterSince(&xOutputStreamPrintf, libs, "g_output_stream_printf", "GIO", "2.40")
+	core.PuregoRegisterSince(&xOutputStreamSetPending, libs, "g_output_stream_set_pending", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamSplice, libs, "g_output_stream_splice", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamSpliceAsync, libs, "g_output_stream_splice_async", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamSpliceFinish, libs, "g_output_stream_splice_finish", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamVprintf, libs, "g_output_stream_vprintf", "GIO", "2.40")
+	core.PuregoRegisterSince(&xOutputStreamWrite, libs, "g_output_stream_write", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteAll, libs, "g_output_stream_write_all", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteAllAsync, libs, "g_output_stream_write_all_async", "GIO", "2.44")
+	core.PuregoRegisterSince(&xOutputStreamWriteAllFinish, libs, "g_output_stream_write_all_finish", "GIO", "2.44")
+	core.PuregoRegisterSince(&xOutputStreamWriteAsync, libs, "g_output_stream_write_async", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteBytes, libs, "g_output_stream_write_bytes", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteBytesAsync, libs, "g_output_stream_write_bytes_async", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteBytesFinish, libs, "g_output_stream_write_bytes_finish", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWriteFinish, libs, "g_output_stream_write_finish", "GIO", "")
+	core.PuregoRegisterSince(&xOutputStreamWritev, libs, "g_output_stream_writev", "GIO", "2.60")
+	core.PuregoRegisterSince(&xOutputStreamWritevAll, libs, "g_output_stream_writev_all", "GIO", "2.60")
+	core.PuregoRegisterSince(&xOutputStreamWritevAllAsync, libs, "g_output_stream_writev_all_async", "GIO", "2.60")
+	core.PuregoRegisterSince(&xOutputStreamWritevAllFinish, libs, "g_output_stream_writev_all_finish", "GIO", "2.60")
+	core.PuregoRegisterSince(&xOutputStreamWritevAsync, libs, "g_output_stream_writev_async", "GIO", "2.60")
+	core.PuregoRegisterSince(&xOutputStreamWritevFinish, libs, "g_output_stream_writev_finish", "GIO", "2.60")
 
 }
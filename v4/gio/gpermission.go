@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -41,7 +40,8 @@ func (x *PermissionClass) OverrideAcquire(cb func(*Permission, *Cancellable) boo
 	if cb == nil {
 		x.xAcquire = 0
 	} else {
-		x.xAcquire = purego.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr) bool {
+		x.xAcquire = core.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PermissionNewFromInternalPtr(PermissionVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -53,7 +53,7 @@ func (x *PermissionClass) GetAcquire() func(*Permission, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xAcquire)
+	core.RegisterFunc(&rawCallback, x.xAcquire)
 	return func(PermissionVar *Permission, CancellableVar *Cancellable) bool {
 		return rawCallback(PermissionVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -64,7 +64,8 @@ func (x *PermissionClass) OverrideAcquireAsync(cb func(*Permission, *Cancellable
 	if cb == nil {
 		x.xAcquireAsync = 0
 	} else {
-		x.xAcquireAsync = purego.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xAcquireAsync = core.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PermissionNewFromInternalPtr(PermissionVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -76,7 +77,7 @@ func (x *PermissionClass) GetAcquireAsync() func(*Permission, *Cancellable, *Asy
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAcquireAsync)
+	core.RegisterFunc(&rawCallback, x.xAcquireAsync)
 	return func(PermissionVar *Permission, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(PermissionVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -87,7 +88,8 @@ func (x *PermissionClass) OverrideAcquireFinish(cb func(*Permission, AsyncResult
 	if cb == nil {
 		x.xAcquireFinish = 0
 	} else {
-		x.xAcquireFinish = purego.NewCallback(func(PermissionVarp uintptr, ResultVarp uintptr) bool {
+		x.xAcquireFinish = core.NewCallback(func(PermissionVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PermissionNewFromInternalPtr(PermissionVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -99,7 +101,7 @@ func (x *PermissionClass) GetAcquireFinish() func(*Permission, AsyncResult) bool
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xAcquireFinish)
+	core.RegisterFunc(&rawCallback, x.xAcquireFinish)
 	return func(PermissionVar *Permission, ResultVar AsyncResult) bool {
 		return rawCallback(PermissionVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -110,7 +112,8 @@ func (x *PermissionClass) OverrideRelease(cb func(*Permission, *Cancellable) boo
 	if cb == nil {
 		x.xRelease = 0
 	} else {
-		x.xRelease = purego.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr) bool {
+		x.xRelease = core.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PermissionNewFromInternalPtr(PermissionVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -122,7 +125,7 @@ func (x *PermissionClass) GetRelease() func(*Permission, *Cancellable) bool {
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xRelease)
+	core.RegisterFunc(&rawCallback, x.xRelease)
 	return func(PermissionVar *Permission, CancellableVar *Cancellable) bool {
 		return rawCallback(PermissionVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -133,7 +136,8 @@ func (x *PermissionClass) OverrideReleaseAsync(cb func(*Permission, *Cancellable
 	if cb == nil {
 		x.xReleaseAsync = 0
 	} else {
-		x.xReleaseAsync = purego.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xReleaseAsync = core.NewCallback(func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PermissionNewFromInternalPtr(PermissionVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -145,7 +149,7 @@ func (x *PermissionClass) GetReleaseAsync() func(*Permission, *Cancellable, *Asy
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReleaseAsync)
+	core.RegisterFunc(&rawCallback, x.xReleaseAsync)
 	return func(PermissionVar *Permission, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(PermissionVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -156,7 +160,8 @@ func (x *PermissionClass) OverrideReleaseFinish(cb func(*Permission, AsyncResult
 	if cb == nil {
 		x.xReleaseFinish = 0
 	} else {
-		x.xReleaseFinish = purego.NewCallback(func(PermissionVarp uintptr, ResultVarp uintptr) bool {
+		x.xReleaseFinish = core.NewCallback(func(PermissionVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PermissionNewFromInternalPtr(PermissionVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -168,7 +173,7 @@ func (x *PermissionClass) GetReleaseFinish() func(*Permission, AsyncResult) bool
 		return nil
 	}
 	var rawCallback func(PermissionVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xReleaseFinish)
+	core.RegisterFunc(&rawCallback, x.xReleaseFinish)
 	return func(PermissionVar *Permission, ResultVar AsyncResult) bool {
 		return rawCallback(PermissionVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -193,7 +198,7 @@ func (x *PermissionPrivate) GoPointer() uintptr {
 // release it.
 //
 // As an example, a `GPermission` might represent the ability for the
-// user to write to a [class@Gio.Settings] object.  This `GPermission` object
+// user to write to a [Settings] object.  This `GPermission` object
 // could then be used to decide if it is appropriate to show a “Click here to
 // unlock” button in a dialog and to provide the mechanism to invoke
 // when that button is clicked.
@@ -215,27 +220,27 @@ func PermissionNewFromInternalPtr(ptr uintptr) *Permission {
 
 var xPermissionAcquire func(uintptr, uintptr, **glib.Error) bool
 
-// Attempts to acquire the permission represented by @permission.
+// Attempts to acquire the permission represented by permission.
 //
 // The precise method by which this happens depends on the permission
 // and the underlying authentication mechanism.  A simple example is
 // that a dialog may appear asking the user to enter their password.
 //
-// You should check with g_permission_get_can_acquire() before calling
+// You should check with [Permission.GetCanAcquire] before calling
 // this function.
 //
-// If the permission is acquired then %TRUE is returned.  Otherwise,
-// %FALSE is returned and @error is set appropriately.
+// If the permission is acquired then true is returned.  Otherwise,
+// false is returned and error is set appropriately.
 //
 // This call is blocking, likely for a very long time (in the case that
-// user interaction is required).  See g_permission_acquire_async() for
+// user interaction is required).  See [Permission.AcquireAsync] for
 // the non-blocking version.
 func (x *Permission) Acquire(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPermissionAcquire(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -243,10 +248,10 @@ func (x *Permission) Acquire(CancellableVar *Cancellable) (bool, error) {
 
 var xPermissionAcquireAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Attempts to acquire the permission represented by @permission.
+// Attempts to acquire the permission represented by permission.
 //
 // This is the first half of the asynchronous version of
-// g_permission_acquire().
+// [Permission.Acquire].
 func (x *Permission) AcquireAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -256,11 +261,12 @@ func (x *Permission) AcquireAsync(CancellableVar *Cancellable, CallbackVar *Asyn
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -271,16 +277,16 @@ func (x *Permission) AcquireAsync(CancellableVar *Cancellable, CallbackVar *Asyn
 var xPermissionAcquireFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Collects the result of attempting to acquire the permission
-// represented by @permission.
+// represented by permission.
 //
 // This is the second half of the asynchronous version of
-// g_permission_acquire().
+// [Permission.Acquire].
 func (x *Permission) AcquireFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPermissionAcquireFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -288,9 +294,9 @@ func (x *Permission) AcquireFinish(ResultVar AsyncResult) (bool, error) {
 
 var xPermissionGetAllowed func(uintptr) bool
 
-// Gets the value of the 'allowed' property.  This property is %TRUE if
+// Gets the value of the 'allowed' property.  This property is true if
 // the caller currently has permission to perform the action that
-// @permission represents the permission to perform.
+// permission represents the permission to perform.
 func (x *Permission) GetAllowed() bool {
 
 	cret := xPermissionGetAllowed(x.GoPointer())
@@ -299,9 +305,9 @@ func (x *Permission) GetAllowed() bool {
 
 var xPermissionGetCanAcquire func(uintptr) bool
 
-// Gets the value of the 'can-acquire' property.  This property is %TRUE
+// Gets the value of the 'can-acquire' property.  This property is true
 // if it is generally possible to acquire the permission by calling
-// g_permission_acquire().
+// [Permission.Acquire].
 func (x *Permission) GetCanAcquire() bool {
 
 	cret := xPermissionGetCanAcquire(x.GoPointer())
@@ -310,9 +316,9 @@ func (x *Permission) GetCanAcquire() bool {
 
 var xPermissionGetCanRelease func(uintptr) bool
 
-// Gets the value of the 'can-release' property.  This property is %TRUE
+// Gets the value of the 'can-release' property.  This property is true
 // if it is generally possible to release the permission by calling
-// g_permission_release().
+// [Permission.Release].
 func (x *Permission) GetCanRelease() bool {
 
 	cret := xPermissionGetCanRelease(x.GoPointer())
@@ -321,9 +327,9 @@ func (x *Permission) GetCanRelease() bool {
 
 var xPermissionImplUpdate func(uintptr, bool, bool, bool)
 
-// This function is called by the #GPermission implementation to update
+// This function is called by the [Permission] implementation to update
 // the properties of the permission.  You should never call this
-// function except from a #GPermission implementation.
+// function except from a [Permission] implementation.
 //
 // GObject notify signals are generated, as appropriate.
 func (x *Permission) ImplUpdate(AllowedVar bool, CanAcquireVar bool, CanReleaseVar bool) {
@@ -334,27 +340,27 @@ func (x *Permission) ImplUpdate(AllowedVar bool, CanAcquireVar bool, CanReleaseV
 
 var xPermissionRelease func(uintptr, uintptr, **glib.Error) bool
 
-// Attempts to release the permission represented by @permission.
+// Attempts to release the permission represented by permission.
 //
 // The precise method by which this happens depends on the permission
 // and the underlying authentication mechanism.  In most cases the
 // permission will be dropped immediately without further action.
 //
-// You should check with g_permission_get_can_release() before calling
+// You should check with [Permission.GetCanRelease] before calling
 // this function.
 //
-// If the permission is released then %TRUE is returned.  Otherwise,
-// %FALSE is returned and @error is set appropriately.
+// If the permission is released then true is returned.  Otherwise,
+// false is returned and error is set appropriately.
 //
 // This call is blocking, likely for a very long time (in the case that
-// user interaction is required).  See g_permission_release_async() for
+// user interaction is required).  See [Permission.ReleaseAsync] for
 // the non-blocking version.
 func (x *Permission) Release(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPermissionRelease(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -362,10 +368,10 @@ func (x *Permission) Release(CancellableVar *Cancellable) (bool, error) {
 
 var xPermissionReleaseAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Attempts to release the permission represented by @permission.
+// Attempts to release the permission represented by permission.
 //
 // This is the first half of the asynchronous version of
-// g_permission_release().
+// [Permission.Release].
 func (x *Permission) ReleaseAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -375,11 +381,12 @@ func (x *Permission) ReleaseAsync(CancellableVar *Cancellable, CallbackVar *Asyn
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -390,16 +397,16 @@ func (x *Permission) ReleaseAsync(CancellableVar *Cancellable, CallbackVar *Asyn
 var xPermissionReleaseFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Collects the result of attempting to release the permission
-// represented by @permission.
+// represented by permission.
 //
 // This is the second half of the asynchronous version of
-// g_permission_release().
+// [Permission.Release].
 func (x *Permission) ReleaseFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPermissionReleaseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -417,8 +424,8 @@ func (c *Permission) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyAllowed gets the "allowed" property.
-// %TRUE if the caller currently has permission to perform the action that
-// @permission represents the permission to perform.
+// true if the caller currently has permission to perform the action that
+// permission represents the permission to perform.
 func (x *Permission) GetPropertyAllowed() bool {
 	var v gobject.Value
 	x.GetProperty("allowed", &v)
@@ -426,8 +433,8 @@ func (x *Permission) GetPropertyAllowed() bool {
 }
 
 // GetPropertyCanAcquire gets the "can-acquire" property.
-// %TRUE if it is generally possible to acquire the permission by calling
-// g_permission_acquire().
+// true if it is generally possible to acquire the permission by calling
+// [Permission.Acquire].
 func (x *Permission) GetPropertyCanAcquire() bool {
 	var v gobject.Value
 	x.GetProperty("can-acquire", &v)
@@ -435,8 +442,8 @@ func (x *Permission) GetPropertyCanAcquire() bool {
 }
 
 // GetPropertyCanRelease gets the "can-release" property.
-// %TRUE if it is generally possible to release the permission by calling
-// g_permission_release().
+// true if it is generally possible to release the permission by calling
+// [Permission.Release].
 func (x *Permission) GetPropertyCanRelease() bool {
 	var v gobject.Value
 	x.GetProperty("can-release", &v)
@@ -448,7 +455,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -457,15 +464,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xPermissionGLibType, libs, "g_permission_get_type")
 
-	core.PuregoSafeRegister(&xPermissionAcquire, libs, "g_permission_acquire")
-	core.PuregoSafeRegister(&xPermissionAcquireAsync, libs, "g_permission_acquire_async")
-	core.PuregoSafeRegister(&xPermissionAcquireFinish, libs, "g_permission_acquire_finish")
-	core.PuregoSafeRegister(&xPermissionGetAllowed, libs, "g_permission_get_allowed")
-	core.PuregoSafeRegister(&xPermissionGetCanAcquire, libs, "g_permission_get_can_acquire")
-	core.PuregoSafeRegister(&xPermissionGetCanRelease, libs, "g_permission_get_can_release")
-	core.PuregoSafeRegister(&xPermissionImplUpdate, libs, "g_permission_impl_update")
-	core.PuregoSafeRegister(&xPermissionRelease, libs, "g_permission_release")
-	core.PuregoSafeRegister(&xPermissionReleaseAsync, libs, "g_permission_release_async")
-	core.PuregoSafeRegister(&xPermissionReleaseFinish, libs, "g_permission_release_finish")
+	gobject.CheckClassSize("GPermissionClass", xPermissionGLibType, unsafe.Sizeof(PermissionClass{}))
+
+	core.PuregoRegisterSince(&xPermissionAcquire, libs, "g_permission_acquire", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionAcquireAsync, libs, "g_permission_acquire_async", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionAcquireFinish, libs, "g_permission_acquire_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionGetAllowed, libs, "g_permission_get_allowed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionGetCanAcquire, libs, "g_permission_get_can_acquire", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionGetCanRelease, libs, "g_permission_get_can_release", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionImplUpdate, libs, "g_permission_impl_update", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionRelease, libs, "g_permission_release", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionReleaseAsync, libs, "g_permission_release_async", "GIO", "2.26")
+	core.PuregoRegisterSince(&xPermissionReleaseFinish, libs, "g_permission_release_finish", "GIO", "2.26")
 
 }
@@ -5,26 +5,26 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // The interface for pollable input streams.
 //
-// The default implementation of @can_poll always returns %TRUE.
+// The default implementation of can_poll always returns true.
 //
-// The default implementation of @read_nonblocking calls
-// g_pollable_input_stream_is_readable(), and then calls
-// g_input_stream_read() if it returns %TRUE. This means you only need
-// to override it if it is possible that your @is_readable
-// implementation may return %TRUE when the stream is not actually
+// The default implementation of read_nonblocking calls
+// [PollableInputStream.IsReadable], and then calls
+// [InputStream.Read] if it returns true. This means you only need
+// to override it if it is possible that your is_readable
+// implementation may return true when the stream is not actually
 // readable.
 type PollableInputStreamInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xCanPoll uintptr
 
@@ -40,25 +40,26 @@ func (x *PollableInputStreamInterface) GoPointer() uintptr {
 }
 
 // OverrideCanPoll sets the "can_poll" callback function.
-// Checks if the #GPollableInputStream instance is actually pollable
+// Checks if the [PollableInputStream] instance is actually pollable
 func (x *PollableInputStreamInterface) OverrideCanPoll(cb func(PollableInputStream) bool) {
 	if cb == nil {
 		x.xCanPoll = 0
 	} else {
-		x.xCanPoll = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanPoll = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&PollableInputStreamBase{Ptr: StreamVarp})
 		})
 	}
 }
 
 // GetCanPoll gets the "can_poll" callback function.
-// Checks if the #GPollableInputStream instance is actually pollable
+// Checks if the [PollableInputStream] instance is actually pollable
 func (x *PollableInputStreamInterface) GetCanPoll() func(PollableInputStream) bool {
 	if x.xCanPoll == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanPoll)
+	core.RegisterFunc(&rawCallback, x.xCanPoll)
 	return func(StreamVar PollableInputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -70,7 +71,8 @@ func (x *PollableInputStreamInterface) OverrideIsReadable(cb func(PollableInputS
 	if cb == nil {
 		x.xIsReadable = 0
 	} else {
-		x.xIsReadable = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xIsReadable = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&PollableInputStreamBase{Ptr: StreamVarp})
 		})
 	}
@@ -83,32 +85,33 @@ func (x *PollableInputStreamInterface) GetIsReadable() func(PollableInputStream)
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsReadable)
+	core.RegisterFunc(&rawCallback, x.xIsReadable)
 	return func(StreamVar PollableInputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
 }
 
 // OverrideCreateSource sets the "create_source" callback function.
-// Creates a #GSource to poll the stream
+// Creates a [glib.Source] to poll the stream
 func (x *PollableInputStreamInterface) OverrideCreateSource(cb func(PollableInputStream, *Cancellable) *glib.Source) {
 	if cb == nil {
 		x.xCreateSource = 0
 	} else {
-		x.xCreateSource = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source {
+		x.xCreateSource = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source {
+			defer core.RecoverPanic()
 			return cb(&PollableInputStreamBase{Ptr: StreamVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetCreateSource gets the "create_source" callback function.
-// Creates a #GSource to poll the stream
+// Creates a [glib.Source] to poll the stream
 func (x *PollableInputStreamInterface) GetCreateSource() func(PollableInputStream, *Cancellable) *glib.Source {
 	if x.xCreateSource == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source
-	purego.RegisterFunc(&rawCallback, x.xCreateSource)
+	core.RegisterFunc(&rawCallback, x.xCreateSource)
 	return func(StreamVar PollableInputStream, CancellableVar *Cancellable) *glib.Source {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -117,12 +120,13 @@ func (x *PollableInputStreamInterface) GetCreateSource() func(PollableInputStrea
 // OverrideReadNonblocking sets the "read_nonblocking" callback function.
 // Does a non-blocking read or returns
 //
-//	%G_IO_ERROR_WOULD_BLOCK
+//	[GIoErrorWouldBlockValue]
 func (x *PollableInputStreamInterface) OverrideReadNonblocking(cb func(PollableInputStream, *[]byte, uint) int) {
 	if cb == nil {
 		x.xReadNonblocking = 0
 	} else {
-		x.xReadNonblocking = purego.NewCallback(func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint) int {
+		x.xReadNonblocking = core.NewCallback(func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint) int {
+			defer core.RecoverPanic()
 			return cb(&PollableInputStreamBase{Ptr: StreamVarp}, BufferVarp, CountVarp)
 		})
 	}
@@ -131,25 +135,25 @@ func (x *PollableInputStreamInterface) OverrideReadNonblocking(cb func(PollableI
 // GetReadNonblocking gets the "read_nonblocking" callback function.
 // Does a non-blocking read or returns
 //
-//	%G_IO_ERROR_WOULD_BLOCK
+//	[GIoErrorWouldBlockValue]
 func (x *PollableInputStreamInterface) GetReadNonblocking() func(PollableInputStream, *[]byte, uint) int {
 	if x.xReadNonblocking == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp *[]byte, CountVarp uint) int
-	purego.RegisterFunc(&rawCallback, x.xReadNonblocking)
+	core.RegisterFunc(&rawCallback, x.xReadNonblocking)
 	return func(StreamVar PollableInputStream, BufferVar *[]byte, CountVar uint) int {
 		return rawCallback(StreamVar.GoPointer(), BufferVar, CountVar)
 	}
 }
 
-// `GPollableInputStream` is implemented by [class@Gio.InputStream]s that
+// `GPollableInputStream` is implemented by [InputStream]s that
 // can be polled for readiness to read. This can be used when
 // interfacing with a non-GIO API that expects
 // UNIX-file-descriptor-style asynchronous I/O rather than GIO-style.
 //
 // Some classes may implement `GPollableInputStream` but have only certain
-// instances of that class be pollable. If [method@Gio.PollableInputStream.can_poll]
+// instances of that class be pollable. If [PollableInputStream.CanPoll]
 // returns false, then the behavior of other `GPollableInputStream` methods is
 // undefined.
 type PollableInputStream interface {
@@ -182,10 +186,55 @@ func (x *PollableInputStreamBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableInputStream but have only certain instances of that class
-// be pollable. If this method returns %FALSE, then the behavior of
-// other #GPollableInputStream methods is undefined.
+// ImplementPollableInputStream adds the PollableInputStream interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - CanPoll() bool
+//   - IsReadable() bool
+//   - CreateSource(*Cancellable) *glib.Source
+//   - ReadNonblocking(*[]byte, uint) int
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see PollableInputStreamInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementPollableInputStream[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, PollableInputStreamGLibType(), func(iface *PollableInputStreamInterface) {
+		if _, ok := any(zero).(interface{ CanPoll() bool }); ok {
+			iface.OverrideCanPoll(func(self PollableInputStream) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanPoll() bool })
+				return impl.CanPoll()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsReadable() bool }); ok {
+			iface.OverrideIsReadable(func(self PollableInputStream) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsReadable() bool })
+				return impl.IsReadable()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateSource(*Cancellable) *glib.Source
+		}); ok {
+			iface.OverrideCreateSource(func(self PollableInputStream, arg0 *Cancellable) *glib.Source {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateSource(*Cancellable) *glib.Source
+				})
+				return impl.CreateSource(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ ReadNonblocking(*[]byte, uint) int }); ok {
+			iface.OverrideReadNonblocking(func(self PollableInputStream, arg0 *[]byte, arg1 uint) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ReadNonblocking(*[]byte, uint) int })
+				return impl.ReadNonblocking(arg0, arg1)
+			})
+		}
+	})
+}
+
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableInputStream] but have only certain instances of that class
+// be pollable. If this method returns false, then the behavior of
+// other [PollableInputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -195,60 +244,60 @@ func (x *PollableInputStreamBase) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be read, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be read, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_input_stream_is_readable(), it is possible that
+// As with [PollableInputStream.IsReadable], it is possible that
 // the stream may not actually be readable even after the source
-// triggers, so you should use g_pollable_input_stream_read_nonblocking()
-// rather than g_input_stream_read() from the callback.
+// triggers, so you should use [PollableInputStream.ReadNonblocking]
+// rather than [InputStream.Read] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *PollableInputStreamBase) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableInputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be read.
+// Checks if stream can be read.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_input_stream_read()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [InputStream.Read]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_input_stream_read_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableInputStream.ReadNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *PollableInputStreamBase) IsReadable() bool {
 
 	cret := XGPollableInputStreamIsReadable(x.GoPointer())
 	return cret
 }
 
-// Attempts to read up to @count bytes from @stream into @buffer, as
-// with g_input_stream_read(). If @stream is not currently readable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_input_stream_create_source() to create a #GSource
-// that will be triggered when @stream is readable.
+// Attempts to read up to count bytes from stream into buffer, as
+// with [InputStream.Read]. If stream is not currently readable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableInputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is readable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
 // The behaviour of this method is undefined if
-// g_pollable_input_stream_can_poll() returns %FALSE for @stream.
+// [PollableInputStream.CanPoll] returns false for stream.
 func (x *PollableInputStreamBase) ReadNonblocking(BufferVar *[]byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableInputStreamReadNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -264,7 +313,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -273,9 +322,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xPollableInputStreamGLibType, libs, "g_pollable_input_stream_get_type")
 
-	core.PuregoSafeRegister(&XGPollableInputStreamCanPoll, libs, "g_pollable_input_stream_can_poll")
-	core.PuregoSafeRegister(&XGPollableInputStreamCreateSource, libs, "g_pollable_input_stream_create_source")
-	core.PuregoSafeRegister(&XGPollableInputStreamIsReadable, libs, "g_pollable_input_stream_is_readable")
-	core.PuregoSafeRegister(&XGPollableInputStreamReadNonblocking, libs, "g_pollable_input_stream_read_nonblocking")
+	gobject.CheckClassSize("GPollableInputStreamInterface", xPollableInputStreamGLibType, unsafe.Sizeof(PollableInputStreamInterface{}))
+
+	core.PuregoRegisterSince(&XGPollableInputStreamCanPoll, libs, "g_pollable_input_stream_can_poll", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableInputStreamCreateSource, libs, "g_pollable_input_stream_create_source", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableInputStreamIsReadable, libs, "g_pollable_input_stream_is_readable", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableInputStreamReadNonblocking, libs, "g_pollable_input_stream_read_nonblocking", "GIO", "2.28")
 
 }
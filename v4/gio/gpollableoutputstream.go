@@ -5,32 +5,32 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // The interface for pollable output streams.
 //
-// The default implementation of @can_poll always returns %TRUE.
+// The default implementation of can_poll always returns true.
 //
-// The default implementation of @write_nonblocking calls
-// g_pollable_output_stream_is_writable(), and then calls
-// g_output_stream_write() if it returns %TRUE. This means you only
-// need to override it if it is possible that your @is_writable
-// implementation may return %TRUE when the stream is not actually
+// The default implementation of write_nonblocking calls
+// [PollableOutputStream.IsWritable], and then calls
+// [OutputStream.Write] if it returns true. This means you only
+// need to override it if it is possible that your is_writable
+// implementation may return true when the stream is not actually
 // writable.
 //
-// The default implementation of @writev_nonblocking calls
-// g_pollable_output_stream_write_nonblocking() for each vector, and converts
-// its return value and error (if set) to a #GPollableReturn. You should
-// override this where possible to avoid having to allocate a #GError to return
-// %G_IO_ERROR_WOULD_BLOCK.
+// The default implementation of writev_nonblocking calls
+// [PollableOutputStream.WriteNonblocking] for each vector, and converts
+// its return value and error (if set) to a [PollableReturn]. You should
+// override this where possible to avoid having to allocate a [glib.Error] to return
+// [GIoErrorWouldBlockValue].
 type PollableOutputStreamInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xCanPoll uintptr
 
@@ -48,25 +48,26 @@ func (x *PollableOutputStreamInterface) GoPointer() uintptr {
 }
 
 // OverrideCanPoll sets the "can_poll" callback function.
-// Checks if the #GPollableOutputStream instance is actually pollable
+// Checks if the [PollableOutputStream] instance is actually pollable
 func (x *PollableOutputStreamInterface) OverrideCanPoll(cb func(PollableOutputStream) bool) {
 	if cb == nil {
 		x.xCanPoll = 0
 	} else {
-		x.xCanPoll = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xCanPoll = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&PollableOutputStreamBase{Ptr: StreamVarp})
 		})
 	}
 }
 
 // GetCanPoll gets the "can_poll" callback function.
-// Checks if the #GPollableOutputStream instance is actually pollable
+// Checks if the [PollableOutputStream] instance is actually pollable
 func (x *PollableOutputStreamInterface) GetCanPoll() func(PollableOutputStream) bool {
 	if x.xCanPoll == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanPoll)
+	core.RegisterFunc(&rawCallback, x.xCanPoll)
 	return func(StreamVar PollableOutputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
@@ -78,7 +79,8 @@ func (x *PollableOutputStreamInterface) OverrideIsWritable(cb func(PollableOutpu
 	if cb == nil {
 		x.xIsWritable = 0
 	} else {
-		x.xIsWritable = purego.NewCallback(func(StreamVarp uintptr) bool {
+		x.xIsWritable = core.NewCallback(func(StreamVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&PollableOutputStreamBase{Ptr: StreamVarp})
 		})
 	}
@@ -91,32 +93,33 @@ func (x *PollableOutputStreamInterface) GetIsWritable() func(PollableOutputStrea
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsWritable)
+	core.RegisterFunc(&rawCallback, x.xIsWritable)
 	return func(StreamVar PollableOutputStream) bool {
 		return rawCallback(StreamVar.GoPointer())
 	}
 }
 
 // OverrideCreateSource sets the "create_source" callback function.
-// Creates a #GSource to poll the stream
+// Creates a [glib.Source] to poll the stream
 func (x *PollableOutputStreamInterface) OverrideCreateSource(cb func(PollableOutputStream, *Cancellable) *glib.Source) {
 	if cb == nil {
 		x.xCreateSource = 0
 	} else {
-		x.xCreateSource = purego.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source {
+		x.xCreateSource = core.NewCallback(func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source {
+			defer core.RecoverPanic()
 			return cb(&PollableOutputStreamBase{Ptr: StreamVarp}, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetCreateSource gets the "create_source" callback function.
-// Creates a #GSource to poll the stream
+// Creates a [glib.Source] to poll the stream
 func (x *PollableOutputStreamInterface) GetCreateSource() func(PollableOutputStream, *Cancellable) *glib.Source {
 	if x.xCreateSource == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, CancellableVarp uintptr) *glib.Source
-	purego.RegisterFunc(&rawCallback, x.xCreateSource)
+	core.RegisterFunc(&rawCallback, x.xCreateSource)
 	return func(StreamVar PollableOutputStream, CancellableVar *Cancellable) *glib.Source {
 		return rawCallback(StreamVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -125,12 +128,13 @@ func (x *PollableOutputStreamInterface) GetCreateSource() func(PollableOutputStr
 // OverrideWriteNonblocking sets the "write_nonblocking" callback function.
 // Does a non-blocking write or returns
 //
-//	%G_IO_ERROR_WOULD_BLOCK
+//	[GIoErrorWouldBlockValue]
 func (x *PollableOutputStreamInterface) OverrideWriteNonblocking(cb func(PollableOutputStream, []byte, uint) int) {
 	if cb == nil {
 		x.xWriteNonblocking = 0
 	} else {
-		x.xWriteNonblocking = purego.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint) int {
+		x.xWriteNonblocking = core.NewCallback(func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint) int {
+			defer core.RecoverPanic()
 			return cb(&PollableOutputStreamBase{Ptr: StreamVarp}, BufferVarp, CountVarp)
 		})
 	}
@@ -139,13 +143,13 @@ func (x *PollableOutputStreamInterface) OverrideWriteNonblocking(cb func(Pollabl
 // GetWriteNonblocking gets the "write_nonblocking" callback function.
 // Does a non-blocking write or returns
 //
-//	%G_IO_ERROR_WOULD_BLOCK
+//	[GIoErrorWouldBlockValue]
 func (x *PollableOutputStreamInterface) GetWriteNonblocking() func(PollableOutputStream, []byte, uint) int {
 	if x.xWriteNonblocking == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, BufferVarp []byte, CountVarp uint) int
-	purego.RegisterFunc(&rawCallback, x.xWriteNonblocking)
+	core.RegisterFunc(&rawCallback, x.xWriteNonblocking)
 	return func(StreamVar PollableOutputStream, BufferVar []byte, CountVar uint) int {
 		return rawCallback(StreamVar.GoPointer(), BufferVar, CountVar)
 	}
@@ -154,12 +158,13 @@ func (x *PollableOutputStreamInterface) GetWriteNonblocking() func(PollableOutpu
 // OverrideWritevNonblocking sets the "writev_nonblocking" callback function.
 // Does a vectored non-blocking write, or returns
 //
-//	%G_POLLABLE_RETURN_WOULD_BLOCK
+//	[GPollableReturnWouldBlockValue]
 func (x *PollableOutputStreamInterface) OverrideWritevNonblocking(cb func(PollableOutputStream, []OutputVector, uint, *uint) PollableReturn) {
 	if cb == nil {
 		x.xWritevNonblocking = 0
 	} else {
-		x.xWritevNonblocking = purego.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint) PollableReturn {
+		x.xWritevNonblocking = core.NewCallback(func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint) PollableReturn {
+			defer core.RecoverPanic()
 			return cb(&PollableOutputStreamBase{Ptr: StreamVarp}, VectorsVarp, NVectorsVarp, BytesWrittenVarp)
 		})
 	}
@@ -168,25 +173,25 @@ func (x *PollableOutputStreamInterface) OverrideWritevNonblocking(cb func(Pollab
 // GetWritevNonblocking gets the "writev_nonblocking" callback function.
 // Does a vectored non-blocking write, or returns
 //
-//	%G_POLLABLE_RETURN_WOULD_BLOCK
+//	[GPollableReturnWouldBlockValue]
 func (x *PollableOutputStreamInterface) GetWritevNonblocking() func(PollableOutputStream, []OutputVector, uint, *uint) PollableReturn {
 	if x.xWritevNonblocking == 0 {
 		return nil
 	}
 	var rawCallback func(StreamVarp uintptr, VectorsVarp []OutputVector, NVectorsVarp uint, BytesWrittenVarp *uint) PollableReturn
-	purego.RegisterFunc(&rawCallback, x.xWritevNonblocking)
+	core.RegisterFunc(&rawCallback, x.xWritevNonblocking)
 	return func(StreamVar PollableOutputStream, VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint) PollableReturn {
 		return rawCallback(StreamVar.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar)
 	}
 }
 
-// `GPollableOutputStream` is implemented by [class@Gio.OutputStream]s that
+// `GPollableOutputStream` is implemented by [OutputStream]s that
 // can be polled for readiness to write. This can be used when
 // interfacing with a non-GIO API that expects
 // UNIX-file-descriptor-style asynchronous I/O rather than GIO-style.
 //
 // Some classes may implement `GPollableOutputStream` but have only certain
-// instances of that class be pollable. If [method@Gio.PollableOutputStream.can_poll]
+// instances of that class be pollable. If [PollableOutputStream.CanPoll]
 // returns false, then the behavior of other `GPollableOutputStream` methods is
 // undefined.
 type PollableOutputStream interface {
@@ -220,10 +225,66 @@ func (x *PollableOutputStreamBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Checks if @stream is actually pollable. Some classes may implement
-// #GPollableOutputStream but have only certain instances of that
-// class be pollable. If this method returns %FALSE, then the behavior
-// of other #GPollableOutputStream methods is undefined.
+// ImplementPollableOutputStream adds the PollableOutputStream interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - CanPoll() bool
+//   - IsWritable() bool
+//   - CreateSource(*Cancellable) *glib.Source
+//   - WriteNonblocking([]byte, uint) int
+//   - WritevNonblocking([]OutputVector, uint, *uint) PollableReturn
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see PollableOutputStreamInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementPollableOutputStream[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, PollableOutputStreamGLibType(), func(iface *PollableOutputStreamInterface) {
+		if _, ok := any(zero).(interface{ CanPoll() bool }); ok {
+			iface.OverrideCanPoll(func(self PollableOutputStream) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanPoll() bool })
+				return impl.CanPoll()
+			})
+		}
+		if _, ok := any(zero).(interface{ IsWritable() bool }); ok {
+			iface.OverrideIsWritable(func(self PollableOutputStream) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsWritable() bool })
+				return impl.IsWritable()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateSource(*Cancellable) *glib.Source
+		}); ok {
+			iface.OverrideCreateSource(func(self PollableOutputStream, arg0 *Cancellable) *glib.Source {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateSource(*Cancellable) *glib.Source
+				})
+				return impl.CreateSource(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ WriteNonblocking([]byte, uint) int }); ok {
+			iface.OverrideWriteNonblocking(func(self PollableOutputStream, arg0 []byte, arg1 uint) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ WriteNonblocking([]byte, uint) int })
+				return impl.WriteNonblocking(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			WritevNonblocking([]OutputVector, uint, *uint) PollableReturn
+		}); ok {
+			iface.OverrideWritevNonblocking(func(self PollableOutputStream, arg0 []OutputVector, arg1 uint, arg2 *uint) PollableReturn {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					WritevNonblocking([]OutputVector, uint, *uint) PollableReturn
+				})
+				return impl.WritevNonblocking(arg0, arg1, arg2)
+			})
+		}
+	})
+}
+
+// Checks if stream is actually pollable. Some classes may implement
+// [PollableOutputStream] but have only certain instances of that
+// class be pollable. If this method returns false, then the behavior
+// of other [PollableOutputStream] methods is undefined.
 //
 // For any given stream, the value returned by this method is constant;
 // a stream cannot switch from pollable to non-pollable or vice versa.
@@ -233,94 +294,94 @@ func (x *PollableOutputStreamBase) CanPoll() bool {
 	return cret
 }
 
-// Creates a #GSource that triggers when @stream can be written, or
-// @cancellable is triggered or an error occurs. The callback on the
-// source is of the #GPollableSourceFunc type.
+// Creates a [glib.Source] that triggers when stream can be written, or
+// cancellable is triggered or an error occurs. The callback on the
+// source is of the [PollableSourceFunc] type.
 //
-// As with g_pollable_output_stream_is_writable(), it is possible that
+// As with [PollableOutputStream.IsWritable], it is possible that
 // the stream may not actually be writable even after the source
-// triggers, so you should use g_pollable_output_stream_write_nonblocking()
-// rather than g_output_stream_write() from the callback.
+// triggers, so you should use [PollableOutputStream.WriteNonblocking]
+// rather than [OutputStream.Write] from the callback.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *PollableOutputStreamBase) CreateSource(CancellableVar *Cancellable) *glib.Source {
 
 	cret := XGPollableOutputStreamCreateSource(x.GoPointer(), CancellableVar.GoPointer())
 	return cret
 }
 
-// Checks if @stream can be written.
+// Checks if stream can be written.
 //
 // Note that some stream types may not be able to implement this 100%
-// reliably, and it is possible that a call to g_output_stream_write()
-// after this returns %TRUE would still block. To guarantee
+// reliably, and it is possible that a call to [OutputStream.Write]
+// after this returns true would still block. To guarantee
 // non-blocking behavior, you should always use
-// g_pollable_output_stream_write_nonblocking(), which will return a
-// %G_IO_ERROR_WOULD_BLOCK error rather than blocking.
+// [PollableOutputStream.WriteNonblocking], which will return a
+// [GIoErrorWouldBlockValue] error rather than blocking.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *PollableOutputStreamBase) IsWritable() bool {
 
 	cret := XGPollableOutputStreamIsWritable(x.GoPointer())
 	return cret
 }
 
-// Attempts to write up to @count bytes from @buffer to @stream, as
-// with g_output_stream_write(). If @stream is not currently writable,
-// this will immediately return %G_IO_ERROR_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable.
+// Attempts to write up to count bytes from buffer to stream, as
+// with [OutputStream.Write]. If stream is not currently writable,
+// this will immediately return [GIoErrorWouldBlockValue], and you can
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_IO_ERROR_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @buffer and
-// @count in the next write call.
+// Also note that if [GIoErrorWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same buffer and
+// count in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *PollableOutputStreamBase) WriteNonblocking(BufferVar []byte, CountVar uint, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWriteNonblocking(x.GoPointer(), BufferVar, CountVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Attempts to write the bytes contained in the @n_vectors @vectors to @stream,
-// as with g_output_stream_writev(). If @stream is not currently writable,
+// Attempts to write the bytes contained in the n_vectors vectors to stream,
+// as with [OutputStream.Writev]. If stream is not currently writable,
 // this will immediately return %@G_POLLABLE_RETURN_WOULD_BLOCK, and you can
-// use g_pollable_output_stream_create_source() to create a #GSource
-// that will be triggered when @stream is writable. @error will *not* be
+// use [PollableOutputStream.CreateSource] to create a [glib.Source]
+// that will be triggered when stream is writable. error will *not* be
 // set in that case.
 //
 // Note that since this method never blocks, you cannot actually
-// use @cancellable to cancel it. However, it will return an error
-// if @cancellable has already been cancelled when you call, which
+// use cancellable to cancel it. However, it will return an error
+// if cancellable has already been cancelled when you call, which
 // may happen if you call this method after a source triggers due
 // to having been cancelled.
 //
-// Also note that if %G_POLLABLE_RETURN_WOULD_BLOCK is returned some underlying
-// transports like D/TLS require that you re-send the same @vectors and
-// @n_vectors in the next write call.
+// Also note that if [GPollableReturnWouldBlockValue] is returned some underlying
+// transports like D/TLS require that you re-send the same vectors and
+// n_vectors in the next write call.
 //
 // The behaviour of this method is undefined if
-// g_pollable_output_stream_can_poll() returns %FALSE for @stream.
+// [PollableOutputStream.CanPoll] returns false for stream.
 func (x *PollableOutputStreamBase) WritevNonblocking(VectorsVar []OutputVector, NVectorsVar uint, BytesWrittenVar *uint, CancellableVar *Cancellable) (PollableReturn, error) {
 	var cerr *glib.Error
 
 	cret := XGPollableOutputStreamWritevNonblocking(x.GoPointer(), VectorsVar, NVectorsVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -337,7 +398,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -346,10 +407,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xPollableOutputStreamGLibType, libs, "g_pollable_output_stream_get_type")
 
-	core.PuregoSafeRegister(&XGPollableOutputStreamCanPoll, libs, "g_pollable_output_stream_can_poll")
-	core.PuregoSafeRegister(&XGPollableOutputStreamCreateSource, libs, "g_pollable_output_stream_create_source")
-	core.PuregoSafeRegister(&XGPollableOutputStreamIsWritable, libs, "g_pollable_output_stream_is_writable")
-	core.PuregoSafeRegister(&XGPollableOutputStreamWriteNonblocking, libs, "g_pollable_output_stream_write_nonblocking")
-	core.PuregoSafeRegister(&XGPollableOutputStreamWritevNonblocking, libs, "g_pollable_output_stream_writev_nonblocking")
+	gobject.CheckClassSize("GPollableOutputStreamInterface", xPollableOutputStreamGLibType, unsafe.Sizeof(PollableOutputStreamInterface{}))
+
+	core.PuregoRegisterSince(&XGPollableOutputStreamCanPoll, libs, "g_pollable_output_stream_can_poll", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableOutputStreamCreateSource, libs, "g_pollable_output_stream_create_source", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableOutputStreamIsWritable, libs, "g_pollable_output_stream_is_writable", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableOutputStreamWriteNonblocking, libs, "g_pollable_output_stream_write_nonblocking", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGPollableOutputStreamWritevNonblocking, libs, "g_pollable_output_stream_writev_nonblocking", "GIO", "2.60")
 
 }
@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -10,10 +9,10 @@ import (
 
 var xPollableSourceNew func(uintptr) *glib.Source
 
-// Utility method for #GPollableInputStream and #GPollableOutputStream
-// implementations. Creates a new #GSource that expects a callback of
-// type #GPollableSourceFunc. The new source does not actually do
-// anything on its own; use g_source_add_child_source() to add other
+// Utility method for [PollableInputStream] and [PollableOutputStream]
+// implementations. Creates a new [glib.Source] that expects a callback of
+// type [PollableSourceFunc]. The new source does not actually do
+// anything on its own; use [glib.Source.AddChildSource] to add other
 // sources to it to cause it to trigger.
 func PollableSourceNew(PollableStreamVar *gobject.Object) *glib.Source {
 
@@ -23,10 +22,10 @@ func PollableSourceNew(PollableStreamVar *gobject.Object) *glib.Source {
 
 var xPollableSourceNewFull func(uintptr, *glib.Source, uintptr) *glib.Source
 
-// Utility method for #GPollableInputStream and #GPollableOutputStream
-// implementations. Creates a new #GSource, as with
-// g_pollable_source_new(), but also attaching @child_source (with a
-// dummy callback), and @cancellable, if they are non-%NULL.
+// Utility method for [PollableInputStream] and [PollableOutputStream]
+// implementations. Creates a new [glib.Source], as with
+// [PollableSourceNew], but also attaching child_source (with a
+// dummy callback), and cancellable, if they are non-nil.
 func PollableSourceNewFull(PollableStreamVar *gobject.Object, ChildSourceVar *glib.Source, CancellableVar *Cancellable) *glib.Source {
 
 	cret := xPollableSourceNewFull(PollableStreamVar.GoPointer(), ChildSourceVar, CancellableVar.GoPointer())
@@ -35,21 +34,21 @@ func PollableSourceNewFull(PollableStreamVar *gobject.Object, ChildSourceVar *gl
 
 var xPollableStreamRead func(uintptr, []byte, uint, bool, uintptr, **glib.Error) int
 
-// Tries to read from @stream, as with g_input_stream_read() (if
-// @blocking is %TRUE) or g_pollable_input_stream_read_nonblocking()
-// (if @blocking is %FALSE). This can be used to more easily share
+// Tries to read from stream, as with [InputStream.Read] (if
+// blocking is true) or [PollableInputStream.ReadNonblocking]
+// (if blocking is false). This can be used to more easily share
 // code between blocking and non-blocking implementations of a method.
 //
-// If @blocking is %FALSE, then @stream must be a
-// #GPollableInputStream for which g_pollable_input_stream_can_poll()
-// returns %TRUE, or else the behavior is undefined. If @blocking is
-// %TRUE, then @stream does not need to be a #GPollableInputStream.
+// If blocking is false, then stream must be a
+// [PollableInputStream] for which [PollableInputStream.CanPoll]
+// returns true, or else the behavior is undefined. If blocking is
+// true, then stream does not need to be a [PollableInputStream].
 func PollableStreamRead(StreamVar *InputStream, BufferVar []byte, CountVar uint, BlockingVar bool, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xPollableStreamRead(StreamVar.GoPointer(), BufferVar, CountVar, BlockingVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -57,22 +56,22 @@ func PollableStreamRead(StreamVar *InputStream, BufferVar []byte, CountVar uint,
 
 var xPollableStreamWrite func(uintptr, []byte, uint, bool, uintptr, **glib.Error) int
 
-// Tries to write to @stream, as with g_output_stream_write() (if
-// @blocking is %TRUE) or g_pollable_output_stream_write_nonblocking()
-// (if @blocking is %FALSE). This can be used to more easily share
+// Tries to write to stream, as with [OutputStream.Write] (if
+// blocking is true) or [PollableOutputStream.WriteNonblocking]
+// (if blocking is false). This can be used to more easily share
 // code between blocking and non-blocking implementations of a method.
 //
-// If @blocking is %FALSE, then @stream must be a
-// #GPollableOutputStream for which
-// g_pollable_output_stream_can_poll() returns %TRUE or else the
-// behavior is undefined. If @blocking is %TRUE, then @stream does not
-// need to be a #GPollableOutputStream.
+// If blocking is false, then stream must be a
+// [PollableOutputStream] for which
+// [PollableOutputStream.CanPoll] returns true or else the
+// behavior is undefined. If blocking is true, then stream does not
+// need to be a [PollableOutputStream].
 func PollableStreamWrite(StreamVar *OutputStream, BufferVar []byte, CountVar uint, BlockingVar bool, CancellableVar *Cancellable) (int, error) {
 	var cerr *glib.Error
 
 	cret := xPollableStreamWrite(StreamVar.GoPointer(), BufferVar, CountVar, BlockingVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -80,30 +79,30 @@ func PollableStreamWrite(StreamVar *OutputStream, BufferVar []byte, CountVar uin
 
 var xPollableStreamWriteAll func(uintptr, []byte, uint, bool, *uint, uintptr, **glib.Error) bool
 
-// Tries to write @count bytes to @stream, as with
-// g_output_stream_write_all(), but using g_pollable_stream_write()
-// rather than g_output_stream_write().
+// Tries to write count bytes to stream, as with
+// [OutputStream.WriteAll], but using [PollableStreamWrite]
+// rather than [OutputStream.Write].
 //
-// On a successful write of @count bytes, %TRUE is returned, and
-// @bytes_written is set to @count.
+// On a successful write of count bytes, true is returned, and
+// bytes_written is set to count.
 //
 // If there is an error during the operation (including
-// %G_IO_ERROR_WOULD_BLOCK in the non-blocking case), %FALSE is
-// returned and @error is set to indicate the error status,
-// @bytes_written is updated to contain the number of bytes written
+// [GIoErrorWouldBlockValue] in the non-blocking case), false is
+// returned and error is set to indicate the error status,
+// bytes_written is updated to contain the number of bytes written
 // into the stream before the error occurred.
 //
-// As with g_pollable_stream_write(), if @blocking is %FALSE, then
-// @stream must be a #GPollableOutputStream for which
-// g_pollable_output_stream_can_poll() returns %TRUE or else the
-// behavior is undefined. If @blocking is %TRUE, then @stream does not
-// need to be a #GPollableOutputStream.
+// As with [PollableStreamWrite], if blocking is false, then
+// stream must be a [PollableOutputStream] for which
+// [PollableOutputStream.CanPoll] returns true or else the
+// behavior is undefined. If blocking is true, then stream does not
+// need to be a [PollableOutputStream].
 func PollableStreamWriteAll(StreamVar *OutputStream, BufferVar []byte, CountVar uint, BlockingVar bool, BytesWrittenVar *uint, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPollableStreamWriteAll(StreamVar.GoPointer(), BufferVar, CountVar, BlockingVar, BytesWrittenVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -114,17 +113,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPollableSourceNew, libs, "g_pollable_source_new")
-	core.PuregoSafeRegister(&xPollableSourceNewFull, libs, "g_pollable_source_new_full")
-	core.PuregoSafeRegister(&xPollableStreamRead, libs, "g_pollable_stream_read")
-	core.PuregoSafeRegister(&xPollableStreamWrite, libs, "g_pollable_stream_write")
-	core.PuregoSafeRegister(&xPollableStreamWriteAll, libs, "g_pollable_stream_write_all")
+	core.PuregoRegisterSince(&xPollableSourceNew, libs, "g_pollable_source_new", "GIO", "2.28")
+	core.PuregoRegisterSince(&xPollableSourceNewFull, libs, "g_pollable_source_new_full", "GIO", "2.34")
+	core.PuregoRegisterSince(&xPollableStreamRead, libs, "g_pollable_stream_read", "GIO", "2.34")
+	core.PuregoRegisterSince(&xPollableStreamWrite, libs, "g_pollable_stream_write", "GIO", "2.34")
+	core.PuregoRegisterSince(&xPollableStreamWriteAll, libs, "g_pollable_stream_write_all", "GIO", "2.34")
 
 }
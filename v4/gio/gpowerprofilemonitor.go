@@ -5,17 +5,16 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GPowerProfileMonitor.
+// The virtual function table for [PowerProfileMonitor].
 type PowerProfileMonitorInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 }
 
 func (x *PowerProfileMonitorInterface) GoPointer() uintptr {
@@ -43,8 +42,8 @@ func (x *PowerProfileMonitorInterface) GoPointer() uintptr {
 // or activity at all), `sysprof` to inspect CPU usage, and `intel_gpu_time` to
 // profile GPU usage.
 //
-// Don’t forget to disconnect the [signal@GObject.Object::notify] signal for
-// [property@Gio.PowerProfileMonitor:power-saver-enabled], and unref the
+// Don’t forget to disconnect the [gobject.Object.ConnectNotifyFunc] signal for
+// [PowerProfileMonitorBase.GetPropertyPowerSaverEnabled], and unref the
 // `GPowerProfileMonitor` itself when exiting.
 type PowerProfileMonitor interface {
 	GoPointer() uintptr
@@ -76,7 +75,7 @@ func (x *PowerProfileMonitorBase) SetGoPointer(ptr uintptr) {
 // Gets whether the system is in “Power Saver” mode.
 //
 // You are expected to listen to the
-// #GPowerProfileMonitor::notify::power-saver-enabled signal to know when the profile has
+// GPowerProfileMonitor::notify::power-saver-enabled signal to know when the profile has
 // changed.
 func (x *PowerProfileMonitorBase) GetPowerSaverEnabled() bool {
 
@@ -98,13 +97,13 @@ var XGPowerProfileMonitorGetPowerSaverEnabled func(uintptr) bool
 
 const (
 	// Extension point for power profile usage monitoring functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	POWER_PROFILE_MONITOR_EXTENSION_POINT_NAME string = "gio-power-profile-monitor"
 )
 
 var xPowerProfileMonitorDupDefault func() uintptr
 
-// Gets a reference to the default #GPowerProfileMonitor for the system.
+// Gets a reference to the default [PowerProfileMonitor] for the system.
 func PowerProfileMonitorDupDefault() *PowerProfileMonitorBase {
 	var cls *PowerProfileMonitorBase
 
@@ -123,17 +122,19 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPowerProfileMonitorDupDefault, libs, "g_power_profile_monitor_dup_default")
+	core.PuregoRegisterSince(&xPowerProfileMonitorDupDefault, libs, "g_power_profile_monitor_dup_default", "GIO", "2.70")
 
 	core.PuregoSafeRegister(&xPowerProfileMonitorGLibType, libs, "g_power_profile_monitor_get_type")
 
-	core.PuregoSafeRegister(&XGPowerProfileMonitorGetPowerSaverEnabled, libs, "g_power_profile_monitor_get_power_saver_enabled")
+	gobject.CheckClassSize("GPowerProfileMonitorInterface", xPowerProfileMonitorGLibType, unsafe.Sizeof(PowerProfileMonitorInterface{}))
+
+	core.PuregoRegisterSince(&XGPowerProfileMonitorGetPowerSaverEnabled, libs, "g_power_profile_monitor_get_power_saver_enabled", "GIO", "2.70")
 
 }
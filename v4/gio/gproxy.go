@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type ProxyInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xConnect uintptr
 
@@ -38,7 +38,8 @@ func (x *ProxyInterface) OverrideConnect(cb func(Proxy, *IOStream, *ProxyAddress
 	if cb == nil {
 		x.xConnect = 0
 	} else {
-		x.xConnect = purego.NewCallback(func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xConnect = core.NewCallback(func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&ProxyBase{Ptr: ProxyVarp}, IOStreamNewFromInternalPtr(ConnectionVarp), ProxyAddressNewFromInternalPtr(ProxyAddressVarp), CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -57,7 +58,7 @@ func (x *ProxyInterface) GetConnect() func(Proxy, *IOStream, *ProxyAddress, *Can
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xConnect)
+	core.RegisterFunc(&rawCallback, x.xConnect)
 	return func(ProxyVar Proxy, ConnectionVar *IOStream, ProxyAddressVar *ProxyAddress, CancellableVar *Cancellable) *IOStream {
 		rawRet := rawCallback(ProxyVar.GoPointer(), ConnectionVar.GoPointer(), ProxyAddressVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -75,7 +76,8 @@ func (x *ProxyInterface) OverrideConnectAsync(cb func(Proxy, *IOStream, *ProxyAd
 	if cb == nil {
 		x.xConnectAsync = 0
 	} else {
-		x.xConnectAsync = purego.NewCallback(func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xConnectAsync = core.NewCallback(func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ProxyBase{Ptr: ProxyVarp}, IOStreamNewFromInternalPtr(ConnectionVarp), ProxyAddressNewFromInternalPtr(ProxyAddressVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -88,7 +90,7 @@ func (x *ProxyInterface) GetConnectAsync() func(Proxy, *IOStream, *ProxyAddress,
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr, ConnectionVarp uintptr, ProxyAddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xConnectAsync)
+	core.RegisterFunc(&rawCallback, x.xConnectAsync)
 	return func(ProxyVar Proxy, ConnectionVar *IOStream, ProxyAddressVar *ProxyAddress, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ProxyVar.GoPointer(), ConnectionVar.GoPointer(), ProxyAddressVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -100,7 +102,8 @@ func (x *ProxyInterface) OverrideConnectFinish(cb func(Proxy, AsyncResult) *IOSt
 	if cb == nil {
 		x.xConnectFinish = 0
 	} else {
-		x.xConnectFinish = purego.NewCallback(func(ProxyVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xConnectFinish = core.NewCallback(func(ProxyVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&ProxyBase{Ptr: ProxyVarp}, &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -117,7 +120,7 @@ func (x *ProxyInterface) GetConnectFinish() func(Proxy, AsyncResult) *IOStream {
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xConnectFinish)
+	core.RegisterFunc(&rawCallback, x.xConnectFinish)
 	return func(ProxyVar Proxy, ResultVar AsyncResult) *IOStream {
 		rawRet := rawCallback(ProxyVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -135,7 +138,8 @@ func (x *ProxyInterface) OverrideSupportsHostname(cb func(Proxy) bool) {
 	if cb == nil {
 		x.xSupportsHostname = 0
 	} else {
-		x.xSupportsHostname = purego.NewCallback(func(ProxyVarp uintptr) bool {
+		x.xSupportsHostname = core.NewCallback(func(ProxyVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&ProxyBase{Ptr: ProxyVarp})
 		})
 	}
@@ -148,7 +152,7 @@ func (x *ProxyInterface) GetSupportsHostname() func(Proxy) bool {
 		return nil
 	}
 	var rawCallback func(ProxyVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSupportsHostname)
+	core.RegisterFunc(&rawCallback, x.xSupportsHostname)
 	return func(ProxyVar Proxy) bool {
 		return rawCallback(ProxyVar.GoPointer())
 	}
@@ -159,7 +163,7 @@ func (x *ProxyInterface) GetSupportsHostname() func(Proxy) bool {
 // The extensions are named after their proxy protocol name. As an
 // example, a SOCKS5 proxy implementation can be retrieved with the
 // name `socks5` using the function
-// [method@Gio.IOExtensionPoint.get_extension_by_name].
+// [IOExtensionPoint.GetExtensionByName].
 type Proxy interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -190,10 +194,59 @@ func (x *ProxyBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Given @connection to communicate with a proxy (eg, a
-// #GSocketConnection that is connected to the proxy server), this
-// does the necessary handshake to connect to @proxy_address, and if
-// required, wraps the #GIOStream to handle proxy payload.
+// ImplementProxy adds the Proxy interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Connect(*IOStream, *ProxyAddress, *Cancellable) *IOStream
+//   - ConnectAsync(*IOStream, *ProxyAddress, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - ConnectFinish(AsyncResult) *IOStream
+//   - SupportsHostname() bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ProxyInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementProxy[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ProxyGLibType(), func(iface *ProxyInterface) {
+		if _, ok := any(zero).(interface {
+			Connect(*IOStream, *ProxyAddress, *Cancellable) *IOStream
+		}); ok {
+			iface.OverrideConnect(func(self Proxy, arg0 *IOStream, arg1 *ProxyAddress, arg2 *Cancellable) *IOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Connect(*IOStream, *ProxyAddress, *Cancellable) *IOStream
+				})
+				return impl.Connect(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ConnectAsync(*IOStream, *ProxyAddress, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideConnectAsync(func(self Proxy, arg0 *IOStream, arg1 *ProxyAddress, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ConnectAsync(*IOStream, *ProxyAddress, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.ConnectAsync(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ ConnectFinish(AsyncResult) *IOStream }); ok {
+			iface.OverrideConnectFinish(func(self Proxy, arg0 AsyncResult) *IOStream {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ConnectFinish(AsyncResult) *IOStream })
+				return impl.ConnectFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SupportsHostname() bool }); ok {
+			iface.OverrideSupportsHostname(func(self Proxy) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SupportsHostname() bool })
+				return impl.SupportsHostname()
+			})
+		}
+	})
+}
+
+// Given connection to communicate with a proxy (eg, a
+// [SocketConnection] that is connected to the proxy server), this
+// does the necessary handshake to connect to proxy_address, and if
+// required, wraps the [IOStream] to handle proxy payload.
 func (x *ProxyBase) Connect(ConnectionVar *IOStream, ProxyAddressVar *ProxyAddress, CancellableVar *Cancellable) (*IOStream, error) {
 	var cls *IOStream
 	var cerr *glib.Error
@@ -201,25 +254,44 @@ func (x *ProxyBase) Connect(ConnectionVar *IOStream, ProxyAddressVar *ProxyAddre
 	cret := XGProxyConnect(x.GoPointer(), ConnectionVar.GoPointer(), ProxyAddressVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &IOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
 }
 
-// Asynchronous version of g_proxy_connect().
+// Asynchronous version of [Proxy.Connect].
 func (x *ProxyBase) ConnectAsync(ConnectionVar *IOStream, ProxyAddressVar *ProxyAddress, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGProxyConnectAsync(x.GoPointer(), ConnectionVar.GoPointer(), ProxyAddressVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGProxyConnectAsync(x.GoPointer(), ConnectionVar.GoPointer(), ProxyAddressVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
-// See g_proxy_connect().
+// See [Proxy.Connect].
 func (x *ProxyBase) ConnectFinish(ResultVar AsyncResult) (*IOStream, error) {
 	var cls *IOStream
 	var cerr *glib.Error
@@ -227,12 +299,15 @@ func (x *ProxyBase) ConnectFinish(ResultVar AsyncResult) (*IOStream, error) {
 	cret := XGProxyConnectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &IOStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -240,11 +315,11 @@ func (x *ProxyBase) ConnectFinish(ResultVar AsyncResult) (*IOStream, error) {
 
 // Some proxy protocols expect to be passed a hostname, which they
 // will resolve to an IP address themselves. Others, like SOCKS4, do
-// not allow this. This function will return %FALSE if @proxy is
-// implementing such a protocol. When %FALSE is returned, the caller
+// not allow this. This function will return false if proxy is
+// implementing such a protocol. When false is returned, the caller
 // should resolve the destination hostname first, and then pass a
-// #GProxyAddress containing the stringified IP address to
-// g_proxy_connect() or g_proxy_connect_async().
+// [ProxyAddress] containing the stringified IP address to
+// [Proxy.Connect] or [Proxy.ConnectAsync].
 func (x *ProxyBase) SupportsHostname() bool {
 
 	cret := XGProxySupportsHostname(x.GoPointer())
@@ -258,7 +333,7 @@ var XGProxySupportsHostname func(uintptr) bool
 
 const (
 	// Extension point for proxy functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	PROXY_EXTENSION_POINT_NAME string = "gio-proxy"
 )
 
@@ -284,20 +359,22 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xProxyGetDefaultForProtocol, libs, "g_proxy_get_default_for_protocol")
+	core.PuregoRegisterSince(&xProxyGetDefaultForProtocol, libs, "g_proxy_get_default_for_protocol", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xProxyGLibType, libs, "g_proxy_get_type")
 
-	core.PuregoSafeRegister(&XGProxyConnect, libs, "g_proxy_connect")
-	core.PuregoSafeRegister(&XGProxyConnectAsync, libs, "g_proxy_connect_async")
-	core.PuregoSafeRegister(&XGProxyConnectFinish, libs, "g_proxy_connect_finish")
-	core.PuregoSafeRegister(&XGProxySupportsHostname, libs, "g_proxy_supports_hostname")
+	gobject.CheckClassSize("GProxyInterface", xProxyGLibType, unsafe.Sizeof(ProxyInterface{}))
+
+	core.PuregoRegisterSince(&XGProxyConnect, libs, "g_proxy_connect", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxyConnectAsync, libs, "g_proxy_connect_async", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxyConnectFinish, libs, "g_proxy_connect_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxySupportsHostname, libs, "g_proxy_supports_hostname", "GIO", "2.26")
 
 }
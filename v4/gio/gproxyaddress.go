@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GProxyAddress.
+// Class structure for [ProxyAddress].
 type ProxyAddressClass struct {
 	_ structs.HostLayout
 
@@ -30,7 +29,7 @@ func (x *ProxyAddressPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A [class@Gio.InetSocketAddress] representing a connection via a proxy server.
+// A [InetSocketAddress] representing a connection via a proxy server.
 type ProxyAddress struct {
 	InetSocketAddress
 }
@@ -49,11 +48,11 @@ func ProxyAddressNewFromInternalPtr(ptr uintptr) *ProxyAddress {
 
 var xNewProxyAddress func(uintptr, uint16, string, string, uint16, uintptr, uintptr) uintptr
 
-// Creates a new #GProxyAddress for @inetaddr with @protocol that should
-// tunnel through @dest_hostname and @dest_port.
+// Creates a new [ProxyAddress] for inetaddr with protocol that should
+// tunnel through dest_hostname and dest_port.
 //
-// (Note that this method doesn't set the #GProxyAddress:uri or
-// #GProxyAddress:destination-protocol fields; use g_object_new()
+// (Note that this method doesn't set the [ProxyAddress.GetPropertyUri] or
+// [ProxyAddress.GetPropertyDestinationProtocol] fields; use [gobject.NewObject]
 // directly if you want to set those.)
 func NewProxyAddress(InetaddrVar *InetAddress, PortVar uint16, ProtocolVar string, DestHostnameVar string, DestPortVar uint16, UsernameVar *string, PasswordVar *string) *ProxyAddress {
 	var cls *ProxyAddress
@@ -76,7 +75,7 @@ func NewProxyAddress(InetaddrVar *InetAddress, PortVar uint16, ProtocolVar strin
 
 var xProxyAddressGetDestinationHostname func(uintptr) string
 
-// Gets @proxy's destination hostname; that is, the name of the host
+// Gets proxy's destination hostname; that is, the name of the host
 // that will be connected to via the proxy, not the name of the proxy
 // itself.
 func (x *ProxyAddress) GetDestinationHostname() string {
@@ -87,7 +86,7 @@ func (x *ProxyAddress) GetDestinationHostname() string {
 
 var xProxyAddressGetDestinationPort func(uintptr) uint16
 
-// Gets @proxy's destination port; that is, the port on the
+// Gets proxy's destination port; that is, the port on the
 // destination host that will be connected to via the proxy, not the
 // port number of the proxy itself.
 func (x *ProxyAddress) GetDestinationPort() uint16 {
@@ -108,7 +107,7 @@ func (x *ProxyAddress) GetDestinationProtocol() string {
 
 var xProxyAddressGetPassword func(uintptr) string
 
-// Gets @proxy's password.
+// Gets proxy's password.
 func (x *ProxyAddress) GetPassword() string {
 
 	cret := xProxyAddressGetPassword(x.GoPointer())
@@ -117,7 +116,7 @@ func (x *ProxyAddress) GetPassword() string {
 
 var xProxyAddressGetProtocol func(uintptr) string
 
-// Gets @proxy's protocol. eg, "socks" or "http"
+// Gets proxy's protocol. eg, "socks" or "http"
 func (x *ProxyAddress) GetProtocol() string {
 
 	cret := xProxyAddressGetProtocol(x.GoPointer())
@@ -126,7 +125,7 @@ func (x *ProxyAddress) GetProtocol() string {
 
 var xProxyAddressGetUri func(uintptr) string
 
-// Gets the proxy URI that @proxy was constructed from.
+// Gets the proxy URI that proxy was constructed from.
 func (x *ProxyAddress) GetUri() string {
 
 	cret := xProxyAddressGetUri(x.GoPointer())
@@ -135,7 +134,7 @@ func (x *ProxyAddress) GetUri() string {
 
 var xProxyAddressGetUsername func(uintptr) string
 
-// Gets @proxy's username.
+// Gets proxy's username.
 func (x *ProxyAddress) GetUsername() string {
 
 	cret := xProxyAddressGetUsername(x.GoPointer())
@@ -188,8 +187,8 @@ func (x *ProxyAddress) GetPropertyDestinationPort() uint {
 }
 
 // SetPropertyDestinationProtocol sets the "destination-protocol" property.
-// The protocol being spoke to the destination host, or %NULL if
-// the #GProxyAddress doesn't know.
+// The protocol being spoke to the destination host, or nil if
+// the [ProxyAddress] doesn't know.
 func (x *ProxyAddress) SetPropertyDestinationProtocol(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -198,8 +197,8 @@ func (x *ProxyAddress) SetPropertyDestinationProtocol(value string) {
 }
 
 // GetPropertyDestinationProtocol gets the "destination-protocol" property.
-// The protocol being spoke to the destination host, or %NULL if
-// the #GProxyAddress doesn't know.
+// The protocol being spoke to the destination host, or nil if
+// the [ProxyAddress] doesn't know.
 func (x *ProxyAddress) GetPropertyDestinationProtocol() string {
 	var v gobject.Value
 	x.GetProperty("destination-protocol", &v)
@@ -241,7 +240,7 @@ func (x *ProxyAddress) GetPropertyProtocol() string {
 }
 
 // SetPropertyUri sets the "uri" property.
-// The URI string that the proxy was constructed from (or %NULL
+// The URI string that the proxy was constructed from (or nil
 // if the creator didn't specify this).
 func (x *ProxyAddress) SetPropertyUri(value string) {
 	var v gobject.Value
@@ -251,7 +250,7 @@ func (x *ProxyAddress) SetPropertyUri(value string) {
 }
 
 // GetPropertyUri gets the "uri" property.
-// The URI string that the proxy was constructed from (or %NULL
+// The URI string that the proxy was constructed from (or nil
 // if the creator didn't specify this).
 func (x *ProxyAddress) GetPropertyUri() string {
 	var v gobject.Value
@@ -276,7 +275,7 @@ func (x *ProxyAddress) GetPropertyUsername() string {
 	return v.GetString()
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *ProxyAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -290,13 +289,13 @@ func (x *ProxyAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *ProxyAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -310,12 +309,12 @@ func (x *ProxyAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *ProxyAddress) ToString() string {
 
@@ -328,7 +327,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -337,14 +336,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xProxyAddressGLibType, libs, "g_proxy_address_get_type")
 
-	core.PuregoSafeRegister(&xNewProxyAddress, libs, "g_proxy_address_new")
+	gobject.CheckClassSize("GProxyAddressClass", xProxyAddressGLibType, unsafe.Sizeof(ProxyAddressClass{}))
 
-	core.PuregoSafeRegister(&xProxyAddressGetDestinationHostname, libs, "g_proxy_address_get_destination_hostname")
-	core.PuregoSafeRegister(&xProxyAddressGetDestinationPort, libs, "g_proxy_address_get_destination_port")
-	core.PuregoSafeRegister(&xProxyAddressGetDestinationProtocol, libs, "g_proxy_address_get_destination_protocol")
-	core.PuregoSafeRegister(&xProxyAddressGetPassword, libs, "g_proxy_address_get_password")
-	core.PuregoSafeRegister(&xProxyAddressGetProtocol, libs, "g_proxy_address_get_protocol")
-	core.PuregoSafeRegister(&xProxyAddressGetUri, libs, "g_proxy_address_get_uri")
-	core.PuregoSafeRegister(&xProxyAddressGetUsername, libs, "g_proxy_address_get_username")
+	core.PuregoRegisterSince(&xNewProxyAddress, libs, "g_proxy_address_new", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xProxyAddressGetDestinationHostname, libs, "g_proxy_address_get_destination_hostname", "GIO", "2.26")
+	core.PuregoRegisterSince(&xProxyAddressGetDestinationPort, libs, "g_proxy_address_get_destination_port", "GIO", "2.26")
+	core.PuregoRegisterSince(&xProxyAddressGetDestinationProtocol, libs, "g_proxy_address_get_destination_protocol", "GIO", "2.34")
+	core.PuregoRegisterSince(&xProxyAddressGetPassword, libs, "g_proxy_address_get_password", "GIO", "2.26")
+	core.PuregoRegisterSince(&xProxyAddressGetProtocol, libs, "g_proxy_address_get_protocol", "GIO", "2.26")
+	core.PuregoRegisterSince(&xProxyAddressGetUri, libs, "g_proxy_address_get_uri", "GIO", "2.34")
+	core.PuregoRegisterSince(&xProxyAddressGetUsername, libs, "g_proxy_address_get_username", "GIO", "2.26")
 
 }
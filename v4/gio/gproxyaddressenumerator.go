@@ -5,12 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GProxyAddressEnumerator.
+// Class structure for [ProxyAddressEnumerator].
 type ProxyAddressEnumeratorClass struct {
 	_ structs.HostLayout
 
@@ -40,7 +40,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -52,7 +53,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -63,7 +64,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -75,7 +77,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -86,7 +88,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -98,7 +101,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -109,7 +112,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -121,7 +125,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -132,7 +136,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -144,7 +149,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -155,7 +160,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -167,7 +173,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -178,7 +184,8 @@ func (x *ProxyAddressEnumeratorClass) OverrideGReserved7(cb func()) {
 	if cb == nil {
 		x.xGReserved7 = 0
 	} else {
-		x.xGReserved7 = purego.NewCallback(func() {
+		x.xGReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -190,7 +197,7 @@ func (x *ProxyAddressEnumeratorClass) GetGReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved7)
+	core.RegisterFunc(&rawCallback, x.xGReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -205,15 +212,15 @@ func (x *ProxyAddressEnumeratorPrivate) GoPointer() uintptr {
 }
 
 // `GProxyAddressEnumerator` is a wrapper around
-// [class@Gio.SocketAddressEnumerator] which takes the [class@Gio.SocketAddress]
-// instances returned by the [class@Gio.SocketAddressEnumerator]
-// and wraps them in [class@Gio.ProxyAddress] instances, using the given
-// [property@Gio.ProxyAddressEnumerator:proxy-resolver].
+// [SocketAddressEnumerator] which takes the [SocketAddress]
+// instances returned by the [SocketAddressEnumerator]
+// and wraps them in [ProxyAddress] instances, using the given
+// ProxyAddressEnumerator:proxy-resolver.
 //
 // This enumerator will be returned (for example, by
-// [method@Gio.SocketConnectable.enumerate]) as appropriate when a proxy is
+// [SocketConnectable.Enumerate]) as appropriate when a proxy is
 // configured; there should be no need to manually wrap a
-// [class@Gio.SocketAddressEnumerator] instance with one.
+// [SocketAddressEnumerator] instance with one.
 type ProxyAddressEnumerator struct {
 	SocketAddressEnumerator
 }
@@ -242,7 +249,7 @@ func (c *ProxyAddressEnumerator) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyDefaultPort sets the "default-port" property.
-// The default port to use if #GProxyAddressEnumerator:uri does not
+// The default port to use if [ProxyAddressEnumerator.GetPropertyUri] does not
 // specify one.
 func (x *ProxyAddressEnumerator) SetPropertyDefaultPort(value uint) {
 	var v gobject.Value
@@ -252,7 +259,7 @@ func (x *ProxyAddressEnumerator) SetPropertyDefaultPort(value uint) {
 }
 
 // GetPropertyDefaultPort gets the "default-port" property.
-// The default port to use if #GProxyAddressEnumerator:uri does not
+// The default port to use if [ProxyAddressEnumerator.GetPropertyUri] does not
 // specify one.
 func (x *ProxyAddressEnumerator) GetPropertyDefaultPort() uint {
 	var v gobject.Value
@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GProxyResolver.
+// The virtual function table for [ProxyResolver].
 type ProxyResolverInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xIsSupported uintptr
 
@@ -32,50 +31,52 @@ func (x *ProxyResolverInterface) GoPointer() uintptr {
 }
 
 // OverrideIsSupported sets the "is_supported" callback function.
-// the virtual function pointer for g_proxy_resolver_is_supported()
+// the virtual function pointer for [ProxyResolver.IsSupported]
 func (x *ProxyResolverInterface) OverrideIsSupported(cb func(ProxyResolver) bool) {
 	if cb == nil {
 		x.xIsSupported = 0
 	} else {
-		x.xIsSupported = purego.NewCallback(func(ResolverVarp uintptr) bool {
+		x.xIsSupported = core.NewCallback(func(ResolverVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&ProxyResolverBase{Ptr: ResolverVarp})
 		})
 	}
 }
 
 // GetIsSupported gets the "is_supported" callback function.
-// the virtual function pointer for g_proxy_resolver_is_supported()
+// the virtual function pointer for [ProxyResolver.IsSupported]
 func (x *ProxyResolverInterface) GetIsSupported() func(ProxyResolver) bool {
 	if x.xIsSupported == 0 {
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsSupported)
+	core.RegisterFunc(&rawCallback, x.xIsSupported)
 	return func(ResolverVar ProxyResolver) bool {
 		return rawCallback(ResolverVar.GoPointer())
 	}
 }
 
 // OverrideLookup sets the "lookup" callback function.
-// the virtual function pointer for g_proxy_resolver_lookup()
+// the virtual function pointer for [ProxyResolver.Lookup]
 func (x *ProxyResolverInterface) OverrideLookup(cb func(ProxyResolver, string, *Cancellable) []string) {
 	if cb == nil {
 		x.xLookup = 0
 	} else {
-		x.xLookup = purego.NewCallback(func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr) []string {
+		x.xLookup = core.NewCallback(func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&ProxyResolverBase{Ptr: ResolverVarp}, UriVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
 }
 
 // GetLookup gets the "lookup" callback function.
-// the virtual function pointer for g_proxy_resolver_lookup()
+// the virtual function pointer for [ProxyResolver.Lookup]
 func (x *ProxyResolverInterface) GetLookup() func(ProxyResolver, string, *Cancellable) []string {
 	if x.xLookup == 0 {
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xLookup)
+	core.RegisterFunc(&rawCallback, x.xLookup)
 	return func(ResolverVar ProxyResolver, UriVar string, CancellableVar *Cancellable) []string {
 		return rawCallback(ResolverVar.GoPointer(), UriVar, CancellableVar.GoPointer())
 	}
@@ -84,12 +85,13 @@ func (x *ProxyResolverInterface) GetLookup() func(ProxyResolver, string, *Cancel
 // OverrideLookupAsync sets the "lookup_async" callback function.
 // the virtual function pointer for
 //
-//	g_proxy_resolver_lookup_async()
+//	[ProxyResolver.LookupAsync]
 func (x *ProxyResolverInterface) OverrideLookupAsync(cb func(ProxyResolver, string, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xLookupAsync = 0
 	} else {
-		x.xLookupAsync = purego.NewCallback(func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupAsync = core.NewCallback(func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ProxyResolverBase{Ptr: ResolverVarp}, UriVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -98,13 +100,13 @@ func (x *ProxyResolverInterface) OverrideLookupAsync(cb func(ProxyResolver, stri
 // GetLookupAsync gets the "lookup_async" callback function.
 // the virtual function pointer for
 //
-//	g_proxy_resolver_lookup_async()
+//	[ProxyResolver.LookupAsync]
 func (x *ProxyResolverInterface) GetLookupAsync() func(ProxyResolver, string, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xLookupAsync == 0 {
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, UriVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupAsync)
 	return func(ResolverVar ProxyResolver, UriVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), UriVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -113,12 +115,13 @@ func (x *ProxyResolverInterface) GetLookupAsync() func(ProxyResolver, string, *C
 // OverrideLookupFinish sets the "lookup_finish" callback function.
 // the virtual function pointer for
 //
-//	g_proxy_resolver_lookup_finish()
+//	[ProxyResolver.LookupFinish]
 func (x *ProxyResolverInterface) OverrideLookupFinish(cb func(ProxyResolver, AsyncResult) []string) {
 	if cb == nil {
 		x.xLookupFinish = 0
 	} else {
-		x.xLookupFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) []string {
+		x.xLookupFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&ProxyResolverBase{Ptr: ResolverVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -127,26 +130,29 @@ func (x *ProxyResolverInterface) OverrideLookupFinish(cb func(ProxyResolver, Asy
 // GetLookupFinish gets the "lookup_finish" callback function.
 // the virtual function pointer for
 //
-//	g_proxy_resolver_lookup_finish()
+//	[ProxyResolver.LookupFinish]
 func (x *ProxyResolverInterface) GetLookupFinish() func(ProxyResolver, AsyncResult) []string {
 	if x.xLookupFinish == 0 {
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xLookupFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupFinish)
 	return func(ResolverVar ProxyResolver, ResultVar AsyncResult) []string {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // `GProxyResolver` provides synchronous and asynchronous network proxy
-// resolution. `GProxyResolver` is used within [class@Gio.SocketClient] through
-// the method [method@Gio.SocketConnectable.proxy_enumerate].
+// resolution. `GProxyResolver` is used within [SocketClient] through
+// the method [SocketConnectable.ProxyEnumerate].
 //
 // Implementations of `GProxyResolver` based on
-// [libproxy](https://github.com/libproxy/libproxy) and GNOME settings can be
-// found in [glib-networking](https://gitlab.gnome.org/GNOME/glib-networking).
+// [libproxy] and GNOME settings can be
+// found in [glib-networking].
 // GIO comes with an implementation for use inside Flatpak portals.
+//
+// [libproxy]: https://github.com/libproxy/libproxy
+// [glib-networking]: https://gitlab.gnome.org/GNOME/glib-networking
 type ProxyResolver interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -177,9 +183,58 @@ func (x *ProxyResolverBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Checks if @resolver can be used on this system. (This is used
-// internally; g_proxy_resolver_get_default() will only return a proxy
-// resolver that returns %TRUE for this method.)
+// ImplementProxyResolver adds the ProxyResolver interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - IsSupported() bool
+//   - Lookup(string, *Cancellable) []string
+//   - LookupAsync(string, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - LookupFinish(AsyncResult) []string
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ProxyResolverInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementProxyResolver[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ProxyResolverGLibType(), func(iface *ProxyResolverInterface) {
+		if _, ok := any(zero).(interface{ IsSupported() bool }); ok {
+			iface.OverrideIsSupported(func(self ProxyResolver) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsSupported() bool })
+				return impl.IsSupported()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Lookup(string, *Cancellable) []string
+		}); ok {
+			iface.OverrideLookup(func(self ProxyResolver, arg0 string, arg1 *Cancellable) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Lookup(string, *Cancellable) []string
+				})
+				return impl.Lookup(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			LookupAsync(string, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideLookupAsync(func(self ProxyResolver, arg0 string, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					LookupAsync(string, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.LookupAsync(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ LookupFinish(AsyncResult) []string }); ok {
+			iface.OverrideLookupFinish(func(self ProxyResolver, arg0 AsyncResult) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ LookupFinish(AsyncResult) []string })
+				return impl.LookupFinish(arg0)
+			})
+		}
+	})
+}
+
+// Checks if resolver can be used on this system. (This is used
+// internally; [ProxyResolverGetDefault] will only return a proxy
+// resolver that returns true for this method.)
 func (x *ProxyResolverBase) IsSupported() bool {
 
 	cret := XGProxyResolverIsSupported(x.GoPointer())
@@ -187,9 +242,9 @@ func (x *ProxyResolverBase) IsSupported() bool {
 }
 
 // Looks into the system proxy configuration to determine what proxy,
-// if any, to use to connect to @uri. The returned proxy URIs are of
-// the form `&lt;protocol&gt;://[user[:password]@]host[:port]` or
-// `direct://`, where `&lt;protocol&gt;` could be http, rtsp, socks
+// if any, to use to connect to uri. The returned proxy URIs are of
+// the form `<protocol>://[user[:password]@]host[:port]` or
+// `direct://`, where `<protocol>` could be http, rtsp, socks
 // or other proxying protocol.
 //
 // If you don't know what network protocol is being used on the
@@ -206,48 +261,64 @@ func (x *ProxyResolverBase) Lookup(UriVar string, CancellableVar *Cancellable) (
 
 	cret := XGProxyResolverLookup(x.GoPointer(), UriVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
-// Asynchronous lookup of proxy. See g_proxy_resolver_lookup() for more
+// Asynchronous lookup of proxy. See [ProxyResolver.Lookup] for more
 // details.
 func (x *ProxyResolverBase) LookupAsync(UriVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGProxyResolverLookupAsync(x.GoPointer(), UriVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGProxyResolverLookupAsync(x.GoPointer(), UriVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Call this function to obtain the array of proxy URIs when
-// g_proxy_resolver_lookup_async() is complete. See
-// g_proxy_resolver_lookup() for more details.
+// [ProxyResolver.LookupAsync] is complete. See
+// [ProxyResolver.Lookup] for more details.
 func (x *ProxyResolverBase) LookupFinish(ResultVar AsyncResult) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := XGProxyResolverLookupFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
 var XGProxyResolverIsSupported func(uintptr) bool
-var XGProxyResolverLookup func(uintptr, string, uintptr, **glib.Error) []string
+var XGProxyResolverLookup func(uintptr, string, uintptr, **glib.Error) uintptr
 var XGProxyResolverLookupAsync func(uintptr, string, uintptr, uintptr, uintptr)
-var XGProxyResolverLookupFinish func(uintptr, uintptr, **glib.Error) []string
+var XGProxyResolverLookupFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 const (
 	// Extension point for proxy resolving functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	PROXY_RESOLVER_EXTENSION_POINT_NAME string = "gio-proxy-resolver"
 )
 
 var xProxyResolverGetDefault func() uintptr
 
-// Gets the default #GProxyResolver for the system.
+// Gets the default [ProxyResolver] for the system.
 func ProxyResolverGetDefault() *ProxyResolverBase {
 	var cls *ProxyResolverBase
 
@@ -267,20 +338,22 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xProxyResolverGetDefault, libs, "g_proxy_resolver_get_default")
+	core.PuregoRegisterSince(&xProxyResolverGetDefault, libs, "g_proxy_resolver_get_default", "GIO", "2.26")
 
 	core.PuregoSafeRegister(&xProxyResolverGLibType, libs, "g_proxy_resolver_get_type")
 
-	core.PuregoSafeRegister(&XGProxyResolverIsSupported, libs, "g_proxy_resolver_is_supported")
-	core.PuregoSafeRegister(&XGProxyResolverLookup, libs, "g_proxy_resolver_lookup")
-	core.PuregoSafeRegister(&XGProxyResolverLookupAsync, libs, "g_proxy_resolver_lookup_async")
-	core.PuregoSafeRegister(&XGProxyResolverLookupFinish, libs, "g_proxy_resolver_lookup_finish")
+	gobject.CheckClassSize("GProxyResolverInterface", xProxyResolverGLibType, unsafe.Sizeof(ProxyResolverInterface{}))
+
+	core.PuregoRegisterSince(&XGProxyResolverIsSupported, libs, "g_proxy_resolver_is_supported", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxyResolverLookup, libs, "g_proxy_resolver_lookup", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxyResolverLookupAsync, libs, "g_proxy_resolver_lookup_async", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGProxyResolverLookupFinish, libs, "g_proxy_resolver_lookup_finish", "GIO", "2.26")
 
 }
@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The virtual function table for #GRemoteActionGroup.
+// The virtual function table for [RemoteActionGroup].
 type RemoteActionGroupInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xActivateActionFull uintptr
 
@@ -27,73 +27,75 @@ func (x *RemoteActionGroupInterface) GoPointer() uintptr {
 }
 
 // OverrideActivateActionFull sets the "activate_action_full" callback function.
-// the virtual function pointer for g_remote_action_group_activate_action_full()
+// the virtual function pointer for [RemoteActionGroup.ActivateActionFull]
 func (x *RemoteActionGroupInterface) OverrideActivateActionFull(cb func(RemoteActionGroup, string, *glib.Variant, *glib.Variant)) {
 	if cb == nil {
 		x.xActivateActionFull = 0
 	} else {
-		x.xActivateActionFull = purego.NewCallback(func(RemoteVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant, PlatformDataVarp *glib.Variant) {
+		x.xActivateActionFull = core.NewCallback(func(RemoteVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&RemoteActionGroupBase{Ptr: RemoteVarp}, ActionNameVarp, ParameterVarp, PlatformDataVarp)
 		})
 	}
 }
 
 // GetActivateActionFull gets the "activate_action_full" callback function.
-// the virtual function pointer for g_remote_action_group_activate_action_full()
+// the virtual function pointer for [RemoteActionGroup.ActivateActionFull]
 func (x *RemoteActionGroupInterface) GetActivateActionFull() func(RemoteActionGroup, string, *glib.Variant, *glib.Variant) {
 	if x.xActivateActionFull == 0 {
 		return nil
 	}
 	var rawCallback func(RemoteVarp uintptr, ActionNameVarp string, ParameterVarp *glib.Variant, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xActivateActionFull)
+	core.RegisterFunc(&rawCallback, x.xActivateActionFull)
 	return func(RemoteVar RemoteActionGroup, ActionNameVar string, ParameterVar *glib.Variant, PlatformDataVar *glib.Variant) {
 		rawCallback(RemoteVar.GoPointer(), ActionNameVar, ParameterVar, PlatformDataVar)
 	}
 }
 
 // OverrideChangeActionStateFull sets the "change_action_state_full" callback function.
-// the virtual function pointer for g_remote_action_group_change_action_state_full()
+// the virtual function pointer for [RemoteActionGroup.ChangeActionStateFull]
 func (x *RemoteActionGroupInterface) OverrideChangeActionStateFull(cb func(RemoteActionGroup, string, *glib.Variant, *glib.Variant)) {
 	if cb == nil {
 		x.xChangeActionStateFull = 0
 	} else {
-		x.xChangeActionStateFull = purego.NewCallback(func(RemoteVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant, PlatformDataVarp *glib.Variant) {
+		x.xChangeActionStateFull = core.NewCallback(func(RemoteVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant, PlatformDataVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&RemoteActionGroupBase{Ptr: RemoteVarp}, ActionNameVarp, ValueVarp, PlatformDataVarp)
 		})
 	}
 }
 
 // GetChangeActionStateFull gets the "change_action_state_full" callback function.
-// the virtual function pointer for g_remote_action_group_change_action_state_full()
+// the virtual function pointer for [RemoteActionGroup.ChangeActionStateFull]
 func (x *RemoteActionGroupInterface) GetChangeActionStateFull() func(RemoteActionGroup, string, *glib.Variant, *glib.Variant) {
 	if x.xChangeActionStateFull == 0 {
 		return nil
 	}
 	var rawCallback func(RemoteVarp uintptr, ActionNameVarp string, ValueVarp *glib.Variant, PlatformDataVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xChangeActionStateFull)
+	core.RegisterFunc(&rawCallback, x.xChangeActionStateFull)
 	return func(RemoteVar RemoteActionGroup, ActionNameVar string, ValueVar *glib.Variant, PlatformDataVar *glib.Variant) {
 		rawCallback(RemoteVar.GoPointer(), ActionNameVar, ValueVar, PlatformDataVar)
 	}
 }
 
-// The `GRemoteActionGroup` interface is implemented by [iface@Gio.ActionGroup]
+// The `GRemoteActionGroup` interface is implemented by [ActionGroup]
 // instances that either transmit action invocations to other processes
 // or receive action invocations in the local process from other
 // processes.
 //
 // The interface has `_full` variants of the two
-// methods on [iface@Gio.ActionGroup] used to activate actions:
-// [method@Gio.ActionGroup.activate_action] and
-// [method@Gio.ActionGroup.change_action_state]. These variants allow a
-// ‘platform data’ [struct@GLib.Variant] to be specified: a dictionary providing
+// methods on [ActionGroup] used to activate actions:
+// [ActionGroup.ActivateAction] and
+// [ActionGroup.ChangeActionState]. These variants allow a
+// ‘platform data’ [glib.Variant] to be specified: a dictionary providing
 // context for the action invocation (for example: timestamps, startup
 // notification IDs, etc).
 //
-// [class@Gio.DBusActionGroup] implements `GRemoteActionGroup`.  This provides a
+// [DBusActionGroup] implements `GRemoteActionGroup`.  This provides a
 // mechanism to send platform data for action invocations over D-Bus.
 //
-// Additionally, [method@Gio.DBusConnection.export_action_group] will check if
-// the exported [iface@Gio.ActionGroup] implements `GRemoteActionGroup` and use
+// Additionally, [DBusConnection.ExportActionGroup] will check if
+// the exported [ActionGroup] implements `GRemoteActionGroup` and use
 // the `_full` variants of the calls if available.  This
 // provides a mechanism by which to receive platform data for action
 // invocations that arrive by way of D-Bus.
@@ -125,15 +127,50 @@ func (x *RemoteActionGroupBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementRemoteActionGroup adds the RemoteActionGroup interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - ActivateActionFull(string, *glib.Variant, *glib.Variant)
+//   - ChangeActionStateFull(string, *glib.Variant, *glib.Variant)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see RemoteActionGroupInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementRemoteActionGroup[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, RemoteActionGroupGLibType(), func(iface *RemoteActionGroupInterface) {
+		if _, ok := any(zero).(interface {
+			ActivateActionFull(string, *glib.Variant, *glib.Variant)
+		}); ok {
+			iface.OverrideActivateActionFull(func(self RemoteActionGroup, arg0 string, arg1 *glib.Variant, arg2 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ActivateActionFull(string, *glib.Variant, *glib.Variant)
+				})
+				impl.ActivateActionFull(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ChangeActionStateFull(string, *glib.Variant, *glib.Variant)
+		}); ok {
+			iface.OverrideChangeActionStateFull(func(self RemoteActionGroup, arg0 string, arg1 *glib.Variant, arg2 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ChangeActionStateFull(string, *glib.Variant, *glib.Variant)
+				})
+				impl.ChangeActionStateFull(arg0, arg1, arg2)
+			})
+		}
+	})
+}
+
 // Activates the remote action.
 //
-// This is the same as g_action_group_activate_action() except that it
+// This is the same as [ActionGroup.ActivateAction] except that it
 // allows for provision of "platform data" to be sent along with the
 // activation request.  This typically contains details such as the user
 // interaction timestamp or startup notification information.
 //
-// @platform_data must be non-%NULL and must have the type
-// %G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
+// platform_data must be non-nil and must have the type
+// G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
 func (x *RemoteActionGroupBase) ActivateActionFull(ActionNameVar string, ParameterVar *glib.Variant, PlatformDataVar *glib.Variant) {
 
 	XGRemoteActionGroupActivateActionFull(x.GoPointer(), ActionNameVar, ParameterVar, PlatformDataVar)
@@ -142,13 +179,13 @@ func (x *RemoteActionGroupBase) ActivateActionFull(ActionNameVar string, Paramet
 
 // Changes the state of a remote action.
 //
-// This is the same as g_action_group_change_action_state() except that
+// This is the same as [ActionGroup.ChangeActionState] except that
 // it allows for provision of "platform data" to be sent along with the
 // state change request.  This typically contains details such as the
 // user interaction timestamp or startup notification information.
 //
-// @platform_data must be non-%NULL and must have the type
-// %G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
+// platform_data must be non-nil and must have the type
+// G_VARIANT_TYPE_VARDICT.  If it is floating, it will be consumed.
 func (x *RemoteActionGroupBase) ChangeActionStateFull(ActionNameVar string, ValueVar *glib.Variant, PlatformDataVar *glib.Variant) {
 
 	XGRemoteActionGroupChangeActionStateFull(x.GoPointer(), ActionNameVar, ValueVar, PlatformDataVar)
@@ -163,7 +200,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -172,7 +209,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xRemoteActionGroupGLibType, libs, "g_remote_action_group_get_type")
 
-	core.PuregoSafeRegister(&XGRemoteActionGroupActivateActionFull, libs, "g_remote_action_group_activate_action_full")
-	core.PuregoSafeRegister(&XGRemoteActionGroupChangeActionStateFull, libs, "g_remote_action_group_change_action_state_full")
+	gobject.CheckClassSize("GRemoteActionGroupInterface", xRemoteActionGroupGLibType, unsafe.Sizeof(RemoteActionGroupInterface{}))
+
+	core.PuregoRegisterSince(&XGRemoteActionGroupActivateActionFull, libs, "g_remote_action_group_activate_action_full", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGRemoteActionGroupChangeActionStateFull, libs, "g_remote_action_group_change_action_state_full", "GIO", "2.32")
 
 }
@@ -2,10 +2,11 @@
 package gio
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -59,7 +60,8 @@ func (x *ResolverClass) OverrideReload(cb func(*Resolver)) {
 	if cb == nil {
 		x.xReload = 0
 	} else {
-		x.xReload = purego.NewCallback(func(ResolverVarp uintptr) {
+		x.xReload = core.NewCallback(func(ResolverVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp))
 		})
 	}
@@ -71,7 +73,7 @@ func (x *ResolverClass) GetReload() func(*Resolver) {
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReload)
+	core.RegisterFunc(&rawCallback, x.xReload)
 	return func(ResolverVar *Resolver) {
 		rawCallback(ResolverVar.GoPointer())
 	}
@@ -82,7 +84,8 @@ func (x *ResolverClass) OverrideLookupByName(cb func(*Resolver, string, *Cancell
 	if cb == nil {
 		x.xLookupByName = 0
 	} else {
-		x.xLookupByName = purego.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr) *glib.List {
+		x.xLookupByName = core.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), HostnameVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -94,7 +97,7 @@ func (x *ResolverClass) GetLookupByName() func(*Resolver, string, *Cancellable)
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupByName)
+	core.RegisterFunc(&rawCallback, x.xLookupByName)
 	return func(ResolverVar *Resolver, HostnameVar string, CancellableVar *Cancellable) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), HostnameVar, CancellableVar.GoPointer())
 	}
@@ -105,7 +108,8 @@ func (x *ResolverClass) OverrideLookupByNameAsync(cb func(*Resolver, string, *Ca
 	if cb == nil {
 		x.xLookupByNameAsync = 0
 	} else {
-		x.xLookupByNameAsync = purego.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupByNameAsync = core.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp), HostnameVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -117,7 +121,7 @@ func (x *ResolverClass) GetLookupByNameAsync() func(*Resolver, string, *Cancella
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, HostnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupByNameAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupByNameAsync)
 	return func(ResolverVar *Resolver, HostnameVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), HostnameVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -128,7 +132,8 @@ func (x *ResolverClass) OverrideLookupByNameFinish(cb func(*Resolver, AsyncResul
 	if cb == nil {
 		x.xLookupByNameFinish = 0
 	} else {
-		x.xLookupByNameFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xLookupByNameFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -140,7 +145,7 @@ func (x *ResolverClass) GetLookupByNameFinish() func(*Resolver, AsyncResult) *gl
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupByNameFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupByNameFinish)
 	return func(ResolverVar *Resolver, ResultVar AsyncResult) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -151,7 +156,8 @@ func (x *ResolverClass) OverrideLookupByAddress(cb func(*Resolver, *InetAddress,
 	if cb == nil {
 		x.xLookupByAddress = 0
 	} else {
-		x.xLookupByAddress = purego.NewCallback(func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr) string {
+		x.xLookupByAddress = core.NewCallback(func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), InetAddressNewFromInternalPtr(AddressVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -163,7 +169,7 @@ func (x *ResolverClass) GetLookupByAddress() func(*Resolver, *InetAddress, *Canc
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xLookupByAddress)
+	core.RegisterFunc(&rawCallback, x.xLookupByAddress)
 	return func(ResolverVar *Resolver, AddressVar *InetAddress, CancellableVar *Cancellable) string {
 		return rawCallback(ResolverVar.GoPointer(), AddressVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -174,7 +180,8 @@ func (x *ResolverClass) OverrideLookupByAddressAsync(cb func(*Resolver, *InetAdd
 	if cb == nil {
 		x.xLookupByAddressAsync = 0
 	} else {
-		x.xLookupByAddressAsync = purego.NewCallback(func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupByAddressAsync = core.NewCallback(func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp), InetAddressNewFromInternalPtr(AddressVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -186,7 +193,7 @@ func (x *ResolverClass) GetLookupByAddressAsync() func(*Resolver, *InetAddress,
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, AddressVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupByAddressAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupByAddressAsync)
 	return func(ResolverVar *Resolver, AddressVar *InetAddress, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), AddressVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -197,7 +204,8 @@ func (x *ResolverClass) OverrideLookupByAddressFinish(cb func(*Resolver, AsyncRe
 	if cb == nil {
 		x.xLookupByAddressFinish = 0
 	} else {
-		x.xLookupByAddressFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) string {
+		x.xLookupByAddressFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -209,7 +217,7 @@ func (x *ResolverClass) GetLookupByAddressFinish() func(*Resolver, AsyncResult)
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xLookupByAddressFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupByAddressFinish)
 	return func(ResolverVar *Resolver, ResultVar AsyncResult) string {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -220,7 +228,8 @@ func (x *ResolverClass) OverrideLookupService(cb func(*Resolver, string, *Cancel
 	if cb == nil {
 		x.xLookupService = 0
 	} else {
-		x.xLookupService = purego.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr) *glib.List {
+		x.xLookupService = core.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), RrnameVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -232,7 +241,7 @@ func (x *ResolverClass) GetLookupService() func(*Resolver, string, *Cancellable)
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupService)
+	core.RegisterFunc(&rawCallback, x.xLookupService)
 	return func(ResolverVar *Resolver, RrnameVar string, CancellableVar *Cancellable) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), RrnameVar, CancellableVar.GoPointer())
 	}
@@ -243,7 +252,8 @@ func (x *ResolverClass) OverrideLookupServiceAsync(cb func(*Resolver, string, *C
 	if cb == nil {
 		x.xLookupServiceAsync = 0
 	} else {
-		x.xLookupServiceAsync = purego.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupServiceAsync = core.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp), RrnameVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -255,7 +265,7 @@ func (x *ResolverClass) GetLookupServiceAsync() func(*Resolver, string, *Cancell
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, RrnameVarp string, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupServiceAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupServiceAsync)
 	return func(ResolverVar *Resolver, RrnameVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), RrnameVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -266,7 +276,8 @@ func (x *ResolverClass) OverrideLookupServiceFinish(cb func(*Resolver, AsyncResu
 	if cb == nil {
 		x.xLookupServiceFinish = 0
 	} else {
-		x.xLookupServiceFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xLookupServiceFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -278,7 +289,7 @@ func (x *ResolverClass) GetLookupServiceFinish() func(*Resolver, AsyncResult) *g
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupServiceFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupServiceFinish)
 	return func(ResolverVar *Resolver, ResultVar AsyncResult) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -289,7 +300,8 @@ func (x *ResolverClass) OverrideLookupRecords(cb func(*Resolver, string, Resolve
 	if cb == nil {
 		x.xLookupRecords = 0
 	} else {
-		x.xLookupRecords = purego.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr) *glib.List {
+		x.xLookupRecords = core.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), RrnameVarp, RecordTypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -301,7 +313,7 @@ func (x *ResolverClass) GetLookupRecords() func(*Resolver, string, ResolverRecor
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupRecords)
+	core.RegisterFunc(&rawCallback, x.xLookupRecords)
 	return func(ResolverVar *Resolver, RrnameVar string, RecordTypeVar ResolverRecordType, CancellableVar *Cancellable) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), RrnameVar, RecordTypeVar, CancellableVar.GoPointer())
 	}
@@ -312,7 +324,8 @@ func (x *ResolverClass) OverrideLookupRecordsAsync(cb func(*Resolver, string, Re
 	if cb == nil {
 		x.xLookupRecordsAsync = 0
 	} else {
-		x.xLookupRecordsAsync = purego.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupRecordsAsync = core.NewCallback(func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp), RrnameVarp, RecordTypeVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -324,7 +337,7 @@ func (x *ResolverClass) GetLookupRecordsAsync() func(*Resolver, string, Resolver
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, RrnameVarp string, RecordTypeVarp ResolverRecordType, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupRecordsAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupRecordsAsync)
 	return func(ResolverVar *Resolver, RrnameVar string, RecordTypeVar ResolverRecordType, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), RrnameVar, RecordTypeVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -335,7 +348,8 @@ func (x *ResolverClass) OverrideLookupRecordsFinish(cb func(*Resolver, AsyncResu
 	if cb == nil {
 		x.xLookupRecordsFinish = 0
 	} else {
-		x.xLookupRecordsFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xLookupRecordsFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -347,7 +361,7 @@ func (x *ResolverClass) GetLookupRecordsFinish() func(*Resolver, AsyncResult) *g
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupRecordsFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupRecordsFinish)
 	return func(ResolverVar *Resolver, ResultVar AsyncResult) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -358,7 +372,8 @@ func (x *ResolverClass) OverrideLookupByNameWithFlagsAsync(cb func(*Resolver, st
 	if cb == nil {
 		x.xLookupByNameWithFlagsAsync = 0
 	} else {
-		x.xLookupByNameWithFlagsAsync = purego.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupByNameWithFlagsAsync = core.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ResolverNewFromInternalPtr(ResolverVarp), HostnameVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -370,7 +385,7 @@ func (x *ResolverClass) GetLookupByNameWithFlagsAsync() func(*Resolver, string,
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupByNameWithFlagsAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupByNameWithFlagsAsync)
 	return func(ResolverVar *Resolver, HostnameVar string, FlagsVar ResolverNameLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ResolverVar.GoPointer(), HostnameVar, FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -381,7 +396,8 @@ func (x *ResolverClass) OverrideLookupByNameWithFlagsFinish(cb func(*Resolver, A
 	if cb == nil {
 		x.xLookupByNameWithFlagsFinish = 0
 	} else {
-		x.xLookupByNameWithFlagsFinish = purego.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xLookupByNameWithFlagsFinish = core.NewCallback(func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -393,7 +409,7 @@ func (x *ResolverClass) GetLookupByNameWithFlagsFinish() func(*Resolver, AsyncRe
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupByNameWithFlagsFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupByNameWithFlagsFinish)
 	return func(ResolverVar *Resolver, ResultVar AsyncResult) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -404,7 +420,8 @@ func (x *ResolverClass) OverrideLookupByNameWithFlags(cb func(*Resolver, string,
 	if cb == nil {
 		x.xLookupByNameWithFlags = 0
 	} else {
-		x.xLookupByNameWithFlags = purego.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr) *glib.List {
+		x.xLookupByNameWithFlags = core.NewCallback(func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(ResolverNewFromInternalPtr(ResolverVarp), HostnameVarp, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -416,7 +433,7 @@ func (x *ResolverClass) GetLookupByNameWithFlags() func(*Resolver, string, Resol
 		return nil
 	}
 	var rawCallback func(ResolverVarp uintptr, HostnameVarp string, FlagsVarp ResolverNameLookupFlags, CancellableVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupByNameWithFlags)
+	core.RegisterFunc(&rawCallback, x.xLookupByNameWithFlags)
 	return func(ResolverVar *Resolver, HostnameVar string, FlagsVar ResolverNameLookupFlags, CancellableVar *Cancellable) *glib.List {
 		return rawCallback(ResolverVar.GoPointer(), HostnameVar, FlagsVar, CancellableVar.GoPointer())
 	}
@@ -431,7 +448,7 @@ func (x *ResolverPrivate) GoPointer() uintptr {
 }
 
 // Flags to modify lookup behavior.
-type ResolverNameLookupFlags int
+type ResolverNameLookupFlags uint32
 
 var xResolverNameLookupFlagsGLibType func() types.GType
 
@@ -441,7 +458,7 @@ func ResolverNameLookupFlagsGLibType() types.GType {
 
 const (
 
-	// default behavior (same as g_resolver_lookup_by_name())
+	// default behavior (same as [Resolver.LookupByName])
 	GResolverNameLookupFlagsDefaultValue ResolverNameLookupFlags = 0
 	// only resolve ipv4 addresses
 	GResolverNameLookupFlagsIpv4OnlyValue ResolverNameLookupFlags = 1
@@ -449,29 +466,70 @@ const (
 	GResolverNameLookupFlagsIpv6OnlyValue ResolverNameLookupFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ResolverNameLookupFlags) GLibType() types.GType {
+	return xResolverNameLookupFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ResolverNameLookupFlags) Has(flags ResolverNameLookupFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ResolverNameLookupFlags) Set(flags ResolverNameLookupFlags) ResolverNameLookupFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ResolverNameLookupFlags) Clear(flags ResolverNameLookupFlags) ResolverNameLookupFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ResolverNameLookupFlags) String() string {
+	if x == 0 {
+		return "G_RESOLVER_NAME_LOOKUP_FLAGS_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GResolverNameLookupFlagsIpv4OnlyValue != 0 && x&GResolverNameLookupFlagsIpv4OnlyValue == GResolverNameLookupFlagsIpv4OnlyValue {
+		names = append(names, "G_RESOLVER_NAME_LOOKUP_FLAGS_IPV4_ONLY")
+		rest &^= GResolverNameLookupFlagsIpv4OnlyValue
+	}
+	if rest&GResolverNameLookupFlagsIpv6OnlyValue != 0 && x&GResolverNameLookupFlagsIpv6OnlyValue == GResolverNameLookupFlagsIpv6OnlyValue {
+		names = append(names, "G_RESOLVER_NAME_LOOKUP_FLAGS_IPV6_ONLY")
+		rest &^= GResolverNameLookupFlagsIpv6OnlyValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xResolverErrorQuark func() glib.Quark
 
-// Gets the #GResolver Error Quark.
+// Gets the [Resolver] Error Quark.
 func ResolverErrorQuark() glib.Quark {
 
 	cret := xResolverErrorQuark()
 	return cret
 }
 
-// The object that handles DNS resolution. Use [func@Gio.Resolver.get_default]
+// The object that handles DNS resolution. Use [ResolverGetDefault]
 // to get the default resolver.
 //
 // `GResolver` provides cancellable synchronous and asynchronous DNS
-// resolution, for hostnames ([method@Gio.Resolver.lookup_by_address],
-// [method@Gio.Resolver.lookup_by_name] and their async variants) and SRV
-// (service) records ([method@Gio.Resolver.lookup_service]).
+// resolution, for hostnames ([Resolver.LookupByAddress],
+// [Resolver.LookupByName] and their async variants) and SRV
+// (service) records ([Resolver.LookupService]).
 //
-// [class@Gio.NetworkAddress] and [class@Gio.NetworkService] provide wrappers
+// [NetworkAddress] and [NetworkService] provide wrappers
 // around `GResolver` functionality that also implement
-// [iface@Gio.SocketConnectable], making it easy to connect to a remote
+// [SocketConnectable], making it easy to connect to a remote
 // host/service.
 //
-// The default resolver (see [func@Gio.Resolver.get_default]) has a timeout of
+// The default resolver (see [ResolverGetDefault]) has a timeout of
 // 30s set on it since GLib 2.78. Earlier versions of GLib did not support
 // resolver timeouts.
 //
@@ -495,7 +553,7 @@ func ResolverNewFromInternalPtr(ptr uintptr) *Resolver {
 
 var xResolverGetTimeout func(uintptr) uint
 
-// Get the timeout applied to all resolver lookups. See #GResolver:timeout.
+// Get the timeout applied to all resolver lookups. See [Resolver.GetPropertyTimeout].
 func (x *Resolver) GetTimeout() uint {
 
 	cret := xResolverGetTimeout(x.GoPointer())
@@ -504,21 +562,21 @@ func (x *Resolver) GetTimeout() uint {
 
 var xResolverLookupByAddress func(uintptr, uintptr, uintptr, **glib.Error) string
 
-// Synchronously reverse-resolves @address to determine its
+// Synchronously reverse-resolves address to determine its
 // associated hostname.
 //
-// If the DNS resolution fails, @error (if non-%NULL) will be set to
-// a value from #GResolverError.
+// If the DNS resolution fails, error (if non-nil) will be set to
+// a value from [ResolverError].
 //
-// If @cancellable is non-%NULL, it can be used to cancel the
-// operation, in which case @error (if non-%NULL) will be set to
-// %G_IO_ERROR_CANCELLED.
+// If cancellable is non-nil, it can be used to cancel the
+// operation, in which case error (if non-nil) will be set to
+// [GIoErrorCancelledValue].
 func (x *Resolver) LookupByAddress(AddressVar *InetAddress, CancellableVar *Cancellable) (string, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByAddress(x.GoPointer(), AddressVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -526,9 +584,9 @@ func (x *Resolver) LookupByAddress(AddressVar *InetAddress, CancellableVar *Canc
 
 var xResolverLookupByAddressAsync func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Begins asynchronously reverse-resolving @address to determine its
-// associated hostname, and eventually calls @callback, which must
-// call g_resolver_lookup_by_address_finish() to get the final result.
+// Begins asynchronously reverse-resolving address to determine its
+// associated hostname, and eventually calls callback, which must
+// call [Resolver.LookupByAddressFinish] to get the final result.
 func (x *Resolver) LookupByAddressAsync(AddressVar *InetAddress, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -538,11 +596,12 @@ func (x *Resolver) LookupByAddressAsync(AddressVar *InetAddress, CancellableVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -553,17 +612,17 @@ func (x *Resolver) LookupByAddressAsync(AddressVar *InetAddress, CancellableVar
 var xResolverLookupByAddressFinish func(uintptr, uintptr, **glib.Error) string
 
 // Retrieves the result of a previous call to
-// g_resolver_lookup_by_address_async().
+// [Resolver.LookupByAddressAsync].
 //
-// If the DNS resolution failed, @error (if non-%NULL) will be set to
-// a value from #GResolverError. If the operation was cancelled,
-// @error will be set to %G_IO_ERROR_CANCELLED.
+// If the DNS resolution failed, error (if non-nil) will be set to
+// a value from [ResolverError]. If the operation was cancelled,
+// error will be set to [GIoErrorCancelledValue].
 func (x *Resolver) LookupByAddressFinish(ResultVar AsyncResult) (string, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByAddressFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -571,35 +630,35 @@ func (x *Resolver) LookupByAddressFinish(ResultVar AsyncResult) (string, error)
 
 var xResolverLookupByName func(uintptr, string, uintptr, **glib.Error) *glib.List
 
-// Synchronously resolves @hostname to determine its associated IP
-// address(es). @hostname may be an ASCII-only or UTF-8 hostname, or
+// Synchronously resolves hostname to determine its associated IP
+// address(es). hostname may be an ASCII-only or UTF-8 hostname, or
 // the textual form of an IP address (in which case this just becomes
-// a wrapper around g_inet_address_new_from_string()).
+// a wrapper around [NewInetAddressFromString]).
 //
-// On success, g_resolver_lookup_by_name() will return a non-empty #GList of
-// #GInetAddress, sorted in order of preference and guaranteed to not
+// On success, [Resolver.LookupByName] will return a non-empty [glib.List] of
+// [InetAddress], sorted in order of preference and guaranteed to not
 // contain duplicates. That is, if using the result to connect to
-// @hostname, you should attempt to connect to the first address
+// hostname, you should attempt to connect to the first address
 // first, then the second if the first fails, etc. If you are using
 // the result to listen on a socket, it is appropriate to add each
-// result using e.g. g_socket_listener_add_address().
+// result using e.g. [SocketListener.AddAddress].
 //
-// If the DNS resolution fails, @error (if non-%NULL) will be set to a
-// value from #GResolverError and %NULL will be returned.
+// If the DNS resolution fails, error (if non-nil) will be set to a
+// value from [ResolverError] and nil will be returned.
 //
-// If @cancellable is non-%NULL, it can be used to cancel the
-// operation, in which case @error (if non-%NULL) will be set to
-// %G_IO_ERROR_CANCELLED.
+// If cancellable is non-nil, it can be used to cancel the
+// operation, in which case error (if non-nil) will be set to
+// [GIoErrorCancelledValue].
 //
 // If you are planning to connect to a socket on the resolved IP
-// address, it may be easier to create a #GNetworkAddress and use its
-// #GSocketConnectable interface.
+// address, it may be easier to create a [NetworkAddress] and use its
+// [SocketConnectable] interface.
 func (x *Resolver) LookupByName(HostnameVar string, CancellableVar *Cancellable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByName(x.GoPointer(), HostnameVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -607,10 +666,10 @@ func (x *Resolver) LookupByName(HostnameVar string, CancellableVar *Cancellable)
 
 var xResolverLookupByNameAsync func(uintptr, string, uintptr, uintptr, uintptr)
 
-// Begins asynchronously resolving @hostname to determine its
-// associated IP address(es), and eventually calls @callback, which
-// must call g_resolver_lookup_by_name_finish() to get the result.
-// See g_resolver_lookup_by_name() for more details.
+// Begins asynchronously resolving hostname to determine its
+// associated IP address(es), and eventually calls callback, which
+// must call [Resolver.LookupByNameFinish] to get the result.
+// See [Resolver.LookupByName] for more details.
 func (x *Resolver) LookupByNameAsync(HostnameVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -620,11 +679,12 @@ func (x *Resolver) LookupByNameAsync(HostnameVar string, CancellableVar *Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -635,17 +695,17 @@ func (x *Resolver) LookupByNameAsync(HostnameVar string, CancellableVar *Cancell
 var xResolverLookupByNameFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
 // Retrieves the result of a call to
-// g_resolver_lookup_by_name_async().
+// [Resolver.LookupByNameAsync].
 //
-// If the DNS resolution failed, @error (if non-%NULL) will be set to
-// a value from #GResolverError. If the operation was cancelled,
-// @error will be set to %G_IO_ERROR_CANCELLED.
+// If the DNS resolution failed, error (if non-nil) will be set to
+// a value from [ResolverError]. If the operation was cancelled,
+// error will be set to [GIoErrorCancelledValue].
 func (x *Resolver) LookupByNameFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByNameFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -653,15 +713,15 @@ func (x *Resolver) LookupByNameFinish(ResultVar AsyncResult) (*glib.List, error)
 
 var xResolverLookupByNameWithFlags func(uintptr, string, ResolverNameLookupFlags, uintptr, **glib.Error) *glib.List
 
-// This differs from g_resolver_lookup_by_name() in that you can modify
-// the lookup behavior with @flags. For example this can be used to limit
-// results with %G_RESOLVER_NAME_LOOKUP_FLAGS_IPV4_ONLY.
+// This differs from [Resolver.LookupByName] in that you can modify
+// the lookup behavior with flags. For example this can be used to limit
+// results with [GResolverNameLookupFlagsIpv4OnlyValue].
 func (x *Resolver) LookupByNameWithFlags(HostnameVar string, FlagsVar ResolverNameLookupFlags, CancellableVar *Cancellable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByNameWithFlags(x.GoPointer(), HostnameVar, FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -669,10 +729,10 @@ func (x *Resolver) LookupByNameWithFlags(HostnameVar string, FlagsVar ResolverNa
 
 var xResolverLookupByNameWithFlagsAsync func(uintptr, string, ResolverNameLookupFlags, uintptr, uintptr, uintptr)
 
-// Begins asynchronously resolving @hostname to determine its
-// associated IP address(es), and eventually calls @callback, which
-// must call g_resolver_lookup_by_name_with_flags_finish() to get the result.
-// See g_resolver_lookup_by_name() for more details.
+// Begins asynchronously resolving hostname to determine its
+// associated IP address(es), and eventually calls callback, which
+// must call [Resolver.LookupByNameWithFlagsFinish] to get the result.
+// See [Resolver.LookupByName] for more details.
 func (x *Resolver) LookupByNameWithFlagsAsync(HostnameVar string, FlagsVar ResolverNameLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -682,11 +742,12 @@ func (x *Resolver) LookupByNameWithFlagsAsync(HostnameVar string, FlagsVar Resol
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -697,17 +758,17 @@ func (x *Resolver) LookupByNameWithFlagsAsync(HostnameVar string, FlagsVar Resol
 var xResolverLookupByNameWithFlagsFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
 // Retrieves the result of a call to
-// g_resolver_lookup_by_name_with_flags_async().
+// [Resolver.LookupByNameWithFlagsAsync].
 //
-// If the DNS resolution failed, @error (if non-%NULL) will be set to
-// a value from #GResolverError. If the operation was cancelled,
-// @error will be set to %G_IO_ERROR_CANCELLED.
+// If the DNS resolution failed, error (if non-nil) will be set to
+// a value from [ResolverError]. If the operation was cancelled,
+// error will be set to [GIoErrorCancelledValue].
 func (x *Resolver) LookupByNameWithFlagsFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupByNameWithFlagsFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -715,22 +776,22 @@ func (x *Resolver) LookupByNameWithFlagsFinish(ResultVar AsyncResult) (*glib.Lis
 
 var xResolverLookupRecords func(uintptr, string, ResolverRecordType, uintptr, **glib.Error) *glib.List
 
-// Synchronously performs a DNS record lookup for the given @rrname and returns
-// a list of records as #GVariant tuples. See #GResolverRecordType for
-// information on what the records contain for each @record_type.
+// Synchronously performs a DNS record lookup for the given rrname and returns
+// a list of records as [glib.Variant] tuples. See [ResolverRecordType] for
+// information on what the records contain for each record_type.
 //
-// If the DNS resolution fails, @error (if non-%NULL) will be set to
-// a value from #GResolverError and %NULL will be returned.
+// If the DNS resolution fails, error (if non-nil) will be set to
+// a value from [ResolverError] and nil will be returned.
 //
-// If @cancellable is non-%NULL, it can be used to cancel the
-// operation, in which case @error (if non-%NULL) will be set to
-// %G_IO_ERROR_CANCELLED.
+// If cancellable is non-nil, it can be used to cancel the
+// operation, in which case error (if non-nil) will be set to
+// [GIoErrorCancelledValue].
 func (x *Resolver) LookupRecords(RrnameVar string, RecordTypeVar ResolverRecordType, CancellableVar *Cancellable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupRecords(x.GoPointer(), RrnameVar, RecordTypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -739,9 +800,9 @@ func (x *Resolver) LookupRecords(RrnameVar string, RecordTypeVar ResolverRecordT
 var xResolverLookupRecordsAsync func(uintptr, string, ResolverRecordType, uintptr, uintptr, uintptr)
 
 // Begins asynchronously performing a DNS lookup for the given
-// @rrname, and eventually calls @callback, which must call
-// g_resolver_lookup_records_finish() to get the final result. See
-// g_resolver_lookup_records() for more details.
+// rrname, and eventually calls callback, which must call
+// [Resolver.LookupRecordsFinish] to get the final result. See
+// [Resolver.LookupRecords] for more details.
 func (x *Resolver) LookupRecordsAsync(RrnameVar string, RecordTypeVar ResolverRecordType, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -751,11 +812,12 @@ func (x *Resolver) LookupRecordsAsync(RrnameVar string, RecordTypeVar ResolverRe
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -766,19 +828,19 @@ func (x *Resolver) LookupRecordsAsync(RrnameVar string, RecordTypeVar ResolverRe
 var xResolverLookupRecordsFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
 // Retrieves the result of a previous call to
-// g_resolver_lookup_records_async(). Returns a non-empty list of records as
-// #GVariant tuples. See #GResolverRecordType for information on what the
+// [Resolver.LookupRecordsAsync]. Returns a non-empty list of records as
+// [glib.Variant] tuples. See [ResolverRecordType] for information on what the
 // records contain.
 //
-// If the DNS resolution failed, @error (if non-%NULL) will be set to
-// a value from #GResolverError. If the operation was cancelled,
-// @error will be set to %G_IO_ERROR_CANCELLED.
+// If the DNS resolution failed, error (if non-nil) will be set to
+// a value from [ResolverError]. If the operation was cancelled,
+// error will be set to [GIoErrorCancelledValue].
 func (x *Resolver) LookupRecordsFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupRecordsFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -786,33 +848,33 @@ func (x *Resolver) LookupRecordsFinish(ResultVar AsyncResult) (*glib.List, error
 
 var xResolverLookupService func(uintptr, string, string, string, uintptr, **glib.Error) *glib.List
 
-// Synchronously performs a DNS SRV lookup for the given @service and
-// @protocol in the given @domain and returns an array of #GSrvTarget.
-// @domain may be an ASCII-only or UTF-8 hostname. Note also that the
-// @service and @protocol arguments do not include the leading underscore
+// Synchronously performs a DNS SRV lookup for the given service and
+// protocol in the given domain and returns an array of [SrvTarget].
+// domain may be an ASCII-only or UTF-8 hostname. Note also that the
+// service and protocol arguments do not include the leading underscore
 // that appears in the actual DNS entry.
 //
-// On success, g_resolver_lookup_service() will return a non-empty #GList of
-// #GSrvTarget, sorted in order of preference. (That is, you should
+// On success, [Resolver.LookupService] will return a non-empty [glib.List] of
+// [SrvTarget], sorted in order of preference. (That is, you should
 // attempt to connect to the first target first, then the second if
 // the first fails, etc.)
 //
-// If the DNS resolution fails, @error (if non-%NULL) will be set to
-// a value from #GResolverError and %NULL will be returned.
+// If the DNS resolution fails, error (if non-nil) will be set to
+// a value from [ResolverError] and nil will be returned.
 //
-// If @cancellable is non-%NULL, it can be used to cancel the
-// operation, in which case @error (if non-%NULL) will be set to
-// %G_IO_ERROR_CANCELLED.
+// If cancellable is non-nil, it can be used to cancel the
+// operation, in which case error (if non-nil) will be set to
+// [GIoErrorCancelledValue].
 //
 // If you are planning to connect to the service, it is usually easier
-// to create a #GNetworkService and use its #GSocketConnectable
+// to create a [NetworkService] and use its [SocketConnectable]
 // interface.
 func (x *Resolver) LookupService(ServiceVar string, ProtocolVar string, DomainVar string, CancellableVar *Cancellable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupService(x.GoPointer(), ServiceVar, ProtocolVar, DomainVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -821,9 +883,9 @@ func (x *Resolver) LookupService(ServiceVar string, ProtocolVar string, DomainVa
 var xResolverLookupServiceAsync func(uintptr, string, string, string, uintptr, uintptr, uintptr)
 
 // Begins asynchronously performing a DNS SRV lookup for the given
-// @service and @protocol in the given @domain, and eventually calls
-// @callback, which must call g_resolver_lookup_service_finish() to
-// get the final result. See g_resolver_lookup_service() for more
+// service and protocol in the given domain, and eventually calls
+// callback, which must call [Resolver.LookupServiceFinish] to
+// get the final result. See [Resolver.LookupService] for more
 // details.
 func (x *Resolver) LookupServiceAsync(ServiceVar string, ProtocolVar string, DomainVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -834,11 +896,12 @@ func (x *Resolver) LookupServiceAsync(ServiceVar string, ProtocolVar string, Dom
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -849,17 +912,17 @@ func (x *Resolver) LookupServiceAsync(ServiceVar string, ProtocolVar string, Dom
 var xResolverLookupServiceFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
 // Retrieves the result of a previous call to
-// g_resolver_lookup_service_async().
+// [Resolver.LookupServiceAsync].
 //
-// If the DNS resolution failed, @error (if non-%NULL) will be set to
-// a value from #GResolverError. If the operation was cancelled,
-// @error will be set to %G_IO_ERROR_CANCELLED.
+// If the DNS resolution failed, error (if non-nil) will be set to
+// a value from [ResolverError]. If the operation was cancelled,
+// error will be set to [GIoErrorCancelledValue].
 func (x *Resolver) LookupServiceFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xResolverLookupServiceFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -867,12 +930,12 @@ func (x *Resolver) LookupServiceFinish(ResultVar AsyncResult) (*glib.List, error
 
 var xResolverSetDefault func(uintptr)
 
-// Sets @resolver to be the application's default resolver (reffing
-// @resolver, and unreffing the previous default resolver, if any).
-// Future calls to g_resolver_get_default() will return this resolver.
+// Sets resolver to be the application's default resolver (reffing
+// resolver, and unreffing the previous default resolver, if any).
+// Future calls to [ResolverGetDefault] will return this resolver.
 //
 // This can be used if an application wants to perform any sort of DNS
-// caching or "pinning"; it can implement its own #GResolver that
+// caching or "pinning"; it can implement its own [Resolver] that
 // calls the original default resolver for DNS operations, and
 // implements its own cache policies on top of that, and then set
 // itself as the default resolver for all later code to use.
@@ -884,7 +947,7 @@ func (x *Resolver) SetDefault() {
 
 var xResolverSetTimeout func(uintptr, uint)
 
-// Set the timeout applied to all resolver lookups. See #GResolver:timeout.
+// Set the timeout applied to all resolver lookups. See [Resolver.GetPropertyTimeout].
 func (x *Resolver) SetTimeout(TimeoutMsVar uint) {
 
 	xResolverSetTimeout(x.GoPointer(), TimeoutMsVar)
@@ -905,7 +968,7 @@ func (c *Resolver) SetGoPointer(ptr uintptr) {
 // SetPropertyTimeout sets the "timeout" property.
 // The timeout applied to all resolver lookups, in milliseconds.
 //
-// This may be changed through the lifetime of the #GResolver. The new value
+// This may be changed through the lifetime of the [Resolver]. The new value
 // will apply to any lookups started after the change, but not to any
 // already-ongoing lookups.
 //
@@ -923,7 +986,7 @@ func (x *Resolver) SetPropertyTimeout(value uint) {
 // GetPropertyTimeout gets the "timeout" property.
 // The timeout applied to all resolver lookups, in milliseconds.
 //
-// This may be changed through the lifetime of the #GResolver. The new value
+// This may be changed through the lifetime of the [Resolver]. The new value
 // will apply to any lookups started after the change, but not to any
 // already-ongoing lookups.
 //
@@ -939,33 +1002,55 @@ func (x *Resolver) GetPropertyTimeout() uint {
 
 // Emitted when the resolver notices that the system resolver
 // configuration has changed.
+//
+// Deprecated: use ConnectReloadFunc, which also accepts method values and closures.
 func (x *Resolver) ConnectReload(cb *func(Resolver)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "reload", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Resolver{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "reload", cbRefPtr, cbPtr)
+}
+
+var xResolverReloadTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xResolverReloadNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Resolver))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Resolver{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "reload", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectReloadFunc connects cb to the "reload" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Resolver) ConnectReloadFunc(cb func(Resolver)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "reload", &xResolverReloadTrampoline, xResolverReloadNewTrampoline, cb)
 }
 
 var xResolverFreeAddresses func(*glib.List)
 
-// Frees @addresses (which should be the return value from
-// g_resolver_lookup_by_name() or g_resolver_lookup_by_name_finish()).
+// Frees addresses (which should be the return value from
+// [Resolver.LookupByName] or [Resolver.LookupByNameFinish]).
 // (This is a convenience method; you can also simply free the results
 // by hand.)
 func ResolverFreeAddresses(AddressesVar *glib.List) {
@@ -976,8 +1061,8 @@ func ResolverFreeAddresses(AddressesVar *glib.List) {
 
 var xResolverFreeTargets func(*glib.List)
 
-// Frees @targets (which should be the return value from
-// g_resolver_lookup_service() or g_resolver_lookup_service_finish()).
+// Frees targets (which should be the return value from
+// [Resolver.LookupService] or [Resolver.LookupServiceFinish]).
 // (This is a convenience method; you can also simply free the
 // results by hand.)
 func ResolverFreeTargets(TargetsVar *glib.List) {
@@ -988,8 +1073,8 @@ func ResolverFreeTargets(TargetsVar *glib.List) {
 
 var xResolverGetDefault func() uintptr
 
-// Gets the default #GResolver. You should unref it when you are done
-// with it. #GResolver may use its reference count as a hint about how
+// Gets the default [Resolver]. You should unref it when you are done
+// with it. [Resolver] may use its reference count as a hint about how
 // many threads it should allocate for concurrent DNS resolutions.
 func ResolverGetDefault() *Resolver {
 	var cls *Resolver
@@ -1009,7 +1094,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1018,31 +1103,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xResolverNameLookupFlagsGLibType, libs, "g_resolver_name_lookup_flags_get_type")
 
-	core.PuregoSafeRegister(&xResolverErrorQuark, libs, "g_resolver_error_quark")
+	core.PuregoRegisterSince(&xResolverErrorQuark, libs, "g_resolver_error_quark", "GIO", "2.22")
 
 	core.PuregoSafeRegister(&xResolverGLibType, libs, "g_resolver_get_type")
 
-	core.PuregoSafeRegister(&xResolverGetTimeout, libs, "g_resolver_get_timeout")
-	core.PuregoSafeRegister(&xResolverLookupByAddress, libs, "g_resolver_lookup_by_address")
-	core.PuregoSafeRegister(&xResolverLookupByAddressAsync, libs, "g_resolver_lookup_by_address_async")
-	core.PuregoSafeRegister(&xResolverLookupByAddressFinish, libs, "g_resolver_lookup_by_address_finish")
-	core.PuregoSafeRegister(&xResolverLookupByName, libs, "g_resolver_lookup_by_name")
-	core.PuregoSafeRegister(&xResolverLookupByNameAsync, libs, "g_resolver_lookup_by_name_async")
-	core.PuregoSafeRegister(&xResolverLookupByNameFinish, libs, "g_resolver_lookup_by_name_finish")
-	core.PuregoSafeRegister(&xResolverLookupByNameWithFlags, libs, "g_resolver_lookup_by_name_with_flags")
-	core.PuregoSafeRegister(&xResolverLookupByNameWithFlagsAsync, libs, "g_resolver_lookup_by_name_with_flags_async")
-	core.PuregoSafeRegister(&xResolverLookupByNameWithFlagsFinish, libs, "g_resolver_lookup_by_name_with_flags_finish")
-	core.PuregoSafeRegister(&xResolverLookupRecords, libs, "g_resolver_lookup_records")
-	core.PuregoSafeRegister(&xResolverLookupRecordsAsync, libs, "g_resolver_lookup_records_async")
-	core.PuregoSafeRegister(&xResolverLookupRecordsFinish, libs, "g_resolver_lookup_records_finish")
-	core.PuregoSafeRegister(&xResolverLookupService, libs, "g_resolver_lookup_service")
-	core.PuregoSafeRegister(&xResolverLookupServiceAsync, libs, "g_resolver_lookup_service_async")
-	core.PuregoSafeRegister(&xResolverLookupServiceFinish, libs, "g_resolver_lookup_service_finish")
-	core.PuregoSafeRegister(&xResolverSetDefault, libs, "g_resolver_set_default")
-	core.PuregoSafeRegister(&xResolverSetTimeout, libs, "g_resolver_set_timeout")
-
-	core.PuregoSafeRegister(&xResolverFreeAddresses, libs, "g_resolver_free_addresses")
-	core.PuregoSafeRegister(&xResolverFreeTargets, libs, "g_resolver_free_targets")
-	core.PuregoSafeRegister(&xResolverGetDefault, libs, "g_resolver_get_default")
+	gobject.CheckClassSize("GResolverClass", xResolverGLibType, unsafe.Sizeof(ResolverClass{}))
+
+	core.PuregoRegisterSince(&xResolverGetTimeout, libs, "g_resolver_get_timeout", "GIO", "2.78")
+	core.PuregoRegisterSince(&xResolverLookupByAddress, libs, "g_resolver_lookup_by_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByAddressAsync, libs, "g_resolver_lookup_by_address_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByAddressFinish, libs, "g_resolver_lookup_by_address_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByName, libs, "g_resolver_lookup_by_name", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByNameAsync, libs, "g_resolver_lookup_by_name_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByNameFinish, libs, "g_resolver_lookup_by_name_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupByNameWithFlags, libs, "g_resolver_lookup_by_name_with_flags", "GIO", "2.60")
+	core.PuregoRegisterSince(&xResolverLookupByNameWithFlagsAsync, libs, "g_resolver_lookup_by_name_with_flags_async", "GIO", "2.60")
+	core.PuregoRegisterSince(&xResolverLookupByNameWithFlagsFinish, libs, "g_resolver_lookup_by_name_with_flags_finish", "GIO", "2.60")
+	core.PuregoRegisterSince(&xResolverLookupRecords, libs, "g_resolver_lookup_records", "GIO", "2.34")
+	core.PuregoRegisterSince(&xResolverLookupRecordsAsync, libs, "g_resolver_lookup_records_async", "GIO", "2.34")
+	core.PuregoRegisterSince(&xResolverLookupRecordsFinish, libs, "g_resolver_lookup_records_finish", "GIO", "2.34")
+	core.PuregoRegisterSince(&xResolverLookupService, libs, "g_resolver_lookup_service", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupServiceAsync, libs, "g_resolver_lookup_service_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverLookupServiceFinish, libs, "g_resolver_lookup_service_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverSetDefault, libs, "g_resolver_set_default", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverSetTimeout, libs, "g_resolver_set_timeout", "GIO", "2.78")
+
+	core.PuregoRegisterSince(&xResolverFreeAddresses, libs, "g_resolver_free_addresses", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverFreeTargets, libs, "g_resolver_free_targets", "GIO", "2.22")
+	core.PuregoRegisterSince(&xResolverGetDefault, libs, "g_resolver_get_default", "GIO", "2.22")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
@@ -32,11 +31,11 @@ func (x *StaticResource) GoPointer() uintptr {
 
 var xStaticResourceFini func(uintptr)
 
-// Finalizes a [struct@Gio.Resource] initialized by
-// [method@Gio.StaticResource.init].
+// Finalizes a [Resource] initialized by
+// [StaticResource.Init].
 //
 // This is normally used by code generated by
-// [`glib-compile-resources`](glib-compile-resources.html)
+// glib-compile-resources
 // and is not typically used by other code.
 func (x *StaticResource) Fini() {
 
@@ -46,11 +45,11 @@ func (x *StaticResource) Fini() {
 
 var xStaticResourceGetResource func(uintptr) *Resource
 
-// Gets the [struct@Gio.Resource] that was registered by a call to
-// [method@Gio.StaticResource.init].
+// Gets the [Resource] that was registered by a call to
+// [StaticResource.Init].
 //
 // This is normally used by code generated by
-// [`glib-compile-resources`](glib-compile-resources.html)
+// glib-compile-resources
 // and is not typically used by other code.
 func (x *StaticResource) GetResource() *Resource {
 
@@ -60,11 +59,11 @@ func (x *StaticResource) GetResource() *Resource {
 
 var xStaticResourceInit func(uintptr)
 
-// Initializes a [struct@Gio.Resource] from static data using a
-// [struct@Gio.StaticResource].
+// Initializes a [Resource] from static data using a
+// [StaticResource].
 //
 // This is normally used by code generated by
-// [`glib-compile-resources`](glib-compile-resources.html)
+// glib-compile-resources
 // and is not typically used by other code.
 func (x *StaticResource) Init() {
 
@@ -74,7 +73,7 @@ func (x *StaticResource) Init() {
 
 var xResourceErrorQuark func() glib.Quark
 
-// Gets the [struct@Gio.Resource] Error Quark.
+// Gets the [Resource] Error Quark.
 func ResourceErrorQuark() glib.Quark {
 
 	cret := xResourceErrorQuark()
@@ -83,59 +82,59 @@ func ResourceErrorQuark() glib.Quark {
 
 var xResourceLoad func(string, **glib.Error) *Resource
 
-// Loads a binary resource bundle and creates a [struct@Gio.Resource]
+// Loads a binary resource bundle and creates a [Resource]
 // representation of it, allowing you to query it for data.
 //
 // If you want to use this resource in the global resource namespace you need
-// to register it with [func@Gio.resources_register].
+// to register it with [ResourcesRegister].
 //
-// If @filename is empty or the data in it is corrupt,
-// %G_RESOURCE_ERROR_INTERNAL will be returned. If @filename doesn’t exist, or
-// there is an error in reading it, an error from [ctor@GLib.MappedFile.new]
+// If filename is empty or the data in it is corrupt,
+// [GResourceErrorInternalValue] will be returned. If filename doesn’t exist, or
+// there is an error in reading it, an error from [glib.NewMappedFile]
 // will be returned.
 func ResourceLoad(FilenameVar string) (*Resource, error) {
 	var cerr *glib.Error
 
 	cret := xResourceLoad(FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xResourcesEnumerateChildren func(string, ResourceLookupFlags, **glib.Error) []string
+var xResourcesEnumerateChildren func(string, ResourceLookupFlags, **glib.Error) uintptr
 
-// Returns all the names of children at the specified @path in the set of
+// Returns all the names of children at the specified path in the set of
 // globally registered resources.
 //
 // The return result is a `NULL` terminated list of strings which should
-// be released with [func@GLib.strfreev].
+// be released with [glib.Strfreev].
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 func ResourcesEnumerateChildren(PathVar string, LookupFlagsVar ResourceLookupFlags) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := xResourcesEnumerateChildren(PathVar, LookupFlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
 var xResourcesGetInfo func(string, ResourceLookupFlags, *uint, *uint32, **glib.Error) bool
 
-// Looks for a file at the specified @path in the set of
+// Looks for a file at the specified path in the set of
 // globally registered resources and if found returns information about it.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 func ResourcesGetInfo(PathVar string, LookupFlagsVar ResourceLookupFlags, SizeVar *uint, FlagsVar *uint32) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xResourcesGetInfo(PathVar, LookupFlagsVar, SizeVar, FlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -143,7 +142,7 @@ func ResourcesGetInfo(PathVar string, LookupFlagsVar ResourceLookupFlags, SizeVa
 
 var xResourcesHasChildren func(string) bool
 
-// Returns whether the specified @path in the set of
+// Returns whether the specified path in the set of
 // globally registered resources has children.
 func ResourcesHasChildren(PathVar string) bool {
 
@@ -153,38 +152,39 @@ func ResourcesHasChildren(PathVar string) bool {
 
 var xResourcesLookupData func(string, ResourceLookupFlags, **glib.Error) *glib.Bytes
 
-// Looks for a file at the specified @path in the set of
-// globally registered resources and returns a [struct@GLib.Bytes] that
+// Looks for a file at the specified path in the set of
+// globally registered resources and returns a [glib.Bytes] that
 // lets you directly access the data in memory.
 //
 // The data is always followed by a zero byte, so you
 // can safely use the data as a C string. However, that byte
-// is not included in the size of the [struct@GLib.Bytes].
+// is not included in the size of the [glib.Bytes].
 //
 // For uncompressed resource files this is a pointer directly into
 // the resource bundle, which is typically in some read-only data section
 // in the program binary. For compressed files we allocate memory on
 // the heap and automatically uncompress the data.
 //
-// @lookup_flags controls the behaviour of the lookup.
-func ResourcesLookupData(PathVar string, LookupFlagsVar ResourceLookupFlags) (*glib.Bytes, error) {
+// lookup_flags controls the behaviour of the lookup.
+func ResourcesLookupData(PathVar string, LookupFlagsVar ResourceLookupFlags) ([]byte, error) {
 	var cerr *glib.Error
 
 	cret := xResourcesLookupData(PathVar, LookupFlagsVar, &cerr)
+	defer cret.Unref()
 	if cerr == nil {
-		return cret, nil
+		return cret.Data(), core.PlatformError
 	}
-	return cret, cerr
+	return cret.Data(), cerr
 
 }
 
 var xResourcesOpenStream func(string, ResourceLookupFlags, **glib.Error) uintptr
 
-// Looks for a file at the specified @path in the set of
-// globally registered resources and returns a [class@Gio.InputStream]
+// Looks for a file at the specified path in the set of
+// globally registered resources and returns a [InputStream]
 // that lets you read the data.
 //
-// @lookup_flags controls the behaviour of the lookup.
+// lookup_flags controls the behaviour of the lookup.
 func ResourcesOpenStream(PathVar string, LookupFlagsVar ResourceLookupFlags) (*InputStream, error) {
 	var cls *InputStream
 	var cerr *glib.Error
@@ -192,12 +192,15 @@ func ResourcesOpenStream(PathVar string, LookupFlagsVar ResourceLookupFlags) (*I
 	cret := xResourcesOpenStream(PathVar, LookupFlagsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &InputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -209,7 +212,7 @@ var xResourcesRegister func(*Resource)
 //
 // Once a resource is registered the files in it can be accessed
 // with the global resource lookup functions like
-// [func@Gio.resources_lookup_data].
+// [ResourcesLookupData].
 func ResourcesRegister(ResourceVar *Resource) {
 
 	xResourcesRegister(ResourceVar)
@@ -230,25 +233,25 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xResourceErrorQuark, libs, "g_resource_error_quark")
-	core.PuregoSafeRegister(&xResourceLoad, libs, "g_resource_load")
-	core.PuregoSafeRegister(&xResourcesEnumerateChildren, libs, "g_resources_enumerate_children")
-	core.PuregoSafeRegister(&xResourcesGetInfo, libs, "g_resources_get_info")
-	core.PuregoSafeRegister(&xResourcesHasChildren, libs, "g_resources_has_children")
-	core.PuregoSafeRegister(&xResourcesLookupData, libs, "g_resources_lookup_data")
-	core.PuregoSafeRegister(&xResourcesOpenStream, libs, "g_resources_open_stream")
-	core.PuregoSafeRegister(&xResourcesRegister, libs, "g_resources_register")
-	core.PuregoSafeRegister(&xResourcesUnregister, libs, "g_resources_unregister")
-
-	core.PuregoSafeRegister(&xStaticResourceFini, libs, "g_static_resource_fini")
-	core.PuregoSafeRegister(&xStaticResourceGetResource, libs, "g_static_resource_get_resource")
-	core.PuregoSafeRegister(&xStaticResourceInit, libs, "g_static_resource_init")
+	core.PuregoRegisterSince(&xResourceErrorQuark, libs, "g_resource_error_quark", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourceLoad, libs, "g_resource_load", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesEnumerateChildren, libs, "g_resources_enumerate_children", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesGetInfo, libs, "g_resources_get_info", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesHasChildren, libs, "g_resources_has_children", "GIO", "2.84")
+	core.PuregoRegisterSince(&xResourcesLookupData, libs, "g_resources_lookup_data", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesOpenStream, libs, "g_resources_open_stream", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesRegister, libs, "g_resources_register", "GIO", "2.32")
+	core.PuregoRegisterSince(&xResourcesUnregister, libs, "g_resources_unregister", "GIO", "2.32")
+
+	core.PuregoRegisterSince(&xStaticResourceFini, libs, "g_static_resource_fini", "GIO", "2.32")
+	core.PuregoRegisterSince(&xStaticResourceGetResource, libs, "g_static_resource_get_resource", "GIO", "2.32")
+	core.PuregoRegisterSince(&xStaticResourceInit, libs, "g_static_resource_init", "GIO", "2.32")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type SeekableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xTell uintptr
 
@@ -38,7 +38,8 @@ func (x *SeekableIface) OverrideTell(cb func(Seekable) int64) {
 	if cb == nil {
 		x.xTell = 0
 	} else {
-		x.xTell = purego.NewCallback(func(SeekableVarp uintptr) int64 {
+		x.xTell = core.NewCallback(func(SeekableVarp uintptr) int64 {
+			defer core.RecoverPanic()
 			return cb(&SeekableBase{Ptr: SeekableVarp})
 		})
 	}
@@ -51,7 +52,7 @@ func (x *SeekableIface) GetTell() func(Seekable) int64 {
 		return nil
 	}
 	var rawCallback func(SeekableVarp uintptr) int64
-	purego.RegisterFunc(&rawCallback, x.xTell)
+	core.RegisterFunc(&rawCallback, x.xTell)
 	return func(SeekableVar Seekable) int64 {
 		return rawCallback(SeekableVar.GoPointer())
 	}
@@ -63,7 +64,8 @@ func (x *SeekableIface) OverrideCanSeek(cb func(Seekable) bool) {
 	if cb == nil {
 		x.xCanSeek = 0
 	} else {
-		x.xCanSeek = purego.NewCallback(func(SeekableVarp uintptr) bool {
+		x.xCanSeek = core.NewCallback(func(SeekableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SeekableBase{Ptr: SeekableVarp})
 		})
 	}
@@ -76,7 +78,7 @@ func (x *SeekableIface) GetCanSeek() func(Seekable) bool {
 		return nil
 	}
 	var rawCallback func(SeekableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanSeek)
+	core.RegisterFunc(&rawCallback, x.xCanSeek)
 	return func(SeekableVar Seekable) bool {
 		return rawCallback(SeekableVar.GoPointer())
 	}
@@ -88,7 +90,8 @@ func (x *SeekableIface) OverrideSeek(cb func(Seekable, int64, glib.SeekType, *Ca
 	if cb == nil {
 		x.xSeek = 0
 	} else {
-		x.xSeek = purego.NewCallback(func(SeekableVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+		x.xSeek = core.NewCallback(func(SeekableVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SeekableBase{Ptr: SeekableVarp}, OffsetVarp, TypeVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -101,7 +104,7 @@ func (x *SeekableIface) GetSeek() func(Seekable, int64, glib.SeekType, *Cancella
 		return nil
 	}
 	var rawCallback func(SeekableVarp uintptr, OffsetVarp int64, TypeVarp glib.SeekType, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSeek)
+	core.RegisterFunc(&rawCallback, x.xSeek)
 	return func(SeekableVar Seekable, OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) bool {
 		return rawCallback(SeekableVar.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer())
 	}
@@ -113,7 +116,8 @@ func (x *SeekableIface) OverrideCanTruncate(cb func(Seekable) bool) {
 	if cb == nil {
 		x.xCanTruncate = 0
 	} else {
-		x.xCanTruncate = purego.NewCallback(func(SeekableVarp uintptr) bool {
+		x.xCanTruncate = core.NewCallback(func(SeekableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SeekableBase{Ptr: SeekableVarp})
 		})
 	}
@@ -126,7 +130,7 @@ func (x *SeekableIface) GetCanTruncate() func(Seekable) bool {
 		return nil
 	}
 	var rawCallback func(SeekableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanTruncate)
+	core.RegisterFunc(&rawCallback, x.xCanTruncate)
 	return func(SeekableVar Seekable) bool {
 		return rawCallback(SeekableVar.GoPointer())
 	}
@@ -138,7 +142,8 @@ func (x *SeekableIface) OverrideTruncateFn(cb func(Seekable, int64, *Cancellable
 	if cb == nil {
 		x.xTruncateFn = 0
 	} else {
-		x.xTruncateFn = purego.NewCallback(func(SeekableVarp uintptr, OffsetVarp int64, CancellableVarp uintptr) bool {
+		x.xTruncateFn = core.NewCallback(func(SeekableVarp uintptr, OffsetVarp int64, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SeekableBase{Ptr: SeekableVarp}, OffsetVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -151,25 +156,25 @@ func (x *SeekableIface) GetTruncateFn() func(Seekable, int64, *Cancellable) bool
 		return nil
 	}
 	var rawCallback func(SeekableVarp uintptr, OffsetVarp int64, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xTruncateFn)
+	core.RegisterFunc(&rawCallback, x.xTruncateFn)
 	return func(SeekableVar Seekable, OffsetVar int64, CancellableVar *Cancellable) bool {
 		return rawCallback(SeekableVar.GoPointer(), OffsetVar, CancellableVar.GoPointer())
 	}
 }
 
 // `GSeekable` is implemented by streams (implementations of
-// [class@Gio.InputStream] or [class@Gio.OutputStream]) that support seeking.
+// [InputStream] or [OutputStream]) that support seeking.
 //
 // Seekable streams largely fall into two categories: resizable and
 // fixed-size.
 //
 // `GSeekable` on fixed-sized streams is approximately the same as POSIX
-// [`lseek()`](man:lseek(2)) on a block device (for example: attempting to seek
+// lseek()) on a block device (for example: attempting to seek
 // past the end of the device is an error).  Fixed streams typically cannot be
 // truncated.
 //
 // `GSeekable` on resizable streams is approximately the same as POSIX
-// [`lseek()`](man:lseek(2)) on a normal file.  Seeking past the end and writing
+// lseek()) on a normal file.  Seeking past the end and writing
 // data will usually cause the stream to resize by introducing zero bytes.
 type Seekable interface {
 	GoPointer() uintptr
@@ -202,7 +207,63 @@ func (x *SeekableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Tests if the stream supports the #GSeekableIface.
+// ImplementSeekable adds the Seekable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Tell() int64
+//   - CanSeek() bool
+//   - Seek(int64, glib.SeekType, *Cancellable) bool
+//   - CanTruncate() bool
+//   - TruncateFn(int64, *Cancellable) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SeekableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSeekable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SeekableGLibType(), func(iface *SeekableIface) {
+		if _, ok := any(zero).(interface{ Tell() int64 }); ok {
+			iface.OverrideTell(func(self Seekable) int64 {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Tell() int64 })
+				return impl.Tell()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanSeek() bool }); ok {
+			iface.OverrideCanSeek(func(self Seekable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanSeek() bool })
+				return impl.CanSeek()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Seek(int64, glib.SeekType, *Cancellable) bool
+		}); ok {
+			iface.OverrideSeek(func(self Seekable, arg0 int64, arg1 glib.SeekType, arg2 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Seek(int64, glib.SeekType, *Cancellable) bool
+				})
+				return impl.Seek(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ CanTruncate() bool }); ok {
+			iface.OverrideCanTruncate(func(self Seekable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanTruncate() bool })
+				return impl.CanTruncate()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			TruncateFn(int64, *Cancellable) bool
+		}); ok {
+			iface.OverrideTruncateFn(func(self Seekable, arg0 int64, arg1 *Cancellable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					TruncateFn(int64, *Cancellable) bool
+				})
+				return impl.TruncateFn(arg0, arg1)
+			})
+		}
+	})
+}
+
+// Tests if the stream supports the [SeekableIface].
 func (x *SeekableBase) CanSeek() bool {
 
 	cret := XGSeekableCanSeek(x.GoPointer())
@@ -210,14 +271,14 @@ func (x *SeekableBase) CanSeek() bool {
 }
 
 // Tests if the length of the stream can be adjusted with
-// g_seekable_truncate().
+// [Seekable.Truncate].
 func (x *SeekableBase) CanTruncate() bool {
 
 	cret := XGSeekableCanTruncate(x.GoPointer())
 	return cret
 }
 
-// Seeks in the stream by the given @offset, modified by @type.
+// Seeks in the stream by the given offset, modified by type.
 //
 // Attempting to seek past the end of the stream will have different
 // results depending on if the stream is fixed-sized or resizable.  If
@@ -228,15 +289,15 @@ func (x *SeekableBase) CanTruncate() bool {
 //
 // Any operation that would result in a negative offset will fail.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *SeekableBase) Seek(OffsetVar int64, TypeVar glib.SeekType, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGSeekableSeek(x.GoPointer(), OffsetVar, TypeVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -249,13 +310,13 @@ func (x *SeekableBase) Tell() int64 {
 	return cret
 }
 
-// Sets the length of the stream to @offset. If the stream was previously
-// larger than @offset, the extra data is discarded. If the stream was
-// previously shorter than @offset, it is extended with NUL ('\0') bytes.
+// Sets the length of the stream to offset. If the stream was previously
+// larger than offset, the extra data is discarded. If the stream was
+// previously shorter than offset, it is extended with NUL ('\0') bytes.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned. If an
+// was cancelled, the error [GIoErrorCancelledValue] will be returned. If an
 // operation was partially finished when the operation was cancelled the
 // partial result will be returned, without an error.
 func (x *SeekableBase) Truncate(OffsetVar int64, CancellableVar *Cancellable) (bool, error) {
@@ -263,7 +324,7 @@ func (x *SeekableBase) Truncate(OffsetVar int64, CancellableVar *Cancellable) (b
 
 	cret := XGSeekableTruncate(x.GoPointer(), OffsetVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -280,7 +341,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -289,10 +350,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSeekableGLibType, libs, "g_seekable_get_type")
 
-	core.PuregoSafeRegister(&XGSeekableCanSeek, libs, "g_seekable_can_seek")
-	core.PuregoSafeRegister(&XGSeekableCanTruncate, libs, "g_seekable_can_truncate")
-	core.PuregoSafeRegister(&XGSeekableSeek, libs, "g_seekable_seek")
-	core.PuregoSafeRegister(&XGSeekableTell, libs, "g_seekable_tell")
-	core.PuregoSafeRegister(&XGSeekableTruncate, libs, "g_seekable_truncate")
+	gobject.CheckClassSize("GSeekableIface", xSeekableGLibType, unsafe.Sizeof(SeekableIface{}))
+
+	core.PuregoRegisterSince(&XGSeekableCanSeek, libs, "g_seekable_can_seek", "GIO", "")
+	core.PuregoRegisterSince(&XGSeekableCanTruncate, libs, "g_seekable_can_truncate", "GIO", "")
+	core.PuregoRegisterSince(&XGSeekableSeek, libs, "g_seekable_seek", "GIO", "")
+	core.PuregoRegisterSince(&XGSeekableTell, libs, "g_seekable_tell", "GIO", "")
+	core.PuregoRegisterSince(&XGSeekableTruncate, libs, "g_seekable_truncate", "GIO", "")
 
 }
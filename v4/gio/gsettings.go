@@ -3,34 +3,34 @@ package gio
 
 import (
 	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type for the function that is used to convert from [class@Gio.Settings]
+// The type for the function that is used to convert from [Settings]
 // to an object property.
 //
-// The @value is already initialized to hold values of the appropriate type.
+// The value is already initialized to hold values of the appropriate type.
 type SettingsBindGetMapping func(*gobject.Value, *glib.Variant, uintptr) bool
 
 // The type for the function that is used to convert an object property
-// value to a [struct@GLib.Variant] for storing it in [class@Gio.Settings].
+// value to a [glib.Variant] for storing it in [Settings].
 type SettingsBindSetMapping func(*gobject.Value, *glib.VariantType, uintptr) *glib.Variant
 
 // The type of the function that is used to convert from a value stored
-// in a [class@Gio.Settings] to a value that is useful to the application.
+// in a [Settings] to a value that is useful to the application.
 //
 // If the value is successfully mapped, the result should be stored at
-// @result and true returned.  If mapping fails (for example, if @value
+// result and true returned.  If mapping fails (for example, if value
 // is not in the right format) then false should be returned.
 //
-// If @value is `NULL` then it means that the mapping function is being
+// If value is `NULL` then it means that the mapping function is being
 // given a ‘last chance’ to successfully return a valid value.  True
 // must be returned in this case.
 type SettingsGetMapping func(*glib.Variant, *uintptr, uintptr) bool
@@ -60,7 +60,8 @@ func (x *SettingsClass) OverrideWritableChanged(cb func(*Settings, string)) {
 	if cb == nil {
 		x.xWritableChanged = 0
 	} else {
-		x.xWritableChanged = purego.NewCallback(func(SettingsVarp uintptr, KeyVarp string) {
+		x.xWritableChanged = core.NewCallback(func(SettingsVarp uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
 			cb(SettingsNewFromInternalPtr(SettingsVarp), KeyVarp)
 		})
 	}
@@ -72,7 +73,7 @@ func (x *SettingsClass) GetWritableChanged() func(*Settings, string) {
 		return nil
 	}
 	var rawCallback func(SettingsVarp uintptr, KeyVarp string)
-	purego.RegisterFunc(&rawCallback, x.xWritableChanged)
+	core.RegisterFunc(&rawCallback, x.xWritableChanged)
 	return func(SettingsVar *Settings, KeyVar string) {
 		rawCallback(SettingsVar.GoPointer(), KeyVar)
 	}
@@ -83,7 +84,8 @@ func (x *SettingsClass) OverrideChanged(cb func(*Settings, string)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(SettingsVarp uintptr, KeyVarp string) {
+		x.xChanged = core.NewCallback(func(SettingsVarp uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
 			cb(SettingsNewFromInternalPtr(SettingsVarp), KeyVarp)
 		})
 	}
@@ -95,7 +97,7 @@ func (x *SettingsClass) GetChanged() func(*Settings, string) {
 		return nil
 	}
 	var rawCallback func(SettingsVarp uintptr, KeyVarp string)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(SettingsVar *Settings, KeyVar string) {
 		rawCallback(SettingsVar.GoPointer(), KeyVar)
 	}
@@ -106,7 +108,8 @@ func (x *SettingsClass) OverrideWritableChangeEvent(cb func(*Settings, glib.Quar
 	if cb == nil {
 		x.xWritableChangeEvent = 0
 	} else {
-		x.xWritableChangeEvent = purego.NewCallback(func(SettingsVarp uintptr, KeyVarp glib.Quark) bool {
+		x.xWritableChangeEvent = core.NewCallback(func(SettingsVarp uintptr, KeyVarp glib.Quark) bool {
+			defer core.RecoverPanic()
 			return cb(SettingsNewFromInternalPtr(SettingsVarp), KeyVarp)
 		})
 	}
@@ -118,7 +121,7 @@ func (x *SettingsClass) GetWritableChangeEvent() func(*Settings, glib.Quark) boo
 		return nil
 	}
 	var rawCallback func(SettingsVarp uintptr, KeyVarp glib.Quark) bool
-	purego.RegisterFunc(&rawCallback, x.xWritableChangeEvent)
+	core.RegisterFunc(&rawCallback, x.xWritableChangeEvent)
 	return func(SettingsVar *Settings, KeyVar glib.Quark) bool {
 		return rawCallback(SettingsVar.GoPointer(), KeyVar)
 	}
@@ -129,7 +132,8 @@ func (x *SettingsClass) OverrideChangeEvent(cb func(*Settings, *glib.Quark, int)
 	if cb == nil {
 		x.xChangeEvent = 0
 	} else {
-		x.xChangeEvent = purego.NewCallback(func(SettingsVarp uintptr, KeysVarp *glib.Quark, NKeysVarp int) bool {
+		x.xChangeEvent = core.NewCallback(func(SettingsVarp uintptr, KeysVarp *glib.Quark, NKeysVarp int) bool {
+			defer core.RecoverPanic()
 			return cb(SettingsNewFromInternalPtr(SettingsVarp), KeysVarp, NKeysVarp)
 		})
 	}
@@ -141,7 +145,7 @@ func (x *SettingsClass) GetChangeEvent() func(*Settings, *glib.Quark, int) bool
 		return nil
 	}
 	var rawCallback func(SettingsVarp uintptr, KeysVarp *glib.Quark, NKeysVarp int) bool
-	purego.RegisterFunc(&rawCallback, x.xChangeEvent)
+	core.RegisterFunc(&rawCallback, x.xChangeEvent)
 	return func(SettingsVar *Settings, KeysVar *glib.Quark, NKeysVar int) bool {
 		return rawCallback(SettingsVar.GoPointer(), KeysVar, NKeysVar)
 	}
@@ -159,7 +163,7 @@ func (x *SettingsPrivate) GoPointer() uintptr {
 //
 // These flags determine in which direction the binding works. The default is to
 // synchronize in both directions.
-type SettingsBindFlags int
+type SettingsBindFlags uint32
 
 var xSettingsBindFlagsGLibType func() types.GType
 
@@ -171,32 +175,85 @@ const (
 
 	// Equivalent to `G_SETTINGS_BIND_GET|G_SETTINGS_BIND_SET`
 	GSettingsBindDefaultValue SettingsBindFlags = 0
-	// Update the [class@GObject.Object] property when the setting changes.
+	// Update the [gobject.Object] property when the setting changes.
 	//   It is an error to use this flag if the property is not writable.
 	GSettingsBindGetValue SettingsBindFlags = 1
-	// Update the setting when the [class@GObject.Object] property changes.
+	// Update the setting when the [gobject.Object] property changes.
 	//   It is an error to use this flag if the property is not readable.
 	GSettingsBindSetValue SettingsBindFlags = 2
 	// Do not try to bind a ‘sensitivity’ property to the writability of the setting
 	GSettingsBindNoSensitivityValue SettingsBindFlags = 4
-	// When set in addition to [flags@Gio.SettingsBindFlags.GET],
-	//   set the [class@GObject.Object] property
+	// When set in addition to SettingsBindFlags.GET,
+	//   set the [gobject.Object] property
 	//   value initially from the setting, but do not listen for changes of the setting
 	GSettingsBindGetNoChangesValue SettingsBindFlags = 8
-	// When passed to [method@Gio.Settings.bind],
+	// When passed to [Settings.Bind],
 	//   uses a pair of mapping functions that invert
 	//   the boolean value when mapping between the setting and the property.  The setting and property must both
-	//   be booleans.  You cannot pass this flag to [method@Gio.Settings.bind_with_mapping].
+	//   be booleans.  You cannot pass this flag to [Settings.BindWithMapping].
 	GSettingsBindInvertBooleanValue SettingsBindFlags = 16
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SettingsBindFlags) GLibType() types.GType {
+	return xSettingsBindFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SettingsBindFlags) Has(flags SettingsBindFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SettingsBindFlags) Set(flags SettingsBindFlags) SettingsBindFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SettingsBindFlags) Clear(flags SettingsBindFlags) SettingsBindFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SettingsBindFlags) String() string {
+	if x == 0 {
+		return "G_SETTINGS_BIND_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GSettingsBindGetValue != 0 && x&GSettingsBindGetValue == GSettingsBindGetValue {
+		names = append(names, "G_SETTINGS_BIND_GET")
+		rest &^= GSettingsBindGetValue
+	}
+	if rest&GSettingsBindSetValue != 0 && x&GSettingsBindSetValue == GSettingsBindSetValue {
+		names = append(names, "G_SETTINGS_BIND_SET")
+		rest &^= GSettingsBindSetValue
+	}
+	if rest&GSettingsBindNoSensitivityValue != 0 && x&GSettingsBindNoSensitivityValue == GSettingsBindNoSensitivityValue {
+		names = append(names, "G_SETTINGS_BIND_NO_SENSITIVITY")
+		rest &^= GSettingsBindNoSensitivityValue
+	}
+	if rest&GSettingsBindGetNoChangesValue != 0 && x&GSettingsBindGetNoChangesValue == GSettingsBindGetNoChangesValue {
+		names = append(names, "G_SETTINGS_BIND_GET_NO_CHANGES")
+		rest &^= GSettingsBindGetNoChangesValue
+	}
+	if rest&GSettingsBindInvertBooleanValue != 0 && x&GSettingsBindInvertBooleanValue == GSettingsBindInvertBooleanValue {
+		names = append(names, "G_SETTINGS_BIND_INVERT_BOOLEAN")
+		rest &^= GSettingsBindInvertBooleanValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The `GSettings` class provides a convenient API for storing and retrieving
 // application settings.
 //
 // Reads and writes can be considered to be non-blocking.  Reading
 // settings with `GSettings` is typically extremely fast: on
 // approximately the same order of magnitude (but slower than) a
-// [struct@GLib.HashTable] lookup.  Writing settings is also extremely fast in
+// [glib.HashTable] lookup.  Writing settings is also extremely fast in
 // terms of time to return to your application, but can be extremely expensive
 // for other threads and other processes.  Many settings backends
 // (including dconf) have lazy initialisation which means in the common
@@ -206,7 +263,7 @@ const (
 // only ever modify `GSettings` keys in response to explicit user action.
 // Particular care should be paid to ensure that modifications are not
 // made during startup — for example, when setting the initial value
-// of preferences widgets.  The built-in [method@Gio.Settings.bind]
+// of preferences widgets.  The built-in [Settings.Bind]
 // functionality is careful not to write settings in response to notify signals
 // as a result of modifications that it makes to widgets.
 //
@@ -216,7 +273,7 @@ const (
 //
 // Normally, a schema has a fixed path that determines where the settings
 // are stored in the conceptual global tree of settings. However, schemas
-// can also be ‘[relocatable](#relocatable-schemas)’, i.e. not equipped with
+// can also be ‘relocatable’, i.e. not equipped with
 // a fixed path. This is
 // useful e.g. when the schema describes an ‘account’, and you want to be
 // able to store a arbitrary number of accounts.
@@ -231,7 +288,7 @@ const (
 //
 // Unlike other configuration systems (like GConf), GSettings does not
 // restrict keys to basic types like strings and numbers. GSettings stores
-// values as [struct@GLib.Variant], and allows any [type@GLib.VariantType] for
+// values as [glib.Variant], and allows any [glib.VariantType] for
 // keys. Key names are restricted to lowercase characters, numbers and `-`.
 // Furthermore, the names must begin with a lowercase character, must not end
 // with a `-`, and must not contain consecutive dashes.
@@ -239,48 +296,45 @@ const (
 // Similar to GConf, the default values in GSettings schemas can be
 // localized, but the localized values are stored in gettext catalogs
 // and looked up with the domain that is specified in the
-// `gettext-domain` attribute of the `&lt;schemalist&gt;` or `&lt;schema&gt;`
+// `gettext-domain` attribute of the `<schemalist>` or `<schema>`
 // elements and the category that is specified in the `l10n` attribute of
-// the `&lt;default&gt;` element. The string which is translated includes all text in
-// the `&lt;default&gt;` element, including any surrounding quotation marks.
+// the `<default>` element. The string which is translated includes all text in
+// the `<default>` element, including any surrounding quotation marks.
 //
 // The `l10n` attribute must be set to `messages` or `time`, and sets the
 // [locale category for
 // translation](https://www.gnu.org/software/gettext/manual/html_node/Aspects.html#index-locale-categories-1).
 // The `messages` category should be used by default; use `time` for
 // translatable date or time formats. A translation comment can be added as an
-// XML comment immediately above the `&lt;default&gt;` element — it is recommended to
+// XML comment immediately above the `<default>` element — it is recommended to
 // add these comments to aid translators understand the meaning and
 // implications of the default value. An optional translation `context`
-// attribute can be set on the `&lt;default&gt;` element to disambiguate multiple
+// attribute can be set on the `<default>` element to disambiguate multiple
 // defaults which use the same string.
 //
 // For example:
-// ```xml
 //
-//	&lt;!-- Translators: A list of words which are not allowed to be typed, in
+//	<!-- Translators: A list of words which are not allowed to be typed, in
 //	     GVariant serialization syntax.
-//	     See: https://developer.gnome.org/glib/stable/gvariant-text.html --&gt;
-//	&lt;default l10n='messages' context='Banned words'&gt;['bad', 'words']&lt;/default&gt;
-//
-// ```
+//	     See: https://developer.gnome.org/glib/stable/gvariant-text.html -->
+//	<default l10n='messages' context='Banned words'>['bad', 'words']</default>
 //
 // Translations of default values must remain syntactically valid serialized
-// [struct@GLib.Variant]s (e.g. retaining any surrounding quotation marks) or
+// [glib.Variant]s (e.g. retaining any surrounding quotation marks) or
 // runtime errors will occur.
 //
 // GSettings uses schemas in a compact binary form that is created
-// by the [`glib-compile-schemas`](glib-compile-schemas.html)
+// by the glib-compile-schemas
 // utility. The input is a schema description in an XML format.
 //
 // A DTD for the gschema XML format can be found here:
-// [gschema.dtd](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/gschema.dtd)
+// [gschema.dtd]
 //
-// The [`glib-compile-schemas`](glib-compile-schemas.html) tool expects schema
+// The glib-compile-schemas tool expects schema
 // files to have the extension `.gschema.xml`.
 //
 // At runtime, schemas are identified by their ID (as specified in the
-// `id` attribute of the `&lt;schema&gt;` element). The convention for schema
+// `id` attribute of the `<schema>` element). The convention for schema
 // IDs is to use a dotted name, similar in style to a D-Bus bus name,
 // e.g. `org.gnome.SessionManager`. In particular, if the settings are
 // for a specific service that owns a D-Bus bus name, the D-Bus bus name
@@ -288,142 +342,136 @@ const (
 // associated with one named application, the ID should not use
 // StudlyCaps, e.g. `org.gnome.font-rendering`.
 //
-// In addition to [struct@GLib.Variant] types, keys can have types that have
-// enumerated types. These can be described by a `&lt;choice&gt;`,
-// `&lt;enum&gt;` or `&lt;flags&gt;` element, as seen in the
+// In addition to [glib.Variant] types, keys can have types that have
+// enumerated types. These can be described by a `<choice>`,
+// `<enum>` or `<flags>` element, as seen in the
 // second example below. The underlying type of such a key
-// is string, but you can use [method@Gio.Settings.get_enum],
-// [method@Gio.Settings.set_enum], [method@Gio.Settings.get_flags],
-// [method@Gio.Settings.set_flags] access the numeric values corresponding to
+// is string, but you can use [Settings.GetEnum],
+// [Settings.SetEnum], [Settings.GetFlags],
+// [Settings.SetFlags] access the numeric values corresponding to
 // the string value of enum and flags keys.
 //
 // An example for default value:
-// ```xml
-// &lt;schemalist&gt;
-//
-//	&lt;schema id="org.gtk.Test" path="/org/gtk/Test/" gettext-domain="test"&gt;
 //
-//	  &lt;key name="greeting" type="s"&gt;
-//	    &lt;default l10n="messages"&gt;"Hello, earthlings"&lt;/default&gt;
-//	    &lt;summary&gt;A greeting&lt;/summary&gt;
-//	    &lt;description&gt;
-//	      Greeting of the invading martians
-//	    &lt;/description&gt;
-//	  &lt;/key&gt;
+//	<schemalist>
+//	  <schema id="org.gtk.Test" path="/org/gtk/Test/" gettext-domain="test">
 //
-//	  &lt;key name="box" type="(ii)"&gt;
-//	    &lt;default&gt;(20,30)&lt;/default&gt;
-//	  &lt;/key&gt;
+//	    <key name="greeting" type="s">
+//	      <default l10n="messages">"Hello, earthlings"</default>
+//	      <summary>A greeting</summary>
+//	      <description>
+//	        Greeting of the invading martians
+//	      </description>
+//	    </key>
 //
-//	  &lt;key name="empty-string" type="s"&gt;
-//	    &lt;default&gt;""&lt;/default&gt;
-//	    &lt;summary&gt;Empty strings have to be provided in GVariant form&lt;/summary&gt;
-//	  &lt;/key&gt;
+//	    <key name="box" type="(ii)">
+//	      <default>(20,30)</default>
+//	    </key>
 //
-//	&lt;/schema&gt;
+//	    <key name="empty-string" type="s">
+//	      <default>""</default>
+//	      <summary>Empty strings have to be provided in GVariant form</summary>
+//	    </key>
 //
-// &lt;/schemalist&gt;
-// ```
+//	  </schema>
+//	</schemalist>
 //
 // An example for ranges, choices and enumerated types:
-// ```xml
-// &lt;schemalist&gt;
-//
-//	&lt;enum id="org.gtk.Test.myenum"&gt;
-//	  &lt;value nick="first" value="1"/&gt;
-//	  &lt;value nick="second" value="2"/&gt;
-//	&lt;/enum&gt;
-//
-//	&lt;flags id="org.gtk.Test.myflags"&gt;
-//	  &lt;value nick="flag1" value="1"/&gt;
-//	  &lt;value nick="flag2" value="2"/&gt;
-//	  &lt;value nick="flag3" value="4"/&gt;
-//	&lt;/flags&gt;
-//
-//	&lt;schema id="org.gtk.Test"&gt;
-//
-//	  &lt;key name="key-with-range" type="i"&gt;
-//	    &lt;range min="1" max="100"/&gt;
-//	    &lt;default&gt;10&lt;/default&gt;
-//	  &lt;/key&gt;
-//
-//	  &lt;key name="key-with-choices" type="s"&gt;
-//	    &lt;choices&gt;
-//	      &lt;choice value='Elisabeth'/&gt;
-//	      &lt;choice value='Annabeth'/&gt;
-//	      &lt;choice value='Joe'/&gt;
-//	    &lt;/choices&gt;
-//	    &lt;aliases&gt;
-//	      &lt;alias value='Anna' target='Annabeth'/&gt;
-//	      &lt;alias value='Beth' target='Elisabeth'/&gt;
-//	    &lt;/aliases&gt;
-//	    &lt;default&gt;'Joe'&lt;/default&gt;
-//	  &lt;/key&gt;
-//
-//	  &lt;key name='enumerated-key' enum='org.gtk.Test.myenum'&gt;
-//	    &lt;default&gt;'first'&lt;/default&gt;
-//	  &lt;/key&gt;
-//
-//	  &lt;key name='flags-key' flags='org.gtk.Test.myflags'&gt;
-//	    &lt;default&gt;["flag1","flag2"]&lt;/default&gt;
-//	  &lt;/key&gt;
-//	&lt;/schema&gt;
-//
-// &lt;/schemalist&gt;
-// ```
-//
-// ## Vendor overrides
+//
+//	<schemalist>
+//
+//	  <enum id="org.gtk.Test.myenum">
+//	    <value nick="first" value="1"/>
+//	    <value nick="second" value="2"/>
+//	  </enum>
+//
+//	  <flags id="org.gtk.Test.myflags">
+//	    <value nick="flag1" value="1"/>
+//	    <value nick="flag2" value="2"/>
+//	    <value nick="flag3" value="4"/>
+//	  </flags>
+//
+//	  <schema id="org.gtk.Test">
+//
+//	    <key name="key-with-range" type="i">
+//	      <range min="1" max="100"/>
+//	      <default>10</default>
+//	    </key>
+//
+//	    <key name="key-with-choices" type="s">
+//	      <choices>
+//	        <choice value='Elisabeth'/>
+//	        <choice value='Annabeth'/>
+//	        <choice value='Joe'/>
+//	      </choices>
+//	      <aliases>
+//	        <alias value='Anna' target='Annabeth'/>
+//	        <alias value='Beth' target='Elisabeth'/>
+//	      </aliases>
+//	      <default>'Joe'</default>
+//	    </key>
+//
+//	    <key name='enumerated-key' enum='org.gtk.Test.myenum'>
+//	      <default>'first'</default>
+//	    </key>
+//
+//	    <key name='flags-key' flags='org.gtk.Test.myflags'>
+//	      <default>["flag1","flag2"]</default>
+//	    </key>
+//	  </schema>
+//	</schemalist>
+//
+// # Vendor overrides
 //
 // Default values are defined in the schemas that get installed by
 // an application. Sometimes, it is necessary for a vendor or distributor
 // to adjust these defaults. Since patching the XML source for the schema
 // is inconvenient and error-prone,
-// [`glib-compile-schemas`](glib-compile-schemas.html) reads so-called ‘vendor
+// glib-compile-schemas reads so-called ‘vendor
 // override’ files. These are keyfiles in the same directory as the XML
 // schema sources which can override default values. The schema ID serves
 // as the group name in the key file, and the values are expected in
-// serialized [struct@GLib.Variant] form, as in the following example:
-// ```
-// [org.gtk.Example]
-// key1='string'
-// key2=1.5
-// ```
+// serialized [glib.Variant] form, as in the following example:
+//
+//	[org.gtk.Example]
+//	key1='string'
+//	key2=1.5
 //
 // `glib-compile-schemas` expects schema files to have the extension
 // `.gschema.override`.
 //
-// ## Delay-apply mode
+// # Delay-apply mode
 //
-// By default, values set on a [class@Gio.Settings] instance immediately start
+// By default, values set on a [Settings] instance immediately start
 // to be written to the backend (although these writes may not complete by the
-// time that [method@Gio.Settings.set]) returns; see [func@Gio.Settings.sync]).
+// time that [Settings.Set]) returns; see [SettingsSync]).
 //
 // In order to allow groups of settings to be changed simultaneously and
 // atomically, GSettings also supports a ‘delay-apply’ mode. In this mode,
-// updated values are kept locally in the [class@Gio.Settings] instance until
-// they are explicitly applied by calling [method@Gio.Settings.apply].
+// updated values are kept locally in the [Settings] instance until
+// they are explicitly applied by calling [Settings.Apply].
 //
 // For example, this could be useful for a preferences dialog where the
 // preferences all need to be applied simultaneously when the user clicks ‘Save’.
 //
-// Switching a [class@Gio.Settings] instance to ‘delay-apply’ mode is a one-time
+// Switching a [Settings] instance to ‘delay-apply’ mode is a one-time
 // irreversible operation: from that point onwards, *all* changes made to that
-// [class@Gio.Settings] have to be explicitly applied by calling
-// [method@Gio.Settings.apply]. The ‘delay-apply’ mode is also propagated to any
+// [Settings] have to be explicitly applied by calling
+// [Settings.Apply]. The ‘delay-apply’ mode is also propagated to any
 // child settings objects subsequently created using
-// [method@Gio.Settings.get_child].
+// [Settings.GetChild].
 //
 // At any point, the set of unapplied changes can be queried using
-// [property@Gio.Settings:has-unapplied], and discarded by calling
-// [method@Gio.Settings.revert].
+// [Settings.GetPropertyHasUnapplied], and discarded by calling
+// [Settings.Revert].
 //
-// ## Binding
+// # Binding
 //
-// A very convenient feature of GSettings lets you bind [class@GObject.Object]
-// properties directly to settings, using [method@Gio.Settings.bind]. Once a
-// [class@GObject.Object] property has been bound to a setting, changes on
+// A very convenient feature of GSettings lets you bind [gobject.Object]
+// properties directly to settings, using [Settings.Bind]. Once a
+// [gobject.Object] property has been bound to a setting, changes on
 // either side are automatically propagated to the other side. GSettings handles
-// details like mapping between [class@GObject.Object] and [struct@GLib.Variant]
+// details like mapping between [gobject.Object] and [glib.Variant]
 // types, and preventing infinite cycles.
 //
 // This makes it very easy to hook up a preferences dialog to the
@@ -433,12 +481,12 @@ const (
 // If this ‘magic’ gets in the way, it can be suppressed with the
 // `G_SETTINGS_BIND_NO_SENSITIVITY` flag.
 //
-// ## Relocatable schemas
+// # Relocatable schemas
 //
 // A relocatable schema is one with no `path` attribute specified on its
-// `&lt;schema&gt;` element. By using [ctor@Gio.Settings.new_with_path], a `GSettings`
+// `<schema>` element. By using [NewSettingsWithPath], a `GSettings`
 // object can be instantiated for a relocatable schema, assigning a path to the
-// instance. Paths passed to [ctor@Gio.Settings.new_with_path] will typically be
+// instance. Paths passed to [NewSettingsWithPath] will typically be
 // constructed dynamically from a constant prefix plus some form of instance
 // identifier; but they must still be valid GSettings paths. Paths could also
 // be constant and used with a globally installed schema originating from a
@@ -449,114 +497,90 @@ const (
 // `org.foo.MyApp.Window`, it could be instantiated for paths
 // `/org/foo/MyApp/main/`, `/org/foo/MyApp/document-1/`,
 // `/org/foo/MyApp/document-2/`, etc. If any of the paths are well-known
-// they can be specified as `&lt;child&gt;` elements in the parent schema, e.g.:
-// ```xml
-// &lt;schema id="org.foo.MyApp" path="/org/foo/MyApp/"&gt;
+// they can be specified as `<child>` elements in the parent schema, e.g.:
 //
-//	&lt;child name="main" schema="org.foo.MyApp.Window"/&gt;
+//	<schema id="org.foo.MyApp" path="/org/foo/MyApp/">
+//	  <child name="main" schema="org.foo.MyApp.Window"/>
+//	</schema>
 //
-// &lt;/schema&gt;
-// ```
+// # Build system integration
 //
-// ## Build system integration
+// # Meson
 //
-// ### Meson
-//
-// GSettings is natively supported by Meson’s [GNOME module](https://mesonbuild.com/Gnome-module.html).
+// GSettings is natively supported by Meson’s [GNOME module].
 //
 // You can install the schemas as any other data file:
 //
-// ```
-// install_data(
-//
-//	'org.foo.MyApp.gschema.xml',
-//	install_dir: get_option('datadir') / 'glib-2.0/schemas',
-//
-// )
-// ```
+//	install_data(
+//	  'org.foo.MyApp.gschema.xml',
+//	  install_dir: get_option('datadir') / 'glib-2.0/schemas',
+//	)
 //
 // You can use `gnome.post_install()` function to compile the schemas on
 // installation:
 //
-// ```
-// gnome = import('gnome')
-// gnome.post_install(
-//
-//	glib_compile_schemas: true,
-//
-// )
-// ```
+//	gnome = import('gnome')
+//	gnome.post_install(
+//	  glib_compile_schemas: true,
+//	)
 //
 // If an enumerated type defined in a C header file is to be used in a GSettings
-// schema, it can either be defined manually using an `&lt;enum&gt;` element in the
+// schema, it can either be defined manually using an `<enum>` element in the
 // schema XML, or it can be extracted automatically from the C header. This
 // approach is preferred, as it ensures the two representations are always
 // synchronised. To do so, you will need to use the `gnome.mkenums()` function
 // with the following templates:
 //
-// ```
-// schemas_enums = gnome.mkenums('org.foo.MyApp.enums.xml',
-//
-//	comments: '&lt;!-- @comment@ --&gt;',
-//	fhead: '&lt;schemalist&gt;',
-//	vhead: '  &lt;@type@ id="org.foo.MyApp.@EnumName@"&gt;',
-//	vprod: '    &lt;value nick="@valuenick@" value="@valuenum@"/&gt;',
-//	vtail: '  &lt;/@type@&gt;',
-//	ftail: '&lt;/schemalist&gt;',
-//	sources: enum_sources,
-//	install_header: true,
-//	install_dir: get_option('datadir') / 'glib-2.0/schemas',
-//
-// )
-// ```
+//	schemas_enums = gnome.mkenums('org.foo.MyApp.enums.xml',
+//	  comments: '<!-- @comment@ -->',
+//	  fhead: '<schemalist>',
+//	  vhead: '  <@type@ id="org.foo.MyApp.@EnumName@">',
+//	  vprod: '    <value nick="@valuenick@" value="@valuenum@"/>',
+//	  vtail: '  </@type@>',
+//	  ftail: '</schemalist>',
+//	  sources: enum_sources,
+//	  install_header: true,
+//	  install_dir: get_option('datadir') / 'glib-2.0/schemas',
+//	)
 //
 // It is recommended to validate your schemas as part of the test suite for
 // your application:
 //
-// ```
-// test('validate-schema',
-//
-//	find_program('glib-compile-schemas'),
-//	args: ['--strict', '--dry-run', meson.current_source_dir()],
-//
-// )
-// ```
+//	test('validate-schema',
+//	  find_program('glib-compile-schemas'),
+//	  args: ['--strict', '--dry-run', meson.current_source_dir()],
+//	)
 //
 // If your application allows running uninstalled, you should also use the
 // `gnome.compile_schemas()` function to compile the schemas in the current
 // build directory:
 //
-// ```
-// gnome.compile_schemas()
-// ```
+//	gnome.compile_schemas()
 //
-// ### Autotools
+// # Autotools
 //
 // GSettings comes with autotools integration to simplify compiling and
 // installing schemas. To add GSettings support to an application, add the
 // following to your `configure.ac`:
-// ```
-// GLIB_GSETTINGS
-// ```
+//
+//	GLIB_GSETTINGS
 //
 // In the appropriate `Makefile.am`, use the following snippet to compile and
 // install the named schema:
-// ```
-// gsettings_SCHEMAS = org.foo.MyApp.gschema.xml
-// EXTRA_DIST = $(gsettings_SCHEMAS)
 //
-// @GSETTINGS_RULES@
-// ```
+//	gsettings_SCHEMAS = org.foo.MyApp.gschema.xml
+//	EXTRA_DIST = $(gsettings_SCHEMAS)
+//
+//	@GSETTINGS_RULES@
 //
 // If an enumerated type defined in a C header file is to be used in a GSettings
-// schema, it can either be defined manually using an `&lt;enum&gt;` element in the
+// schema, it can either be defined manually using an `<enum>` element in the
 // schema XML, or it can be extracted automatically from the C header. This
 // approach is preferred, as it ensures the two representations are always
 // synchronised. To do so, add the following to the relevant `Makefile.am`:
-// ```
-// gsettings_ENUM_NAMESPACE = org.foo.MyApp
-// gsettings_ENUM_FILES = my-app-enums.h my-app-misc.h
-// ```
+//
+//	gsettings_ENUM_NAMESPACE = org.foo.MyApp
+//	gsettings_ENUM_FILES = my-app-enums.h my-app-misc.h
 //
 // `gsettings_ENUM_NAMESPACE` specifies the schema namespace for the enum files,
 // which are specified in `gsettings_ENUM_FILES`. This will generate a
@@ -565,27 +589,28 @@ const (
 // rules. It should not be committed to version control or included in
 // `EXTRA_DIST`.
 //
-// ## Localization
+// # Localization
 //
 // No changes are needed to the build system to mark a schema XML file for
 // translation. Assuming it sets the `gettext-domain` attribute, a schema may
 // be marked for translation by adding it to `POTFILES.in`, assuming gettext
 // 0.19 or newer is in use (the preferred method for translation):
-// ```
-// data/org.foo.MyApp.gschema.xml
-// ```
+//
+//	data/org.foo.MyApp.gschema.xml
 //
 // Alternatively, if intltool 0.50.1 is in use:
-// ```
-// [type: gettext/gsettings]data/org.foo.MyApp.gschema.xml
-// ```
 //
-// GSettings will use gettext to look up translations for the `&lt;summary&gt;` and
-// `&lt;description&gt;` elements, and also any `&lt;default&gt;` elements which have a
+//	[type: gettext/gsettings]data/org.foo.MyApp.gschema.xml
+//
+// GSettings will use gettext to look up translations for the `<summary>` and
+// `<description>` elements, and also any `<default>` elements which have a
 // `l10n` attribute set.
 //
 // Translations **must not** be included in the `.gschema.xml` file by the build
 // system, for example by using a rule to generate the XML file from a template.
+//
+// [gschema.dtd]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/gschema.dtd
+// [GNOME module]: https://mesonbuild.com/Gnome-module.html
 type Settings struct {
 	gobject.Object
 }
@@ -604,19 +629,19 @@ func SettingsNewFromInternalPtr(ptr uintptr) *Settings {
 
 var xNewSettings func(string) uintptr
 
-// Creates a new [class@Gio.Settings] object with the schema specified by
-// @schema_id.
+// Creates a new [Settings] object with the schema specified by
+// schema_id.
 //
 // It is an error for the schema to not exist: schemas are an
 // essential part of a program, as they provide type information.
 // If schemas need to be dynamically loaded (for example, from an
-// optional runtime dependency), [method@Gio.SettingsSchemaSource.lookup]
+// optional runtime dependency), [SettingsSchemaSource.Lookup]
 // can be used to test for their existence before loading them.
 //
-// Signals on the newly created [class@Gio.Settings] object will be dispatched
-// via the thread-default [struct@GLib.MainContext] in effect at the time of the
-// call to [ctor@Gio.Settings.new].  The new [class@Gio.Settings] will hold a reference
-// on the context.  See [method@GLib.MainContext.push_thread_default].
+// Signals on the newly created [Settings] object will be dispatched
+// via the thread-default [glib.MainContext] in effect at the time of the
+// call to [NewSettings].  The new [Settings] will hold a reference
+// on the context.  See [glib.MainContext.PushThreadDefault].
 func NewSettings(SchemaIdVar string) *Settings {
 	var cls *Settings
 
@@ -632,7 +657,7 @@ func NewSettings(SchemaIdVar string) *Settings {
 
 var xNewSettingsFull func(*SettingsSchema, uintptr, uintptr) uintptr
 
-// Creates a new [class@Gio.Settings] object with a given schema, backend and
+// Creates a new [Settings] object with a given schema, backend and
 // path.
 //
 // It should be extremely rare that you ever want to use this function.
@@ -640,20 +665,20 @@ var xNewSettingsFull func(*SettingsSchema, uintptr, uintptr) uintptr
 // that want to provide access to schemas loaded from custom locations,
 // etc).
 //
-// At the most basic level, a [class@Gio.Settings] object is a pure composition of
-// four things: a [struct@Gio.SettingsSchema], a [class@Gio.SettingsBackend], a path within that
-// backend, and a [struct@GLib.MainContext] to which signals are dispatched.
+// At the most basic level, a [Settings] object is a pure composition of
+// four things: a [SettingsSchema], a [SettingsBackend], a path within that
+// backend, and a [glib.MainContext] to which signals are dispatched.
 //
 // This constructor therefore gives you full control over constructing
-// [class@Gio.Settings] instances.  The first 3 parameters are given directly as
-// @schema, @backend and @path, and the main context is taken from the
-// thread-default (as per [ctor@Gio.Settings.new]).
+// [Settings] instances.  The first 3 parameters are given directly as
+// schema, backend and path, and the main context is taken from the
+// thread-default (as per [NewSettings]).
 //
-// If @backend is `NULL` then the default backend is used.
+// If backend is `NULL` then the default backend is used.
 //
-// If @path is `NULL` then the path from the schema is used.  It is an
-// error if @path is `NULL` and the schema has no path of its own or if
-// @path is non-`NULL` and not equal to the path that the schema does
+// If path is `NULL` then the path from the schema is used.  It is an
+// error if path is `NULL` and the schema has no path of its own or if
+// path is non-`NULL` and not equal to the path that the schema does
 // have.
 func NewSettingsFull(SchemaVar *SettingsSchema, BackendVar *SettingsBackend, PathVar *string) *Settings {
 	var cls *Settings
@@ -673,10 +698,10 @@ func NewSettingsFull(SchemaVar *SettingsSchema, BackendVar *SettingsBackend, Pat
 
 var xNewSettingsWithBackend func(string, uintptr) uintptr
 
-// Creates a new [class@Gio.Settings] object with the schema specified by
-// @schema_id and a given [class@Gio.SettingsBackend].
+// Creates a new [Settings] object with the schema specified by
+// schema_id and a given [SettingsBackend].
 //
-// Creating a [class@Gio.Settings] object with a different backend allows accessing
+// Creating a [Settings] object with a different backend allows accessing
 // settings from a database other than the usual one. For example, it may make
 // sense to pass a backend corresponding to the ‘defaults’ settings database on
 // the system to get a settings object that modifies the system default
@@ -696,11 +721,11 @@ func NewSettingsWithBackend(SchemaIdVar string, BackendVar *SettingsBackend) *Se
 
 var xNewSettingsWithBackendAndPath func(string, uintptr, string) uintptr
 
-// Creates a new [class@Gio.Settings] object with the schema specified by
-// @schema_id and a given [class@Gio.SettingsBackend] and path.
+// Creates a new [Settings] object with the schema specified by
+// schema_id and a given [SettingsBackend] and path.
 //
-// This is a mix of [ctor@Gio.Settings.new_with_backend] and
-// [ctor@Gio.Settings.new_with_path].
+// This is a mix of [NewSettingsWithBackend] and
+// [NewSettingsWithPath].
 func NewSettingsWithBackendAndPath(SchemaIdVar string, BackendVar *SettingsBackend, PathVar string) *Settings {
 	var cls *Settings
 
@@ -716,8 +741,8 @@ func NewSettingsWithBackendAndPath(SchemaIdVar string, BackendVar *SettingsBacke
 
 var xNewSettingsWithPath func(string, string) uintptr
 
-// Creates a new [class@Gio.Settings] object with the relocatable schema specified
-// by @schema_id and a given path.
+// Creates a new [Settings] object with the relocatable schema specified
+// by schema_id and a given path.
 //
 // You only need to do this if you want to directly create a settings
 // object with a schema that doesn’t have a specified path of its own.
@@ -726,7 +751,7 @@ var xNewSettingsWithPath func(string, string) uintptr
 // It is a programmer error to call this function for a schema that
 // has an explicitly specified path.
 //
-// It is a programmer error if @path is not a valid path.  A valid path
+// It is a programmer error if path is not a valid path.  A valid path
 // begins and ends with `/` and does not contain two consecutive `/`
 // characters.
 func NewSettingsWithPath(SchemaIdVar string, PathVar string) *Settings {
@@ -746,8 +771,8 @@ var xSettingsApply func(uintptr)
 
 // Applies any changes that have been made to the settings.
 //
-// This function does nothing unless @settings is in
-// [‘delay-apply’ mode](class.Settings.html#delay-apply-mode).  In the normal
+// This function does nothing unless settings is in
+// ‘delay-apply’ mode.  In the normal
 // case settings are always applied immediately.
 func (x *Settings) Apply() {
 
@@ -757,23 +782,23 @@ func (x *Settings) Apply() {
 
 var xSettingsBind func(uintptr, string, uintptr, string, SettingsBindFlags)
 
-// Create a binding between the @key in the @settings object
-// and the property @property of @object.
+// Create a binding between the key in the settings object
+// and the property property of object.
 //
 // The binding uses the default GIO mapping functions to map
 // between the settings and property values. These functions
 // handle booleans, numeric types and string types in a
-// straightforward way. Use [method@Gio.Settings.bind_with_mapping] if
+// straightforward way. Use [Settings.BindWithMapping] if
 // you need a custom mapping, or map between types that are not
 // supported by the default mapping functions.
 //
-// Unless the @flags include [flags@Gio.SettingsBindFlags.NO_SENSITIVITY], this
+// Unless the flags include SettingsBindFlags.NO_SENSITIVITY, this
 // function also establishes a binding between the writability of
-// @key and the `sensitive` property of @object (if @object has
-// a boolean property by that name). See [method@Gio.Settings.bind_writable]
+// key and the `sensitive` property of object (if object has
+// a boolean property by that name). See [Settings.BindWritable]
 // for more details about writable bindings.
 //
-// Note that the lifecycle of the binding is tied to @object,
+// Note that the lifecycle of the binding is tied to object,
 // and that you can have only one binding per object property.
 // If you bind the same property twice on the same object, the second
 // binding overrides the first one.
@@ -785,16 +810,18 @@ func (x *Settings) Bind(KeyVar string, ObjectVar *gobject.Object, PropertyVar st
 
 var xSettingsBindWithMapping func(uintptr, string, uintptr, string, SettingsBindFlags, uintptr, uintptr, uintptr, uintptr)
 
-// Create a binding between the @key in the @settings object
-// and the property @property of @object.
+// Create a binding between the key in the settings object
+// and the property property of object.
 //
 // The binding uses the provided mapping functions to map between
 // settings and property values.
 //
-// Note that the lifecycle of the binding is tied to @object,
+// Note that the lifecycle of the binding is tied to object,
 // and that you can have only one binding per object property.
 // If you bind the same property twice on the same object, the second
 // binding overrides the first one.
+//
+// Deprecated: use [BindWithMappingClosures], the version of this function that language bindings are meant to use.
 func (x *Settings) BindWithMapping(KeyVar string, ObjectVar *gobject.Object, PropertyVar string, FlagsVar SettingsBindFlags, GetMappingVar *SettingsBindGetMapping, SetMappingVar *SettingsBindSetMapping, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var GetMappingVarRef uintptr
@@ -804,11 +831,12 @@ func (x *Settings) BindWithMapping(KeyVar string, ObjectVar *gobject.Object, Pro
 			GetMappingVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *gobject.Value, arg1 *glib.Variant, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *GetMappingVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			GetMappingVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(GetMappingVarPtr, GetMappingVarRef, GetMappingVar)
+			GetMappingVarRef = core.NewCallback(fcb)
+			GetMappingVarRef = glib.SaveCallbackWithClosure(GetMappingVarPtr, GetMappingVarRef, GetMappingVar)
 		}
 	}
 
@@ -819,11 +847,12 @@ func (x *Settings) BindWithMapping(KeyVar string, ObjectVar *gobject.Object, Pro
 			SetMappingVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *gobject.Value, arg1 *glib.VariantType, arg2 uintptr) *glib.Variant {
+				defer core.RecoverPanic()
 				cbFn := *SetMappingVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			SetMappingVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SetMappingVarPtr, SetMappingVarRef, SetMappingVar)
+			SetMappingVarRef = core.NewCallback(fcb)
+			SetMappingVarRef = glib.SaveCallbackWithClosure(SetMappingVarPtr, SetMappingVarRef, SetMappingVar)
 		}
 	}
 
@@ -834,11 +863,12 @@ func (x *Settings) BindWithMapping(KeyVar string, ObjectVar *gobject.Object, Pro
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -848,7 +878,7 @@ func (x *Settings) BindWithMapping(KeyVar string, ObjectVar *gobject.Object, Pro
 
 var xSettingsBindWithMappingClosures func(uintptr, string, uintptr, string, SettingsBindFlags, *gobject.Closure, *gobject.Closure)
 
-// Version of [method@Gio.Settings.bind_with_mapping] using closures instead of
+// Version of [Settings.BindWithMapping] using closures instead of
 // callbacks for easier binding in other languages.
 func (x *Settings) BindWithMappingClosures(KeyVar string, ObjectVar *gobject.Object, PropertyVar string, FlagsVar SettingsBindFlags, GetMappingVar *gobject.Closure, SetMappingVar *gobject.Closure) {
 
@@ -858,8 +888,8 @@ func (x *Settings) BindWithMappingClosures(KeyVar string, ObjectVar *gobject.Obj
 
 var xSettingsBindWritable func(uintptr, string, uintptr, string, bool)
 
-// Create a binding between the writability of @key in the
-// @settings object and the property @property of @object.
+// Create a binding between the writability of key in the
+// settings object and the property property of object.
 //
 // The property must be boolean; `sensitive` or `visible`
 // properties of widgets are the most likely candidates.
@@ -868,11 +898,11 @@ var xSettingsBindWritable func(uintptr, string, uintptr, string, bool)
 // writability of the setting will be propagated to the object
 // property, not the other way.
 //
-// When the @inverted argument is true, the binding inverts the
-// value as it passes from the setting to the object, i.e. @property
+// When the inverted argument is true, the binding inverts the
+// value as it passes from the setting to the object, i.e. property
 // will be set to true if the key is not writable.
 //
-// Note that the lifecycle of the binding is tied to @object,
+// Note that the lifecycle of the binding is tied to object,
 // and that you can have only one binding per object property.
 // If you bind the same property twice on the same object, the second
 // binding overrides the first one.
@@ -884,7 +914,7 @@ func (x *Settings) BindWritable(KeyVar string, ObjectVar *gobject.Object, Proper
 
 var xSettingsCreateAction func(uintptr, string) uintptr
 
-// Creates a [iface@Gio.Action] corresponding to a given [class@Gio.Settings] key.
+// Creates a [Action] corresponding to a given [Settings] key.
 //
 // The action has the same name as the key.
 //
@@ -913,12 +943,12 @@ func (x *Settings) CreateAction(KeyVar string) *ActionBase {
 
 var xSettingsDelay func(uintptr)
 
-// Changes the [class@Gio.Settings] object into
-// [‘delay-apply’ mode](class.Settings.html#delay-apply-mode).
+// Changes the [Settings] object into
+// ‘delay-apply’ mode.
 //
 // In this
-// mode, changes to @settings are not immediately propagated to the
-// backend, but kept locally until [method@Gio.Settings.apply] is called.
+// mode, changes to settings are not immediately propagated to the
+// backend, but kept locally until [Settings.Apply] is called.
 func (x *Settings) Delay() {
 
 	xSettingsDelay(x.GoPointer())
@@ -927,13 +957,13 @@ func (x *Settings) Delay() {
 
 var xSettingsGet func(uintptr, string, string, ...interface{})
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience function that combines [method@Gio.Settings.get_value] with
-// [method@GLib.Variant.get].
+// A convenience function that combines [Settings.GetValue] with
+// [glib.Variant.Get].
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or for the [struct@GLib.VariantType] of @format to mismatch
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or for the [glib.VariantType] of format to mismatch
 // the type given in the schema.
 func (x *Settings) Get(KeyVar string, FormatVar string, varArgs ...interface{}) {
 
@@ -943,12 +973,12 @@ func (x *Settings) Get(KeyVar string, FormatVar string, varArgs ...interface{})
 
 var xSettingsGetBoolean func(uintptr, string) bool
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for booleans.
+// A convenience variant of [Settings.Get] for booleans.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `b` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `b` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetBoolean(KeyVar string) bool {
 
 	cret := xSettingsGetBoolean(x.GoPointer(), KeyVar)
@@ -959,13 +989,13 @@ var xSettingsGetChild func(uintptr, string) uintptr
 
 // Creates a child settings object which has a base path of
 // `base-path/name`, where `base-path` is the base path of
-// @settings and `name` is as specified by the caller.
+// settings and `name` is as specified by the caller.
 //
 // The schema for the child settings object must have been declared
-// in the schema of @settings using a `&lt;child&gt;` element.
+// in the schema of settings using a `<child>` element.
 //
 // The created child settings object will inherit the
-// [property@Gio.Settings:delay-apply] mode from @settings.
+// [Settings.GetPropertyDelayApply] mode from settings.
 func (x *Settings) GetChild(NameVar string) *Settings {
 	var cls *Settings
 
@@ -983,15 +1013,15 @@ var xSettingsGetDefaultValue func(uintptr, string) *glib.Variant
 
 // Gets the ‘default value’ of a key.
 //
-// This is the value that would be read if [method@Gio.Settings.reset] were to be
+// This is the value that would be read if [Settings.Reset] were to be
 // called on the key.
 //
 // Note that this may be a different value than returned by
-// [method@Gio.SettingsSchemaKey.get_default_value] if the system administrator
+// [SettingsSchemaKey.GetDefaultValue] if the system administrator
 // has provided a default value.
 //
-// Comparing the return values of [method@Gio.Settings.get_default_value] and
-// [method@Gio.Settings.get_value] is not sufficient for determining if a value
+// Comparing the return values of [Settings.GetDefaultValue] and
+// [Settings.GetValue] is not sufficient for determining if a value
 // has been set because the user may have explicitly set the value to
 // something that happens to be equal to the default.  The difference
 // here is that if the default changes in the future, the user’s key
@@ -1000,8 +1030,8 @@ var xSettingsGetDefaultValue func(uintptr, string) *glib.Variant
 // This function may be useful for adding an indication to a UI of what
 // the default value was before the user set it.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings.
 func (x *Settings) GetDefaultValue(KeyVar string) *glib.Variant {
 
 	cret := xSettingsGetDefaultValue(x.GoPointer(), KeyVar)
@@ -1010,12 +1040,12 @@ func (x *Settings) GetDefaultValue(KeyVar string) *glib.Variant {
 
 var xSettingsGetDouble func(uintptr, string) float64
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for doubles.
+// A convenience variant of [Settings.Get] for doubles.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `d` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `d` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetDouble(KeyVar string) float64 {
 
 	cret := xSettingsGetDouble(x.GoPointer(), KeyVar)
@@ -1024,14 +1054,14 @@ func (x *Settings) GetDouble(KeyVar string) float64 {
 
 var xSettingsGetEnum func(uintptr, string) int
 
-// Gets the value that is stored in @settings for @key and converts it
+// Gets the value that is stored in settings for key and converts it
 // to the enum value that it represents.
 //
 // In order to use this function the type of the value must be a string
 // and it must be marked in the schema file as an enumerated type.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or is not marked as an enumerated type.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or is not marked as an enumerated type.
 //
 // If the value stored in the configuration database is not a valid
 // value for the enumerated type then this function will return the
@@ -1044,14 +1074,14 @@ func (x *Settings) GetEnum(KeyVar string) int {
 
 var xSettingsGetFlags func(uintptr, string) uint
 
-// Gets the value that is stored in @settings for @key and converts it
+// Gets the value that is stored in settings for key and converts it
 // to the flags value that it represents.
 //
 // In order to use this function the type of the value must be an array
 // of strings and it must be marked in the schema file as a flags type.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or is not marked as a flags type.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or is not marked as a flags type.
 //
 // If the value stored in the configuration database is not a valid
 // value for the flags type then this function will return the default
@@ -1064,11 +1094,11 @@ func (x *Settings) GetFlags(KeyVar string) uint {
 
 var xSettingsGetHasUnapplied func(uintptr) bool
 
-// Returns whether the [class@Gio.Settings] object has any unapplied
+// Returns whether the [Settings] object has any unapplied
 // changes.
 //
 // This can only be the case if it is in
-// [‘delay-apply’ mode](class.Settings.html#delay-apply-mode).
+// ‘delay-apply’ mode.
 func (x *Settings) GetHasUnapplied() bool {
 
 	cret := xSettingsGetHasUnapplied(x.GoPointer())
@@ -1077,12 +1107,12 @@ func (x *Settings) GetHasUnapplied() bool {
 
 var xSettingsGetInt func(uintptr, string) int
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for 32-bit integers.
+// A convenience variant of [Settings.Get] for 32-bit integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `i` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `i` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetInt(KeyVar string) int {
 
 	cret := xSettingsGetInt(x.GoPointer(), KeyVar)
@@ -1091,12 +1121,12 @@ func (x *Settings) GetInt(KeyVar string) int {
 
 var xSettingsGetInt64 func(uintptr, string) int64
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for 64-bit integers.
+// A convenience variant of [Settings.Get] for 64-bit integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `x` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `x` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetInt64(KeyVar string) int64 {
 
 	cret := xSettingsGetInt64(x.GoPointer(), KeyVar)
@@ -1105,12 +1135,12 @@ func (x *Settings) GetInt64(KeyVar string) int64 {
 
 var xSettingsGetMapped func(uintptr, string, uintptr, uintptr) uintptr
 
-// Gets the value that is stored at @key in @settings, subject to
+// Gets the value that is stored at key in settings, subject to
 // application-level validation/mapping.
 //
 // You should use this function when the application needs to perform
 // some processing on the value of the key (for example, parsing).  The
-// @mapping function performs that processing.  If the function
+// mapping function performs that processing.  If the function
 // indicates that the processing was unsuccessful (due to a parse error,
 // for example) then the mapping is tried again with another value.
 //
@@ -1127,9 +1157,9 @@ var xSettingsGetMapped func(uintptr, string, uintptr, uintptr) uintptr
 // If the mapping function still indicates failure at this point then
 // the application will be aborted.
 //
-// The result parameter for the @mapping function is pointed to a
+// The result parameter for the mapping function is pointed to a
 // `gpointer` which is initially set to `NULL`.  The same pointer is given
-// to each invocation of @mapping.  The final value of that `gpointer` is
+// to each invocation of mapping.  The final value of that `gpointer` is
 // what is returned by this function.  `NULL` is valid; it is returned
 // just as any other value would be.
 func (x *Settings) GetMapped(KeyVar string, MappingVar *SettingsGetMapping, UserDataVar uintptr) uintptr {
@@ -1141,11 +1171,12 @@ func (x *Settings) GetMapped(KeyVar string, MappingVar *SettingsGetMapping, User
 			MappingVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *glib.Variant, arg1 *uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *MappingVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			MappingVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MappingVarPtr, MappingVarRef, MappingVar)
+			MappingVarRef = core.NewCallback(fcb)
+			MappingVarRef = glib.SaveCallbackWithClosure(MappingVarPtr, MappingVarRef, MappingVar)
 		}
 	}
 
@@ -1153,50 +1184,41 @@ func (x *Settings) GetMapped(KeyVar string, MappingVar *SettingsGetMapping, User
 	return cret
 }
 
-var xSettingsGetRange func(uintptr, string) *glib.Variant
-
-// Queries the range of a key.
-func (x *Settings) GetRange(KeyVar string) *glib.Variant {
-
-	cret := xSettingsGetRange(x.GoPointer(), KeyVar)
-	return cret
-}
-
 var xSettingsGetString func(uintptr, string) string
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for strings.
+// A convenience variant of [Settings.Get] for strings.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `s` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `s` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetString(KeyVar string) string {
 
 	cret := xSettingsGetString(x.GoPointer(), KeyVar)
 	return cret
 }
 
-var xSettingsGetStrv func(uintptr, string) []string
+var xSettingsGetStrv func(uintptr, string) uintptr
 
-// A convenience variant of [method@Gio.Settings.get] for string arrays.
+// A convenience variant of [Settings.Get] for string arrays.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `as` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `as` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetStrv(KeyVar string) []string {
 
 	cret := xSettingsGetStrv(x.GoPointer(), KeyVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xSettingsGetUint func(uintptr, string) uint
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for 32-bit unsigned
+// A convenience variant of [Settings.Get] for 32-bit unsigned
 // integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `u` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `u` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetUint(KeyVar string) uint {
 
 	cret := xSettingsGetUint(x.GoPointer(), KeyVar)
@@ -1205,13 +1227,13 @@ func (x *Settings) GetUint(KeyVar string) uint {
 
 var xSettingsGetUint64 func(uintptr, string) uint64
 
-// Gets the value that is stored at @key in @settings.
+// Gets the value that is stored at key in settings.
 //
-// A convenience variant of [method@Gio.Settings.get] for 64-bit unsigned
+// A convenience variant of [Settings.Get] for 64-bit unsigned
 // integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `t` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `t` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) GetUint64(KeyVar string) uint64 {
 
 	cret := xSettingsGetUint64(x.GoPointer(), KeyVar)
@@ -1224,11 +1246,11 @@ var xSettingsGetUserValue func(uintptr, string) *glib.Variant
 //
 // The user value of a key is the last value that was set by the user.
 //
-// After calling [method@Gio.Settings.reset] this function should always return
+// After calling [Settings.Reset] this function should always return
 // `NULL` (assuming something is not wrong with the system
 // configuration).
 //
-// It is possible that [method@Gio.Settings.get_value] will return a different
+// It is possible that [Settings.GetValue] will return a different
 // value than this function.  This can happen in the case that the user
 // set a value for a key that was subsequently locked down by the system
 // administrator — this function will return the user’s old value.
@@ -1236,8 +1258,8 @@ var xSettingsGetUserValue func(uintptr, string) *glib.Variant
 // This function may be useful for adding a ‘reset’ option to a UI or
 // for providing indication that a particular value has been changed.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings.
 func (x *Settings) GetUserValue(KeyVar string) *glib.Variant {
 
 	cret := xSettingsGetUserValue(x.GoPointer(), KeyVar)
@@ -1246,10 +1268,10 @@ func (x *Settings) GetUserValue(KeyVar string) *glib.Variant {
 
 var xSettingsGetValue func(uintptr, string) *glib.Variant
 
-// Gets the value that is stored in @settings for @key.
+// Gets the value that is stored in settings for key.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings.
 func (x *Settings) GetValue(KeyVar string) *glib.Variant {
 
 	cret := xSettingsGetValue(x.GoPointer(), KeyVar)
@@ -1265,54 +1287,28 @@ func (x *Settings) IsWritable(NameVar string) bool {
 	return cret
 }
 
-var xSettingsListChildren func(uintptr) []string
+var xSettingsListChildren func(uintptr) uintptr
 
-// Gets the list of children on @settings.
+// Gets the list of children on settings.
 //
 // The list is exactly the list of strings for which it is not an error
-// to call [method@Gio.Settings.get_child].
+// to call [Settings.GetChild].
 //
 // There is little reason to call this function from ‘normal’ code, since
 // you should already know what children are in your schema. This function
 // may still be useful there for introspection reasons, however.
 //
-// You should free the return value with [func@GLib.strfreev] when you are done
+// You should free the return value with [glib.Strfreev] when you are done
 // with it.
 func (x *Settings) ListChildren() []string {
 
 	cret := xSettingsListChildren(x.GoPointer())
-	return cret
-}
-
-var xSettingsListKeys func(uintptr) []string
-
-// Introspects the list of keys on @settings.
-//
-// You should probably not be calling this function from ‘normal’ code
-// (since you should already know what keys are in your schema).  This
-// function is intended for introspection reasons.
-//
-// You should free the return value with [func@GLib.strfreev] when you are done
-// with it.
-func (x *Settings) ListKeys() []string {
-
-	cret := xSettingsListKeys(x.GoPointer())
-	return cret
-}
-
-var xSettingsRangeCheck func(uintptr, string, *glib.Variant) bool
-
-// Checks if the given @value is of the correct type and within the
-// permitted range for @key.
-func (x *Settings) RangeCheck(KeyVar string, ValueVar *glib.Variant) bool {
-
-	cret := xSettingsRangeCheck(x.GoPointer(), KeyVar, ValueVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xSettingsReset func(uintptr, string)
 
-// Resets @key to its default value.
+// Resets key to its default value.
 //
 // This call resets the key, as much as possible, to its default value.
 // That might be the value specified in the schema or the one set by the
@@ -1327,8 +1323,8 @@ var xSettingsRevert func(uintptr)
 
 // Reverts all unapplied changes to the settings.
 //
-// This function does nothing unless @settings is in
-// [‘delay-apply’ mode](class.Settings.html#delay-apply-mode).  In the normal
+// This function does nothing unless settings is in
+// ‘delay-apply’ mode.  In the normal
 // case settings are always applied immediately.
 //
 // Change notifications will be emitted for affected keys.
@@ -1340,13 +1336,13 @@ func (x *Settings) Revert() {
 
 var xSettingsSet func(uintptr, string, string, ...interface{}) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience function that combines [method@Gio.Settings.set_value] with
-// [ctor@GLib.Variant.new].
+// A convenience function that combines [Settings.SetValue] with
+// [glib.NewVariant].
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or for the [struct@GLib.VariantType] of @format to mismatch
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or for the [glib.VariantType] of format to mismatch
 // the type given in the schema.
 func (x *Settings) Set(KeyVar string, FormatVar string, varArgs ...interface{}) bool {
 
@@ -1356,12 +1352,12 @@ func (x *Settings) Set(KeyVar string, FormatVar string, varArgs ...interface{})
 
 var xSettingsSetBoolean func(uintptr, string, bool) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for booleans.
+// A convenience variant of [Settings.Set] for booleans.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `b` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `b` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetBoolean(KeyVar string, ValueVar bool) bool {
 
 	cret := xSettingsSetBoolean(x.GoPointer(), KeyVar, ValueVar)
@@ -1370,12 +1366,12 @@ func (x *Settings) SetBoolean(KeyVar string, ValueVar bool) bool {
 
 var xSettingsSetDouble func(uintptr, string, float64) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for doubles.
+// A convenience variant of [Settings.Set] for doubles.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `d` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `d` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetDouble(KeyVar string, ValueVar float64) bool {
 
 	cret := xSettingsSetDouble(x.GoPointer(), KeyVar, ValueVar)
@@ -1384,16 +1380,16 @@ func (x *Settings) SetDouble(KeyVar string, ValueVar float64) bool {
 
 var xSettingsSetEnum func(uintptr, string, int) bool
 
-// Looks up the enumerated type nick for @value and writes it to @key,
-// within @settings.
+// Looks up the enumerated type nick for value and writes it to key,
+// within settings.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or is not marked as an enumerated type, or for
-// @value not to be a valid value for the named type.
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or is not marked as an enumerated type, or for
+// value not to be a valid value for the named type.
 //
-// After performing the write, accessing @key directly with
-// [method@Gio.Settings.get_string] will return the ‘nick’ associated with
-// @value.
+// After performing the write, accessing key directly with
+// [Settings.GetString] will return the ‘nick’ associated with
+// value.
 func (x *Settings) SetEnum(KeyVar string, ValueVar int) bool {
 
 	cret := xSettingsSetEnum(x.GoPointer(), KeyVar, ValueVar)
@@ -1402,17 +1398,17 @@ func (x *Settings) SetEnum(KeyVar string, ValueVar int) bool {
 
 var xSettingsSetFlags func(uintptr, string, uint) bool
 
-// Looks up the flags type nicks for the bits specified by @value, puts
-// them in an array of strings and writes the array to @key, within
-// @settings.
+// Looks up the flags type nicks for the bits specified by value, puts
+// them in an array of strings and writes the array to key, within
+// settings.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or is not marked as a flags type, or for @value
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or is not marked as a flags type, or for value
 // to contain any bits that are not value for the named type.
 //
-// After performing the write, accessing @key directly with
-// [method@Gio.Settings.get_strv] will return an array of ‘nicks’; one for each
-// bit in @value.
+// After performing the write, accessing key directly with
+// [Settings.GetStrv] will return an array of ‘nicks’; one for each
+// bit in value.
 func (x *Settings) SetFlags(KeyVar string, ValueVar uint) bool {
 
 	cret := xSettingsSetFlags(x.GoPointer(), KeyVar, ValueVar)
@@ -1421,12 +1417,12 @@ func (x *Settings) SetFlags(KeyVar string, ValueVar uint) bool {
 
 var xSettingsSetInt func(uintptr, string, int) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for 32-bit integers.
+// A convenience variant of [Settings.Set] for 32-bit integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `i` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `i` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetInt(KeyVar string, ValueVar int) bool {
 
 	cret := xSettingsSetInt(x.GoPointer(), KeyVar, ValueVar)
@@ -1435,12 +1431,12 @@ func (x *Settings) SetInt(KeyVar string, ValueVar int) bool {
 
 var xSettingsSetInt64 func(uintptr, string, int64) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for 64-bit integers.
+// A convenience variant of [Settings.Set] for 64-bit integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `x` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `x` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetInt64(KeyVar string, ValueVar int64) bool {
 
 	cret := xSettingsSetInt64(x.GoPointer(), KeyVar, ValueVar)
@@ -1449,12 +1445,12 @@ func (x *Settings) SetInt64(KeyVar string, ValueVar int64) bool {
 
 var xSettingsSetString func(uintptr, string, string) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for strings.
+// A convenience variant of [Settings.Set] for strings.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `s` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `s` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetString(KeyVar string, ValueVar string) bool {
 
 	cret := xSettingsSetString(x.GoPointer(), KeyVar, ValueVar)
@@ -1463,13 +1459,13 @@ func (x *Settings) SetString(KeyVar string, ValueVar string) bool {
 
 var xSettingsSetStrv func(uintptr, string, []string) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for string arrays.  If
-// @value is `NULL`, then @key is set to be the empty array.
+// A convenience variant of [Settings.Set] for string arrays.  If
+// value is `NULL`, then key is set to be the empty array.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having an `as` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having an `as` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetStrv(KeyVar string, ValueVar []string) bool {
 
 	cret := xSettingsSetStrv(x.GoPointer(), KeyVar, ValueVar)
@@ -1478,13 +1474,13 @@ func (x *Settings) SetStrv(KeyVar string, ValueVar []string) bool {
 
 var xSettingsSetUint func(uintptr, string, uint) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for 32-bit unsigned
+// A convenience variant of [Settings.Set] for 32-bit unsigned
 // integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `u` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `u` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetUint(KeyVar string, ValueVar uint) bool {
 
 	cret := xSettingsSetUint(x.GoPointer(), KeyVar, ValueVar)
@@ -1493,13 +1489,13 @@ func (x *Settings) SetUint(KeyVar string, ValueVar uint) bool {
 
 var xSettingsSetUint64 func(uintptr, string, uint64) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// A convenience variant of [method@Gio.Settings.set] for 64-bit unsigned
+// A convenience variant of [Settings.Set] for 64-bit unsigned
 // integers.
 //
-// It is a programmer error to give a @key that isn’t specified as
-// having a `t` type in the schema for @settings (see [struct@GLib.VariantType]).
+// It is a programmer error to give a key that isn’t specified as
+// having a `t` type in the schema for settings (see [glib.VariantType]).
 func (x *Settings) SetUint64(KeyVar string, ValueVar uint64) bool {
 
 	cret := xSettingsSetUint64(x.GoPointer(), KeyVar, ValueVar)
@@ -1508,13 +1504,13 @@ func (x *Settings) SetUint64(KeyVar string, ValueVar uint64) bool {
 
 var xSettingsSetValue func(uintptr, string, *glib.Variant) bool
 
-// Sets @key in @settings to @value.
+// Sets key in settings to value.
 //
-// It is a programmer error to give a @key that isn’t contained in the
-// schema for @settings or for @value to have the incorrect type, per
+// It is a programmer error to give a key that isn’t contained in the
+// schema for settings or for value to have the incorrect type, per
 // the schema.
 //
-// If @value is floating then this function consumes the reference.
+// If value is floating then this function consumes the reference.
 func (x *Settings) SetValue(KeyVar string, ValueVar *glib.Variant) bool {
 
 	cret := xSettingsSetValue(x.GoPointer(), KeyVar, ValueVar)
@@ -1533,8 +1529,8 @@ func (c *Settings) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyDelayApply gets the "delay-apply" property.
-// Whether the [class@Gio.Settings] object is in
-// [‘delay-apply’ mode](class.Settings.html#delay-apply-mode).
+// Whether the [Settings] object is in
+// ‘delay-apply’ mode.
 func (x *Settings) GetPropertyDelayApply() bool {
 	var v gobject.Value
 	x.GetProperty("delay-apply", &v)
@@ -1542,9 +1538,9 @@ func (x *Settings) GetPropertyDelayApply() bool {
 }
 
 // GetPropertyHasUnapplied gets the "has-unapplied" property.
-// Whether the [class@Gio.Settings] object has outstanding changes.
+// Whether the [Settings] object has outstanding changes.
 //
-// These changes will be applied when [method@Gio.Settings.apply] is called.
+// These changes will be applied when [Settings.Apply] is called.
 func (x *Settings) GetPropertyHasUnapplied() bool {
 	var v gobject.Value
 	x.GetProperty("has-unapplied", &v)
@@ -1570,14 +1566,18 @@ func (x *Settings) GetPropertyPath() string {
 
 // SetPropertySchema sets the "schema" property.
 // The name of the schema that describes the types of keys
-// for this [class@Gio.Settings] object.
+// for this [Settings] object.
 //
-// The type of this property is *not* [struct@Gio.SettingsSchema].
-// [struct@Gio.SettingsSchema] has only existed since version 2.32 and
+// The type of this property is *not* [SettingsSchema].
+// [SettingsSchema] has only existed since version 2.32 and
 // unfortunately this name was used in previous versions to refer to
 // the schema ID rather than the schema itself.  Take care to use the
-// [property@Gio.Settings:settings-schema] property if you wish to pass in a
-// [struct@Gio.SettingsSchema].
+// [Settings.GetPropertySettingsSchema] property if you wish to pass in a
+// [SettingsSchema].
+//
+// Deprecated: since 2.32. Use the [Settings.GetPropertySchemaId] property instead.
+//
+//	In a future version, this property may instead refer to a [SettingsSchema].
 func (x *Settings) SetPropertySchema(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1587,14 +1587,18 @@ func (x *Settings) SetPropertySchema(value string) {
 
 // GetPropertySchema gets the "schema" property.
 // The name of the schema that describes the types of keys
-// for this [class@Gio.Settings] object.
+// for this [Settings] object.
 //
-// The type of this property is *not* [struct@Gio.SettingsSchema].
-// [struct@Gio.SettingsSchema] has only existed since version 2.32 and
+// The type of this property is *not* [SettingsSchema].
+// [SettingsSchema] has only existed since version 2.32 and
 // unfortunately this name was used in previous versions to refer to
 // the schema ID rather than the schema itself.  Take care to use the
-// [property@Gio.Settings:settings-schema] property if you wish to pass in a
-// [struct@Gio.SettingsSchema].
+// [Settings.GetPropertySettingsSchema] property if you wish to pass in a
+// [SettingsSchema].
+//
+// Deprecated: since 2.32. Use the [Settings.GetPropertySchemaId] property instead.
+//
+//	In a future version, this property may instead refer to a [SettingsSchema].
 func (x *Settings) GetPropertySchema() string {
 	var v gobject.Value
 	x.GetProperty("schema", &v)
@@ -1603,7 +1607,7 @@ func (x *Settings) GetPropertySchema() string {
 
 // SetPropertySchemaId sets the "schema-id" property.
 // The name of the schema that describes the types of keys
-// for this [class@Gio.Settings] object.
+// for this [Settings] object.
 func (x *Settings) SetPropertySchemaId(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1613,7 +1617,7 @@ func (x *Settings) SetPropertySchemaId(value string) {
 
 // GetPropertySchemaId gets the "schema-id" property.
 // The name of the schema that describes the types of keys
-// for this [class@Gio.Settings] object.
+// for this [Settings] object.
 func (x *Settings) GetPropertySchemaId() string {
 	var v gobject.Value
 	x.GetProperty("schema-id", &v)
@@ -1621,13 +1625,13 @@ func (x *Settings) GetPropertySchemaId() string {
 }
 
 // SetPropertySettingsSchema sets the "settings-schema" property.
-// The [struct@Gio.SettingsSchema] describing the types of keys for this
-// [class@Gio.Settings] object.
+// The [SettingsSchema] describing the types of keys for this
+// [Settings] object.
 //
-// Ideally, this property would be called [property@Gio.Settings:schema].
-// [struct@Gio.SettingsSchema]
+// Ideally, this property would be called [Settings.GetPropertySchema].
+// [SettingsSchema]
 // has only existed since version 2.32, however, and before then the
-// [property@Gio.Settings:schema] property was used to refer to the ID of the schema rather
+// [Settings.GetPropertySchema] property was used to refer to the ID of the schema rather
 // than the schema itself.  Take care.
 func (x *Settings) SetPropertySettingsSchema(value uintptr) {
 	var v gobject.Value
@@ -1637,13 +1641,13 @@ func (x *Settings) SetPropertySettingsSchema(value uintptr) {
 }
 
 // GetPropertySettingsSchema gets the "settings-schema" property.
-// The [struct@Gio.SettingsSchema] describing the types of keys for this
-// [class@Gio.Settings] object.
+// The [SettingsSchema] describing the types of keys for this
+// [Settings] object.
 //
-// Ideally, this property would be called [property@Gio.Settings:schema].
-// [struct@Gio.SettingsSchema]
+// Ideally, this property would be called [Settings.GetPropertySchema].
+// [SettingsSchema]
 // has only existed since version 2.32, however, and before then the
-// [property@Gio.Settings:schema] property was used to refer to the ID of the schema rather
+// [Settings.GetPropertySchema] property was used to refer to the ID of the schema rather
 // than the schema itself.  Take care.
 func (x *Settings) GetPropertySettingsSchema() uintptr {
 	var v gobject.Value
@@ -1655,99 +1659,146 @@ func (x *Settings) GetPropertySettingsSchema() uintptr {
 //
 // You should connect to this signal
 // only if you are interested in viewing groups of changes before they
-// are split out into multiple emissions of the [signal@Gio.Settings::changed] signal.
-// For most use cases it is more appropriate to use the [signal@Gio.Settings::changed] signal.
+// are split out into multiple emissions of the [Settings.ConnectChangedFunc] signal.
+// For most use cases it is more appropriate to use the [Settings.ConnectChangedFunc] signal.
 //
 // In the event that the change event applies to one or more specified
-// keys, @keys will be an array of [alias@GLib.Quark]s of length @n_keys.  In the
-// event that the change event applies to the [class@Gio.Settings] object as a
-// whole (ie: potentially every key has been changed) then @keys will
-// be `NULL` and @n_keys will be `0`.
+// keys, keys will be an array of [glib.Quark]s of length n_keys.  In the
+// event that the change event applies to the [Settings] object as a
+// whole (ie: potentially every key has been changed) then keys will
+// be `NULL` and n_keys will be `0`.
 //
-// The default handler for this signal invokes the [signal@Gio.Settings::changed] signal
+// The default handler for this signal invokes the [Settings.ConnectChangedFunc] signal
 // for each affected key.  If any other connected handler returns
 // true then this default functionality will be suppressed.
+//
+// Deprecated: use ConnectChangeEventFunc, which also accepts method values and closures.
 func (x *Settings) ConnectChangeEvent(cb *func(Settings, uintptr, int) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeysVarp uintptr, NKeysVarp int) bool {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, KeysVarp, NKeysVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-event", cbRefPtr, cbPtr)
+}
+
+var xSettingsChangeEventTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, KeysVarp uintptr, NKeysVarp int) bool {
+func xSettingsChangeEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeysVarp uintptr, NKeysVarp int, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Settings, uintptr, int) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Settings{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, KeysVarp, NKeysVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeEventFunc connects cb to the "change-event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Settings) ConnectChangeEventFunc(cb func(Settings, uintptr, int) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-event", &xSettingsChangeEventTrampoline, xSettingsChangeEventNewTrampoline, cb)
 }
 
 // Emitted when a key has potentially changed.
 //
-// You should call one of the [method@Gio.Settings.get] calls to check the new
+// You should call one of the [Settings.Get] calls to check the new
 // value.
 //
 // This signal supports detailed connections.  You can connect to the
 // detailed signal `changed::x` in order to only receive callbacks
 // when key `x` changes.
 //
-// Note that @settings only emits this signal if you have read @key at
-// least once while a signal handler was already connected for @key.
+// Note that settings only emits this signal if you have read key at
+// least once while a signal handler was already connected for key.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Settings) ConnectChanged(cb *func(Settings, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
-
-	fcb := func(clsPtr uintptr, KeyVarp string) {
-		fa := Settings{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-		cbFn(fa, KeyVarp)
+			cbFn(fa, KeyVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
 }
 
 // ConnectChangedWithDetail connects to the "changed" signal with a detail string.
 // The detail is appended as "changed::<detail>".
+//
+// Deprecated: use ConnectChangedWithDetailFunc, which also accepts method values and closures.
 func (x *Settings) ConnectChangedWithDetail(detail string, cb *func(Settings, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("changed::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, KeyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, KeyVarp string) {
+var xSettingsChangedTrampoline uintptr
+
+func xSettingsChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeyVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Settings, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Settings{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, KeyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Settings) ConnectChangedFunc(cb func(Settings, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xSettingsChangedTrampoline, xSettingsChangedNewTrampoline, cb)
+}
+
+// ConnectChangedWithDetailFunc connects cb to the "changed" signal with a detail string, see ConnectChangedFunc.
+// The detail is appended as "changed::<detail>".
+func (x *Settings) ConnectChangedWithDetailFunc(detail string, cb func(Settings, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("changed::%s", detail), &xSettingsChangedTrampoline, xSettingsChangedNewTrampoline, cb)
 }
 
 // Emitted once per writability change event that affects this settings object.
@@ -1755,125 +1806,154 @@ func (x *Settings) ConnectChangedWithDetail(detail string, cb *func(Settings, st
 // You should connect
 // to this signal if you are interested in viewing groups of changes
 // before they are split out into multiple emissions of the
-// [signal@Gio.Settings::writable-changed] signal.  For most use cases it is more
-// appropriate to use the [signal@Gio.Settings::writable-changed] signal.
+// [Settings.ConnectWritableChangedFunc] signal.  For most use cases it is more
+// appropriate to use the [Settings.ConnectWritableChangedFunc] signal.
 //
 // In the event that the writability change applies only to a single
-// key, @key will be set to the [alias@GLib.Quark] for that key.  In the event
+// key, key will be set to the [glib.Quark] for that key.  In the event
 // that the writability change affects the entire settings object,
-// @key will be `0`.
+// key will be `0`.
 //
-// The default handler for this signal invokes the [signal@Gio.Settings::writable-changed]
-// and [signal@Gio.Settings::changed] signals for each affected key.  This is done because
+// The default handler for this signal invokes the [Settings.ConnectWritableChangedFunc]
+// and [Settings.ConnectChangedFunc] signals for each affected key.  This is done because
 // changes in writability might also imply changes in value (if for
 // example, a new mandatory setting is introduced).  If any other
 // connected handler returns true then this default functionality
 // will be suppressed.
+//
+// Deprecated: use ConnectWritableChangeEventFunc, which also accepts method values and closures.
 func (x *Settings) ConnectWritableChangeEvent(cb *func(Settings, uint) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "writable-change-event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyVarp uint) bool {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, KeyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "writable-change-event", cbRefPtr, cbPtr)
+}
+
+var xSettingsWritableChangeEventTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, KeyVarp uint) bool {
+func xSettingsWritableChangeEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeyVarp uint, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Settings, uint) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Settings{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, KeyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "writable-change-event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectWritableChangeEventFunc connects cb to the "writable-change-event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Settings) ConnectWritableChangeEventFunc(cb func(Settings, uint) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "writable-change-event", &xSettingsWritableChangeEventTrampoline, xSettingsWritableChangeEventNewTrampoline, cb)
 }
 
 // Emitted when the writability of a key has potentially changed.
 //
-// You should call [method@Gio.Settings.is_writable] in order to determine the
+// You should call [Settings.IsWritable] in order to determine the
 // new status.
 //
 // This signal supports detailed connections.  You can connect to the
 // detailed signal `writable-changed::x` in order to only receive
 // callbacks when the writability of `x` changes.
+//
+// Deprecated: use ConnectWritableChangedFunc, which also accepts method values and closures.
 func (x *Settings) ConnectWritableChanged(cb *func(Settings, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "writable-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-	fcb := func(clsPtr uintptr, KeyVarp string) {
-		fa := Settings{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
-
-		cbFn(fa, KeyVarp)
+			cbFn(fa, KeyVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "writable-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "writable-changed", cbRefPtr, cbPtr)
 }
 
 // ConnectWritableChangedWithDetail connects to the "writable-changed" signal with a detail string.
 // The detail is appended as "writable-changed::<detail>".
+//
+// Deprecated: use ConnectWritableChangedWithDetailFunc, which also accepts method values and closures.
 func (x *Settings) ConnectWritableChangedWithDetail(detail string, cb *func(Settings, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("writable-changed::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyVarp string) {
+			defer core.RecoverPanic()
+			fa := Settings{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, KeyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, KeyVarp string) {
+var xSettingsWritableChangedTrampoline uintptr
+
+func xSettingsWritableChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeyVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Settings, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Settings{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, KeyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-var xSettingsListRelocatableSchemas func() []string
-
-// Deprecated.
-func SettingsListRelocatableSchemas() []string {
-
-	cret := xSettingsListRelocatableSchemas()
-	return cret
+// ConnectWritableChangedFunc connects cb to the "writable-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Settings) ConnectWritableChangedFunc(cb func(Settings, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "writable-changed", &xSettingsWritableChangedTrampoline, xSettingsWritableChangedNewTrampoline, cb)
 }
 
-var xSettingsListSchemas func() []string
-
-// Deprecated.
-func SettingsListSchemas() []string {
-
-	cret := xSettingsListSchemas()
-	return cret
+// ConnectWritableChangedWithDetailFunc connects cb to the "writable-changed" signal with a detail string, see ConnectWritableChangedFunc.
+// The detail is appended as "writable-changed::<detail>".
+func (x *Settings) ConnectWritableChangedWithDetailFunc(detail string, cb func(Settings, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("writable-changed::%s", detail), &xSettingsWritableChangedTrampoline, xSettingsWritableChangedNewTrampoline, cb)
 }
 
 var xSettingsSync func()
 
 // Ensures that all pending operations are complete for the default backend.
 //
-// Writes made to a [class@Gio.Settings] are handled asynchronously.  For this
+// Writes made to a [Settings] are handled asynchronously.  For this
 // reason, it is very unlikely that the changes have it to disk by the
-// time [method@Gio.Settings.set] returns.
+// time [Settings.Set] returns.
 //
 // This call will block until all of the writes have made it to the
 // backend.  Since the main loop is not running, no change notifications
@@ -1887,7 +1967,7 @@ func SettingsSync() {
 
 var xSettingsUnbind func(uintptr, string)
 
-// Removes an existing binding for @property on @object.
+// Removes an existing binding for property on object.
 //
 // Note that bindings are automatically removed when the
 // object is finalized, so it is rarely necessary to call this
@@ -1903,7 +1983,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1914,59 +1994,56 @@ func init() {
 
 	core.PuregoSafeRegister(&xSettingsGLibType, libs, "g_settings_get_type")
 
-	core.PuregoSafeRegister(&xNewSettings, libs, "g_settings_new")
-	core.PuregoSafeRegister(&xNewSettingsFull, libs, "g_settings_new_full")
-	core.PuregoSafeRegister(&xNewSettingsWithBackend, libs, "g_settings_new_with_backend")
-	core.PuregoSafeRegister(&xNewSettingsWithBackendAndPath, libs, "g_settings_new_with_backend_and_path")
-	core.PuregoSafeRegister(&xNewSettingsWithPath, libs, "g_settings_new_with_path")
-
-	core.PuregoSafeRegister(&xSettingsApply, libs, "g_settings_apply")
-	core.PuregoSafeRegister(&xSettingsBind, libs, "g_settings_bind")
-	core.PuregoSafeRegister(&xSettingsBindWithMapping, libs, "g_settings_bind_with_mapping")
-	core.PuregoSafeRegister(&xSettingsBindWithMappingClosures, libs, "g_settings_bind_with_mapping_closures")
-	core.PuregoSafeRegister(&xSettingsBindWritable, libs, "g_settings_bind_writable")
-	core.PuregoSafeRegister(&xSettingsCreateAction, libs, "g_settings_create_action")
-	core.PuregoSafeRegister(&xSettingsDelay, libs, "g_settings_delay")
-	core.PuregoSafeRegister(&xSettingsGet, libs, "g_settings_get")
-	core.PuregoSafeRegister(&xSettingsGetBoolean, libs, "g_settings_get_boolean")
-	core.PuregoSafeRegister(&xSettingsGetChild, libs, "g_settings_get_child")
-	core.PuregoSafeRegister(&xSettingsGetDefaultValue, libs, "g_settings_get_default_value")
-	core.PuregoSafeRegister(&xSettingsGetDouble, libs, "g_settings_get_double")
-	core.PuregoSafeRegister(&xSettingsGetEnum, libs, "g_settings_get_enum")
-	core.PuregoSafeRegister(&xSettingsGetFlags, libs, "g_settings_get_flags")
-	core.PuregoSafeRegister(&xSettingsGetHasUnapplied, libs, "g_settings_get_has_unapplied")
-	core.PuregoSafeRegister(&xSettingsGetInt, libs, "g_settings_get_int")
-	core.PuregoSafeRegister(&xSettingsGetInt64, libs, "g_settings_get_int64")
-	core.PuregoSafeRegister(&xSettingsGetMapped, libs, "g_settings_get_mapped")
-	core.PuregoSafeRegister(&xSettingsGetRange, libs, "g_settings_get_range")
-	core.PuregoSafeRegister(&xSettingsGetString, libs, "g_settings_get_string")
-	core.PuregoSafeRegister(&xSettingsGetStrv, libs, "g_settings_get_strv")
-	core.PuregoSafeRegister(&xSettingsGetUint, libs, "g_settings_get_uint")
-	core.PuregoSafeRegister(&xSettingsGetUint64, libs, "g_settings_get_uint64")
-	core.PuregoSafeRegister(&xSettingsGetUserValue, libs, "g_settings_get_user_value")
-	core.PuregoSafeRegister(&xSettingsGetValue, libs, "g_settings_get_value")
-	core.PuregoSafeRegister(&xSettingsIsWritable, libs, "g_settings_is_writable")
-	core.PuregoSafeRegister(&xSettingsListChildren, libs, "g_settings_list_children")
-	core.PuregoSafeRegister(&xSettingsListKeys, libs, "g_settings_list_keys")
-	core.PuregoSafeRegister(&xSettingsRangeCheck, libs, "g_settings_range_check")
-	core.PuregoSafeRegister(&xSettingsReset, libs, "g_settings_reset")
-	core.PuregoSafeRegister(&xSettingsRevert, libs, "g_settings_revert")
-	core.PuregoSafeRegister(&xSettingsSet, libs, "g_settings_set")
-	core.PuregoSafeRegister(&xSettingsSetBoolean, libs, "g_settings_set_boolean")
-	core.PuregoSafeRegister(&xSettingsSetDouble, libs, "g_settings_set_double")
-	core.PuregoSafeRegister(&xSettingsSetEnum, libs, "g_settings_set_enum")
-	core.PuregoSafeRegister(&xSettingsSetFlags, libs, "g_settings_set_flags")
-	core.PuregoSafeRegister(&xSettingsSetInt, libs, "g_settings_set_int")
-	core.PuregoSafeRegister(&xSettingsSetInt64, libs, "g_settings_set_int64")
-	core.PuregoSafeRegister(&xSettingsSetString, libs, "g_settings_set_string")
-	core.PuregoSafeRegister(&xSettingsSetStrv, libs, "g_settings_set_strv")
-	core.PuregoSafeRegister(&xSettingsSetUint, libs, "g_settings_set_uint")
-	core.PuregoSafeRegister(&xSettingsSetUint64, libs, "g_settings_set_uint64")
-	core.PuregoSafeRegister(&xSettingsSetValue, libs, "g_settings_set_value")
-
-	core.PuregoSafeRegister(&xSettingsListRelocatableSchemas, libs, "g_settings_list_relocatable_schemas")
-	core.PuregoSafeRegister(&xSettingsListSchemas, libs, "g_settings_list_schemas")
-	core.PuregoSafeRegister(&xSettingsSync, libs, "g_settings_sync")
-	core.PuregoSafeRegister(&xSettingsUnbind, libs, "g_settings_unbind")
+	gobject.CheckClassSize("GSettingsClass", xSettingsGLibType, unsafe.Sizeof(SettingsClass{}))
+
+	core.PuregoRegisterSince(&xNewSettings, libs, "g_settings_new", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewSettingsFull, libs, "g_settings_new_full", "GIO", "2.32")
+	core.PuregoRegisterSince(&xNewSettingsWithBackend, libs, "g_settings_new_with_backend", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewSettingsWithBackendAndPath, libs, "g_settings_new_with_backend_and_path", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewSettingsWithPath, libs, "g_settings_new_with_path", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xSettingsApply, libs, "g_settings_apply", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsBind, libs, "g_settings_bind", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBindWithMapping, libs, "g_settings_bind_with_mapping", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBindWithMappingClosures, libs, "g_settings_bind_with_mapping_closures", "GIO", "2.82")
+	core.PuregoRegisterSince(&xSettingsBindWritable, libs, "g_settings_bind_writable", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsCreateAction, libs, "g_settings_create_action", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsDelay, libs, "g_settings_delay", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGet, libs, "g_settings_get", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetBoolean, libs, "g_settings_get_boolean", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetChild, libs, "g_settings_get_child", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetDefaultValue, libs, "g_settings_get_default_value", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsGetDouble, libs, "g_settings_get_double", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetEnum, libs, "g_settings_get_enum", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetFlags, libs, "g_settings_get_flags", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetHasUnapplied, libs, "g_settings_get_has_unapplied", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetInt, libs, "g_settings_get_int", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetInt64, libs, "g_settings_get_int64", "GIO", "2.50")
+	core.PuregoRegisterSince(&xSettingsGetMapped, libs, "g_settings_get_mapped", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsGetString, libs, "g_settings_get_string", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetStrv, libs, "g_settings_get_strv", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsGetUint, libs, "g_settings_get_uint", "GIO", "2.30")
+	core.PuregoRegisterSince(&xSettingsGetUint64, libs, "g_settings_get_uint64", "GIO", "2.50")
+	core.PuregoRegisterSince(&xSettingsGetUserValue, libs, "g_settings_get_user_value", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsGetValue, libs, "g_settings_get_value", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsIsWritable, libs, "g_settings_is_writable", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsListChildren, libs, "g_settings_list_children", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsReset, libs, "g_settings_reset", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsRevert, libs, "g_settings_revert", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsSet, libs, "g_settings_set", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetBoolean, libs, "g_settings_set_boolean", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetDouble, libs, "g_settings_set_double", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetEnum, libs, "g_settings_set_enum", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsSetFlags, libs, "g_settings_set_flags", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsSetInt, libs, "g_settings_set_int", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetInt64, libs, "g_settings_set_int64", "GIO", "2.50")
+	core.PuregoRegisterSince(&xSettingsSetString, libs, "g_settings_set_string", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetStrv, libs, "g_settings_set_strv", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsSetUint, libs, "g_settings_set_uint", "GIO", "2.30")
+	core.PuregoRegisterSince(&xSettingsSetUint64, libs, "g_settings_set_uint64", "GIO", "2.50")
+	core.PuregoRegisterSince(&xSettingsSetValue, libs, "g_settings_set_value", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xSettingsSync, libs, "g_settings_sync", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsUnbind, libs, "g_settings_unbind", "GIO", "2.26")
 
 }
@@ -0,0 +1,96 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xSettingsGetRange func(uintptr, string) *glib.Variant
+
+// Queries the range of a key.
+//
+// Deprecated: since 2.40. Use [SettingsSchemaKey.GetRange] instead.
+func (x *Settings) GetRange(KeyVar string) *glib.Variant {
+
+	cret := xSettingsGetRange(x.GoPointer(), KeyVar)
+	return cret
+}
+
+var xSettingsListKeys func(uintptr) uintptr
+
+// Introspects the list of keys on settings.
+//
+// You should probably not be calling this function from ‘normal’ code
+// (since you should already know what keys are in your schema).  This
+// function is intended for introspection reasons.
+//
+// You should free the return value with [glib.Strfreev] when you are done
+// with it.
+//
+// Deprecated: since 2.46. Use [SettingsSchema.ListKeys] instead.
+func (x *Settings) ListKeys() []string {
+
+	cret := xSettingsListKeys(x.GoPointer())
+	return core.GoStringSliceFull(cret)
+}
+
+var xSettingsRangeCheck func(uintptr, string, *glib.Variant) bool
+
+// Checks if the given value is of the correct type and within the
+// permitted range for key.
+//
+// Deprecated: since 2.40. Use [SettingsSchemaKey.RangeCheck] instead.
+func (x *Settings) RangeCheck(KeyVar string, ValueVar *glib.Variant) bool {
+
+	cret := xSettingsRangeCheck(x.GoPointer(), KeyVar, ValueVar)
+	return cret
+}
+
+var xSettingsListRelocatableSchemas func() []string
+
+// Deprecated.
+//
+// Deprecated: since 2.40. Use [SettingsSchemaSource.ListSchemas] instead
+func SettingsListRelocatableSchemas() []string {
+
+	cret := xSettingsListRelocatableSchemas()
+	return cret
+}
+
+var xSettingsListSchemas func() []string
+
+// Deprecated.
+//
+// Deprecated: since 2.40. Use [SettingsSchemaSource.ListSchemas] instead.
+// If you used [SettingsListSchemas] to check for the presence of
+// a particular schema, use [SettingsSchemaSource.Lookup] instead
+// of your whole loop.
+func SettingsListSchemas() []string {
+
+	cret := xSettingsListSchemas()
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSettingsGetRange, libs, "g_settings_get_range", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSettingsListKeys, libs, "g_settings_list_keys", "GIO", "")
+	core.PuregoRegisterSince(&xSettingsRangeCheck, libs, "g_settings_range_check", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xSettingsListRelocatableSchemas, libs, "g_settings_list_relocatable_schemas", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSettingsListSchemas, libs, "g_settings_list_schemas", "GIO", "2.26")
+
+}
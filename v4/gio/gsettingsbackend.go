@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GSettingsBackend.
+// Class structure for [SettingsBackend].
 type SettingsBackendClass struct {
 	_ structs.HostLayout
 
@@ -51,7 +50,8 @@ func (x *SettingsBackendClass) OverrideRead(cb func(*SettingsBackend, string, *g
 	if cb == nil {
 		x.xRead = 0
 	} else {
-		x.xRead = purego.NewCallback(func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType, DefaultValueVarp bool) *glib.Variant {
+		x.xRead = core.NewCallback(func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType, DefaultValueVarp bool) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(SettingsBackendNewFromInternalPtr(BackendVarp), KeyVarp, ExpectedTypeVarp, DefaultValueVarp)
 		})
 	}
@@ -64,7 +64,7 @@ func (x *SettingsBackendClass) GetRead() func(*SettingsBackend, string, *glib.Va
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType, DefaultValueVarp bool) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xRead)
+	core.RegisterFunc(&rawCallback, x.xRead)
 	return func(BackendVar *SettingsBackend, KeyVar string, ExpectedTypeVar *glib.VariantType, DefaultValueVar bool) *glib.Variant {
 		return rawCallback(BackendVar.GoPointer(), KeyVar, ExpectedTypeVar, DefaultValueVar)
 	}
@@ -76,7 +76,8 @@ func (x *SettingsBackendClass) OverrideGetWritable(cb func(*SettingsBackend, str
 	if cb == nil {
 		x.xGetWritable = 0
 	} else {
-		x.xGetWritable = purego.NewCallback(func(BackendVarp uintptr, KeyVarp string) bool {
+		x.xGetWritable = core.NewCallback(func(BackendVarp uintptr, KeyVarp string) bool {
+			defer core.RecoverPanic()
 			return cb(SettingsBackendNewFromInternalPtr(BackendVarp), KeyVarp)
 		})
 	}
@@ -89,7 +90,7 @@ func (x *SettingsBackendClass) GetGetWritable() func(*SettingsBackend, string) b
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, KeyVarp string) bool
-	purego.RegisterFunc(&rawCallback, x.xGetWritable)
+	core.RegisterFunc(&rawCallback, x.xGetWritable)
 	return func(BackendVar *SettingsBackend, KeyVar string) bool {
 		return rawCallback(BackendVar.GoPointer(), KeyVar)
 	}
@@ -101,7 +102,8 @@ func (x *SettingsBackendClass) OverrideWrite(cb func(*SettingsBackend, string, *
 	if cb == nil {
 		x.xWrite = 0
 	} else {
-		x.xWrite = purego.NewCallback(func(BackendVarp uintptr, KeyVarp string, ValueVarp *glib.Variant, OriginTagVarp uintptr) bool {
+		x.xWrite = core.NewCallback(func(BackendVarp uintptr, KeyVarp string, ValueVarp *glib.Variant, OriginTagVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(SettingsBackendNewFromInternalPtr(BackendVarp), KeyVarp, ValueVarp, OriginTagVarp)
 		})
 	}
@@ -114,7 +116,7 @@ func (x *SettingsBackendClass) GetWrite() func(*SettingsBackend, string, *glib.V
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, KeyVarp string, ValueVarp *glib.Variant, OriginTagVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xWrite)
+	core.RegisterFunc(&rawCallback, x.xWrite)
 	return func(BackendVar *SettingsBackend, KeyVar string, ValueVar *glib.Variant, OriginTagVar uintptr) bool {
 		return rawCallback(BackendVar.GoPointer(), KeyVar, ValueVar, OriginTagVar)
 	}
@@ -126,7 +128,8 @@ func (x *SettingsBackendClass) OverrideWriteTree(cb func(*SettingsBackend, *glib
 	if cb == nil {
 		x.xWriteTree = 0
 	} else {
-		x.xWriteTree = purego.NewCallback(func(BackendVarp uintptr, TreeVarp *glib.Tree, OriginTagVarp uintptr) bool {
+		x.xWriteTree = core.NewCallback(func(BackendVarp uintptr, TreeVarp *glib.Tree, OriginTagVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(SettingsBackendNewFromInternalPtr(BackendVarp), TreeVarp, OriginTagVarp)
 		})
 	}
@@ -139,7 +142,7 @@ func (x *SettingsBackendClass) GetWriteTree() func(*SettingsBackend, *glib.Tree,
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, TreeVarp *glib.Tree, OriginTagVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xWriteTree)
+	core.RegisterFunc(&rawCallback, x.xWriteTree)
 	return func(BackendVar *SettingsBackend, TreeVar *glib.Tree, OriginTagVar uintptr) bool {
 		return rawCallback(BackendVar.GoPointer(), TreeVar, OriginTagVar)
 	}
@@ -151,7 +154,8 @@ func (x *SettingsBackendClass) OverrideReset(cb func(*SettingsBackend, string, u
 	if cb == nil {
 		x.xReset = 0
 	} else {
-		x.xReset = purego.NewCallback(func(BackendVarp uintptr, KeyVarp string, OriginTagVarp uintptr) {
+		x.xReset = core.NewCallback(func(BackendVarp uintptr, KeyVarp string, OriginTagVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SettingsBackendNewFromInternalPtr(BackendVarp), KeyVarp, OriginTagVarp)
 		})
 	}
@@ -164,7 +168,7 @@ func (x *SettingsBackendClass) GetReset() func(*SettingsBackend, string, uintptr
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, KeyVarp string, OriginTagVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReset)
+	core.RegisterFunc(&rawCallback, x.xReset)
 	return func(BackendVar *SettingsBackend, KeyVar string, OriginTagVar uintptr) {
 		rawCallback(BackendVar.GoPointer(), KeyVar, OriginTagVar)
 	}
@@ -176,7 +180,8 @@ func (x *SettingsBackendClass) OverrideSubscribe(cb func(*SettingsBackend, strin
 	if cb == nil {
 		x.xSubscribe = 0
 	} else {
-		x.xSubscribe = purego.NewCallback(func(BackendVarp uintptr, NameVarp string) {
+		x.xSubscribe = core.NewCallback(func(BackendVarp uintptr, NameVarp string) {
+			defer core.RecoverPanic()
 			cb(SettingsBackendNewFromInternalPtr(BackendVarp), NameVarp)
 		})
 	}
@@ -189,7 +194,7 @@ func (x *SettingsBackendClass) GetSubscribe() func(*SettingsBackend, string) {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, NameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xSubscribe)
+	core.RegisterFunc(&rawCallback, x.xSubscribe)
 	return func(BackendVar *SettingsBackend, NameVar string) {
 		rawCallback(BackendVar.GoPointer(), NameVar)
 	}
@@ -201,7 +206,8 @@ func (x *SettingsBackendClass) OverrideUnsubscribe(cb func(*SettingsBackend, str
 	if cb == nil {
 		x.xUnsubscribe = 0
 	} else {
-		x.xUnsubscribe = purego.NewCallback(func(BackendVarp uintptr, NameVarp string) {
+		x.xUnsubscribe = core.NewCallback(func(BackendVarp uintptr, NameVarp string) {
+			defer core.RecoverPanic()
 			cb(SettingsBackendNewFromInternalPtr(BackendVarp), NameVarp)
 		})
 	}
@@ -214,7 +220,7 @@ func (x *SettingsBackendClass) GetUnsubscribe() func(*SettingsBackend, string) {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, NameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xUnsubscribe)
+	core.RegisterFunc(&rawCallback, x.xUnsubscribe)
 	return func(BackendVar *SettingsBackend, NameVar string) {
 		rawCallback(BackendVar.GoPointer(), NameVar)
 	}
@@ -226,7 +232,8 @@ func (x *SettingsBackendClass) OverrideSync(cb func(*SettingsBackend)) {
 	if cb == nil {
 		x.xSync = 0
 	} else {
-		x.xSync = purego.NewCallback(func(BackendVarp uintptr) {
+		x.xSync = core.NewCallback(func(BackendVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SettingsBackendNewFromInternalPtr(BackendVarp))
 		})
 	}
@@ -239,7 +246,7 @@ func (x *SettingsBackendClass) GetSync() func(*SettingsBackend) {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSync)
+	core.RegisterFunc(&rawCallback, x.xSync)
 	return func(BackendVar *SettingsBackend) {
 		rawCallback(BackendVar.GoPointer())
 	}
@@ -251,7 +258,8 @@ func (x *SettingsBackendClass) OverrideGetPermission(cb func(*SettingsBackend, s
 	if cb == nil {
 		x.xGetPermission = 0
 	} else {
-		x.xGetPermission = purego.NewCallback(func(BackendVarp uintptr, PathVarp string) uintptr {
+		x.xGetPermission = core.NewCallback(func(BackendVarp uintptr, PathVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(SettingsBackendNewFromInternalPtr(BackendVarp), PathVarp)
 			if ret == nil {
 				return 0
@@ -268,7 +276,7 @@ func (x *SettingsBackendClass) GetGetPermission() func(*SettingsBackend, string)
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, PathVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetPermission)
+	core.RegisterFunc(&rawCallback, x.xGetPermission)
 	return func(BackendVar *SettingsBackend, PathVar string) *Permission {
 		rawRet := rawCallback(BackendVar.GoPointer(), PathVar)
 		if rawRet == 0 {
@@ -286,7 +294,8 @@ func (x *SettingsBackendClass) OverrideReadUserValue(cb func(*SettingsBackend, s
 	if cb == nil {
 		x.xReadUserValue = 0
 	} else {
-		x.xReadUserValue = purego.NewCallback(func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant {
+		x.xReadUserValue = core.NewCallback(func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(SettingsBackendNewFromInternalPtr(BackendVarp), KeyVarp, ExpectedTypeVarp)
 		})
 	}
@@ -299,7 +308,7 @@ func (x *SettingsBackendClass) GetReadUserValue() func(*SettingsBackend, string,
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr, KeyVarp string, ExpectedTypeVarp *glib.VariantType) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xReadUserValue)
+	core.RegisterFunc(&rawCallback, x.xReadUserValue)
 	return func(BackendVar *SettingsBackend, KeyVar string, ExpectedTypeVar *glib.VariantType) *glib.Variant {
 		return rawCallback(BackendVar.GoPointer(), KeyVar, ExpectedTypeVar)
 	}
@@ -314,48 +323,44 @@ func (x *SettingsBackendPrivate) GoPointer() uintptr {
 }
 
 const (
-	// Extension point for #GSettingsBackend functionality.
+	// Extension point for [SettingsBackend] functionality.
 	SETTINGS_BACKEND_EXTENSION_POINT_NAME string = "gsettings-backend"
 )
 
 var xKeyfileSettingsBackendNew func(string, string, uintptr) uintptr
 
-// Creates a keyfile-backed [class@Gio.SettingsBackend].
+// Creates a keyfile-backed [SettingsBackend].
 //
-// The filename of the keyfile to use is given by @filename.
+// The filename of the keyfile to use is given by filename.
 //
 // All settings read to or written from the backend must fall under the
-// path given in @root_path (which must start and end with a slash and
-// not contain two consecutive slashes).  @root_path may be `"/"`.
+// path given in root_path (which must start and end with a slash and
+// not contain two consecutive slashes).  root_path may be `"/"`.
 //
-// If @root_group is non-`NULL` then it specifies the name of the keyfile
-// group used for keys that are written directly below @root_path.  For
-// example, if @root_path is `"/apps/example/"` and @root_group is
+// If root_group is non-`NULL` then it specifies the name of the keyfile
+// group used for keys that are written directly below root_path.  For
+// example, if root_path is `"/apps/example/"` and root_group is
 // `"toplevel"`, then setting the key `"/apps/example/enabled"` to true will
 // cause the following to appear in the keyfile:
 //
-// ```
-// [toplevel]
-// enabled=true
-// ```
+//	[toplevel]
+//	enabled=true
 //
-// If @root_group is `NULL` then it is not permitted to store keys
-// directly below the @root_path.
+// If root_group is `NULL` then it is not permitted to store keys
+// directly below the root_path.
 //
-// For keys not stored directly below @root_path (ie: in a sub-path),
+// For keys not stored directly below root_path (ie: in a sub-path),
 // the name of the subpath (with the final slash stripped) is used as
 // the name of the keyfile group.  To continue the example, if
 // `"/apps/example/profiles/default/font-size"` were set to
 // `12` then the following would appear in the keyfile:
 //
-// ```
-// [profiles/default]
-// font-size=12
-// ```
+//	[profiles/default]
+//	font-size=12
 //
 // The backend will refuse writes (and return writability as being
-// false) for keys outside of @root_path and, in the event that
-// @root_group is `NULL`, also for keys directly under @root_path.
+// false) for keys outside of root_path and, in the event that
+// root_group is `NULL`, also for keys directly under root_path.
 // Writes will also be refused if the backend detects that it has the
 // inability to rewrite the keyfile (ie: the containing directory is not
 // writable).
@@ -387,7 +392,7 @@ func KeyfileSettingsBackendNew(FilenameVar string, RootPathVar string, RootGroup
 
 var xMemorySettingsBackendNew func() uintptr
 
-// Creates a memory-backed #GSettingsBackend.
+// Creates a memory-backed [SettingsBackend].
 //
 // This backend allows changes to settings, but does not write them
 // to any backing storage, so the next time you run your application,
@@ -407,7 +412,7 @@ func MemorySettingsBackendNew() *SettingsBackend {
 
 var xNullSettingsBackendNew func() uintptr
 
-// Creates a readonly #GSettingsBackend.
+// Creates a readonly [SettingsBackend].
 //
 // This backend does not allow changes to settings, so all settings
 // will always have their default values.
@@ -426,7 +431,7 @@ func NullSettingsBackendNew() *SettingsBackend {
 
 // The `GSettingsBackend` interface defines a generic interface for
 // non-strictly-typed data that is stored in a hierarchy. To implement
-// an alternative storage backend for [class@Gio.Settings], you need to
+// an alternative storage backend for [Settings], you need to
 // implement the `GSettingsBackend` interface and then make it implement the
 // extension point `G_SETTINGS_BACKEND_EXTENSION_POINT_NAME`.
 //
@@ -439,8 +444,8 @@ func NullSettingsBackendNew() *SettingsBackend {
 // callers that are documented on each of the interface methods.
 //
 // Some of the `GSettingsBackend` functions accept or return a
-// [struct@GLib.Tree]. These trees always have strings as keys and
-// [struct@GLib.Variant] as values.
+// [glib.Tree]. These trees always have strings as keys and
+// [glib.Variant] as values.
 //
 // The `GSettingsBackend` API is exported to allow third-party
 // implementations, but does not carry the same stability guarantees
@@ -469,7 +474,7 @@ var xSettingsBackendChanged func(uintptr, string, uintptr)
 // implementations should call this if a key has possibly changed its
 // value.
 //
-// @key must be a valid key (ie starting with a slash, not containing
+// key must be a valid key (ie starting with a slash, not containing
 // '//', and not ending with a slash).
 //
 // The implementation must call this function during any call to
@@ -485,7 +490,7 @@ var xSettingsBackendChanged func(uintptr, string, uintptr)
 // g_settings_backend_write()).
 //
 // In the case that this call is in response to a call to
-// g_settings_backend_write() then @origin_tag must be set to the same
+// g_settings_backend_write() then origin_tag must be set to the same
 // value that was passed to that call.
 func (x *SettingsBackend) Changed(KeyVar string, OriginTagVar uintptr) {
 
@@ -496,8 +501,8 @@ func (x *SettingsBackend) Changed(KeyVar string, OriginTagVar uintptr) {
 var xSettingsBackendChangedTree func(uintptr, *glib.Tree, uintptr)
 
 // This call is a convenience wrapper.  It gets the list of changes from
-// @tree, computes the longest common prefix and calls
-// g_settings_backend_changed().
+// tree, computes the longest common prefix and calls
+// [SettingsBackend.Changed].
 func (x *SettingsBackend) ChangedTree(TreeVar *glib.Tree, OriginTagVar uintptr) {
 
 	xSettingsBackendChangedTree(x.GoPointer(), TreeVar, OriginTagVar)
@@ -510,21 +515,21 @@ var xSettingsBackendKeysChanged func(uintptr, string, []string, uintptr)
 // implementations should call this if keys have possibly changed their
 // values.
 //
-// @path must be a valid path (ie starting and ending with a slash and
-// not containing '//').  Each string in @items must form a valid key
-// name when @path is prefixed to it (ie: each item must not start or
+// path must be a valid path (ie starting and ending with a slash and
+// not containing '//').  Each string in items must form a valid key
+// name when path is prefixed to it (ie: each item must not start or
 // end with '/' and must not contain '//').
 //
 // The meaning of this signal is that any of the key names resulting
-// from the concatenation of @path with each item in @items may have
+// from the concatenation of path with each item in items may have
 // changed.
 //
 // The same rules for when notifications must occur apply as per
-// g_settings_backend_changed().  These two calls can be used
+// [SettingsBackend.Changed].  These two calls can be used
 // interchangeably if exactly one item has changed (although in that
-// case g_settings_backend_changed() is definitely preferred).
+// case [SettingsBackend.Changed] is definitely preferred).
 //
-// For efficiency reasons, the implementation should strive for @path to
+// For efficiency reasons, the implementation should strive for path to
 // be as long as possible (ie: the longest common prefix of all of the
 // keys that were changed) but this is not strictly required.
 func (x *SettingsBackend) KeysChanged(PathVar string, ItemsVar []string, OriginTagVar uintptr) {
@@ -539,19 +544,19 @@ var xSettingsBackendPathChanged func(uintptr, string, uintptr)
 // Backend implementations should call this if an entire path of keys
 // have possibly changed their values.
 //
-// @path must be a valid path (ie starting and ending with a slash and
+// path must be a valid path (ie starting and ending with a slash and
 // not containing '//').
 //
 // The meaning of this signal is that any of the key which has a name
-// starting with @path may have changed.
+// starting with path may have changed.
 //
 // The same rules for when notifications must occur apply as per
-// g_settings_backend_changed().  This call might be an appropriate
+// [SettingsBackend.Changed].  This call might be an appropriate
 // reasponse to a 'reset' call but implementations are also free to
 // explicitly list the keys that were affected by that call if they can
 // easily do so.
 //
-// For efficiency reasons, the implementation should strive for @path to
+// For efficiency reasons, the implementation should strive for path to
 // be as long as possible (ie: the longest common prefix of all of the
 // keys that were changed) but this is not strictly required.  As an
 // example, if this function is called with the path of "/" then every
@@ -604,9 +609,9 @@ var xSettingsBackendFlattenTree func(*glib.Tree, *string, *[]string, *uintptr)
 // out an array of the key names relative to that prefix and,
 // optionally, the value to store at each of those keys.
 //
-// You must free the value returned in @path, @keys and @values using
-// g_free().  You should not attempt to free or unref the contents of
-// @keys or @values.
+// You must free the value returned in path, keys and values using
+// [glib.Free].  You should not attempt to free or unref the contents of
+// keys or values.
 func SettingsBackendFlattenTree(TreeVar *glib.Tree, PathVar *string, KeysVar *[]string, ValuesVar *uintptr) {
 
 	xSettingsBackendFlattenTree(TreeVar, PathVar, KeysVar, ValuesVar)
@@ -615,7 +620,7 @@ func SettingsBackendFlattenTree(TreeVar *glib.Tree, PathVar *string, KeysVar *[]
 
 var xSettingsBackendGetDefault func() uintptr
 
-// Returns the default #GSettingsBackend. It is possible to override
+// Returns the default [SettingsBackend]. It is possible to override
 // the default by setting the `GSETTINGS_BACKEND` environment variable
 // to the name of a settings backend.
 //
@@ -638,27 +643,29 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xKeyfileSettingsBackendNew, libs, "g_keyfile_settings_backend_new")
-	core.PuregoSafeRegister(&xMemorySettingsBackendNew, libs, "g_memory_settings_backend_new")
-	core.PuregoSafeRegister(&xNullSettingsBackendNew, libs, "g_null_settings_backend_new")
+	core.PuregoRegisterSince(&xKeyfileSettingsBackendNew, libs, "g_keyfile_settings_backend_new", "GIO", "")
+	core.PuregoRegisterSince(&xMemorySettingsBackendNew, libs, "g_memory_settings_backend_new", "GIO", "2.28")
+	core.PuregoRegisterSince(&xNullSettingsBackendNew, libs, "g_null_settings_backend_new", "GIO", "2.28")
 
 	core.PuregoSafeRegister(&xSettingsBackendGLibType, libs, "g_settings_backend_get_type")
 
-	core.PuregoSafeRegister(&xSettingsBackendChanged, libs, "g_settings_backend_changed")
-	core.PuregoSafeRegister(&xSettingsBackendChangedTree, libs, "g_settings_backend_changed_tree")
-	core.PuregoSafeRegister(&xSettingsBackendKeysChanged, libs, "g_settings_backend_keys_changed")
-	core.PuregoSafeRegister(&xSettingsBackendPathChanged, libs, "g_settings_backend_path_changed")
-	core.PuregoSafeRegister(&xSettingsBackendPathWritableChanged, libs, "g_settings_backend_path_writable_changed")
-	core.PuregoSafeRegister(&xSettingsBackendWritableChanged, libs, "g_settings_backend_writable_changed")
+	gobject.CheckClassSize("GSettingsBackendClass", xSettingsBackendGLibType, unsafe.Sizeof(SettingsBackendClass{}))
 
-	core.PuregoSafeRegister(&xSettingsBackendFlattenTree, libs, "g_settings_backend_flatten_tree")
-	core.PuregoSafeRegister(&xSettingsBackendGetDefault, libs, "g_settings_backend_get_default")
+	core.PuregoRegisterSince(&xSettingsBackendChanged, libs, "g_settings_backend_changed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendChangedTree, libs, "g_settings_backend_changed_tree", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendKeysChanged, libs, "g_settings_backend_keys_changed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendPathChanged, libs, "g_settings_backend_path_changed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendPathWritableChanged, libs, "g_settings_backend_path_writable_changed", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendWritableChanged, libs, "g_settings_backend_writable_changed", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xSettingsBackendFlattenTree, libs, "g_settings_backend_flatten_tree", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSettingsBackendGetDefault, libs, "g_settings_backend_get_default", "GIO", "2.28")
 
 }
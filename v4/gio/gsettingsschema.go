@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The [struct@Gio.SettingsSchemaSource] and `GSettingsSchema` APIs provide a
+// The [SettingsSchemaSource] and `GSettingsSchema` APIs provide a
 // mechanism for advanced control over the loading of schemas and a
 // mechanism for introspecting their content.
 //
@@ -21,7 +20,7 @@ import (
 // the schema along with itself and it won't be installed into the
 // standard system directories for schemas.
 //
-// [struct@Gio.SettingsSchemaSource] provides a mechanism for dealing with this
+// [SettingsSchemaSource] provides a mechanism for dealing with this
 // by allowing the creation of a new ‘schema source’ from which schemas can
 // be acquired.  This schema source can then become part of the metadata
 // associated with the plugin and queried whenever the plugin requires
@@ -29,24 +28,21 @@ import (
 //
 // Consider the following example:
 //
-// ```c
-// typedef struct
-//
+//	typedef struct
 //	{
 //	   …
 //	   GSettingsSchemaSource *schema_source;
 //	   …
 //	} Plugin;
 //
-// Plugin *
-// initialise_plugin (const gchar *dir)
-//
+//	Plugin *
+//	initialise_plugin (const gchar *dir)
 //	{
 //	  Plugin *plugin;
 //
 //	  …
 //
-//	  plugin-&gt;schema_source =
+//	  plugin->schema_source =
 //	    g_settings_schema_source_new_from_directory (dir,
 //	      g_settings_schema_source_get_default (), FALSE, NULL);
 //
@@ -55,20 +51,18 @@ import (
 //	  return plugin;
 //	}
 //
-// …
-//
-// GSettings *
-// plugin_get_settings (Plugin      *plugin,
-//
-//	const gchar *schema_id)
+//	…
 //
+//	GSettings *
+//	plugin_get_settings (Plugin      *plugin,
+//	                     const gchar *schema_id)
 //	{
 //	  GSettingsSchema *schema;
 //
 //	  if (schema_id == NULL)
-//	    schema_id = plugin-&gt;identifier;
+//	    schema_id = plugin->identifier;
 //
-//	  schema = g_settings_schema_source_lookup (plugin-&gt;schema_source,
+//	  schema = g_settings_schema_source_lookup (plugin->schema_source,
 //	                                            schema_id, FALSE);
 //
 //	  if (schema == NULL)
@@ -79,8 +73,6 @@ import (
 //	  return g_settings_new_full (schema, NULL, NULL);
 //	}
 //
-// ```
-//
 // The code above shows how hooks should be added to the code that
 // initialises (or enables) the plugin to create the schema source and
 // how an API can be added to the plugin system to provide a convenient
@@ -91,8 +83,6 @@ import (
 // ships a gschemas.compiled file as part of itself, and then simply do
 // the following:
 //
-// ```c
-//
 //	{
 //	  GSettings *settings;
 //	  gint some_value;
@@ -102,8 +92,6 @@ import (
 //	  …
 //	}
 //
-// ```
-//
 // It's also possible that the plugin system expects the schema source
 // files (ie: `.gschema.xml` files) instead of a `gschemas.compiled` file.
 // In that case, the plugin loading system must compile the schemas for
@@ -124,7 +112,7 @@ func (x *SettingsSchema) GoPointer() uintptr {
 
 var xSettingsSchemaGetId func(uintptr) string
 
-// Get the ID of @schema.
+// Get the ID of schema.
 func (x *SettingsSchema) GetId() string {
 
 	cret := xSettingsSchemaGetId(x.GoPointer())
@@ -133,10 +121,10 @@ func (x *SettingsSchema) GetId() string {
 
 var xSettingsSchemaGetKey func(uintptr, string) *SettingsSchemaKey
 
-// Gets the key named @name from @schema.
+// Gets the key named name from schema.
 //
 // It is a programmer error to request a key that does not exist.  See
-// g_settings_schema_list_keys().
+// [SettingsSchema.ListKeys].
 func (x *SettingsSchema) GetKey(NameVar string) *SettingsSchemaKey {
 
 	cret := xSettingsSchemaGetKey(x.GoPointer(), NameVar)
@@ -145,7 +133,7 @@ func (x *SettingsSchema) GetKey(NameVar string) *SettingsSchemaKey {
 
 var xSettingsSchemaGetPath func(uintptr) string
 
-// Gets the path associated with @schema, or %NULL.
+// Gets the path associated with schema, or nil.
 //
 // Schemas may be single-instance or relocatable.  Single-instance
 // schemas correspond to exactly one set of keys in the backend
@@ -153,7 +141,7 @@ var xSettingsSchemaGetPath func(uintptr) string
 //
 // Relocatable schemas can be referenced by other schemas and can
 // therefore describe multiple sets of keys at different locations.  For
-// relocatable schemas, this function will return %NULL.
+// relocatable schemas, this function will return nil.
 func (x *SettingsSchema) GetPath() string {
 
 	cret := xSettingsSchemaGetPath(x.GoPointer())
@@ -162,28 +150,28 @@ func (x *SettingsSchema) GetPath() string {
 
 var xSettingsSchemaHasKey func(uintptr, string) bool
 
-// Checks if @schema has a key named @name.
+// Checks if schema has a key named name.
 func (x *SettingsSchema) HasKey(NameVar string) bool {
 
 	cret := xSettingsSchemaHasKey(x.GoPointer(), NameVar)
 	return cret
 }
 
-var xSettingsSchemaListChildren func(uintptr) []string
+var xSettingsSchemaListChildren func(uintptr) uintptr
 
-// Gets the list of children in @schema.
+// Gets the list of children in schema.
 //
-// You should free the return value with g_strfreev() when you are done
+// You should free the return value with [glib.Strfreev] when you are done
 // with it.
 func (x *SettingsSchema) ListChildren() []string {
 
 	cret := xSettingsSchemaListChildren(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xSettingsSchemaListKeys func(uintptr) []string
+var xSettingsSchemaListKeys func(uintptr) uintptr
 
-// Introspects the list of keys on @schema.
+// Introspects the list of keys on schema.
 //
 // You should probably not be calling this function from "normal" code
 // (since you should already know what keys are in your schema).  This
@@ -191,12 +179,12 @@ var xSettingsSchemaListKeys func(uintptr) []string
 func (x *SettingsSchema) ListKeys() []string {
 
 	cret := xSettingsSchemaListKeys(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xSettingsSchemaRef func(uintptr) *SettingsSchema
 
-// Increase the reference count of @schema, returning a new reference.
+// Increase the reference count of schema, returning a new reference.
 func (x *SettingsSchema) Ref() *SettingsSchema {
 
 	cret := xSettingsSchemaRef(x.GoPointer())
@@ -205,14 +193,14 @@ func (x *SettingsSchema) Ref() *SettingsSchema {
 
 var xSettingsSchemaUnref func(uintptr)
 
-// Decrease the reference count of @schema, possibly freeing it.
+// Decrease the reference count of schema, possibly freeing it.
 func (x *SettingsSchema) Unref() {
 
 	xSettingsSchemaUnref(x.GoPointer())
 
 }
 
-// #GSettingsSchemaKey is an opaque data structure and can only be accessed
+// [SettingsSchemaKey] is an opaque data structure and can only be accessed
 // using the following functions.
 type SettingsSchemaKey struct {
 	_ structs.HostLayout
@@ -230,7 +218,7 @@ func (x *SettingsSchemaKey) GoPointer() uintptr {
 
 var xSettingsSchemaKeyGetDefaultValue func(uintptr) *glib.Variant
 
-// Gets the default value for @key.
+// Gets the default value for key.
 //
 // Note that this is the default value according to the schema.  System
 // administrator defaults and lockdown are not visible via this API.
@@ -242,10 +230,10 @@ func (x *SettingsSchemaKey) GetDefaultValue() *glib.Variant {
 
 var xSettingsSchemaKeyGetDescription func(uintptr) string
 
-// Gets the description for @key.
+// Gets the description for key.
 //
-// If no description has been provided in the schema for @key, returns
-// %NULL.
+// If no description has been provided in the schema for key, returns
+// nil.
 //
 // The description can be one sentence to several paragraphs in length.
 // Paragraphs are delimited with a double newline.  Descriptions can be
@@ -264,7 +252,7 @@ func (x *SettingsSchemaKey) GetDescription() string {
 
 var xSettingsSchemaKeyGetName func(uintptr) string
 
-// Gets the name of @key.
+// Gets the name of key.
 func (x *SettingsSchemaKey) GetName() string {
 
 	cret := xSettingsSchemaKeyGetName(x.GoPointer())
@@ -275,10 +263,10 @@ var xSettingsSchemaKeyGetRange func(uintptr) *glib.Variant
 
 // Queries the range of a key.
 //
-// This function will return a #GVariant that fully describes the range
-// of values that are valid for @key.
+// This function will return a [glib.Variant] that fully describes the range
+// of values that are valid for key.
 //
-// The type of #GVariant returned is `(sv)`. The string describes
+// The type of [glib.Variant] returned is `(sv)`. The string describes
 // the type of range restriction in effect. The type and meaning of
 // the value contained in the variant depends on the string.
 //
@@ -307,7 +295,7 @@ var xSettingsSchemaKeyGetRange func(uintptr) *glib.Variant
 // format may change in any way in the future -- but particularly, new
 // forms may be added to the possibilities described above.
 //
-// You should free the returned value with g_variant_unref() when it is
+// You should free the returned value with [glib.Variant.Unref] when it is
 // no longer needed.
 func (x *SettingsSchemaKey) GetRange() *glib.Variant {
 
@@ -317,10 +305,10 @@ func (x *SettingsSchemaKey) GetRange() *glib.Variant {
 
 var xSettingsSchemaKeyGetSummary func(uintptr) string
 
-// Gets the summary for @key.
+// Gets the summary for key.
 //
-// If no summary has been provided in the schema for @key, returns
-// %NULL.
+// If no summary has been provided in the schema for key, returns
+// nil.
 //
 // The summary is a short description of the purpose of the key; usually
 // one short sentence.  Summaries can be translated and the value
@@ -338,7 +326,7 @@ func (x *SettingsSchemaKey) GetSummary() string {
 
 var xSettingsSchemaKeyGetValueType func(uintptr) *glib.VariantType
 
-// Gets the #GVariantType of @key.
+// Gets the [glib.VariantType] of key.
 func (x *SettingsSchemaKey) GetValueType() *glib.VariantType {
 
 	cret := xSettingsSchemaKeyGetValueType(x.GoPointer())
@@ -347,10 +335,10 @@ func (x *SettingsSchemaKey) GetValueType() *glib.VariantType {
 
 var xSettingsSchemaKeyRangeCheck func(uintptr, *glib.Variant) bool
 
-// Checks if the given @value is within the
-// permitted range for @key.
+// Checks if the given value is within the
+// permitted range for key.
 //
-// It is a programmer error if @value is not of the correct type — you
+// It is a programmer error if value is not of the correct type — you
 // must check for this first.
 func (x *SettingsSchemaKey) RangeCheck(ValueVar *glib.Variant) bool {
 
@@ -360,7 +348,7 @@ func (x *SettingsSchemaKey) RangeCheck(ValueVar *glib.Variant) bool {
 
 var xSettingsSchemaKeyRef func(uintptr) *SettingsSchemaKey
 
-// Increase the reference count of @key, returning a new reference.
+// Increase the reference count of key, returning a new reference.
 func (x *SettingsSchemaKey) Ref() *SettingsSchemaKey {
 
 	cret := xSettingsSchemaKeyRef(x.GoPointer())
@@ -369,7 +357,7 @@ func (x *SettingsSchemaKey) Ref() *SettingsSchemaKey {
 
 var xSettingsSchemaKeyUnref func(uintptr)
 
-// Decrease the reference count of @key, possibly freeing it.
+// Decrease the reference count of key, possibly freeing it.
 func (x *SettingsSchemaKey) Unref() {
 
 	xSettingsSchemaKeyUnref(x.GoPointer())
@@ -396,40 +384,40 @@ var xNewSettingsSchemaSourceFromDirectory func(string, *SettingsSchemaSource, bo
 // Attempts to create a new schema source corresponding to the contents
 // of the given directory.
 //
-// This function is not required for normal uses of #GSettings but it
+// This function is not required for normal uses of [Settings] but it
 // may be useful to authors of plugin management systems.
 //
 // The directory should contain a file called `gschemas.compiled` as
 // produced by the [glib-compile-schemas][glib-compile-schemas] tool.
 //
-// If @trusted is %TRUE then `gschemas.compiled` is trusted not to be
+// If trusted is true then `gschemas.compiled` is trusted not to be
 // corrupted. This assumption has a performance advantage, but can result
 // in crashes or inconsistent behaviour in the case of a corrupted file.
-// Generally, you should set @trusted to %TRUE for files installed by the
-// system and to %FALSE for files in the home directory.
+// Generally, you should set trusted to true for files installed by the
+// system and to false for files in the home directory.
 //
 // In either case, an empty file or some types of corruption in the file will
-// result in %G_FILE_ERROR_INVAL being returned.
+// result in [glib.GFileErrorInvalValue] being returned.
 //
-// If @parent is non-%NULL then there are two effects.
+// If parent is non-nil then there are two effects.
 //
-// First, if g_settings_schema_source_lookup() is called with the
-// @recursive flag set to %TRUE and the schema can not be found in the
+// First, if [SettingsSchemaSource.Lookup] is called with the
+// recursive flag set to true and the schema can not be found in the
 // source, the lookup will recurse to the parent.
 //
 // Second, any references to other schemas specified within this
 // source (ie: `child` or `extends`) references may be resolved
-// from the @parent.
+// from the parent.
 //
 // For this second reason, except in very unusual situations, the
-// @parent should probably be given as the default schema source, as
-// returned by g_settings_schema_source_get_default().
+// parent should probably be given as the default schema source, as
+// returned by [SettingsSchemaSourceGetDefault].
 func NewSettingsSchemaSourceFromDirectory(DirectoryVar string, ParentVar *SettingsSchemaSource, TrustedVar bool) (*SettingsSchemaSource, error) {
 	var cerr *glib.Error
 
 	cret := xNewSettingsSchemaSourceFromDirectory(DirectoryVar, ParentVar, TrustedVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -439,13 +427,13 @@ var xSettingsSchemaSourceListSchemas func(uintptr, bool, *[]string, *[]string)
 
 // Lists the schemas in a given source.
 //
-// If @recursive is %TRUE then include parent sources.  If %FALSE then
+// If recursive is true then include parent sources.  If false then
 // only include the schemas from one source (ie: one directory).  You
-// probably want %TRUE.
+// probably want true.
 //
 // Non-relocatable schemas are those for which you can call
-// g_settings_new().  Relocatable schemas are those for which you must
-// use g_settings_new_with_path().
+// [NewSettings].  Relocatable schemas are those for which you must
+// use [NewSettingsWithPath].
 //
 // Do not call this function from normal programs.  This is designed for
 // use by database editors, commandline tools, etc.
@@ -457,16 +445,16 @@ func (x *SettingsSchemaSource) ListSchemas(RecursiveVar bool, NonRelocatableVar
 
 var xSettingsSchemaSourceLookup func(uintptr, string, bool) *SettingsSchema
 
-// Looks up a schema with the identifier @schema_id in @source.
+// Looks up a schema with the identifier schema_id in source.
 //
-// This function is not required for normal uses of #GSettings but it
+// This function is not required for normal uses of [Settings] but it
 // may be useful to authors of plugin management systems or to those who
 // want to introspect the content of schemas.
 //
-// If the schema isn't found directly in @source and @recursive is %TRUE
+// If the schema isn't found directly in source and recursive is true
 // then the parent sources will also be checked.
 //
-// If the schema isn't found, %NULL is returned.
+// If the schema isn't found, nil is returned.
 func (x *SettingsSchemaSource) Lookup(SchemaIdVar string, RecursiveVar bool) *SettingsSchema {
 
 	cret := xSettingsSchemaSourceLookup(x.GoPointer(), SchemaIdVar, RecursiveVar)
@@ -475,7 +463,7 @@ func (x *SettingsSchemaSource) Lookup(SchemaIdVar string, RecursiveVar bool) *Se
 
 var xSettingsSchemaSourceRef func(uintptr) *SettingsSchemaSource
 
-// Increase the reference count of @source, returning a new reference.
+// Increase the reference count of source, returning a new reference.
 func (x *SettingsSchemaSource) Ref() *SettingsSchemaSource {
 
 	cret := xSettingsSchemaSourceRef(x.GoPointer())
@@ -484,7 +472,7 @@ func (x *SettingsSchemaSource) Ref() *SettingsSchemaSource {
 
 var xSettingsSchemaSourceUnref func(uintptr)
 
-// Decrease the reference count of @source, possibly freeing it.
+// Decrease the reference count of source, possibly freeing it.
 func (x *SettingsSchemaSource) Unref() {
 
 	xSettingsSchemaSourceUnref(x.GoPointer())
@@ -495,11 +483,11 @@ var xSettingsSchemaSourceGetDefault func() *SettingsSchemaSource
 
 // Gets the default system schema source.
 //
-// This function is not required for normal uses of #GSettings but it
+// This function is not required for normal uses of [Settings] but it
 // may be useful to authors of plugin management systems or to those who
 // want to introspect the content of schemas.
 //
-// If no schemas are installed, %NULL will be returned.
+// If no schemas are installed, nil will be returned.
 //
 // The returned source may actually consist of multiple schema sources
 // from different directories, depending on which directories were given
@@ -517,45 +505,45 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSettingsSchemaSourceGetDefault, libs, "g_settings_schema_source_get_default")
+	core.PuregoRegisterSince(&xSettingsSchemaSourceGetDefault, libs, "g_settings_schema_source_get_default", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xSettingsSchemaGLibType, libs, "g_settings_schema_get_type")
 
-	core.PuregoSafeRegister(&xSettingsSchemaGetId, libs, "g_settings_schema_get_id")
-	core.PuregoSafeRegister(&xSettingsSchemaGetKey, libs, "g_settings_schema_get_key")
-	core.PuregoSafeRegister(&xSettingsSchemaGetPath, libs, "g_settings_schema_get_path")
-	core.PuregoSafeRegister(&xSettingsSchemaHasKey, libs, "g_settings_schema_has_key")
-	core.PuregoSafeRegister(&xSettingsSchemaListChildren, libs, "g_settings_schema_list_children")
-	core.PuregoSafeRegister(&xSettingsSchemaListKeys, libs, "g_settings_schema_list_keys")
-	core.PuregoSafeRegister(&xSettingsSchemaRef, libs, "g_settings_schema_ref")
-	core.PuregoSafeRegister(&xSettingsSchemaUnref, libs, "g_settings_schema_unref")
+	core.PuregoRegisterSince(&xSettingsSchemaGetId, libs, "g_settings_schema_get_id", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsSchemaGetKey, libs, "g_settings_schema_get_key", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaGetPath, libs, "g_settings_schema_get_path", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsSchemaHasKey, libs, "g_settings_schema_has_key", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaListChildren, libs, "g_settings_schema_list_children", "GIO", "2.44")
+	core.PuregoRegisterSince(&xSettingsSchemaListKeys, libs, "g_settings_schema_list_keys", "GIO", "2.46")
+	core.PuregoRegisterSince(&xSettingsSchemaRef, libs, "g_settings_schema_ref", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsSchemaUnref, libs, "g_settings_schema_unref", "GIO", "2.32")
 
 	core.PuregoSafeRegister(&xSettingsSchemaKeyGLibType, libs, "g_settings_schema_key_get_type")
 
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetDefaultValue, libs, "g_settings_schema_key_get_default_value")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetDescription, libs, "g_settings_schema_key_get_description")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetName, libs, "g_settings_schema_key_get_name")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetRange, libs, "g_settings_schema_key_get_range")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetSummary, libs, "g_settings_schema_key_get_summary")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyGetValueType, libs, "g_settings_schema_key_get_value_type")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyRangeCheck, libs, "g_settings_schema_key_range_check")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyRef, libs, "g_settings_schema_key_ref")
-	core.PuregoSafeRegister(&xSettingsSchemaKeyUnref, libs, "g_settings_schema_key_unref")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetDefaultValue, libs, "g_settings_schema_key_get_default_value", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetDescription, libs, "g_settings_schema_key_get_description", "GIO", "2.34")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetName, libs, "g_settings_schema_key_get_name", "GIO", "2.44")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetRange, libs, "g_settings_schema_key_get_range", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetSummary, libs, "g_settings_schema_key_get_summary", "GIO", "2.34")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyGetValueType, libs, "g_settings_schema_key_get_value_type", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyRangeCheck, libs, "g_settings_schema_key_range_check", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyRef, libs, "g_settings_schema_key_ref", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaKeyUnref, libs, "g_settings_schema_key_unref", "GIO", "2.40")
 
 	core.PuregoSafeRegister(&xSettingsSchemaSourceGLibType, libs, "g_settings_schema_source_get_type")
 
-	core.PuregoSafeRegister(&xNewSettingsSchemaSourceFromDirectory, libs, "g_settings_schema_source_new_from_directory")
+	core.PuregoRegisterSince(&xNewSettingsSchemaSourceFromDirectory, libs, "g_settings_schema_source_new_from_directory", "GIO", "2.32")
 
-	core.PuregoSafeRegister(&xSettingsSchemaSourceListSchemas, libs, "g_settings_schema_source_list_schemas")
-	core.PuregoSafeRegister(&xSettingsSchemaSourceLookup, libs, "g_settings_schema_source_lookup")
-	core.PuregoSafeRegister(&xSettingsSchemaSourceRef, libs, "g_settings_schema_source_ref")
-	core.PuregoSafeRegister(&xSettingsSchemaSourceUnref, libs, "g_settings_schema_source_unref")
+	core.PuregoRegisterSince(&xSettingsSchemaSourceListSchemas, libs, "g_settings_schema_source_list_schemas", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSettingsSchemaSourceLookup, libs, "g_settings_schema_source_lookup", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsSchemaSourceRef, libs, "g_settings_schema_source_ref", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSettingsSchemaSourceUnref, libs, "g_settings_schema_source_unref", "GIO", "2.32")
 
 }
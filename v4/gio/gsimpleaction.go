@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,8 +11,8 @@ import (
 )
 
 // A `GSimpleAction` is the obvious simple implementation of the
-// [iface@Gio.Action] interface. This is the easiest way to create an action for
-// purposes of adding it to a [class@Gio.SimpleActionGroup].
+// [Action] interface. This is the easiest way to create an action for
+// purposes of adding it to a [SimpleActionGroup].
 type SimpleAction struct {
 	gobject.Object
 }
@@ -34,7 +33,7 @@ var xNewSimpleAction func(string, *glib.VariantType) uintptr
 
 // Creates a new action.
 //
-// The created action is stateless. See g_simple_action_new_stateful() to create
+// The created action is stateless. See [NewSimpleActionStateful] to create
 // an action that has state.
 func NewSimpleAction(NameVar string, ParameterTypeVar *glib.VariantType) *SimpleAction {
 	var cls *SimpleAction
@@ -53,10 +52,10 @@ var xNewSimpleActionStateful func(string, *glib.VariantType, *glib.Variant) uint
 
 // Creates a new stateful action.
 //
-// All future state values must have the same #GVariantType as the initial
-// @state.
+// All future state values must have the same [glib.VariantType] as the initial
+// state.
 //
-// If the @state #GVariant is floating, it is consumed.
+// If the state [glib.Variant] is floating, it is consumed.
 func NewSimpleActionStateful(NameVar string, ParameterTypeVar *glib.VariantType, StateVar *glib.Variant) *SimpleAction {
 	var cls *SimpleAction
 
@@ -93,10 +92,10 @@ var xSimpleActionSetState func(uintptr, *glib.Variant)
 //
 // This should only be called by the implementor of the action.  Users
 // of the action should not attempt to directly modify the 'state'
-// property.  Instead, they should call g_action_change_state() to
+// property.  Instead, they should call [Action.ChangeState] to
 // request the change.
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *SimpleAction) SetState(ValueVar *glib.Variant) {
 
 	xSimpleActionSetState(x.GoPointer(), ValueVar)
@@ -107,7 +106,7 @@ var xSimpleActionSetStateHint func(uintptr, *glib.Variant)
 
 // Sets the state hint for the action.
 //
-// See g_action_get_state_hint() for more information about
+// See [Action.GetStateHint] for more information about
 // action state hints.
 func (x *SimpleAction) SetStateHint(StateHintVar *glib.Variant) {
 
@@ -127,10 +126,10 @@ func (c *SimpleAction) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyEnabled sets the "enabled" property.
-// If @action is currently enabled.
+// If action is currently enabled.
 //
-// If the action is disabled then calls to g_action_activate() and
-// g_action_change_state() have no effect.
+// If the action is disabled then calls to [Action.Activate] and
+// [Action.ChangeState] have no effect.
 func (x *SimpleAction) SetPropertyEnabled(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -139,10 +138,10 @@ func (x *SimpleAction) SetPropertyEnabled(value bool) {
 }
 
 // GetPropertyEnabled gets the "enabled" property.
-// If @action is currently enabled.
+// If action is currently enabled.
 //
-// If the action is disabled then calls to g_action_activate() and
-// g_action_change_state() have no effect.
+// If the action is disabled then calls to [Action.Activate] and
+// [Action.ChangeState] have no effect.
 func (x *SimpleAction) GetPropertyEnabled() bool {
 	var v gobject.Value
 	x.GetProperty("enabled", &v)
@@ -151,7 +150,7 @@ func (x *SimpleAction) GetPropertyEnabled() bool {
 
 // SetPropertyName sets the "name" property.
 // The name of the action. This is mostly meaningful for identifying
-// the action once it has been added to a #GSimpleActionGroup.
+// the action once it has been added to a [SimpleActionGroup].
 func (x *SimpleAction) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -161,7 +160,7 @@ func (x *SimpleAction) SetPropertyName(value string) {
 
 // GetPropertyName gets the "name" property.
 // The name of the action. This is mostly meaningful for identifying
-// the action once it has been added to a #GSimpleActionGroup.
+// the action once it has been added to a [SimpleActionGroup].
 func (x *SimpleAction) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -188,7 +187,7 @@ func (x *SimpleAction) GetPropertyParameterType() uintptr {
 }
 
 // SetPropertyState sets the "state" property.
-// The state of the action, or %NULL if the action is stateless.
+// The state of the action, or nil if the action is stateless.
 func (x *SimpleAction) SetPropertyState(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -197,7 +196,7 @@ func (x *SimpleAction) SetPropertyState(value uintptr) {
 }
 
 // GetPropertyState gets the "state" property.
-// The state of the action, or %NULL if the action is stateless.
+// The state of the action, or nil if the action is stateless.
 func (x *SimpleAction) GetPropertyState() uintptr {
 	var v gobject.Value
 	x.GetProperty("state", &v)
@@ -205,7 +204,7 @@ func (x *SimpleAction) GetPropertyState() uintptr {
 }
 
 // GetPropertyStateType gets the "state-type" property.
-// The #GVariantType of the state that the action has, or %NULL if the
+// The [glib.VariantType] of the state that the action has, or nil if the
 // action is stateless.
 func (x *SimpleAction) GetPropertyStateType() uintptr {
 	var v gobject.Value
@@ -215,129 +214,169 @@ func (x *SimpleAction) GetPropertyStateType() uintptr {
 
 // Indicates that the action was just activated.
 //
-// @parameter will always be of the expected type, i.e. the parameter type
+// parameter will always be of the expected type, i.e. the parameter type
 // specified when the action was created. If an incorrect type is given when
 // activating the action, this signal is not emitted.
 //
 // Since GLib 2.40, if no handler is connected to this signal then the
-// default behaviour for boolean-stated actions with a %NULL parameter
-// type is to toggle them via the #GSimpleAction::change-state signal.
+// default behaviour for boolean-stated actions with a nil parameter
+// type is to toggle them via the [SimpleAction.ConnectChangeStateFunc] signal.
 // For stateful actions where the state type is equal to the parameter
 // type, the default is to forward them directly to
-// #GSimpleAction::change-state.  This should allow almost all users
-// of #GSimpleAction to connect only one handler or the other.
+// [SimpleAction.ConnectChangeStateFunc].  This should allow almost all users
+// of [SimpleAction] to connect only one handler or the other.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *SimpleAction) ConnectActivate(cb *func(SimpleAction, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ParameterVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SimpleAction{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ParameterVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ParameterVarp uintptr) {
+var xSimpleActionActivateTrampoline uintptr
+
+func xSimpleActionActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ParameterVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SimpleAction, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SimpleAction{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ParameterVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SimpleAction) ConnectActivateFunc(cb func(SimpleAction, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xSimpleActionActivateTrampoline, xSimpleActionActivateNewTrampoline, cb)
 }
 
 // Indicates that the action just received a request to change its
 // state.
 //
-// @value will always be of the correct state type, i.e. the type of the
-// initial state passed to g_simple_action_new_stateful(). If an incorrect
+// value will always be of the correct state type, i.e. the type of the
+// initial state passed to [NewSimpleActionStateful]. If an incorrect
 // type is given when requesting to change the state, this signal is not
 // emitted.
 //
 // If no handler is connected to this signal then the default
-// behaviour is to call g_simple_action_set_state() to set the state
+// behaviour is to call [SimpleAction.SetState] to set the state
 // to the requested value. If you connect a signal handler then no
 // default action is taken. If the state should change then you must
-// call g_simple_action_set_state() from the handler.
+// call [SimpleAction.SetState] from the handler.
 //
 // An example of a 'change-state' handler:
-// |[&lt;!-- language="C" --&gt;
-// static void
-// change_volume_state (GSimpleAction *action,
-//
-//	GVariant      *value,
-//	gpointer       user_data)
 //
+//	static void
+//	change_volume_state (GSimpleAction *action,
+//	                     GVariant      *value,
+//	                     gpointer       user_data)
 //	{
 //	  gint requested;
 //
 //	  requested = g_variant_get_int32 (value);
 //
 //	  // Volume only goes from 0 to 10
-//	  if (0 &lt;= requested &amp;&amp; requested &lt;= 10)
+//	  if (0 <= requested && requested <= 10)
 //	    g_simple_action_set_state (action, value);
 //	}
 //
-// ]|
-//
 // The handler need not set the state to the requested value.
 // It could set it to any value at all, or take some other action.
+//
+// Deprecated: use ConnectChangeStateFunc, which also accepts method values and closures.
 func (x *SimpleAction) ConnectChangeState(cb *func(SimpleAction, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-state", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SimpleAction{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-state", cbRefPtr, cbPtr)
+}
+
+var xSimpleActionChangeStateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ValueVarp uintptr) {
+func xSimpleActionChangeStateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ValueVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SimpleAction, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SimpleAction{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-state", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeStateFunc connects cb to the "change-state" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SimpleAction) ConnectChangeStateFunc(cb func(SimpleAction, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-state", &xSimpleActionChangeStateTrampoline, xSimpleActionChangeStateNewTrampoline, cb)
 }
 
 // Activates the action.
 //
-// @parameter must be the correct type of parameter for the action (ie:
+// parameter must be the correct type of parameter for the action (ie:
 // the parameter type given at construction time).  If the parameter
-// type was `NULL` then @parameter must also be `NULL`.
+// type was `NULL` then parameter must also be `NULL`.
 //
-// If the @parameter [type@GLib.Variant] is floating, it is consumed.
+// If the parameter [glib.Variant] is floating, it is consumed.
 func (x *SimpleAction) Activate(ParameterVar *glib.Variant) {
 
 	XGActionActivate(x.GoPointer(), ParameterVar)
 
 }
 
-// Request for the state of @action to be changed to @value.
+// Request for the state of action to be changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.Action.get_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [Action.GetStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.Action.get_state_hint].
+// its state or may change its state to something other than value.
+// See [Action.GetStateHint].
 //
-// If the @value [type@GLib.Variant] is floating, it is consumed.
+// If the value [glib.Variant] is floating, it is consumed.
 func (x *SimpleAction) ChangeState(ValueVar *glib.Variant) {
 
 	XGActionChangeState(x.GoPointer(), ValueVar)
 
 }
 
-// Checks if @action is currently enabled.
+// Checks if action is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -347,7 +386,7 @@ func (x *SimpleAction) GetEnabled() bool {
 	return cret
 }
 
-// Queries the name of @action.
+// Queries the name of action.
 func (x *SimpleAction) GetName() string {
 
 	cret := XGActionGetName(x.GoPointer())
@@ -355,28 +394,28 @@ func (x *SimpleAction) GetName() string {
 }
 
 // Queries the type of the parameter that must be given when activating
-// @action.
+// action.
 //
-// When activating the action using [method@Gio.Action.activate], the
-// [type@GLib.Variant] given to that function must be of the type returned by
+// When activating the action using [Action.Activate], the
+// [glib.Variant] given to that function must be of the type returned by
 // this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 func (x *SimpleAction) GetParameterType() *glib.VariantType {
 
 	cret := XGActionGetParameterType(x.GoPointer())
 	return cret
 }
 
-// Queries the current state of @action.
+// Queries the current state of action.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.Action.get_state_type].
+// given by [Action.GetStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *SimpleAction) GetState() *glib.Variant {
 
 	cret := XGActionGetState(x.GoPointer())
@@ -384,14 +423,14 @@ func (x *SimpleAction) GetState() *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of
-// @action.
+// action.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -400,25 +439,25 @@ func (x *SimpleAction) GetState() *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *SimpleAction) GetStateHint() *glib.Variant {
 
 	cret := XGActionGetStateHint(x.GoPointer())
 	return cret
 }
 
-// Queries the type of the state of @action.
+// Queries the type of the state of action.
 //
 // If the action is stateful (e.g. created with
-// [ctor@Gio.SimpleAction.new_stateful]) then this function returns the
-// [type@GLib.VariantType] of the state.  This is the type of the initial value
-// given as the state. All calls to [method@Gio.Action.change_state] must give a
-// [type@GLib.Variant] of this type and [method@Gio.Action.get_state] will return a
-// [type@GLib.Variant] of the same type.
-//
-// If the action is not stateful (e.g. created with [ctor@Gio.SimpleAction.new])
-// then this function will return `NULL`. In that case, [method@Gio.Action.get_state]
-// will return `NULL` and you must not call [method@Gio.Action.change_state].
+// [NewSimpleActionStateful]) then this function returns the
+// [glib.VariantType] of the state.  This is the type of the initial value
+// given as the state. All calls to [Action.ChangeState] must give a
+// [glib.Variant] of this type and [Action.GetState] will return a
+// [glib.Variant] of the same type.
+//
+// If the action is not stateful (e.g. created with [NewSimpleAction])
+// then this function will return `NULL`. In that case, [Action.GetState]
+// will return `NULL` and you must not call [Action.ChangeState].
 func (x *SimpleAction) GetStateType() *glib.VariantType {
 
 	cret := XGActionGetStateType(x.GoPointer())
@@ -430,7 +469,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -439,11 +478,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSimpleActionGLibType, libs, "g_simple_action_get_type")
 
-	core.PuregoSafeRegister(&xNewSimpleAction, libs, "g_simple_action_new")
-	core.PuregoSafeRegister(&xNewSimpleActionStateful, libs, "g_simple_action_new_stateful")
+	core.PuregoRegisterSince(&xNewSimpleAction, libs, "g_simple_action_new", "GIO", "2.28")
+	core.PuregoRegisterSince(&xNewSimpleActionStateful, libs, "g_simple_action_new_stateful", "GIO", "2.28")
 
-	core.PuregoSafeRegister(&xSimpleActionSetEnabled, libs, "g_simple_action_set_enabled")
-	core.PuregoSafeRegister(&xSimpleActionSetState, libs, "g_simple_action_set_state")
-	core.PuregoSafeRegister(&xSimpleActionSetStateHint, libs, "g_simple_action_set_state_hint")
+	core.PuregoRegisterSince(&xSimpleActionSetEnabled, libs, "g_simple_action_set_enabled", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSimpleActionSetState, libs, "g_simple_action_set_state", "GIO", "2.30")
+	core.PuregoRegisterSince(&xSimpleActionSetStateHint, libs, "g_simple_action_set_state_hint", "GIO", "2.44")
 
 }
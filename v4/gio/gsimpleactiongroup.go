@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -32,8 +31,8 @@ func (x *SimpleActionGroupPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GSimpleActionGroup` is a hash table filled with [iface@Gio.Action] objects,
-// implementing the [iface@Gio.ActionGroup] and [iface@Gio.ActionMap]
+// `GSimpleActionGroup` is a hash table filled with [Action] objects,
+// implementing the [ActionGroup] and [ActionMap]
 // interfaces.
 type SimpleActionGroup struct {
 	gobject.Object
@@ -53,7 +52,7 @@ func SimpleActionGroupNewFromInternalPtr(ptr uintptr) *SimpleActionGroup {
 
 var xNewSimpleActionGroup func() uintptr
 
-// Creates a new, empty, #GSimpleActionGroup.
+// Creates a new, empty, [SimpleActionGroup].
 func NewSimpleActionGroup() *SimpleActionGroup {
 	var cls *SimpleActionGroup
 
@@ -67,60 +66,6 @@ func NewSimpleActionGroup() *SimpleActionGroup {
 	return cls
 }
 
-var xSimpleActionGroupAddEntries func(uintptr, []ActionEntry, int, uintptr)
-
-// A convenience function for creating multiple #GSimpleAction instances
-// and adding them to the action group.
-func (x *SimpleActionGroup) AddEntries(EntriesVar []ActionEntry, NEntriesVar int, UserDataVar uintptr) {
-
-	xSimpleActionGroupAddEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
-
-}
-
-var xSimpleActionGroupInsert func(uintptr, uintptr)
-
-// Adds an action to the action group.
-//
-// If the action group already contains an action with the same name as
-// @action then the old action is dropped from the group.
-//
-// The action group takes its own reference on @action.
-func (x *SimpleActionGroup) Insert(ActionVar Action) {
-
-	xSimpleActionGroupInsert(x.GoPointer(), ActionVar.GoPointer())
-
-}
-
-var xSimpleActionGroupLookup func(uintptr, string) uintptr
-
-// Looks up the action with the name @action_name in the group.
-//
-// If no such action exists, returns %NULL.
-func (x *SimpleActionGroup) Lookup(ActionNameVar string) *ActionBase {
-	var cls *ActionBase
-
-	cret := xSimpleActionGroupLookup(x.GoPointer(), ActionNameVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ActionBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xSimpleActionGroupRemove func(uintptr, string)
-
-// Removes the named action from the action group.
-//
-// If no action of this name is in the group then nothing happens.
-func (x *SimpleActionGroup) Remove(ActionNameVar string) {
-
-	xSimpleActionGroupRemove(x.GoPointer(), ActionNameVar)
-
-}
-
 func (c *SimpleActionGroup) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -132,99 +77,97 @@ func (c *SimpleActionGroup) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *SimpleActionGroup) ActionAdded(ActionNameVar string) {
 
 	XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *SimpleActionGroup) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *SimpleActionGroup) ActionRemoved(ActionNameVar string) {
 
 	XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [ActionGroup] implementations.
 func (x *SimpleActionGroup) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [DBusConnection.Flush] is called
+// before `exit()`. Without `[DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *SimpleActionGroup) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *SimpleActionGroup) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -235,14 +178,14 @@ func (x *SimpleActionGroup) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -253,14 +196,14 @@ func (x *SimpleActionGroup) GetActionParameterType(ActionNameVar string) *glib.V
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *SimpleActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -268,14 +211,14 @@ func (x *SimpleActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -284,7 +227,7 @@ func (x *SimpleActionGroup) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *SimpleActionGroup) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -292,17 +235,17 @@ func (x *SimpleActionGroup) GetActionStateHint(ActionNameVar string) *glib.Varia
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [ActionGroup.GetActionState] will return `NULL`
+// and you must not call [ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -313,38 +256,38 @@ func (x *SimpleActionGroup) GetActionStateType(ActionNameVar string) *glib.Varia
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *SimpleActionGroup) HasAction(ActionNameVar string) bool {
 
 	cret := XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *SimpleActionGroup) ListActions() []string {
 
 	cret := XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [ActionGroup.HasAction], [ActionGroup.GetActionEnabled],
+// [ActionGroup.GetActionParameterType],
+// [ActionGroup.GetActionStateType],
+// [ActionGroup.GetActionStateHint] and
+// [ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -363,47 +306,41 @@ func (x *SimpleActionGroup) QueryAction(ActionNameVar string, EnabledVar *bool,
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *SimpleActionGroup) AddAction(ActionVar Action) {
 
 	XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
+// A convenience function for creating multiple [SimpleAction]
+// instances and adding them to a [ActionMap].
 //
-// Each action is constructed as per one [struct@Gio.ActionEntry].
-//
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -416,15 +353,13 @@ func (x *SimpleActionGroup) AddAction(ActionVar Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *SimpleActionGroup) AddActionEntries(EntriesVar []ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *SimpleActionGroup) LookupAction(ActionNameVar string) *ActionBase {
@@ -450,31 +385,25 @@ func (x *SimpleActionGroup) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [ActionMap]. This is meant as the reverse of
+// [ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *SimpleActionGroup) RemoveActionEntries(EntriesVar []ActionEntry, NEntriesVar int) {
 
 	XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -486,7 +415,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -495,11 +424,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xSimpleActionGroupGLibType, libs, "g_simple_action_group_get_type")
 
-	core.PuregoSafeRegister(&xNewSimpleActionGroup, libs, "g_simple_action_group_new")
+	gobject.CheckClassSize("GSimpleActionGroupClass", xSimpleActionGroupGLibType, unsafe.Sizeof(SimpleActionGroupClass{}))
 
-	core.PuregoSafeRegister(&xSimpleActionGroupAddEntries, libs, "g_simple_action_group_add_entries")
-	core.PuregoSafeRegister(&xSimpleActionGroupInsert, libs, "g_simple_action_group_insert")
-	core.PuregoSafeRegister(&xSimpleActionGroupLookup, libs, "g_simple_action_group_lookup")
-	core.PuregoSafeRegister(&xSimpleActionGroupRemove, libs, "g_simple_action_group_remove")
+	core.PuregoRegisterSince(&xNewSimpleActionGroup, libs, "g_simple_action_group_new", "GIO", "2.28")
 
 }
@@ -0,0 +1,90 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xSimpleActionGroupAddEntries func(uintptr, []ActionEntry, int, uintptr)
+
+// A convenience function for creating multiple [SimpleAction] instances
+// and adding them to the action group.
+//
+// Deprecated: since 2.38. Use [ActionMap.AddActionEntries]
+func (x *SimpleActionGroup) AddEntries(EntriesVar []ActionEntry, NEntriesVar int, UserDataVar uintptr) {
+
+	xSimpleActionGroupAddEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
+
+}
+
+var xSimpleActionGroupInsert func(uintptr, uintptr)
+
+// Adds an action to the action group.
+//
+// If the action group already contains an action with the same name as
+// action then the old action is dropped from the group.
+//
+// The action group takes its own reference on action.
+//
+// Deprecated: since 2.38. Use [ActionMap.AddAction]
+func (x *SimpleActionGroup) Insert(ActionVar Action) {
+
+	xSimpleActionGroupInsert(x.GoPointer(), ActionVar.GoPointer())
+
+}
+
+var xSimpleActionGroupLookup func(uintptr, string) uintptr
+
+// Looks up the action with the name action_name in the group.
+//
+// If no such action exists, returns nil.
+//
+// Deprecated: since 2.38. Use [ActionMap.LookupAction]
+func (x *SimpleActionGroup) Lookup(ActionNameVar string) *ActionBase {
+	var cls *ActionBase
+
+	cret := xSimpleActionGroupLookup(x.GoPointer(), ActionNameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ActionBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSimpleActionGroupRemove func(uintptr, string)
+
+// Removes the named action from the action group.
+//
+// If no action of this name is in the group then nothing happens.
+//
+// Deprecated: since 2.38. Use [ActionMap.RemoveAction]
+func (x *SimpleActionGroup) Remove(ActionNameVar string) {
+
+	xSimpleActionGroupRemove(x.GoPointer(), ActionNameVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSimpleActionGroupAddEntries, libs, "g_simple_action_group_add_entries", "GIO", "2.30")
+	core.PuregoRegisterSince(&xSimpleActionGroupInsert, libs, "g_simple_action_group_insert", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSimpleActionGroupLookup, libs, "g_simple_action_group_lookup", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSimpleActionGroupRemove, libs, "g_simple_action_group_remove", "GIO", "2.28")
+
+}
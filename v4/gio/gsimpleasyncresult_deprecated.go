@@ -0,0 +1,577 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xSimpleAsyncReportErrorInIdle func(uintptr, uintptr, uintptr, glib.Quark, int, string, ...interface{})
+
+// Reports an error in an asynchronous function in an idle function by
+// directly setting the contents of the [AsyncResult] with the given error
+// information.
+//
+// Deprecated: since 2.46. Use [TaskReportError].
+func SimpleAsyncReportErrorInIdle(ObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xSimpleAsyncReportErrorInIdle(ObjectVar.GoPointer(), CallbackVarRef, UserDataVar, DomainVar, CodeVar, FormatVar, varArgs...)
+
+}
+
+var xSimpleAsyncReportGerrorInIdle func(uintptr, uintptr, uintptr, *glib.Error)
+
+// Reports an error in an idle function. Similar to
+// [SimpleAsyncReportErrorInIdle], but takes a [glib.Error] rather
+// than building a new one.
+//
+// Deprecated: since 2.46. Use [TaskReportError].
+func SimpleAsyncReportGerrorInIdle(ObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, ErrorVar *glib.Error) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xSimpleAsyncReportGerrorInIdle(ObjectVar.GoPointer(), CallbackVarRef, UserDataVar, ErrorVar)
+
+}
+
+var xSimpleAsyncReportTakeGerrorInIdle func(uintptr, uintptr, uintptr, *glib.Error)
+
+// Reports an error in an idle function. Similar to
+// [SimpleAsyncReportGerrorInIdle], but takes over the caller's
+// ownership of error, so the caller does not have to free it any more.
+//
+// Deprecated: since 2.46. Use [TaskReportError].
+func SimpleAsyncReportTakeGerrorInIdle(ObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, ErrorVar *glib.Error) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xSimpleAsyncReportTakeGerrorInIdle(ObjectVar.GoPointer(), CallbackVarRef, UserDataVar, ErrorVar)
+
+}
+
+var xNewSimpleAsyncResult func(uintptr, uintptr, uintptr, uintptr) uintptr
+
+// Creates a [SimpleAsyncResult].
+//
+// The common convention is to create the [SimpleAsyncResult] in the
+// function that starts the asynchronous operation and use that same
+// function as the source_tag.
+//
+// If your operation supports cancellation with [Cancellable] (which it
+// probably should) then you should provide the user's cancellable to
+// [SimpleAsyncResult.SetCheckCancellable] immediately after
+// this function returns.
+//
+// Deprecated: since 2.46. Use [NewTask] instead.
+func NewSimpleAsyncResult(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, SourceTagVar uintptr) *SimpleAsyncResult {
+	var cls *SimpleAsyncResult
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	cret := xNewSimpleAsyncResult(SourceObjectVar.GoPointer(), CallbackVarRef, UserDataVar, SourceTagVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &SimpleAsyncResult{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewSimpleAsyncResultError func(uintptr, uintptr, uintptr, glib.Quark, int, string, ...interface{}) uintptr
+
+// Creates a new [SimpleAsyncResult] with a set error.
+//
+// Deprecated: since 2.46. Use [NewTask] and [Task.ReturnNewError] instead.
+func NewSimpleAsyncResultError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) *SimpleAsyncResult {
+	var cls *SimpleAsyncResult
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	cret := xNewSimpleAsyncResultError(SourceObjectVar.GoPointer(), CallbackVarRef, UserDataVar, DomainVar, CodeVar, FormatVar, varArgs...)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &SimpleAsyncResult{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewSimpleAsyncResultFromError func(uintptr, uintptr, uintptr, *glib.Error) uintptr
+
+// Creates a [SimpleAsyncResult] from an error condition.
+//
+// Deprecated: since 2.46. Use [NewTask] and [Task.ReturnError] instead.
+func NewSimpleAsyncResultFromError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, ErrorVar *glib.Error) *SimpleAsyncResult {
+	var cls *SimpleAsyncResult
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	cret := xNewSimpleAsyncResultFromError(SourceObjectVar.GoPointer(), CallbackVarRef, UserDataVar, ErrorVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &SimpleAsyncResult{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewSimpleAsyncResultTakeError func(uintptr, uintptr, uintptr, *glib.Error) uintptr
+
+// Creates a [SimpleAsyncResult] from an error condition, and takes over the
+// caller's ownership of error, so the caller does not need to free it anymore.
+//
+// Deprecated: since 2.46. Use [NewTask] and [Task.ReturnError] instead.
+func NewSimpleAsyncResultTakeError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, UserDataVar uintptr, ErrorVar *glib.Error) *SimpleAsyncResult {
+	var cls *SimpleAsyncResult
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	cret := xNewSimpleAsyncResultTakeError(SourceObjectVar.GoPointer(), CallbackVarRef, UserDataVar, ErrorVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &SimpleAsyncResult{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSimpleAsyncResultComplete func(uintptr)
+
+// Completes an asynchronous I/O job immediately. Must be called in
+// the thread where the asynchronous result was to be delivered, as it
+// invokes the callback directly. If you are in a different thread use
+// [SimpleAsyncResult.CompleteInIdle].
+//
+// Calling this function takes a reference to simple for as long as
+// is needed to complete the call.
+//
+// Deprecated: since 2.46. Use [Task] instead.
+func (x *SimpleAsyncResult) Complete() {
+
+	xSimpleAsyncResultComplete(x.GoPointer())
+
+}
+
+var xSimpleAsyncResultCompleteInIdle func(uintptr)
+
+// Completes an asynchronous function in an idle handler in the
+// thread-default main context (see [glib.MainContext.PushThreadDefault])
+// of the thread that simple was initially created in
+// (and re-pushes that context around the invocation of the callback).
+//
+// Calling this function takes a reference to simple for as long as
+// is needed to complete the call.
+//
+// Deprecated: since 2.46. Use [Task] instead.
+func (x *SimpleAsyncResult) CompleteInIdle() {
+
+	xSimpleAsyncResultCompleteInIdle(x.GoPointer())
+
+}
+
+var xSimpleAsyncResultGetOpResGboolean func(uintptr) bool
+
+// Gets the operation result boolean from within the asynchronous result.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.PropagateBoolean] instead.
+func (x *SimpleAsyncResult) GetOpResGboolean() bool {
+
+	cret := xSimpleAsyncResultGetOpResGboolean(x.GoPointer())
+	return cret
+}
+
+var xSimpleAsyncResultGetOpResGpointer func(uintptr) uintptr
+
+// Gets a pointer result as returned by the asynchronous function.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.PropagatePointer] instead.
+func (x *SimpleAsyncResult) GetOpResGpointer() uintptr {
+
+	cret := xSimpleAsyncResultGetOpResGpointer(x.GoPointer())
+	return cret
+}
+
+var xSimpleAsyncResultGetOpResGssize func(uintptr) int
+
+// Gets a gssize from the asynchronous result.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.PropagateInt] instead.
+func (x *SimpleAsyncResult) GetOpResGssize() int {
+
+	cret := xSimpleAsyncResultGetOpResGssize(x.GoPointer())
+	return cret
+}
+
+var xSimpleAsyncResultGetSourceTag func(uintptr) uintptr
+
+// Gets the source tag for the [SimpleAsyncResult].
+//
+// Deprecated: since 2.46.. Use [Task] and [Task.GetSourceTag] instead.
+func (x *SimpleAsyncResult) GetSourceTag() uintptr {
+
+	cret := xSimpleAsyncResultGetSourceTag(x.GoPointer())
+	return cret
+}
+
+var xSimpleAsyncResultPropagateError func(uintptr) bool
+
+// Propagates an error from within the simple asynchronous result to
+// a given destination.
+//
+// If the [Cancellable] given to a prior call to
+// [SimpleAsyncResult.SetCheckCancellable] is cancelled then this
+// function will return true with dest set appropriately.
+//
+// Deprecated: since 2.46. Use [Task] instead.
+func (x *SimpleAsyncResult) PropagateError() (bool, error) {
+	var cerr *glib.Error
+
+	cret := xSimpleAsyncResultPropagateError(x.GoPointer())
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xSimpleAsyncResultRunInThread func(uintptr, uintptr, int, uintptr)
+
+// Runs the asynchronous job in a separate thread and then calls
+// [SimpleAsyncResult.CompleteInIdle] on simple to return
+// the result to the appropriate main loop.
+//
+// Calling this function takes a reference to simple for as long as
+// is needed to run the job and report its completion.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.RunInThread] instead.
+func (x *SimpleAsyncResult) RunInThread(FuncVar *SimpleAsyncThreadFunc, IoPriorityVar int, CancellableVar *Cancellable) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	xSimpleAsyncResultRunInThread(x.GoPointer(), FuncVarRef, IoPriorityVar, CancellableVar.GoPointer())
+
+}
+
+var xSimpleAsyncResultSetCheckCancellable func(uintptr, uintptr)
+
+// Sets a [Cancellable] to check before dispatching results.
+//
+// This function has one very specific purpose: the provided cancellable
+// is checked at the time of [SimpleAsyncResult.PropagateError] If
+// it is cancelled, these functions will return an "Operation was
+// cancelled" error ([GIoErrorCancelledValue]).
+//
+// Implementors of cancellable asynchronous functions should use this in
+// order to provide a guarantee to their callers that cancelling an
+// async operation will reliably result in an error being returned for
+// that operation (even if a positive result for the operation has
+// already been sent as an idle to the main context to be dispatched).
+//
+// The checking described above is done regardless of any call to the
+// unrelated [SimpleAsyncResult.SetHandleCancellation] function.
+//
+// Deprecated: since 2.46. Use [Task] instead.
+func (x *SimpleAsyncResult) SetCheckCancellable(CheckCancellableVar *Cancellable) {
+
+	xSimpleAsyncResultSetCheckCancellable(x.GoPointer(), CheckCancellableVar.GoPointer())
+
+}
+
+var xSimpleAsyncResultSetError func(uintptr, glib.Quark, int, string, ...interface{})
+
+// Sets an error within the asynchronous result without a [glib.Error].
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnNewError] instead.
+func (x *SimpleAsyncResult) SetError(DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
+
+	xSimpleAsyncResultSetError(x.GoPointer(), DomainVar, CodeVar, FormatVar, varArgs...)
+
+}
+
+var xSimpleAsyncResultSetErrorVa func(uintptr, glib.Quark, int, string, []interface{})
+
+// Sets an error within the asynchronous result without a [glib.Error].
+// Unless writing a binding, see [SimpleAsyncResult.SetError].
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnError] instead.
+func (x *SimpleAsyncResult) SetErrorVa(DomainVar glib.Quark, CodeVar int, FormatVar string, ArgsVar []interface{}) {
+
+	xSimpleAsyncResultSetErrorVa(x.GoPointer(), DomainVar, CodeVar, FormatVar, ArgsVar)
+
+}
+
+var xSimpleAsyncResultSetFromError func(uintptr, *glib.Error)
+
+// Sets the result from a [glib.Error].
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnError] instead.
+func (x *SimpleAsyncResult) SetFromError(ErrorVar *glib.Error) {
+
+	xSimpleAsyncResultSetFromError(x.GoPointer(), ErrorVar)
+
+}
+
+var xSimpleAsyncResultSetHandleCancellation func(uintptr, bool)
+
+// Sets whether to handle cancellation within the asynchronous operation.
+//
+// This function has nothing to do with
+// [SimpleAsyncResult.SetCheckCancellable].  It only refers to the
+// [Cancellable] passed to [SimpleAsyncResult.RunInThread].
+//
+// Deprecated: since 2.46. do not use it in new code.
+func (x *SimpleAsyncResult) SetHandleCancellation(HandleCancellationVar bool) {
+
+	xSimpleAsyncResultSetHandleCancellation(x.GoPointer(), HandleCancellationVar)
+
+}
+
+var xSimpleAsyncResultSetOpResGboolean func(uintptr, bool)
+
+// Sets the operation result to a boolean within the asynchronous result.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnBoolean] instead.
+func (x *SimpleAsyncResult) SetOpResGboolean(OpResVar bool) {
+
+	xSimpleAsyncResultSetOpResGboolean(x.GoPointer(), OpResVar)
+
+}
+
+var xSimpleAsyncResultSetOpResGpointer func(uintptr, uintptr, uintptr)
+
+// Sets the operation result within the asynchronous result to a pointer.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnPointer] instead.
+func (x *SimpleAsyncResult) SetOpResGpointer(OpResVar uintptr, DestroyOpResVar *glib.DestroyNotify) {
+
+	var DestroyOpResVarRef uintptr
+	if DestroyOpResVar != nil {
+		DestroyOpResVarPtr := uintptr(unsafe.Pointer(DestroyOpResVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyOpResVarPtr); ok {
+			DestroyOpResVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyOpResVar
+				cbFn(arg0)
+			}
+			DestroyOpResVarRef = core.NewCallback(fcb)
+			DestroyOpResVarRef = glib.SaveCallbackWithClosure(DestroyOpResVarPtr, DestroyOpResVarRef, DestroyOpResVar)
+		}
+	}
+
+	xSimpleAsyncResultSetOpResGpointer(x.GoPointer(), OpResVar, DestroyOpResVarRef)
+
+}
+
+var xSimpleAsyncResultSetOpResGssize func(uintptr, int)
+
+// Sets the operation result within the asynchronous result to
+// the given op_res.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnInt] instead.
+func (x *SimpleAsyncResult) SetOpResGssize(OpResVar int) {
+
+	xSimpleAsyncResultSetOpResGssize(x.GoPointer(), OpResVar)
+
+}
+
+var xSimpleAsyncResultTakeError func(uintptr, *glib.Error)
+
+// Sets the result from error, and takes over the caller's ownership
+// of error, so the caller does not need to free it any more.
+//
+// Deprecated: since 2.46. Use [Task] and [Task.ReturnError] instead.
+func (x *SimpleAsyncResult) TakeError(ErrorVar *glib.Error) {
+
+	xSimpleAsyncResultTakeError(x.GoPointer(), ErrorVar)
+
+}
+
+var xSimpleAsyncResultIsValid func(uintptr, uintptr, uintptr) bool
+
+// Ensures that the data passed to the _finish function of an async
+// operation is consistent.  Three checks are performed.
+//
+// First, result is checked to ensure that it is really a
+// [SimpleAsyncResult].  Second, source is checked to ensure that it
+// matches the source object of result.  Third, source_tag is
+// checked to ensure that it is equal to the source_tag argument given
+// to [NewSimpleAsyncResult] (which, by convention, is a pointer
+// to the _async function corresponding to the _finish function from
+// which this function is called).  (Alternatively, if either
+// source_tag or result's source tag is nil, then the source tag
+// check is skipped.)
+//
+// Deprecated: since 2.46. Use [Task] and [TaskIsValid] instead.
+func SimpleAsyncResultIsValid(ResultVar AsyncResult, SourceVar *gobject.Object, SourceTagVar uintptr) bool {
+
+	cret := xSimpleAsyncResultIsValid(ResultVar.GoPointer(), SourceVar.GoPointer(), SourceTagVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSimpleAsyncReportErrorInIdle, libs, "g_simple_async_report_error_in_idle", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncReportGerrorInIdle, libs, "g_simple_async_report_gerror_in_idle", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncReportTakeGerrorInIdle, libs, "g_simple_async_report_take_gerror_in_idle", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xNewSimpleAsyncResult, libs, "g_simple_async_result_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewSimpleAsyncResultError, libs, "g_simple_async_result_new_error", "GIO", "")
+	core.PuregoRegisterSince(&xNewSimpleAsyncResultFromError, libs, "g_simple_async_result_new_from_error", "GIO", "")
+	core.PuregoRegisterSince(&xNewSimpleAsyncResultTakeError, libs, "g_simple_async_result_new_take_error", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xSimpleAsyncResultComplete, libs, "g_simple_async_result_complete", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultCompleteInIdle, libs, "g_simple_async_result_complete_in_idle", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultGetOpResGboolean, libs, "g_simple_async_result_get_op_res_gboolean", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultGetOpResGpointer, libs, "g_simple_async_result_get_op_res_gpointer", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultGetOpResGssize, libs, "g_simple_async_result_get_op_res_gssize", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultGetSourceTag, libs, "g_simple_async_result_get_source_tag", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultPropagateError, libs, "g_simple_async_result_propagate_error", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultRunInThread, libs, "g_simple_async_result_run_in_thread", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetCheckCancellable, libs, "g_simple_async_result_set_check_cancellable", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetError, libs, "g_simple_async_result_set_error", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetErrorVa, libs, "g_simple_async_result_set_error_va", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetFromError, libs, "g_simple_async_result_set_from_error", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetHandleCancellation, libs, "g_simple_async_result_set_handle_cancellation", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetOpResGboolean, libs, "g_simple_async_result_set_op_res_gboolean", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetOpResGpointer, libs, "g_simple_async_result_set_op_res_gpointer", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultSetOpResGssize, libs, "g_simple_async_result_set_op_res_gssize", "GIO", "")
+	core.PuregoRegisterSince(&xSimpleAsyncResultTakeError, libs, "g_simple_async_result_take_error", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xSimpleAsyncResultIsValid, libs, "g_simple_async_result_is_valid", "GIO", "2.20")
+
+}
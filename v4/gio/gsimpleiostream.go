@@ -2,21 +2,20 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GSimpleIOStream` creates a [class@Gio.IOStream] from an arbitrary
-// [class@Gio.InputStream] and [class@Gio.OutputStream]. This allows any pair of
-// input and output streams to be used with [class@Gio.IOStream] methods.
+// `GSimpleIOStream` creates a [IOStream] from an arbitrary
+// [InputStream] and [OutputStream]. This allows any pair of
+// input and output streams to be used with [IOStream] methods.
 //
-// This is useful when you obtained a [class@Gio.InputStream] and a
-// [class@Gio.OutputStream] by other means, for instance creating them with
+// This is useful when you obtained a [InputStream] and a
+// [OutputStream] by other means, for instance creating them with
 // platform specific methods as
-// [`g_unix_input_stream_new()`](../gio-unix/ctor.UnixInputStream.new.html)
+// g_unix_input_stream_new()
 // (from `gio-unix-2.0.pc` / `GioUnix-2.0`), and you want to
-// take advantage of the methods provided by [class@Gio.IOStream].
+// take advantage of the methods provided by [IOStream].
 type SimpleIOStream struct {
 	IOStream
 }
@@ -35,8 +34,8 @@ func SimpleIOStreamNewFromInternalPtr(ptr uintptr) *SimpleIOStream {
 
 var xNewSimpleIOStream func(uintptr, uintptr) uintptr
 
-// Creates a new #GSimpleIOStream wrapping @input_stream and @output_stream.
-// See also #GIOStream.
+// Creates a new [SimpleIOStream] wrapping input_stream and output_stream.
+// See also [IOStream].
 func NewSimpleIOStream(InputStreamVar *InputStream, OutputStreamVar *OutputStream) *SimpleIOStream {
 	var cls *SimpleIOStream
 
@@ -66,7 +65,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -75,6 +74,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xSimpleIOStreamGLibType, libs, "g_simple_io_stream_get_type")
 
-	core.PuregoSafeRegister(&xNewSimpleIOStream, libs, "g_simple_io_stream_new")
+	core.PuregoRegisterSince(&xNewSimpleIOStream, libs, "g_simple_io_stream_new", "GIO", "2.44")
 
 }
@@ -2,16 +2,15 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GSimplePermission` is a trivial implementation of [class@Gio.Permission]
+// `GSimplePermission` is a trivial implementation of [Permission]
 // that represents a permission that is either always or never allowed.  The
 // value is given at construction and doesn’t change.
 //
-// Calling [method@Gio.Permission.acquire] or [method@Gio.Permission.release]
+// Calling [Permission.Acquire] or [Permission.Release]
 // on a `GSimplePermission` will result in errors.
 type SimplePermission struct {
 	Permission
@@ -31,7 +30,7 @@ func SimplePermissionNewFromInternalPtr(ptr uintptr) *SimplePermission {
 
 var xNewSimplePermission func(bool) uintptr
 
-// Creates a new #GPermission instance that represents an action that is
+// Creates a new [Permission] instance that represents an action that is
 // either always or never allowed.
 func NewSimplePermission(AllowedVar bool) *SimplePermission {
 	var cls *SimplePermission
@@ -62,7 +61,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -71,6 +70,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xSimplePermissionGLibType, libs, "g_simple_permission_get_type")
 
-	core.PuregoSafeRegister(&xNewSimplePermission, libs, "g_simple_permission_new")
+	core.PuregoRegisterSince(&xNewSimplePermission, libs, "g_simple_permission_new", "GIO", "2.26")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,8 @@ func (x *SimpleProxyResolverClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -49,7 +49,7 @@ func (x *SimpleProxyResolverClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -60,7 +60,8 @@ func (x *SimpleProxyResolverClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -72,7 +73,7 @@ func (x *SimpleProxyResolverClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -83,7 +84,8 @@ func (x *SimpleProxyResolverClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -95,7 +97,7 @@ func (x *SimpleProxyResolverClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -106,7 +108,8 @@ func (x *SimpleProxyResolverClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -118,7 +121,7 @@ func (x *SimpleProxyResolverClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -129,7 +132,8 @@ func (x *SimpleProxyResolverClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -141,7 +145,7 @@ func (x *SimpleProxyResolverClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -155,14 +159,14 @@ func (x *SimpleProxyResolverPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GSimpleProxyResolver` is a simple [iface@Gio.ProxyResolver] implementation
+// `GSimpleProxyResolver` is a simple [ProxyResolver] implementation
 // that handles a single default proxy, multiple URI-scheme-specific
 // proxies, and a list of hosts that proxies should not be used for.
 //
 // `GSimpleProxyResolver` is never the default proxy resolver, but it
 // can be used as the base class for another proxy resolver
 // implementation, or it can be created and used manually, such as
-// with [method@Gio.SocketClient.set_proxy_resolver].
+// with [SocketClient.SetProxyResolver].
 type SimpleProxyResolver struct {
 	gobject.Object
 }
@@ -181,12 +185,12 @@ func SimpleProxyResolverNewFromInternalPtr(ptr uintptr) *SimpleProxyResolver {
 
 var xSimpleProxyResolverSetDefaultProxy func(uintptr, uintptr)
 
-// Sets the default proxy on @resolver, to be used for any URIs that
-// don't match #GSimpleProxyResolver:ignore-hosts or a proxy set
-// via g_simple_proxy_resolver_set_uri_proxy().
+// Sets the default proxy on resolver, to be used for any URIs that
+// don't match [SimpleProxyResolver.GetPropertyIgnoreHosts] or a proxy set
+// via [SimpleProxyResolver.SetUriProxy].
 //
-// If @default_proxy starts with "socks://",
-// #GSimpleProxyResolver will treat it as referring to all three of
+// If default_proxy starts with "socks://",
+// [SimpleProxyResolver] will treat it as referring to all three of
 // the socks5, socks4a, and socks4 proxy types.
 func (x *SimpleProxyResolver) SetDefaultProxy(DefaultProxyVar *string) {
 
@@ -201,8 +205,8 @@ var xSimpleProxyResolverSetIgnoreHosts func(uintptr, []string)
 
 // Sets the list of ignored hosts.
 //
-// See #GSimpleProxyResolver:ignore-hosts for more details on how the
-// @ignore_hosts argument is interpreted.
+// See [SimpleProxyResolver.GetPropertyIgnoreHosts] for more details on how the
+// ignore_hosts argument is interpreted.
 func (x *SimpleProxyResolver) SetIgnoreHosts(IgnoreHostsVar []string) {
 
 	xSimpleProxyResolverSetIgnoreHosts(x.GoPointer(), IgnoreHostsVar)
@@ -211,12 +215,12 @@ func (x *SimpleProxyResolver) SetIgnoreHosts(IgnoreHostsVar []string) {
 
 var xSimpleProxyResolverSetUriProxy func(uintptr, string, string)
 
-// Adds a URI-scheme-specific proxy to @resolver; URIs whose scheme
-// matches @uri_scheme (and which don't match
-// #GSimpleProxyResolver:ignore-hosts) will be proxied via @proxy.
+// Adds a URI-scheme-specific proxy to resolver; URIs whose scheme
+// matches uri_scheme (and which don't match
+// [SimpleProxyResolver.GetPropertyIgnoreHosts]) will be proxied via proxy.
 //
-// As with #GSimpleProxyResolver:default-proxy, if @proxy starts with
-// "socks://", #GSimpleProxyResolver will treat it
+// As with [SimpleProxyResolver.GetPropertyDefaultProxy], if proxy starts with
+// "socks://", [SimpleProxyResolver] will treat it
 // as referring to all three of the socks5, socks4a, and socks4 proxy
 // types.
 func (x *SimpleProxyResolver) SetUriProxy(UriSchemeVar string, ProxyVar string) {
@@ -238,11 +242,11 @@ func (c *SimpleProxyResolver) SetGoPointer(ptr uintptr) {
 
 // SetPropertyDefaultProxy sets the "default-proxy" property.
 // The default proxy URI that will be used for any URI that doesn't
-// match #GSimpleProxyResolver:ignore-hosts, and doesn't match any
-// of the schemes set with g_simple_proxy_resolver_set_uri_proxy().
+// match [SimpleProxyResolver.GetPropertyIgnoreHosts], and doesn't match any
+// of the schemes set with [SimpleProxyResolver.SetUriProxy].
 //
 // Note that as a special case, if this URI starts with
-// "socks://", #GSimpleProxyResolver will treat it as referring
+// "socks://", [SimpleProxyResolver] will treat it as referring
 // to all three of the socks5, socks4a, and socks4 proxy types.
 func (x *SimpleProxyResolver) SetPropertyDefaultProxy(value string) {
 	var v gobject.Value
@@ -253,11 +257,11 @@ func (x *SimpleProxyResolver) SetPropertyDefaultProxy(value string) {
 
 // GetPropertyDefaultProxy gets the "default-proxy" property.
 // The default proxy URI that will be used for any URI that doesn't
-// match #GSimpleProxyResolver:ignore-hosts, and doesn't match any
-// of the schemes set with g_simple_proxy_resolver_set_uri_proxy().
+// match [SimpleProxyResolver.GetPropertyIgnoreHosts], and doesn't match any
+// of the schemes set with [SimpleProxyResolver.SetUriProxy].
 //
 // Note that as a special case, if this URI starts with
-// "socks://", #GSimpleProxyResolver will treat it as referring
+// "socks://", [SimpleProxyResolver] will treat it as referring
 // to all three of the socks5, socks4a, and socks4 proxy types.
 func (x *SimpleProxyResolver) GetPropertyDefaultProxy() string {
 	var v gobject.Value
@@ -295,8 +299,8 @@ func (x *SimpleProxyResolver) GetPropertyDefaultProxy() string {
 // to connections made to hosts identified by address. That is, if
 // example.com has an address of 192.168.1.1, and the :ignore-hosts list
 // contains only "192.168.1.1", then a connection to "example.com"
-// (eg, via a #GNetworkAddress) will use the proxy, and a connection to
-// "192.168.1.1" (eg, via a #GInetSocketAddress) will not.
+// (eg, via a [NetworkAddress]) will use the proxy, and a connection to
+// "192.168.1.1" (eg, via a [InetSocketAddress]) will not.
 //
 // These rules match the "ignore-hosts"/"noproxy" rules most
 // commonly used by other applications.
@@ -337,8 +341,8 @@ func (x *SimpleProxyResolver) SetPropertyIgnoreHosts(value []string) {
 // to connections made to hosts identified by address. That is, if
 // example.com has an address of 192.168.1.1, and the :ignore-hosts list
 // contains only "192.168.1.1", then a connection to "example.com"
-// (eg, via a #GNetworkAddress) will use the proxy, and a connection to
-// "192.168.1.1" (eg, via a #GInetSocketAddress) will not.
+// (eg, via a [NetworkAddress]) will use the proxy, and a connection to
+// "192.168.1.1" (eg, via a [InetSocketAddress]) will not.
 //
 // These rules match the "ignore-hosts"/"noproxy" rules most
 // commonly used by other applications.
@@ -348,9 +352,9 @@ func (x *SimpleProxyResolver) GetPropertyIgnoreHosts() []string {
 	return core.GoStringSlice(v.GetBoxed())
 }
 
-// Checks if @resolver can be used on this system. (This is used
-// internally; g_proxy_resolver_get_default() will only return a proxy
-// resolver that returns %TRUE for this method.)
+// Checks if resolver can be used on this system. (This is used
+// internally; [ProxyResolverGetDefault] will only return a proxy
+// resolver that returns true for this method.)
 func (x *SimpleProxyResolver) IsSupported() bool {
 
 	cret := XGProxyResolverIsSupported(x.GoPointer())
@@ -358,9 +362,9 @@ func (x *SimpleProxyResolver) IsSupported() bool {
 }
 
 // Looks into the system proxy configuration to determine what proxy,
-// if any, to use to connect to @uri. The returned proxy URIs are of
-// the form `&lt;protocol&gt;://[user[:password]@]host[:port]` or
-// `direct://`, where `&lt;protocol&gt;` could be http, rtsp, socks
+// if any, to use to connect to uri. The returned proxy URIs are of
+// the form `<protocol>://[user[:password]@]host[:port]` or
+// `direct://`, where `<protocol>` could be http, rtsp, socks
 // or other proxying protocol.
 //
 // If you don't know what network protocol is being used on the
@@ -377,13 +381,13 @@ func (x *SimpleProxyResolver) Lookup(UriVar string, CancellableVar *Cancellable)
 
 	cret := XGProxyResolverLookup(x.GoPointer(), UriVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
-// Asynchronous lookup of proxy. See g_proxy_resolver_lookup() for more
+// Asynchronous lookup of proxy. See [ProxyResolver.Lookup] for more
 // details.
 func (x *SimpleProxyResolver) LookupAsync(UriVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -392,24 +396,24 @@ func (x *SimpleProxyResolver) LookupAsync(UriVar string, CancellableVar *Cancell
 }
 
 // Call this function to obtain the array of proxy URIs when
-// g_proxy_resolver_lookup_async() is complete. See
-// g_proxy_resolver_lookup() for more details.
+// [ProxyResolver.LookupAsync] is complete. See
+// [ProxyResolver.Lookup] for more details.
 func (x *SimpleProxyResolver) LookupFinish(ResultVar AsyncResult) ([]string, error) {
 	var cerr *glib.Error
 
 	cret := XGProxyResolverLookupFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
 var xSimpleProxyResolverNew func(uintptr, []string) uintptr
 
-// Creates a new #GSimpleProxyResolver. See
-// #GSimpleProxyResolver:default-proxy and
-// #GSimpleProxyResolver:ignore-hosts for more details on how the
+// Creates a new [SimpleProxyResolver]. See
+// [SimpleProxyResolver.GetPropertyDefaultProxy] and
+// [SimpleProxyResolver.GetPropertyIgnoreHosts] for more details on how the
 // arguments are interpreted.
 func SimpleProxyResolverNew(DefaultProxyVar *string, IgnoreHostsVar []string) *ProxyResolverBase {
 	var cls *ProxyResolverBase
@@ -432,7 +436,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -441,10 +445,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSimpleProxyResolverGLibType, libs, "g_simple_proxy_resolver_get_type")
 
-	core.PuregoSafeRegister(&xSimpleProxyResolverSetDefaultProxy, libs, "g_simple_proxy_resolver_set_default_proxy")
-	core.PuregoSafeRegister(&xSimpleProxyResolverSetIgnoreHosts, libs, "g_simple_proxy_resolver_set_ignore_hosts")
-	core.PuregoSafeRegister(&xSimpleProxyResolverSetUriProxy, libs, "g_simple_proxy_resolver_set_uri_proxy")
+	gobject.CheckClassSize("GSimpleProxyResolverClass", xSimpleProxyResolverGLibType, unsafe.Sizeof(SimpleProxyResolverClass{}))
 
-	core.PuregoSafeRegister(&xSimpleProxyResolverNew, libs, "g_simple_proxy_resolver_new")
+	core.PuregoRegisterSince(&xSimpleProxyResolverSetDefaultProxy, libs, "g_simple_proxy_resolver_set_default_proxy", "GIO", "2.36")
+	core.PuregoRegisterSince(&xSimpleProxyResolverSetIgnoreHosts, libs, "g_simple_proxy_resolver_set_ignore_hosts", "GIO", "2.36")
+	core.PuregoRegisterSince(&xSimpleProxyResolverSetUriProxy, libs, "g_simple_proxy_resolver_set_uri_proxy", "GIO", "2.36")
+
+	core.PuregoRegisterSince(&xSimpleProxyResolverNew, libs, "g_simple_proxy_resolver_new", "GIO", "2.36")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -33,7 +32,8 @@ func (x *SocketAddressClass) OverrideGetFamily(cb func(*SocketAddress) SocketFam
 	if cb == nil {
 		x.xGetFamily = 0
 	} else {
-		x.xGetFamily = purego.NewCallback(func(AddressVarp uintptr) SocketFamily {
+		x.xGetFamily = core.NewCallback(func(AddressVarp uintptr) SocketFamily {
+			defer core.RecoverPanic()
 			return cb(SocketAddressNewFromInternalPtr(AddressVarp))
 		})
 	}
@@ -45,7 +45,7 @@ func (x *SocketAddressClass) GetGetFamily() func(*SocketAddress) SocketFamily {
 		return nil
 	}
 	var rawCallback func(AddressVarp uintptr) SocketFamily
-	purego.RegisterFunc(&rawCallback, x.xGetFamily)
+	core.RegisterFunc(&rawCallback, x.xGetFamily)
 	return func(AddressVar *SocketAddress) SocketFamily {
 		return rawCallback(AddressVar.GoPointer())
 	}
@@ -56,7 +56,8 @@ func (x *SocketAddressClass) OverrideGetNativeSize(cb func(*SocketAddress) int)
 	if cb == nil {
 		x.xGetNativeSize = 0
 	} else {
-		x.xGetNativeSize = purego.NewCallback(func(AddressVarp uintptr) int {
+		x.xGetNativeSize = core.NewCallback(func(AddressVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(SocketAddressNewFromInternalPtr(AddressVarp))
 		})
 	}
@@ -68,7 +69,7 @@ func (x *SocketAddressClass) GetGetNativeSize() func(*SocketAddress) int {
 		return nil
 	}
 	var rawCallback func(AddressVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetNativeSize)
+	core.RegisterFunc(&rawCallback, x.xGetNativeSize)
 	return func(AddressVar *SocketAddress) int {
 		return rawCallback(AddressVar.GoPointer())
 	}
@@ -79,7 +80,8 @@ func (x *SocketAddressClass) OverrideToNative(cb func(*SocketAddress, uintptr, u
 	if cb == nil {
 		x.xToNative = 0
 	} else {
-		x.xToNative = purego.NewCallback(func(AddressVarp uintptr, DestVarp uintptr, DestlenVarp uint) bool {
+		x.xToNative = core.NewCallback(func(AddressVarp uintptr, DestVarp uintptr, DestlenVarp uint) bool {
+			defer core.RecoverPanic()
 			return cb(SocketAddressNewFromInternalPtr(AddressVarp), DestVarp, DestlenVarp)
 		})
 	}
@@ -91,16 +93,16 @@ func (x *SocketAddressClass) GetToNative() func(*SocketAddress, uintptr, uint) b
 		return nil
 	}
 	var rawCallback func(AddressVarp uintptr, DestVarp uintptr, DestlenVarp uint) bool
-	purego.RegisterFunc(&rawCallback, x.xToNative)
+	core.RegisterFunc(&rawCallback, x.xToNative)
 	return func(AddressVar *SocketAddress, DestVar uintptr, DestlenVar uint) bool {
 		return rawCallback(AddressVar.GoPointer(), DestVar, DestlenVar)
 	}
 }
 
 // `GSocketAddress` is the equivalent of
-// [`struct sockaddr`](man:sockaddr(3type)) and its subtypes in the BSD sockets
-// API. This is an abstract class; use [class@Gio.InetSocketAddress] for
-// internet sockets, or [class@Gio.UnixSocketAddress] for UNIX domain sockets.
+// struct sockaddr) and its subtypes in the BSD sockets
+// API. This is an abstract class; use [InetSocketAddress] for
+// internet sockets, or [UnixSocketAddress] for UNIX domain sockets.
 type SocketAddress struct {
 	gobject.Object
 }
@@ -119,8 +121,8 @@ func SocketAddressNewFromInternalPtr(ptr uintptr) *SocketAddress {
 
 var xNewSocketAddressFromNative func(uintptr, uint) uintptr
 
-// Creates a #GSocketAddress subclass corresponding to the native
-// struct sockaddr @native.
+// Creates a [SocketAddress] subclass corresponding to the native
+// struct sockaddr native.
 func NewSocketAddressFromNative(NativeVar uintptr, LenVar uint) *SocketAddress {
 	var cls *SocketAddress
 
@@ -136,7 +138,7 @@ func NewSocketAddressFromNative(NativeVar uintptr, LenVar uint) *SocketAddress {
 
 var xSocketAddressGetFamily func(uintptr) SocketFamily
 
-// Gets the socket family type of @address.
+// Gets the socket family type of address.
 func (x *SocketAddress) GetFamily() SocketFamily {
 
 	cret := xSocketAddressGetFamily(x.GoPointer())
@@ -145,9 +147,9 @@ func (x *SocketAddress) GetFamily() SocketFamily {
 
 var xSocketAddressGetNativeSize func(uintptr) int
 
-// Gets the size of @address's native struct sockaddr.
+// Gets the size of address's native struct sockaddr.
 // You can use this to allocate memory to pass to
-// g_socket_address_to_native().
+// [SocketAddress.ToNative].
 func (x *SocketAddress) GetNativeSize() int {
 
 	cret := xSocketAddressGetNativeSize(x.GoPointer())
@@ -156,18 +158,18 @@ func (x *SocketAddress) GetNativeSize() int {
 
 var xSocketAddressToNative func(uintptr, uintptr, uint, **glib.Error) bool
 
-// Converts a #GSocketAddress to a native struct sockaddr, which can
+// Converts a [SocketAddress] to a native struct sockaddr, which can
 // be passed to low-level functions like connect() or bind().
 //
-// If not enough space is available, a %G_IO_ERROR_NO_SPACE error
+// If not enough space is available, a [GIoErrorNoSpaceValue] error
 // is returned. If the address type is not known on the system
-// then a %G_IO_ERROR_NOT_SUPPORTED error is returned.
+// then a [GIoErrorNotSupportedValue] error is returned.
 func (x *SocketAddress) ToNative(DestVar uintptr, DestlenVar uint) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketAddressToNative(x.GoPointer(), DestVar, DestlenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -184,7 +186,7 @@ func (c *SocketAddress) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *SocketAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -198,13 +200,13 @@ func (x *SocketAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *SocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -218,12 +220,12 @@ func (x *SocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *SocketAddress) ToString() string {
 
@@ -236,7 +238,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -245,10 +247,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketAddressGLibType, libs, "g_socket_address_get_type")
 
-	core.PuregoSafeRegister(&xNewSocketAddressFromNative, libs, "g_socket_address_new_from_native")
+	gobject.CheckClassSize("GSocketAddressClass", xSocketAddressGLibType, unsafe.Sizeof(SocketAddressClass{}))
 
-	core.PuregoSafeRegister(&xSocketAddressGetFamily, libs, "g_socket_address_get_family")
-	core.PuregoSafeRegister(&xSocketAddressGetNativeSize, libs, "g_socket_address_get_native_size")
-	core.PuregoSafeRegister(&xSocketAddressToNative, libs, "g_socket_address_to_native")
+	core.PuregoRegisterSince(&xNewSocketAddressFromNative, libs, "g_socket_address_new_from_native", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xSocketAddressGetFamily, libs, "g_socket_address_get_family", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketAddressGetNativeSize, libs, "g_socket_address_get_native_size", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketAddressToNative, libs, "g_socket_address_to_native", "GIO", "2.22")
 
 }
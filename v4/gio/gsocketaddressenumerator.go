@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GSocketAddressEnumerator.
+// Class structure for [SocketAddressEnumerator].
 type SocketAddressEnumeratorClass struct {
 	_ structs.HostLayout
 
@@ -30,12 +29,13 @@ func (x *SocketAddressEnumeratorClass) GoPointer() uintptr {
 }
 
 // OverrideNext sets the "next" callback function.
-// Virtual method for g_socket_address_enumerator_next().
+// Virtual method for [SocketAddressEnumerator.Next].
 func (x *SocketAddressEnumeratorClass) OverrideNext(cb func(*SocketAddressEnumerator, *Cancellable) *SocketAddress) {
 	if cb == nil {
 		x.xNext = 0
 	} else {
-		x.xNext = purego.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr {
+		x.xNext = core.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(SocketAddressEnumeratorNewFromInternalPtr(EnumeratorVarp), CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -46,13 +46,13 @@ func (x *SocketAddressEnumeratorClass) OverrideNext(cb func(*SocketAddressEnumer
 }
 
 // GetNext gets the "next" callback function.
-// Virtual method for g_socket_address_enumerator_next().
+// Virtual method for [SocketAddressEnumerator.Next].
 func (x *SocketAddressEnumeratorClass) GetNext() func(*SocketAddressEnumerator, *Cancellable) *SocketAddress {
 	if x.xNext == 0 {
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xNext)
+	core.RegisterFunc(&rawCallback, x.xNext)
 	return func(EnumeratorVar *SocketAddressEnumerator, CancellableVar *Cancellable) *SocketAddress {
 		rawRet := rawCallback(EnumeratorVar.GoPointer(), CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -65,37 +65,39 @@ func (x *SocketAddressEnumeratorClass) GetNext() func(*SocketAddressEnumerator,
 }
 
 // OverrideNextAsync sets the "next_async" callback function.
-// Virtual method for g_socket_address_enumerator_next_async().
+// Virtual method for [SocketAddressEnumerator.NextAsync].
 func (x *SocketAddressEnumeratorClass) OverrideNextAsync(cb func(*SocketAddressEnumerator, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xNextAsync = 0
 	} else {
-		x.xNextAsync = purego.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xNextAsync = core.NewCallback(func(EnumeratorVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SocketAddressEnumeratorNewFromInternalPtr(EnumeratorVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetNextAsync gets the "next_async" callback function.
-// Virtual method for g_socket_address_enumerator_next_async().
+// Virtual method for [SocketAddressEnumerator.NextAsync].
 func (x *SocketAddressEnumeratorClass) GetNextAsync() func(*SocketAddressEnumerator, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xNextAsync == 0 {
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xNextAsync)
+	core.RegisterFunc(&rawCallback, x.xNextAsync)
 	return func(EnumeratorVar *SocketAddressEnumerator, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(EnumeratorVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideNextFinish sets the "next_finish" callback function.
-// Virtual method for g_socket_address_enumerator_next_finish().
+// Virtual method for [SocketAddressEnumerator.NextFinish].
 func (x *SocketAddressEnumeratorClass) OverrideNextFinish(cb func(*SocketAddressEnumerator, AsyncResult) *SocketAddress) {
 	if cb == nil {
 		x.xNextFinish = 0
 	} else {
-		x.xNextFinish = purego.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xNextFinish = core.NewCallback(func(EnumeratorVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(SocketAddressEnumeratorNewFromInternalPtr(EnumeratorVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -106,13 +108,13 @@ func (x *SocketAddressEnumeratorClass) OverrideNextFinish(cb func(*SocketAddress
 }
 
 // GetNextFinish gets the "next_finish" callback function.
-// Virtual method for g_socket_address_enumerator_next_finish().
+// Virtual method for [SocketAddressEnumerator.NextFinish].
 func (x *SocketAddressEnumeratorClass) GetNextFinish() func(*SocketAddressEnumerator, AsyncResult) *SocketAddress {
 	if x.xNextFinish == 0 {
 		return nil
 	}
 	var rawCallback func(EnumeratorVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xNextFinish)
+	core.RegisterFunc(&rawCallback, x.xNextFinish)
 	return func(EnumeratorVar *SocketAddressEnumerator, ResultVar AsyncResult) *SocketAddress {
 		rawRet := rawCallback(EnumeratorVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -125,18 +127,18 @@ func (x *SocketAddressEnumeratorClass) GetNextFinish() func(*SocketAddressEnumer
 }
 
 // `GSocketAddressEnumerator` is an enumerator type for
-// [class@Gio.SocketAddress] instances. It is returned by enumeration functions
-// such as [method@Gio.SocketConnectable.enumerate], which returns a
-// `GSocketAddressEnumerator` to list each [class@Gio.SocketAddress] which could
-// be used to connect to that [iface@Gio.SocketConnectable].
+// [SocketAddress] instances. It is returned by enumeration functions
+// such as [SocketConnectable.Enumerate], which returns a
+// `GSocketAddressEnumerator` to list each [SocketAddress] which could
+// be used to connect to that [SocketConnectable].
 //
 // Enumeration is typically a blocking operation, so the asynchronous methods
-// [method@Gio.SocketAddressEnumerator.next_async] and
-// [method@Gio.SocketAddressEnumerator.next_finish] should be used where
+// [SocketAddressEnumerator.NextAsync] and
+// [SocketAddressEnumerator.NextFinish] should be used where
 // possible.
 //
 // Each `GSocketAddressEnumerator` can only be enumerated once. Once
-// [method@Gio.SocketAddressEnumerator.next] has returned `NULL`, further
+// [SocketAddressEnumerator.Next] has returned `NULL`, further
 // enumeration with that `GSocketAddressEnumerator` is not possible, and it can
 // be unreffed.
 type SocketAddressEnumerator struct {
@@ -157,18 +159,18 @@ func SocketAddressEnumeratorNewFromInternalPtr(ptr uintptr) *SocketAddressEnumer
 
 var xSocketAddressEnumeratorNext func(uintptr, uintptr, **glib.Error) uintptr
 
-// Retrieves the next #GSocketAddress from @enumerator. Note that this
-// may block for some amount of time. (Eg, a #GNetworkAddress may need
+// Retrieves the next [SocketAddress] from enumerator. Note that this
+// may block for some amount of time. (Eg, a [NetworkAddress] may need
 // to do a DNS lookup before it can return an address.) Use
-// g_socket_address_enumerator_next_async() if you need to avoid
+// [SocketAddressEnumerator.NextAsync] if you need to avoid
 // blocking.
 //
-// If @enumerator is expected to yield addresses, but for some reason
+// If enumerator is expected to yield addresses, but for some reason
 // is unable to (eg, because of a DNS error), then the first call to
-// g_socket_address_enumerator_next() will return an appropriate error
+// [SocketAddressEnumerator.Next] will return an appropriate error
 // in `*error`. However, if the first call to
-// g_socket_address_enumerator_next() succeeds, then any further
-// internal errors (other than @cancellable being triggered) will be
+// [SocketAddressEnumerator.Next] succeeds, then any further
+// internal errors (other than cancellable being triggered) will be
 // ignored.
 func (x *SocketAddressEnumerator) Next(CancellableVar *Cancellable) (*SocketAddress, error) {
 	var cls *SocketAddress
@@ -177,12 +179,15 @@ func (x *SocketAddressEnumerator) Next(CancellableVar *Cancellable) (*SocketAddr
 	cret := xSocketAddressEnumeratorNext(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -190,9 +195,9 @@ func (x *SocketAddressEnumerator) Next(CancellableVar *Cancellable) (*SocketAddr
 
 var xSocketAddressEnumeratorNextAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously retrieves the next #GSocketAddress from @enumerator
-// and then calls @callback, which must call
-// g_socket_address_enumerator_next_finish() to get the result.
+// Asynchronously retrieves the next [SocketAddress] from enumerator
+// and then calls callback, which must call
+// [SocketAddressEnumerator.NextFinish] to get the result.
 //
 // It is an error to call this multiple times before the previous callback has finished.
 func (x *SocketAddressEnumerator) NextAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
@@ -204,11 +209,12 @@ func (x *SocketAddressEnumerator) NextAsync(CancellableVar *Cancellable, Callbac
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -219,8 +225,8 @@ func (x *SocketAddressEnumerator) NextAsync(CancellableVar *Cancellable, Callbac
 var xSocketAddressEnumeratorNextFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Retrieves the result of a completed call to
-// g_socket_address_enumerator_next_async(). See
-// g_socket_address_enumerator_next() for more information about
+// [SocketAddressEnumerator.NextAsync]. See
+// [SocketAddressEnumerator.Next] for more information about
 // error handling.
 func (x *SocketAddressEnumerator) NextFinish(ResultVar AsyncResult) (*SocketAddress, error) {
 	var cls *SocketAddress
@@ -229,12 +235,15 @@ func (x *SocketAddressEnumerator) NextFinish(ResultVar AsyncResult) (*SocketAddr
 	cret := xSocketAddressEnumeratorNextFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -256,7 +265,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -265,8 +274,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketAddressEnumeratorGLibType, libs, "g_socket_address_enumerator_get_type")
 
-	core.PuregoSafeRegister(&xSocketAddressEnumeratorNext, libs, "g_socket_address_enumerator_next")
-	core.PuregoSafeRegister(&xSocketAddressEnumeratorNextAsync, libs, "g_socket_address_enumerator_next_async")
-	core.PuregoSafeRegister(&xSocketAddressEnumeratorNextFinish, libs, "g_socket_address_enumerator_next_finish")
+	gobject.CheckClassSize("GSocketAddressEnumeratorClass", xSocketAddressEnumeratorGLibType, unsafe.Sizeof(SocketAddressEnumeratorClass{}))
+
+	core.PuregoRegisterSince(&xSocketAddressEnumeratorNext, libs, "g_socket_address_enumerator_next", "GIO", "")
+	core.PuregoRegisterSince(&xSocketAddressEnumeratorNextAsync, libs, "g_socket_address_enumerator_next_async", "GIO", "")
+	core.PuregoRegisterSince(&xSocketAddressEnumeratorNextFinish, libs, "g_socket_address_enumerator_next_finish", "GIO", "")
 
 }
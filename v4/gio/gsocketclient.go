@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,8 @@ func (x *SocketClientClass) OverrideEvent(cb func(*SocketClient, SocketClientEve
 	if cb == nil {
 		x.xEvent = 0
 	} else {
-		x.xEvent = purego.NewCallback(func(ClientVarp uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr) {
+		x.xEvent = core.NewCallback(func(ClientVarp uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SocketClientNewFromInternalPtr(ClientVarp), EventVarp, &SocketConnectableBase{Ptr: ConnectableVarp}, IOStreamNewFromInternalPtr(ConnectionVarp))
 		})
 	}
@@ -49,7 +49,7 @@ func (x *SocketClientClass) GetEvent() func(*SocketClient, SocketClientEvent, So
 		return nil
 	}
 	var rawCallback func(ClientVarp uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEvent)
+	core.RegisterFunc(&rawCallback, x.xEvent)
 	return func(ClientVar *SocketClient, EventVar SocketClientEvent, ConnectableVar SocketConnectable, ConnectionVar *IOStream) {
 		rawCallback(ClientVar.GoPointer(), EventVar, ConnectableVar.GoPointer(), ConnectionVar.GoPointer())
 	}
@@ -60,7 +60,8 @@ func (x *SocketClientClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -72,7 +73,7 @@ func (x *SocketClientClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -83,7 +84,8 @@ func (x *SocketClientClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -95,7 +97,7 @@ func (x *SocketClientClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -106,7 +108,8 @@ func (x *SocketClientClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -118,7 +121,7 @@ func (x *SocketClientClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -129,7 +132,8 @@ func (x *SocketClientClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -141,7 +145,7 @@ func (x *SocketClientClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -160,11 +164,11 @@ func (x *SocketClientPrivate) GoPointer() uintptr {
 //
 // You create a `GSocketClient` object, set any options you want, and then
 // call a sync or async connect operation, which returns a
-// [class@Gio.SocketConnection] subclass on success.
+// [SocketConnection] subclass on success.
 //
-// The type of the [class@Gio.SocketConnection] object returned depends on the
+// The type of the [SocketConnection] object returned depends on the
 // type of the underlying socket that is in use. For instance, for a TCP/IP
-// connection it will be a [class@Gio.TcpConnection].
+// connection it will be a [TcpConnection].
 //
 // As `GSocketClient` is a lightweight object, you don't need to cache it. You
 // can just create a new one any time you need one.
@@ -186,7 +190,7 @@ func SocketClientNewFromInternalPtr(ptr uintptr) *SocketClient {
 
 var xNewSocketClient func() uintptr
 
-// Creates a new #GSocketClient with the default options.
+// Creates a new [SocketClient] with the default options.
 func NewSocketClient() *SocketClient {
 	var cls *SocketClient
 
@@ -203,12 +207,12 @@ func NewSocketClient() *SocketClient {
 var xSocketClientAddApplicationProxy func(uintptr, string)
 
 // Enable proxy protocols to be handled by the application. When the
-// indicated proxy protocol is returned by the #GProxyResolver,
-// #GSocketClient will consider this protocol as supported but will
-// not try to find a #GProxy instance to handle handshaking. The
+// indicated proxy protocol is returned by the [ProxyResolver],
+// [SocketClient] will consider this protocol as supported but will
+// not try to find a [Proxy] instance to handle handshaking. The
 // application must check for this case by calling
-// g_socket_connection_get_remote_address() on the returned
-// #GSocketConnection, and seeing if it's a #GProxyAddress of the
+// [SocketConnection.GetRemoteAddress] on the returned
+// [SocketConnection], and seeing if it's a [ProxyAddress] of the
 // appropriate type, to determine whether or not it needs to handle
 // the proxy handshaking itself.
 //
@@ -229,23 +233,23 @@ func (x *SocketClient) AddApplicationProxy(ProtocolVar string) {
 
 var xSocketClientConnect func(uintptr, uintptr, uintptr, **glib.Error) uintptr
 
-// Tries to resolve the @connectable and make a network connection to it.
+// Tries to resolve the connectable and make a network connection to it.
 //
-// Upon a successful connection, a new #GSocketConnection is constructed
+// Upon a successful connection, a new [SocketConnection] is constructed
 // and returned.  The caller owns this new object and must drop their
 // reference to it when finished with it.
 //
-// The type of the #GSocketConnection object returned depends on the type of
+// The type of the [SocketConnection] object returned depends on the type of
 // the underlying socket that is used. For instance, for a TCP/IP connection
-// it will be a #GTcpConnection.
+// it will be a [TcpConnection].
 //
 // The socket created will be the same family as the address that the
-// @connectable resolves to, unless family is set with g_socket_client_set_family()
-// or indirectly via g_socket_client_set_local_address(). The socket type
-// defaults to %G_SOCKET_TYPE_STREAM but can be set with
-// g_socket_client_set_socket_type().
+// connectable resolves to, unless family is set with [SocketClient.SetFamily]
+// or indirectly via [SocketClient.SetLocalAddress]. The socket type
+// defaults to [GSocketTypeStreamValue] but can be set with
+// [SocketClient.SetSocketType].
 //
-// If a local address is specified with g_socket_client_set_local_address() the
+// If a local address is specified with [SocketClient.SetLocalAddress] the
 // socket will be bound to this address before connecting.
 func (x *SocketClient) Connect(ConnectableVar SocketConnectable, CancellableVar *Cancellable) (*SocketConnection, error) {
 	var cls *SocketConnection
@@ -254,12 +258,15 @@ func (x *SocketClient) Connect(ConnectableVar SocketConnectable, CancellableVar
 	cret := xSocketClientConnect(x.GoPointer(), ConnectableVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -267,20 +274,22 @@ func (x *SocketClient) Connect(ConnectableVar SocketConnectable, CancellableVar
 
 var xSocketClientConnectAsync func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// This is the asynchronous version of g_socket_client_connect().
+// This is the asynchronous version of [SocketClient.Connect].
 //
 // You may wish to prefer the asynchronous version even in synchronous
 // command line programs because, since 2.60, it implements
-// [RFC 8305](https://tools.ietf.org/html/rfc8305) "Happy Eyeballs"
+// [RFC 8305] "Happy Eyeballs"
 // recommendations to work around long connection timeouts in networks
 // where IPv6 is broken by performing an IPv4 connection simultaneously
 // without waiting for IPv6 to time out, which is not supported by the
 // synchronous call. (This is not an API guarantee, and may change in
 // the future.)
 //
-// When the operation is finished @callback will be
-// called. You can then call g_socket_client_connect_finish() to get
+// When the operation is finished callback will be
+// called. You can then call [SocketClient.ConnectFinish] to get
 // the result of the operation.
+//
+// [RFC 8305]: https://tools.ietf.org/html/rfc8305
 func (x *SocketClient) ConnectAsync(ConnectableVar SocketConnectable, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -290,11 +299,12 @@ func (x *SocketClient) ConnectAsync(ConnectableVar SocketConnectable, Cancellabl
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -304,7 +314,7 @@ func (x *SocketClient) ConnectAsync(ConnectableVar SocketConnectable, Cancellabl
 
 var xSocketClientConnectFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes an async connect operation. See g_socket_client_connect_async()
+// Finishes an async connect operation. See [SocketClient.ConnectAsync]
 func (x *SocketClient) ConnectFinish(ResultVar AsyncResult) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -312,12 +322,15 @@ func (x *SocketClient) ConnectFinish(ResultVar AsyncResult) (*SocketConnection,
 	cret := xSocketClientConnectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -325,35 +338,35 @@ func (x *SocketClient) ConnectFinish(ResultVar AsyncResult) (*SocketConnection,
 
 var xSocketClientConnectToHost func(uintptr, string, uint16, uintptr, **glib.Error) uintptr
 
-// This is a helper function for g_socket_client_connect().
+// This is a helper function for [SocketClient.Connect].
 //
 // Attempts to create a TCP connection to the named host.
 //
-// @host_and_port may be in any of a number of recognized formats; an IPv6
+// host_and_port may be in any of a number of recognized formats; an IPv6
 // address, an IPv4 address, or a domain name (in which case a DNS
 // lookup is performed).  Quoting with [] is supported for all address
 // types.  A port override may be specified in the usual way with a
 // colon.  Ports may be given as decimal numbers or symbolic names (in
 // which case an /etc/services lookup is performed).
 //
-// If no port override is given in @host_and_port then @default_port will be
+// If no port override is given in host_and_port then default_port will be
 // used as the port number to connect to.
 //
-// In general, @host_and_port is expected to be provided by the user (allowing
+// In general, host_and_port is expected to be provided by the user (allowing
 // them to give the hostname, and a port override if necessary) and
-// @default_port is expected to be provided by the application.
+// default_port is expected to be provided by the application.
 //
 // In the case that an IP address is given, a single connection
 // attempt is made.  In the case that a name is given, multiple
 // connection attempts may be made, in turn and according to the
 // number of address records in DNS, until a connection succeeds.
 //
-// Upon a successful connection, a new #GSocketConnection is constructed
+// Upon a successful connection, a new [SocketConnection] is constructed
 // and returned.  The caller owns this new object and must drop their
 // reference to it when finished with it.
 //
 // In the event of any failure (DNS error, service not found, no hosts
-// connectable) %NULL is returned and @error (if non-%NULL) is set
+// connectable) nil is returned and error (if non-nil) is set
 // accordingly.
 func (x *SocketClient) ConnectToHost(HostAndPortVar string, DefaultPortVar uint16, CancellableVar *Cancellable) (*SocketConnection, error) {
 	var cls *SocketConnection
@@ -362,12 +375,15 @@ func (x *SocketClient) ConnectToHost(HostAndPortVar string, DefaultPortVar uint1
 	cret := xSocketClientConnectToHost(x.GoPointer(), HostAndPortVar, DefaultPortVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -375,10 +391,10 @@ func (x *SocketClient) ConnectToHost(HostAndPortVar string, DefaultPortVar uint1
 
 var xSocketClientConnectToHostAsync func(uintptr, string, uint16, uintptr, uintptr, uintptr)
 
-// This is the asynchronous version of g_socket_client_connect_to_host().
+// This is the asynchronous version of [SocketClient.ConnectToHost].
 //
-// When the operation is finished @callback will be
-// called. You can then call g_socket_client_connect_to_host_finish() to get
+// When the operation is finished callback will be
+// called. You can then call [SocketClient.ConnectToHostFinish] to get
 // the result of the operation.
 func (x *SocketClient) ConnectToHostAsync(HostAndPortVar string, DefaultPortVar uint16, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -389,11 +405,12 @@ func (x *SocketClient) ConnectToHostAsync(HostAndPortVar string, DefaultPortVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -403,7 +420,7 @@ func (x *SocketClient) ConnectToHostAsync(HostAndPortVar string, DefaultPortVar
 
 var xSocketClientConnectToHostFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes an async connect operation. See g_socket_client_connect_to_host_async()
+// Finishes an async connect operation. See [SocketClient.ConnectToHostAsync]
 func (x *SocketClient) ConnectToHostFinish(ResultVar AsyncResult) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -411,12 +428,15 @@ func (x *SocketClient) ConnectToHostFinish(ResultVar AsyncResult) (*SocketConnec
 	cret := xSocketClientConnectToHostFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -426,17 +446,17 @@ var xSocketClientConnectToService func(uintptr, string, string, uintptr, **glib.
 
 // Attempts to create a TCP connection to a service.
 //
-// This call looks up the SRV record for @service at @domain for the
+// This call looks up the SRV record for service at domain for the
 // "tcp" protocol.  It then attempts to connect, in turn, to each of
 // the hosts providing the service until either a connection succeeds
 // or there are no hosts remaining.
 //
-// Upon a successful connection, a new #GSocketConnection is constructed
+// Upon a successful connection, a new [SocketConnection] is constructed
 // and returned.  The caller owns this new object and must drop their
 // reference to it when finished with it.
 //
 // In the event of any failure (DNS error, service not found, no hosts
-// connectable) %NULL is returned and @error (if non-%NULL) is set
+// connectable) nil is returned and error (if non-nil) is set
 // accordingly.
 func (x *SocketClient) ConnectToService(DomainVar string, ServiceVar string, CancellableVar *Cancellable) (*SocketConnection, error) {
 	var cls *SocketConnection
@@ -445,12 +465,15 @@ func (x *SocketClient) ConnectToService(DomainVar string, ServiceVar string, Can
 	cret := xSocketClientConnectToService(x.GoPointer(), DomainVar, ServiceVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -459,7 +482,7 @@ func (x *SocketClient) ConnectToService(DomainVar string, ServiceVar string, Can
 var xSocketClientConnectToServiceAsync func(uintptr, string, string, uintptr, uintptr, uintptr)
 
 // This is the asynchronous version of
-// g_socket_client_connect_to_service().
+// [SocketClient.ConnectToService].
 func (x *SocketClient) ConnectToServiceAsync(DomainVar string, ServiceVar string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -469,11 +492,12 @@ func (x *SocketClient) ConnectToServiceAsync(DomainVar string, ServiceVar string
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -483,7 +507,7 @@ func (x *SocketClient) ConnectToServiceAsync(DomainVar string, ServiceVar string
 
 var xSocketClientConnectToServiceFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes an async connect operation. See g_socket_client_connect_to_service_async()
+// Finishes an async connect operation. See [SocketClient.ConnectToServiceAsync]
 func (x *SocketClient) ConnectToServiceFinish(ResultVar AsyncResult) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -491,12 +515,15 @@ func (x *SocketClient) ConnectToServiceFinish(ResultVar AsyncResult) (*SocketCon
 	cret := xSocketClientConnectToServiceFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -504,26 +531,26 @@ func (x *SocketClient) ConnectToServiceFinish(ResultVar AsyncResult) (*SocketCon
 
 var xSocketClientConnectToUri func(uintptr, string, uint16, uintptr, **glib.Error) uintptr
 
-// This is a helper function for g_socket_client_connect().
+// This is a helper function for [SocketClient.Connect].
 //
 // Attempts to create a TCP connection with a network URI.
 //
-// @uri may be any valid URI containing an "authority" (hostname/port)
-// component. If a port is not specified in the URI, @default_port
-// will be used. TLS will be negotiated if #GSocketClient:tls is %TRUE.
-// (#GSocketClient does not know to automatically assume TLS for
+// uri may be any valid URI containing an "authority" (hostname/port)
+// component. If a port is not specified in the URI, default_port
+// will be used. TLS will be negotiated if [SocketClient.GetPropertyTls] is true.
+// ([SocketClient] does not know to automatically assume TLS for
 // certain URI schemes.)
 //
-// Using this rather than g_socket_client_connect() or
-// g_socket_client_connect_to_host() allows #GSocketClient to
+// Using this rather than [SocketClient.Connect] or
+// [SocketClient.ConnectToHost] allows [SocketClient] to
 // determine when to use application-specific proxy protocols.
 //
-// Upon a successful connection, a new #GSocketConnection is constructed
+// Upon a successful connection, a new [SocketConnection] is constructed
 // and returned.  The caller owns this new object and must drop their
 // reference to it when finished with it.
 //
 // In the event of any failure (DNS error, service not found, no hosts
-// connectable) %NULL is returned and @error (if non-%NULL) is set
+// connectable) nil is returned and error (if non-nil) is set
 // accordingly.
 func (x *SocketClient) ConnectToUri(UriVar string, DefaultPortVar uint16, CancellableVar *Cancellable) (*SocketConnection, error) {
 	var cls *SocketConnection
@@ -532,12 +559,15 @@ func (x *SocketClient) ConnectToUri(UriVar string, DefaultPortVar uint16, Cancel
 	cret := xSocketClientConnectToUri(x.GoPointer(), UriVar, DefaultPortVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -545,10 +575,10 @@ func (x *SocketClient) ConnectToUri(UriVar string, DefaultPortVar uint16, Cancel
 
 var xSocketClientConnectToUriAsync func(uintptr, string, uint16, uintptr, uintptr, uintptr)
 
-// This is the asynchronous version of g_socket_client_connect_to_uri().
+// This is the asynchronous version of [SocketClient.ConnectToUri].
 //
-// When the operation is finished @callback will be
-// called. You can then call g_socket_client_connect_to_uri_finish() to get
+// When the operation is finished callback will be
+// called. You can then call [SocketClient.ConnectToUriFinish] to get
 // the result of the operation.
 func (x *SocketClient) ConnectToUriAsync(UriVar string, DefaultPortVar uint16, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -559,11 +589,12 @@ func (x *SocketClient) ConnectToUriAsync(UriVar string, DefaultPortVar uint16, C
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -573,7 +604,7 @@ func (x *SocketClient) ConnectToUriAsync(UriVar string, DefaultPortVar uint16, C
 
 var xSocketClientConnectToUriFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes an async connect operation. See g_socket_client_connect_to_uri_async()
+// Finishes an async connect operation. See [SocketClient.ConnectToUriAsync]
 func (x *SocketClient) ConnectToUriFinish(ResultVar AsyncResult) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -581,12 +612,15 @@ func (x *SocketClient) ConnectToUriFinish(ResultVar AsyncResult) (*SocketConnect
 	cret := xSocketClientConnectToUriFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -594,7 +628,7 @@ func (x *SocketClient) ConnectToUriFinish(ResultVar AsyncResult) (*SocketConnect
 
 var xSocketClientGetEnableProxy func(uintptr) bool
 
-// Gets the proxy enable state; see g_socket_client_set_enable_proxy()
+// Gets the proxy enable state; see [SocketClient.SetEnableProxy]
 func (x *SocketClient) GetEnableProxy() bool {
 
 	cret := xSocketClientGetEnableProxy(x.GoPointer())
@@ -605,7 +639,7 @@ var xSocketClientGetFamily func(uintptr) SocketFamily
 
 // Gets the socket family of the socket client.
 //
-// See g_socket_client_set_family() for details.
+// See [SocketClient.SetFamily] for details.
 func (x *SocketClient) GetFamily() SocketFamily {
 
 	cret := xSocketClientGetFamily(x.GoPointer())
@@ -616,7 +650,7 @@ var xSocketClientGetLocalAddress func(uintptr) uintptr
 
 // Gets the local address of the socket client.
 //
-// See g_socket_client_set_local_address() for details.
+// See [SocketClient.SetLocalAddress] for details.
 func (x *SocketClient) GetLocalAddress() *SocketAddress {
 	var cls *SocketAddress
 
@@ -635,7 +669,7 @@ var xSocketClientGetProtocol func(uintptr) SocketProtocol
 
 // Gets the protocol name type of the socket client.
 //
-// See g_socket_client_set_protocol() for details.
+// See [SocketClient.SetProtocol] for details.
 func (x *SocketClient) GetProtocol() SocketProtocol {
 
 	cret := xSocketClientGetProtocol(x.GoPointer())
@@ -644,9 +678,9 @@ func (x *SocketClient) GetProtocol() SocketProtocol {
 
 var xSocketClientGetProxyResolver func(uintptr) uintptr
 
-// Gets the #GProxyResolver being used by @client. Normally, this will
-// be the resolver returned by g_proxy_resolver_get_default(), but you
-// can override it with g_socket_client_set_proxy_resolver().
+// Gets the [ProxyResolver] being used by client. Normally, this will
+// be the resolver returned by [ProxyResolverGetDefault], but you
+// can override it with [SocketClient.SetProxyResolver].
 func (x *SocketClient) GetProxyResolver() *ProxyResolverBase {
 	var cls *ProxyResolverBase
 
@@ -665,7 +699,7 @@ var xSocketClientGetSocketType func(uintptr) SocketType
 
 // Gets the socket type of the socket client.
 //
-// See g_socket_client_set_socket_type() for details.
+// See [SocketClient.SetSocketType] for details.
 func (x *SocketClient) GetSocketType() SocketType {
 
 	cret := xSocketClientGetSocketType(x.GoPointer())
@@ -674,9 +708,9 @@ func (x *SocketClient) GetSocketType() SocketType {
 
 var xSocketClientGetTimeout func(uintptr) uint
 
-// Gets the I/O timeout time for sockets created by @client.
+// Gets the I/O timeout time for sockets created by client.
 //
-// See g_socket_client_set_timeout() for details.
+// See [SocketClient.SetTimeout] for details.
 func (x *SocketClient) GetTimeout() uint {
 
 	cret := xSocketClientGetTimeout(x.GoPointer())
@@ -685,36 +719,22 @@ func (x *SocketClient) GetTimeout() uint {
 
 var xSocketClientGetTls func(uintptr) bool
 
-// Gets whether @client creates TLS connections. See
-// g_socket_client_set_tls() for details.
+// Gets whether client creates TLS connections. See
+// [SocketClient.SetTls] for details.
 func (x *SocketClient) GetTls() bool {
 
 	cret := xSocketClientGetTls(x.GoPointer())
 	return cret
 }
 
-var xSocketClientGetTlsValidationFlags func(uintptr) TlsCertificateFlags
-
-// Gets the TLS validation flags used creating TLS connections via
-// @client.
-//
-// This function does not work as originally designed and is impossible
-// to use correctly. See #GSocketClient:tls-validation-flags for more
-// information.
-func (x *SocketClient) GetTlsValidationFlags() TlsCertificateFlags {
-
-	cret := xSocketClientGetTlsValidationFlags(x.GoPointer())
-	return cret
-}
-
 var xSocketClientSetEnableProxy func(uintptr, bool)
 
-// Sets whether or not @client attempts to make connections via a
-// proxy server. When enabled (the default), #GSocketClient will use a
-// #GProxyResolver to determine if a proxy protocol such as SOCKS is
+// Sets whether or not client attempts to make connections via a
+// proxy server. When enabled (the default), [SocketClient] will use a
+// [ProxyResolver] to determine if a proxy protocol such as SOCKS is
 // needed, and automatically do the necessary proxy negotiation.
 //
-// See also g_socket_client_set_proxy_resolver().
+// See also [SocketClient.SetProxyResolver].
 func (x *SocketClient) SetEnableProxy(EnableVar bool) {
 
 	xSocketClientSetEnableProxy(x.GoPointer(), EnableVar)
@@ -724,7 +744,7 @@ func (x *SocketClient) SetEnableProxy(EnableVar bool) {
 var xSocketClientSetFamily func(uintptr, SocketFamily)
 
 // Sets the socket family of the socket client.
-// If this is set to something other than %G_SOCKET_FAMILY_INVALID
+// If this is set to something other than [GSocketFamilyInvalidValue]
 // then the sockets created by this object will be of the specified
 // family.
 //
@@ -741,7 +761,7 @@ var xSocketClientSetLocalAddress func(uintptr, uintptr)
 
 // Sets the local address of the socket client.
 // The sockets created by this object will bound to the
-// specified address (if not %NULL) before connecting.
+// specified address (if not nil) before connecting.
 //
 // This is useful if you want to ensure that the local
 // side of the connection is on a specific port, or on
@@ -758,7 +778,7 @@ var xSocketClientSetProtocol func(uintptr, SocketProtocol)
 // The sockets created by this object will use of the specified
 // protocol.
 //
-// If @protocol is %G_SOCKET_PROTOCOL_DEFAULT that means to use the default
+// If protocol is [GSocketProtocolDefaultValue] that means to use the default
 // protocol for the socket family and type.
 func (x *SocketClient) SetProtocol(ProtocolVar SocketProtocol) {
 
@@ -768,13 +788,13 @@ func (x *SocketClient) SetProtocol(ProtocolVar SocketProtocol) {
 
 var xSocketClientSetProxyResolver func(uintptr, uintptr)
 
-// Overrides the #GProxyResolver used by @client. You can call this if
+// Overrides the [ProxyResolver] used by client. You can call this if
 // you want to use specific proxies, rather than using the system
 // default proxy settings.
 //
 // Note that whether or not the proxy resolver is actually used
-// depends on the setting of #GSocketClient:enable-proxy, which is not
-// changed by this function (but which is %TRUE by default)
+// depends on the setting of [SocketClient.GetPropertyEnableProxy], which is not
+// changed by this function (but which is true by default)
 func (x *SocketClient) SetProxyResolver(ProxyResolverVar ProxyResolver) {
 
 	xSocketClientSetProxyResolver(x.GoPointer(), ProxyResolverVar.GoPointer())
@@ -787,7 +807,7 @@ var xSocketClientSetSocketType func(uintptr, SocketType)
 // The sockets created by this object will be of the specified
 // type.
 //
-// It doesn't make sense to specify a type of %G_SOCKET_TYPE_DATAGRAM,
+// It doesn't make sense to specify a type of [GSocketTypeDatagramValue],
 // as GSocketClient is used for connection oriented services.
 func (x *SocketClient) SetSocketType(TypeVar SocketType) {
 
@@ -797,12 +817,12 @@ func (x *SocketClient) SetSocketType(TypeVar SocketType) {
 
 var xSocketClientSetTimeout func(uintptr, uint)
 
-// Sets the I/O timeout for sockets created by @client. @timeout is a
+// Sets the I/O timeout for sockets created by client. timeout is a
 // time in seconds, or 0 for no timeout (the default).
 //
 // The timeout value affects the initial connection attempt as well,
-// so setting this may cause calls to g_socket_client_connect(), etc,
-// to fail with %G_IO_ERROR_TIMED_OUT.
+// so setting this may cause calls to [SocketClient.Connect], etc,
+// to fail with [GIoErrorTimedOutValue].
 func (x *SocketClient) SetTimeout(TimeoutVar uint) {
 
 	xSocketClientSetTimeout(x.GoPointer(), TimeoutVar)
@@ -811,23 +831,23 @@ func (x *SocketClient) SetTimeout(TimeoutVar uint) {
 
 var xSocketClientSetTls func(uintptr, bool)
 
-// Sets whether @client creates TLS (aka SSL) connections. If @tls is
-// %TRUE, @client will wrap its connections in a #GTlsClientConnection
+// Sets whether client creates TLS (aka SSL) connections. If tls is
+// true, client will wrap its connections in a [TlsClientConnection]
 // and perform a TLS handshake when connecting.
 //
-// Note that since #GSocketClient must return a #GSocketConnection,
-// but #GTlsClientConnection is not a #GSocketConnection, this
-// actually wraps the resulting #GTlsClientConnection in a
-// #GTcpWrapperConnection when returning it. You can use
-// g_tcp_wrapper_connection_get_base_io_stream() on the return value
-// to extract the #GTlsClientConnection.
+// Note that since [SocketClient] must return a [SocketConnection],
+// but [TlsClientConnection] is not a [SocketConnection], this
+// actually wraps the resulting [TlsClientConnection] in a
+// [TcpWrapperConnection] when returning it. You can use
+// [TcpWrapperConnection.GetBaseIoStream] on the return value
+// to extract the [TlsClientConnection].
 //
 // If you need to modify the behavior of the TLS handshake (eg, by
 // setting a client-side certificate to use, or connecting to the
-// #GTlsConnection::accept-certificate signal), you can connect to
-// @client's #GSocketClient::event signal and wait for it to be
-// emitted with %G_SOCKET_CLIENT_TLS_HANDSHAKING, which will give you
-// a chance to see the #GTlsClientConnection before the handshake
+// [TlsConnection.ConnectAcceptCertificateFunc] signal), you can connect to
+// client's [SocketClient.ConnectEventFunc] signal and wait for it to be
+// emitted with [GSocketClientTlsHandshakingValue], which will give you
+// a chance to see the [TlsClientConnection] before the handshake
 // starts.
 func (x *SocketClient) SetTls(TlsVar bool) {
 
@@ -835,20 +855,6 @@ func (x *SocketClient) SetTls(TlsVar bool) {
 
 }
 
-var xSocketClientSetTlsValidationFlags func(uintptr, TlsCertificateFlags)
-
-// Sets the TLS validation flags used when creating TLS connections
-// via @client. The default value is %G_TLS_CERTIFICATE_VALIDATE_ALL.
-//
-// This function does not work as originally designed and is impossible
-// to use correctly. See #GSocketClient:tls-validation-flags for more
-// information.
-func (x *SocketClient) SetTlsValidationFlags(FlagsVar TlsCertificateFlags) {
-
-	xSocketClientSetTlsValidationFlags(x.GoPointer(), FlagsVar)
-
-}
-
 func (c *SocketClient) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -911,76 +917,98 @@ func (x *SocketClient) GetPropertyTls() bool {
 	return v.GetBoolean()
 }
 
-// Emitted when @client's activity on @connectable changes state.
+// Emitted when client's activity on connectable changes state.
 // Among other things, this can be used to provide progress
 // information about a network connection in the UI. The meanings of
-// the different @event values are as follows:
+// the different event values are as follows:
 //
-//   - %G_SOCKET_CLIENT_RESOLVING: @client is about to look up @connectable
-//     in DNS. @connection will be %NULL.
+//   - [GSocketClientResolvingValue]: client is about to look up connectable
+//     in DNS. connection will be nil.
 //
-//   - %G_SOCKET_CLIENT_RESOLVED:  @client has successfully resolved
-//     @connectable in DNS. @connection will be %NULL.
+//   - [GSocketClientResolvedValue]:  client has successfully resolved
+//     connectable in DNS. connection will be nil.
 //
-//   - %G_SOCKET_CLIENT_CONNECTING: @client is about to make a connection
+//   - [GSocketClientConnectingValue]: client is about to make a connection
 //     to a remote host; either a proxy server or the destination server
-//     itself. @connection is the #GSocketConnection, which is not yet
+//     itself. connection is the [SocketConnection], which is not yet
 //     connected.  Since GLib 2.40, you can access the remote
-//     address via g_socket_connection_get_remote_address().
+//     address via [SocketConnection.GetRemoteAddress].
 //
-//   - %G_SOCKET_CLIENT_CONNECTED: @client has successfully connected
-//     to a remote host. @connection is the connected #GSocketConnection.
+//   - [GSocketClientConnectedValue]: client has successfully connected
+//     to a remote host. connection is the connected [SocketConnection].
 //
-//   - %G_SOCKET_CLIENT_PROXY_NEGOTIATING: @client is about to negotiate
-//     with a proxy to get it to connect to @connectable. @connection is
-//     the #GSocketConnection to the proxy server.
+//   - [GSocketClientProxyNegotiatingValue]: client is about to negotiate
+//     with a proxy to get it to connect to connectable. connection is
+//     the [SocketConnection] to the proxy server.
 //
-//   - %G_SOCKET_CLIENT_PROXY_NEGOTIATED: @client has negotiated a
-//     connection to @connectable through a proxy server. @connection is
-//     the stream returned from g_proxy_connect(), which may or may not
-//     be a #GSocketConnection.
+//   - [GSocketClientProxyNegotiatedValue]: client has negotiated a
+//     connection to connectable through a proxy server. connection is
+//     the stream returned from [Proxy.Connect], which may or may not
+//     be a [SocketConnection].
 //
-//   - %G_SOCKET_CLIENT_TLS_HANDSHAKING: @client is about to begin a TLS
-//     handshake. @connection is a #GTlsClientConnection.
+//   - [GSocketClientTlsHandshakingValue]: client is about to begin a TLS
+//     handshake. connection is a [TlsClientConnection].
 //
-//   - %G_SOCKET_CLIENT_TLS_HANDSHAKED: @client has successfully completed
-//     the TLS handshake. @connection is a #GTlsClientConnection.
+//   - [GSocketClientTlsHandshakedValue]: client has successfully completed
+//     the TLS handshake. connection is a [TlsClientConnection].
 //
-//   - %G_SOCKET_CLIENT_COMPLETE: @client has either successfully connected
-//     to @connectable (in which case @connection is the #GSocketConnection
+//   - [GSocketClientCompleteValue]: client has either successfully connected
+//     to connectable (in which case connection is the [SocketConnection]
 //     that it will be returning to the caller) or has failed (in which
-//     case @connection is %NULL and the client is about to return an error).
+//     case connection is nil and the client is about to return an error).
 //
-// Each event except %G_SOCKET_CLIENT_COMPLETE may be emitted
+// Each event except [GSocketClientCompleteValue] may be emitted
 // multiple times (or not at all) for a given connectable (in
-// particular, if @client ends up attempting to connect to more than
-// one address). However, if @client emits the #GSocketClient::event
+// particular, if client ends up attempting to connect to more than
+// one address). However, if client emits the [SocketClient.ConnectEventFunc]
 // signal at all for a given connectable, then it will always emit
-// it with %G_SOCKET_CLIENT_COMPLETE when it is done.
+// it with [GSocketClientCompleteValue] when it is done.
 //
-// Note that there may be additional #GSocketClientEvent values in
-// the future; unrecognized @event values should be ignored.
+// Note that there may be additional [SocketClientEvent] values in
+// the future; unrecognized event values should be ignored.
+//
+// Deprecated: use ConnectEventFunc, which also accepts method values and closures.
 func (x *SocketClient) ConnectEvent(cb *func(SocketClient, SocketClientEvent, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SocketClient{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, EventVarp, ConnectableVarp, ConnectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "event", cbRefPtr, cbPtr)
+}
+
+var xSocketClientEventTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr) {
+func xSocketClientEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, EventVarp SocketClientEvent, ConnectableVarp uintptr, ConnectionVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SocketClient, SocketClientEvent, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SocketClient{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, EventVarp, ConnectableVarp, ConnectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEventFunc connects cb to the "event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SocketClient) ConnectEventFunc(cb func(SocketClient, SocketClientEvent, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "event", &xSocketClientEventTrampoline, xSocketClientEventNewTrampoline, cb)
 }
 
 func init() {
@@ -988,7 +1016,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -997,38 +1025,38 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketClientGLibType, libs, "g_socket_client_get_type")
 
-	core.PuregoSafeRegister(&xNewSocketClient, libs, "g_socket_client_new")
-
-	core.PuregoSafeRegister(&xSocketClientAddApplicationProxy, libs, "g_socket_client_add_application_proxy")
-	core.PuregoSafeRegister(&xSocketClientConnect, libs, "g_socket_client_connect")
-	core.PuregoSafeRegister(&xSocketClientConnectAsync, libs, "g_socket_client_connect_async")
-	core.PuregoSafeRegister(&xSocketClientConnectFinish, libs, "g_socket_client_connect_finish")
-	core.PuregoSafeRegister(&xSocketClientConnectToHost, libs, "g_socket_client_connect_to_host")
-	core.PuregoSafeRegister(&xSocketClientConnectToHostAsync, libs, "g_socket_client_connect_to_host_async")
-	core.PuregoSafeRegister(&xSocketClientConnectToHostFinish, libs, "g_socket_client_connect_to_host_finish")
-	core.PuregoSafeRegister(&xSocketClientConnectToService, libs, "g_socket_client_connect_to_service")
-	core.PuregoSafeRegister(&xSocketClientConnectToServiceAsync, libs, "g_socket_client_connect_to_service_async")
-	core.PuregoSafeRegister(&xSocketClientConnectToServiceFinish, libs, "g_socket_client_connect_to_service_finish")
-	core.PuregoSafeRegister(&xSocketClientConnectToUri, libs, "g_socket_client_connect_to_uri")
-	core.PuregoSafeRegister(&xSocketClientConnectToUriAsync, libs, "g_socket_client_connect_to_uri_async")
-	core.PuregoSafeRegister(&xSocketClientConnectToUriFinish, libs, "g_socket_client_connect_to_uri_finish")
-	core.PuregoSafeRegister(&xSocketClientGetEnableProxy, libs, "g_socket_client_get_enable_proxy")
-	core.PuregoSafeRegister(&xSocketClientGetFamily, libs, "g_socket_client_get_family")
-	core.PuregoSafeRegister(&xSocketClientGetLocalAddress, libs, "g_socket_client_get_local_address")
-	core.PuregoSafeRegister(&xSocketClientGetProtocol, libs, "g_socket_client_get_protocol")
-	core.PuregoSafeRegister(&xSocketClientGetProxyResolver, libs, "g_socket_client_get_proxy_resolver")
-	core.PuregoSafeRegister(&xSocketClientGetSocketType, libs, "g_socket_client_get_socket_type")
-	core.PuregoSafeRegister(&xSocketClientGetTimeout, libs, "g_socket_client_get_timeout")
-	core.PuregoSafeRegister(&xSocketClientGetTls, libs, "g_socket_client_get_tls")
-	core.PuregoSafeRegister(&xSocketClientGetTlsValidationFlags, libs, "g_socket_client_get_tls_validation_flags")
-	core.PuregoSafeRegister(&xSocketClientSetEnableProxy, libs, "g_socket_client_set_enable_proxy")
-	core.PuregoSafeRegister(&xSocketClientSetFamily, libs, "g_socket_client_set_family")
-	core.PuregoSafeRegister(&xSocketClientSetLocalAddress, libs, "g_socket_client_set_local_address")
-	core.PuregoSafeRegister(&xSocketClientSetProtocol, libs, "g_socket_client_set_protocol")
-	core.PuregoSafeRegister(&xSocketClientSetProxyResolver, libs, "g_socket_client_set_proxy_resolver")
-	core.PuregoSafeRegister(&xSocketClientSetSocketType, libs, "g_socket_client_set_socket_type")
-	core.PuregoSafeRegister(&xSocketClientSetTimeout, libs, "g_socket_client_set_timeout")
-	core.PuregoSafeRegister(&xSocketClientSetTls, libs, "g_socket_client_set_tls")
-	core.PuregoSafeRegister(&xSocketClientSetTlsValidationFlags, libs, "g_socket_client_set_tls_validation_flags")
+	gobject.CheckClassSize("GSocketClientClass", xSocketClientGLibType, unsafe.Sizeof(SocketClientClass{}))
+
+	core.PuregoRegisterSince(&xNewSocketClient, libs, "g_socket_client_new", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xSocketClientAddApplicationProxy, libs, "g_socket_client_add_application_proxy", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnect, libs, "g_socket_client_connect", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectAsync, libs, "g_socket_client_connect_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectFinish, libs, "g_socket_client_connect_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToHost, libs, "g_socket_client_connect_to_host", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToHostAsync, libs, "g_socket_client_connect_to_host_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToHostFinish, libs, "g_socket_client_connect_to_host_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToService, libs, "g_socket_client_connect_to_service", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToServiceAsync, libs, "g_socket_client_connect_to_service_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToServiceFinish, libs, "g_socket_client_connect_to_service_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientConnectToUri, libs, "g_socket_client_connect_to_uri", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientConnectToUriAsync, libs, "g_socket_client_connect_to_uri_async", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientConnectToUriFinish, libs, "g_socket_client_connect_to_uri_finish", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientGetEnableProxy, libs, "g_socket_client_get_enable_proxy", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientGetFamily, libs, "g_socket_client_get_family", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientGetLocalAddress, libs, "g_socket_client_get_local_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientGetProtocol, libs, "g_socket_client_get_protocol", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientGetProxyResolver, libs, "g_socket_client_get_proxy_resolver", "GIO", "2.36")
+	core.PuregoRegisterSince(&xSocketClientGetSocketType, libs, "g_socket_client_get_socket_type", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientGetTimeout, libs, "g_socket_client_get_timeout", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientGetTls, libs, "g_socket_client_get_tls", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSocketClientSetEnableProxy, libs, "g_socket_client_set_enable_proxy", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientSetFamily, libs, "g_socket_client_set_family", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientSetLocalAddress, libs, "g_socket_client_set_local_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientSetProtocol, libs, "g_socket_client_set_protocol", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientSetProxyResolver, libs, "g_socket_client_set_proxy_resolver", "GIO", "2.36")
+	core.PuregoRegisterSince(&xSocketClientSetSocketType, libs, "g_socket_client_set_socket_type", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketClientSetTimeout, libs, "g_socket_client_set_timeout", "GIO", "2.26")
+	core.PuregoRegisterSince(&xSocketClientSetTls, libs, "g_socket_client_set_tls", "GIO", "2.28")
 
 }
@@ -0,0 +1,57 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xSocketClientGetTlsValidationFlags func(uintptr) TlsCertificateFlags
+
+// Gets the TLS validation flags used creating TLS connections via
+// client.
+//
+// This function does not work as originally designed and is impossible
+// to use correctly. See GSocketClient:tls-validation-flags for more
+// information.
+//
+// Deprecated: since 2.72. Do not attempt to ignore validation errors.
+func (x *SocketClient) GetTlsValidationFlags() TlsCertificateFlags {
+
+	cret := xSocketClientGetTlsValidationFlags(x.GoPointer())
+	return cret
+}
+
+var xSocketClientSetTlsValidationFlags func(uintptr, TlsCertificateFlags)
+
+// Sets the TLS validation flags used when creating TLS connections
+// via client. The default value is [GTlsCertificateValidateAllValue].
+//
+// This function does not work as originally designed and is impossible
+// to use correctly. See GSocketClient:tls-validation-flags for more
+// information.
+//
+// Deprecated: since 2.72. Do not attempt to ignore validation errors.
+func (x *SocketClient) SetTlsValidationFlags(FlagsVar TlsCertificateFlags) {
+
+	xSocketClientSetTlsValidationFlags(x.GoPointer(), FlagsVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSocketClientGetTlsValidationFlags, libs, "g_socket_client_get_tls_validation_flags", "GIO", "2.28")
+	core.PuregoRegisterSince(&xSocketClientSetTlsValidationFlags, libs, "g_socket_client_set_tls_validation_flags", "GIO", "2.28")
+
+}
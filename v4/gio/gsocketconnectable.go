@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Provides an interface for returning a #GSocketAddressEnumerator
-// and #GProxyAddressEnumerator
+// Provides an interface for returning a [SocketAddressEnumerator]
+// and [ProxyAddressEnumerator]
 type SocketConnectableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xEnumerate uintptr
 
@@ -29,12 +29,13 @@ func (x *SocketConnectableIface) GoPointer() uintptr {
 }
 
 // OverrideEnumerate sets the "enumerate" callback function.
-// Creates a #GSocketAddressEnumerator
+// Creates a [SocketAddressEnumerator]
 func (x *SocketConnectableIface) OverrideEnumerate(cb func(SocketConnectable) *SocketAddressEnumerator) {
 	if cb == nil {
 		x.xEnumerate = 0
 	} else {
-		x.xEnumerate = purego.NewCallback(func(ConnectableVarp uintptr) uintptr {
+		x.xEnumerate = core.NewCallback(func(ConnectableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&SocketConnectableBase{Ptr: ConnectableVarp})
 			if ret == nil {
 				return 0
@@ -45,13 +46,13 @@ func (x *SocketConnectableIface) OverrideEnumerate(cb func(SocketConnectable) *S
 }
 
 // GetEnumerate gets the "enumerate" callback function.
-// Creates a #GSocketAddressEnumerator
+// Creates a [SocketAddressEnumerator]
 func (x *SocketConnectableIface) GetEnumerate() func(SocketConnectable) *SocketAddressEnumerator {
 	if x.xEnumerate == 0 {
 		return nil
 	}
 	var rawCallback func(ConnectableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xEnumerate)
+	core.RegisterFunc(&rawCallback, x.xEnumerate)
 	return func(ConnectableVar SocketConnectable) *SocketAddressEnumerator {
 		rawRet := rawCallback(ConnectableVar.GoPointer())
 		if rawRet == 0 {
@@ -64,12 +65,13 @@ func (x *SocketConnectableIface) GetEnumerate() func(SocketConnectable) *SocketA
 }
 
 // OverrideProxyEnumerate sets the "proxy_enumerate" callback function.
-// Creates a #GProxyAddressEnumerator
+// Creates a [ProxyAddressEnumerator]
 func (x *SocketConnectableIface) OverrideProxyEnumerate(cb func(SocketConnectable) *SocketAddressEnumerator) {
 	if cb == nil {
 		x.xProxyEnumerate = 0
 	} else {
-		x.xProxyEnumerate = purego.NewCallback(func(ConnectableVarp uintptr) uintptr {
+		x.xProxyEnumerate = core.NewCallback(func(ConnectableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&SocketConnectableBase{Ptr: ConnectableVarp})
 			if ret == nil {
 				return 0
@@ -80,13 +82,13 @@ func (x *SocketConnectableIface) OverrideProxyEnumerate(cb func(SocketConnectabl
 }
 
 // GetProxyEnumerate gets the "proxy_enumerate" callback function.
-// Creates a #GProxyAddressEnumerator
+// Creates a [ProxyAddressEnumerator]
 func (x *SocketConnectableIface) GetProxyEnumerate() func(SocketConnectable) *SocketAddressEnumerator {
 	if x.xProxyEnumerate == 0 {
 		return nil
 	}
 	var rawCallback func(ConnectableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xProxyEnumerate)
+	core.RegisterFunc(&rawCallback, x.xProxyEnumerate)
 	return func(ConnectableVar SocketConnectable) *SocketAddressEnumerator {
 		rawRet := rawCallback(ConnectableVar.GoPointer())
 		if rawRet == 0 {
@@ -106,7 +108,8 @@ func (x *SocketConnectableIface) OverrideToString(cb func(SocketConnectable) str
 	if cb == nil {
 		x.xToString = 0
 	} else {
-		x.xToString = purego.NewCallback(func(ConnectableVarp uintptr) string {
+		x.xToString = core.NewCallback(func(ConnectableVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&SocketConnectableBase{Ptr: ConnectableVarp})
 		})
 	}
@@ -121,7 +124,7 @@ func (x *SocketConnectableIface) GetToString() func(SocketConnectable) string {
 		return nil
 	}
 	var rawCallback func(ConnectableVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xToString)
+	core.RegisterFunc(&rawCallback, x.xToString)
 	return func(ConnectableVar SocketConnectable) string {
 		return rawCallback(ConnectableVar.GoPointer())
 	}
@@ -129,18 +132,15 @@ func (x *SocketConnectableIface) GetToString() func(SocketConnectable) string {
 
 // Objects that describe one or more potential socket endpoints
 // implement `GSocketConnectable`. Callers can then use
-// [method@Gio.SocketConnectable.enumerate] to get a
-// [class@Gio.SocketAddressEnumerator] to try out each socket address in turn
+// [SocketConnectable.Enumerate] to get a
+// [SocketAddressEnumerator] to try out each socket address in turn
 // until one succeeds, as shown in the sample code below.
 //
-// ```c
-// MyConnectionType *
-// connect_to_host (const char    *hostname,
-//
-//	guint16        port,
-//	GCancellable  *cancellable,
-//	GError       **error)
-//
+//	MyConnectionType *
+//	connect_to_host (const char    *hostname,
+//	                 guint16        port,
+//	                 GCancellable  *cancellable,
+//	                 GError       **error)
 //	{
 //	  MyConnection *conn = NULL;
 //	  GSocketConnectable *addr;
@@ -155,9 +155,9 @@ func (x *SocketConnectableIface) GetToString() func(SocketConnectable) string {
 //	  // Try each sockaddr until we succeed. Record the first connection error,
 //	  // but not any further ones (since they'll probably be basically the same
 //	  // as the first).
-//	  while (!conn &amp;&amp; (sockaddr = g_socket_address_enumerator_next (enumerator, cancellable, error))
+//	  while (!conn && (sockaddr = g_socket_address_enumerator_next (enumerator, cancellable, error))
 //	    {
-//	      conn = connect_to_sockaddr (sockaddr, conn_error ? NULL : &amp;conn_error);
+//	      conn = connect_to_sockaddr (sockaddr, conn_error ? NULL : &conn_error);
 //	      g_object_unref (sockaddr);
 //	    }
 //	  g_object_unref (enumerator);
@@ -185,8 +185,6 @@ func (x *SocketConnectableIface) GetToString() func(SocketConnectable) string {
 //	      return NULL;
 //	    }
 //	}
-//
-// ```
 type SocketConnectable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -216,7 +214,49 @@ func (x *SocketConnectableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// ImplementSocketConnectable adds the SocketConnectable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Enumerate() *SocketAddressEnumerator
+//   - ProxyEnumerate() *SocketAddressEnumerator
+//   - ToString() string
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SocketConnectableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSocketConnectable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SocketConnectableGLibType(), func(iface *SocketConnectableIface) {
+		if _, ok := any(zero).(interface {
+			Enumerate() *SocketAddressEnumerator
+		}); ok {
+			iface.OverrideEnumerate(func(self SocketConnectable) *SocketAddressEnumerator {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Enumerate() *SocketAddressEnumerator
+				})
+				return impl.Enumerate()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ProxyEnumerate() *SocketAddressEnumerator
+		}); ok {
+			iface.OverrideProxyEnumerate(func(self SocketConnectable) *SocketAddressEnumerator {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ProxyEnumerate() *SocketAddressEnumerator
+				})
+				return impl.ProxyEnumerate()
+			})
+		}
+		if _, ok := any(zero).(interface{ ToString() string }); ok {
+			iface.OverrideToString(func(self SocketConnectable) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ToString() string })
+				return impl.ToString()
+			})
+		}
+	})
+}
+
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *SocketConnectableBase) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -230,13 +270,13 @@ func (x *SocketConnectableBase) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *SocketConnectableBase) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -250,12 +290,12 @@ func (x *SocketConnectableBase) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *SocketConnectableBase) ToString() string {
 
@@ -272,7 +312,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -281,8 +321,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketConnectableGLibType, libs, "g_socket_connectable_get_type")
 
-	core.PuregoSafeRegister(&XGSocketConnectableEnumerate, libs, "g_socket_connectable_enumerate")
-	core.PuregoSafeRegister(&XGSocketConnectableProxyEnumerate, libs, "g_socket_connectable_proxy_enumerate")
-	core.PuregoSafeRegister(&XGSocketConnectableToString, libs, "g_socket_connectable_to_string")
+	gobject.CheckClassSize("GSocketConnectableIface", xSocketConnectableGLibType, unsafe.Sizeof(SocketConnectableIface{}))
+
+	core.PuregoRegisterSince(&XGSocketConnectableEnumerate, libs, "g_socket_connectable_enumerate", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGSocketConnectableProxyEnumerate, libs, "g_socket_connectable_proxy_enumerate", "GIO", "2.26")
+	core.PuregoRegisterSince(&XGSocketConnectableToString, libs, "g_socket_connectable_to_string", "GIO", "2.48")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,7 +38,8 @@ func (x *SocketConnectionClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -51,7 +51,7 @@ func (x *SocketConnectionClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -62,7 +62,8 @@ func (x *SocketConnectionClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -74,7 +75,7 @@ func (x *SocketConnectionClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -85,7 +86,8 @@ func (x *SocketConnectionClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -97,7 +99,7 @@ func (x *SocketConnectionClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -108,7 +110,8 @@ func (x *SocketConnectionClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -120,7 +123,7 @@ func (x *SocketConnectionClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -131,7 +134,8 @@ func (x *SocketConnectionClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -143,7 +147,7 @@ func (x *SocketConnectionClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -154,7 +158,8 @@ func (x *SocketConnectionClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -166,7 +171,7 @@ func (x *SocketConnectionClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -180,22 +185,22 @@ func (x *SocketConnectionPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GSocketConnection` is a [class@Gio.IOStream] for a connected socket. They
-// can be created either by [class@Gio.SocketClient] when connecting to a host,
-// or by [class@Gio.SocketListener] when accepting a new client.
+// `GSocketConnection` is a [IOStream] for a connected socket. They
+// can be created either by [SocketClient] when connecting to a host,
+// or by [SocketListener] when accepting a new client.
 //
 // The type of the `GSocketConnection` object returned from these calls
 // depends on the type of the underlying socket that is in use. For
-// instance, for a TCP/IP connection it will be a [class@Gio.TcpConnection].
+// instance, for a TCP/IP connection it will be a [TcpConnection].
 //
 // Choosing what type of object to construct is done with the socket
 // connection factory, and it is possible for third parties to register
 // custom socket connection types for specific combination of socket
-// family/type/protocol using [func@Gio.SocketConnection.factory_register_type].
+// family/type/protocol using [SocketConnectionFactoryRegisterType].
 //
-// To close a `GSocketConnection`, use [method@Gio.IOStream.close]. Closing both
-// substreams of the [class@Gio.IOStream] separately will not close the
-// underlying [class@Gio.Socket].
+// To close a `GSocketConnection`, use [IOStream.Close]. Closing both
+// substreams of the [IOStream] separately will not close the
+// underlying [Socket].
 type SocketConnection struct {
 	IOStream
 }
@@ -214,13 +219,13 @@ func SocketConnectionNewFromInternalPtr(ptr uintptr) *SocketConnection {
 
 var xSocketConnectionConnect func(uintptr, uintptr, uintptr, **glib.Error) bool
 
-// Connect @connection to the specified remote address.
+// Connect connection to the specified remote address.
 func (x *SocketConnection) Connect(AddressVar *SocketAddress, CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketConnectionConnect(x.GoPointer(), AddressVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -228,16 +233,16 @@ func (x *SocketConnection) Connect(AddressVar *SocketAddress, CancellableVar *Ca
 
 var xSocketConnectionConnectAsync func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronously connect @connection to the specified remote address.
+// Asynchronously connect connection to the specified remote address.
 //
-// This clears the #GSocket:blocking flag on @connection's underlying
+// This clears the [Socket.GetPropertyBlocking] flag on connection's underlying
 // socket if it is currently set.
 //
-// If #GSocket:timeout is set, the operation will time out and return
-// %G_IO_ERROR_TIMED_OUT after that period. Otherwise, it will continue
+// If [Socket.GetPropertyTimeout] is set, the operation will time out and return
+// [GIoErrorTimedOutValue] after that period. Otherwise, it will continue
 // indefinitely until operating system timeouts (if any) are hit.
 //
-// Use g_socket_connection_connect_finish() to retrieve the result.
+// Use [SocketConnection.ConnectFinish] to retrieve the result.
 func (x *SocketConnection) ConnectAsync(AddressVar *SocketAddress, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -247,11 +252,12 @@ func (x *SocketConnection) ConnectAsync(AddressVar *SocketAddress, CancellableVa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -261,13 +267,13 @@ func (x *SocketConnection) ConnectAsync(AddressVar *SocketAddress, CancellableVa
 
 var xSocketConnectionConnectFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Gets the result of a g_socket_connection_connect_async() call.
+// Gets the result of a [SocketConnection.ConnectAsync] call.
 func (x *SocketConnection) ConnectFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketConnectionConnectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -283,12 +289,15 @@ func (x *SocketConnection) GetLocalAddress() (*SocketAddress, error) {
 	cret := xSocketConnectionGetLocalAddress(x.GoPointer())
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -298,9 +307,9 @@ var xSocketConnectionGetRemoteAddress func(uintptr) uintptr
 
 // Try to get the remote address of a socket connection.
 //
-// Since GLib 2.40, when used with g_socket_client_connect() or
-// g_socket_client_connect_async(), during emission of
-// %G_SOCKET_CLIENT_CONNECTING, this function will return the remote
+// Since GLib 2.40, when used with [SocketClient.Connect] or
+// [SocketClient.ConnectAsync], during emission of
+// [GSocketClientConnectingValue], this function will return the remote
 // address that will be used for the connection.  This allows
 // applications to print e.g. "Connecting to example.com
 // (10.42.77.3)...".
@@ -311,12 +320,15 @@ func (x *SocketConnection) GetRemoteAddress() (*SocketAddress, error) {
 	cret := xSocketConnectionGetRemoteAddress(x.GoPointer())
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketAddress{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -324,9 +336,9 @@ func (x *SocketConnection) GetRemoteAddress() (*SocketAddress, error) {
 
 var xSocketConnectionGetSocket func(uintptr) uintptr
 
-// Gets the underlying #GSocket object of the connection.
+// Gets the underlying [Socket] object of the connection.
 // This can be useful if you want to do something unusual on it
-// not supported by the #GSocketConnection APIs.
+// not supported by the [SocketConnection] APIs.
 func (x *SocketConnection) GetSocket() *Socket {
 	var cls *Socket
 
@@ -343,8 +355,8 @@ func (x *SocketConnection) GetSocket() *Socket {
 
 var xSocketConnectionIsConnected func(uintptr) bool
 
-// Checks if @connection is connected. This is equivalent to calling
-// g_socket_is_connected() on @connection's underlying #GSocket.
+// Checks if connection is connected. This is equivalent to calling
+// [Socket.IsConnected] on connection's underlying [Socket].
 func (x *SocketConnection) IsConnected() bool {
 
 	cret := xSocketConnectionIsConnected(x.GoPointer())
@@ -364,10 +376,10 @@ func (c *SocketConnection) SetGoPointer(ptr uintptr) {
 
 var xSocketConnectionFactoryLookupType func(SocketFamily, SocketType, int) types.GType
 
-// Looks up the #GType to be used when creating socket connections on
-// sockets with the specified @family, @type and @protocol_id.
+// Looks up the [gobject.Type] to be used when creating socket connections on
+// sockets with the specified family, type and protocol_id.
 //
-// If no type is registered, the #GSocketConnection base type is returned.
+// If no type is registered, the [SocketConnection] base type is returned.
 func SocketConnectionFactoryLookupType(FamilyVar SocketFamily, TypeVar SocketType, ProtocolIdVar int) types.GType {
 
 	cret := xSocketConnectionFactoryLookupType(FamilyVar, TypeVar, ProtocolIdVar)
@@ -376,10 +388,10 @@ func SocketConnectionFactoryLookupType(FamilyVar SocketFamily, TypeVar SocketTyp
 
 var xSocketConnectionFactoryRegisterType func(types.GType, SocketFamily, SocketType, int)
 
-// Looks up the #GType to be used when creating socket connections on
-// sockets with the specified @family, @type and @protocol.
+// Looks up the [gobject.Type] to be used when creating socket connections on
+// sockets with the specified family, type and protocol.
 //
-// If no type is registered, the #GSocketConnection base type is returned.
+// If no type is registered, the [SocketConnection] base type is returned.
 func SocketConnectionFactoryRegisterType(GTypeVar types.GType, FamilyVar SocketFamily, TypeVar SocketType, ProtocolVar int) {
 
 	xSocketConnectionFactoryRegisterType(GTypeVar, FamilyVar, TypeVar, ProtocolVar)
@@ -391,7 +403,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -400,15 +412,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketConnectionGLibType, libs, "g_socket_connection_get_type")
 
-	core.PuregoSafeRegister(&xSocketConnectionConnect, libs, "g_socket_connection_connect")
-	core.PuregoSafeRegister(&xSocketConnectionConnectAsync, libs, "g_socket_connection_connect_async")
-	core.PuregoSafeRegister(&xSocketConnectionConnectFinish, libs, "g_socket_connection_connect_finish")
-	core.PuregoSafeRegister(&xSocketConnectionGetLocalAddress, libs, "g_socket_connection_get_local_address")
-	core.PuregoSafeRegister(&xSocketConnectionGetRemoteAddress, libs, "g_socket_connection_get_remote_address")
-	core.PuregoSafeRegister(&xSocketConnectionGetSocket, libs, "g_socket_connection_get_socket")
-	core.PuregoSafeRegister(&xSocketConnectionIsConnected, libs, "g_socket_connection_is_connected")
+	gobject.CheckClassSize("GSocketConnectionClass", xSocketConnectionGLibType, unsafe.Sizeof(SocketConnectionClass{}))
+
+	core.PuregoRegisterSince(&xSocketConnectionConnect, libs, "g_socket_connection_connect", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSocketConnectionConnectAsync, libs, "g_socket_connection_connect_async", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSocketConnectionConnectFinish, libs, "g_socket_connection_connect_finish", "GIO", "2.32")
+	core.PuregoRegisterSince(&xSocketConnectionGetLocalAddress, libs, "g_socket_connection_get_local_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketConnectionGetRemoteAddress, libs, "g_socket_connection_get_remote_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketConnectionGetSocket, libs, "g_socket_connection_get_socket", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketConnectionIsConnected, libs, "g_socket_connection_is_connected", "GIO", "2.32")
 
-	core.PuregoSafeRegister(&xSocketConnectionFactoryLookupType, libs, "g_socket_connection_factory_lookup_type")
-	core.PuregoSafeRegister(&xSocketConnectionFactoryRegisterType, libs, "g_socket_connection_factory_register_type")
+	core.PuregoRegisterSince(&xSocketConnectionFactoryLookupType, libs, "g_socket_connection_factory_lookup_type", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketConnectionFactoryRegisterType, libs, "g_socket_connection_factory_register_type", "GIO", "2.22")
 
 }
@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GSocketControlMessage.
+// Class structure for [SocketControlMessage].
 type SocketControlMessageClass struct {
 	_ structs.HostLayout
 
@@ -48,7 +47,8 @@ func (x *SocketControlMessageClass) OverrideGetSize(cb func(*SocketControlMessag
 	if cb == nil {
 		x.xGetSize = 0
 	} else {
-		x.xGetSize = purego.NewCallback(func(MessageVarp uintptr) uint {
+		x.xGetSize = core.NewCallback(func(MessageVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(SocketControlMessageNewFromInternalPtr(MessageVarp))
 		})
 	}
@@ -61,7 +61,7 @@ func (x *SocketControlMessageClass) GetGetSize() func(*SocketControlMessage) uin
 		return nil
 	}
 	var rawCallback func(MessageVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xGetSize)
+	core.RegisterFunc(&rawCallback, x.xGetSize)
 	return func(MessageVar *SocketControlMessage) uint {
 		return rawCallback(MessageVar.GoPointer())
 	}
@@ -73,7 +73,8 @@ func (x *SocketControlMessageClass) OverrideGetLevel(cb func(*SocketControlMessa
 	if cb == nil {
 		x.xGetLevel = 0
 	} else {
-		x.xGetLevel = purego.NewCallback(func(MessageVarp uintptr) int {
+		x.xGetLevel = core.NewCallback(func(MessageVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(SocketControlMessageNewFromInternalPtr(MessageVarp))
 		})
 	}
@@ -86,7 +87,7 @@ func (x *SocketControlMessageClass) GetGetLevel() func(*SocketControlMessage) in
 		return nil
 	}
 	var rawCallback func(MessageVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetLevel)
+	core.RegisterFunc(&rawCallback, x.xGetLevel)
 	return func(MessageVar *SocketControlMessage) int {
 		return rawCallback(MessageVar.GoPointer())
 	}
@@ -98,7 +99,8 @@ func (x *SocketControlMessageClass) OverrideGetType(cb func(*SocketControlMessag
 	if cb == nil {
 		x.xGetType = 0
 	} else {
-		x.xGetType = purego.NewCallback(func(MessageVarp uintptr) int {
+		x.xGetType = core.NewCallback(func(MessageVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(SocketControlMessageNewFromInternalPtr(MessageVarp))
 		})
 	}
@@ -111,7 +113,7 @@ func (x *SocketControlMessageClass) GetGetType() func(*SocketControlMessage) int
 		return nil
 	}
 	var rawCallback func(MessageVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetType)
+	core.RegisterFunc(&rawCallback, x.xGetType)
 	return func(MessageVar *SocketControlMessage) int {
 		return rawCallback(MessageVar.GoPointer())
 	}
@@ -123,7 +125,8 @@ func (x *SocketControlMessageClass) OverrideSerialize(cb func(*SocketControlMess
 	if cb == nil {
 		x.xSerialize = 0
 	} else {
-		x.xSerialize = purego.NewCallback(func(MessageVarp uintptr, DataVarp uintptr) {
+		x.xSerialize = core.NewCallback(func(MessageVarp uintptr, DataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SocketControlMessageNewFromInternalPtr(MessageVarp), DataVarp)
 		})
 	}
@@ -136,7 +139,7 @@ func (x *SocketControlMessageClass) GetSerialize() func(*SocketControlMessage, u
 		return nil
 	}
 	var rawCallback func(MessageVarp uintptr, DataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSerialize)
+	core.RegisterFunc(&rawCallback, x.xSerialize)
 	return func(MessageVar *SocketControlMessage, DataVar uintptr) {
 		rawCallback(MessageVar.GoPointer(), DataVar)
 	}
@@ -148,7 +151,8 @@ func (x *SocketControlMessageClass) OverrideDeserialize(cb func(int, int, uint,
 	if cb == nil {
 		x.xDeserialize = 0
 	} else {
-		x.xDeserialize = purego.NewCallback(func(LevelVarp int, TypeVarp int, SizeVarp uint, DataVarp uintptr) uintptr {
+		x.xDeserialize = core.NewCallback(func(LevelVarp int, TypeVarp int, SizeVarp uint, DataVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(LevelVarp, TypeVarp, SizeVarp, DataVarp)
 			if ret == nil {
 				return 0
@@ -165,7 +169,7 @@ func (x *SocketControlMessageClass) GetDeserialize() func(int, int, uint, uintpt
 		return nil
 	}
 	var rawCallback func(LevelVarp int, TypeVarp int, SizeVarp uint, DataVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDeserialize)
+	core.RegisterFunc(&rawCallback, x.xDeserialize)
 	return func(LevelVar int, TypeVar int, SizeVar uint, DataVar uintptr) *SocketControlMessage {
 		rawRet := rawCallback(LevelVar, TypeVar, SizeVar, DataVar)
 		if rawRet == 0 {
@@ -182,7 +186,8 @@ func (x *SocketControlMessageClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -194,7 +199,7 @@ func (x *SocketControlMessageClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -205,7 +210,8 @@ func (x *SocketControlMessageClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -217,7 +223,7 @@ func (x *SocketControlMessageClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -228,7 +234,8 @@ func (x *SocketControlMessageClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -240,7 +247,7 @@ func (x *SocketControlMessageClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -251,7 +258,8 @@ func (x *SocketControlMessageClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -263,7 +271,7 @@ func (x *SocketControlMessageClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -274,7 +282,8 @@ func (x *SocketControlMessageClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -286,7 +295,7 @@ func (x *SocketControlMessageClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -301,7 +310,7 @@ func (x *SocketControlMessagePrivate) GoPointer() uintptr {
 }
 
 // A `GSocketControlMessage` is a special-purpose utility message that
-// can be sent to or received from a [class@Gio.Socket]. These types of
+// can be sent to or received from a [Socket]. These types of
 // messages are often called ‘ancillary data’.
 //
 // The message can represent some sort of special instruction to or
@@ -309,8 +318,8 @@ func (x *SocketControlMessagePrivate) GoPointer() uintptr {
 // transfer to the peer (for example, sending a file descriptor over
 // a UNIX socket).
 //
-// These messages are sent with [method@Gio.Socket.send_message] and received
-// with [method@Gio.Socket.receive_message].
+// These messages are sent with [Socket.SendMessage] and received
+// with [Socket.ReceiveMessage].
 //
 // To extend the set of control message that can be sent, subclass this
 // class and override the `get_size`, `get_level`, `get_type` and `serialize`
@@ -318,8 +327,8 @@ func (x *SocketControlMessagePrivate) GoPointer() uintptr {
 //
 // To extend the set of control messages that can be received, subclass
 // this class and implement the `deserialize` method. Also, make sure your
-// class is registered with the [type@GObject.Type] type system before calling
-// [method@Gio.Socket.receive_message] to read such a message.
+// class is registered with the [gobject.Type] type system before calling
+// [Socket.ReceiveMessage] to read such a message.
 type SocketControlMessage struct {
 	gobject.Object
 }
@@ -371,8 +380,8 @@ var xSocketControlMessageSerialize func(uintptr, uintptr)
 // Converts the data in the message to bytes placed in the
 // message.
 //
-// @data is guaranteed to have enough space to fit the size
-// returned by g_socket_control_message_get_size() on this
+// data is guaranteed to have enough space to fit the size
+// returned by [SocketControlMessage.GetSize] on this
 // object.
 func (x *SocketControlMessage) Serialize(DataVar uintptr) {
 
@@ -394,11 +403,11 @@ func (c *SocketControlMessage) SetGoPointer(ptr uintptr) {
 var xSocketControlMessageDeserialize func(int, int, uint, uintptr) uintptr
 
 // Tries to deserialize a socket control message of a given
-// @level and @type. This will ask all known (to GType) subclasses
-// of #GSocketControlMessage if they can understand this kind
-// of message and if so deserialize it into a #GSocketControlMessage.
+// level and type. This will ask all known (to GType) subclasses
+// of [SocketControlMessage] if they can understand this kind
+// of message and if so deserialize it into a [SocketControlMessage].
 //
-// If there is no implementation for this kind of control message, %NULL
+// If there is no implementation for this kind of control message, nil
 // will be returned.
 func SocketControlMessageDeserialize(LevelVar int, TypeVar int, SizeVar uint, DataVar uintptr) *SocketControlMessage {
 	var cls *SocketControlMessage
@@ -418,7 +427,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -427,11 +436,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketControlMessageGLibType, libs, "g_socket_control_message_get_type")
 
-	core.PuregoSafeRegister(&xSocketControlMessageGetLevel, libs, "g_socket_control_message_get_level")
-	core.PuregoSafeRegister(&xSocketControlMessageGetMsgType, libs, "g_socket_control_message_get_msg_type")
-	core.PuregoSafeRegister(&xSocketControlMessageGetSize, libs, "g_socket_control_message_get_size")
-	core.PuregoSafeRegister(&xSocketControlMessageSerialize, libs, "g_socket_control_message_serialize")
+	gobject.CheckClassSize("GSocketControlMessageClass", xSocketControlMessageGLibType, unsafe.Sizeof(SocketControlMessageClass{}))
 
-	core.PuregoSafeRegister(&xSocketControlMessageDeserialize, libs, "g_socket_control_message_deserialize")
+	core.PuregoRegisterSince(&xSocketControlMessageGetLevel, libs, "g_socket_control_message_get_level", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketControlMessageGetMsgType, libs, "g_socket_control_message_get_msg_type", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketControlMessageGetSize, libs, "g_socket_control_message_get_size", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketControlMessageSerialize, libs, "g_socket_control_message_serialize", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xSocketControlMessageDeserialize, libs, "g_socket_control_message_deserialize", "GIO", "2.22")
 
 }
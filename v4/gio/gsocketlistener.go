@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GSocketListener.
+// Class structure for [SocketListener].
 type SocketListenerClass struct {
 	_ structs.HostLayout
 
@@ -43,7 +42,8 @@ func (x *SocketListenerClass) OverrideChanged(cb func(*SocketListener)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(ListenerVarp uintptr) {
+		x.xChanged = core.NewCallback(func(ListenerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SocketListenerNewFromInternalPtr(ListenerVarp))
 		})
 	}
@@ -56,7 +56,7 @@ func (x *SocketListenerClass) GetChanged() func(*SocketListener) {
 		return nil
 	}
 	var rawCallback func(ListenerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(ListenerVar *SocketListener) {
 		rawCallback(ListenerVar.GoPointer())
 	}
@@ -67,7 +67,8 @@ func (x *SocketListenerClass) OverrideEvent(cb func(*SocketListener, SocketListe
 	if cb == nil {
 		x.xEvent = 0
 	} else {
-		x.xEvent = purego.NewCallback(func(ListenerVarp uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr) {
+		x.xEvent = core.NewCallback(func(ListenerVarp uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(SocketListenerNewFromInternalPtr(ListenerVarp), EventVarp, SocketNewFromInternalPtr(SocketVarp))
 		})
 	}
@@ -79,7 +80,7 @@ func (x *SocketListenerClass) GetEvent() func(*SocketListener, SocketListenerEve
 		return nil
 	}
 	var rawCallback func(ListenerVarp uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEvent)
+	core.RegisterFunc(&rawCallback, x.xEvent)
 	return func(ListenerVar *SocketListener, EventVar SocketListenerEvent, SocketVar *Socket) {
 		rawCallback(ListenerVar.GoPointer(), EventVar, SocketVar.GoPointer())
 	}
@@ -90,7 +91,8 @@ func (x *SocketListenerClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -102,7 +104,7 @@ func (x *SocketListenerClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -113,7 +115,8 @@ func (x *SocketListenerClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -125,7 +128,7 @@ func (x *SocketListenerClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -136,7 +139,8 @@ func (x *SocketListenerClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -148,7 +152,7 @@ func (x *SocketListenerClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -159,7 +163,8 @@ func (x *SocketListenerClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -171,7 +176,7 @@ func (x *SocketListenerClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -182,7 +187,8 @@ func (x *SocketListenerClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -194,7 +200,7 @@ func (x *SocketListenerClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -213,15 +219,15 @@ func (x *SocketListenerPrivate) GoPointer() uintptr {
 // socket, either sync or async.
 //
 // Add addresses and ports to listen on using
-// [method@Gio.SocketListener.add_address] and
-// [method@Gio.SocketListener.add_inet_port]. These will be listened on until
-// [method@Gio.SocketListener.close] is called. Dropping your final reference to
-// the `GSocketListener` will not cause [method@Gio.SocketListener.close] to be
+// [SocketListener.AddAddress] and
+// [SocketListener.AddInetPort]. These will be listened on until
+// [SocketListener.Close] is called. Dropping your final reference to
+// the `GSocketListener` will not cause [SocketListener.Close] to be
 // called implicitly, as some references to the `GSocketListener` may be held
 // internally.
 //
 // If you want to implement a network server, also look at
-// [class@Gio.SocketService] and [class@Gio.ThreadedSocketService] which are
+// [SocketService] and [ThreadedSocketService] which are
 // subclasses of `GSocketListener` that make this even easier.
 type SocketListener struct {
 	gobject.Object
@@ -241,9 +247,9 @@ func SocketListenerNewFromInternalPtr(ptr uintptr) *SocketListener {
 
 var xNewSocketListener func() uintptr
 
-// Creates a new #GSocketListener with no sockets to listen for.
-// New listeners can be added with e.g. g_socket_listener_add_address()
-// or g_socket_listener_add_inet_port().
+// Creates a new [SocketListener] with no sockets to listen for.
+// New listeners can be added with e.g. [SocketListener.AddAddress]
+// or [SocketListener.AddInetPort].
 func NewSocketListener() *SocketListener {
 	var cls *SocketListener
 
@@ -260,16 +266,16 @@ func NewSocketListener() *SocketListener {
 var xSocketListenerAccept func(uintptr, **gobject.Object, uintptr, **glib.Error) uintptr
 
 // Blocks waiting for a client to connect to any of the sockets added
-// to the listener. Returns a #GSocketConnection for the socket that was
+// to the listener. Returns a [SocketConnection] for the socket that was
 // accepted.
 //
-// If @source_object is not %NULL it will be filled out with the source
+// If source_object is not nil it will be filled out with the source
 // object specified when the corresponding socket or address was added
 // to the listener.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *SocketListener) Accept(SourceObjectVar **gobject.Object, CancellableVar *Cancellable) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -277,12 +283,15 @@ func (x *SocketListener) Accept(SourceObjectVar **gobject.Object, CancellableVar
 	cret := xSocketListenerAccept(x.GoPointer(), SourceObjectVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -290,10 +299,10 @@ func (x *SocketListener) Accept(SourceObjectVar **gobject.Object, CancellableVar
 
 var xSocketListenerAcceptAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// This is the asynchronous version of g_socket_listener_accept().
+// This is the asynchronous version of [SocketListener.Accept].
 //
-// When the operation is finished @callback will be
-// called. You can then call g_socket_listener_accept_finish()
+// When the operation is finished callback will be
+// called. You can then call [SocketListener.AcceptFinish]
 // to get the result of the operation.
 func (x *SocketListener) AcceptAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -304,11 +313,12 @@ func (x *SocketListener) AcceptAsync(CancellableVar *Cancellable, CallbackVar *A
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -318,7 +328,7 @@ func (x *SocketListener) AcceptAsync(CancellableVar *Cancellable, CallbackVar *A
 
 var xSocketListenerAcceptFinish func(uintptr, uintptr, **gobject.Object, **glib.Error) uintptr
 
-// Finishes an async accept operation. See g_socket_listener_accept_async()
+// Finishes an async accept operation. See [SocketListener.AcceptAsync]
 func (x *SocketListener) AcceptFinish(ResultVar AsyncResult, SourceObjectVar **gobject.Object) (*SocketConnection, error) {
 	var cls *SocketConnection
 	var cerr *glib.Error
@@ -326,12 +336,15 @@ func (x *SocketListener) AcceptFinish(ResultVar AsyncResult, SourceObjectVar **g
 	cret := xSocketListenerAcceptFinish(x.GoPointer(), ResultVar.GoPointer(), SourceObjectVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &SocketConnection{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -340,19 +353,19 @@ func (x *SocketListener) AcceptFinish(ResultVar AsyncResult, SourceObjectVar **g
 var xSocketListenerAcceptSocket func(uintptr, **gobject.Object, uintptr, **glib.Error) uintptr
 
 // Blocks waiting for a client to connect to any of the sockets added
-// to the listener. Returns the #GSocket that was accepted.
+// to the listener. Returns the [Socket] that was accepted.
 //
-// If you want to accept the high-level #GSocketConnection, not a #GSocket,
-// which is often the case, then you should use g_socket_listener_accept()
+// If you want to accept the high-level [SocketConnection], not a [Socket],
+// which is often the case, then you should use [SocketListener.Accept]
 // instead.
 //
-// If @source_object is not %NULL it will be filled out with the source
+// If source_object is not nil it will be filled out with the source
 // object specified when the corresponding socket or address was added
 // to the listener.
 //
-// If @cancellable is not %NULL, then the operation can be cancelled by
+// If cancellable is not nil, then the operation can be cancelled by
 // triggering the cancellable object from another thread. If the operation
-// was cancelled, the error %G_IO_ERROR_CANCELLED will be returned.
+// was cancelled, the error [GIoErrorCancelledValue] will be returned.
 func (x *SocketListener) AcceptSocket(SourceObjectVar **gobject.Object, CancellableVar *Cancellable) (*Socket, error) {
 	var cls *Socket
 	var cerr *glib.Error
@@ -360,12 +373,15 @@ func (x *SocketListener) AcceptSocket(SourceObjectVar **gobject.Object, Cancella
 	cret := xSocketListenerAcceptSocket(x.GoPointer(), SourceObjectVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Socket{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -373,10 +389,10 @@ func (x *SocketListener) AcceptSocket(SourceObjectVar **gobject.Object, Cancella
 
 var xSocketListenerAcceptSocketAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// This is the asynchronous version of g_socket_listener_accept_socket().
+// This is the asynchronous version of [SocketListener.AcceptSocket].
 //
-// When the operation is finished @callback will be
-// called. You can then call g_socket_listener_accept_socket_finish()
+// When the operation is finished callback will be
+// called. You can then call [SocketListener.AcceptSocketFinish]
 // to get the result of the operation.
 func (x *SocketListener) AcceptSocketAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -387,11 +403,12 @@ func (x *SocketListener) AcceptSocketAsync(CancellableVar *Cancellable, Callback
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -401,7 +418,7 @@ func (x *SocketListener) AcceptSocketAsync(CancellableVar *Cancellable, Callback
 
 var xSocketListenerAcceptSocketFinish func(uintptr, uintptr, **gobject.Object, **glib.Error) uintptr
 
-// Finishes an async accept operation. See g_socket_listener_accept_socket_async()
+// Finishes an async accept operation. See [SocketListener.AcceptSocketAsync]
 func (x *SocketListener) AcceptSocketFinish(ResultVar AsyncResult, SourceObjectVar **gobject.Object) (*Socket, error) {
 	var cls *Socket
 	var cerr *glib.Error
@@ -409,12 +426,15 @@ func (x *SocketListener) AcceptSocketFinish(ResultVar AsyncResult, SourceObjectV
 	cret := xSocketListenerAcceptSocketFinish(x.GoPointer(), ResultVar.GoPointer(), SourceObjectVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Socket{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -422,35 +442,35 @@ func (x *SocketListener) AcceptSocketFinish(ResultVar AsyncResult, SourceObjectV
 
 var xSocketListenerAddAddress func(uintptr, uintptr, SocketType, SocketProtocol, uintptr, **SocketAddress, **glib.Error) bool
 
-// Creates a socket of type @type and protocol @protocol, binds
-// it to @address and adds it to the set of sockets we're accepting
+// Creates a socket of type type and protocol protocol, binds
+// it to address and adds it to the set of sockets we're accepting
 // sockets from.
 //
 // Note that adding an IPv6 address, depending on the platform,
 // may or may not result in a listener that also accepts IPv4
 // connections.  For more deterministic behavior, see
-// g_socket_listener_add_inet_port().
+// [SocketListener.AddInetPort].
 //
-// @source_object will be passed out in the various calls
+// source_object will be passed out in the various calls
 // to accept to identify this particular source, which is
 // useful if you're listening on multiple addresses and do
 // different things depending on what address is connected to.
 //
-// If successful and @effective_address is non-%NULL then it will
+// If successful and effective_address is non-nil then it will
 // be set to the address that the binding actually occurred at.  This
 // is helpful for determining the port number that was used for when
 // requesting a binding to port 0 (ie: "any port").  This address, if
 // requested, belongs to the caller and must be freed.
 //
-// Call g_socket_listener_close() to stop listening on @address; this will not
-// be done automatically when you drop your final reference to @listener, as
+// Call [SocketListener.Close] to stop listening on address; this will not
+// be done automatically when you drop your final reference to listener, as
 // references may be held internally.
 func (x *SocketListener) AddAddress(AddressVar *SocketAddress, TypeVar SocketType, ProtocolVar SocketProtocol, SourceObjectVar *gobject.Object, EffectiveAddressVar **SocketAddress) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketListenerAddAddress(x.GoPointer(), AddressVar.GoPointer(), TypeVar, ProtocolVar, SourceObjectVar.GoPointer(), EffectiveAddressVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -464,15 +484,15 @@ var xSocketListenerAddAnyInetPort func(uintptr, uintptr, **glib.Error) uint16
 // This is useful if you need to have a socket for incoming connections
 // but don't care about the specific port number.
 //
-// If possible, the [class@Gio.SocketListener] will listen on both IPv4 and
+// If possible, the [SocketListener] will listen on both IPv4 and
 // IPv6 (listening on the same port on both). If listening on one of the socket
-// families fails, the [class@Gio.SocketListener] will only listen on the other.
+// families fails, the [SocketListener] will only listen on the other.
 // If listening on both fails, an error will be returned.
 //
 // If you need to distinguish whether listening on IPv4 or IPv6 or both was
-// successful, connect to [signal@Gio.SocketListener::event].
+// successful, connect to [SocketListener.ConnectEventFunc].
 //
-// @source_object will be passed out in the various calls
+// source_object will be passed out in the various calls
 // to accept to identify this particular source, which is
 // useful if you're listening on multiple addresses and do
 // different things depending on what address is connected to.
@@ -481,7 +501,7 @@ func (x *SocketListener) AddAnyInetPort(SourceObjectVar *gobject.Object) (uint16
 
 	cret := xSocketListenerAddAnyInetPort(x.GoPointer(), SourceObjectVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -489,32 +509,32 @@ func (x *SocketListener) AddAnyInetPort(SourceObjectVar *gobject.Object) (uint16
 
 var xSocketListenerAddInetPort func(uintptr, uint16, uintptr, **glib.Error) bool
 
-// Helper function for g_socket_listener_add_address() that
+// Helper function for [SocketListener.AddAddress] that
 // creates a TCP/IP socket listening on IPv4 and IPv6 (if
 // supported) on the specified port on all interfaces.
 //
-// If possible, the [class@Gio.SocketListener] will listen on both IPv4 and
+// If possible, the [SocketListener] will listen on both IPv4 and
 // IPv6 (listening on the same port on both). If listening on one of the socket
-// families fails, the [class@Gio.SocketListener] will only listen on the other.
+// families fails, the [SocketListener] will only listen on the other.
 // If listening on both fails, an error will be returned.
 //
 // If you need to distinguish whether listening on IPv4 or IPv6 or both was
-// successful, connect to [signal@Gio.SocketListener::event].
+// successful, connect to [SocketListener.ConnectEventFunc].
 //
-// @source_object will be passed out in the various calls
+// source_object will be passed out in the various calls
 // to accept to identify this particular source, which is
 // useful if you're listening on multiple addresses and do
 // different things depending on what address is connected to.
 //
-// Call g_socket_listener_close() to stop listening on @port; this will not
-// be done automatically when you drop your final reference to @listener, as
+// Call [SocketListener.Close] to stop listening on port; this will not
+// be done automatically when you drop your final reference to listener, as
 // references may be held internally.
 func (x *SocketListener) AddInetPort(PortVar uint16, SourceObjectVar *gobject.Object) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketListenerAddInetPort(x.GoPointer(), PortVar, SourceObjectVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -522,28 +542,28 @@ func (x *SocketListener) AddInetPort(PortVar uint16, SourceObjectVar *gobject.Ob
 
 var xSocketListenerAddSocket func(uintptr, uintptr, uintptr, **glib.Error) bool
 
-// Adds @socket to the set of sockets that we try to accept
+// Adds socket to the set of sockets that we try to accept
 // new clients from. The socket must be bound to a local
 // address and listened to.
 //
-// For parallel calls to [class@Gio.SocketListener] methods to work, the socket
-// must be in non-blocking mode. (See [property@Gio.Socket:blocking].)
+// For parallel calls to [SocketListener] methods to work, the socket
+// must be in non-blocking mode. (See [Socket.GetPropertyBlocking].)
 //
-// @source_object will be passed out in the various calls
+// source_object will be passed out in the various calls
 // to accept to identify this particular source, which is
 // useful if you're listening on multiple addresses and do
 // different things depending on what address is connected to.
 //
-// The @socket will not be automatically closed when the @listener is finalized
+// The socket will not be automatically closed when the listener is finalized
 // unless the listener held the final reference to the socket. Before GLib 2.42,
-// the @socket was automatically closed on finalization of the @listener, even
+// the socket was automatically closed on finalization of the listener, even
 // if references to it were held elsewhere.
 func (x *SocketListener) AddSocket(SocketVar *Socket, SourceObjectVar *gobject.Object) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSocketListenerAddSocket(x.GoPointer(), SocketVar.GoPointer(), SourceObjectVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -561,10 +581,10 @@ func (x *SocketListener) Close() {
 var xSocketListenerSetBacklog func(uintptr, int)
 
 // Sets the listen backlog on the sockets in the listener. This must be called
-// before adding any sockets, addresses or ports to the #GSocketListener (for
-// example, by calling g_socket_listener_add_inet_port()) to be effective.
+// before adding any sockets, addresses or ports to the [SocketListener] (for
+// example, by calling [SocketListener.AddInetPort]) to be effective.
 //
-// See g_socket_set_listen_backlog() for details
+// See [Socket.SetListenBacklog] for details
 func (x *SocketListener) SetBacklog(ListenBacklogVar int) {
 
 	xSocketListenerSetBacklog(x.GoPointer(), ListenBacklogVar)
@@ -599,31 +619,53 @@ func (x *SocketListener) GetPropertyListenBacklog() int {
 	return v.GetInt()
 }
 
-// Emitted when @listener's activity on @socket changes state.
-// Note that when @listener is used to listen on both IPv4 and
+// Emitted when listener's activity on socket changes state.
+// Note that when listener is used to listen on both IPv4 and
 // IPv6, a separate set of signals will be emitted for each, and
 // the order they happen in is undefined.
+//
+// Deprecated: use ConnectEventFunc, which also accepts method values and closures.
 func (x *SocketListener) ConnectEvent(cb *func(SocketListener, SocketListenerEvent, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SocketListener{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, EventVarp, SocketVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "event", cbRefPtr, cbPtr)
+}
+
+var xSocketListenerEventTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr) {
+func xSocketListenerEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, EventVarp SocketListenerEvent, SocketVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SocketListener, SocketListenerEvent, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SocketListener{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, EventVarp, SocketVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEventFunc connects cb to the "event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SocketListener) ConnectEventFunc(cb func(SocketListener, SocketListenerEvent, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "event", &xSocketListenerEventTrampoline, xSocketListenerEventNewTrampoline, cb)
 }
 
 func init() {
@@ -631,7 +673,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -640,19 +682,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketListenerGLibType, libs, "g_socket_listener_get_type")
 
-	core.PuregoSafeRegister(&xNewSocketListener, libs, "g_socket_listener_new")
-
-	core.PuregoSafeRegister(&xSocketListenerAccept, libs, "g_socket_listener_accept")
-	core.PuregoSafeRegister(&xSocketListenerAcceptAsync, libs, "g_socket_listener_accept_async")
-	core.PuregoSafeRegister(&xSocketListenerAcceptFinish, libs, "g_socket_listener_accept_finish")
-	core.PuregoSafeRegister(&xSocketListenerAcceptSocket, libs, "g_socket_listener_accept_socket")
-	core.PuregoSafeRegister(&xSocketListenerAcceptSocketAsync, libs, "g_socket_listener_accept_socket_async")
-	core.PuregoSafeRegister(&xSocketListenerAcceptSocketFinish, libs, "g_socket_listener_accept_socket_finish")
-	core.PuregoSafeRegister(&xSocketListenerAddAddress, libs, "g_socket_listener_add_address")
-	core.PuregoSafeRegister(&xSocketListenerAddAnyInetPort, libs, "g_socket_listener_add_any_inet_port")
-	core.PuregoSafeRegister(&xSocketListenerAddInetPort, libs, "g_socket_listener_add_inet_port")
-	core.PuregoSafeRegister(&xSocketListenerAddSocket, libs, "g_socket_listener_add_socket")
-	core.PuregoSafeRegister(&xSocketListenerClose, libs, "g_socket_listener_close")
-	core.PuregoSafeRegister(&xSocketListenerSetBacklog, libs, "g_socket_listener_set_backlog")
+	gobject.CheckClassSize("GSocketListenerClass", xSocketListenerGLibType, unsafe.Sizeof(SocketListenerClass{}))
+
+	core.PuregoRegisterSince(&xNewSocketListener, libs, "g_socket_listener_new", "GIO", "2.22")
+
+	core.PuregoRegisterSince(&xSocketListenerAccept, libs, "g_socket_listener_accept", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAcceptAsync, libs, "g_socket_listener_accept_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAcceptFinish, libs, "g_socket_listener_accept_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAcceptSocket, libs, "g_socket_listener_accept_socket", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAcceptSocketAsync, libs, "g_socket_listener_accept_socket_async", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAcceptSocketFinish, libs, "g_socket_listener_accept_socket_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAddAddress, libs, "g_socket_listener_add_address", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAddAnyInetPort, libs, "g_socket_listener_add_any_inet_port", "GIO", "2.24")
+	core.PuregoRegisterSince(&xSocketListenerAddInetPort, libs, "g_socket_listener_add_inet_port", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerAddSocket, libs, "g_socket_listener_add_socket", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerClose, libs, "g_socket_listener_close", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketListenerSetBacklog, libs, "g_socket_listener_set_backlog", "GIO", "2.22")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GSocketService.
+// Class structure for [SocketService].
 type SocketServiceClass struct {
 	_ structs.HostLayout
 
@@ -43,7 +42,8 @@ func (x *SocketServiceClass) OverrideIncoming(cb func(*SocketService, *SocketCon
 	if cb == nil {
 		x.xIncoming = 0
 	} else {
-		x.xIncoming = purego.NewCallback(func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+		x.xIncoming = core.NewCallback(func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(SocketServiceNewFromInternalPtr(ServiceVarp), SocketConnectionNewFromInternalPtr(ConnectionVarp), gobject.ObjectNewFromInternalPtr(SourceObjectVarp))
 		})
 	}
@@ -56,7 +56,7 @@ func (x *SocketServiceClass) GetIncoming() func(*SocketService, *SocketConnectio
 		return nil
 	}
 	var rawCallback func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIncoming)
+	core.RegisterFunc(&rawCallback, x.xIncoming)
 	return func(ServiceVar *SocketService, ConnectionVar *SocketConnection, SourceObjectVar *gobject.Object) bool {
 		return rawCallback(ServiceVar.GoPointer(), ConnectionVar.GoPointer(), SourceObjectVar.GoPointer())
 	}
@@ -67,7 +67,8 @@ func (x *SocketServiceClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -79,7 +80,7 @@ func (x *SocketServiceClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -90,7 +91,8 @@ func (x *SocketServiceClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -102,7 +104,7 @@ func (x *SocketServiceClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -113,7 +115,8 @@ func (x *SocketServiceClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -125,7 +128,7 @@ func (x *SocketServiceClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -136,7 +139,8 @@ func (x *SocketServiceClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -148,7 +152,7 @@ func (x *SocketServiceClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -159,7 +163,8 @@ func (x *SocketServiceClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -171,7 +176,7 @@ func (x *SocketServiceClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -182,7 +187,8 @@ func (x *SocketServiceClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -194,7 +200,7 @@ func (x *SocketServiceClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -210,27 +216,27 @@ func (x *SocketServicePrivate) GoPointer() uintptr {
 
 // A `GSocketService` is an object that represents a service that
 // is provided to the network or over local sockets.  When a new
-// connection is made to the service the [signal@Gio.SocketService::incoming]
+// connection is made to the service the [SocketService.ConnectIncomingFunc]
 // signal is emitted.
 //
-// A `GSocketService` is a subclass of [class@Gio.SocketListener] and you need
+// A `GSocketService` is a subclass of [SocketListener] and you need
 // to add the addresses you want to accept connections on with the
-// [class@Gio.SocketListener] APIs.
+// [SocketListener] APIs.
 //
 // There are two options for implementing a network service based on
 // `GSocketService`. The first is to create the service using
-// [ctor@Gio.SocketService.new] and to connect to the
-// [signal@Gio.SocketService::incoming] signal. The second is to subclass
+// [NewSocketService] and to connect to the
+// [SocketService.ConnectIncomingFunc] signal. The second is to subclass
 // `GSocketService` and override the default signal handler implementation.
 //
 // In either case, the handler must immediately return, or else it
 // will block additional incoming connections from being serviced.
 // If you are interested in writing connection handlers that contain
-// blocking code then see [class@Gio.ThreadedSocketService].
+// blocking code then see [ThreadedSocketService].
 //
 // The socket service runs on the main loop of the
 // thread-default context (see
-// [method@GLib.MainContext.push_thread_default]) of the thread it is
+// [glib.MainContext.PushThreadDefault]) of the thread it is
 // created in, and is not threadsafe in general. However, the calls to start and
 // stop the service are thread-safe so these can be used from threads that
 // handle incoming clients.
@@ -252,12 +258,12 @@ func SocketServiceNewFromInternalPtr(ptr uintptr) *SocketService {
 
 var xNewSocketService func() uintptr
 
-// Creates a new #GSocketService with no sockets to listen for.
-// New listeners can be added with e.g. g_socket_listener_add_address()
-// or g_socket_listener_add_inet_port().
+// Creates a new [SocketService] with no sockets to listen for.
+// New listeners can be added with e.g. [SocketListener.AddAddress]
+// or [SocketListener.AddInetPort].
 //
 // New services are created active, there is no need to call
-// g_socket_service_start(), unless g_socket_service_stop() has been
+// [SocketService.Start], unless [SocketService.Stop] has been
 // called before.
 func NewSocketService() *SocketService {
 	var cls *SocketService
@@ -289,7 +295,7 @@ var xSocketServiceStart func(uintptr)
 // Restarts the service, i.e. start accepting connections
 // from the added sockets when the mainloop runs. This only needs
 // to be called after the service has been stopped from
-// g_socket_service_stop().
+// [SocketService.Stop].
 //
 // This call is thread-safe, so it may be called from a thread
 // handling an incoming client request.
@@ -309,11 +315,11 @@ var xSocketServiceStop func(uintptr)
 //
 // Note that this only stops accepting new connections; it does not
 // close the listening sockets, and you can call
-// g_socket_service_start() again later to begin listening again. To
-// close the listening sockets, call g_socket_listener_close(). (This
-// will happen automatically when the #GSocketService is finalized.)
+// [SocketService.Start] again later to begin listening again. To
+// close the listening sockets, call [SocketListener.Close]. (This
+// will happen automatically when the [SocketService] is finalized.)
 //
-// This must be called before calling g_socket_listener_close() as
+// This must be called before calling [SocketListener.Close] as
 // the socket service will start accepting connections immediately
 // when a new socket is added.
 func (x *SocketService) Stop() {
@@ -351,33 +357,55 @@ func (x *SocketService) GetPropertyActive() bool {
 }
 
 // The ::incoming signal is emitted when a new incoming connection
-// to @service needs to be handled. The handler must initiate the
-// handling of @connection, but may not block; in essence,
+// to service needs to be handled. The handler must initiate the
+// handling of connection, but may not block; in essence,
 // asynchronous operations must be used.
 //
-// @connection will be unreffed once the signal handler returns,
+// connection will be unreffed once the signal handler returns,
 // so you need to ref it yourself if you are planning to use it.
+//
+// Deprecated: use ConnectIncomingFunc, which also accepts method values and closures.
 func (x *SocketService) ConnectIncoming(cb *func(SocketService, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "incoming", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := SocketService{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ConnectionVarp, SourceObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "incoming", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+var xSocketServiceIncomingTrampoline uintptr
+
+func xSocketServiceIncomingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SocketService, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SocketService{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ConnectionVarp, SourceObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "incoming", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectIncomingFunc connects cb to the "incoming" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SocketService) ConnectIncomingFunc(cb func(SocketService, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "incoming", &xSocketServiceIncomingTrampoline, xSocketServiceIncomingNewTrampoline, cb)
 }
 
 func init() {
@@ -385,7 +413,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -394,10 +422,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSocketServiceGLibType, libs, "g_socket_service_get_type")
 
-	core.PuregoSafeRegister(&xNewSocketService, libs, "g_socket_service_new")
+	gobject.CheckClassSize("GSocketServiceClass", xSocketServiceGLibType, unsafe.Sizeof(SocketServiceClass{}))
+
+	core.PuregoRegisterSince(&xNewSocketService, libs, "g_socket_service_new", "GIO", "2.22")
 
-	core.PuregoSafeRegister(&xSocketServiceIsActive, libs, "g_socket_service_is_active")
-	core.PuregoSafeRegister(&xSocketServiceStart, libs, "g_socket_service_start")
-	core.PuregoSafeRegister(&xSocketServiceStop, libs, "g_socket_service_stop")
+	core.PuregoRegisterSince(&xSocketServiceIsActive, libs, "g_socket_service_is_active", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketServiceStart, libs, "g_socket_service_start", "GIO", "2.22")
+	core.PuregoRegisterSince(&xSocketServiceStop, libs, "g_socket_service_stop", "GIO", "2.22")
 
 }
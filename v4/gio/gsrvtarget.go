@@ -2,14 +2,13 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
 
 var xSrvTargetListSort func(*glib.List) *glib.List
 
-// Sorts @targets in place according to the algorithm in RFC 2782.
+// Sorts targets in place according to the algorithm in RFC 2782.
 func SrvTargetListSort(TargetsVar *glib.List) *glib.List {
 
 	cret := xSrvTargetListSort(TargetsVar)
@@ -21,13 +20,13 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSrvTargetListSort, libs, "g_srv_target_list_sort")
+	core.PuregoRegisterSince(&xSrvTargetListSort, libs, "g_srv_target_list_sort", "GIO", "2.22")
 
 }
@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 // processes.
 //
 // Processes can be communicated with using standard GIO-style APIs (ie:
-// [class@Gio.InputStream], [class@Gio.OutputStream]). There are GIO-style APIs
+// [InputStream], [OutputStream]). There are GIO-style APIs
 // to wait for process termination (ie: cancellable and with an asynchronous
 // variant).
 //
@@ -24,7 +23,7 @@ import (
 //
 // One major advantage that GIO brings over the core GLib library is
 // comprehensive API for asynchronous I/O, such
-// [method@Gio.OutputStream.splice_async].  This makes `GSubprocess`
+// [OutputStream.SpliceAsync].  This makes `GSubprocess`
 // significantly more powerful and flexible than equivalent APIs in
 // some other languages such as the `subprocess.py`
 // included with Python.  For example, using `GSubprocess` one could
@@ -32,7 +31,7 @@ import (
 // processing it, and writing to the input stream of the second, all
 // without blocking the main loop.
 //
-// A powerful [method@Gio.Subprocess.communicate] API is provided similar to the
+// A powerful [Subprocess.Communicate] API is provided similar to the
 // `communicate()` method of `subprocess.py`. This enables very easy
 // interaction with a subprocess that has been opened with pipes.
 //
@@ -45,7 +44,7 @@ import (
 //
 // `GSubprocess` will quickly reap all child processes as they exit,
 // avoiding ‘zombie processes’ remaining around for long periods of
-// time.  [method@Gio.Subprocess.wait] can be used to wait for this to happen,
+// time.  [Subprocess.Wait] can be used to wait for this to happen,
 // but it will happen even without the call being explicitly made.
 //
 // As a matter of principle, `GSubprocess` has no API that accepts
@@ -58,15 +57,15 @@ import (
 //
 // `GSubprocess` attempts to have a very simple API for most uses (ie:
 // spawning a subprocess with arguments and support for most typical
-// kinds of input and output redirection).  See [ctor@Gio.Subprocess.new]. The
-// [class@Gio.SubprocessLauncher] API is provided for more complicated cases
+// kinds of input and output redirection).  See [NewSubprocess]. The
+// [SubprocessLauncher] API is provided for more complicated cases
 // (advanced types of redirection, environment variable manipulation,
 // change of working directory, child setup functions, etc).
 //
 // A typical use of `GSubprocess` will involve calling
-// [ctor@Gio.Subprocess.new], followed by [method@Gio.Subprocess.wait_async] or
-// [method@Gio.Subprocess.wait].  After the process exits, the status can be
-// checked using functions such as [method@Gio.Subprocess.get_if_exited] (which
+// [NewSubprocess], followed by [Subprocess.WaitAsync] or
+// [Subprocess.Wait].  After the process exits, the status can be
+// checked using functions such as [Subprocess.GetIfExited] (which
 // are similar to the familiar `WIFEXITED`-style POSIX macros).
 //
 // Note that as of GLib 2.82, creating a `GSubprocess` causes the signal
@@ -93,12 +92,14 @@ func SubprocessNewFromInternalPtr(ptr uintptr) *Subprocess {
 var xNewSubprocess func(SubprocessFlags, **glib.Error, string, ...interface{}) uintptr
 
 // Create a new process with the given flags and varargs argument
-// list.  By default, matching the g_spawn_async() defaults, the
+// list.  By default, matching the [glib.SpawnAsync] defaults, the
 // child's stdin will be set to the system null device, and
 // stdout/stderr will be inherited from the parent.  You can use
-// @flags to control this behavior.
+// flags to control this behavior.
 //
-// The argument list must be terminated with %NULL.
+// The argument list must be terminated with nil.
+//
+// Deprecated: use [NewSubprocessv], the version of this function that language bindings are meant to use.
 func NewSubprocess(FlagsVar SubprocessFlags, ErrorVar **glib.Error, Argv0Var string, varArgs ...interface{}) *Subprocess {
 	var cls *Subprocess
 
@@ -116,7 +117,7 @@ var xNewSubprocessv func([]string, SubprocessFlags, **glib.Error) uintptr
 
 // Create a new process with the given flags and argument list.
 //
-// The argument list is expected to be %NULL-terminated.
+// The argument list is expected to be nil-terminated.
 func NewSubprocessv(ArgvVar []string, FlagsVar SubprocessFlags) (*Subprocess, error) {
 	var cls *Subprocess
 	var cerr *glib.Error
@@ -124,12 +125,15 @@ func NewSubprocessv(ArgvVar []string, FlagsVar SubprocessFlags) (*Subprocess, er
 	cret := xNewSubprocessv(ArgvVar, FlagsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Subprocess{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -140,38 +144,38 @@ var xSubprocessCommunicate func(uintptr, *glib.Bytes, uintptr, **glib.Bytes, **g
 // Communicate with the subprocess until it terminates, and all input
 // and output has been completed.
 //
-// If @stdin_buf is given, the subprocess must have been created with
-// %G_SUBPROCESS_FLAGS_STDIN_PIPE.  The given data is fed to the
+// If stdin_buf is given, the subprocess must have been created with
+// [GSubprocessFlagsStdinPipeValue].  The given data is fed to the
 // stdin of the subprocess and the pipe is closed (ie: EOF).
 //
 // At the same time (as not to cause blocking when dealing with large
-// amounts of data), if %G_SUBPROCESS_FLAGS_STDOUT_PIPE or
-// %G_SUBPROCESS_FLAGS_STDERR_PIPE were used, reads from those
-// streams.  The data that was read is returned in @stdout and/or
-// the @stderr.
+// amounts of data), if [GSubprocessFlagsStdoutPipeValue] or
+// [GSubprocessFlagsStderrPipeValue] were used, reads from those
+// streams.  The data that was read is returned in stdout and/or
+// the stderr.
 //
-// If the subprocess was created with %G_SUBPROCESS_FLAGS_STDOUT_PIPE,
-// @stdout_buf will contain the data read from stdout.  Otherwise, for
-// subprocesses not created with %G_SUBPROCESS_FLAGS_STDOUT_PIPE,
-// @stdout_buf will be set to %NULL.  Similar provisions apply to
-// @stderr_buf and %G_SUBPROCESS_FLAGS_STDERR_PIPE.
+// If the subprocess was created with [GSubprocessFlagsStdoutPipeValue],
+// stdout_buf will contain the data read from stdout.  Otherwise, for
+// subprocesses not created with [GSubprocessFlagsStdoutPipeValue],
+// stdout_buf will be set to nil.  Similar provisions apply to
+// stderr_buf and [GSubprocessFlagsStderrPipeValue].
 //
-// As usual, any output variable may be given as %NULL to ignore it.
+// As usual, any output variable may be given as nil to ignore it.
 //
 // If you desire the stdout and stderr data to be interleaved, create
-// the subprocess with %G_SUBPROCESS_FLAGS_STDOUT_PIPE and
-// %G_SUBPROCESS_FLAGS_STDERR_MERGE.  The merged result will be returned
-// in @stdout_buf and @stderr_buf will be set to %NULL.
+// the subprocess with [GSubprocessFlagsStdoutPipeValue] and
+// [GSubprocessFlagsStderrMergeValue].  The merged result will be returned
+// in stdout_buf and stderr_buf will be set to nil.
 //
-// In case of any error (including cancellation), %FALSE will be
-// returned with @error set.  Some or all of the stdin data may have
+// In case of any error (including cancellation), false will be
+// returned with error set.  Some or all of the stdin data may have
 // been written.  Any stdout or stderr data that has been read will be
-// discarded. None of the out variables (aside from @error) will have
+// discarded. None of the out variables (aside from error) will have
 // been set to anything in particular and should not be inspected.
 //
-// In the case that %TRUE is returned, the subprocess has exited and the
-// exit status inspection APIs (eg: g_subprocess_get_if_exited(),
-// g_subprocess_get_exit_status()) may be used.
+// In the case that true is returned, the subprocess has exited and the
+// exit status inspection APIs (eg: [Subprocess.GetIfExited],
+// [Subprocess.GetExitStatus]) may be used.
 //
 // You should not attempt to use any of the subprocess pipes after
 // starting this function, since they may be left in strange states,
@@ -183,7 +187,7 @@ func (x *Subprocess) Communicate(StdinBufVar *glib.Bytes, CancellableVar *Cancel
 
 	cret := xSubprocessCommunicate(x.GoPointer(), StdinBufVar, CancellableVar.GoPointer(), StdoutBufVar, StderrBufVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -191,8 +195,8 @@ func (x *Subprocess) Communicate(StdinBufVar *glib.Bytes, CancellableVar *Cancel
 
 var xSubprocessCommunicateAsync func(uintptr, *glib.Bytes, uintptr, uintptr, uintptr)
 
-// Asynchronous version of g_subprocess_communicate().  Complete
-// invocation with g_subprocess_communicate_finish().
+// Asynchronous version of [Subprocess.Communicate].  Complete
+// invocation with [Subprocess.CommunicateFinish].
 func (x *Subprocess) CommunicateAsync(StdinBufVar *glib.Bytes, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -202,11 +206,12 @@ func (x *Subprocess) CommunicateAsync(StdinBufVar *glib.Bytes, CancellableVar *C
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -216,13 +221,13 @@ func (x *Subprocess) CommunicateAsync(StdinBufVar *glib.Bytes, CancellableVar *C
 
 var xSubprocessCommunicateFinish func(uintptr, uintptr, **glib.Bytes, **glib.Bytes, **glib.Error) bool
 
-// Complete an invocation of g_subprocess_communicate_async().
+// Complete an invocation of [Subprocess.CommunicateAsync].
 func (x *Subprocess) CommunicateFinish(ResultVar AsyncResult, StdoutBufVar **glib.Bytes, StderrBufVar **glib.Bytes) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessCommunicateFinish(x.GoPointer(), ResultVar.GoPointer(), StdoutBufVar, StderrBufVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -230,10 +235,10 @@ func (x *Subprocess) CommunicateFinish(ResultVar AsyncResult, StdoutBufVar **gli
 
 var xSubprocessCommunicateUtf8 func(uintptr, uintptr, uintptr, *string, *string, **glib.Error) bool
 
-// Like g_subprocess_communicate(), but validates the output of the
+// Like [Subprocess.Communicate], but validates the output of the
 // process as UTF-8, and returns it as a regular NUL terminated string.
 //
-// On error, @stdout_buf and @stderr_buf will be set to undefined values and
+// On error, stdout_buf and stderr_buf will be set to undefined values and
 // should not be used.
 func (x *Subprocess) CommunicateUtf8(StdinBufVar *string, CancellableVar *Cancellable, StdoutBufVar *string, StderrBufVar *string) (bool, error) {
 	var cerr *glib.Error
@@ -243,7 +248,7 @@ func (x *Subprocess) CommunicateUtf8(StdinBufVar *string, CancellableVar *Cancel
 
 	cret := xSubprocessCommunicateUtf8(x.GoPointer(), StdinBufVarPtr, CancellableVar.GoPointer(), StdoutBufVar, StderrBufVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -251,8 +256,8 @@ func (x *Subprocess) CommunicateUtf8(StdinBufVar *string, CancellableVar *Cancel
 
 var xSubprocessCommunicateUtf8Async func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Asynchronous version of g_subprocess_communicate_utf8().  Complete
-// invocation with g_subprocess_communicate_utf8_finish().
+// Asynchronous version of [Subprocess.CommunicateUtf8].  Complete
+// invocation with [Subprocess.CommunicateUtf8Finish].
 func (x *Subprocess) CommunicateUtf8Async(StdinBufVar *string, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -262,11 +267,12 @@ func (x *Subprocess) CommunicateUtf8Async(StdinBufVar *string, CancellableVar *C
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -279,13 +285,13 @@ func (x *Subprocess) CommunicateUtf8Async(StdinBufVar *string, CancellableVar *C
 
 var xSubprocessCommunicateUtf8Finish func(uintptr, uintptr, *string, *string, **glib.Error) bool
 
-// Complete an invocation of g_subprocess_communicate_utf8_async().
+// Complete an invocation of [Subprocess.CommunicateUtf8Async].
 func (x *Subprocess) CommunicateUtf8Finish(ResultVar AsyncResult, StdoutBufVar *string, StderrBufVar *string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessCommunicateUtf8Finish(x.GoPointer(), ResultVar.GoPointer(), StdoutBufVar, StderrBufVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -296,10 +302,10 @@ var xSubprocessForceExit func(uintptr)
 // Use an operating-system specific method to attempt an immediate,
 // forceful termination of the process.  There is no mechanism to
 // determine whether or not the request itself was successful;
-// however, you can use g_subprocess_wait() to monitor the status of
+// however, you can use [Subprocess.Wait] to monitor the status of
 // the process after calling this function.
 //
-// On Unix, this function sends %SIGKILL.
+// On Unix, this function sends SIGKILL.
 func (x *Subprocess) ForceExit() {
 
 	xSubprocessForceExit(x.GoPointer())
@@ -314,8 +320,8 @@ var xSubprocessGetExitStatus func(uintptr) int
 //
 // This is equivalent to the system WEXITSTATUS macro.
 //
-// It is an error to call this function before g_subprocess_wait() and
-// unless g_subprocess_get_if_exited() returned %TRUE.
+// It is an error to call this function before [Subprocess.Wait] and
+// unless [Subprocess.GetIfExited] returned true.
 func (x *Subprocess) GetExitStatus() int {
 
 	cret := xSubprocessGetExitStatus(x.GoPointer())
@@ -326,7 +332,7 @@ var xSubprocessGetIdentifier func(uintptr) string
 
 // On UNIX, returns the process ID as a decimal string.
 // On Windows, returns the result of GetProcessId() also as a string.
-// If the subprocess has terminated, this will return %NULL.
+// If the subprocess has terminated, this will return nil.
 func (x *Subprocess) GetIdentifier() string {
 
 	cret := xSubprocessGetIdentifier(x.GoPointer())
@@ -340,7 +346,7 @@ var xSubprocessGetIfExited func(uintptr) bool
 //
 // This is equivalent to the system WIFEXITED macro.
 //
-// It is an error to call this function before g_subprocess_wait() has
+// It is an error to call this function before [Subprocess.Wait] has
 // returned.
 func (x *Subprocess) GetIfExited() bool {
 
@@ -354,7 +360,7 @@ var xSubprocessGetIfSignaled func(uintptr) bool
 //
 // This is equivalent to the system WIFSIGNALED macro.
 //
-// It is an error to call this function before g_subprocess_wait() has
+// It is an error to call this function before [Subprocess.Wait] has
 // returned.
 func (x *Subprocess) GetIfSignaled() bool {
 
@@ -368,12 +374,12 @@ var xSubprocessGetStatus func(uintptr) int
 //
 // This value has no particular meaning, but it can be used with the
 // macros defined by the system headers such as WIFEXITED.  It can also
-// be used with g_spawn_check_wait_status().
+// be used with [glib.SpawnCheckWaitStatus].
 //
-// It is more likely that you want to use g_subprocess_get_if_exited()
-// followed by g_subprocess_get_exit_status().
+// It is more likely that you want to use [Subprocess.GetIfExited]
+// followed by [Subprocess.GetExitStatus].
 //
-// It is an error to call this function before g_subprocess_wait() has
+// It is an error to call this function before [Subprocess.Wait] has
 // returned.
 func (x *Subprocess) GetStatus() int {
 
@@ -383,11 +389,11 @@ func (x *Subprocess) GetStatus() int {
 
 var xSubprocessGetStderrPipe func(uintptr) uintptr
 
-// Gets the #GInputStream from which to read the stderr output of
-// @subprocess.
+// Gets the [InputStream] from which to read the stderr output of
+// subprocess.
 //
-// The process must have been created with %G_SUBPROCESS_FLAGS_STDERR_PIPE,
-// otherwise %NULL will be returned.
+// The process must have been created with [GSubprocessFlagsStderrPipeValue],
+// otherwise nil will be returned.
 func (x *Subprocess) GetStderrPipe() *InputStream {
 	var cls *InputStream
 
@@ -404,11 +410,11 @@ func (x *Subprocess) GetStderrPipe() *InputStream {
 
 var xSubprocessGetStdinPipe func(uintptr) uintptr
 
-// Gets the #GOutputStream that you can write to in order to give data
-// to the stdin of @subprocess.
+// Gets the [OutputStream] that you can write to in order to give data
+// to the stdin of subprocess.
 //
-// The process must have been created with %G_SUBPROCESS_FLAGS_STDIN_PIPE and
-// not %G_SUBPROCESS_FLAGS_STDIN_INHERIT, otherwise %NULL will be returned.
+// The process must have been created with [GSubprocessFlagsStdinPipeValue] and
+// not [GSubprocessFlagsStdinInheritValue], otherwise nil will be returned.
 func (x *Subprocess) GetStdinPipe() *OutputStream {
 	var cls *OutputStream
 
@@ -425,11 +431,11 @@ func (x *Subprocess) GetStdinPipe() *OutputStream {
 
 var xSubprocessGetStdoutPipe func(uintptr) uintptr
 
-// Gets the #GInputStream from which to read the stdout output of
-// @subprocess.
+// Gets the [InputStream] from which to read the stdout output of
+// subprocess.
 //
-// The process must have been created with %G_SUBPROCESS_FLAGS_STDOUT_PIPE,
-// otherwise %NULL will be returned.
+// The process must have been created with [GSubprocessFlagsStdoutPipeValue],
+// otherwise nil will be returned.
 func (x *Subprocess) GetStdoutPipe() *InputStream {
 	var cls *InputStream
 
@@ -450,7 +456,7 @@ var xSubprocessGetSuccessful func(uintptr) bool
 // successful if it exited cleanly with an exit status of 0, either by
 // way of the exit() system call or return from main().
 //
-// It is an error to call this function before g_subprocess_wait() has
+// It is an error to call this function before [Subprocess.Wait] has
 // returned.
 func (x *Subprocess) GetSuccessful() bool {
 
@@ -465,8 +471,8 @@ var xSubprocessGetTermSig func(uintptr) int
 //
 // This is equivalent to the system WTERMSIG macro.
 //
-// It is an error to call this function before g_subprocess_wait() and
-// unless g_subprocess_get_if_signaled() returned %TRUE.
+// It is an error to call this function before [Subprocess.Wait] and
+// unless [Subprocess.GetIfSignaled] returned true.
 func (x *Subprocess) GetTermSig() int {
 
 	cret := xSubprocessGetTermSig(x.GoPointer())
@@ -475,7 +481,7 @@ func (x *Subprocess) GetTermSig() int {
 
 var xSubprocessSendSignal func(uintptr, int)
 
-// Sends the UNIX signal @signal_num to the subprocess, if it is still
+// Sends the UNIX signal signal_num to the subprocess, if it is still
 // running.
 //
 // This API is race-free.  If the subprocess has terminated, it will not
@@ -493,20 +499,20 @@ var xSubprocessWait func(uintptr, uintptr, **glib.Error) bool
 // Synchronously wait for the subprocess to terminate.
 //
 // After the process terminates you can query its exit status with
-// functions such as g_subprocess_get_if_exited() and
-// g_subprocess_get_exit_status().
+// functions such as [Subprocess.GetIfExited] and
+// [Subprocess.GetExitStatus].
 //
 // This function does not fail in the case of the subprocess having
-// abnormal termination.  See g_subprocess_wait_check() for that.
+// abnormal termination.  See [Subprocess.WaitCheck] for that.
 //
-// Cancelling @cancellable doesn't kill the subprocess.  Call
-// g_subprocess_force_exit() if it is desirable.
+// Cancelling cancellable doesn't kill the subprocess.  Call
+// [Subprocess.ForceExit] if it is desirable.
 func (x *Subprocess) Wait(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessWait(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -516,7 +522,7 @@ var xSubprocessWaitAsync func(uintptr, uintptr, uintptr, uintptr)
 
 // Wait for the subprocess to terminate.
 //
-// This is the asynchronous version of g_subprocess_wait().
+// This is the asynchronous version of [Subprocess.Wait].
 func (x *Subprocess) WaitAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -526,11 +532,12 @@ func (x *Subprocess) WaitAsync(CancellableVar *Cancellable, CallbackVar *AsyncRe
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -540,13 +547,13 @@ func (x *Subprocess) WaitAsync(CancellableVar *Cancellable, CallbackVar *AsyncRe
 
 var xSubprocessWaitCheck func(uintptr, uintptr, **glib.Error) bool
 
-// Combines g_subprocess_wait() with g_spawn_check_wait_status().
+// Combines [Subprocess.Wait] with [glib.SpawnCheckWaitStatus].
 func (x *Subprocess) WaitCheck(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessWaitCheck(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -554,9 +561,9 @@ func (x *Subprocess) WaitCheck(CancellableVar *Cancellable) (bool, error) {
 
 var xSubprocessWaitCheckAsync func(uintptr, uintptr, uintptr, uintptr)
 
-// Combines g_subprocess_wait_async() with g_spawn_check_wait_status().
+// Combines [Subprocess.WaitAsync] with [glib.SpawnCheckWaitStatus].
 //
-// This is the asynchronous version of g_subprocess_wait_check().
+// This is the asynchronous version of [Subprocess.WaitCheck].
 func (x *Subprocess) WaitCheckAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -566,11 +573,12 @@ func (x *Subprocess) WaitCheckAsync(CancellableVar *Cancellable, CallbackVar *As
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -581,13 +589,13 @@ func (x *Subprocess) WaitCheckAsync(CancellableVar *Cancellable, CallbackVar *As
 var xSubprocessWaitCheckFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Collects the result of a previous call to
-// g_subprocess_wait_check_async().
+// [Subprocess.WaitCheckAsync].
 func (x *Subprocess) WaitCheckFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessWaitCheckFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -596,13 +604,13 @@ func (x *Subprocess) WaitCheckFinish(ResultVar AsyncResult) (bool, error) {
 var xSubprocessWaitFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Collects the result of a previous call to
-// g_subprocess_wait_async().
+// [Subprocess.WaitAsync].
 func (x *Subprocess) WaitFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xSubprocessWaitFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -634,25 +642,25 @@ func (x *Subprocess) SetPropertyArgv(value []string) {
 // g_initable_new() should typically be used instead.
 //
 // The object must be initialized before any real use after initial
-// construction, either with this function or g_async_initable_init_async().
+// construction, either with this function or [AsyncInitable.InitAsync].
 //
-// Implementations may also support cancellation. If @cancellable is not %NULL,
+// Implementations may also support cancellation. If cancellable is not nil,
 // then initialization can be cancelled by triggering the cancellable object
 // from another thread. If the operation was cancelled, the error
-// %G_IO_ERROR_CANCELLED will be returned. If @cancellable is not %NULL and
+// [GIoErrorCancelledValue] will be returned. If cancellable is not nil and
 // the object doesn't support cancellable initialization the error
-// %G_IO_ERROR_NOT_SUPPORTED will be returned.
+// [GIoErrorNotSupportedValue] will be returned.
 //
 // If the object is not initialized, or initialization returns with an
-// error, then all operations on the object except g_object_ref() and
-// g_object_unref() are considered to be invalid, and have undefined
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
 // behaviour. See the [description][iface@Gio.Initable#description] for more details.
 //
-// Callers should not assume that a class which implements #GInitable can be
+// Callers should not assume that a class which implements [Initable] can be
 // initialized multiple times, unless the class explicitly documents itself as
 // supporting this. Generally, a class’ implementation of init() can assume
 // (and assert) that it will only be called once. Previously, this documentation
-// recommended all #GInitable implementations should be idempotent; that
+// recommended all [Initable] implementations should be idempotent; that
 // recommendation was relaxed in GLib 2.54.
 //
 // If a class explicitly supports being initialized multiple times, it is
@@ -662,16 +670,16 @@ func (x *Subprocess) SetPropertyArgv(value []string) {
 //
 // One reason why a class might need to support idempotent initialization is if
 // it is designed to be used via the singleton pattern, with a
-// #GObjectClass.constructor that sometimes returns an existing instance.
-// In this pattern, a caller would expect to be able to call g_initable_init()
-// on the result of g_object_new(), regardless of whether it is in fact a new
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
 // instance.
 func (x *Subprocess) Init(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -682,7 +690,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -691,32 +699,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xSubprocessGLibType, libs, "g_subprocess_get_type")
 
-	core.PuregoSafeRegister(&xNewSubprocess, libs, "g_subprocess_new")
-	core.PuregoSafeRegister(&xNewSubprocessv, libs, "g_subprocess_newv")
-
-	core.PuregoSafeRegister(&xSubprocessCommunicate, libs, "g_subprocess_communicate")
-	core.PuregoSafeRegister(&xSubprocessCommunicateAsync, libs, "g_subprocess_communicate_async")
-	core.PuregoSafeRegister(&xSubprocessCommunicateFinish, libs, "g_subprocess_communicate_finish")
-	core.PuregoSafeRegister(&xSubprocessCommunicateUtf8, libs, "g_subprocess_communicate_utf8")
-	core.PuregoSafeRegister(&xSubprocessCommunicateUtf8Async, libs, "g_subprocess_communicate_utf8_async")
-	core.PuregoSafeRegister(&xSubprocessCommunicateUtf8Finish, libs, "g_subprocess_communicate_utf8_finish")
-	core.PuregoSafeRegister(&xSubprocessForceExit, libs, "g_subprocess_force_exit")
-	core.PuregoSafeRegister(&xSubprocessGetExitStatus, libs, "g_subprocess_get_exit_status")
-	core.PuregoSafeRegister(&xSubprocessGetIdentifier, libs, "g_subprocess_get_identifier")
-	core.PuregoSafeRegister(&xSubprocessGetIfExited, libs, "g_subprocess_get_if_exited")
-	core.PuregoSafeRegister(&xSubprocessGetIfSignaled, libs, "g_subprocess_get_if_signaled")
-	core.PuregoSafeRegister(&xSubprocessGetStatus, libs, "g_subprocess_get_status")
-	core.PuregoSafeRegister(&xSubprocessGetStderrPipe, libs, "g_subprocess_get_stderr_pipe")
-	core.PuregoSafeRegister(&xSubprocessGetStdinPipe, libs, "g_subprocess_get_stdin_pipe")
-	core.PuregoSafeRegister(&xSubprocessGetStdoutPipe, libs, "g_subprocess_get_stdout_pipe")
-	core.PuregoSafeRegister(&xSubprocessGetSuccessful, libs, "g_subprocess_get_successful")
-	core.PuregoSafeRegister(&xSubprocessGetTermSig, libs, "g_subprocess_get_term_sig")
-	core.PuregoSafeRegister(&xSubprocessSendSignal, libs, "g_subprocess_send_signal")
-	core.PuregoSafeRegister(&xSubprocessWait, libs, "g_subprocess_wait")
-	core.PuregoSafeRegister(&xSubprocessWaitAsync, libs, "g_subprocess_wait_async")
-	core.PuregoSafeRegister(&xSubprocessWaitCheck, libs, "g_subprocess_wait_check")
-	core.PuregoSafeRegister(&xSubprocessWaitCheckAsync, libs, "g_subprocess_wait_check_async")
-	core.PuregoSafeRegister(&xSubprocessWaitCheckFinish, libs, "g_subprocess_wait_check_finish")
-	core.PuregoSafeRegister(&xSubprocessWaitFinish, libs, "g_subprocess_wait_finish")
+	core.PuregoRegisterSince(&xNewSubprocess, libs, "g_subprocess_new", "GIO", "2.40")
+	core.PuregoRegisterSince(&xNewSubprocessv, libs, "g_subprocess_newv", "GIO", "2.40")
+
+	core.PuregoRegisterSince(&xSubprocessCommunicate, libs, "g_subprocess_communicate", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessCommunicateAsync, libs, "g_subprocess_communicate_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessCommunicateFinish, libs, "g_subprocess_communicate_finish", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessCommunicateUtf8, libs, "g_subprocess_communicate_utf8", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessCommunicateUtf8Async, libs, "g_subprocess_communicate_utf8_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessCommunicateUtf8Finish, libs, "g_subprocess_communicate_utf8_finish", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessForceExit, libs, "g_subprocess_force_exit", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetExitStatus, libs, "g_subprocess_get_exit_status", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetIdentifier, libs, "g_subprocess_get_identifier", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetIfExited, libs, "g_subprocess_get_if_exited", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetIfSignaled, libs, "g_subprocess_get_if_signaled", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetStatus, libs, "g_subprocess_get_status", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetStderrPipe, libs, "g_subprocess_get_stderr_pipe", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetStdinPipe, libs, "g_subprocess_get_stdin_pipe", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetStdoutPipe, libs, "g_subprocess_get_stdout_pipe", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetSuccessful, libs, "g_subprocess_get_successful", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessGetTermSig, libs, "g_subprocess_get_term_sig", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessSendSignal, libs, "g_subprocess_send_signal", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWait, libs, "g_subprocess_wait", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWaitAsync, libs, "g_subprocess_wait_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWaitCheck, libs, "g_subprocess_wait_check", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWaitCheckAsync, libs, "g_subprocess_wait_check_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWaitCheckFinish, libs, "g_subprocess_wait_check_finish", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessWaitFinish, libs, "g_subprocess_wait_finish", "GIO", "2.40")
 
 }
@@ -4,7 +4,6 @@ package gio
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 // such as where its standard input and output will be directed, the
 // argument list, the environment, and more.
 //
-// While the [class@Gio.Subprocess] class has high level functions covering
+// While the [Subprocess] class has high level functions covering
 // popular cases, use of this class allows access to more advanced
 // options.  It can also be used to launch multiple subprocesses with
 // a similar configuration.
@@ -37,7 +36,7 @@ func SubprocessLauncherNewFromInternalPtr(ptr uintptr) *SubprocessLauncher {
 
 var xNewSubprocessLauncher func(SubprocessFlags) uintptr
 
-// Creates a new #GSubprocessLauncher.
+// Creates a new [SubprocessLauncher].
 //
 // The launcher is created with the default options.  A copy of the
 // environment of the calling process is made at the time of this call
@@ -58,13 +57,13 @@ func NewSubprocessLauncher(FlagsVar SubprocessFlags) *SubprocessLauncher {
 var xSubprocessLauncherClose func(uintptr)
 
 // Closes all the file descriptors previously passed to the object with
-// g_subprocess_launcher_take_fd(), g_subprocess_launcher_take_stderr_fd(), etc.
+// [SubprocessLauncher.TakeFd], [SubprocessLauncher.TakeStderrFd], etc.
 //
-// After calling this method, any subsequent calls to g_subprocess_launcher_spawn() or g_subprocess_launcher_spawnv() will
-// return %G_IO_ERROR_CLOSED. This method is idempotent if
+// After calling this method, any subsequent calls to [SubprocessLauncher.Spawn] or [SubprocessLauncher.Spawnv] will
+// return [GIoErrorClosedValue]. This method is idempotent if
 // called more than once.
 //
-// This function is called automatically when the #GSubprocessLauncher
+// This function is called automatically when the [SubprocessLauncher]
 // is disposed, but is provided separately so that garbage collected
 // language bindings can call it earlier to guarantee when FDs are closed.
 func (x *SubprocessLauncher) Close() {
@@ -75,7 +74,7 @@ func (x *SubprocessLauncher) Close() {
 
 var xSubprocessLauncherGetenv func(uintptr, string) string
 
-// Returns the value of the environment variable @variable in the
+// Returns the value of the environment variable variable in the
 // environment of processes launched from this launcher.
 //
 // On UNIX, the returned string can be an arbitrary byte string.
@@ -93,12 +92,12 @@ var xSubprocessLauncherSetChildSetup func(uintptr, uintptr, uintptr, uintptr)
 // The child setup function will be called after fork() but before
 // exec() on the child's side.
 //
-// @destroy_notify will not be automatically called on the child's side
+// destroy_notify will not be automatically called on the child's side
 // of the fork().  It will only be called when the last reference on the
-// #GSubprocessLauncher is dropped or when a new child setup function is
+// [SubprocessLauncher] is dropped or when a new child setup function is
 // given.
 //
-// %NULL can be given as @child_setup to disable the functionality.
+// nil can be given as child_setup to disable the functionality.
 //
 // Child setup functions are only available on UNIX.
 func (x *SubprocessLauncher) SetChildSetup(ChildSetupVar *glib.SpawnChildSetupFunc, UserDataVar uintptr, DestroyNotifyVar *glib.DestroyNotify) {
@@ -110,11 +109,12 @@ func (x *SubprocessLauncher) SetChildSetup(ChildSetupVar *glib.SpawnChildSetupFu
 			ChildSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ChildSetupVar
 				cbFn(arg0)
 			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
+			ChildSetupVarRef = core.NewCallback(fcb)
+			ChildSetupVarRef = glib.SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
 		}
 	}
 
@@ -125,11 +125,12 @@ func (x *SubprocessLauncher) SetChildSetup(ChildSetupVar *glib.SpawnChildSetupFu
 			DestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyNotifyVar
 				cbFn(arg0)
 			}
-			DestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
+			DestroyNotifyVarRef = core.NewCallback(fcb)
+			DestroyNotifyVarRef = glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
 		}
 	}
 
@@ -155,18 +156,18 @@ var xSubprocessLauncherSetEnviron func(uintptr, []string)
 // Replace the entire environment of processes launched from this
 // launcher with the given 'environ' variable.
 //
-// Typically you will build this variable by using g_listenv() to copy
-// the process 'environ' and using the functions g_environ_setenv(),
-// g_environ_unsetenv(), etc.
+// Typically you will build this variable by using [glib.Listenv] to copy
+// the process 'environ' and using the functions [glib.EnvironSetenv],
+// [glib.EnvironUnsetenv], etc.
 //
-// As an alternative, you can use g_subprocess_launcher_setenv(),
-// g_subprocess_launcher_unsetenv(), etc.
+// As an alternative, you can use [SubprocessLauncher.Setenv],
+// [SubprocessLauncher.Unsetenv], etc.
 //
-// Pass an empty array to set an empty environment. Pass %NULL to inherit the
+// Pass an empty array to set an empty environment. Pass nil to inherit the
 // parent process’ environment. As of GLib 2.54, the parent process’ environment
-// will be copied when g_subprocess_launcher_set_environ() is called.
+// will be copied when [SubprocessLauncher.SetEnviron] is called.
 // Previously, it was copied when the subprocess was executed. This means the
-// copied environment may now be modified (using g_subprocess_launcher_setenv(),
+// copied environment may now be modified (using [SubprocessLauncher.Setenv],
 // etc.) before launching the subprocess.
 //
 // On UNIX, all strings in this array can be arbitrary byte strings.
@@ -181,16 +182,16 @@ var xSubprocessLauncherSetFlags func(uintptr, SubprocessFlags)
 
 // Sets the flags on the launcher.
 //
-// The default flags are %G_SUBPROCESS_FLAGS_NONE.
+// The default flags are [GSubprocessFlagsNoneValue].
 //
 // You may not set flags that specify conflicting options for how to
 // handle a particular stdio stream (eg: specifying both
-// %G_SUBPROCESS_FLAGS_STDIN_PIPE and
-// %G_SUBPROCESS_FLAGS_STDIN_INHERIT).
+// [GSubprocessFlagsStdinPipeValue] and
+// [GSubprocessFlagsStdinInheritValue]).
 //
 // You may also not set a flag that conflicts with a previous call to a
-// function like g_subprocess_launcher_set_stdin_file_path() or
-// g_subprocess_launcher_take_stdout_fd().
+// function like [SubprocessLauncher.SetStdinFilePath] or
+// [SubprocessLauncher.TakeStdoutFd].
 func (x *SubprocessLauncher) SetFlags(FlagsVar SubprocessFlags) {
 
 	xSubprocessLauncherSetFlags(x.GoPointer(), FlagsVar)
@@ -201,13 +202,13 @@ var xSubprocessLauncherSetStderrFilePath func(uintptr, uintptr)
 
 // Sets the file path to use as the stderr for spawned processes.
 //
-// If @path is %NULL then any previously given path is unset.
+// If path is nil then any previously given path is unset.
 //
 // The file will be created or truncated when the process is spawned, as
-// would be the case if using '2&gt;' at the shell.
+// would be the case if using '2>' at the shell.
 //
 // If you want to send both stdout and stderr to the same file then use
-// %G_SUBPROCESS_FLAGS_STDERR_MERGE.
+// [GSubprocessFlagsStderrMergeValue].
 //
 // You may not set a stderr file path if a stderr fd is already set or
 // if the launcher flags contain any flags directing stderr elsewhere.
@@ -226,7 +227,7 @@ var xSubprocessLauncherSetStdinFilePath func(uintptr, uintptr)
 
 // Sets the file path to use as the stdin for spawned processes.
 //
-// If @path is %NULL then any previously given path is unset.
+// If path is nil then any previously given path is unset.
 //
 // The file must exist or spawning the process will fail.
 //
@@ -247,10 +248,10 @@ var xSubprocessLauncherSetStdoutFilePath func(uintptr, uintptr)
 
 // Sets the file path to use as the stdout for spawned processes.
 //
-// If @path is %NULL then any previously given path is unset.
+// If path is nil then any previously given path is unset.
 //
 // The file will be created or truncated when the process is spawned, as
-// would be the case if using '&gt;' at the shell.
+// would be the case if using '>' at the shell.
 //
 // You may not set a stdout file path if a stdout fd is already set or
 // if the launcher flags contain any flags directing stdout elsewhere.
@@ -267,7 +268,7 @@ func (x *SubprocessLauncher) SetStdoutFilePath(PathVar *string) {
 
 var xSubprocessLauncherSetenv func(uintptr, string, string, bool)
 
-// Sets the environment variable @variable in the environment of
+// Sets the environment variable variable in the environment of
 // processes launched from this launcher.
 //
 // On UNIX, both the variable's name and value can be arbitrary byte
@@ -281,7 +282,7 @@ func (x *SubprocessLauncher) Setenv(VariableVar string, ValueVar string, Overwri
 
 var xSubprocessLauncherSpawn func(uintptr, **glib.Error, string, ...interface{}) uintptr
 
-// Creates a #GSubprocess given a provided varargs list of arguments.
+// Creates a [Subprocess] given a provided varargs list of arguments.
 func (x *SubprocessLauncher) Spawn(ErrorVar **glib.Error, Argv0Var string, varArgs ...interface{}) *Subprocess {
 	var cls *Subprocess
 
@@ -297,7 +298,7 @@ func (x *SubprocessLauncher) Spawn(ErrorVar **glib.Error, Argv0Var string, varAr
 
 var xSubprocessLauncherSpawnv func(uintptr, []string, **glib.Error) uintptr
 
-// Creates a #GSubprocess given a provided array of arguments.
+// Creates a [Subprocess] given a provided array of arguments.
 func (x *SubprocessLauncher) Spawnv(ArgvVar []string) (*Subprocess, error) {
 	var cls *Subprocess
 	var cerr *glib.Error
@@ -305,12 +306,15 @@ func (x *SubprocessLauncher) Spawnv(ArgvVar []string) (*Subprocess, error) {
 	cret := xSubprocessLauncherSpawnv(x.GoPointer(), ArgvVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Subprocess{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -319,8 +323,8 @@ func (x *SubprocessLauncher) Spawnv(ArgvVar []string) (*Subprocess, error) {
 var xSubprocessLauncherTakeFd func(uintptr, int, int)
 
 // Transfer an arbitrary file descriptor from parent process to the
-// child.  This function takes ownership of the @source_fd; it will be closed
-// in the parent when @self is freed.
+// child.  This function takes ownership of the source_fd; it will be closed
+// in the parent when self is freed.
 //
 // By default, all file descriptors from the parent will be closed.
 // This function allows you to create (for example) a custom `pipe()` or
@@ -340,12 +344,12 @@ var xSubprocessLauncherTakeStderrFd func(uintptr, int)
 
 // Sets the file descriptor to use as the stderr for spawned processes.
 //
-// If @fd is -1 then any previously given fd is unset.
+// If fd is -1 then any previously given fd is unset.
 //
 // Note that the default behaviour is to pass stderr through to the
 // stderr of the parent process.
 //
-// The passed @fd belongs to the #GSubprocessLauncher.  It will be
+// The passed fd belongs to the [SubprocessLauncher].  It will be
 // automatically closed when the launcher is finalized.  The file
 // descriptor will also be closed on the child side when executing the
 // spawned process.
@@ -364,13 +368,13 @@ var xSubprocessLauncherTakeStdinFd func(uintptr, int)
 
 // Sets the file descriptor to use as the stdin for spawned processes.
 //
-// If @fd is -1 then any previously given fd is unset.
+// If fd is -1 then any previously given fd is unset.
 //
 // Note that if your intention is to have the stdin of the calling
-// process inherited by the child then %G_SUBPROCESS_FLAGS_STDIN_INHERIT
+// process inherited by the child then [GSubprocessFlagsStdinInheritValue]
 // is a better way to go about doing that.
 //
-// The passed @fd is noted but will not be touched in the current
+// The passed fd is noted but will not be touched in the current
 // process.  It is therefore necessary that it be kept open by the
 // caller until the subprocess is spawned.  The file descriptor will
 // also not be explicitly closed on the child side, so it must be marked
@@ -390,12 +394,12 @@ var xSubprocessLauncherTakeStdoutFd func(uintptr, int)
 
 // Sets the file descriptor to use as the stdout for spawned processes.
 //
-// If @fd is -1 then any previously given fd is unset.
+// If fd is -1 then any previously given fd is unset.
 //
 // Note that the default behaviour is to pass stdout through to the
 // stdout of the parent process.
 //
-// The passed @fd is noted but will not be touched in the current
+// The passed fd is noted but will not be touched in the current
 // process.  It is therefore necessary that it be kept open by the
 // caller until the subprocess is spawned.  The file descriptor will
 // also not be explicitly closed on the child side, so it must be marked
@@ -413,7 +417,7 @@ func (x *SubprocessLauncher) TakeStdoutFd(FdVar int) {
 
 var xSubprocessLauncherUnsetenv func(uintptr, string)
 
-// Removes the environment variable @variable from the environment of
+// Removes the environment variable variable from the environment of
 // processes launched from this launcher.
 //
 // On UNIX, the variable's name can be an arbitrary byte string not
@@ -440,7 +444,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -449,24 +453,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xSubprocessLauncherGLibType, libs, "g_subprocess_launcher_get_type")
 
-	core.PuregoSafeRegister(&xNewSubprocessLauncher, libs, "g_subprocess_launcher_new")
-
-	core.PuregoSafeRegister(&xSubprocessLauncherClose, libs, "g_subprocess_launcher_close")
-	core.PuregoSafeRegister(&xSubprocessLauncherGetenv, libs, "g_subprocess_launcher_getenv")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetChildSetup, libs, "g_subprocess_launcher_set_child_setup")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetCwd, libs, "g_subprocess_launcher_set_cwd")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetEnviron, libs, "g_subprocess_launcher_set_environ")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetFlags, libs, "g_subprocess_launcher_set_flags")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetStderrFilePath, libs, "g_subprocess_launcher_set_stderr_file_path")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetStdinFilePath, libs, "g_subprocess_launcher_set_stdin_file_path")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetStdoutFilePath, libs, "g_subprocess_launcher_set_stdout_file_path")
-	core.PuregoSafeRegister(&xSubprocessLauncherSetenv, libs, "g_subprocess_launcher_setenv")
-	core.PuregoSafeRegister(&xSubprocessLauncherSpawn, libs, "g_subprocess_launcher_spawn")
-	core.PuregoSafeRegister(&xSubprocessLauncherSpawnv, libs, "g_subprocess_launcher_spawnv")
-	core.PuregoSafeRegister(&xSubprocessLauncherTakeFd, libs, "g_subprocess_launcher_take_fd")
-	core.PuregoSafeRegister(&xSubprocessLauncherTakeStderrFd, libs, "g_subprocess_launcher_take_stderr_fd")
-	core.PuregoSafeRegister(&xSubprocessLauncherTakeStdinFd, libs, "g_subprocess_launcher_take_stdin_fd")
-	core.PuregoSafeRegister(&xSubprocessLauncherTakeStdoutFd, libs, "g_subprocess_launcher_take_stdout_fd")
-	core.PuregoSafeRegister(&xSubprocessLauncherUnsetenv, libs, "g_subprocess_launcher_unsetenv")
+	core.PuregoRegisterSince(&xNewSubprocessLauncher, libs, "g_subprocess_launcher_new", "GIO", "2.40")
+
+	core.PuregoRegisterSince(&xSubprocessLauncherClose, libs, "g_subprocess_launcher_close", "GIO", "2.68")
+	core.PuregoRegisterSince(&xSubprocessLauncherGetenv, libs, "g_subprocess_launcher_getenv", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetChildSetup, libs, "g_subprocess_launcher_set_child_setup", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetCwd, libs, "g_subprocess_launcher_set_cwd", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetEnviron, libs, "g_subprocess_launcher_set_environ", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetFlags, libs, "g_subprocess_launcher_set_flags", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetStderrFilePath, libs, "g_subprocess_launcher_set_stderr_file_path", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetStdinFilePath, libs, "g_subprocess_launcher_set_stdin_file_path", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetStdoutFilePath, libs, "g_subprocess_launcher_set_stdout_file_path", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSetenv, libs, "g_subprocess_launcher_setenv", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSpawn, libs, "g_subprocess_launcher_spawn", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherSpawnv, libs, "g_subprocess_launcher_spawnv", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherTakeFd, libs, "g_subprocess_launcher_take_fd", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherTakeStderrFd, libs, "g_subprocess_launcher_take_stderr_fd", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherTakeStdinFd, libs, "g_subprocess_launcher_take_stdin_fd", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherTakeStdoutFd, libs, "g_subprocess_launcher_take_stdout_fd", "GIO", "2.40")
+	core.PuregoRegisterSince(&xSubprocessLauncherUnsetenv, libs, "g_subprocess_launcher_unsetenv", "GIO", "2.40")
 
 }
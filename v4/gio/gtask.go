@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,19 +12,19 @@ import (
 )
 
 // The prototype for a task function to be run in a thread via
-// g_task_run_in_thread() or g_task_run_in_thread_sync().
+// [Task.RunInThread] or [Task.RunInThreadSync].
 //
-// If the return-on-cancel flag is set on @task, and @cancellable gets
-// cancelled, then the #GTask will be completed immediately (as though
-// g_task_return_error_if_cancelled() had been called), without
+// If the return-on-cancel flag is set on task, and cancellable gets
+// cancelled, then the [Task] will be completed immediately (as though
+// [Task.ReturnErrorIfCancelled] had been called), without
 // waiting for the task function to complete. However, the task
 // function will continue running in its thread in the background. The
 // function therefore needs to be careful about how it uses
 // externally-visible state in this case. See
-// g_task_set_return_on_cancel() for more details.
+// [Task.SetReturnOnCancel] for more details.
 //
-// Other than in that case, @task will be completed when the
-// #GTaskThreadFunc returns, not when it calls a
+// Other than in that case, task will be completed when the
+// [TaskThreadFunc] returns, not when it calls a
 // `g_task_return_` function.
 type TaskThreadFunc func(uintptr, uintptr, uintptr, uintptr)
 
@@ -39,26 +38,26 @@ func (x *TaskClass) GoPointer() uintptr {
 
 // A `GTask` represents and manages a cancellable ‘task’.
 //
-// ## Asynchronous operations
+// # Asynchronous operations
 //
-// The most common usage of `GTask` is as a [iface@Gio.AsyncResult], to
+// The most common usage of `GTask` is as a [AsyncResult], to
 // manage data during an asynchronous operation. You call
-// [ctor@Gio.Task.new] in the ‘start’ method, followed by
-// [method@Gio.Task.set_task_data] and the like if you need to keep some
+// [NewTask] in the ‘start’ method, followed by
+// [Task.SetTaskData] and the like if you need to keep some
 // additional data associated with the task, and then pass the
 // task object around through your asynchronous operation.
 // Eventually, you will call a method such as
-// [method@Gio.Task.return_pointer] or [method@Gio.Task.return_error], which
+// [Task.ReturnPointer] or [Task.ReturnError], which
 // will save the value you give it and then invoke the task’s callback
 // function in the thread-default main context (see
-// [method@GLib.MainContext.push_thread_default])
+// [glib.MainContext.PushThreadDefault])
 // where it was created (waiting until the next iteration of the main
 // loop first, if necessary). The caller will pass the `GTask` back to
-// the operation’s finish function (as a [iface@Gio.AsyncResult]), and you can
-// use [method@Gio.Task.propagate_pointer] or the like to extract the
+// the operation’s finish function (as a [AsyncResult]), and you can
+// use [Task.PropagatePointer] or the like to extract the
 // return value.
 //
-// Using `GTask` requires the thread-default [struct@GLib.MainContext] from when
+// Using `GTask` requires the thread-default [glib.MainContext] from when
 // the `GTask` was constructed to be running at least until the task has
 // completed and its data has been freed.
 //
@@ -66,27 +65,23 @@ func (x *TaskClass) GoPointer() uintptr {
 // not call `g_task_return_*()` on it. GLib will warn at runtime if this happens
 // (since 2.76).
 //
-// Here is an example for using `GTask` as a [iface@Gio.AsyncResult]:
-// ```c
+// Here is an example for using `GTask` as a [AsyncResult]:
 //
 //	typedef struct {
 //	  CakeFrostingType frosting;
 //	  char *message;
 //	} DecorationData;
 //
-// static void
-// decoration_data_free (DecorationData *decoration)
-//
+//	static void
+//	decoration_data_free (DecorationData *decoration)
 //	{
-//	  g_free (decoration-&gt;message);
+//	  g_free (decoration->message);
 //	  g_slice_free (DecorationData, decoration);
 //	}
 //
-// static void
-// baked_cb (Cake     *cake,
-//
-//	gpointer  user_data)
-//
+//	static void
+//	baked_cb (Cake     *cake,
+//	          gpointer  user_data)
 //	{
 //	  GTask *task = user_data;
 //	  DecorationData *decoration = g_task_get_task_data (task);
@@ -100,7 +95,7 @@ func (x *TaskClass) GoPointer() uintptr {
 //	      return;
 //	    }
 //
-//	  if (!cake_decorate (cake, decoration-&gt;frosting, decoration-&gt;message, &amp;error))
+//	  if (!cake_decorate (cake, decoration->frosting, decoration->message, &error))
 //	    {
 //	      g_object_unref (cake);
 //	      // g_task_return_error() takes ownership of error
@@ -113,24 +108,22 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  g_object_unref (task);
 //	}
 //
-// void
-// baker_bake_cake_async (Baker               *self,
-//
-//	guint                radius,
-//	CakeFlavor           flavor,
-//	CakeFrostingType     frosting,
-//	const char          *message,
-//	GCancellable        *cancellable,
-//	GAsyncReadyCallback  callback,
-//	gpointer             user_data)
-//
+//	void
+//	baker_bake_cake_async (Baker               *self,
+//	                       guint                radius,
+//	                       CakeFlavor           flavor,
+//	                       CakeFrostingType     frosting,
+//	                       const char          *message,
+//	                       GCancellable        *cancellable,
+//	                       GAsyncReadyCallback  callback,
+//	                       gpointer             user_data)
 //	{
 //	  GTask *task;
 //	  DecorationData *decoration;
 //	  Cake  *cake;
 //
 //	  task = g_task_new (self, cancellable, callback, user_data);
-//	  if (radius &lt; 3)
+//	  if (radius < 3)
 //	    {
 //	      g_task_return_new_error (task, BAKER_ERROR, BAKER_ERROR_TOO_SMALL,
 //	                               "%ucm radius cakes are silly",
@@ -149,42 +142,37 @@ func (x *TaskClass) GoPointer() uintptr {
 //	    }
 //
 //	  decoration = g_slice_new (DecorationData);
-//	  decoration-&gt;frosting = frosting;
-//	  decoration-&gt;message = g_strdup (message);
+//	  decoration->frosting = frosting;
+//	  decoration->message = g_strdup (message);
 //	  g_task_set_task_data (task, decoration, (GDestroyNotify) decoration_data_free);
 //
 //	  _baker_begin_cake (self, radius, flavor, cancellable, baked_cb, task);
 //	}
 //
-// Cake *
-// baker_bake_cake_finish (Baker         *self,
-//
-//	GAsyncResult  *result,
-//	GError       **error)
-//
+//	Cake *
+//	baker_bake_cake_finish (Baker         *self,
+//	                        GAsyncResult  *result,
+//	                        GError       **error)
 //	{
 //	  g_return_val_if_fail (g_task_is_valid (result, self), NULL);
 //
 //	  return g_task_propagate_pointer (G_TASK (result), error);
 //	}
 //
-// ```
-//
-// ## Chained asynchronous operations
+// # Chained asynchronous operations
 //
 // `GTask` also tries to simplify asynchronous operations that
 // internally chain together several smaller asynchronous
-// operations. [method@Gio.Task.get_cancellable], [method@Gio.Task.get_context],
-// and [method@Gio.Task.get_priority] allow you to get back the task’s
-// [class@Gio.Cancellable], [struct@GLib.MainContext], and
-// [I/O priority](iface.AsyncResult.html#io-priority)
+// operations. [Task.GetCancellable], [Task.GetContext],
+// and [Task.GetPriority] allow you to get back the task’s
+// [Cancellable], [glib.MainContext], and
+// I/O priority
 // when starting a new subtask, so you don’t have to keep track
-// of them yourself. [method@Gio.Task.attach_source] simplifies the case
+// of them yourself. [Task.AttachSource] simplifies the case
 // of waiting for a source to fire (automatically using the correct
-// [struct@GLib.MainContext] and priority).
+// [glib.MainContext] and priority).
 //
 // Here is an example for chained asynchronous operations:
-// ```c
 //
 //	typedef struct {
 //	  Cake *cake;
@@ -192,27 +180,24 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  char *message;
 //	} BakingData;
 //
-// static void
-// decoration_data_free (BakingData *bd)
-//
+//	static void
+//	decoration_data_free (BakingData *bd)
 //	{
-//	  if (bd-&gt;cake)
-//	    g_object_unref (bd-&gt;cake);
-//	  g_free (bd-&gt;message);
+//	  if (bd->cake)
+//	    g_object_unref (bd->cake);
+//	  g_free (bd->message);
 //	  g_slice_free (BakingData, bd);
 //	}
 //
-// static void
-// decorated_cb (Cake         *cake,
-//
-//	GAsyncResult *result,
-//	gpointer      user_data)
-//
+//	static void
+//	decorated_cb (Cake         *cake,
+//	              GAsyncResult *result,
+//	              gpointer      user_data)
 //	{
 //	  GTask *task = user_data;
 //	  GError *error = NULL;
 //
-//	  if (!cake_decorate_finish (cake, result, &amp;error))
+//	  if (!cake_decorate_finish (cake, result, &error))
 //	    {
 //	      g_object_unref (cake);
 //	      g_task_return_error (task, error);
@@ -226,25 +211,22 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  g_object_unref (task);
 //	}
 //
-// static gboolean
-// decorator_ready (gpointer user_data)
-//
+//	static gboolean
+//	decorator_ready (gpointer user_data)
 //	{
 //	  GTask *task = user_data;
 //	  BakingData *bd = g_task_get_task_data (task);
 //
-//	  cake_decorate_async (bd-&gt;cake, bd-&gt;frosting, bd-&gt;message,
+//	  cake_decorate_async (bd->cake, bd->frosting, bd->message,
 //	                       g_task_get_cancellable (task),
 //	                       decorated_cb, task);
 //
 //	  return G_SOURCE_REMOVE;
 //	}
 //
-// static void
-// baked_cb (Cake     *cake,
-//
-//	gpointer  user_data)
-//
+//	static void
+//	baked_cb (Cake     *cake,
+//	          gpointer  user_data)
 //	{
 //	  GTask *task = user_data;
 //	  BakingData *bd = g_task_get_task_data (task);
@@ -258,7 +240,7 @@ func (x *TaskClass) GoPointer() uintptr {
 //	      return;
 //	    }
 //
-//	  bd-&gt;cake = cake;
+//	  bd->cake = cake;
 //
 //	  // Bail out now if the user has already cancelled
 //	  if (g_task_return_error_if_cancelled (task))
@@ -281,18 +263,16 @@ func (x *TaskClass) GoPointer() uintptr {
 //	    }
 //	}
 //
-// void
-// baker_bake_cake_async (Baker               *self,
-//
-//	guint                radius,
-//	CakeFlavor           flavor,
-//	CakeFrostingType     frosting,
-//	const char          *message,
-//	gint                 priority,
-//	GCancellable        *cancellable,
-//	GAsyncReadyCallback  callback,
-//	gpointer             user_data)
-//
+//	void
+//	baker_bake_cake_async (Baker               *self,
+//	                       guint                radius,
+//	                       CakeFlavor           flavor,
+//	                       CakeFrostingType     frosting,
+//	                       const char          *message,
+//	                       gint                 priority,
+//	                       GCancellable        *cancellable,
+//	                       GAsyncReadyCallback  callback,
+//	                       gpointer             user_data)
 //	{
 //	  GTask *task;
 //	  BakingData *bd;
@@ -301,37 +281,32 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  g_task_set_priority (task, priority);
 //
 //	  bd = g_slice_new0 (BakingData);
-//	  bd-&gt;frosting = frosting;
-//	  bd-&gt;message = g_strdup (message);
+//	  bd->frosting = frosting;
+//	  bd->message = g_strdup (message);
 //	  g_task_set_task_data (task, bd, (GDestroyNotify) baking_data_free);
 //
 //	  _baker_begin_cake (self, radius, flavor, cancellable, baked_cb, task);
 //	}
 //
-// Cake *
-// baker_bake_cake_finish (Baker         *self,
-//
-//	GAsyncResult  *result,
-//	GError       **error)
-//
+//	Cake *
+//	baker_bake_cake_finish (Baker         *self,
+//	                        GAsyncResult  *result,
+//	                        GError       **error)
 //	{
 //	  g_return_val_if_fail (g_task_is_valid (result, self), NULL);
 //
 //	  return g_task_propagate_pointer (G_TASK (result), error);
 //	}
 //
-// ```
-//
-// ## Asynchronous operations from synchronous ones
+// # Asynchronous operations from synchronous ones
 //
-// You can use [method@Gio.Task.run_in_thread] to turn a synchronous
+// You can use [Task.RunInThread] to turn a synchronous
 // operation into an asynchronous one, by running it in a thread.
 // When it completes, the result will be dispatched to the thread-default
-// main context (see [method@GLib.MainContext.push_thread_default])
+// main context (see [glib.MainContext.PushThreadDefault])
 // where the `GTask` was created.
 //
 // Running a task in a thread:
-// ```c
 //
 //	typedef struct {
 //	  guint radius;
@@ -340,83 +315,74 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  char *message;
 //	} CakeData;
 //
-// static void
-// cake_data_free (CakeData *cake_data)
-//
+//	static void
+//	cake_data_free (CakeData *cake_data)
 //	{
-//	  g_free (cake_data-&gt;message);
+//	  g_free (cake_data->message);
 //	  g_slice_free (CakeData, cake_data);
 //	}
 //
-// static void
-// bake_cake_thread (GTask         *task,
-//
-//	gpointer       source_object,
-//	gpointer       task_data,
-//	GCancellable  *cancellable)
-//
+//	static void
+//	bake_cake_thread (GTask         *task,
+//	                  gpointer       source_object,
+//	                  gpointer       task_data,
+//	                  GCancellable  *cancellable)
 //	{
 //	  Baker *self = source_object;
 //	  CakeData *cake_data = task_data;
 //	  Cake *cake;
 //	  GError *error = NULL;
 //
-//	  cake = bake_cake (baker, cake_data-&gt;radius, cake_data-&gt;flavor,
-//	                    cake_data-&gt;frosting, cake_data-&gt;message,
-//	                    cancellable, &amp;error);
+//	  cake = bake_cake (baker, cake_data->radius, cake_data->flavor,
+//	                    cake_data->frosting, cake_data->message,
+//	                    cancellable, &error);
 //	  if (cake)
 //	    g_task_return_pointer (task, cake, g_object_unref);
 //	  else
 //	    g_task_return_error (task, error);
 //	}
 //
-// void
-// baker_bake_cake_async (Baker               *self,
-//
-//	guint                radius,
-//	CakeFlavor           flavor,
-//	CakeFrostingType     frosting,
-//	const char          *message,
-//	GCancellable        *cancellable,
-//	GAsyncReadyCallback  callback,
-//	gpointer             user_data)
-//
+//	void
+//	baker_bake_cake_async (Baker               *self,
+//	                       guint                radius,
+//	                       CakeFlavor           flavor,
+//	                       CakeFrostingType     frosting,
+//	                       const char          *message,
+//	                       GCancellable        *cancellable,
+//	                       GAsyncReadyCallback  callback,
+//	                       gpointer             user_data)
 //	{
 //	  CakeData *cake_data;
 //	  GTask *task;
 //
 //	  cake_data = g_slice_new (CakeData);
-//	  cake_data-&gt;radius = radius;
-//	  cake_data-&gt;flavor = flavor;
-//	  cake_data-&gt;frosting = frosting;
-//	  cake_data-&gt;message = g_strdup (message);
+//	  cake_data->radius = radius;
+//	  cake_data->flavor = flavor;
+//	  cake_data->frosting = frosting;
+//	  cake_data->message = g_strdup (message);
 //	  task = g_task_new (self, cancellable, callback, user_data);
 //	  g_task_set_task_data (task, cake_data, (GDestroyNotify) cake_data_free);
 //	  g_task_run_in_thread (task, bake_cake_thread);
 //	  g_object_unref (task);
 //	}
 //
-// Cake *
-// baker_bake_cake_finish (Baker         *self,
-//
-//	GAsyncResult  *result,
-//	GError       **error)
-//
+//	Cake *
+//	baker_bake_cake_finish (Baker         *self,
+//	                        GAsyncResult  *result,
+//	                        GError       **error)
 //	{
 //	  g_return_val_if_fail (g_task_is_valid (result, self), NULL);
 //
 //	  return g_task_propagate_pointer (G_TASK (result), error);
 //	}
 //
-// ```
-//
-// ## Adding cancellability to uncancellable tasks
+// # Adding cancellability to uncancellable tasks
 //
-// Finally, [method@Gio.Task.run_in_thread] and
-// [method@Gio.Task.run_in_thread_sync] can be used to turn an uncancellable
+// Finally, [Task.RunInThread] and
+// [Task.RunInThreadSync] can be used to turn an uncancellable
 // operation into a cancellable one. If you call
-// [method@Gio.Task.set_return_on_cancel], passing `TRUE`, then if the task’s
-// [class@Gio.Cancellable] is cancelled, it will return control back to the
+// [Task.SetReturnOnCancel], passing `TRUE`, then if the task’s
+// [Cancellable] is cancelled, it will return control back to the
 // caller immediately, while allowing the task thread to continue running in the
 // background (and simply discarding its result when it finally does finish).
 // Provided that the task thread is careful about how it uses
@@ -425,23 +391,21 @@ func (x *TaskClass) GoPointer() uintptr {
 // synchronous variants of blocking APIs.
 //
 // Cancelling a task:
-// ```c
-// static void
-// bake_cake_thread (GTask         *task,
-//
-//	gpointer       source_object,
-//	gpointer       task_data,
-//	GCancellable  *cancellable)
 //
+//	static void
+//	bake_cake_thread (GTask         *task,
+//	                  gpointer       source_object,
+//	                  gpointer       task_data,
+//	                  GCancellable  *cancellable)
 //	{
 //	  Baker *self = source_object;
 //	  CakeData *cake_data = task_data;
 //	  Cake *cake;
 //	  GError *error = NULL;
 //
-//	  cake = bake_cake (baker, cake_data-&gt;radius, cake_data-&gt;flavor,
-//	                    cake_data-&gt;frosting, cake_data-&gt;message,
-//	                    &amp;error);
+//	  cake = bake_cake (baker, cake_data->radius, cake_data->flavor,
+//	                    cake_data->frosting, cake_data->message,
+//	                    &error);
 //	  if (error)
 //	    {
 //	      g_task_return_error (task, error);
@@ -467,17 +431,15 @@ func (x *TaskClass) GoPointer() uintptr {
 //	    }
 //	}
 //
-// void
-// baker_bake_cake_async (Baker               *self,
-//
-//	guint                radius,
-//	CakeFlavor           flavor,
-//	CakeFrostingType     frosting,
-//	const char          *message,
-//	GCancellable        *cancellable,
-//	GAsyncReadyCallback  callback,
-//	gpointer             user_data)
-//
+//	void
+//	baker_bake_cake_async (Baker               *self,
+//	                       guint                radius,
+//	                       CakeFlavor           flavor,
+//	                       CakeFrostingType     frosting,
+//	                       const char          *message,
+//	                       GCancellable        *cancellable,
+//	                       GAsyncReadyCallback  callback,
+//	                       gpointer             user_data)
 //	{
 //	  CakeData *cake_data;
 //	  GTask *task;
@@ -492,16 +454,14 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  g_task_run_in_thread (task, bake_cake_thread);
 //	}
 //
-// Cake *
-// baker_bake_cake_sync (Baker               *self,
-//
-//	guint                radius,
-//	CakeFlavor           flavor,
-//	CakeFrostingType     frosting,
-//	const char          *message,
-//	GCancellable        *cancellable,
-//	GError             **error)
-//
+//	Cake *
+//	baker_bake_cake_sync (Baker               *self,
+//	                      guint                radius,
+//	                      CakeFlavor           flavor,
+//	                      CakeFrostingType     frosting,
+//	                      const char          *message,
+//	                      GCancellable        *cancellable,
+//	                      GError             **error)
 //	{
 //	  CakeData *cake_data;
 //	  GTask *task;
@@ -521,50 +481,48 @@ func (x *TaskClass) GoPointer() uintptr {
 //	  return cake;
 //	}
 //
-// ```
-//
-// ## Porting from [class@Gio.SimpleAsyncResult]
+// # Porting from [class@Gio.SimpleAsyncResult]
 //
-// `GTask`’s API attempts to be simpler than [class@Gio.SimpleAsyncResult]’s
+// `GTask`’s API attempts to be simpler than [SimpleAsyncResult]’s
 // in several ways:
 //
-//   - You can save task-specific data with [method@Gio.Task.set_task_data], and
-//     retrieve it later with [method@Gio.Task.get_task_data]. This replaces the
-//     abuse of [method@Gio.SimpleAsyncResult.set_op_res_gpointer] for the same
-//     purpose with [class@Gio.SimpleAsyncResult].
+//   - You can save task-specific data with [Task.SetTaskData], and
+//     retrieve it later with [Task.GetTaskData]. This replaces the
+//     abuse of [SimpleAsyncResult.SetOpResGpointer] for the same
+//     purpose with [SimpleAsyncResult].
 //   - In addition to the task data, `GTask` also keeps track of the
-//     [priority](iface.AsyncResult.html#io-priority), [class@Gio.Cancellable],
-//     and [struct@GLib.MainContext] associated with the task, so tasks that
+//     priority, [Cancellable],
+//     and [glib.MainContext] associated with the task, so tasks that
 //     consist of a chain of simpler asynchronous operations will have easy access
 //     to those values when starting each sub-task.
-//   - [method@Gio.Task.return_error_if_cancelled] provides simplified
+//   - [Task.ReturnErrorIfCancelled] provides simplified
 //     handling for cancellation. In addition, cancellation
 //     overrides any other `GTask` return value by default, like
-//     [class@Gio.SimpleAsyncResult] does when
-//     [method@Gio.SimpleAsyncResult.set_check_cancellable] is called.
-//     (You can use [method@Gio.Task.set_check_cancellable] to turn off that
-//     behavior.) On the other hand, [method@Gio.Task.run_in_thread]
+//     [SimpleAsyncResult] does when
+//     [SimpleAsyncResult.SetCheckCancellable] is called.
+//     (You can use [Task.SetCheckCancellable] to turn off that
+//     behavior.) On the other hand, [Task.RunInThread]
 //     guarantees that it will always run your
-//     `task_func`, even if the task’s [class@Gio.Cancellable]
+//     `task_func`, even if the task’s [Cancellable]
 //     is already cancelled before the task gets a chance to run;
 //     you can start your `task_func` with a
-//     [method@Gio.Task.return_error_if_cancelled] check if you need the
+//     [Task.ReturnErrorIfCancelled] check if you need the
 //     old behavior.
-//   - The ‘return’ methods (eg, [method@Gio.Task.return_pointer])
+//   - The ‘return’ methods (eg, [Task.ReturnPointer])
 //     automatically cause the task to be ‘completed’ as well, and
 //     there is no need to worry about the ‘complete’ vs ‘complete in idle’
 //     distinction. (`GTask` automatically figures out
 //     whether the task’s callback can be invoked directly, or
-//     if it needs to be sent to another [struct@GLib.MainContext], or delayed
-//     until the next iteration of the current [struct@GLib.MainContext].)
+//     if it needs to be sent to another [glib.MainContext], or delayed
+//     until the next iteration of the current [glib.MainContext].)
 //   - The ‘finish’ functions for `GTask` based operations are generally
-//     much simpler than [class@Gio.SimpleAsyncResult] ones, normally consisting
-//     of only a single call to [method@Gio.Task.propagate_pointer] or the like.
-//     Since [method@Gio.Task.propagate_pointer] ‘steals’ the return value from
+//     much simpler than [SimpleAsyncResult] ones, normally consisting
+//     of only a single call to [Task.PropagatePointer] or the like.
+//     Since [Task.PropagatePointer] ‘steals’ the return value from
 //     the `GTask`, it is not necessary to juggle pointers around to
 //     prevent it from being freed twice.
-//   - With [class@Gio.SimpleAsyncResult], it was common to call
-//     [method@Gio.SimpleAsyncResult.propagate_error] from the
+//   - With [SimpleAsyncResult], it was common to call
+//     [SimpleAsyncResult.PropagateError] from the
 //     `_finish()` wrapper function, and have
 //     virtual method implementations only deal with successful
 //     returns. This behavior is deprecated, because it makes it
@@ -573,14 +531,14 @@ func (x *TaskClass) GoPointer() uintptr {
 //     simple wrapper, and the virtual method should call an
 //     appropriate `g_task_propagate_` function.
 //     Note that wrapper methods can now use
-//     [method@Gio.AsyncResult.legacy_propagate_error] to do old-style
-//     [class@Gio.SimpleAsyncResult] error-returning behavior, and
-//     [method@Gio.AsyncResult.is_tagged] to check if a result is tagged as
+//     [AsyncResult.LegacyPropagateError] to do old-style
+//     [SimpleAsyncResult] error-returning behavior, and
+//     [AsyncResult.IsTagged] to check if a result is tagged as
 //     having come from the `_async()` wrapper
 //     function (for ‘short-circuit’ results, such as when passing
-//     `0` to [method@Gio.InputStream.read_async]).
+//     `0` to [InputStream.ReadAsync]).
 //
-// ## Thread-safety considerations
+// # Thread-safety considerations
 //
 // Due to some infelicities in the API design, there is a
 // thread-safety concern that users of `GTask` have to be aware of:
@@ -614,22 +572,22 @@ func TaskNewFromInternalPtr(ptr uintptr) *Task {
 
 var xNewTask func(uintptr, uintptr, uintptr, uintptr) uintptr
 
-// Creates a #GTask acting on @source_object, which will eventually be
-// used to invoke @callback in the current thread-default main context
-// (see [method@GLib.MainContext.push_thread_default]).
+// Creates a [Task] acting on source_object, which will eventually be
+// used to invoke callback in the current thread-default main context
+// (see [glib.MainContext.PushThreadDefault]).
 //
 // Call this in the "start" method of your asynchronous method, and
-// pass the #GTask around throughout the asynchronous operation. You
-// can use g_task_set_task_data() to attach task-specific data to the
-// object, which you can retrieve later via g_task_get_task_data().
+// pass the [Task] around throughout the asynchronous operation. You
+// can use [Task.SetTaskData] to attach task-specific data to the
+// object, which you can retrieve later via [Task.GetTaskData].
 //
-// By default, if @cancellable is cancelled, then the return value of
-// the task will always be %G_IO_ERROR_CANCELLED, even if the task had
+// By default, if cancellable is cancelled, then the return value of
+// the task will always be [GIoErrorCancelledValue], even if the task had
 // already completed before the cancellation. This allows for
 // simplified handling in cases where cancellation may imply that
 // other objects that the task depends on have been destroyed. If you
 // do not want this behavior, you can use
-// g_task_set_check_cancellable() to change it.
+// [Task.SetCheckCancellable] to change it.
 func NewTask(SourceObjectVar *gobject.Object, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, CallbackDataVar uintptr) *Task {
 	var cls *Task
 
@@ -640,11 +598,12 @@ func NewTask(SourceObjectVar *gobject.Object, CancellableVar *Cancellable, Callb
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -661,16 +620,16 @@ func NewTask(SourceObjectVar *gobject.Object, CancellableVar *Cancellable, Callb
 var xTaskAttachSource func(uintptr, *glib.Source, uintptr)
 
 // A utility function for dealing with async operations where you need
-// to wait for a #GSource to trigger. Attaches @source to @task's
-// #GMainContext with @task's [priority](iface.AsyncResult.html#io-priority),
-// and sets @source's callback to @callback, with @task as the callback's
+// to wait for a [glib.Source] to trigger. Attaches source to task's
+// [glib.MainContext] with task's priority,
+// and sets source's callback to callback, with task as the callback's
 // `user_data`.
 //
-// It will set the @source’s name to the task’s name (as set with
-// g_task_set_name()), if one has been set on the task and the source doesn’t
+// It will set the source’s name to the task’s name (as set with
+// [Task.SetName]), if one has been set on the task and the source doesn’t
 // yet have a name.
 //
-// This takes a reference on @task until @source is destroyed.
+// This takes a reference on task until source is destroyed.
 func (x *Task) AttachSource(SourceVar *glib.Source, CallbackVar *glib.SourceFunc) {
 
 	var CallbackVarRef uintptr
@@ -680,11 +639,12 @@ func (x *Task) AttachSource(SourceVar *glib.Source, CallbackVar *glib.SourceFunc
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				return cbFn(arg0)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -694,7 +654,7 @@ func (x *Task) AttachSource(SourceVar *glib.Source, CallbackVar *glib.SourceFunc
 
 var xTaskGetCancellable func(uintptr) uintptr
 
-// Gets @task's #GCancellable
+// Gets task's [Cancellable]
 func (x *Task) GetCancellable() *Cancellable {
 	var cls *Cancellable
 
@@ -711,8 +671,8 @@ func (x *Task) GetCancellable() *Cancellable {
 
 var xTaskGetCheckCancellable func(uintptr) bool
 
-// Gets @task's check-cancellable flag. See
-// g_task_set_check_cancellable() for more details.
+// Gets task's check-cancellable flag. See
+// [Task.SetCheckCancellable] for more details.
 func (x *Task) GetCheckCancellable() bool {
 
 	cret := xTaskGetCheckCancellable(x.GoPointer())
@@ -721,8 +681,8 @@ func (x *Task) GetCheckCancellable() bool {
 
 var xTaskGetCompleted func(uintptr) bool
 
-// Gets the value of #GTask:completed. This changes from %FALSE to %TRUE after
-// the task’s callback is invoked, and will return %FALSE if called from inside
+// Gets the value of [Task.GetPropertyCompleted]. This changes from false to true after
+// the task’s callback is invoked, and will return false if called from inside
 // the callback.
 func (x *Task) GetCompleted() bool {
 
@@ -732,13 +692,13 @@ func (x *Task) GetCompleted() bool {
 
 var xTaskGetContext func(uintptr) *glib.MainContext
 
-// Gets the #GMainContext that @task will return its result in (that
+// Gets the [glib.MainContext] that task will return its result in (that
 // is, the context that was the thread-default main context
-// (see [method@GLib.MainContext.push_thread_default])
-// at the point when @task was created).
+// (see [glib.MainContext.PushThreadDefault])
+// at the point when task was created).
 //
-// This will always return a non-%NULL value, even if the task's
-// context is the default #GMainContext.
+// This will always return a non-nil value, even if the task's
+// context is the default [glib.MainContext].
 func (x *Task) GetContext() *glib.MainContext {
 
 	cret := xTaskGetContext(x.GoPointer())
@@ -747,7 +707,7 @@ func (x *Task) GetContext() *glib.MainContext {
 
 var xTaskGetName func(uintptr) string
 
-// Gets @task’s name. See g_task_set_name().
+// Gets task’s name. See [Task.SetName].
 func (x *Task) GetName() string {
 
 	cret := xTaskGetName(x.GoPointer())
@@ -756,7 +716,7 @@ func (x *Task) GetName() string {
 
 var xTaskGetPriority func(uintptr) int
 
-// Gets @task's priority
+// Gets task's priority
 func (x *Task) GetPriority() int {
 
 	cret := xTaskGetPriority(x.GoPointer())
@@ -765,8 +725,8 @@ func (x *Task) GetPriority() int {
 
 var xTaskGetReturnOnCancel func(uintptr) bool
 
-// Gets @task's return-on-cancel flag. See
-// g_task_set_return_on_cancel() for more details.
+// Gets task's return-on-cancel flag. See
+// [Task.SetReturnOnCancel] for more details.
 func (x *Task) GetReturnOnCancel() bool {
 
 	cret := xTaskGetReturnOnCancel(x.GoPointer())
@@ -775,8 +735,8 @@ func (x *Task) GetReturnOnCancel() bool {
 
 var xTaskGetSourceObject func(uintptr) uintptr
 
-// Gets the source object from @task. Like
-// g_async_result_get_source_object(), but does not ref the object.
+// Gets the source object from task. Like
+// [AsyncResult.GetSourceObject], but does not ref the object.
 func (x *Task) GetSourceObject() *gobject.Object {
 	var cls *gobject.Object
 
@@ -793,7 +753,7 @@ func (x *Task) GetSourceObject() *gobject.Object {
 
 var xTaskGetSourceTag func(uintptr) uintptr
 
-// Gets @task's source tag. See g_task_set_source_tag().
+// Gets task's source tag. See [Task.SetSourceTag].
 func (x *Task) GetSourceTag() uintptr {
 
 	cret := xTaskGetSourceTag(x.GoPointer())
@@ -802,7 +762,7 @@ func (x *Task) GetSourceTag() uintptr {
 
 var xTaskGetTaskData func(uintptr) uintptr
 
-// Gets @task's `task_data`.
+// Gets task's `task_data`.
 func (x *Task) GetTaskData() uintptr {
 
 	cret := xTaskGetTaskData(x.GoPointer())
@@ -811,7 +771,7 @@ func (x *Task) GetTaskData() uintptr {
 
 var xTaskHadError func(uintptr) bool
 
-// Tests if @task resulted in an error.
+// Tests if task resulted in an error.
 func (x *Task) HadError() bool {
 
 	cret := xTaskHadError(x.GoPointer())
@@ -820,10 +780,10 @@ func (x *Task) HadError() bool {
 
 var xTaskPropagateBoolean func(uintptr) bool
 
-// Gets the result of @task as a #gboolean.
+// Gets the result of task as a #gboolean.
 //
 // If the task resulted in an error, or was cancelled, then this will
-// instead return %FALSE and set @error.
+// instead return false and set error.
 //
 // Since this method transfers ownership of the return value (or
 // error) to the caller, you may only call it once.
@@ -832,7 +792,7 @@ func (x *Task) PropagateBoolean() (bool, error) {
 
 	cret := xTaskPropagateBoolean(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -840,10 +800,10 @@ func (x *Task) PropagateBoolean() (bool, error) {
 
 var xTaskPropagateInt func(uintptr) int
 
-// Gets the result of @task as an integer (#gssize).
+// Gets the result of task as an integer (#gssize).
 //
 // If the task resulted in an error, or was cancelled, then this will
-// instead return -1 and set @error.
+// instead return -1 and set error.
 //
 // Since this method transfers ownership of the return value (or
 // error) to the caller, you may only call it once.
@@ -852,7 +812,7 @@ func (x *Task) PropagateInt() (int, error) {
 
 	cret := xTaskPropagateInt(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -860,11 +820,11 @@ func (x *Task) PropagateInt() (int, error) {
 
 var xTaskPropagatePointer func(uintptr) uintptr
 
-// Gets the result of @task as a pointer, and transfers ownership
+// Gets the result of task as a pointer, and transfers ownership
 // of that value to the caller.
 //
 // If the task resulted in an error, or was cancelled, then this will
-// instead return %NULL and set @error.
+// instead return nil and set error.
 //
 // Since this method transfers ownership of the return value (or
 // error) to the caller, you may only call it once.
@@ -873,7 +833,7 @@ func (x *Task) PropagatePointer() (uintptr, error) {
 
 	cret := xTaskPropagatePointer(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -881,13 +841,13 @@ func (x *Task) PropagatePointer() (uintptr, error) {
 
 var xTaskPropagateValue func(uintptr, *gobject.Value, **glib.Error) bool
 
-// Gets the result of @task as a #GValue, and transfers ownership of
-// that value to the caller. As with g_task_return_value(), this is
-// a generic low-level method; g_task_propagate_pointer() and the like
+// Gets the result of task as a [gobject.Value], and transfers ownership of
+// that value to the caller. As with [Task.ReturnValue], this is
+// a generic low-level method; [Task.PropagatePointer] and the like
 // will usually be more useful for C code.
 //
 // If the task resulted in an error, or was cancelled, then this will
-// instead set @error and return %FALSE.
+// instead set error and return false.
 //
 // Since this method transfers ownership of the return value (or
 // error) to the caller, you may only call it once.
@@ -896,7 +856,7 @@ func (x *Task) PropagateValue(ValueVar *gobject.Value) (bool, error) {
 
 	cret := xTaskPropagateValue(x.GoPointer(), ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -904,8 +864,8 @@ func (x *Task) PropagateValue(ValueVar *gobject.Value) (bool, error) {
 
 var xTaskReturnBoolean func(uintptr, bool)
 
-// Sets @task's result to @result and completes the task (see
-// g_task_return_pointer() for more discussion of exactly what this
+// Sets task's result to result and completes the task (see
+// [Task.ReturnPointer] for more discussion of exactly what this
 // means).
 func (x *Task) ReturnBoolean(ResultVar bool) {
 
@@ -913,31 +873,31 @@ func (x *Task) ReturnBoolean(ResultVar bool) {
 
 }
 
-var xTaskReturnError func(uintptr, *glib.Error)
+var xTaskReturnError func(uintptr, uintptr)
 
-// Sets @task's result to @error (which @task assumes ownership of)
-// and completes the task (see g_task_return_pointer() for more
+// Sets task's result to error (which task assumes ownership of)
+// and completes the task (see [Task.ReturnPointer] for more
 // discussion of exactly what this means).
 //
-// Note that since the task takes ownership of @error, and since the
-// task may be completed before returning from g_task_return_error(),
-// you cannot assume that @error is still valid after calling this.
-// Call g_error_copy() on the error if you need to keep a local copy
+// Note that since the task takes ownership of error, and since the
+// task may be completed before returning from [Task.ReturnError],
+// you cannot assume that error is still valid after calling this.
+// Call [glib.Error.Copy] on the error if you need to keep a local copy
 // as well.
 //
-// See also [method@Gio.Task.return_new_error],
-// [method@Gio.Task.return_new_error_literal].
+// See also [Task.ReturnNewError],
+// [Task.ReturnNewErrorLiteral].
 func (x *Task) ReturnError(ErrorVar *glib.Error) {
 
-	xTaskReturnError(x.GoPointer(), ErrorVar)
+	xTaskReturnError(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
 var xTaskReturnErrorIfCancelled func(uintptr) bool
 
-// Checks if @task's #GCancellable has been cancelled, and if so, sets
-// @task's error accordingly and completes the task (see
-// g_task_return_pointer() for more discussion of exactly what this
+// Checks if task's [Cancellable] has been cancelled, and if so, sets
+// task's error accordingly and completes the task (see
+// [Task.ReturnPointer] for more discussion of exactly what this
 // means).
 func (x *Task) ReturnErrorIfCancelled() bool {
 
@@ -947,8 +907,8 @@ func (x *Task) ReturnErrorIfCancelled() bool {
 
 var xTaskReturnInt func(uintptr, int)
 
-// Sets @task's result to @result and completes the task (see
-// g_task_return_pointer() for more discussion of exactly what this
+// Sets task's result to result and completes the task (see
+// [Task.ReturnPointer] for more discussion of exactly what this
 // means).
 func (x *Task) ReturnInt(ResultVar int) {
 
@@ -958,12 +918,12 @@ func (x *Task) ReturnInt(ResultVar int) {
 
 var xTaskReturnNewError func(uintptr, glib.Quark, int, string, ...interface{})
 
-// Sets @task's result to a new #GError created from @domain, @code,
-// @format, and the remaining arguments, and completes the task (see
-// g_task_return_pointer() for more discussion of exactly what this
+// Sets task's result to a new [glib.Error] created from domain, code,
+// format, and the remaining arguments, and completes the task (see
+// [Task.ReturnPointer] for more discussion of exactly what this
 // means).
 //
-// See also g_task_return_error().
+// See also [Task.ReturnError].
 func (x *Task) ReturnNewError(DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
 
 	xTaskReturnNewError(x.GoPointer(), DomainVar, CodeVar, FormatVar, varArgs...)
@@ -972,13 +932,13 @@ func (x *Task) ReturnNewError(DomainVar glib.Quark, CodeVar int, FormatVar strin
 
 var xTaskReturnNewErrorLiteral func(uintptr, glib.Quark, int, string)
 
-// Sets @task’s result to a new [type@GLib.Error] created from @domain, @code,
-// @message and completes the task.
+// Sets task’s result to a new [glib.Error] created from domain, code,
+// message and completes the task.
 //
-// See [method@Gio.Task.return_pointer] for more discussion of exactly what
+// See [Task.ReturnPointer] for more discussion of exactly what
 // ‘completing the task’ means.
 //
-// See also [method@Gio.Task.return_new_error].
+// See also [Task.ReturnNewError].
 func (x *Task) ReturnNewErrorLiteral(DomainVar glib.Quark, CodeVar int, MessageVar string) {
 
 	xTaskReturnNewErrorLiteral(x.GoPointer(), DomainVar, CodeVar, MessageVar)
@@ -987,22 +947,22 @@ func (x *Task) ReturnNewErrorLiteral(DomainVar glib.Quark, CodeVar int, MessageV
 
 var xTaskReturnPointer func(uintptr, uintptr, uintptr)
 
-// Sets @task's result to @result and completes the task. If @result
-// is not %NULL, then @result_destroy will be used to free @result if
+// Sets task's result to result and completes the task. If result
+// is not nil, then result_destroy will be used to free result if
 // the caller does not take ownership of it with
-// g_task_propagate_pointer().
+// [Task.PropagatePointer].
 //
 // "Completes the task" means that for an ordinary asynchronous task
 // it will either invoke the task's callback, or else queue that
-// callback to be invoked in the proper #GMainContext, or in the next
-// iteration of the current #GMainContext. For a task run via
-// g_task_run_in_thread() or g_task_run_in_thread_sync(), calling this
-// method will save @result to be returned to the caller later, but
-// the task will not actually be completed until the #GTaskThreadFunc
+// callback to be invoked in the proper [glib.MainContext], or in the next
+// iteration of the current [glib.MainContext]. For a task run via
+// [Task.RunInThread] or [Task.RunInThreadSync], calling this
+// method will save result to be returned to the caller later, but
+// the task will not actually be completed until the [TaskThreadFunc]
 // exits.
 //
 // Note that since the task may be completed before returning from
-// g_task_return_pointer(), you cannot assume that @result is still
+// [Task.ReturnPointer], you cannot assume that result is still
 // valid after calling this, unless you are still holding another
 // reference on it.
 func (x *Task) ReturnPointer(ResultVar uintptr, ResultDestroyVar *glib.DestroyNotify) {
@@ -1014,11 +974,12 @@ func (x *Task) ReturnPointer(ResultVar uintptr, ResultDestroyVar *glib.DestroyNo
 			ResultDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ResultDestroyVar
 				cbFn(arg0)
 			}
-			ResultDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ResultDestroyVarPtr, ResultDestroyVarRef, ResultDestroyVar)
+			ResultDestroyVarRef = core.NewCallback(fcb)
+			ResultDestroyVarRef = glib.SaveCallbackWithClosure(ResultDestroyVarPtr, ResultDestroyVarRef, ResultDestroyVar)
 		}
 	}
 
@@ -1026,35 +987,35 @@ func (x *Task) ReturnPointer(ResultVar uintptr, ResultDestroyVar *glib.DestroyNo
 
 }
 
-var xTaskReturnPrefixedError func(uintptr, *glib.Error, string, ...interface{})
+var xTaskReturnPrefixedError func(uintptr, uintptr, string, ...interface{})
 
-// Sets @task's result to @error (which @task assumes ownership of), with
-// the message prefixed according to @format, and completes the task
-// (see g_task_return_pointer() for more discussion of exactly what this
+// Sets task's result to error (which task assumes ownership of), with
+// the message prefixed according to format, and completes the task
+// (see [Task.ReturnPointer] for more discussion of exactly what this
 // means).
 //
-// Note that since the task takes ownership of @error, and since the
-// task may be completed before returning from g_task_return_prefixed_error(),
-// you cannot assume that @error is still valid after calling this.
-// Call g_error_copy() on the error if you need to keep a local copy
+// Note that since the task takes ownership of error, and since the
+// task may be completed before returning from [Task.ReturnPrefixedError],
+// you cannot assume that error is still valid after calling this.
+// Call [glib.Error.Copy] on the error if you need to keep a local copy
 // as well.
 //
-// See also g_task_return_error(), g_prefix_error().
+// See also [Task.ReturnError], [glib.PrefixError].
 func (x *Task) ReturnPrefixedError(ErrorVar *glib.Error, FormatVar string, varArgs ...interface{}) {
 
-	xTaskReturnPrefixedError(x.GoPointer(), ErrorVar, FormatVar, varArgs...)
+	xTaskReturnPrefixedError(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()), FormatVar, varArgs...)
 
 }
 
 var xTaskReturnValue func(uintptr, *gobject.Value)
 
-// Sets @task's result to @result (by copying it) and completes the task.
+// Sets task's result to result (by copying it) and completes the task.
 //
-// If @result is %NULL then a #GValue of type %G_TYPE_POINTER
-// with a value of %NULL will be used for the result.
+// If result is nil then a [gobject.Value] of type G_TYPE_POINTER
+// with a value of nil will be used for the result.
 //
 // This is a very generic low-level method intended primarily for use
-// by language bindings; for C code, g_task_return_pointer() and the
+// by language bindings; for C code, [Task.ReturnPointer] and the
 // like will normally be much easier to use.
 func (x *Task) ReturnValue(ResultVar *gobject.Value) {
 
@@ -1064,15 +1025,15 @@ func (x *Task) ReturnValue(ResultVar *gobject.Value) {
 
 var xTaskRunInThread func(uintptr, uintptr)
 
-// Runs @task_func in another thread. When @task_func returns, @task's
-// #GAsyncReadyCallback will be invoked in @task's #GMainContext.
+// Runs task_func in another thread. When task_func returns, task's
+// [AsyncReadyCallback] will be invoked in task's [glib.MainContext].
 //
-// This takes a ref on @task until the task completes.
+// This takes a ref on task until the task completes.
 //
-// See #GTaskThreadFunc for more details about how @task_func is handled.
+// See [TaskThreadFunc] for more details about how task_func is handled.
 //
 // Although GLib currently rate-limits the tasks queued via
-// g_task_run_in_thread(), you should not assume that it will always
+// [Task.RunInThread], you should not assume that it will always
 // do this. If you have a very large number of tasks to run (several tens of
 // tasks), but don't want them to all run at once, you should only queue a
 // limited number of them (around ten) at a time.
@@ -1082,7 +1043,7 @@ var xTaskRunInThread func(uintptr, uintptr)
 // and enough of them (around 10) execute in a dependency chain, as that will
 // exhaust the thread pool. If this situation is possible, consider using a
 // separate worker thread or thread pool explicitly, rather than using
-// g_task_run_in_thread().
+// [Task.RunInThread].
 func (x *Task) RunInThread(TaskFuncVar *TaskThreadFunc) {
 
 	var TaskFuncVarRef uintptr
@@ -1092,11 +1053,12 @@ func (x *Task) RunInThread(TaskFuncVar *TaskThreadFunc) {
 			TaskFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *TaskFuncVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			TaskFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TaskFuncVarPtr, TaskFuncVarRef, TaskFuncVar)
+			TaskFuncVarRef = core.NewCallback(fcb)
+			TaskFuncVarRef = glib.SaveCallbackWithClosure(TaskFuncVarPtr, TaskFuncVarRef, TaskFuncVar)
 		}
 	}
 
@@ -1106,19 +1068,19 @@ func (x *Task) RunInThread(TaskFuncVar *TaskThreadFunc) {
 
 var xTaskRunInThreadSync func(uintptr, uintptr)
 
-// Runs @task_func in another thread, and waits for it to return or be
-// cancelled. You can use g_task_propagate_pointer(), etc, afterward
-// to get the result of @task_func.
+// Runs task_func in another thread, and waits for it to return or be
+// cancelled. You can use [Task.PropagatePointer], etc, afterward
+// to get the result of task_func.
 //
-// See #GTaskThreadFunc for more details about how @task_func is handled.
+// See [TaskThreadFunc] for more details about how task_func is handled.
 //
-// Normally this is used with tasks created with a %NULL
+// Normally this is used with tasks created with a nil
 // `callback`, but note that even if the task does
-// have a callback, it will not be invoked when @task_func returns.
-// #GTask:completed will be set to %TRUE just before this function returns.
+// have a callback, it will not be invoked when task_func returns.
+// [Task.GetPropertyCompleted] will be set to true just before this function returns.
 //
 // Although GLib currently rate-limits the tasks queued via
-// g_task_run_in_thread_sync(), you should not assume that it will
+// [Task.RunInThreadSync], you should not assume that it will
 // always do this. If you have a very large number of tasks to run,
 // but don't want them to all run at once, you should only queue a
 // limited number of them at a time.
@@ -1131,11 +1093,12 @@ func (x *Task) RunInThreadSync(TaskFuncVar *TaskThreadFunc) {
 			TaskFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *TaskFuncVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			TaskFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TaskFuncVarPtr, TaskFuncVarRef, TaskFuncVar)
+			TaskFuncVarRef = core.NewCallback(fcb)
+			TaskFuncVarRef = glib.SaveCallbackWithClosure(TaskFuncVarPtr, TaskFuncVarRef, TaskFuncVar)
 		}
 	}
 
@@ -1145,20 +1108,20 @@ func (x *Task) RunInThreadSync(TaskFuncVar *TaskThreadFunc) {
 
 var xTaskSetCheckCancellable func(uintptr, bool)
 
-// Sets or clears @task's check-cancellable flag. If this is %TRUE
-// (the default), then g_task_propagate_pointer(), etc, and
-// g_task_had_error() will check the task's #GCancellable first, and
+// Sets or clears task's check-cancellable flag. If this is true
+// (the default), then [Task.PropagatePointer], etc, and
+// [Task.HadError] will check the task's [Cancellable] first, and
 // if it has been cancelled, then they will consider the task to have
 // returned an "Operation was cancelled" error
-// (%G_IO_ERROR_CANCELLED), regardless of any other error or return
+// ([GIoErrorCancelledValue]), regardless of any other error or return
 // value the task may have had.
 //
-// If @check_cancellable is %FALSE, then the #GTask will not check the
-// cancellable itself, and it is up to @task's owner to do this (eg,
-// via g_task_return_error_if_cancelled()).
+// If check_cancellable is false, then the [Task] will not check the
+// cancellable itself, and it is up to task's owner to do this (eg,
+// via [Task.ReturnErrorIfCancelled]).
 //
-// If you are using g_task_set_return_on_cancel() as well, then
-// you must leave check-cancellable set %TRUE.
+// If you are using [Task.SetReturnOnCancel] as well, then
+// you must leave check-cancellable set true.
 func (x *Task) SetCheckCancellable(CheckCancellableVar bool) {
 
 	xTaskSetCheckCancellable(x.GoPointer(), CheckCancellableVar)
@@ -1167,14 +1130,14 @@ func (x *Task) SetCheckCancellable(CheckCancellableVar bool) {
 
 var xTaskSetName func(uintptr, uintptr)
 
-// Sets @task’s name, used in debugging and profiling. The name defaults to
-// %NULL.
+// Sets task’s name, used in debugging and profiling. The name defaults to
+// nil.
 //
 // The task name should describe in a human readable way what the task does.
 // For example, ‘Open file’ or ‘Connect to network host’. It is used to set the
-// name of the #GSource used for idle completion of the task.
+// name of the [glib.Source] used for idle completion of the task.
 //
-// This function may only be called before the @task is first used in a thread
+// This function may only be called before the task is first used in a thread
 // other than the one it was constructed in.
 func (x *Task) SetName(NameVar *string) {
 
@@ -1187,13 +1150,13 @@ func (x *Task) SetName(NameVar *string) {
 
 var xTaskSetPriority func(uintptr, int)
 
-// Sets @task's priority. If you do not call this, it will default to
-// %G_PRIORITY_DEFAULT.
+// Sets task's priority. If you do not call this, it will default to
+// [glib.PRIORITY_DEFAULT].
 //
-// This will affect the priority of #GSources created with
-// g_task_attach_source() and the scheduling of tasks run in threads,
+// This will affect the priority of GSources created with
+// [Task.AttachSource] and the scheduling of tasks run in threads,
 // and can also be explicitly retrieved later via
-// g_task_get_priority().
+// [Task.GetPriority].
 func (x *Task) SetPriority(PriorityVar int) {
 
 	xTaskSetPriority(x.GoPointer(), PriorityVar)
@@ -1202,33 +1165,33 @@ func (x *Task) SetPriority(PriorityVar int) {
 
 var xTaskSetReturnOnCancel func(uintptr, bool) bool
 
-// Sets or clears @task's return-on-cancel flag. This is only
-// meaningful for tasks run via g_task_run_in_thread() or
-// g_task_run_in_thread_sync().
+// Sets or clears task's return-on-cancel flag. This is only
+// meaningful for tasks run via [Task.RunInThread] or
+// [Task.RunInThreadSync].
 //
-// If @return_on_cancel is %TRUE, then cancelling @task's
-// #GCancellable will immediately cause it to return, as though the
-// task's #GTaskThreadFunc had called
-// g_task_return_error_if_cancelled() and then returned.
+// If return_on_cancel is true, then cancelling task's
+// [Cancellable] will immediately cause it to return, as though the
+// task's [TaskThreadFunc] had called
+// [Task.ReturnErrorIfCancelled] and then returned.
 //
 // This allows you to create a cancellable wrapper around an
-// uninterruptible function. The #GTaskThreadFunc just needs to be
+// uninterruptible function. The [TaskThreadFunc] just needs to be
 // careful that it does not modify any externally-visible state after
 // it has been cancelled. To do that, the thread should call
-// g_task_set_return_on_cancel() again to (atomically) set
-// return-on-cancel %FALSE before making externally-visible changes;
+// [Task.SetReturnOnCancel] again to (atomically) set
+// return-on-cancel false before making externally-visible changes;
 // if the task gets cancelled before the return-on-cancel flag could
-// be changed, g_task_set_return_on_cancel() will indicate this by
-// returning %FALSE.
+// be changed, [Task.SetReturnOnCancel] will indicate this by
+// returning false.
 //
 // You can disable and re-enable this flag multiple times if you wish.
-// If the task's #GCancellable is cancelled while return-on-cancel is
-// %FALSE, then calling g_task_set_return_on_cancel() to set it %TRUE
+// If the task's [Cancellable] is cancelled while return-on-cancel is
+// false, then calling [Task.SetReturnOnCancel] to set it true
 // again will cause the task to be cancelled at that point.
 //
-// If the task's #GCancellable is already cancelled before you call
-// g_task_run_in_thread()/g_task_run_in_thread_sync(), then the
-// #GTaskThreadFunc will still be run (for consistency), but the task
+// If the task's [Cancellable] is already cancelled before you call
+// [Task.RunInThread]/[Task.RunInThreadSync], then the
+// [TaskThreadFunc] will still be run (for consistency), but the task
 // will also be completed right away.
 func (x *Task) SetReturnOnCancel(ReturnOnCancelVar bool) bool {
 
@@ -1238,17 +1201,17 @@ func (x *Task) SetReturnOnCancel(ReturnOnCancelVar bool) bool {
 
 var xTaskSetSourceTag func(uintptr, uintptr)
 
-// Sets @task's source tag.
+// Sets task's source tag.
 //
 // You can use this to tag a task return
 // value with a particular pointer (usually a pointer to the function
 // doing the tagging) and then later check it using
-// g_task_get_source_tag() (or g_async_result_is_tagged()) in the
+// [Task.GetSourceTag] (or [AsyncResult.IsTagged]) in the
 // task's "finish" function, to figure out if the response came from a
 // particular place.
 //
 // A macro wrapper around this function will automatically set the
-// task’s name to the string form of @source_tag if it’s not already
+// task’s name to the string form of source_tag if it’s not already
 // set, for convenience.
 func (x *Task) SetSourceTag(SourceTagVar uintptr) {
 
@@ -1258,11 +1221,11 @@ func (x *Task) SetSourceTag(SourceTagVar uintptr) {
 
 var xTaskSetStaticName func(uintptr, uintptr)
 
-// Sets @task’s name, used in debugging and profiling.
+// Sets task’s name, used in debugging and profiling.
 //
-// This is a variant of g_task_set_name() that avoids copying @name.
+// This is a variant of [Task.SetName] that avoids copying name.
 //
-// This function is called automatically by [method@Gio.Task.set_source_tag]
+// This function is called automatically by [Task.SetSourceTag]
 // unless a name is set.
 func (x *Task) SetStaticName(NameVar *string) {
 
@@ -1275,7 +1238,7 @@ func (x *Task) SetStaticName(NameVar *string) {
 
 var xTaskSetTaskData func(uintptr, uintptr, uintptr)
 
-// Sets @task's task data (freeing the existing task data, if any).
+// Sets task's task data (freeing the existing task data, if any).
 func (x *Task) SetTaskData(TaskDataVar uintptr, TaskDataDestroyVar *glib.DestroyNotify) {
 
 	var TaskDataDestroyVarRef uintptr
@@ -1285,11 +1248,12 @@ func (x *Task) SetTaskData(TaskDataVar uintptr, TaskDataDestroyVar *glib.Destroy
 			TaskDataDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *TaskDataDestroyVar
 				cbFn(arg0)
 			}
-			TaskDataDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TaskDataDestroyVarPtr, TaskDataDestroyVarRef, TaskDataDestroyVar)
+			TaskDataDestroyVarRef = core.NewCallback(fcb)
+			TaskDataDestroyVarRef = glib.SaveCallbackWithClosure(TaskDataDestroyVarPtr, TaskDataDestroyVarRef, TaskDataDestroyVar)
 		}
 	}
 
@@ -1312,20 +1276,20 @@ func (c *Task) SetGoPointer(ptr uintptr) {
 // Whether the task has completed, meaning its callback (if set) has been
 // invoked.
 //
-// This can only happen after g_task_return_pointer(),
-// g_task_return_error() or one of the other return functions have been called
+// This can only happen after [Task.ReturnPointer],
+// [Task.ReturnError] or one of the other return functions have been called
 // on the task. However, it is not guaranteed to happen immediately after
 // those functions are called, as the task’s callback may need to be scheduled
 // to run in a different thread.
 //
 // That means it is **not safe** to use this property to track whether a
-// return function has been called on the #GTask. Callers must do that
-// tracking themselves, typically by linking the lifetime of the #GTask to the
+// return function has been called on the [Task]. Callers must do that
+// tracking themselves, typically by linking the lifetime of the [Task] to the
 // control flow of their code.
 //
-// This property is guaranteed to change from %FALSE to %TRUE exactly once.
+// This property is guaranteed to change from false to true exactly once.
 //
-// The #GObject::notify signal for this change is emitted in the same main
+// The [gobject.Object.ConnectNotifyFunc] signal for this change is emitted in the same main
 // context as the task’s callback, immediately after that callback is invoked.
 func (x *Task) GetPropertyCompleted() bool {
 	var v gobject.Value
@@ -1333,29 +1297,29 @@ func (x *Task) GetPropertyCompleted() bool {
 	return v.GetBoolean()
 }
 
-// Gets the user data from a [iface@Gio.AsyncResult].
+// Gets the user data from a [AsyncResult].
 func (x *Task) GetUserData() uintptr {
 
 	cret := XGAsyncResultGetUserData(x.GoPointer())
 	return cret
 }
 
-// Checks if @res has the given @source_tag (generally a function
-// pointer indicating the function @res was created by).
+// Checks if res has the given source_tag (generally a function
+// pointer indicating the function res was created by).
 func (x *Task) IsTagged(SourceTagVar uintptr) bool {
 
 	cret := XGAsyncResultIsTagged(x.GoPointer(), SourceTagVar)
 	return cret
 }
 
-// If @res is a [class@Gio.SimpleAsyncResult], this is equivalent to
-// [method@Gio.SimpleAsyncResult.propagate_error]. Otherwise it returns
+// If res is a [SimpleAsyncResult], this is equivalent to
+// [SimpleAsyncResult.PropagateError]. Otherwise it returns
 // `FALSE`.
 //
 // This can be used for legacy error handling in async `*_finish()`
-// wrapper functions that traditionally handled [class@Gio.SimpleAsyncResult]
+// wrapper functions that traditionally handled [SimpleAsyncResult]
 // error returns themselves rather than calling into the virtual method.
-// This should not be used in new code; [iface@Gio.AsyncResult] errors that are
+// This should not be used in new code; [AsyncResult] errors that are
 // set by virtual methods should also be extracted by virtual methods,
 // to enable subclasses to chain up correctly.
 func (x *Task) LegacyPropagateError() (bool, error) {
@@ -1363,7 +1327,7 @@ func (x *Task) LegacyPropagateError() (bool, error) {
 
 	cret := XGAsyncResultLegacyPropagateError(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1371,8 +1335,8 @@ func (x *Task) LegacyPropagateError() (bool, error) {
 
 var xTaskIsValid func(uintptr, uintptr) bool
 
-// Checks that @result is a #GTask, and that @source_object is its
-// source object (or that @source_object is %NULL and @result has no
+// Checks that result is a [Task], and that source_object is its
+// source object (or that source_object is nil and result has no
 // source object). This can be used in g_return_if_fail() checks.
 func TaskIsValid(ResultVar AsyncResult, SourceObjectVar *gobject.Object) bool {
 
@@ -1380,16 +1344,16 @@ func TaskIsValid(ResultVar AsyncResult, SourceObjectVar *gobject.Object) bool {
 	return cret
 }
 
-var xTaskReportError func(uintptr, uintptr, uintptr, uintptr, *glib.Error)
+var xTaskReportError func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Creates a #GTask and then immediately calls g_task_return_error()
+// Creates a [Task] and then immediately calls [Task.ReturnError]
 // on it. Use this in the wrapper function of an asynchronous method
 // when you want to avoid even calling the virtual method. You can
-// then use g_async_result_is_tagged() in the finish method wrapper to
+// then use [AsyncResult.IsTagged] in the finish method wrapper to
 // check if the result there is tagged as having been created by the
 // wrapper method, and deal with it appropriately if so.
 //
-// See also g_task_report_new_error().
+// See also [TaskReportNewError].
 func TaskReportError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, CallbackDataVar uintptr, SourceTagVar uintptr, ErrorVar *glib.Error) {
 
 	var CallbackVarRef uintptr
@@ -1399,29 +1363,30 @@ func TaskReportError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCal
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
-	xTaskReportError(SourceObjectVar.GoPointer(), CallbackVarRef, CallbackDataVar, SourceTagVar, ErrorVar)
+	xTaskReportError(SourceObjectVar.GoPointer(), CallbackVarRef, CallbackDataVar, SourceTagVar, gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
 var xTaskReportNewError func(uintptr, uintptr, uintptr, uintptr, glib.Quark, int, string, ...interface{})
 
-// Creates a #GTask and then immediately calls
-// g_task_return_new_error() on it. Use this in the wrapper function
+// Creates a [Task] and then immediately calls
+// [Task.ReturnNewError] on it. Use this in the wrapper function
 // of an asynchronous method when you want to avoid even calling the
-// virtual method. You can then use g_async_result_is_tagged() in the
+// virtual method. You can then use [AsyncResult.IsTagged] in the
 // finish method wrapper to check if the result there is tagged as
 // having been created by the wrapper method, and deal with it
 // appropriately if so.
 //
-// See also g_task_report_error().
+// See also [TaskReportError].
 func TaskReportNewError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReadyCallback, CallbackDataVar uintptr, SourceTagVar uintptr, DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
 
 	var CallbackVarRef uintptr
@@ -1431,11 +1396,12 @@ func TaskReportNewError(SourceObjectVar *gobject.Object, CallbackVar *AsyncReady
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1448,7 +1414,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1457,45 +1423,45 @@ func init() {
 
 	core.PuregoSafeRegister(&xTaskGLibType, libs, "g_task_get_type")
 
-	core.PuregoSafeRegister(&xNewTask, libs, "g_task_new")
-
-	core.PuregoSafeRegister(&xTaskAttachSource, libs, "g_task_attach_source")
-	core.PuregoSafeRegister(&xTaskGetCancellable, libs, "g_task_get_cancellable")
-	core.PuregoSafeRegister(&xTaskGetCheckCancellable, libs, "g_task_get_check_cancellable")
-	core.PuregoSafeRegister(&xTaskGetCompleted, libs, "g_task_get_completed")
-	core.PuregoSafeRegister(&xTaskGetContext, libs, "g_task_get_context")
-	core.PuregoSafeRegister(&xTaskGetName, libs, "g_task_get_name")
-	core.PuregoSafeRegister(&xTaskGetPriority, libs, "g_task_get_priority")
-	core.PuregoSafeRegister(&xTaskGetReturnOnCancel, libs, "g_task_get_return_on_cancel")
-	core.PuregoSafeRegister(&xTaskGetSourceObject, libs, "g_task_get_source_object")
-	core.PuregoSafeRegister(&xTaskGetSourceTag, libs, "g_task_get_source_tag")
-	core.PuregoSafeRegister(&xTaskGetTaskData, libs, "g_task_get_task_data")
-	core.PuregoSafeRegister(&xTaskHadError, libs, "g_task_had_error")
-	core.PuregoSafeRegister(&xTaskPropagateBoolean, libs, "g_task_propagate_boolean")
-	core.PuregoSafeRegister(&xTaskPropagateInt, libs, "g_task_propagate_int")
-	core.PuregoSafeRegister(&xTaskPropagatePointer, libs, "g_task_propagate_pointer")
-	core.PuregoSafeRegister(&xTaskPropagateValue, libs, "g_task_propagate_value")
-	core.PuregoSafeRegister(&xTaskReturnBoolean, libs, "g_task_return_boolean")
-	core.PuregoSafeRegister(&xTaskReturnError, libs, "g_task_return_error")
-	core.PuregoSafeRegister(&xTaskReturnErrorIfCancelled, libs, "g_task_return_error_if_cancelled")
-	core.PuregoSafeRegister(&xTaskReturnInt, libs, "g_task_return_int")
-	core.PuregoSafeRegister(&xTaskReturnNewError, libs, "g_task_return_new_error")
-	core.PuregoSafeRegister(&xTaskReturnNewErrorLiteral, libs, "g_task_return_new_error_literal")
-	core.PuregoSafeRegister(&xTaskReturnPointer, libs, "g_task_return_pointer")
-	core.PuregoSafeRegister(&xTaskReturnPrefixedError, libs, "g_task_return_prefixed_error")
-	core.PuregoSafeRegister(&xTaskReturnValue, libs, "g_task_return_value")
-	core.PuregoSafeRegister(&xTaskRunInThread, libs, "g_task_run_in_thread")
-	core.PuregoSafeRegister(&xTaskRunInThreadSync, libs, "g_task_run_in_thread_sync")
-	core.PuregoSafeRegister(&xTaskSetCheckCancellable, libs, "g_task_set_check_cancellable")
-	core.PuregoSafeRegister(&xTaskSetName, libs, "g_task_set_name")
-	core.PuregoSafeRegister(&xTaskSetPriority, libs, "g_task_set_priority")
-	core.PuregoSafeRegister(&xTaskSetReturnOnCancel, libs, "g_task_set_return_on_cancel")
-	core.PuregoSafeRegister(&xTaskSetSourceTag, libs, "g_task_set_source_tag")
-	core.PuregoSafeRegister(&xTaskSetStaticName, libs, "g_task_set_static_name")
-	core.PuregoSafeRegister(&xTaskSetTaskData, libs, "g_task_set_task_data")
-
-	core.PuregoSafeRegister(&xTaskIsValid, libs, "g_task_is_valid")
-	core.PuregoSafeRegister(&xTaskReportError, libs, "g_task_report_error")
-	core.PuregoSafeRegister(&xTaskReportNewError, libs, "g_task_report_new_error")
+	core.PuregoRegisterSince(&xNewTask, libs, "g_task_new", "GIO", "2.36")
+
+	core.PuregoRegisterSince(&xTaskAttachSource, libs, "g_task_attach_source", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetCancellable, libs, "g_task_get_cancellable", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetCheckCancellable, libs, "g_task_get_check_cancellable", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetCompleted, libs, "g_task_get_completed", "GIO", "2.44")
+	core.PuregoRegisterSince(&xTaskGetContext, libs, "g_task_get_context", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetName, libs, "g_task_get_name", "GIO", "2.60")
+	core.PuregoRegisterSince(&xTaskGetPriority, libs, "g_task_get_priority", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetReturnOnCancel, libs, "g_task_get_return_on_cancel", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetSourceObject, libs, "g_task_get_source_object", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetSourceTag, libs, "g_task_get_source_tag", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskGetTaskData, libs, "g_task_get_task_data", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskHadError, libs, "g_task_had_error", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskPropagateBoolean, libs, "g_task_propagate_boolean", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskPropagateInt, libs, "g_task_propagate_int", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskPropagatePointer, libs, "g_task_propagate_pointer", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskPropagateValue, libs, "g_task_propagate_value", "GIO", "2.64")
+	core.PuregoRegisterSince(&xTaskReturnBoolean, libs, "g_task_return_boolean", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnError, libs, "g_task_return_error", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnErrorIfCancelled, libs, "g_task_return_error_if_cancelled", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnInt, libs, "g_task_return_int", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnNewError, libs, "g_task_return_new_error", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnNewErrorLiteral, libs, "g_task_return_new_error_literal", "GIO", "2.80")
+	core.PuregoRegisterSince(&xTaskReturnPointer, libs, "g_task_return_pointer", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReturnPrefixedError, libs, "g_task_return_prefixed_error", "GIO", "2.80")
+	core.PuregoRegisterSince(&xTaskReturnValue, libs, "g_task_return_value", "GIO", "2.64")
+	core.PuregoRegisterSince(&xTaskRunInThread, libs, "g_task_run_in_thread", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskRunInThreadSync, libs, "g_task_run_in_thread_sync", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskSetCheckCancellable, libs, "g_task_set_check_cancellable", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskSetName, libs, "g_task_set_name", "GIO", "2.60")
+	core.PuregoRegisterSince(&xTaskSetPriority, libs, "g_task_set_priority", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskSetReturnOnCancel, libs, "g_task_set_return_on_cancel", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskSetSourceTag, libs, "g_task_set_source_tag", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskSetStaticName, libs, "g_task_set_static_name", "GIO", "2.76")
+	core.PuregoRegisterSince(&xTaskSetTaskData, libs, "g_task_set_task_data", "GIO", "2.36")
+
+	core.PuregoRegisterSince(&xTaskIsValid, libs, "g_task_is_valid", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReportError, libs, "g_task_report_error", "GIO", "2.36")
+	core.PuregoRegisterSince(&xTaskReportNewError, libs, "g_task_report_new_error", "GIO", "2.36")
 
 }
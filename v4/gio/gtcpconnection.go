@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -29,7 +28,7 @@ func (x *TcpConnectionPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// This is the subclass of [class@Gio.SocketConnection] that is created
+// This is the subclass of [SocketConnection] that is created
 // for TCP/IP sockets.
 type TcpConnection struct {
 	SocketConnection
@@ -50,7 +49,7 @@ func TcpConnectionNewFromInternalPtr(ptr uintptr) *TcpConnection {
 var xTcpConnectionGetGracefulDisconnect func(uintptr) bool
 
 // Checks if graceful disconnects are used. See
-// g_tcp_connection_set_graceful_disconnect().
+// [TcpConnection.SetGracefulDisconnect].
 func (x *TcpConnection) GetGracefulDisconnect() bool {
 
 	cret := xTcpConnectionGetGracefulDisconnect(x.GoPointer())
@@ -86,7 +85,7 @@ func (c *TcpConnection) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyGracefulDisconnect sets the "graceful-disconnect" property.
-// Whether [method@Gio.IOStream.close] does a graceful disconnect.
+// Whether [IOStream.Close] does a graceful disconnect.
 func (x *TcpConnection) SetPropertyGracefulDisconnect(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -95,7 +94,7 @@ func (x *TcpConnection) SetPropertyGracefulDisconnect(value bool) {
 }
 
 // GetPropertyGracefulDisconnect gets the "graceful-disconnect" property.
-// Whether [method@Gio.IOStream.close] does a graceful disconnect.
+// Whether [IOStream.Close] does a graceful disconnect.
 func (x *TcpConnection) GetPropertyGracefulDisconnect() bool {
 	var v gobject.Value
 	x.GetProperty("graceful-disconnect", &v)
@@ -107,7 +106,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -116,7 +115,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xTcpConnectionGLibType, libs, "g_tcp_connection_get_type")
 
-	core.PuregoSafeRegister(&xTcpConnectionGetGracefulDisconnect, libs, "g_tcp_connection_get_graceful_disconnect")
-	core.PuregoSafeRegister(&xTcpConnectionSetGracefulDisconnect, libs, "g_tcp_connection_set_graceful_disconnect")
+	gobject.CheckClassSize("GTcpConnectionClass", xTcpConnectionGLibType, unsafe.Sizeof(TcpConnectionClass{}))
+
+	core.PuregoRegisterSince(&xTcpConnectionGetGracefulDisconnect, libs, "g_tcp_connection_get_graceful_disconnect", "GIO", "2.22")
+	core.PuregoRegisterSince(&xTcpConnectionSetGracefulDisconnect, libs, "g_tcp_connection_set_graceful_disconnect", "GIO", "2.22")
 
 }
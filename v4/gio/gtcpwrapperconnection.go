@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -29,12 +28,12 @@ func (x *TcpWrapperConnectionPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A `GTcpWrapperConnection` can be used to wrap a [class@Gio.IOStream] that is
-// based on a [class@Gio.Socket], but which is not actually a
-// [class@Gio.SocketConnection]. This is used by [class@Gio.SocketClient] so
-// that it can always return a [class@Gio.SocketConnection], even when the
+// A `GTcpWrapperConnection` can be used to wrap a [IOStream] that is
+// based on a [Socket], but which is not actually a
+// [SocketConnection]. This is used by [SocketClient] so
+// that it can always return a [SocketConnection], even when the
 // connection it has actually created is not directly a
-// [class@Gio.SocketConnection].
+// [SocketConnection].
 type TcpWrapperConnection struct {
 	TcpConnection
 }
@@ -53,7 +52,7 @@ func TcpWrapperConnectionNewFromInternalPtr(ptr uintptr) *TcpWrapperConnection {
 
 var xNewTcpWrapperConnection func(uintptr, uintptr) uintptr
 
-// Wraps @base_io_stream and @socket together as a #GSocketConnection.
+// Wraps base_io_stream and socket together as a [SocketConnection].
 func NewTcpWrapperConnection(BaseIoStreamVar *IOStream, SocketVar *Socket) *TcpWrapperConnection {
 	var cls *TcpWrapperConnection
 
@@ -69,7 +68,7 @@ func NewTcpWrapperConnection(BaseIoStreamVar *IOStream, SocketVar *Socket) *TcpW
 
 var xTcpWrapperConnectionGetBaseIoStream func(uintptr) uintptr
 
-// Gets @conn's base #GIOStream
+// Gets conn's base [IOStream]
 func (x *TcpWrapperConnection) GetBaseIoStream() *IOStream {
 	var cls *IOStream
 
@@ -100,7 +99,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -109,8 +108,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xTcpWrapperConnectionGLibType, libs, "g_tcp_wrapper_connection_get_type")
 
-	core.PuregoSafeRegister(&xNewTcpWrapperConnection, libs, "g_tcp_wrapper_connection_new")
+	gobject.CheckClassSize("GTcpWrapperConnectionClass", xTcpWrapperConnectionGLibType, unsafe.Sizeof(TcpWrapperConnectionClass{}))
 
-	core.PuregoSafeRegister(&xTcpWrapperConnectionGetBaseIoStream, libs, "g_tcp_wrapper_connection_get_base_io_stream")
+	core.PuregoRegisterSince(&xNewTcpWrapperConnection, libs, "g_tcp_wrapper_connection_new", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xTcpWrapperConnectionGetBaseIoStream, libs, "g_tcp_wrapper_connection_get_base_io_stream", "GIO", "2.28")
 
 }
@@ -2,7 +2,6 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -12,12 +11,12 @@ import (
 // session bus.
 //
 // Note that `GTestDBus` modifies the user’s environment, calling
-// [`setenv()`](man:setenv(3)). This is not thread-safe, so all `GTestDBus`
+// setenv()). This is not thread-safe, so all `GTestDBus`
 // calls should be completed before threads are spawned, or should have
 // appropriate locking to ensure no access conflicts to environment variables
 // shared between `GTestDBus` and other threads.
 //
-// ## Creating unit tests using `GTestDBus`
+// # Creating unit tests using `GTestDBus`
 //
 // Testing of D-Bus services can be tricky because normally we only ever run
 // D-Bus services over an existing instance of the D-Bus daemon thus we
@@ -36,32 +35,28 @@ import (
 // achieve this by adding a file such as `my-server.service.in` in the services
 // directory and have it processed by configure.
 //
-// ```
-// [D-BUS Service]
-// Name=org.gtk.GDBus.Examples.ObjectManager
-// Exec=@abs_top_builddir@/gio/tests/gdbus-example-objectmanager-server
-// ```
+//	[D-BUS Service]
+//	Name=org.gtk.GDBus.Examples.ObjectManager
+//	Exec=@abs_top_builddir@/gio/tests/gdbus-example-objectmanager-server
 //
 // You will also need to indicate this service directory in your test
 // fixtures, so you will need to pass the path while compiling your
 // test cases. Typically this is done with autotools with an added
 // preprocessor flag specified to compile your tests such as:
 //
-// ```
-// -DTEST_SERVICES=\""$(abs_top_builddir)/tests/services"\"
-// ```
+//	-DTEST_SERVICES=\""$(abs_top_builddir)/tests/services"\"
 //
 // Once you have a service definition file which is local to your source tree,
 // you can proceed to set up a GTest fixture using the `GTestDBus` scaffolding.
 //
 // An example of a test fixture for D-Bus services can be found
 // here:
-// [gdbus-test-fixture.c](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-test-fixture.c)
+// [gdbus-test-fixture.c]
 //
 // Note that these examples only deal with isolating the D-Bus aspect of your
 // service. To successfully run isolated unit tests on your service you may need
 // some additional modifications to your test case fixture. For example; if your
-// service uses [class@Gio.Settings] and installs a schema then it is important
+// service uses [Settings] and installs a schema then it is important
 // that your test service not load the schema in the ordinary installed location
 // (chances are that your service and schema files are not yet installed, or
 // worse; there is an older version of the schema file sitting in the install
@@ -81,14 +76,12 @@ import (
 // test cases, an autotools setup might do the following in the directory
 // holding schemas:
 //
-// ```
-//
 //	all-am:
 //	        $(GLIB_COMPILE_SCHEMAS) .
 //
 //	CLEANFILES += gschemas.compiled
 //
-// ```
+// [gdbus-test-fixture.c]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/gio/tests/gdbus-test-fixture.c
 type TestDBus struct {
 	gobject.Object
 }
@@ -107,7 +100,7 @@ func TestDBusNewFromInternalPtr(ptr uintptr) *TestDBus {
 
 var xNewTestDBus func(TestDBusFlags) uintptr
 
-// Create a new #GTestDBus object.
+// Create a new [TestDBus] object.
 func NewTestDBus(FlagsVar TestDBusFlags) *TestDBus {
 	var cls *TestDBus
 
@@ -124,7 +117,7 @@ func NewTestDBus(FlagsVar TestDBusFlags) *TestDBus {
 var xTestDBusAddServiceDir func(uintptr, string)
 
 // Add a path where dbus-daemon will look up .service files. This can't be
-// called after g_test_dbus_up().
+// called after [TestDBus.Up].
 func (x *TestDBus) AddServiceDir(PathVar string) {
 
 	xTestDBusAddServiceDir(x.GoPointer(), PathVar)
@@ -133,9 +126,9 @@ func (x *TestDBus) AddServiceDir(PathVar string) {
 
 var xTestDBusDown func(uintptr)
 
-// Stop the session bus started by g_test_dbus_up().
+// Stop the session bus started by [TestDBus.Up].
 //
-// This will wait for the singleton returned by g_bus_get() or g_bus_get_sync()
+// This will wait for the singleton returned by [BusGet] or [BusGetSync]
 // to be destroyed. This is done to ensure that the next unit test won't get a
 // leaked singleton from this test.
 func (x *TestDBus) Down() {
@@ -146,9 +139,9 @@ func (x *TestDBus) Down() {
 
 var xTestDBusGetBusAddress func(uintptr) string
 
-// Get the address on which dbus-daemon is running. If g_test_dbus_up() has not
-// been called yet, %NULL is returned. This can be used with
-// g_dbus_connection_new_for_address().
+// Get the address on which dbus-daemon is running. If [TestDBus.Up] has not
+// been called yet, nil is returned. This can be used with
+// [DBusConnectionNewForAddress].
 func (x *TestDBus) GetBusAddress() string {
 
 	cret := xTestDBusGetBusAddress(x.GoPointer())
@@ -157,7 +150,7 @@ func (x *TestDBus) GetBusAddress() string {
 
 var xTestDBusGetFlags func(uintptr) TestDBusFlags
 
-// Get the flags of the #GTestDBus object.
+// Get the flags of the [TestDBus] object.
 func (x *TestDBus) GetFlags() TestDBusFlags {
 
 	cret := xTestDBusGetFlags(x.GoPointer())
@@ -166,12 +159,12 @@ func (x *TestDBus) GetFlags() TestDBusFlags {
 
 var xTestDBusStop func(uintptr)
 
-// Stop the session bus started by g_test_dbus_up().
+// Stop the session bus started by [TestDBus.Up].
 //
-// Unlike g_test_dbus_down(), this won't verify the #GDBusConnection
-// singleton returned by g_bus_get() or g_bus_get_sync() is destroyed. Unit
+// Unlike [TestDBus.Down], this won't verify the [DBusConnection]
+// singleton returned by [BusGet] or [BusGetSync] is destroyed. Unit
 // tests wanting to verify behaviour after the session bus has been stopped
-// can use this function but should still call g_test_dbus_down() when done.
+// can use this function but should still call [TestDBus.Down] when done.
 func (x *TestDBus) Stop() {
 
 	xTestDBusStop(x.GoPointer())
@@ -184,10 +177,10 @@ var xTestDBusUp func(uintptr)
 // call, it is safe for unit tests to start sending messages on the session bus.
 //
 // If this function is called from setup callback of g_test_add(),
-// g_test_dbus_down() must be called in its teardown callback.
+// [TestDBus.Down] must be called in its teardown callback.
 //
-// If this function is called from unit test's main(), then g_test_dbus_down()
-// must be called after g_test_run().
+// If this function is called from unit test's main(), then [TestDBus.Down]
+// must be called after [glib.TestRun].
 func (x *TestDBus) Up() {
 
 	xTestDBusUp(x.GoPointer())
@@ -212,7 +205,7 @@ var xTestDBusUnset func()
 //
 // This is useful for unit tests that want to verify behaviour when no session
 // bus is running. It is not necessary to call this if unit test already calls
-// g_test_dbus_up() before acquiring the session bus.
+// [TestDBus.Up] before acquiring the session bus.
 func TestDBusUnset() {
 
 	xTestDBusUnset()
@@ -224,7 +217,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -233,15 +226,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xTestDBusGLibType, libs, "g_test_dbus_get_type")
 
-	core.PuregoSafeRegister(&xNewTestDBus, libs, "g_test_dbus_new")
+	core.PuregoRegisterSince(&xNewTestDBus, libs, "g_test_dbus_new", "GIO", "2.34")
 
-	core.PuregoSafeRegister(&xTestDBusAddServiceDir, libs, "g_test_dbus_add_service_dir")
-	core.PuregoSafeRegister(&xTestDBusDown, libs, "g_test_dbus_down")
-	core.PuregoSafeRegister(&xTestDBusGetBusAddress, libs, "g_test_dbus_get_bus_address")
-	core.PuregoSafeRegister(&xTestDBusGetFlags, libs, "g_test_dbus_get_flags")
-	core.PuregoSafeRegister(&xTestDBusStop, libs, "g_test_dbus_stop")
-	core.PuregoSafeRegister(&xTestDBusUp, libs, "g_test_dbus_up")
+	core.PuregoRegisterSince(&xTestDBusAddServiceDir, libs, "g_test_dbus_add_service_dir", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTestDBusDown, libs, "g_test_dbus_down", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTestDBusGetBusAddress, libs, "g_test_dbus_get_bus_address", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTestDBusGetFlags, libs, "g_test_dbus_get_flags", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTestDBusStop, libs, "g_test_dbus_stop", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTestDBusUp, libs, "g_test_dbus_up", "GIO", "2.34")
 
-	core.PuregoSafeRegister(&xTestDBusUnset, libs, "g_test_dbus_unset")
+	core.PuregoRegisterSince(&xTestDBusUnset, libs, "g_test_dbus_unset", "GIO", "2.34")
 
 }
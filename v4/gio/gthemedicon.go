@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -20,13 +19,13 @@ func (x *ThemedIconClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GThemedIcon` is an implementation of [iface@Gio.Icon] that supports icon
+// `GThemedIcon` is an implementation of [Icon] that supports icon
 // themes.
 //
 // `GThemedIcon` contains a list of all of the icons present in an icon
 // theme, so that icons can be looked up quickly. `GThemedIcon` does
 // not provide actual pixmaps for icons, just the icon names.
-// Ideally something like [method@Gtk.IconTheme.choose_icon] should be used to
+// Ideally something like IconTheme.choose_icon should be used to
 // resolve the list of names so that fallback icons work nicely with
 // themes that inherit other themes.
 type ThemedIcon struct {
@@ -47,7 +46,7 @@ func ThemedIconNewFromInternalPtr(ptr uintptr) *ThemedIcon {
 
 var xNewThemedIcon func(string) uintptr
 
-// Creates a new themed icon for @iconname.
+// Creates a new themed icon for iconname.
 func NewThemedIcon(IconnameVar string) *ThemedIcon {
 	var cls *ThemedIcon
 
@@ -63,7 +62,7 @@ func NewThemedIcon(IconnameVar string) *ThemedIcon {
 
 var xNewThemedIconFromNames func([]string, int) uintptr
 
-// Creates a new themed icon for @iconnames.
+// Creates a new themed icon for iconnames.
 func NewThemedIconFromNames(IconnamesVar []string, LenVar int) *ThemedIcon {
 	var cls *ThemedIcon
 
@@ -79,11 +78,10 @@ func NewThemedIconFromNames(IconnamesVar []string, LenVar int) *ThemedIcon {
 
 var xNewThemedIconWithDefaultFallbacks func(string) uintptr
 
-// Creates a new themed icon for @iconname, and all the names
-// that can be created by shortening @iconname at '-' characters.
+// Creates a new themed icon for iconname, and all the names
+// that can be created by shortening iconname at '-' characters.
 //
-// In the following example, @icon1 and @icon2 are equivalent:
-// |[&lt;!-- language="C" --&gt;
+// In the following example, icon1 and icon2 are equivalent:
 //
 //	const char *names[] = {
 //	  "gnome-dev-cdrom-audio",
@@ -92,9 +90,8 @@ var xNewThemedIconWithDefaultFallbacks func(string) uintptr
 //	  "gnome"
 //	};
 //
-// icon1 = g_themed_icon_new_from_names (names, 4);
-// icon2 = g_themed_icon_new_with_default_fallbacks ("gnome-dev-cdrom-audio");
-// ]|
+//	icon1 = g_themed_icon_new_from_names (names, 4);
+//	icon2 = g_themed_icon_new_with_default_fallbacks ("gnome-dev-cdrom-audio");
 func NewThemedIconWithDefaultFallbacks(IconnameVar string) *ThemedIcon {
 	var cls *ThemedIcon
 
@@ -110,10 +107,10 @@ func NewThemedIconWithDefaultFallbacks(IconnameVar string) *ThemedIcon {
 
 var xThemedIconAppendName func(uintptr, string)
 
-// Append a name to the list of icons from within @icon.
+// Append a name to the list of icons from within icon.
 //
 // Note that doing so invalidates the hash computed by prior calls
-// to g_icon_hash().
+// to [Icon.Hash].
 func (x *ThemedIcon) AppendName(IconnameVar string) {
 
 	xThemedIconAppendName(x.GoPointer(), IconnameVar)
@@ -122,7 +119,7 @@ func (x *ThemedIcon) AppendName(IconnameVar string) {
 
 var xThemedIconGetNames func(uintptr) []string
 
-// Gets the names of icons from within @icon.
+// Gets the names of icons from within icon.
 func (x *ThemedIcon) GetNames() []string {
 
 	cret := xThemedIconGetNames(x.GoPointer())
@@ -131,10 +128,10 @@ func (x *ThemedIcon) GetNames() []string {
 
 var xThemedIconPrependName func(uintptr, string)
 
-// Prepend a name to the list of icons from within @icon.
+// Prepend a name to the list of icons from within icon.
 //
 // Note that doing so invalidates the hash computed by prior calls
-// to g_icon_hash().
+// to [Icon.Hash].
 func (x *ThemedIcon) PrependName(IconnameVar string) {
 
 	xThemedIconPrependName(x.GoPointer(), IconnameVar)
@@ -162,7 +159,7 @@ func (x *ThemedIcon) SetPropertyName(value string) {
 }
 
 // SetPropertyNames sets the "names" property.
-// A %NULL-terminated array of icon names.
+// A nil-terminated array of icon names.
 func (x *ThemedIcon) SetPropertyNames(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -171,7 +168,7 @@ func (x *ThemedIcon) SetPropertyNames(value []string) {
 }
 
 // GetPropertyNames gets the "names" property.
-// A %NULL-terminated array of icon names.
+// A nil-terminated array of icon names.
 func (x *ThemedIcon) GetPropertyNames() []string {
 	var v gobject.Value
 	x.GetProperty("names", &v)
@@ -185,7 +182,6 @@ func (x *ThemedIcon) GetPropertyNames() []string {
 //
 // For example, if the icon name was "gnome-dev-cdrom-audio", the array
 // would become
-// |[&lt;!-- language="C" --&gt;
 //
 //	{
 //	  "gnome-dev-cdrom-audio",
@@ -194,8 +190,6 @@ func (x *ThemedIcon) GetPropertyNames() []string {
 //	  "gnome",
 //	  NULL
 //	};
-//
-// ]|
 func (x *ThemedIcon) SetPropertyUseDefaultFallbacks(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -210,7 +204,6 @@ func (x *ThemedIcon) SetPropertyUseDefaultFallbacks(value bool) {
 //
 // For example, if the icon name was "gnome-dev-cdrom-audio", the array
 // would become
-// |[&lt;!-- language="C" --&gt;
 //
 //	{
 //	  "gnome-dev-cdrom-audio",
@@ -219,8 +212,6 @@ func (x *ThemedIcon) SetPropertyUseDefaultFallbacks(value bool) {
 //	  "gnome",
 //	  NULL
 //	};
-//
-// ]|
 func (x *ThemedIcon) GetPropertyUseDefaultFallbacks() bool {
 	var v gobject.Value
 	x.GetProperty("use-default-fallbacks", &v)
@@ -241,10 +232,10 @@ func (x *ThemedIcon) Hash() uint {
 	return cret
 }
 
-// Serializes a #GIcon into a #GVariant. An equivalent #GIcon can be retrieved
-// back by calling g_icon_deserialize() on the returned value.
+// Serializes a [Icon] into a [glib.Variant]. An equivalent [Icon] can be retrieved
+// back by calling [IconDeserialize] on the returned value.
 // As serialization will avoid using raw icon data when possible, it only
-// makes sense to transfer the #GVariant between processes on the same machine,
+// makes sense to transfer the [glib.Variant] between processes on the same machine,
 // (as opposed to over the network), and within the same file system namespace.
 func (x *ThemedIcon) Serialize() *glib.Variant {
 
@@ -252,21 +243,21 @@ func (x *ThemedIcon) Serialize() *glib.Variant {
 	return cret
 }
 
-// Generates a textual representation of @icon that can be used for
-// serialization such as when passing @icon to a different process or
-// saving it to persistent storage. Use g_icon_new_for_string() to
-// get @icon back from the returned string.
+// Generates a textual representation of icon that can be used for
+// serialization such as when passing icon to a different process or
+// saving it to persistent storage. Use [IconNewForString] to
+// get icon back from the returned string.
 //
-// The encoding of the returned string is proprietary to #GIcon except
+// The encoding of the returned string is proprietary to [Icon] except
 // in the following two cases
 //
-//   - If @icon is a #GFileIcon, the returned string is a native path
+//   - If icon is a [FileIcon], the returned string is a native path
 //     (such as `/path/to/my icon.png`) without escaping
-//     if the #GFile for @icon is a native file.  If the file is not
-//     native, the returned string is the result of g_file_get_uri()
+//     if the [File] for icon is a native file.  If the file is not
+//     native, the returned string is the result of [File.GetUri]
 //     (such as `sftp://path/to/my%20icon.png`).
 //
-//   - If @icon is a #GThemedIcon with exactly one name and no fallbacks,
+//   - If icon is a [ThemedIcon] with exactly one name and no fallbacks,
 //     the encoding is simply the name (such as `network-server`).
 func (x *ThemedIcon) ToString() string {
 
@@ -279,7 +270,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -288,12 +279,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xThemedIconGLibType, libs, "g_themed_icon_get_type")
 
-	core.PuregoSafeRegister(&xNewThemedIcon, libs, "g_themed_icon_new")
-	core.PuregoSafeRegister(&xNewThemedIconFromNames, libs, "g_themed_icon_new_from_names")
-	core.PuregoSafeRegister(&xNewThemedIconWithDefaultFallbacks, libs, "g_themed_icon_new_with_default_fallbacks")
+	core.PuregoRegisterSince(&xNewThemedIcon, libs, "g_themed_icon_new", "GIO", "")
+	core.PuregoRegisterSince(&xNewThemedIconFromNames, libs, "g_themed_icon_new_from_names", "GIO", "")
+	core.PuregoRegisterSince(&xNewThemedIconWithDefaultFallbacks, libs, "g_themed_icon_new_with_default_fallbacks", "GIO", "")
 
-	core.PuregoSafeRegister(&xThemedIconAppendName, libs, "g_themed_icon_append_name")
-	core.PuregoSafeRegister(&xThemedIconGetNames, libs, "g_themed_icon_get_names")
-	core.PuregoSafeRegister(&xThemedIconPrependName, libs, "g_themed_icon_prepend_name")
+	core.PuregoRegisterSince(&xThemedIconAppendName, libs, "g_themed_icon_append_name", "GIO", "")
+	core.PuregoRegisterSince(&xThemedIconGetNames, libs, "g_themed_icon_get_names", "GIO", "")
+	core.PuregoRegisterSince(&xThemedIconPrependName, libs, "g_themed_icon_prepend_name", "GIO", "2.18")
 
 }
@@ -18,7 +18,7 @@ func (x *ThreadedResolverClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// #GThreadedResolver is an implementation of #GResolver which calls the libc
+// [ThreadedResolver] is an implementation of [Resolver] which calls the libc
 // lookup functions in threads to allow them to run asynchronously.
 type ThreadedResolver struct {
 	Resolver
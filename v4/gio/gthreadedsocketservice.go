@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,7 +38,8 @@ func (x *ThreadedSocketServiceClass) OverrideRun(cb func(*ThreadedSocketService,
 	if cb == nil {
 		x.xRun = 0
 	} else {
-		x.xRun = purego.NewCallback(func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+		x.xRun = core.NewCallback(func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(ThreadedSocketServiceNewFromInternalPtr(ServiceVarp), SocketConnectionNewFromInternalPtr(ConnectionVarp), gobject.ObjectNewFromInternalPtr(SourceObjectVarp))
 		})
 	}
@@ -51,7 +51,7 @@ func (x *ThreadedSocketServiceClass) GetRun() func(*ThreadedSocketService, *Sock
 		return nil
 	}
 	var rawCallback func(ServiceVarp uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xRun)
+	core.RegisterFunc(&rawCallback, x.xRun)
 	return func(ServiceVar *ThreadedSocketService, ConnectionVar *SocketConnection, SourceObjectVar *gobject.Object) bool {
 		return rawCallback(ServiceVar.GoPointer(), ConnectionVar.GoPointer(), SourceObjectVar.GoPointer())
 	}
@@ -62,7 +62,8 @@ func (x *ThreadedSocketServiceClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -74,7 +75,7 @@ func (x *ThreadedSocketServiceClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -85,7 +86,8 @@ func (x *ThreadedSocketServiceClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -97,7 +99,7 @@ func (x *ThreadedSocketServiceClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -108,7 +110,8 @@ func (x *ThreadedSocketServiceClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -120,7 +123,7 @@ func (x *ThreadedSocketServiceClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -131,7 +134,8 @@ func (x *ThreadedSocketServiceClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -143,7 +147,7 @@ func (x *ThreadedSocketServiceClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -154,7 +158,8 @@ func (x *ThreadedSocketServiceClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -166,7 +171,7 @@ func (x *ThreadedSocketServiceClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -180,7 +185,7 @@ func (x *ThreadedSocketServicePrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A `GThreadedSocketService` is a simple subclass of [class@Gio.SocketService]
+// A `GThreadedSocketService` is a simple subclass of [SocketService]
 // that handles incoming connections by creating a worker thread and
 // dispatching the connection to it by emitting the
 // [signal@Gio.ThreadedSocketService::run signal] in the new thread.
@@ -190,11 +195,11 @@ func (x *ThreadedSocketServicePrivate) GoPointer() uintptr {
 //
 // The service is implemented using a thread pool, so there is a
 // limited amount of threads available to serve incoming requests.
-// The service automatically stops the [class@Gio.SocketService] from accepting
+// The service automatically stops the [SocketService] from accepting
 // new connections when all threads are busy.
 //
-// As with [class@Gio.SocketService], you may connect to
-// [signal@Gio.ThreadedSocketService::run], or subclass and override the default
+// As with [SocketService], you may connect to
+// [ThreadedSocketService.ConnectRunFunc], or subclass and override the default
 // handler.
 type ThreadedSocketService struct {
 	SocketService
@@ -214,8 +219,8 @@ func ThreadedSocketServiceNewFromInternalPtr(ptr uintptr) *ThreadedSocketService
 
 var xNewThreadedSocketService func(int) uintptr
 
-// Creates a new #GThreadedSocketService with no listeners. Listeners
-// must be added with one of the #GSocketListener "add" methods.
+// Creates a new [ThreadedSocketService] with no listeners. Listeners
+// must be added with one of the [SocketListener] "add" methods.
 func NewThreadedSocketService(MaxThreadsVar int) *ThreadedSocketService {
 	var cls *ThreadedSocketService
 
@@ -259,29 +264,51 @@ func (x *ThreadedSocketService) GetPropertyMaxThreads() int {
 
 // The ::run signal is emitted in a worker thread in response to an
 // incoming connection. This thread is dedicated to handling
-// @connection and may perform blocking IO. The signal handler need
+// connection and may perform blocking IO. The signal handler need
 // not return until the connection is closed.
+//
+// Deprecated: use ConnectRunFunc, which also accepts method values and closures.
 func (x *ThreadedSocketService) ConnectRun(cb *func(ThreadedSocketService, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "run", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := ThreadedSocketService{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ConnectionVarp, SourceObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "run", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr) bool {
+var xThreadedSocketServiceRunTrampoline uintptr
+
+func xThreadedSocketServiceRunNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ConnectionVarp uintptr, SourceObjectVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ThreadedSocketService, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ThreadedSocketService{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ConnectionVarp, SourceObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "run", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRunFunc connects cb to the "run" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ThreadedSocketService) ConnectRunFunc(cb func(ThreadedSocketService, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "run", &xThreadedSocketServiceRunTrampoline, xThreadedSocketServiceRunNewTrampoline, cb)
 }
 
 func init() {
@@ -289,7 +316,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -298,6 +325,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xThreadedSocketServiceGLibType, libs, "g_threaded_socket_service_get_type")
 
-	core.PuregoSafeRegister(&xNewThreadedSocketService, libs, "g_threaded_socket_service_new")
+	gobject.CheckClassSize("GThreadedSocketServiceClass", xThreadedSocketServiceGLibType, unsafe.Sizeof(ThreadedSocketServiceClass{}))
+
+	core.PuregoRegisterSince(&xNewThreadedSocketService, libs, "g_threaded_socket_service_new", "GIO", "2.22")
 
 }
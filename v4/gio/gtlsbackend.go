@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -15,7 +14,7 @@ import (
 type TlsBackendInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSupportsTls uintptr
 
@@ -46,7 +45,8 @@ func (x *TlsBackendInterface) OverrideSupportsTls(cb func(TlsBackend) bool) {
 	if cb == nil {
 		x.xSupportsTls = 0
 	} else {
-		x.xSupportsTls = purego.NewCallback(func(BackendVarp uintptr) bool {
+		x.xSupportsTls = core.NewCallback(func(BackendVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&TlsBackendBase{Ptr: BackendVarp})
 		})
 	}
@@ -59,119 +59,124 @@ func (x *TlsBackendInterface) GetSupportsTls() func(TlsBackend) bool {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSupportsTls)
+	core.RegisterFunc(&rawCallback, x.xSupportsTls)
 	return func(BackendVar TlsBackend) bool {
 		return rawCallback(BackendVar.GoPointer())
 	}
 }
 
 // OverrideGetCertificateType sets the "get_certificate_type" callback function.
-// returns the #GTlsCertificate implementation type
+// returns the [TlsCertificate] implementation type
 func (x *TlsBackendInterface) OverrideGetCertificateType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetCertificateType = 0
 	} else {
-		x.xGetCertificateType = purego.NewCallback(func() types.GType {
+		x.xGetCertificateType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetCertificateType gets the "get_certificate_type" callback function.
-// returns the #GTlsCertificate implementation type
+// returns the [TlsCertificate] implementation type
 func (x *TlsBackendInterface) GetGetCertificateType() func() types.GType {
 	if x.xGetCertificateType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetCertificateType)
+	core.RegisterFunc(&rawCallback, x.xGetCertificateType)
 	return func() types.GType {
 		return rawCallback()
 	}
 }
 
 // OverrideGetClientConnectionType sets the "get_client_connection_type" callback function.
-// returns the #GTlsClientConnection implementation type
+// returns the [TlsClientConnection] implementation type
 func (x *TlsBackendInterface) OverrideGetClientConnectionType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetClientConnectionType = 0
 	} else {
-		x.xGetClientConnectionType = purego.NewCallback(func() types.GType {
+		x.xGetClientConnectionType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetClientConnectionType gets the "get_client_connection_type" callback function.
-// returns the #GTlsClientConnection implementation type
+// returns the [TlsClientConnection] implementation type
 func (x *TlsBackendInterface) GetGetClientConnectionType() func() types.GType {
 	if x.xGetClientConnectionType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetClientConnectionType)
+	core.RegisterFunc(&rawCallback, x.xGetClientConnectionType)
 	return func() types.GType {
 		return rawCallback()
 	}
 }
 
 // OverrideGetServerConnectionType sets the "get_server_connection_type" callback function.
-// returns the #GTlsServerConnection implementation type
+// returns the [TlsServerConnection] implementation type
 func (x *TlsBackendInterface) OverrideGetServerConnectionType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetServerConnectionType = 0
 	} else {
-		x.xGetServerConnectionType = purego.NewCallback(func() types.GType {
+		x.xGetServerConnectionType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetServerConnectionType gets the "get_server_connection_type" callback function.
-// returns the #GTlsServerConnection implementation type
+// returns the [TlsServerConnection] implementation type
 func (x *TlsBackendInterface) GetGetServerConnectionType() func() types.GType {
 	if x.xGetServerConnectionType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetServerConnectionType)
+	core.RegisterFunc(&rawCallback, x.xGetServerConnectionType)
 	return func() types.GType {
 		return rawCallback()
 	}
 }
 
 // OverrideGetFileDatabaseType sets the "get_file_database_type" callback function.
-// returns the #GTlsFileDatabase implementation type.
+// returns the [TlsFileDatabase] implementation type.
 func (x *TlsBackendInterface) OverrideGetFileDatabaseType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetFileDatabaseType = 0
 	} else {
-		x.xGetFileDatabaseType = purego.NewCallback(func() types.GType {
+		x.xGetFileDatabaseType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetFileDatabaseType gets the "get_file_database_type" callback function.
-// returns the #GTlsFileDatabase implementation type.
+// returns the [TlsFileDatabase] implementation type.
 func (x *TlsBackendInterface) GetGetFileDatabaseType() func() types.GType {
 	if x.xGetFileDatabaseType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetFileDatabaseType)
+	core.RegisterFunc(&rawCallback, x.xGetFileDatabaseType)
 	return func() types.GType {
 		return rawCallback()
 	}
 }
 
 // OverrideGetDefaultDatabase sets the "get_default_database" callback function.
-// returns a default #GTlsDatabase instance.
+// returns a default [TlsDatabase] instance.
 func (x *TlsBackendInterface) OverrideGetDefaultDatabase(cb func(TlsBackend) *TlsDatabase) {
 	if cb == nil {
 		x.xGetDefaultDatabase = 0
 	} else {
-		x.xGetDefaultDatabase = purego.NewCallback(func(BackendVarp uintptr) uintptr {
+		x.xGetDefaultDatabase = core.NewCallback(func(BackendVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&TlsBackendBase{Ptr: BackendVarp})
 			if ret == nil {
 				return 0
@@ -182,13 +187,13 @@ func (x *TlsBackendInterface) OverrideGetDefaultDatabase(cb func(TlsBackend) *Tl
 }
 
 // GetGetDefaultDatabase gets the "get_default_database" callback function.
-// returns a default #GTlsDatabase instance.
+// returns a default [TlsDatabase] instance.
 func (x *TlsBackendInterface) GetGetDefaultDatabase() func(TlsBackend) *TlsDatabase {
 	if x.xGetDefaultDatabase == 0 {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetDefaultDatabase)
+	core.RegisterFunc(&rawCallback, x.xGetDefaultDatabase)
 	return func(BackendVar TlsBackend) *TlsDatabase {
 		rawRet := rawCallback(BackendVar.GoPointer())
 		if rawRet == 0 {
@@ -206,7 +211,8 @@ func (x *TlsBackendInterface) OverrideSupportsDtls(cb func(TlsBackend) bool) {
 	if cb == nil {
 		x.xSupportsDtls = 0
 	} else {
-		x.xSupportsDtls = purego.NewCallback(func(BackendVarp uintptr) bool {
+		x.xSupportsDtls = core.NewCallback(func(BackendVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&TlsBackendBase{Ptr: BackendVarp})
 		})
 	}
@@ -219,57 +225,59 @@ func (x *TlsBackendInterface) GetSupportsDtls() func(TlsBackend) bool {
 		return nil
 	}
 	var rawCallback func(BackendVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSupportsDtls)
+	core.RegisterFunc(&rawCallback, x.xSupportsDtls)
 	return func(BackendVar TlsBackend) bool {
 		return rawCallback(BackendVar.GoPointer())
 	}
 }
 
 // OverrideGetDtlsClientConnectionType sets the "get_dtls_client_connection_type" callback function.
-// returns the #GDtlsClientConnection implementation type
+// returns the [DtlsClientConnection] implementation type
 func (x *TlsBackendInterface) OverrideGetDtlsClientConnectionType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetDtlsClientConnectionType = 0
 	} else {
-		x.xGetDtlsClientConnectionType = purego.NewCallback(func() types.GType {
+		x.xGetDtlsClientConnectionType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetDtlsClientConnectionType gets the "get_dtls_client_connection_type" callback function.
-// returns the #GDtlsClientConnection implementation type
+// returns the [DtlsClientConnection] implementation type
 func (x *TlsBackendInterface) GetGetDtlsClientConnectionType() func() types.GType {
 	if x.xGetDtlsClientConnectionType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetDtlsClientConnectionType)
+	core.RegisterFunc(&rawCallback, x.xGetDtlsClientConnectionType)
 	return func() types.GType {
 		return rawCallback()
 	}
 }
 
 // OverrideGetDtlsServerConnectionType sets the "get_dtls_server_connection_type" callback function.
-// returns the #GDtlsServerConnection implementation type
+// returns the [DtlsServerConnection] implementation type
 func (x *TlsBackendInterface) OverrideGetDtlsServerConnectionType(cb func() types.GType) {
 	if cb == nil {
 		x.xGetDtlsServerConnectionType = 0
 	} else {
-		x.xGetDtlsServerConnectionType = purego.NewCallback(func() types.GType {
+		x.xGetDtlsServerConnectionType = core.NewCallback(func() types.GType {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
 }
 
 // GetGetDtlsServerConnectionType gets the "get_dtls_server_connection_type" callback function.
-// returns the #GDtlsServerConnection implementation type
+// returns the [DtlsServerConnection] implementation type
 func (x *TlsBackendInterface) GetGetDtlsServerConnectionType() func() types.GType {
 	if x.xGetDtlsServerConnectionType == 0 {
 		return nil
 	}
 	var rawCallback func() types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetDtlsServerConnectionType)
+	core.RegisterFunc(&rawCallback, x.xGetDtlsServerConnectionType)
 	return func() types.GType {
 		return rawCallback()
 	}
@@ -314,21 +322,55 @@ func (x *TlsBackendBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the #GType of @backend's #GTlsCertificate implementation.
+// ImplementTlsBackend adds the TlsBackend interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - SupportsTls() bool
+//   - GetDefaultDatabase() *TlsDatabase
+//   - SupportsDtls() bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TlsBackendInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTlsBackend[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TlsBackendGLibType(), func(iface *TlsBackendInterface) {
+		if _, ok := any(zero).(interface{ SupportsTls() bool }); ok {
+			iface.OverrideSupportsTls(func(self TlsBackend) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SupportsTls() bool })
+				return impl.SupportsTls()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDefaultDatabase() *TlsDatabase }); ok {
+			iface.OverrideGetDefaultDatabase(func(self TlsBackend) *TlsDatabase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDefaultDatabase() *TlsDatabase })
+				return impl.GetDefaultDatabase()
+			})
+		}
+		if _, ok := any(zero).(interface{ SupportsDtls() bool }); ok {
+			iface.OverrideSupportsDtls(func(self TlsBackend) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SupportsDtls() bool })
+				return impl.SupportsDtls()
+			})
+		}
+	})
+}
+
+// Gets the [gobject.Type] of backend's [TlsCertificate] implementation.
 func (x *TlsBackendBase) GetCertificateType() types.GType {
 
 	cret := XGTlsBackendGetCertificateType(x.GoPointer())
 	return cret
 }
 
-// Gets the #GType of @backend's #GTlsClientConnection implementation.
+// Gets the [gobject.Type] of backend's [TlsClientConnection] implementation.
 func (x *TlsBackendBase) GetClientConnectionType() types.GType {
 
 	cret := XGTlsBackendGetClientConnectionType(x.GoPointer())
 	return cret
 }
 
-// Gets the default #GTlsDatabase used to verify TLS connections.
+// Gets the default [TlsDatabase] used to verify TLS connections.
 func (x *TlsBackendBase) GetDefaultDatabase() *TlsDatabase {
 	var cls *TlsDatabase
 
@@ -342,42 +384,42 @@ func (x *TlsBackendBase) GetDefaultDatabase() *TlsDatabase {
 	return cls
 }
 
-// Gets the #GType of @backend’s #GDtlsClientConnection implementation.
+// Gets the [gobject.Type] of backend’s [DtlsClientConnection] implementation.
 func (x *TlsBackendBase) GetDtlsClientConnectionType() types.GType {
 
 	cret := XGTlsBackendGetDtlsClientConnectionType(x.GoPointer())
 	return cret
 }
 
-// Gets the #GType of @backend’s #GDtlsServerConnection implementation.
+// Gets the [gobject.Type] of backend’s [DtlsServerConnection] implementation.
 func (x *TlsBackendBase) GetDtlsServerConnectionType() types.GType {
 
 	cret := XGTlsBackendGetDtlsServerConnectionType(x.GoPointer())
 	return cret
 }
 
-// Gets the #GType of @backend's #GTlsFileDatabase implementation.
+// Gets the [gobject.Type] of backend's [TlsFileDatabase] implementation.
 func (x *TlsBackendBase) GetFileDatabaseType() types.GType {
 
 	cret := XGTlsBackendGetFileDatabaseType(x.GoPointer())
 	return cret
 }
 
-// Gets the #GType of @backend's #GTlsServerConnection implementation.
+// Gets the [gobject.Type] of backend's [TlsServerConnection] implementation.
 func (x *TlsBackendBase) GetServerConnectionType() types.GType {
 
 	cret := XGTlsBackendGetServerConnectionType(x.GoPointer())
 	return cret
 }
 
-// Set the default #GTlsDatabase used to verify TLS connections
+// Set the default [TlsDatabase] used to verify TLS connections
 //
-// Any subsequent call to g_tls_backend_get_default_database() will return
+// Any subsequent call to [TlsBackend.GetDefaultDatabase] will return
 // the database set in this call.  Existing databases and connections are not
 // modified.
 //
-// Setting a %NULL default database will reset to using the system default
-// database as if g_tls_backend_set_default_database() had never been called.
+// Setting a nil default database will reset to using the system default
+// database as if [TlsBackend.SetDefaultDatabase] had never been called.
 func (x *TlsBackendBase) SetDefaultDatabase(DatabaseVar *TlsDatabase) {
 
 	XGTlsBackendSetDefaultDatabase(x.GoPointer(), DatabaseVar.GoPointer())
@@ -392,8 +434,8 @@ func (x *TlsBackendBase) SupportsDtls() bool {
 	return cret
 }
 
-// Checks if TLS is supported; if this returns %FALSE for the default
-// #GTlsBackend, it means no "real" TLS backend is available.
+// Checks if TLS is supported; if this returns false for the default
+// [TlsBackend], it means no "real" TLS backend is available.
 func (x *TlsBackendBase) SupportsTls() bool {
 
 	cret := XGTlsBackendSupportsTls(x.GoPointer())
@@ -412,14 +454,14 @@ var XGTlsBackendSupportsDtls func(uintptr) bool
 var XGTlsBackendSupportsTls func(uintptr) bool
 
 const (
-	// Extension point for TLS functionality via #GTlsBackend.
-	// See [Extending GIO](overview.html#extending-gio).
+	// Extension point for TLS functionality via [TlsBackend].
+	// See Extending GIO.
 	TLS_BACKEND_EXTENSION_POINT_NAME string = "gio-tls-backend"
 )
 
 var xTlsBackendGetDefault func() uintptr
 
-// Gets the default #GTlsBackend for the system.
+// Gets the default [TlsBackend] for the system.
 func TlsBackendGetDefault() *TlsBackendBase {
 	var cls *TlsBackendBase
 
@@ -439,26 +481,28 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTlsBackendGetDefault, libs, "g_tls_backend_get_default")
+	core.PuregoRegisterSince(&xTlsBackendGetDefault, libs, "g_tls_backend_get_default", "GIO", "2.28")
 
 	core.PuregoSafeRegister(&xTlsBackendGLibType, libs, "g_tls_backend_get_type")
 
-	core.PuregoSafeRegister(&XGTlsBackendGetCertificateType, libs, "g_tls_backend_get_certificate_type")
-	core.PuregoSafeRegister(&XGTlsBackendGetClientConnectionType, libs, "g_tls_backend_get_client_connection_type")
-	core.PuregoSafeRegister(&XGTlsBackendGetDefaultDatabase, libs, "g_tls_backend_get_default_database")
-	core.PuregoSafeRegister(&XGTlsBackendGetDtlsClientConnectionType, libs, "g_tls_backend_get_dtls_client_connection_type")
-	core.PuregoSafeRegister(&XGTlsBackendGetDtlsServerConnectionType, libs, "g_tls_backend_get_dtls_server_connection_type")
-	core.PuregoSafeRegister(&XGTlsBackendGetFileDatabaseType, libs, "g_tls_backend_get_file_database_type")
-	core.PuregoSafeRegister(&XGTlsBackendGetServerConnectionType, libs, "g_tls_backend_get_server_connection_type")
-	core.PuregoSafeRegister(&XGTlsBackendSetDefaultDatabase, libs, "g_tls_backend_set_default_database")
-	core.PuregoSafeRegister(&XGTlsBackendSupportsDtls, libs, "g_tls_backend_supports_dtls")
-	core.PuregoSafeRegister(&XGTlsBackendSupportsTls, libs, "g_tls_backend_supports_tls")
+	gobject.CheckClassSize("GTlsBackendInterface", xTlsBackendGLibType, unsafe.Sizeof(TlsBackendInterface{}))
+
+	core.PuregoRegisterSince(&XGTlsBackendGetCertificateType, libs, "g_tls_backend_get_certificate_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsBackendGetClientConnectionType, libs, "g_tls_backend_get_client_connection_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsBackendGetDefaultDatabase, libs, "g_tls_backend_get_default_database", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGTlsBackendGetDtlsClientConnectionType, libs, "g_tls_backend_get_dtls_client_connection_type", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGTlsBackendGetDtlsServerConnectionType, libs, "g_tls_backend_get_dtls_server_connection_type", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGTlsBackendGetFileDatabaseType, libs, "g_tls_backend_get_file_database_type", "GIO", "2.30")
+	core.PuregoRegisterSince(&XGTlsBackendGetServerConnectionType, libs, "g_tls_backend_get_server_connection_type", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsBackendSetDefaultDatabase, libs, "g_tls_backend_set_default_database", "GIO", "2.60")
+	core.PuregoRegisterSince(&XGTlsBackendSupportsDtls, libs, "g_tls_backend_supports_dtls", "GIO", "2.48")
+	core.PuregoRegisterSince(&XGTlsBackendSupportsTls, libs, "g_tls_backend_supports_tls", "GIO", "2.28")
 
 }
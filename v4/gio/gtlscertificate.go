@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +30,8 @@ func (x *TlsCertificateClass) OverrideVerify(cb func(*TlsCertificate, SocketConn
 	if cb == nil {
 		x.xVerify = 0
 	} else {
-		x.xVerify = purego.NewCallback(func(CertVarp uintptr, IdentityVarp uintptr, TrustedCaVarp uintptr) TlsCertificateFlags {
+		x.xVerify = core.NewCallback(func(CertVarp uintptr, IdentityVarp uintptr, TrustedCaVarp uintptr) TlsCertificateFlags {
+			defer core.RecoverPanic()
 			return cb(TlsCertificateNewFromInternalPtr(CertVarp), &SocketConnectableBase{Ptr: IdentityVarp}, TlsCertificateNewFromInternalPtr(TrustedCaVarp))
 		})
 	}
@@ -43,7 +43,7 @@ func (x *TlsCertificateClass) GetVerify() func(*TlsCertificate, SocketConnectabl
 		return nil
 	}
 	var rawCallback func(CertVarp uintptr, IdentityVarp uintptr, TrustedCaVarp uintptr) TlsCertificateFlags
-	purego.RegisterFunc(&rawCallback, x.xVerify)
+	core.RegisterFunc(&rawCallback, x.xVerify)
 	return func(CertVar *TlsCertificate, IdentityVar SocketConnectable, TrustedCaVar *TlsCertificate) TlsCertificateFlags {
 		return rawCallback(CertVar.GoPointer(), IdentityVar.GoPointer(), TrustedCaVar.GoPointer())
 	}
@@ -61,7 +61,7 @@ func (x *TlsCertificatePrivate) GoPointer() uintptr {
 // This can represent either a certificate only (eg, the certificate
 // received by a client from a server), or the combination of
 // a certificate and a private key (which is needed when acting as a
-// [iface@Gio.TlsServerConnection]).
+// [TlsServerConnection]).
 type TlsCertificate struct {
 	gobject.Object
 }
@@ -80,15 +80,15 @@ func TlsCertificateNewFromInternalPtr(ptr uintptr) *TlsCertificate {
 
 var xNewTlsCertificateFromFile func(string, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from the data in @file.
+// Creates a [TlsCertificate] from the data in file.
 //
 // As of 2.72, if the filename ends in `.p12` or `.pfx` the data is loaded by
-// g_tls_certificate_new_from_pkcs12() otherwise it is loaded by
-// g_tls_certificate_new_from_pem(). See those functions for
+// [NewTlsCertificateFromPkcs12] otherwise it is loaded by
+// [NewTlsCertificateFromPem]. See those functions for
 // exact details.
 //
-// If @file cannot be read or parsed, the function will return %NULL and
-// set @error.
+// If file cannot be read or parsed, the function will return nil and
+// set error.
 func NewTlsCertificateFromFile(FileVar string) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -96,12 +96,15 @@ func NewTlsCertificateFromFile(FileVar string) (*TlsCertificate, error) {
 	cret := xNewTlsCertificateFromFile(FileVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -109,14 +112,14 @@ func NewTlsCertificateFromFile(FileVar string) (*TlsCertificate, error) {
 
 var xNewTlsCertificateFromFileWithPassword func(string, string, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from the data in @file.
+// Creates a [TlsCertificate] from the data in file.
 //
-// If @file cannot be read or parsed, the function will return %NULL and
-// set @error.
+// If file cannot be read or parsed, the function will return nil and
+// set error.
 //
-// Any unknown file types will error with %G_IO_ERROR_NOT_SUPPORTED.
+// Any unknown file types will error with [GIoErrorNotSupportedValue].
 // Currently only `.p12` and `.pfx` files are supported.
-// See g_tls_certificate_new_from_pkcs12() for more details.
+// See [NewTlsCertificateFromPkcs12] for more details.
 func NewTlsCertificateFromFileWithPassword(FileVar string, PasswordVar string) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -124,12 +127,15 @@ func NewTlsCertificateFromFileWithPassword(FileVar string, PasswordVar string) (
 	cret := xNewTlsCertificateFromFileWithPassword(FileVar, PasswordVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -137,20 +143,20 @@ func NewTlsCertificateFromFileWithPassword(FileVar string, PasswordVar string) (
 
 var xNewTlsCertificateFromFiles func(string, string, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from the PEM-encoded data in @cert_file
-// and @key_file. The returned certificate will be the first certificate
-// found in @cert_file. As of GLib 2.44, if @cert_file contains more
+// Creates a [TlsCertificate] from the PEM-encoded data in cert_file
+// and key_file. The returned certificate will be the first certificate
+// found in cert_file. As of GLib 2.44, if cert_file contains more
 // certificates it will try to load a certificate chain. All
 // certificates will be verified in the order found (top-level
 // certificate should be the last one in the file) and the
-// #GTlsCertificate:issuer property of each certificate will be set
+// GTlsCertificate:issuer property of each certificate will be set
 // accordingly if the verification succeeds. If any certificate in the
 // chain cannot be verified, the first certificate in the file will
 // still be returned.
 //
 // If either file cannot be read or parsed, the function will return
-// %NULL and set @error. Otherwise, this behaves like
-// g_tls_certificate_new_from_pem().
+// nil and set error. Otherwise, this behaves like
+// [NewTlsCertificateFromPem].
 func NewTlsCertificateFromFiles(CertFileVar string, KeyFileVar string) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -158,12 +164,15 @@ func NewTlsCertificateFromFiles(CertFileVar string, KeyFileVar string) (*TlsCert
 	cret := xNewTlsCertificateFromFiles(CertFileVar, KeyFileVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -171,17 +180,17 @@ func NewTlsCertificateFromFiles(CertFileVar string, KeyFileVar string) (*TlsCert
 
 var xNewTlsCertificateFromPem func(string, int, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from the PEM-encoded data in @data. If
-// @data includes both a certificate and a private key, then the
+// Creates a [TlsCertificate] from the PEM-encoded data in data. If
+// data includes both a certificate and a private key, then the
 // returned certificate will include the private key data as well. (See
-// the #GTlsCertificate:private-key-pem property for information about
+// the [TlsCertificate.GetPropertyPrivateKeyPem] property for information about
 // supported formats.)
 //
 // The returned certificate will be the first certificate found in
-// @data. As of GLib 2.44, if @data contains more certificates it will
+// data. As of GLib 2.44, if data contains more certificates it will
 // try to load a certificate chain. All certificates will be verified in
 // the order found (top-level certificate should be the last one in the
-// file) and the #GTlsCertificate:issuer property of each certificate
+// file) and the GTlsCertificate:issuer property of each certificate
 // will be set accordingly if the verification succeeds. If any
 // certificate in the chain cannot be verified, the first certificate in
 // the file will still be returned.
@@ -192,12 +201,15 @@ func NewTlsCertificateFromPem(DataVar string, LengthVar int) (*TlsCertificate, e
 	cret := xNewTlsCertificateFromPem(DataVar, LengthVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -205,33 +217,30 @@ func NewTlsCertificateFromPem(DataVar string, LengthVar int) (*TlsCertificate, e
 
 var xNewTlsCertificateFromPkcs11Uris func(string, uintptr, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from a
-// [PKCS \#11](https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html) URI.
+// Creates a [TlsCertificate] from a
+// [PKCS \#11] URI.
 //
-// An example @pkcs11_uri would be `pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01`
+// An example pkcs11_uri would be `pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01`
 //
 // Where the token’s layout is:
 //
-// |[
-// Object 0:
-//
-//	URL: pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01;object=private%20key;type=private
-//	Type: Private key (RSA-2048)
-//	ID: 01
+//	Object 0:
+//	  URL: pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01;object=private%20key;type=private
+//	  Type: Private key (RSA-2048)
+//	  ID: 01
 //
-// Object 1:
-//
-//	URL: pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01;object=Certificate%20for%20Authentication;type=cert
-//	Type: X.509 Certificate (RSA-2048)
-//	ID: 01
-//
-// ]|
+//	Object 1:
+//	  URL: pkcs11:model=Model;manufacturer=Manufacture;serial=1;token=My%20Client%20Certificate;id=%01;object=Certificate%20for%20Authentication;type=cert
+//	  Type: X.509 Certificate (RSA-2048)
+//	  ID: 01
 //
 // In this case the certificate and private key would both be detected and used as expected.
-// @pkcs_uri may also just reference an X.509 certificate object and then optionally
-// @private_key_pkcs11_uri allows using a private key exposed under a different URI.
+// pkcs_uri may also just reference an X.509 certificate object and then optionally
+// private_key_pkcs11_uri allows using a private key exposed under a different URI.
 //
 // Note that the private key is not accessed until usage and may fail or require a PIN later.
+//
+// [PKCS \#11]: https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html
 func NewTlsCertificateFromPkcs11Uris(Pkcs11UriVar string, PrivateKeyPkcs11UriVar *string) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -242,12 +251,15 @@ func NewTlsCertificateFromPkcs11Uris(Pkcs11UriVar string, PrivateKeyPkcs11UriVar
 	cret := xNewTlsCertificateFromPkcs11Uris(Pkcs11UriVar, PrivateKeyPkcs11UriVarPtr, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -255,23 +267,23 @@ func NewTlsCertificateFromPkcs11Uris(Pkcs11UriVar string, PrivateKeyPkcs11UriVar
 
 var xNewTlsCertificateFromPkcs12 func([]byte, uint, uintptr, **glib.Error) uintptr
 
-// Creates a #GTlsCertificate from the data in @data. It must contain
+// Creates a [TlsCertificate] from the data in data. It must contain
 // a certificate and matching private key.
 //
 // If extra certificates are included they will be verified as a chain
-// and the #GTlsCertificate:issuer property will be set.
+// and the GTlsCertificate:issuer property will be set.
 // All other data will be ignored.
 //
 // You can pass as single password for all of the data which will be
 // used both for the PKCS #12 container as well as encrypted
 // private keys. If decryption fails it will error with
-// %G_TLS_ERROR_BAD_CERTIFICATE_PASSWORD.
+// [GTlsErrorBadCertificatePasswordValue].
 //
-// This constructor requires support in the current #GTlsBackend.
+// This constructor requires support in the current [TlsBackend].
 // If support is missing it will error with
-// %G_IO_ERROR_NOT_SUPPORTED.
+// [GIoErrorNotSupportedValue].
 //
-// Other parsing failures will error with %G_TLS_ERROR_BAD_CERTIFICATE.
+// Other parsing failures will error with [GTlsErrorBadCertificateValue].
 func NewTlsCertificateFromPkcs12(DataVar []byte, LengthVar uint, PasswordVar *string) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -282,12 +294,15 @@ func NewTlsCertificateFromPkcs12(DataVar []byte, LengthVar uint, PasswordVar *st
 	cret := xNewTlsCertificateFromPkcs12(DataVar, LengthVar, PasswordVarPtr, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -295,7 +310,7 @@ func NewTlsCertificateFromPkcs12(DataVar []byte, LengthVar uint, PasswordVar *st
 
 var xTlsCertificateGetDnsNames func(uintptr) uintptr
 
-// Gets the value of #GTlsCertificate:dns-names.
+// Gets the value of [TlsCertificate.GetPropertyDnsNames].
 func (x *TlsCertificate) GetDnsNames() uintptr {
 
 	cret := xTlsCertificateGetDnsNames(x.GoPointer())
@@ -304,7 +319,7 @@ func (x *TlsCertificate) GetDnsNames() uintptr {
 
 var xTlsCertificateGetIpAddresses func(uintptr) uintptr
 
-// Gets the value of #GTlsCertificate:ip-addresses.
+// Gets the value of [TlsCertificate.GetPropertyIpAddresses].
 func (x *TlsCertificate) GetIpAddresses() uintptr {
 
 	cret := xTlsCertificateGetIpAddresses(x.GoPointer())
@@ -313,7 +328,7 @@ func (x *TlsCertificate) GetIpAddresses() uintptr {
 
 var xTlsCertificateGetIssuer func(uintptr) uintptr
 
-// Gets the #GTlsCertificate representing @cert's issuer, if known
+// Gets the [TlsCertificate] representing cert's issuer, if known
 func (x *TlsCertificate) GetIssuer() *TlsCertificate {
 	var cls *TlsCertificate
 
@@ -366,11 +381,11 @@ func (x *TlsCertificate) GetSubjectName() string {
 
 var xTlsCertificateIsSame func(uintptr, uintptr) bool
 
-// Check if two #GTlsCertificate objects represent the same certificate.
+// Check if two [TlsCertificate] objects represent the same certificate.
 // The raw DER byte data of the two certificates are checked for equality.
 // This has the effect that two certificates may compare equal even if
-// their #GTlsCertificate:issuer, #GTlsCertificate:private-key, or
-// #GTlsCertificate:private-key-pem properties differ.
+// their GTlsCertificate:issuer, [TlsCertificate.GetPropertyPrivateKey], or
+// [TlsCertificate.GetPropertyPrivateKeyPem] properties differ.
 func (x *TlsCertificate) IsSame(CertTwoVar *TlsCertificate) bool {
 
 	cret := xTlsCertificateIsSame(x.GoPointer(), CertTwoVar.GoPointer())
@@ -379,38 +394,38 @@ func (x *TlsCertificate) IsSame(CertTwoVar *TlsCertificate) bool {
 
 var xTlsCertificateVerify func(uintptr, uintptr, uintptr) TlsCertificateFlags
 
-// This verifies @cert and returns a set of #GTlsCertificateFlags
+// This verifies cert and returns a set of [TlsCertificateFlags]
 // indicating any problems found with it. This can be used to verify a
 // certificate outside the context of making a connection, or to
 // check a certificate against a CA that is not part of the system
 // CA database.
 //
-// If @cert is valid, %G_TLS_CERTIFICATE_NO_FLAGS is returned.
+// If cert is valid, [GTlsCertificateNoFlagsValue] is returned.
 //
-// If @identity is not %NULL, @cert's name(s) will be compared against
-// it, and %G_TLS_CERTIFICATE_BAD_IDENTITY will be set in the return
-// value if it does not match. If @identity is %NULL, that bit will
+// If identity is not nil, cert's name(s) will be compared against
+// it, and [GTlsCertificateBadIdentityValue] will be set in the return
+// value if it does not match. If identity is nil, that bit will
 // never be set in the return value.
 //
-// If @trusted_ca is not %NULL, then @cert (or one of the certificates
+// If trusted_ca is not nil, then cert (or one of the certificates
 // in its chain) must be signed by it, or else
-// %G_TLS_CERTIFICATE_UNKNOWN_CA will be set in the return value. If
-// @trusted_ca is %NULL, that bit will never be set in the return
+// [GTlsCertificateUnknownCaValue] will be set in the return value. If
+// trusted_ca is nil, that bit will never be set in the return
 // value.
 //
 // GLib guarantees that if certificate verification fails, at least one
 // error will be set in the return value, but it does not guarantee
 // that all possible errors will be set. Accordingly, you may not safely
 // decide to ignore any particular type of error. For example, it would
-// be incorrect to mask %G_TLS_CERTIFICATE_EXPIRED if you want to allow
+// be incorrect to mask [GTlsCertificateExpiredValue] if you want to allow
 // expired certificates, because this could potentially be the only
 // error flag set even if other problems exist with the certificate.
 //
-// Because TLS session context is not used, #GTlsCertificate may not
-// perform as many checks on the certificates as #GTlsConnection would.
+// Because TLS session context is not used, [TlsCertificate] may not
+// perform as many checks on the certificates as [TlsConnection] would.
 // For example, certificate constraints may not be honored, and
 // revocation checks may not be performed. The best way to verify TLS
-// certificates used by a TLS connection is to let #GTlsConnection
+// certificates used by a TLS connection is to let [TlsConnection]
 // handle the verification.
 func (x *TlsCertificate) Verify(IdentityVar SocketConnectable, TrustedCaVar *TlsCertificate) TlsCertificateFlags {
 
@@ -431,7 +446,7 @@ func (c *TlsCertificate) SetGoPointer(ptr uintptr) {
 
 // SetPropertyCertificate sets the "certificate" property.
 // The DER (binary) encoded representation of the certificate.
-// This property and the #GTlsCertificate:certificate-pem property
+// This property and the [TlsCertificate.GetPropertyCertificatePem] property
 // represent the same data, just in different forms.
 func (x *TlsCertificate) SetPropertyCertificate(value []byte) {
 	var v gobject.Value
@@ -442,7 +457,7 @@ func (x *TlsCertificate) SetPropertyCertificate(value []byte) {
 
 // GetPropertyCertificate gets the "certificate" property.
 // The DER (binary) encoded representation of the certificate.
-// This property and the #GTlsCertificate:certificate-pem property
+// This property and the [TlsCertificate.GetPropertyCertificatePem] property
 // represent the same data, just in different forms.
 func (x *TlsCertificate) GetPropertyCertificate() []byte {
 	var v gobject.Value
@@ -462,7 +477,7 @@ func (x *TlsCertificate) GetPropertyCertificate() []byte {
 
 // SetPropertyCertificatePem sets the "certificate-pem" property.
 // The PEM (ASCII) encoded representation of the certificate.
-// This property and the #GTlsCertificate:certificate
+// This property and the [TlsCertificate.GetPropertyCertificate]
 // property represent the same data, just in different forms.
 func (x *TlsCertificate) SetPropertyCertificatePem(value string) {
 	var v gobject.Value
@@ -473,7 +488,7 @@ func (x *TlsCertificate) SetPropertyCertificatePem(value string) {
 
 // GetPropertyCertificatePem gets the "certificate-pem" property.
 // The PEM (ASCII) encoded representation of the certificate.
-// This property and the #GTlsCertificate:certificate
+// This property and the [TlsCertificate.GetPropertyCertificate]
 // property represent the same data, just in different forms.
 func (x *TlsCertificate) GetPropertyCertificatePem() string {
 	var v gobject.Value
@@ -483,7 +498,7 @@ func (x *TlsCertificate) GetPropertyCertificatePem() string {
 
 // GetPropertyDnsNames gets the "dns-names" property.
 // The DNS names from the certificate's Subject Alternative Names (SANs),
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertyDnsNames() []uintptr {
 	var v gobject.Value
 	x.GetProperty("dns-names", &v)
@@ -502,7 +517,7 @@ func (x *TlsCertificate) GetPropertyDnsNames() []uintptr {
 
 // GetPropertyIpAddresses gets the "ip-addresses" property.
 // The IP addresses from the certificate's Subject Alternative Names (SANs),
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertyIpAddresses() []uintptr {
 	var v gobject.Value
 	x.GetProperty("ip-addresses", &v)
@@ -521,7 +536,7 @@ func (x *TlsCertificate) GetPropertyIpAddresses() []uintptr {
 
 // GetPropertyIssuerName gets the "issuer-name" property.
 // The issuer from the certificate,
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertyIssuerName() string {
 	var v gobject.Value
 	x.GetProperty("issuer-name", &v)
@@ -530,7 +545,7 @@ func (x *TlsCertificate) GetPropertyIssuerName() string {
 
 // GetPropertyNotValidAfter gets the "not-valid-after" property.
 // The time at which this cert is no longer valid,
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertyNotValidAfter() uintptr {
 	var v gobject.Value
 	x.GetProperty("not-valid-after", &v)
@@ -539,7 +554,7 @@ func (x *TlsCertificate) GetPropertyNotValidAfter() uintptr {
 
 // GetPropertyNotValidBefore gets the "not-valid-before" property.
 // The time at which this cert is considered to be valid,
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertyNotValidBefore() uintptr {
 	var v gobject.Value
 	x.GetProperty("not-valid-before", &v)
@@ -556,11 +571,13 @@ func (x *TlsCertificate) SetPropertyPassword(value string) {
 }
 
 // SetPropertyPkcs11Uri sets the "pkcs11-uri" property.
-// A URI referencing the [PKCS \#11](https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html)
+// A URI referencing the [PKCS \#11]
 // objects containing an X.509 certificate and optionally a private key.
 //
-// If %NULL, the certificate is either not backed by PKCS \#11 or the
-// #GTlsBackend does not support PKCS \#11.
+// If nil, the certificate is either not backed by PKCS \#11 or the
+// [TlsBackend] does not support PKCS \#11.
+//
+// [PKCS \#11]: https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html
 func (x *TlsCertificate) SetPropertyPkcs11Uri(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -569,11 +586,13 @@ func (x *TlsCertificate) SetPropertyPkcs11Uri(value string) {
 }
 
 // GetPropertyPkcs11Uri gets the "pkcs11-uri" property.
-// A URI referencing the [PKCS \#11](https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html)
+// A URI referencing the [PKCS \#11]
 // objects containing an X.509 certificate and optionally a private key.
 //
-// If %NULL, the certificate is either not backed by PKCS \#11 or the
-// #GTlsBackend does not support PKCS \#11.
+// If nil, the certificate is either not backed by PKCS \#11 or the
+// [TlsBackend] does not support PKCS \#11.
+//
+// [PKCS \#11]: https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html
 func (x *TlsCertificate) GetPropertyPkcs11Uri() string {
 	var v gobject.Value
 	x.GetProperty("pkcs11-uri", &v)
@@ -583,7 +602,7 @@ func (x *TlsCertificate) GetPropertyPkcs11Uri() string {
 // SetPropertyPkcs12Data sets the "pkcs12-data" property.
 // The PKCS #12 formatted data used to construct the object.
 //
-// See also: g_tls_certificate_new_from_pkcs12()
+// See also: [NewTlsCertificateFromPkcs12]
 func (x *TlsCertificate) SetPropertyPkcs12Data(value []byte) {
 	var v gobject.Value
 	v.Init(glib.ByteArrayGLibType())
@@ -593,22 +612,25 @@ func (x *TlsCertificate) SetPropertyPkcs12Data(value []byte) {
 
 // SetPropertyPrivateKey sets the "private-key" property.
 // The DER (binary) encoded representation of the certificate's
-// private key, in either [PKCS \#1 format](https://datatracker.ietf.org/doc/html/rfc8017)
-// or unencrypted [PKCS \#8 format.](https://datatracker.ietf.org/doc/html/rfc5208)
+// private key, in either [PKCS \#1 format]
+// or unencrypted [PKCS \#8 format.]
 // PKCS \#8 format is supported since 2.32; earlier releases only
 // support PKCS \#1. You can use the `openssl rsa` tool to convert
 // PKCS \#8 keys to PKCS \#1.
 //
-// This property (or the #GTlsCertificate:private-key-pem property)
+// This property (or the [TlsCertificate.GetPropertyPrivateKeyPem] property)
 // can be set when constructing a key (for example, from a file).
 // Since GLib 2.70, it is now also readable; however, be aware that if
 // the private key is backed by a PKCS \#11 URI – for example, if it
-// is stored on a smartcard – then this property will be %NULL. If so,
+// is stored on a smartcard – then this property will be nil. If so,
 // the private key must be referenced via its PKCS \#11 URI,
-// #GTlsCertificate:private-key-pkcs11-uri. You must check both
+// [TlsCertificate.GetPropertyPrivateKeyPkcs11Uri]. You must check both
 // properties to see if the certificate really has a private key.
 // When this property is read, the output format will be unencrypted
 // PKCS \#8.
+//
+// [PKCS \#1 format]: https://datatracker.ietf.org/doc/html/rfc8017
+// [PKCS \#8 format.]: https://datatracker.ietf.org/doc/html/rfc5208
 func (x *TlsCertificate) SetPropertyPrivateKey(value []byte) {
 	var v gobject.Value
 	v.Init(glib.ByteArrayGLibType())
@@ -618,22 +640,25 @@ func (x *TlsCertificate) SetPropertyPrivateKey(value []byte) {
 
 // GetPropertyPrivateKey gets the "private-key" property.
 // The DER (binary) encoded representation of the certificate's
-// private key, in either [PKCS \#1 format](https://datatracker.ietf.org/doc/html/rfc8017)
-// or unencrypted [PKCS \#8 format.](https://datatracker.ietf.org/doc/html/rfc5208)
+// private key, in either [PKCS \#1 format]
+// or unencrypted [PKCS \#8 format.]
 // PKCS \#8 format is supported since 2.32; earlier releases only
 // support PKCS \#1. You can use the `openssl rsa` tool to convert
 // PKCS \#8 keys to PKCS \#1.
 //
-// This property (or the #GTlsCertificate:private-key-pem property)
+// This property (or the [TlsCertificate.GetPropertyPrivateKeyPem] property)
 // can be set when constructing a key (for example, from a file).
 // Since GLib 2.70, it is now also readable; however, be aware that if
 // the private key is backed by a PKCS \#11 URI – for example, if it
-// is stored on a smartcard – then this property will be %NULL. If so,
+// is stored on a smartcard – then this property will be nil. If so,
 // the private key must be referenced via its PKCS \#11 URI,
-// #GTlsCertificate:private-key-pkcs11-uri. You must check both
+// [TlsCertificate.GetPropertyPrivateKeyPkcs11Uri]. You must check both
 // properties to see if the certificate really has a private key.
 // When this property is read, the output format will be unencrypted
 // PKCS \#8.
+//
+// [PKCS \#1 format]: https://datatracker.ietf.org/doc/html/rfc8017
+// [PKCS \#8 format.]: https://datatracker.ietf.org/doc/html/rfc5208
 func (x *TlsCertificate) GetPropertyPrivateKey() []byte {
 	var v gobject.Value
 	x.GetProperty("private-key", &v)
@@ -652,23 +677,26 @@ func (x *TlsCertificate) GetPropertyPrivateKey() []byte {
 
 // SetPropertyPrivateKeyPem sets the "private-key-pem" property.
 // The PEM (ASCII) encoded representation of the certificate's
-// private key in either [PKCS \#1 format](https://datatracker.ietf.org/doc/html/rfc8017)
+// private key in either [PKCS \#1 format]
 // ("`BEGIN RSA PRIVATE KEY`") or unencrypted
-// [PKCS \#8 format](https://datatracker.ietf.org/doc/html/rfc5208)
+// [PKCS \#8 format]
 // ("`BEGIN PRIVATE KEY`"). PKCS \#8 format is supported since 2.32;
 // earlier releases only support PKCS \#1. You can use the `openssl rsa`
 // tool to convert PKCS \#8 keys to PKCS \#1.
 //
-// This property (or the #GTlsCertificate:private-key property)
+// This property (or the [TlsCertificate.GetPropertyPrivateKey] property)
 // can be set when constructing a key (for example, from a file).
 // Since GLib 2.70, it is now also readable; however, be aware that if
 // the private key is backed by a PKCS \#11 URI - for example, if it
-// is stored on a smartcard - then this property will be %NULL. If so,
+// is stored on a smartcard - then this property will be nil. If so,
 // the private key must be referenced via its PKCS \#11 URI,
-// #GTlsCertificate:private-key-pkcs11-uri. You must check both
+// [TlsCertificate.GetPropertyPrivateKeyPkcs11Uri]. You must check both
 // properties to see if the certificate really has a private key.
 // When this property is read, the output format will be unencrypted
 // PKCS \#8.
+//
+// [PKCS \#1 format]: https://datatracker.ietf.org/doc/html/rfc8017
+// [PKCS \#8 format]: https://datatracker.ietf.org/doc/html/rfc5208
 func (x *TlsCertificate) SetPropertyPrivateKeyPem(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -678,23 +706,26 @@ func (x *TlsCertificate) SetPropertyPrivateKeyPem(value string) {
 
 // GetPropertyPrivateKeyPem gets the "private-key-pem" property.
 // The PEM (ASCII) encoded representation of the certificate's
-// private key in either [PKCS \#1 format](https://datatracker.ietf.org/doc/html/rfc8017)
+// private key in either [PKCS \#1 format]
 // ("`BEGIN RSA PRIVATE KEY`") or unencrypted
-// [PKCS \#8 format](https://datatracker.ietf.org/doc/html/rfc5208)
+// [PKCS \#8 format]
 // ("`BEGIN PRIVATE KEY`"). PKCS \#8 format is supported since 2.32;
 // earlier releases only support PKCS \#1. You can use the `openssl rsa`
 // tool to convert PKCS \#8 keys to PKCS \#1.
 //
-// This property (or the #GTlsCertificate:private-key property)
+// This property (or the [TlsCertificate.GetPropertyPrivateKey] property)
 // can be set when constructing a key (for example, from a file).
 // Since GLib 2.70, it is now also readable; however, be aware that if
 // the private key is backed by a PKCS \#11 URI - for example, if it
-// is stored on a smartcard - then this property will be %NULL. If so,
+// is stored on a smartcard - then this property will be nil. If so,
 // the private key must be referenced via its PKCS \#11 URI,
-// #GTlsCertificate:private-key-pkcs11-uri. You must check both
+// [TlsCertificate.GetPropertyPrivateKeyPkcs11Uri]. You must check both
 // properties to see if the certificate really has a private key.
 // When this property is read, the output format will be unencrypted
 // PKCS \#8.
+//
+// [PKCS \#1 format]: https://datatracker.ietf.org/doc/html/rfc8017
+// [PKCS \#8 format]: https://datatracker.ietf.org/doc/html/rfc5208
 func (x *TlsCertificate) GetPropertyPrivateKeyPem() string {
 	var v gobject.Value
 	x.GetProperty("private-key-pem", &v)
@@ -702,8 +733,10 @@ func (x *TlsCertificate) GetPropertyPrivateKeyPem() string {
 }
 
 // SetPropertyPrivateKeyPkcs11Uri sets the "private-key-pkcs11-uri" property.
-// A URI referencing a [PKCS \#11](https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html)
+// A URI referencing a [PKCS \#11]
 // object containing a private key.
+//
+// [PKCS \#11]: https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html
 func (x *TlsCertificate) SetPropertyPrivateKeyPkcs11Uri(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -712,8 +745,10 @@ func (x *TlsCertificate) SetPropertyPrivateKeyPkcs11Uri(value string) {
 }
 
 // GetPropertyPrivateKeyPkcs11Uri gets the "private-key-pkcs11-uri" property.
-// A URI referencing a [PKCS \#11](https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html)
+// A URI referencing a [PKCS \#11]
 // object containing a private key.
+//
+// [PKCS \#11]: https://docs.oasis-open.org/pkcs11/pkcs11-base/v3.0/os/pkcs11-base-v3.0-os.html
 func (x *TlsCertificate) GetPropertyPrivateKeyPkcs11Uri() string {
 	var v gobject.Value
 	x.GetProperty("private-key-pkcs11-uri", &v)
@@ -722,7 +757,7 @@ func (x *TlsCertificate) GetPropertyPrivateKeyPkcs11Uri() string {
 
 // GetPropertySubjectName gets the "subject-name" property.
 // The subject from the cert,
-// %NULL if unavailable.
+// nil if unavailable.
 func (x *TlsCertificate) GetPropertySubjectName() string {
 	var v gobject.Value
 	x.GetProperty("subject-name", &v)
@@ -731,17 +766,17 @@ func (x *TlsCertificate) GetPropertySubjectName() string {
 
 var xTlsCertificateListNewFromFile func(string, **glib.Error) *glib.List
 
-// Creates one or more #GTlsCertificates from the PEM-encoded
-// data in @file. If @file cannot be read or parsed, the function will
-// return %NULL and set @error. If @file does not contain any
+// Creates one or more GTlsCertificates from the PEM-encoded
+// data in file. If file cannot be read or parsed, the function will
+// return nil and set error. If file does not contain any
 // PEM-encoded certificates, this will return an empty list and not
-// set @error.
+// set error.
 func TlsCertificateListNewFromFile(FileVar string) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xTlsCertificateListNewFromFile(FileVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -752,7 +787,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -761,23 +796,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xTlsCertificateGLibType, libs, "g_tls_certificate_get_type")
 
-	core.PuregoSafeRegister(&xNewTlsCertificateFromFile, libs, "g_tls_certificate_new_from_file")
-	core.PuregoSafeRegister(&xNewTlsCertificateFromFileWithPassword, libs, "g_tls_certificate_new_from_file_with_password")
-	core.PuregoSafeRegister(&xNewTlsCertificateFromFiles, libs, "g_tls_certificate_new_from_files")
-	core.PuregoSafeRegister(&xNewTlsCertificateFromPem, libs, "g_tls_certificate_new_from_pem")
-	core.PuregoSafeRegister(&xNewTlsCertificateFromPkcs11Uris, libs, "g_tls_certificate_new_from_pkcs11_uris")
-	core.PuregoSafeRegister(&xNewTlsCertificateFromPkcs12, libs, "g_tls_certificate_new_from_pkcs12")
-
-	core.PuregoSafeRegister(&xTlsCertificateGetDnsNames, libs, "g_tls_certificate_get_dns_names")
-	core.PuregoSafeRegister(&xTlsCertificateGetIpAddresses, libs, "g_tls_certificate_get_ip_addresses")
-	core.PuregoSafeRegister(&xTlsCertificateGetIssuer, libs, "g_tls_certificate_get_issuer")
-	core.PuregoSafeRegister(&xTlsCertificateGetIssuerName, libs, "g_tls_certificate_get_issuer_name")
-	core.PuregoSafeRegister(&xTlsCertificateGetNotValidAfter, libs, "g_tls_certificate_get_not_valid_after")
-	core.PuregoSafeRegister(&xTlsCertificateGetNotValidBefore, libs, "g_tls_certificate_get_not_valid_before")
-	core.PuregoSafeRegister(&xTlsCertificateGetSubjectName, libs, "g_tls_certificate_get_subject_name")
-	core.PuregoSafeRegister(&xTlsCertificateIsSame, libs, "g_tls_certificate_is_same")
-	core.PuregoSafeRegister(&xTlsCertificateVerify, libs, "g_tls_certificate_verify")
-
-	core.PuregoSafeRegister(&xTlsCertificateListNewFromFile, libs, "g_tls_certificate_list_new_from_file")
+	gobject.CheckClassSize("GTlsCertificateClass", xTlsCertificateGLibType, unsafe.Sizeof(TlsCertificateClass{}))
+
+	core.PuregoRegisterSince(&xNewTlsCertificateFromFile, libs, "g_tls_certificate_new_from_file", "GIO", "2.28")
+	core.PuregoRegisterSince(&xNewTlsCertificateFromFileWithPassword, libs, "g_tls_certificate_new_from_file_with_password", "GIO", "2.72")
+	core.PuregoRegisterSince(&xNewTlsCertificateFromFiles, libs, "g_tls_certificate_new_from_files", "GIO", "2.28")
+	core.PuregoRegisterSince(&xNewTlsCertificateFromPem, libs, "g_tls_certificate_new_from_pem", "GIO", "2.28")
+	core.PuregoRegisterSince(&xNewTlsCertificateFromPkcs11Uris, libs, "g_tls_certificate_new_from_pkcs11_uris", "GIO", "2.68")
+	core.PuregoRegisterSince(&xNewTlsCertificateFromPkcs12, libs, "g_tls_certificate_new_from_pkcs12", "GIO", "2.72")
+
+	core.PuregoRegisterSince(&xTlsCertificateGetDnsNames, libs, "g_tls_certificate_get_dns_names", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateGetIpAddresses, libs, "g_tls_certificate_get_ip_addresses", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateGetIssuer, libs, "g_tls_certificate_get_issuer", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsCertificateGetIssuerName, libs, "g_tls_certificate_get_issuer_name", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateGetNotValidAfter, libs, "g_tls_certificate_get_not_valid_after", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateGetNotValidBefore, libs, "g_tls_certificate_get_not_valid_before", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateGetSubjectName, libs, "g_tls_certificate_get_subject_name", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsCertificateIsSame, libs, "g_tls_certificate_is_same", "GIO", "2.34")
+	core.PuregoRegisterSince(&xTlsCertificateVerify, libs, "g_tls_certificate_verify", "GIO", "2.28")
+
+	core.PuregoRegisterSince(&xTlsCertificateListNewFromFile, libs, "g_tls_certificate_list_new_from_file", "GIO", "2.28")
 
 }
@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// vtable for a #GTlsClientConnection implementation.
+// vtable for a [TlsClientConnection] implementation.
 type TlsClientConnectionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xCopySessionState uintptr
 }
@@ -26,32 +25,33 @@ func (x *TlsClientConnectionInterface) GoPointer() uintptr {
 }
 
 // OverrideCopySessionState sets the "copy_session_state" callback function.
-// Copies session state from one #GTlsClientConnection to another.
+// Copies session state from one [TlsClientConnection] to another.
 func (x *TlsClientConnectionInterface) OverrideCopySessionState(cb func(TlsClientConnection, TlsClientConnection)) {
 	if cb == nil {
 		x.xCopySessionState = 0
 	} else {
-		x.xCopySessionState = purego.NewCallback(func(ConnVarp uintptr, SourceVarp uintptr) {
+		x.xCopySessionState = core.NewCallback(func(ConnVarp uintptr, SourceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&TlsClientConnectionBase{Ptr: ConnVarp}, &TlsClientConnectionBase{Ptr: SourceVarp})
 		})
 	}
 }
 
 // GetCopySessionState gets the "copy_session_state" callback function.
-// Copies session state from one #GTlsClientConnection to another.
+// Copies session state from one [TlsClientConnection] to another.
 func (x *TlsClientConnectionInterface) GetCopySessionState() func(TlsClientConnection, TlsClientConnection) {
 	if x.xCopySessionState == 0 {
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, SourceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCopySessionState)
+	core.RegisterFunc(&rawCallback, x.xCopySessionState)
 	return func(ConnVar TlsClientConnection, SourceVar TlsClientConnection) {
 		rawCallback(ConnVar.GoPointer(), SourceVar.GoPointer())
 	}
 }
 
 // `GTlsClientConnection` is the client-side subclass of
-// [class@Gio.TlsConnection], representing a client-side TLS connection.
+// [TlsConnection], representing a client-side TLS connection.
 type TlsClientConnection interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -86,21 +86,41 @@ func (x *TlsClientConnectionBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementTlsClientConnection adds the TlsClientConnection interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - CopySessionState(TlsClientConnection)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TlsClientConnectionInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTlsClientConnection[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TlsClientConnectionGLibType(), func(iface *TlsClientConnectionInterface) {
+		if _, ok := any(zero).(interface{ CopySessionState(TlsClientConnection) }); ok {
+			iface.OverrideCopySessionState(func(self TlsClientConnection, arg0 TlsClientConnection) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CopySessionState(TlsClientConnection) })
+				impl.CopySessionState(arg0)
+			})
+		}
+	})
+}
+
 // Possibly copies session state from one connection to another, for use
 // in TLS session resumption. This is not normally needed, but may be
 // used when the same session needs to be used between different
 // endpoints, as is required by some protocols, such as FTP over TLS.
-// @source should have already completed a handshake and, since TLS 1.3,
-// it should have been used to read data at least once. @conn should not
+// source should have already completed a handshake and, since TLS 1.3,
+// it should have been used to read data at least once. conn should not
 // have completed a handshake.
 //
 // It is not possible to know whether a call to this function will
 // actually do anything. Because session resumption is normally used
 // only for performance benefit, the TLS backend might not implement
 // this function. Even if implemented, it may not actually succeed in
-// allowing @conn to resume @source's TLS session, because the server
-// may not have sent a session resumption token to @source, or it may
-// refuse to accept the token from @conn. There is no way to know
+// allowing conn to resume source's TLS session, because the server
+// may not have sent a session resumption token to source, or it may
+// refuse to accept the token from conn. There is no way to know
 // whether a call to this function is actually successful.
 //
 // Using this function is not required to benefit from session
@@ -109,7 +129,7 @@ func (x *TlsClientConnectionBase) SetGoPointer(ptr uintptr) {
 // without weakening the privacy guarantees normally provided by TLS,
 // without need to call this function. For example, with TLS 1.3,
 // a session ticket will be automatically copied from any
-// #GTlsClientConnection that has previously received session tickets
+// [TlsClientConnection] that has previously received session tickets
 // from the server, provided a ticket is available that has not
 // previously been used for session resumption, since session ticket
 // reuse would be a privacy weakness. Using this function causes the
@@ -123,9 +143,9 @@ func (x *TlsClientConnectionBase) CopySessionState(SourceVar TlsClientConnection
 // Gets the list of distinguished names of the Certificate Authorities
 // that the server will accept certificates from. This will be set
 // during the TLS handshake if the server requests a certificate.
-// Otherwise, it will be %NULL.
+// Otherwise, it will be nil.
 //
-// Each item in the list is a #GByteArray which contains the complete
+// Each item in the list is a [glib.ByteArray] which contains the complete
 // subject DN of the certificate authority.
 func (x *TlsClientConnectionBase) GetAcceptedCas() *glib.List {
 
@@ -133,7 +153,7 @@ func (x *TlsClientConnectionBase) GetAcceptedCas() *glib.List {
 	return cret
 }
 
-// Gets @conn's expected server identity
+// Gets conn's expected server identity
 func (x *TlsClientConnectionBase) GetServerIdentity() *SocketConnectableBase {
 	var cls *SocketConnectableBase
 
@@ -149,28 +169,32 @@ func (x *TlsClientConnectionBase) GetServerIdentity() *SocketConnectableBase {
 }
 
 // SSL 3.0 is no longer supported. See
-// g_tls_client_connection_set_use_ssl3() for details.
+// [TlsClientConnection.SetUseSsl3] for details.
+//
+// Deprecated: since 2.56. SSL 3.0 is insecure.
 func (x *TlsClientConnectionBase) GetUseSsl3() bool {
 
 	cret := XGTlsClientConnectionGetUseSsl3(x.GoPointer())
 	return cret
 }
 
-// Gets @conn's validation flags
+// Gets conn's validation flags
 //
 // This function does not work as originally designed and is impossible
-// to use correctly. See #GTlsClientConnection:validation-flags for more
+// to use correctly. See GTlsClientConnection:validation-flags for more
 // information.
+//
+// Deprecated: since 2.72. Do not attempt to ignore validation errors.
 func (x *TlsClientConnectionBase) GetValidationFlags() TlsCertificateFlags {
 
 	cret := XGTlsClientConnectionGetValidationFlags(x.GoPointer())
 	return cret
 }
 
-// Sets @conn's expected server identity, which is used both to tell
+// Sets conn's expected server identity, which is used both to tell
 // servers on virtual hosts which certificate to present, and also
-// to let @conn know what name to look for in the certificate when
-// performing %G_TLS_CERTIFICATE_BAD_IDENTITY validation, if enabled.
+// to let conn know what name to look for in the certificate when
+// performing [GTlsCertificateBadIdentityValue] validation, if enabled.
 func (x *TlsClientConnectionBase) SetServerIdentity(IdentityVar SocketConnectable) {
 
 	XGTlsClientConnectionSetServerIdentity(x.GoPointer(), IdentityVar.GoPointer())
@@ -187,19 +211,23 @@ func (x *TlsClientConnectionBase) SetServerIdentity(IdentityVar SocketConnectabl
 // acceptable.
 //
 // Since GLib 2.64, this function does nothing.
+//
+// Deprecated: since 2.56. SSL 3.0 is insecure.
 func (x *TlsClientConnectionBase) SetUseSsl3(UseSsl3Var bool) {
 
 	XGTlsClientConnectionSetUseSsl3(x.GoPointer(), UseSsl3Var)
 
 }
 
-// Sets @conn's validation flags, to override the default set of
+// Sets conn's validation flags, to override the default set of
 // checks performed when validating a server certificate. By default,
-// %G_TLS_CERTIFICATE_VALIDATE_ALL is used.
+// [GTlsCertificateValidateAllValue] is used.
 //
 // This function does not work as originally designed and is impossible
-// to use correctly. See #GTlsClientConnection:validation-flags for more
+// to use correctly. See GTlsClientConnection:validation-flags for more
 // information.
+//
+// Deprecated: since 2.72. Do not attempt to ignore validation errors.
 func (x *TlsClientConnectionBase) SetValidationFlags(FlagsVar TlsCertificateFlags) {
 
 	XGTlsClientConnectionSetValidationFlags(x.GoPointer(), FlagsVar)
@@ -208,7 +236,9 @@ func (x *TlsClientConnectionBase) SetValidationFlags(FlagsVar TlsCertificateFlag
 
 // SetPropertyUseSsl3 sets the "use-ssl3" property.
 // SSL 3.0 is no longer supported. See
-// g_tls_client_connection_set_use_ssl3() for details.
+// [TlsClientConnection.SetUseSsl3] for details.
+//
+// Deprecated: since 2.56. SSL 3.0 is insecure.
 func (x *TlsClientConnectionBase) SetPropertyUseSsl3(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -220,7 +250,9 @@ func (x *TlsClientConnectionBase) SetPropertyUseSsl3(value bool) {
 
 // GetPropertyUseSsl3 gets the "use-ssl3" property.
 // SSL 3.0 is no longer supported. See
-// g_tls_client_connection_set_use_ssl3() for details.
+// [TlsClientConnection.SetUseSsl3] for details.
+//
+// Deprecated: since 2.56. SSL 3.0 is insecure.
 func (x *TlsClientConnectionBase) GetPropertyUseSsl3() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -240,12 +272,12 @@ var XGTlsClientConnectionSetValidationFlags func(uintptr, TlsCertificateFlags)
 
 var xTlsClientConnectionNew func(uintptr, uintptr, **glib.Error) uintptr
 
-// Creates a new #GTlsClientConnection wrapping @base_io_stream (which
+// Creates a new [TlsClientConnection] wrapping base_io_stream (which
 // must have pollable input and output streams) which is assumed to
-// communicate with the server identified by @server_identity.
+// communicate with the server identified by server_identity.
 //
-// See the documentation for #GTlsConnection:base-io-stream for restrictions
-// on when application code can run operations on the @base_io_stream after
+// See the documentation for GTlsConnection:base-io-stream for restrictions
+// on when application code can run operations on the base_io_stream after
 // this function has returned.
 func TlsClientConnectionNew(BaseIoStreamVar *IOStream, ServerIdentityVar SocketConnectable) (*TlsClientConnectionBase, error) {
 	var cls *TlsClientConnectionBase
@@ -254,12 +286,15 @@ func TlsClientConnectionNew(BaseIoStreamVar *IOStream, ServerIdentityVar SocketC
 	cret := xTlsClientConnectionNew(BaseIoStreamVar.GoPointer(), ServerIdentityVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsClientConnectionBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -270,24 +305,26 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTlsClientConnectionNew, libs, "g_tls_client_connection_new")
+	core.PuregoRegisterSince(&xTlsClientConnectionNew, libs, "g_tls_client_connection_new", "GIO", "2.28")
 
 	core.PuregoSafeRegister(&xTlsClientConnectionGLibType, libs, "g_tls_client_connection_get_type")
 
-	core.PuregoSafeRegister(&XGTlsClientConnectionCopySessionState, libs, "g_tls_client_connection_copy_session_state")
-	core.PuregoSafeRegister(&XGTlsClientConnectionGetAcceptedCas, libs, "g_tls_client_connection_get_accepted_cas")
-	core.PuregoSafeRegister(&XGTlsClientConnectionGetServerIdentity, libs, "g_tls_client_connection_get_server_identity")
-	core.PuregoSafeRegister(&XGTlsClientConnectionGetUseSsl3, libs, "g_tls_client_connection_get_use_ssl3")
-	core.PuregoSafeRegister(&XGTlsClientConnectionGetValidationFlags, libs, "g_tls_client_connection_get_validation_flags")
-	core.PuregoSafeRegister(&XGTlsClientConnectionSetServerIdentity, libs, "g_tls_client_connection_set_server_identity")
-	core.PuregoSafeRegister(&XGTlsClientConnectionSetUseSsl3, libs, "g_tls_client_connection_set_use_ssl3")
-	core.PuregoSafeRegister(&XGTlsClientConnectionSetValidationFlags, libs, "g_tls_client_connection_set_validation_flags")
+	gobject.CheckClassSize("GTlsClientConnectionInterface", xTlsClientConnectionGLibType, unsafe.Sizeof(TlsClientConnectionInterface{}))
+
+	core.PuregoRegisterSince(&XGTlsClientConnectionCopySessionState, libs, "g_tls_client_connection_copy_session_state", "GIO", "2.46")
+	core.PuregoRegisterSince(&XGTlsClientConnectionGetAcceptedCas, libs, "g_tls_client_connection_get_accepted_cas", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionGetServerIdentity, libs, "g_tls_client_connection_get_server_identity", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionGetUseSsl3, libs, "g_tls_client_connection_get_use_ssl3", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionGetValidationFlags, libs, "g_tls_client_connection_get_validation_flags", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionSetServerIdentity, libs, "g_tls_client_connection_set_server_identity", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionSetUseSsl3, libs, "g_tls_client_connection_set_use_ssl3", "GIO", "2.28")
+	core.PuregoRegisterSince(&XGTlsClientConnectionSetValidationFlags, libs, "g_tls_client_connection_set_validation_flags", "GIO", "2.28")
 
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The class structure for the #GTlsConnection type.
+// The class structure for the [TlsConnection] type.
 type TlsConnectionClass struct {
 	_ structs.HostLayout
 
@@ -43,7 +42,8 @@ func (x *TlsConnectionClass) OverrideAcceptCertificate(cb func(*TlsConnection, *
 	if cb == nil {
 		x.xAcceptCertificate = 0
 	} else {
-		x.xAcceptCertificate = purego.NewCallback(func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+		x.xAcceptCertificate = core.NewCallback(func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+			defer core.RecoverPanic()
 			return cb(TlsConnectionNewFromInternalPtr(ConnectionVarp), TlsCertificateNewFromInternalPtr(PeerCertVarp), ErrorsVarp)
 		})
 	}
@@ -56,7 +56,7 @@ func (x *TlsConnectionClass) GetAcceptCertificate() func(*TlsConnection, *TlsCer
 		return nil
 	}
 	var rawCallback func(ConnectionVarp uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool
-	purego.RegisterFunc(&rawCallback, x.xAcceptCertificate)
+	core.RegisterFunc(&rawCallback, x.xAcceptCertificate)
 	return func(ConnectionVar *TlsConnection, PeerCertVar *TlsCertificate, ErrorsVar TlsCertificateFlags) bool {
 		return rawCallback(ConnectionVar.GoPointer(), PeerCertVar.GoPointer(), ErrorsVar)
 	}
@@ -68,7 +68,8 @@ func (x *TlsConnectionClass) OverrideHandshake(cb func(*TlsConnection, *Cancella
 	if cb == nil {
 		x.xHandshake = 0
 	} else {
-		x.xHandshake = purego.NewCallback(func(ConnVarp uintptr, CancellableVarp uintptr) bool {
+		x.xHandshake = core.NewCallback(func(ConnVarp uintptr, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(TlsConnectionNewFromInternalPtr(ConnVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -81,7 +82,7 @@ func (x *TlsConnectionClass) GetHandshake() func(*TlsConnection, *Cancellable) b
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHandshake)
+	core.RegisterFunc(&rawCallback, x.xHandshake)
 	return func(ConnVar *TlsConnection, CancellableVar *Cancellable) bool {
 		return rawCallback(ConnVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -93,7 +94,8 @@ func (x *TlsConnectionClass) OverrideHandshakeAsync(cb func(*TlsConnection, int,
 	if cb == nil {
 		x.xHandshakeAsync = 0
 	} else {
-		x.xHandshakeAsync = purego.NewCallback(func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xHandshakeAsync = core.NewCallback(func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsConnectionNewFromInternalPtr(ConnVarp), IoPriorityVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -106,7 +108,7 @@ func (x *TlsConnectionClass) GetHandshakeAsync() func(*TlsConnection, int, *Canc
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, IoPriorityVarp int, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHandshakeAsync)
+	core.RegisterFunc(&rawCallback, x.xHandshakeAsync)
 	return func(ConnVar *TlsConnection, IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(ConnVar.GoPointer(), IoPriorityVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -118,7 +120,8 @@ func (x *TlsConnectionClass) OverrideHandshakeFinish(cb func(*TlsConnection, Asy
 	if cb == nil {
 		x.xHandshakeFinish = 0
 	} else {
-		x.xHandshakeFinish = purego.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+		x.xHandshakeFinish = core.NewCallback(func(ConnVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(TlsConnectionNewFromInternalPtr(ConnVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -131,7 +134,7 @@ func (x *TlsConnectionClass) GetHandshakeFinish() func(*TlsConnection, AsyncResu
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHandshakeFinish)
+	core.RegisterFunc(&rawCallback, x.xHandshakeFinish)
 	return func(ConnVar *TlsConnection, ResultVar AsyncResult) bool {
 		return rawCallback(ConnVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -143,7 +146,8 @@ func (x *TlsConnectionClass) OverrideGetBindingData(cb func(*TlsConnection, TlsC
 	if cb == nil {
 		x.xGetBindingData = 0
 	} else {
-		x.xGetBindingData = purego.NewCallback(func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool {
+		x.xGetBindingData = core.NewCallback(func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool {
+			defer core.RecoverPanic()
 			return cb(TlsConnectionNewFromInternalPtr(ConnVarp), TypeVarp, DataVarp)
 		})
 	}
@@ -156,7 +160,7 @@ func (x *TlsConnectionClass) GetGetBindingData() func(*TlsConnection, TlsChannel
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr, TypeVarp TlsChannelBindingType, DataVarp []byte) bool
-	purego.RegisterFunc(&rawCallback, x.xGetBindingData)
+	core.RegisterFunc(&rawCallback, x.xGetBindingData)
 	return func(ConnVar *TlsConnection, TypeVar TlsChannelBindingType, DataVar []byte) bool {
 		return rawCallback(ConnVar.GoPointer(), TypeVar, DataVar)
 	}
@@ -168,7 +172,8 @@ func (x *TlsConnectionClass) OverrideGetNegotiatedProtocol(cb func(*TlsConnectio
 	if cb == nil {
 		x.xGetNegotiatedProtocol = 0
 	} else {
-		x.xGetNegotiatedProtocol = purego.NewCallback(func(ConnVarp uintptr) string {
+		x.xGetNegotiatedProtocol = core.NewCallback(func(ConnVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(TlsConnectionNewFromInternalPtr(ConnVarp))
 		})
 	}
@@ -181,7 +186,7 @@ func (x *TlsConnectionClass) GetGetNegotiatedProtocol() func(*TlsConnection) str
 		return nil
 	}
 	var rawCallback func(ConnVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetNegotiatedProtocol)
+	core.RegisterFunc(&rawCallback, x.xGetNegotiatedProtocol)
 	return func(ConnVar *TlsConnection) string {
 		return rawCallback(ConnVar.GoPointer())
 	}
@@ -214,12 +219,12 @@ func TlsErrorQuark() glib.Quark {
 }
 
 // `GTlsConnection` is the base TLS connection class type, which wraps
-// a [class@Gio.IOStream] and provides TLS encryption on top of it. Its
-// subclasses, [iface@Gio.TlsClientConnection] and
-// [iface@Gio.TlsServerConnection], implement client-side and server-side TLS,
+// a [IOStream] and provides TLS encryption on top of it. Its
+// subclasses, [TlsClientConnection] and
+// [TlsServerConnection], implement client-side and server-side TLS,
 // respectively.
 //
-// For DTLS (Datagram TLS) support, see [iface@Gio.DtlsConnection].
+// For DTLS (Datagram TLS) support, see [DtlsConnection].
 type TlsConnection struct {
 	IOStream
 }
@@ -238,8 +243,8 @@ func TlsConnectionNewFromInternalPtr(ptr uintptr) *TlsConnection {
 
 var xTlsConnectionEmitAcceptCertificate func(uintptr, uintptr, TlsCertificateFlags) bool
 
-// Used by #GTlsConnection implementations to emit the
-// #GTlsConnection::accept-certificate signal.
+// Used by [TlsConnection] implementations to emit the
+// [TlsConnection.ConnectAcceptCertificateFunc] signal.
 func (x *TlsConnection) EmitAcceptCertificate(PeerCertVar *TlsCertificate, ErrorsVar TlsCertificateFlags) bool {
 
 	cret := xTlsConnectionEmitAcceptCertificate(x.GoPointer(), PeerCertVar.GoPointer(), ErrorsVar)
@@ -248,8 +253,8 @@ func (x *TlsConnection) EmitAcceptCertificate(PeerCertVar *TlsCertificate, Error
 
 var xTlsConnectionGetCertificate func(uintptr) uintptr
 
-// Gets @conn's certificate, as set by
-// g_tls_connection_set_certificate().
+// Gets conn's certificate, as set by
+// [TlsConnection.SetCertificate].
 func (x *TlsConnection) GetCertificate() *TlsCertificate {
 	var cls *TlsCertificate
 
@@ -266,25 +271,28 @@ func (x *TlsConnection) GetCertificate() *TlsCertificate {
 
 var xTlsConnectionGetChannelBindingData func(uintptr, TlsChannelBindingType, *[]byte, **glib.Error) bool
 
-// Query the TLS backend for TLS channel binding data of @type for @conn.
+// Query the TLS backend for TLS channel binding data of type for conn.
 //
 // This call retrieves TLS channel binding data as specified in RFC
-// [5056](https://tools.ietf.org/html/rfc5056), RFC
-// [5929](https://tools.ietf.org/html/rfc5929), and related RFCs.  The
-// binding data is returned in @data.  The @data is resized by the callee
-// using #GByteArray buffer management and will be freed when the @data
-// is destroyed by g_byte_array_unref(). If @data is %NULL, it will only
-// check whether TLS backend is able to fetch the data (e.g. whether @type
+// [5056], RFC
+// [5929], and related RFCs.  The
+// binding data is returned in data.  The data is resized by the callee
+// using [glib.ByteArray] buffer management and will be freed when the data
+// is destroyed by [glib.ByteArrayUnref]. If data is nil, it will only
+// check whether TLS backend is able to fetch the data (e.g. whether type
 // is supported by the TLS backend). It does not guarantee that the data
 // will be available though.  That could happen if TLS connection does not
-// support @type or the binding data is not available yet due to additional
+// support type or the binding data is not available yet due to additional
 // negotiation or input required.
+//
+// [5056]: https://tools.ietf.org/html/rfc5056
+// [5929]: https://tools.ietf.org/html/rfc5929
 func (x *TlsConnection) GetChannelBindingData(TypeVar TlsChannelBindingType, DataVar *[]byte) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xTlsConnectionGetChannelBindingData(x.GoPointer(), TypeVar, DataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -292,7 +300,7 @@ func (x *TlsConnection) GetChannelBindingData(TypeVar TlsChannelBindingType, Dat
 
 var xTlsConnectionGetCiphersuiteName func(uintptr) string
 
-// Returns the name of the current TLS ciphersuite, or %NULL if the
+// Returns the name of the current TLS ciphersuite, or nil if the
 // connection has not handshaked or has been closed. Beware that the TLS
 // backend may use any of multiple different naming conventions, because
 // OpenSSL and GnuTLS have their own ciphersuite naming conventions that
@@ -308,8 +316,8 @@ func (x *TlsConnection) GetCiphersuiteName() string {
 
 var xTlsConnectionGetDatabase func(uintptr) uintptr
 
-// Gets the certificate database that @conn uses to verify
-// peer certificates. See g_tls_connection_set_database().
+// Gets the certificate database that conn uses to verify
+// peer certificates. See [TlsConnection.SetDatabase].
 func (x *TlsConnection) GetDatabase() *TlsDatabase {
 	var cls *TlsDatabase
 
@@ -327,7 +335,7 @@ func (x *TlsConnection) GetDatabase() *TlsDatabase {
 var xTlsConnectionGetInteraction func(uintptr) uintptr
 
 // Get the object that will be used to interact with the user. It will be used
-// for things like prompting the user for passwords. If %NULL is returned, then
+// for things like prompting the user for passwords. If nil is returned, then
 // no user interaction will occur for this connection.
 func (x *TlsConnection) GetInteraction() *TlsInteraction {
 	var cls *TlsInteraction
@@ -349,9 +357,9 @@ var xTlsConnectionGetNegotiatedProtocol func(uintptr) string
 // the handshake.
 //
 // If the peer did not use the ALPN extension, or did not advertise a
-// protocol that matched one of @conn's protocols, or the TLS backend
-// does not support ALPN, then this will be %NULL. See
-// g_tls_connection_set_advertised_protocols().
+// protocol that matched one of conn's protocols, or the TLS backend
+// does not support ALPN, then this will be nil. See
+// [TlsConnection.SetAdvertisedProtocols].
 func (x *TlsConnection) GetNegotiatedProtocol() string {
 
 	cret := xTlsConnectionGetNegotiatedProtocol(x.GoPointer())
@@ -360,9 +368,9 @@ func (x *TlsConnection) GetNegotiatedProtocol() string {
 
 var xTlsConnectionGetPeerCertificate func(uintptr) uintptr
 
-// Gets @conn's peer's certificate after the handshake has completed
+// Gets conn's peer's certificate after the handshake has completed
 // or failed. (It is not set during the emission of
-// #GTlsConnection::accept-certificate.)
+// [TlsConnection.ConnectAcceptCertificateFunc].)
 func (x *TlsConnection) GetPeerCertificate() *TlsCertificate {
 	var cls *TlsCertificate
 
@@ -379,11 +387,11 @@ func (x *TlsConnection) GetPeerCertificate() *TlsCertificate {
 
 var xTlsConnectionGetPeerCertificateErrors func(uintptr) TlsCertificateFlags
 
-// Gets the errors associated with validating @conn's peer's
+// Gets the errors associated with validating conn's peer's
 // certificate, after the handshake has completed or failed. (It is
-// not set during the emission of #GTlsConnection::accept-certificate.)
+// not set during the emission of [TlsConnection.ConnectAcceptCertificateFunc].)
 //
-// See #GTlsConnection:peer-certificate-errors for more information.
+// See GTlsConnection:peer-certificate-errors for more information.
 func (x *TlsConnection) GetPeerCertificateErrors() TlsCertificateFlags {
 
 	cret := xTlsConnectionGetPeerCertificateErrors(x.GoPointer())
@@ -393,58 +401,38 @@ func (x *TlsConnection) GetPeerCertificateErrors() TlsCertificateFlags {
 var xTlsConnectionGetProtocolVersion func(uintptr) TlsProtocolVersion
 
 // Returns the current TLS protocol version, which may be
-// %G_TLS_PROTOCOL_VERSION_UNKNOWN if the connection has not handshaked, or
+// [GTlsProtocolVersionUnknownValue] if the connection has not handshaked, or
 // has been closed, or if the TLS backend has implemented a protocol version
-// that is not a recognized #GTlsProtocolVersion.
+// that is not a recognized [TlsProtocolVersion].
 func (x *TlsConnection) GetProtocolVersion() TlsProtocolVersion {
 
 	cret := xTlsConnectionGetProtocolVersion(x.GoPointer())
 	return cret
 }
 
-var xTlsConnectionGetRehandshakeMode func(uintptr) TlsRehandshakeMode
-
-// Gets @conn rehandshaking mode. See
-// g_tls_connection_set_rehandshake_mode() for details.
-func (x *TlsConnection) GetRehandshakeMode() TlsRehandshakeMode {
-
-	cret := xTlsConnectionGetRehandshakeMode(x.GoPointer())
-	return cret
-}
-
 var xTlsConnectionGetRequireCloseNotify func(uintptr) bool
 
-// Tests whether or not @conn expects a proper TLS close notification
+// Tests whether or not conn expects a proper TLS close notification
 // when the connection is closed. See
-// g_tls_connection_set_require_close_notify() for details.
+// [TlsConnection.SetRequireCloseNotify] for details.
 func (x *TlsConnection) GetRequireCloseNotify() bool {
 
 	cret := xTlsConnectionGetRequireCloseNotify(x.GoPointer())
 	return cret
 }
 
-var xTlsConnectionGetUseSystemCertdb func(uintptr) bool
-
-// Gets whether @conn uses the system certificate database to verify
-// peer certificates. See g_tls_connection_set_use_system_certdb().
-func (x *TlsConnection) GetUseSystemCertdb() bool {
-
-	cret := xTlsConnectionGetUseSystemCertdb(x.GoPointer())
-	return cret
-}
-
 var xTlsConnectionHandshake func(uintptr, uintptr, **glib.Error) bool
 
-// Attempts a TLS handshake on @conn.
+// Attempts a TLS handshake on conn.
 //
 // On the client side, it is never necessary to call this method;
 // although the connection needs to perform a handshake after
 // connecting (or after sending a "STARTTLS"-type command),
-// #GTlsConnection will handle this for you automatically when you try
+// [TlsConnection] will handle this for you automatically when you try
 // to send or receive data on the connection. You can call
-// g_tls_connection_handshake() manually if you want to know whether
+// [TlsConnection.Handshake] manually if you want to know whether
 // the initial handshake succeeded or failed (as opposed to just
-// immediately trying to use @conn to read or write, in which case,
+// immediately trying to use conn to read or write, in which case,
 // if it fails, it may not be possible to tell if it failed before or
 // after completing the handshake), but beware that servers may reject
 // client authentication after the handshake has completed, so a
@@ -454,24 +442,24 @@ var xTlsConnectionHandshake func(uintptr, uintptr, **glib.Error) bool
 // the beginning of the communication, you do not need to call this
 // function explicitly unless you want clearer error reporting.
 //
-// Previously, calling g_tls_connection_handshake() after the initial
+// Previously, calling [TlsConnection.Handshake] after the initial
 // handshake would trigger a rehandshake; however, this usage was
 // deprecated in GLib 2.60 because rehandshaking was removed from the
 // TLS protocol in TLS 1.3. Since GLib 2.64, calling this function after
 // the initial handshake will no longer do anything.
 //
-// When using a #GTlsConnection created by #GSocketClient, the
-// #GSocketClient performs the initial handshake, so calling this
+// When using a [TlsConnection] created by [SocketClient], the
+// [SocketClient] performs the initial handshake, so calling this
 // function manually is not recommended.
 //
-// #GTlsConnection::accept_certificate may be emitted during the
+// GTlsConnection::accept_certificate may be emitted during the
 // handshake.
 func (x *TlsConnection) Handshake(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xTlsConnectionHandshake(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -479,8 +467,8 @@ func (x *TlsConnection) Handshake(CancellableVar *Cancellable) (bool, error) {
 
 var xTlsConnectionHandshakeAsync func(uintptr, int, uintptr, uintptr, uintptr)
 
-// Asynchronously performs a TLS handshake on @conn. See
-// g_tls_connection_handshake() for more information.
+// Asynchronously performs a TLS handshake on conn. See
+// [TlsConnection.Handshake] for more information.
 func (x *TlsConnection) HandshakeAsync(IoPriorityVar int, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -490,11 +478,12 @@ func (x *TlsConnection) HandshakeAsync(IoPriorityVar int, CancellableVar *Cancel
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -505,13 +494,13 @@ func (x *TlsConnection) HandshakeAsync(IoPriorityVar int, CancellableVar *Cancel
 var xTlsConnectionHandshakeFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Finish an asynchronous TLS handshake operation. See
-// g_tls_connection_handshake() for more information.
+// [TlsConnection.Handshake] for more information.
 func (x *TlsConnection) HandshakeFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xTlsConnectionHandshakeFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -523,12 +512,14 @@ var xTlsConnectionSetAdvertisedProtocols func(uintptr, []string)
 // caller is willing to speak on this connection. The
 // Application-Layer Protocol Negotiation (ALPN) extension will be
 // used to negotiate a compatible protocol with the peer; use
-// g_tls_connection_get_negotiated_protocol() to find the negotiated
-// protocol after the handshake.  Specifying %NULL for the the value
-// of @protocols will disable ALPN negotiation.
+// [TlsConnection.GetNegotiatedProtocol] to find the negotiated
+// protocol after the handshake.  Specifying nil for the the value
+// of protocols will disable ALPN negotiation.
 //
-// See [IANA TLS ALPN Protocol IDs](https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids)
+// See [IANA TLS ALPN Protocol IDs]
 // for a list of registered protocol IDs.
+//
+// [IANA TLS ALPN Protocol IDs]: https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids
 func (x *TlsConnection) SetAdvertisedProtocols(ProtocolsVar []string) {
 
 	xTlsConnectionSetAdvertisedProtocols(x.GoPointer(), ProtocolsVar)
@@ -537,24 +528,24 @@ func (x *TlsConnection) SetAdvertisedProtocols(ProtocolsVar []string) {
 
 var xTlsConnectionSetCertificate func(uintptr, uintptr)
 
-// This sets the certificate that @conn will present to its peer
-// during the TLS handshake. For a #GTlsServerConnection, it is
+// This sets the certificate that conn will present to its peer
+// during the TLS handshake. For a [TlsServerConnection], it is
 // mandatory to set this, and that will normally be done at construct
 // time.
 //
-// For a #GTlsClientConnection, this is optional. If a handshake fails
-// with %G_TLS_ERROR_CERTIFICATE_REQUIRED, that means that the server
+// For a [TlsClientConnection], this is optional. If a handshake fails
+// with [GTlsErrorCertificateRequiredValue], that means that the server
 // requires a certificate, and if you try connecting again, you should
 // call this method first. You can call
-// g_tls_client_connection_get_accepted_cas() on the failed connection
+// [TlsClientConnection.GetAcceptedCas] on the failed connection
 // to get a list of Certificate Authorities that the server will
 // accept certificates from.
 //
 // (It is also possible that a server will allow the connection with
 // or without a certificate; in that case, if you don't provide a
 // certificate, you can tell that the server requested one by the fact
-// that g_tls_client_connection_get_accepted_cas() will return
-// non-%NULL.)
+// that [TlsClientConnection.GetAcceptedCas] will return
+// non-nil.)
 func (x *TlsConnection) SetCertificate(CertificateVar *TlsCertificate) {
 
 	xTlsConnectionSetCertificate(x.GoPointer(), CertificateVar.GoPointer())
@@ -565,15 +556,15 @@ var xTlsConnectionSetDatabase func(uintptr, uintptr)
 
 // Sets the certificate database that is used to verify peer certificates.
 // This is set to the default database by default. See
-// g_tls_backend_get_default_database(). If set to %NULL, then
+// [TlsBackend.GetDefaultDatabase]. If set to nil, then
 // peer certificate validation will always set the
-// %G_TLS_CERTIFICATE_UNKNOWN_CA error (meaning
-// #GTlsConnection::accept-certificate will always be emitted on
+// [GTlsCertificateUnknownCaValue] error (meaning
+// [TlsConnection.ConnectAcceptCertificateFunc] will always be emitted on
 // client-side connections, unless that bit is not set in
-// #GTlsClientConnection:validation-flags).
+// GTlsClientConnection:validation-flags).
 //
 // There are nonintuitive security implications when using a non-default
-// database. See #GTlsConnection:database for details.
+// database. See GTlsConnection:database for details.
 func (x *TlsConnection) SetDatabase(DatabaseVar *TlsDatabase) {
 
 	xTlsConnectionSetDatabase(x.GoPointer(), DatabaseVar.GoPointer())
@@ -585,8 +576,8 @@ var xTlsConnectionSetInteraction func(uintptr, uintptr)
 // Set the object that will be used to interact with the user. It will be used
 // for things like prompting the user for passwords.
 //
-// The @interaction argument will normally be a derived subclass of
-// #GTlsInteraction. %NULL can also be provided if no user interaction
+// The interaction argument will normally be a derived subclass of
+// [TlsInteraction]. nil can also be provided if no user interaction
 // should occur for this connection.
 func (x *TlsConnection) SetInteraction(InteractionVar *TlsInteraction) {
 
@@ -594,25 +585,13 @@ func (x *TlsConnection) SetInteraction(InteractionVar *TlsInteraction) {
 
 }
 
-var xTlsConnectionSetRehandshakeMode func(uintptr, TlsRehandshakeMode)
-
-// Since GLib 2.64, changing the rehandshake mode is no longer supported
-// and will have no effect. With TLS 1.3, rehandshaking has been removed from
-// the TLS protocol, replaced by separate post-handshake authentication and
-// rekey operations.
-func (x *TlsConnection) SetRehandshakeMode(ModeVar TlsRehandshakeMode) {
-
-	xTlsConnectionSetRehandshakeMode(x.GoPointer(), ModeVar)
-
-}
-
 var xTlsConnectionSetRequireCloseNotify func(uintptr, bool)
 
-// Sets whether or not @conn expects a proper TLS close notification
-// before the connection is closed. If this is %TRUE (the default),
-// then @conn will expect to receive a TLS close notification from its
+// Sets whether or not conn expects a proper TLS close notification
+// before the connection is closed. If this is true (the default),
+// then conn will expect to receive a TLS close notification from its
 // peer before the connection is closed, and will return a
-// %G_TLS_ERROR_EOF error if the connection is closed without proper
+// [GTlsErrorEofValue] error if the connection is closed without proper
 // notification (since this may indicate a network error, or
 // man-in-the-middle attack).
 //
@@ -622,40 +601,25 @@ var xTlsConnectionSetRequireCloseNotify func(uintptr, bool)
 // somehow self-delimiting); in this case, the close notify is
 // redundant and sometimes omitted. (TLS 1.1 explicitly allows this;
 // in TLS 1.0 it is technically an error, but often done anyway.) You
-// can use g_tls_connection_set_require_close_notify() to tell @conn
+// can use [TlsConnection.SetRequireCloseNotify] to tell conn
 // to allow an "unannounced" connection close, in which case the close
 // will show up as a 0-length read, as in a non-TLS
-// #GSocketConnection, and it is up to the application to check that
+// [SocketConnection], and it is up to the application to check that
 // the data has been fully received.
 //
 // Note that this only affects the behavior when the peer closes the
-// connection; when the application calls g_io_stream_close() itself
-// on @conn, this will send a close notification regardless of the
+// connection; when the application calls [IOStream.Close] itself
+// on conn, this will send a close notification regardless of the
 // setting of this property. If you explicitly want to do an unclean
-// close, you can close @conn's #GTlsConnection:base-io-stream rather
-// than closing @conn itself, but note that this may only be done when no other
-// operations are pending on @conn or the base I/O stream.
+// close, you can close conn's GTlsConnection:base-io-stream rather
+// than closing conn itself, but note that this may only be done when no other
+// operations are pending on conn or the base I/O stream.
 func (x *TlsConnection) SetRequireCloseNotify(RequireCloseNotifyVar bool) {
 
 	xTlsConnectionSetRequireCloseNotify(x.GoPointer(), RequireCloseNotifyVar)
 
 }
 
-var xTlsConnectionSetUseSystemCertdb func(uintptr, bool)
-
-// Sets whether @conn uses the system certificate database to verify
-// peer certificates. This is %TRUE by default. If set to %FALSE, then
-// peer certificate validation will always set the
-// %G_TLS_CERTIFICATE_UNKNOWN_CA error (meaning
-// #GTlsConnection::accept-certificate will always be emitted on
-// client-side connections, unless that bit is not set in
-// #GTlsClientConnection:validation-flags).
-func (x *TlsConnection) SetUseSystemCertdb(UseSystemCertdbVar bool) {
-
-	xTlsConnectionSetUseSystemCertdb(x.GoPointer(), UseSystemCertdbVar)
-
-}
-
 func (c *TlsConnection) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -670,7 +634,7 @@ func (c *TlsConnection) SetGoPointer(ptr uintptr) {
 // SetPropertyAdvertisedProtocols sets the "advertised-protocols" property.
 // The list of application-layer protocols that the connection
 // advertises that it is willing to speak. See
-// g_tls_connection_set_advertised_protocols().
+// [TlsConnection.SetAdvertisedProtocols].
 func (x *TlsConnection) SetPropertyAdvertisedProtocols(value []string) {
 	var v gobject.Value
 	v.Init(glib.StrvGetType())
@@ -681,7 +645,7 @@ func (x *TlsConnection) SetPropertyAdvertisedProtocols(value []string) {
 // GetPropertyAdvertisedProtocols gets the "advertised-protocols" property.
 // The list of application-layer protocols that the connection
 // advertises that it is willing to speak. See
-// g_tls_connection_set_advertised_protocols().
+// [TlsConnection.SetAdvertisedProtocols].
 func (x *TlsConnection) GetPropertyAdvertisedProtocols() []string {
 	var v gobject.Value
 	x.GetProperty("advertised-protocols", &v)
@@ -689,7 +653,7 @@ func (x *TlsConnection) GetPropertyAdvertisedProtocols() []string {
 }
 
 // GetPropertyCiphersuiteName gets the "ciphersuite-name" property.
-// The name of the TLS ciphersuite in use. See g_tls_connection_get_ciphersuite_name().
+// The name of the TLS ciphersuite in use. See [TlsConnection.GetCiphersuiteName].
 func (x *TlsConnection) GetPropertyCiphersuiteName() string {
 	var v gobject.Value
 	x.GetProperty("ciphersuite-name", &v)
@@ -698,7 +662,7 @@ func (x *TlsConnection) GetPropertyCiphersuiteName() string {
 
 // GetPropertyNegotiatedProtocol gets the "negotiated-protocol" property.
 // The application-layer protocol negotiated during the TLS
-// handshake. See g_tls_connection_get_negotiated_protocol().
+// handshake. See [TlsConnection.GetNegotiatedProtocol].
 func (x *TlsConnection) GetPropertyNegotiatedProtocol() string {
 	var v gobject.Value
 	x.GetProperty("negotiated-protocol", &v)
@@ -707,7 +671,7 @@ func (x *TlsConnection) GetPropertyNegotiatedProtocol() string {
 
 // SetPropertyRequireCloseNotify sets the "require-close-notify" property.
 // Whether or not proper TLS close notification is required.
-// See g_tls_connection_set_require_close_notify().
+// See [TlsConnection.SetRequireCloseNotify].
 func (x *TlsConnection) SetPropertyRequireCloseNotify(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -717,7 +681,7 @@ func (x *TlsConnection) SetPropertyRequireCloseNotify(value bool) {
 
 // GetPropertyRequireCloseNotify gets the "require-close-notify" property.
 // Whether or not proper TLS close notification is required.
-// See g_tls_connection_set_require_close_notify().
+// See [TlsConnection.SetRequireCloseNotify].
 func (x *TlsConnection) GetPropertyRequireCloseNotify() bool {
 	var v gobject.Value
 	x.GetProperty("require-close-notify", &v)
@@ -727,7 +691,9 @@ func (x *TlsConnection) GetPropertyRequireCloseNotify() bool {
 // SetPropertyUseSystemCertdb sets the "use-system-certdb" property.
 // Whether or not the system certificate database will be used to
 // verify peer certificates. See
-// g_tls_connection_set_use_system_certdb().
+// [TlsConnection.SetUseSystemCertdb].
+//
+// Deprecated: since 2.30. Use GTlsConnection:database instead
 func (x *TlsConnection) SetPropertyUseSystemCertdb(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -738,7 +704,9 @@ func (x *TlsConnection) SetPropertyUseSystemCertdb(value bool) {
 // GetPropertyUseSystemCertdb gets the "use-system-certdb" property.
 // Whether or not the system certificate database will be used to
 // verify peer certificates. See
-// g_tls_connection_set_use_system_certdb().
+// [TlsConnection.SetUseSystemCertdb].
+//
+// Deprecated: since 2.30. Use GTlsConnection:database instead
 func (x *TlsConnection) GetPropertyUseSystemCertdb() bool {
 	var v gobject.Value
 	x.GetProperty("use-system-certdb", &v)
@@ -746,68 +714,90 @@ func (x *TlsConnection) GetPropertyUseSystemCertdb() bool {
 }
 
 // Emitted during the TLS handshake after the peer certificate has
-// been received. You can examine @peer_cert's certification path by
-// calling g_tls_certificate_get_issuer() on it.
+// been received. You can examine peer_cert's certification path by
+// calling [TlsCertificate.GetIssuer] on it.
 //
-// For a client-side connection, @peer_cert is the server's
+// For a client-side connection, peer_cert is the server's
 // certificate, and the signal will only be emitted if the
-// certificate was not acceptable according to @conn's
-// #GTlsClientConnection:validation_flags. If you would like the
-// certificate to be accepted despite @errors, return %TRUE from the
+// certificate was not acceptable according to conn's
+// GTlsClientConnection:validation_flags. If you would like the
+// certificate to be accepted despite errors, return true from the
 // signal handler. Otherwise, if no handler accepts the certificate,
-// the handshake will fail with %G_TLS_ERROR_BAD_CERTIFICATE.
+// the handshake will fail with [GTlsErrorBadCertificateValue].
 //
 // GLib guarantees that if certificate verification fails, this signal
-// will be emitted with at least one error will be set in @errors, but
+// will be emitted with at least one error will be set in errors, but
 // it does not guarantee that all possible errors will be set.
 // Accordingly, you may not safely decide to ignore any particular
 // type of error. For example, it would be incorrect to ignore
-// %G_TLS_CERTIFICATE_EXPIRED if you want to allow expired
+// [GTlsCertificateExpiredValue] if you want to allow expired
 // certificates, because this could potentially be the only error flag
 // set even if other problems exist with the certificate.
 //
-// For a server-side connection, @peer_cert is the certificate
+// For a server-side connection, peer_cert is the certificate
 // presented by the client, if this was requested via the server's
-// #GTlsServerConnection:authentication_mode. On the server side,
+// GTlsServerConnection:authentication_mode. On the server side,
 // the signal is always emitted when the client presents a
 // certificate, and the certificate will only be accepted if a
-// handler returns %TRUE.
+// handler returns true.
 //
 // Note that if this signal is emitted as part of asynchronous I/O
 // in the main thread, then you should not attempt to interact with
 // the user before returning from the signal handler. If you want to
 // let the user decide whether or not to accept the certificate, you
-// would have to return %FALSE from the signal handler on the first
+// would have to return false from the signal handler on the first
 // attempt, and then after the connection attempt returns a
-// %G_TLS_ERROR_BAD_CERTIFICATE, you can interact with the user, and
+// [GTlsErrorBadCertificateValue], you can interact with the user, and
 // if the user decides to accept the certificate, remember that fact,
-// create a new connection, and return %TRUE from the signal handler
+// create a new connection, and return true from the signal handler
 // the next time.
 //
 // If you are doing I/O in another thread, you do not
 // need to worry about this, and can simply block in the signal
 // handler until the UI thread returns an answer.
+//
+// Deprecated: use ConnectAcceptCertificateFunc, which also accepts method values and closures.
 func (x *TlsConnection) ConnectAcceptCertificate(cb *func(TlsConnection, uintptr, TlsCertificateFlags) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accept-certificate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+			defer core.RecoverPanic()
+			fa := TlsConnection{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PeerCertVarp, ErrorsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accept-certificate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags) bool {
+var xTlsConnectionAcceptCertificateTrampoline uintptr
+
+func xTlsConnectionAcceptCertificateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PeerCertVarp uintptr, ErrorsVarp TlsCertificateFlags, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TlsConnection, uintptr, TlsCertificateFlags) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TlsConnection{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PeerCertVarp, ErrorsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accept-certificate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAcceptCertificateFunc connects cb to the "accept-certificate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TlsConnection) ConnectAcceptCertificateFunc(cb func(TlsConnection, uintptr, TlsCertificateFlags) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accept-certificate", &xTlsConnectionAcceptCertificateTrampoline, xTlsConnectionAcceptCertificateNewTrampoline, cb)
 }
 
 func init() {
@@ -815,40 +805,38 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTlsChannelBindingErrorQuark, libs, "g_tls_channel_binding_error_quark")
-	core.PuregoSafeRegister(&xTlsErrorQuark, libs, "g_tls_error_quark")
+	core.PuregoRegisterSince(&xTlsChannelBindingErrorQuark, libs, "g_tls_channel_binding_error_quark", "GIO", "2.66")
+	core.PuregoRegisterSince(&xTlsErrorQuark, libs, "g_tls_error_quark", "GIO", "2.28")
 
 	core.PuregoSafeRegister(&xTlsConnectionGLibType, libs, "g_tls_connection_get_type")
 
-	core.PuregoSafeRegister(&xTlsConnectionEmitAcceptCertificate, libs, "g_tls_connection_emit_accept_certificate")
-	core.PuregoSafeRegister(&xTlsConnectionGetCertificate, libs, "g_tls_connection_get_certificate")
-	core.PuregoSafeRegister(&xTlsConnectionGetChannelBindingData, libs, "g_tls_connection_get_channel_binding_data")
-	core.PuregoSafeRegister(&xTlsConnectionGetCiphersuiteName, libs, "g_tls_connection_get_ciphersuite_name")
-	core.PuregoSafeRegister(&xTlsConnectionGetDatabase, libs, "g_tls_connection_get_database")
-	core.PuregoSafeRegister(&xTlsConnectionGetInteraction, libs, "g_tls_connection_get_interaction")
-	core.PuregoSafeRegister(&xTlsConnectionGetNegotiatedProtocol, libs, "g_tls_connection_get_negotiated_protocol")
-	core.PuregoSafeRegister(&xTlsConnectionGetPeerCertificate, libs, "g_tls_connection_get_peer_certificate")
-	core.PuregoSafeRegister(&xTlsConnectionGetPeerCertificateErrors, libs, "g_tls_connection_get_peer_certificate_errors")
-	core.PuregoSafeRegister(&xTlsConnectionGetProtocolVersion, libs, "g_tls_connection_get_protocol_version")
-	core.PuregoSafeRegister(&xTlsConnectionGetRehandshakeMode, libs, "g_tls_connection_get_rehandshake_mode")
-	core.PuregoSafeRegister(&xTlsConnectionGetRequireCloseNotify, libs, "g_tls_connection_get_require_close_notify")
-	core.PuregoSafeRegister(&xTlsConnectionGetUseSystemCertdb, libs, "g_tls_connection_get_use_system_certdb")
-	core.PuregoSafeRegister(&xTlsConnectionHandshake, libs, "g_tls_connection_handshake")
-	core.PuregoSafeRegister(&xTlsConnectionHandshakeAsync, libs, "g_tls_connection_handshake_async")
-	core.PuregoSafeRegister(&xTlsConnectionHandshakeFinish, libs, "g_tls_connection_handshake_finish")
-	core.PuregoSafeRegister(&xTlsConnectionSetAdvertisedProtocols, libs, "g_tls_connection_set_advertised_protocols")
-	core.PuregoSafeRegister(&xTlsConnectionSetCertificate, libs, "g_tls_connection_set_certificate")
-	core.PuregoSafeRegister(&xTlsConnectionSetDatabase, libs, "g_tls_connection_set_database")
-	core.PuregoSafeRegister(&xTlsConnectionSetInteraction, libs, "g_tls_connection_set_interaction")
-	core.PuregoSafeRegister(&xTlsConnectionSetRehandshakeMode, libs, "g_tls_connection_set_rehandshake_mode")
-	core.PuregoSafeRegister(&xTlsConnectionSetRequireCloseNotify, libs, "g_tls_connection_set_require_close_notify")
-	core.PuregoSafeRegister(&xTlsConnectionSetUseSystemCertdb, libs, "g_tls_connection_set_use_system_certdb")
+	gobject.CheckClassSize("GTlsConnectionClass", xTlsConnectionGLibType, unsafe.Sizeof(TlsConnectionClass{}))
+
+	core.PuregoRegisterSince(&xTlsConnectionEmitAcceptCertificate, libs, "g_tls_connection_emit_accept_certificate", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionGetCertificate, libs, "g_tls_connection_get_certificate", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionGetChannelBindingData, libs, "g_tls_connection_get_channel_binding_data", "GIO", "2.66")
+	core.PuregoRegisterSince(&xTlsConnectionGetCiphersuiteName, libs, "g_tls_connection_get_ciphersuite_name", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsConnectionGetDatabase, libs, "g_tls_connection_get_database", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsConnectionGetInteraction, libs, "g_tls_connection_get_interaction", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsConnectionGetNegotiatedProtocol, libs, "g_tls_connection_get_negotiated_protocol", "GIO", "2.60")
+	core.PuregoRegisterSince(&xTlsConnectionGetPeerCertificate, libs, "g_tls_connection_get_peer_certificate", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionGetPeerCertificateErrors, libs, "g_tls_connection_get_peer_certificate_errors", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionGetProtocolVersion, libs, "g_tls_connection_get_protocol_version", "GIO", "2.70")
+	core.PuregoRegisterSince(&xTlsConnectionGetRequireCloseNotify, libs, "g_tls_connection_get_require_close_notify", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionHandshake, libs, "g_tls_connection_handshake", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionHandshakeAsync, libs, "g_tls_connection_handshake_async", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionHandshakeFinish, libs, "g_tls_connection_handshake_finish", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionSetAdvertisedProtocols, libs, "g_tls_connection_set_advertised_protocols", "GIO", "2.60")
+	core.PuregoRegisterSince(&xTlsConnectionSetCertificate, libs, "g_tls_connection_set_certificate", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionSetDatabase, libs, "g_tls_connection_set_database", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsConnectionSetInteraction, libs, "g_tls_connection_set_interaction", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsConnectionSetRequireCloseNotify, libs, "g_tls_connection_set_require_close_notify", "GIO", "2.28")
 
 }
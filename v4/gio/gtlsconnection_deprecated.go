@@ -0,0 +1,88 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTlsConnectionGetRehandshakeMode func(uintptr) TlsRehandshakeMode
+
+// Gets conn rehandshaking mode. See
+// [TlsConnection.SetRehandshakeMode] for details.
+//
+// Deprecated: since 2.60.. Changing the rehandshake mode is no longer
+//
+//	required for compatibility. Also, rehandshaking has been removed
+//	from the TLS protocol in TLS 1.3.
+func (x *TlsConnection) GetRehandshakeMode() TlsRehandshakeMode {
+
+	cret := xTlsConnectionGetRehandshakeMode(x.GoPointer())
+	return cret
+}
+
+var xTlsConnectionGetUseSystemCertdb func(uintptr) bool
+
+// Gets whether conn uses the system certificate database to verify
+// peer certificates. See [TlsConnection.SetUseSystemCertdb].
+//
+// Deprecated: since 2.30. Use [TlsConnection.GetDatabase] instead
+func (x *TlsConnection) GetUseSystemCertdb() bool {
+
+	cret := xTlsConnectionGetUseSystemCertdb(x.GoPointer())
+	return cret
+}
+
+var xTlsConnectionSetRehandshakeMode func(uintptr, TlsRehandshakeMode)
+
+// Since GLib 2.64, changing the rehandshake mode is no longer supported
+// and will have no effect. With TLS 1.3, rehandshaking has been removed from
+// the TLS protocol, replaced by separate post-handshake authentication and
+// rekey operations.
+//
+// Deprecated: since 2.60.. Changing the rehandshake mode is no longer
+//
+//	required for compatibility. Also, rehandshaking has been removed
+//	from the TLS protocol in TLS 1.3.
+func (x *TlsConnection) SetRehandshakeMode(ModeVar TlsRehandshakeMode) {
+
+	xTlsConnectionSetRehandshakeMode(x.GoPointer(), ModeVar)
+
+}
+
+var xTlsConnectionSetUseSystemCertdb func(uintptr, bool)
+
+// Sets whether conn uses the system certificate database to verify
+// peer certificates. This is true by default. If set to false, then
+// peer certificate validation will always set the
+// [GTlsCertificateUnknownCaValue] error (meaning
+// [TlsConnection.ConnectAcceptCertificateFunc] will always be emitted on
+// client-side connections, unless that bit is not set in
+// GTlsClientConnection:validation-flags).
+//
+// Deprecated: since 2.30. Use [TlsConnection.SetDatabase] instead
+func (x *TlsConnection) SetUseSystemCertdb(UseSystemCertdbVar bool) {
+
+	xTlsConnectionSetUseSystemCertdb(x.GoPointer(), UseSystemCertdbVar)
+
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTlsConnectionGetRehandshakeMode, libs, "g_tls_connection_get_rehandshake_mode", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionGetUseSystemCertdb, libs, "g_tls_connection_get_use_system_certdb", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionSetRehandshakeMode, libs, "g_tls_connection_set_rehandshake_mode", "GIO", "2.28")
+	core.PuregoRegisterSince(&xTlsConnectionSetUseSystemCertdb, libs, "g_tls_connection_set_use_system_certdb", "GIO", "2.28")
+
+}
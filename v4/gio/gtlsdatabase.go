@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The class for #GTlsDatabase. Derived classes should implement the various
+// The class for [TlsDatabase]. Derived classes should implement the various
 // virtual methods. _async and _finish methods have a default
 // implementation that runs the corresponding sync method in a thread.
 type TlsDatabaseClass struct {
@@ -56,12 +55,13 @@ func (x *TlsDatabaseClass) GoPointer() uintptr {
 // OverrideVerifyChain sets the "verify_chain" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain().
+//	[TlsDatabase.VerifyChain].
 func (x *TlsDatabaseClass) OverrideVerifyChain(cb func(*TlsDatabase, *TlsCertificate, string, SocketConnectable, *TlsInteraction, TlsDatabaseVerifyFlags, *Cancellable) TlsCertificateFlags) {
 	if cb == nil {
 		x.xVerifyChain = 0
 	} else {
-		x.xVerifyChain = purego.NewCallback(func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr) TlsCertificateFlags {
+		x.xVerifyChain = core.NewCallback(func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr) TlsCertificateFlags {
+			defer core.RecoverPanic()
 			return cb(TlsDatabaseNewFromInternalPtr(SelfVarp), TlsCertificateNewFromInternalPtr(ChainVarp), PurposeVarp, &SocketConnectableBase{Ptr: IdentityVarp}, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -70,13 +70,13 @@ func (x *TlsDatabaseClass) OverrideVerifyChain(cb func(*TlsDatabase, *TlsCertifi
 // GetVerifyChain gets the "verify_chain" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain().
+//	[TlsDatabase.VerifyChain].
 func (x *TlsDatabaseClass) GetVerifyChain() func(*TlsDatabase, *TlsCertificate, string, SocketConnectable, *TlsInteraction, TlsDatabaseVerifyFlags, *Cancellable) TlsCertificateFlags {
 	if x.xVerifyChain == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr) TlsCertificateFlags
-	purego.RegisterFunc(&rawCallback, x.xVerifyChain)
+	core.RegisterFunc(&rawCallback, x.xVerifyChain)
 	return func(SelfVar *TlsDatabase, ChainVar *TlsCertificate, PurposeVar string, IdentityVar SocketConnectable, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseVerifyFlags, CancellableVar *Cancellable) TlsCertificateFlags {
 		return rawCallback(SelfVar.GoPointer(), ChainVar.GoPointer(), PurposeVar, IdentityVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -85,12 +85,13 @@ func (x *TlsDatabaseClass) GetVerifyChain() func(*TlsDatabase, *TlsCertificate,
 // OverrideVerifyChainAsync sets the "verify_chain_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain_async().
+//	[TlsDatabase.VerifyChainAsync].
 func (x *TlsDatabaseClass) OverrideVerifyChainAsync(cb func(*TlsDatabase, *TlsCertificate, string, SocketConnectable, *TlsInteraction, TlsDatabaseVerifyFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xVerifyChainAsync = 0
 	} else {
-		x.xVerifyChainAsync = purego.NewCallback(func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xVerifyChainAsync = core.NewCallback(func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsDatabaseNewFromInternalPtr(SelfVarp), TlsCertificateNewFromInternalPtr(ChainVarp), PurposeVarp, &SocketConnectableBase{Ptr: IdentityVarp}, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -99,13 +100,13 @@ func (x *TlsDatabaseClass) OverrideVerifyChainAsync(cb func(*TlsDatabase, *TlsCe
 // GetVerifyChainAsync gets the "verify_chain_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain_async().
+//	[TlsDatabase.VerifyChainAsync].
 func (x *TlsDatabaseClass) GetVerifyChainAsync() func(*TlsDatabase, *TlsCertificate, string, SocketConnectable, *TlsInteraction, TlsDatabaseVerifyFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xVerifyChainAsync == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ChainVarp uintptr, PurposeVarp string, IdentityVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseVerifyFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xVerifyChainAsync)
+	core.RegisterFunc(&rawCallback, x.xVerifyChainAsync)
 	return func(SelfVar *TlsDatabase, ChainVar *TlsCertificate, PurposeVar string, IdentityVar SocketConnectable, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseVerifyFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SelfVar.GoPointer(), ChainVar.GoPointer(), PurposeVar, IdentityVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -114,12 +115,13 @@ func (x *TlsDatabaseClass) GetVerifyChainAsync() func(*TlsDatabase, *TlsCertific
 // OverrideVerifyChainFinish sets the "verify_chain_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain_finish().
+//	[TlsDatabase.VerifyChainFinish].
 func (x *TlsDatabaseClass) OverrideVerifyChainFinish(cb func(*TlsDatabase, AsyncResult) TlsCertificateFlags) {
 	if cb == nil {
 		x.xVerifyChainFinish = 0
 	} else {
-		x.xVerifyChainFinish = purego.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) TlsCertificateFlags {
+		x.xVerifyChainFinish = core.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) TlsCertificateFlags {
+			defer core.RecoverPanic()
 			return cb(TlsDatabaseNewFromInternalPtr(SelfVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -128,13 +130,13 @@ func (x *TlsDatabaseClass) OverrideVerifyChainFinish(cb func(*TlsDatabase, Async
 // GetVerifyChainFinish gets the "verify_chain_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_verify_chain_finish().
+//	[TlsDatabase.VerifyChainFinish].
 func (x *TlsDatabaseClass) GetVerifyChainFinish() func(*TlsDatabase, AsyncResult) TlsCertificateFlags {
 	if x.xVerifyChainFinish == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResultVarp uintptr) TlsCertificateFlags
-	purego.RegisterFunc(&rawCallback, x.xVerifyChainFinish)
+	core.RegisterFunc(&rawCallback, x.xVerifyChainFinish)
 	return func(SelfVar *TlsDatabase, ResultVar AsyncResult) TlsCertificateFlags {
 		return rawCallback(SelfVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -143,12 +145,13 @@ func (x *TlsDatabaseClass) GetVerifyChainFinish() func(*TlsDatabase, AsyncResult
 // OverrideCreateCertificateHandle sets the "create_certificate_handle" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_create_certificate_handle().
+//	[TlsDatabase.CreateCertificateHandle].
 func (x *TlsDatabaseClass) OverrideCreateCertificateHandle(cb func(*TlsDatabase, *TlsCertificate) string) {
 	if cb == nil {
 		x.xCreateCertificateHandle = 0
 	} else {
-		x.xCreateCertificateHandle = purego.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr) string {
+		x.xCreateCertificateHandle = core.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(TlsDatabaseNewFromInternalPtr(SelfVarp), TlsCertificateNewFromInternalPtr(CertificateVarp))
 		})
 	}
@@ -157,13 +160,13 @@ func (x *TlsDatabaseClass) OverrideCreateCertificateHandle(cb func(*TlsDatabase,
 // GetCreateCertificateHandle gets the "create_certificate_handle" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_create_certificate_handle().
+//	[TlsDatabase.CreateCertificateHandle].
 func (x *TlsDatabaseClass) GetCreateCertificateHandle() func(*TlsDatabase, *TlsCertificate) string {
 	if x.xCreateCertificateHandle == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, CertificateVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xCreateCertificateHandle)
+	core.RegisterFunc(&rawCallback, x.xCreateCertificateHandle)
 	return func(SelfVar *TlsDatabase, CertificateVar *TlsCertificate) string {
 		return rawCallback(SelfVar.GoPointer(), CertificateVar.GoPointer())
 	}
@@ -172,12 +175,13 @@ func (x *TlsDatabaseClass) GetCreateCertificateHandle() func(*TlsDatabase, *TlsC
 // OverrideLookupCertificateForHandle sets the "lookup_certificate_for_handle" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle().
+//	[TlsDatabase.LookupCertificateForHandle].
 func (x *TlsDatabaseClass) OverrideLookupCertificateForHandle(cb func(*TlsDatabase, string, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *TlsCertificate) {
 	if cb == nil {
 		x.xLookupCertificateForHandle = 0
 	} else {
-		x.xLookupCertificateForHandle = purego.NewCallback(func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr {
+		x.xLookupCertificateForHandle = core.NewCallback(func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TlsDatabaseNewFromInternalPtr(SelfVarp), HandleVarp, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -190,13 +194,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateForHandle(cb func(*TlsDataba
 // GetLookupCertificateForHandle gets the "lookup_certificate_for_handle" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle().
+//	[TlsDatabase.LookupCertificateForHandle].
 func (x *TlsDatabaseClass) GetLookupCertificateForHandle() func(*TlsDatabase, string, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *TlsCertificate {
 	if x.xLookupCertificateForHandle == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateForHandle)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateForHandle)
 	return func(SelfVar *TlsDatabase, HandleVar string, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) *TlsCertificate {
 		rawRet := rawCallback(SelfVar.GoPointer(), HandleVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -211,12 +215,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateForHandle() func(*TlsDatabase, st
 // OverrideLookupCertificateForHandleAsync sets the "lookup_certificate_for_handle_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle_async().
+//	[TlsDatabase.LookupCertificateForHandleAsync].
 func (x *TlsDatabaseClass) OverrideLookupCertificateForHandleAsync(cb func(*TlsDatabase, string, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xLookupCertificateForHandleAsync = 0
 	} else {
-		x.xLookupCertificateForHandleAsync = purego.NewCallback(func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupCertificateForHandleAsync = core.NewCallback(func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsDatabaseNewFromInternalPtr(SelfVarp), HandleVarp, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -225,13 +230,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateForHandleAsync(cb func(*TlsD
 // GetLookupCertificateForHandleAsync gets the "lookup_certificate_for_handle_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle_async().
+//	[TlsDatabase.LookupCertificateForHandleAsync].
 func (x *TlsDatabaseClass) GetLookupCertificateForHandleAsync() func(*TlsDatabase, string, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xLookupCertificateForHandleAsync == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, HandleVarp string, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateForHandleAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateForHandleAsync)
 	return func(SelfVar *TlsDatabase, HandleVar string, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SelfVar.GoPointer(), HandleVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -240,12 +245,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateForHandleAsync() func(*TlsDatabas
 // OverrideLookupCertificateForHandleFinish sets the "lookup_certificate_for_handle_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle_finish().
+//	[TlsDatabase.LookupCertificateForHandleFinish].
 func (x *TlsDatabaseClass) OverrideLookupCertificateForHandleFinish(cb func(*TlsDatabase, AsyncResult) *TlsCertificate) {
 	if cb == nil {
 		x.xLookupCertificateForHandleFinish = 0
 	} else {
-		x.xLookupCertificateForHandleFinish = purego.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xLookupCertificateForHandleFinish = core.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TlsDatabaseNewFromInternalPtr(SelfVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -258,13 +264,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateForHandleFinish(cb func(*Tls
 // GetLookupCertificateForHandleFinish gets the "lookup_certificate_for_handle_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_for_handle_finish().
+//	[TlsDatabase.LookupCertificateForHandleFinish].
 func (x *TlsDatabaseClass) GetLookupCertificateForHandleFinish() func(*TlsDatabase, AsyncResult) *TlsCertificate {
 	if x.xLookupCertificateForHandleFinish == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateForHandleFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateForHandleFinish)
 	return func(SelfVar *TlsDatabase, ResultVar AsyncResult) *TlsCertificate {
 		rawRet := rawCallback(SelfVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -279,12 +285,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateForHandleFinish() func(*TlsDataba
 // OverrideLookupCertificateIssuer sets the "lookup_certificate_issuer" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer().
+//	[TlsDatabase.LookupCertificateIssuer].
 func (x *TlsDatabaseClass) OverrideLookupCertificateIssuer(cb func(*TlsDatabase, *TlsCertificate, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *TlsCertificate) {
 	if cb == nil {
 		x.xLookupCertificateIssuer = 0
 	} else {
-		x.xLookupCertificateIssuer = purego.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr {
+		x.xLookupCertificateIssuer = core.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TlsDatabaseNewFromInternalPtr(SelfVarp), TlsCertificateNewFromInternalPtr(CertificateVarp), TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 			if ret == nil {
 				return 0
@@ -297,13 +304,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateIssuer(cb func(*TlsDatabase,
 // GetLookupCertificateIssuer gets the "lookup_certificate_issuer" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer().
+//	[TlsDatabase.LookupCertificateIssuer].
 func (x *TlsDatabaseClass) GetLookupCertificateIssuer() func(*TlsDatabase, *TlsCertificate, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *TlsCertificate {
 	if x.xLookupCertificateIssuer == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateIssuer)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateIssuer)
 	return func(SelfVar *TlsDatabase, CertificateVar *TlsCertificate, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) *TlsCertificate {
 		rawRet := rawCallback(SelfVar.GoPointer(), CertificateVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 		if rawRet == 0 {
@@ -318,12 +325,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateIssuer() func(*TlsDatabase, *TlsC
 // OverrideLookupCertificateIssuerAsync sets the "lookup_certificate_issuer_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer_async().
+//	[TlsDatabase.LookupCertificateIssuerAsync].
 func (x *TlsDatabaseClass) OverrideLookupCertificateIssuerAsync(cb func(*TlsDatabase, *TlsCertificate, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xLookupCertificateIssuerAsync = 0
 	} else {
-		x.xLookupCertificateIssuerAsync = purego.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupCertificateIssuerAsync = core.NewCallback(func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsDatabaseNewFromInternalPtr(SelfVarp), TlsCertificateNewFromInternalPtr(CertificateVarp), TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -332,13 +340,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateIssuerAsync(cb func(*TlsData
 // GetLookupCertificateIssuerAsync gets the "lookup_certificate_issuer_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer_async().
+//	[TlsDatabase.LookupCertificateIssuerAsync].
 func (x *TlsDatabaseClass) GetLookupCertificateIssuerAsync() func(*TlsDatabase, *TlsCertificate, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xLookupCertificateIssuerAsync == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, CertificateVarp uintptr, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateIssuerAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateIssuerAsync)
 	return func(SelfVar *TlsDatabase, CertificateVar *TlsCertificate, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SelfVar.GoPointer(), CertificateVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -347,12 +355,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateIssuerAsync() func(*TlsDatabase,
 // OverrideLookupCertificateIssuerFinish sets the "lookup_certificate_issuer_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer_finish().
+//	[TlsDatabase.LookupCertificateIssuerFinish].
 func (x *TlsDatabaseClass) OverrideLookupCertificateIssuerFinish(cb func(*TlsDatabase, AsyncResult) *TlsCertificate) {
 	if cb == nil {
 		x.xLookupCertificateIssuerFinish = 0
 	} else {
-		x.xLookupCertificateIssuerFinish = purego.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) uintptr {
+		x.xLookupCertificateIssuerFinish = core.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TlsDatabaseNewFromInternalPtr(SelfVarp), &AsyncResultBase{Ptr: ResultVarp})
 			if ret == nil {
 				return 0
@@ -365,13 +374,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificateIssuerFinish(cb func(*TlsDat
 // GetLookupCertificateIssuerFinish gets the "lookup_certificate_issuer_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificate_issuer_finish().
+//	[TlsDatabase.LookupCertificateIssuerFinish].
 func (x *TlsDatabaseClass) GetLookupCertificateIssuerFinish() func(*TlsDatabase, AsyncResult) *TlsCertificate {
 	if x.xLookupCertificateIssuerFinish == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResultVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificateIssuerFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificateIssuerFinish)
 	return func(SelfVar *TlsDatabase, ResultVar AsyncResult) *TlsCertificate {
 		rawRet := rawCallback(SelfVar.GoPointer(), ResultVar.GoPointer())
 		if rawRet == 0 {
@@ -386,12 +395,13 @@ func (x *TlsDatabaseClass) GetLookupCertificateIssuerFinish() func(*TlsDatabase,
 // OverrideLookupCertificatesIssuedBy sets the "lookup_certificates_issued_by" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by().
+//	[TlsDatabase.LookupCertificatesIssuedBy].
 func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedBy(cb func(*TlsDatabase, []byte, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *glib.List) {
 	if cb == nil {
 		x.xLookupCertificatesIssuedBy = 0
 	} else {
-		x.xLookupCertificatesIssuedBy = purego.NewCallback(func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) *glib.List {
+		x.xLookupCertificatesIssuedBy = core.NewCallback(func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(TlsDatabaseNewFromInternalPtr(SelfVarp), IssuerRawDnVarp, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -400,13 +410,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedBy(cb func(*TlsDataba
 // GetLookupCertificatesIssuedBy gets the "lookup_certificates_issued_by" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by().
+//	[TlsDatabase.LookupCertificatesIssuedBy].
 func (x *TlsDatabaseClass) GetLookupCertificatesIssuedBy() func(*TlsDatabase, []byte, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable) *glib.List {
 	if x.xLookupCertificatesIssuedBy == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedBy)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedBy)
 	return func(SelfVar *TlsDatabase, IssuerRawDnVar []byte, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) *glib.List {
 		return rawCallback(SelfVar.GoPointer(), IssuerRawDnVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -415,12 +425,13 @@ func (x *TlsDatabaseClass) GetLookupCertificatesIssuedBy() func(*TlsDatabase, []
 // OverrideLookupCertificatesIssuedByAsync sets the "lookup_certificates_issued_by_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by_async().
+//	[TlsDatabase.LookupCertificatesIssuedByAsync].
 func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedByAsync(cb func(*TlsDatabase, []byte, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xLookupCertificatesIssuedByAsync = 0
 	} else {
-		x.xLookupCertificatesIssuedByAsync = purego.NewCallback(func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xLookupCertificatesIssuedByAsync = core.NewCallback(func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsDatabaseNewFromInternalPtr(SelfVarp), IssuerRawDnVarp, TlsInteractionNewFromInternalPtr(InteractionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -429,13 +440,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedByAsync(cb func(*TlsD
 // GetLookupCertificatesIssuedByAsync gets the "lookup_certificates_issued_by_async" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by_async().
+//	[TlsDatabase.LookupCertificatesIssuedByAsync].
 func (x *TlsDatabaseClass) GetLookupCertificatesIssuedByAsync() func(*TlsDatabase, []byte, *TlsInteraction, TlsDatabaseLookupFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xLookupCertificatesIssuedByAsync == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, IssuerRawDnVarp []byte, InteractionVarp uintptr, FlagsVarp TlsDatabaseLookupFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedByAsync)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedByAsync)
 	return func(SelfVar *TlsDatabase, IssuerRawDnVar []byte, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(SelfVar.GoPointer(), IssuerRawDnVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -444,12 +455,13 @@ func (x *TlsDatabaseClass) GetLookupCertificatesIssuedByAsync() func(*TlsDatabas
 // OverrideLookupCertificatesIssuedByFinish sets the "lookup_certificates_issued_by_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by_finish().
+//	[TlsDatabase.LookupCertificatesIssuedByFinish].
 func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedByFinish(cb func(*TlsDatabase, AsyncResult) *glib.List) {
 	if cb == nil {
 		x.xLookupCertificatesIssuedByFinish = 0
 	} else {
-		x.xLookupCertificatesIssuedByFinish = purego.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) *glib.List {
+		x.xLookupCertificatesIssuedByFinish = core.NewCallback(func(SelfVarp uintptr, ResultVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(TlsDatabaseNewFromInternalPtr(SelfVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -458,13 +470,13 @@ func (x *TlsDatabaseClass) OverrideLookupCertificatesIssuedByFinish(cb func(*Tls
 // GetLookupCertificatesIssuedByFinish gets the "lookup_certificates_issued_by_finish" callback function.
 // Virtual method implementing
 //
-//	g_tls_database_lookup_certificates_issued_by_finish().
+//	[TlsDatabase.LookupCertificatesIssuedByFinish].
 func (x *TlsDatabaseClass) GetLookupCertificatesIssuedByFinish() func(*TlsDatabase, AsyncResult) *glib.List {
 	if x.xLookupCertificatesIssuedByFinish == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResultVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedByFinish)
+	core.RegisterFunc(&rawCallback, x.xLookupCertificatesIssuedByFinish)
 	return func(SelfVar *TlsDatabase, ResultVar AsyncResult) *glib.List {
 		return rawCallback(SelfVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -495,7 +507,7 @@ const (
 // All implementations are required to be fully thread-safe.
 //
 // Most common client applications will not directly interact with
-// `GTlsDatabase`. It is used internally by [class@Gio.TlsConnection].
+// `GTlsDatabase`. It is used internally by [TlsConnection].
 type TlsDatabase struct {
 	gobject.Object
 }
@@ -516,7 +528,7 @@ var xTlsDatabaseCreateCertificateHandle func(uintptr, uintptr) string
 
 // Create a handle string for the certificate. The database will only be able
 // to create a handle for certificates that originate from the database. In
-// cases where the database cannot create a handle for a certificate, %NULL
+// cases where the database cannot create a handle for a certificate, nil
 // will be returned.
 //
 // This handle should be stable across various instances of the application,
@@ -533,14 +545,14 @@ var xTlsDatabaseLookupCertificateForHandle func(uintptr, string, uintptr, TlsDat
 // Look up a certificate by its handle.
 //
 // The handle should have been created by calling
-// g_tls_database_create_certificate_handle() on a #GTlsDatabase object of
+// [TlsDatabase.CreateCertificateHandle] on a [TlsDatabase] object of
 // the same TLS backend. The handle is designed to remain valid across
 // instantiations of the database.
 //
 // If the handle is no longer valid, or does not point to a certificate in
-// this database, then %NULL will be returned.
+// this database, then nil will be returned.
 //
-// This function can block, use g_tls_database_lookup_certificate_for_handle_async() to perform
+// This function can block, use [TlsDatabase.LookupCertificateForHandleAsync] to perform
 // the lookup operation asynchronously.
 func (x *TlsDatabase) LookupCertificateForHandle(HandleVar string, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) (*TlsCertificate, error) {
 	var cls *TlsCertificate
@@ -549,12 +561,15 @@ func (x *TlsDatabase) LookupCertificateForHandle(HandleVar string, InteractionVa
 	cret := xTlsDatabaseLookupCertificateForHandle(x.GoPointer(), HandleVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -563,7 +578,7 @@ func (x *TlsDatabase) LookupCertificateForHandle(HandleVar string, InteractionVa
 var xTlsDatabaseLookupCertificateForHandleAsync func(uintptr, string, uintptr, TlsDatabaseLookupFlags, uintptr, uintptr, uintptr)
 
 // Asynchronously look up a certificate by its handle in the database. See
-// g_tls_database_lookup_certificate_for_handle() for more information.
+// [TlsDatabase.LookupCertificateForHandle] for more information.
 func (x *TlsDatabase) LookupCertificateForHandleAsync(HandleVar string, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -573,11 +588,12 @@ func (x *TlsDatabase) LookupCertificateForHandleAsync(HandleVar string, Interact
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -588,10 +604,10 @@ func (x *TlsDatabase) LookupCertificateForHandleAsync(HandleVar string, Interact
 var xTlsDatabaseLookupCertificateForHandleFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finish an asynchronous lookup of a certificate by its handle. See
-// g_tls_database_lookup_certificate_for_handle() for more information.
+// [TlsDatabase.LookupCertificateForHandle] for more information.
 //
 // If the handle is no longer valid, or does not point to a certificate in
-// this database, then %NULL will be returned.
+// this database, then nil will be returned.
 func (x *TlsDatabase) LookupCertificateForHandleFinish(ResultVar AsyncResult) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -599,12 +615,15 @@ func (x *TlsDatabase) LookupCertificateForHandleFinish(ResultVar AsyncResult) (*
 	cret := xTlsDatabaseLookupCertificateForHandleFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -612,18 +631,18 @@ func (x *TlsDatabase) LookupCertificateForHandleFinish(ResultVar AsyncResult) (*
 
 var xTlsDatabaseLookupCertificateIssuer func(uintptr, uintptr, uintptr, TlsDatabaseLookupFlags, uintptr, **glib.Error) uintptr
 
-// Look up the issuer of @certificate in the database. The
-// #GTlsCertificate:issuer property of @certificate is not modified, and
+// Look up the issuer of certificate in the database. The
+// GTlsCertificate:issuer property of certificate is not modified, and
 // the two certificates are not hooked into a chain.
 //
-// This function can block. Use g_tls_database_lookup_certificate_issuer_async()
+// This function can block. Use [TlsDatabase.LookupCertificateIssuerAsync]
 // to perform the lookup operation asynchronously.
 //
 // Beware this function cannot be used to build certification paths. The
 // issuer certificate returned by this function may not be the same as
 // the certificate that would actually be used to construct a valid
 // certification path during certificate verification.
-// [RFC 4158](https://datatracker.ietf.org/doc/html/rfc4158) explains
+// [RFC 4158] explains
 // why an issuer certificate cannot be naively assumed to be part of the
 // the certification path (though GLib's TLS backends may not follow the
 // path building strategies outlined in this RFC). Due to the complexity
@@ -632,6 +651,8 @@ var xTlsDatabaseLookupCertificateIssuer func(uintptr, uintptr, uintptr, TlsDatab
 // certificate. Accordingly, this function cannot be used to make
 // security-related decisions. Only GLib itself should make security
 // decisions about TLS certificates.
+//
+// [RFC 4158]: https://datatracker.ietf.org/doc/html/rfc4158
 func (x *TlsDatabase) LookupCertificateIssuer(CertificateVar *TlsCertificate, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -639,12 +660,15 @@ func (x *TlsDatabase) LookupCertificateIssuer(CertificateVar *TlsCertificate, In
 	cret := xTlsDatabaseLookupCertificateIssuer(x.GoPointer(), CertificateVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -652,8 +676,8 @@ func (x *TlsDatabase) LookupCertificateIssuer(CertificateVar *TlsCertificate, In
 
 var xTlsDatabaseLookupCertificateIssuerAsync func(uintptr, uintptr, uintptr, TlsDatabaseLookupFlags, uintptr, uintptr, uintptr)
 
-// Asynchronously look up the issuer of @certificate in the database. See
-// g_tls_database_lookup_certificate_issuer() for more information.
+// Asynchronously look up the issuer of certificate in the database. See
+// [TlsDatabase.LookupCertificateIssuer] for more information.
 func (x *TlsDatabase) LookupCertificateIssuerAsync(CertificateVar *TlsCertificate, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -663,11 +687,12 @@ func (x *TlsDatabase) LookupCertificateIssuerAsync(CertificateVar *TlsCertificat
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -678,7 +703,7 @@ func (x *TlsDatabase) LookupCertificateIssuerAsync(CertificateVar *TlsCertificat
 var xTlsDatabaseLookupCertificateIssuerFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finish an asynchronous lookup issuer operation. See
-// g_tls_database_lookup_certificate_issuer() for more information.
+// [TlsDatabase.LookupCertificateIssuer] for more information.
 func (x *TlsDatabase) LookupCertificateIssuerFinish(ResultVar AsyncResult) (*TlsCertificate, error) {
 	var cls *TlsCertificate
 	var cerr *glib.Error
@@ -686,12 +711,15 @@ func (x *TlsDatabase) LookupCertificateIssuerFinish(ResultVar AsyncResult) (*Tls
 	cret := xTlsDatabaseLookupCertificateIssuerFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsCertificate{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -701,14 +729,14 @@ var xTlsDatabaseLookupCertificatesIssuedBy func(uintptr, []byte, uintptr, TlsDat
 
 // Look up certificates issued by this issuer in the database.
 //
-// This function can block, use g_tls_database_lookup_certificates_issued_by_async() to perform
+// This function can block, use [TlsDatabase.LookupCertificatesIssuedByAsync] to perform
 // the lookup operation asynchronously.
 func (x *TlsDatabase) LookupCertificatesIssuedBy(IssuerRawDnVar []byte, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseLookupFlags, CancellableVar *Cancellable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xTlsDatabaseLookupCertificatesIssuedBy(x.GoPointer(), IssuerRawDnVar, InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -717,7 +745,7 @@ func (x *TlsDatabase) LookupCertificatesIssuedBy(IssuerRawDnVar []byte, Interact
 var xTlsDatabaseLookupCertificatesIssuedByAsync func(uintptr, []byte, uintptr, TlsDatabaseLookupFlags, uintptr, uintptr, uintptr)
 
 // Asynchronously look up certificates issued by this issuer in the database. See
-// g_tls_database_lookup_certificates_issued_by() for more information.
+// [TlsDatabase.LookupCertificatesIssuedBy] for more information.
 //
 // The database may choose to hold a reference to the issuer byte array for the duration
 // of this asynchronous operation. The byte array should not be modified during
@@ -731,11 +759,12 @@ func (x *TlsDatabase) LookupCertificatesIssuedByAsync(IssuerRawDnVar []byte, Int
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -746,13 +775,13 @@ func (x *TlsDatabase) LookupCertificatesIssuedByAsync(IssuerRawDnVar []byte, Int
 var xTlsDatabaseLookupCertificatesIssuedByFinish func(uintptr, uintptr, **glib.Error) *glib.List
 
 // Finish an asynchronous lookup of certificates. See
-// g_tls_database_lookup_certificates_issued_by() for more information.
+// [TlsDatabase.LookupCertificatesIssuedBy] for more information.
 func (x *TlsDatabase) LookupCertificatesIssuedByFinish(ResultVar AsyncResult) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xTlsDatabaseLookupCertificatesIssuedByFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -763,69 +792,69 @@ var xTlsDatabaseVerifyChain func(uintptr, uintptr, string, uintptr, uintptr, Tls
 // Determines the validity of a certificate chain, outside the context
 // of a TLS session.
 //
-// @chain is a chain of #GTlsCertificate objects each pointing to the next
-// certificate in the chain by its #GTlsCertificate:issuer property.
+// chain is a chain of [TlsCertificate] objects each pointing to the next
+// certificate in the chain by its GTlsCertificate:issuer property.
 //
-// @purpose describes the purpose (or usage) for which the certificate
-// is being used. Typically @purpose will be set to %G_TLS_DATABASE_PURPOSE_AUTHENTICATE_SERVER
+// purpose describes the purpose (or usage) for which the certificate
+// is being used. Typically purpose will be set to [TLS_DATABASE_PURPOSE_AUTHENTICATE_SERVER]
 // which means that the certificate is being used to authenticate a server
 // (and we are acting as the client).
 //
-// The @identity is used to ensure the server certificate is valid for
+// The identity is used to ensure the server certificate is valid for
 // the expected peer identity. If the identity does not match the
-// certificate, %G_TLS_CERTIFICATE_BAD_IDENTITY will be set in the
-// return value. If @identity is %NULL, that bit will never be set in
+// certificate, [GTlsCertificateBadIdentityValue] will be set in the
+// return value. If identity is nil, that bit will never be set in
 // the return value. The peer identity may also be used to check for
 // pinned certificates (trust exceptions) in the database. These may
 // override the normal verification process on a host-by-host basis.
 //
-// Currently there are no @flags, and %G_TLS_DATABASE_VERIFY_NONE should be
+// Currently there are no flags, and [GTlsDatabaseVerifyNoneValue] should be
 // used.
 //
-// If @chain is found to be valid, then the return value will be 0. If
-// @chain is found to be invalid, then the return value will indicate at
+// If chain is found to be valid, then the return value will be 0. If
+// chain is found to be invalid, then the return value will indicate at
 // least one problem found. If the function is unable to determine
-// whether @chain is valid (for example, because @cancellable is
+// whether chain is valid (for example, because cancellable is
 // triggered before it completes) then the return value will be
-// %G_TLS_CERTIFICATE_GENERIC_ERROR and @error will be set accordingly.
-// @error is not set when @chain is successfully analyzed but found to
+// [GTlsCertificateGenericErrorValue] and error will be set accordingly.
+// error is not set when chain is successfully analyzed but found to
 // be invalid.
 //
 // GLib guarantees that if certificate verification fails, at least one
 // error will be set in the return value, but it does not guarantee
 // that all possible errors will be set. Accordingly, you may not safely
 // decide to ignore any particular type of error. For example, it would
-// be incorrect to mask %G_TLS_CERTIFICATE_EXPIRED if you want to allow
+// be incorrect to mask [GTlsCertificateExpiredValue] if you want to allow
 // expired certificates, because this could potentially be the only
 // error flag set even if other problems exist with the certificate.
 //
-// Prior to GLib 2.48, GLib's default TLS backend modified @chain to
-// represent the certification path built by #GTlsDatabase during
-// certificate verification by adjusting the #GTlsCertificate:issuer
-// property of each certificate in @chain. Since GLib 2.48, this no
-// longer occurs, so you cannot rely on #GTlsCertificate:issuer to
+// Prior to GLib 2.48, GLib's default TLS backend modified chain to
+// represent the certification path built by [TlsDatabase] during
+// certificate verification by adjusting the GTlsCertificate:issuer
+// property of each certificate in chain. Since GLib 2.48, this no
+// longer occurs, so you cannot rely on GTlsCertificate:issuer to
 // represent the actual certification path used during certificate
 // verification.
 //
-// Because TLS session context is not used, #GTlsDatabase may not
-// perform as many checks on the certificates as #GTlsConnection would.
+// Because TLS session context is not used, [TlsDatabase] may not
+// perform as many checks on the certificates as [TlsConnection] would.
 // For example, certificate constraints may not be honored, and
 // revocation checks may not be performed. The best way to verify TLS
-// certificates used by a TLS connection is to let #GTlsConnection
+// certificates used by a TLS connection is to let [TlsConnection]
 // handle the verification.
 //
 // The TLS backend may attempt to look up and add missing certificates
 // to the chain. This may involve HTTP requests to download missing
 // certificates.
 //
-// This function can block. Use g_tls_database_verify_chain_async() to
+// This function can block. Use [TlsDatabase.VerifyChainAsync] to
 // perform the verification operation asynchronously.
 func (x *TlsDatabase) VerifyChain(ChainVar *TlsCertificate, PurposeVar string, IdentityVar SocketConnectable, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseVerifyFlags, CancellableVar *Cancellable) (TlsCertificateFlags, error) {
 	var cerr *glib.Error
 
 	cret := xTlsDatabaseVerifyChain(x.GoPointer(), ChainVar.GoPointer(), PurposeVar, IdentityVar.GoPointer(), InteractionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -835,7 +864,7 @@ var xTlsDatabaseVerifyChainAsync func(uintptr, uintptr, string, uintptr, uintptr
 
 // Asynchronously determines the validity of a certificate chain after
 // looking up and adding any missing certificates to the chain. See
-// g_tls_database_verify_chain() for more information.
+// [TlsDatabase.VerifyChain] for more information.
 func (x *TlsDatabase) VerifyChainAsync(ChainVar *TlsCertificate, PurposeVar string, IdentityVar SocketConnectable, InteractionVar *TlsInteraction, FlagsVar TlsDatabaseVerifyFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -845,11 +874,12 @@ func (x *TlsDatabase) VerifyChainAsync(ChainVar *TlsCertificate, PurposeVar stri
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -860,22 +890,22 @@ func (x *TlsDatabase) VerifyChainAsync(ChainVar *TlsCertificate, PurposeVar stri
 var xTlsDatabaseVerifyChainFinish func(uintptr, uintptr, **glib.Error) TlsCertificateFlags
 
 // Finish an asynchronous verify chain operation. See
-// g_tls_database_verify_chain() for more information.
+// [TlsDatabase.VerifyChain] for more information.
 //
-// If @chain is found to be valid, then the return value will be 0. If
-// @chain is found to be invalid, then the return value will indicate
+// If chain is found to be valid, then the return value will be 0. If
+// chain is found to be invalid, then the return value will indicate
 // the problems found. If the function is unable to determine whether
-// @chain is valid or not (eg, because @cancellable is triggered
+// chain is valid or not (eg, because cancellable is triggered
 // before it completes) then the return value will be
-// %G_TLS_CERTIFICATE_GENERIC_ERROR and @error will be set
-// accordingly. @error is not set when @chain is successfully analyzed
+// [GTlsCertificateGenericErrorValue] and error will be set
+// accordingly. error is not set when chain is successfully analyzed
 // but found to be invalid.
 func (x *TlsDatabase) VerifyChainFinish(ResultVar AsyncResult) (TlsCertificateFlags, error) {
 	var cerr *glib.Error
 
 	cret := xTlsDatabaseVerifyChainFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -897,7 +927,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -906,18 +936,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xTlsDatabaseGLibType, libs, "g_tls_database_get_type")
 
-	core.PuregoSafeRegister(&xTlsDatabaseCreateCertificateHandle, libs, "g_tls_database_create_certificate_handle")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateForHandle, libs, "g_tls_database_lookup_certificate_for_handle")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateForHandleAsync, libs, "g_tls_database_lookup_certificate_for_handle_async")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateForHandleFinish, libs, "g_tls_database_lookup_certificate_for_handle_finish")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateIssuer, libs, "g_tls_database_lookup_certificate_issuer")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateIssuerAsync, libs, "g_tls_database_lookup_certificate_issuer_async")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificateIssuerFinish, libs, "g_tls_database_lookup_certificate_issuer_finish")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificatesIssuedBy, libs, "g_tls_database_lookup_certificates_issued_by")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificatesIssuedByAsync, libs, "g_tls_database_lookup_certificates_issued_by_async")
-	core.PuregoSafeRegister(&xTlsDatabaseLookupCertificatesIssuedByFinish, libs, "g_tls_database_lookup_certificates_issued_by_finish")
-	core.PuregoSafeRegister(&xTlsDatabaseVerifyChain, libs, "g_tls_database_verify_chain")
-	core.PuregoSafeRegister(&xTlsDatabaseVerifyChainAsync, libs, "g_tls_database_verify_chain_async")
-	core.PuregoSafeRegister(&xTlsDatabaseVerifyChainFinish, libs, "g_tls_database_verify_chain_finish")
+	gobject.CheckClassSize("GTlsDatabaseClass", xTlsDatabaseGLibType, unsafe.Sizeof(TlsDatabaseClass{}))
+
+	core.PuregoRegisterSince(&xTlsDatabaseCreateCertificateHandle, libs, "g_tls_database_create_certificate_handle", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateForHandle, libs, "g_tls_database_lookup_certificate_for_handle", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateForHandleAsync, libs, "g_tls_database_lookup_certificate_for_handle_async", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateForHandleFinish, libs, "g_tls_database_lookup_certificate_for_handle_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateIssuer, libs, "g_tls_database_lookup_certificate_issuer", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateIssuerAsync, libs, "g_tls_database_lookup_certificate_issuer_async", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificateIssuerFinish, libs, "g_tls_database_lookup_certificate_issuer_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificatesIssuedBy, libs, "g_tls_database_lookup_certificates_issued_by", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificatesIssuedByAsync, libs, "g_tls_database_lookup_certificates_issued_by_async", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseLookupCertificatesIssuedByFinish, libs, "g_tls_database_lookup_certificates_issued_by_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseVerifyChain, libs, "g_tls_database_verify_chain", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseVerifyChainAsync, libs, "g_tls_database_verify_chain_async", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsDatabaseVerifyChainFinish, libs, "g_tls_database_verify_chain_finish", "GIO", "2.30")
 
 }
@@ -5,18 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Provides an interface for #GTlsFileDatabase implementations.
+// Provides an interface for [TlsFileDatabase] implementations.
 type TlsFileDatabaseInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	Padding [8]uintptr
 }
@@ -25,7 +24,7 @@ func (x *TlsFileDatabaseInterface) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GTlsFileDatabase` is implemented by [class@Gio.TlsDatabase] objects which
+// `GTlsFileDatabase` is implemented by [TlsDatabase] objects which
 // load their certificate information from a file. It is an interface which
 // TLS library specific subtypes implement.
 type TlsFileDatabase interface {
@@ -58,7 +57,7 @@ func (x *TlsFileDatabaseBase) SetGoPointer(ptr uintptr) {
 // The path to a file containing PEM encoded certificate authority
 // root anchors. The certificates in this file will be treated as
 // root authorities for the purpose of verifying other certificates
-// via the g_tls_database_verify_chain() operation.
+// via the [TlsDatabase.VerifyChain] operation.
 func (x *TlsFileDatabaseBase) SetPropertyAnchors(value string) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -72,7 +71,7 @@ func (x *TlsFileDatabaseBase) SetPropertyAnchors(value string) {
 // The path to a file containing PEM encoded certificate authority
 // root anchors. The certificates in this file will be treated as
 // root authorities for the purpose of verifying other certificates
-// via the g_tls_database_verify_chain() operation.
+// via the [TlsDatabase.VerifyChain] operation.
 func (x *TlsFileDatabaseBase) GetPropertyAnchors() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -83,10 +82,10 @@ func (x *TlsFileDatabaseBase) GetPropertyAnchors() string {
 
 var xTlsFileDatabaseNew func(string, **glib.Error) uintptr
 
-// Creates a new #GTlsFileDatabase which uses anchor certificate authorities
-// in @anchors to verify certificate chains.
+// Creates a new [TlsFileDatabase] which uses anchor certificate authorities
+// in anchors to verify certificate chains.
 //
-// The certificates in @anchors must be PEM encoded.
+// The certificates in anchors must be PEM encoded.
 func TlsFileDatabaseNew(AnchorsVar string) (*TlsFileDatabaseBase, error) {
 	var cls *TlsFileDatabaseBase
 	var cerr *glib.Error
@@ -94,12 +93,15 @@ func TlsFileDatabaseNew(AnchorsVar string) (*TlsFileDatabaseBase, error) {
 	cret := xTlsFileDatabaseNew(AnchorsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsFileDatabaseBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -110,15 +112,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTlsFileDatabaseNew, libs, "g_tls_file_database_new")
+	core.PuregoRegisterSince(&xTlsFileDatabaseNew, libs, "g_tls_file_database_new", "GIO", "2.30")
 
 	core.PuregoSafeRegister(&xTlsFileDatabaseGLibType, libs, "g_tls_file_database_get_type")
 
+	gobject.CheckClassSize("GTlsFileDatabaseInterface", xTlsFileDatabaseGLibType, unsafe.Sizeof(TlsFileDatabaseInterface{}))
+
 }
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The class for #GTlsInteraction. Derived classes implement the various
+// The class for [TlsInteraction]. Derived classes implement the various
 // virtual interaction methods to handle TLS interactions.
 //
 // Derived classes can choose to implement whichever interactions methods they'd
@@ -24,8 +23,8 @@ import (
 // and the asynchronous methods to display modeless dialogs.
 //
 // If the user cancels an interaction, then the result should be
-// %G_TLS_INTERACTION_FAILED and the error should be set with a domain of
-// %G_IO_ERROR and code of %G_IO_ERROR_CANCELLED.
+// [GTlsInteractionFailedValue] and the error should be set with a domain of
+// G_IO_ERROR and code of [GIoErrorCancelledValue].
 type TlsInteractionClass struct {
 	_ structs.HostLayout
 
@@ -53,14 +52,15 @@ func (x *TlsInteractionClass) GoPointer() uintptr {
 // OverrideAskPassword sets the "ask_password" callback function.
 // ask for a password synchronously. If the implementation
 //
-//	returns %G_TLS_INTERACTION_HANDLED, then the password argument should
-//	have been filled in by using g_tls_password_set_value() or a similar
+//	returns [GTlsInteractionHandledValue], then the password argument should
+//	have been filled in by using [TlsPassword.SetValue] or a similar
 //	function.
 func (x *TlsInteractionClass) OverrideAskPassword(cb func(*TlsInteraction, *TlsPassword, *Cancellable) TlsInteractionResult) {
 	if cb == nil {
 		x.xAskPassword = 0
 	} else {
-		x.xAskPassword = purego.NewCallback(func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr) TlsInteractionResult {
+		x.xAskPassword = core.NewCallback(func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr) TlsInteractionResult {
+			defer core.RecoverPanic()
 			return cb(TlsInteractionNewFromInternalPtr(InteractionVarp), TlsPasswordNewFromInternalPtr(PasswordVarp), CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -69,15 +69,15 @@ func (x *TlsInteractionClass) OverrideAskPassword(cb func(*TlsInteraction, *TlsP
 // GetAskPassword gets the "ask_password" callback function.
 // ask for a password synchronously. If the implementation
 //
-//	returns %G_TLS_INTERACTION_HANDLED, then the password argument should
-//	have been filled in by using g_tls_password_set_value() or a similar
+//	returns [GTlsInteractionHandledValue], then the password argument should
+//	have been filled in by using [TlsPassword.SetValue] or a similar
 //	function.
 func (x *TlsInteractionClass) GetAskPassword() func(*TlsInteraction, *TlsPassword, *Cancellable) TlsInteractionResult {
 	if x.xAskPassword == 0 {
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr) TlsInteractionResult
-	purego.RegisterFunc(&rawCallback, x.xAskPassword)
+	core.RegisterFunc(&rawCallback, x.xAskPassword)
 	return func(InteractionVar *TlsInteraction, PasswordVar *TlsPassword, CancellableVar *Cancellable) TlsInteractionResult {
 		return rawCallback(InteractionVar.GoPointer(), PasswordVar.GoPointer(), CancellableVar.GoPointer())
 	}
@@ -89,7 +89,8 @@ func (x *TlsInteractionClass) OverrideAskPasswordAsync(cb func(*TlsInteraction,
 	if cb == nil {
 		x.xAskPasswordAsync = 0
 	} else {
-		x.xAskPasswordAsync = purego.NewCallback(func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xAskPasswordAsync = core.NewCallback(func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsInteractionNewFromInternalPtr(InteractionVarp), TlsPasswordNewFromInternalPtr(PasswordVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -102,7 +103,7 @@ func (x *TlsInteractionClass) GetAskPasswordAsync() func(*TlsInteraction, *TlsPa
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, PasswordVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAskPasswordAsync)
+	core.RegisterFunc(&rawCallback, x.xAskPasswordAsync)
 	return func(InteractionVar *TlsInteraction, PasswordVar *TlsPassword, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(InteractionVar.GoPointer(), PasswordVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -111,14 +112,15 @@ func (x *TlsInteractionClass) GetAskPasswordAsync() func(*TlsInteraction, *TlsPa
 // OverrideAskPasswordFinish sets the "ask_password_finish" callback function.
 // complete operation to ask for a password asynchronously.
 //
-//	If the implementation returns %G_TLS_INTERACTION_HANDLED, then the
+//	If the implementation returns [GTlsInteractionHandledValue], then the
 //	password argument of the async method should have been filled in by using
-//	g_tls_password_set_value() or a similar function.
+//	[TlsPassword.SetValue] or a similar function.
 func (x *TlsInteractionClass) OverrideAskPasswordFinish(cb func(*TlsInteraction, AsyncResult) TlsInteractionResult) {
 	if cb == nil {
 		x.xAskPasswordFinish = 0
 	} else {
-		x.xAskPasswordFinish = purego.NewCallback(func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult {
+		x.xAskPasswordFinish = core.NewCallback(func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult {
+			defer core.RecoverPanic()
 			return cb(TlsInteractionNewFromInternalPtr(InteractionVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -127,15 +129,15 @@ func (x *TlsInteractionClass) OverrideAskPasswordFinish(cb func(*TlsInteraction,
 // GetAskPasswordFinish gets the "ask_password_finish" callback function.
 // complete operation to ask for a password asynchronously.
 //
-//	If the implementation returns %G_TLS_INTERACTION_HANDLED, then the
+//	If the implementation returns [GTlsInteractionHandledValue], then the
 //	password argument of the async method should have been filled in by using
-//	g_tls_password_set_value() or a similar function.
+//	[TlsPassword.SetValue] or a similar function.
 func (x *TlsInteractionClass) GetAskPasswordFinish() func(*TlsInteraction, AsyncResult) TlsInteractionResult {
 	if x.xAskPasswordFinish == 0 {
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult
-	purego.RegisterFunc(&rawCallback, x.xAskPasswordFinish)
+	core.RegisterFunc(&rawCallback, x.xAskPasswordFinish)
 	return func(InteractionVar *TlsInteraction, ResultVar AsyncResult) TlsInteractionResult {
 		return rawCallback(InteractionVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -144,14 +146,15 @@ func (x *TlsInteractionClass) GetAskPasswordFinish() func(*TlsInteraction, Async
 // OverrideRequestCertificate sets the "request_certificate" callback function.
 // ask for a certificate synchronously. If the
 //
-//	implementation returns %G_TLS_INTERACTION_HANDLED, then the connection
+//	implementation returns [GTlsInteractionHandledValue], then the connection
 //	argument should have been filled in by using
-//	g_tls_connection_set_certificate().
+//	[TlsConnection.SetCertificate].
 func (x *TlsInteractionClass) OverrideRequestCertificate(cb func(*TlsInteraction, *TlsConnection, TlsCertificateRequestFlags, *Cancellable) TlsInteractionResult) {
 	if cb == nil {
 		x.xRequestCertificate = 0
 	} else {
-		x.xRequestCertificate = purego.NewCallback(func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr) TlsInteractionResult {
+		x.xRequestCertificate = core.NewCallback(func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr) TlsInteractionResult {
+			defer core.RecoverPanic()
 			return cb(TlsInteractionNewFromInternalPtr(InteractionVarp), TlsConnectionNewFromInternalPtr(ConnectionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -160,15 +163,15 @@ func (x *TlsInteractionClass) OverrideRequestCertificate(cb func(*TlsInteraction
 // GetRequestCertificate gets the "request_certificate" callback function.
 // ask for a certificate synchronously. If the
 //
-//	implementation returns %G_TLS_INTERACTION_HANDLED, then the connection
+//	implementation returns [GTlsInteractionHandledValue], then the connection
 //	argument should have been filled in by using
-//	g_tls_connection_set_certificate().
+//	[TlsConnection.SetCertificate].
 func (x *TlsInteractionClass) GetRequestCertificate() func(*TlsInteraction, *TlsConnection, TlsCertificateRequestFlags, *Cancellable) TlsInteractionResult {
 	if x.xRequestCertificate == 0 {
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr) TlsInteractionResult
-	purego.RegisterFunc(&rawCallback, x.xRequestCertificate)
+	core.RegisterFunc(&rawCallback, x.xRequestCertificate)
 	return func(InteractionVar *TlsInteraction, ConnectionVar *TlsConnection, FlagsVar TlsCertificateRequestFlags, CancellableVar *Cancellable) TlsInteractionResult {
 		return rawCallback(InteractionVar.GoPointer(), ConnectionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -180,7 +183,8 @@ func (x *TlsInteractionClass) OverrideRequestCertificateAsync(cb func(*TlsIntera
 	if cb == nil {
 		x.xRequestCertificateAsync = 0
 	} else {
-		x.xRequestCertificateAsync = purego.NewCallback(func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xRequestCertificateAsync = core.NewCallback(func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsInteractionNewFromInternalPtr(InteractionVarp), TlsConnectionNewFromInternalPtr(ConnectionVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
@@ -193,7 +197,7 @@ func (x *TlsInteractionClass) GetRequestCertificateAsync() func(*TlsInteraction,
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, ConnectionVarp uintptr, FlagsVarp TlsCertificateRequestFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRequestCertificateAsync)
+	core.RegisterFunc(&rawCallback, x.xRequestCertificateAsync)
 	return func(InteractionVar *TlsInteraction, ConnectionVar *TlsConnection, FlagsVar TlsCertificateRequestFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(InteractionVar.GoPointer(), ConnectionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -202,14 +206,15 @@ func (x *TlsInteractionClass) GetRequestCertificateAsync() func(*TlsInteraction,
 // OverrideRequestCertificateFinish sets the "request_certificate_finish" callback function.
 // complete operation to ask for a certificate
 //
-//	asynchronously. If the implementation returns %G_TLS_INTERACTION_HANDLED,
+//	asynchronously. If the implementation returns [GTlsInteractionHandledValue],
 //	then the connection argument of the async method should have been
-//	filled in by using g_tls_connection_set_certificate().
+//	filled in by using [TlsConnection.SetCertificate].
 func (x *TlsInteractionClass) OverrideRequestCertificateFinish(cb func(*TlsInteraction, AsyncResult) TlsInteractionResult) {
 	if cb == nil {
 		x.xRequestCertificateFinish = 0
 	} else {
-		x.xRequestCertificateFinish = purego.NewCallback(func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult {
+		x.xRequestCertificateFinish = core.NewCallback(func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult {
+			defer core.RecoverPanic()
 			return cb(TlsInteractionNewFromInternalPtr(InteractionVarp), &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -218,15 +223,15 @@ func (x *TlsInteractionClass) OverrideRequestCertificateFinish(cb func(*TlsInter
 // GetRequestCertificateFinish gets the "request_certificate_finish" callback function.
 // complete operation to ask for a certificate
 //
-//	asynchronously. If the implementation returns %G_TLS_INTERACTION_HANDLED,
+//	asynchronously. If the implementation returns [GTlsInteractionHandledValue],
 //	then the connection argument of the async method should have been
-//	filled in by using g_tls_connection_set_certificate().
+//	filled in by using [TlsConnection.SetCertificate].
 func (x *TlsInteractionClass) GetRequestCertificateFinish() func(*TlsInteraction, AsyncResult) TlsInteractionResult {
 	if x.xRequestCertificateFinish == 0 {
 		return nil
 	}
 	var rawCallback func(InteractionVarp uintptr, ResultVarp uintptr) TlsInteractionResult
-	purego.RegisterFunc(&rawCallback, x.xRequestCertificateFinish)
+	core.RegisterFunc(&rawCallback, x.xRequestCertificateFinish)
 	return func(InteractionVar *TlsInteraction, ResultVar AsyncResult) TlsInteractionResult {
 		return rawCallback(InteractionVar.GoPointer(), ResultVar.GoPointer())
 	}
@@ -244,13 +249,13 @@ func (x *TlsInteractionPrivate) GoPointer() uintptr {
 // code to interact with the user. It can be used to ask the user for passwords.
 //
 // To use a `GTlsInteraction` with a TLS connection use
-// [method@Gio.TlsConnection.set_interaction].
+// [TlsConnection.SetInteraction].
 //
 // Callers should instantiate a derived class that implements the various
 // interaction methods to show the required dialogs.
 //
 // Callers should use the 'invoke' functions like
-// [method@Gio.TlsInteraction.invoke_ask_password] to run interaction methods.
+// [TlsInteraction.InvokeAskPassword] to run interaction methods.
 // These functions make sure that the interaction is invoked in the main loop
 // and not in the current thread, if the current thread is not running the
 // main loop.
@@ -279,24 +284,24 @@ func TlsInteractionNewFromInternalPtr(ptr uintptr) *TlsInteraction {
 var xTlsInteractionAskPassword func(uintptr, uintptr, uintptr, **glib.Error) TlsInteractionResult
 
 // Run synchronous interaction to ask the user for a password. In general,
-// g_tls_interaction_invoke_ask_password() should be used instead of this
+// [TlsInteraction.InvokeAskPassword] should be used instead of this
 // function.
 //
 // Derived subclasses usually implement a password prompt, although they may
-// also choose to provide a password from elsewhere. The @password value will
-// be filled in and then @callback will be called. Alternatively the user may
+// also choose to provide a password from elsewhere. The password value will
+// be filled in and then callback will be called. Alternatively the user may
 // abort this password request, which will usually abort the TLS connection.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code. Certain implementations may
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code. Certain implementations may
 // not support immediate cancellation.
 func (x *TlsInteraction) AskPassword(PasswordVar *TlsPassword, CancellableVar *Cancellable) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionAskPassword(x.GoPointer(), PasswordVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -305,17 +310,17 @@ func (x *TlsInteraction) AskPassword(PasswordVar *TlsPassword, CancellableVar *C
 var xTlsInteractionAskPasswordAsync func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
 // Run asynchronous interaction to ask the user for a password. In general,
-// g_tls_interaction_invoke_ask_password() should be used instead of this
+// [TlsInteraction.InvokeAskPassword] should be used instead of this
 // function.
 //
 // Derived subclasses usually implement a password prompt, although they may
-// also choose to provide a password from elsewhere. The @password value will
-// be filled in and then @callback will be called. Alternatively the user may
+// also choose to provide a password from elsewhere. The password value will
+// be filled in and then callback will be called. Alternatively the user may
 // abort this password request, which will usually abort the TLS connection.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code. Certain implementations may
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code. Certain implementations may
 // not support immediate cancellation.
 //
 // Certain implementations may not support immediate cancellation.
@@ -328,11 +333,12 @@ func (x *TlsInteraction) AskPasswordAsync(PasswordVar *TlsPassword, CancellableV
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -343,20 +349,20 @@ func (x *TlsInteraction) AskPasswordAsync(PasswordVar *TlsPassword, CancellableV
 var xTlsInteractionAskPasswordFinish func(uintptr, uintptr, **glib.Error) TlsInteractionResult
 
 // Complete an ask password user interaction request. This should be once
-// the g_tls_interaction_ask_password_async() completion callback is called.
+// the [TlsInteraction.AskPasswordAsync] completion callback is called.
 //
-// If %G_TLS_INTERACTION_HANDLED is returned, then the #GTlsPassword passed
-// to g_tls_interaction_ask_password() will have its password filled in.
+// If [GTlsInteractionHandledValue] is returned, then the [TlsPassword] passed
+// to [TlsInteraction.AskPassword] will have its password filled in.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code.
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code.
 func (x *TlsInteraction) AskPasswordFinish(ResultVar AsyncResult) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionAskPasswordFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -365,14 +371,14 @@ func (x *TlsInteraction) AskPasswordFinish(ResultVar AsyncResult) (TlsInteractio
 var xTlsInteractionInvokeAskPassword func(uintptr, uintptr, uintptr, **glib.Error) TlsInteractionResult
 
 // Invoke the interaction to ask the user for a password. It invokes this
-// interaction in the main loop, specifically the #GMainContext returned by
-// g_main_context_get_thread_default() when the interaction is created. This
-// is called by called by #GTlsConnection or #GTlsDatabase to ask the user
+// interaction in the main loop, specifically the [glib.MainContext] returned by
+// [glib.MainContextGetThreadDefault] when the interaction is created. This
+// is called by called by [TlsConnection] or [TlsDatabase] to ask the user
 // for a password.
 //
 // Derived subclasses usually implement a password prompt, although they may
-// also choose to provide a password from elsewhere. The @password value will
-// be filled in and then @callback will be called. Alternatively the user may
+// also choose to provide a password from elsewhere. The password value will
+// be filled in and then callback will be called. Alternatively the user may
 // abort this password request, which will usually abort the TLS connection.
 //
 // The implementation can either be a synchronous (eg: modal dialog) or an
@@ -380,15 +386,15 @@ var xTlsInteractionInvokeAskPassword func(uintptr, uintptr, uintptr, **glib.Erro
 // calling which ever one correctly.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code. Certain implementations may
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code. Certain implementations may
 // not support immediate cancellation.
 func (x *TlsInteraction) InvokeAskPassword(PasswordVar *TlsPassword, CancellableVar *Cancellable) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionInvokeAskPassword(x.GoPointer(), PasswordVar.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -398,9 +404,9 @@ var xTlsInteractionInvokeRequestCertificate func(uintptr, uintptr, TlsCertificat
 
 // Invoke the interaction to ask the user to choose a certificate to
 // use with the connection. It invokes this interaction in the main
-// loop, specifically the #GMainContext returned by
-// g_main_context_get_thread_default() when the interaction is
-// created. This is called by called by #GTlsConnection when the peer
+// loop, specifically the [glib.MainContext] returned by
+// [glib.MainContextGetThreadDefault] when the interaction is
+// created. This is called by called by [TlsConnection] when the peer
 // requests a certificate during the handshake.
 //
 // Derived subclasses usually implement a certificate selector,
@@ -413,15 +419,15 @@ var xTlsInteractionInvokeRequestCertificate func(uintptr, uintptr, TlsCertificat
 // calling which ever one correctly.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code. Certain implementations may
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code. Certain implementations may
 // not support immediate cancellation.
 func (x *TlsInteraction) InvokeRequestCertificate(ConnectionVar *TlsConnection, FlagsVar TlsCertificateRequestFlags, CancellableVar *Cancellable) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionInvokeRequestCertificate(x.GoPointer(), ConnectionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -430,27 +436,27 @@ func (x *TlsInteraction) InvokeRequestCertificate(ConnectionVar *TlsConnection,
 var xTlsInteractionRequestCertificate func(uintptr, uintptr, TlsCertificateRequestFlags, uintptr, **glib.Error) TlsInteractionResult
 
 // Run synchronous interaction to ask the user to choose a certificate to use
-// with the connection. In general, g_tls_interaction_invoke_request_certificate()
+// with the connection. In general, [TlsInteraction.InvokeRequestCertificate]
 // should be used instead of this function.
 //
 // Derived subclasses usually implement a certificate selector, although they may
 // also choose to provide a certificate from elsewhere. Alternatively the user may
 // abort this certificate request, which will usually abort the TLS connection.
 //
-// If %G_TLS_INTERACTION_HANDLED is returned, then the #GTlsConnection
-// passed to g_tls_interaction_request_certificate() will have had its
-// #GTlsConnection:certificate filled in.
+// If [GTlsInteractionHandledValue] is returned, then the [TlsConnection]
+// passed to [TlsInteraction.RequestCertificate] will have had its
+// GTlsConnection:certificate filled in.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code. Certain implementations may
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code. Certain implementations may
 // not support immediate cancellation.
 func (x *TlsInteraction) RequestCertificate(ConnectionVar *TlsConnection, FlagsVar TlsCertificateRequestFlags, CancellableVar *Cancellable) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionRequestCertificate(x.GoPointer(), ConnectionVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -459,11 +465,11 @@ func (x *TlsInteraction) RequestCertificate(ConnectionVar *TlsConnection, FlagsV
 var xTlsInteractionRequestCertificateAsync func(uintptr, uintptr, TlsCertificateRequestFlags, uintptr, uintptr, uintptr)
 
 // Run asynchronous interaction to ask the user for a certificate to use with
-// the connection. In general, g_tls_interaction_invoke_request_certificate() should
+// the connection. In general, [TlsInteraction.InvokeRequestCertificate] should
 // be used instead of this function.
 //
 // Derived subclasses usually implement a certificate selector, although they may
-// also choose to provide a certificate from elsewhere. @callback will be called
+// also choose to provide a certificate from elsewhere. callback will be called
 // when the operation completes. Alternatively the user may abort this certificate
 // request, which will usually abort the TLS connection.
 func (x *TlsInteraction) RequestCertificateAsync(ConnectionVar *TlsConnection, FlagsVar TlsCertificateRequestFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
@@ -475,11 +481,12 @@ func (x *TlsInteraction) RequestCertificateAsync(ConnectionVar *TlsConnection, F
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -490,21 +497,21 @@ func (x *TlsInteraction) RequestCertificateAsync(ConnectionVar *TlsConnection, F
 var xTlsInteractionRequestCertificateFinish func(uintptr, uintptr, **glib.Error) TlsInteractionResult
 
 // Complete a request certificate user interaction request. This should be once
-// the g_tls_interaction_request_certificate_async() completion callback is called.
+// the [TlsInteraction.RequestCertificateAsync] completion callback is called.
 //
-// If %G_TLS_INTERACTION_HANDLED is returned, then the #GTlsConnection
-// passed to g_tls_interaction_request_certificate_async() will have had its
-// #GTlsConnection:certificate filled in.
+// If [GTlsInteractionHandledValue] is returned, then the [TlsConnection]
+// passed to [TlsInteraction.RequestCertificateAsync] will have had its
+// GTlsConnection:certificate filled in.
 //
 // If the interaction is cancelled by the cancellation object, or by the
-// user then %G_TLS_INTERACTION_FAILED will be returned with an error that
-// contains a %G_IO_ERROR_CANCELLED error code.
+// user then [GTlsInteractionFailedValue] will be returned with an error that
+// contains a [GIoErrorCancelledValue] error code.
 func (x *TlsInteraction) RequestCertificateFinish(ResultVar AsyncResult) (TlsInteractionResult, error) {
 	var cerr *glib.Error
 
 	cret := xTlsInteractionRequestCertificateFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -526,7 +533,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -535,13 +542,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xTlsInteractionGLibType, libs, "g_tls_interaction_get_type")
 
-	core.PuregoSafeRegister(&xTlsInteractionAskPassword, libs, "g_tls_interaction_ask_password")
-	core.PuregoSafeRegister(&xTlsInteractionAskPasswordAsync, libs, "g_tls_interaction_ask_password_async")
-	core.PuregoSafeRegister(&xTlsInteractionAskPasswordFinish, libs, "g_tls_interaction_ask_password_finish")
-	core.PuregoSafeRegister(&xTlsInteractionInvokeAskPassword, libs, "g_tls_interaction_invoke_ask_password")
-	core.PuregoSafeRegister(&xTlsInteractionInvokeRequestCertificate, libs, "g_tls_interaction_invoke_request_certificate")
-	core.PuregoSafeRegister(&xTlsInteractionRequestCertificate, libs, "g_tls_interaction_request_certificate")
-	core.PuregoSafeRegister(&xTlsInteractionRequestCertificateAsync, libs, "g_tls_interaction_request_certificate_async")
-	core.PuregoSafeRegister(&xTlsInteractionRequestCertificateFinish, libs, "g_tls_interaction_request_certificate_finish")
+	gobject.CheckClassSize("GTlsInteractionClass", xTlsInteractionGLibType, unsafe.Sizeof(TlsInteractionClass{}))
+
+	core.PuregoRegisterSince(&xTlsInteractionAskPassword, libs, "g_tls_interaction_ask_password", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsInteractionAskPasswordAsync, libs, "g_tls_interaction_ask_password_async", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsInteractionAskPasswordFinish, libs, "g_tls_interaction_ask_password_finish", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsInteractionInvokeAskPassword, libs, "g_tls_interaction_invoke_ask_password", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsInteractionInvokeRequestCertificate, libs, "g_tls_interaction_invoke_request_certificate", "GIO", "2.40")
+	core.PuregoRegisterSince(&xTlsInteractionRequestCertificate, libs, "g_tls_interaction_request_certificate", "GIO", "2.40")
+	core.PuregoRegisterSince(&xTlsInteractionRequestCertificateAsync, libs, "g_tls_interaction_request_certificate_async", "GIO", "2.40")
+	core.PuregoRegisterSince(&xTlsInteractionRequestCertificateFinish, libs, "g_tls_interaction_request_certificate_finish", "GIO", "2.40")
 
 }
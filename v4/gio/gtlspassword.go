@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GTlsPassword.
+// Class structure for [TlsPassword].
 type TlsPasswordClass struct {
 	_ structs.HostLayout
 
@@ -32,79 +31,82 @@ func (x *TlsPasswordClass) GoPointer() uintptr {
 }
 
 // OverrideGetValue sets the "get_value" callback function.
-// virtual method for g_tls_password_get_value()
+// virtual method for [TlsPassword.GetValue]
 func (x *TlsPasswordClass) OverrideGetValue(cb func(*TlsPassword, *uint) uintptr) {
 	if cb == nil {
 		x.xGetValue = 0
 	} else {
-		x.xGetValue = purego.NewCallback(func(PasswordVarp uintptr, LengthVarp *uint) uintptr {
+		x.xGetValue = core.NewCallback(func(PasswordVarp uintptr, LengthVarp *uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(TlsPasswordNewFromInternalPtr(PasswordVarp), LengthVarp)
 		})
 	}
 }
 
 // GetGetValue gets the "get_value" callback function.
-// virtual method for g_tls_password_get_value()
+// virtual method for [TlsPassword.GetValue]
 func (x *TlsPasswordClass) GetGetValue() func(*TlsPassword, *uint) uintptr {
 	if x.xGetValue == 0 {
 		return nil
 	}
 	var rawCallback func(PasswordVarp uintptr, LengthVarp *uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetValue)
+	core.RegisterFunc(&rawCallback, x.xGetValue)
 	return func(PasswordVar *TlsPassword, LengthVar *uint) uintptr {
 		return rawCallback(PasswordVar.GoPointer(), LengthVar)
 	}
 }
 
 // OverrideSetValue sets the "set_value" callback function.
-// virtual method for g_tls_password_set_value()
+// virtual method for [TlsPassword.SetValue]
 func (x *TlsPasswordClass) OverrideSetValue(cb func(*TlsPassword, []byte, int, *glib.DestroyNotify)) {
 	if cb == nil {
 		x.xSetValue = 0
 	} else {
-		x.xSetValue = purego.NewCallback(func(PasswordVarp uintptr, ValueVarp []byte, LengthVarp int, DestroyVarp uintptr) {
+		x.xSetValue = core.NewCallback(func(PasswordVarp uintptr, ValueVarp []byte, LengthVarp int, DestroyVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TlsPasswordNewFromInternalPtr(PasswordVarp), ValueVarp, LengthVarp, (*glib.DestroyNotify)(unsafe.Pointer(DestroyVarp)))
 		})
 	}
 }
 
 // GetSetValue gets the "set_value" callback function.
-// virtual method for g_tls_password_set_value()
+// virtual method for [TlsPassword.SetValue]
 func (x *TlsPasswordClass) GetSetValue() func(*TlsPassword, []byte, int, *glib.DestroyNotify) {
 	if x.xSetValue == 0 {
 		return nil
 	}
 	var rawCallback func(PasswordVarp uintptr, ValueVarp []byte, LengthVarp int, DestroyVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetValue)
+	core.RegisterFunc(&rawCallback, x.xSetValue)
 	return func(PasswordVar *TlsPassword, ValueVar []byte, LengthVar int, DestroyVar *glib.DestroyNotify) {
 		rawCallback(PasswordVar.GoPointer(), ValueVar, LengthVar, glib.NewCallbackNullable(DestroyVar))
 	}
 }
 
 // OverrideGetDefaultWarning sets the "get_default_warning" callback function.
-// virtual method for g_tls_password_get_warning() if no
+// virtual method for [TlsPassword.GetWarning] if no
 //
-//	value has been set using g_tls_password_set_warning()
+//	value has been set using [TlsPassword.SetWarning]
 func (x *TlsPasswordClass) OverrideGetDefaultWarning(cb func(*TlsPassword) string) {
 	if cb == nil {
 		x.xGetDefaultWarning = 0
 	} else {
-		x.xGetDefaultWarning = purego.NewCallback(func(PasswordVarp uintptr) string {
+		x.xGetDefaultWarning = core.NewCallback(func(PasswordVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(TlsPasswordNewFromInternalPtr(PasswordVarp))
 		})
 	}
 }
 
 // GetGetDefaultWarning gets the "get_default_warning" callback function.
-// virtual method for g_tls_password_get_warning() if no
+// virtual method for [TlsPassword.GetWarning] if no
 //
-//	value has been set using g_tls_password_set_warning()
+//	value has been set using [TlsPassword.SetWarning]
 func (x *TlsPasswordClass) GetGetDefaultWarning() func(*TlsPassword) string {
 	if x.xGetDefaultWarning == 0 {
 		return nil
 	}
 	var rawCallback func(PasswordVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetDefaultWarning)
+	core.RegisterFunc(&rawCallback, x.xGetDefaultWarning)
 	return func(PasswordVar *TlsPassword) string {
 		return rawCallback(PasswordVar.GoPointer())
 	}
@@ -138,7 +140,7 @@ func TlsPasswordNewFromInternalPtr(ptr uintptr) *TlsPassword {
 
 var xNewTlsPassword func(TlsPasswordFlags, string) uintptr
 
-// Create a new #GTlsPassword object.
+// Create a new [TlsPassword] object.
 func NewTlsPassword(FlagsVar TlsPasswordFlags, DescriptionVar string) *TlsPassword {
 	var cls *TlsPassword
 
@@ -172,10 +174,10 @@ func (x *TlsPassword) GetFlags() TlsPasswordFlags {
 
 var xTlsPasswordGetValue func(uintptr, *uint) uintptr
 
-// Get the password value. If @length is not %NULL then it will be
+// Get the password value. If length is not nil then it will be
 // filled in with the length of the password value. (Note that the
-// password value is not nul-terminated, so you can only pass %NULL
-// for @length in contexts where you know the password will have a
+// password value is not nul-terminated, so you can only pass nil
+// for length in contexts where you know the password will have a
 // certain fixed length.)
 func (x *TlsPassword) GetValue(LengthVar *uint) uintptr {
 
@@ -187,7 +189,7 @@ var xTlsPasswordGetWarning func(uintptr) string
 
 // Get a user readable translated warning. Usually this warning is a
 // representation of the password flags returned from
-// g_tls_password_get_flags().
+// [TlsPassword.GetFlags].
 func (x *TlsPassword) GetWarning() string {
 
 	cret := xTlsPasswordGetWarning(x.GoPointer())
@@ -214,11 +216,11 @@ func (x *TlsPassword) SetFlags(FlagsVar TlsPasswordFlags) {
 
 var xTlsPasswordSetValue func(uintptr, []byte, int)
 
-// Set the value for this password. The @value will be copied by the password
+// Set the value for this password. The value will be copied by the password
 // object.
 //
-// Specify the @length, for a non-nul-terminated password. Pass -1 as
-// @length if using a nul-terminated password, and @length will be
+// Specify the length, for a non-nul-terminated password. Pass -1 as
+// length if using a nul-terminated password, and length will be
 // calculated automatically. (Note that the terminating nul is not
 // considered part of the password in this case.)
 func (x *TlsPassword) SetValue(ValueVar []byte, LengthVar int) {
@@ -231,11 +233,11 @@ var xTlsPasswordSetValueFull func(uintptr, []byte, int, uintptr)
 
 // Provide the value for this password.
 //
-// The @value will be owned by the password object, and later freed using
-// the @destroy function callback.
+// The value will be owned by the password object, and later freed using
+// the destroy function callback.
 //
-// Specify the @length, for a non-nul-terminated password. Pass -1 as
-// @length if using a nul-terminated password, and @length will be
+// Specify the length, for a non-nul-terminated password. Pass -1 as
+// length if using a nul-terminated password, and length will be
 // calculated automatically. (Note that the terminating nul is not
 // considered part of the password in this case.)
 func (x *TlsPassword) SetValueFull(ValueVar []byte, LengthVar int, DestroyVar *glib.DestroyNotify) {
@@ -247,11 +249,12 @@ func (x *TlsPassword) SetValueFull(ValueVar []byte, LengthVar int, DestroyVar *g
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -263,7 +266,7 @@ var xTlsPasswordSetWarning func(uintptr, string)
 
 // Set a user readable translated warning. Usually this warning is a
 // representation of the password flags returned from
-// g_tls_password_get_flags().
+// [TlsPassword.GetFlags].
 func (x *TlsPassword) SetWarning(WarningVar string) {
 
 	xTlsPasswordSetWarning(x.GoPointer(), WarningVar)
@@ -320,7 +323,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -329,16 +332,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xTlsPasswordGLibType, libs, "g_tls_password_get_type")
 
-	core.PuregoSafeRegister(&xNewTlsPassword, libs, "g_tls_password_new")
-
-	core.PuregoSafeRegister(&xTlsPasswordGetDescription, libs, "g_tls_password_get_description")
-	core.PuregoSafeRegister(&xTlsPasswordGetFlags, libs, "g_tls_password_get_flags")
-	core.PuregoSafeRegister(&xTlsPasswordGetValue, libs, "g_tls_password_get_value")
-	core.PuregoSafeRegister(&xTlsPasswordGetWarning, libs, "g_tls_password_get_warning")
-	core.PuregoSafeRegister(&xTlsPasswordSetDescription, libs, "g_tls_password_set_description")
-	core.PuregoSafeRegister(&xTlsPasswordSetFlags, libs, "g_tls_password_set_flags")
-	core.PuregoSafeRegister(&xTlsPasswordSetValue, libs, "g_tls_password_set_value")
-	core.PuregoSafeRegister(&xTlsPasswordSetValueFull, libs, "g_tls_password_set_value_full")
-	core.PuregoSafeRegister(&xTlsPasswordSetWarning, libs, "g_tls_password_set_warning")
+	gobject.CheckClassSize("GTlsPasswordClass", xTlsPasswordGLibType, unsafe.Sizeof(TlsPasswordClass{}))
+
+	core.PuregoRegisterSince(&xNewTlsPassword, libs, "g_tls_password_new", "GIO", "2.30")
+
+	core.PuregoRegisterSince(&xTlsPasswordGetDescription, libs, "g_tls_password_get_description", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordGetFlags, libs, "g_tls_password_get_flags", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordGetValue, libs, "g_tls_password_get_value", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordGetWarning, libs, "g_tls_password_get_warning", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordSetDescription, libs, "g_tls_password_set_description", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordSetFlags, libs, "g_tls_password_set_flags", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordSetValue, libs, "g_tls_password_set_value", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordSetValueFull, libs, "g_tls_password_set_value_full", "GIO", "2.30")
+	core.PuregoRegisterSince(&xTlsPasswordSetWarning, libs, "g_tls_password_set_warning", "GIO", "2.30")
 
 }
@@ -5,17 +5,17 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// vtable for a #GTlsServerConnection implementation.
+// vtable for a [TlsServerConnection] implementation.
 type TlsServerConnectionInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 }
 
 func (x *TlsServerConnectionInterface) GoPointer() uintptr {
@@ -23,7 +23,7 @@ func (x *TlsServerConnectionInterface) GoPointer() uintptr {
 }
 
 // `GTlsServerConnection` is the server-side subclass of
-// [class@Gio.TlsConnection], representing a server-side TLS connection.
+// [TlsConnection], representing a server-side TLS connection.
 type TlsServerConnection interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -52,11 +52,11 @@ func (x *TlsServerConnectionBase) SetGoPointer(ptr uintptr) {
 
 var xTlsServerConnectionNew func(uintptr, uintptr, **glib.Error) uintptr
 
-// Creates a new #GTlsServerConnection wrapping @base_io_stream (which
+// Creates a new [TlsServerConnection] wrapping base_io_stream (which
 // must have pollable input and output streams).
 //
-// See the documentation for #GTlsConnection:base-io-stream for restrictions
-// on when application code can run operations on the @base_io_stream after
+// See the documentation for GTlsConnection:base-io-stream for restrictions
+// on when application code can run operations on the base_io_stream after
 // this function has returned.
 func TlsServerConnectionNew(BaseIoStreamVar *IOStream, CertificateVar *TlsCertificate) (*TlsServerConnectionBase, error) {
 	var cls *TlsServerConnectionBase
@@ -65,12 +65,15 @@ func TlsServerConnectionNew(BaseIoStreamVar *IOStream, CertificateVar *TlsCertif
 	cret := xTlsServerConnectionNew(BaseIoStreamVar.GoPointer(), CertificateVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &TlsServerConnectionBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -81,15 +84,17 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTlsServerConnectionNew, libs, "g_tls_server_connection_new")
+	core.PuregoRegisterSince(&xTlsServerConnectionNew, libs, "g_tls_server_connection_new", "GIO", "2.28")
 
 	core.PuregoSafeRegister(&xTlsServerConnectionGLibType, libs, "g_tls_server_connection_get_type")
 
+	gobject.CheckClassSize("GTlsServerConnectionInterface", xTlsServerConnectionGLibType, unsafe.Sizeof(TlsServerConnectionInterface{}))
+
 }
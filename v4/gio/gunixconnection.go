@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -29,7 +29,7 @@ func (x *UnixConnectionPrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// This is the subclass of [class@Gio.SocketConnection] that is created
+// This is the subclass of [SocketConnection] that is created
 // for UNIX domain sockets.
 //
 // It contains functions to do some of the UNIX socket specific
@@ -38,7 +38,7 @@ func (x *UnixConnectionPrivate) GoPointer() uintptr {
 // Since GLib 2.72, `GUnixConnection` is available on all platforms. It requires
 // underlying system support (such as Windows 10 with `AF_UNIX`) at run time.
 //
-// Before GLib 2.72, `&lt;gio/gunixconnection.h&gt;` belonged to the UNIX-specific GIO
+// Before GLib 2.72, `<gio/gunixconnection.h>` belonged to the UNIX-specific GIO
 // interfaces, thus you had to use the `gio-unix-2.0.pc` pkg-config file when
 // using it. This is no longer necessary since GLib 2.72.
 type UnixConnection struct {
@@ -60,7 +60,7 @@ func UnixConnectionNewFromInternalPtr(ptr uintptr) *UnixConnection {
 var xUnixConnectionReceiveCredentials func(uintptr, uintptr, **glib.Error) uintptr
 
 // Receives credentials from the sending end of the connection.  The
-// sending end has to call g_unix_connection_send_credentials() (or
+// sending end has to call [UnixConnection.SendCredentials] (or
 // similar) for this to work.
 //
 // As well as reading the credentials this also reads (and discards) a
@@ -76,7 +76,7 @@ var xUnixConnectionReceiveCredentials func(uintptr, uintptr, **glib.Error) uintp
 // - GNU/Hurd since GLib 2.40
 //
 // Other ways to exchange credentials with a foreign peer includes the
-// #GUnixCredentialsMessage type and g_socket_get_credentials() function.
+// [UnixCredentialsMessage] type and [Socket.GetCredentials] function.
 func (x *UnixConnection) ReceiveCredentials(CancellableVar *Cancellable) (*Credentials, error) {
 	var cls *Credentials
 	var cerr *glib.Error
@@ -84,12 +84,15 @@ func (x *UnixConnection) ReceiveCredentials(CancellableVar *Cancellable) (*Crede
 	cret := xUnixConnectionReceiveCredentials(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Credentials{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -99,11 +102,11 @@ var xUnixConnectionReceiveCredentialsAsync func(uintptr, uintptr, uintptr, uintp
 
 // Asynchronously receive credentials.
 //
-// For more details, see g_unix_connection_receive_credentials() which is
+// For more details, see [UnixConnection.ReceiveCredentials] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called. You can then call
-// g_unix_connection_receive_credentials_finish() to get the result of the operation.
+// When the operation is finished, callback will be called. You can then call
+// [UnixConnection.ReceiveCredentialsFinish] to get the result of the operation.
 func (x *UnixConnection) ReceiveCredentialsAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -113,11 +116,12 @@ func (x *UnixConnection) ReceiveCredentialsAsync(CancellableVar *Cancellable, Ca
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -128,7 +132,7 @@ func (x *UnixConnection) ReceiveCredentialsAsync(CancellableVar *Cancellable, Ca
 var xUnixConnectionReceiveCredentialsFinish func(uintptr, uintptr, **glib.Error) uintptr
 
 // Finishes an asynchronous receive credentials operation started with
-// g_unix_connection_receive_credentials_async().
+// [UnixConnection.ReceiveCredentialsAsync].
 func (x *UnixConnection) ReceiveCredentialsFinish(ResultVar AsyncResult) (*Credentials, error) {
 	var cls *Credentials
 	var cerr *glib.Error
@@ -136,12 +140,15 @@ func (x *UnixConnection) ReceiveCredentialsFinish(ResultVar AsyncResult) (*Crede
 	cret := xUnixConnectionReceiveCredentialsFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Credentials{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -150,7 +157,7 @@ func (x *UnixConnection) ReceiveCredentialsFinish(ResultVar AsyncResult) (*Crede
 var xUnixConnectionReceiveFd func(uintptr, uintptr, **glib.Error) int
 
 // Receives a file descriptor from the sending end of the connection.
-// The sending end has to call g_unix_connection_send_fd() for this
+// The sending end has to call [UnixConnection.SendFd] for this
 // to work.
 //
 // As well as reading the fd this also reads a single byte from the
@@ -161,7 +168,7 @@ func (x *UnixConnection) ReceiveFd(CancellableVar *Cancellable) (int, error) {
 
 	cret := xUnixConnectionReceiveFd(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -171,7 +178,7 @@ var xUnixConnectionSendCredentials func(uintptr, uintptr, **glib.Error) bool
 
 // Passes the credentials of the current user the receiving side
 // of the connection. The receiving end has to call
-// g_unix_connection_receive_credentials() (or similar) to accept the
+// [UnixConnection.ReceiveCredentials] (or similar) to accept the
 // credentials.
 //
 // As well as sending the credentials this also writes a single NUL
@@ -187,13 +194,13 @@ var xUnixConnectionSendCredentials func(uintptr, uintptr, **glib.Error) bool
 // - GNU/Hurd since GLib 2.40
 //
 // Other ways to exchange credentials with a foreign peer includes the
-// #GUnixCredentialsMessage type and g_socket_get_credentials() function.
+// [UnixCredentialsMessage] type and [Socket.GetCredentials] function.
 func (x *UnixConnection) SendCredentials(CancellableVar *Cancellable) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xUnixConnectionSendCredentials(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -203,11 +210,11 @@ var xUnixConnectionSendCredentialsAsync func(uintptr, uintptr, uintptr, uintptr)
 
 // Asynchronously send credentials.
 //
-// For more details, see g_unix_connection_send_credentials() which is
+// For more details, see [UnixConnection.SendCredentials] which is
 // the synchronous version of this call.
 //
-// When the operation is finished, @callback will be called. You can then call
-// g_unix_connection_send_credentials_finish() to get the result of the operation.
+// When the operation is finished, callback will be called. You can then call
+// [UnixConnection.SendCredentialsFinish] to get the result of the operation.
 func (x *UnixConnection) SendCredentialsAsync(CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -217,11 +224,12 @@ func (x *UnixConnection) SendCredentialsAsync(CancellableVar *Cancellable, Callb
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -232,13 +240,13 @@ func (x *UnixConnection) SendCredentialsAsync(CancellableVar *Cancellable, Callb
 var xUnixConnectionSendCredentialsFinish func(uintptr, uintptr, **glib.Error) bool
 
 // Finishes an asynchronous send credentials operation started with
-// g_unix_connection_send_credentials_async().
+// [UnixConnection.SendCredentialsAsync].
 func (x *UnixConnection) SendCredentialsFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xUnixConnectionSendCredentialsFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -247,7 +255,7 @@ func (x *UnixConnection) SendCredentialsFinish(ResultVar AsyncResult) (bool, err
 var xUnixConnectionSendFd func(uintptr, int, uintptr, **glib.Error) bool
 
 // Passes a file descriptor to the receiving side of the
-// connection. The receiving end has to call g_unix_connection_receive_fd()
+// connection. The receiving end has to call [UnixConnection.ReceiveFd]
 // to accept the file descriptor.
 //
 // As well as sending the fd this also writes a single byte to the
@@ -258,7 +266,7 @@ func (x *UnixConnection) SendFd(FdVar int, CancellableVar *Cancellable) (bool, e
 
 	cret := xUnixConnectionSendFd(x.GoPointer(), FdVar, CancellableVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -280,7 +288,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -289,13 +297,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnixConnectionGLibType, libs, "g_unix_connection_get_type")
 
-	core.PuregoSafeRegister(&xUnixConnectionReceiveCredentials, libs, "g_unix_connection_receive_credentials")
-	core.PuregoSafeRegister(&xUnixConnectionReceiveCredentialsAsync, libs, "g_unix_connection_receive_credentials_async")
-	core.PuregoSafeRegister(&xUnixConnectionReceiveCredentialsFinish, libs, "g_unix_connection_receive_credentials_finish")
-	core.PuregoSafeRegister(&xUnixConnectionReceiveFd, libs, "g_unix_connection_receive_fd")
-	core.PuregoSafeRegister(&xUnixConnectionSendCredentials, libs, "g_unix_connection_send_credentials")
-	core.PuregoSafeRegister(&xUnixConnectionSendCredentialsAsync, libs, "g_unix_connection_send_credentials_async")
-	core.PuregoSafeRegister(&xUnixConnectionSendCredentialsFinish, libs, "g_unix_connection_send_credentials_finish")
-	core.PuregoSafeRegister(&xUnixConnectionSendFd, libs, "g_unix_connection_send_fd")
+	gobject.CheckClassSize("GUnixConnectionClass", xUnixConnectionGLibType, unsafe.Sizeof(UnixConnectionClass{}))
+
+	core.PuregoRegisterSince(&xUnixConnectionReceiveCredentials, libs, "g_unix_connection_receive_credentials", "GIO", "2.26")
+	core.PuregoRegisterSince(&xUnixConnectionReceiveCredentialsAsync, libs, "g_unix_connection_receive_credentials_async", "GIO", "2.32")
+	core.PuregoRegisterSince(&xUnixConnectionReceiveCredentialsFinish, libs, "g_unix_connection_receive_credentials_finish", "GIO", "2.32")
+	core.PuregoRegisterSince(&xUnixConnectionReceiveFd, libs, "g_unix_connection_receive_fd", "GIO", "2.22")
+	core.PuregoRegisterSince(&xUnixConnectionSendCredentials, libs, "g_unix_connection_send_credentials", "GIO", "2.26")
+	core.PuregoRegisterSince(&xUnixConnectionSendCredentialsAsync, libs, "g_unix_connection_send_credentials_async", "GIO", "2.32")
+	core.PuregoRegisterSince(&xUnixConnectionSendCredentialsFinish, libs, "g_unix_connection_send_credentials_finish", "GIO", "2.32")
+	core.PuregoRegisterSince(&xUnixConnectionSendFd, libs, "g_unix_connection_send_fd", "GIO", "2.22")
 
 }
@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Class structure for #GUnixCredentialsMessage.
+// Class structure for [UnixCredentialsMessage].
 type UnixCredentialsMessageClass struct {
 	_ structs.HostLayout
 
@@ -31,7 +30,8 @@ func (x *UnixCredentialsMessageClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -43,7 +43,7 @@ func (x *UnixCredentialsMessageClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -54,7 +54,8 @@ func (x *UnixCredentialsMessageClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -66,7 +67,7 @@ func (x *UnixCredentialsMessageClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -80,23 +81,23 @@ func (x *UnixCredentialsMessagePrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// This [class@Gio.SocketControlMessage] contains a [class@Gio.Credentials]
-// instance.  It may be sent using [method@Gio.Socket.send_message] and received
-// using [method@Gio.Socket.receive_message] over UNIX sockets (ie: sockets in
+// This [SocketControlMessage] contains a [Credentials]
+// instance.  It may be sent using [Socket.SendMessage] and received
+// using [Socket.ReceiveMessage] over UNIX sockets (ie: sockets in
 // the `G_SOCKET_FAMILY_UNIX` family).
 //
 // For an easier way to send and receive credentials over
 // stream-oriented UNIX sockets, see
-// [method@Gio.UnixConnection.send_credentials] and
-// [method@Gio.UnixConnection.receive_credentials]. To receive credentials of
+// [UnixConnection.SendCredentials] and
+// [UnixConnection.ReceiveCredentials]. To receive credentials of
 // a foreign process connected to a socket, use
-// [method@Gio.Socket.get_credentials].
+// [Socket.GetCredentials].
 //
 // Since GLib 2.72, `GUnixCredentialMessage` is available on all platforms. It
 // requires underlying system support (such as Windows 10 with `AF_UNIX`) at run
 // time.
 //
-// Before GLib 2.72, `&lt;gio/gunixcredentialsmessage.h&gt;` belonged to the UNIX-specific
+// Before GLib 2.72, `<gio/gunixcredentialsmessage.h>` belonged to the UNIX-specific
 // GIO interfaces, thus you had to use the `gio-unix-2.0.pc` pkg-config file
 // when using it. This is no longer necessary since GLib 2.72.
 type UnixCredentialsMessage struct {
@@ -117,7 +118,7 @@ func UnixCredentialsMessageNewFromInternalPtr(ptr uintptr) *UnixCredentialsMessa
 
 var xNewUnixCredentialsMessage func() uintptr
 
-// Creates a new #GUnixCredentialsMessage with credentials matching the current processes.
+// Creates a new [UnixCredentialsMessage] with credentials matching the current processes.
 func NewUnixCredentialsMessage() *UnixCredentialsMessage {
 	var cls *UnixCredentialsMessage
 
@@ -133,7 +134,7 @@ func NewUnixCredentialsMessage() *UnixCredentialsMessage {
 
 var xNewUnixCredentialsMessageWithCredentials func(uintptr) uintptr
 
-// Creates a new #GUnixCredentialsMessage holding @credentials.
+// Creates a new [UnixCredentialsMessage] holding credentials.
 func NewUnixCredentialsMessageWithCredentials(CredentialsVar *Credentials) *UnixCredentialsMessage {
 	var cls *UnixCredentialsMessage
 
@@ -149,7 +150,7 @@ func NewUnixCredentialsMessageWithCredentials(CredentialsVar *Credentials) *Unix
 
 var xUnixCredentialsMessageGetCredentials func(uintptr) uintptr
 
-// Gets the credentials stored in @message.
+// Gets the credentials stored in message.
 func (x *UnixCredentialsMessage) GetCredentials() *Credentials {
 	var cls *Credentials
 
@@ -177,7 +178,7 @@ func (c *UnixCredentialsMessage) SetGoPointer(ptr uintptr) {
 
 var xUnixCredentialsMessageIsSupported func() bool
 
-// Checks if passing #GCredentials on a #GSocket is supported on this platform.
+// Checks if passing [Credentials] on a [Socket] is supported on this platform.
 func UnixCredentialsMessageIsSupported() bool {
 
 	cret := xUnixCredentialsMessageIsSupported()
@@ -189,7 +190,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -198,11 +199,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnixCredentialsMessageGLibType, libs, "g_unix_credentials_message_get_type")
 
-	core.PuregoSafeRegister(&xNewUnixCredentialsMessage, libs, "g_unix_credentials_message_new")
-	core.PuregoSafeRegister(&xNewUnixCredentialsMessageWithCredentials, libs, "g_unix_credentials_message_new_with_credentials")
+	gobject.CheckClassSize("GUnixCredentialsMessageClass", xUnixCredentialsMessageGLibType, unsafe.Sizeof(UnixCredentialsMessageClass{}))
 
-	core.PuregoSafeRegister(&xUnixCredentialsMessageGetCredentials, libs, "g_unix_credentials_message_get_credentials")
+	core.PuregoRegisterSince(&xNewUnixCredentialsMessage, libs, "g_unix_credentials_message_new", "GIO", "2.26")
+	core.PuregoRegisterSince(&xNewUnixCredentialsMessageWithCredentials, libs, "g_unix_credentials_message_new_with_credentials", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xUnixCredentialsMessageIsSupported, libs, "g_unix_credentials_message_is_supported")
+	core.PuregoRegisterSince(&xUnixCredentialsMessageGetCredentials, libs, "g_unix_credentials_message_get_credentials", "GIO", "2.26")
+
+	core.PuregoRegisterSince(&xUnixCredentialsMessageIsSupported, libs, "g_unix_credentials_message_is_supported", "GIO", "2.26")
 
 }
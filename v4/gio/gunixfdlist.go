@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -37,7 +36,8 @@ func (x *UnixFDListClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -49,7 +49,7 @@ func (x *UnixFDListClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -60,7 +60,8 @@ func (x *UnixFDListClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -72,7 +73,7 @@ func (x *UnixFDListClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -83,7 +84,8 @@ func (x *UnixFDListClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -95,7 +97,7 @@ func (x *UnixFDListClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -106,7 +108,8 @@ func (x *UnixFDListClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -118,7 +121,7 @@ func (x *UnixFDListClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -129,7 +132,8 @@ func (x *UnixFDListClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -141,7 +145,7 @@ func (x *UnixFDListClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -159,12 +163,12 @@ func (x *UnixFDListPrivate) GoPointer() uintptr {
 // descriptors that it contains, closing them when finalized.
 //
 // It may be wrapped in a
-// [`GUnixFDMessage`](../gio-unix/class.UnixFDMessage.html) and sent over a
-// [class@Gio.Socket] in the `G_SOCKET_FAMILY_UNIX` family by using
-// [method@Gio.Socket.send_message] and received using
-// [method@Gio.Socket.receive_message].
+// GUnixFDMessage and sent over a
+// [Socket] in the `G_SOCKET_FAMILY_UNIX` family by using
+// [Socket.SendMessage] and received using
+// [Socket.ReceiveMessage].
 //
-// Before 2.74, `&lt;gio/gunixfdlist.h&gt;` belonged to the UNIX-specific GIO
+// Before 2.74, `<gio/gunixfdlist.h>` belonged to the UNIX-specific GIO
 // interfaces, thus you had to use the `gio-unix-2.0.pc` pkg-config file when
 // using it.
 //
@@ -187,7 +191,7 @@ func UnixFDListNewFromInternalPtr(ptr uintptr) *UnixFDList {
 
 var xNewUnixFDList func() uintptr
 
-// Creates a new #GUnixFDList containing no file descriptors.
+// Creates a new [UnixFDList] containing no file descriptors.
 func NewUnixFDList() *UnixFDList {
 	var cls *UnixFDList
 
@@ -203,14 +207,14 @@ func NewUnixFDList() *UnixFDList {
 
 var xNewUnixFDListFromArray func([]int, int) uintptr
 
-// Creates a new #GUnixFDList containing the file descriptors given in
-// @fds.  The file descriptors become the property of the new list and
+// Creates a new [UnixFDList] containing the file descriptors given in
+// fds.  The file descriptors become the property of the new list and
 // may no longer be used by the caller.  The array itself is owned by
 // the caller.
 //
 // Each file descriptor in the array should be set to close-on-exec.
 //
-// If @n_fds is -1 then @fds must be terminated with -1.
+// If n_fds is -1 then fds must be terminated with -1.
 func NewUnixFDListFromArray(FdsVar []int, NFdsVar int) *UnixFDList {
 	var cls *UnixFDList
 
@@ -226,24 +230,24 @@ func NewUnixFDListFromArray(FdsVar []int, NFdsVar int) *UnixFDList {
 
 var xUnixFDListAppend func(uintptr, int, **glib.Error) int
 
-// Adds a file descriptor to @list.
+// Adds a file descriptor to list.
 //
 // The file descriptor is duplicated using dup(). You keep your copy
-// of the descriptor and the copy contained in @list will be closed
-// when @list is finalized.
+// of the descriptor and the copy contained in list will be closed
+// when list is finalized.
 //
 // A possible cause of failure is exceeding the per-process or
 // system-wide file descriptor limit.
 //
 // The index of the file descriptor in the list is returned.  If you use
-// this index with g_unix_fd_list_get() then you will receive back a
+// this index with [UnixFDList.Get] then you will receive back a
 // duplicated copy of the same file descriptor.
 func (x *UnixFDList) Append(FdVar int) (int, error) {
 	var cerr *glib.Error
 
 	cret := xUnixFDListAppend(x.GoPointer(), FdVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -251,11 +255,11 @@ func (x *UnixFDList) Append(FdVar int) (int, error) {
 
 var xUnixFDListGet func(uintptr, int, **glib.Error) int
 
-// Gets a file descriptor out of @list.
+// Gets a file descriptor out of list.
 //
-// @index_ specifies the index of the file descriptor to get.  It is a
-// programmer error for @index_ to be out of range; see
-// g_unix_fd_list_get_length().
+// index_ specifies the index of the file descriptor to get.  It is a
+// programmer error for index_ to be out of range; see
+// [UnixFDList.GetLength].
 //
 // The file descriptor is duplicated using dup() and set as
 // close-on-exec before being returned.  You must call close() on it
@@ -268,7 +272,7 @@ func (x *UnixFDList) Get(IndexVar int) (int, error) {
 
 	cret := xUnixFDListGet(x.GoPointer(), IndexVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -276,7 +280,7 @@ func (x *UnixFDList) Get(IndexVar int) (int, error) {
 
 var xUnixFDListGetLength func(uintptr) int
 
-// Gets the length of @list (ie: the number of file descriptors
+// Gets the length of list (ie: the number of file descriptors
 // contained within).
 func (x *UnixFDList) GetLength() int {
 
@@ -289,16 +293,16 @@ var xUnixFDListPeekFds func(uintptr, *int) uintptr
 // Returns the array of file descriptors that is contained in this
 // object.
 //
-// After this call, the descriptors remain the property of @list.  The
+// After this call, the descriptors remain the property of list.  The
 // caller must not close them and must not free the array.  The array is
-// valid only until @list is changed in any way.
+// valid only until list is changed in any way.
 //
-// If @length is non-%NULL then it is set to the number of file
+// If length is non-nil then it is set to the number of file
 // descriptors in the returned array. The returned array is also
 // terminated with -1.
 //
-// This function never returns %NULL. In case there are no file
-// descriptors contained in @list, an empty array is returned.
+// This function never returns nil. In case there are no file
+// descriptors contained in list, an empty array is returned.
 func (x *UnixFDList) PeekFds(LengthVar *int) uintptr {
 
 	cret := xUnixFDListPeekFds(x.GoPointer(), LengthVar)
@@ -311,20 +315,20 @@ var xUnixFDListStealFds func(uintptr, *int) uintptr
 // object.
 //
 // After this call, the descriptors are no longer contained in
-// @list. Further calls will return an empty list (unless more
+// list. Further calls will return an empty list (unless more
 // descriptors have been added).
 //
-// The return result of this function must be freed with g_free().
+// The return result of this function must be freed with [glib.Free].
 // The caller is also responsible for closing all of the file
 // descriptors.  The file descriptors in the array are set to
 // close-on-exec.
 //
-// If @length is non-%NULL then it is set to the number of file
+// If length is non-nil then it is set to the number of file
 // descriptors in the returned array. The returned array is also
 // terminated with -1.
 //
-// This function never returns %NULL. In case there are no file
-// descriptors contained in @list, an empty array is returned.
+// This function never returns nil. In case there are no file
+// descriptors contained in list, an empty array is returned.
 func (x *UnixFDList) StealFds(LengthVar *int) uintptr {
 
 	cret := xUnixFDListStealFds(x.GoPointer(), LengthVar)
@@ -347,7 +351,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -356,13 +360,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnixFDListGLibType, libs, "g_unix_fd_list_get_type")
 
-	core.PuregoSafeRegister(&xNewUnixFDList, libs, "g_unix_fd_list_new")
-	core.PuregoSafeRegister(&xNewUnixFDListFromArray, libs, "g_unix_fd_list_new_from_array")
+	gobject.CheckClassSize("GUnixFDListClass", xUnixFDListGLibType, unsafe.Sizeof(UnixFDListClass{}))
 
-	core.PuregoSafeRegister(&xUnixFDListAppend, libs, "g_unix_fd_list_append")
-	core.PuregoSafeRegister(&xUnixFDListGet, libs, "g_unix_fd_list_get")
-	core.PuregoSafeRegister(&xUnixFDListGetLength, libs, "g_unix_fd_list_get_length")
-	core.PuregoSafeRegister(&xUnixFDListPeekFds, libs, "g_unix_fd_list_peek_fds")
-	core.PuregoSafeRegister(&xUnixFDListStealFds, libs, "g_unix_fd_list_steal_fds")
+	core.PuregoRegisterSince(&xNewUnixFDList, libs, "g_unix_fd_list_new", "GIO", "2.24")
+	core.PuregoRegisterSince(&xNewUnixFDListFromArray, libs, "g_unix_fd_list_new_from_array", "GIO", "2.24")
+
+	core.PuregoRegisterSince(&xUnixFDListAppend, libs, "g_unix_fd_list_append", "GIO", "2.24")
+	core.PuregoRegisterSince(&xUnixFDListGet, libs, "g_unix_fd_list_get", "GIO", "2.24")
+	core.PuregoRegisterSince(&xUnixFDListGetLength, libs, "g_unix_fd_list_get_length", "GIO", "2.24")
+	core.PuregoRegisterSince(&xUnixFDListPeekFds, libs, "g_unix_fd_list_peek_fds", "GIO", "2.24")
+	core.PuregoRegisterSince(&xUnixFDListStealFds, libs, "g_unix_fd_list_steal_fds", "GIO", "2.24")
 
 }
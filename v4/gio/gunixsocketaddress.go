@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,14 +38,14 @@ func (x *UnixSocketAddressPrivate) GoPointer() uintptr {
 // permissions, visibility, etc. Currently this is only supported
 // under Linux. If you attempt to use abstract sockets on other
 // systems, function calls may return `G_IO_ERROR_NOT_SUPPORTED`
-// errors. You can use [func@Gio.UnixSocketAddress.abstract_names_supported]
+// errors. You can use [UnixSocketAddressAbstractNamesSupported]
 // to see if abstract names are supported.
 //
 // Since GLib 2.72, `GUnixSocketAddress` is available on all platforms. It
 // requires underlying system support (such as Windows 10 with `AF_UNIX`) at
 // run time.
 //
-// Before GLib 2.72, `&lt;gio/gunixsocketaddress.h&gt;` belonged to the UNIX-specific
+// Before GLib 2.72, `<gio/gunixsocketaddress.h>` belonged to the UNIX-specific
 // GIO interfaces, thus you had to use the `gio-unix-2.0.pc` pkg-config file
 // when using it. This is no longer necessary since GLib 2.72.
 type UnixSocketAddress struct {
@@ -67,10 +66,10 @@ func UnixSocketAddressNewFromInternalPtr(ptr uintptr) *UnixSocketAddress {
 
 var xNewUnixSocketAddress func(string) uintptr
 
-// Creates a new #GUnixSocketAddress for @path.
+// Creates a new [UnixSocketAddress] for path.
 //
 // To create abstract socket addresses, on systems that support that,
-// use g_unix_socket_address_new_abstract().
+// use [NewUnixSocketAddressAbstract].
 func NewUnixSocketAddress(PathVar string) *UnixSocketAddress {
 	var cls *UnixSocketAddress
 
@@ -84,53 +83,36 @@ func NewUnixSocketAddress(PathVar string) *UnixSocketAddress {
 	return cls
 }
 
-var xNewUnixSocketAddressAbstract func([]byte, int) uintptr
-
-// Creates a new %G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED
-// #GUnixSocketAddress for @path.
-func NewUnixSocketAddressAbstract(PathVar []byte, PathLenVar int) *UnixSocketAddress {
-	var cls *UnixSocketAddress
-
-	cret := xNewUnixSocketAddressAbstract(PathVar, PathLenVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &UnixSocketAddress{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xNewUnixSocketAddressWithType func([]byte, int, UnixSocketAddressType) uintptr
 
-// Creates a new #GUnixSocketAddress of type @type with name @path.
+// Creates a new [UnixSocketAddress] of type type with name path.
 //
-// If @type is %G_UNIX_SOCKET_ADDRESS_PATH, this is equivalent to
-// calling g_unix_socket_address_new().
+// If type is [GUnixSocketAddressPathValue], this is equivalent to
+// calling [NewUnixSocketAddress].
 //
-// If @type is %G_UNIX_SOCKET_ADDRESS_ANONYMOUS, @path and @path_len will be
+// If type is [GUnixSocketAddressAnonymousValue], path and path_len will be
 // ignored.
 //
-// If @path_type is %G_UNIX_SOCKET_ADDRESS_ABSTRACT, then @path_len
-// bytes of @path will be copied to the socket's path, and only those
-// bytes will be considered part of the name. (If @path_len is -1,
-// then @path is assumed to be NUL-terminated.) For example, if @path
-// was "test", then calling g_socket_address_get_native_size() on the
+// If path_type is [GUnixSocketAddressAbstractValue], then path_len
+// bytes of path will be copied to the socket's path, and only those
+// bytes will be considered part of the name. (If path_len is -1,
+// then path is assumed to be NUL-terminated.) For example, if path
+// was "test", then calling [SocketAddress.GetNativeSize] on the
 // returned socket would return 7 (2 bytes of overhead, 1 byte for the
 // abstract-socket indicator byte, and 4 bytes for the name "test").
 //
-// If @path_type is %G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED, then
-// @path_len bytes of @path will be copied to the socket's path, the
+// If path_type is [GUnixSocketAddressAbstractPaddedValue], then
+// path_len bytes of path will be copied to the socket's path, the
 // rest of the path will be padded with 0 bytes, and the entire
 // zero-padded buffer will be considered the name. (As above, if
-// @path_len is -1, then @path is assumed to be NUL-terminated.) In
-// this case, g_socket_address_get_native_size() will always return
+// path_len is -1, then path is assumed to be NUL-terminated.) In
+// this case, [SocketAddress.GetNativeSize] will always return
 // the full size of a `struct sockaddr_un`, although
-// g_unix_socket_address_get_path_len() will still return just the
-// length of @path.
+// [UnixSocketAddress.GetPathLen] will still return just the
+// length of path.
 //
-// %G_UNIX_SOCKET_ADDRESS_ABSTRACT is preferred over
-// %G_UNIX_SOCKET_ADDRESS_ABSTRACT_PADDED for new programs. Of course,
+// [GUnixSocketAddressAbstractValue] is preferred over
+// [GUnixSocketAddressAbstractPaddedValue] for new programs. Of course,
 // when connecting to a server created by another process, you must
 // use the appropriate type corresponding to how that process created
 // its listening socket.
@@ -149,29 +131,20 @@ func NewUnixSocketAddressWithType(PathVar []byte, PathLenVar int, TypeVar UnixSo
 
 var xUnixSocketAddressGetAddressType func(uintptr) UnixSocketAddressType
 
-// Gets @address's type.
+// Gets address's type.
 func (x *UnixSocketAddress) GetAddressType() UnixSocketAddressType {
 
 	cret := xUnixSocketAddressGetAddressType(x.GoPointer())
 	return cret
 }
 
-var xUnixSocketAddressGetIsAbstract func(uintptr) bool
-
-// Tests if @address is abstract.
-func (x *UnixSocketAddress) GetIsAbstract() bool {
-
-	cret := xUnixSocketAddressGetIsAbstract(x.GoPointer())
-	return cret
-}
-
 var xUnixSocketAddressGetPath func(uintptr) string
 
-// Gets @address's path, or for abstract sockets the "name".
+// Gets address's path, or for abstract sockets the "name".
 //
 // Guaranteed to be zero-terminated, but an abstract socket
 // may contain embedded zeros, and thus you should use
-// g_unix_socket_address_get_path_len() to get the true length
+// [UnixSocketAddress.GetPathLen] to get the true length
 // of this string.
 func (x *UnixSocketAddress) GetPath() string {
 
@@ -181,9 +154,9 @@ func (x *UnixSocketAddress) GetPath() string {
 
 var xUnixSocketAddressGetPathLen func(uintptr) uint
 
-// Gets the length of @address's path.
+// Gets the length of address's path.
 //
-// For details, see g_unix_socket_address_get_path().
+// For details, see [UnixSocketAddress.GetPath].
 func (x *UnixSocketAddress) GetPathLen() uint {
 
 	cret := xUnixSocketAddressGetPathLen(x.GoPointer())
@@ -203,6 +176,10 @@ func (c *UnixSocketAddress) SetGoPointer(ptr uintptr) {
 
 // SetPropertyAbstract sets the "abstract" property.
 // Whether or not this is an abstract address
+//
+// Deprecated: Use GUnixSocketAddress:address-type, which
+// distinguishes between zero-padded and non-zero-padded
+// abstract addresses.
 func (x *UnixSocketAddress) SetPropertyAbstract(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -212,6 +189,10 @@ func (x *UnixSocketAddress) SetPropertyAbstract(value bool) {
 
 // GetPropertyAbstract gets the "abstract" property.
 // Whether or not this is an abstract address
+//
+// Deprecated: Use GUnixSocketAddress:address-type, which
+// distinguishes between zero-padded and non-zero-padded
+// abstract addresses.
 func (x *UnixSocketAddress) GetPropertyAbstract() bool {
 	var v gobject.Value
 	x.GetProperty("abstract", &v)
@@ -262,7 +243,7 @@ func (x *UnixSocketAddress) GetPropertyPathAsArray() []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(dataPtr)), length)
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable.
+// Creates a [SocketAddressEnumerator] for connectable.
 func (x *UnixSocketAddress) Enumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -276,13 +257,13 @@ func (x *UnixSocketAddress) Enumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Creates a #GSocketAddressEnumerator for @connectable that will
-// return a #GProxyAddress for each of its addresses that you must connect
+// Creates a [SocketAddressEnumerator] for connectable that will
+// return a [ProxyAddress] for each of its addresses that you must connect
 // to via a proxy.
 //
-// If @connectable does not implement
-// g_socket_connectable_proxy_enumerate(), this will fall back to
-// calling g_socket_connectable_enumerate().
+// If connectable does not implement
+// [SocketConnectable.ProxyEnumerate], this will fall back to
+// calling [SocketConnectable.Enumerate].
 func (x *UnixSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	var cls *SocketAddressEnumerator
 
@@ -296,12 +277,12 @@ func (x *UnixSocketAddress) ProxyEnumerate() *SocketAddressEnumerator {
 	return cls
 }
 
-// Format a #GSocketConnectable as a string. This is a human-readable format for
+// Format a [SocketConnectable] as a string. This is a human-readable format for
 // use in debugging output, and is not a stable serialization format. It is not
 // suitable for use in user interfaces as it exposes too much information for a
 // user.
 //
-// If the #GSocketConnectable implementation does not support string formatting,
+// If the [SocketConnectable] implementation does not support string formatting,
 // the implementation’s type name will be returned as a fallback.
 func (x *UnixSocketAddress) ToString() string {
 
@@ -323,7 +304,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -332,15 +313,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnixSocketAddressGLibType, libs, "g_unix_socket_address_get_type")
 
-	core.PuregoSafeRegister(&xNewUnixSocketAddress, libs, "g_unix_socket_address_new")
-	core.PuregoSafeRegister(&xNewUnixSocketAddressAbstract, libs, "g_unix_socket_address_new_abstract")
-	core.PuregoSafeRegister(&xNewUnixSocketAddressWithType, libs, "g_unix_socket_address_new_with_type")
+	gobject.CheckClassSize("GUnixSocketAddressClass", xUnixSocketAddressGLibType, unsafe.Sizeof(UnixSocketAddressClass{}))
+
+	core.PuregoRegisterSince(&xNewUnixSocketAddress, libs, "g_unix_socket_address_new", "GIO", "2.22")
+	core.PuregoRegisterSince(&xNewUnixSocketAddressWithType, libs, "g_unix_socket_address_new_with_type", "GIO", "2.26")
 
-	core.PuregoSafeRegister(&xUnixSocketAddressGetAddressType, libs, "g_unix_socket_address_get_address_type")
-	core.PuregoSafeRegister(&xUnixSocketAddressGetIsAbstract, libs, "g_unix_socket_address_get_is_abstract")
-	core.PuregoSafeRegister(&xUnixSocketAddressGetPath, libs, "g_unix_socket_address_get_path")
-	core.PuregoSafeRegister(&xUnixSocketAddressGetPathLen, libs, "g_unix_socket_address_get_path_len")
+	core.PuregoRegisterSince(&xUnixSocketAddressGetAddressType, libs, "g_unix_socket_address_get_address_type", "GIO", "2.26")
+	core.PuregoRegisterSince(&xUnixSocketAddressGetPath, libs, "g_unix_socket_address_get_path", "GIO", "2.22")
+	core.PuregoRegisterSince(&xUnixSocketAddressGetPathLen, libs, "g_unix_socket_address_get_path_len", "GIO", "2.22")
 
-	core.PuregoSafeRegister(&xUnixSocketAddressAbstractNamesSupported, libs, "g_unix_socket_address_abstract_names_supported")
+	core.PuregoRegisterSince(&xUnixSocketAddressAbstractNamesSupported, libs, "g_unix_socket_address_abstract_names_supported", "GIO", "2.22")
 
 }
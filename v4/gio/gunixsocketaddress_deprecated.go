@@ -0,0 +1,56 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewUnixSocketAddressAbstract func([]byte, int) uintptr
+
+// Creates a new [GUnixSocketAddressAbstractPaddedValue]
+// [UnixSocketAddress] for path.
+//
+// Deprecated: Use [NewUnixSocketAddressWithType].
+func NewUnixSocketAddressAbstract(PathVar []byte, PathLenVar int) *UnixSocketAddress {
+	var cls *UnixSocketAddress
+
+	cret := xNewUnixSocketAddressAbstract(PathVar, PathLenVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &UnixSocketAddress{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xUnixSocketAddressGetIsAbstract func(uintptr) bool
+
+// Tests if address is abstract.
+//
+// Deprecated: Use [UnixSocketAddress.GetAddressType]
+func (x *UnixSocketAddress) GetIsAbstract() bool {
+
+	cret := xUnixSocketAddressGetIsAbstract(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewUnixSocketAddressAbstract, libs, "g_unix_socket_address_new_abstract", "GIO", "")
+
+	core.PuregoRegisterSince(&xUnixSocketAddressGetIsAbstract, libs, "g_unix_socket_address_get_is_abstract", "GIO", "2.22")
+
+}
@@ -5,19 +5,18 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// This function type is used by g_vfs_register_uri_scheme() to make it
-// possible for a client to associate a URI scheme to a different #GFile
+// This function type is used by [Vfs.RegisterUriScheme] to make it
+// possible for a client to associate a URI scheme to a different [File]
 // implementation.
 //
 // The client should return a reference to the new file that has been
-// created for @uri, or %NULL to continue with the default implementation.
+// created for uri, or nil to continue with the default implementation.
 type VfsFileLookupFunc func(uintptr, string, uintptr) uintptr
 
 type VfsClass struct {
@@ -69,7 +68,8 @@ func (x *VfsClass) OverrideIsActive(cb func(*Vfs) bool) {
 	if cb == nil {
 		x.xIsActive = 0
 	} else {
-		x.xIsActive = purego.NewCallback(func(VfsVarp uintptr) bool {
+		x.xIsActive = core.NewCallback(func(VfsVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(VfsNewFromInternalPtr(VfsVarp))
 		})
 	}
@@ -81,7 +81,7 @@ func (x *VfsClass) GetIsActive() func(*Vfs) bool {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsActive)
+	core.RegisterFunc(&rawCallback, x.xIsActive)
 	return func(VfsVar *Vfs) bool {
 		return rawCallback(VfsVar.GoPointer())
 	}
@@ -92,7 +92,8 @@ func (x *VfsClass) OverrideGetFileForPath(cb func(*Vfs, string) *FileBase) {
 	if cb == nil {
 		x.xGetFileForPath = 0
 	} else {
-		x.xGetFileForPath = purego.NewCallback(func(VfsVarp uintptr, PathVarp string) uintptr {
+		x.xGetFileForPath = core.NewCallback(func(VfsVarp uintptr, PathVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VfsNewFromInternalPtr(VfsVarp), PathVarp)
 			if ret == nil {
 				return 0
@@ -108,7 +109,7 @@ func (x *VfsClass) GetGetFileForPath() func(*Vfs, string) *FileBase {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, PathVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFileForPath)
+	core.RegisterFunc(&rawCallback, x.xGetFileForPath)
 	return func(VfsVar *Vfs, PathVar string) *FileBase {
 		rawRet := rawCallback(VfsVar.GoPointer(), PathVar)
 		if rawRet == 0 {
@@ -125,7 +126,8 @@ func (x *VfsClass) OverrideGetFileForUri(cb func(*Vfs, string) *FileBase) {
 	if cb == nil {
 		x.xGetFileForUri = 0
 	} else {
-		x.xGetFileForUri = purego.NewCallback(func(VfsVarp uintptr, UriVarp string) uintptr {
+		x.xGetFileForUri = core.NewCallback(func(VfsVarp uintptr, UriVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VfsNewFromInternalPtr(VfsVarp), UriVarp)
 			if ret == nil {
 				return 0
@@ -141,7 +143,7 @@ func (x *VfsClass) GetGetFileForUri() func(*Vfs, string) *FileBase {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, UriVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFileForUri)
+	core.RegisterFunc(&rawCallback, x.xGetFileForUri)
 	return func(VfsVar *Vfs, UriVar string) *FileBase {
 		rawRet := rawCallback(VfsVar.GoPointer(), UriVar)
 		if rawRet == 0 {
@@ -158,7 +160,8 @@ func (x *VfsClass) OverrideGetSupportedUriSchemes(cb func(*Vfs) []string) {
 	if cb == nil {
 		x.xGetSupportedUriSchemes = 0
 	} else {
-		x.xGetSupportedUriSchemes = purego.NewCallback(func(VfsVarp uintptr) []string {
+		x.xGetSupportedUriSchemes = core.NewCallback(func(VfsVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(VfsNewFromInternalPtr(VfsVarp))
 		})
 	}
@@ -170,7 +173,7 @@ func (x *VfsClass) GetGetSupportedUriSchemes() func(*Vfs) []string {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xGetSupportedUriSchemes)
+	core.RegisterFunc(&rawCallback, x.xGetSupportedUriSchemes)
 	return func(VfsVar *Vfs) []string {
 		return rawCallback(VfsVar.GoPointer())
 	}
@@ -181,7 +184,8 @@ func (x *VfsClass) OverrideParseName(cb func(*Vfs, string) *FileBase) {
 	if cb == nil {
 		x.xParseName = 0
 	} else {
-		x.xParseName = purego.NewCallback(func(VfsVarp uintptr, ParseNameVarp string) uintptr {
+		x.xParseName = core.NewCallback(func(VfsVarp uintptr, ParseNameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VfsNewFromInternalPtr(VfsVarp), ParseNameVarp)
 			if ret == nil {
 				return 0
@@ -197,7 +201,7 @@ func (x *VfsClass) GetParseName() func(*Vfs, string) *FileBase {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, ParseNameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xParseName)
+	core.RegisterFunc(&rawCallback, x.xParseName)
 	return func(VfsVar *Vfs, ParseNameVar string) *FileBase {
 		rawRet := rawCallback(VfsVar.GoPointer(), ParseNameVar)
 		if rawRet == 0 {
@@ -214,7 +218,8 @@ func (x *VfsClass) OverrideLocalFileAddInfo(cb func(*Vfs, string, uint64, *FileA
 	if cb == nil {
 		x.xLocalFileAddInfo = 0
 	} else {
-		x.xLocalFileAddInfo = purego.NewCallback(func(VfsVarp uintptr, FilenameVarp string, DeviceVarp uint64, AttributeMatcherVarp *FileAttributeMatcher, InfoVarp uintptr, CancellableVarp uintptr, ExtraDataVarp uintptr, FreeExtraDataVarp uintptr) {
+		x.xLocalFileAddInfo = core.NewCallback(func(VfsVarp uintptr, FilenameVarp string, DeviceVarp uint64, AttributeMatcherVarp *FileAttributeMatcher, InfoVarp uintptr, CancellableVarp uintptr, ExtraDataVarp uintptr, FreeExtraDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VfsNewFromInternalPtr(VfsVarp), FilenameVarp, DeviceVarp, AttributeMatcherVarp, FileInfoNewFromInternalPtr(InfoVarp), CancellableNewFromInternalPtr(CancellableVarp), ExtraDataVarp, (*glib.DestroyNotify)(unsafe.Pointer(FreeExtraDataVarp)))
 		})
 	}
@@ -226,7 +231,7 @@ func (x *VfsClass) GetLocalFileAddInfo() func(*Vfs, string, uint64, *FileAttribu
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, FilenameVarp string, DeviceVarp uint64, AttributeMatcherVarp *FileAttributeMatcher, InfoVarp uintptr, CancellableVarp uintptr, ExtraDataVarp uintptr, FreeExtraDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xLocalFileAddInfo)
+	core.RegisterFunc(&rawCallback, x.xLocalFileAddInfo)
 	return func(VfsVar *Vfs, FilenameVar string, DeviceVar uint64, AttributeMatcherVar *FileAttributeMatcher, InfoVar *FileInfo, CancellableVar *Cancellable, ExtraDataVar uintptr, FreeExtraDataVar *glib.DestroyNotify) {
 		rawCallback(VfsVar.GoPointer(), FilenameVar, DeviceVar, AttributeMatcherVar, InfoVar.GoPointer(), CancellableVar.GoPointer(), ExtraDataVar, glib.NewCallback(FreeExtraDataVar))
 	}
@@ -237,7 +242,8 @@ func (x *VfsClass) OverrideAddWritableNamespaces(cb func(*Vfs, *FileAttributeInf
 	if cb == nil {
 		x.xAddWritableNamespaces = 0
 	} else {
-		x.xAddWritableNamespaces = purego.NewCallback(func(VfsVarp uintptr, ListVarp *FileAttributeInfoList) {
+		x.xAddWritableNamespaces = core.NewCallback(func(VfsVarp uintptr, ListVarp *FileAttributeInfoList) {
+			defer core.RecoverPanic()
 			cb(VfsNewFromInternalPtr(VfsVarp), ListVarp)
 		})
 	}
@@ -249,7 +255,7 @@ func (x *VfsClass) GetAddWritableNamespaces() func(*Vfs, *FileAttributeInfoList)
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, ListVarp *FileAttributeInfoList)
-	purego.RegisterFunc(&rawCallback, x.xAddWritableNamespaces)
+	core.RegisterFunc(&rawCallback, x.xAddWritableNamespaces)
 	return func(VfsVar *Vfs, ListVar *FileAttributeInfoList) {
 		rawCallback(VfsVar.GoPointer(), ListVar)
 	}
@@ -260,7 +266,8 @@ func (x *VfsClass) OverrideLocalFileSetAttributes(cb func(*Vfs, string, *FileInf
 	if cb == nil {
 		x.xLocalFileSetAttributes = 0
 	} else {
-		x.xLocalFileSetAttributes = purego.NewCallback(func(VfsVarp uintptr, FilenameVarp string, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+		x.xLocalFileSetAttributes = core.NewCallback(func(VfsVarp uintptr, FilenameVarp string, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(VfsNewFromInternalPtr(VfsVarp), FilenameVarp, FileInfoNewFromInternalPtr(InfoVarp), FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp))
 		})
 	}
@@ -272,7 +279,7 @@ func (x *VfsClass) GetLocalFileSetAttributes() func(*Vfs, string, *FileInfo, Fil
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, FilenameVarp string, InfoVarp uintptr, FlagsVarp FileQueryInfoFlags, CancellableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xLocalFileSetAttributes)
+	core.RegisterFunc(&rawCallback, x.xLocalFileSetAttributes)
 	return func(VfsVar *Vfs, FilenameVar string, InfoVar *FileInfo, FlagsVar FileQueryInfoFlags, CancellableVar *Cancellable) bool {
 		return rawCallback(VfsVar.GoPointer(), FilenameVar, InfoVar.GoPointer(), FlagsVar, CancellableVar.GoPointer())
 	}
@@ -283,7 +290,8 @@ func (x *VfsClass) OverrideLocalFileRemoved(cb func(*Vfs, string)) {
 	if cb == nil {
 		x.xLocalFileRemoved = 0
 	} else {
-		x.xLocalFileRemoved = purego.NewCallback(func(VfsVarp uintptr, FilenameVarp string) {
+		x.xLocalFileRemoved = core.NewCallback(func(VfsVarp uintptr, FilenameVarp string) {
+			defer core.RecoverPanic()
 			cb(VfsNewFromInternalPtr(VfsVarp), FilenameVarp)
 		})
 	}
@@ -295,7 +303,7 @@ func (x *VfsClass) GetLocalFileRemoved() func(*Vfs, string) {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, FilenameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xLocalFileRemoved)
+	core.RegisterFunc(&rawCallback, x.xLocalFileRemoved)
 	return func(VfsVar *Vfs, FilenameVar string) {
 		rawCallback(VfsVar.GoPointer(), FilenameVar)
 	}
@@ -306,7 +314,8 @@ func (x *VfsClass) OverrideLocalFileMoved(cb func(*Vfs, string, string)) {
 	if cb == nil {
 		x.xLocalFileMoved = 0
 	} else {
-		x.xLocalFileMoved = purego.NewCallback(func(VfsVarp uintptr, SourceVarp string, DestVarp string) {
+		x.xLocalFileMoved = core.NewCallback(func(VfsVarp uintptr, SourceVarp string, DestVarp string) {
+			defer core.RecoverPanic()
 			cb(VfsNewFromInternalPtr(VfsVarp), SourceVarp, DestVarp)
 		})
 	}
@@ -318,7 +327,7 @@ func (x *VfsClass) GetLocalFileMoved() func(*Vfs, string, string) {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, SourceVarp string, DestVarp string)
-	purego.RegisterFunc(&rawCallback, x.xLocalFileMoved)
+	core.RegisterFunc(&rawCallback, x.xLocalFileMoved)
 	return func(VfsVar *Vfs, SourceVar string, DestVar string) {
 		rawCallback(VfsVar.GoPointer(), SourceVar, DestVar)
 	}
@@ -329,7 +338,8 @@ func (x *VfsClass) OverrideDeserializeIcon(cb func(*Vfs, *glib.Variant) *IconBas
 	if cb == nil {
 		x.xDeserializeIcon = 0
 	} else {
-		x.xDeserializeIcon = purego.NewCallback(func(VfsVarp uintptr, ValueVarp *glib.Variant) uintptr {
+		x.xDeserializeIcon = core.NewCallback(func(VfsVarp uintptr, ValueVarp *glib.Variant) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VfsNewFromInternalPtr(VfsVarp), ValueVarp)
 			if ret == nil {
 				return 0
@@ -345,7 +355,7 @@ func (x *VfsClass) GetDeserializeIcon() func(*Vfs, *glib.Variant) *IconBase {
 		return nil
 	}
 	var rawCallback func(VfsVarp uintptr, ValueVarp *glib.Variant) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDeserializeIcon)
+	core.RegisterFunc(&rawCallback, x.xDeserializeIcon)
 	return func(VfsVar *Vfs, ValueVar *glib.Variant) *IconBase {
 		rawRet := rawCallback(VfsVar.GoPointer(), ValueVar)
 		if rawRet == 0 {
@@ -362,7 +372,8 @@ func (x *VfsClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -374,7 +385,7 @@ func (x *VfsClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -385,7 +396,8 @@ func (x *VfsClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -397,7 +409,7 @@ func (x *VfsClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -408,7 +420,8 @@ func (x *VfsClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -420,7 +433,7 @@ func (x *VfsClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -431,7 +444,8 @@ func (x *VfsClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -443,7 +457,7 @@ func (x *VfsClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -454,7 +468,8 @@ func (x *VfsClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -466,7 +481,7 @@ func (x *VfsClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -477,7 +492,8 @@ func (x *VfsClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -489,15 +505,15 @@ func (x *VfsClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
 }
 
 const (
-	// Extension point for #GVfs functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// Extension point for [Vfs] functionality.
+	// See Extending GIO.
 	VFS_EXTENSION_POINT_NAME string = "gio-vfs"
 )
 
@@ -520,7 +536,7 @@ func VfsNewFromInternalPtr(ptr uintptr) *Vfs {
 
 var xVfsGetFileForPath func(uintptr, string) uintptr
 
-// Gets a #GFile for @path.
+// Gets a [File] for path.
 func (x *Vfs) GetFileForPath(PathVar string) *FileBase {
 	var cls *FileBase
 
@@ -536,7 +552,7 @@ func (x *Vfs) GetFileForPath(PathVar string) *FileBase {
 
 var xVfsGetFileForUri func(uintptr, string) uintptr
 
-// Gets a #GFile for @uri.
+// Gets a [File] for uri.
 //
 // This operation never fails, but the returned object
 // might not support any I/O operation if the URI
@@ -556,7 +572,7 @@ func (x *Vfs) GetFileForUri(UriVar string) *FileBase {
 
 var xVfsGetSupportedUriSchemes func(uintptr) []string
 
-// Gets a list of URI schemes supported by @vfs.
+// Gets a list of URI schemes supported by vfs.
 func (x *Vfs) GetSupportedUriSchemes() []string {
 
 	cret := xVfsGetSupportedUriSchemes(x.GoPointer())
@@ -575,8 +591,8 @@ func (x *Vfs) IsActive() bool {
 var xVfsParseName func(uintptr, string) uintptr
 
 // This operation never fails, but the returned object might
-// not support any I/O operations if the @parse_name cannot
-// be parsed by the #GVfs module.
+// not support any I/O operations if the parse_name cannot
+// be parsed by the [Vfs] module.
 func (x *Vfs) ParseName(ParseNameVar string) *FileBase {
 	var cls *FileBase
 
@@ -592,26 +608,26 @@ func (x *Vfs) ParseName(ParseNameVar string) *FileBase {
 
 var xVfsRegisterUriScheme func(uintptr, string, uintptr, uintptr, uintptr, uintptr, uintptr, uintptr) bool
 
-// Registers @uri_func and @parse_name_func as the #GFile URI and parse name
-// lookup functions for URIs with a scheme matching @scheme.
-// Note that @scheme is registered only within the running application, as
+// Registers uri_func and parse_name_func as the [File] URI and parse name
+// lookup functions for URIs with a scheme matching scheme.
+// Note that scheme is registered only within the running application, as
 // opposed to desktop-wide as it happens with GVfs backends.
 //
-// When a #GFile is requested with an URI containing @scheme (e.g. through
-// g_file_new_for_uri()), @uri_func will be called to allow a custom
-// constructor. The implementation of @uri_func should not be blocking, and
-// must not call g_vfs_register_uri_scheme() or g_vfs_unregister_uri_scheme().
+// When a [File] is requested with an URI containing scheme (e.g. through
+// [FileNewForUri]), uri_func will be called to allow a custom
+// constructor. The implementation of uri_func should not be blocking, and
+// must not call [Vfs.RegisterUriScheme] or [Vfs.UnregisterUriScheme].
 //
-// When g_file_parse_name() is called with a parse name obtained from such file,
-// @parse_name_func will be called to allow the #GFile to be created again. In
-// that case, it's responsibility of @parse_name_func to make sure the parse
-// name matches what the custom #GFile implementation returned when
-// g_file_get_parse_name() was previously called. The implementation of
-// @parse_name_func should not be blocking, and must not call
-// g_vfs_register_uri_scheme() or g_vfs_unregister_uri_scheme().
+// When [FileParseName] is called with a parse name obtained from such file,
+// parse_name_func will be called to allow the [File] to be created again. In
+// that case, it's responsibility of parse_name_func to make sure the parse
+// name matches what the custom [File] implementation returned when
+// [File.GetParseName] was previously called. The implementation of
+// parse_name_func should not be blocking, and must not call
+// [Vfs.RegisterUriScheme] or [Vfs.UnregisterUriScheme].
 //
 // It's an error to call this function twice with the same scheme. To unregister
-// a custom URI scheme, use g_vfs_unregister_uri_scheme().
+// a custom URI scheme, use [Vfs.UnregisterUriScheme].
 func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc, UriDataVar uintptr, UriDestroyVar *glib.DestroyNotify, ParseNameFuncVar *VfsFileLookupFunc, ParseNameDataVar uintptr, ParseNameDestroyVar *glib.DestroyNotify) bool {
 
 	var UriFuncVarRef uintptr
@@ -621,11 +637,12 @@ func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc,
 			UriFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *UriFuncVar
 				return cbFn(arg0, core.GoString(arg1), arg2)
 			}
-			UriFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UriFuncVarPtr, UriFuncVarRef, UriFuncVar)
+			UriFuncVarRef = core.NewCallback(fcb)
+			UriFuncVarRef = glib.SaveCallbackWithClosure(UriFuncVarPtr, UriFuncVarRef, UriFuncVar)
 		}
 	}
 
@@ -636,11 +653,12 @@ func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc,
 			UriDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UriDestroyVar
 				cbFn(arg0)
 			}
-			UriDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UriDestroyVarPtr, UriDestroyVarRef, UriDestroyVar)
+			UriDestroyVarRef = core.NewCallback(fcb)
+			UriDestroyVarRef = glib.SaveCallbackWithClosure(UriDestroyVarPtr, UriDestroyVarRef, UriDestroyVar)
 		}
 	}
 
@@ -651,11 +669,12 @@ func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc,
 			ParseNameFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *ParseNameFuncVar
 				return cbFn(arg0, core.GoString(arg1), arg2)
 			}
-			ParseNameFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ParseNameFuncVarPtr, ParseNameFuncVarRef, ParseNameFuncVar)
+			ParseNameFuncVarRef = core.NewCallback(fcb)
+			ParseNameFuncVarRef = glib.SaveCallbackWithClosure(ParseNameFuncVarPtr, ParseNameFuncVarRef, ParseNameFuncVar)
 		}
 	}
 
@@ -666,11 +685,12 @@ func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc,
 			ParseNameDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ParseNameDestroyVar
 				cbFn(arg0)
 			}
-			ParseNameDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ParseNameDestroyVarPtr, ParseNameDestroyVarRef, ParseNameDestroyVar)
+			ParseNameDestroyVarRef = core.NewCallback(fcb)
+			ParseNameDestroyVarRef = glib.SaveCallbackWithClosure(ParseNameDestroyVarPtr, ParseNameDestroyVarRef, ParseNameDestroyVar)
 		}
 	}
 
@@ -680,8 +700,8 @@ func (x *Vfs) RegisterUriScheme(SchemeVar string, UriFuncVar *VfsFileLookupFunc,
 
 var xVfsUnregisterUriScheme func(uintptr, string) bool
 
-// Unregisters the URI handler for @scheme previously registered with
-// g_vfs_register_uri_scheme().
+// Unregisters the URI handler for scheme previously registered with
+// [Vfs.RegisterUriScheme].
 func (x *Vfs) UnregisterUriScheme(SchemeVar string) bool {
 
 	cret := xVfsUnregisterUriScheme(x.GoPointer(), SchemeVar)
@@ -701,7 +721,7 @@ func (c *Vfs) SetGoPointer(ptr uintptr) {
 
 var xVfsGetDefault func() uintptr
 
-// Gets the default #GVfs for the system.
+// Gets the default [Vfs] for the system.
 func VfsGetDefault() *Vfs {
 	var cls *Vfs
 
@@ -718,7 +738,7 @@ func VfsGetDefault() *Vfs {
 
 var xVfsGetLocal func() uintptr
 
-// Gets the local #GVfs for the system.
+// Gets the local [Vfs] for the system.
 func VfsGetLocal() *Vfs {
 	var cls *Vfs
 
@@ -738,7 +758,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -747,15 +767,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xVfsGLibType, libs, "g_vfs_get_type")
 
-	core.PuregoSafeRegister(&xVfsGetFileForPath, libs, "g_vfs_get_file_for_path")
-	core.PuregoSafeRegister(&xVfsGetFileForUri, libs, "g_vfs_get_file_for_uri")
-	core.PuregoSafeRegister(&xVfsGetSupportedUriSchemes, libs, "g_vfs_get_supported_uri_schemes")
-	core.PuregoSafeRegister(&xVfsIsActive, libs, "g_vfs_is_active")
-	core.PuregoSafeRegister(&xVfsParseName, libs, "g_vfs_parse_name")
-	core.PuregoSafeRegister(&xVfsRegisterUriScheme, libs, "g_vfs_register_uri_scheme")
-	core.PuregoSafeRegister(&xVfsUnregisterUriScheme, libs, "g_vfs_unregister_uri_scheme")
+	gobject.CheckClassSize("GVfsClass", xVfsGLibType, unsafe.Sizeof(VfsClass{}))
 
-	core.PuregoSafeRegister(&xVfsGetDefault, libs, "g_vfs_get_default")
-	core.PuregoSafeRegister(&xVfsGetLocal, libs, "g_vfs_get_local")
+	core.PuregoRegisterSince(&xVfsGetFileForPath, libs, "g_vfs_get_file_for_path", "GIO", "")
+	core.PuregoRegisterSince(&xVfsGetFileForUri, libs, "g_vfs_get_file_for_uri", "GIO", "")
+	core.PuregoRegisterSince(&xVfsGetSupportedUriSchemes, libs, "g_vfs_get_supported_uri_schemes", "GIO", "")
+	core.PuregoRegisterSince(&xVfsIsActive, libs, "g_vfs_is_active", "GIO", "")
+	core.PuregoRegisterSince(&xVfsParseName, libs, "g_vfs_parse_name", "GIO", "")
+	core.PuregoRegisterSince(&xVfsRegisterUriScheme, libs, "g_vfs_register_uri_scheme", "GIO", "2.50")
+	core.PuregoRegisterSince(&xVfsUnregisterUriScheme, libs, "g_vfs_unregister_uri_scheme", "GIO", "2.50")
+
+	core.PuregoRegisterSince(&xVfsGetDefault, libs, "g_vfs_get_default", "GIO", "")
+	core.PuregoRegisterSince(&xVfsGetLocal, libs, "g_vfs_get_local", "GIO", "")
 
 }
@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type VolumeIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xChanged uintptr
 
@@ -70,7 +70,8 @@ func (x *VolumeIface) OverrideChanged(cb func(Volume)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(VolumeVarp uintptr) {
+		x.xChanged = core.NewCallback(func(VolumeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
@@ -83,69 +84,72 @@ func (x *VolumeIface) GetChanged() func(Volume) {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(VolumeVar Volume) {
 		rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideRemoved sets the "removed" callback function.
-// The removed signal that is emitted when the #GVolume have been removed. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The removed signal that is emitted when the [Volume] have been removed. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *VolumeIface) OverrideRemoved(cb func(Volume)) {
 	if cb == nil {
 		x.xRemoved = 0
 	} else {
-		x.xRemoved = purego.NewCallback(func(VolumeVarp uintptr) {
+		x.xRemoved = core.NewCallback(func(VolumeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetRemoved gets the "removed" callback function.
-// The removed signal that is emitted when the #GVolume have been removed. If the recipient is holding references to the object they should release them so the object can be finalized.
+// The removed signal that is emitted when the [Volume] have been removed. If the recipient is holding references to the object they should release them so the object can be finalized.
 func (x *VolumeIface) GetRemoved() func(Volume) {
 	if x.xRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRemoved)
+	core.RegisterFunc(&rawCallback, x.xRemoved)
 	return func(VolumeVar Volume) {
 		rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideGetName sets the "get_name" callback function.
-// Gets a string containing the name of the #GVolume.
+// Gets a string containing the name of the [Volume].
 func (x *VolumeIface) OverrideGetName(cb func(Volume) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(VolumeVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(VolumeVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetGetName gets the "get_name" callback function.
-// Gets a string containing the name of the #GVolume.
+// Gets a string containing the name of the [Volume].
 func (x *VolumeIface) GetGetName() func(Volume) string {
 	if x.xGetName == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(VolumeVar Volume) string {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideGetIcon sets the "get_icon" callback function.
-// Gets a #GIcon for the #GVolume.
+// Gets a [Icon] for the [Volume].
 func (x *VolumeIface) OverrideGetIcon(cb func(Volume) *IconBase) {
 	if cb == nil {
 		x.xGetIcon = 0
 	} else {
-		x.xGetIcon = purego.NewCallback(func(VolumeVarp uintptr) uintptr {
+		x.xGetIcon = core.NewCallback(func(VolumeVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&VolumeBase{Ptr: VolumeVarp})
 			if ret == nil {
 				return 0
@@ -156,13 +160,13 @@ func (x *VolumeIface) OverrideGetIcon(cb func(Volume) *IconBase) {
 }
 
 // GetGetIcon gets the "get_icon" callback function.
-// Gets a #GIcon for the #GVolume.
+// Gets a [Icon] for the [Volume].
 func (x *VolumeIface) GetGetIcon() func(Volume) *IconBase {
 	if x.xGetIcon == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetIcon)
+	core.RegisterFunc(&rawCallback, x.xGetIcon)
 	return func(VolumeVar Volume) *IconBase {
 		rawRet := rawCallback(VolumeVar.GoPointer())
 		if rawRet == 0 {
@@ -175,37 +179,39 @@ func (x *VolumeIface) GetGetIcon() func(Volume) *IconBase {
 }
 
 // OverrideGetUuid sets the "get_uuid" callback function.
-// Gets the UUID for the #GVolume. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns %NULL if there is no UUID available.
+// Gets the UUID for the [Volume]. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns nil if there is no UUID available.
 func (x *VolumeIface) OverrideGetUuid(cb func(Volume) string) {
 	if cb == nil {
 		x.xGetUuid = 0
 	} else {
-		x.xGetUuid = purego.NewCallback(func(VolumeVarp uintptr) string {
+		x.xGetUuid = core.NewCallback(func(VolumeVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetGetUuid gets the "get_uuid" callback function.
-// Gets the UUID for the #GVolume. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns %NULL if there is no UUID available.
+// Gets the UUID for the [Volume]. The reference is typically based on the file system UUID for the mount in question and should be considered an opaque string. Returns nil if there is no UUID available.
 func (x *VolumeIface) GetGetUuid() func(Volume) string {
 	if x.xGetUuid == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetUuid)
+	core.RegisterFunc(&rawCallback, x.xGetUuid)
 	return func(VolumeVar Volume) string {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideGetDrive sets the "get_drive" callback function.
-// Gets a #GDrive the volume is located on. Returns %NULL if the #GVolume is not associated with a #GDrive.
+// Gets a [Drive] the volume is located on. Returns nil if the [Volume] is not associated with a [Drive].
 func (x *VolumeIface) OverrideGetDrive(cb func(Volume) *DriveBase) {
 	if cb == nil {
 		x.xGetDrive = 0
 	} else {
-		x.xGetDrive = purego.NewCallback(func(VolumeVarp uintptr) uintptr {
+		x.xGetDrive = core.NewCallback(func(VolumeVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&VolumeBase{Ptr: VolumeVarp})
 			if ret == nil {
 				return 0
@@ -216,13 +222,13 @@ func (x *VolumeIface) OverrideGetDrive(cb func(Volume) *DriveBase) {
 }
 
 // GetGetDrive gets the "get_drive" callback function.
-// Gets a #GDrive the volume is located on. Returns %NULL if the #GVolume is not associated with a #GDrive.
+// Gets a [Drive] the volume is located on. Returns nil if the [Volume] is not associated with a [Drive].
 func (x *VolumeIface) GetGetDrive() func(Volume) *DriveBase {
 	if x.xGetDrive == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetDrive)
+	core.RegisterFunc(&rawCallback, x.xGetDrive)
 	return func(VolumeVar Volume) *DriveBase {
 		rawRet := rawCallback(VolumeVar.GoPointer())
 		if rawRet == 0 {
@@ -235,12 +241,13 @@ func (x *VolumeIface) GetGetDrive() func(Volume) *DriveBase {
 }
 
 // OverrideGetMount sets the "get_mount" callback function.
-// Gets a #GMount representing the mounted volume. Returns %NULL if the #GVolume is not mounted.
+// Gets a [Mount] representing the mounted volume. Returns nil if the [Volume] is not mounted.
 func (x *VolumeIface) OverrideGetMount(cb func(Volume) *MountBase) {
 	if cb == nil {
 		x.xGetMount = 0
 	} else {
-		x.xGetMount = purego.NewCallback(func(VolumeVarp uintptr) uintptr {
+		x.xGetMount = core.NewCallback(func(VolumeVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&VolumeBase{Ptr: VolumeVarp})
 			if ret == nil {
 				return 0
@@ -251,13 +258,13 @@ func (x *VolumeIface) OverrideGetMount(cb func(Volume) *MountBase) {
 }
 
 // GetGetMount gets the "get_mount" callback function.
-// Gets a #GMount representing the mounted volume. Returns %NULL if the #GVolume is not mounted.
+// Gets a [Mount] representing the mounted volume. Returns nil if the [Volume] is not mounted.
 func (x *VolumeIface) GetGetMount() func(Volume) *MountBase {
 	if x.xGetMount == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetMount)
+	core.RegisterFunc(&rawCallback, x.xGetMount)
 	return func(VolumeVar Volume) *MountBase {
 		rawRet := rawCallback(VolumeVar.GoPointer())
 		if rawRet == 0 {
@@ -270,83 +277,86 @@ func (x *VolumeIface) GetGetMount() func(Volume) *MountBase {
 }
 
 // OverrideCanMount sets the "can_mount" callback function.
-// Returns %TRUE if the #GVolume can be mounted.
+// Returns true if the [Volume] can be mounted.
 func (x *VolumeIface) OverrideCanMount(cb func(Volume) bool) {
 	if cb == nil {
 		x.xCanMount = 0
 	} else {
-		x.xCanMount = purego.NewCallback(func(VolumeVarp uintptr) bool {
+		x.xCanMount = core.NewCallback(func(VolumeVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetCanMount gets the "can_mount" callback function.
-// Returns %TRUE if the #GVolume can be mounted.
+// Returns true if the [Volume] can be mounted.
 func (x *VolumeIface) GetCanMount() func(Volume) bool {
 	if x.xCanMount == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanMount)
+	core.RegisterFunc(&rawCallback, x.xCanMount)
 	return func(VolumeVar Volume) bool {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideCanEject sets the "can_eject" callback function.
-// Checks if a #GVolume can be ejected.
+// Checks if a [Volume] can be ejected.
 func (x *VolumeIface) OverrideCanEject(cb func(Volume) bool) {
 	if cb == nil {
 		x.xCanEject = 0
 	} else {
-		x.xCanEject = purego.NewCallback(func(VolumeVarp uintptr) bool {
+		x.xCanEject = core.NewCallback(func(VolumeVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetCanEject gets the "can_eject" callback function.
-// Checks if a #GVolume can be ejected.
+// Checks if a [Volume] can be ejected.
 func (x *VolumeIface) GetCanEject() func(Volume) bool {
 	if x.xCanEject == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCanEject)
+	core.RegisterFunc(&rawCallback, x.xCanEject)
 	return func(VolumeVar Volume) bool {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideMountFn sets the "mount_fn" callback function.
-// Mounts a given #GVolume.
+// Mounts a given [Volume].
 //
-//	#GVolume implementations must emit the #GMountOperation::aborted
+//	[Volume] implementations must emit the [MountOperation.ConnectAbortedFunc]
 //	signal before completing a mount operation that is aborted while
-//	awaiting input from the user through a #GMountOperation instance.
+//	awaiting input from the user through a [MountOperation] instance.
 func (x *VolumeIface) OverrideMountFn(cb func(Volume, MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xMountFn = 0
 	} else {
-		x.xMountFn = purego.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xMountFn = core.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&VolumeBase{Ptr: VolumeVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetMountFn gets the "mount_fn" callback function.
-// Mounts a given #GVolume.
+// Mounts a given [Volume].
 //
-//	#GVolume implementations must emit the #GMountOperation::aborted
+//	[Volume] implementations must emit the [MountOperation.ConnectAbortedFunc]
 //	signal before completing a mount operation that is aborted while
-//	awaiting input from the user through a #GMountOperation instance.
+//	awaiting input from the user through a [MountOperation] instance.
 func (x *VolumeIface) GetMountFn() func(Volume, MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xMountFn == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, FlagsVarp MountMountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountFn)
+	core.RegisterFunc(&rawCallback, x.xMountFn)
 	return func(VolumeVar Volume, FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(VolumeVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -358,7 +368,8 @@ func (x *VolumeIface) OverrideMountFinish(cb func(Volume, AsyncResult) bool) {
 	if cb == nil {
 		x.xMountFinish = 0
 	} else {
-		x.xMountFinish = purego.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+		x.xMountFinish = core.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -371,32 +382,33 @@ func (x *VolumeIface) GetMountFinish() func(Volume, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMountFinish)
+	core.RegisterFunc(&rawCallback, x.xMountFinish)
 	return func(VolumeVar Volume, ResultVar AsyncResult) bool {
 		return rawCallback(VolumeVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideEject sets the "eject" callback function.
-// Ejects a given #GVolume.
+// Ejects a given [Volume].
 func (x *VolumeIface) OverrideEject(cb func(Volume, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEject = 0
 	} else {
-		x.xEject = purego.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEject = core.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&VolumeBase{Ptr: VolumeVarp}, FlagsVarp, CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEject gets the "eject" callback function.
-// Ejects a given #GVolume.
+// Ejects a given [Volume].
 func (x *VolumeIface) GetEject() func(Volume, MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEject == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEject)
+	core.RegisterFunc(&rawCallback, x.xEject)
 	return func(VolumeVar Volume, FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(VolumeVar.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
@@ -408,7 +420,8 @@ func (x *VolumeIface) OverrideEjectFinish(cb func(Volume, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectFinish = 0
 	} else {
-		x.xEjectFinish = purego.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectFinish = core.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
@@ -421,36 +434,37 @@ func (x *VolumeIface) GetEjectFinish() func(Volume, AsyncResult) bool {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectFinish)
 	return func(VolumeVar Volume, ResultVar AsyncResult) bool {
 		return rawCallback(VolumeVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGetIdentifier sets the "get_identifier" callback function.
-// Returns the [identifier](#volume-identifiers) of the given kind, or %NULL if
+// Returns the identifier of the given kind, or nil if
 //
-//	the #GVolume doesn't have one.
+//	the [Volume] doesn't have one.
 func (x *VolumeIface) OverrideGetIdentifier(cb func(Volume, string) string) {
 	if cb == nil {
 		x.xGetIdentifier = 0
 	} else {
-		x.xGetIdentifier = purego.NewCallback(func(VolumeVarp uintptr, KindVarp string) string {
+		x.xGetIdentifier = core.NewCallback(func(VolumeVarp uintptr, KindVarp string) string {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp}, KindVarp)
 		})
 	}
 }
 
 // GetGetIdentifier gets the "get_identifier" callback function.
-// Returns the [identifier](#volume-identifiers) of the given kind, or %NULL if
+// Returns the identifier of the given kind, or nil if
 //
-//	the #GVolume doesn't have one.
+//	the [Volume] doesn't have one.
 func (x *VolumeIface) GetGetIdentifier() func(Volume, string) string {
 	if x.xGetIdentifier == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, KindVarp string) string
-	purego.RegisterFunc(&rawCallback, x.xGetIdentifier)
+	core.RegisterFunc(&rawCallback, x.xGetIdentifier)
 	return func(VolumeVar Volume, KindVar string) string {
 		return rawCallback(VolumeVar.GoPointer(), KindVar)
 	}
@@ -459,12 +473,13 @@ func (x *VolumeIface) GetGetIdentifier() func(Volume, string) string {
 // OverrideEnumerateIdentifiers sets the "enumerate_identifiers" callback function.
 // Returns an array strings listing the kinds
 //
-//	of [identifiers](#volume-identifiers) which the #GVolume has.
+//	of identifiers which the [Volume] has.
 func (x *VolumeIface) OverrideEnumerateIdentifiers(cb func(Volume) []string) {
 	if cb == nil {
 		x.xEnumerateIdentifiers = 0
 	} else {
-		x.xEnumerateIdentifiers = purego.NewCallback(func(VolumeVarp uintptr) []string {
+		x.xEnumerateIdentifiers = core.NewCallback(func(VolumeVarp uintptr) []string {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
@@ -473,52 +488,54 @@ func (x *VolumeIface) OverrideEnumerateIdentifiers(cb func(Volume) []string) {
 // GetEnumerateIdentifiers gets the "enumerate_identifiers" callback function.
 // Returns an array strings listing the kinds
 //
-//	of [identifiers](#volume-identifiers) which the #GVolume has.
+//	of identifiers which the [Volume] has.
 func (x *VolumeIface) GetEnumerateIdentifiers() func(Volume) []string {
 	if x.xEnumerateIdentifiers == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) []string
-	purego.RegisterFunc(&rawCallback, x.xEnumerateIdentifiers)
+	core.RegisterFunc(&rawCallback, x.xEnumerateIdentifiers)
 	return func(VolumeVar Volume) []string {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideShouldAutomount sets the "should_automount" callback function.
-// Returns %TRUE if the #GVolume should be automatically mounted.
+// Returns true if the [Volume] should be automatically mounted.
 func (x *VolumeIface) OverrideShouldAutomount(cb func(Volume) bool) {
 	if cb == nil {
 		x.xShouldAutomount = 0
 	} else {
-		x.xShouldAutomount = purego.NewCallback(func(VolumeVarp uintptr) bool {
+		x.xShouldAutomount = core.NewCallback(func(VolumeVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetShouldAutomount gets the "should_automount" callback function.
-// Returns %TRUE if the #GVolume should be automatically mounted.
+// Returns true if the [Volume] should be automatically mounted.
 func (x *VolumeIface) GetShouldAutomount() func(Volume) bool {
 	if x.xShouldAutomount == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xShouldAutomount)
+	core.RegisterFunc(&rawCallback, x.xShouldAutomount)
 	return func(VolumeVar Volume) bool {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideGetActivationRoot sets the "get_activation_root" callback function.
-// Returns the activation root for the #GVolume if it is known in advance or %NULL if
+// Returns the activation root for the [Volume] if it is known in advance or nil if
 //
 //	it is not known.
 func (x *VolumeIface) OverrideGetActivationRoot(cb func(Volume) *FileBase) {
 	if cb == nil {
 		x.xGetActivationRoot = 0
 	} else {
-		x.xGetActivationRoot = purego.NewCallback(func(VolumeVarp uintptr) uintptr {
+		x.xGetActivationRoot = core.NewCallback(func(VolumeVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&VolumeBase{Ptr: VolumeVarp})
 			if ret == nil {
 				return 0
@@ -529,7 +546,7 @@ func (x *VolumeIface) OverrideGetActivationRoot(cb func(Volume) *FileBase) {
 }
 
 // GetGetActivationRoot gets the "get_activation_root" callback function.
-// Returns the activation root for the #GVolume if it is known in advance or %NULL if
+// Returns the activation root for the [Volume] if it is known in advance or nil if
 //
 //	it is not known.
 func (x *VolumeIface) GetGetActivationRoot() func(Volume) *FileBase {
@@ -537,7 +554,7 @@ func (x *VolumeIface) GetGetActivationRoot() func(Volume) *FileBase {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetActivationRoot)
+	core.RegisterFunc(&rawCallback, x.xGetActivationRoot)
 	return func(VolumeVar Volume) *FileBase {
 		rawRet := rawCallback(VolumeVar.GoPointer())
 		if rawRet == 0 {
@@ -550,87 +567,91 @@ func (x *VolumeIface) GetGetActivationRoot() func(Volume) *FileBase {
 }
 
 // OverrideEjectWithOperation sets the "eject_with_operation" callback function.
-// Starts ejecting a #GVolume using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Volume] using a [MountOperation]. Since 2.22.
 func (x *VolumeIface) OverrideEjectWithOperation(cb func(Volume, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)) {
 	if cb == nil {
 		x.xEjectWithOperation = 0
 	} else {
-		x.xEjectWithOperation = purego.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+		x.xEjectWithOperation = core.NewCallback(func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&VolumeBase{Ptr: VolumeVarp}, FlagsVarp, MountOperationNewFromInternalPtr(MountOperationVarp), CancellableNewFromInternalPtr(CancellableVarp), (*AsyncReadyCallback)(unsafe.Pointer(CallbackVarp)), UserDataVarp)
 		})
 	}
 }
 
 // GetEjectWithOperation gets the "eject_with_operation" callback function.
-// Starts ejecting a #GVolume using a #GMountOperation. Since 2.22.
+// Starts ejecting a [Volume] using a [MountOperation]. Since 2.22.
 func (x *VolumeIface) GetEjectWithOperation() func(Volume, MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr) {
 	if x.xEjectWithOperation == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, FlagsVarp MountUnmountFlags, MountOperationVarp uintptr, CancellableVarp uintptr, CallbackVarp uintptr, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperation)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperation)
 	return func(VolumeVar Volume, FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 		rawCallback(VolumeVar.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
 	}
 }
 
 // OverrideEjectWithOperationFinish sets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *VolumeIface) OverrideEjectWithOperationFinish(cb func(Volume, AsyncResult) bool) {
 	if cb == nil {
 		x.xEjectWithOperationFinish = 0
 	} else {
-		x.xEjectWithOperationFinish = purego.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+		x.xEjectWithOperationFinish = core.NewCallback(func(VolumeVarp uintptr, ResultVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp}, &AsyncResultBase{Ptr: ResultVarp})
 		})
 	}
 }
 
 // GetEjectWithOperationFinish gets the "eject_with_operation_finish" callback function.
-// Finishes an eject operation using a #GMountOperation. Since 2.22.
+// Finishes an eject operation using a [MountOperation]. Since 2.22.
 func (x *VolumeIface) GetEjectWithOperationFinish() func(Volume, AsyncResult) bool {
 	if x.xEjectWithOperationFinish == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr, ResultVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
+	core.RegisterFunc(&rawCallback, x.xEjectWithOperationFinish)
 	return func(VolumeVar Volume, ResultVar AsyncResult) bool {
 		return rawCallback(VolumeVar.GoPointer(), ResultVar.GoPointer())
 	}
 }
 
 // OverrideGetSortKey sets the "get_sort_key" callback function.
-// Gets a key used for sorting #GVolume instance or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Volume] instance or nil if no such key exists. Since 2.32.
 func (x *VolumeIface) OverrideGetSortKey(cb func(Volume) string) {
 	if cb == nil {
 		x.xGetSortKey = 0
 	} else {
-		x.xGetSortKey = purego.NewCallback(func(VolumeVarp uintptr) string {
+		x.xGetSortKey = core.NewCallback(func(VolumeVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&VolumeBase{Ptr: VolumeVarp})
 		})
 	}
 }
 
 // GetGetSortKey gets the "get_sort_key" callback function.
-// Gets a key used for sorting #GVolume instance or %NULL if no such key exists. Since 2.32.
+// Gets a key used for sorting [Volume] instance or nil if no such key exists. Since 2.32.
 func (x *VolumeIface) GetGetSortKey() func(Volume) string {
 	if x.xGetSortKey == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetSortKey)
+	core.RegisterFunc(&rawCallback, x.xGetSortKey)
 	return func(VolumeVar Volume) string {
 		return rawCallback(VolumeVar.GoPointer())
 	}
 }
 
 // OverrideGetSymbolicIcon sets the "get_symbolic_icon" callback function.
-// Gets a symbolic #GIcon for the #GVolume. Since 2.34.
+// Gets a symbolic [Icon] for the [Volume]. Since 2.34.
 func (x *VolumeIface) OverrideGetSymbolicIcon(cb func(Volume) *IconBase) {
 	if cb == nil {
 		x.xGetSymbolicIcon = 0
 	} else {
-		x.xGetSymbolicIcon = purego.NewCallback(func(VolumeVarp uintptr) uintptr {
+		x.xGetSymbolicIcon = core.NewCallback(func(VolumeVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&VolumeBase{Ptr: VolumeVarp})
 			if ret == nil {
 				return 0
@@ -641,13 +662,13 @@ func (x *VolumeIface) OverrideGetSymbolicIcon(cb func(Volume) *IconBase) {
 }
 
 // GetGetSymbolicIcon gets the "get_symbolic_icon" callback function.
-// Gets a symbolic #GIcon for the #GVolume. Since 2.34.
+// Gets a symbolic [Icon] for the [Volume]. Since 2.34.
 func (x *VolumeIface) GetGetSymbolicIcon() func(Volume) *IconBase {
 	if x.xGetSymbolicIcon == 0 {
 		return nil
 	}
 	var rawCallback func(VolumeVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
+	core.RegisterFunc(&rawCallback, x.xGetSymbolicIcon)
 	return func(VolumeVar Volume) *IconBase {
 		rawRet := rawCallback(VolumeVar.GoPointer())
 		if rawRet == 0 {
@@ -663,33 +684,33 @@ func (x *VolumeIface) GetGetSymbolicIcon() func(Volume) *IconBase {
 // mounted. For example, a file system partition on a USB flash drive, or an
 // optical disc inserted into a disc drive.
 //
-// If a `GVolume` is currently mounted, the corresponding [iface@Gio.Mount] can
-// be retrieved using [method@Gio.Volume.get_mount].
+// If a `GVolume` is currently mounted, the corresponding [Mount] can
+// be retrieved using [Volume.GetMount].
 //
 // Mounting a `GVolume` instance is an asynchronous operation. For more
-// information about asynchronous operations, see [iface@Gio.AsyncResult] and
-// [class@Gio.Task]. To mount a `GVolume`, first call [method@Gio.Volume.mount]
+// information about asynchronous operations, see [AsyncResult] and
+// [Task]. To mount a `GVolume`, first call [Volume.Mount]
 // with (at least) the `GVolume` instance, optionally a
-// [class@Gio.MountOperation] object and a [type@Gio.AsyncReadyCallback].
+// [MountOperation] object and a [AsyncReadyCallback].
 //
 // Typically, one will only want to pass `NULL` for the
-// [class@Gio.MountOperation] if automounting all volumes when a desktop session
+// [MountOperation] if automounting all volumes when a desktop session
 // starts since it’s not desirable to put up a lot of dialogs asking
 // for credentials.
 //
 // The callback will be fired when the operation has resolved (either
-// with success or failure), and a [iface@Gio.AsyncResult] instance will be
+// with success or failure), and a [AsyncResult] instance will be
 // passed to the callback.  That callback should then call
-// [method@Gio.Volume.mount_finish] with the `GVolume` instance and the
-// [iface@Gio.AsyncResult] data to see if the operation was completed
-// successfully.  If a [type@GLib.Error] is present when
-// [method@Gio.Volume.mount_finish] is called, then it will be filled with any
+// [Volume.MountFinish] with the `GVolume` instance and the
+// [AsyncResult] data to see if the operation was completed
+// successfully.  If a [glib.Error] is present when
+// [Volume.MountFinish] is called, then it will be filled with any
 // error information.
 //
-// Note, when [porting from GnomeVFS](migrating-gnome-vfs.html),
+// Note, when porting from GnomeVFS,
 // `GVolume` is the moral equivalent of `GnomeVFSDrive`.
 //
-// ## Volume Identifiers
+// # Volume Identifiers
 //
 // It is sometimes necessary to directly access the underlying
 // operating system object behind a volume (e.g. for passing a volume
@@ -699,7 +720,7 @@ func (x *VolumeIface) GetGetSymbolicIcon() func(Volume) *IconBase {
 // traditional Unix devices (e.g. `/dev/sda2`), UUIDs. GIO uses predefined
 // strings as names for the different kinds of identifiers:
 // `G_VOLUME_IDENTIFIER_KIND_UUID`, `G_VOLUME_IDENTIFIER_KIND_LABEL`, etc.
-// Use [method@Gio.Volume.get_identifier] to obtain an identifier for a volume.
+// Use [Volume.GetIdentifier] to obtain an identifier for a volume.
 //
 // Note that `G_VOLUME_IDENTIFIER_KIND_HAL_UDI` will only be available
 // when the GVFS hal volume monitor is in use. Other volume monitors
@@ -751,6 +772,178 @@ func (x *VolumeBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementVolume adds the Volume interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Changed()
+//   - Removed()
+//   - GetName() string
+//   - GetIcon() *IconBase
+//   - GetUuid() string
+//   - GetDrive() *DriveBase
+//   - GetMount() *MountBase
+//   - CanMount() bool
+//   - CanEject() bool
+//   - MountFn(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - MountFinish(AsyncResult) bool
+//   - Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectFinish(AsyncResult) bool
+//   - GetIdentifier(string) string
+//   - EnumerateIdentifiers() []string
+//   - ShouldAutomount() bool
+//   - GetActivationRoot() *FileBase
+//   - EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+//   - EjectWithOperationFinish(AsyncResult) bool
+//   - GetSortKey() string
+//   - GetSymbolicIcon() *IconBase
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see VolumeIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementVolume[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, VolumeGLibType(), func(iface *VolumeIface) {
+		if _, ok := any(zero).(interface{ Changed() }); ok {
+			iface.OverrideChanged(func(self Volume) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Changed() })
+				impl.Changed()
+			})
+		}
+		if _, ok := any(zero).(interface{ Removed() }); ok {
+			iface.OverrideRemoved(func(self Volume) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Removed() })
+				impl.Removed()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetName() string }); ok {
+			iface.OverrideGetName(func(self Volume) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetName() string })
+				return impl.GetName()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIcon() *IconBase }); ok {
+			iface.OverrideGetIcon(func(self Volume) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIcon() *IconBase })
+				return impl.GetIcon()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetUuid() string }); ok {
+			iface.OverrideGetUuid(func(self Volume) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetUuid() string })
+				return impl.GetUuid()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDrive() *DriveBase }); ok {
+			iface.OverrideGetDrive(func(self Volume) *DriveBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDrive() *DriveBase })
+				return impl.GetDrive()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetMount() *MountBase }); ok {
+			iface.OverrideGetMount(func(self Volume) *MountBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetMount() *MountBase })
+				return impl.GetMount()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanMount() bool }); ok {
+			iface.OverrideCanMount(func(self Volume) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanMount() bool })
+				return impl.CanMount()
+			})
+		}
+		if _, ok := any(zero).(interface{ CanEject() bool }); ok {
+			iface.OverrideCanEject(func(self Volume) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ CanEject() bool })
+				return impl.CanEject()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			MountFn(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideMountFn(func(self Volume, arg0 MountMountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					MountFn(MountMountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.MountFn(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ MountFinish(AsyncResult) bool }); ok {
+			iface.OverrideMountFinish(func(self Volume, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ MountFinish(AsyncResult) bool })
+				return impl.MountFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEject(func(self Volume, arg0 MountUnmountFlags, arg1 *Cancellable, arg2 *AsyncReadyCallback, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					Eject(MountUnmountFlags, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.Eject(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectFinish(func(self Volume, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectFinish(AsyncResult) bool })
+				return impl.EjectFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetIdentifier(string) string }); ok {
+			iface.OverrideGetIdentifier(func(self Volume, arg0 string) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetIdentifier(string) string })
+				return impl.GetIdentifier(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ EnumerateIdentifiers() []string }); ok {
+			iface.OverrideEnumerateIdentifiers(func(self Volume) []string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EnumerateIdentifiers() []string })
+				return impl.EnumerateIdentifiers()
+			})
+		}
+		if _, ok := any(zero).(interface{ ShouldAutomount() bool }); ok {
+			iface.OverrideShouldAutomount(func(self Volume) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ShouldAutomount() bool })
+				return impl.ShouldAutomount()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetActivationRoot() *FileBase }); ok {
+			iface.OverrideGetActivationRoot(func(self Volume) *FileBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActivationRoot() *FileBase })
+				return impl.GetActivationRoot()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+		}); ok {
+			iface.OverrideEjectWithOperation(func(self Volume, arg0 MountUnmountFlags, arg1 *MountOperation, arg2 *Cancellable, arg3 *AsyncReadyCallback, arg4 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					EjectWithOperation(MountUnmountFlags, *MountOperation, *Cancellable, *AsyncReadyCallback, uintptr)
+				})
+				impl.EjectWithOperation(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ EjectWithOperationFinish(AsyncResult) bool }); ok {
+			iface.OverrideEjectWithOperationFinish(func(self Volume, arg0 AsyncResult) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EjectWithOperationFinish(AsyncResult) bool })
+				return impl.EjectWithOperationFinish(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSortKey() string }); ok {
+			iface.OverrideGetSortKey(func(self Volume) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSortKey() string })
+				return impl.GetSortKey()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSymbolicIcon() *IconBase }); ok {
+			iface.OverrideGetSymbolicIcon(func(self Volume) *IconBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSymbolicIcon() *IconBase })
+				return impl.GetSymbolicIcon()
+			})
+		}
+	})
+}
+
 // Checks if a volume can be ejected.
 func (x *VolumeBase) CanEject() bool {
 
@@ -766,66 +959,100 @@ func (x *VolumeBase) CanMount() bool {
 }
 
 // Ejects a volume. This is an asynchronous operation, and is
-// finished by calling g_volume_eject_finish() with the @volume
-// and #GAsyncResult returned in the @callback.
+// finished by calling [Volume.EjectFinish] with the volume
+// and [AsyncResult] returned in the callback.
+//
+// Deprecated: since 2.22. Use [Volume.EjectWithOperation] instead.
 func (x *VolumeBase) Eject(FlagsVar MountUnmountFlags, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGVolumeEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGVolumeEject(x.GoPointer(), FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a volume. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
+//
+// Deprecated: since 2.22. Use [Volume.EjectWithOperationFinish] instead.
 func (x *VolumeBase) EjectFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGVolumeEjectFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Ejects a volume. This is an asynchronous operation, and is
-// finished by calling g_volume_eject_with_operation_finish() with the @volume
-// and #GAsyncResult data returned in the @callback.
+// finished by calling [Volume.EjectWithOperationFinish] with the volume
+// and [AsyncResult] data returned in the callback.
 func (x *VolumeBase) EjectWithOperation(FlagsVar MountUnmountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGVolumeEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGVolumeEjectWithOperation(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes ejecting a volume. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 func (x *VolumeBase) EjectWithOperationFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGVolumeEjectWithOperationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Gets the kinds of [identifiers](#volume-identifiers) that @volume has.
-// Use g_volume_get_identifier() to obtain the identifiers themselves.
+// Gets the kinds of identifiers that volume has.
+// Use [Volume.GetIdentifier] to obtain the identifiers themselves.
 func (x *VolumeBase) EnumerateIdentifiers() []string {
 
 	cret := XGVolumeEnumerateIdentifiers(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Gets the activation root for a #GVolume if it is known ahead of
-// mount time. Returns %NULL otherwise. If not %NULL and if @volume
-// is mounted, then the result of g_mount_get_root() on the
-// #GMount object obtained from g_volume_get_mount() will always
+// Gets the activation root for a [Volume] if it is known ahead of
+// mount time. Returns nil otherwise. If not nil and if volume
+// is mounted, then the result of [Mount.GetRoot] on the
+// [Mount] object obtained from [Volume.GetMount] will always
 // either be equal or a prefix of what this function returns. In
 // other words, in code
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	GMount *mount;
 //	GFile *mount_root
 //	GFile *volume_activation_root;
@@ -834,19 +1061,16 @@ func (x *VolumeBase) EnumerateIdentifiers() []string {
 //	mount_root = g_mount_get_root (mount);
 //	volume_activation_root = g_volume_get_activation_root (volume); // assume not NULL
 //
-// ]|
 // then the expression
-// |[&lt;!-- language="C" --&gt;
 //
 //	(g_file_has_prefix (volume_activation_root, mount_root) ||
 //	 g_file_equal (volume_activation_root, mount_root))
 //
-// ]|
-// will always be %TRUE.
+// will always be true.
 //
-// Activation roots are typically used in #GVolumeMonitor
+// Activation roots are typically used in [VolumeMonitor]
 // implementations to find the underlying mount to shadow, see
-// g_mount_is_shadowed() for more details.
+// [Mount.IsShadowed] for more details.
 func (x *VolumeBase) GetActivationRoot() *FileBase {
 	var cls *FileBase
 
@@ -860,7 +1084,7 @@ func (x *VolumeBase) GetActivationRoot() *FileBase {
 	return cls
 }
 
-// Gets the drive for the @volume.
+// Gets the drive for the volume.
 func (x *VolumeBase) GetDrive() *DriveBase {
 	var cls *DriveBase
 
@@ -874,7 +1098,7 @@ func (x *VolumeBase) GetDrive() *DriveBase {
 	return cls
 }
 
-// Gets the icon for @volume.
+// Gets the icon for volume.
 func (x *VolumeBase) GetIcon() *IconBase {
 	var cls *IconBase
 
@@ -888,8 +1112,8 @@ func (x *VolumeBase) GetIcon() *IconBase {
 	return cls
 }
 
-// Gets the identifier of the given kind for @volume.
-// See the [introduction](#volume-identifiers) for more
+// Gets the identifier of the given kind for volume.
+// See the introduction for more
 // information about volume identifiers.
 func (x *VolumeBase) GetIdentifier(KindVar string) string {
 
@@ -897,7 +1121,7 @@ func (x *VolumeBase) GetIdentifier(KindVar string) string {
 	return cret
 }
 
-// Gets the mount for the @volume.
+// Gets the mount for the volume.
 func (x *VolumeBase) GetMount() *MountBase {
 	var cls *MountBase
 
@@ -911,21 +1135,21 @@ func (x *VolumeBase) GetMount() *MountBase {
 	return cls
 }
 
-// Gets the name of @volume.
+// Gets the name of volume.
 func (x *VolumeBase) GetName() string {
 
 	cret := XGVolumeGetName(x.GoPointer())
 	return cret
 }
 
-// Gets the sort key for @volume, if any.
+// Gets the sort key for volume, if any.
 func (x *VolumeBase) GetSortKey() string {
 
 	cret := XGVolumeGetSortKey(x.GoPointer())
 	return cret
 }
 
-// Gets the symbolic icon for @volume.
+// Gets the symbolic icon for volume.
 func (x *VolumeBase) GetSymbolicIcon() *IconBase {
 	var cls *IconBase
 
@@ -939,9 +1163,9 @@ func (x *VolumeBase) GetSymbolicIcon() *IconBase {
 	return cls
 }
 
-// Gets the UUID for the @volume. The reference is typically based on
+// Gets the UUID for the volume. The reference is typically based on
 // the file system UUID for the volume in question and should be
-// considered an opaque string. Returns %NULL if there is no UUID
+// considered an opaque string. Returns nil if there is no UUID
 // available.
 func (x *VolumeBase) GetUuid() string {
 
@@ -950,27 +1174,43 @@ func (x *VolumeBase) GetUuid() string {
 }
 
 // Mounts a volume. This is an asynchronous operation, and is
-// finished by calling g_volume_mount_finish() with the @volume
-// and #GAsyncResult returned in the @callback.
+// finished by calling [Volume.MountFinish] with the volume
+// and [AsyncResult] returned in the callback.
 func (x *VolumeBase) Mount(FlagsVar MountMountFlags, MountOperationVar *MountOperation, CancellableVar *Cancellable, CallbackVar *AsyncReadyCallback, UserDataVar uintptr) {
 
-	XGVolumeMount(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), glib.NewCallbackNullable(CallbackVar), UserDataVar)
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	XGVolumeMount(x.GoPointer(), FlagsVar, MountOperationVar.GoPointer(), CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
 
 }
 
 // Finishes mounting a volume. If any errors occurred during the operation,
-// @error will be set to contain the errors and %FALSE will be returned.
+// error will be set to contain the errors and false will be returned.
 //
-// If the mount operation succeeded, g_volume_get_mount() on @volume
+// If the mount operation succeeded, [Volume.GetMount] on volume
 // is guaranteed to return the mount right after calling this
 // function; there's no need to listen for the 'mount-added' signal on
-// #GVolumeMonitor.
+// [VolumeMonitor].
 func (x *VolumeBase) MountFinish(ResultVar AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGVolumeMountFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -989,7 +1229,7 @@ var XGVolumeEject func(uintptr, MountUnmountFlags, uintptr, uintptr, uintptr)
 var XGVolumeEjectFinish func(uintptr, uintptr, **glib.Error) bool
 var XGVolumeEjectWithOperation func(uintptr, MountUnmountFlags, uintptr, uintptr, uintptr, uintptr)
 var XGVolumeEjectWithOperationFinish func(uintptr, uintptr, **glib.Error) bool
-var XGVolumeEnumerateIdentifiers func(uintptr) []string
+var XGVolumeEnumerateIdentifiers func(uintptr) uintptr
 var XGVolumeGetActivationRoot func(uintptr) uintptr
 var XGVolumeGetDrive func(uintptr) uintptr
 var XGVolumeGetIcon func(uintptr) uintptr
@@ -1004,25 +1244,27 @@ var XGVolumeMountFinish func(uintptr, uintptr, **glib.Error) bool
 var XGVolumeShouldAutomount func(uintptr) bool
 
 const (
-	// The string used to obtain the volume class with g_volume_get_identifier().
+	// The string used to obtain the volume class with [Volume.GetIdentifier].
 	//
 	// Known volume classes include `device`, `network`, and `loop`. Other
 	// classes may be added in the future.
 	//
-	// This is intended to be used by applications to classify #GVolume
+	// This is intended to be used by applications to classify [Volume]
 	// instances into different sections - for example a file manager or
 	// file chooser can use this information to show `network` volumes under
 	// a "Network" heading and `device` volumes under a "Devices" heading.
 	VOLUME_IDENTIFIER_KIND_CLASS string = "class"
-	// The string used to obtain a Hal UDI with g_volume_get_identifier().
+	// The string used to obtain a Hal UDI with [Volume.GetIdentifier].
+	//
+	// Deprecated: since 2.58. Do not use, HAL is deprecated.
 	VOLUME_IDENTIFIER_KIND_HAL_UDI string = "hal-udi"
-	// The string used to obtain a filesystem label with g_volume_get_identifier().
+	// The string used to obtain a filesystem label with [Volume.GetIdentifier].
 	VOLUME_IDENTIFIER_KIND_LABEL string = "label"
-	// The string used to obtain a NFS mount with g_volume_get_identifier().
+	// The string used to obtain a NFS mount with [Volume.GetIdentifier].
 	VOLUME_IDENTIFIER_KIND_NFS_MOUNT string = "nfs-mount"
-	// The string used to obtain a Unix device path with g_volume_get_identifier().
+	// The string used to obtain a Unix device path with [Volume.GetIdentifier].
 	VOLUME_IDENTIFIER_KIND_UNIX_DEVICE string = "unix-device"
-	// The string used to obtain a UUID with g_volume_get_identifier().
+	// The string used to obtain a UUID with [Volume.GetIdentifier].
 	VOLUME_IDENTIFIER_KIND_UUID string = "uuid"
 )
 
@@ -1031,7 +1273,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1040,24 +1282,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xVolumeGLibType, libs, "g_volume_get_type")
 
-	core.PuregoSafeRegister(&XGVolumeCanEject, libs, "g_volume_can_eject")
-	core.PuregoSafeRegister(&XGVolumeCanMount, libs, "g_volume_can_mount")
-	core.PuregoSafeRegister(&XGVolumeEject, libs, "g_volume_eject")
-	core.PuregoSafeRegister(&XGVolumeEjectFinish, libs, "g_volume_eject_finish")
-	core.PuregoSafeRegister(&XGVolumeEjectWithOperation, libs, "g_volume_eject_with_operation")
-	core.PuregoSafeRegister(&XGVolumeEjectWithOperationFinish, libs, "g_volume_eject_with_operation_finish")
-	core.PuregoSafeRegister(&XGVolumeEnumerateIdentifiers, libs, "g_volume_enumerate_identifiers")
-	core.PuregoSafeRegister(&XGVolumeGetActivationRoot, libs, "g_volume_get_activation_root")
-	core.PuregoSafeRegister(&XGVolumeGetDrive, libs, "g_volume_get_drive")
-	core.PuregoSafeRegister(&XGVolumeGetIcon, libs, "g_volume_get_icon")
-	core.PuregoSafeRegister(&XGVolumeGetIdentifier, libs, "g_volume_get_identifier")
-	core.PuregoSafeRegister(&XGVolumeGetMount, libs, "g_volume_get_mount")
-	core.PuregoSafeRegister(&XGVolumeGetName, libs, "g_volume_get_name")
-	core.PuregoSafeRegister(&XGVolumeGetSortKey, libs, "g_volume_get_sort_key")
-	core.PuregoSafeRegister(&XGVolumeGetSymbolicIcon, libs, "g_volume_get_symbolic_icon")
-	core.PuregoSafeRegister(&XGVolumeGetUuid, libs, "g_volume_get_uuid")
-	core.PuregoSafeRegister(&XGVolumeMount, libs, "g_volume_mount")
-	core.PuregoSafeRegister(&XGVolumeMountFinish, libs, "g_volume_mount_finish")
-	core.PuregoSafeRegister(&XGVolumeShouldAutomount, libs, "g_volume_should_automount")
+	gobject.CheckClassSize("GVolumeIface", xVolumeGLibType, unsafe.Sizeof(VolumeIface{}))
+
+	core.PuregoRegisterSince(&XGVolumeCanEject, libs, "g_volume_can_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeCanMount, libs, "g_volume_can_mount", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeEject, libs, "g_volume_eject", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeEjectFinish, libs, "g_volume_eject_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeEjectWithOperation, libs, "g_volume_eject_with_operation", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGVolumeEjectWithOperationFinish, libs, "g_volume_eject_with_operation_finish", "GIO", "2.22")
+	core.PuregoRegisterSince(&XGVolumeEnumerateIdentifiers, libs, "g_volume_enumerate_identifiers", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetActivationRoot, libs, "g_volume_get_activation_root", "GIO", "2.18")
+	core.PuregoRegisterSince(&XGVolumeGetDrive, libs, "g_volume_get_drive", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetIcon, libs, "g_volume_get_icon", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetIdentifier, libs, "g_volume_get_identifier", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetMount, libs, "g_volume_get_mount", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetName, libs, "g_volume_get_name", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeGetSortKey, libs, "g_volume_get_sort_key", "GIO", "2.32")
+	core.PuregoRegisterSince(&XGVolumeGetSymbolicIcon, libs, "g_volume_get_symbolic_icon", "GIO", "2.34")
+	core.PuregoRegisterSince(&XGVolumeGetUuid, libs, "g_volume_get_uuid", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeMount, libs, "g_volume_mount", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeMountFinish, libs, "g_volume_mount_finish", "GIO", "")
+	core.PuregoRegisterSince(&XGVolumeShouldAutomount, libs, "g_volume_should_automount", "GIO", "")
 
 }
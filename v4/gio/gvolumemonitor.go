@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -77,7 +76,8 @@ func (x *VolumeMonitorClass) OverrideVolumeAdded(cb func(*VolumeMonitor, Volume)
 	if cb == nil {
 		x.xVolumeAdded = 0
 	} else {
-		x.xVolumeAdded = purego.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+		x.xVolumeAdded = core.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &VolumeBase{Ptr: VolumeVarp})
 		})
 	}
@@ -89,7 +89,7 @@ func (x *VolumeMonitorClass) GetVolumeAdded() func(*VolumeMonitor, Volume) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, VolumeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xVolumeAdded)
+	core.RegisterFunc(&rawCallback, x.xVolumeAdded)
 	return func(VolumeMonitorVar *VolumeMonitor, VolumeVar Volume) {
 		rawCallback(VolumeMonitorVar.GoPointer(), VolumeVar.GoPointer())
 	}
@@ -100,7 +100,8 @@ func (x *VolumeMonitorClass) OverrideVolumeRemoved(cb func(*VolumeMonitor, Volum
 	if cb == nil {
 		x.xVolumeRemoved = 0
 	} else {
-		x.xVolumeRemoved = purego.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+		x.xVolumeRemoved = core.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &VolumeBase{Ptr: VolumeVarp})
 		})
 	}
@@ -112,7 +113,7 @@ func (x *VolumeMonitorClass) GetVolumeRemoved() func(*VolumeMonitor, Volume) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, VolumeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xVolumeRemoved)
+	core.RegisterFunc(&rawCallback, x.xVolumeRemoved)
 	return func(VolumeMonitorVar *VolumeMonitor, VolumeVar Volume) {
 		rawCallback(VolumeMonitorVar.GoPointer(), VolumeVar.GoPointer())
 	}
@@ -123,7 +124,8 @@ func (x *VolumeMonitorClass) OverrideVolumeChanged(cb func(*VolumeMonitor, Volum
 	if cb == nil {
 		x.xVolumeChanged = 0
 	} else {
-		x.xVolumeChanged = purego.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+		x.xVolumeChanged = core.NewCallback(func(VolumeMonitorVarp uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &VolumeBase{Ptr: VolumeVarp})
 		})
 	}
@@ -135,7 +137,7 @@ func (x *VolumeMonitorClass) GetVolumeChanged() func(*VolumeMonitor, Volume) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, VolumeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xVolumeChanged)
+	core.RegisterFunc(&rawCallback, x.xVolumeChanged)
 	return func(VolumeMonitorVar *VolumeMonitor, VolumeVar Volume) {
 		rawCallback(VolumeMonitorVar.GoPointer(), VolumeVar.GoPointer())
 	}
@@ -146,7 +148,8 @@ func (x *VolumeMonitorClass) OverrideMountAdded(cb func(*VolumeMonitor, Mount))
 	if cb == nil {
 		x.xMountAdded = 0
 	} else {
-		x.xMountAdded = purego.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+		x.xMountAdded = core.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &MountBase{Ptr: MountVarp})
 		})
 	}
@@ -158,7 +161,7 @@ func (x *VolumeMonitorClass) GetMountAdded() func(*VolumeMonitor, Mount) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountAdded)
+	core.RegisterFunc(&rawCallback, x.xMountAdded)
 	return func(VolumeMonitorVar *VolumeMonitor, MountVar Mount) {
 		rawCallback(VolumeMonitorVar.GoPointer(), MountVar.GoPointer())
 	}
@@ -169,7 +172,8 @@ func (x *VolumeMonitorClass) OverrideMountRemoved(cb func(*VolumeMonitor, Mount)
 	if cb == nil {
 		x.xMountRemoved = 0
 	} else {
-		x.xMountRemoved = purego.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+		x.xMountRemoved = core.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &MountBase{Ptr: MountVarp})
 		})
 	}
@@ -181,7 +185,7 @@ func (x *VolumeMonitorClass) GetMountRemoved() func(*VolumeMonitor, Mount) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountRemoved)
+	core.RegisterFunc(&rawCallback, x.xMountRemoved)
 	return func(VolumeMonitorVar *VolumeMonitor, MountVar Mount) {
 		rawCallback(VolumeMonitorVar.GoPointer(), MountVar.GoPointer())
 	}
@@ -192,7 +196,8 @@ func (x *VolumeMonitorClass) OverrideMountPreUnmount(cb func(*VolumeMonitor, Mou
 	if cb == nil {
 		x.xMountPreUnmount = 0
 	} else {
-		x.xMountPreUnmount = purego.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+		x.xMountPreUnmount = core.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &MountBase{Ptr: MountVarp})
 		})
 	}
@@ -204,7 +209,7 @@ func (x *VolumeMonitorClass) GetMountPreUnmount() func(*VolumeMonitor, Mount) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountPreUnmount)
+	core.RegisterFunc(&rawCallback, x.xMountPreUnmount)
 	return func(VolumeMonitorVar *VolumeMonitor, MountVar Mount) {
 		rawCallback(VolumeMonitorVar.GoPointer(), MountVar.GoPointer())
 	}
@@ -215,7 +220,8 @@ func (x *VolumeMonitorClass) OverrideMountChanged(cb func(*VolumeMonitor, Mount)
 	if cb == nil {
 		x.xMountChanged = 0
 	} else {
-		x.xMountChanged = purego.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+		x.xMountChanged = core.NewCallback(func(VolumeMonitorVarp uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &MountBase{Ptr: MountVarp})
 		})
 	}
@@ -227,7 +233,7 @@ func (x *VolumeMonitorClass) GetMountChanged() func(*VolumeMonitor, Mount) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, MountVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMountChanged)
+	core.RegisterFunc(&rawCallback, x.xMountChanged)
 	return func(VolumeMonitorVar *VolumeMonitor, MountVar Mount) {
 		rawCallback(VolumeMonitorVar.GoPointer(), MountVar.GoPointer())
 	}
@@ -238,7 +244,8 @@ func (x *VolumeMonitorClass) OverrideDriveConnected(cb func(*VolumeMonitor, Driv
 	if cb == nil {
 		x.xDriveConnected = 0
 	} else {
-		x.xDriveConnected = purego.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+		x.xDriveConnected = core.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -250,7 +257,7 @@ func (x *VolumeMonitorClass) GetDriveConnected() func(*VolumeMonitor, Drive) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDriveConnected)
+	core.RegisterFunc(&rawCallback, x.xDriveConnected)
 	return func(VolumeMonitorVar *VolumeMonitor, DriveVar Drive) {
 		rawCallback(VolumeMonitorVar.GoPointer(), DriveVar.GoPointer())
 	}
@@ -261,7 +268,8 @@ func (x *VolumeMonitorClass) OverrideDriveDisconnected(cb func(*VolumeMonitor, D
 	if cb == nil {
 		x.xDriveDisconnected = 0
 	} else {
-		x.xDriveDisconnected = purego.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+		x.xDriveDisconnected = core.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -273,7 +281,7 @@ func (x *VolumeMonitorClass) GetDriveDisconnected() func(*VolumeMonitor, Drive)
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDriveDisconnected)
+	core.RegisterFunc(&rawCallback, x.xDriveDisconnected)
 	return func(VolumeMonitorVar *VolumeMonitor, DriveVar Drive) {
 		rawCallback(VolumeMonitorVar.GoPointer(), DriveVar.GoPointer())
 	}
@@ -284,7 +292,8 @@ func (x *VolumeMonitorClass) OverrideDriveChanged(cb func(*VolumeMonitor, Drive)
 	if cb == nil {
 		x.xDriveChanged = 0
 	} else {
-		x.xDriveChanged = purego.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+		x.xDriveChanged = core.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -296,7 +305,7 @@ func (x *VolumeMonitorClass) GetDriveChanged() func(*VolumeMonitor, Drive) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDriveChanged)
+	core.RegisterFunc(&rawCallback, x.xDriveChanged)
 	return func(VolumeMonitorVar *VolumeMonitor, DriveVar Drive) {
 		rawCallback(VolumeMonitorVar.GoPointer(), DriveVar.GoPointer())
 	}
@@ -307,7 +316,8 @@ func (x *VolumeMonitorClass) OverrideIsSupported(cb func() bool) {
 	if cb == nil {
 		x.xIsSupported = 0
 	} else {
-		x.xIsSupported = purego.NewCallback(func() bool {
+		x.xIsSupported = core.NewCallback(func() bool {
+			defer core.RecoverPanic()
 			return cb()
 		})
 	}
@@ -319,7 +329,7 @@ func (x *VolumeMonitorClass) GetIsSupported() func() bool {
 		return nil
 	}
 	var rawCallback func() bool
-	purego.RegisterFunc(&rawCallback, x.xIsSupported)
+	core.RegisterFunc(&rawCallback, x.xIsSupported)
 	return func() bool {
 		return rawCallback()
 	}
@@ -330,7 +340,8 @@ func (x *VolumeMonitorClass) OverrideGetConnectedDrives(cb func(*VolumeMonitor)
 	if cb == nil {
 		x.xGetConnectedDrives = 0
 	} else {
-		x.xGetConnectedDrives = purego.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+		x.xGetConnectedDrives = core.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp))
 		})
 	}
@@ -342,7 +353,7 @@ func (x *VolumeMonitorClass) GetGetConnectedDrives() func(*VolumeMonitor) *glib.
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetConnectedDrives)
+	core.RegisterFunc(&rawCallback, x.xGetConnectedDrives)
 	return func(VolumeMonitorVar *VolumeMonitor) *glib.List {
 		return rawCallback(VolumeMonitorVar.GoPointer())
 	}
@@ -353,7 +364,8 @@ func (x *VolumeMonitorClass) OverrideGetVolumes(cb func(*VolumeMonitor) *glib.Li
 	if cb == nil {
 		x.xGetVolumes = 0
 	} else {
-		x.xGetVolumes = purego.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+		x.xGetVolumes = core.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp))
 		})
 	}
@@ -365,7 +377,7 @@ func (x *VolumeMonitorClass) GetGetVolumes() func(*VolumeMonitor) *glib.List {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetVolumes)
+	core.RegisterFunc(&rawCallback, x.xGetVolumes)
 	return func(VolumeMonitorVar *VolumeMonitor) *glib.List {
 		return rawCallback(VolumeMonitorVar.GoPointer())
 	}
@@ -376,7 +388,8 @@ func (x *VolumeMonitorClass) OverrideGetMounts(cb func(*VolumeMonitor) *glib.Lis
 	if cb == nil {
 		x.xGetMounts = 0
 	} else {
-		x.xGetMounts = purego.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+		x.xGetMounts = core.NewCallback(func(VolumeMonitorVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp))
 		})
 	}
@@ -388,7 +401,7 @@ func (x *VolumeMonitorClass) GetGetMounts() func(*VolumeMonitor) *glib.List {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetMounts)
+	core.RegisterFunc(&rawCallback, x.xGetMounts)
 	return func(VolumeMonitorVar *VolumeMonitor) *glib.List {
 		return rawCallback(VolumeMonitorVar.GoPointer())
 	}
@@ -399,7 +412,8 @@ func (x *VolumeMonitorClass) OverrideGetVolumeForUuid(cb func(*VolumeMonitor, st
 	if cb == nil {
 		x.xGetVolumeForUuid = 0
 	} else {
-		x.xGetVolumeForUuid = purego.NewCallback(func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr {
+		x.xGetVolumeForUuid = core.NewCallback(func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), UuidVarp)
 			if ret == nil {
 				return 0
@@ -415,7 +429,7 @@ func (x *VolumeMonitorClass) GetGetVolumeForUuid() func(*VolumeMonitor, string)
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetVolumeForUuid)
+	core.RegisterFunc(&rawCallback, x.xGetVolumeForUuid)
 	return func(VolumeMonitorVar *VolumeMonitor, UuidVar string) *VolumeBase {
 		rawRet := rawCallback(VolumeMonitorVar.GoPointer(), UuidVar)
 		if rawRet == 0 {
@@ -432,7 +446,8 @@ func (x *VolumeMonitorClass) OverrideGetMountForUuid(cb func(*VolumeMonitor, str
 	if cb == nil {
 		x.xGetMountForUuid = 0
 	} else {
-		x.xGetMountForUuid = purego.NewCallback(func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr {
+		x.xGetMountForUuid = core.NewCallback(func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), UuidVarp)
 			if ret == nil {
 				return 0
@@ -448,7 +463,7 @@ func (x *VolumeMonitorClass) GetGetMountForUuid() func(*VolumeMonitor, string) *
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, UuidVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetMountForUuid)
+	core.RegisterFunc(&rawCallback, x.xGetMountForUuid)
 	return func(VolumeMonitorVar *VolumeMonitor, UuidVar string) *MountBase {
 		rawRet := rawCallback(VolumeMonitorVar.GoPointer(), UuidVar)
 		if rawRet == 0 {
@@ -465,7 +480,8 @@ func (x *VolumeMonitorClass) OverrideAdoptOrphanMount(cb func(Mount, *VolumeMoni
 	if cb == nil {
 		x.xAdoptOrphanMount = 0
 	} else {
-		x.xAdoptOrphanMount = purego.NewCallback(func(MountVarp uintptr, VolumeMonitorVarp uintptr) uintptr {
+		x.xAdoptOrphanMount = core.NewCallback(func(MountVarp uintptr, VolumeMonitorVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&MountBase{Ptr: MountVarp}, VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp))
 			if ret == nil {
 				return 0
@@ -481,7 +497,7 @@ func (x *VolumeMonitorClass) GetAdoptOrphanMount() func(Mount, *VolumeMonitor) *
 		return nil
 	}
 	var rawCallback func(MountVarp uintptr, VolumeMonitorVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xAdoptOrphanMount)
+	core.RegisterFunc(&rawCallback, x.xAdoptOrphanMount)
 	return func(MountVar Mount, VolumeMonitorVar *VolumeMonitor) *VolumeBase {
 		rawRet := rawCallback(MountVar.GoPointer(), VolumeMonitorVar.GoPointer())
 		if rawRet == 0 {
@@ -498,7 +514,8 @@ func (x *VolumeMonitorClass) OverrideDriveEjectButton(cb func(*VolumeMonitor, Dr
 	if cb == nil {
 		x.xDriveEjectButton = 0
 	} else {
-		x.xDriveEjectButton = purego.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+		x.xDriveEjectButton = core.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -510,7 +527,7 @@ func (x *VolumeMonitorClass) GetDriveEjectButton() func(*VolumeMonitor, Drive) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDriveEjectButton)
+	core.RegisterFunc(&rawCallback, x.xDriveEjectButton)
 	return func(VolumeMonitorVar *VolumeMonitor, DriveVar Drive) {
 		rawCallback(VolumeMonitorVar.GoPointer(), DriveVar.GoPointer())
 	}
@@ -521,7 +538,8 @@ func (x *VolumeMonitorClass) OverrideDriveStopButton(cb func(*VolumeMonitor, Dri
 	if cb == nil {
 		x.xDriveStopButton = 0
 	} else {
-		x.xDriveStopButton = purego.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+		x.xDriveStopButton = core.NewCallback(func(VolumeMonitorVarp uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(VolumeMonitorNewFromInternalPtr(VolumeMonitorVarp), &DriveBase{Ptr: DriveVarp})
 		})
 	}
@@ -533,7 +551,7 @@ func (x *VolumeMonitorClass) GetDriveStopButton() func(*VolumeMonitor, Drive) {
 		return nil
 	}
 	var rawCallback func(VolumeMonitorVarp uintptr, DriveVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDriveStopButton)
+	core.RegisterFunc(&rawCallback, x.xDriveStopButton)
 	return func(VolumeMonitorVar *VolumeMonitor, DriveVar Drive) {
 		rawCallback(VolumeMonitorVar.GoPointer(), DriveVar.GoPointer())
 	}
@@ -544,7 +562,8 @@ func (x *VolumeMonitorClass) OverrideGReserved1(cb func()) {
 	if cb == nil {
 		x.xGReserved1 = 0
 	} else {
-		x.xGReserved1 = purego.NewCallback(func() {
+		x.xGReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -556,7 +575,7 @@ func (x *VolumeMonitorClass) GetGReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved1)
+	core.RegisterFunc(&rawCallback, x.xGReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -567,7 +586,8 @@ func (x *VolumeMonitorClass) OverrideGReserved2(cb func()) {
 	if cb == nil {
 		x.xGReserved2 = 0
 	} else {
-		x.xGReserved2 = purego.NewCallback(func() {
+		x.xGReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -579,7 +599,7 @@ func (x *VolumeMonitorClass) GetGReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved2)
+	core.RegisterFunc(&rawCallback, x.xGReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -590,7 +610,8 @@ func (x *VolumeMonitorClass) OverrideGReserved3(cb func()) {
 	if cb == nil {
 		x.xGReserved3 = 0
 	} else {
-		x.xGReserved3 = purego.NewCallback(func() {
+		x.xGReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -602,7 +623,7 @@ func (x *VolumeMonitorClass) GetGReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved3)
+	core.RegisterFunc(&rawCallback, x.xGReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -613,7 +634,8 @@ func (x *VolumeMonitorClass) OverrideGReserved4(cb func()) {
 	if cb == nil {
 		x.xGReserved4 = 0
 	} else {
-		x.xGReserved4 = purego.NewCallback(func() {
+		x.xGReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -625,7 +647,7 @@ func (x *VolumeMonitorClass) GetGReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved4)
+	core.RegisterFunc(&rawCallback, x.xGReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -636,7 +658,8 @@ func (x *VolumeMonitorClass) OverrideGReserved5(cb func()) {
 	if cb == nil {
 		x.xGReserved5 = 0
 	} else {
-		x.xGReserved5 = purego.NewCallback(func() {
+		x.xGReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -648,7 +671,7 @@ func (x *VolumeMonitorClass) GetGReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved5)
+	core.RegisterFunc(&rawCallback, x.xGReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -659,7 +682,8 @@ func (x *VolumeMonitorClass) OverrideGReserved6(cb func()) {
 	if cb == nil {
 		x.xGReserved6 = 0
 	} else {
-		x.xGReserved6 = purego.NewCallback(func() {
+		x.xGReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -671,7 +695,7 @@ func (x *VolumeMonitorClass) GetGReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGReserved6)
+	core.RegisterFunc(&rawCallback, x.xGReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -679,7 +703,7 @@ func (x *VolumeMonitorClass) GetGReserved6() func() {
 
 const (
 	// Extension point for volume monitor functionality.
-	// See [Extending GIO](overview.html#extending-gio).
+	// See Extending GIO.
 	VOLUME_MONITOR_EXTENSION_POINT_NAME string = "gio-volume-monitor"
 )
 
@@ -689,7 +713,7 @@ const (
 //
 // `GVolumeMonitor` is not
 // thread-default-context aware (see
-// [method@GLib.MainContext.push_thread_default]), and so should not be used
+// [glib.MainContext.PushThreadDefault]), and so should not be used
 // other than from the main thread, with no thread-default-context active.
 //
 // In order to receive updates about volumes and mounts monitored through GVFS,
@@ -715,7 +739,7 @@ var xVolumeMonitorGetConnectedDrives func(uintptr) *glib.List
 // Gets a list of drives connected to the system.
 //
 // The returned list should be freed with g_list_free(), after
-// its elements have been unreffed with g_object_unref().
+// its elements have been unreffed with [gobject.Object.Unref].
 func (x *VolumeMonitor) GetConnectedDrives() *glib.List {
 
 	cret := xVolumeMonitorGetConnectedDrives(x.GoPointer())
@@ -724,7 +748,7 @@ func (x *VolumeMonitor) GetConnectedDrives() *glib.List {
 
 var xVolumeMonitorGetMountForUuid func(uintptr, string) uintptr
 
-// Finds a #GMount object by its UUID (see g_mount_get_uuid())
+// Finds a [Mount] object by its UUID (see [Mount.GetUuid])
 func (x *VolumeMonitor) GetMountForUuid(UuidVar string) *MountBase {
 	var cls *MountBase
 
@@ -743,7 +767,7 @@ var xVolumeMonitorGetMounts func(uintptr) *glib.List
 // Gets a list of the mounts on the system.
 //
 // The returned list should be freed with g_list_free(), after
-// its elements have been unreffed with g_object_unref().
+// its elements have been unreffed with [gobject.Object.Unref].
 func (x *VolumeMonitor) GetMounts() *glib.List {
 
 	cret := xVolumeMonitorGetMounts(x.GoPointer())
@@ -752,7 +776,7 @@ func (x *VolumeMonitor) GetMounts() *glib.List {
 
 var xVolumeMonitorGetVolumeForUuid func(uintptr, string) uintptr
 
-// Finds a #GVolume object by its UUID (see g_volume_get_uuid())
+// Finds a [Volume] object by its UUID (see [Volume.GetUuid])
 func (x *VolumeMonitor) GetVolumeForUuid(UuidVar string) *VolumeBase {
 	var cls *VolumeBase
 
@@ -771,7 +795,7 @@ var xVolumeMonitorGetVolumes func(uintptr) *glib.List
 // Gets a list of the volumes on the system.
 //
 // The returned list should be freed with g_list_free(), after
-// its elements have been unreffed with g_object_unref().
+// its elements have been unreffed with [gobject.Object.Unref].
 func (x *VolumeMonitor) GetVolumes() *glib.List {
 
 	cret := xVolumeMonitorGetVolumes(x.GoPointer())
@@ -790,337 +814,558 @@ func (c *VolumeMonitor) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when a drive changes.
+//
+// Deprecated: use ConnectDriveChangedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectDriveChanged(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drive-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DriveVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drive-changed", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorDriveChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+func xVolumeMonitorDriveChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DriveVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DriveVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drive-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDriveChangedFunc connects cb to the "drive-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectDriveChangedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drive-changed", &xVolumeMonitorDriveChangedTrampoline, xVolumeMonitorDriveChangedNewTrampoline, cb)
 }
 
 // Emitted when a drive is connected to the system.
+//
+// Deprecated: use ConnectDriveConnectedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectDriveConnected(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drive-connected", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DriveVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drive-connected", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+var xVolumeMonitorDriveConnectedTrampoline uintptr
+
+func xVolumeMonitorDriveConnectedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DriveVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DriveVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drive-connected", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDriveConnectedFunc connects cb to the "drive-connected" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectDriveConnectedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drive-connected", &xVolumeMonitorDriveConnectedTrampoline, xVolumeMonitorDriveConnectedNewTrampoline, cb)
 }
 
 // Emitted when a drive is disconnected from the system.
+//
+// Deprecated: use ConnectDriveDisconnectedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectDriveDisconnected(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drive-disconnected", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DriveVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drive-disconnected", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorDriveDisconnectedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+func xVolumeMonitorDriveDisconnectedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DriveVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DriveVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drive-disconnected", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the eject button is pressed on @drive.
+// ConnectDriveDisconnectedFunc connects cb to the "drive-disconnected" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectDriveDisconnectedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drive-disconnected", &xVolumeMonitorDriveDisconnectedTrampoline, xVolumeMonitorDriveDisconnectedNewTrampoline, cb)
+}
+
+// Emitted when the eject button is pressed on drive.
+//
+// Deprecated: use ConnectDriveEjectButtonFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectDriveEjectButton(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drive-eject-button", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DriveVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drive-eject-button", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorDriveEjectButtonTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+func xVolumeMonitorDriveEjectButtonNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DriveVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DriveVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drive-eject-button", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the stop button is pressed on @drive.
+// ConnectDriveEjectButtonFunc connects cb to the "drive-eject-button" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectDriveEjectButtonFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drive-eject-button", &xVolumeMonitorDriveEjectButtonTrampoline, xVolumeMonitorDriveEjectButtonNewTrampoline, cb)
+}
+
+// Emitted when the stop button is pressed on drive.
+//
+// Deprecated: use ConnectDriveStopButtonFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectDriveStopButton(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drive-stop-button", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DriveVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drive-stop-button", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DriveVarp uintptr) {
+var xVolumeMonitorDriveStopButtonTrampoline uintptr
+
+func xVolumeMonitorDriveStopButtonNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DriveVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DriveVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drive-stop-button", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDriveStopButtonFunc connects cb to the "drive-stop-button" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectDriveStopButtonFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drive-stop-button", &xVolumeMonitorDriveStopButtonTrampoline, xVolumeMonitorDriveStopButtonNewTrampoline, cb)
 }
 
 // Emitted when a mount is added.
+//
+// Deprecated: use ConnectMountAddedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectMountAdded(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mount-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mount-added", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, MountVarp uintptr) {
+var xVolumeMonitorMountAddedTrampoline uintptr
+
+func xVolumeMonitorMountAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MountVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mount-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMountAddedFunc connects cb to the "mount-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectMountAddedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mount-added", &xVolumeMonitorMountAddedTrampoline, xVolumeMonitorMountAddedNewTrampoline, cb)
 }
 
 // Emitted when a mount changes.
+//
+// Deprecated: use ConnectMountChangedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectMountChanged(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mount-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mount-changed", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorMountChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MountVarp uintptr) {
+func xVolumeMonitorMountChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MountVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mount-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMountChangedFunc connects cb to the "mount-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectMountChangedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mount-changed", &xVolumeMonitorMountChangedTrampoline, xVolumeMonitorMountChangedNewTrampoline, cb)
 }
 
 // May be emitted when a mount is about to be removed.
 //
 // This signal depends on the backend and is only emitted if
 // GIO was used to unmount.
+//
+// Deprecated: use ConnectMountPreUnmountFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectMountPreUnmount(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mount-pre-unmount", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mount-pre-unmount", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorMountPreUnmountTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MountVarp uintptr) {
+func xVolumeMonitorMountPreUnmountNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MountVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mount-pre-unmount", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMountPreUnmountFunc connects cb to the "mount-pre-unmount" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectMountPreUnmountFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mount-pre-unmount", &xVolumeMonitorMountPreUnmountTrampoline, xVolumeMonitorMountPreUnmountNewTrampoline, cb)
 }
 
 // Emitted when a mount is removed.
+//
+// Deprecated: use ConnectMountRemovedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectMountRemoved(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mount-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MountVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mount-removed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, MountVarp uintptr) {
+var xVolumeMonitorMountRemovedTrampoline uintptr
+
+func xVolumeMonitorMountRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MountVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mount-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMountRemovedFunc connects cb to the "mount-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectMountRemovedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mount-removed", &xVolumeMonitorMountRemovedTrampoline, xVolumeMonitorMountRemovedNewTrampoline, cb)
 }
 
 // Emitted when a mountable volume is added to the system.
+//
+// Deprecated: use ConnectVolumeAddedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectVolumeAdded(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "volume-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VolumeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "volume-added", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorVolumeAddedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+func xVolumeMonitorVolumeAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VolumeVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VolumeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "volume-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectVolumeAddedFunc connects cb to the "volume-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectVolumeAddedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "volume-added", &xVolumeMonitorVolumeAddedTrampoline, xVolumeMonitorVolumeAddedNewTrampoline, cb)
 }
 
 // Emitted when mountable volume is changed.
+//
+// Deprecated: use ConnectVolumeChangedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectVolumeChanged(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "volume-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VolumeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "volume-changed", cbRefPtr, cbPtr)
+}
+
+var xVolumeMonitorVolumeChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+func xVolumeMonitorVolumeChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VolumeVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VolumeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "volume-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectVolumeChangedFunc connects cb to the "volume-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectVolumeChangedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "volume-changed", &xVolumeMonitorVolumeChangedTrampoline, xVolumeMonitorVolumeChangedNewTrampoline, cb)
 }
 
 // Emitted when a mountable volume is removed from the system.
+//
+// Deprecated: use ConnectVolumeRemovedFunc, which also accepts method values and closures.
 func (x *VolumeMonitor) ConnectVolumeRemoved(cb *func(VolumeMonitor, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "volume-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := VolumeMonitor{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VolumeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "volume-removed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, VolumeVarp uintptr) {
+var xVolumeMonitorVolumeRemovedTrampoline uintptr
+
+func xVolumeMonitorVolumeRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VolumeVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(VolumeMonitor, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := VolumeMonitor{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VolumeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "volume-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-var xVolumeMonitorAdoptOrphanMount func(uintptr) uintptr
-
-// This function should be called by any #GVolumeMonitor
-// implementation when a new #GMount object is created that is not
-// associated with a #GVolume object. It must be called just before
-// emitting the @mount_added signal.
-//
-// If the return value is not %NULL, the caller must associate the
-// returned #GVolume object with the #GMount. This involves returning
-// it in its g_mount_get_volume() implementation. The caller must
-// also listen for the "removed" signal on the returned object
-// and give up its reference when handling that signal
-//
-// Similarly, if implementing g_volume_monitor_adopt_orphan_mount(),
-// the implementor must take a reference to @mount and return it in
-// its g_volume_get_mount() implemented. Also, the implementor must
-// listen for the "unmounted" signal on @mount and give up its
-// reference upon handling that signal.
-//
-// There are two main use cases for this function.
-//
-// One is when implementing a user space file system driver that reads
-// blocks of a block device that is already represented by the native
-// volume monitor (for example a CD Audio file system driver). Such
-// a driver will generate its own #GMount object that needs to be
-// associated with the #GVolume object that represents the volume.
-//
-// The other is for implementing a #GVolumeMonitor whose sole purpose
-// is to return #GVolume objects representing entries in the users
-// "favorite servers" list or similar.
-func VolumeMonitorAdoptOrphanMount(MountVar Mount) *VolumeBase {
-	var cls *VolumeBase
-
-	cret := xVolumeMonitorAdoptOrphanMount(MountVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &VolumeBase{}
-	cls.Ptr = cret
-	return cls
+// ConnectVolumeRemovedFunc connects cb to the "volume-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *VolumeMonitor) ConnectVolumeRemovedFunc(cb func(VolumeMonitor, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "volume-removed", &xVolumeMonitorVolumeRemovedTrampoline, xVolumeMonitorVolumeRemovedNewTrampoline, cb)
 }
 
 var xVolumeMonitorGet func() uintptr
@@ -1144,7 +1389,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1153,13 +1398,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xVolumeMonitorGLibType, libs, "g_volume_monitor_get_type")
 
-	core.PuregoSafeRegister(&xVolumeMonitorGetConnectedDrives, libs, "g_volume_monitor_get_connected_drives")
-	core.PuregoSafeRegister(&xVolumeMonitorGetMountForUuid, libs, "g_volume_monitor_get_mount_for_uuid")
-	core.PuregoSafeRegister(&xVolumeMonitorGetMounts, libs, "g_volume_monitor_get_mounts")
-	core.PuregoSafeRegister(&xVolumeMonitorGetVolumeForUuid, libs, "g_volume_monitor_get_volume_for_uuid")
-	core.PuregoSafeRegister(&xVolumeMonitorGetVolumes, libs, "g_volume_monitor_get_volumes")
+	gobject.CheckClassSize("GVolumeMonitorClass", xVolumeMonitorGLibType, unsafe.Sizeof(VolumeMonitorClass{}))
+
+	core.PuregoRegisterSince(&xVolumeMonitorGetConnectedDrives, libs, "g_volume_monitor_get_connected_drives", "GIO", "")
+	core.PuregoRegisterSince(&xVolumeMonitorGetMountForUuid, libs, "g_volume_monitor_get_mount_for_uuid", "GIO", "")
+	core.PuregoRegisterSince(&xVolumeMonitorGetMounts, libs, "g_volume_monitor_get_mounts", "GIO", "")
+	core.PuregoRegisterSince(&xVolumeMonitorGetVolumeForUuid, libs, "g_volume_monitor_get_volume_for_uuid", "GIO", "")
+	core.PuregoRegisterSince(&xVolumeMonitorGetVolumes, libs, "g_volume_monitor_get_volumes", "GIO", "")
 
-	core.PuregoSafeRegister(&xVolumeMonitorAdoptOrphanMount, libs, "g_volume_monitor_adopt_orphan_mount")
-	core.PuregoSafeRegister(&xVolumeMonitorGet, libs, "g_volume_monitor_get")
+	core.PuregoRegisterSince(&xVolumeMonitorGet, libs, "g_volume_monitor_get", "GIO", "")
 
 }
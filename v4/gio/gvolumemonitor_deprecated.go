@@ -0,0 +1,73 @@
+//go:build !puregotk_no_deprecated
+
+// Package gio was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xVolumeMonitorAdoptOrphanMount func(uintptr) uintptr
+
+// This function should be called by any [VolumeMonitor]
+// implementation when a new [Mount] object is created that is not
+// associated with a [Volume] object. It must be called just before
+// emitting the mount_added signal.
+//
+// If the return value is not nil, the caller must associate the
+// returned [Volume] object with the [Mount]. This involves returning
+// it in its [Mount.GetVolume] implementation. The caller must
+// also listen for the "removed" signal on the returned object
+// and give up its reference when handling that signal
+//
+// Similarly, if implementing [VolumeMonitorAdoptOrphanMount],
+// the implementor must take a reference to mount and return it in
+// its [Volume.GetMount] implemented. Also, the implementor must
+// listen for the "unmounted" signal on mount and give up its
+// reference upon handling that signal.
+//
+// There are two main use cases for this function.
+//
+// One is when implementing a user space file system driver that reads
+// blocks of a block device that is already represented by the native
+// volume monitor (for example a CD Audio file system driver). Such
+// a driver will generate its own [Mount] object that needs to be
+// associated with the [Volume] object that represents the volume.
+//
+// The other is for implementing a [VolumeMonitor] whose sole purpose
+// is to return [Volume] objects representing entries in the users
+// "favorite servers" list or similar.
+//
+// Deprecated: since 2.20. Instead of using this function, [VolumeMonitor]
+// implementations should instead create shadow mounts with the URI of
+// the mount they intend to adopt. See the proxy volume monitor in
+// gvfs for an example of this. Also see [Mount.IsShadowed],
+// [Mount.Shadow] and [Mount.Unshadow] functions.
+func VolumeMonitorAdoptOrphanMount(MountVar Mount) *VolumeBase {
+	var cls *VolumeBase
+
+	cret := xVolumeMonitorAdoptOrphanMount(MountVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &VolumeBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GIO", "gio-2.0")
+	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xVolumeMonitorAdoptOrphanMount, libs, "g_volume_monitor_adopt_orphan_mount", "GIO", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,7 +21,7 @@ func (x *ZlibCompressorClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GZlibCompressor` is an implementation of [iface@Gio.Converter] that
+// `GZlibCompressor` is an implementation of [Converter] that
 // compresses data using zlib.
 type ZlibCompressor struct {
 	gobject.Object
@@ -58,7 +57,7 @@ func NewZlibCompressor(FormatVar ZlibCompressorFormat, LevelVar int) *ZlibCompre
 
 var xZlibCompressorGetFileInfo func(uintptr) uintptr
 
-// Gets the [property@Gio.ZlibCompressor:file-info] property.
+// Gets the ZlibCompressor:file-info property.
 func (x *ZlibCompressor) GetFileInfo() *FileInfo {
 	var cls *FileInfo
 
@@ -75,7 +74,7 @@ func (x *ZlibCompressor) GetFileInfo() *FileInfo {
 
 var xZlibCompressorGetOs func(uintptr) int
 
-// Gets the [property@Gio.ZlibCompressor:os] property.
+// Gets the [ZlibCompressor.GetPropertyOs] property.
 func (x *ZlibCompressor) GetOs() int {
 
 	cret := xZlibCompressorGetOs(x.GoPointer())
@@ -84,11 +83,11 @@ func (x *ZlibCompressor) GetOs() int {
 
 var xZlibCompressorSetFileInfo func(uintptr, uintptr)
 
-// Sets the [property@Gio.ZlibCompressor:file-info] property.
+// Sets the ZlibCompressor:file-info property.
 //
 // Note: it is an error to call this function while a compression is in
-// progress; it may only be called immediately after creation of @compressor,
-// or after resetting it with [method@Gio.Converter.reset].
+// progress; it may only be called immediately after creation of compressor,
+// or after resetting it with [Converter.Reset].
 func (x *ZlibCompressor) SetFileInfo(FileInfoVar *FileInfo) {
 
 	xZlibCompressorSetFileInfo(x.GoPointer(), FileInfoVar.GoPointer())
@@ -97,11 +96,11 @@ func (x *ZlibCompressor) SetFileInfo(FileInfoVar *FileInfo) {
 
 var xZlibCompressorSetOs func(uintptr, int)
 
-// Sets the [property@Gio.ZlibCompressor:os] property.
+// Sets the [ZlibCompressor.GetPropertyOs] property.
 //
 // Note: it is an error to call this function while a compression is in
-// progress; it may only be called immediately after creation of @compressor,
-// or after resetting it with [method@Gio.Converter.reset].
+// progress; it may only be called immediately after creation of compressor,
+// or after resetting it with [Converter.Reset].
 func (x *ZlibCompressor) SetOs(OsVar int) {
 
 	xZlibCompressorSetOs(x.GoPointer(), OsVar)
@@ -146,8 +145,8 @@ func (x *ZlibCompressor) GetPropertyLevel() int {
 // The OS code of the gzip header.
 //
 // This will be used if set to a non-negative value, and if
-// [property@Gio.ZlibCompressor:format] is
-// [enum@Gio.ZlibCompressorFormat.GZIP], the compressor will set the OS code of
+// ZlibCompressor:format is
+// ZlibCompressorFormat.GZIP, the compressor will set the OS code of
 // the gzip header to this value.
 //
 // If the value is unset, zlib will set the OS code depending on the platform.
@@ -164,8 +163,8 @@ func (x *ZlibCompressor) SetPropertyOs(value int) {
 // The OS code of the gzip header.
 //
 // This will be used if set to a non-negative value, and if
-// [property@Gio.ZlibCompressor:format] is
-// [enum@Gio.ZlibCompressorFormat.GZIP], the compressor will set the OS code of
+// ZlibCompressor:format is
+// ZlibCompressorFormat.GZIP, the compressor will set the OS code of
 // the gzip header to this value.
 //
 // If the value is unset, zlib will set the OS code depending on the platform.
@@ -179,7 +178,7 @@ func (x *ZlibCompressor) GetPropertyOs() int {
 
 // This is the main operation used when converting data. It is to be called
 // multiple times in a loop, and each time it will do some work, i.e.
-// producing some output (in @outbuf) or consuming some input (from @inbuf) or
+// producing some output (in outbuf) or consuming some input (from inbuf) or
 // both. If its not possible to do any work an error is returned.
 //
 // Note that a single call may not consume all input (or any input at all).
@@ -192,56 +191,56 @@ func (x *ZlibCompressor) GetPropertyOs() int {
 //
 // A full conversion loop involves calling this method repeatedly, each time
 // giving it new input and space output space. When there is no more input
-// data after the data in @inbuf, the flag %G_CONVERTER_INPUT_AT_END must be set.
-// The loop will be (unless some error happens) returning %G_CONVERTER_CONVERTED
+// data after the data in inbuf, the flag [GConverterInputAtEndValue] must be set.
+// The loop will be (unless some error happens) returning [GConverterConvertedValue]
 // each time until all data is consumed and all output is produced, then
-// %G_CONVERTER_FINISHED is returned instead. Note, that %G_CONVERTER_FINISHED
-// may be returned even if %G_CONVERTER_INPUT_AT_END is not set, for instance
+// [GConverterFinishedValue] is returned instead. Note, that [GConverterFinishedValue]
+// may be returned even if [GConverterInputAtEndValue] is not set, for instance
 // in a decompression converter where the end of data is detectable from the
 // data (and there might even be other data after the end of the compressed data).
 //
-// When some data has successfully been converted @bytes_read and is set to
-// the number of bytes read from @inbuf, and @bytes_written is set to indicate
-// how many bytes was written to @outbuf. If there are more data to output
-// or consume (i.e. unless the %G_CONVERTER_INPUT_AT_END is specified) then
-// %G_CONVERTER_CONVERTED is returned, and if no more data is to be output
-// then %G_CONVERTER_FINISHED is returned.
+// When some data has successfully been converted bytes_read and is set to
+// the number of bytes read from inbuf, and bytes_written is set to indicate
+// how many bytes was written to outbuf. If there are more data to output
+// or consume (i.e. unless the [GConverterInputAtEndValue] is specified) then
+// [GConverterConvertedValue] is returned, and if no more data is to be output
+// then [GConverterFinishedValue] is returned.
 //
-// On error %G_CONVERTER_ERROR is returned and @error is set accordingly.
+// On error [GConverterErrorValue] is returned and error is set accordingly.
 // Some errors need special handling:
 //
-// %G_IO_ERROR_NO_SPACE is returned if there is not enough space
+// [GIoErrorNoSpaceValue] is returned if there is not enough space
 // to write the resulting converted data, the application should
-// call the function again with a larger @outbuf to continue.
+// call the function again with a larger outbuf to continue.
 //
-// %G_IO_ERROR_PARTIAL_INPUT is returned if there is not enough
+// [GIoErrorPartialInputValue] is returned if there is not enough
 // input to fully determine what the conversion should produce,
-// and the %G_CONVERTER_INPUT_AT_END flag is not set. This happens for
+// and the [GConverterInputAtEndValue] flag is not set. This happens for
 // example with an incomplete multibyte sequence when converting text,
 // or when a regexp matches up to the end of the input (and may match
-// further input). It may also happen when @inbuf_size is zero and
+// further input). It may also happen when inbuf_size is zero and
 // there is no more data to produce.
 //
 // When this happens the application should read more input and then
 // call the function again. If further input shows that there is no
 // more data call the function again with the same data but with
-// the %G_CONVERTER_INPUT_AT_END flag set. This may cause the conversion
+// the [GConverterInputAtEndValue] flag set. This may cause the conversion
 // to finish as e.g. in the regexp match case (or, to fail again with
-// %G_IO_ERROR_PARTIAL_INPUT in e.g. a charset conversion where the
+// [GIoErrorPartialInputValue] in e.g. a charset conversion where the
 // input is actually partial).
 //
-// After g_converter_convert() has returned %G_CONVERTER_FINISHED the
+// After [Converter.Convert] has returned [GConverterFinishedValue] the
 // converter object is in an invalid state where its not allowed
-// to call g_converter_convert() anymore. At this time you can only
-// free the object or call g_converter_reset() to reset it to the
+// to call [Converter.Convert] anymore. At this time you can only
+// free the object or call [Converter.Reset] to reset it to the
 // initial state.
 //
-// If the flag %G_CONVERTER_FLUSH is set then conversion is modified
+// If the flag [GConverterFlushValue] is set then conversion is modified
 // to try to write out all internal state to the output. The application
 // has to call the function multiple times with the flag set, and when
 // the available input has been consumed and all internal state has
-// been produced then %G_CONVERTER_FLUSHED (or %G_CONVERTER_FINISHED if
-// really at the end) is returned instead of %G_CONVERTER_CONVERTED.
+// been produced then [GConverterFlushedValue] (or [GConverterFinishedValue] if
+// really at the end) is returned instead of [GConverterConvertedValue].
 // This is somewhat similar to what happens at the end of the input stream,
 // but done in the middle of the data.
 //
@@ -258,25 +257,25 @@ func (x *ZlibCompressor) GetPropertyOs() int {
 // Flushing is not always possible (like if a charset converter flushes
 // at a partial multibyte sequence). Converters are supposed to try
 // to produce as much output as possible and then return an error
-// (typically %G_IO_ERROR_PARTIAL_INPUT).
+// (typically [GIoErrorPartialInputValue]).
 func (x *ZlibCompressor) Convert(InbufVar []byte, InbufSizeVar uint, OutbufVar []byte, OutbufSizeVar uint, FlagsVar ConverterFlags, BytesReadVar *uint, BytesWrittenVar *uint) (ConverterResult, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvert(x.GoPointer(), InbufVar, InbufSizeVar, OutbufVar, OutbufSizeVar, FlagsVar, BytesReadVar, BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Applies @converter to the data in @bytes.
+// Applies converter to the data in bytes.
 func (x *ZlibCompressor) ConvertBytes(BytesVar *glib.Bytes) (*glib.Bytes, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvertBytes(x.GoPointer(), BytesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -296,7 +295,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -305,11 +304,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xZlibCompressorGLibType, libs, "g_zlib_compressor_get_type")
 
-	core.PuregoSafeRegister(&xNewZlibCompressor, libs, "g_zlib_compressor_new")
+	gobject.CheckClassSize("GZlibCompressorClass", xZlibCompressorGLibType, unsafe.Sizeof(ZlibCompressorClass{}))
 
-	core.PuregoSafeRegister(&xZlibCompressorGetFileInfo, libs, "g_zlib_compressor_get_file_info")
-	core.PuregoSafeRegister(&xZlibCompressorGetOs, libs, "g_zlib_compressor_get_os")
-	core.PuregoSafeRegister(&xZlibCompressorSetFileInfo, libs, "g_zlib_compressor_set_file_info")
-	core.PuregoSafeRegister(&xZlibCompressorSetOs, libs, "g_zlib_compressor_set_os")
+	core.PuregoRegisterSince(&xNewZlibCompressor, libs, "g_zlib_compressor_new", "GIO", "2.24")
+
+	core.PuregoRegisterSince(&xZlibCompressorGetFileInfo, libs, "g_zlib_compressor_get_file_info", "GIO", "2.26")
+	core.PuregoRegisterSince(&xZlibCompressorGetOs, libs, "g_zlib_compressor_get_os", "GIO", "2.86")
+	core.PuregoRegisterSince(&xZlibCompressorSetFileInfo, libs, "g_zlib_compressor_set_file_info", "GIO", "2.26")
+	core.PuregoRegisterSince(&xZlibCompressorSetOs, libs, "g_zlib_compressor_set_os", "GIO", "2.86")
 
 }
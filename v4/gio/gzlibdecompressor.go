@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,7 +21,7 @@ func (x *ZlibDecompressorClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// `GZlibDecompressor` is an implementation of [iface@Gio.Converter] that
+// `GZlibDecompressor` is an implementation of [Converter] that
 // decompresses data compressed with zlib.
 type ZlibDecompressor struct {
 	gobject.Object
@@ -58,7 +57,7 @@ func NewZlibDecompressor(FormatVar ZlibCompressorFormat) *ZlibDecompressor {
 
 var xZlibDecompressorGetFileInfo func(uintptr) uintptr
 
-// Gets the [property@Gio.ZlibDecompressor:file-info] property.
+// Gets the ZlibDecompressor:file-info property.
 func (x *ZlibDecompressor) GetFileInfo() *FileInfo {
 	var cls *FileInfo
 
@@ -86,7 +85,7 @@ func (c *ZlibDecompressor) SetGoPointer(ptr uintptr) {
 
 // This is the main operation used when converting data. It is to be called
 // multiple times in a loop, and each time it will do some work, i.e.
-// producing some output (in @outbuf) or consuming some input (from @inbuf) or
+// producing some output (in outbuf) or consuming some input (from inbuf) or
 // both. If its not possible to do any work an error is returned.
 //
 // Note that a single call may not consume all input (or any input at all).
@@ -99,56 +98,56 @@ func (c *ZlibDecompressor) SetGoPointer(ptr uintptr) {
 //
 // A full conversion loop involves calling this method repeatedly, each time
 // giving it new input and space output space. When there is no more input
-// data after the data in @inbuf, the flag %G_CONVERTER_INPUT_AT_END must be set.
-// The loop will be (unless some error happens) returning %G_CONVERTER_CONVERTED
+// data after the data in inbuf, the flag [GConverterInputAtEndValue] must be set.
+// The loop will be (unless some error happens) returning [GConverterConvertedValue]
 // each time until all data is consumed and all output is produced, then
-// %G_CONVERTER_FINISHED is returned instead. Note, that %G_CONVERTER_FINISHED
-// may be returned even if %G_CONVERTER_INPUT_AT_END is not set, for instance
+// [GConverterFinishedValue] is returned instead. Note, that [GConverterFinishedValue]
+// may be returned even if [GConverterInputAtEndValue] is not set, for instance
 // in a decompression converter where the end of data is detectable from the
 // data (and there might even be other data after the end of the compressed data).
 //
-// When some data has successfully been converted @bytes_read and is set to
-// the number of bytes read from @inbuf, and @bytes_written is set to indicate
-// how many bytes was written to @outbuf. If there are more data to output
-// or consume (i.e. unless the %G_CONVERTER_INPUT_AT_END is specified) then
-// %G_CONVERTER_CONVERTED is returned, and if no more data is to be output
-// then %G_CONVERTER_FINISHED is returned.
+// When some data has successfully been converted bytes_read and is set to
+// the number of bytes read from inbuf, and bytes_written is set to indicate
+// how many bytes was written to outbuf. If there are more data to output
+// or consume (i.e. unless the [GConverterInputAtEndValue] is specified) then
+// [GConverterConvertedValue] is returned, and if no more data is to be output
+// then [GConverterFinishedValue] is returned.
 //
-// On error %G_CONVERTER_ERROR is returned and @error is set accordingly.
+// On error [GConverterErrorValue] is returned and error is set accordingly.
 // Some errors need special handling:
 //
-// %G_IO_ERROR_NO_SPACE is returned if there is not enough space
+// [GIoErrorNoSpaceValue] is returned if there is not enough space
 // to write the resulting converted data, the application should
-// call the function again with a larger @outbuf to continue.
+// call the function again with a larger outbuf to continue.
 //
-// %G_IO_ERROR_PARTIAL_INPUT is returned if there is not enough
+// [GIoErrorPartialInputValue] is returned if there is not enough
 // input to fully determine what the conversion should produce,
-// and the %G_CONVERTER_INPUT_AT_END flag is not set. This happens for
+// and the [GConverterInputAtEndValue] flag is not set. This happens for
 // example with an incomplete multibyte sequence when converting text,
 // or when a regexp matches up to the end of the input (and may match
-// further input). It may also happen when @inbuf_size is zero and
+// further input). It may also happen when inbuf_size is zero and
 // there is no more data to produce.
 //
 // When this happens the application should read more input and then
 // call the function again. If further input shows that there is no
 // more data call the function again with the same data but with
-// the %G_CONVERTER_INPUT_AT_END flag set. This may cause the conversion
+// the [GConverterInputAtEndValue] flag set. This may cause the conversion
 // to finish as e.g. in the regexp match case (or, to fail again with
-// %G_IO_ERROR_PARTIAL_INPUT in e.g. a charset conversion where the
+// [GIoErrorPartialInputValue] in e.g. a charset conversion where the
 // input is actually partial).
 //
-// After g_converter_convert() has returned %G_CONVERTER_FINISHED the
+// After [Converter.Convert] has returned [GConverterFinishedValue] the
 // converter object is in an invalid state where its not allowed
-// to call g_converter_convert() anymore. At this time you can only
-// free the object or call g_converter_reset() to reset it to the
+// to call [Converter.Convert] anymore. At this time you can only
+// free the object or call [Converter.Reset] to reset it to the
 // initial state.
 //
-// If the flag %G_CONVERTER_FLUSH is set then conversion is modified
+// If the flag [GConverterFlushValue] is set then conversion is modified
 // to try to write out all internal state to the output. The application
 // has to call the function multiple times with the flag set, and when
 // the available input has been consumed and all internal state has
-// been produced then %G_CONVERTER_FLUSHED (or %G_CONVERTER_FINISHED if
-// really at the end) is returned instead of %G_CONVERTER_CONVERTED.
+// been produced then [GConverterFlushedValue] (or [GConverterFinishedValue] if
+// really at the end) is returned instead of [GConverterConvertedValue].
 // This is somewhat similar to what happens at the end of the input stream,
 // but done in the middle of the data.
 //
@@ -165,25 +164,25 @@ func (c *ZlibDecompressor) SetGoPointer(ptr uintptr) {
 // Flushing is not always possible (like if a charset converter flushes
 // at a partial multibyte sequence). Converters are supposed to try
 // to produce as much output as possible and then return an error
-// (typically %G_IO_ERROR_PARTIAL_INPUT).
+// (typically [GIoErrorPartialInputValue]).
 func (x *ZlibDecompressor) Convert(InbufVar []byte, InbufSizeVar uint, OutbufVar []byte, OutbufSizeVar uint, FlagsVar ConverterFlags, BytesReadVar *uint, BytesWrittenVar *uint) (ConverterResult, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvert(x.GoPointer(), InbufVar, InbufSizeVar, OutbufVar, OutbufSizeVar, FlagsVar, BytesReadVar, BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// Applies @converter to the data in @bytes.
+// Applies converter to the data in bytes.
 func (x *ZlibDecompressor) ConvertBytes(BytesVar *glib.Bytes) (*glib.Bytes, error) {
 	var cerr *glib.Error
 
 	cret := XGConverterConvertBytes(x.GoPointer(), BytesVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -203,7 +202,7 @@ func init() {
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -212,8 +211,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xZlibDecompressorGLibType, libs, "g_zlib_decompressor_get_type")
 
-	core.PuregoSafeRegister(&xNewZlibDecompressor, libs, "g_zlib_decompressor_new")
+	gobject.CheckClassSize("GZlibDecompressorClass", xZlibDecompressorGLibType, unsafe.Sizeof(ZlibDecompressorClass{}))
 
-	core.PuregoSafeRegister(&xZlibDecompressorGetFileInfo, libs, "g_zlib_decompressor_get_file_info")
+	core.PuregoRegisterSince(&xNewZlibDecompressor, libs, "g_zlib_decompressor_new", "GIO", "2.24")
+
+	core.PuregoRegisterSince(&xZlibDecompressorGetFileInfo, libs, "g_zlib_decompressor_get_file_info", "GIO", "2.26")
 
 }
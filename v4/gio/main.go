@@ -2,31 +2,35 @@
 package gio
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
 
-var xDbusErrorQuark func() glib.Quark
+var xDBusErrorQuark func() glib.Quark
 
-func DbusErrorQuark() glib.Quark {
+func DBusErrorQuark() glib.Quark {
 
-	cret := xDbusErrorQuark()
+	cret := xDBusErrorQuark()
 	return cret
 }
 
+// Deprecated: use DBusErrorQuark, this name of g_dbus_error_quark is kept for compatibility.
+func DbusErrorQuark() glib.Quark {
+	return DBusErrorQuark()
+}
+
 func init() {
 	core.SetPackageName("GIO", "gio-2.0")
 	core.SetSharedLibraries("GIO", []string{"libgio-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GIO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDbusErrorQuark, libs, "g_dbus_error_quark")
+	core.PuregoRegisterSince(&xDBusErrorQuark, libs, "g_dbus_error_quark", "GIO", "")
 
 }
@@ -0,0 +1,99 @@
+package gio
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// NewActionFunc creates a stateless action that calls activate every time it is activated.
+// parameterType is the GVariant type string of the parameter, or empty for an action without a parameter.
+// The parameter given to activate is nil in the latter case.
+func NewActionFunc(name string, parameterType string, activate func(parameter *glib.Variant)) *SimpleAction {
+	var pt *glib.VariantType
+	if parameterType != "" {
+		pt = glib.NewVariantType(parameterType)
+		defer pt.Free()
+	}
+	action := NewSimpleAction(name, pt)
+	cb := func(_ SimpleAction, parameter uintptr) {
+		activate(glib.VariantNewFromInternalPtr(parameter))
+	}
+	action.ConnectActivateFunc(cb)
+	return action
+}
+
+// DetailedActionName formats an action name together with a target value
+// such that it can be used as the detailed action of e.g. a menu item or a button.
+// target is converted with glib.NewVariantFromGo, a nil target results in the plain action name.
+func DetailedActionName(name string, target interface{}) (string, error) {
+	if target == nil {
+		return name, nil
+	}
+	v, err := glib.NewVariantFromGo(target)
+	if err != nil {
+		return "", err
+	}
+	v.RefSink()
+	defer v.Unref()
+	return ActionPrintDetailedName(name, v), nil
+}
+
+// NewMenuItemWithTarget creates a menu item that activates action with a typed target value.
+// target is converted with glib.NewVariantFromGo.
+func NewMenuItemWithTarget(label string, action string, target interface{}) (*MenuItem, error) {
+	v, err := glib.NewVariantFromGo(target)
+	if err != nil {
+		return nil, err
+	}
+	item := NewMenuItem(&label, nil)
+	item.SetActionAndTargetValue(&action, v)
+	return item, nil
+}
+
+// NewToggleAction creates a stateful boolean action that flips its state every time it is activated.
+// onChange is called with the new state every time the state changes, including changes through ChangeState.
+// It can be used with e.g. a check button or a menu item with the plain action name.
+func NewToggleAction(name string, initial bool, onChange func(bool)) *SimpleAction {
+	action := NewSimpleActionStateful(name, nil, glib.NewVariantBoolean(initial))
+	// the default activate handler of a boolean action without a parameter toggles the state
+	cb := func(a SimpleAction, value uintptr) {
+		v := glib.VariantNewFromInternalPtr(value)
+		a.SetState(v)
+		if onChange != nil {
+			onChange(v.GetBoolean())
+		}
+	}
+	action.ConnectChangeStateFunc(cb)
+	return action
+}
+
+// NewRadioAction creates a stateful string action of which the state is one of choices.
+// The initial state is the first choice.
+// Activating the action with one of the choices as target selects it, see DetailedActionName.
+// onChange is called with the new choice every time the state changes, values that are not in choices are ignored.
+func NewRadioAction(name string, choices []string, onChange func(string)) *SimpleAction {
+	initial := ""
+	if len(choices) > 0 {
+		initial = choices[0]
+	}
+	pt := glib.NewVariantType("s")
+	defer pt.Free()
+	action := NewSimpleActionStateful(name, pt, glib.NewVariantString(initial))
+	action.SetStateHint(glib.NewVariantStrv(choices, len(choices)))
+	// the default activate handler of an action with a parameter of the state type changes the state to the parameter
+	cb := func(a SimpleAction, value uintptr) {
+		v := glib.VariantNewFromInternalPtr(value)
+		choice := v.GetString(nil)
+		for _, c := range choices {
+			if c != choice {
+				continue
+			}
+			a.SetState(v)
+			if onChange != nil {
+				onChange(choice)
+			}
+			return
+		}
+	}
+	action.ConnectChangeStateFunc(cb)
+	return action
+}
@@ -0,0 +1,100 @@
+package gio
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ActionNameFromMethod returns the action name for a Go method name as used by NewActionGroupFromMethods,
+// e.g. "OpenFile" becomes "open-file" and "ExportPDF" becomes "export-pdf".
+func ActionNameFromMethod(method string) string {
+	runes := []rune(method)
+	var sb strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('-')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// NewActionGroupFromMethods creates an action group with an action for every exported method of v
+// that takes at most one argument and returns nothing or an error.
+// The action name is derived from the method name with ActionNameFromMethod
+// and the argument becomes the parameter of the action, its GVariant type follows from the Go type as with glib.VariantSignatureOf.
+// Other methods are skipped.
+//
+//	type Controller struct{ win *gtk.Window }
+//
+//	func (c *Controller) Quit()                    { c.win.Close() }
+//	func (c *Controller) OpenFile(path string) error { ... }
+//	func (c *Controller) Zoom(level int32)         { ... }
+//
+//	window.InsertActionGroup("app", gio.NewActionGroupFromMethods(controller, nil))
+//
+// This publishes "app.quit", "app.open-file" with a string target and "app.zoom" with an int32 target to menus, buttons and shortcuts,
+// see DetailedActionName to create their detailed action names.
+// onError is called with the action name when a method returns an error or the parameter cannot be converted to the argument,
+// if it is nil the errors are written to stderr.
+func NewActionGroupFromMethods(v interface{}, onError func(action string, err error)) *SimpleActionGroup {
+	if onError == nil {
+		onError = func(action string, err error) {
+			fmt.Fprintf(os.Stderr, "gio: action %s: %v\n", action, err)
+		}
+	}
+	group := NewSimpleActionGroup()
+	rv := reflect.ValueOf(v)
+	for i := 0; i < rv.NumMethod(); i++ {
+		action := methodAction(rv.Type().Method(i).Name, rv.Method(i), onError)
+		if action != nil {
+			group.AddAction(action)
+			action.Unref()
+		}
+	}
+	return group
+}
+
+// methodAction creates the action for the method or returns nil if the method cannot be an action
+func methodAction(name string, method reflect.Value, onError func(action string, err error)) *SimpleAction {
+	t := method.Type()
+	if t.NumIn() > 1 || t.NumOut() > 1 || (t.NumOut() == 1 && t.Out(0) != errorType) {
+		return nil
+	}
+	actionName := ActionNameFromMethod(name)
+	call := func(args []reflect.Value) {
+		out := method.Call(args)
+		if len(out) == 1 && !out[0].IsNil() {
+			onError(actionName, out[0].Interface().(error))
+		}
+	}
+	if t.NumIn() == 0 {
+		return NewActionFunc(actionName, "", func(*glib.Variant) {
+			call(nil)
+		})
+	}
+	argType := t.In(0)
+	sig, err := glib.VariantSignatureOf(reflect.Zero(argType).Interface())
+	if err != nil {
+		return nil
+	}
+	return NewActionFunc(actionName, sig, func(parameter *glib.Variant) {
+		arg := reflect.New(argType)
+		if err := parameter.StoreGo(arg.Interface()); err != nil {
+			onError(actionName, err)
+			return
+		}
+		call([]reflect.Value{arg.Elem()})
+	})
+}
@@ -0,0 +1,102 @@
+package gio
+
+import (
+	"errors"
+	"io"
+)
+
+// ContentType is a GIO content type, which is a MIME type such as "image/png" on Unix
+// and a file extension such as ".png" on Windows, see GuessContentType.
+type ContentType string
+
+// sniffLength is the number of bytes of data that ContentTypeForReader looks at, as GIO does for local files
+const sniffLength = 4096
+
+// GuessContentType guesses the content type from the name of a file and the first bytes of its data,
+// filename and data may both be empty to guess from the other only.
+// uncertain reports whether the data was not enough to be sure, e.g. for plain text.
+func GuessContentType(filename string, data []byte) (t ContentType, uncertain bool) {
+	var name *string
+	if filename != "" {
+		name = &filename
+	}
+	guess := ContentTypeGuess(name, data, uint(len(data)), &uncertain)
+	return ContentType(guess), uncertain
+}
+
+// ContentTypeForReader guesses the content type from the data of r, e.g. a download or an upload,
+// it reads up to the first 4096 bytes of r. Use a bufio.Reader and its Peek method to keep the data for reading.
+func ContentTypeForReader(r io.Reader) (ContentType, error) {
+	data := make([]byte, sniffLength)
+	n, err := io.ReadFull(r, data)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	t, _ := GuessContentType("", data[:n])
+	return t, nil
+}
+
+// ContentTypeForMimeType returns the content type of the MIME type, which is the MIME type itself on Unix.
+func ContentTypeForMimeType(mimeType string) ContentType {
+	return ContentType(ContentTypeFromMimeType(mimeType))
+}
+
+// MimeType returns the MIME type of the content type, or an empty string if it has none.
+func (t ContentType) MimeType() string {
+	return ContentTypeGetMimeType(string(t))
+}
+
+// Description returns the name of the content type to show to the user, e.g. "PNG image".
+func (t ContentType) Description() string {
+	return ContentTypeGetDescription(string(t))
+}
+
+// Icon returns the icon of the content type from the icon theme, e.g. to pass to gtk.NewImageFromGicon.
+// The caller owns the returned reference.
+func (t ContentType) Icon() *IconBase {
+	return ContentTypeGetIcon(string(t))
+}
+
+// SymbolicIcon returns the symbolic icon of the content type, see Icon.
+func (t ContentType) SymbolicIcon() *IconBase {
+	return ContentTypeGetSymbolicIcon(string(t))
+}
+
+// GenericIconName returns the name of the icon of the group of the content type, e.g. "image-x-generic".
+func (t ContentType) GenericIconName() string {
+	return ContentTypeGetGenericIconName(string(t))
+}
+
+// IsA reports whether t is supertype or a subtype of it, e.g. "text/x-python" is a "text/plain".
+func (t ContentType) IsA(supertype ContentType) bool {
+	return ContentTypeIsA(string(t), string(supertype))
+}
+
+// IsUnknown reports whether the content type is the unknown type, e.g. "application/octet-stream" on Unix.
+func (t ContentType) IsUnknown() bool {
+	return ContentTypeIsUnknown(string(t))
+}
+
+// CanBeExecutable reports whether files of the content type can be run, such as scripts.
+func (t ContentType) CanBeExecutable() bool {
+	return ContentTypeCanBeExecutable(string(t))
+}
+
+// DefaultApp returns the application that opens files of the content type by default, or nil if there is none.
+func (t ContentType) DefaultApp() *AppInfoBase {
+	return AppInfoGetDefaultForType(string(t), false)
+}
+
+// Apps returns the applications that can open files of the content type, the caller owns the returned references.
+func (t ContentType) Apps() []*AppInfoBase {
+	list := AppInfoGetAllForType(string(t))
+	if list == nil {
+		return nil
+	}
+	defer xVolumesListFree(list)
+	var apps []*AppInfoBase
+	for l := list; l != nil; l = l.Next {
+		apps = append(apps, &AppInfoBase{Ptr: l.Data})
+	}
+	return apps
+}
@@ -0,0 +1,65 @@
+package gio
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// NewIconFromNames returns a themed icon that looks up the first of names that the icon theme has,
+// e.g. NewIconFromNames("folder-music", "folder") for a menu item or a list row.
+func NewIconFromNames(names ...string) *ThemedIcon {
+	return NewThemedIconFromNames(names, len(names))
+}
+
+// NewIconFromPath returns an icon that loads the image file at path, e.g. a cover image in a notification.
+func NewIconFromPath(path string) *FileIcon {
+	file := FileNewForPath(path)
+	defer releaseObject(file.Ptr)
+	return NewFileIcon(file)
+}
+
+// NewIconFromBytes returns an icon of the encoded image data, such as a PNG or an SVG file, e.g. an avatar that was downloaded.
+// The data is copied.
+func NewIconFromBytes(data []byte) *BytesIcon {
+	return NewBytesIcon(data)
+}
+
+// isInstanceOf reports whether the instance at ptr is of the type gtype or a subtype of it
+func isInstanceOf(ptr uintptr, gtype types.GType) bool {
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return gobject.TypeCheckInstanceIsA((*gobject.TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), gtype)
+}
+
+// ResolveIcon returns icon as its implementation in GIO, a *ThemedIcon, *FileIcon, *BytesIcon, *EmblemedIcon or *Emblem,
+// or as an *IconBase for other implementations, such as a gdk.Texture. It returns nil for a nil icon.
+// The functions that return a GIcon return an *IconBase, which is resolved to tell the kinds of icons apart:
+//
+//	switch icon := gio.ResolveIcon(info.GetIcon()).(type) {
+//	case *gio.ThemedIcon:
+//		names := icon.GetNames()
+//	case *gio.FileIcon:
+//		path := icon.GetFile().GetPath()
+//	}
+//
+// The returned icon shares the reference of icon.
+func ResolveIcon(icon Icon) Icon {
+	if icon == nil || icon.GoPointer() == 0 {
+		return nil
+	}
+	ptr := icon.GoPointer()
+	switch {
+	case isInstanceOf(ptr, ThemedIconGLibType()):
+		return ThemedIconNewFromInternalPtr(ptr)
+	case isInstanceOf(ptr, FileIconGLibType()):
+		return FileIconNewFromInternalPtr(ptr)
+	case isInstanceOf(ptr, BytesIconGLibType()):
+		return BytesIconNewFromInternalPtr(ptr)
+	case isInstanceOf(ptr, EmblemedIconGLibType()):
+		return EmblemedIconNewFromInternalPtr(ptr)
+	case isInstanceOf(ptr, EmblemGLibType()):
+		return EmblemNewFromInternalPtr(ptr)
+	}
+	return &IconBase{Ptr: ptr}
+}
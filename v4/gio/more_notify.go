@@ -0,0 +1,103 @@
+package gio
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// notifyActionName is the application action that the buttons of notifications sent with Notify activate,
+// its target is the id of the notification and the label of the button separated by a newline
+const notifyActionName = "go-notification"
+
+// notifyDataID returns the ID of the functions of the notification with id in the user data registry
+func notifyDataID(id string) (uintptr, bool) {
+	n, ok := strings.CutPrefix(id, notifyActionName+"-")
+	if !ok {
+		return 0, false
+	}
+	dataID, err := strconv.ParseUint(n, 10, 64)
+	return uintptr(dataID), err == nil
+}
+
+// notifyActivate calls the function of the button of a notification that target names
+func notifyActivate(parameter *glib.Variant) {
+	if parameter == nil {
+		return
+	}
+	id, label, _ := strings.Cut(parameter.GetString(nil), "\n")
+	dataID, ok := notifyDataID(id)
+	if !ok {
+		return
+	}
+	// the notification is gone once it was activated
+	fns, _ := glib.TakeUserData[map[string]func()](dataID)
+	if fn := fns[label]; fn != nil {
+		fn()
+	}
+}
+
+// Notify sends a desktop notification with the title, the body and the themed icon, which can be empty.
+// Each entry of actions adds a button with the label as key that calls its function on the main loop,
+// the buttons are ordered by label. The function of the empty label is called when the notification itself is clicked.
+// It returns the id of the notification, to withdraw it with WithdrawNotify.
+//
+// The application must be registered, e.g. by running it, and have a desktop file for notifications to show.
+// The functions only live as long as the process, if the application is started by clicking a notification
+// of an earlier process, nothing is called.
+func (x *Application) Notify(title, body, icon string, actions map[string]func()) string {
+	if x.LookupAction(notifyActionName) == nil {
+		action := NewActionFunc(notifyActionName, "s", notifyActivate)
+		x.AddAction(action)
+		action.Unref()
+	}
+
+	fns := make(map[string]func(), len(actions))
+	for label, fn := range actions {
+		fns[label] = fn
+	}
+	// the ID of the functions in the user data registry makes the id of the notification unique
+	dataID := glib.RegisterUserData(fns)
+	if len(fns) == 0 {
+		glib.UnregisterUserData(dataID)
+	}
+	id := fmt.Sprintf("%s-%d", notifyActionName, dataID)
+
+	n := NewNotification(title)
+	defer n.Unref()
+	if body != "" {
+		n.SetBody(&body)
+	}
+	if icon != "" {
+		i := NewThemedIcon(icon)
+		n.SetIcon(i)
+		i.Unref()
+	}
+	labels := make([]string, 0, len(actions))
+	for label := range actions {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	action := "app." + notifyActionName
+	for _, label := range labels {
+		target := glib.NewVariantString(id + "\n" + label)
+		if label == "" {
+			n.SetDefaultActionAndTargetValue(action, target)
+			continue
+		}
+		n.AddButtonWithTargetValue(label, action, target)
+	}
+	x.SendNotification(&id, n)
+	return id
+}
+
+// WithdrawNotify withdraws a notification sent with Notify and releases its functions.
+func (x *Application) WithdrawNotify(id string) {
+	x.WithdrawNotification(id)
+	if dataID, ok := notifyDataID(id); ok {
+		glib.UnregisterUserData(dataID)
+	}
+}
@@ -0,0 +1,197 @@
+package gio
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// fileOperation holds the Go functions of a file operation in progress, it is registered as the user data passed to GIO
+// and unregistered when the operation returns or finishes
+type fileOperation struct {
+	progress func(current, total int64)
+	measure  func(reporting bool, usage DiskUsage)
+	done     func(result *AsyncResultBase)
+}
+
+func startFileOperation(op *fileOperation) uintptr {
+	return glib.RegisterUserData(op)
+}
+
+func fileOperationByID(id uintptr) *fileOperation {
+	op, _ := glib.LookupUserData[*fileOperation](id)
+	return op
+}
+
+func endFileOperation(id uintptr) *fileOperation {
+	op, _ := glib.TakeUserData[*fileOperation](id)
+	return op
+}
+
+// fileProgressTrampoline and measureProgressTrampoline are the single progress callbacks of all file operations
+// the Go function is looked up by the user data such that only one callback is allocated
+var fileProgressTrampoline FileProgressCallback = func(current, total int64, id uintptr) {
+	defer core.RecoverPanic()
+	if op := fileOperationByID(id); op != nil && op.progress != nil {
+		op.progress(current, total)
+	}
+}
+
+var measureProgressTrampoline FileMeasureProgressCallback = func(reporting bool, diskUsage, numDirs, numFiles uint64, id uintptr) {
+	if op := fileOperationByID(id); op != nil && op.measure != nil {
+		op.measure(reporting, DiskUsage{Bytes: diskUsage, Dirs: numDirs, Files: numFiles})
+	}
+}
+
+var fileReadyTrampoline AsyncReadyCallback = func(_ uintptr, result uintptr, id uintptr) {
+	defer core.RecoverPanic()
+	if op := endFileOperation(id); op != nil {
+		op.done(&AsyncResultBase{Ptr: result})
+	}
+}
+
+// CopyGo copies the file to destination like Copy and calls progress, which may be nil,
+// with the number of bytes copied and the total number of bytes while the copy runs.
+// It blocks, use CopyAsyncGo to keep the main loop running, e.g. to update a progress bar.
+func (x *FileBase) CopyGo(destination File, flags FileCopyFlags, cancellable *Cancellable, progress func(current, total int64)) error {
+	if progress == nil {
+		_, err := x.Copy(destination, flags, cancellable, nil, 0)
+		return err
+	}
+	id := startFileOperation(&fileOperation{progress: progress})
+	defer endFileOperation(id)
+	_, err := x.Copy(destination, flags, cancellable, &fileProgressTrampoline, id)
+	return err
+}
+
+// MoveGo moves the file to destination like Move and calls progress, which may be nil,
+// with the number of bytes copied and the total number of bytes if the file has to be copied.
+func (x *FileBase) MoveGo(destination File, flags FileCopyFlags, cancellable *Cancellable, progress func(current, total int64)) error {
+	if progress == nil {
+		_, err := x.Move(destination, flags, cancellable, nil, 0)
+		return err
+	}
+	id := startFileOperation(&fileOperation{progress: progress})
+	defer endFileOperation(id)
+	_, err := x.Move(destination, flags, cancellable, &fileProgressTrampoline, id)
+	return err
+}
+
+// xCopyAsync is g_file_copy_async, which the generated CopyAsync deprecates in favour of g_file_copy_async_with_closures
+var xCopyAsync func(uintptr, uintptr, FileCopyFlags, int, uintptr, uintptr, uintptr, uintptr, uintptr)
+
+var (
+	asyncTrampolinesOnce sync.Once
+	// fileProgressCb and fileReadyCb are the C callbacks of the trampolines for the calls that are not generated
+	fileProgressCb uintptr
+	fileReadyCb    uintptr
+)
+
+func asyncTrampolines() (progress uintptr, ready uintptr) {
+	asyncTrampolinesOnce.Do(func() {
+		fileProgressCb = core.NewCallback(fileProgressTrampoline)
+		fileReadyCb = core.NewCallback(fileReadyTrampoline)
+	})
+	return fileProgressCb, fileReadyCb
+}
+
+// CopyAsyncGo copies the file to destination without blocking and calls done on the main loop when the copy finished.
+// progress, which may be nil, is called on the main loop with the number of bytes copied and the total number of bytes.
+func (x *FileBase) CopyAsyncGo(destination File, flags FileCopyFlags, ioPriority int, cancellable *Cancellable, progress func(current, total int64), done func(err error)) {
+	id := startFileOperation(&fileOperation{
+		progress: progress,
+		done: func(result *AsyncResultBase) {
+			_, err := x.CopyFinish(result)
+			if done != nil {
+				done(err)
+			}
+		},
+	})
+	progressCb, readyCb := asyncTrampolines()
+	if progress == nil {
+		progressCb = 0
+	}
+	xCopyAsync(x.GoPointer(), destination.GoPointer(), flags, ioPriority, cancellable.GoPointer(), progressCb, id, readyCb, id)
+}
+
+// DiskUsage is the size of a file or directory tree, see MeasureDiskUsageGo.
+type DiskUsage struct {
+	// Bytes is the number of bytes of disk space used
+	Bytes uint64
+	// Dirs is the number of directories
+	Dirs uint64
+	// Files is the number of non-directory files
+	Files uint64
+}
+
+// MeasureDiskUsageGo recursively measures the disk usage of the file like MeasureDiskUsage.
+// progress, which may be nil, is called with the usage found so far while the measurement runs,
+// reporting is false for the first call, which only tells that the measurement started.
+func (x *FileBase) MeasureDiskUsageGo(flags FileMeasureFlags, cancellable *Cancellable, progress func(reporting bool, usage DiskUsage)) (DiskUsage, error) {
+	var usage DiskUsage
+	var progressCb *FileMeasureProgressCallback
+	var id uintptr
+	if progress != nil {
+		id = startFileOperation(&fileOperation{measure: progress})
+		defer endFileOperation(id)
+		progressCb = &measureProgressTrampoline
+	}
+	_, err := x.MeasureDiskUsage(flags, cancellable, progressCb, id, &usage.Bytes, &usage.Dirs, &usage.Files)
+	return usage, err
+}
+
+// PasswordRequest is what a mount operation asks for in its "ask-password" signal, see OnAskPassword.
+type PasswordRequest struct {
+	Message       string
+	DefaultUser   string
+	DefaultDomain string
+	Flags         AskPasswordFlags
+}
+
+// PasswordReply answers a PasswordRequest.
+type PasswordReply struct {
+	Username  string
+	Domain    string
+	Password  string
+	Anonymous bool
+	// Save is how long the password may be remembered
+	Save PasswordSave
+}
+
+// OnAskPassword connects fn to the "ask-password" signal, which is emitted when mounting a volume or location needs credentials.
+// fn calls reply with the credentials, which may happen later, e.g. when the user closes a dialog,
+// or with nil to abort the operation. It returns the handler ID.
+func (x *MountOperation) OnAskPassword(fn func(req PasswordRequest, reply func(*PasswordReply))) uint {
+	return x.ConnectAskPasswordFunc(func(op MountOperation, message, defaultUser, defaultDomain string, flags AskPasswordFlags) {
+		fn(PasswordRequest{
+			Message:       message,
+			DefaultUser:   defaultUser,
+			DefaultDomain: defaultDomain,
+			Flags:         flags,
+		}, func(r *PasswordReply) {
+			if r == nil {
+				op.Reply(GMountOperationAbortedValue)
+				return
+			}
+			op.SetUsername(&r.Username)
+			op.SetDomain(&r.Domain)
+			op.SetPassword(&r.Password)
+			op.SetAnonymous(r.Anonymous)
+			op.SetPasswordSave(r.Save)
+			op.Reply(GMountOperationHandledValue)
+		})
+	})
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xCopyAsync, libs, "g_file_copy_async")
+}
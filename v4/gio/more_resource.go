@@ -0,0 +1,278 @@
+package gio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// resourceNotFound returns whether err is the error of a resource path that does not exist
+func resourceNotFound(err error) bool {
+	var gerr *glib.Error
+	return errors.As(err, &gerr) && gerr.Domain == ResourceErrorQuark() && gerr.Code == int32(GResourceErrorNotFoundValue)
+}
+
+// xResourcesEnumerateChildrenPtr is g_resources_enumerate_children returning the array as pointer,
+// the generated function cannot convert the NULL array of a path that does not exist
+var xResourcesEnumerateChildrenPtr func(string, ResourceLookupFlags, **glib.Error) uintptr
+
+var xResourceStrfreev func(uintptr)
+
+// enumerateResources returns the names of the children of the resource directory path
+func enumerateResources(path string) ([]string, error) {
+	var cerr *glib.Error
+	arr := xResourcesEnumerateChildrenPtr(path, GResourceLookupFlagsNoneValue, &cerr)
+	if cerr != nil {
+		return nil, cerr
+	}
+	if arr == 0 {
+		return nil, core.PlatformError
+	}
+	defer xResourceStrfreev(arr)
+	return core.GoStringSlice(arr), nil
+}
+
+// resourcePathError wraps err of the operation on a resource path, such that errors.Is(err, fs.ErrNotExist) works
+func resourcePathError(op, name string, err error) error {
+	if resourceNotFound(err) {
+		err = fs.ErrNotExist
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+// ResourceBytes returns the contents of the file at path in the registered resources,
+// e.g. "/org/example/App/data/config.json".
+// The data is copied, so it can be used after the resource is unregistered.
+func ResourceBytes(path string) ([]byte, error) {
+	b, err := ResourcesLookupData(path, GResourceLookupFlagsNoneValue)
+	if err != nil {
+		return nil, resourcePathError("read", path, err)
+	}
+	return b, nil
+}
+
+// ResourceWalk calls fn for every file and directory below prefix in the registered resources, in lexical order.
+// Directories are passed before their contents and, unlike files, end with a slash.
+// If fn returns fs.SkipDir for a directory its contents are skipped, any other error stops the walk and is returned.
+func ResourceWalk(prefix string, fn func(path string, isDir bool) error) error {
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	children, err := enumerateResources(prefix)
+	if err != nil {
+		return resourcePathError("walk", prefix, err)
+	}
+	sort.Strings(children)
+	for _, c := range children {
+		p := prefix + c
+		isDir := strings.HasSuffix(c, "/")
+		if err := fn(p, isDir); err != nil {
+			if isDir && err == fs.SkipDir {
+				continue
+			}
+			return err
+		}
+		if isDir {
+			if err := ResourceWalk(p, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ResourceFS returns a file system of the registered resources below prefix,
+// so they can be used with Go packages that read an fs.FS, e.g. html/template or fs.WalkDir:
+//
+//	assets := gio.ResourceFS("/org/example/App")
+//	tmpl, err := template.ParseFS(assets, "templates/*.html")
+//
+// Resources that are registered later are visible as well.
+func ResourceFS(prefix string) fs.FS {
+	return resourceFS(strings.TrimSuffix(prefix, "/"))
+}
+
+type resourceFS string
+
+var (
+	_ fs.ReadFileFS = resourceFS("")
+	_ fs.ReadDirFS  = resourceFS("")
+	_ fs.StatFS     = resourceFS("")
+)
+
+// resourcePath returns the resource path of the name in the file system
+func (r resourceFS) resourcePath(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return string(r) + "/", nil
+	}
+	return string(r) + "/" + name, nil
+}
+
+func (r resourceFS) Stat(name string) (fs.FileInfo, error) {
+	p, err := r.resourcePath("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	var size uint
+	var flags uint32
+	if name != "." {
+		if _, err := ResourcesGetInfo(p, GResourceLookupFlagsNoneValue, &size, &flags); err == nil {
+			return &resourceInfo{name: path.Base(name), size: int64(size)}, nil
+		}
+	}
+	// directories have no info of their own
+	if _, err := enumerateResources(p); err != nil {
+		return nil, resourcePathError("stat", name, err)
+	}
+	return &resourceInfo{name: path.Base(name), dir: true}, nil
+}
+
+func (r resourceFS) ReadFile(name string) ([]byte, error) {
+	p, err := r.resourcePath("read", name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ResourceBytes(p)
+	if err != nil {
+		return nil, resourcePathError("read", name, errors.Unwrap(err))
+	}
+	return data, nil
+}
+
+func (r resourceFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	p, err := r.resourcePath("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	children, err := enumerateResources(p)
+	if err != nil {
+		return nil, resourcePathError("readdir", name, err)
+	}
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, c := range children {
+		child := strings.TrimSuffix(c, "/")
+		if name != "." {
+			child = name + "/" + child
+		}
+		info, err := r.Stat(child)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (r resourceFS) Open(name string) (fs.File, error) {
+	info, err := r.Stat(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: errors.Unwrap(err)}
+	}
+	if info.IsDir() {
+		entries, err := r.ReadDir(name)
+		if err != nil {
+			return nil, err
+		}
+		return &resourceDir{info: info, entries: entries}, nil
+	}
+	data, err := r.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceFile{info: info, Reader: bytes.NewReader(data)}, nil
+}
+
+// resourceInfo is the fs.FileInfo of a resource
+type resourceInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (i *resourceInfo) Name() string       { return i.name }
+func (i *resourceInfo) Size() int64        { return i.size }
+func (i *resourceInfo) ModTime() time.Time { return time.Time{} }
+func (i *resourceInfo) IsDir() bool        { return i.dir }
+func (i *resourceInfo) Sys() interface{}   { return nil }
+
+func (i *resourceInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// resourceFile is an opened file of ResourceFS, its contents are read at once
+type resourceFile struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (f *resourceFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *resourceFile) Close() error               { return nil }
+
+// resourceDir is an opened directory of ResourceFS
+type resourceDir struct {
+	info    fs.FileInfo
+	entries []fs.DirEntry
+}
+
+func (d *resourceDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *resourceDir) Close() error               { return nil }
+
+func (d *resourceDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.Name(), Err: errors.New("is a directory")}
+}
+
+func (d *resourceDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(d.entries) {
+		n = len(d.entries)
+	}
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GIO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xResourcesEnumerateChildrenPtr, libs, "g_resources_enumerate_children")
+
+	libs = nil
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xResourceStrfreev, libs, "g_strfreev")
+}
@@ -0,0 +1,208 @@
+package gio
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrSkipped is returned by CopyResolveGo and MoveResolveGo when the destination exists and ConflictSkip was chosen.
+var ErrSkipped = errors.New("gio: the destination exists and was skipped")
+
+// ConflictAction is how a copy or move continues when the destination exists, see ConflictFunc.
+type ConflictAction int
+
+const (
+	// ConflictSkip leaves the source and the destination as they are, the operation returns ErrSkipped
+	ConflictSkip ConflictAction = iota
+	// ConflictOverwrite replaces the destination
+	ConflictOverwrite
+	// ConflictRename copies or moves to a free name next to the destination, e.g. "report (2).pdf"
+	ConflictRename
+	// ConflictCancel stops the operation, it returns the error that the destination exists
+	ConflictCancel
+)
+
+// ConflictFunc decides what happens when the destination of a copy or move exists,
+// source and destination are the URIs of the files, e.g. to ask the user in a dialog.
+type ConflictFunc func(source, destination string) ConflictAction
+
+// CopyResolveGo copies the file to destination like CopyGo and calls resolve if destination exists.
+// It returns the URI of the copy, which differs from destination if resolve chose ConflictRename.
+// A nil resolve fails with the error that the destination exists unless flags contain GFileCopyOverwriteValue.
+func (x *FileBase) CopyResolveGo(destination File, flags FileCopyFlags, cancellable *Cancellable, progress func(current, total int64), resolve ConflictFunc) (string, error) {
+	return x.transferResolve(&FileBase{Ptr: destination.GoPointer()}, flags, cancellable, progress, resolve, x.CopyGo)
+}
+
+// MoveResolveGo moves the file to destination like MoveGo and calls resolve if destination exists, see CopyResolveGo.
+func (x *FileBase) MoveResolveGo(destination File, flags FileCopyFlags, cancellable *Cancellable, progress func(current, total int64), resolve ConflictFunc) (string, error) {
+	return x.transferResolve(&FileBase{Ptr: destination.GoPointer()}, flags, cancellable, progress, resolve, x.MoveGo)
+}
+
+// transferResolve runs transfer, which is CopyGo or MoveGo, until the conflicts are resolved
+func (x *FileBase) transferResolve(dst *FileBase, flags FileCopyFlags, cancellable *Cancellable, progress func(current, total int64), resolve ConflictFunc,
+	transfer func(File, FileCopyFlags, *Cancellable, func(current, total int64)) error) (string, error) {
+	if resolve != nil {
+		// the destination must not be replaced before resolve is asked
+		flags &^= GFileCopyOverwriteValue
+	}
+	err := transfer(dst, flags, cancellable, progress)
+	if err == nil || resolve == nil || !errors.Is(err, GIoErrorExistsValue) {
+		return dst.GetUri(), err
+	}
+	switch resolve(x.GetUri(), dst.GetUri()) {
+	case ConflictSkip:
+		return dst.GetUri(), ErrSkipped
+	case ConflictOverwrite:
+		return dst.GetUri(), transfer(dst, flags|GFileCopyOverwriteValue, cancellable, progress)
+	case ConflictRename:
+		free, err := freeName(dst, cancellable)
+		if err != nil {
+			return dst.GetUri(), err
+		}
+		defer releaseObject(free.Ptr)
+		// the free name may have been taken in the meantime, so conflicts are resolved again
+		return x.transferResolve(free, flags, cancellable, progress, resolve, transfer)
+	}
+	return dst.GetUri(), err
+}
+
+// freeName returns the first file next to f that does not exist, e.g. "report (2).pdf" for "report.pdf"
+func freeName(f *FileBase, cancellable *Cancellable) (*FileBase, error) {
+	parent := f.GetParent()
+	if parent == nil {
+		return nil, fmt.Errorf("gio: %s has no parent to rename it in", f.GetUri())
+	}
+	defer releaseObject(parent.Ptr)
+	name := f.GetBasename()
+	ext := path.Ext(name)
+	// keep double extensions of archives together, e.g. "backup (2).tar.gz"
+	if strings.HasSuffix(strings.TrimSuffix(name, ext), ".tar") {
+		ext = ".tar" + ext
+	}
+	base := strings.TrimSuffix(name, ext)
+	for i := 2; ; i++ {
+		child := parent.GetChild(fmt.Sprintf("%s (%d)%s", base, i, ext))
+		if !child.QueryExists(cancellable) {
+			return child, nil
+		}
+		releaseObject(child.Ptr)
+		if cancellable != nil && cancellable.IsCancelled() {
+			return nil, GIoErrorCancelledValue
+		}
+	}
+}
+
+// TrashItem is a file in the trash, see ListTrash.
+type TrashItem struct {
+	// URI is the location of the file in the trash, e.g. "trash:///report.pdf"
+	URI string
+	// Name is the name of the file to show to the user
+	Name string
+	// OrigPath is the path of the file before it was moved to the trash
+	OrigPath string
+	// Deleted is the time the file was moved to the trash
+	Deleted time.Time
+}
+
+// trashAttributes are the attributes of the files in the trash that make up a TrashItem
+const trashAttributes = "standard::name,standard::display-name,trash::orig-path,trash::deletion-date"
+
+// ListTrash returns the files in the trash of the user.
+// The trash is listed through the trash:/// location of gvfs, without gvfs it returns a not supported error.
+func ListTrash(cancellable *Cancellable) ([]TrashItem, error) {
+	trash := FileNewForUri("trash:///")
+	defer releaseObject(trash.Ptr)
+	enum, err := trash.EnumerateChildren(trashAttributes, GFileQueryInfoNoneValue, cancellable)
+	if err != nil {
+		return nil, err
+	}
+	defer enum.Unref()
+	defer enum.Close(nil)
+	var items []TrashItem
+	for {
+		info, err := enum.NextFile(cancellable)
+		if err != nil {
+			return items, err
+		}
+		if info == nil {
+			return items, nil
+		}
+		item := TrashItem{
+			URI:      "trash:///" + info.GetName(),
+			Name:     info.GetDisplayName(),
+			OrigPath: info.GetAttributeByteString("trash::orig-path"),
+		}
+		if date := info.GetDeletionDate(); date != nil {
+			item.Deleted = time.Unix(date.ToUnix(), 0)
+			date.Unref()
+		}
+		info.Unref()
+		items = append(items, item)
+	}
+}
+
+// trashedItem returns the item that was trashed last from the path, or nil if it cannot be found
+func trashedItem(origPath string, cancellable *Cancellable) *TrashItem {
+	items, _ := ListTrash(cancellable)
+	var found *TrashItem
+	for i := range items {
+		if items[i].OrigPath == origPath && (found == nil || !items[i].Deleted.Before(found.Deleted)) {
+			found = &items[i]
+		}
+	}
+	return found
+}
+
+// TrashGo moves the file to the trash like Trash and returns it as an item of the trash,
+// to put it back with RestoreFromTrash, e.g. to undo the deletion.
+// The item is nil if the file was trashed but cannot be found in the trash, e.g. without gvfs.
+// Files on file systems without a trash fail with GIoErrorNotSupportedValue.
+func (x *FileBase) TrashGo(cancellable *Cancellable) (*TrashItem, error) {
+	origPath := x.GetPath()
+	if _, err := x.Trash(cancellable); err != nil {
+		return nil, err
+	}
+	return trashedItem(origPath, cancellable), nil
+}
+
+// TrashAsyncGo moves the file to the trash without blocking and calls done on the main loop when it finished, see TrashGo.
+func (x *FileBase) TrashAsyncGo(ioPriority int, cancellable *Cancellable, done func(item *TrashItem, err error)) {
+	origPath := x.GetPath()
+	x.TrashAsync(ioPriority, cancellable, &fileReadyTrampoline, startFileOperation(&fileOperation{
+		done: func(result *AsyncResultBase) {
+			_, err := x.TrashFinish(result)
+			if done == nil {
+				return
+			}
+			if err != nil {
+				done(nil, err)
+				return
+			}
+			done(trashedItem(origPath, cancellable), nil)
+		},
+	}))
+}
+
+// RestoreFromTrash moves the item back to its original path, the parent directories are created if they are gone.
+// resolve is called if a file was created at the original path in the meantime, see MoveResolveGo.
+func RestoreFromTrash(item TrashItem, cancellable *Cancellable, resolve ConflictFunc) error {
+	if item.OrigPath == "" {
+		return fmt.Errorf("gio: %s has no original path to restore it to", item.URI)
+	}
+	src := FileNewForUri(item.URI)
+	defer releaseObject(src.Ptr)
+	dst := FileNewForPath(item.OrigPath)
+	defer releaseObject(dst.Ptr)
+	if parent := dst.GetParent(); parent != nil {
+		_, err := parent.MakeDirectoryWithParents(cancellable)
+		releaseObject(parent.Ptr)
+		if err != nil && !errors.Is(err, GIoErrorExistsValue) {
+			return err
+		}
+	}
+	_, err := src.MoveResolveGo(dst, GFileCopyNofollowSymlinksValue|GFileCopyAllMetadataValue, cancellable, nil, resolve)
+	return err
+}
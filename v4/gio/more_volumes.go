@@ -0,0 +1,346 @@
+package gio
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var volumeMonitor struct {
+	once    sync.Once
+	monitor *VolumeMonitor
+}
+
+// DefaultVolumeMonitor returns the volume monitor of the process, which tracks drives, volumes and mounts.
+// Its signals are emitted on the main context that was the thread default when it was first called,
+// so call it on the main thread. The monitor lives as long as the process, do not release it.
+func DefaultVolumeMonitor() *VolumeMonitor {
+	volumeMonitor.once.Do(func() {
+		volumeMonitor.monitor = VolumeMonitorGet()
+	})
+	return volumeMonitor.monitor
+}
+
+// MountInfo is a snapshot of a mounted file system, see VolumeMonitor.Mounts.
+type MountInfo struct {
+	// Name is the name of the mount to show to the user
+	Name string
+	// UUID is the UUID of the mount, it is empty if the mount has none
+	UUID string
+	// Root is the URI of the root directory of the mount, e.g. "file:///run/media/user/USB"
+	Root string
+	// Path is the local path of the root directory, it is empty for remote mounts
+	Path string
+	// Volume is the name of the volume of the mount, it is empty if the mount has no volume
+	Volume string
+	// Drive is the name of the drive of the mount, it is empty if the mount has no drive
+	Drive      string
+	CanUnmount bool
+	CanEject   bool
+}
+
+// releaseObject drops the reference that a getter returned
+func releaseObject(ptr uintptr) {
+	if ptr != 0 {
+		(&gobject.Object{Ptr: ptr}).Unref()
+	}
+}
+
+// newMountInfo reads the snapshot of the mount, which is only borrowed
+func newMountInfo(m *MountBase) MountInfo {
+	info := MountInfo{
+		Name:       m.GetName(),
+		UUID:       m.GetUuid(),
+		CanUnmount: m.CanUnmount(),
+		CanEject:   m.CanEject(),
+	}
+	if root := m.GetRoot(); root != nil {
+		info.Root = root.GetUri()
+		info.Path = root.GetPath()
+		releaseObject(root.Ptr)
+	}
+	if v := m.GetVolume(); v != nil {
+		info.Volume = v.GetName()
+		releaseObject(v.Ptr)
+	}
+	if d := m.GetDrive(); d != nil {
+		info.Drive = d.GetName()
+		releaseObject(d.Ptr)
+	}
+	return info
+}
+
+// xVolumesListFree is g_list_free, the items of the lists of the volume monitor are released one by one
+var xVolumesListFree func(*glib.List)
+
+// Mounts returns a snapshot of the mounts that are currently known, e.g. to fill the sidebar of a file manager.
+func (x *VolumeMonitor) Mounts() []MountInfo {
+	list := x.GetMounts()
+	var mounts []MountInfo
+	for l := list; l != nil; l = l.Next {
+		mounts = append(mounts, newMountInfo(&MountBase{Ptr: l.Data}))
+		releaseObject(l.Data)
+	}
+	if list != nil {
+		xVolumesListFree(list)
+	}
+	return mounts
+}
+
+// lookupMount returns the mount with the root URI, the caller releases it
+func (x *VolumeMonitor) lookupMount(root string) (*MountBase, error) {
+	list := x.GetMounts()
+	var found *MountBase
+	for l := list; l != nil; l = l.Next {
+		m := &MountBase{Ptr: l.Data}
+		if found == nil && mountRoot(m) == root {
+			found = m
+			continue
+		}
+		releaseObject(l.Data)
+	}
+	if list != nil {
+		xVolumesListFree(list)
+	}
+	if found == nil {
+		return nil, fmt.Errorf("gio: %s is not mounted", root)
+	}
+	return found, nil
+}
+
+// mountRoot returns the URI of the root directory of the mount
+func mountRoot(m *MountBase) string {
+	root := m.GetRoot()
+	if root == nil {
+		return ""
+	}
+	defer releaseObject(root.Ptr)
+	return root.GetUri()
+}
+
+// VolumeEventKind is the kind of change of a VolumeEvent.
+type VolumeEventKind int
+
+const (
+	VolumeEventMountAdded VolumeEventKind = iota
+	VolumeEventMountRemoved
+	VolumeEventMountChanged
+	// VolumeEventMountPreUnmount is sent before a mount is unmounted, close the files on it to not block the unmount
+	VolumeEventMountPreUnmount
+	VolumeEventVolumeAdded
+	VolumeEventVolumeRemoved
+	VolumeEventVolumeChanged
+	VolumeEventDriveConnected
+	VolumeEventDriveDisconnected
+	VolumeEventDriveChanged
+)
+
+func (k VolumeEventKind) String() string {
+	switch k {
+	case VolumeEventMountAdded:
+		return "mount-added"
+	case VolumeEventMountRemoved:
+		return "mount-removed"
+	case VolumeEventMountChanged:
+		return "mount-changed"
+	case VolumeEventMountPreUnmount:
+		return "mount-pre-unmount"
+	case VolumeEventVolumeAdded:
+		return "volume-added"
+	case VolumeEventVolumeRemoved:
+		return "volume-removed"
+	case VolumeEventVolumeChanged:
+		return "volume-changed"
+	case VolumeEventDriveConnected:
+		return "drive-connected"
+	case VolumeEventDriveDisconnected:
+		return "drive-disconnected"
+	case VolumeEventDriveChanged:
+		return "drive-changed"
+	}
+	return fmt.Sprintf("VolumeEventKind(%d)", int(k))
+}
+
+// VolumeEvent is a change of the drives, volumes or mounts, see VolumeMonitor.OnEvent.
+type VolumeEvent struct {
+	Kind VolumeEventKind
+	// Mount is the mount of the mount events
+	Mount MountInfo
+	// Name is the name of the volume or drive of the volume and drive events
+	Name string
+	// UUID is the UUID of the volume of the volume events, it is empty if the volume has none
+	UUID string
+}
+
+// OnEvent calls fn on the main loop for every change of the drives, volumes and mounts,
+// e.g. when a USB stick is plugged in, fn receives VolumeEventDriveConnected, VolumeEventVolumeAdded and,
+// once it is mounted, VolumeEventMountAdded. Call the returned function to disconnect fn.
+func (x *VolumeMonitor) OnEvent(fn func(VolumeEvent)) (disconnect func()) {
+	mount := func(kind VolumeEventKind) func(VolumeMonitor, uintptr) {
+		return func(_ VolumeMonitor, ptr uintptr) {
+			fn(VolumeEvent{Kind: kind, Mount: newMountInfo(&MountBase{Ptr: ptr})})
+		}
+	}
+	volume := func(kind VolumeEventKind) func(VolumeMonitor, uintptr) {
+		return func(_ VolumeMonitor, ptr uintptr) {
+			v := &VolumeBase{Ptr: ptr}
+			fn(VolumeEvent{Kind: kind, Name: v.GetName(), UUID: v.GetUuid()})
+		}
+	}
+	drive := func(kind VolumeEventKind) func(VolumeMonitor, uintptr) {
+		return func(_ VolumeMonitor, ptr uintptr) {
+			fn(VolumeEvent{Kind: kind, Name: (&DriveBase{Ptr: ptr}).GetName()})
+		}
+	}
+	handlers := []uint{
+		x.ConnectMountAddedFunc(mount(VolumeEventMountAdded)),
+		x.ConnectMountRemovedFunc(mount(VolumeEventMountRemoved)),
+		x.ConnectMountChangedFunc(mount(VolumeEventMountChanged)),
+		x.ConnectMountPreUnmountFunc(mount(VolumeEventMountPreUnmount)),
+		x.ConnectVolumeAddedFunc(volume(VolumeEventVolumeAdded)),
+		x.ConnectVolumeRemovedFunc(volume(VolumeEventVolumeRemoved)),
+		x.ConnectVolumeChangedFunc(volume(VolumeEventVolumeChanged)),
+		x.ConnectDriveConnectedFunc(drive(VolumeEventDriveConnected)),
+		x.ConnectDriveDisconnectedFunc(drive(VolumeEventDriveDisconnected)),
+		x.ConnectDriveChangedFunc(drive(VolumeEventDriveChanged)),
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			for _, h := range handlers {
+				x.DisconnectSignal(h)
+			}
+		})
+	}
+}
+
+// Events returns a channel that receives every change of the drives, volumes and mounts, see OnEvent.
+// The channel buffers size events, further events are dropped while it is full, so the main loop never blocks.
+// Call the returned function to disconnect the signal handlers and close the channel.
+func (x *VolumeMonitor) Events(size int) (<-chan VolumeEvent, func()) {
+	ch := make(chan VolumeEvent, size)
+	disconnect := x.OnEvent(func(ev VolumeEvent) {
+		select {
+		case ch <- ev:
+		default:
+		}
+	})
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			disconnect()
+			close(ch)
+		})
+	}
+}
+
+// UnmountGo unmounts the mount and calls done on the main loop when it finished.
+// op, which may be nil, asks the user e.g. which applications to close if files on the mount are open.
+func (x *MountBase) UnmountGo(flags MountUnmountFlags, op *MountOperation, cancellable *Cancellable, done func(err error)) {
+	x.UnmountWithOperation(flags, op, cancellable, &fileReadyTrampoline, startFileOperation(&fileOperation{
+		done: func(result *AsyncResultBase) {
+			_, err := x.UnmountWithOperationFinish(result)
+			if done != nil {
+				done(err)
+			}
+		},
+	}))
+}
+
+// EjectGo unmounts the mount and ejects its drive, e.g. to safely remove a USB stick,
+// and calls done on the main loop when it finished, see UnmountGo.
+func (x *MountBase) EjectGo(flags MountUnmountFlags, op *MountOperation, cancellable *Cancellable, done func(err error)) {
+	x.EjectWithOperation(flags, op, cancellable, &fileReadyTrampoline, startFileOperation(&fileOperation{
+		done: func(result *AsyncResultBase) {
+			_, err := x.EjectWithOperationFinish(result)
+			if done != nil {
+				done(err)
+			}
+		},
+	}))
+}
+
+// awaitMount runs the operation started by start in a nested loop, see glib.Await
+func awaitMount(start func(done func(err error))) error {
+	err, loopErr := glib.Await(func(resolve func(error)) {
+		start(resolve)
+	}, 0)
+	if loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// UnmountAwait unmounts the mount and waits until it finished, see UnmountGo and glib.Await.
+func (x *MountBase) UnmountAwait(flags MountUnmountFlags, op *MountOperation, cancellable *Cancellable) error {
+	return awaitMount(func(done func(err error)) {
+		x.UnmountGo(flags, op, cancellable, done)
+	})
+}
+
+// EjectAwait ejects the mount and waits until it finished, see EjectGo and glib.Await.
+func (x *MountBase) EjectAwait(flags MountUnmountFlags, op *MountOperation, cancellable *Cancellable) error {
+	return awaitMount(func(done func(err error)) {
+		x.EjectGo(flags, op, cancellable, done)
+	})
+}
+
+// withMount runs fn with the mount of the snapshot, it calls done with an error if it is not mounted anymore
+func (m MountInfo) withMount(done func(err error), fn func(mount *MountBase, done func(err error))) {
+	mount, err := DefaultVolumeMonitor().lookupMount(m.Root)
+	if err != nil {
+		if done != nil {
+			done(err)
+		}
+		return
+	}
+	fn(mount, func(err error) {
+		releaseObject(mount.Ptr)
+		if done != nil {
+			done(err)
+		}
+	})
+}
+
+// UnmountGo unmounts the mount of the snapshot, which is looked up by its root, see MountBase.UnmountGo.
+func (m MountInfo) UnmountGo(op *MountOperation, cancellable *Cancellable, done func(err error)) {
+	m.withMount(done, func(mount *MountBase, done func(err error)) {
+		mount.UnmountGo(GMountUnmountNoneValue, op, cancellable, done)
+	})
+}
+
+// EjectGo ejects the mount of the snapshot, which is looked up by its root, see MountBase.EjectGo.
+func (m MountInfo) EjectGo(op *MountOperation, cancellable *Cancellable, done func(err error)) {
+	m.withMount(done, func(mount *MountBase, done func(err error)) {
+		mount.EjectGo(GMountUnmountNoneValue, op, cancellable, done)
+	})
+}
+
+// UnmountAwait unmounts the mount of the snapshot and waits until it finished, see UnmountGo and glib.Await.
+func (m MountInfo) UnmountAwait(op *MountOperation, cancellable *Cancellable) error {
+	return awaitMount(func(done func(err error)) {
+		m.UnmountGo(op, cancellable, done)
+	})
+}
+
+// EjectAwait ejects the mount of the snapshot and waits until it finished, see EjectGo and glib.Await.
+func (m MountInfo) EjectAwait(op *MountOperation, cancellable *Cancellable) error {
+	return awaitMount(func(done func(err error)) {
+		m.EjectGo(op, cancellable, done)
+	})
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xVolumesListFree, libs, "g_list_free")
+}
@@ -0,0 +1,199 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xAllocatorFree func(uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *Allocator) Free() {
+
+	xAllocatorFree(x.GoPointer())
+
+}
+
+var xMemChunkAlloc func(uintptr) uintptr
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Alloc() uintptr {
+
+	cret := xMemChunkAlloc(x.GoPointer())
+	return cret
+}
+
+var xMemChunkAlloc0 func(uintptr) uintptr
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Alloc0() uintptr {
+
+	cret := xMemChunkAlloc0(x.GoPointer())
+	return cret
+}
+
+var xMemChunkClean func(uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Clean() {
+
+	xMemChunkClean(x.GoPointer())
+
+}
+
+var xMemChunkDestroy func(uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Destroy() {
+
+	xMemChunkDestroy(x.GoPointer())
+
+}
+
+var xMemChunkFree func(uintptr, uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Free(MemVar uintptr) {
+
+	xMemChunkFree(x.GoPointer(), MemVar)
+
+}
+
+var xMemChunkPrint func(uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Print() {
+
+	xMemChunkPrint(x.GoPointer())
+
+}
+
+var xMemChunkReset func(uintptr)
+
+// Deprecated: since 2.10. do not use it in new code.
+func (x *MemChunk) Reset() {
+
+	xMemChunkReset(x.GoPointer())
+
+}
+
+var xBlowChunks func()
+
+// Deprecated: since 2.10. do not use it in new code.
+func BlowChunks() {
+
+	xBlowChunks()
+
+}
+
+var xListPopAllocator func()
+
+// Deprecated: since 2.10. do not use it in new code.
+func ListPopAllocator() {
+
+	xListPopAllocator()
+
+}
+
+var xListPushAllocator func(*Allocator)
+
+// Deprecated: since 2.10. do not use it in new code.
+func ListPushAllocator(AllocatorVar *Allocator) {
+
+	xListPushAllocator(AllocatorVar)
+
+}
+
+var xMemChunkInfo func()
+
+// Deprecated: since 2.10. do not use it in new code.
+func MemChunkInfo() {
+
+	xMemChunkInfo()
+
+}
+
+var xNodePopAllocator func()
+
+// Deprecated: since 2.10. do not use it in new code.
+func NodePopAllocator() {
+
+	xNodePopAllocator()
+
+}
+
+var xNodePushAllocator func(*Allocator)
+
+// Deprecated: since 2.10. do not use it in new code.
+func NodePushAllocator(AllocatorVar *Allocator) {
+
+	xNodePushAllocator(AllocatorVar)
+
+}
+
+var xSListPopAllocator func()
+
+// Deprecated: since 2.10. do not use it in new code.
+func SListPopAllocator() {
+
+	xSListPopAllocator()
+
+}
+
+// Deprecated: since 2.10. do not use it in new code.
+//
+// This name of g_slist_pop_allocator is kept for compatibility, use SListPopAllocator.
+func SlistPopAllocator() {
+	SListPopAllocator()
+}
+
+var xSListPushAllocator func(*Allocator)
+
+// Deprecated: since 2.10. do not use it in new code.
+func SListPushAllocator(AllocatorVar *Allocator) {
+
+	xSListPushAllocator(AllocatorVar)
+
+}
+
+// Deprecated: since 2.10. do not use it in new code.
+//
+// This name of g_slist_push_allocator is kept for compatibility, use SListPushAllocator.
+func SlistPushAllocator(AllocatorVar *Allocator) {
+	SListPushAllocator(AllocatorVar)
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xBlowChunks, libs, "g_blow_chunks", "GLIB", "")
+	core.PuregoRegisterSince(&xListPopAllocator, libs, "g_list_pop_allocator", "GLIB", "")
+	core.PuregoRegisterSince(&xListPushAllocator, libs, "g_list_push_allocator", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkInfo, libs, "g_mem_chunk_info", "GLIB", "")
+	core.PuregoRegisterSince(&xNodePopAllocator, libs, "g_node_pop_allocator", "GLIB", "")
+	core.PuregoRegisterSince(&xNodePushAllocator, libs, "g_node_push_allocator", "GLIB", "")
+	core.PuregoRegisterSince(&xSListPopAllocator, libs, "g_slist_pop_allocator", "GLIB", "")
+	core.PuregoRegisterSince(&xSListPushAllocator, libs, "g_slist_push_allocator", "GLIB", "")
+
+	core.PuregoRegisterSince(&xAllocatorFree, libs, "g_allocator_free", "GLIB", "")
+
+	core.PuregoRegisterSince(&xMemChunkAlloc, libs, "g_mem_chunk_alloc", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkAlloc0, libs, "g_mem_chunk_alloc0", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkClean, libs, "g_mem_chunk_clean", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkDestroy, libs, "g_mem_chunk_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkFree, libs, "g_mem_chunk_free", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkPrint, libs, "g_mem_chunk_print", "GLIB", "")
+	core.PuregoRegisterSince(&xMemChunkReset, libs, "g_mem_chunk_reset", "GLIB", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -60,20 +59,20 @@ func (x *ByteArray) GoPointer() uintptr {
 //
 // A `GBytes` can come from many different origins that may have
 // different procedures for freeing the memory region.  Examples are
-// memory from [func@GLib.malloc], from memory slices, from a
-// [struct@GLib.MappedFile] or memory from other allocators.
+// memory from [Malloc], from memory slices, from a
+// [MappedFile] or memory from other allocators.
 //
-// `GBytes` work well as keys in [struct@GLib.HashTable]. Use
-// [method@GLib.Bytes.equal] and [method@GLib.Bytes.hash] as parameters to
-// [func@GLib.HashTable.new] or [func@GLib.HashTable.new_full].
-// `GBytes` can also be used as keys in a [struct@GLib.Tree] by passing the
-// [method@GLib.Bytes.compare] function to [ctor@GLib.Tree.new].
+// `GBytes` work well as keys in [HashTable]. Use
+// [Bytes.Equal] and [Bytes.Hash] as parameters to
+// HashTable.new or HashTable.new_full.
+// `GBytes` can also be used as keys in a [Tree] by passing the
+// [Bytes.Compare] function to [NewTree].
 //
 // The data pointed to by this bytes must not be modified. For a mutable
-// array of bytes see [struct@GLib.ByteArray]. Use
-// [method@GLib.Bytes.unref_to_array] to create a mutable array for a `GBytes`
+// array of bytes see [ByteArray]. Use
+// [Bytes.UnrefToArray] to create a mutable array for a `GBytes`
 // sequence. To create an immutable `GBytes` from a mutable
-// [struct@GLib.ByteArray], use the [func@GLib.ByteArray.free_to_bytes]
+// [ByteArray], use the [ByteArrayFreeToBytes]
 // function.
 type Bytes struct {
 	_ structs.HostLayout
@@ -91,11 +90,11 @@ func (x *Bytes) GoPointer() uintptr {
 
 var xNewBytes func([]byte, uint) *Bytes
 
-// Creates a new [struct@GLib.Bytes] from @data.
+// Creates a new [Bytes] from data.
 //
-// @data is copied. If @size is 0, @data may be `NULL`.
+// data is copied. If size is 0, data may be `NULL`.
 //
-// As an optimization, [ctor@GLib.Bytes.new] may avoid an extra allocation by
+// As an optimization, [NewBytes] may avoid an extra allocation by
 // copying the data within the resulting bytes structure if sufficiently small
 // (since GLib 2.84).
 func NewBytes(DataVar []byte, SizeVar uint) *Bytes {
@@ -106,9 +105,9 @@ func NewBytes(DataVar []byte, SizeVar uint) *Bytes {
 
 var xNewBytesStatic func([]byte, uint) *Bytes
 
-// Creates a new [struct@GLib.Bytes] from static data.
+// Creates a new [Bytes] from static data.
 //
-// @data must be static (ie: never modified or freed). It may be `NULL` if @size
+// data must be static (ie: never modified or freed). It may be `NULL` if size
 // is 0.
 func NewBytesStatic(DataVar []byte, SizeVar uint) *Bytes {
 
@@ -118,16 +117,16 @@ func NewBytesStatic(DataVar []byte, SizeVar uint) *Bytes {
 
 var xNewBytesTake func(uintptr, uint) *Bytes
 
-// Creates a new [struct@GLib.Bytes] from @data.
+// Creates a new [Bytes] from data.
 //
-// After this call, @data belongs to the `GBytes` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// After this call, data belongs to the `GBytes` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
 // For creating `GBytes` with memory from other allocators, see
-// [ctor@GLib.Bytes.new_with_free_func].
+// [NewBytesWithFreeFunc].
 //
-// @data may be `NULL` if @size is 0.
+// data may be `NULL` if size is 0.
 func NewBytesTake(DataVar uintptr, SizeVar uint) *Bytes {
 
 	cret := xNewBytesTake(DataVar, SizeVar)
@@ -136,15 +135,15 @@ func NewBytesTake(DataVar uintptr, SizeVar uint) *Bytes {
 
 var xNewBytesWithFreeFunc func([]byte, uint, uintptr, uintptr) *Bytes
 
-// Creates a [struct@GLib.Bytes] from @data.
+// Creates a [Bytes] from data.
 //
-// When the last reference is dropped, @free_func will be called with the
-// @user_data argument.
+// When the last reference is dropped, free_func will be called with the
+// user_data argument.
 //
-// @data must not be modified after this call is made until @free_func has
+// data must not be modified after this call is made until free_func has
 // been called to indicate that the bytes is no longer in use.
 //
-// @data may be `NULL` if @size is 0.
+// data may be `NULL` if size is 0.
 func NewBytesWithFreeFunc(DataVar []byte, SizeVar uint, FreeFuncVar *DestroyNotify, UserDataVar uintptr) *Bytes {
 
 	var FreeFuncVarRef uintptr
@@ -154,11 +153,12 @@ func NewBytesWithFreeFunc(DataVar []byte, SizeVar uint, FreeFuncVar *DestroyNoti
 			FreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FreeFuncVar
 				cbFn(arg0)
 			}
-			FreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
+			FreeFuncVarRef = core.NewCallback(fcb)
+			FreeFuncVarRef = SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
 		}
 	}
 
@@ -168,16 +168,16 @@ func NewBytesWithFreeFunc(DataVar []byte, SizeVar uint, FreeFuncVar *DestroyNoti
 
 var xBytesCompare func(uintptr, uintptr) int
 
-// Compares the two [struct@GLib.Bytes] values.
+// Compares the two [Bytes] values.
 //
 // This function can be used to sort `GBytes` instances in lexicographical
 // order.
 //
-// If @bytes1 and @bytes2 have different length but the shorter one is a
+// If bytes1 and bytes2 have different length but the shorter one is a
 // prefix of the longer one then the shorter one is considered to be less than
 // the longer one. Otherwise the first byte where both differ is used for
-// comparison. If @bytes1 has a smaller value at that position it is
-// considered less, otherwise greater than @bytes2.
+// comparison. If bytes1 has a smaller value at that position it is
+// considered less, otherwise greater than bytes2.
 func (x *Bytes) Compare(Bytes2Var uintptr) int {
 
 	cret := xBytesCompare(x.GoPointer(), Bytes2Var)
@@ -186,12 +186,12 @@ func (x *Bytes) Compare(Bytes2Var uintptr) int {
 
 var xBytesEqual func(uintptr, uintptr) bool
 
-// Compares the two [struct@GLib.Bytes] values being pointed to and returns
+// Compares the two [Bytes] values being pointed to and returns
 // `TRUE` if they are equal.
 //
-// This function can be passed to [func@GLib.HashTable.new] as the
-// @key_equal_func parameter, when using non-`NULL` `GBytes` pointers as keys in
-// a [struct@GLib.HashTable].
+// This function can be passed to HashTable.new as the
+// key_equal_func parameter, when using non-`NULL` `GBytes` pointers as keys in
+// a [HashTable].
 func (x *Bytes) Equal(Bytes2Var uintptr) bool {
 
 	cret := xBytesEqual(x.GoPointer(), Bytes2Var)
@@ -200,15 +200,15 @@ func (x *Bytes) Equal(Bytes2Var uintptr) bool {
 
 var xBytesGetData func(uintptr, *uint) uintptr
 
-// Get the byte data in the [struct@GLib.Bytes].
+// Get the byte data in the [Bytes].
 //
 // This data should not be modified.
 //
 // This function will always return the same pointer for a given `GBytes`.
 //
-// `NULL` may be returned if @size is 0. This is not guaranteed, as the `GBytes`
-// may represent an empty string with @data non-`NULL` and @size as 0. `NULL`
-// will not be returned if @size is non-zero.
+// `NULL` may be returned if size is 0. This is not guaranteed, as the `GBytes`
+// may represent an empty string with data non-`NULL` and size as 0. `NULL`
+// will not be returned if size is non-zero.
 func (x *Bytes) GetData(SizeVar *uint) uintptr {
 
 	cret := xBytesGetData(x.GoPointer(), SizeVar)
@@ -217,26 +217,26 @@ func (x *Bytes) GetData(SizeVar *uint) uintptr {
 
 var xBytesGetRegion func(uintptr, uint, uint, uint) uintptr
 
-// Gets a pointer to a region in @bytes.
+// Gets a pointer to a region in bytes.
 //
-// The region starts at @offset many bytes from the start of the data
-// and contains @n_elements many elements of @element_size size.
+// The region starts at offset many bytes from the start of the data
+// and contains n_elements many elements of element_size size.
 //
-// @n_elements may be zero, but @element_size must always be non-zero.
-// Ideally, @element_size is a static constant (eg: `sizeof` a struct).
+// n_elements may be zero, but element_size must always be non-zero.
+// Ideally, element_size is a static constant (eg: `sizeof` a struct).
 //
 // This function does careful bounds checking (including checking for
 // arithmetic overflows) and returns a non-`NULL` pointer if the
-// specified region lies entirely within the @bytes. If the region is
+// specified region lies entirely within the bytes. If the region is
 // in some way out of range, or if an overflow has occurred, then `NULL`
 // is returned.
 //
 // Note: it is possible to have a valid zero-size region. In this case,
 // the returned pointer will be equal to the base pointer of the data of
-// @bytes, plus @offset.  This will be non-`NULL` except for the case
-// where @bytes itself was a zero-sized region.  Since it is unlikely
+// bytes, plus offset.  This will be non-`NULL` except for the case
+// where bytes itself was a zero-sized region.  Since it is unlikely
 // that you will be using this function to check for a zero-sized region
-// in a zero-sized @bytes, `NULL` effectively always means ‘error’.
+// in a zero-sized bytes, `NULL` effectively always means ‘error’.
 func (x *Bytes) GetRegion(ElementSizeVar uint, OffsetVar uint, NElementsVar uint) uintptr {
 
 	cret := xBytesGetRegion(x.GoPointer(), ElementSizeVar, OffsetVar, NElementsVar)
@@ -245,7 +245,7 @@ func (x *Bytes) GetRegion(ElementSizeVar uint, OffsetVar uint, NElementsVar uint
 
 var xBytesGetSize func(uintptr) uint
 
-// Get the size of the byte data in the [struct@GLib.Bytes].
+// Get the size of the byte data in the [Bytes].
 //
 // This function will always return the same value for a given `GBytes`.
 func (x *Bytes) GetSize() uint {
@@ -256,11 +256,11 @@ func (x *Bytes) GetSize() uint {
 
 var xBytesHash func(uintptr) uint
 
-// Creates an integer hash code for the byte data in the [struct@GLib.Bytes].
+// Creates an integer hash code for the byte data in the [Bytes].
 //
-// This function can be passed to [func@GLib.HashTable.new] as the
-// @key_hash_func parameter, when using non-`NULL` `GBytes` pointers as keys in
-// a [struct@GLib.HashTable].
+// This function can be passed to HashTable.new as the
+// key_hash_func parameter, when using non-`NULL` `GBytes` pointers as keys in
+// a [HashTable].
 func (x *Bytes) Hash() uint {
 
 	cret := xBytesHash(x.GoPointer())
@@ -269,17 +269,17 @@ func (x *Bytes) Hash() uint {
 
 var xBytesNewFromBytes func(uintptr, uint, uint) *Bytes
 
-// Creates a [struct@GLib.Bytes] which is a subsection of another `GBytes`.
+// Creates a [Bytes] which is a subsection of another `GBytes`.
 //
-// The @offset + @length may not be longer than the size of @bytes.
+// The offset + length may not be longer than the size of bytes.
 //
-// A reference to @bytes will be held by the newly created `GBytes` until
+// A reference to bytes will be held by the newly created `GBytes` until
 // the byte data is no longer needed.
 //
-// Since 2.56, if @offset is 0 and @length matches the size of @bytes, then
-// @bytes will be returned with the reference count incremented by 1. If @bytes
+// Since 2.56, if offset is 0 and length matches the size of bytes, then
+// bytes will be returned with the reference count incremented by 1. If bytes
 // is a slice of another `GBytes`, then the resulting `GBytes` will reference
-// the same `GBytes` instead of @bytes. This allows consumers to simplify the
+// the same `GBytes` instead of bytes. This allows consumers to simplify the
 // usage of `GBytes` when asynchronously writing to streams.
 func (x *Bytes) NewFromBytes(OffsetVar uint, LengthVar uint) *Bytes {
 
@@ -289,7 +289,7 @@ func (x *Bytes) NewFromBytes(OffsetVar uint, LengthVar uint) *Bytes {
 
 var xBytesRef func(uintptr) *Bytes
 
-// Increase the reference count on @bytes.
+// Increase the reference count on bytes.
 func (x *Bytes) Ref() *Bytes {
 
 	cret := xBytesRef(x.GoPointer())
@@ -298,9 +298,9 @@ func (x *Bytes) Ref() *Bytes {
 
 var xBytesUnref func(uintptr)
 
-// Releases a reference on @bytes.
+// Releases a reference on bytes.
 //
-// This may result in the bytes being freed. If @bytes is `NULL`, it will
+// This may result in the bytes being freed. If bytes is `NULL`, it will
 // return immediately.
 func (x *Bytes) Unref() {
 
@@ -310,19 +310,19 @@ func (x *Bytes) Unref() {
 
 var xBytesUnrefToArray func(uintptr) uintptr
 
-// Unreferences the bytes, and returns a new mutable [struct@GLib.ByteArray]
+// Unreferences the bytes, and returns a new mutable [ByteArray]
 // containing the same byte data.
 //
 // As an optimization, the byte data is transferred to the array without copying
-// if this was the last reference to @bytes and @bytes was created with
-// [ctor@GLib.Bytes.new], [ctor@GLib.Bytes.new_take] or
-// [func@GLib.ByteArray.free_to_bytes] and the buffer was larger than the size
-// [struct@GLib.Bytes] may internalize within its allocation. In all other cases
+// if this was the last reference to bytes and bytes was created with
+// [NewBytes], [NewBytesTake] or
+// [ByteArrayFreeToBytes] and the buffer was larger than the size
+// [Bytes] may internalize within its allocation. In all other cases
 // the data is copied.
 //
-// Do not use it if @bytes contains more than %G_MAXUINT
-// bytes. [struct@GLib.ByteArray] stores the length of its data in `guint`,
-// which may be shorter than `gsize`, that @bytes is using.
+// Do not use it if bytes contains more than G_MAXUINT
+// bytes. [ByteArray] stores the length of its data in `guint`,
+// which may be shorter than `gsize`, that bytes is using.
 func (x *Bytes) UnrefToArray() uintptr {
 
 	cret := xBytesUnrefToArray(x.GoPointer())
@@ -335,10 +335,10 @@ var xBytesUnrefToData func(uintptr, *uint) uintptr
 // contents.
 //
 // As an optimization, the byte data is returned without copying if this was
-// the last reference to @bytes and @bytes was created with
-// [ctor@GLib.Bytes.new], [ctor@GLib.Bytes.new_take] or
-// [func@GLib.ByteArray.free_to_bytes] and the buffer was larger than the size
-// [struct@GLib.Bytes] may internalize within its allocation. In all other cases
+// the last reference to bytes and bytes was created with
+// [NewBytes], [NewBytesTake] or
+// [ByteArrayFreeToBytes] and the buffer was larger than the size
+// [Bytes] may internalize within its allocation. In all other cases
 // the data is copied.
 func (x *Bytes) UnrefToData(SizeVar *uint) uintptr {
 
@@ -367,22 +367,22 @@ func (x *PtrArray) GoPointer() uintptr {
 
 var xArrayNewTake func(uintptr, uint, bool, uint) uintptr
 
-// Creates a new `GArray` with @data as array data, @len as length and a
+// Creates a new `GArray` with data as array data, len as length and a
 // reference count of 1.
 //
 // This avoids having to copy the data manually, when it can just be
 // inherited.
-// After this call, @data belongs to the `GArray` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// After this call, data belongs to the `GArray` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
 // In case the elements need to be cleared when the array is freed, use
-// [func@GLib.Array.set_clear_func] to set a [callback@GLib.DestroyNotify]
+// Array.set_clear_func to set a [DestroyNotify]
 // function to perform such task.
 //
-// Do not use it if @len or @element_size are greater than
+// Do not use it if len or element_size are greater than
 //
-//	[`G_MAXUINT`](types.html#guint). `GArray` stores the length of its data in
+//	G_MAXUINT. `GArray` stores the length of its data in
 //	`guint`, which may be shorter than `gsize`.
 func ArrayNewTake(DataVar uintptr, LenVar uint, ClearVar bool, ElementSizeVar uint) uintptr {
 
@@ -393,24 +393,24 @@ func ArrayNewTake(DataVar uintptr, LenVar uint, ClearVar bool, ElementSizeVar ui
 
 var xArrayNewTakeZeroTerminated func(uintptr, bool, uint) uintptr
 
-// Creates a new `GArray` with @data as array data, computing the length of it
+// Creates a new `GArray` with data as array data, computing the length of it
 // and setting the reference count to 1.
 //
 // This avoids having to copy the data manually, when it can just be
 // inherited.
-// After this call, @data belongs to the `GArray` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// After this call, data belongs to the `GArray` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
-// The length is calculated by iterating through @data until the first `NULL`
+// The length is calculated by iterating through data until the first `NULL`
 // element is found.
 //
 // In case the elements need to be cleared when the array is freed, use
-// [func@GLib.Array.set_clear_func] to set a [callback@GLib.DestroyNotify]
+// Array.set_clear_func to set a [DestroyNotify]
 // function to perform such task.
 //
-// Do not use it if @data length or @element_size are greater than
-// [`G_MAXUINT`](types.html#guint). `GArray` stores the length of its data in
+// Do not use it if data length or element_size are greater than
+// G_MAXUINT. `GArray` stores the length of its data in
 // `guint`, which may be shorter than `gsize`.
 func ArrayNewTakeZeroTerminated(DataVar uintptr, ClearVar bool, ElementSizeVar uint) uintptr {
 
@@ -432,10 +432,10 @@ func ByteArrayAppend(ArrayVar []byte, DataVar []byte, LenVar uint) uintptr {
 
 var xByteArrayFree func([]byte, bool) uintptr
 
-// Frees the memory allocated by the `GByteArray`. If @free_segment is
+// Frees the memory allocated by the `GByteArray`. If free_segment is
 // true it frees the actual byte data. If the reference count of
-// @array is greater than one, the `GByteArray` wrapper is preserved but
-// the size of @array will be set to zero.
+// array is greater than one, the `GByteArray` wrapper is preserved but
+// the size of array will be set to zero.
 func ByteArrayFree(ArrayVar []byte, FreeSegmentVar bool) uintptr {
 
 	cret := xByteArrayFree(ArrayVar, FreeSegmentVar)
@@ -446,14 +446,14 @@ func ByteArrayFree(ArrayVar []byte, FreeSegmentVar bool) uintptr {
 var xByteArrayFreeToBytes func([]byte) *Bytes
 
 // Transfers the data from the `GByteArray` into a new immutable
-// [struct@GLib.Bytes].
+// [Bytes].
 //
-// The `GByteArray` is freed unless the reference count of @array is greater
+// The `GByteArray` is freed unless the reference count of array is greater
 // than one, in which the `GByteArray` wrapper is preserved but the size of
-// @array will be set to zero.
+// array will be set to zero.
 //
-// This is identical to using [ctor@GLib.Bytes.new_take] and
-// [func@GLib.ByteArray.free] together.
+// This is identical to using [NewBytesTake] and
+// [ByteArrayFree] together.
 func ByteArrayFreeToBytes(ArrayVar []byte) *Bytes {
 
 	cret := xByteArrayFreeToBytes(ArrayVar)
@@ -473,12 +473,12 @@ func ByteArrayNew() uintptr {
 
 var xByteArrayNewTake func([]byte, uint) uintptr
 
-// Creates a byte array containing the @data.
-// After this call, @data belongs to the `GByteArray` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// Creates a byte array containing the data.
+// After this call, data belongs to the `GByteArray` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
-// Do not use it if @len is greater than [`G_MAXUINT`](types.html#guint).
+// Do not use it if len is greater than G_MAXUINT.
 // `GByteArray` stores the length of its data in `guint`, which may be shorter
 // than `gsize`.
 func ByteArrayNewTake(DataVar []byte, LenVar uint) uintptr {
@@ -501,7 +501,7 @@ func ByteArrayPrepend(ArrayVar []byte, DataVar []byte, LenVar uint) uintptr {
 
 var xByteArrayRef func([]byte) uintptr
 
-// Atomically increments the reference count of @array by one.
+// Atomically increments the reference count of array by one.
 // This function is thread-safe and may be called from any thread.
 func ByteArrayRef(ArrayVar []byte) uintptr {
 
@@ -526,7 +526,7 @@ var xByteArrayRemoveIndexFast func([]byte, uint) uintptr
 // Removes the byte at the given index from a `GByteArray`. The last
 // element in the array is used to fill in the space, so this function
 // does not preserve the order of the `GByteArray`. But it is faster
-// than [func@GLib.ByteArray.remove_index].
+// than [ByteArrayRemoveIndex].
 func ByteArrayRemoveIndexFast(ArrayVar []byte, IndexVar uint) uintptr {
 
 	cret := xByteArrayRemoveIndexFast(ArrayVar, IndexVar)
@@ -557,7 +557,7 @@ func ByteArraySetSize(ArrayVar []byte, LengthVar uint) uintptr {
 
 var xByteArraySizedNew func(uint) uintptr
 
-// Creates a new `GByteArray` with @reserved_size bytes preallocated.
+// Creates a new `GByteArray` with reserved_size bytes preallocated.
 // This avoids frequent reallocation, if you are going to add many
 // bytes to the array. Note however that the size of the array is still
 // 0.
@@ -570,7 +570,7 @@ func ByteArraySizedNew(ReservedSizeVar uint) uintptr {
 
 var xByteArraySort func([]byte, uintptr)
 
-// Sorts a byte array, using @compare_func which should be a
+// Sorts a byte array, using compare_func which should be a
 // `qsort()`-style comparison function (returns less than zero for first
 // arg is less than second arg, zero for equal, greater than zero if
 // first arg is greater than second arg).
@@ -589,11 +589,12 @@ func ByteArraySort(ArrayVar []byte, CompareFuncVar *CompareFunc) {
 			CompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CompareFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
 		}
 	}
 
@@ -603,7 +604,7 @@ func ByteArraySort(ArrayVar []byte, CompareFuncVar *CompareFunc) {
 
 var xByteArraySortWithData func([]byte, uintptr, uintptr)
 
-// Like [func@GLib.ByteArray.sort], but the comparison function takes an extra
+// Like [ByteArraySort], but the comparison function takes an extra
 // user data argument.
 func ByteArraySortWithData(ArrayVar []byte, CompareFuncVar *CompareDataFunc, UserDataVar uintptr) {
 
@@ -614,11 +615,12 @@ func ByteArraySortWithData(ArrayVar []byte, CompareFuncVar *CompareDataFunc, Use
 			CompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
 		}
 	}
 
@@ -640,7 +642,7 @@ func ByteArraySteal(ArrayVar []byte, LenVar *uint) uintptr {
 
 var xByteArrayUnref func([]byte)
 
-// Atomically decrements the reference count of @array by one. If the
+// Atomically decrements the reference count of array by one. If the
 // reference count drops to 0, all memory allocated by the array is
 // released. This function is thread-safe and may be called from any
 // thread.
@@ -652,14 +654,14 @@ func ByteArrayUnref(ArrayVar []byte) {
 
 var xPtrArrayFind func([]uintptr, uintptr, *uint) bool
 
-// Checks whether @needle exists in @haystack. If the element is found, true
-// is returned and the element’s index is returned in @index_ (if non-`NULL`).
-// Otherwise, false is returned and @index_ is undefined. If @needle exists
-// multiple times in @haystack, the index of the first instance is returned.
+// Checks whether needle exists in haystack. If the element is found, true
+// is returned and the element’s index is returned in index_ (if non-`NULL`).
+// Otherwise, false is returned and index_ is undefined. If needle exists
+// multiple times in haystack, the index of the first instance is returned.
 //
 // This does pointer comparisons only. If you want to use more complex equality
 // checks, such as string comparisons, use
-// [func@GLib.PtrArray.find_with_equal_func].
+// [PtrArrayFindWithEqualFunc].
 func PtrArrayFind(HaystackVar []uintptr, NeedleVar uintptr, IndexVar *uint) bool {
 
 	cret := xPtrArrayFind(HaystackVar, NeedleVar, IndexVar)
@@ -669,14 +671,14 @@ func PtrArrayFind(HaystackVar []uintptr, NeedleVar uintptr, IndexVar *uint) bool
 
 var xPtrArrayFindWithEqualFunc func([]uintptr, uintptr, uintptr, *uint) bool
 
-// Checks whether @needle exists in @haystack, using the given @equal_func.
+// Checks whether needle exists in haystack, using the given equal_func.
 // If the element is found, true is returned and the element’s index is
-// returned in @index_ (if non-`NULL`). Otherwise, false is returned and @index_
-// is undefined. If @needle exists multiple times in @haystack, the index of
+// returned in index_ (if non-`NULL`). Otherwise, false is returned and index_
+// is undefined. If needle exists multiple times in haystack, the index of
 // the first instance is returned.
 //
-// @equal_func is called with the element from the array as its first parameter,
-// and @needle as its second parameter. If @equal_func is `NULL`, pointer
+// equal_func is called with the element from the array as its first parameter,
+// and needle as its second parameter. If equal_func is `NULL`, pointer
 // equality is used.
 func PtrArrayFindWithEqualFunc(HaystackVar []uintptr, NeedleVar uintptr, EqualFuncVar *EqualFunc, IndexVar *uint) bool {
 
@@ -687,11 +689,12 @@ func PtrArrayFindWithEqualFunc(HaystackVar []uintptr, NeedleVar uintptr, EqualFu
 			EqualFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *EqualFuncVar
 				return cbFn(arg0, arg1)
 			}
-			EqualFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
+			EqualFuncVarRef = core.NewCallback(fcb)
+			EqualFuncVarRef = SaveCallbackWithClosure(EqualFuncVarPtr, EqualFuncVarRef, EqualFuncVar)
 		}
 	}
 
@@ -702,21 +705,21 @@ func PtrArrayFindWithEqualFunc(HaystackVar []uintptr, NeedleVar uintptr, EqualFu
 
 var xPtrArrayNewFromArray func([]uintptr, uint, uintptr, uintptr, uintptr) uintptr
 
-// Creates a new `GPtrArray`, copying @len pointers from @data, and setting
+// Creates a new `GPtrArray`, copying len pointers from data, and setting
 // the array’s reference count to 1.
 //
 // This avoids having to manually add each element one by one.
 //
-// If @copy_func is provided, then it is used to copy each element before
+// If copy_func is provided, then it is used to copy each element before
 // adding them to the new array. If it is `NULL` then the pointers are copied
 // directly.
 //
-// It also sets @element_free_func for freeing each element when the array is
-// destroyed either via [func@GLib.PtrArray.unref], when
-// [func@GLib.PtrArray.free] is called with @free_segment set to true or when
+// It also sets element_free_func for freeing each element when the array is
+// destroyed either via PtrArray.unref, when
+// PtrArray.free is called with free_segment set to true or when
 // removing elements.
 //
-// Do not use it if @len is greater than [`G_MAXUINT`](types.html#guint).
+// Do not use it if len is greater than G_MAXUINT.
 // `GPtrArray` stores the length of its data in `guint`, which may be shorter
 // than `gsize`.
 func PtrArrayNewFromArray(DataVar []uintptr, LenVar uint, CopyFuncVar *CopyFunc, CopyFuncUserDataVar uintptr, ElementFreeFuncVar *DestroyNotify) uintptr {
@@ -728,11 +731,12 @@ func PtrArrayNewFromArray(DataVar []uintptr, LenVar uint, CopyFuncVar *CopyFunc,
 			CopyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CopyFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CopyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
+			CopyFuncVarRef = core.NewCallback(fcb)
+			CopyFuncVarRef = SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
 		}
 	}
 
@@ -743,11 +747,12 @@ func PtrArrayNewFromArray(DataVar []uintptr, LenVar uint, CopyFuncVar *CopyFunc,
 			ElementFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ElementFreeFuncVar
 				cbFn(arg0)
 			}
-			ElementFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
+			ElementFreeFuncVarRef = core.NewCallback(fcb)
+			ElementFreeFuncVarRef = SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
 		}
 	}
 
@@ -758,17 +763,17 @@ func PtrArrayNewFromArray(DataVar []uintptr, LenVar uint, CopyFuncVar *CopyFunc,
 
 var xPtrArrayNewFromNullTerminatedArray func([]uintptr, uintptr, uintptr, uintptr) uintptr
 
-// Creates a new `GPtrArray` copying the pointers from @data after having
+// Creates a new `GPtrArray` copying the pointers from data after having
 // computed the length of it and with a reference count of 1.
 // This avoids having to manually add each element one by one.
-// If @copy_func is provided, then it is used to copy the data in the new
+// If copy_func is provided, then it is used to copy the data in the new
 // array.
-// It also sets @element_free_func for freeing each element when the array is
-// destroyed either via [func@GLib.PtrArray.unref], when
-// [func@GLib.PtrArray.free] is called with @free_segment set to true or when
+// It also sets element_free_func for freeing each element when the array is
+// destroyed either via PtrArray.unref, when
+// PtrArray.free is called with free_segment set to true or when
 // removing elements.
 //
-// Do not use it if the @data has more than [`G_MAXUINT`](types.html#guint)
+// Do not use it if the data has more than G_MAXUINT
 // elements. `GPtrArray` stores the length of its data in `guint`, which may be
 // shorter than `gsize`.
 func PtrArrayNewFromNullTerminatedArray(DataVar []uintptr, CopyFuncVar *CopyFunc, CopyFuncUserDataVar uintptr, ElementFreeFuncVar *DestroyNotify) uintptr {
@@ -780,11 +785,12 @@ func PtrArrayNewFromNullTerminatedArray(DataVar []uintptr, CopyFuncVar *CopyFunc
 			CopyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CopyFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CopyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
+			CopyFuncVarRef = core.NewCallback(fcb)
+			CopyFuncVarRef = SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
 		}
 	}
 
@@ -795,11 +801,12 @@ func PtrArrayNewFromNullTerminatedArray(DataVar []uintptr, CopyFuncVar *CopyFunc
 			ElementFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ElementFreeFuncVar
 				cbFn(arg0)
 			}
-			ElementFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
+			ElementFreeFuncVarRef = core.NewCallback(fcb)
+			ElementFreeFuncVarRef = SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
 		}
 	}
 
@@ -810,20 +817,20 @@ func PtrArrayNewFromNullTerminatedArray(DataVar []uintptr, CopyFuncVar *CopyFunc
 
 var xPtrArrayNewTake func([]uintptr, uint, uintptr) uintptr
 
-// Creates a new `GPtrArray` with @data as pointers, @len as length and a
+// Creates a new `GPtrArray` with data as pointers, len as length and a
 // reference count of 1.
 //
 // This avoids having to copy such data manually.
-// After this call, @data belongs to the `GPtrArray` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// After this call, data belongs to the `GPtrArray` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
-// It also sets @element_free_func for freeing each element when the array is
-// destroyed either via [func@GLib.PtrArray.unref], when
-// [func@GLib.PtrArray.free] is called with @free_segment set to true or when
+// It also sets element_free_func for freeing each element when the array is
+// destroyed either via PtrArray.unref, when
+// PtrArray.free is called with free_segment set to true or when
 // removing elements.
 //
-// Do not use it if @len is greater than [`G_MAXUINT`](types.html#guint).
+// Do not use it if len is greater than G_MAXUINT.
 // `GPtrArray` stores the length of its data in `guint`, which may be shorter
 // than `gsize`.
 func PtrArrayNewTake(DataVar []uintptr, LenVar uint, ElementFreeFuncVar *DestroyNotify) uintptr {
@@ -835,11 +842,12 @@ func PtrArrayNewTake(DataVar []uintptr, LenVar uint, ElementFreeFuncVar *Destroy
 			ElementFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ElementFreeFuncVar
 				cbFn(arg0)
 			}
-			ElementFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
+			ElementFreeFuncVarRef = core.NewCallback(fcb)
+			ElementFreeFuncVarRef = SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
 		}
 	}
 
@@ -850,24 +858,24 @@ func PtrArrayNewTake(DataVar []uintptr, LenVar uint, ElementFreeFuncVar *Destroy
 
 var xPtrArrayNewTakeNullTerminated func([]uintptr, uintptr) uintptr
 
-// Creates a new `GPtrArray` with @data as pointers, computing the length of it
+// Creates a new `GPtrArray` with data as pointers, computing the length of it
 // and setting the reference count to 1.
 //
 // This avoids having to copy such data manually.
-// After this call, @data belongs to the `GPtrArray` and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with [func@GLib.free].
+// After this call, data belongs to the `GPtrArray` and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
-// The length is calculated by iterating through @data until the first `NULL`
+// The length is calculated by iterating through data until the first `NULL`
 // element is found.
 //
-// It also sets @element_free_func for freeing each element when the array is
-// destroyed either via [func@GLib.PtrArray.unref], when
-// [func@GLib.PtrArray.free] is called with @free_segment set to true or when
+// It also sets element_free_func for freeing each element when the array is
+// destroyed either via PtrArray.unref, when
+// PtrArray.free is called with free_segment set to true or when
 // removing elements.
 //
-// Do not use it if the @data length is greater than
-// [`G_MAXUINT`](types.html#guint). `GPtrArray` stores the length of its data
+// Do not use it if the data length is greater than
+// G_MAXUINT. `GPtrArray` stores the length of its data
 // in `guint`, which may be shorter than `gsize`.
 func PtrArrayNewTakeNullTerminated(DataVar []uintptr, ElementFreeFuncVar *DestroyNotify) uintptr {
 
@@ -878,11 +886,12 @@ func PtrArrayNewTakeNullTerminated(DataVar []uintptr, ElementFreeFuncVar *Destro
 			ElementFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ElementFreeFuncVar
 				cbFn(arg0)
 			}
-			ElementFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
+			ElementFreeFuncVarRef = core.NewCallback(fcb)
+			ElementFreeFuncVarRef = SaveCallbackWithClosure(ElementFreeFuncVarPtr, ElementFreeFuncVarRef, ElementFreeFuncVar)
 		}
 	}
 
@@ -896,37 +905,37 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xArrayNewTake, libs, "g_array_new_take")
-	core.PuregoSafeRegister(&xArrayNewTakeZeroTerminated, libs, "g_array_new_take_zero_terminated")
-	core.PuregoSafeRegister(&xByteArrayAppend, libs, "g_byte_array_append")
-	core.PuregoSafeRegister(&xByteArrayFree, libs, "g_byte_array_free")
-	core.PuregoSafeRegister(&xByteArrayFreeToBytes, libs, "g_byte_array_free_to_bytes")
-	core.PuregoSafeRegister(&xByteArrayNew, libs, "g_byte_array_new")
-	core.PuregoSafeRegister(&xByteArrayNewTake, libs, "g_byte_array_new_take")
-	core.PuregoSafeRegister(&xByteArrayPrepend, libs, "g_byte_array_prepend")
-	core.PuregoSafeRegister(&xByteArrayRef, libs, "g_byte_array_ref")
-	core.PuregoSafeRegister(&xByteArrayRemoveIndex, libs, "g_byte_array_remove_index")
-	core.PuregoSafeRegister(&xByteArrayRemoveIndexFast, libs, "g_byte_array_remove_index_fast")
-	core.PuregoSafeRegister(&xByteArrayRemoveRange, libs, "g_byte_array_remove_range")
-	core.PuregoSafeRegister(&xByteArraySetSize, libs, "g_byte_array_set_size")
-	core.PuregoSafeRegister(&xByteArraySizedNew, libs, "g_byte_array_sized_new")
-	core.PuregoSafeRegister(&xByteArraySort, libs, "g_byte_array_sort")
-	core.PuregoSafeRegister(&xByteArraySortWithData, libs, "g_byte_array_sort_with_data")
-	core.PuregoSafeRegister(&xByteArraySteal, libs, "g_byte_array_steal")
-	core.PuregoSafeRegister(&xByteArrayUnref, libs, "g_byte_array_unref")
-	core.PuregoSafeRegister(&xPtrArrayFind, libs, "g_ptr_array_find")
-	core.PuregoSafeRegister(&xPtrArrayFindWithEqualFunc, libs, "g_ptr_array_find_with_equal_func")
-	core.PuregoSafeRegister(&xPtrArrayNewFromArray, libs, "g_ptr_array_new_from_array")
-	core.PuregoSafeRegister(&xPtrArrayNewFromNullTerminatedArray, libs, "g_ptr_array_new_from_null_terminated_array")
-	core.PuregoSafeRegister(&xPtrArrayNewTake, libs, "g_ptr_array_new_take")
-	core.PuregoSafeRegister(&xPtrArrayNewTakeNullTerminated, libs, "g_ptr_array_new_take_null_terminated")
+	core.PuregoRegisterSince(&xArrayNewTake, libs, "g_array_new_take", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xArrayNewTakeZeroTerminated, libs, "g_array_new_take_zero_terminated", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xByteArrayAppend, libs, "g_byte_array_append", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayFree, libs, "g_byte_array_free", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayFreeToBytes, libs, "g_byte_array_free_to_bytes", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xByteArrayNew, libs, "g_byte_array_new", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayNewTake, libs, "g_byte_array_new_take", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xByteArrayPrepend, libs, "g_byte_array_prepend", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayRef, libs, "g_byte_array_ref", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xByteArrayRemoveIndex, libs, "g_byte_array_remove_index", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayRemoveIndexFast, libs, "g_byte_array_remove_index_fast", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArrayRemoveRange, libs, "g_byte_array_remove_range", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xByteArraySetSize, libs, "g_byte_array_set_size", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArraySizedNew, libs, "g_byte_array_sized_new", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArraySort, libs, "g_byte_array_sort", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArraySortWithData, libs, "g_byte_array_sort_with_data", "GLIB", "")
+	core.PuregoRegisterSince(&xByteArraySteal, libs, "g_byte_array_steal", "GLIB", "2.64")
+	core.PuregoRegisterSince(&xByteArrayUnref, libs, "g_byte_array_unref", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xPtrArrayFind, libs, "g_ptr_array_find", "GLIB", "2.54")
+	core.PuregoRegisterSince(&xPtrArrayFindWithEqualFunc, libs, "g_ptr_array_find_with_equal_func", "GLIB", "2.54")
+	core.PuregoRegisterSince(&xPtrArrayNewFromArray, libs, "g_ptr_array_new_from_array", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPtrArrayNewFromNullTerminatedArray, libs, "g_ptr_array_new_from_null_terminated_array", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPtrArrayNewTake, libs, "g_ptr_array_new_take", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPtrArrayNewTakeNullTerminated, libs, "g_ptr_array_new_take_null_terminated", "GLIB", "2.76")
 
 	core.PuregoSafeRegister(&xArrayGLibType, libs, "g_array_get_type")
 
@@ -934,22 +943,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xBytesGLibType, libs, "g_bytes_get_type")
 
-	core.PuregoSafeRegister(&xNewBytes, libs, "g_bytes_new")
-	core.PuregoSafeRegister(&xNewBytesStatic, libs, "g_bytes_new_static")
-	core.PuregoSafeRegister(&xNewBytesTake, libs, "g_bytes_new_take")
-	core.PuregoSafeRegister(&xNewBytesWithFreeFunc, libs, "g_bytes_new_with_free_func")
-
-	core.PuregoSafeRegister(&xBytesCompare, libs, "g_bytes_compare")
-	core.PuregoSafeRegister(&xBytesEqual, libs, "g_bytes_equal")
-	core.PuregoSafeRegister(&xBytesGetData, libs, "g_bytes_get_data")
-	core.PuregoSafeRegister(&xBytesGetRegion, libs, "g_bytes_get_region")
-	core.PuregoSafeRegister(&xBytesGetSize, libs, "g_bytes_get_size")
-	core.PuregoSafeRegister(&xBytesHash, libs, "g_bytes_hash")
-	core.PuregoSafeRegister(&xBytesNewFromBytes, libs, "g_bytes_new_from_bytes")
-	core.PuregoSafeRegister(&xBytesRef, libs, "g_bytes_ref")
-	core.PuregoSafeRegister(&xBytesUnref, libs, "g_bytes_unref")
-	core.PuregoSafeRegister(&xBytesUnrefToArray, libs, "g_bytes_unref_to_array")
-	core.PuregoSafeRegister(&xBytesUnrefToData, libs, "g_bytes_unref_to_data")
+	core.PuregoRegisterSince(&xNewBytes, libs, "g_bytes_new", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xNewBytesStatic, libs, "g_bytes_new_static", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xNewBytesTake, libs, "g_bytes_new_take", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xNewBytesWithFreeFunc, libs, "g_bytes_new_with_free_func", "GLIB", "2.32")
+
+	core.PuregoRegisterSince(&xBytesCompare, libs, "g_bytes_compare", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesEqual, libs, "g_bytes_equal", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesGetData, libs, "g_bytes_get_data", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesGetRegion, libs, "g_bytes_get_region", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xBytesGetSize, libs, "g_bytes_get_size", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesHash, libs, "g_bytes_hash", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesNewFromBytes, libs, "g_bytes_new_from_bytes", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesRef, libs, "g_bytes_ref", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesUnref, libs, "g_bytes_unref", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesUnrefToArray, libs, "g_bytes_unref_to_array", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xBytesUnrefToData, libs, "g_bytes_unref_to_data", "GLIB", "2.32")
 
 	core.PuregoSafeRegister(&xPtrArrayGLibType, libs, "g_ptr_array_get_type")
 
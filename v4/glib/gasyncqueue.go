@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -27,7 +26,7 @@ var xAsyncQueueLength func(uintptr) int
 // Actually this function returns the number of data items in
 // the queue minus the number of waiting threads, so a negative
 // value means waiting threads, and a positive value means available
-// entries in the @queue. A return value of 0 could mean n entries
+// entries in the queue. A return value of 0 could mean n entries
 // in the queue and n threads waiting. This can happen due to locking
 // of the queue or due to scheduling.
 func (x *AsyncQueue) Length() int {
@@ -43,11 +42,11 @@ var xAsyncQueueLengthUnlocked func(uintptr) int
 // Actually this function returns the number of data items in
 // the queue minus the number of waiting threads, so a negative
 // value means waiting threads, and a positive value means available
-// entries in the @queue. A return value of 0 could mean n entries
+// entries in the queue. A return value of 0 could mean n entries
 // in the queue and n threads waiting. This can happen due to locking
 // of the queue or due to scheduling.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) LengthUnlocked() int {
 
 	cret := xAsyncQueueLengthUnlocked(x.GoPointer())
@@ -56,14 +55,14 @@ func (x *AsyncQueue) LengthUnlocked() int {
 
 var xAsyncQueueLock func(uintptr)
 
-// Acquires the @queue's lock. If another thread is already
+// Acquires the queue's lock. If another thread is already
 // holding the lock, this call will block until the lock
 // becomes available.
 //
-// Call g_async_queue_unlock() to drop the lock again.
+// Call [AsyncQueue.Unlock] to drop the lock again.
 //
 // While holding the lock, you can only call the
-// g_async_queue_*_unlocked() functions on @queue. Otherwise,
+// g_async_queue_*_unlocked() functions on queue. Otherwise,
 // deadlock may occur.
 func (x *AsyncQueue) Lock() {
 
@@ -73,7 +72,7 @@ func (x *AsyncQueue) Lock() {
 
 var xAsyncQueuePop func(uintptr) uintptr
 
-// Pops data from the @queue. If @queue is empty, this function
+// Pops data from the queue. If queue is empty, this function
 // blocks until data becomes available.
 func (x *AsyncQueue) Pop() uintptr {
 
@@ -83,10 +82,10 @@ func (x *AsyncQueue) Pop() uintptr {
 
 var xAsyncQueuePopUnlocked func(uintptr) uintptr
 
-// Pops data from the @queue. If @queue is empty, this function
+// Pops data from the queue. If queue is empty, this function
 // blocks until data becomes available.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) PopUnlocked() uintptr {
 
 	cret := xAsyncQueuePopUnlocked(x.GoPointer())
@@ -95,9 +94,9 @@ func (x *AsyncQueue) PopUnlocked() uintptr {
 
 var xAsyncQueuePush func(uintptr, uintptr)
 
-// Pushes the @data into the @queue.
+// Pushes the data into the queue.
 //
-// The @data parameter must not be %NULL.
+// The data parameter must not be nil.
 func (x *AsyncQueue) Push(DataVar uintptr) {
 
 	xAsyncQueuePush(x.GoPointer(), DataVar)
@@ -106,8 +105,8 @@ func (x *AsyncQueue) Push(DataVar uintptr) {
 
 var xAsyncQueuePushFront func(uintptr, uintptr)
 
-// Pushes the @item into the @queue. @item must not be %NULL.
-// In contrast to g_async_queue_push(), this function
+// Pushes the item into the queue. item must not be nil.
+// In contrast to [AsyncQueue.Push], this function
 // pushes the new item ahead of the items already in the queue,
 // so that it will be the next one to be popped off the queue.
 func (x *AsyncQueue) PushFront(ItemVar uintptr) {
@@ -118,12 +117,12 @@ func (x *AsyncQueue) PushFront(ItemVar uintptr) {
 
 var xAsyncQueuePushFrontUnlocked func(uintptr, uintptr)
 
-// Pushes the @item into the @queue. @item must not be %NULL.
-// In contrast to g_async_queue_push_unlocked(), this function
+// Pushes the item into the queue. item must not be nil.
+// In contrast to [AsyncQueue.PushUnlocked], this function
 // pushes the new item ahead of the items already in the queue,
 // so that it will be the next one to be popped off the queue.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) PushFrontUnlocked(ItemVar uintptr) {
 
 	xAsyncQueuePushFrontUnlocked(x.GoPointer(), ItemVar)
@@ -132,16 +131,16 @@ func (x *AsyncQueue) PushFrontUnlocked(ItemVar uintptr) {
 
 var xAsyncQueuePushSorted func(uintptr, uintptr, uintptr, uintptr)
 
-// Inserts @data into @queue using @func to determine the new
+// Inserts data into queue using func to determine the new
 // position.
 //
-// This function requires that the @queue is sorted before pushing on
-// new elements, see g_async_queue_sort().
+// This function requires that the queue is sorted before pushing on
+// new elements, see [AsyncQueue.Sort].
 //
-// This function will lock @queue before it sorts the queue and unlock
+// This function will lock queue before it sorts the queue and unlock
 // it when it is finished.
 //
-// For an example of @func see g_async_queue_sort().
+// For an example of func see [AsyncQueue.Sort].
 func (x *AsyncQueue) PushSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -151,11 +150,12 @@ func (x *AsyncQueue) PushSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserD
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -165,21 +165,21 @@ func (x *AsyncQueue) PushSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserD
 
 var xAsyncQueuePushSortedUnlocked func(uintptr, uintptr, uintptr, uintptr)
 
-// Inserts @data into @queue using @func to determine the new
+// Inserts data into queue using func to determine the new
 // position.
 //
-// The sort function @func is passed two elements of the @queue.
+// The sort function func is passed two elements of the queue.
 // It should return 0 if they are equal, a negative value if the
-// first element should be higher in the @queue or a positive value
-// if the first element should be lower in the @queue than the second
+// first element should be higher in the queue or a positive value
+// if the first element should be lower in the queue than the second
 // element.
 //
-// This function requires that the @queue is sorted before pushing on
-// new elements, see g_async_queue_sort().
+// This function requires that the queue is sorted before pushing on
+// new elements, see [AsyncQueue.Sort].
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 //
-// For an example of @func see g_async_queue_sort().
+// For an example of func see [AsyncQueue.Sort].
 func (x *AsyncQueue) PushSortedUnlocked(DataVar uintptr, FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -189,11 +189,12 @@ func (x *AsyncQueue) PushSortedUnlocked(DataVar uintptr, FuncVar *CompareDataFun
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -203,11 +204,11 @@ func (x *AsyncQueue) PushSortedUnlocked(DataVar uintptr, FuncVar *CompareDataFun
 
 var xAsyncQueuePushUnlocked func(uintptr, uintptr)
 
-// Pushes the @data into the @queue.
+// Pushes the data into the queue.
 //
-// The @data parameter must not be %NULL.
+// The data parameter must not be nil.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) PushUnlocked(DataVar uintptr) {
 
 	xAsyncQueuePushUnlocked(x.GoPointer(), DataVar)
@@ -216,7 +217,7 @@ func (x *AsyncQueue) PushUnlocked(DataVar uintptr) {
 
 var xAsyncQueueRef func(uintptr) *AsyncQueue
 
-// Increases the reference count of the asynchronous @queue by 1.
+// Increases the reference count of the asynchronous queue by 1.
 // You do not need to hold the lock to call this function.
 func (x *AsyncQueue) Ref() *AsyncQueue {
 
@@ -224,15 +225,6 @@ func (x *AsyncQueue) Ref() *AsyncQueue {
 	return cret
 }
 
-var xAsyncQueueRefUnlocked func(uintptr)
-
-// Increases the reference count of the asynchronous @queue by 1.
-func (x *AsyncQueue) RefUnlocked() {
-
-	xAsyncQueueRefUnlocked(x.GoPointer())
-
-}
-
 var xAsyncQueueRemove func(uintptr, uintptr) bool
 
 // Remove an item from the queue.
@@ -246,7 +238,7 @@ var xAsyncQueueRemoveUnlocked func(uintptr, uintptr) bool
 
 // Remove an item from the queue.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) RemoveUnlocked(ItemVar uintptr) bool {
 
 	cret := xAsyncQueueRemoveUnlocked(x.GoPointer(), ItemVar)
@@ -255,20 +247,19 @@ func (x *AsyncQueue) RemoveUnlocked(ItemVar uintptr) bool {
 
 var xAsyncQueueSort func(uintptr, uintptr, uintptr)
 
-// Sorts @queue using @func.
+// Sorts queue using func.
 //
-// The sort function @func is passed two elements of the @queue.
+// The sort function func is passed two elements of the queue.
 // It should return 0 if they are equal, a negative value if the
-// first element should be higher in the @queue or a positive value
-// if the first element should be lower in the @queue than the second
+// first element should be higher in the queue or a positive value
+// if the first element should be lower in the queue than the second
 // element.
 //
-// This function will lock @queue before it sorts the queue and unlock
+// This function will lock queue before it sorts the queue and unlock
 // it when it is finished.
 //
 // If you were sorting a list of priority numbers to make sure the
 // lowest priority would be at the top of the queue, you could use:
-// |[&lt;!-- language="C" --&gt;
 //
 //	gint32 id1;
 //	gint32 id2;
@@ -276,9 +267,7 @@ var xAsyncQueueSort func(uintptr, uintptr, uintptr)
 //	id1 = GPOINTER_TO_INT (element1);
 //	id2 = GPOINTER_TO_INT (element2);
 //
-//	return (id1 &gt; id2 ? +1 : id1 == id2 ? 0 : -1);
-//
-// ]|
+//	return (id1 > id2 ? +1 : id1 == id2 ? 0 : -1);
 func (x *AsyncQueue) Sort(FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -288,11 +277,12 @@ func (x *AsyncQueue) Sort(FuncVar *CompareDataFunc, UserDataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -302,15 +292,15 @@ func (x *AsyncQueue) Sort(FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 var xAsyncQueueSortUnlocked func(uintptr, uintptr, uintptr)
 
-// Sorts @queue using @func.
+// Sorts queue using func.
 //
-// The sort function @func is passed two elements of the @queue.
+// The sort function func is passed two elements of the queue.
 // It should return 0 if they are equal, a negative value if the
-// first element should be higher in the @queue or a positive value
-// if the first element should be lower in the @queue than the second
+// first element should be higher in the queue or a positive value
+// if the first element should be lower in the queue than the second
 // element.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) SortUnlocked(FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -320,11 +310,12 @@ func (x *AsyncQueue) SortUnlocked(FuncVar *CompareDataFunc, UserDataVar uintptr)
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -332,44 +323,12 @@ func (x *AsyncQueue) SortUnlocked(FuncVar *CompareDataFunc, UserDataVar uintptr)
 
 }
 
-var xAsyncQueueTimedPop func(uintptr, *TimeVal) uintptr
-
-// Pops data from the @queue. If the queue is empty, blocks until
-// @end_time or until data becomes available.
-//
-// If no data is received before @end_time, %NULL is returned.
-//
-// To easily calculate @end_time, a combination of g_get_real_time()
-// and g_time_val_add() can be used.
-func (x *AsyncQueue) TimedPop(EndTimeVar *TimeVal) uintptr {
-
-	cret := xAsyncQueueTimedPop(x.GoPointer(), EndTimeVar)
-	return cret
-}
-
-var xAsyncQueueTimedPopUnlocked func(uintptr, *TimeVal) uintptr
-
-// Pops data from the @queue. If the queue is empty, blocks until
-// @end_time or until data becomes available.
-//
-// If no data is received before @end_time, %NULL is returned.
-//
-// To easily calculate @end_time, a combination of g_get_real_time()
-// and g_time_val_add() can be used.
-//
-// This function must be called while holding the @queue's lock.
-func (x *AsyncQueue) TimedPopUnlocked(EndTimeVar *TimeVal) uintptr {
-
-	cret := xAsyncQueueTimedPopUnlocked(x.GoPointer(), EndTimeVar)
-	return cret
-}
-
 var xAsyncQueueTimeoutPop func(uintptr, uint64) uintptr
 
-// Pops data from the @queue. If the queue is empty, blocks for
-// @timeout microseconds, or until data becomes available.
+// Pops data from the queue. If the queue is empty, blocks for
+// timeout microseconds, or until data becomes available.
 //
-// If no data is received before the timeout, %NULL is returned.
+// If no data is received before the timeout, nil is returned.
 func (x *AsyncQueue) TimeoutPop(TimeoutVar uint64) uintptr {
 
 	cret := xAsyncQueueTimeoutPop(x.GoPointer(), TimeoutVar)
@@ -378,12 +337,12 @@ func (x *AsyncQueue) TimeoutPop(TimeoutVar uint64) uintptr {
 
 var xAsyncQueueTimeoutPopUnlocked func(uintptr, uint64) uintptr
 
-// Pops data from the @queue. If the queue is empty, blocks for
-// @timeout microseconds, or until data becomes available.
+// Pops data from the queue. If the queue is empty, blocks for
+// timeout microseconds, or until data becomes available.
 //
-// If no data is received before the timeout, %NULL is returned.
+// If no data is received before the timeout, nil is returned.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) TimeoutPopUnlocked(TimeoutVar uint64) uintptr {
 
 	cret := xAsyncQueueTimeoutPopUnlocked(x.GoPointer(), TimeoutVar)
@@ -392,8 +351,8 @@ func (x *AsyncQueue) TimeoutPopUnlocked(TimeoutVar uint64) uintptr {
 
 var xAsyncQueueTryPop func(uintptr) uintptr
 
-// Tries to pop data from the @queue. If no data is available,
-// %NULL is returned.
+// Tries to pop data from the queue. If no data is available,
+// nil is returned.
 func (x *AsyncQueue) TryPop() uintptr {
 
 	cret := xAsyncQueueTryPop(x.GoPointer())
@@ -402,10 +361,10 @@ func (x *AsyncQueue) TryPop() uintptr {
 
 var xAsyncQueueTryPopUnlocked func(uintptr) uintptr
 
-// Tries to pop data from the @queue. If no data is available,
-// %NULL is returned.
+// Tries to pop data from the queue. If no data is available,
+// nil is returned.
 //
-// This function must be called while holding the @queue's lock.
+// This function must be called while holding the queue's lock.
 func (x *AsyncQueue) TryPopUnlocked() uintptr {
 
 	cret := xAsyncQueueTryPopUnlocked(x.GoPointer())
@@ -417,7 +376,7 @@ var xAsyncQueueUnlock func(uintptr)
 // Releases the queue's lock.
 //
 // Calling this function when you have not acquired
-// the with g_async_queue_lock() leads to undefined
+// the with [AsyncQueue.Lock] leads to undefined
 // behaviour.
 func (x *AsyncQueue) Unlock() {
 
@@ -427,11 +386,11 @@ func (x *AsyncQueue) Unlock() {
 
 var xAsyncQueueUnref func(uintptr)
 
-// Decreases the reference count of the asynchronous @queue by 1.
+// Decreases the reference count of the asynchronous queue by 1.
 //
-// If the reference count went to 0, the @queue will be destroyed
+// If the reference count went to 0, the queue will be destroyed
 // and the memory allocated will be freed. So you are not allowed
-// to use the @queue afterwards, as it might have disappeared.
+// to use the queue afterwards, as it might have disappeared.
 // You do not need to hold the lock to call this function.
 func (x *AsyncQueue) Unref() {
 
@@ -439,18 +398,6 @@ func (x *AsyncQueue) Unref() {
 
 }
 
-var xAsyncQueueUnrefAndUnlock func(uintptr)
-
-// Decreases the reference count of the asynchronous @queue by 1
-// and releases the lock. This function must be called while holding
-// the @queue's lock. If the reference count went to 0, the @queue
-// will be destroyed and the memory allocated will be freed.
-func (x *AsyncQueue) UnrefAndUnlock() {
-
-	xAsyncQueueUnrefAndUnlock(x.GoPointer())
-
-}
-
 var xAsyncQueueNew func() *AsyncQueue
 
 // Creates a new asynchronous queue.
@@ -475,11 +422,12 @@ func AsyncQueueNewFull(ItemFreeFuncVar *DestroyNotify) *AsyncQueue {
 			ItemFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ItemFreeFuncVar
 				cbFn(arg0)
 			}
-			ItemFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ItemFreeFuncVarPtr, ItemFreeFuncVarRef, ItemFreeFuncVar)
+			ItemFreeFuncVarRef = core.NewCallback(fcb)
+			ItemFreeFuncVarRef = SaveCallbackWithClosure(ItemFreeFuncVarPtr, ItemFreeFuncVarRef, ItemFreeFuncVar)
 		}
 	}
 
@@ -493,41 +441,37 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAsyncQueueNew, libs, "g_async_queue_new")
-	core.PuregoSafeRegister(&xAsyncQueueNewFull, libs, "g_async_queue_new_full")
-
-	core.PuregoSafeRegister(&xAsyncQueueLength, libs, "g_async_queue_length")
-	core.PuregoSafeRegister(&xAsyncQueueLengthUnlocked, libs, "g_async_queue_length_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueLock, libs, "g_async_queue_lock")
-	core.PuregoSafeRegister(&xAsyncQueuePop, libs, "g_async_queue_pop")
-	core.PuregoSafeRegister(&xAsyncQueuePopUnlocked, libs, "g_async_queue_pop_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueuePush, libs, "g_async_queue_push")
-	core.PuregoSafeRegister(&xAsyncQueuePushFront, libs, "g_async_queue_push_front")
-	core.PuregoSafeRegister(&xAsyncQueuePushFrontUnlocked, libs, "g_async_queue_push_front_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueuePushSorted, libs, "g_async_queue_push_sorted")
-	core.PuregoSafeRegister(&xAsyncQueuePushSortedUnlocked, libs, "g_async_queue_push_sorted_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueuePushUnlocked, libs, "g_async_queue_push_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueRef, libs, "g_async_queue_ref")
-	core.PuregoSafeRegister(&xAsyncQueueRefUnlocked, libs, "g_async_queue_ref_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueRemove, libs, "g_async_queue_remove")
-	core.PuregoSafeRegister(&xAsyncQueueRemoveUnlocked, libs, "g_async_queue_remove_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueSort, libs, "g_async_queue_sort")
-	core.PuregoSafeRegister(&xAsyncQueueSortUnlocked, libs, "g_async_queue_sort_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueTimedPop, libs, "g_async_queue_timed_pop")
-	core.PuregoSafeRegister(&xAsyncQueueTimedPopUnlocked, libs, "g_async_queue_timed_pop_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueTimeoutPop, libs, "g_async_queue_timeout_pop")
-	core.PuregoSafeRegister(&xAsyncQueueTimeoutPopUnlocked, libs, "g_async_queue_timeout_pop_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueTryPop, libs, "g_async_queue_try_pop")
-	core.PuregoSafeRegister(&xAsyncQueueTryPopUnlocked, libs, "g_async_queue_try_pop_unlocked")
-	core.PuregoSafeRegister(&xAsyncQueueUnlock, libs, "g_async_queue_unlock")
-	core.PuregoSafeRegister(&xAsyncQueueUnref, libs, "g_async_queue_unref")
-	core.PuregoSafeRegister(&xAsyncQueueUnrefAndUnlock, libs, "g_async_queue_unref_and_unlock")
+	core.PuregoRegisterSince(&xAsyncQueueNew, libs, "g_async_queue_new", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueNewFull, libs, "g_async_queue_new_full", "GLIB", "2.16")
+
+	core.PuregoRegisterSince(&xAsyncQueueLength, libs, "g_async_queue_length", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueLengthUnlocked, libs, "g_async_queue_length_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueLock, libs, "g_async_queue_lock", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueuePop, libs, "g_async_queue_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueuePopUnlocked, libs, "g_async_queue_pop_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueuePush, libs, "g_async_queue_push", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueuePushFront, libs, "g_async_queue_push_front", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xAsyncQueuePushFrontUnlocked, libs, "g_async_queue_push_front_unlocked", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xAsyncQueuePushSorted, libs, "g_async_queue_push_sorted", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xAsyncQueuePushSortedUnlocked, libs, "g_async_queue_push_sorted_unlocked", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xAsyncQueuePushUnlocked, libs, "g_async_queue_push_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueRef, libs, "g_async_queue_ref", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueRemove, libs, "g_async_queue_remove", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xAsyncQueueRemoveUnlocked, libs, "g_async_queue_remove_unlocked", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xAsyncQueueSort, libs, "g_async_queue_sort", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xAsyncQueueSortUnlocked, libs, "g_async_queue_sort_unlocked", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xAsyncQueueTimeoutPop, libs, "g_async_queue_timeout_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueTimeoutPopUnlocked, libs, "g_async_queue_timeout_pop_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueTryPop, libs, "g_async_queue_try_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueTryPopUnlocked, libs, "g_async_queue_try_pop_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueUnlock, libs, "g_async_queue_unlock", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueUnref, libs, "g_async_queue_unref", "GLIB", "")
 
 }
@@ -0,0 +1,92 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xAsyncQueueRefUnlocked func(uintptr)
+
+// Increases the reference count of the asynchronous queue by 1.
+//
+// Deprecated: since 2.8. Reference counting is done atomically.
+// so [AsyncQueue.Ref] can be used regardless of the queue's
+// lock.
+func (x *AsyncQueue) RefUnlocked() {
+
+	xAsyncQueueRefUnlocked(x.GoPointer())
+
+}
+
+var xAsyncQueueTimedPop func(uintptr, *TimeVal) uintptr
+
+// Pops data from the queue. If the queue is empty, blocks until
+// end_time or until data becomes available.
+//
+// If no data is received before end_time, nil is returned.
+//
+// To easily calculate end_time, a combination of [GetRealTime]
+// and [TimeVal.Add] can be used.
+//
+// Deprecated: use [AsyncQueue.TimeoutPop].
+func (x *AsyncQueue) TimedPop(EndTimeVar *TimeVal) uintptr {
+
+	cret := xAsyncQueueTimedPop(x.GoPointer(), EndTimeVar)
+	return cret
+}
+
+var xAsyncQueueTimedPopUnlocked func(uintptr, *TimeVal) uintptr
+
+// Pops data from the queue. If the queue is empty, blocks until
+// end_time or until data becomes available.
+//
+// If no data is received before end_time, nil is returned.
+//
+// To easily calculate end_time, a combination of [GetRealTime]
+// and [TimeVal.Add] can be used.
+//
+// This function must be called while holding the queue's lock.
+//
+// Deprecated: use [AsyncQueue.TimeoutPopUnlocked].
+func (x *AsyncQueue) TimedPopUnlocked(EndTimeVar *TimeVal) uintptr {
+
+	cret := xAsyncQueueTimedPopUnlocked(x.GoPointer(), EndTimeVar)
+	return cret
+}
+
+var xAsyncQueueUnrefAndUnlock func(uintptr)
+
+// Decreases the reference count of the asynchronous queue by 1
+// and releases the lock. This function must be called while holding
+// the queue's lock. If the reference count went to 0, the queue
+// will be destroyed and the memory allocated will be freed.
+//
+// Deprecated: since 2.8. Reference counting is done atomically.
+// so [AsyncQueue.Unref] can be used regardless of the queue's
+// lock.
+func (x *AsyncQueue) UnrefAndUnlock() {
+
+	xAsyncQueueUnrefAndUnlock(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xAsyncQueueRefUnlocked, libs, "g_async_queue_ref_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueTimedPop, libs, "g_async_queue_timed_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueTimedPopUnlocked, libs, "g_async_queue_timed_pop_unlocked", "GLIB", "")
+	core.PuregoRegisterSince(&xAsyncQueueUnrefAndUnlock, libs, "g_async_queue_unref_and_unlock", "GLIB", "")
+
+}
@@ -2,13 +2,12 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xAtomicIntAdd func(uintptr, int) int
 
-// Atomically adds @val to the value of @atomic.
+// Atomically adds val to the value of atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic += val; return tmp; }`.
@@ -16,9 +15,9 @@ var xAtomicIntAdd func(uintptr, int) int
 // This call acts as a full compiler and hardware memory barrier.
 //
 // Before version 2.30, this function did not return a value
-// (but g_atomic_int_exchange_and_add() did, and had the same meaning).
+// (but [AtomicIntExchangeAndAdd] did, and had the same meaning).
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntAdd(AtomicVar uintptr, ValVar int) int {
 
@@ -29,15 +28,15 @@ func AtomicIntAdd(AtomicVar uintptr, ValVar int) int {
 
 var xAtomicIntAnd func(uintptr, uint) uint
 
-// Performs an atomic bitwise 'and' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'and' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
 // Think of this operation as an atomic version of
-// `{ tmp = *atomic; *atomic &amp;= val; return tmp; }`.
+// `{ tmp = *atomic; *atomic &= val; return tmp; }`.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntAnd(AtomicVar uintptr, ValVar uint) uint {
 
@@ -48,8 +47,8 @@ func AtomicIntAnd(AtomicVar uintptr, ValVar uint) uint {
 
 var xAtomicIntCompareAndExchange func(uintptr, int, int) bool
 
-// Compares @atomic to @oldval and, if equal, sets it to @newval.
-// If @atomic was not equal to @oldval then no change occurs.
+// Compares atomic to oldval and, if equal, sets it to newval.
+// If atomic was not equal to oldval then no change occurs.
 //
 // This compare and exchange is done atomically.
 //
@@ -58,7 +57,7 @@ var xAtomicIntCompareAndExchange func(uintptr, int, int) bool
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntCompareAndExchange(AtomicVar uintptr, OldvalVar int, NewvalVar int) bool {
 
@@ -69,9 +68,9 @@ func AtomicIntCompareAndExchange(AtomicVar uintptr, OldvalVar int, NewvalVar int
 
 var xAtomicIntCompareAndExchangeFull func(uintptr, int, int, *int) bool
 
-// Compares @atomic to @oldval and, if equal, sets it to @newval.
-// If @atomic was not equal to @oldval then no change occurs.
-// In any case the value of @atomic before this operation is stored in @preval.
+// Compares atomic to oldval and, if equal, sets it to newval.
+// If atomic was not equal to oldval then no change occurs.
+// In any case the value of atomic before this operation is stored in preval.
 //
 // This compare and exchange is done atomically.
 //
@@ -80,7 +79,7 @@ var xAtomicIntCompareAndExchangeFull func(uintptr, int, int, *int) bool
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// See also g_atomic_int_compare_and_exchange()
+// See also [AtomicIntCompareAndExchange]
 func AtomicIntCompareAndExchangeFull(AtomicVar uintptr, OldvalVar int, NewvalVar int, PrevalVar *int) bool {
 
 	cret := xAtomicIntCompareAndExchangeFull(AtomicVar, OldvalVar, NewvalVar, PrevalVar)
@@ -90,14 +89,14 @@ func AtomicIntCompareAndExchangeFull(AtomicVar uintptr, OldvalVar int, NewvalVar
 
 var xAtomicIntDecAndTest func(uintptr) bool
 
-// Decrements the value of @atomic by 1.
+// Decrements the value of atomic by 1.
 //
 // Think of this operation as an atomic version of
 // `{ *atomic -= 1; return (*atomic == 0); }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntDecAndTest(AtomicVar uintptr) bool {
 
@@ -108,7 +107,7 @@ func AtomicIntDecAndTest(AtomicVar uintptr) bool {
 
 var xAtomicIntExchange func(uintptr, int) int
 
-// Sets the @atomic to @newval and returns the old value from @atomic.
+// Sets the atomic to newval and returns the old value from atomic.
 //
 // This exchange is done atomically.
 //
@@ -123,26 +122,14 @@ func AtomicIntExchange(AtomicVar uintptr, NewvalVar int) int {
 	return cret
 }
 
-var xAtomicIntExchangeAndAdd func(uintptr, int) int
-
-// This function existed before g_atomic_int_add() returned the prior
-// value of the integer (which it now does).  It is retained only for
-// compatibility reasons.  Don't use this function in new code.
-func AtomicIntExchangeAndAdd(AtomicVar uintptr, ValVar int) int {
-
-	cret := xAtomicIntExchangeAndAdd(AtomicVar, ValVar)
-
-	return cret
-}
-
 var xAtomicIntGet func(uintptr) int
 
-// Gets the current value of @atomic.
+// Gets the current value of atomic.
 //
 // This call acts as a full compiler and hardware
 // memory barrier (before the get).
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntGet(AtomicVar uintptr) int {
 
@@ -153,13 +140,13 @@ func AtomicIntGet(AtomicVar uintptr) int {
 
 var xAtomicIntInc func(uintptr)
 
-// Increments the value of @atomic by 1.
+// Increments the value of atomic by 1.
 //
 // Think of this operation as an atomic version of `{ *atomic += 1; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntInc(AtomicVar uintptr) {
 
@@ -169,15 +156,15 @@ func AtomicIntInc(AtomicVar uintptr) {
 
 var xAtomicIntOr func(uintptr, uint) uint
 
-// Performs an atomic bitwise 'or' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'or' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic |= val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntOr(AtomicVar uintptr, ValVar uint) uint {
 
@@ -188,12 +175,12 @@ func AtomicIntOr(AtomicVar uintptr, ValVar uint) uint {
 
 var xAtomicIntSet func(uintptr, int)
 
-// Sets the value of @atomic to @newval.
+// Sets the value of atomic to newval.
 //
 // This call acts as a full compiler and hardware
 // memory barrier (after the set).
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntSet(AtomicVar uintptr, NewvalVar int) {
 
@@ -203,15 +190,15 @@ func AtomicIntSet(AtomicVar uintptr, NewvalVar int) {
 
 var xAtomicIntXor func(uintptr, uint) uint
 
-// Performs an atomic bitwise 'xor' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'xor' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic ^= val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicIntXor(AtomicVar uintptr, ValVar uint) uint {
 
@@ -222,14 +209,14 @@ func AtomicIntXor(AtomicVar uintptr, ValVar uint) uint {
 
 var xAtomicPointerAdd func(uintptr, int) int
 
-// Atomically adds @val to the value of @atomic.
+// Atomically adds val to the value of atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic += val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 //
 // In GLib 2.80, the return type was changed from #gssize to #gintptr to add
@@ -244,15 +231,15 @@ func AtomicPointerAdd(AtomicVar uintptr, ValVar int) int {
 
 var xAtomicPointerAnd func(uintptr, uint) uintptr
 
-// Performs an atomic bitwise 'and' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'and' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // Think of this operation as an atomic version of
-// `{ tmp = *atomic; *atomic &amp;= val; return tmp; }`.
+// `{ tmp = *atomic; *atomic &= val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 //
 // In GLib 2.80, the return type was changed from #gsize to #guintptr to add
@@ -267,8 +254,8 @@ func AtomicPointerAnd(AtomicVar uintptr, ValVar uint) uintptr {
 
 var xAtomicPointerCompareAndExchange func(uintptr, uintptr, uintptr) bool
 
-// Compares @atomic to @oldval and, if equal, sets it to @newval.
-// If @atomic was not equal to @oldval then no change occurs.
+// Compares atomic to oldval and, if equal, sets it to newval.
+// If atomic was not equal to oldval then no change occurs.
 //
 // This compare and exchange is done atomically.
 //
@@ -277,7 +264,7 @@ var xAtomicPointerCompareAndExchange func(uintptr, uintptr, uintptr) bool
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicPointerCompareAndExchange(AtomicVar uintptr, OldvalVar uintptr, NewvalVar uintptr) bool {
 
@@ -288,9 +275,9 @@ func AtomicPointerCompareAndExchange(AtomicVar uintptr, OldvalVar uintptr, Newva
 
 var xAtomicPointerCompareAndExchangeFull func(uintptr, uintptr, uintptr, *uintptr) bool
 
-// Compares @atomic to @oldval and, if equal, sets it to @newval.
-// If @atomic was not equal to @oldval then no change occurs.
-// In any case the value of @atomic before this operation is stored in @preval.
+// Compares atomic to oldval and, if equal, sets it to newval.
+// If atomic was not equal to oldval then no change occurs.
+// In any case the value of atomic before this operation is stored in preval.
 //
 // This compare and exchange is done atomically.
 //
@@ -299,7 +286,7 @@ var xAtomicPointerCompareAndExchangeFull func(uintptr, uintptr, uintptr, *uintpt
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// See also g_atomic_pointer_compare_and_exchange()
+// See also [AtomicPointerCompareAndExchange]
 func AtomicPointerCompareAndExchangeFull(AtomicVar uintptr, OldvalVar uintptr, NewvalVar uintptr, PrevalVar *uintptr) bool {
 
 	cret := xAtomicPointerCompareAndExchangeFull(AtomicVar, OldvalVar, NewvalVar, PrevalVar)
@@ -309,7 +296,7 @@ func AtomicPointerCompareAndExchangeFull(AtomicVar uintptr, OldvalVar uintptr, N
 
 var xAtomicPointerExchange func(uintptr, uintptr) uintptr
 
-// Sets the @atomic to @newval and returns the old value from @atomic.
+// Sets the atomic to newval and returns the old value from atomic.
 //
 // This exchange is done atomically.
 //
@@ -326,12 +313,12 @@ func AtomicPointerExchange(AtomicVar uintptr, NewvalVar uintptr) uintptr {
 
 var xAtomicPointerGet func(uintptr) uintptr
 
-// Gets the current value of @atomic.
+// Gets the current value of atomic.
 //
 // This call acts as a full compiler and hardware
 // memory barrier (before the get).
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicPointerGet(AtomicVar uintptr) uintptr {
 
@@ -342,15 +329,15 @@ func AtomicPointerGet(AtomicVar uintptr) uintptr {
 
 var xAtomicPointerOr func(uintptr, uint) uintptr
 
-// Performs an atomic bitwise 'or' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'or' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic |= val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 //
 // In GLib 2.80, the return type was changed from #gsize to #guintptr to add
@@ -365,12 +352,12 @@ func AtomicPointerOr(AtomicVar uintptr, ValVar uint) uintptr {
 
 var xAtomicPointerSet func(uintptr, uintptr)
 
-// Sets the value of @atomic to @newval.
+// Sets the value of atomic to newval.
 //
 // This call acts as a full compiler and hardware
 // memory barrier (after the set).
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 func AtomicPointerSet(AtomicVar uintptr, NewvalVar uintptr) {
 
@@ -380,15 +367,15 @@ func AtomicPointerSet(AtomicVar uintptr, NewvalVar uintptr) {
 
 var xAtomicPointerXor func(uintptr, uint) uintptr
 
-// Performs an atomic bitwise 'xor' of the value of @atomic and @val,
-// storing the result back in @atomic.
+// Performs an atomic bitwise 'xor' of the value of atomic and val,
+// storing the result back in atomic.
 //
 // Think of this operation as an atomic version of
 // `{ tmp = *atomic; *atomic ^= val; return tmp; }`.
 //
 // This call acts as a full compiler and hardware memory barrier.
 //
-// While @atomic has a `volatile` qualifier, this is a historical artifact and
+// While atomic has a `volatile` qualifier, this is a historical artifact and
 // the pointer passed to it should not be `volatile`.
 //
 // In GLib 2.80, the return type was changed from #gsize to #guintptr to add
@@ -406,33 +393,32 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAtomicIntAdd, libs, "g_atomic_int_add")
-	core.PuregoSafeRegister(&xAtomicIntAnd, libs, "g_atomic_int_and")
-	core.PuregoSafeRegister(&xAtomicIntCompareAndExchange, libs, "g_atomic_int_compare_and_exchange")
-	core.PuregoSafeRegister(&xAtomicIntCompareAndExchangeFull, libs, "g_atomic_int_compare_and_exchange_full")
-	core.PuregoSafeRegister(&xAtomicIntDecAndTest, libs, "g_atomic_int_dec_and_test")
-	core.PuregoSafeRegister(&xAtomicIntExchange, libs, "g_atomic_int_exchange")
-	core.PuregoSafeRegister(&xAtomicIntExchangeAndAdd, libs, "g_atomic_int_exchange_and_add")
-	core.PuregoSafeRegister(&xAtomicIntGet, libs, "g_atomic_int_get")
-	core.PuregoSafeRegister(&xAtomicIntInc, libs, "g_atomic_int_inc")
-	core.PuregoSafeRegister(&xAtomicIntOr, libs, "g_atomic_int_or")
-	core.PuregoSafeRegister(&xAtomicIntSet, libs, "g_atomic_int_set")
-	core.PuregoSafeRegister(&xAtomicIntXor, libs, "g_atomic_int_xor")
-	core.PuregoSafeRegister(&xAtomicPointerAdd, libs, "g_atomic_pointer_add")
-	core.PuregoSafeRegister(&xAtomicPointerAnd, libs, "g_atomic_pointer_and")
-	core.PuregoSafeRegister(&xAtomicPointerCompareAndExchange, libs, "g_atomic_pointer_compare_and_exchange")
-	core.PuregoSafeRegister(&xAtomicPointerCompareAndExchangeFull, libs, "g_atomic_pointer_compare_and_exchange_full")
-	core.PuregoSafeRegister(&xAtomicPointerExchange, libs, "g_atomic_pointer_exchange")
-	core.PuregoSafeRegister(&xAtomicPointerGet, libs, "g_atomic_pointer_get")
-	core.PuregoSafeRegister(&xAtomicPointerOr, libs, "g_atomic_pointer_or")
-	core.PuregoSafeRegister(&xAtomicPointerSet, libs, "g_atomic_pointer_set")
-	core.PuregoSafeRegister(&xAtomicPointerXor, libs, "g_atomic_pointer_xor")
+	core.PuregoRegisterSince(&xAtomicIntAdd, libs, "g_atomic_int_add", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntAnd, libs, "g_atomic_int_and", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicIntCompareAndExchange, libs, "g_atomic_int_compare_and_exchange", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntCompareAndExchangeFull, libs, "g_atomic_int_compare_and_exchange_full", "GLIB", "2.74")
+	core.PuregoRegisterSince(&xAtomicIntDecAndTest, libs, "g_atomic_int_dec_and_test", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntExchange, libs, "g_atomic_int_exchange", "GLIB", "2.74")
+	core.PuregoRegisterSince(&xAtomicIntGet, libs, "g_atomic_int_get", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntInc, libs, "g_atomic_int_inc", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntOr, libs, "g_atomic_int_or", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicIntSet, libs, "g_atomic_int_set", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicIntXor, libs, "g_atomic_int_xor", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicPointerAdd, libs, "g_atomic_pointer_add", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicPointerAnd, libs, "g_atomic_pointer_and", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicPointerCompareAndExchange, libs, "g_atomic_pointer_compare_and_exchange", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicPointerCompareAndExchangeFull, libs, "g_atomic_pointer_compare_and_exchange_full", "GLIB", "2.74")
+	core.PuregoRegisterSince(&xAtomicPointerExchange, libs, "g_atomic_pointer_exchange", "GLIB", "2.74")
+	core.PuregoRegisterSince(&xAtomicPointerGet, libs, "g_atomic_pointer_get", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicPointerOr, libs, "g_atomic_pointer_or", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xAtomicPointerSet, libs, "g_atomic_pointer_set", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xAtomicPointerXor, libs, "g_atomic_pointer_xor", "GLIB", "2.30")
 
 }
@@ -0,0 +1,38 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xAtomicIntExchangeAndAdd func(uintptr, int) int
+
+// This function existed before [AtomicIntAdd] returned the prior
+// value of the integer (which it now does).  It is retained only for
+// compatibility reasons.  Don't use this function in new code.
+//
+// Deprecated: since 2.30. Use [AtomicIntAdd] instead.
+func AtomicIntExchangeAndAdd(AtomicVar uintptr, ValVar int) int {
+
+	cret := xAtomicIntExchangeAndAdd(AtomicVar, ValVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xAtomicIntExchangeAndAdd, libs, "g_atomic_int_exchange_and_add", "GLIB", "2.4")
+
+}
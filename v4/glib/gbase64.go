@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -37,7 +36,7 @@ var xBase64DecodeStep func([]byte, uint, *[]byte, int, uint) uint
 //
 // The output buffer must be large enough to fit all the data that will
 // be written to it. Since base64 encodes 3 bytes in 4 chars you need
-// at least: (@len / 4) * 3 + 3 bytes (+ 3 may be needed in case of non-zero
+// at least: (len / 4) * 3 + 3 bytes (+ 3 may be needed in case of non-zero
 // state).
 func Base64DecodeStep(InVar []byte, LenVar uint, OutVar *[]byte, StateVar int, SaveVar uint) uint {
 
@@ -59,13 +58,13 @@ func Base64Encode(DataVar []byte, LenVar uint) string {
 
 var xBase64EncodeClose func(bool, *[]byte, int, int) uint
 
-// Flush the status from a sequence of calls to g_base64_encode_step().
+// Flush the status from a sequence of calls to [Base64EncodeStep].
 //
 // The output buffer must be large enough to fit all the data that will
 // be written to it. It will need up to 4 bytes, or up to 5 bytes if
 // line-breaking is enabled.
 //
-// The @out array will not be automatically nul-terminated.
+// The out array will not be automatically nul-terminated.
 func Base64EncodeClose(BreakLinesVar bool, OutVar *[]byte, StateVar int, SaveVar int) uint {
 
 	cret := xBase64EncodeClose(BreakLinesVar, OutVar, StateVar, SaveVar)
@@ -80,15 +79,15 @@ var xBase64EncodeStep func([]byte, uint, bool, *[]byte, int, int) uint
 // data in chunks to avoid having to have the full encoded data in memory.
 //
 // When all of the data has been converted you must call
-// g_base64_encode_close() to flush the saved state.
+// [Base64EncodeClose] to flush the saved state.
 //
 // The output buffer must be large enough to fit all the data that will
 // be written to it. Due to the way base64 encodes you will need
-// at least: (@len / 3 + 1) * 4 + 4 bytes (+ 4 may be needed in case of
+// at least: (len / 3 + 1) * 4 + 4 bytes (+ 4 may be needed in case of
 // non-zero state). If you enable line-breaking you will need at least:
-// ((@len / 3 + 1) * 4 + 4) / 76 + 1 bytes of extra space.
+// ((len / 3 + 1) * 4 + 4) / 76 + 1 bytes of extra space.
 //
-// @break_lines is typically used when putting base64-encoded data in emails.
+// break_lines is typically used when putting base64-encoded data in emails.
 // It breaks the lines at 76 columns instead of putting all of the text on
 // the same line. This avoids problems with long lines in the email system.
 // Note however that it breaks the lines with `LF` characters, not
@@ -106,18 +105,18 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBase64Decode, libs, "g_base64_decode")
-	core.PuregoSafeRegister(&xBase64DecodeInplace, libs, "g_base64_decode_inplace")
-	core.PuregoSafeRegister(&xBase64DecodeStep, libs, "g_base64_decode_step")
-	core.PuregoSafeRegister(&xBase64Encode, libs, "g_base64_encode")
-	core.PuregoSafeRegister(&xBase64EncodeClose, libs, "g_base64_encode_close")
-	core.PuregoSafeRegister(&xBase64EncodeStep, libs, "g_base64_encode_step")
+	core.PuregoRegisterSince(&xBase64Decode, libs, "g_base64_decode", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBase64DecodeInplace, libs, "g_base64_decode_inplace", "GLIB", "2.20")
+	core.PuregoRegisterSince(&xBase64DecodeStep, libs, "g_base64_decode_step", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBase64Encode, libs, "g_base64_encode", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBase64EncodeClose, libs, "g_base64_encode_close", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBase64EncodeStep, libs, "g_base64_encode_step", "GLIB", "2.12")
 
 }
@@ -2,25 +2,24 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xBitLock func(uintptr, int)
 
-// Sets the indicated @lock_bit in @address.  If the bit is already
-// set, this call will block until g_bit_unlock() unsets the
+// Sets the indicated lock_bit in address.  If the bit is already
+// set, this call will block until [BitUnlock] unsets the
 // corresponding bit.
 //
 // Attempting to lock on two different bits within the same integer is
 // not supported and will very probably cause deadlocks.
 //
-// The value of the bit that is set is (1u &lt;&lt; @bit).  If @bit is not
+// The value of the bit that is set is (1u << bit).  If bit is not
 // between 0 and 31 then the result is undefined.
 //
-// This function accesses @address atomically.  All other accesses to
-// @address must be atomic in order for this function to work
-// reliably. While @address has a `volatile` qualifier, this is a historical
+// This function accesses address atomically.  All other accesses to
+// address must be atomic in order for this function to work
+// reliably. While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func BitLock(AddressVar uintptr, LockBitVar int) {
 
@@ -30,11 +29,11 @@ func BitLock(AddressVar uintptr, LockBitVar int) {
 
 var xBitLockAndGet func(uintptr, uint, *int)
 
-// Sets the indicated @lock_bit in @address and atomically returns the new value.
+// Sets the indicated lock_bit in address and atomically returns the new value.
 //
-// This is like [func@GLib.bit_lock], except it can atomically return the new value at
-// @address (right after obtaining the lock). Thus the value returned in @out_val
-// always has the @lock_bit set.
+// This is like [BitLock], except it can atomically return the new value at
+// address (right after obtaining the lock). Thus the value returned in out_val
+// always has the lock_bit set.
 func BitLockAndGet(AddressVar uintptr, LockBitVar uint, OutValVar *int) {
 
 	xBitLockAndGet(AddressVar, LockBitVar, OutValVar)
@@ -43,18 +42,18 @@ func BitLockAndGet(AddressVar uintptr, LockBitVar uint, OutValVar *int) {
 
 var xBitTrylock func(uintptr, int) bool
 
-// Sets the indicated @lock_bit in @address, returning %TRUE if
-// successful.  If the bit is already set, returns %FALSE immediately.
+// Sets the indicated lock_bit in address, returning true if
+// successful.  If the bit is already set, returns false immediately.
 //
 // Attempting to lock on two different bits within the same integer is
 // not supported.
 //
-// The value of the bit that is set is (1u &lt;&lt; @bit).  If @bit is not
+// The value of the bit that is set is (1u << bit).  If bit is not
 // between 0 and 31 then the result is undefined.
 //
-// This function accesses @address atomically.  All other accesses to
-// @address must be atomic in order for this function to work
-// reliably. While @address has a `volatile` qualifier, this is a historical
+// This function accesses address atomically.  All other accesses to
+// address must be atomic in order for this function to work
+// reliably. While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func BitTrylock(AddressVar uintptr, LockBitVar int) bool {
 
@@ -65,13 +64,13 @@ func BitTrylock(AddressVar uintptr, LockBitVar int) bool {
 
 var xBitUnlock func(uintptr, int)
 
-// Clears the indicated @lock_bit in @address.  If another thread is
-// currently blocked in g_bit_lock() on this same bit then it will be
+// Clears the indicated lock_bit in address.  If another thread is
+// currently blocked in [BitLock] on this same bit then it will be
 // woken up.
 //
-// This function accesses @address atomically.  All other accesses to
-// @address must be atomic in order for this function to work
-// reliably. While @address has a `volatile` qualifier, this is a historical
+// This function accesses address atomically.  All other accesses to
+// address must be atomic in order for this function to work
+// reliably. While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func BitUnlock(AddressVar uintptr, LockBitVar int) {
 
@@ -81,14 +80,14 @@ func BitUnlock(AddressVar uintptr, LockBitVar int) {
 
 var xBitUnlockAndSet func(uintptr, uint, int, int)
 
-// This is like [func@GLib.bit_unlock] but also atomically sets @address to
-// @val.
+// This is like [BitUnlock] but also atomically sets address to
+// val.
 //
-// If @preserve_mask is not zero, then the @preserve_mask bits will be
-// preserved in @address and are not set to @val.
+// If preserve_mask is not zero, then the preserve_mask bits will be
+// preserved in address and are not set to val.
 //
-// Note that the @lock_bit bit will always be unset regardless of
-// @val, @preserve_mask and the currently set value in @address.
+// Note that the lock_bit bit will always be unset regardless of
+// val, preserve_mask and the currently set value in address.
 func BitUnlockAndSet(AddressVar uintptr, LockBitVar uint, NewValVar int, PreserveMaskVar int) {
 
 	xBitUnlockAndSet(AddressVar, LockBitVar, NewValVar, PreserveMaskVar)
@@ -103,7 +102,7 @@ var xPointerBitLock func(uintptr, int)
 // For portability reasons, you may only lock on the bottom 32 bits of
 // the pointer.
 //
-// While @address has a `volatile` qualifier, this is a historical
+// While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func PointerBitLock(AddressVar uintptr, LockBitVar int) {
 
@@ -126,7 +125,7 @@ func PointerBitLockAndGet(AddressVar uintptr, LockBitVar uint, OutPtrVar *uintpt
 
 var xPointerBitLockMaskPtr func(uintptr, uint, bool, uintptr, uintptr) uintptr
 
-// This mangles @ptr as g_pointer_bit_lock() and g_pointer_bit_unlock()
+// This mangles ptr as [PointerBitLock] and [PointerBitUnlock]
 // do.
 func PointerBitLockMaskPtr(PtrVar uintptr, LockBitVar uint, SetVar bool, PreserveMaskVar uintptr, PreservePtrVar uintptr) uintptr {
 
@@ -137,13 +136,13 @@ func PointerBitLockMaskPtr(PtrVar uintptr, LockBitVar uint, SetVar bool, Preserv
 
 var xPointerBitTrylock func(uintptr, int) bool
 
-// This is equivalent to g_bit_trylock(), but working on pointers (or
+// This is equivalent to [BitTrylock], but working on pointers (or
 // other pointer-sized values).
 //
 // For portability reasons, you may only lock on the bottom 32 bits of
 // the pointer.
 //
-// While @address has a `volatile` qualifier, this is a historical
+// While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func PointerBitTrylock(AddressVar uintptr, LockBitVar int) bool {
 
@@ -160,7 +159,7 @@ var xPointerBitUnlock func(uintptr, int)
 // For portability reasons, you may only lock on the bottom 32 bits of
 // the pointer.
 //
-// While @address has a `volatile` qualifier, this is a historical
+// While address has a `volatile` qualifier, this is a historical
 // artifact and the argument passed to it should not be `volatile`.
 func PointerBitUnlock(AddressVar uintptr, LockBitVar int) {
 
@@ -170,12 +169,12 @@ func PointerBitUnlock(AddressVar uintptr, LockBitVar int) {
 
 var xPointerBitUnlockAndSet func(uintptr, uint, uintptr, uintptr)
 
-// This is equivalent to g_pointer_bit_unlock() and atomically setting
+// This is equivalent to [PointerBitUnlock] and atomically setting
 // the pointer value.
 //
 // Note that the lock bit will be cleared from the pointer. If the unlocked
-// pointer that was set is not identical to @ptr, an assertion fails. In other
-// words, @ptr must have @lock_bit unset. This also means, you usually can
+// pointer that was set is not identical to ptr, an assertion fails. In other
+// words, ptr must have lock_bit unset. This also means, you usually can
 // only use this on the lowest bits.
 func PointerBitUnlockAndSet(AddressVar uintptr, LockBitVar uint, PtrVar uintptr, PreserveMaskVar uintptr) {
 
@@ -188,23 +187,23 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBitLock, libs, "g_bit_lock")
-	core.PuregoSafeRegister(&xBitLockAndGet, libs, "g_bit_lock_and_get")
-	core.PuregoSafeRegister(&xBitTrylock, libs, "g_bit_trylock")
-	core.PuregoSafeRegister(&xBitUnlock, libs, "g_bit_unlock")
-	core.PuregoSafeRegister(&xBitUnlockAndSet, libs, "g_bit_unlock_and_set")
-	core.PuregoSafeRegister(&xPointerBitLock, libs, "g_pointer_bit_lock")
-	core.PuregoSafeRegister(&xPointerBitLockAndGet, libs, "g_pointer_bit_lock_and_get")
-	core.PuregoSafeRegister(&xPointerBitLockMaskPtr, libs, "g_pointer_bit_lock_mask_ptr")
-	core.PuregoSafeRegister(&xPointerBitTrylock, libs, "g_pointer_bit_trylock")
-	core.PuregoSafeRegister(&xPointerBitUnlock, libs, "g_pointer_bit_unlock")
-	core.PuregoSafeRegister(&xPointerBitUnlockAndSet, libs, "g_pointer_bit_unlock_and_set")
+	core.PuregoRegisterSince(&xBitLock, libs, "g_bit_lock", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xBitLockAndGet, libs, "g_bit_lock_and_get", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xBitTrylock, libs, "g_bit_trylock", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xBitUnlock, libs, "g_bit_unlock", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xBitUnlockAndSet, libs, "g_bit_unlock_and_set", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xPointerBitLock, libs, "g_pointer_bit_lock", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xPointerBitLockAndGet, libs, "g_pointer_bit_lock_and_get", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xPointerBitLockMaskPtr, libs, "g_pointer_bit_lock_mask_ptr", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xPointerBitTrylock, libs, "g_pointer_bit_trylock", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xPointerBitUnlock, libs, "g_pointer_bit_unlock", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xPointerBitUnlockAndSet, libs, "g_pointer_bit_unlock_and_set", "GLIB", "2.80")
 
 }
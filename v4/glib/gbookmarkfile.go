@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -16,17 +16,17 @@ import (
 // pointed by the URI like its MIME type, the application that is registering
 // the bookmark and the icon that should be used to represent the bookmark.
 // The data is stored using the
-// [Desktop Bookmark Specification](https://www.freedesktop.org/wiki/Specifications/desktop-bookmark-spec/).
+// [Desktop Bookmark Specification].
 //
 // The syntax of the bookmark files is described in detail inside the
 // Desktop Bookmark Specification, here is a quick summary: bookmark
 // files use a sub-class of the XML Bookmark Exchange Language
 // specification, consisting of valid UTF-8 encoded XML, under the
-// `&lt;xbel&gt;` root element; each bookmark is stored inside a
-// `&lt;bookmark&gt;` element, using its URI: no relative paths can
+// `<xbel>` root element; each bookmark is stored inside a
+// `<bookmark>` element, using its URI: no relative paths can
 // be used inside a bookmark file. The bookmark may have a user defined
 // title and description, to be used instead of the URI. Under the
-// `&lt;metadata&gt;` element, with its owner attribute set to
+// `<metadata>` element, with its owner attribute set to
 // `http://freedesktop.org`, is stored the meta-data about a resource
 // pointed by its URI. The meta-data consists of the resource's MIME
 // type; the applications that have registered a bookmark; the groups
@@ -36,17 +36,20 @@ import (
 // displaying the bookmark inside a GUI.
 //
 // Here is an example of a bookmark file:
-// [bookmarks.xbel](https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/glib/tests/bookmarks.xbel)
+// [bookmarks.xbel]
 //
 // A bookmark file might contain more than one bookmark; each bookmark
 // is accessed through its URI.
 //
 // The important caveat of bookmark files is that when you add a new
 // bookmark you must also add the application that is registering it, using
-// [method@GLib.BookmarkFile.add_application] or [method@GLib.BookmarkFile.set_application_info].
+// [BookmarkFile.AddApplication] or [BookmarkFile.SetApplicationInfo].
 // If a bookmark has no applications then it won't be dumped when creating
-// the on disk representation, using [method@GLib.BookmarkFile.to_data] or
-// [method@GLib.BookmarkFile.to_file].
+// the on disk representation, using [BookmarkFile.ToData] or
+// [BookmarkFile.ToFile].
+//
+// [Desktop Bookmark Specification]: https://www.freedesktop.org/wiki/Specifications/desktop-bookmark-spec/
+// [bookmarks.xbel]: https://gitlab.gnome.org/GNOME/glib/-/blob/HEAD/glib/tests/bookmarks.xbel
 type BookmarkFile struct {
 	_ structs.HostLayout
 }
@@ -63,10 +66,10 @@ func (x *BookmarkFile) GoPointer() uintptr {
 
 var xNewBookmarkFile func() *BookmarkFile
 
-// Creates a new empty #GBookmarkFile object.
+// Creates a new empty [BookmarkFile] object.
 //
-// Use g_bookmark_file_load_from_file(), g_bookmark_file_load_from_data()
-// or g_bookmark_file_load_from_data_dirs() to read an existing bookmark
+// Use [BookmarkFile.LoadFromFile], [BookmarkFile.LoadFromData]
+// or [BookmarkFile.LoadFromDataDirs] to read an existing bookmark
 // file.
 func NewBookmarkFile() *BookmarkFile {
 
@@ -76,28 +79,28 @@ func NewBookmarkFile() *BookmarkFile {
 
 var xBookmarkFileAddApplication func(uintptr, string, uintptr, uintptr)
 
-// Adds the application with @name and @exec to the list of
-// applications that have registered a bookmark for @uri into
-// @bookmark.
+// Adds the application with name and exec to the list of
+// applications that have registered a bookmark for uri into
+// bookmark.
 //
-// Every bookmark inside a #GBookmarkFile must have at least an
+// Every bookmark inside a [BookmarkFile] must have at least an
 // application registered.  Each application must provide a name, a
 // command line useful for launching the bookmark, the number of times
 // the bookmark has been registered by the application and the last
 // time the application registered this bookmark.
 //
-// If @name is %NULL, the name of the application will be the
-// same returned by g_get_application_name(); if @exec is %NULL, the
+// If name is nil, the name of the application will be the
+// same returned by [GetApplicationName]; if exec is nil, the
 // command line will be a composition of the program name as
-// returned by g_get_prgname() and the "\%u" modifier, which will be
+// returned by [GetPrgname] and the "\%u" modifier, which will be
 // expanded to the bookmark's URI.
 //
 // This function will automatically take care of updating the
 // registrations count and timestamping in case an application
-// with the same @name had already registered a bookmark for
-// @uri inside @bookmark.
+// with the same name had already registered a bookmark for
+// uri inside bookmark.
 //
-// If no bookmark for @uri is found, one is created.
+// If no bookmark for uri is found, one is created.
 func (x *BookmarkFile) AddApplication(UriVar string, NameVar *string, ExecVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -112,10 +115,10 @@ func (x *BookmarkFile) AddApplication(UriVar string, NameVar *string, ExecVar *s
 
 var xBookmarkFileAddGroup func(uintptr, string, string)
 
-// Adds @group to the list of groups to which the bookmark for @uri
+// Adds group to the list of groups to which the bookmark for uri
 // belongs to.
 //
-// If no bookmark for @uri is found then it is created.
+// If no bookmark for uri is found then it is created.
 func (x *BookmarkFile) AddGroup(UriVar string, GroupVar string) {
 
 	xBookmarkFileAddGroup(x.GoPointer(), UriVar, GroupVar)
@@ -124,7 +127,7 @@ func (x *BookmarkFile) AddGroup(UriVar string, GroupVar string) {
 
 var xBookmarkFileCopy func(uintptr) *BookmarkFile
 
-// Deeply copies a @bookmark #GBookmarkFile object to a new one.
+// Deeply copies a bookmark [BookmarkFile] object to a new one.
 func (x *BookmarkFile) Copy() *BookmarkFile {
 
 	cret := xBookmarkFileCopy(x.GoPointer())
@@ -133,68 +136,25 @@ func (x *BookmarkFile) Copy() *BookmarkFile {
 
 var xBookmarkFileFree func(uintptr)
 
-// Frees a #GBookmarkFile.
+// Frees a [BookmarkFile].
 func (x *BookmarkFile) Free() {
 
 	xBookmarkFileFree(x.GoPointer())
 
 }
 
-var xBookmarkFileGetAdded func(uintptr, string, **Error) int
-
-// Gets the time the bookmark for @uri was added to @bookmark
-//
-// In the event the URI cannot be found, -1 is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
-func (x *BookmarkFile) GetAdded(UriVar string) (int, error) {
-	var cerr *Error
-
-	cret := xBookmarkFileGetAdded(x.GoPointer(), UriVar, &cerr)
-	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
 var xBookmarkFileGetAddedDateTime func(uintptr, string, **Error) *DateTime
 
-// Gets the time the bookmark for @uri was added to @bookmark
+// Gets the time the bookmark for uri was added to bookmark
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetAddedDateTime(UriVar string) (*DateTime, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetAddedDateTime(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
-var xBookmarkFileGetAppInfo func(uintptr, string, string, *string, *uint, *int, **Error) bool
-
-// Gets the registration information of @app_name for the bookmark for
-// @uri.  See g_bookmark_file_set_application_info() for more information about
-// the returned data.
-//
-// The string returned in @app_exec must be freed.
-//
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.  In the
-// event that no application with name @app_name has registered a bookmark
-// for @uri,  %FALSE is returned and error is set to
-// %G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED. In the event that unquoting
-// the command line fails, an error of the %G_SHELL_ERROR domain is
-// set and %FALSE is returned.
-func (x *BookmarkFile) GetAppInfo(UriVar string, NameVar string, ExecVar *string, CountVar *uint, StampVar *int) (bool, error) {
-	var cerr *Error
-
-	cret := xBookmarkFileGetAppInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
-	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -202,25 +162,25 @@ func (x *BookmarkFile) GetAppInfo(UriVar string, NameVar string, ExecVar *string
 
 var xBookmarkFileGetApplicationInfo func(uintptr, string, string, *string, *uint, **DateTime, **Error) bool
 
-// Gets the registration information of @app_name for the bookmark for
-// @uri.  See g_bookmark_file_set_application_info() for more information about
+// Gets the registration information of app_name for the bookmark for
+// uri.  See [BookmarkFile.SetApplicationInfo] for more information about
 // the returned data.
 //
-// The string returned in @app_exec must be freed.
+// The string returned in app_exec must be freed.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.  In the
-// event that no application with name @app_name has registered a bookmark
-// for @uri,  %FALSE is returned and error is set to
-// %G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED. In the event that unquoting
-// the command line fails, an error of the %G_SHELL_ERROR domain is
-// set and %FALSE is returned.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].  In the
+// event that no application with name app_name has registered a bookmark
+// for uri,  false is returned and error is set to
+// [GBookmarkFileErrorAppNotRegisteredValue]. In the event that unquoting
+// the command line fails, an error of the G_SHELL_ERROR domain is
+// set and false is returned.
 func (x *BookmarkFile) GetApplicationInfo(UriVar string, NameVar string, ExecVar *string, CountVar *uint, StampVar **DateTime) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetApplicationInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -229,16 +189,16 @@ func (x *BookmarkFile) GetApplicationInfo(UriVar string, NameVar string, ExecVar
 var xBookmarkFileGetApplications func(uintptr, string, *uint, **Error) []string
 
 // Retrieves the names of the applications that have registered the
-// bookmark for @uri.
+// bookmark for uri.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetApplications(UriVar string, LengthVar *uint) ([]string, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetApplications(x.GoPointer(), UriVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -246,16 +206,16 @@ func (x *BookmarkFile) GetApplications(UriVar string, LengthVar *uint) ([]string
 
 var xBookmarkFileGetDescription func(uintptr, string, **Error) string
 
-// Retrieves the description of the bookmark for @uri.
+// Retrieves the description of the bookmark for uri.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetDescription(UriVar string) (string, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetDescription(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -263,19 +223,19 @@ func (x *BookmarkFile) GetDescription(UriVar string) (string, error) {
 
 var xBookmarkFileGetGroups func(uintptr, string, *uint, **Error) []string
 
-// Retrieves the list of group names of the bookmark for @uri.
+// Retrieves the list of group names of the bookmark for uri.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 //
-// The returned array is %NULL terminated, so @length may optionally
-// be %NULL.
+// The returned array is nil terminated, so length may optionally
+// be nil.
 func (x *BookmarkFile) GetGroups(UriVar string, LengthVar *uint) ([]string, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetGroups(x.GoPointer(), UriVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -283,16 +243,16 @@ func (x *BookmarkFile) GetGroups(UriVar string, LengthVar *uint) ([]string, erro
 
 var xBookmarkFileGetIcon func(uintptr, string, *string, *string, **Error) bool
 
-// Gets the icon of the bookmark for @uri.
+// Gets the icon of the bookmark for uri.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetIcon(UriVar string, HrefVar *string, MimeTypeVar *string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetIcon(x.GoPointer(), UriVar, HrefVar, MimeTypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -300,18 +260,18 @@ func (x *BookmarkFile) GetIcon(UriVar string, HrefVar *string, MimeTypeVar *stri
 
 var xBookmarkFileGetIsPrivate func(uintptr, string, **Error) bool
 
-// Gets whether the private flag of the bookmark for @uri is set.
+// Gets whether the private flag of the bookmark for uri is set.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.  In the
-// event that the private flag cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_INVALID_VALUE.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].  In the
+// event that the private flag cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorInvalidValueValue].
 func (x *BookmarkFile) GetIsPrivate(UriVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetIsPrivate(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -319,35 +279,18 @@ func (x *BookmarkFile) GetIsPrivate(UriVar string) (bool, error) {
 
 var xBookmarkFileGetMimeType func(uintptr, string, **Error) string
 
-// Retrieves the MIME type of the resource pointed by @uri.
+// Retrieves the MIME type of the resource pointed by uri.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.  In the
-// event that the MIME type cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_INVALID_VALUE.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].  In the
+// event that the MIME type cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorInvalidValueValue].
 func (x *BookmarkFile) GetMimeType(UriVar string) (string, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetMimeType(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
-var xBookmarkFileGetModified func(uintptr, string, **Error) int
-
-// Gets the time when the bookmark for @uri was last modified.
-//
-// In the event the URI cannot be found, -1 is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
-func (x *BookmarkFile) GetModified(UriVar string) (int, error) {
-	var cerr *Error
-
-	cret := xBookmarkFileGetModified(x.GoPointer(), UriVar, &cerr)
-	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -355,16 +298,16 @@ func (x *BookmarkFile) GetModified(UriVar string) (int, error) {
 
 var xBookmarkFileGetModifiedDateTime func(uintptr, string, **Error) *DateTime
 
-// Gets the time when the bookmark for @uri was last modified.
+// Gets the time when the bookmark for uri was last modified.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetModifiedDateTime(UriVar string) (*DateTime, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetModifiedDateTime(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -372,7 +315,7 @@ func (x *BookmarkFile) GetModifiedDateTime(UriVar string) (*DateTime, error) {
 
 var xBookmarkFileGetSize func(uintptr) int
 
-// Gets the number of bookmarks inside @bookmark.
+// Gets the number of bookmarks inside bookmark.
 func (x *BookmarkFile) GetSize() int {
 
 	cret := xBookmarkFileGetSize(x.GoPointer())
@@ -381,12 +324,12 @@ func (x *BookmarkFile) GetSize() int {
 
 var xBookmarkFileGetTitle func(uintptr, uintptr, **Error) string
 
-// Returns the title of the bookmark for @uri.
+// Returns the title of the bookmark for uri.
 //
-// If @uri is %NULL, the title of @bookmark is returned.
+// If uri is nil, the title of bookmark is returned.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetTitle(UriVar *string) (string, error) {
 	var cerr *Error
 
@@ -395,7 +338,7 @@ func (x *BookmarkFile) GetTitle(UriVar *string) (string, error) {
 
 	cret := xBookmarkFileGetTitle(x.GoPointer(), UriVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -403,44 +346,27 @@ func (x *BookmarkFile) GetTitle(UriVar *string) (string, error) {
 
 var xBookmarkFileGetUris func(uintptr, *uint) []string
 
-// Returns all URIs of the bookmarks in the bookmark file @bookmark.
-// The array of returned URIs will be %NULL-terminated, so @length may
-// optionally be %NULL.
+// Returns all URIs of the bookmarks in the bookmark file bookmark.
+// The array of returned URIs will be nil-terminated, so length may
+// optionally be nil.
 func (x *BookmarkFile) GetUris(LengthVar *uint) []string {
 
 	cret := xBookmarkFileGetUris(x.GoPointer(), LengthVar)
 	return cret
 }
 
-var xBookmarkFileGetVisited func(uintptr, string, **Error) int
-
-// Gets the time the bookmark for @uri was last visited.
-//
-// In the event the URI cannot be found, -1 is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
-func (x *BookmarkFile) GetVisited(UriVar string) (int, error) {
-	var cerr *Error
-
-	cret := xBookmarkFileGetVisited(x.GoPointer(), UriVar, &cerr)
-	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
 var xBookmarkFileGetVisitedDateTime func(uintptr, string, **Error) *DateTime
 
-// Gets the time the bookmark for @uri was last visited.
+// Gets the time the bookmark for uri was last visited.
 //
-// In the event the URI cannot be found, %NULL is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, nil is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) GetVisitedDateTime(UriVar string) (*DateTime, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileGetVisitedDateTime(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -448,17 +374,17 @@ func (x *BookmarkFile) GetVisitedDateTime(UriVar string) (*DateTime, error) {
 
 var xBookmarkFileHasApplication func(uintptr, string, string, **Error) bool
 
-// Checks whether the bookmark for @uri inside @bookmark has been
-// registered by application @name.
+// Checks whether the bookmark for uri inside bookmark has been
+// registered by application name.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) HasApplication(UriVar string, NameVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileHasApplication(x.GoPointer(), UriVar, NameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -466,17 +392,17 @@ func (x *BookmarkFile) HasApplication(UriVar string, NameVar string) (bool, erro
 
 var xBookmarkFileHasGroup func(uintptr, string, string, **Error) bool
 
-// Checks whether @group appears in the list of groups to which
-// the bookmark for @uri belongs to.
+// Checks whether group appears in the list of groups to which
+// the bookmark for uri belongs to.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) HasGroup(UriVar string, GroupVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileHasGroup(x.GoPointer(), UriVar, GroupVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -484,7 +410,7 @@ func (x *BookmarkFile) HasGroup(UriVar string, GroupVar string) (bool, error) {
 
 var xBookmarkFileHasItem func(uintptr, string) bool
 
-// Looks whether the desktop bookmark has an item with its URI set to @uri.
+// Looks whether the desktop bookmark has an item with its URI set to uri.
 func (x *BookmarkFile) HasItem(UriVar string) bool {
 
 	cret := xBookmarkFileHasItem(x.GoPointer(), UriVar)
@@ -493,15 +419,15 @@ func (x *BookmarkFile) HasItem(UriVar string) bool {
 
 var xBookmarkFileLoadFromData func(uintptr, []byte, uint, **Error) bool
 
-// Loads a bookmark file from memory into an empty #GBookmarkFile
-// structure.  If the object cannot be created then @error is set to a
-// #GBookmarkFileError.
+// Loads a bookmark file from memory into an empty [BookmarkFile]
+// structure.  If the object cannot be created then error is set to a
+// [BookmarkFileError].
 func (x *BookmarkFile) LoadFromData(DataVar []byte, LengthVar uint) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileLoadFromData(x.GoPointer(), DataVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -509,17 +435,17 @@ func (x *BookmarkFile) LoadFromData(DataVar []byte, LengthVar uint) (bool, error
 
 var xBookmarkFileLoadFromDataDirs func(uintptr, string, *string, **Error) bool
 
-// This function looks for a desktop bookmark file named @file in the
-// paths returned from g_get_user_data_dir() and g_get_system_data_dirs(),
-// loads the file into @bookmark and returns the file's full path in
-// @full_path.  If the file could not be loaded then @error is
-// set to either a #GFileError or #GBookmarkFileError.
+// This function looks for a desktop bookmark file named file in the
+// paths returned from [GetUserDataDir] and [GetSystemDataDirs],
+// loads the file into bookmark and returns the file's full path in
+// full_path.  If the file could not be loaded then error is
+// set to either a [FileError] or [BookmarkFileError].
 func (x *BookmarkFile) LoadFromDataDirs(FileVar string, FullPathVar *string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileLoadFromDataDirs(x.GoPointer(), FileVar, FullPathVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -527,15 +453,15 @@ func (x *BookmarkFile) LoadFromDataDirs(FileVar string, FullPathVar *string) (bo
 
 var xBookmarkFileLoadFromFile func(uintptr, string, **Error) bool
 
-// Loads a desktop bookmark file into an empty #GBookmarkFile structure.
-// If the file could not be loaded then @error is set to either a #GFileError
-// or #GBookmarkFileError.
+// Loads a desktop bookmark file into an empty [BookmarkFile] structure.
+// If the file could not be loaded then error is set to either a [FileError]
+// or [BookmarkFileError].
 func (x *BookmarkFile) LoadFromFile(FilenameVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileLoadFromFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -543,12 +469,12 @@ func (x *BookmarkFile) LoadFromFile(FilenameVar string) (bool, error) {
 
 var xBookmarkFileMoveItem func(uintptr, string, uintptr, **Error) bool
 
-// Changes the URI of a bookmark item from @old_uri to @new_uri.  Any
-// existing bookmark for @new_uri will be overwritten.  If @new_uri is
-// %NULL, then the bookmark is removed.
+// Changes the URI of a bookmark item from old_uri to new_uri.  Any
+// existing bookmark for new_uri will be overwritten.  If new_uri is
+// nil, then the bookmark is removed.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
 func (x *BookmarkFile) MoveItem(OldUriVar string, NewUriVar *string) (bool, error) {
 	var cerr *Error
 
@@ -557,7 +483,7 @@ func (x *BookmarkFile) MoveItem(OldUriVar string, NewUriVar *string) (bool, erro
 
 	cret := xBookmarkFileMoveItem(x.GoPointer(), OldUriVar, NewUriVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -565,20 +491,20 @@ func (x *BookmarkFile) MoveItem(OldUriVar string, NewUriVar *string) (bool, erro
 
 var xBookmarkFileRemoveApplication func(uintptr, string, string, **Error) bool
 
-// Removes application registered with @name from the list of applications
-// that have registered a bookmark for @uri inside @bookmark.
+// Removes application registered with name from the list of applications
+// that have registered a bookmark for uri inside bookmark.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
-// In the event that no application with name @app_name has registered
-// a bookmark for @uri,  %FALSE is returned and error is set to
-// %G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
+// In the event that no application with name app_name has registered
+// a bookmark for uri,  false is returned and error is set to
+// [GBookmarkFileErrorAppNotRegisteredValue].
 func (x *BookmarkFile) RemoveApplication(UriVar string, NameVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileRemoveApplication(x.GoPointer(), UriVar, NameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -586,19 +512,19 @@ func (x *BookmarkFile) RemoveApplication(UriVar string, NameVar string) (bool, e
 
 var xBookmarkFileRemoveGroup func(uintptr, string, string, **Error) bool
 
-// Removes @group from the list of groups to which the bookmark
-// for @uri belongs to.
+// Removes group from the list of groups to which the bookmark
+// for uri belongs to.
 //
-// In the event the URI cannot be found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND.
-// In the event no group was defined, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_INVALID_VALUE.
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
+// In the event no group was defined, false is returned and
+// error is set to [GBookmarkFileErrorInvalidValueValue].
 func (x *BookmarkFile) RemoveGroup(UriVar string, GroupVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileRemoveGroup(x.GoPointer(), UriVar, GroupVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -606,116 +532,64 @@ func (x *BookmarkFile) RemoveGroup(UriVar string, GroupVar string) (bool, error)
 
 var xBookmarkFileRemoveItem func(uintptr, string, **Error) bool
 
-// Removes the bookmark for @uri from the bookmark file @bookmark.
+// Removes the bookmark for uri from the bookmark file bookmark.
 func (x *BookmarkFile) RemoveItem(UriVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileRemoveItem(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xBookmarkFileSetAdded func(uintptr, string, int)
-
-// Sets the time the bookmark for @uri was added into @bookmark.
-//
-// If no bookmark for @uri is found then it is created.
-func (x *BookmarkFile) SetAdded(UriVar string, AddedVar int) {
-
-	xBookmarkFileSetAdded(x.GoPointer(), UriVar, AddedVar)
-
-}
-
 var xBookmarkFileSetAddedDateTime func(uintptr, string, *DateTime)
 
-// Sets the time the bookmark for @uri was added into @bookmark.
+// Sets the time the bookmark for uri was added into bookmark.
 //
-// If no bookmark for @uri is found then it is created.
+// If no bookmark for uri is found then it is created.
 func (x *BookmarkFile) SetAddedDateTime(UriVar string, AddedVar *DateTime) {
 
 	xBookmarkFileSetAddedDateTime(x.GoPointer(), UriVar, AddedVar)
 
 }
 
-var xBookmarkFileSetAppInfo func(uintptr, string, string, string, int, int, **Error) bool
-
-// Sets the meta-data of application @name inside the list of
-// applications that have registered a bookmark for @uri inside
-// @bookmark.
-//
-// You should rarely use this function; use g_bookmark_file_add_application()
-// and g_bookmark_file_remove_application() instead.
-//
-// @name can be any UTF-8 encoded string used to identify an
-// application.
-// @exec can have one of these two modifiers: "\%f", which will
-// be expanded as the local file name retrieved from the bookmark's
-// URI; "\%u", which will be expanded as the bookmark's URI.
-// The expansion is done automatically when retrieving the stored
-// command line using the g_bookmark_file_get_application_info() function.
-// @count is the number of times the application has registered the
-// bookmark; if is &lt; 0, the current registration count will be increased
-// by one, if is 0, the application with @name will be removed from
-// the list of registered applications.
-// @stamp is the Unix time of the last registration; if it is -1, the
-// current time will be used.
-//
-// If you try to remove an application by setting its registration count to
-// zero, and no bookmark for @uri is found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND; similarly,
-// in the event that no application @name has registered a bookmark
-// for @uri,  %FALSE is returned and error is set to
-// %G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED.  Otherwise, if no bookmark
-// for @uri is found, one is created.
-func (x *BookmarkFile) SetAppInfo(UriVar string, NameVar string, ExecVar string, CountVar int, StampVar int) (bool, error) {
-	var cerr *Error
-
-	cret := xBookmarkFileSetAppInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
-	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
 var xBookmarkFileSetApplicationInfo func(uintptr, string, string, string, int, *DateTime, **Error) bool
 
-// Sets the meta-data of application @name inside the list of
-// applications that have registered a bookmark for @uri inside
-// @bookmark.
+// Sets the meta-data of application name inside the list of
+// applications that have registered a bookmark for uri inside
+// bookmark.
 //
-// You should rarely use this function; use g_bookmark_file_add_application()
-// and g_bookmark_file_remove_application() instead.
+// You should rarely use this function; use [BookmarkFile.AddApplication]
+// and [BookmarkFile.RemoveApplication] instead.
 //
-// @name can be any UTF-8 encoded string used to identify an
+// name can be any UTF-8 encoded string used to identify an
 // application.
-// @exec can have one of these two modifiers: "\%f", which will
+// exec can have one of these two modifiers: "\%f", which will
 // be expanded as the local file name retrieved from the bookmark's
 // URI; "\%u", which will be expanded as the bookmark's URI.
 // The expansion is done automatically when retrieving the stored
-// command line using the g_bookmark_file_get_application_info() function.
-// @count is the number of times the application has registered the
-// bookmark; if is &lt; 0, the current registration count will be increased
-// by one, if is 0, the application with @name will be removed from
+// command line using the [BookmarkFile.GetApplicationInfo] function.
+// count is the number of times the application has registered the
+// bookmark; if is < 0, the current registration count will be increased
+// by one, if is 0, the application with name will be removed from
 // the list of registered applications.
-// @stamp is the Unix time of the last registration.
+// stamp is the Unix time of the last registration.
 //
 // If you try to remove an application by setting its registration count to
-// zero, and no bookmark for @uri is found, %FALSE is returned and
-// @error is set to %G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND; similarly,
-// in the event that no application @name has registered a bookmark
-// for @uri,  %FALSE is returned and error is set to
-// %G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED.  Otherwise, if no bookmark
-// for @uri is found, one is created.
+// zero, and no bookmark for uri is found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue]; similarly,
+// in the event that no application name has registered a bookmark
+// for uri,  false is returned and error is set to
+// [GBookmarkFileErrorAppNotRegisteredValue].  Otherwise, if no bookmark
+// for uri is found, one is created.
 func (x *BookmarkFile) SetApplicationInfo(UriVar string, NameVar string, ExecVar string, CountVar int, StampVar *DateTime) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileSetApplicationInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -723,11 +597,11 @@ func (x *BookmarkFile) SetApplicationInfo(UriVar string, NameVar string, ExecVar
 
 var xBookmarkFileSetDescription func(uintptr, uintptr, string)
 
-// Sets @description as the description of the bookmark for @uri.
+// Sets description as the description of the bookmark for uri.
 //
-// If @uri is %NULL, the description of @bookmark is set.
+// If uri is nil, the description of bookmark is set.
 //
-// If a bookmark for @uri cannot be found then it is created.
+// If a bookmark for uri cannot be found then it is created.
 func (x *BookmarkFile) SetDescription(UriVar *string, DescriptionVar string) {
 
 	UriVarPtr := core.GStrdupNullable(UriVar)
@@ -739,10 +613,10 @@ func (x *BookmarkFile) SetDescription(UriVar *string, DescriptionVar string) {
 
 var xBookmarkFileSetGroups func(uintptr, string, []string, uint)
 
-// Sets a list of group names for the item with URI @uri.  Each previously
+// Sets a list of group names for the item with URI uri.  Each previously
 // set group name list is removed.
 //
-// If @uri cannot be found then an item for it is created.
+// If uri cannot be found then an item for it is created.
 func (x *BookmarkFile) SetGroups(UriVar string, GroupsVar []string, LengthVar uint) {
 
 	xBookmarkFileSetGroups(x.GoPointer(), UriVar, GroupsVar, LengthVar)
@@ -751,11 +625,11 @@ func (x *BookmarkFile) SetGroups(UriVar string, GroupsVar []string, LengthVar ui
 
 var xBookmarkFileSetIcon func(uintptr, string, uintptr, string)
 
-// Sets the icon for the bookmark for @uri. If @href is %NULL, unsets
-// the currently set icon. @href can either be a full URL for the icon
+// Sets the icon for the bookmark for uri. If href is nil, unsets
+// the currently set icon. href can either be a full URL for the icon
 // file or the icon name following the Icon Naming specification.
 //
-// If no bookmark for @uri is found one is created.
+// If no bookmark for uri is found one is created.
 func (x *BookmarkFile) SetIcon(UriVar string, HrefVar *string, MimeTypeVar string) {
 
 	HrefVarPtr := core.GStrdupNullable(HrefVar)
@@ -767,9 +641,9 @@ func (x *BookmarkFile) SetIcon(UriVar string, HrefVar *string, MimeTypeVar strin
 
 var xBookmarkFileSetIsPrivate func(uintptr, string, bool)
 
-// Sets the private flag of the bookmark for @uri.
+// Sets the private flag of the bookmark for uri.
 //
-// If a bookmark for @uri cannot be found then it is created.
+// If a bookmark for uri cannot be found then it is created.
 func (x *BookmarkFile) SetIsPrivate(UriVar string, IsPrivateVar bool) {
 
 	xBookmarkFileSetIsPrivate(x.GoPointer(), UriVar, IsPrivateVar)
@@ -778,41 +652,25 @@ func (x *BookmarkFile) SetIsPrivate(UriVar string, IsPrivateVar bool) {
 
 var xBookmarkFileSetMimeType func(uintptr, string, string)
 
-// Sets @mime_type as the MIME type of the bookmark for @uri.
+// Sets mime_type as the MIME type of the bookmark for uri.
 //
-// If a bookmark for @uri cannot be found then it is created.
+// If a bookmark for uri cannot be found then it is created.
 func (x *BookmarkFile) SetMimeType(UriVar string, MimeTypeVar string) {
 
 	xBookmarkFileSetMimeType(x.GoPointer(), UriVar, MimeTypeVar)
 
 }
 
-var xBookmarkFileSetModified func(uintptr, string, int)
-
-// Sets the last time the bookmark for @uri was last modified.
-//
-// If no bookmark for @uri is found then it is created.
-//
-// The "modified" time should only be set when the bookmark's meta-data
-// was actually changed.  Every function of #GBookmarkFile that
-// modifies a bookmark also changes the modification time, except for
-// g_bookmark_file_set_visited_date_time().
-func (x *BookmarkFile) SetModified(UriVar string, ModifiedVar int) {
-
-	xBookmarkFileSetModified(x.GoPointer(), UriVar, ModifiedVar)
-
-}
-
 var xBookmarkFileSetModifiedDateTime func(uintptr, string, *DateTime)
 
-// Sets the last time the bookmark for @uri was last modified.
+// Sets the last time the bookmark for uri was last modified.
 //
-// If no bookmark for @uri is found then it is created.
+// If no bookmark for uri is found then it is created.
 //
 // The "modified" time should only be set when the bookmark's meta-data
-// was actually changed.  Every function of #GBookmarkFile that
+// was actually changed.  Every function of [BookmarkFile] that
 // modifies a bookmark also changes the modification time, except for
-// g_bookmark_file_set_visited_date_time().
+// [BookmarkFile.SetVisitedDateTime].
 func (x *BookmarkFile) SetModifiedDateTime(UriVar string, ModifiedVar *DateTime) {
 
 	xBookmarkFileSetModifiedDateTime(x.GoPointer(), UriVar, ModifiedVar)
@@ -821,12 +679,12 @@ func (x *BookmarkFile) SetModifiedDateTime(UriVar string, ModifiedVar *DateTime)
 
 var xBookmarkFileSetTitle func(uintptr, uintptr, string)
 
-// Sets @title as the title of the bookmark for @uri inside the
-// bookmark file @bookmark.
+// Sets title as the title of the bookmark for uri inside the
+// bookmark file bookmark.
 //
-// If @uri is %NULL, the title of @bookmark is set.
+// If uri is nil, the title of bookmark is set.
 //
-// If a bookmark for @uri cannot be found then it is created.
+// If a bookmark for uri cannot be found then it is created.
 func (x *BookmarkFile) SetTitle(UriVar *string, TitleVar string) {
 
 	UriVarPtr := core.GStrdupNullable(UriVar)
@@ -836,33 +694,16 @@ func (x *BookmarkFile) SetTitle(UriVar *string, TitleVar string) {
 
 }
 
-var xBookmarkFileSetVisited func(uintptr, string, int)
-
-// Sets the time the bookmark for @uri was last visited.
-//
-// If no bookmark for @uri is found then it is created.
-//
-// The "visited" time should only be set if the bookmark was launched,
-// either using the command line retrieved by g_bookmark_file_get_application_info()
-// or by the default application for the bookmark's MIME type, retrieved
-// using g_bookmark_file_get_mime_type().  Changing the "visited" time
-// does not affect the "modified" time.
-func (x *BookmarkFile) SetVisited(UriVar string, VisitedVar int) {
-
-	xBookmarkFileSetVisited(x.GoPointer(), UriVar, VisitedVar)
-
-}
-
 var xBookmarkFileSetVisitedDateTime func(uintptr, string, *DateTime)
 
-// Sets the time the bookmark for @uri was last visited.
+// Sets the time the bookmark for uri was last visited.
 //
-// If no bookmark for @uri is found then it is created.
+// If no bookmark for uri is found then it is created.
 //
 // The "visited" time should only be set if the bookmark was launched,
-// either using the command line retrieved by g_bookmark_file_get_application_info()
+// either using the command line retrieved by [BookmarkFile.GetApplicationInfo]
 // or by the default application for the bookmark's MIME type, retrieved
-// using g_bookmark_file_get_mime_type().  Changing the "visited" time
+// using [BookmarkFile.GetMimeType].  Changing the "visited" time
 // does not affect the "modified" time.
 func (x *BookmarkFile) SetVisitedDateTime(UriVar string, VisitedVar *DateTime) {
 
@@ -872,13 +713,13 @@ func (x *BookmarkFile) SetVisitedDateTime(UriVar string, VisitedVar *DateTime) {
 
 var xBookmarkFileToData func(uintptr, *uint, **Error) uintptr
 
-// This function outputs @bookmark as a string.
+// This function outputs bookmark as a string.
 func (x *BookmarkFile) ToData(LengthVar *uint) (uintptr, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileToData(x.GoPointer(), LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -886,14 +727,14 @@ func (x *BookmarkFile) ToData(LengthVar *uint) (uintptr, error) {
 
 var xBookmarkFileToFile func(uintptr, string, **Error) bool
 
-// This function outputs @bookmark into a file.  The write process is
-// guaranteed to be atomic by using g_file_set_contents() internally.
+// This function outputs bookmark into a file.  The write process is
+// guaranteed to be atomic by using [FileSetContents] internally.
 func (x *BookmarkFile) ToFile(FilenameVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xBookmarkFileToFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -924,12 +765,109 @@ const (
 	GBookmarkFileErrorFileNotFoundValue BookmarkFileError = 7
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (BookmarkFileError) ErrorDomain() string {
+	return "g-bookmark-file-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x BookmarkFileError) Error() string {
+	switch x {
+	case GBookmarkFileErrorInvalidUriValue:
+		return "G_BOOKMARK_FILE_ERROR_INVALID_URI"
+	case GBookmarkFileErrorInvalidValueValue:
+		return "G_BOOKMARK_FILE_ERROR_INVALID_VALUE"
+	case GBookmarkFileErrorAppNotRegisteredValue:
+		return "G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED"
+	case GBookmarkFileErrorUriNotFoundValue:
+		return "G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND"
+	case GBookmarkFileErrorReadValue:
+		return "G_BOOKMARK_FILE_ERROR_READ"
+	case GBookmarkFileErrorUnknownEncodingValue:
+		return "G_BOOKMARK_FILE_ERROR_UNKNOWN_ENCODING"
+	case GBookmarkFileErrorWriteValue:
+		return "G_BOOKMARK_FILE_ERROR_WRITE"
+	case GBookmarkFileErrorFileNotFoundValue:
+		return "G_BOOKMARK_FILE_ERROR_FILE_NOT_FOUND"
+	}
+	return fmt.Sprintf("g-bookmark-file-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x BookmarkFileError) String() string {
+	switch x {
+	case GBookmarkFileErrorInvalidUriValue:
+		return "G_BOOKMARK_FILE_ERROR_INVALID_URI"
+	case GBookmarkFileErrorInvalidValueValue:
+		return "G_BOOKMARK_FILE_ERROR_INVALID_VALUE"
+	case GBookmarkFileErrorAppNotRegisteredValue:
+		return "G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED"
+	case GBookmarkFileErrorUriNotFoundValue:
+		return "G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND"
+	case GBookmarkFileErrorReadValue:
+		return "G_BOOKMARK_FILE_ERROR_READ"
+	case GBookmarkFileErrorUnknownEncodingValue:
+		return "G_BOOKMARK_FILE_ERROR_UNKNOWN_ENCODING"
+	case GBookmarkFileErrorWriteValue:
+		return "G_BOOKMARK_FILE_ERROR_WRITE"
+	case GBookmarkFileErrorFileNotFoundValue:
+		return "G_BOOKMARK_FILE_ERROR_FILE_NOT_FOUND"
+	}
+	return fmt.Sprintf("BookmarkFileError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BookmarkFileError) Nick() string {
+	switch x {
+	case GBookmarkFileErrorInvalidUriValue:
+		return "invalid-uri"
+	case GBookmarkFileErrorInvalidValueValue:
+		return "invalid-value"
+	case GBookmarkFileErrorAppNotRegisteredValue:
+		return "app-not-registered"
+	case GBookmarkFileErrorUriNotFoundValue:
+		return "uri-not-found"
+	case GBookmarkFileErrorReadValue:
+		return "read"
+	case GBookmarkFileErrorUnknownEncodingValue:
+		return "unknown-encoding"
+	case GBookmarkFileErrorWriteValue:
+		return "write"
+	case GBookmarkFileErrorFileNotFoundValue:
+		return "file-not-found"
+	}
+	return ""
+}
+
+// BookmarkFileErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BookmarkFileErrorFromString(s string) (BookmarkFileError, bool) {
+	switch s {
+	case "G_BOOKMARK_FILE_ERROR_INVALID_URI", "invalid-uri":
+		return GBookmarkFileErrorInvalidUriValue, true
+	case "G_BOOKMARK_FILE_ERROR_INVALID_VALUE", "invalid-value":
+		return GBookmarkFileErrorInvalidValueValue, true
+	case "G_BOOKMARK_FILE_ERROR_APP_NOT_REGISTERED", "app-not-registered":
+		return GBookmarkFileErrorAppNotRegisteredValue, true
+	case "G_BOOKMARK_FILE_ERROR_URI_NOT_FOUND", "uri-not-found":
+		return GBookmarkFileErrorUriNotFoundValue, true
+	case "G_BOOKMARK_FILE_ERROR_READ", "read":
+		return GBookmarkFileErrorReadValue, true
+	case "G_BOOKMARK_FILE_ERROR_UNKNOWN_ENCODING", "unknown-encoding":
+		return GBookmarkFileErrorUnknownEncodingValue, true
+	case "G_BOOKMARK_FILE_ERROR_WRITE", "write":
+		return GBookmarkFileErrorWriteValue, true
+	case "G_BOOKMARK_FILE_ERROR_FILE_NOT_FOUND", "file-not-found":
+		return GBookmarkFileErrorFileNotFoundValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -938,54 +876,46 @@ func init() {
 
 	core.PuregoSafeRegister(&xBookmarkFileGLibType, libs, "g_bookmark_file_get_type")
 
-	core.PuregoSafeRegister(&xNewBookmarkFile, libs, "g_bookmark_file_new")
-
-	core.PuregoSafeRegister(&xBookmarkFileAddApplication, libs, "g_bookmark_file_add_application")
-	core.PuregoSafeRegister(&xBookmarkFileAddGroup, libs, "g_bookmark_file_add_group")
-	core.PuregoSafeRegister(&xBookmarkFileCopy, libs, "g_bookmark_file_copy")
-	core.PuregoSafeRegister(&xBookmarkFileFree, libs, "g_bookmark_file_free")
-	core.PuregoSafeRegister(&xBookmarkFileGetAdded, libs, "g_bookmark_file_get_added")
-	core.PuregoSafeRegister(&xBookmarkFileGetAddedDateTime, libs, "g_bookmark_file_get_added_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileGetAppInfo, libs, "g_bookmark_file_get_app_info")
-	core.PuregoSafeRegister(&xBookmarkFileGetApplicationInfo, libs, "g_bookmark_file_get_application_info")
-	core.PuregoSafeRegister(&xBookmarkFileGetApplications, libs, "g_bookmark_file_get_applications")
-	core.PuregoSafeRegister(&xBookmarkFileGetDescription, libs, "g_bookmark_file_get_description")
-	core.PuregoSafeRegister(&xBookmarkFileGetGroups, libs, "g_bookmark_file_get_groups")
-	core.PuregoSafeRegister(&xBookmarkFileGetIcon, libs, "g_bookmark_file_get_icon")
-	core.PuregoSafeRegister(&xBookmarkFileGetIsPrivate, libs, "g_bookmark_file_get_is_private")
-	core.PuregoSafeRegister(&xBookmarkFileGetMimeType, libs, "g_bookmark_file_get_mime_type")
-	core.PuregoSafeRegister(&xBookmarkFileGetModified, libs, "g_bookmark_file_get_modified")
-	core.PuregoSafeRegister(&xBookmarkFileGetModifiedDateTime, libs, "g_bookmark_file_get_modified_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileGetSize, libs, "g_bookmark_file_get_size")
-	core.PuregoSafeRegister(&xBookmarkFileGetTitle, libs, "g_bookmark_file_get_title")
-	core.PuregoSafeRegister(&xBookmarkFileGetUris, libs, "g_bookmark_file_get_uris")
-	core.PuregoSafeRegister(&xBookmarkFileGetVisited, libs, "g_bookmark_file_get_visited")
-	core.PuregoSafeRegister(&xBookmarkFileGetVisitedDateTime, libs, "g_bookmark_file_get_visited_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileHasApplication, libs, "g_bookmark_file_has_application")
-	core.PuregoSafeRegister(&xBookmarkFileHasGroup, libs, "g_bookmark_file_has_group")
-	core.PuregoSafeRegister(&xBookmarkFileHasItem, libs, "g_bookmark_file_has_item")
-	core.PuregoSafeRegister(&xBookmarkFileLoadFromData, libs, "g_bookmark_file_load_from_data")
-	core.PuregoSafeRegister(&xBookmarkFileLoadFromDataDirs, libs, "g_bookmark_file_load_from_data_dirs")
-	core.PuregoSafeRegister(&xBookmarkFileLoadFromFile, libs, "g_bookmark_file_load_from_file")
-	core.PuregoSafeRegister(&xBookmarkFileMoveItem, libs, "g_bookmark_file_move_item")
-	core.PuregoSafeRegister(&xBookmarkFileRemoveApplication, libs, "g_bookmark_file_remove_application")
-	core.PuregoSafeRegister(&xBookmarkFileRemoveGroup, libs, "g_bookmark_file_remove_group")
-	core.PuregoSafeRegister(&xBookmarkFileRemoveItem, libs, "g_bookmark_file_remove_item")
-	core.PuregoSafeRegister(&xBookmarkFileSetAdded, libs, "g_bookmark_file_set_added")
-	core.PuregoSafeRegister(&xBookmarkFileSetAddedDateTime, libs, "g_bookmark_file_set_added_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileSetAppInfo, libs, "g_bookmark_file_set_app_info")
-	core.PuregoSafeRegister(&xBookmarkFileSetApplicationInfo, libs, "g_bookmark_file_set_application_info")
-	core.PuregoSafeRegister(&xBookmarkFileSetDescription, libs, "g_bookmark_file_set_description")
-	core.PuregoSafeRegister(&xBookmarkFileSetGroups, libs, "g_bookmark_file_set_groups")
-	core.PuregoSafeRegister(&xBookmarkFileSetIcon, libs, "g_bookmark_file_set_icon")
-	core.PuregoSafeRegister(&xBookmarkFileSetIsPrivate, libs, "g_bookmark_file_set_is_private")
-	core.PuregoSafeRegister(&xBookmarkFileSetMimeType, libs, "g_bookmark_file_set_mime_type")
-	core.PuregoSafeRegister(&xBookmarkFileSetModified, libs, "g_bookmark_file_set_modified")
-	core.PuregoSafeRegister(&xBookmarkFileSetModifiedDateTime, libs, "g_bookmark_file_set_modified_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileSetTitle, libs, "g_bookmark_file_set_title")
-	core.PuregoSafeRegister(&xBookmarkFileSetVisited, libs, "g_bookmark_file_set_visited")
-	core.PuregoSafeRegister(&xBookmarkFileSetVisitedDateTime, libs, "g_bookmark_file_set_visited_date_time")
-	core.PuregoSafeRegister(&xBookmarkFileToData, libs, "g_bookmark_file_to_data")
-	core.PuregoSafeRegister(&xBookmarkFileToFile, libs, "g_bookmark_file_to_file")
+	core.PuregoRegisterSince(&xNewBookmarkFile, libs, "g_bookmark_file_new", "GLIB", "2.12")
+
+	core.PuregoRegisterSince(&xBookmarkFileAddApplication, libs, "g_bookmark_file_add_application", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileAddGroup, libs, "g_bookmark_file_add_group", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileCopy, libs, "g_bookmark_file_copy", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xBookmarkFileFree, libs, "g_bookmark_file_free", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetAddedDateTime, libs, "g_bookmark_file_get_added_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileGetApplicationInfo, libs, "g_bookmark_file_get_application_info", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileGetApplications, libs, "g_bookmark_file_get_applications", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetDescription, libs, "g_bookmark_file_get_description", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetGroups, libs, "g_bookmark_file_get_groups", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetIcon, libs, "g_bookmark_file_get_icon", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetIsPrivate, libs, "g_bookmark_file_get_is_private", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetMimeType, libs, "g_bookmark_file_get_mime_type", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetModifiedDateTime, libs, "g_bookmark_file_get_modified_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileGetSize, libs, "g_bookmark_file_get_size", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetTitle, libs, "g_bookmark_file_get_title", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetUris, libs, "g_bookmark_file_get_uris", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetVisitedDateTime, libs, "g_bookmark_file_get_visited_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileHasApplication, libs, "g_bookmark_file_has_application", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileHasGroup, libs, "g_bookmark_file_has_group", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileHasItem, libs, "g_bookmark_file_has_item", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileLoadFromData, libs, "g_bookmark_file_load_from_data", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileLoadFromDataDirs, libs, "g_bookmark_file_load_from_data_dirs", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileLoadFromFile, libs, "g_bookmark_file_load_from_file", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileMoveItem, libs, "g_bookmark_file_move_item", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileRemoveApplication, libs, "g_bookmark_file_remove_application", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileRemoveGroup, libs, "g_bookmark_file_remove_group", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileRemoveItem, libs, "g_bookmark_file_remove_item", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetAddedDateTime, libs, "g_bookmark_file_set_added_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileSetApplicationInfo, libs, "g_bookmark_file_set_application_info", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileSetDescription, libs, "g_bookmark_file_set_description", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetGroups, libs, "g_bookmark_file_set_groups", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetIcon, libs, "g_bookmark_file_set_icon", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetIsPrivate, libs, "g_bookmark_file_set_is_private", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetMimeType, libs, "g_bookmark_file_set_mime_type", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetModifiedDateTime, libs, "g_bookmark_file_set_modified_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileSetTitle, libs, "g_bookmark_file_set_title", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetVisitedDateTime, libs, "g_bookmark_file_set_visited_date_time", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xBookmarkFileToData, libs, "g_bookmark_file_to_data", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileToFile, libs, "g_bookmark_file_to_file", "GLIB", "2.12")
 
 }
@@ -0,0 +1,225 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xBookmarkFileGetAdded func(uintptr, string, **Error) int
+
+// Gets the time the bookmark for uri was added to bookmark
+//
+// In the event the URI cannot be found, -1 is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
+//
+// Deprecated: since 2.66. Use [BookmarkFile.GetAddedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) GetAdded(UriVar string) (int, error) {
+	var cerr *Error
+
+	cret := xBookmarkFileGetAdded(x.GoPointer(), UriVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xBookmarkFileGetAppInfo func(uintptr, string, string, *string, *uint, *int, **Error) bool
+
+// Gets the registration information of app_name for the bookmark for
+// uri.  See [BookmarkFile.SetApplicationInfo] for more information about
+// the returned data.
+//
+// The string returned in app_exec must be freed.
+//
+// In the event the URI cannot be found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].  In the
+// event that no application with name app_name has registered a bookmark
+// for uri,  false is returned and error is set to
+// [GBookmarkFileErrorAppNotRegisteredValue]. In the event that unquoting
+// the command line fails, an error of the G_SHELL_ERROR domain is
+// set and false is returned.
+//
+// Deprecated: since 2.66. Use [BookmarkFile.GetApplicationInfo] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) GetAppInfo(UriVar string, NameVar string, ExecVar *string, CountVar *uint, StampVar *int) (bool, error) {
+	var cerr *Error
+
+	cret := xBookmarkFileGetAppInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xBookmarkFileGetModified func(uintptr, string, **Error) int
+
+// Gets the time when the bookmark for uri was last modified.
+//
+// In the event the URI cannot be found, -1 is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
+//
+// Deprecated: since 2.66. Use [BookmarkFile.GetModifiedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) GetModified(UriVar string) (int, error) {
+	var cerr *Error
+
+	cret := xBookmarkFileGetModified(x.GoPointer(), UriVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xBookmarkFileGetVisited func(uintptr, string, **Error) int
+
+// Gets the time the bookmark for uri was last visited.
+//
+// In the event the URI cannot be found, -1 is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue].
+//
+// Deprecated: since 2.66. Use [BookmarkFile.GetVisitedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) GetVisited(UriVar string) (int, error) {
+	var cerr *Error
+
+	cret := xBookmarkFileGetVisited(x.GoPointer(), UriVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xBookmarkFileSetAdded func(uintptr, string, int)
+
+// Sets the time the bookmark for uri was added into bookmark.
+//
+// If no bookmark for uri is found then it is created.
+//
+// Deprecated: since 2.66. Use [BookmarkFile.SetAddedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) SetAdded(UriVar string, AddedVar int) {
+
+	xBookmarkFileSetAdded(x.GoPointer(), UriVar, AddedVar)
+
+}
+
+var xBookmarkFileSetAppInfo func(uintptr, string, string, string, int, int, **Error) bool
+
+// Sets the meta-data of application name inside the list of
+// applications that have registered a bookmark for uri inside
+// bookmark.
+//
+// You should rarely use this function; use [BookmarkFile.AddApplication]
+// and [BookmarkFile.RemoveApplication] instead.
+//
+// name can be any UTF-8 encoded string used to identify an
+// application.
+// exec can have one of these two modifiers: "\%f", which will
+// be expanded as the local file name retrieved from the bookmark's
+// URI; "\%u", which will be expanded as the bookmark's URI.
+// The expansion is done automatically when retrieving the stored
+// command line using the [BookmarkFile.GetApplicationInfo] function.
+// count is the number of times the application has registered the
+// bookmark; if is < 0, the current registration count will be increased
+// by one, if is 0, the application with name will be removed from
+// the list of registered applications.
+// stamp is the Unix time of the last registration; if it is -1, the
+// current time will be used.
+//
+// If you try to remove an application by setting its registration count to
+// zero, and no bookmark for uri is found, false is returned and
+// error is set to [GBookmarkFileErrorUriNotFoundValue]; similarly,
+// in the event that no application name has registered a bookmark
+// for uri,  false is returned and error is set to
+// [GBookmarkFileErrorAppNotRegisteredValue].  Otherwise, if no bookmark
+// for uri is found, one is created.
+//
+// Deprecated: since 2.66. Use [BookmarkFile.SetApplicationInfo] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) SetAppInfo(UriVar string, NameVar string, ExecVar string, CountVar int, StampVar int) (bool, error) {
+	var cerr *Error
+
+	cret := xBookmarkFileSetAppInfo(x.GoPointer(), UriVar, NameVar, ExecVar, CountVar, StampVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xBookmarkFileSetModified func(uintptr, string, int)
+
+// Sets the last time the bookmark for uri was last modified.
+//
+// If no bookmark for uri is found then it is created.
+//
+// The "modified" time should only be set when the bookmark's meta-data
+// was actually changed.  Every function of [BookmarkFile] that
+// modifies a bookmark also changes the modification time, except for
+// [BookmarkFile.SetVisitedDateTime].
+//
+// Deprecated: since 2.66. Use [BookmarkFile.SetModifiedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) SetModified(UriVar string, ModifiedVar int) {
+
+	xBookmarkFileSetModified(x.GoPointer(), UriVar, ModifiedVar)
+
+}
+
+var xBookmarkFileSetVisited func(uintptr, string, int)
+
+// Sets the time the bookmark for uri was last visited.
+//
+// If no bookmark for uri is found then it is created.
+//
+// The "visited" time should only be set if the bookmark was launched,
+// either using the command line retrieved by [BookmarkFile.GetApplicationInfo]
+// or by the default application for the bookmark's MIME type, retrieved
+// using [BookmarkFile.GetMimeType].  Changing the "visited" time
+// does not affect the "modified" time.
+//
+// Deprecated: since 2.66. Use [BookmarkFile.SetVisitedDateTime] instead, as
+//
+//	`time_t` is deprecated due to the year 2038 problem.
+func (x *BookmarkFile) SetVisited(UriVar string, VisitedVar int) {
+
+	xBookmarkFileSetVisited(x.GoPointer(), UriVar, VisitedVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xBookmarkFileGetAdded, libs, "g_bookmark_file_get_added", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetAppInfo, libs, "g_bookmark_file_get_app_info", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetModified, libs, "g_bookmark_file_get_modified", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileGetVisited, libs, "g_bookmark_file_get_visited", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetAdded, libs, "g_bookmark_file_set_added", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetAppInfo, libs, "g_bookmark_file_set_app_info", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetModified, libs, "g_bookmark_file_set_modified", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xBookmarkFileSetVisited, libs, "g_bookmark_file_set_visited", "GLIB", "2.12")
+
+}
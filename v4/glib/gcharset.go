@@ -2,16 +2,15 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xGetCharset func(*string) bool
 
-// Obtains the character set for the [current locale](running.html#locale);
-// you might use this character set as an argument to g_convert(), to convert
+// Obtains the character set for the current locale;
+// you might use this character set as an argument to [Convert], to convert
 // from the current locale's encoding to some other encoding. (Frequently
-// g_locale_to_utf8() and g_locale_from_utf8() are nice shortcuts, though.)
+// [LocaleToUtf8] and [LocaleFromUtf8] are nice shortcuts, though.)
 //
 // On Windows the character set returned by this function is the
 // so-called system default ANSI code-page. That is the character set
@@ -24,10 +23,10 @@ var xGetCharset func(*string) bool
 // and `CHARSET` are queried in order. nl_langinfo() returns the C locale if
 // no locale has been loaded by setlocale().
 //
-// The return value is %TRUE if the locale's encoding is UTF-8, in that
-// case you can perhaps avoid calling g_convert().
+// The return value is true if the locale's encoding is UTF-8, in that
+// case you can perhaps avoid calling [Convert].
 //
-// The string returned in @charset is not allocated, and should not be
+// The string returned in charset is not allocated, and should not be
 // freed.
 func GetCharset(CharsetVar *string) bool {
 
@@ -51,7 +50,7 @@ var xGetConsoleCharset func(*string) bool
 // Obtains the character set used by the console attached to the process,
 // which is suitable for printing output to the terminal.
 //
-// Usually this matches the result returned by g_get_charset(), but in
+// Usually this matches the result returned by [GetCharset], but in
 // environments where the locale's character set does not match the encoding
 // of the console this function tries to guess a more suitable value instead.
 //
@@ -60,10 +59,10 @@ var xGetConsoleCharset func(*string) bool
 // If the codepage can't be determined (for example because there is no
 // console attached) UTF-8 is assumed.
 //
-// The return value is %TRUE if the locale's encoding is UTF-8, in that
-// case you can perhaps avoid calling g_convert().
+// The return value is true if the locale's encoding is UTF-8, in that
+// case you can perhaps avoid calling [Convert].
 //
-// The string returned in @charset is not allocated, and should not be
+// The string returned in charset is not allocated, and should not be
 // freed.
 func GetConsoleCharset(CharsetVar *string) bool {
 
@@ -100,10 +99,10 @@ var xGetLanguageNamesWithCategory func(string) []string
 // least desirable and always contains the default locale "C".
 //
 // This function consults the environment variables `LANGUAGE`, `LC_ALL`,
-// @category_name, and `LANG` to find the list of locales specified by the
+// category_name, and `LANG` to find the list of locales specified by the
 // user.
 //
-// g_get_language_names() returns g_get_language_names_with_category("LC_MESSAGES").
+// [GetLanguageNames] returns g_get_language_names_with_category("LC_MESSAGES").
 func GetLanguageNamesWithCategory(CategoryNameVar string) []string {
 
 	cret := xGetLanguageNamesWithCategory(CategoryNameVar)
@@ -111,28 +110,28 @@ func GetLanguageNamesWithCategory(CategoryNameVar string) []string {
 	return cret
 }
 
-var xGetLocaleVariants func(string) []string
+var xGetLocaleVariants func(string) uintptr
 
-// Returns a list of derived variants of @locale, which can be used to
+// Returns a list of derived variants of locale, which can be used to
 // e.g. construct locale-dependent filenames or search paths. The returned
 // list is sorted from most desirable to least desirable.
 // This function handles territory, charset and extra locale modifiers. See
-// [`setlocale(3)`](man:setlocale) for information about locales and their format.
+// setlocale(3) for information about locales and their format.
 //
-// @locale itself is guaranteed to be returned in the output.
+// locale itself is guaranteed to be returned in the output.
 //
-// For example, if @locale is `fr_BE`, then the returned list
-// is `fr_BE`, `fr`. If @locale is `en_GB.UTF-8@euro`, then the returned list
+// For example, if locale is `fr_BE`, then the returned list
+// is `fr_BE`, `fr`. If locale is `en_GB.UTF-8@euro`, then the returned list
 // is `en_GB.UTF-8@euro`, `en_GB.UTF-8`, `en_GB@euro`, `en_GB`, `en.UTF-8@euro`,
 // `en.UTF-8`, `en@euro`, `en`.
 //
 // If you need the list of variants for the current locale,
-// use g_get_language_names().
+// use [GetLanguageNames].
 func GetLocaleVariants(LocaleVar string) []string {
 
 	cret := xGetLocaleVariants(LocaleVar)
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 func init() {
@@ -140,18 +139,18 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xGetCharset, libs, "g_get_charset")
-	core.PuregoSafeRegister(&xGetCodeset, libs, "g_get_codeset")
-	core.PuregoSafeRegister(&xGetConsoleCharset, libs, "g_get_console_charset")
-	core.PuregoSafeRegister(&xGetLanguageNames, libs, "g_get_language_names")
-	core.PuregoSafeRegister(&xGetLanguageNamesWithCategory, libs, "g_get_language_names_with_category")
-	core.PuregoSafeRegister(&xGetLocaleVariants, libs, "g_get_locale_variants")
+	core.PuregoRegisterSince(&xGetCharset, libs, "g_get_charset", "GLIB", "")
+	core.PuregoRegisterSince(&xGetCodeset, libs, "g_get_codeset", "GLIB", "")
+	core.PuregoRegisterSince(&xGetConsoleCharset, libs, "g_get_console_charset", "GLIB", "2.62")
+	core.PuregoRegisterSince(&xGetLanguageNames, libs, "g_get_language_names", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetLanguageNamesWithCategory, libs, "g_get_language_names_with_category", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xGetLocaleVariants, libs, "g_get_locale_variants", "GLIB", "2.28")
 
 }
@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -15,17 +15,17 @@ import (
 // like MD5, SHA-1 and SHA-256. Checksums are commonly used in various
 // environments and specifications.
 //
-// To create a new `GChecksum`, use [ctor@GLib.Checksum.new]. To free
-// a `GChecksum`, use [method@GLib.Checksum.free].
+// To create a new `GChecksum`, use [NewChecksum]. To free
+// a `GChecksum`, use [Checksum.Free].
 //
 // GLib supports incremental checksums using the `GChecksum` data
-// structure, by calling [method@GLib.Checksum.update] as long as there’s data
-// available and then using [method@GLib.Checksum.get_string] or
-// [method@GLib.Checksum.get_digest] to compute the checksum and return it
+// structure, by calling [Checksum.Update] as long as there’s data
+// available and then using [Checksum.GetString] or
+// [Checksum.GetDigest] to compute the checksum and return it
 // either as a string in hexadecimal form, or as a raw sequence of bytes. To
 // compute the checksum for binary blobs and nul-terminated strings in
-// one go, use the convenience functions [func@GLib.compute_checksum_for_data]
-// and [func@GLib.compute_checksum_for_string], respectively.
+// one go, use the convenience functions [ComputeChecksumForData]
+// and [ComputeChecksumForString], respectively.
 type Checksum struct {
 	_ structs.HostLayout
 }
@@ -42,18 +42,18 @@ func (x *Checksum) GoPointer() uintptr {
 
 var xNewChecksum func(ChecksumType) *Checksum
 
-// Creates a new #GChecksum, using the checksum algorithm @checksum_type.
-// If the @checksum_type is not known, %NULL is returned.
-// A #GChecksum can be used to compute the checksum, or digest, of an
+// Creates a new [Checksum], using the checksum algorithm checksum_type.
+// If the checksum_type is not known, nil is returned.
+// A [Checksum] can be used to compute the checksum, or digest, of an
 // arbitrary binary blob, using different hashing algorithms.
 //
-// A #GChecksum works by feeding a binary blob through g_checksum_update()
+// A [Checksum] works by feeding a binary blob through [Checksum.Update]
 // until there is data to be checked; the digest can then be extracted
-// using g_checksum_get_string(), which will return the checksum as a
-// hexadecimal string; or g_checksum_get_digest(), which will return a
-// vector of raw bytes. Once either g_checksum_get_string() or
-// g_checksum_get_digest() have been called on a #GChecksum, the checksum
-// will be closed and it won't be possible to call g_checksum_update()
+// using [Checksum.GetString], which will return the checksum as a
+// hexadecimal string; or [Checksum.GetDigest], which will return a
+// vector of raw bytes. Once either [Checksum.GetString] or
+// [Checksum.GetDigest] have been called on a [Checksum], the checksum
+// will be closed and it won't be possible to call [Checksum.Update]
 // on it anymore.
 func NewChecksum(ChecksumTypeVar ChecksumType) *Checksum {
 
@@ -63,8 +63,8 @@ func NewChecksum(ChecksumTypeVar ChecksumType) *Checksum {
 
 var xChecksumCopy func(uintptr) *Checksum
 
-// Copies a #GChecksum. If @checksum has been closed, by calling
-// g_checksum_get_string() or g_checksum_get_digest(), the copied
+// Copies a [Checksum]. If checksum has been closed, by calling
+// [Checksum.GetString] or [Checksum.GetDigest], the copied
 // checksum will be closed as well.
 func (x *Checksum) Copy() *Checksum {
 
@@ -74,7 +74,7 @@ func (x *Checksum) Copy() *Checksum {
 
 var xChecksumFree func(uintptr)
 
-// Frees the memory allocated for @checksum.
+// Frees the memory allocated for checksum.
 func (x *Checksum) Free() {
 
 	xChecksumFree(x.GoPointer())
@@ -83,11 +83,11 @@ func (x *Checksum) Free() {
 
 var xChecksumGetDigest func(uintptr, []byte, uint)
 
-// Gets the digest from @checksum as a raw binary vector and places it
-// into @buffer. The size of the digest depends on the type of checksum.
+// Gets the digest from checksum as a raw binary vector and places it
+// into buffer. The size of the digest depends on the type of checksum.
 //
-// Once this function has been called, the #GChecksum is closed and can
-// no longer be updated with g_checksum_update().
+// Once this function has been called, the [Checksum] is closed and can
+// no longer be updated with [Checksum.Update].
 func (x *Checksum) GetDigest(BufferVar []byte, DigestLenVar uint) {
 
 	xChecksumGetDigest(x.GoPointer(), BufferVar, DigestLenVar)
@@ -98,8 +98,8 @@ var xChecksumGetString func(uintptr) string
 
 // Gets the digest as a hexadecimal string.
 //
-// Once this function has been called the #GChecksum can no longer be
-// updated with g_checksum_update().
+// Once this function has been called the [Checksum] can no longer be
+// updated with [Checksum.Update].
 //
 // The hexadecimal characters will be lower case.
 func (x *Checksum) GetString() string {
@@ -110,7 +110,7 @@ func (x *Checksum) GetString() string {
 
 var xChecksumReset func(uintptr)
 
-// Resets the state of the @checksum back to its initial state.
+// Resets the state of the checksum back to its initial state.
 func (x *Checksum) Reset() {
 
 	xChecksumReset(x.GoPointer())
@@ -119,19 +119,19 @@ func (x *Checksum) Reset() {
 
 var xChecksumUpdate func(uintptr, []byte, int)
 
-// Feeds @data into an existing #GChecksum. The checksum must still be
-// open, that is g_checksum_get_string() or g_checksum_get_digest() must
-// not have been called on @checksum.
+// Feeds data into an existing [Checksum]. The checksum must still be
+// open, that is [Checksum.GetString] or [Checksum.GetDigest] must
+// not have been called on checksum.
 func (x *Checksum) Update(DataVar []byte, LengthVar int) {
 
 	xChecksumUpdate(x.GoPointer(), DataVar, LengthVar)
 
 }
 
-// The hashing algorithm to be used by #GChecksum when performing the
+// The hashing algorithm to be used by [Checksum] when performing the
 // digest of some data.
 //
-// Note that the #GChecksumType enumeration may be extended at a later
+// Note that the [ChecksumType] enumeration may be extended at a later
 // date to include new hashing algorithm types.
 type ChecksumType int
 
@@ -149,9 +149,60 @@ const (
 	GChecksumSha384Value ChecksumType = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ChecksumType) String() string {
+	switch x {
+	case GChecksumMd5Value:
+		return "G_CHECKSUM_MD5"
+	case GChecksumSha1Value:
+		return "G_CHECKSUM_SHA1"
+	case GChecksumSha256Value:
+		return "G_CHECKSUM_SHA256"
+	case GChecksumSha512Value:
+		return "G_CHECKSUM_SHA512"
+	case GChecksumSha384Value:
+		return "G_CHECKSUM_SHA384"
+	}
+	return fmt.Sprintf("ChecksumType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ChecksumType) Nick() string {
+	switch x {
+	case GChecksumMd5Value:
+		return "md5"
+	case GChecksumSha1Value:
+		return "sha1"
+	case GChecksumSha256Value:
+		return "sha256"
+	case GChecksumSha512Value:
+		return "sha512"
+	case GChecksumSha384Value:
+		return "sha384"
+	}
+	return ""
+}
+
+// ChecksumTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ChecksumTypeFromString(s string) (ChecksumType, bool) {
+	switch s {
+	case "G_CHECKSUM_MD5", "md5":
+		return GChecksumMd5Value, true
+	case "G_CHECKSUM_SHA1", "sha1":
+		return GChecksumSha1Value, true
+	case "G_CHECKSUM_SHA256", "sha256":
+		return GChecksumSha256Value, true
+	case "G_CHECKSUM_SHA512", "sha512":
+		return GChecksumSha512Value, true
+	case "G_CHECKSUM_SHA384", "sha384":
+		return GChecksumSha384Value, true
+	}
+	return 0, false
+}
+
 var xChecksumTypeGetLength func(ChecksumType) int
 
-// Gets the length in bytes of digests of type @checksum_type
+// Gets the length in bytes of digests of type checksum_type
 func ChecksumTypeGetLength(ChecksumTypeVar ChecksumType) int {
 
 	cret := xChecksumTypeGetLength(ChecksumTypeVar)
@@ -161,9 +212,9 @@ func ChecksumTypeGetLength(ChecksumTypeVar ChecksumType) int {
 
 var xComputeChecksumForBytes func(ChecksumType, *Bytes) string
 
-// Computes the checksum for a binary @data. This is a
-// convenience wrapper for g_checksum_new(), g_checksum_get_string()
-// and g_checksum_free().
+// Computes the checksum for a binary data. This is a
+// convenience wrapper for [NewChecksum], [Checksum.GetString]
+// and [Checksum.Free].
 //
 // The hexadecimal string returned will be in lower case.
 func ComputeChecksumForBytes(ChecksumTypeVar ChecksumType, DataVar *Bytes) string {
@@ -175,9 +226,9 @@ func ComputeChecksumForBytes(ChecksumTypeVar ChecksumType, DataVar *Bytes) strin
 
 var xComputeChecksumForData func(ChecksumType, []byte, uint) string
 
-// Computes the checksum for a binary @data of @length. This is a
-// convenience wrapper for g_checksum_new(), g_checksum_get_string()
-// and g_checksum_free().
+// Computes the checksum for a binary data of length. This is a
+// convenience wrapper for [NewChecksum], [Checksum.GetString]
+// and [Checksum.Free].
 //
 // The hexadecimal string returned will be in lower case.
 func ComputeChecksumForData(ChecksumTypeVar ChecksumType, DataVar []byte, LengthVar uint) string {
@@ -204,27 +255,27 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xChecksumTypeGetLength, libs, "g_checksum_type_get_length")
-	core.PuregoSafeRegister(&xComputeChecksumForBytes, libs, "g_compute_checksum_for_bytes")
-	core.PuregoSafeRegister(&xComputeChecksumForData, libs, "g_compute_checksum_for_data")
-	core.PuregoSafeRegister(&xComputeChecksumForString, libs, "g_compute_checksum_for_string")
+	core.PuregoRegisterSince(&xChecksumTypeGetLength, libs, "g_checksum_type_get_length", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xComputeChecksumForBytes, libs, "g_compute_checksum_for_bytes", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xComputeChecksumForData, libs, "g_compute_checksum_for_data", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xComputeChecksumForString, libs, "g_compute_checksum_for_string", "GLIB", "2.16")
 
 	core.PuregoSafeRegister(&xChecksumGLibType, libs, "g_checksum_get_type")
 
-	core.PuregoSafeRegister(&xNewChecksum, libs, "g_checksum_new")
+	core.PuregoRegisterSince(&xNewChecksum, libs, "g_checksum_new", "GLIB", "2.16")
 
-	core.PuregoSafeRegister(&xChecksumCopy, libs, "g_checksum_copy")
-	core.PuregoSafeRegister(&xChecksumFree, libs, "g_checksum_free")
-	core.PuregoSafeRegister(&xChecksumGetDigest, libs, "g_checksum_get_digest")
-	core.PuregoSafeRegister(&xChecksumGetString, libs, "g_checksum_get_string")
-	core.PuregoSafeRegister(&xChecksumReset, libs, "g_checksum_reset")
-	core.PuregoSafeRegister(&xChecksumUpdate, libs, "g_checksum_update")
+	core.PuregoRegisterSince(&xChecksumCopy, libs, "g_checksum_copy", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xChecksumFree, libs, "g_checksum_free", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xChecksumGetDigest, libs, "g_checksum_get_digest", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xChecksumGetString, libs, "g_checksum_get_string", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xChecksumReset, libs, "g_checksum_reset", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xChecksumUpdate, libs, "g_checksum_update", "GLIB", "2.16")
 
 }
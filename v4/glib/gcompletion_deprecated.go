@@ -0,0 +1,139 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xCompletionAddItems func(uintptr, *List)
+
+// Adds items to the [Completion].
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) AddItems(ItemsVar *List) {
+
+	xCompletionAddItems(x.GoPointer(), ItemsVar)
+
+}
+
+var xCompletionClearItems func(uintptr)
+
+// Removes all items from the [Completion]. The items are not freed, so if the
+// memory was dynamically allocated, it should be freed after calling this
+// function.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) ClearItems() {
+
+	xCompletionClearItems(x.GoPointer())
+
+}
+
+var xCompletionComplete func(uintptr, string, string) *List
+
+// Attempts to complete the string prefix using the [Completion]
+// target items.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) Complete(PrefixVar string, NewPrefixVar string) *List {
+
+	cret := xCompletionComplete(x.GoPointer(), PrefixVar, NewPrefixVar)
+	return cret
+}
+
+var xCompletionCompleteUtf8 func(uintptr, string, string) *List
+
+// Attempts to complete the string prefix using the [Completion] target items.
+// In contrast to [Completion.Complete], this function returns the largest common
+// prefix that is a valid UTF-8 string, omitting a possible common partial
+// character.
+//
+// You should use this function instead of [Completion.Complete] if your
+// items are UTF-8 strings.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) CompleteUtf8(PrefixVar string, NewPrefixVar string) *List {
+
+	cret := xCompletionCompleteUtf8(x.GoPointer(), PrefixVar, NewPrefixVar)
+	return cret
+}
+
+var xCompletionFree func(uintptr)
+
+// Frees all memory used by the [Completion]. The items are not freed, so if
+// the memory was dynamically allocated, it should be freed after calling this
+// function.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) Free() {
+
+	xCompletionFree(x.GoPointer())
+
+}
+
+var xCompletionRemoveItems func(uintptr, *List)
+
+// Removes items from a [Completion]. The items are not freed, so if the memory
+// was dynamically allocated, free items with g_list_free_full() after calling
+// this function.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) RemoveItems(ItemsVar *List) {
+
+	xCompletionRemoveItems(x.GoPointer(), ItemsVar)
+
+}
+
+var xCompletionSetCompare func(uintptr, uintptr)
+
+// Sets the function to use for string comparisons. The default string
+// comparison function is strncmp().
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Completion) SetCompare(StrncmpFuncVar *CompletionStrncmpFunc) {
+
+	var StrncmpFuncVarRef uintptr
+	if StrncmpFuncVar != nil {
+		StrncmpFuncVarPtr := uintptr(unsafe.Pointer(StrncmpFuncVar))
+		if cbRefPtr, ok := GetCallback(StrncmpFuncVarPtr); ok {
+			StrncmpFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uint) int {
+				defer core.RecoverPanic()
+				cbFn := *StrncmpFuncVar
+				return cbFn(core.GoString(arg0), core.GoString(arg1), arg2)
+			}
+			StrncmpFuncVarRef = core.NewCallback(fcb)
+			StrncmpFuncVarRef = SaveCallbackWithClosure(StrncmpFuncVarPtr, StrncmpFuncVarRef, StrncmpFuncVar)
+		}
+	}
+
+	xCompletionSetCompare(x.GoPointer(), StrncmpFuncVarRef)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCompletionAddItems, libs, "g_completion_add_items", "GLIB", "")
+	core.PuregoRegisterSince(&xCompletionClearItems, libs, "g_completion_clear_items", "GLIB", "")
+	core.PuregoRegisterSince(&xCompletionComplete, libs, "g_completion_complete", "GLIB", "")
+	core.PuregoRegisterSince(&xCompletionCompleteUtf8, libs, "g_completion_complete_utf8", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xCompletionFree, libs, "g_completion_free", "GLIB", "")
+	core.PuregoRegisterSince(&xCompletionRemoveItems, libs, "g_completion_remove_items", "GLIB", "")
+	core.PuregoRegisterSince(&xCompletionSetCompare, libs, "g_completion_set_compare", "GLIB", "")
+
+}
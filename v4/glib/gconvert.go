@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -25,17 +25,17 @@ var xIConvGIconv func(uintptr, string, uint, string, uint) uint
 // may be implemented via libiconv on UNIX flavors that lack
 // a native implementation.
 //
-// GLib provides g_convert() and g_locale_to_utf8() which are likely
+// GLib provides [Convert] and [LocaleToUtf8] which are likely
 // more convenient than the raw iconv wrappers.
 //
 // Note that the behaviour of iconv() for characters which are valid in the
 // input character set, but which have no representation in the output character
 // set, is implementation defined. This function may return success (with a
 // positive number of non-reversible conversions as replacement characters were
-// used), or it may return -1 and set an error such as %EILSEQ, in such a
+// used), or it may return -1 and set an error such as EILSEQ, in such a
 // situation.
 //
-// See [`iconv(3posix)`](man:iconv(3posix)) and [`iconv(3)`](man:iconv(3)) for more details about behavior when an
+// See iconv(3posix)) and iconv(3)) for more details about behavior when an
 // error occurs.
 func (x *IConv) GIconv(InbufVar string, InbytesLeftVar uint, OutbufVar string, OutbytesLeftVar uint) uint {
 
@@ -48,10 +48,10 @@ var xIConvClose func(uintptr) int
 // Same as the standard UNIX routine iconv_close(), but
 // may be implemented via libiconv on UNIX flavors that lack
 // a native implementation. Should be called to clean up
-// the conversion descriptor from g_iconv_open() when
+// the conversion descriptor from [IConvOpen] when
 // you are done converting things.
 //
-// GLib provides g_convert() and g_locale_to_utf8() which are likely
+// GLib provides [Convert] and [LocaleToUtf8] which are likely
 // more convenient than the raw iconv wrappers.
 func (x *IConv) Close() int {
 
@@ -87,29 +87,126 @@ const (
 	GConvertErrorEmbeddedNulValue ConvertError = 7
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ConvertError) ErrorDomain() string {
+	return "g_convert_error"
+}
+
+// Error returns the C name of the error code
+func (x ConvertError) Error() string {
+	switch x {
+	case GConvertErrorNoConversionValue:
+		return "G_CONVERT_ERROR_NO_CONVERSION"
+	case GConvertErrorIllegalSequenceValue:
+		return "G_CONVERT_ERROR_ILLEGAL_SEQUENCE"
+	case GConvertErrorFailedValue:
+		return "G_CONVERT_ERROR_FAILED"
+	case GConvertErrorPartialInputValue:
+		return "G_CONVERT_ERROR_PARTIAL_INPUT"
+	case GConvertErrorBadUriValue:
+		return "G_CONVERT_ERROR_BAD_URI"
+	case GConvertErrorNotAbsolutePathValue:
+		return "G_CONVERT_ERROR_NOT_ABSOLUTE_PATH"
+	case GConvertErrorNoMemoryValue:
+		return "G_CONVERT_ERROR_NO_MEMORY"
+	case GConvertErrorEmbeddedNulValue:
+		return "G_CONVERT_ERROR_EMBEDDED_NUL"
+	}
+	return fmt.Sprintf("g_convert_error code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ConvertError) String() string {
+	switch x {
+	case GConvertErrorNoConversionValue:
+		return "G_CONVERT_ERROR_NO_CONVERSION"
+	case GConvertErrorIllegalSequenceValue:
+		return "G_CONVERT_ERROR_ILLEGAL_SEQUENCE"
+	case GConvertErrorFailedValue:
+		return "G_CONVERT_ERROR_FAILED"
+	case GConvertErrorPartialInputValue:
+		return "G_CONVERT_ERROR_PARTIAL_INPUT"
+	case GConvertErrorBadUriValue:
+		return "G_CONVERT_ERROR_BAD_URI"
+	case GConvertErrorNotAbsolutePathValue:
+		return "G_CONVERT_ERROR_NOT_ABSOLUTE_PATH"
+	case GConvertErrorNoMemoryValue:
+		return "G_CONVERT_ERROR_NO_MEMORY"
+	case GConvertErrorEmbeddedNulValue:
+		return "G_CONVERT_ERROR_EMBEDDED_NUL"
+	}
+	return fmt.Sprintf("ConvertError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConvertError) Nick() string {
+	switch x {
+	case GConvertErrorNoConversionValue:
+		return "no-conversion"
+	case GConvertErrorIllegalSequenceValue:
+		return "illegal-sequence"
+	case GConvertErrorFailedValue:
+		return "failed"
+	case GConvertErrorPartialInputValue:
+		return "partial-input"
+	case GConvertErrorBadUriValue:
+		return "bad-uri"
+	case GConvertErrorNotAbsolutePathValue:
+		return "not-absolute-path"
+	case GConvertErrorNoMemoryValue:
+		return "no-memory"
+	case GConvertErrorEmbeddedNulValue:
+		return "embedded-nul"
+	}
+	return ""
+}
+
+// ConvertErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConvertErrorFromString(s string) (ConvertError, bool) {
+	switch s {
+	case "G_CONVERT_ERROR_NO_CONVERSION", "no-conversion":
+		return GConvertErrorNoConversionValue, true
+	case "G_CONVERT_ERROR_ILLEGAL_SEQUENCE", "illegal-sequence":
+		return GConvertErrorIllegalSequenceValue, true
+	case "G_CONVERT_ERROR_FAILED", "failed":
+		return GConvertErrorFailedValue, true
+	case "G_CONVERT_ERROR_PARTIAL_INPUT", "partial-input":
+		return GConvertErrorPartialInputValue, true
+	case "G_CONVERT_ERROR_BAD_URI", "bad-uri":
+		return GConvertErrorBadUriValue, true
+	case "G_CONVERT_ERROR_NOT_ABSOLUTE_PATH", "not-absolute-path":
+		return GConvertErrorNotAbsolutePathValue, true
+	case "G_CONVERT_ERROR_NO_MEMORY", "no-memory":
+		return GConvertErrorNoMemoryValue, true
+	case "G_CONVERT_ERROR_EMBEDDED_NUL", "embedded-nul":
+		return GConvertErrorEmbeddedNulValue, true
+	}
+	return 0, false
+}
+
 var xConvert func([]byte, int, string, string, *uint, *uint, **Error) uintptr
 
 // Converts a string from one character set to another.
 //
-// Note that you should use g_iconv() for streaming conversions.
-// Despite the fact that @bytes_read can return information about partial
+// Note that you should use [Iconv] for streaming conversions.
+// Despite the fact that bytes_read can return information about partial
 // characters, the g_convert_... functions are not generally suitable
 // for streaming. If the underlying converter maintains internal state,
-// then this won't be preserved across successive calls to g_convert(),
-// g_convert_with_iconv() or g_convert_with_fallback(). (An example of
+// then this won't be preserved across successive calls to [Convert],
+// [ConvertWithIconv] or [ConvertWithFallback]. (An example of
 // this is the GNU C converter for CP1255 which does not emit a base
 // character until it knows that the next character is not a mark that
 // could combine with the base character.)
 //
 // Using extensions such as "//TRANSLIT" may not work (or may not work
-// well) on many platforms.  Consider using g_str_to_ascii() instead.
+// well) on many platforms.  Consider using [StrToAscii] instead.
 func Convert(StrVar []byte, LenVar int, ToCodesetVar string, FromCodesetVar string, BytesReadVar *uint, BytesWrittenVar *uint) (uintptr, error) {
 	var cerr *Error
 
 	cret := xConvert(StrVar, LenVar, ToCodesetVar, FromCodesetVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -120,17 +217,17 @@ var xConvertWithFallback func([]byte, int, string, string, string, *uint, *uint,
 // Converts a string from one character set to another, possibly
 // including fallback sequences for characters not representable
 // in the output. Note that it is not guaranteed that the specification
-// for the fallback sequences in @fallback will be honored. Some
-// systems may do an approximate conversion from @from_codeset
-// to @to_codeset in their iconv() functions,
+// for the fallback sequences in fallback will be honored. Some
+// systems may do an approximate conversion from from_codeset
+// to to_codeset in their iconv() functions,
 // in which case GLib will simply return that approximate conversion.
 //
-// Note that you should use g_iconv() for streaming conversions.
-// Despite the fact that @bytes_read can return information about partial
+// Note that you should use [Iconv] for streaming conversions.
+// Despite the fact that bytes_read can return information about partial
 // characters, the g_convert_... functions are not generally suitable
 // for streaming. If the underlying converter maintains internal state,
-// then this won't be preserved across successive calls to g_convert(),
-// g_convert_with_iconv() or g_convert_with_fallback(). (An example of
+// then this won't be preserved across successive calls to [Convert],
+// [ConvertWithIconv] or [ConvertWithFallback]. (An example of
 // this is the GNU C converter for CP1255 which does not emit a base
 // character until it knows that the next character is not a mark that
 // could combine with the base character.)
@@ -140,7 +237,7 @@ func ConvertWithFallback(StrVar []byte, LenVar int, ToCodesetVar string, FromCod
 	cret := xConvertWithFallback(StrVar, LenVar, ToCodesetVar, FromCodesetVar, FallbackVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -150,30 +247,30 @@ var xConvertWithIconv func([]byte, int, uintptr, *uint, *uint, **Error) uintptr
 
 // Converts a string from one character set to another.
 //
-// Note that you should use g_iconv() for streaming conversions.
-// Despite the fact that @bytes_read can return information about partial
+// Note that you should use [Iconv] for streaming conversions.
+// Despite the fact that bytes_read can return information about partial
 // characters, the g_convert_... functions are not generally suitable
 // for streaming. If the underlying converter maintains internal state,
-// then this won't be preserved across successive calls to g_convert(),
-// g_convert_with_iconv() or g_convert_with_fallback(). (An example of
+// then this won't be preserved across successive calls to [Convert],
+// [ConvertWithIconv] or [ConvertWithFallback]. (An example of
 // this is the GNU C converter for CP1255 which does not emit a base
 // character until it knows that the next character is not a mark that
 // could combine with the base character.)
 //
 // Characters which are valid in the input character set, but which have no
 // representation in the output character set will result in a
-// %G_CONVERT_ERROR_ILLEGAL_SEQUENCE error. This is in contrast to the iconv()
+// [GConvertErrorIllegalSequenceValue] error. This is in contrast to the iconv()
 // specification, which leaves this behaviour implementation defined. Note that
 // this is the same error code as is returned for an invalid byte sequence in
 // the input character set. To get defined behaviour for conversion of
-// unrepresentable characters, use g_convert_with_fallback().
+// unrepresentable characters, use [ConvertWithFallback].
 func ConvertWithIconv(StrVar []byte, LenVar int, ConverterVar uintptr, BytesReadVar *uint, BytesWrittenVar *uint) (uintptr, error) {
 	var cerr *Error
 
 	cret := xConvertWithIconv(StrVar, LenVar, ConverterVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -186,16 +283,16 @@ var xFilenameDisplayBasename func(string) string
 // for instance there might be problems converting it to UTF-8, and some files
 // can be translated in the display.
 //
-// If GLib cannot make sense of the encoding of @filename, as a last resort it
+// If GLib cannot make sense of the encoding of filename, as a last resort it
 // replaces unknown characters with U+FFFD, the Unicode replacement character.
 // You can search the result for the UTF-8 encoding of this character (which is
-// "\357\277\275" in octal notation) to find out if @filename was in an invalid
+// "\357\277\275" in octal notation) to find out if filename was in an invalid
 // encoding.
 //
 // You must pass the whole absolute pathname to this functions so that
 // translation of well known locations can be done.
 //
-// This function is preferred over g_filename_display_name() if you know the
+// This function is preferred over [FilenameDisplayName] if you know the
 // whole path, as it allows translation.
 func FilenameDisplayBasename(FilenameVar string) string {
 
@@ -209,17 +306,17 @@ var xFilenameDisplayName func(string) string
 // Converts a filename into a valid UTF-8 string. The conversion is
 // not necessarily reversible, so you should keep the original around
 // and use the return value of this function only for display purposes.
-// Unlike g_filename_to_utf8(), the result is guaranteed to be non-%NULL
+// Unlike [FilenameToUtf8], the result is guaranteed to be non-nil
 // even if the filename actually isn't in the GLib file name encoding.
 //
-// If GLib cannot make sense of the encoding of @filename, as a last resort it
+// If GLib cannot make sense of the encoding of filename, as a last resort it
 // replaces unknown characters with U+FFFD, the Unicode replacement character.
 // You can search the result for the UTF-8 encoding of this character (which is
-// "\357\277\275" in octal notation) to find out if @filename was in an invalid
+// "\357\277\275" in octal notation) to find out if filename was in an invalid
 // encoding.
 //
 // If you know the whole pathname of the file you should use
-// g_filename_display_basename(), since that allows location-based
+// [FilenameDisplayBasename], since that allows location-based
 // translation of filenames.
 func FilenameDisplayName(FilenameVar string) string {
 
@@ -243,7 +340,7 @@ func FilenameFromUri(UriVar string, HostnameVar *string) (string, error) {
 	cret := xFilenameFromUri(UriVar, HostnameVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -254,20 +351,20 @@ var xFilenameFromUtf8 func(string, int, *uint, *uint, **Error) string
 // Converts a string from UTF-8 to the encoding GLib uses for
 // filenames. Note that on Windows GLib uses UTF-8 for filenames;
 // on other platforms, this function indirectly depends on the
-// [current locale](running.html#locale).
+// current locale.
 //
-// The input string shall not contain nul characters even if the @len
+// The input string shall not contain nul characters even if the len
 // argument is positive. A nul character found inside the string will result
-// in error %G_CONVERT_ERROR_ILLEGAL_SEQUENCE. If the filename encoding is
+// in error [GConvertErrorIllegalSequenceValue]. If the filename encoding is
 // not UTF-8 and the conversion output contains a nul character, the error
-// %G_CONVERT_ERROR_EMBEDDED_NUL is set and the function returns %NULL.
+// [GConvertErrorEmbeddedNulValue] is set and the function returns nil.
 func FilenameFromUtf8(Utf8stringVar string, LenVar int, BytesReadVar *uint, BytesWrittenVar *uint) (string, error) {
 	var cerr *Error
 
 	cret := xFilenameFromUtf8(Utf8stringVar, LenVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -286,7 +383,7 @@ func FilenameToUri(FilenameVar string, HostnameVar *string) (string, error) {
 	cret := xFilenameToUri(FilenameVar, HostnameVarPtr, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -297,14 +394,14 @@ var xFilenameToUtf8 func(string, int, *uint, *uint, **Error) string
 // Converts a string which is in the encoding used by GLib for
 // filenames into a UTF-8 string. Note that on Windows GLib uses UTF-8
 // for filenames; on other platforms, this function indirectly depends on
-// the [current locale](running.html#locale).
+// the current locale.
 //
-// The input string shall not contain nul characters even if the @len
+// The input string shall not contain nul characters even if the len
 // argument is positive. A nul character found inside the string will result
-// in error %G_CONVERT_ERROR_ILLEGAL_SEQUENCE.
+// in error [GConvertErrorIllegalSequenceValue].
 // If the source encoding is not UTF-8 and the conversion output contains a
-// nul character, the error %G_CONVERT_ERROR_EMBEDDED_NUL is set and the
-// function returns %NULL. Use g_convert() to produce output that
+// nul character, the error [GConvertErrorEmbeddedNulValue] is set and the
+// function returns nil. Use [Convert] to produce output that
 // may contain embedded nul characters.
 func FilenameToUtf8(OpsysstringVar string, LenVar int, BytesReadVar *uint, BytesWrittenVar *uint) (string, error) {
 	var cerr *Error
@@ -312,7 +409,7 @@ func FilenameToUtf8(OpsysstringVar string, LenVar int, BytesReadVar *uint, Bytes
 	cret := xFilenameToUtf8(OpsysstringVar, LenVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -321,9 +418,9 @@ func FilenameToUtf8(OpsysstringVar string, LenVar int, BytesReadVar *uint, Bytes
 var xGetFilenameCharsets func(*[]string) bool
 
 // Determines the preferred character sets used for filenames.
-// The first character set from the @charsets is the filename encoding, the
+// The first character set from the charsets is the filename encoding, the
 // subsequent character sets are used when trying to generate a displayable
-// representation of a filename, see g_filename_display_name().
+// representation of a filename, see [FilenameDisplayName].
 //
 // On Unix, the character sets are determined by consulting the
 // environment variables `G_FILENAME_ENCODING` and `G_BROKEN_FILENAMES`.
@@ -331,15 +428,15 @@ var xGetFilenameCharsets func(*[]string) bool
 // and said environment variables have no effect.
 //
 // `G_FILENAME_ENCODING` may be set to a comma-separated list of
-// character set names. The special token `@locale` is taken to mean the
-// character set for the [current locale](running.html#locale).
+// character set names. The special token `locale` is taken to mean the
+// character set for the current locale.
 // If `G_FILENAME_ENCODING` is not set, but `G_BROKEN_FILENAMES` is,
 // the character set of the current locale is taken as the filename
 // encoding. If neither environment variable  is set, UTF-8 is taken
 // as the filename encoding, but the character set of the current locale
 // is also put in the list of encodings.
 //
-// The returned @charsets belong to GLib and must not be freed.
+// The returned charsets belong to GLib and must not be freed.
 //
 // Note that on Unix, regardless of the locale character set or
 // `G_FILENAME_ENCODING` value, the actual file names present
@@ -357,17 +454,17 @@ var xIconv func(uintptr, string, uint, string, uint) uint
 // may be implemented via libiconv on UNIX flavors that lack
 // a native implementation.
 //
-// GLib provides g_convert() and g_locale_to_utf8() which are likely
+// GLib provides [Convert] and [LocaleToUtf8] which are likely
 // more convenient than the raw iconv wrappers.
 //
 // Note that the behaviour of iconv() for characters which are valid in the
 // input character set, but which have no representation in the output character
 // set, is implementation defined. This function may return success (with a
 // positive number of non-reversible conversions as replacement characters were
-// used), or it may return -1 and set an error such as %EILSEQ, in such a
+// used), or it may return -1 and set an error such as EILSEQ, in such a
 // situation.
 //
-// See [`iconv(3posix)`](man:iconv(3posix)) and [`iconv(3)`](man:iconv(3)) for more details about behavior when an
+// See iconv(3posix)) and iconv(3)) for more details about behavior when an
 // error occurs.
 func Iconv(ConverterVar uintptr, InbufVar string, InbytesLeftVar uint, OutbufVar string, OutbytesLeftVar uint) uint {
 
@@ -376,31 +473,43 @@ func Iconv(ConverterVar uintptr, InbufVar string, InbytesLeftVar uint, OutbufVar
 	return cret
 }
 
-var xIconvOpen func(string, string) uintptr
+var xIConvOpen func(string, string) uintptr
 
 // Same as the standard UNIX routine iconv_open(), but
 // may be implemented via libiconv on UNIX flavors that lack
 // a native implementation.
 //
-// GLib provides g_convert() and g_locale_to_utf8() which are likely
+// GLib provides [Convert] and [LocaleToUtf8] which are likely
 // more convenient than the raw iconv wrappers.
-func IconvOpen(ToCodesetVar string, FromCodesetVar string) uintptr {
+func IConvOpen(ToCodesetVar string, FromCodesetVar string) uintptr {
 
-	cret := xIconvOpen(ToCodesetVar, FromCodesetVar)
+	cret := xIConvOpen(ToCodesetVar, FromCodesetVar)
 
 	return cret
 }
 
+// Same as the standard UNIX routine iconv_open(), but
+// may be implemented via libiconv on UNIX flavors that lack
+// a native implementation.
+//
+// GLib provides [Convert] and [LocaleToUtf8] which are likely
+// more convenient than the raw iconv wrappers.
+//
+// Deprecated: use IConvOpen, this name of g_iconv_open is kept for compatibility.
+func IconvOpen(ToCodesetVar string, FromCodesetVar string) uintptr {
+	return IConvOpen(ToCodesetVar, FromCodesetVar)
+}
+
 var xLocaleFromUtf8 func(string, int, *uint, *uint, **Error) uintptr
 
 // Converts a string from UTF-8 to the encoding used for strings by
 // the C runtime (usually the same as that used by the operating
-// system) in the [current locale](running.html#locale).
+// system) in the current locale.
 // On Windows this means the system codepage.
 //
-// The input string shall not contain nul characters even if the @len
+// The input string shall not contain nul characters even if the len
 // argument is positive. A nul character found inside the string will result
-// in error %G_CONVERT_ERROR_ILLEGAL_SEQUENCE. Use g_convert() to convert
+// in error [GConvertErrorIllegalSequenceValue]. Use [Convert] to convert
 // input that may contain embedded nul characters.
 func LocaleFromUtf8(Utf8stringVar string, LenVar int, BytesReadVar *uint, BytesWrittenVar *uint) (uintptr, error) {
 	var cerr *Error
@@ -408,7 +517,7 @@ func LocaleFromUtf8(Utf8stringVar string, LenVar int, BytesReadVar *uint, BytesW
 	cret := xLocaleFromUtf8(Utf8stringVar, LenVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -418,14 +527,14 @@ var xLocaleToUtf8 func([]byte, int, *uint, *uint, **Error) string
 
 // Converts a string which is in the encoding used for strings by
 // the C runtime (usually the same as that used by the operating
-// system) in the [current locale](running.html#locale) into a UTF-8 string.
+// system) in the current locale into a UTF-8 string.
 //
 // If the source encoding is not UTF-8 and the conversion output contains a
-// nul character, the error %G_CONVERT_ERROR_EMBEDDED_NUL is set and the
-// function returns %NULL.
+// nul character, the error [GConvertErrorEmbeddedNulValue] is set and the
+// function returns nil.
 // If the source encoding is UTF-8, an embedded nul character is treated with
-// the %G_CONVERT_ERROR_ILLEGAL_SEQUENCE error for backward compatibility with
-// earlier versions of this library. Use g_convert() to produce output that
+// the [GConvertErrorIllegalSequenceValue] error for backward compatibility with
+// earlier versions of this library. Use [Convert] to produce output that
 // may contain embedded nul characters.
 func LocaleToUtf8(OpsysstringVar []byte, LenVar int, BytesReadVar *uint, BytesWrittenVar *uint) (string, error) {
 	var cerr *Error
@@ -433,13 +542,13 @@ func LocaleToUtf8(OpsysstringVar []byte, LenVar int, BytesReadVar *uint, BytesWr
 	cret := xLocaleToUtf8(OpsysstringVar, LenVar, BytesReadVar, BytesWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xUriListExtractUris func(string) []string
+var xUriListExtractUris func(string) uintptr
 
 // Splits an URI list conforming to the text/uri-list
 // mime type defined in RFC 2483 into individual URIs,
@@ -448,7 +557,7 @@ func UriListExtractUris(UriListVar string) []string {
 
 	cret := xUriListExtractUris(UriListVar)
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 func init() {
@@ -456,30 +565,30 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xConvert, libs, "g_convert")
-	core.PuregoSafeRegister(&xConvertWithFallback, libs, "g_convert_with_fallback")
-	core.PuregoSafeRegister(&xConvertWithIconv, libs, "g_convert_with_iconv")
-	core.PuregoSafeRegister(&xFilenameDisplayBasename, libs, "g_filename_display_basename")
-	core.PuregoSafeRegister(&xFilenameDisplayName, libs, "g_filename_display_name")
-	core.PuregoSafeRegister(&xFilenameFromUri, libs, "g_filename_from_uri")
-	core.PuregoSafeRegister(&xFilenameFromUtf8, libs, "g_filename_from_utf8")
-	core.PuregoSafeRegister(&xFilenameToUri, libs, "g_filename_to_uri")
-	core.PuregoSafeRegister(&xFilenameToUtf8, libs, "g_filename_to_utf8")
-	core.PuregoSafeRegister(&xGetFilenameCharsets, libs, "g_get_filename_charsets")
-	core.PuregoSafeRegister(&xIconv, libs, "g_iconv")
-	core.PuregoSafeRegister(&xIconvOpen, libs, "g_iconv_open")
-	core.PuregoSafeRegister(&xLocaleFromUtf8, libs, "g_locale_from_utf8")
-	core.PuregoSafeRegister(&xLocaleToUtf8, libs, "g_locale_to_utf8")
-	core.PuregoSafeRegister(&xUriListExtractUris, libs, "g_uri_list_extract_uris")
-
-	core.PuregoSafeRegister(&xIConvGIconv, libs, "g_iconv")
-	core.PuregoSafeRegister(&xIConvClose, libs, "g_iconv_close")
+	core.PuregoRegisterSince(&xConvert, libs, "g_convert", "GLIB", "")
+	core.PuregoRegisterSince(&xConvertWithFallback, libs, "g_convert_with_fallback", "GLIB", "")
+	core.PuregoRegisterSince(&xConvertWithIconv, libs, "g_convert_with_iconv", "GLIB", "")
+	core.PuregoRegisterSince(&xFilenameDisplayBasename, libs, "g_filename_display_basename", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xFilenameDisplayName, libs, "g_filename_display_name", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xFilenameFromUri, libs, "g_filename_from_uri", "GLIB", "")
+	core.PuregoRegisterSince(&xFilenameFromUtf8, libs, "g_filename_from_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xFilenameToUri, libs, "g_filename_to_uri", "GLIB", "")
+	core.PuregoRegisterSince(&xFilenameToUtf8, libs, "g_filename_to_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xGetFilenameCharsets, libs, "g_get_filename_charsets", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xIconv, libs, "g_iconv", "GLIB", "")
+	core.PuregoRegisterSince(&xIConvOpen, libs, "g_iconv_open", "GLIB", "")
+	core.PuregoRegisterSince(&xLocaleFromUtf8, libs, "g_locale_from_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xLocaleToUtf8, libs, "g_locale_to_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xUriListExtractUris, libs, "g_uri_list_extract_uris", "GLIB", "2.6")
+
+	core.PuregoRegisterSince(&xIConvGIconv, libs, "g_iconv", "GLIB", "")
+	core.PuregoRegisterSince(&xIConvClose, libs, "g_iconv_close", "GLIB", "")
 
 }
@@ -5,24 +5,23 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// Specifies the type of function passed to g_dataset_foreach(). It is
-// called with each #GQuark id and associated data element, together
-// with the @user_data parameter supplied to g_dataset_foreach().
+// Specifies the type of function passed to [DatasetForeach]. It is
+// called with each [Quark] id and associated data element, together
+// with the user_data parameter supplied to [DatasetForeach].
 type DataForeachFunc func(Quark, uintptr, uintptr)
 
 // The type of functions that are used to 'duplicate' an object.
 // What this means depends on the context, it could just be
-// incrementing the reference count, if @data is a ref-counted
+// incrementing the reference count, if data is a ref-counted
 // object.
 type DuplicateFunc func(uintptr, uintptr) uintptr
 
 // An opaque data structure that represents a keyed data list.
 //
-// See also: [Keyed data lists](datalist-and-dataset.html).
+// See also: Keyed data lists.
 type Data struct {
 	_ structs.HostLayout
 }
@@ -33,8 +32,8 @@ func (x *Data) GoPointer() uintptr {
 
 const (
 	// A bitmask that restricts the possible flags passed to
-	// g_datalist_set_flags(). Passing a flags value where
-	// flags &amp; ~G_DATALIST_FLAGS_MASK != 0 is an error.
+	// [DatalistSetFlags]. Passing a flags value where
+	// flags & ~G_DATALIST_FLAGS_MASK != 0 is an error.
 	DATALIST_FLAGS_MASK int = 3
 )
 
@@ -52,14 +51,14 @@ func DatalistClear(DatalistVar **Data) {
 var xDatalistForeach func(**Data, uintptr, uintptr)
 
 // Calls the given function for each data element of the datalist. The
-// function is called with each data element's #GQuark id and data,
-// together with the given @user_data parameter. Note that this
-// function is NOT thread-safe. So unless @datalist can be protected
+// function is called with each data element's [Quark] id and data,
+// together with the given user_data parameter. Note that this
+// function is NOT thread-safe. So unless datalist can be protected
 // from any modifications during invocation of this function, it should
 // not be called.
 //
-// @func can make changes to @datalist, but the iteration will not
-// reflect changes made during the g_datalist_foreach() call, other
+// func can make changes to datalist, but the iteration will not
+// reflect changes made during the [DatalistForeach] call, other
 // than skipping over elements that are removed.
 func DatalistForeach(DatalistVar **Data, FuncVar *DataForeachFunc, UserDataVar uintptr) {
 
@@ -70,11 +69,12 @@ func DatalistForeach(DatalistVar **Data, FuncVar *DataForeachFunc, UserDataVar u
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 Quark, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -85,7 +85,7 @@ func DatalistForeach(DatalistVar **Data, FuncVar *DataForeachFunc, UserDataVar u
 var xDatalistGetData func(**Data, string) uintptr
 
 // Gets a data element, using its string identifier. This is slower than
-// g_datalist_id_get_data() because it compares strings.
+// [DatalistIdGetData] because it compares strings.
 func DatalistGetData(DatalistVar **Data, KeyVar string) uintptr {
 
 	cret := xDatalistGetData(DatalistVar, KeyVar)
@@ -96,7 +96,7 @@ func DatalistGetData(DatalistVar **Data, KeyVar string) uintptr {
 var xDatalistGetFlags func(**Data) uint
 
 // Gets flags values packed in together with the datalist.
-// See g_datalist_set_flags().
+// See [DatalistSetFlags].
 func DatalistGetFlags(DatalistVar **Data) uint {
 
 	cret := xDatalistGetFlags(DatalistVar)
@@ -106,15 +106,15 @@ func DatalistGetFlags(DatalistVar **Data) uint {
 
 var xDatalistIdDupData func(**Data, Quark, uintptr, uintptr) uintptr
 
-// This is a variant of g_datalist_id_get_data() which
-// returns a 'duplicate' of the value. @dup_func defines the
+// This is a variant of [DatalistIdGetData] which
+// returns a 'duplicate' of the value. dup_func defines the
 // meaning of 'duplicate' in this context, it could e.g.
 // take a reference on a ref-counted object.
 //
-// If the @key_id is not set in the datalist then @dup_func
-// will be called with a %NULL argument.
+// If the key_id is not set in the datalist then dup_func
+// will be called with a nil argument.
 //
-// Note that @dup_func is called while the datalist is locked, so it
+// Note that dup_func is called while the datalist is locked, so it
 // is not allowed to read or modify the datalist.
 //
 // This function can be useful to avoid races when multiple
@@ -128,11 +128,12 @@ func DatalistIdDupData(DatalistVar **Data, KeyIdVar Quark, DupFuncVar *Duplicate
 			DupFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *DupFuncVar
 				return cbFn(arg0, arg1)
 			}
-			DupFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
+			DupFuncVarRef = core.NewCallback(fcb)
+			DupFuncVarRef = SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
 		}
 	}
 
@@ -143,7 +144,7 @@ func DatalistIdDupData(DatalistVar **Data, KeyIdVar Quark, DupFuncVar *Duplicate
 
 var xDatalistIdGetData func(**Data, Quark) uintptr
 
-// Retrieves the data element corresponding to @key_id.
+// Retrieves the data element corresponding to key_id.
 func DatalistIdGetData(DatalistVar **Data, KeyIdVar Quark) uintptr {
 
 	cret := xDatalistIdGetData(DatalistVar, KeyIdVar)
@@ -158,7 +159,7 @@ var xDatalistIdRemoveMultiple func(**Data, []Quark, uint)
 // This is more efficient than calling g_datalist_id_remove_data()
 // multiple times in a row.
 //
-// Before 2.80, @n_keys had to be not larger than 16.
+// Before 2.80, n_keys had to be not larger than 16.
 // Since 2.84, performance is improved for larger number of keys.
 func DatalistIdRemoveMultiple(DatalistVar **Data, KeysVar []Quark, NKeysVar uint) {
 
@@ -179,18 +180,18 @@ func DatalistIdRemoveNoNotify(DatalistVar **Data, KeyIdVar Quark) uintptr {
 
 var xDatalistIdReplaceData func(**Data, Quark, uintptr, uintptr, uintptr, *DestroyNotify) bool
 
-// Compares the member that is associated with @key_id in
-// @datalist to @oldval, and if they are the same, replace
-// @oldval with @newval.
+// Compares the member that is associated with key_id in
+// datalist to oldval, and if they are the same, replace
+// oldval with newval.
 //
 // This is like a typical atomic compare-and-exchange
-// operation, for a member of @datalist.
+// operation, for a member of datalist.
 //
 // If the previous value was replaced then ownership of the
-// old value (@oldval) is passed to the caller, including
-// the registered destroy notify for it (passed out in @old_destroy).
+// old value (oldval) is passed to the caller, including
+// the registered destroy notify for it (passed out in old_destroy).
 // Its up to the caller to free this as they wish, which may
-// or may not include using @old_destroy as sometimes replacement
+// or may not include using old_destroy as sometimes replacement
 // should not destroy the object in the normal way.
 func DatalistIdReplaceData(DatalistVar **Data, KeyIdVar Quark, OldvalVar uintptr, NewvalVar uintptr, DestroyVar *DestroyNotify, OldDestroyVar *DestroyNotify) bool {
 
@@ -201,11 +202,12 @@ func DatalistIdReplaceData(DatalistVar **Data, KeyIdVar Quark, OldvalVar uintptr
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -216,7 +218,7 @@ func DatalistIdReplaceData(DatalistVar **Data, KeyIdVar Quark, OldvalVar uintptr
 
 var xDatalistIdSetDataFull func(**Data, Quark, uintptr, uintptr)
 
-// Sets the data corresponding to the given #GQuark id, and the
+// Sets the data corresponding to the given [Quark] id, and the
 // function to be called when the element is removed from the datalist.
 // Any previous data with the same key is removed, and its destroy
 // function is called.
@@ -229,11 +231,12 @@ func DatalistIdSetDataFull(DatalistVar **Data, KeyIdVar Quark, DataVar uintptr,
 			DestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFuncVar
 				cbFn(arg0)
 			}
-			DestroyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
+			DestroyFuncVarRef = core.NewCallback(fcb)
+			DestroyFuncVarRef = SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
 		}
 	}
 
@@ -243,7 +246,7 @@ func DatalistIdSetDataFull(DatalistVar **Data, KeyIdVar Quark, DataVar uintptr,
 
 var xDatalistInit func(**Data)
 
-// Resets the datalist to %NULL. It does not free any memory or call
+// Resets the datalist to nil. It does not free any memory or call
 // any destroy functions.
 func DatalistInit(DatalistVar **Data) {
 
@@ -257,7 +260,7 @@ var xDatalistSetFlags func(**Data, uint)
 // to keep a small number of boolean flags in an object with
 // a data list without using any additional space. It is
 // not generally useful except in circumstances where space
-// is very tight. (It is used in the base #GObject type, for
+// is very tight. (It is used in the base [gobject.Object] type, for
 // example.)
 func DatalistSetFlags(DatalistVar **Data, FlagsVar uint) {
 
@@ -267,7 +270,7 @@ func DatalistSetFlags(DatalistVar **Data, FlagsVar uint) {
 
 var xDatalistUnsetFlags func(**Data, uint)
 
-// Turns off flag values for a data list. See g_datalist_unset_flags()
+// Turns off flag values for a data list. See [DatalistUnsetFlags]
 func DatalistUnsetFlags(DatalistVar **Data, FlagsVar uint) {
 
 	xDatalistUnsetFlags(DatalistVar, FlagsVar)
@@ -288,11 +291,11 @@ var xDatasetForeach func(uintptr, uintptr, uintptr)
 
 // Calls the given function for each data element which is associated
 // with the given location. Note that this function is NOT thread-safe.
-// So unless @dataset_location can be protected from any modifications
+// So unless dataset_location can be protected from any modifications
 // during invocation of this function, it should not be called.
 //
-// @func can make changes to the dataset, but the iteration will not
-// reflect changes made during the g_dataset_foreach() call, other
+// func can make changes to the dataset, but the iteration will not
+// reflect changes made during the [DatasetForeach] call, other
 // than skipping over elements that are removed.
 func DatasetForeach(DatasetLocationVar uintptr, FuncVar *DataForeachFunc, UserDataVar uintptr) {
 
@@ -303,11 +306,12 @@ func DatasetForeach(DatasetLocationVar uintptr, FuncVar *DataForeachFunc, UserDa
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 Quark, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -317,7 +321,7 @@ func DatasetForeach(DatasetLocationVar uintptr, FuncVar *DataForeachFunc, UserDa
 
 var xDatasetIdGetData func(uintptr, Quark) uintptr
 
-// Gets the data element corresponding to a #GQuark.
+// Gets the data element corresponding to a [Quark].
 func DatasetIdGetData(DatasetLocationVar uintptr, KeyIdVar Quark) uintptr {
 
 	cret := xDatasetIdGetData(DatasetLocationVar, KeyIdVar)
@@ -338,7 +342,7 @@ func DatasetIdRemoveNoNotify(DatasetLocationVar uintptr, KeyIdVar Quark) uintptr
 
 var xDatasetIdSetDataFull func(uintptr, Quark, uintptr, uintptr)
 
-// Sets the data element associated with the given #GQuark id, and also
+// Sets the data element associated with the given [Quark] id, and also
 // the function to call when the data element is destroyed. Any
 // previous data with the same key is removed, and its destroy function
 // is called.
@@ -351,11 +355,12 @@ func DatasetIdSetDataFull(DatasetLocationVar uintptr, KeyIdVar Quark, DataVar ui
 			DestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFuncVar
 				cbFn(arg0)
 			}
-			DestroyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
+			DestroyFuncVarRef = core.NewCallback(fcb)
+			DestroyFuncVarRef = SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
 		}
 	}
 
@@ -368,30 +373,30 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDatalistClear, libs, "g_datalist_clear")
-	core.PuregoSafeRegister(&xDatalistForeach, libs, "g_datalist_foreach")
-	core.PuregoSafeRegister(&xDatalistGetData, libs, "g_datalist_get_data")
-	core.PuregoSafeRegister(&xDatalistGetFlags, libs, "g_datalist_get_flags")
-	core.PuregoSafeRegister(&xDatalistIdDupData, libs, "g_datalist_id_dup_data")
-	core.PuregoSafeRegister(&xDatalistIdGetData, libs, "g_datalist_id_get_data")
-	core.PuregoSafeRegister(&xDatalistIdRemoveMultiple, libs, "g_datalist_id_remove_multiple")
-	core.PuregoSafeRegister(&xDatalistIdRemoveNoNotify, libs, "g_datalist_id_remove_no_notify")
-	core.PuregoSafeRegister(&xDatalistIdReplaceData, libs, "g_datalist_id_replace_data")
-	core.PuregoSafeRegister(&xDatalistIdSetDataFull, libs, "g_datalist_id_set_data_full")
-	core.PuregoSafeRegister(&xDatalistInit, libs, "g_datalist_init")
-	core.PuregoSafeRegister(&xDatalistSetFlags, libs, "g_datalist_set_flags")
-	core.PuregoSafeRegister(&xDatalistUnsetFlags, libs, "g_datalist_unset_flags")
-	core.PuregoSafeRegister(&xDatasetDestroy, libs, "g_dataset_destroy")
-	core.PuregoSafeRegister(&xDatasetForeach, libs, "g_dataset_foreach")
-	core.PuregoSafeRegister(&xDatasetIdGetData, libs, "g_dataset_id_get_data")
-	core.PuregoSafeRegister(&xDatasetIdRemoveNoNotify, libs, "g_dataset_id_remove_no_notify")
-	core.PuregoSafeRegister(&xDatasetIdSetDataFull, libs, "g_dataset_id_set_data_full")
+	core.PuregoRegisterSince(&xDatalistClear, libs, "g_datalist_clear", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistForeach, libs, "g_datalist_foreach", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistGetData, libs, "g_datalist_get_data", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistGetFlags, libs, "g_datalist_get_flags", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xDatalistIdDupData, libs, "g_datalist_id_dup_data", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xDatalistIdGetData, libs, "g_datalist_id_get_data", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistIdRemoveMultiple, libs, "g_datalist_id_remove_multiple", "GLIB", "2.74")
+	core.PuregoRegisterSince(&xDatalistIdRemoveNoNotify, libs, "g_datalist_id_remove_no_notify", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistIdReplaceData, libs, "g_datalist_id_replace_data", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xDatalistIdSetDataFull, libs, "g_datalist_id_set_data_full", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistInit, libs, "g_datalist_init", "GLIB", "")
+	core.PuregoRegisterSince(&xDatalistSetFlags, libs, "g_datalist_set_flags", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xDatalistUnsetFlags, libs, "g_datalist_unset_flags", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xDatasetDestroy, libs, "g_dataset_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xDatasetForeach, libs, "g_dataset_foreach", "GLIB", "")
+	core.PuregoRegisterSince(&xDatasetIdGetData, libs, "g_dataset_id_get_data", "GLIB", "")
+	core.PuregoRegisterSince(&xDatasetIdRemoveNoNotify, libs, "g_dataset_id_remove_no_notify", "GLIB", "")
+	core.PuregoRegisterSince(&xDatasetIdSetDataFull, libs, "g_dataset_id_set_data_full", "GLIB", "")
 
 }
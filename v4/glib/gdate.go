@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -14,7 +14,7 @@ import (
 //
 // The `GDate` data structure represents a day between January 1, Year 1,
 // and sometime a few thousand years in the future (right now it will go
-// to the year 65535 or so, but [method@GLib.Date.set_parse] only parses up to the
+// to the year 65535 or so, but [Date.SetParse] only parses up to the
 // year 8000 or so - just count on "a few thousand"). `GDate` is meant to
 // represent everyday dates, not astronomical dates or historical dates
 // or ISO timestamps or the like. It extrapolates the current Gregorian
@@ -27,22 +27,22 @@ import (
 // can keep both a Julian and day-month-year representation of the date,
 // since some calculations are much easier with one representation or the
 // other. A Julian representation is simply a count of days since some
-// fixed day in the past; for #GDate the fixed day is January 1, 1 AD.
-// ("Julian" dates in the #GDate API aren't really Julian dates in the
+// fixed day in the past; for [Date] the fixed day is January 1, 1 AD.
+// ("Julian" dates in the [Date] API aren't really Julian dates in the
 // technical sense; technically, Julian dates count from the start of the
 // Julian period, Jan 1, 4713 BC).
 //
 // `GDate` is simple to use. First you need a "blank" date; you can get a
-// dynamically allocated date from [ctor@GLib.Date.new], or you can declare an
-// automatic variable or array and initialize it by calling [method@GLib.Date.clear].
-// A cleared date is safe; it's safe to call [method@GLib.Date.set_dmy] and the other
+// dynamically allocated date from [NewDate], or you can declare an
+// automatic variable or array and initialize it by calling [Date.Clear].
+// A cleared date is safe; it's safe to call [Date.SetDmy] and the other
 // mutator functions to initialize the value of a cleared date. However, a cleared date
 // is initially invalid, meaning that it doesn't represent a day that exists.
 // It is undefined to call any of the date calculation routines on an invalid date.
 // If you obtain a date from a user or other unpredictable source, you should check
-// its validity with the [method@GLib.Date.valid] predicate. [method@GLib.Date.valid]
-// is also used to check for errors with [method@GLib.Date.set_parse] and other functions
-// that can fail. Dates can be invalidated by calling [method@GLib.Date.clear] again.
+// its validity with the [Date.Valid] predicate. [Date.Valid]
+// is also used to check for errors with [Date.SetParse] and other functions
+// that can fail. Dates can be invalidated by calling [Date.Clear] again.
 //
 // It is very important to use the API to access the `GDate` struct. Often only the
 // day-month-year or only the Julian representation is valid. Sometimes neither is valid.
@@ -77,10 +77,10 @@ func (x *Date) GoPointer() uintptr {
 
 var xNewDate func() *Date
 
-// Allocates a #GDate and initializes
+// Allocates a [Date] and initializes
 // it to a safe state. The new date will
-// be cleared (as if you'd called g_date_clear()) but invalid (it won't
-// represent an existing day). Free the return value with g_date_free().
+// be cleared (as if you'd called [Date.Clear]) but invalid (it won't
+// represent an existing day). Free the return value with [Date.Free].
 func NewDate() *Date {
 
 	cret := xNewDate()
@@ -89,10 +89,10 @@ func NewDate() *Date {
 
 var xNewDateDmy func(DateDay, DateMonth, DateYear) *Date
 
-// Create a new #GDate representing the given day-month-year triplet.
+// Create a new [Date] representing the given day-month-year triplet.
 //
-// The triplet you pass in must represent a valid date. Use g_date_valid_dmy()
-// if needed to validate it. The returned #GDate is guaranteed to be non-%NULL
+// The triplet you pass in must represent a valid date. Use [DateValidDmy]
+// if needed to validate it. The returned [Date] is guaranteed to be non-nil
 // and valid.
 func NewDateDmy(DayVar DateDay, MonthVar DateMonth, YearVar DateYear) *Date {
 
@@ -102,10 +102,10 @@ func NewDateDmy(DayVar DateDay, MonthVar DateMonth, YearVar DateYear) *Date {
 
 var xNewDateJulian func(uint32) *Date
 
-// Create a new #GDate representing the given Julian date.
+// Create a new [Date] representing the given Julian date.
 //
-// The @julian_day you pass in must be valid. Use g_date_valid_julian() if
-// needed to validate it. The returned #GDate is guaranteed to be non-%NULL and
+// The julian_day you pass in must be valid. Use [DateValidJulian] if
+// needed to validate it. The returned [Date] is guaranteed to be non-nil and
 // valid.
 func NewDateJulian(JulianDayVar uint32) *Date {
 
@@ -151,11 +151,11 @@ func (x *Date) AddYears(NYearsVar uint) {
 
 var xDateClamp func(uintptr, *Date, *Date)
 
-// If @date is prior to @min_date, sets @date equal to @min_date.
-// If @date falls after @max_date, sets @date equal to @max_date.
-// Otherwise, @date is unchanged.
-// Either of @min_date and @max_date may be %NULL.
-// All non-%NULL dates must be valid.
+// If date is prior to min_date, sets date equal to min_date.
+// If date falls after max_date, sets date equal to max_date.
+// Otherwise, date is unchanged.
+// Either of min_date and max_date may be nil.
+// All non-nil dates must be valid.
 func (x *Date) Clamp(MinDateVar *Date, MaxDateVar *Date) {
 
 	xDateClamp(x.GoPointer(), MinDateVar, MaxDateVar)
@@ -164,10 +164,10 @@ func (x *Date) Clamp(MinDateVar *Date, MaxDateVar *Date) {
 
 var xDateClear func(uintptr, uint)
 
-// Initializes one or more #GDate structs to a safe but invalid
+// Initializes one or more [Date] structs to a safe but invalid
 // state. The cleared dates will not represent an existing date, but will
 // not contain garbage. Useful to init a date declared on the stack.
-// Validity can be tested with g_date_valid().
+// Validity can be tested with [Date.Valid].
 func (x *Date) Clear(NDatesVar uint) {
 
 	xDateClear(x.GoPointer(), NDatesVar)
@@ -187,7 +187,7 @@ func (x *Date) Compare(RhsVar *Date) int {
 var xDateCopy func(uintptr) *Date
 
 // Copies a GDate to a newly-allocated GDate. If the input was invalid
-// (as determined by g_date_valid()), the invalid state will be copied
+// (as determined by [Date.Valid]), the invalid state will be copied
 // as is into the new object.
 func (x *Date) Copy() *Date {
 
@@ -198,7 +198,7 @@ func (x *Date) Copy() *Date {
 var xDateDaysBetween func(uintptr, *Date) int
 
 // Computes the number of days between two dates.
-// If @date2 is prior to @date1, the returned value is negative.
+// If date2 is prior to date1, the returned value is negative.
 // Both dates must be valid.
 func (x *Date) DaysBetween(Date2Var *Date) int {
 
@@ -208,7 +208,7 @@ func (x *Date) DaysBetween(Date2Var *Date) int {
 
 var xDateFree func(uintptr)
 
-// Frees a #GDate returned from g_date_new().
+// Frees a [Date] returned from [NewDate].
 func (x *Date) Free() {
 
 	xDateFree(x.GoPointer())
@@ -246,7 +246,7 @@ func (x *Date) GetIso8601WeekOfYear() uint {
 
 var xDateGetJulian func(uintptr) uint32
 
-// Returns the Julian day or "serial number" of the #GDate. The
+// Returns the Julian day or "serial number" of the [Date]. The
 // Julian day is simply the number of days since January 1, Year 1; i.e.,
 // January 1, Year 1 is Julian day 1; January 2, Year 1 is Julian day 2,
 // etc. The date must be valid.
@@ -294,9 +294,9 @@ var xDateGetWeekOfYear func(uintptr, DateWeekday) uint
 // The result depends on which day is considered the first day of the week,
 // which varies by locale. Both `date` and `first_day_of_week` must be valid.
 //
-// If @date is before the start of the first week of the year (for example,
-// before the first Monday in January if @first_day_of_week is
-// [enum@GLib.DateWeekday.MONDAY]) then zero will be returned.
+// If date is before the start of the first week of the year (for example,
+// before the first Monday in January if first_day_of_week is
+// DateWeekday.MONDAY) then zero will be returned.
 func (x *Date) GetWeekOfYear(FirstDayOfWeekVar DateWeekday) uint {
 
 	cret := xDateGetWeekOfYear(x.GoPointer(), FirstDayOfWeekVar)
@@ -305,7 +305,7 @@ func (x *Date) GetWeekOfYear(FirstDayOfWeekVar DateWeekday) uint {
 
 var xDateGetWeekday func(uintptr) DateWeekday
 
-// Returns the day of the week for a #GDate. The date must be valid.
+// Returns the day of the week for a [Date]. The date must be valid.
 func (x *Date) GetWeekday() DateWeekday {
 
 	cret := xDateGetWeekday(x.GoPointer())
@@ -314,7 +314,7 @@ func (x *Date) GetWeekday() DateWeekday {
 
 var xDateGetYear func(uintptr) DateYear
 
-// Returns the year of a #GDate. The date must be valid.
+// Returns the year of a [Date]. The date must be valid.
 func (x *Date) GetYear() DateYear {
 
 	cret := xDateGetYear(x.GoPointer())
@@ -323,7 +323,7 @@ func (x *Date) GetYear() DateYear {
 
 var xDateIsFirstOfMonth func(uintptr) bool
 
-// Returns %TRUE if the date is on the first of a month.
+// Returns true if the date is on the first of a month.
 // The date must be valid.
 func (x *Date) IsFirstOfMonth() bool {
 
@@ -333,7 +333,7 @@ func (x *Date) IsFirstOfMonth() bool {
 
 var xDateIsLastOfMonth func(uintptr) bool
 
-// Returns %TRUE if the date is the last day of the month.
+// Returns true if the date is the last day of the month.
 // The date must be valid.
 func (x *Date) IsLastOfMonth() bool {
 
@@ -343,7 +343,7 @@ func (x *Date) IsLastOfMonth() bool {
 
 var xDateOrder func(uintptr, *Date)
 
-// Checks if @date1 is less than or equal to @date2,
+// Checks if date1 is less than or equal to date2,
 // and swap the values if this is not the case.
 func (x *Date) Order(Date2Var *Date) {
 
@@ -353,7 +353,7 @@ func (x *Date) Order(Date2Var *Date) {
 
 var xDateSetDay func(uintptr, DateDay)
 
-// Sets the day of the month for a #GDate. If the resulting
+// Sets the day of the month for a [Date]. If the resulting
 // day-month-year triplet is invalid, the date will be invalid.
 func (x *Date) SetDay(DayVar DateDay) {
 
@@ -363,9 +363,9 @@ func (x *Date) SetDay(DayVar DateDay) {
 
 var xDateSetDmy func(uintptr, DateDay, DateMonth, DateYear)
 
-// Sets the value of a #GDate from a day, month, and year.
+// Sets the value of a [Date] from a day, month, and year.
 // The day-month-year triplet must be valid; if you aren't
-// sure it is, call g_date_valid_dmy() to check before you
+// sure it is, call [DateValidDmy] to check before you
 // set it.
 func (x *Date) SetDmy(DayVar DateDay, MonthVar DateMonth, YVar DateYear) {
 
@@ -375,7 +375,7 @@ func (x *Date) SetDmy(DayVar DateDay, MonthVar DateMonth, YVar DateYear) {
 
 var xDateSetJulian func(uintptr, uint32)
 
-// Sets the value of a #GDate from a Julian day number.
+// Sets the value of a [Date] from a Julian day number.
 func (x *Date) SetJulian(JulianDateVar uint32) {
 
 	xDateSetJulian(x.GoPointer(), JulianDateVar)
@@ -384,7 +384,7 @@ func (x *Date) SetJulian(JulianDateVar uint32) {
 
 var xDateSetMonth func(uintptr, DateMonth)
 
-// Sets the month of the year for a #GDate.  If the resulting
+// Sets the month of the year for a [Date].  If the resulting
 // day-month-year triplet is invalid, the date will be invalid.
 func (x *Date) SetMonth(MonthVar DateMonth) {
 
@@ -394,11 +394,11 @@ func (x *Date) SetMonth(MonthVar DateMonth) {
 
 var xDateSetParse func(uintptr, string)
 
-// Parses a user-inputted string @str, and try to figure out what date it
-// represents, taking the [current locale](running.html#locale)
+// Parses a user-inputted string str, and try to figure out what date it
+// represents, taking the current locale
 // into account. If the string is successfully parsed, the date will be
 // valid after the call. Otherwise, it will be invalid. You should check
-// using g_date_valid() to see whether the parsing succeeded.
+// using [Date.Valid] to see whether the parsing succeeded.
 //
 // This function is not appropriate for file formats and the like; it
 // isn't very precise, and its exact behavior varies with the locale.
@@ -411,16 +411,6 @@ func (x *Date) SetParse(StrVar string) {
 
 }
 
-var xDateSetTime func(uintptr, Time)
-
-// Sets the value of a date from a #GTime value.
-// The time to date conversion is done using the user's current timezone.
-func (x *Date) SetTime(TimeVar Time) {
-
-	xDateSetTime(x.GoPointer(), TimeVar)
-
-}
-
 var xDateSetTimeT func(uintptr, int)
 
 // Sets the value of a date to the date corresponding to a time
@@ -428,36 +418,20 @@ var xDateSetTimeT func(uintptr, int)
 // the user's current timezone.
 //
 // To set the value of a date to the current day, you could write:
-// |[&lt;!-- language="C" --&gt;
 //
 //	time_t now = time (NULL);
 //	if (now == (time_t) -1)
 //	  // handle the error
 //	g_date_set_time_t (date, now);
-//
-// ]|
 func (x *Date) SetTimeT(TimetVar int) {
 
 	xDateSetTimeT(x.GoPointer(), TimetVar)
 
 }
 
-var xDateSetTimeVal func(uintptr, *TimeVal)
-
-// Sets the value of a date from a #GTimeVal value.  Note that the
-// @tv_usec member is ignored, because #GDate can't make use of the
-// additional precision.
-//
-// The time to date conversion is done using the user's current timezone.
-func (x *Date) SetTimeVal(TimevalVar *TimeVal) {
-
-	xDateSetTimeVal(x.GoPointer(), TimevalVar)
-
-}
-
 var xDateSetYear func(uintptr, DateYear)
 
-// Sets the year for a #GDate. If the resulting day-month-year
+// Sets the year for a [Date]. If the resulting day-month-year
 // triplet is invalid, the date will be invalid.
 func (x *Date) SetYear(YearVar DateYear) {
 
@@ -503,7 +477,7 @@ func (x *Date) SubtractYears(NYearsVar uint) {
 
 var xDateToStructTm func(uintptr, uintptr)
 
-// Fills in the date-related bits of a struct tm using the @date value.
+// Fills in the date-related bits of a struct tm using the date value.
 // Initializes the non-date parts with something safe but meaningless.
 func (x *Date) ToStructTm(TmVar uintptr) {
 
@@ -513,9 +487,9 @@ func (x *Date) ToStructTm(TmVar uintptr) {
 
 var xDateValid func(uintptr) bool
 
-// Returns %TRUE if the #GDate represents an existing day. The date must not
-// contain garbage; it should have been initialized with g_date_clear()
-// if it wasn't allocated by one of the g_date_new() variants.
+// Returns true if the [Date] represents an existing day. The date must not
+// contain garbage; it should have been initialized with [Date.Clear]
+// if it wasn't allocated by one of the [NewDate] variants.
 func (x *Date) Valid() bool {
 
 	cret := xDateValid(x.GoPointer())
@@ -524,43 +498,49 @@ func (x *Date) Valid() bool {
 
 // Integer representing a day of the month; between 1 and 31.
 //
-// The %G_DATE_BAD_DAY value represents an invalid day of the month.
+// The [DATE_BAD_DAY] value represents an invalid day of the month.
 type DateDay = byte
 
 // Integer type representing a year.
 //
-// The %G_DATE_BAD_YEAR value is the invalid value. The year
-// must be 1 or higher; negative ([BCE](https://en.wikipedia.org/wiki/Common_Era))
+// The [DATE_BAD_YEAR] value is the invalid value. The year
+// must be 1 or higher; negative ([BCE])
 // years are not allowed.
 //
 // The year is represented with four digits.
+//
+// [BCE]: https://en.wikipedia.org/wiki/Common_Era
 type DateYear = uint16
 
 // Simply a replacement for `time_t`. It has been deprecated
 // since it is not equivalent to `time_t` on 64-bit platforms
 // with a 64-bit `time_t`.
 //
-// Unrelated to #GTimer.
+// Unrelated to [Timer].
 //
-// Note that #GTime is defined to always be a 32-bit integer,
+// Note that [Time] is defined to always be a 32-bit integer,
 // unlike `time_t` which may be 64-bit on some systems. Therefore,
-// #GTime will overflow in the year 2038, and you cannot use the
-// address of a #GTime variable as argument to the UNIX time()
+// [Time] will overflow in the year 2038, and you cannot use the
+// address of a [Time] variable as argument to the UNIX time()
 // function.
 //
 // Instead, do the following:
 //
-// |[&lt;!-- language="C" --&gt;
-// time_t ttime;
-// GTime gtime;
+//	time_t ttime;
+//	GTime gtime;
+//
+//	time (&ttime);
+//	gtime = (GTime)ttime;
 //
-// time (&amp;ttime);
-// gtime = (GTime)ttime;
-// ]|
+// Deprecated: since 2.62. This is not [Y2038-safe].
+//
+//	Use [DateTime] or #time_t instead.
+//
+// [Y2038-safe]: https://en.wikipedia.org/wiki/Year_2038_problem
 type Time = int32
 
 const (
-	// Represents an invalid #GDateDay.
+	// Represents an invalid [DateDay].
 	DATE_BAD_DAY int = 0
 	// Represents an invalid Julian day number.
 	DATE_BAD_JULIAN int = 0
@@ -582,8 +562,47 @@ const (
 	GDateYearValue DateDMY = 2
 )
 
-// Enumeration representing a month; values are %G_DATE_JANUARY,
-// %G_DATE_FEBRUARY, etc. %G_DATE_BAD_MONTH is the invalid value.
+// String returns the C name of the value, e.g. for debugging output
+func (x DateDMY) String() string {
+	switch x {
+	case GDateDayValue:
+		return "G_DATE_DAY"
+	case GDateMonthValue:
+		return "G_DATE_MONTH"
+	case GDateYearValue:
+		return "G_DATE_YEAR"
+	}
+	return fmt.Sprintf("DateDMY(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DateDMY) Nick() string {
+	switch x {
+	case GDateDayValue:
+		return "day"
+	case GDateMonthValue:
+		return "month"
+	case GDateYearValue:
+		return "year"
+	}
+	return ""
+}
+
+// DateDMYFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DateDMYFromString(s string) (DateDMY, bool) {
+	switch s {
+	case "G_DATE_DAY", "day":
+		return GDateDayValue, true
+	case "G_DATE_MONTH", "month":
+		return GDateMonthValue, true
+	case "G_DATE_YEAR", "year":
+		return GDateYearValue, true
+	}
+	return 0, false
+}
+
+// Enumeration representing a month; values are [GDateJanuaryValue],
+// [GDateFebruaryValue], etc. [GDateBadMonthValue] is the invalid value.
 type DateMonth int
 
 const (
@@ -616,8 +635,107 @@ const (
 	GDateDecemberValue DateMonth = 12
 )
 
-// Enumeration representing a day of the week; %G_DATE_MONDAY,
-// %G_DATE_TUESDAY, etc. %G_DATE_BAD_WEEKDAY is an invalid weekday.
+// String returns the C name of the value, e.g. for debugging output
+func (x DateMonth) String() string {
+	switch x {
+	case GDateBadMonthValue:
+		return "G_DATE_BAD_MONTH"
+	case GDateJanuaryValue:
+		return "G_DATE_JANUARY"
+	case GDateFebruaryValue:
+		return "G_DATE_FEBRUARY"
+	case GDateMarchValue:
+		return "G_DATE_MARCH"
+	case GDateAprilValue:
+		return "G_DATE_APRIL"
+	case GDateMayValue:
+		return "G_DATE_MAY"
+	case GDateJuneValue:
+		return "G_DATE_JUNE"
+	case GDateJulyValue:
+		return "G_DATE_JULY"
+	case GDateAugustValue:
+		return "G_DATE_AUGUST"
+	case GDateSeptemberValue:
+		return "G_DATE_SEPTEMBER"
+	case GDateOctoberValue:
+		return "G_DATE_OCTOBER"
+	case GDateNovemberValue:
+		return "G_DATE_NOVEMBER"
+	case GDateDecemberValue:
+		return "G_DATE_DECEMBER"
+	}
+	return fmt.Sprintf("DateMonth(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DateMonth) Nick() string {
+	switch x {
+	case GDateBadMonthValue:
+		return "bad-month"
+	case GDateJanuaryValue:
+		return "january"
+	case GDateFebruaryValue:
+		return "february"
+	case GDateMarchValue:
+		return "march"
+	case GDateAprilValue:
+		return "april"
+	case GDateMayValue:
+		return "may"
+	case GDateJuneValue:
+		return "june"
+	case GDateJulyValue:
+		return "july"
+	case GDateAugustValue:
+		return "august"
+	case GDateSeptemberValue:
+		return "september"
+	case GDateOctoberValue:
+		return "october"
+	case GDateNovemberValue:
+		return "november"
+	case GDateDecemberValue:
+		return "december"
+	}
+	return ""
+}
+
+// DateMonthFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DateMonthFromString(s string) (DateMonth, bool) {
+	switch s {
+	case "G_DATE_BAD_MONTH", "bad-month":
+		return GDateBadMonthValue, true
+	case "G_DATE_JANUARY", "january":
+		return GDateJanuaryValue, true
+	case "G_DATE_FEBRUARY", "february":
+		return GDateFebruaryValue, true
+	case "G_DATE_MARCH", "march":
+		return GDateMarchValue, true
+	case "G_DATE_APRIL", "april":
+		return GDateAprilValue, true
+	case "G_DATE_MAY", "may":
+		return GDateMayValue, true
+	case "G_DATE_JUNE", "june":
+		return GDateJuneValue, true
+	case "G_DATE_JULY", "july":
+		return GDateJulyValue, true
+	case "G_DATE_AUGUST", "august":
+		return GDateAugustValue, true
+	case "G_DATE_SEPTEMBER", "september":
+		return GDateSeptemberValue, true
+	case "G_DATE_OCTOBER", "october":
+		return GDateOctoberValue, true
+	case "G_DATE_NOVEMBER", "november":
+		return GDateNovemberValue, true
+	case "G_DATE_DECEMBER", "december":
+		return GDateDecemberValue, true
+	}
+	return 0, false
+}
+
+// Enumeration representing a day of the week; [GDateMondayValue],
+// [GDateTuesdayValue], etc. [GDateBadWeekdayValue] is an invalid weekday.
 type DateWeekday int
 
 const (
@@ -640,6 +758,75 @@ const (
 	GDateSundayValue DateWeekday = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DateWeekday) String() string {
+	switch x {
+	case GDateBadWeekdayValue:
+		return "G_DATE_BAD_WEEKDAY"
+	case GDateMondayValue:
+		return "G_DATE_MONDAY"
+	case GDateTuesdayValue:
+		return "G_DATE_TUESDAY"
+	case GDateWednesdayValue:
+		return "G_DATE_WEDNESDAY"
+	case GDateThursdayValue:
+		return "G_DATE_THURSDAY"
+	case GDateFridayValue:
+		return "G_DATE_FRIDAY"
+	case GDateSaturdayValue:
+		return "G_DATE_SATURDAY"
+	case GDateSundayValue:
+		return "G_DATE_SUNDAY"
+	}
+	return fmt.Sprintf("DateWeekday(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DateWeekday) Nick() string {
+	switch x {
+	case GDateBadWeekdayValue:
+		return "bad-weekday"
+	case GDateMondayValue:
+		return "monday"
+	case GDateTuesdayValue:
+		return "tuesday"
+	case GDateWednesdayValue:
+		return "wednesday"
+	case GDateThursdayValue:
+		return "thursday"
+	case GDateFridayValue:
+		return "friday"
+	case GDateSaturdayValue:
+		return "saturday"
+	case GDateSundayValue:
+		return "sunday"
+	}
+	return ""
+}
+
+// DateWeekdayFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DateWeekdayFromString(s string) (DateWeekday, bool) {
+	switch s {
+	case "G_DATE_BAD_WEEKDAY", "bad-weekday":
+		return GDateBadWeekdayValue, true
+	case "G_DATE_MONDAY", "monday":
+		return GDateMondayValue, true
+	case "G_DATE_TUESDAY", "tuesday":
+		return GDateTuesdayValue, true
+	case "G_DATE_WEDNESDAY", "wednesday":
+		return GDateWednesdayValue, true
+	case "G_DATE_THURSDAY", "thursday":
+		return GDateThursdayValue, true
+	case "G_DATE_FRIDAY", "friday":
+		return GDateFridayValue, true
+	case "G_DATE_SATURDAY", "saturday":
+		return GDateSaturdayValue, true
+	case "G_DATE_SUNDAY", "sunday":
+		return GDateSundayValue, true
+	}
+	return 0, false
+}
+
 var xDateGetDaysInMonth func(DateMonth, DateYear) byte
 
 // Returns the number of days in a month, taking leap
@@ -692,7 +879,7 @@ var xDateGetWeeksInYear func(DateYear, DateWeekday) byte
 //
 // The result will be either 52 or 53. Years always have 52 seven-day periods,
 // plus one or two extra days depending on whether it’s a leap year. This
-// function effectively calculates how many @first_day_of_week days there are in
+// function effectively calculates how many first_day_of_week days there are in
 // the year.
 func DateGetWeeksInYear(YearVar DateYear, FirstDayOfWeekVar DateWeekday) byte {
 
@@ -703,7 +890,7 @@ func DateGetWeeksInYear(YearVar DateYear, FirstDayOfWeekVar DateWeekday) byte {
 
 var xDateIsLeapYear func(DateYear) bool
 
-// Returns %TRUE if the year is a leap year.
+// Returns true if the year is a leap year.
 //
 // For the purposes of this function, leap year is every year
 // divisible by 4 unless that year is divisible by 100. If it
@@ -719,7 +906,7 @@ func DateIsLeapYear(YearVar DateYear) bool {
 var xDateStrftime func(string, uint, string, *Date) uint
 
 // Generates a printed representation of the date, in a
-// [locale](running.html#locale)-specific way.
+// locale-specific way.
 // Works just like the platform's C library strftime() function,
 // but only accepts date-related formats; time-related formats
 // give undefined results. Date must be valid. Unlike strftime()
@@ -728,8 +915,8 @@ var xDateStrftime func(string, uint, string, *Date) uint
 //
 // This function does not provide any conversion specifiers in
 // addition to those implemented by the platform's C library.
-// For example, don't expect that using g_date_strftime() would
-// make the \%F provided by the C99 strftime() work on Windows
+// For example, don't expect that using [DateStrftime] would
+// make the \F provided by the C99 strftime() work on Windows
 // where the C library only complies to C89.
 func DateStrftime(SVar string, SlenVar uint, FormatVar string, DateVar *Date) uint {
 
@@ -740,7 +927,7 @@ func DateStrftime(SVar string, SlenVar uint, FormatVar string, DateVar *Date) ui
 
 var xDateValidDay func(DateDay) bool
 
-// Returns %TRUE if the day of the month is valid (a day is valid if it's
+// Returns true if the day of the month is valid (a day is valid if it's
 // between 1 and 31 inclusive).
 func DateValidDay(DayVar DateDay) bool {
 
@@ -751,8 +938,8 @@ func DateValidDay(DayVar DateDay) bool {
 
 var xDateValidDmy func(DateDay, DateMonth, DateYear) bool
 
-// Returns %TRUE if the day-month-year triplet forms a valid, existing day
-// in the range of days #GDate understands (Year 1 or later, no more than
+// Returns true if the day-month-year triplet forms a valid, existing day
+// in the range of days [Date] understands (Year 1 or later, no more than
 // a few thousand years in the future).
 func DateValidDmy(DayVar DateDay, MonthVar DateMonth, YearVar DateYear) bool {
 
@@ -763,7 +950,7 @@ func DateValidDmy(DayVar DateDay, MonthVar DateMonth, YearVar DateYear) bool {
 
 var xDateValidJulian func(uint32) bool
 
-// Returns %TRUE if the Julian day is valid. Anything greater than zero
+// Returns true if the Julian day is valid. Anything greater than zero
 // is basically a valid Julian, though there is a 32-bit limit.
 func DateValidJulian(JulianDateVar uint32) bool {
 
@@ -774,7 +961,7 @@ func DateValidJulian(JulianDateVar uint32) bool {
 
 var xDateValidMonth func(DateMonth) bool
 
-// Returns %TRUE if the month value is valid. The 12 #GDateMonth
+// Returns true if the month value is valid. The 12 [DateMonth]
 // enumeration values are the only valid months.
 func DateValidMonth(MonthVar DateMonth) bool {
 
@@ -785,7 +972,7 @@ func DateValidMonth(MonthVar DateMonth) bool {
 
 var xDateValidWeekday func(DateWeekday) bool
 
-// Returns %TRUE if the weekday is valid. The seven #GDateWeekday enumeration
+// Returns true if the weekday is valid. The seven [DateWeekday] enumeration
 // values are the only valid weekdays.
 func DateValidWeekday(WeekdayVar DateWeekday) bool {
 
@@ -796,8 +983,8 @@ func DateValidWeekday(WeekdayVar DateWeekday) bool {
 
 var xDateValidYear func(DateYear) bool
 
-// Returns %TRUE if the year is valid. Any year greater than 0 is valid,
-// though there is a 16-bit limit to what #GDate will understand.
+// Returns true if the year is valid. Any year greater than 0 is valid,
+// though there is a 16-bit limit to what [Date] will understand.
 func DateValidYear(YearVar DateYear) bool {
 
 	cret := xDateValidYear(YearVar)
@@ -810,67 +997,65 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDateGetDaysInMonth, libs, "g_date_get_days_in_month")
-	core.PuregoSafeRegister(&xDateGetMondayWeeksInYear, libs, "g_date_get_monday_weeks_in_year")
-	core.PuregoSafeRegister(&xDateGetSundayWeeksInYear, libs, "g_date_get_sunday_weeks_in_year")
-	core.PuregoSafeRegister(&xDateGetWeeksInYear, libs, "g_date_get_weeks_in_year")
-	core.PuregoSafeRegister(&xDateIsLeapYear, libs, "g_date_is_leap_year")
-	core.PuregoSafeRegister(&xDateStrftime, libs, "g_date_strftime")
-	core.PuregoSafeRegister(&xDateValidDay, libs, "g_date_valid_day")
-	core.PuregoSafeRegister(&xDateValidDmy, libs, "g_date_valid_dmy")
-	core.PuregoSafeRegister(&xDateValidJulian, libs, "g_date_valid_julian")
-	core.PuregoSafeRegister(&xDateValidMonth, libs, "g_date_valid_month")
-	core.PuregoSafeRegister(&xDateValidWeekday, libs, "g_date_valid_weekday")
-	core.PuregoSafeRegister(&xDateValidYear, libs, "g_date_valid_year")
+	core.PuregoRegisterSince(&xDateGetDaysInMonth, libs, "g_date_get_days_in_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetMondayWeeksInYear, libs, "g_date_get_monday_weeks_in_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetSundayWeeksInYear, libs, "g_date_get_sunday_weeks_in_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetWeeksInYear, libs, "g_date_get_weeks_in_year", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xDateIsLeapYear, libs, "g_date_is_leap_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateStrftime, libs, "g_date_strftime", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidDay, libs, "g_date_valid_day", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidDmy, libs, "g_date_valid_dmy", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidJulian, libs, "g_date_valid_julian", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidMonth, libs, "g_date_valid_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidWeekday, libs, "g_date_valid_weekday", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValidYear, libs, "g_date_valid_year", "GLIB", "")
 
 	core.PuregoSafeRegister(&xDateGLibType, libs, "g_date_get_type")
 
-	core.PuregoSafeRegister(&xNewDate, libs, "g_date_new")
-	core.PuregoSafeRegister(&xNewDateDmy, libs, "g_date_new_dmy")
-	core.PuregoSafeRegister(&xNewDateJulian, libs, "g_date_new_julian")
-
-	core.PuregoSafeRegister(&xDateAddDays, libs, "g_date_add_days")
-	core.PuregoSafeRegister(&xDateAddMonths, libs, "g_date_add_months")
-	core.PuregoSafeRegister(&xDateAddYears, libs, "g_date_add_years")
-	core.PuregoSafeRegister(&xDateClamp, libs, "g_date_clamp")
-	core.PuregoSafeRegister(&xDateClear, libs, "g_date_clear")
-	core.PuregoSafeRegister(&xDateCompare, libs, "g_date_compare")
-	core.PuregoSafeRegister(&xDateCopy, libs, "g_date_copy")
-	core.PuregoSafeRegister(&xDateDaysBetween, libs, "g_date_days_between")
-	core.PuregoSafeRegister(&xDateFree, libs, "g_date_free")
-	core.PuregoSafeRegister(&xDateGetDay, libs, "g_date_get_day")
-	core.PuregoSafeRegister(&xDateGetDayOfYear, libs, "g_date_get_day_of_year")
-	core.PuregoSafeRegister(&xDateGetIso8601WeekOfYear, libs, "g_date_get_iso8601_week_of_year")
-	core.PuregoSafeRegister(&xDateGetJulian, libs, "g_date_get_julian")
-	core.PuregoSafeRegister(&xDateGetMondayWeekOfYear, libs, "g_date_get_monday_week_of_year")
-	core.PuregoSafeRegister(&xDateGetMonth, libs, "g_date_get_month")
-	core.PuregoSafeRegister(&xDateGetSundayWeekOfYear, libs, "g_date_get_sunday_week_of_year")
-	core.PuregoSafeRegister(&xDateGetWeekOfYear, libs, "g_date_get_week_of_year")
-	core.PuregoSafeRegister(&xDateGetWeekday, libs, "g_date_get_weekday")
-	core.PuregoSafeRegister(&xDateGetYear, libs, "g_date_get_year")
-	core.PuregoSafeRegister(&xDateIsFirstOfMonth, libs, "g_date_is_first_of_month")
-	core.PuregoSafeRegister(&xDateIsLastOfMonth, libs, "g_date_is_last_of_month")
-	core.PuregoSafeRegister(&xDateOrder, libs, "g_date_order")
-	core.PuregoSafeRegister(&xDateSetDay, libs, "g_date_set_day")
-	core.PuregoSafeRegister(&xDateSetDmy, libs, "g_date_set_dmy")
-	core.PuregoSafeRegister(&xDateSetJulian, libs, "g_date_set_julian")
-	core.PuregoSafeRegister(&xDateSetMonth, libs, "g_date_set_month")
-	core.PuregoSafeRegister(&xDateSetParse, libs, "g_date_set_parse")
-	core.PuregoSafeRegister(&xDateSetTime, libs, "g_date_set_time")
-	core.PuregoSafeRegister(&xDateSetTimeT, libs, "g_date_set_time_t")
-	core.PuregoSafeRegister(&xDateSetTimeVal, libs, "g_date_set_time_val")
-	core.PuregoSafeRegister(&xDateSetYear, libs, "g_date_set_year")
-	core.PuregoSafeRegister(&xDateSubtractDays, libs, "g_date_subtract_days")
-	core.PuregoSafeRegister(&xDateSubtractMonths, libs, "g_date_subtract_months")
-	core.PuregoSafeRegister(&xDateSubtractYears, libs, "g_date_subtract_years")
-	core.PuregoSafeRegister(&xDateToStructTm, libs, "g_date_to_struct_tm")
-	core.PuregoSafeRegister(&xDateValid, libs, "g_date_valid")
+	core.PuregoRegisterSince(&xNewDate, libs, "g_date_new", "GLIB", "")
+	core.PuregoRegisterSince(&xNewDateDmy, libs, "g_date_new_dmy", "GLIB", "")
+	core.PuregoRegisterSince(&xNewDateJulian, libs, "g_date_new_julian", "GLIB", "")
+
+	core.PuregoRegisterSince(&xDateAddDays, libs, "g_date_add_days", "GLIB", "")
+	core.PuregoRegisterSince(&xDateAddMonths, libs, "g_date_add_months", "GLIB", "")
+	core.PuregoRegisterSince(&xDateAddYears, libs, "g_date_add_years", "GLIB", "")
+	core.PuregoRegisterSince(&xDateClamp, libs, "g_date_clamp", "GLIB", "")
+	core.PuregoRegisterSince(&xDateClear, libs, "g_date_clear", "GLIB", "")
+	core.PuregoRegisterSince(&xDateCompare, libs, "g_date_compare", "GLIB", "")
+	core.PuregoRegisterSince(&xDateCopy, libs, "g_date_copy", "GLIB", "2.56")
+	core.PuregoRegisterSince(&xDateDaysBetween, libs, "g_date_days_between", "GLIB", "")
+	core.PuregoRegisterSince(&xDateFree, libs, "g_date_free", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetDay, libs, "g_date_get_day", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetDayOfYear, libs, "g_date_get_day_of_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetIso8601WeekOfYear, libs, "g_date_get_iso8601_week_of_year", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xDateGetJulian, libs, "g_date_get_julian", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetMondayWeekOfYear, libs, "g_date_get_monday_week_of_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetMonth, libs, "g_date_get_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetSundayWeekOfYear, libs, "g_date_get_sunday_week_of_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetWeekOfYear, libs, "g_date_get_week_of_year", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xDateGetWeekday, libs, "g_date_get_weekday", "GLIB", "")
+	core.PuregoRegisterSince(&xDateGetYear, libs, "g_date_get_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateIsFirstOfMonth, libs, "g_date_is_first_of_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateIsLastOfMonth, libs, "g_date_is_last_of_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateOrder, libs, "g_date_order", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetDay, libs, "g_date_set_day", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetDmy, libs, "g_date_set_dmy", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetJulian, libs, "g_date_set_julian", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetMonth, libs, "g_date_set_month", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetParse, libs, "g_date_set_parse", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetTimeT, libs, "g_date_set_time_t", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xDateSetYear, libs, "g_date_set_year", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSubtractDays, libs, "g_date_subtract_days", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSubtractMonths, libs, "g_date_subtract_months", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSubtractYears, libs, "g_date_subtract_years", "GLIB", "")
+	core.PuregoRegisterSince(&xDateToStructTm, libs, "g_date_to_struct_tm", "GLIB", "")
+	core.PuregoRegisterSince(&xDateValid, libs, "g_date_valid", "GLIB", "")
 
 }
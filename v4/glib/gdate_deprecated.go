@@ -0,0 +1,54 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xDateSetTime func(uintptr, Time)
+
+// Sets the value of a date from a [Time] value.
+// The time to date conversion is done using the user's current timezone.
+//
+// Deprecated: since 2.10. Use [Date.SetTimeT] instead.
+func (x *Date) SetTime(TimeVar Time) {
+
+	xDateSetTime(x.GoPointer(), TimeVar)
+
+}
+
+var xDateSetTimeVal func(uintptr, *TimeVal)
+
+// Sets the value of a date from a [TimeVal] value.  Note that the
+// tv_usec member is ignored, because [Date] can't make use of the
+// additional precision.
+//
+// The time to date conversion is done using the user's current timezone.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use [Date.SetTimeT]
+//
+//	instead.
+func (x *Date) SetTimeVal(TimevalVar *TimeVal) {
+
+	xDateSetTimeVal(x.GoPointer(), TimevalVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xDateSetTime, libs, "g_date_set_time", "GLIB", "")
+	core.PuregoRegisterSince(&xDateSetTimeVal, libs, "g_date_set_time_val", "GLIB", "2.10")
+
+}
@@ -0,0 +1,98 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewDateTimeFromTimevalLocal func(*TimeVal) *DateTime
+
+// Creates a [DateTime] corresponding to the given [TimeVal] tv in the
+// local time zone.
+//
+// The time contained in a [TimeVal] is always stored in the form of
+// seconds elapsed since 1970-01-01 00:00:00 UTC, regardless of the
+// local time offset.
+//
+// This call can fail (returning nil) if tv represents a time outside
+// of the supported range of [DateTime].
+//
+// You should release the return value by calling [DateTime.Unref]
+// when you are done with it.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	[NewDateTimeFromUnixLocal] instead.
+func NewDateTimeFromTimevalLocal(TvVar *TimeVal) *DateTime {
+
+	cret := xNewDateTimeFromTimevalLocal(TvVar)
+	return cret
+}
+
+var xNewDateTimeFromTimevalUtc func(*TimeVal) *DateTime
+
+// Creates a [DateTime] corresponding to the given [TimeVal] tv in UTC.
+//
+// The time contained in a [TimeVal] is always stored in the form of
+// seconds elapsed since 1970-01-01 00:00:00 UTC.
+//
+// This call can fail (returning nil) if tv represents a time outside
+// of the supported range of [DateTime].
+//
+// You should release the return value by calling [DateTime.Unref]
+// when you are done with it.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	[NewDateTimeFromUnixUtc] instead.
+func NewDateTimeFromTimevalUtc(TvVar *TimeVal) *DateTime {
+
+	cret := xNewDateTimeFromTimevalUtc(TvVar)
+	return cret
+}
+
+var xDateTimeToTimeval func(uintptr, *TimeVal) bool
+
+// Stores the instant in time that datetime represents into tv.
+//
+// The time contained in a [TimeVal] is always stored in the form of
+// seconds elapsed since 1970-01-01 00:00:00 UTC, regardless of the time
+// zone associated with datetime.
+//
+// On systems where 'long' is 32bit (ie: all 32bit systems and all
+// Windows systems), a [TimeVal] is incapable of storing the entire
+// range of values that [DateTime] is capable of expressing.  On those
+// systems, this function returns false to indicate that the time is
+// out of range.
+//
+// On systems where 'long' is 64bit, this function never fails.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	[DateTime.ToUnix] instead.
+func (x *DateTime) ToTimeval(TvVar *TimeVal) bool {
+
+	cret := xDateTimeToTimeval(x.GoPointer(), TvVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewDateTimeFromTimevalLocal, libs, "g_date_time_new_from_timeval_local", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xNewDateTimeFromTimevalUtc, libs, "g_date_time_new_from_timeval_utc", "GLIB", "2.26")
+
+	core.PuregoRegisterSince(&xDateTimeToTimeval, libs, "g_date_time_to_timeval", "GLIB", "2.26")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -28,14 +27,14 @@ func (x *Dir) GoPointer() uintptr {
 var xDirOpen func(string, uint, **Error) *Dir
 
 // Opens a directory for reading. The names of the files in the
-// directory can then be retrieved using g_dir_read_name().  Note
+// directory can then be retrieved using [Dir.ReadName].  Note
 // that the ordering is not defined.
 func DirOpen(PathVar string, FlagsVar uint) (*Dir, error) {
 	var cerr *Error
 
 	cret := xDirOpen(PathVar, FlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -49,8 +48,8 @@ var xDirClose func(uintptr)
 // freed. Prior to GLib 2.80, `GDir` was not reference counted.
 //
 // It is an error to call any of the `GDir` methods other than
-// [method@GLib.Dir.ref] and [method@GLib.Dir.unref] on a `GDir` after calling
-// [method@GLib.Dir.close] on it.
+// [Dir.Ref] and [Dir.Unref] on a `GDir` after calling
+// [Dir.Close] on it.
 func (x *Dir) Close() {
 
 	xDirClose(x.GoPointer())
@@ -59,13 +58,13 @@ func (x *Dir) Close() {
 
 var xDirReadName func(uintptr) string
 
-// Retrieves the name of another entry in the directory, or %NULL.
+// Retrieves the name of another entry in the directory, or nil.
 // The order of entries returned from this function is not defined,
 // and may vary by file system or other operating-system dependent
 // factors.
 //
-// %NULL may also be returned in case of errors. On Unix, you can
-// check `errno` to find out if %NULL was returned because of an error.
+// nil may also be returned in case of errors. On Unix, you can
+// check `errno` to find out if nil was returned because of an error.
 //
 // On Unix, the '.' and '..' entries are omitted, and the returned
 // name is in the on-disk encoding.
@@ -89,7 +88,7 @@ func (x *Dir) Ref() *Dir {
 
 var xDirRewind func(uintptr)
 
-// Resets the given directory. The next call to g_dir_read_name()
+// Resets the given directory. The next call to [Dir.ReadName]
 // will return the first entry again.
 func (x *Dir) Rewind() {
 
@@ -102,14 +101,14 @@ var xDirUnref func(uintptr)
 // Decrements the reference count of `dir`.
 //
 // Once the reference count reaches zero, the directory will be closed and all
-// resources associated with it will be freed. If [method@GLib.Dir.close] is
+// resources associated with it will be freed. If [Dir.Close] is
 // called when the reference count is greater than zero, the directory is closed
 // but the `GDir` structure will not be freed until its reference count reaches
 // zero.
 //
 // It is an error to call any of the `GDir` methods other than
-// [method@GLib.Dir.ref] and [method@GLib.Dir.unref] on a `GDir` after calling
-// [method@GLib.Dir.close] on it.
+// [Dir.Ref] and [Dir.Unref] on a `GDir` after calling
+// [Dir.Close] on it.
 func (x *Dir) Unref() {
 
 	xDirUnref(x.GoPointer())
@@ -121,7 +120,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -130,12 +129,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xDirGLibType, libs, "g_dir_get_type")
 
-	core.PuregoSafeRegister(&xDirOpen, libs, "g_dir_open")
+	core.PuregoRegisterSince(&xDirOpen, libs, "g_dir_open", "GLIB", "")
 
-	core.PuregoSafeRegister(&xDirClose, libs, "g_dir_close")
-	core.PuregoSafeRegister(&xDirReadName, libs, "g_dir_read_name")
-	core.PuregoSafeRegister(&xDirRef, libs, "g_dir_ref")
-	core.PuregoSafeRegister(&xDirRewind, libs, "g_dir_rewind")
-	core.PuregoSafeRegister(&xDirUnref, libs, "g_dir_unref")
+	core.PuregoRegisterSince(&xDirClose, libs, "g_dir_close", "GLIB", "")
+	core.PuregoRegisterSince(&xDirReadName, libs, "g_dir_read_name", "GLIB", "")
+	core.PuregoRegisterSince(&xDirRef, libs, "g_dir_ref", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xDirRewind, libs, "g_dir_rewind", "GLIB", "")
+	core.PuregoRegisterSince(&xDirUnref, libs, "g_dir_unref", "GLIB", "2.80")
 
 }
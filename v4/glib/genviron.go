@@ -2,14 +2,13 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xEnvironGetenv func([]string, string) string
 
-// Returns the value of the environment variable @variable in the
-// provided list @envp.
+// Returns the value of the environment variable variable in the
+// provided list envp.
 func EnvironGetenv(EnvpVar []string, VariableVar string) string {
 
 	cret := xEnvironGetenv(EnvpVar, VariableVar)
@@ -17,45 +16,45 @@ func EnvironGetenv(EnvpVar []string, VariableVar string) string {
 	return cret
 }
 
-var xEnvironSetenv func([]string, string, string, bool) []string
+var xEnvironSetenv func([]string, string, string, bool) uintptr
 
-// Sets the environment variable @variable in the provided list
-// @envp to @value.
+// Sets the environment variable variable in the provided list
+// envp to value.
 func EnvironSetenv(EnvpVar []string, VariableVar string, ValueVar string, OverwriteVar bool) []string {
 
 	cret := xEnvironSetenv(EnvpVar, VariableVar, ValueVar, OverwriteVar)
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xEnvironUnsetenv func([]string, string) []string
+var xEnvironUnsetenv func([]string, string) uintptr
 
-// Removes the environment variable @variable from the provided
-// environment @envp.
+// Removes the environment variable variable from the provided
+// environment envp.
 func EnvironUnsetenv(EnvpVar []string, VariableVar string) []string {
 
 	cret := xEnvironUnsetenv(EnvpVar, VariableVar)
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xGetEnviron func() []string
+var xGetEnviron func() uintptr
 
 // Gets the list of environment variables for the current process.
 //
-// The list is %NULL terminated and each item in the list is of the
+// The list is nil terminated and each item in the list is of the
 // form 'NAME=VALUE'.
 //
 // This is equivalent to direct access to the 'environ' global variable,
 // except portable.
 //
 // The return value is freshly allocated and it should be freed with
-// g_strfreev() when it is no longer needed.
+// [Strfreev] when it is no longer needed.
 func GetEnviron() []string {
 
 	cret := xGetEnviron()
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xGetenv func(string) string
@@ -74,21 +73,21 @@ func Getenv(VariableVar string) string {
 	return cret
 }
 
-var xListenv func() []string
+var xListenv func() uintptr
 
 // Gets the names of all variables set in the environment.
 //
 // Programs that want to be portable to Windows should typically use
-// this function and g_getenv() instead of using the environ array
+// this function and [Getenv] instead of using the environ array
 // from the C library directly. On Windows, the strings in the environ
 // array are in system codepage encoding, while in most of the typical
 // use cases for environment variables in GLib-using programs you want
-// the UTF-8 encoding that this function and g_getenv() provide.
+// the UTF-8 encoding that this function and [Getenv] provide.
 func Listenv() []string {
 
 	cret := xListenv()
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xSetenv func(string, string, bool) bool
@@ -102,16 +101,16 @@ var xSetenv func(string, string, bool) bool
 //
 // You should be mindful of the fact that environment variable handling
 // in UNIX is not thread-safe, and your program may crash if one thread
-// calls g_setenv() while another thread is calling getenv(). (And note
+// calls [Setenv] while another thread is calling getenv(). (And note
 // that many functions, such as gettext(), call getenv() internally.)
 // This function is only safe to use at the very start of your program,
 // before creating any other threads (or creating objects that create
 // worker threads of their own).
 //
 // If you need to set up the environment for a child process, you can
-// use g_get_environ() to get an environment array, modify that with
-// g_environ_setenv() and g_environ_unsetenv(), and then pass that
-// array directly to execvpe(), g_spawn_async(), or the like.
+// use [GetEnviron] to get an environment array, modify that with
+// [EnvironSetenv] and [EnvironUnsetenv], and then pass that
+// array directly to execvpe(), [SpawnAsync], or the like.
 func Setenv(VariableVar string, ValueVar string, OverwriteVar bool) bool {
 
 	cret := xSetenv(VariableVar, ValueVar, OverwriteVar)
@@ -128,16 +127,16 @@ var xUnsetenv func(string)
 //
 // You should be mindful of the fact that environment variable handling
 // in UNIX is not thread-safe, and your program may crash if one thread
-// calls g_unsetenv() while another thread is calling getenv(). (And note
+// calls [Unsetenv] while another thread is calling getenv(). (And note
 // that many functions, such as gettext(), call getenv() internally.) This
 // function is only safe to use at the very start of your program, before
 // creating any other threads (or creating objects that create worker
 // threads of their own).
 //
 // If you need to set up the environment for a child process, you can
-// use g_get_environ() to get an environment array, modify that with
-// g_environ_setenv() and g_environ_unsetenv(), and then pass that
-// array directly to execvpe(), g_spawn_async(), or the like.
+// use [GetEnviron] to get an environment array, modify that with
+// [EnvironSetenv] and [EnvironUnsetenv], and then pass that
+// array directly to execvpe(), [SpawnAsync], or the like.
 func Unsetenv(VariableVar string) {
 
 	xUnsetenv(VariableVar)
@@ -149,20 +148,20 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xEnvironGetenv, libs, "g_environ_getenv")
-	core.PuregoSafeRegister(&xEnvironSetenv, libs, "g_environ_setenv")
-	core.PuregoSafeRegister(&xEnvironUnsetenv, libs, "g_environ_unsetenv")
-	core.PuregoSafeRegister(&xGetEnviron, libs, "g_get_environ")
-	core.PuregoSafeRegister(&xGetenv, libs, "g_getenv")
-	core.PuregoSafeRegister(&xListenv, libs, "g_listenv")
-	core.PuregoSafeRegister(&xSetenv, libs, "g_setenv")
-	core.PuregoSafeRegister(&xUnsetenv, libs, "g_unsetenv")
+	core.PuregoRegisterSince(&xEnvironGetenv, libs, "g_environ_getenv", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xEnvironSetenv, libs, "g_environ_setenv", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xEnvironUnsetenv, libs, "g_environ_unsetenv", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xGetEnviron, libs, "g_get_environ", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xGetenv, libs, "g_getenv", "GLIB", "")
+	core.PuregoRegisterSince(&xListenv, libs, "g_listenv", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xSetenv, libs, "g_setenv", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xUnsetenv, libs, "g_unsetenv", "GLIB", "2.4")
 
 }
@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -15,17 +15,17 @@ import (
 // freed, and should free the error's private data fields.
 //
 // Normally, it is better to use G_DEFINE_EXTENDED_ERROR(), as it
-// already takes care of getting the private data from @error.
+// already takes care of getting the private data from error.
 type ErrorClearFunc func(*Error)
 
 // Specifies the type of function which is called when an extended
 // error instance is copied. It is passed the pointer to the
 // destination error and source error, and should copy only the fields
-// of the private data from @src_error to @dest_error.
+// of the private data from src_error to dest_error.
 //
 // Normally, it is better to use G_DEFINE_EXTENDED_ERROR(), as it
-// already takes care of getting the private data from @src_error and
-// @dest_error.
+// already takes care of getting the private data from src_error and
+// dest_error.
 type ErrorCopyFunc func(*Error, *Error)
 
 // Specifies the type of function which is called just after an
@@ -34,7 +34,7 @@ type ErrorCopyFunc func(*Error, *Error)
 // received with the generated `*_get_private()` function.
 //
 // Normally, it is better to use G_DEFINE_EXTENDED_ERROR(), as it
-// already takes care of getting the private data from @error.
+// already takes care of getting the private data from error.
 type ErrorInitFunc func(*Error)
 
 // The `GError` structure contains information about
@@ -61,19 +61,21 @@ func (x *Error) GoPointer() uintptr {
 
 var xNewError func(Quark, int, string, ...interface{}) *Error
 
-// Creates a new #GError with the given @domain and @code,
-// and a message formatted with @format.
+// Creates a new [Error] with the given domain and code,
+// and a message formatted with format.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func NewError(DomainVar Quark, CodeVar int, FormatVar string, varArgs ...interface{}) *Error {
 
-	cret := xNewError(DomainVar, CodeVar, FormatVar, varArgs...)
+	cret := xNewError(DomainVar, CodeVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 	return cret
 }
 
 var xNewErrorLiteral func(Quark, int, string) *Error
 
-// Creates a new #GError; unlike g_error_new(), @message is
+// Creates a new [Error]; unlike [NewError], message is
 // not a printf()-style format string. Use this function if
-// @message contains text you don't have control over,
+// message contains text you don't have control over,
 // that could include printf() escape sequences.
 func NewErrorLiteral(DomainVar Quark, CodeVar int, MessageVar string) *Error {
 
@@ -83,8 +85,8 @@ func NewErrorLiteral(DomainVar Quark, CodeVar int, MessageVar string) *Error {
 
 var xNewErrorValist func(Quark, int, string, []interface{}) *Error
 
-// Creates a new #GError with the given @domain and @code,
-// and a message formatted with @format.
+// Creates a new [Error] with the given domain and code,
+// and a message formatted with format.
 func NewErrorValist(DomainVar Quark, CodeVar int, FormatVar string, ArgsVar []interface{}) *Error {
 
 	cret := xNewErrorValist(DomainVar, CodeVar, FormatVar, ArgsVar)
@@ -93,7 +95,7 @@ func NewErrorValist(DomainVar Quark, CodeVar int, FormatVar string, ArgsVar []in
 
 var xErrorCopy func(uintptr) *Error
 
-// Makes a copy of @error.
+// Makes a copy of error.
 func (x *Error) Copy() *Error {
 
 	cret := xErrorCopy(x.GoPointer())
@@ -102,7 +104,7 @@ func (x *Error) Copy() *Error {
 
 var xErrorFree func(uintptr)
 
-// Frees a #GError and associated resources.
+// Frees a [Error] and associated resources.
 func (x *Error) Free() {
 
 	xErrorFree(x.GoPointer())
@@ -111,11 +113,11 @@ func (x *Error) Free() {
 
 var xErrorMatches func(uintptr, Quark, int) bool
 
-// Returns %TRUE if @error matches @domain and @code, %FALSE
-// otherwise. In particular, when @error is %NULL, %FALSE will
+// Returns true if error matches domain and code, false
+// otherwise. In particular, when error is nil, false will
 // be returned.
 //
-// If @domain contains a `FAILED` (or otherwise generic) error code,
+// If domain contains a `FAILED` (or otherwise generic) error code,
 // you should generally not check for it explicitly, but should
 // instead treat any not-explicitly-recognized error code as being
 // equivalent to the `FAILED` code. This way, if the domain is
@@ -129,15 +131,15 @@ func (x *Error) Matches(DomainVar Quark, CodeVar int) bool {
 
 var xClearError func()
 
-// If @err or `*err` is %NULL, does nothing. Otherwise,
-// calls g_error_free() on `*err` and sets `*err` to %NULL.
+// If err or `*err` is nil, does nothing. Otherwise,
+// calls [Error.Free] on `*err` and sets `*err` to nil.
 func ClearError() error {
 	var cerr *Error
 
 	xClearError()
 
 	if cerr == nil {
-		return nil
+		return core.PlatformError
 	}
 	return cerr
 
@@ -145,9 +147,9 @@ func ClearError() error {
 
 var xErrorDomainRegister func(string, uint, uintptr, uintptr, uintptr) Quark
 
-// This function registers an extended #GError domain.
-// @error_type_name will be duplicated. Otherwise does the same as
-// g_error_domain_register_static().
+// This function registers an extended [Error] domain.
+// error_type_name will be duplicated. Otherwise does the same as
+// [ErrorDomainRegisterStatic].
 func ErrorDomainRegister(ErrorTypeNameVar string, ErrorTypePrivateSizeVar uint, ErrorTypeInitVar *ErrorInitFunc, ErrorTypeCopyVar *ErrorCopyFunc, ErrorTypeClearVar *ErrorClearFunc) Quark {
 
 	var ErrorTypeInitVarRef uintptr
@@ -157,11 +159,12 @@ func ErrorDomainRegister(ErrorTypeNameVar string, ErrorTypePrivateSizeVar uint,
 			ErrorTypeInitVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeInitVar
 				cbFn(arg0)
 			}
-			ErrorTypeInitVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeInitVarPtr, ErrorTypeInitVarRef, ErrorTypeInitVar)
+			ErrorTypeInitVarRef = core.NewCallback(fcb)
+			ErrorTypeInitVarRef = SaveCallbackWithClosure(ErrorTypeInitVarPtr, ErrorTypeInitVarRef, ErrorTypeInitVar)
 		}
 	}
 
@@ -172,11 +175,12 @@ func ErrorDomainRegister(ErrorTypeNameVar string, ErrorTypePrivateSizeVar uint,
 			ErrorTypeCopyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error, arg1 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeCopyVar
 				cbFn(arg0, arg1)
 			}
-			ErrorTypeCopyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeCopyVarPtr, ErrorTypeCopyVarRef, ErrorTypeCopyVar)
+			ErrorTypeCopyVarRef = core.NewCallback(fcb)
+			ErrorTypeCopyVarRef = SaveCallbackWithClosure(ErrorTypeCopyVarPtr, ErrorTypeCopyVarRef, ErrorTypeCopyVar)
 		}
 	}
 
@@ -187,11 +191,12 @@ func ErrorDomainRegister(ErrorTypeNameVar string, ErrorTypePrivateSizeVar uint,
 			ErrorTypeClearVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeClearVar
 				cbFn(arg0)
 			}
-			ErrorTypeClearVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeClearVarPtr, ErrorTypeClearVarRef, ErrorTypeClearVar)
+			ErrorTypeClearVarRef = core.NewCallback(fcb)
+			ErrorTypeClearVarRef = SaveCallbackWithClosure(ErrorTypeClearVarPtr, ErrorTypeClearVarRef, ErrorTypeClearVar)
 		}
 	}
 
@@ -202,19 +207,19 @@ func ErrorDomainRegister(ErrorTypeNameVar string, ErrorTypePrivateSizeVar uint,
 
 var xErrorDomainRegisterStatic func(string, uint, uintptr, uintptr, uintptr) Quark
 
-// This function registers an extended #GError domain.
+// This function registers an extended [Error] domain.
 //
-// @error_type_name should not be freed. @error_type_private_size must
+// error_type_name should not be freed. error_type_private_size must
 // be greater than 0.
 //
-// @error_type_init receives an initialized #GError and should then initialize
+// error_type_init receives an initialized [Error] and should then initialize
 // the private data.
 //
-// @error_type_copy is a function that receives both original and a copy
-// #GError and should copy the fields of the private error data. The standard
-// #GError fields are already handled.
+// error_type_copy is a function that receives both original and a copy
+// [Error] and should copy the fields of the private error data. The standard
+// [Error] fields are already handled.
 //
-// @error_type_clear receives the pointer to the error, and it should free the
+// error_type_clear receives the pointer to the error, and it should free the
 // fields of the private error data. It should not free the struct itself though.
 //
 // Normally, it is better to use G_DEFINE_EXTENDED_ERROR(), as it
@@ -228,11 +233,12 @@ func ErrorDomainRegisterStatic(ErrorTypeNameVar string, ErrorTypePrivateSizeVar
 			ErrorTypeInitVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeInitVar
 				cbFn(arg0)
 			}
-			ErrorTypeInitVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeInitVarPtr, ErrorTypeInitVarRef, ErrorTypeInitVar)
+			ErrorTypeInitVarRef = core.NewCallback(fcb)
+			ErrorTypeInitVarRef = SaveCallbackWithClosure(ErrorTypeInitVarPtr, ErrorTypeInitVarRef, ErrorTypeInitVar)
 		}
 	}
 
@@ -243,11 +249,12 @@ func ErrorDomainRegisterStatic(ErrorTypeNameVar string, ErrorTypePrivateSizeVar
 			ErrorTypeCopyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error, arg1 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeCopyVar
 				cbFn(arg0, arg1)
 			}
-			ErrorTypeCopyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeCopyVarPtr, ErrorTypeCopyVarRef, ErrorTypeCopyVar)
+			ErrorTypeCopyVarRef = core.NewCallback(fcb)
+			ErrorTypeCopyVarRef = SaveCallbackWithClosure(ErrorTypeCopyVarPtr, ErrorTypeCopyVarRef, ErrorTypeCopyVar)
 		}
 	}
 
@@ -258,11 +265,12 @@ func ErrorDomainRegisterStatic(ErrorTypeNameVar string, ErrorTypePrivateSizeVar
 			ErrorTypeClearVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorTypeClearVar
 				cbFn(arg0)
 			}
-			ErrorTypeClearVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorTypeClearVarPtr, ErrorTypeClearVarRef, ErrorTypeClearVar)
+			ErrorTypeClearVarRef = core.NewCallback(fcb)
+			ErrorTypeClearVarRef = SaveCallbackWithClosure(ErrorTypeClearVarPtr, ErrorTypeClearVarRef, ErrorTypeClearVar)
 		}
 	}
 
@@ -273,22 +281,24 @@ func ErrorDomainRegisterStatic(ErrorTypeNameVar string, ErrorTypePrivateSizeVar
 
 var xPrefixError func(**Error, string, ...interface{})
 
-// Formats a string according to @format and prefix it to an existing
-// error message. If @err is %NULL (ie: no error variable) then do
+// Formats a string according to format and prefix it to an existing
+// error message. If err is nil (ie: no error variable) then do
 // nothing.
 //
-// If `*err` is %NULL (ie: an error variable is present but there is no
+// If `*err` is nil (ie: an error variable is present but there is no
 // error condition) then also do nothing.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func PrefixError(ErrVar **Error, FormatVar string, varArgs ...interface{}) {
 
-	xPrefixError(ErrVar, FormatVar, varArgs...)
+	xPrefixError(ErrVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xPrefixErrorLiteral func(**Error, string)
 
-// Prefixes @prefix to an existing error message. If @err or `*err` is
-// %NULL (i.e.: no error variable) then do nothing.
+// Prefixes prefix to an existing error message. If err or `*err` is
+// nil (i.e.: no error variable) then do nothing.
 func PrefixErrorLiteral(ErrVar **Error, PrefixVar string) {
 
 	xPrefixErrorLiteral(ErrVar, PrefixVar)
@@ -297,13 +307,13 @@ func PrefixErrorLiteral(ErrVar **Error, PrefixVar string) {
 
 var xPropagateError func(**Error, *Error)
 
-// If @dest is %NULL, free @src; otherwise, moves @src into `*dest`.
-// The error variable @dest points to must be %NULL.
+// If dest is nil, free src; otherwise, moves src into `*dest`.
+// The error variable dest points to must be nil.
 //
-// @src must be non-%NULL.
+// src must be non-nil.
 //
-// Note that @src is no longer valid after this call. If you want
-// to keep using the same GError*, you need to set it to %NULL
+// Note that src is no longer valid after this call. If you want
+// to keep using the same GError*, you need to set it to nil
 // after calling this function on it.
 func PropagateError(DestVar **Error, SrcVar *Error) {
 
@@ -313,31 +323,35 @@ func PropagateError(DestVar **Error, SrcVar *Error) {
 
 var xPropagatePrefixedError func(**Error, *Error, string, ...interface{})
 
-// If @dest is %NULL, free @src; otherwise, moves @src into `*dest`.
-// `*dest` must be %NULL. After the move, add a prefix as with
-// g_prefix_error().
+// If dest is nil, free src; otherwise, moves src into `*dest`.
+// `*dest` must be nil. After the move, add a prefix as with
+// [PrefixError].
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func PropagatePrefixedError(DestVar **Error, SrcVar *Error, FormatVar string, varArgs ...interface{}) {
 
-	xPropagatePrefixedError(DestVar, SrcVar, FormatVar, varArgs...)
+	xPropagatePrefixedError(DestVar, SrcVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xSetError func(**Error, Quark, int, string, ...interface{})
 
-// Does nothing if @err is %NULL; if @err is non-%NULL, then `*err`
-// must be %NULL. A new #GError is created and assigned to `*err`.
+// Does nothing if err is nil; if err is non-nil, then `*err`
+// must be nil. A new [Error] is created and assigned to `*err`.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func SetError(ErrVar **Error, DomainVar Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
 
-	xSetError(ErrVar, DomainVar, CodeVar, FormatVar, varArgs...)
+	xSetError(ErrVar, DomainVar, CodeVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xSetErrorLiteral func(**Error, Quark, int, string)
 
-// Does nothing if @err is %NULL; if @err is non-%NULL, then `*err`
-// must be %NULL. A new #GError is created and assigned to `*err`.
-// Unlike g_set_error(), @message is not a printf()-style format string.
-// Use this function if @message contains text you don't have control over,
+// Does nothing if err is nil; if err is non-nil, then `*err`
+// must be nil. A new [Error] is created and assigned to `*err`.
+// Unlike [SetError], message is not a printf()-style format string.
+// Use this function if message contains text you don't have control over,
 // that could include printf() escape sequences.
 func SetErrorLiteral(ErrVar **Error, DomainVar Quark, CodeVar int, MessageVar string) {
 
@@ -350,31 +364,31 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xClearError, libs, "g_clear_error")
-	core.PuregoSafeRegister(&xErrorDomainRegister, libs, "g_error_domain_register")
-	core.PuregoSafeRegister(&xErrorDomainRegisterStatic, libs, "g_error_domain_register_static")
-	core.PuregoSafeRegister(&xPrefixError, libs, "g_prefix_error")
-	core.PuregoSafeRegister(&xPrefixErrorLiteral, libs, "g_prefix_error_literal")
-	core.PuregoSafeRegister(&xPropagateError, libs, "g_propagate_error")
-	core.PuregoSafeRegister(&xPropagatePrefixedError, libs, "g_propagate_prefixed_error")
-	core.PuregoSafeRegister(&xSetError, libs, "g_set_error")
-	core.PuregoSafeRegister(&xSetErrorLiteral, libs, "g_set_error_literal")
+	core.PuregoRegisterSince(&xClearError, libs, "g_clear_error", "GLIB", "")
+	core.PuregoRegisterSince(&xErrorDomainRegister, libs, "g_error_domain_register", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xErrorDomainRegisterStatic, libs, "g_error_domain_register_static", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xPrefixError, libs, "g_prefix_error", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xPrefixErrorLiteral, libs, "g_prefix_error_literal", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xPropagateError, libs, "g_propagate_error", "GLIB", "")
+	core.PuregoRegisterSince(&xPropagatePrefixedError, libs, "g_propagate_prefixed_error", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xSetError, libs, "g_set_error", "GLIB", "")
+	core.PuregoRegisterSince(&xSetErrorLiteral, libs, "g_set_error_literal", "GLIB", "2.18")
 
 	core.PuregoSafeRegister(&xErrorGLibType, libs, "g_error_get_type")
 
-	core.PuregoSafeRegister(&xNewError, libs, "g_error_new")
-	core.PuregoSafeRegister(&xNewErrorLiteral, libs, "g_error_new_literal")
-	core.PuregoSafeRegister(&xNewErrorValist, libs, "g_error_new_valist")
+	core.PuregoRegisterSince(&xNewError, libs, "g_error_new", "GLIB", "")
+	core.PuregoRegisterSince(&xNewErrorLiteral, libs, "g_error_new_literal", "GLIB", "")
+	core.PuregoRegisterSince(&xNewErrorValist, libs, "g_error_new_valist", "GLIB", "2.22")
 
-	core.PuregoSafeRegister(&xErrorCopy, libs, "g_error_copy")
-	core.PuregoSafeRegister(&xErrorFree, libs, "g_error_free")
-	core.PuregoSafeRegister(&xErrorMatches, libs, "g_error_matches")
+	core.PuregoRegisterSince(&xErrorCopy, libs, "g_error_copy", "GLIB", "")
+	core.PuregoRegisterSince(&xErrorFree, libs, "g_error_free", "GLIB", "")
+	core.PuregoRegisterSince(&xErrorMatches, libs, "g_error_matches", "GLIB", "")
 
 }
@@ -2,13 +2,15 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+	"strings"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// Flags to pass to g_file_set_contents_full() to affect its safety and
+// Flags to pass to [FileSetContentsFull] to affect its safety and
 // performance.
-type FileSetContentsFlags int
+type FileSetContentsFlags uint32
 
 const (
 
@@ -23,8 +25,8 @@ const (
 	GFileSetContentsConsistentValue FileSetContentsFlags = 1
 	// Guarantee file durability: after a crash, the
 	//   new version of the file will be available. On Unix systems this equates to
-	//   an `fsync()` on the file (if %G_FILE_SET_CONTENTS_CONSISTENT is unset), or
-	//   the effects of %G_FILE_SET_CONTENTS_CONSISTENT plus an `fsync()` on the
+	//   an `fsync()` on the file (if [GFileSetContentsConsistentValue] is unset), or
+	//   the effects of [GFileSetContentsConsistentValue] plus an `fsync()` on the
 	//   directory containing the file after calling `rename()`.
 	GFileSetContentsDurableValue FileSetContentsFlags = 2
 	// Only apply consistency and durability
@@ -34,28 +36,116 @@ const (
 	GFileSetContentsOnlyExistingValue FileSetContentsFlags = 4
 )
 
-// A test to perform on a file using g_file_test().
-type FileTest int
+// Has reports whether all bits of flags are set in x
+func (x FileSetContentsFlags) Has(flags FileSetContentsFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileSetContentsFlags) Set(flags FileSetContentsFlags) FileSetContentsFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileSetContentsFlags) Clear(flags FileSetContentsFlags) FileSetContentsFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileSetContentsFlags) String() string {
+	if x == 0 {
+		return "G_FILE_SET_CONTENTS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GFileSetContentsConsistentValue != 0 && x&GFileSetContentsConsistentValue == GFileSetContentsConsistentValue {
+		names = append(names, "G_FILE_SET_CONTENTS_CONSISTENT")
+		rest &^= GFileSetContentsConsistentValue
+	}
+	if rest&GFileSetContentsDurableValue != 0 && x&GFileSetContentsDurableValue == GFileSetContentsDurableValue {
+		names = append(names, "G_FILE_SET_CONTENTS_DURABLE")
+		rest &^= GFileSetContentsDurableValue
+	}
+	if rest&GFileSetContentsOnlyExistingValue != 0 && x&GFileSetContentsOnlyExistingValue == GFileSetContentsOnlyExistingValue {
+		names = append(names, "G_FILE_SET_CONTENTS_ONLY_EXISTING")
+		rest &^= GFileSetContentsOnlyExistingValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// A test to perform on a file using [NewFileTest].
+type FileTest uint32
 
 const (
 
-	// %TRUE if the file is a regular file
-	//     (not a directory). Note that this test will also return %TRUE
+	// true if the file is a regular file
+	//     (not a directory). Note that this test will also return true
 	//     if the tested file is a symlink to a regular file.
 	GFileTestIsRegularValue FileTest = 1
-	// %TRUE if the file is a symlink.
+	// true if the file is a symlink.
 	GFileTestIsSymlinkValue FileTest = 2
-	// %TRUE if the file is a directory.
+	// true if the file is a directory.
 	GFileTestIsDirValue FileTest = 4
-	// %TRUE if the file is executable.
+	// true if the file is executable.
 	GFileTestIsExecutableValue FileTest = 8
-	// %TRUE if the file exists. It may or may not
+	// true if the file exists. It may or may not
 	//     be a regular file.
 	GFileTestExistsValue FileTest = 16
 )
 
-// Values corresponding to @errno codes returned from file operations
-// on UNIX. Unlike @errno codes, GFileError values are available on
+// Has reports whether all bits of flags are set in x
+func (x FileTest) Has(flags FileTest) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FileTest) Set(flags FileTest) FileTest {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FileTest) Clear(flags FileTest) FileTest {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FileTest) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GFileTestIsRegularValue != 0 && x&GFileTestIsRegularValue == GFileTestIsRegularValue {
+		names = append(names, "G_FILE_TEST_IS_REGULAR")
+		rest &^= GFileTestIsRegularValue
+	}
+	if rest&GFileTestIsSymlinkValue != 0 && x&GFileTestIsSymlinkValue == GFileTestIsSymlinkValue {
+		names = append(names, "G_FILE_TEST_IS_SYMLINK")
+		rest &^= GFileTestIsSymlinkValue
+	}
+	if rest&GFileTestIsDirValue != 0 && x&GFileTestIsDirValue == GFileTestIsDirValue {
+		names = append(names, "G_FILE_TEST_IS_DIR")
+		rest &^= GFileTestIsDirValue
+	}
+	if rest&GFileTestIsExecutableValue != 0 && x&GFileTestIsExecutableValue == GFileTestIsExecutableValue {
+		names = append(names, "G_FILE_TEST_IS_EXECUTABLE")
+		rest &^= GFileTestIsExecutableValue
+	}
+	if rest&GFileTestExistsValue != 0 && x&GFileTestExistsValue == GFileTestExistsValue {
+		names = append(names, "G_FILE_TEST_EXISTS")
+		rest &^= GFileTestExistsValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Values corresponding to errno codes returned from file operations
+// on UNIX. Unlike errno codes, GFileError values are available on
 // all systems, even Windows. The exact meaning of each code depends
 // on what sort of file operation you were performing; the UNIX
 // documentation gives more details. The following error code descriptions
@@ -159,21 +249,242 @@ const (
 	GFileErrorNosysValue FileError = 23
 	// Does not correspond to a UNIX error code; this
 	//    is the standard "failed for unspecified reason" error code present
-	//    in all #GError error code enumerations. Returned if no specific
+	//    in all [Error] error code enumerations. Returned if no specific
 	//    code applies.
 	GFileErrorFailedValue FileError = 24
 )
 
-var xBasename func(string) string
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (FileError) ErrorDomain() string {
+	return "g-file-error-quark"
+}
 
-// Gets the name of the file without any leading directory
-// components. It returns a pointer into the given file name
-// string.
-func Basename(FileNameVar string) string {
+// Error returns the C name of the error code
+func (x FileError) Error() string {
+	switch x {
+	case GFileErrorExistValue:
+		return "G_FILE_ERROR_EXIST"
+	case GFileErrorIsdirValue:
+		return "G_FILE_ERROR_ISDIR"
+	case GFileErrorAccesValue:
+		return "G_FILE_ERROR_ACCES"
+	case GFileErrorNametoolongValue:
+		return "G_FILE_ERROR_NAMETOOLONG"
+	case GFileErrorNoentValue:
+		return "G_FILE_ERROR_NOENT"
+	case GFileErrorNotdirValue:
+		return "G_FILE_ERROR_NOTDIR"
+	case GFileErrorNxioValue:
+		return "G_FILE_ERROR_NXIO"
+	case GFileErrorNodevValue:
+		return "G_FILE_ERROR_NODEV"
+	case GFileErrorRofsValue:
+		return "G_FILE_ERROR_ROFS"
+	case GFileErrorTxtbsyValue:
+		return "G_FILE_ERROR_TXTBSY"
+	case GFileErrorFaultValue:
+		return "G_FILE_ERROR_FAULT"
+	case GFileErrorLoopValue:
+		return "G_FILE_ERROR_LOOP"
+	case GFileErrorNospcValue:
+		return "G_FILE_ERROR_NOSPC"
+	case GFileErrorNomemValue:
+		return "G_FILE_ERROR_NOMEM"
+	case GFileErrorMfileValue:
+		return "G_FILE_ERROR_MFILE"
+	case GFileErrorNfileValue:
+		return "G_FILE_ERROR_NFILE"
+	case GFileErrorBadfValue:
+		return "G_FILE_ERROR_BADF"
+	case GFileErrorInvalValue:
+		return "G_FILE_ERROR_INVAL"
+	case GFileErrorPipeValue:
+		return "G_FILE_ERROR_PIPE"
+	case GFileErrorAgainValue:
+		return "G_FILE_ERROR_AGAIN"
+	case GFileErrorIntrValue:
+		return "G_FILE_ERROR_INTR"
+	case GFileErrorIoValue:
+		return "G_FILE_ERROR_IO"
+	case GFileErrorPermValue:
+		return "G_FILE_ERROR_PERM"
+	case GFileErrorNosysValue:
+		return "G_FILE_ERROR_NOSYS"
+	case GFileErrorFailedValue:
+		return "G_FILE_ERROR_FAILED"
+	}
+	return fmt.Sprintf("g-file-error-quark code %d", int(x))
+}
 
-	cret := xBasename(FileNameVar)
+// String returns the C name of the value, e.g. for debugging output
+func (x FileError) String() string {
+	switch x {
+	case GFileErrorExistValue:
+		return "G_FILE_ERROR_EXIST"
+	case GFileErrorIsdirValue:
+		return "G_FILE_ERROR_ISDIR"
+	case GFileErrorAccesValue:
+		return "G_FILE_ERROR_ACCES"
+	case GFileErrorNametoolongValue:
+		return "G_FILE_ERROR_NAMETOOLONG"
+	case GFileErrorNoentValue:
+		return "G_FILE_ERROR_NOENT"
+	case GFileErrorNotdirValue:
+		return "G_FILE_ERROR_NOTDIR"
+	case GFileErrorNxioValue:
+		return "G_FILE_ERROR_NXIO"
+	case GFileErrorNodevValue:
+		return "G_FILE_ERROR_NODEV"
+	case GFileErrorRofsValue:
+		return "G_FILE_ERROR_ROFS"
+	case GFileErrorTxtbsyValue:
+		return "G_FILE_ERROR_TXTBSY"
+	case GFileErrorFaultValue:
+		return "G_FILE_ERROR_FAULT"
+	case GFileErrorLoopValue:
+		return "G_FILE_ERROR_LOOP"
+	case GFileErrorNospcValue:
+		return "G_FILE_ERROR_NOSPC"
+	case GFileErrorNomemValue:
+		return "G_FILE_ERROR_NOMEM"
+	case GFileErrorMfileValue:
+		return "G_FILE_ERROR_MFILE"
+	case GFileErrorNfileValue:
+		return "G_FILE_ERROR_NFILE"
+	case GFileErrorBadfValue:
+		return "G_FILE_ERROR_BADF"
+	case GFileErrorInvalValue:
+		return "G_FILE_ERROR_INVAL"
+	case GFileErrorPipeValue:
+		return "G_FILE_ERROR_PIPE"
+	case GFileErrorAgainValue:
+		return "G_FILE_ERROR_AGAIN"
+	case GFileErrorIntrValue:
+		return "G_FILE_ERROR_INTR"
+	case GFileErrorIoValue:
+		return "G_FILE_ERROR_IO"
+	case GFileErrorPermValue:
+		return "G_FILE_ERROR_PERM"
+	case GFileErrorNosysValue:
+		return "G_FILE_ERROR_NOSYS"
+	case GFileErrorFailedValue:
+		return "G_FILE_ERROR_FAILED"
+	}
+	return fmt.Sprintf("FileError(%d)", int(x))
+}
 
-	return cret
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileError) Nick() string {
+	switch x {
+	case GFileErrorExistValue:
+		return "exist"
+	case GFileErrorIsdirValue:
+		return "isdir"
+	case GFileErrorAccesValue:
+		return "acces"
+	case GFileErrorNametoolongValue:
+		return "nametoolong"
+	case GFileErrorNoentValue:
+		return "noent"
+	case GFileErrorNotdirValue:
+		return "notdir"
+	case GFileErrorNxioValue:
+		return "nxio"
+	case GFileErrorNodevValue:
+		return "nodev"
+	case GFileErrorRofsValue:
+		return "rofs"
+	case GFileErrorTxtbsyValue:
+		return "txtbsy"
+	case GFileErrorFaultValue:
+		return "fault"
+	case GFileErrorLoopValue:
+		return "loop"
+	case GFileErrorNospcValue:
+		return "nospc"
+	case GFileErrorNomemValue:
+		return "nomem"
+	case GFileErrorMfileValue:
+		return "mfile"
+	case GFileErrorNfileValue:
+		return "nfile"
+	case GFileErrorBadfValue:
+		return "badf"
+	case GFileErrorInvalValue:
+		return "inval"
+	case GFileErrorPipeValue:
+		return "pipe"
+	case GFileErrorAgainValue:
+		return "again"
+	case GFileErrorIntrValue:
+		return "intr"
+	case GFileErrorIoValue:
+		return "io"
+	case GFileErrorPermValue:
+		return "perm"
+	case GFileErrorNosysValue:
+		return "nosys"
+	case GFileErrorFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// FileErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileErrorFromString(s string) (FileError, bool) {
+	switch s {
+	case "G_FILE_ERROR_EXIST", "exist":
+		return GFileErrorExistValue, true
+	case "G_FILE_ERROR_ISDIR", "isdir":
+		return GFileErrorIsdirValue, true
+	case "G_FILE_ERROR_ACCES", "acces":
+		return GFileErrorAccesValue, true
+	case "G_FILE_ERROR_NAMETOOLONG", "nametoolong":
+		return GFileErrorNametoolongValue, true
+	case "G_FILE_ERROR_NOENT", "noent":
+		return GFileErrorNoentValue, true
+	case "G_FILE_ERROR_NOTDIR", "notdir":
+		return GFileErrorNotdirValue, true
+	case "G_FILE_ERROR_NXIO", "nxio":
+		return GFileErrorNxioValue, true
+	case "G_FILE_ERROR_NODEV", "nodev":
+		return GFileErrorNodevValue, true
+	case "G_FILE_ERROR_ROFS", "rofs":
+		return GFileErrorRofsValue, true
+	case "G_FILE_ERROR_TXTBSY", "txtbsy":
+		return GFileErrorTxtbsyValue, true
+	case "G_FILE_ERROR_FAULT", "fault":
+		return GFileErrorFaultValue, true
+	case "G_FILE_ERROR_LOOP", "loop":
+		return GFileErrorLoopValue, true
+	case "G_FILE_ERROR_NOSPC", "nospc":
+		return GFileErrorNospcValue, true
+	case "G_FILE_ERROR_NOMEM", "nomem":
+		return GFileErrorNomemValue, true
+	case "G_FILE_ERROR_MFILE", "mfile":
+		return GFileErrorMfileValue, true
+	case "G_FILE_ERROR_NFILE", "nfile":
+		return GFileErrorNfileValue, true
+	case "G_FILE_ERROR_BADF", "badf":
+		return GFileErrorBadfValue, true
+	case "G_FILE_ERROR_INVAL", "inval":
+		return GFileErrorInvalValue, true
+	case "G_FILE_ERROR_PIPE", "pipe":
+		return GFileErrorPipeValue, true
+	case "G_FILE_ERROR_AGAIN", "again":
+		return GFileErrorAgainValue, true
+	case "G_FILE_ERROR_INTR", "intr":
+		return GFileErrorIntrValue, true
+	case "G_FILE_ERROR_IO", "io":
+		return GFileErrorIoValue, true
+	case "G_FILE_ERROR_PERM", "perm":
+		return GFileErrorPermValue, true
+	case "G_FILE_ERROR_NOSYS", "nosys":
+		return GFileErrorNosysValue, true
+	case "G_FILE_ERROR_FAILED", "failed":
+		return GFileErrorFailedValue, true
+	}
+	return 0, false
 }
 
 var xBuildFilename func(string, ...interface{}) string
@@ -195,7 +506,7 @@ var xBuildFilename func(string, ...interface{}) string
 // be a relative path.
 //
 // If you are building a path programmatically you may want to use
-// #GPathBuf instead.
+// [PathBuf] instead.
 func BuildFilename(FirstElementVar string, varArgs ...interface{}) string {
 
 	cret := xBuildFilename(FirstElementVar, varArgs...)
@@ -208,7 +519,7 @@ var xBuildFilenameValist func(string, []interface{}) string
 // Creates a filename from a list of elements using the correct
 // separator for the current platform.
 //
-// Behaves exactly like g_build_filename(), but takes the path elements
+// Behaves exactly like [BuildFilename], but takes the path elements
 // as a va_list.
 //
 // This function is mainly meant for implementing other variadic arguments
@@ -225,12 +536,12 @@ var xBuildFilenamev func([]string) string
 // Creates a filename from a vector of elements using the correct
 // separator for the current platform.
 //
-// This function behaves exactly like g_build_filename(), but takes the path
+// This function behaves exactly like [BuildFilename], but takes the path
 // elements as a string array, instead of varargs. This function is mainly
 // meant for language bindings.
 //
 // If you are building a path programmatically you may want to use
-// #GPathBuf instead.
+// [PathBuf] instead.
 func BuildFilenamev(ArgsVar []string) string {
 
 	cret := xBuildFilenamev(ArgsVar)
@@ -240,7 +551,7 @@ func BuildFilenamev(ArgsVar []string) string {
 
 var xBuildPath func(string, string, ...interface{}) string
 
-// Creates a path from a series of elements using @separator as the
+// Creates a path from a series of elements using separator as the
 // separator between elements.
 //
 // At the boundary between two elements, any trailing occurrences of
@@ -277,7 +588,7 @@ func BuildPath(SeparatorVar string, FirstElementVar string, varArgs ...interface
 
 var xBuildPathv func(string, []string) string
 
-// Behaves exactly like g_build_path(), but takes the path elements
+// Behaves exactly like [BuildPath], but takes the path elements
 // as a string array, instead of variadic arguments.
 //
 // This function is mainly meant for language bindings.
@@ -290,15 +601,15 @@ func BuildPathv(SeparatorVar string, ArgsVar []string) string {
 
 var xCanonicalizeFilename func(string, uintptr) string
 
-// Gets the canonical file name from @filename. All triple slashes are turned into
-// single slashes, and all `..` and `.`s resolved against @relative_to.
+// Gets the canonical file name from filename. All triple slashes are turned into
+// single slashes, and all `..` and `.`s resolved against relative_to.
 //
 // Symlinks are not followed, and the returned path is guaranteed to be absolute.
 //
-// If @filename is an absolute path, @relative_to is ignored. Otherwise,
-// @relative_to will be prepended to @filename to make it absolute. @relative_to
-// must be an absolute path, or %NULL. If @relative_to is %NULL, it'll fallback
-// to g_get_current_dir().
+// If filename is an absolute path, relative_to is ignored. Otherwise,
+// relative_to will be prepended to filename to make it absolute. relative_to
+// must be an absolute path, or nil. If relative_to is nil, it'll fallback
+// to [GetCurrentDir].
 //
 // This function never fails, and will canonicalize file paths even if they don't
 // exist.
@@ -317,15 +628,15 @@ func CanonicalizeFilename(FilenameVar string, RelativeToVar *string) string {
 var xDirMakeTmp func(uintptr, **Error) string
 
 // Creates a subdirectory in the preferred directory for temporary
-// files (as returned by g_get_tmp_dir()).
+// files (as returned by [GetTmpDir]).
 //
-// @tmpl should be a string in the GLib file name encoding containing
-// a sequence of six 'X' characters, as the parameter to g_mkstemp().
+// tmpl should be a string in the GLib file name encoding containing
+// a sequence of six 'X' characters, as the parameter to [Mkstemp].
 // However, unlike these functions, the template should only be a
 // basename, no directory components are allowed. If template is
-// %NULL, a default template is used.
+// nil, a default template is used.
 //
-// Note that in contrast to g_mkdtemp() (and mkdtemp()) @tmpl is not
+// Note that in contrast to [Mkdtemp] (and mkdtemp()) tmpl is not
 // modified, and might thus be a read-only literal string.
 func DirMakeTmp(TmplVar *string) (string, error) {
 	var cerr *Error
@@ -336,7 +647,7 @@ func DirMakeTmp(TmplVar *string) (string, error) {
 	cret := xDirMakeTmp(TmplVarPtr, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -344,15 +655,15 @@ func DirMakeTmp(TmplVar *string) (string, error) {
 
 var xFileErrorFromErrno func(int) FileError
 
-// Gets a #GFileError constant based on the passed-in @err_no.
+// Gets a [FileError] constant based on the passed-in err_no.
 //
 // For example, if you pass in `EEXIST` this function returns
-// %G_FILE_ERROR_EXIST. Unlike `errno` values, you can portably
-// assume that all #GFileError values will exist.
+// [GFileErrorExistValue]. Unlike `errno` values, you can portably
+// assume that all [FileError] values will exist.
 //
-// Normally a #GFileError value goes into a #GError returned
+// Normally a [FileError] value goes into a [Error] returned
 // from a function that manipulates files. So you would use
-// g_file_error_from_errno() when constructing a #GError.
+// [FileErrorFromErrno] when constructing a [Error].
 func FileErrorFromErrno(ErrNoVar int) FileError {
 
 	cret := xFileErrorFromErrno(ErrNoVar)
@@ -365,20 +676,20 @@ var xFileGetContents func(string, *[]byte, *uint, **Error) bool
 // Reads an entire file into allocated memory, with good error
 // checking.
 //
-// If the call was successful, it returns %TRUE and sets @contents to the file
-// contents and @length to the length of the file contents in bytes. The string
-// stored in @contents will be nul-terminated, so for text files you can pass
-// %NULL for the @length argument. If the call was not successful, it returns
-// %FALSE and sets @error. The error domain is %G_FILE_ERROR. Possible error
-// codes are those in the #GFileError enumeration. In the error case,
-// @contents is set to %NULL and @length is set to zero.
+// If the call was successful, it returns true and sets contents to the file
+// contents and length to the length of the file contents in bytes. The string
+// stored in contents will be nul-terminated, so for text files you can pass
+// nil for the length argument. If the call was not successful, it returns
+// false and sets error. The error domain is G_FILE_ERROR. Possible error
+// codes are those in the [FileError] enumeration. In the error case,
+// contents is set to nil and length is set to zero.
 func FileGetContents(FilenameVar string, ContentsVar *[]byte, LengthVar *uint) (bool, error) {
 	var cerr *Error
 
 	cret := xFileGetContents(FilenameVar, ContentsVar, LengthVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -387,19 +698,19 @@ func FileGetContents(FilenameVar string, ContentsVar *[]byte, LengthVar *uint) (
 var xFileOpenTmp func(uintptr, *string, **Error) int
 
 // Opens a file for writing in the preferred directory for temporary
-// files (as returned by g_get_tmp_dir()).
+// files (as returned by [GetTmpDir]).
 //
-// @tmpl should be a string in the GLib file name encoding containing
-// a sequence of six 'X' characters, as the parameter to g_mkstemp().
+// tmpl should be a string in the GLib file name encoding containing
+// a sequence of six 'X' characters, as the parameter to [Mkstemp].
 // However, unlike these functions, the template should only be a
 // basename, no directory components are allowed. If template is
-// %NULL, a default template is used.
+// nil, a default template is used.
 //
-// Note that in contrast to g_mkstemp() (and mkstemp()) @tmpl is not
+// Note that in contrast to [Mkstemp] (and mkstemp()) tmpl is not
 // modified, and might thus be a read-only literal string.
 //
-// Upon success, and if @name_used is non-%NULL, the actual name used
-// is returned in @name_used. This string should be freed with g_free()
+// Upon success, and if name_used is non-nil, the actual name used
+// is returned in name_used. This string should be freed with [Free]
 // when not needed any longer. The returned name is in the GLib file
 // name encoding.
 func FileOpenTmp(TmplVar *string, NameUsedVar *string) (int, error) {
@@ -411,7 +722,7 @@ func FileOpenTmp(TmplVar *string, NameUsedVar *string) (int, error) {
 	cret := xFileOpenTmp(TmplVarPtr, NameUsedVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -419,39 +730,34 @@ func FileOpenTmp(TmplVar *string, NameUsedVar *string) (int, error) {
 
 var xFileReadLink func(string, **Error) string
 
-// Reads the contents of the symbolic link @filename like the POSIX
+// Reads the contents of the symbolic link filename like the POSIX
 // `readlink()` function.
 //
 // The returned string is in the encoding used for filenames. Use
-// g_filename_to_utf8() to convert it to UTF-8.
+// [FilenameToUtf8] to convert it to UTF-8.
 //
-// The returned string may also be a relative path. Use g_build_filename()
+// The returned string may also be a relative path. Use [BuildFilename]
 // to convert it to an absolute path:
 //
-// |[&lt;!-- language="C" --&gt;
-// g_autoptr(GError) local_error = NULL;
-// g_autofree gchar *link_target = g_file_read_link ("/etc/localtime", &amp;local_error);
-//
-// if (local_error != NULL)
-//
-//	g_error ("Error reading link: %s", local_error-&gt;message);
-//
-// if (!g_path_is_absolute (link_target))
+//	g_autoptr(GError) local_error = NULL;
+//	g_autofree gchar *link_target = g_file_read_link ("/etc/localtime", &local_error);
 //
-//	{
-//	  g_autofree gchar *absolute_link_target = g_build_filename ("/etc", link_target, NULL);
-//	  g_free (link_target);
-//	  link_target = g_steal_pointer (&amp;absolute_link_target);
-//	}
+//	if (local_error != NULL)
+//	  g_error ("Error reading link: %s", local_error->message);
 //
-// ]|
+//	if (!g_path_is_absolute (link_target))
+//	  {
+//	    g_autofree gchar *absolute_link_target = g_build_filename ("/etc", link_target, NULL);
+//	    g_free (link_target);
+//	    link_target = g_steal_pointer (&absolute_link_target);
+//	  }
 func FileReadLink(FilenameVar string) (string, error) {
 	var cerr *Error
 
 	cret := xFileReadLink(FilenameVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -459,8 +765,8 @@ func FileReadLink(FilenameVar string) (string, error) {
 
 var xFileSetContents func(string, []byte, int, **Error) bool
 
-// Writes all of @contents to a file named @filename. This is a convenience
-// wrapper around calling g_file_set_contents_full() with `flags` set to
+// Writes all of contents to a file named filename. This is a convenience
+// wrapper around calling [FileSetContentsFull] with `flags` set to
 // `G_FILE_SET_CONTENTS_CONSISTENT | G_FILE_SET_CONTENTS_ONLY_EXISTING` and
 // `mode` set to `0666`.
 func FileSetContents(FilenameVar string, ContentsVar []byte, LengthVar int) (bool, error) {
@@ -469,7 +775,7 @@ func FileSetContents(FilenameVar string, ContentsVar []byte, LengthVar int) (boo
 	cret := xFileSetContents(FilenameVar, ContentsVar, LengthVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -477,41 +783,41 @@ func FileSetContents(FilenameVar string, ContentsVar []byte, LengthVar int) (boo
 
 var xFileSetContentsFull func(string, []byte, int, FileSetContentsFlags, int, **Error) bool
 
-// Writes all of @contents to a file named @filename, with good error checking.
-// If a file called @filename already exists it will be overwritten.
+// Writes all of contents to a file named filename, with good error checking.
+// If a file called filename already exists it will be overwritten.
 //
-// @flags control the properties of the write operation: whether it’s atomic,
+// flags control the properties of the write operation: whether it’s atomic,
 // and what the tradeoff is between returning quickly or being resilient to
 // system crashes.
 //
 // As this function performs file I/O, it is recommended to not call it anywhere
 // where blocking would cause problems, such as in the main loop of a graphical
-// application. In particular, if @flags has any value other than
-// %G_FILE_SET_CONTENTS_NONE then this function may call `fsync()`.
+// application. In particular, if flags has any value other than
+// [GFileSetContentsNoneValue] then this function may call `fsync()`.
 //
-// If %G_FILE_SET_CONTENTS_CONSISTENT is set in @flags, the operation is atomic
+// If [GFileSetContentsConsistentValue] is set in flags, the operation is atomic
 // in the sense that it is first written to a temporary file which is then
 // renamed to the final name.
 //
 // Notes:
 //
-//   - On UNIX, if @filename already exists hard links to @filename will break.
+//   - On UNIX, if filename already exists hard links to filename will break.
 //     Also since the file is recreated, existing permissions, access control
-//     lists, metadata etc. may be lost. If @filename is a symbolic link,
+//     lists, metadata etc. may be lost. If filename is a symbolic link,
 //     the link itself will be replaced, not the linked file.
 //
-//   - On UNIX, if @filename already exists and is non-empty, and if the system
+//   - On UNIX, if filename already exists and is non-empty, and if the system
 //     supports it (via a journalling filesystem or equivalent), and if
-//     %G_FILE_SET_CONTENTS_CONSISTENT is set in @flags, the `fsync()` call (or
-//     equivalent) will be used to ensure atomic replacement: @filename
-//     will contain either its old contents or @contents, even in the face of
+//     [GFileSetContentsConsistentValue] is set in flags, the `fsync()` call (or
+//     equivalent) will be used to ensure atomic replacement: filename
+//     will contain either its old contents or contents, even in the face of
 //     system power loss, the disk being unsafely removed, etc.
 //
-//   - On UNIX, if @filename does not already exist or is empty, there is a
+//   - On UNIX, if filename does not already exist or is empty, there is a
 //     possibility that system power loss etc. after calling this function will
-//     leave @filename empty or full of NUL bytes, depending on the underlying
-//     filesystem, unless %G_FILE_SET_CONTENTS_DURABLE and
-//     %G_FILE_SET_CONTENTS_CONSISTENT are set in @flags.
+//     leave filename empty or full of NUL bytes, depending on the underlying
+//     filesystem, unless [GFileSetContentsDurableValue] and
+//     [GFileSetContentsConsistentValue] are set in flags.
 //
 //   - On Windows renaming a file will not remove an existing file with the
 //     new name, so on Windows there is a race condition between the existing
@@ -519,17 +825,17 @@ var xFileSetContentsFull func(string, []byte, int, FileSetContentsFlags, int, **
 //
 //   - On Windows there is no way to remove a file that is open to some
 //     process, or mapped into memory. Thus, this function will fail if
-//     @filename already exists and is open.
+//     filename already exists and is open.
 //
-// If the call was successful, it returns %TRUE. If the call was not successful,
-// it returns %FALSE and sets @error. The error domain is %G_FILE_ERROR.
-// Possible error codes are those in the #GFileError enumeration.
+// If the call was successful, it returns true. If the call was not successful,
+// it returns false and sets error. The error domain is G_FILE_ERROR.
+// Possible error codes are those in the [FileError] enumeration.
 //
 // Note that the name for the temporary file is constructed by appending up
-// to 7 characters to @filename.
+// to 7 characters to filename.
 //
 // If the file didn’t exist before and is created, it will be given the
-// permissions from @mode. Otherwise, the permissions of the existing file will
+// permissions from mode. Otherwise, the permissions of the existing file will
 // remain unchanged.
 func FileSetContentsFull(FilenameVar string, ContentsVar []byte, LengthVar int, FlagsVar FileSetContentsFlags, ModeVar int) (bool, error) {
 	var cerr *Error
@@ -537,7 +843,7 @@ func FileSetContentsFull(FilenameVar string, ContentsVar []byte, LengthVar int,
 	cret := xFileSetContentsFull(FilenameVar, ContentsVar, LengthVar, FlagsVar, ModeVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -545,31 +851,29 @@ func FileSetContentsFull(FilenameVar string, ContentsVar []byte, LengthVar int,
 
 var xNewFileTest func(string, FileTest) bool
 
-// Returns %TRUE if any of the tests in the bitfield @test are
-// %TRUE. For example, `(G_FILE_TEST_EXISTS | G_FILE_TEST_IS_DIR)`
-// will return %TRUE if the file exists; the check whether it's a
-// directory doesn't matter since the existence test is %TRUE. With
+// Returns true if any of the tests in the bitfield test are
+// true. For example, `(G_FILE_TEST_EXISTS | G_FILE_TEST_IS_DIR)`
+// will return true if the file exists; the check whether it's a
+// directory doesn't matter since the existence test is true. With
 // the current set of available tests, there's no point passing in
 // more than one test at a time.
 //
-// Apart from %G_FILE_TEST_IS_SYMLINK all tests follow symbolic links,
-// so for a symbolic link to a regular file g_file_test() will return
-// %TRUE for both %G_FILE_TEST_IS_SYMLINK and %G_FILE_TEST_IS_REGULAR.
+// Apart from [GFileTestIsSymlinkValue] all tests follow symbolic links,
+// so for a symbolic link to a regular file [NewFileTest] will return
+// true for both [GFileTestIsSymlinkValue] and [GFileTestIsRegularValue].
 //
-// Note, that for a dangling symbolic link g_file_test() will return
-// %TRUE for %G_FILE_TEST_IS_SYMLINK and %FALSE for all other flags.
+// Note, that for a dangling symbolic link [NewFileTest] will return
+// true for [GFileTestIsSymlinkValue] and false for all other flags.
 //
-// You should never use g_file_test() to test whether it is safe
+// You should never use [NewFileTest] to test whether it is safe
 // to perform an operation, because there is always the possibility
 // of the condition changing before you actually perform the operation,
-// see [TOCTOU](https://en.wikipedia.org/wiki/Time-of-check_to_time-of-use).
+// see [TOCTOU].
 //
-// For example, you might think you could use %G_FILE_TEST_IS_SYMLINK
+// For example, you might think you could use [GFileTestIsSymlinkValue]
 // to know whether it is safe to write to a file without being
 // tricked into writing into a different location. It doesn't work!
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	// DON'T DO THIS
 //	if (!g_file_test (filename, G_FILE_TEST_IS_SYMLINK))
 //	  {
@@ -592,20 +896,20 @@ var xNewFileTest func(string, FileTest) bool
 //	    // write to fd
 //	  }
 //
-// ]|
-//
-// Another thing to note is that %G_FILE_TEST_EXISTS and
-// %G_FILE_TEST_IS_EXECUTABLE are implemented using the access()
+// Another thing to note is that [GFileTestExistsValue] and
+// [GFileTestIsExecutableValue] are implemented using the access()
 // system call. This usually doesn't matter, but if your program
 // is setuid or setgid it means that these tests will give you
 // the answer for the real user ID and group ID, rather than the
 // effective user ID and group ID.
 //
 // On Windows, there are no symlinks, so testing for
-// %G_FILE_TEST_IS_SYMLINK will always return %FALSE. Testing for
-// %G_FILE_TEST_IS_EXECUTABLE will just check that the file exists and
+// [GFileTestIsSymlinkValue] will always return false. Testing for
+// [GFileTestIsExecutableValue] will just check that the file exists and
 // its name indicates that it is executable, checking for well-known
 // extensions and those listed in the `PATHEXT` environment variable.
+//
+// [TOCTOU]: https://en.wikipedia.org/wiki/Time-of-check_to_time-of-use
 func NewFileTest(FilenameVar string, TestVar FileTest) bool {
 
 	cret := xNewFileTest(FilenameVar, TestVar)
@@ -647,11 +951,11 @@ var xMkdtemp func(string) string
 
 // Creates a temporary directory in the current directory.
 //
-// See the [`mkdtemp()`](man:mkdtemp(3)) documentation on most UNIX-like systems.
+// See the mkdtemp()) documentation on most UNIX-like systems.
 //
 // The parameter is a string that should follow the rules for
 // mkdtemp() templates, i.e. contain the string "XXXXXX".
-// g_mkdtemp() is slightly more flexible than mkdtemp() in that the
+// [Mkdtemp] is slightly more flexible than mkdtemp() in that the
 // sequence does not have to occur at the very end of the template.
 // The X string will be modified to form the name of a directory that
 // didn't exist.
@@ -659,8 +963,8 @@ var xMkdtemp func(string) string
 // on Windows it should be in UTF-8.
 //
 // If you are going to be creating a temporary directory inside the
-// directory returned by g_get_tmp_dir(), you might want to use
-// g_dir_make_tmp() instead.
+// directory returned by [GetTmpDir], you might want to use
+// [DirMakeTmp] instead.
 func Mkdtemp(TmplVar string) string {
 
 	cret := xMkdtemp(TmplVar)
@@ -672,20 +976,20 @@ var xMkdtempFull func(string, int) string
 
 // Creates a temporary directory in the current directory.
 //
-// See the [`mkdtemp()`](man:mkdtemp(3)) documentation on most UNIX-like systems.
+// See the mkdtemp()) documentation on most UNIX-like systems.
 //
 // The parameter is a string that should follow the rules for
 // mkdtemp() templates, i.e. contain the string "XXXXXX".
-// g_mkdtemp_full() is slightly more flexible than mkdtemp() in that the
+// [MkdtempFull] is slightly more flexible than mkdtemp() in that the
 // sequence does not have to occur at the very end of the template
-// and you can pass a @mode. The X string will be modified to form
+// and you can pass a mode. The X string will be modified to form
 // the name of a directory that didn't exist. The string should be
 // in the GLib file name encoding. Most importantly, on Windows it
 // should be in UTF-8.
 //
 // If you are going to be creating a temporary directory inside the
-// directory returned by g_get_tmp_dir(), you might want to use
-// g_dir_make_tmp() instead.
+// directory returned by [GetTmpDir], you might want to use
+// [DirMakeTmp] instead.
 func MkdtempFull(TmplVar string, ModeVar int) string {
 
 	cret := xMkdtempFull(TmplVar, ModeVar)
@@ -697,11 +1001,11 @@ var xMkstemp func(string) int
 
 // Opens a temporary file in the current directory.
 //
-// See the [`mkstemp()`](man:mkstemp(3)) documentation on most UNIX-like systems.
+// See the mkstemp()) documentation on most UNIX-like systems.
 //
 // The parameter is a string that should follow the rules for
 // mkstemp() templates, i.e. contain the string "XXXXXX".
-// g_mkstemp() is slightly more flexible than mkstemp() in that the
+// [Mkstemp] is slightly more flexible than mkstemp() in that the
 // sequence does not have to occur at the very end of the template.
 // The X string will be modified to form the name of a file that
 // didn't exist. The string should be in the GLib file name encoding.
@@ -717,13 +1021,13 @@ var xMkstempFull func(string, int, int) int
 
 // Opens a temporary file in the current directory.
 //
-// See the [`mkstemp()`](man:mkstemp(3)) documentation on most UNIX-like systems.
+// See the mkstemp()) documentation on most UNIX-like systems.
 //
 // The parameter is a string that should follow the rules for
 // mkstemp() templates, i.e. contain the string "XXXXXX".
-// g_mkstemp_full() is slightly more flexible than mkstemp()
+// [MkstempFull] is slightly more flexible than mkstemp()
 // in that the sequence does not have to occur at the very end of the
-// template and you can pass a @mode and additional @flags. The X
+// template and you can pass a mode and additional flags. The X
 // string will be modified to form the name of a file that didn't exist.
 // The string should be in the GLib file name encoding. Most importantly,
 // on Windows it should be in UTF-8.
@@ -738,10 +1042,10 @@ var xPathGetBasename func(string) string
 
 // Gets the last component of the filename.
 //
-// If @file_name ends with a directory separator it gets the component
-// before the last slash. If @file_name consists only of directory
+// If file_name ends with a directory separator it gets the component
+// before the last slash. If file_name consists only of directory
 // separators (and on Windows, possibly a drive letter), a single
-// separator is returned. If @file_name is empty, it gets ".".
+// separator is returned. If file_name is empty, it gets ".".
 func PathGetBasename(FileNameVar string) string {
 
 	cret := xPathGetBasename(FileNameVar)
@@ -766,7 +1070,7 @@ func PathGetDirname(FileNameVar string) string {
 
 var xPathIsAbsolute func(string) bool
 
-// Returns %TRUE if the given @file_name is an absolute file name.
+// Returns true if the given file_name is an absolute file name.
 // Note that this is a somewhat vague concept on Windows.
 //
 // On POSIX systems, an absolute file name is well-defined. It always
@@ -787,7 +1091,7 @@ var xPathIsAbsolute func(string) bool
 // File names relative the current directory on some specific drive,
 // such as "D:foo/bar", are not interpreted as absolute by this
 // function, but they obviously are not relative to the normal current
-// directory as returned by getcwd() or g_get_current_dir()
+// directory as returned by getcwd() or [GetCurrentDir]
 // either. Such paths should be avoided, or need to be handled using
 // Windows-specific code.
 func PathIsAbsolute(FileNameVar string) bool {
@@ -799,9 +1103,9 @@ func PathIsAbsolute(FileNameVar string) bool {
 
 var xPathSkipRoot func(string) string
 
-// Returns a pointer into @file_name after the root component,
-// i.e. after the "/" in UNIX or "C:\" under Windows. If @file_name
-// is not an absolute path it returns %NULL.
+// Returns a pointer into file_name after the root component,
+// i.e. after the "/" in UNIX or "C:\" under Windows. If file_name
+// is not an absolute path it returns nil.
 func PathSkipRoot(FileNameVar string) string {
 
 	cret := xPathSkipRoot(FileNameVar)
@@ -814,37 +1118,36 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBasename, libs, "g_basename")
-	core.PuregoSafeRegister(&xBuildFilename, libs, "g_build_filename")
-	core.PuregoSafeRegister(&xBuildFilenameValist, libs, "g_build_filename_valist")
-	core.PuregoSafeRegister(&xBuildFilenamev, libs, "g_build_filenamev")
-	core.PuregoSafeRegister(&xBuildPath, libs, "g_build_path")
-	core.PuregoSafeRegister(&xBuildPathv, libs, "g_build_pathv")
-	core.PuregoSafeRegister(&xCanonicalizeFilename, libs, "g_canonicalize_filename")
-	core.PuregoSafeRegister(&xDirMakeTmp, libs, "g_dir_make_tmp")
-	core.PuregoSafeRegister(&xFileErrorFromErrno, libs, "g_file_error_from_errno")
-	core.PuregoSafeRegister(&xFileGetContents, libs, "g_file_get_contents")
-	core.PuregoSafeRegister(&xFileOpenTmp, libs, "g_file_open_tmp")
-	core.PuregoSafeRegister(&xFileReadLink, libs, "g_file_read_link")
-	core.PuregoSafeRegister(&xFileSetContents, libs, "g_file_set_contents")
-	core.PuregoSafeRegister(&xFileSetContentsFull, libs, "g_file_set_contents_full")
-	core.PuregoSafeRegister(&xNewFileTest, libs, "g_file_test")
-	core.PuregoSafeRegister(&xGetCurrentDir, libs, "g_get_current_dir")
-	core.PuregoSafeRegister(&xMkdirWithParents, libs, "g_mkdir_with_parents")
-	core.PuregoSafeRegister(&xMkdtemp, libs, "g_mkdtemp")
-	core.PuregoSafeRegister(&xMkdtempFull, libs, "g_mkdtemp_full")
-	core.PuregoSafeRegister(&xMkstemp, libs, "g_mkstemp")
-	core.PuregoSafeRegister(&xMkstempFull, libs, "g_mkstemp_full")
-	core.PuregoSafeRegister(&xPathGetBasename, libs, "g_path_get_basename")
-	core.PuregoSafeRegister(&xPathGetDirname, libs, "g_path_get_dirname")
-	core.PuregoSafeRegister(&xPathIsAbsolute, libs, "g_path_is_absolute")
-	core.PuregoSafeRegister(&xPathSkipRoot, libs, "g_path_skip_root")
+	core.PuregoRegisterSince(&xBuildFilename, libs, "g_build_filename", "GLIB", "")
+	core.PuregoRegisterSince(&xBuildFilenameValist, libs, "g_build_filename_valist", "GLIB", "2.56")
+	core.PuregoRegisterSince(&xBuildFilenamev, libs, "g_build_filenamev", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xBuildPath, libs, "g_build_path", "GLIB", "")
+	core.PuregoRegisterSince(&xBuildPathv, libs, "g_build_pathv", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xCanonicalizeFilename, libs, "g_canonicalize_filename", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xDirMakeTmp, libs, "g_dir_make_tmp", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xFileErrorFromErrno, libs, "g_file_error_from_errno", "GLIB", "")
+	core.PuregoRegisterSince(&xFileGetContents, libs, "g_file_get_contents", "GLIB", "")
+	core.PuregoRegisterSince(&xFileOpenTmp, libs, "g_file_open_tmp", "GLIB", "")
+	core.PuregoRegisterSince(&xFileReadLink, libs, "g_file_read_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xFileSetContents, libs, "g_file_set_contents", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xFileSetContentsFull, libs, "g_file_set_contents_full", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xNewFileTest, libs, "g_file_test", "GLIB", "")
+	core.PuregoRegisterSince(&xGetCurrentDir, libs, "g_get_current_dir", "GLIB", "")
+	core.PuregoRegisterSince(&xMkdirWithParents, libs, "g_mkdir_with_parents", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xMkdtemp, libs, "g_mkdtemp", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xMkdtempFull, libs, "g_mkdtemp_full", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xMkstemp, libs, "g_mkstemp", "GLIB", "")
+	core.PuregoRegisterSince(&xMkstempFull, libs, "g_mkstemp_full", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xPathGetBasename, libs, "g_path_get_basename", "GLIB", "")
+	core.PuregoRegisterSince(&xPathGetDirname, libs, "g_path_get_dirname", "GLIB", "")
+	core.PuregoRegisterSince(&xPathIsAbsolute, libs, "g_path_is_absolute", "GLIB", "")
+	core.PuregoRegisterSince(&xPathSkipRoot, libs, "g_path_skip_root", "GLIB", "")
 
 }
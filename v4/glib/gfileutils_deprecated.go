@@ -0,0 +1,42 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xBasename func(string) string
+
+// Gets the name of the file without any leading directory
+// components. It returns a pointer into the given file name
+// string.
+//
+// Deprecated: since 2.2. Use [PathGetBasename] instead, but notice
+//
+//	that [PathGetBasename] allocates new memory for the
+//	returned string, unlike this function which returns a pointer
+//	into the argument.
+func Basename(FileNameVar string) string {
+
+	cret := xBasename(FileNameVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xBasename, libs, "g_basename", "GLIB", "")
+
+}
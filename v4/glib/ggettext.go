@@ -2,14 +2,14 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 var xDcgettext func(uintptr, string, int) string
 
-// This is a variant of g_dgettext() that allows specifying a locale
-// category instead of always using `LC_MESSAGES`. See g_dgettext() for
+// This is a variant of [Dgettext] that allows specifying a locale
+// category instead of always using `LC_MESSAGES`. See [Dgettext] for
 // more information about how this functions differs from calling
 // dcgettext() directly.
 func Dcgettext(DomainVar *string, MsgidVar string, CategoryVar int) string {
@@ -34,13 +34,13 @@ var xDgettext func(uintptr, string) string
 // the current locale.  This results in a consistent English-only
 // interface instead of one having partial translations.  For this
 // feature to work, the call to textdomain() and setlocale() should
-// precede any g_dgettext() invocations.  For GTK, it means calling
+// precede any [Dgettext] invocations.  For GTK, it means calling
 // textdomain() before gtk_init or its variants.
 //
 // This function disables translations if and only if upon its first
 // call all the following conditions hold:
 //
-// - @domain is not %NULL
+// - domain is not nil
 //
 // - textdomain() has been called to set a default text domain
 //
@@ -66,15 +66,15 @@ func Dgettext(DomainVar *string, MsgidVar string) string {
 	return cret
 }
 
-var xDngettext func(uintptr, string, string, uint) string
+var xDngettext func(uintptr, string, string, types.ULong) string
 
 // This function is a wrapper of dngettext() which does not translate
 // the message if the default domain as set with textdomain() has no
 // translations for the current locale.
 //
-// See g_dgettext() for details of how this differs from dngettext()
+// See [Dgettext] for details of how this differs from dngettext()
 // proper.
-func Dngettext(DomainVar *string, MsgidVar string, MsgidPluralVar string, NVar uint) string {
+func Dngettext(DomainVar *string, MsgidVar string, MsgidPluralVar string, NVar types.ULong) string {
 
 	DomainVarPtr := core.GStrdupNullable(DomainVar)
 	defer core.GFreeNullable(DomainVarPtr)
@@ -86,15 +86,15 @@ func Dngettext(DomainVar *string, MsgidVar string, MsgidPluralVar string, NVar u
 
 var xDpgettext func(uintptr, string, uint) string
 
-// This function is a variant of g_dgettext() which supports
+// This function is a variant of [Dgettext] which supports
 // a disambiguating message context. GNU gettext uses the
 // '\004' character to separate the message context and
-// message id in @msgctxtid.
-// If 0 is passed as @msgidoffset, this function will fall back to
+// message id in msgctxtid.
+// If 0 is passed as msgidoffset, this function will fall back to
 // trying to use the deprecated convention of using "|" as a separation
 // character.
 //
-// This uses g_dgettext() internally. See that functions for differences
+// This uses [Dgettext] internally. See that functions for differences
 // with dgettext() proper.
 //
 // Applications should normally not use this function directly,
@@ -111,12 +111,12 @@ func Dpgettext(DomainVar *string, MsgctxtidVar string, MsgidoffsetVar uint) stri
 
 var xDpgettext2 func(uintptr, string, string) string
 
-// This function is a variant of g_dgettext() which supports
+// This function is a variant of [Dgettext] which supports
 // a disambiguating message context. GNU gettext uses the
 // '\004' character to separate the message context and
-// message id in @msgctxtid.
+// message id in msgctxtid.
 //
-// This uses g_dgettext() internally. See that functions for differences
+// This uses [Dgettext] internally. See that functions for differences
 // with dgettext() proper.
 //
 // This function differs from C_() in that it is not a macro and
@@ -146,18 +146,18 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDcgettext, libs, "g_dcgettext")
-	core.PuregoSafeRegister(&xDgettext, libs, "g_dgettext")
-	core.PuregoSafeRegister(&xDngettext, libs, "g_dngettext")
-	core.PuregoSafeRegister(&xDpgettext, libs, "g_dpgettext")
-	core.PuregoSafeRegister(&xDpgettext2, libs, "g_dpgettext2")
-	core.PuregoSafeRegister(&xStripContext, libs, "g_strip_context")
+	core.PuregoRegisterSince(&xDcgettext, libs, "g_dcgettext", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xDgettext, libs, "g_dgettext", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xDngettext, libs, "g_dngettext", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xDpgettext, libs, "g_dpgettext", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xDpgettext2, libs, "g_dpgettext2", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xStripContext, libs, "g_strip_context", "GLIB", "2.4")
 
 }
@@ -5,25 +5,24 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Specifies the type of the function passed to
-// [func@GLib.HashTable.find], [func@GLib.HashTable.foreach_remove], and
-// [func@GLib.HashTable.foreach_steal].
+// [HashTableFind], [HashTableForeachRemove], and
+// [HashTableForeachSteal].
 //
 // The function is called with each key/value pair, together with
-// the @user_data parameter passed to the calling function.
+// the user_data parameter passed to the calling function.
 //
 // The function should return true if the key/value pair should be
 // selected, meaning it has been found or it should be removed from the
-// [struct@GLib.HashTable], depending on the calling function.
+// [HashTable], depending on the calling function.
 type HRFunc func(uintptr, uintptr, uintptr) bool
 
-// The #GHashTable struct is an opaque data structure to represent a
-// [Hash Table](data-structures.html#hash-tables). It should only be accessed via the
+// The [HashTable] struct is an opaque data structure to represent a
+// Hash Table. It should only be accessed via the
 // following functions.
 type HashTable struct {
 	_ structs.HostLayout
@@ -40,11 +39,11 @@ func (x *HashTable) GoPointer() uintptr {
 }
 
 // A GHashTableIter structure represents an iterator that can be used
-// to iterate over the elements of a #GHashTable. GHashTableIter
+// to iterate over the elements of a [HashTable]. GHashTableIter
 // structures are typically allocated on the stack and then initialized
-// with g_hash_table_iter_init().
+// with [HashTableIter.Init].
 //
-// The iteration order of a #GHashTableIter over the keys/values in a hash
+// The iteration order of a [HashTableIter] over the keys/values in a hash
 // table is not defined.
 type HashTableIter struct {
 	_ structs.HostLayout
@@ -68,7 +67,7 @@ func (x *HashTableIter) GoPointer() uintptr {
 
 var xHashTableIterGetHashTable func(uintptr) *HashTable
 
-// Returns the #GHashTable associated with @iter.
+// Returns the [HashTable] associated with iter.
 func (x *HashTableIter) GetHashTable() *HashTable {
 
 	cret := xHashTableIterGetHashTable(x.GoPointer())
@@ -78,24 +77,20 @@ func (x *HashTableIter) GetHashTable() *HashTable {
 var xHashTableIterInit func(uintptr, *HashTable)
 
 // Initializes a key/value pair iterator and associates it with
-// @hash_table. Modifying the hash table after calling this function
+// hash_table. Modifying the hash table after calling this function
 // invalidates the returned iterator.
 //
-// The iteration order of a #GHashTableIter over the keys/values in a hash
+// The iteration order of a [HashTableIter] over the keys/values in a hash
 // table is not defined.
 //
-// |[&lt;!-- language="C" --&gt;
-// GHashTableIter iter;
-// gpointer key, value;
+//	GHashTableIter iter;
+//	gpointer key, value;
 //
-// g_hash_table_iter_init (&amp;iter, hash_table);
-// while (g_hash_table_iter_next (&amp;iter, &amp;key, &amp;value))
-//
-//	{
-//	  // do something with key and value
-//	}
-//
-// ]|
+//	g_hash_table_iter_init (&iter, hash_table);
+//	while (g_hash_table_iter_next (&iter, &key, &value))
+//	  {
+//	    // do something with key and value
+//	  }
 func (x *HashTableIter) Init(HashTableVar *HashTable) {
 
 	xHashTableIterInit(x.GoPointer(), HashTableVar)
@@ -104,9 +99,9 @@ func (x *HashTableIter) Init(HashTableVar *HashTable) {
 
 var xHashTableIterNext func(uintptr, *uintptr, *uintptr) bool
 
-// Advances @iter and retrieves the key and/or value that are now
-// pointed to as a result of this advancement. If %FALSE is returned,
-// @key and @value are not set, and the iterator becomes invalid.
+// Advances iter and retrieves the key and/or value that are now
+// pointed to as a result of this advancement. If false is returned,
+// key and value are not set, and the iterator becomes invalid.
 func (x *HashTableIter) Next(KeyVar *uintptr, ValueVar *uintptr) bool {
 
 	cret := xHashTableIterNext(x.GoPointer(), KeyVar, ValueVar)
@@ -116,25 +111,22 @@ func (x *HashTableIter) Next(KeyVar *uintptr, ValueVar *uintptr) bool {
 var xHashTableIterRemove func(uintptr)
 
 // Removes the key/value pair currently pointed to by the iterator
-// from its associated #GHashTable. Can only be called after
-// g_hash_table_iter_next() returned %TRUE, and cannot be called
+// from its associated [HashTable]. Can only be called after
+// [HashTableIter.Next] returned true, and cannot be called
 // more than once for the same key/value pair.
 //
-// If the #GHashTable was created using g_hash_table_new_full(),
+// If the [HashTable] was created using g_hash_table_new_full(),
 // the key and value are freed using the supplied destroy functions,
 // otherwise you have to make sure that any dynamically allocated
 // values are freed yourself.
 //
-// It is safe to continue iterating the #GHashTable afterward:
-// |[&lt;!-- language="C" --&gt;
-// while (g_hash_table_iter_next (&amp;iter, &amp;key, &amp;value))
-//
-//	{
-//	  if (condition)
-//	    g_hash_table_iter_remove (&amp;iter);
-//	}
+// It is safe to continue iterating the [HashTable] afterward:
 //
-// ]|
+//	while (g_hash_table_iter_next (&iter, &key, &value))
+//	  {
+//	    if (condition)
+//	      g_hash_table_iter_remove (&iter);
+//	  }
 func (x *HashTableIter) Remove() {
 
 	xHashTableIterRemove(x.GoPointer())
@@ -144,11 +136,11 @@ func (x *HashTableIter) Remove() {
 var xHashTableIterReplace func(uintptr, uintptr)
 
 // Replaces the value currently pointed to by the iterator
-// from its associated #GHashTable. Can only be called after
-// g_hash_table_iter_next() returned %TRUE.
+// from its associated [HashTable]. Can only be called after
+// [HashTableIter.Next] returned true.
 //
-// If you supplied a @value_destroy_func when creating the
-// #GHashTable, the old value is freed using that function.
+// If you supplied a value_destroy_func when creating the
+// [HashTable], the old value is freed using that function.
 func (x *HashTableIter) Replace(ValueVar uintptr) {
 
 	xHashTableIterReplace(x.GoPointer(), ValueVar)
@@ -158,9 +150,9 @@ func (x *HashTableIter) Replace(ValueVar uintptr) {
 var xHashTableIterSteal func(uintptr)
 
 // Removes the key/value pair currently pointed to by the
-// iterator from its associated #GHashTable, without calling
+// iterator from its associated [HashTable], without calling
 // the key and value destroy functions. Can only be called
-// after g_hash_table_iter_next() returned %TRUE, and cannot
+// after [HashTableIter.Next] returned true, and cannot
 // be called more than once for the same key/value pair.
 func (x *HashTableIter) Steal() {
 
@@ -170,10 +162,10 @@ func (x *HashTableIter) Steal() {
 
 var xDirectEqual func(uintptr, uintptr) bool
 
-// Compares two #gpointer arguments and returns %TRUE if they are equal.
-// It can be passed to g_hash_table_new() as the @key_equal_func
+// Compares two #gpointer arguments and returns true if they are equal.
+// It can be passed to g_hash_table_new() as the key_equal_func
 // parameter, when using opaque pointers compared by pointer value as
-// keys in a #GHashTable.
+// keys in a [HashTable].
 //
 // This equality function is also appropriate for keys that are integers
 // stored in pointers, such as `GINT_TO_POINTER (n)`.
@@ -187,9 +179,9 @@ func DirectEqual(V1Var uintptr, V2Var uintptr) bool {
 var xDirectHash func(uintptr) uint
 
 // Converts a gpointer to a hash value.
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
+// It can be passed to g_hash_table_new() as the hash_func parameter,
 // when using opaque pointers compared by pointer value as keys in a
-// #GHashTable.
+// [HashTable].
 //
 // This hash function is also appropriate for keys that are integers
 // stored in pointers, such as `GINT_TO_POINTER (n)`.
@@ -203,10 +195,10 @@ func DirectHash(VVar uintptr) uint {
 var xDoubleEqual func(uintptr, uintptr) bool
 
 // Compares the two #gdouble values being pointed to and returns
-// %TRUE if they are equal.
-// It can be passed to g_hash_table_new() as the @key_equal_func
-// parameter, when using non-%NULL pointers to doubles as keys in a
-// #GHashTable.
+// true if they are equal.
+// It can be passed to g_hash_table_new() as the key_equal_func
+// parameter, when using non-nil pointers to doubles as keys in a
+// [HashTable].
 func DoubleEqual(V1Var uintptr, V2Var uintptr) bool {
 
 	cret := xDoubleEqual(V1Var, V2Var)
@@ -217,9 +209,9 @@ func DoubleEqual(V1Var uintptr, V2Var uintptr) bool {
 var xDoubleHash func(uintptr) uint
 
 // Converts a pointer to a #gdouble to a hash value.
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
-// when using non-%NULL pointers to doubles as keys in a #GHashTable.
+// It can be passed to g_hash_table_new() as the hash_func parameter,
+// It can be passed to g_hash_table_new() as the hash_func parameter,
+// when using non-nil pointers to doubles as keys in a [HashTable].
 func DoubleHash(VVar uintptr) uint {
 
 	cret := xDoubleHash(VVar)
@@ -229,12 +221,12 @@ func DoubleHash(VVar uintptr) uint {
 
 var xHashTableAdd func(*HashTable, uintptr) bool
 
-// This is a convenience function for using a #GHashTable as a set.  It
-// is equivalent to calling g_hash_table_replace() with @key as both the
+// This is a convenience function for using a [HashTable] as a set.  It
+// is equivalent to calling [HashTableReplace] with key as both the
 // key and the value.
 //
-// In particular, this means that if @key already exists in the hash table, then
-// the old copy of @key in the hash table is freed and @key replaces it in the
+// In particular, this means that if key already exists in the hash table, then
+// the old copy of key in the hash table is freed and key replaces it in the
 // table.
 //
 // When a hash table only ever contains keys that have themselves as the
@@ -253,7 +245,7 @@ func HashTableAdd(HashTableVar *HashTable, KeyVar uintptr) bool {
 
 var xHashTableContains func(*HashTable, uintptr) bool
 
-// Checks if @key is in @hash_table.
+// Checks if key is in hash_table.
 func HashTableContains(HashTableVar *HashTable, KeyVar uintptr) bool {
 
 	cret := xHashTableContains(HashTableVar, KeyVar)
@@ -263,9 +255,9 @@ func HashTableContains(HashTableVar *HashTable, KeyVar uintptr) bool {
 
 var xHashTableDestroy func(*HashTable)
 
-// Destroys all keys and values in the #GHashTable and decrements its
+// Destroys all keys and values in the [HashTable] and decrements its
 // reference count by 1. If keys and/or values are dynamically allocated,
-// you should either free them first or create the #GHashTable with destroy
+// you should either free them first or create the [HashTable] with destroy
 // notifiers using g_hash_table_new_full(). In the latter case the destroy
 // functions you supplied will be called on all keys and values during the
 // destruction phase.
@@ -277,15 +269,15 @@ func HashTableDestroy(HashTableVar *HashTable) {
 
 var xHashTableFind func(*HashTable, uintptr, uintptr) uintptr
 
-// Calls the given function for key/value pairs in the #GHashTable
-// until @predicate returns %TRUE. The function is passed the key
-// and value of each pair, and the given @user_data parameter. The
+// Calls the given function for key/value pairs in the [HashTable]
+// until predicate returns true. The function is passed the key
+// and value of each pair, and the given user_data parameter. The
 // hash table may not be modified while iterating over it (you can't
 // add/remove items).
 //
 // Note, that hash tables are really only optimized for forward
-// lookups, i.e. g_hash_table_lookup(). So code that frequently issues
-// g_hash_table_find() or g_hash_table_foreach() (e.g. in the order of
+// lookups, i.e. [HashTableLookup]. So code that frequently issues
+// [HashTableFind] or [HashTableForeach] (e.g. in the order of
 // once per every entry in a hash table) should probably be reworked
 // to use additional or different data structures for reverse lookups
 // (keep in mind that an O(n) find/foreach operation issued for all n
@@ -299,11 +291,12 @@ func HashTableFind(HashTableVar *HashTable, PredicateVar *HRFunc, UserDataVar ui
 			PredicateVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *PredicateVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			PredicateVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(PredicateVarPtr, PredicateVarRef, PredicateVar)
+			PredicateVarRef = core.NewCallback(fcb)
+			PredicateVarRef = SaveCallbackWithClosure(PredicateVarPtr, PredicateVarRef, PredicateVar)
 		}
 	}
 
@@ -315,17 +308,17 @@ func HashTableFind(HashTableVar *HashTable, PredicateVar *HRFunc, UserDataVar ui
 var xHashTableForeach func(*HashTable, uintptr, uintptr)
 
 // Calls the given function for each of the key/value pairs in the
-// #GHashTable.  The function is passed the key and value of each
-// pair, and the given @user_data parameter.  The hash table may not
+// [HashTable].  The function is passed the key and value of each
+// pair, and the given user_data parameter.  The hash table may not
 // be modified while iterating over it (you can't add/remove
 // items). To remove all items matching a predicate, use
-// g_hash_table_foreach_remove().
+// [HashTableForeachRemove].
 //
-// The order in which g_hash_table_foreach() iterates over the keys/values in
+// The order in which [HashTableForeach] iterates over the keys/values in
 // the hash table is not defined.
 //
-// See g_hash_table_find() for performance caveats for linear
-// order searches in contrast to g_hash_table_lookup().
+// See [HashTableFind] for performance caveats for linear
+// order searches in contrast to [HashTableLookup].
 func HashTableForeach(HashTableVar *HashTable, FuncVar *HFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -335,11 +328,12 @@ func HashTableForeach(HashTableVar *HashTable, FuncVar *HFunc, UserDataVar uintp
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -350,12 +344,12 @@ func HashTableForeach(HashTableVar *HashTable, FuncVar *HFunc, UserDataVar uintp
 var xHashTableForeachRemove func(*HashTable, uintptr, uintptr) uint
 
 // Calls the given function for each key/value pair in the
-// #GHashTable. If the function returns %TRUE, then the key/value
-// pair is removed from the #GHashTable. If you supplied key or
-// value destroy functions when creating the #GHashTable, they are
+// [HashTable]. If the function returns true, then the key/value
+// pair is removed from the [HashTable]. If you supplied key or
+// value destroy functions when creating the [HashTable], they are
 // used to free the memory allocated for the removed keys and values.
 //
-// See #GHashTableIter for an alternative way to loop over the
+// See [HashTableIter] for an alternative way to loop over the
 // key/value pairs in the hash table.
 func HashTableForeachRemove(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVar uintptr) uint {
 
@@ -366,11 +360,12 @@ func HashTableForeachRemove(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVa
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -382,11 +377,11 @@ func HashTableForeachRemove(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVa
 var xHashTableForeachSteal func(*HashTable, uintptr, uintptr) uint
 
 // Calls the given function for each key/value pair in the
-// #GHashTable. If the function returns %TRUE, then the key/value
-// pair is removed from the #GHashTable, but no key or value
+// [HashTable]. If the function returns true, then the key/value
+// pair is removed from the [HashTable], but no key or value
 // destroy functions are called.
 //
-// See #GHashTableIter for an alternative way to loop over the
+// See [HashTableIter] for an alternative way to loop over the
 // key/value pairs in the hash table.
 func HashTableForeachSteal(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVar uintptr) uint {
 
@@ -397,11 +392,12 @@ func HashTableForeachSteal(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVar
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -412,12 +408,12 @@ func HashTableForeachSteal(HashTableVar *HashTable, FuncVar *HRFunc, UserDataVar
 
 var xHashTableGetKeysAsPtrArray func(*HashTable) uintptr
 
-// Retrieves every key inside @hash_table, as a #GPtrArray.
+// Retrieves every key inside hash_table, as a [PtrArray].
 // The returned data is valid until changes to the hash release those keys.
 //
 // This iterates over every entry in the hash table to build its return value.
-// To iterate over the entries in a #GHashTable more efficiently, use a
-// #GHashTableIter.
+// To iterate over the entries in a [HashTable] more efficiently, use a
+// [HashTableIter].
 //
 // You should always unref the returned array with g_ptr_array_unref().
 func HashTableGetKeysAsPtrArray(HashTableVar *HashTable) uintptr {
@@ -429,12 +425,12 @@ func HashTableGetKeysAsPtrArray(HashTableVar *HashTable) uintptr {
 
 var xHashTableGetValuesAsPtrArray func(*HashTable) uintptr
 
-// Retrieves every value inside @hash_table, as a #GPtrArray.
+// Retrieves every value inside hash_table, as a [PtrArray].
 // The returned data is valid until changes to the hash release those values.
 //
 // This iterates over every entry in the hash table to build its return value.
-// To iterate over the entries in a #GHashTable more efficiently, use a
-// #GHashTableIter.
+// To iterate over the entries in a [HashTable] more efficiently, use a
+// [HashTableIter].
 //
 // You should always unref the returned array with g_ptr_array_unref().
 func HashTableGetValuesAsPtrArray(HashTableVar *HashTable) uintptr {
@@ -446,13 +442,13 @@ func HashTableGetValuesAsPtrArray(HashTableVar *HashTable) uintptr {
 
 var xHashTableInsert func(*HashTable, uintptr, uintptr) bool
 
-// Inserts a new key and value into a #GHashTable.
+// Inserts a new key and value into a [HashTable].
 //
-// If the key already exists in the #GHashTable its current
+// If the key already exists in the [HashTable] its current
 // value is replaced with the new value. If you supplied a
-// @value_destroy_func when creating the #GHashTable, the old
+// value_destroy_func when creating the [HashTable], the old
 // value is freed using that function. If you supplied a
-// @key_destroy_func when creating the #GHashTable, the passed
+// key_destroy_func when creating the [HashTable], the passed
 // key is freed using that function.
 //
 // Starting from GLib 2.40, this function returns a boolean value to
@@ -467,10 +463,10 @@ func HashTableInsert(HashTableVar *HashTable, KeyVar uintptr, ValueVar uintptr)
 
 var xHashTableLookup func(*HashTable, uintptr) uintptr
 
-// Looks up a key in a #GHashTable. Note that this function cannot
+// Looks up a key in a [HashTable]. Note that this function cannot
 // distinguish between a key that is not present and one which is present
-// and has the value %NULL. If you need this distinction, use
-// g_hash_table_lookup_extended().
+// and has the value nil. If you need this distinction, use
+// [HashTableLookupExtended].
 func HashTableLookup(HashTableVar *HashTable, KeyVar uintptr) uintptr {
 
 	cret := xHashTableLookup(HashTableVar, KeyVar)
@@ -480,14 +476,14 @@ func HashTableLookup(HashTableVar *HashTable, KeyVar uintptr) uintptr {
 
 var xHashTableLookupExtended func(*HashTable, uintptr, *uintptr, *uintptr) bool
 
-// Looks up a key in the #GHashTable, returning the original key and the
-// associated value and a #gboolean which is %TRUE if the key was found. This
+// Looks up a key in the [HashTable], returning the original key and the
+// associated value and a #gboolean which is true if the key was found. This
 // is useful if you need to free the memory allocated for the original key,
-// for example before calling g_hash_table_remove().
+// for example before calling [HashTableRemove].
 //
-// You can actually pass %NULL for @lookup_key to test
-// whether the %NULL key exists, provided the hash and equal functions
-// of @hash_table are %NULL-safe.
+// You can actually pass nil for lookup_key to test
+// whether the nil key exists, provided the hash and equal functions
+// of hash_table are nil-safe.
 func HashTableLookupExtended(HashTableVar *HashTable, LookupKeyVar uintptr, OrigKeyVar *uintptr, ValueVar *uintptr) bool {
 
 	cret := xHashTableLookupExtended(HashTableVar, LookupKeyVar, OrigKeyVar, ValueVar)
@@ -497,14 +493,14 @@ func HashTableLookupExtended(HashTableVar *HashTable, LookupKeyVar uintptr, Orig
 
 var xHashTableNewSimilar func(*HashTable) *HashTable
 
-// Creates a new #GHashTable like g_hash_table_new_full() with a reference
+// Creates a new [HashTable] like g_hash_table_new_full() with a reference
 // count of 1.
 //
 // It inherits the hash function, the key equal function, the key destroy function,
-// as well as the value destroy function, from @other_hash_table.
+// as well as the value destroy function, from other_hash_table.
 //
 // The returned hash table will be empty; it will not contain the keys
-// or values from @other_hash_table.
+// or values from other_hash_table.
 func HashTableNewSimilar(OtherHashTableVar *HashTable) *HashTable {
 
 	cret := xHashTableNewSimilar(OtherHashTableVar)
@@ -514,7 +510,7 @@ func HashTableNewSimilar(OtherHashTableVar *HashTable) *HashTable {
 
 var xHashTableRef func(*HashTable) *HashTable
 
-// Atomically increments the reference count of @hash_table by one.
+// Atomically increments the reference count of hash_table by one.
 // This function is MT-safe and may be called from any thread.
 func HashTableRef(HashTableVar *HashTable) *HashTable {
 
@@ -525,9 +521,9 @@ func HashTableRef(HashTableVar *HashTable) *HashTable {
 
 var xHashTableRemove func(*HashTable, uintptr) bool
 
-// Removes a key and its associated value from a #GHashTable.
+// Removes a key and its associated value from a [HashTable].
 //
-// If the #GHashTable was created using g_hash_table_new_full(), the
+// If the [HashTable] was created using g_hash_table_new_full(), the
 // key and value are freed using the supplied destroy functions, otherwise
 // you have to make sure that any dynamically allocated values are freed
 // yourself.
@@ -540,9 +536,9 @@ func HashTableRemove(HashTableVar *HashTable, KeyVar uintptr) bool {
 
 var xHashTableRemoveAll func(*HashTable)
 
-// Removes all keys and their associated values from a #GHashTable.
+// Removes all keys and their associated values from a [HashTable].
 //
-// If the #GHashTable was created using g_hash_table_new_full(),
+// If the [HashTable] was created using g_hash_table_new_full(),
 // the keys and values are freed using the supplied destroy functions,
 // otherwise you have to make sure that any dynamically allocated
 // values are freed yourself.
@@ -554,13 +550,13 @@ func HashTableRemoveAll(HashTableVar *HashTable) {
 
 var xHashTableReplace func(*HashTable, uintptr, uintptr) bool
 
-// Inserts a new key and value into a #GHashTable similar to
-// g_hash_table_insert(). The difference is that if the key
-// already exists in the #GHashTable, it gets replaced by the
-// new key. If you supplied a @value_destroy_func when creating
-// the #GHashTable, the old value is freed using that function.
-// If you supplied a @key_destroy_func when creating the
-// #GHashTable, the old key is freed using that function.
+// Inserts a new key and value into a [HashTable] similar to
+// [HashTableInsert]. The difference is that if the key
+// already exists in the [HashTable], it gets replaced by the
+// new key. If you supplied a value_destroy_func when creating
+// the [HashTable], the old value is freed using that function.
+// If you supplied a key_destroy_func when creating the
+// [HashTable], the old key is freed using that function.
 //
 // Starting from GLib 2.40, this function returns a boolean value to
 // indicate whether the newly added value was already in the hash table
@@ -574,7 +570,7 @@ func HashTableReplace(HashTableVar *HashTable, KeyVar uintptr, ValueVar uintptr)
 
 var xHashTableSize func(*HashTable) uint
 
-// Returns the number of elements contained in the #GHashTable.
+// Returns the number of elements contained in the [HashTable].
 func HashTableSize(HashTableVar *HashTable) uint {
 
 	cret := xHashTableSize(HashTableVar)
@@ -584,7 +580,7 @@ func HashTableSize(HashTableVar *HashTable) uint {
 
 var xHashTableSteal func(*HashTable, uintptr) bool
 
-// Removes a key and its associated value from a #GHashTable without
+// Removes a key and its associated value from a [HashTable] without
 // calling the key and value destroy functions.
 func HashTableSteal(HashTableVar *HashTable, KeyVar uintptr) bool {
 
@@ -595,7 +591,7 @@ func HashTableSteal(HashTableVar *HashTable, KeyVar uintptr) bool {
 
 var xHashTableStealAll func(*HashTable)
 
-// Removes all keys and their associated values from a #GHashTable
+// Removes all keys and their associated values from a [HashTable]
 // without calling the key and value destroy functions.
 func HashTableStealAll(HashTableVar *HashTable) {
 
@@ -605,9 +601,9 @@ func HashTableStealAll(HashTableVar *HashTable) {
 
 var xHashTableStealAllKeys func(*HashTable) uintptr
 
-// Removes all keys and their associated values from a #GHashTable
+// Removes all keys and their associated values from a [HashTable]
 // without calling the key destroy functions, returning the keys
-// as a #GPtrArray with the free func set to the @hash_table key
+// as a [PtrArray] with the free func set to the hash_table key
 // destroy function.
 func HashTableStealAllKeys(HashTableVar *HashTable) uintptr {
 
@@ -618,9 +614,9 @@ func HashTableStealAllKeys(HashTableVar *HashTable) uintptr {
 
 var xHashTableStealAllValues func(*HashTable) uintptr
 
-// Removes all keys and their associated values from a #GHashTable
+// Removes all keys and their associated values from a [HashTable]
 // without calling the value destroy functions, returning the values
-// as a #GPtrArray with the free func set to the @hash_table value
+// as a [PtrArray] with the free func set to the hash_table value
 // destroy function.
 func HashTableStealAllValues(HashTableVar *HashTable) uintptr {
 
@@ -631,23 +627,23 @@ func HashTableStealAllValues(HashTableVar *HashTable) uintptr {
 
 var xHashTableStealExtended func(*HashTable, uintptr, *uintptr, *uintptr) bool
 
-// Looks up a key in the #GHashTable, stealing the original key and the
-// associated value and returning %TRUE if the key was found. If the key was
-// not found, %FALSE is returned.
+// Looks up a key in the [HashTable], stealing the original key and the
+// associated value and returning true if the key was found. If the key was
+// not found, false is returned.
 //
 // If found, the stolen key and value are removed from the hash table without
 // calling the key and value destroy functions, and ownership is transferred to
-// the caller of this method, as with g_hash_table_steal(). That is the case
-// regardless whether @stolen_key or @stolen_value output parameters are
+// the caller of this method, as with [HashTableSteal]. That is the case
+// regardless whether stolen_key or stolen_value output parameters are
 // requested.
 //
-// You can pass %NULL for @lookup_key, provided the hash and equal functions
-// of @hash_table are %NULL-safe.
+// You can pass nil for lookup_key, provided the hash and equal functions
+// of hash_table are nil-safe.
 //
 // The dictionary implementation optimizes for having all values identical to
-// their keys, for example by using g_hash_table_add(). Before 2.82, when
+// their keys, for example by using [HashTableAdd]. Before 2.82, when
 // stealing both the key and the value from such a dictionary, the value was
-// %NULL. Since 2.82, the returned value and key will be the same.
+// nil. Since 2.82, the returned value and key will be the same.
 func HashTableStealExtended(HashTableVar *HashTable, LookupKeyVar uintptr, StolenKeyVar *uintptr, StolenValueVar *uintptr) bool {
 
 	cret := xHashTableStealExtended(HashTableVar, LookupKeyVar, StolenKeyVar, StolenValueVar)
@@ -657,7 +653,7 @@ func HashTableStealExtended(HashTableVar *HashTable, LookupKeyVar uintptr, Stole
 
 var xHashTableUnref func(*HashTable)
 
-// Atomically decrements the reference count of @hash_table by one.
+// Atomically decrements the reference count of hash_table by one.
 // If the reference count drops to 0, all keys and values will be
 // destroyed, and all memory allocated by the hash table is released.
 // This function is MT-safe and may be called from any thread.
@@ -670,10 +666,10 @@ func HashTableUnref(HashTableVar *HashTable) {
 var xInt64Equal func(uintptr, uintptr) bool
 
 // Compares the two #gint64 values being pointed to and returns
-// %TRUE if they are equal.
-// It can be passed to g_hash_table_new() as the @key_equal_func
-// parameter, when using non-%NULL pointers to 64-bit integers as keys in a
-// #GHashTable.
+// true if they are equal.
+// It can be passed to g_hash_table_new() as the key_equal_func
+// parameter, when using non-nil pointers to 64-bit integers as keys in a
+// [HashTable].
 func Int64Equal(V1Var uintptr, V2Var uintptr) bool {
 
 	cret := xInt64Equal(V1Var, V2Var)
@@ -685,9 +681,9 @@ var xInt64Hash func(uintptr) uint
 
 // Converts a pointer to a #gint64 to a hash value.
 //
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
-// when using non-%NULL pointers to 64-bit integer values as keys in a
-// #GHashTable.
+// It can be passed to g_hash_table_new() as the hash_func parameter,
+// when using non-nil pointers to 64-bit integer values as keys in a
+// [HashTable].
 func Int64Hash(VVar uintptr) uint {
 
 	cret := xInt64Hash(VVar)
@@ -698,14 +694,14 @@ func Int64Hash(VVar uintptr) uint {
 var xIntEqual func(uintptr, uintptr) bool
 
 // Compares the two #gint values being pointed to and returns
-// %TRUE if they are equal.
-// It can be passed to g_hash_table_new() as the @key_equal_func
-// parameter, when using non-%NULL pointers to integers as keys in a
-// #GHashTable.
+// true if they are equal.
+// It can be passed to g_hash_table_new() as the key_equal_func
+// parameter, when using non-nil pointers to integers as keys in a
+// [HashTable].
 //
 // Note that this function acts on pointers to #gint, not on #gint
 // directly: if your hash table's keys are of the form
-// `GINT_TO_POINTER (n)`, use g_direct_equal() instead.
+// `GINT_TO_POINTER (n)`, use [DirectEqual] instead.
 func IntEqual(V1Var uintptr, V2Var uintptr) bool {
 
 	cret := xIntEqual(V1Var, V2Var)
@@ -716,12 +712,12 @@ func IntEqual(V1Var uintptr, V2Var uintptr) bool {
 var xIntHash func(uintptr) uint
 
 // Converts a pointer to a #gint to a hash value.
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
-// when using non-%NULL pointers to integer values as keys in a #GHashTable.
+// It can be passed to g_hash_table_new() as the hash_func parameter,
+// when using non-nil pointers to integer values as keys in a [HashTable].
 //
 // Note that this function acts on pointers to #gint, not on #gint
 // directly: if your hash table's keys are of the form
-// `GINT_TO_POINTER (n)`, use g_direct_hash() instead.
+// `GINT_TO_POINTER (n)`, use [DirectHash] instead.
 func IntHash(VVar uintptr) uint {
 
 	cret := xIntHash(VVar)
@@ -731,14 +727,14 @@ func IntHash(VVar uintptr) uint {
 
 var xStrEqual func(uintptr, uintptr) bool
 
-// Compares two strings for byte-by-byte equality and returns %TRUE
+// Compares two strings for byte-by-byte equality and returns true
 // if they are equal. It can be passed to g_hash_table_new() as the
-// @key_equal_func parameter, when using non-%NULL strings as keys in a
-// #GHashTable.
+// key_equal_func parameter, when using non-nil strings as keys in a
+// [HashTable].
 //
 // This function is typically used for hash table comparisons, but can be used
-// for general purpose comparisons of non-%NULL strings. For a %NULL-safe string
-// comparison function, see g_strcmp0().
+// for general purpose comparisons of non-nil strings. For a nil-safe string
+// comparison function, see [Strcmp0].
 func StrEqual(V1Var uintptr, V2Var uintptr) bool {
 
 	cret := xStrEqual(V1Var, V2Var)
@@ -756,8 +752,8 @@ var xStrHash func(uintptr) uint
 // the string, is updated: `hash = hash * 33 + c`. This function
 // uses the signed value of each byte.
 //
-// It can be passed to g_hash_table_new() as the @hash_func parameter,
-// when using non-%NULL strings as keys in a #GHashTable.
+// It can be passed to g_hash_table_new() as the hash_func parameter,
+// when using non-nil strings as keys in a [HashTable].
 //
 // Note that this function may not be a perfect fit for all use cases.
 // For example, it produces some hash collisions with strings as short
@@ -774,55 +770,55 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDirectEqual, libs, "g_direct_equal")
-	core.PuregoSafeRegister(&xDirectHash, libs, "g_direct_hash")
-	core.PuregoSafeRegister(&xDoubleEqual, libs, "g_double_equal")
-	core.PuregoSafeRegister(&xDoubleHash, libs, "g_double_hash")
-	core.PuregoSafeRegister(&xHashTableAdd, libs, "g_hash_table_add")
-	core.PuregoSafeRegister(&xHashTableContains, libs, "g_hash_table_contains")
-	core.PuregoSafeRegister(&xHashTableDestroy, libs, "g_hash_table_destroy")
-	core.PuregoSafeRegister(&xHashTableFind, libs, "g_hash_table_find")
-	core.PuregoSafeRegister(&xHashTableForeach, libs, "g_hash_table_foreach")
-	core.PuregoSafeRegister(&xHashTableForeachRemove, libs, "g_hash_table_foreach_remove")
-	core.PuregoSafeRegister(&xHashTableForeachSteal, libs, "g_hash_table_foreach_steal")
-	core.PuregoSafeRegister(&xHashTableGetKeysAsPtrArray, libs, "g_hash_table_get_keys_as_ptr_array")
-	core.PuregoSafeRegister(&xHashTableGetValuesAsPtrArray, libs, "g_hash_table_get_values_as_ptr_array")
-	core.PuregoSafeRegister(&xHashTableInsert, libs, "g_hash_table_insert")
-	core.PuregoSafeRegister(&xHashTableLookup, libs, "g_hash_table_lookup")
-	core.PuregoSafeRegister(&xHashTableLookupExtended, libs, "g_hash_table_lookup_extended")
-	core.PuregoSafeRegister(&xHashTableNewSimilar, libs, "g_hash_table_new_similar")
-	core.PuregoSafeRegister(&xHashTableRef, libs, "g_hash_table_ref")
-	core.PuregoSafeRegister(&xHashTableRemove, libs, "g_hash_table_remove")
-	core.PuregoSafeRegister(&xHashTableRemoveAll, libs, "g_hash_table_remove_all")
-	core.PuregoSafeRegister(&xHashTableReplace, libs, "g_hash_table_replace")
-	core.PuregoSafeRegister(&xHashTableSize, libs, "g_hash_table_size")
-	core.PuregoSafeRegister(&xHashTableSteal, libs, "g_hash_table_steal")
-	core.PuregoSafeRegister(&xHashTableStealAll, libs, "g_hash_table_steal_all")
-	core.PuregoSafeRegister(&xHashTableStealAllKeys, libs, "g_hash_table_steal_all_keys")
-	core.PuregoSafeRegister(&xHashTableStealAllValues, libs, "g_hash_table_steal_all_values")
-	core.PuregoSafeRegister(&xHashTableStealExtended, libs, "g_hash_table_steal_extended")
-	core.PuregoSafeRegister(&xHashTableUnref, libs, "g_hash_table_unref")
-	core.PuregoSafeRegister(&xInt64Equal, libs, "g_int64_equal")
-	core.PuregoSafeRegister(&xInt64Hash, libs, "g_int64_hash")
-	core.PuregoSafeRegister(&xIntEqual, libs, "g_int_equal")
-	core.PuregoSafeRegister(&xIntHash, libs, "g_int_hash")
-	core.PuregoSafeRegister(&xStrEqual, libs, "g_str_equal")
-	core.PuregoSafeRegister(&xStrHash, libs, "g_str_hash")
+	core.PuregoRegisterSince(&xDirectEqual, libs, "g_direct_equal", "GLIB", "")
+	core.PuregoRegisterSince(&xDirectHash, libs, "g_direct_hash", "GLIB", "")
+	core.PuregoRegisterSince(&xDoubleEqual, libs, "g_double_equal", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xDoubleHash, libs, "g_double_hash", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xHashTableAdd, libs, "g_hash_table_add", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xHashTableContains, libs, "g_hash_table_contains", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xHashTableDestroy, libs, "g_hash_table_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableFind, libs, "g_hash_table_find", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xHashTableForeach, libs, "g_hash_table_foreach", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableForeachRemove, libs, "g_hash_table_foreach_remove", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableForeachSteal, libs, "g_hash_table_foreach_steal", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableGetKeysAsPtrArray, libs, "g_hash_table_get_keys_as_ptr_array", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xHashTableGetValuesAsPtrArray, libs, "g_hash_table_get_values_as_ptr_array", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xHashTableInsert, libs, "g_hash_table_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableLookup, libs, "g_hash_table_lookup", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableLookupExtended, libs, "g_hash_table_lookup_extended", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableNewSimilar, libs, "g_hash_table_new_similar", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xHashTableRef, libs, "g_hash_table_ref", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xHashTableRemove, libs, "g_hash_table_remove", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableRemoveAll, libs, "g_hash_table_remove_all", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xHashTableReplace, libs, "g_hash_table_replace", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableSize, libs, "g_hash_table_size", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableSteal, libs, "g_hash_table_steal", "GLIB", "")
+	core.PuregoRegisterSince(&xHashTableStealAll, libs, "g_hash_table_steal_all", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xHashTableStealAllKeys, libs, "g_hash_table_steal_all_keys", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xHashTableStealAllValues, libs, "g_hash_table_steal_all_values", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xHashTableStealExtended, libs, "g_hash_table_steal_extended", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xHashTableUnref, libs, "g_hash_table_unref", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xInt64Equal, libs, "g_int64_equal", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xInt64Hash, libs, "g_int64_hash", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xIntEqual, libs, "g_int_equal", "GLIB", "")
+	core.PuregoRegisterSince(&xIntHash, libs, "g_int_hash", "GLIB", "")
+	core.PuregoRegisterSince(&xStrEqual, libs, "g_str_equal", "GLIB", "")
+	core.PuregoRegisterSince(&xStrHash, libs, "g_str_hash", "GLIB", "")
 
 	core.PuregoSafeRegister(&xHashTableGLibType, libs, "g_hash_table_get_type")
 
-	core.PuregoSafeRegister(&xHashTableIterGetHashTable, libs, "g_hash_table_iter_get_hash_table")
-	core.PuregoSafeRegister(&xHashTableIterInit, libs, "g_hash_table_iter_init")
-	core.PuregoSafeRegister(&xHashTableIterNext, libs, "g_hash_table_iter_next")
-	core.PuregoSafeRegister(&xHashTableIterRemove, libs, "g_hash_table_iter_remove")
-	core.PuregoSafeRegister(&xHashTableIterReplace, libs, "g_hash_table_iter_replace")
-	core.PuregoSafeRegister(&xHashTableIterSteal, libs, "g_hash_table_iter_steal")
+	core.PuregoRegisterSince(&xHashTableIterGetHashTable, libs, "g_hash_table_iter_get_hash_table", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xHashTableIterInit, libs, "g_hash_table_iter_init", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xHashTableIterNext, libs, "g_hash_table_iter_next", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xHashTableIterRemove, libs, "g_hash_table_iter_remove", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xHashTableIterReplace, libs, "g_hash_table_iter_replace", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHashTableIterSteal, libs, "g_hash_table_iter_steal", "GLIB", "2.16")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -14,7 +13,7 @@ import (
 // and a key. Simple mechanisms for using SHA1 and other algorithms to
 // digest a key and data together are vulnerable to various security
 // issues.
-// [HMAC](http://en.wikipedia.org/wiki/HMAC)
+// [HMAC]
 // uses algorithms like SHA1 in a secure way to produce a digest of a
 // key and data.
 //
@@ -23,8 +22,10 @@ import (
 // Support for HMAC Digests has been added in GLib 2.30, and support for SHA-512
 // in GLib 2.42. Support for SHA-384 was added in GLib 2.52.
 //
-// To create a new `GHmac`, use [ctor@GLib.Hmac.new]. To free a `GHmac`, use
-// [method@GLib.Hmac.unref].
+// To create a new `GHmac`, use [NewHmac]. To free a `GHmac`, use
+// [Hmac.Unref].
+//
+// [HMAC]: http://en.wikipedia.org/wiki/HMAC
 type Hmac struct {
 	_ structs.HostLayout
 }
@@ -41,22 +42,22 @@ func (x *Hmac) GoPointer() uintptr {
 
 var xNewHmac func(ChecksumType, []byte, uint) *Hmac
 
-// Creates a new #GHmac, using the digest algorithm @digest_type.
-// If the @digest_type is not known, %NULL is returned.
-// A #GHmac can be used to compute the HMAC of a key and an
+// Creates a new [Hmac], using the digest algorithm digest_type.
+// If the digest_type is not known, nil is returned.
+// A [Hmac] can be used to compute the HMAC of a key and an
 // arbitrary binary blob, using different hashing algorithms.
 //
-// A #GHmac works by feeding a binary blob through g_hmac_update()
+// A [Hmac] works by feeding a binary blob through [Hmac.Update]
 // until the data is complete; the digest can then be extracted
-// using g_hmac_get_string(), which will return the checksum as a
-// hexadecimal string; or g_hmac_get_digest(), which will return a
-// array of raw bytes. Once either g_hmac_get_string() or
-// g_hmac_get_digest() have been called on a #GHmac, the HMAC
-// will be closed and it won't be possible to call g_hmac_update()
+// using [Hmac.GetString], which will return the checksum as a
+// hexadecimal string; or [Hmac.GetDigest], which will return a
+// array of raw bytes. Once either [Hmac.GetString] or
+// [Hmac.GetDigest] have been called on a [Hmac], the HMAC
+// will be closed and it won't be possible to call [Hmac.Update]
 // on it anymore.
 //
-// Support for digests of type %G_CHECKSUM_SHA512 has been added in GLib 2.42.
-// Support for %G_CHECKSUM_SHA384 was added in GLib 2.52.
+// Support for digests of type [GChecksumSha512Value] has been added in GLib 2.42.
+// Support for [GChecksumSha384Value] was added in GLib 2.52.
 func NewHmac(DigestTypeVar ChecksumType, KeyVar []byte, KeyLenVar uint) *Hmac {
 
 	cret := xNewHmac(DigestTypeVar, KeyVar, KeyLenVar)
@@ -65,8 +66,8 @@ func NewHmac(DigestTypeVar ChecksumType, KeyVar []byte, KeyLenVar uint) *Hmac {
 
 var xHmacCopy func(uintptr) *Hmac
 
-// Copies a #GHmac. If @hmac has been closed, by calling
-// g_hmac_get_string() or g_hmac_get_digest(), the copied
+// Copies a [Hmac]. If hmac has been closed, by calling
+// [Hmac.GetString] or [Hmac.GetDigest], the copied
 // HMAC will be closed as well.
 func (x *Hmac) Copy() *Hmac {
 
@@ -76,11 +77,11 @@ func (x *Hmac) Copy() *Hmac {
 
 var xHmacGetDigest func(uintptr, []byte, uint)
 
-// Gets the digest from @checksum as a raw binary array and places it
-// into @buffer. The size of the digest depends on the type of checksum.
+// Gets the digest from checksum as a raw binary array and places it
+// into buffer. The size of the digest depends on the type of checksum.
 //
-// Once this function has been called, the #GHmac is closed and can
-// no longer be updated with g_checksum_update().
+// Once this function has been called, the [Hmac] is closed and can
+// no longer be updated with [Checksum.Update].
 func (x *Hmac) GetDigest(BufferVar []byte, DigestLenVar uint) {
 
 	xHmacGetDigest(x.GoPointer(), BufferVar, DigestLenVar)
@@ -91,8 +92,8 @@ var xHmacGetString func(uintptr) string
 
 // Gets the HMAC as a hexadecimal string.
 //
-// Once this function has been called the #GHmac can no longer be
-// updated with g_hmac_update().
+// Once this function has been called the [Hmac] can no longer be
+// updated with [Hmac.Update].
 //
 // The hexadecimal characters will be lower case.
 func (x *Hmac) GetString() string {
@@ -103,7 +104,7 @@ func (x *Hmac) GetString() string {
 
 var xHmacRef func(uintptr) *Hmac
 
-// Atomically increments the reference count of @hmac by one.
+// Atomically increments the reference count of hmac by one.
 //
 // This function is MT-safe and may be called from any thread.
 func (x *Hmac) Ref() *Hmac {
@@ -114,12 +115,12 @@ func (x *Hmac) Ref() *Hmac {
 
 var xHmacUnref func(uintptr)
 
-// Atomically decrements the reference count of @hmac by one.
+// Atomically decrements the reference count of hmac by one.
 //
 // If the reference count drops to 0, all keys and values will be
 // destroyed, and all memory allocated by the hash table is released.
 // This function is MT-safe and may be called from any thread.
-// Frees the memory allocated for @hmac.
+// Frees the memory allocated for hmac.
 func (x *Hmac) Unref() {
 
 	xHmacUnref(x.GoPointer())
@@ -128,10 +129,10 @@ func (x *Hmac) Unref() {
 
 var xHmacUpdate func(uintptr, []byte, int)
 
-// Feeds @data into an existing #GHmac.
+// Feeds data into an existing [Hmac].
 //
-// The HMAC must still be open, that is g_hmac_get_string() or
-// g_hmac_get_digest() must not have been called on @hmac.
+// The HMAC must still be open, that is [Hmac.GetString] or
+// [Hmac.GetDigest] must not have been called on hmac.
 func (x *Hmac) Update(DataVar []byte, LengthVar int) {
 
 	xHmacUpdate(x.GoPointer(), DataVar, LengthVar)
@@ -140,9 +141,9 @@ func (x *Hmac) Update(DataVar []byte, LengthVar int) {
 
 var xComputeHmacForBytes func(ChecksumType, *Bytes, *Bytes) string
 
-// Computes the HMAC for a binary @data. This is a
-// convenience wrapper for g_hmac_new(), g_hmac_get_string()
-// and g_hmac_unref().
+// Computes the HMAC for a binary data. This is a
+// convenience wrapper for [NewHmac], [Hmac.GetString]
+// and [Hmac.Unref].
 //
 // The hexadecimal string returned will be in lower case.
 func ComputeHmacForBytes(DigestTypeVar ChecksumType, KeyVar *Bytes, DataVar *Bytes) string {
@@ -154,9 +155,9 @@ func ComputeHmacForBytes(DigestTypeVar ChecksumType, KeyVar *Bytes, DataVar *Byt
 
 var xComputeHmacForData func(ChecksumType, []byte, uint, []byte, uint) string
 
-// Computes the HMAC for a binary @data of @length. This is a
-// convenience wrapper for g_hmac_new(), g_hmac_get_string()
-// and g_hmac_unref().
+// Computes the HMAC for a binary data of length. This is a
+// convenience wrapper for [NewHmac], [Hmac.GetString]
+// and [Hmac.Unref].
 //
 // The hexadecimal string returned will be in lower case.
 func ComputeHmacForData(DigestTypeVar ChecksumType, KeyVar []byte, KeyLenVar uint, DataVar []byte, LengthVar uint) string {
@@ -183,26 +184,26 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xComputeHmacForBytes, libs, "g_compute_hmac_for_bytes")
-	core.PuregoSafeRegister(&xComputeHmacForData, libs, "g_compute_hmac_for_data")
-	core.PuregoSafeRegister(&xComputeHmacForString, libs, "g_compute_hmac_for_string")
+	core.PuregoRegisterSince(&xComputeHmacForBytes, libs, "g_compute_hmac_for_bytes", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xComputeHmacForData, libs, "g_compute_hmac_for_data", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xComputeHmacForString, libs, "g_compute_hmac_for_string", "GLIB", "2.30")
 
 	core.PuregoSafeRegister(&xHmacGLibType, libs, "g_hmac_get_type")
 
-	core.PuregoSafeRegister(&xNewHmac, libs, "g_hmac_new")
+	core.PuregoRegisterSince(&xNewHmac, libs, "g_hmac_new", "GLIB", "2.30")
 
-	core.PuregoSafeRegister(&xHmacCopy, libs, "g_hmac_copy")
-	core.PuregoSafeRegister(&xHmacGetDigest, libs, "g_hmac_get_digest")
-	core.PuregoSafeRegister(&xHmacGetString, libs, "g_hmac_get_string")
-	core.PuregoSafeRegister(&xHmacRef, libs, "g_hmac_ref")
-	core.PuregoSafeRegister(&xHmacUnref, libs, "g_hmac_unref")
-	core.PuregoSafeRegister(&xHmacUpdate, libs, "g_hmac_update")
+	core.PuregoRegisterSince(&xHmacCopy, libs, "g_hmac_copy", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHmacGetDigest, libs, "g_hmac_get_digest", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHmacGetString, libs, "g_hmac_get_string", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHmacRef, libs, "g_hmac_ref", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHmacUnref, libs, "g_hmac_unref", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xHmacUpdate, libs, "g_hmac_update", "GLIB", "2.30")
 
 }
@@ -2,22 +2,24 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Defines the type of a hook function that can be invoked
-// by g_hook_list_invoke_check().
+// by [HookList.InvokeCheck].
 type HookCheckFunc func(uintptr) bool
 
-// Defines the type of function used by g_hook_list_marshal_check().
+// Defines the type of function used by [HookList.MarshalCheck].
 type HookCheckMarshaller func(*Hook, uintptr) bool
 
-// Defines the type of function used to compare #GHook elements in
-// g_hook_insert_sorted().
+// Defines the type of function used to compare [Hook] elements in
+// [HookInsertSorted].
 type HookCompareFunc func(*Hook, *Hook) int
 
 // Defines the type of function to be called when a hook in a
@@ -28,13 +30,13 @@ type HookFinalizeFunc func(*HookList, *Hook)
 type HookFindFunc func(*Hook, uintptr) bool
 
 // Defines the type of a hook function that can be invoked
-// by g_hook_list_invoke().
+// by [HookList.Invoke].
 type HookFunc func(uintptr)
 
-// Defines the type of function used by g_hook_list_marshal().
+// Defines the type of function used by [HookList.Marshal].
 type HookMarshaller func(*Hook, uintptr)
 
-// The #GHook struct represents a single hook function in a #GHookList.
+// The [Hook] struct represents a single hook function in a [HookList].
 type Hook struct {
 	_ structs.HostLayout
 
@@ -46,7 +48,7 @@ type Hook struct {
 
 	RefCount uint
 
-	HookId uint
+	HookId types.ULong
 
 	Flags uint
 
@@ -61,7 +63,7 @@ func (x *Hook) GoPointer() uintptr {
 
 var xHookCompareIds func(uintptr, *Hook) int
 
-// Compares the ids of two #GHook elements, returning a negative value
+// Compares the ids of two [Hook] elements, returning a negative value
 // if the second id is greater than the first.
 func (x *Hook) CompareIds(SiblingVar *Hook) int {
 
@@ -69,11 +71,11 @@ func (x *Hook) CompareIds(SiblingVar *Hook) int {
 	return cret
 }
 
-// The #GHookList struct represents a list of hook functions.
+// The [HookList] struct represents a list of hook functions.
 type HookList struct {
 	_ structs.HostLayout
 
-	SeqId uint
+	SeqId types.ULong
 
 	HookSize uint
 
@@ -94,7 +96,7 @@ func (x *HookList) GoPointer() uintptr {
 
 var xHookListClear func(uintptr)
 
-// Removes all the #GHook elements from a #GHookList.
+// Removes all the [Hook] elements from a [HookList].
 func (x *HookList) Clear() {
 
 	xHookListClear(x.GoPointer())
@@ -103,8 +105,8 @@ func (x *HookList) Clear() {
 
 var xHookListInit func(uintptr, uint)
 
-// Initializes a #GHookList.
-// This must be called before the #GHookList is used.
+// Initializes a [HookList].
+// This must be called before the [HookList] is used.
 func (x *HookList) Init(HookSizeVar uint) {
 
 	xHookListInit(x.GoPointer(), HookSizeVar)
@@ -113,7 +115,7 @@ func (x *HookList) Init(HookSizeVar uint) {
 
 var xHookListInvoke func(uintptr, bool)
 
-// Calls all of the #GHook functions in a #GHookList.
+// Calls all of the [Hook] functions in a [HookList].
 func (x *HookList) Invoke(MayRecurseVar bool) {
 
 	xHookListInvoke(x.GoPointer(), MayRecurseVar)
@@ -122,8 +124,8 @@ func (x *HookList) Invoke(MayRecurseVar bool) {
 
 var xHookListInvokeCheck func(uintptr, bool)
 
-// Calls all of the #GHook functions in a #GHookList.
-// Any function which returns %FALSE is removed from the #GHookList.
+// Calls all of the [Hook] functions in a [HookList].
+// Any function which returns false is removed from the [HookList].
 func (x *HookList) InvokeCheck(MayRecurseVar bool) {
 
 	xHookListInvokeCheck(x.GoPointer(), MayRecurseVar)
@@ -132,7 +134,7 @@ func (x *HookList) InvokeCheck(MayRecurseVar bool) {
 
 var xHookListMarshal func(uintptr, bool, uintptr, uintptr)
 
-// Calls a function on each valid #GHook.
+// Calls a function on each valid [Hook].
 func (x *HookList) Marshal(MayRecurseVar bool, MarshallerVar *HookMarshaller, MarshalDataVar uintptr) {
 
 	var MarshallerVarRef uintptr
@@ -142,11 +144,12 @@ func (x *HookList) Marshal(MayRecurseVar bool, MarshallerVar *HookMarshaller, Ma
 			MarshallerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Hook, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *MarshallerVar
 				cbFn(arg0, arg1)
 			}
-			MarshallerVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(MarshallerVarPtr, MarshallerVarRef, MarshallerVar)
+			MarshallerVarRef = core.NewCallback(fcb)
+			MarshallerVarRef = SaveCallbackWithClosure(MarshallerVarPtr, MarshallerVarRef, MarshallerVar)
 		}
 	}
 
@@ -156,8 +159,8 @@ func (x *HookList) Marshal(MayRecurseVar bool, MarshallerVar *HookMarshaller, Ma
 
 var xHookListMarshalCheck func(uintptr, bool, uintptr, uintptr)
 
-// Calls a function on each valid #GHook and destroys it if the
-// function returns %FALSE.
+// Calls a function on each valid [Hook] and destroys it if the
+// function returns false.
 func (x *HookList) MarshalCheck(MayRecurseVar bool, MarshallerVar *HookCheckMarshaller, MarshalDataVar uintptr) {
 
 	var MarshallerVarRef uintptr
@@ -167,11 +170,12 @@ func (x *HookList) MarshalCheck(MayRecurseVar bool, MarshallerVar *HookCheckMars
 			MarshallerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Hook, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *MarshallerVar
 				return cbFn(arg0, arg1)
 			}
-			MarshallerVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(MarshallerVarPtr, MarshallerVarRef, MarshallerVar)
+			MarshallerVarRef = core.NewCallback(fcb)
+			MarshallerVarRef = SaveCallbackWithClosure(MarshallerVarPtr, MarshallerVarRef, MarshallerVar)
 		}
 	}
 
@@ -181,14 +185,14 @@ func (x *HookList) MarshalCheck(MayRecurseVar bool, MarshallerVar *HookCheckMars
 
 const (
 	// The position of the first bit which is not reserved for internal
-	// use be the #GHook implementation, i.e.
-	// `1 &lt;&lt; G_HOOK_FLAG_USER_SHIFT` is the first
+	// use be the [Hook] implementation, i.e.
+	// `1 << G_HOOK_FLAG_USER_SHIFT` is the first
 	// bit which can be used for application-defined flags.
 	HOOK_FLAG_USER_SHIFT int = 4
 )
 
-// Flags used internally in the #GHook implementation.
-type HookFlagMask int
+// Flags used internally in the [Hook] implementation.
+type HookFlagMask uint32
 
 const (
 
@@ -197,14 +201,54 @@ const (
 	// set if the hook is currently being run
 	GHookFlagInCallValue HookFlagMask = 2
 	// A mask covering all bits reserved for
-	//   hook flags; see %G_HOOK_FLAG_USER_SHIFT
+	//   hook flags; see [HOOK_FLAG_USER_SHIFT]
 	GHookFlagMaskValue HookFlagMask = 15
 )
 
-var xHookDestroy func(*HookList, uint) bool
+// Has reports whether all bits of flags are set in x
+func (x HookFlagMask) Has(flags HookFlagMask) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x HookFlagMask) Set(flags HookFlagMask) HookFlagMask {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x HookFlagMask) Clear(flags HookFlagMask) HookFlagMask {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x HookFlagMask) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GHookFlagActiveValue != 0 && x&GHookFlagActiveValue == GHookFlagActiveValue {
+		names = append(names, "G_HOOK_FLAG_ACTIVE")
+		rest &^= GHookFlagActiveValue
+	}
+	if rest&GHookFlagInCallValue != 0 && x&GHookFlagInCallValue == GHookFlagInCallValue {
+		names = append(names, "G_HOOK_FLAG_IN_CALL")
+		rest &^= GHookFlagInCallValue
+	}
+	if rest&GHookFlagMaskValue != 0 && x&GHookFlagMaskValue == GHookFlagMaskValue {
+		names = append(names, "G_HOOK_FLAG_MASK")
+		rest &^= GHookFlagMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+var xHookDestroy func(*HookList, types.ULong) bool
 
-// Destroys a #GHook, given its ID.
-func HookDestroy(HookListVar *HookList, HookIdVar uint) bool {
+// Destroys a [Hook], given its ID.
+func HookDestroy(HookListVar *HookList, HookIdVar types.ULong) bool {
 
 	cret := xHookDestroy(HookListVar, HookIdVar)
 
@@ -213,8 +257,8 @@ func HookDestroy(HookListVar *HookList, HookIdVar uint) bool {
 
 var xHookDestroyLink func(*HookList, *Hook)
 
-// Removes one #GHook from a #GHookList, marking it
-// inactive and calling g_hook_unref() on it.
+// Removes one [Hook] from a [HookList], marking it
+// inactive and calling [HookUnref] on it.
 func HookDestroyLink(HookListVar *HookList, HookVar *Hook) {
 
 	xHookDestroyLink(HookListVar, HookVar)
@@ -223,8 +267,8 @@ func HookDestroyLink(HookListVar *HookList, HookVar *Hook) {
 
 var xHookFree func(*HookList, *Hook)
 
-// Calls the #GHookList @finalize_hook function if it exists,
-// and frees the memory allocated for the #GHook.
+// Calls the [HookList] finalize_hook function if it exists,
+// and frees the memory allocated for the [Hook].
 func HookFree(HookListVar *HookList, HookVar *Hook) {
 
 	xHookFree(HookListVar, HookVar)
@@ -233,7 +277,7 @@ func HookFree(HookListVar *HookList, HookVar *Hook) {
 
 var xHookInsertBefore func(*HookList, *Hook, *Hook)
 
-// Inserts a #GHook into a #GHookList, before a given #GHook.
+// Inserts a [Hook] into a [HookList], before a given [Hook].
 func HookInsertBefore(HookListVar *HookList, SiblingVar *Hook, HookVar *Hook) {
 
 	xHookInsertBefore(HookListVar, SiblingVar, HookVar)
@@ -242,7 +286,7 @@ func HookInsertBefore(HookListVar *HookList, SiblingVar *Hook, HookVar *Hook) {
 
 var xHookInsertSorted func(*HookList, *Hook, uintptr)
 
-// Inserts a #GHook into a #GHookList, sorted by the given function.
+// Inserts a [Hook] into a [HookList], sorted by the given function.
 func HookInsertSorted(HookListVar *HookList, HookVar *Hook, FuncVar *HookCompareFunc) {
 
 	var FuncVarRef uintptr
@@ -252,11 +296,12 @@ func HookInsertSorted(HookListVar *HookList, HookVar *Hook, FuncVar *HookCompare
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Hook, arg1 *Hook) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -266,7 +311,7 @@ func HookInsertSorted(HookListVar *HookList, HookVar *Hook, FuncVar *HookCompare
 
 var xHookPrepend func(*HookList, *Hook)
 
-// Prepends a #GHook on the start of a #GHookList.
+// Prepends a [Hook] on the start of a [HookList].
 func HookPrepend(HookListVar *HookList, HookVar *Hook) {
 
 	xHookPrepend(HookListVar, HookVar)
@@ -275,9 +320,9 @@ func HookPrepend(HookListVar *HookList, HookVar *Hook) {
 
 var xHookUnref func(*HookList, *Hook)
 
-// Decrements the reference count of a #GHook.
-// If the reference count falls to 0, the #GHook is removed
-// from the #GHookList and g_hook_free() is called to free it.
+// Decrements the reference count of a [Hook].
+// If the reference count falls to 0, the [Hook] is removed
+// from the [HookList] and [HookFree] is called to free it.
 func HookUnref(HookListVar *HookList, HookVar *Hook) {
 
 	xHookUnref(HookListVar, HookVar)
@@ -289,28 +334,28 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xHookDestroy, libs, "g_hook_destroy")
-	core.PuregoSafeRegister(&xHookDestroyLink, libs, "g_hook_destroy_link")
-	core.PuregoSafeRegister(&xHookFree, libs, "g_hook_free")
-	core.PuregoSafeRegister(&xHookInsertBefore, libs, "g_hook_insert_before")
-	core.PuregoSafeRegister(&xHookInsertSorted, libs, "g_hook_insert_sorted")
-	core.PuregoSafeRegister(&xHookPrepend, libs, "g_hook_prepend")
-	core.PuregoSafeRegister(&xHookUnref, libs, "g_hook_unref")
-
-	core.PuregoSafeRegister(&xHookCompareIds, libs, "g_hook_compare_ids")
-
-	core.PuregoSafeRegister(&xHookListClear, libs, "g_hook_list_clear")
-	core.PuregoSafeRegister(&xHookListInit, libs, "g_hook_list_init")
-	core.PuregoSafeRegister(&xHookListInvoke, libs, "g_hook_list_invoke")
-	core.PuregoSafeRegister(&xHookListInvokeCheck, libs, "g_hook_list_invoke_check")
-	core.PuregoSafeRegister(&xHookListMarshal, libs, "g_hook_list_marshal")
-	core.PuregoSafeRegister(&xHookListMarshalCheck, libs, "g_hook_list_marshal_check")
+	core.PuregoRegisterSince(&xHookDestroy, libs, "g_hook_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xHookDestroyLink, libs, "g_hook_destroy_link", "GLIB", "")
+	core.PuregoRegisterSince(&xHookFree, libs, "g_hook_free", "GLIB", "")
+	core.PuregoRegisterSince(&xHookInsertBefore, libs, "g_hook_insert_before", "GLIB", "")
+	core.PuregoRegisterSince(&xHookInsertSorted, libs, "g_hook_insert_sorted", "GLIB", "")
+	core.PuregoRegisterSince(&xHookPrepend, libs, "g_hook_prepend", "GLIB", "")
+	core.PuregoRegisterSince(&xHookUnref, libs, "g_hook_unref", "GLIB", "")
+
+	core.PuregoRegisterSince(&xHookCompareIds, libs, "g_hook_compare_ids", "GLIB", "")
+
+	core.PuregoRegisterSince(&xHookListClear, libs, "g_hook_list_clear", "GLIB", "")
+	core.PuregoRegisterSince(&xHookListInit, libs, "g_hook_list_init", "GLIB", "")
+	core.PuregoRegisterSince(&xHookListInvoke, libs, "g_hook_list_invoke", "GLIB", "")
+	core.PuregoRegisterSince(&xHookListInvokeCheck, libs, "g_hook_list_invoke_check", "GLIB", "")
+	core.PuregoRegisterSince(&xHookListMarshal, libs, "g_hook_list_marshal", "GLIB", "")
+	core.PuregoRegisterSince(&xHookListMarshalCheck, libs, "g_hook_list_marshal_check", "GLIB", "")
 
 }
@@ -2,20 +2,19 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xHostnameIsAsciiEncoded func(string) bool
 
-// Tests if @hostname contains segments with an ASCII-compatible
+// Tests if hostname contains segments with an ASCII-compatible
 // encoding of an Internationalized Domain Name. If this returns
-// %TRUE, you should decode the hostname with g_hostname_to_unicode()
+// true, you should decode the hostname with [HostnameToUnicode]
 // before displaying it to the user.
 //
 // Note that a hostname might contain a mix of encoded and unencoded
-// segments, and so it is possible for g_hostname_is_non_ascii() and
-// g_hostname_is_ascii_encoded() to both return %TRUE for a name.
+// segments, and so it is possible for [HostnameIsNonAscii] and
+// [HostnameIsAsciiEncoded] to both return true for a name.
 func HostnameIsAsciiEncoded(HostnameVar string) bool {
 
 	cret := xHostnameIsAsciiEncoded(HostnameVar)
@@ -25,7 +24,7 @@ func HostnameIsAsciiEncoded(HostnameVar string) bool {
 
 var xHostnameIsIpAddress func(string) bool
 
-// Tests if @hostname is the string form of an IPv4 or IPv6 address.
+// Tests if hostname is the string form of an IPv4 or IPv6 address.
 // (Eg, "192.168.0.1".)
 //
 // Since 2.66, IPv6 addresses with a zone-id are accepted (RFC6874).
@@ -38,13 +37,13 @@ func HostnameIsIpAddress(HostnameVar string) bool {
 
 var xHostnameIsNonAscii func(string) bool
 
-// Tests if @hostname contains Unicode characters. If this returns
-// %TRUE, you need to encode the hostname with g_hostname_to_ascii()
+// Tests if hostname contains Unicode characters. If this returns
+// true, you need to encode the hostname with [HostnameToAscii]
 // before using it in non-IDN-aware contexts.
 //
 // Note that a hostname might contain a mix of encoded and unencoded
-// segments, and so it is possible for g_hostname_is_non_ascii() and
-// g_hostname_is_ascii_encoded() to both return %TRUE for a name.
+// segments, and so it is possible for [HostnameIsNonAscii] and
+// [HostnameIsAsciiEncoded] to both return true for a name.
 func HostnameIsNonAscii(HostnameVar string) bool {
 
 	cret := xHostnameIsNonAscii(HostnameVar)
@@ -54,7 +53,7 @@ func HostnameIsNonAscii(HostnameVar string) bool {
 
 var xHostnameToAscii func(string) string
 
-// Converts @hostname to its canonical ASCII form; an ASCII-only
+// Converts hostname to its canonical ASCII form; an ASCII-only
 // string containing no uppercase letters and not ending with a
 // trailing dot.
 func HostnameToAscii(HostnameVar string) string {
@@ -66,12 +65,12 @@ func HostnameToAscii(HostnameVar string) string {
 
 var xHostnameToUnicode func(string) string
 
-// Converts @hostname to its canonical presentation form; a UTF-8
+// Converts hostname to its canonical presentation form; a UTF-8
 // string in Unicode normalization form C, containing no uppercase
 // letters, no forbidden characters, and no ASCII-encoded segments,
 // and not ending with a trailing dot.
 //
-// Of course if @hostname is not an internationalized hostname, then
+// Of course if hostname is not an internationalized hostname, then
 // the canonical presentation form will be entirely ASCII.
 func HostnameToUnicode(HostnameVar string) string {
 
@@ -85,17 +84,17 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xHostnameIsAsciiEncoded, libs, "g_hostname_is_ascii_encoded")
-	core.PuregoSafeRegister(&xHostnameIsIpAddress, libs, "g_hostname_is_ip_address")
-	core.PuregoSafeRegister(&xHostnameIsNonAscii, libs, "g_hostname_is_non_ascii")
-	core.PuregoSafeRegister(&xHostnameToAscii, libs, "g_hostname_to_ascii")
-	core.PuregoSafeRegister(&xHostnameToUnicode, libs, "g_hostname_to_unicode")
+	core.PuregoRegisterSince(&xHostnameIsAsciiEncoded, libs, "g_hostname_is_ascii_encoded", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xHostnameIsIpAddress, libs, "g_hostname_is_ip_address", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xHostnameIsNonAscii, libs, "g_hostname_is_non_ascii", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xHostnameToAscii, libs, "g_hostname_to_ascii", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xHostnameToUnicode, libs, "g_hostname_to_unicode", "GLIB", "2.22")
 
 }
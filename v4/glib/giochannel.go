@@ -2,55 +2,56 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Specifies the type of function passed to g_io_add_watch() or
-// g_io_add_watch_full(), which is called when the requested condition
-// on a #GIOChannel is satisfied.
+// Specifies the type of function passed to [IoAddWatch] or
+// [IoAddWatchFull], which is called when the requested condition
+// on a [IOChannel] is satisfied.
 type IOFunc func(*IOChannel, IOCondition, uintptr) bool
 
 // The `GIOChannel` data type aims to provide a portable method for
 // using file descriptors, pipes, and sockets, and integrating them
-// into the main event loop (see [struct@GLib.MainContext]). Currently,
+// into the main event loop (see [MainContext]). Currently,
 // full support is available on UNIX platforms; support for Windows
 // is only partially complete.
 //
 // To create a new `GIOChannel` on UNIX systems use
-// [ctor@GLib.IOChannel.unix_new]. This works for plain file descriptors,
+// [IOChannelUnixNew]. This works for plain file descriptors,
 // pipes and sockets. Alternatively, a channel can be created for a
-// file in a system independent manner using [ctor@GLib.IOChannel.new_file].
+// file in a system independent manner using [NewIOChannelFile].
 //
 // Once a `GIOChannel` has been created, it can be used in a generic
-// manner with the functions [method@GLib.IOChannel.read_chars],
-// [method@GLib.IOChannel.write_chars], [method@GLib.IOChannel.seek_position],
-// and [method@GLib.IOChannel.shutdown].
+// manner with the functions [IOChannel.ReadChars],
+// [IOChannel.WriteChars], [IOChannel.SeekPosition],
+// and [IOChannel.Shutdown].
 //
-// To add a `GIOChannel` to the main event loop, use [func@GLib.io_add_watch] or
-// [func@GLib.io_add_watch_full]. Here you specify which events you are
+// To add a `GIOChannel` to the main event loop, use [IoAddWatch] or
+// [IoAddWatchFull]. Here you specify which events you are
 // interested in on the `GIOChannel`, and provide a function to be called
 // whenever these events occur.
 //
 // `GIOChannel` instances are created with an initial reference count of 1.
-// [method@GLib.IOChannel.ref] and [method@GLib.IOChannel.unref] can be used to
+// [IOChannel.Ref] and [IOChannel.Unref] can be used to
 // increment or decrement the reference count respectively. When the
 // reference count falls to 0, the `GIOChannel` is freed. (Though it
 // isn’t closed automatically, unless it was created using
-// [ctor@GLib.IOChannel.new_file].) Using [func@GLib.io_add_watch] or
-// [func@GLib.io_add_watch_full] increments a channel’s reference count.
+// [NewIOChannelFile].) Using [IoAddWatch] or
+// [IoAddWatchFull] increments a channel’s reference count.
 //
-// The new functions [method@GLib.IOChannel.read_chars],
-// [method@GLib.IOChannel.read_line], [method@GLib.IOChannel.read_line_string],
-// [method@GLib.IOChannel.read_to_end], [method@GLib.IOChannel.write_chars],
-// [method@GLib.IOChannel.seek_position], and [method@GLib.IOChannel.flush]
+// The new functions [IOChannel.ReadChars],
+// [IOChannel.ReadLine], [IOChannel.ReadLineString],
+// [IOChannel.ReadToEnd], [IOChannel.WriteChars],
+// [IOChannel.SeekPosition], and [IOChannel.Flush]
 // should not be mixed with the deprecated functions
-// [method@GLib.IOChannel.read], [method@GLib.IOChannel.write], and
-// [method@GLib.IOChannel.seek] on the same channel.
+// [IOChannel.Read], [IOChannel.Write], and
+// [IOChannel.Seek] on the same channel.
 type IOChannel struct {
 	_ structs.HostLayout
 
@@ -107,9 +108,9 @@ func (x *IOChannel) GoPointer() uintptr {
 
 var xNewIOChannelFile func(string, string, **Error) *IOChannel
 
-// Open a file @filename as a #GIOChannel using mode @mode. This
+// Open a file filename as a [IOChannel] using mode mode. This
 // channel will be closed when the last reference to it is dropped,
-// so there is no need to call g_io_channel_close() (though doing
+// so there is no need to call [IOChannel.Close] (though doing
 // so will not cause problems, as long as no attempt is made to
 // access the channel after it is closed).
 func NewIOChannelFile(FilenameVar string, ModeVar string) (*IOChannel, error) {
@@ -117,7 +118,7 @@ func NewIOChannelFile(FilenameVar string, ModeVar string) (*IOChannel, error) {
 
 	cret := xNewIOChannelFile(FilenameVar, ModeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -125,20 +126,20 @@ func NewIOChannelFile(FilenameVar string, ModeVar string) (*IOChannel, error) {
 
 var xIOChannelUnixNew func(int) *IOChannel
 
-// Creates a new #GIOChannel given a file descriptor. On UNIX systems
+// Creates a new [IOChannel] given a file descriptor. On UNIX systems
 // this works for plain files, pipes, and sockets.
 //
-// The returned #GIOChannel has a reference count of 1.
+// The returned [IOChannel] has a reference count of 1.
 //
-// The default encoding for #GIOChannel is UTF-8. If your application
+// The default encoding for [IOChannel] is UTF-8. If your application
 // is reading output from a command using via pipe, you may need to set
 // the encoding to the encoding of the current locale (see
-// g_get_charset()) with the g_io_channel_set_encoding() function.
+// [GetCharset]) with the [IOChannel.SetEncoding] function.
 // By default, the fd passed will not be closed when the final reference
-// to the #GIOChannel data structure is dropped.
+// to the [IOChannel] data structure is dropped.
 //
 // If you want to read raw binary data without interpretation, then
-// call the g_io_channel_set_encoding() function with %NULL for the
+// call the [IOChannel.SetEncoding] function with nil for the
 // encoding argument.
 //
 // This function is available in GLib on Windows, too, but you should
@@ -153,17 +154,6 @@ func IOChannelUnixNew(FdVar int) *IOChannel {
 	return cret
 }
 
-var xIOChannelClose func(uintptr)
-
-// Close an IO channel. Any pending data to be written will be
-// flushed, ignoring errors. The channel will not be freed until the
-// last reference is dropped using g_io_channel_unref().
-func (x *IOChannel) Close() {
-
-	xIOChannelClose(x.GoPointer())
-
-}
-
 var xIOChannelFlush func(uintptr) IOStatus
 
 // Flushes the write buffer for the GIOChannel.
@@ -172,7 +162,7 @@ func (x *IOChannel) Flush() (IOStatus, error) {
 
 	cret := xIOChannelFlush(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -180,9 +170,9 @@ func (x *IOChannel) Flush() (IOStatus, error) {
 
 var xIOChannelGetBufferCondition func(uintptr) IOCondition
 
-// This function returns a #GIOCondition depending on whether there
+// This function returns a [gobject.IOCondition] depending on whether there
 // is data to be read/space to write data in the internal buffers in
-// the #GIOChannel. Only the flags %G_IO_IN and %G_IO_OUT may be set.
+// the [IOChannel]. Only the flags [GIoInValue] and [GIoOutValue] may be set.
 func (x *IOChannel) GetBufferCondition() IOCondition {
 
 	cret := xIOChannelGetBufferCondition(x.GoPointer())
@@ -200,7 +190,7 @@ func (x *IOChannel) GetBufferSize() uint {
 
 var xIOChannelGetBuffered func(uintptr) bool
 
-// Returns whether @channel is buffered.
+// Returns whether channel is buffered.
 func (x *IOChannel) GetBuffered() bool {
 
 	cret := xIOChannelGetBuffered(x.GoPointer())
@@ -209,10 +199,10 @@ func (x *IOChannel) GetBuffered() bool {
 
 var xIOChannelGetCloseOnUnref func(uintptr) bool
 
-// Returns whether the file/socket/whatever associated with @channel
-// will be closed when @channel receives its final unref and is
-// destroyed. The default value of this is %TRUE for channels created
-// by g_io_channel_new_file (), and %FALSE for all other channels.
+// Returns whether the file/socket/whatever associated with channel
+// will be closed when channel receives its final unref and is
+// destroyed. The default value of this is true for channels created
+// by g_io_channel_new_file (), and false for all other channels.
 func (x *IOChannel) GetCloseOnUnref() bool {
 
 	cret := xIOChannelGetCloseOnUnref(x.GoPointer())
@@ -222,7 +212,7 @@ func (x *IOChannel) GetCloseOnUnref() bool {
 var xIOChannelGetEncoding func(uintptr) string
 
 // Gets the encoding for the input/output of the channel.
-// The internal encoding is always UTF-8. The encoding %NULL
+// The internal encoding is always UTF-8. The encoding nil
 // makes the channel safe for binary data.
 func (x *IOChannel) GetEncoding() string {
 
@@ -232,14 +222,14 @@ func (x *IOChannel) GetEncoding() string {
 
 var xIOChannelGetFlags func(uintptr) IOFlags
 
-// Gets the current flags for a #GIOChannel, including read-only
-// flags such as %G_IO_FLAG_IS_READABLE.
+// Gets the current flags for a [IOChannel], including read-only
+// flags such as [GIoFlagIsReadableValue].
 //
-// The values of the flags %G_IO_FLAG_IS_READABLE and %G_IO_FLAG_IS_WRITABLE
+// The values of the flags [GIoFlagIsReadableValue] and [GIoFlagIsWritableValue]
 // are cached for internal use by the channel when it is created.
 // If they should change at some later point (e.g. partial shutdown
 // of a socket with the UNIX shutdown() function), the user
-// should immediately call g_io_channel_get_flags() to update
+// should immediately call [IOChannel.GetFlags] to update
 // the internal values of these flags.
 func (x *IOChannel) GetFlags() IOFlags {
 
@@ -249,8 +239,8 @@ func (x *IOChannel) GetFlags() IOFlags {
 
 var xIOChannelGetLineTerm func(uintptr, *int) string
 
-// This returns the string that #GIOChannel uses to determine
-// where in the file a line break occurs. A value of %NULL
+// This returns the string that [IOChannel] uses to determine
+// where in the file a line break occurs. A value of nil
 // indicates autodetection. Since 2.84, the return value is always
 // nul-terminated.
 func (x *IOChannel) GetLineTerm(LengthVar *int) string {
@@ -261,35 +251,26 @@ func (x *IOChannel) GetLineTerm(LengthVar *int) string {
 
 var xIOChannelInit func(uintptr)
 
-// Initializes a #GIOChannel struct.
+// Initializes a [IOChannel] struct.
 //
 // This is called by each of the above functions when creating a
-// #GIOChannel, and so is not often needed by the application
-// programmer (unless you are creating a new type of #GIOChannel).
+// [IOChannel], and so is not often needed by the application
+// programmer (unless you are creating a new type of [IOChannel]).
 func (x *IOChannel) Init() {
 
 	xIOChannelInit(x.GoPointer())
 
 }
 
-var xIOChannelRead func(uintptr, string, uint, uint) IOError
-
-// Reads data from a #GIOChannel.
-func (x *IOChannel) Read(BufVar string, CountVar uint, BytesReadVar uint) IOError {
-
-	cret := xIOChannelRead(x.GoPointer(), BufVar, CountVar, BytesReadVar)
-	return cret
-}
-
 var xIOChannelReadChars func(uintptr, *[]byte, uint, *uint, **Error) IOStatus
 
-// Replacement for g_io_channel_read() with the new API.
+// Replacement for [IOChannel.Read] with the new API.
 func (x *IOChannel) ReadChars(BufVar *[]byte, CountVar uint, BytesReadVar *uint) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelReadChars(x.GoPointer(), BufVar, CountVar, BytesReadVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -298,15 +279,15 @@ func (x *IOChannel) ReadChars(BufVar *[]byte, CountVar uint, BytesReadVar *uint)
 var xIOChannelReadLine func(uintptr, *string, *uint, *uint, **Error) IOStatus
 
 // Reads a line, including the terminating character(s),
-// from a #GIOChannel into a newly-allocated string.
-// @str_return will contain allocated memory if the return
-// is %G_IO_STATUS_NORMAL.
+// from a [IOChannel] into a newly-allocated string.
+// str_return will contain allocated memory if the return
+// is [GIoStatusNormalValue].
 func (x *IOChannel) ReadLine(StrReturnVar *string, LengthVar *uint, TerminatorPosVar *uint) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelReadLine(x.GoPointer(), StrReturnVar, LengthVar, TerminatorPosVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -314,13 +295,13 @@ func (x *IOChannel) ReadLine(StrReturnVar *string, LengthVar *uint, TerminatorPo
 
 var xIOChannelReadLineString func(uintptr, *String, uint, **Error) IOStatus
 
-// Reads a line from a #GIOChannel, using a #GString as a buffer.
+// Reads a line from a [IOChannel], using a [String] as a buffer.
 func (x *IOChannel) ReadLineString(BufferVar *String, TerminatorPosVar uint) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelReadLineString(x.GoPointer(), BufferVar, TerminatorPosVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -334,22 +315,22 @@ func (x *IOChannel) ReadToEnd(StrReturnVar *[]byte, LengthVar *uint) (IOStatus,
 
 	cret := xIOChannelReadToEnd(x.GoPointer(), StrReturnVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xIOChannelReadUnichar func(uintptr, *uint32, **Error) IOStatus
+var xIOChannelReadUnichar func(uintptr, *rune, **Error) IOStatus
 
-// Reads a Unicode character from @channel.
-// This function cannot be called on a channel with %NULL encoding.
-func (x *IOChannel) ReadUnichar(ThecharVar *uint32) (IOStatus, error) {
+// Reads a Unicode character from channel.
+// This function cannot be called on a channel with nil encoding.
+func (x *IOChannel) ReadUnichar(ThecharVar *rune) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelReadUnichar(x.GoPointer(), ThecharVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -357,32 +338,22 @@ func (x *IOChannel) ReadUnichar(ThecharVar *uint32) (IOStatus, error) {
 
 var xIOChannelRef func(uintptr) *IOChannel
 
-// Increments the reference count of a #GIOChannel.
+// Increments the reference count of a [IOChannel].
 func (x *IOChannel) Ref() *IOChannel {
 
 	cret := xIOChannelRef(x.GoPointer())
 	return cret
 }
 
-var xIOChannelSeek func(uintptr, int64, SeekType) IOError
-
-// Sets the current position in the #GIOChannel, similar to the standard
-// library function fseek().
-func (x *IOChannel) Seek(OffsetVar int64, TypeVar SeekType) IOError {
-
-	cret := xIOChannelSeek(x.GoPointer(), OffsetVar, TypeVar)
-	return cret
-}
-
 var xIOChannelSeekPosition func(uintptr, int64, SeekType, **Error) IOStatus
 
-// Replacement for g_io_channel_seek() with the new API.
+// Replacement for [IOChannel.Seek] with the new API.
 func (x *IOChannel) SeekPosition(OffsetVar int64, TypeVar SeekType) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelSeekPosition(x.GoPointer(), OffsetVar, TypeVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -400,15 +371,15 @@ func (x *IOChannel) SetBufferSize(SizeVar uint) {
 var xIOChannelSetBuffered func(uintptr, bool)
 
 // The buffering state can only be set if the channel's encoding
-// is %NULL. For any other encoding, the channel must be buffered.
+// is nil. For any other encoding, the channel must be buffered.
 //
 // A buffered channel can only be set unbuffered if the channel's
 // internal buffers have been flushed. Newly created channels or
-// channels which have returned %G_IO_STATUS_EOF
+// channels which have returned [GIoStatusEofValue]
 // not require such a flush. For write-only channels, a call to
 // g_io_channel_flush () is sufficient. For all other channels,
 // the buffers may be flushed by a call to g_io_channel_seek_position ().
-// This includes the possibility of seeking with seek type %G_SEEK_CUR
+// This includes the possibility of seeking with seek type [GSeekCurValue]
 // and an offset of zero. Note that this means that socket-based
 // channels cannot be set unbuffered once they have had data
 // read from them.
@@ -426,12 +397,12 @@ func (x *IOChannel) SetBuffered(BufferedVar bool) {
 
 var xIOChannelSetCloseOnUnref func(uintptr, bool)
 
-// Whether to close the channel on the final unref of the #GIOChannel
-// data structure. The default value of this is %TRUE for channels
-// created by g_io_channel_new_file (), and %FALSE for all other channels.
+// Whether to close the channel on the final unref of the [IOChannel]
+// data structure. The default value of this is true for channels
+// created by g_io_channel_new_file (), and false for all other channels.
 //
-// Setting this flag to %TRUE for a channel you have already closed
-// can cause problems when the final reference to the #GIOChannel is dropped.
+// Setting this flag to true for a channel you have already closed
+// can cause problems when the final reference to the [IOChannel] is dropped.
 func (x *IOChannel) SetCloseOnUnref(DoCloseVar bool) {
 
 	xIOChannelSetCloseOnUnref(x.GoPointer(), DoCloseVar)
@@ -444,7 +415,7 @@ var xIOChannelSetEncoding func(uintptr, uintptr, **Error) IOStatus
 // The internal encoding is always UTF-8. The default encoding
 // for the external file is UTF-8.
 //
-// The encoding %NULL is safe to use with binary data.
+// The encoding nil is safe to use with binary data.
 //
 // The encoding can only be set if one of the following conditions
 // is true:
@@ -454,25 +425,25 @@ var xIOChannelSetEncoding func(uintptr, uintptr, **Error) IOStatus
 // - The channel is write-only.
 //
 //   - The channel is a file, and the file pointer was just repositioned
-//     by a call to g_io_channel_seek_position(). (This flushes all the
+//     by a call to [IOChannel.SeekPosition]. (This flushes all the
 //     internal buffers.)
 //
-// - The current encoding is %NULL or UTF-8.
+// - The current encoding is nil or UTF-8.
 //
-//   - One of the (new API) read functions has just returned %G_IO_STATUS_EOF
-//     (or, in the case of g_io_channel_read_to_end(), %G_IO_STATUS_NORMAL).
+//   - One of the (new API) read functions has just returned [GIoStatusEofValue]
+//     (or, in the case of [IOChannel.ReadToEnd], [GIoStatusNormalValue]).
 //
-//   - One of the functions g_io_channel_read_chars() or
-//     g_io_channel_read_unichar() has returned %G_IO_STATUS_AGAIN or
-//     %G_IO_STATUS_ERROR. This may be useful in the case of
-//     %G_CONVERT_ERROR_ILLEGAL_SEQUENCE.
-//     Returning one of these statuses from g_io_channel_read_line(),
-//     g_io_channel_read_line_string(), or g_io_channel_read_to_end()
+//   - One of the functions [IOChannel.ReadChars] or
+//     [IOChannel.ReadUnichar] has returned [GIoStatusAgainValue] or
+//     [GIoStatusErrorValue]. This may be useful in the case of
+//     [GConvertErrorIllegalSequenceValue].
+//     Returning one of these statuses from [IOChannel.ReadLine],
+//     [IOChannel.ReadLineString], or [IOChannel.ReadToEnd]
 //     does not guarantee that the encoding can be changed.
 //
 // Channels which do not meet one of the above conditions cannot call
-// g_io_channel_seek_position() with an offset of %G_SEEK_CUR, and, if
-// they are "seekable", cannot call g_io_channel_write_chars() after
+// [IOChannel.SeekPosition] with an offset of [GSeekCurValue], and, if
+// they are "seekable", cannot call [IOChannel.WriteChars] after
 // calling one of the API "read" functions.
 func (x *IOChannel) SetEncoding(EncodingVar *string) (IOStatus, error) {
 	var cerr *Error
@@ -482,7 +453,7 @@ func (x *IOChannel) SetEncoding(EncodingVar *string) (IOStatus, error) {
 
 	cret := xIOChannelSetEncoding(x.GoPointer(), EncodingVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -490,13 +461,13 @@ func (x *IOChannel) SetEncoding(EncodingVar *string) (IOStatus, error) {
 
 var xIOChannelSetFlags func(uintptr, IOFlags, **Error) IOStatus
 
-// Sets the (writeable) flags in @channel to (@flags &amp; %G_IO_FLAG_SET_MASK).
+// Sets the (writeable) flags in channel to (flags & [GIoFlagSetMaskValue]).
 func (x *IOChannel) SetFlags(FlagsVar IOFlags) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelSetFlags(x.GoPointer(), FlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -504,7 +475,7 @@ func (x *IOChannel) SetFlags(FlagsVar IOFlags) (IOStatus, error) {
 
 var xIOChannelSetLineTerm func(uintptr, uintptr, int)
 
-// This sets the string that #GIOChannel uses to determine
+// This sets the string that [IOChannel] uses to determine
 // where in the file a line break occurs.
 func (x *IOChannel) SetLineTerm(LineTermVar *string, LengthVar int) {
 
@@ -518,14 +489,14 @@ func (x *IOChannel) SetLineTerm(LineTermVar *string, LengthVar int) {
 var xIOChannelShutdown func(uintptr, bool, **Error) IOStatus
 
 // Close an IO channel. Any pending data to be written will be
-// flushed if @flush is %TRUE. The channel will not be freed until the
-// last reference is dropped using g_io_channel_unref().
+// flushed if flush is true. The channel will not be freed until the
+// last reference is dropped using [IOChannel.Unref].
 func (x *IOChannel) Shutdown(FlushVar bool) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelShutdown(x.GoPointer(), FlushVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -533,10 +504,10 @@ func (x *IOChannel) Shutdown(FlushVar bool) (IOStatus, error) {
 
 var xIOChannelUnixGetFd func(uintptr) int
 
-// Returns the file descriptor of the #GIOChannel.
+// Returns the file descriptor of the [IOChannel].
 //
 // On Windows this function returns the file descriptor or socket of
-// the #GIOChannel.
+// the [IOChannel].
 func (x *IOChannel) UnixGetFd() int {
 
 	cret := xIOChannelUnixGetFd(x.GoPointer())
@@ -545,27 +516,18 @@ func (x *IOChannel) UnixGetFd() int {
 
 var xIOChannelUnref func(uintptr)
 
-// Decrements the reference count of a #GIOChannel.
+// Decrements the reference count of a [IOChannel].
 func (x *IOChannel) Unref() {
 
 	xIOChannelUnref(x.GoPointer())
 
 }
 
-var xIOChannelWrite func(uintptr, string, uint, uint) IOError
-
-// Writes data to a #GIOChannel.
-func (x *IOChannel) Write(BufVar string, CountVar uint, BytesWrittenVar uint) IOError {
-
-	cret := xIOChannelWrite(x.GoPointer(), BufVar, CountVar, BytesWrittenVar)
-	return cret
-}
-
 var xIOChannelWriteChars func(uintptr, []byte, int, *uint, **Error) IOStatus
 
-// Replacement for g_io_channel_write() with the new API.
+// Replacement for [IOChannel.Write] with the new API.
 //
-// On seekable channels with encodings other than %NULL or UTF-8, generic
+// On seekable channels with encodings other than nil or UTF-8, generic
 // mixing of reading and writing is not allowed. A call to g_io_channel_write_chars ()
 // may only be made on a channel from which data has been read in the
 // cases described in the documentation for g_io_channel_set_encoding ().
@@ -574,28 +536,28 @@ func (x *IOChannel) WriteChars(BufVar []byte, CountVar int, BytesWrittenVar *uin
 
 	cret := xIOChannelWriteChars(x.GoPointer(), BufVar, CountVar, BytesWrittenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xIOChannelWriteUnichar func(uintptr, uint32, **Error) IOStatus
+var xIOChannelWriteUnichar func(uintptr, rune, **Error) IOStatus
 
-// Writes a Unicode character to @channel.
-// This function cannot be called on a channel with %NULL encoding.
-func (x *IOChannel) WriteUnichar(ThecharVar uint32) (IOStatus, error) {
+// Writes a Unicode character to channel.
+// This function cannot be called on a channel with nil encoding.
+func (x *IOChannel) WriteUnichar(ThecharVar rune) (IOStatus, error) {
 	var cerr *Error
 
 	cret := xIOChannelWriteUnichar(x.GoPointer(), ThecharVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-// A table of functions used to handle different types of #GIOChannel
+// A table of functions used to handle different types of [IOChannel]
 // in a generic way.
 type IOFuncs struct {
 	_ structs.HostLayout
@@ -624,14 +586,15 @@ func (x *IOFuncs) GoPointer() uintptr {
 // OverrideIoRead sets the "io_read" callback function.
 // reads raw bytes from the channel.  This is called from
 //
-//	various functions such as g_io_channel_read_chars() to
+//	various functions such as [IOChannel.ReadChars] to
 //	read raw bytes from the channel.  Encoding and buffering
 //	issues are dealt with at a higher level.
 func (x *IOFuncs) OverrideIoRead(cb func(*IOChannel, string, uint, uint) IOStatus) {
 	if cb == nil {
 		x.xIoRead = 0
 	} else {
-		x.xIoRead = purego.NewCallback(func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesReadVarp uint) IOStatus {
+		x.xIoRead = core.NewCallback(func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesReadVarp uint) IOStatus {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp, BufVarp, CountVarp, BytesReadVarp)
 		})
 	}
@@ -640,7 +603,7 @@ func (x *IOFuncs) OverrideIoRead(cb func(*IOChannel, string, uint, uint) IOStatu
 // GetIoRead gets the "io_read" callback function.
 // reads raw bytes from the channel.  This is called from
 //
-//	various functions such as g_io_channel_read_chars() to
+//	various functions such as [IOChannel.ReadChars] to
 //	read raw bytes from the channel.  Encoding and buffering
 //	issues are dealt with at a higher level.
 func (x *IOFuncs) GetIoRead() func(*IOChannel, string, uint, uint) IOStatus {
@@ -648,7 +611,7 @@ func (x *IOFuncs) GetIoRead() func(*IOChannel, string, uint, uint) IOStatus {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesReadVarp uint) IOStatus
-	purego.RegisterFunc(&rawCallback, x.xIoRead)
+	core.RegisterFunc(&rawCallback, x.xIoRead)
 	return func(ChannelVar *IOChannel, BufVar string, CountVar uint, BytesReadVar uint) IOStatus {
 		return rawCallback(ChannelVar, BufVar, CountVar, BytesReadVar)
 	}
@@ -657,14 +620,15 @@ func (x *IOFuncs) GetIoRead() func(*IOChannel, string, uint, uint) IOStatus {
 // OverrideIoWrite sets the "io_write" callback function.
 // writes raw bytes to the channel.  This is called from
 //
-//	various functions such as g_io_channel_write_chars() to
+//	various functions such as [IOChannel.WriteChars] to
 //	write raw bytes to the channel.  Encoding and buffering
 //	issues are dealt with at a higher level.
 func (x *IOFuncs) OverrideIoWrite(cb func(*IOChannel, string, uint, uint) IOStatus) {
 	if cb == nil {
 		x.xIoWrite = 0
 	} else {
-		x.xIoWrite = purego.NewCallback(func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesWrittenVarp uint) IOStatus {
+		x.xIoWrite = core.NewCallback(func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesWrittenVarp uint) IOStatus {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp, BufVarp, CountVarp, BytesWrittenVarp)
 		})
 	}
@@ -673,7 +637,7 @@ func (x *IOFuncs) OverrideIoWrite(cb func(*IOChannel, string, uint, uint) IOStat
 // GetIoWrite gets the "io_write" callback function.
 // writes raw bytes to the channel.  This is called from
 //
-//	various functions such as g_io_channel_write_chars() to
+//	various functions such as [IOChannel.WriteChars] to
 //	write raw bytes to the channel.  Encoding and buffering
 //	issues are dealt with at a higher level.
 func (x *IOFuncs) GetIoWrite() func(*IOChannel, string, uint, uint) IOStatus {
@@ -681,7 +645,7 @@ func (x *IOFuncs) GetIoWrite() func(*IOChannel, string, uint, uint) IOStatus {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel, BufVarp string, CountVarp uint, BytesWrittenVarp uint) IOStatus
-	purego.RegisterFunc(&rawCallback, x.xIoWrite)
+	core.RegisterFunc(&rawCallback, x.xIoWrite)
 	return func(ChannelVar *IOChannel, BufVar string, CountVar uint, BytesWrittenVar uint) IOStatus {
 		return rawCallback(ChannelVar, BufVar, CountVar, BytesWrittenVar)
 	}
@@ -690,12 +654,13 @@ func (x *IOFuncs) GetIoWrite() func(*IOChannel, string, uint, uint) IOStatus {
 // OverrideIoSeek sets the "io_seek" callback function.
 // seeks the channel.  This is called from
 //
-//	g_io_channel_seek() on channels that support it.
+//	[IOChannel.Seek] on channels that support it.
 func (x *IOFuncs) OverrideIoSeek(cb func(*IOChannel, int64, SeekType) IOStatus) {
 	if cb == nil {
 		x.xIoSeek = 0
 	} else {
-		x.xIoSeek = purego.NewCallback(func(ChannelVarp *IOChannel, OffsetVarp int64, TypeVarp SeekType) IOStatus {
+		x.xIoSeek = core.NewCallback(func(ChannelVarp *IOChannel, OffsetVarp int64, TypeVarp SeekType) IOStatus {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp, OffsetVarp, TypeVarp)
 		})
 	}
@@ -704,13 +669,13 @@ func (x *IOFuncs) OverrideIoSeek(cb func(*IOChannel, int64, SeekType) IOStatus)
 // GetIoSeek gets the "io_seek" callback function.
 // seeks the channel.  This is called from
 //
-//	g_io_channel_seek() on channels that support it.
+//	[IOChannel.Seek] on channels that support it.
 func (x *IOFuncs) GetIoSeek() func(*IOChannel, int64, SeekType) IOStatus {
 	if x.xIoSeek == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel, OffsetVarp int64, TypeVarp SeekType) IOStatus
-	purego.RegisterFunc(&rawCallback, x.xIoSeek)
+	core.RegisterFunc(&rawCallback, x.xIoSeek)
 	return func(ChannelVar *IOChannel, OffsetVar int64, TypeVar SeekType) IOStatus {
 		return rawCallback(ChannelVar, OffsetVar, TypeVar)
 	}
@@ -719,12 +684,13 @@ func (x *IOFuncs) GetIoSeek() func(*IOChannel, int64, SeekType) IOStatus {
 // OverrideIoClose sets the "io_close" callback function.
 // closes the channel.  This is called from
 //
-//	g_io_channel_close() after flushing the buffers.
+//	[IOChannel.Close] after flushing the buffers.
 func (x *IOFuncs) OverrideIoClose(cb func(*IOChannel) IOStatus) {
 	if cb == nil {
 		x.xIoClose = 0
 	} else {
-		x.xIoClose = purego.NewCallback(func(ChannelVarp *IOChannel) IOStatus {
+		x.xIoClose = core.NewCallback(func(ChannelVarp *IOChannel) IOStatus {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp)
 		})
 	}
@@ -733,13 +699,13 @@ func (x *IOFuncs) OverrideIoClose(cb func(*IOChannel) IOStatus) {
 // GetIoClose gets the "io_close" callback function.
 // closes the channel.  This is called from
 //
-//	g_io_channel_close() after flushing the buffers.
+//	[IOChannel.Close] after flushing the buffers.
 func (x *IOFuncs) GetIoClose() func(*IOChannel) IOStatus {
 	if x.xIoClose == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel) IOStatus
-	purego.RegisterFunc(&rawCallback, x.xIoClose)
+	core.RegisterFunc(&rawCallback, x.xIoClose)
 	return func(ChannelVar *IOChannel) IOStatus {
 		return rawCallback(ChannelVar)
 	}
@@ -748,12 +714,13 @@ func (x *IOFuncs) GetIoClose() func(*IOChannel) IOStatus {
 // OverrideIoCreateWatch sets the "io_create_watch" callback function.
 // creates a watch on the channel.  This call
 //
-//	corresponds directly to g_io_create_watch().
+//	corresponds directly to [IoCreateWatch].
 func (x *IOFuncs) OverrideIoCreateWatch(cb func(*IOChannel, IOCondition) *Source) {
 	if cb == nil {
 		x.xIoCreateWatch = 0
 	} else {
-		x.xIoCreateWatch = purego.NewCallback(func(ChannelVarp *IOChannel, ConditionVarp IOCondition) *Source {
+		x.xIoCreateWatch = core.NewCallback(func(ChannelVarp *IOChannel, ConditionVarp IOCondition) *Source {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp, ConditionVarp)
 		})
 	}
@@ -762,116 +729,119 @@ func (x *IOFuncs) OverrideIoCreateWatch(cb func(*IOChannel, IOCondition) *Source
 // GetIoCreateWatch gets the "io_create_watch" callback function.
 // creates a watch on the channel.  This call
 //
-//	corresponds directly to g_io_create_watch().
+//	corresponds directly to [IoCreateWatch].
 func (x *IOFuncs) GetIoCreateWatch() func(*IOChannel, IOCondition) *Source {
 	if x.xIoCreateWatch == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel, ConditionVarp IOCondition) *Source
-	purego.RegisterFunc(&rawCallback, x.xIoCreateWatch)
+	core.RegisterFunc(&rawCallback, x.xIoCreateWatch)
 	return func(ChannelVar *IOChannel, ConditionVar IOCondition) *Source {
 		return rawCallback(ChannelVar, ConditionVar)
 	}
 }
 
 // OverrideIoFree sets the "io_free" callback function.
-// called from g_io_channel_unref() when the channel needs to
+// called from [IOChannel.Unref] when the channel needs to
 //
 //	be freed.  This function must free the memory associated
-//	with the channel, including freeing the #GIOChannel
+//	with the channel, including freeing the [IOChannel]
 //	structure itself.  The channel buffers have been flushed
-//	and possibly @io_close has been called by the time this
+//	and possibly io_close has been called by the time this
 //	function is called.
 func (x *IOFuncs) OverrideIoFree(cb func(*IOChannel)) {
 	if cb == nil {
 		x.xIoFree = 0
 	} else {
-		x.xIoFree = purego.NewCallback(func(ChannelVarp *IOChannel) {
+		x.xIoFree = core.NewCallback(func(ChannelVarp *IOChannel) {
+			defer core.RecoverPanic()
 			cb(ChannelVarp)
 		})
 	}
 }
 
 // GetIoFree gets the "io_free" callback function.
-// called from g_io_channel_unref() when the channel needs to
+// called from [IOChannel.Unref] when the channel needs to
 //
 //	be freed.  This function must free the memory associated
-//	with the channel, including freeing the #GIOChannel
+//	with the channel, including freeing the [IOChannel]
 //	structure itself.  The channel buffers have been flushed
-//	and possibly @io_close has been called by the time this
+//	and possibly io_close has been called by the time this
 //	function is called.
 func (x *IOFuncs) GetIoFree() func(*IOChannel) {
 	if x.xIoFree == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel)
-	purego.RegisterFunc(&rawCallback, x.xIoFree)
+	core.RegisterFunc(&rawCallback, x.xIoFree)
 	return func(ChannelVar *IOChannel) {
 		rawCallback(ChannelVar)
 	}
 }
 
 // OverrideIoSetFlags sets the "io_set_flags" callback function.
-// sets the #GIOFlags on the channel.  This is called
+// sets the [IOFlags] on the channel.  This is called
 //
-//	from g_io_channel_set_flags() with all flags except
-//	for %G_IO_FLAG_APPEND and %G_IO_FLAG_NONBLOCK masked
+//	from [IOChannel.SetFlags] with all flags except
+//	for [GIoFlagAppendValue] and [GIoFlagNonblockValue] masked
 //	out.
 func (x *IOFuncs) OverrideIoSetFlags(cb func(*IOChannel, IOFlags) IOStatus) {
 	if cb == nil {
 		x.xIoSetFlags = 0
 	} else {
-		x.xIoSetFlags = purego.NewCallback(func(ChannelVarp *IOChannel, FlagsVarp IOFlags) IOStatus {
+		x.xIoSetFlags = core.NewCallback(func(ChannelVarp *IOChannel, FlagsVarp IOFlags) IOStatus {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp, FlagsVarp)
 		})
 	}
 }
 
 // GetIoSetFlags gets the "io_set_flags" callback function.
-// sets the #GIOFlags on the channel.  This is called
+// sets the [IOFlags] on the channel.  This is called
 //
-//	from g_io_channel_set_flags() with all flags except
-//	for %G_IO_FLAG_APPEND and %G_IO_FLAG_NONBLOCK masked
+//	from [IOChannel.SetFlags] with all flags except
+//	for [GIoFlagAppendValue] and [GIoFlagNonblockValue] masked
 //	out.
 func (x *IOFuncs) GetIoSetFlags() func(*IOChannel, IOFlags) IOStatus {
 	if x.xIoSetFlags == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel, FlagsVarp IOFlags) IOStatus
-	purego.RegisterFunc(&rawCallback, x.xIoSetFlags)
+	core.RegisterFunc(&rawCallback, x.xIoSetFlags)
 	return func(ChannelVar *IOChannel, FlagsVar IOFlags) IOStatus {
 		return rawCallback(ChannelVar, FlagsVar)
 	}
 }
 
 // OverrideIoGetFlags sets the "io_get_flags" callback function.
-// gets the #GIOFlags for the channel.  This function
+// gets the [IOFlags] for the channel.  This function
 //
-//	need only return the %G_IO_FLAG_APPEND and
-//	%G_IO_FLAG_NONBLOCK flags; g_io_channel_get_flags()
+//	need only return the [GIoFlagAppendValue] and
+//	[GIoFlagNonblockValue] flags; [IOChannel.GetFlags]
 //	automatically adds the others as appropriate.
 func (x *IOFuncs) OverrideIoGetFlags(cb func(*IOChannel) IOFlags) {
 	if cb == nil {
 		x.xIoGetFlags = 0
 	} else {
-		x.xIoGetFlags = purego.NewCallback(func(ChannelVarp *IOChannel) IOFlags {
+		x.xIoGetFlags = core.NewCallback(func(ChannelVarp *IOChannel) IOFlags {
+			defer core.RecoverPanic()
 			return cb(ChannelVarp)
 		})
 	}
 }
 
 // GetIoGetFlags gets the "io_get_flags" callback function.
-// gets the #GIOFlags for the channel.  This function
+// gets the [IOFlags] for the channel.  This function
 //
-//	need only return the %G_IO_FLAG_APPEND and
-//	%G_IO_FLAG_NONBLOCK flags; g_io_channel_get_flags()
+//	need only return the [GIoFlagAppendValue] and
+//	[GIoFlagNonblockValue] flags; [IOChannel.GetFlags]
 //	automatically adds the others as appropriate.
 func (x *IOFuncs) GetIoGetFlags() func(*IOChannel) IOFlags {
 	if x.xIoGetFlags == 0 {
 		return nil
 	}
 	var rawCallback func(ChannelVarp *IOChannel) IOFlags
-	purego.RegisterFunc(&rawCallback, x.xIoGetFlags)
+	core.RegisterFunc(&rawCallback, x.xIoGetFlags)
 	return func(ChannelVar *IOChannel) IOFlags {
 		return rawCallback(ChannelVar)
 	}
@@ -883,7 +853,7 @@ const (
 
 // A bitwise combination representing a condition to watch for on an
 // event source.
-type IOCondition int
+type IOCondition uint32
 
 var xIOConditionGLibType func() types.GType
 
@@ -908,20 +878,77 @@ const (
 	GIoNvalValue IOCondition = 32
 )
 
-// Specifies properties of a #GIOChannel. Some of the flags can only be
-// read with g_io_channel_get_flags(), but not changed with
-// g_io_channel_set_flags().
-type IOFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (IOCondition) GLibType() types.GType {
+	return xIOConditionGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x IOCondition) Has(flags IOCondition) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x IOCondition) Set(flags IOCondition) IOCondition {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x IOCondition) Clear(flags IOCondition) IOCondition {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x IOCondition) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GIoInValue != 0 && x&GIoInValue == GIoInValue {
+		names = append(names, "G_IO_IN")
+		rest &^= GIoInValue
+	}
+	if rest&GIoOutValue != 0 && x&GIoOutValue == GIoOutValue {
+		names = append(names, "G_IO_OUT")
+		rest &^= GIoOutValue
+	}
+	if rest&GIoPriValue != 0 && x&GIoPriValue == GIoPriValue {
+		names = append(names, "G_IO_PRI")
+		rest &^= GIoPriValue
+	}
+	if rest&GIoErrValue != 0 && x&GIoErrValue == GIoErrValue {
+		names = append(names, "G_IO_ERR")
+		rest &^= GIoErrValue
+	}
+	if rest&GIoHupValue != 0 && x&GIoHupValue == GIoHupValue {
+		names = append(names, "G_IO_HUP")
+		rest &^= GIoHupValue
+	}
+	if rest&GIoNvalValue != 0 && x&GIoNvalValue == GIoNvalValue {
+		names = append(names, "G_IO_NVAL")
+		rest &^= GIoNvalValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Specifies properties of a [IOChannel]. Some of the flags can only be
+// read with [IOChannel.GetFlags], but not changed with
+// [IOChannel.SetFlags].
+type IOFlags uint32
 
 const (
 
 	// no special flags set. Since: 2.74
 	GIoFlagNoneValue IOFlags = 0
-	// turns on append mode, corresponds to %O_APPEND
+	// turns on append mode, corresponds to O_APPEND
 	//     (see the documentation of the UNIX open() syscall)
 	GIoFlagAppendValue IOFlags = 1
 	// turns on nonblocking mode, corresponds to
-	//     %O_NONBLOCK/%O_NDELAY (see the documentation of the UNIX open()
+	//     O_NONBLOCK/O_NDELAY (see the documentation of the UNIX open()
 	//     syscall)
 	GIoFlagNonblockValue IOFlags = 2
 	// indicates that the io channel is readable.
@@ -935,20 +962,84 @@ const (
 	//     here for compatibility reasons. Deprecated since 2.30
 	GIoFlagIsWriteableValue IOFlags = 8
 	// indicates that the io channel is seekable,
-	//     i.e. that g_io_channel_seek_position() can be used on it.
+	//     i.e. that [IOChannel.SeekPosition] can be used on it.
 	//     This flag cannot be changed.
 	GIoFlagIsSeekableValue IOFlags = 16
 	// the mask that specifies all the valid flags.
 	GIoFlagMaskValue IOFlags = 31
 	// the mask of the flags that are returned from
-	//     g_io_channel_get_flags()
+	//     [IOChannel.GetFlags]
 	GIoFlagGetMaskValue IOFlags = 31
 	// the mask of the flags that the user can modify
-	//     with g_io_channel_set_flags()
+	//     with [IOChannel.SetFlags]
 	GIoFlagSetMaskValue IOFlags = 3
 )
 
-// Error codes returned by #GIOChannel operations.
+// Has reports whether all bits of flags are set in x
+func (x IOFlags) Has(flags IOFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x IOFlags) Set(flags IOFlags) IOFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x IOFlags) Clear(flags IOFlags) IOFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x IOFlags) String() string {
+	if x == 0 {
+		return "G_IO_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GIoFlagAppendValue != 0 && x&GIoFlagAppendValue == GIoFlagAppendValue {
+		names = append(names, "G_IO_FLAG_APPEND")
+		rest &^= GIoFlagAppendValue
+	}
+	if rest&GIoFlagNonblockValue != 0 && x&GIoFlagNonblockValue == GIoFlagNonblockValue {
+		names = append(names, "G_IO_FLAG_NONBLOCK")
+		rest &^= GIoFlagNonblockValue
+	}
+	if rest&GIoFlagIsReadableValue != 0 && x&GIoFlagIsReadableValue == GIoFlagIsReadableValue {
+		names = append(names, "G_IO_FLAG_IS_READABLE")
+		rest &^= GIoFlagIsReadableValue
+	}
+	if rest&GIoFlagIsWritableValue != 0 && x&GIoFlagIsWritableValue == GIoFlagIsWritableValue {
+		names = append(names, "G_IO_FLAG_IS_WRITABLE")
+		rest &^= GIoFlagIsWritableValue
+	}
+	if rest&GIoFlagIsWriteableValue != 0 && x&GIoFlagIsWriteableValue == GIoFlagIsWriteableValue {
+		names = append(names, "G_IO_FLAG_IS_WRITEABLE")
+		rest &^= GIoFlagIsWriteableValue
+	}
+	if rest&GIoFlagIsSeekableValue != 0 && x&GIoFlagIsSeekableValue == GIoFlagIsSeekableValue {
+		names = append(names, "G_IO_FLAG_IS_SEEKABLE")
+		rest &^= GIoFlagIsSeekableValue
+	}
+	if rest&GIoFlagMaskValue != 0 && x&GIoFlagMaskValue == GIoFlagMaskValue {
+		names = append(names, "G_IO_FLAG_MASK")
+		rest &^= GIoFlagMaskValue
+	}
+	if rest&GIoFlagGetMaskValue != 0 && x&GIoFlagGetMaskValue == GIoFlagGetMaskValue {
+		names = append(names, "G_IO_FLAG_GET_MASK")
+		rest &^= GIoFlagGetMaskValue
+	}
+	if rest&GIoFlagSetMaskValue != 0 && x&GIoFlagSetMaskValue == GIoFlagSetMaskValue {
+		names = append(names, "G_IO_FLAG_SET_MASK")
+		rest &^= GIoFlagSetMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Error codes returned by [IOChannel] operations.
 type IOChannelError int
 
 const (
@@ -973,8 +1064,113 @@ const (
 	GIoChannelErrorFailedValue IOChannelError = 8
 )
 
-// #GIOError is only used by the deprecated functions
-// g_io_channel_read(), g_io_channel_write(), and g_io_channel_seek().
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (IOChannelError) ErrorDomain() string {
+	return "g-io-channel-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x IOChannelError) Error() string {
+	switch x {
+	case GIoChannelErrorFbigValue:
+		return "G_IO_CHANNEL_ERROR_FBIG"
+	case GIoChannelErrorInvalValue:
+		return "G_IO_CHANNEL_ERROR_INVAL"
+	case GIoChannelErrorIoValue:
+		return "G_IO_CHANNEL_ERROR_IO"
+	case GIoChannelErrorIsdirValue:
+		return "G_IO_CHANNEL_ERROR_ISDIR"
+	case GIoChannelErrorNospcValue:
+		return "G_IO_CHANNEL_ERROR_NOSPC"
+	case GIoChannelErrorNxioValue:
+		return "G_IO_CHANNEL_ERROR_NXIO"
+	case GIoChannelErrorOverflowValue:
+		return "G_IO_CHANNEL_ERROR_OVERFLOW"
+	case GIoChannelErrorPipeValue:
+		return "G_IO_CHANNEL_ERROR_PIPE"
+	case GIoChannelErrorFailedValue:
+		return "G_IO_CHANNEL_ERROR_FAILED"
+	}
+	return fmt.Sprintf("g-io-channel-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x IOChannelError) String() string {
+	switch x {
+	case GIoChannelErrorFbigValue:
+		return "G_IO_CHANNEL_ERROR_FBIG"
+	case GIoChannelErrorInvalValue:
+		return "G_IO_CHANNEL_ERROR_INVAL"
+	case GIoChannelErrorIoValue:
+		return "G_IO_CHANNEL_ERROR_IO"
+	case GIoChannelErrorIsdirValue:
+		return "G_IO_CHANNEL_ERROR_ISDIR"
+	case GIoChannelErrorNospcValue:
+		return "G_IO_CHANNEL_ERROR_NOSPC"
+	case GIoChannelErrorNxioValue:
+		return "G_IO_CHANNEL_ERROR_NXIO"
+	case GIoChannelErrorOverflowValue:
+		return "G_IO_CHANNEL_ERROR_OVERFLOW"
+	case GIoChannelErrorPipeValue:
+		return "G_IO_CHANNEL_ERROR_PIPE"
+	case GIoChannelErrorFailedValue:
+		return "G_IO_CHANNEL_ERROR_FAILED"
+	}
+	return fmt.Sprintf("IOChannelError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IOChannelError) Nick() string {
+	switch x {
+	case GIoChannelErrorFbigValue:
+		return "fbig"
+	case GIoChannelErrorInvalValue:
+		return "inval"
+	case GIoChannelErrorIoValue:
+		return "io"
+	case GIoChannelErrorIsdirValue:
+		return "isdir"
+	case GIoChannelErrorNospcValue:
+		return "nospc"
+	case GIoChannelErrorNxioValue:
+		return "nxio"
+	case GIoChannelErrorOverflowValue:
+		return "overflow"
+	case GIoChannelErrorPipeValue:
+		return "pipe"
+	case GIoChannelErrorFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// IOChannelErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IOChannelErrorFromString(s string) (IOChannelError, bool) {
+	switch s {
+	case "G_IO_CHANNEL_ERROR_FBIG", "fbig":
+		return GIoChannelErrorFbigValue, true
+	case "G_IO_CHANNEL_ERROR_INVAL", "inval":
+		return GIoChannelErrorInvalValue, true
+	case "G_IO_CHANNEL_ERROR_IO", "io":
+		return GIoChannelErrorIoValue, true
+	case "G_IO_CHANNEL_ERROR_ISDIR", "isdir":
+		return GIoChannelErrorIsdirValue, true
+	case "G_IO_CHANNEL_ERROR_NOSPC", "nospc":
+		return GIoChannelErrorNospcValue, true
+	case "G_IO_CHANNEL_ERROR_NXIO", "nxio":
+		return GIoChannelErrorNxioValue, true
+	case "G_IO_CHANNEL_ERROR_OVERFLOW", "overflow":
+		return GIoChannelErrorOverflowValue, true
+	case "G_IO_CHANNEL_ERROR_PIPE", "pipe":
+		return GIoChannelErrorPipeValue, true
+	case "G_IO_CHANNEL_ERROR_FAILED", "failed":
+		return GIoChannelErrorFailedValue, true
+	}
+	return 0, false
+}
+
+// [IOError] is only used by the deprecated functions
+// [IOChannel.Read], [IOChannel.Write], and [IOChannel.Seek].
 type IOError int
 
 const (
@@ -989,7 +1185,52 @@ const (
 	GIoErrorUnknownValue IOError = 3
 )
 
-// Statuses returned by most of the #GIOFuncs functions.
+// String returns the C name of the value, e.g. for debugging output
+func (x IOError) String() string {
+	switch x {
+	case GIoErrorNoneValue:
+		return "G_IO_ERROR_NONE"
+	case GIoErrorAgainValue:
+		return "G_IO_ERROR_AGAIN"
+	case GIoErrorInvalValue:
+		return "G_IO_ERROR_INVAL"
+	case GIoErrorUnknownValue:
+		return "G_IO_ERROR_UNKNOWN"
+	}
+	return fmt.Sprintf("IOError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IOError) Nick() string {
+	switch x {
+	case GIoErrorNoneValue:
+		return "none"
+	case GIoErrorAgainValue:
+		return "again"
+	case GIoErrorInvalValue:
+		return "inval"
+	case GIoErrorUnknownValue:
+		return "unknown"
+	}
+	return ""
+}
+
+// IOErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IOErrorFromString(s string) (IOError, bool) {
+	switch s {
+	case "G_IO_ERROR_NONE", "none":
+		return GIoErrorNoneValue, true
+	case "G_IO_ERROR_AGAIN", "again":
+		return GIoErrorAgainValue, true
+	case "G_IO_ERROR_INVAL", "inval":
+		return GIoErrorInvalValue, true
+	case "G_IO_ERROR_UNKNOWN", "unknown":
+		return GIoErrorUnknownValue, true
+	}
+	return 0, false
+}
+
+// Statuses returned by most of the [IOFuncs] functions.
 type IOStatus int
 
 const (
@@ -1004,8 +1245,53 @@ const (
 	GIoStatusAgainValue IOStatus = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x IOStatus) String() string {
+	switch x {
+	case GIoStatusErrorValue:
+		return "G_IO_STATUS_ERROR"
+	case GIoStatusNormalValue:
+		return "G_IO_STATUS_NORMAL"
+	case GIoStatusEofValue:
+		return "G_IO_STATUS_EOF"
+	case GIoStatusAgainValue:
+		return "G_IO_STATUS_AGAIN"
+	}
+	return fmt.Sprintf("IOStatus(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IOStatus) Nick() string {
+	switch x {
+	case GIoStatusErrorValue:
+		return "error"
+	case GIoStatusNormalValue:
+		return "normal"
+	case GIoStatusEofValue:
+		return "eof"
+	case GIoStatusAgainValue:
+		return "again"
+	}
+	return ""
+}
+
+// IOStatusFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IOStatusFromString(s string) (IOStatus, bool) {
+	switch s {
+	case "G_IO_STATUS_ERROR", "error":
+		return GIoStatusErrorValue, true
+	case "G_IO_STATUS_NORMAL", "normal":
+		return GIoStatusNormalValue, true
+	case "G_IO_STATUS_EOF", "eof":
+		return GIoStatusEofValue, true
+	case "G_IO_STATUS_AGAIN", "again":
+		return GIoStatusAgainValue, true
+	}
+	return 0, false
+}
+
 // An enumeration specifying the base position for a
-// g_io_channel_seek_position() operation.
+// [IOChannel.SeekPosition] operation.
 type SeekType int
 
 const (
@@ -1018,10 +1304,51 @@ const (
 	GSeekEndValue SeekType = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SeekType) String() string {
+	switch x {
+	case GSeekCurValue:
+		return "G_SEEK_CUR"
+	case GSeekSetValue:
+		return "G_SEEK_SET"
+	case GSeekEndValue:
+		return "G_SEEK_END"
+	}
+	return fmt.Sprintf("SeekType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SeekType) Nick() string {
+	switch x {
+	case GSeekCurValue:
+		return "cur"
+	case GSeekSetValue:
+		return "set"
+	case GSeekEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// SeekTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SeekTypeFromString(s string) (SeekType, bool) {
+	switch s {
+	case "G_SEEK_CUR", "cur":
+		return GSeekCurValue, true
+	case "G_SEEK_SET", "set":
+		return GSeekSetValue, true
+	case "G_SEEK_END", "end":
+		return GSeekEndValue, true
+	}
+	return 0, false
+}
+
 var xIoAddWatch func(*IOChannel, IOCondition, uintptr, uintptr) uint
 
-// Adds the #GIOChannel into the default main loop context
+// Adds the [IOChannel] into the default main loop context
 // with the default priority.
+//
+// Deprecated: use [IoAddWatchFull], the version of this function that language bindings are meant to use.
 func IoAddWatch(ChannelVar *IOChannel, ConditionVar IOCondition, FuncVar *IOFunc, UserDataVar uintptr) uint {
 
 	var FuncVarRef uintptr
@@ -1031,11 +1358,12 @@ func IoAddWatch(ChannelVar *IOChannel, ConditionVar IOCondition, FuncVar *IOFunc
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *IOChannel, arg1 IOCondition, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -1046,11 +1374,11 @@ func IoAddWatch(ChannelVar *IOChannel, ConditionVar IOCondition, FuncVar *IOFunc
 
 var xIoAddWatchFull func(*IOChannel, int, IOCondition, uintptr, uintptr, uintptr) uint
 
-// Adds the #GIOChannel into the default main loop context
+// Adds the [IOChannel] into the default main loop context
 // with the given priority.
 //
-// This internally creates a main loop source using g_io_create_watch()
-// and attaches it to the main loop context with g_source_attach().
+// This internally creates a main loop source using [IoCreateWatch]
+// and attaches it to the main loop context with [Source.Attach].
 // You can do these steps manually if you need greater control.
 func IoAddWatchFull(ChannelVar *IOChannel, PriorityVar int, ConditionVar IOCondition, FuncVar *IOFunc, UserDataVar uintptr, NotifyVar *DestroyNotify) uint {
 
@@ -1061,11 +1389,12 @@ func IoAddWatchFull(ChannelVar *IOChannel, PriorityVar int, ConditionVar IOCondi
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *IOChannel, arg1 IOCondition, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -1076,11 +1405,12 @@ func IoAddWatchFull(ChannelVar *IOChannel, PriorityVar int, ConditionVar IOCondi
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -1089,30 +1419,37 @@ func IoAddWatchFull(ChannelVar *IOChannel, PriorityVar int, ConditionVar IOCondi
 	return cret
 }
 
-var xIoChannelErrorFromErrno func(int) IOChannelError
+var xIOChannelErrorFromErrno func(int) IOChannelError
 
-// Converts an `errno` error number to a #GIOChannelError.
-func IoChannelErrorFromErrno(EnVar int) IOChannelError {
+// Converts an `errno` error number to a [IOChannelError].
+func IOChannelErrorFromErrno(EnVar int) IOChannelError {
 
-	cret := xIoChannelErrorFromErrno(EnVar)
+	cret := xIOChannelErrorFromErrno(EnVar)
 
 	return cret
 }
 
+// Converts an `errno` error number to a [IOChannelError].
+//
+// Deprecated: use IOChannelErrorFromErrno, this name of g_io_channel_error_from_errno is kept for compatibility.
+func IoChannelErrorFromErrno(EnVar int) IOChannelError {
+	return IOChannelErrorFromErrno(EnVar)
+}
+
 var xIoCreateWatch func(*IOChannel, IOCondition) *Source
 
-// Creates a #GSource that's dispatched when @condition is met for the
-// given @channel. For example, if condition is %G_IO_IN, the source will
+// Creates a [Source] that's dispatched when condition is met for the
+// given channel. For example, if condition is [GIoInValue], the source will
 // be dispatched when there's data available for reading.
 //
-// The callback function invoked by the #GSource should be added with
-// g_source_set_callback(), but it has type #GIOFunc (not #GSourceFunc).
+// The callback function invoked by the [Source] should be added with
+// [Source.SetCallback], but it has type [IOFunc] (not [SourceFunc]).
 //
-// g_io_add_watch() is a simpler interface to this same functionality, for
+// [IoAddWatch] is a simpler interface to this same functionality, for
 // the case where you want to add the source to the default main loop context
 // at the default priority.
 //
-// On Windows, polling a #GSource created to watch a channel for a socket
+// On Windows, polling a [Source] created to watch a channel for a socket
 // puts the socket in non-blocking mode. This is a side-effect of the
 // implementation and unavoidable.
 func IoCreateWatch(ChannelVar *IOChannel, ConditionVar IOCondition) *Source {
@@ -1127,7 +1464,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1136,46 +1473,42 @@ func init() {
 
 	core.PuregoSafeRegister(&xIOConditionGLibType, libs, "g_io_condition_get_type")
 
-	core.PuregoSafeRegister(&xIoAddWatch, libs, "g_io_add_watch")
-	core.PuregoSafeRegister(&xIoAddWatchFull, libs, "g_io_add_watch_full")
-	core.PuregoSafeRegister(&xIoChannelErrorFromErrno, libs, "g_io_channel_error_from_errno")
-	core.PuregoSafeRegister(&xIoCreateWatch, libs, "g_io_create_watch")
+	core.PuregoRegisterSince(&xIoAddWatch, libs, "g_io_add_watch", "GLIB", "")
+	core.PuregoRegisterSince(&xIoAddWatchFull, libs, "g_io_add_watch_full", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelErrorFromErrno, libs, "g_io_channel_error_from_errno", "GLIB", "")
+	core.PuregoRegisterSince(&xIoCreateWatch, libs, "g_io_create_watch", "GLIB", "")
 
 	core.PuregoSafeRegister(&xIOChannelGLibType, libs, "g_io_channel_get_type")
 
-	core.PuregoSafeRegister(&xNewIOChannelFile, libs, "g_io_channel_new_file")
-	core.PuregoSafeRegister(&xIOChannelUnixNew, libs, "g_io_channel_unix_new")
-
-	core.PuregoSafeRegister(&xIOChannelClose, libs, "g_io_channel_close")
-	core.PuregoSafeRegister(&xIOChannelFlush, libs, "g_io_channel_flush")
-	core.PuregoSafeRegister(&xIOChannelGetBufferCondition, libs, "g_io_channel_get_buffer_condition")
-	core.PuregoSafeRegister(&xIOChannelGetBufferSize, libs, "g_io_channel_get_buffer_size")
-	core.PuregoSafeRegister(&xIOChannelGetBuffered, libs, "g_io_channel_get_buffered")
-	core.PuregoSafeRegister(&xIOChannelGetCloseOnUnref, libs, "g_io_channel_get_close_on_unref")
-	core.PuregoSafeRegister(&xIOChannelGetEncoding, libs, "g_io_channel_get_encoding")
-	core.PuregoSafeRegister(&xIOChannelGetFlags, libs, "g_io_channel_get_flags")
-	core.PuregoSafeRegister(&xIOChannelGetLineTerm, libs, "g_io_channel_get_line_term")
-	core.PuregoSafeRegister(&xIOChannelInit, libs, "g_io_channel_init")
-	core.PuregoSafeRegister(&xIOChannelRead, libs, "g_io_channel_read")
-	core.PuregoSafeRegister(&xIOChannelReadChars, libs, "g_io_channel_read_chars")
-	core.PuregoSafeRegister(&xIOChannelReadLine, libs, "g_io_channel_read_line")
-	core.PuregoSafeRegister(&xIOChannelReadLineString, libs, "g_io_channel_read_line_string")
-	core.PuregoSafeRegister(&xIOChannelReadToEnd, libs, "g_io_channel_read_to_end")
-	core.PuregoSafeRegister(&xIOChannelReadUnichar, libs, "g_io_channel_read_unichar")
-	core.PuregoSafeRegister(&xIOChannelRef, libs, "g_io_channel_ref")
-	core.PuregoSafeRegister(&xIOChannelSeek, libs, "g_io_channel_seek")
-	core.PuregoSafeRegister(&xIOChannelSeekPosition, libs, "g_io_channel_seek_position")
-	core.PuregoSafeRegister(&xIOChannelSetBufferSize, libs, "g_io_channel_set_buffer_size")
-	core.PuregoSafeRegister(&xIOChannelSetBuffered, libs, "g_io_channel_set_buffered")
-	core.PuregoSafeRegister(&xIOChannelSetCloseOnUnref, libs, "g_io_channel_set_close_on_unref")
-	core.PuregoSafeRegister(&xIOChannelSetEncoding, libs, "g_io_channel_set_encoding")
-	core.PuregoSafeRegister(&xIOChannelSetFlags, libs, "g_io_channel_set_flags")
-	core.PuregoSafeRegister(&xIOChannelSetLineTerm, libs, "g_io_channel_set_line_term")
-	core.PuregoSafeRegister(&xIOChannelShutdown, libs, "g_io_channel_shutdown")
-	core.PuregoSafeRegister(&xIOChannelUnixGetFd, libs, "g_io_channel_unix_get_fd")
-	core.PuregoSafeRegister(&xIOChannelUnref, libs, "g_io_channel_unref")
-	core.PuregoSafeRegister(&xIOChannelWrite, libs, "g_io_channel_write")
-	core.PuregoSafeRegister(&xIOChannelWriteChars, libs, "g_io_channel_write_chars")
-	core.PuregoSafeRegister(&xIOChannelWriteUnichar, libs, "g_io_channel_write_unichar")
+	core.PuregoRegisterSince(&xNewIOChannelFile, libs, "g_io_channel_new_file", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelUnixNew, libs, "g_io_channel_unix_new", "GLIB", "")
+
+	core.PuregoRegisterSince(&xIOChannelFlush, libs, "g_io_channel_flush", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetBufferCondition, libs, "g_io_channel_get_buffer_condition", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetBufferSize, libs, "g_io_channel_get_buffer_size", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetBuffered, libs, "g_io_channel_get_buffered", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetCloseOnUnref, libs, "g_io_channel_get_close_on_unref", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetEncoding, libs, "g_io_channel_get_encoding", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetFlags, libs, "g_io_channel_get_flags", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelGetLineTerm, libs, "g_io_channel_get_line_term", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelInit, libs, "g_io_channel_init", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelReadChars, libs, "g_io_channel_read_chars", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelReadLine, libs, "g_io_channel_read_line", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelReadLineString, libs, "g_io_channel_read_line_string", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelReadToEnd, libs, "g_io_channel_read_to_end", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelReadUnichar, libs, "g_io_channel_read_unichar", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelRef, libs, "g_io_channel_ref", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSeekPosition, libs, "g_io_channel_seek_position", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetBufferSize, libs, "g_io_channel_set_buffer_size", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetBuffered, libs, "g_io_channel_set_buffered", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetCloseOnUnref, libs, "g_io_channel_set_close_on_unref", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetEncoding, libs, "g_io_channel_set_encoding", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetFlags, libs, "g_io_channel_set_flags", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSetLineTerm, libs, "g_io_channel_set_line_term", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelShutdown, libs, "g_io_channel_shutdown", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelUnixGetFd, libs, "g_io_channel_unix_get_fd", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelUnref, libs, "g_io_channel_unref", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelWriteChars, libs, "g_io_channel_write_chars", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelWriteUnichar, libs, "g_io_channel_write_unichar", "GLIB", "")
 
 }
@@ -0,0 +1,74 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xIOChannelClose func(uintptr)
+
+// Close an IO channel. Any pending data to be written will be
+// flushed, ignoring errors. The channel will not be freed until the
+// last reference is dropped using [IOChannel.Unref].
+//
+// Deprecated: since 2.2. Use [IOChannel.Shutdown] instead.
+func (x *IOChannel) Close() {
+
+	xIOChannelClose(x.GoPointer())
+
+}
+
+var xIOChannelRead func(uintptr, string, uint, uint) IOError
+
+// Reads data from a [IOChannel].
+//
+// Deprecated: since 2.2. Use [IOChannel.ReadChars] instead.
+func (x *IOChannel) Read(BufVar string, CountVar uint, BytesReadVar uint) IOError {
+
+	cret := xIOChannelRead(x.GoPointer(), BufVar, CountVar, BytesReadVar)
+	return cret
+}
+
+var xIOChannelSeek func(uintptr, int64, SeekType) IOError
+
+// Sets the current position in the [IOChannel], similar to the standard
+// library function fseek().
+//
+// Deprecated: since 2.2. Use [IOChannel.SeekPosition] instead.
+func (x *IOChannel) Seek(OffsetVar int64, TypeVar SeekType) IOError {
+
+	cret := xIOChannelSeek(x.GoPointer(), OffsetVar, TypeVar)
+	return cret
+}
+
+var xIOChannelWrite func(uintptr, string, uint, uint) IOError
+
+// Writes data to a [IOChannel].
+//
+// Deprecated: since 2.2. Use [IOChannel.WriteChars] instead.
+func (x *IOChannel) Write(BufVar string, CountVar uint, BytesWrittenVar uint) IOError {
+
+	cret := xIOChannelWrite(x.GoPointer(), BufVar, CountVar, BytesWrittenVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIOChannelClose, libs, "g_io_channel_close", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelRead, libs, "g_io_channel_read", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelSeek, libs, "g_io_channel_seek", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelWrite, libs, "g_io_channel_write", "GLIB", "")
+
+}
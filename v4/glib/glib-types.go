@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -30,14 +29,14 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xStrvGetType, libs, "g_strv_get_type")
-	core.PuregoSafeRegister(&xVariantGetGtype, libs, "g_variant_get_gtype")
+	core.PuregoRegisterSince(&xStrvGetType, libs, "g_strv_get_type", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantGetGtype, libs, "g_variant_get_gtype", "GLIB", "")
 
 }
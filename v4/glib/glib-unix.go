@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -37,11 +37,44 @@ const (
 	GUnixPipeEndWriteValue UnixPipeEnd = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x UnixPipeEnd) String() string {
+	switch x {
+	case GUnixPipeEndReadValue:
+		return "G_UNIX_PIPE_END_READ"
+	case GUnixPipeEndWriteValue:
+		return "G_UNIX_PIPE_END_WRITE"
+	}
+	return fmt.Sprintf("UnixPipeEnd(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UnixPipeEnd) Nick() string {
+	switch x {
+	case GUnixPipeEndReadValue:
+		return "read"
+	case GUnixPipeEndWriteValue:
+		return "write"
+	}
+	return ""
+}
+
+// UnixPipeEndFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnixPipeEndFromString(s string) (UnixPipeEnd, bool) {
+	switch s {
+	case "G_UNIX_PIPE_END_READ", "read":
+		return GUnixPipeEndReadValue, true
+	case "G_UNIX_PIPE_END_WRITE", "write":
+		return GUnixPipeEndWriteValue, true
+	}
+	return 0, false
+}
+
 var xClosefrom func(int) int
 
-// Close every file descriptor equal to or greater than @lowfd.
+// Close every file descriptor equal to or greater than lowfd.
 //
-// Typically @lowfd will be 3, to leave standard input, standard output
+// Typically lowfd will be 3, to leave standard input, standard output
 // and standard error open.
 //
 // This is the same as Linux `close_range (lowfd, ~0U, 0)`,
@@ -50,10 +83,10 @@ var xClosefrom func(int) int
 // and async-signal-safe on all OSs.
 //
 // This function is async-signal safe, making it safe to call from a
-// signal handler or a [callback@GLib.SpawnChildSetupFunc], as long as @lowfd is
+// signal handler or a [SpawnChildSetupFunc], as long as lowfd is
 // non-negative.
-// See [`signal(7)`](man:signal(7)) and
-// [`signal-safety(7)`](man:signal-safety(7)) for more details.
+// See signal(7)) and
+// signal-safety(7)) for more details.
 func Closefrom(LowfdVar int) int {
 
 	cret := xClosefrom(LowfdVar)
@@ -63,20 +96,20 @@ func Closefrom(LowfdVar int) int {
 
 var xFdwalkSetCloexec func(int) int
 
-// Mark every file descriptor equal to or greater than @lowfd to be closed
+// Mark every file descriptor equal to or greater than lowfd to be closed
 // at the next `execve()` or similar, as if via the `FD_CLOEXEC` flag.
 //
-// Typically @lowfd will be 3, to leave standard input, standard output
+// Typically lowfd will be 3, to leave standard input, standard output
 // and standard error open after exec.
 //
 // This is the same as Linux `close_range (lowfd, ~0U, CLOSE_RANGE_CLOEXEC)`,
 // but portable to other OSs and to older versions of Linux.
 //
 // This function is async-signal safe, making it safe to call from a
-// signal handler or a [callback@GLib.SpawnChildSetupFunc], as long as @lowfd is
+// signal handler or a [SpawnChildSetupFunc], as long as lowfd is
 // non-negative.
-// See [`signal(7)`](man:signal(7)) and
-// [`signal-safety(7)`](man:signal-safety(7)) for more details.
+// See signal(7)) and
+// signal-safety(7)) for more details.
 func FdwalkSetCloexec(LowfdVar int) int {
 
 	cret := xFdwalkSetCloexec(LowfdVar)
@@ -87,15 +120,15 @@ func FdwalkSetCloexec(LowfdVar int) int {
 var xUnixFdAdd func(int, IOCondition, uintptr, uintptr) uint
 
 // Sets a function to be called when the IO condition, as specified by
-// @condition becomes true for @fd.
+// condition becomes true for fd.
 //
-// @function will be called when the specified IO condition becomes
-// %TRUE.  The function is expected to clear whatever event caused the
-// IO condition to become true and return %TRUE in order to be notified
-// when it happens again.  If @function returns %FALSE then the watch
+// function will be called when the specified IO condition becomes
+// true.  The function is expected to clear whatever event caused the
+// IO condition to become true and return true in order to be notified
+// when it happens again.  If function returns false then the watch
 // will be cancelled.
 //
-// The return value of this function can be passed to g_source_remove()
+// The return value of this function can be passed to [SourceRemove]
 // to cancel the watch at any time that it exists.
 //
 // The source will never close the fd -- you must do it yourself.
@@ -108,11 +141,12 @@ func UnixFdAdd(FdVar int, ConditionVar IOCondition, FunctionVar *UnixFDSourceFun
 			FunctionVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 int, arg1 IOCondition, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FunctionVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FunctionVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FunctionVarPtr, FunctionVarRef, FunctionVar)
+			FunctionVarRef = core.NewCallback(fcb)
+			FunctionVarRef = SaveCallbackWithClosure(FunctionVarPtr, FunctionVarRef, FunctionVar)
 		}
 	}
 
@@ -124,11 +158,11 @@ func UnixFdAdd(FdVar int, ConditionVar IOCondition, FunctionVar *UnixFDSourceFun
 var xUnixFdAddFull func(int, int, IOCondition, uintptr, uintptr, uintptr) uint
 
 // Sets a function to be called when the IO condition, as specified by
-// @condition becomes true for @fd.
+// condition becomes true for fd.
 //
-// This is the same as g_unix_fd_add(), except that it allows you to
-// specify a non-default priority and a provide a #GDestroyNotify for
-// @user_data.
+// This is the same as [UnixFdAdd], except that it allows you to
+// specify a non-default priority and a provide a [DestroyNotify] for
+// user_data.
 func UnixFdAddFull(PriorityVar int, FdVar int, ConditionVar IOCondition, FunctionVar *UnixFDSourceFunc, UserDataVar uintptr, NotifyVar *DestroyNotify) uint {
 
 	var FunctionVarRef uintptr
@@ -138,11 +172,12 @@ func UnixFdAddFull(PriorityVar int, FdVar int, ConditionVar IOCondition, Functio
 			FunctionVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 int, arg1 IOCondition, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FunctionVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FunctionVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FunctionVarPtr, FunctionVarRef, FunctionVar)
+			FunctionVarRef = core.NewCallback(fcb)
+			FunctionVarRef = SaveCallbackWithClosure(FunctionVarPtr, FunctionVarRef, FunctionVar)
 		}
 	}
 
@@ -153,11 +188,12 @@ func UnixFdAddFull(PriorityVar int, FdVar int, ConditionVar IOCondition, Functio
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -168,13 +204,13 @@ func UnixFdAddFull(PriorityVar int, FdVar int, ConditionVar IOCondition, Functio
 
 var xUnixFdSourceNew func(int, IOCondition) *Source
 
-// Creates a #GSource to watch for a particular I/O condition on a file
+// Creates a [Source] to watch for a particular I/O condition on a file
 // descriptor.
 //
-// The source will never close the @fd — you must do it yourself.
+// The source will never close the fd — you must do it yourself.
 //
-// Any callback attached to the returned #GSource must have type
-// #GUnixFDSourceFunc.
+// Any callback attached to the returned [Source] must have type
+// [UnixFDSourceFunc].
 func UnixFdSourceNew(FdVar int, ConditionVar IOCondition) *Source {
 
 	cret := xUnixFdSourceNew(FdVar, ConditionVar)
@@ -184,11 +220,11 @@ func UnixFdSourceNew(FdVar int, ConditionVar IOCondition) *Source {
 
 var xUnixGetPasswdEntry func(string, **Error) uintptr
 
-// Get the `passwd` file entry for the given @user_name using `getpwnam_r()`.
-// This can fail if the given @user_name doesn’t exist.
+// Get the `passwd` file entry for the given user_name using `getpwnam_r()`.
+// This can fail if the given user_name doesn’t exist.
 //
-// The returned `struct passwd` has been allocated using g_malloc() and should
-// be freed using g_free(). The strings referenced by the returned struct are
+// The returned `struct passwd` has been allocated using [Malloc] and should
+// be freed using [Free]. The strings referenced by the returned struct are
 // included in the same allocation, so are valid until the `struct passwd` is
 // freed.
 //
@@ -201,7 +237,7 @@ func UnixGetPasswdEntry(UserNameVar string) (uintptr, error) {
 	cret := xUnixGetPasswdEntry(UserNameVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -218,8 +254,8 @@ var xUnixOpenPipe func([2]int, int, **Error) bool
 // you wanted to configure `O_NONBLOCK` then that had to be done separately with
 // `fcntl()`.
 //
-// Since GLib 2.80, the constants %G_UNIX_PIPE_END_READ and
-// %G_UNIX_PIPE_END_WRITE can be used as mnemonic indexes in @fds.
+// Since GLib 2.80, the constants [GUnixPipeEndReadValue] and
+// [GUnixPipeEndWriteValue] can be used as mnemonic indexes in fds.
 //
 // It is a programmer error to call this function with unsupported flags, and a
 // critical warning will be raised.
@@ -234,7 +270,7 @@ func UnixOpenPipe(FdsVar [2]int, FlagsVar int) (bool, error) {
 	cret := xUnixOpenPipe(FdsVar, FlagsVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -243,15 +279,15 @@ func UnixOpenPipe(FdsVar [2]int, FlagsVar int) (bool, error) {
 var xUnixSetFdNonblocking func(int, bool, **Error) bool
 
 // Control the non-blocking state of the given file descriptor,
-// according to @nonblock. On most systems this uses %O_NONBLOCK, but
-// on some older ones may use %O_NDELAY.
+// according to nonblock. On most systems this uses O_NONBLOCK, but
+// on some older ones may use O_NDELAY.
 func UnixSetFdNonblocking(FdVar int, NonblockVar bool) (bool, error) {
 	var cerr *Error
 
 	cret := xUnixSetFdNonblocking(FdVar, NonblockVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -259,9 +295,11 @@ func UnixSetFdNonblocking(FdVar int, NonblockVar bool) (bool, error) {
 
 var xUnixSignalAdd func(int, uintptr, uintptr) uint
 
-// A convenience function for g_unix_signal_source_new(), which
-// attaches to the default #GMainContext.  You can remove the watch
-// using g_source_remove().
+// A convenience function for [UnixSignalSourceNew], which
+// attaches to the default [MainContext].  You can remove the watch
+// using [SourceRemove].
+//
+// Deprecated: use [UnixSignalAddFull], the version of this function that language bindings are meant to use.
 func UnixSignalAdd(SignumVar int, HandlerVar *SourceFunc, UserDataVar uintptr) uint {
 
 	var HandlerVarRef uintptr
@@ -271,11 +309,12 @@ func UnixSignalAdd(SignumVar int, HandlerVar *SourceFunc, UserDataVar uintptr) u
 			HandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *HandlerVar
 				return cbFn(arg0)
 			}
-			HandlerVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(HandlerVarPtr, HandlerVarRef, HandlerVar)
+			HandlerVarRef = core.NewCallback(fcb)
+			HandlerVarRef = SaveCallbackWithClosure(HandlerVarPtr, HandlerVarRef, HandlerVar)
 		}
 	}
 
@@ -286,9 +325,9 @@ func UnixSignalAdd(SignumVar int, HandlerVar *SourceFunc, UserDataVar uintptr) u
 
 var xUnixSignalAddFull func(int, int, uintptr, uintptr, uintptr) uint
 
-// A convenience function for g_unix_signal_source_new(), which
-// attaches to the default #GMainContext.  You can remove the watch
-// using g_source_remove().
+// A convenience function for [UnixSignalSourceNew], which
+// attaches to the default [MainContext].  You can remove the watch
+// using [SourceRemove].
 func UnixSignalAddFull(PriorityVar int, SignumVar int, HandlerVar *SourceFunc, UserDataVar uintptr, NotifyVar *DestroyNotify) uint {
 
 	var HandlerVarRef uintptr
@@ -298,11 +337,12 @@ func UnixSignalAddFull(PriorityVar int, SignumVar int, HandlerVar *SourceFunc, U
 			HandlerVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *HandlerVar
 				return cbFn(arg0)
 			}
-			HandlerVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(HandlerVarPtr, HandlerVarRef, HandlerVar)
+			HandlerVarRef = core.NewCallback(fcb)
+			HandlerVarRef = SaveCallbackWithClosure(HandlerVarPtr, HandlerVarRef, HandlerVar)
 		}
 	}
 
@@ -313,11 +353,12 @@ func UnixSignalAddFull(PriorityVar int, SignumVar int, HandlerVar *SourceFunc, U
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -328,18 +369,18 @@ func UnixSignalAddFull(PriorityVar int, SignumVar int, HandlerVar *SourceFunc, U
 
 var xUnixSignalSourceNew func(int) *Source
 
-// Create a #GSource that will be dispatched upon delivery of the UNIX
-// signal @signum.  In GLib versions before 2.36, only `SIGHUP`, `SIGINT`,
+// Create a [Source] that will be dispatched upon delivery of the UNIX
+// signal signum.  In GLib versions before 2.36, only `SIGHUP`, `SIGINT`,
 // `SIGTERM` can be monitored.  In GLib 2.36, `SIGUSR1` and `SIGUSR2`
 // were added. In GLib 2.54, `SIGWINCH` was added.
 //
 // Note that unlike the UNIX default, all sources which have created a
 // watch will be dispatched, regardless of which underlying thread
-// invoked g_unix_signal_source_new().
+// invoked [UnixSignalSourceNew].
 //
 // For example, an effective use of this function is to handle `SIGTERM`
 // cleanly; flushing any outstanding files, and then calling
-// g_main_loop_quit().  It is not safe to do any of this from a regular
+// [MainLoop.Quit].  It is not safe to do any of this from a regular
 // UNIX signal handler; such a handler may be invoked while malloc() or
 // another library function is running, causing reentrancy issues if the
 // handler attempts to use those functions.  None of the GLib/GObject
@@ -348,8 +389,8 @@ var xUnixSignalSourceNew func(int) *Source
 // The interaction of this source when combined with native UNIX
 // functions like sigprocmask() is not defined.
 //
-// The source will not initially be associated with any #GMainContext
-// and must be added to one with g_source_attach() before it will be
+// The source will not initially be associated with any [MainContext]
+// and must be added to one with [Source.Attach] before it will be
 // executed.
 func UnixSignalSourceNew(SignumVar int) *Source {
 
@@ -363,23 +404,23 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xClosefrom, libs, "g_closefrom")
-	core.PuregoSafeRegister(&xFdwalkSetCloexec, libs, "g_fdwalk_set_cloexec")
-	core.PuregoSafeRegister(&xUnixFdAdd, libs, "g_unix_fd_add")
-	core.PuregoSafeRegister(&xUnixFdAddFull, libs, "g_unix_fd_add_full")
-	core.PuregoSafeRegister(&xUnixFdSourceNew, libs, "g_unix_fd_source_new")
-	core.PuregoSafeRegister(&xUnixGetPasswdEntry, libs, "g_unix_get_passwd_entry")
-	core.PuregoSafeRegister(&xUnixOpenPipe, libs, "g_unix_open_pipe")
-	core.PuregoSafeRegister(&xUnixSetFdNonblocking, libs, "g_unix_set_fd_nonblocking")
-	core.PuregoSafeRegister(&xUnixSignalAdd, libs, "g_unix_signal_add")
-	core.PuregoSafeRegister(&xUnixSignalAddFull, libs, "g_unix_signal_add_full")
-	core.PuregoSafeRegister(&xUnixSignalSourceNew, libs, "g_unix_signal_source_new")
+	core.PuregoRegisterSince(&xClosefrom, libs, "g_closefrom", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xFdwalkSetCloexec, libs, "g_fdwalk_set_cloexec", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xUnixFdAdd, libs, "g_unix_fd_add", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xUnixFdAddFull, libs, "g_unix_fd_add_full", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xUnixFdSourceNew, libs, "g_unix_fd_source_new", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xUnixGetPasswdEntry, libs, "g_unix_get_passwd_entry", "GLIB", "2.64")
+	core.PuregoRegisterSince(&xUnixOpenPipe, libs, "g_unix_open_pipe", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnixSetFdNonblocking, libs, "g_unix_set_fd_nonblocking", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnixSignalAdd, libs, "g_unix_signal_add", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnixSignalAddFull, libs, "g_unix_signal_add_full", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnixSignalSourceNew, libs, "g_unix_signal_source_new", "GLIB", "2.30")
 
 }
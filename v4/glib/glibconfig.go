@@ -80,10 +80,10 @@ const (
 
 	MODULE_SUFFIX string = "so"
 	// A format specifier that can be used in printf()-style format strings
-	// when printing a #GPid.
+	// when printing a [Pid].
 	PID_FORMAT string = "i"
 	// A format specifier that can be used in printf()-style format strings
-	// when printing the @fd member of a #GPollFD.
+	// when printing the fd member of a [PollFD].
 	POLLFD_FORMAT string = "%d"
 	// The search path separator character.
 	// This is ':' on UNIX machines and ';' under Windows.
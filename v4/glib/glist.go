@@ -5,11 +5,10 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// The #GList struct is used for each element in a doubly-linked list.
+// The [List] struct is used for each element in a doubly-linked list.
 type List struct {
 	_ structs.HostLayout
 
@@ -26,9 +25,9 @@ func (x *List) GoPointer() uintptr {
 
 var xClearList func(**List, uintptr)
 
-// Clears a pointer to a #GList, freeing it and, optionally, freeing its elements using @destroy.
+// Clears a pointer to a [List], freeing it and, optionally, freeing its elements using destroy.
 //
-// @list_ptr must be a valid pointer. If @list_ptr points to a null #GList, this does nothing.
+// list_ptr must be a valid pointer. If list_ptr points to a null [List], this does nothing.
 func ClearList(ListPtrVar **List, DestroyVar *DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -38,11 +37,12 @@ func ClearList(ListPtrVar **List, DestroyVar *DestroyNotify) {
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -55,13 +55,13 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xClearList, libs, "g_clear_list")
+	core.PuregoRegisterSince(&xClearList, libs, "g_clear_list", "GLIB", "2.64")
 
 }
@@ -11,13 +11,17 @@ const (
 	//
 	// This is granted to be undefined when compiling with a C++ compiler.
 	//
-	// See also: %G_C_STD_CHECK_VERSION and %G_CXX_STD_VERSION
+	// See also: G_C_STD_CHECK_VERSION and G_CXX_STD_VERSION
 	C_STD_VERSION int = 199000
 	// Expands to "" on all modern compilers, and to  __FUNCTION__ on gcc
 	// version 2.x. Don't use it.
+	//
+	// Deprecated: since 2.16. Use G_STRFUNC() instead
 	GNUC_FUNCTION string = ""
 	// Expands to "" on all modern compilers, and to __PRETTY_FUNCTION__
 	// on gcc version 2.x. Don't use it.
+	//
+	// Deprecated: since 2.16. Use G_STRFUNC() instead
 	GNUC_PRETTY_FUNCTION string = ""
 	// Defined to 1 if gcc-style visibility handling is supported.
 	HAVE_GNUC_VISIBILITY int = 1
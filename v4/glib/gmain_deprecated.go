@@ -0,0 +1,77 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xMainContextWait func(uintptr, *Cond, *Mutex) bool
+
+// Tries to become the owner of the specified context, and waits on cond if
+// another thread is the owner.
+//
+// This is the same as [MainContext.Acquire], but if another thread
+// is the owner, atomically drop mutex and wait on cond until
+// that owner releases ownership or until cond is signaled, then
+// try again (once) to become the owner.
+//
+// Deprecated: since 2.58. Use [MainContext.IsOwner] and separate
+//
+//	locking instead.
+func (x *MainContext) Wait(CondVar *Cond, MutexVar *Mutex) bool {
+
+	cret := xMainContextWait(x.GoPointer(), CondVar, MutexVar)
+	return cret
+}
+
+var xSourceGetCurrentTime func(uintptr, *TimeVal)
+
+// This function ignores source and is otherwise the same as
+// [GetCurrentTime].
+//
+// Deprecated: since 2.28. use [Source.GetTime] instead
+func (x *Source) GetCurrentTime(TimevalVar *TimeVal) {
+
+	xSourceGetCurrentTime(x.GoPointer(), TimevalVar)
+
+}
+
+var xGetCurrentTime func(*TimeVal)
+
+// Queries the system wall-clock time.
+//
+// This is equivalent to the UNIX gettimeofday())
+// function, but portable.
+//
+// You may find [GetRealTime] to be more convenient.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	[GetRealTime] instead.
+func GetCurrentTime(ResultVar *TimeVal) {
+
+	xGetCurrentTime(ResultVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xGetCurrentTime, libs, "g_get_current_time", "GLIB", "")
+
+	core.PuregoRegisterSince(&xMainContextWait, libs, "g_main_context_wait", "GLIB", "")
+
+	core.PuregoRegisterSince(&xSourceGetCurrentTime, libs, "g_source_get_current_time", "GLIB", "")
+
+}
@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The #GMappedFile represents a file mapping created with
-// g_mapped_file_new(). It has only private members and should
+// The [MappedFile] represents a file mapping created with
+// [NewMappedFile]. It has only private members and should
 // not be accessed directly.
 type MappedFile struct {
 	_ structs.HostLayout
@@ -31,26 +30,26 @@ var xNewMappedFile func(string, bool, **Error) *MappedFile
 
 // Maps a file into memory. On UNIX, this is using the mmap() function.
 //
-// If @writable is %TRUE, the mapped buffer may be modified, otherwise
+// If writable is true, the mapped buffer may be modified, otherwise
 // it is an error to modify the mapped buffer. Modifications to the buffer
 // are not visible to other processes mapping the same file, and are not
 // written back to the file.
 //
 // Note that modifications of the underlying file might affect the contents
-// of the #GMappedFile. Therefore, mapping should only be used if the file
+// of the [MappedFile]. Therefore, mapping should only be used if the file
 // will not be modified, or if all modifications of the file are done
-// atomically (e.g. using g_file_set_contents()).
+// atomically (e.g. using [FileSetContents]).
 //
-// If @filename is the name of an empty, regular file, the function
-// will successfully return an empty #GMappedFile. In other cases of
-// size 0 (e.g. device files such as /dev/null), @error will be set
-// to the #GFileError value %G_FILE_ERROR_INVAL.
+// If filename is the name of an empty, regular file, the function
+// will successfully return an empty [MappedFile]. In other cases of
+// size 0 (e.g. device files such as /dev/null), error will be set
+// to the [FileError] value [GFileErrorInvalValue].
 func NewMappedFile(FilenameVar string, WritableVar bool) (*MappedFile, error) {
 	var cerr *Error
 
 	cret := xNewMappedFile(FilenameVar, WritableVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -60,41 +59,31 @@ var xNewMappedFileFromFd func(int, bool, **Error) *MappedFile
 
 // Maps a file into memory. On UNIX, this is using the mmap() function.
 //
-// If @writable is %TRUE, the mapped buffer may be modified, otherwise
+// If writable is true, the mapped buffer may be modified, otherwise
 // it is an error to modify the mapped buffer. Modifications to the buffer
 // are not visible to other processes mapping the same file, and are not
 // written back to the file.
 //
 // Note that modifications of the underlying file might affect the contents
-// of the #GMappedFile. Therefore, mapping should only be used if the file
+// of the [MappedFile]. Therefore, mapping should only be used if the file
 // will not be modified, or if all modifications of the file are done
-// atomically (e.g. using g_file_set_contents()).
+// atomically (e.g. using [FileSetContents]).
 func NewMappedFileFromFd(FdVar int, WritableVar bool) (*MappedFile, error) {
 	var cerr *Error
 
 	cret := xNewMappedFileFromFd(FdVar, WritableVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xMappedFileFree func(uintptr)
-
-// This call existed before #GMappedFile had refcounting and is currently
-// exactly the same as g_mapped_file_unref().
-func (x *MappedFile) Free() {
-
-	xMappedFileFree(x.GoPointer())
-
-}
-
 var xMappedFileGetBytes func(uintptr) *Bytes
 
-// Creates a new #GBytes which references the data mapped from @file.
+// Creates a new [Bytes] which references the data mapped from file.
 // The mapped contents of the file must not be modified after creating this
-// bytes object, because a #GBytes should be immutable.
+// bytes object, because a [Bytes] should be immutable.
 func (x *MappedFile) GetBytes() *Bytes {
 
 	cret := xMappedFileGetBytes(x.GoPointer())
@@ -103,12 +92,12 @@ func (x *MappedFile) GetBytes() *Bytes {
 
 var xMappedFileGetContents func(uintptr) string
 
-// Returns the contents of a #GMappedFile.
+// Returns the contents of a [MappedFile].
 //
 // Note that the contents may not be zero-terminated,
-// even if the #GMappedFile is backed by a text file.
+// even if the [MappedFile] is backed by a text file.
 //
-// If the file is empty then %NULL is returned.
+// If the file is empty then nil is returned.
 func (x *MappedFile) GetContents() string {
 
 	cret := xMappedFileGetContents(x.GoPointer())
@@ -117,7 +106,7 @@ func (x *MappedFile) GetContents() string {
 
 var xMappedFileGetLength func(uintptr) uint
 
-// Returns the length of the contents of a #GMappedFile.
+// Returns the length of the contents of a [MappedFile].
 func (x *MappedFile) GetLength() uint {
 
 	cret := xMappedFileGetLength(x.GoPointer())
@@ -126,7 +115,7 @@ func (x *MappedFile) GetLength() uint {
 
 var xMappedFileRef func(uintptr) *MappedFile
 
-// Increments the reference count of @file by one.  It is safe to call
+// Increments the reference count of file by one.  It is safe to call
 // this function from any thread.
 func (x *MappedFile) Ref() *MappedFile {
 
@@ -136,8 +125,8 @@ func (x *MappedFile) Ref() *MappedFile {
 
 var xMappedFileUnref func(uintptr)
 
-// Decrements the reference count of @file by one.  If the reference count
-// drops to 0, unmaps the buffer of @file and frees it.
+// Decrements the reference count of file by one.  If the reference count
+// drops to 0, unmaps the buffer of file and frees it.
 //
 // It is safe to call this function from any thread.
 //
@@ -153,7 +142,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -162,14 +151,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xMappedFileGLibType, libs, "g_mapped_file_get_type")
 
-	core.PuregoSafeRegister(&xNewMappedFile, libs, "g_mapped_file_new")
-	core.PuregoSafeRegister(&xNewMappedFileFromFd, libs, "g_mapped_file_new_from_fd")
+	core.PuregoRegisterSince(&xNewMappedFile, libs, "g_mapped_file_new", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xNewMappedFileFromFd, libs, "g_mapped_file_new_from_fd", "GLIB", "2.32")
 
-	core.PuregoSafeRegister(&xMappedFileFree, libs, "g_mapped_file_free")
-	core.PuregoSafeRegister(&xMappedFileGetBytes, libs, "g_mapped_file_get_bytes")
-	core.PuregoSafeRegister(&xMappedFileGetContents, libs, "g_mapped_file_get_contents")
-	core.PuregoSafeRegister(&xMappedFileGetLength, libs, "g_mapped_file_get_length")
-	core.PuregoSafeRegister(&xMappedFileRef, libs, "g_mapped_file_ref")
-	core.PuregoSafeRegister(&xMappedFileUnref, libs, "g_mapped_file_unref")
+	core.PuregoRegisterSince(&xMappedFileGetBytes, libs, "g_mapped_file_get_bytes", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xMappedFileGetContents, libs, "g_mapped_file_get_contents", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xMappedFileGetLength, libs, "g_mapped_file_get_length", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xMappedFileRef, libs, "g_mapped_file_ref", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xMappedFileUnref, libs, "g_mapped_file_unref", "GLIB", "")
 
 }
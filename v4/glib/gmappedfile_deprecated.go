@@ -0,0 +1,36 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xMappedFileFree func(uintptr)
+
+// This call existed before [MappedFile] had refcounting and is currently
+// exactly the same as [MappedFile.Unref].
+//
+// Deprecated: since 2.22. Use [MappedFile.Unref] instead.
+func (x *MappedFile) Free() {
+
+	xMappedFileFree(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xMappedFileFree, libs, "g_mapped_file_free", "GLIB", "2.8")
+
+}
@@ -2,10 +2,11 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -13,7 +14,7 @@ import (
 // A parse context is used to parse a stream of bytes that
 // you expect to contain marked-up text.
 //
-// See g_markup_parse_context_new(), #GMarkupParser, and so
+// See [NewMarkupParseContext], [MarkupParser], and so
 // on for more details.
 type MarkupParseContext struct {
 	_ structs.HostLayout
@@ -45,11 +46,12 @@ func NewMarkupParseContext(ParserVar *MarkupParser, FlagsVar MarkupParseFlags, U
 			UserDataDnotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataDnotifyVar
 				cbFn(arg0)
 			}
-			UserDataDnotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(UserDataDnotifyVarPtr, UserDataDnotifyVarRef, UserDataDnotifyVar)
+			UserDataDnotifyVarRef = core.NewCallback(fcb)
+			UserDataDnotifyVarRef = SaveCallbackWithClosure(UserDataDnotifyVarPtr, UserDataDnotifyVarRef, UserDataDnotifyVar)
 		}
 	}
 
@@ -59,8 +61,8 @@ func NewMarkupParseContext(ParserVar *MarkupParser, FlagsVar MarkupParseFlags, U
 
 var xMarkupParseContextEndParse func(uintptr) bool
 
-// Signals to the #GMarkupParseContext that all data has been
-// fed into the parse context with g_markup_parse_context_parse().
+// Signals to the [MarkupParseContext] that all data has been
+// fed into the parse context with [MarkupParseContext.Parse].
 //
 // This function reports an error if the document isn't complete,
 // for example if elements are still open.
@@ -69,7 +71,7 @@ func (x *MarkupParseContext) EndParse() (bool, error) {
 
 	cret := xMarkupParseContextEndParse(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -77,10 +79,10 @@ func (x *MarkupParseContext) EndParse() (bool, error) {
 
 var xMarkupParseContextFree func(uintptr)
 
-// Frees a #GMarkupParseContext.
+// Frees a [MarkupParseContext].
 //
 // This function can't be called from inside one of the
-// #GMarkupParser functions or while a subparser is pushed.
+// [MarkupParser] functions or while a subparser is pushed.
 func (x *MarkupParseContext) Free() {
 
 	xMarkupParseContextFree(x.GoPointer())
@@ -93,7 +95,7 @@ var xMarkupParseContextGetElement func(uintptr) string
 //
 // If called from the start_element or end_element handlers this will
 // give the element_name as passed to those functions. For the parent
-// elements, see g_markup_parse_context_get_element_stack().
+// elements, see [MarkupParseContext.GetElementStack].
 func (x *MarkupParseContext) GetElement() string {
 
 	cret := xMarkupParseContextGetElement(x.GoPointer())
@@ -104,13 +106,13 @@ var xMarkupParseContextGetElementStack func(uintptr) *SList
 
 // Retrieves the element stack from the internal state of the parser.
 //
-// The returned #GSList is a list of strings where the first item is
+// The returned [SList] is a list of strings where the first item is
 // the currently open tag (as would be returned by
-// g_markup_parse_context_get_element()) and the next item is its
+// [MarkupParseContext.GetElement]) and the next item is its
 // immediate parent.
 //
 // This function is intended to be used in the start_element and
-// end_element handlers where g_markup_parse_context_get_element()
+// end_element handlers where [MarkupParseContext.GetElement]
 // would merely return the name of the element that is being
 // processed.
 func (x *MarkupParseContext) GetElementStack() *SList {
@@ -133,11 +135,11 @@ func (x *MarkupParseContext) GetPosition(LineNumberVar *int, CharNumberVar *int)
 
 var xMarkupParseContextGetUserData func(uintptr) uintptr
 
-// Returns the user_data associated with @context.
+// Returns the user_data associated with context.
 //
 // This will either be the user_data that was provided to
-// g_markup_parse_context_new() or to the most recent call
-// of g_markup_parse_context_push().
+// [NewMarkupParseContext] or to the most recent call
+// of [MarkupParseContext.Push].
 func (x *MarkupParseContext) GetUserData() uintptr {
 
 	cret := xMarkupParseContextGetUserData(x.GoPointer())
@@ -146,7 +148,7 @@ func (x *MarkupParseContext) GetUserData() uintptr {
 
 var xMarkupParseContextParse func(uintptr, string, int, **Error) bool
 
-// Feed some data to the #GMarkupParseContext.
+// Feed some data to the [MarkupParseContext].
 //
 // The data need not be valid UTF-8; an error will be signaled if
 // it's invalid. The data need not be an entire document; you can
@@ -154,14 +156,14 @@ var xMarkupParseContextParse func(uintptr, string, int, **Error) bool
 // to this function. Typically, as you receive data from a network
 // connection or file, you feed each received chunk of data into this
 // function, aborting the process if an error occurs. Once an error
-// is reported, no further data may be fed to the #GMarkupParseContext;
+// is reported, no further data may be fed to the [MarkupParseContext];
 // all errors are fatal.
 func (x *MarkupParseContext) Parse(TextVar string, TextLenVar int) (bool, error) {
 	var cerr *Error
 
 	cret := xMarkupParseContextParse(x.GoPointer(), TextVar, TextLenVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -172,11 +174,11 @@ var xMarkupParseContextPop func(uintptr) uintptr
 // Completes the process of a temporary sub-parser redirection.
 //
 // This function exists to collect the user_data allocated by a
-// matching call to g_markup_parse_context_push(). It must be called
+// matching call to [MarkupParseContext.Push]. It must be called
 // in the end_element handler corresponding to the start_element
-// handler during which g_markup_parse_context_push() was called.
+// handler during which [MarkupParseContext.Push] was called.
 // You must not call this function from the error callback -- the
-// @user_data is provided directly to the callback in that case.
+// user_data is provided directly to the callback in that case.
 //
 // This function is not intended to be directly called by users
 // interested in invoking subparsers. Instead, it is intended to
@@ -193,23 +195,23 @@ var xMarkupParseContextPush func(uintptr, *MarkupParser, uintptr)
 // Temporarily redirects markup data to a sub-parser.
 //
 // This function may only be called from the start_element handler of
-// a #GMarkupParser. It must be matched with a corresponding call to
-// g_markup_parse_context_pop() in the matching end_element handler
+// a [MarkupParser]. It must be matched with a corresponding call to
+// [MarkupParseContext.Pop] in the matching end_element handler
 // (except in the case that the parser aborts due to an error).
 //
 // All tags, text and other data between the matching tags is
-// redirected to the subparser given by @parser. @user_data is used
-// as the user_data for that parser. @user_data is also passed to the
+// redirected to the subparser given by parser. user_data is used
+// as the user_data for that parser. user_data is also passed to the
 // error callback in the event that an error occurs. This includes
 // errors that occur in subparsers of the subparser.
 //
 // The end tag matching the start tag for which this call was made is
 // handled by the previous parser (which is given its own user_data)
-// which is why g_markup_parse_context_pop() is provided to allow "one
-// last access" to the @user_data provided to this function. In the
-// case of error, the @user_data provided here is passed directly to
-// the error callback of the subparser and g_markup_parse_context_pop()
-// should not be called. In either case, if @user_data was allocated
+// which is why [MarkupParseContext.Pop] is provided to allow "one
+// last access" to the user_data provided to this function. In the
+// case of error, the user_data provided here is passed directly to
+// the error callback of the subparser and [MarkupParseContext.Pop]
+// should not be called. In either case, if user_data was allocated
 // then it ought to be freed from both of these locations.
 //
 // This function is not intended to be directly called by users
@@ -220,42 +222,35 @@ var xMarkupParseContextPush func(uintptr, *MarkupParser, uintptr)
 // As an example, see the following implementation of a simple
 // parser that counts the number of tags encountered.
 //
-// |[&lt;!-- language="C" --&gt;
-// typedef struct
-//
+//	typedef struct
 //	{
 //	  gint tag_count;
 //	} CounterData;
 //
-// static void
-// counter_start_element (GMarkupParseContext  *context,
-//
-//	const gchar          *element_name,
-//	const gchar         **attribute_names,
-//	const gchar         **attribute_values,
-//	gpointer              user_data,
-//	GError              **error)
-//
+//	static void
+//	counter_start_element (GMarkupParseContext  *context,
+//	                       const gchar          *element_name,
+//	                       const gchar         **attribute_names,
+//	                       const gchar         **attribute_values,
+//	                       gpointer              user_data,
+//	                       GError              **error)
 //	{
 //	  CounterData *data = user_data;
 //
-//	  data-&gt;tag_count++;
+//	  data->tag_count++;
 //	}
 //
-// static void
-// counter_error (GMarkupParseContext *context,
-//
-//	GError              *error,
-//	gpointer             user_data)
-//
+//	static void
+//	counter_error (GMarkupParseContext *context,
+//	               GError              *error,
+//	               gpointer             user_data)
 //	{
 //	  CounterData *data = user_data;
 //
 //	  g_slice_free (CounterData, data);
 //	}
 //
-// static GMarkupParser counter_subparser =
-//
+//	static GMarkupParser counter_subparser =
 //	{
 //	  counter_start_element,
 //	  NULL,
@@ -264,42 +259,33 @@ var xMarkupParseContextPush func(uintptr, *MarkupParser, uintptr)
 //	  counter_error
 //	};
 //
-// ]|
-//
 // In order to allow this parser to be easily used as a subparser, the
 // following interface is provided:
 //
-// |[&lt;!-- language="C" --&gt;
-// void
-// start_counting (GMarkupParseContext *context)
-//
+//	void
+//	start_counting (GMarkupParseContext *context)
 //	{
 //	  CounterData *data = g_slice_new (CounterData);
 //
-//	  data-&gt;tag_count = 0;
-//	  g_markup_parse_context_push (context, &amp;counter_subparser, data);
+//	  data->tag_count = 0;
+//	  g_markup_parse_context_push (context, &counter_subparser, data);
 //	}
 //
-// gint
-// end_counting (GMarkupParseContext *context)
-//
+//	gint
+//	end_counting (GMarkupParseContext *context)
 //	{
 //	  CounterData *data = g_markup_parse_context_pop (context);
 //	  int result;
 //
-//	  result = data-&gt;tag_count;
+//	  result = data->tag_count;
 //	  g_slice_free (CounterData, data);
 //
 //	  return result;
 //	}
 //
-// ]|
-//
 // The subparser would then be used as follows:
 //
-// |[&lt;!-- language="C" --&gt;
-// static void start_element (context, element_name, ...)
-//
+//	static void start_element (context, element_name, ...)
 //	{
 //	  if (strcmp (element_name, "count-these") == 0)
 //	    start_counting (context);
@@ -307,16 +293,13 @@ var xMarkupParseContextPush func(uintptr, *MarkupParser, uintptr)
 //	  // else, handle other tags...
 //	}
 //
-// static void end_element (context, element_name, ...)
-//
+//	static void end_element (context, element_name, ...)
 //	{
 //	  if (strcmp (element_name, "count-these") == 0)
 //	    g_print ("Counted %d tags\n", end_counting (context));
 //
 //	  // else, handle other tags...
 //	}
-//
-// ]|
 func (x *MarkupParseContext) Push(ParserVar *MarkupParser, UserDataVar uintptr) {
 
 	xMarkupParseContextPush(x.GoPointer(), ParserVar, UserDataVar)
@@ -325,7 +308,7 @@ func (x *MarkupParseContext) Push(ParserVar *MarkupParser, UserDataVar uintptr)
 
 var xMarkupParseContextRef func(uintptr) *MarkupParseContext
 
-// Increases the reference count of @context.
+// Increases the reference count of context.
 func (x *MarkupParseContext) Ref() *MarkupParseContext {
 
 	cret := xMarkupParseContextRef(x.GoPointer())
@@ -334,7 +317,7 @@ func (x *MarkupParseContext) Ref() *MarkupParseContext {
 
 var xMarkupParseContextUnref func(uintptr)
 
-// Decreases the reference count of @context.  When its reference count
+// Decreases the reference count of context.  When its reference count
 // drops to 0, it is freed.
 func (x *MarkupParseContext) Unref() {
 
@@ -342,15 +325,15 @@ func (x *MarkupParseContext) Unref() {
 
 }
 
-// Any of the fields in #GMarkupParser can be %NULL, in which case they
-// will be ignored. Except for the @error function, any of these callbacks
-// can set an error; in particular the %G_MARKUP_ERROR_UNKNOWN_ELEMENT,
-// %G_MARKUP_ERROR_UNKNOWN_ATTRIBUTE, and %G_MARKUP_ERROR_INVALID_CONTENT
+// Any of the fields in [MarkupParser] can be nil, in which case they
+// will be ignored. Except for the error function, any of these callbacks
+// can set an error; in particular the [GMarkupErrorUnknownElementValue],
+// [GMarkupErrorUnknownAttributeValue], and [GMarkupErrorInvalidContentValue]
 // errors are intended to be set from these callbacks. If you set an error
-// from a callback, g_markup_parse_context_parse() will report that error
+// from a callback, [MarkupParseContext.Parse] will report that error
 // back to its caller.
 //
-// Refer to the [GMarkup](../glib/markup.html) documentation to understand
+// Refer to the GMarkup documentation to understand
 // the scope and limitations of `GMarkupParser`. In particular, it is not a
 // full XML parser and it must not be used to process untrusted data.
 type MarkupParser struct {
@@ -374,13 +357,14 @@ func (x *MarkupParser) GoPointer() uintptr {
 // OverrideStartElement sets the "start_element" callback function.
 // Callback to invoke when the opening tag of an element
 //
-//	is seen. The callback's @attribute_names and @attribute_values parameters
-//	are %NULL-terminated.
+//	is seen. The callback's attribute_names and attribute_values parameters
+//	are nil-terminated.
 func (x *MarkupParser) OverrideStartElement(cb func(*MarkupParseContext, string, string, string, uintptr)) {
 	if cb == nil {
 		x.xStartElement = 0
 	} else {
-		x.xStartElement = purego.NewCallback(func(ContextVarp *MarkupParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr) {
+		x.xStartElement = core.NewCallback(func(ContextVarp *MarkupParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ElementNameVarp, AttributeNamesVarp, AttributeValuesVarp, UserDataVarp)
 		})
 	}
@@ -389,14 +373,14 @@ func (x *MarkupParser) OverrideStartElement(cb func(*MarkupParseContext, string,
 // GetStartElement gets the "start_element" callback function.
 // Callback to invoke when the opening tag of an element
 //
-//	is seen. The callback's @attribute_names and @attribute_values parameters
-//	are %NULL-terminated.
+//	is seen. The callback's attribute_names and attribute_values parameters
+//	are nil-terminated.
 func (x *MarkupParser) GetStartElement() func(*MarkupParseContext, string, string, string, uintptr) {
 	if x.xStartElement == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp *MarkupParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStartElement)
+	core.RegisterFunc(&rawCallback, x.xStartElement)
 	return func(ContextVar *MarkupParseContext, ElementNameVar string, AttributeNamesVar string, AttributeValuesVar string, UserDataVar uintptr) {
 		rawCallback(ContextVar, ElementNameVar, AttributeNamesVar, AttributeValuesVar, UserDataVar)
 	}
@@ -406,12 +390,13 @@ func (x *MarkupParser) GetStartElement() func(*MarkupParseContext, string, strin
 // Callback to invoke when the closing tag of an element
 //
 //	is seen. Note that this is also called for empty tags like
-//	`&lt;empty/&gt;`.
+//	`<empty/>`.
 func (x *MarkupParser) OverrideEndElement(cb func(*MarkupParseContext, string, uintptr)) {
 	if cb == nil {
 		x.xEndElement = 0
 	} else {
-		x.xEndElement = purego.NewCallback(func(ContextVarp *MarkupParseContext, ElementNameVarp string, UserDataVarp uintptr) {
+		x.xEndElement = core.NewCallback(func(ContextVarp *MarkupParseContext, ElementNameVarp string, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ElementNameVarp, UserDataVarp)
 		})
 	}
@@ -421,13 +406,13 @@ func (x *MarkupParser) OverrideEndElement(cb func(*MarkupParseContext, string, u
 // Callback to invoke when the closing tag of an element
 //
 //	is seen. Note that this is also called for empty tags like
-//	`&lt;empty/&gt;`.
+//	`<empty/>`.
 func (x *MarkupParser) GetEndElement() func(*MarkupParseContext, string, uintptr) {
 	if x.xEndElement == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp *MarkupParseContext, ElementNameVarp string, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEndElement)
+	core.RegisterFunc(&rawCallback, x.xEndElement)
 	return func(ContextVar *MarkupParseContext, ElementNameVar string, UserDataVar uintptr) {
 		rawCallback(ContextVar, ElementNameVar, UserDataVar)
 	}
@@ -438,13 +423,14 @@ func (x *MarkupParser) GetEndElement() func(*MarkupParseContext, string, uintptr
 //
 //	inside an element). Note that the text of an element may be spread
 //	over multiple calls of this function. If the
-//	%G_MARKUP_TREAT_CDATA_AS_TEXT flag is set, this function is also
+//	[GMarkupTreatCdataAsTextValue] flag is set, this function is also
 //	called for the content of CDATA marked sections.
 func (x *MarkupParser) OverrideText(cb func(*MarkupParseContext, string, uint, uintptr)) {
 	if cb == nil {
 		x.xText = 0
 	} else {
-		x.xText = purego.NewCallback(func(ContextVarp *MarkupParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+		x.xText = core.NewCallback(func(ContextVarp *MarkupParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, TextVarp, TextLenVarp, UserDataVarp)
 		})
 	}
@@ -455,14 +441,14 @@ func (x *MarkupParser) OverrideText(cb func(*MarkupParseContext, string, uint, u
 //
 //	inside an element). Note that the text of an element may be spread
 //	over multiple calls of this function. If the
-//	%G_MARKUP_TREAT_CDATA_AS_TEXT flag is set, this function is also
+//	[GMarkupTreatCdataAsTextValue] flag is set, this function is also
 //	called for the content of CDATA marked sections.
 func (x *MarkupParser) GetText() func(*MarkupParseContext, string, uint, uintptr) {
 	if x.xText == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp *MarkupParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xText)
+	core.RegisterFunc(&rawCallback, x.xText)
 	return func(ContextVar *MarkupParseContext, TextVar string, TextLenVar uint, UserDataVar uintptr) {
 		rawCallback(ContextVar, TextVar, TextLenVar, UserDataVar)
 	}
@@ -473,13 +459,14 @@ func (x *MarkupParser) GetText() func(*MarkupParseContext, string, uint, uintptr
 //
 //	and doctype declarations; if you're re-writing the parsed document,
 //	write the passthrough text back out in the same position. If the
-//	%G_MARKUP_TREAT_CDATA_AS_TEXT flag is not set, this function is also
+//	[GMarkupTreatCdataAsTextValue] flag is not set, this function is also
 //	called for CDATA marked sections.
 func (x *MarkupParser) OverridePassthrough(cb func(*MarkupParseContext, string, uint, uintptr)) {
 	if cb == nil {
 		x.xPassthrough = 0
 	} else {
-		x.xPassthrough = purego.NewCallback(func(ContextVarp *MarkupParseContext, PassthroughTextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+		x.xPassthrough = core.NewCallback(func(ContextVarp *MarkupParseContext, PassthroughTextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, PassthroughTextVarp, TextLenVarp, UserDataVarp)
 		})
 	}
@@ -490,14 +477,14 @@ func (x *MarkupParser) OverridePassthrough(cb func(*MarkupParseContext, string,
 //
 //	and doctype declarations; if you're re-writing the parsed document,
 //	write the passthrough text back out in the same position. If the
-//	%G_MARKUP_TREAT_CDATA_AS_TEXT flag is not set, this function is also
+//	[GMarkupTreatCdataAsTextValue] flag is not set, this function is also
 //	called for CDATA marked sections.
 func (x *MarkupParser) GetPassthrough() func(*MarkupParseContext, string, uint, uintptr) {
 	if x.xPassthrough == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp *MarkupParseContext, PassthroughTextVarp string, TextLenVarp uint, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPassthrough)
+	core.RegisterFunc(&rawCallback, x.xPassthrough)
 	return func(ContextVar *MarkupParseContext, PassthroughTextVar string, TextLenVar uint, UserDataVar uintptr) {
 		rawCallback(ContextVar, PassthroughTextVar, TextLenVar, UserDataVar)
 	}
@@ -509,7 +496,8 @@ func (x *MarkupParser) OverrideError(cb func(*MarkupParseContext, *Error, uintpt
 	if cb == nil {
 		x.xError = 0
 	} else {
-		x.xError = purego.NewCallback(func(ContextVarp *MarkupParseContext, ErrorVarp *Error, UserDataVarp uintptr) {
+		x.xError = core.NewCallback(func(ContextVarp *MarkupParseContext, ErrorVarp *Error, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ErrorVarp, UserDataVarp)
 		})
 	}
@@ -522,7 +510,7 @@ func (x *MarkupParser) GetError() func(*MarkupParseContext, *Error, uintptr) {
 		return nil
 	}
 	var rawCallback func(ContextVarp *MarkupParseContext, ErrorVarp *Error, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xError)
+	core.RegisterFunc(&rawCallback, x.xError)
 	return func(ContextVar *MarkupParseContext, ErrorVar *Error, UserDataVar uintptr) {
 		rawCallback(ContextVar, ErrorVar, UserDataVar)
 	}
@@ -530,11 +518,11 @@ func (x *MarkupParser) GetError() func(*MarkupParseContext, *Error, uintptr) {
 
 // A mixed enumerated type and flags field. You must specify one type
 // (string, strdup, boolean, tristate).  Additionally, you may  optionally
-// bitwise OR the type with the flag %G_MARKUP_COLLECT_OPTIONAL.
+// bitwise OR the type with the flag [GMarkupCollectOptionalValue].
 //
 // It is likely that this enum will be extended in the future to
 // support other types.
-type MarkupCollectType int
+type MarkupCollectType uint32
 
 const (
 
@@ -543,22 +531,22 @@ const (
 	GMarkupCollectInvalidValue MarkupCollectType = 0
 	// collect the string pointer directly from
 	//     the attribute_values[] array. Expects a parameter of type (const
-	//     char **). If %G_MARKUP_COLLECT_OPTIONAL is specified and the
-	//     attribute isn't present then the pointer will be set to %NULL
+	//     char **). If [GMarkupCollectOptionalValue] is specified and the
+	//     attribute isn't present then the pointer will be set to nil
 	GMarkupCollectStringValue MarkupCollectType = 1
-	// as with %G_MARKUP_COLLECT_STRING, but
-	//     expects a parameter of type (char **) and g_strdup()s the
-	//     returned pointer. The pointer must be freed with g_free()
+	// as with [GMarkupCollectStringValue], but
+	//     expects a parameter of type (char **) and [Strdup]s the
+	//     returned pointer. The pointer must be freed with [Free]
 	GMarkupCollectStrdupValue MarkupCollectType = 2
 	// expects a parameter of type (`gboolean *`)
-	//     and parses the attribute value as a boolean. Sets %FALSE if the
+	//     and parses the attribute value as a boolean. Sets false if the
 	//     attribute isn't present. Valid boolean values consist of
 	//     (case-insensitive) "false", "f", "no", "n", "0" and "true", "t",
 	//     "yes", "y", "1"
 	GMarkupCollectBooleanValue MarkupCollectType = 3
-	// as with %G_MARKUP_COLLECT_BOOLEAN, but
+	// as with [GMarkupCollectBooleanValue], but
 	//     in the case of a missing attribute a value is set that compares
-	//     equal to neither %FALSE nor %TRUE %G_MARKUP_COLLECT_OPTIONAL is
+	//     equal to neither false nor true [GMarkupCollectOptionalValue] is
 	//     implied
 	GMarkupCollectTristateValue MarkupCollectType = 4
 	// can be bitwise ORed with the other fields.
@@ -567,8 +555,56 @@ const (
 	GMarkupCollectOptionalValue MarkupCollectType = 65536
 )
 
+// Has reports whether all bits of flags are set in x
+func (x MarkupCollectType) Has(flags MarkupCollectType) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x MarkupCollectType) Set(flags MarkupCollectType) MarkupCollectType {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x MarkupCollectType) Clear(flags MarkupCollectType) MarkupCollectType {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x MarkupCollectType) String() string {
+	if x == 0 {
+		return "G_MARKUP_COLLECT_INVALID"
+	}
+	var names []string
+	rest := x
+	if rest&GMarkupCollectStringValue != 0 && x&GMarkupCollectStringValue == GMarkupCollectStringValue {
+		names = append(names, "G_MARKUP_COLLECT_STRING")
+		rest &^= GMarkupCollectStringValue
+	}
+	if rest&GMarkupCollectStrdupValue != 0 && x&GMarkupCollectStrdupValue == GMarkupCollectStrdupValue {
+		names = append(names, "G_MARKUP_COLLECT_STRDUP")
+		rest &^= GMarkupCollectStrdupValue
+	}
+	if rest&GMarkupCollectBooleanValue != 0 && x&GMarkupCollectBooleanValue == GMarkupCollectBooleanValue {
+		names = append(names, "G_MARKUP_COLLECT_BOOLEAN")
+		rest &^= GMarkupCollectBooleanValue
+	}
+	if rest&GMarkupCollectTristateValue != 0 && x&GMarkupCollectTristateValue == GMarkupCollectTristateValue {
+		names = append(names, "G_MARKUP_COLLECT_TRISTATE")
+		rest &^= GMarkupCollectTristateValue
+	}
+	if rest&GMarkupCollectOptionalValue != 0 && x&GMarkupCollectOptionalValue == GMarkupCollectOptionalValue {
+		names = append(names, "G_MARKUP_COLLECT_OPTIONAL")
+		rest &^= GMarkupCollectOptionalValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags that affect the behaviour of the parser.
-type MarkupParseFlags int
+type MarkupParseFlags uint32
 
 const (
 
@@ -577,16 +613,16 @@ const (
 	// flag you should not use
 	GMarkupDoNotUseThisUnsupportedFlagValue MarkupParseFlags = 1
 	// When this flag is set, CDATA marked
-	//     sections are not passed literally to the @passthrough function of
+	//     sections are not passed literally to the passthrough function of
 	//     the parser. Instead, the content of the section (without the
-	//     `&lt;![CDATA[` and `]]&gt;`) is
-	//     passed to the @text function. This flag was added in GLib 2.12
+	//     `<![CDATA[` and `]]>`) is
+	//     passed to the text function. This flag was added in GLib 2.12
 	GMarkupTreatCdataAsTextValue MarkupParseFlags = 2
 	// Normally errors caught by GMarkup
 	//     itself have line/column information prefixed to them to let the
 	//     caller know the location of the error. When this flag is set the
 	//     location information is also prefixed to errors generated by the
-	//     #GMarkupParser implementation functions
+	//     [MarkupParser] implementation functions
 	GMarkupPrefixErrorPositionValue MarkupParseFlags = 4
 	// Ignore (don't report) qualified
 	//     attributes and tags, along with their contents.  A qualified
@@ -595,6 +631,50 @@ const (
 	GMarkupIgnoreQualifiedValue MarkupParseFlags = 8
 )
 
+// Has reports whether all bits of flags are set in x
+func (x MarkupParseFlags) Has(flags MarkupParseFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x MarkupParseFlags) Set(flags MarkupParseFlags) MarkupParseFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x MarkupParseFlags) Clear(flags MarkupParseFlags) MarkupParseFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x MarkupParseFlags) String() string {
+	if x == 0 {
+		return "G_MARKUP_DEFAULT_FLAGS"
+	}
+	var names []string
+	rest := x
+	if rest&GMarkupDoNotUseThisUnsupportedFlagValue != 0 && x&GMarkupDoNotUseThisUnsupportedFlagValue == GMarkupDoNotUseThisUnsupportedFlagValue {
+		names = append(names, "G_MARKUP_DO_NOT_USE_THIS_UNSUPPORTED_FLAG")
+		rest &^= GMarkupDoNotUseThisUnsupportedFlagValue
+	}
+	if rest&GMarkupTreatCdataAsTextValue != 0 && x&GMarkupTreatCdataAsTextValue == GMarkupTreatCdataAsTextValue {
+		names = append(names, "G_MARKUP_TREAT_CDATA_AS_TEXT")
+		rest &^= GMarkupTreatCdataAsTextValue
+	}
+	if rest&GMarkupPrefixErrorPositionValue != 0 && x&GMarkupPrefixErrorPositionValue == GMarkupPrefixErrorPositionValue {
+		names = append(names, "G_MARKUP_PREFIX_ERROR_POSITION")
+		rest &^= GMarkupPrefixErrorPositionValue
+	}
+	if rest&GMarkupIgnoreQualifiedValue != 0 && x&GMarkupIgnoreQualifiedValue == GMarkupIgnoreQualifiedValue {
+		names = append(names, "G_MARKUP_IGNORE_QUALIFIED")
+		rest &^= GMarkupIgnoreQualifiedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Error codes returned by markup parsing.
 type MarkupError int
 
@@ -606,54 +686,143 @@ const (
 	GMarkupErrorEmptyValue MarkupError = 1
 	// document was ill-formed
 	GMarkupErrorParseValue MarkupError = 2
-	// error should be set by #GMarkupParser
+	// error should be set by [MarkupParser]
 	//     functions; element wasn't known
 	GMarkupErrorUnknownElementValue MarkupError = 3
-	// error should be set by #GMarkupParser
+	// error should be set by [MarkupParser]
 	//     functions; attribute wasn't known
 	GMarkupErrorUnknownAttributeValue MarkupError = 4
-	// error should be set by #GMarkupParser
+	// error should be set by [MarkupParser]
 	//     functions; content was invalid
 	GMarkupErrorInvalidContentValue MarkupError = 5
-	// error should be set by #GMarkupParser
+	// error should be set by [MarkupParser]
 	//     functions; a required attribute was missing
 	GMarkupErrorMissingAttributeValue MarkupError = 6
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (MarkupError) ErrorDomain() string {
+	return "g-markup-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x MarkupError) Error() string {
+	switch x {
+	case GMarkupErrorBadUtf8Value:
+		return "G_MARKUP_ERROR_BAD_UTF8"
+	case GMarkupErrorEmptyValue:
+		return "G_MARKUP_ERROR_EMPTY"
+	case GMarkupErrorParseValue:
+		return "G_MARKUP_ERROR_PARSE"
+	case GMarkupErrorUnknownElementValue:
+		return "G_MARKUP_ERROR_UNKNOWN_ELEMENT"
+	case GMarkupErrorUnknownAttributeValue:
+		return "G_MARKUP_ERROR_UNKNOWN_ATTRIBUTE"
+	case GMarkupErrorInvalidContentValue:
+		return "G_MARKUP_ERROR_INVALID_CONTENT"
+	case GMarkupErrorMissingAttributeValue:
+		return "G_MARKUP_ERROR_MISSING_ATTRIBUTE"
+	}
+	return fmt.Sprintf("g-markup-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x MarkupError) String() string {
+	switch x {
+	case GMarkupErrorBadUtf8Value:
+		return "G_MARKUP_ERROR_BAD_UTF8"
+	case GMarkupErrorEmptyValue:
+		return "G_MARKUP_ERROR_EMPTY"
+	case GMarkupErrorParseValue:
+		return "G_MARKUP_ERROR_PARSE"
+	case GMarkupErrorUnknownElementValue:
+		return "G_MARKUP_ERROR_UNKNOWN_ELEMENT"
+	case GMarkupErrorUnknownAttributeValue:
+		return "G_MARKUP_ERROR_UNKNOWN_ATTRIBUTE"
+	case GMarkupErrorInvalidContentValue:
+		return "G_MARKUP_ERROR_INVALID_CONTENT"
+	case GMarkupErrorMissingAttributeValue:
+		return "G_MARKUP_ERROR_MISSING_ATTRIBUTE"
+	}
+	return fmt.Sprintf("MarkupError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MarkupError) Nick() string {
+	switch x {
+	case GMarkupErrorBadUtf8Value:
+		return "bad-utf8"
+	case GMarkupErrorEmptyValue:
+		return "empty"
+	case GMarkupErrorParseValue:
+		return "parse"
+	case GMarkupErrorUnknownElementValue:
+		return "unknown-element"
+	case GMarkupErrorUnknownAttributeValue:
+		return "unknown-attribute"
+	case GMarkupErrorInvalidContentValue:
+		return "invalid-content"
+	case GMarkupErrorMissingAttributeValue:
+		return "missing-attribute"
+	}
+	return ""
+}
+
+// MarkupErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MarkupErrorFromString(s string) (MarkupError, bool) {
+	switch s {
+	case "G_MARKUP_ERROR_BAD_UTF8", "bad-utf8":
+		return GMarkupErrorBadUtf8Value, true
+	case "G_MARKUP_ERROR_EMPTY", "empty":
+		return GMarkupErrorEmptyValue, true
+	case "G_MARKUP_ERROR_PARSE", "parse":
+		return GMarkupErrorParseValue, true
+	case "G_MARKUP_ERROR_UNKNOWN_ELEMENT", "unknown-element":
+		return GMarkupErrorUnknownElementValue, true
+	case "G_MARKUP_ERROR_UNKNOWN_ATTRIBUTE", "unknown-attribute":
+		return GMarkupErrorUnknownAttributeValue, true
+	case "G_MARKUP_ERROR_INVALID_CONTENT", "invalid-content":
+		return GMarkupErrorInvalidContentValue, true
+	case "G_MARKUP_ERROR_MISSING_ATTRIBUTE", "missing-attribute":
+		return GMarkupErrorMissingAttributeValue, true
+	}
+	return 0, false
+}
+
 var xMarkupCollectAttributes func(string, string, string, **Error, MarkupCollectType, string, ...interface{}) bool
 
 // Collects the attributes of the element from the data passed to the
-// #GMarkupParser start_element function, dealing with common error
+// [MarkupParser] start_element function, dealing with common error
 // conditions and supporting boolean values.
 //
 // This utility function is not required to write a parser but can save
 // a lot of typing.
 //
-// The @element_name, @attribute_names, @attribute_values and @error
+// The element_name, attribute_names, attribute_values and error
 // parameters passed to the start_element callback should be passed
 // unmodified to this function.
 //
 // Following these arguments is a list of "supported" attributes to collect.
 // It is an error to specify multiple attributes with the same name. If any
-// attribute not in the list appears in the @attribute_names array then an
+// attribute not in the list appears in the attribute_names array then an
 // unknown attribute error will result.
 //
-// The #GMarkupCollectType field allows specifying the type of collection
+// The [MarkupCollectType] field allows specifying the type of collection
 // to perform and if a given attribute must appear or is optional.
 //
 // The attribute name is simply the name of the attribute to collect.
 //
 // The pointer should be of the appropriate type (see the descriptions
-// under #GMarkupCollectType) and may be %NULL in case a particular
+// under [MarkupCollectType]) and may be nil in case a particular
 // attribute is to be allowed but ignored.
 //
 // This function deals with issuing errors for missing attributes
-// (of type %G_MARKUP_ERROR_MISSING_ATTRIBUTE), unknown attributes
-// (of type %G_MARKUP_ERROR_UNKNOWN_ATTRIBUTE) and duplicate
-// attributes (of type %G_MARKUP_ERROR_INVALID_CONTENT) as well
+// (of type [GMarkupErrorMissingAttributeValue]), unknown attributes
+// (of type [GMarkupErrorUnknownAttributeValue]) and duplicate
+// attributes (of type [GMarkupErrorInvalidContentValue]) as well
 // as parse errors for boolean-valued attributes (again of type
-// %G_MARKUP_ERROR_INVALID_CONTENT). In all of these cases %FALSE
-// will be returned and @error will be set as appropriate.
+// [GMarkupErrorInvalidContentValue]). In all of these cases false
+// will be returned and error will be set as appropriate.
 func MarkupCollectAttributes(ElementNameVar string, AttributeNamesVar string, AttributeValuesVar string, ErrorVar **Error, FirstTypeVar MarkupCollectType, FirstAttrVar string, varArgs ...interface{}) bool {
 
 	cret := xMarkupCollectAttributes(ElementNameVar, AttributeNamesVar, AttributeValuesVar, ErrorVar, FirstTypeVar, FirstAttrVar, varArgs...)
@@ -673,7 +842,7 @@ var xMarkupEscapeText func(string, int) string
 // of line endings and attribute values.
 //
 // Note also that this function will produce character references in
-// the range of &amp;#x1; ... &amp;#x1f; for all control sequences
+// the range of &#x1; ... &#x1f; for all control sequences
 // except for tabstop, newline and carriage return.  The character
 // references in this range are not valid XML 1.0, but they are
 // valid XML 1.1 and will be accepted by the GMarkup parser.
@@ -686,26 +855,22 @@ func MarkupEscapeText(TextVar string, LengthVar int) string {
 
 var xMarkupPrintfEscaped func(string, ...interface{}) string
 
-// Formats arguments according to @format, escaping
+// Formats arguments according to format, escaping
 // all string and character arguments in the fashion
-// of g_markup_escape_text(). This is useful when you
+// of [MarkupEscapeText]. This is useful when you
 // want to insert literal strings into XML-style markup
 // output, without having to worry that the strings
 // might themselves contain markup.
 //
-// |[&lt;!-- language="C" --&gt;
-// const char *store = "Fortnum &amp; Mason";
-// const char *item = "Tea";
-// char *output;
-//
-// output = g_markup_printf_escaped ("&lt;purchase&gt;"
-//
-//	"&lt;store&gt;%s&lt;/store&gt;"
-//	"&lt;item&gt;%s&lt;/item&gt;"
-//	"&lt;/purchase&gt;",
-//	store, item);
+//	const char *store = "Fortnum & Mason";
+//	const char *item = "Tea";
+//	char *output;
 //
-// ]|
+//	output = g_markup_printf_escaped ("<purchase>"
+//	                                  "<store>%s</store>"
+//	                                  "<item>%s</item>"
+//	                                  "</purchase>",
+//	                                  store, item);
 func MarkupPrintfEscaped(FormatVar string, varArgs ...interface{}) string {
 
 	cret := xMarkupPrintfEscaped(FormatVar, varArgs...)
@@ -715,9 +880,9 @@ func MarkupPrintfEscaped(FormatVar string, varArgs ...interface{}) string {
 
 var xMarkupVprintfEscaped func(string, []interface{}) string
 
-// Formats the data in @args according to @format, escaping
+// Formats the data in args according to format, escaping
 // all string and character arguments in the fashion
-// of g_markup_escape_text(). See g_markup_printf_escaped().
+// of [MarkupEscapeText]. See [MarkupPrintfEscaped].
 func MarkupVprintfEscaped(FormatVar string, ArgsVar []interface{}) string {
 
 	cret := xMarkupVprintfEscaped(FormatVar, ArgsVar)
@@ -730,32 +895,32 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xMarkupCollectAttributes, libs, "g_markup_collect_attributes")
-	core.PuregoSafeRegister(&xMarkupEscapeText, libs, "g_markup_escape_text")
-	core.PuregoSafeRegister(&xMarkupPrintfEscaped, libs, "g_markup_printf_escaped")
-	core.PuregoSafeRegister(&xMarkupVprintfEscaped, libs, "g_markup_vprintf_escaped")
+	core.PuregoRegisterSince(&xMarkupCollectAttributes, libs, "g_markup_collect_attributes", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xMarkupEscapeText, libs, "g_markup_escape_text", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupPrintfEscaped, libs, "g_markup_printf_escaped", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xMarkupVprintfEscaped, libs, "g_markup_vprintf_escaped", "GLIB", "2.4")
 
 	core.PuregoSafeRegister(&xMarkupParseContextGLibType, libs, "g_markup_parse_context_get_type")
 
-	core.PuregoSafeRegister(&xNewMarkupParseContext, libs, "g_markup_parse_context_new")
-
-	core.PuregoSafeRegister(&xMarkupParseContextEndParse, libs, "g_markup_parse_context_end_parse")
-	core.PuregoSafeRegister(&xMarkupParseContextFree, libs, "g_markup_parse_context_free")
-	core.PuregoSafeRegister(&xMarkupParseContextGetElement, libs, "g_markup_parse_context_get_element")
-	core.PuregoSafeRegister(&xMarkupParseContextGetElementStack, libs, "g_markup_parse_context_get_element_stack")
-	core.PuregoSafeRegister(&xMarkupParseContextGetPosition, libs, "g_markup_parse_context_get_position")
-	core.PuregoSafeRegister(&xMarkupParseContextGetUserData, libs, "g_markup_parse_context_get_user_data")
-	core.PuregoSafeRegister(&xMarkupParseContextParse, libs, "g_markup_parse_context_parse")
-	core.PuregoSafeRegister(&xMarkupParseContextPop, libs, "g_markup_parse_context_pop")
-	core.PuregoSafeRegister(&xMarkupParseContextPush, libs, "g_markup_parse_context_push")
-	core.PuregoSafeRegister(&xMarkupParseContextRef, libs, "g_markup_parse_context_ref")
-	core.PuregoSafeRegister(&xMarkupParseContextUnref, libs, "g_markup_parse_context_unref")
+	core.PuregoRegisterSince(&xNewMarkupParseContext, libs, "g_markup_parse_context_new", "GLIB", "")
+
+	core.PuregoRegisterSince(&xMarkupParseContextEndParse, libs, "g_markup_parse_context_end_parse", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupParseContextFree, libs, "g_markup_parse_context_free", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupParseContextGetElement, libs, "g_markup_parse_context_get_element", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xMarkupParseContextGetElementStack, libs, "g_markup_parse_context_get_element_stack", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xMarkupParseContextGetPosition, libs, "g_markup_parse_context_get_position", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupParseContextGetUserData, libs, "g_markup_parse_context_get_user_data", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xMarkupParseContextParse, libs, "g_markup_parse_context_parse", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupParseContextPop, libs, "g_markup_parse_context_pop", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xMarkupParseContextPush, libs, "g_markup_parse_context_push", "GLIB", "2.18")
+	core.PuregoRegisterSince(&xMarkupParseContextRef, libs, "g_markup_parse_context_ref", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xMarkupParseContextUnref, libs, "g_markup_parse_context_unref", "GLIB", "2.36")
 
 }
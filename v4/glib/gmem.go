@@ -5,12 +5,11 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// A set of functions used to perform memory allocation. The same #GMemVTable must
-// be used for all allocations in the same program; a call to g_mem_set_vtable(),
+// A set of functions used to perform memory allocation. The same [MemVTable] must
+// be used for all allocations in the same program; a call to [MemSetVtable],
 // if it exists, should be prior to any use of GLib.
 //
 // This functions related to this has been deprecated in 2.46, and no longer work.
@@ -40,7 +39,8 @@ func (x *MemVTable) OverrideMalloc(cb func(uint) uintptr) {
 	if cb == nil {
 		x.xMalloc = 0
 	} else {
-		x.xMalloc = purego.NewCallback(func(NBytesVarp uint) uintptr {
+		x.xMalloc = core.NewCallback(func(NBytesVarp uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(NBytesVarp)
 		})
 	}
@@ -53,7 +53,7 @@ func (x *MemVTable) GetMalloc() func(uint) uintptr {
 		return nil
 	}
 	var rawCallback func(NBytesVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xMalloc)
+	core.RegisterFunc(&rawCallback, x.xMalloc)
 	return func(NBytesVar uint) uintptr {
 		return rawCallback(NBytesVar)
 	}
@@ -65,7 +65,8 @@ func (x *MemVTable) OverrideRealloc(cb func(uintptr, uint) uintptr) {
 	if cb == nil {
 		x.xRealloc = 0
 	} else {
-		x.xRealloc = purego.NewCallback(func(MemVarp uintptr, NBytesVarp uint) uintptr {
+		x.xRealloc = core.NewCallback(func(MemVarp uintptr, NBytesVarp uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(MemVarp, NBytesVarp)
 		})
 	}
@@ -78,7 +79,7 @@ func (x *MemVTable) GetRealloc() func(uintptr, uint) uintptr {
 		return nil
 	}
 	var rawCallback func(MemVarp uintptr, NBytesVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xRealloc)
+	core.RegisterFunc(&rawCallback, x.xRealloc)
 	return func(MemVar uintptr, NBytesVar uint) uintptr {
 		return rawCallback(MemVar, NBytesVar)
 	}
@@ -90,7 +91,8 @@ func (x *MemVTable) OverrideFree(cb func(uintptr)) {
 	if cb == nil {
 		x.xFree = 0
 	} else {
-		x.xFree = purego.NewCallback(func(MemVarp uintptr) {
+		x.xFree = core.NewCallback(func(MemVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MemVarp)
 		})
 	}
@@ -103,7 +105,7 @@ func (x *MemVTable) GetFree() func(uintptr) {
 		return nil
 	}
 	var rawCallback func(MemVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFree)
+	core.RegisterFunc(&rawCallback, x.xFree)
 	return func(MemVar uintptr) {
 		rawCallback(MemVar)
 	}
@@ -115,7 +117,8 @@ func (x *MemVTable) OverrideCalloc(cb func(uint, uint) uintptr) {
 	if cb == nil {
 		x.xCalloc = 0
 	} else {
-		x.xCalloc = purego.NewCallback(func(NBlocksVarp uint, NBlockBytesVarp uint) uintptr {
+		x.xCalloc = core.NewCallback(func(NBlocksVarp uint, NBlockBytesVarp uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(NBlocksVarp, NBlockBytesVarp)
 		})
 	}
@@ -128,7 +131,7 @@ func (x *MemVTable) GetCalloc() func(uint, uint) uintptr {
 		return nil
 	}
 	var rawCallback func(NBlocksVarp uint, NBlockBytesVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCalloc)
+	core.RegisterFunc(&rawCallback, x.xCalloc)
 	return func(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 		return rawCallback(NBlocksVar, NBlockBytesVar)
 	}
@@ -140,7 +143,8 @@ func (x *MemVTable) OverrideTryMalloc(cb func(uint) uintptr) {
 	if cb == nil {
 		x.xTryMalloc = 0
 	} else {
-		x.xTryMalloc = purego.NewCallback(func(NBytesVarp uint) uintptr {
+		x.xTryMalloc = core.NewCallback(func(NBytesVarp uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(NBytesVarp)
 		})
 	}
@@ -153,7 +157,7 @@ func (x *MemVTable) GetTryMalloc() func(uint) uintptr {
 		return nil
 	}
 	var rawCallback func(NBytesVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xTryMalloc)
+	core.RegisterFunc(&rawCallback, x.xTryMalloc)
 	return func(NBytesVar uint) uintptr {
 		return rawCallback(NBytesVar)
 	}
@@ -165,7 +169,8 @@ func (x *MemVTable) OverrideTryRealloc(cb func(uintptr, uint) uintptr) {
 	if cb == nil {
 		x.xTryRealloc = 0
 	} else {
-		x.xTryRealloc = purego.NewCallback(func(MemVarp uintptr, NBytesVarp uint) uintptr {
+		x.xTryRealloc = core.NewCallback(func(MemVarp uintptr, NBytesVarp uint) uintptr {
+			defer core.RecoverPanic()
 			return cb(MemVarp, NBytesVarp)
 		})
 	}
@@ -178,7 +183,7 @@ func (x *MemVTable) GetTryRealloc() func(uintptr, uint) uintptr {
 		return nil
 	}
 	var rawCallback func(MemVarp uintptr, NBytesVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xTryRealloc)
+	core.RegisterFunc(&rawCallback, x.xTryRealloc)
 	return func(MemVar uintptr, NBytesVar uint) uintptr {
 		return rawCallback(MemVar, NBytesVar)
 	}
@@ -186,7 +191,7 @@ func (x *MemVTable) GetTryRealloc() func(uintptr, uint) uintptr {
 
 var xAlignedAlloc func(uint, uint, uint) uintptr
 
-// This function is similar to g_malloc(), allocating (@n_blocks * @n_block_bytes)
+// This function is similar to [Malloc], allocating (n_blocks * n_block_bytes)
 // bytes, but care is taken to align the allocated memory to with the given
 // alignment value. Additionally, it will detect possible overflow during
 // multiplication.
@@ -195,7 +200,7 @@ var xAlignedAlloc func(uint, uint, uint) uintptr
 // the program is terminated.
 //
 // Aligned memory allocations returned by this function can only be
-// freed using g_aligned_free_sized() or g_aligned_free().
+// freed using [AlignedFreeSized] or [AlignedFree].
 func AlignedAlloc(NBlocksVar uint, NBlockBytesVar uint, AlignmentVar uint) uintptr {
 
 	cret := xAlignedAlloc(NBlocksVar, NBlockBytesVar, AlignmentVar)
@@ -205,7 +210,7 @@ func AlignedAlloc(NBlocksVar uint, NBlockBytesVar uint, AlignmentVar uint) uintp
 
 var xAlignedAlloc0 func(uint, uint, uint) uintptr
 
-// This function is similar to g_aligned_alloc(), but it will
+// This function is similar to [AlignedAlloc], but it will
 // also clear the allocated memory before returning it.
 func AlignedAlloc0(NBlocksVar uint, NBlockBytesVar uint, AlignmentVar uint) uintptr {
 
@@ -216,7 +221,7 @@ func AlignedAlloc0(NBlocksVar uint, NBlockBytesVar uint, AlignmentVar uint) uint
 
 var xAlignedFree func(uintptr)
 
-// Frees the memory allocated by g_aligned_alloc().
+// Frees the memory allocated by [AlignedAlloc].
 func AlignedFree(MemVar uintptr) {
 
 	xAlignedFree(MemVar)
@@ -225,15 +230,15 @@ func AlignedFree(MemVar uintptr) {
 
 var xAlignedFreeSized func(uintptr, uint, uint)
 
-// Frees the memory pointed to by @mem, assuming it is has the given @size and
-// @alignment.
+// Frees the memory pointed to by mem, assuming it is has the given size and
+// alignment.
 //
-// If @mem is %NULL this is a no-op (and @size is ignored).
+// If mem is nil this is a no-op (and size is ignored).
 //
-// It is an error if @size doesn’t match the size, or @alignment doesn’t match
-// the alignment, passed when @mem was allocated. @size and @alignment are
+// It is an error if size doesn’t match the size, or alignment doesn’t match
+// the alignment, passed when mem was allocated. size and alignment are
 // passed to this function to allow optimizations in the allocator. If you
-// don’t know either of them, use g_aligned_free() instead.
+// don’t know either of them, use [AlignedFree] instead.
 func AlignedFreeSized(MemVar uintptr, AlignmentVar uint, SizeVar uint) {
 
 	xAlignedFreeSized(MemVar, AlignmentVar, SizeVar)
@@ -244,16 +249,16 @@ var xClearPointer func(uintptr, uintptr)
 
 // Clears a reference to a variable.
 //
-// @pp must not be %NULL.
+// pp must not be nil.
 //
-// If the reference is %NULL then this function does nothing.
-// Otherwise, the variable is destroyed using @destroy and the
-// pointer is set to %NULL.
+// If the reference is nil then this function does nothing.
+// Otherwise, the variable is destroyed using destroy and the
+// pointer is set to nil.
 //
 // A macro is also included that allows this function to be used without
 // pointer casts. This will mask any warnings about incompatible function types
-// or calling conventions, so you must ensure that your @destroy function is
-// compatible with being called as [callback@GLib.DestroyNotify] using the
+// or calling conventions, so you must ensure that your destroy function is
+// compatible with being called as [DestroyNotify] using the
 // standard calling convention for the platform that GLib was compiled for;
 // otherwise the program will experience undefined behaviour.
 //
@@ -263,22 +268,19 @@ var xClearPointer func(uintptr, uintptr)
 // `__cdecl` calling convention.
 //
 // The affected functions can be used by wrapping them in a
-// [callback@GLib.DestroyNotify] that is declared with the standard calling
+// [DestroyNotify] that is declared with the standard calling
 // convention:
 //
-// ```c
-// // Wrapper needed to avoid mismatched calling conventions on Windows
-// static void
-// destroy_sync (void *sync)
-//
+//	// Wrapper needed to avoid mismatched calling conventions on Windows
+//	static void
+//	destroy_sync (void *sync)
 //	{
 //	  glDeleteSync (sync);
 //	}
 //
-// // …
+//	// …
 //
-// g_clear_pointer (&amp;sync, destroy_sync);
-// ```
+//	g_clear_pointer (&sync, destroy_sync);
 func ClearPointer(PpVar uintptr, DestroyVar *DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -288,11 +290,12 @@ func ClearPointer(PpVar uintptr, DestroyVar *DestroyNotify) {
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -302,9 +305,9 @@ func ClearPointer(PpVar uintptr, DestroyVar *DestroyNotify) {
 
 var xFree func(uintptr)
 
-// Frees the memory pointed to by @mem.
+// Frees the memory pointed to by mem.
 //
-// If you know the allocated size of @mem, calling g_free_sized() may be faster,
+// If you know the allocated size of mem, calling [FreeSized] may be faster,
 // depending on the libc implementation in use.
 //
 // Starting from GLib 2.78, this may happen automatically in case a GCC
@@ -313,8 +316,8 @@ var xFree func(uintptr)
 // `__builtin_object_size()`](https://gcc.gnu.org/onlinedocs/gcc/Object-Size-Checking.html)
 // to understand its caveats).
 //
-// If @mem is %NULL it simply returns, so there is no need to check @mem
-// against %NULL before calling this function.
+// If mem is nil it simply returns, so there is no need to check mem
+// against nil before calling this function.
 func Free(MemVar uintptr) {
 
 	xFree(MemVar)
@@ -323,16 +326,16 @@ func Free(MemVar uintptr) {
 
 var xFreeSized func(uintptr, uint)
 
-// Frees the memory pointed to by @mem, assuming it is has the given @size.
+// Frees the memory pointed to by mem, assuming it is has the given size.
 //
-// If @mem is %NULL this is a no-op (and @size is ignored).
+// If mem is nil this is a no-op (and size is ignored).
 //
-// It is an error if @size doesn’t match the size passed when @mem was
-// allocated. @size is passed to this function to allow optimizations in the
-// allocator. If you don’t know the allocation size, use g_free() instead.
+// It is an error if size doesn’t match the size passed when mem was
+// allocated. size is passed to this function to allow optimizations in the
+// allocator. If you don’t know the allocation size, use [Free] instead.
 //
 // In case a GCC compatible compiler is used, this function may be used
-// automatically via g_free() if the allocated size is known at compile time,
+// automatically via [Free] if the allocated size is known at compile time,
 // since GLib 2.78.
 func FreeSized(MemVar uintptr, SizeVar uint) {
 
@@ -342,8 +345,8 @@ func FreeSized(MemVar uintptr, SizeVar uint) {
 
 var xMalloc func(uint) uintptr
 
-// Allocates @n_bytes bytes of memory.
-// If @n_bytes is 0 it returns %NULL.
+// Allocates n_bytes bytes of memory.
+// If n_bytes is 0 it returns nil.
 //
 // If the allocation fails (because the system is out of memory),
 // the program is terminated.
@@ -356,8 +359,8 @@ func Malloc(NBytesVar uint) uintptr {
 
 var xMalloc0 func(uint) uintptr
 
-// Allocates @n_bytes bytes of memory, initialized to 0's.
-// If @n_bytes is 0 it returns %NULL.
+// Allocates n_bytes bytes of memory, initialized to 0's.
+// If n_bytes is 0 it returns nil.
 //
 // If the allocation fails (because the system is out of memory),
 // the program is terminated.
@@ -370,7 +373,7 @@ func Malloc0(NBytesVar uint) uintptr {
 
 var xMalloc0N func(uint, uint) uintptr
 
-// This function is similar to g_malloc0(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [Malloc0], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 //
 // If the allocation fails (because the system is out of memory),
@@ -384,7 +387,7 @@ func Malloc0N(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
 var xMallocN func(uint, uint) uintptr
 
-// This function is similar to g_malloc(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [Malloc], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 //
 // If the allocation fails (because the system is out of memory),
@@ -396,50 +399,13 @@ func MallocN(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 	return cret
 }
 
-var xMemIsSystemMalloc func() bool
-
-// Checks whether the allocator used by g_malloc() is the system's
-// malloc implementation. If it returns %TRUE memory allocated with
-// malloc() can be used interchangeably with memory allocated using g_malloc().
-// This function is useful for avoiding an extra copy of allocated memory returned
-// by a non-GLib-based API.
-func MemIsSystemMalloc() bool {
-
-	cret := xMemIsSystemMalloc()
-
-	return cret
-}
-
-var xMemProfile func()
-
-// GLib used to support some tools for memory profiling, but this
-// no longer works. There are many other useful tools for memory
-// profiling these days which can be used instead.
-func MemProfile() {
-
-	xMemProfile()
-
-}
-
-var xMemSetVtable func(*MemVTable)
-
-// This function used to let you override the memory allocation function.
-// However, its use was incompatible with the use of global constructors
-// in GLib and GIO, because those use the GLib allocators before main is
-// reached. Therefore this function is now deprecated and is just a stub.
-func MemSetVtable(VtableVar *MemVTable) {
-
-	xMemSetVtable(VtableVar)
-
-}
-
 var xRealloc func(uintptr, uint) uintptr
 
-// Reallocates the memory pointed to by @mem, so that it now has space for
-// @n_bytes bytes of memory. It returns the new address of the memory, which may
-// have been moved. @mem may be %NULL, in which case it's considered to
-// have zero-length. @n_bytes may be 0, in which case %NULL will be returned
-// and @mem will be freed unless it is %NULL.
+// Reallocates the memory pointed to by mem, so that it now has space for
+// n_bytes bytes of memory. It returns the new address of the memory, which may
+// have been moved. mem may be nil, in which case it's considered to
+// have zero-length. n_bytes may be 0, in which case nil will be returned
+// and mem will be freed unless it is nil.
 //
 // If the allocation fails (because the system is out of memory),
 // the program is terminated.
@@ -452,7 +418,7 @@ func Realloc(MemVar uintptr, NBytesVar uint) uintptr {
 
 var xReallocN func(uintptr, uint, uint) uintptr
 
-// This function is similar to g_realloc(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [Realloc], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 //
 // If the allocation fails (because the system is out of memory),
@@ -466,8 +432,8 @@ func ReallocN(MemVar uintptr, NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
 var xTryMalloc func(uint) uintptr
 
-// Attempts to allocate @n_bytes, and returns %NULL on failure.
-// Contrast with g_malloc(), which aborts the program on failure.
+// Attempts to allocate n_bytes, and returns nil on failure.
+// Contrast with [Malloc], which aborts the program on failure.
 func TryMalloc(NBytesVar uint) uintptr {
 
 	cret := xTryMalloc(NBytesVar)
@@ -477,8 +443,8 @@ func TryMalloc(NBytesVar uint) uintptr {
 
 var xTryMalloc0 func(uint) uintptr
 
-// Attempts to allocate @n_bytes, initialized to 0's, and returns %NULL on
-// failure. Contrast with g_malloc0(), which aborts the program on failure.
+// Attempts to allocate n_bytes, initialized to 0's, and returns nil on
+// failure. Contrast with [Malloc0], which aborts the program on failure.
 func TryMalloc0(NBytesVar uint) uintptr {
 
 	cret := xTryMalloc0(NBytesVar)
@@ -488,7 +454,7 @@ func TryMalloc0(NBytesVar uint) uintptr {
 
 var xTryMalloc0N func(uint, uint) uintptr
 
-// This function is similar to g_try_malloc0(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [TryMalloc0], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 func TryMalloc0N(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
@@ -499,7 +465,7 @@ func TryMalloc0N(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
 var xTryMallocN func(uint, uint) uintptr
 
-// This function is similar to g_try_malloc(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [TryMalloc], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 func TryMallocN(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
@@ -510,11 +476,11 @@ func TryMallocN(NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
 var xTryRealloc func(uintptr, uint) uintptr
 
-// Attempts to realloc @mem to a new size, @n_bytes, and returns %NULL
-// on failure. Contrast with g_realloc(), which aborts the program
+// Attempts to realloc mem to a new size, n_bytes, and returns nil
+// on failure. Contrast with [Realloc], which aborts the program
 // on failure.
 //
-// If @mem is %NULL, behaves the same as g_try_malloc().
+// If mem is nil, behaves the same as [TryMalloc].
 func TryRealloc(MemVar uintptr, NBytesVar uint) uintptr {
 
 	cret := xTryRealloc(MemVar, NBytesVar)
@@ -524,7 +490,7 @@ func TryRealloc(MemVar uintptr, NBytesVar uint) uintptr {
 
 var xTryReallocN func(uintptr, uint, uint) uintptr
 
-// This function is similar to g_try_realloc(), allocating (@n_blocks * @n_block_bytes) bytes,
+// This function is similar to [TryRealloc], allocating (n_blocks * n_block_bytes) bytes,
 // but care is taken to detect possible overflow during multiplication.
 func TryReallocN(MemVar uintptr, NBlocksVar uint, NBlockBytesVar uint) uintptr {
 
@@ -538,34 +504,31 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAlignedAlloc, libs, "g_aligned_alloc")
-	core.PuregoSafeRegister(&xAlignedAlloc0, libs, "g_aligned_alloc0")
-	core.PuregoSafeRegister(&xAlignedFree, libs, "g_aligned_free")
-	core.PuregoSafeRegister(&xAlignedFreeSized, libs, "g_aligned_free_sized")
-	core.PuregoSafeRegister(&xClearPointer, libs, "g_clear_pointer")
-	core.PuregoSafeRegister(&xFree, libs, "g_free")
-	core.PuregoSafeRegister(&xFreeSized, libs, "g_free_sized")
-	core.PuregoSafeRegister(&xMalloc, libs, "g_malloc")
-	core.PuregoSafeRegister(&xMalloc0, libs, "g_malloc0")
-	core.PuregoSafeRegister(&xMalloc0N, libs, "g_malloc0_n")
-	core.PuregoSafeRegister(&xMallocN, libs, "g_malloc_n")
-	core.PuregoSafeRegister(&xMemIsSystemMalloc, libs, "g_mem_is_system_malloc")
-	core.PuregoSafeRegister(&xMemProfile, libs, "g_mem_profile")
-	core.PuregoSafeRegister(&xMemSetVtable, libs, "g_mem_set_vtable")
-	core.PuregoSafeRegister(&xRealloc, libs, "g_realloc")
-	core.PuregoSafeRegister(&xReallocN, libs, "g_realloc_n")
-	core.PuregoSafeRegister(&xTryMalloc, libs, "g_try_malloc")
-	core.PuregoSafeRegister(&xTryMalloc0, libs, "g_try_malloc0")
-	core.PuregoSafeRegister(&xTryMalloc0N, libs, "g_try_malloc0_n")
-	core.PuregoSafeRegister(&xTryMallocN, libs, "g_try_malloc_n")
-	core.PuregoSafeRegister(&xTryRealloc, libs, "g_try_realloc")
-	core.PuregoSafeRegister(&xTryReallocN, libs, "g_try_realloc_n")
+	core.PuregoRegisterSince(&xAlignedAlloc, libs, "g_aligned_alloc", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xAlignedAlloc0, libs, "g_aligned_alloc0", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xAlignedFree, libs, "g_aligned_free", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xAlignedFreeSized, libs, "g_aligned_free_sized", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xClearPointer, libs, "g_clear_pointer", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xFree, libs, "g_free", "GLIB", "")
+	core.PuregoRegisterSince(&xFreeSized, libs, "g_free_sized", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xMalloc, libs, "g_malloc", "GLIB", "")
+	core.PuregoRegisterSince(&xMalloc0, libs, "g_malloc0", "GLIB", "")
+	core.PuregoRegisterSince(&xMalloc0N, libs, "g_malloc0_n", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xMallocN, libs, "g_malloc_n", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xRealloc, libs, "g_realloc", "GLIB", "")
+	core.PuregoRegisterSince(&xReallocN, libs, "g_realloc_n", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xTryMalloc, libs, "g_try_malloc", "GLIB", "")
+	core.PuregoRegisterSince(&xTryMalloc0, libs, "g_try_malloc0", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xTryMalloc0N, libs, "g_try_malloc0_n", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xTryMallocN, libs, "g_try_malloc_n", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xTryRealloc, libs, "g_try_realloc", "GLIB", "")
+	core.PuregoRegisterSince(&xTryReallocN, libs, "g_try_realloc_n", "GLIB", "2.24")
 
 }
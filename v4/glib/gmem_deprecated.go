@@ -0,0 +1,71 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xMemIsSystemMalloc func() bool
+
+// Checks whether the allocator used by [Malloc] is the system's
+// malloc implementation. If it returns true memory allocated with
+// malloc() can be used interchangeably with memory allocated using [Malloc].
+// This function is useful for avoiding an extra copy of allocated memory returned
+// by a non-GLib-based API.
+//
+// Deprecated: since 2.46. GLib always uses the system malloc, so this function always
+// returns true.
+func MemIsSystemMalloc() bool {
+
+	cret := xMemIsSystemMalloc()
+
+	return cret
+}
+
+var xMemProfile func()
+
+// GLib used to support some tools for memory profiling, but this
+// no longer works. There are many other useful tools for memory
+// profiling these days which can be used instead.
+//
+// Deprecated: since 2.46. Use other memory profiling tools instead
+func MemProfile() {
+
+	xMemProfile()
+
+}
+
+var xMemSetVtable func(*MemVTable)
+
+// This function used to let you override the memory allocation function.
+// However, its use was incompatible with the use of global constructors
+// in GLib and GIO, because those use the GLib allocators before main is
+// reached. Therefore this function is now deprecated and is just a stub.
+//
+// Deprecated: since 2.46. This function now does nothing. Use other memory
+// profiling tools instead
+func MemSetVtable(VtableVar *MemVTable) {
+
+	xMemSetVtable(VtableVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xMemIsSystemMalloc, libs, "g_mem_is_system_malloc", "GLIB", "")
+	core.PuregoRegisterSince(&xMemProfile, libs, "g_mem_profile", "GLIB", "")
+	core.PuregoRegisterSince(&xMemSetVtable, libs, "g_mem_set_vtable", "GLIB", "")
+
+}
@@ -2,17 +2,18 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 // Specifies the prototype of log handler functions.
 //
-// The default log handler, [func@GLib.log_default_handler], automatically appends a
-// new-line character to @message when printing it. It is advised that any
+// The default log handler, [LogDefaultHandler], automatically appends a
+// new-line character to message when printing it. It is advised that any
 // custom log handler functions behave similarly, so that logging calls in user
 // code do not need modifying to add a new-line character to the message if the
 // log handler is changed.
@@ -21,26 +22,26 @@ import (
 // application domain.
 //
 // This is not used if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 type LogFunc func(string, LogLevelFlags, string, uintptr)
 
 // Writer function for log entries. A log entry is a collection of one or more
-// #GLogFields, using the standard [field names from journal
+// GLogFields, using the standard [field names from journal
 // specification](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html).
-// See g_log_structured() for more information.
+// See [LogStructured] for more information.
 //
 // Writer functions must ignore fields which they do not recognise, unless they
 // can write arbitrary binary output, as field values may be arbitrary binary.
 //
-// @log_level is guaranteed to be included in @fields as the `PRIORITY` field,
+// log_level is guaranteed to be included in fields as the `PRIORITY` field,
 // but is provided separately for convenience of deciding whether or where to
 // output the log entry.
 //
-// Writer functions should return %G_LOG_WRITER_HANDLED if they handled the log
+// Writer functions should return [GLogWriterHandledValue] if they handled the log
 // message successfully or if they deliberately ignored it. If there was an
 // error handling the message (for example, if the writer function is meant to
 // send messages to a remote logging server and there is a network error), it
-// should return %G_LOG_WRITER_UNHANDLED. This allows writer functions to be
+// should return [GLogWriterUnhandledValue]. This allows writer functions to be
 // chained and fall back to simpler handlers in case of failure.
 type LogWriterFunc func(LogLevelFlags, []LogField, uint, uintptr) LogWriterOutput
 
@@ -49,11 +50,11 @@ type LogWriterFunc func(LogLevelFlags, []LogField, uint, uintptr) LogWriterOutpu
 type PrintFunc func(string)
 
 // Structure representing a single field in a structured log entry. See
-// g_log_structured() for details.
+// [LogStructured] for details.
 //
 // Log fields may contain arbitrary values, including binary with embedded nul
 // bytes. If the field contains a string, the string must be UTF-8 encoded and
-// have a trailing nul byte. Otherwise, @length must be set to a non-negative
+// have a trailing nul byte. Otherwise, length must be set to a non-negative
 // value.
 type LogField struct {
 	_ structs.HostLayout
@@ -70,7 +71,7 @@ func (x *LogField) GoPointer() uintptr {
 }
 
 const (
-	// Defines the log domain. See [Log Domains](#log-domains).
+	// Defines the log domain. See Log Domains.
 	//
 	// Libraries should define this so that any messages
 	// which they log can be differentiated from messages from other
@@ -81,25 +82,24 @@ const (
 	// application or library name, optionally followed by a hyphen and a sub-domain
 	// name. For example, `bloatpad` or `bloatpad-io`.
 	//
-	// If undefined, it defaults to the default %NULL (or `""`) log domain; this is
+	// If undefined, it defaults to the default nil (or `""`) log domain; this is
 	// not advisable, as it cannot be filtered against using the `G_MESSAGES_DEBUG`
 	// environment variable.
 	//
 	// For example, GTK uses this in its `Makefile.am`:
-	// |[
-	// AM_CPPFLAGS = -DG_LOG_DOMAIN=\"Gtk\"
-	// ]|
 	//
-	// Applications can choose to leave it as the default %NULL (or `""`)
+	// 	AM_CPPFLAGS = -DG_LOG_DOMAIN=\"Gtk\"
+	//
+	// Applications can choose to leave it as the default nil (or `""`)
 	// domain. However, defining the domain offers the same advantages as
 	// above.
 	LOG_DOMAIN byte = 0
 	// GLib log levels that are considered fatal by default.
 	//
 	// This is not used if structured logging is enabled; see
-	// [Using Structured Logging](logging.html#using-structured-logging).
+	// Using Structured Logging.
 	LOG_FATAL_MASK int = 5
-	// Log levels below `1&lt;&lt;G_LOG_LEVEL_USER_SHIFT` are used by GLib.
+	// Log levels below `1<<G_LOG_LEVEL_USER_SHIFT` are used by GLib.
 	// Higher bits can be used for user-defined log levels.
 	LOG_LEVEL_USER_SHIFT int = 8
 )
@@ -107,8 +107,8 @@ const (
 // Flags specifying the level of log messages.
 //
 // It is possible to change how GLib treats messages of the various
-// levels using [func@GLib.log_set_handler] and [func@GLib.log_set_fatal_mask].
-type LogLevelFlags int
+// levels using [LogSetHandler] and [LogSetFatalMask].
+type LogLevelFlags uint32
 
 const (
 
@@ -116,31 +116,95 @@ const (
 	GLogFlagRecursionValue LogLevelFlags = 1
 	// internal flag
 	GLogFlagFatalValue LogLevelFlags = 2
-	// log level for errors, see [func@GLib.error].
-	//   This level is also used for messages produced by [func@GLib.assert].
+	// log level for errors, see error.
+	//   This level is also used for messages produced by assert.
 	GLogLevelErrorValue LogLevelFlags = 4
 	// log level for critical warning messages, see
-	//   [func@GLib.critical]. This level is also used for messages produced by
-	//   [func@GLib.return_if_fail] and [func@GLib.return_val_if_fail].
+	//   critical. This level is also used for messages produced by
+	//   return_if_fail and return_val_if_fail.
 	GLogLevelCriticalValue LogLevelFlags = 8
-	// log level for warnings, see [func@GLib.warning]
+	// log level for warnings, see warning
 	GLogLevelWarningValue LogLevelFlags = 16
-	// log level for messages, see [func@GLib.message]
+	// log level for messages, see message
 	GLogLevelMessageValue LogLevelFlags = 32
-	// log level for informational messages, see [func@GLib.info]
+	// log level for informational messages, see info
 	GLogLevelInfoValue LogLevelFlags = 64
-	// log level for debug messages, see [func@GLib.debug]
+	// log level for debug messages, see debug
 	GLogLevelDebugValue LogLevelFlags = 128
 	// a mask including all log levels
-	GLogLevelMaskValue LogLevelFlags = -4
+	GLogLevelMaskValue LogLevelFlags = 4294967292
 )
 
-// Return values from #GLogWriterFuncs to indicate whether the given log entry
+// Has reports whether all bits of flags are set in x
+func (x LogLevelFlags) Has(flags LogLevelFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x LogLevelFlags) Set(flags LogLevelFlags) LogLevelFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x LogLevelFlags) Clear(flags LogLevelFlags) LogLevelFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x LogLevelFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GLogFlagRecursionValue != 0 && x&GLogFlagRecursionValue == GLogFlagRecursionValue {
+		names = append(names, "G_LOG_FLAG_RECURSION")
+		rest &^= GLogFlagRecursionValue
+	}
+	if rest&GLogFlagFatalValue != 0 && x&GLogFlagFatalValue == GLogFlagFatalValue {
+		names = append(names, "G_LOG_FLAG_FATAL")
+		rest &^= GLogFlagFatalValue
+	}
+	if rest&GLogLevelErrorValue != 0 && x&GLogLevelErrorValue == GLogLevelErrorValue {
+		names = append(names, "G_LOG_LEVEL_ERROR")
+		rest &^= GLogLevelErrorValue
+	}
+	if rest&GLogLevelCriticalValue != 0 && x&GLogLevelCriticalValue == GLogLevelCriticalValue {
+		names = append(names, "G_LOG_LEVEL_CRITICAL")
+		rest &^= GLogLevelCriticalValue
+	}
+	if rest&GLogLevelWarningValue != 0 && x&GLogLevelWarningValue == GLogLevelWarningValue {
+		names = append(names, "G_LOG_LEVEL_WARNING")
+		rest &^= GLogLevelWarningValue
+	}
+	if rest&GLogLevelMessageValue != 0 && x&GLogLevelMessageValue == GLogLevelMessageValue {
+		names = append(names, "G_LOG_LEVEL_MESSAGE")
+		rest &^= GLogLevelMessageValue
+	}
+	if rest&GLogLevelInfoValue != 0 && x&GLogLevelInfoValue == GLogLevelInfoValue {
+		names = append(names, "G_LOG_LEVEL_INFO")
+		rest &^= GLogLevelInfoValue
+	}
+	if rest&GLogLevelDebugValue != 0 && x&GLogLevelDebugValue == GLogLevelDebugValue {
+		names = append(names, "G_LOG_LEVEL_DEBUG")
+		rest &^= GLogLevelDebugValue
+	}
+	if rest&GLogLevelMaskValue != 0 && x&GLogLevelMaskValue == GLogLevelMaskValue {
+		names = append(names, "G_LOG_LEVEL_MASK")
+		rest &^= GLogLevelMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Return values from GLogWriterFuncs to indicate whether the given log entry
 // was successfully handled by the writer, or whether there was an error in
 // handling it (and hence a fallback writer should be used).
 //
-// If a #GLogWriterFunc ignores a log entry, it should return
-// %G_LOG_WRITER_HANDLED.
+// If a [LogWriterFunc] ignores a log entry, it should return
+// [GLogWriterHandledValue].
 type LogWriterOutput int
 
 const (
@@ -151,6 +215,39 @@ const (
 	GLogWriterUnhandledValue LogWriterOutput = 0
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LogWriterOutput) String() string {
+	switch x {
+	case GLogWriterHandledValue:
+		return "G_LOG_WRITER_HANDLED"
+	case GLogWriterUnhandledValue:
+		return "G_LOG_WRITER_UNHANDLED"
+	}
+	return fmt.Sprintf("LogWriterOutput(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LogWriterOutput) Nick() string {
+	switch x {
+	case GLogWriterHandledValue:
+		return "handled"
+	case GLogWriterUnhandledValue:
+		return "unhandled"
+	}
+	return ""
+}
+
+// LogWriterOutputFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LogWriterOutputFromString(s string) (LogWriterOutput, bool) {
+	switch s {
+	case "G_LOG_WRITER_HANDLED", "handled":
+		return GLogWriterHandledValue, true
+	case "G_LOG_WRITER_UNHANDLED", "unhandled":
+		return GLogWriterUnhandledValue, true
+	}
+	return 0, false
+}
+
 var xAssertWarning func(string, string, int, string, string)
 
 func AssertWarning(LogDomainVar string, FileVar string, LineVar int, PrettyFunctionVar string, ExpressionVar string) {
@@ -163,35 +260,37 @@ var xLog func(uintptr, LogLevelFlags, string, ...interface{})
 
 // Logs an error or debugging message.
 //
-// If the log level has been set as fatal, [func@GLib.BREAKPOINT] is called
-// to terminate the program. See the documentation for [func@GLib.BREAKPOINT] for
+// If the log level has been set as fatal, BREAKPOINT is called
+// to terminate the program. See the documentation for BREAKPOINT for
 // details of the debugging options this provides.
 //
-// If [func@GLib.log_default_handler] is used as the log handler function, a new-line
+// If [LogDefaultHandler] is used as the log handler function, a new-line
 // character will automatically be appended to @..., and need not be entered
 // manually.
 //
-// If [structured logging is enabled](logging.html#using-structured-logging) this will
-// output via the structured log writer function (see [func@GLib.log_set_writer_func]).
+// If structured logging is enabled this will
+// output via the structured log writer function (see [LogSetWriterFunc]).
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Log(LogDomainVar *string, LogLevelVar LogLevelFlags, FormatVar string, varArgs ...interface{}) {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
 	defer core.GFreeNullable(LogDomainVarPtr)
 
-	xLog(LogDomainVarPtr, LogLevelVar, FormatVar, varArgs...)
+	xLog(LogDomainVarPtr, LogLevelVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xLogDefaultHandler func(uintptr, LogLevelFlags, uintptr, uintptr)
 
-// The default log handler set up by GLib; [func@GLib.log_set_default_handler]
+// The default log handler set up by GLib; [LogSetDefaultHandler]
 // allows to install an alternate default log handler.
 //
 // This is used if no log handler has been set for the particular log
 // domain and log level combination. It outputs the message to `stderr`
-// or `stdout` and if the log level is fatal it calls [func@GLib.BREAKPOINT]. It automatically
+// or `stdout` and if the log level is fatal it calls BREAKPOINT. It automatically
 // prints a new-line character after the message, so one does not need to be
-// manually included in @message.
+// manually included in message.
 //
 // The behavior of this log handler can be influenced by a number of
 // environment variables:
@@ -202,19 +301,19 @@ var xLogDefaultHandler func(uintptr, LogLevelFlags, uintptr, uintptr)
 //   - `G_MESSAGES_DEBUG`: A space-separated list of log domains for
 //     which debug and informational messages are printed. By default
 //     these messages are not printed. If you need to set the allowed
-//     domains at runtime, use [func@GLib.log_writer_default_set_debug_domains].
+//     domains at runtime, use [LogWriterDefaultSetDebugDomains].
 //   - `DEBUG_INVOCATION`: If set to `1`, this is equivalent to
 //     `G_MESSAGES_DEBUG=all`. `DEBUG_INVOCATION` is a standard environment
 //     variable set by systemd to prompt debug output. (Since: 2.84)
 //
-// `stderr` is used for levels [flags@GLib.LogLevelFlags.LEVEL_ERROR],
-// [flags@GLib.LogLevelFlags.LEVEL_CRITICAL], [flags@GLib.LogLevelFlags.LEVEL_WARNING] and
-// [flags@GLib.LogLevelFlags.LEVEL_MESSAGE]. `stdout` is used for
+// `stderr` is used for levels LogLevelFlags.LEVEL_ERROR,
+// LogLevelFlags.LEVEL_CRITICAL, LogLevelFlags.LEVEL_WARNING and
+// LogLevelFlags.LEVEL_MESSAGE. `stdout` is used for
 // the rest, unless `stderr` was requested by
-// [func@GLib.log_writer_default_set_use_stderr].
+// [LogWriterDefaultSetUseStderr].
 //
 // This has no effect if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 func LogDefaultHandler(LogDomainVar *string, LogLevelVar LogLevelFlags, MessageVar *string, UnusedDataVar uintptr) {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
@@ -233,22 +332,19 @@ var xLogGetAlwaysFatal func() LogLevelFlags
 //
 // This is mostly used by custom log writers to make fatal messages
 // (`fatal-warnings`, `fatal-criticals`) work as expected, when using the
-// `G_DEBUG` environment variable (see [Running GLib Applications](running.html)).
+// `G_DEBUG` environment variable (see Running GLib Applications).
 //
 // An example usage is shown below:
 //
-// ```c
-// static GLogWriterOutput
-// my_custom_log_writer_fn (GLogLevelFlags log_level,
-//
-//	const GLogField *fields,
-//	gsize n_fields,
-//	gpointer user_data)
-//
-// {
+//	static GLogWriterOutput
+//	my_custom_log_writer_fn (GLogLevelFlags log_level,
+//	                         const GLogField *fields,
+//	                         gsize n_fields,
+//	                         gpointer user_data)
+//	{
 //
 //	   // abort if the message was fatal
-//	   if (log_level &amp; g_log_get_always_fatal ())
+//	   if (log_level & g_log_get_always_fatal ())
 //	     g_abort ();
 //
 //	   // custom log handling code
@@ -258,8 +354,6 @@ var xLogGetAlwaysFatal func() LogLevelFlags
 //	   // success
 //	   return G_LOG_WRITER_HANDLED;
 //	}
-//
-// ```
 func LogGetAlwaysFatal() LogLevelFlags {
 
 	cret := xLogGetAlwaysFatal()
@@ -271,13 +365,13 @@ var xLogGetDebugEnabled func() bool
 
 // Return whether debug output from the GLib logging system is enabled.
 //
-// Note that this should not be used to conditionalise calls to [func@GLib.debug] or
-// other logging functions; it should only be used from [type@GLib.LogWriterFunc]
+// Note that this should not be used to conditionalise calls to debug or
+// other logging functions; it should only be used from [LogWriterFunc]
 // implementations.
 //
 // Note also that the value of this does not depend on `G_MESSAGES_DEBUG`, nor
-// `DEBUG_INVOCATION`, nor [func@GLib.log_writer_default_set_debug_domains]; see
-// the docs for [func@GLib.log_set_debug_enabled].
+// `DEBUG_INVOCATION`, nor [LogWriterDefaultSetDebugDomains]; see
+// the docs for [LogSetDebugEnabled].
 func LogGetDebugEnabled() bool {
 
 	cret := xLogGetDebugEnabled()
@@ -290,7 +384,7 @@ var xLogRemoveHandler func(string, uint)
 // Removes the log handler.
 //
 // This has no effect if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 func LogRemoveHandler(LogDomainVar string, HandlerIdVar uint) {
 
 	xLogRemoveHandler(LogDomainVar, HandlerIdVar)
@@ -303,19 +397,19 @@ var xLogSetAlwaysFatal func(LogLevelFlags) LogLevelFlags
 //
 // When a message with any of these levels is logged the program terminates.
 // You can only set the levels defined by GLib to be fatal.
-// [flags@GLib.LogLevelFlags.LEVEL_ERROR] is always fatal.
+// LogLevelFlags.LEVEL_ERROR is always fatal.
 //
 // You can also make some message levels fatal at runtime by setting
 // the `G_DEBUG` environment variable (see
-// [Running GLib Applications](running.html)).
+// Running GLib Applications).
 //
 // Libraries should not call this function, as it affects all messages logged
 // by a process, including those from other libraries.
 //
-// Structured log messages (using [func@GLib.log_structured] and
-// [func@GLib.log_structured_array]) are fatal only if the default log writer is used;
+// Structured log messages (using [LogStructured] and
+// [LogStructuredArray]) are fatal only if the default log writer is used;
 // otherwise it is up to the writer function to determine which log messages
-// are fatal. See [Using Structured Logging](logging.html#using-structured-logging).
+// are fatal. See Using Structured Logging.
 func LogSetAlwaysFatal(FatalMaskVar LogLevelFlags) LogLevelFlags {
 
 	cret := xLogSetAlwaysFatal(FatalMaskVar)
@@ -328,7 +422,7 @@ var xLogSetDebugEnabled func(bool)
 // Enable or disable debug output from the GLib logging system for all domains.
 //
 // This value interacts disjunctively with `G_MESSAGES_DEBUG`, `DEBUG_INVOCATION` and
-// [func@GLib.log_writer_default_set_debug_domains] — if any of them would allow
+// [LogWriterDefaultSetDebugDomains] — if any of them would allow
 // a debug message to be outputted, it will be.
 //
 // Note that this should not be used from within library code to enable debug
@@ -345,10 +439,10 @@ var xLogSetDefaultHandler func(uintptr, uintptr) uintptr
 // log handler has been set for the particular log domain
 // and log level combination.
 //
-// By default, GLib uses [func@GLib.log_default_handler] as default log handler.
+// By default, GLib uses [LogDefaultHandler] as default log handler.
 //
 // This has no effect if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 func LogSetDefaultHandler(LogFuncVar *LogFunc, UserDataVar uintptr) uintptr {
 
 	var LogFuncVarRef uintptr
@@ -358,11 +452,12 @@ func LogSetDefaultHandler(LogFuncVar *LogFunc, UserDataVar uintptr) uintptr {
 			LogFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 LogLevelFlags, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *LogFuncVar
 				cbFn(core.GoString(arg0), arg1, core.GoString(arg2), arg3)
 			}
-			LogFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
+			LogFuncVarRef = core.NewCallback(fcb)
+			LogFuncVarRef = SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
 		}
 	}
 
@@ -375,18 +470,18 @@ var xLogSetFatalMask func(string, LogLevelFlags) LogLevelFlags
 
 // Sets the log levels which are fatal in the given domain.
 //
-// [flags@GLib.LogLevelFlags.LEVEL_ERROR] is always fatal.
+// LogLevelFlags.LEVEL_ERROR is always fatal.
 //
-// This has no effect on structured log messages (using [func@GLib.log_structured] or
-// [func@GLib.log_structured_array]). To change the fatal behaviour for specific log
+// This has no effect on structured log messages (using [LogStructured] or
+// [LogStructuredArray]). To change the fatal behaviour for specific log
 // messages, programs must install a custom log writer function using
-// [func@GLib.log_set_writer_func]. See
-// [Using Structured Logging](logging.html#using-structured-logging).
+// [LogSetWriterFunc]. See
+// Using Structured Logging.
 //
 // This function is mostly intended to be used with
-// [flags@GLib.LogLevelFlags.LEVEL_CRITICAL].  You should typically not set
-// [flags@GLib.LogLevelFlags.LEVEL_WARNING], [flags@GLib.LogLevelFlags.LEVEL_MESSAGE], [flags@GLib.LogLevelFlags.LEVEL_INFO] or
-// [flags@GLib.LogLevelFlags.LEVEL_DEBUG] as fatal except inside of test programs.
+// LogLevelFlags.LEVEL_CRITICAL.  You should typically not set
+// LogLevelFlags.LEVEL_WARNING, LogLevelFlags.LEVEL_MESSAGE, LogLevelFlags.LEVEL_INFO or
+// LogLevelFlags.LEVEL_DEBUG as fatal except inside of test programs.
 func LogSetFatalMask(LogDomainVar string, FatalMaskVar LogLevelFlags) LogLevelFlags {
 
 	cret := xLogSetFatalMask(LogDomainVar, FatalMaskVar)
@@ -398,16 +493,16 @@ var xLogSetHandler func(uintptr, LogLevelFlags, uintptr, uintptr) uint
 
 // Sets the log handler for a domain and a set of log levels.
 //
-// To handle fatal and recursive messages the @log_levels parameter
-// must be combined with the [flags@GLib.LogLevelFlags.FLAG_FATAL] and [flags@GLib.LogLevelFlags.FLAG_RECURSION]
+// To handle fatal and recursive messages the log_levels parameter
+// must be combined with the LogLevelFlags.FLAG_FATAL and LogLevelFlags.FLAG_RECURSION
 // bit flags.
 //
-// Note that since the [flags@GLib.LogLevelFlags.LEVEL_ERROR] log level is always fatal, if
+// Note that since the LogLevelFlags.LEVEL_ERROR log level is always fatal, if
 // you want to set a handler for this log level you must combine it with
-// [flags@GLib.LogLevelFlags.FLAG_FATAL].
+// LogLevelFlags.FLAG_FATAL.
 //
 // This has no effect if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 //
 // The `log_domain` parameter can be set to `NULL` or an empty string to use the default
 // application domain.
@@ -415,30 +510,20 @@ var xLogSetHandler func(uintptr, LogLevelFlags, uintptr, uintptr) uint
 // Here is an example for adding a log handler for all warning messages
 // in the default domain:
 //
-// ```c
-// g_log_set_handler (NULL, G_LOG_LEVEL_WARNING | G_LOG_FLAG_FATAL
-//
-//	| G_LOG_FLAG_RECURSION, my_log_handler, NULL);
-//
-// ```
+//	g_log_set_handler (NULL, G_LOG_LEVEL_WARNING | G_LOG_FLAG_FATAL
+//	                   | G_LOG_FLAG_RECURSION, my_log_handler, NULL);
 //
 // This example adds a log handler for all critical messages from GTK:
 //
-// ```c
-// g_log_set_handler ("Gtk", G_LOG_LEVEL_CRITICAL | G_LOG_FLAG_FATAL
-//
-//	| G_LOG_FLAG_RECURSION, my_log_handler, NULL);
-//
-// ```
+//	g_log_set_handler ("Gtk", G_LOG_LEVEL_CRITICAL | G_LOG_FLAG_FATAL
+//	                   | G_LOG_FLAG_RECURSION, my_log_handler, NULL);
 //
 // This example adds a log handler for all messages from GLib:
 //
-// ```c
-// g_log_set_handler ("GLib", G_LOG_LEVEL_MASK | G_LOG_FLAG_FATAL
-//
-//	| G_LOG_FLAG_RECURSION, my_log_handler, NULL);
+//	g_log_set_handler ("GLib", G_LOG_LEVEL_MASK | G_LOG_FLAG_FATAL
+//	                   | G_LOG_FLAG_RECURSION, my_log_handler, NULL);
 //
-// ```
+// Deprecated: use [LogSetHandlerFull], the version of this function that language bindings are meant to use.
 func LogSetHandler(LogDomainVar *string, LogLevelsVar LogLevelFlags, LogFuncVar *LogFunc, UserDataVar uintptr) uint {
 
 	var LogFuncVarRef uintptr
@@ -448,11 +533,12 @@ func LogSetHandler(LogDomainVar *string, LogLevelsVar LogLevelFlags, LogFuncVar
 			LogFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 LogLevelFlags, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *LogFuncVar
 				cbFn(core.GoString(arg0), arg1, core.GoString(arg2), arg3)
 			}
-			LogFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
+			LogFuncVarRef = core.NewCallback(fcb)
+			LogFuncVarRef = SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
 		}
 	}
 
@@ -466,10 +552,10 @@ func LogSetHandler(LogDomainVar *string, LogLevelsVar LogLevelFlags, LogFuncVar
 
 var xLogSetHandlerFull func(uintptr, LogLevelFlags, uintptr, uintptr, uintptr) uint
 
-// Like [func@GLib.log_set_handler], but takes a destroy notify for the @user_data.
+// Like [LogSetHandler], but takes a destroy notify for the user_data.
 //
 // This has no effect if structured logging is enabled; see
-// [Using Structured Logging](logging.html#using-structured-logging).
+// Using Structured Logging.
 //
 // The `log_domain` parameter can be set to `NULL` or an empty string to use the default
 // application domain.
@@ -482,11 +568,12 @@ func LogSetHandlerFull(LogDomainVar *string, LogLevelsVar LogLevelFlags, LogFunc
 			LogFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 LogLevelFlags, arg2 uintptr, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *LogFuncVar
 				cbFn(core.GoString(arg0), arg1, core.GoString(arg2), arg3)
 			}
-			LogFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
+			LogFuncVarRef = core.NewCallback(fcb)
+			LogFuncVarRef = SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
 		}
 	}
 
@@ -497,11 +584,12 @@ func LogSetHandlerFull(LogDomainVar *string, LogLevelsVar LogLevelFlags, LogFunc
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -519,7 +607,7 @@ var xLogSetWriterFunc func(uintptr, uintptr, uintptr)
 // message.
 //
 // Each program should set a writer function, or the default writer
-// ([func@GLib.log_writer_default]) will be used.
+// ([LogWriterDefault]) will be used.
 //
 // Libraries **must not** call this function — only programs are allowed to
 // install a writer function, as there must be a single, central point where
@@ -535,11 +623,12 @@ func LogSetWriterFunc(FuncVar *LogWriterFunc, UserDataVar uintptr, UserDataFreeV
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 LogLevelFlags, arg1 []LogField, arg2 uint, arg3 uintptr) LogWriterOutput {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -550,11 +639,12 @@ func LogSetWriterFunc(FuncVar *LogWriterFunc, UserDataVar uintptr, UserDataFreeV
 			UserDataFreeVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeVar
 				cbFn(arg0)
 			}
-			UserDataFreeVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(UserDataFreeVarPtr, UserDataFreeVarRef, UserDataFreeVar)
+			UserDataFreeVarRef = core.NewCallback(fcb)
+			UserDataFreeVarRef = SaveCallbackWithClosure(UserDataFreeVarPtr, UserDataFreeVarRef, UserDataFreeVar)
 		}
 	}
 
@@ -567,17 +657,17 @@ var xLogStructured func(string, LogLevelFlags, ...interface{})
 // Log a message with structured data.
 //
 // The message will be passed through to the log writer set by the application
-// using [func@GLib.log_set_writer_func]. If the message is fatal (i.e. its log level
-// is [flags@GLib.LogLevelFlags.LEVEL_ERROR]), the program will be aborted by calling
-// [func@GLib.BREAKPOINT] at the end of this function. If the log writer returns
-// [enum@GLib.LogWriterOutput.UNHANDLED] (failure), no other fallback writers will be tried.
-// See the documentation for [type@GLib.LogWriterFunc] for information on chaining
+// using [LogSetWriterFunc]. If the message is fatal (i.e. its log level
+// is LogLevelFlags.LEVEL_ERROR), the program will be aborted by calling
+// BREAKPOINT at the end of this function. If the log writer returns
+// LogWriterOutput.UNHANDLED (failure), no other fallback writers will be tried.
+// See the documentation for [LogWriterFunc] for information on chaining
 // writers.
 //
 // The structured data is provided as key–value pairs, where keys are UTF-8
 // strings, and values are arbitrary pointers — typically pointing to UTF-8
 // strings, but that is not a requirement. To pass binary (non-nul-terminated)
-// structured data, use [func@GLib.log_structured_array]. The keys for structured data
+// structured data, use [LogStructuredArray]. The keys for structured data
 // should follow the [systemd journal
 // fields](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html)
 // specification. It is suggested that custom keys are namespaced according to
@@ -591,38 +681,34 @@ var xLogStructured func(string, LogLevelFlags, ...interface{})
 // non-nul-terminated UTF-8 strings can be passed with their correct length,
 // with the exception of `"GLIB_DOMAIN"` which was only fixed with GLib 2.82.3.
 //
-// The @log_domain will be converted into a `GLIB_DOMAIN` field. @log_level will
+// The log_domain will be converted into a `GLIB_DOMAIN` field. log_level will
 // be converted into a
-// [`PRIORITY`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#PRIORITY=)
+// [PRIORITY]
 // field. The format string will have its placeholders substituted for the provided
 // values and be converted into a
-// [`MESSAGE`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#MESSAGE=)
+// [MESSAGE]
 // field.
 //
 // Other fields you may commonly want to pass into this function:
 //
-//   - [`MESSAGE_ID`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#MESSAGE_ID=)
-//   - [`CODE_FILE`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_FILE=)
-//   - [`CODE_LINE`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_LINE=)
-//   - [`CODE_FUNC`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_FUNC=)
-//   - [`ERRNO`](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#ERRNO=)
+//   - [MESSAGE_ID]
+//   - [CODE_FILE]
+//   - [CODE_LINE]
+//   - [CODE_FUNC]
+//   - [ERRNO]
 //
 // Note that `CODE_FILE`, `CODE_LINE` and `CODE_FUNC` are automatically set by
-// the logging macros, [func@GLib.DEBUG_HERE], [func@GLib.message], [func@GLib.warning], [func@GLib.critical],
-// [func@GLib.error], etc, if the symbol `G_LOG_USE_STRUCTURED` is defined before including
+// the logging macros, DEBUG_HERE, message, warning, critical,
+// error, etc, if the symbol `G_LOG_USE_STRUCTURED` is defined before including
 // `glib.h`.
 //
 // For example:
 //
-// ```c
-// g_log_structured (G_LOG_DOMAIN, G_LOG_LEVEL_DEBUG,
-//
-//	"MESSAGE_ID", "06d4df59e6c24647bfe69d2c27ef0b4e",
-//	"MY_APPLICATION_CUSTOM_FIELD", "some debug string",
-//	"MESSAGE", "This is a debug message about pointer %p and integer %u.",
-//	some_pointer, some_integer);
-//
-// ```
+//	g_log_structured (G_LOG_DOMAIN, G_LOG_LEVEL_DEBUG,
+//	                  "MESSAGE_ID", "06d4df59e6c24647bfe69d2c27ef0b4e",
+//	                  "MY_APPLICATION_CUSTOM_FIELD", "some debug string",
+//	                  "MESSAGE", "This is a debug message about pointer %p and integer %u.",
+//	                  some_pointer, some_integer);
 //
 // Note that each `MESSAGE_ID` must be [uniquely and randomly
 // generated](https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#MESSAGE_ID=).
@@ -631,23 +717,19 @@ var xLogStructured func(string, LogLevelFlags, ...interface{})
 // your software.
 //
 // To pass a user data pointer to the log writer function which is specific to
-// this logging call, you must use [func@GLib.log_structured_array] and pass the pointer
+// this logging call, you must use [LogStructuredArray] and pass the pointer
 // as a field with `GLogField.length` set to zero, otherwise it will be
 // interpreted as a string.
 //
 // For example:
 //
-// ```c
-//
 //	const GLogField fields[] = {
 //	  { "MESSAGE", "This is a debug message.", -1 },
 //	  { "MESSAGE_ID", "fcfb2e1e65c3494386b74878f1abf893", -1 },
 //	  { "MY_APPLICATION_CUSTOM_FIELD", "some debug string", -1 },
 //	  { "MY_APPLICATION_STATE", state_object, 0 },
 //	};
-//
-// g_log_structured_array (G_LOG_LEVEL_DEBUG, fields, G_N_ELEMENTS (fields));
-// ```
+//	g_log_structured_array (G_LOG_LEVEL_DEBUG, fields, G_N_ELEMENTS (fields));
 //
 // Note also that, even if no other structured fields are specified, there
 // must always be a `MESSAGE` key before the format string. The `MESSAGE`-format
@@ -657,6 +739,14 @@ var xLogStructured func(string, LogLevelFlags, ...interface{})
 // The default writer function for `stdout` and `stderr` will automatically
 // append a new-line character after the message, so you should not add one
 // manually to the format string.
+//
+// [PRIORITY]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#PRIORITY=
+// [MESSAGE]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#MESSAGE=
+// [MESSAGE_ID]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#MESSAGE_ID=
+// [CODE_FILE]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_FILE=
+// [CODE_LINE]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_LINE=
+// [CODE_FUNC]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#CODE_FUNC=
+// [ERRNO]: https://www.freedesktop.org/software/systemd/man/systemd.journal-fields.html#ERRNO=
 func LogStructured(LogDomainVar string, LogLevelVar LogLevelFlags, varArgs ...interface{}) {
 
 	xLogStructured(LogDomainVar, LogLevelVar, varArgs...)
@@ -668,13 +758,13 @@ var xLogStructuredArray func(LogLevelFlags, []LogField, uint)
 // Log a message with structured data.
 //
 // The message will be passed through to the log writer set by the application
-// using [func@GLib.log_set_writer_func]. If the
-// message is fatal (i.e. its log level is [flags@GLib.LogLevelFlags.LEVEL_ERROR]), the program will
+// using [LogSetWriterFunc]. If the
+// message is fatal (i.e. its log level is LogLevelFlags.LEVEL_ERROR), the program will
 // be aborted at the end of this function.
 //
-// See [func@GLib.log_structured] for more documentation.
+// See [LogStructured] for more documentation.
 //
-// This assumes that @log_level is already present in @fields (typically as the
+// This assumes that log_level is already present in fields (typically as the
 // `PRIORITY` field).
 func LogStructuredArray(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFieldsVar uint) {
 
@@ -692,21 +782,21 @@ func LogStructuredStandard(LogDomainVar string, LogLevelVar LogLevelFlags, FileV
 
 var xLogVariant func(uintptr, LogLevelFlags, *Variant)
 
-// Log a message with structured data, accepting the data within a [type@GLib.Variant].
+// Log a message with structured data, accepting the data within a [Variant].
 //
 // This version is especially useful for use in other languages, via introspection.
 //
-// The only mandatory item in the @fields dictionary is the `"MESSAGE"` which must
+// The only mandatory item in the fields dictionary is the `"MESSAGE"` which must
 // contain the text shown to the user.
 //
-// The values in the @fields dictionary are likely to be of type `G_VARIANT_TYPE_STRING`.
+// The values in the fields dictionary are likely to be of type `G_VARIANT_TYPE_STRING`.
 // Array of bytes (`G_VARIANT_TYPE_BYTESTRING`) is also
 // supported. In this case the message is handled as binary and will be forwarded
 // to the log writer as such. The size of the array should not be higher than
 // `G_MAXSSIZE`. Otherwise it will be truncated to this size. For other types
-// [method@GLib.Variant.print] will be used to convert the value into a string.
+// [Variant.Print] will be used to convert the value into a string.
 //
-// For more details on its usage and about the parameters, see [func@GLib.log_structured].
+// For more details on its usage and about the parameters, see [LogStructured].
 func LogVariant(LogDomainVar *string, LogLevelVar LogLevelFlags, FieldsVar *Variant) {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
@@ -729,15 +819,15 @@ var xLogWriterDefault func(LogLevelFlags, []LogField, uint, uintptr) LogWriterOu
 // future. Distributors of GLib may modify this function to impose their own
 // (documented) platform-specific log writing policies.
 //
-// This is suitable for use as a [type@GLib.LogWriterFunc], and is the default writer used
-// if no other is set using [func@GLib.log_set_writer_func].
+// This is suitable for use as a [LogWriterFunc], and is the default writer used
+// if no other is set using [LogSetWriterFunc].
 //
-// As with [func@GLib.log_default_handler], this function drops debug and informational
+// As with [LogDefaultHandler], this function drops debug and informational
 // messages unless their log domain (or `all`) is listed in the space-separated
 // `G_MESSAGES_DEBUG` environment variable, or `DEBUG_INVOCATION=1` is set in
-// the environment, or set at runtime by [func@GLib.log_writer_default_set_debug_domains].
+// the environment, or set at runtime by [LogWriterDefaultSetDebugDomains].
 //
-// [func@GLib.log_writer_default] uses the mask set by [func@GLib.log_set_always_fatal] to
+// [LogWriterDefault] uses the mask set by [LogSetAlwaysFatal] to
 // determine which messages are fatal. When using a custom writer function instead it is
 // up to the writer function to determine which log messages are fatal.
 func LogWriterDefault(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFieldsVar uint, UserDataVar uintptr) LogWriterOutput {
@@ -767,12 +857,12 @@ var xLogWriterDefaultSetUseStderr func(bool)
 // Configure whether the built-in log functions will output all log messages to
 // `stderr`.
 //
-// The built-in log functions are [func@GLib.log_default_handler] for the
-// old-style API, and both [func@GLib.log_writer_default] and
-// [func@GLib.log_writer_standard_streams] for the structured API.
+// The built-in log functions are [LogDefaultHandler] for the
+// old-style API, and both [LogWriterDefault] and
+// [LogWriterStandardStreams] for the structured API.
 //
-// By default, log messages of levels [flags@GLib.LogLevelFlags.LEVEL_INFO] and
-// [flags@GLib.LogLevelFlags.LEVEL_DEBUG] are sent to `stdout`, and other log messages are
+// By default, log messages of levels LogLevelFlags.LEVEL_INFO and
+// LogLevelFlags.LEVEL_DEBUG are sent to `stdout`, and other log messages are
 // sent to `stderr`. This is problematic for applications that intend
 // to reserve `stdout` for structured output such as JSON or XML.
 //
@@ -787,37 +877,29 @@ func LogWriterDefaultSetUseStderr(UseStderrVar bool) {
 
 var xLogWriterDefaultWouldDrop func(LogLevelFlags, uintptr) bool
 
-// Check whether [func@GLib.log_writer_default] and [func@GLib.log_default_handler] would
+// Check whether [LogWriterDefault] and [LogDefaultHandler] would
 // ignore a message with the given domain and level.
 //
-// As with [func@GLib.log_default_handler], this function drops debug and informational
+// As with [LogDefaultHandler], this function drops debug and informational
 // messages unless their log domain (or `all`) is listed in the space-separated
 // `G_MESSAGES_DEBUG` environment variable, or `DEBUG_INVOCATION=1` is set in
-// the environment, or by [func@GLib.log_writer_default_set_debug_domains].
+// the environment, or by [LogWriterDefaultSetDebugDomains].
 //
 // This can be used when implementing log writers with the same filtering
 // behaviour as the default, but a different destination or output format:
 //
-// ```c
-// if (g_log_writer_default_would_drop (log_level, log_domain))
-//
-//	return G_LOG_WRITER_HANDLED;
-//
-// ]|
+//	if (g_log_writer_default_would_drop (log_level, log_domain))
+//	  return G_LOG_WRITER_HANDLED;
 //
 // or to skip an expensive computation if it is only needed for a debugging
 // message, and `G_MESSAGES_DEBUG` and `DEBUG_INVOCATION` are not set:
 //
-// ```c
-// if (!g_log_writer_default_would_drop (G_LOG_LEVEL_DEBUG, G_LOG_DOMAIN))
+//	if (!g_log_writer_default_would_drop (G_LOG_LEVEL_DEBUG, G_LOG_DOMAIN))
+//	  {
+//	    g_autofree gchar *result = expensive_computation (my_object);
 //
-//	{
-//	  g_autofree gchar *result = expensive_computation (my_object);
-//
-//	  g_debug ("my_object result: %s", result);
-//	}
-//
-// ```
+//	    g_debug ("my_object result: %s", result);
+//	  }
 func LogWriterDefaultWouldDrop(LogLevelVar LogLevelFlags, LogDomainVar *string) bool {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
@@ -835,7 +917,7 @@ var xLogWriterFormatFields func(LogLevelFlags, []LogField, uint, bool) string
 //
 // This will include the values of all fields it knows
 // how to interpret, which includes `MESSAGE` and `GLIB_DOMAIN` (see the
-// documentation for [func@GLib.log_structured]). It does not include values from
+// documentation for [LogStructured]). It does not include values from
 // unknown fields.
 //
 // The returned string does **not** have a trailing new-line character. It is
@@ -850,15 +932,14 @@ func LogWriterFormatFields(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFie
 
 var xLogWriterIsJournald func(int) bool
 
-// Check whether the given @output_fd file descriptor is a connection to the
+// Check whether the given output_fd file descriptor is a connection to the
 // systemd journal, or something else (like a log file or `stdout` or
 // `stderr`).
 //
 // Invalid file descriptors are accepted and return `FALSE`, which allows for
 // the following construct without needing any additional error handling:
-// ```c
-// is_journald = g_log_writer_is_journald (fileno (stderr));
-// ```
+//
+//	is_journald = g_log_writer_is_journald (fileno (stderr));
 func LogWriterIsJournald(OutputFdVar int) bool {
 
 	cret := xLogWriterIsJournald(OutputFdVar)
@@ -875,10 +956,10 @@ var xLogWriterJournald func(LogLevelFlags, []LogField, uint, uintptr) LogWriterO
 // length zero (indicating program-specific data) then only its key will be
 // sent.
 //
-// This is suitable for use as a [type@GLib.LogWriterFunc].
+// This is suitable for use as a [LogWriterFunc].
 //
 // If GLib has been compiled without systemd support, this function is still
-// defined, but will always return [enum@GLib.LogWriterOutput.UNHANDLED].
+// defined, but will always return LogWriterOutput.UNHANDLED.
 func LogWriterJournald(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFieldsVar uint, UserDataVar uintptr) LogWriterOutput {
 
 	cret := xLogWriterJournald(LogLevelVar, FieldsVar, NFieldsVar, UserDataVar)
@@ -891,20 +972,22 @@ var xLogWriterStandardStreams func(LogLevelFlags, []LogField, uint, uintptr) Log
 // Format a structured log message and print it to either `stdout` or `stderr`,
 // depending on its log level.
 //
-// [flags@GLib.LogLevelFlags.LEVEL_INFO] and [flags@GLib.LogLevelFlags.LEVEL_DEBUG] messages
+// LogLevelFlags.LEVEL_INFO and LogLevelFlags.LEVEL_DEBUG messages
 // are sent to `stdout`, or to `stderr` if requested by
-// [func@GLib.log_writer_default_set_use_stderr];
+// [LogWriterDefaultSetUseStderr];
 // all other log levels are sent to `stderr`. Only fields
 // which are understood by this function are included in the formatted string
 // which is printed.
 //
 // If the output stream supports
-// [ANSI color escape sequences](https://en.wikipedia.org/wiki/ANSI_escape_code),
+// [ANSI color escape sequences],
 // they will be used in the output.
 //
 // A trailing new-line character is added to the log message when it is printed.
 //
-// This is suitable for use as a [type@GLib.LogWriterFunc].
+// This is suitable for use as a [LogWriterFunc].
+//
+// [ANSI color escape sequences]: https://en.wikipedia.org/wiki/ANSI_escape_code
 func LogWriterStandardStreams(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFieldsVar uint, UserDataVar uintptr) LogWriterOutput {
 
 	cret := xLogWriterStandardStreams(LogLevelVar, FieldsVar, NFieldsVar, UserDataVar)
@@ -914,10 +997,12 @@ func LogWriterStandardStreams(LogLevelVar LogLevelFlags, FieldsVar []LogField, N
 
 var xLogWriterSupportsColor func(int) bool
 
-// Check whether the given @output_fd file descriptor supports
-// [ANSI color escape sequences](https://en.wikipedia.org/wiki/ANSI_escape_code).
+// Check whether the given output_fd file descriptor supports
+// [ANSI color escape sequences].
 //
 // If so, they can safely be used when formatting log messages.
+//
+// [ANSI color escape sequences]: https://en.wikipedia.org/wiki/ANSI_escape_code
 func LogWriterSupportsColor(OutputFdVar int) bool {
 
 	cret := xLogWriterSupportsColor(OutputFdVar)
@@ -935,10 +1020,10 @@ var xLogWriterSyslog func(LogLevelFlags, []LogField, uint, uintptr) LogWriterOut
 // values: "auth", "daemon", and "user". If SYSLOG_FACILITY is not specified, LOG_USER
 // facility will be used.
 //
-// This is suitable for use as a [type@GLib.LogWriterFunc].
+// This is suitable for use as a [LogWriterFunc].
 //
 // If syslog is not supported, this function is still defined, but will always
-// return [enum@GLib.LogWriterOutput.UNHANDLED].
+// return LogWriterOutput.UNHANDLED.
 func LogWriterSyslog(LogLevelVar LogLevelFlags, FieldsVar []LogField, NFieldsVar uint, UserDataVar uintptr) LogWriterOutput {
 
 	cret := xLogWriterSyslog(LogLevelVar, FieldsVar, NFieldsVar, UserDataVar)
@@ -950,16 +1035,16 @@ var xLogv func(uintptr, LogLevelFlags, string, []interface{})
 
 // Logs an error or debugging message.
 //
-// If the log level has been set as fatal, [func@GLib.BREAKPOINT] is called
-// to terminate the program. See the documentation for [func@GLib.BREAKPOINT] for
+// If the log level has been set as fatal, BREAKPOINT is called
+// to terminate the program. See the documentation for BREAKPOINT for
 // details of the debugging options this provides.
 //
-// If [func@GLib.log_default_handler] is used as the log handler function, a new-line
+// If [LogDefaultHandler] is used as the log handler function, a new-line
 // character will automatically be appended to @..., and need not be entered
 // manually.
 //
-// If [structured logging is enabled](logging.html#using-structured-logging) this will
-// output via the structured log writer function (see [func@GLib.log_set_writer_func]).
+// If structured logging is enabled this will
+// output via the structured log writer function (see [LogSetWriterFunc]).
 //
 // The `log_domain` parameter can be set to `NULL` or an empty string to use the default
 // application domain.
@@ -977,17 +1062,19 @@ var xPrint func(string, ...interface{})
 // Outputs a formatted message via the print handler.
 //
 // The default print handler outputs the encoded message to `stdout`, without
-// appending a trailing new-line character. Typically, @format should end with
+// appending a trailing new-line character. Typically, format should end with
 // its own new-line character.
 //
 // This function should not be used from within libraries for debugging
 // messages, since it may be redirected by applications to special
 // purpose message windows or even files. Instead, libraries should
-// use [func@GLib.log], [func@GLib.log_structured], or the convenience macros
-// [func@GLib.message], [func@GLib.warning] and [func@GLib.error].
+// use [Log], [LogStructured], or the convenience macros
+// message, warning and error.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Print(FormatVar string, varArgs ...interface{}) {
 
-	xPrint(FormatVar, varArgs...)
+	xPrint("%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
@@ -996,15 +1083,17 @@ var xPrinterr func(string, ...interface{})
 // Outputs a formatted message via the error message handler.
 //
 // The default handler outputs the encoded message to `stderr`, without appending
-// a trailing new-line character. Typically, @format should end with its own
+// a trailing new-line character. Typically, format should end with its own
 // new-line character.
 //
 // This function should not be used from within libraries.
-// Instead [func@GLib.log] or [func@GLib.log_structured] should be used, or the convenience
-// macros [func@GLib.message], [func@GLib.warning] and [func@GLib.error].
+// Instead [Log] or [LogStructured] should be used, or the convenience
+// macros message, warning and error.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Printerr(FormatVar string, varArgs ...interface{}) {
 
-	xPrinterr(FormatVar, varArgs...)
+	xPrinterr("%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
@@ -1013,10 +1102,10 @@ var xPrintfStringUpperBound func(string, []interface{}) uint
 // Calculates the maximum space needed to store the output
 // of the `sprintf()` function.
 //
-// If @format or @args are invalid, `0` is returned. This could happen if, for
-// example, @format contains an `%lc` or `%ls` placeholder and @args contains a
+// If format or args are invalid, `0` is returned. This could happen if, for
+// example, format contains an `%lc` or `%ls` placeholder and args contains a
 // wide character which cannot be represented in multibyte encoding. `0`
-// can also be returned legitimately if, for example, @format is `%s` and @args
+// can also be returned legitimately if, for example, format is `%s` and args
 // is an empty string. The caller is responsible for differentiating these two
 // return cases if necessary. It is recommended to not use `%lc` or `%ls`
 // placeholders in any case, as their behaviour is locale-dependent.
@@ -1029,8 +1118,8 @@ func PrintfStringUpperBound(FormatVar string, ArgsVar []interface{}) uint {
 
 var xReturnIfFailWarning func(uintptr, string, uintptr)
 
-// Internal function used to print messages from the public [func@GLib.return_if_fail]
-// and [func@GLib.return_val_if_fail] macros.
+// Internal function used to print messages from the public return_if_fail
+// and return_val_if_fail macros.
 func ReturnIfFailWarning(LogDomainVar *string, PrettyFunctionVar string, ExpressionVar *string) {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
@@ -1045,17 +1134,17 @@ func ReturnIfFailWarning(LogDomainVar *string, PrettyFunctionVar string, Express
 
 var xSetPrintHandler func(uintptr) uintptr
 
-// Sets the print handler to @func, or resets it to the
+// Sets the print handler to func, or resets it to the
 // default GLib handler if `NULL`.
 //
-// Any messages passed to [func@GLib.print] will be output via
+// Any messages passed to [Print] will be output via
 // the new handler. The default handler outputs
 // the encoded message to `stdout`. By providing your own handler
 // you can redirect the output, to a GTK widget or a
 // log file for example.
 //
 // Since 2.76 this functions always returns a valid
-// [type@GLib.PrintFunc], and never returns `NULL`. If no custom
+// [PrintFunc], and never returns `NULL`. If no custom
 // print handler was set, it will return the GLib
 // default print handler and that can be re-used to
 // decorate its output and/or to write to `stderr`
@@ -1069,11 +1158,12 @@ func SetPrintHandler(FuncVar *PrintFunc) uintptr {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(core.GoString(arg0))
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -1084,17 +1174,17 @@ func SetPrintHandler(FuncVar *PrintFunc) uintptr {
 
 var xSetPrinterrHandler func(uintptr) uintptr
 
-// Sets the handler for printing error messages to @func,
+// Sets the handler for printing error messages to func,
 // or resets it to the default GLib handler if `NULL`.
 //
-// Any messages passed to [func@GLib.printerr] will be output via
+// Any messages passed to [Printerr] will be output via
 // the new handler. The default handler outputs the encoded
 // message to `stderr`. By providing your own handler you can
 // redirect the output, to a GTK widget or a log file for
 // example.
 //
 // Since 2.76 this functions always returns a valid
-// [type@GLib.PrintFunc], and never returns `NULL`. If no custom error
+// [PrintFunc], and never returns `NULL`. If no custom error
 // print handler was set, it will return the GLib default
 // error print handler and that can be re-used to decorate
 // its output and/or to write to `stderr` in all platforms.
@@ -1108,11 +1198,12 @@ func SetPrinterrHandler(FuncVar *PrintFunc) uintptr {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(core.GoString(arg0))
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -1123,8 +1214,8 @@ func SetPrinterrHandler(FuncVar *PrintFunc) uintptr {
 
 var xWarnMessage func(uintptr, string, int, string, uintptr)
 
-// Internal function used to print messages from the public [func@GLib.warn_if_reached]
-// and [func@GLib.warn_if_fail] macros.
+// Internal function used to print messages from the public warn_if_reached
+// and warn_if_fail macros.
 func WarnMessage(DomainVar *string, FileVar string, LineVar int, FuncVar string, WarnexprVar *string) {
 
 	DomainVarPtr := core.GStrdupNullable(DomainVar)
@@ -1142,47 +1233,47 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAssertWarning, libs, "g_assert_warning")
-	core.PuregoSafeRegister(&xLog, libs, "g_log")
-	core.PuregoSafeRegister(&xLogDefaultHandler, libs, "g_log_default_handler")
-	core.PuregoSafeRegister(&xLogGetAlwaysFatal, libs, "g_log_get_always_fatal")
-	core.PuregoSafeRegister(&xLogGetDebugEnabled, libs, "g_log_get_debug_enabled")
-	core.PuregoSafeRegister(&xLogRemoveHandler, libs, "g_log_remove_handler")
-	core.PuregoSafeRegister(&xLogSetAlwaysFatal, libs, "g_log_set_always_fatal")
-	core.PuregoSafeRegister(&xLogSetDebugEnabled, libs, "g_log_set_debug_enabled")
-	core.PuregoSafeRegister(&xLogSetDefaultHandler, libs, "g_log_set_default_handler")
-	core.PuregoSafeRegister(&xLogSetFatalMask, libs, "g_log_set_fatal_mask")
-	core.PuregoSafeRegister(&xLogSetHandler, libs, "g_log_set_handler")
-	core.PuregoSafeRegister(&xLogSetHandlerFull, libs, "g_log_set_handler_full")
-	core.PuregoSafeRegister(&xLogSetWriterFunc, libs, "g_log_set_writer_func")
-	core.PuregoSafeRegister(&xLogStructured, libs, "g_log_structured")
-	core.PuregoSafeRegister(&xLogStructuredArray, libs, "g_log_structured_array")
-	core.PuregoSafeRegister(&xLogStructuredStandard, libs, "g_log_structured_standard")
-	core.PuregoSafeRegister(&xLogVariant, libs, "g_log_variant")
-	core.PuregoSafeRegister(&xLogWriterDefault, libs, "g_log_writer_default")
-	core.PuregoSafeRegister(&xLogWriterDefaultSetDebugDomains, libs, "g_log_writer_default_set_debug_domains")
-	core.PuregoSafeRegister(&xLogWriterDefaultSetUseStderr, libs, "g_log_writer_default_set_use_stderr")
-	core.PuregoSafeRegister(&xLogWriterDefaultWouldDrop, libs, "g_log_writer_default_would_drop")
-	core.PuregoSafeRegister(&xLogWriterFormatFields, libs, "g_log_writer_format_fields")
-	core.PuregoSafeRegister(&xLogWriterIsJournald, libs, "g_log_writer_is_journald")
-	core.PuregoSafeRegister(&xLogWriterJournald, libs, "g_log_writer_journald")
-	core.PuregoSafeRegister(&xLogWriterStandardStreams, libs, "g_log_writer_standard_streams")
-	core.PuregoSafeRegister(&xLogWriterSupportsColor, libs, "g_log_writer_supports_color")
-	core.PuregoSafeRegister(&xLogWriterSyslog, libs, "g_log_writer_syslog")
-	core.PuregoSafeRegister(&xLogv, libs, "g_logv")
-	core.PuregoSafeRegister(&xPrint, libs, "g_print")
-	core.PuregoSafeRegister(&xPrinterr, libs, "g_printerr")
-	core.PuregoSafeRegister(&xPrintfStringUpperBound, libs, "g_printf_string_upper_bound")
-	core.PuregoSafeRegister(&xReturnIfFailWarning, libs, "g_return_if_fail_warning")
-	core.PuregoSafeRegister(&xSetPrintHandler, libs, "g_set_print_handler")
-	core.PuregoSafeRegister(&xSetPrinterrHandler, libs, "g_set_printerr_handler")
-	core.PuregoSafeRegister(&xWarnMessage, libs, "g_warn_message")
+	core.PuregoRegisterSince(&xAssertWarning, libs, "g_assert_warning", "GLIB", "")
+	core.PuregoRegisterSince(&xLog, libs, "g_log", "GLIB", "")
+	core.PuregoRegisterSince(&xLogDefaultHandler, libs, "g_log_default_handler", "GLIB", "")
+	core.PuregoRegisterSince(&xLogGetAlwaysFatal, libs, "g_log_get_always_fatal", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xLogGetDebugEnabled, libs, "g_log_get_debug_enabled", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xLogRemoveHandler, libs, "g_log_remove_handler", "GLIB", "")
+	core.PuregoRegisterSince(&xLogSetAlwaysFatal, libs, "g_log_set_always_fatal", "GLIB", "")
+	core.PuregoRegisterSince(&xLogSetDebugEnabled, libs, "g_log_set_debug_enabled", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xLogSetDefaultHandler, libs, "g_log_set_default_handler", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xLogSetFatalMask, libs, "g_log_set_fatal_mask", "GLIB", "")
+	core.PuregoRegisterSince(&xLogSetHandler, libs, "g_log_set_handler", "GLIB", "")
+	core.PuregoRegisterSince(&xLogSetHandlerFull, libs, "g_log_set_handler_full", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xLogSetWriterFunc, libs, "g_log_set_writer_func", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogStructured, libs, "g_log_structured", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogStructuredArray, libs, "g_log_structured_array", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogStructuredStandard, libs, "g_log_structured_standard", "GLIB", "")
+	core.PuregoRegisterSince(&xLogVariant, libs, "g_log_variant", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterDefault, libs, "g_log_writer_default", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterDefaultSetDebugDomains, libs, "g_log_writer_default_set_debug_domains", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xLogWriterDefaultSetUseStderr, libs, "g_log_writer_default_set_use_stderr", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xLogWriterDefaultWouldDrop, libs, "g_log_writer_default_would_drop", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xLogWriterFormatFields, libs, "g_log_writer_format_fields", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterIsJournald, libs, "g_log_writer_is_journald", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterJournald, libs, "g_log_writer_journald", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterStandardStreams, libs, "g_log_writer_standard_streams", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterSupportsColor, libs, "g_log_writer_supports_color", "GLIB", "2.50")
+	core.PuregoRegisterSince(&xLogWriterSyslog, libs, "g_log_writer_syslog", "GLIB", "2.80")
+	core.PuregoRegisterSince(&xLogv, libs, "g_logv", "GLIB", "")
+	core.PuregoRegisterSince(&xPrint, libs, "g_print", "GLIB", "")
+	core.PuregoRegisterSince(&xPrinterr, libs, "g_printerr", "GLIB", "")
+	core.PuregoRegisterSince(&xPrintfStringUpperBound, libs, "g_printf_string_upper_bound", "GLIB", "")
+	core.PuregoRegisterSince(&xReturnIfFailWarning, libs, "g_return_if_fail_warning", "GLIB", "")
+	core.PuregoRegisterSince(&xSetPrintHandler, libs, "g_set_print_handler", "GLIB", "")
+	core.PuregoRegisterSince(&xSetPrinterrHandler, libs, "g_set_printerr_handler", "GLIB", "")
+	core.PuregoRegisterSince(&xWarnMessage, libs, "g_warn_message", "GLIB", "")
 
 }
@@ -2,25 +2,26 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// Specifies the type of function passed to g_node_children_foreach().
+// Specifies the type of function passed to [Node.ChildrenForeach].
 // The function is called with each child node, together with the user
-// data passed to g_node_children_foreach().
+// data passed to [Node.ChildrenForeach].
 type NodeForeachFunc func(*Node, uintptr)
 
-// Specifies the type of function passed to g_node_traverse(). The
+// Specifies the type of function passed to [Node.Traverse]. The
 // function is called with each of the nodes visited, together with the
-// user data passed to g_node_traverse(). If the function returns
-// %TRUE, then the traversal is stopped.
+// user data passed to [Node.Traverse]. If the function returns
+// true, then the traversal is stopped.
 type NodeTraverseFunc func(*Node, uintptr) bool
 
-// The #GNode struct represents one node in a [n-ary tree](data-structures.html#n-ary-trees).
+// The [Node] struct represents one node in a n-ary tree.
 type Node struct {
 	_ structs.HostLayout
 
@@ -41,7 +42,7 @@ func (x *Node) GoPointer() uintptr {
 
 var xNodeChildIndex func(uintptr, uintptr) int
 
-// Gets the position of the first child of a #GNode
+// Gets the position of the first child of a [Node]
 // which contains the given data.
 func (x *Node) ChildIndex(DataVar uintptr) int {
 
@@ -51,8 +52,8 @@ func (x *Node) ChildIndex(DataVar uintptr) int {
 
 var xNodeChildPosition func(uintptr, *Node) int
 
-// Gets the position of a #GNode with respect to its siblings.
-// @child must be a child of @node. The first child is numbered 0,
+// Gets the position of a [Node] with respect to its siblings.
+// child must be a child of node. The first child is numbered 0,
 // the second 1, and so on.
 func (x *Node) ChildPosition(ChildVar *Node) int {
 
@@ -62,8 +63,8 @@ func (x *Node) ChildPosition(ChildVar *Node) int {
 
 var xNodeChildrenForeach func(uintptr, TraverseFlags, uintptr, uintptr)
 
-// Calls a function for each of the children of a #GNode. Note that it
-// doesn't descend beneath the child nodes. @func must not do anything
+// Calls a function for each of the children of a [Node]. Note that it
+// doesn't descend beneath the child nodes. func must not do anything
 // that would modify the structure of the tree.
 func (x *Node) ChildrenForeach(FlagsVar TraverseFlags, FuncVar *NodeForeachFunc, DataVar uintptr) {
 
@@ -74,11 +75,12 @@ func (x *Node) ChildrenForeach(FlagsVar TraverseFlags, FuncVar *NodeForeachFunc,
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Node, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -88,8 +90,8 @@ func (x *Node) ChildrenForeach(FlagsVar TraverseFlags, FuncVar *NodeForeachFunc,
 
 var xNodeCopy func(uintptr) *Node
 
-// Recursively copies a #GNode (but does not deep-copy the data inside the
-// nodes, see g_node_copy_deep() if you need that).
+// Recursively copies a [Node] (but does not deep-copy the data inside the
+// nodes, see [Node.CopyDeep] if you need that).
 func (x *Node) Copy() *Node {
 
 	cret := xNodeCopy(x.GoPointer())
@@ -98,7 +100,7 @@ func (x *Node) Copy() *Node {
 
 var xNodeCopyDeep func(uintptr, uintptr, uintptr) *Node
 
-// Recursively copies a #GNode and its data.
+// Recursively copies a [Node] and its data.
 func (x *Node) CopyDeep(CopyFuncVar *CopyFunc, DataVar uintptr) *Node {
 
 	var CopyFuncVarRef uintptr
@@ -108,11 +110,12 @@ func (x *Node) CopyDeep(CopyFuncVar *CopyFunc, DataVar uintptr) *Node {
 			CopyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CopyFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CopyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
+			CopyFuncVarRef = core.NewCallback(fcb)
+			CopyFuncVarRef = SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
 		}
 	}
 
@@ -122,9 +125,9 @@ func (x *Node) CopyDeep(CopyFuncVar *CopyFunc, DataVar uintptr) *Node {
 
 var xNodeDepth func(uintptr) uint
 
-// Gets the depth of a #GNode.
+// Gets the depth of a [Node].
 //
-// If @node is %NULL the depth is 0. The root node has a depth of 1.
+// If node is nil the depth is 0. The root node has a depth of 1.
 // For the children of the root node the depth is 2. And so on.
 func (x *Node) Depth() uint {
 
@@ -134,7 +137,7 @@ func (x *Node) Depth() uint {
 
 var xNodeDestroy func(uintptr)
 
-// Removes @root and its children from the tree, freeing any memory
+// Removes root and its children from the tree, freeing any memory
 // allocated.
 func (x *Node) Destroy() {
 
@@ -144,7 +147,7 @@ func (x *Node) Destroy() {
 
 var xNodeFind func(uintptr, TraverseType, TraverseFlags, uintptr) *Node
 
-// Finds a #GNode in a tree.
+// Finds a [Node] in a tree.
 func (x *Node) Find(OrderVar TraverseType, FlagsVar TraverseFlags, DataVar uintptr) *Node {
 
 	cret := xNodeFind(x.GoPointer(), OrderVar, FlagsVar, DataVar)
@@ -153,7 +156,7 @@ func (x *Node) Find(OrderVar TraverseType, FlagsVar TraverseFlags, DataVar uintp
 
 var xNodeFindChild func(uintptr, TraverseFlags, uintptr) *Node
 
-// Finds the first child of a #GNode with the given data.
+// Finds the first child of a [Node] with the given data.
 func (x *Node) FindChild(FlagsVar TraverseFlags, DataVar uintptr) *Node {
 
 	cret := xNodeFindChild(x.GoPointer(), FlagsVar, DataVar)
@@ -162,7 +165,7 @@ func (x *Node) FindChild(FlagsVar TraverseFlags, DataVar uintptr) *Node {
 
 var xNodeFirstSibling func(uintptr) *Node
 
-// Gets the first sibling of a #GNode.
+// Gets the first sibling of a [Node].
 // This could possibly be the node itself.
 func (x *Node) FirstSibling() *Node {
 
@@ -181,7 +184,7 @@ func (x *Node) GetRoot() *Node {
 
 var xNodeInsert func(uintptr, int, *Node) *Node
 
-// Inserts a #GNode beneath the parent at the given position.
+// Inserts a [Node] beneath the parent at the given position.
 func (x *Node) Insert(PositionVar int, NodeVar *Node) *Node {
 
 	cret := xNodeInsert(x.GoPointer(), PositionVar, NodeVar)
@@ -190,7 +193,7 @@ func (x *Node) Insert(PositionVar int, NodeVar *Node) *Node {
 
 var xNodeInsertAfter func(uintptr, *Node, *Node) *Node
 
-// Inserts a #GNode beneath the parent after the given sibling.
+// Inserts a [Node] beneath the parent after the given sibling.
 func (x *Node) InsertAfter(SiblingVar *Node, NodeVar *Node) *Node {
 
 	cret := xNodeInsertAfter(x.GoPointer(), SiblingVar, NodeVar)
@@ -199,7 +202,7 @@ func (x *Node) InsertAfter(SiblingVar *Node, NodeVar *Node) *Node {
 
 var xNodeInsertBefore func(uintptr, *Node, *Node) *Node
 
-// Inserts a #GNode beneath the parent before the given sibling.
+// Inserts a [Node] beneath the parent before the given sibling.
 func (x *Node) InsertBefore(SiblingVar *Node, NodeVar *Node) *Node {
 
 	cret := xNodeInsertBefore(x.GoPointer(), SiblingVar, NodeVar)
@@ -208,9 +211,9 @@ func (x *Node) InsertBefore(SiblingVar *Node, NodeVar *Node) *Node {
 
 var xNodeIsAncestor func(uintptr, *Node) bool
 
-// Returns %TRUE if @node is an ancestor of @descendant.
-// This is true if node is the parent of @descendant,
-// or if node is the grandparent of @descendant etc.
+// Returns true if node is an ancestor of descendant.
+// This is true if node is the parent of descendant,
+// or if node is the grandparent of descendant etc.
 func (x *Node) IsAncestor(DescendantVar *Node) bool {
 
 	cret := xNodeIsAncestor(x.GoPointer(), DescendantVar)
@@ -219,7 +222,7 @@ func (x *Node) IsAncestor(DescendantVar *Node) bool {
 
 var xNodeLastChild func(uintptr) *Node
 
-// Gets the last child of a #GNode.
+// Gets the last child of a [Node].
 func (x *Node) LastChild() *Node {
 
 	cret := xNodeLastChild(x.GoPointer())
@@ -228,7 +231,7 @@ func (x *Node) LastChild() *Node {
 
 var xNodeLastSibling func(uintptr) *Node
 
-// Gets the last sibling of a #GNode.
+// Gets the last sibling of a [Node].
 // This could possibly be the node itself.
 func (x *Node) LastSibling() *Node {
 
@@ -238,11 +241,11 @@ func (x *Node) LastSibling() *Node {
 
 var xNodeMaxHeight func(uintptr) uint
 
-// Gets the maximum height of all branches beneath a #GNode.
-// This is the maximum distance from the #GNode to all leaf nodes.
+// Gets the maximum height of all branches beneath a [Node].
+// This is the maximum distance from the [Node] to all leaf nodes.
 //
-// If @root is %NULL, 0 is returned. If @root has no children,
-// 1 is returned. If @root has children, 2 is returned. And so on.
+// If root is nil, 0 is returned. If root has no children,
+// 1 is returned. If root has children, 2 is returned. And so on.
 func (x *Node) MaxHeight() uint {
 
 	cret := xNodeMaxHeight(x.GoPointer())
@@ -251,7 +254,7 @@ func (x *Node) MaxHeight() uint {
 
 var xNodeNChildren func(uintptr) uint
 
-// Gets the number of children of a #GNode.
+// Gets the number of children of a [Node].
 func (x *Node) NChildren() uint {
 
 	cret := xNodeNChildren(x.GoPointer())
@@ -269,9 +272,9 @@ func (x *Node) NNodes(FlagsVar TraverseFlags) uint {
 
 var xNodeNthChild func(uintptr, uint) *Node
 
-// Gets a child of a #GNode, using the given index.
+// Gets a child of a [Node], using the given index.
 // The first child is at index 0. If the index is
-// too big, %NULL is returned.
+// too big, nil is returned.
 func (x *Node) NthChild(NVar uint) *Node {
 
 	cret := xNodeNthChild(x.GoPointer(), NVar)
@@ -280,7 +283,7 @@ func (x *Node) NthChild(NVar uint) *Node {
 
 var xNodePrepend func(uintptr, *Node) *Node
 
-// Inserts a #GNode as the first child of the given parent.
+// Inserts a [Node] as the first child of the given parent.
 func (x *Node) Prepend(NodeVar *Node) *Node {
 
 	cret := xNodePrepend(x.GoPointer(), NodeVar)
@@ -289,7 +292,7 @@ func (x *Node) Prepend(NodeVar *Node) *Node {
 
 var xNodeReverseChildren func(uintptr)
 
-// Reverses the order of the children of a #GNode.
+// Reverses the order of the children of a [Node].
 // (It doesn't change the order of the grandchildren.)
 func (x *Node) ReverseChildren() {
 
@@ -299,10 +302,10 @@ func (x *Node) ReverseChildren() {
 
 var xNodeTraverse func(uintptr, TraverseType, TraverseFlags, int, uintptr, uintptr)
 
-// Traverses a tree starting at the given root #GNode.
+// Traverses a tree starting at the given root [Node].
 // It calls the given function for each node visited.
-// The traversal can be halted at any point by returning %TRUE from @func.
-// @func must not do anything that would modify the structure of the tree.
+// The traversal can be halted at any point by returning true from func.
+// func must not do anything that would modify the structure of the tree.
 func (x *Node) Traverse(OrderVar TraverseType, FlagsVar TraverseFlags, MaxDepthVar int, FuncVar *NodeTraverseFunc, DataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -312,11 +315,12 @@ func (x *Node) Traverse(OrderVar TraverseType, FlagsVar TraverseFlags, MaxDepthV
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Node, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -326,7 +330,7 @@ func (x *Node) Traverse(OrderVar TraverseType, FlagsVar TraverseFlags, MaxDepthV
 
 var xNodeUnlink func(uintptr)
 
-// Unlinks a #GNode from a tree, resulting in two separate trees.
+// Unlinks a [Node] from a tree, resulting in two separate trees.
 func (x *Node) Unlink() {
 
 	xNodeUnlink(x.GoPointer())
@@ -334,62 +338,90 @@ func (x *Node) Unlink() {
 }
 
 // Specifies which nodes are visited during several of the tree
-// functions, including g_node_traverse() and g_node_find().
-type TraverseFlags int
+// functions, including [Node.Traverse] and [Node.Find].
+type TraverseFlags uint32
 
 const (
 
 	// only leaf nodes should be visited. This name has
 	//                     been introduced in 2.6, for older version use
-	//                     %G_TRAVERSE_LEAFS.
+	//                     [GTraverseLeafsValue].
 	GTraverseLeavesValue TraverseFlags = 1
 	// only non-leaf nodes should be visited. This
 	//                         name has been introduced in 2.6, for older
-	//                         version use %G_TRAVERSE_NON_LEAFS.
+	//                         version use [GTraverseNonLeafsValue].
 	GTraverseNonLeavesValue TraverseFlags = 2
 	// all nodes should be visited.
 	GTraverseAllValue TraverseFlags = 3
 	// a mask of all traverse flags.
 	GTraverseMaskValue TraverseFlags = 3
-	// identical to %G_TRAVERSE_LEAVES.
+	// identical to [GTraverseLeavesValue].
 	GTraverseLeafsValue TraverseFlags = 1
-	// identical to %G_TRAVERSE_NON_LEAVES.
+	// identical to [GTraverseNonLeavesValue].
 	GTraverseNonLeafsValue TraverseFlags = 2
 )
 
-// Specifies the type of traversal performed by g_tree_traverse(),
-// g_node_traverse() and g_node_find().
+// Has reports whether all bits of flags are set in x
+func (x TraverseFlags) Has(flags TraverseFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TraverseFlags) Set(flags TraverseFlags) TraverseFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TraverseFlags) Clear(flags TraverseFlags) TraverseFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TraverseFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GTraverseLeavesValue != 0 && x&GTraverseLeavesValue == GTraverseLeavesValue {
+		names = append(names, "G_TRAVERSE_LEAVES")
+		rest &^= GTraverseLeavesValue
+	}
+	if rest&GTraverseNonLeavesValue != 0 && x&GTraverseNonLeavesValue == GTraverseNonLeavesValue {
+		names = append(names, "G_TRAVERSE_NON_LEAVES")
+		rest &^= GTraverseNonLeavesValue
+	}
+	if rest&GTraverseAllValue != 0 && x&GTraverseAllValue == GTraverseAllValue {
+		names = append(names, "G_TRAVERSE_ALL")
+		rest &^= GTraverseAllValue
+	}
+	if rest&GTraverseMaskValue != 0 && x&GTraverseMaskValue == GTraverseMaskValue {
+		names = append(names, "G_TRAVERSE_MASK")
+		rest &^= GTraverseMaskValue
+	}
+	if rest&GTraverseLeafsValue != 0 && x&GTraverseLeafsValue == GTraverseLeafsValue {
+		names = append(names, "G_TRAVERSE_LEAFS")
+		rest &^= GTraverseLeafsValue
+	}
+	if rest&GTraverseNonLeafsValue != 0 && x&GTraverseNonLeafsValue == GTraverseNonLeafsValue {
+		names = append(names, "G_TRAVERSE_NON_LEAFS")
+		rest &^= GTraverseNonLeafsValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Specifies the type of traversal performed by [Tree.Traverse],
+// [Node.Traverse] and [Node.Find].
 //
 // The different orders are illustrated here:
 //
-//   - In order: A, B, C, D, E, F, G, H, I
-//     &lt;picture&gt;
-//     &lt;source srcset="Sorted_binary_tree_inorder-dark.svg"
-//     media="(prefers-color-scheme: dark)"&gt;
-//     &lt;img src="Sorted_binary_tree_inorder.svg"
-//     alt="Sorted binary tree, in-order traversal"&gt;
-//     &lt;/picture&gt;
-//   - Pre order: F, B, A, D, C, E, G, I, H
-//     &lt;picture&gt;
-//     &lt;source srcset="Sorted_binary_tree_preorder-dark.svg"
-//     media="(prefers-color-scheme: dark)"&gt;
-//     &lt;img src="Sorted_binary_tree_preorder.svg"
-//     alt="Sorted binary tree, pre-order traversal"&gt;
-//     &lt;/picture&gt;
-//   - Post order: A, C, E, D, B, H, I, G, F
-//     &lt;picture&gt;
-//     &lt;source srcset="Sorted_binary_tree_postorder-dark.svg"
-//     media="(prefers-color-scheme: dark)"&gt;
-//     &lt;img src="Sorted_binary_tree_postorder.svg"
-//     alt="Sorted binary tree, post-order traversal"&gt;
-//     &lt;/picture&gt;
-//   - Level order: F, B, G, A, D, I, C, E, H
-//     &lt;picture&gt;
-//     &lt;source srcset="Sorted_binary_tree_breadth-first_traversal-dark.svg"
-//     media="(prefers-color-scheme: dark)"&gt;
-//     &lt;img src="Sorted_binary_tree_breadth-first_traversal.svg"
-//     alt="Sorted binary tree, breadth-first level order traversal"&gt;
-//     &lt;/picture&gt;
+// - In order: A, B, C, D, E, F, G, H, I
+// - Pre order: F, B, A, D, C, E, G, I, H
+// - Post order: A, C, E, D, B, H, I, G, F
+// - Level order: F, B, G, A, D, I, C, E, H
 type TraverseType int
 
 const (
@@ -404,50 +436,95 @@ const (
 	// visits the node's children, then the node itself.
 	GPostOrderValue TraverseType = 2
 	// is not implemented for
-	//              [balanced binary trees](data-structures.html#binary-trees).
-	//              For [n-ary trees](data-structures.html#n-ary-trees), it
+	//              balanced binary trees.
+	//              For n-ary trees, it
 	//              visits the root node first, then its children, then
 	//              its grandchildren, and so on. Note that this is less
 	//              efficient than the other orders.
 	GLevelOrderValue TraverseType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TraverseType) String() string {
+	switch x {
+	case GInOrderValue:
+		return "G_IN_ORDER"
+	case GPreOrderValue:
+		return "G_PRE_ORDER"
+	case GPostOrderValue:
+		return "G_POST_ORDER"
+	case GLevelOrderValue:
+		return "G_LEVEL_ORDER"
+	}
+	return fmt.Sprintf("TraverseType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TraverseType) Nick() string {
+	switch x {
+	case GInOrderValue:
+		return "in-order"
+	case GPreOrderValue:
+		return "pre-order"
+	case GPostOrderValue:
+		return "post-order"
+	case GLevelOrderValue:
+		return "level-order"
+	}
+	return ""
+}
+
+// TraverseTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TraverseTypeFromString(s string) (TraverseType, bool) {
+	switch s {
+	case "G_IN_ORDER", "in-order":
+		return GInOrderValue, true
+	case "G_PRE_ORDER", "pre-order":
+		return GPreOrderValue, true
+	case "G_POST_ORDER", "post-order":
+		return GPostOrderValue, true
+	case "G_LEVEL_ORDER", "level-order":
+		return GLevelOrderValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xNodeChildIndex, libs, "g_node_child_index")
-	core.PuregoSafeRegister(&xNodeChildPosition, libs, "g_node_child_position")
-	core.PuregoSafeRegister(&xNodeChildrenForeach, libs, "g_node_children_foreach")
-	core.PuregoSafeRegister(&xNodeCopy, libs, "g_node_copy")
-	core.PuregoSafeRegister(&xNodeCopyDeep, libs, "g_node_copy_deep")
-	core.PuregoSafeRegister(&xNodeDepth, libs, "g_node_depth")
-	core.PuregoSafeRegister(&xNodeDestroy, libs, "g_node_destroy")
-	core.PuregoSafeRegister(&xNodeFind, libs, "g_node_find")
-	core.PuregoSafeRegister(&xNodeFindChild, libs, "g_node_find_child")
-	core.PuregoSafeRegister(&xNodeFirstSibling, libs, "g_node_first_sibling")
-	core.PuregoSafeRegister(&xNodeGetRoot, libs, "g_node_get_root")
-	core.PuregoSafeRegister(&xNodeInsert, libs, "g_node_insert")
-	core.PuregoSafeRegister(&xNodeInsertAfter, libs, "g_node_insert_after")
-	core.PuregoSafeRegister(&xNodeInsertBefore, libs, "g_node_insert_before")
-	core.PuregoSafeRegister(&xNodeIsAncestor, libs, "g_node_is_ancestor")
-	core.PuregoSafeRegister(&xNodeLastChild, libs, "g_node_last_child")
-	core.PuregoSafeRegister(&xNodeLastSibling, libs, "g_node_last_sibling")
-	core.PuregoSafeRegister(&xNodeMaxHeight, libs, "g_node_max_height")
-	core.PuregoSafeRegister(&xNodeNChildren, libs, "g_node_n_children")
-	core.PuregoSafeRegister(&xNodeNNodes, libs, "g_node_n_nodes")
-	core.PuregoSafeRegister(&xNodeNthChild, libs, "g_node_nth_child")
-	core.PuregoSafeRegister(&xNodePrepend, libs, "g_node_prepend")
-	core.PuregoSafeRegister(&xNodeReverseChildren, libs, "g_node_reverse_children")
-	core.PuregoSafeRegister(&xNodeTraverse, libs, "g_node_traverse")
-	core.PuregoSafeRegister(&xNodeUnlink, libs, "g_node_unlink")
+	core.PuregoRegisterSince(&xNodeChildIndex, libs, "g_node_child_index", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeChildPosition, libs, "g_node_child_position", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeChildrenForeach, libs, "g_node_children_foreach", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeCopy, libs, "g_node_copy", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeCopyDeep, libs, "g_node_copy_deep", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xNodeDepth, libs, "g_node_depth", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeDestroy, libs, "g_node_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeFind, libs, "g_node_find", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeFindChild, libs, "g_node_find_child", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeFirstSibling, libs, "g_node_first_sibling", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeGetRoot, libs, "g_node_get_root", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeInsert, libs, "g_node_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeInsertAfter, libs, "g_node_insert_after", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeInsertBefore, libs, "g_node_insert_before", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeIsAncestor, libs, "g_node_is_ancestor", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeLastChild, libs, "g_node_last_child", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeLastSibling, libs, "g_node_last_sibling", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeMaxHeight, libs, "g_node_max_height", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeNChildren, libs, "g_node_n_children", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeNNodes, libs, "g_node_n_nodes", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeNthChild, libs, "g_node_nth_child", "GLIB", "")
+	core.PuregoRegisterSince(&xNodePrepend, libs, "g_node_prepend", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeReverseChildren, libs, "g_node_reverse_children", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeTraverse, libs, "g_node_traverse", "GLIB", "")
+	core.PuregoRegisterSince(&xNodeUnlink, libs, "g_node_unlink", "GLIB", "")
 
 }
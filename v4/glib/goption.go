@@ -2,15 +2,16 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of function to be passed as callback for %G_OPTION_ARG_CALLBACK
+// The type of function to be passed as callback for [GOptionArgCallbackValue]
 // options.
 type OptionArgFunc func(string, string, uintptr, **Error) bool
 
@@ -33,9 +34,9 @@ func (x *OptionContext) GoPointer() uintptr {
 
 var xOptionContextAddGroup func(uintptr, *OptionGroup)
 
-// Adds a #GOptionGroup to the @context, so that parsing with @context
+// Adds a [OptionGroup] to the context, so that parsing with context
 // will recognize the options in the group. Note that this will take
-// ownership of the @group and thus the @group should not be freed.
+// ownership of the group and thus the group should not be freed.
 func (x *OptionContext) AddGroup(GroupVar *OptionGroup) {
 
 	xOptionContextAddGroup(x.GoPointer(), GroupVar)
@@ -45,7 +46,7 @@ func (x *OptionContext) AddGroup(GroupVar *OptionGroup) {
 var xOptionContextAddMainEntries func(uintptr, []OptionEntry, uintptr)
 
 // A convenience function which creates a main group if it doesn't
-// exist, adds the @entries to it and sets the translation domain.
+// exist, adds the entries to it and sets the translation domain.
 func (x *OptionContext) AddMainEntries(EntriesVar []OptionEntry, TranslationDomainVar *string) {
 
 	TranslationDomainVarPtr := core.GStrdupNullable(TranslationDomainVar)
@@ -61,7 +62,7 @@ var xOptionContextFree func(uintptr)
 // added to it.
 //
 // Please note that parsed arguments need to be freed separately (see
-// #GOptionEntry).
+// [OptionEntry]).
 func (x *OptionContext) Free() {
 
 	xOptionContextFree(x.GoPointer())
@@ -70,7 +71,7 @@ func (x *OptionContext) Free() {
 
 var xOptionContextGetDescription func(uintptr) string
 
-// Returns the description. See g_option_context_set_description().
+// Returns the description. See [OptionContext.SetDescription].
 func (x *OptionContext) GetDescription() string {
 
 	cret := xOptionContextGetDescription(x.GoPointer())
@@ -95,7 +96,7 @@ func (x *OptionContext) GetHelp(MainHelpVar bool, GroupVar *OptionGroup) string
 var xOptionContextGetHelpEnabled func(uintptr) bool
 
 // Returns whether automatic `--help` generation
-// is turned on for @context. See g_option_context_set_help_enabled().
+// is turned on for context. See [OptionContext.SetHelpEnabled].
 func (x *OptionContext) GetHelpEnabled() bool {
 
 	cret := xOptionContextGetHelpEnabled(x.GoPointer())
@@ -105,7 +106,7 @@ func (x *OptionContext) GetHelpEnabled() bool {
 var xOptionContextGetIgnoreUnknownOptions func(uintptr) bool
 
 // Returns whether unknown options are ignored or not. See
-// g_option_context_set_ignore_unknown_options().
+// [OptionContext.SetIgnoreUnknownOptions].
 func (x *OptionContext) GetIgnoreUnknownOptions() bool {
 
 	cret := xOptionContextGetIgnoreUnknownOptions(x.GoPointer())
@@ -114,7 +115,7 @@ func (x *OptionContext) GetIgnoreUnknownOptions() bool {
 
 var xOptionContextGetMainGroup func(uintptr) *OptionGroup
 
-// Returns a pointer to the main group of @context.
+// Returns a pointer to the main group of context.
 func (x *OptionContext) GetMainGroup() *OptionGroup {
 
 	cret := xOptionContextGetMainGroup(x.GoPointer())
@@ -125,7 +126,7 @@ var xOptionContextGetStrictPosix func(uintptr) bool
 
 // Returns whether strict POSIX code is enabled.
 //
-// See g_option_context_set_strict_posix() for more information.
+// See [OptionContext.SetStrictPosix] for more information.
 func (x *OptionContext) GetStrictPosix() bool {
 
 	cret := xOptionContextGetStrictPosix(x.GoPointer())
@@ -134,7 +135,7 @@ func (x *OptionContext) GetStrictPosix() bool {
 
 var xOptionContextGetSummary func(uintptr) string
 
-// Returns the summary. See g_option_context_set_summary().
+// Returns the summary. See [OptionContext.SetSummary].
 func (x *OptionContext) GetSummary() string {
 
 	cret := xOptionContextGetSummary(x.GoPointer())
@@ -144,32 +145,32 @@ func (x *OptionContext) GetSummary() string {
 var xOptionContextParse func(uintptr, int, []string, **Error) bool
 
 // Parses the command line arguments, recognizing options
-// which have been added to @context. A side-effect of
-// calling this function is that g_set_prgname() will be
+// which have been added to context. A side-effect of
+// calling this function is that [SetPrgname] will be
 // called.
 //
 // If the parsing is successful, any parsed arguments are
-// removed from the array and @argc and @argv are updated
-// accordingly. A '--' option is stripped from @argv
+// removed from the array and argc and argv are updated
+// accordingly. A '--' option is stripped from argv
 // unless there are unparsed options before and after it,
 // or some of the options after it start with '-'. In case
-// of an error, @argc and @argv are left unmodified.
+// of an error, argc and argv are left unmodified.
 //
 // If automatic `--help` support is enabled
-// (see g_option_context_set_help_enabled()), and the
-// @argv array contains one of the recognized help options,
+// (see [OptionContext.SetHelpEnabled]), and the
+// argv array contains one of the recognized help options,
 // this function will produce help output to stdout and
 // call `exit (0)`.
 //
 // Note that function depends on the
-// [current locale](running.html#locale) for automatic
+// current locale for automatic
 // character set conversion of string and filename arguments.
 func (x *OptionContext) Parse(ArgcVar int, ArgvVar []string) (bool, error) {
 	var cerr *Error
 
 	cret := xOptionContextParse(x.GoPointer(), ArgcVar, ArgvVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -179,26 +180,26 @@ var xOptionContextParseStrv func(uintptr, []string, **Error) bool
 
 // Parses the command line arguments.
 //
-// This function is similar to g_option_context_parse() except that it
+// This function is similar to [OptionContext.Parse] except that it
 // respects the normal memory rules when dealing with a strv instead of
 // assuming that the passed-in array is the argv of the main function.
 //
 // In particular, strings that are removed from the arguments list will
-// be freed using g_free().
+// be freed using [Free].
 //
 // On Windows, the strings are expected to be in UTF-8.  This is in
-// contrast to g_option_context_parse() which expects them to be in the
-// system codepage, which is how they are passed as @argv to main().
+// contrast to [OptionContext.Parse] which expects them to be in the
+// system codepage, which is how they are passed as argv to main().
 // See g_win32_get_command_line() for a solution.
 //
-// This function is useful if you are trying to use #GOptionContext with
-// #GApplication.
+// This function is useful if you are trying to use [OptionContext] with
+// [gio.Application].
 func (x *OptionContext) ParseStrv(ArgumentsVar []string) (bool, error) {
 	var cerr *Error
 
 	cret := xOptionContextParseStrv(x.GoPointer(), ArgumentsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -210,7 +211,7 @@ var xOptionContextSetDescription func(uintptr, uintptr)
 // of options. This text often includes a bug reporting address.
 //
 // Note that the summary is translated (see
-// g_option_context_set_translate_func()).
+// [OptionContext.SetTranslateFunc]).
 func (x *OptionContext) SetDescription(DescriptionVar *string) {
 
 	DescriptionVarPtr := core.GStrdupNullable(DescriptionVar)
@@ -223,7 +224,7 @@ func (x *OptionContext) SetDescription(DescriptionVar *string) {
 var xOptionContextSetHelpEnabled func(uintptr, bool)
 
 // Enables or disables automatic generation of `--help` output.
-// By default, g_option_context_parse() recognizes `--help`, `-h`,
+// By default, [OptionContext.Parse] recognizes `--help`, `-h`,
 // `-?`, `--help-all` and `--help-groupname` and creates suitable
 // output to stdout.
 func (x *OptionContext) SetHelpEnabled(HelpEnabledVar bool) {
@@ -235,8 +236,8 @@ func (x *OptionContext) SetHelpEnabled(HelpEnabledVar bool) {
 var xOptionContextSetIgnoreUnknownOptions func(uintptr, bool)
 
 // Sets whether to ignore unknown options or not. If an argument is
-// ignored, it is left in the @argv array after parsing. By default,
-// g_option_context_parse() treats unknown options as error.
+// ignored, it is left in the argv array after parsing. By default,
+// [OptionContext.Parse] treats unknown options as error.
 //
 // This setting does not affect non-option arguments (i.e. arguments
 // which don't start with a dash). But note that GOption cannot reliably
@@ -249,8 +250,8 @@ func (x *OptionContext) SetIgnoreUnknownOptions(IgnoreUnknownVar bool) {
 
 var xOptionContextSetMainGroup func(uintptr, *OptionGroup)
 
-// Sets a #GOptionGroup as main group of the @context.
-// This has the same effect as calling g_option_context_add_group(),
+// Sets a [OptionGroup] as main group of the context.
+// This has the same effect as calling [OptionContext.AddGroup],
 // the only difference is that the options in the main group are
 // treated differently when generating `--help` output.
 func (x *OptionContext) SetMainGroup(GroupVar *OptionGroup) {
@@ -297,8 +298,8 @@ var xOptionContextSetSummary func(uintptr, uintptr)
 // of options. This is typically a summary of the program functionality.
 //
 // Note that the summary is translated (see
-// g_option_context_set_translate_func() and
-// g_option_context_set_translation_domain()).
+// [OptionContext.SetTranslateFunc] and
+// [OptionContext.SetTranslationDomain]).
 func (x *OptionContext) SetSummary(SummaryVar *string) {
 
 	SummaryVarPtr := core.GStrdupNullable(SummaryVar)
@@ -311,16 +312,16 @@ func (x *OptionContext) SetSummary(SummaryVar *string) {
 var xOptionContextSetTranslateFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets the function which is used to translate the contexts
-// user-visible strings, for `--help` output. If @func is %NULL,
+// user-visible strings, for `--help` output. If func is nil,
 // strings are not translated.
 //
 // Note that option groups have their own translation functions,
-// this function only affects the @parameter_string (see g_option_context_new()),
-// the summary (see g_option_context_set_summary()) and the description
-// (see g_option_context_set_description()).
+// this function only affects the parameter_string (see g_option_context_new()),
+// the summary (see [OptionContext.SetSummary]) and the description
+// (see [OptionContext.SetDescription]).
 //
 // If you are using gettext(), you only need to set the translation
-// domain, see g_option_context_set_translation_domain().
+// domain, see [OptionContext.SetTranslationDomain].
 func (x *OptionContext) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr, DestroyNotifyVar *DestroyNotify) {
 
 	var FuncVarRef uintptr
@@ -330,11 +331,12 @@ func (x *OptionContext) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) string {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(core.GoString(arg0), arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -345,11 +347,12 @@ func (x *OptionContext) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr
 			DestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyNotifyVar
 				cbFn(arg0)
 			}
-			DestroyNotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
+			DestroyNotifyVarRef = core.NewCallback(fcb)
+			DestroyNotifyVarRef = SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
 		}
 	}
 
@@ -367,29 +370,29 @@ func (x *OptionContext) SetTranslationDomain(DomainVar string) {
 
 }
 
-// - %G_OPTION_ARG_NONE: %gboolean
+// - [GOptionArgNoneValue]: %gboolean
 //
-//   - %G_OPTION_ARG_STRING: %gchar*
+//   - [GOptionArgStringValue]: %gchar*
 //
-//   - %G_OPTION_ARG_INT: %gint
+//   - [GOptionArgIntValue]: %gint
 //
-//   - %G_OPTION_ARG_FILENAME: %gchar*
+//   - [GOptionArgFilenameValue]: %gchar*
 //
-//   - %G_OPTION_ARG_STRING_ARRAY: %gchar**
+//   - [GOptionArgStringArrayValue]: %gchar**
 //
-//   - %G_OPTION_ARG_FILENAME_ARRAY: %gchar**
+//   - [GOptionArgFilenameArrayValue]: %gchar**
 //
-//   - %G_OPTION_ARG_DOUBLE: %gdouble
+//   - [GOptionArgDoubleValue]: %gdouble
 //
-//     If @arg type is %G_OPTION_ARG_STRING or %G_OPTION_ARG_FILENAME,
+//     If arg type is [GOptionArgStringValue] or [GOptionArgFilenameValue],
 //     the location will contain a newly allocated string if the option
-//     was given. That string needs to be freed by the callee using g_free().
-//     Likewise if @arg type is %G_OPTION_ARG_STRING_ARRAY or
-//     %G_OPTION_ARG_FILENAME_ARRAY, the data should be freed using g_strfreev().
+//     was given. That string needs to be freed by the callee using [Free].
+//     Likewise if arg type is [GOptionArgStringArrayValue] or
+//     [GOptionArgFilenameArrayValue], the data should be freed using [Strfreev].
 //
 // A GOptionEntry struct defines a single option. To have an effect, they
-// must be added to a #GOptionGroup with g_option_context_add_main_entries()
-// or g_option_group_add_entries().
+// must be added to a [OptionGroup] with [OptionContext.AddMainEntries]
+// or [OptionGroup.AddEntries].
 type OptionEntry struct {
 	_ structs.HostLayout
 
@@ -418,7 +421,7 @@ func (x *OptionEntry) GoPointer() uintptr {
 // All options in a group share the same translation function. Libraries which
 // need to parse commandline options are expected to provide a function for
 // getting a `GOptionGroup` holding their options, which
-// the application can then add to its #GOptionContext.
+// the application can then add to its [OptionContext].
 type OptionGroup struct {
 	_ structs.HostLayout
 }
@@ -435,9 +438,9 @@ func (x *OptionGroup) GoPointer() uintptr {
 
 var xNewOptionGroup func(string, string, string, uintptr, uintptr) *OptionGroup
 
-// Creates a new #GOptionGroup.
+// Creates a new [OptionGroup].
 //
-// @description is typically used to provide a title for the group. If so, it
+// description is typically used to provide a title for the group. If so, it
 // is recommended that it’s written in title case, and has a trailing colon so
 // that it matches the style of built-in GLib group titles such as
 // ‘Application Options:’.
@@ -450,11 +453,12 @@ func NewOptionGroup(NameVar string, DescriptionVar string, HelpDescriptionVar st
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -464,26 +468,16 @@ func NewOptionGroup(NameVar string, DescriptionVar string, HelpDescriptionVar st
 
 var xOptionGroupAddEntries func(uintptr, []OptionEntry)
 
-// Adds the options specified in @entries to @group.
+// Adds the options specified in entries to group.
 func (x *OptionGroup) AddEntries(EntriesVar []OptionEntry) {
 
 	xOptionGroupAddEntries(x.GoPointer(), EntriesVar)
 
 }
 
-var xOptionGroupFree func(uintptr)
-
-// Frees a #GOptionGroup. Note that you must not free groups
-// which have been added to a #GOptionContext.
-func (x *OptionGroup) Free() {
-
-	xOptionGroupFree(x.GoPointer())
-
-}
-
 var xOptionGroupRef func(uintptr) *OptionGroup
 
-// Increments the reference count of @group by one.
+// Increments the reference count of group by one.
 func (x *OptionGroup) Ref() *OptionGroup {
 
 	cret := xOptionGroupRef(x.GoPointer())
@@ -492,11 +486,11 @@ func (x *OptionGroup) Ref() *OptionGroup {
 
 var xOptionGroupSetErrorHook func(uintptr, uintptr)
 
-// Associates a function with @group which will be called
-// from g_option_context_parse() when an error occurs.
+// Associates a function with group which will be called
+// from [OptionContext.Parse] when an error occurs.
 //
-// Note that the user data to be passed to @error_func can be
-// specified when constructing the group with g_option_group_new().
+// Note that the user data to be passed to error_func can be
+// specified when constructing the group with [NewOptionGroup].
 func (x *OptionGroup) SetErrorHook(ErrorFuncVar *OptionErrorFunc) {
 
 	var ErrorFuncVarRef uintptr
@@ -506,11 +500,12 @@ func (x *OptionGroup) SetErrorHook(ErrorFuncVar *OptionErrorFunc) {
 			ErrorFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *OptionContext, arg1 *OptionGroup, arg2 uintptr, arg3 **Error) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorFuncVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			ErrorFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ErrorFuncVarPtr, ErrorFuncVarRef, ErrorFuncVar)
+			ErrorFuncVarRef = core.NewCallback(fcb)
+			ErrorFuncVarRef = SaveCallbackWithClosure(ErrorFuncVarPtr, ErrorFuncVarRef, ErrorFuncVar)
 		}
 	}
 
@@ -520,13 +515,13 @@ func (x *OptionGroup) SetErrorHook(ErrorFuncVar *OptionErrorFunc) {
 
 var xOptionGroupSetParseHooks func(uintptr, uintptr, uintptr)
 
-// Associates two functions with @group which will be called
-// from g_option_context_parse() before the first option is parsed
+// Associates two functions with group which will be called
+// from [OptionContext.Parse] before the first option is parsed
 // and after the last option has been parsed, respectively.
 //
-// Note that the user data to be passed to @pre_parse_func and
-// @post_parse_func can be specified when constructing the group
-// with g_option_group_new().
+// Note that the user data to be passed to pre_parse_func and
+// post_parse_func can be specified when constructing the group
+// with [NewOptionGroup].
 func (x *OptionGroup) SetParseHooks(PreParseFuncVar *OptionParseFunc, PostParseFuncVar *OptionParseFunc) {
 
 	var PreParseFuncVarRef uintptr
@@ -536,11 +531,12 @@ func (x *OptionGroup) SetParseHooks(PreParseFuncVar *OptionParseFunc, PostParseF
 			PreParseFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *OptionContext, arg1 *OptionGroup, arg2 uintptr, arg3 **Error) bool {
+				defer core.RecoverPanic()
 				cbFn := *PreParseFuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			PreParseFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(PreParseFuncVarPtr, PreParseFuncVarRef, PreParseFuncVar)
+			PreParseFuncVarRef = core.NewCallback(fcb)
+			PreParseFuncVarRef = SaveCallbackWithClosure(PreParseFuncVarPtr, PreParseFuncVarRef, PreParseFuncVar)
 		}
 	}
 
@@ -551,11 +547,12 @@ func (x *OptionGroup) SetParseHooks(PreParseFuncVar *OptionParseFunc, PostParseF
 			PostParseFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *OptionContext, arg1 *OptionGroup, arg2 uintptr, arg3 **Error) bool {
+				defer core.RecoverPanic()
 				cbFn := *PostParseFuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			PostParseFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(PostParseFuncVarPtr, PostParseFuncVarRef, PostParseFuncVar)
+			PostParseFuncVarRef = core.NewCallback(fcb)
+			PostParseFuncVarRef = SaveCallbackWithClosure(PostParseFuncVarPtr, PostParseFuncVarRef, PostParseFuncVar)
 		}
 	}
 
@@ -567,10 +564,10 @@ var xOptionGroupSetTranslateFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets the function which is used to translate user-visible strings,
 // for `--help` output. Different groups can use different
-// #GTranslateFuncs. If @func is %NULL, strings are not translated.
+// GTranslateFuncs. If func is nil, strings are not translated.
 //
 // If you are using gettext(), you only need to set the translation
-// domain, see g_option_group_set_translation_domain().
+// domain, see [OptionGroup.SetTranslationDomain].
 func (x *OptionGroup) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr, DestroyNotifyVar *DestroyNotify) {
 
 	var FuncVarRef uintptr
@@ -580,11 +577,12 @@ func (x *OptionGroup) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr,
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) string {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(core.GoString(arg0), arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -595,11 +593,12 @@ func (x *OptionGroup) SetTranslateFunc(FuncVar *TranslateFunc, DataVar uintptr,
 			DestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyNotifyVar
 				cbFn(arg0)
 			}
-			DestroyNotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
+			DestroyNotifyVarRef = core.NewCallback(fcb)
+			DestroyNotifyVarRef = SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
 		}
 	}
 
@@ -619,9 +618,9 @@ func (x *OptionGroup) SetTranslationDomain(DomainVar string) {
 
 var xOptionGroupUnref func(uintptr)
 
-// Decrements the reference count of @group by one.
-// If the reference count drops to 0, the @group will be freed.
-// and all memory allocated by the @group is released.
+// Decrements the reference count of group by one.
+// If the reference count drops to 0, the group will be freed.
+// and all memory allocated by the group is released.
 func (x *OptionGroup) Unref() {
 
 	xOptionGroupUnref(x.GoPointer())
@@ -632,18 +631,17 @@ const (
 	// If a long option in the main group has this name, it is not treated as a
 	// regular option. Instead it collects all non-option arguments which would
 	// otherwise be left in `argv`. The option must be of type
-	// %G_OPTION_ARG_CALLBACK, %G_OPTION_ARG_STRING_ARRAY
-	// or %G_OPTION_ARG_FILENAME_ARRAY.
+	// [GOptionArgCallbackValue], [GOptionArgStringArrayValue]
+	// or [GOptionArgFilenameArrayValue].
 	//
-	//
-	// Using %G_OPTION_REMAINING instead of simply scanning `argv`
+	// Using [OPTION_REMAINING] instead of simply scanning `argv`
 	// for leftover arguments has the advantage that GOption takes care of
 	// necessary encoding conversions for strings or filenames.
 	OPTION_REMAINING string = ""
 )
 
 // Flags which modify individual options.
-type OptionFlags int
+type OptionFlags uint32
 
 const (
 
@@ -654,19 +652,19 @@ const (
 	// The option appears in the main section of the
 	//   `--help` output, even if it is defined in a group.
 	GOptionFlagInMainValue OptionFlags = 2
-	// For options of the %G_OPTION_ARG_NONE kind, this
-	//   flag indicates that the sense of the option is reversed. i.e. %FALSE will
-	//   be stored into the argument rather than %TRUE.
+	// For options of the [GOptionArgNoneValue] kind, this
+	//   flag indicates that the sense of the option is reversed. i.e. false will
+	//   be stored into the argument rather than true.
 	GOptionFlagReverseValue OptionFlags = 4
-	// For options of the %G_OPTION_ARG_CALLBACK kind,
+	// For options of the [GOptionArgCallbackValue] kind,
 	//   this flag indicates that the callback does not take any argument
-	//   (like a %G_OPTION_ARG_NONE option). Since 2.8
+	//   (like a [GOptionArgNoneValue] option). Since 2.8
 	GOptionFlagNoArgValue OptionFlags = 8
-	// For options of the %G_OPTION_ARG_CALLBACK
+	// For options of the [GOptionArgCallbackValue]
 	//   kind, this flag indicates that the argument should be passed to the
 	//   callback in the GLib filename encoding rather than UTF-8. Since 2.8
 	GOptionFlagFilenameValue OptionFlags = 16
-	// For options of the %G_OPTION_ARG_CALLBACK
+	// For options of the [GOptionArgCallbackValue]
 	//   kind, this flag indicates that the argument supply is optional.
 	//   If no argument is given then data of %GOptionParseFunc will be
 	//   set to NULL. Since 2.8
@@ -686,7 +684,67 @@ const (
 	GOptionFlagDeprecatedValue OptionFlags = 128
 )
 
-// The #GOptionArg enum values determine which type of extra argument the
+// Has reports whether all bits of flags are set in x
+func (x OptionFlags) Has(flags OptionFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x OptionFlags) Set(flags OptionFlags) OptionFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x OptionFlags) Clear(flags OptionFlags) OptionFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x OptionFlags) String() string {
+	if x == 0 {
+		return "G_OPTION_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GOptionFlagHiddenValue != 0 && x&GOptionFlagHiddenValue == GOptionFlagHiddenValue {
+		names = append(names, "G_OPTION_FLAG_HIDDEN")
+		rest &^= GOptionFlagHiddenValue
+	}
+	if rest&GOptionFlagInMainValue != 0 && x&GOptionFlagInMainValue == GOptionFlagInMainValue {
+		names = append(names, "G_OPTION_FLAG_IN_MAIN")
+		rest &^= GOptionFlagInMainValue
+	}
+	if rest&GOptionFlagReverseValue != 0 && x&GOptionFlagReverseValue == GOptionFlagReverseValue {
+		names = append(names, "G_OPTION_FLAG_REVERSE")
+		rest &^= GOptionFlagReverseValue
+	}
+	if rest&GOptionFlagNoArgValue != 0 && x&GOptionFlagNoArgValue == GOptionFlagNoArgValue {
+		names = append(names, "G_OPTION_FLAG_NO_ARG")
+		rest &^= GOptionFlagNoArgValue
+	}
+	if rest&GOptionFlagFilenameValue != 0 && x&GOptionFlagFilenameValue == GOptionFlagFilenameValue {
+		names = append(names, "G_OPTION_FLAG_FILENAME")
+		rest &^= GOptionFlagFilenameValue
+	}
+	if rest&GOptionFlagOptionalArgValue != 0 && x&GOptionFlagOptionalArgValue == GOptionFlagOptionalArgValue {
+		names = append(names, "G_OPTION_FLAG_OPTIONAL_ARG")
+		rest &^= GOptionFlagOptionalArgValue
+	}
+	if rest&GOptionFlagNoaliasValue != 0 && x&GOptionFlagNoaliasValue == GOptionFlagNoaliasValue {
+		names = append(names, "G_OPTION_FLAG_NOALIAS")
+		rest &^= GOptionFlagNoaliasValue
+	}
+	if rest&GOptionFlagDeprecatedValue != 0 && x&GOptionFlagDeprecatedValue == GOptionFlagDeprecatedValue {
+		names = append(names, "G_OPTION_FLAG_DEPRECATED")
+		rest &^= GOptionFlagDeprecatedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// The [OptionArg] enum values determine which type of extra argument the
 // options expect to find. If an option expects an extra argument, it can
 // be specified in several ways; with a short option: `-x arg`, with a long
 // option: `--name arg` or combined in a single argument: `--name=arg`.
@@ -700,7 +758,7 @@ const (
 	GOptionArgStringValue OptionArg = 1
 	// The option takes an integer argument.
 	GOptionArgIntValue OptionArg = 2
-	// The option provides a callback (of type #GOptionArgFunc)
+	// The option provides a callback (of type [OptionArgFunc])
 	//   to parse the extra argument.
 	GOptionArgCallbackValue OptionArg = 3
 	// The option takes a filename as argument, which will
@@ -717,12 +775,87 @@ const (
 	//   Since 2.12
 	GOptionArgDoubleValue OptionArg = 7
 	// The option takes a 64-bit integer. Like
-	//   %G_OPTION_ARG_INT but for larger numbers. The number can be in
+	//   [GOptionArgIntValue] but for larger numbers. The number can be in
 	//   decimal base, or in hexadecimal (when prefixed with `0x`, for
 	//   example, `0xffffffff`). Since 2.12
 	GOptionArgInt64Value OptionArg = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x OptionArg) String() string {
+	switch x {
+	case GOptionArgNoneValue:
+		return "G_OPTION_ARG_NONE"
+	case GOptionArgStringValue:
+		return "G_OPTION_ARG_STRING"
+	case GOptionArgIntValue:
+		return "G_OPTION_ARG_INT"
+	case GOptionArgCallbackValue:
+		return "G_OPTION_ARG_CALLBACK"
+	case GOptionArgFilenameValue:
+		return "G_OPTION_ARG_FILENAME"
+	case GOptionArgStringArrayValue:
+		return "G_OPTION_ARG_STRING_ARRAY"
+	case GOptionArgFilenameArrayValue:
+		return "G_OPTION_ARG_FILENAME_ARRAY"
+	case GOptionArgDoubleValue:
+		return "G_OPTION_ARG_DOUBLE"
+	case GOptionArgInt64Value:
+		return "G_OPTION_ARG_INT64"
+	}
+	return fmt.Sprintf("OptionArg(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x OptionArg) Nick() string {
+	switch x {
+	case GOptionArgNoneValue:
+		return "none"
+	case GOptionArgStringValue:
+		return "string"
+	case GOptionArgIntValue:
+		return "int"
+	case GOptionArgCallbackValue:
+		return "callback"
+	case GOptionArgFilenameValue:
+		return "filename"
+	case GOptionArgStringArrayValue:
+		return "string-array"
+	case GOptionArgFilenameArrayValue:
+		return "filename-array"
+	case GOptionArgDoubleValue:
+		return "double"
+	case GOptionArgInt64Value:
+		return "int64"
+	}
+	return ""
+}
+
+// OptionArgFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OptionArgFromString(s string) (OptionArg, bool) {
+	switch s {
+	case "G_OPTION_ARG_NONE", "none":
+		return GOptionArgNoneValue, true
+	case "G_OPTION_ARG_STRING", "string":
+		return GOptionArgStringValue, true
+	case "G_OPTION_ARG_INT", "int":
+		return GOptionArgIntValue, true
+	case "G_OPTION_ARG_CALLBACK", "callback":
+		return GOptionArgCallbackValue, true
+	case "G_OPTION_ARG_FILENAME", "filename":
+		return GOptionArgFilenameValue, true
+	case "G_OPTION_ARG_STRING_ARRAY", "string-array":
+		return GOptionArgStringArrayValue, true
+	case "G_OPTION_ARG_FILENAME_ARRAY", "filename-array":
+		return GOptionArgFilenameArrayValue, true
+	case "G_OPTION_ARG_DOUBLE", "double":
+		return GOptionArgDoubleValue, true
+	case "G_OPTION_ARG_INT64", "int64":
+		return GOptionArgInt64Value, true
+	}
+	return 0, false
+}
+
 // Error codes returned by option parsing.
 type OptionError int
 
@@ -730,58 +863,114 @@ const (
 
 	// An option was not known to the parser.
 	//  This error will only be reported, if the parser hasn't been instructed
-	//  to ignore unknown options, see g_option_context_set_ignore_unknown_options().
+	//  to ignore unknown options, see [OptionContext.SetIgnoreUnknownOptions].
 	GOptionErrorUnknownOptionValue OptionError = 0
 	// A value couldn't be parsed.
 	GOptionErrorBadValueValue OptionError = 1
-	// A #GOptionArgFunc callback failed.
+	// A [OptionArgFunc] callback failed.
 	GOptionErrorFailedValue OptionError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (OptionError) ErrorDomain() string {
+	return "g-option-context-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x OptionError) Error() string {
+	switch x {
+	case GOptionErrorUnknownOptionValue:
+		return "G_OPTION_ERROR_UNKNOWN_OPTION"
+	case GOptionErrorBadValueValue:
+		return "G_OPTION_ERROR_BAD_VALUE"
+	case GOptionErrorFailedValue:
+		return "G_OPTION_ERROR_FAILED"
+	}
+	return fmt.Sprintf("g-option-context-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x OptionError) String() string {
+	switch x {
+	case GOptionErrorUnknownOptionValue:
+		return "G_OPTION_ERROR_UNKNOWN_OPTION"
+	case GOptionErrorBadValueValue:
+		return "G_OPTION_ERROR_BAD_VALUE"
+	case GOptionErrorFailedValue:
+		return "G_OPTION_ERROR_FAILED"
+	}
+	return fmt.Sprintf("OptionError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x OptionError) Nick() string {
+	switch x {
+	case GOptionErrorUnknownOptionValue:
+		return "unknown-option"
+	case GOptionErrorBadValueValue:
+		return "bad-value"
+	case GOptionErrorFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// OptionErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OptionErrorFromString(s string) (OptionError, bool) {
+	switch s {
+	case "G_OPTION_ERROR_UNKNOWN_OPTION", "unknown-option":
+		return GOptionErrorUnknownOptionValue, true
+	case "G_OPTION_ERROR_BAD_VALUE", "bad-value":
+		return GOptionErrorBadValueValue, true
+	case "G_OPTION_ERROR_FAILED", "failed":
+		return GOptionErrorFailedValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xOptionContextAddGroup, libs, "g_option_context_add_group")
-	core.PuregoSafeRegister(&xOptionContextAddMainEntries, libs, "g_option_context_add_main_entries")
-	core.PuregoSafeRegister(&xOptionContextFree, libs, "g_option_context_free")
-	core.PuregoSafeRegister(&xOptionContextGetDescription, libs, "g_option_context_get_description")
-	core.PuregoSafeRegister(&xOptionContextGetHelp, libs, "g_option_context_get_help")
-	core.PuregoSafeRegister(&xOptionContextGetHelpEnabled, libs, "g_option_context_get_help_enabled")
-	core.PuregoSafeRegister(&xOptionContextGetIgnoreUnknownOptions, libs, "g_option_context_get_ignore_unknown_options")
-	core.PuregoSafeRegister(&xOptionContextGetMainGroup, libs, "g_option_context_get_main_group")
-	core.PuregoSafeRegister(&xOptionContextGetStrictPosix, libs, "g_option_context_get_strict_posix")
-	core.PuregoSafeRegister(&xOptionContextGetSummary, libs, "g_option_context_get_summary")
-	core.PuregoSafeRegister(&xOptionContextParse, libs, "g_option_context_parse")
-	core.PuregoSafeRegister(&xOptionContextParseStrv, libs, "g_option_context_parse_strv")
-	core.PuregoSafeRegister(&xOptionContextSetDescription, libs, "g_option_context_set_description")
-	core.PuregoSafeRegister(&xOptionContextSetHelpEnabled, libs, "g_option_context_set_help_enabled")
-	core.PuregoSafeRegister(&xOptionContextSetIgnoreUnknownOptions, libs, "g_option_context_set_ignore_unknown_options")
-	core.PuregoSafeRegister(&xOptionContextSetMainGroup, libs, "g_option_context_set_main_group")
-	core.PuregoSafeRegister(&xOptionContextSetStrictPosix, libs, "g_option_context_set_strict_posix")
-	core.PuregoSafeRegister(&xOptionContextSetSummary, libs, "g_option_context_set_summary")
-	core.PuregoSafeRegister(&xOptionContextSetTranslateFunc, libs, "g_option_context_set_translate_func")
-	core.PuregoSafeRegister(&xOptionContextSetTranslationDomain, libs, "g_option_context_set_translation_domain")
+	core.PuregoRegisterSince(&xOptionContextAddGroup, libs, "g_option_context_add_group", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextAddMainEntries, libs, "g_option_context_add_main_entries", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextFree, libs, "g_option_context_free", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextGetDescription, libs, "g_option_context_get_description", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xOptionContextGetHelp, libs, "g_option_context_get_help", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xOptionContextGetHelpEnabled, libs, "g_option_context_get_help_enabled", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextGetIgnoreUnknownOptions, libs, "g_option_context_get_ignore_unknown_options", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextGetMainGroup, libs, "g_option_context_get_main_group", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextGetStrictPosix, libs, "g_option_context_get_strict_posix", "GLIB", "2.44")
+	core.PuregoRegisterSince(&xOptionContextGetSummary, libs, "g_option_context_get_summary", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xOptionContextParse, libs, "g_option_context_parse", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextParseStrv, libs, "g_option_context_parse_strv", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xOptionContextSetDescription, libs, "g_option_context_set_description", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xOptionContextSetHelpEnabled, libs, "g_option_context_set_help_enabled", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextSetIgnoreUnknownOptions, libs, "g_option_context_set_ignore_unknown_options", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextSetMainGroup, libs, "g_option_context_set_main_group", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionContextSetStrictPosix, libs, "g_option_context_set_strict_posix", "GLIB", "2.44")
+	core.PuregoRegisterSince(&xOptionContextSetSummary, libs, "g_option_context_set_summary", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xOptionContextSetTranslateFunc, libs, "g_option_context_set_translate_func", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xOptionContextSetTranslationDomain, libs, "g_option_context_set_translation_domain", "GLIB", "2.12")
 
 	core.PuregoSafeRegister(&xOptionGroupGLibType, libs, "g_option_group_get_type")
 
-	core.PuregoSafeRegister(&xNewOptionGroup, libs, "g_option_group_new")
+	core.PuregoRegisterSince(&xNewOptionGroup, libs, "g_option_group_new", "GLIB", "2.6")
 
-	core.PuregoSafeRegister(&xOptionGroupAddEntries, libs, "g_option_group_add_entries")
-	core.PuregoSafeRegister(&xOptionGroupFree, libs, "g_option_group_free")
-	core.PuregoSafeRegister(&xOptionGroupRef, libs, "g_option_group_ref")
-	core.PuregoSafeRegister(&xOptionGroupSetErrorHook, libs, "g_option_group_set_error_hook")
-	core.PuregoSafeRegister(&xOptionGroupSetParseHooks, libs, "g_option_group_set_parse_hooks")
-	core.PuregoSafeRegister(&xOptionGroupSetTranslateFunc, libs, "g_option_group_set_translate_func")
-	core.PuregoSafeRegister(&xOptionGroupSetTranslationDomain, libs, "g_option_group_set_translation_domain")
-	core.PuregoSafeRegister(&xOptionGroupUnref, libs, "g_option_group_unref")
+	core.PuregoRegisterSince(&xOptionGroupAddEntries, libs, "g_option_group_add_entries", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionGroupRef, libs, "g_option_group_ref", "GLIB", "2.44")
+	core.PuregoRegisterSince(&xOptionGroupSetErrorHook, libs, "g_option_group_set_error_hook", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionGroupSetParseHooks, libs, "g_option_group_set_parse_hooks", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionGroupSetTranslateFunc, libs, "g_option_group_set_translate_func", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionGroupSetTranslationDomain, libs, "g_option_group_set_translation_domain", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOptionGroupUnref, libs, "g_option_group_unref", "GLIB", "2.44")
 
 }
@@ -0,0 +1,36 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xOptionGroupFree func(uintptr)
+
+// Frees a [OptionGroup]. Note that you must not free groups
+// which have been added to a [OptionContext].
+//
+// Deprecated: since 2.44. Use [OptionGroup.Unref] instead.
+func (x *OptionGroup) Free() {
+
+	xOptionGroupFree(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xOptionGroupFree, libs, "g_option_group_free", "GLIB", "2.6")
+
+}
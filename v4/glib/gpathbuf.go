@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -13,32 +12,28 @@ import (
 // individual elements, using the platform specific conventions for path
 // separators.
 //
-// ```c
-// g_auto (GPathBuf) path;
+//	g_auto (GPathBuf) path;
 //
-// g_path_buf_init (&amp;path);
+//	g_path_buf_init (&path);
 //
-// g_path_buf_push (&amp;path, "usr");
-// g_path_buf_push (&amp;path, "bin");
-// g_path_buf_push (&amp;path, "echo");
+//	g_path_buf_push (&path, "usr");
+//	g_path_buf_push (&path, "bin");
+//	g_path_buf_push (&path, "echo");
 //
-// g_autofree char *echo = g_path_buf_to_path (&amp;path);
-// g_assert_cmpstr (echo, ==, "/usr/bin/echo");
-// ```
+//	g_autofree char *echo = g_path_buf_to_path (&path);
+//	g_assert_cmpstr (echo, ==, "/usr/bin/echo");
 //
 // You can also load a full path and then operate on its components:
 //
-// ```c
-// g_auto (GPathBuf) path;
+//	g_auto (GPathBuf) path;
 //
-// g_path_buf_init_from_path (&amp;path, "/usr/bin/echo");
+//	g_path_buf_init_from_path (&path, "/usr/bin/echo");
 //
-// g_path_buf_pop (&amp;path);
-// g_path_buf_push (&amp;path, "sh");
+//	g_path_buf_pop (&path);
+//	g_path_buf_push (&path, "sh");
 //
-// g_autofree char *sh = g_path_buf_to_path (&amp;path);
-// g_assert_cmpstr (sh, ==, "/usr/bin/sh");
-// ```
+//	g_autofree char *sh = g_path_buf_to_path (&path);
+//	g_assert_cmpstr (sh, ==, "/usr/bin/sh");
 type PathBuf struct {
 	_ structs.HostLayout
 
@@ -54,8 +49,8 @@ var xPathBufClear func(uintptr)
 // Clears the contents of the path buffer.
 //
 // This function should be use to free the resources in a stack-allocated
-// `GPathBuf` initialized using g_path_buf_init() or
-// g_path_buf_init_from_path().
+// `GPathBuf` initialized using [PathBuf.Init] or
+// [PathBuf.InitFromPath].
 func (x *PathBuf) Clear() {
 
 	xPathBufClear(x.GoPointer())
@@ -68,7 +63,7 @@ var xPathBufClearToPath func(uintptr) string
 //
 // This function returns `NULL` if the `GPathBuf` is empty.
 //
-// See also: g_path_buf_to_path()
+// See also: [PathBuf.ToPath]
 func (x *PathBuf) ClearToPath() string {
 
 	cret := xPathBufClearToPath(x.GoPointer())
@@ -100,7 +95,7 @@ var xPathBufFreeToPath func(uintptr) string
 //
 // This function returns `NULL` if the `GPathBuf` is empty.
 //
-// See also: g_path_buf_to_path()
+// See also: [PathBuf.ToPath]
 func (x *PathBuf) FreeToPath() string {
 
 	cret := xPathBufFreeToPath(x.GoPointer())
@@ -134,25 +129,23 @@ var xPathBufPop func(uintptr) bool
 //
 // If there is only one element in the path buffer (for example, `/` on
 // Unix-like operating systems or the drive on Windows systems), it will
-// not be removed and %FALSE will be returned instead.
+// not be removed and false will be returned instead.
 //
-// |[&lt;!-- language="C" --&gt;
-// GPathBuf buf, cmp;
+//	GPathBuf buf, cmp;
 //
-// g_path_buf_init_from_path (&amp;buf, "/bin/sh");
+//	g_path_buf_init_from_path (&buf, "/bin/sh");
 //
-// g_path_buf_pop (&amp;buf);
-// g_path_buf_init_from_path (&amp;cmp, "/bin");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp));
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_pop (&buf);
+//	g_path_buf_init_from_path (&cmp, "/bin");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp));
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_pop (&amp;buf);
-// g_path_buf_init_from_path (&amp;cmp, "/");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp));
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_pop (&buf);
+//	g_path_buf_init_from_path (&cmp, "/");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp));
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_clear (&amp;buf);
-// ]|
+//	g_path_buf_clear (&buf);
 func (x *PathBuf) Pop() bool {
 
 	cret := xPathBufPop(x.GoPointer())
@@ -161,33 +154,31 @@ func (x *PathBuf) Pop() bool {
 
 var xPathBufPush func(uintptr, string) *PathBuf
 
-// Extends the given path buffer with @path.
+// Extends the given path buffer with path.
 //
-// If @path is absolute, it replaces the current path.
+// If path is absolute, it replaces the current path.
 //
-// If @path contains a directory separator, the buffer is extended by
+// If path contains a directory separator, the buffer is extended by
 // as many elements the path provides.
 //
 // On Windows, both forward slashes and backslashes are treated as
-// directory separators. On other platforms, %G_DIR_SEPARATOR_S is the
+// directory separators. On other platforms, [DIR_SEPARATOR_S] is the
 // only directory separator.
 //
-// |[&lt;!-- language="C" --&gt;
-// GPathBuf buf, cmp;
+//	GPathBuf buf, cmp;
 //
-// g_path_buf_init_from_path (&amp;buf, "/tmp");
-// g_path_buf_push (&amp;buf, ".X11-unix/X0");
-// g_path_buf_init_from_path (&amp;cmp, "/tmp/.X11-unix/X0");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp));
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_init_from_path (&buf, "/tmp");
+//	g_path_buf_push (&buf, ".X11-unix/X0");
+//	g_path_buf_init_from_path (&cmp, "/tmp/.X11-unix/X0");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp));
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_push (&amp;buf, "/etc/locale.conf");
-// g_path_buf_init_from_path (&amp;cmp, "/etc/locale.conf");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp));
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_push (&buf, "/etc/locale.conf");
+//	g_path_buf_init_from_path (&cmp, "/etc/locale.conf");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp));
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_clear (&amp;buf);
-// ]|
+//	g_path_buf_clear (&buf);
 func (x *PathBuf) Push(PathVar string) *PathBuf {
 
 	cret := xPathBufPush(x.GoPointer(), PathVar)
@@ -198,7 +189,7 @@ var xPathBufSetExtension func(uintptr, uintptr) bool
 
 // Adds an extension to the file name in the path buffer.
 //
-// If @extension is `NULL`, the extension will be unset.
+// If extension is `NULL`, the extension will be unset.
 //
 // If the path buffer does not have a file name set, this function returns
 // `FALSE` and leaves the path buffer unmodified.
@@ -220,29 +211,27 @@ var xPathBufSetFilename func(uintptr, string) bool
 //
 // If the path buffer only contains the root element (on Unix-like operating
 // systems) or the drive (on Windows), this is the equivalent of pushing
-// the new @file_name.
+// the new file_name.
 //
 // If the path buffer contains a path, this is the equivalent of
-// popping the path buffer and pushing @file_name, creating a
+// popping the path buffer and pushing file_name, creating a
 // sibling of the original path.
 //
-// |[&lt;!-- language="C" --&gt;
-// GPathBuf buf, cmp;
+//	GPathBuf buf, cmp;
 //
-// g_path_buf_init_from_path (&amp;buf, "/");
+//	g_path_buf_init_from_path (&buf, "/");
 //
-// g_path_buf_set_filename (&amp;buf, "bar");
-// g_path_buf_init_from_path (&amp;cmp, "/bar");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp));
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_set_filename (&buf, "bar");
+//	g_path_buf_init_from_path (&cmp, "/bar");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp));
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_set_filename (&amp;buf, "baz.txt");
-// g_path_buf_init_from_path (&amp;cmp, "/baz.txt");
-// g_assert_true (g_path_buf_equal (&amp;buf, &amp;cmp);
-// g_path_buf_clear (&amp;cmp);
+//	g_path_buf_set_filename (&buf, "baz.txt");
+//	g_path_buf_init_from_path (&cmp, "/baz.txt");
+//	g_assert_true (g_path_buf_equal (&buf, &cmp);
+//	g_path_buf_clear (&cmp);
 //
-// g_path_buf_clear (&amp;buf);
-// ]|
+//	g_path_buf_clear (&buf);
 func (x *PathBuf) SetFilename(FileNameVar string) bool {
 
 	cret := xPathBufSetFilename(x.GoPointer(), FileNameVar)
@@ -286,26 +275,26 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPathBufEqual, libs, "g_path_buf_equal")
-
-	core.PuregoSafeRegister(&xPathBufClear, libs, "g_path_buf_clear")
-	core.PuregoSafeRegister(&xPathBufClearToPath, libs, "g_path_buf_clear_to_path")
-	core.PuregoSafeRegister(&xPathBufCopy, libs, "g_path_buf_copy")
-	core.PuregoSafeRegister(&xPathBufFree, libs, "g_path_buf_free")
-	core.PuregoSafeRegister(&xPathBufFreeToPath, libs, "g_path_buf_free_to_path")
-	core.PuregoSafeRegister(&xPathBufInit, libs, "g_path_buf_init")
-	core.PuregoSafeRegister(&xPathBufInitFromPath, libs, "g_path_buf_init_from_path")
-	core.PuregoSafeRegister(&xPathBufPop, libs, "g_path_buf_pop")
-	core.PuregoSafeRegister(&xPathBufPush, libs, "g_path_buf_push")
-	core.PuregoSafeRegister(&xPathBufSetExtension, libs, "g_path_buf_set_extension")
-	core.PuregoSafeRegister(&xPathBufSetFilename, libs, "g_path_buf_set_filename")
-	core.PuregoSafeRegister(&xPathBufToPath, libs, "g_path_buf_to_path")
+	core.PuregoRegisterSince(&xPathBufEqual, libs, "g_path_buf_equal", "GLIB", "2.76")
+
+	core.PuregoRegisterSince(&xPathBufClear, libs, "g_path_buf_clear", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufClearToPath, libs, "g_path_buf_clear_to_path", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufCopy, libs, "g_path_buf_copy", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufFree, libs, "g_path_buf_free", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufFreeToPath, libs, "g_path_buf_free_to_path", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufInit, libs, "g_path_buf_init", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufInitFromPath, libs, "g_path_buf_init_from_path", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufPop, libs, "g_path_buf_pop", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufPush, libs, "g_path_buf_push", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufSetExtension, libs, "g_path_buf_set_extension", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufSetFilename, libs, "g_path_buf_set_filename", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xPathBufToPath, libs, "g_path_buf_to_path", "GLIB", "2.76")
 
 }
@@ -0,0 +1,74 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xPatternMatch func(*PatternSpec, uint, string, uintptr) bool
+
+// Matches a string against a compiled pattern.
+//
+// Passing the correct
+// length of the string given is mandatory. The reversed string can be
+// omitted by passing `NULL`, this is more efficient if the reversed
+// version of the string to be matched is not at hand, as
+// `[PatternMatch]` will only construct it if the compiled pattern
+// requires reverse matches.
+//
+// Note that, if the user code will (possibly) match a string against a
+// multitude of patterns containing wildcards, chances are high that
+// some patterns will require a reversed string. In this case, it’s
+// more efficient to provide the reversed string to avoid multiple
+// constructions thereof in the various calls to `[PatternMatch]`.
+//
+// Note also that the reverse of a UTF-8 encoded string can in general
+// not be obtained by [Strreverse]. This works only if the string
+// does not contain any multibyte characters. GLib offers the
+// [Utf8Strreverse] function to reverse UTF-8 encoded strings.
+//
+// Deprecated: since 2.70. Use [PatternSpec.Match] instead
+func PatternMatch(PspecVar *PatternSpec, StringLengthVar uint, StringVar string, StringReversedVar *string) bool {
+
+	StringReversedVarPtr := core.GStrdupNullable(StringReversedVar)
+	defer core.GFreeNullable(StringReversedVarPtr)
+
+	cret := xPatternMatch(PspecVar, StringLengthVar, StringVar, StringReversedVarPtr)
+
+	return cret
+}
+
+var xPatternMatchString func(*PatternSpec, string) bool
+
+// Matches a string against a compiled pattern.
+//
+// If the string is to be
+// matched against more than one pattern, consider using
+// [PatternSpec.Match] instead while supplying the reversed string.
+//
+// Deprecated: since 2.70. Use [PatternSpec.MatchString] instead
+func PatternMatchString(PspecVar *PatternSpec, StringVar string) bool {
+
+	cret := xPatternMatchString(PspecVar, StringVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xPatternMatch, libs, "g_pattern_match", "GLIB", "")
+	core.PuregoRegisterSince(&xPatternMatchString, libs, "g_pattern_match_string", "GLIB", "")
+
+}
@@ -5,12 +5,11 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Specifies the type of function passed to g_main_context_set_poll_func().
+// Specifies the type of function passed to [MainContext.SetPollFunc].
 // The semantics of the function should match those of the poll() system call.
 type PollFunc func(*PollFD, uint, int) int
 
@@ -38,23 +37,23 @@ func (x *PollFD) GoPointer() uintptr {
 
 var xPoll func(*PollFD, uint, int) int
 
-// Polls @fds, as with the poll() system call, but portably. (On
+// Polls fds, as with the poll() system call, but portably. (On
 // systems that don't have poll(), it is emulated using select().)
-// This is used internally by #GMainContext, but it can be called
+// This is used internally by [MainContext], but it can be called
 // directly if you need to block until a file descriptor is ready, but
 // don't want to run the full main loop.
 //
-// Each element of @fds is a #GPollFD describing a single file
-// descriptor to poll. The @fd field indicates the file descriptor,
-// and the @events field indicates the events to poll for. On return,
-// the @revents fields will be filled with the events that actually
+// Each element of fds is a [PollFD] describing a single file
+// descriptor to poll. The fd field indicates the file descriptor,
+// and the events field indicates the events to poll for. On return,
+// the revents fields will be filled with the events that actually
 // occurred.
 //
-// On POSIX systems, the file descriptors in @fds can be any sort of
+// On POSIX systems, the file descriptors in fds can be any sort of
 // file descriptor, but the situation is much more complicated on
-// Windows. If you need to use g_poll() in code that has to run on
+// Windows. If you need to use [Poll] in code that has to run on
 // Windows, the easiest solution is to construct all of your
-// #GPollFDs with g_io_channel_win32_make_pollfd().
+// GPollFDs with g_io_channel_win32_make_pollfd().
 func Poll(FdsVar *PollFD, NfdsVar uint, TimeoutVar int) int {
 
 	cret := xPoll(FdsVar, NfdsVar, TimeoutVar)
@@ -67,14 +66,14 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPoll, libs, "g_poll")
+	core.PuregoRegisterSince(&xPoll, libs, "g_poll", "GLIB", "2.20")
 
 	core.PuregoSafeRegister(&xPollFDGLibType, libs, "g_pollfd_get_type")
 
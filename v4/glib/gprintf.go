@@ -2,7 +2,8 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -12,9 +13,11 @@ var xFprintf func(uintptr, string, ...interface{}) int
 // positional parameters, as specified in the Single Unix Specification.
 //
 // `glib/gprintf.h` must be explicitly included in order to use this function.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Fprintf(FileVar uintptr, FormatVar string, varArgs ...interface{}) int {
 
-	cret := xFprintf(FileVar, FormatVar, varArgs...)
+	cret := xFprintf(FileVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 	return cret
 }
@@ -25,13 +28,15 @@ var xPrintf func(string, ...interface{}) int
 // positional parameters, as specified in the Single Unix Specification.
 //
 // As with the standard `printf()`, this does not automatically append a trailing
-// new-line character to the message, so typically @format should end with its
+// new-line character to the message, so typically format should end with its
 // own new-line character.
 //
 // `glib/gprintf.h` must be explicitly included in order to use this function.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Printf(FormatVar string, varArgs ...interface{}) int {
 
-	cret := xPrintf(FormatVar, varArgs...)
+	cret := xPrintf("%s", fmt.Sprintf(FormatVar, varArgs...))
 
 	return cret
 }
@@ -41,15 +46,17 @@ var xSprintf func(string, string, ...interface{}) int
 // An implementation of the standard `sprintf()` function which supports
 // positional parameters, as specified in the Single Unix Specification.
 //
-// Note that it is usually better to use [func@GLib.snprintf], to avoid the
+// Note that it is usually better to use [Snprintf], to avoid the
 // risk of buffer overflow.
 //
 // `glib/gprintf.h` must be explicitly included in order to use this function.
 //
-// See also [func@GLib.strdup_printf].
+// See also [StrdupPrintf].
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func Sprintf(StringVar string, FormatVar string, varArgs ...interface{}) int {
 
-	cret := xSprintf(StringVar, FormatVar, varArgs...)
+	cret := xSprintf(StringVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 	return cret
 }
@@ -58,12 +65,12 @@ var xVasprintf func(*string, string, []interface{}) int
 
 // An implementation of the GNU `vasprintf()` function which supports
 // positional parameters, as specified in the Single Unix Specification.
-// This function is similar to [func@GLib.vsprintf], except that it allocates a
+// This function is similar to [Vsprintf], except that it allocates a
 // string to hold the output, instead of putting the output in a buffer
 // you allocate in advance.
 //
-// The returned value in @string is guaranteed to be non-`NULL`, unless
-// @format contains `%lc` or `%ls` conversions, which can fail if no
+// The returned value in string is guaranteed to be non-`NULL`, unless
+// format contains `%lc` or `%ls` conversions, which can fail if no
 // multibyte representation is available for the given character.
 //
 // `glib/gprintf.h` must be explicitly included in order to use this function.
@@ -118,19 +125,19 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xFprintf, libs, "g_fprintf")
-	core.PuregoSafeRegister(&xPrintf, libs, "g_printf")
-	core.PuregoSafeRegister(&xSprintf, libs, "g_sprintf")
-	core.PuregoSafeRegister(&xVasprintf, libs, "g_vasprintf")
-	core.PuregoSafeRegister(&xVfprintf, libs, "g_vfprintf")
-	core.PuregoSafeRegister(&xVprintf, libs, "g_vprintf")
-	core.PuregoSafeRegister(&xVsprintf, libs, "g_vsprintf")
+	core.PuregoRegisterSince(&xFprintf, libs, "g_fprintf", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xPrintf, libs, "g_printf", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xSprintf, libs, "g_sprintf", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xVasprintf, libs, "g_vasprintf", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xVfprintf, libs, "g_vfprintf", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xVprintf, libs, "g_vprintf", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xVsprintf, libs, "g_vsprintf", "GLIB", "2.2")
 
 }
@@ -0,0 +1,59 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xQsortWithData func(uintptr, int, uint, uintptr, uintptr)
+
+// This is just like the standard C qsort()) function, but
+// the comparison routine accepts a user data argument
+// (like qsort_r())).
+//
+// Unlike `qsort()`, this is guaranteed to be a stable sort (since GLib 2.32).
+//
+// Deprecated: since 2.82. `total_elems` is too small to represent larger arrays; use
+//
+//	[SortArray] instead
+func QsortWithData(PbaseVar uintptr, TotalElemsVar int, SizeVar uint, CompareFuncVar *CompareDataFunc, UserDataVar uintptr) {
+
+	var CompareFuncVarRef uintptr
+	if CompareFuncVar != nil {
+		CompareFuncVarPtr := uintptr(unsafe.Pointer(CompareFuncVar))
+		if cbRefPtr, ok := GetCallback(CompareFuncVarPtr); ok {
+			CompareFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *CompareFuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+		}
+	}
+
+	xQsortWithData(PbaseVar, TotalElemsVar, SizeVar, CompareFuncVarRef, UserDataVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xQsortWithData, libs, "g_qsort_with_data", "GLIB", "")
+
+}
@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -15,20 +14,20 @@ import (
 // retrieve the other.
 //
 // Quarks are used for both
-// [datasets and keyed data lists](datalist-and-dataset.html).
+// datasets and keyed data lists.
 //
-// To create a new quark from a string, use [func@GLib.quark_from_string]
-// or [func@GLib.quark_from_static_string].
+// To create a new quark from a string, use [QuarkFromString]
+// or [QuarkFromStaticString].
 //
 // To find the string corresponding to a given `GQuark`, use
-// [func@GLib.quark_to_string].
+// [QuarkToString].
 //
 // To find the `GQuark` corresponding to a given string, use
-// [func@GLib.quark_try_string].
+// [QuarkTryString].
 //
 // Another use for the string pool maintained for the quark functions
-// is string interning, using [func@GLib.intern_string] or
-// [func@GLib.intern_static_string]. An interned string is a canonical
+// is string interning, using [InternString] or
+// [InternStaticString]. An interned string is a canonical
 // representation for a string. One important advantage of interned
 // strings is that they can be compared for equality by a simple
 // pointer comparison, rather than using `strcmp()`.
@@ -36,10 +35,10 @@ type Quark = uint32
 
 var xInternStaticString func(uintptr) string
 
-// Returns a canonical representation for @string. Interned strings
+// Returns a canonical representation for string. Interned strings
 // can be compared for equality by comparing the pointers, instead of
-// using strcmp(). g_intern_static_string() does not copy the string,
-// therefore @string must not be freed or modified.
+// using strcmp(). [InternStaticString] does not copy the string,
+// therefore string must not be freed or modified.
 //
 // This function must not be used before library constructors have finished
 // running. In particular, this means it cannot be used to initialize global
@@ -56,7 +55,7 @@ func InternStaticString(StringVar *string) string {
 
 var xInternString func(uintptr) string
 
-// Returns a canonical representation for @string. Interned strings
+// Returns a canonical representation for string. Interned strings
 // can be compared for equality by comparing the pointers, instead of
 // using strcmp().
 //
@@ -75,12 +74,12 @@ func InternString(StringVar *string) string {
 
 var xQuarkFromStaticString func(uintptr) Quark
 
-// Gets the #GQuark identifying the given (static) string. If the
-// string does not currently have an associated #GQuark, a new #GQuark
+// Gets the [Quark] identifying the given (static) string. If the
+// string does not currently have an associated [Quark], a new [Quark]
 // is created, linked to the given string.
 //
-// Note that this function is identical to g_quark_from_string() except
-// that if a new #GQuark is created the string itself is used rather
+// Note that this function is identical to [QuarkFromString] except
+// that if a new [Quark] is created the string itself is used rather
 // than a copy. This saves memory, but can only be used if the string
 // will continue to exist until the program terminates. It can be used
 // with statically allocated strings in the main program, but not with
@@ -103,8 +102,8 @@ func QuarkFromStaticString(StringVar *string) Quark {
 
 var xQuarkFromString func(uintptr) Quark
 
-// Gets the #GQuark identifying the given string. If the string does
-// not currently have an associated #GQuark, a new #GQuark is created,
+// Gets the [Quark] identifying the given string. If the string does
+// not currently have an associated [Quark], a new [Quark] is created,
 // using a copy of the string.
 //
 // This function must not be used before library constructors have finished
@@ -122,7 +121,7 @@ func QuarkFromString(StringVar *string) Quark {
 
 var xQuarkToString func(Quark) string
 
-// Gets the string associated with the given #GQuark.
+// Gets the string associated with the given [Quark].
 func QuarkToString(QuarkVar Quark) string {
 
 	cret := xQuarkToString(QuarkVar)
@@ -132,11 +131,11 @@ func QuarkToString(QuarkVar Quark) string {
 
 var xQuarkTryString func(uintptr) Quark
 
-// Gets the #GQuark associated with the given string, or 0 if string is
-// %NULL or it has no associated #GQuark.
+// Gets the [Quark] associated with the given string, or 0 if string is
+// nil or it has no associated [Quark].
 //
 // If you want the GQuark to be created if it doesn't already exist,
-// use g_quark_from_string() or g_quark_from_static_string().
+// use [QuarkFromString] or [QuarkFromStaticString].
 //
 // This function must not be used before library constructors have finished
 // running.
@@ -155,18 +154,18 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xInternStaticString, libs, "g_intern_static_string")
-	core.PuregoSafeRegister(&xInternString, libs, "g_intern_string")
-	core.PuregoSafeRegister(&xQuarkFromStaticString, libs, "g_quark_from_static_string")
-	core.PuregoSafeRegister(&xQuarkFromString, libs, "g_quark_from_string")
-	core.PuregoSafeRegister(&xQuarkToString, libs, "g_quark_to_string")
-	core.PuregoSafeRegister(&xQuarkTryString, libs, "g_quark_try_string")
+	core.PuregoRegisterSince(&xInternStaticString, libs, "g_intern_static_string", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xInternString, libs, "g_intern_string", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xQuarkFromStaticString, libs, "g_quark_from_static_string", "GLIB", "")
+	core.PuregoRegisterSince(&xQuarkFromString, libs, "g_quark_from_string", "GLIB", "")
+	core.PuregoRegisterSince(&xQuarkToString, libs, "g_quark_to_string", "GLIB", "")
+	core.PuregoRegisterSince(&xQuarkTryString, libs, "g_quark_try_string", "GLIB", "")
 
 }
@@ -5,12 +5,11 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 // Contains the public fields of a
-// [Queue](data-structures.html#double-ended-queues).
+// Queue.
 type Queue struct {
 	_ structs.HostLayout
 
@@ -27,7 +26,7 @@ func (x *Queue) GoPointer() uintptr {
 
 var xQueueClear func(uintptr)
 
-// Removes all the elements in @queue. If queue elements contain
+// Removes all the elements in queue. If queue elements contain
 // dynamically-allocated memory, they should be freed first.
 func (x *Queue) Clear() {
 
@@ -37,8 +36,8 @@ func (x *Queue) Clear() {
 
 var xQueueClearFull func(uintptr, uintptr)
 
-// Convenience method, which frees all the memory used by a #GQueue,
-// and calls the provided @free_func on each item in the #GQueue.
+// Convenience method, which frees all the memory used by a [Queue],
+// and calls the provided free_func on each item in the [Queue].
 func (x *Queue) ClearFull(FreeFuncVar *DestroyNotify) {
 
 	var FreeFuncVarRef uintptr
@@ -48,11 +47,12 @@ func (x *Queue) ClearFull(FreeFuncVar *DestroyNotify) {
 			FreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FreeFuncVar
 				cbFn(arg0)
 			}
-			FreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
+			FreeFuncVarRef = core.NewCallback(fcb)
+			FreeFuncVarRef = SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
 		}
 	}
 
@@ -62,7 +62,7 @@ func (x *Queue) ClearFull(FreeFuncVar *DestroyNotify) {
 
 var xQueueCopy func(uintptr) *Queue
 
-// Copies a @queue. Note that is a shallow copy. If the elements in the
+// Copies a queue. Note that is a shallow copy. If the elements in the
 // queue consist of pointers to data, the pointers are copied, but the
 // actual data is not.
 func (x *Queue) Copy() *Queue {
@@ -73,9 +73,9 @@ func (x *Queue) Copy() *Queue {
 
 var xQueueDeleteLink func(uintptr, *List)
 
-// Removes @link_ from @queue and frees it.
+// Removes link_ from queue and frees it.
 //
-// @link_ must be part of @queue.
+// link_ must be part of queue.
 func (x *Queue) DeleteLink(LinkVar *List) {
 
 	xQueueDeleteLink(x.GoPointer(), LinkVar)
@@ -84,7 +84,7 @@ func (x *Queue) DeleteLink(LinkVar *List) {
 
 var xQueueFind func(uintptr, uintptr) *List
 
-// Finds the first link in @queue which contains @data.
+// Finds the first link in queue which contains data.
 func (x *Queue) Find(DataVar uintptr) *List {
 
 	cret := xQueueFind(x.GoPointer(), DataVar)
@@ -93,10 +93,10 @@ func (x *Queue) Find(DataVar uintptr) *List {
 
 var xQueueFindCustom func(uintptr, uintptr, uintptr) *List
 
-// Finds an element in a #GQueue, using a supplied function to find the
+// Finds an element in a [Queue], using a supplied function to find the
 // desired element. It iterates over the queue, calling the given function
 // which should return 0 when the desired element is found. The function
-// takes two gconstpointer arguments, the #GQueue element's data as the
+// takes two gconstpointer arguments, the [Queue] element's data as the
 // first argument and the given user data as the second argument.
 func (x *Queue) FindCustom(DataVar uintptr, FuncVar *CompareFunc) *List {
 
@@ -107,11 +107,12 @@ func (x *Queue) FindCustom(DataVar uintptr, FuncVar *CompareFunc) *List {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -121,10 +122,10 @@ func (x *Queue) FindCustom(DataVar uintptr, FuncVar *CompareFunc) *List {
 
 var xQueueForeach func(uintptr, uintptr, uintptr)
 
-// Calls @func for each element in the queue passing @user_data to the
+// Calls func for each element in the queue passing user_data to the
 // function.
 //
-// It is safe for @func to remove the element from @queue, but it must
+// It is safe for func to remove the element from queue, but it must
 // not modify any part of the queue after that element.
 func (x *Queue) Foreach(FuncVar *Func, UserDataVar uintptr) {
 
@@ -135,11 +136,12 @@ func (x *Queue) Foreach(FuncVar *Func, UserDataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -149,12 +151,12 @@ func (x *Queue) Foreach(FuncVar *Func, UserDataVar uintptr) {
 
 var xQueueFree func(uintptr)
 
-// Frees the memory allocated for the #GQueue. Only call this function
-// if @queue was created with g_queue_new(). If queue elements contain
+// Frees the memory allocated for the [Queue]. Only call this function
+// if queue was created with g_queue_new(). If queue elements contain
 // dynamically-allocated memory, they should be freed first.
 //
 // If queue elements contain dynamically-allocated memory, you should
-// either use g_queue_free_full() or free them manually first.
+// either use [Queue.FreeFull] or free them manually first.
 func (x *Queue) Free() {
 
 	xQueueFree(x.GoPointer())
@@ -163,10 +165,10 @@ func (x *Queue) Free() {
 
 var xQueueFreeFull func(uintptr, uintptr)
 
-// Convenience method, which frees all the memory used by a #GQueue,
+// Convenience method, which frees all the memory used by a [Queue],
 // and calls the specified destroy function on every element's data.
 //
-// @free_func should not modify the queue (eg, by removing the freed
+// free_func should not modify the queue (eg, by removing the freed
 // element from it).
 func (x *Queue) FreeFull(FreeFuncVar *DestroyNotify) {
 
@@ -177,11 +179,12 @@ func (x *Queue) FreeFull(FreeFuncVar *DestroyNotify) {
 			FreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FreeFuncVar
 				cbFn(arg0)
 			}
-			FreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
+			FreeFuncVarRef = core.NewCallback(fcb)
+			FreeFuncVarRef = SaveCallbackWithClosure(FreeFuncVarPtr, FreeFuncVarRef, FreeFuncVar)
 		}
 	}
 
@@ -191,7 +194,7 @@ func (x *Queue) FreeFull(FreeFuncVar *DestroyNotify) {
 
 var xQueueGetLength func(uintptr) uint
 
-// Returns the number of items in @queue.
+// Returns the number of items in queue.
 func (x *Queue) GetLength() uint {
 
 	cret := xQueueGetLength(x.GoPointer())
@@ -200,7 +203,7 @@ func (x *Queue) GetLength() uint {
 
 var xQueueIndex func(uintptr, uintptr) int
 
-// Returns the position of the first element in @queue which contains @data.
+// Returns the position of the first element in queue which contains data.
 func (x *Queue) Index(DataVar uintptr) int {
 
 	cret := xQueueIndex(x.GoPointer(), DataVar)
@@ -209,9 +212,9 @@ func (x *Queue) Index(DataVar uintptr) int {
 
 var xQueueInit func(uintptr)
 
-// A statically-allocated #GQueue must be initialized with this function
+// A statically-allocated [Queue] must be initialized with this function
 // before it can be used. Alternatively you can initialize it with
-// %G_QUEUE_INIT. It is not necessary to initialize queues created with
+// G_QUEUE_INIT. It is not necessary to initialize queues created with
 // g_queue_new().
 func (x *Queue) Init() {
 
@@ -221,9 +224,9 @@ func (x *Queue) Init() {
 
 var xQueueInsertAfter func(uintptr, *List, uintptr)
 
-// Inserts @data into @queue after @sibling.
+// Inserts data into queue after sibling.
 //
-// @sibling must be part of @queue. Since GLib 2.44 a %NULL sibling pushes the
+// sibling must be part of queue. Since GLib 2.44 a nil sibling pushes the
 // data at the head of the queue.
 func (x *Queue) InsertAfter(SiblingVar *List, DataVar uintptr) {
 
@@ -233,9 +236,9 @@ func (x *Queue) InsertAfter(SiblingVar *List, DataVar uintptr) {
 
 var xQueueInsertAfterLink func(uintptr, *List, *List)
 
-// Inserts @link_ into @queue after @sibling.
+// Inserts link_ into queue after sibling.
 //
-// @sibling must be part of @queue.
+// sibling must be part of queue.
 func (x *Queue) InsertAfterLink(SiblingVar *List, LinkVar *List) {
 
 	xQueueInsertAfterLink(x.GoPointer(), SiblingVar, LinkVar)
@@ -244,9 +247,9 @@ func (x *Queue) InsertAfterLink(SiblingVar *List, LinkVar *List) {
 
 var xQueueInsertBefore func(uintptr, *List, uintptr)
 
-// Inserts @data into @queue before @sibling.
+// Inserts data into queue before sibling.
 //
-// @sibling must be part of @queue. Since GLib 2.44 a %NULL sibling pushes the
+// sibling must be part of queue. Since GLib 2.44 a nil sibling pushes the
 // data at the tail of the queue.
 func (x *Queue) InsertBefore(SiblingVar *List, DataVar uintptr) {
 
@@ -256,9 +259,9 @@ func (x *Queue) InsertBefore(SiblingVar *List, DataVar uintptr) {
 
 var xQueueInsertBeforeLink func(uintptr, *List, *List)
 
-// Inserts @link_ into @queue before @sibling.
+// Inserts link_ into queue before sibling.
 //
-// @sibling must be part of @queue.
+// sibling must be part of queue.
 func (x *Queue) InsertBeforeLink(SiblingVar *List, LinkVar *List) {
 
 	xQueueInsertBeforeLink(x.GoPointer(), SiblingVar, LinkVar)
@@ -267,7 +270,7 @@ func (x *Queue) InsertBeforeLink(SiblingVar *List, LinkVar *List) {
 
 var xQueueInsertSorted func(uintptr, uintptr, uintptr, uintptr)
 
-// Inserts @data into @queue using @func to determine the new position.
+// Inserts data into queue using func to determine the new position.
 func (x *Queue) InsertSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -277,11 +280,12 @@ func (x *Queue) InsertSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserData
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -291,7 +295,7 @@ func (x *Queue) InsertSorted(DataVar uintptr, FuncVar *CompareDataFunc, UserData
 
 var xQueueIsEmpty func(uintptr) bool
 
-// Returns %TRUE if the queue is empty.
+// Returns true if the queue is empty.
 func (x *Queue) IsEmpty() bool {
 
 	cret := xQueueIsEmpty(x.GoPointer())
@@ -300,7 +304,7 @@ func (x *Queue) IsEmpty() bool {
 
 var xQueueLinkIndex func(uintptr, *List) int
 
-// Returns the position of @link_ in @queue.
+// Returns the position of link_ in queue.
 func (x *Queue) LinkIndex(LinkVar *List) int {
 
 	cret := xQueueLinkIndex(x.GoPointer(), LinkVar)
@@ -318,7 +322,7 @@ func (x *Queue) PeekHead() uintptr {
 
 var xQueuePeekHeadLink func(uintptr) *List
 
-// Returns the first link in @queue.
+// Returns the first link in queue.
 func (x *Queue) PeekHeadLink() *List {
 
 	cret := xQueuePeekHeadLink(x.GoPointer())
@@ -327,7 +331,7 @@ func (x *Queue) PeekHeadLink() *List {
 
 var xQueuePeekNth func(uintptr, uint) uintptr
 
-// Returns the @n'th element of @queue.
+// Returns the n'th element of queue.
 func (x *Queue) PeekNth(NVar uint) uintptr {
 
 	cret := xQueuePeekNth(x.GoPointer(), NVar)
@@ -354,7 +358,7 @@ func (x *Queue) PeekTail() uintptr {
 
 var xQueuePeekTailLink func(uintptr) *List
 
-// Returns the last link in @queue.
+// Returns the last link in queue.
 func (x *Queue) PeekTailLink() *List {
 
 	cret := xQueuePeekTailLink(x.GoPointer())
@@ -381,7 +385,7 @@ func (x *Queue) PopHeadLink() *List {
 
 var xQueuePopNth func(uintptr, uint) uintptr
 
-// Removes the @n'th element of @queue and returns its data.
+// Removes the n'th element of queue and returns its data.
 func (x *Queue) PopNth(NVar uint) uintptr {
 
 	cret := xQueuePopNth(x.GoPointer(), NVar)
@@ -435,7 +439,7 @@ func (x *Queue) PushHeadLink(LinkVar *List) {
 
 var xQueuePushNth func(uintptr, uintptr, int)
 
-// Inserts a new element into @queue at the given position.
+// Inserts a new element into queue at the given position.
 func (x *Queue) PushNth(DataVar uintptr, NVar int) {
 
 	xQueuePushNth(x.GoPointer(), DataVar, NVar)
@@ -444,7 +448,7 @@ func (x *Queue) PushNth(DataVar uintptr, NVar int) {
 
 var xQueuePushNthLink func(uintptr, int, *List)
 
-// Inserts @link into @queue at the given position.
+// Inserts link into queue at the given position.
 func (x *Queue) PushNthLink(NVar int, LinkVar *List) {
 
 	xQueuePushNthLink(x.GoPointer(), NVar, LinkVar)
@@ -471,7 +475,7 @@ func (x *Queue) PushTailLink(LinkVar *List) {
 
 var xQueueRemove func(uintptr, uintptr) bool
 
-// Removes the first element in @queue that contains @data.
+// Removes the first element in queue that contains data.
 func (x *Queue) Remove(DataVar uintptr) bool {
 
 	cret := xQueueRemove(x.GoPointer(), DataVar)
@@ -480,7 +484,7 @@ func (x *Queue) Remove(DataVar uintptr) bool {
 
 var xQueueRemoveAll func(uintptr, uintptr) uint
 
-// Remove all elements whose data equals @data from @queue.
+// Remove all elements whose data equals data from queue.
 func (x *Queue) RemoveAll(DataVar uintptr) uint {
 
 	cret := xQueueRemoveAll(x.GoPointer(), DataVar)
@@ -489,7 +493,7 @@ func (x *Queue) RemoveAll(DataVar uintptr) uint {
 
 var xQueueReverse func(uintptr)
 
-// Reverses the order of the items in @queue.
+// Reverses the order of the items in queue.
 func (x *Queue) Reverse() {
 
 	xQueueReverse(x.GoPointer())
@@ -498,7 +502,7 @@ func (x *Queue) Reverse() {
 
 var xQueueSort func(uintptr, uintptr, uintptr)
 
-// Sorts @queue using @compare_func.
+// Sorts queue using compare_func.
 func (x *Queue) Sort(CompareFuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 	var CompareFuncVarRef uintptr
@@ -508,11 +512,12 @@ func (x *Queue) Sort(CompareFuncVar *CompareDataFunc, UserDataVar uintptr) {
 			CompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
 		}
 	}
 
@@ -522,10 +527,10 @@ func (x *Queue) Sort(CompareFuncVar *CompareDataFunc, UserDataVar uintptr) {
 
 var xQueueUnlink func(uintptr, *List)
 
-// Unlinks @link_ so that it will no longer be part of @queue.
+// Unlinks link_ so that it will no longer be part of queue.
 // The link is not freed.
 //
-// @link_ must be part of @queue.
+// link_ must be part of queue.
 func (x *Queue) Unlink(LinkVar *List) {
 
 	xQueueUnlink(x.GoPointer(), LinkVar)
@@ -537,54 +542,54 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xQueueClear, libs, "g_queue_clear")
-	core.PuregoSafeRegister(&xQueueClearFull, libs, "g_queue_clear_full")
-	core.PuregoSafeRegister(&xQueueCopy, libs, "g_queue_copy")
-	core.PuregoSafeRegister(&xQueueDeleteLink, libs, "g_queue_delete_link")
-	core.PuregoSafeRegister(&xQueueFind, libs, "g_queue_find")
-	core.PuregoSafeRegister(&xQueueFindCustom, libs, "g_queue_find_custom")
-	core.PuregoSafeRegister(&xQueueForeach, libs, "g_queue_foreach")
-	core.PuregoSafeRegister(&xQueueFree, libs, "g_queue_free")
-	core.PuregoSafeRegister(&xQueueFreeFull, libs, "g_queue_free_full")
-	core.PuregoSafeRegister(&xQueueGetLength, libs, "g_queue_get_length")
-	core.PuregoSafeRegister(&xQueueIndex, libs, "g_queue_index")
-	core.PuregoSafeRegister(&xQueueInit, libs, "g_queue_init")
-	core.PuregoSafeRegister(&xQueueInsertAfter, libs, "g_queue_insert_after")
-	core.PuregoSafeRegister(&xQueueInsertAfterLink, libs, "g_queue_insert_after_link")
-	core.PuregoSafeRegister(&xQueueInsertBefore, libs, "g_queue_insert_before")
-	core.PuregoSafeRegister(&xQueueInsertBeforeLink, libs, "g_queue_insert_before_link")
-	core.PuregoSafeRegister(&xQueueInsertSorted, libs, "g_queue_insert_sorted")
-	core.PuregoSafeRegister(&xQueueIsEmpty, libs, "g_queue_is_empty")
-	core.PuregoSafeRegister(&xQueueLinkIndex, libs, "g_queue_link_index")
-	core.PuregoSafeRegister(&xQueuePeekHead, libs, "g_queue_peek_head")
-	core.PuregoSafeRegister(&xQueuePeekHeadLink, libs, "g_queue_peek_head_link")
-	core.PuregoSafeRegister(&xQueuePeekNth, libs, "g_queue_peek_nth")
-	core.PuregoSafeRegister(&xQueuePeekNthLink, libs, "g_queue_peek_nth_link")
-	core.PuregoSafeRegister(&xQueuePeekTail, libs, "g_queue_peek_tail")
-	core.PuregoSafeRegister(&xQueuePeekTailLink, libs, "g_queue_peek_tail_link")
-	core.PuregoSafeRegister(&xQueuePopHead, libs, "g_queue_pop_head")
-	core.PuregoSafeRegister(&xQueuePopHeadLink, libs, "g_queue_pop_head_link")
-	core.PuregoSafeRegister(&xQueuePopNth, libs, "g_queue_pop_nth")
-	core.PuregoSafeRegister(&xQueuePopNthLink, libs, "g_queue_pop_nth_link")
-	core.PuregoSafeRegister(&xQueuePopTail, libs, "g_queue_pop_tail")
-	core.PuregoSafeRegister(&xQueuePopTailLink, libs, "g_queue_pop_tail_link")
-	core.PuregoSafeRegister(&xQueuePushHead, libs, "g_queue_push_head")
-	core.PuregoSafeRegister(&xQueuePushHeadLink, libs, "g_queue_push_head_link")
-	core.PuregoSafeRegister(&xQueuePushNth, libs, "g_queue_push_nth")
-	core.PuregoSafeRegister(&xQueuePushNthLink, libs, "g_queue_push_nth_link")
-	core.PuregoSafeRegister(&xQueuePushTail, libs, "g_queue_push_tail")
-	core.PuregoSafeRegister(&xQueuePushTailLink, libs, "g_queue_push_tail_link")
-	core.PuregoSafeRegister(&xQueueRemove, libs, "g_queue_remove")
-	core.PuregoSafeRegister(&xQueueRemoveAll, libs, "g_queue_remove_all")
-	core.PuregoSafeRegister(&xQueueReverse, libs, "g_queue_reverse")
-	core.PuregoSafeRegister(&xQueueSort, libs, "g_queue_sort")
-	core.PuregoSafeRegister(&xQueueUnlink, libs, "g_queue_unlink")
+	core.PuregoRegisterSince(&xQueueClear, libs, "g_queue_clear", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xQueueClearFull, libs, "g_queue_clear_full", "GLIB", "2.60")
+	core.PuregoRegisterSince(&xQueueCopy, libs, "g_queue_copy", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueDeleteLink, libs, "g_queue_delete_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueFind, libs, "g_queue_find", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueFindCustom, libs, "g_queue_find_custom", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueForeach, libs, "g_queue_foreach", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueFree, libs, "g_queue_free", "GLIB", "")
+	core.PuregoRegisterSince(&xQueueFreeFull, libs, "g_queue_free_full", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xQueueGetLength, libs, "g_queue_get_length", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueIndex, libs, "g_queue_index", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueInit, libs, "g_queue_init", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xQueueInsertAfter, libs, "g_queue_insert_after", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueInsertAfterLink, libs, "g_queue_insert_after_link", "GLIB", "2.62")
+	core.PuregoRegisterSince(&xQueueInsertBefore, libs, "g_queue_insert_before", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueInsertBeforeLink, libs, "g_queue_insert_before_link", "GLIB", "2.62")
+	core.PuregoRegisterSince(&xQueueInsertSorted, libs, "g_queue_insert_sorted", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueIsEmpty, libs, "g_queue_is_empty", "GLIB", "")
+	core.PuregoRegisterSince(&xQueueLinkIndex, libs, "g_queue_link_index", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePeekHead, libs, "g_queue_peek_head", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePeekHeadLink, libs, "g_queue_peek_head_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePeekNth, libs, "g_queue_peek_nth", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePeekNthLink, libs, "g_queue_peek_nth_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePeekTail, libs, "g_queue_peek_tail", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePeekTailLink, libs, "g_queue_peek_tail_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePopHead, libs, "g_queue_pop_head", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePopHeadLink, libs, "g_queue_pop_head_link", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePopNth, libs, "g_queue_pop_nth", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePopNthLink, libs, "g_queue_pop_nth_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePopTail, libs, "g_queue_pop_tail", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePopTailLink, libs, "g_queue_pop_tail_link", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePushHead, libs, "g_queue_push_head", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePushHeadLink, libs, "g_queue_push_head_link", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePushNth, libs, "g_queue_push_nth", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePushNthLink, libs, "g_queue_push_nth_link", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueuePushTail, libs, "g_queue_push_tail", "GLIB", "")
+	core.PuregoRegisterSince(&xQueuePushTailLink, libs, "g_queue_push_tail_link", "GLIB", "")
+	core.PuregoRegisterSince(&xQueueRemove, libs, "g_queue_remove", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueRemoveAll, libs, "g_queue_remove_all", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueReverse, libs, "g_queue_reverse", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueSort, libs, "g_queue_sort", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xQueueUnlink, libs, "g_queue_unlink", "GLIB", "2.4")
 
 }
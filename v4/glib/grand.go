@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -41,7 +40,7 @@ func NewRand() *Rand {
 
 var xNewRandWithSeed func(uint32) *Rand
 
-// Creates a new random number generator initialized with @seed.
+// Creates a new random number generator initialized with seed.
 func NewRandWithSeed(SeedVar uint32) *Rand {
 
 	cret := xNewRandWithSeed(SeedVar)
@@ -50,7 +49,7 @@ func NewRandWithSeed(SeedVar uint32) *Rand {
 
 var xNewRandWithSeedArray func(uint32, uint) *Rand
 
-// Creates a new random number generator initialized with @seed.
+// Creates a new random number generator initialized with seed.
 func NewRandWithSeedArray(SeedVar uint32, SeedLengthVar uint) *Rand {
 
 	cret := xNewRandWithSeedArray(SeedVar, SeedLengthVar)
@@ -59,7 +58,7 @@ func NewRandWithSeedArray(SeedVar uint32, SeedLengthVar uint) *Rand {
 
 var xRandCopy func(uintptr) *Rand
 
-// Copies a #GRand into a new one with the same exact state as before.
+// Copies a [Rand] into a new one with the same exact state as before.
 // This way you can take a snapshot of the random number generator for
 // replaying later.
 func (x *Rand) Copy() *Rand {
@@ -70,7 +69,7 @@ func (x *Rand) Copy() *Rand {
 
 var xRandDouble func(uintptr) float64
 
-// Returns the next random #gdouble from @rand_ equally distributed over
+// Returns the next random #gdouble from rand_ equally distributed over
 // the range [0..1).
 func (x *Rand) Double() float64 {
 
@@ -80,8 +79,8 @@ func (x *Rand) Double() float64 {
 
 var xRandDoubleRange func(uintptr, float64, float64) float64
 
-// Returns the next random #gdouble from @rand_ equally distributed over
-// the range [@begin..@end).
+// Returns the next random #gdouble from rand_ equally distributed over
+// the range [begin..@end).
 func (x *Rand) DoubleRange(BeginVar float64, EndVar float64) float64 {
 
 	cret := xRandDoubleRange(x.GoPointer(), BeginVar, EndVar)
@@ -90,7 +89,7 @@ func (x *Rand) DoubleRange(BeginVar float64, EndVar float64) float64 {
 
 var xRandFree func(uintptr)
 
-// Frees the memory allocated for the #GRand.
+// Frees the memory allocated for the [Rand].
 func (x *Rand) Free() {
 
 	xRandFree(x.GoPointer())
@@ -99,7 +98,7 @@ func (x *Rand) Free() {
 
 var xRandInt func(uintptr) uint32
 
-// Returns the next random #guint32 from @rand_ equally distributed over
+// Returns the next random #guint32 from rand_ equally distributed over
 // the range [0..2^32-1].
 func (x *Rand) Int() uint32 {
 
@@ -109,8 +108,8 @@ func (x *Rand) Int() uint32 {
 
 var xRandIntRange func(uintptr, int32, int32) int32
 
-// Returns the next random #gint32 from @rand_ equally distributed over
-// the range [@begin..@end-1].
+// Returns the next random #gint32 from rand_ equally distributed over
+// the range [begin..@end-1].
 func (x *Rand) IntRange(BeginVar int32, EndVar int32) int32 {
 
 	cret := xRandIntRange(x.GoPointer(), BeginVar, EndVar)
@@ -119,7 +118,7 @@ func (x *Rand) IntRange(BeginVar int32, EndVar int32) int32 {
 
 var xRandSetSeed func(uintptr, uint32)
 
-// Sets the seed for the random number generator #GRand to @seed.
+// Sets the seed for the random number generator [Rand] to seed.
 func (x *Rand) SetSeed(SeedVar uint32) {
 
 	xRandSetSeed(x.GoPointer(), SeedVar)
@@ -152,7 +151,7 @@ func RandomDouble() float64 {
 var xRandomDoubleRange func(float64, float64) float64
 
 // Returns a random #gdouble equally distributed over the range
-// [@begin..@end).
+// [begin..@end).
 func RandomDoubleRange(BeginVar float64, EndVar float64) float64 {
 
 	cret := xRandomDoubleRange(BeginVar, EndVar)
@@ -174,7 +173,7 @@ func RandomInt() uint32 {
 var xRandomIntRange func(int32, int32) int32
 
 // Returns a random #gint32 equally distributed over the range
-// [@begin..@end-1].
+// [begin..@end-1].
 func RandomIntRange(BeginVar int32, EndVar int32) int32 {
 
 	cret := xRandomIntRange(BeginVar, EndVar)
@@ -185,7 +184,7 @@ func RandomIntRange(BeginVar int32, EndVar int32) int32 {
 var xRandomSetSeed func(uint32)
 
 // Sets the seed for the global random number generator, which is used
-// by the g_random_* functions, to @seed.
+// by the g_random_* functions, to seed.
 func RandomSetSeed(SeedVar uint32) {
 
 	xRandomSetSeed(SeedVar)
@@ -197,32 +196,32 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xRandomDouble, libs, "g_random_double")
-	core.PuregoSafeRegister(&xRandomDoubleRange, libs, "g_random_double_range")
-	core.PuregoSafeRegister(&xRandomInt, libs, "g_random_int")
-	core.PuregoSafeRegister(&xRandomIntRange, libs, "g_random_int_range")
-	core.PuregoSafeRegister(&xRandomSetSeed, libs, "g_random_set_seed")
+	core.PuregoRegisterSince(&xRandomDouble, libs, "g_random_double", "GLIB", "")
+	core.PuregoRegisterSince(&xRandomDoubleRange, libs, "g_random_double_range", "GLIB", "")
+	core.PuregoRegisterSince(&xRandomInt, libs, "g_random_int", "GLIB", "")
+	core.PuregoRegisterSince(&xRandomIntRange, libs, "g_random_int_range", "GLIB", "")
+	core.PuregoRegisterSince(&xRandomSetSeed, libs, "g_random_set_seed", "GLIB", "")
 
 	core.PuregoSafeRegister(&xRandGLibType, libs, "g_rand_get_type")
 
-	core.PuregoSafeRegister(&xNewRand, libs, "g_rand_new")
-	core.PuregoSafeRegister(&xNewRandWithSeed, libs, "g_rand_new_with_seed")
-	core.PuregoSafeRegister(&xNewRandWithSeedArray, libs, "g_rand_new_with_seed_array")
-
-	core.PuregoSafeRegister(&xRandCopy, libs, "g_rand_copy")
-	core.PuregoSafeRegister(&xRandDouble, libs, "g_rand_double")
-	core.PuregoSafeRegister(&xRandDoubleRange, libs, "g_rand_double_range")
-	core.PuregoSafeRegister(&xRandFree, libs, "g_rand_free")
-	core.PuregoSafeRegister(&xRandInt, libs, "g_rand_int")
-	core.PuregoSafeRegister(&xRandIntRange, libs, "g_rand_int_range")
-	core.PuregoSafeRegister(&xRandSetSeed, libs, "g_rand_set_seed")
-	core.PuregoSafeRegister(&xRandSetSeedArray, libs, "g_rand_set_seed_array")
+	core.PuregoRegisterSince(&xNewRand, libs, "g_rand_new", "GLIB", "")
+	core.PuregoRegisterSince(&xNewRandWithSeed, libs, "g_rand_new_with_seed", "GLIB", "")
+	core.PuregoRegisterSince(&xNewRandWithSeedArray, libs, "g_rand_new_with_seed_array", "GLIB", "2.4")
+
+	core.PuregoRegisterSince(&xRandCopy, libs, "g_rand_copy", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xRandDouble, libs, "g_rand_double", "GLIB", "")
+	core.PuregoRegisterSince(&xRandDoubleRange, libs, "g_rand_double_range", "GLIB", "")
+	core.PuregoRegisterSince(&xRandFree, libs, "g_rand_free", "GLIB", "")
+	core.PuregoRegisterSince(&xRandInt, libs, "g_rand_int", "GLIB", "")
+	core.PuregoRegisterSince(&xRandIntRange, libs, "g_rand_int_range", "GLIB", "")
+	core.PuregoRegisterSince(&xRandSetSeed, libs, "g_rand_set_seed", "GLIB", "")
+	core.PuregoRegisterSince(&xRandSetSeedArray, libs, "g_rand_set_seed_array", "GLIB", "2.4")
 
 }
@@ -4,13 +4,12 @@ package glib
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xAtomicRcBoxAcquire func(uintptr) uintptr
 
-// Atomically acquires a reference on the data pointed by @mem_block.
+// Atomically acquires a reference on the data pointed by mem_block.
 func AtomicRcBoxAcquire(MemBlockVar uintptr) uintptr {
 
 	cret := xAtomicRcBoxAcquire(MemBlockVar)
@@ -20,7 +19,7 @@ func AtomicRcBoxAcquire(MemBlockVar uintptr) uintptr {
 
 var xAtomicRcBoxAlloc func(uint) uintptr
 
-// Allocates @block_size bytes of memory, and adds atomic
+// Allocates block_size bytes of memory, and adds atomic
 // reference counting semantics to it.
 //
 // The data will be freed when its reference count drops to
@@ -37,7 +36,7 @@ func AtomicRcBoxAlloc(BlockSizeVar uint) uintptr {
 
 var xAtomicRcBoxAlloc0 func(uint) uintptr
 
-// Allocates @block_size bytes of memory, and adds atomic
+// Allocates block_size bytes of memory, and adds atomic
 // reference counting semantics to it.
 //
 // The contents of the returned data is set to zero.
@@ -57,7 +56,7 @@ func AtomicRcBoxAlloc0(BlockSizeVar uint) uintptr {
 var xAtomicRcBoxDup func(uint, uintptr) uintptr
 
 // Allocates a new block of data with atomic reference counting
-// semantics, and copies @block_size bytes of @mem_block
+// semantics, and copies block_size bytes of mem_block
 // into it.
 func AtomicRcBoxDup(BlockSizeVar uint, MemBlockVar uintptr) uintptr {
 
@@ -68,7 +67,7 @@ func AtomicRcBoxDup(BlockSizeVar uint, MemBlockVar uintptr) uintptr {
 
 var xAtomicRcBoxGetSize func(uintptr) uint
 
-// Retrieves the size of the reference counted data pointed by @mem_block.
+// Retrieves the size of the reference counted data pointed by mem_block.
 func AtomicRcBoxGetSize(MemBlockVar uintptr) uint {
 
 	cret := xAtomicRcBoxGetSize(MemBlockVar)
@@ -78,10 +77,10 @@ func AtomicRcBoxGetSize(MemBlockVar uintptr) uint {
 
 var xAtomicRcBoxRelease func(uintptr)
 
-// Atomically releases a reference on the data pointed by @mem_block.
+// Atomically releases a reference on the data pointed by mem_block.
 //
 // If the reference was the last one, it will free the
-// resources allocated for @mem_block.
+// resources allocated for mem_block.
 func AtomicRcBoxRelease(MemBlockVar uintptr) {
 
 	xAtomicRcBoxRelease(MemBlockVar)
@@ -90,15 +89,15 @@ func AtomicRcBoxRelease(MemBlockVar uintptr) {
 
 var xAtomicRcBoxReleaseFull func(uintptr, uintptr)
 
-// Atomically releases a reference on the data pointed by @mem_block.
+// Atomically releases a reference on the data pointed by mem_block.
 //
-// If the reference was the last one, it will call @clear_func
-// to clear the contents of @mem_block, and then will free the
-// resources allocated for @mem_block.
+// If the reference was the last one, it will call clear_func
+// to clear the contents of mem_block, and then will free the
+// resources allocated for mem_block.
 //
-// Note that implementing weak references via @clear_func is not thread-safe:
+// Note that implementing weak references via clear_func is not thread-safe:
 // clearing a pointer to the memory from the callback can race with another
-// thread trying to access it as @mem_block already has a reference count of 0
+// thread trying to access it as mem_block already has a reference count of 0
 // when the callback is called and will be freed.
 func AtomicRcBoxReleaseFull(MemBlockVar uintptr, ClearFuncVar *DestroyNotify) {
 
@@ -109,11 +108,12 @@ func AtomicRcBoxReleaseFull(MemBlockVar uintptr, ClearFuncVar *DestroyNotify) {
 			ClearFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ClearFuncVar
 				cbFn(arg0)
 			}
-			ClearFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ClearFuncVarPtr, ClearFuncVarRef, ClearFuncVar)
+			ClearFuncVarRef = core.NewCallback(fcb)
+			ClearFuncVarRef = SaveCallbackWithClosure(ClearFuncVarPtr, ClearFuncVarRef, ClearFuncVar)
 		}
 	}
 
@@ -123,7 +123,7 @@ func AtomicRcBoxReleaseFull(MemBlockVar uintptr, ClearFuncVar *DestroyNotify) {
 
 var xRcBoxAcquire func(uintptr) uintptr
 
-// Acquires a reference on the data pointed by @mem_block.
+// Acquires a reference on the data pointed by mem_block.
 func RcBoxAcquire(MemBlockVar uintptr) uintptr {
 
 	cret := xRcBoxAcquire(MemBlockVar)
@@ -133,7 +133,7 @@ func RcBoxAcquire(MemBlockVar uintptr) uintptr {
 
 var xRcBoxAlloc func(uint) uintptr
 
-// Allocates @block_size bytes of memory, and adds reference
+// Allocates block_size bytes of memory, and adds reference
 // counting semantics to it.
 //
 // The data will be freed when its reference count drops to
@@ -150,7 +150,7 @@ func RcBoxAlloc(BlockSizeVar uint) uintptr {
 
 var xRcBoxAlloc0 func(uint) uintptr
 
-// Allocates @block_size bytes of memory, and adds reference
+// Allocates block_size bytes of memory, and adds reference
 // counting semantics to it.
 //
 // The contents of the returned data is set to zero.
@@ -170,7 +170,7 @@ func RcBoxAlloc0(BlockSizeVar uint) uintptr {
 var xRcBoxDup func(uint, uintptr) uintptr
 
 // Allocates a new block of data with reference counting
-// semantics, and copies @block_size bytes of @mem_block
+// semantics, and copies block_size bytes of mem_block
 // into it.
 func RcBoxDup(BlockSizeVar uint, MemBlockVar uintptr) uintptr {
 
@@ -181,7 +181,7 @@ func RcBoxDup(BlockSizeVar uint, MemBlockVar uintptr) uintptr {
 
 var xRcBoxGetSize func(uintptr) uint
 
-// Retrieves the size of the reference counted data pointed by @mem_block.
+// Retrieves the size of the reference counted data pointed by mem_block.
 func RcBoxGetSize(MemBlockVar uintptr) uint {
 
 	cret := xRcBoxGetSize(MemBlockVar)
@@ -191,10 +191,10 @@ func RcBoxGetSize(MemBlockVar uintptr) uint {
 
 var xRcBoxRelease func(uintptr)
 
-// Releases a reference on the data pointed by @mem_block.
+// Releases a reference on the data pointed by mem_block.
 //
 // If the reference was the last one, it will free the
-// resources allocated for @mem_block.
+// resources allocated for mem_block.
 func RcBoxRelease(MemBlockVar uintptr) {
 
 	xRcBoxRelease(MemBlockVar)
@@ -203,11 +203,11 @@ func RcBoxRelease(MemBlockVar uintptr) {
 
 var xRcBoxReleaseFull func(uintptr, uintptr)
 
-// Releases a reference on the data pointed by @mem_block.
+// Releases a reference on the data pointed by mem_block.
 //
-// If the reference was the last one, it will call @clear_func
-// to clear the contents of @mem_block, and then will free the
-// resources allocated for @mem_block.
+// If the reference was the last one, it will call clear_func
+// to clear the contents of mem_block, and then will free the
+// resources allocated for mem_block.
 func RcBoxReleaseFull(MemBlockVar uintptr, ClearFuncVar *DestroyNotify) {
 
 	var ClearFuncVarRef uintptr
@@ -217,11 +217,12 @@ func RcBoxReleaseFull(MemBlockVar uintptr, ClearFuncVar *DestroyNotify) {
 			ClearFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ClearFuncVar
 				cbFn(arg0)
 			}
-			ClearFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ClearFuncVarPtr, ClearFuncVarRef, ClearFuncVar)
+			ClearFuncVarRef = core.NewCallback(fcb)
+			ClearFuncVarRef = SaveCallbackWithClosure(ClearFuncVarPtr, ClearFuncVarRef, ClearFuncVar)
 		}
 	}
 
@@ -234,26 +235,26 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAtomicRcBoxAcquire, libs, "g_atomic_rc_box_acquire")
-	core.PuregoSafeRegister(&xAtomicRcBoxAlloc, libs, "g_atomic_rc_box_alloc")
-	core.PuregoSafeRegister(&xAtomicRcBoxAlloc0, libs, "g_atomic_rc_box_alloc0")
-	core.PuregoSafeRegister(&xAtomicRcBoxDup, libs, "g_atomic_rc_box_dup")
-	core.PuregoSafeRegister(&xAtomicRcBoxGetSize, libs, "g_atomic_rc_box_get_size")
-	core.PuregoSafeRegister(&xAtomicRcBoxRelease, libs, "g_atomic_rc_box_release")
-	core.PuregoSafeRegister(&xAtomicRcBoxReleaseFull, libs, "g_atomic_rc_box_release_full")
-	core.PuregoSafeRegister(&xRcBoxAcquire, libs, "g_rc_box_acquire")
-	core.PuregoSafeRegister(&xRcBoxAlloc, libs, "g_rc_box_alloc")
-	core.PuregoSafeRegister(&xRcBoxAlloc0, libs, "g_rc_box_alloc0")
-	core.PuregoSafeRegister(&xRcBoxDup, libs, "g_rc_box_dup")
-	core.PuregoSafeRegister(&xRcBoxGetSize, libs, "g_rc_box_get_size")
-	core.PuregoSafeRegister(&xRcBoxRelease, libs, "g_rc_box_release")
-	core.PuregoSafeRegister(&xRcBoxReleaseFull, libs, "g_rc_box_release_full")
+	core.PuregoRegisterSince(&xAtomicRcBoxAcquire, libs, "g_atomic_rc_box_acquire", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxAlloc, libs, "g_atomic_rc_box_alloc", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxAlloc0, libs, "g_atomic_rc_box_alloc0", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxDup, libs, "g_atomic_rc_box_dup", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxGetSize, libs, "g_atomic_rc_box_get_size", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxRelease, libs, "g_atomic_rc_box_release", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRcBoxReleaseFull, libs, "g_atomic_rc_box_release_full", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxAcquire, libs, "g_rc_box_acquire", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxAlloc, libs, "g_rc_box_alloc", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxAlloc0, libs, "g_rc_box_alloc0", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxDup, libs, "g_rc_box_dup", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxGetSize, libs, "g_rc_box_get_size", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxRelease, libs, "g_rc_box_release", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRcBoxReleaseFull, libs, "g_rc_box_release_full", "GLIB", "2.58")
 
 }
@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -12,44 +11,40 @@ const (
 	// This macro is useful for initializing `gatomicrefcount` fields inside
 	// structures, for instance:
 	//
-	// |[&lt;!-- language="C" --&gt;
-	// typedef struct {
-	//   gatomicrefcount ref_count;
-	//   char *name;
-	//   char *address;
-	// } Person;
+	// 	typedef struct {
+	// 	  gatomicrefcount ref_count;
+	// 	  char *name;
+	// 	  char *address;
+	// 	} Person;
 	//
-	// static const Person default_person = {
-	//   .ref_count = G_ATOMIC_REF_COUNT_INIT,
-	//   .name = "Default name",
-	//   .address = "Default address",
-	// };
-	// ]|
+	// 	static const Person default_person = {
+	// 	  .ref_count = G_ATOMIC_REF_COUNT_INIT,
+	// 	  .name = "Default name",
+	// 	  .address = "Default address",
+	// 	};
 	ATOMIC_REF_COUNT_INIT int = 1
 	// Evaluates to the initial reference count for `grefcount`.
 	//
 	// This macro is useful for initializing `grefcount` fields inside
 	// structures, for instance:
 	//
-	// |[&lt;!-- language="C" --&gt;
-	// typedef struct {
-	//   grefcount ref_count;
-	//   char *name;
-	//   char *address;
-	// } Person;
+	// 	typedef struct {
+	// 	  grefcount ref_count;
+	// 	  char *name;
+	// 	  char *address;
+	// 	} Person;
 	//
-	// static const Person default_person = {
-	//   .ref_count = G_REF_COUNT_INIT,
-	//   .name = "Default name",
-	//   .address = "Default address",
-	// };
-	// ]|
+	// 	static const Person default_person = {
+	// 	  .ref_count = G_REF_COUNT_INIT,
+	// 	  .name = "Default name",
+	// 	  .address = "Default address",
+	// 	};
 	REF_COUNT_INIT int = -1
 )
 
 var xAtomicRefCountCompare func(int, int) bool
 
-// Atomically compares the current value of @arc with @val.
+// Atomically compares the current value of arc with val.
 func AtomicRefCountCompare(ArcVar int, ValVar int) bool {
 
 	cret := xAtomicRefCountCompare(ArcVar, ValVar)
@@ -61,9 +56,9 @@ var xAtomicRefCountDec func(int) bool
 
 // Atomically decreases the reference count.
 //
-// If %TRUE is returned, the reference count reached 0. After this point, @arc
+// If true is returned, the reference count reached 0. After this point, arc
 // is an undefined state and must be reinitialized with
-// g_atomic_ref_count_init() to be used again.
+// [AtomicRefCountInit] to be used again.
 func AtomicRefCountDec(ArcVar int) bool {
 
 	cret := xAtomicRefCountDec(ArcVar)
@@ -91,7 +86,7 @@ func AtomicRefCountInit(ArcVar *int) {
 
 var xRefCountCompare func(int, int) bool
 
-// Compares the current value of @rc with @val.
+// Compares the current value of rc with val.
 func RefCountCompare(RcVar int, ValVar int) bool {
 
 	cret := xRefCountCompare(RcVar, ValVar)
@@ -103,9 +98,9 @@ var xRefCountDec func(int) bool
 
 // Decreases the reference count.
 //
-// If %TRUE is returned, the reference count reached 0. After this point, @rc
+// If true is returned, the reference count reached 0. After this point, rc
 // is an undefined state and must be reinitialized with
-// g_ref_count_init() to be used again.
+// [RefCountInit] to be used again.
 func RefCountDec(RcVar int) bool {
 
 	cret := xRefCountDec(RcVar)
@@ -136,20 +131,20 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAtomicRefCountCompare, libs, "g_atomic_ref_count_compare")
-	core.PuregoSafeRegister(&xAtomicRefCountDec, libs, "g_atomic_ref_count_dec")
-	core.PuregoSafeRegister(&xAtomicRefCountInc, libs, "g_atomic_ref_count_inc")
-	core.PuregoSafeRegister(&xAtomicRefCountInit, libs, "g_atomic_ref_count_init")
-	core.PuregoSafeRegister(&xRefCountCompare, libs, "g_ref_count_compare")
-	core.PuregoSafeRegister(&xRefCountDec, libs, "g_ref_count_dec")
-	core.PuregoSafeRegister(&xRefCountInc, libs, "g_ref_count_inc")
-	core.PuregoSafeRegister(&xRefCountInit, libs, "g_ref_count_init")
+	core.PuregoRegisterSince(&xAtomicRefCountCompare, libs, "g_atomic_ref_count_compare", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRefCountDec, libs, "g_atomic_ref_count_dec", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRefCountInc, libs, "g_atomic_ref_count_inc", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xAtomicRefCountInit, libs, "g_atomic_ref_count_init", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefCountCompare, libs, "g_ref_count_compare", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefCountDec, libs, "g_ref_count_dec", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefCountInc, libs, "g_ref_count_inc", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefCountInit, libs, "g_ref_count_init", "GLIB", "2.58")
 
 }
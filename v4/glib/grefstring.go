@@ -2,16 +2,15 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// A typedef for a reference-counted string. A pointer to a #GRefString can be
+// A typedef for a reference-counted string. A pointer to a [RefString] can be
 // treated like a standard `char*` array by all code, but can additionally have
 // `g_ref_string_*()` methods called on it. `g_ref_string_*()` methods cannot be
-// called on `char*` arrays not allocated using g_ref_string_new().
+// called on `char*` arrays not allocated using [RefStringNew].
 //
-// If using #GRefString with autocleanups, g_autoptr() must be used rather than
+// If using [RefString] with autocleanups, g_autoptr() must be used rather than
 // g_autofree(), so that the reference counting metadata is also freed.
 type RefString = byte
 
@@ -29,8 +28,8 @@ var xRefStringEqual func(string, string) bool
 
 // Compares two ref-counted strings for byte-by-byte equality.
 //
-// It can be passed to [func@GLib.HashTable.new] as the key equality function,
-// and behaves exactly the same as [func@GLib.str_equal] (or `strcmp()`), but
+// It can be passed to HashTable.new as the key equality function,
+// and behaves exactly the same as [StrEqual] (or `strcmp()`), but
 // can return slightly faster as it can check the string lengths before checking
 // all the bytes.
 func RefStringEqual(Str1Var string, Str2Var string) bool {
@@ -42,7 +41,7 @@ func RefStringEqual(Str1Var string, Str2Var string) bool {
 
 var xRefStringLength func(string) uint
 
-// Retrieves the length of @str.
+// Retrieves the length of str.
 func RefStringLength(StrVar string) uint {
 
 	cret := xRefStringLength(StrVar)
@@ -52,7 +51,7 @@ func RefStringLength(StrVar string) uint {
 
 var xRefStringNew func(string) string
 
-// Creates a new reference counted string and copies the contents of @str
+// Creates a new reference counted string and copies the contents of str
 // into it.
 func RefStringNew(StrVar string) string {
 
@@ -63,11 +62,11 @@ func RefStringNew(StrVar string) string {
 
 var xRefStringNewIntern func(string) string
 
-// Creates a new reference counted string and copies the content of @str
+// Creates a new reference counted string and copies the content of str
 // into it.
 //
-// If you call this function multiple times with the same @str, or with
-// the same contents of @str, it will return a new reference, instead of
+// If you call this function multiple times with the same str, or with
+// the same contents of str, it will return a new reference, instead of
 // creating a new string.
 func RefStringNewIntern(StrVar string) string {
 
@@ -78,11 +77,11 @@ func RefStringNewIntern(StrVar string) string {
 
 var xRefStringNewLen func(string, int) string
 
-// Creates a new reference counted string and copies the contents of @str
-// into it, up to @len bytes.
+// Creates a new reference counted string and copies the contents of str
+// into it, up to len bytes.
 //
 // Since this function does not stop at nul bytes, it is the caller's
-// responsibility to ensure that @str has at least @len addressable bytes.
+// responsibility to ensure that str has at least len addressable bytes.
 func RefStringNewLen(StrVar string, LenVar int) string {
 
 	cret := xRefStringNewLen(StrVar, LenVar)
@@ -105,19 +104,19 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xRefStringAcquire, libs, "g_ref_string_acquire")
-	core.PuregoSafeRegister(&xRefStringEqual, libs, "g_ref_string_equal")
-	core.PuregoSafeRegister(&xRefStringLength, libs, "g_ref_string_length")
-	core.PuregoSafeRegister(&xRefStringNew, libs, "g_ref_string_new")
-	core.PuregoSafeRegister(&xRefStringNewIntern, libs, "g_ref_string_new_intern")
-	core.PuregoSafeRegister(&xRefStringNewLen, libs, "g_ref_string_new_len")
-	core.PuregoSafeRegister(&xRefStringRelease, libs, "g_ref_string_release")
+	core.PuregoRegisterSince(&xRefStringAcquire, libs, "g_ref_string_acquire", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefStringEqual, libs, "g_ref_string_equal", "GLIB", "2.84")
+	core.PuregoRegisterSince(&xRefStringLength, libs, "g_ref_string_length", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefStringNew, libs, "g_ref_string_new", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefStringNewIntern, libs, "g_ref_string_new_intern", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefStringNewLen, libs, "g_ref_string_new_len", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xRefStringRelease, libs, "g_ref_string_release", "GLIB", "2.58")
 
 }
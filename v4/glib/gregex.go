@@ -2,18 +2,19 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Specifies the type of the function passed to g_regex_replace_eval().
+// Specifies the type of the function passed to [Regex.ReplaceEval].
 // It is called for each occurrence of the pattern in the string passed
-// to g_regex_replace_eval(), and it should append the replacement to
-// @result.
+// to [Regex.ReplaceEval], and it should append the replacement to
+// result.
 type RegexEvalCallback func(*MatchInfo, *String, uintptr) bool
 
 // A GMatchInfo is an opaque struct used to return information about
@@ -34,29 +35,29 @@ func (x *MatchInfo) GoPointer() uintptr {
 
 var xMatchInfoExpandReferences func(uintptr, string, **Error) string
 
-// Returns a new string containing the text in @string_to_expand with
+// Returns a new string containing the text in string_to_expand with
 // references and escape sequences expanded. References refer to the last
-// match done with @string against @regex and have the same syntax used by
-// g_regex_replace().
+// match done with string against regex and have the same syntax used by
+// [Regex.Replace].
 //
-// The @string_to_expand must be UTF-8 encoded even if %G_REGEX_RAW was
-// passed to g_regex_new().
+// The string_to_expand must be UTF-8 encoded even if [GRegexRawValue] was
+// passed to [NewRegex].
 //
 // The backreferences are extracted from the string passed to the match
 // function, so you cannot call this function after freeing the string.
 //
-// @match_info may be %NULL in which case @string_to_expand must not
+// match_info may be nil in which case string_to_expand must not
 // contain references. For instance "foo\n" does not refer to an actual
 // pattern and '\n' merely will be replaced with \n character,
 // while to expand "\0" (whole match) one needs the result of a match.
-// Use g_regex_check_replacement() to find out whether @string_to_expand
+// Use [RegexCheckReplacement] to find out whether string_to_expand
 // contains references.
 func (x *MatchInfo) ExpandReferences(StringToExpandVar string) (string, error) {
 	var cerr *Error
 
 	cret := xMatchInfoExpandReferences(x.GoPointer(), StringToExpandVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -64,16 +65,16 @@ func (x *MatchInfo) ExpandReferences(StringToExpandVar string) (string, error) {
 
 var xMatchInfoFetch func(uintptr, int) string
 
-// Retrieves the text matching the @match_num'th capturing
+// Retrieves the text matching the match_num'th capturing
 // parentheses. 0 is the full text of the match, 1 is the first paren
 // set, 2 the second, and so on.
 //
-// If @match_num is a valid sub pattern but it didn't match anything
+// If match_num is a valid sub pattern but it didn't match anything
 // (e.g. sub pattern 1, matching "b" against "(a)?b") then an empty
 // string is returned.
 //
 // If the match was obtained using the DFA algorithm, that is using
-// g_regex_match_all() or g_regex_match_all_full(), the retrieved
+// [Regex.MatchAll] or [Regex.MatchAllFull], the retrieved
 // string is not that of a set of parentheses but that of a matched
 // substring. Substrings are matched in reverse order of length, so
 // 0 is the longest match.
@@ -86,7 +87,7 @@ func (x *MatchInfo) Fetch(MatchNumVar int) string {
 	return cret
 }
 
-var xMatchInfoFetchAll func(uintptr) []string
+var xMatchInfoFetchAll func(uintptr) uintptr
 
 // Bundles up pointers to each of the matching substrings from a match
 // and stores them in an array of gchar pointers. The first element in
@@ -97,7 +98,7 @@ var xMatchInfoFetchAll func(uintptr) []string
 // "b" against "(a)?b") then an empty string is inserted.
 //
 // If the last match was obtained using the DFA algorithm, that is using
-// g_regex_match_all() or g_regex_match_all_full(), the retrieved
+// [Regex.MatchAll] or [Regex.MatchAllFull], the retrieved
 // strings are not that matched by sets of parentheses but that of the
 // matched substring. Substrings are matched in reverse order of length,
 // so the first one is the longest match.
@@ -107,15 +108,15 @@ var xMatchInfoFetchAll func(uintptr) []string
 func (x *MatchInfo) FetchAll() []string {
 
 	cret := xMatchInfoFetchAll(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xMatchInfoFetchNamed func(uintptr, string) string
 
-// Retrieves the text matching the capturing parentheses named @name.
+// Retrieves the text matching the capturing parentheses named name.
 //
-// If @name is a valid sub pattern name but it didn't match anything
-// (e.g. sub pattern `"X"`, matching `"b"` against `"(?P&lt;X&gt;a)?b"`)
+// If name is a valid sub pattern name but it didn't match anything
+// (e.g. sub pattern `"X"`, matching `"b"` against `"(?P<X>a)?b"`)
 // then an empty string is returned.
 //
 // The string is fetched from the string passed to the match function,
@@ -128,13 +129,13 @@ func (x *MatchInfo) FetchNamed(NameVar string) string {
 
 var xMatchInfoFetchNamedPos func(uintptr, string, *int, *int) bool
 
-// Retrieves the position in bytes of the capturing parentheses named @name.
+// Retrieves the position in bytes of the capturing parentheses named name.
 //
-// If @name is a valid sub pattern name but it didn't match anything
-// (e.g. sub pattern `"X"`, matching `"b"` against `"(?P&lt;X&gt;a)?b"`)
-// then @start_pos and @end_pos are set to -1 and %TRUE is returned.
+// If name is a valid sub pattern name but it didn't match anything
+// (e.g. sub pattern `"X"`, matching `"b"` against `"(?P<X>a)?b"`)
+// then start_pos and end_pos are set to -1 and true is returned.
 //
-// As @end_pos is set to the byte after the final byte of the match (on success),
+// As end_pos is set to the byte after the final byte of the match (on success),
 // the length of the match can be calculated as `end_pos - start_pos`.
 func (x *MatchInfo) FetchNamedPos(NameVar string, StartPosVar *int, EndPosVar *int) bool {
 
@@ -147,36 +148,36 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 // Returns the start and end positions (in bytes) of a successfully matching
 // capture parenthesis.
 //
-// Valid values for @match_num are `0` for the full text of the match,
+// Valid values for match_num are `0` for the full text of the match,
 // `1` for the first paren set, `2` for the second, and so on.
 //
-// As @end_pos is set to the byte after the final byte of the match (on success),
+// As end_pos is set to the byte after the final byte of the match (on success),
 // the length of the match can be calculated as `end_pos - start_pos`.
 //
-// As a best practice, initialize @start_pos and @end_pos to identifiable
+// As a best practice, initialize start_pos and end_pos to identifiable
 // values, such as `G_MAXINT`, so that you can test if
-// `g_match_info_fetch_pos()` actually changed the value for a given
+// `[MatchInfo.FetchPos]` actually changed the value for a given
 // capture parenthesis.
 //
-// The parameter @match_num corresponds to a matched capture parenthesis. The
-// actual value you use for @match_num depends on the method used to generate
-// @match_info. The following sections describe those methods.
+// The parameter match_num corresponds to a matched capture parenthesis. The
+// actual value you use for match_num depends on the method used to generate
+// match_info. The following sections describe those methods.
 //
-// ## Methods Using Non-deterministic Finite Automata Matching
+// # Methods Using Non-deterministic Finite Automata Matching
 //
-// The methods [method@GLib.Regex.match] and [method@GLib.Regex.match_full]
-// return a [struct@GLib.MatchInfo] using traditional (greedy) pattern
+// The methods [Regex.Match] and [Regex.MatchFull]
+// return a [MatchInfo] using traditional (greedy) pattern
 // matching, also known as
-// [Non-deterministic Finite Automaton](https://en.wikipedia.org/wiki/Nondeterministic_finite_automaton)
+// [Non-deterministic Finite Automaton]
 // (NFA) matching. You pass the returned `GMatchInfo` from these methods to
-// `g_match_info_fetch_pos()` to determine the start and end positions
-// of capture parentheses. The values for @match_num correspond to the capture
+// `[MatchInfo.FetchPos]` to determine the start and end positions
+// of capture parentheses. The values for match_num correspond to the capture
 // parentheses in order, with `0` corresponding to the entire matched string.
 //
-// @match_num can refer to a capture parenthesis with no match. For example,
+// match_num can refer to a capture parenthesis with no match. For example,
 // the string `b` matches against the pattern `(a)?b`, but the capture
-// parenthesis `(a)` has no match. In this case, `g_match_info_fetch_pos()`
-// returns true and sets @start_pos and @end_pos to `-1` when called with
+// parenthesis `(a)` has no match. In this case, `[MatchInfo.FetchPos]`
+// returns true and sets start_pos and end_pos to `-1` when called with
 // `match_num` as `1` (for `(a)`).
 //
 // For an expanded example, a regex pattern is `(a)?(.*?)the (.*)`,
@@ -185,7 +186,7 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 // for the entire string, and three explicitly declared in the regex pattern.
 //
 // Given this example, the following table describes the return values
-// from `g_match_info_fetch_pos()` for various values of @match_num.
+// from `[MatchInfo.FetchPos]` for various values of match_num.
 //
 // `match_num` | Contents | Return value | Returned `start_pos` | Returned `end_pos`
 // ----------- | -------- | ------------ | -------------------- | ------------------
@@ -197,12 +198,10 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //
 // The following code sample and output implements this example.
 //
-// ``` { .c }
-// #include &lt;glib.h&gt;
-//
-// int
-// main (int argc, char *argv[])
+//	#include <glib.h>
 //
+//	int
+//	main (int argc, char *argv[])
 //	{
 //	  g_autoptr(GError) local_error = NULL;
 //	  const char *regex_pattern = "(a)?(.*?)the (.*)";
@@ -212,15 +211,15 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //	  regex = g_regex_new (regex_pattern,
 //	                       G_REGEX_DEFAULT,
 //	                       G_REGEX_MATCH_DEFAULT,
-//	                       &amp;local_error);
+//	                       &local_error);
 //	  if (regex == NULL)
 //	    {
-//	      g_printerr ("Error creating regex: %s\n", local_error-&gt;message);
+//	      g_printerr ("Error creating regex: %s\n", local_error->message);
 //	      return 1;
 //	    }
 //
 //	  g_autoptr(GMatchInfo) match_info = NULL;
-//	  g_regex_match (regex, test_string, G_REGEX_MATCH_DEFAULT, &amp;match_info);
+//	  g_regex_match (regex, test_string, G_REGEX_MATCH_DEFAULT, &match_info);
 //
 //	  int n_matched_strings = g_match_info_get_match_count (match_info);
 //
@@ -228,7 +227,7 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //	  g_print ("match_num Contents                  Return value returned start_pos returned end_pos\n");
 //
 //	  // Iterate over each capture paren, including one that is out of range as a demonstration.
-//	  for (int match_num = 0; match_num &lt;= n_matched_strings; match_num++)
+//	  for (int match_num = 0; match_num <= n_matched_strings; match_num++)
 //	    {
 //	      gboolean found_match;
 //	      g_autofree char *paren_string = NULL;
@@ -237,8 +236,8 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //
 //	      found_match = g_match_info_fetch_pos (match_info,
 //	                                            match_num,
-//	                                            &amp;start_pos,
-//	                                            &amp;end_pos);
+//	                                            &start_pos,
+//	                                            &end_pos);
 //
 //	      // If no match, display N/A as the found string.
 //	      if (start_pos == G_MAXINT || start_pos == -1)
@@ -252,68 +251,63 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //	  return 0;
 //	}
 //
-// ```
+//	match_num Contents                  Return value returned start_pos returned end_pos
+//	0         glib regexes are the best 1            0                  25
+//	1         N/A                       1            -1                 -1
+//	2         glib regexes are          1            0                  17
+//	3         best                      1            21                 25
+//	4         N/A                       0            2147483647         2147483647
 //
-// ```
-// match_num Contents                  Return value returned start_pos returned end_pos
-// 0         glib regexes are the best 1            0                  25
-// 1         N/A                       1            -1                 -1
-// 2         glib regexes are          1            0                  17
-// 3         best                      1            21                 25
-// 4         N/A                       0            2147483647         2147483647
-// ```
-// ## Methods Using Deterministic Finite Automata Matching
+// # Methods Using Deterministic Finite Automata Matching
 //
-// The methods [method@GLib.Regex.match_all] and
-// [method@GLib.Regex.match_all_full]
+// The methods [Regex.MatchAll] and
+// [Regex.MatchAllFull]
 // return a `GMatchInfo` using
-// [Deterministic Finite Automaton](https://en.wikipedia.org/wiki/Deterministic_finite_automaton)
+// [Deterministic Finite Automaton]
 // (DFA) pattern matching. This algorithm detects overlapping matches. You pass
-// the returned `GMatchInfo` from these methods to `g_match_info_fetch_pos()`
+// the returned `GMatchInfo` from these methods to `[MatchInfo.FetchPos]`
 // to determine the start and end positions of each overlapping match. Use the
-// method [method@GLib.MatchInfo.get_match_count] to determine the number
+// method [MatchInfo.GetMatchCount] to determine the number
 // of overlapping matches.
 //
-// For example, a regex pattern is `&lt;.*&gt;`, and a candidate string is
-// `&lt;a&gt; &lt;b&gt; &lt;c&gt;`. In this scenario there are three implicit capture
-// parentheses: one for the entire string, one for `&lt;a&gt; &lt;b&gt;`, and one for `&lt;a&gt;`.
+// For example, a regex pattern is `<.*>`, and a candidate string is
+// `<a>  <c>`. In this scenario there are three implicit capture
+// parentheses: one for the entire string, one for `<a> `, and one for `<a>`.
 //
 // Given this example, the following table describes the return values from
-// `g_match_info_fetch_pos()` for various values of @match_num.
+// `[MatchInfo.FetchPos]` for various values of match_num.
 //
 // `match_num` | Contents | Return value | Returned `start_pos` | Returned `end_pos`
 // ----------- | -------- | ------------ | -------------------- | ------------------
 // 0 | Matches entire string | True | 0 | 11
-// 1 | Matches `&lt;a&gt; &lt;b&gt;` | True | 0 | 7
-// 2 | Matches `&lt;a&gt;` | True | 0 | 3
+// 1 | Matches `<a> ` | True | 0 | 7
+// 2 | Matches `<a>` | True | 0 | 3
 // 3 | Capture paren out of range | False | Unchanged | Unchanged
 //
 // The following code sample and output implements this example.
 //
-// ``` { .c }
-// #include &lt;glib.h&gt;
-//
-// int
-// main (int argc, char *argv[])
+//	#include <glib.h>
 //
+//	int
+//	main (int argc, char *argv[])
 //	{
 //	  g_autoptr(GError) local_error = NULL;
-//	  const char *regex_pattern = "&lt;.*&gt;";
-//	  const char *test_string = "&lt;a&gt; &lt;b&gt; &lt;c&gt;";
+//	  const char *regex_pattern = "<.*>";
+//	  const char *test_string = "<a> <b> <c>";
 //	  g_autoptr(GRegex) regex = NULL;
 //
 //	  regex = g_regex_new (regex_pattern,
 //	                       G_REGEX_DEFAULT,
 //	                       G_REGEX_MATCH_DEFAULT,
-//	                       &amp;local_error);
+//	                       &local_error);
 //	  if (regex == NULL)
 //	    {
-//	      g_printerr ("Error creating regex: %s\n", local_error-&gt;message);
+//	      g_printerr ("Error creating regex: %s\n", local_error->message);
 //	      return -1;
 //	    }
 //
 //	  g_autoptr(GMatchInfo) match_info = NULL;
-//	  g_regex_match_all (regex, test_string, G_REGEX_MATCH_DEFAULT, &amp;match_info);
+//	  g_regex_match_all (regex, test_string, G_REGEX_MATCH_DEFAULT, &match_info);
 //
 //	  int n_matched_strings = g_match_info_get_match_count (match_info);
 //
@@ -321,14 +315,14 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //	  g_print ("match_num Contents                  Return value returned start_pos returned end_pos\n");
 //
 //	  // Iterate over each capture paren, including one that is out of range as a demonstration.
-//	  for (int match_num = 0; match_num &lt;= n_matched_strings; match_num++)
+//	  for (int match_num = 0; match_num <= n_matched_strings; match_num++)
 //	    {
 //	      gboolean found_match;
 //	      g_autofree char *paren_string = NULL;
 //	      int start_pos = G_MAXINT;
 //	      int end_pos = G_MAXINT;
 //
-//	      found_match = g_match_info_fetch_pos (match_info, match_num, &amp;start_pos, &amp;end_pos);
+//	      found_match = g_match_info_fetch_pos (match_info, match_num, &start_pos, &end_pos);
 //
 //	      // If no match, display N/A as the found string.
 //	      if (start_pos == G_MAXINT || start_pos == -1)
@@ -342,15 +336,14 @@ var xMatchInfoFetchPos func(uintptr, int, *int, *int) bool
 //	  return 0;
 //	}
 //
-// ```
+//	match_num Contents                  Return value returned start_pos returned end_pos
+//	0         <a> <b> <c>               1            0                  11
+//	1         <a> <b>                   1            0                  7
+//	2         <a>                       1            0                  3
+//	3         N/A                       0            2147483647         2147483647
 //
-// ```
-// match_num Contents                  Return value returned start_pos returned end_pos
-// 0         &lt;a&gt; &lt;b&gt; &lt;c&gt;               1            0                  11
-// 1         &lt;a&gt; &lt;b&gt;                   1            0                  7
-// 2         &lt;a&gt;                       1            0                  3
-// 3         N/A                       0            2147483647         2147483647
-// ```
+// [Non-deterministic Finite Automaton]: https://en.wikipedia.org/wiki/Nondeterministic_finite_automaton
+// [Deterministic Finite Automaton]: https://en.wikipedia.org/wiki/Deterministic_finite_automaton
 func (x *MatchInfo) FetchPos(MatchNumVar int, StartPosVar *int, EndPosVar *int) bool {
 
 	cret := xMatchInfoFetchPos(x.GoPointer(), MatchNumVar, StartPosVar, EndPosVar)
@@ -359,7 +352,7 @@ func (x *MatchInfo) FetchPos(MatchNumVar int, StartPosVar *int, EndPosVar *int)
 
 var xMatchInfoFree func(uintptr)
 
-// If @match_info is not %NULL, calls g_match_info_unref(); otherwise does
+// If match_info is not nil, calls [MatchInfo.Unref]; otherwise does
 // nothing.
 func (x *MatchInfo) Free() {
 
@@ -374,7 +367,7 @@ var xMatchInfoGetMatchCount func(uintptr) int
 // has no substrings in it and 0 is returned if the match failed.
 //
 // If the last match was obtained using the DFA algorithm, that is
-// using g_regex_match_all() or g_regex_match_all_full(), the retrieved
+// using [Regex.MatchAll] or [Regex.MatchAllFull], the retrieved
 // count is not that of the number of capturing parentheses but that of
 // the number of matched substrings.
 func (x *MatchInfo) GetMatchCount() int {
@@ -385,9 +378,9 @@ func (x *MatchInfo) GetMatchCount() int {
 
 var xMatchInfoGetRegex func(uintptr) *Regex
 
-// Returns #GRegex object used in @match_info. It belongs to Glib
-// and must not be freed. Use g_regex_ref() if you need to keep it
-// after you free @match_info object.
+// Returns [Regex] object used in match_info. It belongs to Glib
+// and must not be freed. Use [Regex.Ref] if you need to keep it
+// after you free match_info object.
 func (x *MatchInfo) GetRegex() *Regex {
 
 	cret := xMatchInfoGetRegex(x.GoPointer())
@@ -396,8 +389,8 @@ func (x *MatchInfo) GetRegex() *Regex {
 
 var xMatchInfoGetString func(uintptr) string
 
-// Returns the string searched with @match_info. This is the
-// string passed to g_regex_match() or g_regex_replace() so
+// Returns the string searched with match_info. This is the
+// string passed to [Regex.Match] or [Regex.Replace] so
 // you may not free it before calling this function.
 func (x *MatchInfo) GetString() string {
 
@@ -408,7 +401,7 @@ func (x *MatchInfo) GetString() string {
 var xMatchInfoIsPartialMatch func(uintptr) bool
 
 // Usually if the string passed to g_regex_match*() matches as far as
-// it goes, but is too short to match the entire pattern, %FALSE is
+// it goes, but is too short to match the entire pattern, false is
 // returned. There are circumstances where it might be helpful to
 // distinguish this case from other cases in which there is no match.
 //
@@ -421,19 +414,19 @@ var xMatchInfoIsPartialMatch func(uintptr) bool
 // able to raise an error as soon as a mistake is made.
 //
 // GRegex supports the concept of partial matching by means of the
-// %G_REGEX_MATCH_PARTIAL_SOFT and %G_REGEX_MATCH_PARTIAL_HARD flags.
+// [GRegexMatchPartialSoftValue] and [GRegexMatchPartialHardValue] flags.
 // When they are used, the return code for
-// g_regex_match() or g_regex_match_full() is, as usual, %TRUE
-// for a complete match, %FALSE otherwise. But, when these functions
-// return %FALSE, you can check if the match was partial calling
-// g_match_info_is_partial_match().
-//
-// The difference between %G_REGEX_MATCH_PARTIAL_SOFT and
-// %G_REGEX_MATCH_PARTIAL_HARD is that when a partial match is encountered
-// with %G_REGEX_MATCH_PARTIAL_SOFT, matching continues to search for a
-// possible complete match, while with %G_REGEX_MATCH_PARTIAL_HARD matching
+// [Regex.Match] or [Regex.MatchFull] is, as usual, true
+// for a complete match, false otherwise. But, when these functions
+// return false, you can check if the match was partial calling
+// [MatchInfo.IsPartialMatch].
+//
+// The difference between [GRegexMatchPartialSoftValue] and
+// [GRegexMatchPartialHardValue] is that when a partial match is encountered
+// with [GRegexMatchPartialSoftValue], matching continues to search for a
+// possible complete match, while with [GRegexMatchPartialHardValue] matching
 // stops at the partial match.
-// When both %G_REGEX_MATCH_PARTIAL_SOFT and %G_REGEX_MATCH_PARTIAL_HARD
+// When both [GRegexMatchPartialSoftValue] and [GRegexMatchPartialHardValue]
 // are set, the latter takes precedence.
 //
 // There were formerly some restrictions on the pattern for partial matching.
@@ -458,8 +451,8 @@ func (x *MatchInfo) Matches() bool {
 var xMatchInfoNext func(uintptr) bool
 
 // Scans for the next match using the same parameters of the previous
-// call to g_regex_match_full() or g_regex_match() that returned
-// @match_info.
+// call to [Regex.MatchFull] or [Regex.Match] that returned
+// match_info.
 //
 // The match is done on the string passed to the match function, so you
 // cannot free it before calling this function.
@@ -468,7 +461,7 @@ func (x *MatchInfo) Next() (bool, error) {
 
 	cret := xMatchInfoNext(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -476,7 +469,7 @@ func (x *MatchInfo) Next() (bool, error) {
 
 var xMatchInfoRef func(uintptr) *MatchInfo
 
-// Increases reference count of @match_info by 1.
+// Increases reference count of match_info by 1.
 func (x *MatchInfo) Ref() *MatchInfo {
 
 	cret := xMatchInfoRef(x.GoPointer())
@@ -485,7 +478,7 @@ func (x *MatchInfo) Ref() *MatchInfo {
 
 var xMatchInfoUnref func(uintptr)
 
-// Decreases reference count of @match_info by 1. When reference count drops
+// Decreases reference count of match_info by 1. When reference count drops
 // to zero, it frees all the memory associated with the match_info structure.
 func (x *MatchInfo) Unref() {
 
@@ -501,42 +494,36 @@ func (x *MatchInfo) Unref() {
 // `GRegex` implements regular expression pattern matching using syntax and
 // semantics (such as character classes, quantifiers, and capture groups)
 // similar to Perl regular expression. See the
-// [PCRE documentation](man:pcre2pattern(3)) for details.
+// PCRE documentation) for details.
 //
 // A typical scenario for regex pattern matching is to check if a string
 // matches a pattern. The following statements implement this scenario.
 //
-// ``` { .c }
-// const char *regex_pattern = ".*GLib.*";
-// const char *string_to_search = "You will love the GLib implementation of regex";
-// g_autoptr(GMatchInfo) match_info = NULL;
-// g_autoptr(GRegex) regex = NULL;
-//
-// regex = g_regex_new (regex_pattern, G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
-// g_assert (regex != NULL);
-//
-// if (g_regex_match (regex, string_to_search, G_REGEX_MATCH_DEFAULT, &amp;match_info))
-//
-//	{
-//	  int start_pos, end_pos;
-//	  g_match_info_fetch_pos (match_info, 0, &amp;start_pos, &amp;end_pos);
-//	  g_print ("Match successful! Overall pattern matches bytes %d to %d\n", start_pos, end_pos);
-//	}
-//
-// else
-//
-//	{
-//	  g_print ("No match!\n");
-//	}
-//
-// ```
+//	const char *regex_pattern = ".*GLib.*";
+//	const char *string_to_search = "You will love the GLib implementation of regex";
+//	g_autoptr(GMatchInfo) match_info = NULL;
+//	g_autoptr(GRegex) regex = NULL;
+//
+//	regex = g_regex_new (regex_pattern, G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
+//	g_assert (regex != NULL);
+//
+//	if (g_regex_match (regex, string_to_search, G_REGEX_MATCH_DEFAULT, &match_info))
+//	  {
+//	    int start_pos, end_pos;
+//	    g_match_info_fetch_pos (match_info, 0, &start_pos, &end_pos);
+//	    g_print ("Match successful! Overall pattern matches bytes %d to %d\n", start_pos, end_pos);
+//	  }
+//	else
+//	  {
+//	    g_print ("No match!\n");
+//	  }
 //
 // The constructor for `GRegex` includes two sets of bitmapped flags:
 //
-// * [flags@GLib.RegexCompileFlags]—These flags
+// * [RegexCompileFlags]—These flags
 // control how GLib compiles the regex. There are options for case
 // sensitivity, multiline, ignoring whitespace, etc.
-// * [flags@GLib.RegexMatchFlags]—These flags control
+// * [RegexMatchFlags]—These flags control
 // `GRegex`’s matching behavior, such as anchoring and customizing definitions
 // for newline characters.
 //
@@ -555,45 +542,37 @@ func (x *MatchInfo) Unref() {
 // `care` preceded by a non-word boundary character (instead of a hyphen)
 // and followed by a space.
 //
-// ``` { .c }
-// const char *regex_pattern = "\\Bcare\\s";
-// ```
+//	const char *regex_pattern = "\\Bcare\\s";
 //
 // An efficient way to match with this pattern is to start examining at
 // `start_position` 6 in the string `healthcare` or `health-care`.
 //
-// ``` { .c }
-// const char *regex_pattern = "\\Bcare\\s";
-// const char *string_to_search = "healthcare provider";
-// g_autoptr(GMatchInfo) match_info = NULL;
-// g_autoptr(GRegex) regex = NULL;
-//
-// regex = g_regex_new (
-//
-//	regex_pattern,
-//	G_REGEX_DEFAULT,
-//	G_REGEX_MATCH_DEFAULT,
-//	NULL);
-//
-// g_assert (regex != NULL);
-//
-// g_regex_match_full (
-//
-//	regex,
-//	string_to_search,
-//	-1,
-//	6, // position of 'c' in the test string.
-//	G_REGEX_MATCH_DEFAULT,
-//	&amp;match_info,
-//	NULL);
-//
-// ```
-//
-// The method [method@GLib.Regex.match_full] (and other methods implementing
+//	const char *regex_pattern = "\\Bcare\\s";
+//	const char *string_to_search = "healthcare provider";
+//	g_autoptr(GMatchInfo) match_info = NULL;
+//	g_autoptr(GRegex) regex = NULL;
+//
+//	regex = g_regex_new (
+//	  regex_pattern,
+//	  G_REGEX_DEFAULT,
+//	  G_REGEX_MATCH_DEFAULT,
+//	  NULL);
+//	g_assert (regex != NULL);
+//
+//	g_regex_match_full (
+//	  regex,
+//	  string_to_search,
+//	  -1,
+//	  6, // position of 'c' in the test string.
+//	  G_REGEX_MATCH_DEFAULT,
+//	  &match_info,
+//	  NULL);
+//
+// The method [Regex.MatchFull] (and other methods implementing
 // `start_pos`) allow for lookback before the start position to determine if
 // the previous character satisfies an assertion.
 //
-// Unless you set the [flags@GLib.RegexCompileFlags.RAW] as one of
+// Unless you set the RegexCompileFlags.RAW as one of
 // the `GRegexCompileFlags`, all the strings passed to `GRegex` methods must
 // be encoded in UTF-8. The lengths and the positions inside the strings are
 // in bytes and not in characters, so, for instance, `\xc3\xa0` (i.e., `à`)
@@ -611,24 +590,26 @@ func (x *MatchInfo) Unref() {
 // The behaviour of the dot, circumflex, and dollar metacharacters are
 // affected by newline characters. By default, `GRegex` matches any newline
 // character matched by `\R`. You can limit the matched newline characters by
-// specifying the [flags@GLib.RegexMatchFlags.NEWLINE_CR],
-// [flags@GLib.RegexMatchFlags.NEWLINE_LF], and
-// [flags@GLib.RegexMatchFlags.NEWLINE_CRLF] compile options, and
-// with [flags@GLib.RegexMatchFlags.NEWLINE_ANY],
-// [flags@GLib.RegexMatchFlags.NEWLINE_CR],
-// [flags@GLib.RegexMatchFlags.NEWLINE_LF] and
-// [flags@GLib.RegexMatchFlags.NEWLINE_CRLF] match options.
+// specifying the RegexMatchFlags.NEWLINE_CR,
+// RegexMatchFlags.NEWLINE_LF, and
+// RegexMatchFlags.NEWLINE_CRLF compile options, and
+// with RegexMatchFlags.NEWLINE_ANY,
+// RegexMatchFlags.NEWLINE_CR,
+// RegexMatchFlags.NEWLINE_LF and
+// RegexMatchFlags.NEWLINE_CRLF match options.
 // These settings are also relevant when compiling a pattern if
-// [flags@GLib.RegexCompileFlags.EXTENDED] is set and an unescaped
+// RegexCompileFlags.EXTENDED is set and an unescaped
 // `#` outside a character class is encountered. This indicates a comment
 // that lasts until after the next newline.
 //
 // Because `GRegex` does not modify its internal state between creation and
 // destruction, you can create and modify the same `GRegex` instance from
-// different threads. In contrast, [struct@GLib.MatchInfo] is not thread safe.
+// different threads. In contrast, [MatchInfo] is not thread safe.
 //
 // The regular expression low-level functionalities are obtained through
-// the excellent [PCRE](http://www.pcre.org/) library written by Philip Hazel.
+// the excellent [PCRE] library written by Philip Hazel.
+//
+// [PCRE]: http://www.pcre.org/
 type Regex struct {
 	_ structs.HostLayout
 }
@@ -646,13 +627,13 @@ func (x *Regex) GoPointer() uintptr {
 var xNewRegex func(string, RegexCompileFlags, RegexMatchFlags, **Error) *Regex
 
 // Compiles the regular expression to an internal form, and does
-// the initial setup of the #GRegex structure.
+// the initial setup of the [Regex] structure.
 func NewRegex(PatternVar string, CompileOptionsVar RegexCompileFlags, MatchOptionsVar RegexMatchFlags) (*Regex, error) {
 	var cerr *Error
 
 	cret := xNewRegex(PatternVar, CompileOptionsVar, MatchOptionsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -669,7 +650,7 @@ func (x *Regex) GetCaptureCount() int {
 
 var xRegexGetCompileFlags func(uintptr) RegexCompileFlags
 
-// Returns the compile options that @regex was created with.
+// Returns the compile options that regex was created with.
 //
 // Depending on the version of PCRE that is used, this may or may not
 // include flags set by option expressions such as `(?i)` found at the
@@ -691,7 +672,7 @@ func (x *Regex) GetHasCrOrLf() bool {
 
 var xRegexGetMatchFlags func(uintptr) RegexMatchFlags
 
-// Returns the match options that @regex was created with.
+// Returns the match options that regex was created with.
 func (x *Regex) GetMatchFlags() RegexMatchFlags {
 
 	cret := xRegexGetMatchFlags(x.GoPointer())
@@ -722,8 +703,8 @@ func (x *Regex) GetMaxLookbehind() int {
 
 var xRegexGetPattern func(uintptr) string
 
-// Gets the pattern string associated with @regex, i.e. a copy of
-// the string passed to g_regex_new().
+// Gets the pattern string associated with regex, i.e. a copy of
+// the string passed to [NewRegex].
 func (x *Regex) GetPattern() string {
 
 	cret := xRegexGetPattern(x.GoPointer())
@@ -732,7 +713,7 @@ func (x *Regex) GetPattern() string {
 
 var xRegexGetStringNumber func(uintptr, string) int
 
-// Retrieves the number of the subexpression named @name.
+// Retrieves the number of the subexpression named name.
 func (x *Regex) GetStringNumber(NameVar string) int {
 
 	cret := xRegexGetStringNumber(x.GoPointer(), NameVar)
@@ -741,32 +722,30 @@ func (x *Regex) GetStringNumber(NameVar string) int {
 
 var xRegexMatch func(uintptr, string, RegexMatchFlags, **MatchInfo) bool
 
-// Scans for a match in @string for the pattern in @regex.
-// The @match_options are combined with the match options specified
-// when the @regex structure was created, letting you have more
-// flexibility in reusing #GRegex structures.
+// Scans for a match in string for the pattern in regex.
+// The match_options are combined with the match options specified
+// when the regex structure was created, letting you have more
+// flexibility in reusing [Regex] structures.
 //
-// Unless %G_REGEX_RAW is specified in the options, @string must be valid UTF-8.
+// Unless [GRegexRawValue] is specified in the options, string must be valid UTF-8.
 //
-// A #GMatchInfo structure, used to get information on the match,
-// is stored in @match_info if not %NULL. Note that if @match_info
-// is not %NULL then it is created even if the function returns %FALSE,
+// A [MatchInfo] structure, used to get information on the match,
+// is stored in match_info if not nil. Note that if match_info
+// is not nil then it is created even if the function returns false,
 // i.e. you must free it regardless if regular expression actually matched.
 //
 // To retrieve all the non-overlapping matches of the pattern in
-// string you can use g_match_info_next().
-//
-// |[&lt;!-- language="C" --&gt;
-// static void
-// print_uppercase_words (const gchar *string)
+// string you can use [MatchInfo.Next].
 //
+//	static void
+//	print_uppercase_words (const gchar *string)
 //	{
 //	  // Print all uppercase-only words.
 //	  GRegex *regex;
 //	  GMatchInfo *match_info;
 //
 //	  regex = g_regex_new ("[A-Z]+", G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
-//	  g_regex_match (regex, string, 0, &amp;match_info);
+//	  g_regex_match (regex, string, 0, &match_info);
 //	  while (g_match_info_matches (match_info))
 //	    {
 //	      gchar *word = g_match_info_fetch (match_info, 0);
@@ -778,11 +757,9 @@ var xRegexMatch func(uintptr, string, RegexMatchFlags, **MatchInfo) bool
 //	  g_regex_unref (regex);
 //	}
 //
-// ]|
-//
-// @string is not copied and is used in #GMatchInfo internally. If
-// you use any #GMatchInfo method (except g_match_info_free()) after
-// freeing or modifying @string then the behaviour is undefined.
+// string is not copied and is used in [MatchInfo] internally. If
+// you use any [MatchInfo] method (except [MatchInfo.Free]) after
+// freeing or modifying string then the behaviour is undefined.
 func (x *Regex) Match(StringVar string, MatchOptionsVar RegexMatchFlags, MatchInfoVar **MatchInfo) bool {
 
 	cret := xRegexMatch(x.GoPointer(), StringVar, MatchOptionsVar, MatchInfoVar)
@@ -794,17 +771,17 @@ var xRegexMatchAll func(uintptr, string, RegexMatchFlags, **MatchInfo) bool
 // Using the standard algorithm for regular expression matching only
 // the longest match in the string is retrieved. This function uses
 // a different algorithm so it can retrieve all the possible matches.
-// For more documentation see g_regex_match_all_full().
+// For more documentation see [Regex.MatchAllFull].
 //
-// A #GMatchInfo structure, used to get information on the match, is
-// stored in @match_info if not %NULL. Note that if @match_info is
-// not %NULL then it is created even if the function returns %FALSE,
+// A [MatchInfo] structure, used to get information on the match, is
+// stored in match_info if not nil. Note that if match_info is
+// not nil then it is created even if the function returns false,
 // i.e. you must free it regardless if regular expression actually
 // matched.
 //
-// @string is not copied and is used in #GMatchInfo internally. If
-// you use any #GMatchInfo method (except g_match_info_free()) after
-// freeing or modifying @string then the behaviour is undefined.
+// string is not copied and is used in [MatchInfo] internally. If
+// you use any [MatchInfo] method (except [MatchInfo.Free]) after
+// freeing or modifying string then the behaviour is undefined.
 func (x *Regex) MatchAll(StringVar string, MatchOptionsVar RegexMatchFlags, MatchInfoVar **MatchInfo) bool {
 
 	cret := xRegexMatchAll(x.GoPointer(), StringVar, MatchOptionsVar, MatchInfoVar)
@@ -814,49 +791,49 @@ func (x *Regex) MatchAll(StringVar string, MatchOptionsVar RegexMatchFlags, Matc
 var xRegexMatchAllFull func(uintptr, []string, int, int, RegexMatchFlags, **MatchInfo, **Error) bool
 
 // Using the standard algorithm for regular expression matching only
-// the longest match in the @string is retrieved, it is not possible
+// the longest match in the string is retrieved, it is not possible
 // to obtain all the available matches. For instance matching
-// `"&lt;a&gt; &lt;b&gt; &lt;c&gt;"` against the pattern `"&lt;.*&gt;"`
-// you get `"&lt;a&gt; &lt;b&gt; &lt;c&gt;"`.
+// `"<a>  <c>"` against the pattern `"<.*>"`
+// you get `"<a>  <c>"`.
 //
 // This function uses a different algorithm (called DFA, i.e. deterministic
 // finite automaton), so it can retrieve all the possible matches, all
 // starting at the same point in the string. For instance matching
-// `"&lt;a&gt; &lt;b&gt; &lt;c&gt;"` against the pattern `"&lt;.*&gt;"`
-// you would obtain three matches: `"&lt;a&gt; &lt;b&gt; &lt;c&gt;"`,
-// `"&lt;a&gt; &lt;b&gt;"` and `"&lt;a&gt;"`.
+// `"<a>  <c>"` against the pattern `"<.*>"`
+// you would obtain three matches: `"<a>  <c>"`,
+// `"<a> "` and `"<a>"`.
 //
 // The number of matched strings is retrieved using
-// g_match_info_get_match_count(). To obtain the matched strings and
-// their position you can use, respectively, g_match_info_fetch() and
-// g_match_info_fetch_pos(). Note that the strings are returned in
+// [MatchInfo.GetMatchCount]. To obtain the matched strings and
+// their position you can use, respectively, [MatchInfo.Fetch] and
+// [MatchInfo.FetchPos]. Note that the strings are returned in
 // reverse order of length; that is, the longest matching string is
 // given first.
 //
 // Note that the DFA algorithm is slower than the standard one and it
 // is not able to capture substrings, so backreferences do not work.
 //
-// Setting @start_position differs from just passing over a shortened
-// string and setting %G_REGEX_MATCH_NOTBOL in the case of a pattern
+// Setting start_position differs from just passing over a shortened
+// string and setting [GRegexMatchNotbolValue] in the case of a pattern
 // that begins with any kind of lookbehind assertion, such as "\b".
 //
-// Unless %G_REGEX_RAW is specified in the options, @string must be valid UTF-8.
+// Unless [GRegexRawValue] is specified in the options, string must be valid UTF-8.
 //
-// A #GMatchInfo structure, used to get information on the match, is
-// stored in @match_info if not %NULL. Note that if @match_info is
-// not %NULL then it is created even if the function returns %FALSE,
+// A [MatchInfo] structure, used to get information on the match, is
+// stored in match_info if not nil. Note that if match_info is
+// not nil then it is created even if the function returns false,
 // i.e. you must free it regardless if regular expression actually
 // matched.
 //
-// @string is not copied and is used in #GMatchInfo internally. If
-// you use any #GMatchInfo method (except g_match_info_free()) after
-// freeing or modifying @string then the behaviour is undefined.
+// string is not copied and is used in [MatchInfo] internally. If
+// you use any [MatchInfo] method (except [MatchInfo.Free]) after
+// freeing or modifying string then the behaviour is undefined.
 func (x *Regex) MatchAllFull(StringVar []string, StringLenVar int, StartPositionVar int, MatchOptionsVar RegexMatchFlags, MatchInfoVar **MatchInfo) (bool, error) {
 	var cerr *Error
 
 	cret := xRegexMatchAllFull(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, MatchOptionsVar, MatchInfoVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -864,34 +841,32 @@ func (x *Regex) MatchAllFull(StringVar []string, StringLenVar int, StartPosition
 
 var xRegexMatchFull func(uintptr, []string, int, int, RegexMatchFlags, **MatchInfo, **Error) bool
 
-// Scans for a match in @string for the pattern in @regex.
-// The @match_options are combined with the match options specified
-// when the @regex structure was created, letting you have more
-// flexibility in reusing #GRegex structures.
+// Scans for a match in string for the pattern in regex.
+// The match_options are combined with the match options specified
+// when the regex structure was created, letting you have more
+// flexibility in reusing [Regex] structures.
 //
-// Setting @start_position differs from just passing over a shortened
-// string and setting %G_REGEX_MATCH_NOTBOL in the case of a pattern
+// Setting start_position differs from just passing over a shortened
+// string and setting [GRegexMatchNotbolValue] in the case of a pattern
 // that begins with any kind of lookbehind assertion, such as "\b".
 //
-// Unless %G_REGEX_RAW is specified in the options, @string must be valid UTF-8.
+// Unless [GRegexRawValue] is specified in the options, string must be valid UTF-8.
 //
-// A #GMatchInfo structure, used to get information on the match, is
-// stored in @match_info if not %NULL. Note that if @match_info is
-// not %NULL then it is created even if the function returns %FALSE,
+// A [MatchInfo] structure, used to get information on the match, is
+// stored in match_info if not nil. Note that if match_info is
+// not nil then it is created even if the function returns false,
 // i.e. you must free it regardless if regular expression actually
 // matched.
 //
-// @string is not copied and is used in #GMatchInfo internally. If
-// you use any #GMatchInfo method (except g_match_info_free()) after
-// freeing or modifying @string then the behaviour is undefined.
+// string is not copied and is used in [MatchInfo] internally. If
+// you use any [MatchInfo] method (except [MatchInfo.Free]) after
+// freeing or modifying string then the behaviour is undefined.
 //
 // To retrieve all the non-overlapping matches of the pattern in
-// string you can use g_match_info_next().
-//
-// |[&lt;!-- language="C" --&gt;
-// static void
-// print_uppercase_words (const gchar *string)
+// string you can use [MatchInfo.Next].
 //
+//	static void
+//	print_uppercase_words (const gchar *string)
 //	{
 //	  // Print all uppercase-only words.
 //	  GRegex *regex;
@@ -899,30 +874,28 @@ var xRegexMatchFull func(uintptr, []string, int, int, RegexMatchFlags, **MatchIn
 //	  GError *error = NULL;
 //
 //	  regex = g_regex_new ("[A-Z]+", G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
-//	  g_regex_match_full (regex, string, -1, 0, 0, &amp;match_info, &amp;error);
+//	  g_regex_match_full (regex, string, -1, 0, 0, &match_info, &error);
 //	  while (g_match_info_matches (match_info))
 //	    {
 //	      gchar *word = g_match_info_fetch (match_info, 0);
 //	      g_print ("Found: %s\n", word);
 //	      g_free (word);
-//	      g_match_info_next (match_info, &amp;error);
+//	      g_match_info_next (match_info, &error);
 //	    }
 //	  g_match_info_free (match_info);
 //	  g_regex_unref (regex);
 //	  if (error != NULL)
 //	    {
-//	      g_printerr ("Error while matching: %s\n", error-&gt;message);
+//	      g_printerr ("Error while matching: %s\n", error->message);
 //	      g_error_free (error);
 //	    }
 //	}
-//
-// ]|
 func (x *Regex) MatchFull(StringVar []string, StringLenVar int, StartPositionVar int, MatchOptionsVar RegexMatchFlags, MatchInfoVar **MatchInfo) (bool, error) {
 	var cerr *Error
 
 	cret := xRegexMatchFull(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, MatchOptionsVar, MatchInfoVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -930,7 +903,7 @@ func (x *Regex) MatchFull(StringVar []string, StringLenVar int, StartPositionVar
 
 var xRegexRef func(uintptr) *Regex
 
-// Increases reference count of @regex by 1.
+// Increases reference count of regex by 1.
 func (x *Regex) Ref() *Regex {
 
 	cret := xRegexRef(x.GoPointer())
@@ -939,10 +912,10 @@ func (x *Regex) Ref() *Regex {
 
 var xRegexReplace func(uintptr, []string, int, int, string, RegexMatchFlags, **Error) string
 
-// Replaces all occurrences of the pattern in @regex with the
+// Replaces all occurrences of the pattern in regex with the
 // replacement text. Backreferences of the form `\number` or
-// `\g&lt;number&gt;` in the replacement text are interpolated by the
-// number-th captured subexpression of the match, `\g&lt;name&gt;` refers
+// `\g<number>` in the replacement text are interpolated by the
+// number-th captured subexpression of the match, `\g<name>` refers
 // to the captured subexpression with the given name. `\0` refers
 // to the complete match, but `\0` followed by a number is the octal
 // representation of a character. To include a literal `\` in the
@@ -956,21 +929,21 @@ var xRegexReplace func(uintptr, []string, int, int, string, RegexMatchFlags, **E
 // - \U: Convert to upper case till \E
 // - \E: End case modification
 //
-// If you do not need to use backreferences use g_regex_replace_literal().
+// If you do not need to use backreferences use [Regex.ReplaceLiteral].
 //
-// The @replacement string must be UTF-8 encoded even if %G_REGEX_RAW was
-// passed to g_regex_new(). If you want to use not UTF-8 encoded strings
-// you can use g_regex_replace_literal().
+// The replacement string must be UTF-8 encoded even if [GRegexRawValue] was
+// passed to [NewRegex]. If you want to use not UTF-8 encoded strings
+// you can use [Regex.ReplaceLiteral].
 //
-// Setting @start_position differs from just passing over a shortened
-// string and setting %G_REGEX_MATCH_NOTBOL in the case of a pattern that
+// Setting start_position differs from just passing over a shortened
+// string and setting [GRegexMatchNotbolValue] in the case of a pattern that
 // begins with any kind of lookbehind assertion, such as "\b".
 func (x *Regex) Replace(StringVar []string, StringLenVar int, StartPositionVar int, ReplacementVar string, MatchOptionsVar RegexMatchFlags) (string, error) {
 	var cerr *Error
 
 	cret := xRegexReplace(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, ReplacementVar, MatchOptionsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -979,21 +952,19 @@ func (x *Regex) Replace(StringVar []string, StringLenVar int, StartPositionVar i
 var xRegexReplaceEval func(uintptr, []string, int, int, RegexMatchFlags, uintptr, uintptr, **Error) string
 
 // Replaces occurrences of the pattern in regex with the output of
-// @eval for that occurrence.
+// eval for that occurrence.
 //
-// Setting @start_position differs from just passing over a shortened
-// string and setting %G_REGEX_MATCH_NOTBOL in the case of a pattern
+// Setting start_position differs from just passing over a shortened
+// string and setting [GRegexMatchNotbolValue] in the case of a pattern
 // that begins with any kind of lookbehind assertion, such as "\b".
 //
-// The following example uses g_regex_replace_eval() to replace multiple
+// The following example uses [Regex.ReplaceEval] to replace multiple
 // strings at once:
-// |[&lt;!-- language="C" --&gt;
-// static gboolean
-// eval_cb (const GMatchInfo *info,
-//
-//	GString          *res,
-//	gpointer          data)
 //
+//	static gboolean
+//	eval_cb (const GMatchInfo *info,
+//	         GString          *res,
+//	         gpointer          data)
 //	{
 //	  gchar *match;
 //	  gchar *r;
@@ -1006,25 +977,24 @@ var xRegexReplaceEval func(uintptr, []string, int, int, RegexMatchFlags, uintptr
 //	   return FALSE;
 //	}
 //
-// ...
+//	...
 //
-// GRegex *reg;
-// GHashTable *h;
-// gchar *res;
+//	GRegex *reg;
+//	GHashTable *h;
+//	gchar *res;
 //
-// h = g_hash_table_new (g_str_hash, g_str_equal);
+//	h = g_hash_table_new (g_str_hash, g_str_equal);
 //
-// g_hash_table_insert (h, "1", "ONE");
-// g_hash_table_insert (h, "2", "TWO");
-// g_hash_table_insert (h, "3", "THREE");
-// g_hash_table_insert (h, "4", "FOUR");
+//	g_hash_table_insert (h, "1", "ONE");
+//	g_hash_table_insert (h, "2", "TWO");
+//	g_hash_table_insert (h, "3", "THREE");
+//	g_hash_table_insert (h, "4", "FOUR");
 //
-// reg = g_regex_new ("1|2|3|4", G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
-// res = g_regex_replace_eval (reg, text, -1, 0, 0, eval_cb, h, NULL);
-// g_hash_table_destroy (h);
+//	reg = g_regex_new ("1|2|3|4", G_REGEX_DEFAULT, G_REGEX_MATCH_DEFAULT, NULL);
+//	res = g_regex_replace_eval (reg, text, -1, 0, 0, eval_cb, h, NULL);
+//	g_hash_table_destroy (h);
 //
-// ...
-// ]|
+//	...
 func (x *Regex) ReplaceEval(StringVar []string, StringLenVar int, StartPositionVar int, MatchOptionsVar RegexMatchFlags, EvalVar *RegexEvalCallback, UserDataVar uintptr) (string, error) {
 	var cerr *Error
 
@@ -1035,17 +1005,18 @@ func (x *Regex) ReplaceEval(StringVar []string, StringLenVar int, StartPositionV
 			EvalVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *MatchInfo, arg1 *String, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *EvalVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			EvalVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(EvalVarPtr, EvalVarRef, EvalVar)
+			EvalVarRef = core.NewCallback(fcb)
+			EvalVarRef = SaveCallbackWithClosure(EvalVarPtr, EvalVarRef, EvalVar)
 		}
 	}
 
 	cret := xRegexReplaceEval(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, MatchOptionsVar, EvalVarRef, UserDataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1053,12 +1024,12 @@ func (x *Regex) ReplaceEval(StringVar []string, StringLenVar int, StartPositionV
 
 var xRegexReplaceLiteral func(uintptr, []string, int, int, string, RegexMatchFlags, **Error) string
 
-// Replaces all occurrences of the pattern in @regex with the
-// replacement text. @replacement is replaced literally, to
-// include backreferences use g_regex_replace().
+// Replaces all occurrences of the pattern in regex with the
+// replacement text. replacement is replaced literally, to
+// include backreferences use [Regex.Replace].
 //
-// Setting @start_position differs from just passing over a
-// shortened string and setting %G_REGEX_MATCH_NOTBOL in the
+// Setting start_position differs from just passing over a
+// shortened string and setting [GRegexMatchNotbolValue] in the
 // case of a pattern that begins with any kind of lookbehind
 // assertion, such as "\b".
 func (x *Regex) ReplaceLiteral(StringVar []string, StringLenVar int, StartPositionVar int, ReplacementVar string, MatchOptionsVar RegexMatchFlags) (string, error) {
@@ -1066,13 +1037,13 @@ func (x *Regex) ReplaceLiteral(StringVar []string, StringLenVar int, StartPositi
 
 	cret := xRegexReplaceLiteral(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, ReplacementVar, MatchOptionsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xRegexSplit func(uintptr, string, RegexMatchFlags) []string
+var xRegexSplit func(uintptr, string, RegexMatchFlags) uintptr
 
 // Breaks the string on the pattern, and returns an array of the tokens.
 // If the pattern contains capturing parentheses, then the text for each
@@ -1087,17 +1058,17 @@ var xRegexSplit func(uintptr, string, RegexMatchFlags) []string
 // you do need to represent empty elements, you'll need to check for the
 // empty string before calling this function.
 //
-// A pattern that can match empty strings splits @string into separate
+// A pattern that can match empty strings splits string into separate
 // characters wherever it matches the empty string between characters.
 // For example splitting "ab c" using as a separator "\s*", you will get
 // "a", "b" and "c".
 func (x *Regex) Split(StringVar string, MatchOptionsVar RegexMatchFlags) []string {
 
 	cret := xRegexSplit(x.GoPointer(), StringVar, MatchOptionsVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xRegexSplitFull func(uintptr, []string, int, int, RegexMatchFlags, int, **Error) []string
+var xRegexSplitFull func(uintptr, []string, int, int, RegexMatchFlags, int, **Error) uintptr
 
 // Breaks the string on the pattern, and returns an array of the tokens.
 // If the pattern contains capturing parentheses, then the text for each
@@ -1112,28 +1083,28 @@ var xRegexSplitFull func(uintptr, []string, int, int, RegexMatchFlags, int, **Er
 // you do need to represent empty elements, you'll need to check for the
 // empty string before calling this function.
 //
-// A pattern that can match empty strings splits @string into separate
+// A pattern that can match empty strings splits string into separate
 // characters wherever it matches the empty string between characters.
 // For example splitting "ab c" using as a separator "\s*", you will get
 // "a", "b" and "c".
 //
-// Setting @start_position differs from just passing over a shortened
-// string and setting %G_REGEX_MATCH_NOTBOL in the case of a pattern
+// Setting start_position differs from just passing over a shortened
+// string and setting [GRegexMatchNotbolValue] in the case of a pattern
 // that begins with any kind of lookbehind assertion, such as "\b".
 func (x *Regex) SplitFull(StringVar []string, StringLenVar int, StartPositionVar int, MatchOptionsVar RegexMatchFlags, MaxTokensVar int) ([]string, error) {
 	var cerr *Error
 
 	cret := xRegexSplitFull(x.GoPointer(), StringVar, StringLenVar, StartPositionVar, MatchOptionsVar, MaxTokensVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return core.GoStringSliceFull(cret), core.PlatformError
 	}
-	return cret, cerr
+	return core.GoStringSliceFull(cret), cerr
 
 }
 
 var xRegexUnref func(uintptr)
 
-// Decreases reference count of @regex by 1. When reference count drops
+// Decreases reference count of regex by 1. When reference count drops
 // to zero, it frees all the memory associated with the regex structure.
 func (x *Regex) Unref() {
 
@@ -1142,7 +1113,7 @@ func (x *Regex) Unref() {
 }
 
 // Flags specifying compile-time options.
-type RegexCompileFlags int
+type RegexCompileFlags uint32
 
 const (
 
@@ -1157,8 +1128,8 @@ const (
 	//     newlines). The "start of line" metacharacter ("^") matches only
 	//     at the start of the string, while the "end of line" metacharacter
 	//     ("$") matches only at the end of the string, or before a terminating
-	//     newline (unless %G_REGEX_DOLLAR_ENDONLY is set). When
-	//     %G_REGEX_MULTILINE is set, the "start of line" and "end of line"
+	//     newline (unless [GRegexDollarEndonlyValue] is set). When
+	//     [GRegexMultilineValue] is set, the "start of line" and "end of line"
 	//     constructs match immediately following or immediately before any
 	//     newline in the string, respectively, as well as at the very start
 	//     and end. This can be changed within a pattern by a "(?m)" option
@@ -1185,7 +1156,7 @@ const (
 	//     matches only at the end of the string. Without this option, a
 	//     dollar also matches immediately before the final character if
 	//     it is a newline (but not before any other newlines). This option
-	//     is ignored if %G_REGEX_MULTILINE is set.
+	//     is ignored if [GRegexMultilineValue] is set.
 	GRegexDollarEndonlyValue RegexCompileFlags = 32
 	// Inverts the "greediness" of the quantifiers so that
 	//     they are not greedy by default, but become greedy if followed by "?".
@@ -1242,8 +1213,108 @@ const (
 	GRegexJavascriptCompatValue RegexCompileFlags = 33554432
 )
 
+// Has reports whether all bits of flags are set in x
+func (x RegexCompileFlags) Has(flags RegexCompileFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x RegexCompileFlags) Set(flags RegexCompileFlags) RegexCompileFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x RegexCompileFlags) Clear(flags RegexCompileFlags) RegexCompileFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x RegexCompileFlags) String() string {
+	if x == 0 {
+		return "G_REGEX_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GRegexCaselessValue != 0 && x&GRegexCaselessValue == GRegexCaselessValue {
+		names = append(names, "G_REGEX_CASELESS")
+		rest &^= GRegexCaselessValue
+	}
+	if rest&GRegexMultilineValue != 0 && x&GRegexMultilineValue == GRegexMultilineValue {
+		names = append(names, "G_REGEX_MULTILINE")
+		rest &^= GRegexMultilineValue
+	}
+	if rest&GRegexDotallValue != 0 && x&GRegexDotallValue == GRegexDotallValue {
+		names = append(names, "G_REGEX_DOTALL")
+		rest &^= GRegexDotallValue
+	}
+	if rest&GRegexExtendedValue != 0 && x&GRegexExtendedValue == GRegexExtendedValue {
+		names = append(names, "G_REGEX_EXTENDED")
+		rest &^= GRegexExtendedValue
+	}
+	if rest&GRegexAnchoredValue != 0 && x&GRegexAnchoredValue == GRegexAnchoredValue {
+		names = append(names, "G_REGEX_ANCHORED")
+		rest &^= GRegexAnchoredValue
+	}
+	if rest&GRegexDollarEndonlyValue != 0 && x&GRegexDollarEndonlyValue == GRegexDollarEndonlyValue {
+		names = append(names, "G_REGEX_DOLLAR_ENDONLY")
+		rest &^= GRegexDollarEndonlyValue
+	}
+	if rest&GRegexUngreedyValue != 0 && x&GRegexUngreedyValue == GRegexUngreedyValue {
+		names = append(names, "G_REGEX_UNGREEDY")
+		rest &^= GRegexUngreedyValue
+	}
+	if rest&GRegexRawValue != 0 && x&GRegexRawValue == GRegexRawValue {
+		names = append(names, "G_REGEX_RAW")
+		rest &^= GRegexRawValue
+	}
+	if rest&GRegexNoAutoCaptureValue != 0 && x&GRegexNoAutoCaptureValue == GRegexNoAutoCaptureValue {
+		names = append(names, "G_REGEX_NO_AUTO_CAPTURE")
+		rest &^= GRegexNoAutoCaptureValue
+	}
+	if rest&GRegexOptimizeValue != 0 && x&GRegexOptimizeValue == GRegexOptimizeValue {
+		names = append(names, "G_REGEX_OPTIMIZE")
+		rest &^= GRegexOptimizeValue
+	}
+	if rest&GRegexFirstlineValue != 0 && x&GRegexFirstlineValue == GRegexFirstlineValue {
+		names = append(names, "G_REGEX_FIRSTLINE")
+		rest &^= GRegexFirstlineValue
+	}
+	if rest&GRegexDupnamesValue != 0 && x&GRegexDupnamesValue == GRegexDupnamesValue {
+		names = append(names, "G_REGEX_DUPNAMES")
+		rest &^= GRegexDupnamesValue
+	}
+	if rest&GRegexNewlineCrValue != 0 && x&GRegexNewlineCrValue == GRegexNewlineCrValue {
+		names = append(names, "G_REGEX_NEWLINE_CR")
+		rest &^= GRegexNewlineCrValue
+	}
+	if rest&GRegexNewlineLfValue != 0 && x&GRegexNewlineLfValue == GRegexNewlineLfValue {
+		names = append(names, "G_REGEX_NEWLINE_LF")
+		rest &^= GRegexNewlineLfValue
+	}
+	if rest&GRegexNewlineCrlfValue != 0 && x&GRegexNewlineCrlfValue == GRegexNewlineCrlfValue {
+		names = append(names, "G_REGEX_NEWLINE_CRLF")
+		rest &^= GRegexNewlineCrlfValue
+	}
+	if rest&GRegexNewlineAnycrlfValue != 0 && x&GRegexNewlineAnycrlfValue == GRegexNewlineAnycrlfValue {
+		names = append(names, "G_REGEX_NEWLINE_ANYCRLF")
+		rest &^= GRegexNewlineAnycrlfValue
+	}
+	if rest&GRegexBsrAnycrlfValue != 0 && x&GRegexBsrAnycrlfValue == GRegexBsrAnycrlfValue {
+		names = append(names, "G_REGEX_BSR_ANYCRLF")
+		rest &^= GRegexBsrAnycrlfValue
+	}
+	if rest&GRegexJavascriptCompatValue != 0 && x&GRegexJavascriptCompatValue == GRegexJavascriptCompatValue {
+		names = append(names, "G_REGEX_JAVASCRIPT_COMPAT")
+		rest &^= GRegexJavascriptCompatValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags specifying match-time options.
-type RegexMatchFlags int
+type RegexMatchFlags uint32
 
 const (
 
@@ -1257,7 +1328,7 @@ const (
 	GRegexMatchAnchoredValue RegexMatchFlags = 16
 	// Specifies that first character of the string is
 	//     not the beginning of a line, so the circumflex metacharacter should
-	//     not match before it. Setting this without %G_REGEX_MULTILINE (at
+	//     not match before it. Setting this without [GRegexMultilineValue] (at
 	//     compile time) causes circumflex never to match. This option affects
 	//     only the behaviour of the circumflex metacharacter, it does not
 	//     affect "\A".
@@ -1265,7 +1336,7 @@ const (
 	// Specifies that the end of the subject string is
 	//     not the end of a line, so the dollar metacharacter should not match
 	//     it nor (except in multiline mode) a newline immediately before it.
-	//     Setting this without %G_REGEX_MULTILINE (at compile time) causes
+	//     Setting this without [GRegexMultilineValue] (at compile time) causes
 	//     dollar never to match. This option affects only the behaviour of
 	//     the dollar metacharacter, it does not affect "\Z" or "\z".
 	GRegexMatchNoteolValue RegexMatchFlags = 256
@@ -1279,52 +1350,140 @@ const (
 	//     of "a" or "b".
 	GRegexMatchNotemptyValue RegexMatchFlags = 1024
 	// Turns on the partial matching feature, for more
-	//     documentation on partial matching see g_match_info_is_partial_match().
+	//     documentation on partial matching see [MatchInfo.IsPartialMatch].
 	GRegexMatchPartialValue RegexMatchFlags = 32768
 	// Overrides the newline definition set when
-	//     creating a new #GRegex, setting the '\r' character as line terminator.
+	//     creating a new [Regex], setting the '\r' character as line terminator.
 	GRegexMatchNewlineCrValue RegexMatchFlags = 1048576
 	// Overrides the newline definition set when
-	//     creating a new #GRegex, setting the '\n' character as line terminator.
+	//     creating a new [Regex], setting the '\n' character as line terminator.
 	GRegexMatchNewlineLfValue RegexMatchFlags = 2097152
 	// Overrides the newline definition set when
-	//     creating a new #GRegex, setting the '\r\n' characters sequence as line terminator.
+	//     creating a new [Regex], setting the '\r\n' characters sequence as line terminator.
 	GRegexMatchNewlineCrlfValue RegexMatchFlags = 3145728
 	// Overrides the newline definition set when
-	//     creating a new #GRegex, any Unicode newline sequence
+	//     creating a new [Regex], any Unicode newline sequence
 	//     is recognised as a newline. These are '\r', '\n' and '\rn', and the
 	//     single characters U+000B LINE TABULATION, U+000C FORM FEED (FF),
 	//     U+0085 NEXT LINE (NEL), U+2028 LINE SEPARATOR and
 	//     U+2029 PARAGRAPH SEPARATOR.
 	GRegexMatchNewlineAnyValue RegexMatchFlags = 4194304
 	// Overrides the newline definition set when
-	//     creating a new #GRegex; any '\r', '\n', or '\r\n' character sequence
+	//     creating a new [Regex]; any '\r', '\n', or '\r\n' character sequence
 	//     is recognized as a newline. Since: 2.34
 	GRegexMatchNewlineAnycrlfValue RegexMatchFlags = 5242880
 	// Overrides the newline definition for "\R" set when
-	//     creating a new #GRegex; only '\r', '\n', or '\r\n' character sequences
+	//     creating a new [Regex]; only '\r', '\n', or '\r\n' character sequences
 	//     are recognized as a newline by "\R". Since: 2.34
 	GRegexMatchBsrAnycrlfValue RegexMatchFlags = 8388608
 	// Overrides the newline definition for "\R" set when
-	//     creating a new #GRegex; any Unicode newline character or character sequence
+	//     creating a new [Regex]; any Unicode newline character or character sequence
 	//     are recognized as a newline by "\R". These are '\r', '\n' and '\rn', and the
 	//     single characters U+000B LINE TABULATION, U+000C FORM FEED (FF),
 	//     U+0085 NEXT LINE (NEL), U+2028 LINE SEPARATOR and
 	//     U+2029 PARAGRAPH SEPARATOR. Since: 2.34
 	GRegexMatchBsrAnyValue RegexMatchFlags = 16777216
-	// An alias for %G_REGEX_MATCH_PARTIAL. Since: 2.34
+	// An alias for [GRegexMatchPartialValue]. Since: 2.34
 	GRegexMatchPartialSoftValue RegexMatchFlags = 32768
 	// Turns on the partial matching feature. In contrast to
-	//     to %G_REGEX_MATCH_PARTIAL_SOFT, this stops matching as soon as a partial match
+	//     to [GRegexMatchPartialSoftValue], this stops matching as soon as a partial match
 	//     is found, without continuing to search for a possible complete match. See
-	//     g_match_info_is_partial_match() for more information. Since: 2.34
+	//     [MatchInfo.IsPartialMatch] for more information. Since: 2.34
 	GRegexMatchPartialHardValue RegexMatchFlags = 134217728
-	// Like %G_REGEX_MATCH_NOTEMPTY, but only applied to
+	// Like [GRegexMatchNotemptyValue], but only applied to
 	//     the start of the matched string. For anchored
 	//     patterns this can only happen for pattern containing "\K". Since: 2.34
 	GRegexMatchNotemptyAtstartValue RegexMatchFlags = 268435456
 )
 
+// Has reports whether all bits of flags are set in x
+func (x RegexMatchFlags) Has(flags RegexMatchFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x RegexMatchFlags) Set(flags RegexMatchFlags) RegexMatchFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x RegexMatchFlags) Clear(flags RegexMatchFlags) RegexMatchFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x RegexMatchFlags) String() string {
+	if x == 0 {
+		return "G_REGEX_MATCH_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GRegexMatchAnchoredValue != 0 && x&GRegexMatchAnchoredValue == GRegexMatchAnchoredValue {
+		names = append(names, "G_REGEX_MATCH_ANCHORED")
+		rest &^= GRegexMatchAnchoredValue
+	}
+	if rest&GRegexMatchNotbolValue != 0 && x&GRegexMatchNotbolValue == GRegexMatchNotbolValue {
+		names = append(names, "G_REGEX_MATCH_NOTBOL")
+		rest &^= GRegexMatchNotbolValue
+	}
+	if rest&GRegexMatchNoteolValue != 0 && x&GRegexMatchNoteolValue == GRegexMatchNoteolValue {
+		names = append(names, "G_REGEX_MATCH_NOTEOL")
+		rest &^= GRegexMatchNoteolValue
+	}
+	if rest&GRegexMatchNotemptyValue != 0 && x&GRegexMatchNotemptyValue == GRegexMatchNotemptyValue {
+		names = append(names, "G_REGEX_MATCH_NOTEMPTY")
+		rest &^= GRegexMatchNotemptyValue
+	}
+	if rest&GRegexMatchPartialValue != 0 && x&GRegexMatchPartialValue == GRegexMatchPartialValue {
+		names = append(names, "G_REGEX_MATCH_PARTIAL")
+		rest &^= GRegexMatchPartialValue
+	}
+	if rest&GRegexMatchNewlineCrValue != 0 && x&GRegexMatchNewlineCrValue == GRegexMatchNewlineCrValue {
+		names = append(names, "G_REGEX_MATCH_NEWLINE_CR")
+		rest &^= GRegexMatchNewlineCrValue
+	}
+	if rest&GRegexMatchNewlineLfValue != 0 && x&GRegexMatchNewlineLfValue == GRegexMatchNewlineLfValue {
+		names = append(names, "G_REGEX_MATCH_NEWLINE_LF")
+		rest &^= GRegexMatchNewlineLfValue
+	}
+	if rest&GRegexMatchNewlineCrlfValue != 0 && x&GRegexMatchNewlineCrlfValue == GRegexMatchNewlineCrlfValue {
+		names = append(names, "G_REGEX_MATCH_NEWLINE_CRLF")
+		rest &^= GRegexMatchNewlineCrlfValue
+	}
+	if rest&GRegexMatchNewlineAnyValue != 0 && x&GRegexMatchNewlineAnyValue == GRegexMatchNewlineAnyValue {
+		names = append(names, "G_REGEX_MATCH_NEWLINE_ANY")
+		rest &^= GRegexMatchNewlineAnyValue
+	}
+	if rest&GRegexMatchNewlineAnycrlfValue != 0 && x&GRegexMatchNewlineAnycrlfValue == GRegexMatchNewlineAnycrlfValue {
+		names = append(names, "G_REGEX_MATCH_NEWLINE_ANYCRLF")
+		rest &^= GRegexMatchNewlineAnycrlfValue
+	}
+	if rest&GRegexMatchBsrAnycrlfValue != 0 && x&GRegexMatchBsrAnycrlfValue == GRegexMatchBsrAnycrlfValue {
+		names = append(names, "G_REGEX_MATCH_BSR_ANYCRLF")
+		rest &^= GRegexMatchBsrAnycrlfValue
+	}
+	if rest&GRegexMatchBsrAnyValue != 0 && x&GRegexMatchBsrAnyValue == GRegexMatchBsrAnyValue {
+		names = append(names, "G_REGEX_MATCH_BSR_ANY")
+		rest &^= GRegexMatchBsrAnyValue
+	}
+	if rest&GRegexMatchPartialSoftValue != 0 && x&GRegexMatchPartialSoftValue == GRegexMatchPartialSoftValue {
+		names = append(names, "G_REGEX_MATCH_PARTIAL_SOFT")
+		rest &^= GRegexMatchPartialSoftValue
+	}
+	if rest&GRegexMatchPartialHardValue != 0 && x&GRegexMatchPartialHardValue == GRegexMatchPartialHardValue {
+		names = append(names, "G_REGEX_MATCH_PARTIAL_HARD")
+		rest &^= GRegexMatchPartialHardValue
+	}
+	if rest&GRegexMatchNotemptyAtstartValue != 0 && x&GRegexMatchNotemptyAtstartValue == GRegexMatchNotemptyAtstartValue {
+		names = append(names, "G_REGEX_MATCH_NOTEMPTY_ATSTART")
+		rest &^= GRegexMatchNotemptyAtstartValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Error codes returned by regular expressions functions.
 type RegexError int
 
@@ -1367,7 +1526,7 @@ const (
 	// Nothing to repeat. Since 2.16
 	GRegexErrorNothingToRepeatValue RegexError = 109
 	// Unrecognized character after "(?",
-	//     "(?&lt;" or "(?P". Since 2.16
+	//     "(?<" or "(?P". Since 2.16
 	GRegexErrorUnrecognizedCharacterValue RegexError = 112
 	// POSIX named classes are
 	//     supported only within a class. Since 2.16
@@ -1489,24 +1648,513 @@ const (
 	GRegexErrorCharacterValueTooLargeValue RegexError = 176
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (RegexError) ErrorDomain() string {
+	return "g-regex-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x RegexError) Error() string {
+	switch x {
+	case GRegexErrorCompileValue:
+		return "G_REGEX_ERROR_COMPILE"
+	case GRegexErrorOptimizeValue:
+		return "G_REGEX_ERROR_OPTIMIZE"
+	case GRegexErrorReplaceValue:
+		return "G_REGEX_ERROR_REPLACE"
+	case GRegexErrorMatchValue:
+		return "G_REGEX_ERROR_MATCH"
+	case GRegexErrorInternalValue:
+		return "G_REGEX_ERROR_INTERNAL"
+	case GRegexErrorStrayBackslashValue:
+		return "G_REGEX_ERROR_STRAY_BACKSLASH"
+	case GRegexErrorMissingControlCharValue:
+		return "G_REGEX_ERROR_MISSING_CONTROL_CHAR"
+	case GRegexErrorUnrecognizedEscapeValue:
+		return "G_REGEX_ERROR_UNRECOGNIZED_ESCAPE"
+	case GRegexErrorQuantifiersOutOfOrderValue:
+		return "G_REGEX_ERROR_QUANTIFIERS_OUT_OF_ORDER"
+	case GRegexErrorQuantifierTooBigValue:
+		return "G_REGEX_ERROR_QUANTIFIER_TOO_BIG"
+	case GRegexErrorUnterminatedCharacterClassValue:
+		return "G_REGEX_ERROR_UNTERMINATED_CHARACTER_CLASS"
+	case GRegexErrorInvalidEscapeInCharacterClassValue:
+		return "G_REGEX_ERROR_INVALID_ESCAPE_IN_CHARACTER_CLASS"
+	case GRegexErrorRangeOutOfOrderValue:
+		return "G_REGEX_ERROR_RANGE_OUT_OF_ORDER"
+	case GRegexErrorNothingToRepeatValue:
+		return "G_REGEX_ERROR_NOTHING_TO_REPEAT"
+	case GRegexErrorUnrecognizedCharacterValue:
+		return "G_REGEX_ERROR_UNRECOGNIZED_CHARACTER"
+	case GRegexErrorPosixNamedClassOutsideClassValue:
+		return "G_REGEX_ERROR_POSIX_NAMED_CLASS_OUTSIDE_CLASS"
+	case GRegexErrorUnmatchedParenthesisValue:
+		return "G_REGEX_ERROR_UNMATCHED_PARENTHESIS"
+	case GRegexErrorInexistentSubpatternReferenceValue:
+		return "G_REGEX_ERROR_INEXISTENT_SUBPATTERN_REFERENCE"
+	case GRegexErrorUnterminatedCommentValue:
+		return "G_REGEX_ERROR_UNTERMINATED_COMMENT"
+	case GRegexErrorExpressionTooLargeValue:
+		return "G_REGEX_ERROR_EXPRESSION_TOO_LARGE"
+	case GRegexErrorMemoryErrorValue:
+		return "G_REGEX_ERROR_MEMORY_ERROR"
+	case GRegexErrorVariableLengthLookbehindValue:
+		return "G_REGEX_ERROR_VARIABLE_LENGTH_LOOKBEHIND"
+	case GRegexErrorMalformedConditionValue:
+		return "G_REGEX_ERROR_MALFORMED_CONDITION"
+	case GRegexErrorTooManyConditionalBranchesValue:
+		return "G_REGEX_ERROR_TOO_MANY_CONDITIONAL_BRANCHES"
+	case GRegexErrorAssertionExpectedValue:
+		return "G_REGEX_ERROR_ASSERTION_EXPECTED"
+	case GRegexErrorUnknownPosixClassNameValue:
+		return "G_REGEX_ERROR_UNKNOWN_POSIX_CLASS_NAME"
+	case GRegexErrorPosixCollatingElementsNotSupportedValue:
+		return "G_REGEX_ERROR_POSIX_COLLATING_ELEMENTS_NOT_SUPPORTED"
+	case GRegexErrorHexCodeTooLargeValue:
+		return "G_REGEX_ERROR_HEX_CODE_TOO_LARGE"
+	case GRegexErrorInvalidConditionValue:
+		return "G_REGEX_ERROR_INVALID_CONDITION"
+	case GRegexErrorSingleByteMatchInLookbehindValue:
+		return "G_REGEX_ERROR_SINGLE_BYTE_MATCH_IN_LOOKBEHIND"
+	case GRegexErrorInfiniteLoopValue:
+		return "G_REGEX_ERROR_INFINITE_LOOP"
+	case GRegexErrorMissingSubpatternNameTerminatorValue:
+		return "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME_TERMINATOR"
+	case GRegexErrorDuplicateSubpatternNameValue:
+		return "G_REGEX_ERROR_DUPLICATE_SUBPATTERN_NAME"
+	case GRegexErrorMalformedPropertyValue:
+		return "G_REGEX_ERROR_MALFORMED_PROPERTY"
+	case GRegexErrorUnknownPropertyValue:
+		return "G_REGEX_ERROR_UNKNOWN_PROPERTY"
+	case GRegexErrorSubpatternNameTooLongValue:
+		return "G_REGEX_ERROR_SUBPATTERN_NAME_TOO_LONG"
+	case GRegexErrorTooManySubpatternsValue:
+		return "G_REGEX_ERROR_TOO_MANY_SUBPATTERNS"
+	case GRegexErrorInvalidOctalValueValue:
+		return "G_REGEX_ERROR_INVALID_OCTAL_VALUE"
+	case GRegexErrorTooManyBranchesInDefineValue:
+		return "G_REGEX_ERROR_TOO_MANY_BRANCHES_IN_DEFINE"
+	case GRegexErrorDefineRepetionValue:
+		return "G_REGEX_ERROR_DEFINE_REPETION"
+	case GRegexErrorInconsistentNewlineOptionsValue:
+		return "G_REGEX_ERROR_INCONSISTENT_NEWLINE_OPTIONS"
+	case GRegexErrorMissingBackReferenceValue:
+		return "G_REGEX_ERROR_MISSING_BACK_REFERENCE"
+	case GRegexErrorInvalidRelativeReferenceValue:
+		return "G_REGEX_ERROR_INVALID_RELATIVE_REFERENCE"
+	case GRegexErrorBacktrackingControlVerbArgumentForbiddenValue:
+		return "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_FORBIDDEN"
+	case GRegexErrorUnknownBacktrackingControlVerbValue:
+		return "G_REGEX_ERROR_UNKNOWN_BACKTRACKING_CONTROL_VERB"
+	case GRegexErrorNumberTooBigValue:
+		return "G_REGEX_ERROR_NUMBER_TOO_BIG"
+	case GRegexErrorMissingSubpatternNameValue:
+		return "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME"
+	case GRegexErrorMissingDigitValue:
+		return "G_REGEX_ERROR_MISSING_DIGIT"
+	case GRegexErrorInvalidDataCharacterValue:
+		return "G_REGEX_ERROR_INVALID_DATA_CHARACTER"
+	case GRegexErrorExtraSubpatternNameValue:
+		return "G_REGEX_ERROR_EXTRA_SUBPATTERN_NAME"
+	case GRegexErrorBacktrackingControlVerbArgumentRequiredValue:
+		return "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_REQUIRED"
+	case GRegexErrorInvalidControlCharValue:
+		return "G_REGEX_ERROR_INVALID_CONTROL_CHAR"
+	case GRegexErrorMissingNameValue:
+		return "G_REGEX_ERROR_MISSING_NAME"
+	case GRegexErrorNotSupportedInClassValue:
+		return "G_REGEX_ERROR_NOT_SUPPORTED_IN_CLASS"
+	case GRegexErrorTooManyForwardReferencesValue:
+		return "G_REGEX_ERROR_TOO_MANY_FORWARD_REFERENCES"
+	case GRegexErrorNameTooLongValue:
+		return "G_REGEX_ERROR_NAME_TOO_LONG"
+	case GRegexErrorCharacterValueTooLargeValue:
+		return "G_REGEX_ERROR_CHARACTER_VALUE_TOO_LARGE"
+	}
+	return fmt.Sprintf("g-regex-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x RegexError) String() string {
+	switch x {
+	case GRegexErrorCompileValue:
+		return "G_REGEX_ERROR_COMPILE"
+	case GRegexErrorOptimizeValue:
+		return "G_REGEX_ERROR_OPTIMIZE"
+	case GRegexErrorReplaceValue:
+		return "G_REGEX_ERROR_REPLACE"
+	case GRegexErrorMatchValue:
+		return "G_REGEX_ERROR_MATCH"
+	case GRegexErrorInternalValue:
+		return "G_REGEX_ERROR_INTERNAL"
+	case GRegexErrorStrayBackslashValue:
+		return "G_REGEX_ERROR_STRAY_BACKSLASH"
+	case GRegexErrorMissingControlCharValue:
+		return "G_REGEX_ERROR_MISSING_CONTROL_CHAR"
+	case GRegexErrorUnrecognizedEscapeValue:
+		return "G_REGEX_ERROR_UNRECOGNIZED_ESCAPE"
+	case GRegexErrorQuantifiersOutOfOrderValue:
+		return "G_REGEX_ERROR_QUANTIFIERS_OUT_OF_ORDER"
+	case GRegexErrorQuantifierTooBigValue:
+		return "G_REGEX_ERROR_QUANTIFIER_TOO_BIG"
+	case GRegexErrorUnterminatedCharacterClassValue:
+		return "G_REGEX_ERROR_UNTERMINATED_CHARACTER_CLASS"
+	case GRegexErrorInvalidEscapeInCharacterClassValue:
+		return "G_REGEX_ERROR_INVALID_ESCAPE_IN_CHARACTER_CLASS"
+	case GRegexErrorRangeOutOfOrderValue:
+		return "G_REGEX_ERROR_RANGE_OUT_OF_ORDER"
+	case GRegexErrorNothingToRepeatValue:
+		return "G_REGEX_ERROR_NOTHING_TO_REPEAT"
+	case GRegexErrorUnrecognizedCharacterValue:
+		return "G_REGEX_ERROR_UNRECOGNIZED_CHARACTER"
+	case GRegexErrorPosixNamedClassOutsideClassValue:
+		return "G_REGEX_ERROR_POSIX_NAMED_CLASS_OUTSIDE_CLASS"
+	case GRegexErrorUnmatchedParenthesisValue:
+		return "G_REGEX_ERROR_UNMATCHED_PARENTHESIS"
+	case GRegexErrorInexistentSubpatternReferenceValue:
+		return "G_REGEX_ERROR_INEXISTENT_SUBPATTERN_REFERENCE"
+	case GRegexErrorUnterminatedCommentValue:
+		return "G_REGEX_ERROR_UNTERMINATED_COMMENT"
+	case GRegexErrorExpressionTooLargeValue:
+		return "G_REGEX_ERROR_EXPRESSION_TOO_LARGE"
+	case GRegexErrorMemoryErrorValue:
+		return "G_REGEX_ERROR_MEMORY_ERROR"
+	case GRegexErrorVariableLengthLookbehindValue:
+		return "G_REGEX_ERROR_VARIABLE_LENGTH_LOOKBEHIND"
+	case GRegexErrorMalformedConditionValue:
+		return "G_REGEX_ERROR_MALFORMED_CONDITION"
+	case GRegexErrorTooManyConditionalBranchesValue:
+		return "G_REGEX_ERROR_TOO_MANY_CONDITIONAL_BRANCHES"
+	case GRegexErrorAssertionExpectedValue:
+		return "G_REGEX_ERROR_ASSERTION_EXPECTED"
+	case GRegexErrorUnknownPosixClassNameValue:
+		return "G_REGEX_ERROR_UNKNOWN_POSIX_CLASS_NAME"
+	case GRegexErrorPosixCollatingElementsNotSupportedValue:
+		return "G_REGEX_ERROR_POSIX_COLLATING_ELEMENTS_NOT_SUPPORTED"
+	case GRegexErrorHexCodeTooLargeValue:
+		return "G_REGEX_ERROR_HEX_CODE_TOO_LARGE"
+	case GRegexErrorInvalidConditionValue:
+		return "G_REGEX_ERROR_INVALID_CONDITION"
+	case GRegexErrorSingleByteMatchInLookbehindValue:
+		return "G_REGEX_ERROR_SINGLE_BYTE_MATCH_IN_LOOKBEHIND"
+	case GRegexErrorInfiniteLoopValue:
+		return "G_REGEX_ERROR_INFINITE_LOOP"
+	case GRegexErrorMissingSubpatternNameTerminatorValue:
+		return "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME_TERMINATOR"
+	case GRegexErrorDuplicateSubpatternNameValue:
+		return "G_REGEX_ERROR_DUPLICATE_SUBPATTERN_NAME"
+	case GRegexErrorMalformedPropertyValue:
+		return "G_REGEX_ERROR_MALFORMED_PROPERTY"
+	case GRegexErrorUnknownPropertyValue:
+		return "G_REGEX_ERROR_UNKNOWN_PROPERTY"
+	case GRegexErrorSubpatternNameTooLongValue:
+		return "G_REGEX_ERROR_SUBPATTERN_NAME_TOO_LONG"
+	case GRegexErrorTooManySubpatternsValue:
+		return "G_REGEX_ERROR_TOO_MANY_SUBPATTERNS"
+	case GRegexErrorInvalidOctalValueValue:
+		return "G_REGEX_ERROR_INVALID_OCTAL_VALUE"
+	case GRegexErrorTooManyBranchesInDefineValue:
+		return "G_REGEX_ERROR_TOO_MANY_BRANCHES_IN_DEFINE"
+	case GRegexErrorDefineRepetionValue:
+		return "G_REGEX_ERROR_DEFINE_REPETION"
+	case GRegexErrorInconsistentNewlineOptionsValue:
+		return "G_REGEX_ERROR_INCONSISTENT_NEWLINE_OPTIONS"
+	case GRegexErrorMissingBackReferenceValue:
+		return "G_REGEX_ERROR_MISSING_BACK_REFERENCE"
+	case GRegexErrorInvalidRelativeReferenceValue:
+		return "G_REGEX_ERROR_INVALID_RELATIVE_REFERENCE"
+	case GRegexErrorBacktrackingControlVerbArgumentForbiddenValue:
+		return "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_FORBIDDEN"
+	case GRegexErrorUnknownBacktrackingControlVerbValue:
+		return "G_REGEX_ERROR_UNKNOWN_BACKTRACKING_CONTROL_VERB"
+	case GRegexErrorNumberTooBigValue:
+		return "G_REGEX_ERROR_NUMBER_TOO_BIG"
+	case GRegexErrorMissingSubpatternNameValue:
+		return "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME"
+	case GRegexErrorMissingDigitValue:
+		return "G_REGEX_ERROR_MISSING_DIGIT"
+	case GRegexErrorInvalidDataCharacterValue:
+		return "G_REGEX_ERROR_INVALID_DATA_CHARACTER"
+	case GRegexErrorExtraSubpatternNameValue:
+		return "G_REGEX_ERROR_EXTRA_SUBPATTERN_NAME"
+	case GRegexErrorBacktrackingControlVerbArgumentRequiredValue:
+		return "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_REQUIRED"
+	case GRegexErrorInvalidControlCharValue:
+		return "G_REGEX_ERROR_INVALID_CONTROL_CHAR"
+	case GRegexErrorMissingNameValue:
+		return "G_REGEX_ERROR_MISSING_NAME"
+	case GRegexErrorNotSupportedInClassValue:
+		return "G_REGEX_ERROR_NOT_SUPPORTED_IN_CLASS"
+	case GRegexErrorTooManyForwardReferencesValue:
+		return "G_REGEX_ERROR_TOO_MANY_FORWARD_REFERENCES"
+	case GRegexErrorNameTooLongValue:
+		return "G_REGEX_ERROR_NAME_TOO_LONG"
+	case GRegexErrorCharacterValueTooLargeValue:
+		return "G_REGEX_ERROR_CHARACTER_VALUE_TOO_LARGE"
+	}
+	return fmt.Sprintf("RegexError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RegexError) Nick() string {
+	switch x {
+	case GRegexErrorCompileValue:
+		return "compile"
+	case GRegexErrorOptimizeValue:
+		return "optimize"
+	case GRegexErrorReplaceValue:
+		return "replace"
+	case GRegexErrorMatchValue:
+		return "match"
+	case GRegexErrorInternalValue:
+		return "internal"
+	case GRegexErrorStrayBackslashValue:
+		return "stray-backslash"
+	case GRegexErrorMissingControlCharValue:
+		return "missing-control-char"
+	case GRegexErrorUnrecognizedEscapeValue:
+		return "unrecognized-escape"
+	case GRegexErrorQuantifiersOutOfOrderValue:
+		return "quantifiers-out-of-order"
+	case GRegexErrorQuantifierTooBigValue:
+		return "quantifier-too-big"
+	case GRegexErrorUnterminatedCharacterClassValue:
+		return "unterminated-character-class"
+	case GRegexErrorInvalidEscapeInCharacterClassValue:
+		return "invalid-escape-in-character-class"
+	case GRegexErrorRangeOutOfOrderValue:
+		return "range-out-of-order"
+	case GRegexErrorNothingToRepeatValue:
+		return "nothing-to-repeat"
+	case GRegexErrorUnrecognizedCharacterValue:
+		return "unrecognized-character"
+	case GRegexErrorPosixNamedClassOutsideClassValue:
+		return "posix-named-class-outside-class"
+	case GRegexErrorUnmatchedParenthesisValue:
+		return "unmatched-parenthesis"
+	case GRegexErrorInexistentSubpatternReferenceValue:
+		return "inexistent-subpattern-reference"
+	case GRegexErrorUnterminatedCommentValue:
+		return "unterminated-comment"
+	case GRegexErrorExpressionTooLargeValue:
+		return "expression-too-large"
+	case GRegexErrorMemoryErrorValue:
+		return "memory-error"
+	case GRegexErrorVariableLengthLookbehindValue:
+		return "variable-length-lookbehind"
+	case GRegexErrorMalformedConditionValue:
+		return "malformed-condition"
+	case GRegexErrorTooManyConditionalBranchesValue:
+		return "too-many-conditional-branches"
+	case GRegexErrorAssertionExpectedValue:
+		return "assertion-expected"
+	case GRegexErrorUnknownPosixClassNameValue:
+		return "unknown-posix-class-name"
+	case GRegexErrorPosixCollatingElementsNotSupportedValue:
+		return "posix-collating-elements-not-supported"
+	case GRegexErrorHexCodeTooLargeValue:
+		return "hex-code-too-large"
+	case GRegexErrorInvalidConditionValue:
+		return "invalid-condition"
+	case GRegexErrorSingleByteMatchInLookbehindValue:
+		return "single-byte-match-in-lookbehind"
+	case GRegexErrorInfiniteLoopValue:
+		return "infinite-loop"
+	case GRegexErrorMissingSubpatternNameTerminatorValue:
+		return "missing-subpattern-name-terminator"
+	case GRegexErrorDuplicateSubpatternNameValue:
+		return "duplicate-subpattern-name"
+	case GRegexErrorMalformedPropertyValue:
+		return "malformed-property"
+	case GRegexErrorUnknownPropertyValue:
+		return "unknown-property"
+	case GRegexErrorSubpatternNameTooLongValue:
+		return "subpattern-name-too-long"
+	case GRegexErrorTooManySubpatternsValue:
+		return "too-many-subpatterns"
+	case GRegexErrorInvalidOctalValueValue:
+		return "invalid-octal-value"
+	case GRegexErrorTooManyBranchesInDefineValue:
+		return "too-many-branches-in-define"
+	case GRegexErrorDefineRepetionValue:
+		return "define-repetion"
+	case GRegexErrorInconsistentNewlineOptionsValue:
+		return "inconsistent-newline-options"
+	case GRegexErrorMissingBackReferenceValue:
+		return "missing-back-reference"
+	case GRegexErrorInvalidRelativeReferenceValue:
+		return "invalid-relative-reference"
+	case GRegexErrorBacktrackingControlVerbArgumentForbiddenValue:
+		return "backtracking-control-verb-argument-forbidden"
+	case GRegexErrorUnknownBacktrackingControlVerbValue:
+		return "unknown-backtracking-control-verb"
+	case GRegexErrorNumberTooBigValue:
+		return "number-too-big"
+	case GRegexErrorMissingSubpatternNameValue:
+		return "missing-subpattern-name"
+	case GRegexErrorMissingDigitValue:
+		return "missing-digit"
+	case GRegexErrorInvalidDataCharacterValue:
+		return "invalid-data-character"
+	case GRegexErrorExtraSubpatternNameValue:
+		return "extra-subpattern-name"
+	case GRegexErrorBacktrackingControlVerbArgumentRequiredValue:
+		return "backtracking-control-verb-argument-required"
+	case GRegexErrorInvalidControlCharValue:
+		return "invalid-control-char"
+	case GRegexErrorMissingNameValue:
+		return "missing-name"
+	case GRegexErrorNotSupportedInClassValue:
+		return "not-supported-in-class"
+	case GRegexErrorTooManyForwardReferencesValue:
+		return "too-many-forward-references"
+	case GRegexErrorNameTooLongValue:
+		return "name-too-long"
+	case GRegexErrorCharacterValueTooLargeValue:
+		return "character-value-too-large"
+	}
+	return ""
+}
+
+// RegexErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RegexErrorFromString(s string) (RegexError, bool) {
+	switch s {
+	case "G_REGEX_ERROR_COMPILE", "compile":
+		return GRegexErrorCompileValue, true
+	case "G_REGEX_ERROR_OPTIMIZE", "optimize":
+		return GRegexErrorOptimizeValue, true
+	case "G_REGEX_ERROR_REPLACE", "replace":
+		return GRegexErrorReplaceValue, true
+	case "G_REGEX_ERROR_MATCH", "match":
+		return GRegexErrorMatchValue, true
+	case "G_REGEX_ERROR_INTERNAL", "internal":
+		return GRegexErrorInternalValue, true
+	case "G_REGEX_ERROR_STRAY_BACKSLASH", "stray-backslash":
+		return GRegexErrorStrayBackslashValue, true
+	case "G_REGEX_ERROR_MISSING_CONTROL_CHAR", "missing-control-char":
+		return GRegexErrorMissingControlCharValue, true
+	case "G_REGEX_ERROR_UNRECOGNIZED_ESCAPE", "unrecognized-escape":
+		return GRegexErrorUnrecognizedEscapeValue, true
+	case "G_REGEX_ERROR_QUANTIFIERS_OUT_OF_ORDER", "quantifiers-out-of-order":
+		return GRegexErrorQuantifiersOutOfOrderValue, true
+	case "G_REGEX_ERROR_QUANTIFIER_TOO_BIG", "quantifier-too-big":
+		return GRegexErrorQuantifierTooBigValue, true
+	case "G_REGEX_ERROR_UNTERMINATED_CHARACTER_CLASS", "unterminated-character-class":
+		return GRegexErrorUnterminatedCharacterClassValue, true
+	case "G_REGEX_ERROR_INVALID_ESCAPE_IN_CHARACTER_CLASS", "invalid-escape-in-character-class":
+		return GRegexErrorInvalidEscapeInCharacterClassValue, true
+	case "G_REGEX_ERROR_RANGE_OUT_OF_ORDER", "range-out-of-order":
+		return GRegexErrorRangeOutOfOrderValue, true
+	case "G_REGEX_ERROR_NOTHING_TO_REPEAT", "nothing-to-repeat":
+		return GRegexErrorNothingToRepeatValue, true
+	case "G_REGEX_ERROR_UNRECOGNIZED_CHARACTER", "unrecognized-character":
+		return GRegexErrorUnrecognizedCharacterValue, true
+	case "G_REGEX_ERROR_POSIX_NAMED_CLASS_OUTSIDE_CLASS", "posix-named-class-outside-class":
+		return GRegexErrorPosixNamedClassOutsideClassValue, true
+	case "G_REGEX_ERROR_UNMATCHED_PARENTHESIS", "unmatched-parenthesis":
+		return GRegexErrorUnmatchedParenthesisValue, true
+	case "G_REGEX_ERROR_INEXISTENT_SUBPATTERN_REFERENCE", "inexistent-subpattern-reference":
+		return GRegexErrorInexistentSubpatternReferenceValue, true
+	case "G_REGEX_ERROR_UNTERMINATED_COMMENT", "unterminated-comment":
+		return GRegexErrorUnterminatedCommentValue, true
+	case "G_REGEX_ERROR_EXPRESSION_TOO_LARGE", "expression-too-large":
+		return GRegexErrorExpressionTooLargeValue, true
+	case "G_REGEX_ERROR_MEMORY_ERROR", "memory-error":
+		return GRegexErrorMemoryErrorValue, true
+	case "G_REGEX_ERROR_VARIABLE_LENGTH_LOOKBEHIND", "variable-length-lookbehind":
+		return GRegexErrorVariableLengthLookbehindValue, true
+	case "G_REGEX_ERROR_MALFORMED_CONDITION", "malformed-condition":
+		return GRegexErrorMalformedConditionValue, true
+	case "G_REGEX_ERROR_TOO_MANY_CONDITIONAL_BRANCHES", "too-many-conditional-branches":
+		return GRegexErrorTooManyConditionalBranchesValue, true
+	case "G_REGEX_ERROR_ASSERTION_EXPECTED", "assertion-expected":
+		return GRegexErrorAssertionExpectedValue, true
+	case "G_REGEX_ERROR_UNKNOWN_POSIX_CLASS_NAME", "unknown-posix-class-name":
+		return GRegexErrorUnknownPosixClassNameValue, true
+	case "G_REGEX_ERROR_POSIX_COLLATING_ELEMENTS_NOT_SUPPORTED", "posix-collating-elements-not-supported":
+		return GRegexErrorPosixCollatingElementsNotSupportedValue, true
+	case "G_REGEX_ERROR_HEX_CODE_TOO_LARGE", "hex-code-too-large":
+		return GRegexErrorHexCodeTooLargeValue, true
+	case "G_REGEX_ERROR_INVALID_CONDITION", "invalid-condition":
+		return GRegexErrorInvalidConditionValue, true
+	case "G_REGEX_ERROR_SINGLE_BYTE_MATCH_IN_LOOKBEHIND", "single-byte-match-in-lookbehind":
+		return GRegexErrorSingleByteMatchInLookbehindValue, true
+	case "G_REGEX_ERROR_INFINITE_LOOP", "infinite-loop":
+		return GRegexErrorInfiniteLoopValue, true
+	case "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME_TERMINATOR", "missing-subpattern-name-terminator":
+		return GRegexErrorMissingSubpatternNameTerminatorValue, true
+	case "G_REGEX_ERROR_DUPLICATE_SUBPATTERN_NAME", "duplicate-subpattern-name":
+		return GRegexErrorDuplicateSubpatternNameValue, true
+	case "G_REGEX_ERROR_MALFORMED_PROPERTY", "malformed-property":
+		return GRegexErrorMalformedPropertyValue, true
+	case "G_REGEX_ERROR_UNKNOWN_PROPERTY", "unknown-property":
+		return GRegexErrorUnknownPropertyValue, true
+	case "G_REGEX_ERROR_SUBPATTERN_NAME_TOO_LONG", "subpattern-name-too-long":
+		return GRegexErrorSubpatternNameTooLongValue, true
+	case "G_REGEX_ERROR_TOO_MANY_SUBPATTERNS", "too-many-subpatterns":
+		return GRegexErrorTooManySubpatternsValue, true
+	case "G_REGEX_ERROR_INVALID_OCTAL_VALUE", "invalid-octal-value":
+		return GRegexErrorInvalidOctalValueValue, true
+	case "G_REGEX_ERROR_TOO_MANY_BRANCHES_IN_DEFINE", "too-many-branches-in-define":
+		return GRegexErrorTooManyBranchesInDefineValue, true
+	case "G_REGEX_ERROR_DEFINE_REPETION", "define-repetion":
+		return GRegexErrorDefineRepetionValue, true
+	case "G_REGEX_ERROR_INCONSISTENT_NEWLINE_OPTIONS", "inconsistent-newline-options":
+		return GRegexErrorInconsistentNewlineOptionsValue, true
+	case "G_REGEX_ERROR_MISSING_BACK_REFERENCE", "missing-back-reference":
+		return GRegexErrorMissingBackReferenceValue, true
+	case "G_REGEX_ERROR_INVALID_RELATIVE_REFERENCE", "invalid-relative-reference":
+		return GRegexErrorInvalidRelativeReferenceValue, true
+	case "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_FORBIDDEN", "backtracking-control-verb-argument-forbidden":
+		return GRegexErrorBacktrackingControlVerbArgumentForbiddenValue, true
+	case "G_REGEX_ERROR_UNKNOWN_BACKTRACKING_CONTROL_VERB", "unknown-backtracking-control-verb":
+		return GRegexErrorUnknownBacktrackingControlVerbValue, true
+	case "G_REGEX_ERROR_NUMBER_TOO_BIG", "number-too-big":
+		return GRegexErrorNumberTooBigValue, true
+	case "G_REGEX_ERROR_MISSING_SUBPATTERN_NAME", "missing-subpattern-name":
+		return GRegexErrorMissingSubpatternNameValue, true
+	case "G_REGEX_ERROR_MISSING_DIGIT", "missing-digit":
+		return GRegexErrorMissingDigitValue, true
+	case "G_REGEX_ERROR_INVALID_DATA_CHARACTER", "invalid-data-character":
+		return GRegexErrorInvalidDataCharacterValue, true
+	case "G_REGEX_ERROR_EXTRA_SUBPATTERN_NAME", "extra-subpattern-name":
+		return GRegexErrorExtraSubpatternNameValue, true
+	case "G_REGEX_ERROR_BACKTRACKING_CONTROL_VERB_ARGUMENT_REQUIRED", "backtracking-control-verb-argument-required":
+		return GRegexErrorBacktrackingControlVerbArgumentRequiredValue, true
+	case "G_REGEX_ERROR_INVALID_CONTROL_CHAR", "invalid-control-char":
+		return GRegexErrorInvalidControlCharValue, true
+	case "G_REGEX_ERROR_MISSING_NAME", "missing-name":
+		return GRegexErrorMissingNameValue, true
+	case "G_REGEX_ERROR_NOT_SUPPORTED_IN_CLASS", "not-supported-in-class":
+		return GRegexErrorNotSupportedInClassValue, true
+	case "G_REGEX_ERROR_TOO_MANY_FORWARD_REFERENCES", "too-many-forward-references":
+		return GRegexErrorTooManyForwardReferencesValue, true
+	case "G_REGEX_ERROR_NAME_TOO_LONG", "name-too-long":
+		return GRegexErrorNameTooLongValue, true
+	case "G_REGEX_ERROR_CHARACTER_VALUE_TOO_LARGE", "character-value-too-large":
+		return GRegexErrorCharacterValueTooLargeValue, true
+	}
+	return 0, false
+}
+
 var xRegexCheckReplacement func(string, *bool, **Error) bool
 
-// Checks whether @replacement is a valid replacement string
-// (see g_regex_replace()), i.e. that all escape sequences in
+// Checks whether replacement is a valid replacement string
+// (see [Regex.Replace]), i.e. that all escape sequences in
 // it are valid.
 //
-// If @has_references is not %NULL then @replacement is checked
+// If has_references is not nil then replacement is checked
 // for pattern references. For instance, replacement text 'foo\n'
 // does not contain references and may be evaluated without information
 // about actual match, but '\0\1' (whole match followed by first
-// subpattern) requires valid #GMatchInfo object.
+// subpattern) requires valid [MatchInfo] object.
 func RegexCheckReplacement(ReplacementVar string, HasReferencesVar *bool) (bool, error) {
 	var cerr *Error
 
 	cret := xRegexCheckReplacement(ReplacementVar, HasReferencesVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1514,11 +2162,11 @@ func RegexCheckReplacement(ReplacementVar string, HasReferencesVar *bool) (bool,
 
 var xRegexEscapeNul func(string, int) string
 
-// Escapes the nul characters in @string to "\x00".  It can be used
+// Escapes the nul characters in string to "\x00".  It can be used
 // to compile a regex with embedded nul characters.
 //
-// For completeness, @length can be -1 for a nul-terminated string.
-// In this case the output string will be of course equal to @string.
+// For completeness, length can be -1 for a nul-terminated string.
+// In this case the output string will be of course equal to string.
 func RegexEscapeNul(StringVar string, LengthVar int) string {
 
 	cret := xRegexEscapeNul(StringVar, LengthVar)
@@ -1529,12 +2177,12 @@ func RegexEscapeNul(StringVar string, LengthVar int) string {
 var xRegexEscapeString func(string, int) string
 
 // Escapes the special characters used for regular expressions
-// in @string, for instance "a.b*c" becomes "a\.b\*c". This
+// in string, for instance "a.b*c" becomes "a\.b\*c". This
 // function is useful to dynamically generate regular expressions.
 //
-// @string can contain nul characters that are replaced with "\0",
-// in this case remember to specify the correct length of @string
-// in @length.
+// string can contain nul characters that are replaced with "\0",
+// in this case remember to specify the correct length of string
+// in length.
 func RegexEscapeString(StringVar string, LengthVar int) string {
 
 	cret := xRegexEscapeString(StringVar, LengthVar)
@@ -1544,16 +2192,16 @@ func RegexEscapeString(StringVar string, LengthVar int) string {
 
 var xRegexMatchSimple func(string, string, RegexCompileFlags, RegexMatchFlags) bool
 
-// Scans for a match in @string for @pattern.
+// Scans for a match in string for pattern.
 //
-// This function is equivalent to g_regex_match() but it does not
-// require to compile the pattern with g_regex_new(), avoiding some
+// This function is equivalent to [Regex.Match] but it does not
+// require to compile the pattern with [NewRegex], avoiding some
 // lines of code when you need just to do a match without extracting
 // substrings, capture counts, and so on.
 //
-// If this function is to be called on the same @pattern more than
+// If this function is to be called on the same pattern more than
 // once, it's more efficient to compile the pattern once with
-// g_regex_new() and then use g_regex_match().
+// [NewRegex] and then use [Regex.Match].
 func RegexMatchSimple(PatternVar string, StringVar string, CompileOptionsVar RegexCompileFlags, MatchOptionsVar RegexMatchFlags) bool {
 
 	cret := xRegexMatchSimple(PatternVar, StringVar, CompileOptionsVar, MatchOptionsVar)
@@ -1561,7 +2209,7 @@ func RegexMatchSimple(PatternVar string, StringVar string, CompileOptionsVar Reg
 	return cret
 }
 
-var xRegexSplitSimple func(string, string, RegexCompileFlags, RegexMatchFlags) []string
+var xRegexSplitSimple func(string, string, RegexCompileFlags, RegexMatchFlags) uintptr
 
 // Breaks the string on the pattern, and returns an array of
 // the tokens. If the pattern contains capturing parentheses,
@@ -1569,14 +2217,14 @@ var xRegexSplitSimple func(string, string, RegexCompileFlags, RegexMatchFlags) [
 // If the pattern does not match anywhere in the string, then the
 // whole string is returned as the first token.
 //
-// This function is equivalent to g_regex_split() but it does
-// not require to compile the pattern with g_regex_new(), avoiding
+// This function is equivalent to [Regex.Split] but it does
+// not require to compile the pattern with [NewRegex], avoiding
 // some lines of code when you need just to do a split without
 // extracting substrings, capture counts, and so on.
 //
-// If this function is to be called on the same @pattern more than
+// If this function is to be called on the same pattern more than
 // once, it's more efficient to compile the pattern once with
-// g_regex_new() and then use g_regex_split().
+// [NewRegex] and then use [Regex.Split].
 //
 // As a special case, the result of splitting the empty string ""
 // is an empty vector, not a vector containing a single string.
@@ -1586,7 +2234,7 @@ var xRegexSplitSimple func(string, string, RegexCompileFlags, RegexMatchFlags) [
 // you'll need to check for the empty string before calling this
 // function.
 //
-// A pattern that can match empty strings splits @string into
+// A pattern that can match empty strings splits string into
 // separate characters wherever it matches the empty string between
 // characters. For example splitting "ab c" using as a separator
 // "\s*", you will get "a", "b" and "c".
@@ -1594,7 +2242,7 @@ func RegexSplitSimple(PatternVar string, StringVar string, CompileOptionsVar Reg
 
 	cret := xRegexSplitSimple(PatternVar, StringVar, CompileOptionsVar, MatchOptionsVar)
 
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 func init() {
@@ -1602,59 +2250,59 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xRegexCheckReplacement, libs, "g_regex_check_replacement")
-	core.PuregoSafeRegister(&xRegexEscapeNul, libs, "g_regex_escape_nul")
-	core.PuregoSafeRegister(&xRegexEscapeString, libs, "g_regex_escape_string")
-	core.PuregoSafeRegister(&xRegexMatchSimple, libs, "g_regex_match_simple")
-	core.PuregoSafeRegister(&xRegexSplitSimple, libs, "g_regex_split_simple")
+	core.PuregoRegisterSince(&xRegexCheckReplacement, libs, "g_regex_check_replacement", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexEscapeNul, libs, "g_regex_escape_nul", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xRegexEscapeString, libs, "g_regex_escape_string", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexMatchSimple, libs, "g_regex_match_simple", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexSplitSimple, libs, "g_regex_split_simple", "GLIB", "2.14")
 
 	core.PuregoSafeRegister(&xMatchInfoGLibType, libs, "g_match_info_get_type")
 
-	core.PuregoSafeRegister(&xMatchInfoExpandReferences, libs, "g_match_info_expand_references")
-	core.PuregoSafeRegister(&xMatchInfoFetch, libs, "g_match_info_fetch")
-	core.PuregoSafeRegister(&xMatchInfoFetchAll, libs, "g_match_info_fetch_all")
-	core.PuregoSafeRegister(&xMatchInfoFetchNamed, libs, "g_match_info_fetch_named")
-	core.PuregoSafeRegister(&xMatchInfoFetchNamedPos, libs, "g_match_info_fetch_named_pos")
-	core.PuregoSafeRegister(&xMatchInfoFetchPos, libs, "g_match_info_fetch_pos")
-	core.PuregoSafeRegister(&xMatchInfoFree, libs, "g_match_info_free")
-	core.PuregoSafeRegister(&xMatchInfoGetMatchCount, libs, "g_match_info_get_match_count")
-	core.PuregoSafeRegister(&xMatchInfoGetRegex, libs, "g_match_info_get_regex")
-	core.PuregoSafeRegister(&xMatchInfoGetString, libs, "g_match_info_get_string")
-	core.PuregoSafeRegister(&xMatchInfoIsPartialMatch, libs, "g_match_info_is_partial_match")
-	core.PuregoSafeRegister(&xMatchInfoMatches, libs, "g_match_info_matches")
-	core.PuregoSafeRegister(&xMatchInfoNext, libs, "g_match_info_next")
-	core.PuregoSafeRegister(&xMatchInfoRef, libs, "g_match_info_ref")
-	core.PuregoSafeRegister(&xMatchInfoUnref, libs, "g_match_info_unref")
+	core.PuregoRegisterSince(&xMatchInfoExpandReferences, libs, "g_match_info_expand_references", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFetch, libs, "g_match_info_fetch", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFetchAll, libs, "g_match_info_fetch_all", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFetchNamed, libs, "g_match_info_fetch_named", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFetchNamedPos, libs, "g_match_info_fetch_named_pos", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFetchPos, libs, "g_match_info_fetch_pos", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoFree, libs, "g_match_info_free", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoGetMatchCount, libs, "g_match_info_get_match_count", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoGetRegex, libs, "g_match_info_get_regex", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoGetString, libs, "g_match_info_get_string", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoIsPartialMatch, libs, "g_match_info_is_partial_match", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoMatches, libs, "g_match_info_matches", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoNext, libs, "g_match_info_next", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xMatchInfoRef, libs, "g_match_info_ref", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xMatchInfoUnref, libs, "g_match_info_unref", "GLIB", "2.30")
 
 	core.PuregoSafeRegister(&xRegexGLibType, libs, "g_regex_get_type")
 
-	core.PuregoSafeRegister(&xNewRegex, libs, "g_regex_new")
-
-	core.PuregoSafeRegister(&xRegexGetCaptureCount, libs, "g_regex_get_capture_count")
-	core.PuregoSafeRegister(&xRegexGetCompileFlags, libs, "g_regex_get_compile_flags")
-	core.PuregoSafeRegister(&xRegexGetHasCrOrLf, libs, "g_regex_get_has_cr_or_lf")
-	core.PuregoSafeRegister(&xRegexGetMatchFlags, libs, "g_regex_get_match_flags")
-	core.PuregoSafeRegister(&xRegexGetMaxBackref, libs, "g_regex_get_max_backref")
-	core.PuregoSafeRegister(&xRegexGetMaxLookbehind, libs, "g_regex_get_max_lookbehind")
-	core.PuregoSafeRegister(&xRegexGetPattern, libs, "g_regex_get_pattern")
-	core.PuregoSafeRegister(&xRegexGetStringNumber, libs, "g_regex_get_string_number")
-	core.PuregoSafeRegister(&xRegexMatch, libs, "g_regex_match")
-	core.PuregoSafeRegister(&xRegexMatchAll, libs, "g_regex_match_all")
-	core.PuregoSafeRegister(&xRegexMatchAllFull, libs, "g_regex_match_all_full")
-	core.PuregoSafeRegister(&xRegexMatchFull, libs, "g_regex_match_full")
-	core.PuregoSafeRegister(&xRegexRef, libs, "g_regex_ref")
-	core.PuregoSafeRegister(&xRegexReplace, libs, "g_regex_replace")
-	core.PuregoSafeRegister(&xRegexReplaceEval, libs, "g_regex_replace_eval")
-	core.PuregoSafeRegister(&xRegexReplaceLiteral, libs, "g_regex_replace_literal")
-	core.PuregoSafeRegister(&xRegexSplit, libs, "g_regex_split")
-	core.PuregoSafeRegister(&xRegexSplitFull, libs, "g_regex_split_full")
-	core.PuregoSafeRegister(&xRegexUnref, libs, "g_regex_unref")
+	core.PuregoRegisterSince(&xNewRegex, libs, "g_regex_new", "GLIB", "2.14")
+
+	core.PuregoRegisterSince(&xRegexGetCaptureCount, libs, "g_regex_get_capture_count", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexGetCompileFlags, libs, "g_regex_get_compile_flags", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xRegexGetHasCrOrLf, libs, "g_regex_get_has_cr_or_lf", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xRegexGetMatchFlags, libs, "g_regex_get_match_flags", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xRegexGetMaxBackref, libs, "g_regex_get_max_backref", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexGetMaxLookbehind, libs, "g_regex_get_max_lookbehind", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xRegexGetPattern, libs, "g_regex_get_pattern", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexGetStringNumber, libs, "g_regex_get_string_number", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexMatch, libs, "g_regex_match", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexMatchAll, libs, "g_regex_match_all", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexMatchAllFull, libs, "g_regex_match_all_full", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexMatchFull, libs, "g_regex_match_full", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexRef, libs, "g_regex_ref", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexReplace, libs, "g_regex_replace", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexReplaceEval, libs, "g_regex_replace_eval", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexReplaceLiteral, libs, "g_regex_replace_literal", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexSplit, libs, "g_regex_split", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexSplitFull, libs, "g_regex_split_full", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xRegexUnref, libs, "g_regex_unref", "GLIB", "2.14")
 
 }
@@ -0,0 +1,193 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xRelationCount func(uintptr, uintptr, int) int
+
+// Returns the number of tuples in a [Relation] that have the given
+// value in the given field.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Count(KeyVar uintptr, FieldVar int) int {
+
+	cret := xRelationCount(x.GoPointer(), KeyVar, FieldVar)
+	return cret
+}
+
+var xRelationDelete func(uintptr, uintptr, int) int
+
+// Deletes any records from a [Relation] that have the given key value
+// in the given field.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Delete(KeyVar uintptr, FieldVar int) int {
+
+	cret := xRelationDelete(x.GoPointer(), KeyVar, FieldVar)
+	return cret
+}
+
+var xRelationDestroy func(uintptr)
+
+// Destroys the [Relation], freeing all memory allocated. However, it
+// does not free memory allocated for the tuple data, so you should
+// free that first if appropriate.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Destroy() {
+
+	xRelationDestroy(x.GoPointer())
+
+}
+
+var xRelationExists func(uintptr, ...interface{}) bool
+
+// Returns true if a record with the given values exists in a
+// [Relation]. Note that the values are compared directly, so that, for
+// example, two copies of the same string will not match.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Exists(varArgs ...interface{}) bool {
+
+	cret := xRelationExists(x.GoPointer(), varArgs...)
+	return cret
+}
+
+var xRelationIndex func(uintptr, int, uintptr, uintptr)
+
+// Creates an index on the given field. Note that this must be called
+// before any records are added to the [Relation].
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Index(FieldVar int, HashFuncVar *HashFunc, KeyEqualFuncVar *EqualFunc) {
+
+	var HashFuncVarRef uintptr
+	if HashFuncVar != nil {
+		HashFuncVarPtr := uintptr(unsafe.Pointer(HashFuncVar))
+		if cbRefPtr, ok := GetCallback(HashFuncVarPtr); ok {
+			HashFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) uint {
+				defer core.RecoverPanic()
+				cbFn := *HashFuncVar
+				return cbFn(arg0)
+			}
+			HashFuncVarRef = core.NewCallback(fcb)
+			HashFuncVarRef = SaveCallbackWithClosure(HashFuncVarPtr, HashFuncVarRef, HashFuncVar)
+		}
+	}
+
+	var KeyEqualFuncVarRef uintptr
+	if KeyEqualFuncVar != nil {
+		KeyEqualFuncVarPtr := uintptr(unsafe.Pointer(KeyEqualFuncVar))
+		if cbRefPtr, ok := GetCallback(KeyEqualFuncVarPtr); ok {
+			KeyEqualFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *KeyEqualFuncVar
+				return cbFn(arg0, arg1)
+			}
+			KeyEqualFuncVarRef = core.NewCallback(fcb)
+			KeyEqualFuncVarRef = SaveCallbackWithClosure(KeyEqualFuncVarPtr, KeyEqualFuncVarRef, KeyEqualFuncVar)
+		}
+	}
+
+	xRelationIndex(x.GoPointer(), FieldVar, HashFuncVarRef, KeyEqualFuncVarRef)
+
+}
+
+var xRelationInsert func(uintptr, ...interface{})
+
+// Inserts a record into a [Relation].
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Insert(varArgs ...interface{}) {
+
+	xRelationInsert(x.GoPointer(), varArgs...)
+
+}
+
+var xRelationPrint func(uintptr)
+
+// Outputs information about all records in a [Relation], as well as
+// the indexes. It is for debugging.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Print() {
+
+	xRelationPrint(x.GoPointer())
+
+}
+
+var xRelationSelect func(uintptr, uintptr, int) *Tuples
+
+// Returns all of the tuples which have the given key in the given
+// field. Use [Tuples.Index] to access the returned records. The
+// returned records should be freed with [Tuples.Destroy].
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Relation) Select(KeyVar uintptr, FieldVar int) *Tuples {
+
+	cret := xRelationSelect(x.GoPointer(), KeyVar, FieldVar)
+	return cret
+}
+
+var xTuplesDestroy func(uintptr)
+
+// Frees the records which were returned by [Relation.Select]. This
+// should always be called after [Relation.Select] when you are
+// finished with the records. The records are not removed from the
+// [Relation].
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Tuples) Destroy() {
+
+	xTuplesDestroy(x.GoPointer())
+
+}
+
+var xTuplesIndex func(uintptr, int, int) uintptr
+
+// Gets a field from the records returned by [Relation.Select]. It
+// returns the given field of the record at the given index. The
+// returned value should not be changed.
+//
+// Deprecated: since 2.26. Rarely used API
+func (x *Tuples) Index(IndexVar int, FieldVar int) uintptr {
+
+	cret := xTuplesIndex(x.GoPointer(), IndexVar, FieldVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xRelationCount, libs, "g_relation_count", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationDelete, libs, "g_relation_delete", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationDestroy, libs, "g_relation_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationExists, libs, "g_relation_exists", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationIndex, libs, "g_relation_index", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationInsert, libs, "g_relation_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationPrint, libs, "g_relation_print", "GLIB", "")
+	core.PuregoRegisterSince(&xRelationSelect, libs, "g_relation_select", "GLIB", "")
+
+	core.PuregoRegisterSince(&xTuplesDestroy, libs, "g_tuples_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xTuplesIndex, libs, "g_tuples_index", "GLIB", "")
+
+}
@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -14,18 +14,18 @@ type ScannerMsgFunc func(*Scanner, string, bool)
 
 // `GScanner` provides a general-purpose lexical scanner.
 //
-// You should set @input_name after creating the scanner, since
+// You should set input_name after creating the scanner, since
 // it is used by the default message handler when displaying
 // warnings and errors. If you are scanning a file, the filename
 // would be a good choice.
 //
-// The @user_data and @max_parse_errors fields are not used.
+// The user_data and max_parse_errors fields are not used.
 // If you need to associate extra data with the scanner you
 // can place them here.
 //
 // If you want to use your own message handler you can set the
-// @msg_handler field. The type of the message handler function
-// is declared by #GScannerMsgFunc.
+// msg_handler field. The type of the message handler function
+// is declared by [ScannerMsgFunc].
 type Scanner struct {
 	_ structs.HostLayout
 
@@ -80,7 +80,7 @@ var xScannerCurLine func(uintptr) uint
 
 // Returns the current line in the input stream (counting
 // from 1). This is the line of the last token parsed via
-// g_scanner_get_next_token().
+// [Scanner.GetNextToken].
 func (x *Scanner) CurLine() uint {
 
 	cret := xScannerCurLine(x.GoPointer())
@@ -91,7 +91,7 @@ var xScannerCurPosition func(uintptr) uint
 
 // Returns the current position in the current line (counting
 // from 0). This is the position of the last token parsed via
-// g_scanner_get_next_token().
+// [Scanner.GetNextToken].
 func (x *Scanner) CurPosition() uint {
 
 	cret := xScannerCurPosition(x.GoPointer())
@@ -100,8 +100,8 @@ func (x *Scanner) CurPosition() uint {
 
 var xScannerCurToken func(uintptr) TokenType
 
-// Gets the current token type. This is simply the @token
-// field in the #GScanner structure.
+// Gets the current token type. This is simply the token
+// field in the [Scanner] structure.
 func (x *Scanner) CurToken() TokenType {
 
 	cret := xScannerCurToken(x.GoPointer())
@@ -110,8 +110,8 @@ func (x *Scanner) CurToken() TokenType {
 
 var xScannerCurValue func(uintptr) TokenValue
 
-// Gets the current token value. This is simply the @value
-// field in the #GScanner structure.
+// Gets the current token value. This is simply the value
+// field in the [Scanner] structure.
 func (x *Scanner) CurValue() TokenValue {
 
 	cret := xScannerCurValue(x.GoPointer())
@@ -120,7 +120,7 @@ func (x *Scanner) CurValue() TokenValue {
 
 var xScannerDestroy func(uintptr)
 
-// Frees all memory used by the #GScanner.
+// Frees all memory used by the [Scanner].
 func (x *Scanner) Destroy() {
 
 	xScannerDestroy(x.GoPointer())
@@ -129,7 +129,7 @@ func (x *Scanner) Destroy() {
 
 var xScannerEof func(uintptr) bool
 
-// Returns %TRUE if the scanner has reached the end of
+// Returns true if the scanner has reached the end of
 // the file or text buffer.
 func (x *Scanner) Eof() bool {
 
@@ -139,19 +139,21 @@ func (x *Scanner) Eof() bool {
 
 var xScannerError func(uintptr, string, ...interface{})
 
-// Outputs an error message, via the #GScanner message handler.
+// Outputs an error message, via the [Scanner] message handler.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *Scanner) Error(FormatVar string, varArgs ...interface{}) {
 
-	xScannerError(x.GoPointer(), FormatVar, varArgs...)
+	xScannerError(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xScannerGetNextToken func(uintptr) TokenType
 
-// Parses the next token just like g_scanner_peek_next_token()
+// Parses the next token just like [Scanner.PeekNextToken]
 // and also removes it from the input stream. The token data is
-// placed in the @token, @value, @line, and @position fields of
-// the #GScanner structure.
+// placed in the token, value, line, and position fields of
+// the [Scanner] structure.
 func (x *Scanner) GetNextToken() TokenType {
 
 	cret := xScannerGetNextToken(x.GoPointer())
@@ -179,7 +181,7 @@ func (x *Scanner) InputText(TextVar string, TextLenVar uint) {
 var xScannerLookupSymbol func(uintptr, string) uintptr
 
 // Looks up a symbol in the current scope and return its value.
-// If the symbol is not bound in the current scope, %NULL is
+// If the symbol is not bound in the current scope, nil is
 // returned.
 func (x *Scanner) LookupSymbol(SymbolVar string) uintptr {
 
@@ -190,11 +192,11 @@ func (x *Scanner) LookupSymbol(SymbolVar string) uintptr {
 var xScannerPeekNextToken func(uintptr) TokenType
 
 // Parses the next token, without removing it from the input stream.
-// The token data is placed in the @next_token, @next_value, @next_line,
-// and @next_position fields of the #GScanner structure.
+// The token data is placed in the next_token, next_value, next_line,
+// and next_position fields of the [Scanner] structure.
 //
 // Note that, while the token is not removed from the input stream
-// (i.e. the next call to g_scanner_get_next_token() will return the
+// (i.e. the next call to [Scanner.GetNextToken] will return the
 // same token), it will not be reevaluated. This can lead to surprising
 // results when changing scope or the scanner configuration after peeking
 // the next token. Getting the next token after switching the scope or
@@ -218,8 +220,8 @@ func (x *Scanner) ScopeAddSymbol(ScopeIdVar uint, SymbolVar string, ValueVar uin
 var xScannerScopeForeachSymbol func(uintptr, uint, uintptr, uintptr)
 
 // Calls the given function for each of the symbol/value pairs
-// in the given scope of the #GScanner. The function is passed
-// the symbol and value of each pair, and the given @user_data
+// in the given scope of the [Scanner]. The function is passed
+// the symbol and value of each pair, and the given user_data
 // parameter.
 func (x *Scanner) ScopeForeachSymbol(ScopeIdVar uint, FuncVar *HFunc, UserDataVar uintptr) {
 
@@ -230,11 +232,12 @@ func (x *Scanner) ScopeForeachSymbol(ScopeIdVar uint, FuncVar *HFunc, UserDataVa
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -245,7 +248,7 @@ func (x *Scanner) ScopeForeachSymbol(ScopeIdVar uint, FuncVar *HFunc, UserDataVa
 var xScannerScopeLookupSymbol func(uintptr, uint, string) uintptr
 
 // Looks up a symbol in a scope and return its value. If the
-// symbol is not bound in the scope, %NULL is returned.
+// symbol is not bound in the scope, nil is returned.
 func (x *Scanner) ScopeLookupSymbol(ScopeIdVar uint, SymbolVar string) uintptr {
 
 	cret := xScannerScopeLookupSymbol(x.GoPointer(), ScopeIdVar, SymbolVar)
@@ -286,9 +289,9 @@ var xScannerUnexpToken func(uintptr, TokenType, string, string, string, string,
 
 // Outputs a message through the scanner's msg_handler,
 // resulting from an unexpected token in the input stream.
-// Note that you should not call g_scanner_peek_next_token()
-// followed by g_scanner_unexp_token() without an intermediate
-// call to g_scanner_get_next_token(), as g_scanner_unexp_token()
+// Note that you should not call [Scanner.PeekNextToken]
+// followed by [Scanner.UnexpToken] without an intermediate
+// call to [Scanner.GetNextToken], as [Scanner.UnexpToken]
 // evaluates the scanner's current token (not the peeked token)
 // to construct part of the message.
 func (x *Scanner) UnexpToken(ExpectedTokenVar TokenType, IdentifierSpecVar string, SymbolSpecVar string, SymbolNameVar string, MessageVar string, IsErrorVar int) {
@@ -299,14 +302,16 @@ func (x *Scanner) UnexpToken(ExpectedTokenVar TokenType, IdentifierSpecVar strin
 
 var xScannerWarn func(uintptr, string, ...interface{})
 
-// Outputs a warning message, via the #GScanner message handler.
+// Outputs a warning message, via the [Scanner] message handler.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *Scanner) Warn(FormatVar string, varArgs ...interface{}) {
 
-	xScannerWarn(x.GoPointer(), FormatVar, varArgs...)
+	xScannerWarn(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
-// Specifies the #GScanner parser configuration. Most settings can
+// Specifies the [Scanner] parser configuration. Most settings can
 // be changed during the parsing phase and will affect the lexical
 // parsing of the next unpeeked token.
 type ScannerConfig struct {
@@ -377,20 +382,20 @@ type TokenValue = uintptr
 const (
 	// The set of uppercase ASCII alphabet characters.
 	// Used for specifying valid identifier characters
-	// in #GScannerConfig.
+	// in [ScannerConfig].
 	CSET_A_2_Z string = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	// The set of ASCII digits.
 	// Used for specifying valid identifier characters
-	// in #GScannerConfig.
+	// in [ScannerConfig].
 	CSET_DIGITS string = "0123456789"
 	// The set of lowercase ASCII alphabet characters.
 	// Used for specifying valid identifier characters
-	// in #GScannerConfig.
+	// in [ScannerConfig].
 	CSET_a_2_z string = "abcdefghijklmnopqrstuvwxyz"
 )
 
-// The possible errors, used in the @v_error field
-// of #GTokenValue, when the token is a %G_TOKEN_ERROR.
+// The possible errors, used in the v_error field
+// of [TokenValue], when the token is a [GTokenErrorValue].
 type ErrorType int
 
 const (
@@ -413,8 +418,77 @@ const (
 	GErrFloatMalformedValue ErrorType = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ErrorType) String() string {
+	switch x {
+	case GErrUnknownValue:
+		return "G_ERR_UNKNOWN"
+	case GErrUnexpEofValue:
+		return "G_ERR_UNEXP_EOF"
+	case GErrUnexpEofInStringValue:
+		return "G_ERR_UNEXP_EOF_IN_STRING"
+	case GErrUnexpEofInCommentValue:
+		return "G_ERR_UNEXP_EOF_IN_COMMENT"
+	case GErrNonDigitInConstValue:
+		return "G_ERR_NON_DIGIT_IN_CONST"
+	case GErrDigitRadixValue:
+		return "G_ERR_DIGIT_RADIX"
+	case GErrFloatRadixValue:
+		return "G_ERR_FLOAT_RADIX"
+	case GErrFloatMalformedValue:
+		return "G_ERR_FLOAT_MALFORMED"
+	}
+	return fmt.Sprintf("ErrorType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ErrorType) Nick() string {
+	switch x {
+	case GErrUnknownValue:
+		return "unknown"
+	case GErrUnexpEofValue:
+		return "unexp-eof"
+	case GErrUnexpEofInStringValue:
+		return "unexp-eof-in-string"
+	case GErrUnexpEofInCommentValue:
+		return "unexp-eof-in-comment"
+	case GErrNonDigitInConstValue:
+		return "non-digit-in-const"
+	case GErrDigitRadixValue:
+		return "digit-radix"
+	case GErrFloatRadixValue:
+		return "float-radix"
+	case GErrFloatMalformedValue:
+		return "float-malformed"
+	}
+	return ""
+}
+
+// ErrorTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ErrorTypeFromString(s string) (ErrorType, bool) {
+	switch s {
+	case "G_ERR_UNKNOWN", "unknown":
+		return GErrUnknownValue, true
+	case "G_ERR_UNEXP_EOF", "unexp-eof":
+		return GErrUnexpEofValue, true
+	case "G_ERR_UNEXP_EOF_IN_STRING", "unexp-eof-in-string":
+		return GErrUnexpEofInStringValue, true
+	case "G_ERR_UNEXP_EOF_IN_COMMENT", "unexp-eof-in-comment":
+		return GErrUnexpEofInCommentValue, true
+	case "G_ERR_NON_DIGIT_IN_CONST", "non-digit-in-const":
+		return GErrNonDigitInConstValue, true
+	case "G_ERR_DIGIT_RADIX", "digit-radix":
+		return GErrDigitRadixValue, true
+	case "G_ERR_FLOAT_RADIX", "float-radix":
+		return GErrFloatRadixValue, true
+	case "G_ERR_FLOAT_MALFORMED", "float-malformed":
+		return GErrFloatMalformedValue, true
+	}
+	return 0, false
+}
+
 // The possible types of token returned from each
-// g_scanner_get_next_token() call.
+// [Scanner.GetNextToken] call.
 type TokenType int
 
 const (
@@ -467,37 +541,196 @@ const (
 	GTokenCommentMultiValue TokenType = 269
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TokenType) String() string {
+	switch x {
+	case GTokenEofValue:
+		return "G_TOKEN_EOF"
+	case GTokenLeftParenValue:
+		return "G_TOKEN_LEFT_PAREN"
+	case GTokenRightParenValue:
+		return "G_TOKEN_RIGHT_PAREN"
+	case GTokenLeftCurlyValue:
+		return "G_TOKEN_LEFT_CURLY"
+	case GTokenRightCurlyValue:
+		return "G_TOKEN_RIGHT_CURLY"
+	case GTokenLeftBraceValue:
+		return "G_TOKEN_LEFT_BRACE"
+	case GTokenRightBraceValue:
+		return "G_TOKEN_RIGHT_BRACE"
+	case GTokenEqualSignValue:
+		return "G_TOKEN_EQUAL_SIGN"
+	case GTokenCommaValue:
+		return "G_TOKEN_COMMA"
+	case GTokenNoneValue:
+		return "G_TOKEN_NONE"
+	case GTokenErrorValue:
+		return "G_TOKEN_ERROR"
+	case GTokenCharValue:
+		return "G_TOKEN_CHAR"
+	case GTokenBinaryValue:
+		return "G_TOKEN_BINARY"
+	case GTokenOctalValue:
+		return "G_TOKEN_OCTAL"
+	case GTokenIntValue:
+		return "G_TOKEN_INT"
+	case GTokenHexValue:
+		return "G_TOKEN_HEX"
+	case GTokenFloatValue:
+		return "G_TOKEN_FLOAT"
+	case GTokenStringValue:
+		return "G_TOKEN_STRING"
+	case GTokenSymbolValue:
+		return "G_TOKEN_SYMBOL"
+	case GTokenIdentifierValue:
+		return "G_TOKEN_IDENTIFIER"
+	case GTokenIdentifierNullValue:
+		return "G_TOKEN_IDENTIFIER_NULL"
+	case GTokenCommentSingleValue:
+		return "G_TOKEN_COMMENT_SINGLE"
+	case GTokenCommentMultiValue:
+		return "G_TOKEN_COMMENT_MULTI"
+	}
+	return fmt.Sprintf("TokenType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TokenType) Nick() string {
+	switch x {
+	case GTokenEofValue:
+		return "eof"
+	case GTokenLeftParenValue:
+		return "left-paren"
+	case GTokenRightParenValue:
+		return "right-paren"
+	case GTokenLeftCurlyValue:
+		return "left-curly"
+	case GTokenRightCurlyValue:
+		return "right-curly"
+	case GTokenLeftBraceValue:
+		return "left-brace"
+	case GTokenRightBraceValue:
+		return "right-brace"
+	case GTokenEqualSignValue:
+		return "equal-sign"
+	case GTokenCommaValue:
+		return "comma"
+	case GTokenNoneValue:
+		return "none"
+	case GTokenErrorValue:
+		return "error"
+	case GTokenCharValue:
+		return "char"
+	case GTokenBinaryValue:
+		return "binary"
+	case GTokenOctalValue:
+		return "octal"
+	case GTokenIntValue:
+		return "int"
+	case GTokenHexValue:
+		return "hex"
+	case GTokenFloatValue:
+		return "float"
+	case GTokenStringValue:
+		return "string"
+	case GTokenSymbolValue:
+		return "symbol"
+	case GTokenIdentifierValue:
+		return "identifier"
+	case GTokenIdentifierNullValue:
+		return "identifier-null"
+	case GTokenCommentSingleValue:
+		return "comment-single"
+	case GTokenCommentMultiValue:
+		return "comment-multi"
+	}
+	return ""
+}
+
+// TokenTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TokenTypeFromString(s string) (TokenType, bool) {
+	switch s {
+	case "G_TOKEN_EOF", "eof":
+		return GTokenEofValue, true
+	case "G_TOKEN_LEFT_PAREN", "left-paren":
+		return GTokenLeftParenValue, true
+	case "G_TOKEN_RIGHT_PAREN", "right-paren":
+		return GTokenRightParenValue, true
+	case "G_TOKEN_LEFT_CURLY", "left-curly":
+		return GTokenLeftCurlyValue, true
+	case "G_TOKEN_RIGHT_CURLY", "right-curly":
+		return GTokenRightCurlyValue, true
+	case "G_TOKEN_LEFT_BRACE", "left-brace":
+		return GTokenLeftBraceValue, true
+	case "G_TOKEN_RIGHT_BRACE", "right-brace":
+		return GTokenRightBraceValue, true
+	case "G_TOKEN_EQUAL_SIGN", "equal-sign":
+		return GTokenEqualSignValue, true
+	case "G_TOKEN_COMMA", "comma":
+		return GTokenCommaValue, true
+	case "G_TOKEN_NONE", "none":
+		return GTokenNoneValue, true
+	case "G_TOKEN_ERROR", "error":
+		return GTokenErrorValue, true
+	case "G_TOKEN_CHAR", "char":
+		return GTokenCharValue, true
+	case "G_TOKEN_BINARY", "binary":
+		return GTokenBinaryValue, true
+	case "G_TOKEN_OCTAL", "octal":
+		return GTokenOctalValue, true
+	case "G_TOKEN_INT", "int":
+		return GTokenIntValue, true
+	case "G_TOKEN_HEX", "hex":
+		return GTokenHexValue, true
+	case "G_TOKEN_FLOAT", "float":
+		return GTokenFloatValue, true
+	case "G_TOKEN_STRING", "string":
+		return GTokenStringValue, true
+	case "G_TOKEN_SYMBOL", "symbol":
+		return GTokenSymbolValue, true
+	case "G_TOKEN_IDENTIFIER", "identifier":
+		return GTokenIdentifierValue, true
+	case "G_TOKEN_IDENTIFIER_NULL", "identifier-null":
+		return GTokenIdentifierNullValue, true
+	case "G_TOKEN_COMMENT_SINGLE", "comment-single":
+		return GTokenCommentSingleValue, true
+	case "G_TOKEN_COMMENT_MULTI", "comment-multi":
+		return GTokenCommentMultiValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xScannerCurLine, libs, "g_scanner_cur_line")
-	core.PuregoSafeRegister(&xScannerCurPosition, libs, "g_scanner_cur_position")
-	core.PuregoSafeRegister(&xScannerCurToken, libs, "g_scanner_cur_token")
-	core.PuregoSafeRegister(&xScannerCurValue, libs, "g_scanner_cur_value")
-	core.PuregoSafeRegister(&xScannerDestroy, libs, "g_scanner_destroy")
-	core.PuregoSafeRegister(&xScannerEof, libs, "g_scanner_eof")
-	core.PuregoSafeRegister(&xScannerError, libs, "g_scanner_error")
-	core.PuregoSafeRegister(&xScannerGetNextToken, libs, "g_scanner_get_next_token")
-	core.PuregoSafeRegister(&xScannerInputFile, libs, "g_scanner_input_file")
-	core.PuregoSafeRegister(&xScannerInputText, libs, "g_scanner_input_text")
-	core.PuregoSafeRegister(&xScannerLookupSymbol, libs, "g_scanner_lookup_symbol")
-	core.PuregoSafeRegister(&xScannerPeekNextToken, libs, "g_scanner_peek_next_token")
-	core.PuregoSafeRegister(&xScannerScopeAddSymbol, libs, "g_scanner_scope_add_symbol")
-	core.PuregoSafeRegister(&xScannerScopeForeachSymbol, libs, "g_scanner_scope_foreach_symbol")
-	core.PuregoSafeRegister(&xScannerScopeLookupSymbol, libs, "g_scanner_scope_lookup_symbol")
-	core.PuregoSafeRegister(&xScannerScopeRemoveSymbol, libs, "g_scanner_scope_remove_symbol")
-	core.PuregoSafeRegister(&xScannerSetScope, libs, "g_scanner_set_scope")
-	core.PuregoSafeRegister(&xScannerSyncFileOffset, libs, "g_scanner_sync_file_offset")
-	core.PuregoSafeRegister(&xScannerUnexpToken, libs, "g_scanner_unexp_token")
-	core.PuregoSafeRegister(&xScannerWarn, libs, "g_scanner_warn")
+	core.PuregoRegisterSince(&xScannerCurLine, libs, "g_scanner_cur_line", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerCurPosition, libs, "g_scanner_cur_position", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerCurToken, libs, "g_scanner_cur_token", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerCurValue, libs, "g_scanner_cur_value", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerDestroy, libs, "g_scanner_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerEof, libs, "g_scanner_eof", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerError, libs, "g_scanner_error", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerGetNextToken, libs, "g_scanner_get_next_token", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerInputFile, libs, "g_scanner_input_file", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerInputText, libs, "g_scanner_input_text", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerLookupSymbol, libs, "g_scanner_lookup_symbol", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerPeekNextToken, libs, "g_scanner_peek_next_token", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerScopeAddSymbol, libs, "g_scanner_scope_add_symbol", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerScopeForeachSymbol, libs, "g_scanner_scope_foreach_symbol", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerScopeLookupSymbol, libs, "g_scanner_scope_lookup_symbol", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerScopeRemoveSymbol, libs, "g_scanner_scope_remove_symbol", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerSetScope, libs, "g_scanner_set_scope", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerSyncFileOffset, libs, "g_scanner_sync_file_offset", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerUnexpToken, libs, "g_scanner_unexp_token", "GLIB", "")
+	core.PuregoRegisterSince(&xScannerWarn, libs, "g_scanner_warn", "GLIB", "")
 
 }
@@ -5,17 +5,16 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// A #GSequenceIterCompareFunc is a function used to compare iterators.
+// A [SequenceIterCompareFunc] is a function used to compare iterators.
 // It must return zero if the iterators compare equal, a negative value
-// if @a comes before @b, and a positive value if @b comes before @a.
+// if a comes before b, and a positive value if b comes before a.
 type SequenceIterCompareFunc func(*SequenceIter, *SequenceIter, uintptr) int
 
-// The #GSequence struct is an opaque data type representing a
-// [sequence](data-structures.html#scalable-lists) data type.
+// The [Sequence] struct is an opaque data type representing a
+// sequence data type.
 type Sequence struct {
 	_ structs.HostLayout
 }
@@ -26,7 +25,7 @@ func (x *Sequence) GoPointer() uintptr {
 
 var xSequenceAppend func(uintptr, uintptr) *SequenceIter
 
-// Adds a new item to the end of @seq.
+// Adds a new item to the end of seq.
 func (x *Sequence) Append(DataVar uintptr) *SequenceIter {
 
 	cret := xSequenceAppend(x.GoPointer(), DataVar)
@@ -35,8 +34,8 @@ func (x *Sequence) Append(DataVar uintptr) *SequenceIter {
 
 var xSequenceForeach func(uintptr, uintptr, uintptr)
 
-// Calls @func for each item in the sequence passing @user_data
-// to the function. @func must not modify the sequence itself.
+// Calls func for each item in the sequence passing user_data
+// to the function. func must not modify the sequence itself.
 func (x *Sequence) Foreach(FuncVar *Func, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -46,11 +45,12 @@ func (x *Sequence) Foreach(FuncVar *Func, UserDataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -60,9 +60,9 @@ func (x *Sequence) Foreach(FuncVar *Func, UserDataVar uintptr) {
 
 var xSequenceFree func(uintptr)
 
-// Frees the memory allocated for @seq. If @seq has a data destroy
+// Frees the memory allocated for seq. If seq has a data destroy
 // function associated with it, that function is called on all items
-// in @seq.
+// in seq.
 func (x *Sequence) Free() {
 
 	xSequenceFree(x.GoPointer())
@@ -71,7 +71,7 @@ func (x *Sequence) Free() {
 
 var xSequenceGetBeginIter func(uintptr) *SequenceIter
 
-// Returns the begin iterator for @seq.
+// Returns the begin iterator for seq.
 func (x *Sequence) GetBeginIter() *SequenceIter {
 
 	cret := xSequenceGetBeginIter(x.GoPointer())
@@ -80,7 +80,7 @@ func (x *Sequence) GetBeginIter() *SequenceIter {
 
 var xSequenceGetEndIter func(uintptr) *SequenceIter
 
-// Returns the end iterator for @seg
+// Returns the end iterator for seg
 func (x *Sequence) GetEndIter() *SequenceIter {
 
 	cret := xSequenceGetEndIter(x.GoPointer())
@@ -89,8 +89,8 @@ func (x *Sequence) GetEndIter() *SequenceIter {
 
 var xSequenceGetIterAtPos func(uintptr, int) *SequenceIter
 
-// Returns the iterator at position @pos. If @pos is negative or larger
-// than the number of items in @seq, the end iterator is returned.
+// Returns the iterator at position pos. If pos is negative or larger
+// than the number of items in seq, the end iterator is returned.
 func (x *Sequence) GetIterAtPos(PosVar int) *SequenceIter {
 
 	cret := xSequenceGetIterAtPos(x.GoPointer(), PosVar)
@@ -99,9 +99,9 @@ func (x *Sequence) GetIterAtPos(PosVar int) *SequenceIter {
 
 var xSequenceGetLength func(uintptr) int
 
-// Returns the positive length (&gt;= 0) of @seq. Note that this method is
+// Returns the positive length (>= 0) of seq. Note that this method is
 // O(h) where `h' is the height of the tree. It is thus more efficient
-// to use g_sequence_is_empty() when comparing the length to zero.
+// to use [Sequence.IsEmpty] when comparing the length to zero.
 func (x *Sequence) GetLength() int {
 
 	cret := xSequenceGetLength(x.GoPointer())
@@ -110,18 +110,18 @@ func (x *Sequence) GetLength() int {
 
 var xSequenceInsertSorted func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
-// Inserts @data into @seq using @cmp_func to determine the new
-// position. The sequence must already be sorted according to @cmp_func;
-// otherwise the new position of @data is undefined.
+// Inserts data into seq using cmp_func to determine the new
+// position. The sequence must already be sorted according to cmp_func;
+// otherwise the new position of data is undefined.
 //
-// @cmp_func is called with two items of the @seq, and @cmp_data.
+// cmp_func is called with two items of the seq, and cmp_data.
 // It should return 0 if the items are equal, a negative value
 // if the first item comes before the second, and a positive value
 // if the second item comes before the first.
 //
-// Note that when adding a large amount of data to a #GSequence,
+// Note that when adding a large amount of data to a [Sequence],
 // it is more efficient to do unsorted insertions and then call
-// g_sequence_sort() or g_sequence_sort_iter().
+// [Sequence.Sort] or [Sequence.SortIter].
 func (x *Sequence) InsertSorted(DataVar uintptr, CmpFuncVar *CompareDataFunc, CmpDataVar uintptr) *SequenceIter {
 
 	var CmpFuncVarRef uintptr
@@ -131,11 +131,12 @@ func (x *Sequence) InsertSorted(DataVar uintptr, CmpFuncVar *CompareDataFunc, Cm
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -145,18 +146,18 @@ func (x *Sequence) InsertSorted(DataVar uintptr, CmpFuncVar *CompareDataFunc, Cm
 
 var xSequenceInsertSortedIter func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
-// Like g_sequence_insert_sorted(), but uses
-// a #GSequenceIterCompareFunc instead of a #GCompareDataFunc as
+// Like [Sequence.InsertSorted], but uses
+// a [SequenceIterCompareFunc] instead of a [CompareDataFunc] as
 // the compare function.
 //
-// @iter_cmp is called with two iterators pointing into @seq.
+// iter_cmp is called with two iterators pointing into seq.
 // It should return 0 if the iterators are equal, a negative
 // value if the first iterator comes before the second, and a
 // positive value if the second iterator comes before the first.
 //
-// Note that when adding a large amount of data to a #GSequence,
+// Note that when adding a large amount of data to a [Sequence],
 // it is more efficient to do unsorted insertions and then call
-// g_sequence_sort() or g_sequence_sort_iter().
+// [Sequence.Sort] or [Sequence.SortIter].
 func (x *Sequence) InsertSortedIter(DataVar uintptr, IterCmpVar *SequenceIterCompareFunc, CmpDataVar uintptr) *SequenceIter {
 
 	var IterCmpVarRef uintptr
@@ -166,11 +167,12 @@ func (x *Sequence) InsertSortedIter(DataVar uintptr, IterCmpVar *SequenceIterCom
 			IterCmpVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SequenceIter, arg1 *SequenceIter, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *IterCmpVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			IterCmpVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
+			IterCmpVarRef = core.NewCallback(fcb)
+			IterCmpVarRef = SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
 		}
 	}
 
@@ -180,10 +182,10 @@ func (x *Sequence) InsertSortedIter(DataVar uintptr, IterCmpVar *SequenceIterCom
 
 var xSequenceIsEmpty func(uintptr) bool
 
-// Returns %TRUE if the sequence contains zero items.
+// Returns true if the sequence contains zero items.
 //
 // This function is functionally identical to checking the result of
-// g_sequence_get_length() being equal to zero. However this function is
+// [Sequence.GetLength] being equal to zero. However this function is
 // implemented in O(1) running time.
 func (x *Sequence) IsEmpty() bool {
 
@@ -194,12 +196,12 @@ func (x *Sequence) IsEmpty() bool {
 var xSequenceLookup func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
 // Returns an iterator pointing to the position of the first item found
-// equal to @data according to @cmp_func and @cmp_data. If more than one
+// equal to data according to cmp_func and cmp_data. If more than one
 // item is equal, it is not guaranteed that it is the first which is
-// returned. In that case, you can use g_sequence_iter_next() and
-// g_sequence_iter_prev() to get others.
+// returned. In that case, you can use [SequenceIter.Next] and
+// [SequenceIter.Prev] to get others.
 //
-// @cmp_func is called with two items of the @seq, and @cmp_data.
+// cmp_func is called with two items of the seq, and cmp_data.
 // It should return 0 if the items are equal, a negative value if
 // the first item comes before the second, and a positive value if
 // the second item comes before the first.
@@ -215,11 +217,12 @@ func (x *Sequence) Lookup(DataVar uintptr, CmpFuncVar *CompareDataFunc, CmpDataV
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -229,10 +232,10 @@ func (x *Sequence) Lookup(DataVar uintptr, CmpFuncVar *CompareDataFunc, CmpDataV
 
 var xSequenceLookupIter func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
-// Like g_sequence_lookup(), but uses a #GSequenceIterCompareFunc
-// instead of a #GCompareDataFunc as the compare function.
+// Like [Sequence.Lookup], but uses a [SequenceIterCompareFunc]
+// instead of a [CompareDataFunc] as the compare function.
 //
-// @iter_cmp is called with two iterators pointing into @seq.
+// iter_cmp is called with two iterators pointing into seq.
 // It should return 0 if the iterators are equal, a negative value
 // if the first iterator comes before the second, and a positive
 // value if the second iterator comes before the first.
@@ -248,11 +251,12 @@ func (x *Sequence) LookupIter(DataVar uintptr, IterCmpVar *SequenceIterCompareFu
 			IterCmpVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SequenceIter, arg1 *SequenceIter, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *IterCmpVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			IterCmpVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
+			IterCmpVarRef = core.NewCallback(fcb)
+			IterCmpVarRef = SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
 		}
 	}
 
@@ -262,7 +266,7 @@ func (x *Sequence) LookupIter(DataVar uintptr, IterCmpVar *SequenceIterCompareFu
 
 var xSequencePrepend func(uintptr, uintptr) *SequenceIter
 
-// Adds a new item to the front of @seq
+// Adds a new item to the front of seq
 func (x *Sequence) Prepend(DataVar uintptr) *SequenceIter {
 
 	cret := xSequencePrepend(x.GoPointer(), DataVar)
@@ -271,16 +275,16 @@ func (x *Sequence) Prepend(DataVar uintptr) *SequenceIter {
 
 var xSequenceSearch func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
-// Returns an iterator pointing to the position where @data would
-// be inserted according to @cmp_func and @cmp_data.
+// Returns an iterator pointing to the position where data would
+// be inserted according to cmp_func and cmp_data.
 //
-// @cmp_func is called with two items of the @seq, and @cmp_data.
+// cmp_func is called with two items of the seq, and cmp_data.
 // It should return 0 if the items are equal, a negative value if
 // the first item comes before the second, and a positive value if
 // the second item comes before the first.
 //
 // If you are simply searching for an existing element of the sequence,
-// consider using g_sequence_lookup().
+// consider using [Sequence.Lookup].
 //
 // This function will fail if the data contained in the sequence is
 // unsorted.
@@ -293,11 +297,12 @@ func (x *Sequence) Search(DataVar uintptr, CmpFuncVar *CompareDataFunc, CmpDataV
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -307,16 +312,16 @@ func (x *Sequence) Search(DataVar uintptr, CmpFuncVar *CompareDataFunc, CmpDataV
 
 var xSequenceSearchIter func(uintptr, uintptr, uintptr, uintptr) *SequenceIter
 
-// Like g_sequence_search(), but uses a #GSequenceIterCompareFunc
-// instead of a #GCompareDataFunc as the compare function.
+// Like [Sequence.Search], but uses a [SequenceIterCompareFunc]
+// instead of a [CompareDataFunc] as the compare function.
 //
-// @iter_cmp is called with two iterators pointing into @seq.
+// iter_cmp is called with two iterators pointing into seq.
 // It should return 0 if the iterators are equal, a negative value
 // if the first iterator comes before the second, and a positive
 // value if the second iterator comes before the first.
 //
 // If you are simply searching for an existing element of the sequence,
-// consider using g_sequence_lookup_iter().
+// consider using [Sequence.LookupIter].
 //
 // This function will fail if the data contained in the sequence is
 // unsorted.
@@ -329,11 +334,12 @@ func (x *Sequence) SearchIter(DataVar uintptr, IterCmpVar *SequenceIterCompareFu
 			IterCmpVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SequenceIter, arg1 *SequenceIter, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *IterCmpVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			IterCmpVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
+			IterCmpVarRef = core.NewCallback(fcb)
+			IterCmpVarRef = SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
 		}
 	}
 
@@ -343,9 +349,9 @@ func (x *Sequence) SearchIter(DataVar uintptr, IterCmpVar *SequenceIterCompareFu
 
 var xSequenceSort func(uintptr, uintptr, uintptr)
 
-// Sorts @seq using @cmp_func.
+// Sorts seq using cmp_func.
 //
-// @cmp_func is passed two items of @seq and should
+// cmp_func is passed two items of seq and should
 // return 0 if they are equal, a negative value if the
 // first comes before the second, and a positive value
 // if the second comes before the first.
@@ -358,11 +364,12 @@ func (x *Sequence) Sort(CmpFuncVar *CompareDataFunc, CmpDataVar uintptr) {
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -372,10 +379,10 @@ func (x *Sequence) Sort(CmpFuncVar *CompareDataFunc, CmpDataVar uintptr) {
 
 var xSequenceSortIter func(uintptr, uintptr, uintptr)
 
-// Like g_sequence_sort(), but uses a #GSequenceIterCompareFunc instead
-// of a #GCompareDataFunc as the compare function
+// Like [Sequence.Sort], but uses a [SequenceIterCompareFunc] instead
+// of a [CompareDataFunc] as the compare function
 //
-// @cmp_func is called with two iterators pointing into @seq. It should
+// cmp_func is called with two iterators pointing into seq. It should
 // return 0 if the iterators are equal, a negative value if the first
 // iterator comes before the second, and a positive value if the second
 // iterator comes before the first.
@@ -388,11 +395,12 @@ func (x *Sequence) SortIter(CmpFuncVar *SequenceIterCompareFunc, CmpDataVar uint
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SequenceIter, arg1 *SequenceIter, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -400,8 +408,8 @@ func (x *Sequence) SortIter(CmpFuncVar *SequenceIterCompareFunc, CmpDataVar uint
 
 }
 
-// The #GSequenceIter struct is an opaque data type representing an
-// iterator pointing into a #GSequence.
+// The [SequenceIter] struct is an opaque data type representing an
+// iterator pointing into a [Sequence].
 type SequenceIter struct {
 	_ structs.HostLayout
 }
@@ -412,10 +420,10 @@ func (x *SequenceIter) GoPointer() uintptr {
 
 var xSequenceIterCompare func(uintptr, *SequenceIter) int
 
-// Returns a negative number if @a comes before @b, 0 if they are equal,
-// and a positive number if @a comes after @b.
+// Returns a negative number if a comes before b, 0 if they are equal,
+// and a positive number if a comes after b.
 //
-// The @a and @b iterators must point into the same sequence.
+// The a and b iterators must point into the same sequence.
 func (x *SequenceIter) Compare(BVar *SequenceIter) int {
 
 	cret := xSequenceIterCompare(x.GoPointer(), BVar)
@@ -424,7 +432,7 @@ func (x *SequenceIter) Compare(BVar *SequenceIter) int {
 
 var xSequenceIterGetPosition func(uintptr) int
 
-// Returns the position of @iter
+// Returns the position of iter
 func (x *SequenceIter) GetPosition() int {
 
 	cret := xSequenceIterGetPosition(x.GoPointer())
@@ -433,7 +441,7 @@ func (x *SequenceIter) GetPosition() int {
 
 var xSequenceIterGetSequence func(uintptr) *Sequence
 
-// Returns the #GSequence that @iter points into.
+// Returns the [Sequence] that iter points into.
 func (x *SequenceIter) GetSequence() *Sequence {
 
 	cret := xSequenceIterGetSequence(x.GoPointer())
@@ -442,7 +450,7 @@ func (x *SequenceIter) GetSequence() *Sequence {
 
 var xSequenceIterIsBegin func(uintptr) bool
 
-// Returns whether @iter is the begin iterator
+// Returns whether iter is the begin iterator
 func (x *SequenceIter) IsBegin() bool {
 
 	cret := xSequenceIterIsBegin(x.GoPointer())
@@ -451,7 +459,7 @@ func (x *SequenceIter) IsBegin() bool {
 
 var xSequenceIterIsEnd func(uintptr) bool
 
-// Returns whether @iter is the end iterator
+// Returns whether iter is the end iterator
 func (x *SequenceIter) IsEnd() bool {
 
 	cret := xSequenceIterIsEnd(x.GoPointer())
@@ -460,9 +468,9 @@ func (x *SequenceIter) IsEnd() bool {
 
 var xSequenceIterMove func(uintptr, int) *SequenceIter
 
-// Returns the #GSequenceIter which is @delta positions away from @iter.
-// If @iter is closer than -@delta positions to the beginning of the sequence,
-// the begin iterator is returned. If @iter is closer than @delta positions
+// Returns the [SequenceIter] which is delta positions away from iter.
+// If iter is closer than -delta positions to the beginning of the sequence,
+// the begin iterator is returned. If iter is closer than delta positions
 // to the end of the sequence, the end iterator is returned.
 func (x *SequenceIter) Move(DeltaVar int) *SequenceIter {
 
@@ -472,8 +480,8 @@ func (x *SequenceIter) Move(DeltaVar int) *SequenceIter {
 
 var xSequenceIterNext func(uintptr) *SequenceIter
 
-// Returns an iterator pointing to the next position after @iter.
-// If @iter is the end iterator, the end iterator is returned.
+// Returns an iterator pointing to the next position after iter.
+// If iter is the end iterator, the end iterator is returned.
 func (x *SequenceIter) Next() *SequenceIter {
 
 	cret := xSequenceIterNext(x.GoPointer())
@@ -482,8 +490,8 @@ func (x *SequenceIter) Next() *SequenceIter {
 
 var xSequenceIterPrev func(uintptr) *SequenceIter
 
-// Returns an iterator pointing to the previous position before @iter.
-// If @iter is the begin iterator, the begin iterator is returned.
+// Returns an iterator pointing to the previous position before iter.
+// If iter is the begin iterator, the begin iterator is returned.
 func (x *SequenceIter) Prev() *SequenceIter {
 
 	cret := xSequenceIterPrev(x.GoPointer())
@@ -492,8 +500,8 @@ func (x *SequenceIter) Prev() *SequenceIter {
 
 var xSequenceForeachRange func(*SequenceIter, *SequenceIter, uintptr, uintptr)
 
-// Calls @func for each item in the range (@begin, @end) passing
-// @user_data to the function. @func must not modify the sequence
+// Calls func for each item in the range (begin, end) passing
+// user_data to the function. func must not modify the sequence
 // itself.
 func SequenceForeachRange(BeginVar *SequenceIter, EndVar *SequenceIter, FuncVar *Func, UserDataVar uintptr) {
 
@@ -504,11 +512,12 @@ func SequenceForeachRange(BeginVar *SequenceIter, EndVar *SequenceIter, FuncVar
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -518,7 +527,7 @@ func SequenceForeachRange(BeginVar *SequenceIter, EndVar *SequenceIter, FuncVar
 
 var xSequenceGet func(*SequenceIter) uintptr
 
-// Returns the data that @iter points to.
+// Returns the data that iter points to.
 func SequenceGet(IterVar *SequenceIter) uintptr {
 
 	cret := xSequenceGet(IterVar)
@@ -528,7 +537,7 @@ func SequenceGet(IterVar *SequenceIter) uintptr {
 
 var xSequenceInsertBefore func(*SequenceIter, uintptr) *SequenceIter
 
-// Inserts a new item just before the item pointed to by @iter.
+// Inserts a new item just before the item pointed to by iter.
 func SequenceInsertBefore(IterVar *SequenceIter, DataVar uintptr) *SequenceIter {
 
 	cret := xSequenceInsertBefore(IterVar, DataVar)
@@ -538,9 +547,9 @@ func SequenceInsertBefore(IterVar *SequenceIter, DataVar uintptr) *SequenceIter
 
 var xSequenceMove func(*SequenceIter, *SequenceIter)
 
-// Moves the item pointed to by @src to the position indicated by @dest.
-// After calling this function @dest will point to the position immediately
-// after @src. It is allowed for @src and @dest to point into different
+// Moves the item pointed to by src to the position indicated by dest.
+// After calling this function dest will point to the position immediately
+// after src. It is allowed for src and dest to point into different
 // sequences.
 func SequenceMove(SrcVar *SequenceIter, DestVar *SequenceIter) {
 
@@ -550,14 +559,14 @@ func SequenceMove(SrcVar *SequenceIter, DestVar *SequenceIter) {
 
 var xSequenceMoveRange func(*SequenceIter, *SequenceIter, *SequenceIter)
 
-// Inserts the (@begin, @end) range at the destination pointed to by @dest.
-// The @begin and @end iters must point into the same sequence. It is
-// allowed for @dest to point to a different sequence than the one pointed
-// into by @begin and @end.
+// Inserts the (begin, end) range at the destination pointed to by dest.
+// The begin and end iters must point into the same sequence. It is
+// allowed for dest to point to a different sequence than the one pointed
+// into by begin and end.
 //
-// If @dest is %NULL, the range indicated by @begin and @end is
-// removed from the sequence. If @dest points to a place within
-// the (@begin, @end) range, the range does not move.
+// If dest is nil, the range indicated by begin and end is
+// removed from the sequence. If dest points to a place within
+// the (begin, end) range, the range does not move.
 func SequenceMoveRange(DestVar *SequenceIter, BeginVar *SequenceIter, EndVar *SequenceIter) {
 
 	xSequenceMoveRange(DestVar, BeginVar, EndVar)
@@ -566,12 +575,12 @@ func SequenceMoveRange(DestVar *SequenceIter, BeginVar *SequenceIter, EndVar *Se
 
 var xSequenceRangeGetMidpoint func(*SequenceIter, *SequenceIter) *SequenceIter
 
-// Finds an iterator somewhere in the range (@begin, @end). This
+// Finds an iterator somewhere in the range (begin, end). This
 // iterator will be close to the middle of the range, but is not
 // guaranteed to be exactly in the middle.
 //
-// The @begin and @end iterators must both point to the same sequence
-// and @begin must come before or be equal to @end in the sequence.
+// The begin and end iterators must both point to the same sequence
+// and begin must come before or be equal to end in the sequence.
 func SequenceRangeGetMidpoint(BeginVar *SequenceIter, EndVar *SequenceIter) *SequenceIter {
 
 	cret := xSequenceRangeGetMidpoint(BeginVar, EndVar)
@@ -581,7 +590,7 @@ func SequenceRangeGetMidpoint(BeginVar *SequenceIter, EndVar *SequenceIter) *Seq
 
 var xSequenceRemove func(*SequenceIter)
 
-// Removes the item pointed to by @iter. It is an error to pass the
+// Removes the item pointed to by iter. It is an error to pass the
 // end iterator to this function.
 //
 // If the sequence has a data destroy function associated with it, this
@@ -594,7 +603,7 @@ func SequenceRemove(IterVar *SequenceIter) {
 
 var xSequenceRemoveRange func(*SequenceIter, *SequenceIter)
 
-// Removes all items in the (@begin, @end) range.
+// Removes all items in the (begin, end) range.
 //
 // If the sequence has a data destroy function associated with it, this
 // function is called on the data for the removed items.
@@ -606,9 +615,9 @@ func SequenceRemoveRange(BeginVar *SequenceIter, EndVar *SequenceIter) {
 
 var xSequenceSet func(*SequenceIter, uintptr)
 
-// Changes the data for the item pointed to by @iter to be @data. If
+// Changes the data for the item pointed to by iter to be data. If
 // the sequence has a data destroy function associated with it, that
-// function is called on the existing data that @iter pointed to.
+// function is called on the existing data that iter pointed to.
 func SequenceSet(IterVar *SequenceIter, DataVar uintptr) {
 
 	xSequenceSet(IterVar, DataVar)
@@ -617,13 +626,13 @@ func SequenceSet(IterVar *SequenceIter, DataVar uintptr) {
 
 var xSequenceSortChanged func(*SequenceIter, uintptr, uintptr)
 
-// Moves the data pointed to by @iter to a new position as indicated by
-// @cmp_func. This
+// Moves the data pointed to by iter to a new position as indicated by
+// cmp_func. This
 // function should be called for items in a sequence already sorted according
-// to @cmp_func whenever some aspect of an item changes so that @cmp_func
+// to cmp_func whenever some aspect of an item changes so that cmp_func
 // may return different values for that item.
 //
-// @cmp_func is called with two items of the @seq, and @cmp_data.
+// cmp_func is called with two items of the seq, and cmp_data.
 // It should return 0 if the items are equal, a negative value if
 // the first item comes before the second, and a positive value if
 // the second item comes before the first.
@@ -636,11 +645,12 @@ func SequenceSortChanged(IterVar *SequenceIter, CmpFuncVar *CompareDataFunc, Cmp
 			CmpFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *CmpFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CmpFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
+			CmpFuncVarRef = core.NewCallback(fcb)
+			CmpFuncVarRef = SaveCallbackWithClosure(CmpFuncVarPtr, CmpFuncVarRef, CmpFuncVar)
 		}
 	}
 
@@ -650,12 +660,12 @@ func SequenceSortChanged(IterVar *SequenceIter, CmpFuncVar *CompareDataFunc, Cmp
 
 var xSequenceSortChangedIter func(*SequenceIter, uintptr, uintptr)
 
-// Like g_sequence_sort_changed(), but uses
-// a #GSequenceIterCompareFunc instead of a #GCompareDataFunc as
+// Like [SequenceSortChanged], but uses
+// a [SequenceIterCompareFunc] instead of a [CompareDataFunc] as
 // the compare function.
 //
-// @iter_cmp is called with two iterators pointing into the #GSequence that
-// @iter points into. It should
+// iter_cmp is called with two iterators pointing into the [Sequence] that
+// iter points into. It should
 // return 0 if the iterators are equal, a negative value if the first
 // iterator comes before the second, and a positive value if the second
 // iterator comes before the first.
@@ -668,11 +678,12 @@ func SequenceSortChangedIter(IterVar *SequenceIter, IterCmpVar *SequenceIterComp
 			IterCmpVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SequenceIter, arg1 *SequenceIter, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *IterCmpVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			IterCmpVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
+			IterCmpVarRef = core.NewCallback(fcb)
+			IterCmpVarRef = SaveCallbackWithClosure(IterCmpVarPtr, IterCmpVarRef, IterCmpVar)
 		}
 	}
 
@@ -682,7 +693,7 @@ func SequenceSortChangedIter(IterVar *SequenceIter, IterCmpVar *SequenceIterComp
 
 var xSequenceSwap func(*SequenceIter, *SequenceIter)
 
-// Swaps the items pointed to by @a and @b. It is allowed for @a and @b
+// Swaps the items pointed to by a and b. It is allowed for a and b
 // to point into difference sequences.
 func SequenceSwap(AVar *SequenceIter, BVar *SequenceIter) {
 
@@ -695,51 +706,51 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSequenceForeachRange, libs, "g_sequence_foreach_range")
-	core.PuregoSafeRegister(&xSequenceGet, libs, "g_sequence_get")
-	core.PuregoSafeRegister(&xSequenceInsertBefore, libs, "g_sequence_insert_before")
-	core.PuregoSafeRegister(&xSequenceMove, libs, "g_sequence_move")
-	core.PuregoSafeRegister(&xSequenceMoveRange, libs, "g_sequence_move_range")
-	core.PuregoSafeRegister(&xSequenceRangeGetMidpoint, libs, "g_sequence_range_get_midpoint")
-	core.PuregoSafeRegister(&xSequenceRemove, libs, "g_sequence_remove")
-	core.PuregoSafeRegister(&xSequenceRemoveRange, libs, "g_sequence_remove_range")
-	core.PuregoSafeRegister(&xSequenceSet, libs, "g_sequence_set")
-	core.PuregoSafeRegister(&xSequenceSortChanged, libs, "g_sequence_sort_changed")
-	core.PuregoSafeRegister(&xSequenceSortChangedIter, libs, "g_sequence_sort_changed_iter")
-	core.PuregoSafeRegister(&xSequenceSwap, libs, "g_sequence_swap")
-
-	core.PuregoSafeRegister(&xSequenceAppend, libs, "g_sequence_append")
-	core.PuregoSafeRegister(&xSequenceForeach, libs, "g_sequence_foreach")
-	core.PuregoSafeRegister(&xSequenceFree, libs, "g_sequence_free")
-	core.PuregoSafeRegister(&xSequenceGetBeginIter, libs, "g_sequence_get_begin_iter")
-	core.PuregoSafeRegister(&xSequenceGetEndIter, libs, "g_sequence_get_end_iter")
-	core.PuregoSafeRegister(&xSequenceGetIterAtPos, libs, "g_sequence_get_iter_at_pos")
-	core.PuregoSafeRegister(&xSequenceGetLength, libs, "g_sequence_get_length")
-	core.PuregoSafeRegister(&xSequenceInsertSorted, libs, "g_sequence_insert_sorted")
-	core.PuregoSafeRegister(&xSequenceInsertSortedIter, libs, "g_sequence_insert_sorted_iter")
-	core.PuregoSafeRegister(&xSequenceIsEmpty, libs, "g_sequence_is_empty")
-	core.PuregoSafeRegister(&xSequenceLookup, libs, "g_sequence_lookup")
-	core.PuregoSafeRegister(&xSequenceLookupIter, libs, "g_sequence_lookup_iter")
-	core.PuregoSafeRegister(&xSequencePrepend, libs, "g_sequence_prepend")
-	core.PuregoSafeRegister(&xSequenceSearch, libs, "g_sequence_search")
-	core.PuregoSafeRegister(&xSequenceSearchIter, libs, "g_sequence_search_iter")
-	core.PuregoSafeRegister(&xSequenceSort, libs, "g_sequence_sort")
-	core.PuregoSafeRegister(&xSequenceSortIter, libs, "g_sequence_sort_iter")
-
-	core.PuregoSafeRegister(&xSequenceIterCompare, libs, "g_sequence_iter_compare")
-	core.PuregoSafeRegister(&xSequenceIterGetPosition, libs, "g_sequence_iter_get_position")
-	core.PuregoSafeRegister(&xSequenceIterGetSequence, libs, "g_sequence_iter_get_sequence")
-	core.PuregoSafeRegister(&xSequenceIterIsBegin, libs, "g_sequence_iter_is_begin")
-	core.PuregoSafeRegister(&xSequenceIterIsEnd, libs, "g_sequence_iter_is_end")
-	core.PuregoSafeRegister(&xSequenceIterMove, libs, "g_sequence_iter_move")
-	core.PuregoSafeRegister(&xSequenceIterNext, libs, "g_sequence_iter_next")
-	core.PuregoSafeRegister(&xSequenceIterPrev, libs, "g_sequence_iter_prev")
+	core.PuregoRegisterSince(&xSequenceForeachRange, libs, "g_sequence_foreach_range", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceGet, libs, "g_sequence_get", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceInsertBefore, libs, "g_sequence_insert_before", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceMove, libs, "g_sequence_move", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceMoveRange, libs, "g_sequence_move_range", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceRangeGetMidpoint, libs, "g_sequence_range_get_midpoint", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceRemove, libs, "g_sequence_remove", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceRemoveRange, libs, "g_sequence_remove_range", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSet, libs, "g_sequence_set", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSortChanged, libs, "g_sequence_sort_changed", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSortChangedIter, libs, "g_sequence_sort_changed_iter", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSwap, libs, "g_sequence_swap", "GLIB", "2.14")
+
+	core.PuregoRegisterSince(&xSequenceAppend, libs, "g_sequence_append", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceForeach, libs, "g_sequence_foreach", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceFree, libs, "g_sequence_free", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceGetBeginIter, libs, "g_sequence_get_begin_iter", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceGetEndIter, libs, "g_sequence_get_end_iter", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceGetIterAtPos, libs, "g_sequence_get_iter_at_pos", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceGetLength, libs, "g_sequence_get_length", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceInsertSorted, libs, "g_sequence_insert_sorted", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceInsertSortedIter, libs, "g_sequence_insert_sorted_iter", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIsEmpty, libs, "g_sequence_is_empty", "GLIB", "2.48")
+	core.PuregoRegisterSince(&xSequenceLookup, libs, "g_sequence_lookup", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xSequenceLookupIter, libs, "g_sequence_lookup_iter", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xSequencePrepend, libs, "g_sequence_prepend", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSearch, libs, "g_sequence_search", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSearchIter, libs, "g_sequence_search_iter", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSort, libs, "g_sequence_sort", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceSortIter, libs, "g_sequence_sort_iter", "GLIB", "2.14")
+
+	core.PuregoRegisterSince(&xSequenceIterCompare, libs, "g_sequence_iter_compare", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterGetPosition, libs, "g_sequence_iter_get_position", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterGetSequence, libs, "g_sequence_iter_get_sequence", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterIsBegin, libs, "g_sequence_iter_is_begin", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterIsEnd, libs, "g_sequence_iter_is_end", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterMove, libs, "g_sequence_iter_move", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterNext, libs, "g_sequence_iter_next", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSequenceIterPrev, libs, "g_sequence_iter_prev", "GLIB", "2.14")
 
 }
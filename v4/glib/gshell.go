@@ -2,7 +2,8 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -19,6 +20,63 @@ const (
 	GShellErrorFailedValue ShellError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ShellError) ErrorDomain() string {
+	return "g-shell-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x ShellError) Error() string {
+	switch x {
+	case GShellErrorBadQuotingValue:
+		return "G_SHELL_ERROR_BAD_QUOTING"
+	case GShellErrorEmptyStringValue:
+		return "G_SHELL_ERROR_EMPTY_STRING"
+	case GShellErrorFailedValue:
+		return "G_SHELL_ERROR_FAILED"
+	}
+	return fmt.Sprintf("g-shell-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ShellError) String() string {
+	switch x {
+	case GShellErrorBadQuotingValue:
+		return "G_SHELL_ERROR_BAD_QUOTING"
+	case GShellErrorEmptyStringValue:
+		return "G_SHELL_ERROR_EMPTY_STRING"
+	case GShellErrorFailedValue:
+		return "G_SHELL_ERROR_FAILED"
+	}
+	return fmt.Sprintf("ShellError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ShellError) Nick() string {
+	switch x {
+	case GShellErrorBadQuotingValue:
+		return "bad-quoting"
+	case GShellErrorEmptyStringValue:
+		return "empty-string"
+	case GShellErrorFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// ShellErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ShellErrorFromString(s string) (ShellError, bool) {
+	switch s {
+	case "G_SHELL_ERROR_BAD_QUOTING", "bad-quoting":
+		return GShellErrorBadQuotingValue, true
+	case "G_SHELL_ERROR_EMPTY_STRING", "empty-string":
+		return GShellErrorEmptyStringValue, true
+	case "G_SHELL_ERROR_FAILED", "failed":
+		return GShellErrorFailedValue, true
+	}
+	return 0, false
+}
+
 var xShellParseArgv func(string, *int, *[]string, **Error) bool
 
 // Parses a command line into an argument vector, in much the same way
@@ -31,21 +89,21 @@ var xShellParseArgv func(string, *int, *[]string, **Error) bool
 // unsupported shell expansions. If the input does contain such expansions,
 // they are passed through literally.
 //
-// Possible errors are those from the %G_SHELL_ERROR domain.
+// Possible errors are those from the G_SHELL_ERROR domain.
 //
-// In particular, if @command_line is an empty string (or a string containing
-// only whitespace), %G_SHELL_ERROR_EMPTY_STRING will be returned. It’s
-// guaranteed that @argvp will be a non-empty array if this function returns
+// In particular, if command_line is an empty string (or a string containing
+// only whitespace), [GShellErrorEmptyStringValue] will be returned. It’s
+// guaranteed that argvp will be a non-empty array if this function returns
 // successfully.
 //
-// Free the returned vector with g_strfreev().
+// Free the returned vector with [Strfreev].
 func ShellParseArgv(CommandLineVar string, ArgcpVar *int, ArgvpVar *[]string) (bool, error) {
 	var cerr *Error
 
 	cret := xShellParseArgv(CommandLineVar, ArgcpVar, ArgvpVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -54,12 +112,12 @@ func ShellParseArgv(CommandLineVar string, ArgcpVar *int, ArgvpVar *[]string) (b
 var xShellQuote func(string) string
 
 // Quotes a string so that the shell (/bin/sh) will interpret the
-// quoted string to mean @unquoted_string.
+// quoted string to mean unquoted_string.
 //
 // If you pass a filename to the shell, for example, you should first
 // quote it with this function.
 //
-// The return value must be freed with g_free().
+// The return value must be freed with [Free].
 //
 // The quoting style used is undefined (single or double quotes may be
 // used).
@@ -81,17 +139,17 @@ var xShellUnquote func(string, **Error) string
 // will be passed through literally instead of being expanded).
 //
 // This function is guaranteed to succeed if applied to the result of
-// g_shell_quote(). If it fails, it returns %NULL and sets the
+// [ShellQuote]. If it fails, it returns nil and sets the
 // error.
 //
-// The @quoted_string need not actually contain quoted or escaped text;
-// g_shell_unquote() simply goes through the string and unquotes/unescapes
+// The quoted_string need not actually contain quoted or escaped text;
+// [ShellUnquote] simply goes through the string and unquotes/unescapes
 // anything that the shell would. Both single and double quotes are
 // handled, as are escapes including escaped newlines.
 //
-// The return value must be freed with g_free().
+// The return value must be freed with [Free].
 //
-// Possible errors are in the %G_SHELL_ERROR domain.
+// Possible errors are in the G_SHELL_ERROR domain.
 //
 // Shell quoting rules are a bit strange. Single quotes preserve the
 // literal string exactly. escape sequences are not allowed; not even
@@ -105,7 +163,7 @@ func ShellUnquote(QuotedStringVar string) (string, error) {
 	cret := xShellUnquote(QuotedStringVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -116,15 +174,15 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xShellParseArgv, libs, "g_shell_parse_argv")
-	core.PuregoSafeRegister(&xShellQuote, libs, "g_shell_quote")
-	core.PuregoSafeRegister(&xShellUnquote, libs, "g_shell_unquote")
+	core.PuregoRegisterSince(&xShellParseArgv, libs, "g_shell_parse_argv", "GLIB", "")
+	core.PuregoRegisterSince(&xShellQuote, libs, "g_shell_quote", "GLIB", "")
+	core.PuregoRegisterSince(&xShellUnquote, libs, "g_shell_unquote", "GLIB", "")
 
 }
@@ -2,7 +2,8 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -22,6 +23,63 @@ const (
 	GSliceConfigContentionCounterValue SliceConfig = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SliceConfig) String() string {
+	switch x {
+	case GSliceConfigAlwaysMallocValue:
+		return "G_SLICE_CONFIG_ALWAYS_MALLOC"
+	case GSliceConfigBypassMagazinesValue:
+		return "G_SLICE_CONFIG_BYPASS_MAGAZINES"
+	case GSliceConfigWorkingSetMsecsValue:
+		return "G_SLICE_CONFIG_WORKING_SET_MSECS"
+	case GSliceConfigColorIncrementValue:
+		return "G_SLICE_CONFIG_COLOR_INCREMENT"
+	case GSliceConfigChunkSizesValue:
+		return "G_SLICE_CONFIG_CHUNK_SIZES"
+	case GSliceConfigContentionCounterValue:
+		return "G_SLICE_CONFIG_CONTENTION_COUNTER"
+	}
+	return fmt.Sprintf("SliceConfig(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SliceConfig) Nick() string {
+	switch x {
+	case GSliceConfigAlwaysMallocValue:
+		return "always-malloc"
+	case GSliceConfigBypassMagazinesValue:
+		return "bypass-magazines"
+	case GSliceConfigWorkingSetMsecsValue:
+		return "working-set-msecs"
+	case GSliceConfigColorIncrementValue:
+		return "color-increment"
+	case GSliceConfigChunkSizesValue:
+		return "chunk-sizes"
+	case GSliceConfigContentionCounterValue:
+		return "contention-counter"
+	}
+	return ""
+}
+
+// SliceConfigFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SliceConfigFromString(s string) (SliceConfig, bool) {
+	switch s {
+	case "G_SLICE_CONFIG_ALWAYS_MALLOC", "always-malloc":
+		return GSliceConfigAlwaysMallocValue, true
+	case "G_SLICE_CONFIG_BYPASS_MAGAZINES", "bypass-magazines":
+		return GSliceConfigBypassMagazinesValue, true
+	case "G_SLICE_CONFIG_WORKING_SET_MSECS", "working-set-msecs":
+		return GSliceConfigWorkingSetMsecsValue, true
+	case "G_SLICE_CONFIG_COLOR_INCREMENT", "color-increment":
+		return GSliceConfigColorIncrementValue, true
+	case "G_SLICE_CONFIG_CHUNK_SIZES", "chunk-sizes":
+		return GSliceConfigChunkSizesValue, true
+	case "G_SLICE_CONFIG_CONTENTION_COUNTER", "contention-counter":
+		return GSliceConfigContentionCounterValue, true
+	}
+	return 0, false
+}
+
 var xSliceAlloc func(uint) uintptr
 
 // Allocates a block of memory from the libc allocator.
@@ -40,7 +98,7 @@ func SliceAlloc(BlockSizeVar uint) uintptr {
 
 var xSliceAlloc0 func(uint) uintptr
 
-// Allocates a block of memory via g_slice_alloc() and initializes
+// Allocates a block of memory via [SliceAlloc] and initializes
 // the returned memory to 0.
 //
 // Since GLib 2.76 this always uses the system malloc() implementation
@@ -55,9 +113,9 @@ func SliceAlloc0(BlockSizeVar uint) uintptr {
 var xSliceCopy func(uint, uintptr) uintptr
 
 // Allocates a block of memory from the slice allocator
-// and copies @block_size bytes into it from @mem_block.
+// and copies block_size bytes into it from mem_block.
 //
-// @mem_block must be non-%NULL if @block_size is non-zero.
+// mem_block must be non-nil if block_size is non-zero.
 //
 // Since GLib 2.76 this always uses the system malloc() implementation
 // internally.
@@ -72,13 +130,13 @@ var xSliceFree1 func(uint, uintptr)
 
 // Frees a block of memory.
 //
-// The memory must have been allocated via g_slice_alloc() or
-// g_slice_alloc0() and the @block_size has to match the size
+// The memory must have been allocated via [SliceAlloc] or
+// [SliceAlloc0] and the block_size has to match the size
 // specified upon allocation. Note that the exact release behaviour
-// can be changed with the [`G_DEBUG=gc-friendly`](running.html#environment-variables) environment
+// can be changed with the G_DEBUG=gc-friendly environment
 // variable.
 //
-// If @mem_block is %NULL, this function does nothing.
+// If mem_block is nil, this function does nothing.
 //
 // Since GLib 2.76 this always uses the system free_sized() implementation
 // internally.
@@ -90,16 +148,16 @@ func SliceFree1(BlockSizeVar uint, MemBlockVar uintptr) {
 
 var xSliceFreeChainWithOffset func(uint, uintptr, uint)
 
-// Frees a linked list of memory blocks of structure type @type.
+// Frees a linked list of memory blocks of structure type type.
 //
 // The memory blocks must be equal-sized, allocated via
-// g_slice_alloc() or g_slice_alloc0() and linked together by a
-// @next pointer (similar to #GSList). The offset of the @next
+// [SliceAlloc] or [SliceAlloc0] and linked together by a
+// next pointer (similar to [SList]). The offset of the next
 // field in each block is passed as third argument.
 // Note that the exact release behaviour can be changed with the
-// [`G_DEBUG=gc-friendly`](running.html#environment-variables) environment variable.
+// G_DEBUG=gc-friendly environment variable.
 //
-// If @mem_chain is %NULL, this function does nothing.
+// If mem_chain is nil, this function does nothing.
 //
 // Since GLib 2.76 this always uses the system free_sized() implementation
 // internally.
@@ -140,20 +198,20 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSliceAlloc, libs, "g_slice_alloc")
-	core.PuregoSafeRegister(&xSliceAlloc0, libs, "g_slice_alloc0")
-	core.PuregoSafeRegister(&xSliceCopy, libs, "g_slice_copy")
-	core.PuregoSafeRegister(&xSliceFree1, libs, "g_slice_free1")
-	core.PuregoSafeRegister(&xSliceFreeChainWithOffset, libs, "g_slice_free_chain_with_offset")
-	core.PuregoSafeRegister(&xSliceGetConfig, libs, "g_slice_get_config")
-	core.PuregoSafeRegister(&xSliceGetConfigState, libs, "g_slice_get_config_state")
-	core.PuregoSafeRegister(&xSliceSetConfig, libs, "g_slice_set_config")
+	core.PuregoRegisterSince(&xSliceAlloc, libs, "g_slice_alloc", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xSliceAlloc0, libs, "g_slice_alloc0", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xSliceCopy, libs, "g_slice_copy", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xSliceFree1, libs, "g_slice_free1", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xSliceFreeChainWithOffset, libs, "g_slice_free_chain_with_offset", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xSliceGetConfig, libs, "g_slice_get_config", "GLIB", "")
+	core.PuregoRegisterSince(&xSliceGetConfigState, libs, "g_slice_get_config_state", "GLIB", "")
+	core.PuregoRegisterSince(&xSliceSetConfig, libs, "g_slice_set_config", "GLIB", "")
 
 }
@@ -5,11 +5,10 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// The #GSList struct is used for each element in the singly-linked
+// The [SList] struct is used for each element in the singly-linked
 // list.
 type SList struct {
 	_ structs.HostLayout
@@ -25,9 +24,9 @@ func (x *SList) GoPointer() uintptr {
 
 var xClearSlist func(**SList, uintptr)
 
-// Clears a pointer to a #GSList, freeing it and, optionally, freeing its elements using @destroy.
+// Clears a pointer to a [SList], freeing it and, optionally, freeing its elements using destroy.
 //
-// @slist_ptr must be a valid pointer. If @slist_ptr points to a null #GSList, this does nothing.
+// slist_ptr must be a valid pointer. If slist_ptr points to a null [SList], this does nothing.
 func ClearSlist(SlistPtrVar **SList, DestroyVar *DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -37,11 +36,12 @@ func ClearSlist(SlistPtrVar **SList, DestroyVar *DestroyNotify) {
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -54,13 +54,13 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xClearSlist, libs, "g_clear_slist")
+	core.PuregoRegisterSince(&xClearSlist, libs, "g_clear_slist", "GLIB", "2.64")
 
 }
@@ -2,14 +2,15 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-// Specifies the type of the setup function passed to g_spawn_async(),
-// g_spawn_sync() and g_spawn_async_with_pipes(), which can, in very
+// Specifies the type of the setup function passed to [SpawnAsync],
+// [SpawnSync] and [SpawnAsyncWithPipes], which can, in very
 // limited ways, be used to affect the child's execution.
 //
 // On POSIX platforms, the function is called in the child after GLib
@@ -24,7 +25,7 @@ import (
 // functions.
 //
 // However, even on POSIX, you are extremely limited in what you can
-// safely do from a #GSpawnChildSetupFunc, because any mutexes that were
+// safely do from a [SpawnChildSetupFunc], because any mutexes that were
 // held by other threads in the parent process at the time of the fork()
 // will still be locked in the child process, and they will never be
 // unlocked (since the threads that held them don't exist in the child).
@@ -35,13 +36,13 @@ import (
 // In particular, it is not safe to call any function which may
 // call malloc(), which includes POSIX functions such as setenv().
 // If you need to set up the child environment differently from
-// the parent, you should use g_get_environ(), g_environ_setenv(),
-// and g_environ_unsetenv(), and then pass the complete environment
+// the parent, you should use [GetEnviron], [EnvironSetenv],
+// and [EnvironUnsetenv], and then pass the complete environment
 // list to the `g_spawn...` function.
 type SpawnChildSetupFunc func(uintptr)
 
-// Flags passed to g_spawn_sync(), g_spawn_async() and g_spawn_async_with_pipes().
-type SpawnFlags int
+// Flags passed to [SpawnSync], [SpawnAsync] and [SpawnAsyncWithPipes].
+type SpawnFlags uint32
 
 const (
 
@@ -52,7 +53,7 @@ const (
 	//     stdout and stderr will be closed before calling exec() in the child.
 	GSpawnLeaveDescriptorsOpenValue SpawnFlags = 1
 	// the child will not be automatically reaped;
-	//     you must use g_child_watch_add() yourself (or call waitpid() or handle
+	//     you must use [ChildWatchAdd] yourself (or call waitpid() or handle
 	//     `SIGCHLD` yourself), or the child will become a zombie.
 	GSpawnDoNotReapChildValue SpawnFlags = 2
 	// `argv[0]` need not be an absolute path, it will be
@@ -68,7 +69,7 @@ const (
 	GSpawnChildInheritsStdinValue SpawnFlags = 32
 	// the first element of `argv` is the file to
 	//     execute, while the remaining elements are the actual argument vector
-	//     to pass to the file. Normally g_spawn_async_with_pipes() uses `argv[0]`
+	//     to pass to the file. Normally [SpawnAsyncWithPipes] uses `argv[0]`
 	//     as the file to execute, and passes all of `argv` to the child.
 	GSpawnFileAndArgvZeroValue SpawnFlags = 64
 	// if `argv[0]` is not an absolute path,
@@ -86,6 +87,82 @@ const (
 	GSpawnStdinFromDevNullValue SpawnFlags = 2048
 )
 
+// Has reports whether all bits of flags are set in x
+func (x SpawnFlags) Has(flags SpawnFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SpawnFlags) Set(flags SpawnFlags) SpawnFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SpawnFlags) Clear(flags SpawnFlags) SpawnFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SpawnFlags) String() string {
+	if x == 0 {
+		return "G_SPAWN_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GSpawnLeaveDescriptorsOpenValue != 0 && x&GSpawnLeaveDescriptorsOpenValue == GSpawnLeaveDescriptorsOpenValue {
+		names = append(names, "G_SPAWN_LEAVE_DESCRIPTORS_OPEN")
+		rest &^= GSpawnLeaveDescriptorsOpenValue
+	}
+	if rest&GSpawnDoNotReapChildValue != 0 && x&GSpawnDoNotReapChildValue == GSpawnDoNotReapChildValue {
+		names = append(names, "G_SPAWN_DO_NOT_REAP_CHILD")
+		rest &^= GSpawnDoNotReapChildValue
+	}
+	if rest&GSpawnSearchPathValue != 0 && x&GSpawnSearchPathValue == GSpawnSearchPathValue {
+		names = append(names, "G_SPAWN_SEARCH_PATH")
+		rest &^= GSpawnSearchPathValue
+	}
+	if rest&GSpawnStdoutToDevNullValue != 0 && x&GSpawnStdoutToDevNullValue == GSpawnStdoutToDevNullValue {
+		names = append(names, "G_SPAWN_STDOUT_TO_DEV_NULL")
+		rest &^= GSpawnStdoutToDevNullValue
+	}
+	if rest&GSpawnStderrToDevNullValue != 0 && x&GSpawnStderrToDevNullValue == GSpawnStderrToDevNullValue {
+		names = append(names, "G_SPAWN_STDERR_TO_DEV_NULL")
+		rest &^= GSpawnStderrToDevNullValue
+	}
+	if rest&GSpawnChildInheritsStdinValue != 0 && x&GSpawnChildInheritsStdinValue == GSpawnChildInheritsStdinValue {
+		names = append(names, "G_SPAWN_CHILD_INHERITS_STDIN")
+		rest &^= GSpawnChildInheritsStdinValue
+	}
+	if rest&GSpawnFileAndArgvZeroValue != 0 && x&GSpawnFileAndArgvZeroValue == GSpawnFileAndArgvZeroValue {
+		names = append(names, "G_SPAWN_FILE_AND_ARGV_ZERO")
+		rest &^= GSpawnFileAndArgvZeroValue
+	}
+	if rest&GSpawnSearchPathFromEnvpValue != 0 && x&GSpawnSearchPathFromEnvpValue == GSpawnSearchPathFromEnvpValue {
+		names = append(names, "G_SPAWN_SEARCH_PATH_FROM_ENVP")
+		rest &^= GSpawnSearchPathFromEnvpValue
+	}
+	if rest&GSpawnCloexecPipesValue != 0 && x&GSpawnCloexecPipesValue == GSpawnCloexecPipesValue {
+		names = append(names, "G_SPAWN_CLOEXEC_PIPES")
+		rest &^= GSpawnCloexecPipesValue
+	}
+	if rest&GSpawnChildInheritsStdoutValue != 0 && x&GSpawnChildInheritsStdoutValue == GSpawnChildInheritsStdoutValue {
+		names = append(names, "G_SPAWN_CHILD_INHERITS_STDOUT")
+		rest &^= GSpawnChildInheritsStdoutValue
+	}
+	if rest&GSpawnChildInheritsStderrValue != 0 && x&GSpawnChildInheritsStderrValue == GSpawnChildInheritsStderrValue {
+		names = append(names, "G_SPAWN_CHILD_INHERITS_STDERR")
+		rest &^= GSpawnChildInheritsStderrValue
+	}
+	if rest&GSpawnStdinFromDevNullValue != 0 && x&GSpawnStdinFromDevNullValue == GSpawnStdinFromDevNullValue {
+		names = append(names, "G_SPAWN_STDIN_FROM_DEV_NULL")
+		rest &^= GSpawnStdinFromDevNullValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Error codes returned by spawning processes.
 type SpawnError int
 
@@ -103,7 +180,7 @@ const (
 	GSpawnErrorPermValue SpawnError = 4
 	// execv() returned `E2BIG`
 	GSpawnErrorTooBigValue SpawnError = 5
-	// deprecated alias for %G_SPAWN_ERROR_TOO_BIG (deprecated since GLib 2.32)
+	// deprecated alias for [GSpawnErrorTooBigValue] (deprecated since GLib 2.32)
 	GSpawnError2bigValue SpawnError = 5
 	// execv() returned `ENOEXEC`
 	GSpawnErrorNoexecValue SpawnError = 6
@@ -132,27 +209,222 @@ const (
 	// execv() returned `ELIBBAD`
 	GSpawnErrorLibbadValue SpawnError = 18
 	// Some other fatal failure,
-	//   `error-&gt;message` should explain.
+	//   `error->message` should explain.
 	GSpawnErrorFailedValue SpawnError = 19
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (SpawnError) ErrorDomain() string {
+	return "g-exec-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x SpawnError) Error() string {
+	switch x {
+	case GSpawnErrorForkValue:
+		return "G_SPAWN_ERROR_FORK"
+	case GSpawnErrorReadValue:
+		return "G_SPAWN_ERROR_READ"
+	case GSpawnErrorChdirValue:
+		return "G_SPAWN_ERROR_CHDIR"
+	case GSpawnErrorAccesValue:
+		return "G_SPAWN_ERROR_ACCES"
+	case GSpawnErrorPermValue:
+		return "G_SPAWN_ERROR_PERM"
+	case GSpawnErrorTooBigValue:
+		return "G_SPAWN_ERROR_TOO_BIG"
+	case GSpawnErrorNoexecValue:
+		return "G_SPAWN_ERROR_NOEXEC"
+	case GSpawnErrorNametoolongValue:
+		return "G_SPAWN_ERROR_NAMETOOLONG"
+	case GSpawnErrorNoentValue:
+		return "G_SPAWN_ERROR_NOENT"
+	case GSpawnErrorNomemValue:
+		return "G_SPAWN_ERROR_NOMEM"
+	case GSpawnErrorNotdirValue:
+		return "G_SPAWN_ERROR_NOTDIR"
+	case GSpawnErrorLoopValue:
+		return "G_SPAWN_ERROR_LOOP"
+	case GSpawnErrorTxtbusyValue:
+		return "G_SPAWN_ERROR_TXTBUSY"
+	case GSpawnErrorIoValue:
+		return "G_SPAWN_ERROR_IO"
+	case GSpawnErrorNfileValue:
+		return "G_SPAWN_ERROR_NFILE"
+	case GSpawnErrorMfileValue:
+		return "G_SPAWN_ERROR_MFILE"
+	case GSpawnErrorInvalValue:
+		return "G_SPAWN_ERROR_INVAL"
+	case GSpawnErrorIsdirValue:
+		return "G_SPAWN_ERROR_ISDIR"
+	case GSpawnErrorLibbadValue:
+		return "G_SPAWN_ERROR_LIBBAD"
+	case GSpawnErrorFailedValue:
+		return "G_SPAWN_ERROR_FAILED"
+	}
+	return fmt.Sprintf("g-exec-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x SpawnError) String() string {
+	switch x {
+	case GSpawnErrorForkValue:
+		return "G_SPAWN_ERROR_FORK"
+	case GSpawnErrorReadValue:
+		return "G_SPAWN_ERROR_READ"
+	case GSpawnErrorChdirValue:
+		return "G_SPAWN_ERROR_CHDIR"
+	case GSpawnErrorAccesValue:
+		return "G_SPAWN_ERROR_ACCES"
+	case GSpawnErrorPermValue:
+		return "G_SPAWN_ERROR_PERM"
+	case GSpawnErrorTooBigValue:
+		return "G_SPAWN_ERROR_TOO_BIG"
+	case GSpawnErrorNoexecValue:
+		return "G_SPAWN_ERROR_NOEXEC"
+	case GSpawnErrorNametoolongValue:
+		return "G_SPAWN_ERROR_NAMETOOLONG"
+	case GSpawnErrorNoentValue:
+		return "G_SPAWN_ERROR_NOENT"
+	case GSpawnErrorNomemValue:
+		return "G_SPAWN_ERROR_NOMEM"
+	case GSpawnErrorNotdirValue:
+		return "G_SPAWN_ERROR_NOTDIR"
+	case GSpawnErrorLoopValue:
+		return "G_SPAWN_ERROR_LOOP"
+	case GSpawnErrorTxtbusyValue:
+		return "G_SPAWN_ERROR_TXTBUSY"
+	case GSpawnErrorIoValue:
+		return "G_SPAWN_ERROR_IO"
+	case GSpawnErrorNfileValue:
+		return "G_SPAWN_ERROR_NFILE"
+	case GSpawnErrorMfileValue:
+		return "G_SPAWN_ERROR_MFILE"
+	case GSpawnErrorInvalValue:
+		return "G_SPAWN_ERROR_INVAL"
+	case GSpawnErrorIsdirValue:
+		return "G_SPAWN_ERROR_ISDIR"
+	case GSpawnErrorLibbadValue:
+		return "G_SPAWN_ERROR_LIBBAD"
+	case GSpawnErrorFailedValue:
+		return "G_SPAWN_ERROR_FAILED"
+	}
+	return fmt.Sprintf("SpawnError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SpawnError) Nick() string {
+	switch x {
+	case GSpawnErrorForkValue:
+		return "fork"
+	case GSpawnErrorReadValue:
+		return "read"
+	case GSpawnErrorChdirValue:
+		return "chdir"
+	case GSpawnErrorAccesValue:
+		return "acces"
+	case GSpawnErrorPermValue:
+		return "perm"
+	case GSpawnErrorTooBigValue:
+		return "too-big"
+	case GSpawnErrorNoexecValue:
+		return "noexec"
+	case GSpawnErrorNametoolongValue:
+		return "nametoolong"
+	case GSpawnErrorNoentValue:
+		return "noent"
+	case GSpawnErrorNomemValue:
+		return "nomem"
+	case GSpawnErrorNotdirValue:
+		return "notdir"
+	case GSpawnErrorLoopValue:
+		return "loop"
+	case GSpawnErrorTxtbusyValue:
+		return "txtbusy"
+	case GSpawnErrorIoValue:
+		return "io"
+	case GSpawnErrorNfileValue:
+		return "nfile"
+	case GSpawnErrorMfileValue:
+		return "mfile"
+	case GSpawnErrorInvalValue:
+		return "inval"
+	case GSpawnErrorIsdirValue:
+		return "isdir"
+	case GSpawnErrorLibbadValue:
+		return "libbad"
+	case GSpawnErrorFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// SpawnErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SpawnErrorFromString(s string) (SpawnError, bool) {
+	switch s {
+	case "G_SPAWN_ERROR_FORK", "fork":
+		return GSpawnErrorForkValue, true
+	case "G_SPAWN_ERROR_READ", "read":
+		return GSpawnErrorReadValue, true
+	case "G_SPAWN_ERROR_CHDIR", "chdir":
+		return GSpawnErrorChdirValue, true
+	case "G_SPAWN_ERROR_ACCES", "acces":
+		return GSpawnErrorAccesValue, true
+	case "G_SPAWN_ERROR_PERM", "perm":
+		return GSpawnErrorPermValue, true
+	case "G_SPAWN_ERROR_TOO_BIG", "too-big":
+		return GSpawnErrorTooBigValue, true
+	case "G_SPAWN_ERROR_2BIG", "2big":
+		return GSpawnError2bigValue, true
+	case "G_SPAWN_ERROR_NOEXEC", "noexec":
+		return GSpawnErrorNoexecValue, true
+	case "G_SPAWN_ERROR_NAMETOOLONG", "nametoolong":
+		return GSpawnErrorNametoolongValue, true
+	case "G_SPAWN_ERROR_NOENT", "noent":
+		return GSpawnErrorNoentValue, true
+	case "G_SPAWN_ERROR_NOMEM", "nomem":
+		return GSpawnErrorNomemValue, true
+	case "G_SPAWN_ERROR_NOTDIR", "notdir":
+		return GSpawnErrorNotdirValue, true
+	case "G_SPAWN_ERROR_LOOP", "loop":
+		return GSpawnErrorLoopValue, true
+	case "G_SPAWN_ERROR_TXTBUSY", "txtbusy":
+		return GSpawnErrorTxtbusyValue, true
+	case "G_SPAWN_ERROR_IO", "io":
+		return GSpawnErrorIoValue, true
+	case "G_SPAWN_ERROR_NFILE", "nfile":
+		return GSpawnErrorNfileValue, true
+	case "G_SPAWN_ERROR_MFILE", "mfile":
+		return GSpawnErrorMfileValue, true
+	case "G_SPAWN_ERROR_INVAL", "inval":
+		return GSpawnErrorInvalValue, true
+	case "G_SPAWN_ERROR_ISDIR", "isdir":
+		return GSpawnErrorIsdirValue, true
+	case "G_SPAWN_ERROR_LIBBAD", "libbad":
+		return GSpawnErrorLibbadValue, true
+	case "G_SPAWN_ERROR_FAILED", "failed":
+		return GSpawnErrorFailedValue, true
+	}
+	return 0, false
+}
+
 var xSpawnAsync func(uintptr, []string, []string, SpawnFlags, uintptr, uintptr, *Pid, **Error) bool
 
 // Executes a child program asynchronously.
 //
-// See g_spawn_async_with_pipes_and_fds() for a full description; this function
-// simply calls the g_spawn_async_with_pipes() without any pipes, which in turn
-// calls g_spawn_async_with_pipes_and_fds().
+// See [SpawnAsyncWithPipesAndFds] for a full description; this function
+// simply calls the [SpawnAsyncWithPipes] without any pipes, which in turn
+// calls [SpawnAsyncWithPipesAndFds].
 //
-// You should call g_spawn_close_pid() on the returned child process
+// You should call [SpawnClosePid] on the returned child process
 // reference when you don't need it any more.
 //
 // If you are writing a GTK application, and the program you are spawning is a
 // graphical application too, then to ensure that the spawned program opens its
-// windows on the right screen, you may want to use #GdkAppLaunchContext,
-// #GAppLaunchContext, or set the %DISPLAY environment variable.
+// windows on the right screen, you may want to use [gdk.AppLaunchContext],
+// [gio.AppLaunchContext], or set the DISPLAY environment variable.
 //
-// Note that the returned @child_pid on Windows is a handle to the child
+// Note that the returned child_pid on Windows is a handle to the child
 // process and not its identifier. Process handles and process identifiers
 // are different concepts on Windows.
 func SpawnAsync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string, FlagsVar SpawnFlags, ChildSetupVar *SpawnChildSetupFunc, UserDataVar uintptr, ChildPidVar *Pid) (bool, error) {
@@ -165,11 +437,12 @@ func SpawnAsync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string,
 			ChildSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ChildSetupVar
 				cbFn(arg0)
 			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
+			ChildSetupVarRef = core.NewCallback(fcb)
+			ChildSetupVarRef = SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
 		}
 	}
 
@@ -179,7 +452,7 @@ func SpawnAsync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string,
 	cret := xSpawnAsync(WorkingDirectoryVarPtr, ArgvVar, EnvpVar, FlagsVar, ChildSetupVarRef, UserDataVar, ChildPidVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -189,7 +462,7 @@ var xSpawnAsyncWithFds func(uintptr, []string, []string, SpawnFlags, uintptr, ui
 
 // Executes a child program asynchronously.
 //
-// Identical to g_spawn_async_with_pipes_and_fds() but with `n_fds` set to zero,
+// Identical to [SpawnAsyncWithPipesAndFds] but with `n_fds` set to zero,
 // so no FD assignments are used.
 func SpawnAsyncWithFds(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string, FlagsVar SpawnFlags, ChildSetupVar *SpawnChildSetupFunc, UserDataVar uintptr, ChildPidVar *Pid, StdinFdVar int, StdoutFdVar int, StderrFdVar int) (bool, error) {
 	var cerr *Error
@@ -201,11 +474,12 @@ func SpawnAsyncWithFds(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []
 			ChildSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ChildSetupVar
 				cbFn(arg0)
 			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
+			ChildSetupVarRef = core.NewCallback(fcb)
+			ChildSetupVarRef = SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
 		}
 	}
 
@@ -215,7 +489,7 @@ func SpawnAsyncWithFds(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []
 	cret := xSpawnAsyncWithFds(WorkingDirectoryVarPtr, ArgvVar, EnvpVar, FlagsVar, ChildSetupVarRef, UserDataVar, ChildPidVar, StdinFdVar, StdoutFdVar, StderrFdVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -223,7 +497,7 @@ func SpawnAsyncWithFds(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []
 
 var xSpawnAsyncWithPipes func(uintptr, []string, []string, SpawnFlags, uintptr, uintptr, *Pid, *int, *int, *int, **Error) bool
 
-// Identical to g_spawn_async_with_pipes_and_fds() but with `n_fds` set to zero,
+// Identical to [SpawnAsyncWithPipesAndFds] but with `n_fds` set to zero,
 // so no FD assignments are used.
 func SpawnAsyncWithPipes(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string, FlagsVar SpawnFlags, ChildSetupVar *SpawnChildSetupFunc, UserDataVar uintptr, ChildPidVar *Pid, StandardInputVar *int, StandardOutputVar *int, StandardErrorVar *int) (bool, error) {
 	var cerr *Error
@@ -235,11 +509,12 @@ func SpawnAsyncWithPipes(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar
 			ChildSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ChildSetupVar
 				cbFn(arg0)
 			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
+			ChildSetupVarRef = core.NewCallback(fcb)
+			ChildSetupVarRef = SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
 		}
 	}
 
@@ -249,7 +524,7 @@ func SpawnAsyncWithPipes(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar
 	cret := xSpawnAsyncWithPipes(WorkingDirectoryVarPtr, ArgvVar, EnvpVar, FlagsVar, ChildSetupVarRef, UserDataVar, ChildPidVar, StandardInputVar, StandardOutputVar, StandardErrorVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -261,21 +536,21 @@ var xSpawnAsyncWithPipesAndFds func(uintptr, []string, []string, SpawnFlags, uin
 // block waiting for the child to exit).
 //
 // The child program is specified by the only argument that must be
-// provided, @argv. @argv should be a %NULL-terminated array of strings,
+// provided, argv. argv should be a nil-terminated array of strings,
 // to be passed as the argument vector for the child. The first string
-// in @argv is of course the name of the program to execute. By default,
-// the name of the program must be a full path. If @flags contains the
-// %G_SPAWN_SEARCH_PATH flag, the `PATH` environment variable is used to
-// search for the executable. If @flags contains the
-// %G_SPAWN_SEARCH_PATH_FROM_ENVP flag, the `PATH` variable from @envp
+// in argv is of course the name of the program to execute. By default,
+// the name of the program must be a full path. If flags contains the
+// [GSpawnSearchPathValue] flag, the `PATH` environment variable is used to
+// search for the executable. If flags contains the
+// [GSpawnSearchPathFromEnvpValue] flag, the `PATH` variable from envp
 // is used to search for the executable. If both the
-// %G_SPAWN_SEARCH_PATH and %G_SPAWN_SEARCH_PATH_FROM_ENVP flags are
-// set, the `PATH` variable from @envp takes precedence over the
+// [GSpawnSearchPathValue] and [GSpawnSearchPathFromEnvpValue] flags are
+// set, the `PATH` variable from envp takes precedence over the
 // environment variable.
 //
-// If the program name is not a full path and %G_SPAWN_SEARCH_PATH flag
+// If the program name is not a full path and [GSpawnSearchPathValue] flag
 // is not used, then the program will be run from the current directory
-// (or @working_directory, if specified); this might be unexpected or even
+// (or working_directory, if specified); this might be unexpected or even
 // dangerous in some cases when the current directory is world-writable.
 //
 // On Windows, note that all the string or string vector arguments to
@@ -293,7 +568,7 @@ var xSpawnAsyncWithPipesAndFds func(uintptr, []string, []string, SpawnFlags, uin
 //
 // On Windows the low-level child process creation API `CreateProcess()`
 // doesn't use argument vectors, but a command line. The C runtime
-// library's `spawn*()` family of functions (which g_spawn_async_with_pipes()
+// library's `spawn*()` family of functions (which [SpawnAsyncWithPipes]
 // eventually calls) paste the argument vector elements together into
 // a command line, and the C runtime startup code does a corresponding
 // reconstruction of an argument vector from the command line, to be
@@ -302,133 +577,133 @@ var xSpawnAsyncWithPipesAndFds func(uintptr, []string, []string, SpawnFlags, uin
 // don't do any quoting or escaping, but on the other hand the startup
 // code does do unquoting and unescaping in order to enable receiving
 // arguments with embedded spaces or double quotes. To work around this
-// asymmetry, g_spawn_async_with_pipes() will do quoting and escaping on
+// asymmetry, [SpawnAsyncWithPipes] will do quoting and escaping on
 // argument vector elements that need it before calling the C runtime
 // `spawn()` function.
 //
-// The returned @child_pid on Windows is a handle to the child
+// The returned child_pid on Windows is a handle to the child
 // process, not its identifier. Process handles and process
 // identifiers are different concepts on Windows.
 //
-// @envp is a %NULL-terminated array of strings, where each string
+// envp is a nil-terminated array of strings, where each string
 // has the form `KEY=VALUE`. This will become the child's environment.
-// If @envp is %NULL, the child inherits its parent's environment.
+// If envp is nil, the child inherits its parent's environment.
 //
-// @flags should be the bitwise OR of any flags you want to affect the
-// function's behaviour. The %G_SPAWN_DO_NOT_REAP_CHILD means that the
+// flags should be the bitwise OR of any flags you want to affect the
+// function's behaviour. The [GSpawnDoNotReapChildValue] means that the
 // child will not automatically be reaped; you must use a child watch
-// (g_child_watch_add()) to be notified about the death of the child process,
+// ([ChildWatchAdd]) to be notified about the death of the child process,
 // otherwise it will stay around as a zombie process until this process exits.
-// Eventually you must call g_spawn_close_pid() on the @child_pid, in order to
+// Eventually you must call [SpawnClosePid] on the child_pid, in order to
 // free resources which may be associated with the child process. (On Unix,
 // using a child watch is equivalent to calling waitpid() or handling
-// the `SIGCHLD` signal manually. On Windows, calling g_spawn_close_pid()
+// the `SIGCHLD` signal manually. On Windows, calling [SpawnClosePid]
 // is equivalent to calling `CloseHandle()` on the process handle returned
-// in @child_pid). See g_child_watch_add().
+// in child_pid). See [ChildWatchAdd].
 //
 // Open UNIX file descriptors marked as `FD_CLOEXEC` will be automatically
-// closed in the child process. %G_SPAWN_LEAVE_DESCRIPTORS_OPEN means that
+// closed in the child process. [GSpawnLeaveDescriptorsOpenValue] means that
 // other open file descriptors will be inherited by the child; otherwise all
 // descriptors except stdin/stdout/stderr will be closed before calling `exec()`
-// in the child. %G_SPAWN_SEARCH_PATH means that @argv[0] need not be an
+// in the child. [GSpawnSearchPathValue] means that argv[0] need not be an
 // absolute path, it will be looked for in the `PATH` environment
-// variable. %G_SPAWN_SEARCH_PATH_FROM_ENVP means need not be an
+// variable. [GSpawnSearchPathFromEnvpValue] means need not be an
 // absolute path, it will be looked for in the `PATH` variable from
-// @envp. If both %G_SPAWN_SEARCH_PATH and %G_SPAWN_SEARCH_PATH_FROM_ENVP
-// are used, the value from @envp takes precedence over the environment.
+// envp. If both [GSpawnSearchPathValue] and [GSpawnSearchPathFromEnvpValue]
+// are used, the value from envp takes precedence over the environment.
 //
-// %G_SPAWN_CHILD_INHERITS_STDIN means that the child will inherit the parent's
+// [GSpawnChildInheritsStdinValue] means that the child will inherit the parent's
 // standard input (by default, the child's standard input is attached to
-// `/dev/null`). %G_SPAWN_STDIN_FROM_DEV_NULL explicitly imposes the default
+// `/dev/null`). [GSpawnStdinFromDevNullValue] explicitly imposes the default
 // behavior. Both flags cannot be enabled at the same time and, in both cases,
-// the @stdin_pipe_out argument is ignored.
+// the stdin_pipe_out argument is ignored.
 //
-// %G_SPAWN_STDOUT_TO_DEV_NULL means that the child's standard output
+// [GSpawnStdoutToDevNullValue] means that the child's standard output
 // will be discarded (by default, it goes to the same location as the parent's
-// standard output). %G_SPAWN_CHILD_INHERITS_STDOUT explicitly imposes the
+// standard output). [GSpawnChildInheritsStdoutValue] explicitly imposes the
 // default behavior. Both flags cannot be enabled at the same time and, in
-// both cases, the @stdout_pipe_out argument is ignored.
+// both cases, the stdout_pipe_out argument is ignored.
 //
-// %G_SPAWN_STDERR_TO_DEV_NULL means that the child's standard error
+// [GSpawnStderrToDevNullValue] means that the child's standard error
 // will be discarded (by default, it goes to the same location as the parent's
-// standard error). %G_SPAWN_CHILD_INHERITS_STDERR explicitly imposes the
+// standard error). [GSpawnChildInheritsStderrValue] explicitly imposes the
 // default behavior. Both flags cannot be enabled at the same time and, in
-// both cases, the @stderr_pipe_out argument is ignored.
+// both cases, the stderr_pipe_out argument is ignored.
 //
 // It is valid to pass the same FD in multiple parameters (e.g. you can pass
-// a single FD for both @stdout_fd and @stderr_fd, and include it in
-// @source_fds too).
-//
-// @source_fds and @target_fds allow zero or more FDs from this process to be
-// remapped to different FDs in the spawned process. If @n_fds is greater than
-// zero, @source_fds and @target_fds must both be non-%NULL and the same length.
-// Each FD in @source_fds is remapped to the FD number at the same index in
-// @target_fds. The source and target FD may be equal to simply propagate an FD
+// a single FD for both stdout_fd and stderr_fd, and include it in
+// source_fds too).
+//
+// source_fds and target_fds allow zero or more FDs from this process to be
+// remapped to different FDs in the spawned process. If n_fds is greater than
+// zero, source_fds and target_fds must both be non-nil and the same length.
+// Each FD in source_fds is remapped to the FD number at the same index in
+// target_fds. The source and target FD may be equal to simply propagate an FD
 // to the spawned process. FD remappings are processed after standard FDs, so
-// any target FDs which equal @stdin_fd, @stdout_fd or @stderr_fd will overwrite
+// any target FDs which equal stdin_fd, stdout_fd or stderr_fd will overwrite
 // them in the spawned process.
 //
-// @source_fds is supported on Windows since 2.72.
+// source_fds is supported on Windows since 2.72.
 //
-// %G_SPAWN_FILE_AND_ARGV_ZERO means that the first element of @argv is
+// [GSpawnFileAndArgvZeroValue] means that the first element of argv is
 // the file to execute, while the remaining elements are the actual
-// argument vector to pass to the file. Normally g_spawn_async_with_pipes()
-// uses @argv[0] as the file to execute, and passes all of @argv to the child.
+// argument vector to pass to the file. Normally [SpawnAsyncWithPipes]
+// uses argv[0] as the file to execute, and passes all of argv to the child.
 //
-// @child_setup and @user_data are a function and user data. On POSIX
+// child_setup and user_data are a function and user data. On POSIX
 // platforms, the function is called in the child after GLib has
 // performed all the setup it plans to perform (including creating
 // pipes, closing file descriptors, etc.) but before calling `exec()`.
-// That is, @child_setup is called just before calling `exec()` in the
+// That is, child_setup is called just before calling `exec()` in the
 // child. Obviously actions taken in this function will only affect
 // the child, not the parent.
 //
 // On Windows, there is no separate `fork()` and `exec()` functionality.
 // Child processes are created and run with a single API call,
-// `CreateProcess()`. There is no sensible thing @child_setup
+// `CreateProcess()`. There is no sensible thing child_setup
 // could be used for on Windows so it is ignored and not called.
 //
-// If non-%NULL, @child_pid will on Unix be filled with the child's
+// If non-nil, child_pid will on Unix be filled with the child's
 // process ID. You can use the process ID to send signals to the child,
-// or to use g_child_watch_add() (or `waitpid()`) if you specified the
-// %G_SPAWN_DO_NOT_REAP_CHILD flag. On Windows, @child_pid will be
+// or to use [ChildWatchAdd] (or `waitpid()`) if you specified the
+// [GSpawnDoNotReapChildValue] flag. On Windows, child_pid will be
 // filled with a handle to the child process only if you specified the
-// %G_SPAWN_DO_NOT_REAP_CHILD flag. You can then access the child
+// [GSpawnDoNotReapChildValue] flag. You can then access the child
 // process using the Win32 API, for example wait for its termination
 // with the `WaitFor*()` functions, or examine its exit code with
 // `GetExitCodeProcess()`. You should close the handle with `CloseHandle()`
-// or g_spawn_close_pid() when you no longer need it.
+// or [SpawnClosePid] when you no longer need it.
 //
-// If non-%NULL, the @stdin_pipe_out, @stdout_pipe_out, @stderr_pipe_out
+// If non-nil, the stdin_pipe_out, stdout_pipe_out, stderr_pipe_out
 // locations will be filled with file descriptors for writing to the child's
 // standard input or reading from its standard output or standard error.
-// The caller of g_spawn_async_with_pipes() must close these file descriptors
-// when they are no longer in use. If these parameters are %NULL, the
+// The caller of [SpawnAsyncWithPipes] must close these file descriptors
+// when they are no longer in use. If these parameters are nil, the
 // corresponding pipe won't be created.
 //
-// If @stdin_pipe_out is %NULL, the child's standard input is attached to
-// `/dev/null` unless %G_SPAWN_CHILD_INHERITS_STDIN is set.
+// If stdin_pipe_out is nil, the child's standard input is attached to
+// `/dev/null` unless [GSpawnChildInheritsStdinValue] is set.
 //
-// If @stderr_pipe_out is NULL, the child's standard error goes to the same
-// location as the parent's standard error unless %G_SPAWN_STDERR_TO_DEV_NULL
+// If stderr_pipe_out is NULL, the child's standard error goes to the same
+// location as the parent's standard error unless [GSpawnStderrToDevNullValue]
 // is set.
 //
-// If @stdout_pipe_out is NULL, the child's standard output goes to the same
-// location as the parent's standard output unless %G_SPAWN_STDOUT_TO_DEV_NULL
+// If stdout_pipe_out is NULL, the child's standard output goes to the same
+// location as the parent's standard output unless [GSpawnStdoutToDevNullValue]
 // is set.
 //
-// @error can be %NULL to ignore errors, or non-%NULL to report errors.
-// If an error is set, the function returns %FALSE. Errors are reported
+// error can be nil to ignore errors, or non-nil to report errors.
+// If an error is set, the function returns false. Errors are reported
 // even if they occur in the child (for example if the executable in
-// `@argv[0]` is not found). Typically the `message` field of returned
+// `argv[0]` is not found). Typically the `message` field of returned
 // errors should be displayed to users. Possible errors are those from
-// the %G_SPAWN_ERROR domain.
+// the G_SPAWN_ERROR domain.
 //
-// If an error occurs, @child_pid, @stdin_pipe_out, @stdout_pipe_out,
-// and @stderr_pipe_out will not be filled with valid values.
+// If an error occurs, child_pid, stdin_pipe_out, stdout_pipe_out,
+// and stderr_pipe_out will not be filled with valid values.
 //
-// If @child_pid is not %NULL and an error does not occur then the returned
-// process reference must be closed using g_spawn_close_pid().
+// If child_pid is not nil and an error does not occur then the returned
+// process reference must be closed using [SpawnClosePid].
 //
 // On modern UNIX platforms, GLib can use an efficient process launching
 // codepath driven internally by `posix_spawn()`. This has the advantage of
@@ -438,111 +713,64 @@ var xSpawnAsyncWithPipesAndFds func(uintptr, []string, []string, SpawnFlags, uin
 // This optimized codepath will be used provided that the following conditions
 // are met:
 //
-//  1. %G_SPAWN_DO_NOT_REAP_CHILD is set
-//  2. %G_SPAWN_LEAVE_DESCRIPTORS_OPEN is set
-//  3. %G_SPAWN_SEARCH_PATH_FROM_ENVP is not set
-//  4. @working_directory is %NULL
-//  5. @child_setup is %NULL
+//  1. [GSpawnDoNotReapChildValue] is set
+//  2. [GSpawnLeaveDescriptorsOpenValue] is set
+//  3. [GSpawnSearchPathFromEnvpValue] is not set
+//  4. working_directory is nil
+//  5. child_setup is nil
 //  6. The program is of a recognised binary format, or has a shebang.
 //     Otherwise, GLib will have to execute the program through the
 //     shell, which is not done using the optimized codepath.
 //
 // If you are writing a GTK application, and the program you are spawning is a
 // graphical application too, then to ensure that the spawned program opens its
-// windows on the right screen, you may want to use #GdkAppLaunchContext,
-// #GAppLaunchContext, or set the `DISPLAY` environment variable.
+// windows on the right screen, you may want to use [gdk.AppLaunchContext],
+// [gio.AppLaunchContext], or set the `DISPLAY` environment variable.
 func SpawnAsyncWithPipesAndFds(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string, FlagsVar SpawnFlags, ChildSetupVar *SpawnChildSetupFunc, UserDataVar uintptr, StdinFdVar int, StdoutFdVar int, StderrFdVar int, SourceFdsVar []int, TargetFdsVar []int, NFdsVar uint, ChildPidOutVar *Pid, StdinPipeOutVar *int, StdoutPipeOutVar *int, StderrPipeOutVar *int) (bool, error) {
-	var cerr *Error
-
-	var ChildSetupVarRef uintptr
-	if ChildSetupVar != nil {
-		ChildSetupVarPtr := uintptr(unsafe.Pointer(ChildSetupVar))
-		if cbRefPtr, ok := GetCallback(ChildSetupVarPtr); ok {
-			ChildSetupVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *ChildSetupVar
-				cbFn(arg0)
-			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
-		}
-	}
-
-	WorkingDirectoryVarPtr := core.GStrdupNullable(WorkingDirectoryVar)
-	defer core.GFreeNullable(WorkingDirectoryVarPtr)
-
-	cret := xSpawnAsyncWithPipesAndFds(WorkingDirectoryVarPtr, ArgvVar, EnvpVar, FlagsVar, ChildSetupVarRef, UserDataVar, StdinFdVar, StdoutFdVar, StderrFdVar, SourceFdsVar, TargetFdsVar, NFdsVar, ChildPidOutVar, StdinPipeOutVar, StdoutPipeOutVar, StderrPipeOutVar, &cerr)
-
-	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
-}
-
-var xSpawnCheckExitStatus func(int, **Error) bool
-
-// An old name for g_spawn_check_wait_status(), deprecated because its
-// name is misleading.
-//
-// Despite the name of the function, @wait_status must be the wait status
-// as returned by g_spawn_sync(), g_subprocess_get_status(), `waitpid()`,
-// etc. On Unix platforms, it is incorrect for it to be the exit status
-// as passed to `exit()` or returned by g_subprocess_get_exit_status() or
-// `WEXITSTATUS()`.
-func SpawnCheckExitStatus(WaitStatusVar int) (bool, error) {
-	var cerr *Error
-
-	cret := xSpawnCheckExitStatus(WaitStatusVar, &cerr)
-
-	if cerr == nil {
-		return cret, nil
-	}
-	return cret, cerr
-
+	var cret bool
+	return cret, core.UnsupportedABI("g_spawn_async_with_pipes_and_fds", "17 arguments are more than the 15 that purego passes")
 }
 
 var xSpawnCheckWaitStatus func(int, **Error) bool
 
-// Set @error if @wait_status indicates the child exited abnormally
+// Set error if wait_status indicates the child exited abnormally
 // (e.g. with a nonzero exit code, or via a fatal signal).
 //
-// The g_spawn_sync() and g_child_watch_add() family of APIs return the
+// The [SpawnSync] and [ChildWatchAdd] family of APIs return the
 // status of subprocesses encoded in a platform-specific way.
 // On Unix, this is guaranteed to be in the same format waitpid() returns,
 // and on Windows it is guaranteed to be the result of GetExitCodeProcess().
 //
 // Prior to the introduction of this function in GLib 2.34, interpreting
-// @wait_status required use of platform-specific APIs, which is problematic
+// wait_status required use of platform-specific APIs, which is problematic
 // for software using GLib as a cross-platform layer.
 //
 // Additionally, many programs simply want to determine whether or not
-// the child exited successfully, and either propagate a #GError or
+// the child exited successfully, and either propagate a [Error] or
 // print a message to standard error. In that common case, this function
-// can be used. Note that the error message in @error will contain
+// can be used. Note that the error message in error will contain
 // human-readable information about the wait status.
 //
-// The @domain and @code of @error have special semantics in the case
+// The domain and code of error have special semantics in the case
 // where the process has an "exit code", as opposed to being killed by
 // a signal. On Unix, this happens if WIFEXITED() would be true of
-// @wait_status. On Windows, it is always the case.
+// wait_status. On Windows, it is always the case.
 //
 // The special semantics are that the actual exit code will be the
-// code set in @error, and the domain will be %G_SPAWN_EXIT_ERROR.
+// code set in error, and the domain will be G_SPAWN_EXIT_ERROR.
 // This allows you to differentiate between different exit codes.
 //
 // If the process was terminated by some means other than an exit
 // status (for example if it was killed by a signal), the domain will be
-// %G_SPAWN_ERROR and the code will be %G_SPAWN_ERROR_FAILED.
+// G_SPAWN_ERROR and the code will be [GSpawnErrorFailedValue].
 //
 // This function just offers convenience; you can of course also check
-// the available platform via a macro such as %G_OS_UNIX, and use
-// WIFEXITED() and WEXITSTATUS() on @wait_status directly. Do not attempt
+// the available platform via a macro such as G_OS_UNIX, and use
+// WIFEXITED() and WEXITSTATUS() on wait_status directly. Do not attempt
 // to scan or parse the error message string; it may be translated and/or
 // change in future versions of GLib.
 //
-// Prior to version 2.70, g_spawn_check_exit_status() provides the same
+// Prior to version 2.70, [SpawnCheckExitStatus] provides the same
 // functionality, although under a misleading name.
 func SpawnCheckWaitStatus(WaitStatusVar int) (bool, error) {
 	var cerr *Error
@@ -550,7 +778,7 @@ func SpawnCheckWaitStatus(WaitStatusVar int) (bool, error) {
 	cret := xSpawnCheckWaitStatus(WaitStatusVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -558,8 +786,8 @@ func SpawnCheckWaitStatus(WaitStatusVar int) (bool, error) {
 
 var xSpawnClosePid func(Pid)
 
-// On some platforms, notably Windows, the #GPid type represents a resource
-// which must be closed to prevent resource leaking. g_spawn_close_pid()
+// On some platforms, notably Windows, the [Pid] type represents a resource
+// which must be closed to prevent resource leaking. [SpawnClosePid]
 // is provided for this purpose. It should be used on all platforms, even
 // though it doesn't do anything under UNIX.
 func SpawnClosePid(PidVar Pid) {
@@ -570,23 +798,23 @@ func SpawnClosePid(PidVar Pid) {
 
 var xSpawnCommandLineAsync func(string, **Error) bool
 
-// A simple version of g_spawn_async() that parses a command line with
-// g_shell_parse_argv() and passes it to g_spawn_async().
+// A simple version of [SpawnAsync] that parses a command line with
+// [ShellParseArgv] and passes it to [SpawnAsync].
 //
-// Runs a command line in the background. Unlike g_spawn_async(), the
-// %G_SPAWN_SEARCH_PATH flag is enabled, other flags are not. Note
-// that %G_SPAWN_SEARCH_PATH can have security implications, so
-// consider using g_spawn_async() directly if appropriate. Possible
-// errors are those from g_shell_parse_argv() and g_spawn_async().
+// Runs a command line in the background. Unlike [SpawnAsync], the
+// [GSpawnSearchPathValue] flag is enabled, other flags are not. Note
+// that [GSpawnSearchPathValue] can have security implications, so
+// consider using [SpawnAsync] directly if appropriate. Possible
+// errors are those from [ShellParseArgv] and [SpawnAsync].
 //
-// The same concerns on Windows apply as for g_spawn_command_line_sync().
+// The same concerns on Windows apply as for [SpawnCommandLineSync].
 func SpawnCommandLineAsync(CommandLineVar string) (bool, error) {
 	var cerr *Error
 
 	cret := xSpawnCommandLineAsync(CommandLineVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -594,31 +822,31 @@ func SpawnCommandLineAsync(CommandLineVar string) (bool, error) {
 
 var xSpawnCommandLineSync func(string, *[]byte, *[]byte, *int, **Error) bool
 
-// A simple version of g_spawn_sync() with little-used parameters
+// A simple version of [SpawnSync] with little-used parameters
 // removed, taking a command line instead of an argument vector.
 //
-// See g_spawn_sync() for full details.
+// See [SpawnSync] for full details.
 //
-// The @command_line argument will be parsed by g_shell_parse_argv().
+// The command_line argument will be parsed by [ShellParseArgv].
 //
-// Unlike g_spawn_sync(), the %G_SPAWN_SEARCH_PATH flag is enabled.
-// Note that %G_SPAWN_SEARCH_PATH can have security implications, so
-// consider using g_spawn_sync() directly if appropriate.
+// Unlike [SpawnSync], the [GSpawnSearchPathValue] flag is enabled.
+// Note that [GSpawnSearchPathValue] can have security implications, so
+// consider using [SpawnSync] directly if appropriate.
 //
-// Possible errors are those from g_spawn_sync() and those
-// from g_shell_parse_argv().
+// Possible errors are those from [SpawnSync] and those
+// from [ShellParseArgv].
 //
-// If @wait_status is non-%NULL, the platform-specific status of
+// If wait_status is non-nil, the platform-specific status of
 // the child is stored there; see the documentation of
-// g_spawn_check_wait_status() for how to use and interpret this.
+// [SpawnCheckWaitStatus] for how to use and interpret this.
 // On Unix platforms, note that it is usually not equal
 // to the integer passed to `exit()` or returned from `main()`.
 //
-// On Windows, please note the implications of g_shell_parse_argv()
-// parsing @command_line. Parsing is done according to Unix shell rules, not
+// On Windows, please note the implications of [ShellParseArgv]
+// parsing command_line. Parsing is done according to Unix shell rules, not
 // Windows command interpreter rules.
 // Space is a separator, and backslashes are
-// special. Thus you cannot simply pass a @command_line containing
+// special. Thus you cannot simply pass a command_line containing
 // canonical Windows paths, like "c:\\program files\\app\\app.exe", as
 // the backslashes will be eaten, and the space will act as a
 // separator. You need to enclose such paths with single quotes, like
@@ -629,7 +857,7 @@ func SpawnCommandLineSync(CommandLineVar string, StandardOutputVar *[]byte, Stan
 	cret := xSpawnCommandLineSync(CommandLineVar, StandardOutputVar, StandardErrorVar, WaitStatusVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -639,25 +867,25 @@ var xSpawnSync func(uintptr, []string, []string, SpawnFlags, uintptr, uintptr, *
 
 // Executes a child synchronously (waits for the child to exit before returning).
 //
-// All output from the child is stored in @standard_output and @standard_error,
-// if those parameters are non-%NULL. Note that you must set the
-// %G_SPAWN_STDOUT_TO_DEV_NULL and %G_SPAWN_STDERR_TO_DEV_NULL flags when
-// passing %NULL for @standard_output and @standard_error.
+// All output from the child is stored in standard_output and standard_error,
+// if those parameters are non-nil. Note that you must set the
+// [GSpawnStdoutToDevNullValue] and [GSpawnStderrToDevNullValue] flags when
+// passing nil for standard_output and standard_error.
 //
-// If @wait_status is non-%NULL, the platform-specific status of
+// If wait_status is non-nil, the platform-specific status of
 // the child is stored there; see the documentation of
-// g_spawn_check_wait_status() for how to use and interpret this.
+// [SpawnCheckWaitStatus] for how to use and interpret this.
 // On Unix platforms, note that it is usually not equal
 // to the integer passed to `exit()` or returned from `main()`.
 //
-// Note that it is invalid to pass %G_SPAWN_DO_NOT_REAP_CHILD in
-// @flags, and on POSIX platforms, the same restrictions as for
-// g_child_watch_source_new() apply.
+// Note that it is invalid to pass [GSpawnDoNotReapChildValue] in
+// flags, and on POSIX platforms, the same restrictions as for
+// [ChildWatchSourceNew] apply.
 //
-// If an error occurs, no data is returned in @standard_output,
-// @standard_error, or @wait_status.
+// If an error occurs, no data is returned in standard_output,
+// standard_error, or wait_status.
 //
-// This function calls g_spawn_async_with_pipes() internally; see that
+// This function calls [SpawnAsyncWithPipes] internally; see that
 // function for full details on the other parameters and details on
 // how these functions work on Windows.
 func SpawnSync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string, FlagsVar SpawnFlags, ChildSetupVar *SpawnChildSetupFunc, UserDataVar uintptr, StandardOutputVar *[]byte, StandardErrorVar *[]byte, WaitStatusVar *int) (bool, error) {
@@ -670,11 +898,12 @@ func SpawnSync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string,
 			ChildSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ChildSetupVar
 				cbFn(arg0)
 			}
-			ChildSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
+			ChildSetupVarRef = core.NewCallback(fcb)
+			ChildSetupVarRef = SaveCallbackWithClosure(ChildSetupVarPtr, ChildSetupVarRef, ChildSetupVar)
 		}
 	}
 
@@ -684,7 +913,7 @@ func SpawnSync(WorkingDirectoryVar *string, ArgvVar []string, EnvpVar []string,
 	cret := xSpawnSync(WorkingDirectoryVarPtr, ArgvVar, EnvpVar, FlagsVar, ChildSetupVarRef, UserDataVar, StandardOutputVar, StandardErrorVar, WaitStatusVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -695,22 +924,21 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSpawnAsync, libs, "g_spawn_async")
-	core.PuregoSafeRegister(&xSpawnAsyncWithFds, libs, "g_spawn_async_with_fds")
-	core.PuregoSafeRegister(&xSpawnAsyncWithPipes, libs, "g_spawn_async_with_pipes")
-	core.PuregoSafeRegister(&xSpawnAsyncWithPipesAndFds, libs, "g_spawn_async_with_pipes_and_fds")
-	core.PuregoSafeRegister(&xSpawnCheckExitStatus, libs, "g_spawn_check_exit_status")
-	core.PuregoSafeRegister(&xSpawnCheckWaitStatus, libs, "g_spawn_check_wait_status")
-	core.PuregoSafeRegister(&xSpawnClosePid, libs, "g_spawn_close_pid")
-	core.PuregoSafeRegister(&xSpawnCommandLineAsync, libs, "g_spawn_command_line_async")
-	core.PuregoSafeRegister(&xSpawnCommandLineSync, libs, "g_spawn_command_line_sync")
-	core.PuregoSafeRegister(&xSpawnSync, libs, "g_spawn_sync")
+	core.PuregoRegisterSince(&xSpawnAsync, libs, "g_spawn_async", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnAsyncWithFds, libs, "g_spawn_async_with_fds", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xSpawnAsyncWithPipes, libs, "g_spawn_async_with_pipes", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnAsyncWithPipesAndFds, libs, "g_spawn_async_with_pipes_and_fds", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xSpawnCheckWaitStatus, libs, "g_spawn_check_wait_status", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xSpawnClosePid, libs, "g_spawn_close_pid", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnCommandLineAsync, libs, "g_spawn_command_line_async", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnCommandLineSync, libs, "g_spawn_command_line_sync", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnSync, libs, "g_spawn_sync", "GLIB", "")
 
 }
@@ -0,0 +1,48 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xSpawnCheckExitStatus func(int, **Error) bool
+
+// An old name for [SpawnCheckWaitStatus], deprecated because its
+// name is misleading.
+//
+// Despite the name of the function, wait_status must be the wait status
+// as returned by [SpawnSync], [gio.Subprocess.GetStatus], `waitpid()`,
+// etc. On Unix platforms, it is incorrect for it to be the exit status
+// as passed to `exit()` or returned by [gio.Subprocess.GetExitStatus] or
+// `WEXITSTATUS()`.
+//
+// Deprecated: since 2.70. Use [SpawnCheckWaitStatus] instead, and check whether your code is conflating wait and exit statuses.
+func SpawnCheckExitStatus(WaitStatusVar int) (bool, error) {
+	var cerr *Error
+
+	cret := xSpawnCheckExitStatus(WaitStatusVar, &cerr)
+
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSpawnCheckExitStatus, libs, "g_spawn_check_exit_status", "GLIB", "2.34")
+
+}
@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 // A type corresponding to the appropriate struct type for the stat()
 // system call, depending on the platform and/or compiler being used.
 //
-// See g_stat() for more information.
+// See [Stat] for more information.
 type StatBuf struct {
 	_ structs.HostLayout
 }
@@ -45,7 +44,7 @@ func Access(FilenameVar string, ModeVar int) int {
 var xChdir func(string) int
 
 // A wrapper for the POSIX chdir() function. The function changes the
-// current directory of the process to @path.
+// current directory of the process to path.
 //
 // See your C library manual for more details about chdir().
 func Chdir(PathVar string) int {
@@ -77,29 +76,29 @@ func Chmod(FilenameVar string, ModeVar int) int {
 var xClose func(int, **Error) bool
 
 // This wraps the close() call. In case of error, %errno will be
-// preserved, but the error will also be stored as a #GError in @error.
+// preserved, but the error will also be stored as a [Error] in error.
 // In case of success, %errno is undefined.
 //
-// Besides using #GError, there is another major reason to prefer this
+// Besides using [Error], there is another major reason to prefer this
 // function over the call provided by the system; on Unix, it will
-// attempt to correctly handle %EINTR, which has platform-specific
+// attempt to correctly handle EINTR, which has platform-specific
 // semantics.
 //
 // It is a bug to call this function with an invalid file descriptor.
 //
 // On POSIX platforms since GLib 2.76, this function is async-signal safe
-// if (and only if) @error is %NULL and @fd is a valid open file descriptor.
-// This makes it safe to call from a signal handler or a #GSpawnChildSetupFunc
+// if (and only if) error is nil and fd is a valid open file descriptor.
+// This makes it safe to call from a signal handler or a [SpawnChildSetupFunc]
 // under those conditions.
-// See [`signal(7)`](man:signal(7)) and
-// [`signal-safety(7)`](man:signal-safety(7)) for more details.
+// See signal(7)) and
+// signal-safety(7)) for more details.
 func Close(FdVar int) (bool, error) {
 	var cerr *Error
 
 	cret := xClose(FdVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -149,15 +148,17 @@ var xFopen func(string, string) uintptr
 //
 // As `close()` and `fclose()` are part of the C library, this implies that it is
 // currently impossible to close a file if the application C library and the C library
-// used by GLib are different. Convenience functions like g_file_set_contents_full()
+// used by GLib are different. Convenience functions like [FileSetContentsFull]
 // avoid this problem.
 //
-// Since GLib 2.86, the `e` option is supported in @mode on all platforms. On
+// Since GLib 2.86, the `e` option is supported in mode on all platforms. On
 // Unix platforms it will set `O_CLOEXEC` on the opened file descriptor. On
 // Windows platforms it will be converted to the
-// [`N` modifier](https://learn.microsoft.com/en-us/cpp/c-runtime-library/reference/fopen-wfopen?view=msvc-170).
+// [N modifier].
 // It is recommended to set `e` unconditionally, unless you know the returned
 // file should be shared between this process and a new fork.
+//
+// [N modifier]: https://learn.microsoft.com/en-us/cpp/c-runtime-library/reference/fopen-wfopen?view=msvc-170
 func Fopen(FilenameVar string, ModeVar string) uintptr {
 
 	cret := xFopen(FilenameVar, ModeVar)
@@ -172,8 +173,8 @@ var xFreopen func(string, string, uintptr) uintptr
 //
 // See your C library manual for more details about freopen().
 //
-// Since GLib 2.86, the `e` option is supported in @mode on all platforms. See
-// the documentation for [func@GLib.fopen] for more details.
+// Since GLib 2.86, the `e` option is supported in mode on all platforms. See
+// the documentation for [Fopen] for more details.
 func Freopen(FilenameVar string, ModeVar string, StreamVar uintptr) uintptr {
 
 	cret := xFreopen(FilenameVar, ModeVar, StreamVar)
@@ -203,8 +204,8 @@ var xLstat func(string, *StatBuf) int
 // A wrapper for the POSIX lstat() function. The lstat() function is
 // like stat() except that in the case of symbolic links, it returns
 // information about the symbolic link itself and not the file that it
-// refers to. If the system does not support symbolic links g_lstat()
-// is identical to g_stat().
+// refers to. If the system does not support symbolic links [Lstat]
+// is identical to [Stat].
 //
 // See your C library manual for more details about lstat().
 func Lstat(FilenameVar string, BufVar *StatBuf) int {
@@ -315,7 +316,7 @@ var xStat func(string, *StatBuf) int
 // returns information about a file. On Windows the stat() function in
 // the C library checks only the FAT-style READONLY attribute and does
 // not look at the ACL at all. Thus on Windows the protection bits in
-// the @st_mode field are a fabrication of little use.
+// the st_mode field are a fabrication of little use.
 //
 // On Windows the Microsoft C libraries have several variants of the
 // stat struct and stat() function with names like _stat(), _stat32(),
@@ -325,7 +326,7 @@ var xStat func(string, *StatBuf) int
 // In Microsoft's compiler, by default struct stat means one with
 // 64-bit time fields while in MinGW struct stat is the legacy one
 // with 32-bit fields. To hopefully clear up this messs, the gstdio.h
-// header defines a type #GStatBuf which is the appropriate struct type
+// header defines a type [StatBuf] which is the appropriate struct type
 // depending on the platform and/or compiler being used. On POSIX it
 // is just struct stat, but note that even on POSIX platforms, stat()
 // might be a macro.
@@ -374,29 +375,29 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAccess, libs, "g_access")
-	core.PuregoSafeRegister(&xChdir, libs, "g_chdir")
-	core.PuregoSafeRegister(&xChmod, libs, "g_chmod")
-	core.PuregoSafeRegister(&xClose, libs, "g_close")
-	core.PuregoSafeRegister(&xCreat, libs, "g_creat")
-	core.PuregoSafeRegister(&xFopen, libs, "g_fopen")
-	core.PuregoSafeRegister(&xFreopen, libs, "g_freopen")
-	core.PuregoSafeRegister(&xFsync, libs, "g_fsync")
-	core.PuregoSafeRegister(&xLstat, libs, "g_lstat")
-	core.PuregoSafeRegister(&xMkdir, libs, "g_mkdir")
-	core.PuregoSafeRegister(&xOpen, libs, "g_open")
-	core.PuregoSafeRegister(&xRemove, libs, "g_remove")
-	core.PuregoSafeRegister(&xRename, libs, "g_rename")
-	core.PuregoSafeRegister(&xRmdir, libs, "g_rmdir")
-	core.PuregoSafeRegister(&xStat, libs, "g_stat")
-	core.PuregoSafeRegister(&xUnlink, libs, "g_unlink")
-	core.PuregoSafeRegister(&xUtime, libs, "g_utime")
+	core.PuregoRegisterSince(&xAccess, libs, "g_access", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xChdir, libs, "g_chdir", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xChmod, libs, "g_chmod", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xClose, libs, "g_close", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xCreat, libs, "g_creat", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xFopen, libs, "g_fopen", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xFreopen, libs, "g_freopen", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xFsync, libs, "g_fsync", "GLIB", "2.64")
+	core.PuregoRegisterSince(&xLstat, libs, "g_lstat", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xMkdir, libs, "g_mkdir", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xOpen, libs, "g_open", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xRemove, libs, "g_remove", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xRename, libs, "g_rename", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xRmdir, libs, "g_rmdir", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xStat, libs, "g_stat", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xUnlink, libs, "g_unlink", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xUtime, libs, "g_utime", "GLIB", "2.18")
 
 }
@@ -0,0 +1,118 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xMemdup func(uintptr, uint) uintptr
+
+// Allocates byte_size bytes of memory, and copies byte_size bytes into it
+// from mem. If mem is `NULL` it returns `NULL`.
+//
+// Deprecated: since 2.68. Use [Memdup2] instead, as it accepts a gsize argument
+//
+//	for byte_size, avoiding the possibility of overflow in a `gsize` → `guint`
+//	conversion
+func Memdup(MemVar uintptr, ByteSizeVar uint) uintptr {
+
+	cret := xMemdup(MemVar, ByteSizeVar)
+
+	return cret
+}
+
+var xStrcasecmp func(string, string) int
+
+// A case-insensitive string comparison, corresponding to the standard
+// `strcasecmp()` function on platforms which support it.
+//
+// Deprecated: since 2.2. See [Strncasecmp] for a discussion of why this
+//
+//	function is deprecated and how to replace it.
+func Strcasecmp(S1Var string, S2Var string) int {
+
+	cret := xStrcasecmp(S1Var, S2Var)
+
+	return cret
+}
+
+var xStrdown func(string) string
+
+// Converts a string to lower case.
+//
+// Deprecated: since 2.2. This function is totally broken for the reasons discussed
+//
+//	in the [Strncasecmp] docs — use [AsciiStrdown] or
+//	[Utf8Strdown] instead.
+func Strdown(StringVar string) string {
+
+	cret := xStrdown(StringVar)
+
+	return cret
+}
+
+var xStrncasecmp func(string, string, uint) int
+
+// A case-insensitive string comparison, corresponding to the standard
+// `strncasecmp()` function on platforms which support it. It is similar
+// to [Strcasecmp] except it only compares the first n characters of
+// the strings.
+//
+// Deprecated: since 2.2. The problem with `[Strncasecmp]` is that it does
+//
+//	the comparison by calling `toupper()`/`tolower()`. These functions
+//	are locale-specific and operate on single bytes. However, it is
+//	impossible to handle things correctly from an internationalization
+//	standpoint by operating on bytes, since characters may be multibyte.
+//	Thus `[Strncasecmp]` is broken if your string is guaranteed to be
+//	ASCII, since it is locale-sensitive, and it's broken if your string
+//	is localized, since it doesn't work on many encodings at all,
+//	including UTF-8, EUC-JP, etc.
+//
+//	There are therefore two replacement techniques: [AsciiStrncasecmp],
+//	which only works on ASCII and is not locale-sensitive, and
+//	[Utf8Casefold] followed by `strcmp()` on the resulting strings,
+//	which is good for case-insensitive sorting of UTF-8.
+func Strncasecmp(S1Var string, S2Var string, NVar uint) int {
+
+	cret := xStrncasecmp(S1Var, S2Var, NVar)
+
+	return cret
+}
+
+var xStrup func(string) string
+
+// Converts a string to upper case.
+//
+// Deprecated: since 2.2. This function is totally broken for the reasons discussed
+//
+//	in the [Strncasecmp] docs — use [AsciiStrup] or
+//	[Utf8Strup] instead.
+func Strup(StringVar string) string {
+
+	cret := xStrup(StringVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xMemdup, libs, "g_memdup", "GLIB", "")
+	core.PuregoRegisterSince(&xStrcasecmp, libs, "g_strcasecmp", "GLIB", "")
+	core.PuregoRegisterSince(&xStrdown, libs, "g_strdown", "GLIB", "")
+	core.PuregoRegisterSince(&xStrncasecmp, libs, "g_strncasecmp", "GLIB", "")
+	core.PuregoRegisterSince(&xStrup, libs, "g_strup", "GLIB", "")
+
+}
@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -42,7 +42,7 @@ func (x *String) GoPointer() uintptr {
 
 var xNewString func(uintptr) *String
 
-// Creates a new #GString, initialized with the given string.
+// Creates a new [String], initialized with the given string.
 func NewString(InitVar *string) *String {
 
 	InitVarPtr := core.GStrdupNullable(InitVar)
@@ -54,12 +54,12 @@ func NewString(InitVar *string) *String {
 
 var xNewStringLen func(string, int) *String
 
-// Creates a new #GString with @len bytes of the @init buffer.
-// Because a length is provided, @init need not be nul-terminated,
+// Creates a new [String] with len bytes of the init buffer.
+// Because a length is provided, init need not be nul-terminated,
 // and can contain embedded nul bytes.
 //
 // Since this function does not stop at nul bytes, it is the caller's
-// responsibility to ensure that @init has at least @len addressable
+// responsibility to ensure that init has at least len addressable
 // bytes.
 func NewStringLen(InitVar string, LenVar int) *String {
 
@@ -69,11 +69,11 @@ func NewStringLen(InitVar string, LenVar int) *String {
 
 var xNewStringTake func(uintptr) *String
 
-// Creates a new #GString, initialized with the given string.
+// Creates a new [String], initialized with the given string.
 //
-// After this call, @init belongs to the #GString and may no longer be
-// modified by the caller. The memory of @init has to be dynamically
-// allocated and will eventually be freed with g_free().
+// After this call, init belongs to the [String] and may no longer be
+// modified by the caller. The memory of init has to be dynamically
+// allocated and will eventually be freed with [Free].
 func NewStringTake(InitVar *string) *String {
 
 	cret := xNewStringTake(core.GStrdupNullable(InitVar))
@@ -82,7 +82,7 @@ func NewStringTake(InitVar *string) *String {
 
 var xStringSizedNew func(uint) *String
 
-// Creates a new #GString, with enough space for @dfl_size
+// Creates a new [String], with enough space for dfl_size
 // bytes. This is useful if you are going to add a lot of
 // text to the string and don't want it to be reallocated
 // too often.
@@ -94,7 +94,7 @@ func StringSizedNew(DflSizeVar uint) *String {
 
 var xStringAppend func(uintptr, string) *String
 
-// Adds a string onto the end of a #GString, expanding
+// Adds a string onto the end of a [String], expanding
 // it if necessary.
 func (x *String) Append(ValVar string) *String {
 
@@ -104,7 +104,7 @@ func (x *String) Append(ValVar string) *String {
 
 var xStringAppendC func(uintptr, byte) *String
 
-// Adds a byte onto the end of a #GString, expanding
+// Adds a byte onto the end of a [String], expanding
 // it if necessary.
 func (x *String) AppendC(CVar byte) *String {
 
@@ -114,15 +114,15 @@ func (x *String) AppendC(CVar byte) *String {
 
 var xStringAppendLen func(uintptr, string, int) *String
 
-// Appends @len bytes of @val to @string.
+// Appends len bytes of val to string.
 //
-// If @len is positive, @val may contain embedded nuls and need
+// If len is positive, val may contain embedded nuls and need
 // not be nul-terminated. It is the caller's responsibility to
-// ensure that @val has at least @len addressable bytes.
+// ensure that val has at least len addressable bytes.
 //
-// If @len is negative, @val must be nul-terminated and @len
+// If len is negative, val must be nul-terminated and len
 // is considered to request the entire string length. This
-// makes g_string_append_len() equivalent to g_string_append().
+// makes [String.AppendLen] equivalent to [String.Append].
 func (x *String) AppendLen(ValVar string, LenVar int) *String {
 
 	cret := xStringAppendLen(x.GoPointer(), ValVar, LenVar)
@@ -131,20 +131,22 @@ func (x *String) AppendLen(ValVar string, LenVar int) *String {
 
 var xStringAppendPrintf func(uintptr, string, ...interface{})
 
-// Appends a formatted string onto the end of a #GString.
-// This function is similar to g_string_printf() except
-// that the text is appended to the #GString.
+// Appends a formatted string onto the end of a [String].
+// This function is similar to [String.Printf] except
+// that the text is appended to the [String].
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *String) AppendPrintf(FormatVar string, varArgs ...interface{}) {
 
-	xStringAppendPrintf(x.GoPointer(), FormatVar, varArgs...)
+	xStringAppendPrintf(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
-var xStringAppendUnichar func(uintptr, uint32) *String
+var xStringAppendUnichar func(uintptr, rune) *String
 
 // Converts a Unicode character into UTF-8, and appends it
 // to the string.
-func (x *String) AppendUnichar(WcVar uint32) *String {
+func (x *String) AppendUnichar(WcVar rune) *String {
 
 	cret := xStringAppendUnichar(x.GoPointer(), WcVar)
 	return cret
@@ -152,7 +154,7 @@ func (x *String) AppendUnichar(WcVar uint32) *String {
 
 var xStringAppendUriEscaped func(uintptr, string, string, bool) *String
 
-// Appends @unescaped to @string, escaping any characters that
+// Appends unescaped to string, escaping any characters that
 // are reserved in URIs using URI-style escape sequences.
 func (x *String) AppendUriEscaped(UnescapedVar string, ReservedCharsAllowedVar string, AllowUtf8Var bool) *String {
 
@@ -162,8 +164,8 @@ func (x *String) AppendUriEscaped(UnescapedVar string, ReservedCharsAllowedVar s
 
 var xStringAppendVprintf func(uintptr, string, []interface{})
 
-// Appends a formatted string onto the end of a #GString.
-// This function is similar to g_string_append_printf()
+// Appends a formatted string onto the end of a [String].
+// This function is similar to [String.AppendPrintf]
 // except that the arguments to the format string are passed
 // as a va_list.
 func (x *String) AppendVprintf(FormatVar string, ArgsVar []interface{}) {
@@ -192,7 +194,7 @@ func (x *String) AsciiUp() *String {
 
 var xStringAssign func(uintptr, string) *String
 
-// Copies the bytes from a string into a #GString,
+// Copies the bytes from a string into a [String],
 // destroying any previous contents. It is rather like
 // the standard strcpy() function, except that you do not
 // have to worry about having enough space to copy the string.
@@ -204,9 +206,9 @@ func (x *String) Assign(RvalVar string) *String {
 
 var xStringCopy func(uintptr) *String
 
-// Copies the [struct@GLib.String] instance and its contents.
+// Copies the [String] instance and its contents.
 //
-// This will preserve the allocation length of the [struct@GLib.String] in the
+// This will preserve the allocation length of the [String] in the
 // copy.
 func (x *String) Copy() *String {
 
@@ -214,19 +216,10 @@ func (x *String) Copy() *String {
 	return cret
 }
 
-var xStringDown func(uintptr) *String
-
-// Converts a #GString to lowercase.
-func (x *String) Down() *String {
-
-	cret := xStringDown(x.GoPointer())
-	return cret
-}
-
 var xStringEqual func(uintptr, *String) bool
 
-// Compares two strings for equality, returning %TRUE if they are equal.
-// For use with #GHashTable.
+// Compares two strings for equality, returning true if they are equal.
+// For use with [HashTable].
 func (x *String) Equal(V2Var *String) bool {
 
 	cret := xStringEqual(x.GoPointer(), V2Var)
@@ -235,8 +228,8 @@ func (x *String) Equal(V2Var *String) bool {
 
 var xStringErase func(uintptr, int, int) *String
 
-// Removes @len bytes from a #GString, starting at position @pos.
-// The rest of the #GString is shifted down to fill the gap.
+// Removes len bytes from a [String], starting at position pos.
+// The rest of the [String] is shifted down to fill the gap.
 func (x *String) Erase(PosVar int, LenVar int) *String {
 
 	cret := xStringErase(x.GoPointer(), PosVar, LenVar)
@@ -245,13 +238,13 @@ func (x *String) Erase(PosVar int, LenVar int) *String {
 
 var xStringFree func(uintptr, bool) string
 
-// Frees the memory allocated for the #GString.
-// If @free_segment is %TRUE it also frees the character data.  If
-// it's %FALSE, the caller gains ownership of the buffer and must
-// free it after use with g_free().
+// Frees the memory allocated for the [String].
+// If free_segment is true it also frees the character data.  If
+// it's false, the caller gains ownership of the buffer and must
+// free it after use with [Free].
 //
-// Instead of passing %FALSE to this function, consider using
-// g_string_free_and_steal().
+// Instead of passing false to this function, consider using
+// [String.FreeAndSteal].
 func (x *String) Free(FreeSegmentVar bool) string {
 
 	cret := xStringFree(x.GoPointer(), FreeSegmentVar)
@@ -260,10 +253,10 @@ func (x *String) Free(FreeSegmentVar bool) string {
 
 var xStringFreeAndSteal func(uintptr) string
 
-// Frees the memory allocated for the #GString.
+// Frees the memory allocated for the [String].
 //
 // The caller gains ownership of the buffer and
-// must free it after use with g_free().
+// must free it after use with [Free].
 func (x *String) FreeAndSteal() string {
 
 	cret := xStringFreeAndSteal(x.GoPointer())
@@ -272,13 +265,13 @@ func (x *String) FreeAndSteal() string {
 
 var xStringFreeToBytes func(uintptr) *Bytes
 
-// Transfers ownership of the contents of @string to a newly allocated
-// #GBytes.  The #GString structure itself is deallocated, and it is
-// therefore invalid to use @string after invoking this function.
+// Transfers ownership of the contents of string to a newly allocated
+// [Bytes].  The [String] structure itself is deallocated, and it is
+// therefore invalid to use string after invoking this function.
 //
-// Note that while #GString ensures that its buffer always has a
+// Note that while [String] ensures that its buffer always has a
 // trailing nul character (not reflected in its "len"), the returned
-// #GBytes does not include this extra nul; i.e. it has length exactly
+// [Bytes] does not include this extra nul; i.e. it has length exactly
 // equal to the "len" member.
 func (x *String) FreeToBytes() *Bytes {
 
@@ -288,7 +281,7 @@ func (x *String) FreeToBytes() *Bytes {
 
 var xStringHash func(uintptr) uint
 
-// Creates a hash code for @str; for use with #GHashTable.
+// Creates a hash code for str; for use with [HashTable].
 func (x *String) Hash() uint {
 
 	cret := xStringHash(x.GoPointer())
@@ -297,7 +290,7 @@ func (x *String) Hash() uint {
 
 var xStringInsert func(uintptr, int, string) *String
 
-// Inserts a copy of a string into a #GString,
+// Inserts a copy of a string into a [String],
 // expanding it if necessary.
 func (x *String) Insert(PosVar int, ValVar string) *String {
 
@@ -307,7 +300,7 @@ func (x *String) Insert(PosVar int, ValVar string) *String {
 
 var xStringInsertC func(uintptr, int, byte) *String
 
-// Inserts a byte into a #GString, expanding it if necessary.
+// Inserts a byte into a [String], expanding it if necessary.
 func (x *String) InsertC(PosVar int, CVar byte) *String {
 
 	cret := xStringInsertC(x.GoPointer(), PosVar, CVar)
@@ -316,27 +309,27 @@ func (x *String) InsertC(PosVar int, CVar byte) *String {
 
 var xStringInsertLen func(uintptr, int, string, int) *String
 
-// Inserts @len bytes of @val into @string at @pos.
+// Inserts len bytes of val into string at pos.
 //
-// If @len is positive, @val may contain embedded nuls and need
+// If len is positive, val may contain embedded nuls and need
 // not be nul-terminated. It is the caller's responsibility to
-// ensure that @val has at least @len addressable bytes.
+// ensure that val has at least len addressable bytes.
 //
-// If @len is negative, @val must be nul-terminated and @len
+// If len is negative, val must be nul-terminated and len
 // is considered to request the entire string length.
 //
-// If @pos is -1, bytes are inserted at the end of the string.
+// If pos is -1, bytes are inserted at the end of the string.
 func (x *String) InsertLen(PosVar int, ValVar string, LenVar int) *String {
 
 	cret := xStringInsertLen(x.GoPointer(), PosVar, ValVar, LenVar)
 	return cret
 }
 
-var xStringInsertUnichar func(uintptr, int, uint32) *String
+var xStringInsertUnichar func(uintptr, int, rune) *String
 
 // Converts a Unicode character into UTF-8, and insert it
 // into the string at the given position.
-func (x *String) InsertUnichar(PosVar int, WcVar uint32) *String {
+func (x *String) InsertUnichar(PosVar int, WcVar rune) *String {
 
 	cret := xStringInsertUnichar(x.GoPointer(), PosVar, WcVar)
 	return cret
@@ -363,7 +356,7 @@ func (x *String) OverwriteLen(PosVar uint, ValVar string, LenVar int) *String {
 
 var xStringPrepend func(uintptr, string) *String
 
-// Adds a string on to the start of a #GString,
+// Adds a string on to the start of a [String],
 // expanding it if necessary.
 func (x *String) Prepend(ValVar string) *String {
 
@@ -373,7 +366,7 @@ func (x *String) Prepend(ValVar string) *String {
 
 var xStringPrependC func(uintptr, byte) *String
 
-// Adds a byte onto the start of a #GString,
+// Adds a byte onto the start of a [String],
 // expanding it if necessary.
 func (x *String) PrependC(CVar byte) *String {
 
@@ -383,26 +376,26 @@ func (x *String) PrependC(CVar byte) *String {
 
 var xStringPrependLen func(uintptr, string, int) *String
 
-// Prepends @len bytes of @val to @string.
+// Prepends len bytes of val to string.
 //
-// If @len is positive, @val may contain embedded nuls and need
+// If len is positive, val may contain embedded nuls and need
 // not be nul-terminated. It is the caller's responsibility to
-// ensure that @val has at least @len addressable bytes.
+// ensure that val has at least len addressable bytes.
 //
-// If @len is negative, @val must be nul-terminated and @len
+// If len is negative, val must be nul-terminated and len
 // is considered to request the entire string length. This
-// makes g_string_prepend_len() equivalent to g_string_prepend().
+// makes [String.PrependLen] equivalent to [String.Prepend].
 func (x *String) PrependLen(ValVar string, LenVar int) *String {
 
 	cret := xStringPrependLen(x.GoPointer(), ValVar, LenVar)
 	return cret
 }
 
-var xStringPrependUnichar func(uintptr, uint32) *String
+var xStringPrependUnichar func(uintptr, rune) *String
 
 // Converts a Unicode character into UTF-8, and prepends it
 // to the string.
-func (x *String) PrependUnichar(WcVar uint32) *String {
+func (x *String) PrependUnichar(WcVar rune) *String {
 
 	cret := xStringPrependUnichar(x.GoPointer(), WcVar)
 	return cret
@@ -410,25 +403,27 @@ func (x *String) PrependUnichar(WcVar uint32) *String {
 
 var xStringPrintf func(uintptr, string, ...interface{})
 
-// Writes a formatted string into a #GString.
+// Writes a formatted string into a [String].
 // This is similar to the standard sprintf() function,
-// except that the #GString buffer automatically expands
+// except that the [String] buffer automatically expands
 // to contain the results. The previous contents of the
-// #GString are destroyed.
+// [String] are destroyed.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *String) Printf(FormatVar string, varArgs ...interface{}) {
 
-	xStringPrintf(x.GoPointer(), FormatVar, varArgs...)
+	xStringPrintf(x.GoPointer(), "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xStringReplace func(uintptr, string, string, uint) uint
 
-// Replaces the string @find with the string @replace in a #GString up to
-// @limit times. If the number of instances of @find in the #GString is
-// less than @limit, all instances are replaced. If @limit is `0`,
-// all instances of @find are replaced.
+// Replaces the string find with the string replace in a [String] up to
+// limit times. If the number of instances of find in the [String] is
+// less than limit, all instances are replaced. If limit is `0`,
+// all instances of find are replaced.
 //
-// If @find is the empty string, since versions 2.69.1 and 2.68.4 the
+// If find is the empty string, since versions 2.69.1 and 2.68.4 the
 // replacement will be inserted no more than once per possible position
 // (beginning of string, end of string and between characters). This did
 // not work correctly in earlier versions.
@@ -440,11 +435,11 @@ func (x *String) Replace(FindVar string, ReplaceVar string, LimitVar uint) uint
 
 var xStringSetSize func(uintptr, uint) *String
 
-// Sets the length of a #GString. If the length is less than
+// Sets the length of a [String]. If the length is less than
 // the current length, the string will be truncated. If the
 // length is greater than the current length, the contents
 // of the newly added area are undefined. (However, as
-// always, string-&gt;str[string-&gt;len] will be a nul byte.)
+// always, string->str[string->len] will be a nul byte.)
 func (x *String) SetSize(LenVar uint) *String {
 
 	cret := xStringSetSize(x.GoPointer(), LenVar)
@@ -453,26 +448,17 @@ func (x *String) SetSize(LenVar uint) *String {
 
 var xStringTruncate func(uintptr, uint) *String
 
-// Cuts off the end of the GString, leaving the first @len bytes.
+// Cuts off the end of the GString, leaving the first len bytes.
 func (x *String) Truncate(LenVar uint) *String {
 
 	cret := xStringTruncate(x.GoPointer(), LenVar)
 	return cret
 }
 
-var xStringUp func(uintptr) *String
-
-// Converts a #GString to uppercase.
-func (x *String) Up() *String {
-
-	cret := xStringUp(x.GoPointer())
-	return cret
-}
-
 var xStringVprintf func(uintptr, string, []interface{})
 
-// Writes a formatted string into a #GString.
-// This function is similar to g_string_printf() except that
+// Writes a formatted string into a [String].
+// This function is similar to [String.Printf] except that
 // the arguments to the format string are passed as a va_list.
 func (x *String) Vprintf(FormatVar string, ArgsVar []interface{}) {
 
@@ -485,7 +471,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -494,44 +480,42 @@ func init() {
 
 	core.PuregoSafeRegister(&xStringGLibType, libs, "g_gstring_get_type")
 
-	core.PuregoSafeRegister(&xNewString, libs, "g_string_new")
-	core.PuregoSafeRegister(&xNewStringLen, libs, "g_string_new_len")
-	core.PuregoSafeRegister(&xNewStringTake, libs, "g_string_new_take")
-	core.PuregoSafeRegister(&xStringSizedNew, libs, "g_string_sized_new")
-
-	core.PuregoSafeRegister(&xStringAppend, libs, "g_string_append")
-	core.PuregoSafeRegister(&xStringAppendC, libs, "g_string_append_c")
-	core.PuregoSafeRegister(&xStringAppendLen, libs, "g_string_append_len")
-	core.PuregoSafeRegister(&xStringAppendPrintf, libs, "g_string_append_printf")
-	core.PuregoSafeRegister(&xStringAppendUnichar, libs, "g_string_append_unichar")
-	core.PuregoSafeRegister(&xStringAppendUriEscaped, libs, "g_string_append_uri_escaped")
-	core.PuregoSafeRegister(&xStringAppendVprintf, libs, "g_string_append_vprintf")
-	core.PuregoSafeRegister(&xStringAsciiDown, libs, "g_string_ascii_down")
-	core.PuregoSafeRegister(&xStringAsciiUp, libs, "g_string_ascii_up")
-	core.PuregoSafeRegister(&xStringAssign, libs, "g_string_assign")
-	core.PuregoSafeRegister(&xStringCopy, libs, "g_string_copy")
-	core.PuregoSafeRegister(&xStringDown, libs, "g_string_down")
-	core.PuregoSafeRegister(&xStringEqual, libs, "g_string_equal")
-	core.PuregoSafeRegister(&xStringErase, libs, "g_string_erase")
-	core.PuregoSafeRegister(&xStringFree, libs, "g_string_free")
-	core.PuregoSafeRegister(&xStringFreeAndSteal, libs, "g_string_free_and_steal")
-	core.PuregoSafeRegister(&xStringFreeToBytes, libs, "g_string_free_to_bytes")
-	core.PuregoSafeRegister(&xStringHash, libs, "g_string_hash")
-	core.PuregoSafeRegister(&xStringInsert, libs, "g_string_insert")
-	core.PuregoSafeRegister(&xStringInsertC, libs, "g_string_insert_c")
-	core.PuregoSafeRegister(&xStringInsertLen, libs, "g_string_insert_len")
-	core.PuregoSafeRegister(&xStringInsertUnichar, libs, "g_string_insert_unichar")
-	core.PuregoSafeRegister(&xStringOverwrite, libs, "g_string_overwrite")
-	core.PuregoSafeRegister(&xStringOverwriteLen, libs, "g_string_overwrite_len")
-	core.PuregoSafeRegister(&xStringPrepend, libs, "g_string_prepend")
-	core.PuregoSafeRegister(&xStringPrependC, libs, "g_string_prepend_c")
-	core.PuregoSafeRegister(&xStringPrependLen, libs, "g_string_prepend_len")
-	core.PuregoSafeRegister(&xStringPrependUnichar, libs, "g_string_prepend_unichar")
-	core.PuregoSafeRegister(&xStringPrintf, libs, "g_string_printf")
-	core.PuregoSafeRegister(&xStringReplace, libs, "g_string_replace")
-	core.PuregoSafeRegister(&xStringSetSize, libs, "g_string_set_size")
-	core.PuregoSafeRegister(&xStringTruncate, libs, "g_string_truncate")
-	core.PuregoSafeRegister(&xStringUp, libs, "g_string_up")
-	core.PuregoSafeRegister(&xStringVprintf, libs, "g_string_vprintf")
+	core.PuregoRegisterSince(&xNewString, libs, "g_string_new", "GLIB", "")
+	core.PuregoRegisterSince(&xNewStringLen, libs, "g_string_new_len", "GLIB", "")
+	core.PuregoRegisterSince(&xNewStringTake, libs, "g_string_new_take", "GLIB", "2.78")
+	core.PuregoRegisterSince(&xStringSizedNew, libs, "g_string_sized_new", "GLIB", "")
+
+	core.PuregoRegisterSince(&xStringAppend, libs, "g_string_append", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAppendC, libs, "g_string_append_c", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAppendLen, libs, "g_string_append_len", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAppendPrintf, libs, "g_string_append_printf", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAppendUnichar, libs, "g_string_append_unichar", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAppendUriEscaped, libs, "g_string_append_uri_escaped", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xStringAppendVprintf, libs, "g_string_append_vprintf", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xStringAsciiDown, libs, "g_string_ascii_down", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAsciiUp, libs, "g_string_ascii_up", "GLIB", "")
+	core.PuregoRegisterSince(&xStringAssign, libs, "g_string_assign", "GLIB", "")
+	core.PuregoRegisterSince(&xStringCopy, libs, "g_string_copy", "GLIB", "2.86")
+	core.PuregoRegisterSince(&xStringEqual, libs, "g_string_equal", "GLIB", "")
+	core.PuregoRegisterSince(&xStringErase, libs, "g_string_erase", "GLIB", "")
+	core.PuregoRegisterSince(&xStringFree, libs, "g_string_free", "GLIB", "")
+	core.PuregoRegisterSince(&xStringFreeAndSteal, libs, "g_string_free_and_steal", "GLIB", "2.76")
+	core.PuregoRegisterSince(&xStringFreeToBytes, libs, "g_string_free_to_bytes", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xStringHash, libs, "g_string_hash", "GLIB", "")
+	core.PuregoRegisterSince(&xStringInsert, libs, "g_string_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xStringInsertC, libs, "g_string_insert_c", "GLIB", "")
+	core.PuregoRegisterSince(&xStringInsertLen, libs, "g_string_insert_len", "GLIB", "")
+	core.PuregoRegisterSince(&xStringInsertUnichar, libs, "g_string_insert_unichar", "GLIB", "")
+	core.PuregoRegisterSince(&xStringOverwrite, libs, "g_string_overwrite", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xStringOverwriteLen, libs, "g_string_overwrite_len", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xStringPrepend, libs, "g_string_prepend", "GLIB", "")
+	core.PuregoRegisterSince(&xStringPrependC, libs, "g_string_prepend_c", "GLIB", "")
+	core.PuregoRegisterSince(&xStringPrependLen, libs, "g_string_prepend_len", "GLIB", "")
+	core.PuregoRegisterSince(&xStringPrependUnichar, libs, "g_string_prepend_unichar", "GLIB", "")
+	core.PuregoRegisterSince(&xStringPrintf, libs, "g_string_printf", "GLIB", "")
+	core.PuregoRegisterSince(&xStringReplace, libs, "g_string_replace", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStringSetSize, libs, "g_string_set_size", "GLIB", "")
+	core.PuregoRegisterSince(&xStringTruncate, libs, "g_string_truncate", "GLIB", "")
+	core.PuregoRegisterSince(&xStringVprintf, libs, "g_string_vprintf", "GLIB", "2.14")
 
 }
@@ -0,0 +1,53 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xStringDown func(uintptr) *String
+
+// Converts a [String] to lowercase.
+//
+// Deprecated: since 2.2. This function uses the locale-specific
+//
+//	tolower() function, which is almost never the right thing.
+//	Use [String.AsciiDown] or [Utf8Strdown] instead.
+func (x *String) Down() *String {
+
+	cret := xStringDown(x.GoPointer())
+	return cret
+}
+
+var xStringUp func(uintptr) *String
+
+// Converts a [String] to uppercase.
+//
+// Deprecated: since 2.2. This function uses the locale-specific
+//
+//	toupper() function, which is almost never the right thing.
+//	Use [String.AsciiUp] or [Utf8Strup] instead.
+func (x *String) Up() *String {
+
+	cret := xStringUp(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xStringDown, libs, "g_string_down", "GLIB", "")
+	core.PuregoRegisterSince(&xStringUp, libs, "g_string_up", "GLIB", "")
+
+}
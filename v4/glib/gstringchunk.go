@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -17,20 +16,20 @@ import (
 // is full a new block is allocated.
 //
 // When storing a large number of strings, string chunks are more
-// efficient than using [func@GLib.strdup] since fewer calls to `malloc()`
+// efficient than using [Strdup] since fewer calls to `malloc()`
 // are needed, and less memory is wasted in memory allocation overheads.
 //
-// By adding strings with [method@GLib.StringChunk.insert_const] it is also
+// By adding strings with [StringChunk.InsertConst] it is also
 // possible to remove duplicates.
 //
-// To create a new `GStringChunk` use [func@GLib.StringChunk.new].
+// To create a new `GStringChunk` use StringChunk.new.
 //
-// To add strings to a `GStringChunk` use [method@GLib.StringChunk.insert].
+// To add strings to a `GStringChunk` use [StringChunk.Insert].
 //
 // To add strings to a `GStringChunk`, but without duplicating strings
-// which are already in the `GStringChunk`, use [method@GLib.StringChunk.insert_const].
+// which are already in the `GStringChunk`, use [StringChunk.InsertConst].
 //
-// To free the entire `GStringChunk` use [method@GLib.StringChunk.free].
+// To free the entire `GStringChunk` use [StringChunk.Free].
 // It is not possible to free individual strings.
 type StringChunk struct {
 	_ structs.HostLayout
@@ -42,8 +41,8 @@ func (x *StringChunk) GoPointer() uintptr {
 
 var xStringChunkClear func(uintptr)
 
-// Frees all strings contained within the #GStringChunk.
-// After calling g_string_chunk_clear() it is not safe to
+// Frees all strings contained within the [StringChunk].
+// After calling [StringChunk.Clear] it is not safe to
 // access any of the strings which were contained within it.
 func (x *StringChunk) Clear() {
 
@@ -53,8 +52,8 @@ func (x *StringChunk) Clear() {
 
 var xStringChunkFree func(uintptr)
 
-// Frees all memory allocated by the #GStringChunk.
-// After calling g_string_chunk_free() it is not safe to
+// Frees all memory allocated by the [StringChunk].
+// After calling [StringChunk.Free] it is not safe to
 // access any of the strings which were contained within it.
 func (x *StringChunk) Free() {
 
@@ -64,16 +63,16 @@ func (x *StringChunk) Free() {
 
 var xStringChunkInsert func(uintptr, string) string
 
-// Adds a copy of @string to the #GStringChunk.
+// Adds a copy of string to the [StringChunk].
 // It returns a pointer to the new copy of the string
-// in the #GStringChunk. The characters in the string
+// in the [StringChunk]. The characters in the string
 // can be changed, if necessary, though you should not
 // change anything after the end of the string.
 //
-// Unlike g_string_chunk_insert_const(), this function
+// Unlike [StringChunk.InsertConst], this function
 // does not check for duplicates. Also strings added
-// with g_string_chunk_insert() will not be searched
-// by g_string_chunk_insert_const() when looking for
+// with [StringChunk.Insert] will not be searched
+// by [StringChunk.InsertConst] when looking for
 // duplicates.
 func (x *StringChunk) Insert(StringVar string) string {
 
@@ -83,9 +82,9 @@ func (x *StringChunk) Insert(StringVar string) string {
 
 var xStringChunkInsertConst func(uintptr, string) string
 
-// Adds a copy of @string to the #GStringChunk, unless the same
-// string has already been added to the #GStringChunk with
-// g_string_chunk_insert_const().
+// Adds a copy of string to the [StringChunk], unless the same
+// string has already been added to the [StringChunk] with
+// [StringChunk.InsertConst].
 //
 // This function is useful if you need to copy a large number
 // of strings but do not want to waste space storing duplicates.
@@ -93,8 +92,8 @@ var xStringChunkInsertConst func(uintptr, string) string
 // the same string, and so any changes made to the strings
 // should be done very carefully.
 //
-// Note that g_string_chunk_insert_const() will not return a
-// pointer to a string added with g_string_chunk_insert(), even
+// Note that [StringChunk.InsertConst] will not return a
+// pointer to a string added with [StringChunk.Insert], even
 // if they do match.
 func (x *StringChunk) InsertConst(StringVar string) string {
 
@@ -104,11 +103,11 @@ func (x *StringChunk) InsertConst(StringVar string) string {
 
 var xStringChunkInsertLen func(uintptr, string, int) string
 
-// Adds a copy of the first @len bytes of @string to the #GStringChunk.
+// Adds a copy of the first len bytes of string to the [StringChunk].
 // The copy is nul-terminated.
 //
 // Since this function does not stop at nul bytes, it is the caller's
-// responsibility to ensure that @string has at least @len addressable
+// responsibility to ensure that string has at least len addressable
 // bytes.
 //
 // The characters in the returned string can be changed, if necessary,
@@ -124,17 +123,17 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xStringChunkClear, libs, "g_string_chunk_clear")
-	core.PuregoSafeRegister(&xStringChunkFree, libs, "g_string_chunk_free")
-	core.PuregoSafeRegister(&xStringChunkInsert, libs, "g_string_chunk_insert")
-	core.PuregoSafeRegister(&xStringChunkInsertConst, libs, "g_string_chunk_insert_const")
-	core.PuregoSafeRegister(&xStringChunkInsertLen, libs, "g_string_chunk_insert_len")
+	core.PuregoRegisterSince(&xStringChunkClear, libs, "g_string_chunk_clear", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xStringChunkFree, libs, "g_string_chunk_free", "GLIB", "")
+	core.PuregoRegisterSince(&xStringChunkInsert, libs, "g_string_chunk_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xStringChunkInsertConst, libs, "g_string_chunk_insert_const", "GLIB", "")
+	core.PuregoRegisterSince(&xStringChunkInsertLen, libs, "g_string_chunk_insert_len", "GLIB", "2.4")
 
 }
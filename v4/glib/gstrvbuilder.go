@@ -5,17 +5,14 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// `GStrvBuilder` is a helper object to build a %NULL-terminated string arrays.
+// `GStrvBuilder` is a helper object to build a nil-terminated string arrays.
 //
 // The following example shows how to build a two element array:
 //
-// ```c
-//
 //	g_autoptr(GStrvBuilder) builder = g_strv_builder_new ();
 //	g_strv_builder_add (builder, "hello");
 //	g_strv_builder_add (builder, "world");
@@ -23,8 +20,6 @@ import (
 //	g_auto(GStrv) array = g_strv_builder_end (builder);
 //
 //	g_assert_true (g_strv_equal (array, (const char *[]) { "hello", "world", NULL }));
-//
-// ```
 type StrvBuilder struct {
 	_ structs.HostLayout
 }
@@ -41,8 +36,8 @@ func (x *StrvBuilder) GoPointer() uintptr {
 
 var xNewStrvBuilder func() *StrvBuilder
 
-// Creates a new #GStrvBuilder with a reference count of 1.
-// Use g_strv_builder_unref() on the returned value when no longer needed.
+// Creates a new [StrvBuilder] with a reference count of 1.
+// Use [StrvBuilder.Unref] on the returned value when no longer needed.
 func NewStrvBuilder() *StrvBuilder {
 
 	cret := xNewStrvBuilder()
@@ -82,20 +77,20 @@ func (x *StrvBuilder) Addv(ValueVar []string) {
 
 }
 
-var xStrvBuilderEnd func(uintptr) []string
+var xStrvBuilderEnd func(uintptr) uintptr
 
 // Ends the builder process and returns the constructed NULL-terminated string
-// array. The returned value should be freed with g_strfreev() when no longer
+// array. The returned value should be freed with [Strfreev] when no longer
 // needed.
 func (x *StrvBuilder) End() []string {
 
 	cret := xStrvBuilderEnd(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xStrvBuilderRef func(uintptr) *StrvBuilder
 
-// Atomically increments the reference count of @builder by one.
+// Atomically increments the reference count of builder by one.
 // This function is thread-safe and may be called from any thread.
 func (x *StrvBuilder) Ref() *StrvBuilder {
 
@@ -105,8 +100,8 @@ func (x *StrvBuilder) Ref() *StrvBuilder {
 
 var xStrvBuilderTake func(uintptr, uintptr)
 
-// Add a string to the end of the array. After @value belongs to the
-// #GStrvBuilder and may no longer be modified by the caller.
+// Add a string to the end of the array. After value belongs to the
+// [StrvBuilder] and may no longer be modified by the caller.
 //
 // Since 2.80
 func (x *StrvBuilder) Take(ValueVar string) {
@@ -117,17 +112,17 @@ func (x *StrvBuilder) Take(ValueVar string) {
 
 var xStrvBuilderUnref func(uintptr)
 
-// Decreases the reference count on @builder.
+// Decreases the reference count on builder.
 //
 // In the event that there are no more references, releases all memory
-// associated with the #GStrvBuilder.
+// associated with the [StrvBuilder].
 func (x *StrvBuilder) Unref() {
 
 	xStrvBuilderUnref(x.GoPointer())
 
 }
 
-var xStrvBuilderUnrefToStrv func(uintptr) []string
+var xStrvBuilderUnrefToStrv func(uintptr) uintptr
 
 // Decreases the reference count on the string vector builder, and returns
 // its contents as a `NULL`-terminated string array.
@@ -135,21 +130,19 @@ var xStrvBuilderUnrefToStrv func(uintptr) []string
 // This function is especially useful for cases where it's not possible
 // to use `g_autoptr()`.
 //
-// ```c
-// GStrvBuilder *builder = g_strv_builder_new ();
-// g_strv_builder_add (builder, "hello");
-// g_strv_builder_add (builder, "world");
+//	GStrvBuilder *builder = g_strv_builder_new ();
+//	g_strv_builder_add (builder, "hello");
+//	g_strv_builder_add (builder, "world");
 //
-// GStrv array = g_strv_builder_unref_to_strv (builder);
+//	GStrv array = g_strv_builder_unref_to_strv (builder);
 //
-// g_assert_true (g_strv_equal (array, (const char *[]) { "hello", "world", NULL }));
+//	g_assert_true (g_strv_equal (array, (const char *[]) { "hello", "world", NULL }));
 //
-// g_strfreev (array);
-// ```
+//	g_strfreev (array);
 func (x *StrvBuilder) UnrefToStrv() []string {
 
 	cret := xStrvBuilderUnrefToStrv(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 func init() {
@@ -157,7 +150,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -166,15 +159,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xStrvBuilderGLibType, libs, "g_strv_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewStrvBuilder, libs, "g_strv_builder_new")
+	core.PuregoRegisterSince(&xNewStrvBuilder, libs, "g_strv_builder_new", "GLIB", "2.68")
 
-	core.PuregoSafeRegister(&xStrvBuilderAdd, libs, "g_strv_builder_add")
-	core.PuregoSafeRegister(&xStrvBuilderAddMany, libs, "g_strv_builder_add_many")
-	core.PuregoSafeRegister(&xStrvBuilderAddv, libs, "g_strv_builder_addv")
-	core.PuregoSafeRegister(&xStrvBuilderEnd, libs, "g_strv_builder_end")
-	core.PuregoSafeRegister(&xStrvBuilderRef, libs, "g_strv_builder_ref")
-	core.PuregoSafeRegister(&xStrvBuilderTake, libs, "g_strv_builder_take")
-	core.PuregoSafeRegister(&xStrvBuilderUnref, libs, "g_strv_builder_unref")
-	core.PuregoSafeRegister(&xStrvBuilderUnrefToStrv, libs, "g_strv_builder_unref_to_strv")
+	core.PuregoRegisterSince(&xStrvBuilderAdd, libs, "g_strv_builder_add", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderAddMany, libs, "g_strv_builder_add_many", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderAddv, libs, "g_strv_builder_addv", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderEnd, libs, "g_strv_builder_end", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderRef, libs, "g_strv_builder_ref", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderTake, libs, "g_strv_builder_take", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderUnref, libs, "g_strv_builder_unref", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xStrvBuilderUnrefToStrv, libs, "g_strv_builder_unref_to_strv", "GLIB", "2.82")
 
 }
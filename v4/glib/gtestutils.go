@@ -2,10 +2,11 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -18,12 +19,12 @@ type TestDataFunc func(uintptr)
 // This is used for the fixture setup and teardown functions
 // as well as for the testcases themselves.
 //
-// @user_data is a pointer to the data that was given when
+// user_data is a pointer to the data that was given when
 // registering the test case.
 //
-// @fixture will be a pointer to the area of memory allocated by the
+// fixture will be a pointer to the area of memory allocated by the
 // test framework, of the size requested.  If the requested size was
-// zero then @fixture will be equal to @user_data.
+// zero then fixture will be equal to user_data.
 type TestFixtureFunc func(uintptr, uintptr)
 
 // The type used for test case functions.
@@ -43,7 +44,7 @@ func (x *TestCase) GoPointer() uintptr {
 
 var xTestCaseFree func(uintptr)
 
-// Free the @test_case.
+// Free the test_case.
 func (x *TestCase) Free() {
 
 	xTestCaseFree(x.GoPointer())
@@ -147,7 +148,7 @@ func (x *TestSuite) GoPointer() uintptr {
 
 var xTestSuiteAdd func(uintptr, *TestCase)
 
-// Adds @test_case to @suite.
+// Adds test_case to suite.
 func (x *TestSuite) Add(TestCaseVar *TestCase) {
 
 	xTestSuiteAdd(x.GoPointer(), TestCaseVar)
@@ -156,7 +157,7 @@ func (x *TestSuite) Add(TestCaseVar *TestCase) {
 
 var xTestSuiteAddSuite func(uintptr, *TestSuite)
 
-// Adds @nestedsuite to @suite.
+// Adds nestedsuite to suite.
 func (x *TestSuite) AddSuite(NestedsuiteVar *TestSuite) {
 
 	xTestSuiteAddSuite(x.GoPointer(), NestedsuiteVar)
@@ -165,7 +166,7 @@ func (x *TestSuite) AddSuite(NestedsuiteVar *TestSuite) {
 
 var xTestSuiteFree func(uintptr)
 
-// Frees the @suite and all nested suites.
+// Frees the suite and all nested suites.
 func (x *TestSuite) Free() {
 
 	xTestSuiteFree(x.GoPointer())
@@ -173,51 +174,51 @@ func (x *TestSuite) Free() {
 }
 
 const (
-	// A value that can be passed as an option to [func@GLib.test_init].
+	// A value that can be passed as an option to [TestInit].
 	//
 	// Creates a unique temporary directory for each unit test and uses sets
 	// XDG directories to point into subdirectories of it for the duration of
 	// the unit test. The directory tree is cleaned up after the test finishes
 	// successfully.
 	//
-	// Note that this doesn’t take effect until [func@GLib.test_run] is called,
-	// so calls to (for example) [func@GLib.get_home_dir] will return the
+	// Note that this doesn’t take effect until [TestRun] is called,
+	// so calls to (for example) [GetHomeDir] will return the
 	// system-wide value when made in a test program’s main() function.
 	//
 	// The following functions will return subdirectories of the temporary directory
 	// when this option is used. The specific subdirectory paths in use are not
 	// guaranteed to be stable API — always use a getter function to retrieve them.
 	//
-	//  - [func@GLib.get_home_dir]
-	//  - [func@GLib.get_user_cache_dir]
-	//  - [func@GLib.get_system_config_dirs]
-	//  - [func@GLib.get_user_config_dir]
-	//  - [func@GLib.get_system_data_dirs]
-	//  - [func@GLib.get_user_data_dir]
-	//  - [func@GLib.get_user_state_dir]
-	//  - [func@GLib.get_user_runtime_dir]
+	//  - [GetHomeDir]
+	//  - [GetUserCacheDir]
+	//  - [GetSystemConfigDirs]
+	//  - [GetUserConfigDir]
+	//  - [GetSystemDataDirs]
+	//  - [GetUserDataDir]
+	//  - [GetUserStateDir]
+	//  - [GetUserRuntimeDir]
 	//
 	// The subdirectories may not be created by the test harness; as with normal
-	// calls to functions like [func@GLib.get_user_cache_dir], the caller must
+	// calls to functions like [GetUserCacheDir], the caller must
 	// be prepared to create the directory if it doesn’t exist.
 	TEST_OPTION_ISOLATE_DIRS string = "isolate_dirs"
-	// A value that can be passed as an option to [func@GLib.test_init].
+	// A value that can be passed as an option to [TestInit].
 	//
 	// If this option is given, assertions will not abort the process, but
-	// call [func@GLib.test_fail]. Equivalent to [func@GLib.test_set_nonfatal_assertions].
+	// call [TestFail]. Equivalent to [TestSetNonfatalAssertions].
 	TEST_OPTION_NONFATAL_ASSERTIONS string = "nonfatal-assertions"
-	// A value that can be passed as an option to [func@GLib.test_init].
+	// A value that can be passed as an option to [TestInit].
 	//
-	// If this option is given, [func@GLib.test_init] will not call [func@GLib.set_prgname].
+	// If this option is given, [TestInit] will not call [SetPrgname].
 	TEST_OPTION_NO_PRGNAME string = "no_g_set_prgname"
 )
 
-// Flags to pass to [func@GLib.test_trap_subprocess] to control input and output.
+// Flags to pass to [TestTrapSubprocess] to control input and output.
 //
-// Note that in contrast with [func@GLib.test_trap_fork], the default
-// behavior of [func@GLib.test_trap_subprocess] is to not show stdout
+// Note that in contrast with [TestTrapFork], the default
+// behavior of [TestTrapSubprocess] is to not show stdout
 // and stderr.
-type TestSubprocessFlags int
+type TestSubprocessFlags uint32
 
 const (
 
@@ -230,19 +231,64 @@ const (
 	// If this flag is given, the child
 	//   process will inherit the parent's stdout. Otherwise, the child's
 	//   stdout will not be visible, but it will be captured to allow
-	//   later tests with [func@GLib.test_trap_assert_stdout].
+	//   later tests with test_trap_assert_stdout.
 	GTestSubprocessInheritStdoutValue TestSubprocessFlags = 2
 	// If this flag is given, the child
 	//   process will inherit the parent's stderr. Otherwise, the child's
 	//   stderr will not be visible, but it will be captured to allow
-	//   later tests with [func@GLib.test_trap_assert_stderr].
+	//   later tests with test_trap_assert_stderr.
 	GTestSubprocessInheritStderrValue TestSubprocessFlags = 4
 )
 
-// Flags to pass to [func@GLib.test_trap_fork] to control input and output.
+// Has reports whether all bits of flags are set in x
+func (x TestSubprocessFlags) Has(flags TestSubprocessFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TestSubprocessFlags) Set(flags TestSubprocessFlags) TestSubprocessFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TestSubprocessFlags) Clear(flags TestSubprocessFlags) TestSubprocessFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TestSubprocessFlags) String() string {
+	if x == 0 {
+		return "G_TEST_SUBPROCESS_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GTestSubprocessInheritStdinValue != 0 && x&GTestSubprocessInheritStdinValue == GTestSubprocessInheritStdinValue {
+		names = append(names, "G_TEST_SUBPROCESS_INHERIT_STDIN")
+		rest &^= GTestSubprocessInheritStdinValue
+	}
+	if rest&GTestSubprocessInheritStdoutValue != 0 && x&GTestSubprocessInheritStdoutValue == GTestSubprocessInheritStdoutValue {
+		names = append(names, "G_TEST_SUBPROCESS_INHERIT_STDOUT")
+		rest &^= GTestSubprocessInheritStdoutValue
+	}
+	if rest&GTestSubprocessInheritStderrValue != 0 && x&GTestSubprocessInheritStderrValue == GTestSubprocessInheritStderrValue {
+		names = append(names, "G_TEST_SUBPROCESS_INHERIT_STDERR")
+		rest &^= GTestSubprocessInheritStderrValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags to pass to [TestTrapFork] to control input and output.
 //
 // Test traps are guards around forked tests. These flags determine what traps to set.
-type TestTrapFlags int
+//
+// Deprecated: since 2.38. `GTestTrapFlags` is used only with [TestTrapFork],
+//
+//	which is deprecated. Its replacement, [TestTrapSubprocess] uses
+//	[TestSubprocessFlags].
+type TestTrapFlags uint32
 
 const (
 
@@ -264,8 +310,48 @@ const (
 	GTestTrapInheritStdinValue TestTrapFlags = 512
 )
 
+// Has reports whether all bits of flags are set in x
+func (x TestTrapFlags) Has(flags TestTrapFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TestTrapFlags) Set(flags TestTrapFlags) TestTrapFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TestTrapFlags) Clear(flags TestTrapFlags) TestTrapFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TestTrapFlags) String() string {
+	if x == 0 {
+		return "G_TEST_TRAP_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GTestTrapSilenceStdoutValue != 0 && x&GTestTrapSilenceStdoutValue == GTestTrapSilenceStdoutValue {
+		names = append(names, "G_TEST_TRAP_SILENCE_STDOUT")
+		rest &^= GTestTrapSilenceStdoutValue
+	}
+	if rest&GTestTrapSilenceStderrValue != 0 && x&GTestTrapSilenceStderrValue == GTestTrapSilenceStderrValue {
+		names = append(names, "G_TEST_TRAP_SILENCE_STDERR")
+		rest &^= GTestTrapSilenceStderrValue
+	}
+	if rest&GTestTrapInheritStdinValue != 0 && x&GTestTrapInheritStdinValue == GTestTrapInheritStdinValue {
+		names = append(names, "G_TEST_TRAP_INHERIT_STDIN")
+		rest &^= GTestTrapInheritStdinValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The type of file to return the filename for, when used with
-// [func@GLib.test_build_filename].
+// [TestBuildFilename].
 //
 // These two options correspond rather directly to the 'dist' and
 // 'built' terminology that automake uses and are explicitly used to
@@ -291,6 +377,39 @@ const (
 	GTestBuiltValue TestFileType = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TestFileType) String() string {
+	switch x {
+	case GTestDistValue:
+		return "G_TEST_DIST"
+	case GTestBuiltValue:
+		return "G_TEST_BUILT"
+	}
+	return fmt.Sprintf("TestFileType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TestFileType) Nick() string {
+	switch x {
+	case GTestDistValue:
+		return "dist"
+	case GTestBuiltValue:
+		return "built"
+	}
+	return ""
+}
+
+// TestFileTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TestFileTypeFromString(s string) (TestFileType, bool) {
+	switch s {
+	case "G_TEST_DIST", "dist":
+		return GTestDistValue, true
+	case "G_TEST_BUILT", "built":
+		return GTestBuiltValue, true
+	}
+	return 0, false
+}
+
 type TestLogType int
 
 const (
@@ -319,6 +438,99 @@ const (
 	GTestLogStopSuiteValue TestLogType = 11
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TestLogType) String() string {
+	switch x {
+	case GTestLogNoneValue:
+		return "G_TEST_LOG_NONE"
+	case GTestLogErrorValue:
+		return "G_TEST_LOG_ERROR"
+	case GTestLogStartBinaryValue:
+		return "G_TEST_LOG_START_BINARY"
+	case GTestLogListCaseValue:
+		return "G_TEST_LOG_LIST_CASE"
+	case GTestLogSkipCaseValue:
+		return "G_TEST_LOG_SKIP_CASE"
+	case GTestLogStartCaseValue:
+		return "G_TEST_LOG_START_CASE"
+	case GTestLogStopCaseValue:
+		return "G_TEST_LOG_STOP_CASE"
+	case GTestLogMinResultValue:
+		return "G_TEST_LOG_MIN_RESULT"
+	case GTestLogMaxResultValue:
+		return "G_TEST_LOG_MAX_RESULT"
+	case GTestLogMessageValue:
+		return "G_TEST_LOG_MESSAGE"
+	case GTestLogStartSuiteValue:
+		return "G_TEST_LOG_START_SUITE"
+	case GTestLogStopSuiteValue:
+		return "G_TEST_LOG_STOP_SUITE"
+	}
+	return fmt.Sprintf("TestLogType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TestLogType) Nick() string {
+	switch x {
+	case GTestLogNoneValue:
+		return "none"
+	case GTestLogErrorValue:
+		return "error"
+	case GTestLogStartBinaryValue:
+		return "start-binary"
+	case GTestLogListCaseValue:
+		return "list-case"
+	case GTestLogSkipCaseValue:
+		return "skip-case"
+	case GTestLogStartCaseValue:
+		return "start-case"
+	case GTestLogStopCaseValue:
+		return "stop-case"
+	case GTestLogMinResultValue:
+		return "min-result"
+	case GTestLogMaxResultValue:
+		return "max-result"
+	case GTestLogMessageValue:
+		return "message"
+	case GTestLogStartSuiteValue:
+		return "start-suite"
+	case GTestLogStopSuiteValue:
+		return "stop-suite"
+	}
+	return ""
+}
+
+// TestLogTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TestLogTypeFromString(s string) (TestLogType, bool) {
+	switch s {
+	case "G_TEST_LOG_NONE", "none":
+		return GTestLogNoneValue, true
+	case "G_TEST_LOG_ERROR", "error":
+		return GTestLogErrorValue, true
+	case "G_TEST_LOG_START_BINARY", "start-binary":
+		return GTestLogStartBinaryValue, true
+	case "G_TEST_LOG_LIST_CASE", "list-case":
+		return GTestLogListCaseValue, true
+	case "G_TEST_LOG_SKIP_CASE", "skip-case":
+		return GTestLogSkipCaseValue, true
+	case "G_TEST_LOG_START_CASE", "start-case":
+		return GTestLogStartCaseValue, true
+	case "G_TEST_LOG_STOP_CASE", "stop-case":
+		return GTestLogStopCaseValue, true
+	case "G_TEST_LOG_MIN_RESULT", "min-result":
+		return GTestLogMinResultValue, true
+	case "G_TEST_LOG_MAX_RESULT", "max-result":
+		return GTestLogMaxResultValue, true
+	case "G_TEST_LOG_MESSAGE", "message":
+		return GTestLogMessageValue, true
+	case "G_TEST_LOG_START_SUITE", "start-suite":
+		return GTestLogStartSuiteValue, true
+	case "G_TEST_LOG_STOP_SUITE", "stop-suite":
+		return GTestLogStopSuiteValue, true
+	}
+	return 0, false
+}
+
 type TestResult int
 
 const (
@@ -331,6 +543,51 @@ const (
 	GTestRunIncompleteValue TestResult = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TestResult) String() string {
+	switch x {
+	case GTestRunSuccessValue:
+		return "G_TEST_RUN_SUCCESS"
+	case GTestRunSkippedValue:
+		return "G_TEST_RUN_SKIPPED"
+	case GTestRunFailureValue:
+		return "G_TEST_RUN_FAILURE"
+	case GTestRunIncompleteValue:
+		return "G_TEST_RUN_INCOMPLETE"
+	}
+	return fmt.Sprintf("TestResult(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TestResult) Nick() string {
+	switch x {
+	case GTestRunSuccessValue:
+		return "success"
+	case GTestRunSkippedValue:
+		return "skipped"
+	case GTestRunFailureValue:
+		return "failure"
+	case GTestRunIncompleteValue:
+		return "incomplete"
+	}
+	return ""
+}
+
+// TestResultFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TestResultFromString(s string) (TestResult, bool) {
+	switch s {
+	case "G_TEST_RUN_SUCCESS", "success":
+		return GTestRunSuccessValue, true
+	case "G_TEST_RUN_SKIPPED", "skipped":
+		return GTestRunSkippedValue, true
+	case "G_TEST_RUN_FAILURE", "failure":
+		return GTestRunFailureValue, true
+	case "G_TEST_RUN_INCOMPLETE", "incomplete":
+		return GTestRunIncompleteValue, true
+	}
+	return 0, false
+}
+
 var xAssertionMessage func(string, string, int, string, string)
 
 func AssertionMessage(DomainVar string, FileVar string, LineVar int, FuncVar string, MessageVar string) {
@@ -350,9 +607,7 @@ func AssertionMessageCmpint(DomainVar string, FileVar string, LineVar int, FuncV
 var xAssertionMessageCmpnum func(string, string, int, string, string, float64, string, float64, byte)
 
 func AssertionMessageCmpnum(DomainVar string, FileVar string, LineVar int, FuncVar string, ExprVar string, Arg1Var float64, CmpVar string, Arg2Var float64, NumtypeVar byte) {
-
-	xAssertionMessageCmpnum(DomainVar, FileVar, LineVar, FuncVar, ExprVar, Arg1Var, CmpVar, Arg2Var, NumtypeVar)
-
+	core.UnsupportedABI("g_assertion_message_cmpnum", "arg1 is a long double, which purego cannot pass")
 }
 
 var xAssertionMessageCmpstr func(string, string, int, string, string, string, string, string)
@@ -397,7 +652,7 @@ func AssertionMessageExpr(DomainVar *string, FileVar string, LineVar int, FuncVa
 
 var xStrcmp0 func(uintptr, uintptr) int
 
-// Compares @str1 and @str2 like `strcmp()`.
+// Compares str1 and str2 like `strcmp()`.
 //
 // Handles `NULL` gracefully by sorting it before non-`NULL` strings.
 // Comparing two `NULL` pointers returns 0.
@@ -418,18 +673,18 @@ var xTestAddDataFunc func(string, uintptr, uintptr)
 
 // Creates a new test case.
 //
-// This function is similar to [func@GLib.test_create_case].
+// This function is similar to [TestCreateCase].
 // However the test is assumed to use no fixture, and test suites are
 // automatically created on the fly and added to the root fixture,
-// based on the /-separated portions of @testpath. The @test_data
-// argument will be passed as first argument to @test_func.
+// based on the /-separated portions of testpath. The test_data
+// argument will be passed as first argument to test_func.
 //
-// If @testpath includes the component "subprocess" anywhere in it,
+// If testpath includes the component "subprocess" anywhere in it,
 // the test will be skipped by default, and only run if explicitly
-// required via the `-p` command-line option or [func@GLib.test_trap_subprocess].
+// required via the `-p` command-line option or [TestTrapSubprocess].
 //
-// No component of @testpath may start with a dot (`.`) if the
-// [const@GLib.TEST_OPTION_ISOLATE_DIRS] option is being used;
+// No component of testpath may start with a dot (`.`) if the
+// [TEST_OPTION_ISOLATE_DIRS] option is being used;
 // and it is recommended to do so even if it isn’t.
 func TestAddDataFunc(TestpathVar string, TestDataVar uintptr, TestFuncVar *TestDataFunc) {
 
@@ -440,11 +695,12 @@ func TestAddDataFunc(TestpathVar string, TestDataVar uintptr, TestFuncVar *TestD
 			TestFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *TestFuncVar
 				cbFn(arg0)
 			}
-			TestFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
+			TestFuncVarRef = core.NewCallback(fcb)
+			TestFuncVarRef = SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
 		}
 	}
 
@@ -456,8 +712,8 @@ var xTestAddDataFuncFull func(string, uintptr, uintptr, uintptr)
 
 // Creates a new test case.
 //
-// In contrast to [func@GLib.test_add_data_func], this function
-// is freeing @test_data after the test run is complete.
+// In contrast to [TestAddDataFunc], this function
+// is freeing test_data after the test run is complete.
 func TestAddDataFuncFull(TestpathVar string, TestDataVar uintptr, TestFuncVar *TestDataFunc, DataFreeFuncVar *DestroyNotify) {
 
 	var TestFuncVarRef uintptr
@@ -467,11 +723,12 @@ func TestAddDataFuncFull(TestpathVar string, TestDataVar uintptr, TestFuncVar *T
 			TestFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *TestFuncVar
 				cbFn(arg0)
 			}
-			TestFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
+			TestFuncVarRef = core.NewCallback(fcb)
+			TestFuncVarRef = SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
 		}
 	}
 
@@ -482,11 +739,12 @@ func TestAddDataFuncFull(TestpathVar string, TestDataVar uintptr, TestFuncVar *T
 			DataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataFreeFuncVar
 				cbFn(arg0)
 			}
-			DataFreeFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataFreeFuncVarPtr, DataFreeFuncVarRef, DataFreeFuncVar)
+			DataFreeFuncVarRef = core.NewCallback(fcb)
+			DataFreeFuncVarRef = SaveCallbackWithClosure(DataFreeFuncVarPtr, DataFreeFuncVarRef, DataFreeFuncVar)
 		}
 	}
 
@@ -498,17 +756,17 @@ var xTestAddFunc func(string, uintptr)
 
 // Creates a new test case.
 //
-// This function is similar to [func@GLib.test_create_case].
+// This function is similar to [TestCreateCase].
 // However the test is assumed to use no fixture, and test suites are
 // automatically created on the fly and added to the root fixture,
-// based on the /-separated portions of @testpath.
+// based on the /-separated portions of testpath.
 //
-// If @testpath includes the component "subprocess" anywhere in it,
+// If testpath includes the component "subprocess" anywhere in it,
 // the test will be skipped by default, and only run if explicitly
-// required via the `-p` command-line option or [func@GLib.test_trap_subprocess].
+// required via the `-p` command-line option or [TestTrapSubprocess].
 //
-// No component of @testpath may start with a dot (`.`) if the
-// [const@GLib.TEST_OPTION_ISOLATE_DIRS] option is being used; and
+// No component of testpath may start with a dot (`.`) if the
+// [TEST_OPTION_ISOLATE_DIRS] option is being used; and
 // it is recommended to do so even if it isn’t.
 func TestAddFunc(TestpathVar string, TestFuncVar *TestFunc) {
 
@@ -519,11 +777,12 @@ func TestAddFunc(TestpathVar string, TestFuncVar *TestFunc) {
 			TestFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *TestFuncVar
 				cbFn()
 			}
-			TestFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
+			TestFuncVarRef = core.NewCallback(fcb)
+			TestFuncVarRef = SaveCallbackWithClosure(TestFuncVarPtr, TestFuncVarRef, TestFuncVar)
 		}
 	}
 
@@ -542,11 +801,12 @@ func TestAddVtable(TestpathVar string, DataSizeVar uint, TestDataVar uintptr, Da
 			DataSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataSetupVar
 				cbFn(arg0, arg1)
 			}
-			DataSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataSetupVarPtr, DataSetupVarRef, DataSetupVar)
+			DataSetupVarRef = core.NewCallback(fcb)
+			DataSetupVarRef = SaveCallbackWithClosure(DataSetupVarPtr, DataSetupVarRef, DataSetupVar)
 		}
 	}
 
@@ -557,11 +817,12 @@ func TestAddVtable(TestpathVar string, DataSizeVar uint, TestDataVar uintptr, Da
 			DataTestVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataTestVar
 				cbFn(arg0, arg1)
 			}
-			DataTestVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataTestVarPtr, DataTestVarRef, DataTestVar)
+			DataTestVarRef = core.NewCallback(fcb)
+			DataTestVarRef = SaveCallbackWithClosure(DataTestVarPtr, DataTestVarRef, DataTestVar)
 		}
 	}
 
@@ -572,11 +833,12 @@ func TestAddVtable(TestpathVar string, DataSizeVar uint, TestDataVar uintptr, Da
 			DataTeardownVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataTeardownVar
 				cbFn(arg0, arg1)
 			}
-			DataTeardownVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataTeardownVarPtr, DataTeardownVarRef, DataTeardownVar)
+			DataTeardownVarRef = core.NewCallback(fcb)
+			DataTeardownVarRef = SaveCallbackWithClosure(DataTeardownVarPtr, DataTeardownVarRef, DataTeardownVar)
 		}
 	}
 
@@ -596,14 +858,14 @@ var xTestBug func(string)
 
 // Adds a message to test reports that associates a bug URI with a test case.
 //
-// Bug URIs are constructed from a base URI set with [func@GLib.test_bug_base]
-// and @bug_uri_snippet. If [func@GLib.test_bug_base] has not been called, it is
+// Bug URIs are constructed from a base URI set with [TestBugBase]
+// and bug_uri_snippet. If [TestBugBase] has not been called, it is
 // assumed to be the empty string, so a full URI can be provided to
-// [func@GLib.test_bug] instead.
+// [TestBug] instead.
 //
-// See also [func@GLib.test_summary].
+// See also [TestSummary].
 //
-// Since GLib 2.70, the base URI is not prepended to @bug_uri_snippet
+// Since GLib 2.70, the base URI is not prepended to bug_uri_snippet
 // if it is already a valid URI.
 func TestBug(BugUriSnippetVar string) {
 
@@ -616,17 +878,17 @@ var xTestBugBase func(string)
 // Specifies the base URI for bug reports.
 //
 // The base URI is used to construct bug report messages for
-// [func@GLib.test_message] when [func@GLib.test_bug] is called.
+// [TestMessage] when [TestBug] is called.
 // Calling this function outside of a test case sets the
 // default base URI for all test cases. Calling it from within
 // a test case changes the base URI for the scope of the test
 // case only.
 // Bug URIs are constructed by appending a bug specific URI
-// portion to @uri_pattern, or by replacing the special string
-// `%s` within @uri_pattern if that is present.
+// portion to uri_pattern, or by replacing the special string
+// `%s` within uri_pattern if that is present.
 //
-// If [func@GLib.test_bug_base] is not called, bug URIs are formed
-// solely from the value provided by [func@GLib.test_bug].
+// If [TestBugBase] is not called, bug URIs are formed
+// solely from the value provided by [TestBug].
 func TestBugBase(UriPatternVar string) {
 
 	xTestBugBase(UriPatternVar)
@@ -637,13 +899,13 @@ var xTestBuildFilename func(TestFileType, string, ...interface{}) string
 
 // Creates the pathname to a data file that is required for a test.
 //
-// This function is conceptually similar to [func@GLib.build_filename]
+// This function is conceptually similar to [BuildFilename]
 // except that the first argument has been replaced with a
-// [enum@GLib.TestFileType] argument.
+// [TestFileType] argument.
 //
 // The data file should either have been distributed with the module
-// containing the test ([enum@GLib.TestFileType.dist] or built as part of the
-// buildcsystem of that module ([enum@GLib.TestFileType.built]).
+// containing the test ([GTestDistValue] or built as part of the
+// buildcsystem of that module ([GTestBuiltValue]).
 //
 // In order for this function to work in srcdir != builddir situations,
 // the `G_TEST_SRCDIR` and `G_TEST_BUILDDIR` environment variables need
@@ -666,23 +928,23 @@ func TestBuildFilename(FileTypeVar TestFileType, FirstPathVar string, varArgs ..
 
 var xTestCreateCase func(string, uint, uintptr, uintptr, uintptr, uintptr) *TestCase
 
-// Creates a new [struct@GLib.TestCase].
+// Creates a new [TestCase].
 //
-// This API is fairly low level, and calling [func@GLib.test_add] or
-// [func@GLib.test_add_func] is preferable.
+// This API is fairly low level, and calling test_add or
+// [TestAddFunc] is preferable.
 //
-// When this test is executed, a fixture structure of size @data_size
-// will be automatically allocated and filled with zeros. Then @data_setup
+// When this test is executed, a fixture structure of size data_size
+// will be automatically allocated and filled with zeros. Then data_setup
 // is called to initialize the fixture. After fixture setup, the actual test
-// function @data_test is called. Once the test run completes, the
-// fixture structure is torn down by calling @data_teardown and after
+// function data_test is called. Once the test run completes, the
+// fixture structure is torn down by calling data_teardown and after
 // that the memory is automatically released by the test framework.
 //
 // Splitting up a test run into fixture setup, test function and
 // fixture teardown is most useful if the same fixture type is used for
-// multiple tests. In this cases, [func@GLib.test_create_case] will be
-// called with the same type of fixture (the @data_size argument), but
-// varying @test_name and @data_test arguments.
+// multiple tests. In this cases, [TestCreateCase] will be
+// called with the same type of fixture (the data_size argument), but
+// varying test_name and data_test arguments.
 func TestCreateCase(TestNameVar string, DataSizeVar uint, TestDataVar uintptr, DataSetupVar *TestFixtureFunc, DataTestVar *TestFixtureFunc, DataTeardownVar *TestFixtureFunc) *TestCase {
 
 	var DataSetupVarRef uintptr
@@ -692,11 +954,12 @@ func TestCreateCase(TestNameVar string, DataSizeVar uint, TestDataVar uintptr, D
 			DataSetupVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataSetupVar
 				cbFn(arg0, arg1)
 			}
-			DataSetupVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataSetupVarPtr, DataSetupVarRef, DataSetupVar)
+			DataSetupVarRef = core.NewCallback(fcb)
+			DataSetupVarRef = SaveCallbackWithClosure(DataSetupVarPtr, DataSetupVarRef, DataSetupVar)
 		}
 	}
 
@@ -707,11 +970,12 @@ func TestCreateCase(TestNameVar string, DataSizeVar uint, TestDataVar uintptr, D
 			DataTestVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataTestVar
 				cbFn(arg0, arg1)
 			}
-			DataTestVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataTestVarPtr, DataTestVarRef, DataTestVar)
+			DataTestVarRef = core.NewCallback(fcb)
+			DataTestVarRef = SaveCallbackWithClosure(DataTestVarPtr, DataTestVarRef, DataTestVar)
 		}
 	}
 
@@ -722,11 +986,12 @@ func TestCreateCase(TestNameVar string, DataSizeVar uint, TestDataVar uintptr, D
 			DataTeardownVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataTeardownVar
 				cbFn(arg0, arg1)
 			}
-			DataTeardownVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DataTeardownVarPtr, DataTeardownVarRef, DataTeardownVar)
+			DataTeardownVarRef = core.NewCallback(fcb)
+			DataTeardownVarRef = SaveCallbackWithClosure(DataTeardownVarPtr, DataTeardownVarRef, DataTeardownVar)
 		}
 	}
 
@@ -737,7 +1002,7 @@ func TestCreateCase(TestNameVar string, DataSizeVar uint, TestDataVar uintptr, D
 
 var xTestCreateSuite func(string) *TestSuite
 
-// Creates a new test suite with the name @suite_name.
+// Creates a new test suite with the name suite_name.
 func TestCreateSuite(SuiteNameVar string) *TestSuite {
 
 	cret := xTestCreateSuite(SuiteNameVar)
@@ -760,43 +1025,39 @@ func TestDisableCrashReporting() {
 
 var xTestExpectMessage func(uintptr, LogLevelFlags, string)
 
-// Indicates that a message with the given @log_domain and @log_level,
-// with text matching @pattern, is expected to be logged.
+// Indicates that a message with the given log_domain and log_level,
+// with text matching pattern, is expected to be logged.
 //
 // When this message is logged, it will not be printed, and the test case will
 // not abort.
 //
-// This API may only be used with the old logging API ([func@GLib.log] without
+// This API may only be used with the old logging API ([Log] without
 // `G_LOG_USE_STRUCTURED` defined). It will not work with the structured logging
-// API. See [Testing for Messages](logging.html#testing-for-messages).
+// API. See Testing for Messages.
 //
-// Use [func@GLib.test_assert_expected_messages] to assert that all
+// Use test_assert_expected_messages to assert that all
 // previously-expected messages have been seen and suppressed.
 //
 // You can call this multiple times in a row, if multiple messages are
 // expected as a result of a single call. (The messages must appear in
-// the same order as the calls to [func@GLib.test_expect_message].)
+// the same order as the calls to [TestExpectMessage].)
 //
 // For example:
 //
-// ```c
-// // g_main_context_push_thread_default() should fail if the
-// // context is already owned by another thread.
-// g_test_expect_message (G_LOG_DOMAIN,
-//
-//	G_LOG_LEVEL_CRITICAL,
-//	"assertion*acquired_context*failed");
+//	// g_main_context_push_thread_default() should fail if the
+//	// context is already owned by another thread.
+//	g_test_expect_message (G_LOG_DOMAIN,
+//	                       G_LOG_LEVEL_CRITICAL,
+//	                       "assertion*acquired_context*failed");
+//	g_main_context_push_thread_default (bad_context);
+//	g_test_assert_expected_messages ();
 //
-// g_main_context_push_thread_default (bad_context);
-// g_test_assert_expected_messages ();
-// ```
+// Note that you cannot use this to test error messages, since
+// error intentionally never returns even if the program doesn’t
+// abort; use [TestTrapSubprocess] in this case.
 //
-// Note that you cannot use this to test [func@GLib.error] messages, since
-// [func@GLib.error] intentionally never returns even if the program doesn’t
-// abort; use [func@GLib.test_trap_subprocess] in this case.
-//
-// If messages at [flags@GLib.LogLevelFlags.LEVEL_DEBUG] are emitted, but not explicitly
-// expected via [func@GLib.test_expect_message] then they will be ignored.
+// If messages at LogLevelFlags.LEVEL_DEBUG are emitted, but not explicitly
+// expected via [TestExpectMessage] then they will be ignored.
 func TestExpectMessage(LogDomainVar *string, LogLevelVar LogLevelFlags, PatternVar string) {
 
 	LogDomainVarPtr := core.GStrdupNullable(LogDomainVar)
@@ -823,11 +1084,11 @@ var xTestFail func()
 //
 // If not called from inside a test, this function does nothing.
 //
-// Note that unlike [func@GLib.test_skip] and [func@GLib.test_incomplete],
+// Note that unlike [TestSkip] and [TestIncomplete],
 // this function does not log a message alongside the test failure.
 // If details of the test failure are available, either log them with
-// [func@GLib.test_message] before [func@GLib.test_fail], or use
-// [func@GLib.test_fail_printf] instead.
+// [TestMessage] before [TestFail], or use
+// [TestFailPrintf] instead.
 func TestFail() {
 
 	xTestFail()
@@ -838,9 +1099,9 @@ var xTestFailPrintf func(string, ...interface{})
 
 // Indicates that a test failed and records a message.
 //
-// Also see [func@GLib.test_fail].
+// Also see [TestFail].
 //
-// The message is formatted as if by [func@GLib.strdup_printf].
+// The message is formatted as if by [StrdupPrintf].
 func TestFailPrintf(FormatVar string, varArgs ...interface{}) {
 
 	xTestFailPrintf(FormatVar, varArgs...)
@@ -851,8 +1112,8 @@ var xTestFailed func() bool
 
 // Returns whether a test has already failed.
 //
-// This will be the case when [func@GLib.test_fail],
-// [func@GLib.test_incomplete] or [func@GLib.test_skip] have
+// This will be the case when [TestFail],
+// [TestIncomplete] or [TestSkip] have
 // been called, but also if an assertion has failed.
 //
 // This can be useful to return early from a test if
@@ -870,7 +1131,7 @@ func TestFailed() bool {
 var xTestGetDir func(TestFileType) string
 
 // Gets the pathname of the directory containing test files of the type
-// specified by @file_type.
+// specified by file_type.
 //
 // This is approximately the same as calling `g_test_build_filename(".")`,
 // but you don't need to free the return value.
@@ -885,7 +1146,7 @@ var xTestGetFilename func(TestFileType, string, ...interface{}) string
 
 // Gets the pathname to a data file that is required for a test.
 //
-// This is the same as [func@GLib.test_build_filename] with two differences.
+// This is the same as [TestBuildFilename] with two differences.
 // The first difference is that you must only use this function from within
 // a testcase function. The second difference is that you need not free
 // the return value — it will be automatically freed when the testcase
@@ -907,7 +1168,7 @@ var xTestGetPath func() string
 // Gets the test path for the test currently being run.
 //
 // In essence, it will be the same string passed as the first argument
-// to e.g. [func@GLib.test_add] when the test was added.
+// to e.g. test_add when the test was added.
 //
 // This function returns a valid string only within a test function.
 //
@@ -956,8 +1217,8 @@ var xTestIncompletePrintf func(string, ...interface{})
 // Indicates that a test failed because of some incomplete
 // functionality.
 //
-// Equivalent to [func@GLib.test_incomplete], but the explanation
-// is formatted as if by [func@GLib.strdup_printf].
+// Equivalent to [TestIncomplete], but the explanation
+// is formatted as if by [StrdupPrintf].
 func TestIncompletePrintf(FormatVar string, varArgs ...interface{}) {
 
 	xTestIncompletePrintf(FormatVar, varArgs...)
@@ -1001,28 +1262,28 @@ var xTestInit func(int, string, ...interface{})
 //     `quick`: Quick tests, should run really quickly and give good coverage (the default).
 //
 //     `undefined`: Tests for undefined behaviour, may provoke programming errors
-//     under [func@GLib.test_trap_subprocess] or [func@GLib.test_expect_message]
+//     under [TestTrapSubprocess] or [TestExpectMessage]
 //     to check that appropriate assertions or warnings are given (the default).
 //
 //     `no-undefined`: Avoid tests for undefined behaviour.
 //
 // - `--debug-log`: Debug test logging output.
 //
-// Any parsed arguments are removed from @argv, and @argc is adjust accordingly.
+// Any parsed arguments are removed from argv, and argc is adjust accordingly.
 //
 // The following options are supported:
 //
-//   - `G_TEST_OPTION_NO_PRGNAME`: Causes g_test_init() to not call
-//     [func@GLib.set_prgname]. Since. 2.84
+//   - `G_TEST_OPTION_NO_PRGNAME`: Causes [TestInit] to not call
+//     [SetPrgname]. Since. 2.84
 //   - `G_TEST_OPTION_ISOLATE_DIRS`: Creates a unique temporary directory for each
 //     unit test and sets XDG directories to point there for the duration of the unit
-//     test. See [const@GLib.TEST_OPTION_ISOLATE_DIRS].
+//     test. See [TEST_OPTION_ISOLATE_DIRS].
 //   - `G_TEST_OPTION_NONFATAL_ASSERTIONS`: This has the same effect as
-//     [func@GLib.test_set_nonfatal_assertions]. Since 2.84
+//     [TestSetNonfatalAssertions]. Since 2.84
 //
-// Since 2.58, if tests are compiled with `G_DISABLE_ASSERT` defined, `g_test_init()`
+// Since 2.58, if tests are compiled with `G_DISABLE_ASSERT` defined, `[TestInit]`
 // will print an error and exit. This is to prevent no-op tests from being executed,
-// as [func@GLib.assert] is commonly (erroneously) used in unit tests, and is a no-op
+// as assert is commonly (erroneously) used in unit tests, and is a no-op
 // when compiled with `G_DISABLE_ASSERT`. Ensure your tests are compiled without
 // `G_DISABLE_ASSERT` defined.
 func TestInit(ArgcVar int, ArgvVar string, varArgs ...interface{}) {
@@ -1050,10 +1311,10 @@ var xTestLogSetFatalHandler func(uintptr, uintptr)
 // This handler has no effect on g_error messages.
 //
 // This handler also has no effect on structured log messages (using
-// [func@GLib.log_structured] or [func@GLib.log_structured_array]). To change the fatal
+// [LogStructured] or [LogStructuredArray]). To change the fatal
 // behaviour for specific log messages, programs must install a custom log
-// writer function using [func@GLib.log_set_writer_func].See
-// [Using Structured Logging](logging.html#using-structured-logging).
+// writer function using [LogSetWriterFunc].See
+// Using Structured Logging.
 func TestLogSetFatalHandler(LogFuncVar *TestLogFatalFunc, UserDataVar uintptr) {
 
 	var LogFuncVarRef uintptr
@@ -1063,11 +1324,12 @@ func TestLogSetFatalHandler(LogFuncVar *TestLogFatalFunc, UserDataVar uintptr) {
 			LogFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 LogLevelFlags, arg2 uintptr, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *LogFuncVar
 				return cbFn(core.GoString(arg0), arg1, core.GoString(arg2), arg3)
 			}
-			LogFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
+			LogFuncVarRef = core.NewCallback(fcb)
+			LogFuncVarRef = SaveCallbackWithClosure(LogFuncVarPtr, LogFuncVarRef, LogFuncVar)
 		}
 	}
 
@@ -1090,7 +1352,7 @@ var xTestMaximizedResult func(float64, string, ...interface{})
 //
 // The test should generally strive to maximize the reported
 // quantities (larger values are better than smaller ones),
-// this and @maximized_quantity can determine sorting
+// this and maximized_quantity can determine sorting
 // order for test result reports.
 func TestMaximizedResult(MaximizedQuantityVar float64, FormatVar string, varArgs ...interface{}) {
 
@@ -1113,7 +1375,7 @@ var xTestMinimizedResult func(float64, string, ...interface{})
 //
 // The test should generally strive to minimize the reported
 // quantities (smaller values are better than larger ones),
-// this and @minimized_quantity can determine sorting
+// this and minimized_quantity can determine sorting
 // order for test result reports.
 func TestMinimizedResult(MinimizedQuantityVar float64, FormatVar string, varArgs ...interface{}) {
 
@@ -1123,7 +1385,7 @@ func TestMinimizedResult(MinimizedQuantityVar float64, FormatVar string, varArgs
 
 var xTestQueueDestroy func(uintptr, uintptr)
 
-// Enqueues a callback @destroy_func to be executed during the next test case
+// Enqueues a callback destroy_func to be executed during the next test case
 // teardown phase.
 //
 // This is most useful to auto destroy allocated test resources at the end
@@ -1139,11 +1401,12 @@ func TestQueueDestroy(DestroyFuncVar *DestroyNotify, DestroyDataVar uintptr) {
 			DestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFuncVar
 				cbFn(arg0)
 			}
-			DestroyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
+			DestroyFuncVarRef = core.NewCallback(fcb)
+			DestroyFuncVarRef = SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
 		}
 	}
 
@@ -1153,11 +1416,11 @@ func TestQueueDestroy(DestroyFuncVar *DestroyNotify, DestroyDataVar uintptr) {
 
 var xTestQueueFree func(uintptr)
 
-// Enqueues a pointer to be released with [func@GLib.free]
+// Enqueues a pointer to be released with [Free]
 // during the next teardown phase.
 //
-// This is equivalent to calling [func@GLib.test_queue_destroy]
-// with a destroy callback of [func@GLib.free].
+// This is equivalent to calling [TestQueueDestroy]
+// with a destroy callback of [Free].
 func TestQueueFree(GfreePointerVar uintptr) {
 
 	xTestQueueFree(GfreePointerVar)
@@ -1168,7 +1431,7 @@ var xTestRandDouble func() float64
 
 // Gets a reproducible random floating point number.
 //
-// See [func@GLib.test_rand_int] for details on test case random numbers.
+// See [TestRandInt] for details on test case random numbers.
 func TestRandDouble() float64 {
 
 	cret := xTestRandDouble()
@@ -1180,7 +1443,7 @@ var xTestRandDoubleRange func(float64, float64) float64
 
 // Gets a reproducible random floating point number out of a specified range.
 //
-// See [func@GLib.test_rand_int] for details on test case random numbers.
+// See [TestRandInt] for details on test case random numbers.
 func TestRandDoubleRange(RangeStartVar float64, RangeEndVar float64) float64 {
 
 	cret := xTestRandDoubleRange(RangeStartVar, RangeEndVar)
@@ -1210,7 +1473,7 @@ var xTestRandIntRange func(int32, int32) int32
 
 // Gets a reproducible random integer number out of a specified range.
 //
-// See [func@GLib.test_rand_int] for details on test case random numbers.
+// See [TestRandInt] for details on test case random numbers.
 func TestRandIntRange(BeginVar int32, EndVar int32) int32 {
 
 	cret := xTestRandIntRange(BeginVar, EndVar)
@@ -1222,12 +1485,12 @@ var xTestRun func() int
 
 // Runs all tests under the toplevel suite.
 //
-// The toplevel suite can be retrieved with [func@GLib.test_get_root].
+// The toplevel suite can be retrieved with [TestGetRoot].
 //
-// Similar to [func@GLib.test_run_suite], the test cases to be run are
+// Similar to [TestRunSuite], the test cases to be run are
 // filtered according to test path arguments (`-p testpath` and `-s testpath`)
-// as parsed by [func@GLib.test_init]. [func@GLib.test_run_suite] or
-// [func@GLib.test_run] may only be called once in a program.
+// as parsed by [TestInit]. [TestRunSuite] or
+// [TestRun] may only be called once in a program.
 //
 // In general, the tests and sub-suites within each suite are run in
 // the order in which they are defined. However, note that prior to
@@ -1235,7 +1498,7 @@ var xTestRun func() int
 // functions which caused them to create multiple suites with the same
 // name, meaning that if you created tests "/foo/simple",
 // "/bar/simple", and "/foo/using-bar" in that order, they would get
-// run in that order (since [func@GLib.test_run] would run the first "/foo"
+// run in that order (since [TestRun] would run the first "/foo"
 // suite, then the "/bar" suite, then the second "/foo" suite). As of
 // 2.36, this bug is fixed, and adding the tests in that order would
 // result in a running order of "/foo/simple", "/foo/using-bar",
@@ -1249,7 +1512,7 @@ var xTestRun func() int
 // However, you should never make the actual result of a test depend
 // on the order that tests are run in. If you need to ensure that some
 // particular code runs before or after a given test case, use
-// [func@GLib.test_add], which lets you specify setup and teardown functions.
+// test_add, which lets you specify setup and teardown functions.
 //
 // If all tests are skipped or marked as incomplete (expected failures),
 // this function will return 0 if producing TAP output, or 77 (treated
@@ -1263,14 +1526,14 @@ func TestRun() int {
 
 var xTestRunSuite func(*TestSuite) int
 
-// Executes the tests within @suite and all nested test suites.
+// Executes the tests within suite and all nested test suites.
 //
 // The test suites to be executed are filtered according to
 // test path arguments (`-p testpath` and `-s testpath`) as parsed by
-// [func@GLib.test_init]. See the [func@GLib.test_run] documentation
+// [TestInit]. See the [TestRun] documentation
 // for more information on the order that tests are run in.
 //
-// [func@GLib.test_run_suite] or [func@GLib.test_run] may only be
+// [TestRunSuite] or [TestRun] may only be
 // called once in a program.
 func TestRunSuite(SuiteVar *TestSuite) int {
 
@@ -1287,15 +1550,15 @@ var xTestSetNonfatalAssertions func()
 // and the various `g_test_trap_assert_*()` macros are changed
 // to not abort to program.
 //
-// Instead, they will call [func@GLib.test_fail] and continue.
-// (This also changes the behavior of [func@GLib.test_fail] so that
+// Instead, they will call [TestFail] and continue.
+// (This also changes the behavior of [TestFail] so that
 // it will not cause the test program to abort after completing
 // the failed test.)
 //
-// Note that the [func@GLib.assert_not_reached] and [func@GLib.assert]
+// Note that the assert_not_reached and assert
 // macros are not affected by this.
 //
-// This function can only be called after [func@GLib.test_init].
+// This function can only be called after [TestInit].
 func TestSetNonfatalAssertions() {
 
 	xTestSetNonfatalAssertions()
@@ -1325,8 +1588,8 @@ var xTestSkipPrintf func(string, ...interface{})
 
 // Indicates that a test was skipped.
 //
-// Equivalent to [func@GLib.test_skip], but the explanation
-// is formatted as if by [func@GLib.strdup_printf].
+// Equivalent to [TestSkip], but the explanation
+// is formatted as if by [StrdupPrintf].
 func TestSkipPrintf(FormatVar string, varArgs ...interface{}) {
 
 	xTestSkipPrintf(FormatVar, varArgs...)
@@ -1335,7 +1598,7 @@ func TestSkipPrintf(FormatVar string, varArgs ...interface{}) {
 
 var xTestSubprocess func() bool
 
-// Returns true if the test program is running under [func@GLib.test_trap_subprocess].
+// Returns true if the test program is running under [TestTrapSubprocess].
 func TestSubprocess() bool {
 
 	cret := xTestSubprocess()
@@ -1355,10 +1618,8 @@ var xTestSummary func(string)
 //
 // For example:
 //
-// ```c
-// static void
-// test_array_sort (void)
-//
+//	static void
+//	test_array_sort (void)
 //	{
 //	  g_test_summary ("Test my_array_sort() sorts the array correctly and stably, "
 //	                  "including testing zero length and one-element arrays.");
@@ -1366,9 +1627,7 @@ var xTestSummary func(string)
 //	  // ...
 //	}
 //
-// ```
-//
-// See also [func@GLib.test_bug].
+// See also [TestBug].
 func TestSummary(SummaryVar string) {
 
 	xTestSummary(SummaryVar)
@@ -1378,7 +1637,7 @@ func TestSummary(SummaryVar string) {
 var xTestTimerElapsed func() float64
 
 // Gets the number of seconds since the last start of the timer with
-// [func@GLib.test_timer_start].
+// [TestTimerStart].
 func TestTimerElapsed() float64 {
 
 	cret := xTestTimerElapsed()
@@ -1388,7 +1647,7 @@ func TestTimerElapsed() float64 {
 
 var xTestTimerLast func() float64
 
-// Reports the last result of [func@GLib.test_timer_elapsed].
+// Reports the last result of [TestTimerElapsed].
 func TestTimerLast() float64 {
 
 	cret := xTestTimerLast()
@@ -1400,7 +1659,7 @@ var xTestTimerStart func()
 
 // Starts a timing test.
 //
-// Call [func@GLib.test_timer_elapsed] when the task is supposed
+// Call [TestTimerElapsed] when the task is supposed
 // to be done. Call this function again to restart the timer.
 func TestTimerStart() {
 
@@ -1416,55 +1675,9 @@ func TestTrapAssertions(DomainVar string, FileVar string, LineVar int, FuncVar s
 
 }
 
-var xTestTrapFork func(uint64, TestTrapFlags) bool
-
-// Forks the current test program to execute a test case that might
-// not return or that might abort.
-//
-// If @usec_timeout is non-0, the forked test case is aborted and
-// considered failing if its run time exceeds it.
-//
-// The forking behavior can be configured with [flags@GLib.TestTrapFlags]
-// flags.
-//
-// In the following example, the test code forks, the forked child
-// process produces some sample output and exits successfully.
-// The forking parent process then asserts successful child program
-// termination and validates child program outputs.
-//
-// ```c
-//
-//	static void
-//	test_fork_patterns (void)
-//	{
-//	  if (g_test_trap_fork (0, G_TEST_TRAP_SILENCE_STDOUT | G_TEST_TRAP_SILENCE_STDERR))
-//	    {
-//	      g_print ("some stdout text: somagic17
-//
-// ");
-//
-//	g_printerr ("some stderr text: semagic43
-//
-// ");
-//
-//	      exit (0); // successful test run
-//	    }
-//	  g_test_trap_assert_passed ();
-//	  g_test_trap_assert_stdout ("*somagic17*");
-//	  g_test_trap_assert_stderr ("*semagic43*");
-//	}
-//
-// ```
-func TestTrapFork(UsecTimeoutVar uint64, TestTrapFlagsVar TestTrapFlags) bool {
-
-	cret := xTestTrapFork(UsecTimeoutVar, TestTrapFlagsVar)
-
-	return cret
-}
-
 var xTestTrapHasPassed func() bool
 
-// Checks the result of the last [func@GLib.test_trap_subprocess] call.
+// Checks the result of the last [TestTrapSubprocess] call.
 func TestTrapHasPassed() bool {
 
 	cret := xTestTrapHasPassed()
@@ -1474,7 +1687,7 @@ func TestTrapHasPassed() bool {
 
 var xTestTrapReachedTimeout func() bool
 
-// Checks the result of the last [func@GLib.test_trap_subprocess] call.
+// Checks the result of the last [TestTrapSubprocess] call.
 func TestTrapReachedTimeout() bool {
 
 	cret := xTestTrapReachedTimeout()
@@ -1484,9 +1697,9 @@ func TestTrapReachedTimeout() bool {
 
 var xTestTrapSubprocess func(uintptr, uint64, TestSubprocessFlags)
 
-// Respawns the test program to run only @test_path in a subprocess.
+// Respawns the test program to run only test_path in a subprocess.
 //
-// This is equivalent to calling [func@GLib.test_trap_subprocess_with_envp]
+// This is equivalent to calling [TestTrapSubprocessWithEnvp]
 // with `envp` set to `NULL`. See the documentation for that function
 // for full details.
 func TestTrapSubprocess(TestPathVar *string, UsecTimeoutVar uint64, TestFlagsVar TestSubprocessFlags) {
@@ -1500,51 +1713,49 @@ func TestTrapSubprocess(TestPathVar *string, UsecTimeoutVar uint64, TestFlagsVar
 
 var xTestTrapSubprocessWithEnvp func(uintptr, []string, uint64, TestSubprocessFlags)
 
-// Respawns the test program to run only @test_path in a subprocess with
+// Respawns the test program to run only test_path in a subprocess with
 // a given environment.
 //
 // This can be used for a test case that might not return, or that
 // might abort.
 //
-// If @test_path is `NULL` then the same test is re-run in a subprocess.
-// You can use [func@GLib.test_subprocess] to determine whether the test
+// If test_path is `NULL` then the same test is re-run in a subprocess.
+// You can use [TestSubprocess] to determine whether the test
 // is in a subprocess or not.
 //
-// @test_path can also be the name of the parent test, followed by
+// test_path can also be the name of the parent test, followed by
 // "`/subprocess/`" and then a name for the specific subtest (or just
 // ending with "`/subprocess`" if the test only has one child test);
 // tests with names of this form will automatically be skipped in the
 // parent process.
 //
-// If @envp is `NULL`, the parent process’ environment will be inherited.
+// If envp is `NULL`, the parent process’ environment will be inherited.
 //
-// If @usec_timeout is non-0, the test subprocess is aborted and
+// If usec_timeout is non-0, the test subprocess is aborted and
 // considered failing if its run time exceeds it.
 //
-// The subprocess behavior can be configured with [flags@GLib.TestSubprocessFlags]
+// The subprocess behavior can be configured with [TestSubprocessFlags]
 // flags.
 //
-// You can use methods such as [func@GLib.test_trap_assert_passed],
-// [func@GLib.test_trap_assert_failed], and [func@GLib.test_trap_assert_stderr] to
+// You can use methods such as test_trap_assert_passed,
+// test_trap_assert_failed, and test_trap_assert_stderr to
 // check the results of the subprocess. (But note that
-// [func@GLib.test_trap_assert_stdout] and [func@GLib.test_trap_assert_stderr]
-// cannot be used if @test_flags specifies that the child should
+// test_trap_assert_stdout and test_trap_assert_stderr
+// cannot be used if test_flags specifies that the child should
 // inherit the parent stdout/stderr.)
 //
 // If your `main ()` needs to behave differently in the subprocess, you can
-// call [func@GLib.test_subprocess] (after calling [func@GLib.test_init])
+// call [TestSubprocess] (after calling [TestInit])
 // to see whether you are in a subprocess.
 //
 // Internally, this function tracks the child process using
-// [func@GLib.child_watch_source_new], so your process must not ignore
+// [ChildWatchSourceNew], so your process must not ignore
 // `SIGCHLD`, and must not attempt to watch or wait for the child process
 // via another mechanism.
 //
 // The following example tests that calling `my_object_new(1000000)` will
 // abort with an error message.
 //
-// ```c
-//
 //	static void
 //	test_create_large_object (void)
 //	{
@@ -1572,7 +1783,7 @@ var xTestTrapSubprocessWithEnvp func(uintptr, []string, uint64, TestSubprocessFl
 //
 //	  // Reruns this same test in a subprocess
 //	  g_auto(GStrv) envp = g_get_environ ();
-//	  envp = g_environ_setenv (g_steal_pointer (&amp;envp), "USER", "charlie", TRUE);
+//	  envp = g_environ_setenv (g_steal_pointer (&envp), "USER", "charlie", TRUE);
 //	  g_test_trap_subprocess_with_envp (NULL, envp, 0, G_TEST_SUBPROCESS_DEFAULT);
 //	  g_test_trap_assert_passed ();
 //	  g_test_trap_assert_stdout ("Username is now simulated as charlie");
@@ -1581,7 +1792,7 @@ var xTestTrapSubprocessWithEnvp func(uintptr, []string, uint64, TestSubprocessFl
 //	int
 //	main (int argc, char **argv)
 //	{
-//	  g_test_init (&amp;argc, &amp;argv, NULL);
+//	  g_test_init (&argc, &argv, NULL);
 //
 //	  g_test_add_func ("/myobject/create-large-object",
 //	                   test_create_large_object);
@@ -1589,8 +1800,6 @@ var xTestTrapSubprocessWithEnvp func(uintptr, []string, uint64, TestSubprocessFl
 //	                   test_different_username);
 //	  return g_test_run ();
 //	}
-//
-// ```
 func TestTrapSubprocessWithEnvp(TestPathVar *string, EnvpVar []string, UsecTimeoutVar uint64, TestFlagsVar TestSubprocessFlags) {
 
 	TestPathVarPtr := core.GStrdupNullable(TestPathVar)
@@ -1605,81 +1814,80 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAssertionMessage, libs, "g_assertion_message")
-	core.PuregoSafeRegister(&xAssertionMessageCmpint, libs, "g_assertion_message_cmpint")
-	core.PuregoSafeRegister(&xAssertionMessageCmpnum, libs, "g_assertion_message_cmpnum")
-	core.PuregoSafeRegister(&xAssertionMessageCmpstr, libs, "g_assertion_message_cmpstr")
-	core.PuregoSafeRegister(&xAssertionMessageCmpstrv, libs, "g_assertion_message_cmpstrv")
-	core.PuregoSafeRegister(&xAssertionMessageError, libs, "g_assertion_message_error")
-	core.PuregoSafeRegister(&xAssertionMessageExpr, libs, "g_assertion_message_expr")
-	core.PuregoSafeRegister(&xStrcmp0, libs, "g_strcmp0")
-	core.PuregoSafeRegister(&xTestAddDataFunc, libs, "g_test_add_data_func")
-	core.PuregoSafeRegister(&xTestAddDataFuncFull, libs, "g_test_add_data_func_full")
-	core.PuregoSafeRegister(&xTestAddFunc, libs, "g_test_add_func")
-	core.PuregoSafeRegister(&xTestAddVtable, libs, "g_test_add_vtable")
-	core.PuregoSafeRegister(&xTestAssertExpectedMessagesInternal, libs, "g_test_assert_expected_messages_internal")
-	core.PuregoSafeRegister(&xTestBug, libs, "g_test_bug")
-	core.PuregoSafeRegister(&xTestBugBase, libs, "g_test_bug_base")
-	core.PuregoSafeRegister(&xTestBuildFilename, libs, "g_test_build_filename")
-	core.PuregoSafeRegister(&xTestCreateCase, libs, "g_test_create_case")
-	core.PuregoSafeRegister(&xTestCreateSuite, libs, "g_test_create_suite")
-	core.PuregoSafeRegister(&xTestDisableCrashReporting, libs, "g_test_disable_crash_reporting")
-	core.PuregoSafeRegister(&xTestExpectMessage, libs, "g_test_expect_message")
-	core.PuregoSafeRegister(&xTestFail, libs, "g_test_fail")
-	core.PuregoSafeRegister(&xTestFailPrintf, libs, "g_test_fail_printf")
-	core.PuregoSafeRegister(&xTestFailed, libs, "g_test_failed")
-	core.PuregoSafeRegister(&xTestGetDir, libs, "g_test_get_dir")
-	core.PuregoSafeRegister(&xTestGetFilename, libs, "g_test_get_filename")
-	core.PuregoSafeRegister(&xTestGetPath, libs, "g_test_get_path")
-	core.PuregoSafeRegister(&xTestGetRoot, libs, "g_test_get_root")
-	core.PuregoSafeRegister(&xTestIncomplete, libs, "g_test_incomplete")
-	core.PuregoSafeRegister(&xTestIncompletePrintf, libs, "g_test_incomplete_printf")
-	core.PuregoSafeRegister(&xTestInit, libs, "g_test_init")
-	core.PuregoSafeRegister(&xTestLogSetFatalHandler, libs, "g_test_log_set_fatal_handler")
-	core.PuregoSafeRegister(&xTestLogTypeName, libs, "g_test_log_type_name")
-	core.PuregoSafeRegister(&xTestMaximizedResult, libs, "g_test_maximized_result")
-	core.PuregoSafeRegister(&xTestMessage, libs, "g_test_message")
-	core.PuregoSafeRegister(&xTestMinimizedResult, libs, "g_test_minimized_result")
-	core.PuregoSafeRegister(&xTestQueueDestroy, libs, "g_test_queue_destroy")
-	core.PuregoSafeRegister(&xTestQueueFree, libs, "g_test_queue_free")
-	core.PuregoSafeRegister(&xTestRandDouble, libs, "g_test_rand_double")
-	core.PuregoSafeRegister(&xTestRandDoubleRange, libs, "g_test_rand_double_range")
-	core.PuregoSafeRegister(&xTestRandInt, libs, "g_test_rand_int")
-	core.PuregoSafeRegister(&xTestRandIntRange, libs, "g_test_rand_int_range")
-	core.PuregoSafeRegister(&xTestRun, libs, "g_test_run")
-	core.PuregoSafeRegister(&xTestRunSuite, libs, "g_test_run_suite")
-	core.PuregoSafeRegister(&xTestSetNonfatalAssertions, libs, "g_test_set_nonfatal_assertions")
-	core.PuregoSafeRegister(&xTestSkip, libs, "g_test_skip")
-	core.PuregoSafeRegister(&xTestSkipPrintf, libs, "g_test_skip_printf")
-	core.PuregoSafeRegister(&xTestSubprocess, libs, "g_test_subprocess")
-	core.PuregoSafeRegister(&xTestSummary, libs, "g_test_summary")
-	core.PuregoSafeRegister(&xTestTimerElapsed, libs, "g_test_timer_elapsed")
-	core.PuregoSafeRegister(&xTestTimerLast, libs, "g_test_timer_last")
-	core.PuregoSafeRegister(&xTestTimerStart, libs, "g_test_timer_start")
-	core.PuregoSafeRegister(&xTestTrapAssertions, libs, "g_test_trap_assertions")
-	core.PuregoSafeRegister(&xTestTrapFork, libs, "g_test_trap_fork")
-	core.PuregoSafeRegister(&xTestTrapHasPassed, libs, "g_test_trap_has_passed")
-	core.PuregoSafeRegister(&xTestTrapReachedTimeout, libs, "g_test_trap_reached_timeout")
-	core.PuregoSafeRegister(&xTestTrapSubprocess, libs, "g_test_trap_subprocess")
-	core.PuregoSafeRegister(&xTestTrapSubprocessWithEnvp, libs, "g_test_trap_subprocess_with_envp")
-
-	core.PuregoSafeRegister(&xTestCaseFree, libs, "g_test_case_free")
-
-	core.PuregoSafeRegister(&xTestLogBufferFree, libs, "g_test_log_buffer_free")
-	core.PuregoSafeRegister(&xTestLogBufferPop, libs, "g_test_log_buffer_pop")
-	core.PuregoSafeRegister(&xTestLogBufferPush, libs, "g_test_log_buffer_push")
-
-	core.PuregoSafeRegister(&xTestLogMsgFree, libs, "g_test_log_msg_free")
-
-	core.PuregoSafeRegister(&xTestSuiteAdd, libs, "g_test_suite_add")
-	core.PuregoSafeRegister(&xTestSuiteAddSuite, libs, "g_test_suite_add_suite")
-	core.PuregoSafeRegister(&xTestSuiteFree, libs, "g_test_suite_free")
+	core.PuregoRegisterSince(&xAssertionMessage, libs, "g_assertion_message", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageCmpint, libs, "g_assertion_message_cmpint", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageCmpnum, libs, "g_assertion_message_cmpnum", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageCmpstr, libs, "g_assertion_message_cmpstr", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageCmpstrv, libs, "g_assertion_message_cmpstrv", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageError, libs, "g_assertion_message_error", "GLIB", "")
+	core.PuregoRegisterSince(&xAssertionMessageExpr, libs, "g_assertion_message_expr", "GLIB", "")
+	core.PuregoRegisterSince(&xStrcmp0, libs, "g_strcmp0", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestAddDataFunc, libs, "g_test_add_data_func", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestAddDataFuncFull, libs, "g_test_add_data_func_full", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xTestAddFunc, libs, "g_test_add_func", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestAddVtable, libs, "g_test_add_vtable", "GLIB", "")
+	core.PuregoRegisterSince(&xTestAssertExpectedMessagesInternal, libs, "g_test_assert_expected_messages_internal", "GLIB", "")
+	core.PuregoRegisterSince(&xTestBug, libs, "g_test_bug", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestBugBase, libs, "g_test_bug_base", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestBuildFilename, libs, "g_test_build_filename", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestCreateCase, libs, "g_test_create_case", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestCreateSuite, libs, "g_test_create_suite", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestDisableCrashReporting, libs, "g_test_disable_crash_reporting", "GLIB", "2.78")
+	core.PuregoRegisterSince(&xTestExpectMessage, libs, "g_test_expect_message", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xTestFail, libs, "g_test_fail", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xTestFailPrintf, libs, "g_test_fail_printf", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xTestFailed, libs, "g_test_failed", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestGetDir, libs, "g_test_get_dir", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestGetFilename, libs, "g_test_get_filename", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestGetPath, libs, "g_test_get_path", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTestGetRoot, libs, "g_test_get_root", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestIncomplete, libs, "g_test_incomplete", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestIncompletePrintf, libs, "g_test_incomplete_printf", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xTestInit, libs, "g_test_init", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestLogSetFatalHandler, libs, "g_test_log_set_fatal_handler", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xTestLogTypeName, libs, "g_test_log_type_name", "GLIB", "")
+	core.PuregoRegisterSince(&xTestMaximizedResult, libs, "g_test_maximized_result", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestMessage, libs, "g_test_message", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestMinimizedResult, libs, "g_test_minimized_result", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestQueueDestroy, libs, "g_test_queue_destroy", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestQueueFree, libs, "g_test_queue_free", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRandDouble, libs, "g_test_rand_double", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRandDoubleRange, libs, "g_test_rand_double_range", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRandInt, libs, "g_test_rand_int", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRandIntRange, libs, "g_test_rand_int_range", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRun, libs, "g_test_run", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestRunSuite, libs, "g_test_run_suite", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestSetNonfatalAssertions, libs, "g_test_set_nonfatal_assertions", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestSkip, libs, "g_test_skip", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestSkipPrintf, libs, "g_test_skip_printf", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xTestSubprocess, libs, "g_test_subprocess", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestSummary, libs, "g_test_summary", "GLIB", "2.62")
+	core.PuregoRegisterSince(&xTestTimerElapsed, libs, "g_test_timer_elapsed", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestTimerLast, libs, "g_test_timer_last", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestTimerStart, libs, "g_test_timer_start", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestTrapAssertions, libs, "g_test_trap_assertions", "GLIB", "")
+	core.PuregoRegisterSince(&xTestTrapHasPassed, libs, "g_test_trap_has_passed", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestTrapReachedTimeout, libs, "g_test_trap_reached_timeout", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestTrapSubprocess, libs, "g_test_trap_subprocess", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xTestTrapSubprocessWithEnvp, libs, "g_test_trap_subprocess_with_envp", "GLIB", "2.80")
+
+	core.PuregoRegisterSince(&xTestCaseFree, libs, "g_test_case_free", "GLIB", "2.70")
+
+	core.PuregoRegisterSince(&xTestLogBufferFree, libs, "g_test_log_buffer_free", "GLIB", "")
+	core.PuregoRegisterSince(&xTestLogBufferPop, libs, "g_test_log_buffer_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xTestLogBufferPush, libs, "g_test_log_buffer_push", "GLIB", "")
+
+	core.PuregoRegisterSince(&xTestLogMsgFree, libs, "g_test_log_msg_free", "GLIB", "")
+
+	core.PuregoRegisterSince(&xTestSuiteAdd, libs, "g_test_suite_add", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestSuiteAddSuite, libs, "g_test_suite_add_suite", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xTestSuiteFree, libs, "g_test_suite_free", "GLIB", "2.70")
 
 }
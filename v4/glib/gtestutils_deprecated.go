@@ -0,0 +1,67 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTestTrapFork func(uint64, TestTrapFlags) bool
+
+// Forks the current test program to execute a test case that might
+// not return or that might abort.
+//
+// If usec_timeout is non-0, the forked test case is aborted and
+// considered failing if its run time exceeds it.
+//
+// The forking behavior can be configured with [TestTrapFlags]
+// flags.
+//
+// In the following example, the test code forks, the forked child
+// process produces some sample output and exits successfully.
+// The forking parent process then asserts successful child program
+// termination and validates child program outputs.
+//
+//	  static void
+//	  test_fork_patterns (void)
+//	  {
+//	    if (g_test_trap_fork (0, G_TEST_TRAP_SILENCE_STDOUT | G_TEST_TRAP_SILENCE_STDERR))
+//	      {
+//	        g_print ("some stdout text: somagic17
+//	");
+//	        g_printerr ("some stderr text: semagic43
+//	");
+//	        exit (0); // successful test run
+//	      }
+//	    g_test_trap_assert_passed ();
+//	    g_test_trap_assert_stdout ("*somagic17*");
+//	    g_test_trap_assert_stderr ("*semagic43*");
+//	  }
+//
+// Deprecated: This function is implemented only on Unix platforms,
+// is not always reliable due to problems inherent in fork-without-exec
+// and doesn't set close-on-exec flag on its file descriptors.
+// Use func@GLib.test_trap_subprocess] instead.
+func TestTrapFork(UsecTimeoutVar uint64, TestTrapFlagsVar TestTrapFlags) bool {
+
+	cret := xTestTrapFork(UsecTimeoutVar, TestTrapFlagsVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTestTrapFork, libs, "g_test_trap_fork", "GLIB", "2.16")
+
+}
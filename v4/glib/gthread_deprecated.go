@@ -0,0 +1,571 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xCondFree func(uintptr)
+
+// Destroys a [Cond] that has been created with [CondNew].
+//
+// Calling [Cond.Free] for a [Cond] on which threads are
+// blocking leads to undefined behaviour.
+//
+// Deprecated: since 2.32. GCond can now be statically allocated, or embedded
+// in structures and initialised with [Cond.Init].
+func (x *Cond) Free() {
+
+	xCondFree(x.GoPointer())
+
+}
+
+var xCondTimedWait func(uintptr, *Mutex, *TimeVal) bool
+
+// Waits until this thread is woken up on cond, but not longer than
+// until the time specified by abs_time. The mutex is unlocked before
+// falling asleep and locked again before resuming.
+//
+// If abs_time is nil, [Cond.TimedWait] acts like [Cond.Wait].
+//
+// This function can be used even if [ThreadInit] has not yet been
+// called, and, in that case, will immediately return true.
+//
+// To easily calculate abs_time a combination of [GetRealTime]
+// and [TimeVal.Add] can be used.
+//
+// Deprecated: since 2.32. Use [Cond.WaitUntil] instead.
+func (x *Cond) TimedWait(MutexVar *Mutex, AbsTimeVar *TimeVal) bool {
+
+	cret := xCondTimedWait(x.GoPointer(), MutexVar, AbsTimeVar)
+	return cret
+}
+
+var xStaticMutexFree func(uintptr)
+
+// Releases all resources allocated to mutex.
+//
+// You don't have to call this functions for a [StaticMutex] with an
+// unbounded lifetime, i.e. objects declared 'static', but if you have
+// a [StaticMutex] as a member of a structure and the structure is
+// freed, you should also free the [StaticMutex].
+//
+// Calling [StaticMutex.Free] on a locked mutex may result in
+// undefined behaviour.
+//
+// Deprecated: since 2.32. Use g_mutex_clear()
+func (x *StaticMutex) Free() {
+
+	xStaticMutexFree(x.GoPointer())
+
+}
+
+var xStaticMutexInit func(uintptr)
+
+// Initializes mutex.
+// Alternatively you can initialize it with G_STATIC_MUTEX_INIT.
+//
+// Deprecated: since 2.32. Use g_mutex_init()
+func (x *StaticMutex) Init() {
+
+	xStaticMutexInit(x.GoPointer())
+
+}
+
+var xStaticRWLockFree func(uintptr)
+
+// Releases all resources allocated to lock.
+//
+// You don't have to call this functions for a [StaticRWLock] with an
+// unbounded lifetime, i.e. objects declared 'static', but if you have
+// a [StaticRWLock] as a member of a structure, and the structure is
+// freed, you should also free the [StaticRWLock].
+//
+// Deprecated: since 2.32. Use a [RWLock] instead
+func (x *StaticRWLock) Free() {
+
+	xStaticRWLockFree(x.GoPointer())
+
+}
+
+var xStaticRWLockInit func(uintptr)
+
+// A [StaticRWLock] must be initialized with this function before it
+// can be used. Alternatively you can initialize it with
+// G_STATIC_RW_LOCK_INIT.
+//
+// Deprecated: since 2.32. Use [RWLock.Init] instead
+func (x *StaticRWLock) Init() {
+
+	xStaticRWLockInit(x.GoPointer())
+
+}
+
+var xStaticRWLockReaderLock func(uintptr)
+
+// Locks lock for reading. There may be unlimited concurrent locks for
+// reading of a [StaticRWLock] at the same time.  If lock is already
+// locked for writing by another thread or if another thread is already
+// waiting to lock lock for writing, this function will block until
+// lock is unlocked by the other writing thread and no other writing
+// threads want to lock lock. This lock has to be unlocked by
+// [StaticRWLock.ReaderUnlock].
+//
+// [StaticRWLock] is not recursive. It might seem to be possible to
+// recursively lock for reading, but that can result in a deadlock, due
+// to writer preference.
+//
+// Deprecated: since 2.32. Use [RWLock.ReaderLock] instead
+func (x *StaticRWLock) ReaderLock() {
+
+	xStaticRWLockReaderLock(x.GoPointer())
+
+}
+
+var xStaticRWLockReaderTrylock func(uintptr) bool
+
+// Tries to lock lock for reading. If lock is already locked for
+// writing by another thread or if another thread is already waiting to
+// lock lock for writing, immediately returns false. Otherwise locks
+// lock for reading and returns true. This lock has to be unlocked by
+// [StaticRWLock.ReaderUnlock].
+//
+// Deprecated: since 2.32. Use [RWLock.ReaderTrylock] instead
+func (x *StaticRWLock) ReaderTrylock() bool {
+
+	cret := xStaticRWLockReaderTrylock(x.GoPointer())
+	return cret
+}
+
+var xStaticRWLockReaderUnlock func(uintptr)
+
+// Unlocks lock. If a thread waits to lock lock for writing and all
+// locks for reading have been unlocked, the waiting thread is woken up
+// and can lock lock for writing.
+//
+// Deprecated: since 2.32. Use [RWLock.ReaderUnlock] instead
+func (x *StaticRWLock) ReaderUnlock() {
+
+	xStaticRWLockReaderUnlock(x.GoPointer())
+
+}
+
+var xStaticRWLockWriterLock func(uintptr)
+
+// Locks lock for writing. If lock is already locked for writing or
+// reading by other threads, this function will block until lock is
+// completely unlocked and then lock lock for writing. While this
+// functions waits to lock lock, no other thread can lock lock for
+// reading. When lock is locked for writing, no other thread can lock
+// lock (neither for reading nor writing). This lock has to be
+// unlocked by [StaticRWLock.WriterUnlock].
+//
+// Deprecated: since 2.32. Use [RWLock.WriterLock] instead
+func (x *StaticRWLock) WriterLock() {
+
+	xStaticRWLockWriterLock(x.GoPointer())
+
+}
+
+var xStaticRWLockWriterTrylock func(uintptr) bool
+
+// Tries to lock lock for writing. If lock is already locked (for
+// either reading or writing) by another thread, it immediately returns
+// false. Otherwise it locks lock for writing and returns true. This
+// lock has to be unlocked by [StaticRWLock.WriterUnlock].
+//
+// Deprecated: since 2.32. Use [RWLock.WriterTrylock] instead
+func (x *StaticRWLock) WriterTrylock() bool {
+
+	cret := xStaticRWLockWriterTrylock(x.GoPointer())
+	return cret
+}
+
+var xStaticRWLockWriterUnlock func(uintptr)
+
+// Unlocks lock. If a thread is waiting to lock lock for writing and
+// all locks for reading have been unlocked, the waiting thread is
+// woken up and can lock lock for writing. If no thread is waiting to
+// lock lock for writing, and some thread or threads are waiting to
+// lock lock for reading, the waiting threads are woken up and can
+// lock lock for reading.
+//
+// Deprecated: since 2.32. Use [RWLock.WriterUnlock] instead
+func (x *StaticRWLock) WriterUnlock() {
+
+	xStaticRWLockWriterUnlock(x.GoPointer())
+
+}
+
+var xStaticRecMutexFree func(uintptr)
+
+// Releases all resources allocated to a [StaticRecMutex].
+//
+// You don't have to call this functions for a [StaticRecMutex] with an
+// unbounded lifetime, i.e. objects declared 'static', but if you have
+// a [StaticRecMutex] as a member of a structure and the structure is
+// freed, you should also free the [StaticRecMutex].
+//
+// Deprecated: since 2.32. Use [RecMutex.Clear]
+func (x *StaticRecMutex) Free() {
+
+	xStaticRecMutexFree(x.GoPointer())
+
+}
+
+var xStaticRecMutexInit func(uintptr)
+
+// A [StaticRecMutex] must be initialized with this function before it
+// can be used. Alternatively you can initialize it with
+// G_STATIC_REC_MUTEX_INIT.
+//
+// Deprecated: since 2.32. Use [RecMutex.Init]
+func (x *StaticRecMutex) Init() {
+
+	xStaticRecMutexInit(x.GoPointer())
+
+}
+
+var xStaticRecMutexLock func(uintptr)
+
+// Locks mutex. If mutex is already locked by another thread, the
+// current thread will block until mutex is unlocked by the other
+// thread. If mutex is already locked by the calling thread, this
+// functions increases the depth of mutex and returns immediately.
+//
+// Deprecated: since 2.32. Use [RecMutex.Lock]
+func (x *StaticRecMutex) Lock() {
+
+	xStaticRecMutexLock(x.GoPointer())
+
+}
+
+var xStaticRecMutexLockFull func(uintptr, uint)
+
+// Works like calling [StaticRecMutex.Lock] for mutex depth times.
+//
+// Deprecated: since 2.32. Use [RecMutex.Lock]
+func (x *StaticRecMutex) LockFull(DepthVar uint) {
+
+	xStaticRecMutexLockFull(x.GoPointer(), DepthVar)
+
+}
+
+var xStaticRecMutexTrylock func(uintptr) bool
+
+// Tries to lock mutex. If mutex is already locked by another thread,
+// it immediately returns false. Otherwise it locks mutex and returns
+// true. If mutex is already locked by the calling thread, this
+// functions increases the depth of mutex and immediately returns
+// true.
+//
+// Deprecated: since 2.32. Use [RecMutex.Trylock]
+func (x *StaticRecMutex) Trylock() bool {
+
+	cret := xStaticRecMutexTrylock(x.GoPointer())
+	return cret
+}
+
+var xStaticRecMutexUnlock func(uintptr)
+
+// Unlocks mutex. Another thread will be allowed to lock mutex only
+// when it has been unlocked as many times as it had been locked
+// before. If mutex is completely unlocked and another thread is
+// blocked in a [StaticRecMutex.Lock] call for mutex, it will be
+// woken and can lock mutex itself.
+//
+// Deprecated: since 2.32. Use [RecMutex.Unlock]
+func (x *StaticRecMutex) Unlock() {
+
+	xStaticRecMutexUnlock(x.GoPointer())
+
+}
+
+var xStaticRecMutexUnlockFull func(uintptr) uint
+
+// Completely unlocks mutex. If another thread is blocked in a
+// [StaticRecMutex.Lock] call for mutex, it will be woken and can
+// lock mutex itself. This function returns the number of times that
+// mutex has been locked by the current thread. To restore the state
+// before the call to [StaticRecMutex.UnlockFull] you can call
+// [StaticRecMutex.LockFull] with the depth returned by this
+// function.
+//
+// Deprecated: since 2.32. Use [RecMutex.Unlock]
+func (x *StaticRecMutex) UnlockFull() uint {
+
+	cret := xStaticRecMutexUnlockFull(x.GoPointer())
+	return cret
+}
+
+var xThreadSetPriority func(uintptr, ThreadPriority)
+
+// This function does nothing.
+//
+// Deprecated: since 2.32. Thread priorities no longer have any effect.
+func (x *Thread) SetPriority(PriorityVar ThreadPriority) {
+
+	xThreadSetPriority(x.GoPointer(), PriorityVar)
+
+}
+
+var xCondNew func() *Cond
+
+// Allocates and initializes a new [Cond].
+//
+// Deprecated: since 2.32. GCond can now be statically allocated, or embedded
+// in structures and initialised with [Cond.Init].
+func CondNew() *Cond {
+
+	cret := xCondNew()
+
+	return cret
+}
+
+var xMutexNew func() *Mutex
+
+// Allocates and initializes a new [Mutex].
+//
+// Deprecated: since 2.32. GMutex can now be statically allocated, or embedded
+// in structures and initialised with g_mutex_init().
+func MutexNew() *Mutex {
+
+	cret := xMutexNew()
+
+	return cret
+}
+
+var xPrivateNew func(uintptr) *Private
+
+// Creates a new [Private].
+//
+// Deprecated: since 2.32. dynamic allocation of [Private] is a bad idea.  Use
+//
+//	static storage and G_PRIVATE_INIT() instead.
+func PrivateNew(NotifyVar *DestroyNotify) *Private {
+
+	var NotifyVarRef uintptr
+	if NotifyVar != nil {
+		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
+		if cbRefPtr, ok := GetCallback(NotifyVarPtr); ok {
+			NotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *NotifyVar
+				cbFn(arg0)
+			}
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+		}
+	}
+
+	cret := xPrivateNew(NotifyVarRef)
+
+	return cret
+}
+
+var xThreadCreate func(uintptr, uintptr, bool, **Error) *Thread
+
+// This function creates a new thread.
+//
+// The new thread executes the function func with the argument data.
+// If the thread was created successfully, it is returned.
+//
+// error can be nil to ignore errors, or non-nil to report errors.
+// The error is set, if and only if the function returns nil.
+//
+// This function returns a reference to the created thread only if
+// joinable is true.  In that case, you must free this reference by
+// calling [Thread.Unref] or [Thread.Join].  If joinable is false
+// then you should probably not touch the return value.
+//
+// Deprecated: since 2.32. Use [NewThread] instead
+func ThreadCreate(FuncVar *ThreadFunc, DataVar uintptr, JoinableVar bool) (*Thread, error) {
+	var cerr *Error
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) uintptr {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	cret := xThreadCreate(FuncVarRef, DataVar, JoinableVar, &cerr)
+
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xThreadCreateFull func(uintptr, uintptr, types.ULong, bool, bool, ThreadPriority, **Error) *Thread
+
+// This function creates a new thread.
+//
+// Deprecated: since 2.32. The bound and priority arguments are now ignored.
+// Use [NewThread].
+func ThreadCreateFull(FuncVar *ThreadFunc, DataVar uintptr, StackSizeVar types.ULong, JoinableVar bool, BoundVar bool, PriorityVar ThreadPriority) (*Thread, error) {
+	var cerr *Error
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) uintptr {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	cret := xThreadCreateFull(FuncVarRef, DataVar, StackSizeVar, JoinableVar, BoundVar, PriorityVar, &cerr)
+
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xThreadForeach func(uintptr, uintptr)
+
+// Call thread_func on all GThreads that have been
+// created with [ThreadCreate].
+//
+// Note that threads may decide to exit while thread_func is
+// running, so without intimate knowledge about the lifetime of
+// foreign threads, thread_func shouldn't access the GThread*
+// pointer passed in as first argument. However, thread_func will
+// not be called for threads which are known to have exited already.
+//
+// Due to thread lifetime checks, this function has an execution complexity
+// which is quadratic in the number of existing threads.
+//
+// Deprecated: since 2.32. There aren't many things you can do with a [Thread],
+//
+//	except comparing it with one that was returned from [ThreadCreate].
+//	There are better ways to find out if your thread is still alive.
+func ThreadForeach(ThreadFuncVar *Func, UserDataVar uintptr) {
+
+	var ThreadFuncVarRef uintptr
+	if ThreadFuncVar != nil {
+		ThreadFuncVarPtr := uintptr(unsafe.Pointer(ThreadFuncVar))
+		if cbRefPtr, ok := GetCallback(ThreadFuncVarPtr); ok {
+			ThreadFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *ThreadFuncVar
+				cbFn(arg0, arg1)
+			}
+			ThreadFuncVarRef = core.NewCallback(fcb)
+			ThreadFuncVarRef = SaveCallbackWithClosure(ThreadFuncVarPtr, ThreadFuncVarRef, ThreadFuncVar)
+		}
+	}
+
+	xThreadForeach(ThreadFuncVarRef, UserDataVar)
+
+}
+
+var xThreadInit func(uintptr)
+
+// If you use GLib from more than one thread, you must initialize the
+// thread system by calling [ThreadInit].
+//
+// Since version 2.24, calling [ThreadInit] multiple times is allowed,
+// but nothing happens except for the first call.
+//
+// Since version 2.32, GLib does not support custom thread implementations
+// anymore and the vtable parameter is ignored and you should pass nil.
+//
+// ::: note
+//
+//	[ThreadInit] must not be called directly or indirectly in a
+//	callback from GLib. Also no mutexes may be currently locked
+//	while calling [ThreadInit].
+//
+// ::: note
+//
+//	To use [ThreadInit] in your program, you have to link with
+//	the libraries that the command `pkg-config --libs gthread-2.0`
+//	outputs. This is not the case for all the other thread-related
+//	functions of GLib. Those can be used without having to link
+//	with the thread libraries.
+//
+// Deprecated: since 2.32. This function is no longer necessary. The GLib
+//
+//	threading system is automatically initialized at the start
+//	of your program.
+func ThreadInit(VtableVar uintptr) {
+
+	xThreadInit(VtableVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCondNew, libs, "g_cond_new", "GLIB", "")
+	core.PuregoRegisterSince(&xMutexNew, libs, "g_mutex_new", "GLIB", "")
+	core.PuregoRegisterSince(&xPrivateNew, libs, "g_private_new", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadCreate, libs, "g_thread_create", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadCreateFull, libs, "g_thread_create_full", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadForeach, libs, "g_thread_foreach", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xThreadInit, libs, "g_thread_init", "GLIB", "")
+
+	core.PuregoRegisterSince(&xCondFree, libs, "g_cond_free", "GLIB", "")
+	core.PuregoRegisterSince(&xCondTimedWait, libs, "g_cond_timed_wait", "GLIB", "")
+
+	core.PuregoRegisterSince(&xStaticMutexFree, libs, "g_static_mutex_free", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticMutexInit, libs, "g_static_mutex_init", "GLIB", "")
+
+	core.PuregoRegisterSince(&xStaticRWLockFree, libs, "g_static_rw_lock_free", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockInit, libs, "g_static_rw_lock_init", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockReaderLock, libs, "g_static_rw_lock_reader_lock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockReaderTrylock, libs, "g_static_rw_lock_reader_trylock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockReaderUnlock, libs, "g_static_rw_lock_reader_unlock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockWriterLock, libs, "g_static_rw_lock_writer_lock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockWriterTrylock, libs, "g_static_rw_lock_writer_trylock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRWLockWriterUnlock, libs, "g_static_rw_lock_writer_unlock", "GLIB", "")
+
+	core.PuregoRegisterSince(&xStaticRecMutexFree, libs, "g_static_rec_mutex_free", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexInit, libs, "g_static_rec_mutex_init", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexLock, libs, "g_static_rec_mutex_lock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexLockFull, libs, "g_static_rec_mutex_lock_full", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexTrylock, libs, "g_static_rec_mutex_trylock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexUnlock, libs, "g_static_rec_mutex_unlock", "GLIB", "")
+	core.PuregoRegisterSince(&xStaticRecMutexUnlockFull, libs, "g_static_rec_mutex_unlock_full", "GLIB", "")
+
+	core.PuregoRegisterSince(&xThreadSetPriority, libs, "g_thread_set_priority", "GLIB", "")
+
+}
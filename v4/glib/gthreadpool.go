@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -20,21 +19,21 @@ import (
 // Therefore GLib provides thread pools for your convenience. An added advantage is, that the
 // threads can be shared between the different subsystems of your program, when they are using GLib.
 //
-// To create a new thread pool, you use [func@GLib.ThreadPool.new].
-// It is destroyed by [method@GLib.ThreadPool.free].
+// To create a new thread pool, you use ThreadPool.new.
+// It is destroyed by [ThreadPool.Free].
 //
-// If you want to execute a certain task within a thread pool, use [method@GLib.ThreadPool.push].
+// If you want to execute a certain task within a thread pool, use [ThreadPool.Push].
 //
-// To get the current number of running threads you call [method@GLib.ThreadPool.get_num_threads].
-// To get the number of still unprocessed tasks you call [method@GLib.ThreadPool.unprocessed].
+// To get the current number of running threads you call [ThreadPool.GetNumThreads].
+// To get the number of still unprocessed tasks you call [ThreadPool.Unprocessed].
 // To control the maximum number of threads for a thread pool, you use
-// [method@GLib.ThreadPool.get_max_threads]. and [method@GLib.ThreadPool.set_max_threads].
+// [ThreadPool.GetMaxThreads]. and [ThreadPool.SetMaxThreads].
 //
 // Finally you can control the number of unused threads, that are kept alive by GLib for future use.
-// The current number can be fetched with [func@GLib.ThreadPool.get_num_unused_threads].
-// The maximum number can be controlled by [func@GLib.ThreadPool.get_max_unused_threads] and
-// [func@GLib.ThreadPool.set_max_unused_threads]. All currently unused threads
-// can be stopped by calling [func@GLib.ThreadPool.stop_unused_threads].
+// The current number can be fetched with [ThreadPoolGetNumUnusedThreads].
+// The maximum number can be controlled by [ThreadPoolGetMaxUnusedThreads] and
+// [ThreadPoolSetMaxUnusedThreads]. All currently unused threads
+// can be stopped by calling [ThreadPoolStopUnusedThreads].
 type ThreadPool struct {
 	_ structs.HostLayout
 
@@ -51,20 +50,20 @@ func (x *ThreadPool) GoPointer() uintptr {
 
 var xThreadPoolFree func(uintptr, bool, bool)
 
-// Frees all resources allocated for @pool.
+// Frees all resources allocated for pool.
 //
-// If @immediate is %TRUE, no new task is processed for @pool.
-// Otherwise @pool is not freed before the last task is processed.
+// If immediate is true, no new task is processed for pool.
+// Otherwise pool is not freed before the last task is processed.
 // Note however, that no thread of this pool is interrupted while
 // processing a task. Instead at least all still running threads
-// can finish their tasks before the @pool is freed.
+// can finish their tasks before the pool is freed.
 //
-// If @wait_ is %TRUE, this function does not return before all
-// tasks to be processed (dependent on @immediate, whether all
+// If wait_ is true, this function does not return before all
+// tasks to be processed (dependent on immediate, whether all
 // or only the currently running) are ready.
 // Otherwise this function returns immediately.
 //
-// After calling this function @pool must not be used anymore.
+// After calling this function pool must not be used anymore.
 func (x *ThreadPool) Free(ImmediateVar bool, WaitVar bool) {
 
 	xThreadPoolFree(x.GoPointer(), ImmediateVar, WaitVar)
@@ -73,7 +72,7 @@ func (x *ThreadPool) Free(ImmediateVar bool, WaitVar bool) {
 
 var xThreadPoolGetMaxThreads func(uintptr) int
 
-// Returns the maximal number of threads for @pool.
+// Returns the maximal number of threads for pool.
 func (x *ThreadPool) GetMaxThreads() int {
 
 	cret := xThreadPoolGetMaxThreads(x.GoPointer())
@@ -82,7 +81,7 @@ func (x *ThreadPool) GetMaxThreads() int {
 
 var xThreadPoolGetNumThreads func(uintptr) uint
 
-// Returns the number of threads currently running in @pool.
+// Returns the number of threads currently running in pool.
 func (x *ThreadPool) GetNumThreads() uint {
 
 	cret := xThreadPoolGetNumThreads(x.GoPointer())
@@ -101,17 +100,17 @@ func (x *ThreadPool) MoveToFront(DataVar uintptr) bool {
 
 var xThreadPoolPush func(uintptr, uintptr, **Error) bool
 
-// Inserts @data into the list of tasks to be executed by @pool.
+// Inserts data into the list of tasks to be executed by pool.
 //
 // When the number of currently running threads is lower than the
 // maximal allowed number of threads, a new thread is started (or
 // reused) with the properties given to g_thread_pool_new().
-// Otherwise, @data stays in the queue until a thread in this pool
-// finishes its previous task and processes @data.
+// Otherwise, data stays in the queue until a thread in this pool
+// finishes its previous task and processes data.
 //
-// @error can be %NULL to ignore errors, or non-%NULL to report
+// error can be nil to ignore errors, or non-nil to report
 // errors. An error can only occur when a new thread couldn't be
-// created. In that case @data is simply appended to the queue of
+// created. In that case data is simply appended to the queue of
 // work to do.
 //
 // Before version 2.32, this function did not return a success status.
@@ -120,7 +119,7 @@ func (x *ThreadPool) Push(DataVar uintptr) (bool, error) {
 
 	cret := xThreadPoolPush(x.GoPointer(), DataVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -128,22 +127,22 @@ func (x *ThreadPool) Push(DataVar uintptr) (bool, error) {
 
 var xThreadPoolSetMaxThreads func(uintptr, int, **Error) bool
 
-// Sets the maximal allowed number of threads for @pool.
+// Sets the maximal allowed number of threads for pool.
 // A value of -1 means that the maximal number of threads
-// is unlimited. If @pool is an exclusive thread pool, setting
+// is unlimited. If pool is an exclusive thread pool, setting
 // the maximal number of threads to -1 is not allowed.
 //
-// Setting @max_threads to 0 means stopping all work for @pool.
-// It is effectively frozen until @max_threads is set to a non-zero
+// Setting max_threads to 0 means stopping all work for pool.
+// It is effectively frozen until max_threads is set to a non-zero
 // value again.
 //
-// A thread is never terminated while calling @func, as supplied by
+// A thread is never terminated while calling func, as supplied by
 // g_thread_pool_new(). Instead the maximal number of threads only
-// has effect for the allocation of new threads in g_thread_pool_push().
+// has effect for the allocation of new threads in [ThreadPool.Push].
 // A new thread is allocated, whenever the number of currently
-// running threads in @pool is smaller than the maximal number.
+// running threads in pool is smaller than the maximal number.
 //
-// @error can be %NULL to ignore errors, or non-%NULL to report
+// error can be nil to ignore errors, or non-nil to report
 // errors. An error can only occur when a new thread couldn't be
 // created.
 //
@@ -153,7 +152,7 @@ func (x *ThreadPool) SetMaxThreads(MaxThreadsVar int) (bool, error) {
 
 	cret := xThreadPoolSetMaxThreads(x.GoPointer(), MaxThreadsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -162,7 +161,7 @@ func (x *ThreadPool) SetMaxThreads(MaxThreadsVar int) (bool, error) {
 var xThreadPoolSetSortFunction func(uintptr, uintptr, uintptr)
 
 // Sets the function used to sort the list of tasks. This allows the
-// tasks to be processed by a priority determined by @func, and not
+// tasks to be processed by a priority determined by func, and not
 // just in the order in which they were added to the pool.
 //
 // Note, if the maximum number of threads is more than 1, the order
@@ -179,11 +178,12 @@ func (x *ThreadPool) SetSortFunction(FuncVar *CompareDataFunc, UserDataVar uintp
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -193,7 +193,7 @@ func (x *ThreadPool) SetSortFunction(FuncVar *CompareDataFunc, UserDataVar uintp
 
 var xThreadPoolUnprocessed func(uintptr) uint
 
-// Returns the number of tasks still unprocessed in @pool.
+// Returns the number of tasks still unprocessed in pool.
 func (x *ThreadPool) Unprocessed() uint {
 
 	cret := xThreadPoolUnprocessed(x.GoPointer())
@@ -202,7 +202,7 @@ func (x *ThreadPool) Unprocessed() uint {
 
 var xThreadPoolGetMaxIdleTime func() uint
 
-// This function will return the maximum @interval that a
+// This function will return the maximum interval that a
 // thread will wait in the thread pool for new tasks before
 // being stopped.
 //
@@ -237,13 +237,13 @@ func ThreadPoolGetNumUnusedThreads() uint {
 
 var xThreadPoolSetMaxIdleTime func(uint)
 
-// This function will set the maximum @interval that a thread
+// This function will set the maximum interval that a thread
 // waiting in the pool for new tasks can be idle for before
 // being stopped. This function is similar to calling
-// g_thread_pool_stop_unused_threads() on a regular timeout,
+// [ThreadPoolStopUnusedThreads] on a regular timeout,
 // except this is done on a per thread basis.
 //
-// By setting @interval to 0, idle threads will not be stopped.
+// By setting interval to 0, idle threads will not be stopped.
 //
 // The default value is 15000 (15 seconds).
 func ThreadPoolSetMaxIdleTime(IntervalVar uint) {
@@ -254,8 +254,8 @@ func ThreadPoolSetMaxIdleTime(IntervalVar uint) {
 
 var xThreadPoolSetMaxUnusedThreads func(int)
 
-// Sets the maximal number of unused threads to @max_threads.
-// If @max_threads is -1, no limit is imposed on the number
+// Sets the maximal number of unused threads to max_threads.
+// If max_threads is -1, no limit is imposed on the number
 // of unused threads.
 //
 // The default value is 8 since GLib 2.84. Previously the default value was 2.
@@ -269,7 +269,7 @@ var xThreadPoolStopUnusedThreads func()
 
 // Stops all currently unused threads. This does not change the
 // maximal number of unused threads. This function can be used to
-// regularly stop all unused threads e.g. from g_timeout_add().
+// regularly stop all unused threads e.g. from [TimeoutAdd].
 func ThreadPoolStopUnusedThreads() {
 
 	xThreadPoolStopUnusedThreads()
@@ -281,27 +281,27 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xThreadPoolGetMaxIdleTime, libs, "g_thread_pool_get_max_idle_time")
-	core.PuregoSafeRegister(&xThreadPoolGetMaxUnusedThreads, libs, "g_thread_pool_get_max_unused_threads")
-	core.PuregoSafeRegister(&xThreadPoolGetNumUnusedThreads, libs, "g_thread_pool_get_num_unused_threads")
-	core.PuregoSafeRegister(&xThreadPoolSetMaxIdleTime, libs, "g_thread_pool_set_max_idle_time")
-	core.PuregoSafeRegister(&xThreadPoolSetMaxUnusedThreads, libs, "g_thread_pool_set_max_unused_threads")
-	core.PuregoSafeRegister(&xThreadPoolStopUnusedThreads, libs, "g_thread_pool_stop_unused_threads")
-
-	core.PuregoSafeRegister(&xThreadPoolFree, libs, "g_thread_pool_free")
-	core.PuregoSafeRegister(&xThreadPoolGetMaxThreads, libs, "g_thread_pool_get_max_threads")
-	core.PuregoSafeRegister(&xThreadPoolGetNumThreads, libs, "g_thread_pool_get_num_threads")
-	core.PuregoSafeRegister(&xThreadPoolMoveToFront, libs, "g_thread_pool_move_to_front")
-	core.PuregoSafeRegister(&xThreadPoolPush, libs, "g_thread_pool_push")
-	core.PuregoSafeRegister(&xThreadPoolSetMaxThreads, libs, "g_thread_pool_set_max_threads")
-	core.PuregoSafeRegister(&xThreadPoolSetSortFunction, libs, "g_thread_pool_set_sort_function")
-	core.PuregoSafeRegister(&xThreadPoolUnprocessed, libs, "g_thread_pool_unprocessed")
+	core.PuregoRegisterSince(&xThreadPoolGetMaxIdleTime, libs, "g_thread_pool_get_max_idle_time", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xThreadPoolGetMaxUnusedThreads, libs, "g_thread_pool_get_max_unused_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolGetNumUnusedThreads, libs, "g_thread_pool_get_num_unused_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolSetMaxIdleTime, libs, "g_thread_pool_set_max_idle_time", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xThreadPoolSetMaxUnusedThreads, libs, "g_thread_pool_set_max_unused_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolStopUnusedThreads, libs, "g_thread_pool_stop_unused_threads", "GLIB", "")
+
+	core.PuregoRegisterSince(&xThreadPoolFree, libs, "g_thread_pool_free", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolGetMaxThreads, libs, "g_thread_pool_get_max_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolGetNumThreads, libs, "g_thread_pool_get_num_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolMoveToFront, libs, "g_thread_pool_move_to_front", "GLIB", "2.46")
+	core.PuregoRegisterSince(&xThreadPoolPush, libs, "g_thread_pool_push", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolSetMaxThreads, libs, "g_thread_pool_set_max_threads", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadPoolSetSortFunction, libs, "g_thread_pool_set_sort_function", "GLIB", "2.10")
+	core.PuregoRegisterSince(&xThreadPoolUnprocessed, libs, "g_thread_pool_unprocessed", "GLIB", "")
 
 }
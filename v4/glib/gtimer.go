@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // `GTimer` records a start time, and counts microseconds elapsed since
@@ -25,7 +25,7 @@ func (x *Timer) GoPointer() uintptr {
 var xTimerContinue func(uintptr)
 
 // Resumes a timer that has previously been stopped with
-// g_timer_stop(). g_timer_stop() must be called before using this
+// [Timer.Stop]. [Timer.Stop] must be called before using this
 // function.
 func (x *Timer) Continue() {
 
@@ -42,15 +42,15 @@ func (x *Timer) Destroy() {
 
 }
 
-var xTimerElapsed func(uintptr, uint) float64
+var xTimerElapsed func(uintptr, types.ULong) float64
 
-// If @timer has been started but not stopped, obtains the time since
-// the timer was started. If @timer has been stopped, obtains the
+// If timer has been started but not stopped, obtains the time since
+// the timer was started. If timer has been stopped, obtains the
 // elapsed time between the time it was started and the time it was
 // stopped. The return value is the number of seconds elapsed,
-// including any fractional part. The @microseconds out parameter is
+// including any fractional part. The microseconds out parameter is
 // essentially useless.
-func (x *Timer) Elapsed(MicrosecondsVar uint) float64 {
+func (x *Timer) Elapsed(MicrosecondsVar types.ULong) float64 {
 
 	cret := xTimerElapsed(x.GoPointer(), MicrosecondsVar)
 	return cret
@@ -67,8 +67,8 @@ func (x *Timer) IsActive() bool {
 
 var xTimerReset func(uintptr)
 
-// This function is useless; it's fine to call g_timer_start() on an
-// already-started timer to reset the start time, so g_timer_reset()
+// This function is useless; it's fine to call [Timer.Start] on an
+// already-started timer to reset the start time, so [Timer.Reset]
 // serves no purpose.
 func (x *Timer) Reset() {
 
@@ -78,10 +78,10 @@ func (x *Timer) Reset() {
 
 var xTimerStart func(uintptr)
 
-// Marks a start time, so that future calls to g_timer_elapsed() will
-// report the time since g_timer_start() was called. g_timer_new()
+// Marks a start time, so that future calls to [Timer.Elapsed] will
+// report the time since [Timer.Start] was called. g_timer_new()
 // automatically marks the start time, so no need to call
-// g_timer_start() immediately after creating the timer.
+// [Timer.Start] immediately after creating the timer.
 func (x *Timer) Start() {
 
 	xTimerStart(x.GoPointer())
@@ -90,7 +90,7 @@ func (x *Timer) Start() {
 
 var xTimerStop func(uintptr)
 
-// Marks an end time, so calls to g_timer_elapsed() will return the
+// Marks an end time, so calls to [Timer.Elapsed] will return the
 // difference between this end time and the start time.
 func (x *Timer) Stop() {
 
@@ -104,41 +104,15 @@ const (
 	USEC_PER_SEC int = 1000000
 )
 
-var xTimeValFromIso8601 func(string, *TimeVal) bool
-
-// Converts a string containing an ISO 8601 encoded date and time
-// to a #GTimeVal and puts it into @time_.
-//
-// @iso_date must include year, month, day, hours, minutes, and
-// seconds. It can optionally include fractions of a second and a time
-// zone indicator. (In the absence of any time zone indication, the
-// timestamp is assumed to be in local time.)
-//
-// Any leading or trailing space in @iso_date is ignored.
-//
-// This function was deprecated, along with #GTimeVal itself, in GLib 2.62.
-// Equivalent functionality is available using code like:
-// |[
-// GDateTime *dt = g_date_time_new_from_iso8601 (iso8601_string, NULL);
-// gint64 time_val = g_date_time_to_unix (dt);
-// g_date_time_unref (dt);
-// ]|
-func TimeValFromIso8601(IsoDateVar string, TimeVar *TimeVal) bool {
-
-	cret := xTimeValFromIso8601(IsoDateVar, TimeVar)
-
-	return cret
-}
-
-var xUsleep func(uint)
+var xUsleep func(types.ULong)
 
 // Pauses the current thread for the given number of microseconds.
 //
 // There are 1 million microseconds per second (represented by the
-// %G_USEC_PER_SEC macro). g_usleep() may have limited precision,
+// [USEC_PER_SEC] macro). [Usleep] may have limited precision,
 // depending on hardware and operating system; don't rely on the exact
 // length of the sleep.
-func Usleep(MicrosecondsVar uint) {
+func Usleep(MicrosecondsVar types.ULong) {
 
 	xUsleep(MicrosecondsVar)
 
@@ -149,22 +123,21 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTimeValFromIso8601, libs, "g_time_val_from_iso8601")
-	core.PuregoSafeRegister(&xUsleep, libs, "g_usleep")
+	core.PuregoRegisterSince(&xUsleep, libs, "g_usleep", "GLIB", "")
 
-	core.PuregoSafeRegister(&xTimerContinue, libs, "g_timer_continue")
-	core.PuregoSafeRegister(&xTimerDestroy, libs, "g_timer_destroy")
-	core.PuregoSafeRegister(&xTimerElapsed, libs, "g_timer_elapsed")
-	core.PuregoSafeRegister(&xTimerIsActive, libs, "g_timer_is_active")
-	core.PuregoSafeRegister(&xTimerReset, libs, "g_timer_reset")
-	core.PuregoSafeRegister(&xTimerStart, libs, "g_timer_start")
-	core.PuregoSafeRegister(&xTimerStop, libs, "g_timer_stop")
+	core.PuregoRegisterSince(&xTimerContinue, libs, "g_timer_continue", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xTimerDestroy, libs, "g_timer_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xTimerElapsed, libs, "g_timer_elapsed", "GLIB", "")
+	core.PuregoRegisterSince(&xTimerIsActive, libs, "g_timer_is_active", "GLIB", "2.62")
+	core.PuregoRegisterSince(&xTimerReset, libs, "g_timer_reset", "GLIB", "")
+	core.PuregoRegisterSince(&xTimerStart, libs, "g_timer_start", "GLIB", "")
+	core.PuregoRegisterSince(&xTimerStop, libs, "g_timer_stop", "GLIB", "")
 
 }
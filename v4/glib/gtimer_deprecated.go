@@ -0,0 +1,53 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTimeValFromIso8601 func(string, *TimeVal) bool
+
+// Converts a string containing an ISO 8601 encoded date and time
+// to a [TimeVal] and puts it into time_.
+//
+// iso_date must include year, month, day, hours, minutes, and
+// seconds. It can optionally include fractions of a second and a time
+// zone indicator. (In the absence of any time zone indication, the
+// timestamp is assumed to be in local time.)
+//
+// Any leading or trailing space in iso_date is ignored.
+//
+// This function was deprecated, along with [TimeVal] itself, in GLib 2.62.
+// Equivalent functionality is available using code like:
+//
+//	GDateTime *dt = g_date_time_new_from_iso8601 (iso8601_string, NULL);
+//	gint64 time_val = g_date_time_to_unix (dt);
+//	g_date_time_unref (dt);
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	[NewDateTimeFromIso8601] instead.
+func TimeValFromIso8601(IsoDateVar string, TimeVar *TimeVal) bool {
+
+	cret := xTimeValFromIso8601(IsoDateVar, TimeVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTimeValFromIso8601, libs, "g_time_val_from_iso8601", "GLIB", "2.12")
+
+}
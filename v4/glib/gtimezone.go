@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -15,16 +15,16 @@ import (
 // The `GTimeZone` struct is refcounted and immutable.
 //
 // Each time zone has an identifier (for example, ‘Europe/London’) which is
-// platform dependent. See [ctor@GLib.TimeZone.new] for information on the
+// platform dependent. See [NewTimeZone] for information on the
 // identifier formats. The identifier of a time zone can be retrieved using
-// [method@GLib.TimeZone.get_identifier].
+// [TimeZone.GetIdentifier].
 //
 // A time zone contains a number of intervals. Each interval has an abbreviation
 // to describe it (for example, ‘PDT’), an offset to UTC and a flag indicating
 // if the daylight savings time is in effect during that interval. A time zone
 // always has at least one interval — interval 0. Note that interval abbreviations
 // are not the same as time zone identifiers (apart from ‘UTC’), and cannot be
-// passed to [ctor@GLib.TimeZone.new].
+// passed to [NewTimeZone].
 //
 // Every UTC time is contained within exactly one interval, but a given
 // local time may be contained within zero, one or two intervals (due to
@@ -50,32 +50,16 @@ func (x *TimeZone) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xNewTimeZone func(uintptr) *TimeZone
-
-// A version of g_time_zone_new_identifier() which returns the UTC time zone
-// if @identifier could not be parsed or loaded.
-//
-// If you need to check whether @identifier was loaded successfully, use
-// g_time_zone_new_identifier().
-func NewTimeZone(IdentifierVar *string) *TimeZone {
-
-	IdentifierVarPtr := core.GStrdupNullable(IdentifierVar)
-	defer core.GFreeNullable(IdentifierVarPtr)
-
-	cret := xNewTimeZone(IdentifierVarPtr)
-	return cret
-}
-
 var xNewTimeZoneIdentifier func(uintptr) *TimeZone
 
-// Creates a #GTimeZone corresponding to @identifier. If @identifier cannot be
-// parsed or loaded, %NULL is returned.
+// Creates a [TimeZone] corresponding to identifier. If identifier cannot be
+// parsed or loaded, nil is returned.
 //
-// @identifier can either be an RFC3339/ISO 8601 time offset or
+// identifier can either be an RFC3339/ISO 8601 time offset or
 // something that would pass as a valid value for the `TZ` environment
-// variable (including %NULL).
+// variable (including nil).
 //
-// In Windows, @identifier can also be the unlocalized name of a time
+// In Windows, identifier can also be the unlocalized name of a time
 // zone for standard time, for example "Pacific Standard Time".
 //
 // Valid RFC3339 time offsets are `"Z"` (for UTC) or
@@ -96,7 +80,7 @@ var xNewTimeZoneIdentifier func(uintptr) *TimeZone
 // `"Jn"` (Julian day with n between 1 and 365, leap
 // years not counted), `"n"` (zero-based Julian day
 // with n between 0 and 365) or `"Mm.w.d"` (day d
-// (0 &lt;= d &lt;= 6) of week w (1 &lt;= w &lt;= 5) of month m (1 &lt;= m &lt;= 12), day
+// (0 <= d <= 6) of week w (1 <= w <= 5) of month m (1 <= m <= 12), day
 // 0 is a Sunday).  Times are in local wall clock time, the default is
 // 02:00:00.
 //
@@ -107,9 +91,9 @@ var xNewTimeZoneIdentifier func(uintptr) *TimeZone
 // Offsets are time values to be added to the local time to get
 // Coordinated Universal Time (UTC).
 //
-// g_time_zone_new_local() calls this function with the value of the
+// [NewTimeZoneLocal] calls this function with the value of the
 // `TZ` environment variable. This function itself is independent of
-// the value of `TZ`, but if @identifier is %NULL then `/etc/localtime`
+// the value of `TZ`, but if identifier is nil then `/etc/localtime`
 // will be consulted to discover the correct time zone on UNIX and the
 // registry will be consulted or GetTimeZoneInformation() will be used
 // to get the local time zone on Windows.
@@ -121,18 +105,22 @@ var xNewTimeZoneIdentifier func(uintptr) *TimeZone
 // instead.
 //
 // See
-// [RFC3339 §5.6](http://tools.ietf.org/html/rfc3339#section-5.6)
+// [RFC3339 §5.6]
 // for a precise definition of valid RFC3339 time offsets
 // (the `time-offset` expansion) and ISO 8601 for the
 // full list of valid time offsets.  See
-// [The GNU C Library manual](http://www.gnu.org/s/libc/manual/html_node/TZ-Variable.html)
+// [The GNU C Library manual]
 // for an explanation of the possible
 // values of the `TZ` environment variable. See
-// [Microsoft Time Zone Index Values](http://msdn.microsoft.com/en-us/library/ms912391%28v=winembedded.11%29.aspx)
+// [Microsoft Time Zone Index Values]
 // for the list of time zones on Windows.
 //
-// You should release the return value by calling g_time_zone_unref()
+// You should release the return value by calling [TimeZone.Unref]
 // when you are done with it.
+//
+// [RFC3339 §5.6]: http://tools.ietf.org/html/rfc3339#section-5.6
+// [The GNU C Library manual]: http://www.gnu.org/s/libc/manual/html_node/TZ-Variable.html
+// [Microsoft Time Zone Index Values]: http://msdn.microsoft.com/en-us/library/ms912391%28v=winembedded.11%29.aspx
 func NewTimeZoneIdentifier(IdentifierVar *string) *TimeZone {
 
 	IdentifierVarPtr := core.GStrdupNullable(IdentifierVar)
@@ -144,14 +132,14 @@ func NewTimeZoneIdentifier(IdentifierVar *string) *TimeZone {
 
 var xNewTimeZoneLocal func() *TimeZone
 
-// Creates a #GTimeZone corresponding to local time.  The local time
+// Creates a [TimeZone] corresponding to local time.  The local time
 // zone may change between invocations to this function; for example,
 // if the system administrator changes it.
 //
-// This is equivalent to calling g_time_zone_new() with the value of
-// the `TZ` environment variable (including the possibility of %NULL).
+// This is equivalent to calling [NewTimeZone] with the value of
+// the `TZ` environment variable (including the possibility of nil).
 //
-// You should release the return value by calling g_time_zone_unref()
+// You should release the return value by calling [TimeZone.Unref]
 // when you are done with it.
 func NewTimeZoneLocal() *TimeZone {
 
@@ -161,16 +149,16 @@ func NewTimeZoneLocal() *TimeZone {
 
 var xNewTimeZoneOffset func(int32) *TimeZone
 
-// Creates a #GTimeZone corresponding to the given constant offset from UTC,
+// Creates a [TimeZone] corresponding to the given constant offset from UTC,
 // in seconds.
 //
-// This is equivalent to calling g_time_zone_new() with a string in the form
+// This is equivalent to calling [NewTimeZone] with a string in the form
 // `[+|-]hh[:mm[:ss]]`.
 //
-// It is possible for this function to fail if @seconds is too big (greater than
+// It is possible for this function to fail if seconds is too big (greater than
 // 24 hours), in which case this function will return the UTC timezone for
 // backwards compatibility. To detect failures like this, use
-// g_time_zone_new_identifier() directly.
+// [NewTimeZoneIdentifier] directly.
 func NewTimeZoneOffset(SecondsVar int32) *TimeZone {
 
 	cret := xNewTimeZoneOffset(SecondsVar)
@@ -179,12 +167,12 @@ func NewTimeZoneOffset(SecondsVar int32) *TimeZone {
 
 var xNewTimeZoneUtc func() *TimeZone
 
-// Creates a #GTimeZone corresponding to UTC.
+// Creates a [TimeZone] corresponding to UTC.
 //
-// This is equivalent to calling g_time_zone_new() with a value like
+// This is equivalent to calling [NewTimeZone] with a value like
 // "Z", "UTC", "+00", etc.
 //
-// You should release the return value by calling g_time_zone_unref()
+// You should release the return value by calling [TimeZone.Unref]
 // when you are done with it.
 func NewTimeZoneUtc() *TimeZone {
 
@@ -194,21 +182,21 @@ func NewTimeZoneUtc() *TimeZone {
 
 var xTimeZoneAdjustTime func(uintptr, TimeType, int64) int
 
-// Finds an interval within @tz that corresponds to the given @time_,
-// possibly adjusting @time_ if required to fit into an interval.
-// The meaning of @time_ depends on @type.
+// Finds an interval within tz that corresponds to the given time_,
+// possibly adjusting time_ if required to fit into an interval.
+// The meaning of time_ depends on type.
 //
-// This function is similar to g_time_zone_find_interval(), with the
+// This function is similar to [TimeZone.FindInterval], with the
 // difference that it always succeeds (by making the adjustments
 // described below).
 //
-// In any of the cases where g_time_zone_find_interval() succeeds then
-// this function returns the same value, without modifying @time_.
+// In any of the cases where [TimeZone.FindInterval] succeeds then
+// this function returns the same value, without modifying time_.
 //
-// This function may, however, modify @time_ in order to deal with
-// non-existent times.  If the non-existent local @time_ of 02:30 were
+// This function may, however, modify time_ in order to deal with
+// non-existent times.  If the non-existent local time_ of 02:30 were
 // requested on March 14th 2010 in Toronto then this function would
-// adjust @time_ to be 03:00 and return the interval containing the
+// adjust time_ to be 03:00 and return the interval containing the
 // adjusted time.
 func (x *TimeZone) AdjustTime(TypeVar TimeType, TimeVar int64) int {
 
@@ -218,18 +206,18 @@ func (x *TimeZone) AdjustTime(TypeVar TimeType, TimeVar int64) int {
 
 var xTimeZoneFindInterval func(uintptr, TimeType, int64) int
 
-// Finds an interval within @tz that corresponds to the given @time_.
-// The meaning of @time_ depends on @type.
+// Finds an interval within tz that corresponds to the given time_.
+// The meaning of time_ depends on type.
 //
-// If @type is %G_TIME_TYPE_UNIVERSAL then this function will always
+// If type is [GTimeTypeUniversalValue] then this function will always
 // succeed (since universal time is monotonic and continuous).
 //
-// Otherwise @time_ is treated as local time.  The distinction between
-// %G_TIME_TYPE_STANDARD and %G_TIME_TYPE_DAYLIGHT is ignored except in
-// the case that the given @time_ is ambiguous.  In Toronto, for example,
+// Otherwise time_ is treated as local time.  The distinction between
+// [GTimeTypeStandardValue] and [GTimeTypeDaylightValue] is ignored except in
+// the case that the given time_ is ambiguous.  In Toronto, for example,
 // 01:30 on November 7th 2010 occurred twice (once inside of daylight
 // savings time and the next, an hour later, outside of daylight savings
-// time).  In this case, the different value of @type would result in a
+// time).  In this case, the different value of type would result in a
 // different interval being returned.
 //
 // It is still possible for this function to fail.  In Toronto, for
@@ -245,7 +233,7 @@ func (x *TimeZone) FindInterval(TypeVar TimeType, TimeVar int64) int {
 var xTimeZoneGetAbbreviation func(uintptr, int) string
 
 // Determines the time zone abbreviation to be used during a particular
-// @interval of time in the time zone @tz.
+// interval of time in the time zone tz.
 //
 // For example, in Toronto this is currently "EST" during the winter
 // months and "EDT" during the summer months when daylight savings time
@@ -258,9 +246,9 @@ func (x *TimeZone) GetAbbreviation(IntervalVar int) string {
 
 var xTimeZoneGetIdentifier func(uintptr) string
 
-// Get the identifier of this #GTimeZone, as passed to g_time_zone_new().
+// Get the identifier of this [TimeZone], as passed to [NewTimeZone].
 // If the identifier passed at construction time was not recognised, `UTC` will
-// be returned. If it was %NULL, the identifier of the local timezone at
+// be returned. If it was nil, the identifier of the local timezone at
 // construction time will be returned.
 //
 // The identifier will be returned in the same format as provided at
@@ -274,11 +262,11 @@ func (x *TimeZone) GetIdentifier() string {
 
 var xTimeZoneGetOffset func(uintptr, int) int32
 
-// Determines the offset to UTC in effect during a particular @interval
-// of time in the time zone @tz.
+// Determines the offset to UTC in effect during a particular interval
+// of time in the time zone tz.
 //
 // The offset is the number of seconds that you add to UTC time to
-// arrive at local time for @tz (ie: negative numbers for time zones
+// arrive at local time for tz (ie: negative numbers for time zones
 // west of GMT, positive numbers for east).
 func (x *TimeZone) GetOffset(IntervalVar int) int32 {
 
@@ -289,7 +277,7 @@ func (x *TimeZone) GetOffset(IntervalVar int) int32 {
 var xTimeZoneIsDst func(uintptr, int) bool
 
 // Determines if daylight savings time is in effect during a particular
-// @interval of time in the time zone @tz.
+// interval of time in the time zone tz.
 func (x *TimeZone) IsDst(IntervalVar int) bool {
 
 	cret := xTimeZoneIsDst(x.GoPointer(), IntervalVar)
@@ -298,7 +286,7 @@ func (x *TimeZone) IsDst(IntervalVar int) bool {
 
 var xTimeZoneRef func(uintptr) *TimeZone
 
-// Increases the reference count on @tz.
+// Increases the reference count on tz.
 func (x *TimeZone) Ref() *TimeZone {
 
 	cret := xTimeZoneRef(x.GoPointer())
@@ -307,7 +295,7 @@ func (x *TimeZone) Ref() *TimeZone {
 
 var xTimeZoneUnref func(uintptr)
 
-// Decreases the reference count on @tz.
+// Decreases the reference count on tz.
 func (x *TimeZone) Unref() {
 
 	xTimeZoneUnref(x.GoPointer())
@@ -334,12 +322,51 @@ const (
 	GTimeTypeUniversalValue TimeType = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TimeType) String() string {
+	switch x {
+	case GTimeTypeStandardValue:
+		return "G_TIME_TYPE_STANDARD"
+	case GTimeTypeDaylightValue:
+		return "G_TIME_TYPE_DAYLIGHT"
+	case GTimeTypeUniversalValue:
+		return "G_TIME_TYPE_UNIVERSAL"
+	}
+	return fmt.Sprintf("TimeType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TimeType) Nick() string {
+	switch x {
+	case GTimeTypeStandardValue:
+		return "standard"
+	case GTimeTypeDaylightValue:
+		return "daylight"
+	case GTimeTypeUniversalValue:
+		return "universal"
+	}
+	return ""
+}
+
+// TimeTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TimeTypeFromString(s string) (TimeType, bool) {
+	switch s {
+	case "G_TIME_TYPE_STANDARD", "standard":
+		return GTimeTypeStandardValue, true
+	case "G_TIME_TYPE_DAYLIGHT", "daylight":
+		return GTimeTypeDaylightValue, true
+	case "G_TIME_TYPE_UNIVERSAL", "universal":
+		return GTimeTypeUniversalValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -348,19 +375,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xTimeZoneGLibType, libs, "g_time_zone_get_type")
 
-	core.PuregoSafeRegister(&xNewTimeZone, libs, "g_time_zone_new")
-	core.PuregoSafeRegister(&xNewTimeZoneIdentifier, libs, "g_time_zone_new_identifier")
-	core.PuregoSafeRegister(&xNewTimeZoneLocal, libs, "g_time_zone_new_local")
-	core.PuregoSafeRegister(&xNewTimeZoneOffset, libs, "g_time_zone_new_offset")
-	core.PuregoSafeRegister(&xNewTimeZoneUtc, libs, "g_time_zone_new_utc")
-
-	core.PuregoSafeRegister(&xTimeZoneAdjustTime, libs, "g_time_zone_adjust_time")
-	core.PuregoSafeRegister(&xTimeZoneFindInterval, libs, "g_time_zone_find_interval")
-	core.PuregoSafeRegister(&xTimeZoneGetAbbreviation, libs, "g_time_zone_get_abbreviation")
-	core.PuregoSafeRegister(&xTimeZoneGetIdentifier, libs, "g_time_zone_get_identifier")
-	core.PuregoSafeRegister(&xTimeZoneGetOffset, libs, "g_time_zone_get_offset")
-	core.PuregoSafeRegister(&xTimeZoneIsDst, libs, "g_time_zone_is_dst")
-	core.PuregoSafeRegister(&xTimeZoneRef, libs, "g_time_zone_ref")
-	core.PuregoSafeRegister(&xTimeZoneUnref, libs, "g_time_zone_unref")
+	core.PuregoRegisterSince(&xNewTimeZoneIdentifier, libs, "g_time_zone_new_identifier", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xNewTimeZoneLocal, libs, "g_time_zone_new_local", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xNewTimeZoneOffset, libs, "g_time_zone_new_offset", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xNewTimeZoneUtc, libs, "g_time_zone_new_utc", "GLIB", "2.26")
+
+	core.PuregoRegisterSince(&xTimeZoneAdjustTime, libs, "g_time_zone_adjust_time", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneFindInterval, libs, "g_time_zone_find_interval", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneGetAbbreviation, libs, "g_time_zone_get_abbreviation", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneGetIdentifier, libs, "g_time_zone_get_identifier", "GLIB", "2.58")
+	core.PuregoRegisterSince(&xTimeZoneGetOffset, libs, "g_time_zone_get_offset", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneIsDst, libs, "g_time_zone_is_dst", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneRef, libs, "g_time_zone_ref", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xTimeZoneUnref, libs, "g_time_zone_unref", "GLIB", "2.26")
 
 }
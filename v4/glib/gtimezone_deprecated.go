@@ -0,0 +1,45 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewTimeZone func(uintptr) *TimeZone
+
+// A version of [NewTimeZoneIdentifier] which returns the UTC time zone
+// if identifier could not be parsed or loaded.
+//
+// If you need to check whether identifier was loaded successfully, use
+// [NewTimeZoneIdentifier].
+//
+// Deprecated: since 2.68. Use [NewTimeZoneIdentifier] instead, as it provides
+//
+//	error reporting. Change your code to handle a potentially nil return
+//	value.
+func NewTimeZone(IdentifierVar *string) *TimeZone {
+
+	IdentifierVarPtr := core.GStrdupNullable(IdentifierVar)
+	defer core.GFreeNullable(IdentifierVarPtr)
+
+	cret := xNewTimeZone(IdentifierVarPtr)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewTimeZone, libs, "g_time_zone_new", "GLIB", "2.26")
+
+}
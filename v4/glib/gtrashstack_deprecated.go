@@ -0,0 +1,78 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTrashStackHeight func(**TrashStack) uint
+
+// Returns the height of a [TrashStack].
+//
+// Note that execution of this function is of O(N) complexity
+// where N denotes the number of items on the stack.
+//
+// Deprecated: since 2.48. [TrashStack] is deprecated without replacement
+func TrashStackHeight(StackPVar **TrashStack) uint {
+
+	cret := xTrashStackHeight(StackPVar)
+
+	return cret
+}
+
+var xTrashStackPeek func(**TrashStack) uintptr
+
+// Returns the element at the top of a [TrashStack]
+// which may be nil.
+//
+// Deprecated: since 2.48. [TrashStack] is deprecated without replacement
+func TrashStackPeek(StackPVar **TrashStack) uintptr {
+
+	cret := xTrashStackPeek(StackPVar)
+
+	return cret
+}
+
+var xTrashStackPop func(**TrashStack) uintptr
+
+// Pops a piece of memory off a [TrashStack].
+//
+// Deprecated: since 2.48. [TrashStack] is deprecated without replacement
+func TrashStackPop(StackPVar **TrashStack) uintptr {
+
+	cret := xTrashStackPop(StackPVar)
+
+	return cret
+}
+
+var xTrashStackPush func(**TrashStack, uintptr)
+
+// Pushes a piece of memory onto a [TrashStack].
+//
+// Deprecated: since 2.48. [TrashStack] is deprecated without replacement
+func TrashStackPush(StackPVar **TrashStack, DataPVar uintptr) {
+
+	xTrashStackPush(StackPVar, DataPVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTrashStackHeight, libs, "g_trash_stack_height", "GLIB", "")
+	core.PuregoRegisterSince(&xTrashStackPeek, libs, "g_trash_stack_peek", "GLIB", "")
+	core.PuregoRegisterSince(&xTrashStackPop, libs, "g_trash_stack_pop", "GLIB", "")
+	core.PuregoRegisterSince(&xTrashStackPush, libs, "g_trash_stack_push", "GLIB", "")
+
+}
@@ -5,25 +5,24 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Specifies the type of function passed to g_tree_traverse(). It is
-// passed the key and value of each node, together with the @user_data
-// parameter passed to g_tree_traverse(). If the function returns
-// %TRUE, the traversal is stopped.
+// Specifies the type of function passed to [Tree.Traverse]. It is
+// passed the key and value of each node, together with the user_data
+// parameter passed to [Tree.Traverse]. If the function returns
+// true, the traversal is stopped.
 type TraverseFunc func(uintptr, uintptr, uintptr) bool
 
-// Specifies the type of function passed to g_tree_foreach_node(). It is
-// passed each node, together with the @user_data parameter passed to
-// g_tree_foreach_node(). If the function returns %TRUE, the traversal is
+// Specifies the type of function passed to [Tree.ForeachNode]. It is
+// passed each node, together with the user_data parameter passed to
+// [Tree.ForeachNode]. If the function returns true, the traversal is
 // stopped.
 type TraverseNodeFunc func(*TreeNode, uintptr) bool
 
 // The GTree struct is an opaque data structure representing a
-// [balanced binary tree](data-structures.html#binary-trees). It should be
+// balanced binary tree. It should be
 // accessed only by using the following functions.
 type Tree struct {
 	_ structs.HostLayout
@@ -41,7 +40,7 @@ func (x *Tree) GoPointer() uintptr {
 
 var xNewTree func(uintptr) *Tree
 
-// Creates a new #GTree.
+// Creates a new [Tree].
 func NewTree(KeyCompareFuncVar *CompareFunc) *Tree {
 
 	var KeyCompareFuncVarRef uintptr
@@ -51,11 +50,12 @@ func NewTree(KeyCompareFuncVar *CompareFunc) *Tree {
 			KeyCompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *KeyCompareFuncVar
 				return cbFn(arg0, arg1)
 			}
-			KeyCompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
+			KeyCompareFuncVarRef = core.NewCallback(fcb)
+			KeyCompareFuncVarRef = SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
 		}
 	}
 
@@ -65,9 +65,9 @@ func NewTree(KeyCompareFuncVar *CompareFunc) *Tree {
 
 var xNewTreeFull func(uintptr, uintptr, uintptr, uintptr) *Tree
 
-// Creates a new #GTree like g_tree_new() and allows to specify functions
+// Creates a new [Tree] like [NewTree] and allows to specify functions
 // to free the memory allocated for the key and value that get called when
-// removing the entry from the #GTree.
+// removing the entry from the [Tree].
 func NewTreeFull(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr, KeyDestroyFuncVar *DestroyNotify, ValueDestroyFuncVar *DestroyNotify) *Tree {
 
 	var KeyCompareFuncVarRef uintptr
@@ -77,11 +77,12 @@ func NewTreeFull(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr,
 			KeyCompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *KeyCompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			KeyCompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
+			KeyCompareFuncVarRef = core.NewCallback(fcb)
+			KeyCompareFuncVarRef = SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
 		}
 	}
 
@@ -92,11 +93,12 @@ func NewTreeFull(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr,
 			KeyDestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *KeyDestroyFuncVar
 				cbFn(arg0)
 			}
-			KeyDestroyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(KeyDestroyFuncVarPtr, KeyDestroyFuncVarRef, KeyDestroyFuncVar)
+			KeyDestroyFuncVarRef = core.NewCallback(fcb)
+			KeyDestroyFuncVarRef = SaveCallbackWithClosure(KeyDestroyFuncVarPtr, KeyDestroyFuncVarRef, KeyDestroyFuncVar)
 		}
 	}
 
@@ -107,11 +109,12 @@ func NewTreeFull(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr,
 			ValueDestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ValueDestroyFuncVar
 				cbFn(arg0)
 			}
-			ValueDestroyFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(ValueDestroyFuncVarPtr, ValueDestroyFuncVarRef, ValueDestroyFuncVar)
+			ValueDestroyFuncVarRef = core.NewCallback(fcb)
+			ValueDestroyFuncVarRef = SaveCallbackWithClosure(ValueDestroyFuncVarPtr, ValueDestroyFuncVarRef, ValueDestroyFuncVar)
 		}
 	}
 
@@ -121,8 +124,8 @@ func NewTreeFull(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr,
 
 var xNewTreeWithData func(uintptr, uintptr) *Tree
 
-// Creates a new #GTree with a comparison function that accepts user data.
-// See g_tree_new() for more details.
+// Creates a new [Tree] with a comparison function that accepts user data.
+// See [NewTree] for more details.
 func NewTreeWithData(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintptr) *Tree {
 
 	var KeyCompareFuncVarRef uintptr
@@ -132,11 +135,12 @@ func NewTreeWithData(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintp
 			KeyCompareFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *KeyCompareFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			KeyCompareFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
+			KeyCompareFuncVarRef = core.NewCallback(fcb)
+			KeyCompareFuncVarRef = SaveCallbackWithClosure(KeyCompareFuncVarPtr, KeyCompareFuncVarRef, KeyCompareFuncVar)
 		}
 	}
 
@@ -146,12 +150,12 @@ func NewTreeWithData(KeyCompareFuncVar *CompareDataFunc, KeyCompareDataVar uintp
 
 var xTreeDestroy func(uintptr)
 
-// Removes all keys and values from the #GTree and decreases its
+// Removes all keys and values from the [Tree] and decreases its
 // reference count by one. If keys and/or values are dynamically
-// allocated, you should either free them first or create the #GTree
-// using g_tree_new_full(). In the latter case the destroy functions
+// allocated, you should either free them first or create the [Tree]
+// using [NewTreeFull]. In the latter case the destroy functions
 // you supplied will be called on all keys and values before destroying
-// the #GTree.
+// the [Tree].
 func (x *Tree) Destroy() {
 
 	xTreeDestroy(x.GoPointer())
@@ -160,13 +164,13 @@ func (x *Tree) Destroy() {
 
 var xTreeForeach func(uintptr, uintptr, uintptr)
 
-// Calls the given function for each of the key/value pairs in the #GTree.
+// Calls the given function for each of the key/value pairs in the [Tree].
 // The function is passed the key and value of each pair, and the given
-// @data parameter. The tree is traversed in sorted order.
+// data parameter. The tree is traversed in sorted order.
 //
 // The tree may not be modified while iterating over it (you can't
 // add/remove items). To remove all items matching a predicate, you need
-// to add each item to a list in your #GTraverseFunc as you walk over
+// to add each item to a list in your [TraverseFunc] as you walk over
 // the tree, then walk the list and remove each item.
 func (x *Tree) Foreach(FuncVar *TraverseFunc, UserDataVar uintptr) {
 
@@ -177,11 +181,12 @@ func (x *Tree) Foreach(FuncVar *TraverseFunc, UserDataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -191,13 +196,13 @@ func (x *Tree) Foreach(FuncVar *TraverseFunc, UserDataVar uintptr) {
 
 var xTreeForeachNode func(uintptr, uintptr, uintptr)
 
-// Calls the given function for each of the nodes in the #GTree.
+// Calls the given function for each of the nodes in the [Tree].
 // The function is passed the pointer to the particular node, and the given
-// @data parameter. The tree traversal happens in-order.
+// data parameter. The tree traversal happens in-order.
 //
 // The tree may not be modified while iterating over it (you can't
 // add/remove items). To remove all items matching a predicate, you need
-// to add each item to a list in your #GTraverseFunc as you walk over
+// to add each item to a list in your [TraverseFunc] as you walk over
 // the tree, then walk the list and remove each item.
 func (x *Tree) ForeachNode(FuncVar *TraverseNodeFunc, UserDataVar uintptr) {
 
@@ -208,11 +213,12 @@ func (x *Tree) ForeachNode(FuncVar *TraverseNodeFunc, UserDataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *TreeNode, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -222,10 +228,10 @@ func (x *Tree) ForeachNode(FuncVar *TraverseNodeFunc, UserDataVar uintptr) {
 
 var xTreeHeight func(uintptr) int
 
-// Gets the height of a #GTree.
+// Gets the height of a [Tree].
 //
-// If the #GTree contains no nodes, the height is 0.
-// If the #GTree contains only one root node the height is 1.
+// If the [Tree] contains no nodes, the height is 0.
+// If the [Tree] contains only one root node the height is 1.
 // If the root node has children the height is 2, etc.
 func (x *Tree) Height() int {
 
@@ -235,9 +241,9 @@ func (x *Tree) Height() int {
 
 var xTreeInsert func(uintptr, uintptr, uintptr)
 
-// Inserts a key/value pair into a #GTree.
+// Inserts a key/value pair into a [Tree].
 //
-// Inserts a new key and value into a #GTree as g_tree_insert_node() does,
+// Inserts a new key and value into a [Tree] as [Tree.InsertNode] does,
 // only this function does not return the inserted or set node.
 func (x *Tree) Insert(KeyVar uintptr, ValueVar uintptr) {
 
@@ -247,12 +253,12 @@ func (x *Tree) Insert(KeyVar uintptr, ValueVar uintptr) {
 
 var xTreeInsertNode func(uintptr, uintptr, uintptr) *TreeNode
 
-// Inserts a key/value pair into a #GTree.
+// Inserts a key/value pair into a [Tree].
 //
-// If the given key already exists in the #GTree its corresponding value
-// is set to the new value. If you supplied a @value_destroy_func when
-// creating the #GTree, the old value is freed using that function. If
-// you supplied a @key_destroy_func when creating the #GTree, the passed
+// If the given key already exists in the [Tree] its corresponding value
+// is set to the new value. If you supplied a value_destroy_func when
+// creating the [Tree], the old value is freed using that function. If
+// you supplied a key_destroy_func when creating the [Tree], the passed
 // key is freed using that function.
 //
 // The tree is automatically 'balanced' as new key/value pairs are added,
@@ -268,7 +274,7 @@ func (x *Tree) InsertNode(KeyVar uintptr, ValueVar uintptr) *TreeNode {
 
 var xTreeLookup func(uintptr, uintptr) uintptr
 
-// Gets the value corresponding to the given key. Since a #GTree is
+// Gets the value corresponding to the given key. Since a [Tree] is
 // automatically balanced as key/value pairs are added, key lookup
 // is O(log n) (where n is the number of key/value pairs in the tree).
 func (x *Tree) Lookup(KeyVar uintptr) uintptr {
@@ -279,10 +285,10 @@ func (x *Tree) Lookup(KeyVar uintptr) uintptr {
 
 var xTreeLookupExtended func(uintptr, uintptr, *uintptr, *uintptr) bool
 
-// Looks up a key in the #GTree, returning the original key and the
+// Looks up a key in the [Tree], returning the original key and the
 // associated value. This is useful if you need to free the memory
 // allocated for the original key, for example before calling
-// g_tree_remove().
+// [Tree.Remove].
 func (x *Tree) LookupExtended(LookupKeyVar uintptr, OrigKeyVar *uintptr, ValueVar *uintptr) bool {
 
 	cret := xTreeLookupExtended(x.GoPointer(), LookupKeyVar, OrigKeyVar, ValueVar)
@@ -291,7 +297,7 @@ func (x *Tree) LookupExtended(LookupKeyVar uintptr, OrigKeyVar *uintptr, ValueVa
 
 var xTreeLookupNode func(uintptr, uintptr) *TreeNode
 
-// Gets the tree node corresponding to the given key. Since a #GTree is
+// Gets the tree node corresponding to the given key. Since a [Tree] is
 // automatically balanced as key/value pairs are added, key lookup
 // is O(log n) (where n is the number of key/value pairs in the tree).
 func (x *Tree) LookupNode(KeyVar uintptr) *TreeNode {
@@ -303,7 +309,7 @@ func (x *Tree) LookupNode(KeyVar uintptr) *TreeNode {
 var xTreeLowerBound func(uintptr, uintptr) *TreeNode
 
 // Gets the lower bound node corresponding to the given key,
-// or %NULL if the tree is empty or all the nodes in the tree
+// or nil if the tree is empty or all the nodes in the tree
 // have keys that are strictly lower than the searched key.
 //
 // The lower bound is the first node that has its key greater
@@ -316,7 +322,7 @@ func (x *Tree) LowerBound(KeyVar uintptr) *TreeNode {
 
 var xTreeNnodes func(uintptr) int
 
-// Gets the number of nodes in a #GTree.
+// Gets the number of nodes in a [Tree].
 func (x *Tree) Nnodes() int {
 
 	cret := xTreeNnodes(x.GoPointer())
@@ -325,7 +331,7 @@ func (x *Tree) Nnodes() int {
 
 var xTreeNodeFirst func(uintptr) *TreeNode
 
-// Returns the first in-order node of the tree, or %NULL
+// Returns the first in-order node of the tree, or nil
 // for an empty tree.
 func (x *Tree) NodeFirst() *TreeNode {
 
@@ -335,7 +341,7 @@ func (x *Tree) NodeFirst() *TreeNode {
 
 var xTreeNodeLast func(uintptr) *TreeNode
 
-// Returns the last in-order node of the tree, or %NULL
+// Returns the last in-order node of the tree, or nil
 // for an empty tree.
 func (x *Tree) NodeLast() *TreeNode {
 
@@ -345,7 +351,7 @@ func (x *Tree) NodeLast() *TreeNode {
 
 var xTreeRef func(uintptr) *Tree
 
-// Increments the reference count of @tree by one.
+// Increments the reference count of tree by one.
 //
 // It is safe to call this function from any thread.
 func (x *Tree) Ref() *Tree {
@@ -356,12 +362,12 @@ func (x *Tree) Ref() *Tree {
 
 var xTreeRemove func(uintptr, uintptr) bool
 
-// Removes a key/value pair from a #GTree.
+// Removes a key/value pair from a [Tree].
 //
-// If the #GTree was created using g_tree_new_full(), the key and value
+// If the [Tree] was created using [NewTreeFull], the key and value
 // are freed using the supplied destroy functions, otherwise you have to
 // make sure that any dynamically allocated values are freed yourself.
-// If the key does not exist in the #GTree, the function does nothing.
+// If the key does not exist in the [Tree], the function does nothing.
 //
 // The cost of maintaining a balanced tree while removing a key/value
 // result in a O(n log(n)) operation where most of the other operations
@@ -374,8 +380,8 @@ func (x *Tree) Remove(KeyVar uintptr) bool {
 
 var xTreeRemoveAll func(uintptr)
 
-// Removes all nodes from a #GTree and destroys their keys and values,
-// then resets the #GTree’s root to %NULL.
+// Removes all nodes from a [Tree] and destroys their keys and values,
+// then resets the [Tree]’s root to nil.
 func (x *Tree) RemoveAll() {
 
 	xTreeRemoveAll(x.GoPointer())
@@ -384,7 +390,7 @@ func (x *Tree) RemoveAll() {
 
 var xTreeReplace func(uintptr, uintptr, uintptr)
 
-// Inserts a new key and value into a #GTree as g_tree_replace_node() does,
+// Inserts a new key and value into a [Tree] as [Tree.ReplaceNode] does,
 // only this function does not return the inserted or set node.
 func (x *Tree) Replace(KeyVar uintptr, ValueVar uintptr) {
 
@@ -394,11 +400,11 @@ func (x *Tree) Replace(KeyVar uintptr, ValueVar uintptr) {
 
 var xTreeReplaceNode func(uintptr, uintptr, uintptr) *TreeNode
 
-// Inserts a new key and value into a #GTree similar to g_tree_insert_node().
-// The difference is that if the key already exists in the #GTree, it gets
-// replaced by the new key. If you supplied a @value_destroy_func when
-// creating the #GTree, the old value is freed using that function. If you
-// supplied a @key_destroy_func when creating the #GTree, the old key is
+// Inserts a new key and value into a [Tree] similar to [Tree.InsertNode].
+// The difference is that if the key already exists in the [Tree], it gets
+// replaced by the new key. If you supplied a value_destroy_func when
+// creating the [Tree], the old value is freed using that function. If you
+// supplied a key_destroy_func when creating the [Tree], the old key is
 // freed using that function.
 //
 // The tree is automatically 'balanced' as new key/value pairs are added,
@@ -411,14 +417,14 @@ func (x *Tree) ReplaceNode(KeyVar uintptr, ValueVar uintptr) *TreeNode {
 
 var xTreeSearch func(uintptr, uintptr, uintptr) uintptr
 
-// Searches a #GTree using @search_func.
+// Searches a [Tree] using search_func.
 //
-// The @search_func is called with a pointer to the key of a key/value
-// pair in the tree, and the passed in @user_data. If @search_func returns
+// The search_func is called with a pointer to the key of a key/value
+// pair in the tree, and the passed in user_data. If search_func returns
 // 0 for a key/value pair, then the corresponding value is returned as
-// the result of g_tree_search(). If @search_func returns -1, searching
+// the result of [Tree.Search]. If search_func returns -1, searching
 // will proceed among the key/value pairs that have a smaller key; if
-// @search_func returns 1, searching will proceed among the key/value
+// search_func returns 1, searching will proceed among the key/value
 // pairs that have a larger key.
 func (x *Tree) Search(SearchFuncVar *CompareFunc, UserDataVar uintptr) uintptr {
 
@@ -429,11 +435,12 @@ func (x *Tree) Search(SearchFuncVar *CompareFunc, UserDataVar uintptr) uintptr {
 			SearchFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SearchFuncVar
 				return cbFn(arg0, arg1)
 			}
-			SearchFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(SearchFuncVarPtr, SearchFuncVarRef, SearchFuncVar)
+			SearchFuncVarRef = core.NewCallback(fcb)
+			SearchFuncVarRef = SaveCallbackWithClosure(SearchFuncVarPtr, SearchFuncVarRef, SearchFuncVar)
 		}
 	}
 
@@ -443,14 +450,14 @@ func (x *Tree) Search(SearchFuncVar *CompareFunc, UserDataVar uintptr) uintptr {
 
 var xTreeSearchNode func(uintptr, uintptr, uintptr) *TreeNode
 
-// Searches a #GTree using @search_func.
+// Searches a [Tree] using search_func.
 //
-// The @search_func is called with a pointer to the key of a key/value
-// pair in the tree, and the passed in @user_data. If @search_func returns
+// The search_func is called with a pointer to the key of a key/value
+// pair in the tree, and the passed in user_data. If search_func returns
 // 0 for a key/value pair, then the corresponding node is returned as
-// the result of g_tree_search(). If @search_func returns -1, searching
+// the result of [Tree.Search]. If search_func returns -1, searching
 // will proceed among the key/value pairs that have a smaller key; if
-// @search_func returns 1, searching will proceed among the key/value
+// search_func returns 1, searching will proceed among the key/value
 // pairs that have a larger key.
 func (x *Tree) SearchNode(SearchFuncVar *CompareFunc, UserDataVar uintptr) *TreeNode {
 
@@ -461,11 +468,12 @@ func (x *Tree) SearchNode(SearchFuncVar *CompareFunc, UserDataVar uintptr) *Tree
 			SearchFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SearchFuncVar
 				return cbFn(arg0, arg1)
 			}
-			SearchFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(SearchFuncVarPtr, SearchFuncVarRef, SearchFuncVar)
+			SearchFuncVarRef = core.NewCallback(fcb)
+			SearchFuncVarRef = SaveCallbackWithClosure(SearchFuncVarPtr, SearchFuncVarRef, SearchFuncVar)
 		}
 	}
 
@@ -475,46 +483,22 @@ func (x *Tree) SearchNode(SearchFuncVar *CompareFunc, UserDataVar uintptr) *Tree
 
 var xTreeSteal func(uintptr, uintptr) bool
 
-// Removes a key and its associated value from a #GTree without calling
+// Removes a key and its associated value from a [Tree] without calling
 // the key and value destroy functions.
 //
-// If the key does not exist in the #GTree, the function does nothing.
+// If the key does not exist in the [Tree], the function does nothing.
 func (x *Tree) Steal(KeyVar uintptr) bool {
 
 	cret := xTreeSteal(x.GoPointer(), KeyVar)
 	return cret
 }
 
-var xTreeTraverse func(uintptr, uintptr, TraverseType, uintptr)
-
-// Calls the given function for each node in the #GTree.
-func (x *Tree) Traverse(TraverseFuncVar *TraverseFunc, TraverseTypeVar TraverseType, UserDataVar uintptr) {
-
-	var TraverseFuncVarRef uintptr
-	if TraverseFuncVar != nil {
-		TraverseFuncVarPtr := uintptr(unsafe.Pointer(TraverseFuncVar))
-		if cbRefPtr, ok := GetCallback(TraverseFuncVarPtr); ok {
-			TraverseFuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
-				cbFn := *TraverseFuncVar
-				return cbFn(arg0, arg1, arg2)
-			}
-			TraverseFuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(TraverseFuncVarPtr, TraverseFuncVarRef, TraverseFuncVar)
-		}
-	}
-
-	xTreeTraverse(x.GoPointer(), TraverseFuncVarRef, TraverseTypeVar, UserDataVar)
-
-}
-
 var xTreeUnref func(uintptr)
 
-// Decrements the reference count of @tree by one.
+// Decrements the reference count of tree by one.
 // If the reference count drops to 0, all keys and values will
 // be destroyed (if destroy functions were specified) and all
-// memory allocated by @tree will be released.
+// memory allocated by tree will be released.
 //
 // It is safe to call this function from any thread.
 func (x *Tree) Unref() {
@@ -526,7 +510,7 @@ func (x *Tree) Unref() {
 var xTreeUpperBound func(uintptr, uintptr) *TreeNode
 
 // Gets the upper bound node corresponding to the given key,
-// or %NULL if the tree is empty or all the nodes in the tree
+// or nil if the tree is empty or all the nodes in the tree
 // have keys that are lower than or equal to the searched key.
 //
 // The upper bound is the first node that has its key strictly greater
@@ -537,7 +521,7 @@ func (x *Tree) UpperBound(KeyVar uintptr) *TreeNode {
 	return cret
 }
 
-// An opaque type which identifies a specific node in a #GTree.
+// An opaque type which identifies a specific node in a [Tree].
 type TreeNode struct {
 	_ structs.HostLayout
 }
@@ -557,7 +541,7 @@ func (x *TreeNode) Key() uintptr {
 
 var xTreeNodeNext func(uintptr) *TreeNode
 
-// Returns the next in-order node of the tree, or %NULL
+// Returns the next in-order node of the tree, or nil
 // if the passed node was already the last one.
 func (x *TreeNode) Next() *TreeNode {
 
@@ -567,7 +551,7 @@ func (x *TreeNode) Next() *TreeNode {
 
 var xTreeNodePrevious func(uintptr) *TreeNode
 
-// Returns the previous in-order node of the tree, or %NULL
+// Returns the previous in-order node of the tree, or nil
 // if the passed node was already the first one.
 func (x *TreeNode) Previous() *TreeNode {
 
@@ -589,7 +573,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -598,38 +582,37 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeGLibType, libs, "g_tree_get_type")
 
-	core.PuregoSafeRegister(&xNewTree, libs, "g_tree_new")
-	core.PuregoSafeRegister(&xNewTreeFull, libs, "g_tree_new_full")
-	core.PuregoSafeRegister(&xNewTreeWithData, libs, "g_tree_new_with_data")
-
-	core.PuregoSafeRegister(&xTreeDestroy, libs, "g_tree_destroy")
-	core.PuregoSafeRegister(&xTreeForeach, libs, "g_tree_foreach")
-	core.PuregoSafeRegister(&xTreeForeachNode, libs, "g_tree_foreach_node")
-	core.PuregoSafeRegister(&xTreeHeight, libs, "g_tree_height")
-	core.PuregoSafeRegister(&xTreeInsert, libs, "g_tree_insert")
-	core.PuregoSafeRegister(&xTreeInsertNode, libs, "g_tree_insert_node")
-	core.PuregoSafeRegister(&xTreeLookup, libs, "g_tree_lookup")
-	core.PuregoSafeRegister(&xTreeLookupExtended, libs, "g_tree_lookup_extended")
-	core.PuregoSafeRegister(&xTreeLookupNode, libs, "g_tree_lookup_node")
-	core.PuregoSafeRegister(&xTreeLowerBound, libs, "g_tree_lower_bound")
-	core.PuregoSafeRegister(&xTreeNnodes, libs, "g_tree_nnodes")
-	core.PuregoSafeRegister(&xTreeNodeFirst, libs, "g_tree_node_first")
-	core.PuregoSafeRegister(&xTreeNodeLast, libs, "g_tree_node_last")
-	core.PuregoSafeRegister(&xTreeRef, libs, "g_tree_ref")
-	core.PuregoSafeRegister(&xTreeRemove, libs, "g_tree_remove")
-	core.PuregoSafeRegister(&xTreeRemoveAll, libs, "g_tree_remove_all")
-	core.PuregoSafeRegister(&xTreeReplace, libs, "g_tree_replace")
-	core.PuregoSafeRegister(&xTreeReplaceNode, libs, "g_tree_replace_node")
-	core.PuregoSafeRegister(&xTreeSearch, libs, "g_tree_search")
-	core.PuregoSafeRegister(&xTreeSearchNode, libs, "g_tree_search_node")
-	core.PuregoSafeRegister(&xTreeSteal, libs, "g_tree_steal")
-	core.PuregoSafeRegister(&xTreeTraverse, libs, "g_tree_traverse")
-	core.PuregoSafeRegister(&xTreeUnref, libs, "g_tree_unref")
-	core.PuregoSafeRegister(&xTreeUpperBound, libs, "g_tree_upper_bound")
-
-	core.PuregoSafeRegister(&xTreeNodeKey, libs, "g_tree_node_key")
-	core.PuregoSafeRegister(&xTreeNodeNext, libs, "g_tree_node_next")
-	core.PuregoSafeRegister(&xTreeNodePrevious, libs, "g_tree_node_previous")
-	core.PuregoSafeRegister(&xTreeNodeValue, libs, "g_tree_node_value")
+	core.PuregoRegisterSince(&xNewTree, libs, "g_tree_new", "GLIB", "")
+	core.PuregoRegisterSince(&xNewTreeFull, libs, "g_tree_new_full", "GLIB", "")
+	core.PuregoRegisterSince(&xNewTreeWithData, libs, "g_tree_new_with_data", "GLIB", "")
+
+	core.PuregoRegisterSince(&xTreeDestroy, libs, "g_tree_destroy", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeForeach, libs, "g_tree_foreach", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeForeachNode, libs, "g_tree_foreach_node", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeHeight, libs, "g_tree_height", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeInsert, libs, "g_tree_insert", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeInsertNode, libs, "g_tree_insert_node", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeLookup, libs, "g_tree_lookup", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeLookupExtended, libs, "g_tree_lookup_extended", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeLookupNode, libs, "g_tree_lookup_node", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeLowerBound, libs, "g_tree_lower_bound", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeNnodes, libs, "g_tree_nnodes", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeNodeFirst, libs, "g_tree_node_first", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeNodeLast, libs, "g_tree_node_last", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeRef, libs, "g_tree_ref", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xTreeRemove, libs, "g_tree_remove", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeRemoveAll, libs, "g_tree_remove_all", "GLIB", "2.70")
+	core.PuregoRegisterSince(&xTreeReplace, libs, "g_tree_replace", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeReplaceNode, libs, "g_tree_replace_node", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeSearch, libs, "g_tree_search", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeSearchNode, libs, "g_tree_search_node", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeSteal, libs, "g_tree_steal", "GLIB", "")
+	core.PuregoRegisterSince(&xTreeUnref, libs, "g_tree_unref", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xTreeUpperBound, libs, "g_tree_upper_bound", "GLIB", "2.68")
+
+	core.PuregoRegisterSince(&xTreeNodeKey, libs, "g_tree_node_key", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeNodeNext, libs, "g_tree_node_next", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeNodePrevious, libs, "g_tree_node_previous", "GLIB", "2.68")
+	core.PuregoRegisterSince(&xTreeNodeValue, libs, "g_tree_node_value", "GLIB", "2.68")
 
 }
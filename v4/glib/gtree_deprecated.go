@@ -0,0 +1,57 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTreeTraverse func(uintptr, uintptr, TraverseType, uintptr)
+
+// Calls the given function for each node in the [Tree].
+//
+// Deprecated: since 2.2. The order of a balanced tree is somewhat arbitrary.
+//
+//	If you just want to visit all nodes in sorted order, use
+//	[Tree.Foreach] instead. If you really need to visit nodes in
+//	a different order, consider using an n-ary tree.
+func (x *Tree) Traverse(TraverseFuncVar *TraverseFunc, TraverseTypeVar TraverseType, UserDataVar uintptr) {
+
+	var TraverseFuncVarRef uintptr
+	if TraverseFuncVar != nil {
+		TraverseFuncVarPtr := uintptr(unsafe.Pointer(TraverseFuncVar))
+		if cbRefPtr, ok := GetCallback(TraverseFuncVarPtr); ok {
+			TraverseFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *TraverseFuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			TraverseFuncVarRef = core.NewCallback(fcb)
+			TraverseFuncVarRef = SaveCallbackWithClosure(TraverseFuncVarPtr, TraverseFuncVarRef, TraverseFuncVar)
+		}
+	}
+
+	xTreeTraverse(x.GoPointer(), TraverseFuncVarRef, TraverseTypeVar, UserDataVar)
+
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeTraverse, libs, "g_tree_traverse", "GLIB", "")
+
+}
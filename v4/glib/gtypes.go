@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Specifies the type of a comparison function used to compare two
@@ -31,15 +31,15 @@ type CopyFunc func(uintptr, uintptr) uintptr
 type DestroyNotify func(uintptr)
 
 // Specifies the type of a function used to test two values for
-// equality. The function should return %TRUE if both values are equal
-// and %FALSE otherwise.
+// equality. The function should return true if both values are equal
+// and false otherwise.
 type EqualFunc func(uintptr, uintptr) bool
 
 // Specifies the type of a function used to test two values for
-// equality. The function should return %TRUE if both values are equal
-// and %FALSE otherwise.
+// equality. The function should return true if both values are equal
+// and false otherwise.
 //
-// This is a version of #GEqualFunc which provides a @user_data closure from
+// This is a version of [EqualFunc] which provides a user_data closure from
 // the caller.
 type EqualFuncFull func(uintptr, uintptr, uintptr) bool
 
@@ -52,20 +52,20 @@ type FreeFunc func(uintptr)
 // g_slist_foreach().
 type Func func(uintptr, uintptr)
 
-// Specifies the type of the function passed to g_hash_table_foreach().
-// It is called with each key/value pair, together with the @user_data
-// parameter which is passed to g_hash_table_foreach().
+// Specifies the type of the function passed to [HashTableForeach].
+// It is called with each key/value pair, together with the user_data
+// parameter which is passed to [HashTableForeach].
 type HFunc func(uintptr, uintptr, uintptr)
 
 // Specifies the type of the hash function which is passed to
-// g_hash_table_new() when a #GHashTable is created.
+// g_hash_table_new() when a [HashTable] is created.
 //
 // The function is passed a key and should return a #guint hash value.
-// The functions g_direct_hash(), g_int_hash() and g_str_hash() provide
+// The functions [DirectHash], [IntHash] and [StrHash] provide
 // hash functions which can be used when the key is a #gpointer, #gint*,
 // and #gchar* respectively.
 //
-// g_direct_hash() is also the appropriate hash function for keys
+// [DirectHash] is also the appropriate hash function for keys
 // of the form `GINT_TO_POINTER (n)` (or similar macros).
 //
 // A good hash functions should produce
@@ -79,18 +79,20 @@ type HFunc func(uintptr, uintptr, uintptr)
 // cause hash collisions. Therefore, you should consider choosing
 // a more secure hash function when using a GHashTable with keys
 // that originate in untrusted data (such as HTTP requests).
-// Using g_str_hash() in that situation might make your application
+// Using [StrHash] in that situation might make your application
 // vulnerable to
-// [Algorithmic Complexity Attacks](https://lwn.net/Articles/474912/).
+// [Algorithmic Complexity Attacks].
 //
 // The key to choosing a good hash is unpredictability.  Even
 // cryptographic hashes are very easy to find collisions for when the
 // remainder is taken modulo a somewhat predictable prime number.  There
 // must be an element of randomness that an attacker is unable to guess.
+//
+// [Algorithmic Complexity Attacks]: https://lwn.net/Articles/474912/
 type HashFunc func(uintptr) uint
 
 // The type of functions which are used to translate user-visible
-// strings, for &lt;option&gt;--help&lt;/option&gt; output.
+// strings, for <option>--help</option> output.
 type TranslateFunc func(string, uintptr) string
 
 // Represents a precise time, with seconds and microseconds.
@@ -103,77 +105,40 @@ type TranslateFunc func(string, uintptr) string
 // removed from a future version of GLib. A consequence of using `glong` for
 // `tv_sec` is that on 32-bit systems `GTimeVal` is subject to the year 2038
 // problem.
+//
+// Deprecated: since 2.62. Use [DateTime] or #guint64 instead.
 type TimeVal struct {
 	_ structs.HostLayout
 
-	TvSec int
+	TvSec types.Long
 
-	TvUsec int
+	TvUsec types.Long
 }
 
 func (x *TimeVal) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xTimeValAdd func(uintptr, int)
-
-// Adds the given number of microseconds to @time_. @microseconds can
-// also be negative to decrease the value of @time_.
-func (x *TimeVal) Add(MicrosecondsVar int) {
-
-	xTimeValAdd(x.GoPointer(), MicrosecondsVar)
-
+// NewTimeValAlloc returns a zeroed TimeVal allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated TimeVal. Release it with Free.
+func NewTimeValAlloc() *TimeVal {
+	cret := core.GMalloc0(max(unsafe.Sizeof(TimeVal{}), 16))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*TimeVal)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
 }
 
-var xTimeValToIso8601 func(uintptr) string
-
-// Converts @time_ into an RFC 3339 encoded string, relative to the
-// Coordinated Universal Time (UTC). This is one of the many formats
-// allowed by ISO 8601.
-//
-// ISO 8601 allows a large number of date/time formats, with or without
-// punctuation and optional elements. The format returned by this function
-// is a complete date and time, with optional punctuation included, the
-// UTC time zone represented as "Z", and the @tv_usec part included if
-// and only if it is nonzero, i.e. either
-// "YYYY-MM-DDTHH:MM:SSZ" or "YYYY-MM-DDTHH:MM:SS.fffffZ".
-//
-// This corresponds to the Internet date/time format defined by
-// [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt),
-// and to either of the two most-precise formats defined by
-// the W3C Note
-// [Date and Time Formats](http://www.w3.org/TR/NOTE-datetime-19980827).
-// Both of these documents are profiles of ISO 8601.
-//
-// Use g_date_time_format() or g_strdup_printf() if a different
-// variation of ISO 8601 format is required.
-//
-// If @time_ represents a date which is too large to fit into a `struct tm`,
-// %NULL will be returned. This is platform dependent. Note also that since
-// `GTimeVal` stores the number of seconds as a `glong`, on 32-bit systems it
-// is subject to the year 2038 problem. Accordingly, since GLib 2.62, this
-// function has been deprecated. Equivalent functionality is available using:
-// |[
-// GDateTime *dt = g_date_time_new_from_unix_utc (time_val);
-// iso8601_string = g_date_time_format_iso8601 (dt);
-// g_date_time_unref (dt);
-// ]|
-//
-// The return value of g_time_val_to_iso8601() has been nullable since GLib
-// 2.54; before then, GLib would crash under the same conditions.
-func (x *TimeVal) ToIso8601() string {
-
-	cret := xTimeValToIso8601(x.GoPointer())
-	return cret
+// Free releases the TimeVal with g_free, it must have been allocated by GLib, e.g. with NewTimeValAlloc.
+func (x *TimeVal) Free() {
+	core.GFree(x.GoPointer())
 }
 
-// The #GFloatIEEE754 and #GDoubleIEEE754 unions are used to access the sign,
+// The [FloatIEEE754] and [DoubleIEEE754] unions are used to access the sign,
 // mantissa and exponent of IEEE floats and doubles. These unions are defined
 // as appropriate for a given platform. IEEE floats and doubles are supported
 // (used for storage) by at least Intel, PPC and Sparc.
 type DoubleIEEE754 = uintptr
 
-// The #GFloatIEEE754 and #GDoubleIEEE754 unions are used to access the sign,
+// The [FloatIEEE754] and [DoubleIEEE754] unions are used to access the sign,
 // mantissa and exponent of IEEE floats and doubles. These unions are defined
 // as appropriate for a given platform. IEEE floats and doubles are supported
 // (used for storage) by at least Intel, PPC and Sparc.
@@ -181,7 +146,7 @@ type FloatIEEE754 = uintptr
 
 const (
 	// Specifies one of the possible types of byte order.
-	// See %G_BYTE_ORDER.
+	// See G_BYTE_ORDER.
 	BIG_ENDIAN int = 4321
 	// The base of natural logarithms.
 	E float64 = 2.718282
@@ -190,7 +155,7 @@ const (
 	// The bias by which exponents in single-precision floats are offset.
 	IEEE754_FLOAT_BIAS int = 127
 	// Specifies one of the possible types of byte order.
-	// See %G_BYTE_ORDER.
+	// See G_BYTE_ORDER.
 	LITTLE_ENDIAN int = 1234
 	// The natural logarithm of 10.
 	LN10 float64 = 2.302585
@@ -223,7 +188,7 @@ const (
 	// The minimum value which can be held in a #gint8.
 	MININT8 int8 = -128
 	// Specifies one of the possible types of byte order
-	// (currently unused). See %G_BYTE_ORDER.
+	// (currently unused). See G_BYTE_ORDER.
 	PDP_ENDIAN int = 3412
 	// The value of pi (ratio of circle's circumference to its diameter).
 	PI float64 = 3.141593
@@ -234,20 +199,3 @@ const (
 	// The square root of two.
 	SQRT2 float64 = 1.414214
 )
-
-func init() {
-	core.SetPackageName("GLIB", "glib-2.0")
-	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xTimeValAdd, libs, "g_time_val_add")
-	core.PuregoSafeRegister(&xTimeValToIso8601, libs, "g_time_val_to_iso8601")
-
-}
@@ -0,0 +1,88 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xTimeValAdd func(uintptr, types.Long)
+
+// Adds the given number of microseconds to time_. microseconds can
+// also be negative to decrease the value of time_.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use `guint64` for
+//
+//	representing microseconds since the epoch, or use [DateTime].
+func (x *TimeVal) Add(MicrosecondsVar types.Long) {
+
+	xTimeValAdd(x.GoPointer(), MicrosecondsVar)
+
+}
+
+var xTimeValToIso8601 func(uintptr) string
+
+// Converts time_ into an RFC 3339 encoded string, relative to the
+// Coordinated Universal Time (UTC). This is one of the many formats
+// allowed by ISO 8601.
+//
+// ISO 8601 allows a large number of date/time formats, with or without
+// punctuation and optional elements. The format returned by this function
+// is a complete date and time, with optional punctuation included, the
+// UTC time zone represented as "Z", and the tv_usec part included if
+// and only if it is nonzero, i.e. either
+// "YYYY-MM-DDTHH:MM:SSZ" or "YYYY-MM-DDTHH:MM:SS.fffffZ".
+//
+// This corresponds to the Internet date/time format defined by
+// [RFC 3339],
+// and to either of the two most-precise formats defined by
+// the W3C Note
+// [Date and Time Formats].
+// Both of these documents are profiles of ISO 8601.
+//
+// Use [DateTime.Format] or [StrdupPrintf] if a different
+// variation of ISO 8601 format is required.
+//
+// If time_ represents a date which is too large to fit into a `struct tm`,
+// nil will be returned. This is platform dependent. Note also that since
+// `GTimeVal` stores the number of seconds as a `glong`, on 32-bit systems it
+// is subject to the year 2038 problem. Accordingly, since GLib 2.62, this
+// function has been deprecated. Equivalent functionality is available using:
+//
+//	GDateTime *dt = g_date_time_new_from_unix_utc (time_val);
+//	iso8601_string = g_date_time_format_iso8601 (dt);
+//	g_date_time_unref (dt);
+//
+// The return value of [TimeVal.ToIso8601] has been nullable since GLib
+// 2.54; before then, GLib would crash under the same conditions.
+//
+// Deprecated: since 2.62. [TimeVal] is not year-2038-safe. Use
+//
+//	g_date_time_format_iso8601(dt) instead.
+//
+// [RFC 3339]: https://www.ietf.org/rfc/rfc3339.txt
+// [Date and Time Formats]: http://www.w3.org/TR/NOTE-datetime-19980827
+func (x *TimeVal) ToIso8601() string {
+
+	cret := xTimeValToIso8601(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTimeValAdd, libs, "g_time_val_add", "GLIB", "")
+	core.PuregoRegisterSince(&xTimeValToIso8601, libs, "g_time_val_to_iso8601", "GLIB", "2.12")
+
+}
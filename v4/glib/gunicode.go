@@ -2,7 +2,8 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -33,34 +34,89 @@ const (
 	// standardize differences that do not affect the
 	//     text content, such as the above-mentioned accent representation
 	GNormalizeDefaultValue NormalizeMode = 0
-	// another name for %G_NORMALIZE_DEFAULT
+	// another name for [GNormalizeDefaultValue]
 	GNormalizeNfdValue NormalizeMode = 0
-	// like %G_NORMALIZE_DEFAULT, but with
+	// like [GNormalizeDefaultValue], but with
 	//     composed forms rather than a maximally decomposed form
 	GNormalizeDefaultComposeValue NormalizeMode = 1
-	// another name for %G_NORMALIZE_DEFAULT_COMPOSE
+	// another name for [GNormalizeDefaultComposeValue]
 	GNormalizeNfcValue NormalizeMode = 1
-	// beyond %G_NORMALIZE_DEFAULT also standardize the
+	// beyond [GNormalizeDefaultValue] also standardize the
 	//     "compatibility" characters in Unicode, such as SUPERSCRIPT THREE
 	//     to the standard forms (in this case DIGIT THREE). Formatting
 	//     information may be lost but for most text operations such
 	//     characters should be considered the same
 	GNormalizeAllValue NormalizeMode = 2
-	// another name for %G_NORMALIZE_ALL
+	// another name for [GNormalizeAllValue]
 	GNormalizeNfkdValue NormalizeMode = 2
-	// like %G_NORMALIZE_ALL, but with composed
+	// like [GNormalizeAllValue], but with composed
 	//     forms rather than a maximally decomposed form
 	GNormalizeAllComposeValue NormalizeMode = 3
-	// another name for %G_NORMALIZE_ALL_COMPOSE
+	// another name for [GNormalizeAllComposeValue]
 	GNormalizeNfkcValue NormalizeMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x NormalizeMode) String() string {
+	switch x {
+	case GNormalizeDefaultValue:
+		return "G_NORMALIZE_DEFAULT"
+	case GNormalizeDefaultComposeValue:
+		return "G_NORMALIZE_DEFAULT_COMPOSE"
+	case GNormalizeAllValue:
+		return "G_NORMALIZE_ALL"
+	case GNormalizeAllComposeValue:
+		return "G_NORMALIZE_ALL_COMPOSE"
+	}
+	return fmt.Sprintf("NormalizeMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NormalizeMode) Nick() string {
+	switch x {
+	case GNormalizeDefaultValue:
+		return "default"
+	case GNormalizeDefaultComposeValue:
+		return "default-compose"
+	case GNormalizeAllValue:
+		return "all"
+	case GNormalizeAllComposeValue:
+		return "all-compose"
+	}
+	return ""
+}
+
+// NormalizeModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NormalizeModeFromString(s string) (NormalizeMode, bool) {
+	switch s {
+	case "G_NORMALIZE_DEFAULT", "default":
+		return GNormalizeDefaultValue, true
+	case "G_NORMALIZE_NFD", "nfd":
+		return GNormalizeNfdValue, true
+	case "G_NORMALIZE_DEFAULT_COMPOSE", "default-compose":
+		return GNormalizeDefaultComposeValue, true
+	case "G_NORMALIZE_NFC", "nfc":
+		return GNormalizeNfcValue, true
+	case "G_NORMALIZE_ALL", "all":
+		return GNormalizeAllValue, true
+	case "G_NORMALIZE_NFKD", "nfkd":
+		return GNormalizeNfkdValue, true
+	case "G_NORMALIZE_ALL_COMPOSE", "all-compose":
+		return GNormalizeAllComposeValue, true
+	case "G_NORMALIZE_NFKC", "nfkc":
+		return GNormalizeNfkcValue, true
+	}
+	return 0, false
+}
+
 // These are the possible line break classifications.
 //
 // Since new Unicode versions may add new types here, applications should be ready
-// to handle unknown values. They may be regarded as %G_UNICODE_BREAK_UNKNOWN.
+// to handle unknown values. They may be regarded as [GUnicodeBreakUnknownValue].
+//
+// See [Unicode Line Breaking Algorithm].
 //
-// See [Unicode Line Breaking Algorithm](https://www.unicode.org/reports/tr14/).
+// [Unicode Line Breaking Algorithm]: https://www.unicode.org/reports/tr14/
 type UnicodeBreakType int
 
 var xUnicodeBreakTypeGLibType func() types.GType
@@ -143,7 +199,7 @@ const (
 	GUnicodeBreakHangulLvSyllableValue UnicodeBreakType = 34
 	// Hangul LVT Syllable (H3)
 	GUnicodeBreakHangulLvtSyllableValue UnicodeBreakType = 35
-	// Closing Parenthesis (CP). Since 2.28. Deprecated: 2.70: Use %G_UNICODE_BREAK_CLOSE_PARENTHESIS instead.
+	// Closing Parenthesis (CP). Since 2.28. Deprecated: 2.70: Use [GUnicodeBreakCloseParenthesisValue] instead.
 	GUnicodeBreakCloseParanthesisValue UnicodeBreakType = 36
 	// Closing Parenthesis (CP). Since 2.70
 	GUnicodeBreakCloseParenthesisValue UnicodeBreakType = 36
@@ -171,14 +227,327 @@ const (
 	GUnicodeBreakViramaValue UnicodeBreakType = 47
 )
 
-// The #GUnicodeScript enumeration identifies different writing
+// String returns the C name of the value, e.g. for debugging output
+func (x UnicodeBreakType) String() string {
+	switch x {
+	case GUnicodeBreakMandatoryValue:
+		return "G_UNICODE_BREAK_MANDATORY"
+	case GUnicodeBreakCarriageReturnValue:
+		return "G_UNICODE_BREAK_CARRIAGE_RETURN"
+	case GUnicodeBreakLineFeedValue:
+		return "G_UNICODE_BREAK_LINE_FEED"
+	case GUnicodeBreakCombiningMarkValue:
+		return "G_UNICODE_BREAK_COMBINING_MARK"
+	case GUnicodeBreakSurrogateValue:
+		return "G_UNICODE_BREAK_SURROGATE"
+	case GUnicodeBreakZeroWidthSpaceValue:
+		return "G_UNICODE_BREAK_ZERO_WIDTH_SPACE"
+	case GUnicodeBreakInseparableValue:
+		return "G_UNICODE_BREAK_INSEPARABLE"
+	case GUnicodeBreakNonBreakingGlueValue:
+		return "G_UNICODE_BREAK_NON_BREAKING_GLUE"
+	case GUnicodeBreakContingentValue:
+		return "G_UNICODE_BREAK_CONTINGENT"
+	case GUnicodeBreakSpaceValue:
+		return "G_UNICODE_BREAK_SPACE"
+	case GUnicodeBreakAfterValue:
+		return "G_UNICODE_BREAK_AFTER"
+	case GUnicodeBreakBeforeValue:
+		return "G_UNICODE_BREAK_BEFORE"
+	case GUnicodeBreakBeforeAndAfterValue:
+		return "G_UNICODE_BREAK_BEFORE_AND_AFTER"
+	case GUnicodeBreakHyphenValue:
+		return "G_UNICODE_BREAK_HYPHEN"
+	case GUnicodeBreakNonStarterValue:
+		return "G_UNICODE_BREAK_NON_STARTER"
+	case GUnicodeBreakOpenPunctuationValue:
+		return "G_UNICODE_BREAK_OPEN_PUNCTUATION"
+	case GUnicodeBreakClosePunctuationValue:
+		return "G_UNICODE_BREAK_CLOSE_PUNCTUATION"
+	case GUnicodeBreakQuotationValue:
+		return "G_UNICODE_BREAK_QUOTATION"
+	case GUnicodeBreakExclamationValue:
+		return "G_UNICODE_BREAK_EXCLAMATION"
+	case GUnicodeBreakIdeographicValue:
+		return "G_UNICODE_BREAK_IDEOGRAPHIC"
+	case GUnicodeBreakNumericValue:
+		return "G_UNICODE_BREAK_NUMERIC"
+	case GUnicodeBreakInfixSeparatorValue:
+		return "G_UNICODE_BREAK_INFIX_SEPARATOR"
+	case GUnicodeBreakSymbolValue:
+		return "G_UNICODE_BREAK_SYMBOL"
+	case GUnicodeBreakAlphabeticValue:
+		return "G_UNICODE_BREAK_ALPHABETIC"
+	case GUnicodeBreakPrefixValue:
+		return "G_UNICODE_BREAK_PREFIX"
+	case GUnicodeBreakPostfixValue:
+		return "G_UNICODE_BREAK_POSTFIX"
+	case GUnicodeBreakComplexContextValue:
+		return "G_UNICODE_BREAK_COMPLEX_CONTEXT"
+	case GUnicodeBreakAmbiguousValue:
+		return "G_UNICODE_BREAK_AMBIGUOUS"
+	case GUnicodeBreakUnknownValue:
+		return "G_UNICODE_BREAK_UNKNOWN"
+	case GUnicodeBreakNextLineValue:
+		return "G_UNICODE_BREAK_NEXT_LINE"
+	case GUnicodeBreakWordJoinerValue:
+		return "G_UNICODE_BREAK_WORD_JOINER"
+	case GUnicodeBreakHangulLJamoValue:
+		return "G_UNICODE_BREAK_HANGUL_L_JAMO"
+	case GUnicodeBreakHangulVJamoValue:
+		return "G_UNICODE_BREAK_HANGUL_V_JAMO"
+	case GUnicodeBreakHangulTJamoValue:
+		return "G_UNICODE_BREAK_HANGUL_T_JAMO"
+	case GUnicodeBreakHangulLvSyllableValue:
+		return "G_UNICODE_BREAK_HANGUL_LV_SYLLABLE"
+	case GUnicodeBreakHangulLvtSyllableValue:
+		return "G_UNICODE_BREAK_HANGUL_LVT_SYLLABLE"
+	case GUnicodeBreakCloseParanthesisValue:
+		return "G_UNICODE_BREAK_CLOSE_PARANTHESIS"
+	case GUnicodeBreakConditionalJapaneseStarterValue:
+		return "G_UNICODE_BREAK_CONDITIONAL_JAPANESE_STARTER"
+	case GUnicodeBreakHebrewLetterValue:
+		return "G_UNICODE_BREAK_HEBREW_LETTER"
+	case GUnicodeBreakRegionalIndicatorValue:
+		return "G_UNICODE_BREAK_REGIONAL_INDICATOR"
+	case GUnicodeBreakEmojiBaseValue:
+		return "G_UNICODE_BREAK_EMOJI_BASE"
+	case GUnicodeBreakEmojiModifierValue:
+		return "G_UNICODE_BREAK_EMOJI_MODIFIER"
+	case GUnicodeBreakZeroWidthJoinerValue:
+		return "G_UNICODE_BREAK_ZERO_WIDTH_JOINER"
+	case GUnicodeBreakAksaraValue:
+		return "G_UNICODE_BREAK_AKSARA"
+	case GUnicodeBreakAksaraPreBaseValue:
+		return "G_UNICODE_BREAK_AKSARA_PRE_BASE"
+	case GUnicodeBreakAksaraStartValue:
+		return "G_UNICODE_BREAK_AKSARA_START"
+	case GUnicodeBreakViramaFinalValue:
+		return "G_UNICODE_BREAK_VIRAMA_FINAL"
+	case GUnicodeBreakViramaValue:
+		return "G_UNICODE_BREAK_VIRAMA"
+	}
+	return fmt.Sprintf("UnicodeBreakType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UnicodeBreakType) Nick() string {
+	switch x {
+	case GUnicodeBreakMandatoryValue:
+		return "mandatory"
+	case GUnicodeBreakCarriageReturnValue:
+		return "carriage-return"
+	case GUnicodeBreakLineFeedValue:
+		return "line-feed"
+	case GUnicodeBreakCombiningMarkValue:
+		return "combining-mark"
+	case GUnicodeBreakSurrogateValue:
+		return "surrogate"
+	case GUnicodeBreakZeroWidthSpaceValue:
+		return "zero-width-space"
+	case GUnicodeBreakInseparableValue:
+		return "inseparable"
+	case GUnicodeBreakNonBreakingGlueValue:
+		return "non-breaking-glue"
+	case GUnicodeBreakContingentValue:
+		return "contingent"
+	case GUnicodeBreakSpaceValue:
+		return "space"
+	case GUnicodeBreakAfterValue:
+		return "after"
+	case GUnicodeBreakBeforeValue:
+		return "before"
+	case GUnicodeBreakBeforeAndAfterValue:
+		return "before-and-after"
+	case GUnicodeBreakHyphenValue:
+		return "hyphen"
+	case GUnicodeBreakNonStarterValue:
+		return "non-starter"
+	case GUnicodeBreakOpenPunctuationValue:
+		return "open-punctuation"
+	case GUnicodeBreakClosePunctuationValue:
+		return "close-punctuation"
+	case GUnicodeBreakQuotationValue:
+		return "quotation"
+	case GUnicodeBreakExclamationValue:
+		return "exclamation"
+	case GUnicodeBreakIdeographicValue:
+		return "ideographic"
+	case GUnicodeBreakNumericValue:
+		return "numeric"
+	case GUnicodeBreakInfixSeparatorValue:
+		return "infix-separator"
+	case GUnicodeBreakSymbolValue:
+		return "symbol"
+	case GUnicodeBreakAlphabeticValue:
+		return "alphabetic"
+	case GUnicodeBreakPrefixValue:
+		return "prefix"
+	case GUnicodeBreakPostfixValue:
+		return "postfix"
+	case GUnicodeBreakComplexContextValue:
+		return "complex-context"
+	case GUnicodeBreakAmbiguousValue:
+		return "ambiguous"
+	case GUnicodeBreakUnknownValue:
+		return "unknown"
+	case GUnicodeBreakNextLineValue:
+		return "next-line"
+	case GUnicodeBreakWordJoinerValue:
+		return "word-joiner"
+	case GUnicodeBreakHangulLJamoValue:
+		return "hangul-l-jamo"
+	case GUnicodeBreakHangulVJamoValue:
+		return "hangul-v-jamo"
+	case GUnicodeBreakHangulTJamoValue:
+		return "hangul-t-jamo"
+	case GUnicodeBreakHangulLvSyllableValue:
+		return "hangul-lv-syllable"
+	case GUnicodeBreakHangulLvtSyllableValue:
+		return "hangul-lvt-syllable"
+	case GUnicodeBreakCloseParanthesisValue:
+		return "close-paranthesis"
+	case GUnicodeBreakConditionalJapaneseStarterValue:
+		return "conditional-japanese-starter"
+	case GUnicodeBreakHebrewLetterValue:
+		return "hebrew-letter"
+	case GUnicodeBreakRegionalIndicatorValue:
+		return "regional-indicator"
+	case GUnicodeBreakEmojiBaseValue:
+		return "emoji-base"
+	case GUnicodeBreakEmojiModifierValue:
+		return "emoji-modifier"
+	case GUnicodeBreakZeroWidthJoinerValue:
+		return "zero-width-joiner"
+	case GUnicodeBreakAksaraValue:
+		return "aksara"
+	case GUnicodeBreakAksaraPreBaseValue:
+		return "aksara-pre-base"
+	case GUnicodeBreakAksaraStartValue:
+		return "aksara-start"
+	case GUnicodeBreakViramaFinalValue:
+		return "virama-final"
+	case GUnicodeBreakViramaValue:
+		return "virama"
+	}
+	return ""
+}
+
+// UnicodeBreakTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnicodeBreakTypeFromString(s string) (UnicodeBreakType, bool) {
+	switch s {
+	case "G_UNICODE_BREAK_MANDATORY", "mandatory":
+		return GUnicodeBreakMandatoryValue, true
+	case "G_UNICODE_BREAK_CARRIAGE_RETURN", "carriage-return":
+		return GUnicodeBreakCarriageReturnValue, true
+	case "G_UNICODE_BREAK_LINE_FEED", "line-feed":
+		return GUnicodeBreakLineFeedValue, true
+	case "G_UNICODE_BREAK_COMBINING_MARK", "combining-mark":
+		return GUnicodeBreakCombiningMarkValue, true
+	case "G_UNICODE_BREAK_SURROGATE", "surrogate":
+		return GUnicodeBreakSurrogateValue, true
+	case "G_UNICODE_BREAK_ZERO_WIDTH_SPACE", "zero-width-space":
+		return GUnicodeBreakZeroWidthSpaceValue, true
+	case "G_UNICODE_BREAK_INSEPARABLE", "inseparable":
+		return GUnicodeBreakInseparableValue, true
+	case "G_UNICODE_BREAK_NON_BREAKING_GLUE", "non-breaking-glue":
+		return GUnicodeBreakNonBreakingGlueValue, true
+	case "G_UNICODE_BREAK_CONTINGENT", "contingent":
+		return GUnicodeBreakContingentValue, true
+	case "G_UNICODE_BREAK_SPACE", "space":
+		return GUnicodeBreakSpaceValue, true
+	case "G_UNICODE_BREAK_AFTER", "after":
+		return GUnicodeBreakAfterValue, true
+	case "G_UNICODE_BREAK_BEFORE", "before":
+		return GUnicodeBreakBeforeValue, true
+	case "G_UNICODE_BREAK_BEFORE_AND_AFTER", "before-and-after":
+		return GUnicodeBreakBeforeAndAfterValue, true
+	case "G_UNICODE_BREAK_HYPHEN", "hyphen":
+		return GUnicodeBreakHyphenValue, true
+	case "G_UNICODE_BREAK_NON_STARTER", "non-starter":
+		return GUnicodeBreakNonStarterValue, true
+	case "G_UNICODE_BREAK_OPEN_PUNCTUATION", "open-punctuation":
+		return GUnicodeBreakOpenPunctuationValue, true
+	case "G_UNICODE_BREAK_CLOSE_PUNCTUATION", "close-punctuation":
+		return GUnicodeBreakClosePunctuationValue, true
+	case "G_UNICODE_BREAK_QUOTATION", "quotation":
+		return GUnicodeBreakQuotationValue, true
+	case "G_UNICODE_BREAK_EXCLAMATION", "exclamation":
+		return GUnicodeBreakExclamationValue, true
+	case "G_UNICODE_BREAK_IDEOGRAPHIC", "ideographic":
+		return GUnicodeBreakIdeographicValue, true
+	case "G_UNICODE_BREAK_NUMERIC", "numeric":
+		return GUnicodeBreakNumericValue, true
+	case "G_UNICODE_BREAK_INFIX_SEPARATOR", "infix-separator":
+		return GUnicodeBreakInfixSeparatorValue, true
+	case "G_UNICODE_BREAK_SYMBOL", "symbol":
+		return GUnicodeBreakSymbolValue, true
+	case "G_UNICODE_BREAK_ALPHABETIC", "alphabetic":
+		return GUnicodeBreakAlphabeticValue, true
+	case "G_UNICODE_BREAK_PREFIX", "prefix":
+		return GUnicodeBreakPrefixValue, true
+	case "G_UNICODE_BREAK_POSTFIX", "postfix":
+		return GUnicodeBreakPostfixValue, true
+	case "G_UNICODE_BREAK_COMPLEX_CONTEXT", "complex-context":
+		return GUnicodeBreakComplexContextValue, true
+	case "G_UNICODE_BREAK_AMBIGUOUS", "ambiguous":
+		return GUnicodeBreakAmbiguousValue, true
+	case "G_UNICODE_BREAK_UNKNOWN", "unknown":
+		return GUnicodeBreakUnknownValue, true
+	case "G_UNICODE_BREAK_NEXT_LINE", "next-line":
+		return GUnicodeBreakNextLineValue, true
+	case "G_UNICODE_BREAK_WORD_JOINER", "word-joiner":
+		return GUnicodeBreakWordJoinerValue, true
+	case "G_UNICODE_BREAK_HANGUL_L_JAMO", "hangul-l-jamo":
+		return GUnicodeBreakHangulLJamoValue, true
+	case "G_UNICODE_BREAK_HANGUL_V_JAMO", "hangul-v-jamo":
+		return GUnicodeBreakHangulVJamoValue, true
+	case "G_UNICODE_BREAK_HANGUL_T_JAMO", "hangul-t-jamo":
+		return GUnicodeBreakHangulTJamoValue, true
+	case "G_UNICODE_BREAK_HANGUL_LV_SYLLABLE", "hangul-lv-syllable":
+		return GUnicodeBreakHangulLvSyllableValue, true
+	case "G_UNICODE_BREAK_HANGUL_LVT_SYLLABLE", "hangul-lvt-syllable":
+		return GUnicodeBreakHangulLvtSyllableValue, true
+	case "G_UNICODE_BREAK_CLOSE_PARANTHESIS", "close-paranthesis":
+		return GUnicodeBreakCloseParanthesisValue, true
+	case "G_UNICODE_BREAK_CLOSE_PARENTHESIS", "close-parenthesis":
+		return GUnicodeBreakCloseParenthesisValue, true
+	case "G_UNICODE_BREAK_CONDITIONAL_JAPANESE_STARTER", "conditional-japanese-starter":
+		return GUnicodeBreakConditionalJapaneseStarterValue, true
+	case "G_UNICODE_BREAK_HEBREW_LETTER", "hebrew-letter":
+		return GUnicodeBreakHebrewLetterValue, true
+	case "G_UNICODE_BREAK_REGIONAL_INDICATOR", "regional-indicator":
+		return GUnicodeBreakRegionalIndicatorValue, true
+	case "G_UNICODE_BREAK_EMOJI_BASE", "emoji-base":
+		return GUnicodeBreakEmojiBaseValue, true
+	case "G_UNICODE_BREAK_EMOJI_MODIFIER", "emoji-modifier":
+		return GUnicodeBreakEmojiModifierValue, true
+	case "G_UNICODE_BREAK_ZERO_WIDTH_JOINER", "zero-width-joiner":
+		return GUnicodeBreakZeroWidthJoinerValue, true
+	case "G_UNICODE_BREAK_AKSARA", "aksara":
+		return GUnicodeBreakAksaraValue, true
+	case "G_UNICODE_BREAK_AKSARA_PRE_BASE", "aksara-pre-base":
+		return GUnicodeBreakAksaraPreBaseValue, true
+	case "G_UNICODE_BREAK_AKSARA_START", "aksara-start":
+		return GUnicodeBreakAksaraStartValue, true
+	case "G_UNICODE_BREAK_VIRAMA_FINAL", "virama-final":
+		return GUnicodeBreakViramaFinalValue, true
+	case "G_UNICODE_BREAK_VIRAMA", "virama":
+		return GUnicodeBreakViramaValue, true
+	}
+	return 0, false
+}
+
+// The [UnicodeScript] enumeration identifies different writing
 // systems. The values correspond to the names as defined in the
 // Unicode standard. The enumeration has been added in GLib 2.14,
-// and is interchangeable with #PangoScript.
+// and is interchangeable with [pango.Script].
 //
 // Note that new types may be added in the future. Applications
 // should be ready to handle unknown values.
-// See [Unicode Standard Annex #24: Script names](http://www.unicode.org/reports/tr24/).
+// See [Unicode Standard Annex #24: Script names].
+//
+// [Unicode Standard Annex #24: Script names]: http://www.unicode.org/reports/tr24/
 type UnicodeScript int
 
 var xUnicodeScriptGLibType func() types.GType
@@ -189,7 +558,7 @@ func UnicodeScriptGLibType() types.GType {
 
 const (
 
-	// a value never returned from g_unichar_get_script()
+	// a value never returned from [UnicharGetScript]
 	GUnicodeScriptInvalidCodeValue UnicodeScript = -1
 	// a character used by multiple different scripts
 	GUnicodeScriptCommonValue UnicodeScript = 0
@@ -538,9 +907,1070 @@ const (
 	GUnicodeScriptOlOnalValue UnicodeScript = 171
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x UnicodeScript) String() string {
+	switch x {
+	case GUnicodeScriptInvalidCodeValue:
+		return "G_UNICODE_SCRIPT_INVALID_CODE"
+	case GUnicodeScriptCommonValue:
+		return "G_UNICODE_SCRIPT_COMMON"
+	case GUnicodeScriptInheritedValue:
+		return "G_UNICODE_SCRIPT_INHERITED"
+	case GUnicodeScriptArabicValue:
+		return "G_UNICODE_SCRIPT_ARABIC"
+	case GUnicodeScriptArmenianValue:
+		return "G_UNICODE_SCRIPT_ARMENIAN"
+	case GUnicodeScriptBengaliValue:
+		return "G_UNICODE_SCRIPT_BENGALI"
+	case GUnicodeScriptBopomofoValue:
+		return "G_UNICODE_SCRIPT_BOPOMOFO"
+	case GUnicodeScriptCherokeeValue:
+		return "G_UNICODE_SCRIPT_CHEROKEE"
+	case GUnicodeScriptCopticValue:
+		return "G_UNICODE_SCRIPT_COPTIC"
+	case GUnicodeScriptCyrillicValue:
+		return "G_UNICODE_SCRIPT_CYRILLIC"
+	case GUnicodeScriptDeseretValue:
+		return "G_UNICODE_SCRIPT_DESERET"
+	case GUnicodeScriptDevanagariValue:
+		return "G_UNICODE_SCRIPT_DEVANAGARI"
+	case GUnicodeScriptEthiopicValue:
+		return "G_UNICODE_SCRIPT_ETHIOPIC"
+	case GUnicodeScriptGeorgianValue:
+		return "G_UNICODE_SCRIPT_GEORGIAN"
+	case GUnicodeScriptGothicValue:
+		return "G_UNICODE_SCRIPT_GOTHIC"
+	case GUnicodeScriptGreekValue:
+		return "G_UNICODE_SCRIPT_GREEK"
+	case GUnicodeScriptGujaratiValue:
+		return "G_UNICODE_SCRIPT_GUJARATI"
+	case GUnicodeScriptGurmukhiValue:
+		return "G_UNICODE_SCRIPT_GURMUKHI"
+	case GUnicodeScriptHanValue:
+		return "G_UNICODE_SCRIPT_HAN"
+	case GUnicodeScriptHangulValue:
+		return "G_UNICODE_SCRIPT_HANGUL"
+	case GUnicodeScriptHebrewValue:
+		return "G_UNICODE_SCRIPT_HEBREW"
+	case GUnicodeScriptHiraganaValue:
+		return "G_UNICODE_SCRIPT_HIRAGANA"
+	case GUnicodeScriptKannadaValue:
+		return "G_UNICODE_SCRIPT_KANNADA"
+	case GUnicodeScriptKatakanaValue:
+		return "G_UNICODE_SCRIPT_KATAKANA"
+	case GUnicodeScriptKhmerValue:
+		return "G_UNICODE_SCRIPT_KHMER"
+	case GUnicodeScriptLaoValue:
+		return "G_UNICODE_SCRIPT_LAO"
+	case GUnicodeScriptLatinValue:
+		return "G_UNICODE_SCRIPT_LATIN"
+	case GUnicodeScriptMalayalamValue:
+		return "G_UNICODE_SCRIPT_MALAYALAM"
+	case GUnicodeScriptMongolianValue:
+		return "G_UNICODE_SCRIPT_MONGOLIAN"
+	case GUnicodeScriptMyanmarValue:
+		return "G_UNICODE_SCRIPT_MYANMAR"
+	case GUnicodeScriptOghamValue:
+		return "G_UNICODE_SCRIPT_OGHAM"
+	case GUnicodeScriptOldItalicValue:
+		return "G_UNICODE_SCRIPT_OLD_ITALIC"
+	case GUnicodeScriptOriyaValue:
+		return "G_UNICODE_SCRIPT_ORIYA"
+	case GUnicodeScriptRunicValue:
+		return "G_UNICODE_SCRIPT_RUNIC"
+	case GUnicodeScriptSinhalaValue:
+		return "G_UNICODE_SCRIPT_SINHALA"
+	case GUnicodeScriptSyriacValue:
+		return "G_UNICODE_SCRIPT_SYRIAC"
+	case GUnicodeScriptTamilValue:
+		return "G_UNICODE_SCRIPT_TAMIL"
+	case GUnicodeScriptTeluguValue:
+		return "G_UNICODE_SCRIPT_TELUGU"
+	case GUnicodeScriptThaanaValue:
+		return "G_UNICODE_SCRIPT_THAANA"
+	case GUnicodeScriptThaiValue:
+		return "G_UNICODE_SCRIPT_THAI"
+	case GUnicodeScriptTibetanValue:
+		return "G_UNICODE_SCRIPT_TIBETAN"
+	case GUnicodeScriptCanadianAboriginalValue:
+		return "G_UNICODE_SCRIPT_CANADIAN_ABORIGINAL"
+	case GUnicodeScriptYiValue:
+		return "G_UNICODE_SCRIPT_YI"
+	case GUnicodeScriptTagalogValue:
+		return "G_UNICODE_SCRIPT_TAGALOG"
+	case GUnicodeScriptHanunooValue:
+		return "G_UNICODE_SCRIPT_HANUNOO"
+	case GUnicodeScriptBuhidValue:
+		return "G_UNICODE_SCRIPT_BUHID"
+	case GUnicodeScriptTagbanwaValue:
+		return "G_UNICODE_SCRIPT_TAGBANWA"
+	case GUnicodeScriptBrailleValue:
+		return "G_UNICODE_SCRIPT_BRAILLE"
+	case GUnicodeScriptCypriotValue:
+		return "G_UNICODE_SCRIPT_CYPRIOT"
+	case GUnicodeScriptLimbuValue:
+		return "G_UNICODE_SCRIPT_LIMBU"
+	case GUnicodeScriptOsmanyaValue:
+		return "G_UNICODE_SCRIPT_OSMANYA"
+	case GUnicodeScriptShavianValue:
+		return "G_UNICODE_SCRIPT_SHAVIAN"
+	case GUnicodeScriptLinearBValue:
+		return "G_UNICODE_SCRIPT_LINEAR_B"
+	case GUnicodeScriptTaiLeValue:
+		return "G_UNICODE_SCRIPT_TAI_LE"
+	case GUnicodeScriptUgariticValue:
+		return "G_UNICODE_SCRIPT_UGARITIC"
+	case GUnicodeScriptNewTaiLueValue:
+		return "G_UNICODE_SCRIPT_NEW_TAI_LUE"
+	case GUnicodeScriptBugineseValue:
+		return "G_UNICODE_SCRIPT_BUGINESE"
+	case GUnicodeScriptGlagoliticValue:
+		return "G_UNICODE_SCRIPT_GLAGOLITIC"
+	case GUnicodeScriptTifinaghValue:
+		return "G_UNICODE_SCRIPT_TIFINAGH"
+	case GUnicodeScriptSylotiNagriValue:
+		return "G_UNICODE_SCRIPT_SYLOTI_NAGRI"
+	case GUnicodeScriptOldPersianValue:
+		return "G_UNICODE_SCRIPT_OLD_PERSIAN"
+	case GUnicodeScriptKharoshthiValue:
+		return "G_UNICODE_SCRIPT_KHAROSHTHI"
+	case GUnicodeScriptUnknownValue:
+		return "G_UNICODE_SCRIPT_UNKNOWN"
+	case GUnicodeScriptBalineseValue:
+		return "G_UNICODE_SCRIPT_BALINESE"
+	case GUnicodeScriptCuneiformValue:
+		return "G_UNICODE_SCRIPT_CUNEIFORM"
+	case GUnicodeScriptPhoenicianValue:
+		return "G_UNICODE_SCRIPT_PHOENICIAN"
+	case GUnicodeScriptPhagsPaValue:
+		return "G_UNICODE_SCRIPT_PHAGS_PA"
+	case GUnicodeScriptNkoValue:
+		return "G_UNICODE_SCRIPT_NKO"
+	case GUnicodeScriptKayahLiValue:
+		return "G_UNICODE_SCRIPT_KAYAH_LI"
+	case GUnicodeScriptLepchaValue:
+		return "G_UNICODE_SCRIPT_LEPCHA"
+	case GUnicodeScriptRejangValue:
+		return "G_UNICODE_SCRIPT_REJANG"
+	case GUnicodeScriptSundaneseValue:
+		return "G_UNICODE_SCRIPT_SUNDANESE"
+	case GUnicodeScriptSaurashtraValue:
+		return "G_UNICODE_SCRIPT_SAURASHTRA"
+	case GUnicodeScriptChamValue:
+		return "G_UNICODE_SCRIPT_CHAM"
+	case GUnicodeScriptOlChikiValue:
+		return "G_UNICODE_SCRIPT_OL_CHIKI"
+	case GUnicodeScriptVaiValue:
+		return "G_UNICODE_SCRIPT_VAI"
+	case GUnicodeScriptCarianValue:
+		return "G_UNICODE_SCRIPT_CARIAN"
+	case GUnicodeScriptLycianValue:
+		return "G_UNICODE_SCRIPT_LYCIAN"
+	case GUnicodeScriptLydianValue:
+		return "G_UNICODE_SCRIPT_LYDIAN"
+	case GUnicodeScriptAvestanValue:
+		return "G_UNICODE_SCRIPT_AVESTAN"
+	case GUnicodeScriptBamumValue:
+		return "G_UNICODE_SCRIPT_BAMUM"
+	case GUnicodeScriptEgyptianHieroglyphsValue:
+		return "G_UNICODE_SCRIPT_EGYPTIAN_HIEROGLYPHS"
+	case GUnicodeScriptImperialAramaicValue:
+		return "G_UNICODE_SCRIPT_IMPERIAL_ARAMAIC"
+	case GUnicodeScriptInscriptionalPahlaviValue:
+		return "G_UNICODE_SCRIPT_INSCRIPTIONAL_PAHLAVI"
+	case GUnicodeScriptInscriptionalParthianValue:
+		return "G_UNICODE_SCRIPT_INSCRIPTIONAL_PARTHIAN"
+	case GUnicodeScriptJavaneseValue:
+		return "G_UNICODE_SCRIPT_JAVANESE"
+	case GUnicodeScriptKaithiValue:
+		return "G_UNICODE_SCRIPT_KAITHI"
+	case GUnicodeScriptLisuValue:
+		return "G_UNICODE_SCRIPT_LISU"
+	case GUnicodeScriptMeeteiMayekValue:
+		return "G_UNICODE_SCRIPT_MEETEI_MAYEK"
+	case GUnicodeScriptOldSouthArabianValue:
+		return "G_UNICODE_SCRIPT_OLD_SOUTH_ARABIAN"
+	case GUnicodeScriptOldTurkicValue:
+		return "G_UNICODE_SCRIPT_OLD_TURKIC"
+	case GUnicodeScriptSamaritanValue:
+		return "G_UNICODE_SCRIPT_SAMARITAN"
+	case GUnicodeScriptTaiThamValue:
+		return "G_UNICODE_SCRIPT_TAI_THAM"
+	case GUnicodeScriptTaiVietValue:
+		return "G_UNICODE_SCRIPT_TAI_VIET"
+	case GUnicodeScriptBatakValue:
+		return "G_UNICODE_SCRIPT_BATAK"
+	case GUnicodeScriptBrahmiValue:
+		return "G_UNICODE_SCRIPT_BRAHMI"
+	case GUnicodeScriptMandaicValue:
+		return "G_UNICODE_SCRIPT_MANDAIC"
+	case GUnicodeScriptChakmaValue:
+		return "G_UNICODE_SCRIPT_CHAKMA"
+	case GUnicodeScriptMeroiticCursiveValue:
+		return "G_UNICODE_SCRIPT_MEROITIC_CURSIVE"
+	case GUnicodeScriptMeroiticHieroglyphsValue:
+		return "G_UNICODE_SCRIPT_MEROITIC_HIEROGLYPHS"
+	case GUnicodeScriptMiaoValue:
+		return "G_UNICODE_SCRIPT_MIAO"
+	case GUnicodeScriptSharadaValue:
+		return "G_UNICODE_SCRIPT_SHARADA"
+	case GUnicodeScriptSoraSompengValue:
+		return "G_UNICODE_SCRIPT_SORA_SOMPENG"
+	case GUnicodeScriptTakriValue:
+		return "G_UNICODE_SCRIPT_TAKRI"
+	case GUnicodeScriptBassaVahValue:
+		return "G_UNICODE_SCRIPT_BASSA_VAH"
+	case GUnicodeScriptCaucasianAlbanianValue:
+		return "G_UNICODE_SCRIPT_CAUCASIAN_ALBANIAN"
+	case GUnicodeScriptDuployanValue:
+		return "G_UNICODE_SCRIPT_DUPLOYAN"
+	case GUnicodeScriptElbasanValue:
+		return "G_UNICODE_SCRIPT_ELBASAN"
+	case GUnicodeScriptGranthaValue:
+		return "G_UNICODE_SCRIPT_GRANTHA"
+	case GUnicodeScriptKhojkiValue:
+		return "G_UNICODE_SCRIPT_KHOJKI"
+	case GUnicodeScriptKhudawadiValue:
+		return "G_UNICODE_SCRIPT_KHUDAWADI"
+	case GUnicodeScriptLinearAValue:
+		return "G_UNICODE_SCRIPT_LINEAR_A"
+	case GUnicodeScriptMahajaniValue:
+		return "G_UNICODE_SCRIPT_MAHAJANI"
+	case GUnicodeScriptManichaeanValue:
+		return "G_UNICODE_SCRIPT_MANICHAEAN"
+	case GUnicodeScriptMendeKikakuiValue:
+		return "G_UNICODE_SCRIPT_MENDE_KIKAKUI"
+	case GUnicodeScriptModiValue:
+		return "G_UNICODE_SCRIPT_MODI"
+	case GUnicodeScriptMroValue:
+		return "G_UNICODE_SCRIPT_MRO"
+	case GUnicodeScriptNabataeanValue:
+		return "G_UNICODE_SCRIPT_NABATAEAN"
+	case GUnicodeScriptOldNorthArabianValue:
+		return "G_UNICODE_SCRIPT_OLD_NORTH_ARABIAN"
+	case GUnicodeScriptOldPermicValue:
+		return "G_UNICODE_SCRIPT_OLD_PERMIC"
+	case GUnicodeScriptPahawhHmongValue:
+		return "G_UNICODE_SCRIPT_PAHAWH_HMONG"
+	case GUnicodeScriptPalmyreneValue:
+		return "G_UNICODE_SCRIPT_PALMYRENE"
+	case GUnicodeScriptPauCinHauValue:
+		return "G_UNICODE_SCRIPT_PAU_CIN_HAU"
+	case GUnicodeScriptPsalterPahlaviValue:
+		return "G_UNICODE_SCRIPT_PSALTER_PAHLAVI"
+	case GUnicodeScriptSiddhamValue:
+		return "G_UNICODE_SCRIPT_SIDDHAM"
+	case GUnicodeScriptTirhutaValue:
+		return "G_UNICODE_SCRIPT_TIRHUTA"
+	case GUnicodeScriptWarangCitiValue:
+		return "G_UNICODE_SCRIPT_WARANG_CITI"
+	case GUnicodeScriptAhomValue:
+		return "G_UNICODE_SCRIPT_AHOM"
+	case GUnicodeScriptAnatolianHieroglyphsValue:
+		return "G_UNICODE_SCRIPT_ANATOLIAN_HIEROGLYPHS"
+	case GUnicodeScriptHatranValue:
+		return "G_UNICODE_SCRIPT_HATRAN"
+	case GUnicodeScriptMultaniValue:
+		return "G_UNICODE_SCRIPT_MULTANI"
+	case GUnicodeScriptOldHungarianValue:
+		return "G_UNICODE_SCRIPT_OLD_HUNGARIAN"
+	case GUnicodeScriptSignwritingValue:
+		return "G_UNICODE_SCRIPT_SIGNWRITING"
+	case GUnicodeScriptAdlamValue:
+		return "G_UNICODE_SCRIPT_ADLAM"
+	case GUnicodeScriptBhaiksukiValue:
+		return "G_UNICODE_SCRIPT_BHAIKSUKI"
+	case GUnicodeScriptMarchenValue:
+		return "G_UNICODE_SCRIPT_MARCHEN"
+	case GUnicodeScriptNewaValue:
+		return "G_UNICODE_SCRIPT_NEWA"
+	case GUnicodeScriptOsageValue:
+		return "G_UNICODE_SCRIPT_OSAGE"
+	case GUnicodeScriptTangutValue:
+		return "G_UNICODE_SCRIPT_TANGUT"
+	case GUnicodeScriptMasaramGondiValue:
+		return "G_UNICODE_SCRIPT_MASARAM_GONDI"
+	case GUnicodeScriptNushuValue:
+		return "G_UNICODE_SCRIPT_NUSHU"
+	case GUnicodeScriptSoyomboValue:
+		return "G_UNICODE_SCRIPT_SOYOMBO"
+	case GUnicodeScriptZanabazarSquareValue:
+		return "G_UNICODE_SCRIPT_ZANABAZAR_SQUARE"
+	case GUnicodeScriptDograValue:
+		return "G_UNICODE_SCRIPT_DOGRA"
+	case GUnicodeScriptGunjalaGondiValue:
+		return "G_UNICODE_SCRIPT_GUNJALA_GONDI"
+	case GUnicodeScriptHanifiRohingyaValue:
+		return "G_UNICODE_SCRIPT_HANIFI_ROHINGYA"
+	case GUnicodeScriptMakasarValue:
+		return "G_UNICODE_SCRIPT_MAKASAR"
+	case GUnicodeScriptMedefaidrinValue:
+		return "G_UNICODE_SCRIPT_MEDEFAIDRIN"
+	case GUnicodeScriptOldSogdianValue:
+		return "G_UNICODE_SCRIPT_OLD_SOGDIAN"
+	case GUnicodeScriptSogdianValue:
+		return "G_UNICODE_SCRIPT_SOGDIAN"
+	case GUnicodeScriptElymaicValue:
+		return "G_UNICODE_SCRIPT_ELYMAIC"
+	case GUnicodeScriptNandinagariValue:
+		return "G_UNICODE_SCRIPT_NANDINAGARI"
+	case GUnicodeScriptNyiakengPuachueHmongValue:
+		return "G_UNICODE_SCRIPT_NYIAKENG_PUACHUE_HMONG"
+	case GUnicodeScriptWanchoValue:
+		return "G_UNICODE_SCRIPT_WANCHO"
+	case GUnicodeScriptChorasmianValue:
+		return "G_UNICODE_SCRIPT_CHORASMIAN"
+	case GUnicodeScriptDivesAkuruValue:
+		return "G_UNICODE_SCRIPT_DIVES_AKURU"
+	case GUnicodeScriptKhitanSmallScriptValue:
+		return "G_UNICODE_SCRIPT_KHITAN_SMALL_SCRIPT"
+	case GUnicodeScriptYezidiValue:
+		return "G_UNICODE_SCRIPT_YEZIDI"
+	case GUnicodeScriptCyproMinoanValue:
+		return "G_UNICODE_SCRIPT_CYPRO_MINOAN"
+	case GUnicodeScriptOldUyghurValue:
+		return "G_UNICODE_SCRIPT_OLD_UYGHUR"
+	case GUnicodeScriptTangsaValue:
+		return "G_UNICODE_SCRIPT_TANGSA"
+	case GUnicodeScriptTotoValue:
+		return "G_UNICODE_SCRIPT_TOTO"
+	case GUnicodeScriptVithkuqiValue:
+		return "G_UNICODE_SCRIPT_VITHKUQI"
+	case GUnicodeScriptMathValue:
+		return "G_UNICODE_SCRIPT_MATH"
+	case GUnicodeScriptKawiValue:
+		return "G_UNICODE_SCRIPT_KAWI"
+	case GUnicodeScriptNagMundariValue:
+		return "G_UNICODE_SCRIPT_NAG_MUNDARI"
+	case GUnicodeScriptTodhriValue:
+		return "G_UNICODE_SCRIPT_TODHRI"
+	case GUnicodeScriptGarayValue:
+		return "G_UNICODE_SCRIPT_GARAY"
+	case GUnicodeScriptTuluTigalariValue:
+		return "G_UNICODE_SCRIPT_TULU_TIGALARI"
+	case GUnicodeScriptSunuwarValue:
+		return "G_UNICODE_SCRIPT_SUNUWAR"
+	case GUnicodeScriptGurungKhemaValue:
+		return "G_UNICODE_SCRIPT_GURUNG_KHEMA"
+	case GUnicodeScriptKiratRaiValue:
+		return "G_UNICODE_SCRIPT_KIRAT_RAI"
+	case GUnicodeScriptOlOnalValue:
+		return "G_UNICODE_SCRIPT_OL_ONAL"
+	}
+	return fmt.Sprintf("UnicodeScript(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UnicodeScript) Nick() string {
+	switch x {
+	case GUnicodeScriptInvalidCodeValue:
+		return "invalid-code"
+	case GUnicodeScriptCommonValue:
+		return "common"
+	case GUnicodeScriptInheritedValue:
+		return "inherited"
+	case GUnicodeScriptArabicValue:
+		return "arabic"
+	case GUnicodeScriptArmenianValue:
+		return "armenian"
+	case GUnicodeScriptBengaliValue:
+		return "bengali"
+	case GUnicodeScriptBopomofoValue:
+		return "bopomofo"
+	case GUnicodeScriptCherokeeValue:
+		return "cherokee"
+	case GUnicodeScriptCopticValue:
+		return "coptic"
+	case GUnicodeScriptCyrillicValue:
+		return "cyrillic"
+	case GUnicodeScriptDeseretValue:
+		return "deseret"
+	case GUnicodeScriptDevanagariValue:
+		return "devanagari"
+	case GUnicodeScriptEthiopicValue:
+		return "ethiopic"
+	case GUnicodeScriptGeorgianValue:
+		return "georgian"
+	case GUnicodeScriptGothicValue:
+		return "gothic"
+	case GUnicodeScriptGreekValue:
+		return "greek"
+	case GUnicodeScriptGujaratiValue:
+		return "gujarati"
+	case GUnicodeScriptGurmukhiValue:
+		return "gurmukhi"
+	case GUnicodeScriptHanValue:
+		return "han"
+	case GUnicodeScriptHangulValue:
+		return "hangul"
+	case GUnicodeScriptHebrewValue:
+		return "hebrew"
+	case GUnicodeScriptHiraganaValue:
+		return "hiragana"
+	case GUnicodeScriptKannadaValue:
+		return "kannada"
+	case GUnicodeScriptKatakanaValue:
+		return "katakana"
+	case GUnicodeScriptKhmerValue:
+		return "khmer"
+	case GUnicodeScriptLaoValue:
+		return "lao"
+	case GUnicodeScriptLatinValue:
+		return "latin"
+	case GUnicodeScriptMalayalamValue:
+		return "malayalam"
+	case GUnicodeScriptMongolianValue:
+		return "mongolian"
+	case GUnicodeScriptMyanmarValue:
+		return "myanmar"
+	case GUnicodeScriptOghamValue:
+		return "ogham"
+	case GUnicodeScriptOldItalicValue:
+		return "old-italic"
+	case GUnicodeScriptOriyaValue:
+		return "oriya"
+	case GUnicodeScriptRunicValue:
+		return "runic"
+	case GUnicodeScriptSinhalaValue:
+		return "sinhala"
+	case GUnicodeScriptSyriacValue:
+		return "syriac"
+	case GUnicodeScriptTamilValue:
+		return "tamil"
+	case GUnicodeScriptTeluguValue:
+		return "telugu"
+	case GUnicodeScriptThaanaValue:
+		return "thaana"
+	case GUnicodeScriptThaiValue:
+		return "thai"
+	case GUnicodeScriptTibetanValue:
+		return "tibetan"
+	case GUnicodeScriptCanadianAboriginalValue:
+		return "canadian-aboriginal"
+	case GUnicodeScriptYiValue:
+		return "yi"
+	case GUnicodeScriptTagalogValue:
+		return "tagalog"
+	case GUnicodeScriptHanunooValue:
+		return "hanunoo"
+	case GUnicodeScriptBuhidValue:
+		return "buhid"
+	case GUnicodeScriptTagbanwaValue:
+		return "tagbanwa"
+	case GUnicodeScriptBrailleValue:
+		return "braille"
+	case GUnicodeScriptCypriotValue:
+		return "cypriot"
+	case GUnicodeScriptLimbuValue:
+		return "limbu"
+	case GUnicodeScriptOsmanyaValue:
+		return "osmanya"
+	case GUnicodeScriptShavianValue:
+		return "shavian"
+	case GUnicodeScriptLinearBValue:
+		return "linear-b"
+	case GUnicodeScriptTaiLeValue:
+		return "tai-le"
+	case GUnicodeScriptUgariticValue:
+		return "ugaritic"
+	case GUnicodeScriptNewTaiLueValue:
+		return "new-tai-lue"
+	case GUnicodeScriptBugineseValue:
+		return "buginese"
+	case GUnicodeScriptGlagoliticValue:
+		return "glagolitic"
+	case GUnicodeScriptTifinaghValue:
+		return "tifinagh"
+	case GUnicodeScriptSylotiNagriValue:
+		return "syloti-nagri"
+	case GUnicodeScriptOldPersianValue:
+		return "old-persian"
+	case GUnicodeScriptKharoshthiValue:
+		return "kharoshthi"
+	case GUnicodeScriptUnknownValue:
+		return "unknown"
+	case GUnicodeScriptBalineseValue:
+		return "balinese"
+	case GUnicodeScriptCuneiformValue:
+		return "cuneiform"
+	case GUnicodeScriptPhoenicianValue:
+		return "phoenician"
+	case GUnicodeScriptPhagsPaValue:
+		return "phags-pa"
+	case GUnicodeScriptNkoValue:
+		return "nko"
+	case GUnicodeScriptKayahLiValue:
+		return "kayah-li"
+	case GUnicodeScriptLepchaValue:
+		return "lepcha"
+	case GUnicodeScriptRejangValue:
+		return "rejang"
+	case GUnicodeScriptSundaneseValue:
+		return "sundanese"
+	case GUnicodeScriptSaurashtraValue:
+		return "saurashtra"
+	case GUnicodeScriptChamValue:
+		return "cham"
+	case GUnicodeScriptOlChikiValue:
+		return "ol-chiki"
+	case GUnicodeScriptVaiValue:
+		return "vai"
+	case GUnicodeScriptCarianValue:
+		return "carian"
+	case GUnicodeScriptLycianValue:
+		return "lycian"
+	case GUnicodeScriptLydianValue:
+		return "lydian"
+	case GUnicodeScriptAvestanValue:
+		return "avestan"
+	case GUnicodeScriptBamumValue:
+		return "bamum"
+	case GUnicodeScriptEgyptianHieroglyphsValue:
+		return "egyptian-hieroglyphs"
+	case GUnicodeScriptImperialAramaicValue:
+		return "imperial-aramaic"
+	case GUnicodeScriptInscriptionalPahlaviValue:
+		return "inscriptional-pahlavi"
+	case GUnicodeScriptInscriptionalParthianValue:
+		return "inscriptional-parthian"
+	case GUnicodeScriptJavaneseValue:
+		return "javanese"
+	case GUnicodeScriptKaithiValue:
+		return "kaithi"
+	case GUnicodeScriptLisuValue:
+		return "lisu"
+	case GUnicodeScriptMeeteiMayekValue:
+		return "meetei-mayek"
+	case GUnicodeScriptOldSouthArabianValue:
+		return "old-south-arabian"
+	case GUnicodeScriptOldTurkicValue:
+		return "old-turkic"
+	case GUnicodeScriptSamaritanValue:
+		return "samaritan"
+	case GUnicodeScriptTaiThamValue:
+		return "tai-tham"
+	case GUnicodeScriptTaiVietValue:
+		return "tai-viet"
+	case GUnicodeScriptBatakValue:
+		return "batak"
+	case GUnicodeScriptBrahmiValue:
+		return "brahmi"
+	case GUnicodeScriptMandaicValue:
+		return "mandaic"
+	case GUnicodeScriptChakmaValue:
+		return "chakma"
+	case GUnicodeScriptMeroiticCursiveValue:
+		return "meroitic-cursive"
+	case GUnicodeScriptMeroiticHieroglyphsValue:
+		return "meroitic-hieroglyphs"
+	case GUnicodeScriptMiaoValue:
+		return "miao"
+	case GUnicodeScriptSharadaValue:
+		return "sharada"
+	case GUnicodeScriptSoraSompengValue:
+		return "sora-sompeng"
+	case GUnicodeScriptTakriValue:
+		return "takri"
+	case GUnicodeScriptBassaVahValue:
+		return "bassa-vah"
+	case GUnicodeScriptCaucasianAlbanianValue:
+		return "caucasian-albanian"
+	case GUnicodeScriptDuployanValue:
+		return "duployan"
+	case GUnicodeScriptElbasanValue:
+		return "elbasan"
+	case GUnicodeScriptGranthaValue:
+		return "grantha"
+	case GUnicodeScriptKhojkiValue:
+		return "khojki"
+	case GUnicodeScriptKhudawadiValue:
+		return "khudawadi"
+	case GUnicodeScriptLinearAValue:
+		return "linear-a"
+	case GUnicodeScriptMahajaniValue:
+		return "mahajani"
+	case GUnicodeScriptManichaeanValue:
+		return "manichaean"
+	case GUnicodeScriptMendeKikakuiValue:
+		return "mende-kikakui"
+	case GUnicodeScriptModiValue:
+		return "modi"
+	case GUnicodeScriptMroValue:
+		return "mro"
+	case GUnicodeScriptNabataeanValue:
+		return "nabataean"
+	case GUnicodeScriptOldNorthArabianValue:
+		return "old-north-arabian"
+	case GUnicodeScriptOldPermicValue:
+		return "old-permic"
+	case GUnicodeScriptPahawhHmongValue:
+		return "pahawh-hmong"
+	case GUnicodeScriptPalmyreneValue:
+		return "palmyrene"
+	case GUnicodeScriptPauCinHauValue:
+		return "pau-cin-hau"
+	case GUnicodeScriptPsalterPahlaviValue:
+		return "psalter-pahlavi"
+	case GUnicodeScriptSiddhamValue:
+		return "siddham"
+	case GUnicodeScriptTirhutaValue:
+		return "tirhuta"
+	case GUnicodeScriptWarangCitiValue:
+		return "warang-citi"
+	case GUnicodeScriptAhomValue:
+		return "ahom"
+	case GUnicodeScriptAnatolianHieroglyphsValue:
+		return "anatolian-hieroglyphs"
+	case GUnicodeScriptHatranValue:
+		return "hatran"
+	case GUnicodeScriptMultaniValue:
+		return "multani"
+	case GUnicodeScriptOldHungarianValue:
+		return "old-hungarian"
+	case GUnicodeScriptSignwritingValue:
+		return "signwriting"
+	case GUnicodeScriptAdlamValue:
+		return "adlam"
+	case GUnicodeScriptBhaiksukiValue:
+		return "bhaiksuki"
+	case GUnicodeScriptMarchenValue:
+		return "marchen"
+	case GUnicodeScriptNewaValue:
+		return "newa"
+	case GUnicodeScriptOsageValue:
+		return "osage"
+	case GUnicodeScriptTangutValue:
+		return "tangut"
+	case GUnicodeScriptMasaramGondiValue:
+		return "masaram-gondi"
+	case GUnicodeScriptNushuValue:
+		return "nushu"
+	case GUnicodeScriptSoyomboValue:
+		return "soyombo"
+	case GUnicodeScriptZanabazarSquareValue:
+		return "zanabazar-square"
+	case GUnicodeScriptDograValue:
+		return "dogra"
+	case GUnicodeScriptGunjalaGondiValue:
+		return "gunjala-gondi"
+	case GUnicodeScriptHanifiRohingyaValue:
+		return "hanifi-rohingya"
+	case GUnicodeScriptMakasarValue:
+		return "makasar"
+	case GUnicodeScriptMedefaidrinValue:
+		return "medefaidrin"
+	case GUnicodeScriptOldSogdianValue:
+		return "old-sogdian"
+	case GUnicodeScriptSogdianValue:
+		return "sogdian"
+	case GUnicodeScriptElymaicValue:
+		return "elymaic"
+	case GUnicodeScriptNandinagariValue:
+		return "nandinagari"
+	case GUnicodeScriptNyiakengPuachueHmongValue:
+		return "nyiakeng-puachue-hmong"
+	case GUnicodeScriptWanchoValue:
+		return "wancho"
+	case GUnicodeScriptChorasmianValue:
+		return "chorasmian"
+	case GUnicodeScriptDivesAkuruValue:
+		return "dives-akuru"
+	case GUnicodeScriptKhitanSmallScriptValue:
+		return "khitan-small-script"
+	case GUnicodeScriptYezidiValue:
+		return "yezidi"
+	case GUnicodeScriptCyproMinoanValue:
+		return "cypro-minoan"
+	case GUnicodeScriptOldUyghurValue:
+		return "old-uyghur"
+	case GUnicodeScriptTangsaValue:
+		return "tangsa"
+	case GUnicodeScriptTotoValue:
+		return "toto"
+	case GUnicodeScriptVithkuqiValue:
+		return "vithkuqi"
+	case GUnicodeScriptMathValue:
+		return "math"
+	case GUnicodeScriptKawiValue:
+		return "kawi"
+	case GUnicodeScriptNagMundariValue:
+		return "nag-mundari"
+	case GUnicodeScriptTodhriValue:
+		return "todhri"
+	case GUnicodeScriptGarayValue:
+		return "garay"
+	case GUnicodeScriptTuluTigalariValue:
+		return "tulu-tigalari"
+	case GUnicodeScriptSunuwarValue:
+		return "sunuwar"
+	case GUnicodeScriptGurungKhemaValue:
+		return "gurung-khema"
+	case GUnicodeScriptKiratRaiValue:
+		return "kirat-rai"
+	case GUnicodeScriptOlOnalValue:
+		return "ol-onal"
+	}
+	return ""
+}
+
+// UnicodeScriptFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnicodeScriptFromString(s string) (UnicodeScript, bool) {
+	switch s {
+	case "G_UNICODE_SCRIPT_INVALID_CODE", "invalid-code":
+		return GUnicodeScriptInvalidCodeValue, true
+	case "G_UNICODE_SCRIPT_COMMON", "common":
+		return GUnicodeScriptCommonValue, true
+	case "G_UNICODE_SCRIPT_INHERITED", "inherited":
+		return GUnicodeScriptInheritedValue, true
+	case "G_UNICODE_SCRIPT_ARABIC", "arabic":
+		return GUnicodeScriptArabicValue, true
+	case "G_UNICODE_SCRIPT_ARMENIAN", "armenian":
+		return GUnicodeScriptArmenianValue, true
+	case "G_UNICODE_SCRIPT_BENGALI", "bengali":
+		return GUnicodeScriptBengaliValue, true
+	case "G_UNICODE_SCRIPT_BOPOMOFO", "bopomofo":
+		return GUnicodeScriptBopomofoValue, true
+	case "G_UNICODE_SCRIPT_CHEROKEE", "cherokee":
+		return GUnicodeScriptCherokeeValue, true
+	case "G_UNICODE_SCRIPT_COPTIC", "coptic":
+		return GUnicodeScriptCopticValue, true
+	case "G_UNICODE_SCRIPT_CYRILLIC", "cyrillic":
+		return GUnicodeScriptCyrillicValue, true
+	case "G_UNICODE_SCRIPT_DESERET", "deseret":
+		return GUnicodeScriptDeseretValue, true
+	case "G_UNICODE_SCRIPT_DEVANAGARI", "devanagari":
+		return GUnicodeScriptDevanagariValue, true
+	case "G_UNICODE_SCRIPT_ETHIOPIC", "ethiopic":
+		return GUnicodeScriptEthiopicValue, true
+	case "G_UNICODE_SCRIPT_GEORGIAN", "georgian":
+		return GUnicodeScriptGeorgianValue, true
+	case "G_UNICODE_SCRIPT_GOTHIC", "gothic":
+		return GUnicodeScriptGothicValue, true
+	case "G_UNICODE_SCRIPT_GREEK", "greek":
+		return GUnicodeScriptGreekValue, true
+	case "G_UNICODE_SCRIPT_GUJARATI", "gujarati":
+		return GUnicodeScriptGujaratiValue, true
+	case "G_UNICODE_SCRIPT_GURMUKHI", "gurmukhi":
+		return GUnicodeScriptGurmukhiValue, true
+	case "G_UNICODE_SCRIPT_HAN", "han":
+		return GUnicodeScriptHanValue, true
+	case "G_UNICODE_SCRIPT_HANGUL", "hangul":
+		return GUnicodeScriptHangulValue, true
+	case "G_UNICODE_SCRIPT_HEBREW", "hebrew":
+		return GUnicodeScriptHebrewValue, true
+	case "G_UNICODE_SCRIPT_HIRAGANA", "hiragana":
+		return GUnicodeScriptHiraganaValue, true
+	case "G_UNICODE_SCRIPT_KANNADA", "kannada":
+		return GUnicodeScriptKannadaValue, true
+	case "G_UNICODE_SCRIPT_KATAKANA", "katakana":
+		return GUnicodeScriptKatakanaValue, true
+	case "G_UNICODE_SCRIPT_KHMER", "khmer":
+		return GUnicodeScriptKhmerValue, true
+	case "G_UNICODE_SCRIPT_LAO", "lao":
+		return GUnicodeScriptLaoValue, true
+	case "G_UNICODE_SCRIPT_LATIN", "latin":
+		return GUnicodeScriptLatinValue, true
+	case "G_UNICODE_SCRIPT_MALAYALAM", "malayalam":
+		return GUnicodeScriptMalayalamValue, true
+	case "G_UNICODE_SCRIPT_MONGOLIAN", "mongolian":
+		return GUnicodeScriptMongolianValue, true
+	case "G_UNICODE_SCRIPT_MYANMAR", "myanmar":
+		return GUnicodeScriptMyanmarValue, true
+	case "G_UNICODE_SCRIPT_OGHAM", "ogham":
+		return GUnicodeScriptOghamValue, true
+	case "G_UNICODE_SCRIPT_OLD_ITALIC", "old-italic":
+		return GUnicodeScriptOldItalicValue, true
+	case "G_UNICODE_SCRIPT_ORIYA", "oriya":
+		return GUnicodeScriptOriyaValue, true
+	case "G_UNICODE_SCRIPT_RUNIC", "runic":
+		return GUnicodeScriptRunicValue, true
+	case "G_UNICODE_SCRIPT_SINHALA", "sinhala":
+		return GUnicodeScriptSinhalaValue, true
+	case "G_UNICODE_SCRIPT_SYRIAC", "syriac":
+		return GUnicodeScriptSyriacValue, true
+	case "G_UNICODE_SCRIPT_TAMIL", "tamil":
+		return GUnicodeScriptTamilValue, true
+	case "G_UNICODE_SCRIPT_TELUGU", "telugu":
+		return GUnicodeScriptTeluguValue, true
+	case "G_UNICODE_SCRIPT_THAANA", "thaana":
+		return GUnicodeScriptThaanaValue, true
+	case "G_UNICODE_SCRIPT_THAI", "thai":
+		return GUnicodeScriptThaiValue, true
+	case "G_UNICODE_SCRIPT_TIBETAN", "tibetan":
+		return GUnicodeScriptTibetanValue, true
+	case "G_UNICODE_SCRIPT_CANADIAN_ABORIGINAL", "canadian-aboriginal":
+		return GUnicodeScriptCanadianAboriginalValue, true
+	case "G_UNICODE_SCRIPT_YI", "yi":
+		return GUnicodeScriptYiValue, true
+	case "G_UNICODE_SCRIPT_TAGALOG", "tagalog":
+		return GUnicodeScriptTagalogValue, true
+	case "G_UNICODE_SCRIPT_HANUNOO", "hanunoo":
+		return GUnicodeScriptHanunooValue, true
+	case "G_UNICODE_SCRIPT_BUHID", "buhid":
+		return GUnicodeScriptBuhidValue, true
+	case "G_UNICODE_SCRIPT_TAGBANWA", "tagbanwa":
+		return GUnicodeScriptTagbanwaValue, true
+	case "G_UNICODE_SCRIPT_BRAILLE", "braille":
+		return GUnicodeScriptBrailleValue, true
+	case "G_UNICODE_SCRIPT_CYPRIOT", "cypriot":
+		return GUnicodeScriptCypriotValue, true
+	case "G_UNICODE_SCRIPT_LIMBU", "limbu":
+		return GUnicodeScriptLimbuValue, true
+	case "G_UNICODE_SCRIPT_OSMANYA", "osmanya":
+		return GUnicodeScriptOsmanyaValue, true
+	case "G_UNICODE_SCRIPT_SHAVIAN", "shavian":
+		return GUnicodeScriptShavianValue, true
+	case "G_UNICODE_SCRIPT_LINEAR_B", "linear-b":
+		return GUnicodeScriptLinearBValue, true
+	case "G_UNICODE_SCRIPT_TAI_LE", "tai-le":
+		return GUnicodeScriptTaiLeValue, true
+	case "G_UNICODE_SCRIPT_UGARITIC", "ugaritic":
+		return GUnicodeScriptUgariticValue, true
+	case "G_UNICODE_SCRIPT_NEW_TAI_LUE", "new-tai-lue":
+		return GUnicodeScriptNewTaiLueValue, true
+	case "G_UNICODE_SCRIPT_BUGINESE", "buginese":
+		return GUnicodeScriptBugineseValue, true
+	case "G_UNICODE_SCRIPT_GLAGOLITIC", "glagolitic":
+		return GUnicodeScriptGlagoliticValue, true
+	case "G_UNICODE_SCRIPT_TIFINAGH", "tifinagh":
+		return GUnicodeScriptTifinaghValue, true
+	case "G_UNICODE_SCRIPT_SYLOTI_NAGRI", "syloti-nagri":
+		return GUnicodeScriptSylotiNagriValue, true
+	case "G_UNICODE_SCRIPT_OLD_PERSIAN", "old-persian":
+		return GUnicodeScriptOldPersianValue, true
+	case "G_UNICODE_SCRIPT_KHAROSHTHI", "kharoshthi":
+		return GUnicodeScriptKharoshthiValue, true
+	case "G_UNICODE_SCRIPT_UNKNOWN", "unknown":
+		return GUnicodeScriptUnknownValue, true
+	case "G_UNICODE_SCRIPT_BALINESE", "balinese":
+		return GUnicodeScriptBalineseValue, true
+	case "G_UNICODE_SCRIPT_CUNEIFORM", "cuneiform":
+		return GUnicodeScriptCuneiformValue, true
+	case "G_UNICODE_SCRIPT_PHOENICIAN", "phoenician":
+		return GUnicodeScriptPhoenicianValue, true
+	case "G_UNICODE_SCRIPT_PHAGS_PA", "phags-pa":
+		return GUnicodeScriptPhagsPaValue, true
+	case "G_UNICODE_SCRIPT_NKO", "nko":
+		return GUnicodeScriptNkoValue, true
+	case "G_UNICODE_SCRIPT_KAYAH_LI", "kayah-li":
+		return GUnicodeScriptKayahLiValue, true
+	case "G_UNICODE_SCRIPT_LEPCHA", "lepcha":
+		return GUnicodeScriptLepchaValue, true
+	case "G_UNICODE_SCRIPT_REJANG", "rejang":
+		return GUnicodeScriptRejangValue, true
+	case "G_UNICODE_SCRIPT_SUNDANESE", "sundanese":
+		return GUnicodeScriptSundaneseValue, true
+	case "G_UNICODE_SCRIPT_SAURASHTRA", "saurashtra":
+		return GUnicodeScriptSaurashtraValue, true
+	case "G_UNICODE_SCRIPT_CHAM", "cham":
+		return GUnicodeScriptChamValue, true
+	case "G_UNICODE_SCRIPT_OL_CHIKI", "ol-chiki":
+		return GUnicodeScriptOlChikiValue, true
+	case "G_UNICODE_SCRIPT_VAI", "vai":
+		return GUnicodeScriptVaiValue, true
+	case "G_UNICODE_SCRIPT_CARIAN", "carian":
+		return GUnicodeScriptCarianValue, true
+	case "G_UNICODE_SCRIPT_LYCIAN", "lycian":
+		return GUnicodeScriptLycianValue, true
+	case "G_UNICODE_SCRIPT_LYDIAN", "lydian":
+		return GUnicodeScriptLydianValue, true
+	case "G_UNICODE_SCRIPT_AVESTAN", "avestan":
+		return GUnicodeScriptAvestanValue, true
+	case "G_UNICODE_SCRIPT_BAMUM", "bamum":
+		return GUnicodeScriptBamumValue, true
+	case "G_UNICODE_SCRIPT_EGYPTIAN_HIEROGLYPHS", "egyptian-hieroglyphs":
+		return GUnicodeScriptEgyptianHieroglyphsValue, true
+	case "G_UNICODE_SCRIPT_IMPERIAL_ARAMAIC", "imperial-aramaic":
+		return GUnicodeScriptImperialAramaicValue, true
+	case "G_UNICODE_SCRIPT_INSCRIPTIONAL_PAHLAVI", "inscriptional-pahlavi":
+		return GUnicodeScriptInscriptionalPahlaviValue, true
+	case "G_UNICODE_SCRIPT_INSCRIPTIONAL_PARTHIAN", "inscriptional-parthian":
+		return GUnicodeScriptInscriptionalParthianValue, true
+	case "G_UNICODE_SCRIPT_JAVANESE", "javanese":
+		return GUnicodeScriptJavaneseValue, true
+	case "G_UNICODE_SCRIPT_KAITHI", "kaithi":
+		return GUnicodeScriptKaithiValue, true
+	case "G_UNICODE_SCRIPT_LISU", "lisu":
+		return GUnicodeScriptLisuValue, true
+	case "G_UNICODE_SCRIPT_MEETEI_MAYEK", "meetei-mayek":
+		return GUnicodeScriptMeeteiMayekValue, true
+	case "G_UNICODE_SCRIPT_OLD_SOUTH_ARABIAN", "old-south-arabian":
+		return GUnicodeScriptOldSouthArabianValue, true
+	case "G_UNICODE_SCRIPT_OLD_TURKIC", "old-turkic":
+		return GUnicodeScriptOldTurkicValue, true
+	case "G_UNICODE_SCRIPT_SAMARITAN", "samaritan":
+		return GUnicodeScriptSamaritanValue, true
+	case "G_UNICODE_SCRIPT_TAI_THAM", "tai-tham":
+		return GUnicodeScriptTaiThamValue, true
+	case "G_UNICODE_SCRIPT_TAI_VIET", "tai-viet":
+		return GUnicodeScriptTaiVietValue, true
+	case "G_UNICODE_SCRIPT_BATAK", "batak":
+		return GUnicodeScriptBatakValue, true
+	case "G_UNICODE_SCRIPT_BRAHMI", "brahmi":
+		return GUnicodeScriptBrahmiValue, true
+	case "G_UNICODE_SCRIPT_MANDAIC", "mandaic":
+		return GUnicodeScriptMandaicValue, true
+	case "G_UNICODE_SCRIPT_CHAKMA", "chakma":
+		return GUnicodeScriptChakmaValue, true
+	case "G_UNICODE_SCRIPT_MEROITIC_CURSIVE", "meroitic-cursive":
+		return GUnicodeScriptMeroiticCursiveValue, true
+	case "G_UNICODE_SCRIPT_MEROITIC_HIEROGLYPHS", "meroitic-hieroglyphs":
+		return GUnicodeScriptMeroiticHieroglyphsValue, true
+	case "G_UNICODE_SCRIPT_MIAO", "miao":
+		return GUnicodeScriptMiaoValue, true
+	case "G_UNICODE_SCRIPT_SHARADA", "sharada":
+		return GUnicodeScriptSharadaValue, true
+	case "G_UNICODE_SCRIPT_SORA_SOMPENG", "sora-sompeng":
+		return GUnicodeScriptSoraSompengValue, true
+	case "G_UNICODE_SCRIPT_TAKRI", "takri":
+		return GUnicodeScriptTakriValue, true
+	case "G_UNICODE_SCRIPT_BASSA_VAH", "bassa-vah":
+		return GUnicodeScriptBassaVahValue, true
+	case "G_UNICODE_SCRIPT_CAUCASIAN_ALBANIAN", "caucasian-albanian":
+		return GUnicodeScriptCaucasianAlbanianValue, true
+	case "G_UNICODE_SCRIPT_DUPLOYAN", "duployan":
+		return GUnicodeScriptDuployanValue, true
+	case "G_UNICODE_SCRIPT_ELBASAN", "elbasan":
+		return GUnicodeScriptElbasanValue, true
+	case "G_UNICODE_SCRIPT_GRANTHA", "grantha":
+		return GUnicodeScriptGranthaValue, true
+	case "G_UNICODE_SCRIPT_KHOJKI", "khojki":
+		return GUnicodeScriptKhojkiValue, true
+	case "G_UNICODE_SCRIPT_KHUDAWADI", "khudawadi":
+		return GUnicodeScriptKhudawadiValue, true
+	case "G_UNICODE_SCRIPT_LINEAR_A", "linear-a":
+		return GUnicodeScriptLinearAValue, true
+	case "G_UNICODE_SCRIPT_MAHAJANI", "mahajani":
+		return GUnicodeScriptMahajaniValue, true
+	case "G_UNICODE_SCRIPT_MANICHAEAN", "manichaean":
+		return GUnicodeScriptManichaeanValue, true
+	case "G_UNICODE_SCRIPT_MENDE_KIKAKUI", "mende-kikakui":
+		return GUnicodeScriptMendeKikakuiValue, true
+	case "G_UNICODE_SCRIPT_MODI", "modi":
+		return GUnicodeScriptModiValue, true
+	case "G_UNICODE_SCRIPT_MRO", "mro":
+		return GUnicodeScriptMroValue, true
+	case "G_UNICODE_SCRIPT_NABATAEAN", "nabataean":
+		return GUnicodeScriptNabataeanValue, true
+	case "G_UNICODE_SCRIPT_OLD_NORTH_ARABIAN", "old-north-arabian":
+		return GUnicodeScriptOldNorthArabianValue, true
+	case "G_UNICODE_SCRIPT_OLD_PERMIC", "old-permic":
+		return GUnicodeScriptOldPermicValue, true
+	case "G_UNICODE_SCRIPT_PAHAWH_HMONG", "pahawh-hmong":
+		return GUnicodeScriptPahawhHmongValue, true
+	case "G_UNICODE_SCRIPT_PALMYRENE", "palmyrene":
+		return GUnicodeScriptPalmyreneValue, true
+	case "G_UNICODE_SCRIPT_PAU_CIN_HAU", "pau-cin-hau":
+		return GUnicodeScriptPauCinHauValue, true
+	case "G_UNICODE_SCRIPT_PSALTER_PAHLAVI", "psalter-pahlavi":
+		return GUnicodeScriptPsalterPahlaviValue, true
+	case "G_UNICODE_SCRIPT_SIDDHAM", "siddham":
+		return GUnicodeScriptSiddhamValue, true
+	case "G_UNICODE_SCRIPT_TIRHUTA", "tirhuta":
+		return GUnicodeScriptTirhutaValue, true
+	case "G_UNICODE_SCRIPT_WARANG_CITI", "warang-citi":
+		return GUnicodeScriptWarangCitiValue, true
+	case "G_UNICODE_SCRIPT_AHOM", "ahom":
+		return GUnicodeScriptAhomValue, true
+	case "G_UNICODE_SCRIPT_ANATOLIAN_HIEROGLYPHS", "anatolian-hieroglyphs":
+		return GUnicodeScriptAnatolianHieroglyphsValue, true
+	case "G_UNICODE_SCRIPT_HATRAN", "hatran":
+		return GUnicodeScriptHatranValue, true
+	case "G_UNICODE_SCRIPT_MULTANI", "multani":
+		return GUnicodeScriptMultaniValue, true
+	case "G_UNICODE_SCRIPT_OLD_HUNGARIAN", "old-hungarian":
+		return GUnicodeScriptOldHungarianValue, true
+	case "G_UNICODE_SCRIPT_SIGNWRITING", "signwriting":
+		return GUnicodeScriptSignwritingValue, true
+	case "G_UNICODE_SCRIPT_ADLAM", "adlam":
+		return GUnicodeScriptAdlamValue, true
+	case "G_UNICODE_SCRIPT_BHAIKSUKI", "bhaiksuki":
+		return GUnicodeScriptBhaiksukiValue, true
+	case "G_UNICODE_SCRIPT_MARCHEN", "marchen":
+		return GUnicodeScriptMarchenValue, true
+	case "G_UNICODE_SCRIPT_NEWA", "newa":
+		return GUnicodeScriptNewaValue, true
+	case "G_UNICODE_SCRIPT_OSAGE", "osage":
+		return GUnicodeScriptOsageValue, true
+	case "G_UNICODE_SCRIPT_TANGUT", "tangut":
+		return GUnicodeScriptTangutValue, true
+	case "G_UNICODE_SCRIPT_MASARAM_GONDI", "masaram-gondi":
+		return GUnicodeScriptMasaramGondiValue, true
+	case "G_UNICODE_SCRIPT_NUSHU", "nushu":
+		return GUnicodeScriptNushuValue, true
+	case "G_UNICODE_SCRIPT_SOYOMBO", "soyombo":
+		return GUnicodeScriptSoyomboValue, true
+	case "G_UNICODE_SCRIPT_ZANABAZAR_SQUARE", "zanabazar-square":
+		return GUnicodeScriptZanabazarSquareValue, true
+	case "G_UNICODE_SCRIPT_DOGRA", "dogra":
+		return GUnicodeScriptDograValue, true
+	case "G_UNICODE_SCRIPT_GUNJALA_GONDI", "gunjala-gondi":
+		return GUnicodeScriptGunjalaGondiValue, true
+	case "G_UNICODE_SCRIPT_HANIFI_ROHINGYA", "hanifi-rohingya":
+		return GUnicodeScriptHanifiRohingyaValue, true
+	case "G_UNICODE_SCRIPT_MAKASAR", "makasar":
+		return GUnicodeScriptMakasarValue, true
+	case "G_UNICODE_SCRIPT_MEDEFAIDRIN", "medefaidrin":
+		return GUnicodeScriptMedefaidrinValue, true
+	case "G_UNICODE_SCRIPT_OLD_SOGDIAN", "old-sogdian":
+		return GUnicodeScriptOldSogdianValue, true
+	case "G_UNICODE_SCRIPT_SOGDIAN", "sogdian":
+		return GUnicodeScriptSogdianValue, true
+	case "G_UNICODE_SCRIPT_ELYMAIC", "elymaic":
+		return GUnicodeScriptElymaicValue, true
+	case "G_UNICODE_SCRIPT_NANDINAGARI", "nandinagari":
+		return GUnicodeScriptNandinagariValue, true
+	case "G_UNICODE_SCRIPT_NYIAKENG_PUACHUE_HMONG", "nyiakeng-puachue-hmong":
+		return GUnicodeScriptNyiakengPuachueHmongValue, true
+	case "G_UNICODE_SCRIPT_WANCHO", "wancho":
+		return GUnicodeScriptWanchoValue, true
+	case "G_UNICODE_SCRIPT_CHORASMIAN", "chorasmian":
+		return GUnicodeScriptChorasmianValue, true
+	case "G_UNICODE_SCRIPT_DIVES_AKURU", "dives-akuru":
+		return GUnicodeScriptDivesAkuruValue, true
+	case "G_UNICODE_SCRIPT_KHITAN_SMALL_SCRIPT", "khitan-small-script":
+		return GUnicodeScriptKhitanSmallScriptValue, true
+	case "G_UNICODE_SCRIPT_YEZIDI", "yezidi":
+		return GUnicodeScriptYezidiValue, true
+	case "G_UNICODE_SCRIPT_CYPRO_MINOAN", "cypro-minoan":
+		return GUnicodeScriptCyproMinoanValue, true
+	case "G_UNICODE_SCRIPT_OLD_UYGHUR", "old-uyghur":
+		return GUnicodeScriptOldUyghurValue, true
+	case "G_UNICODE_SCRIPT_TANGSA", "tangsa":
+		return GUnicodeScriptTangsaValue, true
+	case "G_UNICODE_SCRIPT_TOTO", "toto":
+		return GUnicodeScriptTotoValue, true
+	case "G_UNICODE_SCRIPT_VITHKUQI", "vithkuqi":
+		return GUnicodeScriptVithkuqiValue, true
+	case "G_UNICODE_SCRIPT_MATH", "math":
+		return GUnicodeScriptMathValue, true
+	case "G_UNICODE_SCRIPT_KAWI", "kawi":
+		return GUnicodeScriptKawiValue, true
+	case "G_UNICODE_SCRIPT_NAG_MUNDARI", "nag-mundari":
+		return GUnicodeScriptNagMundariValue, true
+	case "G_UNICODE_SCRIPT_TODHRI", "todhri":
+		return GUnicodeScriptTodhriValue, true
+	case "G_UNICODE_SCRIPT_GARAY", "garay":
+		return GUnicodeScriptGarayValue, true
+	case "G_UNICODE_SCRIPT_TULU_TIGALARI", "tulu-tigalari":
+		return GUnicodeScriptTuluTigalariValue, true
+	case "G_UNICODE_SCRIPT_SUNUWAR", "sunuwar":
+		return GUnicodeScriptSunuwarValue, true
+	case "G_UNICODE_SCRIPT_GURUNG_KHEMA", "gurung-khema":
+		return GUnicodeScriptGurungKhemaValue, true
+	case "G_UNICODE_SCRIPT_KIRAT_RAI", "kirat-rai":
+		return GUnicodeScriptKiratRaiValue, true
+	case "G_UNICODE_SCRIPT_OL_ONAL", "ol-onal":
+		return GUnicodeScriptOlOnalValue, true
+	}
+	return 0, false
+}
+
 // These are the possible character classifications from the
 // Unicode specification.
-// See [Unicode Character Database](http://www.unicode.org/reports/tr44/#General_Category_Values).
+// See [Unicode Character Database].
+//
+// [Unicode Character Database]: http://www.unicode.org/reports/tr44/General_Category_Values
 type UnicodeType int
 
 var xUnicodeTypeGLibType func() types.GType
@@ -613,92 +2043,295 @@ const (
 	GUnicodeSpaceSeparatorValue UnicodeType = 29
 )
 
-var xUcs4ToUtf16 func([]uint32, int, *int, *int, **Error) uint16
+// String returns the C name of the value, e.g. for debugging output
+func (x UnicodeType) String() string {
+	switch x {
+	case GUnicodeControlValue:
+		return "G_UNICODE_CONTROL"
+	case GUnicodeFormatValue:
+		return "G_UNICODE_FORMAT"
+	case GUnicodeUnassignedValue:
+		return "G_UNICODE_UNASSIGNED"
+	case GUnicodePrivateUseValue:
+		return "G_UNICODE_PRIVATE_USE"
+	case GUnicodeSurrogateValue:
+		return "G_UNICODE_SURROGATE"
+	case GUnicodeLowercaseLetterValue:
+		return "G_UNICODE_LOWERCASE_LETTER"
+	case GUnicodeModifierLetterValue:
+		return "G_UNICODE_MODIFIER_LETTER"
+	case GUnicodeOtherLetterValue:
+		return "G_UNICODE_OTHER_LETTER"
+	case GUnicodeTitlecaseLetterValue:
+		return "G_UNICODE_TITLECASE_LETTER"
+	case GUnicodeUppercaseLetterValue:
+		return "G_UNICODE_UPPERCASE_LETTER"
+	case GUnicodeSpacingMarkValue:
+		return "G_UNICODE_SPACING_MARK"
+	case GUnicodeEnclosingMarkValue:
+		return "G_UNICODE_ENCLOSING_MARK"
+	case GUnicodeNonSpacingMarkValue:
+		return "G_UNICODE_NON_SPACING_MARK"
+	case GUnicodeDecimalNumberValue:
+		return "G_UNICODE_DECIMAL_NUMBER"
+	case GUnicodeLetterNumberValue:
+		return "G_UNICODE_LETTER_NUMBER"
+	case GUnicodeOtherNumberValue:
+		return "G_UNICODE_OTHER_NUMBER"
+	case GUnicodeConnectPunctuationValue:
+		return "G_UNICODE_CONNECT_PUNCTUATION"
+	case GUnicodeDashPunctuationValue:
+		return "G_UNICODE_DASH_PUNCTUATION"
+	case GUnicodeClosePunctuationValue:
+		return "G_UNICODE_CLOSE_PUNCTUATION"
+	case GUnicodeFinalPunctuationValue:
+		return "G_UNICODE_FINAL_PUNCTUATION"
+	case GUnicodeInitialPunctuationValue:
+		return "G_UNICODE_INITIAL_PUNCTUATION"
+	case GUnicodeOtherPunctuationValue:
+		return "G_UNICODE_OTHER_PUNCTUATION"
+	case GUnicodeOpenPunctuationValue:
+		return "G_UNICODE_OPEN_PUNCTUATION"
+	case GUnicodeCurrencySymbolValue:
+		return "G_UNICODE_CURRENCY_SYMBOL"
+	case GUnicodeModifierSymbolValue:
+		return "G_UNICODE_MODIFIER_SYMBOL"
+	case GUnicodeMathSymbolValue:
+		return "G_UNICODE_MATH_SYMBOL"
+	case GUnicodeOtherSymbolValue:
+		return "G_UNICODE_OTHER_SYMBOL"
+	case GUnicodeLineSeparatorValue:
+		return "G_UNICODE_LINE_SEPARATOR"
+	case GUnicodeParagraphSeparatorValue:
+		return "G_UNICODE_PARAGRAPH_SEPARATOR"
+	case GUnicodeSpaceSeparatorValue:
+		return "G_UNICODE_SPACE_SEPARATOR"
+	}
+	return fmt.Sprintf("UnicodeType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UnicodeType) Nick() string {
+	switch x {
+	case GUnicodeControlValue:
+		return "control"
+	case GUnicodeFormatValue:
+		return "format"
+	case GUnicodeUnassignedValue:
+		return "unassigned"
+	case GUnicodePrivateUseValue:
+		return "private-use"
+	case GUnicodeSurrogateValue:
+		return "surrogate"
+	case GUnicodeLowercaseLetterValue:
+		return "lowercase-letter"
+	case GUnicodeModifierLetterValue:
+		return "modifier-letter"
+	case GUnicodeOtherLetterValue:
+		return "other-letter"
+	case GUnicodeTitlecaseLetterValue:
+		return "titlecase-letter"
+	case GUnicodeUppercaseLetterValue:
+		return "uppercase-letter"
+	case GUnicodeSpacingMarkValue:
+		return "spacing-mark"
+	case GUnicodeEnclosingMarkValue:
+		return "enclosing-mark"
+	case GUnicodeNonSpacingMarkValue:
+		return "non-spacing-mark"
+	case GUnicodeDecimalNumberValue:
+		return "decimal-number"
+	case GUnicodeLetterNumberValue:
+		return "letter-number"
+	case GUnicodeOtherNumberValue:
+		return "other-number"
+	case GUnicodeConnectPunctuationValue:
+		return "connect-punctuation"
+	case GUnicodeDashPunctuationValue:
+		return "dash-punctuation"
+	case GUnicodeClosePunctuationValue:
+		return "close-punctuation"
+	case GUnicodeFinalPunctuationValue:
+		return "final-punctuation"
+	case GUnicodeInitialPunctuationValue:
+		return "initial-punctuation"
+	case GUnicodeOtherPunctuationValue:
+		return "other-punctuation"
+	case GUnicodeOpenPunctuationValue:
+		return "open-punctuation"
+	case GUnicodeCurrencySymbolValue:
+		return "currency-symbol"
+	case GUnicodeModifierSymbolValue:
+		return "modifier-symbol"
+	case GUnicodeMathSymbolValue:
+		return "math-symbol"
+	case GUnicodeOtherSymbolValue:
+		return "other-symbol"
+	case GUnicodeLineSeparatorValue:
+		return "line-separator"
+	case GUnicodeParagraphSeparatorValue:
+		return "paragraph-separator"
+	case GUnicodeSpaceSeparatorValue:
+		return "space-separator"
+	}
+	return ""
+}
+
+// UnicodeTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnicodeTypeFromString(s string) (UnicodeType, bool) {
+	switch s {
+	case "G_UNICODE_CONTROL", "control":
+		return GUnicodeControlValue, true
+	case "G_UNICODE_FORMAT", "format":
+		return GUnicodeFormatValue, true
+	case "G_UNICODE_UNASSIGNED", "unassigned":
+		return GUnicodeUnassignedValue, true
+	case "G_UNICODE_PRIVATE_USE", "private-use":
+		return GUnicodePrivateUseValue, true
+	case "G_UNICODE_SURROGATE", "surrogate":
+		return GUnicodeSurrogateValue, true
+	case "G_UNICODE_LOWERCASE_LETTER", "lowercase-letter":
+		return GUnicodeLowercaseLetterValue, true
+	case "G_UNICODE_MODIFIER_LETTER", "modifier-letter":
+		return GUnicodeModifierLetterValue, true
+	case "G_UNICODE_OTHER_LETTER", "other-letter":
+		return GUnicodeOtherLetterValue, true
+	case "G_UNICODE_TITLECASE_LETTER", "titlecase-letter":
+		return GUnicodeTitlecaseLetterValue, true
+	case "G_UNICODE_UPPERCASE_LETTER", "uppercase-letter":
+		return GUnicodeUppercaseLetterValue, true
+	case "G_UNICODE_SPACING_MARK", "spacing-mark":
+		return GUnicodeSpacingMarkValue, true
+	case "G_UNICODE_ENCLOSING_MARK", "enclosing-mark":
+		return GUnicodeEnclosingMarkValue, true
+	case "G_UNICODE_NON_SPACING_MARK", "non-spacing-mark":
+		return GUnicodeNonSpacingMarkValue, true
+	case "G_UNICODE_DECIMAL_NUMBER", "decimal-number":
+		return GUnicodeDecimalNumberValue, true
+	case "G_UNICODE_LETTER_NUMBER", "letter-number":
+		return GUnicodeLetterNumberValue, true
+	case "G_UNICODE_OTHER_NUMBER", "other-number":
+		return GUnicodeOtherNumberValue, true
+	case "G_UNICODE_CONNECT_PUNCTUATION", "connect-punctuation":
+		return GUnicodeConnectPunctuationValue, true
+	case "G_UNICODE_DASH_PUNCTUATION", "dash-punctuation":
+		return GUnicodeDashPunctuationValue, true
+	case "G_UNICODE_CLOSE_PUNCTUATION", "close-punctuation":
+		return GUnicodeClosePunctuationValue, true
+	case "G_UNICODE_FINAL_PUNCTUATION", "final-punctuation":
+		return GUnicodeFinalPunctuationValue, true
+	case "G_UNICODE_INITIAL_PUNCTUATION", "initial-punctuation":
+		return GUnicodeInitialPunctuationValue, true
+	case "G_UNICODE_OTHER_PUNCTUATION", "other-punctuation":
+		return GUnicodeOtherPunctuationValue, true
+	case "G_UNICODE_OPEN_PUNCTUATION", "open-punctuation":
+		return GUnicodeOpenPunctuationValue, true
+	case "G_UNICODE_CURRENCY_SYMBOL", "currency-symbol":
+		return GUnicodeCurrencySymbolValue, true
+	case "G_UNICODE_MODIFIER_SYMBOL", "modifier-symbol":
+		return GUnicodeModifierSymbolValue, true
+	case "G_UNICODE_MATH_SYMBOL", "math-symbol":
+		return GUnicodeMathSymbolValue, true
+	case "G_UNICODE_OTHER_SYMBOL", "other-symbol":
+		return GUnicodeOtherSymbolValue, true
+	case "G_UNICODE_LINE_SEPARATOR", "line-separator":
+		return GUnicodeLineSeparatorValue, true
+	case "G_UNICODE_PARAGRAPH_SEPARATOR", "paragraph-separator":
+		return GUnicodeParagraphSeparatorValue, true
+	case "G_UNICODE_SPACE_SEPARATOR", "space-separator":
+		return GUnicodeSpaceSeparatorValue, true
+	}
+	return 0, false
+}
+
+var xUcs4ToUtf16 func([]rune, types.Long, *types.Long, *types.Long, **Error) uint16
 
 // Convert a string from UCS-4 to UTF-16.
 //
 // A nul character (U+0000) will be added to the result after the converted text.
-func Ucs4ToUtf16(StrVar []uint32, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (uint16, error) {
+func Ucs4ToUtf16(StrVar []rune, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (uint16, error) {
 	var cerr *Error
 
 	cret := xUcs4ToUtf16(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xUcs4ToUtf8 func([]uint32, int, *int, *int, **Error) string
+var xUcs4ToUtf8 func([]rune, types.Long, *types.Long, *types.Long, **Error) string
 
 // Convert a string from a 32-bit fixed width representation as UCS-4.
 // to UTF-8.
 //
 // The result will be terminated with a nul byte.
-func Ucs4ToUtf8(StrVar []uint32, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (string, error) {
+func Ucs4ToUtf8(StrVar []rune, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (string, error) {
 	var cerr *Error
 
 	cret := xUcs4ToUtf8(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xUnicharBreakType func(uint32) UnicodeBreakType
+var xUnicharBreakType func(rune) UnicodeBreakType
 
-// Determines the break type of @c. @c should be a Unicode character
+// Determines the break type of c. c should be a Unicode character
 // (to derive a character from UTF-8 encoded text, use
-// g_utf8_get_char()). The break type is used to find word and line
+// [Utf8GetChar]). The break type is used to find word and line
 // breaks ("text boundaries"), Pango implements the Unicode boundary
 // resolution algorithms and normally you would use a function such
-// as pango_break() instead of caring about break types yourself.
-func UnicharBreakType(CVar uint32) UnicodeBreakType {
+// as [pango.Break] instead of caring about break types yourself.
+func UnicharBreakType(CVar rune) UnicodeBreakType {
 
 	cret := xUnicharBreakType(CVar)
 
 	return cret
 }
 
-var xUnicharCombiningClass func(uint32) int
+var xUnicharCombiningClass func(rune) int
 
 // Determines the canonical combining class of a Unicode character.
-func UnicharCombiningClass(UcVar uint32) int {
+func UnicharCombiningClass(UcVar rune) int {
 
 	cret := xUnicharCombiningClass(UcVar)
 
 	return cret
 }
 
-var xUnicharCompose func(uint32, uint32, *uint32) bool
+var xUnicharCompose func(rune, rune, *rune) bool
 
 // Performs a single composition step of the
 // Unicode canonical composition algorithm.
 //
 // This function includes algorithmic Hangul Jamo composition,
-// but it is not exactly the inverse of g_unichar_decompose().
-// No composition can have either of @a or @b equal to zero.
+// but it is not exactly the inverse of [UnicharDecompose].
+// No composition can have either of a or b equal to zero.
 // To be precise, this function composes if and only if
 // there exists a Primary Composite P which is canonically
-// equivalent to the sequence &lt;@a,@b&gt;.  See the Unicode
+// equivalent to the sequence <a,b>.  See the Unicode
 // Standard for the definition of Primary Composite.
 //
-// If @a and @b do not compose a new character, @ch is set to zero.
+// If a and b do not compose a new character, ch is set to zero.
 //
 // See
-// [UAX#15](http://unicode.org/reports/tr15/)
+// [UAX#15]
 // for details.
-func UnicharCompose(AVar uint32, BVar uint32, ChVar *uint32) bool {
+//
+// [UAX#15]: http://unicode.org/reports/tr15/
+func UnicharCompose(AVar rune, BVar rune, ChVar *rune) bool {
 
 	cret := xUnicharCompose(AVar, BVar, ChVar)
 
 	return cret
 }
 
-var xUnicharDecompose func(uint32, *uint32, *uint32) bool
+var xUnicharDecompose func(rune, *rune, *rune) bool
 
 // Performs a single decomposition step of the
 // Unicode canonical decomposition algorithm.
@@ -710,244 +2343,248 @@ var xUnicharDecompose func(uint32, *uint32, *uint32) bool
 // other character. In the case of singletons `*b` will
 // be set to zero.
 //
-// If @ch is not decomposable, `*a` is set to @ch and `*b`
+// If ch is not decomposable, `*a` is set to ch and `*b`
 // is set to zero.
 //
 // Note that the way Unicode decomposition pairs are
-// defined, it is guaranteed that @b would not decompose
-// further, but @a may itself decompose.  To get the full
-// canonical decomposition for @ch, one would need to
-// recursively call this function on @a.  Or use
-// g_unichar_fully_decompose().
+// defined, it is guaranteed that b would not decompose
+// further, but a may itself decompose.  To get the full
+// canonical decomposition for ch, one would need to
+// recursively call this function on a.  Or use
+// [UnicharFullyDecompose].
 //
 // See
-// [UAX#15](http://unicode.org/reports/tr15/)
+// [UAX#15]
 // for details.
-func UnicharDecompose(ChVar uint32, AVar *uint32, BVar *uint32) bool {
+//
+// [UAX#15]: http://unicode.org/reports/tr15/
+func UnicharDecompose(ChVar rune, AVar *rune, BVar *rune) bool {
 
 	cret := xUnicharDecompose(ChVar, AVar, BVar)
 
 	return cret
 }
 
-var xUnicharDigitValue func(uint32) int
+var xUnicharDigitValue func(rune) int
 
 // Determines the numeric value of a character as a decimal
 // digit.
-func UnicharDigitValue(CVar uint32) int {
+func UnicharDigitValue(CVar rune) int {
 
 	cret := xUnicharDigitValue(CVar)
 
 	return cret
 }
 
-var xUnicharFullyDecompose func(uint32, bool, *uint32, uint) uint
+var xUnicharFullyDecompose func(rune, bool, *rune, uint) uint
 
 // Computes the canonical or compatibility decomposition of a
 // Unicode character.  For compatibility decomposition,
-// pass %TRUE for @compat; for canonical decomposition
-// pass %FALSE for @compat.
+// pass true for compat; for canonical decomposition
+// pass false for compat.
 //
-// The decomposed sequence is placed in @result.  Only up to
-// @result_len characters are written into @result.  The length
-// of the full decomposition (irrespective of @result_len) is
+// The decomposed sequence is placed in result.  Only up to
+// result_len characters are written into result.  The length
+// of the full decomposition (irrespective of result_len) is
 // returned by the function.  For canonical decomposition,
 // currently all decompositions are of length at most 4, but
 // this may change in the future (very unlikely though).
 // At any rate, Unicode does guarantee that a buffer of length
 // 18 is always enough for both compatibility and canonical
 // decompositions, so that is the size recommended. This is provided
-// as %G_UNICHAR_MAX_DECOMPOSITION_LENGTH.
+// as [UNICHAR_MAX_DECOMPOSITION_LENGTH].
 //
 // See
-// [UAX#15](http://unicode.org/reports/tr15/)
+// [UAX#15]
 // for details.
-func UnicharFullyDecompose(ChVar uint32, CompatVar bool, ResultVar *uint32, ResultLenVar uint) uint {
+//
+// [UAX#15]: http://unicode.org/reports/tr15/
+func UnicharFullyDecompose(ChVar rune, CompatVar bool, ResultVar *rune, ResultLenVar uint) uint {
 
 	cret := xUnicharFullyDecompose(ChVar, CompatVar, ResultVar, ResultLenVar)
 
 	return cret
 }
 
-var xUnicharGetMirrorChar func(uint32, *uint32) bool
+var xUnicharGetMirrorChar func(rune, *rune) bool
 
 // In Unicode, some characters are "mirrored". This means that their
 // images are mirrored horizontally in text that is laid out from right
 // to left. For instance, "(" would become its mirror image, ")", in
 // right-to-left text.
 //
-// If @ch has the Unicode mirrored property and there is another unicode
-// character that typically has a glyph that is the mirror image of @ch's
-// glyph and @mirrored_ch is set, it puts that character in the address
-// pointed to by @mirrored_ch.  Otherwise the original character is put.
-func UnicharGetMirrorChar(ChVar uint32, MirroredChVar *uint32) bool {
+// If ch has the Unicode mirrored property and there is another unicode
+// character that typically has a glyph that is the mirror image of ch's
+// glyph and mirrored_ch is set, it puts that character in the address
+// pointed to by mirrored_ch.  Otherwise the original character is put.
+func UnicharGetMirrorChar(ChVar rune, MirroredChVar *rune) bool {
 
 	cret := xUnicharGetMirrorChar(ChVar, MirroredChVar)
 
 	return cret
 }
 
-var xUnicharGetScript func(uint32) UnicodeScript
+var xUnicharGetScript func(rune) UnicodeScript
 
-// Looks up the #GUnicodeScript for a particular character (as defined
-// by Unicode Standard Annex \#24). No check is made for @ch being a
+// Looks up the [UnicodeScript] for a particular character (as defined
+// by Unicode Standard Annex \#24). No check is made for ch being a
 // valid Unicode character; if you pass in invalid character, the
 // result is undefined.
 //
-// This function is equivalent to pango_script_for_unichar() and the
+// This function is equivalent to [pango.ScriptForUnichar] and the
 // two are interchangeable.
-func UnicharGetScript(ChVar uint32) UnicodeScript {
+func UnicharGetScript(ChVar rune) UnicodeScript {
 
 	cret := xUnicharGetScript(ChVar)
 
 	return cret
 }
 
-var xUnicharIsalnum func(uint32) bool
+var xUnicharIsalnum func(rune) bool
 
 // Determines whether a character is alphanumeric.
 // Given some UTF-8 text, obtain a character value
-// with g_utf8_get_char().
-func UnicharIsalnum(CVar uint32) bool {
+// with [Utf8GetChar].
+func UnicharIsalnum(CVar rune) bool {
 
 	cret := xUnicharIsalnum(CVar)
 
 	return cret
 }
 
-var xUnicharIsalpha func(uint32) bool
+var xUnicharIsalpha func(rune) bool
 
 // Determines whether a character is alphabetic (i.e. a letter).
 // Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIsalpha(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIsalpha(CVar rune) bool {
 
 	cret := xUnicharIsalpha(CVar)
 
 	return cret
 }
 
-var xUnicharIscntrl func(uint32) bool
+var xUnicharIscntrl func(rune) bool
 
 // Determines whether a character is a control character.
 // Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIscntrl(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIscntrl(CVar rune) bool {
 
 	cret := xUnicharIscntrl(CVar)
 
 	return cret
 }
 
-var xUnicharIsdefined func(uint32) bool
+var xUnicharIsdefined func(rune) bool
 
 // Determines if a given character is assigned in the Unicode
 // standard.
-func UnicharIsdefined(CVar uint32) bool {
+func UnicharIsdefined(CVar rune) bool {
 
 	cret := xUnicharIsdefined(CVar)
 
 	return cret
 }
 
-var xUnicharIsdigit func(uint32) bool
+var xUnicharIsdigit func(rune) bool
 
 // Determines whether a character is numeric (i.e. a digit).  This
 // covers ASCII 0-9 and also digits in other languages/scripts.  Given
-// some UTF-8 text, obtain a character value with g_utf8_get_char().
-func UnicharIsdigit(CVar uint32) bool {
+// some UTF-8 text, obtain a character value with [Utf8GetChar].
+func UnicharIsdigit(CVar rune) bool {
 
 	cret := xUnicharIsdigit(CVar)
 
 	return cret
 }
 
-var xUnicharIsgraph func(uint32) bool
+var xUnicharIsgraph func(rune) bool
 
 // Determines whether a character is printable and not a space
-// (returns %FALSE for control characters, format characters, and
-// spaces). g_unichar_isprint() is similar, but returns %TRUE for
+// (returns false for control characters, format characters, and
+// spaces). [UnicharIsprint] is similar, but returns true for
 // spaces. Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIsgraph(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIsgraph(CVar rune) bool {
 
 	cret := xUnicharIsgraph(CVar)
 
 	return cret
 }
 
-var xUnicharIslower func(uint32) bool
+var xUnicharIslower func(rune) bool
 
 // Determines whether a character is a lowercase letter.
 // Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIslower(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIslower(CVar rune) bool {
 
 	cret := xUnicharIslower(CVar)
 
 	return cret
 }
 
-var xUnicharIsmark func(uint32) bool
+var xUnicharIsmark func(rune) bool
 
 // Determines whether a character is a mark (non-spacing mark,
 // combining mark, or enclosing mark in Unicode speak).
 // Given some UTF-8 text, obtain a character value
-// with g_utf8_get_char().
+// with [Utf8GetChar].
 //
 // Note: in most cases where isalpha characters are allowed,
 // ismark characters should be allowed to as they are essential
 // for writing most European languages as well as many non-Latin
 // scripts.
-func UnicharIsmark(CVar uint32) bool {
+func UnicharIsmark(CVar rune) bool {
 
 	cret := xUnicharIsmark(CVar)
 
 	return cret
 }
 
-var xUnicharIsprint func(uint32) bool
+var xUnicharIsprint func(rune) bool
 
 // Determines whether a character is printable.
-// Unlike g_unichar_isgraph(), returns %TRUE for spaces.
+// Unlike [UnicharIsgraph], returns true for spaces.
 // Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIsprint(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIsprint(CVar rune) bool {
 
 	cret := xUnicharIsprint(CVar)
 
 	return cret
 }
 
-var xUnicharIspunct func(uint32) bool
+var xUnicharIspunct func(rune) bool
 
 // Determines whether a character is punctuation or a symbol.
 // Given some UTF-8 text, obtain a character value with
-// g_utf8_get_char().
-func UnicharIspunct(CVar uint32) bool {
+// [Utf8GetChar].
+func UnicharIspunct(CVar rune) bool {
 
 	cret := xUnicharIspunct(CVar)
 
 	return cret
 }
 
-var xUnicharIsspace func(uint32) bool
+var xUnicharIsspace func(rune) bool
 
 // Determines whether a character is a space, tab, or line separator
 // (newline, carriage return, etc.).  Given some UTF-8 text, obtain a
-// character value with g_utf8_get_char().
+// character value with [Utf8GetChar].
 //
 // (Note: don't use this to do word breaking; you have to use
 // Pango or equivalent to get word breaking right, the algorithm
 // is fairly complex.)
-func UnicharIsspace(CVar uint32) bool {
+func UnicharIsspace(CVar rune) bool {
 
 	cret := xUnicharIsspace(CVar)
 
 	return cret
 }
 
-var xUnicharIstitle func(uint32) bool
+var xUnicharIstitle func(rune) bool
 
 // Determines if a character is titlecase. Some characters in
 // Unicode which are composites, such as the DZ digraph
@@ -955,172 +2592,164 @@ var xUnicharIstitle func(uint32) bool
 // form is used at the beginning of a word where only the
 // first letter is capitalized. The titlecase form of the DZ
 // digraph is U+01F2 LATIN CAPITAL LETTTER D WITH SMALL LETTER Z.
-func UnicharIstitle(CVar uint32) bool {
+func UnicharIstitle(CVar rune) bool {
 
 	cret := xUnicharIstitle(CVar)
 
 	return cret
 }
 
-var xUnicharIsupper func(uint32) bool
+var xUnicharIsupper func(rune) bool
 
 // Determines if a character is uppercase.
-func UnicharIsupper(CVar uint32) bool {
+func UnicharIsupper(CVar rune) bool {
 
 	cret := xUnicharIsupper(CVar)
 
 	return cret
 }
 
-var xUnicharIswide func(uint32) bool
+var xUnicharIswide func(rune) bool
 
 // Determines if a character is typically rendered in a double-width
 // cell.
-func UnicharIswide(CVar uint32) bool {
+func UnicharIswide(CVar rune) bool {
 
 	cret := xUnicharIswide(CVar)
 
 	return cret
 }
 
-var xUnicharIswideCjk func(uint32) bool
+var xUnicharIswideCjk func(rune) bool
 
 // Determines if a character is typically rendered in a double-width
 // cell under legacy East Asian locales.  If a character is wide according to
-// g_unichar_iswide(), then it is also reported wide with this function, but
+// [UnicharIswide], then it is also reported wide with this function, but
 // the converse is not necessarily true. See the
-// [Unicode Standard Annex #11](http://www.unicode.org/reports/tr11/)
+// [Unicode Standard Annex #11]
 // for details.
 //
-// If a character passes the g_unichar_iswide() test then it will also pass
+// If a character passes the [UnicharIswide] test then it will also pass
 // this test, but not the other way around.  Note that some characters may
-// pass both this test and g_unichar_iszerowidth().
-func UnicharIswideCjk(CVar uint32) bool {
+// pass both this test and [UnicharIszerowidth].
+//
+// [Unicode Standard Annex #11]: http://www.unicode.org/reports/tr11/
+func UnicharIswideCjk(CVar rune) bool {
 
 	cret := xUnicharIswideCjk(CVar)
 
 	return cret
 }
 
-var xUnicharIsxdigit func(uint32) bool
+var xUnicharIsxdigit func(rune) bool
 
 // Determines if a character is a hexadecimal digit.
-func UnicharIsxdigit(CVar uint32) bool {
+func UnicharIsxdigit(CVar rune) bool {
 
 	cret := xUnicharIsxdigit(CVar)
 
 	return cret
 }
 
-var xUnicharIszerowidth func(uint32) bool
+var xUnicharIszerowidth func(rune) bool
 
 // Determines if a given character typically takes zero width when rendered.
-// The return value is %TRUE for all non-spacing and enclosing marks
+// The return value is true for all non-spacing and enclosing marks
 // (e.g., combining accents), format characters, zero-width
 // space, but not U+00AD SOFT HYPHEN.
 //
-// A typical use of this function is with one of g_unichar_iswide() or
-// g_unichar_iswide_cjk() to determine the number of cells a string occupies
+// A typical use of this function is with one of [UnicharIswide] or
+// [UnicharIswideCjk] to determine the number of cells a string occupies
 // when displayed on a grid display (terminals).  However, note that not all
 // terminals support zero-width rendering of zero-width marks.
-func UnicharIszerowidth(CVar uint32) bool {
+func UnicharIszerowidth(CVar rune) bool {
 
 	cret := xUnicharIszerowidth(CVar)
 
 	return cret
 }
 
-var xUnicharToUtf8 func(uint32, *string) int
+var xUnicharToUtf8 func(rune, *string) int
 
 // Converts a single character to UTF-8.
-func UnicharToUtf8(CVar uint32, OutbufVar *string) int {
+func UnicharToUtf8(CVar rune, OutbufVar *string) int {
 
 	cret := xUnicharToUtf8(CVar, OutbufVar)
 
 	return cret
 }
 
-var xUnicharTolower func(uint32) uint32
+var xUnicharTolower func(rune) rune
 
 // Converts a character to lower case.
-func UnicharTolower(CVar uint32) uint32 {
+func UnicharTolower(CVar rune) rune {
 
 	cret := xUnicharTolower(CVar)
 
 	return cret
 }
 
-var xUnicharTotitle func(uint32) uint32
+var xUnicharTotitle func(rune) rune
 
 // Converts a character to the titlecase.
-func UnicharTotitle(CVar uint32) uint32 {
+func UnicharTotitle(CVar rune) rune {
 
 	cret := xUnicharTotitle(CVar)
 
 	return cret
 }
 
-var xUnicharToupper func(uint32) uint32
+var xUnicharToupper func(rune) rune
 
 // Converts a character to uppercase.
-func UnicharToupper(CVar uint32) uint32 {
+func UnicharToupper(CVar rune) rune {
 
 	cret := xUnicharToupper(CVar)
 
 	return cret
 }
 
-var xUnicharType func(uint32) UnicodeType
+var xUnicharType func(rune) UnicodeType
 
 // Classifies a Unicode character by type.
-func UnicharType(CVar uint32) UnicodeType {
+func UnicharType(CVar rune) UnicodeType {
 
 	cret := xUnicharType(CVar)
 
 	return cret
 }
 
-var xUnicharValidate func(uint32) bool
+var xUnicharValidate func(rune) bool
 
-// Checks whether @ch is a valid Unicode character.
+// Checks whether ch is a valid Unicode character.
 //
-// Some possible integer values of @ch will not be valid. U+0000 is considered a
+// Some possible integer values of ch will not be valid. U+0000 is considered a
 // valid character, though it’s normally a string terminator.
-func UnicharValidate(ChVar uint32) bool {
+func UnicharValidate(ChVar rune) bool {
 
 	cret := xUnicharValidate(ChVar)
 
 	return cret
 }
 
-var xUnicharXdigitValue func(uint32) int
+var xUnicharXdigitValue func(rune) int
 
 // Determines the numeric value of a character as a hexadecimal
 // digit.
-func UnicharXdigitValue(CVar uint32) int {
+func UnicharXdigitValue(CVar rune) int {
 
 	cret := xUnicharXdigitValue(CVar)
 
 	return cret
 }
 
-var xUnicodeCanonicalDecomposition func(uint32, uint) uint32
-
-// Computes the canonical decomposition of a Unicode character.
-func UnicodeCanonicalDecomposition(ChVar uint32, ResultLenVar uint) uint32 {
-
-	cret := xUnicodeCanonicalDecomposition(ChVar, ResultLenVar)
-
-	return cret
-}
-
-var xUnicodeCanonicalOrdering func([]uint32, uint)
+var xUnicodeCanonicalOrdering func([]rune, uint)
 
 // Computes the canonical ordering of a string in-place.
 // This rearranges decomposed characters in the string
 // according to their combining classes.  See the Unicode
 // manual for more information.
-func UnicodeCanonicalOrdering(StringVar []uint32, LenVar uint) {
+func UnicodeCanonicalOrdering(StringVar []rune, LenVar uint) {
 
 	xUnicodeCanonicalOrdering(StringVar, LenVar)
 
@@ -1128,15 +2757,17 @@ func UnicodeCanonicalOrdering(StringVar []uint32, LenVar uint) {
 
 var xUnicodeScriptFromIso15924 func(uint32) UnicodeScript
 
-// Looks up the Unicode script for @iso15924.  ISO 15924 assigns four-letter
+// Looks up the Unicode script for iso15924.  ISO 15924 assigns four-letter
 // codes to scripts.  For example, the code for Arabic is 'Arab'.
-// This function accepts four letter codes encoded as a @guint32 in a
+// This function accepts four letter codes encoded as a guint32 in a
 // big-endian fashion.  That is, the code expected for Arabic is
 // 0x41726162 (0x41 is ASCII code for 'A', 0x72 is ASCII code for 'r', etc).
 //
 // See
-// [Codes for the representation of names of scripts](http://unicode.org/iso15924/codelists.html)
+// [Codes for the representation of names of scripts]
 // for details.
+//
+// [Codes for the representation of names of scripts]: http://unicode.org/iso15924/codelists.html
 func UnicodeScriptFromIso15924(Iso15924Var uint32) UnicodeScript {
 
 	cret := xUnicodeScriptFromIso15924(Iso15924Var)
@@ -1146,15 +2777,17 @@ func UnicodeScriptFromIso15924(Iso15924Var uint32) UnicodeScript {
 
 var xUnicodeScriptToIso15924 func(UnicodeScript) uint32
 
-// Looks up the ISO 15924 code for @script.  ISO 15924 assigns four-letter
+// Looks up the ISO 15924 code for script.  ISO 15924 assigns four-letter
 // codes to scripts.  For example, the code for Arabic is 'Arab'.  The
-// four letter codes are encoded as a @guint32 by this function in a
+// four letter codes are encoded as a guint32 by this function in a
 // big-endian fashion.  That is, the code returned for Arabic is
 // 0x41726162 (0x41 is ASCII code for 'A', 0x72 is ASCII code for 'r', etc).
 //
 // See
-// [Codes for the representation of names of scripts](http://unicode.org/iso15924/codelists.html)
+// [Codes for the representation of names of scripts]
 // for details.
+//
+// [Codes for the representation of names of scripts]: http://unicode.org/iso15924/codelists.html
 func UnicodeScriptToIso15924(ScriptVar UnicodeScript) uint32 {
 
 	cret := xUnicodeScriptToIso15924(ScriptVar)
@@ -1162,24 +2795,24 @@ func UnicodeScriptToIso15924(ScriptVar UnicodeScript) uint32 {
 	return cret
 }
 
-var xUtf16ToUcs4 func([]uint16, int, *int, *int, **Error) uint32
+var xUtf16ToUcs4 func([]uint16, types.Long, *types.Long, *types.Long, **Error) uintptr
 
 // Convert a string from UTF-16 to UCS-4.
 //
 // The result will be nul-terminated.
-func Utf16ToUcs4(StrVar []uint16, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (uint32, error) {
+func Utf16ToUcs4(StrVar []uint16, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (uintptr, error) {
 	var cerr *Error
 
 	cret := xUtf16ToUcs4(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xUtf16ToUtf8 func([]uint16, int, *int, *int, **Error) string
+var xUtf16ToUtf8 func([]uint16, types.Long, *types.Long, *types.Long, **Error) string
 
 // Convert a string from UTF-16 to UTF-8.
 //
@@ -1187,7 +2820,7 @@ var xUtf16ToUtf8 func([]uint16, int, *int, *int, **Error) string
 //
 // Note that the input is expected to be already in native endianness,
 // an initial byte-order-mark character is not handled specially.
-// [func@GLib.convert] can be used to convert a byte buffer of UTF-16 data of
+// [Convert] can be used to convert a byte buffer of UTF-16 data of
 // ambiguous endianness.
 //
 // Further note that this function does not validate the result
@@ -1195,13 +2828,13 @@ var xUtf16ToUtf8 func([]uint16, int, *int, *int, **Error) string
 // validation done by this function is to ensure that the input can
 // be correctly interpreted as UTF-16, i.e. it doesn’t contain
 // unpaired surrogates or partial character sequences.
-func Utf16ToUtf8(StrVar []uint16, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (string, error) {
+func Utf16ToUtf8(StrVar []uint16, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (string, error) {
 	var cerr *Error
 
 	cret := xUtf16ToUtf8(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1212,9 +2845,9 @@ var xUtf8Casefold func(string, int) string
 // Converts a string into a form that is independent of case. The
 // result will not correspond to any particular case, but can be
 // compared for equality or ordered with the results of calling
-// g_utf8_casefold() on other strings.
+// [Utf8Casefold] on other strings.
 //
-// Note that calling g_utf8_casefold() followed by g_utf8_collate() is
+// Note that calling [Utf8Casefold] followed by [Utf8Collate] is
 // only an approximation to the correct linguistic case insensitive
 // ordering, though it is a fairly good one. Getting this exactly
 // right would require a more sophisticated collation function that
@@ -1230,9 +2863,9 @@ func Utf8Casefold(StrVar string, LenVar int) string {
 var xUtf8Collate func(string, string) int
 
 // Compares two strings for ordering using the linguistically
-// correct rules for the [current locale](running.html#locale).
+// correct rules for the current locale.
 // When sorting a large number of strings, it will be significantly
-// faster to obtain collation keys with g_utf8_collate_key() and
+// faster to obtain collation keys with [Utf8CollateKey] and
 // compare the keys with strcmp() when sorting instead of sorting
 // the original strings.
 //
@@ -1254,9 +2887,9 @@ var xUtf8CollateKey func(string, int) string
 //
 // The results of comparing the collation keys of two strings
 // with strcmp() will always be the same as comparing the two
-// original keys with g_utf8_collate().
+// original keys with [Utf8Collate].
 //
-// Note that this function depends on the [current locale](running.html#locale).
+// Note that this function depends on the current locale.
 //
 // Note that the returned string is not guaranteed to be in any
 // encoding, especially UTF-8. The returned value is meant to be
@@ -1280,7 +2913,7 @@ var xUtf8CollateKeyForFilename func(string, int) string
 // would like to treat numbers intelligently so that "file1" "file10" "file5"
 // is sorted as "file1" "file5" "file10".
 //
-// Note that this function depends on the [current locale](running.html#locale).
+// Note that this function depends on the current locale.
 //
 // Note that the returned string is not guaranteed to be in any
 // encoding, especially UTF-8. The returned value is meant to be
@@ -1294,15 +2927,15 @@ func Utf8CollateKeyForFilename(StrVar string, LenVar int) string {
 
 var xUtf8FindNextChar func(string, uintptr) string
 
-// Finds the start of the next UTF-8 character in the string after @p.
+// Finds the start of the next UTF-8 character in the string after p.
 //
-// @p does not have to be at the beginning of a UTF-8 character. No check
+// p does not have to be at the beginning of a UTF-8 character. No check
 // is made to see if the character found is actually valid other than
 // it starts with an appropriate byte.
 //
-// If @end is `NULL`, the return value will never be `NULL`: if the end of the
+// If end is `NULL`, the return value will never be `NULL`: if the end of the
 // string is reached, a pointer to the terminating nul byte is returned. If
-// @end is non-`NULL`, the return value will be `NULL` if the end of the string
+// end is non-`NULL`, the return value will be `NULL` if the end of the string
 // is reached.
 func Utf8FindNextChar(PVar string, EndVar *string) string {
 
@@ -1316,11 +2949,11 @@ func Utf8FindNextChar(PVar string, EndVar *string) string {
 
 var xUtf8FindPrevChar func(string, string) string
 
-// Given a position @p with a UTF-8 encoded string @str, find the start
-// of the previous UTF-8 character starting before @p. Returns `NULL` if no
-// UTF-8 characters are present in @str before @p.
+// Given a position p with a UTF-8 encoded string str, find the start
+// of the previous UTF-8 character starting before p. Returns `NULL` if no
+// UTF-8 characters are present in str before p.
 //
-// @p does not have to be at the beginning of a UTF-8 character. No check
+// p does not have to be at the beginning of a UTF-8 character. No check
 // is made to see if the character found is actually valid other than
 // it starts with an appropriate byte.
 func Utf8FindPrevChar(StrVar string, PVar string) string {
@@ -1330,22 +2963,22 @@ func Utf8FindPrevChar(StrVar string, PVar string) string {
 	return cret
 }
 
-var xUtf8GetChar func(string) uint32
+var xUtf8GetChar func(string) rune
 
 // Converts a sequence of bytes encoded as UTF-8 to a Unicode character.
 //
-// If @p does not point to a valid UTF-8 encoded character, results
+// If p does not point to a valid UTF-8 encoded character, results
 // are undefined. If you are not sure that the bytes are complete
-// valid Unicode characters, you should use [func@GLib.utf8_get_char_validated]
+// valid Unicode characters, you should use [Utf8GetCharValidated]
 // instead.
-func Utf8GetChar(PVar string) uint32 {
+func Utf8GetChar(PVar string) rune {
 
 	cret := xUtf8GetChar(PVar)
 
 	return cret
 }
 
-var xUtf8GetCharValidated func(string, int) uint32
+var xUtf8GetCharValidated func(string, int) rune
 
 // Convert a sequence of bytes encoded as UTF-8 to a Unicode character.
 //
@@ -1353,10 +2986,10 @@ var xUtf8GetCharValidated func(string, int) uint32
 // such as characters that are out of the range of Unicode, and for
 // overlong encodings of valid characters.
 //
-// Note that [func@GLib.utf8_get_char_validated] returns `(gunichar)-2` if
-// @max_len is positive and any of the bytes in the first UTF-8 character
+// Note that [Utf8GetCharValidated] returns `(gunichar)-2` if
+// max_len is positive and any of the bytes in the first UTF-8 character
 // sequence are nul.
-func Utf8GetCharValidated(PVar string, MaxLenVar int) uint32 {
+func Utf8GetCharValidated(PVar string, MaxLenVar int) rune {
 
 	cret := xUtf8GetCharValidated(PVar, MaxLenVar)
 
@@ -1387,22 +3020,22 @@ var xUtf8Normalize func(string, int, NormalizeMode) string
 // such issues as whether a character with an accent
 // is represented as a base character and combining
 // accent or as a single precomposed character. The
-// string has to be valid UTF-8, otherwise %NULL is
-// returned. You should generally call g_utf8_normalize()
+// string has to be valid UTF-8, otherwise nil is
+// returned. You should generally call [Utf8Normalize]
 // before comparing two Unicode strings.
 //
-// The normalization mode %G_NORMALIZE_DEFAULT only
+// The normalization mode [GNormalizeDefaultValue] only
 // standardizes differences that do not affect the
 // text content, such as the above-mentioned accent
-// representation. %G_NORMALIZE_ALL also standardizes
+// representation. [GNormalizeAllValue] also standardizes
 // the "compatibility" characters in Unicode, such
 // as SUPERSCRIPT THREE to the standard forms
 // (in this case DIGIT THREE). Formatting information
 // may be lost but for most text operations such
 // characters should be considered the same.
 //
-// %G_NORMALIZE_DEFAULT_COMPOSE and %G_NORMALIZE_ALL_COMPOSE
-// are like %G_NORMALIZE_DEFAULT and %G_NORMALIZE_ALL,
+// [GNormalizeDefaultComposeValue] and [GNormalizeAllComposeValue]
+// are like [GNormalizeDefaultValue] and [GNormalizeAllValue],
 // but returned a result with composed forms rather
 // than a maximally decomposed form. This is often
 // useful if you intend to convert the string to
@@ -1415,36 +3048,36 @@ func Utf8Normalize(StrVar string, LenVar int, ModeVar NormalizeMode) string {
 	return cret
 }
 
-var xUtf8OffsetToPointer func(string, int) string
+var xUtf8OffsetToPointer func(string, types.Long) string
 
 // Converts from an integer character offset to a pointer to a position
 // within the string.
 //
-// Since 2.10, this function allows to pass a negative @offset to
+// Since 2.10, this function allows to pass a negative offset to
 // step backwards. It is usually worth stepping backwards from the end
-// instead of forwards if @offset is in the last fourth of the string,
+// instead of forwards if offset is in the last fourth of the string,
 // since moving forward is about 3 times faster than moving backward.
 //
-// Note that this function doesn’t abort when reaching the end of @str.
-// Therefore you should be sure that @offset is within string boundaries
-// before calling that function. Call [func@GLib.utf8_strlen] when unsure.
+// Note that this function doesn’t abort when reaching the end of str.
+// Therefore you should be sure that offset is within string boundaries
+// before calling that function. Call [Utf8Strlen] when unsure.
 // This limitation exists as this function is called frequently during
 // text rendering and therefore has to be as fast as possible.
-func Utf8OffsetToPointer(StrVar string, OffsetVar int) string {
+func Utf8OffsetToPointer(StrVar string, OffsetVar types.Long) string {
 
 	cret := xUtf8OffsetToPointer(StrVar, OffsetVar)
 
 	return cret
 }
 
-var xUtf8PointerToOffset func(string, string) int
+var xUtf8PointerToOffset func(string, string) types.Long
 
 // Converts from a pointer to position within a string to an integer
 // character offset.
 //
-// Since 2.10, this function allows @pos to be before @str, and returns
+// Since 2.10, this function allows pos to be before str, and returns
 // a negative offset in this case.
-func Utf8PointerToOffset(StrVar string, PosVar string) int {
+func Utf8PointerToOffset(StrVar string, PosVar string) types.Long {
 
 	cret := xUtf8PointerToOffset(StrVar, PosVar)
 
@@ -1453,12 +3086,12 @@ func Utf8PointerToOffset(StrVar string, PosVar string) int {
 
 var xUtf8PrevChar func(string) string
 
-// Finds the previous UTF-8 character in the string before @p.
+// Finds the previous UTF-8 character in the string before p.
 //
-// @p does not have to be at the beginning of a UTF-8 character. No check
+// p does not have to be at the beginning of a UTF-8 character. No check
 // is made to see if the character found is actually valid other than
-// it starts with an appropriate byte. If @p might be the first
-// character of the string, you must use [func@GLib.utf8_find_prev_char]
+// it starts with an appropriate byte. If p might be the first
+// character of the string, you must use [Utf8FindPrevChar]
 // instead.
 func Utf8PrevChar(PVar string) string {
 
@@ -1467,13 +3100,13 @@ func Utf8PrevChar(PVar string) string {
 	return cret
 }
 
-var xUtf8Strchr func(string, int, uint32) string
+var xUtf8Strchr func(string, int, rune) string
 
 // Finds the leftmost occurrence of the given Unicode character
-// in a UTF-8 encoded string, while limiting the search to @len bytes.
+// in a UTF-8 encoded string, while limiting the search to len bytes.
 //
-// If @len is `-1`, allow unbounded search.
-func Utf8Strchr(PVar string, LenVar int, CVar uint32) string {
+// If len is `-1`, allow unbounded search.
+func Utf8Strchr(PVar string, LenVar int, CVar rune) string {
 
 	cret := xUtf8Strchr(PVar, LenVar, CVar)
 
@@ -1493,12 +3126,12 @@ func Utf8Strdown(StrVar string, LenVar int) string {
 	return cret
 }
 
-var xUtf8Strlen func(string, int) int
+var xUtf8Strlen func(string, int) types.Long
 
 // Computes the length of the string in characters, not including
-// the terminating nul character. If the @max’th byte falls in the
+// the terminating nul character. If the max’th byte falls in the
 // middle of a character, the last (partial) character is not counted.
-func Utf8Strlen(PVar string, MaxVar int) int {
+func Utf8Strlen(PVar string, MaxVar int) types.Long {
 
 	cret := xUtf8Strlen(PVar, MaxVar)
 
@@ -1507,14 +3140,14 @@ func Utf8Strlen(PVar string, MaxVar int) int {
 
 var xUtf8Strncpy func(string, string, uint) string
 
-// Like the standard C [`strncpy()`](man:strncpy) function, but copies a given
+// Like the standard C strncpy() function, but copies a given
 // number of characters instead of a given number of bytes.
 //
-// The @src string must be valid UTF-8 encoded text. (Use
-// [func@GLib.utf8_validate] on all text before trying to use UTF-8 utility
+// The src string must be valid UTF-8 encoded text. (Use
+// [Utf8Validate] on all text before trying to use UTF-8 utility
 // functions with it.)
 //
-// Note you must ensure @dest is at least 4 * @n + 1 to fit the
+// Note you must ensure dest is at least 4 * n + 1 to fit the
 // largest possible UTF-8 characters
 func Utf8Strncpy(DestVar string, SrcVar string, NVar uint) string {
 
@@ -1523,13 +3156,13 @@ func Utf8Strncpy(DestVar string, SrcVar string, NVar uint) string {
 	return cret
 }
 
-var xUtf8Strrchr func(string, int, uint32) string
+var xUtf8Strrchr func(string, int, rune) string
 
 // Find the rightmost occurrence of the given Unicode character
-// in a UTF-8 encoded string, while limiting the search to @len bytes.
+// in a UTF-8 encoded string, while limiting the search to len bytes.
 //
-// If @len is `-1`, allow unbounded search.
-func Utf8Strrchr(PVar string, LenVar int, CVar uint32) string {
+// If len is `-1`, allow unbounded search.
+func Utf8Strrchr(PVar string, LenVar int, CVar rune) string {
 
 	cret := xUtf8Strrchr(PVar, LenVar, CVar)
 
@@ -1540,7 +3173,7 @@ var xUtf8Strreverse func(string, int) string
 
 // Reverses a UTF-8 string.
 //
-// @str must be valid UTF-8 encoded text. (Use [func@GLib.utf8_validate] on all
+// str must be valid UTF-8 encoded text. (Use [Utf8Validate] on all
 // text before trying to use UTF-8 utility functions with it.)
 //
 // This function is intended for programmatic uses of reversed strings.
@@ -1549,8 +3182,8 @@ var xUtf8Strreverse func(string, int) string
 // characters which might need special handling when reversing a string
 // for display purposes.
 //
-// Note that unlike [func@GLib.strreverse], this function returns
-// newly-allocated memory, which should be freed with [func@GLib.free] when
+// Note that unlike [Strreverse], this function returns
+// newly-allocated memory, which should be freed with [Free] when
 // no longer needed.
 func Utf8Strreverse(StrVar string, LenVar int) string {
 
@@ -1573,65 +3206,65 @@ func Utf8Strup(StrVar string, LenVar int) string {
 	return cret
 }
 
-var xUtf8Substring func(string, int, int) string
+var xUtf8Substring func(string, types.Long, types.Long) string
 
 // Copies a substring out of a UTF-8 encoded string.
-// The substring will contain @end_pos - @start_pos characters.
+// The substring will contain end_pos - start_pos characters.
 //
-// Since GLib 2.72, `-1` can be passed to @end_pos to indicate the
+// Since GLib 2.72, `-1` can be passed to end_pos to indicate the
 // end of the string.
-func Utf8Substring(StrVar string, StartPosVar int, EndPosVar int) string {
+func Utf8Substring(StrVar string, StartPosVar types.Long, EndPosVar types.Long) string {
 
 	cret := xUtf8Substring(StrVar, StartPosVar, EndPosVar)
 
 	return cret
 }
 
-var xUtf8ToUcs4 func(string, int, *int, *int, **Error) uint32
+var xUtf8ToUcs4 func(string, types.Long, *types.Long, *types.Long, **Error) uintptr
 
 // Convert a string from UTF-8 to a 32-bit fixed width representation as UCS-4.
 //
 // A trailing nul character (U+0000) will be added to the string after the
 // converted text.
-func Utf8ToUcs4(StrVar string, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (uint32, error) {
+func Utf8ToUcs4(StrVar string, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (uintptr, error) {
 	var cerr *Error
 
 	cret := xUtf8ToUcs4(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
-var xUtf8ToUcs4Fast func(string, int, *int) uint32
+var xUtf8ToUcs4Fast func(string, types.Long, *types.Long) uintptr
 
 // Convert a string from UTF-8 to a 32-bit fixed width
 // representation as UCS-4, assuming valid UTF-8 input.
 //
-// This function is roughly twice as fast as [func@GLib.utf8_to_ucs4]
+// This function is roughly twice as fast as [Utf8ToUcs4]
 // but does no error checking on the input. A trailing nul character (U+0000)
 // will be added to the string after the converted text.
-func Utf8ToUcs4Fast(StrVar string, LenVar int, ItemsWrittenVar *int) uint32 {
+func Utf8ToUcs4Fast(StrVar string, LenVar types.Long, ItemsWrittenVar *types.Long) uintptr {
 
 	cret := xUtf8ToUcs4Fast(StrVar, LenVar, ItemsWrittenVar)
 
 	return cret
 }
 
-var xUtf8ToUtf16 func(string, int, *int, *int, **Error) uint16
+var xUtf8ToUtf16 func(string, types.Long, *types.Long, *types.Long, **Error) uint16
 
 // Convert a string from UTF-8 to UTF-16.
 //
 // A nul character (U+0000) will be added to the result after the converted text.
-func Utf8ToUtf16(StrVar string, LenVar int, ItemsReadVar *int, ItemsWrittenVar *int) (uint16, error) {
+func Utf8ToUtf16(StrVar string, LenVar types.Long, ItemsReadVar *types.Long, ItemsWrittenVar *types.Long) (uint16, error) {
 	var cerr *Error
 
 	cret := xUtf8ToUtf16(StrVar, LenVar, ItemsReadVar, ItemsWrittenVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1639,11 +3272,11 @@ func Utf8ToUtf16(StrVar string, LenVar int, ItemsReadVar *int, ItemsWrittenVar *
 
 var xUtf8TruncateMiddle func(string, uint) string
 
-// Cuts off the middle of the string, preserving half of @truncate_length
+// Cuts off the middle of the string, preserving half of truncate_length
 // characters at the beginning and half at the end.
 //
-// If @string is already short enough, this returns a copy of @string.
-// If @truncate_length is `0`, an empty string is returned.
+// If string is already short enough, this returns a copy of string.
+// If truncate_length is `0`, an empty string is returned.
 func Utf8TruncateMiddle(StringVar string, TruncateLengthVar uint) string {
 
 	cret := xUtf8TruncateMiddle(StringVar, TruncateLengthVar)
@@ -1655,20 +3288,20 @@ var xUtf8Validate func([]byte, int, *[]byte) bool
 
 // Validates UTF-8 encoded text.
 //
-// @str is the text to validate; if @str is nul-terminated, then @max_len can be
-// `-1`, otherwise @max_len should be the number of bytes to validate.
+// str is the text to validate; if str is nul-terminated, then max_len can be
+// `-1`, otherwise max_len should be the number of bytes to validate.
 //
-// If @end is non-`NULL`, then the end of the valid range will be stored there.
+// If end is non-`NULL`, then the end of the valid range will be stored there.
 // This is the first byte of the first invalid character if some bytes were
 // invalid, or the end of the text being validated otherwise — either the
-// trailing nul byte, or the first byte beyond @max_len (if it’s positive).
+// trailing nul byte, or the first byte beyond max_len (if it’s positive).
 //
-// Note that `g_utf8_validate()` returns `FALSE` if @max_len is  positive and
-// any of the @max_len bytes are nul.
+// Note that `[Utf8Validate]` returns `FALSE` if max_len is  positive and
+// any of the max_len bytes are nul.
 //
-// Returns `TRUE` if all of @str was valid. Many GLib and GTK
+// Returns `TRUE` if all of str was valid. Many GLib and GTK
 // routines require valid UTF-8 as input; so data read from a file
-// or the network should be checked with `g_utf8_validate()` before
+// or the network should be checked with `[Utf8Validate]` before
 // doing anything else with it.
 func Utf8Validate(StrVar []byte, MaxLenVar int, EndVar *[]byte) bool {
 
@@ -1681,8 +3314,8 @@ var xUtf8ValidateLen func([]byte, uint, *[]byte) bool
 
 // Validates UTF-8 encoded text.
 //
-// As with [func@GLib.utf8_validate], but @max_len must be set, and hence this
-// function will always return `FALSE` if any of the bytes of @str are nul.
+// As with [Utf8Validate], but max_len must be set, and hence this
+// function will always return `FALSE` if any of the bytes of str are nul.
 func Utf8ValidateLen(StrVar []byte, MaxLenVar uint, EndVar *[]byte) bool {
 
 	cret := xUtf8ValidateLen(StrVar, MaxLenVar, EndVar)
@@ -1695,7 +3328,7 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1710,72 +3343,71 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnicodeTypeGLibType, libs, "g_unicode_type_get_type")
 
-	core.PuregoSafeRegister(&xUcs4ToUtf16, libs, "g_ucs4_to_utf16")
-	core.PuregoSafeRegister(&xUcs4ToUtf8, libs, "g_ucs4_to_utf8")
-	core.PuregoSafeRegister(&xUnicharBreakType, libs, "g_unichar_break_type")
-	core.PuregoSafeRegister(&xUnicharCombiningClass, libs, "g_unichar_combining_class")
-	core.PuregoSafeRegister(&xUnicharCompose, libs, "g_unichar_compose")
-	core.PuregoSafeRegister(&xUnicharDecompose, libs, "g_unichar_decompose")
-	core.PuregoSafeRegister(&xUnicharDigitValue, libs, "g_unichar_digit_value")
-	core.PuregoSafeRegister(&xUnicharFullyDecompose, libs, "g_unichar_fully_decompose")
-	core.PuregoSafeRegister(&xUnicharGetMirrorChar, libs, "g_unichar_get_mirror_char")
-	core.PuregoSafeRegister(&xUnicharGetScript, libs, "g_unichar_get_script")
-	core.PuregoSafeRegister(&xUnicharIsalnum, libs, "g_unichar_isalnum")
-	core.PuregoSafeRegister(&xUnicharIsalpha, libs, "g_unichar_isalpha")
-	core.PuregoSafeRegister(&xUnicharIscntrl, libs, "g_unichar_iscntrl")
-	core.PuregoSafeRegister(&xUnicharIsdefined, libs, "g_unichar_isdefined")
-	core.PuregoSafeRegister(&xUnicharIsdigit, libs, "g_unichar_isdigit")
-	core.PuregoSafeRegister(&xUnicharIsgraph, libs, "g_unichar_isgraph")
-	core.PuregoSafeRegister(&xUnicharIslower, libs, "g_unichar_islower")
-	core.PuregoSafeRegister(&xUnicharIsmark, libs, "g_unichar_ismark")
-	core.PuregoSafeRegister(&xUnicharIsprint, libs, "g_unichar_isprint")
-	core.PuregoSafeRegister(&xUnicharIspunct, libs, "g_unichar_ispunct")
-	core.PuregoSafeRegister(&xUnicharIsspace, libs, "g_unichar_isspace")
-	core.PuregoSafeRegister(&xUnicharIstitle, libs, "g_unichar_istitle")
-	core.PuregoSafeRegister(&xUnicharIsupper, libs, "g_unichar_isupper")
-	core.PuregoSafeRegister(&xUnicharIswide, libs, "g_unichar_iswide")
-	core.PuregoSafeRegister(&xUnicharIswideCjk, libs, "g_unichar_iswide_cjk")
-	core.PuregoSafeRegister(&xUnicharIsxdigit, libs, "g_unichar_isxdigit")
-	core.PuregoSafeRegister(&xUnicharIszerowidth, libs, "g_unichar_iszerowidth")
-	core.PuregoSafeRegister(&xUnicharToUtf8, libs, "g_unichar_to_utf8")
-	core.PuregoSafeRegister(&xUnicharTolower, libs, "g_unichar_tolower")
-	core.PuregoSafeRegister(&xUnicharTotitle, libs, "g_unichar_totitle")
-	core.PuregoSafeRegister(&xUnicharToupper, libs, "g_unichar_toupper")
-	core.PuregoSafeRegister(&xUnicharType, libs, "g_unichar_type")
-	core.PuregoSafeRegister(&xUnicharValidate, libs, "g_unichar_validate")
-	core.PuregoSafeRegister(&xUnicharXdigitValue, libs, "g_unichar_xdigit_value")
-	core.PuregoSafeRegister(&xUnicodeCanonicalDecomposition, libs, "g_unicode_canonical_decomposition")
-	core.PuregoSafeRegister(&xUnicodeCanonicalOrdering, libs, "g_unicode_canonical_ordering")
-	core.PuregoSafeRegister(&xUnicodeScriptFromIso15924, libs, "g_unicode_script_from_iso15924")
-	core.PuregoSafeRegister(&xUnicodeScriptToIso15924, libs, "g_unicode_script_to_iso15924")
-	core.PuregoSafeRegister(&xUtf16ToUcs4, libs, "g_utf16_to_ucs4")
-	core.PuregoSafeRegister(&xUtf16ToUtf8, libs, "g_utf16_to_utf8")
-	core.PuregoSafeRegister(&xUtf8Casefold, libs, "g_utf8_casefold")
-	core.PuregoSafeRegister(&xUtf8Collate, libs, "g_utf8_collate")
-	core.PuregoSafeRegister(&xUtf8CollateKey, libs, "g_utf8_collate_key")
-	core.PuregoSafeRegister(&xUtf8CollateKeyForFilename, libs, "g_utf8_collate_key_for_filename")
-	core.PuregoSafeRegister(&xUtf8FindNextChar, libs, "g_utf8_find_next_char")
-	core.PuregoSafeRegister(&xUtf8FindPrevChar, libs, "g_utf8_find_prev_char")
-	core.PuregoSafeRegister(&xUtf8GetChar, libs, "g_utf8_get_char")
-	core.PuregoSafeRegister(&xUtf8GetCharValidated, libs, "g_utf8_get_char_validated")
-	core.PuregoSafeRegister(&xUtf8MakeValid, libs, "g_utf8_make_valid")
-	core.PuregoSafeRegister(&xUtf8Normalize, libs, "g_utf8_normalize")
-	core.PuregoSafeRegister(&xUtf8OffsetToPointer, libs, "g_utf8_offset_to_pointer")
-	core.PuregoSafeRegister(&xUtf8PointerToOffset, libs, "g_utf8_pointer_to_offset")
-	core.PuregoSafeRegister(&xUtf8PrevChar, libs, "g_utf8_prev_char")
-	core.PuregoSafeRegister(&xUtf8Strchr, libs, "g_utf8_strchr")
-	core.PuregoSafeRegister(&xUtf8Strdown, libs, "g_utf8_strdown")
-	core.PuregoSafeRegister(&xUtf8Strlen, libs, "g_utf8_strlen")
-	core.PuregoSafeRegister(&xUtf8Strncpy, libs, "g_utf8_strncpy")
-	core.PuregoSafeRegister(&xUtf8Strrchr, libs, "g_utf8_strrchr")
-	core.PuregoSafeRegister(&xUtf8Strreverse, libs, "g_utf8_strreverse")
-	core.PuregoSafeRegister(&xUtf8Strup, libs, "g_utf8_strup")
-	core.PuregoSafeRegister(&xUtf8Substring, libs, "g_utf8_substring")
-	core.PuregoSafeRegister(&xUtf8ToUcs4, libs, "g_utf8_to_ucs4")
-	core.PuregoSafeRegister(&xUtf8ToUcs4Fast, libs, "g_utf8_to_ucs4_fast")
-	core.PuregoSafeRegister(&xUtf8ToUtf16, libs, "g_utf8_to_utf16")
-	core.PuregoSafeRegister(&xUtf8TruncateMiddle, libs, "g_utf8_truncate_middle")
-	core.PuregoSafeRegister(&xUtf8Validate, libs, "g_utf8_validate")
-	core.PuregoSafeRegister(&xUtf8ValidateLen, libs, "g_utf8_validate_len")
+	core.PuregoRegisterSince(&xUcs4ToUtf16, libs, "g_ucs4_to_utf16", "GLIB", "")
+	core.PuregoRegisterSince(&xUcs4ToUtf8, libs, "g_ucs4_to_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharBreakType, libs, "g_unichar_break_type", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharCombiningClass, libs, "g_unichar_combining_class", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xUnicharCompose, libs, "g_unichar_compose", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnicharDecompose, libs, "g_unichar_decompose", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnicharDigitValue, libs, "g_unichar_digit_value", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharFullyDecompose, libs, "g_unichar_fully_decompose", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnicharGetMirrorChar, libs, "g_unichar_get_mirror_char", "GLIB", "2.4")
+	core.PuregoRegisterSince(&xUnicharGetScript, libs, "g_unichar_get_script", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xUnicharIsalnum, libs, "g_unichar_isalnum", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsalpha, libs, "g_unichar_isalpha", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIscntrl, libs, "g_unichar_iscntrl", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsdefined, libs, "g_unichar_isdefined", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsdigit, libs, "g_unichar_isdigit", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsgraph, libs, "g_unichar_isgraph", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIslower, libs, "g_unichar_islower", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsmark, libs, "g_unichar_ismark", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xUnicharIsprint, libs, "g_unichar_isprint", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIspunct, libs, "g_unichar_ispunct", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsspace, libs, "g_unichar_isspace", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIstitle, libs, "g_unichar_istitle", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIsupper, libs, "g_unichar_isupper", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIswide, libs, "g_unichar_iswide", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIswideCjk, libs, "g_unichar_iswide_cjk", "GLIB", "2.12")
+	core.PuregoRegisterSince(&xUnicharIsxdigit, libs, "g_unichar_isxdigit", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharIszerowidth, libs, "g_unichar_iszerowidth", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xUnicharToUtf8, libs, "g_unichar_to_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharTolower, libs, "g_unichar_tolower", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharTotitle, libs, "g_unichar_totitle", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharToupper, libs, "g_unichar_toupper", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharType, libs, "g_unichar_type", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharValidate, libs, "g_unichar_validate", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicharXdigitValue, libs, "g_unichar_xdigit_value", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicodeCanonicalOrdering, libs, "g_unicode_canonical_ordering", "GLIB", "")
+	core.PuregoRegisterSince(&xUnicodeScriptFromIso15924, libs, "g_unicode_script_from_iso15924", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUnicodeScriptToIso15924, libs, "g_unicode_script_to_iso15924", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUtf16ToUcs4, libs, "g_utf16_to_ucs4", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf16ToUtf8, libs, "g_utf16_to_utf8", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Casefold, libs, "g_utf8_casefold", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Collate, libs, "g_utf8_collate", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8CollateKey, libs, "g_utf8_collate_key", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8CollateKeyForFilename, libs, "g_utf8_collate_key_for_filename", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xUtf8FindNextChar, libs, "g_utf8_find_next_char", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8FindPrevChar, libs, "g_utf8_find_prev_char", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8GetChar, libs, "g_utf8_get_char", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8GetCharValidated, libs, "g_utf8_get_char_validated", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8MakeValid, libs, "g_utf8_make_valid", "GLIB", "2.52")
+	core.PuregoRegisterSince(&xUtf8Normalize, libs, "g_utf8_normalize", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8OffsetToPointer, libs, "g_utf8_offset_to_pointer", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8PointerToOffset, libs, "g_utf8_pointer_to_offset", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8PrevChar, libs, "g_utf8_prev_char", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strchr, libs, "g_utf8_strchr", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strdown, libs, "g_utf8_strdown", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strlen, libs, "g_utf8_strlen", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strncpy, libs, "g_utf8_strncpy", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strrchr, libs, "g_utf8_strrchr", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Strreverse, libs, "g_utf8_strreverse", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xUtf8Strup, libs, "g_utf8_strup", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8Substring, libs, "g_utf8_substring", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xUtf8ToUcs4, libs, "g_utf8_to_ucs4", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8ToUcs4Fast, libs, "g_utf8_to_ucs4_fast", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8ToUtf16, libs, "g_utf8_to_utf16", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8TruncateMiddle, libs, "g_utf8_truncate_middle", "GLIB", "2.78")
+	core.PuregoRegisterSince(&xUtf8Validate, libs, "g_utf8_validate", "GLIB", "")
+	core.PuregoRegisterSince(&xUtf8ValidateLen, libs, "g_utf8_validate_len", "GLIB", "2.60")
 
 }
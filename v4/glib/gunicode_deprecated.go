@@ -0,0 +1,38 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xUnicodeCanonicalDecomposition func(rune, uint) uintptr
+
+// Computes the canonical decomposition of a Unicode character.
+//
+// Deprecated: since 2.30. Use the more flexible [UnicharFullyDecompose]
+//
+//	instead.
+func UnicodeCanonicalDecomposition(ChVar rune, ResultLenVar uint) uintptr {
+
+	cret := xUnicodeCanonicalDecomposition(ChVar, ResultLenVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xUnicodeCanonicalDecomposition, libs, "g_unicode_canonical_decomposition", "GLIB", "")
+
+}
@@ -2,10 +2,11 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -14,13 +15,13 @@ import (
 // their components, and build valid URIs from individual components.
 //
 // Since `GUri` only represents absolute URIs, all `GUri`s will have a
-// URI scheme, so [method@GLib.Uri.get_scheme] will always return a non-`NULL`
+// URI scheme, so [Uri.GetScheme] will always return a non-`NULL`
 // answer. Likewise, by definition, all URIs have a path component, so
-// [method@GLib.Uri.get_path] will always return a non-`NULL` string (which may
+// [Uri.GetPath] will always return a non-`NULL` string (which may
 // be empty).
 //
 // If the URI string has an
-// [‘authority’ component](https://tools.ietf.org/html/rfc3986#section-3) (that
+// [‘authority’ component] (that
 // is, if the scheme is followed by `://` rather than just `:`), then the
 // `GUri` will contain a hostname, and possibly a port and ‘userinfo’.
 // Additionally, depending on how the `GUri` was constructed/parsed (for example,
@@ -38,51 +39,45 @@ import (
 //
 // For example, with the encoded flag:
 //
-// ```c
-// g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue", G_URI_FLAGS_ENCODED, &amp;err);
-// g_assert_cmpstr (g_uri_get_query (uri), ==, "query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue");
-// ```
+//	g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue", G_URI_FLAGS_ENCODED, &err);
+//	g_assert_cmpstr (g_uri_get_query (uri), ==, "query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue");
 //
 // While the default `%`-decoding behaviour would give:
 //
-// ```c
-// g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue", G_URI_FLAGS_NONE, &amp;err);
-// g_assert_cmpstr (g_uri_get_query (uri), ==, "query=http://host/path?param=value");
-// ```
+//	g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fparam%3Dvalue", G_URI_FLAGS_NONE, &err);
+//	g_assert_cmpstr (g_uri_get_query (uri), ==, "query=http://host/path?param=value");
 //
 // During decoding, if an invalid UTF-8 string is encountered, parsing will fail
 // with an error indicating the bad string location:
 //
-// ```c
-// g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fbad%3D%00alue", G_URI_FLAGS_NONE, &amp;err);
-// g_assert_error (err, G_URI_ERROR, G_URI_ERROR_BAD_QUERY);
-// ```
+//	g_autoptr(GUri) uri = g_uri_parse ("http://host/path?query=http%3A%2F%2Fhost%2Fpath%3Fbad%3D%00alue", G_URI_FLAGS_NONE, &err);
+//	g_assert_error (err, G_URI_ERROR, G_URI_ERROR_BAD_QUERY);
 //
 // You should pass `G_URI_FLAGS_ENCODED` or `G_URI_FLAGS_ENCODED_QUERY` if you
 // need to handle that case manually. In particular, if the query string
 // contains `=` characters that are `%`-encoded, you should let
-// [func@GLib.Uri.parse_params] do the decoding once of the query.
+// [UriParseParams] do the decoding once of the query.
 //
 // `GUri` is immutable once constructed, and can safely be accessed from
 // multiple threads. Its reference counting is atomic.
 //
 // Note that the scope of `GUri` is to help manipulate URIs in various applications,
-// following [RFC 3986](https://tools.ietf.org/html/rfc3986). In particular,
+// following [RFC 3986]. In particular,
 // it doesn't intend to cover web browser needs, and doesn’t implement the
-// [WHATWG URL](https://url.spec.whatwg.org/) standard. No APIs are provided to
+// [WHATWG URL] standard. No APIs are provided to
 // help prevent
-// [homograph attacks](https://en.wikipedia.org/wiki/IDN_homograph_attack), so
+// [homograph attacks], so
 // `GUri` is not suitable for formatting URIs for display to the user for making
 // security-sensitive decisions.
 //
-// ## Relative and absolute URIs
+// # Relative and absolute URIs
 //
-// As defined in [RFC 3986](https://tools.ietf.org/html/rfc3986#section-4), the
+// As defined in [RFC 3986], the
 // hierarchical nature of URIs means that they can either be ‘relative
 // references’ (sometimes referred to as ‘relative URIs’) or ‘URIs’ (for
 // clarity, ‘URIs’ are referred to in this documentation as
 // ‘absolute URIs’ — although
-// [in contrast to RFC 3986](https://tools.ietf.org/html/rfc3986#section-4.3),
+// [in contrast to RFC 3986],
 // fragment identifiers are always allowed).
 //
 // Relative references have one or more components of the URI missing. In
@@ -95,7 +90,7 @@ import (
 //
 // Absolute URIs have a scheme specified. Any other components of the URI which
 // are missing are specified as explicitly unset in the URI, rather than being
-// resolved relative to a base URI using [method@GLib.Uri.parse_relative].
+// resolved relative to a base URI using [Uri.ParseRelative].
 //
 // For example, a valid absolute URI is `file:///home/bob` or
 // `https://search.com?query=string`.
@@ -104,73 +99,79 @@ import (
 // or a relative reference; see the documentation for individual functions as to
 // what forms they accept.
 //
-// ## Parsing URIs
+// # Parsing URIs
 //
-// The most minimalist APIs for parsing URIs are [func@GLib.Uri.split] and
-// [func@GLib.Uri.split_with_user]. These split a URI into its component
+// The most minimalist APIs for parsing URIs are [UriSplit] and
+// [UriSplitWithUser]. These split a URI into its component
 // parts, and return the parts; the difference between the two is that
-// [func@GLib.Uri.split] treats the ‘userinfo’ component of the URI as a
-// single element, while [func@GLib.Uri.split_with_user] can (depending on the
-// [flags@GLib.UriFlags] you pass) treat it as containing a username, password,
-// and authentication parameters. Alternatively, [func@GLib.Uri.split_network]
+// [UriSplit] treats the ‘userinfo’ component of the URI as a
+// single element, while [UriSplitWithUser] can (depending on the
+// [UriFlags] you pass) treat it as containing a username, password,
+// and authentication parameters. Alternatively, [UriSplitNetwork]
 // can be used when you are only interested in the components that are
 // needed to initiate a network connection to the service (scheme,
 // host, and port).
 //
-// [func@GLib.Uri.parse] is similar to [func@GLib.Uri.split], but instead of
+// [UriParse] is similar to [UriSplit], but instead of
 // returning individual strings, it returns a `GUri` structure (and it requires
 // that the URI be an absolute URI).
 //
-// [func@GLib.Uri.resolve_relative] and [method@GLib.Uri.parse_relative] allow
+// [UriResolveRelative] and [Uri.ParseRelative] allow
 // you to resolve a relative URI relative to a base URI.
-// [func@GLib.Uri.resolve_relative] takes two strings and returns a string,
-// and [method@GLib.Uri.parse_relative] takes a `GUri` and a string and returns a
+// [UriResolveRelative] takes two strings and returns a string,
+// and [Uri.ParseRelative] takes a `GUri` and a string and returns a
 // `GUri`.
 //
-// All of the parsing functions take a [flags@GLib.UriFlags] argument describing
+// All of the parsing functions take a [UriFlags] argument describing
 // exactly how to parse the URI; see the documentation for that type
 // for more details on the specific flags that you can pass. If you
 // need to choose different flags based on the type of URI, you can
-// use [func@GLib.Uri.peek_scheme] on the URI string to check the scheme
+// use [UriPeekScheme] on the URI string to check the scheme
 // first, and use that to decide what flags to parse it with.
 //
 // For example, you might want to use `G_URI_PARAMS_WWW_FORM` when parsing the
-// params for a web URI, so compare the result of [func@GLib.Uri.peek_scheme]
+// params for a web URI, so compare the result of [UriPeekScheme]
 // against `http` and `https`.
 //
-// ## Building URIs
+// # Building URIs
 //
-// [func@GLib.Uri.join] and [func@GLib.Uri.join_with_user] can be used to construct
+// [UriJoin] and [UriJoinWithUser] can be used to construct
 // valid URI strings from a set of component strings. They are the
-// inverse of [func@GLib.Uri.split] and [func@GLib.Uri.split_with_user].
+// inverse of [UriSplit] and [UriSplitWithUser].
 //
-// Similarly, [func@GLib.Uri.build] and [func@GLib.Uri.build_with_user] can be
+// Similarly, [UriBuild] and [UriBuildWithUser] can be
 // used to construct a `GUri` from a set of component strings.
 //
 // As with the parsing functions, the building functions take a
-// [flags@GLib.UriFlags] argument. In particular, it is important to keep in mind
+// [UriFlags] argument. In particular, it is important to keep in mind
 // whether the URI components you are using are already `%`-encoded. If so,
 // you must pass the `G_URI_FLAGS_ENCODED` flag.
 //
-// ## `file://` URIs
+// # `file://` URIs
 //
 // Note that Windows and Unix both define special rules for parsing
 // `file://` URIs (involving non-UTF-8 character sets on Unix, and the
 // interpretation of path separators on Windows). `GUri` does not
-// implement these rules. Use [func@GLib.filename_from_uri] and
-// [func@GLib.filename_to_uri] if you want to properly convert between
+// implement these rules. Use [FilenameFromUri] and
+// [FilenameToUri] if you want to properly convert between
 // `file://` URIs and local filenames.
 //
-// ## URI Equality
+// # URI Equality
 //
 // Note that there is no `g_uri_equal ()` function, because comparing
 // URIs usefully requires scheme-specific knowledge that `GUri` does
 // not have. `GUri` can help with normalization if you use the various
-// encoded [flags@GLib.UriFlags] as well as `G_URI_FLAGS_SCHEME_NORMALIZE`
+// encoded [UriFlags] as well as `G_URI_FLAGS_SCHEME_NORMALIZE`
 // however it is not comprehensive.
 // For example, `data:,foo` and `data:;base64,Zm9v` resolve to the same
 // thing according to the `data:` URI specification which GLib does not
 // handle.
+//
+// [‘authority’ component]: https://tools.ietf.org/html/rfc3986#section-3
+// [RFC 3986]: https://tools.ietf.org/html/rfc3986
+// [WHATWG URL]: https://url.spec.whatwg.org/
+// [homograph attacks]: https://en.wikipedia.org/wiki/IDN_homograph_attack
+// [in contrast to RFC 3986]: https://tools.ietf.org/html/rfc3986#section-4.3
 type Uri struct {
 	_ structs.HostLayout
 }
@@ -187,12 +188,12 @@ func (x *Uri) GoPointer() uintptr {
 
 var xUriGetAuthParams func(uintptr) string
 
-// Gets @uri's authentication parameters, which may contain
-// `%`-encoding, depending on the flags with which @uri was created.
-// (If @uri was not created with %G_URI_FLAGS_HAS_AUTH_PARAMS then this will
-// be %NULL.)
+// Gets uri's authentication parameters, which may contain
+// `%`-encoding, depending on the flags with which uri was created.
+// (If uri was not created with [GUriFlagsHasAuthParamsValue] then this will
+// be nil.)
 //
-// Depending on the URI scheme, g_uri_parse_params() may be useful for
+// Depending on the URI scheme, [UriParseParams] may be useful for
 // further parsing this information.
 func (x *Uri) GetAuthParams() string {
 
@@ -202,7 +203,7 @@ func (x *Uri) GetAuthParams() string {
 
 var xUriGetFlags func(uintptr) UriFlags
 
-// Gets @uri's flags set upon construction.
+// Gets uri's flags set upon construction.
 func (x *Uri) GetFlags() UriFlags {
 
 	cret := xUriGetFlags(x.GoPointer())
@@ -211,8 +212,8 @@ func (x *Uri) GetFlags() UriFlags {
 
 var xUriGetFragment func(uintptr) string
 
-// Gets @uri's fragment, which may contain `%`-encoding, depending on
-// the flags with which @uri was created.
+// Gets uri's fragment, which may contain `%`-encoding, depending on
+// the flags with which uri was created.
 func (x *Uri) GetFragment() string {
 
 	cret := xUriGetFragment(x.GoPointer())
@@ -221,11 +222,11 @@ func (x *Uri) GetFragment() string {
 
 var xUriGetHost func(uintptr) string
 
-// Gets @uri's host. This will never have `%`-encoded characters,
-// unless it is non-UTF-8 (which can only be the case if @uri was
-// created with %G_URI_FLAGS_NON_DNS).
+// Gets uri's host. This will never have `%`-encoded characters,
+// unless it is non-UTF-8 (which can only be the case if uri was
+// created with [GUriFlagsNonDnsValue]).
 //
-// If @uri contained an IPv6 address literal, this value will be just
+// If uri contained an IPv6 address literal, this value will be just
 // that address, without the brackets around it that are necessary in
 // the string form of the URI. Note that in this case there may also
 // be a scope ID attached to the address. Eg, `fe80::1234%“em1` (or
@@ -238,9 +239,9 @@ func (x *Uri) GetHost() string {
 
 var xUriGetPassword func(uintptr) string
 
-// Gets @uri's password, which may contain `%`-encoding, depending on
-// the flags with which @uri was created. (If @uri was not created
-// with %G_URI_FLAGS_HAS_PASSWORD then this will be %NULL.)
+// Gets uri's password, which may contain `%`-encoding, depending on
+// the flags with which uri was created. (If uri was not created
+// with [GUriFlagsHasPasswordValue] then this will be nil.)
 func (x *Uri) GetPassword() string {
 
 	cret := xUriGetPassword(x.GoPointer())
@@ -249,8 +250,8 @@ func (x *Uri) GetPassword() string {
 
 var xUriGetPath func(uintptr) string
 
-// Gets @uri's path, which may contain `%`-encoding, depending on the
-// flags with which @uri was created.
+// Gets uri's path, which may contain `%`-encoding, depending on the
+// flags with which uri was created.
 func (x *Uri) GetPath() string {
 
 	cret := xUriGetPath(x.GoPointer())
@@ -259,7 +260,7 @@ func (x *Uri) GetPath() string {
 
 var xUriGetPort func(uintptr) int
 
-// Gets @uri's port.
+// Gets uri's port.
 func (x *Uri) GetPort() int {
 
 	cret := xUriGetPort(x.GoPointer())
@@ -268,11 +269,11 @@ func (x *Uri) GetPort() int {
 
 var xUriGetQuery func(uintptr) string
 
-// Gets @uri's query, which may contain `%`-encoding, depending on the
-// flags with which @uri was created.
+// Gets uri's query, which may contain `%`-encoding, depending on the
+// flags with which uri was created.
 //
 // For queries consisting of a series of `name=value` parameters,
-// #GUriParamsIter or g_uri_parse_params() may be useful.
+// [UriParamsIter] or [UriParseParams] may be useful.
 func (x *Uri) GetQuery() string {
 
 	cret := xUriGetQuery(x.GoPointer())
@@ -281,8 +282,8 @@ func (x *Uri) GetQuery() string {
 
 var xUriGetScheme func(uintptr) string
 
-// Gets @uri's scheme. Note that this will always be all-lowercase,
-// regardless of the string or strings that @uri was created from.
+// Gets uri's scheme. Note that this will always be all-lowercase,
+// regardless of the string or strings that uri was created from.
 func (x *Uri) GetScheme() string {
 
 	cret := xUriGetScheme(x.GoPointer())
@@ -291,10 +292,10 @@ func (x *Uri) GetScheme() string {
 
 var xUriGetUser func(uintptr) string
 
-// Gets the ‘username’ component of @uri's userinfo, which may contain
-// `%`-encoding, depending on the flags with which @uri was created.
-// If @uri was not created with %G_URI_FLAGS_HAS_PASSWORD or
-// %G_URI_FLAGS_HAS_AUTH_PARAMS, this is the same as g_uri_get_userinfo().
+// Gets the ‘username’ component of uri's userinfo, which may contain
+// `%`-encoding, depending on the flags with which uri was created.
+// If uri was not created with [GUriFlagsHasPasswordValue] or
+// [GUriFlagsHasAuthParamsValue], this is the same as [Uri.GetUserinfo].
 func (x *Uri) GetUser() string {
 
 	cret := xUriGetUser(x.GoPointer())
@@ -303,8 +304,8 @@ func (x *Uri) GetUser() string {
 
 var xUriGetUserinfo func(uintptr) string
 
-// Gets @uri's userinfo, which may contain `%`-encoding, depending on
-// the flags with which @uri was created.
+// Gets uri's userinfo, which may contain `%`-encoding, depending on
+// the flags with which uri was created.
 func (x *Uri) GetUserinfo() string {
 
 	cret := xUriGetUserinfo(x.GoPointer())
@@ -313,8 +314,8 @@ func (x *Uri) GetUserinfo() string {
 
 var xUriParseRelative func(uintptr, string, UriFlags, **Error) *Uri
 
-// Parses @uri_ref according to @flags and, if it is a
-// [relative URI](#relative-and-absolute-uris), resolves it relative to @base_uri.
+// Parses uri_ref according to flags and, if it is a
+// relative URI, resolves it relative to base_uri.
 // If the result is not a valid absolute URI, it will be discarded, and an error
 // returned.
 func (x *Uri) ParseRelative(UriRefVar string, FlagsVar UriFlags) (*Uri, error) {
@@ -322,7 +323,7 @@ func (x *Uri) ParseRelative(UriRefVar string, FlagsVar UriFlags) (*Uri, error) {
 
 	cret := xUriParseRelative(x.GoPointer(), UriRefVar, FlagsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -330,7 +331,7 @@ func (x *Uri) ParseRelative(UriRefVar string, FlagsVar UriFlags) (*Uri, error) {
 
 var xUriRef func(uintptr) *Uri
 
-// Increments the reference count of @uri by one.
+// Increments the reference count of uri by one.
 func (x *Uri) Ref() *Uri {
 
 	cret := xUriRef(x.GoPointer())
@@ -339,18 +340,18 @@ func (x *Uri) Ref() *Uri {
 
 var xUriToString func(uintptr) string
 
-// Returns a string representing @uri.
+// Returns a string representing uri.
 //
 // This is not guaranteed to return a string which is identical to the
-// string that @uri was parsed from. However, if the source URI was
+// string that uri was parsed from. However, if the source URI was
 // syntactically correct (according to RFC 3986), and it was parsed
-// with %G_URI_FLAGS_ENCODED, then g_uri_to_string() is guaranteed to return
+// with [GUriFlagsEncodedValue], then [Uri.ToString] is guaranteed to return
 // a string which is at least semantically equivalent to the source
 // URI (according to RFC 3986).
 //
-// If @uri might contain sensitive details, such as authentication parameters,
+// If uri might contain sensitive details, such as authentication parameters,
 // or private data in its query string, and the returned string is going to be
-// logged, then consider using g_uri_to_string_partial() to redact parts.
+// logged, then consider using [Uri.ToStringPartial] to redact parts.
 func (x *Uri) ToString() string {
 
 	cret := xUriToString(x.GoPointer())
@@ -359,8 +360,8 @@ func (x *Uri) ToString() string {
 
 var xUriToStringPartial func(uintptr, UriHideFlags) string
 
-// Returns a string representing @uri, subject to the options in
-// @flags. See g_uri_to_string() and #GUriHideFlags for more details.
+// Returns a string representing uri, subject to the options in
+// flags. See [Uri.ToString] and [UriHideFlags] for more details.
 func (x *Uri) ToStringPartial(FlagsVar UriHideFlags) string {
 
 	cret := xUriToStringPartial(x.GoPointer(), FlagsVar)
@@ -369,10 +370,10 @@ func (x *Uri) ToStringPartial(FlagsVar UriHideFlags) string {
 
 var xUriUnref func(uintptr)
 
-// Atomically decrements the reference count of @uri by one.
+// Atomically decrements the reference count of uri by one.
 //
 // When the reference count reaches zero, the resources allocated by
-// @uri are freed
+// uri are freed
 func (x *Uri) Unref() {
 
 	xUriUnref(x.GoPointer())
@@ -380,13 +381,13 @@ func (x *Uri) Unref() {
 }
 
 // Many URI schemes include one or more attribute/value pairs as part of the URI
-// value. For example `scheme://server/path?query=string&amp;is=there` has two
+// value. For example `scheme://server/path?query=string&is=there` has two
 // attributes – `query=string` and `is=there` – in its query part.
 //
-// A #GUriParamsIter structure represents an iterator that can be used to
-// iterate over the attribute/value pairs of a URI query string. #GUriParamsIter
+// A [UriParamsIter] structure represents an iterator that can be used to
+// iterate over the attribute/value pairs of a URI query string. [UriParamsIter]
 // structures are typically allocated on the stack and then initialized with
-// g_uri_params_iter_init(). See the documentation for g_uri_params_iter_init()
+// [UriParamsIter.Init]. See the documentation for [UriParamsIter.Init]
 // for a usage example.
 type UriParamsIter struct {
 	_ structs.HostLayout
@@ -408,41 +409,35 @@ var xUriParamsIterInit func(uintptr, string, int, string, UriParamsFlags)
 
 // Initializes an attribute/value pair iterator.
 //
-// The iterator keeps pointers to the @params and @separators arguments, those
+// The iterator keeps pointers to the params and separators arguments, those
 // variables must thus outlive the iterator and not be modified during the
 // iteration.
 //
-// If %G_URI_PARAMS_WWW_FORM is passed in @flags, `+` characters in the param
+// If [GUriParamsWwwFormValue] is passed in flags, `+` characters in the param
 // string will be replaced with spaces in the output. For example, `foo=bar+baz`
 // will give attribute `foo` with value `bar baz`. This is commonly used on the
 // web (the `https` and `http` schemes only), but is deprecated in favour of
 // the equivalent of encoding spaces as `%20`.
 //
-// Unlike with g_uri_parse_params(), %G_URI_PARAMS_CASE_INSENSITIVE has no
-// effect if passed to @flags for g_uri_params_iter_init(). The caller is
+// Unlike with [UriParseParams], [GUriParamsCaseInsensitiveValue] has no
+// effect if passed to flags for [UriParamsIter.Init]. The caller is
 // responsible for doing their own case-insensitive comparisons.
 //
-// |[&lt;!-- language="C" --&gt;
-// GUriParamsIter iter;
-// GError *error = NULL;
-// gchar *unowned_attr, *unowned_value;
-//
-// g_uri_params_iter_init (&amp;iter, "foo=bar&amp;baz=bar&amp;Foo=frob&amp;baz=bar2", -1, "&amp;", G_URI_PARAMS_NONE);
-// while (g_uri_params_iter_next (&amp;iter, &amp;unowned_attr, &amp;unowned_value, &amp;error))
-//
-//	{
-//	  g_autofree gchar *attr = g_steal_pointer (&amp;unowned_attr);
-//	  g_autofree gchar *value = g_steal_pointer (&amp;unowned_value);
-//	  // do something with attr and value; this code will be called 4 times
-//	  // for the params string in this example: once with attr=foo and value=bar,
-//	  // then with baz/bar, then Foo/frob, then baz/bar2.
-//	}
-//
-// if (error)
-//
-//	// handle parsing error
-//
-// ]|
+//	GUriParamsIter iter;
+//	GError *error = NULL;
+//	gchar *unowned_attr, *unowned_value;
+//
+//	g_uri_params_iter_init (&iter, "foo=bar&baz=bar&Foo=frob&baz=bar2", -1, "&", G_URI_PARAMS_NONE);
+//	while (g_uri_params_iter_next (&iter, &unowned_attr, &unowned_value, &error))
+//	  {
+//	    g_autofree gchar *attr = g_steal_pointer (&unowned_attr);
+//	    g_autofree gchar *value = g_steal_pointer (&unowned_value);
+//	    // do something with attr and value; this code will be called 4 times
+//	    // for the params string in this example: once with attr=foo and value=bar,
+//	    // then with baz/bar, then Foo/frob, then baz/bar2.
+//	  }
+//	if (error)
+//	  // handle parsing error
 func (x *UriParamsIter) Init(ParamsVar string, LengthVar int, SeparatorsVar string, FlagsVar UriParamsFlags) {
 
 	xUriParamsIterInit(x.GoPointer(), ParamsVar, LengthVar, SeparatorsVar, FlagsVar)
@@ -451,21 +446,21 @@ func (x *UriParamsIter) Init(ParamsVar string, LengthVar int, SeparatorsVar stri
 
 var xUriParamsIterNext func(uintptr, *string, *string, **Error) bool
 
-// Advances @iter and retrieves the next attribute/value. %FALSE is returned if
-// an error has occurred (in which case @error is set), or if the end of the
-// iteration is reached (in which case @attribute and @value are set to %NULL
-// and the iterator becomes invalid). If %TRUE is returned,
-// g_uri_params_iter_next() may be called again to receive another
+// Advances iter and retrieves the next attribute/value. false is returned if
+// an error has occurred (in which case error is set), or if the end of the
+// iteration is reached (in which case attribute and value are set to nil
+// and the iterator becomes invalid). If true is returned,
+// [UriParamsIter.Next] may be called again to receive another
 // attribute/value pair.
 //
-// Note that the same @attribute may be returned multiple times, since URIs
+// Note that the same attribute may be returned multiple times, since URIs
 // allow repeated attributes.
 func (x *UriParamsIter) Next(AttributeVar *string, ValueVar *string) (bool, error) {
 	var cerr *Error
 
 	cret := xUriParamsIterNext(x.GoPointer(), AttributeVar, ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -473,30 +468,36 @@ func (x *UriParamsIter) Next(AttributeVar *string, ValueVar *string) (bool, erro
 
 const (
 	// Generic delimiters characters as defined in
-	// [RFC 3986](https://tools.ietf.org/html/rfc3986). Includes `:/?#[]@`.
+	// [RFC 3986]. Includes `:/?#[]@`.
+	//
+	// [RFC 3986]: https://tools.ietf.org/html/rfc3986
 	URI_RESERVED_CHARS_GENERIC_DELIMITERS string = ":/?#[]@"
 	// Subcomponent delimiter characters as defined in
-	// [RFC 3986](https://tools.ietf.org/html/rfc3986). Includes `!$&amp;'()*+,;=`.
+	// [RFC 3986]. Includes `!$&'()*+,;=`.
+	//
+	// [RFC 3986]: https://tools.ietf.org/html/rfc3986
 	URI_RESERVED_CHARS_SUBCOMPONENT_DELIMITERS string = "!$&'()*+,;="
 )
 
 // Flags that describe a URI.
 //
 // When parsing a URI, if you need to choose different flags based on
-// the type of URI, you can use g_uri_peek_scheme() on the URI string
+// the type of URI, you can use [UriPeekScheme] on the URI string
 // to check the scheme first, and use that to decide what flags to
 // parse it with.
-type UriFlags int
+type UriFlags uint32
 
 const (
 
 	// No flags set.
 	GUriFlagsNoneValue UriFlags = 0
 	// Parse the URI more relaxedly than the
-	//     [RFC 3986](https://tools.ietf.org/html/rfc3986) grammar specifies,
+	//     [RFC 3986] grammar specifies,
 	//     fixing up or ignoring common mistakes in URIs coming from external
 	//     sources. This is also needed for some obscure URI schemes where `;`
 	//     separates the host from the path. Don’t use this flag unless you need to.
+	//
+	// [RFC 3986]: https://tools.ietf.org/html/rfc3986
 	GUriFlagsParseRelaxedValue UriFlags = 1
 	// The userinfo field may contain a password,
 	//     which will be separated from the username by `:`.
@@ -508,20 +509,20 @@ const (
 	// When parsing a URI, this indicates that `%`-encoded
 	//     characters in the userinfo, path, query, and fragment fields
 	//     should not be decoded. (And likewise the host field if
-	//     %G_URI_FLAGS_NON_DNS is also set.) When building a URI, it indicates
-	//     that you have already `%`-encoded the components, and so #GUri
+	//     [GUriFlagsNonDnsValue] is also set.) When building a URI, it indicates
+	//     that you have already `%`-encoded the components, and so [Uri]
 	//     should not do any encoding itself.
 	GUriFlagsEncodedValue UriFlags = 8
 	// The host component should not be assumed to be a
 	//     DNS hostname or IP address (for example, for `smb` URIs with NetBIOS
 	//     hostnames).
 	GUriFlagsNonDnsValue UriFlags = 16
-	// Same as %G_URI_FLAGS_ENCODED, for the query
+	// Same as [GUriFlagsEncodedValue], for the query
 	//     field only.
 	GUriFlagsEncodedQueryValue UriFlags = 32
-	// Same as %G_URI_FLAGS_ENCODED, for the path only.
+	// Same as [GUriFlagsEncodedValue], for the path only.
 	GUriFlagsEncodedPathValue UriFlags = 64
-	// Same as %G_URI_FLAGS_ENCODED, for the
+	// Same as [GUriFlagsEncodedValue], for the
 	//     fragment only.
 	GUriFlagsEncodedFragmentValue UriFlags = 128
 	// A scheme-based normalization will be applied.
@@ -531,11 +532,75 @@ const (
 	GUriFlagsSchemeNormalizeValue UriFlags = 256
 )
 
+// Has reports whether all bits of flags are set in x
+func (x UriFlags) Has(flags UriFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x UriFlags) Set(flags UriFlags) UriFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x UriFlags) Clear(flags UriFlags) UriFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x UriFlags) String() string {
+	if x == 0 {
+		return "G_URI_FLAGS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GUriFlagsParseRelaxedValue != 0 && x&GUriFlagsParseRelaxedValue == GUriFlagsParseRelaxedValue {
+		names = append(names, "G_URI_FLAGS_PARSE_RELAXED")
+		rest &^= GUriFlagsParseRelaxedValue
+	}
+	if rest&GUriFlagsHasPasswordValue != 0 && x&GUriFlagsHasPasswordValue == GUriFlagsHasPasswordValue {
+		names = append(names, "G_URI_FLAGS_HAS_PASSWORD")
+		rest &^= GUriFlagsHasPasswordValue
+	}
+	if rest&GUriFlagsHasAuthParamsValue != 0 && x&GUriFlagsHasAuthParamsValue == GUriFlagsHasAuthParamsValue {
+		names = append(names, "G_URI_FLAGS_HAS_AUTH_PARAMS")
+		rest &^= GUriFlagsHasAuthParamsValue
+	}
+	if rest&GUriFlagsEncodedValue != 0 && x&GUriFlagsEncodedValue == GUriFlagsEncodedValue {
+		names = append(names, "G_URI_FLAGS_ENCODED")
+		rest &^= GUriFlagsEncodedValue
+	}
+	if rest&GUriFlagsNonDnsValue != 0 && x&GUriFlagsNonDnsValue == GUriFlagsNonDnsValue {
+		names = append(names, "G_URI_FLAGS_NON_DNS")
+		rest &^= GUriFlagsNonDnsValue
+	}
+	if rest&GUriFlagsEncodedQueryValue != 0 && x&GUriFlagsEncodedQueryValue == GUriFlagsEncodedQueryValue {
+		names = append(names, "G_URI_FLAGS_ENCODED_QUERY")
+		rest &^= GUriFlagsEncodedQueryValue
+	}
+	if rest&GUriFlagsEncodedPathValue != 0 && x&GUriFlagsEncodedPathValue == GUriFlagsEncodedPathValue {
+		names = append(names, "G_URI_FLAGS_ENCODED_PATH")
+		rest &^= GUriFlagsEncodedPathValue
+	}
+	if rest&GUriFlagsEncodedFragmentValue != 0 && x&GUriFlagsEncodedFragmentValue == GUriFlagsEncodedFragmentValue {
+		names = append(names, "G_URI_FLAGS_ENCODED_FRAGMENT")
+		rest &^= GUriFlagsEncodedFragmentValue
+	}
+	if rest&GUriFlagsSchemeNormalizeValue != 0 && x&GUriFlagsSchemeNormalizeValue == GUriFlagsSchemeNormalizeValue {
+		names = append(names, "G_URI_FLAGS_SCHEME_NORMALIZE")
+		rest &^= GUriFlagsSchemeNormalizeValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Flags describing what parts of the URI to hide in
-// g_uri_to_string_partial(). Note that %G_URI_HIDE_PASSWORD and
-// %G_URI_HIDE_AUTH_PARAMS will only work if the #GUri was parsed with
+// [Uri.ToStringPartial]. Note that [GUriHidePasswordValue] and
+// [GUriHideAuthParamsValue] will only work if the [Uri] was parsed with
 // the corresponding flags.
-type UriHideFlags int
+type UriHideFlags uint32
 
 const (
 
@@ -553,9 +618,57 @@ const (
 	GUriHideFragmentValue UriHideFlags = 16
 )
 
-// Flags modifying the way parameters are handled by g_uri_parse_params() and
-// #GUriParamsIter.
-type UriParamsFlags int
+// Has reports whether all bits of flags are set in x
+func (x UriHideFlags) Has(flags UriHideFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x UriHideFlags) Set(flags UriHideFlags) UriHideFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x UriHideFlags) Clear(flags UriHideFlags) UriHideFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x UriHideFlags) String() string {
+	if x == 0 {
+		return "G_URI_HIDE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GUriHideUserinfoValue != 0 && x&GUriHideUserinfoValue == GUriHideUserinfoValue {
+		names = append(names, "G_URI_HIDE_USERINFO")
+		rest &^= GUriHideUserinfoValue
+	}
+	if rest&GUriHidePasswordValue != 0 && x&GUriHidePasswordValue == GUriHidePasswordValue {
+		names = append(names, "G_URI_HIDE_PASSWORD")
+		rest &^= GUriHidePasswordValue
+	}
+	if rest&GUriHideAuthParamsValue != 0 && x&GUriHideAuthParamsValue == GUriHideAuthParamsValue {
+		names = append(names, "G_URI_HIDE_AUTH_PARAMS")
+		rest &^= GUriHideAuthParamsValue
+	}
+	if rest&GUriHideQueryValue != 0 && x&GUriHideQueryValue == GUriHideQueryValue {
+		names = append(names, "G_URI_HIDE_QUERY")
+		rest &^= GUriHideQueryValue
+	}
+	if rest&GUriHideFragmentValue != 0 && x&GUriHideFragmentValue == GUriHideFragmentValue {
+		names = append(names, "G_URI_HIDE_FRAGMENT")
+		rest &^= GUriHideFragmentValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags modifying the way parameters are handled by [UriParseParams] and
+// [UriParamsIter].
+type UriParamsFlags uint32
 
 const (
 
@@ -566,11 +679,51 @@ const (
 	// Replace `+` with space character. Only useful for
 	//     URLs on the web, using the `https` or `http` schemas.
 	GUriParamsWwwFormValue UriParamsFlags = 2
-	// See %G_URI_FLAGS_PARSE_RELAXED.
+	// See [GUriFlagsParseRelaxedValue].
 	GUriParamsParseRelaxedValue UriParamsFlags = 4
 )
 
-// Error codes returned by #GUri methods.
+// Has reports whether all bits of flags are set in x
+func (x UriParamsFlags) Has(flags UriParamsFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x UriParamsFlags) Set(flags UriParamsFlags) UriParamsFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x UriParamsFlags) Clear(flags UriParamsFlags) UriParamsFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x UriParamsFlags) String() string {
+	if x == 0 {
+		return "G_URI_PARAMS_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GUriParamsCaseInsensitiveValue != 0 && x&GUriParamsCaseInsensitiveValue == GUriParamsCaseInsensitiveValue {
+		names = append(names, "G_URI_PARAMS_CASE_INSENSITIVE")
+		rest &^= GUriParamsCaseInsensitiveValue
+	}
+	if rest&GUriParamsWwwFormValue != 0 && x&GUriParamsWwwFormValue == GUriParamsWwwFormValue {
+		names = append(names, "G_URI_PARAMS_WWW_FORM")
+		rest &^= GUriParamsWwwFormValue
+	}
+	if rest&GUriParamsParseRelaxedValue != 0 && x&GUriParamsParseRelaxedValue == GUriParamsParseRelaxedValue {
+		names = append(names, "G_URI_PARAMS_PARSE_RELAXED")
+		rest &^= GUriParamsParseRelaxedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Error codes returned by [Uri] methods.
 type UriError int
 
 const (
@@ -598,11 +751,124 @@ const (
 	GUriErrorBadFragmentValue UriError = 9
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (UriError) ErrorDomain() string {
+	return "g-uri-quark"
+}
+
+// Error returns the C name of the error code
+func (x UriError) Error() string {
+	switch x {
+	case GUriErrorFailedValue:
+		return "G_URI_ERROR_FAILED"
+	case GUriErrorBadSchemeValue:
+		return "G_URI_ERROR_BAD_SCHEME"
+	case GUriErrorBadUserValue:
+		return "G_URI_ERROR_BAD_USER"
+	case GUriErrorBadPasswordValue:
+		return "G_URI_ERROR_BAD_PASSWORD"
+	case GUriErrorBadAuthParamsValue:
+		return "G_URI_ERROR_BAD_AUTH_PARAMS"
+	case GUriErrorBadHostValue:
+		return "G_URI_ERROR_BAD_HOST"
+	case GUriErrorBadPortValue:
+		return "G_URI_ERROR_BAD_PORT"
+	case GUriErrorBadPathValue:
+		return "G_URI_ERROR_BAD_PATH"
+	case GUriErrorBadQueryValue:
+		return "G_URI_ERROR_BAD_QUERY"
+	case GUriErrorBadFragmentValue:
+		return "G_URI_ERROR_BAD_FRAGMENT"
+	}
+	return fmt.Sprintf("g-uri-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x UriError) String() string {
+	switch x {
+	case GUriErrorFailedValue:
+		return "G_URI_ERROR_FAILED"
+	case GUriErrorBadSchemeValue:
+		return "G_URI_ERROR_BAD_SCHEME"
+	case GUriErrorBadUserValue:
+		return "G_URI_ERROR_BAD_USER"
+	case GUriErrorBadPasswordValue:
+		return "G_URI_ERROR_BAD_PASSWORD"
+	case GUriErrorBadAuthParamsValue:
+		return "G_URI_ERROR_BAD_AUTH_PARAMS"
+	case GUriErrorBadHostValue:
+		return "G_URI_ERROR_BAD_HOST"
+	case GUriErrorBadPortValue:
+		return "G_URI_ERROR_BAD_PORT"
+	case GUriErrorBadPathValue:
+		return "G_URI_ERROR_BAD_PATH"
+	case GUriErrorBadQueryValue:
+		return "G_URI_ERROR_BAD_QUERY"
+	case GUriErrorBadFragmentValue:
+		return "G_URI_ERROR_BAD_FRAGMENT"
+	}
+	return fmt.Sprintf("UriError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UriError) Nick() string {
+	switch x {
+	case GUriErrorFailedValue:
+		return "failed"
+	case GUriErrorBadSchemeValue:
+		return "bad-scheme"
+	case GUriErrorBadUserValue:
+		return "bad-user"
+	case GUriErrorBadPasswordValue:
+		return "bad-password"
+	case GUriErrorBadAuthParamsValue:
+		return "bad-auth-params"
+	case GUriErrorBadHostValue:
+		return "bad-host"
+	case GUriErrorBadPortValue:
+		return "bad-port"
+	case GUriErrorBadPathValue:
+		return "bad-path"
+	case GUriErrorBadQueryValue:
+		return "bad-query"
+	case GUriErrorBadFragmentValue:
+		return "bad-fragment"
+	}
+	return ""
+}
+
+// UriErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UriErrorFromString(s string) (UriError, bool) {
+	switch s {
+	case "G_URI_ERROR_FAILED", "failed":
+		return GUriErrorFailedValue, true
+	case "G_URI_ERROR_BAD_SCHEME", "bad-scheme":
+		return GUriErrorBadSchemeValue, true
+	case "G_URI_ERROR_BAD_USER", "bad-user":
+		return GUriErrorBadUserValue, true
+	case "G_URI_ERROR_BAD_PASSWORD", "bad-password":
+		return GUriErrorBadPasswordValue, true
+	case "G_URI_ERROR_BAD_AUTH_PARAMS", "bad-auth-params":
+		return GUriErrorBadAuthParamsValue, true
+	case "G_URI_ERROR_BAD_HOST", "bad-host":
+		return GUriErrorBadHostValue, true
+	case "G_URI_ERROR_BAD_PORT", "bad-port":
+		return GUriErrorBadPortValue, true
+	case "G_URI_ERROR_BAD_PATH", "bad-path":
+		return GUriErrorBadPathValue, true
+	case "G_URI_ERROR_BAD_QUERY", "bad-query":
+		return GUriErrorBadQueryValue, true
+	case "G_URI_ERROR_BAD_FRAGMENT", "bad-fragment":
+		return GUriErrorBadFragmentValue, true
+	}
+	return 0, false
+}
+
 var xUriBuild func(UriFlags, string, uintptr, uintptr, int, string, uintptr, uintptr) *Uri
 
-// Creates a new #GUri from the given components according to @flags.
+// Creates a new [Uri] from the given components according to flags.
 //
-// See also g_uri_build_with_user(), which allows specifying the
+// See also [UriBuildWithUser], which allows specifying the
 // components of the "userinfo" separately.
 func UriBuild(FlagsVar UriFlags, SchemeVar string, UserinfoVar *string, HostVar *string, PortVar int, PathVar string, QueryVar *string, FragmentVar *string) *Uri {
 
@@ -625,14 +891,14 @@ func UriBuild(FlagsVar UriFlags, SchemeVar string, UserinfoVar *string, HostVar
 
 var xUriBuildWithUser func(UriFlags, string, uintptr, uintptr, uintptr, uintptr, int, string, uintptr, uintptr) *Uri
 
-// Creates a new #GUri from the given components according to @flags
-// (%G_URI_FLAGS_HAS_PASSWORD is added unconditionally). The @flags must be
+// Creates a new [Uri] from the given components according to flags
+// ([GUriFlagsHasPasswordValue] is added unconditionally). The flags must be
 // coherent with the passed values, in particular use `%`-encoded values with
-// %G_URI_FLAGS_ENCODED.
+// [GUriFlagsEncodedValue].
 //
-// In contrast to g_uri_build(), this allows specifying the components
-// of the ‘userinfo’ field separately. Note that @user must be non-%NULL
-// if either @password or @auth_params is non-%NULL.
+// In contrast to [UriBuild], this allows specifying the components
+// of the ‘userinfo’ field separately. Note that user must be non-nil
+// if either password or auth_params is non-nil.
 func UriBuildWithUser(FlagsVar UriFlags, SchemeVar string, UserVar *string, PasswordVar *string, AuthParamsVar *string, HostVar *string, PortVar int, PathVar string, QueryVar *string, FragmentVar *string) *Uri {
 
 	UserVarPtr := core.GStrdupNullable(UserVar)
@@ -664,7 +930,7 @@ var xUriEscapeBytes func([]byte, uint, uintptr) string
 //
 // Normally all characters that are not ‘unreserved’ (i.e. ASCII
 // alphanumerical characters plus dash, dot, underscore and tilde) are
-// escaped. But if you specify characters in @reserved_chars_allowed
+// escaped. But if you specify characters in reserved_chars_allowed
 // they are not escaped. This is useful for the ‘reserved’ characters
 // in the URI specification, since those are allowed unescaped in some
 // portions of a URI.
@@ -687,7 +953,7 @@ var xUriEscapeString func(string, uintptr, bool) string
 //
 // Normally all characters that are not "unreserved" (i.e. ASCII
 // alphanumerical characters plus dash, dot, underscore and tilde) are
-// escaped. But if you specify characters in @reserved_chars_allowed
+// escaped. But if you specify characters in reserved_chars_allowed
 // they are not escaped. This is useful for the "reserved" characters
 // in the URI specification, since those are allowed unescaped in some
 // portions of a URI.
@@ -703,21 +969,21 @@ func UriEscapeString(UnescapedVar string, ReservedCharsAllowedVar *string, Allow
 
 var xUriIsValid func(string, UriFlags, **Error) bool
 
-// Parses @uri_string according to @flags, to determine whether it is a valid
-// [absolute URI](#relative-and-absolute-uris), i.e. it does not need to be resolved
-// relative to another URI using g_uri_parse_relative().
+// Parses uri_string according to flags, to determine whether it is a valid
+// absolute URI, i.e. it does not need to be resolved
+// relative to another URI using [Uri.ParseRelative].
 //
 // If it’s not a valid URI, an error is returned explaining how it’s invalid.
 //
-// See g_uri_split(), and the definition of #GUriFlags, for more
-// information on the effect of @flags.
+// See [UriSplit], and the definition of [UriFlags], for more
+// information on the effect of flags.
 func UriIsValid(UriStringVar string, FlagsVar UriFlags) (bool, error) {
 	var cerr *Error
 
 	cret := xUriIsValid(UriStringVar, FlagsVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -725,20 +991,22 @@ func UriIsValid(UriStringVar string, FlagsVar UriFlags) (bool, error) {
 
 var xUriJoin func(UriFlags, uintptr, uintptr, uintptr, int, string, uintptr, uintptr) string
 
-// Joins the given components together according to @flags to create
-// an absolute URI string. @path may not be %NULL (though it may be the empty
+// Joins the given components together according to flags to create
+// an absolute URI string. path may not be nil (though it may be the empty
 // string).
 //
-// When @host is present, @path must either be empty or begin with a slash (`/`)
-// character. When @host is not present, @path cannot begin with two slash
+// When host is present, path must either be empty or begin with a slash (`/`)
+// character. When host is not present, path cannot begin with two slash
 // characters (`//`). See
-// [RFC 3986, section 3](https://tools.ietf.org/html/rfc3986#section-3).
+// [RFC 3986, section 3].
 //
-// See also g_uri_join_with_user(), which allows specifying the
+// See also [UriJoinWithUser], which allows specifying the
 // components of the ‘userinfo’ separately.
 //
-// %G_URI_FLAGS_HAS_PASSWORD and %G_URI_FLAGS_HAS_AUTH_PARAMS are ignored if set
-// in @flags.
+// [GUriFlagsHasPasswordValue] and [GUriFlagsHasAuthParamsValue] are ignored if set
+// in flags.
+//
+// [RFC 3986, section 3]: https://tools.ietf.org/html/rfc3986#section-3
 func UriJoin(FlagsVar UriFlags, SchemeVar *string, UserinfoVar *string, HostVar *string, PortVar int, PathVar string, QueryVar *string, FragmentVar *string) string {
 
 	SchemeVarPtr := core.GStrdupNullable(SchemeVar)
@@ -763,15 +1031,15 @@ func UriJoin(FlagsVar UriFlags, SchemeVar *string, UserinfoVar *string, HostVar
 
 var xUriJoinWithUser func(UriFlags, uintptr, uintptr, uintptr, uintptr, uintptr, int, string, uintptr, uintptr) string
 
-// Joins the given components together according to @flags to create
-// an absolute URI string. @path may not be %NULL (though it may be the empty
+// Joins the given components together according to flags to create
+// an absolute URI string. path may not be nil (though it may be the empty
 // string).
 //
-// In contrast to g_uri_join(), this allows specifying the components
+// In contrast to [UriJoin], this allows specifying the components
 // of the ‘userinfo’ separately. It otherwise behaves the same.
 //
-// %G_URI_FLAGS_HAS_PASSWORD and %G_URI_FLAGS_HAS_AUTH_PARAMS are ignored if set
-// in @flags.
+// [GUriFlagsHasPasswordValue] and [GUriFlagsHasAuthParamsValue] are ignored if set
+// in flags.
 func UriJoinWithUser(FlagsVar UriFlags, SchemeVar *string, UserVar *string, PasswordVar *string, AuthParamsVar *string, HostVar *string, PortVar int, PathVar string, QueryVar *string, FragmentVar *string) string {
 
 	SchemeVarPtr := core.GStrdupNullable(SchemeVar)
@@ -802,8 +1070,8 @@ func UriJoinWithUser(FlagsVar UriFlags, SchemeVar *string, UserVar *string, Pass
 
 var xUriParse func(string, UriFlags, **Error) *Uri
 
-// Parses @uri_string according to @flags. If the result is not a
-// valid [absolute URI](#relative-and-absolute-uris), it will be discarded, and an
+// Parses uri_string according to flags. If the result is not a
+// valid absolute URI, it will be discarded, and an
 // error returned.
 func UriParse(UriStringVar string, FlagsVar UriFlags) (*Uri, error) {
 	var cerr *Error
@@ -811,7 +1079,7 @@ func UriParse(UriStringVar string, FlagsVar UriFlags) (*Uri, error) {
 	cret := xUriParse(UriStringVar, FlagsVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -823,33 +1091,33 @@ var xUriParseParams func(string, int, string, UriParamsFlags, **Error) *HashTabl
 // value. This method can be used to parse them into a hash table. When an
 // attribute has multiple occurrences, the last value is the final returned
 // value. If you need to handle repeated attributes differently, use
-// #GUriParamsIter.
+// [UriParamsIter].
 //
-// The @params string is assumed to still be `%`-encoded, but the returned
+// The params string is assumed to still be `%`-encoded, but the returned
 // values will be fully decoded. (Thus it is possible that the returned values
-// may contain `=` or @separators, if the value was encoded in the input.)
-// Invalid `%`-encoding is treated as with the %G_URI_FLAGS_PARSE_RELAXED
-// rules for g_uri_parse(). (However, if @params is the path or query string
-// from a #GUri that was parsed without %G_URI_FLAGS_PARSE_RELAXED and
-// %G_URI_FLAGS_ENCODED, then you already know that it does not contain any
+// may contain `=` or separators, if the value was encoded in the input.)
+// Invalid `%`-encoding is treated as with the [GUriFlagsParseRelaxedValue]
+// rules for [UriParse]. (However, if params is the path or query string
+// from a [Uri] that was parsed without [GUriFlagsParseRelaxedValue] and
+// [GUriFlagsEncodedValue], then you already know that it does not contain any
 // invalid encoding.)
 //
-// %G_URI_PARAMS_WWW_FORM is handled as documented for g_uri_params_iter_init().
+// [GUriParamsWwwFormValue] is handled as documented for [UriParamsIter.Init].
 //
-// If %G_URI_PARAMS_CASE_INSENSITIVE is passed to @flags, attributes will be
-// compared case-insensitively, so a params string `attr=123&amp;Attr=456` will only
+// If [GUriParamsCaseInsensitiveValue] is passed to flags, attributes will be
+// compared case-insensitively, so a params string `attr=123&Attr=456` will only
 // return a single attribute–value pair, `Attr=456`. Case will be preserved in
 // the returned attributes.
 //
-// If @params cannot be parsed (for example, it contains two @separators
-// characters in a row), then @error is set and %NULL is returned.
+// If params cannot be parsed (for example, it contains two separators
+// characters in a row), then error is set and nil is returned.
 func UriParseParams(ParamsVar string, LengthVar int, SeparatorsVar string, FlagsVar UriParamsFlags) (*HashTable, error) {
 	var cerr *Error
 
 	cret := xUriParseParams(ParamsVar, LengthVar, SeparatorsVar, FlagsVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -858,12 +1126,14 @@ func UriParseParams(ParamsVar string, LengthVar int, SeparatorsVar string, Flags
 var xUriParseScheme func(string) string
 
 // Gets the scheme portion of a URI string.
-// [RFC 3986](https://tools.ietf.org/html/rfc3986#section-3) decodes the scheme
+// [RFC 3986] decodes the scheme
 // as:
-// |[
-// URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
-// ]|
+//
+//	URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
+//
 // Common schemes include `file`, `https`, `svn+ssh`, etc.
+//
+// [RFC 3986]: https://tools.ietf.org/html/rfc3986#section-3
 func UriParseScheme(UriVar string) string {
 
 	cret := xUriParseScheme(UriVar)
@@ -874,15 +1144,17 @@ func UriParseScheme(UriVar string) string {
 var xUriPeekScheme func(string) string
 
 // Gets the scheme portion of a URI string.
-// [RFC 3986](https://tools.ietf.org/html/rfc3986#section-3) decodes the scheme
+// [RFC 3986] decodes the scheme
 // as:
-// |[
-// URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
-// ]|
+//
+//	URI = scheme ":" hier-part [ "?" query ] [ "#" fragment ]
+//
 // Common schemes include `file`, `https`, `svn+ssh`, etc.
 //
-// Unlike g_uri_parse_scheme(), the returned scheme is normalized to
+// Unlike [UriParseScheme], the returned scheme is normalized to
 // all-lowercase and does not need to be freed.
+//
+// [RFC 3986]: https://tools.ietf.org/html/rfc3986#section-3
 func UriPeekScheme(UriVar string) string {
 
 	cret := xUriPeekScheme(UriVar)
@@ -892,13 +1164,13 @@ func UriPeekScheme(UriVar string) string {
 
 var xUriResolveRelative func(uintptr, string, UriFlags, **Error) string
 
-// Parses @uri_ref according to @flags and, if it is a
-// [relative URI](#relative-and-absolute-uris), resolves it relative to
-// @base_uri_string. If the result is not a valid absolute URI, it will be
+// Parses uri_ref according to flags and, if it is a
+// relative URI, resolves it relative to
+// base_uri_string. If the result is not a valid absolute URI, it will be
 // discarded, and an error returned.
 //
-// (If @base_uri_string is %NULL, this just returns @uri_ref, or
-// %NULL if @uri_ref is invalid or not absolute.)
+// (If base_uri_string is nil, this just returns uri_ref, or
+// nil if uri_ref is invalid or not absolute.)
 func UriResolveRelative(BaseUriStringVar *string, UriRefVar string, FlagsVar UriFlags) (string, error) {
 	var cerr *Error
 
@@ -908,7 +1180,7 @@ func UriResolveRelative(BaseUriStringVar *string, UriRefVar string, FlagsVar Uri
 	cret := xUriResolveRelative(BaseUriStringVarPtr, UriRefVar, FlagsVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -916,29 +1188,29 @@ func UriResolveRelative(BaseUriStringVar *string, UriRefVar string, FlagsVar Uri
 
 var xUriSplit func(string, UriFlags, *string, *string, *string, *int, *string, *string, *string, **Error) bool
 
-// Parses @uri_ref (which can be an
-// [absolute or relative URI](#relative-and-absolute-uris)) according to @flags, and
-// returns the pieces. Any component that doesn't appear in @uri_ref will be
-// returned as %NULL (but note that all URIs always have a path component,
+// Parses uri_ref (which can be an
+// absolute or relative URI) according to flags, and
+// returns the pieces. Any component that doesn't appear in uri_ref will be
+// returned as nil (but note that all URIs always have a path component,
 // though it may be the empty string).
 //
-// If @flags contains %G_URI_FLAGS_ENCODED, then `%`-encoded characters in
-// @uri_ref will remain encoded in the output strings. (If not,
+// If flags contains [GUriFlagsEncodedValue], then `%`-encoded characters in
+// uri_ref will remain encoded in the output strings. (If not,
 // then all such characters will be decoded.) Note that decoding will
 // only work if the URI components are ASCII or UTF-8, so you will
-// need to use %G_URI_FLAGS_ENCODED if they are not.
+// need to use [GUriFlagsEncodedValue] if they are not.
 //
-// Note that the %G_URI_FLAGS_HAS_PASSWORD and
-// %G_URI_FLAGS_HAS_AUTH_PARAMS @flags are ignored by g_uri_split(),
+// Note that the [GUriFlagsHasPasswordValue] and
+// [GUriFlagsHasAuthParamsValue] flags are ignored by [UriSplit],
 // since it always returns only the full userinfo; use
-// g_uri_split_with_user() if you want it split up.
+// [UriSplitWithUser] if you want it split up.
 func UriSplit(UriRefVar string, FlagsVar UriFlags, SchemeVar *string, UserinfoVar *string, HostVar *string, PortVar *int, PathVar *string, QueryVar *string, FragmentVar *string) (bool, error) {
 	var cerr *Error
 
 	cret := xUriSplit(UriRefVar, FlagsVar, SchemeVar, UserinfoVar, HostVar, PortVar, PathVar, QueryVar, FragmentVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -946,11 +1218,11 @@ func UriSplit(UriRefVar string, FlagsVar UriFlags, SchemeVar *string, UserinfoVa
 
 var xUriSplitNetwork func(string, UriFlags, *string, *string, *int, **Error) bool
 
-// Parses @uri_string (which must be an [absolute URI](#relative-and-absolute-uris))
-// according to @flags, and returns the pieces relevant to connecting to a host.
-// See the documentation for g_uri_split() for more details; this is
+// Parses uri_string (which must be an absolute URI)
+// according to flags, and returns the pieces relevant to connecting to a host.
+// See the documentation for [UriSplit] for more details; this is
 // mostly a wrapper around that function with simpler arguments.
-// However, it will return an error if @uri_string is a relative URI,
+// However, it will return an error if uri_string is a relative URI,
 // or does not contain a hostname component.
 func UriSplitNetwork(UriStringVar string, FlagsVar UriFlags, SchemeVar *string, HostVar *string, PortVar *int) (bool, error) {
 	var cerr *Error
@@ -958,7 +1230,7 @@ func UriSplitNetwork(UriStringVar string, FlagsVar UriFlags, SchemeVar *string,
 	cret := xUriSplitNetwork(UriStringVar, FlagsVar, SchemeVar, HostVar, PortVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -966,24 +1238,24 @@ func UriSplitNetwork(UriStringVar string, FlagsVar UriFlags, SchemeVar *string,
 
 var xUriSplitWithUser func(string, UriFlags, *string, *string, *string, *string, *string, *int, *string, *string, *string, **Error) bool
 
-// Parses @uri_ref (which can be an
-// [absolute or relative URI](#relative-and-absolute-uris)) according to @flags, and
-// returns the pieces. Any component that doesn't appear in @uri_ref will be
-// returned as %NULL (but note that all URIs always have a path component,
+// Parses uri_ref (which can be an
+// absolute or relative URI) according to flags, and
+// returns the pieces. Any component that doesn't appear in uri_ref will be
+// returned as nil (but note that all URIs always have a path component,
 // though it may be the empty string).
 //
-// See g_uri_split(), and the definition of #GUriFlags, for more
-// information on the effect of @flags. Note that @password will only
-// be parsed out if @flags contains %G_URI_FLAGS_HAS_PASSWORD, and
-// @auth_params will only be parsed out if @flags contains
-// %G_URI_FLAGS_HAS_AUTH_PARAMS.
+// See [UriSplit], and the definition of [UriFlags], for more
+// information on the effect of flags. Note that password will only
+// be parsed out if flags contains [GUriFlagsHasPasswordValue], and
+// auth_params will only be parsed out if flags contains
+// [GUriFlagsHasAuthParamsValue].
 func UriSplitWithUser(UriRefVar string, FlagsVar UriFlags, SchemeVar *string, UserVar *string, PasswordVar *string, AuthParamsVar *string, HostVar *string, PortVar *int, PathVar *string, QueryVar *string, FragmentVar *string) (bool, error) {
 	var cerr *Error
 
 	cret := xUriSplitWithUser(UriRefVar, FlagsVar, SchemeVar, UserVar, PasswordVar, AuthParamsVar, HostVar, PortVar, PathVar, QueryVar, FragmentVar, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -993,11 +1265,11 @@ var xUriUnescapeBytes func(string, int, uintptr, **Error) *Bytes
 
 // Unescapes a segment of an escaped string as binary data.
 //
-// Note that in contrast to g_uri_unescape_string(), this does allow
+// Note that in contrast to [UriUnescapeString], this does allow
 // nul bytes to appear in the output.
 //
-// If any of the characters in @illegal_characters appears as an escaped
-// character in @escaped_string, then that is an error and %NULL will be
+// If any of the characters in illegal_characters appears as an escaped
+// character in escaped_string, then that is an error and nil will be
 // returned. This is useful if you want to avoid for instance having a slash
 // being expanded in an escaped path element, which might confuse pathname
 // handling.
@@ -1010,7 +1282,7 @@ func UriUnescapeBytes(EscapedStringVar string, LengthVar int, IllegalCharactersV
 	cret := xUriUnescapeBytes(EscapedStringVar, LengthVar, IllegalCharactersVarPtr, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1020,14 +1292,14 @@ var xUriUnescapeSegment func(uintptr, uintptr, uintptr) string
 
 // Unescapes a segment of an escaped string.
 //
-// If any of the characters in @illegal_characters or the NUL
-// character appears as an escaped character in @escaped_string, then
-// that is an error and %NULL will be returned. This is useful if you
+// If any of the characters in illegal_characters or the NUL
+// character appears as an escaped character in escaped_string, then
+// that is an error and nil will be returned. This is useful if you
 // want to avoid for instance having a slash being expanded in an
 // escaped path element, which might confuse pathname handling.
 //
 // Note: `NUL` byte is not accepted in the output, in contrast to
-// g_uri_unescape_bytes().
+// [UriUnescapeBytes].
 func UriUnescapeSegment(EscapedStringVar *string, EscapedStringEndVar *string, IllegalCharactersVar *string) string {
 
 	EscapedStringVarPtr := core.GStrdupNullable(EscapedStringVar)
@@ -1048,9 +1320,9 @@ var xUriUnescapeString func(string, uintptr) string
 
 // Unescapes a whole escaped string.
 //
-// If any of the characters in @illegal_characters or the NUL
-// character appears as an escaped character in @escaped_string, then
-// that is an error and %NULL will be returned. This is useful if you
+// If any of the characters in illegal_characters or the NUL
+// character appears as an escaped character in escaped_string, then
+// that is an error and nil will be returned. This is useful if you
 // want to avoid for instance having a slash being expanded in an
 // escaped path element, which might confuse pathname handling.
 func UriUnescapeString(EscapedStringVar string, IllegalCharactersVar *string) string {
@@ -1068,52 +1340,52 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xUriBuild, libs, "g_uri_build")
-	core.PuregoSafeRegister(&xUriBuildWithUser, libs, "g_uri_build_with_user")
-	core.PuregoSafeRegister(&xUriEscapeBytes, libs, "g_uri_escape_bytes")
-	core.PuregoSafeRegister(&xUriEscapeString, libs, "g_uri_escape_string")
-	core.PuregoSafeRegister(&xUriIsValid, libs, "g_uri_is_valid")
-	core.PuregoSafeRegister(&xUriJoin, libs, "g_uri_join")
-	core.PuregoSafeRegister(&xUriJoinWithUser, libs, "g_uri_join_with_user")
-	core.PuregoSafeRegister(&xUriParse, libs, "g_uri_parse")
-	core.PuregoSafeRegister(&xUriParseParams, libs, "g_uri_parse_params")
-	core.PuregoSafeRegister(&xUriParseScheme, libs, "g_uri_parse_scheme")
-	core.PuregoSafeRegister(&xUriPeekScheme, libs, "g_uri_peek_scheme")
-	core.PuregoSafeRegister(&xUriResolveRelative, libs, "g_uri_resolve_relative")
-	core.PuregoSafeRegister(&xUriSplit, libs, "g_uri_split")
-	core.PuregoSafeRegister(&xUriSplitNetwork, libs, "g_uri_split_network")
-	core.PuregoSafeRegister(&xUriSplitWithUser, libs, "g_uri_split_with_user")
-	core.PuregoSafeRegister(&xUriUnescapeBytes, libs, "g_uri_unescape_bytes")
-	core.PuregoSafeRegister(&xUriUnescapeSegment, libs, "g_uri_unescape_segment")
-	core.PuregoSafeRegister(&xUriUnescapeString, libs, "g_uri_unescape_string")
+	core.PuregoRegisterSince(&xUriBuild, libs, "g_uri_build", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriBuildWithUser, libs, "g_uri_build_with_user", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriEscapeBytes, libs, "g_uri_escape_bytes", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriEscapeString, libs, "g_uri_escape_string", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xUriIsValid, libs, "g_uri_is_valid", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriJoin, libs, "g_uri_join", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriJoinWithUser, libs, "g_uri_join_with_user", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriParse, libs, "g_uri_parse", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriParseParams, libs, "g_uri_parse_params", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriParseScheme, libs, "g_uri_parse_scheme", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xUriPeekScheme, libs, "g_uri_peek_scheme", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriResolveRelative, libs, "g_uri_resolve_relative", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriSplit, libs, "g_uri_split", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriSplitNetwork, libs, "g_uri_split_network", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriSplitWithUser, libs, "g_uri_split_with_user", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriUnescapeBytes, libs, "g_uri_unescape_bytes", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriUnescapeSegment, libs, "g_uri_unescape_segment", "GLIB", "2.16")
+	core.PuregoRegisterSince(&xUriUnescapeString, libs, "g_uri_unescape_string", "GLIB", "2.16")
 
 	core.PuregoSafeRegister(&xUriGLibType, libs, "g_uri_get_type")
 
-	core.PuregoSafeRegister(&xUriGetAuthParams, libs, "g_uri_get_auth_params")
-	core.PuregoSafeRegister(&xUriGetFlags, libs, "g_uri_get_flags")
-	core.PuregoSafeRegister(&xUriGetFragment, libs, "g_uri_get_fragment")
-	core.PuregoSafeRegister(&xUriGetHost, libs, "g_uri_get_host")
-	core.PuregoSafeRegister(&xUriGetPassword, libs, "g_uri_get_password")
-	core.PuregoSafeRegister(&xUriGetPath, libs, "g_uri_get_path")
-	core.PuregoSafeRegister(&xUriGetPort, libs, "g_uri_get_port")
-	core.PuregoSafeRegister(&xUriGetQuery, libs, "g_uri_get_query")
-	core.PuregoSafeRegister(&xUriGetScheme, libs, "g_uri_get_scheme")
-	core.PuregoSafeRegister(&xUriGetUser, libs, "g_uri_get_user")
-	core.PuregoSafeRegister(&xUriGetUserinfo, libs, "g_uri_get_userinfo")
-	core.PuregoSafeRegister(&xUriParseRelative, libs, "g_uri_parse_relative")
-	core.PuregoSafeRegister(&xUriRef, libs, "g_uri_ref")
-	core.PuregoSafeRegister(&xUriToString, libs, "g_uri_to_string")
-	core.PuregoSafeRegister(&xUriToStringPartial, libs, "g_uri_to_string_partial")
-	core.PuregoSafeRegister(&xUriUnref, libs, "g_uri_unref")
-
-	core.PuregoSafeRegister(&xUriParamsIterInit, libs, "g_uri_params_iter_init")
-	core.PuregoSafeRegister(&xUriParamsIterNext, libs, "g_uri_params_iter_next")
+	core.PuregoRegisterSince(&xUriGetAuthParams, libs, "g_uri_get_auth_params", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetFlags, libs, "g_uri_get_flags", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetFragment, libs, "g_uri_get_fragment", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetHost, libs, "g_uri_get_host", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetPassword, libs, "g_uri_get_password", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetPath, libs, "g_uri_get_path", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetPort, libs, "g_uri_get_port", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetQuery, libs, "g_uri_get_query", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetScheme, libs, "g_uri_get_scheme", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetUser, libs, "g_uri_get_user", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriGetUserinfo, libs, "g_uri_get_userinfo", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriParseRelative, libs, "g_uri_parse_relative", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriRef, libs, "g_uri_ref", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriToString, libs, "g_uri_to_string", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriToStringPartial, libs, "g_uri_to_string_partial", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriUnref, libs, "g_uri_unref", "GLIB", "2.66")
+
+	core.PuregoRegisterSince(&xUriParamsIterInit, libs, "g_uri_params_iter_init", "GLIB", "2.66")
+	core.PuregoRegisterSince(&xUriParamsIterNext, libs, "g_uri_params_iter_next", "GLIB", "2.66")
 
 }
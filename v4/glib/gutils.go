@@ -2,20 +2,22 @@
 package glib
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // Declares a type of function which takes no arguments
 // and has no return value. It is used to specify the type
-// function passed to g_atexit().
+// function passed to [Atexit].
 type VoidFunc func()
 
 // Associates a string with a bit flag.
-// Used in g_parse_debug_string().
+// Used in [ParseDebugString].
 type DebugKey struct {
 	_ structs.HostLayout
 
@@ -28,12 +30,12 @@ func (x *DebugKey) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Flags to modify the format of the string returned by g_format_size_full().
-type FormatSizeFlags int
+// Flags to modify the format of the string returned by [FormatSizeFull].
+type FormatSizeFlags uint32
 
 const (
 
-	// behave the same as g_format_size()
+	// behave the same as [FormatSize]
 	GFormatSizeDefaultValue FormatSizeFlags = 0
 	// include the exact number of bytes as part
 	//     of the returned string.  For example, "45.6 kB (45,612 bytes)".
@@ -56,11 +58,59 @@ const (
 	GFormatSizeOnlyUnitValue FormatSizeFlags = 16
 )
 
+// Has reports whether all bits of flags are set in x
+func (x FormatSizeFlags) Has(flags FormatSizeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FormatSizeFlags) Set(flags FormatSizeFlags) FormatSizeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FormatSizeFlags) Clear(flags FormatSizeFlags) FormatSizeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FormatSizeFlags) String() string {
+	if x == 0 {
+		return "G_FORMAT_SIZE_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GFormatSizeLongFormatValue != 0 && x&GFormatSizeLongFormatValue == GFormatSizeLongFormatValue {
+		names = append(names, "G_FORMAT_SIZE_LONG_FORMAT")
+		rest &^= GFormatSizeLongFormatValue
+	}
+	if rest&GFormatSizeIecUnitsValue != 0 && x&GFormatSizeIecUnitsValue == GFormatSizeIecUnitsValue {
+		names = append(names, "G_FORMAT_SIZE_IEC_UNITS")
+		rest &^= GFormatSizeIecUnitsValue
+	}
+	if rest&GFormatSizeBitsValue != 0 && x&GFormatSizeBitsValue == GFormatSizeBitsValue {
+		names = append(names, "G_FORMAT_SIZE_BITS")
+		rest &^= GFormatSizeBitsValue
+	}
+	if rest&GFormatSizeOnlyValueValue != 0 && x&GFormatSizeOnlyValueValue == GFormatSizeOnlyValueValue {
+		names = append(names, "G_FORMAT_SIZE_ONLY_VALUE")
+		rest &^= GFormatSizeOnlyValueValue
+	}
+	if rest&GFormatSizeOnlyUnitValue != 0 && x&GFormatSizeOnlyUnitValue == GFormatSizeOnlyUnitValue {
+		names = append(names, "G_FORMAT_SIZE_ONLY_UNIT")
+		rest &^= GFormatSizeOnlyUnitValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // These are logical ids for special directories which are defined
-// depending on the platform used. You should use g_get_user_special_dir()
+// depending on the platform used. You should use [GetUserSpecialDir]
 // to retrieve the full path associated to the logical id.
 //
-// The #GUserDirectory enumeration can be extended at later date. Not
+// The [UserDirectory] enumeration can be extended at later date. Not
 // every platform has a directory for every logical id in this
 // enumeration.
 type UserDirectory int
@@ -87,91 +137,113 @@ const (
 	GUserNDirectoriesValue UserDirectory = 8
 )
 
-var xAtexit func(uintptr)
-
-// Specifies a function to be called at normal program termination.
-//
-// Since GLib 2.8.2, on Windows g_atexit() actually is a preprocessor
-// macro that maps to a call to the atexit() function in the C
-// library. This means that in case the code that calls g_atexit(),
-// i.e. atexit(), is in a DLL, the function will be called when the
-// DLL is detached from the program. This typically makes more sense
-// than that the function is called when the GLib DLL is detached,
-// which happened earlier when g_atexit() was a function in the GLib
-// DLL.
-//
-// The behaviour of atexit() in the context of dynamically loaded
-// modules is not formally specified and varies wildly.
-//
-// On POSIX systems, calling g_atexit() (or atexit()) in a dynamically
-// loaded module which is unloaded before the program terminates might
-// well cause a crash at program exit.
-//
-// Some POSIX systems implement atexit() like Windows, and have each
-// dynamically loaded module maintain an own atexit chain that is
-// called when the module is unloaded.
-//
-// On other POSIX systems, before a dynamically loaded module is
-// unloaded, the registered atexit functions (if any) residing in that
-// module are called, regardless where the code that registered them
-// resided. This is presumably the most robust approach.
-//
-// As can be seen from the above, for portability it's best to avoid
-// calling g_atexit() (or atexit()) except in the main executable of a
-// program.
-func Atexit(FuncVar *VoidFunc) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func() {
-				cbFn := *FuncVar
-				cbFn()
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
+// String returns the C name of the value, e.g. for debugging output
+func (x UserDirectory) String() string {
+	switch x {
+	case GUserDirectoryDesktopValue:
+		return "G_USER_DIRECTORY_DESKTOP"
+	case GUserDirectoryDocumentsValue:
+		return "G_USER_DIRECTORY_DOCUMENTS"
+	case GUserDirectoryDownloadValue:
+		return "G_USER_DIRECTORY_DOWNLOAD"
+	case GUserDirectoryMusicValue:
+		return "G_USER_DIRECTORY_MUSIC"
+	case GUserDirectoryPicturesValue:
+		return "G_USER_DIRECTORY_PICTURES"
+	case GUserDirectoryPublicShareValue:
+		return "G_USER_DIRECTORY_PUBLIC_SHARE"
+	case GUserDirectoryTemplatesValue:
+		return "G_USER_DIRECTORY_TEMPLATES"
+	case GUserDirectoryVideosValue:
+		return "G_USER_DIRECTORY_VIDEOS"
+	case GUserNDirectoriesValue:
+		return "G_USER_N_DIRECTORIES"
 	}
-
-	xAtexit(FuncVarRef)
-
+	return fmt.Sprintf("UserDirectory(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UserDirectory) Nick() string {
+	switch x {
+	case GUserDirectoryDesktopValue:
+		return "directory-desktop"
+	case GUserDirectoryDocumentsValue:
+		return "directory-documents"
+	case GUserDirectoryDownloadValue:
+		return "directory-download"
+	case GUserDirectoryMusicValue:
+		return "directory-music"
+	case GUserDirectoryPicturesValue:
+		return "directory-pictures"
+	case GUserDirectoryPublicShareValue:
+		return "directory-public-share"
+	case GUserDirectoryTemplatesValue:
+		return "directory-templates"
+	case GUserDirectoryVideosValue:
+		return "directory-videos"
+	case GUserNDirectoriesValue:
+		return "n-directories"
+	}
+	return ""
+}
+
+// UserDirectoryFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UserDirectoryFromString(s string) (UserDirectory, bool) {
+	switch s {
+	case "G_USER_DIRECTORY_DESKTOP", "directory-desktop":
+		return GUserDirectoryDesktopValue, true
+	case "G_USER_DIRECTORY_DOCUMENTS", "directory-documents":
+		return GUserDirectoryDocumentsValue, true
+	case "G_USER_DIRECTORY_DOWNLOAD", "directory-download":
+		return GUserDirectoryDownloadValue, true
+	case "G_USER_DIRECTORY_MUSIC", "directory-music":
+		return GUserDirectoryMusicValue, true
+	case "G_USER_DIRECTORY_PICTURES", "directory-pictures":
+		return GUserDirectoryPicturesValue, true
+	case "G_USER_DIRECTORY_PUBLIC_SHARE", "directory-public-share":
+		return GUserDirectoryPublicShareValue, true
+	case "G_USER_DIRECTORY_TEMPLATES", "directory-templates":
+		return GUserDirectoryTemplatesValue, true
+	case "G_USER_DIRECTORY_VIDEOS", "directory-videos":
+		return GUserDirectoryVideosValue, true
+	case "G_USER_N_DIRECTORIES", "n-directories":
+		return GUserNDirectoriesValue, true
+	}
+	return 0, false
 }
 
-var xBitNthLsf func(uint, int) int
+var xBitNthLsf func(types.ULong, int) int
 
-// Find the position of the first bit set in @mask, searching
-// from (but not including) @nth_bit upwards. Bits are numbered
+// Find the position of the first bit set in mask, searching
+// from (but not including) nth_bit upwards. Bits are numbered
 // from 0 (least significant) to sizeof(#gulong) * 8 - 1 (31 or 63,
-// usually). To start searching from the 0th bit, set @nth_bit to -1.
-func BitNthLsf(MaskVar uint, NthBitVar int) int {
+// usually). To start searching from the 0th bit, set nth_bit to -1.
+func BitNthLsf(MaskVar types.ULong, NthBitVar int) int {
 
 	cret := xBitNthLsf(MaskVar, NthBitVar)
 
 	return cret
 }
 
-var xBitNthMsf func(uint, int) int
+var xBitNthMsf func(types.ULong, int) int
 
-// Find the position of the first bit set in @mask, searching
-// from (but not including) @nth_bit downwards. Bits are numbered
+// Find the position of the first bit set in mask, searching
+// from (but not including) nth_bit downwards. Bits are numbered
 // from 0 (least significant) to sizeof(#gulong) * 8 - 1 (31 or 63,
-// usually). To start searching from the last bit, set @nth_bit to
+// usually). To start searching from the last bit, set nth_bit to
 // -1 or GLIB_SIZEOF_LONG * 8.
-func BitNthMsf(MaskVar uint, NthBitVar int) int {
+func BitNthMsf(MaskVar types.ULong, NthBitVar int) int {
 
 	cret := xBitNthMsf(MaskVar, NthBitVar)
 
 	return cret
 }
 
-var xBitStorage func(uint) uint
+var xBitStorage func(types.ULong) uint
 
-// Gets the number of bits used to hold @number,
-// e.g. if @number is 4, 3 bits are needed.
-func BitStorage(NumberVar uint) uint {
+// Gets the number of bits used to hold number,
+// e.g. if number is 4, 3 bits are needed.
+func BitStorage(NumberVar types.ULong) uint {
 
 	cret := xBitStorage(NumberVar)
 
@@ -180,13 +252,13 @@ func BitStorage(NumberVar uint) uint {
 
 var xFindProgramInPath func(string) string
 
-// Locates the first executable named @program in the user's path, in the
+// Locates the first executable named program in the user's path, in the
 // same way that execvp() would locate it. Returns an allocated string
-// with the absolute path name, or %NULL if the program is not found in
-// the path. If @program is already an absolute path, returns a copy of
-// @program if @program exists and is executable, and %NULL otherwise.
+// with the absolute path name, or nil if the program is not found in
+// the path. If program is already an absolute path, returns a copy of
+// program if program exists and is executable, and nil otherwise.
 //
-// On Windows, if @program does not have a file type suffix, tries
+// On Windows, if program does not have a file type suffix, tries
 // with the suffixes .exe, .cmd, .bat and .com, and the suffixes in
 // the `PATHEXT` environment variable.
 //
@@ -215,9 +287,9 @@ var xFormatSize func(uint64) string
 //
 // The prefix units base is 1000 (i.e. 1 kB is 1000 bytes).
 //
-// This string should be freed with g_free() when not needed any longer.
+// This string should be freed with [Free] when not needed any longer.
 //
-// See g_format_size_full() for more options about how the size might be
+// See [FormatSizeFull] for more options about how the size might be
 // formatted.
 func FormatSize(SizeVar uint64) string {
 
@@ -226,30 +298,12 @@ func FormatSize(SizeVar uint64) string {
 	return cret
 }
 
-var xFormatSizeForDisplay func(int64) string
-
-// Formats a size (for example the size of a file) into a human
-// readable string. Sizes are rounded to the nearest size prefix
-// (KB, MB, GB) and are displayed rounded to the nearest tenth.
-// E.g. the file size 3292528 bytes will be converted into the
-// string "3.1 MB".
-//
-// The prefix units base is 1024 (i.e. 1 KB is 1024 bytes).
-//
-// This string should be freed with g_free() when not needed any longer.
-func FormatSizeForDisplay(SizeVar int64) string {
-
-	cret := xFormatSizeForDisplay(SizeVar)
-
-	return cret
-}
-
 var xFormatSizeFull func(uint64, FormatSizeFlags) string
 
 // Formats a size.
 //
-// This function is similar to g_format_size() but allows for flags
-// that modify the output. See #GFormatSizeFlags.
+// This function is similar to [FormatSize] but allows for flags
+// that modify the output. See [FormatSizeFlags].
 func FormatSizeFull(SizeVar uint64, FlagsVar FormatSizeFlags) string {
 
 	cret := xFormatSizeFull(SizeVar, FlagsVar)
@@ -260,11 +314,11 @@ func FormatSizeFull(SizeVar uint64, FlagsVar FormatSizeFlags) string {
 var xGetApplicationName func() string
 
 // Gets a human-readable name for the application, as set by
-// g_set_application_name(). This name should be localized if
+// [SetApplicationName]. This name should be localized if
 // possible, and is intended for display to the user.  Contrast with
-// g_get_prgname(), which gets a non-localized name. If
-// g_set_application_name() has not been called, returns the result of
-// g_get_prgname() (which may be %NULL if g_set_prgname() has also not
+// [GetPrgname], which gets a non-localized name. If
+// [SetApplicationName] has not been called, returns the result of
+// [GetPrgname] (which may be nil if [SetPrgname] has also not
 // been called).
 func GetApplicationName() string {
 
@@ -331,10 +385,10 @@ var xGetOsInfo func(string) string
 //
 // On Linux this comes from the `/etc/os-release` file. On other systems, it may
 // come from a variety of sources. You can either use the standard key names
-// like %G_OS_INFO_KEY_NAME or pass any UTF-8 string key name. For example,
+// like G_OS_INFO_KEY_NAME or pass any UTF-8 string key name. For example,
 // `/etc/os-release` provides a number of other less commonly used values that may
 // be useful. No key is guaranteed to be provided, so the caller should always
-// check if the result is %NULL.
+// check if the result is nil.
 func GetOsInfo(KeyNameVar string) string {
 
 	cret := xGetOsInfo(KeyNameVar)
@@ -345,13 +399,13 @@ func GetOsInfo(KeyNameVar string) string {
 var xGetPrgname func() string
 
 // Gets the name of the program. This name should not be localized,
-// in contrast to g_get_application_name().
+// in contrast to [GetApplicationName].
 //
-// If you are using #GApplication the program name is set in
-// g_application_run(). In case of GDK or GTK it is set in
-// gdk_init(), which is called by gtk_init() and the
-// #GtkApplication::startup handler. The program name is found by
-// taking the last component of @argv[0].
+// If you are using [gio.Application] the program name is set in
+// [gio.Application.Run]. In case of GDK or GTK it is set in
+// gdk_init(), which is called by [gtk.Init] and the
+// GtkApplication::startup handler. The program name is found by
+// taking the last component of argv[0].
 func GetPrgname() string {
 
 	cret := xGetPrgname()
@@ -380,7 +434,7 @@ var xGetSystemConfigDirs func() []string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // In this case the list of directories retrieved will be `XDG_CONFIG_DIRS`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_CONFIG_DIRS` is defined.
@@ -395,6 +449,8 @@ var xGetSystemConfigDirs func() []string
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
 func GetSystemConfigDirs() []string {
 
 	cret := xGetSystemConfigDirs()
@@ -409,7 +465,7 @@ var xGetSystemDataDirs func() []string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec)
+// [XDG Base Directory Specification]
 // In this case the list of directories retrieved will be `XDG_DATA_DIRS`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_DATA_DIRS` is defined.
@@ -438,6 +494,8 @@ var xGetSystemDataDirs func() []string
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
 func GetSystemDataDirs() []string {
 
 	cret := xGetSystemDataDirs()
@@ -459,7 +517,7 @@ var xGetTmpDir func() string
 // as a default.
 //
 // The encoding of the returned string is system-defined. On Windows,
-// it is always UTF-8. The return value is never %NULL or the empty
+// it is always UTF-8. The return value is never nil or the empty
 // string.
 func GetTmpDir() string {
 
@@ -475,17 +533,20 @@ var xGetUserCacheDir func() string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // In this case the directory retrieved will be `XDG_CACHE_HOME`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_CACHE_HOME` is defined.
 // If `XDG_CACHE_HOME` is undefined, the directory that serves as a common
 // repository for temporary Internet files is used instead. A typical path is
 // `C:\Documents and Settings\username\Local Settings\Temporary Internet Files`.
-// See the [documentation for `FOLDERID_InternetCache`](https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid).
+// See the [documentation for FOLDERID_InternetCache].
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
+// [documentation for FOLDERID_InternetCache]: https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid
 func GetUserCacheDir() string {
 
 	cret := xGetUserCacheDir()
@@ -500,18 +561,21 @@ var xGetUserConfigDir func() string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // In this case the directory retrieved will be `XDG_CONFIG_HOME`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_CONFIG_HOME` is defined.
 // If `XDG_CONFIG_HOME` is undefined, the folder to use for local (as opposed
 // to roaming) application data is used instead. See the
-// [documentation for `FOLDERID_LocalAppData`](https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid).
+// [documentation for FOLDERID_LocalAppData].
 // Note that in this case on Windows it will be  the same
-// as what g_get_user_data_dir() returns.
+// as what [GetUserDataDir] returns.
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
+// [documentation for FOLDERID_LocalAppData]: https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid
 func GetUserConfigDir() string {
 
 	cret := xGetUserConfigDir()
@@ -526,18 +590,21 @@ var xGetUserDataDir func() string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // In this case the directory retrieved will be `XDG_DATA_HOME`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_DATA_HOME`
 // is defined. If `XDG_DATA_HOME` is undefined, the folder to use for local (as
 // opposed to roaming) application data is used instead. See the
-// [documentation for `FOLDERID_LocalAppData`](https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid).
+// [documentation for FOLDERID_LocalAppData].
 // Note that in this case on Windows it will be the same
-// as what g_get_user_config_dir() returns.
+// as what [GetUserConfigDir] returns.
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
+// [documentation for FOLDERID_LocalAppData]: https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid
 func GetUserDataDir() string {
 
 	cret := xGetUserDataDir()
@@ -565,14 +632,16 @@ var xGetUserRuntimeDir func() string
 //
 // This is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // This is the directory
 // specified in the `XDG_RUNTIME_DIR` environment variable.
 // In the case that this variable is not set, we return the value of
-// g_get_user_cache_dir(), after verifying that it exists.
+// [GetUserCacheDir], after verifying that it exists.
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
 func GetUserRuntimeDir() string {
 
 	cret := xGetUserRuntimeDir()
@@ -585,7 +654,7 @@ var xGetUserSpecialDir func(UserDirectory) string
 // Returns the full path of a special directory using its logical id.
 //
 // On UNIX this is done using the XDG special user directories.
-// For compatibility with existing practise, %G_USER_DIRECTORY_DESKTOP
+// For compatibility with existing practise, [GUserDirectoryDesktopValue]
 // falls back to `$HOME/Desktop` when XDG special user directories have
 // not been set up.
 //
@@ -606,18 +675,21 @@ var xGetUserStateDir func() string
 //
 // On UNIX platforms this is determined using the mechanisms described
 // in the
-// [XDG Base Directory Specification](http://www.freedesktop.org/Standards/basedir-spec).
+// [XDG Base Directory Specification].
 // In this case the directory retrieved will be `XDG_STATE_HOME`.
 //
 // On Windows it follows XDG Base Directory Specification if `XDG_STATE_HOME` is defined.
 // If `XDG_STATE_HOME` is undefined, the folder to use for local (as opposed
 // to roaming) application data is used instead. See the
-// [documentation for `FOLDERID_LocalAppData`](https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid).
+// [documentation for FOLDERID_LocalAppData].
 // Note that in this case on Windows it will be the same
-// as what g_get_user_data_dir() returns.
+// as what [GetUserDataDir] returns.
 //
 // The return value is cached and modifying it at runtime is not supported, as
 // it’s not thread-safe to modify environment variables at runtime.
+//
+// [XDG Base Directory Specification]: http://www.freedesktop.org/Standards/basedir-spec
+// [documentation for FOLDERID_LocalAppData]: https://docs.microsoft.com/en-us/windows/win32/shell/knownfolderid
 func GetUserStateDir() string {
 
 	cret := xGetUserStateDir()
@@ -627,7 +699,7 @@ func GetUserStateDir() string {
 
 var xNullifyPointer func(uintptr)
 
-// Set the pointer at the specified location to %NULL.
+// Set the pointer at the specified location to nil.
 func NullifyPointer(NullifyLocationVar uintptr) {
 
 	xNullifyPointer(NullifyLocationVar)
@@ -641,12 +713,12 @@ var xParseDebugString func(uintptr, []DebugKey, uint) uint
 // within GDK and GTK to parse the debug options passed on the
 // command line or through environment variables.
 //
-// If @string is equal to "all", all flags are set. Any flags
-// specified along with "all" in @string are inverted; thus,
+// If string is equal to "all", all flags are set. Any flags
+// specified along with "all" in string are inverted; thus,
 // "all,foo,bar" or "foo,bar,all" sets all flags except those
 // corresponding to "foo" and "bar".
 //
-// If @string is equal to "help", all the available keys in @keys
+// If string is equal to "help", all the available keys in keys
 // are printed out to standard error.
 func ParseDebugString(StringVar *string, KeysVar []DebugKey, NkeysVar uint) uint {
 
@@ -660,12 +732,12 @@ func ParseDebugString(StringVar *string, KeysVar []DebugKey, NkeysVar uint) uint
 
 var xReloadUserSpecialDirsCache func()
 
-// Resets the cache used for g_get_user_special_dir(), so
+// Resets the cache used for [GetUserSpecialDir], so
 // that the latest on-disk version is used. Call this only
 // if you just changed the data on disk yourself.
 //
 // Due to thread safety issues this may cause leaking of strings
-// that were previously returned from g_get_user_special_dir()
+// that were previously returned from [GetUserSpecialDir]
 // that can't be freed. We ensure to only leak the data for
 // the directories that actually changed value though.
 func ReloadUserSpecialDirsCache() {
@@ -678,9 +750,9 @@ var xSetApplicationName func(string)
 
 // Sets a human-readable name for the application. This name should be
 // localized if possible, and is intended for display to the user.
-// Contrast with g_set_prgname(), which sets a non-localized name.
-// g_set_prgname() will be called automatically by gtk_init(),
-// but g_set_application_name() will not.
+// Contrast with [SetPrgname], which sets a non-localized name.
+// [SetPrgname] will be called automatically by [gtk.Init],
+// but [SetApplicationName] will not.
 //
 // Note that for thread safety reasons, this function can only
 // be called once.
@@ -696,72 +768,76 @@ func SetApplicationName(ApplicationNameVar string) {
 var xSetPrgname func(string)
 
 // Sets the name of the program. This name should not be localized,
-// in contrast to g_set_application_name().
+// in contrast to [SetApplicationName].
 //
-// If you are using #GApplication the program name is set in
-// g_application_run(). In case of GDK or GTK it is set in
-// gdk_init(), which is called by gtk_init() and the
-// #GtkApplication::startup handler. By default, the program name is
-// found by taking the last component of @argv[0].
+// If you are using [gio.Application] the program name is set in
+// [gio.Application.Run]. In case of GDK or GTK it is set in
+// gdk_init(), which is called by [gtk.Init] and the
+// GtkApplication::startup handler. By default, the program name is
+// found by taking the last component of argv[0].
 //
 // Since GLib 2.72, this function can be called multiple times
 // and is fully thread safe. Prior to GLib 2.72, this function
 // could only be called once per process.
 //
-// See the [GTK documentation](https://docs.gtk.org/gtk4/migrating-3to4.html#set-a-proper-application-id)
-// for requirements on integrating g_set_prgname() with GTK applications.
+// See the [GTK documentation]
+// for requirements on integrating [SetPrgname] with GTK applications.
+//
+// [GTK documentation]: https://docs.gtk.org/gtk4/migrating-3to4.html#set-a-proper-application-id
 func SetPrgname(PrgnameVar string) {
 
 	xSetPrgname(PrgnameVar)
 
 }
 
-var xSnprintf func(string, uint, string, ...interface{}) int
+var xSnprintf func(string, types.ULong, string, ...interface{}) int
 
 // A safer form of the standard sprintf() function. The output is guaranteed
-// to not exceed @n characters (including the terminating nul character), so
+// to not exceed n characters (including the terminating nul character), so
 // it is easy to ensure that a buffer overflow cannot occur.
 //
-// See also [func@GLib.strdup_printf].
+// See also [StrdupPrintf].
 //
 // In versions of GLib prior to 1.2.3, this function may return -1 if the
 // output was truncated, and the truncated string may not be nul-terminated.
 // In versions prior to 1.3.12, this function returns the length of the output
 // string.
 //
-// The return value of g_snprintf() conforms to the snprintf()
+// The return value of [Snprintf] conforms to the snprintf()
 // function as standardized in ISO C99. Note that this is different from
 // traditional `snprintf()`, which returns the length of the output string.
 //
 // The format string may contain positional parameters, as specified in
 // the Single Unix Specification.
-func Snprintf(StringVar string, NVar uint, FormatVar string, varArgs ...interface{}) int {
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
+func Snprintf(StringVar string, NVar types.ULong, FormatVar string, varArgs ...interface{}) int {
 
-	cret := xSnprintf(StringVar, NVar, FormatVar, varArgs...)
+	cret := xSnprintf(StringVar, NVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 	return cret
 }
 
-var xVsnprintf func(string, uint, string, []interface{}) int
+var xVsnprintf func(string, types.ULong, string, []interface{}) int
 
 // A safer form of the standard `vsprintf()` function. The output is guaranteed
-// to not exceed @n characters (including the terminating nul character), so
+// to not exceed n characters (including the terminating nul character), so
 // it is easy to ensure that a buffer overflow cannot occur.
 //
-// See also [func@GLib.strdup_vprintf].
+// See also [StrdupVprintf].
 //
 // In versions of GLib prior to 1.2.3, this function may return -1 if the
 // output was truncated, and the truncated string may not be nul-terminated.
 // In versions prior to 1.3.12, this function returns the length of the output
 // string.
 //
-// The return value of `g_vsnprintf()` conforms to the `vsnprintf()` function
+// The return value of `[Vsnprintf]` conforms to the `vsnprintf()` function
 // as standardized in ISO C99. Note that this is different from traditional
 // `vsnprintf()`, which returns the length of the output string.
 //
 // The format string may contain positional parameters, as specified in
 // the Single Unix Specification.
-func Vsnprintf(StringVar string, NVar uint, FormatVar string, ArgsVar []interface{}) int {
+func Vsnprintf(StringVar string, NVar types.ULong, FormatVar string, ArgsVar []interface{}) int {
 
 	cret := xVsnprintf(StringVar, NVar, FormatVar, ArgsVar)
 
@@ -773,43 +849,41 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAtexit, libs, "g_atexit")
-	core.PuregoSafeRegister(&xBitNthLsf, libs, "g_bit_nth_lsf")
-	core.PuregoSafeRegister(&xBitNthMsf, libs, "g_bit_nth_msf")
-	core.PuregoSafeRegister(&xBitStorage, libs, "g_bit_storage")
-	core.PuregoSafeRegister(&xFindProgramInPath, libs, "g_find_program_in_path")
-	core.PuregoSafeRegister(&xFormatSize, libs, "g_format_size")
-	core.PuregoSafeRegister(&xFormatSizeForDisplay, libs, "g_format_size_for_display")
-	core.PuregoSafeRegister(&xFormatSizeFull, libs, "g_format_size_full")
-	core.PuregoSafeRegister(&xGetApplicationName, libs, "g_get_application_name")
-	core.PuregoSafeRegister(&xGetHomeDir, libs, "g_get_home_dir")
-	core.PuregoSafeRegister(&xGetHostName, libs, "g_get_host_name")
-	core.PuregoSafeRegister(&xGetOsInfo, libs, "g_get_os_info")
-	core.PuregoSafeRegister(&xGetPrgname, libs, "g_get_prgname")
-	core.PuregoSafeRegister(&xGetRealName, libs, "g_get_real_name")
-	core.PuregoSafeRegister(&xGetSystemConfigDirs, libs, "g_get_system_config_dirs")
-	core.PuregoSafeRegister(&xGetSystemDataDirs, libs, "g_get_system_data_dirs")
-	core.PuregoSafeRegister(&xGetTmpDir, libs, "g_get_tmp_dir")
-	core.PuregoSafeRegister(&xGetUserCacheDir, libs, "g_get_user_cache_dir")
-	core.PuregoSafeRegister(&xGetUserConfigDir, libs, "g_get_user_config_dir")
-	core.PuregoSafeRegister(&xGetUserDataDir, libs, "g_get_user_data_dir")
-	core.PuregoSafeRegister(&xGetUserName, libs, "g_get_user_name")
-	core.PuregoSafeRegister(&xGetUserRuntimeDir, libs, "g_get_user_runtime_dir")
-	core.PuregoSafeRegister(&xGetUserSpecialDir, libs, "g_get_user_special_dir")
-	core.PuregoSafeRegister(&xGetUserStateDir, libs, "g_get_user_state_dir")
-	core.PuregoSafeRegister(&xNullifyPointer, libs, "g_nullify_pointer")
-	core.PuregoSafeRegister(&xParseDebugString, libs, "g_parse_debug_string")
-	core.PuregoSafeRegister(&xReloadUserSpecialDirsCache, libs, "g_reload_user_special_dirs_cache")
-	core.PuregoSafeRegister(&xSetApplicationName, libs, "g_set_application_name")
-	core.PuregoSafeRegister(&xSetPrgname, libs, "g_set_prgname")
-	core.PuregoSafeRegister(&xSnprintf, libs, "g_snprintf")
-	core.PuregoSafeRegister(&xVsnprintf, libs, "g_vsnprintf")
+	core.PuregoRegisterSince(&xBitNthLsf, libs, "g_bit_nth_lsf", "GLIB", "")
+	core.PuregoRegisterSince(&xBitNthMsf, libs, "g_bit_nth_msf", "GLIB", "")
+	core.PuregoRegisterSince(&xBitStorage, libs, "g_bit_storage", "GLIB", "")
+	core.PuregoRegisterSince(&xFindProgramInPath, libs, "g_find_program_in_path", "GLIB", "")
+	core.PuregoRegisterSince(&xFormatSize, libs, "g_format_size", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xFormatSizeFull, libs, "g_format_size_full", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xGetApplicationName, libs, "g_get_application_name", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xGetHomeDir, libs, "g_get_home_dir", "GLIB", "")
+	core.PuregoRegisterSince(&xGetHostName, libs, "g_get_host_name", "GLIB", "2.8")
+	core.PuregoRegisterSince(&xGetOsInfo, libs, "g_get_os_info", "GLIB", "2.64")
+	core.PuregoRegisterSince(&xGetPrgname, libs, "g_get_prgname", "GLIB", "")
+	core.PuregoRegisterSince(&xGetRealName, libs, "g_get_real_name", "GLIB", "")
+	core.PuregoRegisterSince(&xGetSystemConfigDirs, libs, "g_get_system_config_dirs", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetSystemDataDirs, libs, "g_get_system_data_dirs", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetTmpDir, libs, "g_get_tmp_dir", "GLIB", "")
+	core.PuregoRegisterSince(&xGetUserCacheDir, libs, "g_get_user_cache_dir", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetUserConfigDir, libs, "g_get_user_config_dir", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetUserDataDir, libs, "g_get_user_data_dir", "GLIB", "2.6")
+	core.PuregoRegisterSince(&xGetUserName, libs, "g_get_user_name", "GLIB", "")
+	core.PuregoRegisterSince(&xGetUserRuntimeDir, libs, "g_get_user_runtime_dir", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xGetUserSpecialDir, libs, "g_get_user_special_dir", "GLIB", "2.14")
+	core.PuregoRegisterSince(&xGetUserStateDir, libs, "g_get_user_state_dir", "GLIB", "2.72")
+	core.PuregoRegisterSince(&xNullifyPointer, libs, "g_nullify_pointer", "GLIB", "")
+	core.PuregoRegisterSince(&xParseDebugString, libs, "g_parse_debug_string", "GLIB", "")
+	core.PuregoRegisterSince(&xReloadUserSpecialDirsCache, libs, "g_reload_user_special_dirs_cache", "GLIB", "2.22")
+	core.PuregoRegisterSince(&xSetApplicationName, libs, "g_set_application_name", "GLIB", "2.2")
+	core.PuregoRegisterSince(&xSetPrgname, libs, "g_set_prgname", "GLIB", "")
+	core.PuregoRegisterSince(&xSnprintf, libs, "g_snprintf", "GLIB", "")
+	core.PuregoRegisterSince(&xVsnprintf, libs, "g_vsnprintf", "GLIB", "")
 
 }
@@ -0,0 +1,105 @@
+//go:build !puregotk_no_deprecated
+
+// Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package glib
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xAtexit func(uintptr)
+
+// Specifies a function to be called at normal program termination.
+//
+// Since GLib 2.8.2, on Windows [Atexit] actually is a preprocessor
+// macro that maps to a call to the atexit() function in the C
+// library. This means that in case the code that calls [Atexit],
+// i.e. atexit(), is in a DLL, the function will be called when the
+// DLL is detached from the program. This typically makes more sense
+// than that the function is called when the GLib DLL is detached,
+// which happened earlier when [Atexit] was a function in the GLib
+// DLL.
+//
+// The behaviour of atexit() in the context of dynamically loaded
+// modules is not formally specified and varies wildly.
+//
+// On POSIX systems, calling [Atexit] (or atexit()) in a dynamically
+// loaded module which is unloaded before the program terminates might
+// well cause a crash at program exit.
+//
+// Some POSIX systems implement atexit() like Windows, and have each
+// dynamically loaded module maintain an own atexit chain that is
+// called when the module is unloaded.
+//
+// On other POSIX systems, before a dynamically loaded module is
+// unloaded, the registered atexit functions (if any) residing in that
+// module are called, regardless where the code that registered them
+// resided. This is presumably the most robust approach.
+//
+// As can be seen from the above, for portability it's best to avoid
+// calling [Atexit] (or atexit()) except in the main executable of a
+// program.
+//
+// Deprecated: since 2.32. It is best to avoid [Atexit].
+func Atexit(FuncVar *VoidFunc) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func() {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn()
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	xAtexit(FuncVarRef)
+
+}
+
+var xFormatSizeForDisplay func(int64) string
+
+// Formats a size (for example the size of a file) into a human
+// readable string. Sizes are rounded to the nearest size prefix
+// (KB, MB, GB) and are displayed rounded to the nearest tenth.
+// E.g. the file size 3292528 bytes will be converted into the
+// string "3.1 MB".
+//
+// The prefix units base is 1024 (i.e. 1 KB is 1024 bytes).
+//
+// This string should be freed with [Free] when not needed any longer.
+//
+// Deprecated: since 2.30. This function is broken due to its use of SI
+//
+//	suffixes to denote IEC units. Use [FormatSize] instead.
+func FormatSizeForDisplay(SizeVar int64) string {
+
+	cret := xFormatSizeForDisplay(SizeVar)
+
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GLIB", "glib-2.0")
+	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xAtexit, libs, "g_atexit", "GLIB", "")
+	core.PuregoRegisterSince(&xFormatSizeForDisplay, libs, "g_format_size_for_display", "GLIB", "2.16")
+
+}
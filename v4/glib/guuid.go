@@ -2,13 +2,12 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 var xUuidStringIsValid func(string) bool
 
-// Parses the string @str and verify if it is a UUID.
+// Parses the string str and verify if it is a UUID.
 //
 // The function accepts the following syntax:
 //
@@ -26,7 +25,7 @@ func UuidStringIsValid(StrVar string) bool {
 var xUuidStringRandom func() string
 
 // Generates a random UUID (RFC 4122 version 4) as a string. It has the same
-// randomness guarantees as #GRand, so must not be used for cryptographic
+// randomness guarantees as [Rand], so must not be used for cryptographic
 // purposes such as key generation, nonces, salts or one-time pads.
 func UuidStringRandom() string {
 
@@ -40,14 +39,14 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xUuidStringIsValid, libs, "g_uuid_string_is_valid")
-	core.PuregoSafeRegister(&xUuidStringRandom, libs, "g_uuid_string_random")
+	core.PuregoRegisterSince(&xUuidStringIsValid, libs, "g_uuid_string_is_valid", "GLIB", "2.52")
+	core.PuregoRegisterSince(&xUuidStringRandom, libs, "g_uuid_string_random", "GLIB", "2.52")
 
 }
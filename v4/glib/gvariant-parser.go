@@ -1,33 +1,2 @@
 // Package glib was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package glib
-
-import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
-)
-
-var xVariantParserGetErrorQuark func() Quark
-
-// Same as g_variant_error_quark().
-func VariantParserGetErrorQuark() Quark {
-
-	cret := xVariantParserGetErrorQuark()
-
-	return cret
-}
-
-func init() {
-	core.SetPackageName("GLIB", "glib-2.0")
-	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xVariantParserGetErrorQuark, libs, "g_variant_parser_get_error_quark")
-
-}
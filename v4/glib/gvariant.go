@@ -2,10 +2,10 @@
 package glib
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -20,7 +20,7 @@ import (
 //
 // `GVariant` is useful whenever data needs to be serialized, for example when
 // sending method parameters in D-Bus, or when saving settings using
-// [`GSettings`](../gio/class.Settings.html).
+// GSettings.
 //
 // When creating a new `GVariant`, you pass the data you want to store in it
 // along with a string representing the type of data you wish to pass to it.
@@ -28,28 +28,26 @@ import (
 // For instance, if you want to create a `GVariant` holding an integer value you
 // can use:
 //
-// ```c
-// GVariant *v = g_variant_new ("u", 40);
-// ```
+//	GVariant *v = g_variant_new ("u", 40);
 //
 // The string `u` in the first argument tells `GVariant` that the data passed to
 // the constructor (`40`) is going to be an unsigned integer.
 //
 // More advanced examples of `GVariant` in use can be found in documentation for
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 //
 // The range of possible values is determined by the type.
 //
-// The type system used by `GVariant` is [type@GLib.VariantType].
+// The type system used by `GVariant` is [VariantType].
 //
 // `GVariant` instances always have a type and a value (which are given
 // at construction time).  The type and value of a `GVariant` instance
 // can never change other than by the `GVariant` itself being
 // destroyed.  A `GVariant` cannot contain a pointer.
 //
-// `GVariant` is reference counted using [method@GLib.Variant.ref] and
-// [method@GLib.Variant.unref].  `GVariant` also has floating reference counts —
-// see [method@GLib.Variant.ref_sink].
+// `GVariant` is reference counted using [Variant.Ref] and
+// [Variant.Unref].  `GVariant` also has floating reference counts —
+// see [Variant.RefSink].
 //
 // `GVariant` is completely threadsafe.  A `GVariant` instance can be
 // concurrently accessed in any way from any number of threads without
@@ -62,10 +60,10 @@ import (
 // Serialized `GVariant` data can also be sent over the network.
 //
 // `GVariant` is largely compatible with D-Bus.  Almost all types of
-// `GVariant` instances can be sent over D-Bus.  See [type@GLib.VariantType] for
+// `GVariant` instances can be sent over D-Bus.  See [VariantType] for
 // exceptions.  (However, `GVariant`’s serialization format is not the same
 // as the serialization format of a D-Bus message body: use
-// [GDBusMessage](../gio/class.DBusMessage.html), in the GIO library, for those.)
+// GDBusMessage, in the GIO library, for those.)
 //
 // For space-efficiency, the `GVariant` serialization format does not
 // automatically include the variant’s length, type or endianness,
@@ -79,7 +77,7 @@ import (
 // A `GVariant`’s size is limited mainly by any lower level operating
 // system constraints, such as the number of bits in `gsize`.  For
 // example, it is reasonable to have a 2GB file mapped into memory
-// with [struct@GLib.MappedFile], and call [ctor@GLib.Variant.new_from_data] on
+// with [MappedFile], and call [NewVariantFromData] on
 // it.
 //
 // For convenience to C programmers, `GVariant` features powerful
@@ -90,7 +88,7 @@ import (
 // values.  `GVariant` includes a printer for this language and a parser
 // with type inferencing.
 //
-// ## Memory Use
+// # Memory Use
 //
 // `GVariant` tries to be quite efficient with respect to memory use.
 // This section gives a rough idea of how much memory is used by the
@@ -102,7 +100,7 @@ import (
 // information cache, buffer management memory and memory for the
 // `GVariant` structure itself.
 //
-// ## Serialized Data Memory
+// # Serialized Data Memory
 //
 // This is the memory that is used for storing `GVariant` data in
 // serialized form.  This is what would be sent over the network or
@@ -161,7 +159,7 @@ import (
 // bytes. 14 + 2 + 11 + 2 = 29 bytes to encode the entire two-item
 // dictionary.
 //
-// ## Type Information Cache
+// # Type Information Cache
 //
 // For each `GVariant` type that currently exists in the program a type
 // information structure is kept in the type information cache.  The
@@ -197,7 +195,7 @@ import (
 // This means that in total, for our `a{sv}` example, 91 bytes of
 // type information would be allocated.
 //
-// The type information cache, additionally, uses a [struct@GLib.HashTable] to
+// The type information cache, additionally, uses a [HashTable] to
 // store and look up the cached items and stores a pointer to this
 // hash table in static storage.  The hash table is freed when there
 // are zero items in the type cache.
@@ -207,20 +205,20 @@ import (
 // different types of values in it and that only one type information
 // structure is required for many different values of the same type.
 //
-// ## Buffer Management Memory
+// # Buffer Management Memory
 //
 // `GVariant` uses an internal buffer management structure to deal
 // with the various different possible sources of serialized data
 // that it uses.  The buffer is responsible for ensuring that the
 // correct call is made when the data is no longer in use by
-// `GVariant`.  This may involve a [func@GLib.free] or
-// even [method@GLib.MappedFile.unref].
+// `GVariant`.  This may involve a [Free] or
+// even [MappedFile.Unref].
 //
 // One buffer management structure is used for each chunk of
 // serialized data.  The size of the buffer management structure
 // is `4 * (void *)`.  On 32-bit systems, that’s 16 bytes.
 //
-// ## GVariant structure
+// # GVariant structure
 //
 // The size of a `GVariant` structure is `6 * (void *)`.  On 32-bit
 // systems, that’s 24 bytes.
@@ -236,19 +234,19 @@ import (
 //
 // If calls are made to start accessing the other values then
 // `GVariant` instances will exist for those values only for as long
-// as they are in use (ie: until you call [method@GLib.Variant.unref]).  The
+// as they are in use (ie: until you call [Variant.Unref]).  The
 // type information is shared.  The serialized data and the buffer
 // management structure for that serialized data is shared by the
 // child.
 //
-// ## Summary
+// # Summary
 //
 // To put the entire example together, for our dictionary mapping
 // strings to variants (with two entries, as given above), we are
 // using 91 bytes of memory for type information, 29 bytes of memory
 // for the serialized data, 16 bytes for buffer management and 24
 // bytes for the `GVariant` instance, or a total of 160 bytes, plus
-// allocation overhead.  If we were to use [method@GLib.Variant.get_child_value]
+// allocation overhead.  If we were to use [Variant.GetChildValue]
 // to access the two dictionary entries, we would use an additional 48
 // bytes.  If we were to have other dictionaries of the same type, we
 // would use more memory for the serialized data and buffer
@@ -270,36 +268,32 @@ func (x *Variant) GoPointer() uintptr {
 
 var xNewVariant func(string, ...interface{}) *Variant
 
-// Creates a new #GVariant instance.
+// Creates a new [Variant] instance.
 //
-// Think of this function as an analogue to g_strdup_printf().
+// Think of this function as an analogue to [StrdupPrintf].
 //
 // The type of the created instance and the arguments that are expected
-// by this function are determined by @format_string. See the section on
-// [GVariant format strings](gvariant-format-strings.html). Please note that
+// by this function are determined by format_string. See the section on
+// GVariant format strings. Please note that
 // the syntax of the format string is very likely to be extended in the
 // future.
 //
 // The first character of the format string must not be '*' '?' '@' or
-// 'r'; in essence, a new #GVariant must always be constructed by this
+// 'r'; in essence, a new [Variant] must always be constructed by this
 // function (and not merely passed through it unmodified).
 //
 // Note that the arguments must be of the correct width for their types
-// specified in @format_string. This can be achieved by casting them. See
-// the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// specified in format_string. This can be achieved by casting them. See
+// the GVariant varargs documentation.
 //
-// |[&lt;!-- language="C" --&gt;
-// MyFlags some_flags = FLAG_ONE | FLAG_TWO;
-// const gchar *some_strings[] = { "a", "b", "c", NULL };
-// GVariant *new_variant;
+//	MyFlags some_flags = FLAG_ONE | FLAG_TWO;
+//	const gchar *some_strings[] = { "a", "b", "c", NULL };
+//	GVariant *new_variant;
 //
-// new_variant = g_variant_new ("(t^as)",
-//
-//	// This cast is required.
-//	(guint64) some_flags,
-//	some_strings);
-//
-// ]|
+//	new_variant = g_variant_new ("(t^as)",
+//	                             // This cast is required.
+//	                             (guint64) some_flags,
+//	                             some_strings);
 func NewVariant(FormatStringVar string, varArgs ...interface{}) *Variant {
 
 	cret := xNewVariant(FormatStringVar, varArgs...)
@@ -308,21 +302,21 @@ func NewVariant(FormatStringVar string, varArgs ...interface{}) *Variant {
 
 var xNewVariantArray func(*VariantType, uintptr, uint) *Variant
 
-// Creates a new #GVariant array from @children.
+// Creates a new [Variant] array from children.
 //
-// @child_type must be non-%NULL if @n_children is zero.  Otherwise, the
+// child_type must be non-nil if n_children is zero.  Otherwise, the
 // child type is determined by inspecting the first element of the
-// @children array.  If @child_type is non-%NULL then it must be a
+// children array.  If child_type is non-nil then it must be a
 // definite type.
 //
-// The items of the array are taken from the @children array.  No entry
-// in the @children array may be %NULL.
+// The items of the array are taken from the children array.  No entry
+// in the children array may be nil.
 //
 // All items in the array must have the same type, which must be the
-// same as @child_type, if given.
+// same as child_type, if given.
 //
-// If the @children are floating references (see g_variant_ref_sink()), the
-// new instance takes ownership of them as if via g_variant_ref_sink().
+// If the children are floating references (see [Variant.RefSink]), the
+// new instance takes ownership of them as if via [Variant.RefSink].
 func NewVariantArray(ChildTypeVar *VariantType, ChildrenVar uintptr, NChildrenVar uint) *Variant {
 
 	cret := xNewVariantArray(ChildTypeVar, ChildrenVar, NChildrenVar)
@@ -331,7 +325,7 @@ func NewVariantArray(ChildTypeVar *VariantType, ChildrenVar uintptr, NChildrenVa
 
 var xNewVariantBoolean func(bool) *Variant
 
-// Creates a new boolean #GVariant instance -- either %TRUE or %FALSE.
+// Creates a new boolean [Variant] instance -- either true or false.
 func NewVariantBoolean(ValueVar bool) *Variant {
 
 	cret := xNewVariantBoolean(ValueVar)
@@ -340,7 +334,7 @@ func NewVariantBoolean(ValueVar bool) *Variant {
 
 var xNewVariantByte func(byte) *Variant
 
-// Creates a new byte #GVariant instance.
+// Creates a new byte [Variant] instance.
 func NewVariantByte(ValueVar byte) *Variant {
 
 	cret := xNewVariantByte(ValueVar)
@@ -349,8 +343,8 @@ func NewVariantByte(ValueVar byte) *Variant {
 
 var xNewVariantBytestring func([]byte) *Variant
 
-// Creates an array-of-bytes #GVariant with the contents of @string.
-// This function is just like g_variant_new_string() except that the
+// Creates an array-of-bytes [Variant] with the contents of string.
+// This function is just like [NewVariantString] except that the
 // string need not be valid UTF-8.
 //
 // The nul terminator character at the end of the string is stored in
@@ -363,10 +357,10 @@ func NewVariantBytestring(StringVar []byte) *Variant {
 
 var xNewVariantBytestringArray func([]string, int) *Variant
 
-// Constructs an array of bytestring #GVariant from the given array of
+// Constructs an array of bytestring [Variant] from the given array of
 // strings.
 //
-// If @length is -1 then @strv is %NULL-terminated.
+// If length is -1 then strv is nil-terminated.
 func NewVariantBytestringArray(StrvVar []string, LengthVar int) *Variant {
 
 	cret := xNewVariantBytestringArray(StrvVar, LengthVar)
@@ -375,11 +369,11 @@ func NewVariantBytestringArray(StrvVar []string, LengthVar int) *Variant {
 
 var xNewVariantDictEntry func(*Variant, *Variant) *Variant
 
-// Creates a new dictionary entry #GVariant. @key and @value must be
-// non-%NULL. @key must be a value of a basic type (ie: not a container).
+// Creates a new dictionary entry [Variant]. key and value must be
+// non-nil. key must be a value of a basic type (ie: not a container).
 //
-// If the @key or @value are floating references (see g_variant_ref_sink()),
-// the new instance takes ownership of them as if via g_variant_ref_sink().
+// If the key or value are floating references (see [Variant.RefSink]),
+// the new instance takes ownership of them as if via [Variant.RefSink].
 func NewVariantDictEntry(KeyVar *Variant, ValueVar *Variant) *Variant {
 
 	cret := xNewVariantDictEntry(KeyVar, ValueVar)
@@ -388,7 +382,7 @@ func NewVariantDictEntry(KeyVar *Variant, ValueVar *Variant) *Variant {
 
 var xNewVariantDouble func(float64) *Variant
 
-// Creates a new double #GVariant instance.
+// Creates a new double [Variant] instance.
 func NewVariantDouble(ValueVar float64) *Variant {
 
 	cret := xNewVariantDouble(ValueVar)
@@ -397,19 +391,19 @@ func NewVariantDouble(ValueVar float64) *Variant {
 
 var xNewVariantFixedArray func(*VariantType, uintptr, uint, uint) *Variant
 
-// Constructs a new array #GVariant instance, where the elements are
-// of @element_type type.
+// Constructs a new array [Variant] instance, where the elements are
+// of element_type type.
 //
-// @elements must be an array with fixed-sized elements.  Numeric types are
+// elements must be an array with fixed-sized elements.  Numeric types are
 // fixed-size as are tuples containing only other fixed-sized types.
 //
-// @element_size must be the size of a single element in the array.
+// element_size must be the size of a single element in the array.
 // For example, if calling this function for an array of 32-bit integers,
 // you might say sizeof(gint32). This value isn't used except for the purpose
 // of a double-check that the form of the serialized data matches the caller's
 // expectation.
 //
-// @n_elements must be the length of the @elements array.
+// n_elements must be the length of the elements array.
 func NewVariantFixedArray(ElementTypeVar *VariantType, ElementsVar uintptr, NElementsVar uint, ElementSizeVar uint) *Variant {
 
 	cret := xNewVariantFixedArray(ElementTypeVar, ElementsVar, NElementsVar, ElementSizeVar)
@@ -418,13 +412,13 @@ func NewVariantFixedArray(ElementTypeVar *VariantType, ElementsVar uintptr, NEle
 
 var xNewVariantFromBytes func(*VariantType, *Bytes, bool) *Variant
 
-// Constructs a new serialized-mode #GVariant instance.  This is the
+// Constructs a new serialized-mode [Variant] instance.  This is the
 // inner interface for creation of new serialized values that gets
 // called from various functions in gvariant.c.
 //
-// A reference is taken on @bytes.
+// A reference is taken on bytes.
 //
-// The data in @bytes must be aligned appropriately for the @type being loaded.
+// The data in bytes must be aligned appropriately for the type being loaded.
 // Otherwise this function will internally create a copy of the memory (since
 // GLib 2.60) or (in older versions) fail and exit the process.
 func NewVariantFromBytes(TypeVar *VariantType, BytesVar *Bytes, TrustedVar bool) *Variant {
@@ -435,33 +429,33 @@ func NewVariantFromBytes(TypeVar *VariantType, BytesVar *Bytes, TrustedVar bool)
 
 var xNewVariantFromData func(*VariantType, []byte, uint, bool, uintptr, uintptr) *Variant
 
-// Creates a new #GVariant instance from serialized data.
+// Creates a new [Variant] instance from serialized data.
 //
-// @type is the type of #GVariant instance that will be constructed.
-// The interpretation of @data depends on knowing the type.
+// type is the type of [Variant] instance that will be constructed.
+// The interpretation of data depends on knowing the type.
 //
-// @data is not modified by this function and must remain valid with an
-// unchanging value until such a time as @notify is called with
-// @user_data.  If the contents of @data change before that time then
+// data is not modified by this function and must remain valid with an
+// unchanging value until such a time as notify is called with
+// user_data.  If the contents of data change before that time then
 // the result is undefined.
 //
-// If @data is trusted to be serialized data in normal form then
-// @trusted should be %TRUE.  This applies to serialized data created
+// If data is trusted to be serialized data in normal form then
+// trusted should be true.  This applies to serialized data created
 // within this process or read from a trusted location on the disk (such
 // as a file installed in /usr/lib alongside your application).  You
-// should set trusted to %FALSE if @data is read from the network, a
+// should set trusted to false if data is read from the network, a
 // file in the user's home directory, etc.
 //
-// If @data was not stored in this machine's native endianness, any multi-byte
+// If data was not stored in this machine's native endianness, any multi-byte
 // numeric values in the returned variant will also be in non-native
-// endianness. g_variant_byteswap() can be used to recover the original values.
+// endianness. [Variant.Byteswap] can be used to recover the original values.
 //
-// @notify will be called with @user_data when @data is no longer
+// notify will be called with user_data when data is no longer
 // needed.  The exact time of this call is unspecified and might even be
 // before this function returns.
 //
-// Note: @data must be backed by memory that is aligned appropriately for the
-// @type being loaded. Otherwise this function will internally create a copy of
+// Note: data must be backed by memory that is aligned appropriately for the
+// type being loaded. Otherwise this function will internally create a copy of
 // the memory (since GLib 2.60) or (in older versions) fail and exit the
 // process.
 func NewVariantFromData(TypeVar *VariantType, DataVar []byte, SizeVar uint, TrustedVar bool, NotifyVar *DestroyNotify, UserDataVar uintptr) *Variant {
@@ -473,11 +467,12 @@ func NewVariantFromData(TypeVar *VariantType, DataVar []byte, SizeVar uint, Trus
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -487,7 +482,7 @@ func NewVariantFromData(TypeVar *VariantType, DataVar []byte, SizeVar uint, Trus
 
 var xNewVariantHandle func(int32) *Variant
 
-// Creates a new handle #GVariant instance.
+// Creates a new handle [Variant] instance.
 //
 // By convention, handles are indexes into an array of file descriptors
 // that are sent alongside a D-Bus message.  If you're not interacting
@@ -500,7 +495,7 @@ func NewVariantHandle(ValueVar int32) *Variant {
 
 var xNewVariantInt16 func(int16) *Variant
 
-// Creates a new int16 #GVariant instance.
+// Creates a new int16 [Variant] instance.
 func NewVariantInt16(ValueVar int16) *Variant {
 
 	cret := xNewVariantInt16(ValueVar)
@@ -509,7 +504,7 @@ func NewVariantInt16(ValueVar int16) *Variant {
 
 var xNewVariantInt32 func(int32) *Variant
 
-// Creates a new int32 #GVariant instance.
+// Creates a new int32 [Variant] instance.
 func NewVariantInt32(ValueVar int32) *Variant {
 
 	cret := xNewVariantInt32(ValueVar)
@@ -518,7 +513,7 @@ func NewVariantInt32(ValueVar int32) *Variant {
 
 var xNewVariantInt64 func(int64) *Variant
 
-// Creates a new int64 #GVariant instance.
+// Creates a new int64 [Variant] instance.
 func NewVariantInt64(ValueVar int64) *Variant {
 
 	cret := xNewVariantInt64(ValueVar)
@@ -527,16 +522,16 @@ func NewVariantInt64(ValueVar int64) *Variant {
 
 var xNewVariantMaybe func(*VariantType, *Variant) *Variant
 
-// Depending on if @child is %NULL, either wraps @child inside of a
-// maybe container or creates a Nothing instance for the given @type.
+// Depending on if child is nil, either wraps child inside of a
+// maybe container or creates a Nothing instance for the given type.
 //
-// At least one of @child_type and @child must be non-%NULL.
-// If @child_type is non-%NULL then it must be a definite type.
-// If they are both non-%NULL then @child_type must be the type
-// of @child.
+// At least one of child_type and child must be non-nil.
+// If child_type is non-nil then it must be a definite type.
+// If they are both non-nil then child_type must be the type
+// of child.
 //
-// If @child is a floating reference (see g_variant_ref_sink()), the new
-// instance takes ownership of @child.
+// If child is a floating reference (see [Variant.RefSink]), the new
+// instance takes ownership of child.
 func NewVariantMaybe(ChildTypeVar *VariantType, ChildVar *Variant) *Variant {
 
 	cret := xNewVariantMaybe(ChildTypeVar, ChildVar)
@@ -545,9 +540,9 @@ func NewVariantMaybe(ChildTypeVar *VariantType, ChildVar *Variant) *Variant {
 
 var xNewVariantObjectPath func(string) *Variant
 
-// Creates a D-Bus object path #GVariant with the contents of @object_path.
-// @object_path must be a valid D-Bus object path.  Use
-// g_variant_is_object_path() if you're not sure.
+// Creates a D-Bus object path [Variant] with the contents of object_path.
+// object_path must be a valid D-Bus object path.  Use
+// [VariantIsObjectPath] if you're not sure.
 func NewVariantObjectPath(ObjectPathVar string) *Variant {
 
 	cret := xNewVariantObjectPath(ObjectPathVar)
@@ -556,13 +551,13 @@ func NewVariantObjectPath(ObjectPathVar string) *Variant {
 
 var xNewVariantObjv func([]string, int) *Variant
 
-// Constructs an array of object paths #GVariant from the given array of
+// Constructs an array of object paths [Variant] from the given array of
 // strings.
 //
-// Each string must be a valid #GVariant object path; see
-// g_variant_is_object_path().
+// Each string must be a valid [Variant] object path; see
+// [VariantIsObjectPath].
 //
-// If @length is -1 then @strv is %NULL-terminated.
+// If length is -1 then strv is nil-terminated.
 func NewVariantObjv(StrvVar []string, LengthVar int) *Variant {
 
 	cret := xNewVariantObjv(StrvVar, LengthVar)
@@ -571,38 +566,34 @@ func NewVariantObjv(StrvVar []string, LengthVar int) *Variant {
 
 var xNewVariantParsed func(string, ...interface{}) *Variant
 
-// Parses @format and returns the result.
+// Parses format and returns the result.
 //
-// @format must be a text format #GVariant with one extension: at any
+// format must be a text format [Variant] with one extension: at any
 // point that a value may appear in the text, a '%' character followed
-// by a GVariant format string (as per g_variant_new()) may appear.  In
+// by a GVariant format string (as per [NewVariant]) may appear.  In
 // that case, the same arguments are collected from the argument list as
-// g_variant_new() would have collected.
+// [NewVariant] would have collected.
 //
 // Note that the arguments must be of the correct width for their types
-// specified in @format. This can be achieved by casting them. See
-// the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// specified in format. This can be achieved by casting them. See
+// the GVariant varargs documentation.
 //
 // Consider this simple example:
-// |[&lt;!-- language="C" --&gt;
 //
 //	g_variant_new_parsed ("[('one', 1), ('two', %i), (%s, 3)]", 2, "three");
 //
-// ]|
-//
 // In the example, the variable argument parameters are collected and
 // filled in as if they were part of the original string to produce the
 // result of
-// |[&lt;!-- language="C" --&gt;
-// [('one', 1), ('two', 2), ('three', 3)]
-// ]|
 //
-// This function is intended only to be used with @format as a string
+//	[('one', 1), ('two', 2), ('three', 3)]
+//
+// This function is intended only to be used with format as a string
 // literal.  Any parse error is fatal to the calling process.  If you
-// want to parse data from untrusted sources, use g_variant_parse().
+// want to parse data from untrusted sources, use [VariantParse].
 //
 // You may not use this function to return, unmodified, a single
-// #GVariant pointer from the argument list.  ie: @format may not solely
+// [Variant] pointer from the argument list.  ie: format may not solely
 // be anything along the lines of "%*", "%?", "\%r", or anything starting
 // with "%@".
 func NewVariantParsed(FormatVar string, varArgs ...interface{}) *Variant {
@@ -613,27 +604,27 @@ func NewVariantParsed(FormatVar string, varArgs ...interface{}) *Variant {
 
 var xNewVariantParsedVa func(string, []interface{}) *Variant
 
-// Parses @format and returns the result.
+// Parses format and returns the result.
 //
-// This is the version of g_variant_new_parsed() intended to be used
+// This is the version of [NewVariantParsed] intended to be used
 // from libraries.
 //
 // The return value will be floating if it was a newly created GVariant
-// instance.  In the case that @format simply specified the collection
-// of a #GVariant pointer (eg: @format was "%*") then the collected
-// #GVariant pointer will be returned unmodified, without adding any
+// instance.  In the case that format simply specified the collection
+// of a [Variant] pointer (eg: format was "%*") then the collected
+// [Variant] pointer will be returned unmodified, without adding any
 // additional references.
 //
-// Note that the arguments in @app must be of the correct width for their types
-// specified in @format when collected into the #va_list. See
-// the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// Note that the arguments in app must be of the correct width for their types
+// specified in format when collected into the #va_list. See
+// the GVariant varargs documentation.
 //
 // In order to behave correctly in all cases it is necessary for the
-// calling function to g_variant_ref_sink() the return result before
+// calling function to [Variant.RefSink] the return result before
 // returning control to the user that originally provided the pointer.
 // At this point, the caller will have their own full reference to the
 // result.  This can also be done by adding the result to a container,
-// or by passing it to another g_variant_new() call.
+// or by passing it to another [NewVariant] call.
 func NewVariantParsedVa(FormatVar string, AppVar []interface{}) *Variant {
 
 	cret := xNewVariantParsedVa(FormatVar, AppVar)
@@ -644,20 +635,22 @@ var xNewVariantPrintf func(string, ...interface{}) *Variant
 
 // Creates a string-type GVariant using printf formatting.
 //
-// This is similar to calling g_strdup_printf() and then
-// g_variant_new_string() but it saves a temporary variable and an
+// This is similar to calling [StrdupPrintf] and then
+// [NewVariantString] but it saves a temporary variable and an
 // unnecessary copy.
+//
+// FormatStringVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func NewVariantPrintf(FormatStringVar string, varArgs ...interface{}) *Variant {
 
-	cret := xNewVariantPrintf(FormatStringVar, varArgs...)
+	cret := xNewVariantPrintf("%s", fmt.Sprintf(FormatStringVar, varArgs...))
 	return cret
 }
 
 var xNewVariantSignature func(string) *Variant
 
-// Creates a D-Bus type signature #GVariant with the contents of
-// @string.  @string must be a valid D-Bus type signature.  Use
-// g_variant_is_signature() if you're not sure.
+// Creates a D-Bus type signature [Variant] with the contents of
+// string.  string must be a valid D-Bus type signature.  Use
+// [VariantIsSignature] if you're not sure.
 func NewVariantSignature(SignatureVar string) *Variant {
 
 	cret := xNewVariantSignature(SignatureVar)
@@ -666,11 +659,11 @@ func NewVariantSignature(SignatureVar string) *Variant {
 
 var xNewVariantString func(string) *Variant
 
-// Creates a string #GVariant with the contents of @string.
+// Creates a string [Variant] with the contents of string.
 //
-// @string must be valid UTF-8, and must not be %NULL. To encode
-// potentially-%NULL strings, use g_variant_new() with `ms` as the
-// [format string](gvariant-format-strings.html#maybe-types).
+// string must be valid UTF-8, and must not be nil. To encode
+// potentially-nil strings, use [NewVariant] with `ms` as the
+// format string.
 func NewVariantString(StringVar string) *Variant {
 
 	cret := xNewVariantString(StringVar)
@@ -679,10 +672,10 @@ func NewVariantString(StringVar string) *Variant {
 
 var xNewVariantStrv func([]string, int) *Variant
 
-// Constructs an array of strings #GVariant from the given array of
+// Constructs an array of strings [Variant] from the given array of
 // strings.
 //
-// If @length is -1 then @strv is %NULL-terminated.
+// If length is -1 then strv is nil-terminated.
 func NewVariantStrv(StrvVar []string, LengthVar int) *Variant {
 
 	cret := xNewVariantStrv(StrvVar, LengthVar)
@@ -691,17 +684,17 @@ func NewVariantStrv(StrvVar []string, LengthVar int) *Variant {
 
 var xNewVariantTakeString func(string) *Variant
 
-// Creates a string #GVariant with the contents of @string.
+// Creates a string [Variant] with the contents of string.
 //
-// @string must be valid UTF-8, and must not be %NULL. To encode
-// potentially-%NULL strings, use this with g_variant_new_maybe().
+// string must be valid UTF-8, and must not be nil. To encode
+// potentially-nil strings, use this with [NewVariantMaybe].
 //
-// After this call, @string belongs to the #GVariant and may no longer be
-// modified by the caller. The memory of @data has to be dynamically
-// allocated and will eventually be freed with g_free().
+// After this call, string belongs to the [Variant] and may no longer be
+// modified by the caller. The memory of data has to be dynamically
+// allocated and will eventually be freed with [Free].
 //
-// You must not modify or access @string in any other way after passing
-// it to this function.  It is even possible that @string is immediately
+// You must not modify or access string in any other way after passing
+// it to this function.  It is even possible that string is immediately
 // freed.
 func NewVariantTakeString(StringVar string) *Variant {
 
@@ -711,14 +704,14 @@ func NewVariantTakeString(StringVar string) *Variant {
 
 var xNewVariantTuple func(uintptr, uint) *Variant
 
-// Creates a new tuple #GVariant out of the items in @children.  The
-// type is determined from the types of @children.  No entry in the
-// @children array may be %NULL.
+// Creates a new tuple [Variant] out of the items in children.  The
+// type is determined from the types of children.  No entry in the
+// children array may be nil.
 //
-// If @n_children is 0 then the unit tuple is constructed.
+// If n_children is 0 then the unit tuple is constructed.
 //
-// If the @children are floating references (see g_variant_ref_sink()), the
-// new instance takes ownership of them as if via g_variant_ref_sink().
+// If the children are floating references (see [Variant.RefSink]), the
+// new instance takes ownership of them as if via [Variant.RefSink].
 func NewVariantTuple(ChildrenVar uintptr, NChildrenVar uint) *Variant {
 
 	cret := xNewVariantTuple(ChildrenVar, NChildrenVar)
@@ -727,7 +720,7 @@ func NewVariantTuple(ChildrenVar uintptr, NChildrenVar uint) *Variant {
 
 var xNewVariantUint16 func(uint16) *Variant
 
-// Creates a new uint16 #GVariant instance.
+// Creates a new uint16 [Variant] instance.
 func NewVariantUint16(ValueVar uint16) *Variant {
 
 	cret := xNewVariantUint16(ValueVar)
@@ -736,7 +729,7 @@ func NewVariantUint16(ValueVar uint16) *Variant {
 
 var xNewVariantUint32 func(uint32) *Variant
 
-// Creates a new uint32 #GVariant instance.
+// Creates a new uint32 [Variant] instance.
 func NewVariantUint32(ValueVar uint32) *Variant {
 
 	cret := xNewVariantUint32(ValueVar)
@@ -745,7 +738,7 @@ func NewVariantUint32(ValueVar uint32) *Variant {
 
 var xNewVariantUint64 func(uint64) *Variant
 
-// Creates a new uint64 #GVariant instance.
+// Creates a new uint64 [Variant] instance.
 func NewVariantUint64(ValueVar uint64) *Variant {
 
 	cret := xNewVariantUint64(ValueVar)
@@ -755,41 +748,41 @@ func NewVariantUint64(ValueVar uint64) *Variant {
 var xNewVariantVa func(string, uintptr, []interface{}) *Variant
 
 // This function is intended to be used by libraries based on
-// #GVariant that want to provide g_variant_new()-like functionality
+// [Variant] that want to provide [NewVariant]-like functionality
 // to their users.
 //
-// The API is more general than g_variant_new() to allow a wider range
+// The API is more general than [NewVariant] to allow a wider range
 // of possible uses.
 //
-// @format_string must still point to a valid format string, but it only
-// needs to be nul-terminated if @endptr is %NULL.  If @endptr is
-// non-%NULL then it is updated to point to the first character past the
+// format_string must still point to a valid format string, but it only
+// needs to be nul-terminated if endptr is nil.  If endptr is
+// non-nil then it is updated to point to the first character past the
 // end of the format string.
 //
-// @app is a pointer to a #va_list.  The arguments, according to
-// @format_string, are collected from this #va_list and the list is left
+// app is a pointer to a #va_list.  The arguments, according to
+// format_string, are collected from this #va_list and the list is left
 // pointing to the argument following the last.
 //
-// Note that the arguments in @app must be of the correct width for their
-// types specified in @format_string when collected into the #va_list.
-// See the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// Note that the arguments in app must be of the correct width for their
+// types specified in format_string when collected into the #va_list.
+// See the GVariant varargs documentation.
 //
 // These two generalisations allow mixing of multiple calls to
-// g_variant_new_va() and g_variant_get_va() within a single actual
+// [NewVariantVa] and [Variant.GetVa] within a single actual
 // varargs call by the user.
 //
 // The return value will be floating if it was a newly created GVariant
 // instance (for example, if the format string was "(ii)").  In the case
 // that the format_string was '*', '?', 'r', or a format starting with
-// '@' then the collected #GVariant pointer will be returned unmodified,
+// '@' then the collected [Variant] pointer will be returned unmodified,
 // without adding any additional references.
 //
 // In order to behave correctly in all cases it is necessary for the
-// calling function to g_variant_ref_sink() the return result before
+// calling function to [Variant.RefSink] the return result before
 // returning control to the user that originally provided the pointer.
 // At this point, the caller will have their own full reference to the
 // result.  This can also be done by adding the result to a container,
-// or by passing it to another g_variant_new() call.
+// or by passing it to another [NewVariant] call.
 func NewVariantVa(FormatStringVar string, EndptrVar *string, AppVar []interface{}) *Variant {
 
 	EndptrVarPtr := core.GStrdupNullable(EndptrVar)
@@ -801,11 +794,11 @@ func NewVariantVa(FormatStringVar string, EndptrVar *string, AppVar []interface{
 
 var xNewVariantVariant func(*Variant) *Variant
 
-// Boxes @value.  The result is a #GVariant instance representing a
+// Boxes value.  The result is a [Variant] instance representing a
 // variant containing the original value.
 //
-// If @child is a floating reference (see g_variant_ref_sink()), the new
-// instance takes ownership of @child.
+// If child is a floating reference (see [Variant.RefSink]), the new
+// instance takes ownership of child.
 func NewVariantVariant(ValueVar *Variant) *Variant {
 
 	cret := xNewVariantVariant(ValueVar)
@@ -814,8 +807,8 @@ func NewVariantVariant(ValueVar *Variant) *Variant {
 
 var xVariantByteswap func(uintptr) *Variant
 
-// Performs a byteswapping operation on the contents of @value.  The
-// result is that all multi-byte numeric data contained in @value is
+// Performs a byteswapping operation on the contents of value.  The
+// result is that all multi-byte numeric data contained in value is
 // byteswapped.  That includes 16, 32, and 64bit signed and unsigned
 // integers as well as file handles and double precision floating point
 // values.
@@ -826,7 +819,7 @@ var xVariantByteswap func(uintptr) *Variant
 //
 // While this function can safely handle untrusted, non-normal data, it is
 // recommended to check whether the input is in normal form beforehand, using
-// g_variant_is_normal_form(), and to reject non-normal inputs if your
+// [Variant.IsNormalForm], and to reject non-normal inputs if your
 // application can be strict about what inputs it rejects.
 //
 // The returned value is always in normal form and is marked as trusted.
@@ -839,20 +832,20 @@ func (x *Variant) Byteswap() *Variant {
 
 var xVariantCheckFormatString func(uintptr, string, bool) bool
 
-// Checks if calling g_variant_get() with @format_string on @value would
-// be valid from a type-compatibility standpoint.  @format_string is
+// Checks if calling [Variant.Get] with format_string on value would
+// be valid from a type-compatibility standpoint.  format_string is
 // assumed to be a valid format string (from a syntactic standpoint).
 //
-// If @copy_only is %TRUE then this function additionally checks that it
-// would be safe to call g_variant_unref() on @value immediately after
-// the call to g_variant_get() without invalidating the result.  This is
+// If copy_only is true then this function additionally checks that it
+// would be safe to call [Variant.Unref] on value immediately after
+// the call to [Variant.Get] without invalidating the result.  This is
 // only possible if deep copies are made (ie: there are no pointers to
-// the data inside of the soon-to-be-freed #GVariant instance).  If this
-// check fails then a g_critical() is printed and %FALSE is returned.
+// the data inside of the soon-to-be-freed [Variant] instance).  If this
+// check fails then a g_critical() is printed and false is returned.
 //
 // This function is meant to be used by functions that wish to provide
-// varargs accessors to #GVariant values of uncertain values (eg:
-// g_variant_lookup() or g_menu_model_get_item_attribute()).
+// varargs accessors to [Variant] values of uncertain values (eg:
+// [Variant.Lookup] or [gio.MenuModel.GetItemAttribute]).
 func (x *Variant) CheckFormatString(FormatStringVar string, CopyOnlyVar bool) bool {
 
 	cret := xVariantCheckFormatString(x.GoPointer(), FormatStringVar, CopyOnlyVar)
@@ -861,7 +854,7 @@ func (x *Variant) CheckFormatString(FormatStringVar string, CopyOnlyVar bool) bo
 
 var xVariantClassify func(uintptr) VariantClass
 
-// Classifies @value according to its top-level type.
+// Classifies value according to its top-level type.
 func (x *Variant) Classify() VariantClass {
 
 	cret := xVariantClassify(x.GoPointer())
@@ -870,14 +863,14 @@ func (x *Variant) Classify() VariantClass {
 
 var xVariantCompare func(uintptr, uintptr) int
 
-// Compares @one and @two.
+// Compares one and two.
 //
-// The types of @one and @two are #gconstpointer only to allow use of
-// this function with #GTree, #GPtrArray, etc.  They must each be a
-// #GVariant.
+// The types of one and two are #gconstpointer only to allow use of
+// this function with [Tree], [PtrArray], etc.  They must each be a
+// [Variant].
 //
 // Comparison is only defined for basic types (ie: booleans, numbers,
-// strings).  For booleans, %FALSE is less than %TRUE.  Numbers are
+// strings).  For booleans, false is less than true.  Numbers are
 // ordered in the usual way.  Strings are in ASCII lexographical order.
 //
 // It is a programmer error to attempt to compare container values or
@@ -887,7 +880,7 @@ var xVariantCompare func(uintptr, uintptr) int
 // well-behaved when it comes to comparison of doubles; in particular,
 // the handling of incomparable values (ie: NaN) is undefined.
 //
-// If you only require an equality comparison, g_variant_equal() is more
+// If you only require an equality comparison, [Variant.Equal] is more
 // general.
 func (x *Variant) Compare(TwoVar uintptr) int {
 
@@ -897,10 +890,10 @@ func (x *Variant) Compare(TwoVar uintptr) int {
 
 var xVariantDupBytestring func(uintptr, *uint) uintptr
 
-// Similar to g_variant_get_bytestring() except that instead of
+// Similar to [Variant.GetBytestring] except that instead of
 // returning a constant string, the string is duplicated.
 //
-// The return value must be freed using g_free().
+// The return value must be freed using [Free].
 func (x *Variant) DupBytestring(LengthVar *uint) uintptr {
 
 	cret := xVariantDupBytestring(x.GoPointer(), LengthVar)
@@ -909,78 +902,78 @@ func (x *Variant) DupBytestring(LengthVar *uint) uintptr {
 
 var xVariantDupBytestringArray func(uintptr, *uint) []string
 
-// Gets the contents of an array of array of bytes #GVariant.  This call
+// Gets the contents of an array of array of bytes [Variant].  This call
 // makes a deep copy; the return result should be released with
-// g_strfreev().
+// [Strfreev].
 //
-// If @length is non-%NULL then the number of elements in the result is
+// If length is non-nil then the number of elements in the result is
 // stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) DupBytestringArray(LengthVar *uint) []string {
 
 	cret := xVariantDupBytestringArray(x.GoPointer(), LengthVar)
 	return cret
 }
 
-var xVariantDupObjv func(uintptr, *uint) []string
+var xVariantDupObjv func(uintptr, *uint) uintptr
 
-// Gets the contents of an array of object paths #GVariant.  This call
+// Gets the contents of an array of object paths [Variant].  This call
 // makes a deep copy; the return result should be released with
-// g_strfreev().
+// [Strfreev].
 //
-// If @length is non-%NULL then the number of elements in the result
+// If length is non-nil then the number of elements in the result
 // is stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) DupObjv(LengthVar *uint) []string {
 
 	cret := xVariantDupObjv(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xVariantDupString func(uintptr, *uint) string
 
-// Similar to g_variant_get_string() except that instead of returning
+// Similar to [Variant.GetString] except that instead of returning
 // a constant string, the string is duplicated.
 //
 // The string will always be UTF-8 encoded.
 //
-// The return value must be freed using g_free().
+// The return value must be freed using [Free].
 func (x *Variant) DupString(LengthVar *uint) string {
 
 	cret := xVariantDupString(x.GoPointer(), LengthVar)
 	return cret
 }
 
-var xVariantDupStrv func(uintptr, *uint) []string
+var xVariantDupStrv func(uintptr, *uint) uintptr
 
-// Gets the contents of an array of strings #GVariant.  This call
+// Gets the contents of an array of strings [Variant].  This call
 // makes a deep copy; the return result should be released with
-// g_strfreev().
+// [Strfreev].
 //
-// If @length is non-%NULL then the number of elements in the result
+// If length is non-nil then the number of elements in the result
 // is stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) DupStrv(LengthVar *uint) []string {
 
 	cret := xVariantDupStrv(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xVariantEqual func(uintptr, uintptr) bool
 
-// Checks if @one and @two have the same type and value.
+// Checks if one and two have the same type and value.
 //
-// The types of @one and @two are #gconstpointer only to allow use of
-// this function with #GHashTable.  They must each be a #GVariant.
+// The types of one and two are #gconstpointer only to allow use of
+// this function with [HashTable].  They must each be a [Variant].
 func (x *Variant) Equal(TwoVar uintptr) bool {
 
 	cret := xVariantEqual(x.GoPointer(), TwoVar)
@@ -989,22 +982,22 @@ func (x *Variant) Equal(TwoVar uintptr) bool {
 
 var xVariantGet func(uintptr, string, ...interface{})
 
-// Deconstructs a #GVariant instance.
+// Deconstructs a [Variant] instance.
 //
 // Think of this function as an analogue to scanf().
 //
 // The arguments that are expected by this function are entirely
-// determined by @format_string.  @format_string also restricts the
-// permissible types of @value.  It is an error to give a value with
+// determined by format_string.  format_string also restricts the
+// permissible types of value.  It is an error to give a value with
 // an incompatible type.  See the section on
-// [GVariant format strings](gvariant-format-strings.html).
+// GVariant format strings.
 // Please note that the syntax of the format string is very likely to be
 // extended in the future.
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed,
 // see the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 func (x *Variant) Get(FormatStringVar string, varArgs ...interface{}) {
 
 	xVariantGet(x.GoPointer(), FormatStringVar, varArgs...)
@@ -1013,10 +1006,10 @@ func (x *Variant) Get(FormatStringVar string, varArgs ...interface{}) {
 
 var xVariantGetBoolean func(uintptr) bool
 
-// Returns the boolean value of @value.
+// Returns the boolean value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_BOOLEAN.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_BOOLEAN.
 func (x *Variant) GetBoolean() bool {
 
 	cret := xVariantGetBoolean(x.GoPointer())
@@ -1025,10 +1018,10 @@ func (x *Variant) GetBoolean() bool {
 
 var xVariantGetByte func(uintptr) byte
 
-// Returns the byte value of @value.
+// Returns the byte value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_BYTE.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_BYTE.
 func (x *Variant) GetByte() byte {
 
 	cret := xVariantGetByte(x.GoPointer())
@@ -1037,24 +1030,24 @@ func (x *Variant) GetByte() byte {
 
 var xVariantGetBytestring func(uintptr) uintptr
 
-// Returns the string value of a #GVariant instance with an
+// Returns the string value of a [Variant] instance with an
 // array-of-bytes type.  The string has no particular encoding.
 //
 // If the array does not end with a nul terminator character, the empty
 // string is returned.  For this reason, you can always trust that a
-// non-%NULL nul-terminated string will be returned by this function.
+// non-nil nul-terminated string will be returned by this function.
 //
 // If the array contains a nul terminator character somewhere other than
 // the last byte then the returned string is the string, up to the first
 // such nul character.
 //
-// g_variant_get_fixed_array() should be used instead if the array contains
+// [Variant.GetFixedArray] should be used instead if the array contains
 // arbitrary data that could not be nul-terminated or could contain nul bytes.
 //
-// It is an error to call this function with a @value that is not an
+// It is an error to call this function with a value that is not an
 // array of bytes.
 //
-// The return value remains valid as long as @value exists.
+// The return value remains valid as long as value exists.
 func (x *Variant) GetBytestring() uintptr {
 
 	cret := xVariantGetBytestring(x.GoPointer())
@@ -1063,16 +1056,16 @@ func (x *Variant) GetBytestring() uintptr {
 
 var xVariantGetBytestringArray func(uintptr, *uint) []string
 
-// Gets the contents of an array of array of bytes #GVariant.  This call
+// Gets the contents of an array of array of bytes [Variant].  This call
 // makes a shallow copy; the return result should be released with
-// g_free(), but the individual strings must not be modified.
+// [Free], but the individual strings must not be modified.
 //
-// If @length is non-%NULL then the number of elements in the result is
+// If length is non-nil then the number of elements in the result is
 // stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) GetBytestringArray(LengthVar *uint) []string {
 
 	cret := xVariantGetBytestringArray(x.GoPointer(), LengthVar)
@@ -1081,15 +1074,15 @@ func (x *Variant) GetBytestringArray(LengthVar *uint) []string {
 
 var xVariantGetChild func(uintptr, uint, string, ...interface{})
 
-// Reads a child item out of a container #GVariant instance and
-// deconstructs it according to @format_string.  This call is
-// essentially a combination of g_variant_get_child_value() and
-// g_variant_get().
+// Reads a child item out of a container [Variant] instance and
+// deconstructs it according to format_string.  This call is
+// essentially a combination of [Variant.GetChildValue] and
+// [Variant.Get].
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed,
 // see the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 func (x *Variant) GetChild(IndexVar uint, FormatStringVar string, varArgs ...interface{}) {
 
 	xVariantGetChild(x.GoPointer(), IndexVar, FormatStringVar, varArgs...)
@@ -1098,26 +1091,26 @@ func (x *Variant) GetChild(IndexVar uint, FormatStringVar string, varArgs ...int
 
 var xVariantGetChildValue func(uintptr, uint) *Variant
 
-// Reads a child item out of a container #GVariant instance.  This
+// Reads a child item out of a container [Variant] instance.  This
 // includes variants, maybes, arrays, tuples and dictionary
 // entries.  It is an error to call this function on any other type of
-// #GVariant.
+// [Variant].
 //
-// It is an error if @index_ is greater than the number of child items
-// in the container.  See g_variant_n_children().
+// It is an error if index_ is greater than the number of child items
+// in the container.  See [Variant.NChildren].
 //
 // The returned value is never floating.  You should free it with
-// g_variant_unref() when you're done with it.
+// [Variant.Unref] when you're done with it.
 //
 // Note that values borrowed from the returned child are not guaranteed to
 // still be valid after the child is freed even if you still hold a reference
-// to @value, if @value has not been serialized at the time this function is
-// called. To avoid this, you can serialize @value by calling
-// g_variant_get_data() and optionally ignoring the return value.
+// to value, if value has not been serialized at the time this function is
+// called. To avoid this, you can serialize value by calling
+// [Variant.GetData] and optionally ignoring the return value.
 //
 // There may be implementation specific restrictions on deeply nested values,
 // which would result in the unit tuple being returned as the child value,
-// instead of further nested children. #GVariant is guaranteed to handle
+// instead of further nested children. [Variant] is guaranteed to handle
 // nesting up to at least 64 levels.
 //
 // This function is O(1).
@@ -1129,29 +1122,29 @@ func (x *Variant) GetChildValue(IndexVar uint) *Variant {
 
 var xVariantGetData func(uintptr) uintptr
 
-// Returns a pointer to the serialized form of a #GVariant instance.
+// Returns a pointer to the serialized form of a [Variant] instance.
 // The returned data may not be in fully-normalised form if read from an
 // untrusted source.  The returned data must not be freed; it remains
-// valid for as long as @value exists.
+// valid for as long as value exists.
 //
-// If @value is a fixed-sized value that was deserialized from a
-// corrupted serialized container then %NULL may be returned.  In this
+// If value is a fixed-sized value that was deserialized from a
+// corrupted serialized container then nil may be returned.  In this
 // case, the proper thing to do is typically to use the appropriate
-// number of nul bytes in place of @value.  If @value is not fixed-sized
-// then %NULL is never returned.
+// number of nul bytes in place of value.  If value is not fixed-sized
+// then nil is never returned.
 //
-// In the case that @value is already in serialized form, this function
+// In the case that value is already in serialized form, this function
 // is O(1).  If the value is not already in serialized form,
 // serialization occurs implicitly and is approximately O(n) in the size
 // of the result.
 //
 // To deserialize the data returned by this function, in addition to the
-// serialized data, you must know the type of the #GVariant, and (if the
+// serialized data, you must know the type of the [Variant], and (if the
 // machine might be different) the endianness of the machine that stored
 // it. As a result, file formats or network messages that incorporate
-// serialized #GVariants must include this information either
+// serialized GVariants must include this information either
 // implicitly (for instance "the file always contains a
-// %G_VARIANT_TYPE_VARIANT and it is always in little-endian order") or
+// G_VARIANT_TYPE_VARIANT and it is always in little-endian order") or
 // explicitly (by storing the type and/or endianness in addition to the
 // serialized data).
 func (x *Variant) GetData() uintptr {
@@ -1162,9 +1155,9 @@ func (x *Variant) GetData() uintptr {
 
 var xVariantGetDataAsBytes func(uintptr) *Bytes
 
-// Returns a pointer to the serialized form of a #GVariant instance.
+// Returns a pointer to the serialized form of a [Variant] instance.
 // The semantics of this function are exactly the same as
-// g_variant_get_data(), except that the returned #GBytes holds
+// [Variant.GetData], except that the returned [Bytes] holds
 // a reference to the variant data.
 func (x *Variant) GetDataAsBytes() *Bytes {
 
@@ -1174,10 +1167,10 @@ func (x *Variant) GetDataAsBytes() *Bytes {
 
 var xVariantGetDouble func(uintptr) float64
 
-// Returns the double precision floating point value of @value.
+// Returns the double precision floating point value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_DOUBLE.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_DOUBLE.
 func (x *Variant) GetDouble() float64 {
 
 	cret := xVariantGetDouble(x.GoPointer())
@@ -1189,28 +1182,28 @@ var xVariantGetFixedArray func(uintptr, *uint, uint) uintptr
 // Provides access to the serialized data for an array of fixed-sized
 // items.
 //
-// @value must be an array with fixed-sized elements.  Numeric types are
+// value must be an array with fixed-sized elements.  Numeric types are
 // fixed-size, as are tuples containing only other fixed-sized types.
 //
-// @element_size must be the size of a single element in the array,
+// element_size must be the size of a single element in the array,
 // as given by the section on
-// [serialized data memory](struct.Variant.html#serialized-data-memory).
+// serialized data memory.
 //
 // In particular, arrays of these fixed-sized types can be interpreted
-// as an array of the given C type, with @element_size set to the size
+// as an array of the given C type, with element_size set to the size
 // the appropriate type:
-// - %G_VARIANT_TYPE_INT16 (etc.): #gint16 (etc.)
-// - %G_VARIANT_TYPE_BOOLEAN: #guchar (not #gboolean!)
-// - %G_VARIANT_TYPE_BYTE: #guint8
-// - %G_VARIANT_TYPE_HANDLE: #guint32
-// - %G_VARIANT_TYPE_DOUBLE: #gdouble
+// - G_VARIANT_TYPE_INT16 (etc.): #gint16 (etc.)
+// - G_VARIANT_TYPE_BOOLEAN: #guchar (not #gboolean!)
+// - G_VARIANT_TYPE_BYTE: #guint8
+// - G_VARIANT_TYPE_HANDLE: #guint32
+// - G_VARIANT_TYPE_DOUBLE: #gdouble
 //
 // For example, if calling this function for an array of 32-bit integers,
 // you might say `sizeof(gint32)`. This value isn't used except for the purpose
 // of a double-check that the form of the serialized data matches the caller's
 // expectation.
 //
-// @n_elements, which must be non-%NULL, is set equal to the number of
+// n_elements, which must be non-nil, is set equal to the number of
 // items in the array.
 func (x *Variant) GetFixedArray(NElementsVar *uint, ElementSizeVar uint) uintptr {
 
@@ -1220,10 +1213,10 @@ func (x *Variant) GetFixedArray(NElementsVar *uint, ElementSizeVar uint) uintptr
 
 var xVariantGetHandle func(uintptr) int32
 
-// Returns the 32-bit signed integer value of @value.
+// Returns the 32-bit signed integer value of value.
 //
-// It is an error to call this function with a @value of any type other
-// than %G_VARIANT_TYPE_HANDLE.
+// It is an error to call this function with a value of any type other
+// than G_VARIANT_TYPE_HANDLE.
 //
 // By convention, handles are indexes into an array of file descriptors
 // that are sent alongside a D-Bus message.  If you're not interacting
@@ -1236,10 +1229,10 @@ func (x *Variant) GetHandle() int32 {
 
 var xVariantGetInt16 func(uintptr) int16
 
-// Returns the 16-bit signed integer value of @value.
+// Returns the 16-bit signed integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_INT16.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_INT16.
 func (x *Variant) GetInt16() int16 {
 
 	cret := xVariantGetInt16(x.GoPointer())
@@ -1248,10 +1241,10 @@ func (x *Variant) GetInt16() int16 {
 
 var xVariantGetInt32 func(uintptr) int32
 
-// Returns the 32-bit signed integer value of @value.
+// Returns the 32-bit signed integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_INT32.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_INT32.
 func (x *Variant) GetInt32() int32 {
 
 	cret := xVariantGetInt32(x.GoPointer())
@@ -1260,10 +1253,10 @@ func (x *Variant) GetInt32() int32 {
 
 var xVariantGetInt64 func(uintptr) int64
 
-// Returns the 64-bit signed integer value of @value.
+// Returns the 64-bit signed integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_INT64.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_INT64.
 func (x *Variant) GetInt64() int64 {
 
 	cret := xVariantGetInt64(x.GoPointer())
@@ -1272,8 +1265,8 @@ func (x *Variant) GetInt64() int64 {
 
 var xVariantGetMaybe func(uintptr) *Variant
 
-// Given a maybe-typed #GVariant instance, extract its value.  If the
-// value is Nothing, then this function returns %NULL.
+// Given a maybe-typed [Variant] instance, extract its value.  If the
+// value is Nothing, then this function returns nil.
 func (x *Variant) GetMaybe() *Variant {
 
 	cret := xVariantGetMaybe(x.GoPointer())
@@ -1282,29 +1275,29 @@ func (x *Variant) GetMaybe() *Variant {
 
 var xVariantGetNormalForm func(uintptr) *Variant
 
-// Gets a #GVariant instance that has the same value as @value and is
+// Gets a [Variant] instance that has the same value as value and is
 // trusted to be in normal form.
 //
-// If @value is already trusted to be in normal form then a new
-// reference to @value is returned.
+// If value is already trusted to be in normal form then a new
+// reference to value is returned.
 //
-// If @value is not already trusted, then it is scanned to check if it
+// If value is not already trusted, then it is scanned to check if it
 // is in normal form.  If it is found to be in normal form then it is
 // marked as trusted and a new reference to it is returned.
 //
-// If @value is found not to be in normal form then a new trusted
-// #GVariant is created with the same value as @value. The non-normal parts of
-// @value will be replaced with default values which are guaranteed to be in
+// If value is found not to be in normal form then a new trusted
+// [Variant] is created with the same value as value. The non-normal parts of
+// value will be replaced with default values which are guaranteed to be in
 // normal form.
 //
-// It makes sense to call this function if you've received #GVariant
+// It makes sense to call this function if you've received [Variant]
 // data from untrusted sources and you want to ensure your serialized
 // output is definitely in normal form.
 //
-// If @value is already in normal form, a new reference will be returned
-// (which will be floating if @value is floating). If it is not in normal form,
-// the newly created #GVariant will be returned with a single non-floating
-// reference. Typically, g_variant_take_ref() should be called on the return
+// If value is already in normal form, a new reference will be returned
+// (which will be floating if value is floating). If it is not in normal form,
+// the newly created [Variant] will be returned with a single non-floating
+// reference. Typically, [Variant.TakeRef] should be called on the return
 // value from this function to guarantee ownership of a single non-floating
 // reference to it.
 func (x *Variant) GetNormalForm() *Variant {
@@ -1313,33 +1306,33 @@ func (x *Variant) GetNormalForm() *Variant {
 	return cret
 }
 
-var xVariantGetObjv func(uintptr, *uint) []string
+var xVariantGetObjv func(uintptr, *uint) uintptr
 
-// Gets the contents of an array of object paths #GVariant.  This call
+// Gets the contents of an array of object paths [Variant].  This call
 // makes a shallow copy; the return result should be released with
-// g_free(), but the individual strings must not be modified.
+// [Free], but the individual strings must not be modified.
 //
-// If @length is non-%NULL then the number of elements in the result
+// If length is non-nil then the number of elements in the result
 // is stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) GetObjv(LengthVar *uint) []string {
 
 	cret := xVariantGetObjv(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceContainer(cret)
 }
 
 var xVariantGetSize func(uintptr) uint
 
-// Determines the number of bytes that would be required to store @value
-// with g_variant_store().
+// Determines the number of bytes that would be required to store value
+// with [Variant.Store].
 //
-// If @value has a fixed-sized type then this function always returned
+// If value has a fixed-sized type then this function always returned
 // that fixed size.
 //
-// In the case that @value is already in serialized form or the size has
+// In the case that value is already in serialized form or the size has
 // already been calculated (ie: this function has been called before)
 // then this function is O(1).  Otherwise, the size is calculated, an
 // operation which is approximately O(n) in the number of values
@@ -1352,53 +1345,53 @@ func (x *Variant) GetSize() uint {
 
 var xVariantGetString func(uintptr, *uint) string
 
-// Returns the string value of a #GVariant instance with a string
-// type.  This includes the types %G_VARIANT_TYPE_STRING,
-// %G_VARIANT_TYPE_OBJECT_PATH and %G_VARIANT_TYPE_SIGNATURE.
+// Returns the string value of a [Variant] instance with a string
+// type.  This includes the types G_VARIANT_TYPE_STRING,
+// G_VARIANT_TYPE_OBJECT_PATH and G_VARIANT_TYPE_SIGNATURE.
 //
-// The string will always be UTF-8 encoded, will never be %NULL, and will never
+// The string will always be UTF-8 encoded, will never be nil, and will never
 // contain nul bytes.
 //
-// If @length is non-%NULL then the length of the string (in bytes) is
+// If length is non-nil then the length of the string (in bytes) is
 // returned there.  For trusted values, this information is already
 // known.  Untrusted values will be validated and, if valid, a strlen() will be
 // performed. If invalid, a default value will be returned — for
-// %G_VARIANT_TYPE_OBJECT_PATH, this is `"/"`, and for other types it is the
+// G_VARIANT_TYPE_OBJECT_PATH, this is `"/"`, and for other types it is the
 // empty string.
 //
-// It is an error to call this function with a @value of any type
+// It is an error to call this function with a value of any type
 // other than those three.
 //
-// The return value remains valid as long as @value exists.
+// The return value remains valid as long as value exists.
 func (x *Variant) GetString(LengthVar *uint) string {
 
 	cret := xVariantGetString(x.GoPointer(), LengthVar)
 	return cret
 }
 
-var xVariantGetStrv func(uintptr, *uint) []string
+var xVariantGetStrv func(uintptr, *uint) uintptr
 
-// Gets the contents of an array of strings #GVariant.  This call
+// Gets the contents of an array of strings [Variant].  This call
 // makes a shallow copy; the return result should be released with
-// g_free(), but the individual strings must not be modified.
+// [Free], but the individual strings must not be modified.
 //
-// If @length is non-%NULL then the number of elements in the result
+// If length is non-nil then the number of elements in the result
 // is stored there.  In any case, the resulting array will be
-// %NULL-terminated.
+// nil-terminated.
 //
-// For an empty array, @length will be set to 0 and a pointer to a
-// %NULL pointer will be returned.
+// For an empty array, length will be set to 0 and a pointer to a
+// nil pointer will be returned.
 func (x *Variant) GetStrv(LengthVar *uint) []string {
 
 	cret := xVariantGetStrv(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceContainer(cret)
 }
 
 var xVariantGetType func(uintptr) *VariantType
 
-// Determines the type of @value.
+// Determines the type of value.
 //
-// The return value is valid for the lifetime of @value and must not
+// The return value is valid for the lifetime of value and must not
 // be freed.
 func (x *Variant) GetType() *VariantType {
 
@@ -1408,9 +1401,9 @@ func (x *Variant) GetType() *VariantType {
 
 var xVariantGetTypeString func(uintptr) string
 
-// Returns the type string of @value.  Unlike the result of calling
-// g_variant_type_peek_string(), this string is nul-terminated.  This
-// string belongs to #GVariant and must not be freed.
+// Returns the type string of value.  Unlike the result of calling
+// [VariantType.PeekString], this string is nul-terminated.  This
+// string belongs to [Variant] and must not be freed.
 func (x *Variant) GetTypeString() string {
 
 	cret := xVariantGetTypeString(x.GoPointer())
@@ -1419,10 +1412,10 @@ func (x *Variant) GetTypeString() string {
 
 var xVariantGetUint16 func(uintptr) uint16
 
-// Returns the 16-bit unsigned integer value of @value.
+// Returns the 16-bit unsigned integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_UINT16.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_UINT16.
 func (x *Variant) GetUint16() uint16 {
 
 	cret := xVariantGetUint16(x.GoPointer())
@@ -1431,10 +1424,10 @@ func (x *Variant) GetUint16() uint16 {
 
 var xVariantGetUint32 func(uintptr) uint32
 
-// Returns the 32-bit unsigned integer value of @value.
+// Returns the 32-bit unsigned integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_UINT32.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_UINT32.
 func (x *Variant) GetUint32() uint32 {
 
 	cret := xVariantGetUint32(x.GoPointer())
@@ -1443,10 +1436,10 @@ func (x *Variant) GetUint32() uint32 {
 
 var xVariantGetUint64 func(uintptr) uint64
 
-// Returns the 64-bit unsigned integer value of @value.
+// Returns the 64-bit unsigned integer value of value.
 //
-// It is an error to call this function with a @value of any type
-// other than %G_VARIANT_TYPE_UINT64.
+// It is an error to call this function with a value of any type
+// other than G_VARIANT_TYPE_UINT64.
 func (x *Variant) GetUint64() uint64 {
 
 	cret := xVariantGetUint64(x.GoPointer())
@@ -1455,30 +1448,30 @@ func (x *Variant) GetUint64() uint64 {
 
 var xVariantGetVa func(uintptr, string, uintptr, []interface{})
 
-// This function is intended to be used by libraries based on #GVariant
-// that want to provide g_variant_get()-like functionality to their
+// This function is intended to be used by libraries based on [Variant]
+// that want to provide [Variant.Get]-like functionality to their
 // users.
 //
-// The API is more general than g_variant_get() to allow a wider range
+// The API is more general than [Variant.Get] to allow a wider range
 // of possible uses.
 //
-// @format_string must still point to a valid format string, but it only
-// need to be nul-terminated if @endptr is %NULL.  If @endptr is
-// non-%NULL then it is updated to point to the first character past the
+// format_string must still point to a valid format string, but it only
+// need to be nul-terminated if endptr is nil.  If endptr is
+// non-nil then it is updated to point to the first character past the
 // end of the format string.
 //
-// @app is a pointer to a #va_list.  The arguments, according to
-// @format_string, are collected from this #va_list and the list is left
+// app is a pointer to a #va_list.  The arguments, according to
+// format_string, are collected from this #va_list and the list is left
 // pointing to the argument following the last.
 //
 // These two generalisations allow mixing of multiple calls to
-// g_variant_new_va() and g_variant_get_va() within a single actual
+// [NewVariantVa] and [Variant.GetVa] within a single actual
 // varargs call by the user.
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed,
 // see the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 func (x *Variant) GetVa(FormatStringVar string, EndptrVar *string, AppVar []interface{}) {
 
 	EndptrVarPtr := core.GStrdupNullable(EndptrVar)
@@ -1490,8 +1483,8 @@ func (x *Variant) GetVa(FormatStringVar string, EndptrVar *string, AppVar []inte
 
 var xVariantGetVariant func(uintptr) *Variant
 
-// Unboxes @value.  The result is the #GVariant instance that was
-// contained in @value.
+// Unboxes value.  The result is the [Variant] instance that was
+// contained in value.
 func (x *Variant) GetVariant() *Variant {
 
 	cret := xVariantGetVariant(x.GoPointer())
@@ -1500,15 +1493,15 @@ func (x *Variant) GetVariant() *Variant {
 
 var xVariantHash func(uintptr) uint
 
-// Generates a hash value for a #GVariant instance.
+// Generates a hash value for a [Variant] instance.
 //
 // The output of this function is guaranteed to be the same for a given
 // value only per-process.  It may change between different processor
 // architectures or even different versions of GLib.  Do not use this
 // function as a basis for building protocols or file formats.
 //
-// The type of @value is #gconstpointer only to allow use of this
-// function with #GHashTable.  @value must be a #GVariant.
+// The type of value is #gconstpointer only to allow use of this
+// function with [HashTable].  value must be a [Variant].
 func (x *Variant) Hash() uint {
 
 	cret := xVariantHash(x.GoPointer())
@@ -1517,7 +1510,7 @@ func (x *Variant) Hash() uint {
 
 var xVariantIsContainer func(uintptr) bool
 
-// Checks if @value is a container.
+// Checks if value is a container.
 func (x *Variant) IsContainer() bool {
 
 	cret := xVariantIsContainer(x.GoPointer())
@@ -1526,14 +1519,14 @@ func (x *Variant) IsContainer() bool {
 
 var xVariantIsFloating func(uintptr) bool
 
-// Checks whether @value has a floating reference count.
+// Checks whether value has a floating reference count.
 //
 // This function should only ever be used to assert that a given variant
 // is or is not floating, or for debug purposes. To acquire a reference
-// to a variant that might be floating, always use g_variant_ref_sink()
-// or g_variant_take_ref().
+// to a variant that might be floating, always use [Variant.RefSink]
+// or [Variant.TakeRef].
 //
-// See g_variant_ref_sink() for more information about floating reference
+// See [Variant.RefSink] for more information about floating reference
 // counts.
 func (x *Variant) IsFloating() bool {
 
@@ -1543,16 +1536,16 @@ func (x *Variant) IsFloating() bool {
 
 var xVariantIsNormalForm func(uintptr) bool
 
-// Checks if @value is in normal form.
+// Checks if value is in normal form.
 //
 // The main reason to do this is to detect if a given chunk of
-// serialized data is in normal form: load the data into a #GVariant
-// using g_variant_new_from_data() and then use this function to
+// serialized data is in normal form: load the data into a [Variant]
+// using [NewVariantFromData] and then use this function to
 // check.
 //
-// If @value is found to be in normal form then it will be marked as
+// If value is found to be in normal form then it will be marked as
 // being trusted.  If the value was already marked as being trusted then
-// this function will immediately return %TRUE.
+// this function will immediately return true.
 //
 // There may be implementation specific restrictions on deeply nested values.
 // GVariant is guaranteed to handle nesting up to at least 64 levels.
@@ -1573,14 +1566,14 @@ func (x *Variant) IsOfType(TypeVar *VariantType) bool {
 
 var xVariantIterNew func(uintptr) *VariantIter
 
-// Creates a heap-allocated #GVariantIter for iterating over the items
-// in @value.
+// Creates a heap-allocated [VariantIter] for iterating over the items
+// in value.
 //
-// Use g_variant_iter_free() to free the return value when you no longer
+// Use [VariantIter.Free] to free the return value when you no longer
 // need it.
 //
-// A reference is taken to @value and will be released only when
-// g_variant_iter_free() is called.
+// A reference is taken to value and will be released only when
+// [VariantIter.Free] is called.
 func (x *Variant) IterNew() *VariantIter {
 
 	cret := xVariantIterNew(x.GoPointer())
@@ -1589,20 +1582,20 @@ func (x *Variant) IterNew() *VariantIter {
 
 var xVariantLookup func(uintptr, string, string, ...interface{}) bool
 
-// Looks up a value in a dictionary #GVariant.
+// Looks up a value in a dictionary [Variant].
 //
-// This function is a wrapper around g_variant_lookup_value() and
-// g_variant_get().  In the case that %NULL would have been returned,
-// this function returns %FALSE.  Otherwise, it unpacks the returned
-// value and returns %TRUE.
+// This function is a wrapper around [Variant.LookupValue] and
+// [Variant.Get].  In the case that nil would have been returned,
+// this function returns false.  Otherwise, it unpacks the returned
+// value and returns true.
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed,
 // see the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 //
 // This function is currently implemented with a linear scan.  If you
-// plan to do many lookups then #GVariantDict may be more efficient.
+// plan to do many lookups then [VariantDict] may be more efficient.
 func (x *Variant) Lookup(KeyVar string, FormatStringVar string, varArgs ...interface{}) bool {
 
 	cret := xVariantLookup(x.GoPointer(), KeyVar, FormatStringVar, varArgs...)
@@ -1611,27 +1604,27 @@ func (x *Variant) Lookup(KeyVar string, FormatStringVar string, varArgs ...inter
 
 var xVariantLookupValue func(uintptr, string, *VariantType) *Variant
 
-// Looks up a value in a dictionary #GVariant.
+// Looks up a value in a dictionary [Variant].
 //
 // This function works with dictionaries of the type a{s*} (and equally
 // well with type a{o*}, but we only further discuss the string case
 // for sake of clarity).
 //
-// In the event that @dictionary has the type a{sv}, the @expected_type
+// In the event that dictionary has the type a{sv}, the expected_type
 // string specifies what type of value is expected to be inside of the
 // variant. If the value inside the variant has a different type then
-// %NULL is returned. In the event that @dictionary has a value type other
-// than v then @expected_type must directly match the value type and it is
+// nil is returned. In the event that dictionary has a value type other
+// than v then expected_type must directly match the value type and it is
 // used to unpack the value directly or an error occurs.
 //
-// In either case, if @key is not found in @dictionary, %NULL is returned.
+// In either case, if key is not found in dictionary, nil is returned.
 //
 // If the key is found and the value has the correct type, it is
-// returned.  If @expected_type was specified then any non-%NULL return
+// returned.  If expected_type was specified then any non-nil return
 // value will have this type.
 //
 // This function is currently implemented with a linear scan.  If you
-// plan to do many lookups then #GVariantDict may be more efficient.
+// plan to do many lookups then [VariantDict] may be more efficient.
 func (x *Variant) LookupValue(KeyVar string, ExpectedTypeVar *VariantType) *Variant {
 
 	cret := xVariantLookupValue(x.GoPointer(), KeyVar, ExpectedTypeVar)
@@ -1640,10 +1633,10 @@ func (x *Variant) LookupValue(KeyVar string, ExpectedTypeVar *VariantType) *Vari
 
 var xVariantNChildren func(uintptr) uint
 
-// Determines the number of children in a container #GVariant instance.
+// Determines the number of children in a container [Variant] instance.
 // This includes variants, maybes, arrays, tuples and dictionary
 // entries.  It is an error to call this function on any other type of
-// #GVariant.
+// [Variant].
 //
 // For variants, the return value is always 1.  For values with maybe
 // types, it is always zero or one.  For arrays, it is the length of the
@@ -1659,11 +1652,11 @@ func (x *Variant) NChildren() uint {
 
 var xVariantPrint func(uintptr, bool) string
 
-// Pretty-prints @value in the format understood by g_variant_parse().
+// Pretty-prints value in the format understood by [VariantParse].
 //
-// The format is described [here](gvariant-text-format.html).
+// The format is described here.
 //
-// If @type_annotate is %TRUE, then type information is included in
+// If type_annotate is true, then type information is included in
 // the output.
 func (x *Variant) Print(TypeAnnotateVar bool) string {
 
@@ -1673,10 +1666,10 @@ func (x *Variant) Print(TypeAnnotateVar bool) string {
 
 var xVariantPrintString func(uintptr, *String, bool) *String
 
-// Behaves as g_variant_print(), but operates on a #GString.
+// Behaves as [Variant.Print], but operates on a [String].
 //
-// If @string is non-%NULL then it is appended to and returned.  Else,
-// a new empty #GString is allocated and it is returned.
+// If string is non-nil then it is appended to and returned.  Else,
+// a new empty [String] is allocated and it is returned.
 func (x *Variant) PrintString(StringVar *String, TypeAnnotateVar bool) *String {
 
 	cret := xVariantPrintString(x.GoPointer(), StringVar, TypeAnnotateVar)
@@ -1685,7 +1678,7 @@ func (x *Variant) PrintString(StringVar *String, TypeAnnotateVar bool) *String {
 
 var xVariantRef func(uintptr) *Variant
 
-// Increases the reference count of @value.
+// Increases the reference count of value.
 func (x *Variant) Ref() *Variant {
 
 	cret := xVariantRef(x.GoPointer())
@@ -1694,22 +1687,22 @@ func (x *Variant) Ref() *Variant {
 
 var xVariantRefSink func(uintptr) *Variant
 
-// #GVariant uses a floating reference count system.  All functions with
+// [Variant] uses a floating reference count system.  All functions with
 // names starting with `g_variant_new_` return floating
 // references.
 //
-// Calling g_variant_ref_sink() on a #GVariant with a floating reference
+// Calling [Variant.RefSink] on a [Variant] with a floating reference
 // will convert the floating reference into a full reference.  Calling
-// g_variant_ref_sink() on a non-floating #GVariant results in an
+// [Variant.RefSink] on a non-floating [Variant] results in an
 // additional normal reference being added.
 //
-// In other words, if the @value is floating, then this call "assumes
+// In other words, if the value is floating, then this call "assumes
 // ownership" of the floating reference, converting it to a normal
-// reference.  If the @value is not floating, then this call adds a
+// reference.  If the value is not floating, then this call adds a
 // new normal reference increasing the reference count by one.
 //
-// All calls that result in a #GVariant instance being inserted into a
-// container will call g_variant_ref_sink() on the instance.  This means
+// All calls that result in a [Variant] instance being inserted into a
+// container will call [Variant.RefSink] on the instance.  This means
 // that if the value was just created (and has only its floating
 // reference) then the container will assume sole ownership of the value
 // at that point and the caller will not need to unreference it.  This
@@ -1724,18 +1717,18 @@ func (x *Variant) RefSink() *Variant {
 
 var xVariantStore func(uintptr, uintptr)
 
-// Stores the serialized form of @value at @data.  @data should be
-// large enough.  See g_variant_get_size().
+// Stores the serialized form of value at data.  data should be
+// large enough.  See [Variant.GetSize].
 //
 // The stored data is in machine native byte order but may not be in
 // fully-normalised form if read from an untrusted source.  See
-// g_variant_get_normal_form() for a solution.
+// [Variant.GetNormalForm] for a solution.
 //
-// As with g_variant_get_data(), to be able to deserialize the
+// As with [Variant.GetData], to be able to deserialize the
 // serialized variant successfully, its type and (if the destination
 // machine might be different) its endianness must also be available.
 //
-// This function is approximately O(n) in the size of @data.
+// This function is approximately O(n) in the size of data.
 func (x *Variant) Store(DataVar uintptr) {
 
 	xVariantStore(x.GoPointer(), DataVar)
@@ -1744,22 +1737,22 @@ func (x *Variant) Store(DataVar uintptr) {
 
 var xVariantTakeRef func(uintptr) *Variant
 
-// If @value is floating, sink it.  Otherwise, do nothing.
+// If value is floating, sink it.  Otherwise, do nothing.
 //
-// Typically you want to use g_variant_ref_sink() in order to
+// Typically you want to use [Variant.RefSink] in order to
 // automatically do the correct thing with respect to floating or
 // non-floating references, but there is one specific scenario where
 // this function is helpful.
 //
 // The situation where this function is helpful is when creating an API
 // that allows the user to provide a callback function that returns a
-// #GVariant.  We certainly want to allow the user the flexibility to
+// [Variant].  We certainly want to allow the user the flexibility to
 // return a non-floating reference from this callback (for the case
 // where the value that is being returned already exists).
 //
-// At the same time, the style of the #GVariant API makes it likely that
-// for newly-created #GVariant instances, the user can be saved some
-// typing if they are allowed to return a #GVariant with a floating
+// At the same time, the style of the [Variant] API makes it likely that
+// for newly-created [Variant] instances, the user can be saved some
+// typing if they are allowed to return a [Variant] with a floating
 // reference.
 //
 // Using this function on the return value of the user's callback allows
@@ -1769,10 +1762,10 @@ var xVariantTakeRef func(uintptr) *Variant
 // that has been converted to a full reference.
 //
 // This function has an odd interaction when combined with
-// g_variant_ref_sink() running at the same time in another thread on
-// the same #GVariant instance.  If g_variant_ref_sink() runs first then
+// [Variant.RefSink] running at the same time in another thread on
+// the same [Variant] instance.  If [Variant.RefSink] runs first then
 // the result will be that the floating reference is converted to a hard
-// reference.  If g_variant_take_ref() runs first then the result will
+// reference.  If [Variant.TakeRef] runs first then the result will
 // be that the floating reference is converted to a hard reference and
 // an additional reference on top of that one is added.  It is best to
 // avoid this situation.
@@ -1784,7 +1777,7 @@ func (x *Variant) TakeRef() *Variant {
 
 var xVariantUnref func(uintptr)
 
-// Decreases the reference count of @value.  When its reference count
+// Decreases the reference count of value.  When its reference count
 // drops to 0, the memory used by the variant is freed.
 func (x *Variant) Unref() {
 
@@ -1792,12 +1785,12 @@ func (x *Variant) Unref() {
 
 }
 
-// A utility type for constructing container-type #GVariant instances.
+// A utility type for constructing container-type [Variant] instances.
 //
 // This is an opaque structure and may only be accessed using the
 // following functions.
 //
-// #GVariantBuilder is not threadsafe in any way.  Do not attempt to
+// [VariantBuilder] is not threadsafe in any way.  Do not attempt to
 // access it from more than one thread.
 type VariantBuilder struct {
 	_ structs.HostLayout
@@ -1815,15 +1808,15 @@ func (x *VariantBuilder) GoPointer() uintptr {
 
 var xNewVariantBuilder func(*VariantType) *VariantBuilder
 
-// Allocates and initialises a new #GVariantBuilder.
+// Allocates and initialises a new [VariantBuilder].
 //
-// You should call g_variant_builder_unref() on the return value when it
+// You should call [VariantBuilder.Unref] on the return value when it
 // is no longer needed.  The memory will not be automatically freed by
 // any other call.
 //
-// In most cases it is easier to place a #GVariantBuilder directly on
+// In most cases it is easier to place a [VariantBuilder] directly on
 // the stack of the calling function and initialise it with
-// g_variant_builder_init_static().
+// [VariantBuilder.InitStatic].
 func NewVariantBuilder(TypeVar *VariantType) *VariantBuilder {
 
 	cret := xNewVariantBuilder(TypeVar)
@@ -1832,38 +1825,34 @@ func NewVariantBuilder(TypeVar *VariantType) *VariantBuilder {
 
 var xVariantBuilderAdd func(uintptr, string, ...interface{})
 
-// Adds to a #GVariantBuilder.
+// Adds to a [VariantBuilder].
 //
 // This call is a convenience wrapper that is exactly equivalent to
-// calling g_variant_new() followed by g_variant_builder_add_value().
+// calling [NewVariant] followed by [VariantBuilder.AddValue].
 //
 // Note that the arguments must be of the correct width for their types
-// specified in @format_string. This can be achieved by casting them. See
-// the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// specified in format_string. This can be achieved by casting them. See
+// the GVariant varargs documentation.
 //
 // This function might be used as follows:
 //
-// |[&lt;!-- language="C" --&gt;
-// GVariant *
-// make_pointless_dictionary (void)
-//
+//	GVariant *
+//	make_pointless_dictionary (void)
 //	{
 //	  GVariantBuilder builder;
 //	  int i;
 //
-//	  g_variant_builder_init_static (&amp;builder, G_VARIANT_TYPE_ARRAY);
-//	  for (i = 0; i &lt; 16; i++)
+//	  g_variant_builder_init_static (&builder, G_VARIANT_TYPE_ARRAY);
+//	  for (i = 0; i < 16; i++)
 //	    {
 //	      gchar buf[3];
 //
 //	      sprintf (buf, "%d", i);
-//	      g_variant_builder_add (&amp;builder, "{is}", i, buf);
+//	      g_variant_builder_add (&builder, "{is}", i, buf);
 //	    }
 //
-//	  return g_variant_builder_end (&amp;builder);
+//	  return g_variant_builder_end (&builder);
 //	}
-//
-// ]|
 func (x *VariantBuilder) Add(FormatStringVar string, varArgs ...interface{}) {
 
 	xVariantBuilderAdd(x.GoPointer(), FormatStringVar, varArgs...)
@@ -1872,34 +1861,30 @@ func (x *VariantBuilder) Add(FormatStringVar string, varArgs ...interface{}) {
 
 var xVariantBuilderAddParsed func(uintptr, string, ...interface{})
 
-// Adds to a #GVariantBuilder.
+// Adds to a [VariantBuilder].
 //
 // This call is a convenience wrapper that is exactly equivalent to
-// calling g_variant_new_parsed() followed by
-// g_variant_builder_add_value().
+// calling [NewVariantParsed] followed by
+// [VariantBuilder.AddValue].
 //
 // Note that the arguments must be of the correct width for their types
-// specified in @format_string. This can be achieved by casting them. See
-// the [GVariant varargs documentation](gvariant-format-strings.html#varargs).
+// specified in format_string. This can be achieved by casting them. See
+// the GVariant varargs documentation.
 //
 // This function might be used as follows:
 //
-// |[&lt;!-- language="C" --&gt;
-// GVariant *
-// make_pointless_dictionary (void)
-//
+//	GVariant *
+//	make_pointless_dictionary (void)
 //	{
 //	  GVariantBuilder builder;
 //	  int i;
 //
-//	  g_variant_builder_init_static (&amp;builder, G_VARIANT_TYPE_ARRAY);
-//	  g_variant_builder_add_parsed (&amp;builder, "{'width', &lt;%i&gt;}", 600);
-//	  g_variant_builder_add_parsed (&amp;builder, "{'title', &lt;%s&gt;}", "foo");
-//	  g_variant_builder_add_parsed (&amp;builder, "{'transparency', &lt;0.5&gt;}");
-//	  return g_variant_builder_end (&amp;builder);
+//	  g_variant_builder_init_static (&builder, G_VARIANT_TYPE_ARRAY);
+//	  g_variant_builder_add_parsed (&builder, "{'width', <%i>}", 600);
+//	  g_variant_builder_add_parsed (&builder, "{'title', <%s>}", "foo");
+//	  g_variant_builder_add_parsed (&builder, "{'transparency', <0.5>}");
+//	  return g_variant_builder_end (&builder);
 //	}
-//
-// ]|
 func (x *VariantBuilder) AddParsed(FormatVar string, varArgs ...interface{}) {
 
 	xVariantBuilderAddParsed(x.GoPointer(), FormatVar, varArgs...)
@@ -1908,7 +1893,7 @@ func (x *VariantBuilder) AddParsed(FormatVar string, varArgs ...interface{}) {
 
 var xVariantBuilderAddValue func(uintptr, *Variant)
 
-// Adds @value to @builder.
+// Adds value to builder.
 //
 // It is an error to call this function in any way that would create an
 // inconsistent value to be constructed.  Some examples of this are
@@ -1916,8 +1901,8 @@ var xVariantBuilderAddValue func(uintptr, *Variant)
 // types or number of items in a tuple, putting more than one value into
 // a variant, etc.
 //
-// If @value is a floating reference (see g_variant_ref_sink()),
-// the @builder instance takes ownership of @value.
+// If value is a floating reference (see [Variant.RefSink]),
+// the builder instance takes ownership of value.
 func (x *VariantBuilder) AddValue(ValueVar *Variant) {
 
 	xVariantBuilderAddValue(x.GoPointer(), ValueVar)
@@ -1926,19 +1911,19 @@ func (x *VariantBuilder) AddValue(ValueVar *Variant) {
 
 var xVariantBuilderClear func(uintptr)
 
-// Releases all memory associated with a #GVariantBuilder without
-// freeing the #GVariantBuilder structure itself.
+// Releases all memory associated with a [VariantBuilder] without
+// freeing the [VariantBuilder] structure itself.
 //
 // It typically only makes sense to do this on a stack-allocated
-// #GVariantBuilder if you want to abort building the value part-way
+// [VariantBuilder] if you want to abort building the value part-way
 // through.  This function need not be called if you call
-// g_variant_builder_end() and it also doesn't need to be called on
-// builders allocated with g_variant_builder_new() (see
-// g_variant_builder_unref() for that).
+// [VariantBuilder.End] and it also doesn't need to be called on
+// builders allocated with [NewVariantBuilder] (see
+// [VariantBuilder.Unref] for that).
 //
-// This function leaves the #GVariantBuilder structure set to all-zeros.
+// This function leaves the [VariantBuilder] structure set to all-zeros.
 // It is valid to call this function on either an initialised
-// #GVariantBuilder or one that is set to all-zeros but it is not valid
+// [VariantBuilder] or one that is set to all-zeros but it is not valid
 // to call this function on uninitialised memory.
 func (x *VariantBuilder) Clear() {
 
@@ -1948,8 +1933,8 @@ func (x *VariantBuilder) Clear() {
 
 var xVariantBuilderClose func(uintptr)
 
-// Closes the subcontainer inside the given @builder that was opened by
-// the most recent call to g_variant_builder_open().
+// Closes the subcontainer inside the given builder that was opened by
+// the most recent call to [VariantBuilder.Open].
 //
 // It is an error to call this function in any way that would create an
 // inconsistent value to be constructed (ie: too few values added to the
@@ -1964,13 +1949,13 @@ var xVariantBuilderEnd func(uintptr) *Variant
 
 // Ends the builder process and returns the constructed value.
 //
-// It is not permissible to use @builder in any way after this call
+// It is not permissible to use builder in any way after this call
 // except for reference counting operations (in the case of a
-// heap-allocated #GVariantBuilder) or by reinitialising it with
-// g_variant_builder_init() (in the case of stack-allocated). This
+// heap-allocated [VariantBuilder]) or by reinitialising it with
+// [VariantBuilder.Init] (in the case of stack-allocated). This
 // means that for the stack-allocated builders there is no need to
-// call g_variant_builder_clear() after the call to
-// g_variant_builder_end().
+// call [VariantBuilder.Clear] after the call to
+// [VariantBuilder.End].
 //
 // It is an error to call this function in any way that would create an
 // inconsistent value to be constructed (ie: insufficient number of
@@ -1987,38 +1972,38 @@ func (x *VariantBuilder) End() *Variant {
 
 var xVariantBuilderInit func(uintptr, *VariantType)
 
-// Initialises a #GVariantBuilder structure.
+// Initialises a [VariantBuilder] structure.
 //
-// @type must be non-%NULL.  It specifies the type of container to
+// type must be non-nil.  It specifies the type of container to
 // construct.  It can be an indefinite type such as
-// %G_VARIANT_TYPE_ARRAY or a definite type such as "as" or "(ii)".
+// G_VARIANT_TYPE_ARRAY or a definite type such as "as" or "(ii)".
 // Maybe, array, tuple, dictionary entry and variant-typed values may be
 // constructed.
 //
 // If using a static type such as one of the `G_VARIANT_TYPE_*` constants
 // or a `G_VARIANT_TYPE ("(ii)")` macro, it is more performant to use
-// g_variant_builder_init_static() rather than g_variant_builder_init().
+// [VariantBuilder.InitStatic] rather than [VariantBuilder.Init].
 //
 // After the builder is initialised, values are added using
-// g_variant_builder_add_value() or g_variant_builder_add().
+// [VariantBuilder.AddValue] or [VariantBuilder.Add].
 //
-// After all the child values are added, g_variant_builder_end() frees
-// the memory associated with the builder and returns the #GVariant that
+// After all the child values are added, [VariantBuilder.End] frees
+// the memory associated with the builder and returns the [Variant] that
 // was created.
 //
-// This function completely ignores the previous contents of @builder.
+// This function completely ignores the previous contents of builder.
 // On one hand this means that it is valid to pass in completely
 // uninitialised memory.  On the other hand, this means that if you are
-// initialising over top of an existing #GVariantBuilder you need to
-// first call g_variant_builder_clear() in order to avoid leaking
+// initialising over top of an existing [VariantBuilder] you need to
+// first call [VariantBuilder.Clear] in order to avoid leaking
 // memory.
 //
-// You must not call g_variant_builder_ref() or
-// g_variant_builder_unref() on a #GVariantBuilder that was initialised
+// You must not call [VariantBuilder.Ref] or
+// [VariantBuilder.Unref] on a [VariantBuilder] that was initialised
 // with this function.  If you ever pass a reference to a
-// #GVariantBuilder outside of the control of your own code then you
+// [VariantBuilder] outside of the control of your own code then you
 // should assume that the person receiving that reference may try to use
-// reference counting; you should use g_variant_builder_new() instead of
+// reference counting; you should use [NewVariantBuilder] instead of
 // this function.
 func (x *VariantBuilder) Init(TypeVar *VariantType) {
 
@@ -2028,11 +2013,11 @@ func (x *VariantBuilder) Init(TypeVar *VariantType) {
 
 var xVariantBuilderInitStatic func(uintptr, *VariantType)
 
-// Initialises a #GVariantBuilder structure.
+// Initialises a [VariantBuilder] structure.
 //
-// This function works exactly like g_variant_builder_init() but does
-// not make a copy of @type. Therefore, @type must remain valid for the
-// lifetime of @builder. This is always true of type constants like
+// This function works exactly like [VariantBuilder.Init] but does
+// not make a copy of type. Therefore, type must remain valid for the
+// lifetime of builder. This is always true of type constants like
 // `G_VARIANT_TYPE_*` or `G_VARIANT_TYPE ("(ii)")`.
 func (x *VariantBuilder) InitStatic(TypeVar *VariantType) {
 
@@ -2042,9 +2027,9 @@ func (x *VariantBuilder) InitStatic(TypeVar *VariantType) {
 
 var xVariantBuilderOpen func(uintptr, *VariantType)
 
-// Opens a subcontainer inside the given @builder.  When done adding
-// items to the subcontainer, g_variant_builder_close() must be called. @type
-// is the type of the container: so to build a tuple of several values, @type
+// Opens a subcontainer inside the given builder.  When done adding
+// items to the subcontainer, [VariantBuilder.Close] must be called. type
+// is the type of the container: so to build a tuple of several values, type
 // must include the tuple itself.
 //
 // It is an error to call this function in any way that would cause an
@@ -2052,33 +2037,31 @@ var xVariantBuilderOpen func(uintptr, *VariantType)
 // a value of an incorrect type).
 //
 // Example of building a nested variant:
-// |[&lt;!-- language="C" --&gt;
-// GVariantBuilder builder;
-// guint32 some_number = get_number ();
-// g_autoptr (GHashTable) some_dict = get_dict ();
-// GHashTableIter iter;
-// const gchar *key;
-// const GVariant *value;
-// g_autoptr (GVariant) output = NULL;
-//
-// g_variant_builder_init (&amp;builder, G_VARIANT_TYPE ("(ua{sv})"));
-// g_variant_builder_add (&amp;builder, "u", some_number);
-// g_variant_builder_open (&amp;builder, G_VARIANT_TYPE ("a{sv}"));
-//
-// g_hash_table_iter_init (&amp;iter, some_dict);
-// while (g_hash_table_iter_next (&amp;iter, (gpointer *) &amp;key, (gpointer *) &amp;value))
-//
-//	{
-//	  g_variant_builder_open (&amp;builder, G_VARIANT_TYPE ("{sv}"));
-//	  g_variant_builder_add (&amp;builder, "s", key);
-//	  g_variant_builder_add (&amp;builder, "v", value);
-//	  g_variant_builder_close (&amp;builder);
-//	}
-//
-// g_variant_builder_close (&amp;builder);
 //
-// output = g_variant_builder_end (&amp;builder);
-// ]|
+//	GVariantBuilder builder;
+//	guint32 some_number = get_number ();
+//	g_autoptr (GHashTable) some_dict = get_dict ();
+//	GHashTableIter iter;
+//	const gchar *key;
+//	const GVariant *value;
+//	g_autoptr (GVariant) output = NULL;
+//
+//	g_variant_builder_init (&builder, G_VARIANT_TYPE ("(ua{sv})"));
+//	g_variant_builder_add (&builder, "u", some_number);
+//	g_variant_builder_open (&builder, G_VARIANT_TYPE ("a{sv}"));
+//
+//	g_hash_table_iter_init (&iter, some_dict);
+//	while (g_hash_table_iter_next (&iter, (gpointer *) &key, (gpointer *) &value))
+//	  {
+//	    g_variant_builder_open (&builder, G_VARIANT_TYPE ("{sv}"));
+//	    g_variant_builder_add (&builder, "s", key);
+//	    g_variant_builder_add (&builder, "v", value);
+//	    g_variant_builder_close (&builder);
+//	  }
+//
+//	g_variant_builder_close (&builder);
+//
+//	output = g_variant_builder_end (&builder);
 func (x *VariantBuilder) Open(TypeVar *VariantType) {
 
 	xVariantBuilderOpen(x.GoPointer(), TypeVar)
@@ -2087,9 +2070,9 @@ func (x *VariantBuilder) Open(TypeVar *VariantType) {
 
 var xVariantBuilderRef func(uintptr) *VariantBuilder
 
-// Increases the reference count on @builder.
+// Increases the reference count on builder.
 //
-// Don't call this on stack-allocated #GVariantBuilder instances or bad
+// Don't call this on stack-allocated [VariantBuilder] instances or bad
 // things will happen.
 func (x *VariantBuilder) Ref() *VariantBuilder {
 
@@ -2099,12 +2082,12 @@ func (x *VariantBuilder) Ref() *VariantBuilder {
 
 var xVariantBuilderUnref func(uintptr)
 
-// Decreases the reference count on @builder.
+// Decreases the reference count on builder.
 //
 // In the event that there are no more references, releases all memory
-// associated with the #GVariantBuilder.
+// associated with the [VariantBuilder].
 //
-// Don't call this on stack-allocated #GVariantBuilder instances or bad
+// Don't call this on stack-allocated [VariantBuilder] instances or bad
 // things will happen.
 func (x *VariantBuilder) Unref() {
 
@@ -2112,33 +2095,33 @@ func (x *VariantBuilder) Unref() {
 
 }
 
-// #GVariantDict is a mutable interface to #GVariant dictionaries.
+// [VariantDict] is a mutable interface to [Variant] dictionaries.
 //
 // It can be used for doing a sequence of dictionary lookups in an
-// efficient way on an existing #GVariant dictionary or it can be used
+// efficient way on an existing [Variant] dictionary or it can be used
 // to construct new dictionaries with a hashtable-like interface.  It
 // can also be used for taking existing dictionaries and modifying them
 // in order to create new ones.
 //
-// #GVariantDict can only be used with %G_VARIANT_TYPE_VARDICT
+// [VariantDict] can only be used with G_VARIANT_TYPE_VARDICT
 // dictionaries.
 //
-// It is possible to use #GVariantDict allocated on the stack or on the
-// heap.  When using a stack-allocated #GVariantDict, you begin with a
-// call to g_variant_dict_init() and free the resources with a call to
-// g_variant_dict_clear().
+// It is possible to use [VariantDict] allocated on the stack or on the
+// heap.  When using a stack-allocated [VariantDict], you begin with a
+// call to [VariantDict.Init] and free the resources with a call to
+// [VariantDict.Clear].
 //
-// Heap-allocated #GVariantDict follows normal refcounting rules: you
-// allocate it with g_variant_dict_new() and use g_variant_dict_ref()
-// and g_variant_dict_unref().
+// Heap-allocated [VariantDict] follows normal refcounting rules: you
+// allocate it with [NewVariantDict] and use [VariantDict.Ref]
+// and [VariantDict.Unref].
 //
-// g_variant_dict_end() is used to convert the #GVariantDict back into a
-// dictionary-type #GVariant.  When used with stack-allocated instances,
+// [VariantDict.End] is used to convert the [VariantDict] back into a
+// dictionary-type [Variant].  When used with stack-allocated instances,
 // this also implicitly frees all associated memory, but for
-// heap-allocated instances, you must still call g_variant_dict_unref()
+// heap-allocated instances, you must still call [VariantDict.Unref]
 // afterwards.
 //
-// You will typically want to use a heap-allocated #GVariantDict when
+// You will typically want to use a heap-allocated [VariantDict] when
 // you expose it as part of an API.  For most other uses, the
 // stack-allocated form will be more convenient.
 //
@@ -2146,11 +2129,9 @@ func (x *VariantBuilder) Unref() {
 // style: take an existing dictionary and look up the "count" uint32
 // key, adding 1 to it if it is found, or returning an error if the
 // key is not found.  Each returns the new dictionary as a floating
-// #GVariant.
+// [Variant].
 //
-// ## Using a stack-allocated GVariantDict
-//
-// |[&lt;!-- language="C" --&gt;
+// # Using a stack-allocated GVariantDict
 //
 //	GVariant *
 //	add_to_count (GVariant  *orig,
@@ -2159,24 +2140,20 @@ func (x *VariantBuilder) Unref() {
 //	  GVariantDict dict;
 //	  guint32 count;
 //
-//	  g_variant_dict_init (&amp;dict, orig);
-//	  if (!g_variant_dict_lookup (&amp;dict, "count", "u", &amp;count))
+//	  g_variant_dict_init (&dict, orig);
+//	  if (!g_variant_dict_lookup (&dict, "count", "u", &count))
 //	    {
 //	      g_set_error (...);
-//	      g_variant_dict_clear (&amp;dict);
+//	      g_variant_dict_clear (&dict);
 //	      return NULL;
 //	    }
 //
-//	  g_variant_dict_insert (&amp;dict, "count", "u", count + 1);
+//	  g_variant_dict_insert (&dict, "count", "u", count + 1);
 //
-//	  return g_variant_dict_end (&amp;dict);
+//	  return g_variant_dict_end (&dict);
 //	}
 //
-// ]|
-//
-// ## Using heap-allocated GVariantDict
-//
-// |[&lt;!-- language="C" --&gt;
+// # Using heap-allocated GVariantDict
 //
 //	GVariant *
 //	add_to_count (GVariant  *orig,
@@ -2188,7 +2165,7 @@ func (x *VariantBuilder) Unref() {
 //
 //	  dict = g_variant_dict_new (orig);
 //
-//	  if (g_variant_dict_lookup (dict, "count", "u", &amp;count))
+//	  if (g_variant_dict_lookup (dict, "count", "u", &count))
 //	    {
 //	      g_variant_dict_insert (dict, "count", "u", count + 1);
 //	      result = g_variant_dict_end (dict);
@@ -2203,8 +2180,6 @@ func (x *VariantBuilder) Unref() {
 //
 //	  return result;
 //	}
-//
-// ]|
 type VariantDict struct {
 	_ structs.HostLayout
 }
@@ -2221,16 +2196,16 @@ func (x *VariantDict) GoPointer() uintptr {
 
 var xNewVariantDict func(*Variant) *VariantDict
 
-// Allocates and initialises a new #GVariantDict.
+// Allocates and initialises a new [VariantDict].
 //
-// You should call g_variant_dict_unref() on the return value when it
+// You should call [VariantDict.Unref] on the return value when it
 // is no longer needed.  The memory will not be automatically freed by
 // any other call.
 //
-// In some cases it may be easier to place a #GVariantDict directly on
+// In some cases it may be easier to place a [VariantDict] directly on
 // the stack of the calling function and initialise it with
-// g_variant_dict_init().  This is particularly useful when you are
-// using #GVariantDict to construct a #GVariant.
+// [VariantDict.Init].  This is particularly useful when you are
+// using [VariantDict] to construct a [Variant].
 func NewVariantDict(FromAsvVar *Variant) *VariantDict {
 
 	cret := xNewVariantDict(FromAsvVar)
@@ -2239,19 +2214,19 @@ func NewVariantDict(FromAsvVar *Variant) *VariantDict {
 
 var xVariantDictClear func(uintptr)
 
-// Releases all memory associated with a #GVariantDict without freeing
-// the #GVariantDict structure itself.
+// Releases all memory associated with a [VariantDict] without freeing
+// the [VariantDict] structure itself.
 //
 // It typically only makes sense to do this on a stack-allocated
-// #GVariantDict if you want to abort building the value part-way
+// [VariantDict] if you want to abort building the value part-way
 // through.  This function need not be called if you call
-// g_variant_dict_end() and it also doesn't need to be called on dicts
-// allocated with g_variant_dict_new (see g_variant_dict_unref() for
+// [VariantDict.End] and it also doesn't need to be called on dicts
+// allocated with g_variant_dict_new (see [VariantDict.Unref] for
 // that).
 //
 // It is valid to call this function on either an initialised
-// #GVariantDict or one that was previously cleared by an earlier call
-// to g_variant_dict_clear() but it is not valid to call this function
+// [VariantDict] or one that was previously cleared by an earlier call
+// to [VariantDict.Clear] but it is not valid to call this function
 // on uninitialised memory.
 func (x *VariantDict) Clear() {
 
@@ -2261,7 +2236,7 @@ func (x *VariantDict) Clear() {
 
 var xVariantDictContains func(uintptr, string) bool
 
-// Checks if @key exists in @dict.
+// Checks if key exists in dict.
 func (x *VariantDict) Contains(KeyVar string) bool {
 
 	cret := xVariantDictContains(x.GoPointer(), KeyVar)
@@ -2270,12 +2245,12 @@ func (x *VariantDict) Contains(KeyVar string) bool {
 
 var xVariantDictEnd func(uintptr) *Variant
 
-// Returns the current value of @dict as a #GVariant of type
-// %G_VARIANT_TYPE_VARDICT, clearing it in the process.
+// Returns the current value of dict as a [Variant] of type
+// G_VARIANT_TYPE_VARDICT, clearing it in the process.
 //
-// It is not permissible to use @dict in any way after this call except
+// It is not permissible to use dict in any way after this call except
 // for reference counting operations (in the case of a heap-allocated
-// #GVariantDict) or by reinitialising it with g_variant_dict_init() (in
+// [VariantDict]) or by reinitialising it with [VariantDict.Init] (in
 // the case of stack-allocated).
 func (x *VariantDict) End() *Variant {
 
@@ -2285,22 +2260,22 @@ func (x *VariantDict) End() *Variant {
 
 var xVariantDictInit func(uintptr, *Variant)
 
-// Initialises a #GVariantDict structure.
+// Initialises a [VariantDict] structure.
 //
-// If @from_asv is given, it is used to initialise the dictionary.
+// If from_asv is given, it is used to initialise the dictionary.
 //
-// This function completely ignores the previous contents of @dict.  On
+// This function completely ignores the previous contents of dict.  On
 // one hand this means that it is valid to pass in completely
 // uninitialised memory.  On the other hand, this means that if you are
-// initialising over top of an existing #GVariantDict you need to first
-// call g_variant_dict_clear() in order to avoid leaking memory.
+// initialising over top of an existing [VariantDict] you need to first
+// call [VariantDict.Clear] in order to avoid leaking memory.
 //
-// You must not call g_variant_dict_ref() or g_variant_dict_unref() on a
-// #GVariantDict that was initialised with this function.  If you ever
-// pass a reference to a #GVariantDict outside of the control of your
+// You must not call [VariantDict.Ref] or [VariantDict.Unref] on a
+// [VariantDict] that was initialised with this function.  If you ever
+// pass a reference to a [VariantDict] outside of the control of your
 // own code then you should assume that the person receiving that
 // reference may try to use reference counting; you should use
-// g_variant_dict_new() instead of this function.
+// [NewVariantDict] instead of this function.
 func (x *VariantDict) Init(FromAsvVar *Variant) {
 
 	xVariantDictInit(x.GoPointer(), FromAsvVar)
@@ -2309,10 +2284,10 @@ func (x *VariantDict) Init(FromAsvVar *Variant) {
 
 var xVariantDictInsert func(uintptr, string, string, ...interface{})
 
-// Inserts a value into a #GVariantDict.
+// Inserts a value into a [VariantDict].
 //
 // This call is a convenience wrapper that is exactly equivalent to
-// calling g_variant_new() followed by g_variant_dict_insert_value().
+// calling [NewVariant] followed by [VariantDict.InsertValue].
 func (x *VariantDict) Insert(KeyVar string, FormatStringVar string, varArgs ...interface{}) {
 
 	xVariantDictInsert(x.GoPointer(), KeyVar, FormatStringVar, varArgs...)
@@ -2321,9 +2296,9 @@ func (x *VariantDict) Insert(KeyVar string, FormatStringVar string, varArgs ...i
 
 var xVariantDictInsertValue func(uintptr, string, *Variant)
 
-// Inserts (or replaces) a key in a #GVariantDict.
+// Inserts (or replaces) a key in a [VariantDict].
 //
-// @value is consumed if it is floating.
+// value is consumed if it is floating.
 func (x *VariantDict) InsertValue(KeyVar string, ValueVar *Variant) {
 
 	xVariantDictInsertValue(x.GoPointer(), KeyVar, ValueVar)
@@ -2332,17 +2307,17 @@ func (x *VariantDict) InsertValue(KeyVar string, ValueVar *Variant) {
 
 var xVariantDictLookup func(uintptr, string, string, ...interface{}) bool
 
-// Looks up a value in a #GVariantDict.
+// Looks up a value in a [VariantDict].
 //
-// This function is a wrapper around g_variant_dict_lookup_value() and
-// g_variant_get().  In the case that %NULL would have been returned,
-// this function returns %FALSE and does not modify the values of the arguments
+// This function is a wrapper around [VariantDict.LookupValue] and
+// [Variant.Get].  In the case that nil would have been returned,
+// this function returns false and does not modify the values of the arguments
 // passed in to @....  Otherwise, it unpacks the returned
-// value and returns %TRUE.
+// value and returns true.
 //
-// @format_string determines the C types that are used for unpacking the
+// format_string determines the C types that are used for unpacking the
 // values and also determines if the values are copied or borrowed, see the
-// section on [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// section on GVariant format strings.
 func (x *VariantDict) Lookup(KeyVar string, FormatStringVar string, varArgs ...interface{}) bool {
 
 	cret := xVariantDictLookup(x.GoPointer(), KeyVar, FormatStringVar, varArgs...)
@@ -2351,16 +2326,16 @@ func (x *VariantDict) Lookup(KeyVar string, FormatStringVar string, varArgs ...i
 
 var xVariantDictLookupValue func(uintptr, string, *VariantType) *Variant
 
-// Looks up a value in a #GVariantDict.
+// Looks up a value in a [VariantDict].
 //
-// If @key is not found in @dictionary, %NULL is returned.
+// If key is not found in dictionary, nil is returned.
 //
-// The @expected_type string specifies what type of value is expected.
-// If the value associated with @key has a different type then %NULL is
+// The expected_type string specifies what type of value is expected.
+// If the value associated with key has a different type then nil is
 // returned.
 //
 // If the key is found and the value has the correct type, it is
-// returned.  If @expected_type was specified then any non-%NULL return
+// returned.  If expected_type was specified then any non-nil return
 // value will have this type.
 func (x *VariantDict) LookupValue(KeyVar string, ExpectedTypeVar *VariantType) *Variant {
 
@@ -2370,9 +2345,9 @@ func (x *VariantDict) LookupValue(KeyVar string, ExpectedTypeVar *VariantType) *
 
 var xVariantDictRef func(uintptr) *VariantDict
 
-// Increases the reference count on @dict.
+// Increases the reference count on dict.
 //
-// Don't call this on stack-allocated #GVariantDict instances or bad
+// Don't call this on stack-allocated [VariantDict] instances or bad
 // things will happen.
 func (x *VariantDict) Ref() *VariantDict {
 
@@ -2382,7 +2357,7 @@ func (x *VariantDict) Ref() *VariantDict {
 
 var xVariantDictRemove func(uintptr, string) bool
 
-// Removes a key and its associated value from a #GVariantDict.
+// Removes a key and its associated value from a [VariantDict].
 func (x *VariantDict) Remove(KeyVar string) bool {
 
 	cret := xVariantDictRemove(x.GoPointer(), KeyVar)
@@ -2391,12 +2366,12 @@ func (x *VariantDict) Remove(KeyVar string) bool {
 
 var xVariantDictUnref func(uintptr)
 
-// Decreases the reference count on @dict.
+// Decreases the reference count on dict.
 //
 // In the event that there are no more references, releases all memory
-// associated with the #GVariantDict.
+// associated with the [VariantDict].
 //
-// Don't call this on stack-allocated #GVariantDict instances or bad
+// Don't call this on stack-allocated [VariantDict] instances or bad
 // things will happen.
 func (x *VariantDict) Unref() {
 
@@ -2404,7 +2379,7 @@ func (x *VariantDict) Unref() {
 
 }
 
-// #GVariantIter is an opaque data structure and can only be accessed
+// [VariantIter] is an opaque data structure and can only be accessed
 // using the following functions.
 type VariantIter struct {
 	_ structs.HostLayout
@@ -2418,16 +2393,16 @@ func (x *VariantIter) GoPointer() uintptr {
 
 var xVariantIterCopy func(uintptr) *VariantIter
 
-// Creates a new heap-allocated #GVariantIter to iterate over the
-// container that was being iterated over by @iter.  Iteration begins on
+// Creates a new heap-allocated [VariantIter] to iterate over the
+// container that was being iterated over by iter.  Iteration begins on
 // the new iterator from the current position of the old iterator but
 // the two copies are independent past that point.
 //
-// Use g_variant_iter_free() to free the return value when you no longer
+// Use [VariantIter.Free] to free the return value when you no longer
 // need it.
 //
-// A reference is taken to the container that @iter is iterating over
-// and will be related only when g_variant_iter_free() is called.
+// A reference is taken to the container that iter is iterating over
+// and will be related only when [VariantIter.Free] is called.
 func (x *VariantIter) Copy() *VariantIter {
 
 	cret := xVariantIterCopy(x.GoPointer())
@@ -2436,9 +2411,9 @@ func (x *VariantIter) Copy() *VariantIter {
 
 var xVariantIterFree func(uintptr)
 
-// Frees a heap-allocated #GVariantIter.  Only call this function on
-// iterators that were returned by g_variant_iter_new() or
-// g_variant_iter_copy().
+// Frees a heap-allocated [VariantIter].  Only call this function on
+// iterators that were returned by [Variant.IterNew] or
+// [VariantIter.Copy].
 func (x *VariantIter) Free() {
 
 	xVariantIterFree(x.GoPointer())
@@ -2447,11 +2422,11 @@ func (x *VariantIter) Free() {
 
 var xVariantIterInit func(uintptr, *Variant) uint
 
-// Initialises (without allocating) a #GVariantIter.  @iter may be
+// Initialises (without allocating) a [VariantIter].  iter may be
 // completely uninitialised prior to this call; its old value is
 // ignored.
 //
-// The iterator remains valid for as long as @value exists, and need not
+// The iterator remains valid for as long as value exists, and need not
 // be freed in any way.
 func (x *VariantIter) Init(ValueVar *Variant) uint {
 
@@ -2462,9 +2437,9 @@ func (x *VariantIter) Init(ValueVar *Variant) uint {
 var xVariantIterLoop func(uintptr, string, ...interface{}) bool
 
 // Gets the next item in the container and unpacks it into the variable
-// argument list according to @format_string, returning %TRUE.
+// argument list according to format_string, returning true.
 //
-// If no more items remain then %FALSE is returned.
+// If no more items remain then false is returned.
 //
 // On the first call to this function, the pointers appearing on the
 // variable argument list are assumed to point at uninitialised memory.
@@ -2478,15 +2453,14 @@ var xVariantIterLoop func(uintptr, string, ...interface{}) bool
 // used when iterating over an array.  It is only valid to call this
 // function with a string constant for the format string and the same
 // string constant must be used each time.  Mixing calls to this
-// function and g_variant_iter_next() or g_variant_iter_next_value() on
+// function and [VariantIter.Next] or [VariantIter.NextValue] on
 // the same iterator causes undefined behavior.
 //
-// If you break out of a such a while loop using g_variant_iter_loop() then
+// If you break out of a such a while loop using [VariantIter.Loop] then
 // you must free or unreference all the unpacked values as you would with
-// g_variant_get(). Failure to do so will cause a memory leak.
+// [Variant.Get]. Failure to do so will cause a memory leak.
 //
-// Here is an example for memory management with g_variant_iter_loop():
-// |[&lt;!-- language="C" --&gt;
+// Here is an example for memory management with [VariantIter.Loop]:
 //
 //	// Iterates a dictionary of type 'a{sv}'
 //	void
@@ -2496,8 +2470,8 @@ var xVariantIterLoop func(uintptr, string, ...interface{}) bool
 //	  GVariant *value;
 //	  gchar *key;
 //
-//	  g_variant_iter_init (&amp;iter, dictionary);
-//	  while (g_variant_iter_loop (&amp;iter, "{sv}", &amp;key, &amp;value))
+//	  g_variant_iter_init (&iter, dictionary);
+//	  while (g_variant_iter_loop (&iter, "{sv}", &key, &value))
 //	    {
 //	      g_print ("Item '%s' has type '%s'\n", key,
 //	               g_variant_get_type_string (value));
@@ -2507,24 +2481,22 @@ var xVariantIterLoop func(uintptr, string, ...interface{}) bool
 //	    }
 //	}
 //
-// ]|
-//
-// For most cases you should use g_variant_iter_next().
+// For most cases you should use [VariantIter.Next].
 //
-// This function is really only useful when unpacking into #GVariant or
-// #GVariantIter in order to allow you to skip the call to
-// g_variant_unref() or g_variant_iter_free().
+// This function is really only useful when unpacking into [Variant] or
+// [VariantIter] in order to allow you to skip the call to
+// [Variant.Unref] or [VariantIter.Free].
 //
 // For example, if you are only looping over simple integer and string
-// types, g_variant_iter_next() is definitely preferred.  For string
-// types, use the '&amp;' prefix to avoid allocating any memory at all (and
+// types, [VariantIter.Next] is definitely preferred.  For string
+// types, use the '&' prefix to avoid allocating any memory at all (and
 // thereby avoiding the need to free anything as well).
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed.
 //
 // See the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 func (x *VariantIter) Loop(FormatStringVar string, varArgs ...interface{}) bool {
 
 	cret := xVariantIterLoop(x.GoPointer(), FormatStringVar, varArgs...)
@@ -2547,17 +2519,16 @@ func (x *VariantIter) NChildren() uint {
 var xVariantIterNext func(uintptr, string, ...interface{}) bool
 
 // Gets the next item in the container and unpacks it into the variable
-// argument list according to @format_string, returning %TRUE.
+// argument list according to format_string, returning true.
 //
-// If no more items remain then %FALSE is returned.
+// If no more items remain then false is returned.
 //
 // All of the pointers given on the variable arguments list of this
 // function are assumed to point at uninitialised memory.  It is the
 // responsibility of the caller to free all of the values returned by
 // the unpacking process.
 //
-// Here is an example for memory management with g_variant_iter_next():
-// |[&lt;!-- language="C" --&gt;
+// Here is an example for memory management with [VariantIter.Next]:
 //
 //	// Iterates a dictionary of type 'a{sv}'
 //	void
@@ -2567,8 +2538,8 @@ var xVariantIterNext func(uintptr, string, ...interface{}) bool
 //	  GVariant *value;
 //	  gchar *key;
 //
-//	  g_variant_iter_init (&amp;iter, dictionary);
-//	  while (g_variant_iter_next (&amp;iter, "{sv}", &amp;key, &amp;value))
+//	  g_variant_iter_init (&iter, dictionary);
+//	  while (g_variant_iter_next (&iter, "{sv}", &key, &value))
 //	    {
 //	      g_print ("Item '%s' has type '%s'\n", key,
 //	               g_variant_get_type_string (value));
@@ -2579,16 +2550,14 @@ var xVariantIterNext func(uintptr, string, ...interface{}) bool
 //	    }
 //	}
 //
-// ]|
-//
 // For a solution that is likely to be more convenient to C programmers
-// when dealing with loops, see g_variant_iter_loop().
+// when dealing with loops, see [VariantIter.Loop].
 //
-// @format_string determines the C types that are used for unpacking
+// format_string determines the C types that are used for unpacking
 // the values and also determines if the values are copied or borrowed.
 //
 // See the section on
-// [`GVariant` format strings](gvariant-format-strings.html#pointers).
+// GVariant format strings.
 func (x *VariantIter) Next(FormatStringVar string, varArgs ...interface{}) bool {
 
 	cret := xVariantIterNext(x.GoPointer(), FormatStringVar, varArgs...)
@@ -2598,13 +2567,12 @@ func (x *VariantIter) Next(FormatStringVar string, varArgs ...interface{}) bool
 var xVariantIterNextValue func(uintptr) *Variant
 
 // Gets the next item in the container.  If no more items remain then
-// %NULL is returned.
+// nil is returned.
 //
-// Use g_variant_unref() to drop your reference on the return value when
+// Use [Variant.Unref] to drop your reference on the return value when
 // you no longer need it.
 //
-// Here is an example for iterating with g_variant_iter_next_value():
-// |[&lt;!-- language="C" --&gt;
+// Here is an example for iterating with [VariantIter.NextValue]:
 //
 //	// recursively iterate a container
 //	void
@@ -2613,8 +2581,8 @@ var xVariantIterNextValue func(uintptr) *Variant
 //	  GVariantIter iter;
 //	  GVariant *child;
 //
-//	  g_variant_iter_init (&amp;iter, container);
-//	  while ((child = g_variant_iter_next_value (&amp;iter)))
+//	  g_variant_iter_init (&iter, container);
+//	  while ((child = g_variant_iter_next_value (&iter)))
 //	    {
 //	      g_print ("type '%s'\n", g_variant_get_type_string (child));
 //
@@ -2624,59 +2592,186 @@ var xVariantIterNextValue func(uintptr) *Variant
 //	      g_variant_unref (child);
 //	    }
 //	}
-//
-// ]|
 func (x *VariantIter) NextValue() *Variant {
 
 	cret := xVariantIterNextValue(x.GoPointer())
 	return cret
 }
 
-// The range of possible top-level types of #GVariant instances.
+// The range of possible top-level types of [Variant] instances.
 type VariantClass int
 
 const (
 
-	// The #GVariant is a boolean.
+	// The [Variant] is a boolean.
 	GVariantClassBooleanValue VariantClass = 98
-	// The #GVariant is a byte.
+	// The [Variant] is a byte.
 	GVariantClassByteValue VariantClass = 121
-	// The #GVariant is a signed 16 bit integer.
+	// The [Variant] is a signed 16 bit integer.
 	GVariantClassInt16Value VariantClass = 110
-	// The #GVariant is an unsigned 16 bit integer.
+	// The [Variant] is an unsigned 16 bit integer.
 	GVariantClassUint16Value VariantClass = 113
-	// The #GVariant is a signed 32 bit integer.
+	// The [Variant] is a signed 32 bit integer.
 	GVariantClassInt32Value VariantClass = 105
-	// The #GVariant is an unsigned 32 bit integer.
+	// The [Variant] is an unsigned 32 bit integer.
 	GVariantClassUint32Value VariantClass = 117
-	// The #GVariant is a signed 64 bit integer.
+	// The [Variant] is a signed 64 bit integer.
 	GVariantClassInt64Value VariantClass = 120
-	// The #GVariant is an unsigned 64 bit integer.
+	// The [Variant] is an unsigned 64 bit integer.
 	GVariantClassUint64Value VariantClass = 116
-	// The #GVariant is a file handle index.
+	// The [Variant] is a file handle index.
 	GVariantClassHandleValue VariantClass = 104
-	// The #GVariant is a double precision floating
+	// The [Variant] is a double precision floating
 	//                          point value.
 	GVariantClassDoubleValue VariantClass = 100
-	// The #GVariant is a normal string.
+	// The [Variant] is a normal string.
 	GVariantClassStringValue VariantClass = 115
-	// The #GVariant is a D-Bus object path
+	// The [Variant] is a D-Bus object path
 	//                               string.
 	GVariantClassObjectPathValue VariantClass = 111
-	// The #GVariant is a D-Bus signature string.
+	// The [Variant] is a D-Bus signature string.
 	GVariantClassSignatureValue VariantClass = 103
-	// The #GVariant is a variant.
+	// The [Variant] is a variant.
 	GVariantClassVariantValue VariantClass = 118
-	// The #GVariant is a maybe-typed value.
+	// The [Variant] is a maybe-typed value.
 	GVariantClassMaybeValue VariantClass = 109
-	// The #GVariant is an array.
+	// The [Variant] is an array.
 	GVariantClassArrayValue VariantClass = 97
-	// The #GVariant is a tuple.
+	// The [Variant] is a tuple.
 	GVariantClassTupleValue VariantClass = 40
-	// The #GVariant is a dictionary entry.
+	// The [Variant] is a dictionary entry.
 	GVariantClassDictEntryValue VariantClass = 123
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x VariantClass) String() string {
+	switch x {
+	case GVariantClassBooleanValue:
+		return "G_VARIANT_CLASS_BOOLEAN"
+	case GVariantClassByteValue:
+		return "G_VARIANT_CLASS_BYTE"
+	case GVariantClassInt16Value:
+		return "G_VARIANT_CLASS_INT16"
+	case GVariantClassUint16Value:
+		return "G_VARIANT_CLASS_UINT16"
+	case GVariantClassInt32Value:
+		return "G_VARIANT_CLASS_INT32"
+	case GVariantClassUint32Value:
+		return "G_VARIANT_CLASS_UINT32"
+	case GVariantClassInt64Value:
+		return "G_VARIANT_CLASS_INT64"
+	case GVariantClassUint64Value:
+		return "G_VARIANT_CLASS_UINT64"
+	case GVariantClassHandleValue:
+		return "G_VARIANT_CLASS_HANDLE"
+	case GVariantClassDoubleValue:
+		return "G_VARIANT_CLASS_DOUBLE"
+	case GVariantClassStringValue:
+		return "G_VARIANT_CLASS_STRING"
+	case GVariantClassObjectPathValue:
+		return "G_VARIANT_CLASS_OBJECT_PATH"
+	case GVariantClassSignatureValue:
+		return "G_VARIANT_CLASS_SIGNATURE"
+	case GVariantClassVariantValue:
+		return "G_VARIANT_CLASS_VARIANT"
+	case GVariantClassMaybeValue:
+		return "G_VARIANT_CLASS_MAYBE"
+	case GVariantClassArrayValue:
+		return "G_VARIANT_CLASS_ARRAY"
+	case GVariantClassTupleValue:
+		return "G_VARIANT_CLASS_TUPLE"
+	case GVariantClassDictEntryValue:
+		return "G_VARIANT_CLASS_DICT_ENTRY"
+	}
+	return fmt.Sprintf("VariantClass(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x VariantClass) Nick() string {
+	switch x {
+	case GVariantClassBooleanValue:
+		return "boolean"
+	case GVariantClassByteValue:
+		return "byte"
+	case GVariantClassInt16Value:
+		return "int16"
+	case GVariantClassUint16Value:
+		return "uint16"
+	case GVariantClassInt32Value:
+		return "int32"
+	case GVariantClassUint32Value:
+		return "uint32"
+	case GVariantClassInt64Value:
+		return "int64"
+	case GVariantClassUint64Value:
+		return "uint64"
+	case GVariantClassHandleValue:
+		return "handle"
+	case GVariantClassDoubleValue:
+		return "double"
+	case GVariantClassStringValue:
+		return "string"
+	case GVariantClassObjectPathValue:
+		return "object-path"
+	case GVariantClassSignatureValue:
+		return "signature"
+	case GVariantClassVariantValue:
+		return "variant"
+	case GVariantClassMaybeValue:
+		return "maybe"
+	case GVariantClassArrayValue:
+		return "array"
+	case GVariantClassTupleValue:
+		return "tuple"
+	case GVariantClassDictEntryValue:
+		return "dict-entry"
+	}
+	return ""
+}
+
+// VariantClassFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func VariantClassFromString(s string) (VariantClass, bool) {
+	switch s {
+	case "G_VARIANT_CLASS_BOOLEAN", "boolean":
+		return GVariantClassBooleanValue, true
+	case "G_VARIANT_CLASS_BYTE", "byte":
+		return GVariantClassByteValue, true
+	case "G_VARIANT_CLASS_INT16", "int16":
+		return GVariantClassInt16Value, true
+	case "G_VARIANT_CLASS_UINT16", "uint16":
+		return GVariantClassUint16Value, true
+	case "G_VARIANT_CLASS_INT32", "int32":
+		return GVariantClassInt32Value, true
+	case "G_VARIANT_CLASS_UINT32", "uint32":
+		return GVariantClassUint32Value, true
+	case "G_VARIANT_CLASS_INT64", "int64":
+		return GVariantClassInt64Value, true
+	case "G_VARIANT_CLASS_UINT64", "uint64":
+		return GVariantClassUint64Value, true
+	case "G_VARIANT_CLASS_HANDLE", "handle":
+		return GVariantClassHandleValue, true
+	case "G_VARIANT_CLASS_DOUBLE", "double":
+		return GVariantClassDoubleValue, true
+	case "G_VARIANT_CLASS_STRING", "string":
+		return GVariantClassStringValue, true
+	case "G_VARIANT_CLASS_OBJECT_PATH", "object-path":
+		return GVariantClassObjectPathValue, true
+	case "G_VARIANT_CLASS_SIGNATURE", "signature":
+		return GVariantClassSignatureValue, true
+	case "G_VARIANT_CLASS_VARIANT", "variant":
+		return GVariantClassVariantValue, true
+	case "G_VARIANT_CLASS_MAYBE", "maybe":
+		return GVariantClassMaybeValue, true
+	case "G_VARIANT_CLASS_ARRAY", "array":
+		return GVariantClassArrayValue, true
+	case "G_VARIANT_CLASS_TUPLE", "tuple":
+		return GVariantClassTupleValue, true
+	case "G_VARIANT_CLASS_DICT_ENTRY", "dict-entry":
+		return GVariantClassDictEntryValue, true
+	}
+	return 0, false
+}
+
 // Error codes returned by parsing text-format GVariants.
 type VariantParseError int
 
@@ -2684,23 +2779,23 @@ const (
 
 	// generic error (unused)
 	GVariantParseErrorFailedValue VariantParseError = 0
-	// a non-basic #GVariantType was given where a basic type was expected
+	// a non-basic [VariantType] was given where a basic type was expected
 	GVariantParseErrorBasicTypeExpectedValue VariantParseError = 1
-	// cannot infer the #GVariantType
+	// cannot infer the [VariantType]
 	GVariantParseErrorCannotInferTypeValue VariantParseError = 2
-	// an indefinite #GVariantType was given where a definite type was expected
+	// an indefinite [VariantType] was given where a definite type was expected
 	GVariantParseErrorDefiniteTypeExpectedValue VariantParseError = 3
 	// extra data after parsing finished
 	GVariantParseErrorInputNotAtEndValue VariantParseError = 4
 	// invalid character in number or unicode escape
 	GVariantParseErrorInvalidCharacterValue VariantParseError = 5
-	// not a valid #GVariant format string
+	// not a valid [Variant] format string
 	GVariantParseErrorInvalidFormatStringValue VariantParseError = 6
 	// not a valid object path
 	GVariantParseErrorInvalidObjectPathValue VariantParseError = 7
 	// not a valid type signature
 	GVariantParseErrorInvalidSignatureValue VariantParseError = 8
-	// not a valid #GVariant type string
+	// not a valid [Variant] type string
 	GVariantParseErrorInvalidTypeStringValue VariantParseError = 9
 	// could not find a common type for array entries
 	GVariantParseErrorNoCommonTypeValue VariantParseError = 10
@@ -2718,15 +2813,200 @@ const (
 	GVariantParseErrorUnterminatedStringConstantValue VariantParseError = 16
 	// no value given
 	GVariantParseErrorValueExpectedValue VariantParseError = 17
-	// variant was too deeply nested; #GVariant is only guaranteed to handle nesting up to 64 levels (Since: 2.64)
+	// variant was too deeply nested; [Variant] is only guaranteed to handle nesting up to 64 levels (Since: 2.64)
 	GVariantParseErrorRecursionValue VariantParseError = 18
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (VariantParseError) ErrorDomain() string {
+	return "g-variant-parse-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x VariantParseError) Error() string {
+	switch x {
+	case GVariantParseErrorFailedValue:
+		return "G_VARIANT_PARSE_ERROR_FAILED"
+	case GVariantParseErrorBasicTypeExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_BASIC_TYPE_EXPECTED"
+	case GVariantParseErrorCannotInferTypeValue:
+		return "G_VARIANT_PARSE_ERROR_CANNOT_INFER_TYPE"
+	case GVariantParseErrorDefiniteTypeExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_DEFINITE_TYPE_EXPECTED"
+	case GVariantParseErrorInputNotAtEndValue:
+		return "G_VARIANT_PARSE_ERROR_INPUT_NOT_AT_END"
+	case GVariantParseErrorInvalidCharacterValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_CHARACTER"
+	case GVariantParseErrorInvalidFormatStringValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_FORMAT_STRING"
+	case GVariantParseErrorInvalidObjectPathValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_OBJECT_PATH"
+	case GVariantParseErrorInvalidSignatureValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_SIGNATURE"
+	case GVariantParseErrorInvalidTypeStringValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_TYPE_STRING"
+	case GVariantParseErrorNoCommonTypeValue:
+		return "G_VARIANT_PARSE_ERROR_NO_COMMON_TYPE"
+	case GVariantParseErrorNumberOutOfRangeValue:
+		return "G_VARIANT_PARSE_ERROR_NUMBER_OUT_OF_RANGE"
+	case GVariantParseErrorNumberTooBigValue:
+		return "G_VARIANT_PARSE_ERROR_NUMBER_TOO_BIG"
+	case GVariantParseErrorTypeErrorValue:
+		return "G_VARIANT_PARSE_ERROR_TYPE_ERROR"
+	case GVariantParseErrorUnexpectedTokenValue:
+		return "G_VARIANT_PARSE_ERROR_UNEXPECTED_TOKEN"
+	case GVariantParseErrorUnknownKeywordValue:
+		return "G_VARIANT_PARSE_ERROR_UNKNOWN_KEYWORD"
+	case GVariantParseErrorUnterminatedStringConstantValue:
+		return "G_VARIANT_PARSE_ERROR_UNTERMINATED_STRING_CONSTANT"
+	case GVariantParseErrorValueExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_VALUE_EXPECTED"
+	case GVariantParseErrorRecursionValue:
+		return "G_VARIANT_PARSE_ERROR_RECURSION"
+	}
+	return fmt.Sprintf("g-variant-parse-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x VariantParseError) String() string {
+	switch x {
+	case GVariantParseErrorFailedValue:
+		return "G_VARIANT_PARSE_ERROR_FAILED"
+	case GVariantParseErrorBasicTypeExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_BASIC_TYPE_EXPECTED"
+	case GVariantParseErrorCannotInferTypeValue:
+		return "G_VARIANT_PARSE_ERROR_CANNOT_INFER_TYPE"
+	case GVariantParseErrorDefiniteTypeExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_DEFINITE_TYPE_EXPECTED"
+	case GVariantParseErrorInputNotAtEndValue:
+		return "G_VARIANT_PARSE_ERROR_INPUT_NOT_AT_END"
+	case GVariantParseErrorInvalidCharacterValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_CHARACTER"
+	case GVariantParseErrorInvalidFormatStringValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_FORMAT_STRING"
+	case GVariantParseErrorInvalidObjectPathValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_OBJECT_PATH"
+	case GVariantParseErrorInvalidSignatureValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_SIGNATURE"
+	case GVariantParseErrorInvalidTypeStringValue:
+		return "G_VARIANT_PARSE_ERROR_INVALID_TYPE_STRING"
+	case GVariantParseErrorNoCommonTypeValue:
+		return "G_VARIANT_PARSE_ERROR_NO_COMMON_TYPE"
+	case GVariantParseErrorNumberOutOfRangeValue:
+		return "G_VARIANT_PARSE_ERROR_NUMBER_OUT_OF_RANGE"
+	case GVariantParseErrorNumberTooBigValue:
+		return "G_VARIANT_PARSE_ERROR_NUMBER_TOO_BIG"
+	case GVariantParseErrorTypeErrorValue:
+		return "G_VARIANT_PARSE_ERROR_TYPE_ERROR"
+	case GVariantParseErrorUnexpectedTokenValue:
+		return "G_VARIANT_PARSE_ERROR_UNEXPECTED_TOKEN"
+	case GVariantParseErrorUnknownKeywordValue:
+		return "G_VARIANT_PARSE_ERROR_UNKNOWN_KEYWORD"
+	case GVariantParseErrorUnterminatedStringConstantValue:
+		return "G_VARIANT_PARSE_ERROR_UNTERMINATED_STRING_CONSTANT"
+	case GVariantParseErrorValueExpectedValue:
+		return "G_VARIANT_PARSE_ERROR_VALUE_EXPECTED"
+	case GVariantParseErrorRecursionValue:
+		return "G_VARIANT_PARSE_ERROR_RECURSION"
+	}
+	return fmt.Sprintf("VariantParseError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x VariantParseError) Nick() string {
+	switch x {
+	case GVariantParseErrorFailedValue:
+		return "failed"
+	case GVariantParseErrorBasicTypeExpectedValue:
+		return "basic-type-expected"
+	case GVariantParseErrorCannotInferTypeValue:
+		return "cannot-infer-type"
+	case GVariantParseErrorDefiniteTypeExpectedValue:
+		return "definite-type-expected"
+	case GVariantParseErrorInputNotAtEndValue:
+		return "input-not-at-end"
+	case GVariantParseErrorInvalidCharacterValue:
+		return "invalid-character"
+	case GVariantParseErrorInvalidFormatStringValue:
+		return "invalid-format-string"
+	case GVariantParseErrorInvalidObjectPathValue:
+		return "invalid-object-path"
+	case GVariantParseErrorInvalidSignatureValue:
+		return "invalid-signature"
+	case GVariantParseErrorInvalidTypeStringValue:
+		return "invalid-type-string"
+	case GVariantParseErrorNoCommonTypeValue:
+		return "no-common-type"
+	case GVariantParseErrorNumberOutOfRangeValue:
+		return "number-out-of-range"
+	case GVariantParseErrorNumberTooBigValue:
+		return "number-too-big"
+	case GVariantParseErrorTypeErrorValue:
+		return "type-error"
+	case GVariantParseErrorUnexpectedTokenValue:
+		return "unexpected-token"
+	case GVariantParseErrorUnknownKeywordValue:
+		return "unknown-keyword"
+	case GVariantParseErrorUnterminatedStringConstantValue:
+		return "unterminated-string-constant"
+	case GVariantParseErrorValueExpectedValue:
+		return "value-expected"
+	case GVariantParseErrorRecursionValue:
+		return "recursion"
+	}
+	return ""
+}
+
+// VariantParseErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func VariantParseErrorFromString(s string) (VariantParseError, bool) {
+	switch s {
+	case "G_VARIANT_PARSE_ERROR_FAILED", "failed":
+		return GVariantParseErrorFailedValue, true
+	case "G_VARIANT_PARSE_ERROR_BASIC_TYPE_EXPECTED", "basic-type-expected":
+		return GVariantParseErrorBasicTypeExpectedValue, true
+	case "G_VARIANT_PARSE_ERROR_CANNOT_INFER_TYPE", "cannot-infer-type":
+		return GVariantParseErrorCannotInferTypeValue, true
+	case "G_VARIANT_PARSE_ERROR_DEFINITE_TYPE_EXPECTED", "definite-type-expected":
+		return GVariantParseErrorDefiniteTypeExpectedValue, true
+	case "G_VARIANT_PARSE_ERROR_INPUT_NOT_AT_END", "input-not-at-end":
+		return GVariantParseErrorInputNotAtEndValue, true
+	case "G_VARIANT_PARSE_ERROR_INVALID_CHARACTER", "invalid-character":
+		return GVariantParseErrorInvalidCharacterValue, true
+	case "G_VARIANT_PARSE_ERROR_INVALID_FORMAT_STRING", "invalid-format-string":
+		return GVariantParseErrorInvalidFormatStringValue, true
+	case "G_VARIANT_PARSE_ERROR_INVALID_OBJECT_PATH", "invalid-object-path":
+		return GVariantParseErrorInvalidObjectPathValue, true
+	case "G_VARIANT_PARSE_ERROR_INVALID_SIGNATURE", "invalid-signature":
+		return GVariantParseErrorInvalidSignatureValue, true
+	case "G_VARIANT_PARSE_ERROR_INVALID_TYPE_STRING", "invalid-type-string":
+		return GVariantParseErrorInvalidTypeStringValue, true
+	case "G_VARIANT_PARSE_ERROR_NO_COMMON_TYPE", "no-common-type":
+		return GVariantParseErrorNoCommonTypeValue, true
+	case "G_VARIANT_PARSE_ERROR_NUMBER_OUT_OF_RANGE", "number-out-of-range":
+		return GVariantParseErrorNumberOutOfRangeValue, true
+	case "G_VARIANT_PARSE_ERROR_NUMBER_TOO_BIG", "number-too-big":
+		return GVariantParseErrorNumberTooBigValue, true
+	case "G_VARIANT_PARSE_ERROR_TYPE_ERROR", "type-error":
+		return GVariantParseErrorTypeErrorValue, true
+	case "G_VARIANT_PARSE_ERROR_UNEXPECTED_TOKEN", "unexpected-token":
+		return GVariantParseErrorUnexpectedTokenValue, true
+	case "G_VARIANT_PARSE_ERROR_UNKNOWN_KEYWORD", "unknown-keyword":
+		return GVariantParseErrorUnknownKeywordValue, true
+	case "G_VARIANT_PARSE_ERROR_UNTERMINATED_STRING_CONSTANT", "unterminated-string-constant":
+		return GVariantParseErrorUnterminatedStringConstantValue, true
+	case "G_VARIANT_PARSE_ERROR_VALUE_EXPECTED", "value-expected":
+		return GVariantParseErrorValueExpectedValue, true
+	case "G_VARIANT_PARSE_ERROR_RECURSION", "recursion":
+		return GVariantParseErrorRecursionValue, true
+	}
+	return 0, false
+}
+
 var xVariantIsObjectPath func(string) bool
 
 // Determines if a given string is a valid D-Bus object path.  You
 // should ensure that a string is a valid D-Bus object path before
-// passing it to g_variant_new_object_path().
+// passing it to [NewVariantObjectPath].
 //
 // A valid object path starts with `/` followed by zero or more
 // sequences of characters separated by `/` characters.  Each sequence
@@ -2743,9 +3023,9 @@ var xVariantIsSignature func(string) bool
 
 // Determines if a given string is a valid D-Bus type signature.  You
 // should ensure that a string is a valid D-Bus type signature before
-// passing it to g_variant_new_signature().
+// passing it to [NewVariantSignature].
 //
-// D-Bus type signatures consist of zero or more definite #GVariantType
+// D-Bus type signatures consist of zero or more definite [VariantType]
 // strings in sequence.
 func VariantIsSignature(StringVar string) bool {
 
@@ -2756,41 +3036,41 @@ func VariantIsSignature(StringVar string) bool {
 
 var xVariantParse func(*VariantType, string, uintptr, uintptr, **Error) *Variant
 
-// Parses a #GVariant from a text representation.
+// Parses a [Variant] from a text representation.
 //
-// A single #GVariant is parsed from the content of @text.
+// A single [Variant] is parsed from the content of text.
 //
-// The format is described [here](gvariant-text-format.html).
+// The format is described here.
 //
-// The memory at @limit will never be accessed and the parser behaves as
-// if the character at @limit is the nul terminator.  This has the
-// effect of bounding @text.
+// The memory at limit will never be accessed and the parser behaves as
+// if the character at limit is the nul terminator.  This has the
+// effect of bounding text.
 //
-// If @endptr is non-%NULL then @text is permitted to contain data
-// following the value that this function parses and @endptr will be
+// If endptr is non-nil then text is permitted to contain data
+// following the value that this function parses and endptr will be
 // updated to point to the first character past the end of the text
-// parsed by this function.  If @endptr is %NULL and there is extra data
+// parsed by this function.  If endptr is nil and there is extra data
 // then an error is returned.
 //
-// If @type is non-%NULL then the value will be parsed to have that
+// If type is non-nil then the value will be parsed to have that
 // type.  This may result in additional parse errors (in the case that
 // the parsed value doesn't fit the type) but may also result in fewer
 // errors (in the case that the type would have been ambiguous, such as
 // with empty arrays).
 //
-// In the event that the parsing is successful, the resulting #GVariant
+// In the event that the parsing is successful, the resulting [Variant]
 // is returned. It is never floating, and must be freed with
-// [method@GLib.Variant.unref].
+// [Variant.Unref].
 //
-// In case of any error, %NULL will be returned.  If @error is non-%NULL
+// In case of any error, nil will be returned.  If error is non-nil
 // then it will be set to reflect the error that occurred.
 //
 // Officially, the language understood by the parser is “any string
-// produced by [method@GLib.Variant.print]”. This explicitly includes
-// `g_variant_print()`’s annotated types like `int64 -1000`.
+// produced by [Variant.Print]”. This explicitly includes
+// `[Variant.Print]`’s annotated types like `int64 -1000`.
 //
 // There may be implementation specific restrictions on deeply nested values,
-// which would result in a %G_VARIANT_PARSE_ERROR_RECURSION error. #GVariant is
+// which would result in a [GVariantParseErrorRecursionValue] error. [Variant] is
 // guaranteed to handle nesting up to at least 64 levels.
 func VariantParse(TypeVar *VariantType, TextVar string, LimitVar *string, EndptrVar *string) (*Variant, error) {
 	var cerr *Error
@@ -2804,7 +3084,7 @@ func VariantParse(TypeVar *VariantType, TextVar string, LimitVar *string, Endptr
 	cret := xVariantParse(TypeVar, TextVar, LimitVarPtr, EndptrVarPtr, &cerr)
 
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -2812,7 +3092,7 @@ func VariantParse(TypeVar *VariantType, TextVar string, LimitVar *string, Endptr
 
 var xVariantParseErrorPrintContext func(*Error, string) string
 
-// Pretty-prints a message showing the context of a #GVariant parse
+// Pretty-prints a message showing the context of a [Variant] parse
 // error within the string for which parsing was attempted.
 //
 // The resulting string is suitable for output to the console or other
@@ -2820,30 +3100,22 @@ var xVariantParseErrorPrintContext func(*Error, string) string
 //
 // The message will typically look something like one of the following:
 //
-// |[
-// unterminated string constant:
-//
-//	(1, 2, 3, 'abc
-//	          ^^^^
-//
-// ]|
+//	unterminated string constant:
+//	  (1, 2, 3, 'abc
+//	            ^^^^
 //
 // or
 //
-// |[
-// unable to find a common type:
-//
-//	[1, 2, 3, 'str']
-//	 ^        ^^^^^
-//
-// ]|
+//	unable to find a common type:
+//	  [1, 2, 3, 'str']
+//	   ^        ^^^^^
 //
 // The format of the message may change in a future version.
 //
-// @error must have come from a failed attempt to g_variant_parse() and
-// @source_str must be exactly the same string that caused the error.
-// If @source_str was not nul-terminated when you passed it to
-// g_variant_parse() then you must add nul termination before using this
+// error must have come from a failed attempt to [VariantParse] and
+// source_str must be exactly the same string that caused the error.
+// If source_str was not nul-terminated when you passed it to
+// [VariantParse] then you must add nul termination before using this
 // function.
 func VariantParseErrorPrintContext(ErrorVar *Error, SourceStrVar string) string {
 
@@ -2857,145 +3129,145 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVariantIsObjectPath, libs, "g_variant_is_object_path")
-	core.PuregoSafeRegister(&xVariantIsSignature, libs, "g_variant_is_signature")
-	core.PuregoSafeRegister(&xVariantParse, libs, "g_variant_parse")
-	core.PuregoSafeRegister(&xVariantParseErrorPrintContext, libs, "g_variant_parse_error_print_context")
+	core.PuregoRegisterSince(&xVariantIsObjectPath, libs, "g_variant_is_object_path", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIsSignature, libs, "g_variant_is_signature", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantParse, libs, "g_variant_parse", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantParseErrorPrintContext, libs, "g_variant_parse_error_print_context", "GLIB", "2.40")
 
 	core.PuregoSafeRegister(&xVariantGLibType, libs, "intern")
 
-	core.PuregoSafeRegister(&xNewVariant, libs, "g_variant_new")
-	core.PuregoSafeRegister(&xNewVariantArray, libs, "g_variant_new_array")
-	core.PuregoSafeRegister(&xNewVariantBoolean, libs, "g_variant_new_boolean")
-	core.PuregoSafeRegister(&xNewVariantByte, libs, "g_variant_new_byte")
-	core.PuregoSafeRegister(&xNewVariantBytestring, libs, "g_variant_new_bytestring")
-	core.PuregoSafeRegister(&xNewVariantBytestringArray, libs, "g_variant_new_bytestring_array")
-	core.PuregoSafeRegister(&xNewVariantDictEntry, libs, "g_variant_new_dict_entry")
-	core.PuregoSafeRegister(&xNewVariantDouble, libs, "g_variant_new_double")
-	core.PuregoSafeRegister(&xNewVariantFixedArray, libs, "g_variant_new_fixed_array")
-	core.PuregoSafeRegister(&xNewVariantFromBytes, libs, "g_variant_new_from_bytes")
-	core.PuregoSafeRegister(&xNewVariantFromData, libs, "g_variant_new_from_data")
-	core.PuregoSafeRegister(&xNewVariantHandle, libs, "g_variant_new_handle")
-	core.PuregoSafeRegister(&xNewVariantInt16, libs, "g_variant_new_int16")
-	core.PuregoSafeRegister(&xNewVariantInt32, libs, "g_variant_new_int32")
-	core.PuregoSafeRegister(&xNewVariantInt64, libs, "g_variant_new_int64")
-	core.PuregoSafeRegister(&xNewVariantMaybe, libs, "g_variant_new_maybe")
-	core.PuregoSafeRegister(&xNewVariantObjectPath, libs, "g_variant_new_object_path")
-	core.PuregoSafeRegister(&xNewVariantObjv, libs, "g_variant_new_objv")
-	core.PuregoSafeRegister(&xNewVariantParsed, libs, "g_variant_new_parsed")
-	core.PuregoSafeRegister(&xNewVariantParsedVa, libs, "g_variant_new_parsed_va")
-	core.PuregoSafeRegister(&xNewVariantPrintf, libs, "g_variant_new_printf")
-	core.PuregoSafeRegister(&xNewVariantSignature, libs, "g_variant_new_signature")
-	core.PuregoSafeRegister(&xNewVariantString, libs, "g_variant_new_string")
-	core.PuregoSafeRegister(&xNewVariantStrv, libs, "g_variant_new_strv")
-	core.PuregoSafeRegister(&xNewVariantTakeString, libs, "g_variant_new_take_string")
-	core.PuregoSafeRegister(&xNewVariantTuple, libs, "g_variant_new_tuple")
-	core.PuregoSafeRegister(&xNewVariantUint16, libs, "g_variant_new_uint16")
-	core.PuregoSafeRegister(&xNewVariantUint32, libs, "g_variant_new_uint32")
-	core.PuregoSafeRegister(&xNewVariantUint64, libs, "g_variant_new_uint64")
-	core.PuregoSafeRegister(&xNewVariantVa, libs, "g_variant_new_va")
-	core.PuregoSafeRegister(&xNewVariantVariant, libs, "g_variant_new_variant")
-
-	core.PuregoSafeRegister(&xVariantByteswap, libs, "g_variant_byteswap")
-	core.PuregoSafeRegister(&xVariantCheckFormatString, libs, "g_variant_check_format_string")
-	core.PuregoSafeRegister(&xVariantClassify, libs, "g_variant_classify")
-	core.PuregoSafeRegister(&xVariantCompare, libs, "g_variant_compare")
-	core.PuregoSafeRegister(&xVariantDupBytestring, libs, "g_variant_dup_bytestring")
-	core.PuregoSafeRegister(&xVariantDupBytestringArray, libs, "g_variant_dup_bytestring_array")
-	core.PuregoSafeRegister(&xVariantDupObjv, libs, "g_variant_dup_objv")
-	core.PuregoSafeRegister(&xVariantDupString, libs, "g_variant_dup_string")
-	core.PuregoSafeRegister(&xVariantDupStrv, libs, "g_variant_dup_strv")
-	core.PuregoSafeRegister(&xVariantEqual, libs, "g_variant_equal")
-	core.PuregoSafeRegister(&xVariantGet, libs, "g_variant_get")
-	core.PuregoSafeRegister(&xVariantGetBoolean, libs, "g_variant_get_boolean")
-	core.PuregoSafeRegister(&xVariantGetByte, libs, "g_variant_get_byte")
-	core.PuregoSafeRegister(&xVariantGetBytestring, libs, "g_variant_get_bytestring")
-	core.PuregoSafeRegister(&xVariantGetBytestringArray, libs, "g_variant_get_bytestring_array")
-	core.PuregoSafeRegister(&xVariantGetChild, libs, "g_variant_get_child")
-	core.PuregoSafeRegister(&xVariantGetChildValue, libs, "g_variant_get_child_value")
-	core.PuregoSafeRegister(&xVariantGetData, libs, "g_variant_get_data")
-	core.PuregoSafeRegister(&xVariantGetDataAsBytes, libs, "g_variant_get_data_as_bytes")
-	core.PuregoSafeRegister(&xVariantGetDouble, libs, "g_variant_get_double")
-	core.PuregoSafeRegister(&xVariantGetFixedArray, libs, "g_variant_get_fixed_array")
-	core.PuregoSafeRegister(&xVariantGetHandle, libs, "g_variant_get_handle")
-	core.PuregoSafeRegister(&xVariantGetInt16, libs, "g_variant_get_int16")
-	core.PuregoSafeRegister(&xVariantGetInt32, libs, "g_variant_get_int32")
-	core.PuregoSafeRegister(&xVariantGetInt64, libs, "g_variant_get_int64")
-	core.PuregoSafeRegister(&xVariantGetMaybe, libs, "g_variant_get_maybe")
-	core.PuregoSafeRegister(&xVariantGetNormalForm, libs, "g_variant_get_normal_form")
-	core.PuregoSafeRegister(&xVariantGetObjv, libs, "g_variant_get_objv")
-	core.PuregoSafeRegister(&xVariantGetSize, libs, "g_variant_get_size")
-	core.PuregoSafeRegister(&xVariantGetString, libs, "g_variant_get_string")
-	core.PuregoSafeRegister(&xVariantGetStrv, libs, "g_variant_get_strv")
-	core.PuregoSafeRegister(&xVariantGetType, libs, "g_variant_get_type")
-	core.PuregoSafeRegister(&xVariantGetTypeString, libs, "g_variant_get_type_string")
-	core.PuregoSafeRegister(&xVariantGetUint16, libs, "g_variant_get_uint16")
-	core.PuregoSafeRegister(&xVariantGetUint32, libs, "g_variant_get_uint32")
-	core.PuregoSafeRegister(&xVariantGetUint64, libs, "g_variant_get_uint64")
-	core.PuregoSafeRegister(&xVariantGetVa, libs, "g_variant_get_va")
-	core.PuregoSafeRegister(&xVariantGetVariant, libs, "g_variant_get_variant")
-	core.PuregoSafeRegister(&xVariantHash, libs, "g_variant_hash")
-	core.PuregoSafeRegister(&xVariantIsContainer, libs, "g_variant_is_container")
-	core.PuregoSafeRegister(&xVariantIsFloating, libs, "g_variant_is_floating")
-	core.PuregoSafeRegister(&xVariantIsNormalForm, libs, "g_variant_is_normal_form")
-	core.PuregoSafeRegister(&xVariantIsOfType, libs, "g_variant_is_of_type")
-	core.PuregoSafeRegister(&xVariantIterNew, libs, "g_variant_iter_new")
-	core.PuregoSafeRegister(&xVariantLookup, libs, "g_variant_lookup")
-	core.PuregoSafeRegister(&xVariantLookupValue, libs, "g_variant_lookup_value")
-	core.PuregoSafeRegister(&xVariantNChildren, libs, "g_variant_n_children")
-	core.PuregoSafeRegister(&xVariantPrint, libs, "g_variant_print")
-	core.PuregoSafeRegister(&xVariantPrintString, libs, "g_variant_print_string")
-	core.PuregoSafeRegister(&xVariantRef, libs, "g_variant_ref")
-	core.PuregoSafeRegister(&xVariantRefSink, libs, "g_variant_ref_sink")
-	core.PuregoSafeRegister(&xVariantStore, libs, "g_variant_store")
-	core.PuregoSafeRegister(&xVariantTakeRef, libs, "g_variant_take_ref")
-	core.PuregoSafeRegister(&xVariantUnref, libs, "g_variant_unref")
+	core.PuregoRegisterSince(&xNewVariant, libs, "g_variant_new", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantArray, libs, "g_variant_new_array", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantBoolean, libs, "g_variant_new_boolean", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantByte, libs, "g_variant_new_byte", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantBytestring, libs, "g_variant_new_bytestring", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xNewVariantBytestringArray, libs, "g_variant_new_bytestring_array", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xNewVariantDictEntry, libs, "g_variant_new_dict_entry", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantDouble, libs, "g_variant_new_double", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantFixedArray, libs, "g_variant_new_fixed_array", "GLIB", "2.32")
+	core.PuregoRegisterSince(&xNewVariantFromBytes, libs, "g_variant_new_from_bytes", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xNewVariantFromData, libs, "g_variant_new_from_data", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantHandle, libs, "g_variant_new_handle", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantInt16, libs, "g_variant_new_int16", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantInt32, libs, "g_variant_new_int32", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantInt64, libs, "g_variant_new_int64", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantMaybe, libs, "g_variant_new_maybe", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantObjectPath, libs, "g_variant_new_object_path", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantObjv, libs, "g_variant_new_objv", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xNewVariantParsed, libs, "g_variant_new_parsed", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantParsedVa, libs, "g_variant_new_parsed_va", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantPrintf, libs, "g_variant_new_printf", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xNewVariantSignature, libs, "g_variant_new_signature", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantString, libs, "g_variant_new_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantStrv, libs, "g_variant_new_strv", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantTakeString, libs, "g_variant_new_take_string", "GLIB", "2.38")
+	core.PuregoRegisterSince(&xNewVariantTuple, libs, "g_variant_new_tuple", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantUint16, libs, "g_variant_new_uint16", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantUint32, libs, "g_variant_new_uint32", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantUint64, libs, "g_variant_new_uint64", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantVa, libs, "g_variant_new_va", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantVariant, libs, "g_variant_new_variant", "GLIB", "2.24")
+
+	core.PuregoRegisterSince(&xVariantByteswap, libs, "g_variant_byteswap", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantCheckFormatString, libs, "g_variant_check_format_string", "GLIB", "2.34")
+	core.PuregoRegisterSince(&xVariantClassify, libs, "g_variant_classify", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantCompare, libs, "g_variant_compare", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantDupBytestring, libs, "g_variant_dup_bytestring", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantDupBytestringArray, libs, "g_variant_dup_bytestring_array", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantDupObjv, libs, "g_variant_dup_objv", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xVariantDupString, libs, "g_variant_dup_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantDupStrv, libs, "g_variant_dup_strv", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantEqual, libs, "g_variant_equal", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGet, libs, "g_variant_get", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetBoolean, libs, "g_variant_get_boolean", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetByte, libs, "g_variant_get_byte", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetBytestring, libs, "g_variant_get_bytestring", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantGetBytestringArray, libs, "g_variant_get_bytestring_array", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantGetChild, libs, "g_variant_get_child", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetChildValue, libs, "g_variant_get_child_value", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetData, libs, "g_variant_get_data", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetDataAsBytes, libs, "g_variant_get_data_as_bytes", "GLIB", "2.36")
+	core.PuregoRegisterSince(&xVariantGetDouble, libs, "g_variant_get_double", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetFixedArray, libs, "g_variant_get_fixed_array", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetHandle, libs, "g_variant_get_handle", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetInt16, libs, "g_variant_get_int16", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetInt32, libs, "g_variant_get_int32", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetInt64, libs, "g_variant_get_int64", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetMaybe, libs, "g_variant_get_maybe", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetNormalForm, libs, "g_variant_get_normal_form", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetObjv, libs, "g_variant_get_objv", "GLIB", "2.30")
+	core.PuregoRegisterSince(&xVariantGetSize, libs, "g_variant_get_size", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetString, libs, "g_variant_get_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetStrv, libs, "g_variant_get_strv", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetType, libs, "g_variant_get_type", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetTypeString, libs, "g_variant_get_type_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetUint16, libs, "g_variant_get_uint16", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetUint32, libs, "g_variant_get_uint32", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetUint64, libs, "g_variant_get_uint64", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetVa, libs, "g_variant_get_va", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantGetVariant, libs, "g_variant_get_variant", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantHash, libs, "g_variant_hash", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIsContainer, libs, "g_variant_is_container", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIsFloating, libs, "g_variant_is_floating", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantIsNormalForm, libs, "g_variant_is_normal_form", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIsOfType, libs, "g_variant_is_of_type", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterNew, libs, "g_variant_iter_new", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantLookup, libs, "g_variant_lookup", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xVariantLookupValue, libs, "g_variant_lookup_value", "GLIB", "2.28")
+	core.PuregoRegisterSince(&xVariantNChildren, libs, "g_variant_n_children", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantPrint, libs, "g_variant_print", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantPrintString, libs, "g_variant_print_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantRef, libs, "g_variant_ref", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantRefSink, libs, "g_variant_ref_sink", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantStore, libs, "g_variant_store", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTakeRef, libs, "g_variant_take_ref", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantUnref, libs, "g_variant_unref", "GLIB", "2.24")
 
 	core.PuregoSafeRegister(&xVariantBuilderGLibType, libs, "g_variant_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewVariantBuilder, libs, "g_variant_builder_new")
+	core.PuregoRegisterSince(&xNewVariantBuilder, libs, "g_variant_builder_new", "GLIB", "2.24")
 
-	core.PuregoSafeRegister(&xVariantBuilderAdd, libs, "g_variant_builder_add")
-	core.PuregoSafeRegister(&xVariantBuilderAddParsed, libs, "g_variant_builder_add_parsed")
-	core.PuregoSafeRegister(&xVariantBuilderAddValue, libs, "g_variant_builder_add_value")
-	core.PuregoSafeRegister(&xVariantBuilderClear, libs, "g_variant_builder_clear")
-	core.PuregoSafeRegister(&xVariantBuilderClose, libs, "g_variant_builder_close")
-	core.PuregoSafeRegister(&xVariantBuilderEnd, libs, "g_variant_builder_end")
-	core.PuregoSafeRegister(&xVariantBuilderInit, libs, "g_variant_builder_init")
-	core.PuregoSafeRegister(&xVariantBuilderInitStatic, libs, "g_variant_builder_init_static")
-	core.PuregoSafeRegister(&xVariantBuilderOpen, libs, "g_variant_builder_open")
-	core.PuregoSafeRegister(&xVariantBuilderRef, libs, "g_variant_builder_ref")
-	core.PuregoSafeRegister(&xVariantBuilderUnref, libs, "g_variant_builder_unref")
+	core.PuregoRegisterSince(&xVariantBuilderAdd, libs, "g_variant_builder_add", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderAddParsed, libs, "g_variant_builder_add_parsed", "GLIB", "2.26")
+	core.PuregoRegisterSince(&xVariantBuilderAddValue, libs, "g_variant_builder_add_value", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderClear, libs, "g_variant_builder_clear", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderClose, libs, "g_variant_builder_close", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderEnd, libs, "g_variant_builder_end", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderInit, libs, "g_variant_builder_init", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderInitStatic, libs, "g_variant_builder_init_static", "GLIB", "2.84")
+	core.PuregoRegisterSince(&xVariantBuilderOpen, libs, "g_variant_builder_open", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderRef, libs, "g_variant_builder_ref", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantBuilderUnref, libs, "g_variant_builder_unref", "GLIB", "2.24")
 
 	core.PuregoSafeRegister(&xVariantDictGLibType, libs, "g_variant_dict_get_type")
 
-	core.PuregoSafeRegister(&xNewVariantDict, libs, "g_variant_dict_new")
-
-	core.PuregoSafeRegister(&xVariantDictClear, libs, "g_variant_dict_clear")
-	core.PuregoSafeRegister(&xVariantDictContains, libs, "g_variant_dict_contains")
-	core.PuregoSafeRegister(&xVariantDictEnd, libs, "g_variant_dict_end")
-	core.PuregoSafeRegister(&xVariantDictInit, libs, "g_variant_dict_init")
-	core.PuregoSafeRegister(&xVariantDictInsert, libs, "g_variant_dict_insert")
-	core.PuregoSafeRegister(&xVariantDictInsertValue, libs, "g_variant_dict_insert_value")
-	core.PuregoSafeRegister(&xVariantDictLookup, libs, "g_variant_dict_lookup")
-	core.PuregoSafeRegister(&xVariantDictLookupValue, libs, "g_variant_dict_lookup_value")
-	core.PuregoSafeRegister(&xVariantDictRef, libs, "g_variant_dict_ref")
-	core.PuregoSafeRegister(&xVariantDictRemove, libs, "g_variant_dict_remove")
-	core.PuregoSafeRegister(&xVariantDictUnref, libs, "g_variant_dict_unref")
-
-	core.PuregoSafeRegister(&xVariantIterCopy, libs, "g_variant_iter_copy")
-	core.PuregoSafeRegister(&xVariantIterFree, libs, "g_variant_iter_free")
-	core.PuregoSafeRegister(&xVariantIterInit, libs, "g_variant_iter_init")
-	core.PuregoSafeRegister(&xVariantIterLoop, libs, "g_variant_iter_loop")
-	core.PuregoSafeRegister(&xVariantIterNChildren, libs, "g_variant_iter_n_children")
-	core.PuregoSafeRegister(&xVariantIterNext, libs, "g_variant_iter_next")
-	core.PuregoSafeRegister(&xVariantIterNextValue, libs, "g_variant_iter_next_value")
+	core.PuregoRegisterSince(&xNewVariantDict, libs, "g_variant_dict_new", "GLIB", "2.40")
+
+	core.PuregoRegisterSince(&xVariantDictClear, libs, "g_variant_dict_clear", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictContains, libs, "g_variant_dict_contains", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictEnd, libs, "g_variant_dict_end", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictInit, libs, "g_variant_dict_init", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictInsert, libs, "g_variant_dict_insert", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictInsertValue, libs, "g_variant_dict_insert_value", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictLookup, libs, "g_variant_dict_lookup", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictLookupValue, libs, "g_variant_dict_lookup_value", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictRef, libs, "g_variant_dict_ref", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictRemove, libs, "g_variant_dict_remove", "GLIB", "2.40")
+	core.PuregoRegisterSince(&xVariantDictUnref, libs, "g_variant_dict_unref", "GLIB", "2.40")
+
+	core.PuregoRegisterSince(&xVariantIterCopy, libs, "g_variant_iter_copy", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterFree, libs, "g_variant_iter_free", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterInit, libs, "g_variant_iter_init", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterLoop, libs, "g_variant_iter_loop", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterNChildren, libs, "g_variant_iter_n_children", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterNext, libs, "g_variant_iter_next", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantIterNextValue, libs, "g_variant_iter_next_value", "GLIB", "2.24")
 
 }
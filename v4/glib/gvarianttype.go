@@ -5,43 +5,42 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A type in the [type@GLib.Variant] type system.
+// A type in the [Variant] type system.
 //
-// [type@GLib.Variant] types are represented as strings, but have a strict
-// syntax described below. All [type@GLib.VariantType]s passed to GLib must be
+// [Variant] types are represented as strings, but have a strict
+// syntax described below. All [VariantType]s passed to GLib must be
 // valid, and they are typically expected to be static (i.e. not provided by
-// user input) as they determine how binary [type@GLib.Variant] data is
+// user input) as they determine how binary [Variant] data is
 // interpreted.
 //
-// To convert a static string to a [type@GLib.VariantType] in C, use the
-// [func@GLib.VARIANT_TYPE] casting macro. When GLib is compiled with checks
+// To convert a static string to a [VariantType] in C, use the
+// VARIANT_TYPE casting macro. When GLib is compiled with checks
 // enabled, it will validate the type. To check if an arbitrary string is a
-// valid [type@GLib.VariantType], use [func@GLib.VariantType.string_is_valid].
+// valid [VariantType], use [VariantTypeStringIsValid].
 //
-// ## GVariant Type System
+// # GVariant Type System
 //
-// This section introduces the [type@GLib.Variant] type system. It is based, in
+// This section introduces the [Variant] type system. It is based, in
 // large part, on the D-Bus type system, with two major changes and
 // some minor lifting of restrictions. The
-// [D-Bus specification](http://dbus.freedesktop.org/doc/dbus-specification.html),
+// [D-Bus specification],
 // therefore, provides a significant amount of
-// information that is useful when working with [type@GLib.Variant].
+// information that is useful when working with [Variant].
 //
 // The first major change with respect to the D-Bus type system is the
-// introduction of maybe (or ‘nullable’) types.  Any type in [type@GLib.Variant]
+// introduction of maybe (or ‘nullable’) types.  Any type in [Variant]
 // can be converted to a maybe type, in which case, `nothing` (or `null`)
 // becomes a valid value.  Maybe types have been added by introducing the
 // character `m` to type strings.
 //
-// The second major change is that the [type@GLib.Variant] type system supports
+// The second major change is that the [Variant] type system supports
 // the concept of ‘indefinite types’ — types that are less specific than
 // the normal types found in D-Bus.  For example, it is possible to speak
-// of ‘an array of any type’ in [type@GLib.Variant], where the D-Bus type system
+// of ‘an array of any type’ in [Variant], where the D-Bus type system
 // would require you to speak of ‘an array of integers’ or ‘an array of
 // strings’.  Indefinite types have been added by introducing the
 // characters `*`, `?` and `r` to type strings.
@@ -50,24 +49,24 @@ import (
 // types are lifted along with the restriction that dictionary entries
 // may only appear nested inside of arrays.
 //
-// Just as in D-Bus, [type@GLib.Variant] types are described with strings (‘type
+// Just as in D-Bus, [Variant] types are described with strings (‘type
 // strings’).  Subject to the differences mentioned above, these strings
 // are of the same form as those found in D-Bus.  Note, however: D-Bus
 // always works in terms of messages and therefore individual type
 // strings appear nowhere in its interface.  Instead, ‘signatures’
 // are a concatenation of the strings of the type of each argument in a
-// message.  [type@GLib.Variant] deals with single values directly so
-// [type@GLib.Variant] type strings always describe the type of exactly one
+// message.  [Variant] deals with single values directly so
+// [Variant] type strings always describe the type of exactly one
 // value.  This means that a D-Bus signature string is generally not a valid
-// [type@GLib.Variant] type string — except in the case that it is the signature
+// [Variant] type string — except in the case that it is the signature
 // of a message containing exactly one argument.
 //
 // An indefinite type is similar in spirit to what may be called an
 // abstract type in other type systems.  No value can exist that has an
 // indefinite type as its type, but values can exist that have types
 // that are subtypes of indefinite types.  That is to say,
-// [method@GLib.Variant.get_type] will never return an indefinite type, but
-// calling [method@GLib.Variant.is_of_type] with an indefinite type may return
+// [Variant.GetType] will never return an indefinite type, but
+// calling [Variant.IsOfType] with an indefinite type may return
 // true.  For example, you cannot have a value that represents ‘an
 // array of no particular type’, but you can have an ‘array of integers’
 // which certainly matches the type of ‘an array of no particular type’,
@@ -77,18 +76,18 @@ import (
 // This is similar to how instances of abstract classes may not
 // directly exist in other type systems, but instances of their
 // non-abstract subtypes may.  For example, in GTK, no object that has
-// the type of [`GtkWidget`](https://docs.gtk.org/gtk4/class.Widget.html) can
-// exist (since `GtkWidget` is an abstract class), but a [`GtkWindow`](https://docs.gtk.org/gtk4/class.Window.html)
+// the type of [GtkWidget] can
+// exist (since `GtkWidget` is an abstract class), but a [GtkWindow]
 // can certainly be instantiated, and you would say that a `GtkWindow` is a
 // `GtkWidget` (since `GtkWindow` is a subclass of `GtkWidget`).
 //
-// Two types may not be compared by value; use [method@GLib.VariantType.equal]
-// or [method@GLib.VariantType.is_subtype_of]  May be copied using
-// [method@GLib.VariantType.copy] and freed using [method@GLib.VariantType.free].
+// Two types may not be compared by value; use [VariantType.Equal]
+// or [VariantType.IsSubtypeOf]  May be copied using
+// [VariantType.Copy] and freed using [VariantType.Free].
 //
-// ## GVariant Type Strings
+// # GVariant Type Strings
 //
-// A [type@GLib.Variant] type string can be any of the following:
+// A [Variant] type string can be any of the following:
 //
 //   - any basic type string (listed below)
 //   - `v`, `r` or `*`
@@ -99,16 +98,16 @@ import (
 //     followed by another type string, followed by the character `}`
 //
 // A basic type string describes a basic type (as per
-// [method@GLib.VariantType.is_basic]) and is always a single character in
+// [VariantType.IsBasic]) and is always a single character in
 // length. The valid basic type strings are `b`, `y`, `n`, `q`, `i`, `u`, `x`,
 // `t`, `h`, `d`, `s`, `o`, `g` and `?`.
 //
 // The above definition is recursive to arbitrary depth. `aaaaai` and
 // `(ui(nq((y)))s)` are both valid type strings, as is
 // `a(aa(ui)(qna{ya(yd)}))`. In order to not hit memory limits,
-// [type@GLib.Variant] imposes a limit on recursion depth of 65 nested
+// [Variant] imposes a limit on recursion depth of 65 nested
 // containers. This is the limit in the D-Bus specification (64) plus one to
-// allow a [`GDBusMessage`](../gio/class.DBusMessage.html) to be nested in
+// allow a GDBusMessage to be nested in
 // a top-level tuple.
 //
 // The meaning of each of the characters is as follows:
@@ -194,6 +193,10 @@ import (
 // so this type string corresponds to `G_VARIANT_TYPE_DICTIONARY`. Note
 // that, due to the restriction that the key of a dictionary entry must
 // be a basic type, `{**}` is not a valid type string.
+//
+// [D-Bus specification]: http://dbus.freedesktop.org/doc/dbus-specification.html
+// [GtkWidget]: https://docs.gtk.org/gtk4/class.Widget.html
+// [GtkWindow]: https://docs.gtk.org/gtk4/class.Window.html
 type VariantType struct {
 	_ structs.HostLayout
 }
@@ -210,13 +213,13 @@ func (x *VariantType) GoPointer() uintptr {
 
 var xNewVariantType func(string) *VariantType
 
-// Creates a new [type@GLib.VariantType] corresponding to the type string given
-// by @type_string.
+// Creates a new [VariantType] corresponding to the type string given
+// by type_string.
 //
-// It is appropriate to call [method@GLib.VariantType.free] on the return value.
+// It is appropriate to call [VariantType.Free] on the return value.
 //
 // It is a programmer error to call this function with an invalid type
-// string.  Use [func@GLib.VariantType.string_is_valid] if you are unsure.
+// string.  Use [VariantTypeStringIsValid] if you are unsure.
 func NewVariantType(TypeStringVar string) *VariantType {
 
 	cret := xNewVariantType(TypeStringVar)
@@ -226,9 +229,9 @@ func NewVariantType(TypeStringVar string) *VariantType {
 var xNewVariantTypeArray func(*VariantType) *VariantType
 
 // Constructs the type corresponding to an array of elements of the
-// type @type.
+// type type.
 //
-// It is appropriate to call [method@GLib.VariantType.first] on the return value.
+// It is appropriate to call [VariantType.First] on the return value.
 func NewVariantTypeArray(ElementVar *VariantType) *VariantType {
 
 	cret := xNewVariantTypeArray(ElementVar)
@@ -238,9 +241,9 @@ func NewVariantTypeArray(ElementVar *VariantType) *VariantType {
 var xNewVariantTypeDictEntry func(*VariantType, *VariantType) *VariantType
 
 // Constructs the type corresponding to a dictionary entry with a key
-// of type @key and a value of type @value.
+// of type key and a value of type value.
 //
-// It is appropriate to call [method@GLib.VariantType.free] on the return value.
+// It is appropriate to call [VariantType.Free] on the return value.
 func NewVariantTypeDictEntry(KeyVar *VariantType, ValueVar *VariantType) *VariantType {
 
 	cret := xNewVariantTypeDictEntry(KeyVar, ValueVar)
@@ -250,9 +253,9 @@ func NewVariantTypeDictEntry(KeyVar *VariantType, ValueVar *VariantType) *Varian
 var xNewVariantTypeMaybe func(*VariantType) *VariantType
 
 // Constructs the type corresponding to a ‘maybe’ instance containing
-// type @type or `Nothing`.
+// type type or `Nothing`.
 //
-// It is appropriate to call [method@GLib.VariantType.free] on the return value.
+// It is appropriate to call [VariantType.Free] on the return value.
 func NewVariantTypeMaybe(ElementVar *VariantType) *VariantType {
 
 	cret := xNewVariantTypeMaybe(ElementVar)
@@ -261,12 +264,12 @@ func NewVariantTypeMaybe(ElementVar *VariantType) *VariantType {
 
 var xNewVariantTypeTuple func(uintptr, int) *VariantType
 
-// Constructs a new tuple type, from @items.
+// Constructs a new tuple type, from items.
 //
-// @length is the number of items in @items, or `-1` to indicate that
-// @items is `NULL`-terminated.
+// length is the number of items in items, or `-1` to indicate that
+// items is `NULL`-terminated.
 //
-// It is appropriate to call [method@GLib.VariantType.free] on the return value.
+// It is appropriate to call [VariantType.Free] on the return value.
 func NewVariantTypeTuple(ItemsVar uintptr, LengthVar int) *VariantType {
 
 	cret := xNewVariantTypeTuple(ItemsVar, LengthVar)
@@ -275,10 +278,10 @@ func NewVariantTypeTuple(ItemsVar uintptr, LengthVar int) *VariantType {
 
 var xVariantTypeCopy func(uintptr) *VariantType
 
-// Makes a copy of a [type@GLib.VariantType].
+// Makes a copy of a [VariantType].
 //
-// It is appropriate to call [method@GLib.VariantType.free] on the return value.
-// @type may not be `NULL`.
+// It is appropriate to call [VariantType.Free] on the return value.
+// type may not be `NULL`.
 func (x *VariantType) Copy() *VariantType {
 
 	cret := xVariantTypeCopy(x.GoPointer())
@@ -287,10 +290,10 @@ func (x *VariantType) Copy() *VariantType {
 
 var xVariantTypeDupString func(uintptr) string
 
-// Returns a newly-allocated copy of the type string corresponding to @type.
+// Returns a newly-allocated copy of the type string corresponding to type.
 //
 // The returned string is nul-terminated.  It is appropriate to call
-// [func@GLib.free] on the return value.
+// [Free] on the return value.
 func (x *VariantType) DupString() string {
 
 	cret := xVariantTypeDupString(x.GoPointer())
@@ -310,16 +313,16 @@ func (x *VariantType) Element() *VariantType {
 
 var xVariantTypeEqual func(uintptr, uintptr) bool
 
-// Compares @type1 and @type2 for equality.
+// Compares type1 and type2 for equality.
 //
 // Only returns true if the types are exactly equal.  Even if one type
 // is an indefinite type and the other is a subtype of it, false will
 // be returned if they are not exactly equal.  If you want to check for
-// subtypes, use [method@GLib.VariantType.is_subtype_of].
+// subtypes, use [VariantType.IsSubtypeOf].
 //
-// The argument types of @type1 and @type2 are only `gconstpointer` to
-// allow use with [type@GLib.HashTable] without function pointer casting.  For
-// both arguments, a valid [type@GLib.VariantType] must be provided.
+// The argument types of type1 and type2 are only `gconstpointer` to
+// allow use with [HashTable] without function pointer casting.  For
+// both arguments, a valid [VariantType] must be provided.
 func (x *VariantType) Equal(Type2Var uintptr) bool {
 
 	cret := xVariantTypeEqual(x.GoPointer(), Type2Var)
@@ -338,9 +341,9 @@ var xVariantTypeFirst func(uintptr) *VariantType
 // In the case of a dictionary entry type, this returns the type of
 // the key.
 //
-// `NULL` is returned in case of @type being `G_VARIANT_TYPE_UNIT`.
+// `NULL` is returned in case of type being `G_VARIANT_TYPE_UNIT`.
 //
-// This call, together with [method@GLib.VariantType.next] provides an iterator
+// This call, together with [VariantType.Next] provides an iterator
 // interface over tuple and dictionary entry types.
 func (x *VariantType) First() *VariantType {
 
@@ -350,11 +353,11 @@ func (x *VariantType) First() *VariantType {
 
 var xVariantTypeFree func(uintptr)
 
-// Frees a [type@GLib.VariantType] that was allocated with
-// [method@GLib.VariantType.copy], [ctor@GLib.VariantType.new] or one of the
+// Frees a [VariantType] that was allocated with
+// [VariantType.Copy], [NewVariantType] or one of the
 // container type constructor functions.
 //
-// In the case that @type is `NULL`, this function does nothing.
+// In the case that type is `NULL`, this function does nothing.
 //
 // Since 2.24
 func (x *VariantType) Free() {
@@ -365,10 +368,10 @@ func (x *VariantType) Free() {
 
 var xVariantTypeGetStringLength func(uintptr) uint
 
-// Returns the length of the type string corresponding to the given @type.
+// Returns the length of the type string corresponding to the given type.
 //
 // This function must be used to determine the valid extent of
-// the memory region returned by [method@GLib.VariantType.peek_string].
+// the memory region returned by [VariantType.PeekString].
 func (x *VariantType) GetStringLength() uint {
 
 	cret := xVariantTypeGetStringLength(x.GoPointer())
@@ -377,11 +380,11 @@ func (x *VariantType) GetStringLength() uint {
 
 var xVariantTypeHash func(uintptr) uint
 
-// Hashes @type.
+// Hashes type.
 //
-// The argument type of @type is only `gconstpointer` to allow use with
-// [type@GLib.HashTable] without function pointer casting.  A valid
-// [type@GLib.VariantType] must be provided.
+// The argument type of type is only `gconstpointer` to allow use with
+// [HashTable] without function pointer casting.  A valid
+// [VariantType] must be provided.
 func (x *VariantType) Hash() uint {
 
 	cret := xVariantTypeHash(x.GoPointer())
@@ -390,9 +393,9 @@ func (x *VariantType) Hash() uint {
 
 var xVariantTypeIsArray func(uintptr) bool
 
-// Determines if the given @type is an array type.
+// Determines if the given type is an array type.
 //
-// This is true if the type string for @type starts with an `a`.
+// This is true if the type string for type starts with an `a`.
 //
 // This function returns true for any indefinite type for which every
 // definite subtype is an array type — `G_VARIANT_TYPE_ARRAY`, for
@@ -405,7 +408,7 @@ func (x *VariantType) IsArray() bool {
 
 var xVariantTypeIsBasic func(uintptr) bool
 
-// Determines if the given @type is a basic type.
+// Determines if the given type is a basic type.
 //
 // Basic types are booleans, bytes, integers, doubles, strings, object
 // paths and signatures.
@@ -422,7 +425,7 @@ func (x *VariantType) IsBasic() bool {
 
 var xVariantTypeIsContainer func(uintptr) bool
 
-// Determines if the given @type is a container type.
+// Determines if the given type is a container type.
 //
 // Container types are any array, maybe, tuple, or dictionary
 // entry types plus the variant type.
@@ -438,13 +441,13 @@ func (x *VariantType) IsContainer() bool {
 
 var xVariantTypeIsDefinite func(uintptr) bool
 
-// Determines if the given @type is definite (ie: not indefinite).
+// Determines if the given type is definite (ie: not indefinite).
 //
 // A type is definite if its type string does not contain any indefinite
 // type characters (`*`, `?`, or `r`).
 //
-// A [type@GLib.Variant] instance may not have an indefinite type, so calling
-// this function on the result of [method@GLib.Variant.get_type] will always
+// A [Variant] instance may not have an indefinite type, so calling
+// this function on the result of [Variant.GetType] will always
 // result in true being returned.  Calling this function on an
 // indefinite type like `G_VARIANT_TYPE_ARRAY`, however, will result in
 // `FALSE` being returned.
@@ -456,9 +459,9 @@ func (x *VariantType) IsDefinite() bool {
 
 var xVariantTypeIsDictEntry func(uintptr) bool
 
-// Determines if the given @type is a dictionary entry type.
+// Determines if the given type is a dictionary entry type.
 //
-// This is true if the type string for @type starts with a `{`.
+// This is true if the type string for type starts with a `{`.
 //
 // This function returns true for any indefinite type for which every
 // definite subtype is a dictionary entry type —
@@ -471,9 +474,9 @@ func (x *VariantType) IsDictEntry() bool {
 
 var xVariantTypeIsMaybe func(uintptr) bool
 
-// Determines if the given @type is a ‘maybe’ type.
+// Determines if the given type is a ‘maybe’ type.
 //
-// This is true if the type string for @type starts with an `m`.
+// This is true if the type string for type starts with an `m`.
 //
 // This function returns true for any indefinite type for which every
 // definite subtype is a ‘maybe’ type — `G_VARIANT_TYPE_MAYBE`, for
@@ -486,9 +489,9 @@ func (x *VariantType) IsMaybe() bool {
 
 var xVariantTypeIsSubtypeOf func(uintptr, *VariantType) bool
 
-// Checks if @type is a subtype of @supertype.
+// Checks if type is a subtype of supertype.
 //
-// This function returns true if @type is a subtype of @supertype.  All
+// This function returns true if type is a subtype of supertype.  All
 // types are considered to be subtypes of themselves.  Aside from that,
 // only indefinite types can have subtypes.
 func (x *VariantType) IsSubtypeOf(SupertypeVar *VariantType) bool {
@@ -499,9 +502,9 @@ func (x *VariantType) IsSubtypeOf(SupertypeVar *VariantType) bool {
 
 var xVariantTypeIsTuple func(uintptr) bool
 
-// Determines if the given @type is a tuple type.
+// Determines if the given type is a tuple type.
 //
-// This is true if the type string for @type starts with a `(` or if @type is
+// This is true if the type string for type starts with a `(` or if type is
 // `G_VARIANT_TYPE_TUPLE`.
 //
 // This function returns true for any indefinite type for which every
@@ -515,7 +518,7 @@ func (x *VariantType) IsTuple() bool {
 
 var xVariantTypeIsVariant func(uintptr) bool
 
-// Determines if the given @type is the variant type.
+// Determines if the given type is the variant type.
 func (x *VariantType) IsVariant() bool {
 
 	cret := xVariantTypeIsVariant(x.GoPointer())
@@ -528,7 +531,7 @@ var xVariantTypeKey func(uintptr) *VariantType
 //
 // This function may only be used with a dictionary entry type.  Other
 // than the additional restriction, this call is equivalent to
-// [method@GLib.VariantType.first].
+// [VariantType.First].
 func (x *VariantType) Key() *VariantType {
 
 	cret := xVariantTypeKey(x.GoPointer())
@@ -557,14 +560,14 @@ var xVariantTypeNext func(uintptr) *VariantType
 // Determines the next item type of a tuple or dictionary entry
 // type.
 //
-// @type must be the result of a previous call to
-// [method@GLib.VariantType.first] or [method@GLib.VariantType.next].
+// type must be the result of a previous call to
+// [VariantType.First] or [VariantType.Next].
 //
 // If called on the key type of a dictionary entry then this call
 // returns the value type.  If called on the value type of a dictionary
 // entry then this call returns `NULL`.
 //
-// For tuples, `NULL` is returned when @type is the last item in the tuple.
+// For tuples, `NULL` is returned when type is the last item in the tuple.
 func (x *VariantType) Next() *VariantType {
 
 	cret := xVariantTypeNext(x.GoPointer())
@@ -573,12 +576,12 @@ func (x *VariantType) Next() *VariantType {
 
 var xVariantTypePeekString func(uintptr) string
 
-// Returns the type string corresponding to the given @type.
+// Returns the type string corresponding to the given type.
 //
 // The result is not nul-terminated; in order to determine its length you
-// must call [method@GLib.VariantType.get_string_length].
+// must call [VariantType.GetStringLength].
 //
-// To get a nul-terminated string, see [method@GLib.VariantType.dup_string].
+// To get a nul-terminated string, see [VariantType.DupString].
 func (x *VariantType) PeekString() string {
 
 	cret := xVariantTypePeekString(x.GoPointer())
@@ -616,10 +619,10 @@ func VariantTypeStringGetDepth(TypeStringVar string) uint {
 
 var xVariantTypeStringIsValid func(string) bool
 
-// Checks if @type_string is a valid
-// [GVariant type string](./struct.VariantType.html#gvariant-type-strings).
+// Checks if type_string is a valid
+// GVariant type string.
 //
-// This call is equivalent to calling [func@GLib.VariantType.string_scan] and
+// This call is equivalent to calling [VariantTypeStringScan] and
 // confirming that the following character is a nul terminator.
 func VariantTypeStringIsValid(TypeStringVar string) bool {
 
@@ -630,20 +633,20 @@ func VariantTypeStringIsValid(TypeStringVar string) bool {
 
 var xVariantTypeStringScan func(string, uintptr, *string) bool
 
-// Scan for a single complete and valid GVariant type string in @string.
+// Scan for a single complete and valid GVariant type string in string.
 //
-// The memory pointed to by @limit (or bytes beyond it) is never
+// The memory pointed to by limit (or bytes beyond it) is never
 // accessed.
 //
-// If a valid type string is found, @endptr is updated to point to the
-// first character past the end of the string that was found and %TRUE
+// If a valid type string is found, endptr is updated to point to the
+// first character past the end of the string that was found and true
 // is returned.
 //
-// If there is no valid type string starting at @string, or if the type
-// string does not end before @limit then %FALSE is returned.
+// If there is no valid type string starting at string, or if the type
+// string does not end before limit then false is returned.
 //
 // For the simple case of checking if a string is a valid type string,
-// see [func@GLib.VariantType.string_is_valid].
+// see [VariantTypeStringIsValid].
 func VariantTypeStringScan(StringVar string, LimitVar *string, EndptrVar *string) bool {
 
 	LimitVarPtr := core.GStrdupNullable(LimitVar)
@@ -659,47 +662,47 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVariantTypeChecked, libs, "g_variant_type_checked_")
-	core.PuregoSafeRegister(&xVariantTypeStringGetDepth, libs, "g_variant_type_string_get_depth_")
-	core.PuregoSafeRegister(&xVariantTypeStringIsValid, libs, "g_variant_type_string_is_valid")
-	core.PuregoSafeRegister(&xVariantTypeStringScan, libs, "g_variant_type_string_scan")
+	core.PuregoRegisterSince(&xVariantTypeChecked, libs, "g_variant_type_checked_", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantTypeStringGetDepth, libs, "g_variant_type_string_get_depth_", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantTypeStringIsValid, libs, "g_variant_type_string_is_valid", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantTypeStringScan, libs, "g_variant_type_string_scan", "GLIB", "2.24")
 
 	core.PuregoSafeRegister(&xVariantTypeGLibType, libs, "g_variant_type_get_gtype")
 
-	core.PuregoSafeRegister(&xNewVariantType, libs, "g_variant_type_new")
-	core.PuregoSafeRegister(&xNewVariantTypeArray, libs, "g_variant_type_new_array")
-	core.PuregoSafeRegister(&xNewVariantTypeDictEntry, libs, "g_variant_type_new_dict_entry")
-	core.PuregoSafeRegister(&xNewVariantTypeMaybe, libs, "g_variant_type_new_maybe")
-	core.PuregoSafeRegister(&xNewVariantTypeTuple, libs, "g_variant_type_new_tuple")
-
-	core.PuregoSafeRegister(&xVariantTypeCopy, libs, "g_variant_type_copy")
-	core.PuregoSafeRegister(&xVariantTypeDupString, libs, "g_variant_type_dup_string")
-	core.PuregoSafeRegister(&xVariantTypeElement, libs, "g_variant_type_element")
-	core.PuregoSafeRegister(&xVariantTypeEqual, libs, "g_variant_type_equal")
-	core.PuregoSafeRegister(&xVariantTypeFirst, libs, "g_variant_type_first")
-	core.PuregoSafeRegister(&xVariantTypeFree, libs, "g_variant_type_free")
-	core.PuregoSafeRegister(&xVariantTypeGetStringLength, libs, "g_variant_type_get_string_length")
-	core.PuregoSafeRegister(&xVariantTypeHash, libs, "g_variant_type_hash")
-	core.PuregoSafeRegister(&xVariantTypeIsArray, libs, "g_variant_type_is_array")
-	core.PuregoSafeRegister(&xVariantTypeIsBasic, libs, "g_variant_type_is_basic")
-	core.PuregoSafeRegister(&xVariantTypeIsContainer, libs, "g_variant_type_is_container")
-	core.PuregoSafeRegister(&xVariantTypeIsDefinite, libs, "g_variant_type_is_definite")
-	core.PuregoSafeRegister(&xVariantTypeIsDictEntry, libs, "g_variant_type_is_dict_entry")
-	core.PuregoSafeRegister(&xVariantTypeIsMaybe, libs, "g_variant_type_is_maybe")
-	core.PuregoSafeRegister(&xVariantTypeIsSubtypeOf, libs, "g_variant_type_is_subtype_of")
-	core.PuregoSafeRegister(&xVariantTypeIsTuple, libs, "g_variant_type_is_tuple")
-	core.PuregoSafeRegister(&xVariantTypeIsVariant, libs, "g_variant_type_is_variant")
-	core.PuregoSafeRegister(&xVariantTypeKey, libs, "g_variant_type_key")
-	core.PuregoSafeRegister(&xVariantTypeNItems, libs, "g_variant_type_n_items")
-	core.PuregoSafeRegister(&xVariantTypeNext, libs, "g_variant_type_next")
-	core.PuregoSafeRegister(&xVariantTypePeekString, libs, "g_variant_type_peek_string")
-	core.PuregoSafeRegister(&xVariantTypeValue, libs, "g_variant_type_value")
+	core.PuregoRegisterSince(&xNewVariantType, libs, "g_variant_type_new", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantTypeArray, libs, "g_variant_type_new_array", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantTypeDictEntry, libs, "g_variant_type_new_dict_entry", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantTypeMaybe, libs, "g_variant_type_new_maybe", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xNewVariantTypeTuple, libs, "g_variant_type_new_tuple", "GLIB", "2.24")
+
+	core.PuregoRegisterSince(&xVariantTypeCopy, libs, "g_variant_type_copy", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeDupString, libs, "g_variant_type_dup_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeElement, libs, "g_variant_type_element", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeEqual, libs, "g_variant_type_equal", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeFirst, libs, "g_variant_type_first", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeFree, libs, "g_variant_type_free", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeGetStringLength, libs, "g_variant_type_get_string_length", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeHash, libs, "g_variant_type_hash", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsArray, libs, "g_variant_type_is_array", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsBasic, libs, "g_variant_type_is_basic", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsContainer, libs, "g_variant_type_is_container", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsDefinite, libs, "g_variant_type_is_definite", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsDictEntry, libs, "g_variant_type_is_dict_entry", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsMaybe, libs, "g_variant_type_is_maybe", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsSubtypeOf, libs, "g_variant_type_is_subtype_of", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsTuple, libs, "g_variant_type_is_tuple", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeIsVariant, libs, "g_variant_type_is_variant", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeKey, libs, "g_variant_type_key", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeNItems, libs, "g_variant_type_n_items", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeNext, libs, "g_variant_type_next", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypePeekString, libs, "g_variant_type_peek_string", "GLIB", "2.24")
+	core.PuregoRegisterSince(&xVariantTypeValue, libs, "g_variant_type_value", "GLIB", "2.24")
 
 }
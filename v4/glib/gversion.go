@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -11,17 +10,17 @@ var xCheckVersion func(uint, uint, uint) string
 // Checks that the GLib library in use is compatible with the
 // given version.
 //
-// Generally you would pass in the constants %GLIB_MAJOR_VERSION,
-// %GLIB_MINOR_VERSION, %GLIB_MICRO_VERSION as the three arguments
+// Generally you would pass in the constants [MAJOR_VERSION],
+// [MINOR_VERSION], [MICRO_VERSION] as the three arguments
 // to this function; that produces a check that the library in use
 // is compatible with the version of GLib the application or module
 // was compiled against.
 //
 // Compatibility is defined by two things: first the version
 // of the running library is newer than the version
-// `@required_major.required_minor.@required_micro`. Second
+// `required_major.required_minor.@required_micro`. Second
 // the running library must be binary compatible with the
-// version `@required_major.@required_minor.@required_micro`
+// version `required_major.@required_minor.@required_micro`
 // (same major version.)
 func CheckVersion(RequiredMajorVar uint, RequiredMinorVar uint, RequiredMicroVar uint) string {
 
@@ -30,18 +29,25 @@ func CheckVersion(RequiredMajorVar uint, RequiredMinorVar uint, RequiredMicroVar
 	return cret
 }
 
+// CheckVersionAtLeast returns whether the loaded GLIB library is version major.minor.micro or later,
+// calling a function that was added in a later version panics with a core.MissingSymbolError.
+// It is false for another major version, see CheckVersion.
+func CheckVersionAtLeast(major, minor, micro uint) bool {
+	return CheckVersion(major, minor, micro) == ""
+}
+
 func init() {
 	core.SetPackageName("GLIB", "glib-2.0")
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCheckVersion, libs, "glib_check_version")
+	core.PuregoRegisterSince(&xCheckVersion, libs, "glib_check_version", "GLIB", "2.6")
 
 }
@@ -5,14 +5,14 @@ const (
 	// A macro that should be defined by the user prior to including
 	// the glib.h header.
 	// The definition should be one of the predefined GLib version
-	// macros: %GLIB_VERSION_2_26, %GLIB_VERSION_2_28,...
+	// macros: GLIB_VERSION_2_26, GLIB_VERSION_2_28,...
 	//
 	// This macro defines the earliest version of GLib that the package is
 	// required to be able to compile against.
 	//
 	// If the compiler is configured to warn about the use of deprecated
 	// functions, then using functions that were deprecated in version
-	// %GLIB_VERSION_MIN_REQUIRED or earlier will cause warnings (but
+	// [VERSION_MIN_REQUIRED] or earlier will cause warnings (but
 	// using functions deprecated in later releases will not).
 	VERSION_MIN_REQUIRED int = 2
 )
@@ -2,7 +2,6 @@
 package glib
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -33,15 +32,20 @@ func FileErrorQuark() Quark {
 	return cret
 }
 
-var xIoChannelErrorQuark func() Quark
+var xIOChannelErrorQuark func() Quark
 
-func IoChannelErrorQuark() Quark {
+func IOChannelErrorQuark() Quark {
 
-	cret := xIoChannelErrorQuark()
+	cret := xIOChannelErrorQuark()
 
 	return cret
 }
 
+// Deprecated: use IOChannelErrorQuark, this name of g_io_channel_error_quark is kept for compatibility.
+func IoChannelErrorQuark() Quark {
+	return IOChannelErrorQuark()
+}
+
 var xKeyFileErrorQuark func() Quark
 
 func KeyFileErrorQuark() Quark {
@@ -155,28 +159,28 @@ func init() {
 	core.SetSharedLibraries("GLIB", []string{"libgobject-2.0.so.0", "libglib-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GLIB") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBookmarkFileErrorQuark, libs, "g_bookmark_file_error_quark")
-	core.PuregoSafeRegister(&xConvertErrorQuark, libs, "g_convert_error_quark")
-	core.PuregoSafeRegister(&xFileErrorQuark, libs, "g_file_error_quark")
-	core.PuregoSafeRegister(&xIoChannelErrorQuark, libs, "g_io_channel_error_quark")
-	core.PuregoSafeRegister(&xKeyFileErrorQuark, libs, "g_key_file_error_quark")
-	core.PuregoSafeRegister(&xMarkupErrorQuark, libs, "g_markup_error_quark")
-	core.PuregoSafeRegister(&xNumberParserErrorQuark, libs, "g_number_parser_error_quark")
-	core.PuregoSafeRegister(&xOptionErrorQuark, libs, "g_option_error_quark")
-	core.PuregoSafeRegister(&xRegexErrorQuark, libs, "g_regex_error_quark")
-	core.PuregoSafeRegister(&xShellErrorQuark, libs, "g_shell_error_quark")
-	core.PuregoSafeRegister(&xSpawnErrorQuark, libs, "g_spawn_error_quark")
-	core.PuregoSafeRegister(&xSpawnExitErrorQuark, libs, "g_spawn_exit_error_quark")
-	core.PuregoSafeRegister(&xThreadErrorQuark, libs, "g_thread_error_quark")
-	core.PuregoSafeRegister(&xUnixErrorQuark, libs, "g_unix_error_quark")
-	core.PuregoSafeRegister(&xUriErrorQuark, libs, "g_uri_error_quark")
-	core.PuregoSafeRegister(&xVariantParseErrorQuark, libs, "g_variant_parse_error_quark")
+	core.PuregoRegisterSince(&xBookmarkFileErrorQuark, libs, "g_bookmark_file_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xConvertErrorQuark, libs, "g_convert_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xFileErrorQuark, libs, "g_file_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xIOChannelErrorQuark, libs, "g_io_channel_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xKeyFileErrorQuark, libs, "g_key_file_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xMarkupErrorQuark, libs, "g_markup_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xNumberParserErrorQuark, libs, "g_number_parser_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xOptionErrorQuark, libs, "g_option_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xRegexErrorQuark, libs, "g_regex_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xShellErrorQuark, libs, "g_shell_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnErrorQuark, libs, "g_spawn_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xSpawnExitErrorQuark, libs, "g_spawn_exit_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xThreadErrorQuark, libs, "g_thread_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xUnixErrorQuark, libs, "g_unix_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xUriErrorQuark, libs, "g_uri_error_quark", "GLIB", "")
+	core.PuregoRegisterSince(&xVariantParseErrorQuark, libs, "g_variant_parse_error_quark", "GLIB", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"reflect"
 	"sync"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -15,21 +14,31 @@ var callbacks = struct {
 	closures          map[uintptr]interface{}
 	handlerToCallback map[uint]uintptr
 	sourceToCallback  map[uint]uintptr
-	callbackRefCount  map[uintptr]int
+	// signalRefs counts the signal handlers that use the C callback of a Go function,
+	// including handlers that are being connected
+	signalRefs map[uintptr]int
+	// pinned are the Go functions whose C callback is passed to C outside of signal handlers,
+	// e.g. as a sort function, GLib does not tell when it stops using them so they are never released
+	pinned map[uintptr]bool
 }{
 	refs:              make(map[uintptr]uintptr),
 	closures:          make(map[uintptr]interface{}),
 	handlerToCallback: make(map[uint]uintptr),
 	sourceToCallback:  make(map[uint]uintptr),
-	callbackRefCount:  make(map[uintptr]int),
+	signalRefs:        make(map[uintptr]int),
+	pinned:            make(map[uintptr]bool),
 }
 
 // GetCallback retrives a callback reference by value.
+// The callback is kept for the lifetime of the process, see AcquireSignalCallback for signal handlers.
 // Users should not need to call this.
 func GetCallback(cbPtr uintptr) (uintptr, bool) {
-	callbacks.RLock()
-	defer callbacks.RUnlock()
+	callbacks.Lock()
+	defer callbacks.Unlock()
 	refPtr, ok := callbacks.refs[cbPtr]
+	if ok {
+		callbacks.pinned[cbPtr] = true
+	}
 	return refPtr, ok
 }
 
@@ -38,20 +47,82 @@ func GetCallback(cbPtr uintptr) (uintptr, bool) {
 func SaveCallback(cbPtr uintptr, refPtr uintptr) {
 	callbacks.Lock()
 	callbacks.refs[cbPtr] = refPtr
+	callbacks.pinned[cbPtr] = true
 	callbacks.Unlock()
 }
 
 // SaveCallbackWithClosure saves a reference to the callback value and retains the
 // provided closure to prevent it from being garbage collected.
+// It returns the callback to use for the value: if another goroutine saved a callback for it
+// since GetCallback was called, that callback is returned and refPtr is released.
 // Users should not need to call this.
-func SaveCallbackWithClosure(cbPtr uintptr, refPtr uintptr, closure interface{}) {
+func SaveCallbackWithClosure(cbPtr uintptr, refPtr uintptr, closure interface{}) uintptr {
 	callbacks.Lock()
+	defer callbacks.Unlock()
+	callbacks.pinned[cbPtr] = true
+	return saveCallback(cbPtr, refPtr, closure)
+}
+
+// saveCallback stores refPtr for cbPtr or returns the callback that is stored already, releasing refPtr.
+// Caller must hold callbacks.Lock().
+func saveCallback(cbPtr uintptr, refPtr uintptr, closure interface{}) uintptr {
+	if prev, ok := callbacks.refs[cbPtr]; ok && prev != refPtr {
+		core.UnrefCallback(refPtr)
+		return prev
+	}
 	callbacks.refs[cbPtr] = refPtr
 	callbacks.closures[cbPtr] = closure
-	if _, ok := callbacks.callbackRefCount[cbPtr]; !ok {
-		callbacks.callbackRefCount[cbPtr] = 1
+	return refPtr
+}
+
+// AcquireSignalCallback returns the callback saved for the value like GetCallback
+// and takes a reference to it for a signal handler, which is released with ReleaseSignalCallback.
+// Users should not need to call this, the generated ConnectX methods do.
+func AcquireSignalCallback(cbPtr uintptr) (uintptr, bool) {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	refPtr, ok := callbacks.refs[cbPtr]
+	if ok {
+		callbacks.signalRefs[cbPtr]++
 	}
-	callbacks.Unlock()
+	return refPtr, ok
+}
+
+// SaveSignalCallback saves the callback for the value like SaveCallbackWithClosure
+// and takes a reference to it for a signal handler, which is released with ReleaseSignalCallback.
+// Users should not need to call this, the generated ConnectX methods do.
+func SaveSignalCallback(cbPtr uintptr, refPtr uintptr, closure interface{}) uintptr {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	callbacks.signalRefs[cbPtr]++
+	return saveCallback(cbPtr, refPtr, closure)
+}
+
+// ReleaseSignalCallback releases a reference of AcquireSignalCallback or SaveSignalCallback,
+// once GLib destroyed the signal handler that used the callback.
+// The C callback is released with the last signal handler, such that its slot can be reused,
+// unless it is used outside of signal handlers too.
+// Users should not need to call this.
+func ReleaseSignalCallback(cbPtr uintptr) {
+	callbacks.Lock()
+	defer callbacks.Unlock()
+	count, ok := callbacks.signalRefs[cbPtr]
+	if !ok {
+		return
+	}
+	if count > 1 {
+		callbacks.signalRefs[cbPtr] = count - 1
+		return
+	}
+	delete(callbacks.signalRefs, cbPtr)
+	if callbacks.pinned[cbPtr] {
+		return
+	}
+	if refPtr, ok := callbacks.refs[cbPtr]; ok {
+		core.UnrefCallback(refPtr)
+	}
+	delete(callbacks.refs, cbPtr)
+	delete(callbacks.closures, cbPtr)
 }
 
 // RemoveCallback removes a callback from the registry, allowing it to be garbage
@@ -71,88 +142,44 @@ func RemoveCallback(cbPtr uintptr) {
 	}
 	delete(callbacks.refs, cbPtr)
 	delete(callbacks.closures, cbPtr)
-	delete(callbacks.callbackRefCount, cbPtr)
+	delete(callbacks.signalRefs, cbPtr)
+	delete(callbacks.pinned, cbPtr)
 	callbacks.Unlock()
 }
 
-// acquireCallbackRef increments callbackRefCount for cbPtr.
-// Caller must hold callbacks.Lock().
-func acquireCallbackRef(cbPtr uintptr) {
-	callbacks.callbackRefCount[cbPtr]++
-}
-
-func hasCallbackMappings(cbPtr uintptr) bool {
-	for _, mappedCbPtr := range callbacks.handlerToCallback {
-		if mappedCbPtr == cbPtr {
-			return true
-		}
-	}
-	for _, mappedCbPtr := range callbacks.sourceToCallback {
-		if mappedCbPtr == cbPtr {
-			return true
-		}
-	}
-	return false
-}
-
-// releaseCallbackRef decrements callbackRefCount for cbPtr and removes callback
-// data when it reaches zero.
-// Caller must hold callbacks.Lock().
-// Handler/source mappings to cbPtr are expected to be removed or replaced by
-// the caller (RemoveCallbackByHandler, RemoveCallbackBySource,
-// SaveHandlerMapping, SaveSourceMapping).
-func releaseCallbackRef(cbPtr uintptr) {
-	count, ok := callbacks.callbackRefCount[cbPtr]
-	if !ok {
-		return
-	}
-
-	count--
-	if count > 0 {
-		callbacks.callbackRefCount[cbPtr] = count
-		return
-	}
-
-	delete(callbacks.callbackRefCount, cbPtr)
-	delete(callbacks.refs, cbPtr)
-	delete(callbacks.closures, cbPtr)
-}
-
 // SaveHandlerMapping records a signal handler ID → callback pointer mapping
-// so that DisconnectSignal can clean up the callback registry.
+// so that the Go callback of a handler can be found with CallbackByHandler.
 func SaveHandlerMapping(handlerID uint, cbPtr uintptr) {
 	if handlerID == 0 {
 		return
 	}
 
 	callbacks.Lock()
-	defer callbacks.Unlock()
-	if prevCbPtr, ok := callbacks.handlerToCallback[handlerID]; ok {
-		if prevCbPtr == cbPtr {
-			return
-		}
-		releaseCallbackRef(prevCbPtr)
-		if !hasCallbackMappings(prevCbPtr) {
-			releaseCallbackRef(prevCbPtr)
-		}
-	}
 	callbacks.handlerToCallback[handlerID] = cbPtr
-	acquireCallbackRef(cbPtr)
+	callbacks.Unlock()
 }
 
-// RemoveCallbackByHandler removes a callback from the registry using a signal handler ID.
+// RemoveCallbackByHandler removes the mapping of a signal handler ID.
+// The callback itself is released with ReleaseSignalCallback when GLib destroys the handler.
 func RemoveCallbackByHandler(handlerID uint) {
 	callbacks.Lock()
-	if cbPtr, ok := callbacks.handlerToCallback[handlerID]; ok {
-		delete(callbacks.handlerToCallback, handlerID)
-		releaseCallbackRef(cbPtr)
-		if !hasCallbackMappings(cbPtr) {
-			releaseCallbackRef(cbPtr)
-		}
-	}
+	delete(callbacks.handlerToCallback, handlerID)
 	callbacks.Unlock()
 }
 
+// CallbackByHandler returns the Go callback that was connected for a signal handler ID.
+// Users should not need to call this.
+func CallbackByHandler(handlerID uint) (interface{}, bool) {
+	callbacks.RLock()
+	defer callbacks.RUnlock()
+	cbPtr, ok := callbacks.handlerToCallback[handlerID]
+	if !ok {
+		return nil, false
+	}
+	closure, ok := callbacks.closures[cbPtr]
+	return closure, ok
+}
+
 // SaveSourceMapping records a source ID -> callback pointer mapping.
 func SaveSourceMapping(sourceID uint, cbPtr uintptr) {
 	if sourceID == 0 {
@@ -160,30 +187,14 @@ func SaveSourceMapping(sourceID uint, cbPtr uintptr) {
 	}
 
 	callbacks.Lock()
-	defer callbacks.Unlock()
-	if prevCbPtr, ok := callbacks.sourceToCallback[sourceID]; ok {
-		if prevCbPtr == cbPtr {
-			return
-		}
-		releaseCallbackRef(prevCbPtr)
-		if !hasCallbackMappings(prevCbPtr) {
-			releaseCallbackRef(prevCbPtr)
-		}
-	}
 	callbacks.sourceToCallback[sourceID] = cbPtr
-	acquireCallbackRef(cbPtr)
+	callbacks.Unlock()
 }
 
 // RemoveCallbackBySource removes a callback mapping using a source ID.
 func RemoveCallbackBySource(sourceID uint) {
 	callbacks.Lock()
-	if cbPtr, ok := callbacks.sourceToCallback[sourceID]; ok {
-		delete(callbacks.sourceToCallback, sourceID)
-		releaseCallbackRef(cbPtr)
-		if !hasCallbackMappings(cbPtr) {
-			releaseCallbackRef(cbPtr)
-		}
-	}
+	delete(callbacks.sourceToCallback, sourceID)
 	callbacks.Unlock()
 }
 
@@ -227,6 +238,7 @@ var sourceTrampolineOnceCb uintptr
 
 func initSourceTrampoline() {
 	fn := func(id uintptr) uintptr {
+		defer core.RecoverPanic()
 		sourceTrampolines.Lock()
 		entry, ok := sourceTrampolines.funcs[id]
 		if !ok {
@@ -236,28 +248,33 @@ func initSourceTrampoline() {
 		cb := entry.fn
 		sourceTrampolines.Unlock()
 
-		result := cb(0)
-
-		if !result {
-			sourceTrampolines.Lock()
-			delete(sourceTrampolines.funcs, id)
-			// Also clean up the reverse mapping (source ID → data ID).
-			for sid, did := range sourceTrampolines.sourceToDataID {
-				if did == id {
-					delete(sourceTrampolines.sourceToDataID, sid)
-					break
+		result := false
+		// Deferred so that the entry is also cleaned up when cb panics,
+		// the panic handler then returns 0 — SOURCE_REMOVE.
+		defer func() {
+			if !result {
+				sourceTrampolines.Lock()
+				delete(sourceTrampolines.funcs, id)
+				// Also clean up the reverse mapping (source ID → data ID).
+				for sid, did := range sourceTrampolines.sourceToDataID {
+					if did == id {
+						delete(sourceTrampolines.sourceToDataID, sid)
+						break
+					}
 				}
+				sourceTrampolines.Unlock()
 			}
-			sourceTrampolines.Unlock()
-		}
+		}()
+		result = cb(0)
 		if result {
 			return 1
 		}
 		return 0
 	}
-	sourceTrampolineCb = purego.NewCallback(fn)
+	sourceTrampolineCb = core.NewCallback(fn)
 
 	onceFn := func(id uintptr) {
+		defer core.RecoverPanic()
 		sourceTrampolines.Lock()
 		entry, ok := sourceTrampolines.funcs[id]
 		if !ok {
@@ -277,7 +294,7 @@ func initSourceTrampoline() {
 
 		cb(0)
 	}
-	sourceTrampolineOnceCb = purego.NewCallback(onceFn)
+	sourceTrampolineOnceCb = core.NewCallback(onceFn)
 }
 
 // registerSourceFunc stores a SourceFunc in the trampoline map and returns
@@ -342,9 +359,20 @@ func UnrefCallback(fnPtr interface{}) error {
 	return unrefCallback(fnPtr)
 }
 
+// SetPanicHandler sets the function that is called when a Go callback called from C panics,
+// such as a signal handler, a source function or a virtual method.
+// A panic that unwinds through the C frames of GTK crashes the process with an unreadable stack,
+// so it is recovered and passed to fn with its stack trace, after which the callback returns zero values:
+// e.g. a source function returns false and is removed, a signal handler with a boolean result returns false.
+// The default handler writes the panic and its stack trace to standard error, nil restores it. To crash instead, exit from the handler.
+// Functions passed to NewCallback directly are not guarded, they should defer core.RecoverPanic themselves.
+func SetPanicHandler(fn func(v interface{}, stack []byte)) {
+	core.SetPanicHandler(fn)
+}
+
 // NewCallback is an alias to purego.NewCallback
 func NewCallback(fnPtr interface{}) uintptr {
-	return purego.NewCallbackFnPtr(fnPtr)
+	return core.NewCallbackFnPtr(fnPtr)
 }
 
 // NewCallbackNullable is an alias to purego.NewCallback that returns a null pointer for null functions
@@ -368,3 +396,45 @@ func (e *Error) Error() string {
 func (e *Error) MessageGo() string {
 	return core.GoString(e.Message)
 }
+
+// ErrorCode is implemented by the enumerations of the codes of an error domain, such as gio.IOErrorEnum.
+// The codes can be compared with an *Error using errors.Is and extracted from it using errors.As:
+//
+//	if errors.Is(err, gio.GIoErrorNotFoundValue) {
+//		...
+//	}
+//	var code gio.IOErrorEnum
+//	if errors.As(err, &code) {
+//		...
+//	}
+type ErrorCode interface {
+	error
+	// ErrorDomain returns the name of the quark of the error domain, e.g. g-io-error-quark
+	ErrorDomain() string
+}
+
+// Is reports whether e has the domain and code of target, which is an ErrorCode or an *Error.
+func (e *Error) Is(target error) bool {
+	switch t := target.(type) {
+	case *Error:
+		return t != nil && e.Domain == t.Domain && e.Code == t.Code
+	case ErrorCode:
+		rv := reflect.ValueOf(t)
+		return rv.CanInt() && QuarkToString(e.Domain) == t.ErrorDomain() && rv.Int() == int64(e.Code)
+	}
+	return false
+}
+
+// As sets target to the code of e if target points to an ErrorCode of the domain of e.
+func (e *Error) As(target interface{}) bool {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || !rv.Elem().CanInt() {
+		return false
+	}
+	code, ok := reflect.Zero(rv.Elem().Type()).Interface().(ErrorCode)
+	if !ok || QuarkToString(e.Domain) != code.ErrorDomain() {
+		return false
+	}
+	rv.Elem().SetInt(int64(e.Code))
+	return true
+}
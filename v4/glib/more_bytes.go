@@ -0,0 +1,27 @@
+package glib
+
+import "unsafe"
+
+// NewBytesFromSlice returns a GBytes with a copy of data, which the caller releases with Unref.
+// The functions that take or return a GBytes for content, e.g. gio.NewBytesIcon, take and return a []byte themselves.
+func NewBytesFromSlice(data []byte) *Bytes {
+	if len(data) == 0 {
+		return NewBytes(nil, 0)
+	}
+	return NewBytes(data, uint(len(data)))
+}
+
+// Data returns a copy of the data of the GBytes, it does not change its reference count.
+// It returns nil for a nil GBytes.
+func (x *Bytes) Data() []byte {
+	if x == nil {
+		return nil
+	}
+	var size uint
+	ptr := x.GetData(&size)
+	if ptr == 0 {
+		return []byte{}
+	}
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return append([]byte{}, unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), size)...)
+}
@@ -0,0 +1,98 @@
+package glib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+// CallbackCounts are the numbers of callbacks that the bindings keep alive, see CallbackStats.
+type CallbackCounts struct {
+	// Live is the number of C callbacks that were created and not released.
+	// At most Max callbacks can be alive at the same time, creating more panics.
+	Live int
+	// Max is the maximum number of live callbacks
+	Max int
+	// Registered is the number of Go functions in the callback registry, passed by pointer to the bindings
+	Registered int
+	// Handlers is the number of signal handler IDs mapped to a registered callback
+	Handlers int
+	// Sources is the number of source IDs mapped to a registered callback
+	Sources int
+	// SourceFuncs is the number of source functions, e.g. of IdleAdd and TimeoutAdd, that have not been removed.
+	// They share a single C callback.
+	SourceFuncs int
+	// UserData is the number of Go values registered with RegisterUserData that are not unregistered yet
+	UserData int
+}
+
+// CallbackStats returns the numbers of callbacks that are alive, to find callbacks that are never released
+// before the limit of live callbacks is reached. Some callbacks, like the trampolines, live as long as the process.
+func CallbackStats() CallbackCounts {
+	live, _ := core.LiveCallbacks()
+	c := CallbackCounts{
+		Live: live,
+		Max:  core.MaxCallbacks,
+	}
+	callbacks.RLock()
+	c.Registered = len(callbacks.refs)
+	c.Handlers = len(callbacks.handlerToCallback)
+	c.Sources = len(callbacks.sourceToCallback)
+	callbacks.RUnlock()
+	sourceTrampolines.Lock()
+	c.SourceFuncs = len(sourceTrampolines.funcs)
+	sourceTrampolines.Unlock()
+	userData.Lock()
+	c.UserData = len(userData.values)
+	userData.Unlock()
+	return c
+}
+
+// ReportLeaks writes the callback counts to w, see CallbackStats, and returns the number of live callbacks.
+// With the environment variable PUREGOTK_DEBUG=leaks, it also writes where the live callbacks were created,
+// callbacks created at the same place are grouped and the places with most callbacks come first.
+func ReportLeaks(w io.Writer) int {
+	c := CallbackStats()
+	fmt.Fprintf(w, "puregotk: %d of %d callbacks alive, %d registered, %d signal handlers, %d sources, %d source functions, %d user data\n",
+		c.Live, c.Max, c.Registered, c.Handlers, c.Sources, c.SourceFuncs, c.UserData)
+	_, stacks := core.LiveCallbacks()
+	if len(stacks) == 0 {
+		if !core.Debug("leaks") {
+			fmt.Fprintln(w, "puregotk: set PUREGOTK_DEBUG=leaks to record where callbacks are created")
+		}
+		return c.Live
+	}
+	counts := make(map[string]int)
+	for _, stack := range stacks {
+		counts[string(stack)]++
+	}
+	places := make([]string, 0, len(counts))
+	for stack := range counts {
+		places = append(places, stack)
+	}
+	sort.Slice(places, func(i, j int) bool {
+		if counts[places[i]] != counts[places[j]] {
+			return counts[places[i]] > counts[places[j]]
+		}
+		return places[i] < places[j]
+	})
+	for _, stack := range places {
+		fmt.Fprintf(w, "\n%d callbacks created at:\n%s", counts[stack], stack)
+	}
+	return c.Live
+}
+
+// Exit exits the process with the code, like os.Exit.
+// With the environment variable PUREGOTK_DEBUG=leaks, it first writes the leak report to standard error,
+// see ReportLeaks, so it can replace os.Exit in main:
+//
+//	glib.Exit(app.Run(len(os.Args), os.Args))
+func Exit(code int) {
+	if core.Debug("leaks") {
+		ReportLeaks(os.Stderr)
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,124 @@
+package glib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrLoopNotOwner is returned by Await when another thread runs the main context,
+	// iterating it would block until that thread returns, which deadlocks if it waits for the caller
+	ErrLoopNotOwner = errors.New("glib: the main context is owned by another thread, a nested loop would deadlock")
+	// ErrLoopTooDeep is returned by Await when MaxNestedLoops nested loops are already running
+	ErrLoopTooDeep = errors.New("glib: too many nested main loops")
+	// ErrLoopTimeout is returned by Await when the result did not arrive before the timeout
+	ErrLoopTimeout = errors.New("glib: nested main loop timed out")
+)
+
+// MaxNestedLoops is the number of nested loops that Await runs at most at the same time.
+// Every nested loop can only return after the loops nested in it returned, so deep nesting is almost always a bug.
+var MaxNestedLoops = 8
+
+// nestedWarnDepth is the main loop depth from which Await warns,
+// at depth 1 it is called from a callback of the main loop, which is the intended use
+const nestedWarnDepth = 2
+
+var nestedLoops atomic.Int32
+
+// NestedLoops returns the number of nested loops that are running in Await.
+func NestedLoops() int {
+	return int(nestedLoops.Load())
+}
+
+// Await runs a nested loop on the default main context until the operation started by start calls resolve,
+// and returns the value passed to resolve. This makes it possible to wait for an asynchronous result,
+// e.g. the response of a dialog, where the code cannot be written with callbacks:
+//
+//	button, err := glib.Await(func(resolve func(int)) {
+//		alert.ChooseGo(win, nil, func(button int, _ error) { resolve(button) })
+//	}, 0)
+//
+// Prefer callbacks where possible: while the nested loop runs, the callback that called Await does not return.
+// Await returns after the loops nested in it returned, so the outer of two nested dialogs cannot finish first.
+// resolve can be called from any goroutine, only the first call counts.
+// A timeout of 0 waits forever, otherwise ErrLoopTimeout is returned when it expires.
+//
+// Await must be called on the thread that runs the main loop, from other threads it returns ErrLoopNotOwner
+// instead of deadlocking. It prints a warning when it is called from a callback of another nested loop,
+// as input to the windows of the outer loops is handled but their callbacks that call Await cannot return.
+func Await[T any](start func(resolve func(T)), timeout time.Duration) (T, error) {
+	var zero T
+	if NestedLoops() >= MaxNestedLoops {
+		return zero, ErrLoopTooDeep
+	}
+
+	// the context is owned by a thread, so keep the goroutine on it until the loop returns
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	ctx := MainContextDefault()
+	if !ctx.Acquire() {
+		return zero, ErrLoopNotOwner
+	}
+	defer ctx.Release()
+
+	nestedLoops.Add(1)
+	defer nestedLoops.Add(-1)
+	// the depth is a C int
+	if depth := int(int32(MainDepth())); depth >= nestedWarnDepth {
+		fmt.Fprintf(os.Stderr, "glib: Await called at main loop depth %d, the callbacks of the outer loops cannot return until it does\n", depth)
+	}
+
+	var (
+		mu       sync.Mutex
+		done     bool
+		timedOut bool
+		value    T
+	)
+	finished := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return done || timedOut
+	}
+	start(func(v T) {
+		mu.Lock()
+		if done || timedOut {
+			mu.Unlock()
+			return
+		}
+		done = true
+		value = v
+		mu.Unlock()
+		// wake up the loop if it is resolved from another goroutine
+		ctx.Wakeup()
+	})
+
+	var timeoutID uint
+	if timeout > 0 {
+		fn := SourceOnceFunc(func(uintptr) {
+			mu.Lock()
+			timedOut = !done
+			timeoutID = 0
+			mu.Unlock()
+		})
+		timeoutID = TimeoutAddOnce(uint(timeout/time.Millisecond), &fn, 0)
+	}
+
+	for !finished() {
+		ctx.Iteration(true)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if timeoutID != 0 {
+		SourceRemove(timeoutID)
+	}
+	if timedOut {
+		return zero, ErrLoopTimeout
+	}
+	return value, nil
+}
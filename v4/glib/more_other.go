@@ -1,8 +1,10 @@
-//go:build !windows && !darwin && !freebsd && (!linux || (!amd64 && !arm64))
+//go:build !windows && !darwin && !freebsd && !netbsd && (!linux || (!amd64 && !arm64 && !loong64))
 
 package glib
 
-// unrefCallback on unsupported on other platforms
+import "github.com/jwijenbergh/puregotk/pkg/core"
+
+// unrefCallback is unsupported on other platforms, callbacks are never created there
 func unrefCallback(_ interface{}) error {
-	panic("glib.UnrefCallback is unsupported on this platform")
+	return core.ErrUnsupported
 }
@@ -1,4 +1,4 @@
-//go:build darwin || freebsd || (linux && (amd64 || arm64))
+//go:build darwin || freebsd || netbsd || (linux && (amd64 || arm64 || loong64))
 
 package glib
 
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 func unrefCallback(fnPtr interface{}) error {
@@ -20,12 +20,12 @@ func unrefCallback(fnPtr interface{}) error {
 	cbPtr := reflect.ValueOf(fnPtr).Pointer()
 	refPtr, ok := GetCallback(cbPtr)
 	if !ok {
-		return purego.UnrefCallbackFnPtr(fnPtr)
+		return core.UnrefCallbackFnPtr(fnPtr)
 	}
 	defer func() {
 		callbacks.Lock()
 		delete(callbacks.refs, cbPtr)
 		callbacks.Unlock()
 	}()
-	return purego.UnrefCallback(refPtr)
+	return core.UnrefCallback(refPtr)
 }
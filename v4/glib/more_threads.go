@@ -0,0 +1,52 @@
+package glib
+
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+// checkThreads is set with PUREGOTK_DEBUG=threads
+var checkThreads = core.Debug("threads")
+
+// mainThread is the thread that initialized the package, Go runs the initialization on the main thread of the process
+var mainThread *Thread
+
+func init() {
+	mainThread = ThreadSelf()
+}
+
+// IsMainThread reports whether it is called on the main thread of the process, the thread that must run the GTK main loop.
+// The main goroutine only stays on the main thread if it calls runtime.LockOSThread in an init function,
+// as GTK applications must do anyway.
+//
+// The bindings follow the threading rules of the libraries they bind:
+// GTK, GDK, libadwaita and the helper packages under gtk must only be used from the thread that runs the main loop,
+// while GLib and GObject are thread safe where their C documentation says so.
+// The Go side of the bindings keeps the following contract.
+//
+// Safe to call from any goroutine:
+//   - adding and removing sources, e.g. IdleAdd, TimeoutAdd and SourceRemove, the usual way to get back to the main thread
+//   - connecting, disconnecting and emitting signals of GObjects and the generated ConnectX and ConnectXFunc methods
+//   - the callback registry, CallbackStats, ReportLeaks and SetPanicHandler
+//   - gobject.SetData, gobject.GetData and gobject.HandlersOf
+//   - the string and library helpers of pkg/core
+//
+// Main thread only:
+//   - everything in the gtk, gdk, gsk and adw packages and the packages under gtk, such as vdom and widgetpool
+//   - Await and nested main loops
+//
+// With the environment variable PUREGOTK_DEBUG=threads, the main thread only helpers assert this with AssertMainThread.
+func IsMainThread() bool {
+	// GLib creates the GThread of a thread on its first call and keeps it for the lifetime of the thread
+	return ThreadSelf() == mainThread
+}
+
+// AssertMainThread panics if it is not called on the main thread, see IsMainThread.
+// fn is the name of the calling function for the panic message.
+// The check is only done with the environment variable PUREGOTK_DEBUG=threads, otherwise it returns immediately.
+func AssertMainThread(fn string) {
+	if checkThreads && !IsMainThread() {
+		panic(fmt.Sprintf("%s must be called on the main thread, use glib.IdleAdd to run it there", fn))
+	}
+}
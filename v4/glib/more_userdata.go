@@ -0,0 +1,93 @@
+package glib
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+// userData holds the Go values registered with RegisterUserData by their ID
+var userData = struct {
+	sync.Mutex
+	nextID uintptr
+	values map[uintptr]interface{}
+}{
+	values: make(map[uintptr]interface{}),
+}
+
+// RegisterUserData stores a Go value and returns the ID to pass as the user data of a C function,
+// e.g. the data of a signal handler, a sort function or an async callback.
+// The C callback can then be a single function that is shared by all calls and looks the value up with LookupUserData,
+// such that no callback is allocated per call:
+//
+//	var drawTrampoline gtk.DrawingAreaDrawFunc = func(_ uintptr, cr *cairo.Context, width, height int, id uintptr) {
+//		if draw, ok := glib.LookupUserData[func(*cairo.Context, int, int)](id); ok {
+//			draw(cr, width, height)
+//		}
+//	}
+//	...
+//	area.SetDrawFunc(&drawTrampoline, glib.RegisterUserData(draw), glib.UserDataDestroyNotify())
+//
+// The value is kept until the ID is unregistered, usually by UserDataDestroyNotify when C destroys the user data.
+// IDs are never 0.
+func RegisterUserData(value interface{}) uintptr {
+	userData.Lock()
+	defer userData.Unlock()
+	userData.nextID++
+	userData.values[userData.nextID] = value
+	return userData.nextID
+}
+
+// LookupUserData returns the value registered with the ID.
+// It returns false if the ID is unregistered or the value is not of type T.
+func LookupUserData[T any](id uintptr) (T, bool) {
+	userData.Lock()
+	defer userData.Unlock()
+	t, ok := userData.values[id].(T)
+	return t, ok
+}
+
+// TakeUserData returns the value registered with the ID like LookupUserData and unregisters it if it is of type T,
+// e.g. in an async callback that is called once.
+func TakeUserData[T any](id uintptr) (T, bool) {
+	userData.Lock()
+	defer userData.Unlock()
+	t, ok := userData.values[id].(T)
+	if ok {
+		delete(userData.values, id)
+	}
+	return t, ok
+}
+
+// UnregisterUserData releases the value registered with the ID, unknown IDs are ignored.
+func UnregisterUserData(id uintptr) {
+	userData.Lock()
+	delete(userData.values, id)
+	userData.Unlock()
+}
+
+// userDataDestroy is the destroy notify of all registered values
+var userDataDestroy DestroyNotify = UnregisterUserData
+
+// UserDataDestroyNotify returns the destroy notify that unregisters the user data it is called with,
+// pass it with an ID of RegisterUserData such that the value lives as long as C uses it.
+// It can be used as GClosureNotify of a signal handler as well, as its first argument is the user data too.
+func UserDataDestroyNotify() *DestroyNotify {
+	return &userDataDestroy
+}
+
+var (
+	userDataDestroyOnce sync.Once
+	userDataDestroyCb   uintptr
+)
+
+// UserDataDestroyCallback returns UserDataDestroyNotify as a C function pointer, for functions that are called through purego directly.
+func UserDataDestroyCallback() uintptr {
+	userDataDestroyOnce.Do(func() {
+		userDataDestroyCb = core.NewCallback(func(id uintptr) {
+			defer core.RecoverPanic()
+			UnregisterUserData(id)
+		})
+	})
+	return userDataDestroyCb
+}
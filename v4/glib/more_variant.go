@@ -0,0 +1,618 @@
+package glib
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"unsafe"
+)
+
+var variantPtrType = reflect.TypeOf((*Variant)(nil))
+
+// VariantNewFromInternalPtr converts a raw GVariant pointer, e.g. a signal argument, to a *Variant.
+// It returns nil for a null pointer.
+func VariantNewFromInternalPtr(ptr uintptr) *Variant {
+	if ptr == 0 {
+		return nil
+	}
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return (*Variant)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
+}
+
+// NewVariantFromGo creates a new floating GVariant from a Go value.
+//
+// The GVariant type is derived from the Go type, see VariantSignatureOf.
+// A *Variant is returned as is.
+func NewVariantFromGo(v interface{}) (*Variant, error) {
+	if val, ok := v.(*Variant); ok {
+		return val, nil
+	}
+	sig, err := VariantSignatureOf(v)
+	if err != nil {
+		return nil, err
+	}
+	return NewVariantFromGoWithType(sig, v)
+}
+
+// VariantSignatureOf returns the GVariant type string that NewVariantFromGo uses for the Go value v.
+//
+// The Go types map to GVariant types as follows:
+// bool (b), byte (y), int16 (n), uint16 (q), int32 (i), uint32 (u),
+// int64 and int (x), uint64 and uint (t), float32 and float64 (d), string (s).
+// Slices and arrays become arrays (a), maps become dictionaries (a{}) and
+// structs become tuples of their exported fields.
+// Interfaces and a nested *Variant become a boxed variant (v),
+// e.g. a map[string]interface{} becomes a{sv}.
+func VariantSignatureOf(v interface{}) (string, error) {
+	if v == nil {
+		return "", fmt.Errorf("cannot derive a GVariant type from nil")
+	}
+	if val, ok := v.(*Variant); ok {
+		return val.GetTypeString(), nil
+	}
+	return variantSignature(reflect.TypeOf(v))
+}
+
+func variantSignature(t reflect.Type) (string, error) {
+	if t == variantPtrType {
+		return "v", nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "b", nil
+	case reflect.Uint8:
+		return "y", nil
+	case reflect.Int16:
+		return "n", nil
+	case reflect.Uint16:
+		return "q", nil
+	case reflect.Int32:
+		return "i", nil
+	case reflect.Uint32:
+		return "u", nil
+	case reflect.Int64, reflect.Int:
+		return "x", nil
+	case reflect.Uint64, reflect.Uint:
+		return "t", nil
+	case reflect.Float32, reflect.Float64:
+		return "d", nil
+	case reflect.String:
+		return "s", nil
+	case reflect.Interface:
+		return "v", nil
+	case reflect.Ptr:
+		return variantSignature(t.Elem())
+	case reflect.Slice, reflect.Array:
+		elem, err := variantSignature(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "a" + elem, nil
+	case reflect.Map:
+		key, err := variantSignature(t.Key())
+		if err != nil {
+			return "", err
+		}
+		if len(key) != 1 || key == "v" {
+			return "", fmt.Errorf("cannot use Go type %s as a GVariant dictionary key", t.Key())
+		}
+		val, err := variantSignature(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "a{" + key + val + "}", nil
+	case reflect.Struct:
+		sig := "("
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			f, err := variantSignature(t.Field(i).Type)
+			if err != nil {
+				return "", err
+			}
+			sig += f
+		}
+		return sig + ")", nil
+	default:
+		return "", fmt.Errorf("cannot convert Go type %s to a GVariant", t)
+	}
+}
+
+// SplitVariantSignature splits a string of concatenated GVariant types, e.g. the contents of a tuple, into the single types.
+// For example "sa{sv}(ii)" becomes ["s", "a{sv}", "(ii)"].
+func SplitVariantSignature(sig string) ([]string, error) {
+	var types []string
+	for sig != "" {
+		n, err := variantTypeLen(sig)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, sig[:n])
+		sig = sig[n:]
+	}
+	return types, nil
+}
+
+// variantTypeLen returns the length of the first complete type in sig
+func variantTypeLen(sig string) (int, error) {
+	if sig == "" {
+		return 0, fmt.Errorf("unexpected end of GVariant type string")
+	}
+	switch sig[0] {
+	case 'a', 'm':
+		n, err := variantTypeLen(sig[1:])
+		return n + 1, err
+	case '(', '{':
+		end := byte(')')
+		if sig[0] == '{' {
+			end = '}'
+		}
+		i := 1
+		for i < len(sig) && sig[i] != end {
+			n, err := variantTypeLen(sig[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+		if i >= len(sig) {
+			return 0, fmt.Errorf("unterminated container in GVariant type string %q", sig)
+		}
+		return i + 1, nil
+	case 'b', 'y', 'n', 'q', 'i', 'u', 'x', 't', 'h', 'd', 's', 'o', 'g', 'v':
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("invalid character %q in GVariant type string %q", sig[0], sig)
+	}
+}
+
+// variantIntRanges are the smallest and largest values of the integer GVariant types
+var variantIntRanges = map[string][2]int64{
+	"y": {0, math.MaxUint8},
+	"n": {math.MinInt16, math.MaxInt16},
+	"q": {0, math.MaxUint16},
+	"i": {math.MinInt32, math.MaxInt32},
+	"u": {0, math.MaxUint32},
+	"x": {math.MinInt64, math.MaxInt64},
+	"t": {0, math.MaxInt64},
+	"h": {math.MinInt32, math.MaxInt32},
+}
+
+// NewVariantFromGoWithType creates a new floating GVariant of type sig from a Go value.
+// Unlike NewVariantFromGo, the GVariant type is not derived from the Go type,
+// so numbers are converted to the requested size and a []interface{} or struct can be used for any tuple.
+// A number that does not fit into the requested size is an error.
+func NewVariantFromGoWithType(sig string, v interface{}) (*Variant, error) {
+	return newVariantFromValue(sig, reflect.ValueOf(v))
+}
+
+func newVariantFromValue(sig string, v reflect.Value) (*Variant, error) {
+	for v.IsValid() && (v.Kind() == reflect.Interface || (v.Kind() == reflect.Ptr && v.Type() != variantPtrType)) {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+	if sig == "" {
+		return nil, fmt.Errorf("empty GVariant type string")
+	}
+	if v.IsValid() && v.Type() == variantPtrType {
+		val := v.Interface().(*Variant)
+		if val == nil {
+			return nil, fmt.Errorf("cannot convert a nil *Variant to GVariant type %q", sig)
+		}
+		if sig == "v" && val.GetTypeString() != "v" {
+			return NewVariantVariant(val), nil
+		}
+		if t := val.GetTypeString(); t != sig {
+			return nil, fmt.Errorf("GVariant of type %q given where %q is expected", t, sig)
+		}
+		return val, nil
+	}
+	if sig[0] == 'm' {
+		if !v.IsValid() {
+			t := NewVariantType(sig[1:])
+			defer t.Free()
+			return NewVariantMaybe(t, nil), nil
+		}
+		child, err := newVariantFromValue(sig[1:], v)
+		if err != nil {
+			return nil, err
+		}
+		return NewVariantMaybe(nil, child), nil
+	}
+	if !v.IsValid() {
+		return nil, fmt.Errorf("cannot convert nil to GVariant type %q", sig)
+	}
+
+	mismatch := func() (*Variant, error) {
+		return nil, fmt.Errorf("cannot convert Go type %s to GVariant type %q", v.Type(), sig)
+	}
+	switch sig {
+	case "b":
+		if v.Kind() != reflect.Bool {
+			return mismatch()
+		}
+		return NewVariantBoolean(v.Bool()), nil
+	case "y", "n", "q", "i", "u", "x", "t", "h":
+		var i int64
+		switch {
+		case v.CanInt():
+			i = v.Int()
+			if i < variantIntRanges[sig][0] || i > variantIntRanges[sig][1] {
+				return nil, fmt.Errorf("Go value %d overflows GVariant type %q", i, sig)
+			}
+		case v.CanUint():
+			u := v.Uint()
+			if sig != "t" && u > uint64(variantIntRanges[sig][1]) {
+				return nil, fmt.Errorf("Go value %d overflows GVariant type %q", u, sig)
+			}
+			i = int64(u)
+		default:
+			return mismatch()
+		}
+		switch sig {
+		case "y":
+			return NewVariantByte(byte(i)), nil
+		case "n":
+			return NewVariantInt16(int16(i)), nil
+		case "q":
+			return NewVariantUint16(uint16(i)), nil
+		case "i":
+			return NewVariantInt32(int32(i)), nil
+		case "u":
+			return NewVariantUint32(uint32(i)), nil
+		case "x":
+			return NewVariantInt64(i), nil
+		case "t":
+			return NewVariantUint64(uint64(i)), nil
+		default:
+			return NewVariantHandle(int32(i)), nil
+		}
+	case "d":
+		switch {
+		case v.CanFloat():
+			return NewVariantDouble(v.Float()), nil
+		case v.CanInt():
+			return NewVariantDouble(float64(v.Int())), nil
+		case v.CanUint():
+			return NewVariantDouble(float64(v.Uint())), nil
+		}
+		return mismatch()
+	case "s", "o", "g":
+		if v.Kind() != reflect.String {
+			return mismatch()
+		}
+		switch sig {
+		case "o":
+			return NewVariantObjectPath(v.String()), nil
+		case "g":
+			return NewVariantSignature(v.String()), nil
+		}
+		return NewVariantString(v.String()), nil
+	case "v":
+		inner, err := variantSignature(v.Type())
+		if err != nil {
+			return nil, err
+		}
+		child, err := newVariantFromValue(inner, v)
+		if err != nil {
+			return nil, err
+		}
+		return NewVariantVariant(child), nil
+	}
+
+	switch sig[0] {
+	case 'a':
+		builder := newVariantBuilder(sig)
+		defer builder.Unref()
+		if len(sig) > 1 && sig[1] == '{' {
+			if v.Kind() != reflect.Map {
+				return mismatch()
+			}
+			kv, err := SplitVariantSignature(sig[2 : len(sig)-1])
+			if err != nil || len(kv) != 2 {
+				return nil, fmt.Errorf("invalid GVariant dictionary type %q", sig)
+			}
+			keys := v.MapKeys()
+			sort.Slice(keys, func(i, j int) bool {
+				return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+			})
+			for _, k := range keys {
+				key, err := newVariantFromValue(kv[0], k)
+				if err != nil {
+					return nil, err
+				}
+				val, err := newVariantFromValue(kv[1], v.MapIndex(k))
+				if err != nil {
+					key.RefSink()
+					key.Unref()
+					return nil, err
+				}
+				builder.AddValue(NewVariantDictEntry(key, val))
+			}
+			return builder.End(), nil
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return mismatch()
+		}
+		for i := 0; i < v.Len(); i++ {
+			child, err := newVariantFromValue(sig[1:], v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			builder.AddValue(child)
+		}
+		return builder.End(), nil
+	case '(':
+		types, err := SplitVariantSignature(sig[1 : len(sig)-1])
+		if err != nil {
+			return nil, err
+		}
+		var fields []reflect.Value
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				fields = append(fields, v.Index(i))
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					fields = append(fields, v.Field(i))
+				}
+			}
+		default:
+			return mismatch()
+		}
+		if len(fields) != len(types) {
+			return nil, fmt.Errorf("GVariant tuple type %q needs %d values, got %d", sig, len(types), len(fields))
+		}
+		builder := newVariantBuilder(sig)
+		defer builder.Unref()
+		for i, f := range fields {
+			child, err := newVariantFromValue(types[i], f)
+			if err != nil {
+				return nil, err
+			}
+			builder.AddValue(child)
+		}
+		return builder.End(), nil
+	}
+	return nil, fmt.Errorf("unsupported GVariant type %q", sig)
+}
+
+// newVariantBuilder creates a builder for a container of type sig
+// the builder copies the type so it is freed directly
+func newVariantBuilder(sig string) *VariantBuilder {
+	t := NewVariantType(sig)
+	defer t.Free()
+	return NewVariantBuilder(t)
+}
+
+// NewVariantTupleFromGo creates a new floating GVariant tuple with one child per value.
+// The type of each child is derived with VariantSignatureOf.
+func NewVariantTupleFromGo(values ...interface{}) (*Variant, error) {
+	sig := "("
+	for _, v := range values {
+		s, err := VariantSignatureOf(v)
+		if err != nil {
+			return nil, err
+		}
+		sig += s
+	}
+	return NewVariantFromGoWithType(sig+")", values)
+}
+
+// GoValue converts a GVariant to a Go value.
+//
+// Basic types use the exact Go type of the GVariant type,
+// e.g. a GVariant of type i becomes an int32.
+// Object paths (o) and signatures (g) become strings and a variant (v) is unboxed.
+// Arrays of strings (as, ao) become a []string, a bytestring (ay) becomes a []byte
+// and other arrays and tuples become a []interface{}.
+// Dictionaries with string keys become a map[string]interface{},
+// other dictionaries a map[interface{}]interface{}.
+// An empty maybe becomes nil.
+func (x *Variant) GoValue() (interface{}, error) {
+	switch t := x.GetTypeString(); t {
+	case "b":
+		return x.GetBoolean(), nil
+	case "y":
+		return x.GetByte(), nil
+	case "n":
+		return x.GetInt16(), nil
+	case "q":
+		return x.GetUint16(), nil
+	case "i":
+		return x.GetInt32(), nil
+	case "u":
+		return x.GetUint32(), nil
+	case "x":
+		return x.GetInt64(), nil
+	case "t":
+		return x.GetUint64(), nil
+	case "h":
+		return x.GetHandle(), nil
+	case "d":
+		return x.GetDouble(), nil
+	case "s", "o", "g":
+		return x.GetString(nil), nil
+	case "as":
+		return x.GetStrv(nil), nil
+	case "ao":
+		return x.GetObjv(nil), nil
+	case "ay":
+		n := x.NChildren()
+		b := make([]byte, n)
+		for i := uint(0); i < n; i++ {
+			c := x.GetChildValue(i)
+			b[i] = c.GetByte()
+			c.Unref()
+		}
+		return b, nil
+	case "v":
+		inner := x.GetVariant()
+		defer inner.Unref()
+		return inner.GoValue()
+	default:
+		switch t[0] {
+		case 'm':
+			inner := x.GetMaybe()
+			if inner == nil {
+				return nil, nil
+			}
+			defer inner.Unref()
+			return inner.GoValue()
+		case 'a':
+			if t[1] == '{' {
+				return x.goDict(t[2] == 's' || t[2] == 'o' || t[2] == 'g')
+			}
+			return x.goChildren()
+		case '(':
+			return x.goChildren()
+		}
+		return nil, fmt.Errorf("cannot convert GVariant of type %q to a Go value", t)
+	}
+}
+
+func (x *Variant) goChildren() ([]interface{}, error) {
+	n := x.NChildren()
+	ret := make([]interface{}, n)
+	for i := uint(0); i < n; i++ {
+		c := x.GetChildValue(i)
+		v, err := c.GoValue()
+		c.Unref()
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = v
+	}
+	return ret, nil
+}
+
+func (x *Variant) goDict(stringKeys bool) (interface{}, error) {
+	n := x.NChildren()
+	strMap := make(map[string]interface{}, n)
+	anyMap := make(map[interface{}]interface{}, n)
+	for i := uint(0); i < n; i++ {
+		entry := x.GetChildValue(i)
+		kv, err := entry.goChildren()
+		entry.Unref()
+		if err != nil {
+			return nil, err
+		}
+		if stringKeys {
+			strMap[kv[0].(string)] = kv[1]
+		} else {
+			anyMap[kv[0]] = kv[1]
+		}
+	}
+	if stringKeys {
+		return strMap, nil
+	}
+	return anyMap, nil
+}
+
+// StoreGo converts a GVariant to a Go value like GoValue and stores it in the value that dst points to.
+// Numbers are converted to the Go type of dst, arrays and tuples are stored in slices, arrays or structs
+// and dictionaries in maps, e.g. a GVariant of type a{sv} can be stored in a map[string]interface{}
+// and one of type (si) in a struct with a string and an int field.
+func (x *Variant) StoreGo(dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("cannot store GVariant in non-pointer %T", dst)
+	}
+	v, err := x.GoValue()
+	if err != nil {
+		return err
+	}
+	return storeGoValue(rv.Elem(), v)
+}
+
+func storeGoValue(dst reflect.Value, v interface{}) error {
+	if v == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	src := reflect.ValueOf(v)
+	t := dst.Type()
+	switch {
+	case src.Type().AssignableTo(t):
+		dst.Set(src)
+		return nil
+	case t.Kind() == reflect.Ptr:
+		p := reflect.New(t.Elem())
+		if err := storeGoValue(p.Elem(), v); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	case src.Kind() == reflect.Slice && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array):
+		n := src.Len()
+		if t.Kind() == reflect.Slice {
+			dst.Set(reflect.MakeSlice(t, n, n))
+		} else if n != t.Len() {
+			return fmt.Errorf("cannot store %d GVariant elements in %s", n, t)
+		}
+		for i := 0; i < n; i++ {
+			if err := storeGoValue(dst.Index(i), src.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case src.Kind() == reflect.Slice && t.Kind() == reflect.Struct:
+		i := 0
+		for f := 0; f < t.NumField(); f++ {
+			if !t.Field(f).IsExported() {
+				continue
+			}
+			if i >= src.Len() {
+				return fmt.Errorf("cannot store %d GVariant tuple elements in %s", src.Len(), t)
+			}
+			if err := storeGoValue(dst.Field(f), src.Index(i).Interface()); err != nil {
+				return err
+			}
+			i++
+		}
+		if i != src.Len() {
+			return fmt.Errorf("cannot store %d GVariant tuple elements in %s", src.Len(), t)
+		}
+		return nil
+	case src.Kind() == reflect.Map && t.Kind() == reflect.Map:
+		m := reflect.MakeMapWithSize(t, src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			k := reflect.New(t.Key()).Elem()
+			if err := storeGoValue(k, iter.Key().Interface()); err != nil {
+				return err
+			}
+			e := reflect.New(t.Elem()).Elem()
+			if err := storeGoValue(e, iter.Value().Interface()); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		dst.Set(m)
+		return nil
+	case basicKind(src.Kind()) != 0 && basicKind(src.Kind()) == basicKind(t.Kind()):
+		// numbers are converted to other number types, booleans only to booleans and strings only to strings
+		dst.Set(src.Convert(t))
+		return nil
+	}
+	return fmt.Errorf("cannot store GVariant value of Go type %s in %s", src.Type(), t)
+}
+
+// basicKind returns reflect.Bool, reflect.Int for all numbers or reflect.String for the kinds that storeGoValue converts
+// and reflect.Invalid for all other kinds
+func basicKind(k reflect.Kind) reflect.Kind {
+	switch {
+	case k == reflect.Bool || k == reflect.String:
+		return k
+	case k >= reflect.Int && k <= reflect.Float64:
+		return reflect.Int
+	}
+	return reflect.Invalid
+}
@@ -0,0 +1,146 @@
+package glib
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// StallAction is what a watchdog does when the main loop stalls, see WatchdogOptions.
+type StallAction int
+
+const (
+	// StallLog writes a line to standard error when the main loop stalls and when it responds again
+	StallLog StallAction = iota
+	// StallDump also writes the stacks of all goroutines to standard error, like a SIGQUIT without exiting,
+	// which shows the goroutine that holds the main thread and the locks it waits for
+	StallDump
+	// StallCallback only calls WatchdogOptions.OnStall
+	StallCallback
+)
+
+// Stall describes a stall of the main loop that a watchdog detected.
+type Stall struct {
+	// Since is the time of the ping that the main loop did not answer
+	Since time.Time
+	// Duration is how long the main loop has not responded when the stall is reported
+	Duration time.Duration
+	// Stacks are the stacks of all goroutines when the stall was detected
+	Stacks []byte
+}
+
+// WatchdogOptions configures StartWatchdog.
+type WatchdogOptions struct {
+	// Threshold is how long the main loop may take to respond to a ping before it counts as stalled, 1 second if 0
+	Threshold time.Duration
+	// Interval is the time between pings, Threshold / 4 if 0
+	Interval time.Duration
+	// Action is what the watchdog does when the main loop stalls
+	Action StallAction
+	// OnStall is called on the goroutine of the watchdog when the main loop stalls, with any Action.
+	// It must not call functions that need the main thread, they would wait for the stall to end.
+	OnStall func(Stall)
+	// OnRecover is called on the goroutine of the watchdog when the main loop responds again after a stall
+	OnRecover func(stalled time.Duration)
+}
+
+// StartWatchdog starts a goroutine that pings the default main context with an idle callback and reports when
+// the main loop did not run it within the threshold, e.g. because a callback on the main thread waits for a Go lock
+// that a goroutine holds while it waits for the main thread. A stall is reported once, and when the main loop
+// responds again its duration is reported as well. The pings run at the default priority, so a main loop that is
+// busy with higher priority sources for longer than the threshold counts as stalled too.
+//
+// The watchdog is only meant for debugging and can be started from any goroutine, call stop to end it.
+func StartWatchdog(opts WatchdogOptions) (stop func()) {
+	if opts.Threshold <= 0 {
+		opts.Threshold = time.Second
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = opts.Threshold / 4
+	}
+
+	var (
+		mu sync.Mutex
+		// sent is the time of the ping that was not answered yet, zero if there is none
+		sent time.Time
+	)
+	// a single function answers all pings, the trampoline entry of every ping is removed once it ran
+	pong := SourceOnceFunc(func(uintptr) {
+		mu.Lock()
+		sent = time.Time{}
+		mu.Unlock()
+	})
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		var stalled time.Time
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				mu.Lock()
+				pending := sent
+				if pending.IsZero() {
+					sent = now
+				}
+				mu.Unlock()
+
+				if pending.IsZero() {
+					if !stalled.IsZero() {
+						reportRecover(opts, now.Sub(stalled))
+						stalled = time.Time{}
+					}
+					IdleAddOnce(&pong, 0)
+					continue
+				}
+				if stalled.IsZero() && now.Sub(pending) >= opts.Threshold {
+					stalled = pending
+					reportStall(opts, Stall{Since: pending, Duration: now.Sub(pending), Stacks: allStacks()})
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// allStacks returns the stacks of all goroutines
+func allStacks() []byte {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+func reportStall(opts WatchdogOptions, s Stall) {
+	switch opts.Action {
+	case StallLog:
+		fmt.Fprintf(os.Stderr, "glib: the main loop has not responded for %s\n", s.Duration.Round(time.Millisecond))
+	case StallDump:
+		fmt.Fprintf(os.Stderr, "glib: the main loop has not responded for %s, goroutines:\n\n%s\n", s.Duration.Round(time.Millisecond), s.Stacks)
+	}
+	if opts.OnStall != nil {
+		opts.OnStall(s)
+	}
+}
+
+func reportRecover(opts WatchdogOptions, stalled time.Duration) {
+	if opts.Action != StallCallback {
+		fmt.Fprintf(os.Stderr, "glib: the main loop responded again after %s\n", stalled.Round(time.Millisecond))
+	}
+	if opts.OnRecover != nil {
+		opts.OnRecover(stalled)
+	}
+}
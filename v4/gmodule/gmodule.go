@@ -2,28 +2,29 @@
 package gmodule
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 // Specifies the type of the module initialization function.
 // If a module contains a function named g_module_check_init() it is called
-// automatically when the module is loaded. It is passed the #GModule structure
-// and should return %NULL on success or a string describing the initialization
+// automatically when the module is loaded. It is passed the [Module] structure
+// and should return nil on success or a string describing the initialization
 // error.
 type ModuleCheckInit func(*Module) string
 
 // Specifies the type of the module function called when it is unloaded.
 // If a module contains a function named g_module_unload() it is called
 // automatically when the module is unloaded.
-// It is passed the #GModule structure.
+// It is passed the [Module] structure.
 type ModuleUnload func(*Module)
 
-// The #GModule struct is an opaque data structure to represent a
-// [dynamically-loaded module](modules.html#dynamic-loading-of-modules).
+// The [Module] struct is an opaque data structure to represent a
+// dynamically-loaded module.
 // It should only be accessed via the following functions.
 //
 // To ensure correct lock ordering, these functions must not be called from
@@ -49,7 +50,7 @@ func (x *Module) Close() bool {
 var xModuleMakeResident func(uintptr)
 
 // Ensures that a module will never be unloaded.
-// Any future g_module_close() calls on the module will be ignored.
+// Any future [Module.Close] calls on the module will be ignored.
 func (x *Module) MakeResident() {
 
 	xModuleMakeResident(x.GoPointer())
@@ -60,7 +61,7 @@ var xModuleName func(uintptr) string
 
 // Returns the filename that the module was opened with.
 //
-// If @module refers to the application itself, "main" is returned.
+// If module refers to the application itself, "main" is returned.
 func (x *Module) Name() string {
 
 	cret := xModuleName(x.GoPointer())
@@ -70,7 +71,7 @@ func (x *Module) Name() string {
 var xModuleSymbol func(uintptr, string, *uintptr) bool
 
 // Gets a symbol pointer from a module, such as one exported
-// by %G_MODULE_EXPORT. Note that a valid symbol can be %NULL.
+// by G_MODULE_EXPORT. Note that a valid symbol can be nil.
 func (x *Module) Symbol(SymbolNameVar string, SymbolVar *uintptr) bool {
 
 	cret := xModuleSymbol(x.GoPointer(), SymbolNameVar, SymbolVar)
@@ -79,7 +80,7 @@ func (x *Module) Symbol(SymbolNameVar string, SymbolVar *uintptr) bool {
 
 // Flags passed to g_module_open().
 // Note that these flags are not supported on all platforms.
-type ModuleFlags int
+type ModuleFlags uint32
 
 const (
 
@@ -96,6 +97,46 @@ const (
 	GModuleBindMaskValue ModuleFlags = 3
 )
 
+// Has reports whether all bits of flags are set in x
+func (x ModuleFlags) Has(flags ModuleFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ModuleFlags) Set(flags ModuleFlags) ModuleFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ModuleFlags) Clear(flags ModuleFlags) ModuleFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ModuleFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GModuleBindLazyValue != 0 && x&GModuleBindLazyValue == GModuleBindLazyValue {
+		names = append(names, "G_MODULE_BIND_LAZY")
+		rest &^= GModuleBindLazyValue
+	}
+	if rest&GModuleBindLocalValue != 0 && x&GModuleBindLocalValue == GModuleBindLocalValue {
+		names = append(names, "G_MODULE_BIND_LOCAL")
+		rest &^= GModuleBindLocalValue
+	}
+	if rest&GModuleBindMaskValue != 0 && x&GModuleBindMaskValue == GModuleBindMaskValue {
+		names = append(names, "G_MODULE_BIND_MASK")
+		rest &^= GModuleBindMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Errors returned by g_module_open_full().
 type ModuleError int
 
@@ -107,28 +148,53 @@ const (
 	GModuleErrorCheckFailedValue ModuleError = 1
 )
 
-var xModuleBuildPath func(uintptr, string) string
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ModuleError) ErrorDomain() string {
+	return "g-module-error-quark"
+}
 
-// A portable way to build the filename of a module. The platform-specific
-// prefix and suffix are added to the filename, if needed, and the result
-// is added to the directory, using the correct separator character.
-//
-// The directory should specify the directory where the module can be found.
-// It can be %NULL or an empty string to indicate that the module is in a
-// standard platform-specific directory, though this is not recommended
-// since the wrong module may be found.
-//
-// For example, calling g_module_build_path() on a Linux system with a
-// @directory of `/lib` and a @module_name of "mylibrary" will return
-// `/lib/libmylibrary.so`. On a Windows system, using `\Windows` as the
-// directory it will return `\Windows\mylibrary.dll`.
-func ModuleBuildPath(DirectoryVar *string, ModuleNameVar string) string {
+// Error returns the C name of the error code
+func (x ModuleError) Error() string {
+	switch x {
+	case GModuleErrorFailedValue:
+		return "G_MODULE_ERROR_FAILED"
+	case GModuleErrorCheckFailedValue:
+		return "G_MODULE_ERROR_CHECK_FAILED"
+	}
+	return fmt.Sprintf("g-module-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ModuleError) String() string {
+	switch x {
+	case GModuleErrorFailedValue:
+		return "G_MODULE_ERROR_FAILED"
+	case GModuleErrorCheckFailedValue:
+		return "G_MODULE_ERROR_CHECK_FAILED"
+	}
+	return fmt.Sprintf("ModuleError(%d)", int(x))
+}
 
-	DirectoryVarPtr := core.GStrdupNullable(DirectoryVar)
-	defer core.GFreeNullable(DirectoryVarPtr)
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ModuleError) Nick() string {
+	switch x {
+	case GModuleErrorFailedValue:
+		return "failed"
+	case GModuleErrorCheckFailedValue:
+		return "check-failed"
+	}
+	return ""
+}
 
-	cret := xModuleBuildPath(DirectoryVarPtr, ModuleNameVar)
-	return cret
+// ModuleErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ModuleErrorFromString(s string) (ModuleError, bool) {
+	switch s {
+	case "G_MODULE_ERROR_FAILED", "failed":
+		return GModuleErrorFailedValue, true
+	case "G_MODULE_ERROR_CHECK_FAILED", "check-failed":
+		return GModuleErrorCheckFailedValue, true
+	}
+	return 0, false
 }
 
 var xNewModuleError func() string
@@ -154,20 +220,19 @@ func init() {
 	core.SetSharedLibraries("GMODULE", []string{"libgmodule-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GMODULE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xModuleBuildPath, libs, "g_module_build_path")
-	core.PuregoSafeRegister(&xNewModuleError, libs, "g_module_error")
-	core.PuregoSafeRegister(&xModuleSupported, libs, "g_module_supported")
+	core.PuregoRegisterSince(&xNewModuleError, libs, "g_module_error", "GMODULE", "")
+	core.PuregoRegisterSince(&xModuleSupported, libs, "g_module_supported", "GMODULE", "")
 
-	core.PuregoSafeRegister(&xModuleClose, libs, "g_module_close")
-	core.PuregoSafeRegister(&xModuleMakeResident, libs, "g_module_make_resident")
-	core.PuregoSafeRegister(&xModuleName, libs, "g_module_name")
-	core.PuregoSafeRegister(&xModuleSymbol, libs, "g_module_symbol")
+	core.PuregoRegisterSince(&xModuleClose, libs, "g_module_close", "GMODULE", "")
+	core.PuregoRegisterSince(&xModuleMakeResident, libs, "g_module_make_resident", "GMODULE", "")
+	core.PuregoRegisterSince(&xModuleName, libs, "g_module_name", "GMODULE", "")
+	core.PuregoRegisterSince(&xModuleSymbol, libs, "g_module_symbol", "GMODULE", "")
 
 }
@@ -0,0 +1,51 @@
+//go:build !puregotk_no_deprecated
+
+// Package gmodule was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gmodule
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xModuleBuildPath func(uintptr, string) string
+
+// A portable way to build the filename of a module. The platform-specific
+// prefix and suffix are added to the filename, if needed, and the result
+// is added to the directory, using the correct separator character.
+//
+// The directory should specify the directory where the module can be found.
+// It can be nil or an empty string to indicate that the module is in a
+// standard platform-specific directory, though this is not recommended
+// since the wrong module may be found.
+//
+// For example, calling [ModuleBuildPath] on a Linux system with a
+// directory of `/lib` and a module_name of "mylibrary" will return
+// `/lib/libmylibrary.so`. On a Windows system, using `\Windows` as the
+// directory it will return `\Windows\mylibrary.dll`.
+//
+// Deprecated: since 2.76. Use g_module_open() instead with module_name as the
+// basename of the file_name argument. See [glib.MODULE_SUFFIX] for why.
+func ModuleBuildPath(DirectoryVar *string, ModuleNameVar string) string {
+
+	DirectoryVarPtr := core.GStrdupNullable(DirectoryVar)
+	defer core.GFreeNullable(DirectoryVarPtr)
+
+	cret := xModuleBuildPath(DirectoryVarPtr, ModuleNameVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GMODULE", "gmodule-2.0")
+	core.SetSharedLibraries("GMODULE", []string{"libgmodule-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GMODULE") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xModuleBuildPath, libs, "g_module_build_path", "GMODULE", "")
+
+}
@@ -2,7 +2,6 @@
 package gmodule
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
@@ -20,13 +19,13 @@ func init() {
 	core.SetSharedLibraries("GMODULE", []string{"libgmodule-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GMODULE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xModuleErrorQuark, libs, "g_module_error_quark")
+	core.PuregoRegisterSince(&xModuleErrorQuark, libs, "g_module_error_quark", "GMODULE", "")
 
 }
@@ -0,0 +1,260 @@
+// Package binding binds nested properties of GObjects, such as "child.model.title".
+//
+// Every property of the path except the last has to hold an object.
+// Notify handlers are connected to every object along the path and are moved when an intermediate object changes,
+// so the binding keeps following the path like a GtkExpression or a property binding of a GtkBuilder file would.
+package binding
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// link is a notify handler on the object at a position of the path
+type link struct {
+	obj     *gobject.Object
+	handler uint
+}
+
+// Binding follows a property path from a root object.
+type Binding struct {
+	root     *gobject.Object
+	names    []string
+	links    []link
+	onChange func(value *gobject.Value)
+}
+
+// linkRef is the position of a link in a binding, it is the user data of the notify handler
+// and is unregistered when the handler is disconnected
+type linkRef struct {
+	binding *Binding
+	index   int
+}
+
+var (
+	xSignalConnectData func(uintptr, string, uintptr, uintptr, uintptr, int) uint
+	// g_object_class_find_property is registered directly as the pspec it returns is owned by the class
+	xObjectClassFindProperty func(uintptr, string) uintptr
+	notifyOnce               sync.Once
+	// notifyCb is the single notify handler shared by all bindings
+	notifyCb uintptr
+)
+
+func notifyCallback() uintptr {
+	notifyOnce.Do(func() {
+		notifyCb = core.NewCallback(func(_ uintptr, _ uintptr, id uintptr) {
+			defer core.RecoverPanic()
+			if ref, ok := glib.LookupUserData[linkRef](id); ok {
+				ref.binding.changed(ref.index)
+			}
+		})
+	})
+	return notifyCb
+}
+
+// Path watches the property path of obj, e.g. "child.model.title".
+// fn is called with the current value of the last property when watching starts
+// and every time it changes, either because the property itself changed or because an object along the path was replaced.
+// fn receives nil when an object along the path is nil.
+// The value is only valid during the call.
+//
+// An error is returned for an empty path or if a property along the path does not exist or does not hold an object.
+func Path(obj *gobject.Object, path string, fn func(value *gobject.Value)) (*Binding, error) {
+	if path == "" {
+		return nil, fmt.Errorf("binding: empty property path")
+	}
+	b := &Binding{
+		root:     obj,
+		names:    strings.Split(path, "."),
+		onChange: fn,
+	}
+	if err := b.follow(0); err != nil {
+		b.Unbind()
+		return nil, err
+	}
+	b.emit()
+	return b, nil
+}
+
+// Bind sets the property at dstPath of dst to the value at srcPath of src
+// when the binding is created and whenever the source value changes.
+// The value is converted with g_value_transform if the types of the properties differ.
+// The objects along dstPath are looked up every time the value is set,
+// nothing is set while the source or destination path contains a nil object.
+func Bind(src *gobject.Object, srcPath string, dst *gobject.Object, dstPath string) (*Binding, error) {
+	names := strings.Split(dstPath, ".")
+	if dstPath == "" {
+		return nil, fmt.Errorf("binding: empty property path")
+	}
+	return Path(src, srcPath, func(value *gobject.Value) {
+		if value == nil {
+			return
+		}
+		owner, ok := lookup(dst, names[:len(names)-1])
+		if !ok {
+			return
+		}
+		defer owner.Unref()
+		name := names[len(names)-1]
+		if !hasProperty(owner, name) {
+			return
+		}
+		var v gobject.Value
+		owner.GetProperty(name, &v)
+		defer v.Unset()
+		if value.Transform(&v) {
+			owner.SetProperty(name, &v)
+		}
+	})
+}
+
+// Value reads the current value at the end of the path into v, which must be unset.
+// It returns false without touching v if an object along the path is nil.
+// Call v.Unset when done.
+func (b *Binding) Value(v *gobject.Value) bool {
+	if len(b.links) < len(b.names) {
+		return false
+	}
+	b.links[len(b.links)-1].obj.GetProperty(b.names[len(b.names)-1], v)
+	return true
+}
+
+// Unbind disconnects all handlers and releases the objects along the path.
+func (b *Binding) Unbind() {
+	b.cut(0)
+}
+
+// changed is called when the property at index of the path changed
+func (b *Binding) changed(index int) {
+	if index < len(b.names)-1 {
+		b.cut(index + 1)
+		// errors are not reported here, the path simply ends at the object that does not match
+		b.follow(index + 1)
+	}
+	b.emit()
+}
+
+func (b *Binding) emit() {
+	var v gobject.Value
+	if !b.Value(&v) {
+		b.onChange(nil)
+		return
+	}
+	defer v.Unset()
+	b.onChange(&v)
+}
+
+// follow connects handlers starting at index of the path, until the end of the path or a nil object
+func (b *Binding) follow(index int) error {
+	obj := b.root
+	if index > 0 {
+		next, err := propertyObject(b.links[index-1].obj, b.names[index-1])
+		if err != nil || next == nil {
+			return err
+		}
+		obj = next
+	}
+	for i := index; i < len(b.names); i++ {
+		name := b.names[i]
+		if !hasProperty(obj, name) {
+			if i > 0 {
+				obj.Unref()
+			}
+			return fmt.Errorf("binding: object has no property %q", name)
+		}
+		b.links = append(b.links, b.connect(obj, i))
+		if i == len(b.names)-1 {
+			break
+		}
+		next, err := propertyObject(obj, name)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			break
+		}
+		obj = next
+	}
+	return nil
+}
+
+// cut disconnects the handlers from index until the end of the path
+// the objects after the root were referenced by follow and are released
+func (b *Binding) cut(index int) {
+	if index >= len(b.links) {
+		return
+	}
+	for i := len(b.links) - 1; i >= index; i-- {
+		l := b.links[i]
+		gobject.SignalHandlerDisconnect(l.obj, types.ULong(l.handler))
+		if i > 0 {
+			l.obj.Unref()
+		}
+	}
+	b.links = b.links[:index]
+}
+
+func (b *Binding) connect(obj *gobject.Object, index int) link {
+	id := glib.RegisterUserData(linkRef{binding: b, index: index})
+	handler := xSignalConnectData(obj.GoPointer(), "notify::"+b.names[index], notifyCallback(), id, glib.UserDataDestroyCallback(), 0)
+	return link{obj: obj, handler: handler}
+}
+
+// lookup follows names from obj and returns a new reference to the object at the end
+func lookup(obj *gobject.Object, names []string) (*gobject.Object, bool) {
+	obj.Ref()
+	for _, name := range names {
+		if !hasProperty(obj, name) {
+			obj.Unref()
+			return nil, false
+		}
+		next, err := propertyObject(obj, name)
+		obj.Unref()
+		if err != nil || next == nil {
+			return nil, false
+		}
+		obj = next
+	}
+	return obj, true
+}
+
+// propertyObject returns a new reference to the object held by the property name of obj
+func propertyObject(obj *gobject.Object, name string) (*gobject.Object, error) {
+	var v gobject.Value
+	obj.GetProperty(name, &v)
+	defer v.Unset()
+	if !gobject.TypeIsA(v.GType, gobject.TypeObjectVal) {
+		return nil, fmt.Errorf("binding: property %q does not hold an object", name)
+	}
+	return v.GetObject(), nil
+}
+
+// hasProperty looks up the property name in the class of obj
+func hasProperty(obj *gobject.Object, name string) bool {
+	ptr := obj.GoPointer()
+	// the class is the first field of every instance
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	class := *(*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
+	return xObjectClassFindProperty(class, name) != 0
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xSignalConnectData, libs, "g_signal_connect_data")
+	core.PuregoSafeRegister(&xObjectClassFindProperty, libs, "g_object_class_find_property")
+}
@@ -0,0 +1,59 @@
+package gobject_test
+
+import (
+	"testing"
+
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// userDataLeft fails the test if values registered since before are still in the user data registry
+func userDataLeft(t *testing.T, before glib.CallbackCounts, what string) {
+	t.Helper()
+	if after := glib.CallbackStats(); after.UserData != before.UserData {
+		t.Fatalf("%d user data are left after %s", after.UserData-before.UserData, what)
+	}
+}
+
+func TestClosureFuncFinalized(t *testing.T) {
+	before := glib.CallbackStats()
+	c := gobject.NewClosureFunc(func(*gobject.Value, []gobject.Value) {})
+	// drops the floating reference, which finalizes the closure
+	c.Sink()
+	userDataLeft(t, before, "the closure is finalized")
+}
+
+func TestClosureFuncInvalidated(t *testing.T) {
+	before := glib.CallbackStats()
+	called := false
+	c := gobject.NewClosureFunc(func(*gobject.Value, []gobject.Value) {
+		called = true
+	})
+	c.Ref()
+	c.Sink()
+	defer c.Unref()
+	c.Invalidate()
+	userDataLeft(t, before, "the closure is invalidated")
+	c.Invoke(nil, 0, nil, 0)
+	if called {
+		t.Fatal("the invalidated closure was invoked")
+	}
+}
+
+func TestActionHandlerFinalized(t *testing.T) {
+	before := glib.CallbackStats()
+	action := gio.NewActionFunc("finalized", "", func(*glib.Variant) {})
+	action.ConnectActivateFunc(func(gio.SimpleAction, uintptr) {})
+	action.Unref()
+	userDataLeft(t, before, "the action is finalized")
+}
+
+func TestActionHandlerDisconnected(t *testing.T) {
+	action := gio.NewSimpleAction("disconnected", nil)
+	defer action.Unref()
+	before := glib.CallbackStats()
+	id := action.ConnectActivateFunc(func(gio.SimpleAction, uintptr) {})
+	action.DisconnectSignal(id)
+	userDataLeft(t, before, "the handler is disconnected")
+}
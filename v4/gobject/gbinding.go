@@ -2,25 +2,27 @@
 package gobject
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+	"strings"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A function to be called to transform @from_value to @to_value.
+// A function to be called to transform from_value to to_value.
 //
-// If this is the @transform_to function of a binding, then @from_value
-// is the @source_property on the @source object, and @to_value is the
-// @target_property on the @target object. If this is the
-// @transform_from function of a %G_BINDING_BIDIRECTIONAL binding,
+// If this is the transform_to function of a binding, then from_value
+// is the source_property on the source object, and to_value is the
+// target_property on the target object. If this is the
+// transform_from function of a [GBindingBidirectionalValue] binding,
 // then those roles are reversed.
 type BindingTransformFunc func(uintptr, *Value, *Value, uintptr) bool
 
-// Flags to be passed to g_object_bind_property() or
-// g_object_bind_property_full().
+// Flags to be passed to [Object.BindProperty] or
+// [Object.BindPropertyFull].
 //
 // This enumeration can be extended at later date.
-type BindingFlags int
+type BindingFlags uint32
 
 var xBindingFlagsGLibType func() types.GType
 
@@ -42,43 +44,80 @@ const (
 	//   the synchronization is always from the source to the target.
 	GBindingSyncCreateValue BindingFlags = 2
 	// If the two properties being bound are
-	//   booleans, setting one to %TRUE will result in the other being
-	//   set to %FALSE and vice versa. This flag will only work for
+	//   booleans, setting one to true will result in the other being
+	//   set to false and vice versa. This flag will only work for
 	//   boolean properties, and cannot be used when passing custom
-	//   transformation functions to g_object_bind_property_full().
+	//   transformation functions to [Object.BindPropertyFull].
 	GBindingInvertBooleanValue BindingFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (BindingFlags) GLibType() types.GType {
+	return xBindingFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x BindingFlags) Has(flags BindingFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x BindingFlags) Set(flags BindingFlags) BindingFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x BindingFlags) Clear(flags BindingFlags) BindingFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x BindingFlags) String() string {
+	if x == 0 {
+		return "G_BINDING_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GBindingBidirectionalValue != 0 && x&GBindingBidirectionalValue == GBindingBidirectionalValue {
+		names = append(names, "G_BINDING_BIDIRECTIONAL")
+		rest &^= GBindingBidirectionalValue
+	}
+	if rest&GBindingSyncCreateValue != 0 && x&GBindingSyncCreateValue == GBindingSyncCreateValue {
+		names = append(names, "G_BINDING_SYNC_CREATE")
+		rest &^= GBindingSyncCreateValue
+	}
+	if rest&GBindingInvertBooleanValue != 0 && x&GBindingInvertBooleanValue == GBindingInvertBooleanValue {
+		names = append(names, "G_BINDING_INVERT_BOOLEAN")
+		rest &^= GBindingInvertBooleanValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // `GObject` instance (or source) and another property on another `GObject`
 // instance (or target).
 //
 // Whenever the source property changes, the same value is applied to the
 // target property; for instance, the following binding:
 //
-// ```c
-//
 //	g_object_bind_property (object1, "property-a",
 //	                        object2, "property-b",
 //	                        G_BINDING_DEFAULT);
 //
-// ```
-//
-// will cause the property named "property-b" of @object2 to be updated
-// every time [method@GObject.set] or the specific accessor changes the value of
-// the property "property-a" of @object1.
+// will cause the property named "property-b" of object2 to be updated
+// every time set or the specific accessor changes the value of
+// the property "property-a" of object1.
 //
 // It is possible to create a bidirectional binding between two properties
 // of two `GObject` instances, so that if either property changes, the
 // other is updated as well, for instance:
 //
-// ```c
-//
 //	g_object_bind_property (object1, "property-a",
 //	                        object2, "property-b",
 //	                        G_BINDING_BIDIRECTIONAL);
 //
-// ```
-//
 // will keep the two properties in sync.
 //
 // It is also possible to set a custom transformation function (in both
@@ -86,8 +125,6 @@ const (
 // transformation from the source value to the target value before
 // applying it; for instance, the following binding:
 //
-// ```c
-//
 //	g_object_bind_property_full (adjustment1, "value",
 //	                             adjustment2, "value",
 //	                             G_BINDING_BIDIRECTIONAL,
@@ -95,41 +132,35 @@ const (
 //	                             fahrenheit_to_celsius,
 //	                             NULL, NULL);
 //
-// ```
-//
 // will keep the "value" property of the two adjustments in sync; the
-// @celsius_to_fahrenheit function will be called whenever the "value"
-// property of @adjustment1 changes and will transform the current value
-// of the property before applying it to the "value" property of @adjustment2.
+// celsius_to_fahrenheit function will be called whenever the "value"
+// property of adjustment1 changes and will transform the current value
+// of the property before applying it to the "value" property of adjustment2.
 //
-// Vice versa, the @fahrenheit_to_celsius function will be called whenever
-// the "value" property of @adjustment2 changes, and will transform the
+// Vice versa, the fahrenheit_to_celsius function will be called whenever
+// the "value" property of adjustment2 changes, and will transform the
 // current value of the property before applying it to the "value" property
-// of @adjustment1.
-//
-// Note that #GBinding does not resolve cycles by itself; a cycle like
-//
-// ```
+// of adjustment1.
 //
-//	object1:propertyA -&gt; object2:propertyB
-//	object2:propertyB -&gt; object3:propertyC
-//	object3:propertyC -&gt; object1:propertyA
+// Note that [Binding] does not resolve cycles by itself; a cycle like
 //
-// ```
+//	object1:propertyA -> object2:propertyB
+//	object2:propertyB -> object3:propertyC
+//	object3:propertyC -> object1:propertyA
 //
 // might lead to an infinite loop. The loop, in this particular case,
 // can be avoided if the objects emit the `GObject::notify` signal only
 // if the value has effectively been changed. A binding is implemented
 // using the `GObject::notify` signal, so it is susceptible to all the
-// various ways of blocking a signal emission, like [func@GObject.signal_stop_emission]
-// or [func@GObject.signal_handler_block].
+// various ways of blocking a signal emission, like [SignalStopEmission]
+// or [SignalHandlerBlock].
 //
 // A binding will be severed, and the resources it allocates freed, whenever
 // either one of the `GObject` instances it refers to are finalized, or when
-// the #GBinding instance loses its last reference.
+// the [Binding] instance loses its last reference.
 //
 // Bindings for languages with garbage collection can use
-// [method@GObject.Binding.unbind] to explicitly release a binding between the source
+// [Binding.Unbind] to explicitly release a binding between the source
 // and target properties, instead of relying on the last reference on the
 // binding, source, and target instances to drop.
 type Binding struct {
@@ -150,11 +181,11 @@ func BindingNewFromInternalPtr(ptr uintptr) *Binding {
 
 var xBindingDupSource func(uintptr) uintptr
 
-// Retrieves the #GObject instance used as the source of the binding.
+// Retrieves the [Object] instance used as the source of the binding.
 //
-// A #GBinding can outlive the source #GObject as the binding does not hold a
+// A [Binding] can outlive the source [Object] as the binding does not hold a
 // strong reference to the source. If the source is destroyed before the
-// binding then this function will return %NULL.
+// binding then this function will return nil.
 func (x *Binding) DupSource() *Object {
 	var cls *Object
 
@@ -170,11 +201,11 @@ func (x *Binding) DupSource() *Object {
 
 var xBindingDupTarget func(uintptr) uintptr
 
-// Retrieves the #GObject instance used as the target of the binding.
+// Retrieves the [Object] instance used as the target of the binding.
 //
-// A #GBinding can outlive the target #GObject as the binding does not hold a
+// A [Binding] can outlive the target [Object] as the binding does not hold a
 // strong reference to the target. If the target is destroyed before the
-// binding then this function will return %NULL.
+// binding then this function will return nil.
 func (x *Binding) DupTarget() *Object {
 	var cls *Object
 
@@ -190,41 +221,16 @@ func (x *Binding) DupTarget() *Object {
 
 var xBindingGetFlags func(uintptr) BindingFlags
 
-// Retrieves the flags passed when constructing the #GBinding.
+// Retrieves the flags passed when constructing the [Binding].
 func (x *Binding) GetFlags() BindingFlags {
 
 	cret := xBindingGetFlags(x.GoPointer())
 	return cret
 }
 
-var xBindingGetSource func(uintptr) uintptr
-
-// Retrieves the #GObject instance used as the source of the binding.
-//
-// A #GBinding can outlive the source #GObject as the binding does not hold a
-// strong reference to the source. If the source is destroyed before the
-// binding then this function will return %NULL.
-//
-// Use g_binding_dup_source() if the source or binding are used from different
-// threads as otherwise the pointer returned from this function might become
-// invalid if the source is finalized from another thread in the meantime.
-func (x *Binding) GetSource() *Object {
-	var cls *Object
-
-	cret := xBindingGetSource(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	IncreaseRef(cret)
-	cls = &Object{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xBindingGetSourceProperty func(uintptr) string
 
-// Retrieves the name of the property of #GBinding:source used as the source
+// Retrieves the name of the property of GBinding:source used as the source
 // of the binding.
 func (x *Binding) GetSourceProperty() string {
 
@@ -232,34 +238,9 @@ func (x *Binding) GetSourceProperty() string {
 	return cret
 }
 
-var xBindingGetTarget func(uintptr) uintptr
-
-// Retrieves the #GObject instance used as the target of the binding.
-//
-// A #GBinding can outlive the target #GObject as the binding does not hold a
-// strong reference to the target. If the target is destroyed before the
-// binding then this function will return %NULL.
-//
-// Use g_binding_dup_target() if the target or binding are used from different
-// threads as otherwise the pointer returned from this function might become
-// invalid if the target is finalized from another thread in the meantime.
-func (x *Binding) GetTarget() *Object {
-	var cls *Object
-
-	cret := xBindingGetTarget(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	IncreaseRef(cret)
-	cls = &Object{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xBindingGetTargetProperty func(uintptr) string
 
-// Retrieves the name of the property of #GBinding:target used as the target
+// Retrieves the name of the property of GBinding:target used as the target
 // of the binding.
 func (x *Binding) GetTargetProperty() string {
 
@@ -270,16 +251,16 @@ func (x *Binding) GetTargetProperty() string {
 var xBindingUnbind func(uintptr)
 
 // Explicitly releases the binding between the source and the target
-// property expressed by @binding.
+// property expressed by binding.
 //
 // This function will release the reference that is being held on
-// the @binding instance if the binding is still bound; if you want to hold on
-// to the #GBinding instance after calling g_binding_unbind(), you will need
+// the binding instance if the binding is still bound; if you want to hold on
+// to the [Binding] instance after calling [Binding.Unbind], you will need
 // to hold a reference to it.
 //
-// Note however that this function does not take ownership of @binding, it
+// Note however that this function does not take ownership of binding, it
 // only unrefs the reference that was initially created by
-// g_object_bind_property() and is owned by the binding.
+// [Object.BindProperty] and is owned by the binding.
 func (x *Binding) Unbind() {
 
 	xBindingUnbind(x.GoPointer())
@@ -298,7 +279,7 @@ func (c *Binding) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertySourceProperty sets the "source-property" property.
-// The name of the property of #GBinding:source that should be used
+// The name of the property of GBinding:source that should be used
 // as the source of the binding.
 //
 // This should be in [canonical form][canonical-parameter-names] to get the
@@ -311,7 +292,7 @@ func (x *Binding) SetPropertySourceProperty(value string) {
 }
 
 // GetPropertySourceProperty gets the "source-property" property.
-// The name of the property of #GBinding:source that should be used
+// The name of the property of GBinding:source that should be used
 // as the source of the binding.
 //
 // This should be in [canonical form][canonical-parameter-names] to get the
@@ -323,7 +304,7 @@ func (x *Binding) GetPropertySourceProperty() string {
 }
 
 // SetPropertyTargetProperty sets the "target-property" property.
-// The name of the property of #GBinding:target that should be used
+// The name of the property of GBinding:target that should be used
 // as the target of the binding.
 //
 // This should be in [canonical form][canonical-parameter-names] to get the
@@ -336,7 +317,7 @@ func (x *Binding) SetPropertyTargetProperty(value string) {
 }
 
 // GetPropertyTargetProperty gets the "target-property" property.
-// The name of the property of #GBinding:target that should be used
+// The name of the property of GBinding:target that should be used
 // as the target of the binding.
 //
 // This should be in [canonical form][canonical-parameter-names] to get the
@@ -352,7 +333,7 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -363,13 +344,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xBindingGLibType, libs, "g_binding_get_type")
 
-	core.PuregoSafeRegister(&xBindingDupSource, libs, "g_binding_dup_source")
-	core.PuregoSafeRegister(&xBindingDupTarget, libs, "g_binding_dup_target")
-	core.PuregoSafeRegister(&xBindingGetFlags, libs, "g_binding_get_flags")
-	core.PuregoSafeRegister(&xBindingGetSource, libs, "g_binding_get_source")
-	core.PuregoSafeRegister(&xBindingGetSourceProperty, libs, "g_binding_get_source_property")
-	core.PuregoSafeRegister(&xBindingGetTarget, libs, "g_binding_get_target")
-	core.PuregoSafeRegister(&xBindingGetTargetProperty, libs, "g_binding_get_target_property")
-	core.PuregoSafeRegister(&xBindingUnbind, libs, "g_binding_unbind")
+	core.PuregoRegisterSince(&xBindingDupSource, libs, "g_binding_dup_source", "GOBJECT", "2.68")
+	core.PuregoRegisterSince(&xBindingDupTarget, libs, "g_binding_dup_target", "GOBJECT", "2.68")
+	core.PuregoRegisterSince(&xBindingGetFlags, libs, "g_binding_get_flags", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xBindingGetSourceProperty, libs, "g_binding_get_source_property", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xBindingGetTargetProperty, libs, "g_binding_get_target_property", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xBindingUnbind, libs, "g_binding_unbind", "GOBJECT", "2.38")
 
 }
@@ -0,0 +1,81 @@
+//go:build !puregotk_no_deprecated
+
+// Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gobject
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xBindingGetSource func(uintptr) uintptr
+
+// Retrieves the [Object] instance used as the source of the binding.
+//
+// A [Binding] can outlive the source [Object] as the binding does not hold a
+// strong reference to the source. If the source is destroyed before the
+// binding then this function will return nil.
+//
+// Use [Binding.DupSource] if the source or binding are used from different
+// threads as otherwise the pointer returned from this function might become
+// invalid if the source is finalized from another thread in the meantime.
+//
+// Deprecated: since 2.68. Use [Binding.DupSource] for a safer version of this
+// function.
+func (x *Binding) GetSource() *Object {
+	var cls *Object
+
+	cret := xBindingGetSource(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	IncreaseRef(cret)
+	cls = &Object{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xBindingGetTarget func(uintptr) uintptr
+
+// Retrieves the [Object] instance used as the target of the binding.
+//
+// A [Binding] can outlive the target [Object] as the binding does not hold a
+// strong reference to the target. If the target is destroyed before the
+// binding then this function will return nil.
+//
+// Use [Binding.DupTarget] if the target or binding are used from different
+// threads as otherwise the pointer returned from this function might become
+// invalid if the target is finalized from another thread in the meantime.
+//
+// Deprecated: since 2.68. Use [Binding.DupTarget] for a safer version of this
+// function.
+func (x *Binding) GetTarget() *Object {
+	var cls *Object
+
+	cret := xBindingGetTarget(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	IncreaseRef(cret)
+	cls = &Object{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xBindingGetSource, libs, "g_binding_get_source", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xBindingGetTarget, libs, "g_binding_get_target", "GOBJECT", "2.26")
+
+}
@@ -4,7 +4,6 @@ package gobject
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -16,7 +15,7 @@ import (
 // Use the various methods to bind properties from a single source
 // object to multiple destination objects. Properties can be bound
 // bidirectionally and are connected when the source object is set
-// with [method@GObject.BindingGroup.set_source].
+// with [BindingGroup.SetSource].
 type BindingGroup struct {
 	Object
 }
@@ -35,7 +34,7 @@ func BindingGroupNewFromInternalPtr(ptr uintptr) *BindingGroup {
 
 var xNewBindingGroup func() uintptr
 
-// Creates a new #GBindingGroup.
+// Creates a new [BindingGroup].
 func NewBindingGroup() *BindingGroup {
 	var cls *BindingGroup
 
@@ -51,12 +50,12 @@ func NewBindingGroup() *BindingGroup {
 
 var xBindingGroupBind func(uintptr, string, uintptr, string, BindingFlags)
 
-// Creates a binding between @source_property on the source object
-// and @target_property on @target. Whenever the @source_property
-// is changed the @target_property is updated using the same value.
-// The binding flag %G_BINDING_SYNC_CREATE is automatically specified.
+// Creates a binding between source_property on the source object
+// and target_property on target. Whenever the source_property
+// is changed the target_property is updated using the same value.
+// The binding flag [GBindingSyncCreateValue] is automatically specified.
 //
-// See g_object_bind_property() for more information.
+// See [Object.BindProperty] for more information.
 func (x *BindingGroup) Bind(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags) {
 
 	xBindingGroupBind(x.GoPointer(), SourcePropertyVar, TargetVar.GoPointer(), TargetPropertyVar, FlagsVar)
@@ -65,12 +64,14 @@ func (x *BindingGroup) Bind(SourcePropertyVar string, TargetVar *Object, TargetP
 
 var xBindingGroupBindFull func(uintptr, string, uintptr, string, BindingFlags, uintptr, uintptr, uintptr, uintptr)
 
-// Creates a binding between @source_property on the source object and
-// @target_property on @target, allowing you to set the transformation
+// Creates a binding between source_property on the source object and
+// target_property on target, allowing you to set the transformation
 // functions to be used by the binding. The binding flag
-// %G_BINDING_SYNC_CREATE is automatically specified.
+// [GBindingSyncCreateValue] is automatically specified.
 //
-// See g_object_bind_property_full() for more information.
+// See [Object.BindPropertyFull] for more information.
+//
+// Deprecated: use [BindWithClosures], the version of this function that language bindings are meant to use.
 func (x *BindingGroup) BindFull(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags, TransformToVar *BindingTransformFunc, TransformFromVar *BindingTransformFunc, UserDataVar uintptr, UserDataDestroyVar *glib.DestroyNotify) {
 
 	var TransformToVarRef uintptr
@@ -80,11 +81,12 @@ func (x *BindingGroup) BindFull(SourcePropertyVar string, TargetVar *Object, Tar
 			TransformToVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *TransformToVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			TransformToVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TransformToVarPtr, TransformToVarRef, TransformToVar)
+			TransformToVarRef = core.NewCallback(fcb)
+			TransformToVarRef = glib.SaveCallbackWithClosure(TransformToVarPtr, TransformToVarRef, TransformToVar)
 		}
 	}
 
@@ -95,11 +97,12 @@ func (x *BindingGroup) BindFull(SourcePropertyVar string, TargetVar *Object, Tar
 			TransformFromVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *TransformFromVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			TransformFromVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TransformFromVarPtr, TransformFromVarRef, TransformFromVar)
+			TransformFromVarRef = core.NewCallback(fcb)
+			TransformFromVarRef = glib.SaveCallbackWithClosure(TransformFromVarPtr, TransformFromVarRef, TransformFromVar)
 		}
 	}
 
@@ -110,11 +113,12 @@ func (x *BindingGroup) BindFull(SourcePropertyVar string, TargetVar *Object, Tar
 			UserDataDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataDestroyVar
 				cbFn(arg0)
 			}
-			UserDataDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataDestroyVarPtr, UserDataDestroyVarRef, UserDataDestroyVar)
+			UserDataDestroyVarRef = core.NewCallback(fcb)
+			UserDataDestroyVarRef = glib.SaveCallbackWithClosure(UserDataDestroyVarPtr, UserDataDestroyVarRef, UserDataDestroyVar)
 		}
 	}
 
@@ -124,16 +128,16 @@ func (x *BindingGroup) BindFull(SourcePropertyVar string, TargetVar *Object, Tar
 
 var xBindingGroupBindWithClosures func(uintptr, string, uintptr, string, BindingFlags, *Closure, *Closure)
 
-// Creates a binding between @source_property on the source object and
-// @target_property on @target, allowing you to set the transformation
+// Creates a binding between source_property on the source object and
+// target_property on target, allowing you to set the transformation
 // functions to be used by the binding. The binding flag
-// %G_BINDING_SYNC_CREATE is automatically specified.
+// [GBindingSyncCreateValue] is automatically specified.
 //
 // This function is the language bindings friendly version of
-// g_binding_group_bind_property_full(), using #GClosures
+// g_binding_group_bind_property_full(), using GClosures
 // instead of function pointers.
 //
-// See g_object_bind_property_with_closures() for more information.
+// See [Object.BindPropertyWithClosures] for more information.
 func (x *BindingGroup) BindWithClosures(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags, TransformToVar *Closure, TransformFromVar *Closure) {
 
 	xBindingGroupBindWithClosures(x.GoPointer(), SourcePropertyVar, TargetVar.GoPointer(), TargetPropertyVar, FlagsVar, TransformToVar, TransformFromVar)
@@ -159,11 +163,11 @@ func (x *BindingGroup) DupSource() *Object {
 
 var xBindingGroupSetSource func(uintptr, uintptr)
 
-// Sets @source as the source object used for creating property
+// Sets source as the source object used for creating property
 // bindings. If there is already a source object all bindings from it
 // will be removed.
 //
-// Note that all properties that have been bound must exist on @source.
+// Note that all properties that have been bound must exist on source.
 func (x *BindingGroup) SetSource(SourceVar *Object) {
 
 	xBindingGroupSetSource(x.GoPointer(), SourceVar.GoPointer())
@@ -186,7 +190,7 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -195,12 +199,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xBindingGroupGLibType, libs, "g_binding_group_get_type")
 
-	core.PuregoSafeRegister(&xNewBindingGroup, libs, "g_binding_group_new")
+	core.PuregoRegisterSince(&xNewBindingGroup, libs, "g_binding_group_new", "GOBJECT", "2.72")
 
-	core.PuregoSafeRegister(&xBindingGroupBind, libs, "g_binding_group_bind")
-	core.PuregoSafeRegister(&xBindingGroupBindFull, libs, "g_binding_group_bind_full")
-	core.PuregoSafeRegister(&xBindingGroupBindWithClosures, libs, "g_binding_group_bind_with_closures")
-	core.PuregoSafeRegister(&xBindingGroupDupSource, libs, "g_binding_group_dup_source")
-	core.PuregoSafeRegister(&xBindingGroupSetSource, libs, "g_binding_group_set_source")
+	core.PuregoRegisterSince(&xBindingGroupBind, libs, "g_binding_group_bind", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xBindingGroupBindFull, libs, "g_binding_group_bind_full", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xBindingGroupBindWithClosures, libs, "g_binding_group_bind_with_closures", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xBindingGroupDupSource, libs, "g_binding_group_dup_source", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xBindingGroupSetSource, libs, "g_binding_group_set_source", "GOBJECT", "2.72")
 
 }
@@ -4,7 +4,6 @@ package gobject
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -20,7 +19,7 @@ type BoxedFreeFunc func(uintptr)
 
 var xBoxedCopy func(types.GType, uintptr) uintptr
 
-// Provide a copy of a boxed structure @src_boxed which is of type @boxed_type.
+// Provide a copy of a boxed structure src_boxed which is of type boxed_type.
 func BoxedCopy(BoxedTypeVar types.GType, SrcBoxedVar uintptr) uintptr {
 
 	cret := xBoxedCopy(BoxedTypeVar, SrcBoxedVar)
@@ -29,7 +28,7 @@ func BoxedCopy(BoxedTypeVar types.GType, SrcBoxedVar uintptr) uintptr {
 
 var xBoxedFree func(types.GType, uintptr)
 
-// Free the boxed structure @boxed which is of type @boxed_type.
+// Free the boxed structure boxed which is of type boxed_type.
 func BoxedFree(BoxedTypeVar types.GType, BoxedVar uintptr) {
 
 	xBoxedFree(BoxedTypeVar, BoxedVar)
@@ -38,14 +37,14 @@ func BoxedFree(BoxedTypeVar types.GType, BoxedVar uintptr) {
 
 var xBoxedTypeRegisterStatic func(string, uintptr, uintptr) types.GType
 
-// This function creates a new %G_TYPE_BOXED derived type id for a new
-// boxed type with name @name.
+// This function creates a new G_TYPE_BOXED derived type id for a new
+// boxed type with name name.
 //
 // Boxed type handling functions have to be provided to copy and free
 // opaque boxed structures of this type.
 //
 // For the general case, it is recommended to use G_DEFINE_BOXED_TYPE()
-// instead of calling g_boxed_type_register_static() directly. The macro
+// instead of calling [BoxedTypeRegisterStatic] directly. The macro
 // will create the appropriate `*_get_type()` function for the boxed type.
 func BoxedTypeRegisterStatic(NameVar string, BoxedCopyVar *BoxedCopyFunc, BoxedFreeVar *BoxedFreeFunc) types.GType {
 
@@ -56,11 +55,12 @@ func BoxedTypeRegisterStatic(NameVar string, BoxedCopyVar *BoxedCopyFunc, BoxedF
 			BoxedCopyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *BoxedCopyVar
 				return cbFn(arg0)
 			}
-			BoxedCopyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(BoxedCopyVarPtr, BoxedCopyVarRef, BoxedCopyVar)
+			BoxedCopyVarRef = core.NewCallback(fcb)
+			BoxedCopyVarRef = glib.SaveCallbackWithClosure(BoxedCopyVarPtr, BoxedCopyVarRef, BoxedCopyVar)
 		}
 	}
 
@@ -71,11 +71,12 @@ func BoxedTypeRegisterStatic(NameVar string, BoxedCopyVar *BoxedCopyFunc, BoxedF
 			BoxedFreeVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *BoxedFreeVar
 				cbFn(arg0)
 			}
-			BoxedFreeVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(BoxedFreeVarPtr, BoxedFreeVarRef, BoxedFreeVar)
+			BoxedFreeVarRef = core.NewCallback(fcb)
+			BoxedFreeVarRef = glib.SaveCallbackWithClosure(BoxedFreeVarPtr, BoxedFreeVarRef, BoxedFreeVar)
 		}
 	}
 
@@ -88,15 +89,15 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBoxedCopy, libs, "g_boxed_copy")
-	core.PuregoSafeRegister(&xBoxedFree, libs, "g_boxed_free")
-	core.PuregoSafeRegister(&xBoxedTypeRegisterStatic, libs, "g_boxed_type_register_static")
+	core.PuregoRegisterSince(&xBoxedCopy, libs, "g_boxed_copy", "GOBJECT", "")
+	core.PuregoRegisterSince(&xBoxedFree, libs, "g_boxed_free", "GOBJECT", "")
+	core.PuregoRegisterSince(&xBoxedTypeRegisterStatic, libs, "g_boxed_type_register_static", "GOBJECT", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -18,7 +17,7 @@ import (
 // return void. The required signature of a callback function is determined by
 // the context in which is used (e.g. the signal to which it is connected).
 //
-// Use G_CALLBACK() to cast the callback function to a #GCallback.
+// Use G_CALLBACK() to cast the callback function to a [Callback].
 type Callback func()
 
 // The type used for marshaller functions.
@@ -33,11 +32,11 @@ type ClosureNotify func(uintptr, *Closure)
 // marshalling the signal argument into GValues.
 type VaClosureMarshal func(*Closure, *Value, *TypeInstance, []interface{}, uintptr, int, []types.GType)
 
-// A #GCClosure is a specialization of #GClosure for C function callbacks.
+// A [CClosure] is a specialization of [Closure] for C function callbacks.
 type CClosure struct {
 	_ structs.HostLayout
 
-	Closure uintptr
+	Closure Closure
 
 	Callback uintptr
 }
@@ -50,29 +49,29 @@ func (x *CClosure) GoPointer() uintptr {
 //
 // It will generally comprise a function of some kind and a marshaller
 // used to call it. It is the responsibility of the marshaller to
-// convert the arguments for the invocation from #GValues into
+// convert the arguments for the invocation from GValues into
 // a suitable form, perform the callback on the converted arguments,
-// and transform the return value back into a #GValue.
+// and transform the return value back into a [Value].
 //
 // In the case of C programs, a closure usually just holds a pointer
 // to a function and maybe a data argument, and the marshaller
-// converts between #GValue and native C types. The GObject
-// library provides the #GCClosure type for this purpose. Bindings for
-// other languages need marshallers which convert between #GValues
+// converts between [Value] and native C types. The GObject
+// library provides the [CClosure] type for this purpose. Bindings for
+// other languages need marshallers which convert between GValues
 // and suitable representations in the runtime of the language in
 // order to use functions written in that language as callbacks. Use
-// g_closure_set_marshal() to set the marshaller on such a custom
+// [Closure.SetMarshal] to set the marshaller on such a custom
 // closure implementation.
 //
 // Within GObject, closures play an important role in the
 // implementation of signals. When a signal is registered, the
-// @c_marshaller argument to g_signal_new() specifies the default C
+// c_marshaller argument to [SignalNew] specifies the default C
 // marshaller for any closure which is connected to this
 // signal. GObject provides a number of C marshallers for this
 // purpose, see the g_cclosure_marshal_*() functions. Additional C
 // marshallers can be generated with the [glib-genmarshal][glib-genmarshal]
 // utility.  Closures can be explicitly connected to signals with
-// g_signal_connect_closure(), but it usually more convenient to let
+// [SignalConnectClosure], but it usually more convenient to let
 // GObject create a closure automatically by using one of the
 // g_signal_connect_*() functions which take a callback function/user
 // data pair.
@@ -84,11 +83,11 @@ func (x *CClosure) GoPointer() uintptr {
 //     which means that language bindings don't have to write individual glue
 //     for each callback type.
 //
-//   - The reference counting of #GClosure makes it easy to handle reentrancy
+//   - The reference counting of [Closure] makes it easy to handle reentrancy
 //     right; if a callback is removed while it is being invoked, the closure
 //     and its parameters won't be freed until the invocation finishes.
 //
-//   - g_closure_invalidate() and invalidation notifiers allow callbacks to be
+//   - [Closure.Invalidate] and invalidation notifiers allow callbacks to be
 //     automatically removed when the objects they point to go away.
 type Closure struct {
 	_ structs.HostLayout
@@ -132,9 +131,9 @@ func (x *Closure) GoPointer() uintptr {
 
 var xNewClosureObject func(uint, uintptr) *Closure
 
-// A variant of g_closure_new_simple() which stores @object in the
-// @data field of the closure and calls g_object_watch_closure() on
-// @object and the created closure. This function is mainly useful
+// A variant of [NewClosureSimple] which stores object in the
+// data field of the closure and calls [Object.WatchClosure] on
+// object and the created closure. This function is mainly useful
 // when implementing new types of closures.
 func NewClosureObject(SizeofClosureVar uint, ObjectVar *Object) *Closure {
 
@@ -145,32 +144,27 @@ func NewClosureObject(SizeofClosureVar uint, ObjectVar *Object) *Closure {
 var xNewClosureSimple func(uint, uintptr) *Closure
 
 // Allocates a struct of the given size and initializes the initial
-// part as a #GClosure.
+// part as a [Closure].
 //
 // This function is mainly useful when implementing new types of closures:
 //
-// |[&lt;!-- language="C" --&gt;
-// typedef struct _MyClosure MyClosure;
-// struct _MyClosure
-//
+//	typedef struct _MyClosure MyClosure;
+//	struct _MyClosure
 //	{
 //	  GClosure closure;
 //	  // extra data goes here
 //	};
 //
-// static void
-// my_closure_finalize (gpointer  notify_data,
-//
-//	GClosure *closure)
-//
+//	static void
+//	my_closure_finalize (gpointer  notify_data,
+//	                     GClosure *closure)
 //	{
 //	  MyClosure *my_closure = (MyClosure *)closure;
 //
 //	  // free extra data here
 //	}
 //
-// MyClosure *my_closure_new (gpointer data)
-//
+//	MyClosure *my_closure_new (gpointer data)
 //	{
 //	  GClosure *closure;
 //	  MyClosure *my_closure;
@@ -184,8 +178,6 @@ var xNewClosureSimple func(uint, uintptr) *Closure
 //	                                   my_closure_finalize);
 //	  return my_closure;
 //	}
-//
-// ]|
 func NewClosureSimple(SizeofClosureVar uint, DataVar uintptr) *Closure {
 
 	cret := xNewClosureSimple(SizeofClosureVar, DataVar)
@@ -195,10 +187,10 @@ func NewClosureSimple(SizeofClosureVar uint, DataVar uintptr) *Closure {
 var xClosureAddFinalizeNotifier func(uintptr, uintptr, uintptr)
 
 // Registers a finalization notifier which will be called when the
-// reference count of @closure goes down to 0.
+// reference count of closure goes down to 0.
 //
 // Multiple finalization notifiers on a single closure are invoked in
-// unspecified order. If a single call to g_closure_unref() results in
+// unspecified order. If a single call to [Closure.Unref] results in
 // the closure being both invalidated and finalized, then the invalidate
 // notifiers will be run before the finalize notifiers.
 func (x *Closure) AddFinalizeNotifier(NotifyDataVar uintptr, NotifyFuncVar *ClosureNotify) {
@@ -210,11 +202,12 @@ func (x *Closure) AddFinalizeNotifier(NotifyDataVar uintptr, NotifyFuncVar *Clos
 			NotifyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyFuncVar
 				cbFn(arg0, arg1)
 			}
-			NotifyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
+			NotifyFuncVarRef = core.NewCallback(fcb)
+			NotifyFuncVarRef = glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
 		}
 	}
 
@@ -225,7 +218,7 @@ func (x *Closure) AddFinalizeNotifier(NotifyDataVar uintptr, NotifyFuncVar *Clos
 var xClosureAddInvalidateNotifier func(uintptr, uintptr, uintptr)
 
 // Registers an invalidation notifier which will be called when the
-// @closure is invalidated with g_closure_invalidate().
+// closure is invalidated with [Closure.Invalidate].
 //
 // Invalidation notifiers are invoked before finalization notifiers,
 // in an unspecified order.
@@ -238,11 +231,12 @@ func (x *Closure) AddInvalidateNotifier(NotifyDataVar uintptr, NotifyFuncVar *Cl
 			NotifyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyFuncVar
 				cbFn(arg0, arg1)
 			}
-			NotifyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
+			NotifyFuncVarRef = core.NewCallback(fcb)
+			NotifyFuncVarRef = glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
 		}
 	}
 
@@ -256,7 +250,7 @@ var xClosureAddMarshalGuards func(uintptr, uintptr, uintptr, uintptr, uintptr)
 // closure callback, respectively.
 //
 // This is typically used to protect the extra arguments for the
-// duration of the callback. See g_object_watch_closure() for an
+// duration of the callback. See [Object.WatchClosure] for an
 // example of marshal guards.
 func (x *Closure) AddMarshalGuards(PreMarshalDataVar uintptr, PreMarshalNotifyVar *ClosureNotify, PostMarshalDataVar uintptr, PostMarshalNotifyVar *ClosureNotify) {
 
@@ -267,11 +261,12 @@ func (x *Closure) AddMarshalGuards(PreMarshalDataVar uintptr, PreMarshalNotifyVa
 			PreMarshalNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *PreMarshalNotifyVar
 				cbFn(arg0, arg1)
 			}
-			PreMarshalNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(PreMarshalNotifyVarPtr, PreMarshalNotifyVarRef, PreMarshalNotifyVar)
+			PreMarshalNotifyVarRef = core.NewCallback(fcb)
+			PreMarshalNotifyVarRef = glib.SaveCallbackWithClosure(PreMarshalNotifyVarPtr, PreMarshalNotifyVarRef, PreMarshalNotifyVar)
 		}
 	}
 
@@ -282,11 +277,12 @@ func (x *Closure) AddMarshalGuards(PreMarshalDataVar uintptr, PreMarshalNotifyVa
 			PostMarshalNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *PostMarshalNotifyVar
 				cbFn(arg0, arg1)
 			}
-			PostMarshalNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(PostMarshalNotifyVarPtr, PostMarshalNotifyVarRef, PostMarshalNotifyVar)
+			PostMarshalNotifyVarRef = core.NewCallback(fcb)
+			PostMarshalNotifyVarRef = glib.SaveCallbackWithClosure(PostMarshalNotifyVarPtr, PostMarshalNotifyVarRef, PostMarshalNotifyVar)
 		}
 	}
 
@@ -298,17 +294,17 @@ var xClosureInvalidate func(uintptr)
 
 // Sets a flag on the closure to indicate that its calling
 // environment has become invalid, and thus causes any future
-// invocations of g_closure_invoke() on this @closure to be
+// invocations of [Closure.Invoke] on this closure to be
 // ignored.
 //
 // Also, invalidation notifiers installed on the closure will
 // be called at this point. Note that unless you are holding a
 // reference to the closure yourself, the invalidation notifiers may
 // unref the closure and cause it to be destroyed, so if you need to
-// access the closure after calling g_closure_invalidate(), make sure
-// that you've previously called g_closure_ref().
+// access the closure after calling [Closure.Invalidate], make sure
+// that you've previously called [Closure.Ref].
 //
-// Note that g_closure_invalidate() will also be called when the
+// Note that [Closure.Invalidate] will also be called when the
 // reference count of a closure drops to zero (unless it has already
 // been invalidated before).
 func (x *Closure) Invalidate() {
@@ -319,7 +315,7 @@ func (x *Closure) Invalidate() {
 
 var xClosureInvoke func(uintptr, *Value, uint, []Value, uintptr)
 
-// Invokes the closure, i.e. executes the callback represented by the @closure.
+// Invokes the closure, i.e. executes the callback represented by the closure.
 func (x *Closure) Invoke(ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar []Value, InvocationHintVar uintptr) {
 
 	xClosureInvoke(x.GoPointer(), ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar)
@@ -350,11 +346,12 @@ func (x *Closure) RemoveFinalizeNotifier(NotifyDataVar uintptr, NotifyFuncVar *C
 			NotifyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyFuncVar
 				cbFn(arg0, arg1)
 			}
-			NotifyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
+			NotifyFuncVarRef = core.NewCallback(fcb)
+			NotifyFuncVarRef = glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
 		}
 	}
 
@@ -376,11 +373,12 @@ func (x *Closure) RemoveInvalidateNotifier(NotifyDataVar uintptr, NotifyFuncVar
 			NotifyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyFuncVar
 				cbFn(arg0, arg1)
 			}
-			NotifyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
+			NotifyFuncVarRef = core.NewCallback(fcb)
+			NotifyFuncVarRef = glib.SaveCallbackWithClosure(NotifyFuncVarPtr, NotifyFuncVarRef, NotifyFuncVar)
 		}
 	}
 
@@ -390,16 +388,16 @@ func (x *Closure) RemoveInvalidateNotifier(NotifyDataVar uintptr, NotifyFuncVar
 
 var xClosureSetMarshal func(uintptr, uintptr)
 
-// Sets the marshaller of @closure.
+// Sets the marshaller of closure.
 //
-// The `marshal_data` of @marshal provides a way for a meta marshaller to
+// The `marshal_data` of marshal provides a way for a meta marshaller to
 // provide additional information to the marshaller.
 //
 // For GObject's C predefined marshallers (the `g_cclosure_marshal_*()`
 // functions), what it provides is a callback function to use instead of
-// @closure-&gt;callback.
+// closure->callback.
 //
-// See also: g_closure_set_meta_marshal()
+// See also: [Closure.SetMetaMarshal]
 func (x *Closure) SetMarshal(MarshalVar *ClosureMarshal) {
 
 	var MarshalVarRef uintptr
@@ -409,11 +407,12 @@ func (x *Closure) SetMarshal(MarshalVar *ClosureMarshal) {
 			MarshalVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Closure, arg1 *Value, arg2 uint, arg3 []Value, arg4 uintptr, arg5 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *MarshalVar
 				cbFn(arg0, arg1, arg2, arg3, arg4, arg5)
 			}
-			MarshalVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MarshalVarPtr, MarshalVarRef, MarshalVar)
+			MarshalVarRef = core.NewCallback(fcb)
+			MarshalVarRef = glib.SaveCallbackWithClosure(MarshalVarPtr, MarshalVarRef, MarshalVar)
 		}
 	}
 
@@ -423,23 +422,23 @@ func (x *Closure) SetMarshal(MarshalVar *ClosureMarshal) {
 
 var xClosureSetMetaMarshal func(uintptr, uintptr, uintptr)
 
-// Sets the meta marshaller of @closure.
+// Sets the meta marshaller of closure.
 //
-// A meta marshaller wraps the @closure's marshal and modifies the way
+// A meta marshaller wraps the closure's marshal and modifies the way
 // it is called in some fashion. The most common use of this facility
 // is for C callbacks.
 //
 // The same marshallers (generated by [glib-genmarshal][glib-genmarshal]),
 // are used everywhere, but the way that we get the callback function
-// differs. In most cases we want to use the @closure's callback, but in
+// differs. In most cases we want to use the closure's callback, but in
 // other cases we want to use some different technique to retrieve the
 // callback function.
 //
 // For example, class closures for signals (see
-// g_signal_type_cclosure_new()) retrieve the callback function from a
+// [SignalTypeCclosureNew]) retrieve the callback function from a
 // fixed offset in the class structure.  The meta marshaller retrieves
 // the right callback and passes it to the marshaller as the
-// @marshal_data argument.
+// marshal_data argument.
 func (x *Closure) SetMetaMarshal(MarshalDataVar uintptr, MetaMarshalVar *ClosureMarshal) {
 
 	var MetaMarshalVarRef uintptr
@@ -449,11 +448,12 @@ func (x *Closure) SetMetaMarshal(MarshalDataVar uintptr, MetaMarshalVar *Closure
 			MetaMarshalVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Closure, arg1 *Value, arg2 uint, arg3 []Value, arg4 uintptr, arg5 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *MetaMarshalVar
 				cbFn(arg0, arg1, arg2, arg3, arg4, arg5)
 			}
-			MetaMarshalVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MetaMarshalVarPtr, MetaMarshalVarRef, MetaMarshalVar)
+			MetaMarshalVarRef = core.NewCallback(fcb)
+			MetaMarshalVarRef = glib.SaveCallbackWithClosure(MetaMarshalVarPtr, MetaMarshalVarRef, MetaMarshalVar)
 		}
 	}
 
@@ -470,32 +470,26 @@ var xClosureSink func(uintptr)
 //
 // This function checks to see if the object is still floating, and if so,
 // unsets the floating state and decreases the reference count. If the
-// closure is not floating, g_closure_sink() does nothing.
+// closure is not floating, [Closure.Sink] does nothing.
 //
 // The reason for the existence of the floating state is to prevent
 // cumbersome code sequences like:
 //
-// |[&lt;!-- language="C" --&gt;
-// closure = g_cclosure_new (cb_func, cb_data);
-// g_source_set_closure (source, closure);
-// g_closure_unref (closure); // GObject doesn't really need this
-// ]|
+//	closure = g_cclosure_new (cb_func, cb_data);
+//	g_source_set_closure (source, closure);
+//	g_closure_unref (closure); // GObject doesn't really need this
 //
-// Because g_source_set_closure() (and similar functions) take ownership of the
+// Because [SourceSetClosure] (and similar functions) take ownership of the
 // initial reference count, if it is unowned, we instead can write:
 //
-// |[&lt;!-- language="C" --&gt;
-// g_source_set_closure (source, g_cclosure_new (cb_func, cb_data));
-// ]|
+//	g_source_set_closure (source, g_cclosure_new (cb_func, cb_data));
 //
-// Generally, this function is used together with g_closure_ref(). An example
+// Generally, this function is used together with [Closure.Ref]. An example
 // of storing a closure for later notification looks like:
 //
-// |[&lt;!-- language="C" --&gt;
-// static GClosure *notify_closure = NULL;
-// void
-// foo_notify_set_closure (GClosure *closure)
-//
+//	static GClosure *notify_closure = NULL;
+//	void
+//	foo_notify_set_closure (GClosure *closure)
 //	{
 //	  if (notify_closure)
 //	    g_closure_unref (notify_closure);
@@ -507,11 +501,9 @@ var xClosureSink func(uintptr)
 //	    }
 //	}
 //
-// ]|
-//
-// Because g_closure_sink() may decrement the reference count of a closure
-// (if it hasn't been called on @closure yet) just like g_closure_unref(),
-// g_closure_ref() should be called prior to this function.
+// Because [Closure.Sink] may decrement the reference count of a closure
+// (if it hasn't been called on closure yet) just like [Closure.Unref],
+// [Closure.Ref] should be called prior to this function.
 func (x *Closure) Sink() {
 
 	xClosureSink(x.GoPointer())
@@ -536,7 +528,8 @@ func (x *Closure) OverrideMarshal(cb func(*Closure, *Value, uint, *Value, uintpt
 	if cb == nil {
 		x.xMarshal = 0
 	} else {
-		x.xMarshal = purego.NewCallback(func(ClosureVarp *Closure, ReturnValueVarp *Value, NParamValuesVarp uint, ParamValuesVarp *Value, InvocationHintVarp uintptr, MarshalDataVarp uintptr) {
+		x.xMarshal = core.NewCallback(func(ClosureVarp *Closure, ReturnValueVarp *Value, NParamValuesVarp uint, ParamValuesVarp *Value, InvocationHintVarp uintptr, MarshalDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ClosureVarp, ReturnValueVarp, NParamValuesVarp, ParamValuesVarp, InvocationHintVarp, MarshalDataVarp)
 		})
 	}
@@ -548,7 +541,7 @@ func (x *Closure) GetMarshal() func(*Closure, *Value, uint, *Value, uintptr, uin
 		return nil
 	}
 	var rawCallback func(ClosureVarp *Closure, ReturnValueVarp *Value, NParamValuesVarp uint, ParamValuesVarp *Value, InvocationHintVarp uintptr, MarshalDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMarshal)
+	core.RegisterFunc(&rawCallback, x.xMarshal)
 	return func(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 		rawCallback(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 	}
@@ -566,26 +559,41 @@ func (x *ClosureNotifyData) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xCclosureMarshalGeneric func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalGeneric func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
 // A generic marshaller function implemented via
-// [libffi](http://sourceware.org/libffi/).
+// [libffi].
 //
-// Normally this function is not passed explicitly to g_signal_new(),
-// but used automatically by GLib when specifying a %NULL marshaller.
-func CclosureMarshalGeneric(ClosureVar *Closure, ReturnGvalueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+// Normally this function is not passed explicitly to [SignalNew],
+// but used automatically by GLib when specifying a nil marshaller.
+//
+// [libffi]: http://sourceware.org/libffi/
+func CClosureMarshalGeneric(ClosureVar *Closure, ReturnGvalueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalGeneric(ClosureVar, ReturnGvalueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
-	xCclosureMarshalGeneric(ClosureVar, ReturnGvalueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
+// A generic marshaller function implemented via
+// [libffi].
+//
+// Normally this function is not passed explicitly to [SignalNew],
+// but used automatically by GLib when specifying a nil marshaller.
+//
+// Deprecated: use CClosureMarshalGeneric, this name of g_cclosure_marshal_generic is kept for compatibility.
+//
+// [libffi]: http://sourceware.org/libffi/
+func CclosureMarshalGeneric(ClosureVar *Closure, ReturnGvalueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalGeneric(ClosureVar, ReturnGvalueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureNew func(uintptr, uintptr, uintptr) *Closure
+var xCClosureNew func(uintptr, uintptr, uintptr) *Closure
 
-// Creates a new closure which invokes @callback_func with @user_data as
+// Creates a new closure which invokes callback_func with user_data as
 // the last parameter.
 //
-// @destroy_data will be called as a finalize notifier on the #GClosure.
-func CclosureNew(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
+// destroy_data will be called as a finalize notifier on the [Closure].
+func CClosureNew(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
 
 	var CallbackFuncVarRef uintptr
 	if CallbackFuncVar != nil {
@@ -594,11 +602,12 @@ func CclosureNew(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar
 			CallbackFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackFuncVar
 				cbFn()
 			}
-			CallbackFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
+			CallbackFuncVarRef = core.NewCallback(fcb)
+			CallbackFuncVarRef = glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
 		}
 	}
 
@@ -609,25 +618,36 @@ func CclosureNew(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar
 			DestroyDataVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyDataVar
 				cbFn(arg0, arg1)
 			}
-			DestroyDataVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
+			DestroyDataVarRef = core.NewCallback(fcb)
+			DestroyDataVarRef = glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
 		}
 	}
 
-	cret := xCclosureNew(CallbackFuncVarRef, UserDataVar, DestroyDataVarRef)
+	cret := xCClosureNew(CallbackFuncVarRef, UserDataVar, DestroyDataVarRef)
 	return cret
 }
 
-var xCclosureNewSwap func(uintptr, uintptr, uintptr) *Closure
+// Creates a new closure which invokes callback_func with user_data as
+// the last parameter.
+//
+// destroy_data will be called as a finalize notifier on the [Closure].
+//
+// Deprecated: use CClosureNew, this name of g_cclosure_new is kept for compatibility.
+func CclosureNew(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
+	return CClosureNew(CallbackFuncVar, UserDataVar, DestroyDataVar)
+}
+
+var xCClosureNewSwap func(uintptr, uintptr, uintptr) *Closure
 
-// Creates a new closure which invokes @callback_func with @user_data as
+// Creates a new closure which invokes callback_func with user_data as
 // the first parameter.
 //
-// @destroy_data will be called as a finalize notifier on the #GClosure.
-func CclosureNewSwap(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
+// destroy_data will be called as a finalize notifier on the [Closure].
+func CClosureNewSwap(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
 
 	var CallbackFuncVarRef uintptr
 	if CallbackFuncVar != nil {
@@ -636,11 +656,12 @@ func CclosureNewSwap(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyData
 			CallbackFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackFuncVar
 				cbFn()
 			}
-			CallbackFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
+			CallbackFuncVarRef = core.NewCallback(fcb)
+			CallbackFuncVarRef = glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
 		}
 	}
 
@@ -651,23 +672,34 @@ func CclosureNewSwap(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyData
 			DestroyDataVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyDataVar
 				cbFn(arg0, arg1)
 			}
-			DestroyDataVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
+			DestroyDataVarRef = core.NewCallback(fcb)
+			DestroyDataVarRef = glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
 		}
 	}
 
-	cret := xCclosureNewSwap(CallbackFuncVarRef, UserDataVar, DestroyDataVarRef)
+	cret := xCClosureNewSwap(CallbackFuncVarRef, UserDataVar, DestroyDataVarRef)
 	return cret
 }
 
+// Creates a new closure which invokes callback_func with user_data as
+// the first parameter.
+//
+// destroy_data will be called as a finalize notifier on the [Closure].
+//
+// Deprecated: use CClosureNewSwap, this name of g_cclosure_new_swap is kept for compatibility.
+func CclosureNewSwap(CallbackFuncVar *Callback, UserDataVar uintptr, DestroyDataVar *ClosureNotify) *Closure {
+	return CClosureNewSwap(CallbackFuncVar, UserDataVar, DestroyDataVar)
+}
+
 var xSignalTypeCclosureNew func(types.GType, uint) *Closure
 
 // Creates a new closure which invokes the function found at the offset
-// @struct_offset in the class structure of the interface or classed type
-// identified by @itype.
+// struct_offset in the class structure of the interface or classed type
+// identified by itype.
 func SignalTypeCclosureNew(ItypeVar types.GType, StructOffsetVar uint) *Closure {
 
 	cret := xSignalTypeCclosureNew(ItypeVar, StructOffsetVar)
@@ -679,34 +711,34 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCclosureMarshalGeneric, libs, "g_cclosure_marshal_generic")
-	core.PuregoSafeRegister(&xCclosureNew, libs, "g_cclosure_new")
-	core.PuregoSafeRegister(&xCclosureNewSwap, libs, "g_cclosure_new_swap")
-	core.PuregoSafeRegister(&xSignalTypeCclosureNew, libs, "g_signal_type_cclosure_new")
+	core.PuregoRegisterSince(&xCClosureMarshalGeneric, libs, "g_cclosure_marshal_generic", "GOBJECT", "2.30")
+	core.PuregoRegisterSince(&xCClosureNew, libs, "g_cclosure_new", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureNewSwap, libs, "g_cclosure_new_swap", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalTypeCclosureNew, libs, "g_signal_type_cclosure_new", "GOBJECT", "")
 
 	core.PuregoSafeRegister(&xClosureGLibType, libs, "g_closure_get_type")
 
-	core.PuregoSafeRegister(&xNewClosureObject, libs, "g_closure_new_object")
-	core.PuregoSafeRegister(&xNewClosureSimple, libs, "g_closure_new_simple")
-
-	core.PuregoSafeRegister(&xClosureAddFinalizeNotifier, libs, "g_closure_add_finalize_notifier")
-	core.PuregoSafeRegister(&xClosureAddInvalidateNotifier, libs, "g_closure_add_invalidate_notifier")
-	core.PuregoSafeRegister(&xClosureAddMarshalGuards, libs, "g_closure_add_marshal_guards")
-	core.PuregoSafeRegister(&xClosureInvalidate, libs, "g_closure_invalidate")
-	core.PuregoSafeRegister(&xClosureInvoke, libs, "g_closure_invoke")
-	core.PuregoSafeRegister(&xClosureRef, libs, "g_closure_ref")
-	core.PuregoSafeRegister(&xClosureRemoveFinalizeNotifier, libs, "g_closure_remove_finalize_notifier")
-	core.PuregoSafeRegister(&xClosureRemoveInvalidateNotifier, libs, "g_closure_remove_invalidate_notifier")
-	core.PuregoSafeRegister(&xClosureSetMarshal, libs, "g_closure_set_marshal")
-	core.PuregoSafeRegister(&xClosureSetMetaMarshal, libs, "g_closure_set_meta_marshal")
-	core.PuregoSafeRegister(&xClosureSink, libs, "g_closure_sink")
-	core.PuregoSafeRegister(&xClosureUnref, libs, "g_closure_unref")
+	core.PuregoRegisterSince(&xNewClosureObject, libs, "g_closure_new_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewClosureSimple, libs, "g_closure_new_simple", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xClosureAddFinalizeNotifier, libs, "g_closure_add_finalize_notifier", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureAddInvalidateNotifier, libs, "g_closure_add_invalidate_notifier", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureAddMarshalGuards, libs, "g_closure_add_marshal_guards", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureInvalidate, libs, "g_closure_invalidate", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureInvoke, libs, "g_closure_invoke", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureRef, libs, "g_closure_ref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureRemoveFinalizeNotifier, libs, "g_closure_remove_finalize_notifier", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureRemoveInvalidateNotifier, libs, "g_closure_remove_invalidate_notifier", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureSetMarshal, libs, "g_closure_set_marshal", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureSetMetaMarshal, libs, "g_closure_set_meta_marshal", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureSink, libs, "g_closure_sink", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClosureUnref, libs, "g_closure_unref", "GOBJECT", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -15,7 +14,7 @@ import (
 type EnumClass struct {
 	_ structs.HostLayout
 
-	GTypeClass uintptr
+	GTypeClass TypeClass
 
 	Minimum int32
 
@@ -51,7 +50,7 @@ func (x *EnumValue) GoPointer() uintptr {
 type FlagsClass struct {
 	_ structs.HostLayout
 
-	GTypeClass uintptr
+	GTypeClass TypeClass
 
 	Mask uint
 
@@ -83,17 +82,14 @@ func (x *FlagsValue) GoPointer() uintptr {
 var xEnumCompleteTypeInfo func(types.GType, *TypeInfo, []EnumValue)
 
 // This function is meant to be called from the `complete_type_info`
-// function of a #GTypePlugin implementation, as in the following
+// function of a [TypePlugin] implementation, as in the following
 // example:
 //
-// |[&lt;!-- language="C" --&gt;
-// static void
-// my_enum_complete_type_info (GTypePlugin     *plugin,
-//
-//	GType            g_type,
-//	GTypeInfo       *info,
-//	GTypeValueTable *value_table)
-//
+//	static void
+//	my_enum_complete_type_info (GTypePlugin     *plugin,
+//	                            GType            g_type,
+//	                            GTypeInfo       *info,
+//	                            GTypeValueTable *value_table)
 //	{
 //	  static const GEnumValue values[] = {
 //	    { MY_ENUM_FOO, "MY_ENUM_FOO", "foo" },
@@ -103,8 +99,6 @@ var xEnumCompleteTypeInfo func(types.GType, *TypeInfo, []EnumValue)
 //
 //	  g_enum_complete_type_info (type, info, values);
 //	}
-//
-// ]|
 func EnumCompleteTypeInfo(GEnumTypeVar types.GType, InfoVar *TypeInfo, ConstValuesVar []EnumValue) {
 
 	xEnumCompleteTypeInfo(GEnumTypeVar, InfoVar, ConstValuesVar)
@@ -113,7 +107,7 @@ func EnumCompleteTypeInfo(GEnumTypeVar types.GType, InfoVar *TypeInfo, ConstValu
 
 var xEnumGetValue func(*EnumClass, int) *EnumValue
 
-// Returns the #GEnumValue for a value.
+// Returns the [EnumValue] for a value.
 func EnumGetValue(EnumClassVar *EnumClass, ValueVar int) *EnumValue {
 
 	cret := xEnumGetValue(EnumClassVar, ValueVar)
@@ -122,7 +116,7 @@ func EnumGetValue(EnumClassVar *EnumClass, ValueVar int) *EnumValue {
 
 var xEnumGetValueByName func(*EnumClass, string) *EnumValue
 
-// Looks up a #GEnumValue by name.
+// Looks up a [EnumValue] by name.
 func EnumGetValueByName(EnumClassVar *EnumClass, NameVar string) *EnumValue {
 
 	cret := xEnumGetValueByName(EnumClassVar, NameVar)
@@ -131,7 +125,7 @@ func EnumGetValueByName(EnumClassVar *EnumClass, NameVar string) *EnumValue {
 
 var xEnumGetValueByNick func(*EnumClass, string) *EnumValue
 
-// Looks up a #GEnumValue by nickname.
+// Looks up a [EnumValue] by nickname.
 func EnumGetValueByNick(EnumClassVar *EnumClass, NickVar string) *EnumValue {
 
 	cret := xEnumGetValueByNick(EnumClassVar, NickVar)
@@ -140,11 +134,11 @@ func EnumGetValueByNick(EnumClassVar *EnumClass, NickVar string) *EnumValue {
 
 var xEnumRegisterStatic func(string, []EnumValue) types.GType
 
-// Registers a new static enumeration type with the name @name.
+// Registers a new static enumeration type with the name name.
 //
 // It is normally more convenient to let [glib-mkenums][glib-mkenums],
 // generate a my_enum_get_type() function from a usual C enumeration
-// definition  than to write one yourself using g_enum_register_static().
+// definition  than to write one yourself using [EnumRegisterStatic].
 func EnumRegisterStatic(NameVar string, ConstStaticValuesVar []EnumValue) types.GType {
 
 	cret := xEnumRegisterStatic(NameVar, ConstStaticValuesVar)
@@ -153,7 +147,7 @@ func EnumRegisterStatic(NameVar string, ConstStaticValuesVar []EnumValue) types.
 
 var xEnumToString func(types.GType, int) string
 
-// Pretty-prints @value in the form of the enum’s name.
+// Pretty-prints value in the form of the enum’s name.
 //
 // This is intended to be used for debugging purposes. The format of the output
 // may change in the future.
@@ -166,8 +160,8 @@ func EnumToString(GEnumTypeVar types.GType, ValueVar int) string {
 var xFlagsCompleteTypeInfo func(types.GType, *TypeInfo, []FlagsValue)
 
 // This function is meant to be called from the complete_type_info()
-// function of a #GTypePlugin implementation, see the example for
-// g_enum_complete_type_info() above.
+// function of a [TypePlugin] implementation, see the example for
+// [EnumCompleteTypeInfo] above.
 func FlagsCompleteTypeInfo(GFlagsTypeVar types.GType, InfoVar *TypeInfo, ConstValuesVar []FlagsValue) {
 
 	xFlagsCompleteTypeInfo(GFlagsTypeVar, InfoVar, ConstValuesVar)
@@ -176,7 +170,7 @@ func FlagsCompleteTypeInfo(GFlagsTypeVar types.GType, InfoVar *TypeInfo, ConstVa
 
 var xFlagsGetFirstValue func(*FlagsClass, uint) *FlagsValue
 
-// Returns the first #GFlagsValue which is set in @value.
+// Returns the first [FlagsValue] which is set in value.
 func FlagsGetFirstValue(FlagsClassVar *FlagsClass, ValueVar uint) *FlagsValue {
 
 	cret := xFlagsGetFirstValue(FlagsClassVar, ValueVar)
@@ -185,7 +179,7 @@ func FlagsGetFirstValue(FlagsClassVar *FlagsClass, ValueVar uint) *FlagsValue {
 
 var xFlagsGetValueByName func(*FlagsClass, string) *FlagsValue
 
-// Looks up a #GFlagsValue by name.
+// Looks up a [FlagsValue] by name.
 func FlagsGetValueByName(FlagsClassVar *FlagsClass, NameVar string) *FlagsValue {
 
 	cret := xFlagsGetValueByName(FlagsClassVar, NameVar)
@@ -194,7 +188,7 @@ func FlagsGetValueByName(FlagsClassVar *FlagsClass, NameVar string) *FlagsValue
 
 var xFlagsGetValueByNick func(*FlagsClass, string) *FlagsValue
 
-// Looks up a #GFlagsValue by nickname.
+// Looks up a [FlagsValue] by nickname.
 func FlagsGetValueByNick(FlagsClassVar *FlagsClass, NickVar string) *FlagsValue {
 
 	cret := xFlagsGetValueByNick(FlagsClassVar, NickVar)
@@ -203,11 +197,11 @@ func FlagsGetValueByNick(FlagsClassVar *FlagsClass, NickVar string) *FlagsValue
 
 var xFlagsRegisterStatic func(string, []FlagsValue) types.GType
 
-// Registers a new static flags type with the name @name.
+// Registers a new static flags type with the name name.
 //
 // It is normally more convenient to let [glib-mkenums][glib-mkenums]
 // generate a my_flags_get_type() function from a usual C enumeration
-// definition than to write one yourself using g_flags_register_static().
+// definition than to write one yourself using [FlagsRegisterStatic].
 func FlagsRegisterStatic(NameVar string, ConstStaticValuesVar []FlagsValue) types.GType {
 
 	cret := xFlagsRegisterStatic(NameVar, ConstStaticValuesVar)
@@ -216,7 +210,7 @@ func FlagsRegisterStatic(NameVar string, ConstStaticValuesVar []FlagsValue) type
 
 var xFlagsToString func(types.GType, uint) string
 
-// Pretty-prints @value in the form of the flag names separated by ` | ` and
+// Pretty-prints value in the form of the flag names separated by ` | ` and
 // sorted. Any extra bits will be shown at the end as a hexadecimal number.
 //
 // This is intended to be used for debugging purposes. The format of the output
@@ -232,24 +226,24 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xEnumCompleteTypeInfo, libs, "g_enum_complete_type_info")
-	core.PuregoSafeRegister(&xEnumGetValue, libs, "g_enum_get_value")
-	core.PuregoSafeRegister(&xEnumGetValueByName, libs, "g_enum_get_value_by_name")
-	core.PuregoSafeRegister(&xEnumGetValueByNick, libs, "g_enum_get_value_by_nick")
-	core.PuregoSafeRegister(&xEnumRegisterStatic, libs, "g_enum_register_static")
-	core.PuregoSafeRegister(&xEnumToString, libs, "g_enum_to_string")
-	core.PuregoSafeRegister(&xFlagsCompleteTypeInfo, libs, "g_flags_complete_type_info")
-	core.PuregoSafeRegister(&xFlagsGetFirstValue, libs, "g_flags_get_first_value")
-	core.PuregoSafeRegister(&xFlagsGetValueByName, libs, "g_flags_get_value_by_name")
-	core.PuregoSafeRegister(&xFlagsGetValueByNick, libs, "g_flags_get_value_by_nick")
-	core.PuregoSafeRegister(&xFlagsRegisterStatic, libs, "g_flags_register_static")
-	core.PuregoSafeRegister(&xFlagsToString, libs, "g_flags_to_string")
+	core.PuregoRegisterSince(&xEnumCompleteTypeInfo, libs, "g_enum_complete_type_info", "GOBJECT", "")
+	core.PuregoRegisterSince(&xEnumGetValue, libs, "g_enum_get_value", "GOBJECT", "")
+	core.PuregoRegisterSince(&xEnumGetValueByName, libs, "g_enum_get_value_by_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xEnumGetValueByNick, libs, "g_enum_get_value_by_nick", "GOBJECT", "")
+	core.PuregoRegisterSince(&xEnumRegisterStatic, libs, "g_enum_register_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xEnumToString, libs, "g_enum_to_string", "GOBJECT", "2.54")
+	core.PuregoRegisterSince(&xFlagsCompleteTypeInfo, libs, "g_flags_complete_type_info", "GOBJECT", "")
+	core.PuregoRegisterSince(&xFlagsGetFirstValue, libs, "g_flags_get_first_value", "GOBJECT", "")
+	core.PuregoRegisterSince(&xFlagsGetValueByName, libs, "g_flags_get_value_by_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xFlagsGetValueByNick, libs, "g_flags_get_value_by_nick", "GOBJECT", "")
+	core.PuregoRegisterSince(&xFlagsRegisterStatic, libs, "g_flags_register_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xFlagsToString, libs, "g_flags_to_string", "GOBJECT", "2.54")
 
 }
@@ -2,271 +2,454 @@
 package gobject
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
-var xCclosureMarshalBOOLEANBOXEDBOXED func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalBOOLEANBOXEDBOXED func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with handlers that
+// A [ClosureMarshal] function for use with signals with handlers that
 // take two boxed pointers as arguments and return a boolean.  If you
 // have such a signal, you will probably also need to use an
-// accumulator, such as g_signal_accumulator_true_handled().
-func CclosureMarshalBOOLEANBOXEDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+// accumulator, such as [SignalAccumulatorTrueHandled].
+func CClosureMarshalBOOLEANBOXEDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalBOOLEANBOXEDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalBOOLEANBOXEDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalBOOLEANFLAGS func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with handlers that
+// take two boxed pointers as arguments and return a boolean.  If you
+// have such a signal, you will probably also need to use an
+// accumulator, such as [SignalAccumulatorTrueHandled].
+//
+// Deprecated: use CClosureMarshalBOOLEANBOXEDBOXED, this name of g_cclosure_marshal_BOOLEAN__BOXED_BOXED is kept for compatibility.
+func CclosureMarshalBOOLEANBOXEDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalBOOLEANBOXEDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalBOOLEANFLAGS func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with handlers that
+// A [ClosureMarshal] function for use with signals with handlers that
 // take a flags type as an argument and return a boolean.  If you have
 // such a signal, you will probably also need to use an accumulator,
-// such as g_signal_accumulator_true_handled().
-func CclosureMarshalBOOLEANFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+// such as [SignalAccumulatorTrueHandled].
+func CClosureMarshalBOOLEANFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalBOOLEANFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalBOOLEANFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalSTRINGOBJECTPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with handlers that
+// take a flags type as an argument and return a boolean.  If you have
+// such a signal, you will probably also need to use an accumulator,
+// such as [SignalAccumulatorTrueHandled].
+//
+// Deprecated: use CClosureMarshalBOOLEANFLAGS, this name of g_cclosure_marshal_BOOLEAN__FLAGS is kept for compatibility.
+func CclosureMarshalBOOLEANFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalBOOLEANFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with handlers that
-// take a #GObject and a pointer and produce a string.  It is highly
+var xCClosureMarshalSTRINGOBJECTPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with handlers that
+// take a [Object] and a pointer and produce a string.  It is highly
 // unlikely that your signal handler fits this description.
-func CclosureMarshalSTRINGOBJECTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalSTRINGOBJECTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalSTRINGOBJECTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalSTRINGOBJECTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+
+}
 
+// A [ClosureMarshal] function for use with signals with handlers that
+// take a [Object] and a pointer and produce a string.  It is highly
+// unlikely that your signal handler fits this description.
+//
+// Deprecated: use CClosureMarshalSTRINGOBJECTPOINTER, this name of g_cclosure_marshal_STRING__OBJECT_POINTER is kept for compatibility.
+func CclosureMarshalSTRINGOBJECTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalSTRINGOBJECTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDBOOLEAN func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDBOOLEAN func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // boolean argument.
-func CclosureMarshalVOIDBOOLEAN(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDBOOLEAN(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDBOOLEAN(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDBOOLEAN(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDBOXED func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// boolean argument.
+//
+// Deprecated: use CClosureMarshalVOIDBOOLEAN, this name of g_cclosure_marshal_VOID__BOOLEAN is kept for compatibility.
+func CclosureMarshalVOIDBOOLEAN(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDBOOLEAN(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalVOIDBOXED func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // argument which is any boxed pointer type.
-func CclosureMarshalVOIDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalVOIDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
-	xCclosureMarshalVOIDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
+// A [ClosureMarshal] function for use with signals with a single
+// argument which is any boxed pointer type.
+//
+// Deprecated: use CClosureMarshalVOIDBOXED, this name of g_cclosure_marshal_VOID__BOXED is kept for compatibility.
+func CclosureMarshalVOIDBOXED(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDBOXED(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDCHAR func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDCHAR func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // character argument.
-func CclosureMarshalVOIDCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDDOUBLE func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// character argument.
+//
+// Deprecated: use CClosureMarshalVOIDCHAR, this name of g_cclosure_marshal_VOID__CHAR is kept for compatibility.
+func CclosureMarshalVOIDCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with one
+var xCClosureMarshalVOIDDOUBLE func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with one
 // double-precision floating point argument.
-func CclosureMarshalVOIDDOUBLE(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDDOUBLE(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDDOUBLE(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDDOUBLE(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDENUM func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with one
+// double-precision floating point argument.
+//
+// Deprecated: use CClosureMarshalVOIDDOUBLE, this name of g_cclosure_marshal_VOID__DOUBLE is kept for compatibility.
+func CclosureMarshalVOIDDOUBLE(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDDOUBLE(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with a single
+var xCClosureMarshalVOIDENUM func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with a single
 // argument with an enumerated type.
-func CclosureMarshalVOIDENUM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDENUM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDENUM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDENUM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+
+}
 
+// A [ClosureMarshal] function for use with signals with a single
+// argument with an enumerated type.
+//
+// Deprecated: use CClosureMarshalVOIDENUM, this name of g_cclosure_marshal_VOID__ENUM is kept for compatibility.
+func CclosureMarshalVOIDENUM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDENUM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDFLAGS func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDFLAGS func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // argument with a flags types.
-func CclosureMarshalVOIDFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDFLOAT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// argument with a flags types.
+//
+// Deprecated: use CClosureMarshalVOIDFLAGS, this name of g_cclosure_marshal_VOID__FLAGS is kept for compatibility.
+func CclosureMarshalVOIDFLAGS(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDFLAGS(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with one
+var xCClosureMarshalVOIDFLOAT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with one
 // single-precision floating point argument.
-func CclosureMarshalVOIDFLOAT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDFLOAT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDFLOAT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDFLOAT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+
+}
 
+// A [ClosureMarshal] function for use with signals with one
+// single-precision floating point argument.
+//
+// Deprecated: use CClosureMarshalVOIDFLOAT, this name of g_cclosure_marshal_VOID__FLOAT is kept for compatibility.
+func CclosureMarshalVOIDFLOAT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDFLOAT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDINT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDINT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // integer argument.
-func CclosureMarshalVOIDINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDLONG func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// integer argument.
+//
+// Deprecated: use CClosureMarshalVOIDINT, this name of g_cclosure_marshal_VOID__INT is kept for compatibility.
+func CclosureMarshalVOIDINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalVOIDLONG func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with with a single
+// A [ClosureMarshal] function for use with signals with with a single
 // long integer argument.
-func CclosureMarshalVOIDLONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDLONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDLONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDLONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDOBJECT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with with a single
+// long integer argument.
+//
+// Deprecated: use CClosureMarshalVOIDLONG, this name of g_cclosure_marshal_VOID__LONG is kept for compatibility.
+func CclosureMarshalVOIDLONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDLONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with a single
-// #GObject argument.
-func CclosureMarshalVOIDOBJECT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+var xCClosureMarshalVOIDOBJECT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-	xCclosureMarshalVOIDOBJECT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+// A [ClosureMarshal] function for use with signals with a single
+// [Object] argument.
+func CClosureMarshalVOIDOBJECT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalVOIDOBJECT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDPARAM func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// [Object] argument.
+//
+// Deprecated: use CClosureMarshalVOIDOBJECT, this name of g_cclosure_marshal_VOID__OBJECT is kept for compatibility.
+func CclosureMarshalVOIDOBJECT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDOBJECT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with a single
-// argument of type #GParamSpec.
-func CclosureMarshalVOIDPARAM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+var xCClosureMarshalVOIDPARAM func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with a single
+// argument of type [ParamSpec].
+func CClosureMarshalVOIDPARAM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDPARAM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDPARAM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// argument of type [ParamSpec].
+//
+// Deprecated: use CClosureMarshalVOIDPARAM, this name of g_cclosure_marshal_VOID__PARAM is kept for compatibility.
+func CclosureMarshalVOIDPARAM(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDPARAM(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalVOIDPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single raw
+// A [ClosureMarshal] function for use with signals with a single raw
 // pointer argument type.
 //
 // If it is possible, it is better to use one of the more specific
 // functions such as g_cclosure_marshal_VOID__OBJECT() or
 // g_cclosure_marshal_VOID__OBJECT().
-func CclosureMarshalVOIDPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDSTRING func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single raw
+// pointer argument type.
+//
+// If it is possible, it is better to use one of the more specific
+// functions such as g_cclosure_marshal_VOID__OBJECT() or
+// g_cclosure_marshal_VOID__OBJECT().
+//
+// Deprecated: use CClosureMarshalVOIDPOINTER, this name of g_cclosure_marshal_VOID__POINTER is kept for compatibility.
+func CclosureMarshalVOIDPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
-// A #GClosureMarshal function for use with signals with a single string
+var xCClosureMarshalVOIDSTRING func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+
+// A [ClosureMarshal] function for use with signals with a single string
 // argument.
-func CclosureMarshalVOIDSTRING(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDSTRING(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDSTRING(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDSTRING(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+
+}
 
+// A [ClosureMarshal] function for use with signals with a single string
+// argument.
+//
+// Deprecated: use CClosureMarshalVOIDSTRING, this name of g_cclosure_marshal_VOID__STRING is kept for compatibility.
+func CclosureMarshalVOIDSTRING(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDSTRING(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDUCHAR func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDUCHAR func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // unsigned character argument.
-func CclosureMarshalVOIDUCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDUCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDUCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDUCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDUINT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with a single
+// unsigned character argument.
+//
+// Deprecated: use CClosureMarshalVOIDUCHAR, this name of g_cclosure_marshal_VOID__UCHAR is kept for compatibility.
+func CclosureMarshalVOIDUCHAR(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDUCHAR(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalVOIDUINT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with with a single
+// A [ClosureMarshal] function for use with signals with with a single
 // unsigned integer argument.
-func CclosureMarshalVOIDUINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDUINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDUINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDUINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
-var xCclosureMarshalVOIDUINTPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+// A [ClosureMarshal] function for use with signals with with a single
+// unsigned integer argument.
+//
+// Deprecated: use CClosureMarshalVOIDUINT, this name of g_cclosure_marshal_VOID__UINT is kept for compatibility.
+func CclosureMarshalVOIDUINT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDUINT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
+var xCClosureMarshalVOIDUINTPOINTER func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with an unsigned int
+// A [ClosureMarshal] function for use with signals with an unsigned int
 // and a pointer as arguments.
-func CclosureMarshalVOIDUINTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDUINTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalVOIDUINTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
-	xCclosureMarshalVOIDUINTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
+// A [ClosureMarshal] function for use with signals with an unsigned int
+// and a pointer as arguments.
+//
+// Deprecated: use CClosureMarshalVOIDUINTPOINTER, this name of g_cclosure_marshal_VOID__UINT_POINTER is kept for compatibility.
+func CclosureMarshalVOIDUINTPOINTER(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDUINTPOINTER(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDULONG func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDULONG func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
+// A [ClosureMarshal] function for use with signals with a single
 // unsigned long integer argument.
-func CclosureMarshalVOIDULONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+func CClosureMarshalVOIDULONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalVOIDULONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
-	xCclosureMarshalVOIDULONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
+// A [ClosureMarshal] function for use with signals with a single
+// unsigned long integer argument.
+//
+// Deprecated: use CClosureMarshalVOIDULONG, this name of g_cclosure_marshal_VOID__ULONG is kept for compatibility.
+func CclosureMarshalVOIDULONG(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDULONG(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDVARIANT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDVARIANT func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with a single
-// #GVariant argument.
-func CclosureMarshalVOIDVARIANT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+// A [ClosureMarshal] function for use with signals with a single
+// [glib.Variant] argument.
+func CClosureMarshalVOIDVARIANT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+
+	xCClosureMarshalVOIDVARIANT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
-	xCclosureMarshalVOIDVARIANT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
 
+// A [ClosureMarshal] function for use with signals with a single
+// [glib.Variant] argument.
+//
+// Deprecated: use CClosureMarshalVOIDVARIANT, this name of g_cclosure_marshal_VOID__VARIANT is kept for compatibility.
+func CclosureMarshalVOIDVARIANT(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDVARIANT(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 }
 
-var xCclosureMarshalVOIDVOID func(*Closure, *Value, uint, *Value, uintptr, uintptr)
+var xCClosureMarshalVOIDVOID func(*Closure, *Value, uint, *Value, uintptr, uintptr)
 
-// A #GClosureMarshal function for use with signals with no arguments.
-func CclosureMarshalVOIDVOID(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+// A [ClosureMarshal] function for use with signals with no arguments.
+func CClosureMarshalVOIDVOID(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
 
-	xCclosureMarshalVOIDVOID(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+	xCClosureMarshalVOIDVOID(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
 
 }
 
+// A [ClosureMarshal] function for use with signals with no arguments.
+//
+// Deprecated: use CClosureMarshalVOIDVOID, this name of g_cclosure_marshal_VOID__VOID is kept for compatibility.
+func CclosureMarshalVOIDVOID(ClosureVar *Closure, ReturnValueVar *Value, NParamValuesVar uint, ParamValuesVar *Value, InvocationHintVar uintptr, MarshalDataVar uintptr) {
+	CClosureMarshalVOIDVOID(ClosureVar, ReturnValueVar, NParamValuesVar, ParamValuesVar, InvocationHintVar, MarshalDataVar)
+}
+
 func init() {
 	core.SetPackageName("GOBJECT", "gobject-2.0")
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCclosureMarshalBOOLEANBOXEDBOXED, libs, "g_cclosure_marshal_BOOLEAN__BOXED_BOXED")
-	core.PuregoSafeRegister(&xCclosureMarshalBOOLEANFLAGS, libs, "g_cclosure_marshal_BOOLEAN__FLAGS")
-	core.PuregoSafeRegister(&xCclosureMarshalSTRINGOBJECTPOINTER, libs, "g_cclosure_marshal_STRING__OBJECT_POINTER")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDBOOLEAN, libs, "g_cclosure_marshal_VOID__BOOLEAN")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDBOXED, libs, "g_cclosure_marshal_VOID__BOXED")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDCHAR, libs, "g_cclosure_marshal_VOID__CHAR")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDDOUBLE, libs, "g_cclosure_marshal_VOID__DOUBLE")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDENUM, libs, "g_cclosure_marshal_VOID__ENUM")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDFLAGS, libs, "g_cclosure_marshal_VOID__FLAGS")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDFLOAT, libs, "g_cclosure_marshal_VOID__FLOAT")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDINT, libs, "g_cclosure_marshal_VOID__INT")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDLONG, libs, "g_cclosure_marshal_VOID__LONG")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDOBJECT, libs, "g_cclosure_marshal_VOID__OBJECT")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDPARAM, libs, "g_cclosure_marshal_VOID__PARAM")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDPOINTER, libs, "g_cclosure_marshal_VOID__POINTER")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDSTRING, libs, "g_cclosure_marshal_VOID__STRING")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDUCHAR, libs, "g_cclosure_marshal_VOID__UCHAR")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDUINT, libs, "g_cclosure_marshal_VOID__UINT")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDUINTPOINTER, libs, "g_cclosure_marshal_VOID__UINT_POINTER")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDULONG, libs, "g_cclosure_marshal_VOID__ULONG")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDVARIANT, libs, "g_cclosure_marshal_VOID__VARIANT")
-	core.PuregoSafeRegister(&xCclosureMarshalVOIDVOID, libs, "g_cclosure_marshal_VOID__VOID")
+	core.PuregoRegisterSince(&xCClosureMarshalBOOLEANBOXEDBOXED, libs, "g_cclosure_marshal_BOOLEAN__BOXED_BOXED", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalBOOLEANFLAGS, libs, "g_cclosure_marshal_BOOLEAN__FLAGS", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalSTRINGOBJECTPOINTER, libs, "g_cclosure_marshal_STRING__OBJECT_POINTER", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDBOOLEAN, libs, "g_cclosure_marshal_VOID__BOOLEAN", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDBOXED, libs, "g_cclosure_marshal_VOID__BOXED", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDCHAR, libs, "g_cclosure_marshal_VOID__CHAR", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDDOUBLE, libs, "g_cclosure_marshal_VOID__DOUBLE", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDENUM, libs, "g_cclosure_marshal_VOID__ENUM", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDFLAGS, libs, "g_cclosure_marshal_VOID__FLAGS", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDFLOAT, libs, "g_cclosure_marshal_VOID__FLOAT", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDINT, libs, "g_cclosure_marshal_VOID__INT", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDLONG, libs, "g_cclosure_marshal_VOID__LONG", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDOBJECT, libs, "g_cclosure_marshal_VOID__OBJECT", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDPARAM, libs, "g_cclosure_marshal_VOID__PARAM", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDPOINTER, libs, "g_cclosure_marshal_VOID__POINTER", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDSTRING, libs, "g_cclosure_marshal_VOID__STRING", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDUCHAR, libs, "g_cclosure_marshal_VOID__UCHAR", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDUINT, libs, "g_cclosure_marshal_VOID__UINT", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDUINTPOINTER, libs, "g_cclosure_marshal_VOID__UINT_POINTER", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDULONG, libs, "g_cclosure_marshal_VOID__ULONG", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDVARIANT, libs, "g_cclosure_marshal_VOID__VARIANT", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureMarshalVOIDVOID, libs, "g_cclosure_marshal_VOID__VOID", "GOBJECT", "")
 
 }
@@ -6,37 +6,36 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of the @finalize function of #GObjectClass.
+// The type of the finalize function of [ObjectClass].
 type ObjectFinalizeFunc func(uintptr)
 
-// The type of the @get_property function of #GObjectClass.
+// The type of the get_property function of [ObjectClass].
 type ObjectGetPropertyFunc func(uintptr, uint, *Value, uintptr)
 
-// The type of the @set_property function of #GObjectClass.
+// The type of the set_property function of [ObjectClass].
 type ObjectSetPropertyFunc func(uintptr, uint, *Value, uintptr)
 
 // A callback function used for notification when the state
 // of a toggle reference changes.
 //
-// See also: g_object_add_toggle_ref()
+// See also: [Object.AddToggleRef]
 type ToggleNotify func(uintptr, uintptr, bool)
 
-// A #GWeakNotify function can be added to an object as a callback that gets
+// A [WeakNotify] function can be added to an object as a callback that gets
 // triggered when the object is finalized.
 //
-// Since the object is already being disposed when the #GWeakNotify is called,
+// Since the object is already being disposed when the [WeakNotify] is called,
 // there's not much you could do with the object, apart from e.g. using its
 // address as hash-index or the like.
 //
-// In particular, this means it’s invalid to call g_object_ref(),
-// g_weak_ref_init(), g_weak_ref_set(), g_object_add_toggle_ref(),
-// g_object_weak_ref(), g_object_add_weak_pointer() or any function which calls
+// In particular, this means it’s invalid to call [Object.Ref],
+// [WeakRef.Init], [WeakRef.Set], [Object.AddToggleRef],
+// [Object.WeakRef], [Object.AddWeakPointer] or any function which calls
 // them on the object from this callback.
 type WeakNotify func(uintptr, uintptr)
 
@@ -44,7 +43,7 @@ type WeakNotify func(uintptr, uintptr)
 type InitiallyUnownedClass struct {
 	_ structs.HostLayout
 
-	GTypeClass uintptr
+	GTypeClass TypeClass
 
 	ConstructProperties *glib.SList
 
@@ -80,17 +79,18 @@ func (x *InitiallyUnownedClass) GoPointer() uintptr {
 }
 
 // OverrideConstructor sets the "constructor" callback function.
-// the @constructor function is called by g_object_new () to
+// the constructor function is called by g_object_new () to
 //
 //	complete the object initialization after all the construction properties are
-//	set. The first thing a @constructor implementation must do is chain up to the
-//	@constructor of the parent class. Overriding @constructor should be rarely
+//	set. The first thing a constructor implementation must do is chain up to the
+//	constructor of the parent class. Overriding constructor should be rarely
 //	needed, e.g. to handle construct properties, or to implement singletons.
 func (x *InitiallyUnownedClass) OverrideConstructor(cb func(types.GType, uint, *ObjectConstructParam) *Object) {
 	if cb == nil {
 		x.xConstructor = 0
 	} else {
-		x.xConstructor = purego.NewCallback(func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr {
+		x.xConstructor = core.NewCallback(func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TypeVarp, NConstructPropertiesVarp, ConstructPropertiesVarp)
 			if ret == nil {
 				return 0
@@ -101,18 +101,18 @@ func (x *InitiallyUnownedClass) OverrideConstructor(cb func(types.GType, uint, *
 }
 
 // GetConstructor gets the "constructor" callback function.
-// the @constructor function is called by g_object_new () to
+// the constructor function is called by g_object_new () to
 //
 //	complete the object initialization after all the construction properties are
-//	set. The first thing a @constructor implementation must do is chain up to the
-//	@constructor of the parent class. Overriding @constructor should be rarely
+//	set. The first thing a constructor implementation must do is chain up to the
+//	constructor of the parent class. Overriding constructor should be rarely
 //	needed, e.g. to handle construct properties, or to implement singletons.
 func (x *InitiallyUnownedClass) GetConstructor() func(types.GType, uint, *ObjectConstructParam) *Object {
 	if x.xConstructor == 0 {
 		return nil
 	}
 	var rawCallback func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr
-	purego.RegisterFunc(&rawCallback, x.xConstructor)
+	core.RegisterFunc(&rawCallback, x.xConstructor)
 	return func(TypeVar types.GType, NConstructPropertiesVar uint, ConstructPropertiesVar *ObjectConstructParam) *Object {
 		rawRet := rawCallback(TypeVar, NConstructPropertiesVar, ConstructPropertiesVar)
 		if rawRet == 0 {
@@ -128,14 +128,15 @@ func (x *InitiallyUnownedClass) GetConstructor() func(types.GType, uint, *Object
 // the generic setter for all properties of this type. Should be
 //
 //	overridden for every type with properties. If implementations of
-//	@set_property don't emit property change notification explicitly, this will
+//	set_property don't emit property change notification explicitly, this will
 //	be done implicitly by the type system. However, if the notify signal is
 //	emitted explicitly, the type system will not emit it a second time.
 func (x *InitiallyUnownedClass) OverrideSetProperty(cb func(*Object, uint, *Value, *ParamSpec)) {
 	if cb == nil {
 		x.xSetProperty = 0
 	} else {
-		x.xSetProperty = purego.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+		x.xSetProperty = core.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), PropertyIdVarp, ValueVarp, ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -145,7 +146,7 @@ func (x *InitiallyUnownedClass) OverrideSetProperty(cb func(*Object, uint, *Valu
 // the generic setter for all properties of this type. Should be
 //
 //	overridden for every type with properties. If implementations of
-//	@set_property don't emit property change notification explicitly, this will
+//	set_property don't emit property change notification explicitly, this will
 //	be done implicitly by the type system. However, if the notify signal is
 //	emitted explicitly, the type system will not emit it a second time.
 func (x *InitiallyUnownedClass) GetSetProperty() func(*Object, uint, *Value, *ParamSpec) {
@@ -153,7 +154,7 @@ func (x *InitiallyUnownedClass) GetSetProperty() func(*Object, uint, *Value, *Pa
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetProperty)
+	core.RegisterFunc(&rawCallback, x.xSetProperty)
 	return func(ObjectVar *Object, PropertyIdVar uint, ValueVar *Value, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PropertyIdVar, ValueVar, PspecVar.GoPointer())
 	}
@@ -167,7 +168,8 @@ func (x *InitiallyUnownedClass) OverrideGetProperty(cb func(*Object, uint, *Valu
 	if cb == nil {
 		x.xGetProperty = 0
 	} else {
-		x.xGetProperty = purego.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+		x.xGetProperty = core.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), PropertyIdVarp, ValueVarp, ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -182,42 +184,43 @@ func (x *InitiallyUnownedClass) GetGetProperty() func(*Object, uint, *Value, *Pa
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xGetProperty)
+	core.RegisterFunc(&rawCallback, x.xGetProperty)
 	return func(ObjectVar *Object, PropertyIdVar uint, ValueVar *Value, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PropertyIdVar, ValueVar, PspecVar.GoPointer())
 	}
 }
 
 // OverrideDispose sets the "dispose" callback function.
-// the @dispose function is supposed to drop all references to other
+// the dispose function is supposed to drop all references to other
 //
 //	objects, but keep the instance otherwise intact, so that client method
 //	invocations still work. It may be run multiple times (due to reference
-//	loops). Before returning, @dispose should chain up to the @dispose method
+//	loops). Before returning, dispose should chain up to the dispose method
 //	of the parent class.
 func (x *InitiallyUnownedClass) OverrideDispose(cb func(*Object)) {
 	if cb == nil {
 		x.xDispose = 0
 	} else {
-		x.xDispose = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xDispose = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetDispose gets the "dispose" callback function.
-// the @dispose function is supposed to drop all references to other
+// the dispose function is supposed to drop all references to other
 //
 //	objects, but keep the instance otherwise intact, so that client method
 //	invocations still work. It may be run multiple times (due to reference
-//	loops). Before returning, @dispose should chain up to the @dispose method
+//	loops). Before returning, dispose should chain up to the dispose method
 //	of the parent class.
 func (x *InitiallyUnownedClass) GetDispose() func(*Object) {
 	if x.xDispose == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDispose)
+	core.RegisterFunc(&rawCallback, x.xDispose)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
@@ -226,13 +229,14 @@ func (x *InitiallyUnownedClass) GetDispose() func(*Object) {
 // OverrideFinalize sets the "finalize" callback function.
 // instance finalization function, should finish the finalization of
 //
-//	the instance begun in @dispose and chain up to the @finalize method of the
+//	the instance begun in dispose and chain up to the finalize method of the
 //	parent class.
 func (x *InitiallyUnownedClass) OverrideFinalize(cb func(*Object)) {
 	if cb == nil {
 		x.xFinalize = 0
 	} else {
-		x.xFinalize = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xFinalize = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
@@ -241,14 +245,14 @@ func (x *InitiallyUnownedClass) OverrideFinalize(cb func(*Object)) {
 // GetFinalize gets the "finalize" callback function.
 // instance finalization function, should finish the finalization of
 //
-//	the instance begun in @dispose and chain up to the @finalize method of the
+//	the instance begun in dispose and chain up to the finalize method of the
 //	parent class.
 func (x *InitiallyUnownedClass) GetFinalize() func(*Object) {
 	if x.xFinalize == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFinalize)
+	core.RegisterFunc(&rawCallback, x.xFinalize)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
@@ -257,13 +261,14 @@ func (x *InitiallyUnownedClass) GetFinalize() func(*Object) {
 // OverrideDispatchPropertiesChanged sets the "dispatch_properties_changed" callback function.
 // emits property change notification for a bunch
 //
-//	of properties. Overriding @dispatch_properties_changed should be rarely
+//	of properties. Overriding dispatch_properties_changed should be rarely
 //	needed.
 func (x *InitiallyUnownedClass) OverrideDispatchPropertiesChanged(cb func(*Object, uint, **ParamSpec)) {
 	if cb == nil {
 		x.xDispatchPropertiesChanged = 0
 	} else {
-		x.xDispatchPropertiesChanged = purego.NewCallback(func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr) {
+		x.xDispatchPropertiesChanged = core.NewCallback(func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), NPspecsVarp, (**ParamSpec)(unsafe.Pointer(PspecsVarp)))
 		})
 	}
@@ -272,14 +277,14 @@ func (x *InitiallyUnownedClass) OverrideDispatchPropertiesChanged(cb func(*Objec
 // GetDispatchPropertiesChanged gets the "dispatch_properties_changed" callback function.
 // emits property change notification for a bunch
 //
-//	of properties. Overriding @dispatch_properties_changed should be rarely
+//	of properties. Overriding dispatch_properties_changed should be rarely
 //	needed.
 func (x *InitiallyUnownedClass) GetDispatchPropertiesChanged() func(*Object, uint, **ParamSpec) {
 	if x.xDispatchPropertiesChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDispatchPropertiesChanged)
+	core.RegisterFunc(&rawCallback, x.xDispatchPropertiesChanged)
 	return func(ObjectVar *Object, NPspecsVar uint, PspecsVar **ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), NPspecsVar, *ConvertPtr(PspecsVar))
 	}
@@ -291,7 +296,8 @@ func (x *InitiallyUnownedClass) OverrideNotify(cb func(*Object, *ParamSpec)) {
 	if cb == nil {
 		x.xNotify = 0
 	} else {
-		x.xNotify = purego.NewCallback(func(ObjectVarp uintptr, PspecVarp uintptr) {
+		x.xNotify = core.NewCallback(func(ObjectVarp uintptr, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -304,46 +310,47 @@ func (x *InitiallyUnownedClass) GetNotify() func(*Object, *ParamSpec) {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xNotify)
+	core.RegisterFunc(&rawCallback, x.xNotify)
 	return func(ObjectVar *Object, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PspecVar.GoPointer())
 	}
 }
 
 // OverrideConstructed sets the "constructed" callback function.
-// the @constructed function is called by g_object_new() as the
+// the constructed function is called by [NewObject] as the
 //
 //	final step of the object creation process.  At the point of the call, all
 //	construction properties have been set on the object.  The purpose of this
 //	call is to allow for object initialisation steps that can only be performed
-//	after construction properties have been set.  @constructed implementors
-//	should chain up to the @constructed call of their parent class to allow it
+//	after construction properties have been set.  constructed implementors
+//	should chain up to the constructed call of their parent class to allow it
 //	to complete its initialisation.
 func (x *InitiallyUnownedClass) OverrideConstructed(cb func(*Object)) {
 	if cb == nil {
 		x.xConstructed = 0
 	} else {
-		x.xConstructed = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xConstructed = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetConstructed gets the "constructed" callback function.
-// the @constructed function is called by g_object_new() as the
+// the constructed function is called by [NewObject] as the
 //
 //	final step of the object creation process.  At the point of the call, all
 //	construction properties have been set on the object.  The purpose of this
 //	call is to allow for object initialisation steps that can only be performed
-//	after construction properties have been set.  @constructed implementors
-//	should chain up to the @constructed call of their parent class to allow it
+//	after construction properties have been set.  constructed implementors
+//	should chain up to the constructed call of their parent class to allow it
 //	to complete its initialisation.
 func (x *InitiallyUnownedClass) GetConstructed() func(*Object) {
 	if x.xConstructed == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xConstructed)
+	core.RegisterFunc(&rawCallback, x.xConstructed)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
@@ -351,22 +358,19 @@ func (x *InitiallyUnownedClass) GetConstructed() func(*Object) {
 
 // The class structure for the GObject type.
 //
-// |[&lt;!-- language="C" --&gt;
-// // Example of implementing a singleton using a constructor.
-// static MySingleton *the_singleton = NULL;
-//
-// static GObject*
-// my_singleton_constructor (GType                  type,
-//
-//	guint                  n_construct_params,
-//	GObjectConstructParam *construct_params)
+//	// Example of implementing a singleton using a constructor.
+//	static MySingleton *the_singleton = NULL;
 //
+//	static GObject*
+//	my_singleton_constructor (GType                  type,
+//	                          guint                  n_construct_params,
+//	                          GObjectConstructParam *construct_params)
 //	{
 //	  GObject *object;
 //
 //	  if (!the_singleton)
 //	    {
-//	      object = G_OBJECT_CLASS (parent_class)-&gt;constructor (type,
+//	      object = G_OBJECT_CLASS (parent_class)->constructor (type,
 //	                                                           n_construct_params,
 //	                                                           construct_params);
 //	      the_singleton = MY_SINGLETON (object);
@@ -376,12 +380,10 @@ func (x *InitiallyUnownedClass) GetConstructed() func(*Object) {
 //
 //	  return object;
 //	}
-//
-// ]|
 type ObjectClass struct {
 	_ structs.HostLayout
 
-	GTypeClass uintptr
+	GTypeClass TypeClass
 
 	ConstructProperties *glib.SList
 
@@ -418,7 +420,7 @@ func (x *ObjectClass) GoPointer() uintptr {
 
 var xObjectClassFindProperty func(uintptr, string) uintptr
 
-// Looks up the #GParamSpec for a property of a class.
+// Looks up the [ParamSpec] for a property of a class.
 func (x *ObjectClass) FindProperty(PropertyNameVar string) *ParamSpec {
 	var cls *ParamSpec
 
@@ -435,36 +437,33 @@ func (x *ObjectClass) FindProperty(PropertyNameVar string) *ParamSpec {
 
 var xObjectClassInstallProperties func(uintptr, uint, uintptr)
 
-// Installs new properties from an array of #GParamSpecs.
+// Installs new properties from an array of GParamSpecs.
 //
 // All properties should be installed during the class initializer.  It
 // is possible to install properties after that, but doing so is not
 // recommend, and specifically, is not guaranteed to be thread-safe vs.
 // use of properties on the same type on other threads.
 //
-// The property id of each property is the index of each #GParamSpec in
-// the @pspecs array.
+// The property id of each property is the index of each [ParamSpec] in
+// the pspecs array.
 //
-// The property id of 0 is treated specially by #GObject and it should not
-// be used to store a #GParamSpec.
+// The property id of 0 is treated specially by [Object] and it should not
+// be used to store a [ParamSpec].
 //
 // This function should be used if you plan to use a static array of
-// #GParamSpecs and g_object_notify_by_pspec(). For instance, this
+// GParamSpecs and [Object.NotifyByPspec]. For instance, this
 // class initialization:
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	typedef enum {
 //	  PROP_FOO = 1,
 //	  PROP_BAR,
 //	  N_PROPERTIES
 //	} MyObjectProperty;
 //
-// static GParamSpec *obj_properties[N_PROPERTIES] = { NULL, };
-//
-// static void
-// my_object_class_init (MyObjectClass *klass)
+//	static GParamSpec *obj_properties[N_PROPERTIES] = { NULL, };
 //
+//	static void
+//	my_object_class_init (MyObjectClass *klass)
 //	{
 //	  GObjectClass *gobject_class = G_OBJECT_CLASS (klass);
 //
@@ -479,30 +478,24 @@ var xObjectClassInstallProperties func(uintptr, uint, uintptr)
 //	                         NULL,
 //	                         G_PARAM_READWRITE | G_PARAM_STATIC_STRINGS);
 //
-//	  gobject_class-&gt;set_property = my_object_set_property;
-//	  gobject_class-&gt;get_property = my_object_get_property;
+//	  gobject_class->set_property = my_object_set_property;
+//	  gobject_class->get_property = my_object_get_property;
 //	  g_object_class_install_properties (gobject_class,
 //	                                     G_N_ELEMENTS (obj_properties),
 //	                                     obj_properties);
 //	}
 //
-// ]|
-//
-// allows calling g_object_notify_by_pspec() to notify of property changes:
-//
-// |[&lt;!-- language="C" --&gt;
-// void
-// my_object_set_foo (MyObject *self, gint foo)
+// allows calling [Object.NotifyByPspec] to notify of property changes:
 //
+//	void
+//	my_object_set_foo (MyObject *self, gint foo)
 //	{
-//	  if (self-&gt;foo != foo)
+//	  if (self->foo != foo)
 //	    {
-//	      self-&gt;foo = foo;
+//	      self->foo = foo;
 //	      g_object_notify_by_pspec (G_OBJECT (self), obj_properties[PROP_FOO]);
 //	    }
 //	 }
-//
-// ]|
 func (x *ObjectClass) InstallProperties(NPspecsVar uint, PspecsVar uintptr) {
 
 	xObjectClassInstallProperties(x.GoPointer(), NPspecsVar, PspecsVar)
@@ -529,7 +522,7 @@ func (x *ObjectClass) InstallProperty(PropertyIdVar uint, PspecVar *ParamSpec) {
 
 var xObjectClassListProperties func(uintptr, *uint) uintptr
 
-// Get an array of #GParamSpec* for all properties of a class.
+// Get an array of [ParamSpec]* for all properties of a class.
 func (x *ObjectClass) ListProperties(NPropertiesVar *uint) uintptr {
 
 	cret := xObjectClassListProperties(x.GoPointer(), NPropertiesVar)
@@ -538,22 +531,22 @@ func (x *ObjectClass) ListProperties(NPropertiesVar *uint) uintptr {
 
 var xObjectClassOverrideProperty func(uintptr, uint, string)
 
-// Registers @property_id as referring to a property with the name
-// @name in a parent class or in an interface implemented by @oclass.
+// Registers property_id as referring to a property with the name
+// name in a parent class or in an interface implemented by oclass.
 // This allows this class to "override" a property implementation in
 // a parent class or to provide the implementation of a property from
 // an interface.
 //
 // Internally, overriding is implemented by creating a property of type
-// #GParamSpecOverride; generally operations that query the properties of
-// the object class, such as g_object_class_find_property() or
-// g_object_class_list_properties() will return the overridden
-// property. However, in one case, the @construct_properties argument of
-// the @constructor virtual function, the #GParamSpecOverride is passed
-// instead, so that the @param_id field of the #GParamSpec will be
+// [ParamSpecOverride]; generally operations that query the properties of
+// the object class, such as [ObjectClass.FindProperty] or
+// [ObjectClass.ListProperties] will return the overridden
+// property. However, in one case, the construct_properties argument of
+// the constructor virtual function, the [ParamSpecOverride] is passed
+// instead, so that the param_id field of the [ParamSpec] will be
 // correct.  For virtually all uses, this makes no difference. If you
 // need to get the overridden property, you can call
-// g_param_spec_get_redirect_target().
+// [ParamSpec.GetRedirectTarget].
 func (x *ObjectClass) OverrideProperty(PropertyIdVar uint, NameVar string) {
 
 	xObjectClassOverrideProperty(x.GoPointer(), PropertyIdVar, NameVar)
@@ -561,17 +554,18 @@ func (x *ObjectClass) OverrideProperty(PropertyIdVar uint, NameVar string) {
 }
 
 // OverrideConstructor sets the "constructor" callback function.
-// the @constructor function is called by g_object_new () to
+// the constructor function is called by g_object_new () to
 //
 //	complete the object initialization after all the construction properties are
-//	set. The first thing a @constructor implementation must do is chain up to the
-//	@constructor of the parent class. Overriding @constructor should be rarely
+//	set. The first thing a constructor implementation must do is chain up to the
+//	constructor of the parent class. Overriding constructor should be rarely
 //	needed, e.g. to handle construct properties, or to implement singletons.
 func (x *ObjectClass) OverrideConstructor(cb func(types.GType, uint, *ObjectConstructParam) *Object) {
 	if cb == nil {
 		x.xConstructor = 0
 	} else {
-		x.xConstructor = purego.NewCallback(func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr {
+		x.xConstructor = core.NewCallback(func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TypeVarp, NConstructPropertiesVarp, ConstructPropertiesVarp)
 			if ret == nil {
 				return 0
@@ -582,18 +576,18 @@ func (x *ObjectClass) OverrideConstructor(cb func(types.GType, uint, *ObjectCons
 }
 
 // GetConstructor gets the "constructor" callback function.
-// the @constructor function is called by g_object_new () to
+// the constructor function is called by g_object_new () to
 //
 //	complete the object initialization after all the construction properties are
-//	set. The first thing a @constructor implementation must do is chain up to the
-//	@constructor of the parent class. Overriding @constructor should be rarely
+//	set. The first thing a constructor implementation must do is chain up to the
+//	constructor of the parent class. Overriding constructor should be rarely
 //	needed, e.g. to handle construct properties, or to implement singletons.
 func (x *ObjectClass) GetConstructor() func(types.GType, uint, *ObjectConstructParam) *Object {
 	if x.xConstructor == 0 {
 		return nil
 	}
 	var rawCallback func(TypeVarp types.GType, NConstructPropertiesVarp uint, ConstructPropertiesVarp *ObjectConstructParam) uintptr
-	purego.RegisterFunc(&rawCallback, x.xConstructor)
+	core.RegisterFunc(&rawCallback, x.xConstructor)
 	return func(TypeVar types.GType, NConstructPropertiesVar uint, ConstructPropertiesVar *ObjectConstructParam) *Object {
 		rawRet := rawCallback(TypeVar, NConstructPropertiesVar, ConstructPropertiesVar)
 		if rawRet == 0 {
@@ -606,110 +600,114 @@ func (x *ObjectClass) GetConstructor() func(types.GType, uint, *ObjectConstructP
 }
 
 // OverrideSetProperty sets the "set_property" callback function.
-// The type of the @set_property function of #GObjectClass.
+// The type of the set_property function of [ObjectClass].
 func (x *ObjectClass) OverrideSetProperty(cb func(*Object, uint, *Value, *ParamSpec)) {
 	if cb == nil {
 		x.xSetProperty = 0
 	} else {
-		x.xSetProperty = purego.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+		x.xSetProperty = core.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), PropertyIdVarp, ValueVarp, ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
 }
 
 // GetSetProperty gets the "set_property" callback function.
-// The type of the @set_property function of #GObjectClass.
+// The type of the set_property function of [ObjectClass].
 func (x *ObjectClass) GetSetProperty() func(*Object, uint, *Value, *ParamSpec) {
 	if x.xSetProperty == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetProperty)
+	core.RegisterFunc(&rawCallback, x.xSetProperty)
 	return func(ObjectVar *Object, PropertyIdVar uint, ValueVar *Value, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PropertyIdVar, ValueVar, PspecVar.GoPointer())
 	}
 }
 
 // OverrideGetProperty sets the "get_property" callback function.
-// The type of the @get_property function of #GObjectClass.
+// The type of the get_property function of [ObjectClass].
 func (x *ObjectClass) OverrideGetProperty(cb func(*Object, uint, *Value, *ParamSpec)) {
 	if cb == nil {
 		x.xGetProperty = 0
 	} else {
-		x.xGetProperty = purego.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+		x.xGetProperty = core.NewCallback(func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), PropertyIdVarp, ValueVarp, ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
 }
 
 // GetGetProperty gets the "get_property" callback function.
-// The type of the @get_property function of #GObjectClass.
+// The type of the get_property function of [ObjectClass].
 func (x *ObjectClass) GetGetProperty() func(*Object, uint, *Value, *ParamSpec) {
 	if x.xGetProperty == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PropertyIdVarp uint, ValueVarp *Value, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xGetProperty)
+	core.RegisterFunc(&rawCallback, x.xGetProperty)
 	return func(ObjectVar *Object, PropertyIdVar uint, ValueVar *Value, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PropertyIdVar, ValueVar, PspecVar.GoPointer())
 	}
 }
 
 // OverrideDispose sets the "dispose" callback function.
-// the @dispose function is supposed to drop all references to other
+// the dispose function is supposed to drop all references to other
 //
 //	objects, but keep the instance otherwise intact, so that client method
 //	invocations still work. It may be run multiple times (due to reference
-//	loops). Before returning, @dispose should chain up to the @dispose method
+//	loops). Before returning, dispose should chain up to the dispose method
 //	of the parent class.
 func (x *ObjectClass) OverrideDispose(cb func(*Object)) {
 	if cb == nil {
 		x.xDispose = 0
 	} else {
-		x.xDispose = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xDispose = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetDispose gets the "dispose" callback function.
-// the @dispose function is supposed to drop all references to other
+// the dispose function is supposed to drop all references to other
 //
 //	objects, but keep the instance otherwise intact, so that client method
 //	invocations still work. It may be run multiple times (due to reference
-//	loops). Before returning, @dispose should chain up to the @dispose method
+//	loops). Before returning, dispose should chain up to the dispose method
 //	of the parent class.
 func (x *ObjectClass) GetDispose() func(*Object) {
 	if x.xDispose == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDispose)
+	core.RegisterFunc(&rawCallback, x.xDispose)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
 }
 
 // OverrideFinalize sets the "finalize" callback function.
-// The type of the @finalize function of #GObjectClass.
+// The type of the finalize function of [ObjectClass].
 func (x *ObjectClass) OverrideFinalize(cb func(*Object)) {
 	if cb == nil {
 		x.xFinalize = 0
 	} else {
-		x.xFinalize = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xFinalize = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetFinalize gets the "finalize" callback function.
-// The type of the @finalize function of #GObjectClass.
+// The type of the finalize function of [ObjectClass].
 func (x *ObjectClass) GetFinalize() func(*Object) {
 	if x.xFinalize == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFinalize)
+	core.RegisterFunc(&rawCallback, x.xFinalize)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
@@ -718,13 +716,14 @@ func (x *ObjectClass) GetFinalize() func(*Object) {
 // OverrideDispatchPropertiesChanged sets the "dispatch_properties_changed" callback function.
 // emits property change notification for a bunch
 //
-//	of properties. Overriding @dispatch_properties_changed should be rarely
+//	of properties. Overriding dispatch_properties_changed should be rarely
 //	needed.
 func (x *ObjectClass) OverrideDispatchPropertiesChanged(cb func(*Object, uint, **ParamSpec)) {
 	if cb == nil {
 		x.xDispatchPropertiesChanged = 0
 	} else {
-		x.xDispatchPropertiesChanged = purego.NewCallback(func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr) {
+		x.xDispatchPropertiesChanged = core.NewCallback(func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), NPspecsVarp, (**ParamSpec)(unsafe.Pointer(PspecsVarp)))
 		})
 	}
@@ -733,14 +732,14 @@ func (x *ObjectClass) OverrideDispatchPropertiesChanged(cb func(*Object, uint, *
 // GetDispatchPropertiesChanged gets the "dispatch_properties_changed" callback function.
 // emits property change notification for a bunch
 //
-//	of properties. Overriding @dispatch_properties_changed should be rarely
+//	of properties. Overriding dispatch_properties_changed should be rarely
 //	needed.
 func (x *ObjectClass) GetDispatchPropertiesChanged() func(*Object, uint, **ParamSpec) {
 	if x.xDispatchPropertiesChanged == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, NPspecsVarp uint, PspecsVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xDispatchPropertiesChanged)
+	core.RegisterFunc(&rawCallback, x.xDispatchPropertiesChanged)
 	return func(ObjectVar *Object, NPspecsVar uint, PspecsVar **ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), NPspecsVar, *ConvertPtr(PspecsVar))
 	}
@@ -752,7 +751,8 @@ func (x *ObjectClass) OverrideNotify(cb func(*Object, *ParamSpec)) {
 	if cb == nil {
 		x.xNotify = 0
 	} else {
-		x.xNotify = purego.NewCallback(func(ObjectVarp uintptr, PspecVarp uintptr) {
+		x.xNotify = core.NewCallback(func(ObjectVarp uintptr, PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp), ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -765,53 +765,54 @@ func (x *ObjectClass) GetNotify() func(*Object, *ParamSpec) {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr, PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xNotify)
+	core.RegisterFunc(&rawCallback, x.xNotify)
 	return func(ObjectVar *Object, PspecVar *ParamSpec) {
 		rawCallback(ObjectVar.GoPointer(), PspecVar.GoPointer())
 	}
 }
 
 // OverrideConstructed sets the "constructed" callback function.
-// the @constructed function is called by g_object_new() as the
+// the constructed function is called by [NewObject] as the
 //
 //	final step of the object creation process.  At the point of the call, all
 //	construction properties have been set on the object.  The purpose of this
 //	call is to allow for object initialisation steps that can only be performed
-//	after construction properties have been set.  @constructed implementors
-//	should chain up to the @constructed call of their parent class to allow it
+//	after construction properties have been set.  constructed implementors
+//	should chain up to the constructed call of their parent class to allow it
 //	to complete its initialisation.
 func (x *ObjectClass) OverrideConstructed(cb func(*Object)) {
 	if cb == nil {
 		x.xConstructed = 0
 	} else {
-		x.xConstructed = purego.NewCallback(func(ObjectVarp uintptr) {
+		x.xConstructed = core.NewCallback(func(ObjectVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetConstructed gets the "constructed" callback function.
-// the @constructed function is called by g_object_new() as the
+// the constructed function is called by [NewObject] as the
 //
 //	final step of the object creation process.  At the point of the call, all
 //	construction properties have been set on the object.  The purpose of this
 //	call is to allow for object initialisation steps that can only be performed
-//	after construction properties have been set.  @constructed implementors
-//	should chain up to the @constructed call of their parent class to allow it
+//	after construction properties have been set.  constructed implementors
+//	should chain up to the constructed call of their parent class to allow it
 //	to complete its initialisation.
 func (x *ObjectClass) GetConstructed() func(*Object) {
 	if x.xConstructed == 0 {
 		return nil
 	}
 	var rawCallback func(ObjectVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xConstructed)
+	core.RegisterFunc(&rawCallback, x.xConstructed)
 	return func(ObjectVar *Object) {
 		rawCallback(ObjectVar.GoPointer())
 	}
 }
 
 // The GObjectConstructParam struct is an auxiliary structure used to hand
-// #GParamSpec/#GValue pairs to the @constructor of a #GObjectClass.
+// [ParamSpec]/[Value] pairs to the constructor of a [ObjectClass].
 type ObjectConstructParam struct {
 	_ structs.HostLayout
 
@@ -824,29 +825,29 @@ func (x *ObjectConstructParam) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A structure containing a weak reference to a #GObject.
+// A structure containing a weak reference to a [Object].
 //
-// A `GWeakRef` can either be empty (i.e. point to %NULL), or point to an
+// A `GWeakRef` can either be empty (i.e. point to nil), or point to an
 // object for as long as at least one "strong" reference to that object
-// exists. Before the object's #GObjectClass.dispose method is called,
-// every #GWeakRef associated with becomes empty (i.e. points to %NULL).
+// exists. Before the object's [ObjectClass].dispose method is called,
+// every [WeakRef] associated with becomes empty (i.e. points to nil).
 //
-// Like #GValue, #GWeakRef can be statically allocated, stack- or
+// Like [Value], [WeakRef] can be statically allocated, stack- or
 // heap-allocated, or embedded in larger structures.
 //
-// Unlike g_object_weak_ref() and g_object_add_weak_pointer(), this weak
+// Unlike [Object.WeakRef] and [Object.AddWeakPointer], this weak
 // reference is thread-safe: converting a weak pointer to a reference is
 // atomic with respect to invalidation of weak pointers to destroyed
 // objects.
 //
-// If the object's #GObjectClass.dispose method results in additional
-// references to the object being held (‘re-referencing’), any #GWeakRefs taken
-// before it was disposed will continue to point to %NULL.  Any #GWeakRefs taken
+// If the object's [ObjectClass].dispose method results in additional
+// references to the object being held (‘re-referencing’), any GWeakRefs taken
+// before it was disposed will continue to point to nil.  Any GWeakRefs taken
 // during disposal and after re-referencing, or after disposal has returned due
 // to the re-referencing, will continue to point to the object until its refcount
 // goes back to zero, at which point they too will be invalidated.
 //
-// It is invalid to take a #GWeakRef on an object during #GObjectClass.dispose
+// It is invalid to take a [WeakRef] on an object during [ObjectClass].dispose
 // without first having or creating a strong reference to the object.
 type WeakRef struct {
 	_ structs.HostLayout
@@ -858,11 +859,11 @@ func (x *WeakRef) GoPointer() uintptr {
 
 var xWeakRefClear func(uintptr)
 
-// Frees resources associated with a non-statically-allocated #GWeakRef.
-// After this call, the #GWeakRef is left in an undefined state.
+// Frees resources associated with a non-statically-allocated [WeakRef].
+// After this call, the [WeakRef] is left in an undefined state.
 //
-// You should only call this on a #GWeakRef that previously had
-// g_weak_ref_init() called on it.
+// You should only call this on a [WeakRef] that previously had
+// [WeakRef.Init] called on it.
 func (x *WeakRef) Clear() {
 
 	xWeakRefClear(x.GoPointer())
@@ -871,15 +872,15 @@ func (x *WeakRef) Clear() {
 
 var xWeakRefGet func(uintptr) uintptr
 
-// If @weak_ref is not empty, atomically acquire a strong
+// If weak_ref is not empty, atomically acquire a strong
 // reference to the object it points to, and return that reference.
 //
 // This function is needed because of the potential race between taking
-// the pointer value and g_object_ref() on it, if the object was losing
+// the pointer value and [Object.Ref] on it, if the object was losing
 // its last reference at the same time in a different thread.
 //
 // The caller should release the resulting reference in the usual way,
-// by using g_object_unref().
+// by using [Object.Unref].
 func (x *WeakRef) Get() *Object {
 	var cls *Object
 
@@ -895,15 +896,15 @@ func (x *WeakRef) Get() *Object {
 
 var xWeakRefInit func(uintptr, uintptr)
 
-// Initialise a non-statically-allocated #GWeakRef.
+// Initialise a non-statically-allocated [WeakRef].
 //
-// This function also calls g_weak_ref_set() with @object on the
+// This function also calls [WeakRef.Set] with object on the
 // freshly-initialised weak reference.
 //
 // This function should always be matched with a call to
-// g_weak_ref_clear().  It is not necessary to use this function for a
-// #GWeakRef in static storage because it will already be
-// properly initialised.  Just use g_weak_ref_set() directly.
+// [WeakRef.Clear].  It is not necessary to use this function for a
+// [WeakRef] in static storage because it will already be
+// properly initialised.  Just use [WeakRef.Set] directly.
 func (x *WeakRef) Init(ObjectVar *Object) {
 
 	xWeakRefInit(x.GoPointer(), ObjectVar.GoPointer())
@@ -912,10 +913,10 @@ func (x *WeakRef) Init(ObjectVar *Object) {
 
 var xWeakRefSet func(uintptr, uintptr)
 
-// Change the object to which @weak_ref points, or set it to
-// %NULL.
+// Change the object to which weak_ref points, or set it to
+// nil.
 //
-// You must own a strong reference on @object while calling this
+// You must own a strong reference on object while calling this
 // function.
 func (x *WeakRef) Set(ObjectVar *Object) {
 
@@ -923,14 +924,14 @@ func (x *WeakRef) Set(ObjectVar *Object) {
 
 }
 
-var xCclosureNewObject func(uintptr, uintptr) *Closure
+var xCClosureNewObject func(uintptr, uintptr) *Closure
 
-// A variant of g_cclosure_new() which uses @object as @user_data and
-// calls g_object_watch_closure() on @object and the created
+// A variant of [CClosureNew] which uses object as user_data and
+// calls [Object.WatchClosure] on object and the created
 // closure. This function is useful when you have a callback closely
-// associated with a #GObject, and want the callback to no longer run
+// associated with a [Object], and want the callback to no longer run
 // after the object is is freed.
-func CclosureNewObject(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
+func CClosureNewObject(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
 
 	var CallbackFuncVarRef uintptr
 	if CallbackFuncVar != nil {
@@ -939,26 +940,38 @@ func CclosureNewObject(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
 			CallbackFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackFuncVar
 				cbFn()
 			}
-			CallbackFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
+			CallbackFuncVarRef = core.NewCallback(fcb)
+			CallbackFuncVarRef = glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
 		}
 	}
 
-	cret := xCclosureNewObject(CallbackFuncVarRef, ObjectVar.GoPointer())
+	cret := xCClosureNewObject(CallbackFuncVarRef, ObjectVar.GoPointer())
 	return cret
 }
 
-var xCclosureNewObjectSwap func(uintptr, uintptr) *Closure
+// A variant of [CClosureNew] which uses object as user_data and
+// calls [Object.WatchClosure] on object and the created
+// closure. This function is useful when you have a callback closely
+// associated with a [Object], and want the callback to no longer run
+// after the object is is freed.
+//
+// Deprecated: use CClosureNewObject, this name of g_cclosure_new_object is kept for compatibility.
+func CclosureNewObject(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
+	return CClosureNewObject(CallbackFuncVar, ObjectVar)
+}
+
+var xCClosureNewObjectSwap func(uintptr, uintptr) *Closure
 
-// A variant of g_cclosure_new_swap() which uses @object as @user_data
-// and calls g_object_watch_closure() on @object and the created
+// A variant of [CClosureNewSwap] which uses object as user_data
+// and calls [Object.WatchClosure] on object and the created
 // closure. This function is useful when you have a callback closely
-// associated with a #GObject, and want the callback to no longer run
+// associated with a [Object], and want the callback to no longer run
 // after the object is is freed.
-func CclosureNewObjectSwap(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
+func CClosureNewObjectSwap(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
 
 	var CallbackFuncVarRef uintptr
 	if CallbackFuncVar != nil {
@@ -967,27 +980,39 @@ func CclosureNewObjectSwap(CallbackFuncVar *Callback, ObjectVar *Object) *Closur
 			CallbackFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackFuncVar
 				cbFn()
 			}
-			CallbackFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
+			CallbackFuncVarRef = core.NewCallback(fcb)
+			CallbackFuncVarRef = glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
 		}
 	}
 
-	cret := xCclosureNewObjectSwap(CallbackFuncVarRef, ObjectVar.GoPointer())
+	cret := xCClosureNewObjectSwap(CallbackFuncVarRef, ObjectVar.GoPointer())
 	return cret
 }
 
+// A variant of [CClosureNewSwap] which uses object as user_data
+// and calls [Object.WatchClosure] on object and the created
+// closure. This function is useful when you have a callback closely
+// associated with a [Object], and want the callback to no longer run
+// after the object is is freed.
+//
+// Deprecated: use CClosureNewObjectSwap, this name of g_cclosure_new_object_swap is kept for compatibility.
+func CclosureNewObjectSwap(CallbackFuncVar *Callback, ObjectVar *Object) *Closure {
+	return CClosureNewObjectSwap(CallbackFuncVar, ObjectVar)
+}
+
 var xClearObject func(uintptr)
 
-// Clears a reference to a #GObject.
+// Clears a reference to a [Object].
 //
-// @object_ptr must not be %NULL.
+// object_ptr must not be nil.
 //
-// If the reference is %NULL then this function does nothing.
+// If the reference is nil then this function does nothing.
 // Otherwise, the reference count of the object is decreased and the
-// pointer is set to %NULL.
+// pointer is set to nil.
 //
 // A macro is also included that allows this function to be used without
 // pointer casts.
@@ -997,25 +1022,25 @@ func ClearObject(ObjectPtrVar **Object) {
 
 }
 
-var xSignalConnectObject func(*TypeInstance, string, uintptr, uintptr, ConnectFlags) uint
+var xSignalConnectObject func(*TypeInstance, string, uintptr, uintptr, ConnectFlags) types.ULong
 
-// This is similar to g_signal_connect_data(), but uses a closure which
-// ensures that the @gobject stays alive during the call to @c_handler
-// by temporarily adding a reference count to @gobject.
+// This is similar to [SignalConnectData], but uses a closure which
+// ensures that the gobject stays alive during the call to c_handler
+// by temporarily adding a reference count to gobject.
 //
-// When the @gobject is destroyed the signal handler will be automatically
+// When the gobject is destroyed the signal handler will be automatically
 // disconnected.  Note that this is not currently threadsafe (ie:
-// emitting a signal while @gobject is being destroyed in another thread
+// emitting a signal while gobject is being destroyed in another thread
 // is not safe).
 //
 // This function cannot fail. If the given signal name doesn’t exist,
 // a critical warning is emitted. No validation is performed on the
-// "detail" string when specified in @detailed_signal, other than a
+// "detail" string when specified in detailed_signal, other than a
 // non-empty check.
 //
-// Refer to the [signals documentation](signals.html) for more
+// Refer to the signals documentation for more
 // details.
-func SignalConnectObject(InstanceVar *TypeInstance, DetailedSignalVar string, CHandlerVar *Callback, GobjectVar *Object, ConnectFlagsVar ConnectFlags) uint {
+func SignalConnectObject(InstanceVar *TypeInstance, DetailedSignalVar string, CHandlerVar *Callback, GobjectVar *Object, ConnectFlagsVar ConnectFlags) types.ULong {
 
 	var CHandlerVarRef uintptr
 	if CHandlerVar != nil {
@@ -1024,11 +1049,12 @@ func SignalConnectObject(InstanceVar *TypeInstance, DetailedSignalVar string, CH
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -1078,9 +1104,9 @@ func (c *InitiallyUnowned) SetGoPointer(ptr uintptr) {
 // For a tutorial on implementing a new `GObject` class, see [How to define and
 // implement a new GObject](tutorial.html#how-to-define-and-implement-a-new-gobject).
 // For a list of naming conventions for GObjects and their methods, see the
-// [GType conventions](concepts.html#conventions). For the high-level concepts
+// GType conventions. For the high-level concepts
 // behind GObject, read
-// [Instantiatable classed types: Objects](concepts.html#instantiatable-classed-types-objects).
+// Instantiatable classed types: Objects.
 //
 // Since GLib 2.72, all `GObject`s are guaranteed to be aligned to at least the
 // alignment of the largest basic GLib type (typically this is `guint64` or
@@ -1107,50 +1133,41 @@ func ObjectNewFromInternalPtr(ptr uintptr) *Object {
 
 var xNewObject func(types.GType, string, ...interface{}) uintptr
 
-// Creates a new instance of a #GObject subtype and sets its properties.
+// Creates a new instance of a [Object] subtype and sets its properties.
 //
-// Construction parameters (see %G_PARAM_CONSTRUCT, %G_PARAM_CONSTRUCT_ONLY)
+// Construction parameters (see [GParamConstructValue], [GParamConstructOnlyValue])
 // which are not explicitly specified are set to their default values. Any
 // private data for the object is guaranteed to be initialized with zeros, as
-// per g_type_create_instance().
+// per [TypeCreateInstance].
 //
 // Note that in C, small integer types in variable argument lists are promoted
 // up to `gint` or `guint` as appropriate, and read back accordingly. `gint` is
 // 32 bits on every platform on which GLib is currently supported. This means that
-// you can use C expressions of type `gint` with g_object_new() and properties of
+// you can use C expressions of type `gint` with [NewObject] and properties of
 // type `gint` or `guint` or smaller. Specifically, you can use integer literals
 // with these property types.
 //
 // When using property types of `gint64` or `guint64`, you must ensure that the
 // value that you provide is 64 bit. This means that you should use a cast or
-// make use of the %G_GINT64_CONSTANT or %G_GUINT64_CONSTANT macros.
+// make use of the G_GINT64_CONSTANT or G_GUINT64_CONSTANT macros.
 //
 // Similarly, `gfloat` is promoted to `gdouble`, so you must ensure that the value
 // you provide is a `gdouble`, even for a property of type `gfloat`.
 //
-// Since GLib 2.72, all #GObjects are guaranteed to be aligned to at least the
+// Since GLib 2.72, all GObjects are guaranteed to be aligned to at least the
 // alignment of the largest basic GLib type (typically this is `guint64` or
-// `gdouble`). If you need larger alignment for an element in a #GObject, you
-// should allocate it on the heap (aligned), or arrange for your #GObject to be
+// `gdouble`). If you need larger alignment for an element in a [Object], you
+// should allocate it on the heap (aligned), or arrange for your [Object] to be
 // appropriately padded.
 func NewObject(ObjectTypeVar types.GType, FirstPropertyNameVar string, varArgs ...interface{}) *Object {
-	var cls *Object
-
-	cret := xNewObject(ObjectTypeVar, FirstPropertyNameVar, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Object{}
-	cls.Ptr = cret
-	return cls
+	return newObjectVarArgs(ObjectTypeVar, FirstPropertyNameVar, varArgs...)
 }
 
 var xNewObjectValist func(types.GType, string, []interface{}) uintptr
 
-// Creates a new instance of a #GObject subtype and sets its properties.
+// Creates a new instance of a [Object] subtype and sets its properties.
 //
-// Construction parameters (see %G_PARAM_CONSTRUCT, %G_PARAM_CONSTRUCT_ONLY)
+// Construction parameters (see [GParamConstructValue], [GParamConstructOnlyValue])
 // which are not explicitly specified are set to their default values.
 func NewObjectValist(ObjectTypeVar types.GType, FirstPropertyNameVar string, VarArgsVar []interface{}) *Object {
 	var cls *Object
@@ -1167,11 +1184,11 @@ func NewObjectValist(ObjectTypeVar types.GType, FirstPropertyNameVar string, Var
 
 var xNewObjectWithProperties func(types.GType, uint, []string, []Value) uintptr
 
-// Creates a new instance of a #GObject subtype and sets its properties using
-// the provided arrays. Both arrays must have exactly @n_properties elements,
+// Creates a new instance of a [Object] subtype and sets its properties using
+// the provided arrays. Both arrays must have exactly n_properties elements,
 // and the names and values correspond by index.
 //
-// Construction parameters (see %G_PARAM_CONSTRUCT, %G_PARAM_CONSTRUCT_ONLY)
+// Construction parameters (see [GParamConstructValue], [GParamConstructOnlyValue])
 // which are not explicitly specified are set to their default values.
 func NewObjectWithProperties(ObjectTypeVar types.GType, NPropertiesVar uint, NamesVar []string, ValuesVar []Value) *Object {
 	var cls *Object
@@ -1186,25 +1203,6 @@ func NewObjectWithProperties(ObjectTypeVar types.GType, NPropertiesVar uint, Nam
 	return cls
 }
 
-var xNewObjectv func(types.GType, uint, []Parameter) uintptr
-
-// Creates a new instance of a #GObject subtype and sets its properties.
-//
-// Construction parameters (see %G_PARAM_CONSTRUCT, %G_PARAM_CONSTRUCT_ONLY)
-// which are not explicitly specified are set to their default values.
-func NewObjectv(ObjectTypeVar types.GType, NParametersVar uint, ParametersVar []Parameter) *Object {
-	var cls *Object
-
-	cret := xNewObjectv(ObjectTypeVar, NParametersVar, ParametersVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Object{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xObjectAddToggleRef func(uintptr, uintptr, uintptr)
 
 // Increases the reference count of the object by one and sets a
@@ -1212,22 +1210,22 @@ var xObjectAddToggleRef func(uintptr, uintptr, uintptr)
 // dropped, or when this is already the last reference to the object
 // and another reference is established.
 //
-// This functionality is intended for binding @object to a proxy
+// This functionality is intended for binding object to a proxy
 // object managed by another memory manager. This is done with two
 // paired references: the strong reference added by
-// g_object_add_toggle_ref() and a reverse reference to the proxy
+// [Object.AddToggleRef] and a reverse reference to the proxy
 // object which is either a strong reference or weak reference.
 //
-// The setup is that when there are no other references to @object,
-// only a weak reference is held in the reverse direction from @object
+// The setup is that when there are no other references to object,
+// only a weak reference is held in the reverse direction from object
 // to the proxy object, but when there are other references held to
-// @object, a strong reference is held. The @notify callback is called
-// when the reference from @object to the proxy object should be
-// "toggled" from strong to weak (@is_last_ref true) or weak to strong
-// (@is_last_ref false).
+// object, a strong reference is held. The notify callback is called
+// when the reference from object to the proxy object should be
+// "toggled" from strong to weak (is_last_ref true) or weak to strong
+// (is_last_ref false).
 //
 // Since a (normal) reference must be held to the object before
-// calling g_object_add_toggle_ref(), the initial state of the reverse
+// calling [Object.AddToggleRef], the initial state of the reverse
 // link is always strong.
 //
 // Multiple toggle references may be added to the same gobject,
@@ -1236,12 +1234,12 @@ var xObjectAddToggleRef func(uintptr, uintptr, uintptr)
 // this reason, you should only ever use a toggle reference if there
 // is important state in the proxy object.
 //
-// Note that if you unref the object on another thread, then @notify might
-// still be invoked after g_object_remove_toggle_ref(), and the object argument
+// Note that if you unref the object on another thread, then notify might
+// still be invoked after [Object.RemoveToggleRef], and the object argument
 // might be a dangling pointer. If the object is destroyed on other threads,
 // you must take care of that yourself.
 //
-// A g_object_add_toggle_ref() must be released with g_object_remove_toggle_ref().
+// A [Object.AddToggleRef] must be released with [Object.RemoveToggleRef].
 func (x *Object) AddToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
 
 	var NotifyVarRef uintptr
@@ -1251,11 +1249,12 @@ func (x *Object) AddToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 bool) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0, arg1, arg2)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -1265,15 +1264,15 @@ func (x *Object) AddToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
 
 var xObjectAddWeakPointer func(uintptr, uintptr)
 
-// Adds a weak reference from weak_pointer to @object to indicate that
-// the pointer located at @weak_pointer_location is only valid during
-// the lifetime of @object. When the @object is finalized,
-// @weak_pointer will be set to %NULL.
+// Adds a weak reference from weak_pointer to object to indicate that
+// the pointer located at weak_pointer_location is only valid during
+// the lifetime of object. When the object is finalized,
+// weak_pointer will be set to nil.
 //
-// Note that as with g_object_weak_ref(), the weak references created by
+// Note that as with [Object.WeakRef], the weak references created by
 // this method are not thread-safe: they cannot safely be used in one
-// thread if the object's last g_object_unref() might happen in another
-// thread. Use #GWeakRef if thread-safety is required.
+// thread if the object's last [Object.Unref] might happen in another
+// thread. Use [WeakRef] if thread-safety is required.
 func (x *Object) AddWeakPointer(WeakPointerLocationVar uintptr) {
 
 	xObjectAddWeakPointer(x.GoPointer(), WeakPointerLocationVar)
@@ -1282,39 +1281,35 @@ func (x *Object) AddWeakPointer(WeakPointerLocationVar uintptr) {
 
 var xObjectBindProperty func(uintptr, string, uintptr, string, BindingFlags) uintptr
 
-// Creates a binding between @source_property on @source and @target_property
-// on @target.
+// Creates a binding between source_property on source and target_property
+// on target.
 //
-// Whenever the @source_property is changed the @target_property is
+// Whenever the source_property is changed the target_property is
 // updated using the same value. For instance:
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	g_object_bind_property (action, "active", widget, "sensitive", 0);
 //
-// ]|
-//
-// Will result in the "sensitive" property of the widget #GObject instance to be
-// updated with the same value of the "active" property of the action #GObject
+// Will result in the "sensitive" property of the widget [Object] instance to be
+// updated with the same value of the "active" property of the action [Object]
 // instance.
 //
-// If @flags contains %G_BINDING_BIDIRECTIONAL then the binding will be mutual:
-// if @target_property on @target changes then the @source_property on @source
+// If flags contains [GBindingBidirectionalValue] then the binding will be mutual:
+// if target_property on target changes then the source_property on source
 // will be updated as well.
 //
-// The binding will automatically be removed when either the @source or the
-// @target instances are finalized. To remove the binding without affecting the
-// @source and the @target you can just call g_object_unref() on the returned
-// #GBinding instance.
+// The binding will automatically be removed when either the source or the
+// target instances are finalized. To remove the binding without affecting the
+// source and the target you can just call [Object.Unref] on the returned
+// [Binding] instance.
 //
-// Removing the binding by calling g_object_unref() on it must only be done if
-// the binding, @source and @target are only used from a single thread and it
-// is clear that both @source and @target outlive the binding. Especially it
-// is not safe to rely on this if the binding, @source or @target can be
+// Removing the binding by calling [Object.Unref] on it must only be done if
+// the binding, source and target are only used from a single thread and it
+// is clear that both source and target outlive the binding. Especially it
+// is not safe to rely on this if the binding, source or target can be
 // finalized from different threads. Keep another reference to the binding and
-// use g_binding_unbind() instead to be on the safe side.
+// use [Binding.Unbind] instead to be on the safe side.
 //
-// A #GObject can have multiple bindings.
+// A [Object] can have multiple bindings.
 func (x *Object) BindProperty(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags) *Binding {
 	var cls *Binding
 
@@ -1331,31 +1326,33 @@ func (x *Object) BindProperty(SourcePropertyVar string, TargetVar *Object, Targe
 
 var xObjectBindPropertyFull func(uintptr, string, uintptr, string, BindingFlags, uintptr, uintptr, uintptr, uintptr) uintptr
 
-// Complete version of g_object_bind_property().
+// Complete version of [Object.BindProperty].
 //
-// Creates a binding between @source_property on @source and @target_property
-// on @target, allowing you to set the transformation functions to be used by
+// Creates a binding between source_property on source and target_property
+// on target, allowing you to set the transformation functions to be used by
 // the binding.
 //
-// If @flags contains %G_BINDING_BIDIRECTIONAL then the binding will be mutual:
-// if @target_property on @target changes then the @source_property on @source
-// will be updated as well. The @transform_from function is only used in case
+// If flags contains [GBindingBidirectionalValue] then the binding will be mutual:
+// if target_property on target changes then the source_property on source
+// will be updated as well. The transform_from function is only used in case
 // of bidirectional bindings, otherwise it will be ignored
 //
-// The binding will automatically be removed when either the @source or the
-// @target instances are finalized. This will release the reference that is
-// being held on the #GBinding instance; if you want to hold on to the
-// #GBinding instance, you will need to hold a reference to it.
+// The binding will automatically be removed when either the source or the
+// target instances are finalized. This will release the reference that is
+// being held on the [Binding] instance; if you want to hold on to the
+// [Binding] instance, you will need to hold a reference to it.
 //
-// To remove the binding, call g_binding_unbind().
+// To remove the binding, call [Binding.Unbind].
 //
-// A #GObject can have multiple bindings.
+// A [Object] can have multiple bindings.
 //
-// The same @user_data parameter will be used for both @transform_to
-// and @transform_from transformation functions; the @notify function will
+// The same user_data parameter will be used for both transform_to
+// and transform_from transformation functions; the notify function will
 // be called once, when the binding is removed. If you need different data
 // for each transformation function, please use
-// g_object_bind_property_with_closures() instead.
+// [Object.BindPropertyWithClosures] instead.
+//
+// Deprecated: use [BindPropertyWithClosures], the version of this function that language bindings are meant to use.
 func (x *Object) BindPropertyFull(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags, TransformToVar *BindingTransformFunc, TransformFromVar *BindingTransformFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) *Binding {
 	var cls *Binding
 
@@ -1366,11 +1363,12 @@ func (x *Object) BindPropertyFull(SourcePropertyVar string, TargetVar *Object, T
 			TransformToVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *TransformToVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			TransformToVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TransformToVarPtr, TransformToVarRef, TransformToVar)
+			TransformToVarRef = core.NewCallback(fcb)
+			TransformToVarRef = glib.SaveCallbackWithClosure(TransformToVarPtr, TransformToVarRef, TransformToVar)
 		}
 	}
 
@@ -1381,11 +1379,12 @@ func (x *Object) BindPropertyFull(SourcePropertyVar string, TargetVar *Object, T
 			TransformFromVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *TransformFromVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			TransformFromVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TransformFromVarPtr, TransformFromVarRef, TransformFromVar)
+			TransformFromVarRef = core.NewCallback(fcb)
+			TransformFromVarRef = glib.SaveCallbackWithClosure(TransformFromVarPtr, TransformFromVarRef, TransformFromVar)
 		}
 	}
 
@@ -1396,11 +1395,12 @@ func (x *Object) BindPropertyFull(SourcePropertyVar string, TargetVar *Object, T
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -1417,12 +1417,12 @@ func (x *Object) BindPropertyFull(SourcePropertyVar string, TargetVar *Object, T
 
 var xObjectBindPropertyWithClosures func(uintptr, string, uintptr, string, BindingFlags, *Closure, *Closure) uintptr
 
-// Creates a binding between @source_property on @source and @target_property
-// on @target, allowing you to set the transformation functions to be used by
+// Creates a binding between source_property on source and target_property
+// on target, allowing you to set the transformation functions to be used by
 // the binding.
 //
 // This function is the language bindings friendly version of
-// g_object_bind_property_full(), using #GClosures instead of
+// [Object.BindPropertyFull], using GClosures instead of
 // function pointers.
 func (x *Object) BindPropertyWithClosures(SourcePropertyVar string, TargetVar *Object, TargetPropertyVar string, FlagsVar BindingFlags, TransformToVar *Closure, TransformFromVar *Closure) *Binding {
 	var cls *Binding
@@ -1455,18 +1455,14 @@ var xObjectConnect func(uintptr, string, ...interface{}) uintptr
 // - `swapped_signal_after`, `swapped-signal-after`: equivalent to `g_signal_connect_data (..., NULL, G_CONNECT_SWAPPED | G_CONNECT_AFTER)`
 // - `swapped_object_signal_after`, `swapped-object-signal-after`: equivalent to `g_signal_connect_object (..., G_CONNECT_SWAPPED | G_CONNECT_AFTER)`
 //
-// ```c
-// menu-&gt;toplevel = g_object_connect (g_object_new (GTK_TYPE_WINDOW,
-//
-//	              "type", GTK_WINDOW_POPUP,
-//	              "child", menu,
-//	              NULL),
-//	"signal::event", gtk_menu_window_event, menu,
-//	"signal::size_request", gtk_menu_window_size_request, menu,
-//	"signal::destroy", gtk_widget_destroyed, &amp;menu-&gt;toplevel,
-//	NULL);
-//
-// ```
+//	menu->toplevel = g_object_connect (g_object_new (GTK_TYPE_WINDOW,
+//	                                                 "type", GTK_WINDOW_POPUP,
+//	                                                 "child", menu,
+//	                                                 NULL),
+//	                                   "signal::event", gtk_menu_window_event, menu,
+//	                                   "signal::size_request", gtk_menu_window_size_request, menu,
+//	                                   "signal::destroy", gtk_widget_destroyed, &menu->toplevel,
+//	                                   NULL);
 func (x *Object) Connect(SignalSpecVar string, varArgs ...interface{}) *Object {
 	var cls *Object
 
@@ -1497,15 +1493,15 @@ func (x *Object) Disconnect(SignalSpecVar string, varArgs ...interface{}) {
 
 var xObjectDupData func(uintptr, string, uintptr, uintptr) uintptr
 
-// This is a variant of g_object_get_data() which returns
-// a 'duplicate' of the value. @dup_func defines the
+// This is a variant of [Object.GetData] which returns
+// a 'duplicate' of the value. dup_func defines the
 // meaning of 'duplicate' in this context, it could e.g.
 // take a reference on a ref-counted object.
 //
-// If the @key is not set on the object then @dup_func
-// will be called with a %NULL argument.
+// If the key is not set on the object then dup_func
+// will be called with a nil argument.
 //
-// Note that @dup_func is called while user data of @object
+// Note that dup_func is called while user data of object
 // is locked.
 //
 // This function can be useful to avoid races when multiple
@@ -1520,11 +1516,12 @@ func (x *Object) DupData(KeyVar string, DupFuncVar *glib.DuplicateFunc, UserData
 			DupFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *DupFuncVar
 				return cbFn(arg0, arg1)
 			}
-			DupFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
+			DupFuncVarRef = core.NewCallback(fcb)
+			DupFuncVarRef = glib.SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
 		}
 	}
 
@@ -1534,15 +1531,15 @@ func (x *Object) DupData(KeyVar string, DupFuncVar *glib.DuplicateFunc, UserData
 
 var xObjectDupQdata func(uintptr, glib.Quark, uintptr, uintptr) uintptr
 
-// This is a variant of g_object_get_qdata() which returns
-// a 'duplicate' of the value. @dup_func defines the
+// This is a variant of [Object.GetQdata] which returns
+// a 'duplicate' of the value. dup_func defines the
 // meaning of 'duplicate' in this context, it could e.g.
 // take a reference on a ref-counted object.
 //
-// If the @quark is not set on the object then @dup_func
-// will be called with a %NULL argument.
+// If the quark is not set on the object then dup_func
+// will be called with a nil argument.
 //
-// Note that @dup_func is called while user data of @object
+// Note that dup_func is called while user data of object
 // is locked.
 //
 // This function can be useful to avoid races when multiple
@@ -1557,11 +1554,12 @@ func (x *Object) DupQdata(QuarkVar glib.Quark, DupFuncVar *glib.DuplicateFunc, U
 			DupFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *DupFuncVar
 				return cbFn(arg0, arg1)
 			}
-			DupFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
+			DupFuncVarRef = core.NewCallback(fcb)
+			DupFuncVarRef = glib.SaveCallbackWithClosure(DupFuncVarPtr, DupFuncVarRef, DupFuncVar)
 		}
 	}
 
@@ -1571,10 +1569,10 @@ func (x *Object) DupQdata(QuarkVar glib.Quark, DupFuncVar *glib.DuplicateFunc, U
 
 var xObjectForceFloating func(uintptr)
 
-// This function is intended for #GObject implementations to re-enforce
+// This function is intended for [Object] implementations to re-enforce
 // a [floating][floating-ref] object reference. Doing this is seldom
-// required: all #GInitiallyUnowneds are created with a floating reference
-// which usually just needs to be sunken by calling g_object_ref_sink().
+// required: all GInitiallyUnowneds are created with a floating reference
+// which usually just needs to be sunken by calling [Object.RefSink].
 func (x *Object) ForceFloating() {
 
 	xObjectForceFloating(x.GoPointer())
@@ -1583,11 +1581,11 @@ func (x *Object) ForceFloating() {
 
 var xObjectFreezeNotify func(uintptr)
 
-// Increases the freeze count on @object. If the freeze count is
-// non-zero, the emission of "notify" signals on @object is
+// Increases the freeze count on object. If the freeze count is
+// non-zero, the emission of "notify" signals on object is
 // stopped. The signals are queued until the freeze count is decreased
 // to zero. Duplicate notifications are squashed so that at most one
-// #GObject::notify signal is emitted for each property modified while the
+// [Object.ConnectNotifyFunc] signal is emitted for each property modified while the
 // object is frozen.
 //
 // This is necessary for accessors that modify multiple properties to prevent
@@ -1604,11 +1602,10 @@ var xObjectGet func(uintptr, string, ...interface{})
 //
 // In general, a copy is made of the property contents and the caller
 // is responsible for freeing the memory in the appropriate manner for
-// the type, for instance by calling g_free() or g_object_unref().
+// the type, for instance by calling [glib.Free] or [Object.Unref].
 //
-// Here is an example of using g_object_get() to get the contents
+// Here is an example of using [Object.Get] to get the contents
 // of three properties: an integer, a string and an object:
-// |[&lt;!-- language="C" --&gt;
 //
 //	gint intval;
 //	guint64 uint64val;
@@ -1616,27 +1613,23 @@ var xObjectGet func(uintptr, string, ...interface{})
 //	GObject *objval;
 //
 //	g_object_get (my_object,
-//	              "int-property", &amp;intval,
-//	              "uint64-property", &amp;uint64val,
-//	              "str-property", &amp;strval,
-//	              "obj-property", &amp;objval,
+//	              "int-property", &intval,
+//	              "uint64-property", &uint64val,
+//	              "str-property", &strval,
+//	              "obj-property", &objval,
 //	              NULL);
 //
 //	// Do something with intval, uint64val, strval, objval
 //
 //	g_free (strval);
 //	g_object_unref (objval);
-//
-// ]|
 func (x *Object) Get(FirstPropertyNameVar string, varArgs ...interface{}) {
-
-	xObjectGet(x.GoPointer(), FirstPropertyNameVar, varArgs...)
-
+	objectGetVarArgs(x, FirstPropertyNameVar, varArgs...)
 }
 
 var xObjectGetData func(uintptr, string) uintptr
 
-// Gets a named field from the objects table of associations (see g_object_set_data()).
+// Gets a named field from the objects table of associations (see [Object.SetData]).
 func (x *Object) GetData(KeyVar string) uintptr {
 
 	cret := xObjectGetData(x.GoPointer(), KeyVar)
@@ -1647,20 +1640,20 @@ var xObjectGetProperty func(uintptr, string, *Value)
 
 // Gets a property of an object.
 //
-// The @value can be:
+// The value can be:
 //
-//   - an empty #GValue initialized by %G_VALUE_INIT, which will be
+//   - an empty [Value] initialized by G_VALUE_INIT, which will be
 //     automatically initialized with the expected type of the property
 //     (since GLib 2.60)
-//   - a #GValue initialized with the expected type of the property
-//   - a #GValue initialized with a type to which the expected type
+//   - a [Value] initialized with the expected type of the property
+//   - a [Value] initialized with a type to which the expected type
 //     of the property can be transformed
 //
 // In general, a copy is made of the property contents and the caller is
-// responsible for freeing the memory by calling g_value_unset().
+// responsible for freeing the memory by calling [Value.Unset].
 //
-// Note that g_object_get_property() is really intended for language
-// bindings, g_object_get() is much more convenient for C programming.
+// Note that [Object.GetProperty] is really intended for language
+// bindings, [Object.Get] is much more convenient for C programming.
 func (x *Object) GetProperty(PropertyNameVar string, ValueVar *Value) {
 
 	xObjectGetProperty(x.GoPointer(), PropertyNameVar, ValueVar)
@@ -1670,7 +1663,7 @@ func (x *Object) GetProperty(PropertyNameVar string, ValueVar *Value) {
 var xObjectGetQdata func(uintptr, glib.Quark) uintptr
 
 // This function gets back user data pointers stored via
-// g_object_set_qdata().
+// [Object.SetQdata].
 func (x *Object) GetQdata(QuarkVar glib.Quark) uintptr {
 
 	cret := xObjectGetQdata(x.GoPointer(), QuarkVar)
@@ -1683,9 +1676,9 @@ var xObjectGetValist func(uintptr, string, []interface{})
 //
 // In general, a copy is made of the property contents and the caller
 // is responsible for freeing the memory in the appropriate manner for
-// the type, for instance by calling g_free() or g_object_unref().
+// the type, for instance by calling [glib.Free] or [Object.Unref].
 //
-// See g_object_get().
+// See [Object.Get].
 func (x *Object) GetValist(FirstPropertyNameVar string, VarArgsVar []interface{}) {
 
 	xObjectGetValist(x.GoPointer(), FirstPropertyNameVar, VarArgsVar)
@@ -1694,8 +1687,8 @@ func (x *Object) GetValist(FirstPropertyNameVar string, VarArgsVar []interface{}
 
 var xObjectGetv func(uintptr, uint, []string, []Value)
 
-// Gets @n_properties properties for an @object.
-// Obtained properties will be set to @values. All properties must be valid.
+// Gets n_properties properties for an object.
+// Obtained properties will be set to values. All properties must be valid.
 // Warnings will be emitted and undefined behaviour may result if invalid
 // properties are passed in.
 func (x *Object) Getv(NPropertiesVar uint, NamesVar []string, ValuesVar []Value) {
@@ -1706,7 +1699,7 @@ func (x *Object) Getv(NPropertiesVar uint, NamesVar []string, ValuesVar []Value)
 
 var xObjectIsFloating func(uintptr) bool
 
-// Checks whether @object has a [floating][floating-ref] reference.
+// Checks whether object has a [floating][floating-ref] reference.
 func (x *Object) IsFloating() bool {
 
 	cret := xObjectIsFloating(x.GoPointer())
@@ -1715,15 +1708,15 @@ func (x *Object) IsFloating() bool {
 
 var xObjectNotify func(uintptr, string)
 
-// Emits a "notify" signal for the property @property_name on @object.
+// Emits a "notify" signal for the property property_name on object.
 //
 // When possible, eg. when signaling a property change from within the class
-// that registered the property, you should use g_object_notify_by_pspec()
+// that registered the property, you should use [Object.NotifyByPspec]
 // instead.
 //
 // Note that emission of the notify signal may be blocked with
-// g_object_freeze_notify(). In this case, the signal emissions are queued
-// and will be emitted (in reverse order) when g_object_thaw_notify() is
+// [Object.FreezeNotify]. In this case, the signal emissions are queued
+// and will be emitted (in reverse order) when [Object.ThawNotify] is
 // called.
 func (x *Object) Notify(PropertyNameVar string) {
 
@@ -1733,17 +1726,15 @@ func (x *Object) Notify(PropertyNameVar string) {
 
 var xObjectNotifyByPspec func(uintptr, uintptr)
 
-// Emits a "notify" signal for the property specified by @pspec on @object.
+// Emits a "notify" signal for the property specified by pspec on object.
 //
 // This function omits the property name lookup, hence it is faster than
-// g_object_notify().
+// [Object.Notify].
 //
-// One way to avoid using g_object_notify() from within the
-// class that registered the properties, and using g_object_notify_by_pspec()
+// One way to avoid using [Object.Notify] from within the
+// class that registered the properties, and using [Object.NotifyByPspec]
 // instead, is to store the GParamSpec used with
-// g_object_class_install_property() inside a static array, e.g.:
-//
-// |[&lt;!-- language="C" --&gt;
+// [ObjectClass.InstallProperty] inside a static array, e.g.:
 //
 //	typedef enum
 //	{
@@ -1765,15 +1756,9 @@ var xObjectNotifyByPspec func(uintptr, uintptr)
 //	                                   properties[PROP_FOO]);
 //	}
 //
-// ]|
-//
 // and then notify a change on the "foo" property with:
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	g_object_notify_by_pspec (self, properties[PROP_FOO]);
-//
-// ]|
 func (x *Object) NotifyByPspec(PspecVar *ParamSpec) {
 
 	xObjectNotifyByPspec(x.GoPointer(), PspecVar.GoPointer())
@@ -1782,10 +1767,10 @@ func (x *Object) NotifyByPspec(PspecVar *ParamSpec) {
 
 var xObjectRef func(uintptr) uintptr
 
-// Increases the reference count of @object.
+// Increases the reference count of object.
 //
 // Since GLib 2.56, if `GLIB_VERSION_MAX_ALLOWED` is 2.56 or greater, the type
-// of @object will be propagated to the return type (using the GCC typeof()
+// of object will be propagated to the return type (using the GCC typeof()
 // extension), so any casting the caller needs to do on the return type must be
 // explicit.
 func (x *Object) Ref() *Object {
@@ -1803,8 +1788,8 @@ func (x *Object) Ref() *Object {
 
 var xObjectRefSink func(uintptr) uintptr
 
-// Increase the reference count of @object, and possibly remove the
-// [floating][floating-ref] reference, if @object has a floating reference.
+// Increase the reference count of object, and possibly remove the
+// [floating][floating-ref] reference, if object has a floating reference.
 //
 // In other words, if the object is floating, then this call "assumes
 // ownership" of the floating reference, converting it to a normal
@@ -1812,8 +1797,8 @@ var xObjectRefSink func(uintptr) uintptr
 // count unchanged.  If the object is not floating, then this call
 // adds a new normal reference increasing the reference count by one.
 //
-// Since GLib 2.56, the type of @object will be propagated to the return type
-// under the same conditions as for g_object_ref().
+// Since GLib 2.56, the type of object will be propagated to the return type
+// under the same conditions as for [Object.Ref].
 func (x *Object) RefSink() *Object {
 	var cls *Object
 
@@ -1830,11 +1815,11 @@ func (x *Object) RefSink() *Object {
 
 var xObjectRemoveToggleRef func(uintptr, uintptr, uintptr)
 
-// Removes a reference added with g_object_add_toggle_ref(). The
+// Removes a reference added with [Object.AddToggleRef]. The
 // reference count of the object is decreased by one.
 //
-// Note that if you unref the object on another thread, then @notify might
-// still be invoked after g_object_remove_toggle_ref(), and the object argument
+// Note that if you unref the object on another thread, then notify might
+// still be invoked after [Object.RemoveToggleRef], and the object argument
 // might be a dangling pointer. If the object is destroyed on other threads,
 // you must take care of that yourself.
 func (x *Object) RemoveToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
@@ -1846,11 +1831,12 @@ func (x *Object) RemoveToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 bool) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0, arg1, arg2)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -1860,9 +1846,9 @@ func (x *Object) RemoveToggleRef(NotifyVar *ToggleNotify, DataVar uintptr) {
 
 var xObjectRemoveWeakPointer func(uintptr, uintptr)
 
-// Removes a weak reference from @object that was previously added
-// using g_object_add_weak_pointer(). The @weak_pointer_location has
-// to match the one used with g_object_add_weak_pointer().
+// Removes a weak reference from object that was previously added
+// using [Object.AddWeakPointer]. The weak_pointer_location has
+// to match the one used with [Object.AddWeakPointer].
 func (x *Object) RemoveWeakPointer(WeakPointerLocationVar uintptr) {
 
 	xObjectRemoveWeakPointer(x.GoPointer(), WeakPointerLocationVar)
@@ -1871,22 +1857,22 @@ func (x *Object) RemoveWeakPointer(WeakPointerLocationVar uintptr) {
 
 var xObjectReplaceData func(uintptr, string, uintptr, uintptr, uintptr, *glib.DestroyNotify) bool
 
-// Compares the user data for the key @key on @object with
-// @oldval, and if they are the same, replaces @oldval with
-// @newval.
+// Compares the user data for the key key on object with
+// oldval, and if they are the same, replaces oldval with
+// newval.
 //
 // This is like a typical atomic compare-and-exchange
 // operation, for user data on an object.
 //
 // If the previous value was replaced then ownership of the
-// old value (@oldval) is passed to the caller, including
-// the registered destroy notify for it (passed out in @old_destroy).
+// old value (oldval) is passed to the caller, including
+// the registered destroy notify for it (passed out in old_destroy).
 // It’s up to the caller to free this as needed, which may
-// or may not include using @old_destroy as sometimes replacement
+// or may not include using old_destroy as sometimes replacement
 // should not destroy the object in the normal way.
 //
-// See g_object_set_data() for guidance on using a small, bounded set of values
-// for @key.
+// See [Object.SetData] for guidance on using a small, bounded set of values
+// for key.
 func (x *Object) ReplaceData(KeyVar string, OldvalVar uintptr, NewvalVar uintptr, DestroyVar *glib.DestroyNotify, OldDestroyVar *glib.DestroyNotify) bool {
 
 	var DestroyVarRef uintptr
@@ -1896,11 +1882,12 @@ func (x *Object) ReplaceData(KeyVar string, OldvalVar uintptr, NewvalVar uintptr
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -1910,18 +1897,18 @@ func (x *Object) ReplaceData(KeyVar string, OldvalVar uintptr, NewvalVar uintptr
 
 var xObjectReplaceQdata func(uintptr, glib.Quark, uintptr, uintptr, uintptr, *glib.DestroyNotify) bool
 
-// Compares the user data for the key @quark on @object with
-// @oldval, and if they are the same, replaces @oldval with
-// @newval.
+// Compares the user data for the key quark on object with
+// oldval, and if they are the same, replaces oldval with
+// newval.
 //
 // This is like a typical atomic compare-and-exchange
 // operation, for user data on an object.
 //
 // If the previous value was replaced then ownership of the
-// old value (@oldval) is passed to the caller, including
-// the registered destroy notify for it (passed out in @old_destroy).
+// old value (oldval) is passed to the caller, including
+// the registered destroy notify for it (passed out in old_destroy).
 // It’s up to the caller to free this as needed, which may
-// or may not include using @old_destroy as sometimes replacement
+// or may not include using old_destroy as sometimes replacement
 // should not destroy the object in the normal way.
 func (x *Object) ReplaceQdata(QuarkVar glib.Quark, OldvalVar uintptr, NewvalVar uintptr, DestroyVar *glib.DestroyNotify, OldDestroyVar *glib.DestroyNotify) bool {
 
@@ -1932,11 +1919,12 @@ func (x *Object) ReplaceQdata(QuarkVar glib.Quark, OldvalVar uintptr, NewvalVar
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -1961,17 +1949,15 @@ var xObjectSet func(uintptr, string, ...interface{})
 // Sets properties on an object.
 //
 // The same caveats about passing integer literals as varargs apply as with
-// g_object_new(). In particular, any integer literals set as the values for
+// [NewObject]. In particular, any integer literals set as the values for
 // properties of type #gint64 or #guint64 must be 64 bits wide, using the
-// %G_GINT64_CONSTANT or %G_GUINT64_CONSTANT macros.
+// G_GINT64_CONSTANT or G_GUINT64_CONSTANT macros.
 //
 // Note that the "notify" signals are queued and only emitted (in
 // reverse order) after all properties have been set. See
-// g_object_freeze_notify().
+// [Object.FreezeNotify].
 func (x *Object) Set(FirstPropertyNameVar string, varArgs ...interface{}) {
-
-	xObjectSet(x.GoPointer(), FirstPropertyNameVar, varArgs...)
-
+	objectSetVarArgs(x, FirstPropertyNameVar, varArgs...)
 }
 
 var xObjectSetData func(uintptr, string, uintptr)
@@ -1982,10 +1968,10 @@ var xObjectSetData func(uintptr, string, uintptr)
 // If the object already had an association with that name,
 // the old association will be destroyed.
 //
-// Internally, the @key is converted to a #GQuark using g_quark_from_string().
-// This means a copy of @key is kept permanently (even after @object has been
+// Internally, the key is converted to a [glib.Quark] using [glib.QuarkFromString].
+// This means a copy of key is kept permanently (even after object has been
 // finalized) — so it is recommended to only use a small, bounded set of values
-// for @key in your program, to avoid the #GQuark storage growing unbounded.
+// for key in your program, to avoid the [glib.Quark] storage growing unbounded.
 func (x *Object) SetData(KeyVar string, DataVar uintptr) {
 
 	xObjectSetData(x.GoPointer(), KeyVar, DataVar)
@@ -1994,11 +1980,11 @@ func (x *Object) SetData(KeyVar string, DataVar uintptr) {
 
 var xObjectSetDataFull func(uintptr, string, uintptr, uintptr)
 
-// Like g_object_set_data() except it adds notification
+// Like [Object.SetData] except it adds notification
 // for when the association is destroyed, either by setting it
 // to a different value or when the object is destroyed.
 //
-// Note that the @destroy callback is not called if @data is %NULL.
+// Note that the destroy callback is not called if data is nil.
 func (x *Object) SetDataFull(KeyVar string, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -2008,11 +1994,12 @@ func (x *Object) SetDataFull(KeyVar string, DataVar uintptr, DestroyVar *glib.De
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -2032,12 +2019,12 @@ func (x *Object) SetProperty(PropertyNameVar string, ValueVar *Value) {
 var xObjectSetQdata func(uintptr, glib.Quark, uintptr)
 
 // This sets an opaque, named pointer on an object.
-// The name is specified through a #GQuark (retrieved e.g. via
-// g_quark_from_static_string()), and the pointer
-// can be gotten back from the @object with g_object_get_qdata()
-// until the @object is finalized.
+// The name is specified through a [glib.Quark] (retrieved e.g. via
+// [glib.QuarkFromStaticString]), and the pointer
+// can be gotten back from the object with [Object.GetQdata]
+// until the object is finalized.
 // Setting a previously set user data pointer, overrides (frees)
-// the old pointer set, using #NULL as pointer essentially
+// the old pointer set, using NULL as pointer essentially
 // removes the data stored.
 func (x *Object) SetQdata(QuarkVar glib.Quark, DataVar uintptr) {
 
@@ -2047,11 +2034,11 @@ func (x *Object) SetQdata(QuarkVar glib.Quark, DataVar uintptr) {
 
 var xObjectSetQdataFull func(uintptr, glib.Quark, uintptr, uintptr)
 
-// This function works like g_object_set_qdata(), but in addition,
+// This function works like [Object.SetQdata], but in addition,
 // a void (*destroy) (gpointer) function may be specified which is
-// called with @data as argument when the @object is finalized, or
-// the data is being overwritten by a call to g_object_set_qdata()
-// with the same @quark.
+// called with data as argument when the object is finalized, or
+// the data is being overwritten by a call to [Object.SetQdata]
+// with the same quark.
 func (x *Object) SetQdataFull(QuarkVar glib.Quark, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -2061,11 +2048,12 @@ func (x *Object) SetQdataFull(QuarkVar glib.Quark, DataVar uintptr, DestroyVar *
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -2084,8 +2072,8 @@ func (x *Object) SetValist(FirstPropertyNameVar string, VarArgsVar []interface{}
 
 var xObjectSetv func(uintptr, uint, []string, []Value)
 
-// Sets @n_properties properties for an @object.
-// Properties to be set will be taken from @values. All properties must be
+// Sets n_properties properties for an object.
+// Properties to be set will be taken from values. All properties must be
 // valid. Warnings will be emitted and undefined behaviour may result if invalid
 // properties are passed in.
 func (x *Object) Setv(NPropertiesVar uint, NamesVar []string, ValuesVar []Value) {
@@ -2107,17 +2095,15 @@ func (x *Object) StealData(KeyVar string) uintptr {
 var xObjectStealQdata func(uintptr, glib.Quark) uintptr
 
 // This function gets back user data pointers stored via
-// g_object_set_qdata() and removes the @data from object
+// [Object.SetQdata] and removes the data from object
 // without invoking its destroy() function (if any was
 // set).
 // Usually, calling this function is only required to update
 // user data pointers with a destroy notifier, for example:
-// |[&lt;!-- language="C" --&gt;
-// void
-// object_add_to_user_list (GObject     *object,
-//
-//	const gchar *new_string)
 //
+//	void
+//	object_add_to_user_list (GObject     *object,
+//	                         const gchar *new_string)
 //	{
 //	  // the quark, naming the object data
 //	  GQuark quark_string_list = g_quark_from_static_string ("my-string-list");
@@ -2129,23 +2115,20 @@ var xObjectStealQdata func(uintptr, glib.Quark) uintptr
 //	  // this changed 'list', so we need to set it again
 //	  g_object_set_qdata_full (object, quark_string_list, list, free_string_list);
 //	}
-//
-// static void
-// free_string_list (gpointer data)
-//
+//	static void
+//	free_string_list (gpointer data)
 //	{
 //	  GList *node, *list = data;
 //
-//	  for (node = list; node; node = node-&gt;next)
-//	    g_free (node-&gt;data);
+//	  for (node = list; node; node = node->next)
+//	    g_free (node->data);
 //	  g_list_free (list);
 //	}
 //
-// ]|
-// Using g_object_get_qdata() in the above example, instead of
-// g_object_steal_qdata() would have left the destroy function set,
+// Using [Object.GetQdata] in the above example, instead of
+// [Object.StealQdata] would have left the destroy function set,
 // and thus the partial string list would have been freed upon
-// g_object_set_qdata_full().
+// [Object.SetQdataFull].
 func (x *Object) StealQdata(QuarkVar glib.Quark) uintptr {
 
 	cret := xObjectStealQdata(x.GoPointer(), QuarkVar)
@@ -2154,12 +2137,12 @@ func (x *Object) StealQdata(QuarkVar glib.Quark) uintptr {
 
 var xObjectTakeRef func(uintptr) uintptr
 
-// If @object is floating, sink it.  Otherwise, do nothing.
+// If object is floating, sink it.  Otherwise, do nothing.
 //
 // In other words, this function will convert a floating reference (if
 // present) into a full reference.
 //
-// Typically you want to use g_object_ref_sink() in order to
+// Typically you want to use [Object.RefSink] in order to
 // automatically do the correct thing with respect to floating or
 // non-floating references, but there is one specific scenario where
 // this function is helpful.
@@ -2182,10 +2165,10 @@ var xObjectTakeRef func(uintptr) uintptr
 // that has been converted to a full reference.
 //
 // This function has an odd interaction when combined with
-// g_object_ref_sink() running at the same time in another thread on
-// the same #GObject instance. If g_object_ref_sink() runs first then
+// [Object.RefSink] running at the same time in another thread on
+// the same [Object] instance. If [Object.RefSink] runs first then
 // the result will be that the floating reference is converted to a hard
-// reference. If g_object_take_ref() runs first then the result will be
+// reference. If [Object.TakeRef] runs first then the result will be
 // that the floating reference is converted to a hard reference and an
 // additional reference on top of that one is added. It is best to avoid
 // this situation.
@@ -2205,11 +2188,11 @@ func (x *Object) TakeRef() *Object {
 var xObjectThawNotify func(uintptr)
 
 // Reverts the effect of a previous call to
-// g_object_freeze_notify(). The freeze count is decreased on @object
+// [Object.FreezeNotify]. The freeze count is decreased on object
 // and when it reaches zero, queued "notify" signals are emitted.
 //
 // Duplicate notifications for each property are squashed so that at most one
-// #GObject::notify signal is emitted for each property, in the reverse order
+// [Object.ConnectNotifyFunc] signal is emitted for each property, in the reverse order
 // in which they have been queued.
 //
 // It is an error to call this function when the freeze count is zero.
@@ -2221,13 +2204,13 @@ func (x *Object) ThawNotify() {
 
 var xObjectUnref func(uintptr)
 
-// Decreases the reference count of @object. When its reference count
+// Decreases the reference count of object. When its reference count
 // drops to 0, the object is finalized (i.e. its memory is freed).
 //
-// If the pointer to the #GObject may be reused in future (for example, if it is
+// If the pointer to the [Object] may be reused in future (for example, if it is
 // an instance variable of another object), it is recommended to clear the
-// pointer to %NULL rather than retain a dangling pointer to a potentially
-// invalid #GObject instance. Use g_clear_object() for this.
+// pointer to nil rather than retain a dangling pointer to a potentially
+// invalid [Object] instance. Use [ClearObject] for this.
 func (x *Object) Unref() {
 
 	xObjectUnref(x.GoPointer())
@@ -2236,15 +2219,15 @@ func (x *Object) Unref() {
 
 var xObjectWatchClosure func(uintptr, *Closure)
 
-// This function essentially limits the life time of the @closure to
+// This function essentially limits the life time of the closure to
 // the life time of the object. That is, when the object is finalized,
-// the @closure is invalidated by calling g_closure_invalidate() on
+// the closure is invalidated by calling [Closure.Invalidate] on
 // it, in order to prevent invocations of the closure with a finalized
-// (nonexisting) object. Also, g_object_ref() and g_object_unref() are
-// added as marshal guards to the @closure, to ensure that an extra
-// reference count is held on @object during invocation of the
-// @closure.  Usually, this function will be called on closures that
-// use this @object as closure data.
+// (nonexisting) object. Also, [Object.Ref] and [Object.Unref] are
+// added as marshal guards to the closure, to ensure that an extra
+// reference count is held on object during invocation of the
+// closure.  Usually, this function will be called on closures that
+// use this object as closure data.
 func (x *Object) WatchClosure(ClosureVar *Closure) {
 
 	xObjectWatchClosure(x.GoPointer(), ClosureVar)
@@ -2256,13 +2239,13 @@ var xObjectWeakRef func(uintptr, uintptr, uintptr)
 // Adds a weak reference callback to an object. Weak references are
 // used for notification when an object is disposed. They are called
 // "weak references" because they allow you to safely hold a pointer
-// to an object without calling g_object_ref() (g_object_ref() adds a
+// to an object without calling [Object.Ref] ([Object.Ref] adds a
 // strong reference, that is, forces the object to stay alive).
 //
 // Note that the weak references created by this method are not
 // thread-safe: they cannot safely be used in one thread if the
-// object's last g_object_unref() might happen in another thread.
-// Use #GWeakRef if thread-safety is required.
+// object's last [Object.Unref] might happen in another thread.
+// Use [WeakRef] if thread-safety is required.
 func (x *Object) WeakRef(NotifyVar *WeakNotify, DataVar uintptr) {
 
 	var NotifyVarRef uintptr
@@ -2272,11 +2255,12 @@ func (x *Object) WeakRef(NotifyVar *WeakNotify, DataVar uintptr) {
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0, arg1)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -2296,11 +2280,12 @@ func (x *Object) WeakUnref(NotifyVar *WeakNotify, DataVar uintptr) {
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0, arg1)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -2320,79 +2305,100 @@ func (c *Object) SetGoPointer(ptr uintptr) {
 }
 
 // The notify signal is emitted on an object when one of its properties has
-// its value set through g_object_set_property(), g_object_set(), et al.
+// its value set through [Object.SetProperty], [Object.Set], et al.
 //
 // Note that getting this signal doesn’t itself guarantee that the value of
 // the property has actually changed. When it is emitted is determined by the
 // derived GObject class. If the implementor did not create the property with
-// %G_PARAM_EXPLICIT_NOTIFY, then any call to g_object_set_property() results
+// [GParamExplicitNotifyValue], then any call to [Object.SetProperty] results
 // in ::notify being emitted, even if the new value is the same as the old.
-// If they did pass %G_PARAM_EXPLICIT_NOTIFY, then this signal is emitted only
-// when they explicitly call g_object_notify() or g_object_notify_by_pspec(),
+// If they did pass [GParamExplicitNotifyValue], then this signal is emitted only
+// when they explicitly call [Object.Notify] or [Object.NotifyByPspec],
 // and common practice is to do that only when the value has actually changed.
 //
 // This signal is typically used to obtain change notification for a
 // single property, by specifying the property name as a detail in the
 // g_signal_connect() call, like this:
 //
-// |[&lt;!-- language="C" --&gt;
-// g_signal_connect (text_view-&gt;buffer, "notify::paste-target-list",
-//
-//	G_CALLBACK (gtk_text_view_target_list_notify),
-//	text_view)
-//
-// ]|
+//	g_signal_connect (text_view->buffer, "notify::paste-target-list",
+//	                  G_CALLBACK (gtk_text_view_target_list_notify),
+//	                  text_view)
 //
 // It is important to note that you must use
 // [canonical parameter names][class@GObject.ParamSpec#parameter-names] as
 // detail strings for the notify signal.
+//
+// Deprecated: use ConnectNotifyFunc, which also accepts method values and closures.
 func (x *Object) ConnectNotify(cb *func(Object, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := SignalConnect(x.GoPointer(), "notify", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PspecVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Object{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-	fcb := func(clsPtr uintptr, PspecVarp uintptr) {
-		fa := Object{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
-
-		cbFn(fa, PspecVarp)
+			cbFn(fa, PspecVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := SignalConnect(x.GoPointer(), "notify", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return SignalConnectCallback(x.GoPointer(), "notify", cbRefPtr, cbPtr)
 }
 
 // ConnectNotifyWithDetail connects to the "notify" signal with a detail string.
 // The detail is appended as "notify::<detail>".
+//
+// Deprecated: use ConnectNotifyWithDetailFunc, which also accepts method values and closures.
 func (x *Object) ConnectNotifyWithDetail(detail string, cb *func(Object, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("notify::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PspecVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Object{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PspecVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xObjectNotifyTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PspecVarp uintptr) {
+func xObjectNotifyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PspecVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := SignalFunc(data).(func(Object, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Object{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PspecVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNotifyFunc connects cb to the "notify" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Object) ConnectNotifyFunc(cb func(Object, uintptr)) uint {
+	return SignalConnectFunc(x.GoPointer(), "notify", &xObjectNotifyTrampoline, xObjectNotifyNewTrampoline, cb)
+}
+
+// ConnectNotifyWithDetailFunc connects cb to the "notify" signal with a detail string, see ConnectNotifyFunc.
+// The detail is appended as "notify::<detail>".
+func (x *Object) ConnectNotifyWithDetailFunc(detail string, cb func(Object, uintptr)) uint {
+	return SignalConnectFunc(x.GoPointer(), fmt.Sprintf("notify::%s", detail), &xObjectNotifyTrampoline, xObjectNotifyNewTrampoline, cb)
 }
 
 var xObjectCompatControl func(uint, uintptr) uint
@@ -2405,11 +2411,11 @@ func ObjectCompatControl(WhatVar uint, DataVar uintptr) uint {
 
 var xObjectInterfaceFindProperty func(*TypeInterface, string) uintptr
 
-// Find the #GParamSpec with the given name for an
-// interface. Generally, the interface vtable passed in as @g_iface
-// will be the default vtable from g_type_default_interface_ref(), or,
+// Find the [ParamSpec] with the given name for an
+// interface. Generally, the interface vtable passed in as g_iface
+// will be the default vtable from [TypeDefaultInterfaceRef], or,
 // if you know the interface has already been loaded,
-// g_type_default_interface_peek().
+// [TypeDefaultInterfacePeek].
 func ObjectInterfaceFindProperty(GIfaceVar *TypeInterface, PropertyNameVar string) *ParamSpec {
 	var cls *ParamSpec
 
@@ -2430,18 +2436,18 @@ var xObjectInterfaceInstallProperty func(*TypeInterface, uintptr)
 // that are added to GObject-derived types. Adding a property to an
 // interface forces all objects classes with that interface to have a
 // compatible property. The compatible property could be a newly
-// created #GParamSpec, but normally
-// g_object_class_override_property() will be used so that the object
+// created [ParamSpec], but normally
+// [ObjectClass.OverrideProperty] will be used so that the object
 // class only needs to provide an implementation and inherits the
 // property description, default value, bounds, and so forth from the
 // interface property.
 //
 // This function is meant to be called from the interface's default
-// vtable initialization function (the @class_init member of
-// #GTypeInfo.) It must not be called after after @class_init has
+// vtable initialization function (the class_init member of
+// [TypeInfo].) It must not be called after after class_init has
 // been called for any object types implementing this interface.
 //
-// If @pspec is a floating reference, it will be consumed.
+// If pspec is a floating reference, it will be consumed.
 func ObjectInterfaceInstallProperty(GIfaceVar *TypeInterface, PspecVar *ParamSpec) {
 
 	xObjectInterfaceInstallProperty(GIfaceVar, PspecVar.GoPointer())
@@ -2451,9 +2457,9 @@ func ObjectInterfaceInstallProperty(GIfaceVar *TypeInterface, PspecVar *ParamSpe
 var xObjectInterfaceListProperties func(*TypeInterface, *uint) uintptr
 
 // Lists the properties of an interface.Generally, the interface
-// vtable passed in as @g_iface will be the default vtable from
-// g_type_default_interface_ref(), or, if you know the interface has
-// already been loaded, g_type_default_interface_peek().
+// vtable passed in as g_iface will be the default vtable from
+// [TypeDefaultInterfaceRef], or, if you know the interface has
+// already been loaded, [TypeDefaultInterfacePeek].
 func ObjectInterfaceListProperties(GIfaceVar *TypeInterface, NPropertiesPVar *uint) uintptr {
 
 	cret := xObjectInterfaceListProperties(GIfaceVar, NPropertiesPVar)
@@ -2465,85 +2471,88 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCclosureNewObject, libs, "g_cclosure_new_object")
-	core.PuregoSafeRegister(&xCclosureNewObjectSwap, libs, "g_cclosure_new_object_swap")
-	core.PuregoSafeRegister(&xClearObject, libs, "g_clear_object")
-	core.PuregoSafeRegister(&xSignalConnectObject, libs, "g_signal_connect_object")
+	core.PuregoRegisterSince(&xCClosureNewObject, libs, "g_cclosure_new_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xCClosureNewObjectSwap, libs, "g_cclosure_new_object_swap", "GOBJECT", "")
+	core.PuregoRegisterSince(&xClearObject, libs, "g_clear_object", "GOBJECT", "2.28")
+	core.PuregoRegisterSince(&xSignalConnectObject, libs, "g_signal_connect_object", "GOBJECT", "")
 
-	core.PuregoSafeRegister(&xObjectClassFindProperty, libs, "g_object_class_find_property")
-	core.PuregoSafeRegister(&xObjectClassInstallProperties, libs, "g_object_class_install_properties")
-	core.PuregoSafeRegister(&xObjectClassInstallProperty, libs, "g_object_class_install_property")
-	core.PuregoSafeRegister(&xObjectClassListProperties, libs, "g_object_class_list_properties")
-	core.PuregoSafeRegister(&xObjectClassOverrideProperty, libs, "g_object_class_override_property")
+	core.PuregoRegisterSince(&xObjectClassFindProperty, libs, "g_object_class_find_property", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectClassInstallProperties, libs, "g_object_class_install_properties", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xObjectClassInstallProperty, libs, "g_object_class_install_property", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectClassListProperties, libs, "g_object_class_list_properties", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectClassOverrideProperty, libs, "g_object_class_override_property", "GOBJECT", "2.4")
 
-	core.PuregoSafeRegister(&xWeakRefClear, libs, "g_weak_ref_clear")
-	core.PuregoSafeRegister(&xWeakRefGet, libs, "g_weak_ref_get")
-	core.PuregoSafeRegister(&xWeakRefInit, libs, "g_weak_ref_init")
-	core.PuregoSafeRegister(&xWeakRefSet, libs, "g_weak_ref_set")
+	core.PuregoRegisterSince(&xWeakRefClear, libs, "g_weak_ref_clear", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xWeakRefGet, libs, "g_weak_ref_get", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xWeakRefInit, libs, "g_weak_ref_init", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xWeakRefSet, libs, "g_weak_ref_set", "GOBJECT", "2.32")
 
 	core.PuregoSafeRegister(&xInitiallyUnownedGLibType, libs, "g_initially_unowned_get_type")
 
+	CheckClassSize("GInitiallyUnownedClass", xInitiallyUnownedGLibType, unsafe.Sizeof(InitiallyUnownedClass{}))
+
 	core.PuregoSafeRegister(&xObjectGLibType, libs, "g_object_get_type")
 
-	core.PuregoSafeRegister(&xNewObject, libs, "g_object_new")
-	core.PuregoSafeRegister(&xNewObjectValist, libs, "g_object_new_valist")
-	core.PuregoSafeRegister(&xNewObjectWithProperties, libs, "g_object_new_with_properties")
-	core.PuregoSafeRegister(&xNewObjectv, libs, "g_object_newv")
-
-	core.PuregoSafeRegister(&xObjectAddToggleRef, libs, "g_object_add_toggle_ref")
-	core.PuregoSafeRegister(&xObjectAddWeakPointer, libs, "g_object_add_weak_pointer")
-	core.PuregoSafeRegister(&xObjectBindProperty, libs, "g_object_bind_property")
-	core.PuregoSafeRegister(&xObjectBindPropertyFull, libs, "g_object_bind_property_full")
-	core.PuregoSafeRegister(&xObjectBindPropertyWithClosures, libs, "g_object_bind_property_with_closures")
-	core.PuregoSafeRegister(&xObjectConnect, libs, "g_object_connect")
-	core.PuregoSafeRegister(&xObjectDisconnect, libs, "g_object_disconnect")
-	core.PuregoSafeRegister(&xObjectDupData, libs, "g_object_dup_data")
-	core.PuregoSafeRegister(&xObjectDupQdata, libs, "g_object_dup_qdata")
-	core.PuregoSafeRegister(&xObjectForceFloating, libs, "g_object_force_floating")
-	core.PuregoSafeRegister(&xObjectFreezeNotify, libs, "g_object_freeze_notify")
-	core.PuregoSafeRegister(&xObjectGet, libs, "g_object_get")
-	core.PuregoSafeRegister(&xObjectGetData, libs, "g_object_get_data")
-	core.PuregoSafeRegister(&xObjectGetProperty, libs, "g_object_get_property")
-	core.PuregoSafeRegister(&xObjectGetQdata, libs, "g_object_get_qdata")
-	core.PuregoSafeRegister(&xObjectGetValist, libs, "g_object_get_valist")
-	core.PuregoSafeRegister(&xObjectGetv, libs, "g_object_getv")
-	core.PuregoSafeRegister(&xObjectIsFloating, libs, "g_object_is_floating")
-	core.PuregoSafeRegister(&xObjectNotify, libs, "g_object_notify")
-	core.PuregoSafeRegister(&xObjectNotifyByPspec, libs, "g_object_notify_by_pspec")
-	core.PuregoSafeRegister(&xObjectRef, libs, "g_object_ref")
-	core.PuregoSafeRegister(&xObjectRefSink, libs, "g_object_ref_sink")
-	core.PuregoSafeRegister(&xObjectRemoveToggleRef, libs, "g_object_remove_toggle_ref")
-	core.PuregoSafeRegister(&xObjectRemoveWeakPointer, libs, "g_object_remove_weak_pointer")
-	core.PuregoSafeRegister(&xObjectReplaceData, libs, "g_object_replace_data")
-	core.PuregoSafeRegister(&xObjectReplaceQdata, libs, "g_object_replace_qdata")
-	core.PuregoSafeRegister(&xObjectRunDispose, libs, "g_object_run_dispose")
-	core.PuregoSafeRegister(&xObjectSet, libs, "g_object_set")
-	core.PuregoSafeRegister(&xObjectSetData, libs, "g_object_set_data")
-	core.PuregoSafeRegister(&xObjectSetDataFull, libs, "g_object_set_data_full")
-	core.PuregoSafeRegister(&xObjectSetProperty, libs, "g_object_set_property")
-	core.PuregoSafeRegister(&xObjectSetQdata, libs, "g_object_set_qdata")
-	core.PuregoSafeRegister(&xObjectSetQdataFull, libs, "g_object_set_qdata_full")
-	core.PuregoSafeRegister(&xObjectSetValist, libs, "g_object_set_valist")
-	core.PuregoSafeRegister(&xObjectSetv, libs, "g_object_setv")
-	core.PuregoSafeRegister(&xObjectStealData, libs, "g_object_steal_data")
-	core.PuregoSafeRegister(&xObjectStealQdata, libs, "g_object_steal_qdata")
-	core.PuregoSafeRegister(&xObjectTakeRef, libs, "g_object_take_ref")
-	core.PuregoSafeRegister(&xObjectThawNotify, libs, "g_object_thaw_notify")
-	core.PuregoSafeRegister(&xObjectUnref, libs, "g_object_unref")
-	core.PuregoSafeRegister(&xObjectWatchClosure, libs, "g_object_watch_closure")
-	core.PuregoSafeRegister(&xObjectWeakRef, libs, "g_object_weak_ref")
-	core.PuregoSafeRegister(&xObjectWeakUnref, libs, "g_object_weak_unref")
-
-	core.PuregoSafeRegister(&xObjectCompatControl, libs, "g_object_compat_control")
-	core.PuregoSafeRegister(&xObjectInterfaceFindProperty, libs, "g_object_interface_find_property")
-	core.PuregoSafeRegister(&xObjectInterfaceInstallProperty, libs, "g_object_interface_install_property")
-	core.PuregoSafeRegister(&xObjectInterfaceListProperties, libs, "g_object_interface_list_properties")
+	CheckClassSize("GObjectClass", xObjectGLibType, unsafe.Sizeof(ObjectClass{}))
+
+	core.PuregoRegisterSince(&xNewObject, libs, "g_object_new", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewObjectValist, libs, "g_object_new_valist", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewObjectWithProperties, libs, "g_object_new_with_properties", "GOBJECT", "2.54")
+
+	core.PuregoRegisterSince(&xObjectAddToggleRef, libs, "g_object_add_toggle_ref", "GOBJECT", "2.8")
+	core.PuregoRegisterSince(&xObjectAddWeakPointer, libs, "g_object_add_weak_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectBindProperty, libs, "g_object_bind_property", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xObjectBindPropertyFull, libs, "g_object_bind_property_full", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xObjectBindPropertyWithClosures, libs, "g_object_bind_property_with_closures", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xObjectConnect, libs, "g_object_connect", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectDisconnect, libs, "g_object_disconnect", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectDupData, libs, "g_object_dup_data", "GOBJECT", "2.34")
+	core.PuregoRegisterSince(&xObjectDupQdata, libs, "g_object_dup_qdata", "GOBJECT", "2.34")
+	core.PuregoRegisterSince(&xObjectForceFloating, libs, "g_object_force_floating", "GOBJECT", "2.10")
+	core.PuregoRegisterSince(&xObjectFreezeNotify, libs, "g_object_freeze_notify", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGet, libs, "g_object_get", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGetData, libs, "g_object_get_data", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGetProperty, libs, "g_object_get_property", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGetQdata, libs, "g_object_get_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGetValist, libs, "g_object_get_valist", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectGetv, libs, "g_object_getv", "GOBJECT", "2.54")
+	core.PuregoRegisterSince(&xObjectIsFloating, libs, "g_object_is_floating", "GOBJECT", "2.10")
+	core.PuregoRegisterSince(&xObjectNotify, libs, "g_object_notify", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectNotifyByPspec, libs, "g_object_notify_by_pspec", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xObjectRef, libs, "g_object_ref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectRefSink, libs, "g_object_ref_sink", "GOBJECT", "2.10")
+	core.PuregoRegisterSince(&xObjectRemoveToggleRef, libs, "g_object_remove_toggle_ref", "GOBJECT", "2.8")
+	core.PuregoRegisterSince(&xObjectRemoveWeakPointer, libs, "g_object_remove_weak_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectReplaceData, libs, "g_object_replace_data", "GOBJECT", "2.34")
+	core.PuregoRegisterSince(&xObjectReplaceQdata, libs, "g_object_replace_qdata", "GOBJECT", "2.34")
+	core.PuregoRegisterSince(&xObjectRunDispose, libs, "g_object_run_dispose", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSet, libs, "g_object_set", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetData, libs, "g_object_set_data", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetDataFull, libs, "g_object_set_data_full", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetProperty, libs, "g_object_set_property", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetQdata, libs, "g_object_set_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetQdataFull, libs, "g_object_set_qdata_full", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetValist, libs, "g_object_set_valist", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectSetv, libs, "g_object_setv", "GOBJECT", "2.54")
+	core.PuregoRegisterSince(&xObjectStealData, libs, "g_object_steal_data", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectStealQdata, libs, "g_object_steal_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectTakeRef, libs, "g_object_take_ref", "GOBJECT", "2.70")
+	core.PuregoRegisterSince(&xObjectThawNotify, libs, "g_object_thaw_notify", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectUnref, libs, "g_object_unref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectWatchClosure, libs, "g_object_watch_closure", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectWeakRef, libs, "g_object_weak_ref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectWeakUnref, libs, "g_object_weak_unref", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xObjectCompatControl, libs, "g_object_compat_control", "GOBJECT", "")
+	core.PuregoRegisterSince(&xObjectInterfaceFindProperty, libs, "g_object_interface_find_property", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xObjectInterfaceInstallProperty, libs, "g_object_interface_install_property", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xObjectInterfaceListProperties, libs, "g_object_interface_list_properties", "GOBJECT", "2.4")
 
 }
@@ -0,0 +1,47 @@
+//go:build !puregotk_no_deprecated
+
+// Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gobject
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xNewObjectv func(types.GType, uint, []Parameter) uintptr
+
+// Creates a new instance of a [Object] subtype and sets its properties.
+//
+// Construction parameters (see [GParamConstructValue], [GParamConstructOnlyValue])
+// which are not explicitly specified are set to their default values.
+//
+// Deprecated: since 2.54. Use [NewObjectWithProperties] instead.
+// deprecated. See [Parameter] for more information.
+func NewObjectv(ObjectTypeVar types.GType, NParametersVar uint, ParametersVar []Parameter) *Object {
+	var cls *Object
+
+	cret := xNewObjectv(ObjectTypeVar, NParametersVar, ParametersVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Object{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewObjectv, libs, "g_object_newv", "GOBJECT", "")
+
+}
@@ -2,10 +2,11 @@
 package gobject
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -13,11 +14,11 @@ import (
 
 // The class structure for the GParamSpec type.
 // Normally, GParamSpec classes are filled by
-// g_param_type_register_static().
+// [ParamTypeRegisterStatic].
 type ParamSpecClass struct {
 	_ structs.HostLayout
 
-	GTypeClass uintptr
+	GTypeClass TypeClass
 
 	ValueType types.GType
 
@@ -46,7 +47,8 @@ func (x *ParamSpecClass) OverrideFinalize(cb func(*ParamSpec)) {
 	if cb == nil {
 		x.xFinalize = 0
 	} else {
-		x.xFinalize = purego.NewCallback(func(PspecVarp uintptr) {
+		x.xFinalize = core.NewCallback(func(PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -61,139 +63,143 @@ func (x *ParamSpecClass) GetFinalize() func(*ParamSpec) {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFinalize)
+	core.RegisterFunc(&rawCallback, x.xFinalize)
 	return func(PspecVar *ParamSpec) {
 		rawCallback(PspecVar.GoPointer())
 	}
 }
 
 // OverrideValueSetDefault sets the "value_set_default" callback function.
-// Resets a @value to the default value for this type
+// Resets a value to the default value for this type
 //
-//	(recommended, the default is g_value_reset()), see
-//	g_param_value_set_default().
+//	(recommended, the default is [Value.Reset]), see
+//	[ParamValueSetDefault].
 func (x *ParamSpecClass) OverrideValueSetDefault(cb func(*ParamSpec, *Value)) {
 	if cb == nil {
 		x.xValueSetDefault = 0
 	} else {
-		x.xValueSetDefault = purego.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) {
+		x.xValueSetDefault = core.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) {
+			defer core.RecoverPanic()
 			cb(ParamSpecNewFromInternalPtr(PspecVarp), ValueVarp)
 		})
 	}
 }
 
 // GetValueSetDefault gets the "value_set_default" callback function.
-// Resets a @value to the default value for this type
+// Resets a value to the default value for this type
 //
-//	(recommended, the default is g_value_reset()), see
-//	g_param_value_set_default().
+//	(recommended, the default is [Value.Reset]), see
+//	[ParamValueSetDefault].
 func (x *ParamSpecClass) GetValueSetDefault() func(*ParamSpec, *Value) {
 	if x.xValueSetDefault == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, ValueVarp *Value)
-	purego.RegisterFunc(&rawCallback, x.xValueSetDefault)
+	core.RegisterFunc(&rawCallback, x.xValueSetDefault)
 	return func(PspecVar *ParamSpec, ValueVar *Value) {
 		rawCallback(PspecVar.GoPointer(), ValueVar)
 	}
 }
 
 // OverrideValueValidate sets the "value_validate" callback function.
-// Ensures that the contents of @value comply with the
+// Ensures that the contents of value comply with the
 //
 //	specifications set out by this type (optional), see
-//	g_param_value_validate().
+//	[ParamValueValidate].
 func (x *ParamSpecClass) OverrideValueValidate(cb func(*ParamSpec, *Value) bool) {
 	if cb == nil {
 		x.xValueValidate = 0
 	} else {
-		x.xValueValidate = purego.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+		x.xValueValidate = core.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+			defer core.RecoverPanic()
 			return cb(ParamSpecNewFromInternalPtr(PspecVarp), ValueVarp)
 		})
 	}
 }
 
 // GetValueValidate gets the "value_validate" callback function.
-// Ensures that the contents of @value comply with the
+// Ensures that the contents of value comply with the
 //
 //	specifications set out by this type (optional), see
-//	g_param_value_validate().
+//	[ParamValueValidate].
 func (x *ParamSpecClass) GetValueValidate() func(*ParamSpec, *Value) bool {
 	if x.xValueValidate == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, ValueVarp *Value) bool
-	purego.RegisterFunc(&rawCallback, x.xValueValidate)
+	core.RegisterFunc(&rawCallback, x.xValueValidate)
 	return func(PspecVar *ParamSpec, ValueVar *Value) bool {
 		return rawCallback(PspecVar.GoPointer(), ValueVar)
 	}
 }
 
 // OverrideValuesCmp sets the "values_cmp" callback function.
-// Compares @value1 with @value2 according to this type
+// Compares value1 with value2 according to this type
 //
-//	(recommended, the default is memcmp()), see g_param_values_cmp().
+//	(recommended, the default is memcmp()), see [ParamValuesCmp].
 func (x *ParamSpecClass) OverrideValuesCmp(cb func(*ParamSpec, *Value, *Value) int) {
 	if cb == nil {
 		x.xValuesCmp = 0
 	} else {
-		x.xValuesCmp = purego.NewCallback(func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int {
+		x.xValuesCmp = core.NewCallback(func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int {
+			defer core.RecoverPanic()
 			return cb(ParamSpecNewFromInternalPtr(PspecVarp), Value1Varp, Value2Varp)
 		})
 	}
 }
 
 // GetValuesCmp gets the "values_cmp" callback function.
-// Compares @value1 with @value2 according to this type
+// Compares value1 with value2 according to this type
 //
-//	(recommended, the default is memcmp()), see g_param_values_cmp().
+//	(recommended, the default is memcmp()), see [ParamValuesCmp].
 func (x *ParamSpecClass) GetValuesCmp() func(*ParamSpec, *Value, *Value) int {
 	if x.xValuesCmp == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int
-	purego.RegisterFunc(&rawCallback, x.xValuesCmp)
+	core.RegisterFunc(&rawCallback, x.xValuesCmp)
 	return func(PspecVar *ParamSpec, Value1Var *Value, Value2Var *Value) int {
 		return rawCallback(PspecVar.GoPointer(), Value1Var, Value2Var)
 	}
 }
 
 // OverrideValueIsValid sets the "value_is_valid" callback function.
-// Checks if contents of @value comply with the specifications
+// Checks if contents of value comply with the specifications
 //
 //	set out by this type, without modifying the value. This vfunc is optional.
-//	If it isn't set, GObject will use @value_validate. Since 2.74
+//	If it isn't set, GObject will use value_validate. Since 2.74
 func (x *ParamSpecClass) OverrideValueIsValid(cb func(*ParamSpec, *Value) bool) {
 	if cb == nil {
 		x.xValueIsValid = 0
 	} else {
-		x.xValueIsValid = purego.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+		x.xValueIsValid = core.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+			defer core.RecoverPanic()
 			return cb(ParamSpecNewFromInternalPtr(PspecVarp), ValueVarp)
 		})
 	}
 }
 
 // GetValueIsValid gets the "value_is_valid" callback function.
-// Checks if contents of @value comply with the specifications
+// Checks if contents of value comply with the specifications
 //
 //	set out by this type, without modifying the value. This vfunc is optional.
-//	If it isn't set, GObject will use @value_validate. Since 2.74
+//	If it isn't set, GObject will use value_validate. Since 2.74
 func (x *ParamSpecClass) GetValueIsValid() func(*ParamSpec, *Value) bool {
 	if x.xValueIsValid == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, ValueVarp *Value) bool
-	purego.RegisterFunc(&rawCallback, x.xValueIsValid)
+	core.RegisterFunc(&rawCallback, x.xValueIsValid)
 	return func(PspecVar *ParamSpec, ValueVar *Value) bool {
 		return rawCallback(PspecVar.GoPointer(), ValueVar)
 	}
 }
 
-// A #GParamSpecPool maintains a collection of #GParamSpecs which can be
+// A [ParamSpecPool] maintains a collection of GParamSpecs which can be
 // quickly accessed by owner and name.
 //
-// The implementation of the #GObject property system uses such a pool to
-// store the #GParamSpecs of the properties all object types.
+// The implementation of the [Object] property system uses such a pool to
+// store the GParamSpecs of the properties all object types.
 type ParamSpecPool struct {
 	_ structs.HostLayout
 }
@@ -204,7 +210,7 @@ func (x *ParamSpecPool) GoPointer() uintptr {
 
 var xParamSpecPoolFree func(uintptr)
 
-// Frees the resources allocated by a #GParamSpecPool.
+// Frees the resources allocated by a [ParamSpecPool].
 func (x *ParamSpecPool) Free() {
 
 	xParamSpecPoolFree(x.GoPointer())
@@ -213,7 +219,7 @@ func (x *ParamSpecPool) Free() {
 
 var xParamSpecPoolInsert func(uintptr, uintptr, types.GType)
 
-// Inserts a #GParamSpec in the pool.
+// Inserts a [ParamSpec] in the pool.
 func (x *ParamSpecPool) Insert(PspecVar *ParamSpec, OwnerTypeVar types.GType) {
 
 	xParamSpecPoolInsert(x.GoPointer(), PspecVar.GoPointer(), OwnerTypeVar)
@@ -222,7 +228,7 @@ func (x *ParamSpecPool) Insert(PspecVar *ParamSpec, OwnerTypeVar types.GType) {
 
 var xParamSpecPoolList func(uintptr, types.GType, *uint) uintptr
 
-// Gets an array of all #GParamSpecs owned by @owner_type in
+// Gets an array of all GParamSpecs owned by owner_type in
 // the pool.
 func (x *ParamSpecPool) List(OwnerTypeVar types.GType, NPspecsPVar *uint) uintptr {
 
@@ -232,7 +238,7 @@ func (x *ParamSpecPool) List(OwnerTypeVar types.GType, NPspecsPVar *uint) uintpt
 
 var xParamSpecPoolListOwned func(uintptr, types.GType) *glib.List
 
-// Gets an #GList of all #GParamSpecs owned by @owner_type in
+// Gets an [glib.List] of all GParamSpecs owned by owner_type in
 // the pool.
 func (x *ParamSpecPool) ListOwned(OwnerTypeVar types.GType) *glib.List {
 
@@ -242,7 +248,7 @@ func (x *ParamSpecPool) ListOwned(OwnerTypeVar types.GType) *glib.List {
 
 var xParamSpecPoolLookup func(uintptr, string, types.GType, bool) uintptr
 
-// Looks up a #GParamSpec in the pool.
+// Looks up a [ParamSpec] in the pool.
 func (x *ParamSpecPool) Lookup(ParamNameVar string, OwnerTypeVar types.GType, WalkAncestorsVar bool) *ParamSpec {
 	var cls *ParamSpec
 
@@ -259,7 +265,7 @@ func (x *ParamSpecPool) Lookup(ParamNameVar string, OwnerTypeVar types.GType, Wa
 
 var xParamSpecPoolRemove func(uintptr, uintptr)
 
-// Removes a #GParamSpec from the pool.
+// Removes a [ParamSpec] from the pool.
 func (x *ParamSpecPool) Remove(PspecVar *ParamSpec) {
 
 	xParamSpecPoolRemove(x.GoPointer(), PspecVar.GoPointer())
@@ -270,10 +276,10 @@ func (x *ParamSpecPool) Remove(PspecVar *ParamSpec) {
 // required to initialize and destruct (finalize) a parameter's class and
 // instances thereof.
 //
-// The initialized structure is passed to the g_param_type_register_static()
+// The initialized structure is passed to the [ParamTypeRegisterStatic]
 // The type system will perform a deep copy of this structure, so its memory
 // does not need to be persistent across invocation of
-// g_param_type_register_static().
+// [ParamTypeRegisterStatic].
 type ParamSpecTypeInfo struct {
 	_ structs.HostLayout
 
@@ -304,7 +310,8 @@ func (x *ParamSpecTypeInfo) OverrideInstanceInit(cb func(*ParamSpec)) {
 	if cb == nil {
 		x.xInstanceInit = 0
 	} else {
-		x.xInstanceInit = purego.NewCallback(func(PspecVarp uintptr) {
+		x.xInstanceInit = core.NewCallback(func(PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -317,7 +324,7 @@ func (x *ParamSpecTypeInfo) GetInstanceInit() func(*ParamSpec) {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInstanceInit)
+	core.RegisterFunc(&rawCallback, x.xInstanceInit)
 	return func(PspecVar *ParamSpec) {
 		rawCallback(PspecVar.GoPointer())
 	}
@@ -329,7 +336,8 @@ func (x *ParamSpecTypeInfo) OverrideFinalize(cb func(*ParamSpec)) {
 	if cb == nil {
 		x.xFinalize = 0
 	} else {
-		x.xFinalize = purego.NewCallback(func(PspecVarp uintptr) {
+		x.xFinalize = core.NewCallback(func(PspecVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ParamSpecNewFromInternalPtr(PspecVarp))
 		})
 	}
@@ -342,105 +350,110 @@ func (x *ParamSpecTypeInfo) GetFinalize() func(*ParamSpec) {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFinalize)
+	core.RegisterFunc(&rawCallback, x.xFinalize)
 	return func(PspecVar *ParamSpec) {
 		rawCallback(PspecVar.GoPointer())
 	}
 }
 
 // OverrideValueSetDefault sets the "value_set_default" callback function.
-// Resets a @value to the default value for @pspec
+// Resets a value to the default value for pspec
 //
-//	(recommended, the default is g_value_reset()), see
-//	g_param_value_set_default().
+//	(recommended, the default is [Value.Reset]), see
+//	[ParamValueSetDefault].
 func (x *ParamSpecTypeInfo) OverrideValueSetDefault(cb func(*ParamSpec, *Value)) {
 	if cb == nil {
 		x.xValueSetDefault = 0
 	} else {
-		x.xValueSetDefault = purego.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) {
+		x.xValueSetDefault = core.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) {
+			defer core.RecoverPanic()
 			cb(ParamSpecNewFromInternalPtr(PspecVarp), ValueVarp)
 		})
 	}
 }
 
 // GetValueSetDefault gets the "value_set_default" callback function.
-// Resets a @value to the default value for @pspec
+// Resets a value to the default value for pspec
 //
-//	(recommended, the default is g_value_reset()), see
-//	g_param_value_set_default().
+//	(recommended, the default is [Value.Reset]), see
+//	[ParamValueSetDefault].
 func (x *ParamSpecTypeInfo) GetValueSetDefault() func(*ParamSpec, *Value) {
 	if x.xValueSetDefault == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, ValueVarp *Value)
-	purego.RegisterFunc(&rawCallback, x.xValueSetDefault)
+	core.RegisterFunc(&rawCallback, x.xValueSetDefault)
 	return func(PspecVar *ParamSpec, ValueVar *Value) {
 		rawCallback(PspecVar.GoPointer(), ValueVar)
 	}
 }
 
 // OverrideValueValidate sets the "value_validate" callback function.
-// Ensures that the contents of @value comply with the
+// Ensures that the contents of value comply with the
 //
-//	specifications set out by @pspec (optional), see
-//	g_param_value_validate().
+//	specifications set out by pspec (optional), see
+//	[ParamValueValidate].
 func (x *ParamSpecTypeInfo) OverrideValueValidate(cb func(*ParamSpec, *Value) bool) {
 	if cb == nil {
 		x.xValueValidate = 0
 	} else {
-		x.xValueValidate = purego.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+		x.xValueValidate = core.NewCallback(func(PspecVarp uintptr, ValueVarp *Value) bool {
+			defer core.RecoverPanic()
 			return cb(ParamSpecNewFromInternalPtr(PspecVarp), ValueVarp)
 		})
 	}
 }
 
 // GetValueValidate gets the "value_validate" callback function.
-// Ensures that the contents of @value comply with the
+// Ensures that the contents of value comply with the
 //
-//	specifications set out by @pspec (optional), see
-//	g_param_value_validate().
+//	specifications set out by pspec (optional), see
+//	[ParamValueValidate].
 func (x *ParamSpecTypeInfo) GetValueValidate() func(*ParamSpec, *Value) bool {
 	if x.xValueValidate == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, ValueVarp *Value) bool
-	purego.RegisterFunc(&rawCallback, x.xValueValidate)
+	core.RegisterFunc(&rawCallback, x.xValueValidate)
 	return func(PspecVar *ParamSpec, ValueVar *Value) bool {
 		return rawCallback(PspecVar.GoPointer(), ValueVar)
 	}
 }
 
 // OverrideValuesCmp sets the "values_cmp" callback function.
-// Compares @value1 with @value2 according to @pspec
+// Compares value1 with value2 according to pspec
 //
-//	(recommended, the default is memcmp()), see g_param_values_cmp().
+//	(recommended, the default is memcmp()), see [ParamValuesCmp].
 func (x *ParamSpecTypeInfo) OverrideValuesCmp(cb func(*ParamSpec, *Value, *Value) int) {
 	if cb == nil {
 		x.xValuesCmp = 0
 	} else {
-		x.xValuesCmp = purego.NewCallback(func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int {
+		x.xValuesCmp = core.NewCallback(func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int {
+			defer core.RecoverPanic()
 			return cb(ParamSpecNewFromInternalPtr(PspecVarp), Value1Varp, Value2Varp)
 		})
 	}
 }
 
 // GetValuesCmp gets the "values_cmp" callback function.
-// Compares @value1 with @value2 according to @pspec
+// Compares value1 with value2 according to pspec
 //
-//	(recommended, the default is memcmp()), see g_param_values_cmp().
+//	(recommended, the default is memcmp()), see [ParamValuesCmp].
 func (x *ParamSpecTypeInfo) GetValuesCmp() func(*ParamSpec, *Value, *Value) int {
 	if x.xValuesCmp == 0 {
 		return nil
 	}
 	var rawCallback func(PspecVarp uintptr, Value1Varp *Value, Value2Varp *Value) int
-	purego.RegisterFunc(&rawCallback, x.xValuesCmp)
+	core.RegisterFunc(&rawCallback, x.xValuesCmp)
 	return func(PspecVar *ParamSpec, Value1Var *Value, Value2Var *Value) int {
 		return rawCallback(PspecVar.GoPointer(), Value1Var, Value2Var)
 	}
 }
 
 // The GParameter struct is an auxiliary structure used
-// to hand parameter name/value pairs to g_object_newv().
+// to hand parameter name/value pairs to [NewObjectv].
+//
+// Deprecated: since 2.54. This type is not introspectable.
 type Parameter struct {
 	_ structs.HostLayout
 
@@ -454,9 +467,9 @@ func (x *Parameter) GoPointer() uintptr {
 }
 
 const (
-	// Mask containing the bits of #GParamSpec.flags which are reserved for GLib.
+	// Mask containing the bits of [ParamSpec].flags which are reserved for GLib.
 	PARAM_MASK int = 255
-	// #GParamFlags value alias for %G_PARAM_STATIC_NAME | %G_PARAM_STATIC_NICK | %G_PARAM_STATIC_BLURB.
+	// [ParamFlags] value alias for [GParamStaticNameValue] | [GParamStaticNickValue] | [GParamStaticBlurbValue].
 	//
 	// It is recommended to use this for all properties by default, as it allows for
 	// internal performance improvements in GObject.
@@ -467,15 +480,15 @@ const (
 	// Since 2.13.0
 	PARAM_STATIC_STRINGS int = 224
 	// Minimum shift count to be used for user defined flags, to be stored in
-	// #GParamSpec.flags. The maximum allowed is 10.
+	// [ParamSpec].flags. The maximum allowed is 10.
 	PARAM_USER_SHIFT int = 8
 )
 
-// Through the #GParamFlags flag values, certain aspects of parameters
+// Through the [ParamFlags] flag values, certain aspects of parameters
 // can be configured.
 //
-// See also: %G_PARAM_STATIC_STRINGS
-type ParamFlags int
+// See also: [PARAM_STATIC_STRINGS]
+type ParamFlags uint32
 
 const (
 
@@ -483,15 +496,15 @@ const (
 	GParamReadableValue ParamFlags = 1
 	// the parameter is writable
 	GParamWritableValue ParamFlags = 2
-	// alias for %G_PARAM_READABLE | %G_PARAM_WRITABLE
+	// alias for [GParamReadableValue] | [GParamWritableValue]
 	GParamReadwriteValue ParamFlags = 3
 	// the parameter will be set upon object construction.
-	//   See [vfunc@Object.constructed] for more details
+	//   See constructed for more details
 	GParamConstructValue ParamFlags = 4
 	// the parameter can only be set upon object construction.
-	//   See [vfunc@Object.constructed] for more details
+	//   See constructed for more details
 	GParamConstructOnlyValue ParamFlags = 8
-	// upon parameter conversion (see g_param_value_convert())
+	// upon parameter conversion (see [ParamValueConvert])
 	//  strict validation is not required
 	GParamLaxValidationValue ParamFlags = 16
 	// the string used as name when constructing the
@@ -511,9 +524,9 @@ const (
 	//  unmodified for the lifetime of the parameter.
 	//  Since 2.8
 	GParamStaticBlurbValue ParamFlags = 128
-	// calls to g_object_set_property() for this
+	// calls to [Object.SetProperty] for this
 	//   property will not automatically result in a "notify" signal being
-	//   emitted: the implementation must call g_object_notify() themselves
+	//   emitted: the implementation must call [Object.Notify] themselves
 	//   in case the property actually changes.  Since: 2.42.
 	GParamExplicitNotifyValue ParamFlags = 1073741824
 	// the parameter is deprecated and will be removed
@@ -523,13 +536,89 @@ const (
 	GParamDeprecatedValue ParamFlags = 2147483648
 )
 
+// Has reports whether all bits of flags are set in x
+func (x ParamFlags) Has(flags ParamFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ParamFlags) Set(flags ParamFlags) ParamFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ParamFlags) Clear(flags ParamFlags) ParamFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ParamFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GParamReadableValue != 0 && x&GParamReadableValue == GParamReadableValue {
+		names = append(names, "G_PARAM_READABLE")
+		rest &^= GParamReadableValue
+	}
+	if rest&GParamWritableValue != 0 && x&GParamWritableValue == GParamWritableValue {
+		names = append(names, "G_PARAM_WRITABLE")
+		rest &^= GParamWritableValue
+	}
+	if rest&GParamReadwriteValue != 0 && x&GParamReadwriteValue == GParamReadwriteValue {
+		names = append(names, "G_PARAM_READWRITE")
+		rest &^= GParamReadwriteValue
+	}
+	if rest&GParamConstructValue != 0 && x&GParamConstructValue == GParamConstructValue {
+		names = append(names, "G_PARAM_CONSTRUCT")
+		rest &^= GParamConstructValue
+	}
+	if rest&GParamConstructOnlyValue != 0 && x&GParamConstructOnlyValue == GParamConstructOnlyValue {
+		names = append(names, "G_PARAM_CONSTRUCT_ONLY")
+		rest &^= GParamConstructOnlyValue
+	}
+	if rest&GParamLaxValidationValue != 0 && x&GParamLaxValidationValue == GParamLaxValidationValue {
+		names = append(names, "G_PARAM_LAX_VALIDATION")
+		rest &^= GParamLaxValidationValue
+	}
+	if rest&GParamStaticNameValue != 0 && x&GParamStaticNameValue == GParamStaticNameValue {
+		names = append(names, "G_PARAM_STATIC_NAME")
+		rest &^= GParamStaticNameValue
+	}
+	if rest&GParamPrivateValue != 0 && x&GParamPrivateValue == GParamPrivateValue {
+		names = append(names, "G_PARAM_PRIVATE")
+		rest &^= GParamPrivateValue
+	}
+	if rest&GParamStaticNickValue != 0 && x&GParamStaticNickValue == GParamStaticNickValue {
+		names = append(names, "G_PARAM_STATIC_NICK")
+		rest &^= GParamStaticNickValue
+	}
+	if rest&GParamStaticBlurbValue != 0 && x&GParamStaticBlurbValue == GParamStaticBlurbValue {
+		names = append(names, "G_PARAM_STATIC_BLURB")
+		rest &^= GParamStaticBlurbValue
+	}
+	if rest&GParamExplicitNotifyValue != 0 && x&GParamExplicitNotifyValue == GParamExplicitNotifyValue {
+		names = append(names, "G_PARAM_EXPLICIT_NOTIFY")
+		rest &^= GParamExplicitNotifyValue
+	}
+	if rest&GParamDeprecatedValue != 0 && x&GParamDeprecatedValue == GParamDeprecatedValue {
+		names = append(names, "G_PARAM_DEPRECATED")
+		rest &^= GParamDeprecatedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xParamTypeRegisterStatic func(string, *ParamSpecTypeInfo) types.GType
 
-// Registers @name as the name of a new static type derived
-// from %G_TYPE_PARAM.
+// Registers name as the name of a new static type derived
+// from G_TYPE_PARAM.
 //
-// The type system uses the information contained in the #GParamSpecTypeInfo
-// structure pointed to by @info to manage the #GParamSpec type and its
+// The type system uses the information contained in the [ParamSpecTypeInfo]
+// structure pointed to by info to manage the [ParamSpec] type and its
 // instances.
 func ParamTypeRegisterStatic(NameVar string, PspecInfoVar *ParamSpecTypeInfo) types.GType {
 
@@ -539,13 +628,13 @@ func ParamTypeRegisterStatic(NameVar string, PspecInfoVar *ParamSpecTypeInfo) ty
 
 var xParamValueConvert func(uintptr, *Value, *Value, bool) bool
 
-// Transforms @src_value into @dest_value if possible, and then
-// validates @dest_value, in order for it to conform to @pspec.  If
-// @strict_validation is %TRUE this function will only succeed if the
-// transformed @dest_value complied to @pspec without modifications.
+// Transforms src_value into dest_value if possible, and then
+// validates dest_value, in order for it to conform to pspec.  If
+// strict_validation is true this function will only succeed if the
+// transformed dest_value complied to pspec without modifications.
 //
-// See also g_value_type_transformable(), g_value_transform() and
-// g_param_value_validate().
+// See also [ValueTypeTransformable], [Value.Transform] and
+// [ParamValueValidate].
 func ParamValueConvert(PspecVar *ParamSpec, SrcValueVar *Value, DestValueVar *Value, StrictValidationVar bool) bool {
 
 	cret := xParamValueConvert(PspecVar.GoPointer(), SrcValueVar, DestValueVar, StrictValidationVar)
@@ -554,7 +643,7 @@ func ParamValueConvert(PspecVar *ParamSpec, SrcValueVar *Value, DestValueVar *Va
 
 var xParamValueDefaults func(uintptr, *Value) bool
 
-// Checks whether @value contains the default value as specified in @pspec.
+// Checks whether value contains the default value as specified in pspec.
 func ParamValueDefaults(PspecVar *ParamSpec, ValueVar *Value) bool {
 
 	cret := xParamValueDefaults(PspecVar.GoPointer(), ValueVar)
@@ -563,8 +652,8 @@ func ParamValueDefaults(PspecVar *ParamSpec, ValueVar *Value) bool {
 
 var xParamValueIsValid func(uintptr, *Value) bool
 
-// Return whether the contents of @value comply with the specifications
-// set out by @pspec.
+// Return whether the contents of value comply with the specifications
+// set out by pspec.
 func ParamValueIsValid(PspecVar *ParamSpec, ValueVar *Value) bool {
 
 	cret := xParamValueIsValid(PspecVar.GoPointer(), ValueVar)
@@ -573,7 +662,7 @@ func ParamValueIsValid(PspecVar *ParamSpec, ValueVar *Value) bool {
 
 var xParamValueSetDefault func(uintptr, *Value)
 
-// Sets @value to its default value as specified in @pspec.
+// Sets value to its default value as specified in pspec.
 func ParamValueSetDefault(PspecVar *ParamSpec, ValueVar *Value) {
 
 	xParamValueSetDefault(PspecVar.GoPointer(), ValueVar)
@@ -582,10 +671,10 @@ func ParamValueSetDefault(PspecVar *ParamSpec, ValueVar *Value) {
 
 var xParamValueValidate func(uintptr, *Value) bool
 
-// Ensures that the contents of @value comply with the specifications
-// set out by @pspec. For example, a #GParamSpecInt might require
-// that integers stored in @value may not be smaller than -42 and not be
-// greater than +42. If @value contains an integer outside of this range,
+// Ensures that the contents of value comply with the specifications
+// set out by pspec. For example, a [ParamSpecInt] might require
+// that integers stored in value may not be smaller than -42 and not be
+// greater than +42. If value contains an integer outside of this range,
 // it is modified accordingly, so the resulting value will fit into the
 // range -42 .. +42.
 func ParamValueValidate(PspecVar *ParamSpec, ValueVar *Value) bool {
@@ -596,8 +685,8 @@ func ParamValueValidate(PspecVar *ParamSpec, ValueVar *Value) bool {
 
 var xParamValuesCmp func(uintptr, *Value, *Value) int
 
-// Compares @value1 with @value2 according to @pspec, and return -1, 0 or +1,
-// if @value1 is found to be less than, equal to or greater than @value2,
+// Compares value1 with value2 according to pspec, and return -1, 0 or +1,
+// if value1 is found to be less than, equal to or greater than value2,
 // respectively.
 func ParamValuesCmp(PspecVar *ParamSpec, Value1Var *Value, Value2Var *Value) int {
 
@@ -607,12 +696,12 @@ func ParamValuesCmp(PspecVar *ParamSpec, Value1Var *Value, Value2Var *Value) int
 
 // `GParamSpec` encapsulates the metadata required to specify parameters, such as `GObject` properties.
 //
-// ## Parameter names
+// # Parameter names
 //
 // A property name consists of one or more segments consisting of ASCII letters
 // and digits, separated by either the `-` or `_` character. The first
 // character of a property name must be a letter. These are the same rules as
-// for signal naming (see [func@GObject.signal_new]).
+// for signal naming (see [SignalNew]).
 //
 // When creating and looking up a `GParamSpec`, either separator can be
 // used, but they cannot be mixed. Using `-` is considerably more
@@ -635,7 +724,7 @@ func ParamSpecNewFromInternalPtr(ptr uintptr) *ParamSpec {
 
 var xParamSpecGetBlurb func(uintptr) string
 
-// Get the short description of a #GParamSpec.
+// Get the short description of a [ParamSpec].
 func (x *ParamSpec) GetBlurb() string {
 
 	cret := xParamSpecGetBlurb(x.GoPointer())
@@ -644,9 +733,9 @@ func (x *ParamSpec) GetBlurb() string {
 
 var xParamSpecGetDefaultValue func(uintptr) *Value
 
-// Gets the default value of @pspec as a pointer to a #GValue.
+// Gets the default value of pspec as a pointer to a [Value].
 //
-// The #GValue will remain valid for the life of @pspec.
+// The [Value] will remain valid for the life of pspec.
 func (x *ParamSpec) GetDefaultValue() *Value {
 
 	cret := xParamSpecGetDefaultValue(x.GoPointer())
@@ -655,9 +744,9 @@ func (x *ParamSpec) GetDefaultValue() *Value {
 
 var xParamSpecGetName func(uintptr) string
 
-// Get the name of a #GParamSpec.
+// Get the name of a [ParamSpec].
 //
-// The name is always an "interned" string (as per g_intern_string()).
+// The name is always an "interned" string (as per [glib.InternString]).
 // This allows for pointer-value comparisons.
 func (x *ParamSpec) GetName() string {
 
@@ -676,7 +765,7 @@ func (x *ParamSpec) GetNameQuark() glib.Quark {
 
 var xParamSpecGetNick func(uintptr) string
 
-// Get the nickname of a #GParamSpec.
+// Get the nickname of a [ParamSpec].
 func (x *ParamSpec) GetNick() string {
 
 	cret := xParamSpecGetNick(x.GoPointer())
@@ -685,7 +774,7 @@ func (x *ParamSpec) GetNick() string {
 
 var xParamSpecGetQdata func(uintptr, glib.Quark) uintptr
 
-// Gets back user data pointers stored via g_param_spec_set_qdata().
+// Gets back user data pointers stored via [ParamSpec.SetQdata].
 func (x *ParamSpec) GetQdata(QuarkVar glib.Quark) uintptr {
 
 	cret := xParamSpecGetQdata(x.GoPointer(), QuarkVar)
@@ -699,7 +788,7 @@ var xParamSpecGetRedirectTarget func(uintptr) uintptr
 // providing a new implementation of a property in a derived
 // type while preserving all the properties from the parent
 // type. Redirection is established by creating a property
-// of type #GParamSpecOverride. See g_object_class_override_property()
+// of type [ParamSpecOverride]. See [ObjectClass.OverrideProperty]
 // for an example of the use of this capability.
 func (x *ParamSpec) GetRedirectTarget() *ParamSpec {
 	var cls *ParamSpec
@@ -717,7 +806,7 @@ func (x *ParamSpec) GetRedirectTarget() *ParamSpec {
 
 var xParamSpecRef func(uintptr) uintptr
 
-// Increments the reference count of @pspec.
+// Increments the reference count of pspec.
 func (x *ParamSpec) Ref() *ParamSpec {
 	var cls *ParamSpec
 
@@ -733,7 +822,7 @@ func (x *ParamSpec) Ref() *ParamSpec {
 
 var xParamSpecRefSink func(uintptr) uintptr
 
-// Convenience function to ref and sink a #GParamSpec.
+// Convenience function to ref and sink a [ParamSpec].
 func (x *ParamSpec) RefSink() *ParamSpec {
 	var cls *ParamSpec
 
@@ -749,12 +838,12 @@ func (x *ParamSpec) RefSink() *ParamSpec {
 
 var xParamSpecSetQdata func(uintptr, glib.Quark, uintptr)
 
-// Sets an opaque, named pointer on a #GParamSpec. The name is
-// specified through a #GQuark (retrieved e.g. via
-// g_quark_from_static_string()), and the pointer can be gotten back
-// from the @pspec with g_param_spec_get_qdata().  Setting a
+// Sets an opaque, named pointer on a [ParamSpec]. The name is
+// specified through a [glib.Quark] (retrieved e.g. via
+// [glib.QuarkFromStaticString]), and the pointer can be gotten back
+// from the pspec with [ParamSpec.GetQdata].  Setting a
 // previously set user data pointer, overrides (frees) the old pointer
-// set, using %NULL as pointer essentially removes the data stored.
+// set, using nil as pointer essentially removes the data stored.
 func (x *ParamSpec) SetQdata(QuarkVar glib.Quark, DataVar uintptr) {
 
 	xParamSpecSetQdata(x.GoPointer(), QuarkVar, DataVar)
@@ -763,11 +852,11 @@ func (x *ParamSpec) SetQdata(QuarkVar glib.Quark, DataVar uintptr) {
 
 var xParamSpecSetQdataFull func(uintptr, glib.Quark, uintptr, uintptr)
 
-// This function works like g_param_spec_set_qdata(), but in addition,
+// This function works like [ParamSpec.SetQdata], but in addition,
 // a `void (*destroy) (gpointer)` function may be
-// specified which is called with @data as argument when the @pspec is
+// specified which is called with data as argument when the pspec is
 // finalized, or the data is being overwritten by a call to
-// g_param_spec_set_qdata() with the same @quark.
+// [ParamSpec.SetQdata] with the same quark.
 func (x *ParamSpec) SetQdataFull(QuarkVar glib.Quark, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var DestroyVarRef uintptr
@@ -777,11 +866,12 @@ func (x *ParamSpec) SetQdataFull(QuarkVar glib.Quark, DataVar uintptr, DestroyVa
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -791,12 +881,12 @@ func (x *ParamSpec) SetQdataFull(QuarkVar glib.Quark, DataVar uintptr, DestroyVa
 
 var xParamSpecSink func(uintptr)
 
-// The initial reference count of a newly created #GParamSpec is 1,
-// even though no one has explicitly called g_param_spec_ref() on it
+// The initial reference count of a newly created [ParamSpec] is 1,
+// even though no one has explicitly called [ParamSpec.Ref] on it
 // yet. So the initial reference count is flagged as "floating", until
 // someone calls `g_param_spec_ref (pspec); g_param_spec_sink
 // (pspec);` in sequence on it, taking over the initial
-// reference count (thus ending up with a @pspec that has a reference
+// reference count (thus ending up with a pspec that has a reference
 // count of 1 still, but is not flagged "floating" anymore).
 func (x *ParamSpec) Sink() {
 
@@ -806,8 +896,8 @@ func (x *ParamSpec) Sink() {
 
 var xParamSpecStealQdata func(uintptr, glib.Quark) uintptr
 
-// Gets back user data pointers stored via g_param_spec_set_qdata()
-// and removes the @data from @pspec without invoking its destroy()
+// Gets back user data pointers stored via [ParamSpec.SetQdata]
+// and removes the data from pspec without invoking its destroy()
 // function (if any was set).  Usually, calling this function is only
 // required to update user data pointers with a destroy notifier.
 func (x *ParamSpec) StealQdata(QuarkVar glib.Quark) uintptr {
@@ -818,7 +908,7 @@ func (x *ParamSpec) StealQdata(QuarkVar glib.Quark) uintptr {
 
 var xParamSpecUnref func(uintptr)
 
-// Decrements the reference count of a @pspec.
+// Decrements the reference count of a pspec.
 func (x *ParamSpec) Unref() {
 
 	xParamSpecUnref(x.GoPointer())
@@ -838,14 +928,14 @@ func (c *ParamSpec) SetGoPointer(ptr uintptr) {
 
 var xParamSpecInternal func(types.GType, string, uintptr, uintptr, ParamFlags) uintptr
 
-// Creates a new #GParamSpec instance.
+// Creates a new [ParamSpec] instance.
 //
 // See [canonical parameter names][class@GObject.ParamSpec#parameter-names]
-// for details of the rules for @name. Names which violate these rules lead
+// for details of the rules for name. Names which violate these rules lead
 // to undefined behaviour.
 //
-// Beyond the name, #GParamSpecs have two more descriptive strings, the
-// @nick and @blurb, which may be used as a localized label and description.
+// Beyond the name, GParamSpecs have two more descriptive strings, the
+// nick and blurb, which may be used as a localized label and description.
 // For GTK and related libraries these are considered deprecated and may be
 // omitted, while for other libraries such as GStreamer and its plugins they
 // are essential. When in doubt, follow the conventions used in the
@@ -872,7 +962,7 @@ func ParamSpecInternal(ParamTypeVar types.GType, NameVar string, NickVar *string
 
 var xParamSpecIsValidName func(string) bool
 
-// Validate a property name for a #GParamSpec. This can be useful for
+// Validate a property name for a [ParamSpec]. This can be useful for
 // dynamically-generated properties which need to be validated at run-time
 // before actually trying to create them.
 //
@@ -889,46 +979,48 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xParamTypeRegisterStatic, libs, "g_param_type_register_static")
-	core.PuregoSafeRegister(&xParamValueConvert, libs, "g_param_value_convert")
-	core.PuregoSafeRegister(&xParamValueDefaults, libs, "g_param_value_defaults")
-	core.PuregoSafeRegister(&xParamValueIsValid, libs, "g_param_value_is_valid")
-	core.PuregoSafeRegister(&xParamValueSetDefault, libs, "g_param_value_set_default")
-	core.PuregoSafeRegister(&xParamValueValidate, libs, "g_param_value_validate")
-	core.PuregoSafeRegister(&xParamValuesCmp, libs, "g_param_values_cmp")
-
-	core.PuregoSafeRegister(&xParamSpecPoolFree, libs, "g_param_spec_pool_free")
-	core.PuregoSafeRegister(&xParamSpecPoolInsert, libs, "g_param_spec_pool_insert")
-	core.PuregoSafeRegister(&xParamSpecPoolList, libs, "g_param_spec_pool_list")
-	core.PuregoSafeRegister(&xParamSpecPoolListOwned, libs, "g_param_spec_pool_list_owned")
-	core.PuregoSafeRegister(&xParamSpecPoolLookup, libs, "g_param_spec_pool_lookup")
-	core.PuregoSafeRegister(&xParamSpecPoolRemove, libs, "g_param_spec_pool_remove")
+	core.PuregoRegisterSince(&xParamTypeRegisterStatic, libs, "g_param_type_register_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamValueConvert, libs, "g_param_value_convert", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamValueDefaults, libs, "g_param_value_defaults", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamValueIsValid, libs, "g_param_value_is_valid", "GOBJECT", "2.74")
+	core.PuregoRegisterSince(&xParamValueSetDefault, libs, "g_param_value_set_default", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamValueValidate, libs, "g_param_value_validate", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamValuesCmp, libs, "g_param_values_cmp", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xParamSpecPoolFree, libs, "g_param_spec_pool_free", "GOBJECT", "2.80")
+	core.PuregoRegisterSince(&xParamSpecPoolInsert, libs, "g_param_spec_pool_insert", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecPoolList, libs, "g_param_spec_pool_list", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecPoolListOwned, libs, "g_param_spec_pool_list_owned", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecPoolLookup, libs, "g_param_spec_pool_lookup", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecPoolRemove, libs, "g_param_spec_pool_remove", "GOBJECT", "")
 
 	core.PuregoSafeRegister(&xParamSpecGLibType, libs, "intern")
 
-	core.PuregoSafeRegister(&xParamSpecGetBlurb, libs, "g_param_spec_get_blurb")
-	core.PuregoSafeRegister(&xParamSpecGetDefaultValue, libs, "g_param_spec_get_default_value")
-	core.PuregoSafeRegister(&xParamSpecGetName, libs, "g_param_spec_get_name")
-	core.PuregoSafeRegister(&xParamSpecGetNameQuark, libs, "g_param_spec_get_name_quark")
-	core.PuregoSafeRegister(&xParamSpecGetNick, libs, "g_param_spec_get_nick")
-	core.PuregoSafeRegister(&xParamSpecGetQdata, libs, "g_param_spec_get_qdata")
-	core.PuregoSafeRegister(&xParamSpecGetRedirectTarget, libs, "g_param_spec_get_redirect_target")
-	core.PuregoSafeRegister(&xParamSpecRef, libs, "g_param_spec_ref")
-	core.PuregoSafeRegister(&xParamSpecRefSink, libs, "g_param_spec_ref_sink")
-	core.PuregoSafeRegister(&xParamSpecSetQdata, libs, "g_param_spec_set_qdata")
-	core.PuregoSafeRegister(&xParamSpecSetQdataFull, libs, "g_param_spec_set_qdata_full")
-	core.PuregoSafeRegister(&xParamSpecSink, libs, "g_param_spec_sink")
-	core.PuregoSafeRegister(&xParamSpecStealQdata, libs, "g_param_spec_steal_qdata")
-	core.PuregoSafeRegister(&xParamSpecUnref, libs, "g_param_spec_unref")
-
-	core.PuregoSafeRegister(&xParamSpecInternal, libs, "g_param_spec_internal")
-	core.PuregoSafeRegister(&xParamSpecIsValidName, libs, "g_param_spec_is_valid_name")
+	CheckClassSize("GParamSpecClass", xParamSpecGLibType, unsafe.Sizeof(ParamSpecClass{}))
+
+	core.PuregoRegisterSince(&xParamSpecGetBlurb, libs, "g_param_spec_get_blurb", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecGetDefaultValue, libs, "g_param_spec_get_default_value", "GOBJECT", "2.38")
+	core.PuregoRegisterSince(&xParamSpecGetName, libs, "g_param_spec_get_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecGetNameQuark, libs, "g_param_spec_get_name_quark", "GOBJECT", "2.46")
+	core.PuregoRegisterSince(&xParamSpecGetNick, libs, "g_param_spec_get_nick", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecGetQdata, libs, "g_param_spec_get_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecGetRedirectTarget, libs, "g_param_spec_get_redirect_target", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xParamSpecRef, libs, "g_param_spec_ref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecRefSink, libs, "g_param_spec_ref_sink", "GOBJECT", "2.10")
+	core.PuregoRegisterSince(&xParamSpecSetQdata, libs, "g_param_spec_set_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecSetQdataFull, libs, "g_param_spec_set_qdata_full", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecSink, libs, "g_param_spec_sink", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecStealQdata, libs, "g_param_spec_steal_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecUnref, libs, "g_param_spec_unref", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xParamSpecInternal, libs, "g_param_spec_internal", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecIsValidName, libs, "g_param_spec_is_valid_name", "GOBJECT", "2.66")
 
 }
@@ -2,7 +2,6 @@
 package gobject
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,13 +9,13 @@ import (
 
 var xNewParamSpecBoolean func(string, uintptr, uintptr, bool, ParamFlags) uintptr
 
-// Creates a new #GParamSpecBoolean instance specifying a %G_TYPE_BOOLEAN
+// Creates a new [ParamSpecBoolean] instance specifying a G_TYPE_BOOLEAN
 // property. In many cases, it may be more appropriate to use an enum with
-// g_param_spec_enum(), both to improve code clarity by using explicitly named
+// [NewParamSpecEnum], both to improve code clarity by using explicitly named
 // values, and to allow for more values to be added in future without breaking
 // API.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecBoolean(NameVar string, NickVar *string, BlurbVar *string, DefaultValueVar bool, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -38,10 +37,10 @@ func NewParamSpecBoolean(NameVar string, NickVar *string, BlurbVar *string, Defa
 
 var xNewParamSpecBoxed func(string, uintptr, uintptr, types.GType, ParamFlags) uintptr
 
-// Creates a new #GParamSpecBoxed instance specifying a %G_TYPE_BOXED
+// Creates a new [ParamSpecBoxed] instance specifying a G_TYPE_BOXED
 // derived property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecBoxed(NameVar string, NickVar *string, BlurbVar *string, BoxedTypeVar types.GType, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -63,7 +62,7 @@ func NewParamSpecBoxed(NameVar string, NickVar *string, BlurbVar *string, BoxedT
 
 var xNewParamSpecChar func(string, uintptr, uintptr, int8, int8, int8, ParamFlags) uintptr
 
-// Creates a new #GParamSpecChar instance specifying a %G_TYPE_CHAR property.
+// Creates a new [ParamSpecChar] instance specifying a G_TYPE_CHAR property.
 func NewParamSpecChar(NameVar string, NickVar *string, BlurbVar *string, MinimumVar int8, MaximumVar int8, DefaultValueVar int8, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -85,10 +84,10 @@ func NewParamSpecChar(NameVar string, NickVar *string, BlurbVar *string, Minimum
 
 var xNewParamSpecDouble func(string, uintptr, uintptr, float64, float64, float64, ParamFlags) uintptr
 
-// Creates a new #GParamSpecDouble instance specifying a %G_TYPE_DOUBLE
+// Creates a new [ParamSpecDouble] instance specifying a G_TYPE_DOUBLE
 // property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecDouble(NameVar string, NickVar *string, BlurbVar *string, MinimumVar float64, MaximumVar float64, DefaultValueVar float64, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -110,10 +109,10 @@ func NewParamSpecDouble(NameVar string, NickVar *string, BlurbVar *string, Minim
 
 var xNewParamSpecEnum func(string, uintptr, uintptr, types.GType, int, ParamFlags) uintptr
 
-// Creates a new #GParamSpecEnum instance specifying a %G_TYPE_ENUM
+// Creates a new [ParamSpecEnum] instance specifying a G_TYPE_ENUM
 // property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecEnum(NameVar string, NickVar *string, BlurbVar *string, EnumTypeVar types.GType, DefaultValueVar int, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -135,10 +134,10 @@ func NewParamSpecEnum(NameVar string, NickVar *string, BlurbVar *string, EnumTyp
 
 var xNewParamSpecFlags func(string, uintptr, uintptr, types.GType, uint, ParamFlags) uintptr
 
-// Creates a new #GParamSpecFlags instance specifying a %G_TYPE_FLAGS
+// Creates a new [ParamSpecFlags] instance specifying a G_TYPE_FLAGS
 // property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecFlags(NameVar string, NickVar *string, BlurbVar *string, FlagsTypeVar types.GType, DefaultValueVar uint, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -160,9 +159,9 @@ func NewParamSpecFlags(NameVar string, NickVar *string, BlurbVar *string, FlagsT
 
 var xNewParamSpecFloat func(string, uintptr, uintptr, float32, float32, float32, ParamFlags) uintptr
 
-// Creates a new #GParamSpecFloat instance specifying a %G_TYPE_FLOAT property.
+// Creates a new [ParamSpecFloat] instance specifying a G_TYPE_FLOAT property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecFloat(NameVar string, NickVar *string, BlurbVar *string, MinimumVar float32, MaximumVar float32, DefaultValueVar float32, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -184,10 +183,10 @@ func NewParamSpecFloat(NameVar string, NickVar *string, BlurbVar *string, Minimu
 
 var xParamSpecGtype func(string, uintptr, uintptr, types.GType, ParamFlags) uintptr
 
-// Creates a new #GParamSpecGType instance specifying a
-// %G_TYPE_GTYPE property.
+// Creates a new [ParamSpecGType] instance specifying a
+// G_TYPE_GTYPE property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func ParamSpecGtype(NameVar string, NickVar *string, BlurbVar *string, IsATypeVar types.GType, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -209,9 +208,9 @@ func ParamSpecGtype(NameVar string, NickVar *string, BlurbVar *string, IsATypeVa
 
 var xNewParamSpecInt func(string, uintptr, uintptr, int, int, int, ParamFlags) uintptr
 
-// Creates a new #GParamSpecInt instance specifying a %G_TYPE_INT property.
+// Creates a new [ParamSpecInt] instance specifying a G_TYPE_INT property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecInt(NameVar string, NickVar *string, BlurbVar *string, MinimumVar int, MaximumVar int, DefaultValueVar int, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -233,9 +232,9 @@ func NewParamSpecInt(NameVar string, NickVar *string, BlurbVar *string, MinimumV
 
 var xNewParamSpecInt64 func(string, uintptr, uintptr, int64, int64, int64, ParamFlags) uintptr
 
-// Creates a new #GParamSpecInt64 instance specifying a %G_TYPE_INT64 property.
+// Creates a new [ParamSpecInt64] instance specifying a G_TYPE_INT64 property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecInt64(NameVar string, NickVar *string, BlurbVar *string, MinimumVar int64, MaximumVar int64, DefaultValueVar int64, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -255,12 +254,12 @@ func NewParamSpecInt64(NameVar string, NickVar *string, BlurbVar *string, Minimu
 	return cls
 }
 
-var xNewParamSpecLong func(string, uintptr, uintptr, int, int, int, ParamFlags) uintptr
+var xNewParamSpecLong func(string, uintptr, uintptr, types.Long, types.Long, types.Long, ParamFlags) uintptr
 
-// Creates a new #GParamSpecLong instance specifying a %G_TYPE_LONG property.
+// Creates a new [ParamSpecLong] instance specifying a G_TYPE_LONG property.
 //
-// See g_param_spec_internal() for details on property names.
-func NewParamSpecLong(NameVar string, NickVar *string, BlurbVar *string, MinimumVar int, MaximumVar int, DefaultValueVar int, FlagsVar ParamFlags) *ParamSpec {
+// See [ParamSpecInternal] for details on property names.
+func NewParamSpecLong(NameVar string, NickVar *string, BlurbVar *string, MinimumVar types.Long, MaximumVar types.Long, DefaultValueVar types.Long, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
 	NickVarPtr := core.GStrdupNullable(NickVar)
@@ -281,10 +280,10 @@ func NewParamSpecLong(NameVar string, NickVar *string, BlurbVar *string, Minimum
 
 var xNewParamSpecObject func(string, uintptr, uintptr, types.GType, ParamFlags) uintptr
 
-// Creates a new #GParamSpecBoxed instance specifying a %G_TYPE_OBJECT
+// Creates a new [ParamSpecBoxed] instance specifying a G_TYPE_OBJECT
 // derived property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecObject(NameVar string, NickVar *string, BlurbVar *string, ObjectTypeVar types.GType, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -306,7 +305,7 @@ func NewParamSpecObject(NameVar string, NickVar *string, BlurbVar *string, Objec
 
 var xNewParamSpecOverride func(string, uintptr) uintptr
 
-// Creates a new property of type #GParamSpecOverride. This is used
+// Creates a new property of type [ParamSpecOverride]. This is used
 // to direct operations to another paramspec, and will not be directly
 // useful unless you are implementing a new base type similar to GObject.
 func NewParamSpecOverride(NameVar string, OverriddenVar *ParamSpec) *ParamSpec {
@@ -324,10 +323,10 @@ func NewParamSpecOverride(NameVar string, OverriddenVar *ParamSpec) *ParamSpec {
 
 var xNewParamSpecParam func(string, uintptr, uintptr, types.GType, ParamFlags) uintptr
 
-// Creates a new #GParamSpecParam instance specifying a %G_TYPE_PARAM
+// Creates a new [ParamSpecParam] instance specifying a G_TYPE_PARAM
 // property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecParam(NameVar string, NickVar *string, BlurbVar *string, ParamTypeVar types.GType, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -349,11 +348,11 @@ func NewParamSpecParam(NameVar string, NickVar *string, BlurbVar *string, ParamT
 
 var xNewParamSpecPointer func(string, uintptr, uintptr, ParamFlags) uintptr
 
-// Creates a new #GParamSpecPointer instance specifying a pointer property.
-// Where possible, it is better to use g_param_spec_object() or
-// g_param_spec_boxed() to expose memory management information.
+// Creates a new [ParamSpecPointer] instance specifying a pointer property.
+// Where possible, it is better to use [NewParamSpecObject] or
+// [NewParamSpecBoxed] to expose memory management information.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecPointer(NameVar string, NickVar *string, BlurbVar *string, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -375,9 +374,9 @@ func NewParamSpecPointer(NameVar string, NickVar *string, BlurbVar *string, Flag
 
 var xNewParamSpecString func(string, uintptr, uintptr, uintptr, ParamFlags) uintptr
 
-// Creates a new #GParamSpecString instance.
+// Creates a new [ParamSpecString] instance.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecString(NameVar string, NickVar *string, BlurbVar *string, DefaultValueVar *string, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -402,7 +401,7 @@ func NewParamSpecString(NameVar string, NickVar *string, BlurbVar *string, Defau
 
 var xParamSpecUchar func(string, uintptr, uintptr, byte, byte, byte, ParamFlags) uintptr
 
-// Creates a new #GParamSpecUChar instance specifying a %G_TYPE_UCHAR property.
+// Creates a new [ParamSpecUChar] instance specifying a G_TYPE_UCHAR property.
 func ParamSpecUchar(NameVar string, NickVar *string, BlurbVar *string, MinimumVar byte, MaximumVar byte, DefaultValueVar byte, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -424,9 +423,9 @@ func ParamSpecUchar(NameVar string, NickVar *string, BlurbVar *string, MinimumVa
 
 var xParamSpecUint func(string, uintptr, uintptr, uint, uint, uint, ParamFlags) uintptr
 
-// Creates a new #GParamSpecUInt instance specifying a %G_TYPE_UINT property.
+// Creates a new [ParamSpecUInt] instance specifying a G_TYPE_UINT property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func ParamSpecUint(NameVar string, NickVar *string, BlurbVar *string, MinimumVar uint, MaximumVar uint, DefaultValueVar uint, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -448,10 +447,10 @@ func ParamSpecUint(NameVar string, NickVar *string, BlurbVar *string, MinimumVar
 
 var xParamSpecUint64 func(string, uintptr, uintptr, uint64, uint64, uint64, ParamFlags) uintptr
 
-// Creates a new #GParamSpecUInt64 instance specifying a %G_TYPE_UINT64
+// Creates a new [ParamSpecUInt64] instance specifying a G_TYPE_UINT64
 // property.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func ParamSpecUint64(NameVar string, NickVar *string, BlurbVar *string, MinimumVar uint64, MaximumVar uint64, DefaultValueVar uint64, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -471,13 +470,13 @@ func ParamSpecUint64(NameVar string, NickVar *string, BlurbVar *string, MinimumV
 	return cls
 }
 
-var xParamSpecUlong func(string, uintptr, uintptr, uint, uint, uint, ParamFlags) uintptr
+var xParamSpecUlong func(string, uintptr, uintptr, types.ULong, types.ULong, types.ULong, ParamFlags) uintptr
 
-// Creates a new #GParamSpecULong instance specifying a %G_TYPE_ULONG
+// Creates a new [ParamSpecULong] instance specifying a G_TYPE_ULONG
 // property.
 //
-// See g_param_spec_internal() for details on property names.
-func ParamSpecUlong(NameVar string, NickVar *string, BlurbVar *string, MinimumVar uint, MaximumVar uint, DefaultValueVar uint, FlagsVar ParamFlags) *ParamSpec {
+// See [ParamSpecInternal] for details on property names.
+func ParamSpecUlong(NameVar string, NickVar *string, BlurbVar *string, MinimumVar types.ULong, MaximumVar types.ULong, DefaultValueVar types.ULong, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
 	NickVarPtr := core.GStrdupNullable(NickVar)
@@ -496,14 +495,14 @@ func ParamSpecUlong(NameVar string, NickVar *string, BlurbVar *string, MinimumVa
 	return cls
 }
 
-var xNewParamSpecUnichar func(string, uintptr, uintptr, uint32, ParamFlags) uintptr
+var xNewParamSpecUnichar func(string, uintptr, uintptr, rune, ParamFlags) uintptr
 
-// Creates a new #GParamSpecUnichar instance specifying a %G_TYPE_UINT
-// property. #GValue structures for this property can be accessed with
-// g_value_set_uint() and g_value_get_uint().
+// Creates a new [ParamSpecUnichar] instance specifying a G_TYPE_UINT
+// property. [Value] structures for this property can be accessed with
+// [Value.SetUint] and [Value.GetUint].
 //
-// See g_param_spec_internal() for details on property names.
-func NewParamSpecUnichar(NameVar string, NickVar *string, BlurbVar *string, DefaultValueVar uint32, FlagsVar ParamFlags) *ParamSpec {
+// See [ParamSpecInternal] for details on property names.
+func NewParamSpecUnichar(NameVar string, NickVar *string, BlurbVar *string, DefaultValueVar rune, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
 	NickVarPtr := core.GStrdupNullable(NickVar)
@@ -524,12 +523,12 @@ func NewParamSpecUnichar(NameVar string, NickVar *string, BlurbVar *string, Defa
 
 var xNewParamSpecValueArray func(string, uintptr, uintptr, uintptr, ParamFlags) uintptr
 
-// Creates a new #GParamSpecValueArray instance specifying a
-// %G_TYPE_VALUE_ARRAY property. %G_TYPE_VALUE_ARRAY is a
-// %G_TYPE_BOXED type, as such, #GValue structures for this property
-// can be accessed with g_value_set_boxed() and g_value_get_boxed().
+// Creates a new [ParamSpecValueArray] instance specifying a
+// G_TYPE_VALUE_ARRAY property. G_TYPE_VALUE_ARRAY is a
+// G_TYPE_BOXED type, as such, [Value] structures for this property
+// can be accessed with [Value.SetBoxed] and [Value.GetBoxed].
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecValueArray(NameVar string, NickVar *string, BlurbVar *string, ElementSpecVar *ParamSpec, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -551,12 +550,12 @@ func NewParamSpecValueArray(NameVar string, NickVar *string, BlurbVar *string, E
 
 var xNewParamSpecVariant func(string, uintptr, uintptr, *glib.VariantType, *glib.Variant, ParamFlags) uintptr
 
-// Creates a new #GParamSpecVariant instance specifying a #GVariant
+// Creates a new [ParamSpecVariant] instance specifying a [glib.Variant]
 // property.
 //
-// If @default_value is floating, it is consumed.
+// If default_value is floating, it is consumed.
 //
-// See g_param_spec_internal() for details on property names.
+// See [ParamSpecInternal] for details on property names.
 func NewParamSpecVariant(NameVar string, NickVar *string, BlurbVar *string, TypeVar *glib.VariantType, DefaultValueVar *glib.Variant, FlagsVar ParamFlags) *ParamSpec {
 	var cls *ParamSpec
 
@@ -576,7 +575,7 @@ func NewParamSpecVariant(NameVar string, NickVar *string, BlurbVar *string, Type
 	return cls
 }
 
-// A #GParamSpec derived structure that contains the meta data for boolean properties.
+// A [ParamSpec] derived structure that contains the meta data for boolean properties.
 type ParamSpecBoolean struct {
 	ParamSpec
 }
@@ -604,7 +603,7 @@ func (c *ParamSpecBoolean) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for boxed properties.
+// A [ParamSpec] derived structure that contains the meta data for boxed properties.
 type ParamSpecBoxed struct {
 	ParamSpec
 }
@@ -632,7 +631,7 @@ func (c *ParamSpecBoxed) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for character properties.
+// A [ParamSpec] derived structure that contains the meta data for character properties.
 type ParamSpecChar struct {
 	ParamSpec
 }
@@ -660,7 +659,7 @@ func (c *ParamSpecChar) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for double properties.
+// A [ParamSpec] derived structure that contains the meta data for double properties.
 type ParamSpecDouble struct {
 	ParamSpec
 }
@@ -688,7 +687,7 @@ func (c *ParamSpecDouble) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for enum
+// A [ParamSpec] derived structure that contains the meta data for enum
 // properties.
 type ParamSpecEnum struct {
 	ParamSpec
@@ -717,7 +716,7 @@ func (c *ParamSpecEnum) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for flags
+// A [ParamSpec] derived structure that contains the meta data for flags
 // properties.
 type ParamSpecFlags struct {
 	ParamSpec
@@ -746,7 +745,7 @@ func (c *ParamSpecFlags) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for float properties.
+// A [ParamSpec] derived structure that contains the meta data for float properties.
 type ParamSpecFloat struct {
 	ParamSpec
 }
@@ -774,7 +773,7 @@ func (c *ParamSpecFloat) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for #GType properties.
+// A [ParamSpec] derived structure that contains the meta data for [Type] properties.
 type ParamSpecGType struct {
 	ParamSpec
 }
@@ -802,7 +801,7 @@ func (c *ParamSpecGType) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for integer properties.
+// A [ParamSpec] derived structure that contains the meta data for integer properties.
 type ParamSpecInt struct {
 	ParamSpec
 }
@@ -830,7 +829,7 @@ func (c *ParamSpecInt) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for 64bit integer properties.
+// A [ParamSpec] derived structure that contains the meta data for 64bit integer properties.
 type ParamSpecInt64 struct {
 	ParamSpec
 }
@@ -858,7 +857,7 @@ func (c *ParamSpecInt64) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for long integer properties.
+// A [ParamSpec] derived structure that contains the meta data for long integer properties.
 type ParamSpecLong struct {
 	ParamSpec
 }
@@ -886,7 +885,7 @@ func (c *ParamSpecLong) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for object properties.
+// A [ParamSpec] derived structure that contains the meta data for object properties.
 type ParamSpecObject struct {
 	ParamSpec
 }
@@ -914,16 +913,16 @@ func (c *ParamSpecObject) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that redirects operations to
-// other types of #GParamSpec.
+// A [ParamSpec] derived structure that redirects operations to
+// other types of [ParamSpec].
 //
 // All operations other than getting or setting the value are redirected,
 // including accessing the nick and blurb, validating a value, and so
 // forth.
 //
-// See g_param_spec_get_redirect_target() for retrieving the overridden
-// property. #GParamSpecOverride is used in implementing
-// g_object_class_override_property(), and will not be directly useful
+// See [ParamSpec.GetRedirectTarget] for retrieving the overridden
+// property. [ParamSpecOverride] is used in implementing
+// [ObjectClass.OverrideProperty], and will not be directly useful
 // unless you are implementing a new base type similar to GObject.
 type ParamSpecOverride struct {
 	ParamSpec
@@ -952,7 +951,7 @@ func (c *ParamSpecOverride) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for %G_TYPE_PARAM
+// A [ParamSpec] derived structure that contains the meta data for G_TYPE_PARAM
 // properties.
 type ParamSpecParam struct {
 	ParamSpec
@@ -981,7 +980,7 @@ func (c *ParamSpecParam) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for pointer properties.
+// A [ParamSpec] derived structure that contains the meta data for pointer properties.
 type ParamSpecPointer struct {
 	ParamSpec
 }
@@ -1009,7 +1008,7 @@ func (c *ParamSpecPointer) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for string
+// A [ParamSpec] derived structure that contains the meta data for string
 // properties.
 type ParamSpecString struct {
 	ParamSpec
@@ -1038,7 +1037,7 @@ func (c *ParamSpecString) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for unsigned character properties.
+// A [ParamSpec] derived structure that contains the meta data for unsigned character properties.
 type ParamSpecUChar struct {
 	ParamSpec
 }
@@ -1066,7 +1065,7 @@ func (c *ParamSpecUChar) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for unsigned integer properties.
+// A [ParamSpec] derived structure that contains the meta data for unsigned integer properties.
 type ParamSpecUInt struct {
 	ParamSpec
 }
@@ -1094,7 +1093,7 @@ func (c *ParamSpecUInt) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for unsigned 64bit integer properties.
+// A [ParamSpec] derived structure that contains the meta data for unsigned 64bit integer properties.
 type ParamSpecUInt64 struct {
 	ParamSpec
 }
@@ -1122,7 +1121,7 @@ func (c *ParamSpecUInt64) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for unsigned long integer properties.
+// A [ParamSpec] derived structure that contains the meta data for unsigned long integer properties.
 type ParamSpecULong struct {
 	ParamSpec
 }
@@ -1150,7 +1149,7 @@ func (c *ParamSpecULong) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for unichar (unsigned integer) properties.
+// A [ParamSpec] derived structure that contains the meta data for unichar (unsigned integer) properties.
 type ParamSpecUnichar struct {
 	ParamSpec
 }
@@ -1178,7 +1177,7 @@ func (c *ParamSpecUnichar) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for #GValueArray properties.
+// A [ParamSpec] derived structure that contains the meta data for [ValueArray] properties.
 type ParamSpecValueArray struct {
 	ParamSpec
 }
@@ -1206,12 +1205,12 @@ func (c *ParamSpecValueArray) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A #GParamSpec derived structure that contains the meta data for #GVariant properties.
+// A [ParamSpec] derived structure that contains the meta data for [glib.Variant] properties.
 //
-// When comparing values with g_param_values_cmp(), scalar values with the same
-// type will be compared with g_variant_compare(). Other non-%NULL variants will
-// be checked for equality with g_variant_equal(), and their sort order is
-// otherwise undefined. %NULL is ordered before non-%NULL variants. Two %NULL
+// When comparing values with [ParamValuesCmp], scalar values with the same
+// type will be compared with [glib.Variant.Compare]. Other non-nil variants will
+// be checked for equality with [glib.Variant.Equal], and their sort order is
+// otherwise undefined. nil is ordered before non-nil variants. Two nil
 // values compare equal.
 type ParamSpecVariant struct {
 	ParamSpec
@@ -1245,36 +1244,36 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xNewParamSpecBoolean, libs, "g_param_spec_boolean")
-	core.PuregoSafeRegister(&xNewParamSpecBoxed, libs, "g_param_spec_boxed")
-	core.PuregoSafeRegister(&xNewParamSpecChar, libs, "g_param_spec_char")
-	core.PuregoSafeRegister(&xNewParamSpecDouble, libs, "g_param_spec_double")
-	core.PuregoSafeRegister(&xNewParamSpecEnum, libs, "g_param_spec_enum")
-	core.PuregoSafeRegister(&xNewParamSpecFlags, libs, "g_param_spec_flags")
-	core.PuregoSafeRegister(&xNewParamSpecFloat, libs, "g_param_spec_float")
-	core.PuregoSafeRegister(&xParamSpecGtype, libs, "g_param_spec_gtype")
-	core.PuregoSafeRegister(&xNewParamSpecInt, libs, "g_param_spec_int")
-	core.PuregoSafeRegister(&xNewParamSpecInt64, libs, "g_param_spec_int64")
-	core.PuregoSafeRegister(&xNewParamSpecLong, libs, "g_param_spec_long")
-	core.PuregoSafeRegister(&xNewParamSpecObject, libs, "g_param_spec_object")
-	core.PuregoSafeRegister(&xNewParamSpecOverride, libs, "g_param_spec_override")
-	core.PuregoSafeRegister(&xNewParamSpecParam, libs, "g_param_spec_param")
-	core.PuregoSafeRegister(&xNewParamSpecPointer, libs, "g_param_spec_pointer")
-	core.PuregoSafeRegister(&xNewParamSpecString, libs, "g_param_spec_string")
-	core.PuregoSafeRegister(&xParamSpecUchar, libs, "g_param_spec_uchar")
-	core.PuregoSafeRegister(&xParamSpecUint, libs, "g_param_spec_uint")
-	core.PuregoSafeRegister(&xParamSpecUint64, libs, "g_param_spec_uint64")
-	core.PuregoSafeRegister(&xParamSpecUlong, libs, "g_param_spec_ulong")
-	core.PuregoSafeRegister(&xNewParamSpecUnichar, libs, "g_param_spec_unichar")
-	core.PuregoSafeRegister(&xNewParamSpecValueArray, libs, "g_param_spec_value_array")
-	core.PuregoSafeRegister(&xNewParamSpecVariant, libs, "g_param_spec_variant")
+	core.PuregoRegisterSince(&xNewParamSpecBoolean, libs, "g_param_spec_boolean", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecBoxed, libs, "g_param_spec_boxed", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecChar, libs, "g_param_spec_char", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecDouble, libs, "g_param_spec_double", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecEnum, libs, "g_param_spec_enum", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecFlags, libs, "g_param_spec_flags", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecFloat, libs, "g_param_spec_float", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecGtype, libs, "g_param_spec_gtype", "GOBJECT", "2.10")
+	core.PuregoRegisterSince(&xNewParamSpecInt, libs, "g_param_spec_int", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecInt64, libs, "g_param_spec_int64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecLong, libs, "g_param_spec_long", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecObject, libs, "g_param_spec_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecOverride, libs, "g_param_spec_override", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xNewParamSpecParam, libs, "g_param_spec_param", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecPointer, libs, "g_param_spec_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecString, libs, "g_param_spec_string", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecUchar, libs, "g_param_spec_uchar", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecUint, libs, "g_param_spec_uint", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecUint64, libs, "g_param_spec_uint64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xParamSpecUlong, libs, "g_param_spec_ulong", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecUnichar, libs, "g_param_spec_unichar", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecValueArray, libs, "g_param_spec_value_array", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewParamSpecVariant, libs, "g_param_spec_variant", "GOBJECT", "2.26")
 
 	core.PuregoSafeRegister(&xParamSpecBooleanGLibType, libs, "intern")
 
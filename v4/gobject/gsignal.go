@@ -2,10 +2,11 @@
 package gobject
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -16,7 +17,7 @@ import (
 // during a signal emission.
 //
 // The signal accumulator is specified at signal creation time, if it is
-// left %NULL, no accumulation of callback return values is performed.
+// left nil, no accumulation of callback return values is performed.
 // The return value of signal emissions is then the value returned by the
 // last callback.
 type SignalAccumulator func(*SignalInvocationHint, *Value, *Value, uintptr) bool
@@ -26,10 +27,10 @@ type SignalAccumulator func(*SignalInvocationHint, *Value, *Value, uintptr) bool
 // Emission hooks allow you to tie a hook to the signal type, so that it will
 // trap all emissions of that signal, from any object.
 //
-// You may not attach these to signals created with the %G_SIGNAL_NO_HOOKS flag.
+// You may not attach these to signals created with the [GSignalNoHooksValue] flag.
 type SignalEmissionHook func(*SignalInvocationHint, uint, []Value, uintptr) bool
 
-// The #GSignalInvocationHint structure is used to pass on additional information
+// The [SignalInvocationHint] structure is used to pass on additional information
 // to callbacks during a signal emission.
 type SignalInvocationHint struct {
 	_ structs.HostLayout
@@ -47,7 +48,7 @@ func (x *SignalInvocationHint) GoPointer() uintptr {
 
 // A structure holding in-depth information for a specific signal.
 //
-// See also: g_signal_query()
+// See also: [NewSignalQuery]
 type SignalQuery struct {
 	_ structs.HostLayout
 
@@ -70,11 +71,24 @@ func (x *SignalQuery) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewSignalQueryAlloc returns a zeroed SignalQuery allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated SignalQuery. Release it with Free.
+func NewSignalQueryAlloc() *SignalQuery {
+	cret := core.GMalloc0(max(unsafe.Sizeof(SignalQuery{}), 56))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*SignalQuery)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the SignalQuery with g_free, it must have been allocated by GLib, e.g. with NewSignalQueryAlloc.
+func (x *SignalQuery) Free() {
+	core.GFree(x.GoPointer())
+}
+
 // This is the signature of marshaller functions, required to marshall
 // arrays of parameter values to signal emissions into C language callback
 // invocations.
 //
-// It is merely an alias to #GClosureMarshal since the #GClosure mechanism
+// It is merely an alias to [ClosureMarshal] since the [Closure] mechanism
 // takes over responsibility of actual function invocation for the signal
 // system.
 type SignalCMarshaller = ClosureMarshal
@@ -85,15 +99,15 @@ type SignalCMarshaller = ClosureMarshal
 type SignalCVaMarshaller = VaClosureMarshal
 
 const (
-	// A mask for all #GSignalFlags bits.
+	// A mask for all [SignalFlags] bits.
 	SIGNAL_FLAGS_MASK int = 511
-	// A mask for all #GSignalMatchType bits.
+	// A mask for all [SignalMatchType] bits.
 	SIGNAL_MATCH_MASK int = 63
 )
 
 // The connection flags are used to specify the behaviour of a signal's
 // connection.
-type ConnectFlags int
+type ConnectFlags uint32
 
 const (
 
@@ -108,8 +122,44 @@ const (
 	GConnectSwappedValue ConnectFlags = 2
 )
 
+// Has reports whether all bits of flags are set in x
+func (x ConnectFlags) Has(flags ConnectFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ConnectFlags) Set(flags ConnectFlags) ConnectFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ConnectFlags) Clear(flags ConnectFlags) ConnectFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ConnectFlags) String() string {
+	if x == 0 {
+		return "G_CONNECT_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&GConnectAfterValue != 0 && x&GConnectAfterValue == GConnectAfterValue {
+		names = append(names, "G_CONNECT_AFTER")
+		rest &^= GConnectAfterValue
+	}
+	if rest&GConnectSwappedValue != 0 && x&GConnectSwappedValue == GConnectSwappedValue {
+		names = append(names, "G_CONNECT_SWAPPED")
+		rest &^= GConnectSwappedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The signal flags are used to specify a signal's behaviour.
-type SignalFlags int
+type SignalFlags uint32
 
 const (
 
@@ -127,7 +177,7 @@ const (
 	//  upon handler connections and emissions.
 	GSignalDetailedValue SignalFlags = 16
 	// Action signals are signals that may freely be emitted on alive
-	//  objects from user code via g_signal_emit() and friends, without
+	//  objects from user code via [SignalEmit] and friends, without
 	//  the need of being embedded into extra code that performs pre or
 	//  post emission adjustments on the object. They can also be thought
 	//  of as object methods which can be called generically by
@@ -145,16 +195,84 @@ const (
 	GSignalDeprecatedValue SignalFlags = 256
 	// The signal accumulator was invoked for the first time.
 	//
-	// This flag is only used in [callback@GObject.SignalAccumulator][accumulator functions]
-	// for the `run_type` field of the [struct@GObject.SignalInvocationHint], to
+	// This flag is only used in [SignalAccumulator][accumulator functions]
+	// for the `run_type` field of the [SignalInvocationHint], to
 	// mark the first call to the accumulator function for a signal emission.
 	GSignalAccumulatorFirstRunValue SignalFlags = 131072
 )
 
-// The match types specify what g_signal_handlers_block_matched(),
-// g_signal_handlers_unblock_matched() and g_signal_handlers_disconnect_matched()
+// Has reports whether all bits of flags are set in x
+func (x SignalFlags) Has(flags SignalFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SignalFlags) Set(flags SignalFlags) SignalFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SignalFlags) Clear(flags SignalFlags) SignalFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SignalFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GSignalRunFirstValue != 0 && x&GSignalRunFirstValue == GSignalRunFirstValue {
+		names = append(names, "G_SIGNAL_RUN_FIRST")
+		rest &^= GSignalRunFirstValue
+	}
+	if rest&GSignalRunLastValue != 0 && x&GSignalRunLastValue == GSignalRunLastValue {
+		names = append(names, "G_SIGNAL_RUN_LAST")
+		rest &^= GSignalRunLastValue
+	}
+	if rest&GSignalRunCleanupValue != 0 && x&GSignalRunCleanupValue == GSignalRunCleanupValue {
+		names = append(names, "G_SIGNAL_RUN_CLEANUP")
+		rest &^= GSignalRunCleanupValue
+	}
+	if rest&GSignalNoRecurseValue != 0 && x&GSignalNoRecurseValue == GSignalNoRecurseValue {
+		names = append(names, "G_SIGNAL_NO_RECURSE")
+		rest &^= GSignalNoRecurseValue
+	}
+	if rest&GSignalDetailedValue != 0 && x&GSignalDetailedValue == GSignalDetailedValue {
+		names = append(names, "G_SIGNAL_DETAILED")
+		rest &^= GSignalDetailedValue
+	}
+	if rest&GSignalActionValue != 0 && x&GSignalActionValue == GSignalActionValue {
+		names = append(names, "G_SIGNAL_ACTION")
+		rest &^= GSignalActionValue
+	}
+	if rest&GSignalNoHooksValue != 0 && x&GSignalNoHooksValue == GSignalNoHooksValue {
+		names = append(names, "G_SIGNAL_NO_HOOKS")
+		rest &^= GSignalNoHooksValue
+	}
+	if rest&GSignalMustCollectValue != 0 && x&GSignalMustCollectValue == GSignalMustCollectValue {
+		names = append(names, "G_SIGNAL_MUST_COLLECT")
+		rest &^= GSignalMustCollectValue
+	}
+	if rest&GSignalDeprecatedValue != 0 && x&GSignalDeprecatedValue == GSignalDeprecatedValue {
+		names = append(names, "G_SIGNAL_DEPRECATED")
+		rest &^= GSignalDeprecatedValue
+	}
+	if rest&GSignalAccumulatorFirstRunValue != 0 && x&GSignalAccumulatorFirstRunValue == GSignalAccumulatorFirstRunValue {
+		names = append(names, "G_SIGNAL_ACCUMULATOR_FIRST_RUN")
+		rest &^= GSignalAccumulatorFirstRunValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// The match types specify what [SignalHandlersBlockMatched],
+// [SignalHandlersUnblockMatched] and [SignalHandlersDisconnectMatched]
 // match signals by.
-type SignalMatchType int
+type SignalMatchType uint32
 
 const (
 
@@ -172,17 +290,69 @@ const (
 	GSignalMatchUnblockedValue SignalMatchType = 32
 )
 
-var xClearSignalHandler func(uint, uintptr)
+// Has reports whether all bits of flags are set in x
+func (x SignalMatchType) Has(flags SignalMatchType) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SignalMatchType) Set(flags SignalMatchType) SignalMatchType {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SignalMatchType) Clear(flags SignalMatchType) SignalMatchType {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SignalMatchType) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GSignalMatchIdValue != 0 && x&GSignalMatchIdValue == GSignalMatchIdValue {
+		names = append(names, "G_SIGNAL_MATCH_ID")
+		rest &^= GSignalMatchIdValue
+	}
+	if rest&GSignalMatchDetailValue != 0 && x&GSignalMatchDetailValue == GSignalMatchDetailValue {
+		names = append(names, "G_SIGNAL_MATCH_DETAIL")
+		rest &^= GSignalMatchDetailValue
+	}
+	if rest&GSignalMatchClosureValue != 0 && x&GSignalMatchClosureValue == GSignalMatchClosureValue {
+		names = append(names, "G_SIGNAL_MATCH_CLOSURE")
+		rest &^= GSignalMatchClosureValue
+	}
+	if rest&GSignalMatchFuncValue != 0 && x&GSignalMatchFuncValue == GSignalMatchFuncValue {
+		names = append(names, "G_SIGNAL_MATCH_FUNC")
+		rest &^= GSignalMatchFuncValue
+	}
+	if rest&GSignalMatchDataValue != 0 && x&GSignalMatchDataValue == GSignalMatchDataValue {
+		names = append(names, "G_SIGNAL_MATCH_DATA")
+		rest &^= GSignalMatchDataValue
+	}
+	if rest&GSignalMatchUnblockedValue != 0 && x&GSignalMatchUnblockedValue == GSignalMatchUnblockedValue {
+		names = append(names, "G_SIGNAL_MATCH_UNBLOCKED")
+		rest &^= GSignalMatchUnblockedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
 
-// Disconnects a handler from @instance so it will not be called during
+var xClearSignalHandler func(types.ULong, uintptr)
+
+// Disconnects a handler from instance so it will not be called during
 // any future or currently ongoing emissions of the signal it has been
-// connected to. The @handler_id_ptr is then set to zero, which is never a valid handler ID value (see g_signal_connect()).
+// connected to. The handler_id_ptr is then set to zero, which is never a valid handler ID value (see g_signal_connect()).
 //
 // If the handler ID is 0 then this function does nothing.
 //
 // There is also a macro version of this function so that the code
 // will be inlined.
-func ClearSignalHandler(HandlerIdPtrVar uint, InstanceVar *Object) {
+func ClearSignalHandler(HandlerIdPtrVar types.ULong, InstanceVar *Object) {
 
 	xClearSignalHandler(HandlerIdPtrVar, InstanceVar.GoPointer())
 
@@ -190,7 +360,7 @@ func ClearSignalHandler(HandlerIdPtrVar uint, InstanceVar *Object) {
 
 var xSignalAccumulatorFirstWins func(*SignalInvocationHint, *Value, *Value, uintptr) bool
 
-// A predefined #GSignalAccumulator for signals intended to be used as a
+// A predefined [SignalAccumulator] for signals intended to be used as a
 // hook for application code to provide a particular value.  Usually
 // only one such value is desired and multiple handlers for the same
 // signal don't make much sense (except for the case of the default
@@ -208,11 +378,11 @@ func SignalAccumulatorFirstWins(IhintVar *SignalInvocationHint, ReturnAccuVar *V
 
 var xSignalAccumulatorTrueHandled func(*SignalInvocationHint, *Value, *Value, uintptr) bool
 
-// A predefined #GSignalAccumulator for signals that return a
+// A predefined [SignalAccumulator] for signals that return a
 // boolean values. The behavior that this accumulator gives is
-// that a return of %TRUE stops the signal emission: no further
-// callbacks will be invoked, while a return of %FALSE allows
-// the emission to continue. The idea here is that a %TRUE return
+// that a return of true stops the signal emission: no further
+// callbacks will be invoked, while a return of false allows
+// the emission to continue. The idea here is that a true return
 // indicates that the callback handled the signal, and no further
 // handling is needed.
 func SignalAccumulatorTrueHandled(IhintVar *SignalInvocationHint, ReturnAccuVar *Value, HandlerReturnVar *Value, DummyVar uintptr) bool {
@@ -221,12 +391,12 @@ func SignalAccumulatorTrueHandled(IhintVar *SignalInvocationHint, ReturnAccuVar
 	return cret
 }
 
-var xSignalAddEmissionHook func(uint, glib.Quark, uintptr, uintptr, uintptr) uint
+var xSignalAddEmissionHook func(uint, glib.Quark, uintptr, uintptr, uintptr) types.ULong
 
 // Adds an emission hook for a signal, which will get called for any emission
 // of that signal, independent of the instance. This is possible only
-// for signals which don't have %G_SIGNAL_NO_HOOKS flag set.
-func SignalAddEmissionHook(SignalIdVar uint, DetailVar glib.Quark, HookFuncVar *SignalEmissionHook, HookDataVar uintptr, DataDestroyVar *glib.DestroyNotify) uint {
+// for signals which don't have [GSignalNoHooksValue] flag set.
+func SignalAddEmissionHook(SignalIdVar uint, DetailVar glib.Quark, HookFuncVar *SignalEmissionHook, HookDataVar uintptr, DataDestroyVar *glib.DestroyNotify) types.ULong {
 
 	var HookFuncVarRef uintptr
 	if HookFuncVar != nil {
@@ -235,11 +405,12 @@ func SignalAddEmissionHook(SignalIdVar uint, DetailVar glib.Quark, HookFuncVar *
 			HookFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SignalInvocationHint, arg1 uint, arg2 []Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *HookFuncVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			HookFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(HookFuncVarPtr, HookFuncVarRef, HookFuncVar)
+			HookFuncVarRef = core.NewCallback(fcb)
+			HookFuncVarRef = glib.SaveCallbackWithClosure(HookFuncVarPtr, HookFuncVarRef, HookFuncVar)
 		}
 	}
 
@@ -250,11 +421,12 @@ func SignalAddEmissionHook(SignalIdVar uint, DetailVar glib.Quark, HookFuncVar *
 			DataDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DataDestroyVar
 				cbFn(arg0)
 			}
-			DataDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DataDestroyVarPtr, DataDestroyVarRef, DataDestroyVar)
+			DataDestroyVarRef = core.NewCallback(fcb)
+			DataDestroyVarRef = glib.SaveCallbackWithClosure(DataDestroyVarPtr, DataDestroyVarRef, DataDestroyVar)
 		}
 	}
 
@@ -266,8 +438,8 @@ var xSignalChainFromOverridden func([]Value, *Value)
 
 // Calls the original class closure of a signal. This function should only
 // be called from an overridden class closure; see
-// g_signal_override_class_closure() and
-// g_signal_override_class_handler().
+// [SignalOverrideClassClosure] and
+// [SignalOverrideClassHandler].
 func SignalChainFromOverridden(InstanceAndParamsVar []Value, ReturnValueVar *Value) {
 
 	xSignalChainFromOverridden(InstanceAndParamsVar, ReturnValueVar)
@@ -278,70 +450,70 @@ var xSignalChainFromOverriddenHandler func(*TypeInstance, ...interface{})
 
 // Calls the original class closure of a signal. This function should
 // only be called from an overridden class closure; see
-// g_signal_override_class_closure() and
-// g_signal_override_class_handler().
+// [SignalOverrideClassClosure] and
+// [SignalOverrideClassHandler].
 func SignalChainFromOverriddenHandler(InstanceVar *TypeInstance, varArgs ...interface{}) {
 
 	xSignalChainFromOverriddenHandler(InstanceVar, varArgs...)
 
 }
 
-var xSignalConnectClosure func(uintptr, string, *Closure, bool) uint
+var xSignalConnectClosure func(uintptr, string, *Closure, bool) types.ULong
 
 // Connects a closure to a signal for a particular object.
 //
-// If @closure is a floating reference (see g_closure_sink()), this function
-// takes ownership of @closure.
+// If closure is a floating reference (see [Closure.Sink]), this function
+// takes ownership of closure.
 //
 // This function cannot fail. If the given signal name doesn’t exist,
 // a critical warning is emitted. No validation is performed on the
-// ‘detail’ string when specified in @detailed_signal, other than a
+// ‘detail’ string when specified in detailed_signal, other than a
 // non-empty check.
 //
-// Refer to the [signals documentation](signals.html) for more
+// Refer to the signals documentation for more
 // details.
-func SignalConnectClosure(InstanceVar *Object, DetailedSignalVar string, ClosureVar *Closure, AfterVar bool) uint {
+func SignalConnectClosure(InstanceVar *Object, DetailedSignalVar string, ClosureVar *Closure, AfterVar bool) types.ULong {
 
 	cret := xSignalConnectClosure(InstanceVar.GoPointer(), DetailedSignalVar, ClosureVar, AfterVar)
 	return cret
 }
 
-var xSignalConnectClosureById func(uintptr, uint, glib.Quark, *Closure, bool) uint
+var xSignalConnectClosureById func(uintptr, uint, glib.Quark, *Closure, bool) types.ULong
 
 // Connects a closure to a signal for a particular object.
 //
-// If @closure is a floating reference (see g_closure_sink()), this function
-// takes ownership of @closure.
+// If closure is a floating reference (see [Closure.Sink]), this function
+// takes ownership of closure.
 //
 // This function cannot fail. If the given signal name doesn’t exist,
 // a critical warning is emitted. No validation is performed on the
-// ‘detail’ string when specified in @detailed_signal, other than a
+// ‘detail’ string when specified in detailed_signal, other than a
 // non-empty check.
 //
-// Refer to the [signals documentation](signals.html) for more
+// Refer to the signals documentation for more
 // details.
-func SignalConnectClosureById(InstanceVar *Object, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, AfterVar bool) uint {
+func SignalConnectClosureById(InstanceVar *Object, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, AfterVar bool) types.ULong {
 
 	cret := xSignalConnectClosureById(InstanceVar.GoPointer(), SignalIdVar, DetailVar, ClosureVar, AfterVar)
 	return cret
 }
 
-var xSignalConnectData func(uintptr, string, uintptr, uintptr, uintptr, ConnectFlags) uint
+var xSignalConnectData func(uintptr, string, uintptr, uintptr, uintptr, ConnectFlags) types.ULong
 
-// Connects a #GCallback function to a signal for a particular object. Similar
-// to g_signal_connect(), but allows to provide a #GClosureNotify for the data
+// Connects a [Callback] function to a signal for a particular object. Similar
+// to g_signal_connect(), but allows to provide a [ClosureNotify] for the data
 // which will be called when the signal handler is disconnected and no longer
-// used. Specify @connect_flags if you need `..._after()` or
+// used. Specify connect_flags if you need `..._after()` or
 // `..._swapped()` variants of this function.
 //
 // This function cannot fail. If the given signal name doesn’t exist,
 // a critical warning is emitted. No validation is performed on the
-// ‘detail’ string when specified in @detailed_signal, other than a
+// ‘detail’ string when specified in detailed_signal, other than a
 // non-empty check.
 //
-// Refer to the [signals documentation](signals.html) for more
+// Refer to the signals documentation for more
 // details.
-func SignalConnectData(InstanceVar *Object, DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr, DestroyDataVar *ClosureNotify, ConnectFlagsVar ConnectFlags) uint {
+func SignalConnectData(InstanceVar *Object, DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr, DestroyDataVar *ClosureNotify, ConnectFlagsVar ConnectFlags) types.ULong {
 
 	var CHandlerVarRef uintptr
 	if CHandlerVar != nil {
@@ -350,11 +522,12 @@ func SignalConnectData(InstanceVar *Object, DetailedSignalVar string, CHandlerVa
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -365,11 +538,12 @@ func SignalConnectData(InstanceVar *Object, DetailedSignalVar string, CHandlerVa
 			DestroyDataVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyDataVar
 				cbFn(arg0, arg1)
 			}
-			DestroyDataVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
+			DestroyDataVarRef = core.NewCallback(fcb)
+			DestroyDataVarRef = glib.SaveCallbackWithClosure(DestroyDataVarPtr, DestroyDataVarRef, DestroyDataVar)
 		}
 	}
 
@@ -382,12 +556,10 @@ var xSignalEmit func(uintptr, uint, glib.Quark, ...interface{})
 // Emits a signal. Signal emission is done synchronously.
 // The method will only return control after all handlers are called or signal emission was stopped.
 //
-// Note that g_signal_emit() resets the return value to the default
-// if no handlers are connected, in contrast to g_signal_emitv().
+// Note that [SignalEmit] resets the return value to the default
+// if no handlers are connected, in contrast to [SignalEmitv].
 func SignalEmit(InstanceVar *Object, SignalIdVar uint, DetailVar glib.Quark, varArgs ...interface{}) {
-
-	xSignalEmit(InstanceVar.GoPointer(), SignalIdVar, DetailVar, varArgs...)
-
+	signalEmitVarArgs(InstanceVar, SignalIdVar, DetailVar, varArgs...)
 }
 
 var xSignalEmitByName func(uintptr, string, ...interface{})
@@ -395,12 +567,10 @@ var xSignalEmitByName func(uintptr, string, ...interface{})
 // Emits a signal. Signal emission is done synchronously.
 // The method will only return control after all handlers are called or signal emission was stopped.
 //
-// Note that g_signal_emit_by_name() resets the return value to the default
-// if no handlers are connected, in contrast to g_signal_emitv().
+// Note that [SignalEmitByName] resets the return value to the default
+// if no handlers are connected, in contrast to [SignalEmitv].
 func SignalEmitByName(InstanceVar *Object, DetailedSignalVar string, varArgs ...interface{}) {
-
-	xSignalEmitByName(InstanceVar.GoPointer(), DetailedSignalVar, varArgs...)
-
+	signalEmitByNameVarArgs(InstanceVar, DetailedSignalVar, varArgs...)
 }
 
 var xSignalEmitValist func(*TypeInstance, uint, glib.Quark, []interface{})
@@ -408,8 +578,8 @@ var xSignalEmitValist func(*TypeInstance, uint, glib.Quark, []interface{})
 // Emits a signal. Signal emission is done synchronously.
 // The method will only return control after all handlers are called or signal emission was stopped.
 //
-// Note that g_signal_emit_valist() resets the return value to the default
-// if no handlers are connected, in contrast to g_signal_emitv().
+// Note that [SignalEmitValist] resets the return value to the default
+// if no handlers are connected, in contrast to [SignalEmitv].
 func SignalEmitValist(InstanceVar *TypeInstance, SignalIdVar uint, DetailVar glib.Quark, VarArgsVar []interface{}) {
 
 	xSignalEmitValist(InstanceVar, SignalIdVar, DetailVar, VarArgsVar)
@@ -421,8 +591,8 @@ var xSignalEmitv func([]Value, uint, glib.Quark, *Value)
 // Emits a signal. Signal emission is done synchronously.
 // The method will only return control after all handlers are called or signal emission was stopped.
 //
-// Note that g_signal_emitv() doesn't change @return_value if no handlers are
-// connected, in contrast to g_signal_emit() and g_signal_emit_valist().
+// Note that [SignalEmitv] doesn't change return_value if no handlers are
+// connected, in contrast to [SignalEmit] and [SignalEmitValist].
 func SignalEmitv(InstanceAndParamsVar []Value, SignalIdVar uint, DetailVar glib.Quark, ReturnValueVar *Value) {
 
 	xSignalEmitv(InstanceAndParamsVar, SignalIdVar, DetailVar, ReturnValueVar)
@@ -438,7 +608,7 @@ func SignalGetInvocationHint(InstanceVar *Object) *SignalInvocationHint {
 	return cret
 }
 
-var xSignalHandlerBlock func(uintptr, uint)
+var xSignalHandlerBlock func(uintptr, types.ULong)
 
 // Blocks a handler of an instance so it will not be called during any
 // signal emissions unless it is unblocked again. Thus "blocking" a
@@ -446,53 +616,53 @@ var xSignalHandlerBlock func(uintptr, uint)
 // has to be unblocked exactly the same amount of times it has been
 // blocked before to become active again.
 //
-// The @handler_id has to be a valid signal handler id, connected to a
-// signal of @instance.
-func SignalHandlerBlock(InstanceVar *Object, HandlerIdVar uint) {
+// The handler_id has to be a valid signal handler id, connected to a
+// signal of instance.
+func SignalHandlerBlock(InstanceVar *Object, HandlerIdVar types.ULong) {
 
 	xSignalHandlerBlock(InstanceVar.GoPointer(), HandlerIdVar)
 
 }
 
-var xSignalHandlerDisconnect func(uintptr, uint)
+var xSignalHandlerDisconnect func(uintptr, types.ULong)
 
 // Disconnects a handler from an instance so it will not be called during
 // any future or currently ongoing emissions of the signal it has been
-// connected to. The @handler_id becomes invalid and may be reused.
+// connected to. The handler_id becomes invalid and may be reused.
 //
-// The @handler_id has to be a valid signal handler id, connected to a
-// signal of @instance.
-func SignalHandlerDisconnect(InstanceVar *Object, HandlerIdVar uint) {
+// The handler_id has to be a valid signal handler id, connected to a
+// signal of instance.
+func SignalHandlerDisconnect(InstanceVar *Object, HandlerIdVar types.ULong) {
 
 	xSignalHandlerDisconnect(InstanceVar.GoPointer(), HandlerIdVar)
 
 }
 
-var xSignalHandlerFind func(uintptr, SignalMatchType, uint, glib.Quark, *Closure, uintptr, uintptr) uint
+var xSignalHandlerFind func(uintptr, SignalMatchType, uint, glib.Quark, *Closure, uintptr, uintptr) types.ULong
 
 // Finds the first signal handler that matches certain selection criteria.
-// The criteria mask is passed as an OR-ed combination of #GSignalMatchType
+// The criteria mask is passed as an OR-ed combination of [SignalMatchType]
 // flags, and the criteria values are passed as arguments.
-// The match @mask has to be non-0 for successful matches.
+// The match mask has to be non-0 for successful matches.
 // If no handler was found, 0 is returned.
-func SignalHandlerFind(InstanceVar *Object, MaskVar SignalMatchType, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, FuncVar uintptr, DataVar uintptr) uint {
+func SignalHandlerFind(InstanceVar *Object, MaskVar SignalMatchType, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, FuncVar uintptr, DataVar uintptr) types.ULong {
 
 	cret := xSignalHandlerFind(InstanceVar.GoPointer(), MaskVar, SignalIdVar, DetailVar, ClosureVar, FuncVar, DataVar)
 	return cret
 }
 
-var xSignalHandlerIsConnected func(uintptr, uint) bool
+var xSignalHandlerIsConnected func(uintptr, types.ULong) bool
 
-// Returns whether @handler_id is the ID of a handler connected to @instance.
-func SignalHandlerIsConnected(InstanceVar *Object, HandlerIdVar uint) bool {
+// Returns whether handler_id is the ID of a handler connected to instance.
+func SignalHandlerIsConnected(InstanceVar *Object, HandlerIdVar types.ULong) bool {
 
 	cret := xSignalHandlerIsConnected(InstanceVar.GoPointer(), HandlerIdVar)
 	return cret
 }
 
-var xSignalHandlerUnblock func(uintptr, uint)
+var xSignalHandlerUnblock func(uintptr, types.ULong)
 
-// Undoes the effect of a previous g_signal_handler_block() call.  A
+// Undoes the effect of a previous [SignalHandlerBlock] call.  A
 // blocked handler is skipped during signal emissions and will not be
 // invoked, unblocking it (for exactly the amount of times it has been
 // blocked before) reverts its "blocked" state, so the handler will be
@@ -503,9 +673,9 @@ var xSignalHandlerUnblock func(uintptr, uint)
 // currently ongoing emission depends on how far that emission has
 // proceeded yet).
 //
-// The @handler_id has to be a valid id of a signal handler that is
-// connected to a signal of @instance and is currently blocked.
-func SignalHandlerUnblock(InstanceVar *Object, HandlerIdVar uint) {
+// The handler_id has to be a valid id of a signal handler that is
+// connected to a signal of instance and is currently blocked.
+func SignalHandlerUnblock(InstanceVar *Object, HandlerIdVar types.ULong) {
 
 	xSignalHandlerUnblock(InstanceVar.GoPointer(), HandlerIdVar)
 
@@ -515,17 +685,17 @@ var xSignalHandlersBlockMatched func(uintptr, SignalMatchType, uint, glib.Quark,
 
 // Blocks all handlers on an instance that match a certain selection criteria.
 //
-// The criteria mask is passed as a combination of #GSignalMatchType flags, and
+// The criteria mask is passed as a combination of [SignalMatchType] flags, and
 // the criteria values are passed as arguments. A handler must match on all
-// flags set in @mask to be blocked (i.e. the match is conjunctive).
+// flags set in mask to be blocked (i.e. the match is conjunctive).
 //
-// Passing at least one of the %G_SIGNAL_MATCH_ID, %G_SIGNAL_MATCH_CLOSURE,
-// %G_SIGNAL_MATCH_FUNC
-// or %G_SIGNAL_MATCH_DATA match flags is required for successful matches.
+// Passing at least one of the [GSignalMatchIdValue], [GSignalMatchClosureValue],
+// [GSignalMatchFuncValue]
+// or [GSignalMatchDataValue] match flags is required for successful matches.
 // If no handlers were found, 0 is returned, the number of blocked handlers
 // otherwise.
 //
-// Support for %G_SIGNAL_MATCH_ID was added in GLib 2.78.
+// Support for [GSignalMatchIdValue] was added in GLib 2.78.
 func SignalHandlersBlockMatched(InstanceVar *Object, MaskVar SignalMatchType, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, FuncVar uintptr, DataVar uintptr) uint {
 
 	cret := xSignalHandlersBlockMatched(InstanceVar.GoPointer(), MaskVar, SignalIdVar, DetailVar, ClosureVar, FuncVar, DataVar)
@@ -535,7 +705,7 @@ func SignalHandlersBlockMatched(InstanceVar *Object, MaskVar SignalMatchType, Si
 var xSignalHandlersDestroy func(uintptr)
 
 // Destroy all signal handlers of a type instance. This function is
-// an implementation detail of the #GObject dispose implementation,
+// an implementation detail of the [Object] dispose implementation,
 // and should not be used outside of the type system.
 func SignalHandlersDestroy(InstanceVar *Object) {
 
@@ -548,17 +718,17 @@ var xSignalHandlersDisconnectMatched func(uintptr, SignalMatchType, uint, glib.Q
 // Disconnects all handlers on an instance that match a certain
 // selection criteria.
 //
-// The criteria mask is passed as a combination of #GSignalMatchType flags, and
+// The criteria mask is passed as a combination of [SignalMatchType] flags, and
 // the criteria values are passed as arguments. A handler must match on all
-// flags set in @mask to be disconnected (i.e. the match is conjunctive).
+// flags set in mask to be disconnected (i.e. the match is conjunctive).
 //
-// Passing at least one of the %G_SIGNAL_MATCH_ID, %G_SIGNAL_MATCH_CLOSURE,
-// %G_SIGNAL_MATCH_FUNC or
-// %G_SIGNAL_MATCH_DATA match flags is required for successful
+// Passing at least one of the [GSignalMatchIdValue], [GSignalMatchClosureValue],
+// [GSignalMatchFuncValue] or
+// [GSignalMatchDataValue] match flags is required for successful
 // matches.  If no handlers were found, 0 is returned, the number of
 // disconnected handlers otherwise.
 //
-// Support for %G_SIGNAL_MATCH_ID was added in GLib 2.78.
+// Support for [GSignalMatchIdValue] was added in GLib 2.78.
 func SignalHandlersDisconnectMatched(InstanceVar *Object, MaskVar SignalMatchType, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, FuncVar uintptr, DataVar uintptr) uint {
 
 	cret := xSignalHandlersDisconnectMatched(InstanceVar.GoPointer(), MaskVar, SignalIdVar, DetailVar, ClosureVar, FuncVar, DataVar)
@@ -570,18 +740,18 @@ var xSignalHandlersUnblockMatched func(uintptr, SignalMatchType, uint, glib.Quar
 // Unblocks all handlers on an instance that match a certain selection
 // criteria.
 //
-// The criteria mask is passed as a combination of #GSignalMatchType flags, and
+// The criteria mask is passed as a combination of [SignalMatchType] flags, and
 // the criteria values are passed as arguments. A handler must match on all
-// flags set in @mask to be unblocked (i.e. the match is conjunctive).
+// flags set in mask to be unblocked (i.e. the match is conjunctive).
 //
-// Passing at least one of the %G_SIGNAL_MATCH_ID, %G_SIGNAL_MATCH_CLOSURE,
-// %G_SIGNAL_MATCH_FUNC
-// or %G_SIGNAL_MATCH_DATA match flags is required for successful matches.
+// Passing at least one of the [GSignalMatchIdValue], [GSignalMatchClosureValue],
+// [GSignalMatchFuncValue]
+// or [GSignalMatchDataValue] match flags is required for successful matches.
 // If no handlers were found, 0 is returned, the number of unblocked handlers
 // otherwise. The match criteria should not apply to any handlers that are
 // not currently blocked.
 //
-// Support for %G_SIGNAL_MATCH_ID was added in GLib 2.78.
+// Support for [GSignalMatchIdValue] was added in GLib 2.78.
 func SignalHandlersUnblockMatched(InstanceVar *Object, MaskVar SignalMatchType, SignalIdVar uint, DetailVar glib.Quark, ClosureVar *Closure, FuncVar uintptr, DataVar uintptr) uint {
 
 	cret := xSignalHandlersUnblockMatched(InstanceVar.GoPointer(), MaskVar, SignalIdVar, DetailVar, ClosureVar, FuncVar, DataVar)
@@ -590,13 +760,13 @@ func SignalHandlersUnblockMatched(InstanceVar *Object, MaskVar SignalMatchType,
 
 var xSignalHasHandlerPending func(uintptr, uint, glib.Quark, bool) bool
 
-// Returns whether there are any handlers connected to @instance for the
+// Returns whether there are any handlers connected to instance for the
 // given signal id and detail.
 //
-// If @detail is 0 then it will only match handlers that were connected
-// without detail.  If @detail is non-zero then it will match handlers
+// If detail is 0 then it will only match handlers that were connected
+// without detail.  If detail is non-zero then it will match handlers
 // connected both without detail and with the given detail.  This is
-// consistent with how a signal emitted with @detail would be delivered
+// consistent with how a signal emitted with detail would be delivered
 // to those handlers.
 //
 // Since 2.46 this also checks for a non-default class closure being
@@ -617,7 +787,7 @@ var xSignalIsValidName func(string) bool
 // Validate a signal name. This can be useful for dynamically-generated signals
 // which need to be validated at run-time before actually trying to create them.
 //
-// See [func@GObject.signal_new] for details of the rules for valid names.
+// See [SignalNew] for details of the rules for valid names.
 // The rules for signal names are the same as those for property names.
 func SignalIsValidName(NameVar string) bool {
 
@@ -629,7 +799,7 @@ var xSignalListIds func(types.GType, *uint) uintptr
 
 // Lists the signals by id that a certain instance or interface type
 // created. Further information about the signals can be acquired through
-// g_signal_query().
+// [NewSignalQuery].
 func SignalListIds(ItypeVar types.GType, NIdsVar *uint) uintptr {
 
 	cret := xSignalListIds(ItypeVar, NIdsVar)
@@ -644,11 +814,11 @@ var xSignalLookup func(string, types.GType) uint
 //
 // Also tries the ancestors of the given type.
 //
-// The type class passed as @itype must already have been instantiated (for
+// The type class passed as itype must already have been instantiated (for
 // example, using g_type_class_ref()) for this function to work, as signals are
 // always installed during class initialization.
 //
-// See g_signal_new() for details on allowed signal names.
+// See [SignalNew] for details on allowed signal names.
 func SignalLookup(NameVar string, ItypeVar types.GType) uint {
 
 	cret := xSignalLookup(NameVar, ItypeVar)
@@ -674,23 +844,23 @@ var xSignalNew func(string, types.GType, SignalFlags, uint, uintptr, uintptr, ui
 // digits, separated by either the `-` or `_` character. The first
 // character of a signal name must be a letter. Names which violate these
 // rules lead to undefined behaviour. These are the same rules as for property
-// naming (see g_param_spec_internal()).
+// naming (see [ParamSpecInternal]).
 //
 // When registering a signal and looking up a signal, either separator can
 // be used, but they cannot be mixed. Using `-` is considerably more efficient.
 // Using `_` is discouraged.
 //
-// If 0 is used for @class_offset subclasses cannot override the class handler
-// in their class_init method by doing super_class-&gt;signal_handler = my_signal_handler.
-// Instead they will have to use g_signal_override_class_handler().
+// If 0 is used for class_offset subclasses cannot override the class handler
+// in their class_init method by doing super_class->signal_handler = my_signal_handler.
+// Instead they will have to use [SignalOverrideClassHandler].
 //
-// If @c_marshaller is %NULL, g_cclosure_marshal_generic() will be used as
-// the marshaller for this signal. In some simple cases, g_signal_new()
+// If c_marshaller is nil, [CClosureMarshalGeneric] will be used as
+// the marshaller for this signal. In some simple cases, [SignalNew]
 // will use a more optimized c_marshaller and va_marshaller for the signal
-// instead of g_cclosure_marshal_generic().
+// instead of [CClosureMarshalGeneric].
 //
-// If @c_marshaller is non-%NULL, you need to also specify a va_marshaller
-// using g_signal_set_va_marshaller() or the generic va_marshaller will
+// If c_marshaller is non-nil, you need to also specify a va_marshaller
+// using [SignalSetVaMarshaller] or the generic va_marshaller will
 // be used.
 func SignalNew(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar SignalFlags, ClassOffsetVar uint, AccumulatorVar *SignalAccumulator, AccuDataVar uintptr, CMarshallerVar *SignalCMarshaller, ReturnTypeVar types.GType, NParamsVar uint, varArgs ...interface{}) uint {
 
@@ -701,11 +871,12 @@ func SignalNew(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar Signal
 			AccumulatorVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SignalInvocationHint, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *AccumulatorVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			AccumulatorVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
+			AccumulatorVarRef = core.NewCallback(fcb)
+			AccumulatorVarRef = glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
 		}
 	}
 
@@ -717,19 +888,19 @@ var xSignalNewClassHandler func(string, types.GType, SignalFlags, uintptr, uintp
 
 // Creates a new signal. (This is usually done in the class initializer.)
 //
-// This is a variant of g_signal_new() that takes a C callback instead
+// This is a variant of [SignalNew] that takes a C callback instead
 // of a class offset for the signal's class handler. This function
 // doesn't need a function pointer exposed in the class structure of
 // an object definition, instead the function pointer is passed
 // directly and can be overridden by derived classes with
-// g_signal_override_class_closure() or
-// g_signal_override_class_handler() and chained to with
-// g_signal_chain_from_overridden() or
-// g_signal_chain_from_overridden_handler().
+// [SignalOverrideClassClosure] or
+// [SignalOverrideClassHandler] and chained to with
+// [SignalChainFromOverridden] or
+// [SignalChainFromOverriddenHandler].
 //
-// See g_signal_new() for information about signal names.
+// See [SignalNew] for information about signal names.
 //
-// If c_marshaller is %NULL, g_cclosure_marshal_generic() will be used as
+// If c_marshaller is nil, [CClosureMarshalGeneric] will be used as
 // the marshaller for this signal.
 func SignalNewClassHandler(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar SignalFlags, ClassHandlerVar *Callback, AccumulatorVar *SignalAccumulator, AccuDataVar uintptr, CMarshallerVar *SignalCMarshaller, ReturnTypeVar types.GType, NParamsVar uint, varArgs ...interface{}) uint {
 
@@ -740,11 +911,12 @@ func SignalNewClassHandler(SignalNameVar string, ItypeVar types.GType, SignalFla
 			ClassHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *ClassHandlerVar
 				cbFn()
 			}
-			ClassHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ClassHandlerVarPtr, ClassHandlerVarRef, ClassHandlerVar)
+			ClassHandlerVarRef = core.NewCallback(fcb)
+			ClassHandlerVarRef = glib.SaveCallbackWithClosure(ClassHandlerVarPtr, ClassHandlerVarRef, ClassHandlerVar)
 		}
 	}
 
@@ -755,11 +927,12 @@ func SignalNewClassHandler(SignalNameVar string, ItypeVar types.GType, SignalFla
 			AccumulatorVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SignalInvocationHint, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *AccumulatorVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			AccumulatorVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
+			AccumulatorVarRef = core.NewCallback(fcb)
+			AccumulatorVarRef = glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
 		}
 	}
 
@@ -771,9 +944,9 @@ var xSignalNewValist func(string, types.GType, SignalFlags, *Closure, uintptr, u
 
 // Creates a new signal. (This is usually done in the class initializer.)
 //
-// See g_signal_new() for details on allowed signal names.
+// See [SignalNew] for details on allowed signal names.
 //
-// If c_marshaller is %NULL, g_cclosure_marshal_generic() will be used as
+// If c_marshaller is nil, [CClosureMarshalGeneric] will be used as
 // the marshaller for this signal.
 func SignalNewValist(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar SignalFlags, ClassClosureVar *Closure, AccumulatorVar *SignalAccumulator, AccuDataVar uintptr, CMarshallerVar *SignalCMarshaller, ReturnTypeVar types.GType, NParamsVar uint, ArgsVar []interface{}) uint {
 
@@ -784,11 +957,12 @@ func SignalNewValist(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar
 			AccumulatorVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SignalInvocationHint, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *AccumulatorVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			AccumulatorVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
+			AccumulatorVarRef = core.NewCallback(fcb)
+			AccumulatorVarRef = glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
 		}
 	}
 
@@ -800,9 +974,9 @@ var xSignalNewv func(string, types.GType, SignalFlags, *Closure, uintptr, uintpt
 
 // Creates a new signal. (This is usually done in the class initializer.)
 //
-// See g_signal_new() for details on allowed signal names.
+// See [SignalNew] for details on allowed signal names.
 //
-// If c_marshaller is %NULL, g_cclosure_marshal_generic() will be used as
+// If c_marshaller is nil, [CClosureMarshalGeneric] will be used as
 // the marshaller for this signal.
 func SignalNewv(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar SignalFlags, ClassClosureVar *Closure, AccumulatorVar *SignalAccumulator, AccuDataVar uintptr, CMarshallerVar *SignalCMarshaller, ReturnTypeVar types.GType, NParamsVar uint, ParamTypesVar []types.GType) uint {
 
@@ -813,11 +987,12 @@ func SignalNewv(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar Signa
 			AccumulatorVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *SignalInvocationHint, arg1 *Value, arg2 *Value, arg3 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *AccumulatorVar
 				return cbFn(arg0, arg1, arg2, arg3)
 			}
-			AccumulatorVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
+			AccumulatorVarRef = core.NewCallback(fcb)
+			AccumulatorVarRef = glib.SaveCallbackWithClosure(AccumulatorVarPtr, AccumulatorVarRef, AccumulatorVar)
 		}
 	}
 
@@ -828,11 +1003,11 @@ func SignalNewv(SignalNameVar string, ItypeVar types.GType, SignalFlagsVar Signa
 var xSignalOverrideClassClosure func(uint, types.GType, *Closure)
 
 // Overrides the class closure (i.e. the default handler) for the given signal
-// for emissions on instances of @instance_type. @instance_type must be derived
+// for emissions on instances of instance_type. instance_type must be derived
 // from the type to which the signal belongs.
 //
-// See g_signal_chain_from_overridden() and
-// g_signal_chain_from_overridden_handler() for how to chain up to the
+// See [SignalChainFromOverridden] and
+// [SignalChainFromOverriddenHandler] for how to chain up to the
 // parent class closure from inside the overridden one.
 func SignalOverrideClassClosure(SignalIdVar uint, InstanceTypeVar types.GType, ClassClosureVar *Closure) {
 
@@ -843,12 +1018,12 @@ func SignalOverrideClassClosure(SignalIdVar uint, InstanceTypeVar types.GType, C
 var xSignalOverrideClassHandler func(string, types.GType, uintptr)
 
 // Overrides the class closure (i.e. the default handler) for the
-// given signal for emissions on instances of @instance_type with
-// callback @class_handler. @instance_type must be derived from the
+// given signal for emissions on instances of instance_type with
+// callback class_handler. instance_type must be derived from the
 // type to which the signal belongs.
 //
-// See g_signal_chain_from_overridden() and
-// g_signal_chain_from_overridden_handler() for how to chain up to the
+// See [SignalChainFromOverridden] and
+// [SignalChainFromOverriddenHandler] for how to chain up to the
 // parent class closure from inside the overridden one.
 func SignalOverrideClassHandler(SignalNameVar string, InstanceTypeVar types.GType, ClassHandlerVar *Callback) {
 
@@ -859,11 +1034,12 @@ func SignalOverrideClassHandler(SignalNameVar string, InstanceTypeVar types.GTyp
 			ClassHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *ClassHandlerVar
 				cbFn()
 			}
-			ClassHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ClassHandlerVarPtr, ClassHandlerVarRef, ClassHandlerVar)
+			ClassHandlerVarRef = core.NewCallback(fcb)
+			ClassHandlerVarRef = glib.SaveCallbackWithClosure(ClassHandlerVarPtr, ClassHandlerVarRef, ClassHandlerVar)
 		}
 	}
 
@@ -873,8 +1049,8 @@ func SignalOverrideClassHandler(SignalNameVar string, InstanceTypeVar types.GTyp
 
 var xSignalParseName func(string, types.GType, *uint, *glib.Quark, bool) bool
 
-// Internal function to parse a signal name into its @signal_id
-// and @detail quark.
+// Internal function to parse a signal name into its signal_id
+// and detail quark.
 func SignalParseName(DetailedSignalVar string, ItypeVar types.GType, SignalIdPVar *uint, DetailPVar *glib.Quark, ForceDetailQuarkVar bool) bool {
 
 	cret := xSignalParseName(DetailedSignalVar, ItypeVar, SignalIdPVar, DetailPVar, ForceDetailQuarkVar)
@@ -886,8 +1062,8 @@ var xNewSignalQuery func(uint, *SignalQuery)
 // Queries the signal system for in-depth information about a
 // specific signal. This function will fill in a user-provided
 // structure to hold signal-specific information. If an invalid
-// signal id is passed in, the @signal_id member of the #GSignalQuery
-// is 0. All members filled into the #GSignalQuery structure should
+// signal id is passed in, the signal_id member of the [SignalQuery]
+// is 0. All members filled into the [SignalQuery] structure should
 // be considered constant and have to be left untouched.
 func NewSignalQuery(SignalIdVar uint, QueryVar *SignalQuery) {
 
@@ -895,10 +1071,10 @@ func NewSignalQuery(SignalIdVar uint, QueryVar *SignalQuery) {
 
 }
 
-var xSignalRemoveEmissionHook func(uint, uint)
+var xSignalRemoveEmissionHook func(uint, types.ULong)
 
 // Deletes an emission hook.
-func SignalRemoveEmissionHook(SignalIdVar uint, HookIdVar uint) {
+func SignalRemoveEmissionHook(SignalIdVar uint, HookIdVar types.ULong) {
 
 	xSignalRemoveEmissionHook(SignalIdVar, HookIdVar)
 
@@ -906,10 +1082,10 @@ func SignalRemoveEmissionHook(SignalIdVar uint, HookIdVar uint) {
 
 var xSignalSetVaMarshaller func(uint, types.GType, uintptr)
 
-// Change the #GSignalCVaMarshaller used for a given signal.  This is a
+// Change the [SignalCVaMarshaller] used for a given signal.  This is a
 // specialised form of the marshaller that can often be used for the
 // common case of a single connected signal handler and avoids the
-// overhead of #GValue.  Its use is optional.
+// overhead of [Value].  Its use is optional.
 func SignalSetVaMarshaller(SignalIdVar uint, InstanceTypeVar types.GType, VaMarshallerVar *SignalCVaMarshaller) {
 
 	xSignalSetVaMarshaller(SignalIdVar, InstanceTypeVar, glib.NewCallback(VaMarshallerVar))
@@ -921,7 +1097,7 @@ var xSignalStopEmission func(uintptr, uint, glib.Quark)
 // Stops a signal's current emission.
 //
 // This will prevent the default method from running, if the signal was
-// %G_SIGNAL_RUN_LAST and you connected normally (i.e. without the "after"
+// [GSignalRunLastValue] and you connected normally (i.e. without the "after"
 // flag).
 //
 // Prints a warning if used on a signal which isn't being emitted.
@@ -935,7 +1111,7 @@ var xSignalStopEmissionByName func(uintptr, string)
 
 // Stops a signal's current emission.
 //
-// This is just like g_signal_stop_emission() except it will look up the
+// This is just like [SignalStopEmission] except it will look up the
 // signal id for you.
 func SignalStopEmissionByName(InstanceVar *Object, DetailedSignalVar string) {
 
@@ -948,52 +1124,52 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xClearSignalHandler, libs, "g_clear_signal_handler")
-	core.PuregoSafeRegister(&xSignalAccumulatorFirstWins, libs, "g_signal_accumulator_first_wins")
-	core.PuregoSafeRegister(&xSignalAccumulatorTrueHandled, libs, "g_signal_accumulator_true_handled")
-	core.PuregoSafeRegister(&xSignalAddEmissionHook, libs, "g_signal_add_emission_hook")
-	core.PuregoSafeRegister(&xSignalChainFromOverridden, libs, "g_signal_chain_from_overridden")
-	core.PuregoSafeRegister(&xSignalChainFromOverriddenHandler, libs, "g_signal_chain_from_overridden_handler")
-	core.PuregoSafeRegister(&xSignalConnectClosure, libs, "g_signal_connect_closure")
-	core.PuregoSafeRegister(&xSignalConnectClosureById, libs, "g_signal_connect_closure_by_id")
-	core.PuregoSafeRegister(&xSignalConnectData, libs, "g_signal_connect_data")
-	core.PuregoSafeRegister(&xSignalEmit, libs, "g_signal_emit")
-	core.PuregoSafeRegister(&xSignalEmitByName, libs, "g_signal_emit_by_name")
-	core.PuregoSafeRegister(&xSignalEmitValist, libs, "g_signal_emit_valist")
-	core.PuregoSafeRegister(&xSignalEmitv, libs, "g_signal_emitv")
-	core.PuregoSafeRegister(&xSignalGetInvocationHint, libs, "g_signal_get_invocation_hint")
-	core.PuregoSafeRegister(&xSignalHandlerBlock, libs, "g_signal_handler_block")
-	core.PuregoSafeRegister(&xSignalHandlerDisconnect, libs, "g_signal_handler_disconnect")
-	core.PuregoSafeRegister(&xSignalHandlerFind, libs, "g_signal_handler_find")
-	core.PuregoSafeRegister(&xSignalHandlerIsConnected, libs, "g_signal_handler_is_connected")
-	core.PuregoSafeRegister(&xSignalHandlerUnblock, libs, "g_signal_handler_unblock")
-	core.PuregoSafeRegister(&xSignalHandlersBlockMatched, libs, "g_signal_handlers_block_matched")
-	core.PuregoSafeRegister(&xSignalHandlersDestroy, libs, "g_signal_handlers_destroy")
-	core.PuregoSafeRegister(&xSignalHandlersDisconnectMatched, libs, "g_signal_handlers_disconnect_matched")
-	core.PuregoSafeRegister(&xSignalHandlersUnblockMatched, libs, "g_signal_handlers_unblock_matched")
-	core.PuregoSafeRegister(&xSignalHasHandlerPending, libs, "g_signal_has_handler_pending")
-	core.PuregoSafeRegister(&xSignalIsValidName, libs, "g_signal_is_valid_name")
-	core.PuregoSafeRegister(&xSignalListIds, libs, "g_signal_list_ids")
-	core.PuregoSafeRegister(&xSignalLookup, libs, "g_signal_lookup")
-	core.PuregoSafeRegister(&xSignalName, libs, "g_signal_name")
-	core.PuregoSafeRegister(&xSignalNew, libs, "g_signal_new")
-	core.PuregoSafeRegister(&xSignalNewClassHandler, libs, "g_signal_new_class_handler")
-	core.PuregoSafeRegister(&xSignalNewValist, libs, "g_signal_new_valist")
-	core.PuregoSafeRegister(&xSignalNewv, libs, "g_signal_newv")
-	core.PuregoSafeRegister(&xSignalOverrideClassClosure, libs, "g_signal_override_class_closure")
-	core.PuregoSafeRegister(&xSignalOverrideClassHandler, libs, "g_signal_override_class_handler")
-	core.PuregoSafeRegister(&xSignalParseName, libs, "g_signal_parse_name")
-	core.PuregoSafeRegister(&xNewSignalQuery, libs, "g_signal_query")
-	core.PuregoSafeRegister(&xSignalRemoveEmissionHook, libs, "g_signal_remove_emission_hook")
-	core.PuregoSafeRegister(&xSignalSetVaMarshaller, libs, "g_signal_set_va_marshaller")
-	core.PuregoSafeRegister(&xSignalStopEmission, libs, "g_signal_stop_emission")
-	core.PuregoSafeRegister(&xSignalStopEmissionByName, libs, "g_signal_stop_emission_by_name")
+	core.PuregoRegisterSince(&xClearSignalHandler, libs, "g_clear_signal_handler", "GOBJECT", "2.62")
+	core.PuregoRegisterSince(&xSignalAccumulatorFirstWins, libs, "g_signal_accumulator_first_wins", "GOBJECT", "2.28")
+	core.PuregoRegisterSince(&xSignalAccumulatorTrueHandled, libs, "g_signal_accumulator_true_handled", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xSignalAddEmissionHook, libs, "g_signal_add_emission_hook", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalChainFromOverridden, libs, "g_signal_chain_from_overridden", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalChainFromOverriddenHandler, libs, "g_signal_chain_from_overridden_handler", "GOBJECT", "2.18")
+	core.PuregoRegisterSince(&xSignalConnectClosure, libs, "g_signal_connect_closure", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalConnectClosureById, libs, "g_signal_connect_closure_by_id", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalConnectData, libs, "g_signal_connect_data", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalEmit, libs, "g_signal_emit", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalEmitByName, libs, "g_signal_emit_by_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalEmitValist, libs, "g_signal_emit_valist", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalEmitv, libs, "g_signal_emitv", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalGetInvocationHint, libs, "g_signal_get_invocation_hint", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlerBlock, libs, "g_signal_handler_block", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlerDisconnect, libs, "g_signal_handler_disconnect", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlerFind, libs, "g_signal_handler_find", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlerIsConnected, libs, "g_signal_handler_is_connected", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlerUnblock, libs, "g_signal_handler_unblock", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlersBlockMatched, libs, "g_signal_handlers_block_matched", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlersDestroy, libs, "g_signal_handlers_destroy", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlersDisconnectMatched, libs, "g_signal_handlers_disconnect_matched", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHandlersUnblockMatched, libs, "g_signal_handlers_unblock_matched", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalHasHandlerPending, libs, "g_signal_has_handler_pending", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalIsValidName, libs, "g_signal_is_valid_name", "GOBJECT", "2.66")
+	core.PuregoRegisterSince(&xSignalListIds, libs, "g_signal_list_ids", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalLookup, libs, "g_signal_lookup", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalName, libs, "g_signal_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalNew, libs, "g_signal_new", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalNewClassHandler, libs, "g_signal_new_class_handler", "GOBJECT", "2.18")
+	core.PuregoRegisterSince(&xSignalNewValist, libs, "g_signal_new_valist", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalNewv, libs, "g_signal_newv", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalOverrideClassClosure, libs, "g_signal_override_class_closure", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalOverrideClassHandler, libs, "g_signal_override_class_handler", "GOBJECT", "2.18")
+	core.PuregoRegisterSince(&xSignalParseName, libs, "g_signal_parse_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewSignalQuery, libs, "g_signal_query", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalRemoveEmissionHook, libs, "g_signal_remove_emission_hook", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalSetVaMarshaller, libs, "g_signal_set_va_marshaller", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xSignalStopEmission, libs, "g_signal_stop_emission", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSignalStopEmissionByName, libs, "g_signal_stop_emission_by_name", "GOBJECT", "")
 
 }
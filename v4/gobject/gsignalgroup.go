@@ -4,7 +4,6 @@ package gobject
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -47,7 +46,7 @@ func SignalGroupNewFromInternalPtr(ptr uintptr) *SignalGroup {
 
 var xNewSignalGroup func(types.GType) uintptr
 
-// Creates a new #GSignalGroup for target instances of @target_type.
+// Creates a new [SignalGroup] for target instances of target_type.
 func NewSignalGroup(TargetTypeVar types.GType) *SignalGroup {
 	var cls *SignalGroup
 
@@ -63,7 +62,7 @@ func NewSignalGroup(TargetTypeVar types.GType) *SignalGroup {
 
 var xSignalGroupBlock func(uintptr)
 
-// Blocks all signal handlers managed by @self so they will not
+// Blocks all signal handlers managed by self so they will not
 // be called during any signal emissions. Must be unblocked exactly
 // the same number of times it has been blocked to become active again.
 //
@@ -76,10 +75,10 @@ func (x *SignalGroup) Block() {
 
 var xSignalGroupConnect func(uintptr, string, uintptr, uintptr)
 
-// Connects @c_handler to the signal @detailed_signal
-// on the target instance of @self.
+// Connects c_handler to the signal detailed_signal
+// on the target instance of self.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) Connect(DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr) {
 
 	var CHandlerVarRef uintptr
@@ -89,11 +88,12 @@ func (x *SignalGroup) Connect(DetailedSignalVar string, CHandlerVar *Callback, D
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -103,12 +103,12 @@ func (x *SignalGroup) Connect(DetailedSignalVar string, CHandlerVar *Callback, D
 
 var xSignalGroupConnectAfter func(uintptr, string, uintptr, uintptr)
 
-// Connects @c_handler to the signal @detailed_signal
-// on the target instance of @self.
+// Connects c_handler to the signal detailed_signal
+// on the target instance of self.
 //
-// The @c_handler will be called after the default handler of the signal.
+// The c_handler will be called after the default handler of the signal.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) ConnectAfter(DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr) {
 
 	var CHandlerVarRef uintptr
@@ -118,11 +118,12 @@ func (x *SignalGroup) ConnectAfter(DetailedSignalVar string, CHandlerVar *Callba
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -132,9 +133,9 @@ func (x *SignalGroup) ConnectAfter(DetailedSignalVar string, CHandlerVar *Callba
 
 var xSignalGroupConnectClosure func(uintptr, string, *Closure, bool)
 
-// Connects @closure to the signal @detailed_signal on #GSignalGroup:target.
+// Connects closure to the signal detailed_signal on GSignalGroup:target.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) ConnectClosure(DetailedSignalVar string, ClosureVar *Closure, AfterVar bool) {
 
 	xSignalGroupConnectClosure(x.GoPointer(), DetailedSignalVar, ClosureVar, AfterVar)
@@ -143,10 +144,10 @@ func (x *SignalGroup) ConnectClosure(DetailedSignalVar string, ClosureVar *Closu
 
 var xSignalGroupConnectData func(uintptr, string, uintptr, uintptr, uintptr, ConnectFlags)
 
-// Connects @c_handler to the signal @detailed_signal
-// on the target instance of @self.
+// Connects c_handler to the signal detailed_signal
+// on the target instance of self.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) ConnectData(DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr, NotifyVar *ClosureNotify, FlagsVar ConnectFlags) {
 
 	var CHandlerVarRef uintptr
@@ -156,11 +157,12 @@ func (x *SignalGroup) ConnectData(DetailedSignalVar string, CHandlerVar *Callbac
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -171,11 +173,12 @@ func (x *SignalGroup) ConnectData(DetailedSignalVar string, CHandlerVar *Callbac
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *Closure) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0, arg1)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -185,13 +188,13 @@ func (x *SignalGroup) ConnectData(DetailedSignalVar string, CHandlerVar *Callbac
 
 var xSignalGroupConnectObject func(uintptr, string, uintptr, uintptr, ConnectFlags)
 
-// Connects @c_handler to the signal @detailed_signal on #GSignalGroup:target.
+// Connects c_handler to the signal detailed_signal on GSignalGroup:target.
 //
-// Ensures that the @object stays alive during the call to @c_handler
-// by temporarily adding a reference count. When the @object is destroyed
+// Ensures that the object stays alive during the call to c_handler
+// by temporarily adding a reference count. When the object is destroyed
 // the signal handler will automatically be removed.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) ConnectObject(DetailedSignalVar string, CHandlerVar *Callback, ObjectVar uintptr, FlagsVar ConnectFlags) {
 
 	var CHandlerVarRef uintptr
@@ -201,11 +204,12 @@ func (x *SignalGroup) ConnectObject(DetailedSignalVar string, CHandlerVar *Callb
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -215,13 +219,13 @@ func (x *SignalGroup) ConnectObject(DetailedSignalVar string, CHandlerVar *Callb
 
 var xSignalGroupConnectSwapped func(uintptr, string, uintptr, uintptr)
 
-// Connects @c_handler to the signal @detailed_signal
-// on the target instance of @self.
+// Connects c_handler to the signal detailed_signal
+// on the target instance of self.
 //
-// The instance on which the signal is emitted and @data
-// will be swapped when calling @c_handler.
+// The instance on which the signal is emitted and data
+// will be swapped when calling c_handler.
 //
-// You cannot connect a signal handler after #GSignalGroup:target has been set.
+// You cannot connect a signal handler after GSignalGroup:target has been set.
 func (x *SignalGroup) ConnectSwapped(DetailedSignalVar string, CHandlerVar *Callback, DataVar uintptr) {
 
 	var CHandlerVarRef uintptr
@@ -231,11 +235,12 @@ func (x *SignalGroup) ConnectSwapped(DetailedSignalVar string, CHandlerVar *Call
 			CHandlerVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CHandlerVar
 				cbFn()
 			}
-			CHandlerVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
+			CHandlerVarRef = core.NewCallback(fcb)
+			CHandlerVarRef = glib.SaveCallbackWithClosure(CHandlerVarPtr, CHandlerVarRef, CHandlerVar)
 		}
 	}
 
@@ -262,11 +267,11 @@ func (x *SignalGroup) DupTarget() *Object {
 var xSignalGroupSetTarget func(uintptr, uintptr)
 
 // Sets the target instance used when connecting signals. Any signal
-// that has been registered with g_signal_group_connect_object() or
+// that has been registered with [SignalGroup.ConnectObject] or
 // similar functions will be connected to this object.
 //
 // If the target instance was previously set, signals will be
-// disconnected from that object prior to connecting to @target.
+// disconnected from that object prior to connecting to target.
 func (x *SignalGroup) SetTarget(TargetVar *Object) {
 
 	xSignalGroupSetTarget(x.GoPointer(), TargetVar.GoPointer())
@@ -275,7 +280,7 @@ func (x *SignalGroup) SetTarget(TargetVar *Object) {
 
 var xSignalGroupUnblock func(uintptr)
 
-// Unblocks all signal handlers managed by @self so they will be
+// Unblocks all signal handlers managed by self so they will be
 // called again during any signal emissions unless it is blocked
 // again. Must be unblocked exactly the same number of times it
 // has been blocked to become active again.
@@ -296,59 +301,103 @@ func (c *SignalGroup) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// This signal is emitted when #GSignalGroup:target is set to a new value
-// other than %NULL. It is similar to #GObject::notify on `target` except it
-// will not emit when #GSignalGroup:target is %NULL and also allows for
-// receiving the #GObject without a data-race.
+// This signal is emitted when GSignalGroup:target is set to a new value
+// other than nil. It is similar to [Object.ConnectNotifyFunc] on `target` except it
+// will not emit when GSignalGroup:target is nil and also allows for
+// receiving the [Object] without a data-race.
+//
+// Deprecated: use ConnectBindFunc, which also accepts method values and closures.
 func (x *SignalGroup) ConnectBind(cb *func(SignalGroup, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := SignalConnect(x.GoPointer(), "bind", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, InstanceVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalGroup{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, InstanceVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return SignalConnectCallback(x.GoPointer(), "bind", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, InstanceVarp uintptr) {
+var xSignalGroupBindTrampoline uintptr
+
+func xSignalGroupBindNewTrampoline() interface{} {
+	return func(clsPtr uintptr, InstanceVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := SignalFunc(data).(func(SignalGroup, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalGroup{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, InstanceVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := SignalConnect(x.GoPointer(), "bind", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// This signal is emitted when the target instance of @self is set to a
-// new #GObject.
+// ConnectBindFunc connects cb to the "bind" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalGroup) ConnectBindFunc(cb func(SignalGroup, uintptr)) uint {
+	return SignalConnectFunc(x.GoPointer(), "bind", &xSignalGroupBindTrampoline, xSignalGroupBindNewTrampoline, cb)
+}
+
+// This signal is emitted when the target instance of self is set to a
+// new [Object].
 //
-// This signal will only be emitted if the previous target of @self is
-// non-%NULL.
+// This signal will only be emitted if the previous target of self is
+// non-nil.
+//
+// Deprecated: use ConnectUnbindFunc, which also accepts method values and closures.
 func (x *SignalGroup) ConnectUnbind(cb *func(SignalGroup)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := SignalConnect(x.GoPointer(), "unbind", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalGroup{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return SignalConnectCallback(x.GoPointer(), "unbind", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSignalGroupUnbindTrampoline uintptr
+
+func xSignalGroupUnbindNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := SignalFunc(data).(func(SignalGroup))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalGroup{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := SignalConnect(x.GoPointer(), "unbind", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnbindFunc connects cb to the "unbind" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalGroup) ConnectUnbindFunc(cb func(SignalGroup)) uint {
+	return SignalConnectFunc(x.GoPointer(), "unbind", &xSignalGroupUnbindTrampoline, xSignalGroupUnbindNewTrampoline, cb)
 }
 
 func init() {
@@ -356,7 +405,7 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -365,17 +414,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xSignalGroupGLibType, libs, "g_signal_group_get_type")
 
-	core.PuregoSafeRegister(&xNewSignalGroup, libs, "g_signal_group_new")
-
-	core.PuregoSafeRegister(&xSignalGroupBlock, libs, "g_signal_group_block")
-	core.PuregoSafeRegister(&xSignalGroupConnect, libs, "g_signal_group_connect")
-	core.PuregoSafeRegister(&xSignalGroupConnectAfter, libs, "g_signal_group_connect_after")
-	core.PuregoSafeRegister(&xSignalGroupConnectClosure, libs, "g_signal_group_connect_closure")
-	core.PuregoSafeRegister(&xSignalGroupConnectData, libs, "g_signal_group_connect_data")
-	core.PuregoSafeRegister(&xSignalGroupConnectObject, libs, "g_signal_group_connect_object")
-	core.PuregoSafeRegister(&xSignalGroupConnectSwapped, libs, "g_signal_group_connect_swapped")
-	core.PuregoSafeRegister(&xSignalGroupDupTarget, libs, "g_signal_group_dup_target")
-	core.PuregoSafeRegister(&xSignalGroupSetTarget, libs, "g_signal_group_set_target")
-	core.PuregoSafeRegister(&xSignalGroupUnblock, libs, "g_signal_group_unblock")
+	core.PuregoRegisterSince(&xNewSignalGroup, libs, "g_signal_group_new", "GOBJECT", "2.72")
+
+	core.PuregoRegisterSince(&xSignalGroupBlock, libs, "g_signal_group_block", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupConnect, libs, "g_signal_group_connect", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupConnectAfter, libs, "g_signal_group_connect_after", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupConnectClosure, libs, "g_signal_group_connect_closure", "GOBJECT", "2.74")
+	core.PuregoRegisterSince(&xSignalGroupConnectData, libs, "g_signal_group_connect_data", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupConnectObject, libs, "g_signal_group_connect_object", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupConnectSwapped, libs, "g_signal_group_connect_swapped", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupDupTarget, libs, "g_signal_group_dup_target", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupSetTarget, libs, "g_signal_group_set_target", "GOBJECT", "2.72")
+	core.PuregoRegisterSince(&xSignalGroupUnblock, libs, "g_signal_group_unblock", "GOBJECT", "2.72")
 
 }
@@ -2,17 +2,16 @@
 package gobject
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
 
 var xSourceSetClosure func(*glib.Source, *Closure)
 
-// Set the callback for a source as a #GClosure.
+// Set the callback for a source as a [Closure].
 //
-// If the source is not one of the standard GLib types, the @closure_callback
-// and @closure_marshal fields of the #GSourceFuncs structure must have been
+// If the source is not one of the standard GLib types, the closure_callback
+// and closure_marshal fields of the [glib.SourceFuncs] structure must have been
 // filled in with pointers to appropriate functions.
 func SourceSetClosure(SourceVar *glib.Source, ClosureVar *Closure) {
 
@@ -22,14 +21,14 @@ func SourceSetClosure(SourceVar *glib.Source, ClosureVar *Closure) {
 
 var xSourceSetDummyCallback func(*glib.Source)
 
-// Sets a dummy callback for @source. The callback will do nothing, and
-// if the source expects a #gboolean return value, it will return %TRUE.
+// Sets a dummy callback for source. The callback will do nothing, and
+// if the source expects a #gboolean return value, it will return true.
 // (If the source expects any other type of return value, it will return
-// a 0/%NULL value; whatever g_value_init() initializes a #GValue to for
+// a 0/nil value; whatever [Value.Init] initializes a [Value] to for
 // that type.)
 //
 // If the source is not one of the standard GLib types, the
-// @closure_callback and @closure_marshal fields of the #GSourceFuncs
+// closure_callback and closure_marshal fields of the [glib.SourceFuncs]
 // structure must have been filled in with pointers to appropriate
 // functions.
 func SourceSetDummyCallback(SourceVar *glib.Source) {
@@ -43,14 +42,14 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSourceSetClosure, libs, "g_source_set_closure")
-	core.PuregoSafeRegister(&xSourceSetDummyCallback, libs, "g_source_set_dummy_callback")
+	core.PuregoRegisterSince(&xSourceSetClosure, libs, "g_source_set_closure", "GOBJECT", "")
+	core.PuregoRegisterSince(&xSourceSetDummyCallback, libs, "g_source_set_dummy_callback", "GOBJECT", "")
 
 }
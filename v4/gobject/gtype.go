@@ -2,10 +2,11 @@
 package gobject
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -39,7 +40,7 @@ type BaseInitFunc func(*TypeClass)
 // This function is rarely needed, as dynamically allocated class resources
 // should be handled by GBaseInitFunc() and GBaseFinalizeFunc().
 //
-// Also, specification of a GClassFinalizeFunc() in the #GTypeInfo
+// Also, specification of a GClassFinalizeFunc() in the [TypeInfo]
 // structure of a static type is invalid, because classes of static types
 // will never be finalized (they are artificially kept alive when their
 // reference count drops to zero).
@@ -74,33 +75,25 @@ type ClassFinalizeFunc func(*TypeClass, uintptr)
 // An example may help to correspond the intend of the different class
 // initializers:
 //
-// |[&lt;!-- language="C" --&gt;
-//
 //	typedef struct {
 //	  GObjectClass parent_class;
 //	  gint         static_integer;
 //	  gchar       *dynamic_string;
 //	} TypeAClass;
-//
-// static void
-// type_a_base_class_init (TypeAClass *class)
-//
+//	static void
+//	type_a_base_class_init (TypeAClass *class)
 //	{
-//	  class-&gt;dynamic_string = g_strdup ("some string");
+//	  class->dynamic_string = g_strdup ("some string");
 //	}
-//
-// static void
-// type_a_base_class_finalize (TypeAClass *class)
-//
+//	static void
+//	type_a_base_class_finalize (TypeAClass *class)
 //	{
-//	  g_free (class-&gt;dynamic_string);
+//	  g_free (class->dynamic_string);
 //	}
-//
-// static void
-// type_a_class_init (TypeAClass *class)
-//
+//	static void
+//	type_a_class_init (TypeAClass *class)
 //	{
-//	  class-&gt;static_integer = 42;
+//	  class->static_integer = 42;
 //	}
 //
 //	typedef struct {
@@ -108,30 +101,22 @@ type ClassFinalizeFunc func(*TypeClass, uintptr)
 //	  gfloat       static_float;
 //	  GString     *dynamic_gstring;
 //	} TypeBClass;
-//
-// static void
-// type_b_base_class_init (TypeBClass *class)
-//
+//	static void
+//	type_b_base_class_init (TypeBClass *class)
 //	{
-//	  class-&gt;dynamic_gstring = g_string_new ("some other string");
+//	  class->dynamic_gstring = g_string_new ("some other string");
 //	}
-//
-// static void
-// type_b_base_class_finalize (TypeBClass *class)
-//
+//	static void
+//	type_b_base_class_finalize (TypeBClass *class)
 //	{
-//	  g_string_free (class-&gt;dynamic_gstring);
+//	  g_string_free (class->dynamic_gstring);
 //	}
-//
-// static void
-// type_b_class_init (TypeBClass *class)
-//
+//	static void
+//	type_b_class_init (TypeBClass *class)
 //	{
-//	  class-&gt;static_float = 3.14159265358979323846;
+//	  class->static_float = 3.14159265358979323846;
 //	}
 //
-// ]|
-//
 // Initialization of TypeBClass will first cause initialization of
 // TypeAClass (derived classes reference their parent classes, see
 // g_type_class_ref() on this).
@@ -169,7 +154,7 @@ type ClassInitFunc func(*TypeClass, uintptr)
 // is altered during its initialization to always point to the class that
 // belongs to the type the current initializer was introduced for.
 //
-// The extended members of @instance are guaranteed to have been filled with
+// The extended members of instance are guaranteed to have been filled with
 // zeros before this function is called.
 type InstanceInitFunc func(*TypeInstance, *TypeClass)
 
@@ -185,7 +170,7 @@ type InterfaceFinalizeFunc func(*TypeInterface, uintptr)
 // This function should initialize all internal data and* allocate any
 // resources required by the interface.
 //
-// The members of @iface_data are guaranteed to have been filled with
+// The members of iface_data are guaranteed to have been filled with
 // zeros before this function is called.
 type InterfaceInitFunc func(*TypeInterface, uintptr)
 
@@ -193,82 +178,70 @@ type InterfaceInitFunc func(*TypeInterface, uintptr)
 // drops to zero.
 //
 // It may use g_type_class_ref() to prevent the class from being freed. You
-// should not call g_type_class_unref() from a #GTypeClassCacheFunc function
-// to prevent infinite recursion, use g_type_class_unref_uncached() instead.
+// should not call [TypeClass.Unref] from a [TypeClassCacheFunc] function
+// to prevent infinite recursion, use [TypeClass.UnrefUncached] instead.
 //
 // The functions have to check the class id passed in to figure
 // whether they actually want to cache the class of this type, since all
-// classes are routed through the same #GTypeClassCacheFunc chain.
+// classes are routed through the same [TypeClassCacheFunc] chain.
 type TypeClassCacheFunc func(uintptr, *TypeClass) bool
 
 // A callback called after an interface vtable is initialized.
 //
-// See g_type_add_interface_check().
+// See [TypeAddInterfaceCheck].
 type TypeInterfaceCheckFunc func(uintptr, *TypeInterface)
 
 // This function is responsible for converting the values collected from
-// a variadic argument list into contents suitable for storage in a #GValue.
+// a variadic argument list into contents suitable for storage in a [Value].
 //
-// This function should setup @value similar to #GTypeValueInitFunc; e.g.
+// This function should setup value similar to [TypeValueInitFunc]; e.g.
 // for a string value that does not allow `NULL` pointers, it needs to either
 // emit an error, or do an implicit conversion by storing an empty string.
 //
-// The @value passed in to this function has a zero-filled data array, so
-// just like for #GTypeValueInitFunc it is guaranteed to not contain any old
+// The value passed in to this function has a zero-filled data array, so
+// just like for [TypeValueInitFunc] it is guaranteed to not contain any old
 // contents that might need freeing.
 //
-// The @n_collect_values argument is the string length of the `collect_format`
-// field of #GTypeValueTable, and `collect_values` is an array of #GTypeCValue
-// with length of @n_collect_values, containing the collected values according
+// The n_collect_values argument is the string length of the `collect_format`
+// field of [TypeValueTable], and `collect_values` is an array of [TypeCValue]
+// with length of n_collect_values, containing the collected values according
 // to `collect_format`.
 //
-// The @collect_flags argument provided as a hint by the caller. It may
-// contain the flag %G_VALUE_NOCOPY_CONTENTS indicating that the collected
-// value contents may be considered ‘static’ for the duration of the @value
-// lifetime. Thus an extra copy of the contents stored in @collect_values is
-// not required for assignment to @value.
+// The collect_flags argument provided as a hint by the caller. It may
+// contain the flag [VALUE_NOCOPY_CONTENTS] indicating that the collected
+// value contents may be considered ‘static’ for the duration of the value
+// lifetime. Thus an extra copy of the contents stored in collect_values is
+// not required for assignment to value.
 //
 // For our above string example, we continue with:
 //
-// |[&lt;!-- language="C" --&gt;
-// if (!collect_values[0].v_pointer)
-//
-//	value-&gt;data[0].v_pointer = g_strdup ("");
-//
-// else if (collect_flags &amp; G_VALUE_NOCOPY_CONTENTS)
-//
-//	{
-//	  value-&gt;data[0].v_pointer = collect_values[0].v_pointer;
-//	  // keep a flag for the value_free() implementation to not free this string
-//	  value-&gt;data[1].v_uint = G_VALUE_NOCOPY_CONTENTS;
-//	}
-//
-// else
-//
-//	value-&gt;data[0].v_pointer = g_strdup (collect_values[0].v_pointer);
-//
-// return NULL;
-// ]|
+//	if (!collect_values[0].v_pointer)
+//	  value->data[0].v_pointer = g_strdup ("");
+//	else if (collect_flags & G_VALUE_NOCOPY_CONTENTS)
+//	  {
+//	    value->data[0].v_pointer = collect_values[0].v_pointer;
+//	    // keep a flag for the value_free() implementation to not free this string
+//	    value->data[1].v_uint = G_VALUE_NOCOPY_CONTENTS;
+//	  }
+//	else
+//	  value->data[0].v_pointer = g_strdup (collect_values[0].v_pointer);
+//	return NULL;
 //
 // It should be noted, that it is generally a bad idea to follow the
-// %G_VALUE_NOCOPY_CONTENTS hint for reference counted types. Due to
+// [VALUE_NOCOPY_CONTENTS] hint for reference counted types. Due to
 // reentrancy requirements and reference count assertions performed
 // by the signal emission code, reference counts should always be
-// incremented for reference counted contents stored in the `value-&gt;data`
+// incremented for reference counted contents stored in the `value->data`
 // array. To deviate from our string example for a moment, and taking
 // a look at an exemplary implementation for `GTypeValueTable.collect_value()`
 // of `GObject`:
 //
-// |[&lt;!-- language="C" --&gt;
-// GObject *object = G_OBJECT (collect_values[0].v_pointer);
-// g_return_val_if_fail (object != NULL,
-//
-//	g_strdup_printf ("Object %p passed as invalid NULL pointer", object));
-//
-// // never honour G_VALUE_NOCOPY_CONTENTS for ref-counted types
-// value-&gt;data[0].v_pointer = g_object_ref (object);
-// return NULL;
-// ]|
+//	GObject *object = G_OBJECT (collect_values[0].v_pointer);
+//	g_return_val_if_fail (object != NULL,
+//	   g_strdup_printf ("Object %p passed as invalid NULL pointer", object));
+//	// never honour G_VALUE_NOCOPY_CONTENTS for ref-counted types
+//	value->data[0].v_pointer = g_object_ref (object);
+//	return NULL;
 //
 // The reference count for valid objects is always incremented, regardless
 // of `collect_flags`. For invalid objects, the example returns a newly
@@ -282,51 +255,43 @@ type TypeInterfaceCheckFunc func(uintptr, *TypeInterface)
 // valid upon error returns, `value` is simply thrown away without further
 // freeing. As such, it is a good idea to not allocate `GValue` contents
 // prior to returning an error; however, `collect_values()` is not obliged
-// to return a correctly setup @value for error returns, simply because
+// to return a correctly setup value for error returns, simply because
 // any non-`NULL` return is considered a fatal programming error, and
 // further program behaviour is undefined.
 type TypeValueCollectFunc func(*Value, uint, []TypeCValue, uint) string
 
-// Copies the content of a #GValue into another.
+// Copies the content of a [Value] into another.
 //
-// The @dest_value is a #GValue with zero-filled data section and @src_value
-// is a properly initialized #GValue of same type, or derived type.
+// The dest_value is a [Value] with zero-filled data section and src_value
+// is a properly initialized [Value] of same type, or derived type.
 //
-// The purpose of this function is to copy the contents of @src_value
-// into @dest_value in a way, that even after @src_value has been freed, the
-// contents of @dest_value remain valid. String type example:
+// The purpose of this function is to copy the contents of src_value
+// into dest_value in a way, that even after src_value has been freed, the
+// contents of dest_value remain valid. String type example:
 //
-// |[&lt;!-- language="C" --&gt;
-// dest_value-&gt;data[0].v_pointer = g_strdup (src_value-&gt;data[0].v_pointer);
-// ]|
+//	dest_value->data[0].v_pointer = g_strdup (src_value->data[0].v_pointer);
 type TypeValueCopyFunc func(*Value, *Value)
 
-// Frees any old contents that might be left in the `value-&gt;data` array of
+// Frees any old contents that might be left in the `value->data` array of
 // the given value.
 //
-// No resources may remain allocated through the #GValue contents after this
+// No resources may remain allocated through the [Value] contents after this
 // function returns. E.g. for our above string type:
 //
-// |[&lt;!-- language="C" --&gt;
-// // only free strings without a specific flag for static storage
-// if (!(value-&gt;data[1].v_uint &amp; G_VALUE_NOCOPY_CONTENTS))
-//
-//	g_free (value-&gt;data[0].v_pointer);
-//
-// ]|
+//	// only free strings without a specific flag for static storage
+//	if (!(value->data[1].v_uint & G_VALUE_NOCOPY_CONTENTS))
+//	  g_free (value->data[0].v_pointer);
 type TypeValueFreeFunc func(*Value)
 
-// Initializes the value contents by setting the fields of the `value-&gt;data`
+// Initializes the value contents by setting the fields of the `value->data`
 // array.
 //
-// The data array of the #GValue passed into this function was zero-filled
+// The data array of the [Value] passed into this function was zero-filled
 // with `memset()`, so no care has to be taken to free any old contents.
-// For example, in the case of a string value that may never be %NULL, the
+// For example, in the case of a string value that may never be nil, the
 // implementation might look like:
 //
-// |[&lt;!-- language="C" --&gt;
-// value-&gt;data[0].v_pointer = g_strdup ("");
-// ]|
+//	value->data[0].v_pointer = g_strdup ("");
 type TypeValueInitFunc func(*Value)
 
 // This function is responsible for storing the `value`
@@ -334,54 +299,39 @@ type TypeValueInitFunc func(*Value)
 // got collected into `collect_values` according to `lcopy_format`.
 //
 // The `n_collect_values` argument equals the string length of
-// `lcopy_format`, and `collect_flags` may contain %G_VALUE_NOCOPY_CONTENTS.
+// `lcopy_format`, and `collect_flags` may contain [VALUE_NOCOPY_CONTENTS].
 //
-// In contrast to #GTypeValueCollectFunc, this function is obliged to always
-// properly support %G_VALUE_NOCOPY_CONTENTS.
+// In contrast to [TypeValueCollectFunc], this function is obliged to always
+// properly support [VALUE_NOCOPY_CONTENTS].
 //
-// Similar to #GTypeValueCollectFunc the function may prematurely abort by
+// Similar to [TypeValueCollectFunc] the function may prematurely abort by
 // returning a newly allocated string describing an error condition. To
 // complete the string example:
 //
-// |[&lt;!-- language="C" --&gt;
-// gchar **string_p = collect_values[0].v_pointer;
-// g_return_val_if_fail (string_p != NULL,
-//
-//	g_strdup ("string location passed as NULL"));
-//
-// if (collect_flags &amp; G_VALUE_NOCOPY_CONTENTS)
-//
-//	*string_p = value-&gt;data[0].v_pointer;
-//
-// else
+//	gchar **string_p = collect_values[0].v_pointer;
+//	g_return_val_if_fail (string_p != NULL,
+//	  g_strdup ("string location passed as NULL"));
 //
-//	*string_p = g_strdup (value-&gt;data[0].v_pointer);
-//
-// ]|
+//	if (collect_flags & G_VALUE_NOCOPY_CONTENTS)
+//	  *string_p = value->data[0].v_pointer;
+//	else
+//	  *string_p = g_strdup (value->data[0].v_pointer);
 //
 // And an illustrative version of this function for reference-counted
 // types:
 //
-// |[&lt;!-- language="C" --&gt;
-// GObject **object_p = collect_values[0].v_pointer;
-// g_return_val_if_fail (object_p != NULL,
-//
-//	g_strdup ("object location passed as NULL"));
-//
-// if (value-&gt;data[0].v_pointer == NULL)
-//
-//	*object_p = NULL;
-//
-// else if (collect_flags &amp; G_VALUE_NOCOPY_CONTENTS) // always honour
+//	GObject **object_p = collect_values[0].v_pointer;
+//	g_return_val_if_fail (object_p != NULL,
+//	  g_strdup ("object location passed as NULL"));
 //
-//	*object_p = value-&gt;data[0].v_pointer;
+//	if (value->data[0].v_pointer == NULL)
+//	  *object_p = NULL;
+//	else if (collect_flags & G_VALUE_NOCOPY_CONTENTS) // always honour
+//	  *object_p = value->data[0].v_pointer;
+//	else
+//	  *object_p = g_object_ref (value->data[0].v_pointer);
 //
-// else
-//
-//	*object_p = g_object_ref (value-&gt;data[0].v_pointer);
-//
-// return NULL;
-// ]|
+//	return NULL;
 type TypeValueLCopyFunc func(*Value, uint, []TypeCValue, uint) string
 
 // If the value contents fit into a pointer, such as objects or strings,
@@ -389,9 +339,7 @@ type TypeValueLCopyFunc func(*Value, uint, []TypeCValue, uint) string
 //
 // To extend on our above string example:
 //
-// |[&lt;!-- language="C" --&gt;
-// return value-&gt;data[0].v_pointer;
-// ]|
+//	return value->data[0].v_pointer;
 type TypeValuePeekPointerFunc func(*Value) uintptr
 
 // A structure that provides information to the type system which is
@@ -421,90 +369,16 @@ func (x *TypeClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xTypeClassAddPrivate func(uintptr, uint)
-
-// Registers a private structure for an instantiatable type.
-//
-// When an object is allocated, the private structures for
-// the type and all of its parent types are allocated
-// sequentially in the same memory block as the public
-// structures, and are zero-filled.
-//
-// Note that the accumulated size of the private structures of
-// a type and all its parent types cannot exceed 64 KiB.
-//
-// This function should be called in the type's class_init() function.
-// The private structure can be retrieved using the
-// G_TYPE_INSTANCE_GET_PRIVATE() macro.
-//
-// The following example shows attaching a private structure
-// MyObjectPrivate to an object MyObject defined in the standard
-// GObject fashion in the type's class_init() function.
-//
-// Note the use of a structure member "priv" to avoid the overhead
-// of repeatedly calling MY_OBJECT_GET_PRIVATE().
-//
-// |[&lt;!-- language="C" --&gt;
-// typedef struct _MyObject        MyObject;
-// typedef struct _MyObjectPrivate MyObjectPrivate;
-//
-//	struct _MyObject {
-//	 GObject parent;
-//
-//	 MyObjectPrivate *priv;
-//	};
-//
-//	struct _MyObjectPrivate {
-//	  int some_field;
-//	};
-//
-// static void
-// my_object_class_init (MyObjectClass *klass)
-//
-//	{
-//	  g_type_class_add_private (klass, sizeof (MyObjectPrivate));
-//	}
-//
-// static void
-// my_object_init (MyObject *my_object)
-//
-//	{
-//	  my_object-&gt;priv = G_TYPE_INSTANCE_GET_PRIVATE (my_object,
-//	                                                 MY_TYPE_OBJECT,
-//	                                                 MyObjectPrivate);
-//	  // my_object-&gt;priv-&gt;some_field will be automatically initialised to 0
-//	}
-//
-// static int
-// my_object_get_some_field (MyObject *my_object)
-//
-//	{
-//	  MyObjectPrivate *priv;
-//
-//	  g_return_val_if_fail (MY_IS_OBJECT (my_object), 0);
-//
-//	  priv = my_object-&gt;priv;
-//
-//	  return priv-&gt;some_field;
-//	}
-//
-// ]|
-func (x *TypeClass) AddPrivate(PrivateSizeVar uint) {
-
-	xTypeClassAddPrivate(x.GoPointer(), PrivateSizeVar)
-
-}
-
 var xTypeClassGetInstancePrivateOffset func(uintptr) int
 
-// Gets the offset of the private data for instances of @g_class.
+// Gets the offset of the private data for instances of g_class.
 //
 // This is how many bytes you should add to the instance pointer of a
 // class in order to get the private data for the type represented by
-// @g_class.
+// g_class.
 //
 // You can only call this function after you have registered a private
-// data area for @g_class using g_type_class_add_private().
+// data area for g_class using [TypeClass.AddPrivate].
 func (x *TypeClass) GetInstancePrivateOffset() int {
 
 	cret := xTypeClassGetInstancePrivateOffset(x.GoPointer())
@@ -537,33 +411,6 @@ func (x *TypeClass) PeekParent() *TypeClass {
 	return cret
 }
 
-var xTypeClassUnref func(uintptr)
-
-// Decrements the reference count of the class structure being passed in.
-//
-// Once the last reference count of a class has been released, classes
-// may be finalized by the type system, so further dereferencing of a
-// class pointer after g_type_class_unref() are invalid.
-func (x *TypeClass) Unref() {
-
-	xTypeClassUnref(x.GoPointer())
-
-}
-
-var xTypeClassUnrefUncached func(uintptr)
-
-// A variant of g_type_class_unref() for use in #GTypeClassCacheFunc
-// implementations.
-//
-// It unreferences a class without consulting the chain
-// of #GTypeClassCacheFuncs, avoiding the recursion which would occur
-// otherwise.
-func (x *TypeClass) UnrefUncached() {
-
-	xTypeClassUnrefUncached(x.GoPointer())
-
-}
-
 // A structure that provides information to the type system which is
 // used specifically for managing fundamental types.
 type TypeFundamentalInfo struct {
@@ -580,11 +427,11 @@ func (x *TypeFundamentalInfo) GoPointer() uintptr {
 // required to initialize and destruct (finalize) a type's class and
 // its instances.
 //
-// The initialized structure is passed to the g_type_register_static() function
-// (or is copied into the provided #GTypeInfo structure in the
-// g_type_plugin_complete_type_info()). The type system will perform a deep
+// The initialized structure is passed to the [TypeRegisterStatic] function
+// (or is copied into the provided [TypeInfo] structure in the
+// [TypePlugin.CompleteTypeInfo]). The type system will perform a deep
 // copy of this structure, so its memory does not need to be persistent
-// across invocation of g_type_register_static().
+// across invocation of [TypeRegisterStatic].
 type TypeInfo struct {
 	_ structs.HostLayout
 
@@ -647,8 +494,8 @@ func (x *TypeInterface) GoPointer() uintptr {
 
 var xTypeInterfacePeekParent func(uintptr) *TypeInterface
 
-// Returns the corresponding #GTypeInterface structure of the parent type
-// of the instance type to which @g_iface belongs.
+// Returns the corresponding [TypeInterface] structure of the parent type
+// of the instance type to which g_iface belongs.
 //
 // This is useful when deriving the implementation of an interface from the
 // parent type and then possibly overriding some methods.
@@ -660,7 +507,7 @@ func (x *TypeInterface) PeekParent() *TypeInterface {
 
 // A structure holding information for a specific type.
 //
-// See also: g_type_query()
+// See also: [NewTypeQuery]
 type TypeQuery struct {
 	_ structs.HostLayout
 
@@ -677,6 +524,19 @@ func (x *TypeQuery) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewTypeQueryAlloc returns a zeroed TypeQuery allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated TypeQuery. Release it with Free.
+func NewTypeQueryAlloc() *TypeQuery {
+	cret := core.GMalloc0(max(unsafe.Sizeof(TypeQuery{}), 32))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*TypeQuery)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the TypeQuery with g_free, it must have been allocated by GLib, e.g. with NewTypeQueryAlloc.
+func (x *TypeQuery) Free() {
+	core.GFree(x.GoPointer())
+}
+
 // - `'i'`: Integers, passed as `collect_values[].v_int`
 //
 //   - `'l'`: Longs, passed as `collect_values[].v_long`
@@ -690,7 +550,7 @@ func (x *TypeQuery) GoPointer() uintptr {
 //     floats to doubles. So for collection of short int or char, `'i'`
 //     needs to be used, and for collection of floats `'d'`.
 //
-// The #GTypeValueTable provides the functions required by the #GValue
+// The [TypeValueTable] provides the functions required by the [Value]
 // implementation, to serve as a container for values of a type.
 type TypeValueTable struct {
 	_ structs.HostLayout
@@ -739,12 +599,14 @@ const (
 	TYPE_RESERVED_USER_FIRST int = 49
 )
 
-// These flags used to be passed to g_type_init_with_debug_flags() which
+// These flags used to be passed to [TypeInitWithDebugFlags] which
 // is now deprecated.
 //
 // If you need to enable debugging features, use the `GOBJECT_DEBUG`
 // environment variable.
-type TypeDebugFlags int
+//
+// Deprecated: since 2.36. [TypeInit] is now done automatically
+type TypeDebugFlags uint32
 
 const (
 
@@ -760,8 +622,52 @@ const (
 	GTypeDebugMaskValue TypeDebugFlags = 7
 )
 
+// Has reports whether all bits of flags are set in x
+func (x TypeDebugFlags) Has(flags TypeDebugFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TypeDebugFlags) Set(flags TypeDebugFlags) TypeDebugFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TypeDebugFlags) Clear(flags TypeDebugFlags) TypeDebugFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TypeDebugFlags) String() string {
+	if x == 0 {
+		return "G_TYPE_DEBUG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GTypeDebugObjectsValue != 0 && x&GTypeDebugObjectsValue == GTypeDebugObjectsValue {
+		names = append(names, "G_TYPE_DEBUG_OBJECTS")
+		rest &^= GTypeDebugObjectsValue
+	}
+	if rest&GTypeDebugSignalsValue != 0 && x&GTypeDebugSignalsValue == GTypeDebugSignalsValue {
+		names = append(names, "G_TYPE_DEBUG_SIGNALS")
+		rest &^= GTypeDebugSignalsValue
+	}
+	if rest&GTypeDebugInstanceCountValue != 0 && x&GTypeDebugInstanceCountValue == GTypeDebugInstanceCountValue {
+		names = append(names, "G_TYPE_DEBUG_INSTANCE_COUNT")
+		rest &^= GTypeDebugInstanceCountValue
+	}
+	if rest&GTypeDebugMaskValue != 0 && x&GTypeDebugMaskValue == GTypeDebugMaskValue {
+		names = append(names, "G_TYPE_DEBUG_MASK")
+		rest &^= GTypeDebugMaskValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Bit masks used to check or determine characteristics of a type.
-type TypeFlags int
+type TypeFlags uint32
 
 const (
 
@@ -772,7 +678,7 @@ const (
 	GTypeFlagAbstractValue TypeFlags = 16
 	// Indicates an abstract value type, i.e. a type
 	//  that introduces a value table, but can't be used for
-	//  g_value_init()
+	//  [Value.Init]
 	GTypeFlagValueAbstractValue TypeFlags = 32
 	// Indicates a final type. A final type is a non-derivable
 	//  leaf node in a deep derivable type hierarchy tree. Since: 2.70
@@ -783,9 +689,53 @@ const (
 	GTypeFlagDeprecatedValue TypeFlags = 128
 )
 
+// Has reports whether all bits of flags are set in x
+func (x TypeFlags) Has(flags TypeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TypeFlags) Set(flags TypeFlags) TypeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TypeFlags) Clear(flags TypeFlags) TypeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TypeFlags) String() string {
+	if x == 0 {
+		return "G_TYPE_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&GTypeFlagAbstractValue != 0 && x&GTypeFlagAbstractValue == GTypeFlagAbstractValue {
+		names = append(names, "G_TYPE_FLAG_ABSTRACT")
+		rest &^= GTypeFlagAbstractValue
+	}
+	if rest&GTypeFlagValueAbstractValue != 0 && x&GTypeFlagValueAbstractValue == GTypeFlagValueAbstractValue {
+		names = append(names, "G_TYPE_FLAG_VALUE_ABSTRACT")
+		rest &^= GTypeFlagValueAbstractValue
+	}
+	if rest&GTypeFlagFinalValue != 0 && x&GTypeFlagFinalValue == GTypeFlagFinalValue {
+		names = append(names, "G_TYPE_FLAG_FINAL")
+		rest &^= GTypeFlagFinalValue
+	}
+	if rest&GTypeFlagDeprecatedValue != 0 && x&GTypeFlagDeprecatedValue == GTypeFlagDeprecatedValue {
+		names = append(names, "G_TYPE_FLAG_DEPRECATED")
+		rest &^= GTypeFlagDeprecatedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Bit masks used to check or determine specific characteristics of a
 // fundamental type.
-type TypeFundamentalFlags int
+type TypeFundamentalFlags uint32
 
 const (
 
@@ -799,14 +749,58 @@ const (
 	GTypeFlagDeepDerivableValue TypeFundamentalFlags = 8
 )
 
+// Has reports whether all bits of flags are set in x
+func (x TypeFundamentalFlags) Has(flags TypeFundamentalFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TypeFundamentalFlags) Set(flags TypeFundamentalFlags) TypeFundamentalFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TypeFundamentalFlags) Clear(flags TypeFundamentalFlags) TypeFundamentalFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TypeFundamentalFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GTypeFlagClassedValue != 0 && x&GTypeFlagClassedValue == GTypeFlagClassedValue {
+		names = append(names, "G_TYPE_FLAG_CLASSED")
+		rest &^= GTypeFlagClassedValue
+	}
+	if rest&GTypeFlagInstantiatableValue != 0 && x&GTypeFlagInstantiatableValue == GTypeFlagInstantiatableValue {
+		names = append(names, "G_TYPE_FLAG_INSTANTIATABLE")
+		rest &^= GTypeFlagInstantiatableValue
+	}
+	if rest&GTypeFlagDerivableValue != 0 && x&GTypeFlagDerivableValue == GTypeFlagDerivableValue {
+		names = append(names, "G_TYPE_FLAG_DERIVABLE")
+		rest &^= GTypeFlagDerivableValue
+	}
+	if rest&GTypeFlagDeepDerivableValue != 0 && x&GTypeFlagDeepDerivableValue == GTypeFlagDeepDerivableValue {
+		names = append(names, "G_TYPE_FLAG_DEEP_DERIVABLE")
+		rest &^= GTypeFlagDeepDerivableValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xTypeAddClassCacheFunc func(uintptr, uintptr)
 
-// Adds a #GTypeClassCacheFunc to be called before the reference count of a
+// Adds a [TypeClassCacheFunc] to be called before the reference count of a
 // class goes from one to zero. This can be used to prevent premature class
-// destruction. All installed #GTypeClassCacheFunc functions will be chained
-// until one of them returns %TRUE. The functions have to check the class id
+// destruction. All installed [TypeClassCacheFunc] functions will be chained
+// until one of them returns true. The functions have to check the class id
 // passed in to figure whether they actually want to cache the class of this
-// type, since all classes are routed through the same #GTypeClassCacheFunc
+// type, since all classes are routed through the same [TypeClassCacheFunc]
 // chain.
 func TypeAddClassCacheFunc(CacheDataVar uintptr, CacheFuncVar *TypeClassCacheFunc) {
 
@@ -817,11 +811,12 @@ func TypeAddClassCacheFunc(CacheDataVar uintptr, CacheFuncVar *TypeClassCacheFun
 			CacheFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *TypeClass) bool {
+				defer core.RecoverPanic()
 				cbFn := *CacheFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CacheFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CacheFuncVarPtr, CacheFuncVarRef, CacheFuncVar)
+			CacheFuncVarRef = core.NewCallback(fcb)
+			CacheFuncVarRef = glib.SaveCallbackWithClosure(CacheFuncVarPtr, CacheFuncVarRef, CacheFuncVar)
 		}
 	}
 
@@ -858,12 +853,12 @@ func TypeAddInstancePrivate(ClassTypeVar types.GType, PrivateSizeVar uint) int {
 var xTypeAddInterfaceCheck func(uintptr, uintptr)
 
 // Adds a function to be called after an interface vtable is
-// initialized for any class (i.e. after the @interface_init
-// member of #GInterfaceInfo has been called).
+// initialized for any class (i.e. after the interface_init
+// member of [InterfaceInfo] has been called).
 //
 // This function is useful when you want to check an invariant
 // that depends on the interfaces of a class. For instance, the
-// implementation of #GObject uses this facility to check that an
+// implementation of [Object] uses this facility to check that an
 // object implements all of the properties that are defined on its
 // interfaces.
 func TypeAddInterfaceCheck(CheckDataVar uintptr, CheckFuncVar *TypeInterfaceCheckFunc) {
@@ -875,11 +870,12 @@ func TypeAddInterfaceCheck(CheckDataVar uintptr, CheckFuncVar *TypeInterfaceChec
 			CheckFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *TypeInterface) {
+				defer core.RecoverPanic()
 				cbFn := *CheckFuncVar
 				cbFn(arg0, arg1)
 			}
-			CheckFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CheckFuncVarPtr, CheckFuncVarRef, CheckFuncVar)
+			CheckFuncVarRef = core.NewCallback(fcb)
+			CheckFuncVarRef = glib.SaveCallbackWithClosure(CheckFuncVarPtr, CheckFuncVarRef, CheckFuncVar)
 		}
 	}
 
@@ -889,8 +885,8 @@ func TypeAddInterfaceCheck(CheckDataVar uintptr, CheckFuncVar *TypeInterfaceChec
 
 var xTypeAddInterfaceDynamic func(types.GType, types.GType, uintptr)
 
-// Adds @interface_type to the dynamic @instance_type. The information
-// contained in the #GTypePlugin structure pointed to by @plugin
+// Adds interface_type to the dynamic instance_type. The information
+// contained in the [TypePlugin] structure pointed to by plugin
 // is used to manage the relationship.
 func TypeAddInterfaceDynamic(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, PluginVar TypePlugin) {
 
@@ -900,9 +896,9 @@ func TypeAddInterfaceDynamic(InstanceTypeVar types.GType, InterfaceTypeVar types
 
 var xTypeAddInterfaceStatic func(types.GType, types.GType, *InterfaceInfo)
 
-// Adds @interface_type to the static @instance_type.
-// The information contained in the #GInterfaceInfo structure
-// pointed to by @info is used to manage the relationship.
+// Adds interface_type to the static instance_type.
+// The information contained in the [InterfaceInfo] structure
+// pointed to by info is used to manage the relationship.
 func TypeAddInterfaceStatic(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, InfoVar *InterfaceInfo) {
 
 	xTypeAddInterfaceStatic(InstanceTypeVar, InterfaceTypeVar, InfoVar)
@@ -986,7 +982,7 @@ func TypeCheckValueHolds(ValueVar *Value, TypeVar types.GType) bool {
 var xTypeChildren func(types.GType, *uint) uintptr
 
 // Return a newly allocated and 0-terminated array of type IDs, listing
-// the child types of @type.
+// the child types of type.
 func TypeChildren(TypeVar types.GType, NChildrenVar *uint) uintptr {
 
 	cret := xTypeChildren(TypeVar, NChildrenVar)
@@ -1003,12 +999,12 @@ func TypeClassAdjustPrivateOffset(GClassVar uintptr, PrivateSizeOrOffsetVar int)
 
 var xTypeClassGet func(types.GType) *TypeClass
 
-// Retrieves the type class of the given @type.
+// Retrieves the type class of the given type.
 //
 // This function will create the class on demand if it does not exist
 // already.
 //
-// If you don't want to create the class, use g_type_class_peek() instead.
+// If you don't want to create the class, use [TypeClassPeek] instead.
 func TypeClassGet(TypeVar types.GType) *TypeClass {
 
 	cret := xTypeClassGet(TypeVar)
@@ -1019,10 +1015,10 @@ var xTypeClassPeek func(types.GType) *TypeClass
 
 // Retrieves the class for a give type.
 //
-// This function is essentially the same as g_type_class_get(),
+// This function is essentially the same as [TypeClassGet],
 // except that the class may have not been instantiated yet.
 //
-// As a consequence, this function may return %NULL if the class
+// As a consequence, this function may return nil if the class
 // of the type passed in does not currently exist (hasn't been
 // referenced before).
 func TypeClassPeek(TypeVar types.GType) *TypeClass {
@@ -1033,7 +1029,7 @@ func TypeClassPeek(TypeVar types.GType) *TypeClass {
 
 var xTypeClassPeekStatic func(types.GType) *TypeClass
 
-// A more efficient version of g_type_class_peek() which works only for
+// A more efficient version of [TypeClassPeek] which works only for
 // static types.
 func TypeClassPeekStatic(TypeVar types.GType) *TypeClass {
 
@@ -1043,14 +1039,14 @@ func TypeClassPeekStatic(TypeVar types.GType) *TypeClass {
 
 var xTypeCreateInstance func(types.GType) *TypeInstance
 
-// Creates and initializes an instance of @type if @type is valid and
+// Creates and initializes an instance of type if type is valid and
 // can be instantiated. The type system only performs basic allocation
 // and structure setups for instances: actual instance creation should
 // happen through functions supplied by the type's fundamental type
-// implementation.  So use of g_type_create_instance() is reserved for
+// implementation.  So use of [TypeCreateInstance] is reserved for
 // implementers of fundamental types only. E.g. instances of the
-// #GObject hierarchy should be created via g_object_new() and never
-// directly through g_type_create_instance() which doesn't handle things
+// [Object] hierarchy should be created via [NewObject] and never
+// directly through [TypeCreateInstance] which doesn't handle things
 // like singleton objects or object construction.
 //
 // The extended members of the returned instance are guaranteed to be filled
@@ -1058,7 +1054,7 @@ var xTypeCreateInstance func(types.GType) *TypeInstance
 //
 // Note: Do not use this function, unless you're implementing a
 // fundamental type. Also language bindings should not use this
-// function, but g_object_new() instead.
+// function, but [NewObject] instead.
 func TypeCreateInstance(TypeVar types.GType) *TypeInstance {
 
 	cret := xTypeCreateInstance(TypeVar)
@@ -1067,17 +1063,17 @@ func TypeCreateInstance(TypeVar types.GType) *TypeInstance {
 
 var xTypeDefaultInterfaceGet func(types.GType) *TypeInterface
 
-// Returns the default interface vtable for the given @g_type.
+// Returns the default interface vtable for the given g_type.
 //
 // If the type is not currently in use, then the default vtable
 // for the type will be created and initialized by calling
 // the base interface init and default vtable init functions for
-// the type (the @base_init and @class_init members of #GTypeInfo).
+// the type (the base_init and class_init members of [TypeInfo]).
 //
 // If you don't want to create the interface vtable, you should use
-// g_type_default_interface_peek() instead.
+// [TypeDefaultInterfacePeek] instead.
 //
-// Calling g_type_default_interface_get() is useful when you
+// Calling [TypeDefaultInterfaceGet] is useful when you
 // want to make sure that signals and properties for an interface
 // have been installed.
 func TypeDefaultInterfaceGet(GTypeVar types.GType) *TypeInterface {
@@ -1088,7 +1084,7 @@ func TypeDefaultInterfaceGet(GTypeVar types.GType) *TypeInterface {
 
 var xTypeDefaultInterfacePeek func(types.GType) *TypeInterface
 
-// If the interface type @g_type is currently in use, returns its
+// If the interface type g_type is currently in use, returns its
 // default interface vtable.
 func TypeDefaultInterfacePeek(GTypeVar types.GType) *TypeInterface {
 
@@ -1096,38 +1092,6 @@ func TypeDefaultInterfacePeek(GTypeVar types.GType) *TypeInterface {
 	return cret
 }
 
-var xTypeDefaultInterfaceRef func(types.GType) *TypeInterface
-
-// Increments the reference count for the interface type @g_type,
-// and returns the default interface vtable for the type.
-//
-// If the type is not currently in use, then the default vtable
-// for the type will be created and initialized by calling
-// the base interface init and default vtable init functions for
-// the type (the @base_init and @class_init members of #GTypeInfo).
-// Calling g_type_default_interface_ref() is useful when you
-// want to make sure that signals and properties for an interface
-// have been installed.
-func TypeDefaultInterfaceRef(GTypeVar types.GType) *TypeInterface {
-
-	cret := xTypeDefaultInterfaceRef(GTypeVar)
-	return cret
-}
-
-var xTypeDefaultInterfaceUnref func(*TypeInterface)
-
-// Decrements the reference count for the type corresponding to the
-// interface default vtable @g_iface.
-//
-// If the type is dynamic, then when no one is using the interface and all
-// references have been released, the finalize function for the interface's
-// default vtable (the @class_finalize member of #GTypeInfo) will be called.
-func TypeDefaultInterfaceUnref(GIfaceVar *TypeInterface) {
-
-	xTypeDefaultInterfaceUnref(GIfaceVar)
-
-}
-
 var xTypeDepth func(types.GType) uint
 
 // Returns the length of the ancestry of the passed in type. This
@@ -1140,17 +1104,17 @@ func TypeDepth(TypeVar types.GType) uint {
 
 var xTypeEnsure func(types.GType)
 
-// Ensures that the indicated @type has been registered with the
+// Ensures that the indicated type has been registered with the
 // type system, and its _class_init() method has been run.
 //
 // In theory, simply calling the type's _get_type() method (or using
 // the corresponding macro) is supposed take care of this. However,
-// _get_type() methods are often marked %G_GNUC_CONST for performance
+// _get_type() methods are often marked G_GNUC_CONST for performance
 // reasons, even though this is technically incorrect (since
-// %G_GNUC_CONST requires that the function not have side effects,
+// G_GNUC_CONST requires that the function not have side effects,
 // which _get_type() methods do on the first call). As a result, if
 // you write a bare call to a _get_type() macro, it may get optimized
-// out by the compiler. Using g_type_ensure() guarantees that the
+// out by the compiler. Using [TypeEnsure] guarantees that the
 // type's _get_type() method is called.
 func TypeEnsure(TypeVar types.GType) {
 
@@ -1163,7 +1127,7 @@ var xTypeFreeInstance func(*TypeInstance)
 // Frees an instance of a type, returning it to the instance pool for
 // the type, if there is one.
 //
-// Like g_type_create_instance(), this function is reserved for
+// Like [TypeCreateInstance], this function is reserved for
 // implementors of fundamental types.
 func TypeFreeInstance(InstanceVar *TypeInstance) {
 
@@ -1196,7 +1160,7 @@ func TypeFundamental(TypeIdVar types.GType) types.GType {
 var xTypeFundamentalNext func() types.GType
 
 // Returns the next free fundamental type id which can be used to
-// register a new fundamental type with g_type_register_fundamental().
+// register a new fundamental type with [TypeRegisterFundamental].
 // The returned type ID represents the highest currently registered
 // fundamental type identifier.
 func TypeFundamentalNext() types.GType {
@@ -1219,7 +1183,7 @@ func TypeGetInstanceCount(TypeVar types.GType) int {
 
 var xTypeGetPlugin func(types.GType) uintptr
 
-// Returns the #GTypePlugin structure for @type.
+// Returns the [TypePlugin] structure for type.
 func TypeGetPlugin(TypeVar types.GType) *TypePluginBase {
 	var cls *TypePluginBase
 
@@ -1236,12 +1200,12 @@ func TypeGetPlugin(TypeVar types.GType) *TypePluginBase {
 
 var xTypeGetQdata func(types.GType, glib.Quark) uintptr
 
-// Obtains data which has previously been attached to @type
-// with g_type_set_qdata().
+// Obtains data which has previously been attached to type
+// with [TypeSetQdata].
 //
 // Note that this does not take subtyping into account; data
-// attached to one type with g_type_set_qdata() cannot
-// be retrieved from a subtype using g_type_get_qdata().
+// attached to one type with [TypeSetQdata] cannot
+// be retrieved from a subtype using [TypeGetQdata].
 func TypeGetQdata(TypeVar types.GType, QuarkVar glib.Quark) uintptr {
 
 	cret := xTypeGetQdata(TypeVar, QuarkVar)
@@ -1253,7 +1217,7 @@ var xTypeGetTypeRegistrationSerial func() uint
 // Returns an opaque serial number that represents the state of the set
 // of registered types. Any time a type is registered this serial changes,
 // which means you can cache information based on type lookups (such as
-// g_type_from_name()) and know if the cache is still valid at a later
+// [TypeFromName]) and know if the cache is still valid at a later
 // time by comparing the current serial with the one at the type lookup.
 func TypeGetTypeRegistrationSerial() uint {
 
@@ -1261,36 +1225,11 @@ func TypeGetTypeRegistrationSerial() uint {
 	return cret
 }
 
-var xTypeInit func()
-
-// This function used to initialise the type system.  Since GLib 2.36,
-// the type system is initialised automatically and this function does
-// nothing.
-func TypeInit() {
-
-	xTypeInit()
-
-}
-
-var xTypeInitWithDebugFlags func(TypeDebugFlags)
-
-// This function used to initialise the type system with debugging
-// flags.  Since GLib 2.36, the type system is initialised automatically
-// and this function does nothing.
-//
-// If you need to enable debugging features, use the `GOBJECT_DEBUG`
-// environment variable.
-func TypeInitWithDebugFlags(DebugFlagsVar TypeDebugFlags) {
-
-	xTypeInitWithDebugFlags(DebugFlagsVar)
-
-}
-
 var xTypeInterfaceAddPrerequisite func(types.GType, types.GType)
 
-// Adds @prerequisite_type to the list of prerequisites of @interface_type.
-// This means that any type implementing @interface_type must also implement
-// @prerequisite_type. Prerequisites can be thought of as an alternative to
+// Adds prerequisite_type to the list of prerequisites of interface_type.
+// This means that any type implementing interface_type must also implement
+// prerequisite_type. Prerequisites can be thought of as an alternative to
 // interface derivation (which GType doesn't support). An interface can have
 // at most one instantiatable prerequisite type.
 func TypeInterfaceAddPrerequisite(InterfaceTypeVar types.GType, PrerequisiteTypeVar types.GType) {
@@ -1301,10 +1240,10 @@ func TypeInterfaceAddPrerequisite(InterfaceTypeVar types.GType, PrerequisiteType
 
 var xTypeInterfaceGetPlugin func(types.GType, types.GType) uintptr
 
-// Returns the #GTypePlugin structure for the dynamic interface
-// @interface_type which has been added to @instance_type, or %NULL
-// if @interface_type has not been added to @instance_type or does
-// not have a #GTypePlugin structure. See g_type_add_interface_dynamic().
+// Returns the [TypePlugin] structure for the dynamic interface
+// interface_type which has been added to instance_type, or nil
+// if interface_type has not been added to instance_type or does
+// not have a [TypePlugin] structure. See [TypeAddInterfaceDynamic].
 func TypeInterfaceGetPlugin(InstanceTypeVar types.GType, InterfaceTypeVar types.GType) *TypePluginBase {
 	var cls *TypePluginBase
 
@@ -1323,9 +1262,9 @@ var xTypeInterfaceInstantiatablePrerequisite func(types.GType) types.GType
 
 // Returns the most specific instantiatable prerequisite of an
 // interface type. If the interface type has no instantiatable
-// prerequisite, %G_TYPE_INVALID is returned.
+// prerequisite, G_TYPE_INVALID is returned.
 //
-// See g_type_interface_add_prerequisite() for more information
+// See [TypeInterfaceAddPrerequisite] for more information
 // about prerequisites.
 func TypeInterfaceInstantiatablePrerequisite(InterfaceTypeVar types.GType) types.GType {
 
@@ -1335,7 +1274,7 @@ func TypeInterfaceInstantiatablePrerequisite(InterfaceTypeVar types.GType) types
 
 var xTypeInterfacePeek func(*TypeClass, types.GType) *TypeInterface
 
-// Returns the #GTypeInterface structure of an interface to which the
+// Returns the [TypeInterface] structure of an interface to which the
 // passed in class conforms.
 func TypeInterfacePeek(InstanceClassVar *TypeClass, IfaceTypeVar types.GType) *TypeInterface {
 
@@ -1355,7 +1294,7 @@ func TypeInterfacePrerequisites(InterfaceTypeVar types.GType, NPrerequisitesVar
 var xTypeInterfaces func(types.GType, *uint) uintptr
 
 // Return a newly allocated and 0-terminated array of type IDs, listing
-// the interface types that @type conforms to.
+// the interface types that type conforms to.
 func TypeInterfaces(TypeVar types.GType, NInterfacesVar *uint) uintptr {
 
 	cret := xTypeInterfaces(TypeVar, NInterfacesVar)
@@ -1364,9 +1303,9 @@ func TypeInterfaces(TypeVar types.GType, NInterfacesVar *uint) uintptr {
 
 var xTypeIsA func(types.GType, types.GType) bool
 
-// If @is_a_type is a derivable type, check whether @type is a
-// descendant of @is_a_type. If @is_a_type is an interface, check
-// whether @type conforms to it.
+// If is_a_type is a derivable type, check whether type is a
+// descendant of is_a_type. If is_a_type is an interface, check
+// whether type conforms to it.
 func TypeIsA(TypeVar types.GType, IsATypeVar types.GType) bool {
 
 	cret := xTypeIsA(TypeVar, IsATypeVar)
@@ -1378,7 +1317,7 @@ var xTypeName func(types.GType) string
 // Get the unique name that is assigned to a type ID.
 //
 // Note that this function (like all other GType API) cannot cope with
-// invalid type IDs. %G_TYPE_INVALID may be passed to this function, as
+// invalid type IDs. G_TYPE_INVALID may be passed to this function, as
 // may be any other validly registered type ID, but randomized type IDs
 // should not be passed in and will most likely lead to a crash.
 func TypeName(TypeVar types.GType) string {
@@ -1405,11 +1344,11 @@ func TypeNameFromInstance(InstanceVar *TypeInstance) string {
 
 var xTypeNextBase func(types.GType, types.GType) types.GType
 
-// Given a @leaf_type and a @root_type which is contained in its
-// ancestry, return the type that @root_type is the immediate parent
+// Given a leaf_type and a root_type which is contained in its
+// ancestry, return the type that root_type is the immediate parent
 // of. In other words, this function determines the type that is
-// derived directly from @root_type which is also a base class of
-// @leaf_type.  Given a root type and a leaf type, this function can
+// derived directly from root_type which is also a base class of
+// leaf_type.  Given a root type and a leaf type, this function can
 // be used to determine the types and order in which the leaf type is
 // descended from the root type.
 func TypeNextBase(LeafTypeVar types.GType, RootTypeVar types.GType) types.GType {
@@ -1442,9 +1381,9 @@ var xNewTypeQuery func(types.GType, *TypeQuery)
 // Queries the type system for information about a specific type.
 //
 // This function will fill in a user-provided structure to hold
-// type-specific information. If an invalid #GType is passed in, the
-// @type member of the #GTypeQuery is 0. All members filled into the
-// #GTypeQuery structure should be considered constant and have to be
+// type-specific information. If an invalid [Type] is passed in, the
+// type member of the [TypeQuery] is 0. All members filled into the
+// [TypeQuery] structure should be considered constant and have to be
 // left untouched.
 //
 // Since GLib 2.78, this function allows queries on dynamic types. Previously
@@ -1457,10 +1396,10 @@ func NewTypeQuery(TypeVar types.GType, QueryVar *TypeQuery) {
 
 var xTypeRegisterDynamic func(types.GType, string, uintptr, TypeFlags) types.GType
 
-// Registers @type_name as the name of a new dynamic type derived from
-// @parent_type.  The type system uses the information contained in the
-// #GTypePlugin structure pointed to by @plugin to manage the type and its
-// instances (if not abstract).  The value of @flags determines the nature
+// Registers type_name as the name of a new dynamic type derived from
+// parent_type.  The type system uses the information contained in the
+// [TypePlugin] structure pointed to by plugin to manage the type and its
+// instances (if not abstract).  The value of flags determines the nature
 // (e.g. abstract or not) of the type.
 func TypeRegisterDynamic(ParentTypeVar types.GType, TypeNameVar string, PluginVar TypePlugin, FlagsVar TypeFlags) types.GType {
 
@@ -1470,12 +1409,12 @@ func TypeRegisterDynamic(ParentTypeVar types.GType, TypeNameVar string, PluginVa
 
 var xTypeRegisterFundamental func(types.GType, string, *TypeInfo, *TypeFundamentalInfo, TypeFlags) types.GType
 
-// Registers @type_id as the predefined identifier and @type_name as the
-// name of a fundamental type. If @type_id is already registered, or a
-// type named @type_name is already registered, the behaviour is undefined.
-// The type system uses the information contained in the #GTypeInfo structure
-// pointed to by @info and the #GTypeFundamentalInfo structure pointed to by
-// @finfo to manage the type and its instances. The value of @flags determines
+// Registers type_id as the predefined identifier and type_name as the
+// name of a fundamental type. If type_id is already registered, or a
+// type named type_name is already registered, the behaviour is undefined.
+// The type system uses the information contained in the [TypeInfo] structure
+// pointed to by info and the [TypeFundamentalInfo] structure pointed to by
+// finfo to manage the type and its instances. The value of flags determines
 // additional characteristics of the fundamental type.
 func TypeRegisterFundamental(TypeIdVar types.GType, TypeNameVar string, InfoVar *TypeInfo, FinfoVar *TypeFundamentalInfo, FlagsVar TypeFlags) types.GType {
 
@@ -1485,10 +1424,10 @@ func TypeRegisterFundamental(TypeIdVar types.GType, TypeNameVar string, InfoVar
 
 var xTypeRegisterStatic func(types.GType, string, *TypeInfo, TypeFlags) types.GType
 
-// Registers @type_name as the name of a new static type derived from
-// @parent_type. The type system uses the information contained in the
-// #GTypeInfo structure pointed to by @info to manage the type and its
-// instances (if not abstract). The value of @flags determines the nature
+// Registers type_name as the name of a new static type derived from
+// parent_type. The type system uses the information contained in the
+// [TypeInfo] structure pointed to by info to manage the type and its
+// instances (if not abstract). The value of flags determines the nature
 // (e.g. abstract or not) of the type.
 func TypeRegisterStatic(ParentTypeVar types.GType, TypeNameVar string, InfoVar *TypeInfo, FlagsVar TypeFlags) types.GType {
 
@@ -1498,10 +1437,10 @@ func TypeRegisterStatic(ParentTypeVar types.GType, TypeNameVar string, InfoVar *
 
 var xTypeRegisterStaticSimple func(types.GType, string, uint, uintptr, uint, uintptr, TypeFlags) types.GType
 
-// Registers @type_name as the name of a new static type derived from
-// @parent_type.  The value of @flags determines the nature (e.g.
-// abstract or not) of the type. It works by filling a #GTypeInfo
-// struct and calling g_type_register_static().
+// Registers type_name as the name of a new static type derived from
+// parent_type.  The value of flags determines the nature (e.g.
+// abstract or not) of the type. It works by filling a [TypeInfo]
+// struct and calling [TypeRegisterStatic].
 func TypeRegisterStaticSimple(ParentTypeVar types.GType, TypeNameVar string, ClassSizeVar uint, ClassInitVar *ClassInitFunc, InstanceSizeVar uint, InstanceInitVar *InstanceInitFunc, FlagsVar TypeFlags) types.GType {
 
 	var ClassInitVarRef uintptr
@@ -1511,11 +1450,12 @@ func TypeRegisterStaticSimple(ParentTypeVar types.GType, TypeNameVar string, Cla
 			ClassInitVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *TypeClass, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ClassInitVar
 				cbFn(arg0, arg1)
 			}
-			ClassInitVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ClassInitVarPtr, ClassInitVarRef, ClassInitVar)
+			ClassInitVarRef = core.NewCallback(fcb)
+			ClassInitVarRef = glib.SaveCallbackWithClosure(ClassInitVarPtr, ClassInitVarRef, ClassInitVar)
 		}
 	}
 
@@ -1526,11 +1466,12 @@ func TypeRegisterStaticSimple(ParentTypeVar types.GType, TypeNameVar string, Cla
 			InstanceInitVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *TypeInstance, arg1 *TypeClass) {
+				defer core.RecoverPanic()
 				cbFn := *InstanceInitVar
 				cbFn(arg0, arg1)
 			}
-			InstanceInitVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(InstanceInitVarPtr, InstanceInitVarRef, InstanceInitVar)
+			InstanceInitVarRef = core.NewCallback(fcb)
+			InstanceInitVarRef = glib.SaveCallbackWithClosure(InstanceInitVarPtr, InstanceInitVarRef, InstanceInitVar)
 		}
 	}
 
@@ -1540,9 +1481,9 @@ func TypeRegisterStaticSimple(ParentTypeVar types.GType, TypeNameVar string, Cla
 
 var xTypeRemoveClassCacheFunc func(uintptr, uintptr)
 
-// Removes a previously installed #GTypeClassCacheFunc. The cache
-// maintained by @cache_func has to be empty when calling
-// g_type_remove_class_cache_func() to avoid leaks.
+// Removes a previously installed [TypeClassCacheFunc]. The cache
+// maintained by cache_func has to be empty when calling
+// [TypeRemoveClassCacheFunc] to avoid leaks.
 func TypeRemoveClassCacheFunc(CacheDataVar uintptr, CacheFuncVar *TypeClassCacheFunc) {
 
 	var CacheFuncVarRef uintptr
@@ -1552,11 +1493,12 @@ func TypeRemoveClassCacheFunc(CacheDataVar uintptr, CacheFuncVar *TypeClassCache
 			CacheFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *TypeClass) bool {
+				defer core.RecoverPanic()
 				cbFn := *CacheFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CacheFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CacheFuncVarPtr, CacheFuncVarRef, CacheFuncVar)
+			CacheFuncVarRef = core.NewCallback(fcb)
+			CacheFuncVarRef = glib.SaveCallbackWithClosure(CacheFuncVarPtr, CacheFuncVarRef, CacheFuncVar)
 		}
 	}
 
@@ -1567,7 +1509,7 @@ func TypeRemoveClassCacheFunc(CacheDataVar uintptr, CacheFuncVar *TypeClassCache
 var xTypeRemoveInterfaceCheck func(uintptr, uintptr)
 
 // Removes an interface check function added with
-// g_type_add_interface_check().
+// [TypeAddInterfaceCheck].
 func TypeRemoveInterfaceCheck(CheckDataVar uintptr, CheckFuncVar *TypeInterfaceCheckFunc) {
 
 	var CheckFuncVarRef uintptr
@@ -1577,11 +1519,12 @@ func TypeRemoveInterfaceCheck(CheckDataVar uintptr, CheckFuncVar *TypeInterfaceC
 			CheckFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *TypeInterface) {
+				defer core.RecoverPanic()
 				cbFn := *CheckFuncVar
 				cbFn(arg0, arg1)
 			}
-			CheckFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CheckFuncVarPtr, CheckFuncVarRef, CheckFuncVar)
+			CheckFuncVarRef = core.NewCallback(fcb)
+			CheckFuncVarRef = glib.SaveCallbackWithClosure(CheckFuncVarPtr, CheckFuncVarRef, CheckFuncVar)
 		}
 	}
 
@@ -1608,11 +1551,11 @@ func TypeTestFlags(TypeVar types.GType, FlagsVar uint) bool {
 
 var xTypeValueTablePeek func(types.GType) *TypeValueTable
 
-// Returns the location of the #GTypeValueTable associated with @type.
+// Returns the location of the [TypeValueTable] associated with type.
 //
 // Note that this function should only be used from source code
 // that implements or has internal knowledge of the implementation of
-// @type.
+// type.
 func TypeValueTablePeek(TypeVar types.GType) *TypeValueTable {
 
 	cret := xTypeValueTablePeek(TypeVar)
@@ -1624,83 +1567,76 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTypeAddClassCacheFunc, libs, "g_type_add_class_cache_func")
-	core.PuregoSafeRegister(&xTypeAddClassPrivate, libs, "g_type_add_class_private")
-	core.PuregoSafeRegister(&xTypeAddInstancePrivate, libs, "g_type_add_instance_private")
-	core.PuregoSafeRegister(&xTypeAddInterfaceCheck, libs, "g_type_add_interface_check")
-	core.PuregoSafeRegister(&xTypeAddInterfaceDynamic, libs, "g_type_add_interface_dynamic")
-	core.PuregoSafeRegister(&xTypeAddInterfaceStatic, libs, "g_type_add_interface_static")
-	core.PuregoSafeRegister(&xTypeCheckClassCast, libs, "g_type_check_class_cast")
-	core.PuregoSafeRegister(&xTypeCheckClassIsA, libs, "g_type_check_class_is_a")
-	core.PuregoSafeRegister(&xTypeCheckInstance, libs, "g_type_check_instance")
-	core.PuregoSafeRegister(&xTypeCheckInstanceCast, libs, "g_type_check_instance_cast")
-	core.PuregoSafeRegister(&xTypeCheckInstanceIsA, libs, "g_type_check_instance_is_a")
-	core.PuregoSafeRegister(&xTypeCheckInstanceIsFundamentallyA, libs, "g_type_check_instance_is_fundamentally_a")
-	core.PuregoSafeRegister(&xTypeCheckIsValueType, libs, "g_type_check_is_value_type")
-	core.PuregoSafeRegister(&xTypeCheckValue, libs, "g_type_check_value")
-	core.PuregoSafeRegister(&xTypeCheckValueHolds, libs, "g_type_check_value_holds")
-	core.PuregoSafeRegister(&xTypeChildren, libs, "g_type_children")
-	core.PuregoSafeRegister(&xTypeClassAdjustPrivateOffset, libs, "g_type_class_adjust_private_offset")
-	core.PuregoSafeRegister(&xTypeClassGet, libs, "g_type_class_get")
-	core.PuregoSafeRegister(&xTypeClassPeek, libs, "g_type_class_peek")
-	core.PuregoSafeRegister(&xTypeClassPeekStatic, libs, "g_type_class_peek_static")
-	core.PuregoSafeRegister(&xTypeCreateInstance, libs, "g_type_create_instance")
-	core.PuregoSafeRegister(&xTypeDefaultInterfaceGet, libs, "g_type_default_interface_get")
-	core.PuregoSafeRegister(&xTypeDefaultInterfacePeek, libs, "g_type_default_interface_peek")
-	core.PuregoSafeRegister(&xTypeDefaultInterfaceRef, libs, "g_type_default_interface_ref")
-	core.PuregoSafeRegister(&xTypeDefaultInterfaceUnref, libs, "g_type_default_interface_unref")
-	core.PuregoSafeRegister(&xTypeDepth, libs, "g_type_depth")
-	core.PuregoSafeRegister(&xTypeEnsure, libs, "g_type_ensure")
-	core.PuregoSafeRegister(&xTypeFreeInstance, libs, "g_type_free_instance")
-	core.PuregoSafeRegister(&xTypeFromName, libs, "g_type_from_name")
-	core.PuregoSafeRegister(&xTypeFundamental, libs, "g_type_fundamental")
-	core.PuregoSafeRegister(&xTypeFundamentalNext, libs, "g_type_fundamental_next")
-	core.PuregoSafeRegister(&xTypeGetInstanceCount, libs, "g_type_get_instance_count")
-	core.PuregoSafeRegister(&xTypeGetPlugin, libs, "g_type_get_plugin")
-	core.PuregoSafeRegister(&xTypeGetQdata, libs, "g_type_get_qdata")
-	core.PuregoSafeRegister(&xTypeGetTypeRegistrationSerial, libs, "g_type_get_type_registration_serial")
-	core.PuregoSafeRegister(&xTypeInit, libs, "g_type_init")
-	core.PuregoSafeRegister(&xTypeInitWithDebugFlags, libs, "g_type_init_with_debug_flags")
-	core.PuregoSafeRegister(&xTypeInterfaceAddPrerequisite, libs, "g_type_interface_add_prerequisite")
-	core.PuregoSafeRegister(&xTypeInterfaceGetPlugin, libs, "g_type_interface_get_plugin")
-	core.PuregoSafeRegister(&xTypeInterfaceInstantiatablePrerequisite, libs, "g_type_interface_instantiatable_prerequisite")
-	core.PuregoSafeRegister(&xTypeInterfacePeek, libs, "g_type_interface_peek")
-	core.PuregoSafeRegister(&xTypeInterfacePrerequisites, libs, "g_type_interface_prerequisites")
-	core.PuregoSafeRegister(&xTypeInterfaces, libs, "g_type_interfaces")
-	core.PuregoSafeRegister(&xTypeIsA, libs, "g_type_is_a")
-	core.PuregoSafeRegister(&xTypeName, libs, "g_type_name")
-	core.PuregoSafeRegister(&xTypeNameFromClass, libs, "g_type_name_from_class")
-	core.PuregoSafeRegister(&xTypeNameFromInstance, libs, "g_type_name_from_instance")
-	core.PuregoSafeRegister(&xTypeNextBase, libs, "g_type_next_base")
-	core.PuregoSafeRegister(&xTypeParent, libs, "g_type_parent")
-	core.PuregoSafeRegister(&xTypeQname, libs, "g_type_qname")
-	core.PuregoSafeRegister(&xNewTypeQuery, libs, "g_type_query")
-	core.PuregoSafeRegister(&xTypeRegisterDynamic, libs, "g_type_register_dynamic")
-	core.PuregoSafeRegister(&xTypeRegisterFundamental, libs, "g_type_register_fundamental")
-	core.PuregoSafeRegister(&xTypeRegisterStatic, libs, "g_type_register_static")
-	core.PuregoSafeRegister(&xTypeRegisterStaticSimple, libs, "g_type_register_static_simple")
-	core.PuregoSafeRegister(&xTypeRemoveClassCacheFunc, libs, "g_type_remove_class_cache_func")
-	core.PuregoSafeRegister(&xTypeRemoveInterfaceCheck, libs, "g_type_remove_interface_check")
-	core.PuregoSafeRegister(&xTypeSetQdata, libs, "g_type_set_qdata")
-	core.PuregoSafeRegister(&xTypeTestFlags, libs, "g_type_test_flags")
-	core.PuregoSafeRegister(&xTypeValueTablePeek, libs, "g_type_value_table_peek")
-
-	core.PuregoSafeRegister(&xTypeClassAddPrivate, libs, "g_type_class_add_private")
-	core.PuregoSafeRegister(&xTypeClassGetInstancePrivateOffset, libs, "g_type_class_get_instance_private_offset")
-	core.PuregoSafeRegister(&xTypeClassGetPrivate, libs, "g_type_class_get_private")
-	core.PuregoSafeRegister(&xTypeClassPeekParent, libs, "g_type_class_peek_parent")
-	core.PuregoSafeRegister(&xTypeClassUnref, libs, "g_type_class_unref")
-	core.PuregoSafeRegister(&xTypeClassUnrefUncached, libs, "g_type_class_unref_uncached")
-
-	core.PuregoSafeRegister(&xTypeInstanceGetPrivate, libs, "g_type_instance_get_private")
-
-	core.PuregoSafeRegister(&xTypeInterfacePeekParent, libs, "g_type_interface_peek_parent")
+	core.PuregoRegisterSince(&xTypeAddClassCacheFunc, libs, "g_type_add_class_cache_func", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeAddClassPrivate, libs, "g_type_add_class_private", "GOBJECT", "2.24")
+	core.PuregoRegisterSince(&xTypeAddInstancePrivate, libs, "g_type_add_instance_private", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeAddInterfaceCheck, libs, "g_type_add_interface_check", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeAddInterfaceDynamic, libs, "g_type_add_interface_dynamic", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeAddInterfaceStatic, libs, "g_type_add_interface_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckClassCast, libs, "g_type_check_class_cast", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckClassIsA, libs, "g_type_check_class_is_a", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckInstance, libs, "g_type_check_instance", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckInstanceCast, libs, "g_type_check_instance_cast", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckInstanceIsA, libs, "g_type_check_instance_is_a", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckInstanceIsFundamentallyA, libs, "g_type_check_instance_is_fundamentally_a", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckIsValueType, libs, "g_type_check_is_value_type", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckValue, libs, "g_type_check_value", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeCheckValueHolds, libs, "g_type_check_value_holds", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeChildren, libs, "g_type_children", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeClassAdjustPrivateOffset, libs, "g_type_class_adjust_private_offset", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeClassGet, libs, "g_type_class_get", "GOBJECT", "2.84")
+	core.PuregoRegisterSince(&xTypeClassPeek, libs, "g_type_class_peek", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeClassPeekStatic, libs, "g_type_class_peek_static", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeCreateInstance, libs, "g_type_create_instance", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeDefaultInterfaceGet, libs, "g_type_default_interface_get", "GOBJECT", "2.84")
+	core.PuregoRegisterSince(&xTypeDefaultInterfacePeek, libs, "g_type_default_interface_peek", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeDepth, libs, "g_type_depth", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeEnsure, libs, "g_type_ensure", "GOBJECT", "2.34")
+	core.PuregoRegisterSince(&xTypeFreeInstance, libs, "g_type_free_instance", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeFromName, libs, "g_type_from_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeFundamental, libs, "g_type_fundamental", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeFundamentalNext, libs, "g_type_fundamental_next", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeGetInstanceCount, libs, "g_type_get_instance_count", "GOBJECT", "2.44")
+	core.PuregoRegisterSince(&xTypeGetPlugin, libs, "g_type_get_plugin", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeGetQdata, libs, "g_type_get_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeGetTypeRegistrationSerial, libs, "g_type_get_type_registration_serial", "GOBJECT", "2.36")
+	core.PuregoRegisterSince(&xTypeInterfaceAddPrerequisite, libs, "g_type_interface_add_prerequisite", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeInterfaceGetPlugin, libs, "g_type_interface_get_plugin", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeInterfaceInstantiatablePrerequisite, libs, "g_type_interface_instantiatable_prerequisite", "GOBJECT", "2.68")
+	core.PuregoRegisterSince(&xTypeInterfacePeek, libs, "g_type_interface_peek", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeInterfacePrerequisites, libs, "g_type_interface_prerequisites", "GOBJECT", "2.2")
+	core.PuregoRegisterSince(&xTypeInterfaces, libs, "g_type_interfaces", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeIsA, libs, "g_type_is_a", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeName, libs, "g_type_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeNameFromClass, libs, "g_type_name_from_class", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeNameFromInstance, libs, "g_type_name_from_instance", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeNextBase, libs, "g_type_next_base", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeParent, libs, "g_type_parent", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeQname, libs, "g_type_qname", "GOBJECT", "")
+	core.PuregoRegisterSince(&xNewTypeQuery, libs, "g_type_query", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeRegisterDynamic, libs, "g_type_register_dynamic", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeRegisterFundamental, libs, "g_type_register_fundamental", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeRegisterStatic, libs, "g_type_register_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeRegisterStaticSimple, libs, "g_type_register_static_simple", "GOBJECT", "2.12")
+	core.PuregoRegisterSince(&xTypeRemoveClassCacheFunc, libs, "g_type_remove_class_cache_func", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeRemoveInterfaceCheck, libs, "g_type_remove_interface_check", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeSetQdata, libs, "g_type_set_qdata", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeTestFlags, libs, "g_type_test_flags", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeValueTablePeek, libs, "g_type_value_table_peek", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xTypeClassGetInstancePrivateOffset, libs, "g_type_class_get_instance_private_offset", "GOBJECT", "2.38")
+	core.PuregoRegisterSince(&xTypeClassGetPrivate, libs, "g_type_class_get_private", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeClassPeekParent, libs, "g_type_class_peek_parent", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xTypeInstanceGetPrivate, libs, "g_type_instance_get_private", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xTypeInterfacePeekParent, libs, "g_type_interface_peek_parent", "GOBJECT", "")
 
 }
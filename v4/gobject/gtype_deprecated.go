@@ -0,0 +1,207 @@
+//go:build !puregotk_no_deprecated
+
+// Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gobject
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xTypeClassAddPrivate func(uintptr, uint)
+
+// Registers a private structure for an instantiatable type.
+//
+// When an object is allocated, the private structures for
+// the type and all of its parent types are allocated
+// sequentially in the same memory block as the public
+// structures, and are zero-filled.
+//
+// Note that the accumulated size of the private structures of
+// a type and all its parent types cannot exceed 64 KiB.
+//
+// This function should be called in the type's class_init() function.
+// The private structure can be retrieved using the
+// G_TYPE_INSTANCE_GET_PRIVATE() macro.
+//
+// The following example shows attaching a private structure
+// MyObjectPrivate to an object MyObject defined in the standard
+// GObject fashion in the type's class_init() function.
+//
+// Note the use of a structure member "priv" to avoid the overhead
+// of repeatedly calling MY_OBJECT_GET_PRIVATE().
+//
+//	typedef struct _MyObject        MyObject;
+//	typedef struct _MyObjectPrivate MyObjectPrivate;
+//
+//	struct _MyObject {
+//	 GObject parent;
+//
+//	 MyObjectPrivate *priv;
+//	};
+//
+//	struct _MyObjectPrivate {
+//	  int some_field;
+//	};
+//
+//	static void
+//	my_object_class_init (MyObjectClass *klass)
+//	{
+//	  g_type_class_add_private (klass, sizeof (MyObjectPrivate));
+//	}
+//
+//	static void
+//	my_object_init (MyObject *my_object)
+//	{
+//	  my_object->priv = G_TYPE_INSTANCE_GET_PRIVATE (my_object,
+//	                                                 MY_TYPE_OBJECT,
+//	                                                 MyObjectPrivate);
+//	  // my_object->priv->some_field will be automatically initialised to 0
+//	}
+//
+//	static int
+//	my_object_get_some_field (MyObject *my_object)
+//	{
+//	  MyObjectPrivate *priv;
+//
+//	  g_return_val_if_fail (MY_IS_OBJECT (my_object), 0);
+//
+//	  priv = my_object->priv;
+//
+//	  return priv->some_field;
+//	}
+//
+// Deprecated: since 2.58. Use the G_ADD_PRIVATE() macro with the `G_DEFINE_*`
+//
+//	family of macros to add instance private data to a type
+func (x *TypeClass) AddPrivate(PrivateSizeVar uint) {
+
+	xTypeClassAddPrivate(x.GoPointer(), PrivateSizeVar)
+
+}
+
+var xTypeClassUnref func(uintptr)
+
+// Decrements the reference count of the class structure being passed in.
+//
+// Once the last reference count of a class has been released, classes
+// may be finalized by the type system, so further dereferencing of a
+// class pointer after [TypeClass.Unref] are invalid.
+//
+// Deprecated: since 2.84. Type class reference counting has been removed and type
+//
+//	classes now cannot be finalized. This function no longer does anything.
+func (x *TypeClass) Unref() {
+
+	xTypeClassUnref(x.GoPointer())
+
+}
+
+var xTypeClassUnrefUncached func(uintptr)
+
+// A variant of [TypeClass.Unref] for use in [TypeClassCacheFunc]
+// implementations.
+//
+// It unreferences a class without consulting the chain
+// of GTypeClassCacheFuncs, avoiding the recursion which would occur
+// otherwise.
+//
+// Deprecated: since 2.84. Type class reference counting has been removed and type
+//
+//	classes now cannot be finalized. This function no longer does anything.
+func (x *TypeClass) UnrefUncached() {
+
+	xTypeClassUnrefUncached(x.GoPointer())
+
+}
+
+var xTypeDefaultInterfaceRef func(types.GType) *TypeInterface
+
+// Increments the reference count for the interface type g_type,
+// and returns the default interface vtable for the type.
+//
+// If the type is not currently in use, then the default vtable
+// for the type will be created and initialized by calling
+// the base interface init and default vtable init functions for
+// the type (the base_init and class_init members of [TypeInfo]).
+// Calling [TypeDefaultInterfaceRef] is useful when you
+// want to make sure that signals and properties for an interface
+// have been installed.
+//
+// Deprecated: since 2.84. Use [TypeDefaultInterfaceGet] instead
+func TypeDefaultInterfaceRef(GTypeVar types.GType) *TypeInterface {
+
+	cret := xTypeDefaultInterfaceRef(GTypeVar)
+	return cret
+}
+
+var xTypeDefaultInterfaceUnref func(*TypeInterface)
+
+// Decrements the reference count for the type corresponding to the
+// interface default vtable g_iface.
+//
+// If the type is dynamic, then when no one is using the interface and all
+// references have been released, the finalize function for the interface's
+// default vtable (the class_finalize member of [TypeInfo]) will be called.
+//
+// Deprecated: since 2.84. Interface reference counting has been removed and
+//
+//	interface types now cannot be finalized. This function no longer does
+//	anything.
+func TypeDefaultInterfaceUnref(GIfaceVar *TypeInterface) {
+
+	xTypeDefaultInterfaceUnref(GIfaceVar)
+
+}
+
+var xTypeInit func()
+
+// This function used to initialise the type system.  Since GLib 2.36,
+// the type system is initialised automatically and this function does
+// nothing.
+//
+// Deprecated: since 2.36. the type system is now initialised automatically
+func TypeInit() {
+
+	xTypeInit()
+
+}
+
+var xTypeInitWithDebugFlags func(TypeDebugFlags)
+
+// This function used to initialise the type system with debugging
+// flags.  Since GLib 2.36, the type system is initialised automatically
+// and this function does nothing.
+//
+// If you need to enable debugging features, use the `GOBJECT_DEBUG`
+// environment variable.
+//
+// Deprecated: since 2.36. the type system is now initialised automatically
+func TypeInitWithDebugFlags(DebugFlagsVar TypeDebugFlags) {
+
+	xTypeInitWithDebugFlags(DebugFlagsVar)
+
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTypeDefaultInterfaceRef, libs, "g_type_default_interface_ref", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeDefaultInterfaceUnref, libs, "g_type_default_interface_unref", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeInit, libs, "g_type_init", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeInitWithDebugFlags, libs, "g_type_init_with_debug_flags", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xTypeClassAddPrivate, libs, "g_type_class_add_private", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xTypeClassUnref, libs, "g_type_class_unref", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeClassUnrefUncached, libs, "g_type_class_unref_uncached", "GOBJECT", "")
+
+}
@@ -5,13 +5,12 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// In order to implement dynamic loading of types based on #GTypeModule,
-// the @load and @unload functions in #GTypeModuleClass must be implemented.
+// In order to implement dynamic loading of types based on [TypeModule],
+// the load and unload functions in [TypeModuleClass] must be implemented.
 type TypeModuleClass struct {
 	_ structs.HostLayout
 
@@ -37,12 +36,13 @@ func (x *TypeModuleClass) GoPointer() uintptr {
 // OverrideLoad sets the "load" callback function.
 // loads the module and registers one or more types using
 //
-//	g_type_module_register_type().
+//	[TypeModule.RegisterType].
 func (x *TypeModuleClass) OverrideLoad(cb func(*TypeModule) bool) {
 	if cb == nil {
 		x.xLoad = 0
 	} else {
-		x.xLoad = purego.NewCallback(func(ModuleVarp uintptr) bool {
+		x.xLoad = core.NewCallback(func(ModuleVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(TypeModuleNewFromInternalPtr(ModuleVarp))
 		})
 	}
@@ -51,13 +51,13 @@ func (x *TypeModuleClass) OverrideLoad(cb func(*TypeModule) bool) {
 // GetLoad gets the "load" callback function.
 // loads the module and registers one or more types using
 //
-//	g_type_module_register_type().
+//	[TypeModule.RegisterType].
 func (x *TypeModuleClass) GetLoad() func(*TypeModule) bool {
 	if x.xLoad == 0 {
 		return nil
 	}
 	var rawCallback func(ModuleVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xLoad)
+	core.RegisterFunc(&rawCallback, x.xLoad)
 	return func(ModuleVar *TypeModule) bool {
 		return rawCallback(ModuleVar.GoPointer())
 	}
@@ -69,7 +69,8 @@ func (x *TypeModuleClass) OverrideUnload(cb func(*TypeModule)) {
 	if cb == nil {
 		x.xUnload = 0
 	} else {
-		x.xUnload = purego.NewCallback(func(ModuleVarp uintptr) {
+		x.xUnload = core.NewCallback(func(ModuleVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TypeModuleNewFromInternalPtr(ModuleVarp))
 		})
 	}
@@ -82,7 +83,7 @@ func (x *TypeModuleClass) GetUnload() func(*TypeModule) {
 		return nil
 	}
 	var rawCallback func(ModuleVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnload)
+	core.RegisterFunc(&rawCallback, x.xUnload)
 	return func(ModuleVar *TypeModule) {
 		rawCallback(ModuleVar.GoPointer())
 	}
@@ -93,7 +94,8 @@ func (x *TypeModuleClass) OverrideReserved1(cb func()) {
 	if cb == nil {
 		x.xReserved1 = 0
 	} else {
-		x.xReserved1 = purego.NewCallback(func() {
+		x.xReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -105,7 +107,7 @@ func (x *TypeModuleClass) GetReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved1)
+	core.RegisterFunc(&rawCallback, x.xReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -116,7 +118,8 @@ func (x *TypeModuleClass) OverrideReserved2(cb func()) {
 	if cb == nil {
 		x.xReserved2 = 0
 	} else {
-		x.xReserved2 = purego.NewCallback(func() {
+		x.xReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -128,7 +131,7 @@ func (x *TypeModuleClass) GetReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved2)
+	core.RegisterFunc(&rawCallback, x.xReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -139,7 +142,8 @@ func (x *TypeModuleClass) OverrideReserved3(cb func()) {
 	if cb == nil {
 		x.xReserved3 = 0
 	} else {
-		x.xReserved3 = purego.NewCallback(func() {
+		x.xReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -151,7 +155,7 @@ func (x *TypeModuleClass) GetReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved3)
+	core.RegisterFunc(&rawCallback, x.xReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -162,7 +166,8 @@ func (x *TypeModuleClass) OverrideReserved4(cb func()) {
 	if cb == nil {
 		x.xReserved4 = 0
 	} else {
-		x.xReserved4 = purego.NewCallback(func() {
+		x.xReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -174,7 +179,7 @@ func (x *TypeModuleClass) GetReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xReserved4)
+	core.RegisterFunc(&rawCallback, x.xReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -187,25 +192,25 @@ func (x *TypeModuleClass) GetReserved4() func() {
 // implements some number of types and interface implementations.
 //
 // When the module is loaded, it registers its types and interfaces
-// using [method@GObject.TypeModule.register_type] and
-// [method@GObject.TypeModule.add_interface].
+// using [TypeModule.RegisterType] and
+// [TypeModule.AddInterface].
 // As long as any instances of these types and interface implementations
 // are in use, the module is kept loaded. When the types and interfaces
 // are gone, the module may be unloaded. If the types and interfaces
 // become used again, the module will be reloaded. Note that the last
 // reference cannot be released from within the module code, since that
-// would lead to the caller's code being unloaded before `g_object_unref()`
+// would lead to the caller's code being unloaded before `[Object.Unref]`
 // returns to it.
 //
 // Keeping track of whether the module should be loaded or not is done by
 // using a use count - it starts at zero, and whenever it is greater than
 // zero, the module is loaded. The use count is maintained internally by
 // the type system, but also can be explicitly controlled by
-// [method@GObject.TypeModule.use] and [method@GObject.TypeModule.unuse].
-// Typically, when loading a module for the first type, `g_type_module_use()`
+// [TypeModule.Use] and [TypeModule.Unuse].
+// Typically, when loading a module for the first type, `[TypeModule.Use]`
 // will be used to load it so that it can initialize its types. At some later
 // point, when the module no longer needs to be loaded except for the type
-// implementations it contains, `g_type_module_unuse()` is called.
+// implementations it contains, `[TypeModule.Unuse]` is called.
 //
 // `GTypeModule` does not actually provide any implementation of module
 // loading and unloading. To create a particular module type you must
@@ -236,7 +241,7 @@ var xTypeModuleAddInterface func(uintptr, types.GType, types.GType, *InterfaceIn
 // As long as any instances of the type exist, the type plugin will
 // not be unloaded.
 //
-// Since 2.56 if @module is %NULL this will call g_type_add_interface_static()
+// Since 2.56 if module is nil this will call [TypeAddInterfaceStatic]
 // instead. This can be used when making a static build of the module.
 func (x *TypeModule) AddInterface(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, InterfaceInfoVar *InterfaceInfo) {
 
@@ -247,14 +252,14 @@ func (x *TypeModule) AddInterface(InstanceTypeVar types.GType, InterfaceTypeVar
 var xTypeModuleRegisterEnum func(uintptr, string, []EnumValue) types.GType
 
 // Looks up or registers an enumeration that is implemented with a particular
-// type plugin. If a type with name @type_name was previously registered,
-// the #GType identifier for the type is returned, otherwise the type
-// is newly registered, and the resulting #GType identifier returned.
+// type plugin. If a type with name type_name was previously registered,
+// the [Type] identifier for the type is returned, otherwise the type
+// is newly registered, and the resulting [Type] identifier returned.
 //
 // As long as any instances of the type exist, the type plugin will
 // not be unloaded.
 //
-// Since 2.56 if @module is %NULL this will call g_type_register_static()
+// Since 2.56 if module is nil this will call [TypeRegisterStatic]
 // instead. This can be used when making a static build of the module.
 func (x *TypeModule) RegisterEnum(NameVar string, ConstStaticValuesVar []EnumValue) types.GType {
 
@@ -265,14 +270,14 @@ func (x *TypeModule) RegisterEnum(NameVar string, ConstStaticValuesVar []EnumVal
 var xTypeModuleRegisterFlags func(uintptr, string, []FlagsValue) types.GType
 
 // Looks up or registers a flags type that is implemented with a particular
-// type plugin. If a type with name @type_name was previously registered,
-// the #GType identifier for the type is returned, otherwise the type
-// is newly registered, and the resulting #GType identifier returned.
+// type plugin. If a type with name type_name was previously registered,
+// the [Type] identifier for the type is returned, otherwise the type
+// is newly registered, and the resulting [Type] identifier returned.
 //
 // As long as any instances of the type exist, the type plugin will
 // not be unloaded.
 //
-// Since 2.56 if @module is %NULL this will call g_type_register_static()
+// Since 2.56 if module is nil this will call [TypeRegisterStatic]
 // instead. This can be used when making a static build of the module.
 func (x *TypeModule) RegisterFlags(NameVar string, ConstStaticValuesVar []FlagsValue) types.GType {
 
@@ -283,18 +288,18 @@ func (x *TypeModule) RegisterFlags(NameVar string, ConstStaticValuesVar []FlagsV
 var xTypeModuleRegisterType func(uintptr, types.GType, string, *TypeInfo, TypeFlags) types.GType
 
 // Looks up or registers a type that is implemented with a particular
-// type plugin. If a type with name @type_name was previously registered,
-// the #GType identifier for the type is returned, otherwise the type
-// is newly registered, and the resulting #GType identifier returned.
+// type plugin. If a type with name type_name was previously registered,
+// the [Type] identifier for the type is returned, otherwise the type
+// is newly registered, and the resulting [Type] identifier returned.
 //
 // When reregistering a type (typically because a module is unloaded
-// then reloaded, and reinitialized), @module and @parent_type must
+// then reloaded, and reinitialized), module and parent_type must
 // be the same as they were previously.
 //
 // As long as any instances of the type exist, the type plugin will
 // not be unloaded.
 //
-// Since 2.56 if @module is %NULL this will call g_type_register_static()
+// Since 2.56 if module is nil this will call [TypeRegisterStatic]
 // instead. This can be used when making a static build of the module.
 func (x *TypeModule) RegisterType(ParentTypeVar types.GType, TypeNameVar string, TypeInfoVar *TypeInfo, FlagsVar TypeFlags) types.GType {
 
@@ -304,7 +309,7 @@ func (x *TypeModule) RegisterType(ParentTypeVar types.GType, TypeNameVar string,
 
 var xTypeModuleSetName func(uintptr, string)
 
-// Sets the name for a #GTypeModule
+// Sets the name for a [TypeModule]
 func (x *TypeModule) SetName(NameVar string) {
 
 	xTypeModuleSetName(x.GoPointer(), NameVar)
@@ -313,10 +318,10 @@ func (x *TypeModule) SetName(NameVar string) {
 
 var xTypeModuleUnuse func(uintptr)
 
-// Decreases the use count of a #GTypeModule by one. If the
+// Decreases the use count of a [TypeModule] by one. If the
 // result is zero, the module will be unloaded. (However, the
-// #GTypeModule will not be freed, and types associated with the
-// #GTypeModule are not unregistered. Once a #GTypeModule is
+// [TypeModule] will not be freed, and types associated with the
+// [TypeModule] are not unregistered. Once a [TypeModule] is
 // initialized, it must exist forever.)
 func (x *TypeModule) Unuse() {
 
@@ -326,7 +331,7 @@ func (x *TypeModule) Unuse() {
 
 var xTypeModuleUse func(uintptr) bool
 
-// Increases the use count of a #GTypeModule by one. If the
+// Increases the use count of a [TypeModule] by one. If the
 // use count was zero before, the plugin will be loaded.
 // If loading the plugin fails, the use count is reset to
 // its prior value.
@@ -347,8 +352,8 @@ func (c *TypeModule) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Calls the @complete_interface_info function from the
-// #GTypePluginClass of @plugin. There should be no need to use this
+// Calls the complete_interface_info function from the
+// [TypePluginClass] of plugin. There should be no need to use this
 // function outside of the GObject type system itself.
 func (x *TypeModule) CompleteInterfaceInfo(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, InfoVar *InterfaceInfo) {
 
@@ -356,7 +361,7 @@ func (x *TypeModule) CompleteInterfaceInfo(InstanceTypeVar types.GType, Interfac
 
 }
 
-// Calls the @complete_type_info function from the #GTypePluginClass of @plugin.
+// Calls the complete_type_info function from the [TypePluginClass] of plugin.
 // There should be no need to use this function outside of the GObject
 // type system itself.
 func (x *TypeModule) CompleteTypeInfo(GTypeVar types.GType, InfoVar *TypeInfo, ValueTableVar *TypeValueTable) {
@@ -370,7 +375,7 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -379,12 +384,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xTypeModuleGLibType, libs, "g_type_module_get_type")
 
-	core.PuregoSafeRegister(&xTypeModuleAddInterface, libs, "g_type_module_add_interface")
-	core.PuregoSafeRegister(&xTypeModuleRegisterEnum, libs, "g_type_module_register_enum")
-	core.PuregoSafeRegister(&xTypeModuleRegisterFlags, libs, "g_type_module_register_flags")
-	core.PuregoSafeRegister(&xTypeModuleRegisterType, libs, "g_type_module_register_type")
-	core.PuregoSafeRegister(&xTypeModuleSetName, libs, "g_type_module_set_name")
-	core.PuregoSafeRegister(&xTypeModuleUnuse, libs, "g_type_module_unuse")
-	core.PuregoSafeRegister(&xTypeModuleUse, libs, "g_type_module_use")
+	CheckClassSize("GTypeModuleClass", xTypeModuleGLibType, unsafe.Sizeof(TypeModuleClass{}))
+
+	core.PuregoRegisterSince(&xTypeModuleAddInterface, libs, "g_type_module_add_interface", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeModuleRegisterEnum, libs, "g_type_module_register_enum", "GOBJECT", "2.6")
+	core.PuregoRegisterSince(&xTypeModuleRegisterFlags, libs, "g_type_module_register_flags", "GOBJECT", "2.6")
+	core.PuregoRegisterSince(&xTypeModuleRegisterType, libs, "g_type_module_register_type", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeModuleSetName, libs, "g_type_module_set_name", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeModuleUnuse, libs, "g_type_module_unuse", "GOBJECT", "")
+	core.PuregoRegisterSince(&xTypeModuleUse, libs, "g_type_module_use", "GOBJECT", "")
 
 }
@@ -5,30 +5,29 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of the @complete_interface_info function of #GTypePluginClass.
+// The type of the complete_interface_info function of [TypePluginClass].
 type TypePluginCompleteInterfaceInfo func(uintptr, types.GType, types.GType, *InterfaceInfo)
 
-// The type of the @complete_type_info function of #GTypePluginClass.
+// The type of the complete_type_info function of [TypePluginClass].
 type TypePluginCompleteTypeInfo func(uintptr, types.GType, *TypeInfo, *TypeValueTable)
 
-// The type of the @unuse_plugin function of #GTypePluginClass.
+// The type of the unuse_plugin function of [TypePluginClass].
 type TypePluginUnuse func(uintptr)
 
-// The type of the @use_plugin function of #GTypePluginClass, which gets called
-// to increase the use count of @plugin.
+// The type of the use_plugin function of [TypePluginClass], which gets called
+// to increase the use count of plugin.
 type TypePluginUse func(uintptr)
 
-// The #GTypePlugin interface is used by the type system in order to handle
+// The [TypePlugin] interface is used by the type system in order to handle
 // the lifecycle of dynamically loaded types.
 type TypePluginClass struct {
 	_ structs.HostLayout
 
-	BaseIface uintptr
+	BaseIface TypeInterface
 
 	UsePlugin TypePluginUse
 
@@ -51,44 +50,44 @@ func (x *TypePluginClass) GoPointer() uintptr {
 //  1. The type is initially introduced (usually upon loading the module
 //     the first time, or by your main application that knows what modules
 //     introduces what types), like this:
-//     ```c
+//
 //     new_type_id = g_type_register_dynamic (parent_type_id,
 //     "TypeName",
 //     new_type_plugin,
 //     type_flags);
-//     ```
+//
 //     where `new_type_plugin` is an implementation of the
 //     `GTypePlugin` interface.
 //
 //  2. The type's implementation is referenced, e.g. through
-//     [func@GObject.TypeClass.ref] or through [func@GObject.type_create_instance]
-//     (this is being called by [ctor@GObject.Object.new]) or through one of the above
+//     TypeClass.ref or through [TypeCreateInstance]
+//     (this is being called by [NewObject]) or through one of the above
 //     done on a type derived from `new_type_id`.
 //
 //  3. This causes the type system to load the type's implementation by calling
-//     [method@GObject.TypePlugin.use] and [method@GObject.TypePlugin.complete_type_info]
+//     [TypePlugin.Use] and [TypePlugin.CompleteTypeInfo]
 //     on `new_type_plugin`.
 //
 //  4. At some point the type's implementation isn't required anymore, e.g. after
-//     [method@GObject.TypeClass.unref] or [func@GObject.type_free_instance]
+//     [TypeClass.Unref] or [TypeFreeInstance]
 //     (called when the reference count of an instance drops to zero).
 //
 //  5. This causes the type system to throw away the information retrieved
-//     from [method@GObject.TypePlugin.complete_type_info] and then it calls
-//     [method@GObject.TypePlugin.unuse] on `new_type_plugin`.
+//     from [TypePlugin.CompleteTypeInfo] and then it calls
+//     [TypePlugin.Unuse] on `new_type_plugin`.
 //
 // 6. Things may repeat from the second step.
 //
 // So basically, you need to implement a `GTypePlugin` type that
 // carries a use_count, once use_count goes from zero to one, you need
 // to load the implementation to successfully handle the upcoming
-// [method@GObject.TypePlugin.complete_type_info] call. Later, maybe after
+// [TypePlugin.CompleteTypeInfo] call. Later, maybe after
 // succeeding use/unuse calls, once use_count drops to zero, you can
 // unload the implementation again. The type system makes sure to call
-// [method@GObject.TypePlugin.use] and [method@GObject.TypePlugin.complete_type_info]
+// [TypePlugin.Use] and [TypePlugin.CompleteTypeInfo]
 // again when the type is needed again.
 //
-// [class@GObject.TypeModule] is an implementation of `GTypePlugin` that
+// [TypeModule] is an implementation of `GTypePlugin` that
 // already implements most of this except for the actual module loading and
 // unloading. It even handles multiple registered types per module.
 type TypePlugin interface {
@@ -121,8 +120,8 @@ func (x *TypePluginBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Calls the @complete_interface_info function from the
-// #GTypePluginClass of @plugin. There should be no need to use this
+// Calls the complete_interface_info function from the
+// [TypePluginClass] of plugin. There should be no need to use this
 // function outside of the GObject type system itself.
 func (x *TypePluginBase) CompleteInterfaceInfo(InstanceTypeVar types.GType, InterfaceTypeVar types.GType, InfoVar *InterfaceInfo) {
 
@@ -130,7 +129,7 @@ func (x *TypePluginBase) CompleteInterfaceInfo(InstanceTypeVar types.GType, Inte
 
 }
 
-// Calls the @complete_type_info function from the #GTypePluginClass of @plugin.
+// Calls the complete_type_info function from the [TypePluginClass] of plugin.
 // There should be no need to use this function outside of the GObject
 // type system itself.
 func (x *TypePluginBase) CompleteTypeInfo(GTypeVar types.GType, InfoVar *TypeInfo, ValueTableVar *TypeValueTable) {
@@ -139,8 +138,8 @@ func (x *TypePluginBase) CompleteTypeInfo(GTypeVar types.GType, InfoVar *TypeInf
 
 }
 
-// Calls the @unuse_plugin function from the #GTypePluginClass of
-// @plugin.  There should be no need to use this function outside of
+// Calls the unuse_plugin function from the [TypePluginClass] of
+// plugin.  There should be no need to use this function outside of
 // the GObject type system itself.
 func (x *TypePluginBase) Unuse() {
 
@@ -148,8 +147,8 @@ func (x *TypePluginBase) Unuse() {
 
 }
 
-// Calls the @use_plugin function from the #GTypePluginClass of
-// @plugin.  There should be no need to use this function outside of
+// Calls the use_plugin function from the [TypePluginClass] of
+// plugin.  There should be no need to use this function outside of
 // the GObject type system itself.
 func (x *TypePluginBase) Use() {
 
@@ -167,7 +166,7 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -176,9 +175,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xTypePluginGLibType, libs, "g_type_plugin_get_type")
 
-	core.PuregoSafeRegister(&XGTypePluginCompleteInterfaceInfo, libs, "g_type_plugin_complete_interface_info")
-	core.PuregoSafeRegister(&XGTypePluginCompleteTypeInfo, libs, "g_type_plugin_complete_type_info")
-	core.PuregoSafeRegister(&XGTypePluginUnuse, libs, "g_type_plugin_unuse")
-	core.PuregoSafeRegister(&XGTypePluginUse, libs, "g_type_plugin_use")
+	core.PuregoRegisterSince(&XGTypePluginCompleteInterfaceInfo, libs, "g_type_plugin_complete_interface_info", "GOBJECT", "")
+	core.PuregoRegisterSince(&XGTypePluginCompleteTypeInfo, libs, "g_type_plugin_complete_type_info", "GOBJECT", "")
+	core.PuregoRegisterSince(&XGTypePluginUnuse, libs, "g_type_plugin_unuse", "GOBJECT", "")
+	core.PuregoRegisterSince(&XGTypePluginUse, libs, "g_type_plugin_use", "GOBJECT", "")
 
 }
@@ -5,36 +5,35 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // The type of value transformation functions which can be registered with
-// [func@GObject.Value.register_transform_func].
+// [ValueRegisterTransformFunc].
 //
-// @dest_value will be initialized to the correct destination type.
+// dest_value will be initialized to the correct destination type.
 type ValueTransform func(*Value, *Value)
 
 // An opaque structure used to hold different types of values.
 //
 // Before it can be used, a `GValue` has to be initialized to a specific type by
-// calling [method@GObject.Value.init] on it.
+// calling [Value.Init] on it.
 //
 // Many types which are stored within a `GValue` need to allocate data on the
-// heap, so [method@GObject.Value.unset] must always be called on a `GValue` to
+// heap, so [Value.Unset] must always be called on a `GValue` to
 // free any such data once you’re finished with the `GValue`, even if the
 // `GValue` itself is stored on the stack.
 //
 // The data within the structure has protected scope: it is accessible only
-// to functions within a [struct@GObject.TypeValueTable] structure, or
+// to functions within a [TypeValueTable] structure, or
 // implementations of the `g_value_*()` API. That is, code which implements new
 // fundamental types.
 //
 // `GValue` users cannot make any assumptions about how data is stored
-// within the 2 element @data union, and the @g_type member should
-// only be accessed through the [func@GObject.VALUE_TYPE] macro and related
+// within the 2 element data union, and the g_type member should
+// only be accessed through the VALUE_TYPE macro and related
 // macros.
 type Value struct {
 	_ structs.HostLayout
@@ -54,9 +53,22 @@ func (x *Value) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Free releases the Value with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Value) Free() {
+	BoxedFree(ValueGLibType(), x.GoPointer())
+}
+
+// NewValueAlloc returns a zeroed Value allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated Value. Release it with Free.
+func NewValueAlloc() *Value {
+	cret := core.GMalloc0(max(unsafe.Sizeof(Value{}), 24))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Value)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xValueCopy func(uintptr, *Value)
 
-// Copies the value of @src_value into @dest_value.
+// Copies the value of src_value into dest_value.
 func (x *Value) Copy(DestValueVar *Value) {
 
 	xValueCopy(x.GoPointer(), DestValueVar)
@@ -65,9 +77,9 @@ func (x *Value) Copy(DestValueVar *Value) {
 
 var xValueDupBoxed func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_BOXED derived #GValue.  Upon getting,
+// Get the contents of a G_TYPE_BOXED derived [Value].  Upon getting,
 // the boxed value is duplicated and needs to be later freed with
-// g_boxed_free(), e.g. like: g_boxed_free (G_VALUE_TYPE (@value),
+// [BoxedFree], e.g. like: g_boxed_free (G_VALUE_TYPE (value),
 // return_value);
 func (x *Value) DupBoxed() uintptr {
 
@@ -77,9 +89,9 @@ func (x *Value) DupBoxed() uintptr {
 
 var xValueDupObject func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_OBJECT derived #GValue, increasing
-// its reference count. If the contents of the #GValue are %NULL, then
-// %NULL will be returned.
+// Get the contents of a G_TYPE_OBJECT derived [Value], increasing
+// its reference count. If the contents of the [Value] are nil, then
+// nil will be returned.
 func (x *Value) DupObject() *Object {
 	var cls *Object
 
@@ -95,7 +107,7 @@ func (x *Value) DupObject() *Object {
 
 var xValueDupParam func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_PARAM #GValue, increasing its
+// Get the contents of a G_TYPE_PARAM [Value], increasing its
 // reference count.
 func (x *Value) DupParam() *ParamSpec {
 	var cls *ParamSpec
@@ -112,7 +124,7 @@ func (x *Value) DupParam() *ParamSpec {
 
 var xValueDupString func(uintptr) string
 
-// Get a copy the contents of a %G_TYPE_STRING #GValue.
+// Get a copy the contents of a G_TYPE_STRING [Value].
 func (x *Value) DupString() string {
 
 	cret := xValueDupString(x.GoPointer())
@@ -121,8 +133,8 @@ func (x *Value) DupString() string {
 
 var xValueDupVariant func(uintptr) *glib.Variant
 
-// Get the contents of a variant #GValue, increasing its refcount. The returned
-// #GVariant is never floating.
+// Get the contents of a variant [Value], increasing its refcount. The returned
+// [glib.Variant] is never floating.
 func (x *Value) DupVariant() *glib.Variant {
 
 	cret := xValueDupVariant(x.GoPointer())
@@ -131,7 +143,7 @@ func (x *Value) DupVariant() *glib.Variant {
 
 var xValueFitsPointer func(uintptr) bool
 
-// Determines if @value will fit inside the size of a pointer value.
+// Determines if value will fit inside the size of a pointer value.
 //
 // This is an internal function introduced mainly for C marshallers.
 func (x *Value) FitsPointer() bool {
@@ -142,7 +154,7 @@ func (x *Value) FitsPointer() bool {
 
 var xValueGetBoolean func(uintptr) bool
 
-// Get the contents of a %G_TYPE_BOOLEAN #GValue.
+// Get the contents of a G_TYPE_BOOLEAN [Value].
 func (x *Value) GetBoolean() bool {
 
 	cret := xValueGetBoolean(x.GoPointer())
@@ -151,28 +163,16 @@ func (x *Value) GetBoolean() bool {
 
 var xValueGetBoxed func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_BOXED derived #GValue.
+// Get the contents of a G_TYPE_BOXED derived [Value].
 func (x *Value) GetBoxed() uintptr {
 
 	cret := xValueGetBoxed(x.GoPointer())
 	return cret
 }
 
-var xValueGetChar func(uintptr) byte
-
-// Do not use this function; it is broken on platforms where the %char
-// type is unsigned, such as ARM and PowerPC.  See g_value_get_schar().
-//
-// Get the contents of a %G_TYPE_CHAR #GValue.
-func (x *Value) GetChar() byte {
-
-	cret := xValueGetChar(x.GoPointer())
-	return cret
-}
-
 var xValueGetDouble func(uintptr) float64
 
-// Get the contents of a %G_TYPE_DOUBLE #GValue.
+// Get the contents of a G_TYPE_DOUBLE [Value].
 func (x *Value) GetDouble() float64 {
 
 	cret := xValueGetDouble(x.GoPointer())
@@ -181,7 +181,7 @@ func (x *Value) GetDouble() float64 {
 
 var xValueGetEnum func(uintptr) int
 
-// Get the contents of a %G_TYPE_ENUM #GValue.
+// Get the contents of a G_TYPE_ENUM [Value].
 func (x *Value) GetEnum() int {
 
 	cret := xValueGetEnum(x.GoPointer())
@@ -190,7 +190,7 @@ func (x *Value) GetEnum() int {
 
 var xValueGetFlags func(uintptr) uint
 
-// Get the contents of a %G_TYPE_FLAGS #GValue.
+// Get the contents of a G_TYPE_FLAGS [Value].
 func (x *Value) GetFlags() uint {
 
 	cret := xValueGetFlags(x.GoPointer())
@@ -199,7 +199,7 @@ func (x *Value) GetFlags() uint {
 
 var xValueGetFloat func(uintptr) float32
 
-// Get the contents of a %G_TYPE_FLOAT #GValue.
+// Get the contents of a G_TYPE_FLOAT [Value].
 func (x *Value) GetFloat() float32 {
 
 	cret := xValueGetFloat(x.GoPointer())
@@ -208,7 +208,7 @@ func (x *Value) GetFloat() float32 {
 
 var xValueGetGtype func(uintptr) types.GType
 
-// Get the contents of a %G_TYPE_GTYPE #GValue.
+// Get the contents of a G_TYPE_GTYPE [Value].
 func (x *Value) GetGtype() types.GType {
 
 	cret := xValueGetGtype(x.GoPointer())
@@ -217,7 +217,7 @@ func (x *Value) GetGtype() types.GType {
 
 var xValueGetInt func(uintptr) int
 
-// Get the contents of a %G_TYPE_INT #GValue.
+// Get the contents of a G_TYPE_INT [Value].
 func (x *Value) GetInt() int {
 
 	cret := xValueGetInt(x.GoPointer())
@@ -226,17 +226,17 @@ func (x *Value) GetInt() int {
 
 var xValueGetInt64 func(uintptr) int64
 
-// Get the contents of a %G_TYPE_INT64 #GValue.
+// Get the contents of a G_TYPE_INT64 [Value].
 func (x *Value) GetInt64() int64 {
 
 	cret := xValueGetInt64(x.GoPointer())
 	return cret
 }
 
-var xValueGetLong func(uintptr) int
+var xValueGetLong func(uintptr) types.Long
 
-// Get the contents of a %G_TYPE_LONG #GValue.
-func (x *Value) GetLong() int {
+// Get the contents of a G_TYPE_LONG [Value].
+func (x *Value) GetLong() types.Long {
 
 	cret := xValueGetLong(x.GoPointer())
 	return cret
@@ -244,7 +244,7 @@ func (x *Value) GetLong() int {
 
 var xValueGetObject func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_OBJECT derived #GValue.
+// Get the contents of a G_TYPE_OBJECT derived [Value].
 func (x *Value) GetObject() *Object {
 	var cls *Object
 
@@ -261,7 +261,7 @@ func (x *Value) GetObject() *Object {
 
 var xValueGetParam func(uintptr) uintptr
 
-// Get the contents of a %G_TYPE_PARAM #GValue.
+// Get the contents of a G_TYPE_PARAM [Value].
 func (x *Value) GetParam() *ParamSpec {
 	var cls *ParamSpec
 
@@ -278,7 +278,7 @@ func (x *Value) GetParam() *ParamSpec {
 
 var xValueGetPointer func(uintptr) uintptr
 
-// Get the contents of a pointer #GValue.
+// Get the contents of a pointer [Value].
 func (x *Value) GetPointer() uintptr {
 
 	cret := xValueGetPointer(x.GoPointer())
@@ -287,7 +287,7 @@ func (x *Value) GetPointer() uintptr {
 
 var xValueGetSchar func(uintptr) int8
 
-// Get the contents of a %G_TYPE_CHAR #GValue.
+// Get the contents of a G_TYPE_CHAR [Value].
 func (x *Value) GetSchar() int8 {
 
 	cret := xValueGetSchar(x.GoPointer())
@@ -296,7 +296,7 @@ func (x *Value) GetSchar() int8 {
 
 var xValueGetString func(uintptr) string
 
-// Get the contents of a %G_TYPE_STRING #GValue.
+// Get the contents of a G_TYPE_STRING [Value].
 func (x *Value) GetString() string {
 
 	cret := xValueGetString(x.GoPointer())
@@ -305,7 +305,7 @@ func (x *Value) GetString() string {
 
 var xValueGetUchar func(uintptr) byte
 
-// Get the contents of a %G_TYPE_UCHAR #GValue.
+// Get the contents of a G_TYPE_UCHAR [Value].
 func (x *Value) GetUchar() byte {
 
 	cret := xValueGetUchar(x.GoPointer())
@@ -314,7 +314,7 @@ func (x *Value) GetUchar() byte {
 
 var xValueGetUint func(uintptr) uint
 
-// Get the contents of a %G_TYPE_UINT #GValue.
+// Get the contents of a G_TYPE_UINT [Value].
 func (x *Value) GetUint() uint {
 
 	cret := xValueGetUint(x.GoPointer())
@@ -323,17 +323,17 @@ func (x *Value) GetUint() uint {
 
 var xValueGetUint64 func(uintptr) uint64
 
-// Get the contents of a %G_TYPE_UINT64 #GValue.
+// Get the contents of a G_TYPE_UINT64 [Value].
 func (x *Value) GetUint64() uint64 {
 
 	cret := xValueGetUint64(x.GoPointer())
 	return cret
 }
 
-var xValueGetUlong func(uintptr) uint
+var xValueGetUlong func(uintptr) types.ULong
 
-// Get the contents of a %G_TYPE_ULONG #GValue.
-func (x *Value) GetUlong() uint {
+// Get the contents of a G_TYPE_ULONG [Value].
+func (x *Value) GetUlong() types.ULong {
 
 	cret := xValueGetUlong(x.GoPointer())
 	return cret
@@ -341,7 +341,7 @@ func (x *Value) GetUlong() uint {
 
 var xValueGetVariant func(uintptr) *glib.Variant
 
-// Get the contents of a variant #GValue.
+// Get the contents of a variant [Value].
 func (x *Value) GetVariant() *glib.Variant {
 
 	cret := xValueGetVariant(x.GoPointer())
@@ -350,21 +350,17 @@ func (x *Value) GetVariant() *glib.Variant {
 
 var xValueInit func(uintptr, types.GType) *Value
 
-// Initializes @value to store values of the given @type, and sets its value
-// to the default for @type.
+// Initializes value to store values of the given type, and sets its value
+// to the default for type.
 //
-// This must be called before any other methods on a [struct@GObject.Value], so
+// This must be called before any other methods on a [Value], so
 // the value knows what type it’s meant to store.
 //
-// ```c
-//
 //	GValue value = G_VALUE_INIT;
 //
-//	g_value_init (&amp;value, SOME_G_TYPE);
+//	g_value_init (&value, SOME_G_TYPE);
 //	…
-//	g_value_unset (&amp;value);
-//
-// ```
+//	g_value_unset (&value);
 func (x *Value) Init(GTypeVar types.GType) *Value {
 
 	cret := xValueInit(x.GoPointer(), GTypeVar)
@@ -373,15 +369,15 @@ func (x *Value) Init(GTypeVar types.GType) *Value {
 
 var xValueInitFromInstance func(uintptr, *TypeInstance)
 
-// Initializes and sets @value from an instantiatable type.
+// Initializes and sets value from an instantiatable type.
 //
-// This calls the [callback@GObject.TypeValueCollectFunc] function for the type
-// the [struct@GObject.Value] contains.
+// This calls the [TypeValueCollectFunc] function for the type
+// the [Value] contains.
 //
-// Note: The @value will be initialised with the exact type of
-// @instance.  If you wish to set the @value’s type to a different
-// [type@GObject.Type] (such as a parent class type), you need to manually call
-// [method@GObject.Value.init] and [method@GObject.Value.set_instance].
+// Note: The value will be initialised with the exact type of
+// instance.  If you wish to set the value’s type to a different
+// [Type] (such as a parent class type), you need to manually call
+// [Value.Init] and [Value.SetInstance].
 func (x *Value) InitFromInstance(InstanceVar *TypeInstance) {
 
 	xValueInitFromInstance(x.GoPointer(), InstanceVar)
@@ -392,7 +388,7 @@ var xValuePeekPointer func(uintptr) uintptr
 
 // Returns the value contents as a pointer.
 //
-// This function asserts that [method@GObject.Value.fits_pointer] returned true
+// This function asserts that [Value.FitsPointer] returned true
 // for the passed in value.
 //
 // This is an internal function introduced mainly for C marshallers.
@@ -404,9 +400,9 @@ func (x *Value) PeekPointer() uintptr {
 
 var xValueReset func(uintptr) *Value
 
-// Clears the current value in @value and resets it to the default value
+// Clears the current value in value and resets it to the default value
 // (as if the value had just been initialized using
-// [method@GObject.Value.init]).
+// [Value.Init]).
 func (x *Value) Reset() *Value {
 
 	cret := xValueReset(x.GoPointer())
@@ -415,7 +411,7 @@ func (x *Value) Reset() *Value {
 
 var xValueSetBoolean func(uintptr, bool)
 
-// Set the contents of a %G_TYPE_BOOLEAN #GValue to @v_boolean.
+// Set the contents of a G_TYPE_BOOLEAN [Value] to v_boolean.
 func (x *Value) SetBoolean(VBooleanVar bool) {
 
 	xValueSetBoolean(x.GoPointer(), VBooleanVar)
@@ -424,34 +420,16 @@ func (x *Value) SetBoolean(VBooleanVar bool) {
 
 var xValueSetBoxed func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_BOXED derived #GValue to @v_boxed.
+// Set the contents of a G_TYPE_BOXED derived [Value] to v_boxed.
 func (x *Value) SetBoxed(VBoxedVar uintptr) {
 
 	xValueSetBoxed(x.GoPointer(), VBoxedVar)
 
 }
 
-var xValueSetBoxedTakeOwnership func(uintptr, uintptr)
-
-// This is an internal function introduced mainly for C marshallers.
-func (x *Value) SetBoxedTakeOwnership(VBoxedVar uintptr) {
-
-	xValueSetBoxedTakeOwnership(x.GoPointer(), VBoxedVar)
-
-}
-
-var xValueSetChar func(uintptr, byte)
-
-// Set the contents of a %G_TYPE_CHAR #GValue to @v_char.
-func (x *Value) SetChar(VCharVar byte) {
-
-	xValueSetChar(x.GoPointer(), VCharVar)
-
-}
-
 var xValueSetDouble func(uintptr, float64)
 
-// Set the contents of a %G_TYPE_DOUBLE #GValue to @v_double.
+// Set the contents of a G_TYPE_DOUBLE [Value] to v_double.
 func (x *Value) SetDouble(VDoubleVar float64) {
 
 	xValueSetDouble(x.GoPointer(), VDoubleVar)
@@ -460,7 +438,7 @@ func (x *Value) SetDouble(VDoubleVar float64) {
 
 var xValueSetEnum func(uintptr, int)
 
-// Set the contents of a %G_TYPE_ENUM #GValue to @v_enum.
+// Set the contents of a G_TYPE_ENUM [Value] to v_enum.
 func (x *Value) SetEnum(VEnumVar int) {
 
 	xValueSetEnum(x.GoPointer(), VEnumVar)
@@ -469,7 +447,7 @@ func (x *Value) SetEnum(VEnumVar int) {
 
 var xValueSetFlags func(uintptr, uint)
 
-// Set the contents of a %G_TYPE_FLAGS #GValue to @v_flags.
+// Set the contents of a G_TYPE_FLAGS [Value] to v_flags.
 func (x *Value) SetFlags(VFlagsVar uint) {
 
 	xValueSetFlags(x.GoPointer(), VFlagsVar)
@@ -478,7 +456,7 @@ func (x *Value) SetFlags(VFlagsVar uint) {
 
 var xValueSetFloat func(uintptr, float32)
 
-// Set the contents of a %G_TYPE_FLOAT #GValue to @v_float.
+// Set the contents of a G_TYPE_FLOAT [Value] to v_float.
 func (x *Value) SetFloat(VFloatVar float32) {
 
 	xValueSetFloat(x.GoPointer(), VFloatVar)
@@ -487,7 +465,7 @@ func (x *Value) SetFloat(VFloatVar float32) {
 
 var xValueSetGtype func(uintptr, types.GType)
 
-// Set the contents of a %G_TYPE_GTYPE #GValue to @v_gtype.
+// Set the contents of a G_TYPE_GTYPE [Value] to v_gtype.
 func (x *Value) SetGtype(VGtypeVar types.GType) {
 
 	xValueSetGtype(x.GoPointer(), VGtypeVar)
@@ -496,10 +474,10 @@ func (x *Value) SetGtype(VGtypeVar types.GType) {
 
 var xValueSetInstance func(uintptr, uintptr)
 
-// Sets @value from an instantiatable type.
+// Sets value from an instantiatable type.
 //
-// This calls the [callback@GObject.TypeValueCollectFunc] function for the type
-// the [struct@GObject.Value] contains.
+// This calls the [TypeValueCollectFunc] function for the type
+// the [Value] contains.
 func (x *Value) SetInstance(InstanceVar uintptr) {
 
 	xValueSetInstance(x.GoPointer(), InstanceVar)
@@ -508,7 +486,7 @@ func (x *Value) SetInstance(InstanceVar uintptr) {
 
 var xValueSetInt func(uintptr, int)
 
-// Set the contents of a %G_TYPE_INT #GValue to @v_int.
+// Set the contents of a G_TYPE_INT [Value] to v_int.
 func (x *Value) SetInt(VIntVar int) {
 
 	xValueSetInt(x.GoPointer(), VIntVar)
@@ -517,7 +495,7 @@ func (x *Value) SetInt(VIntVar int) {
 
 var xValueSetInt64 func(uintptr, int64)
 
-// Set the contents of a %G_TYPE_INT64 #GValue to @v_int64.
+// Set the contents of a G_TYPE_INT64 [Value] to v_int64.
 func (x *Value) SetInt64(VInt64Var int64) {
 
 	xValueSetInt64(x.GoPointer(), VInt64Var)
@@ -526,9 +504,9 @@ func (x *Value) SetInt64(VInt64Var int64) {
 
 var xValueSetInternedString func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_STRING #GValue to @v_string.  The string is
+// Set the contents of a G_TYPE_STRING [Value] to v_string.  The string is
 // assumed to be static and interned (canonical, for example from
-// g_intern_string()), and is thus not duplicated when setting the #GValue.
+// [glib.InternString]), and is thus not duplicated when setting the [Value].
 func (x *Value) SetInternedString(VStringVar *string) {
 
 	VStringVarPtr := core.GStrdupNullable(VStringVar)
@@ -538,10 +516,10 @@ func (x *Value) SetInternedString(VStringVar *string) {
 
 }
 
-var xValueSetLong func(uintptr, int)
+var xValueSetLong func(uintptr, types.Long)
 
-// Set the contents of a %G_TYPE_LONG #GValue to @v_long.
-func (x *Value) SetLong(VLongVar int) {
+// Set the contents of a G_TYPE_LONG [Value] to v_long.
+func (x *Value) SetLong(VLongVar types.Long) {
 
 	xValueSetLong(x.GoPointer(), VLongVar)
 
@@ -549,53 +527,35 @@ func (x *Value) SetLong(VLongVar int) {
 
 var xValueSetObject func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_OBJECT derived #GValue to @v_object.
+// Set the contents of a G_TYPE_OBJECT derived [Value] to v_object.
 //
-// g_value_set_object() increases the reference count of @v_object
-// (the #GValue holds a reference to @v_object).  If you do not wish
+// [Value.SetObject] increases the reference count of v_object
+// (the [Value] holds a reference to v_object).  If you do not wish
 // to increase the reference count of the object (i.e. you wish to
-// pass your current reference to the #GValue because you no longer
-// need it), use g_value_take_object() instead.
+// pass your current reference to the [Value] because you no longer
+// need it), use [Value.TakeObject] instead.
 //
-// It is important that your #GValue holds a reference to @v_object (either its
+// It is important that your [Value] holds a reference to v_object (either its
 // own, or one it has taken) to ensure that the object won't be destroyed while
-// the #GValue still exists).
+// the [Value] still exists).
 func (x *Value) SetObject(VObjectVar *Object) {
 
 	xValueSetObject(x.GoPointer(), VObjectVar.GoPointer())
 
 }
 
-var xValueSetObjectTakeOwnership func(uintptr, uintptr)
-
-// This is an internal function introduced mainly for C marshallers.
-func (x *Value) SetObjectTakeOwnership(VObjectVar uintptr) {
-
-	xValueSetObjectTakeOwnership(x.GoPointer(), VObjectVar)
-
-}
-
 var xValueSetParam func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_PARAM #GValue to @param.
+// Set the contents of a G_TYPE_PARAM [Value] to param.
 func (x *Value) SetParam(ParamVar *ParamSpec) {
 
 	xValueSetParam(x.GoPointer(), ParamVar.GoPointer())
 
 }
 
-var xValueSetParamTakeOwnership func(uintptr, uintptr)
-
-// This is an internal function introduced mainly for C marshallers.
-func (x *Value) SetParamTakeOwnership(ParamVar *ParamSpec) {
-
-	xValueSetParamTakeOwnership(x.GoPointer(), ParamVar.GoPointer())
-
-}
-
 var xValueSetPointer func(uintptr, uintptr)
 
-// Set the contents of a pointer #GValue to @v_pointer.
+// Set the contents of a pointer [Value] to v_pointer.
 func (x *Value) SetPointer(VPointerVar uintptr) {
 
 	xValueSetPointer(x.GoPointer(), VPointerVar)
@@ -604,7 +564,7 @@ func (x *Value) SetPointer(VPointerVar uintptr) {
 
 var xValueSetSchar func(uintptr, int8)
 
-// Set the contents of a %G_TYPE_CHAR #GValue to @v_char.
+// Set the contents of a G_TYPE_CHAR [Value] to v_char.
 func (x *Value) SetSchar(VCharVar int8) {
 
 	xValueSetSchar(x.GoPointer(), VCharVar)
@@ -613,10 +573,10 @@ func (x *Value) SetSchar(VCharVar int8) {
 
 var xValueSetStaticBoxed func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_BOXED derived #GValue to @v_boxed.
+// Set the contents of a G_TYPE_BOXED derived [Value] to v_boxed.
 //
 // The boxed value is assumed to be static, and is thus not duplicated
-// when setting the #GValue.
+// when setting the [Value].
 func (x *Value) SetStaticBoxed(VBoxedVar uintptr) {
 
 	xValueSetStaticBoxed(x.GoPointer(), VBoxedVar)
@@ -625,11 +585,11 @@ func (x *Value) SetStaticBoxed(VBoxedVar uintptr) {
 
 var xValueSetStaticString func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_STRING #GValue to @v_string.
+// Set the contents of a G_TYPE_STRING [Value] to v_string.
 // The string is assumed to be static, and is thus not duplicated
-// when setting the #GValue.
+// when setting the [Value].
 //
-// If the the string is a canonical string, using g_value_set_interned_string()
+// If the the string is a canonical string, using [Value.SetInternedString]
 // is more appropriate.
 func (x *Value) SetStaticString(VStringVar *string) {
 
@@ -642,7 +602,7 @@ func (x *Value) SetStaticString(VStringVar *string) {
 
 var xValueSetString func(uintptr, uintptr)
 
-// Set the contents of a %G_TYPE_STRING #GValue to a copy of @v_string.
+// Set the contents of a G_TYPE_STRING [Value] to a copy of v_string.
 func (x *Value) SetString(VStringVar *string) {
 
 	VStringVarPtr := core.GStrdupNullable(VStringVar)
@@ -652,21 +612,9 @@ func (x *Value) SetString(VStringVar *string) {
 
 }
 
-var xValueSetStringTakeOwnership func(uintptr, uintptr)
-
-// This is an internal function introduced mainly for C marshallers.
-func (x *Value) SetStringTakeOwnership(VStringVar *string) {
-
-	VStringVarPtr := core.GStrdupNullable(VStringVar)
-	defer core.GFreeNullable(VStringVarPtr)
-
-	xValueSetStringTakeOwnership(x.GoPointer(), VStringVarPtr)
-
-}
-
 var xValueSetUchar func(uintptr, byte)
 
-// Set the contents of a %G_TYPE_UCHAR #GValue to @v_uchar.
+// Set the contents of a G_TYPE_UCHAR [Value] to v_uchar.
 func (x *Value) SetUchar(VUcharVar byte) {
 
 	xValueSetUchar(x.GoPointer(), VUcharVar)
@@ -675,7 +623,7 @@ func (x *Value) SetUchar(VUcharVar byte) {
 
 var xValueSetUint func(uintptr, uint)
 
-// Set the contents of a %G_TYPE_UINT #GValue to @v_uint.
+// Set the contents of a G_TYPE_UINT [Value] to v_uint.
 func (x *Value) SetUint(VUintVar uint) {
 
 	xValueSetUint(x.GoPointer(), VUintVar)
@@ -684,17 +632,17 @@ func (x *Value) SetUint(VUintVar uint) {
 
 var xValueSetUint64 func(uintptr, uint64)
 
-// Set the contents of a %G_TYPE_UINT64 #GValue to @v_uint64.
+// Set the contents of a G_TYPE_UINT64 [Value] to v_uint64.
 func (x *Value) SetUint64(VUint64Var uint64) {
 
 	xValueSetUint64(x.GoPointer(), VUint64Var)
 
 }
 
-var xValueSetUlong func(uintptr, uint)
+var xValueSetUlong func(uintptr, types.ULong)
 
-// Set the contents of a %G_TYPE_ULONG #GValue to @v_ulong.
-func (x *Value) SetUlong(VUlongVar uint) {
+// Set the contents of a G_TYPE_ULONG [Value] to v_ulong.
+func (x *Value) SetUlong(VUlongVar types.ULong) {
 
 	xValueSetUlong(x.GoPointer(), VUlongVar)
 
@@ -702,7 +650,7 @@ func (x *Value) SetUlong(VUlongVar uint) {
 
 var xValueSetVariant func(uintptr, *glib.Variant)
 
-// Set the contents of a variant #GValue to @variant.
+// Set the contents of a variant [Value] to variant.
 // If the variant is floating, it is consumed.
 func (x *Value) SetVariant(VariantVar *glib.Variant) {
 
@@ -712,13 +660,13 @@ func (x *Value) SetVariant(VariantVar *glib.Variant) {
 
 var xValueStealString func(uintptr) string
 
-// Steal ownership on contents of a %G_TYPE_STRING #GValue.
-// As a result of this operation the value's contents will be reset to %NULL.
+// Steal ownership on contents of a G_TYPE_STRING [Value].
+// As a result of this operation the value's contents will be reset to nil.
 //
 // The purpose of this call is to provide a way to avoid an extra copy
-// when some object have been serialized into string through #GValue API.
+// when some object have been serialized into string through [Value] API.
 //
-// NOTE: for safety and compatibility purposes, if #GValue contains
+// NOTE: for safety and compatibility purposes, if [Value] contains
 // static string, or an interned one, this function will return a copy
 // of the string. Otherwise the transfer notation would be ambiguous.
 func (x *Value) StealString() string {
@@ -729,8 +677,8 @@ func (x *Value) StealString() string {
 
 var xValueTakeBoxed func(uintptr, uintptr)
 
-// Sets the contents of a %G_TYPE_BOXED derived #GValue to @v_boxed
-// and takes over the ownership of the caller’s reference to @v_boxed;
+// Sets the contents of a G_TYPE_BOXED derived [Value] to v_boxed
+// and takes over the ownership of the caller’s reference to v_boxed;
 // the caller doesn’t have to unref it any more.
 func (x *Value) TakeBoxed(VBoxedVar uintptr) {
 
@@ -740,13 +688,13 @@ func (x *Value) TakeBoxed(VBoxedVar uintptr) {
 
 var xValueTakeObject func(uintptr, uintptr)
 
-// Sets the contents of a %G_TYPE_OBJECT derived #GValue to @v_object
-// and takes over the ownership of the caller’s reference to @v_object;
+// Sets the contents of a G_TYPE_OBJECT derived [Value] to v_object
+// and takes over the ownership of the caller’s reference to v_object;
 // the caller doesn’t have to unref it any more (i.e. the reference
 // count of the object is not increased).
 //
-// If you want the #GValue to hold its own reference to @v_object, use
-// g_value_set_object() instead.
+// If you want the [Value] to hold its own reference to v_object, use
+// [Value.SetObject] instead.
 func (x *Value) TakeObject(VObjectVar uintptr) {
 
 	xValueTakeObject(x.GoPointer(), VObjectVar)
@@ -755,8 +703,8 @@ func (x *Value) TakeObject(VObjectVar uintptr) {
 
 var xValueTakeParam func(uintptr, uintptr)
 
-// Sets the contents of a %G_TYPE_PARAM #GValue to @param and takes
-// over the ownership of the caller’s reference to @param; the caller
+// Sets the contents of a G_TYPE_PARAM [Value] to param and takes
+// over the ownership of the caller’s reference to param; the caller
 // doesn’t have to unref it any more.
 func (x *Value) TakeParam(ParamVar *ParamSpec) {
 
@@ -766,7 +714,7 @@ func (x *Value) TakeParam(ParamVar *ParamSpec) {
 
 var xValueTakeString func(uintptr, uintptr)
 
-// Sets the contents of a %G_TYPE_STRING #GValue to @v_string.
+// Sets the contents of a G_TYPE_STRING [Value] to v_string.
 func (x *Value) TakeString(VStringVar *string) {
 
 	xValueTakeString(x.GoPointer(), core.GStrdupNullable(VStringVar))
@@ -775,16 +723,16 @@ func (x *Value) TakeString(VStringVar *string) {
 
 var xValueTakeVariant func(uintptr, *glib.Variant)
 
-// Set the contents of a variant #GValue to @variant, and takes over
-// the ownership of the caller's reference to @variant;
+// Set the contents of a variant [Value] to variant, and takes over
+// the ownership of the caller's reference to variant;
 // the caller doesn't have to unref it any more (i.e. the reference
 // count of the variant is not increased).
 //
-// If @variant was floating then its floating reference is converted to
+// If variant was floating then its floating reference is converted to
 // a hard reference.
 //
-// If you want the #GValue to hold its own reference to @variant, use
-// g_value_set_variant() instead.
+// If you want the [Value] to hold its own reference to variant, use
+// [Value.SetVariant] instead.
 //
 // This is an internal function introduced mainly for C marshallers.
 func (x *Value) TakeVariant(VariantVar *glib.Variant) {
@@ -795,10 +743,10 @@ func (x *Value) TakeVariant(VariantVar *glib.Variant) {
 
 var xValueTransform func(uintptr, *Value) bool
 
-// Tries to cast the contents of @src_value into a type appropriate
-// to store in @dest_value.
+// Tries to cast the contents of src_value into a type appropriate
+// to store in dest_value.
 //
-// If a transformation is not possible, @dest_value is not modified.
+// If a transformation is not possible, dest_value is not modified.
 //
 // For example, this could transform a `G_TYPE_INT` value into a `G_TYPE_FLOAT`
 // value.
@@ -815,11 +763,11 @@ func (x *Value) Transform(DestValueVar *Value) bool {
 
 var xValueUnset func(uintptr)
 
-// Clears the current value in @value (if any) and ‘unsets’ the type.
+// Clears the current value in value (if any) and ‘unsets’ the type.
 //
-// This releases all resources associated with this [struct@GObject.Value]. An
+// This releases all resources associated with this [Value]. An
 // unset value is the same as a cleared (zero-filled)
-// [struct@GObject.Value] structure set to `G_VALUE_INIT`.
+// [Value] structure set to `G_VALUE_INIT`.
 func (x *Value) Unset() {
 
 	xValueUnset(x.GoPointer())
@@ -827,37 +775,37 @@ func (x *Value) Unset() {
 }
 
 const (
-	// Flag to indicate that a string in a [struct@GObject.Value] is canonical and
+	// Flag to indicate that a string in a [Value] is canonical and
 	// will exist for the duration of the process.
 	//
-	// See [method@GObject.Value.set_interned_string].
+	// See [Value.SetInternedString].
 	//
 	// This flag should be checked by implementations of
-	// [callback@GObject.TypeValueFreeFunc], [callback@GObject.TypeValueCollectFunc]
-	// and [callback@GObject.TypeValueLCopyFunc].
+	// [TypeValueFreeFunc], [TypeValueCollectFunc]
+	// and [TypeValueLCopyFunc].
 	VALUE_INTERNED_STRING int = 268435456
-	// Flag to indicate that allocated data in a [struct@GObject.Value] shouldn’t be
+	// Flag to indicate that allocated data in a [Value] shouldn’t be
 	// copied.
 	//
-	// If passed to [func@GObject.VALUE_COLLECT], allocated data won’t be copied
+	// If passed to VALUE_COLLECT, allocated data won’t be copied
 	// but used verbatim. This does not affect ref-counted types like objects.
 	//
-	// This does not affect usage of [method@GObject.Value.copy]: the data will
+	// This does not affect usage of [Value.Copy]: the data will
 	// be copied if it is not ref-counted.
 	//
 	// This flag should be checked by implementations of
-	// [callback@GObject.TypeValueFreeFunc], [callback@GObject.TypeValueCollectFunc]
-	// and [callback@GObject.TypeValueLCopyFunc].
+	// [TypeValueFreeFunc], [TypeValueCollectFunc]
+	// and [TypeValueLCopyFunc].
 	VALUE_NOCOPY_CONTENTS int = 134217728
 )
 
 var xValueRegisterTransformFunc func(types.GType, types.GType, uintptr)
 
 // Registers a value transformation function for use in
-// [method@GObject.Value.transform].
+// [Value.Transform].
 //
-// Any previously registered transformation function for @src_type and
-// @dest_type will be replaced.
+// Any previously registered transformation function for src_type and
+// dest_type will be replaced.
 func ValueRegisterTransformFunc(SrcTypeVar types.GType, DestTypeVar types.GType, TransformFuncVar *ValueTransform) {
 
 	var TransformFuncVarRef uintptr
@@ -867,11 +815,12 @@ func ValueRegisterTransformFunc(SrcTypeVar types.GType, DestTypeVar types.GType,
 			TransformFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Value, arg1 *Value) {
+				defer core.RecoverPanic()
 				cbFn := *TransformFuncVar
 				cbFn(arg0, arg1)
 			}
-			TransformFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(TransformFuncVarPtr, TransformFuncVarRef, TransformFuncVar)
+			TransformFuncVarRef = core.NewCallback(fcb)
+			TransformFuncVarRef = glib.SaveCallbackWithClosure(TransformFuncVarPtr, TransformFuncVarRef, TransformFuncVar)
 		}
 	}
 
@@ -881,8 +830,8 @@ func ValueRegisterTransformFunc(SrcTypeVar types.GType, DestTypeVar types.GType,
 
 var xValueTypeCompatible func(types.GType, types.GType) bool
 
-// Checks whether a [method@GObject.Value.copy] is able to copy values of type
-// @src_type into values of type @dest_type.
+// Checks whether a [Value.Copy] is able to copy values of type
+// src_type into values of type dest_type.
 func ValueTypeCompatible(SrcTypeVar types.GType, DestTypeVar types.GType) bool {
 
 	cret := xValueTypeCompatible(SrcTypeVar, DestTypeVar)
@@ -891,12 +840,12 @@ func ValueTypeCompatible(SrcTypeVar types.GType, DestTypeVar types.GType) bool {
 
 var xValueTypeTransformable func(types.GType, types.GType) bool
 
-// Checks whether [method@GObject.Value.transform] is able to transform values
-// of type @src_type into values of type @dest_type.
+// Checks whether [Value.Transform] is able to transform values
+// of type src_type into values of type dest_type.
 //
 // Note that for the types to be transformable, they must be compatible or a
 // transformation function must be registered using
-// [func@GObject.Value.register_transform_func].
+// [ValueRegisterTransformFunc].
 func ValueTypeTransformable(SrcTypeVar types.GType, DestTypeVar types.GType) bool {
 
 	cret := xValueTypeTransformable(SrcTypeVar, DestTypeVar)
@@ -908,87 +857,81 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xValueRegisterTransformFunc, libs, "g_value_register_transform_func")
-	core.PuregoSafeRegister(&xValueTypeCompatible, libs, "g_value_type_compatible")
-	core.PuregoSafeRegister(&xValueTypeTransformable, libs, "g_value_type_transformable")
+	core.PuregoRegisterSince(&xValueRegisterTransformFunc, libs, "g_value_register_transform_func", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueTypeCompatible, libs, "g_value_type_compatible", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueTypeTransformable, libs, "g_value_type_transformable", "GOBJECT", "")
 
 	core.PuregoSafeRegister(&xValueGLibType, libs, "g_value_get_type")
 
-	core.PuregoSafeRegister(&xValueCopy, libs, "g_value_copy")
-	core.PuregoSafeRegister(&xValueDupBoxed, libs, "g_value_dup_boxed")
-	core.PuregoSafeRegister(&xValueDupObject, libs, "g_value_dup_object")
-	core.PuregoSafeRegister(&xValueDupParam, libs, "g_value_dup_param")
-	core.PuregoSafeRegister(&xValueDupString, libs, "g_value_dup_string")
-	core.PuregoSafeRegister(&xValueDupVariant, libs, "g_value_dup_variant")
-	core.PuregoSafeRegister(&xValueFitsPointer, libs, "g_value_fits_pointer")
-	core.PuregoSafeRegister(&xValueGetBoolean, libs, "g_value_get_boolean")
-	core.PuregoSafeRegister(&xValueGetBoxed, libs, "g_value_get_boxed")
-	core.PuregoSafeRegister(&xValueGetChar, libs, "g_value_get_char")
-	core.PuregoSafeRegister(&xValueGetDouble, libs, "g_value_get_double")
-	core.PuregoSafeRegister(&xValueGetEnum, libs, "g_value_get_enum")
-	core.PuregoSafeRegister(&xValueGetFlags, libs, "g_value_get_flags")
-	core.PuregoSafeRegister(&xValueGetFloat, libs, "g_value_get_float")
-	core.PuregoSafeRegister(&xValueGetGtype, libs, "g_value_get_gtype")
-	core.PuregoSafeRegister(&xValueGetInt, libs, "g_value_get_int")
-	core.PuregoSafeRegister(&xValueGetInt64, libs, "g_value_get_int64")
-	core.PuregoSafeRegister(&xValueGetLong, libs, "g_value_get_long")
-	core.PuregoSafeRegister(&xValueGetObject, libs, "g_value_get_object")
-	core.PuregoSafeRegister(&xValueGetParam, libs, "g_value_get_param")
-	core.PuregoSafeRegister(&xValueGetPointer, libs, "g_value_get_pointer")
-	core.PuregoSafeRegister(&xValueGetSchar, libs, "g_value_get_schar")
-	core.PuregoSafeRegister(&xValueGetString, libs, "g_value_get_string")
-	core.PuregoSafeRegister(&xValueGetUchar, libs, "g_value_get_uchar")
-	core.PuregoSafeRegister(&xValueGetUint, libs, "g_value_get_uint")
-	core.PuregoSafeRegister(&xValueGetUint64, libs, "g_value_get_uint64")
-	core.PuregoSafeRegister(&xValueGetUlong, libs, "g_value_get_ulong")
-	core.PuregoSafeRegister(&xValueGetVariant, libs, "g_value_get_variant")
-	core.PuregoSafeRegister(&xValueInit, libs, "g_value_init")
-	core.PuregoSafeRegister(&xValueInitFromInstance, libs, "g_value_init_from_instance")
-	core.PuregoSafeRegister(&xValuePeekPointer, libs, "g_value_peek_pointer")
-	core.PuregoSafeRegister(&xValueReset, libs, "g_value_reset")
-	core.PuregoSafeRegister(&xValueSetBoolean, libs, "g_value_set_boolean")
-	core.PuregoSafeRegister(&xValueSetBoxed, libs, "g_value_set_boxed")
-	core.PuregoSafeRegister(&xValueSetBoxedTakeOwnership, libs, "g_value_set_boxed_take_ownership")
-	core.PuregoSafeRegister(&xValueSetChar, libs, "g_value_set_char")
-	core.PuregoSafeRegister(&xValueSetDouble, libs, "g_value_set_double")
-	core.PuregoSafeRegister(&xValueSetEnum, libs, "g_value_set_enum")
-	core.PuregoSafeRegister(&xValueSetFlags, libs, "g_value_set_flags")
-	core.PuregoSafeRegister(&xValueSetFloat, libs, "g_value_set_float")
-	core.PuregoSafeRegister(&xValueSetGtype, libs, "g_value_set_gtype")
-	core.PuregoSafeRegister(&xValueSetInstance, libs, "g_value_set_instance")
-	core.PuregoSafeRegister(&xValueSetInt, libs, "g_value_set_int")
-	core.PuregoSafeRegister(&xValueSetInt64, libs, "g_value_set_int64")
-	core.PuregoSafeRegister(&xValueSetInternedString, libs, "g_value_set_interned_string")
-	core.PuregoSafeRegister(&xValueSetLong, libs, "g_value_set_long")
-	core.PuregoSafeRegister(&xValueSetObject, libs, "g_value_set_object")
-	core.PuregoSafeRegister(&xValueSetObjectTakeOwnership, libs, "g_value_set_object_take_ownership")
-	core.PuregoSafeRegister(&xValueSetParam, libs, "g_value_set_param")
-	core.PuregoSafeRegister(&xValueSetParamTakeOwnership, libs, "g_value_set_param_take_ownership")
-	core.PuregoSafeRegister(&xValueSetPointer, libs, "g_value_set_pointer")
-	core.PuregoSafeRegister(&xValueSetSchar, libs, "g_value_set_schar")
-	core.PuregoSafeRegister(&xValueSetStaticBoxed, libs, "g_value_set_static_boxed")
-	core.PuregoSafeRegister(&xValueSetStaticString, libs, "g_value_set_static_string")
-	core.PuregoSafeRegister(&xValueSetString, libs, "g_value_set_string")
-	core.PuregoSafeRegister(&xValueSetStringTakeOwnership, libs, "g_value_set_string_take_ownership")
-	core.PuregoSafeRegister(&xValueSetUchar, libs, "g_value_set_uchar")
-	core.PuregoSafeRegister(&xValueSetUint, libs, "g_value_set_uint")
-	core.PuregoSafeRegister(&xValueSetUint64, libs, "g_value_set_uint64")
-	core.PuregoSafeRegister(&xValueSetUlong, libs, "g_value_set_ulong")
-	core.PuregoSafeRegister(&xValueSetVariant, libs, "g_value_set_variant")
-	core.PuregoSafeRegister(&xValueStealString, libs, "g_value_steal_string")
-	core.PuregoSafeRegister(&xValueTakeBoxed, libs, "g_value_take_boxed")
-	core.PuregoSafeRegister(&xValueTakeObject, libs, "g_value_take_object")
-	core.PuregoSafeRegister(&xValueTakeParam, libs, "g_value_take_param")
-	core.PuregoSafeRegister(&xValueTakeString, libs, "g_value_take_string")
-	core.PuregoSafeRegister(&xValueTakeVariant, libs, "g_value_take_variant")
-	core.PuregoSafeRegister(&xValueTransform, libs, "g_value_transform")
-	core.PuregoSafeRegister(&xValueUnset, libs, "g_value_unset")
+	core.PuregoRegisterSince(&xValueCopy, libs, "g_value_copy", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueDupBoxed, libs, "g_value_dup_boxed", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueDupObject, libs, "g_value_dup_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueDupParam, libs, "g_value_dup_param", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueDupString, libs, "g_value_dup_string", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueDupVariant, libs, "g_value_dup_variant", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xValueFitsPointer, libs, "g_value_fits_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetBoolean, libs, "g_value_get_boolean", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetBoxed, libs, "g_value_get_boxed", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetDouble, libs, "g_value_get_double", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetEnum, libs, "g_value_get_enum", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetFlags, libs, "g_value_get_flags", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetFloat, libs, "g_value_get_float", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetGtype, libs, "g_value_get_gtype", "GOBJECT", "2.12")
+	core.PuregoRegisterSince(&xValueGetInt, libs, "g_value_get_int", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetInt64, libs, "g_value_get_int64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetLong, libs, "g_value_get_long", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetObject, libs, "g_value_get_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetParam, libs, "g_value_get_param", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetPointer, libs, "g_value_get_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetSchar, libs, "g_value_get_schar", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xValueGetString, libs, "g_value_get_string", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetUchar, libs, "g_value_get_uchar", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetUint, libs, "g_value_get_uint", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetUint64, libs, "g_value_get_uint64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetUlong, libs, "g_value_get_ulong", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueGetVariant, libs, "g_value_get_variant", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xValueInit, libs, "g_value_init", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueInitFromInstance, libs, "g_value_init_from_instance", "GOBJECT", "2.42")
+	core.PuregoRegisterSince(&xValuePeekPointer, libs, "g_value_peek_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueReset, libs, "g_value_reset", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetBoolean, libs, "g_value_set_boolean", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetBoxed, libs, "g_value_set_boxed", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetDouble, libs, "g_value_set_double", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetEnum, libs, "g_value_set_enum", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetFlags, libs, "g_value_set_flags", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetFloat, libs, "g_value_set_float", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetGtype, libs, "g_value_set_gtype", "GOBJECT", "2.12")
+	core.PuregoRegisterSince(&xValueSetInstance, libs, "g_value_set_instance", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetInt, libs, "g_value_set_int", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetInt64, libs, "g_value_set_int64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetInternedString, libs, "g_value_set_interned_string", "GOBJECT", "2.66")
+	core.PuregoRegisterSince(&xValueSetLong, libs, "g_value_set_long", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetObject, libs, "g_value_set_object", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetParam, libs, "g_value_set_param", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetPointer, libs, "g_value_set_pointer", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetSchar, libs, "g_value_set_schar", "GOBJECT", "2.32")
+	core.PuregoRegisterSince(&xValueSetStaticBoxed, libs, "g_value_set_static_boxed", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetStaticString, libs, "g_value_set_static_string", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetString, libs, "g_value_set_string", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetUchar, libs, "g_value_set_uchar", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetUint, libs, "g_value_set_uint", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetUint64, libs, "g_value_set_uint64", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetUlong, libs, "g_value_set_ulong", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetVariant, libs, "g_value_set_variant", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xValueStealString, libs, "g_value_steal_string", "GOBJECT", "2.80")
+	core.PuregoRegisterSince(&xValueTakeBoxed, libs, "g_value_take_boxed", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xValueTakeObject, libs, "g_value_take_object", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xValueTakeParam, libs, "g_value_take_param", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xValueTakeString, libs, "g_value_take_string", "GOBJECT", "2.4")
+	core.PuregoRegisterSince(&xValueTakeVariant, libs, "g_value_take_variant", "GOBJECT", "2.26")
+	core.PuregoRegisterSince(&xValueTransform, libs, "g_value_transform", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueUnset, libs, "g_value_unset", "GOBJECT", "")
 
 }
@@ -0,0 +1,101 @@
+//go:build !puregotk_no_deprecated
+
+// Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gobject
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xValueGetChar func(uintptr) byte
+
+// Do not use this function; it is broken on platforms where the %char
+// type is unsigned, such as ARM and PowerPC.  See [Value.GetSchar].
+//
+// Get the contents of a G_TYPE_CHAR [Value].
+//
+// Deprecated: since 2.32. This function's return type is broken, see [Value.GetSchar]
+func (x *Value) GetChar() byte {
+
+	cret := xValueGetChar(x.GoPointer())
+	return cret
+}
+
+var xValueSetBoxedTakeOwnership func(uintptr, uintptr)
+
+// This is an internal function introduced mainly for C marshallers.
+//
+// Deprecated: since 2.4. Use [Value.TakeBoxed] instead.
+func (x *Value) SetBoxedTakeOwnership(VBoxedVar uintptr) {
+
+	xValueSetBoxedTakeOwnership(x.GoPointer(), VBoxedVar)
+
+}
+
+var xValueSetChar func(uintptr, byte)
+
+// Set the contents of a G_TYPE_CHAR [Value] to v_char.
+//
+// Deprecated: since 2.32. This function's input type is broken, see [Value.SetSchar]
+func (x *Value) SetChar(VCharVar byte) {
+
+	xValueSetChar(x.GoPointer(), VCharVar)
+
+}
+
+var xValueSetObjectTakeOwnership func(uintptr, uintptr)
+
+// This is an internal function introduced mainly for C marshallers.
+//
+// Deprecated: since 2.4. Use [Value.TakeObject] instead.
+func (x *Value) SetObjectTakeOwnership(VObjectVar uintptr) {
+
+	xValueSetObjectTakeOwnership(x.GoPointer(), VObjectVar)
+
+}
+
+var xValueSetParamTakeOwnership func(uintptr, uintptr)
+
+// This is an internal function introduced mainly for C marshallers.
+//
+// Deprecated: since 2.4. Use [Value.TakeParam] instead.
+func (x *Value) SetParamTakeOwnership(ParamVar *ParamSpec) {
+
+	xValueSetParamTakeOwnership(x.GoPointer(), ParamVar.GoPointer())
+
+}
+
+var xValueSetStringTakeOwnership func(uintptr, uintptr)
+
+// This is an internal function introduced mainly for C marshallers.
+//
+// Deprecated: since 2.4. Use [Value.TakeString] instead.
+func (x *Value) SetStringTakeOwnership(VStringVar *string) {
+
+	VStringVarPtr := core.GStrdupNullable(VStringVar)
+	defer core.GFreeNullable(VStringVarPtr)
+
+	xValueSetStringTakeOwnership(x.GoPointer(), VStringVarPtr)
+
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xValueGetChar, libs, "g_value_get_char", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetBoxedTakeOwnership, libs, "g_value_set_boxed_take_ownership", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetChar, libs, "g_value_set_char", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetObjectTakeOwnership, libs, "g_value_set_object_take_ownership", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetParamTakeOwnership, libs, "g_value_set_param_take_ownership", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueSetStringTakeOwnership, libs, "g_value_set_string_take_ownership", "GOBJECT", "")
+
+}
@@ -0,0 +1,195 @@
+//go:build !puregotk_no_deprecated
+
+// Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gobject
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xNewValueArray func(uint) *ValueArray
+
+// Allocate and initialize a new [ValueArray], optionally preserve space
+// for n_prealloced elements. New arrays always contain 0 elements,
+// regardless of the value of n_prealloced.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_sized_new() instead.
+func NewValueArray(NPreallocedVar uint) *ValueArray {
+
+	cret := xNewValueArray(NPreallocedVar)
+	return cret
+}
+
+var xValueArrayAppend func(uintptr, *Value) *ValueArray
+
+// Insert a copy of value as last element of value_array. If value is
+// nil, an uninitialized value is appended.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_append_val() instead.
+func (x *ValueArray) Append(ValueVar *Value) *ValueArray {
+
+	cret := xValueArrayAppend(x.GoPointer(), ValueVar)
+	return cret
+}
+
+var xValueArrayCopy func(uintptr) *ValueArray
+
+// Construct an exact copy of a [ValueArray] by duplicating all its
+// contents.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_ref() instead.
+func (x *ValueArray) Copy() *ValueArray {
+
+	cret := xValueArrayCopy(x.GoPointer())
+	return cret
+}
+
+var xValueArrayFree func(uintptr)
+
+// Free a [ValueArray] including its contents.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_unref() instead.
+func (x *ValueArray) Free() {
+
+	xValueArrayFree(x.GoPointer())
+
+}
+
+var xValueArrayGetNth func(uintptr, uint) *Value
+
+// Return a pointer to the value at index_ contained in value_array.
+//
+// Deprecated: since 2.32. Use g_array_index() instead.
+func (x *ValueArray) GetNth(IndexVar uint) *Value {
+
+	cret := xValueArrayGetNth(x.GoPointer(), IndexVar)
+	return cret
+}
+
+var xValueArrayInsert func(uintptr, uint, *Value) *ValueArray
+
+// Insert a copy of value at specified position into value_array. If value
+// is nil, an uninitialized value is inserted.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_insert_val() instead.
+func (x *ValueArray) Insert(IndexVar uint, ValueVar *Value) *ValueArray {
+
+	cret := xValueArrayInsert(x.GoPointer(), IndexVar, ValueVar)
+	return cret
+}
+
+var xValueArrayPrepend func(uintptr, *Value) *ValueArray
+
+// Insert a copy of value as first element of value_array. If value is
+// nil, an uninitialized value is prepended.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_prepend_val() instead.
+func (x *ValueArray) Prepend(ValueVar *Value) *ValueArray {
+
+	cret := xValueArrayPrepend(x.GoPointer(), ValueVar)
+	return cret
+}
+
+var xValueArrayRemove func(uintptr, uint) *ValueArray
+
+// Remove the value at position index_ from value_array.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_remove_index() instead.
+func (x *ValueArray) Remove(IndexVar uint) *ValueArray {
+
+	cret := xValueArrayRemove(x.GoPointer(), IndexVar)
+	return cret
+}
+
+var xValueArraySort func(uintptr, uintptr) *ValueArray
+
+// Sort value_array using compare_func to compare the elements according to
+// the semantics of [glib.CompareFunc].
+//
+// The current implementation uses the same sorting algorithm as standard
+// C qsort() function.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_sort().
+//
+// See [SortWithData] for the version of this function that language bindings are meant to use.
+func (x *ValueArray) Sort(CompareFuncVar *glib.CompareFunc) *ValueArray {
+
+	var CompareFuncVarRef uintptr
+	if CompareFuncVar != nil {
+		CompareFuncVarPtr := uintptr(unsafe.Pointer(CompareFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(CompareFuncVarPtr); ok {
+			CompareFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *CompareFuncVar
+				return cbFn(arg0, arg1)
+			}
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+		}
+	}
+
+	cret := xValueArraySort(x.GoPointer(), CompareFuncVarRef)
+	return cret
+}
+
+var xValueArraySortWithData func(uintptr, uintptr, uintptr) *ValueArray
+
+// Sort value_array using compare_func to compare the elements according
+// to the semantics of [glib.CompareDataFunc].
+//
+// The current implementation uses the same sorting algorithm as standard
+// C qsort() function.
+//
+// Deprecated: since 2.32. Use [glib.Array] and g_array_sort_with_data().
+func (x *ValueArray) SortWithData(CompareFuncVar *glib.CompareDataFunc, UserDataVar uintptr) *ValueArray {
+
+	var CompareFuncVarRef uintptr
+	if CompareFuncVar != nil {
+		CompareFuncVarPtr := uintptr(unsafe.Pointer(CompareFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(CompareFuncVarPtr); ok {
+			CompareFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *CompareFuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			CompareFuncVarRef = core.NewCallback(fcb)
+			CompareFuncVarRef = glib.SaveCallbackWithClosure(CompareFuncVarPtr, CompareFuncVarRef, CompareFuncVar)
+		}
+	}
+
+	cret := xValueArraySortWithData(x.GoPointer(), CompareFuncVarRef, UserDataVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewValueArray, libs, "g_value_array_new", "GOBJECT", "")
+
+	core.PuregoRegisterSince(&xValueArrayAppend, libs, "g_value_array_append", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayCopy, libs, "g_value_array_copy", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayFree, libs, "g_value_array_free", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayGetNth, libs, "g_value_array_get_nth", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayInsert, libs, "g_value_array_insert", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayPrepend, libs, "g_value_array_prepend", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArrayRemove, libs, "g_value_array_remove", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArraySort, libs, "g_value_array_sort", "GOBJECT", "")
+	core.PuregoRegisterSince(&xValueArraySortWithData, libs, "g_value_array_sort_with_data", "GOBJECT", "")
+
+}
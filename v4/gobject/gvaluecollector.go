@@ -5,7 +5,7 @@ package gobject
 type TypeCValue = uintptr
 
 const (
-	// The maximal number of #GTypeCValues which can be collected for a
-	// single #GValue.
+	// The maximal number of GTypeCValues which can be collected for a
+	// single [Value].
 	VALUE_COLLECT_FORMAT_MAX_LENGTH int = 8
 )
@@ -2,7 +2,6 @@
 package gobject
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -17,8 +16,8 @@ func GtypeGetType() types.GType {
 
 var xPointerTypeRegisterStatic func(string) types.GType
 
-// Creates a new %G_TYPE_POINTER derived type id for a new
-// pointer type with name @name.
+// Creates a new G_TYPE_POINTER derived type id for a new
+// pointer type with name name.
 func PointerTypeRegisterStatic(NameVar string) types.GType {
 
 	cret := xPointerTypeRegisterStatic(NameVar)
@@ -28,7 +27,7 @@ func PointerTypeRegisterStatic(NameVar string) types.GType {
 var xStrdupValueContents func(*Value) string
 
 // Return a newly allocated string, which describes the contents of a
-// #GValue.  The main purpose of this function is to describe #GValue
+// [Value].  The main purpose of this function is to describe [Value]
 // contents for debugging output, the way in which the contents are
 // described may change between different GLib versions.
 func StrdupValueContents(ValueVar *Value) string {
@@ -42,15 +41,15 @@ func init() {
 	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GOBJECT") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xGtypeGetType, libs, "g_gtype_get_type")
-	core.PuregoSafeRegister(&xPointerTypeRegisterStatic, libs, "g_pointer_type_register_static")
-	core.PuregoSafeRegister(&xStrdupValueContents, libs, "g_strdup_value_contents")
+	core.PuregoRegisterSince(&xGtypeGetType, libs, "g_gtype_get_type", "GOBJECT", "")
+	core.PuregoRegisterSince(&xPointerTypeRegisterStatic, libs, "g_pointer_type_register_static", "GOBJECT", "")
+	core.PuregoRegisterSince(&xStrdupValueContents, libs, "g_strdup_value_contents", "GOBJECT", "")
 
 }
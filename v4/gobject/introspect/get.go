@@ -0,0 +1,14 @@
+//go:build puregotk_no_deprecated
+
+package introspect
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// defaultInterface returns the default vtable of the interface t and the function that releases it,
+// without deprecated functions g_type_default_interface_get is used, which needs GLib 2.84
+func defaultInterface(t types.GType) (*gobject.TypeInterface, func()) {
+	return gobject.TypeDefaultInterfaceGet(t), func() {}
+}
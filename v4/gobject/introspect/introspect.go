@@ -0,0 +1,631 @@
+// Package introspect describes types registered from Go as GObject introspection data,
+// such that language bindings and tools that read GIR or typelib files see their properties and signals:
+//
+//	ns := introspect.Namespace{Name: "My", Version: "1.0", Types: []types.GType{counterType}}
+//	f, _ := os.Create("My-1.0.gir")
+//	ns.WriteGIR(f)
+//
+// The GIR file is compiled to a typelib with g-ir-compiler:
+//
+//	g-ir-compiler My-1.0.gir -o My-1.0.typelib
+//
+// The types are described as they are registered when WriteGIR is called, so call it after the types
+// have their properties and signals, e.g. after creating their first instance.
+// The types have no get-type function, bindings look them up by name once the Go program registered them.
+package introspect
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// Include is a namespace that the described types refer to.
+type Include struct {
+	// Name is the namespace, e.g. "Gtk"
+	Name string
+	// Version is the version of the namespace, e.g. "4.0"
+	Version string
+	// Prefix is the prefix of the C type names of the namespace, e.g. "Gtk"
+	Prefix string
+}
+
+// knownIncludes are the namespaces of the bindings, the G prefix is shared by GObject, GLib and Gio, see typeNamespace
+var knownIncludes = []Include{
+	{Name: "Adw", Version: "1", Prefix: "Adw"},
+	{Name: "Gtk", Version: "4.0", Prefix: "Gtk"},
+	{Name: "GdkPixbuf", Version: "2.0", Prefix: "GdkPixbuf"},
+	{Name: "Gdk", Version: "4.0", Prefix: "Gdk"},
+	{Name: "Gsk", Version: "4.0", Prefix: "Gsk"},
+	{Name: "Graphene", Version: "1.0", Prefix: "Graphene"},
+	{Name: "Pango", Version: "1.0", Prefix: "Pango"},
+	{Name: "Gio", Version: "2.0", Prefix: "G"},
+	{Name: "GLib", Version: "2.0", Prefix: "G"},
+	{Name: "GObject", Version: "2.0", Prefix: "G"},
+}
+
+// gobjectTypes are the types with the G prefix that belong to GObject instead of GLib or Gio
+var gobjectTypes = map[string]bool{
+	"GObject":           true,
+	"GInitiallyUnowned": true,
+	"GBinding":          true,
+	"GBindingGroup":     true,
+	"GSignalGroup":      true,
+	"GTypeModule":       true,
+	"GClosure":          true,
+	"GValue":            true,
+	"GValueArray":       true,
+	"GStrv":             true,
+}
+
+// Namespace is a namespace of types registered from Go.
+type Namespace struct {
+	// Name is the namespace, e.g. "My"
+	Name string
+	// Version is the version of the namespace, e.g. "1.0"
+	Version string
+	// Prefix is the prefix of the C type names of the types, e.g. "My" for "MyCounter", it defaults to Name
+	Prefix string
+	// Types are the classes, interfaces, enums and flags to describe
+	Types []types.GType
+	// Includes are the namespaces of other libraries that the types refer to,
+	// the namespaces of the bindings are known
+	Includes []Include
+}
+
+// WriteGIR writes the GIR XML of the namespace to w.
+func (ns Namespace) WriteGIR(w io.Writer) error {
+	d := describer{ns: ns, used: make(map[string]Include)}
+	if d.ns.Prefix == "" {
+		d.ns.Prefix = ns.Name
+	}
+	n := xmlNamespace{
+		Name:             ns.Name,
+		Version:          ns.Version,
+		IdentifierPrefix: d.ns.Prefix,
+		SymbolPrefix:     strings.ToLower(d.ns.Prefix),
+	}
+	for _, t := range ns.Types {
+		name := gobject.TypeName(t)
+		if !strings.HasPrefix(name, d.ns.Prefix) {
+			return fmt.Errorf("introspect: type %s does not have the prefix %s", name, d.ns.Prefix)
+		}
+		switch gobject.TypeFundamental(t) {
+		case gobject.TypeObjectVal:
+			n.Classes = append(n.Classes, d.class(t))
+		case gobject.TypeInterfaceVal:
+			n.Interfaces = append(n.Interfaces, d.iface(t))
+		case gobject.TypeEnumVal:
+			n.Enums = append(n.Enums, d.enum(t))
+		case gobject.TypeFlagsVal:
+			n.Bitfields = append(n.Bitfields, d.flags(t))
+		default:
+			return fmt.Errorf("introspect: type %s is not a class, interface, enum or flags type", name)
+		}
+	}
+
+	r := xmlRepository{
+		Version: "1.2",
+		Xmlns:   "http://www.gtk.org/introspection/core/1.0",
+		XmlnsC:  "http://www.gtk.org/introspection/c/1.0",
+		XmlnsG:  "http://www.gtk.org/introspection/glib/1.0",
+		// the base types of every class
+		Includes:  []xmlInclude{{Name: "GObject", Version: "2.0"}},
+		Namespace: n,
+	}
+	names := make([]string, 0, len(d.used))
+	for name := range d.used {
+		if name != "GObject" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		r.Includes = append(r.Includes, xmlInclude{Name: name, Version: d.used[name].Version})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// describer converts the types of a namespace and collects the namespaces they refer to
+type describer struct {
+	ns   Namespace
+	used map[string]Include
+}
+
+// typeName returns the GIR name of a type, qualified with its namespace unless it belongs to the described namespace
+func (d *describer) typeName(t types.GType) string {
+	t &^= signalStaticScope
+	switch t {
+	case gobject.TypeNoneVal:
+		return "none"
+	case gobject.TypeCharVal:
+		return "gchar"
+	case gobject.TypeUcharVal:
+		return "guint8"
+	case gobject.TypeBooleanVal:
+		return "gboolean"
+	case gobject.TypeIntVal:
+		return "gint"
+	case gobject.TypeUintVal:
+		return "guint"
+	case gobject.TypeLongVal:
+		return "glong"
+	case gobject.TypeUlongVal:
+		return "gulong"
+	case gobject.TypeInt64Val:
+		return "gint64"
+	case gobject.TypeUint64Val:
+		return "guint64"
+	case gobject.TypeFloatVal:
+		return "gfloat"
+	case gobject.TypeDoubleVal:
+		return "gdouble"
+	case gobject.TypeStringVal:
+		return "utf8"
+	case gobject.TypePointerVal:
+		return "gpointer"
+	case typeVariant:
+		d.used["GLib"] = knownInclude("GLib")
+		return "GLib.Variant"
+	case gobject.TypeParamVal:
+		return "GObject.ParamSpec"
+	}
+	name := gobject.TypeName(t)
+	if strings.HasPrefix(name, d.ns.Prefix) {
+		return strings.TrimPrefix(name, d.ns.Prefix)
+	}
+	inc, ok := d.typeNamespace(t, name)
+	if !ok {
+		// an unknown library, the type is still usable as its fundamental type
+		if f := gobject.TypeFundamental(t); f != t {
+			return d.typeName(f)
+		}
+		return "gpointer"
+	}
+	d.used[inc.Name] = inc
+	return inc.Name + "." + strings.TrimPrefix(name, inc.Prefix)
+}
+
+// typeNamespace returns the namespace that the type with the C name belongs to
+func (d *describer) typeNamespace(t types.GType, name string) (Include, bool) {
+	includes := append(append([]Include(nil), d.ns.Includes...), knownIncludes...)
+	for _, inc := range includes {
+		if !strings.HasPrefix(name, inc.Prefix) {
+			continue
+		}
+		if inc.Prefix != "G" {
+			return inc, true
+		}
+		// GObject, GLib and Gio share the prefix, GLib only has boxed types
+		switch {
+		case gobjectTypes[name] || strings.HasPrefix(name, "GParam"):
+			return knownInclude("GObject"), true
+		case gobject.TypeFundamental(t) == gobject.TypeBoxedVal:
+			return knownInclude("GLib"), true
+		default:
+			return knownInclude("Gio"), true
+		}
+	}
+	return Include{}, false
+}
+
+// knownInclude returns the known namespace with the name
+func knownInclude(name string) Include {
+	for _, inc := range knownIncludes {
+		if inc.Name == name {
+			return inc
+		}
+	}
+	return Include{}
+}
+
+func (d *describer) class(t types.GType) xmlClass {
+	name := gobject.TypeName(t)
+	c := xmlClass{
+		Name:     strings.TrimPrefix(name, d.ns.Prefix),
+		CType:    name,
+		Parent:   d.typeName(gobject.TypeParent(t)),
+		TypeName: name,
+		GetType:  "intern",
+	}
+	if gobject.TypeTestFlags(t, uint(gobject.GTypeFlagAbstractValue)) {
+		c.Abstract = "1"
+	}
+	if gobject.TypeTestFlags(t, uint(gobject.GTypeFlagFinalValue)) {
+		c.Final = "1"
+	}
+	for _, iface := range typeArray(gobject.TypeInterfaces, t) {
+		// only the interfaces that the type adds itself
+		if gobject.TypeIsA(gobject.TypeParent(t), iface) {
+			continue
+		}
+		c.Implements = append(c.Implements, xmlRef{Name: d.typeName(iface)})
+	}
+
+	class := xTypeClassRef(t)
+	defer xTypeClassUnref(class)
+	var n uint
+	specs := xObjectClassListProperties(class, &n)
+	c.Properties = d.properties(t, specs, n)
+	c.Signals = d.signals(t)
+	return c
+}
+
+func (d *describer) iface(t types.GType) xmlInterface {
+	name := gobject.TypeName(t)
+	i := xmlInterface{
+		Name:     strings.TrimPrefix(name, d.ns.Prefix),
+		CType:    name,
+		TypeName: name,
+		GetType:  "intern",
+	}
+	for _, pre := range typeArray(gobject.TypeInterfacePrerequisites, t) {
+		i.Prerequisites = append(i.Prerequisites, xmlRef{Name: d.typeName(pre)})
+	}
+
+	iface, release := defaultInterface(t)
+	defer release()
+	var n uint
+	specs := gobject.ObjectInterfaceListProperties(iface, &n)
+	i.Properties = d.properties(t, specs, n)
+	i.Signals = d.signals(t)
+	return i
+}
+
+// paramSpec is the C layout of the public fields of GParamSpec
+type paramSpec struct {
+	instance  uintptr
+	name      uintptr
+	flags     gobject.ParamFlags
+	valueType types.GType
+	ownerType types.GType
+}
+
+// properties describes the properties in the array of GParamSpec pointers that the type itself installs, and frees the array
+func (d *describer) properties(t types.GType, specs uintptr, n uint) []xmlProperty {
+	if specs == 0 {
+		return nil
+	}
+	defer glib.Free(specs)
+	var props []xmlProperty
+	for _, ptr := range unsafe.Slice((*uintptr)(*(*unsafe.Pointer)(unsafe.Pointer(&specs))), n) {
+		// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+		spec := (*paramSpec)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
+		if spec.ownerType != t {
+			continue
+		}
+		p := xmlProperty{
+			Name:     core.GoString(spec.name),
+			Transfer: "none",
+			Type:     xmlType{Name: d.typeName(spec.valueType)},
+		}
+		if spec.flags&gobject.GParamReadableValue == 0 {
+			p.Readable = "0"
+		}
+		if spec.flags&gobject.GParamWritableValue != 0 {
+			p.Writable = "1"
+		}
+		if spec.flags&gobject.GParamConstructValue != 0 {
+			p.Construct = "1"
+		}
+		if spec.flags&gobject.GParamConstructOnlyValue != 0 {
+			p.ConstructOnly = "1"
+		}
+		if spec.flags&gobject.GParamDeprecatedValue != 0 {
+			p.Deprecated = "1"
+		}
+		if blurb := (&gobject.ParamSpec{Ptr: ptr}).GetBlurb(); blurb != "" {
+			p.Doc = &xmlDoc{Text: blurb}
+		}
+		props = append(props, p)
+	}
+	return props
+}
+
+// signalQuery is the C layout of GSignalQuery
+type signalQuery struct {
+	id         uint32
+	name       uintptr
+	itype      types.GType
+	flags      gobject.SignalFlags
+	returnType types.GType
+	nParams    uint32
+	paramTypes uintptr
+}
+
+// signalStaticScope is the flag that signal argument types can have, G_SIGNAL_TYPE_STATIC_SCOPE
+const signalStaticScope types.GType = 1
+
+// typeVariant is G_TYPE_VARIANT
+const typeVariant types.GType = 21 << 2
+
+// signals describes the signals that the type itself adds
+func (d *describer) signals(t types.GType) []xmlSignal {
+	var n uint
+	ids := gobject.SignalListIds(t, &n)
+	if ids == 0 {
+		return nil
+	}
+	defer glib.Free(ids)
+	var signals []xmlSignal
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	for _, id := range unsafe.Slice((*uint32)(*(*unsafe.Pointer)(unsafe.Pointer(&ids))), n) {
+		var q signalQuery
+		xSignalQuery(uint(id), &q)
+		s := xmlSignal{
+			Name: core.GoString(q.name),
+			Return: xmlReturn{
+				Transfer: "none",
+				Type:     xmlType{Name: d.typeName(q.returnType)},
+			},
+		}
+		switch {
+		case q.flags&gobject.GSignalRunFirstValue != 0:
+			s.When = "first"
+		case q.flags&gobject.GSignalRunLastValue != 0:
+			s.When = "last"
+		default:
+			s.When = "cleanup"
+		}
+		if q.flags&gobject.GSignalActionValue != 0 {
+			s.Action = "1"
+		}
+		if q.flags&gobject.GSignalDetailedValue != 0 {
+			s.Detailed = "1"
+		}
+		if q.flags&gobject.GSignalNoRecurseValue != 0 {
+			s.NoRecurse = "1"
+		}
+		if q.flags&gobject.GSignalNoHooksValue != 0 {
+			s.NoHooks = "1"
+		}
+		if q.flags&gobject.GSignalDeprecatedValue != 0 {
+			s.Deprecated = "1"
+		}
+		if q.nParams > 0 {
+			s.Parameters = &xmlParameters{}
+			// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+			params := unsafe.Slice((*types.GType)(*(*unsafe.Pointer)(unsafe.Pointer(&q.paramTypes))), q.nParams)
+			for i, p := range params {
+				s.Parameters.Parameters = append(s.Parameters.Parameters, xmlParameter{
+					Name:     fmt.Sprintf("arg%d", i+1),
+					Transfer: "none",
+					Type:     xmlType{Name: d.typeName(p)},
+				})
+			}
+		}
+		signals = append(signals, s)
+	}
+	return signals
+}
+
+// enumValue is the C layout of GEnumValue and GFlagsValue
+type enumValue struct {
+	value int32
+	name  uintptr
+	nick  uintptr
+}
+
+// enumClass is the C layout of GEnumClass
+type enumClass struct {
+	gtype   types.GType
+	minimum int32
+	maximum int32
+	nValues uint32
+	values  uintptr
+}
+
+// flagsClass is the C layout of GFlagsClass
+type flagsClass struct {
+	gtype   types.GType
+	mask    uint32
+	nValues uint32
+	values  uintptr
+}
+
+func (d *describer) enum(t types.GType) xmlEnum {
+	class := xTypeClassRef(t)
+	defer xTypeClassUnref(class)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	c := (*enumClass)(*(*unsafe.Pointer)(unsafe.Pointer(&class)))
+	return d.enumeration(t, c.values, c.nValues)
+}
+
+func (d *describer) flags(t types.GType) xmlEnum {
+	class := xTypeClassRef(t)
+	defer xTypeClassUnref(class)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	c := (*flagsClass)(*(*unsafe.Pointer)(unsafe.Pointer(&class)))
+	return d.enumeration(t, c.values, c.nValues)
+}
+
+// enumeration describes the enum or flags type with the array of values
+func (d *describer) enumeration(t types.GType, values uintptr, n uint32) xmlEnum {
+	name := gobject.TypeName(t)
+	e := xmlEnum{
+		Name:     strings.TrimPrefix(name, d.ns.Prefix),
+		CType:    name,
+		TypeName: name,
+		GetType:  "intern",
+	}
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	for _, v := range unsafe.Slice((*enumValue)(*(*unsafe.Pointer)(unsafe.Pointer(&values))), n) {
+		nick := core.GoString(v.nick)
+		e.Members = append(e.Members, xmlMember{
+			Name:        strings.ReplaceAll(nick, "-", "_"),
+			Value:       fmt.Sprint(v.value),
+			CIdentifier: core.GoString(v.name),
+			Nick:        nick,
+		})
+	}
+	return e
+}
+
+// typeArray calls a GObject function that returns an array of types and converts it
+func typeArray(fn func(types.GType, *uint) uintptr, t types.GType) []types.GType {
+	var n uint
+	arr := fn(t, &n)
+	if arr == 0 {
+		return nil
+	}
+	defer glib.Free(arr)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return append([]types.GType(nil), unsafe.Slice((*types.GType)(*(*unsafe.Pointer)(unsafe.Pointer(&arr))), n)...)
+}
+
+var (
+	xTypeClassRef              func(types.GType) uintptr
+	xTypeClassUnref            func(uintptr)
+	xObjectClassListProperties func(uintptr, *uint) uintptr
+	xSignalQuery               func(uint, *signalQuery)
+)
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xTypeClassRef, libs, "g_type_class_ref")
+	core.PuregoSafeRegister(&xTypeClassUnref, libs, "g_type_class_unref")
+	core.PuregoSafeRegister(&xObjectClassListProperties, libs, "g_object_class_list_properties")
+	core.PuregoSafeRegister(&xSignalQuery, libs, "g_signal_query")
+}
+
+// The GIR elements, see https://gitlab.gnome.org/GNOME/gobject-introspection/-/blob/main/docs/gir-1.2.rnc
+
+type xmlRepository struct {
+	XMLName   xml.Name     `xml:"repository"`
+	Version   string       `xml:"version,attr"`
+	Xmlns     string       `xml:"xmlns,attr"`
+	XmlnsC    string       `xml:"xmlns:c,attr"`
+	XmlnsG    string       `xml:"xmlns:glib,attr"`
+	Includes  []xmlInclude `xml:"include"`
+	Namespace xmlNamespace `xml:"namespace"`
+}
+
+type xmlInclude struct {
+	Name    string `xml:"name,attr"`
+	Version string `xml:"version,attr"`
+}
+
+type xmlNamespace struct {
+	Name             string         `xml:"name,attr"`
+	Version          string         `xml:"version,attr"`
+	IdentifierPrefix string         `xml:"c:identifier-prefixes,attr"`
+	SymbolPrefix     string         `xml:"c:symbol-prefixes,attr"`
+	Classes          []xmlClass     `xml:"class"`
+	Interfaces       []xmlInterface `xml:"interface"`
+	Enums            []xmlEnum      `xml:"enumeration"`
+	Bitfields        []xmlEnum      `xml:"bitfield"`
+}
+
+type xmlClass struct {
+	Name       string        `xml:"name,attr"`
+	CType      string        `xml:"c:type,attr"`
+	Parent     string        `xml:"parent,attr"`
+	Abstract   string        `xml:"abstract,attr,omitempty"`
+	Final      string        `xml:"final,attr,omitempty"`
+	TypeName   string        `xml:"glib:type-name,attr"`
+	GetType    string        `xml:"glib:get-type,attr"`
+	Implements []xmlRef      `xml:"implements"`
+	Properties []xmlProperty `xml:"property"`
+	Signals    []xmlSignal   `xml:"glib:signal"`
+}
+
+type xmlInterface struct {
+	Name          string        `xml:"name,attr"`
+	CType         string        `xml:"c:type,attr"`
+	TypeName      string        `xml:"glib:type-name,attr"`
+	GetType       string        `xml:"glib:get-type,attr"`
+	Prerequisites []xmlRef      `xml:"prerequisite"`
+	Properties    []xmlProperty `xml:"property"`
+	Signals       []xmlSignal   `xml:"glib:signal"`
+}
+
+type xmlRef struct {
+	Name string `xml:"name,attr"`
+}
+
+type xmlDoc struct {
+	Text string `xml:",chardata"`
+}
+
+type xmlType struct {
+	Name string `xml:"name,attr"`
+}
+
+type xmlProperty struct {
+	Name          string  `xml:"name,attr"`
+	Readable      string  `xml:"readable,attr,omitempty"`
+	Writable      string  `xml:"writable,attr,omitempty"`
+	Construct     string  `xml:"construct,attr,omitempty"`
+	ConstructOnly string  `xml:"construct-only,attr,omitempty"`
+	Deprecated    string  `xml:"deprecated,attr,omitempty"`
+	Transfer      string  `xml:"transfer-ownership,attr"`
+	Doc           *xmlDoc `xml:"doc"`
+	Type          xmlType `xml:"type"`
+}
+
+type xmlSignal struct {
+	Name       string         `xml:"name,attr"`
+	When       string         `xml:"when,attr"`
+	Action     string         `xml:"action,attr,omitempty"`
+	Detailed   string         `xml:"detailed,attr,omitempty"`
+	NoRecurse  string         `xml:"no-recurse,attr,omitempty"`
+	NoHooks    string         `xml:"no-hooks,attr,omitempty"`
+	Deprecated string         `xml:"deprecated,attr,omitempty"`
+	Return     xmlReturn      `xml:"return-value"`
+	Parameters *xmlParameters `xml:"parameters"`
+}
+
+type xmlReturn struct {
+	Transfer string  `xml:"transfer-ownership,attr"`
+	Type     xmlType `xml:"type"`
+}
+
+type xmlParameters struct {
+	Parameters []xmlParameter `xml:"parameter"`
+}
+
+type xmlParameter struct {
+	Name     string  `xml:"name,attr"`
+	Transfer string  `xml:"transfer-ownership,attr"`
+	Type     xmlType `xml:"type"`
+}
+
+type xmlEnum struct {
+	Name     string      `xml:"name,attr"`
+	CType    string      `xml:"c:type,attr"`
+	TypeName string      `xml:"glib:type-name,attr"`
+	GetType  string      `xml:"glib:get-type,attr"`
+	Members  []xmlMember `xml:"member"`
+}
+
+type xmlMember struct {
+	Name        string `xml:"name,attr"`
+	Value       string `xml:"value,attr"`
+	CIdentifier string `xml:"c:identifier,attr"`
+	Nick        string `xml:"glib:nick,attr"`
+}
@@ -0,0 +1,15 @@
+//go:build !puregotk_no_deprecated
+
+package introspect
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// defaultInterface returns the default vtable of the interface t and the function that releases it,
+// g_type_default_interface_ref works with all versions of GLib
+func defaultInterface(t types.GType) (*gobject.TypeInterface, func()) {
+	iface := gobject.TypeDefaultInterfaceRef(t)
+	return iface, func() { gobject.TypeDefaultInterfaceUnref(iface) }
+}
@@ -1,11 +1,17 @@
 // Package gobject was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gobject
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 type ValueDataUnion = uintptr
 
-type IOCondition int
+type IOCondition uint32
 
 var xIOConditionGLibType func() types.GType
 
@@ -26,3 +32,76 @@ const (
 
 	GIoNvalValue IOCondition = 32
 )
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (IOCondition) GLibType() types.GType {
+	return xIOConditionGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x IOCondition) Has(flags IOCondition) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x IOCondition) Set(flags IOCondition) IOCondition {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x IOCondition) Clear(flags IOCondition) IOCondition {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x IOCondition) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&GIoInValue != 0 && x&GIoInValue == GIoInValue {
+		names = append(names, "G_IO_IN")
+		rest &^= GIoInValue
+	}
+	if rest&GIoOutValue != 0 && x&GIoOutValue == GIoOutValue {
+		names = append(names, "G_IO_OUT")
+		rest &^= GIoOutValue
+	}
+	if rest&GIoPriValue != 0 && x&GIoPriValue == GIoPriValue {
+		names = append(names, "G_IO_PRI")
+		rest &^= GIoPriValue
+	}
+	if rest&GIoErrValue != 0 && x&GIoErrValue == GIoErrValue {
+		names = append(names, "G_IO_ERR")
+		rest &^= GIoErrValue
+	}
+	if rest&GIoHupValue != 0 && x&GIoHupValue == GIoHupValue {
+		names = append(names, "G_IO_HUP")
+		rest &^= GIoHupValue
+	}
+	if rest&GIoNvalValue != 0 && x&GIoNvalValue == GIoNvalValue {
+		names = append(names, "G_IO_NVAL")
+		rest &^= GIoNvalValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+func init() {
+	core.SetPackageName("GOBJECT", "gobject-2.0")
+	core.SetSharedLibraries("GOBJECT", []string{"libgobject-2.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xIOConditionGLibType, libs, "g_io_condition_get_type")
+
+}
@@ -2,9 +2,12 @@ package gobject
 
 import (
 	"reflect"
+	"sync"
 	"unsafe"
 
+	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 type Ptr interface {
@@ -30,7 +33,174 @@ func IncreaseRef(a uintptr) {
 }
 
 func SignalConnect(a uintptr, b string, c uintptr) uint {
-	return xSignalConnectData(a, b, c, 0, 0, 0)
+	handlerID := uint(xSignalConnectData(a, b, c, 0, 0, 0))
+	rememberHandler(handlerID, &signalHandler{instance: a, signal: b, callback: c})
+	return handlerID
+}
+
+// signalCallback is the user data of a handler connected with SignalConnectCallback
+type signalCallback struct {
+	cbPtr     uintptr
+	handlerID uint
+}
+
+var (
+	signalCallbackNotifyOnce sync.Once
+	// signalCallbackNotifyCb releases the callback of a handler connected with SignalConnectCallback
+	signalCallbackNotifyCb uintptr
+)
+
+func signalCallbackNotifyCallback() uintptr {
+	signalCallbackNotifyOnce.Do(func() {
+		signalCallbackNotifyCb = core.NewCallback(func(id uintptr, _ uintptr) {
+			defer core.RecoverPanic()
+			sc, ok := glib.TakeUserData[*signalCallback](id)
+			if !ok {
+				return
+			}
+			signalFuncsMu.Lock()
+			handlerID := sc.handlerID
+			signalFuncsMu.Unlock()
+			glib.RemoveCallbackByHandler(handlerID)
+			forgetHandler(handlerID)
+			glib.ReleaseSignalCallback(sc.cbPtr)
+		})
+	})
+	return signalCallbackNotifyCb
+}
+
+// SignalConnectCallback connects the callback c of the Go function at cbPtr to the signal b of instance a,
+// c must be taken with glib.AcquireSignalCallback or glib.SaveSignalCallback.
+// The reference to c is released when GLib destroys the closure of the handler, e.g. when it is disconnected
+// or the instance is finalized, so handlers that are never disconnected with DisconnectSignal are cleaned up as well.
+// GLib destroys the closure only after the emissions that call it on other threads returned,
+// so c is never released while it can still be called.
+// Users should not need to call this, the generated ConnectX methods do.
+func SignalConnectCallback(a uintptr, b string, c uintptr, cbPtr uintptr) uint {
+	sc := &signalCallback{cbPtr: cbPtr}
+	id := glib.RegisterUserData(sc)
+	handlerID := uint(xSignalConnectData(a, b, c, id, signalCallbackNotifyCallback(), 0))
+	if handlerID == 0 {
+		// GLib does not call the notify if the signal does not exist
+		glib.UnregisterUserData(id)
+		glib.ReleaseSignalCallback(cbPtr)
+		return 0
+	}
+	signalFuncsMu.Lock()
+	sc.handlerID = handlerID
+	signalFuncsMu.Unlock()
+	glib.SaveHandlerMapping(handlerID, cbPtr)
+	rememberHandler(handlerID, &signalHandler{instance: a, signal: b, callback: c, data: id})
+	return handlerID
+}
+
+// signalFunc is the user data of a handler connected with SignalConnectFunc
+type signalFunc struct {
+	fn        interface{}
+	handlerID uint
+}
+
+var (
+	// signalFuncsMu guards the trampolines and the handler IDs of signalFunc and signalCallback
+	signalFuncsMu        sync.Mutex
+	signalFuncNotifyOnce sync.Once
+	// signalFuncNotifyCb releases the Go function of a handler connected with SignalConnectFunc
+	signalFuncNotifyCb uintptr
+)
+
+func signalFuncNotifyCallback() uintptr {
+	signalFuncNotifyOnce.Do(func() {
+		signalFuncNotifyCb = core.NewCallback(func(id uintptr, _ uintptr) {
+			defer core.RecoverPanic()
+			sf, ok := glib.TakeUserData[*signalFunc](id)
+			if !ok {
+				return
+			}
+			signalFuncsMu.Lock()
+			handlerID := sf.handlerID
+			signalFuncsMu.Unlock()
+			forgetHandler(handlerID)
+		})
+	})
+	return signalFuncNotifyCb
+}
+
+// SignalConnectFunc connects the Go function fn to the signal b of instance a.
+// Unlike SignalConnect, fn is stored by connection and passed to the trampoline as user data,
+// so any function, method value or closure can be connected without allocating a callback per handler:
+// the trampoline is created once per signal by newTrampoline and stored in trampoline.
+// fn is released when the handler is disconnected or the instance is finalized.
+// Users should not need to call this, the generated ConnectXFunc methods do.
+func SignalConnectFunc(a uintptr, b string, trampoline *uintptr, newTrampoline func() interface{}, fn interface{}) uint {
+	signalFuncsMu.Lock()
+	if *trampoline == 0 {
+		*trampoline = core.NewCallback(newTrampoline())
+	}
+	cb := *trampoline
+	signalFuncsMu.Unlock()
+	sf := &signalFunc{fn: fn}
+	id := glib.RegisterUserData(sf)
+	// fn is released by an invalidate notifier, which is called as soon as the handler is disconnected,
+	// a GClosureNotify of g_signal_connect_data is only called once the closure is finalized
+	closure := xCClosureNew(cb, id, 0)
+	xClosureAddInvalidateNotifier(closure.GoPointer(), id, signalFuncNotifyCallback())
+	handlerID := uint(xSignalConnectClosure(a, b, closure, false))
+	if handlerID == 0 {
+		// the closure is still floating if the signal does not exist, sinking it finalizes it and releases fn
+		xClosureSink(closure.GoPointer())
+		return 0
+	}
+	signalFuncsMu.Lock()
+	sf.handlerID = handlerID
+	signalFuncsMu.Unlock()
+	rememberHandler(handlerID, &signalHandler{instance: a, signal: b, callback: cb, data: id, fn: fn})
+	return handlerID
+}
+
+// SignalFunc returns the Go function of the handler connected with SignalConnectFunc for the user data of the trampoline.
+// Users should not need to call this.
+func SignalFunc(data uintptr) interface{} {
+	if sf, ok := glib.LookupUserData[*signalFunc](data); ok {
+		return sf.fn
+	}
+	return nil
+}
+
+var (
+	closureNotifyOnce sync.Once
+	// closureNotifyCb is the single GClosureNotify shared by all notifications
+	// the Go function is looked up by the notify data such that only one callback is allocated
+	closureNotifyCb uintptr
+)
+
+func closureNotifyCallback() uintptr {
+	closureNotifyOnce.Do(func() {
+		closureNotifyCb = core.NewCallback(func(id uintptr, _ uintptr) {
+			defer core.RecoverPanic()
+			if fn, ok := glib.TakeUserData[func()](id); ok {
+				fn()
+			}
+		})
+	})
+	return closureNotifyCb
+}
+
+// registerClosureNotify stores fn and returns the notify data key that it is called for
+func registerClosureNotify(fn func()) uintptr {
+	return glib.RegisterUserData(fn)
+}
+
+// OnInvalidate calls fn once when the closure is invalidated.
+// This happens e.g. when the signal handler, source or expression that owns the closure is destroyed.
+// Use it to release Go state of closures that are owned by GTK and not connected through ConnectSignal,
+// such as closures created for builder scopes, shortcuts or actions.
+func (x *Closure) OnInvalidate(fn func()) {
+	xClosureAddInvalidateNotifier(x.GoPointer(), registerClosureNotify(fn), closureNotifyCallback())
+}
+
+// OnFinalize calls fn once when the last reference to the closure is dropped.
+func (x *Closure) OnFinalize(fn func()) {
+	xClosureAddFinalizeNotifier(x.GoPointer(), registerClosureNotify(fn), closureNotifyCallback())
 }
 
 func (o Object) Cast(v Ptr) {
@@ -39,22 +209,21 @@ func (o Object) Cast(v Ptr) {
 
 func (o Object) ConnectSignal(signal string, cb *func()) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := SignalConnect(o.GoPointer(), signal, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func() {
+			defer core.RecoverPanic()
+			(*cb)()
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-
-	cbRefPtr := glib.NewCallback(cb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := SignalConnect(o.GoPointer(), signal, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return SignalConnectCallback(o.GoPointer(), signal, cbRefPtr, cbPtr)
 }
 
 func (o Object) DisconnectSignal(handler uint) {
-	SignalHandlerDisconnect(&o, handler)
+	SignalHandlerDisconnect(&o, types.ULong(handler))
 	glib.RemoveCallbackByHandler(handler)
+	forgetHandler(handler)
 }
 
 // types
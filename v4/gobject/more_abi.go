@@ -0,0 +1,103 @@
+package gobject
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// checkABI is set with PUREGOTK_DEBUG=abi
+var checkABI = core.Debug("abi")
+
+// ABIMismatch is a class or interface structure whose Go struct does not have the size that the installed library registered,
+// its fields are at the wrong offsets, e.g. because the library is newer or older than the GIR files the bindings were generated from.
+type ABIMismatch struct {
+	// Name is the C name of the structure, e.g. "GtkWidgetClass"
+	Name string
+	// GoSize and CSize are the sizes in bytes of the Go struct and of the structure in the library
+	GoSize, CSize uintptr
+}
+
+func (m ABIMismatch) String() string {
+	return fmt.Sprintf("%s has %d bytes in Go but %d bytes in the installed library", m.Name, m.GoSize, m.CSize)
+}
+
+// classSizeCheck is a check passed to CheckClassSize
+type classSizeCheck struct {
+	name  string
+	gtype func() types.GType
+	size  uintptr
+}
+
+var abi = struct {
+	sync.Mutex
+	// ready is set once the functions of this package are loaded, the checks of its own classes wait for it
+	ready      bool
+	pending    []classSizeCheck
+	mismatches []ABIMismatch
+}{}
+
+// typeQuery is the C layout of GTypeQuery, the sizes are guint
+type typeQuery struct {
+	gtype        types.GType
+	typeName     uintptr
+	classSize    uint32
+	instanceSize uint32
+}
+
+// CheckClassSize compares size, the size of the Go struct of the class or interface structure name,
+// with the size of the class or interface of the type that gtype returns in the installed library.
+// The generated packages call it for their class and interface structures when they are loaded.
+// It only checks with the environment variable PUREGOTK_DEBUG=abi, a mismatch is written to standard error
+// and returned by ABIMismatches. Overriding virtual functions of a mismatched class writes to the wrong offsets.
+func CheckClassSize(name string, gtype func() types.GType, size uintptr) {
+	if !checkABI || gtype == nil {
+		return
+	}
+	c := classSizeCheck{name: name, gtype: gtype, size: size}
+	abi.Lock()
+	if !abi.ready {
+		abi.pending = append(abi.pending, c)
+		abi.Unlock()
+		return
+	}
+	abi.Unlock()
+	c.run()
+}
+
+func (c classSizeCheck) run() {
+	var q typeQuery
+	NewTypeQuery(c.gtype(), (*TypeQuery)(unsafe.Pointer(&q)))
+	if q.gtype == 0 || uintptr(q.classSize) == c.size {
+		return
+	}
+	m := ABIMismatch{Name: c.name, GoSize: c.size, CSize: uintptr(q.classSize)}
+	abi.Lock()
+	abi.mismatches = append(abi.mismatches, m)
+	abi.Unlock()
+	fmt.Fprintf(os.Stderr, "puregotk: ABI mismatch: %s, the bindings were generated for another version of the library\n", m)
+}
+
+// ABIMismatches returns the mismatches that CheckClassSize found so far, e.g. to fail a test on them.
+// It is always empty without the environment variable PUREGOTK_DEBUG=abi.
+func ABIMismatches() []ABIMismatch {
+	abi.Lock()
+	defer abi.Unlock()
+	return append([]ABIMismatch(nil), abi.mismatches...)
+}
+
+func init() {
+	// the files of the package are initialized in the order of their names, this one after the generated ones
+	abi.Lock()
+	abi.ready = true
+	pending := abi.pending
+	abi.pending = nil
+	abi.Unlock()
+	for _, c := range pending {
+		c.run()
+	}
+}
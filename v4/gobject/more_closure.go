@@ -0,0 +1,58 @@
+package gobject
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// closureSize is the size of the C GClosure, its bit fields take a guint and are followed by three pointers
+// the generated Closure struct has a field per bit field and is larger
+const closureSize = 4 * unsafe.Sizeof(uintptr(0))
+
+// closureDataOffset is the offset of the data pointer in the C GClosure, after the bit fields and the marshal
+const closureDataOffset = 2 * unsafe.Sizeof(uintptr(0))
+
+var (
+	closureMarshalOnce sync.Once
+	// closureMarshalCb is the single GClosureMarshal shared by all Go closures
+	// the Go function is looked up by the data of the closure such that only one callback is allocated
+	closureMarshalCb uintptr
+)
+
+func closureMarshalCallback() uintptr {
+	closureMarshalOnce.Do(func() {
+		closureMarshalCb = core.NewCallback(func(closure uintptr, ret uintptr, n uint, params uintptr, _ uintptr, _ uintptr) {
+			defer core.RecoverPanic()
+			// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+			id := *(*uintptr)(unsafe.Add(*(*unsafe.Pointer)(unsafe.Pointer(&closure)), closureDataOffset))
+			fn, ok := glib.LookupUserData[func(ret *Value, params []Value)](id)
+			if !ok {
+				return
+			}
+			var values []Value
+			if n > 0 && params != 0 {
+				// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+				values = unsafe.Slice((*Value)(*(*unsafe.Pointer)(unsafe.Pointer(&params))), n)
+			}
+			fn((*Value)(*(*unsafe.Pointer)(unsafe.Pointer(&ret))), values)
+		})
+	})
+	return closureMarshalCb
+}
+
+// NewClosureFunc returns a floating closure that calls fn when it is invoked, for the APIs that take a GClosure,
+// such as gtk.NewClosureExpression. fn is called with the return value, which is initialized to the return type
+// and nil if the caller ignores it, see Value.SetGo, and with the parameter values, which are owned by the caller.
+// The function is released when the closure is invalidated, e.g. by its owner, or finalized.
+func NewClosureFunc(fn func(ret *Value, params []Value)) *Closure {
+	id := glib.RegisterUserData(fn)
+	c := NewClosureSimple(uint(closureSize), id)
+	ptr := c.GoPointer()
+	// finalizing a closure invalidates it first, so the notifier is called in both cases
+	xClosureAddInvalidateNotifier(ptr, id, glib.UserDataDestroyCallback())
+	xClosureSetMarshal(ptr, closureMarshalCallback())
+	return c
+}
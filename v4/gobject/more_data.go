@@ -0,0 +1,39 @@
+package gobject
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+func dataQuark(key string) glib.Quark {
+	return glib.QuarkFromString(&key)
+}
+
+// SetData attaches a Go value to the object under key, replacing a value that was set before.
+// The value is released when the object is finalized, so Go state can live exactly as long as the object,
+// e.g. the model behind a widget:
+//
+//	gobject.SetData(&row.Object, "item", item)
+//	item, ok := gobject.GetData[*Item](&row.Object, "item")
+//
+// The key is a GQuark, so values set from C with the same key are replaced as well.
+func SetData[T any](obj Ptr, key string, value T) {
+	// the object stores the ID of the value as qdata, the value is released when GLib destroys the qdata
+	id := glib.RegisterUserData(value)
+	xObjectSetQdataFull(obj.GoPointer(), dataQuark(key), id, glib.UserDataDestroyCallback())
+}
+
+// GetData returns the value attached to the object under key with SetData.
+// It returns false if there is no value or it is not of type T.
+func GetData[T any](obj Ptr, key string) (T, bool) {
+	var zero T
+	id := xObjectGetQdata(obj.GoPointer(), dataQuark(key))
+	if id == 0 {
+		return zero, false
+	}
+	return glib.LookupUserData[T](id)
+}
+
+// DeleteData removes the value attached to the object under key.
+func DeleteData(obj Ptr, key string) {
+	xObjectSetQdata(obj.GoPointer(), dataQuark(key), 0)
+}
@@ -0,0 +1,114 @@
+package gobject
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// signalHandler is a handler connected through SignalConnect or SignalConnectFunc
+type signalHandler struct {
+	instance uintptr
+	signal   string
+	// callback and data are the C callback and user data of the handler, which identify it for g_signal_handler_find
+	callback uintptr
+	data     uintptr
+	// fn is the Go function of SignalConnectFunc
+	fn interface{}
+}
+
+var signalHandlers = struct {
+	sync.Mutex
+	byID map[uint]*signalHandler
+}{
+	byID: make(map[uint]*signalHandler),
+}
+
+func rememberHandler(handlerID uint, h *signalHandler) {
+	if handlerID == 0 {
+		return
+	}
+	signalHandlers.Lock()
+	signalHandlers.byID[handlerID] = h
+	signalHandlers.Unlock()
+}
+
+func forgetHandler(handlerID uint) {
+	signalHandlers.Lock()
+	delete(signalHandlers.byID, handlerID)
+	signalHandlers.Unlock()
+}
+
+// HandlerInfo describes a signal handler returned by HandlersOf.
+type HandlerInfo struct {
+	// ID is the handler ID as returned by the Connect method
+	ID uint
+	// Signal is the signal name including the detail, e.g. "notify::label"
+	Signal string
+	// Blocked is true while the handler is blocked with SignalHandlerBlock
+	Blocked bool
+	// Func is the name of the Go function of the handler, e.g. "main.(*App).onClicked-fm" for a method value
+	// or "main.main.func1" for a closure, empty if it is not known
+	Func string
+}
+
+// HandlersOf returns the signal handlers connected to the object through the Connect methods, sorted by ID.
+// It helps to find handlers that are connected more than once or never disconnected.
+// Handlers connected by C code, e.g. by GTK itself, are not included.
+func HandlersOf(obj Ptr) []HandlerInfo {
+	instance := obj.GoPointer()
+	type entry struct {
+		id uint
+		h  *signalHandler
+	}
+	var entries []entry
+	signalHandlers.Lock()
+	for id, h := range signalHandlers.byID {
+		if h.instance == instance {
+			entries = append(entries, entry{id, h})
+		}
+	}
+	signalHandlers.Unlock()
+
+	infos := make([]HandlerInfo, 0, len(entries))
+	for _, e := range entries {
+		if !xSignalHandlerIsConnected(instance, types.ULong(e.id)) {
+			continue
+		}
+		fn := e.h.fn
+		if fn == nil {
+			fn, _ = glib.CallbackByHandler(e.id)
+		}
+		unblocked := xSignalHandlerFind(instance, GSignalMatchFuncValue|GSignalMatchDataValue|GSignalMatchUnblockedValue, 0, 0, nil, e.h.callback, e.h.data)
+		infos = append(infos, HandlerInfo{
+			ID:      e.id,
+			Signal:  e.h.signal,
+			Blocked: unblocked == 0,
+			Func:    funcName(fn),
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// funcName returns the name of the Go function fn, which can also be a pointer to a function
+func funcName(fn interface{}) string {
+	v := reflect.ValueOf(fn)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return ""
+	}
+	return f.Name()
+}
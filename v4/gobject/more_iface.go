@@ -0,0 +1,70 @@
+package gobject
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// interfaceInfo is the C layout of GInterfaceInfo
+// the generated struct has Go function fields that cannot be called from C
+type interfaceInfo struct {
+	init     uintptr
+	finalize uintptr
+	data     uintptr
+}
+
+var (
+	interfaceInitOnce sync.Once
+	// interfaceInitCb is the single GInterfaceInitFunc shared by all Go interface implementations
+	// the Go function is looked up by the interface data such that only one callback is allocated
+	interfaceInitCb uintptr
+)
+
+func interfaceInitCallback() uintptr {
+	interfaceInitOnce.Do(func() {
+		interfaceInitCb = core.NewCallback(func(iface uintptr, id uintptr) {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(iface uintptr)](id); ok {
+				fn(iface)
+			}
+		})
+	})
+	return interfaceInitCb
+}
+
+// TypeAddInterfaceFunc adds the interface to the type registered by Go, e.g. with TypeRegisterStaticSimple.
+// init is called once with the address of the interface vtable, such as GListModelInterface, when the class is initialized.
+// Use ImplementInterface to get the vtable as its Go struct instead.
+// Interfaces must be added before the first instance of the type is created.
+func TypeAddInterfaceFunc(instanceType, interfaceType types.GType, init func(iface uintptr)) {
+	// types are never unregistered, so init is kept for the lifetime of the process
+	info := interfaceInfo{init: interfaceInitCallback(), data: glib.RegisterUserData(init)}
+	// GLib copies the info, so it does not need to outlive the call
+	TypeAddInterfaceStatic(instanceType, interfaceType, (*InterfaceInfo)(unsafe.Pointer(&info)))
+}
+
+// ImplementInterface declares that the Go type instanceType implements the interface.
+// init fills the vtable of the interface with the Override methods of its struct, e.g.
+//
+//	gobject.ImplementInterface(myModelType, gio.ListModelGLibType(), func(iface *gio.ListModelInterface) {
+//		iface.OverrideGetItemType(func(gio.ListModel) types.GType { return gobject.TypeObjectVal })
+//		iface.OverrideGetNItems(func(m gio.ListModel) uint { return uint(len(rows[m.GoPointer()])) })
+//		iface.OverrideGetItem(func(m gio.ListModel, i uint) *gobject.Object { return itemAt(m, i) })
+//	})
+//
+// T must be the vtable struct of the interface, e.g. gio.ListModelInterface or gtk.BuildableIface.
+// Interfaces without virtual functions but with properties, such as gtk.Orientable,
+// are implemented with a nil init and ObjectClass.OverrideProperty in the class init function.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementInterface[T any](instanceType, interfaceType types.GType, init func(iface *T)) {
+	TypeAddInterfaceFunc(instanceType, interfaceType, func(iface uintptr) {
+		if init != nil {
+			// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+			init((*T)(*(*unsafe.Pointer)(unsafe.Pointer(&iface))))
+		}
+	})
+}
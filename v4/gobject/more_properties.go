@@ -0,0 +1,171 @@
+package gobject
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// Param is a property of a Go class, created with one of the Param functions and installed with InstallFieldProperties.
+type Param struct {
+	// Spec is the param spec of the property, it is owned by the class once installed
+	Spec  *ParamSpec
+	field string
+}
+
+// paramFlags removes the static string flags, the Go strings passed to the param spec do not outlive the call
+func paramFlags(flags ParamFlags) ParamFlags {
+	return flags &^ (GParamStaticNameValue | GParamStaticNickValue | GParamStaticBlurbValue)
+}
+
+func newParam(spec *ParamSpec, name string) *Param {
+	if spec == nil {
+		panic(fmt.Sprintf("gobject: invalid property %q", name))
+	}
+	return &Param{Spec: spec, field: fieldName(name)}
+}
+
+// fieldName returns the Go field name of a property, e.g. "MaxWidth" for "max-width"
+func fieldName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	for i, p := range parts {
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// ParamString returns a string property with the default value def.
+func ParamString(name string, flags ParamFlags, def string) *Param {
+	return newParam(NewParamSpecString(name, nil, nil, &def, paramFlags(flags)), name)
+}
+
+// ParamBool returns a boolean property with the default value def.
+func ParamBool(name string, flags ParamFlags, def bool) *Param {
+	return newParam(NewParamSpecBoolean(name, nil, nil, def, paramFlags(flags)), name)
+}
+
+// ParamInt returns an int property that ranges from min to max with the default value def.
+func ParamInt(name string, flags ParamFlags, min, max, def int) *Param {
+	return newParam(NewParamSpecInt(name, nil, nil, min, max, def, paramFlags(flags)), name)
+}
+
+// ParamUint returns an unsigned int property that ranges from min to max with the default value def.
+func ParamUint(name string, flags ParamFlags, min, max, def uint) *Param {
+	return newParam(ParamSpecUint(name, nil, nil, min, max, def, paramFlags(flags)), name)
+}
+
+// ParamInt64 returns a 64 bit int property that ranges from min to max with the default value def.
+func ParamInt64(name string, flags ParamFlags, min, max, def int64) *Param {
+	return newParam(NewParamSpecInt64(name, nil, nil, min, max, def, paramFlags(flags)), name)
+}
+
+// ParamDouble returns a double property that ranges from min to max with the default value def.
+func ParamDouble(name string, flags ParamFlags, min, max, def float64) *Param {
+	return newParam(NewParamSpecDouble(name, nil, nil, min, max, def, paramFlags(flags)), name)
+}
+
+// ParamEnum returns a property of the enum type gtype, e.g. gtk.AlignGLibType(), with the default value def.
+func ParamEnum(name string, flags ParamFlags, gtype types.GType, def int) *Param {
+	return newParam(NewParamSpecEnum(name, nil, nil, gtype, def, paramFlags(flags)), name)
+}
+
+// ParamObject returns a property that holds an object of the type gtype or its subclasses, e.g. gtk.WidgetGLibType().
+func ParamObject(name string, flags ParamFlags, gtype types.GType) *Param {
+	return newParam(NewParamSpecObject(name, nil, nil, gtype, paramFlags(flags)), name)
+}
+
+// Field sets the name of the Go struct field that holds the property.
+// By default it is the property name in camel case, e.g. "MaxWidth" for "max-width".
+func (p *Param) Field(name string) *Param {
+	p.field = name
+	return p
+}
+
+// InstallFieldProperties installs the properties on a class registered by Go and stores their values in the fields of the Go struct T,
+// instance returns the struct of an instance of the class. Call it in the class init function:
+//
+//	type Card struct {
+//		Title string
+//		Count int
+//		Icon  *gtk.Image
+//	}
+//
+//	classInit := gobject.ClassInitFunc(func(class *gobject.TypeClass, _ uintptr) {
+//		objClass := (*gobject.ObjectClass)(unsafe.Pointer(class))
+//		gobject.InstallFieldProperties(objClass, func(obj *gobject.Object) *Card {
+//			card, _ := gobject.GetData[*Card](obj, "card")
+//			return card
+//		},
+//			gobject.ParamString("title", gobject.GParamReadwriteValue, ""),
+//			gobject.ParamInt("count", gobject.GParamReadwriteValue, 0, 100, 0),
+//			gobject.ParamObject("icon", gobject.GParamReadwriteValue, gtk.ImageGLibType()),
+//		)
+//	})
+//
+// It overrides get_property and set_property of the class, the values are converted as with Value.InitGo and Value.AssignTo.
+// Object fields hold a reference to their object, set the property to nil in dispose to release it.
+// It panics if T has no field for a property, a value that cannot be converted panics in the handler.
+func InstallFieldProperties[T any](class *ObjectClass, instance func(obj *Object) *T, params ...*Param) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gobject: cannot install properties on %s, it is not a struct", t))
+	}
+	fields := make([][]int, len(params))
+	for i, p := range params {
+		f, ok := t.FieldByName(p.field)
+		if !ok {
+			panic(fmt.Sprintf("gobject: %s has no field %s for property %q", t, p.field, p.Spec.GetName()))
+		}
+		fields[i] = f.Index
+	}
+	field := func(obj *Object, id uint) reflect.Value {
+		if id == 0 || int(id) > len(params) {
+			panic(fmt.Sprintf("gobject: invalid property id %d", id))
+		}
+		v := instance(obj)
+		if v == nil {
+			panic(fmt.Sprintf("gobject: %s of the instance is nil", t))
+		}
+		return reflect.ValueOf(v).Elem().FieldByIndex(fields[id-1])
+	}
+	// fieldValue returns the value of f for the conversions, struct fields of a generated class are passed by address
+	fieldValue := func(f reflect.Value) interface{} {
+		if f.Kind() == reflect.Struct {
+			return f.Addr().Interface()
+		}
+		return f.Interface()
+	}
+	class.OverrideGetProperty(func(obj *Object, id uint, value *Value, pspec *ParamSpec) {
+		f := field(obj, id)
+		if err := value.setGo(value.GType, fieldValue(f)); err != nil {
+			panic(fmt.Sprintf("gobject: cannot get property %q from %s: %s", pspec.GetName(), f.Type(), err))
+		}
+	})
+	class.OverrideSetProperty(func(obj *Object, id uint, value *Value, pspec *ParamSpec) {
+		f := field(obj, id)
+		var old uintptr
+		isObject := TypeFundamental(value.GType) == TypeObjectVal
+		if isObject {
+			old, _ = goPtr(fieldValue(f))
+		}
+		if err := value.AssignTo(f.Addr().Interface()); err != nil {
+			panic(fmt.Sprintf("gobject: cannot set property %q: %s", pspec.GetName(), err))
+		}
+		if !isObject {
+			return
+		}
+		if ptr, _ := goPtr(fieldValue(f)); ptr != 0 {
+			(&Object{Ptr: ptr}).Ref()
+		}
+		if old != 0 {
+			(&Object{Ptr: old}).Unref()
+		}
+	})
+	for i, p := range params {
+		class.InstallProperty(uint(i+1), p.Spec)
+	}
+}
@@ -0,0 +1,76 @@
+package gobject
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// InstanceType returns the type that obj is an instance of, which may be a subclass of its Go type,
+// e.g. the type of a GtkButton for a *gtk.Widget. It returns TypeInvalidVal for nil.
+func InstanceType(obj Ptr) types.GType {
+	if obj == nil || obj.GoPointer() == 0 {
+		return types.GType(TypeInvalidVal)
+	}
+	return instanceType(obj.GoPointer())
+}
+
+// InstanceTypeName returns the name of the type that obj is an instance of, e.g. "GtkButton", or "" for nil.
+func InstanceTypeName(obj Ptr) string {
+	t := InstanceType(obj)
+	if t == types.GType(TypeInvalidVal) {
+		return ""
+	}
+	return TypeName(t)
+}
+
+// TypeAncestors returns t followed by its parent types up to its fundamental type.
+func TypeAncestors(t types.GType) []types.GType {
+	var ancestors []types.GType
+	for ; t != types.GType(TypeInvalidVal); t = TypeParent(t) {
+		ancestors = append(ancestors, t)
+	}
+	return ancestors
+}
+
+// Ancestors returns the names of the type that obj is an instance of and of its parent types,
+// e.g. [GtkButton GtkWidget GInitiallyUnowned GObject] for a button. It returns nil for nil.
+func Ancestors(obj Ptr) []string {
+	var names []string
+	for _, t := range TypeAncestors(InstanceType(obj)) {
+		names = append(names, TypeName(t))
+	}
+	return names
+}
+
+// TypeInterfaceList returns the interfaces that t implements, including those implemented by its parent types.
+func TypeInterfaceList(t types.GType) []types.GType {
+	var n uint
+	ptr := TypeInterfaces(t, &n)
+	if ptr == 0 {
+		return nil
+	}
+	defer core.GFree(ptr)
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	ifaces := unsafe.Slice((*types.GType)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), int(uint32(n)))
+	return append([]types.GType(nil), ifaces...)
+}
+
+// InterfaceList returns the names of the interfaces that obj implements, e.g. [GtkAccessible GtkBuildable ...] for a widget.
+func InterfaceList(obj Ptr) []string {
+	var names []string
+	if t := InstanceType(obj); t != types.GType(TypeInvalidVal) {
+		for _, iface := range TypeInterfaceList(t) {
+			names = append(names, TypeName(iface))
+		}
+	}
+	return names
+}
+
+// Implements reports whether obj is an instance of ifaceType, which is usually an interface such as
+// gtk.EditableGLibType(), but may be any type, like a class that obj derives from. It returns false for nil.
+func Implements(obj Ptr, ifaceType types.GType) bool {
+	t := InstanceType(obj)
+	return t != types.GType(TypeInvalidVal) && TypeIsA(t, ifaceType)
+}
@@ -0,0 +1,141 @@
+package gobject
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// toggleBox is the state of an object owned by Go through a toggle reference, see Own
+type toggleBox struct {
+	ptr uintptr
+	// wrapper returns the Go wrapper returned by Own from a weak pointer, nil once it is collected
+	wrapper func() interface{}
+	// strong keeps the wrapper reachable while C holds other references to the object
+	strong interface{}
+}
+
+var toggles = struct {
+	sync.Mutex
+	boxes map[uintptr]*toggleBox
+}{
+	boxes: make(map[uintptr]*toggleBox),
+}
+
+var (
+	toggleNotifyOnce sync.Once
+	// toggleNotifyCb is the single GToggleNotify shared by all objects owned by Go
+	toggleNotifyCb uintptr
+)
+
+func toggleNotifyCallback() uintptr {
+	toggleNotifyOnce.Do(func() {
+		toggleNotifyCb = core.NewCallback(func(_ uintptr, obj uintptr, isLast bool) {
+			defer core.RecoverPanic()
+			toggles.Lock()
+			defer toggles.Unlock()
+			box, ok := toggles.boxes[obj]
+			if !ok {
+				return
+			}
+			if isLast {
+				// only Go references the object, the weak pointer lets it be collected with the wrapper
+				box.strong = nil
+			} else if box.strong == nil {
+				// nil if the wrapper was collected already, its cleanup then drops the toggle reference
+				box.strong = box.wrapper()
+			}
+		})
+	})
+	return toggleNotifyCb
+}
+
+// ownedWrapper is the allocation of a wrapper returned by Own.
+// The pointer field keeps it out of the tiny allocator, which packs small allocations without pointers,
+// like wrappers with a single uintptr, into one block that is only freed once all of them are unreachable,
+// such that the cleanup of a wrapper could run late or never.
+type ownedWrapper[T any] struct {
+	wrapper T
+	_       *T
+}
+
+// Own makes Go own obj with a toggle reference, the approach of gotk4,
+// so the object lives exactly as long as the returned wrapper or C code references it,
+// and the Go values tied to it, such as signal handlers and values of SetData, are released with it.
+// It takes over the reference that the caller holds, e.g. of a constructor, which must not be released anymore:
+//
+//	action := gobject.Own(gio.NewSimpleAction("refresh", nil))
+//	action.ConnectActivateFunc(onRefresh)
+//
+// While C holds references to the object, e.g. a container holds a widget, the wrapper is kept reachable.
+// Once only the wrapper references the object and the garbage collector collects the wrapper,
+// the toggle reference is dropped in an idle callback of the main loop, which finalizes the object.
+// The returned wrapper is a copy of obj, only it keeps the object alive,
+// obj and other wrappers of the object, e.g. returned by getters, do not.
+// Call it once per object with the reference of a constructor, it panics if the object is already owned.
+func Own[T any, PT interface {
+	*T
+	Ptr
+}](obj PT) PT {
+	if obj == nil || obj.GoPointer() == 0 {
+		return obj
+	}
+	ptr := obj.GoPointer()
+	owned := &ownedWrapper[T]{wrapper: *obj}
+	w := PT(&owned.wrapper)
+	wp := weak.Make(&owned.wrapper)
+	box := &toggleBox{
+		ptr: ptr,
+		wrapper: func() interface{} {
+			if p := wp.Value(); p != nil {
+				return PT(p)
+			}
+			return nil
+		},
+		// the caller holds a reference until it is released below, which notifies if it was the last one
+		strong: w,
+	}
+	toggles.Lock()
+	if _, ok := toggles.boxes[ptr]; ok {
+		toggles.Unlock()
+		panic("gobject: the object is already owned by Go")
+	}
+	toggles.boxes[ptr] = box
+	toggles.Unlock()
+	o := &Object{Ptr: ptr}
+	if o.IsFloating() {
+		o.RefSink()
+	}
+	xObjectAddToggleRef(ptr, toggleNotifyCallback(), 0)
+	o.Unref()
+	runtime.AddCleanup(&owned.wrapper, releaseToggle, box)
+	return w
+}
+
+// releaseToggle is the cleanup of a wrapper returned by Own
+// objects are not thread safe, so the toggle reference is dropped on the main loop
+func releaseToggle(box *toggleBox) {
+	fn := glib.SourceOnceFunc(func(uintptr) {
+		toggles.Lock()
+		owned := toggles.boxes[box.ptr] == box
+		if owned {
+			delete(toggles.boxes, box.ptr)
+		}
+		toggles.Unlock()
+		if owned {
+			xObjectRemoveToggleRef(box.ptr, toggleNotifyCallback(), 0)
+		}
+	})
+	glib.IdleAddOnce(&fn, 0)
+}
+
+// Owned reports whether the object is owned by Go with Own.
+func Owned(obj Ptr) bool {
+	toggles.Lock()
+	defer toggles.Unlock()
+	_, ok := toggles.boxes[obj.GoPointer()]
+	return ok
+}
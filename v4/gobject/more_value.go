@@ -0,0 +1,423 @@
+package gobject
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// typeVariant is G_TYPE_VARIANT, which is missing from the type constants
+const typeVariant types.GType = 21 << 2
+
+// goPointer is implemented by the generated classes, interfaces and records
+type goPointer interface {
+	GoPointer() uintptr
+}
+
+// InitGo initializes x, which must be zeroed or unset, to the type gtype and sets it to the Go value,
+// e.g. for a property or a column of a list store. The value is converted by the fundamental type of gtype:
+//   - booleans take a bool
+//   - numbers, enums and flags take any Go number, such as an int or a gtk.Align, in the range of the C type
+//   - strings take a string, or nil or a nil *string for NULL
+//   - objects and interfaces take nil or anything with a GoPointer method, such as a *gtk.Widget
+//   - boxed types, pointers and param specs take a uintptr or anything with a GoPointer method, such as a *gdk.RGBA,
+//     boxed types are copied and also take a generated record by value, such as a gdk.RGBA
+//   - variants take nil or a *glib.Variant
+//
+// A *Value holding a type that can be transformed to gtype is accepted for every type.
+// Flags with a GLib type, such as a gio.FileCopyFlags, must be of gtype or its subtypes,
+// gtype may be TypeInvalidVal to take the type of the flags.
+// x is left unset if the value cannot be converted.
+func (x *Value) InitGo(gtype types.GType, value interface{}) error {
+	if f, ok := value.(glibTyped); ok && gtype == TypeInvalidVal {
+		gtype = f.GLibType()
+	}
+	x.Init(gtype)
+	if err := x.setGo(gtype, value); err != nil {
+		x.Unset()
+		return fmt.Errorf("gobject: cannot set %s value from %T: %w", TypeName(gtype), value, err)
+	}
+	return nil
+}
+
+// SetGo sets x, which must be initialized, to the Go value, converted by the type of x as described for InitGo.
+// Use it for values that C initialized, such as the return value of a closure.
+func (x *Value) SetGo(value interface{}) error {
+	if err := x.setGo(x.GType, value); err != nil {
+		return fmt.Errorf("gobject: cannot set %s value from %T: %w", TypeName(x.GType), value, err)
+	}
+	return nil
+}
+
+func (x *Value) setGo(gtype types.GType, value interface{}) error {
+	if v, ok := value.(*Value); ok {
+		if !v.Transform(x) {
+			return fmt.Errorf("cannot transform %s", TypeName(v.GType))
+		}
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	switch TypeFundamental(gtype) {
+	case TypeBooleanVal:
+		if rv.Kind() != reflect.Bool {
+			return errUnsupported
+		}
+		x.SetBoolean(rv.Bool())
+	case TypeCharVal:
+		n, err := goInt[int8](rv)
+		if err != nil {
+			return err
+		}
+		x.SetSchar(n)
+	case TypeUcharVal:
+		n, err := goUint[uint8](rv)
+		if err != nil {
+			return err
+		}
+		x.SetUchar(n)
+	case TypeIntVal:
+		n, err := goInt[int32](rv)
+		if err != nil {
+			return err
+		}
+		x.SetInt(int(n))
+	case TypeUintVal:
+		n, err := goUint[uint32](rv)
+		if err != nil {
+			return err
+		}
+		x.SetUint(uint(n))
+	case TypeLongVal:
+		n, err := goInt[types.Long](rv)
+		if err != nil {
+			return err
+		}
+		x.SetLong(n)
+	case TypeUlongVal:
+		n, err := goUint[types.ULong](rv)
+		if err != nil {
+			return err
+		}
+		x.SetUlong(n)
+	case TypeInt64Val:
+		n, err := goInt[int64](rv)
+		if err != nil {
+			return err
+		}
+		x.SetInt64(n)
+	case TypeUint64Val:
+		n, err := goUint[uint64](rv)
+		if err != nil {
+			return err
+		}
+		x.SetUint64(n)
+	case TypeEnumVal:
+		n, err := goInt[int32](rv)
+		if err != nil {
+			return err
+		}
+		x.SetEnum(int(n))
+	case TypeFlagsVal:
+		if f, ok := value.(glibTyped); ok && !TypeIsA(f.GLibType(), gtype) {
+			return fmt.Errorf("the flags are of the type %s", TypeName(f.GLibType()))
+		}
+		n, err := goUint[uint32](rv)
+		if err != nil {
+			return err
+		}
+		x.SetFlags(uint(n))
+	case TypeFloatVal:
+		f, err := goFloat(rv)
+		if err != nil {
+			return err
+		}
+		if math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+			return fmt.Errorf("Go value %v overflows float32", f)
+		}
+		x.SetFloat(float32(f))
+	case TypeDoubleVal:
+		f, err := goFloat(rv)
+		x.SetDouble(f)
+		return err
+	case TypeStringVal:
+		switch s := value.(type) {
+		case string:
+			x.SetString(&s)
+		case *string:
+			x.SetString(s)
+		case nil:
+		default:
+			return errUnsupported
+		}
+	case TypeObjectVal, TypeInterfaceVal:
+		if value == nil {
+			return nil
+		}
+		ptr, err := goPtr(value)
+		if err != nil {
+			return err
+		}
+		x.SetObject(&Object{Ptr: ptr})
+	case TypeBoxedVal:
+		if rv.Kind() == reflect.Struct && reflect.PointerTo(rv.Type()).Implements(goPointerType) {
+			// a record by value, SetBoxed copies it from a Go copy
+			p := reflect.New(rv.Type())
+			p.Elem().Set(rv)
+			x.SetBoxed(p.Interface().(goPointer).GoPointer())
+			runtime.KeepAlive(p)
+			return nil
+		}
+		ptr, err := goPtr(value)
+		x.SetBoxed(ptr)
+		return err
+	case TypePointerVal:
+		ptr, err := goPtr(value)
+		x.SetPointer(ptr)
+		return err
+	case TypeParamVal:
+		ptr, err := goPtr(value)
+		x.SetParam(&ParamSpec{Ptr: ptr})
+		return err
+	case typeVariant:
+		if value == nil {
+			return nil
+		}
+		v, ok := value.(*glib.Variant)
+		if !ok {
+			return errUnsupported
+		}
+		x.SetVariant(v)
+	default:
+		return errUnsupported
+	}
+	return nil
+}
+
+var errUnsupported = fmt.Errorf("unsupported Go type")
+
+// glibTyped is implemented by the generated flags types, which know their GLib type
+type glibTyped interface {
+	GLibType() types.GType
+}
+
+// goInt converts a Go number to the signed integer type T of a GValue,
+// numbers out of the range of T are an error instead of being truncated.
+func goInt[T ~int8 | ~int16 | ~int32 | ~int64 | ~int](rv reflect.Value) (T, error) {
+	bits := reflect.TypeFor[T]().Bits()
+	min, max := int64(-1)<<(bits-1), int64(1)<<(bits-1)-1
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := rv.Int(); n >= min && n <= max {
+			return T(n), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n := rv.Uint(); n <= uint64(max) {
+			return T(n), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		// -min is 2^(bits-1), max is not exact as a float64 for 64 bits
+		if f := rv.Float(); f >= float64(min) && f < -float64(min) {
+			return T(f), nil
+		}
+	default:
+		return 0, errUnsupported
+	}
+	return 0, fmt.Errorf("Go value %v overflows %s", rv, reflect.TypeFor[T]())
+}
+
+// goUint converts a Go number to the unsigned integer type T of a GValue like goInt
+func goUint[T ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uint](rv reflect.Value) (T, error) {
+	bits := reflect.TypeFor[T]().Bits()
+	max := uint64(1)<<(bits-1)<<1 - 1
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := rv.Int(); n >= 0 && uint64(n) <= max {
+			return T(n), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n := rv.Uint(); n <= max {
+			return T(n), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); f >= 0 && f < math.Ldexp(1, bits) {
+			return T(f), nil
+		}
+	default:
+		return 0, errUnsupported
+	}
+	return 0, fmt.Errorf("Go value %v overflows %s", rv, reflect.TypeFor[T]())
+}
+
+func goFloat(rv reflect.Value) (float64, error) {
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), nil
+	}
+	return 0, errUnsupported
+}
+
+func goPtr(value interface{}) (uintptr, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case uintptr:
+		return v, nil
+	case goPointer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return 0, nil
+		}
+		return v.GoPointer(), nil
+	}
+	return 0, errUnsupported
+}
+
+var goPointerType = reflect.TypeOf((*goPointer)(nil)).Elem()
+
+// BoxedCopyNullable returns a copy of the boxed value at ptr made with g_boxed_copy, or 0 if ptr is 0.
+// Users should not need to call this, the generated bindings copy boxed values that C takes ownership of.
+func BoxedCopyNullable(gtype types.GType, ptr uintptr) uintptr {
+	if ptr == 0 {
+		return 0
+	}
+	return BoxedCopy(gtype, ptr)
+}
+
+// assignBoxed stores the boxed value at ptr in dst if it is a generated record or a pointer to one
+// the record is copied, a pointer points to the value owned by the GValue
+func assignBoxed(ptr uintptr, dst reflect.Value) (bool, error) {
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	cptr := *(*unsafe.Pointer)(unsafe.Pointer(&ptr))
+	switch {
+	case dst.Kind() == reflect.Ptr && dst.Type().Elem().Kind() == reflect.Struct && dst.Type().Implements(goPointerType):
+		if ptr == 0 {
+			dst.Set(reflect.Zero(dst.Type()))
+		} else {
+			dst.Set(reflect.NewAt(dst.Type().Elem(), cptr))
+		}
+		return true, nil
+	case dst.Kind() == reflect.Struct && reflect.PointerTo(dst.Type()).Implements(goPointerType):
+		if dst.Type().Size() == 0 {
+			return true, fmt.Errorf("gobject: cannot copy %s, its fields are not known, assign it to a pointer instead", dst.Type())
+		}
+		if ptr == 0 {
+			return true, fmt.Errorf("gobject: cannot assign NULL to %s", dst.Type())
+		}
+		dst.Set(reflect.NewAt(dst.Type(), cptr).Elem())
+		return true, nil
+	}
+	return false, nil
+}
+
+// GoValue returns the value of x as Go value: a bool, int8, uint8, int, uint, int64, uint64, float32, float64 or string,
+// an *Object for objects and interfaces, a *glib.Variant for variants or a uintptr for boxed types, pointers and param specs.
+// Enums are returned as int and flags as uint.
+// The returned objects, boxed types and variants are owned by x.
+func (x *Value) GoValue() interface{} {
+	switch TypeFundamental(x.GType) {
+	case TypeBooleanVal:
+		return x.GetBoolean()
+	case TypeCharVal:
+		return x.GetSchar()
+	case TypeUcharVal:
+		return x.GetUchar()
+	case TypeIntVal:
+		// C ints are 32 bit
+		return int(int32(x.GetInt()))
+	case TypeUintVal:
+		return uint(uint32(x.GetUint()))
+	case TypeLongVal:
+		return x.GetLong()
+	case TypeUlongVal:
+		return x.GetUlong()
+	case TypeInt64Val:
+		return x.GetInt64()
+	case TypeUint64Val:
+		return x.GetUint64()
+	case TypeEnumVal:
+		return int(int32(x.GetEnum()))
+	case TypeFlagsVal:
+		return uint(uint32(x.GetFlags()))
+	case TypeFloatVal:
+		return x.GetFloat()
+	case TypeDoubleVal:
+		return x.GetDouble()
+	case TypeStringVal:
+		return x.GetString()
+	case TypeObjectVal, TypeInterfaceVal:
+		return x.GetObject()
+	case TypeBoxedVal:
+		return x.GetBoxed()
+	case TypePointerVal:
+		return x.GetPointer()
+	case TypeParamVal:
+		if p := x.GetParam(); p != nil {
+			return p.Ptr
+		}
+		return uintptr(0)
+	case typeVariant:
+		return x.GetVariant()
+	}
+	return nil
+}
+
+// AssignTo stores the value of x in the Go variable that dst points to, the counterpart of InitGo.
+// dst is a pointer to a variable of the type GoValue returns or a type it converts to, e.g. a *gtk.Align for an enum.
+// Objects are stored in a *uintptr, a **Object or a pointer to a generated class, such as a *gtk.Widget or a **gtk.Widget,
+// without taking a reference.
+// Boxed types are stored in a *uintptr, a pointer to a pointer to a generated record, such as a **gdk.RGBA,
+// which points to the value owned by x, or a pointer to a record with known fields, such as a *gdk.RGBA, which copies it.
+func (x *Value) AssignTo(dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("gobject: cannot assign %s value to %T, it is not a pointer", TypeName(x.GType), dst)
+	}
+	value := x.GoValue()
+	if obj, ok := value.(*Object); ok {
+		switch d := dst.(type) {
+		case *uintptr:
+			*d = obj.GoPointer()
+			return nil
+		case interface{ SetGoPointer(uintptr) }:
+			d.SetGoPointer(obj.GoPointer())
+			return nil
+		}
+		// a pointer to a pointer of a generated class
+		if elem := rv.Elem(); elem.Kind() == reflect.Ptr && elem.Type() != reflect.TypeOf(obj) {
+			if obj == nil {
+				elem.Set(reflect.Zero(elem.Type()))
+				return nil
+			}
+			n := reflect.New(elem.Type().Elem())
+			if s, ok := n.Interface().(interface{ SetGoPointer(uintptr) }); ok {
+				s.SetGoPointer(obj.GoPointer())
+				elem.Set(n)
+				return nil
+			}
+		}
+	}
+	if ptr, ok := value.(uintptr); ok && TypeFundamental(x.GType) == TypeBoxedVal {
+		if ok, err := assignBoxed(ptr, rv.Elem()); ok {
+			return err
+		}
+	}
+	if value == nil {
+		return fmt.Errorf("gobject: cannot assign %s value, its type is not supported", TypeName(x.GType))
+	}
+	v := reflect.ValueOf(value)
+	elem := rv.Elem()
+	// reflect converts numbers to strings as runes
+	if !v.Type().ConvertibleTo(elem.Type()) || (v.Kind() == reflect.String) != (elem.Kind() == reflect.String) {
+		return fmt.Errorf("gobject: cannot assign %s value to %T", TypeName(x.GType), dst)
+	}
+	elem.Set(v.Convert(elem.Type()))
+	return nil
+}
@@ -0,0 +1,321 @@
+package gobject
+
+import (
+	"fmt"
+	"sort"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// The variadic functions of GObject are generated with a call to these helpers, which use the variants that take arrays,
+// see internal/gir/types/varargs.go
+
+var (
+	xObjectNewWithPropertiesPtr func(types.GType, uint32, **byte, *Value) uintptr
+	xObjectSetvPtr              func(uintptr, uint32, **byte, *Value)
+	xObjectGetvPtr              func(uintptr, uint32, **byte, *Value)
+	xSignalEmitvPtr             func(*Value, uint32, glib.Quark, *Value)
+	xSignalQueryPtr             func(uint32, *signalQueryC)
+	// xObjectClassFindPropertyPtr is g_object_class_find_property without the reference that ObjectClass.FindProperty takes,
+	// param specs are not objects
+	xObjectClassFindPropertyPtr func(uintptr, string) uintptr
+	xVarArgsTypeClassRef        func(types.GType) uintptr
+	xVarArgsTypeClassUnref      func(uintptr)
+)
+
+// signalQueryC is the C layout of GSignalQuery
+type signalQueryC struct {
+	id         uint32
+	name       uintptr
+	itype      types.GType
+	flags      SignalFlags
+	returnType types.GType
+	nParams    uint32
+	paramTypes uintptr
+}
+
+// signalStaticScope is the flag that signal argument types can have, G_SIGNAL_TYPE_STATIC_SCOPE
+const signalStaticScope types.GType = 1
+
+// propertyPairs splits the name and value pairs of the variadic property functions,
+// the terminating nil of the C functions may be left out
+func propertyPairs(first string, varArgs []interface{}) (names []string, values []interface{}) {
+	name := first
+	for i := 0; name != ""; i++ {
+		if i >= len(varArgs) {
+			panic(fmt.Sprintf("gobject: missing value for property %s", name))
+		}
+		names = append(names, name)
+		values = append(values, varArgs[i])
+		i++
+		if i >= len(varArgs) || varArgs[i] == nil {
+			break
+		}
+		n, ok := varArgs[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("gobject: expected a property name after %s, got %T", name, varArgs[i]))
+		}
+		name = n
+	}
+	return names, values
+}
+
+// propertyValues initializes a value for every property of the class with the type of the property
+// and sets it to the Go value if set is true, see Value.InitGo
+func propertyValues(class uintptr, gtype types.GType, names []string, values []interface{}, set bool) ([]Value, error) {
+	gvalues := make([]Value, len(names))
+	for i, name := range names {
+		pspec := xObjectClassFindPropertyPtr(class, name)
+		if pspec == 0 {
+			unsetValues(gvalues)
+			return nil, fmt.Errorf("gobject: %s has no property %s", TypeName(gtype), name)
+		}
+		ptype := (&ParamSpec{Ptr: pspec}).GetDefaultValue().GType
+		if !set {
+			gvalues[i].Init(ptype)
+			continue
+		}
+		if err := gvalues[i].InitGo(ptype, values[i]); err != nil {
+			unsetValues(gvalues)
+			return nil, fmt.Errorf("%w for property %s", err, name)
+		}
+	}
+	return gvalues, nil
+}
+
+func unsetValues(values []Value) {
+	for i := range values {
+		if values[i].GType != 0 {
+			values[i].Unset()
+		}
+	}
+}
+
+func firstValue(values []Value) *Value {
+	if len(values) == 0 {
+		return nil
+	}
+	return &values[0]
+}
+
+// instanceType returns the type of the instance, G_TYPE_FROM_INSTANCE
+func instanceType(ptr uintptr) types.GType {
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return (*TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))).GClass.GType
+}
+
+// NewObjectWithGoValues creates an instance of the object type with the properties set to the Go values,
+// converted as described for Value.InitGo. E.g. for a class whose only C constructor is variadic:
+//
+//	obj, err := gobject.NewObjectWithGoValues(gtk.LabelGLibType(), map[string]interface{}{
+//		"label":      "Hello",
+//		"selectable": true,
+//	})
+//
+// The properties are set in the order of their names.
+// It returns an error if the type has no such property or a value cannot be converted to the type of its property.
+func NewObjectWithGoValues(gtype types.GType, properties map[string]interface{}) (*Object, error) {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]interface{}, len(names))
+	for i, name := range names {
+		values[i] = properties[name]
+	}
+	return newObjectWithValues(gtype, names, values)
+}
+
+func newObjectWithValues(gtype types.GType, names []string, values []interface{}) (*Object, error) {
+	// GLib aborts for abstract types
+	if !TypeIsA(gtype, ObjectGLibType()) || TypeTestFlags(gtype, uint(GTypeFlagAbstractValue)) {
+		return nil, fmt.Errorf("gobject: %s is not an instantiatable object type", TypeName(gtype))
+	}
+	class := xVarArgsTypeClassRef(gtype)
+	defer xVarArgsTypeClassUnref(class)
+	gvalues, err := propertyValues(class, gtype, names, values, true)
+	if err != nil {
+		return nil, err
+	}
+	defer unsetValues(gvalues)
+	cret := xObjectNewWithPropertiesPtr(gtype, uint32(len(names)), core.ByteSlice(names), firstValue(gvalues))
+	if cret == 0 {
+		return nil, fmt.Errorf("gobject: cannot create an instance of %s", TypeName(gtype))
+	}
+	cls := &Object{}
+	cls.Ptr = cret
+	return cls, nil
+}
+
+// SetGoValues sets the properties of the object to the Go values, converted as described for Value.InitGo.
+// Unlike Set, it returns an error instead of panicking if the object has no such property
+// or a value cannot be converted to the type of its property, in which case no property is set.
+// The properties are set in the order of their names.
+func (x *Object) SetGoValues(properties map[string]interface{}) error {
+	if len(properties) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]interface{}, len(names))
+	for i, name := range names {
+		values[i] = properties[name]
+	}
+	gvalues, err := propertyValues(x.instanceClass(), instanceType(x.GoPointer()), names, values, true)
+	if err != nil {
+		return err
+	}
+	defer unsetValues(gvalues)
+	xObjectSetvPtr(x.GoPointer(), uint32(len(names)), core.ByteSlice(names), &gvalues[0])
+	return nil
+}
+
+// ResetProperty sets the property of the object back to the default value of its param spec.
+// It returns an error if the object has no such property.
+func (x *Object) ResetProperty(name string) error {
+	pspec := xObjectClassFindPropertyPtr(x.instanceClass(), name)
+	if pspec == 0 {
+		return fmt.Errorf("gobject: %s has no property %s", TypeName(instanceType(x.GoPointer())), name)
+	}
+	x.SetProperty(name, (&ParamSpec{Ptr: pspec}).GetDefaultValue())
+	return nil
+}
+
+// PropertyType returns the value type of the property of the object, or false if the object has no such property.
+func (x *Object) PropertyType(name string) (types.GType, bool) {
+	pspec := xObjectClassFindPropertyPtr(x.instanceClass(), name)
+	if pspec == 0 {
+		return 0, false
+	}
+	return (&ParamSpec{Ptr: pspec}).GetDefaultValue().GType, true
+}
+
+// newObjectVarArgs implements g_object_new with g_object_new_with_properties
+func newObjectVarArgs(ObjectTypeVar types.GType, FirstPropertyNameVar string, varArgs ...interface{}) *Object {
+	names, values := propertyPairs(FirstPropertyNameVar, varArgs)
+	obj, err := newObjectWithValues(ObjectTypeVar, names, values)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// objectSetVarArgs implements g_object_set with g_object_setv
+func objectSetVarArgs(x *Object, FirstPropertyNameVar string, varArgs ...interface{}) {
+	names, values := propertyPairs(FirstPropertyNameVar, varArgs)
+	if len(names) == 0 {
+		return
+	}
+	gvalues, err := propertyValues(x.instanceClass(), instanceType(x.GoPointer()), names, values, true)
+	if err != nil {
+		panic(err)
+	}
+	defer unsetValues(gvalues)
+	xObjectSetvPtr(x.GoPointer(), uint32(len(names)), core.ByteSlice(names), &gvalues[0])
+}
+
+// objectGetVarArgs implements g_object_get with g_object_getv, the values are pointers to Go variables, see Value.AssignTo
+func objectGetVarArgs(x *Object, FirstPropertyNameVar string, varArgs ...interface{}) {
+	names, dsts := propertyPairs(FirstPropertyNameVar, varArgs)
+	if len(names) == 0 {
+		return
+	}
+	gvalues, err := propertyValues(x.instanceClass(), instanceType(x.GoPointer()), names, nil, false)
+	if err != nil {
+		panic(err)
+	}
+	defer unsetValues(gvalues)
+	xObjectGetvPtr(x.GoPointer(), uint32(len(names)), core.ByteSlice(names), &gvalues[0])
+	for i := range gvalues {
+		if err := gvalues[i].AssignTo(dsts[i]); err != nil {
+			panic(fmt.Sprintf("%s for property %s", err, names[i]))
+		}
+	}
+}
+
+// instanceClass returns the class of the instance, G_OBJECT_GET_CLASS
+func (x *Object) instanceClass() uintptr {
+	ptr := x.GoPointer()
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return uintptr(unsafe.Pointer((*TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))).GClass))
+}
+
+// signalEmitVarArgs implements g_signal_emit with g_signal_emitv,
+// the arguments are followed by a pointer to a Go variable for the return value, if the signal has one
+func signalEmitVarArgs(InstanceVar *Object, SignalIdVar uint, DetailVar glib.Quark, varArgs ...interface{}) {
+	var q signalQueryC
+	xSignalQueryPtr(uint32(SignalIdVar), &q)
+	if q.id == 0 {
+		panic(fmt.Sprintf("gobject: invalid signal id %d", SignalIdVar))
+	}
+	name := core.GoString(q.name)
+	n := int(q.nParams)
+	if len(varArgs) < n {
+		panic(fmt.Sprintf("gobject: signal %s takes %d arguments, got %d", name, n, len(varArgs)))
+	}
+
+	values := make([]Value, n+1)
+	defer unsetValues(values)
+	values[0].Init(instanceType(InstanceVar.GoPointer()))
+	values[0].SetInstance(InstanceVar.GoPointer())
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	params := unsafe.Slice((*types.GType)(*(*unsafe.Pointer)(unsafe.Pointer(&q.paramTypes))), n)
+	for i, t := range params {
+		if err := values[i+1].InitGo(t&^signalStaticScope, varArgs[i]); err != nil {
+			panic(fmt.Sprintf("%s for argument %d of signal %s", err, i+1, name))
+		}
+	}
+
+	rtype := q.returnType &^ signalStaticScope
+	if rtype == TypeNoneVal {
+		xSignalEmitvPtr(&values[0], uint32(SignalIdVar), DetailVar, nil)
+		return
+	}
+	var ret Value
+	ret.Init(rtype)
+	defer ret.Unset()
+	xSignalEmitvPtr(&values[0], uint32(SignalIdVar), DetailVar, &ret)
+	if len(varArgs) > n && varArgs[n] != nil {
+		if err := ret.AssignTo(varArgs[n]); err != nil {
+			panic(fmt.Sprintf("%s for the return value of signal %s", err, name))
+		}
+	}
+}
+
+// signalEmitByNameVarArgs implements g_signal_emit_by_name with g_signal_emitv, see signalEmitVarArgs
+func signalEmitByNameVarArgs(InstanceVar *Object, DetailedSignalVar string, varArgs ...interface{}) {
+	var id uint
+	var detail glib.Quark
+	// the id is a C guint, it is 0 if the name cannot be parsed
+	SignalParseName(DetailedSignalVar, instanceType(InstanceVar.GoPointer()), &id, &detail, true)
+	if uint32(id) == 0 {
+		panic(fmt.Sprintf("gobject: %s has no signal %s", TypeName(instanceType(InstanceVar.GoPointer())), DetailedSignalVar))
+	}
+	signalEmitVarArgs(InstanceVar, uint(uint32(id)), detail, varArgs...)
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xObjectNewWithPropertiesPtr, libs, "g_object_new_with_properties")
+	core.PuregoSafeRegister(&xObjectSetvPtr, libs, "g_object_setv")
+	core.PuregoSafeRegister(&xObjectGetvPtr, libs, "g_object_getv")
+	core.PuregoSafeRegister(&xSignalEmitvPtr, libs, "g_signal_emitv")
+	core.PuregoSafeRegister(&xSignalQueryPtr, libs, "g_signal_query")
+	core.PuregoSafeRegister(&xObjectClassFindPropertyPtr, libs, "g_object_class_find_property")
+	core.PuregoSafeRegister(&xVarArgsTypeClassRef, libs, "g_type_class_ref")
+	core.PuregoSafeRegister(&xVarArgsTypeClassUnref, libs, "g_type_class_unref")
+}
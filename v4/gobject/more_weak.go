@@ -0,0 +1,126 @@
+package gobject
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var (
+	weakNotifyOnce sync.Once
+	// weakNotifyCb is the single GWeakNotify shared by all weak references
+	// the function is looked up by the data passed to g_object_weak_ref, it is unregistered when it is called or removed
+	weakNotifyCb uintptr
+)
+
+func weakNotifyCallback() uintptr {
+	weakNotifyOnce.Do(func() {
+		weakNotifyCb = core.NewCallback(func(id uintptr, _ uintptr) {
+			defer core.RecoverPanic()
+			if fn, ok := glib.TakeUserData[func()](id); ok {
+				fn()
+			}
+		})
+	})
+	return weakNotifyCb
+}
+
+// AddWeakRef calls fn once when the object is disposed, without keeping the object alive.
+// Use it to drop Go state that refers to the object, e.g. entries of a map keyed by widget,
+// so stale wrappers of finalized objects are never used:
+//
+//	cache[win.GoPointer()] = state
+//	win.AddWeakRef(func() {
+//		delete(cache, win.GoPointer())
+//	})
+//
+// The returned function removes the weak reference before the object is disposed, calling it later does nothing.
+// fn and the values it captures are released once it is called or removed.
+// Like g_object_weak_ref, it must be used on the thread that drops the last reference to the object,
+// usually the main thread, use a GWeakRef with WeakRef.Init otherwise.
+func (x *Object) AddWeakRef(fn func()) (remove func()) {
+	id := glib.RegisterUserData(fn)
+	ptr := x.GoPointer()
+	cb := weakNotifyCallback()
+	xObjectWeakRef(ptr, cb, id)
+	return func() {
+		if _, ok := glib.TakeUserData[func()](id); ok {
+			xObjectWeakUnref(ptr, cb, id)
+		}
+	}
+}
+
+// Weak is a weak reference to an object of the generated class T, e.g. gtk.Window.
+// It does not keep the object alive and Get returns nil once the object is disposed:
+//
+//	w := gobject.NewWeak(win)
+//	...
+//	if win := w.Get(); win != nil {
+//		win.Present()
+//	}
+//
+// Like AddWeakRef, it must be used on the thread that drops the last reference to the object, usually the main thread.
+type Weak[T any] struct {
+	mu     sync.Mutex
+	ptr    uintptr
+	wrap   func(uintptr) *T
+	remove func()
+}
+
+// NewWeak returns a weak reference to obj, or a reference that is always empty if obj is nil.
+func NewWeak[T any, PT interface {
+	*T
+	Ptr
+}](obj PT) *Weak[T] {
+	w := &Weak[T]{
+		wrap: func(ptr uintptr) *T {
+			cls := PT(new(T))
+			cls.SetGoPointer(ptr)
+			return (*T)(cls)
+		},
+	}
+	if obj == nil || obj.GoPointer() == 0 {
+		return w
+	}
+	w.ptr = obj.GoPointer()
+	w.remove = (&Object{Ptr: w.ptr}).AddWeakRef(func() {
+		w.mu.Lock()
+		w.ptr = 0
+		w.remove = nil
+		w.mu.Unlock()
+	})
+	return w
+}
+
+// Get returns a new wrapper of the object, or nil if it has been disposed or the reference was released.
+// The wrapper does not hold a reference, take one with Ref to keep the object alive beyond the current callback.
+func (w *Weak[T]) Get() *T {
+	w.mu.Lock()
+	ptr := w.ptr
+	w.mu.Unlock()
+	if ptr == 0 {
+		return nil
+	}
+	return w.wrap(ptr)
+}
+
+// Alive reports whether the object has not been disposed and the reference was not released.
+func (w *Weak[T]) Alive() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.ptr != 0
+}
+
+// Release stops watching the object, Get returns nil afterwards.
+// It is only needed when the reference is dropped before the object, to remove the weak reference from it.
+func (w *Weak[T]) Release() {
+	w.mu.Lock()
+	remove := w.remove
+	w.ptr = 0
+	w.remove = nil
+	w.mu.Unlock()
+	if remove != nil {
+		remove()
+	}
+}
@@ -0,0 +1,151 @@
+package gobject_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// The tests stress the signal and callback registries from several goroutines, run them with go test -race.
+
+const (
+	stressGoroutines = 8
+	stressRounds     = 200
+)
+
+func TestConcurrentConnectDisconnect(t *testing.T) {
+	action := gio.NewSimpleAction("stress", nil)
+	defer action.Unref()
+
+	// shared by all goroutines, such that they connect the same callback of the registry
+	var calls atomic.Int64
+	shared := func(gio.SimpleAction, uintptr) {
+		calls.Add(1)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < stressGoroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < stressRounds; i++ {
+				byPtr := action.ConnectActivate(&shared)
+				byFunc := action.ConnectActivateFunc(func(gio.SimpleAction, uintptr) {
+					calls.Add(1)
+				})
+				action.DisconnectSignal(byFunc)
+				action.DisconnectSignal(byPtr)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if handlers := gobject.HandlersOf(action); len(handlers) != 0 {
+		t.Fatalf("%d handlers are left after disconnecting all of them: %v", len(handlers), handlers)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("handlers were called %d times without an emission", n)
+	}
+
+	// the shared callback must still work after the other goroutines released their references
+	h := action.ConnectActivate(&shared)
+	action.Activate(nil)
+	action.DisconnectSignal(h)
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("the reconnected handler was called %d times, want 1", n)
+	}
+}
+
+func TestEmitWhileDisconnecting(t *testing.T) {
+	action := gio.NewSimpleAction("stress", nil)
+	defer action.Unref()
+
+	var calls atomic.Int64
+	// the handler that stays connected, to check that no emission is lost
+	var kept atomic.Int64
+	keptID := action.ConnectActivateFunc(func(gio.SimpleAction, uintptr) {
+		kept.Add(1)
+	})
+	defer action.DisconnectSignal(keptID)
+
+	var emitted atomic.Int64
+	done := make(chan struct{})
+	var emitters sync.WaitGroup
+	for g := 0; g < stressGoroutines/2; g++ {
+		emitters.Add(1)
+		go func() {
+			defer emitters.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				action.Activate(nil)
+				emitted.Add(1)
+			}
+		}()
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < stressGoroutines/2; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn := func(gio.SimpleAction, uintptr) {
+				calls.Add(1)
+			}
+			for i := 0; i < stressRounds; i++ {
+				byPtr := action.ConnectActivate(&fn)
+				byFunc := action.ConnectActivateFunc(func(gio.SimpleAction, uintptr) {
+					calls.Add(1)
+				})
+				action.DisconnectSignal(byPtr)
+				action.DisconnectSignal(byFunc)
+			}
+		}()
+	}
+	wg.Wait()
+	close(done)
+	emitters.Wait()
+
+	if got, want := kept.Load(), emitted.Load(); got != want {
+		t.Fatalf("the connected handler was called %d times for %d emissions", got, want)
+	}
+	if handlers := gobject.HandlersOf(action); len(handlers) != 1 || handlers[0].ID != keptID {
+		t.Fatalf("only handler %d should be left, got %v", keptID, handlers)
+	}
+}
+
+func TestConnectUnknownSignal(t *testing.T) {
+	action := gio.NewSimpleAction("unknown", nil)
+	defer action.Unref()
+
+	before := glib.CallbackStats()
+	var trampoline uintptr
+	id := gobject.SignalConnectFunc(action.GoPointer(), "no-such-signal", &trampoline, func() interface{} {
+		return func(uintptr, uintptr) {}
+	}, func() {})
+	if id != 0 {
+		t.Fatalf("connecting to an unknown signal returned handler %d, want 0", id)
+	}
+	cb := func() {}
+	if id := action.ConnectSignal("no-such-signal", &cb); id != 0 {
+		t.Fatalf("connecting a callback to an unknown signal returned handler %d, want 0", id)
+	}
+
+	if handlers := gobject.HandlersOf(action); len(handlers) != 0 {
+		t.Fatalf("%d handlers are remembered for an unknown signal: %v", len(handlers), handlers)
+	}
+	after := glib.CallbackStats()
+	if after.UserData != before.UserData {
+		t.Fatalf("%d user data are left after connecting to an unknown signal", after.UserData-before.UserData)
+	}
+	if after.Registered != before.Registered || after.Handlers != before.Handlers {
+		t.Fatalf("callbacks are left after connecting to an unknown signal: %+v, before %+v", after, before)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package types
+
+// Long is the C type long, which has the size of a pointer on the platforms other than Windows.
+type Long = int
+
+// ULong is the C type unsigned long.
+type ULong = uint
@@ -0,0 +1,7 @@
+package types
+
+// Long is the C type long, which has 32 bits on Windows, also on 64-bit platforms.
+type Long = int32
+
+// ULong is the C type unsigned long.
+type ULong = uint32
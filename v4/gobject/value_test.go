@@ -0,0 +1,77 @@
+package gobject_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+func TestInitGoRange(t *testing.T) {
+	tests := []struct {
+		gtype types.GType
+		value interface{}
+		ok    bool
+	}{
+		{gobject.TypeCharVal, 127, true},
+		{gobject.TypeCharVal, 128, false},
+		{gobject.TypeCharVal, -128, true},
+		{gobject.TypeCharVal, -129, false},
+		{gobject.TypeCharVal, 127.5, true},
+		{gobject.TypeCharVal, 128.0, false},
+		{gobject.TypeUcharVal, uint16(255), true},
+		{gobject.TypeUcharVal, uint16(256), false},
+		{gobject.TypeUcharVal, -1, false},
+		{gobject.TypeIntVal, int64(math.MaxInt32), true},
+		{gobject.TypeIntVal, int64(math.MaxInt32) + 1, false},
+		{gobject.TypeIntVal, int64(math.MinInt32), true},
+		{gobject.TypeIntVal, int64(math.MinInt32) - 1, false},
+		{gobject.TypeUintVal, uint64(math.MaxUint32), true},
+		{gobject.TypeUintVal, uint64(math.MaxUint32) + 1, false},
+		{gobject.TypeUintVal, -1, false},
+		{gobject.TypeInt64Val, uint64(math.MaxInt64), true},
+		{gobject.TypeInt64Val, uint64(math.MaxInt64) + 1, false},
+		{gobject.TypeInt64Val, math.Ldexp(1, 63), false},
+		{gobject.TypeUint64Val, uint64(math.MaxUint64), true},
+		{gobject.TypeUint64Val, int8(-1), false},
+		{gobject.TypeUint64Val, math.Ldexp(1, 64), false},
+		{gobject.TypeFloatVal, math.MaxFloat32, true},
+		{gobject.TypeFloatVal, math.MaxFloat64, false},
+		{gobject.TypeFloatVal, math.Inf(-1), true},
+	}
+	for _, tt := range tests {
+		var v gobject.Value
+		err := v.InitGo(tt.gtype, tt.value)
+		if tt.ok && err != nil {
+			t.Errorf("%s from %T %v: %v", gobject.TypeName(tt.gtype), tt.value, tt.value, err)
+			continue
+		}
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s from %T %v: got %v, want an overflow error", gobject.TypeName(tt.gtype), tt.value, tt.value, v.GoValue())
+				v.Unset()
+			}
+			continue
+		}
+		if _, float := tt.value.(float64); !float && fmt.Sprint(v.GoValue()) != fmt.Sprint(tt.value) {
+			t.Errorf("%s from %T %v: got %v", gobject.TypeName(tt.gtype), tt.value, tt.value, v.GoValue())
+		}
+		v.Unset()
+	}
+}
+
+func TestSetGoOverflowKeepsValue(t *testing.T) {
+	var v gobject.Value
+	if err := v.InitGo(gobject.TypeUcharVal, 7); err != nil {
+		t.Fatal(err)
+	}
+	defer v.Unset()
+	if err := v.SetGo(300); err == nil {
+		t.Fatalf("setting a guchar to 300 succeeded with %v", v.GoValue())
+	}
+	if got := v.GoValue(); got != byte(7) {
+		t.Fatalf("the value changed to %v after an overflow", got)
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -15,7 +15,7 @@ import (
 type Box struct {
 	_ structs.HostLayout
 
-	Min uintptr
+	Min Vec3
 
 	Max uintptr
 }
@@ -30,6 +30,16 @@ func (x *Box) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Box made with g_boxed_copy, release it with Free.
+func (x *Box) Copy() *Box {
+	cret := gobject.BoxedCopy(BoxGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Box)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xBoxAlloc func() *Box
 
 // Allocates a new #graphene_box_t.
@@ -43,8 +53,8 @@ func BoxAlloc() *Box {
 
 var xBoxContainsBox func(uintptr, *Box) bool
 
-// Checks whether the #graphene_box_t @a contains the given
-// #graphene_box_t @b.
+// Checks whether the #graphene_box_t a contains the given
+// #graphene_box_t b.
 func (x *Box) ContainsBox(BVar *Box) bool {
 
 	cret := xBoxContainsBox(x.GoPointer(), BVar)
@@ -53,7 +63,7 @@ func (x *Box) ContainsBox(BVar *Box) bool {
 
 var xBoxContainsPoint func(uintptr, *Point3D) bool
 
-// Checks whether @box contains the given @point.
+// Checks whether box contains the given point.
 func (x *Box) ContainsPoint(PointVar *Point3D) bool {
 
 	cret := xBoxContainsPoint(x.GoPointer(), PointVar)
@@ -71,7 +81,7 @@ func (x *Box) Equal(BVar *Box) bool {
 
 var xBoxExpand func(uintptr, *Point3D, *Box)
 
-// Expands the dimensions of @box to include the coordinates at @point.
+// Expands the dimensions of box to include the coordinates at point.
 func (x *Box) Expand(PointVar *Point3D, ResVar *Box) {
 
 	xBoxExpand(x.GoPointer(), PointVar, ResVar)
@@ -80,9 +90,9 @@ func (x *Box) Expand(PointVar *Point3D, ResVar *Box) {
 
 var xBoxExpandScalar func(uintptr, float32, *Box)
 
-// Expands the dimensions of @box by the given @scalar value.
+// Expands the dimensions of box by the given scalar value.
 //
-// If @scalar is positive, the #graphene_box_t will grow; if @scalar is
+// If scalar is positive, the #graphene_box_t will grow; if scalar is
 // negative, the #graphene_box_t will shrink.
 func (x *Box) ExpandScalar(ScalarVar float32, ResVar *Box) {
 
@@ -92,7 +102,7 @@ func (x *Box) ExpandScalar(ScalarVar float32, ResVar *Box) {
 
 var xBoxExpandVec3 func(uintptr, *Vec3, *Box)
 
-// Expands the dimensions of @box to include the coordinates of the
+// Expands the dimensions of box to include the coordinates of the
 // given vector.
 func (x *Box) ExpandVec3(VecVar *Vec3, ResVar *Box) {
 
@@ -102,7 +112,7 @@ func (x *Box) ExpandVec3(VecVar *Vec3, ResVar *Box) {
 
 var xBoxFree func(uintptr)
 
-// Frees the resources allocated by graphene_box_alloc().
+// Frees the resources allocated by [BoxAlloc].
 func (x *Box) Free() {
 
 	xBoxFree(x.GoPointer())
@@ -130,7 +140,7 @@ func (x *Box) GetCenter(CenterVar *Point3D) {
 
 var xBoxGetDepth func(uintptr) float32
 
-// Retrieves the size of the @box on the Z axis.
+// Retrieves the size of the box on the Z axis.
 func (x *Box) GetDepth() float32 {
 
 	cret := xBoxGetDepth(x.GoPointer())
@@ -139,7 +149,7 @@ func (x *Box) GetDepth() float32 {
 
 var xBoxGetHeight func(uintptr) float32
 
-// Retrieves the size of the @box on the Y axis.
+// Retrieves the size of the box on the Y axis.
 func (x *Box) GetHeight() float32 {
 
 	cret := xBoxGetHeight(x.GoPointer())
@@ -169,7 +179,7 @@ func (x *Box) GetMin(MinVar *Point3D) {
 var xBoxGetSize func(uintptr, *Vec3)
 
 // Retrieves the size of the box on all three axes, and stores
-// it into the given @size vector.
+// it into the given size vector.
 func (x *Box) GetSize(SizeVar *Vec3) {
 
 	xBoxGetSize(x.GoPointer(), SizeVar)
@@ -187,7 +197,7 @@ func (x *Box) GetVertices(VerticesVar *[8]Vec3) {
 
 var xBoxGetWidth func(uintptr) float32
 
-// Retrieves the size of the @box on the X axis.
+// Retrieves the size of the box on the X axis.
 func (x *Box) GetWidth() float32 {
 
 	cret := xBoxGetWidth(x.GoPointer())
@@ -218,8 +228,8 @@ var xBoxInitFromPoints func(uintptr, uint, []Point3D) *Box
 // Initializes the given #graphene_box_t with the given array
 // of vertices.
 //
-// If @n_points is 0, the returned box is initialized with
-// graphene_box_empty().
+// If n_points is 0, the returned box is initialized with
+// [BoxEmpty].
 func (x *Box) InitFromPoints(NPointsVar uint, PointsVar []Point3D) *Box {
 
 	cret := xBoxInitFromPoints(x.GoPointer(), NPointsVar, PointsVar)
@@ -241,8 +251,8 @@ var xBoxInitFromVectors func(uintptr, uint, []Vec3) *Box
 // Initializes the given #graphene_box_t with the given array
 // of vertices.
 //
-// If @n_vectors is 0, the returned box is initialized with
-// graphene_box_empty().
+// If n_vectors is 0, the returned box is initialized with
+// [BoxEmpty].
 func (x *Box) InitFromVectors(NVectorsVar uint, VectorsVar []Vec3) *Box {
 
 	cret := xBoxInitFromVectors(x.GoPointer(), NVectorsVar, VectorsVar)
@@ -253,8 +263,8 @@ var xBoxIntersection func(uintptr, *Box, *Box) bool
 
 // Intersects the two given #graphene_box_t.
 //
-// If the two boxes do not intersect, @res will contain a degenerate box
-// initialized with graphene_box_empty().
+// If the two boxes do not intersect, res will contain a degenerate box
+// initialized with [BoxEmpty].
 func (x *Box) Intersection(BVar *Box, ResVar *Box) bool {
 
 	cret := xBoxIntersection(x.GoPointer(), BVar, ResVar)
@@ -344,46 +354,46 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBoxEmpty, libs, "graphene_box_empty")
-	core.PuregoSafeRegister(&xBoxInfinite, libs, "graphene_box_infinite")
-	core.PuregoSafeRegister(&xBoxMinusOne, libs, "graphene_box_minus_one")
-	core.PuregoSafeRegister(&xBoxOne, libs, "graphene_box_one")
-	core.PuregoSafeRegister(&xBoxOneMinusOne, libs, "graphene_box_one_minus_one")
-	core.PuregoSafeRegister(&xBoxZero, libs, "graphene_box_zero")
+	core.PuregoRegisterSince(&xBoxEmpty, libs, "graphene_box_empty", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInfinite, libs, "graphene_box_infinite", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxMinusOne, libs, "graphene_box_minus_one", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxOne, libs, "graphene_box_one", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxOneMinusOne, libs, "graphene_box_one_minus_one", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxZero, libs, "graphene_box_zero", "GRAPHENE", "1.2")
 
 	core.PuregoSafeRegister(&xBoxGLibType, libs, "graphene_box_get_type")
 
-	core.PuregoSafeRegister(&xBoxAlloc, libs, "graphene_box_alloc")
-
-	core.PuregoSafeRegister(&xBoxContainsBox, libs, "graphene_box_contains_box")
-	core.PuregoSafeRegister(&xBoxContainsPoint, libs, "graphene_box_contains_point")
-	core.PuregoSafeRegister(&xBoxEqual, libs, "graphene_box_equal")
-	core.PuregoSafeRegister(&xBoxExpand, libs, "graphene_box_expand")
-	core.PuregoSafeRegister(&xBoxExpandScalar, libs, "graphene_box_expand_scalar")
-	core.PuregoSafeRegister(&xBoxExpandVec3, libs, "graphene_box_expand_vec3")
-	core.PuregoSafeRegister(&xBoxFree, libs, "graphene_box_free")
-	core.PuregoSafeRegister(&xBoxGetBoundingSphere, libs, "graphene_box_get_bounding_sphere")
-	core.PuregoSafeRegister(&xBoxGetCenter, libs, "graphene_box_get_center")
-	core.PuregoSafeRegister(&xBoxGetDepth, libs, "graphene_box_get_depth")
-	core.PuregoSafeRegister(&xBoxGetHeight, libs, "graphene_box_get_height")
-	core.PuregoSafeRegister(&xBoxGetMax, libs, "graphene_box_get_max")
-	core.PuregoSafeRegister(&xBoxGetMin, libs, "graphene_box_get_min")
-	core.PuregoSafeRegister(&xBoxGetSize, libs, "graphene_box_get_size")
-	core.PuregoSafeRegister(&xBoxGetVertices, libs, "graphene_box_get_vertices")
-	core.PuregoSafeRegister(&xBoxGetWidth, libs, "graphene_box_get_width")
-	core.PuregoSafeRegister(&xBoxInit, libs, "graphene_box_init")
-	core.PuregoSafeRegister(&xBoxInitFromBox, libs, "graphene_box_init_from_box")
-	core.PuregoSafeRegister(&xBoxInitFromPoints, libs, "graphene_box_init_from_points")
-	core.PuregoSafeRegister(&xBoxInitFromVec3, libs, "graphene_box_init_from_vec3")
-	core.PuregoSafeRegister(&xBoxInitFromVectors, libs, "graphene_box_init_from_vectors")
-	core.PuregoSafeRegister(&xBoxIntersection, libs, "graphene_box_intersection")
-	core.PuregoSafeRegister(&xBoxUnion, libs, "graphene_box_union")
+	core.PuregoRegisterSince(&xBoxAlloc, libs, "graphene_box_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xBoxContainsBox, libs, "graphene_box_contains_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxContainsPoint, libs, "graphene_box_contains_point", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxEqual, libs, "graphene_box_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxExpand, libs, "graphene_box_expand", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxExpandScalar, libs, "graphene_box_expand_scalar", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxExpandVec3, libs, "graphene_box_expand_vec3", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxFree, libs, "graphene_box_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetBoundingSphere, libs, "graphene_box_get_bounding_sphere", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetCenter, libs, "graphene_box_get_center", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetDepth, libs, "graphene_box_get_depth", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetHeight, libs, "graphene_box_get_height", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetMax, libs, "graphene_box_get_max", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetMin, libs, "graphene_box_get_min", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetSize, libs, "graphene_box_get_size", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetVertices, libs, "graphene_box_get_vertices", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxGetWidth, libs, "graphene_box_get_width", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInit, libs, "graphene_box_init", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInitFromBox, libs, "graphene_box_init_from_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInitFromPoints, libs, "graphene_box_init_from_points", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInitFromVec3, libs, "graphene_box_init_from_vec3", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxInitFromVectors, libs, "graphene_box_init_from_vectors", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxIntersection, libs, "graphene_box_intersection", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xBoxUnion, libs, "graphene_box_union", "GRAPHENE", "1.2")
 
 }
@@ -25,7 +25,7 @@ func (x *Simd4F) GoPointer() uintptr {
 type Simd4X4F struct {
 	_ structs.HostLayout
 
-	X uintptr
+	X Simd4F
 
 	Y uintptr
 
@@ -2,11 +2,12 @@
 package graphene
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +18,7 @@ import (
 type Euler struct {
 	_ structs.HostLayout
 
-	Angles uintptr
+	Angles Vec3
 
 	Order EulerOrder
 }
@@ -32,6 +33,16 @@ func (x *Euler) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Euler made with g_boxed_copy, release it with Free.
+func (x *Euler) Copy() *Euler {
+	cret := gobject.BoxedCopy(EulerGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Euler)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xEulerAlloc func() *Euler
 
 // Allocates a new #graphene_euler_t.
@@ -54,7 +65,7 @@ func (x *Euler) Equal(BVar *Euler) bool {
 
 var xEulerFree func(uintptr)
 
-// Frees the resources allocated by graphene_euler_alloc().
+// Frees the resources allocated by [EulerAlloc].
 func (x *Euler) Free() {
 
 	xEulerFree(x.GoPointer())
@@ -66,7 +77,7 @@ var xEulerGetAlpha func(uintptr) float32
 // Retrieves the first component of the Euler angle vector,
 // depending on the order of rotation.
 //
-// See also: graphene_euler_get_x()
+// See also: [Euler.GetX]
 func (x *Euler) GetAlpha() float32 {
 
 	cret := xEulerGetAlpha(x.GoPointer())
@@ -78,7 +89,7 @@ var xEulerGetBeta func(uintptr) float32
 // Retrieves the second component of the Euler angle vector,
 // depending on the order of rotation.
 //
-// See also: graphene_euler_get_y()
+// See also: [Euler.GetY]
 func (x *Euler) GetBeta() float32 {
 
 	cret := xEulerGetBeta(x.GoPointer())
@@ -90,7 +101,7 @@ var xEulerGetGamma func(uintptr) float32
 // Retrieves the third component of the Euler angle vector,
 // depending on the order of rotation.
 //
-// See also: graphene_euler_get_z()
+// See also: [Euler.GetZ]
 func (x *Euler) GetGamma() float32 {
 
 	cret := xEulerGetGamma(x.GoPointer())
@@ -103,7 +114,7 @@ var xEulerGetOrder func(uintptr) EulerOrder
 // #graphene_euler_t structure, when converting to and from other
 // structures, like #graphene_quaternion_t and #graphene_matrix_t.
 //
-// This function does not return the %GRAPHENE_EULER_ORDER_DEFAULT
+// This function does not return the [EulerOrderDefaultValue]
 // enumeration value; it will return the effective order of rotation
 // instead.
 func (x *Euler) GetOrder() EulerOrder {
@@ -143,7 +154,7 @@ var xEulerInit func(uintptr, float32, float32, float32) *Euler
 
 // Initializes a #graphene_euler_t using the given angles.
 //
-// The order of the rotations is %GRAPHENE_EULER_ORDER_DEFAULT.
+// The order of the rotations is [EulerOrderDefaultValue].
 func (x *Euler) Init(XVar float32, YVar float32, ZVar float32) *Euler {
 
 	cret := xEulerInit(x.GoPointer(), XVar, YVar, ZVar)
@@ -155,8 +166,8 @@ var xEulerInitFromEuler func(uintptr, *Euler) *Euler
 // Initializes a #graphene_euler_t using the angles and order of
 // another #graphene_euler_t.
 //
-// If the #graphene_euler_t @src is %NULL, this function is equivalent
-// to calling graphene_euler_init() with all angles set to 0.
+// If the #graphene_euler_t src is nil, this function is equivalent
+// to calling [Euler.Init] with all angles set to 0.
 func (x *Euler) InitFromEuler(SrcVar *Euler) *Euler {
 
 	cret := xEulerInitFromEuler(x.GoPointer(), SrcVar)
@@ -167,7 +178,7 @@ var xEulerInitFromMatrix func(uintptr, *Matrix, EulerOrder) *Euler
 
 // Initializes a #graphene_euler_t using the given rotation matrix.
 //
-// If the #graphene_matrix_t @m is %NULL, the #graphene_euler_t will
+// If the #graphene_matrix_t m is nil, the #graphene_euler_t will
 // be initialized with all angles set to 0.
 func (x *Euler) InitFromMatrix(MVar *Matrix, OrderVar EulerOrder) *Euler {
 
@@ -179,7 +190,7 @@ var xEulerInitFromQuaternion func(uintptr, *Quaternion, EulerOrder) *Euler
 
 // Initializes a #graphene_euler_t using the given normalized quaternion.
 //
-// If the #graphene_quaternion_t @q is %NULL, the #graphene_euler_t will
+// If the #graphene_quaternion_t q is nil, the #graphene_euler_t will
 // be initialized with all angles set to 0.
 func (x *Euler) InitFromQuaternion(QVar *Quaternion, OrderVar EulerOrder) *Euler {
 
@@ -202,7 +213,7 @@ var xEulerInitFromVec3 func(uintptr, *Vec3, EulerOrder) *Euler
 // Initializes a #graphene_euler_t using the angles contained in a
 // #graphene_vec3_t.
 //
-// If the #graphene_vec3_t @v is %NULL, the #graphene_euler_t will be
+// If the #graphene_vec3_t v is nil, the #graphene_euler_t will be
 // initialized with all angles set to 0.
 func (x *Euler) InitFromVec3(VVar *Vec3, OrderVar EulerOrder) *Euler {
 
@@ -212,7 +223,7 @@ func (x *Euler) InitFromVec3(VVar *Vec3, OrderVar EulerOrder) *Euler {
 
 var xEulerInitWithOrder func(uintptr, float32, float32, float32, EulerOrder) *Euler
 
-// Initializes a #graphene_euler_t with the given angles and @order.
+// Initializes a #graphene_euler_t with the given angles and order.
 func (x *Euler) InitWithOrder(XVar float32, YVar float32, ZVar float32, OrderVar EulerOrder) *Euler {
 
 	cret := xEulerInitWithOrder(x.GoPointer(), XVar, YVar, ZVar, OrderVar)
@@ -221,7 +232,7 @@ func (x *Euler) InitWithOrder(XVar float32, YVar float32, ZVar float32, OrderVar
 
 var xEulerReorder func(uintptr, EulerOrder, *Euler)
 
-// Reorders a #graphene_euler_t using @order.
+// Reorders a #graphene_euler_t using order.
 //
 // This function is equivalent to creating a #graphene_quaternion_t from the
 // given #graphene_euler_t, and then converting the quaternion into another
@@ -239,7 +250,7 @@ var xEulerToMatrix func(uintptr, *Matrix)
 //
 // The rotations are applied over the reference frame axes in the order
 // associated with the #graphene_euler_t; for instance, if the order
-// used to initialize @e is %GRAPHENE_EULER_ORDER_XYZ:
+// used to initialize e is [EulerOrderXyzValue]:
 //
 //   - the first rotation moves the body around the X axis with
 //     an angle φ
@@ -277,7 +288,7 @@ func (x *Euler) ToVec3(ResVar *Vec3) {
 
 // Specify the order of the rotations on each axis.
 //
-// The %GRAPHENE_EULER_ORDER_DEFAULT value is special, and is used
+// The [EulerOrderDefaultValue] value is special, and is used
 // as an alias for one of the other orders.
 type EulerOrder int
 
@@ -287,22 +298,22 @@ const (
 	//   default order is one of the following enumeration values
 	EulerOrderDefaultValue EulerOrder = -1
 	// Rotate in the X, Y, and Z order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SXYZ
+	//   Graphene 1.10, it's an alias for [EulerOrderSxyzValue]
 	EulerOrderXyzValue EulerOrder = 0
 	// Rotate in the Y, Z, and X order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SYZX
+	//   Graphene 1.10, it's an alias for [EulerOrderSyzxValue]
 	EulerOrderYzxValue EulerOrder = 1
 	// Rotate in the Z, X, and Y order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SZXY
+	//   Graphene 1.10, it's an alias for [EulerOrderSzxyValue]
 	EulerOrderZxyValue EulerOrder = 2
 	// Rotate in the X, Z, and Y order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SXZY
+	//   Graphene 1.10, it's an alias for [EulerOrderSxzyValue]
 	EulerOrderXzyValue EulerOrder = 3
 	// Rotate in the Y, X, and Z order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SYXZ
+	//   Graphene 1.10, it's an alias for [EulerOrderSyxzValue]
 	EulerOrderYxzValue EulerOrder = 4
 	// Rotate in the Z, Y, and X order. Deprecated in
-	//   Graphene 1.10, it's an alias for %GRAPHENE_EULER_ORDER_SZYX
+	//   Graphene 1.10, it's an alias for [EulerOrderSzyxValue]
 	EulerOrderZyxValue EulerOrder = 5
 	// Defines a static rotation along the X, Y, and Z axes (Since: 1.10)
 	EulerOrderSxyzValue EulerOrder = 6
@@ -354,12 +365,219 @@ const (
 	EulerOrderRzyzValue EulerOrder = 29
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x EulerOrder) String() string {
+	switch x {
+	case EulerOrderDefaultValue:
+		return "GRAPHENE_EULER_ORDER_DEFAULT"
+	case EulerOrderXyzValue:
+		return "GRAPHENE_EULER_ORDER_XYZ"
+	case EulerOrderYzxValue:
+		return "GRAPHENE_EULER_ORDER_YZX"
+	case EulerOrderZxyValue:
+		return "GRAPHENE_EULER_ORDER_ZXY"
+	case EulerOrderXzyValue:
+		return "GRAPHENE_EULER_ORDER_XZY"
+	case EulerOrderYxzValue:
+		return "GRAPHENE_EULER_ORDER_YXZ"
+	case EulerOrderZyxValue:
+		return "GRAPHENE_EULER_ORDER_ZYX"
+	case EulerOrderSxyzValue:
+		return "GRAPHENE_EULER_ORDER_SXYZ"
+	case EulerOrderSxyxValue:
+		return "GRAPHENE_EULER_ORDER_SXYX"
+	case EulerOrderSxzyValue:
+		return "GRAPHENE_EULER_ORDER_SXZY"
+	case EulerOrderSxzxValue:
+		return "GRAPHENE_EULER_ORDER_SXZX"
+	case EulerOrderSyzxValue:
+		return "GRAPHENE_EULER_ORDER_SYZX"
+	case EulerOrderSyzyValue:
+		return "GRAPHENE_EULER_ORDER_SYZY"
+	case EulerOrderSyxzValue:
+		return "GRAPHENE_EULER_ORDER_SYXZ"
+	case EulerOrderSyxyValue:
+		return "GRAPHENE_EULER_ORDER_SYXY"
+	case EulerOrderSzxyValue:
+		return "GRAPHENE_EULER_ORDER_SZXY"
+	case EulerOrderSzxzValue:
+		return "GRAPHENE_EULER_ORDER_SZXZ"
+	case EulerOrderSzyxValue:
+		return "GRAPHENE_EULER_ORDER_SZYX"
+	case EulerOrderSzyzValue:
+		return "GRAPHENE_EULER_ORDER_SZYZ"
+	case EulerOrderRzyxValue:
+		return "GRAPHENE_EULER_ORDER_RZYX"
+	case EulerOrderRxyxValue:
+		return "GRAPHENE_EULER_ORDER_RXYX"
+	case EulerOrderRyzxValue:
+		return "GRAPHENE_EULER_ORDER_RYZX"
+	case EulerOrderRxzxValue:
+		return "GRAPHENE_EULER_ORDER_RXZX"
+	case EulerOrderRxzyValue:
+		return "GRAPHENE_EULER_ORDER_RXZY"
+	case EulerOrderRyzyValue:
+		return "GRAPHENE_EULER_ORDER_RYZY"
+	case EulerOrderRzxyValue:
+		return "GRAPHENE_EULER_ORDER_RZXY"
+	case EulerOrderRyxyValue:
+		return "GRAPHENE_EULER_ORDER_RYXY"
+	case EulerOrderRyxzValue:
+		return "GRAPHENE_EULER_ORDER_RYXZ"
+	case EulerOrderRzxzValue:
+		return "GRAPHENE_EULER_ORDER_RZXZ"
+	case EulerOrderRxyzValue:
+		return "GRAPHENE_EULER_ORDER_RXYZ"
+	case EulerOrderRzyzValue:
+		return "GRAPHENE_EULER_ORDER_RZYZ"
+	}
+	return fmt.Sprintf("EulerOrder(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EulerOrder) Nick() string {
+	switch x {
+	case EulerOrderDefaultValue:
+		return "default"
+	case EulerOrderXyzValue:
+		return "xyz"
+	case EulerOrderYzxValue:
+		return "yzx"
+	case EulerOrderZxyValue:
+		return "zxy"
+	case EulerOrderXzyValue:
+		return "xzy"
+	case EulerOrderYxzValue:
+		return "yxz"
+	case EulerOrderZyxValue:
+		return "zyx"
+	case EulerOrderSxyzValue:
+		return "sxyz"
+	case EulerOrderSxyxValue:
+		return "sxyx"
+	case EulerOrderSxzyValue:
+		return "sxzy"
+	case EulerOrderSxzxValue:
+		return "sxzx"
+	case EulerOrderSyzxValue:
+		return "syzx"
+	case EulerOrderSyzyValue:
+		return "syzy"
+	case EulerOrderSyxzValue:
+		return "syxz"
+	case EulerOrderSyxyValue:
+		return "syxy"
+	case EulerOrderSzxyValue:
+		return "szxy"
+	case EulerOrderSzxzValue:
+		return "szxz"
+	case EulerOrderSzyxValue:
+		return "szyx"
+	case EulerOrderSzyzValue:
+		return "szyz"
+	case EulerOrderRzyxValue:
+		return "rzyx"
+	case EulerOrderRxyxValue:
+		return "rxyx"
+	case EulerOrderRyzxValue:
+		return "ryzx"
+	case EulerOrderRxzxValue:
+		return "rxzx"
+	case EulerOrderRxzyValue:
+		return "rxzy"
+	case EulerOrderRyzyValue:
+		return "ryzy"
+	case EulerOrderRzxyValue:
+		return "rzxy"
+	case EulerOrderRyxyValue:
+		return "ryxy"
+	case EulerOrderRyxzValue:
+		return "ryxz"
+	case EulerOrderRzxzValue:
+		return "rzxz"
+	case EulerOrderRxyzValue:
+		return "rxyz"
+	case EulerOrderRzyzValue:
+		return "rzyz"
+	}
+	return ""
+}
+
+// EulerOrderFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EulerOrderFromString(s string) (EulerOrder, bool) {
+	switch s {
+	case "GRAPHENE_EULER_ORDER_DEFAULT", "default":
+		return EulerOrderDefaultValue, true
+	case "GRAPHENE_EULER_ORDER_XYZ", "xyz":
+		return EulerOrderXyzValue, true
+	case "GRAPHENE_EULER_ORDER_YZX", "yzx":
+		return EulerOrderYzxValue, true
+	case "GRAPHENE_EULER_ORDER_ZXY", "zxy":
+		return EulerOrderZxyValue, true
+	case "GRAPHENE_EULER_ORDER_XZY", "xzy":
+		return EulerOrderXzyValue, true
+	case "GRAPHENE_EULER_ORDER_YXZ", "yxz":
+		return EulerOrderYxzValue, true
+	case "GRAPHENE_EULER_ORDER_ZYX", "zyx":
+		return EulerOrderZyxValue, true
+	case "GRAPHENE_EULER_ORDER_SXYZ", "sxyz":
+		return EulerOrderSxyzValue, true
+	case "GRAPHENE_EULER_ORDER_SXYX", "sxyx":
+		return EulerOrderSxyxValue, true
+	case "GRAPHENE_EULER_ORDER_SXZY", "sxzy":
+		return EulerOrderSxzyValue, true
+	case "GRAPHENE_EULER_ORDER_SXZX", "sxzx":
+		return EulerOrderSxzxValue, true
+	case "GRAPHENE_EULER_ORDER_SYZX", "syzx":
+		return EulerOrderSyzxValue, true
+	case "GRAPHENE_EULER_ORDER_SYZY", "syzy":
+		return EulerOrderSyzyValue, true
+	case "GRAPHENE_EULER_ORDER_SYXZ", "syxz":
+		return EulerOrderSyxzValue, true
+	case "GRAPHENE_EULER_ORDER_SYXY", "syxy":
+		return EulerOrderSyxyValue, true
+	case "GRAPHENE_EULER_ORDER_SZXY", "szxy":
+		return EulerOrderSzxyValue, true
+	case "GRAPHENE_EULER_ORDER_SZXZ", "szxz":
+		return EulerOrderSzxzValue, true
+	case "GRAPHENE_EULER_ORDER_SZYX", "szyx":
+		return EulerOrderSzyxValue, true
+	case "GRAPHENE_EULER_ORDER_SZYZ", "szyz":
+		return EulerOrderSzyzValue, true
+	case "GRAPHENE_EULER_ORDER_RZYX", "rzyx":
+		return EulerOrderRzyxValue, true
+	case "GRAPHENE_EULER_ORDER_RXYX", "rxyx":
+		return EulerOrderRxyxValue, true
+	case "GRAPHENE_EULER_ORDER_RYZX", "ryzx":
+		return EulerOrderRyzxValue, true
+	case "GRAPHENE_EULER_ORDER_RXZX", "rxzx":
+		return EulerOrderRxzxValue, true
+	case "GRAPHENE_EULER_ORDER_RXZY", "rxzy":
+		return EulerOrderRxzyValue, true
+	case "GRAPHENE_EULER_ORDER_RYZY", "ryzy":
+		return EulerOrderRyzyValue, true
+	case "GRAPHENE_EULER_ORDER_RZXY", "rzxy":
+		return EulerOrderRzxyValue, true
+	case "GRAPHENE_EULER_ORDER_RYXY", "ryxy":
+		return EulerOrderRyxyValue, true
+	case "GRAPHENE_EULER_ORDER_RYXZ", "ryxz":
+		return EulerOrderRyxzValue, true
+	case "GRAPHENE_EULER_ORDER_RZXZ", "rzxz":
+		return EulerOrderRzxzValue, true
+	case "GRAPHENE_EULER_ORDER_RXYZ", "rxyz":
+		return EulerOrderRxyzValue, true
+	case "GRAPHENE_EULER_ORDER_RZYZ", "rzyz":
+		return EulerOrderRzyzValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GRAPHENE", "graphene-gobject-1.0")
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -368,27 +586,27 @@ func init() {
 
 	core.PuregoSafeRegister(&xEulerGLibType, libs, "graphene_euler_get_type")
 
-	core.PuregoSafeRegister(&xEulerAlloc, libs, "graphene_euler_alloc")
-
-	core.PuregoSafeRegister(&xEulerEqual, libs, "graphene_euler_equal")
-	core.PuregoSafeRegister(&xEulerFree, libs, "graphene_euler_free")
-	core.PuregoSafeRegister(&xEulerGetAlpha, libs, "graphene_euler_get_alpha")
-	core.PuregoSafeRegister(&xEulerGetBeta, libs, "graphene_euler_get_beta")
-	core.PuregoSafeRegister(&xEulerGetGamma, libs, "graphene_euler_get_gamma")
-	core.PuregoSafeRegister(&xEulerGetOrder, libs, "graphene_euler_get_order")
-	core.PuregoSafeRegister(&xEulerGetX, libs, "graphene_euler_get_x")
-	core.PuregoSafeRegister(&xEulerGetY, libs, "graphene_euler_get_y")
-	core.PuregoSafeRegister(&xEulerGetZ, libs, "graphene_euler_get_z")
-	core.PuregoSafeRegister(&xEulerInit, libs, "graphene_euler_init")
-	core.PuregoSafeRegister(&xEulerInitFromEuler, libs, "graphene_euler_init_from_euler")
-	core.PuregoSafeRegister(&xEulerInitFromMatrix, libs, "graphene_euler_init_from_matrix")
-	core.PuregoSafeRegister(&xEulerInitFromQuaternion, libs, "graphene_euler_init_from_quaternion")
-	core.PuregoSafeRegister(&xEulerInitFromRadians, libs, "graphene_euler_init_from_radians")
-	core.PuregoSafeRegister(&xEulerInitFromVec3, libs, "graphene_euler_init_from_vec3")
-	core.PuregoSafeRegister(&xEulerInitWithOrder, libs, "graphene_euler_init_with_order")
-	core.PuregoSafeRegister(&xEulerReorder, libs, "graphene_euler_reorder")
-	core.PuregoSafeRegister(&xEulerToMatrix, libs, "graphene_euler_to_matrix")
-	core.PuregoSafeRegister(&xEulerToQuaternion, libs, "graphene_euler_to_quaternion")
-	core.PuregoSafeRegister(&xEulerToVec3, libs, "graphene_euler_to_vec3")
+	core.PuregoRegisterSince(&xEulerAlloc, libs, "graphene_euler_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xEulerEqual, libs, "graphene_euler_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerFree, libs, "graphene_euler_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerGetAlpha, libs, "graphene_euler_get_alpha", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xEulerGetBeta, libs, "graphene_euler_get_beta", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xEulerGetGamma, libs, "graphene_euler_get_gamma", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xEulerGetOrder, libs, "graphene_euler_get_order", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerGetX, libs, "graphene_euler_get_x", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerGetY, libs, "graphene_euler_get_y", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerGetZ, libs, "graphene_euler_get_z", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInit, libs, "graphene_euler_init", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInitFromEuler, libs, "graphene_euler_init_from_euler", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInitFromMatrix, libs, "graphene_euler_init_from_matrix", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInitFromQuaternion, libs, "graphene_euler_init_from_quaternion", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInitFromRadians, libs, "graphene_euler_init_from_radians", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xEulerInitFromVec3, libs, "graphene_euler_init_from_vec3", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerInitWithOrder, libs, "graphene_euler_init_with_order", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerReorder, libs, "graphene_euler_reorder", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerToMatrix, libs, "graphene_euler_to_matrix", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xEulerToQuaternion, libs, "graphene_euler_to_quaternion", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xEulerToVec3, libs, "graphene_euler_to_vec3", "GRAPHENE", "1.2")
 
 }
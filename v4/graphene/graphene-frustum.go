@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -30,6 +30,16 @@ func (x *Frustum) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Frustum made with g_boxed_copy, release it with Free.
+func (x *Frustum) Copy() *Frustum {
+	cret := gobject.BoxedCopy(FrustumGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Frustum)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xFrustumAlloc func() *Frustum
 
 // Allocates a new #graphene_frustum_t structure.
@@ -62,7 +72,7 @@ func (x *Frustum) Equal(BVar *Frustum) bool {
 
 var xFrustumFree func(uintptr)
 
-// Frees the resources allocated by graphene_frustum_alloc().
+// Frees the resources allocated by [FrustumAlloc].
 func (x *Frustum) Free() {
 
 	xFrustumFree(x.GoPointer())
@@ -100,7 +110,7 @@ func (x *Frustum) InitFromFrustum(SrcVar *Frustum) *Frustum {
 
 var xFrustumInitFromMatrix func(uintptr, *Matrix) *Frustum
 
-// Initializes a #graphene_frustum_t using the given @matrix.
+// Initializes a #graphene_frustum_t using the given matrix.
 func (x *Frustum) InitFromMatrix(MatrixVar *Matrix) *Frustum {
 
 	cret := xFrustumInitFromMatrix(x.GoPointer(), MatrixVar)
@@ -109,7 +119,7 @@ func (x *Frustum) InitFromMatrix(MatrixVar *Matrix) *Frustum {
 
 var xFrustumIntersectsBox func(uintptr, *Box) bool
 
-// Checks whether the given @box intersects a plane of
+// Checks whether the given box intersects a plane of
 // a #graphene_frustum_t.
 func (x *Frustum) IntersectsBox(BoxVar *Box) bool {
 
@@ -119,7 +129,7 @@ func (x *Frustum) IntersectsBox(BoxVar *Box) bool {
 
 var xFrustumIntersectsSphere func(uintptr, *Sphere) bool
 
-// Checks whether the given @sphere intersects a plane of
+// Checks whether the given sphere intersects a plane of
 // a #graphene_frustum_t.
 func (x *Frustum) IntersectsSphere(SphereVar *Sphere) bool {
 
@@ -132,7 +142,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -141,16 +151,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xFrustumGLibType, libs, "graphene_frustum_get_type")
 
-	core.PuregoSafeRegister(&xFrustumAlloc, libs, "graphene_frustum_alloc")
-
-	core.PuregoSafeRegister(&xFrustumContainsPoint, libs, "graphene_frustum_contains_point")
-	core.PuregoSafeRegister(&xFrustumEqual, libs, "graphene_frustum_equal")
-	core.PuregoSafeRegister(&xFrustumFree, libs, "graphene_frustum_free")
-	core.PuregoSafeRegister(&xFrustumGetPlanes, libs, "graphene_frustum_get_planes")
-	core.PuregoSafeRegister(&xFrustumInit, libs, "graphene_frustum_init")
-	core.PuregoSafeRegister(&xFrustumInitFromFrustum, libs, "graphene_frustum_init_from_frustum")
-	core.PuregoSafeRegister(&xFrustumInitFromMatrix, libs, "graphene_frustum_init_from_matrix")
-	core.PuregoSafeRegister(&xFrustumIntersectsBox, libs, "graphene_frustum_intersects_box")
-	core.PuregoSafeRegister(&xFrustumIntersectsSphere, libs, "graphene_frustum_intersects_sphere")
+	core.PuregoRegisterSince(&xFrustumAlloc, libs, "graphene_frustum_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xFrustumContainsPoint, libs, "graphene_frustum_contains_point", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumEqual, libs, "graphene_frustum_equal", "GRAPHENE", "1.6")
+	core.PuregoRegisterSince(&xFrustumFree, libs, "graphene_frustum_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumGetPlanes, libs, "graphene_frustum_get_planes", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumInit, libs, "graphene_frustum_init", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumInitFromFrustum, libs, "graphene_frustum_init_from_frustum", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumInitFromMatrix, libs, "graphene_frustum_init_from_matrix", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumIntersectsBox, libs, "graphene_frustum_intersects_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xFrustumIntersectsSphere, libs, "graphene_frustum_intersects_sphere", "GRAPHENE", "1.2")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type Matrix struct {
 	_ structs.HostLayout
 
-	Value uintptr
+	Value Simd4X4F
 }
 
 var xMatrixGLibType func() types.GType
@@ -30,6 +30,16 @@ func (x *Matrix) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Matrix made with g_boxed_copy, release it with Free.
+func (x *Matrix) Copy() *Matrix {
+	cret := gobject.BoxedCopy(MatrixGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Matrix)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xMatrixAlloc func() *Matrix
 
 // Allocates a new #graphene_matrix_t.
@@ -44,10 +54,13 @@ var xMatrixDecompose func(uintptr, *Vec3, *Vec3, *Quaternion, *Vec3, *Vec4) bool
 // Decomposes a transformation matrix into its component transformations.
 //
 // The algorithm for decomposing a matrix is taken from the
-// [CSS3 Transforms specification](http://dev.w3.org/csswg/css-transforms/);
+// [CSS3 Transforms specification];
 // specifically, the decomposition code is based on the equivalent code
 // published in "Graphics Gems II", edited by Jim Arvo, and
-// [available online](http://web.archive.org/web/20150512160205/http://tog.acm.org/resources/GraphicsGems/gemsii/unmatrix.c).
+// [available online].
+//
+// [CSS3 Transforms specification]: http://dev.w3.org/csswg/css-transforms/
+// [available online]: http://web.archive.org/web/20150512160205/http://tog.acm.org/resources/GraphicsGems/gemsii/unmatrix.c
 func (x *Matrix) Decompose(TranslateVar *Vec3, ScaleVar *Vec3, RotateVar *Quaternion, ShearVar *Vec3, PerspectiveVar *Vec4) bool {
 
 	cret := xMatrixDecompose(x.GoPointer(), TranslateVar, ScaleVar, RotateVar, ShearVar, PerspectiveVar)
@@ -77,12 +90,10 @@ var xMatrixEqualFast func(uintptr, *Matrix) bool
 // Checks whether the two given #graphene_matrix_t matrices are
 // byte-by-byte equal.
 //
-// While this function is faster than graphene_matrix_equal(), it
+// While this function is faster than [Matrix.Equal], it
 // can also return false negatives, so it should be used in
-// conjuction with either graphene_matrix_equal() or
-// graphene_matrix_near(). For instance:
-//
-// |[&lt;!-- language="C" --&gt;
+// conjuction with either [Matrix.Equal] or
+// [Matrix.Near]. For instance:
 //
 //	if (graphene_matrix_equal_fast (a, b))
 //	  {
@@ -97,8 +108,6 @@ var xMatrixEqualFast func(uintptr, *Matrix) bool
 //	    else
 //	      // matrices are not equal
 //	  }
-//
-// ]|
 func (x *Matrix) EqualFast(BVar *Matrix) bool {
 
 	cret := xMatrixEqualFast(x.GoPointer(), BVar)
@@ -107,7 +116,7 @@ func (x *Matrix) EqualFast(BVar *Matrix) bool {
 
 var xMatrixFree func(uintptr)
 
-// Frees the resources allocated by graphene_matrix_alloc().
+// Frees the resources allocated by [MatrixAlloc].
 func (x *Matrix) Free() {
 
 	xMatrixFree(x.GoPointer())
@@ -116,7 +125,7 @@ func (x *Matrix) Free() {
 
 var xMatrixGetRow func(uintptr, uint, *Vec4)
 
-// Retrieves the given row vector at @index_ inside a matrix.
+// Retrieves the given row vector at index_ inside a matrix.
 func (x *Matrix) GetRow(IndexVar uint, ResVar *Vec4) {
 
 	xMatrixGetRow(x.GoPointer(), IndexVar, ResVar)
@@ -125,7 +134,7 @@ func (x *Matrix) GetRow(IndexVar uint, ResVar *Vec4) {
 
 var xMatrixGetValue func(uintptr, uint, uint) float32
 
-// Retrieves the value at the given @row and @col index.
+// Retrieves the value at the given row and col index.
 func (x *Matrix) GetValue(RowVar uint, ColVar uint) float32 {
 
 	cret := xMatrixGetValue(x.GoPointer(), RowVar, ColVar)
@@ -134,7 +143,7 @@ func (x *Matrix) GetValue(RowVar uint, ColVar uint) float32 {
 
 var xMatrixGetXScale func(uintptr) float32
 
-// Retrieves the scaling factor on the X axis in @m.
+// Retrieves the scaling factor on the X axis in m.
 func (x *Matrix) GetXScale() float32 {
 
 	cret := xMatrixGetXScale(x.GoPointer())
@@ -143,7 +152,7 @@ func (x *Matrix) GetXScale() float32 {
 
 var xMatrixGetXTranslation func(uintptr) float32
 
-// Retrieves the translation component on the X axis from @m.
+// Retrieves the translation component on the X axis from m.
 func (x *Matrix) GetXTranslation() float32 {
 
 	cret := xMatrixGetXTranslation(x.GoPointer())
@@ -152,7 +161,7 @@ func (x *Matrix) GetXTranslation() float32 {
 
 var xMatrixGetYScale func(uintptr) float32
 
-// Retrieves the scaling factor on the Y axis in @m.
+// Retrieves the scaling factor on the Y axis in m.
 func (x *Matrix) GetYScale() float32 {
 
 	cret := xMatrixGetYScale(x.GoPointer())
@@ -161,7 +170,7 @@ func (x *Matrix) GetYScale() float32 {
 
 var xMatrixGetYTranslation func(uintptr) float32
 
-// Retrieves the translation component on the Y axis from @m.
+// Retrieves the translation component on the Y axis from m.
 func (x *Matrix) GetYTranslation() float32 {
 
 	cret := xMatrixGetYTranslation(x.GoPointer())
@@ -170,7 +179,7 @@ func (x *Matrix) GetYTranslation() float32 {
 
 var xMatrixGetZScale func(uintptr) float32
 
-// Retrieves the scaling factor on the Z axis in @m.
+// Retrieves the scaling factor on the Z axis in m.
 func (x *Matrix) GetZScale() float32 {
 
 	cret := xMatrixGetZScale(x.GoPointer())
@@ -179,7 +188,7 @@ func (x *Matrix) GetZScale() float32 {
 
 var xMatrixGetZTranslation func(uintptr) float32
 
-// Retrieves the translation component on the Z axis from @m.
+// Retrieves the translation component on the Z axis from m.
 func (x *Matrix) GetZTranslation() float32 {
 
 	cret := xMatrixGetZTranslation(x.GoPointer())
@@ -193,14 +202,10 @@ var xMatrixInitFrom2d func(uintptr, float64, float64, float64, float64, float64,
 //
 // The arguments map to the following matrix layout:
 //
-// |[&lt;!-- language="plain" --&gt;
-//
 //	⎛ xx  yx ⎞   ⎛  a   b  0 ⎞
 //	⎜ xy  yy ⎟ = ⎜  c   d  0 ⎟
 //	⎝ x0  y0 ⎠   ⎝ tx  ty  1 ⎠
 //
-// ]|
-//
 // This function can be used to convert between an affine matrix type
 // from other libraries and a #graphene_matrix_t.
 func (x *Matrix) InitFrom2d(XxVar float64, YxVar float64, XyVar float64, YyVar float64, X0Var float64, Y0Var float64) *Matrix {
@@ -243,7 +248,7 @@ var xMatrixInitFrustum func(uintptr, float32, float32, float32, float32, float32
 
 // Initializes a #graphene_matrix_t compatible with #graphene_frustum_t.
 //
-// See also: graphene_frustum_init_from_matrix()
+// See also: [Frustum.InitFromMatrix]
 func (x *Matrix) InitFrustum(LeftVar float32, RightVar float32, BottomVar float32, TopVar float32, ZNearVar float32, ZFarVar float32) *Matrix {
 
 	cret := xMatrixInitFrustum(x.GoPointer(), LeftVar, RightVar, BottomVar, TopVar, ZNearVar, ZFarVar)
@@ -262,18 +267,18 @@ func (x *Matrix) InitIdentity() *Matrix {
 var xMatrixInitLookAt func(uintptr, *Vec3, *Vec3, *Vec3) *Matrix
 
 // Initializes a #graphene_matrix_t so that it positions the "camera"
-// at the given @eye coordinates towards an object at the @center
+// at the given eye coordinates towards an object at the center
 // coordinates. The top of the camera is aligned to the direction
-// of the @up vector.
+// of the up vector.
 //
 // Before the transform, the camera is assumed to be placed at the
 // origin, looking towards the negative Z axis, with the top side of
 // the camera facing in the direction of the Y axis and the right
 // side in the direction of the X axis.
 //
-// In theory, one could use @m to transform a model of such a camera
+// In theory, one could use m to transform a model of such a camera
 // into world-space. However, it is more common to use the inverse of
-// @m to transform another object from world coordinates to the view
+// m to transform another object from world coordinates to the view
 // coordinates of the camera. Typically you would then apply the
 // camera projection transform to get from view to screen
 // coordinates.
@@ -303,8 +308,8 @@ func (x *Matrix) InitPerspective(FovyVar float32, AspectVar float32, ZNearVar fl
 
 var xMatrixInitRotate func(uintptr, float32, *Vec3) *Matrix
 
-// Initializes @m to represent a rotation of @angle degrees on
-// the axis represented by the @axis vector.
+// Initializes m to represent a rotation of angle degrees on
+// the axis represented by the axis vector.
 func (x *Matrix) InitRotate(AngleVar float32, AxisVar *Vec3) *Matrix {
 
 	cret := xMatrixInitRotate(x.GoPointer(), AngleVar, AxisVar)
@@ -405,7 +410,7 @@ var xMatrixMultiply func(uintptr, *Matrix, *Matrix)
 // Multiplies two #graphene_matrix_t.
 //
 // Matrix multiplication is not commutative in general; the order of the factors matters.
-// The product of this multiplication is (@a × @b)
+// The product of this multiplication is (a × b)
 func (x *Matrix) Multiply(BVar *Matrix, ResVar *Matrix) {
 
 	xMatrixMultiply(x.GoPointer(), BVar, ResVar)
@@ -415,7 +420,7 @@ func (x *Matrix) Multiply(BVar *Matrix, ResVar *Matrix) {
 var xMatrixNear func(uintptr, *Matrix, float32) bool
 
 // Compares the two given #graphene_matrix_t matrices and checks
-// whether their values are within the given @epsilon of each
+// whether their values are within the given epsilon of each
 // other.
 func (x *Matrix) Near(BVar *Matrix, EpsilonVar float32) bool {
 
@@ -434,7 +439,7 @@ func (x *Matrix) Normalize(ResVar *Matrix) {
 
 var xMatrixPerspective func(uintptr, float32, *Matrix)
 
-// Applies a perspective of @depth to the matrix.
+// Applies a perspective of depth to the matrix.
 func (x *Matrix) Perspective(DepthVar float32, ResVar *Matrix) {
 
 	xMatrixPerspective(x.GoPointer(), DepthVar, ResVar)
@@ -455,7 +460,7 @@ func (x *Matrix) Print() {
 
 var xMatrixProjectPoint func(uintptr, *Point, *Point)
 
-// Projects a #graphene_point_t using the matrix @m.
+// Projects a #graphene_point_t using the matrix m.
 func (x *Matrix) ProjectPoint(PVar *Point, ResVar *Point) {
 
 	xMatrixProjectPoint(x.GoPointer(), PVar, ResVar)
@@ -466,7 +471,7 @@ var xMatrixProjectRect func(uintptr, *Rect, *Quad)
 
 // Projects all corners of a #graphene_rect_t using the given matrix.
 //
-// See also: graphene_matrix_project_point()
+// See also: [Matrix.ProjectPoint]
 func (x *Matrix) ProjectRect(RVar *Rect, ResVar *Quad) {
 
 	xMatrixProjectRect(x.GoPointer(), RVar, ResVar)
@@ -487,11 +492,11 @@ func (x *Matrix) ProjectRectBounds(RVar *Rect, ResVar *Rect) {
 
 var xMatrixRotate func(uintptr, float32, *Vec3)
 
-// Adds a rotation transformation to @m, using the given @angle
-// and @axis vector.
+// Adds a rotation transformation to m, using the given angle
+// and axis vector.
 //
-// This is the equivalent of calling graphene_matrix_init_rotate() and
-// then multiplying the matrix @m with the rotation matrix.
+// This is the equivalent of calling [Matrix.InitRotate] and
+// then multiplying the matrix m with the rotation matrix.
 func (x *Matrix) Rotate(AngleVar float32, AxisVar *Vec3) {
 
 	xMatrixRotate(x.GoPointer(), AngleVar, AxisVar)
@@ -500,7 +505,7 @@ func (x *Matrix) Rotate(AngleVar float32, AxisVar *Vec3) {
 
 var xMatrixRotateEuler func(uintptr, *Euler)
 
-// Adds a rotation transformation to @m, using the given
+// Adds a rotation transformation to m, using the given
 // #graphene_euler_t.
 func (x *Matrix) RotateEuler(EVar *Euler) {
 
@@ -510,11 +515,11 @@ func (x *Matrix) RotateEuler(EVar *Euler) {
 
 var xMatrixRotateQuaternion func(uintptr, *Quaternion)
 
-// Adds a rotation transformation to @m, using the given
+// Adds a rotation transformation to m, using the given
 // #graphene_quaternion_t.
 //
-// This is the equivalent of calling graphene_quaternion_to_matrix() and
-// then multiplying @m with the rotation matrix.
+// This is the equivalent of calling [Quaternion.ToMatrix] and
+// then multiplying m with the rotation matrix.
 func (x *Matrix) RotateQuaternion(QVar *Quaternion) {
 
 	xMatrixRotateQuaternion(x.GoPointer(), QVar)
@@ -523,10 +528,10 @@ func (x *Matrix) RotateQuaternion(QVar *Quaternion) {
 
 var xMatrixRotateX func(uintptr, float32)
 
-// Adds a rotation transformation around the X axis to @m, using
-// the given @angle.
+// Adds a rotation transformation around the X axis to m, using
+// the given angle.
 //
-// See also: graphene_matrix_rotate()
+// See also: [Matrix.Rotate]
 func (x *Matrix) RotateX(AngleVar float32) {
 
 	xMatrixRotateX(x.GoPointer(), AngleVar)
@@ -535,10 +540,10 @@ func (x *Matrix) RotateX(AngleVar float32) {
 
 var xMatrixRotateY func(uintptr, float32)
 
-// Adds a rotation transformation around the Y axis to @m, using
-// the given @angle.
+// Adds a rotation transformation around the Y axis to m, using
+// the given angle.
 //
-// See also: graphene_matrix_rotate()
+// See also: [Matrix.Rotate]
 func (x *Matrix) RotateY(AngleVar float32) {
 
 	xMatrixRotateY(x.GoPointer(), AngleVar)
@@ -547,10 +552,10 @@ func (x *Matrix) RotateY(AngleVar float32) {
 
 var xMatrixRotateZ func(uintptr, float32)
 
-// Adds a rotation transformation around the Z axis to @m, using
-// the given @angle.
+// Adds a rotation transformation around the Z axis to m, using
+// the given angle.
 //
-// See also: graphene_matrix_rotate()
+// See also: [Matrix.Rotate]
 func (x *Matrix) RotateZ(AngleVar float32) {
 
 	xMatrixRotateZ(x.GoPointer(), AngleVar)
@@ -559,11 +564,11 @@ func (x *Matrix) RotateZ(AngleVar float32) {
 
 var xMatrixScale func(uintptr, float32, float32, float32)
 
-// Adds a scaling transformation to @m, using the three
+// Adds a scaling transformation to m, using the three
 // given factors.
 //
-// This is the equivalent of calling graphene_matrix_init_scale() and then
-// multiplying the matrix @m with the scale matrix.
+// This is the equivalent of calling [Matrix.InitScale] and then
+// multiplying the matrix m with the scale matrix.
 func (x *Matrix) Scale(FactorXVar float32, FactorYVar float32, FactorZVar float32) {
 
 	xMatrixScale(x.GoPointer(), FactorXVar, FactorYVar, FactorZVar)
@@ -572,7 +577,7 @@ func (x *Matrix) Scale(FactorXVar float32, FactorYVar float32, FactorZVar float3
 
 var xMatrixSkewXy func(uintptr, float32)
 
-// Adds a skew of @factor on the X and Y axis to the given matrix.
+// Adds a skew of factor on the X and Y axis to the given matrix.
 func (x *Matrix) SkewXy(FactorVar float32) {
 
 	xMatrixSkewXy(x.GoPointer(), FactorVar)
@@ -581,7 +586,7 @@ func (x *Matrix) SkewXy(FactorVar float32) {
 
 var xMatrixSkewXz func(uintptr, float32)
 
-// Adds a skew of @factor on the X and Z axis to the given matrix.
+// Adds a skew of factor on the X and Z axis to the given matrix.
 func (x *Matrix) SkewXz(FactorVar float32) {
 
 	xMatrixSkewXz(x.GoPointer(), FactorVar)
@@ -590,7 +595,7 @@ func (x *Matrix) SkewXz(FactorVar float32) {
 
 var xMatrixSkewYz func(uintptr, float32)
 
-// Adds a skew of @factor on the Y and Z axis to the given matrix.
+// Adds a skew of factor on the Y and Z axis to the given matrix.
 func (x *Matrix) SkewYz(FactorVar float32) {
 
 	xMatrixSkewYz(x.GoPointer(), FactorVar)
@@ -604,14 +609,10 @@ var xMatrixTo2d func(uintptr, *float64, *float64, *float64, *float64, *float64,
 //
 // The returned values have the following layout:
 //
-// |[&lt;!-- language="plain" --&gt;
-//
 //	⎛ xx  yx ⎞   ⎛  a   b  0 ⎞
 //	⎜ xy  yy ⎟ = ⎜  c   d  0 ⎟
 //	⎝ x0  y0 ⎠   ⎝ tx  ty  1 ⎠
 //
-// ]|
-//
 // This function can be used to convert between a #graphene_matrix_t
 // and an affine matrix type from other libraries.
 func (x *Matrix) To2d(XxVar *float64, YxVar *float64, XyVar *float64, YyVar *float64, X0Var *float64, Y0Var *float64) bool {
@@ -632,12 +633,12 @@ func (x *Matrix) ToFloat(VVar *[16]float32) {
 
 var xMatrixTransformBounds func(uintptr, *Rect, *Rect)
 
-// Transforms each corner of a #graphene_rect_t using the given matrix @m.
+// Transforms each corner of a #graphene_rect_t using the given matrix m.
 //
 // The result is the axis aligned bounding rectangle containing the coplanar
 // quadrilateral.
 //
-// See also: graphene_matrix_transform_point()
+// See also: [Matrix.TransformPoint]
 func (x *Matrix) TransformBounds(RVar *Rect, ResVar *Rect) {
 
 	xMatrixTransformBounds(x.GoPointer(), RVar, ResVar)
@@ -646,7 +647,7 @@ func (x *Matrix) TransformBounds(RVar *Rect, ResVar *Rect) {
 
 var xMatrixTransformBox func(uintptr, *Box, *Box)
 
-// Transforms the vertices of a #graphene_box_t using the given matrix @m.
+// Transforms the vertices of a #graphene_box_t using the given matrix m.
 //
 // The result is the axis aligned bounding box containing the transformed
 // vertices.
@@ -658,9 +659,9 @@ func (x *Matrix) TransformBox(BVar *Box, ResVar *Box) {
 
 var xMatrixTransformPoint func(uintptr, *Point, *Point)
 
-// Transforms the given #graphene_point_t using the matrix @m.
+// Transforms the given #graphene_point_t using the matrix m.
 //
-// Unlike graphene_matrix_transform_vec3(), this function will take into
+// Unlike [Matrix.TransformVec3], this function will take into
 // account the fourth row vector of the #graphene_matrix_t when computing
 // the dot product of each row vector of the matrix.
 //
@@ -673,9 +674,9 @@ func (x *Matrix) TransformPoint(PVar *Point, ResVar *Point) {
 
 var xMatrixTransformPoint3d func(uintptr, *Point3D, *Point3D)
 
-// Transforms the given #graphene_point3d_t using the matrix @m.
+// Transforms the given #graphene_point3d_t using the matrix m.
 //
-// Unlike graphene_matrix_transform_vec3(), this function will take into
+// Unlike [Matrix.TransformVec3], this function will take into
 // account the fourth row vector of the #graphene_matrix_t when computing
 // the dot product of each row vector of the matrix.
 //
@@ -688,7 +689,7 @@ func (x *Matrix) TransformPoint3d(PVar *Point3D, ResVar *Point3D) {
 
 var xMatrixTransformRay func(uintptr, *Ray, *Ray)
 
-// Transform a #graphene_ray_t using the given matrix @m.
+// Transform a #graphene_ray_t using the given matrix m.
 func (x *Matrix) TransformRay(RVar *Ray, ResVar *Ray) {
 
 	xMatrixTransformRay(x.GoPointer(), RVar, ResVar)
@@ -697,11 +698,11 @@ func (x *Matrix) TransformRay(RVar *Ray, ResVar *Ray) {
 
 var xMatrixTransformRect func(uintptr, *Rect, *Quad)
 
-// Transforms each corner of a #graphene_rect_t using the given matrix @m.
+// Transforms each corner of a #graphene_rect_t using the given matrix m.
 //
 // The result is a coplanar quadrilateral.
 //
-// See also: graphene_matrix_transform_point()
+// See also: [Matrix.TransformPoint]
 func (x *Matrix) TransformRect(RVar *Rect, ResVar *Quad) {
 
 	xMatrixTransformRect(x.GoPointer(), RVar, ResVar)
@@ -710,7 +711,7 @@ func (x *Matrix) TransformRect(RVar *Rect, ResVar *Quad) {
 
 var xMatrixTransformSphere func(uintptr, *Sphere, *Sphere)
 
-// Transforms a #graphene_sphere_t using the given matrix @m. The
+// Transforms a #graphene_sphere_t using the given matrix m. The
 // result is the bounding sphere containing the transformed sphere.
 func (x *Matrix) TransformSphere(SVar *Sphere, ResVar *Sphere) {
 
@@ -720,10 +721,10 @@ func (x *Matrix) TransformSphere(SVar *Sphere, ResVar *Sphere) {
 
 var xMatrixTransformVec3 func(uintptr, *Vec3, *Vec3)
 
-// Transforms the given #graphene_vec3_t using the matrix @m.
+// Transforms the given #graphene_vec3_t using the matrix m.
 //
-// This function will multiply the X, Y, and Z row vectors of the matrix @m
-// with the corresponding components of the vector @v. The W row vector will
+// This function will multiply the X, Y, and Z row vectors of the matrix m
+// with the corresponding components of the vector v. The W row vector will
 // be ignored.
 //
 // See also: graphene_simd4x4f_vec3_mul()
@@ -735,7 +736,7 @@ func (x *Matrix) TransformVec3(VVar *Vec3, ResVar *Vec3) {
 
 var xMatrixTransformVec4 func(uintptr, *Vec4, *Vec4)
 
-// Transforms the given #graphene_vec4_t using the matrix @m.
+// Transforms the given #graphene_vec4_t using the matrix m.
 //
 // See also: graphene_simd4x4f_vec4_mul()
 func (x *Matrix) TransformVec4(VVar *Vec4, ResVar *Vec4) {
@@ -746,11 +747,11 @@ func (x *Matrix) TransformVec4(VVar *Vec4, ResVar *Vec4) {
 
 var xMatrixTranslate func(uintptr, *Point3D)
 
-// Adds a translation transformation to @m using the coordinates
+// Adds a translation transformation to m using the coordinates
 // of the given #graphene_point3d_t.
 //
-// This is the equivalent of calling graphene_matrix_init_translate() and
-// then multiplying @m with the translation matrix.
+// This is the equivalent of calling [Matrix.InitTranslate] and
+// then multiplying m with the translation matrix.
 func (x *Matrix) Translate(PosVar *Point3D) {
 
 	xMatrixTranslate(x.GoPointer(), PosVar)
@@ -768,8 +769,8 @@ func (x *Matrix) Transpose(ResVar *Matrix) {
 
 var xMatrixUnprojectPoint3d func(uintptr, *Matrix, *Point3D, *Point3D)
 
-// Unprojects the given @point using the @projection matrix and
-// a @modelview matrix.
+// Unprojects the given point using the projection matrix and
+// a modelview matrix.
 func (x *Matrix) UnprojectPoint3d(ModelviewVar *Matrix, PointVar *Point3D, ResVar *Point3D) {
 
 	xMatrixUnprojectPoint3d(x.GoPointer(), ModelviewVar, PointVar, ResVar)
@@ -779,7 +780,7 @@ func (x *Matrix) UnprojectPoint3d(ModelviewVar *Matrix, PointVar *Point3D, ResVa
 var xMatrixUntransformBounds func(uintptr, *Rect, *Rect, *Rect)
 
 // Undoes the transformation on the corners of a #graphene_rect_t using the
-// given matrix, within the given axis aligned rectangular @bounds.
+// given matrix, within the given axis aligned rectangular bounds.
 func (x *Matrix) UntransformBounds(RVar *Rect, BoundsVar *Rect, ResVar *Rect) {
 
 	xMatrixUntransformBounds(x.GoPointer(), RVar, BoundsVar, ResVar)
@@ -789,7 +790,7 @@ func (x *Matrix) UntransformBounds(RVar *Rect, BoundsVar *Rect, ResVar *Rect) {
 var xMatrixUntransformPoint func(uintptr, *Point, *Rect, *Point) bool
 
 // Undoes the transformation of a #graphene_point_t using the
-// given matrix, within the given axis aligned rectangular @bounds.
+// given matrix, within the given axis aligned rectangular bounds.
 func (x *Matrix) UntransformPoint(PVar *Point, BoundsVar *Rect, ResVar *Point) bool {
 
 	cret := xMatrixUntransformPoint(x.GoPointer(), PVar, BoundsVar, ResVar)
@@ -801,7 +802,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -810,73 +811,73 @@ func init() {
 
 	core.PuregoSafeRegister(&xMatrixGLibType, libs, "graphene_matrix_get_type")
 
-	core.PuregoSafeRegister(&xMatrixAlloc, libs, "graphene_matrix_alloc")
-
-	core.PuregoSafeRegister(&xMatrixDecompose, libs, "graphene_matrix_decompose")
-	core.PuregoSafeRegister(&xMatrixDeterminant, libs, "graphene_matrix_determinant")
-	core.PuregoSafeRegister(&xMatrixEqual, libs, "graphene_matrix_equal")
-	core.PuregoSafeRegister(&xMatrixEqualFast, libs, "graphene_matrix_equal_fast")
-	core.PuregoSafeRegister(&xMatrixFree, libs, "graphene_matrix_free")
-	core.PuregoSafeRegister(&xMatrixGetRow, libs, "graphene_matrix_get_row")
-	core.PuregoSafeRegister(&xMatrixGetValue, libs, "graphene_matrix_get_value")
-	core.PuregoSafeRegister(&xMatrixGetXScale, libs, "graphene_matrix_get_x_scale")
-	core.PuregoSafeRegister(&xMatrixGetXTranslation, libs, "graphene_matrix_get_x_translation")
-	core.PuregoSafeRegister(&xMatrixGetYScale, libs, "graphene_matrix_get_y_scale")
-	core.PuregoSafeRegister(&xMatrixGetYTranslation, libs, "graphene_matrix_get_y_translation")
-	core.PuregoSafeRegister(&xMatrixGetZScale, libs, "graphene_matrix_get_z_scale")
-	core.PuregoSafeRegister(&xMatrixGetZTranslation, libs, "graphene_matrix_get_z_translation")
-	core.PuregoSafeRegister(&xMatrixInitFrom2d, libs, "graphene_matrix_init_from_2d")
-	core.PuregoSafeRegister(&xMatrixInitFromFloat, libs, "graphene_matrix_init_from_float")
-	core.PuregoSafeRegister(&xMatrixInitFromMatrix, libs, "graphene_matrix_init_from_matrix")
-	core.PuregoSafeRegister(&xMatrixInitFromVec4, libs, "graphene_matrix_init_from_vec4")
-	core.PuregoSafeRegister(&xMatrixInitFrustum, libs, "graphene_matrix_init_frustum")
-	core.PuregoSafeRegister(&xMatrixInitIdentity, libs, "graphene_matrix_init_identity")
-	core.PuregoSafeRegister(&xMatrixInitLookAt, libs, "graphene_matrix_init_look_at")
-	core.PuregoSafeRegister(&xMatrixInitOrtho, libs, "graphene_matrix_init_ortho")
-	core.PuregoSafeRegister(&xMatrixInitPerspective, libs, "graphene_matrix_init_perspective")
-	core.PuregoSafeRegister(&xMatrixInitRotate, libs, "graphene_matrix_init_rotate")
-	core.PuregoSafeRegister(&xMatrixInitScale, libs, "graphene_matrix_init_scale")
-	core.PuregoSafeRegister(&xMatrixInitSkew, libs, "graphene_matrix_init_skew")
-	core.PuregoSafeRegister(&xMatrixInitTranslate, libs, "graphene_matrix_init_translate")
-	core.PuregoSafeRegister(&xMatrixInterpolate, libs, "graphene_matrix_interpolate")
-	core.PuregoSafeRegister(&xMatrixInverse, libs, "graphene_matrix_inverse")
-	core.PuregoSafeRegister(&xMatrixIs2d, libs, "graphene_matrix_is_2d")
-	core.PuregoSafeRegister(&xMatrixIsBackfaceVisible, libs, "graphene_matrix_is_backface_visible")
-	core.PuregoSafeRegister(&xMatrixIsIdentity, libs, "graphene_matrix_is_identity")
-	core.PuregoSafeRegister(&xMatrixIsSingular, libs, "graphene_matrix_is_singular")
-	core.PuregoSafeRegister(&xMatrixMultiply, libs, "graphene_matrix_multiply")
-	core.PuregoSafeRegister(&xMatrixNear, libs, "graphene_matrix_near")
-	core.PuregoSafeRegister(&xMatrixNormalize, libs, "graphene_matrix_normalize")
-	core.PuregoSafeRegister(&xMatrixPerspective, libs, "graphene_matrix_perspective")
-	core.PuregoSafeRegister(&xMatrixPrint, libs, "graphene_matrix_print")
-	core.PuregoSafeRegister(&xMatrixProjectPoint, libs, "graphene_matrix_project_point")
-	core.PuregoSafeRegister(&xMatrixProjectRect, libs, "graphene_matrix_project_rect")
-	core.PuregoSafeRegister(&xMatrixProjectRectBounds, libs, "graphene_matrix_project_rect_bounds")
-	core.PuregoSafeRegister(&xMatrixRotate, libs, "graphene_matrix_rotate")
-	core.PuregoSafeRegister(&xMatrixRotateEuler, libs, "graphene_matrix_rotate_euler")
-	core.PuregoSafeRegister(&xMatrixRotateQuaternion, libs, "graphene_matrix_rotate_quaternion")
-	core.PuregoSafeRegister(&xMatrixRotateX, libs, "graphene_matrix_rotate_x")
-	core.PuregoSafeRegister(&xMatrixRotateY, libs, "graphene_matrix_rotate_y")
-	core.PuregoSafeRegister(&xMatrixRotateZ, libs, "graphene_matrix_rotate_z")
-	core.PuregoSafeRegister(&xMatrixScale, libs, "graphene_matrix_scale")
-	core.PuregoSafeRegister(&xMatrixSkewXy, libs, "graphene_matrix_skew_xy")
-	core.PuregoSafeRegister(&xMatrixSkewXz, libs, "graphene_matrix_skew_xz")
-	core.PuregoSafeRegister(&xMatrixSkewYz, libs, "graphene_matrix_skew_yz")
-	core.PuregoSafeRegister(&xMatrixTo2d, libs, "graphene_matrix_to_2d")
-	core.PuregoSafeRegister(&xMatrixToFloat, libs, "graphene_matrix_to_float")
-	core.PuregoSafeRegister(&xMatrixTransformBounds, libs, "graphene_matrix_transform_bounds")
-	core.PuregoSafeRegister(&xMatrixTransformBox, libs, "graphene_matrix_transform_box")
-	core.PuregoSafeRegister(&xMatrixTransformPoint, libs, "graphene_matrix_transform_point")
-	core.PuregoSafeRegister(&xMatrixTransformPoint3d, libs, "graphene_matrix_transform_point3d")
-	core.PuregoSafeRegister(&xMatrixTransformRay, libs, "graphene_matrix_transform_ray")
-	core.PuregoSafeRegister(&xMatrixTransformRect, libs, "graphene_matrix_transform_rect")
-	core.PuregoSafeRegister(&xMatrixTransformSphere, libs, "graphene_matrix_transform_sphere")
-	core.PuregoSafeRegister(&xMatrixTransformVec3, libs, "graphene_matrix_transform_vec3")
-	core.PuregoSafeRegister(&xMatrixTransformVec4, libs, "graphene_matrix_transform_vec4")
-	core.PuregoSafeRegister(&xMatrixTranslate, libs, "graphene_matrix_translate")
-	core.PuregoSafeRegister(&xMatrixTranspose, libs, "graphene_matrix_transpose")
-	core.PuregoSafeRegister(&xMatrixUnprojectPoint3d, libs, "graphene_matrix_unproject_point3d")
-	core.PuregoSafeRegister(&xMatrixUntransformBounds, libs, "graphene_matrix_untransform_bounds")
-	core.PuregoSafeRegister(&xMatrixUntransformPoint, libs, "graphene_matrix_untransform_point")
+	core.PuregoRegisterSince(&xMatrixAlloc, libs, "graphene_matrix_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xMatrixDecompose, libs, "graphene_matrix_decompose", "GRAPHENE", "")
+	core.PuregoRegisterSince(&xMatrixDeterminant, libs, "graphene_matrix_determinant", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixEqual, libs, "graphene_matrix_equal", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixEqualFast, libs, "graphene_matrix_equal_fast", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixFree, libs, "graphene_matrix_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetRow, libs, "graphene_matrix_get_row", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetValue, libs, "graphene_matrix_get_value", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetXScale, libs, "graphene_matrix_get_x_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetXTranslation, libs, "graphene_matrix_get_x_translation", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixGetYScale, libs, "graphene_matrix_get_y_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetYTranslation, libs, "graphene_matrix_get_y_translation", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixGetZScale, libs, "graphene_matrix_get_z_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixGetZTranslation, libs, "graphene_matrix_get_z_translation", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixInitFrom2d, libs, "graphene_matrix_init_from_2d", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitFromFloat, libs, "graphene_matrix_init_from_float", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitFromMatrix, libs, "graphene_matrix_init_from_matrix", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitFromVec4, libs, "graphene_matrix_init_from_vec4", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitFrustum, libs, "graphene_matrix_init_frustum", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixInitIdentity, libs, "graphene_matrix_init_identity", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitLookAt, libs, "graphene_matrix_init_look_at", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitOrtho, libs, "graphene_matrix_init_ortho", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitPerspective, libs, "graphene_matrix_init_perspective", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitRotate, libs, "graphene_matrix_init_rotate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitScale, libs, "graphene_matrix_init_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitSkew, libs, "graphene_matrix_init_skew", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInitTranslate, libs, "graphene_matrix_init_translate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInterpolate, libs, "graphene_matrix_interpolate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixInverse, libs, "graphene_matrix_inverse", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixIs2d, libs, "graphene_matrix_is_2d", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixIsBackfaceVisible, libs, "graphene_matrix_is_backface_visible", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixIsIdentity, libs, "graphene_matrix_is_identity", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixIsSingular, libs, "graphene_matrix_is_singular", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixMultiply, libs, "graphene_matrix_multiply", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixNear, libs, "graphene_matrix_near", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xMatrixNormalize, libs, "graphene_matrix_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixPerspective, libs, "graphene_matrix_perspective", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixPrint, libs, "graphene_matrix_print", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixProjectPoint, libs, "graphene_matrix_project_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixProjectRect, libs, "graphene_matrix_project_rect", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixProjectRectBounds, libs, "graphene_matrix_project_rect_bounds", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixRotate, libs, "graphene_matrix_rotate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixRotateEuler, libs, "graphene_matrix_rotate_euler", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixRotateQuaternion, libs, "graphene_matrix_rotate_quaternion", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixRotateX, libs, "graphene_matrix_rotate_x", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixRotateY, libs, "graphene_matrix_rotate_y", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixRotateZ, libs, "graphene_matrix_rotate_z", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixScale, libs, "graphene_matrix_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixSkewXy, libs, "graphene_matrix_skew_xy", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixSkewXz, libs, "graphene_matrix_skew_xz", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixSkewYz, libs, "graphene_matrix_skew_yz", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTo2d, libs, "graphene_matrix_to_2d", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixToFloat, libs, "graphene_matrix_to_float", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTransformBounds, libs, "graphene_matrix_transform_bounds", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTransformBox, libs, "graphene_matrix_transform_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixTransformPoint, libs, "graphene_matrix_transform_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTransformPoint3d, libs, "graphene_matrix_transform_point3d", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixTransformRay, libs, "graphene_matrix_transform_ray", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xMatrixTransformRect, libs, "graphene_matrix_transform_rect", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTransformSphere, libs, "graphene_matrix_transform_sphere", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixTransformVec3, libs, "graphene_matrix_transform_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTransformVec4, libs, "graphene_matrix_transform_vec4", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTranslate, libs, "graphene_matrix_translate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixTranspose, libs, "graphene_matrix_transpose", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixUnprojectPoint3d, libs, "graphene_matrix_unproject_point3d", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xMatrixUntransformBounds, libs, "graphene_matrix_untransform_bounds", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xMatrixUntransformPoint, libs, "graphene_matrix_untransform_point", "GRAPHENE", "1.0")
 
 }
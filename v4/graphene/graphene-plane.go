@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type Plane struct {
 	_ structs.HostLayout
 
-	Normal uintptr
+	Normal Vec3
 
 	Constant float32
 }
@@ -32,6 +32,16 @@ func (x *Plane) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Plane made with g_boxed_copy, release it with Free.
+func (x *Plane) Copy() *Plane {
+	cret := gobject.BoxedCopy(PlaneGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Plane)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xPlaneAlloc func() *Plane
 
 // Allocates a new #graphene_plane_t structure.
@@ -45,7 +55,7 @@ func PlaneAlloc() *Plane {
 
 var xPlaneDistance func(uintptr, *Point3D) float32
 
-// Computes the distance of @point from a #graphene_plane_t.
+// Computes the distance of point from a #graphene_plane_t.
 func (x *Plane) Distance(PointVar *Point3D) float32 {
 
 	cret := xPlaneDistance(x.GoPointer(), PointVar)
@@ -63,7 +73,7 @@ func (x *Plane) Equal(BVar *Plane) bool {
 
 var xPlaneFree func(uintptr)
 
-// Frees the resources allocated by graphene_plane_alloc().
+// Frees the resources allocated by [PlaneAlloc].
 func (x *Plane) Free() {
 
 	xPlaneFree(x.GoPointer())
@@ -92,8 +102,8 @@ func (x *Plane) GetNormal(NormalVar *Vec3) {
 
 var xPlaneInit func(uintptr, *Vec3, float32) *Plane
 
-// Initializes the given #graphene_plane_t using the given @normal vector
-// and @constant values.
+// Initializes the given #graphene_plane_t using the given normal vector
+// and constant values.
 func (x *Plane) Init(NormalVar *Vec3, ConstantVar float32) *Plane {
 
 	cret := xPlaneInit(x.GoPointer(), NormalVar, ConstantVar)
@@ -165,12 +175,12 @@ func (x *Plane) Normalize(ResVar *Plane) {
 
 var xPlaneTransform func(uintptr, *Matrix, *Matrix, *Plane)
 
-// Transforms a #graphene_plane_t @p using the given @matrix
-// and @normal_matrix.
+// Transforms a #graphene_plane_t p using the given matrix
+// and normal_matrix.
 //
-// If @normal_matrix is %NULL, a transformation matrix for the plane
-// normal will be computed from @matrix. If you are transforming
-// multiple planes using the same @matrix it's recommended to compute
+// If normal_matrix is nil, a transformation matrix for the plane
+// normal will be computed from matrix. If you are transforming
+// multiple planes using the same matrix it's recommended to compute
 // the normal matrix beforehand to avoid incurring in the cost of
 // recomputing it every time.
 func (x *Plane) Transform(MatrixVar *Matrix, NormalMatrixVar *Matrix, ResVar *Plane) {
@@ -184,7 +194,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -193,20 +203,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xPlaneGLibType, libs, "graphene_plane_get_type")
 
-	core.PuregoSafeRegister(&xPlaneAlloc, libs, "graphene_plane_alloc")
-
-	core.PuregoSafeRegister(&xPlaneDistance, libs, "graphene_plane_distance")
-	core.PuregoSafeRegister(&xPlaneEqual, libs, "graphene_plane_equal")
-	core.PuregoSafeRegister(&xPlaneFree, libs, "graphene_plane_free")
-	core.PuregoSafeRegister(&xPlaneGetConstant, libs, "graphene_plane_get_constant")
-	core.PuregoSafeRegister(&xPlaneGetNormal, libs, "graphene_plane_get_normal")
-	core.PuregoSafeRegister(&xPlaneInit, libs, "graphene_plane_init")
-	core.PuregoSafeRegister(&xPlaneInitFromPlane, libs, "graphene_plane_init_from_plane")
-	core.PuregoSafeRegister(&xPlaneInitFromPoint, libs, "graphene_plane_init_from_point")
-	core.PuregoSafeRegister(&xPlaneInitFromPoints, libs, "graphene_plane_init_from_points")
-	core.PuregoSafeRegister(&xPlaneInitFromVec4, libs, "graphene_plane_init_from_vec4")
-	core.PuregoSafeRegister(&xPlaneNegate, libs, "graphene_plane_negate")
-	core.PuregoSafeRegister(&xPlaneNormalize, libs, "graphene_plane_normalize")
-	core.PuregoSafeRegister(&xPlaneTransform, libs, "graphene_plane_transform")
+	core.PuregoRegisterSince(&xPlaneAlloc, libs, "graphene_plane_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xPlaneDistance, libs, "graphene_plane_distance", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneEqual, libs, "graphene_plane_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneFree, libs, "graphene_plane_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneGetConstant, libs, "graphene_plane_get_constant", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneGetNormal, libs, "graphene_plane_get_normal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneInit, libs, "graphene_plane_init", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneInitFromPlane, libs, "graphene_plane_init_from_plane", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneInitFromPoint, libs, "graphene_plane_init_from_point", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneInitFromPoints, libs, "graphene_plane_init_from_points", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneInitFromVec4, libs, "graphene_plane_init_from_vec4", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneNegate, libs, "graphene_plane_negate", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneNormalize, libs, "graphene_plane_normalize", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xPlaneTransform, libs, "graphene_plane_transform", "GRAPHENE", "1.10")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -29,16 +29,24 @@ func (x *Point) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Point made with g_boxed_copy, release it with Free.
+func (x *Point) Copy() *Point {
+	cret := gobject.BoxedCopy(PointGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Point)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xPointAlloc func() *Point
 
 // Allocates a new #graphene_point_t structure.
 //
 // The coordinates of the returned point are (0, 0).
 //
-// It's possible to chain this function with graphene_point_init()
-// or graphene_point_init_from_point(), e.g.:
-//
-// |[&lt;!-- language="C" --&gt;
+// It's possible to chain this function with [Point.Init]
+// or [Point.InitFromPoint], e.g.:
 //
 //	graphene_point_t *
 //	point_new (float x, float y)
@@ -51,8 +59,6 @@ var xPointAlloc func() *Point
 //	{
 //	  return graphene_point_init_from_point (graphene_point_alloc (), p);
 //	}
-//
-// ]|
 func PointAlloc() *Point {
 
 	cret := xPointAlloc()
@@ -61,7 +67,7 @@ func PointAlloc() *Point {
 
 var xPointDistance func(uintptr, *Point, *float32, *float32) float32
 
-// Computes the distance between @a and @b.
+// Computes the distance between a and b.
 func (x *Point) Distance(BVar *Point, DXVar *float32, DYVar *float32) float32 {
 
 	cret := xPointDistance(x.GoPointer(), BVar, DXVar, DYVar)
@@ -70,12 +76,12 @@ func (x *Point) Distance(BVar *Point, DXVar *float32, DYVar *float32) float32 {
 
 var xPointEqual func(uintptr, *Point) bool
 
-// Checks if the two points @a and @b point to the same
+// Checks if the two points a and b point to the same
 // coordinates.
 //
 // This function accounts for floating point fluctuations; if
 // you want to control the fuzziness of the match, you can use
-// graphene_point_near() instead.
+// [Point.Near] instead.
 func (x *Point) Equal(BVar *Point) bool {
 
 	cret := xPointEqual(x.GoPointer(), BVar)
@@ -84,7 +90,7 @@ func (x *Point) Equal(BVar *Point) bool {
 
 var xPointFree func(uintptr)
 
-// Frees the resources allocated by graphene_point_alloc().
+// Frees the resources allocated by [PointAlloc].
 func (x *Point) Free() {
 
 	xPointFree(x.GoPointer())
@@ -93,7 +99,7 @@ func (x *Point) Free() {
 
 var xPointInit func(uintptr, float32, float32) *Point
 
-// Initializes @p to the given @x and @y coordinates.
+// Initializes p to the given x and y coordinates.
 //
 // It's safe to call this function multiple times.
 func (x *Point) Init(XVar float32, YVar float32) *Point {
@@ -104,7 +110,7 @@ func (x *Point) Init(XVar float32, YVar float32) *Point {
 
 var xPointInitFromPoint func(uintptr, *Point) *Point
 
-// Initializes @p with the same coordinates of @src.
+// Initializes p with the same coordinates of src.
 func (x *Point) InitFromPoint(SrcVar *Point) *Point {
 
 	cret := xPointInitFromPoint(x.GoPointer(), SrcVar)
@@ -113,7 +119,7 @@ func (x *Point) InitFromPoint(SrcVar *Point) *Point {
 
 var xPointInitFromVec2 func(uintptr, *Vec2) *Point
 
-// Initializes @p with the coordinates inside the given #graphene_vec2_t.
+// Initializes p with the coordinates inside the given #graphene_vec2_t.
 func (x *Point) InitFromVec2(SrcVar *Vec2) *Point {
 
 	cret := xPointInitFromVec2(x.GoPointer(), SrcVar)
@@ -122,8 +128,8 @@ func (x *Point) InitFromVec2(SrcVar *Vec2) *Point {
 
 var xPointInterpolate func(uintptr, *Point, float64, *Point)
 
-// Linearly interpolates the coordinates of @a and @b using the
-// given @factor.
+// Linearly interpolates the coordinates of a and b using the
+// given factor.
 func (x *Point) Interpolate(BVar *Point, FactorVar float64, ResVar *Point) {
 
 	xPointInterpolate(x.GoPointer(), BVar, FactorVar, ResVar)
@@ -132,8 +138,8 @@ func (x *Point) Interpolate(BVar *Point, FactorVar float64, ResVar *Point) {
 
 var xPointNear func(uintptr, *Point, float32) bool
 
-// Checks whether the two points @a and @b are within
-// the threshold of @epsilon.
+// Checks whether the two points a and b are within
+// the threshold of epsilon.
 func (x *Point) Near(BVar *Point, EpsilonVar float32) bool {
 
 	cret := xPointNear(x.GoPointer(), BVar, EpsilonVar)
@@ -164,27 +170,27 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPointZero, libs, "graphene_point_zero")
+	core.PuregoRegisterSince(&xPointZero, libs, "graphene_point_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xPointGLibType, libs, "graphene_point_get_type")
 
-	core.PuregoSafeRegister(&xPointAlloc, libs, "graphene_point_alloc")
-
-	core.PuregoSafeRegister(&xPointDistance, libs, "graphene_point_distance")
-	core.PuregoSafeRegister(&xPointEqual, libs, "graphene_point_equal")
-	core.PuregoSafeRegister(&xPointFree, libs, "graphene_point_free")
-	core.PuregoSafeRegister(&xPointInit, libs, "graphene_point_init")
-	core.PuregoSafeRegister(&xPointInitFromPoint, libs, "graphene_point_init_from_point")
-	core.PuregoSafeRegister(&xPointInitFromVec2, libs, "graphene_point_init_from_vec2")
-	core.PuregoSafeRegister(&xPointInterpolate, libs, "graphene_point_interpolate")
-	core.PuregoSafeRegister(&xPointNear, libs, "graphene_point_near")
-	core.PuregoSafeRegister(&xPointToVec2, libs, "graphene_point_to_vec2")
+	core.PuregoRegisterSince(&xPointAlloc, libs, "graphene_point_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xPointDistance, libs, "graphene_point_distance", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointEqual, libs, "graphene_point_equal", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointFree, libs, "graphene_point_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointInit, libs, "graphene_point_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointInitFromPoint, libs, "graphene_point_init_from_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointInitFromVec2, libs, "graphene_point_init_from_vec2", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xPointInterpolate, libs, "graphene_point_interpolate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointNear, libs, "graphene_point_near", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPointToVec2, libs, "graphene_point_to_vec2", "GRAPHENE", "1.4")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -31,6 +31,16 @@ func (x *Point3D) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Point3D made with g_boxed_copy, release it with Free.
+func (x *Point3D) Copy() *Point3D {
+	cret := gobject.BoxedCopy(Point3DGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Point3D)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xPoint3DAlloc func() *Point3D
 
 // Allocates a #graphene_point3d_t structure.
@@ -78,7 +88,7 @@ func (x *Point3D) Equal(BVar *Point3D) bool {
 
 var xPoint3DFree func(uintptr)
 
-// Frees the resources allocated via graphene_point3d_alloc().
+// Frees the resources allocated via [Point3DAlloc].
 func (x *Point3D) Free() {
 
 	xPoint3DFree(x.GoPointer())
@@ -116,8 +126,8 @@ func (x *Point3D) InitFromVec3(VVar *Vec3) *Point3D {
 
 var xPoint3DInterpolate func(uintptr, *Point3D, float64, *Point3D)
 
-// Linearly interpolates each component of @a and @b using the
-// provided @factor, and places the result in @res.
+// Linearly interpolates each component of a and b using the
+// provided factor, and places the result in res.
 func (x *Point3D) Interpolate(BVar *Point3D, FactorVar float64, ResVar *Point3D) {
 
 	xPoint3DInterpolate(x.GoPointer(), BVar, FactorVar, ResVar)
@@ -137,7 +147,7 @@ func (x *Point3D) Length() float32 {
 var xPoint3DNear func(uintptr, *Point3D, float32) bool
 
 // Checks whether the two points are near each other, within
-// an @epsilon factor.
+// an epsilon factor.
 func (x *Point3D) Near(BVar *Point3D, EpsilonVar float32) bool {
 
 	cret := xPoint3DNear(x.GoPointer(), BVar, EpsilonVar)
@@ -170,7 +180,7 @@ func (x *Point3D) NormalizeViewport(ViewportVar *Rect, ZNearVar float32, ZFarVar
 var xPoint3DScale func(uintptr, float32, *Point3D)
 
 // Scales the coordinates of the given #graphene_point3d_t by
-// the given @factor.
+// the given factor.
 func (x *Point3D) Scale(FactorVar float32, ResVar *Point3D) {
 
 	xPoint3DScale(x.GoPointer(), FactorVar, ResVar)
@@ -187,47 +197,54 @@ func (x *Point3D) ToVec3(VVar *Vec3) {
 
 }
 
-var xPoint3dZero func() *Point3D
+var xPoint3DZero func() *Point3D
 
 // Retrieves a constant point with all three coordinates set to 0.
-func Point3dZero() *Point3D {
+func Point3DZero() *Point3D {
 
-	cret := xPoint3dZero()
+	cret := xPoint3DZero()
 	return cret
 }
 
+// Retrieves a constant point with all three coordinates set to 0.
+//
+// Deprecated: use Point3DZero, this name of graphene_point3d_zero is kept for compatibility.
+func Point3dZero() *Point3D {
+	return Point3DZero()
+}
+
 func init() {
 	core.SetPackageName("GRAPHENE", "graphene-gobject-1.0")
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPoint3dZero, libs, "graphene_point3d_zero")
+	core.PuregoRegisterSince(&xPoint3DZero, libs, "graphene_point3d_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xPoint3DGLibType, libs, "graphene_point3d_get_type")
 
-	core.PuregoSafeRegister(&xPoint3DAlloc, libs, "graphene_point3d_alloc")
-
-	core.PuregoSafeRegister(&xPoint3DCross, libs, "graphene_point3d_cross")
-	core.PuregoSafeRegister(&xPoint3DDistance, libs, "graphene_point3d_distance")
-	core.PuregoSafeRegister(&xPoint3DDot, libs, "graphene_point3d_dot")
-	core.PuregoSafeRegister(&xPoint3DEqual, libs, "graphene_point3d_equal")
-	core.PuregoSafeRegister(&xPoint3DFree, libs, "graphene_point3d_free")
-	core.PuregoSafeRegister(&xPoint3DInit, libs, "graphene_point3d_init")
-	core.PuregoSafeRegister(&xPoint3DInitFromPoint, libs, "graphene_point3d_init_from_point")
-	core.PuregoSafeRegister(&xPoint3DInitFromVec3, libs, "graphene_point3d_init_from_vec3")
-	core.PuregoSafeRegister(&xPoint3DInterpolate, libs, "graphene_point3d_interpolate")
-	core.PuregoSafeRegister(&xPoint3DLength, libs, "graphene_point3d_length")
-	core.PuregoSafeRegister(&xPoint3DNear, libs, "graphene_point3d_near")
-	core.PuregoSafeRegister(&xPoint3DNormalize, libs, "graphene_point3d_normalize")
-	core.PuregoSafeRegister(&xPoint3DNormalizeViewport, libs, "graphene_point3d_normalize_viewport")
-	core.PuregoSafeRegister(&xPoint3DScale, libs, "graphene_point3d_scale")
-	core.PuregoSafeRegister(&xPoint3DToVec3, libs, "graphene_point3d_to_vec3")
+	core.PuregoRegisterSince(&xPoint3DAlloc, libs, "graphene_point3d_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xPoint3DCross, libs, "graphene_point3d_cross", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DDistance, libs, "graphene_point3d_distance", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xPoint3DDot, libs, "graphene_point3d_dot", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DEqual, libs, "graphene_point3d_equal", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DFree, libs, "graphene_point3d_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DInit, libs, "graphene_point3d_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DInitFromPoint, libs, "graphene_point3d_init_from_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DInitFromVec3, libs, "graphene_point3d_init_from_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DInterpolate, libs, "graphene_point3d_interpolate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DLength, libs, "graphene_point3d_length", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DNear, libs, "graphene_point3d_near", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DNormalize, libs, "graphene_point3d_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DNormalizeViewport, libs, "graphene_point3d_normalize_viewport", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xPoint3DScale, libs, "graphene_point3d_scale", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xPoint3DToVec3, libs, "graphene_point3d_to_vec3", "GRAPHENE", "1.0")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -30,6 +30,16 @@ func (x *Quad) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Quad made with g_boxed_copy, release it with Free.
+func (x *Quad) Copy() *Quad {
+	cret := gobject.BoxedCopy(QuadGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Quad)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xQuadAlloc func() *Quad
 
 // Allocates a new #graphene_quad_t instance.
@@ -43,7 +53,7 @@ func QuadAlloc() *Quad {
 
 var xQuadBounds func(uintptr, *Rect)
 
-// Computes the bounding rectangle of @q and places it into @r.
+// Computes the bounding rectangle of q and places it into r.
 func (x *Quad) Bounds(RVar *Rect) {
 
 	xQuadBounds(x.GoPointer(), RVar)
@@ -61,7 +71,7 @@ func (x *Quad) Contains(PVar *Point) bool {
 
 var xQuadFree func(uintptr)
 
-// Frees the resources allocated by graphene_quad_alloc()
+// Frees the resources allocated by [QuadAlloc]
 func (x *Quad) Free() {
 
 	xQuadFree(x.GoPointer())
@@ -110,7 +120,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -119,14 +129,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xQuadGLibType, libs, "graphene_quad_get_type")
 
-	core.PuregoSafeRegister(&xQuadAlloc, libs, "graphene_quad_alloc")
+	core.PuregoRegisterSince(&xQuadAlloc, libs, "graphene_quad_alloc", "GRAPHENE", "1.0")
 
-	core.PuregoSafeRegister(&xQuadBounds, libs, "graphene_quad_bounds")
-	core.PuregoSafeRegister(&xQuadContains, libs, "graphene_quad_contains")
-	core.PuregoSafeRegister(&xQuadFree, libs, "graphene_quad_free")
-	core.PuregoSafeRegister(&xQuadGetPoint, libs, "graphene_quad_get_point")
-	core.PuregoSafeRegister(&xQuadInit, libs, "graphene_quad_init")
-	core.PuregoSafeRegister(&xQuadInitFromPoints, libs, "graphene_quad_init_from_points")
-	core.PuregoSafeRegister(&xQuadInitFromRect, libs, "graphene_quad_init_from_rect")
+	core.PuregoRegisterSince(&xQuadBounds, libs, "graphene_quad_bounds", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuadContains, libs, "graphene_quad_contains", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuadFree, libs, "graphene_quad_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuadGetPoint, libs, "graphene_quad_get_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuadInit, libs, "graphene_quad_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuadInitFromPoints, libs, "graphene_quad_init_from_points", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xQuadInitFromRect, libs, "graphene_quad_init_from_rect", "GRAPHENE", "1.0")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -36,6 +36,16 @@ func (x *Quaternion) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Quaternion made with g_boxed_copy, release it with Free.
+func (x *Quaternion) Copy() *Quaternion {
+	cret := gobject.BoxedCopy(QuaternionGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Quaternion)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xQuaternionAlloc func() *Quaternion
 
 // Allocates a new #graphene_quaternion_t.
@@ -49,7 +59,7 @@ func QuaternionAlloc() *Quaternion {
 
 var xQuaternionAdd func(uintptr, *Quaternion, *Quaternion)
 
-// Adds two #graphene_quaternion_t @a and @b.
+// Adds two #graphene_quaternion_t a and b.
 func (x *Quaternion) Add(BVar *Quaternion, ResVar *Quaternion) {
 
 	xQuaternionAdd(x.GoPointer(), BVar, ResVar)
@@ -76,7 +86,7 @@ func (x *Quaternion) Equal(BVar *Quaternion) bool {
 
 var xQuaternionFree func(uintptr)
 
-// Releases the resources allocated by graphene_quaternion_alloc().
+// Releases the resources allocated by [QuaternionAlloc].
 func (x *Quaternion) Free() {
 
 	xQuaternionFree(x.GoPointer())
@@ -94,8 +104,8 @@ func (x *Quaternion) Init(XVar float32, YVar float32, ZVar float32, WVar float32
 
 var xQuaternionInitFromAngleVec3 func(uintptr, float32, *Vec3) *Quaternion
 
-// Initializes a #graphene_quaternion_t using an @angle on a
-// specific @axis.
+// Initializes a #graphene_quaternion_t using an angle on a
+// specific axis.
 func (x *Quaternion) InitFromAngleVec3(AngleVar float32, AxisVar *Vec3) *Quaternion {
 
 	cret := xQuaternionInitFromAngleVec3(x.GoPointer(), AngleVar, AxisVar)
@@ -105,10 +115,12 @@ func (x *Quaternion) InitFromAngleVec3(AngleVar float32, AxisVar *Vec3) *Quatern
 var xQuaternionInitFromAngles func(uintptr, float32, float32, float32) *Quaternion
 
 // Initializes a #graphene_quaternion_t using the values of
-// the [Euler angles](http://en.wikipedia.org/wiki/Euler_angles)
+// the [Euler angles]
 // on each axis.
 //
-// See also: graphene_quaternion_init_from_euler()
+// See also: [Quaternion.InitFromEuler]
+//
+// [Euler angles]: http://en.wikipedia.org/wiki/Euler_angles
 func (x *Quaternion) InitFromAngles(DegXVar float32, DegYVar float32, DegZVar float32) *Quaternion {
 
 	cret := xQuaternionInitFromAngles(x.GoPointer(), DegXVar, DegYVar, DegZVar)
@@ -136,7 +148,7 @@ func (x *Quaternion) InitFromMatrix(MVar *Matrix) *Quaternion {
 
 var xQuaternionInitFromQuaternion func(uintptr, *Quaternion) *Quaternion
 
-// Initializes a #graphene_quaternion_t with the values from @src.
+// Initializes a #graphene_quaternion_t with the values from src.
 func (x *Quaternion) InitFromQuaternion(SrcVar *Quaternion) *Quaternion {
 
 	cret := xQuaternionInitFromQuaternion(x.GoPointer(), SrcVar)
@@ -146,10 +158,12 @@ func (x *Quaternion) InitFromQuaternion(SrcVar *Quaternion) *Quaternion {
 var xQuaternionInitFromRadians func(uintptr, float32, float32, float32) *Quaternion
 
 // Initializes a #graphene_quaternion_t using the values of
-// the [Euler angles](http://en.wikipedia.org/wiki/Euler_angles)
+// the [Euler angles]
 // on each axis.
 //
-// See also: graphene_quaternion_init_from_euler()
+// See also: [Quaternion.InitFromEuler]
+//
+// [Euler angles]: http://en.wikipedia.org/wiki/Euler_angles
 func (x *Quaternion) InitFromRadians(RadXVar float32, RadYVar float32, RadZVar float32) *Quaternion {
 
 	cret := xQuaternionInitFromRadians(x.GoPointer(), RadXVar, RadYVar, RadZVar)
@@ -158,7 +172,7 @@ func (x *Quaternion) InitFromRadians(RadXVar float32, RadYVar float32, RadZVar f
 
 var xQuaternionInitFromVec4 func(uintptr, *Vec4) *Quaternion
 
-// Initializes a #graphene_quaternion_t with the values from @src.
+// Initializes a #graphene_quaternion_t with the values from src.
 func (x *Quaternion) InitFromVec4(SrcVar *Vec4) *Quaternion {
 
 	cret := xQuaternionInitFromVec4(x.GoPointer(), SrcVar)
@@ -178,7 +192,7 @@ func (x *Quaternion) InitIdentity() *Quaternion {
 var xQuaternionInvert func(uintptr, *Quaternion)
 
 // Inverts a #graphene_quaternion_t, and returns the conjugate
-// quaternion of @q.
+// quaternion of q.
 func (x *Quaternion) Invert(ResVar *Quaternion) {
 
 	xQuaternionInvert(x.GoPointer(), ResVar)
@@ -187,7 +201,7 @@ func (x *Quaternion) Invert(ResVar *Quaternion) {
 
 var xQuaternionMultiply func(uintptr, *Quaternion, *Quaternion)
 
-// Multiplies two #graphene_quaternion_t @a and @b.
+// Multiplies two #graphene_quaternion_t a and b.
 func (x *Quaternion) Multiply(BVar *Quaternion, ResVar *Quaternion) {
 
 	xQuaternionMultiply(x.GoPointer(), BVar, ResVar)
@@ -205,7 +219,7 @@ func (x *Quaternion) Normalize(ResVar *Quaternion) {
 
 var xQuaternionScale func(uintptr, float32, *Quaternion)
 
-// Scales all the elements of a #graphene_quaternion_t @q using
+// Scales all the elements of a #graphene_quaternion_t q using
 // the given scalar factor.
 func (x *Quaternion) Scale(FactorVar float32, ResVar *Quaternion) {
 
@@ -216,8 +230,10 @@ func (x *Quaternion) Scale(FactorVar float32, ResVar *Quaternion) {
 var xQuaternionSlerp func(uintptr, *Quaternion, float32, *Quaternion)
 
 // Interpolates between the two given quaternions using a spherical
-// linear interpolation, or [SLERP](http://en.wikipedia.org/wiki/Slerp),
-// using the given interpolation @factor.
+// linear interpolation, or [SLERP],
+// using the given interpolation factor.
+//
+// [SLERP]: http://en.wikipedia.org/wiki/Slerp
 func (x *Quaternion) Slerp(BVar *Quaternion, FactorVar float32, ResVar *Quaternion) {
 
 	xQuaternionSlerp(x.GoPointer(), BVar, FactorVar, ResVar)
@@ -226,7 +242,7 @@ func (x *Quaternion) Slerp(BVar *Quaternion, FactorVar float32, ResVar *Quaterni
 
 var xQuaternionToAngleVec3 func(uintptr, *float32, *Vec3)
 
-// Converts a quaternion into an @angle, @axis pair.
+// Converts a quaternion into an angle, axis pair.
 func (x *Quaternion) ToAngleVec3(AngleVar *float32, AxisVar *Vec3) {
 
 	xQuaternionToAngleVec3(x.GoPointer(), AngleVar, AxisVar)
@@ -236,8 +252,10 @@ func (x *Quaternion) ToAngleVec3(AngleVar *float32, AxisVar *Vec3) {
 var xQuaternionToAngles func(uintptr, *float32, *float32, *float32)
 
 // Converts a #graphene_quaternion_t to its corresponding rotations
-// on the [Euler angles](http://en.wikipedia.org/wiki/Euler_angles)
+// on the [Euler angles]
 // on each axis.
+//
+// [Euler angles]: http://en.wikipedia.org/wiki/Euler_angles
 func (x *Quaternion) ToAngles(DegXVar *float32, DegYVar *float32, DegZVar *float32) {
 
 	xQuaternionToAngles(x.GoPointer(), DegXVar, DegYVar, DegZVar)
@@ -257,8 +275,10 @@ func (x *Quaternion) ToMatrix(MVar *Matrix) {
 var xQuaternionToRadians func(uintptr, *float32, *float32, *float32)
 
 // Converts a #graphene_quaternion_t to its corresponding rotations
-// on the [Euler angles](http://en.wikipedia.org/wiki/Euler_angles)
+// on the [Euler angles]
 // on each axis.
+//
+// [Euler angles]: http://en.wikipedia.org/wiki/Euler_angles
 func (x *Quaternion) ToRadians(RadXVar *float32, RadYVar *float32, RadZVar *float32) {
 
 	xQuaternionToRadians(x.GoPointer(), RadXVar, RadYVar, RadZVar)
@@ -280,7 +300,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -289,30 +309,30 @@ func init() {
 
 	core.PuregoSafeRegister(&xQuaternionGLibType, libs, "graphene_quaternion_get_type")
 
-	core.PuregoSafeRegister(&xQuaternionAlloc, libs, "graphene_quaternion_alloc")
-
-	core.PuregoSafeRegister(&xQuaternionAdd, libs, "graphene_quaternion_add")
-	core.PuregoSafeRegister(&xQuaternionDot, libs, "graphene_quaternion_dot")
-	core.PuregoSafeRegister(&xQuaternionEqual, libs, "graphene_quaternion_equal")
-	core.PuregoSafeRegister(&xQuaternionFree, libs, "graphene_quaternion_free")
-	core.PuregoSafeRegister(&xQuaternionInit, libs, "graphene_quaternion_init")
-	core.PuregoSafeRegister(&xQuaternionInitFromAngleVec3, libs, "graphene_quaternion_init_from_angle_vec3")
-	core.PuregoSafeRegister(&xQuaternionInitFromAngles, libs, "graphene_quaternion_init_from_angles")
-	core.PuregoSafeRegister(&xQuaternionInitFromEuler, libs, "graphene_quaternion_init_from_euler")
-	core.PuregoSafeRegister(&xQuaternionInitFromMatrix, libs, "graphene_quaternion_init_from_matrix")
-	core.PuregoSafeRegister(&xQuaternionInitFromQuaternion, libs, "graphene_quaternion_init_from_quaternion")
-	core.PuregoSafeRegister(&xQuaternionInitFromRadians, libs, "graphene_quaternion_init_from_radians")
-	core.PuregoSafeRegister(&xQuaternionInitFromVec4, libs, "graphene_quaternion_init_from_vec4")
-	core.PuregoSafeRegister(&xQuaternionInitIdentity, libs, "graphene_quaternion_init_identity")
-	core.PuregoSafeRegister(&xQuaternionInvert, libs, "graphene_quaternion_invert")
-	core.PuregoSafeRegister(&xQuaternionMultiply, libs, "graphene_quaternion_multiply")
-	core.PuregoSafeRegister(&xQuaternionNormalize, libs, "graphene_quaternion_normalize")
-	core.PuregoSafeRegister(&xQuaternionScale, libs, "graphene_quaternion_scale")
-	core.PuregoSafeRegister(&xQuaternionSlerp, libs, "graphene_quaternion_slerp")
-	core.PuregoSafeRegister(&xQuaternionToAngleVec3, libs, "graphene_quaternion_to_angle_vec3")
-	core.PuregoSafeRegister(&xQuaternionToAngles, libs, "graphene_quaternion_to_angles")
-	core.PuregoSafeRegister(&xQuaternionToMatrix, libs, "graphene_quaternion_to_matrix")
-	core.PuregoSafeRegister(&xQuaternionToRadians, libs, "graphene_quaternion_to_radians")
-	core.PuregoSafeRegister(&xQuaternionToVec4, libs, "graphene_quaternion_to_vec4")
+	core.PuregoRegisterSince(&xQuaternionAlloc, libs, "graphene_quaternion_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xQuaternionAdd, libs, "graphene_quaternion_add", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xQuaternionDot, libs, "graphene_quaternion_dot", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionEqual, libs, "graphene_quaternion_equal", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionFree, libs, "graphene_quaternion_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInit, libs, "graphene_quaternion_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromAngleVec3, libs, "graphene_quaternion_init_from_angle_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromAngles, libs, "graphene_quaternion_init_from_angles", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromEuler, libs, "graphene_quaternion_init_from_euler", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xQuaternionInitFromMatrix, libs, "graphene_quaternion_init_from_matrix", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromQuaternion, libs, "graphene_quaternion_init_from_quaternion", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromRadians, libs, "graphene_quaternion_init_from_radians", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitFromVec4, libs, "graphene_quaternion_init_from_vec4", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInitIdentity, libs, "graphene_quaternion_init_identity", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionInvert, libs, "graphene_quaternion_invert", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionMultiply, libs, "graphene_quaternion_multiply", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xQuaternionNormalize, libs, "graphene_quaternion_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionScale, libs, "graphene_quaternion_scale", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xQuaternionSlerp, libs, "graphene_quaternion_slerp", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionToAngleVec3, libs, "graphene_quaternion_to_angle_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionToAngles, libs, "graphene_quaternion_to_angles", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xQuaternionToMatrix, libs, "graphene_quaternion_to_matrix", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xQuaternionToRadians, libs, "graphene_quaternion_to_radians", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xQuaternionToVec4, libs, "graphene_quaternion_to_vec4", "GRAPHENE", "1.0")
 
 }
@@ -2,11 +2,12 @@
 package graphene
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +18,7 @@ import (
 type Ray struct {
 	_ structs.HostLayout
 
-	Origin uintptr
+	Origin Vec3
 
 	Direction uintptr
 }
@@ -32,6 +33,16 @@ func (x *Ray) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Ray made with g_boxed_copy, release it with Free.
+func (x *Ray) Copy() *Ray {
+	cret := gobject.BoxedCopy(RayGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Ray)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xRayAlloc func() *Ray
 
 // Allocates a new #graphene_ray_t structure.
@@ -54,7 +65,7 @@ func (x *Ray) Equal(BVar *Ray) bool {
 
 var xRayFree func(uintptr)
 
-// Frees the resources allocated by graphene_ray_alloc().
+// Frees the resources allocated by [RayAlloc].
 func (x *Ray) Free() {
 
 	xRayFree(x.GoPointer())
@@ -64,7 +75,7 @@ func (x *Ray) Free() {
 var xRayGetClosestPointToPoint func(uintptr, *Point3D, *Point3D)
 
 // Computes the point on the given #graphene_ray_t that is closest to the
-// given point @p.
+// given point p.
 func (x *Ray) GetClosestPointToPoint(PVar *Point3D, ResVar *Point3D) {
 
 	xRayGetClosestPointToPoint(x.GoPointer(), PVar, ResVar)
@@ -95,7 +106,7 @@ func (x *Ray) GetDistanceToPlane(PVar *Plane) float32 {
 var xRayGetDistanceToPoint func(uintptr, *Point3D) float32
 
 // Computes the distance of the closest approach between the
-// given #graphene_ray_t @r and the point @p.
+// given #graphene_ray_t r and the point p.
 //
 // The closest approach to a ray from a point is the distance
 // between the point and the projection of the point on the
@@ -117,7 +128,7 @@ func (x *Ray) GetOrigin(OriginVar *Point3D) {
 
 var xRayGetPositionAt func(uintptr, float32, *Point3D)
 
-// Retrieves the coordinates of a point at the distance @t along the
+// Retrieves the coordinates of a point at the distance t along the
 // given #graphene_ray_t.
 func (x *Ray) GetPositionAt(TVar float32, PositionVar *Point3D) {
 
@@ -127,8 +138,8 @@ func (x *Ray) GetPositionAt(TVar float32, PositionVar *Point3D) {
 
 var xRayInit func(uintptr, *Point3D, *Vec3) *Ray
 
-// Initializes the given #graphene_ray_t using the given @origin
-// and @direction values.
+// Initializes the given #graphene_ray_t using the given origin
+// and direction values.
 func (x *Ray) Init(OriginVar *Point3D, DirectionVar *Vec3) *Ray {
 
 	cret := xRayInit(x.GoPointer(), OriginVar, DirectionVar)
@@ -156,8 +167,8 @@ func (x *Ray) InitFromVec3(OriginVar *Vec3, DirectionVar *Vec3) *Ray {
 
 var xRayIntersectBox func(uintptr, *Box, *float32) RayIntersectionKind
 
-// Intersects the given #graphene_ray_t @r with the given
-// #graphene_box_t @b.
+// Intersects the given #graphene_ray_t r with the given
+// #graphene_box_t b.
 func (x *Ray) IntersectBox(BVar *Box, TOutVar *float32) RayIntersectionKind {
 
 	cret := xRayIntersectBox(x.GoPointer(), BVar, TOutVar)
@@ -166,8 +177,8 @@ func (x *Ray) IntersectBox(BVar *Box, TOutVar *float32) RayIntersectionKind {
 
 var xRayIntersectSphere func(uintptr, *Sphere, *float32) RayIntersectionKind
 
-// Intersects the given #graphene_ray_t @r with the given
-// #graphene_sphere_t @s.
+// Intersects the given #graphene_ray_t r with the given
+// #graphene_sphere_t s.
 func (x *Ray) IntersectSphere(SVar *Sphere, TOutVar *float32) RayIntersectionKind {
 
 	cret := xRayIntersectSphere(x.GoPointer(), SVar, TOutVar)
@@ -176,8 +187,8 @@ func (x *Ray) IntersectSphere(SVar *Sphere, TOutVar *float32) RayIntersectionKin
 
 var xRayIntersectTriangle func(uintptr, *Triangle, *float32) RayIntersectionKind
 
-// Intersects the given #graphene_ray_t @r with the given
-// #graphene_triangle_t @t.
+// Intersects the given #graphene_ray_t r with the given
+// #graphene_triangle_t t.
 func (x *Ray) IntersectTriangle(TVar *Triangle, TOutVar *float32) RayIntersectionKind {
 
 	cret := xRayIntersectTriangle(x.GoPointer(), TVar, TOutVar)
@@ -186,10 +197,10 @@ func (x *Ray) IntersectTriangle(TVar *Triangle, TOutVar *float32) RayIntersectio
 
 var xRayIntersectsBox func(uintptr, *Box) bool
 
-// Checks whether the given #graphene_ray_t @r intersects the
-// given #graphene_box_t @b.
+// Checks whether the given #graphene_ray_t r intersects the
+// given #graphene_box_t b.
 //
-// See also: graphene_ray_intersect_box()
+// See also: [Ray.IntersectBox]
 func (x *Ray) IntersectsBox(BVar *Box) bool {
 
 	cret := xRayIntersectsBox(x.GoPointer(), BVar)
@@ -198,10 +209,10 @@ func (x *Ray) IntersectsBox(BVar *Box) bool {
 
 var xRayIntersectsSphere func(uintptr, *Sphere) bool
 
-// Checks if the given #graphene_ray_t @r intersects the
-// given #graphene_sphere_t @s.
+// Checks if the given #graphene_ray_t r intersects the
+// given #graphene_sphere_t s.
 //
-// See also: graphene_ray_intersect_sphere()
+// See also: [Ray.IntersectSphere]
 func (x *Ray) IntersectsSphere(SVar *Sphere) bool {
 
 	cret := xRayIntersectsSphere(x.GoPointer(), SVar)
@@ -210,10 +221,10 @@ func (x *Ray) IntersectsSphere(SVar *Sphere) bool {
 
 var xRayIntersectsTriangle func(uintptr, *Triangle) bool
 
-// Checks whether the given #graphene_ray_t @r intersects the
-// given #graphene_triangle_t @b.
+// Checks whether the given #graphene_ray_t r intersects the
+// given #graphene_triangle_t b.
 //
-// See also: graphene_ray_intersect_triangle()
+// See also: [Ray.IntersectTriangle]
 func (x *Ray) IntersectsTriangle(TVar *Triangle) bool {
 
 	cret := xRayIntersectsTriangle(x.GoPointer(), TVar)
@@ -235,12 +246,51 @@ const (
 	RayIntersectionKindLeaveValue RayIntersectionKind = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x RayIntersectionKind) String() string {
+	switch x {
+	case RayIntersectionKindNoneValue:
+		return "GRAPHENE_RAY_INTERSECTION_KIND_NONE"
+	case RayIntersectionKindEnterValue:
+		return "GRAPHENE_RAY_INTERSECTION_KIND_ENTER"
+	case RayIntersectionKindLeaveValue:
+		return "GRAPHENE_RAY_INTERSECTION_KIND_LEAVE"
+	}
+	return fmt.Sprintf("RayIntersectionKind(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RayIntersectionKind) Nick() string {
+	switch x {
+	case RayIntersectionKindNoneValue:
+		return "none"
+	case RayIntersectionKindEnterValue:
+		return "enter"
+	case RayIntersectionKindLeaveValue:
+		return "leave"
+	}
+	return ""
+}
+
+// RayIntersectionKindFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RayIntersectionKindFromString(s string) (RayIntersectionKind, bool) {
+	switch s {
+	case "GRAPHENE_RAY_INTERSECTION_KIND_NONE", "none":
+		return RayIntersectionKindNoneValue, true
+	case "GRAPHENE_RAY_INTERSECTION_KIND_ENTER", "enter":
+		return RayIntersectionKindEnterValue, true
+	case "GRAPHENE_RAY_INTERSECTION_KIND_LEAVE", "leave":
+		return RayIntersectionKindLeaveValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GRAPHENE", "graphene-gobject-1.0")
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -249,24 +299,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xRayGLibType, libs, "graphene_ray_get_type")
 
-	core.PuregoSafeRegister(&xRayAlloc, libs, "graphene_ray_alloc")
-
-	core.PuregoSafeRegister(&xRayEqual, libs, "graphene_ray_equal")
-	core.PuregoSafeRegister(&xRayFree, libs, "graphene_ray_free")
-	core.PuregoSafeRegister(&xRayGetClosestPointToPoint, libs, "graphene_ray_get_closest_point_to_point")
-	core.PuregoSafeRegister(&xRayGetDirection, libs, "graphene_ray_get_direction")
-	core.PuregoSafeRegister(&xRayGetDistanceToPlane, libs, "graphene_ray_get_distance_to_plane")
-	core.PuregoSafeRegister(&xRayGetDistanceToPoint, libs, "graphene_ray_get_distance_to_point")
-	core.PuregoSafeRegister(&xRayGetOrigin, libs, "graphene_ray_get_origin")
-	core.PuregoSafeRegister(&xRayGetPositionAt, libs, "graphene_ray_get_position_at")
-	core.PuregoSafeRegister(&xRayInit, libs, "graphene_ray_init")
-	core.PuregoSafeRegister(&xRayInitFromRay, libs, "graphene_ray_init_from_ray")
-	core.PuregoSafeRegister(&xRayInitFromVec3, libs, "graphene_ray_init_from_vec3")
-	core.PuregoSafeRegister(&xRayIntersectBox, libs, "graphene_ray_intersect_box")
-	core.PuregoSafeRegister(&xRayIntersectSphere, libs, "graphene_ray_intersect_sphere")
-	core.PuregoSafeRegister(&xRayIntersectTriangle, libs, "graphene_ray_intersect_triangle")
-	core.PuregoSafeRegister(&xRayIntersectsBox, libs, "graphene_ray_intersects_box")
-	core.PuregoSafeRegister(&xRayIntersectsSphere, libs, "graphene_ray_intersects_sphere")
-	core.PuregoSafeRegister(&xRayIntersectsTriangle, libs, "graphene_ray_intersects_triangle")
+	core.PuregoRegisterSince(&xRayAlloc, libs, "graphene_ray_alloc", "GRAPHENE", "1.4")
+
+	core.PuregoRegisterSince(&xRayEqual, libs, "graphene_ray_equal", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayFree, libs, "graphene_ray_free", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetClosestPointToPoint, libs, "graphene_ray_get_closest_point_to_point", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetDirection, libs, "graphene_ray_get_direction", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetDistanceToPlane, libs, "graphene_ray_get_distance_to_plane", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetDistanceToPoint, libs, "graphene_ray_get_distance_to_point", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetOrigin, libs, "graphene_ray_get_origin", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayGetPositionAt, libs, "graphene_ray_get_position_at", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayInit, libs, "graphene_ray_init", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayInitFromRay, libs, "graphene_ray_init_from_ray", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayInitFromVec3, libs, "graphene_ray_init_from_vec3", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRayIntersectBox, libs, "graphene_ray_intersect_box", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRayIntersectSphere, libs, "graphene_ray_intersect_sphere", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRayIntersectTriangle, libs, "graphene_ray_intersect_triangle", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRayIntersectsBox, libs, "graphene_ray_intersects_box", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRayIntersectsSphere, libs, "graphene_ray_intersects_sphere", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRayIntersectsTriangle, libs, "graphene_ray_intersects_triangle", "GRAPHENE", "1.10")
 
 }
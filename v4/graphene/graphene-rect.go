@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 // equivalent to a #graphene_rect_t with an origin of [ 10, 10 ] and a size
 // of [ -10, -10 ].
 //
-// Application code can normalize rectangles using graphene_rect_normalize();
+// Application code can normalize rectangles using [Rect.Normalize];
 // this function will ensure that the width and height of a rectangle are
 // positive values. All functions taking a #graphene_rect_t as an argument
 // will internally operate on a normalized copy; all functions returning a
@@ -25,7 +25,7 @@ import (
 type Rect struct {
 	_ structs.HostLayout
 
-	Origin uintptr
+	Origin Point
 
 	Size uintptr
 }
@@ -40,6 +40,16 @@ func (x *Rect) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Rect made with g_boxed_copy, release it with Free.
+func (x *Rect) Copy() *Rect {
+	cret := gobject.BoxedCopy(RectGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Rect)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xRectContainsPoint func(uintptr, *Point) bool
 
 // Checks whether a #graphene_rect_t contains the given coordinates.
@@ -79,7 +89,7 @@ func (x *Rect) Expand(PVar *Point, ResVar *Rect) {
 
 var xRectFree func(uintptr)
 
-// Frees the resources allocated by graphene_rect_alloc().
+// Frees the resources allocated by [RectAlloc].
 func (x *Rect) Free() {
 
 	xRectFree(x.GoPointer())
@@ -201,7 +211,7 @@ func (x *Rect) Init(XVar float32, YVar float32, WidthVar float32, HeightVar floa
 
 var xRectInitFromRect func(uintptr, *Rect) *Rect
 
-// Initializes @r using the given @src rectangle.
+// Initializes r using the given src rectangle.
 //
 // This function will implicitly normalize the #graphene_rect_t
 // before returning.
@@ -216,13 +226,13 @@ var xRectInset func(uintptr, float32, float32) *Rect
 // Changes the given rectangle to be smaller, or larger depending on the
 // given inset parameters.
 //
-// To create an inset rectangle, use positive @d_x or @d_y values; to
-// create a larger, encompassing rectangle, use negative @d_x or @d_y
+// To create an inset rectangle, use positive d_x or d_y values; to
+// create a larger, encompassing rectangle, use negative d_x or d_y
 // values.
 //
-// The origin of the rectangle is offset by @d_x and @d_y, while the size
-// is adjusted by `(2 * @d_x, 2 * @d_y)`. If @d_x and @d_y are positive
-// values, the size of the rectangle is decreased; if @d_x and @d_y are
+// The origin of the rectangle is offset by d_x and d_y, while the size
+// is adjusted by `(2 * d_x, 2 * d_y)`. If d_x and d_y are positive
+// values, the size of the rectangle is decreased; if d_x and d_y are
 // negative values, the size of the rectangle is increased.
 //
 // If the size of the resulting inset rectangle has a negative width or
@@ -238,13 +248,13 @@ var xRectInsetR func(uintptr, float32, float32, *Rect)
 // Changes the given rectangle to be smaller, or larger depending on the
 // given inset parameters.
 //
-// To create an inset rectangle, use positive @d_x or @d_y values; to
-// create a larger, encompassing rectangle, use negative @d_x or @d_y
+// To create an inset rectangle, use positive d_x or d_y values; to
+// create a larger, encompassing rectangle, use negative d_x or d_y
 // values.
 //
-// The origin of the rectangle is offset by @d_x and @d_y, while the size
-// is adjusted by `(2 * @d_x, 2 * @d_y)`. If @d_x and @d_y are positive
-// values, the size of the rectangle is decreased; if @d_x and @d_y are
+// The origin of the rectangle is offset by d_x and d_y, while the size
+// is adjusted by `(2 * d_x, 2 * d_y)`. If d_x and d_y are positive
+// values, the size of the rectangle is decreased; if d_x and d_y are
 // negative values, the size of the rectangle is increased.
 //
 // If the size of the resulting inset rectangle has a negative width or
@@ -269,11 +279,9 @@ var xRectIntersection func(uintptr, *Rect, *Rect) bool
 
 // Computes the intersection of the two given rectangles.
 //
-// ![](rectangle-intersection.png)
-//
 // The intersection in the image above is the blue outline.
 //
-// If the two rectangles do not intersect, @res will contain
+// If the two rectangles do not intersect, res will contain
 // a degenerate rectangle with origin in (0, 0) and a size of 0.
 func (x *Rect) Intersection(BVar *Rect, ResVar *Rect) bool {
 
@@ -309,7 +317,7 @@ func (x *Rect) NormalizeR(ResVar *Rect) {
 
 var xRectOffset func(uintptr, float32, float32) *Rect
 
-// Offsets the origin by @d_x and @d_y.
+// Offsets the origin by d_x and d_y.
 //
 // The size of the rectangle is unchanged.
 func (x *Rect) Offset(DXVar float32, DYVar float32) *Rect {
@@ -320,7 +328,7 @@ func (x *Rect) Offset(DXVar float32, DYVar float32) *Rect {
 
 var xRectOffsetR func(uintptr, float32, float32, *Rect)
 
-// Offsets the origin of the given rectangle by @d_x and @d_y.
+// Offsets the origin of the given rectangle by d_x and d_y.
 //
 // The size of the rectangle is left unchanged.
 func (x *Rect) OffsetR(DXVar float32, DYVar float32, ResVar *Rect) {
@@ -329,23 +337,6 @@ func (x *Rect) OffsetR(DXVar float32, DYVar float32, ResVar *Rect) {
 
 }
 
-var xRectRound func(uintptr, *Rect)
-
-// Rounds the origin and size of the given rectangle to
-// their nearest integer values; the rounding is guaranteed
-// to be large enough to have an area bigger or equal to the
-// original rectangle, but might not fully contain its extents.
-// Use graphene_rect_round_extents() in case you need to round
-// to a rectangle that covers fully the original one.
-//
-// This function is the equivalent of calling `floor` on
-// the coordinates of the origin, and `ceil` on the size.
-func (x *Rect) Round(ResVar *Rect) {
-
-	xRectRound(x.GoPointer(), ResVar)
-
-}
-
 var xRectRoundExtents func(uintptr, *Rect)
 
 // Rounds the origin of the given rectangle to its nearest
@@ -375,21 +366,10 @@ func (x *Rect) RoundExtents(ResVar *Rect) {
 
 }
 
-var xRectRoundToPixel func(uintptr) *Rect
-
-// Rounds the origin and the size of the given rectangle to
-// their nearest integer values; the rounding is guaranteed
-// to be large enough to contain the original rectangle.
-func (x *Rect) RoundToPixel() *Rect {
-
-	cret := xRectRoundToPixel(x.GoPointer())
-	return cret
-}
-
 var xRectScale func(uintptr, float32, float32, *Rect)
 
-// Scales the size and origin of a rectangle horizontaly by @s_h,
-// and vertically by @s_v. The result @res is normalized.
+// Scales the size and origin of a rectangle horizontaly by s_h,
+// and vertically by s_v. The result res is normalized.
 func (x *Rect) Scale(SHVar float32, SVVar float32, ResVar *Rect) {
 
 	xRectScale(x.GoPointer(), SHVar, SVVar, ResVar)
@@ -400,8 +380,6 @@ var xRectUnion func(uintptr, *Rect, *Rect)
 
 // Computes the union of the two given rectangles.
 //
-// ![](rectangle-union.png)
-//
 // The union in the image above is the blue outline.
 func (x *Rect) Union(BVar *Rect, ResVar *Rect) {
 
@@ -435,48 +413,46 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xRectAlloc, libs, "graphene_rect_alloc")
-	core.PuregoSafeRegister(&xRectZero, libs, "graphene_rect_zero")
+	core.PuregoRegisterSince(&xRectAlloc, libs, "graphene_rect_alloc", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectZero, libs, "graphene_rect_zero", "GRAPHENE", "1.4")
 
 	core.PuregoSafeRegister(&xRectGLibType, libs, "graphene_rect_get_type")
 
-	core.PuregoSafeRegister(&xRectContainsPoint, libs, "graphene_rect_contains_point")
-	core.PuregoSafeRegister(&xRectContainsRect, libs, "graphene_rect_contains_rect")
-	core.PuregoSafeRegister(&xRectEqual, libs, "graphene_rect_equal")
-	core.PuregoSafeRegister(&xRectExpand, libs, "graphene_rect_expand")
-	core.PuregoSafeRegister(&xRectFree, libs, "graphene_rect_free")
-	core.PuregoSafeRegister(&xRectGetArea, libs, "graphene_rect_get_area")
-	core.PuregoSafeRegister(&xRectGetBottomLeft, libs, "graphene_rect_get_bottom_left")
-	core.PuregoSafeRegister(&xRectGetBottomRight, libs, "graphene_rect_get_bottom_right")
-	core.PuregoSafeRegister(&xRectGetCenter, libs, "graphene_rect_get_center")
-	core.PuregoSafeRegister(&xRectGetHeight, libs, "graphene_rect_get_height")
-	core.PuregoSafeRegister(&xRectGetTopLeft, libs, "graphene_rect_get_top_left")
-	core.PuregoSafeRegister(&xRectGetTopRight, libs, "graphene_rect_get_top_right")
-	core.PuregoSafeRegister(&xRectGetVertices, libs, "graphene_rect_get_vertices")
-	core.PuregoSafeRegister(&xRectGetWidth, libs, "graphene_rect_get_width")
-	core.PuregoSafeRegister(&xRectGetX, libs, "graphene_rect_get_x")
-	core.PuregoSafeRegister(&xRectGetY, libs, "graphene_rect_get_y")
-	core.PuregoSafeRegister(&xRectInit, libs, "graphene_rect_init")
-	core.PuregoSafeRegister(&xRectInitFromRect, libs, "graphene_rect_init_from_rect")
-	core.PuregoSafeRegister(&xRectInset, libs, "graphene_rect_inset")
-	core.PuregoSafeRegister(&xRectInsetR, libs, "graphene_rect_inset_r")
-	core.PuregoSafeRegister(&xRectInterpolate, libs, "graphene_rect_interpolate")
-	core.PuregoSafeRegister(&xRectIntersection, libs, "graphene_rect_intersection")
-	core.PuregoSafeRegister(&xRectNormalize, libs, "graphene_rect_normalize")
-	core.PuregoSafeRegister(&xRectNormalizeR, libs, "graphene_rect_normalize_r")
-	core.PuregoSafeRegister(&xRectOffset, libs, "graphene_rect_offset")
-	core.PuregoSafeRegister(&xRectOffsetR, libs, "graphene_rect_offset_r")
-	core.PuregoSafeRegister(&xRectRound, libs, "graphene_rect_round")
-	core.PuregoSafeRegister(&xRectRoundExtents, libs, "graphene_rect_round_extents")
-	core.PuregoSafeRegister(&xRectRoundToPixel, libs, "graphene_rect_round_to_pixel")
-	core.PuregoSafeRegister(&xRectScale, libs, "graphene_rect_scale")
-	core.PuregoSafeRegister(&xRectUnion, libs, "graphene_rect_union")
+	core.PuregoRegisterSince(&xRectContainsPoint, libs, "graphene_rect_contains_point", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectContainsRect, libs, "graphene_rect_contains_rect", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectEqual, libs, "graphene_rect_equal", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectExpand, libs, "graphene_rect_expand", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectFree, libs, "graphene_rect_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetArea, libs, "graphene_rect_get_area", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRectGetBottomLeft, libs, "graphene_rect_get_bottom_left", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetBottomRight, libs, "graphene_rect_get_bottom_right", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetCenter, libs, "graphene_rect_get_center", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetHeight, libs, "graphene_rect_get_height", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetTopLeft, libs, "graphene_rect_get_top_left", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetTopRight, libs, "graphene_rect_get_top_right", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetVertices, libs, "graphene_rect_get_vertices", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectGetWidth, libs, "graphene_rect_get_width", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetX, libs, "graphene_rect_get_x", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectGetY, libs, "graphene_rect_get_y", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectInit, libs, "graphene_rect_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectInitFromRect, libs, "graphene_rect_init_from_rect", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectInset, libs, "graphene_rect_inset", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectInsetR, libs, "graphene_rect_inset_r", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectInterpolate, libs, "graphene_rect_interpolate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectIntersection, libs, "graphene_rect_intersection", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectNormalize, libs, "graphene_rect_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectNormalizeR, libs, "graphene_rect_normalize_r", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectOffset, libs, "graphene_rect_offset", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xRectOffsetR, libs, "graphene_rect_offset_r", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectRoundExtents, libs, "graphene_rect_round_extents", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRectScale, libs, "graphene_rect_scale", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xRectUnion, libs, "graphene_rect_union", "GRAPHENE", "1.0")
 
 }
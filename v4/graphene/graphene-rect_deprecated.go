@@ -0,0 +1,57 @@
+//go:build !puregotk_no_deprecated
+
+// Package graphene was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package graphene
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xRectRound func(uintptr, *Rect)
+
+// Rounds the origin and size of the given rectangle to
+// their nearest integer values; the rounding is guaranteed
+// to be large enough to have an area bigger or equal to the
+// original rectangle, but might not fully contain its extents.
+// Use [Rect.RoundExtents] in case you need to round
+// to a rectangle that covers fully the original one.
+//
+// This function is the equivalent of calling `floor` on
+// the coordinates of the origin, and `ceil` on the size.
+//
+// Deprecated: since 1.10. Use [Rect.RoundExtents] instead
+func (x *Rect) Round(ResVar *Rect) {
+
+	xRectRound(x.GoPointer(), ResVar)
+
+}
+
+var xRectRoundToPixel func(uintptr) *Rect
+
+// Rounds the origin and the size of the given rectangle to
+// their nearest integer values; the rounding is guaranteed
+// to be large enough to contain the original rectangle.
+//
+// Deprecated: since 1.4. Use [Rect.Round] instead
+func (x *Rect) RoundToPixel() *Rect {
+
+	cret := xRectRoundToPixel(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GRAPHENE", "graphene-gobject-1.0")
+	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GRAPHENE") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xRectRound, libs, "graphene_rect_round", "GRAPHENE", "1.4")
+	core.PuregoRegisterSince(&xRectRoundToPixel, libs, "graphene_rect_round_to_pixel", "GRAPHENE", "1.0")
+
+}
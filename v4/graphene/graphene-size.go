@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -29,6 +29,16 @@ func (x *Size) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Size made with g_boxed_copy, release it with Free.
+func (x *Size) Copy() *Size {
+	cret := gobject.BoxedCopy(SizeGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Size)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xSizeAlloc func() *Size
 
 // Allocates a new #graphene_size_t.
@@ -51,7 +61,7 @@ func (x *Size) Equal(BVar *Size) bool {
 
 var xSizeFree func(uintptr)
 
-// Frees the resources allocated by graphene_size_alloc().
+// Frees the resources allocated by [SizeAlloc].
 func (x *Size) Free() {
 
 	xSizeFree(x.GoPointer())
@@ -60,7 +70,7 @@ func (x *Size) Free() {
 
 var xSizeInit func(uintptr, float32, float32) *Size
 
-// Initializes a #graphene_size_t using the given @width and @height.
+// Initializes a #graphene_size_t using the given width and height.
 func (x *Size) Init(WidthVar float32, HeightVar float32) *Size {
 
 	cret := xSizeInit(x.GoPointer(), WidthVar, HeightVar)
@@ -70,7 +80,7 @@ func (x *Size) Init(WidthVar float32, HeightVar float32) *Size {
 var xSizeInitFromSize func(uintptr, *Size) *Size
 
 // Initializes a #graphene_size_t using the width and height of
-// the given @src.
+// the given src.
 func (x *Size) InitFromSize(SrcVar *Size) *Size {
 
 	cret := xSizeInitFromSize(x.GoPointer(), SrcVar)
@@ -80,7 +90,7 @@ func (x *Size) InitFromSize(SrcVar *Size) *Size {
 var xSizeInterpolate func(uintptr, *Size, float64, *Size)
 
 // Linearly interpolates the two given #graphene_size_t using the given
-// interpolation @factor.
+// interpolation factor.
 func (x *Size) Interpolate(BVar *Size, FactorVar float64, ResVar *Size) {
 
 	xSizeInterpolate(x.GoPointer(), BVar, FactorVar, ResVar)
@@ -89,7 +99,7 @@ func (x *Size) Interpolate(BVar *Size, FactorVar float64, ResVar *Size) {
 
 var xSizeScale func(uintptr, float32, *Size)
 
-// Scales the components of a #graphene_size_t using the given @factor.
+// Scales the components of a #graphene_size_t using the given factor.
 func (x *Size) Scale(FactorVar float32, ResVar *Size) {
 
 	xSizeScale(x.GoPointer(), FactorVar, ResVar)
@@ -111,24 +121,24 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSizeZero, libs, "graphene_size_zero")
+	core.PuregoRegisterSince(&xSizeZero, libs, "graphene_size_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xSizeGLibType, libs, "graphene_size_get_type")
 
-	core.PuregoSafeRegister(&xSizeAlloc, libs, "graphene_size_alloc")
+	core.PuregoRegisterSince(&xSizeAlloc, libs, "graphene_size_alloc", "GRAPHENE", "1.0")
 
-	core.PuregoSafeRegister(&xSizeEqual, libs, "graphene_size_equal")
-	core.PuregoSafeRegister(&xSizeFree, libs, "graphene_size_free")
-	core.PuregoSafeRegister(&xSizeInit, libs, "graphene_size_init")
-	core.PuregoSafeRegister(&xSizeInitFromSize, libs, "graphene_size_init_from_size")
-	core.PuregoSafeRegister(&xSizeInterpolate, libs, "graphene_size_interpolate")
-	core.PuregoSafeRegister(&xSizeScale, libs, "graphene_size_scale")
+	core.PuregoRegisterSince(&xSizeEqual, libs, "graphene_size_equal", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xSizeFree, libs, "graphene_size_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xSizeInit, libs, "graphene_size_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xSizeInitFromSize, libs, "graphene_size_init_from_size", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xSizeInterpolate, libs, "graphene_size_interpolate", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xSizeScale, libs, "graphene_size_scale", "GRAPHENE", "1.0")
 
 }
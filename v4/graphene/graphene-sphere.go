@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -14,7 +14,7 @@ import (
 type Sphere struct {
 	_ structs.HostLayout
 
-	Center uintptr
+	Center Vec3
 
 	Radius float32
 }
@@ -29,6 +29,16 @@ func (x *Sphere) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Sphere made with g_boxed_copy, release it with Free.
+func (x *Sphere) Copy() *Sphere {
+	cret := gobject.BoxedCopy(SphereGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Sphere)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xSphereAlloc func() *Sphere
 
 // Allocates a new #graphene_sphere_t.
@@ -42,7 +52,7 @@ func SphereAlloc() *Sphere {
 
 var xSphereContainsPoint func(uintptr, *Point3D) bool
 
-// Checks whether the given @point is contained in the volume
+// Checks whether the given point is contained in the volume
 // of a #graphene_sphere_t.
 func (x *Sphere) ContainsPoint(PointVar *Point3D) bool {
 
@@ -52,7 +62,7 @@ func (x *Sphere) ContainsPoint(PointVar *Point3D) bool {
 
 var xSphereDistance func(uintptr, *Point3D) float32
 
-// Computes the distance of the given @point from the surface of
+// Computes the distance of the given point from the surface of
 // a #graphene_sphere_t.
 func (x *Sphere) Distance(PointVar *Point3D) float32 {
 
@@ -71,7 +81,7 @@ func (x *Sphere) Equal(BVar *Sphere) bool {
 
 var xSphereFree func(uintptr)
 
-// Frees the resources allocated by graphene_sphere_alloc().
+// Frees the resources allocated by [SphereAlloc].
 func (x *Sphere) Free() {
 
 	xSphereFree(x.GoPointer())
@@ -108,7 +118,7 @@ func (x *Sphere) GetRadius() float32 {
 
 var xSphereInit func(uintptr, *Point3D, float32) *Sphere
 
-// Initializes the given #graphene_sphere_t with the given @center and @radius.
+// Initializes the given #graphene_sphere_t with the given center and radius.
 func (x *Sphere) Init(CenterVar *Point3D, RadiusVar float32) *Sphere {
 
 	cret := xSphereInit(x.GoPointer(), CenterVar, RadiusVar)
@@ -121,7 +131,7 @@ var xSphereInitFromPoints func(uintptr, uint, []Point3D, *Point3D) *Sphere
 // of 3D coordinates so that the sphere includes them.
 //
 // The center of the sphere can either be specified, or will be center
-// of the 3D volume that encompasses all @points.
+// of the 3D volume that encompasses all points.
 func (x *Sphere) InitFromPoints(NPointsVar uint, PointsVar []Point3D, CenterVar *Point3D) *Sphere {
 
 	cret := xSphereInitFromPoints(x.GoPointer(), NPointsVar, PointsVar, CenterVar)
@@ -134,7 +144,7 @@ var xSphereInitFromVectors func(uintptr, uint, []Vec3, *Point3D) *Sphere
 // of 3D coordinates so that the sphere includes them.
 //
 // The center of the sphere can either be specified, or will be center
-// of the 3D volume that encompasses all @vectors.
+// of the 3D volume that encompasses all vectors.
 func (x *Sphere) InitFromVectors(NVectorsVar uint, VectorsVar []Vec3, CenterVar *Point3D) *Sphere {
 
 	cret := xSphereInitFromVectors(x.GoPointer(), NVectorsVar, VectorsVar, CenterVar)
@@ -152,7 +162,7 @@ func (x *Sphere) IsEmpty() bool {
 
 var xSphereTranslate func(uintptr, *Point3D, *Sphere)
 
-// Translates the center of the given #graphene_sphere_t using the @point
+// Translates the center of the given #graphene_sphere_t using the point
 // coordinates as the delta of the translation.
 func (x *Sphere) Translate(PointVar *Point3D, ResVar *Sphere) {
 
@@ -165,7 +175,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -174,19 +184,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xSphereGLibType, libs, "graphene_sphere_get_type")
 
-	core.PuregoSafeRegister(&xSphereAlloc, libs, "graphene_sphere_alloc")
-
-	core.PuregoSafeRegister(&xSphereContainsPoint, libs, "graphene_sphere_contains_point")
-	core.PuregoSafeRegister(&xSphereDistance, libs, "graphene_sphere_distance")
-	core.PuregoSafeRegister(&xSphereEqual, libs, "graphene_sphere_equal")
-	core.PuregoSafeRegister(&xSphereFree, libs, "graphene_sphere_free")
-	core.PuregoSafeRegister(&xSphereGetBoundingBox, libs, "graphene_sphere_get_bounding_box")
-	core.PuregoSafeRegister(&xSphereGetCenter, libs, "graphene_sphere_get_center")
-	core.PuregoSafeRegister(&xSphereGetRadius, libs, "graphene_sphere_get_radius")
-	core.PuregoSafeRegister(&xSphereInit, libs, "graphene_sphere_init")
-	core.PuregoSafeRegister(&xSphereInitFromPoints, libs, "graphene_sphere_init_from_points")
-	core.PuregoSafeRegister(&xSphereInitFromVectors, libs, "graphene_sphere_init_from_vectors")
-	core.PuregoSafeRegister(&xSphereIsEmpty, libs, "graphene_sphere_is_empty")
-	core.PuregoSafeRegister(&xSphereTranslate, libs, "graphene_sphere_translate")
+	core.PuregoRegisterSince(&xSphereAlloc, libs, "graphene_sphere_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xSphereContainsPoint, libs, "graphene_sphere_contains_point", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereDistance, libs, "graphene_sphere_distance", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereEqual, libs, "graphene_sphere_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereFree, libs, "graphene_sphere_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereGetBoundingBox, libs, "graphene_sphere_get_bounding_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereGetCenter, libs, "graphene_sphere_get_center", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereGetRadius, libs, "graphene_sphere_get_radius", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereInit, libs, "graphene_sphere_init", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereInitFromPoints, libs, "graphene_sphere_init_from_points", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereInitFromVectors, libs, "graphene_sphere_init_from_vectors", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereIsEmpty, libs, "graphene_sphere_is_empty", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xSphereTranslate, libs, "graphene_sphere_translate", "GRAPHENE", "1.2")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -14,7 +14,7 @@ import (
 type Triangle struct {
 	_ structs.HostLayout
 
-	A uintptr
+	A Vec3
 
 	B uintptr
 
@@ -31,6 +31,16 @@ func (x *Triangle) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Triangle made with g_boxed_copy, release it with Free.
+func (x *Triangle) Copy() *Triangle {
+	cret := gobject.BoxedCopy(TriangleGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Triangle)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xTriangleAlloc func() *Triangle
 
 // Allocates a new #graphene_triangle_t.
@@ -44,7 +54,7 @@ func TriangleAlloc() *Triangle {
 
 var xTriangleContainsPoint func(uintptr, *Point3D) bool
 
-// Checks whether the given triangle @t contains the point @p.
+// Checks whether the given triangle t contains the point p.
 func (x *Triangle) ContainsPoint(PVar *Point3D) bool {
 
 	cret := xTriangleContainsPoint(x.GoPointer(), PVar)
@@ -62,7 +72,7 @@ func (x *Triangle) Equal(BVar *Triangle) bool {
 
 var xTriangleFree func(uintptr)
 
-// Frees the resources allocated by graphene_triangle_alloc().
+// Frees the resources allocated by [TriangleAlloc].
 func (x *Triangle) Free() {
 
 	xTriangleFree(x.GoPointer())
@@ -80,22 +90,22 @@ func (x *Triangle) GetArea() float32 {
 
 var xTriangleGetBarycoords func(uintptr, *Point3D, *Vec2) bool
 
-// Computes the [barycentric coordinates](http://en.wikipedia.org/wiki/Barycentric_coordinate_system)
-// of the given point @p.
+// Computes the [barycentric coordinates]
+// of the given point p.
 //
-// The point @p must lie on the same plane as the triangle @t; if the
+// The point p must lie on the same plane as the triangle t; if the
 // point is not coplanar, the result of this function is undefined.
 //
 // If we place the origin in the coordinates of the triangle's A point,
 // the barycentric coordinates are `u`, which is on the AC vector; and `v`
 // which is on the AB vector:
 //
-// ![](triangle-barycentric.png)
-//
 // The returned #graphene_vec2_t contains the following values, in order:
 //
 //   - `res.x = u`
 //   - `res.y = v`
+//
+// [barycentric coordinates]: http://en.wikipedia.org/wiki/Barycentric_coordinate_system
 func (x *Triangle) GetBarycoords(PVar *Point3D, ResVar *Vec2) bool {
 
 	cret := xTriangleGetBarycoords(x.GoPointer(), PVar, ResVar)
@@ -115,8 +125,10 @@ var xTriangleGetMidpoint func(uintptr, *Point3D)
 
 // Computes the coordinates of the midpoint of the given #graphene_triangle_t.
 //
-// The midpoint G is the [centroid](https://en.wikipedia.org/wiki/Centroid#Triangle_centroid)
+// The midpoint G is the [centroid]
 // of the triangle, i.e. the intersection of its medians.
+//
+// [centroid]: https://en.wikipedia.org/wiki/Centroid#Triangle_centroid
 func (x *Triangle) GetMidpoint(ResVar *Point3D) {
 
 	xTriangleGetMidpoint(x.GoPointer(), ResVar)
@@ -153,18 +165,18 @@ func (x *Triangle) GetPoints(AVar *Point3D, BVar *Point3D, CVar *Point3D) {
 
 var xTriangleGetUv func(uintptr, *Point3D, *Vec2, *Vec2, *Vec2, *Vec2) bool
 
-// Computes the UV coordinates of the given point @p.
+// Computes the UV coordinates of the given point p.
 //
-// The point @p must lie on the same plane as the triangle @t; if the point
-// is not coplanar, the result of this function is undefined. If @p is %NULL,
+// The point p must lie on the same plane as the triangle t; if the point
+// is not coplanar, the result of this function is undefined. If p is nil,
 // the point will be set in (0, 0, 0).
 //
-// The UV coordinates will be placed in the @res vector:
+// The UV coordinates will be placed in the res vector:
 //
 //   - `res.x = u`
 //   - `res.y = v`
 //
-// See also: graphene_triangle_get_barycoords()
+// See also: [Triangle.GetBarycoords]
 func (x *Triangle) GetUv(PVar *Point3D, UvAVar *Vec2, UvBVar *Vec2, UvCVar *Vec2, ResVar *Vec2) bool {
 
 	cret := xTriangleGetUv(x.GoPointer(), PVar, UvAVar, UvBVar, UvCVar, ResVar)
@@ -214,7 +226,7 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -223,22 +235,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xTriangleGLibType, libs, "graphene_triangle_get_type")
 
-	core.PuregoSafeRegister(&xTriangleAlloc, libs, "graphene_triangle_alloc")
-
-	core.PuregoSafeRegister(&xTriangleContainsPoint, libs, "graphene_triangle_contains_point")
-	core.PuregoSafeRegister(&xTriangleEqual, libs, "graphene_triangle_equal")
-	core.PuregoSafeRegister(&xTriangleFree, libs, "graphene_triangle_free")
-	core.PuregoSafeRegister(&xTriangleGetArea, libs, "graphene_triangle_get_area")
-	core.PuregoSafeRegister(&xTriangleGetBarycoords, libs, "graphene_triangle_get_barycoords")
-	core.PuregoSafeRegister(&xTriangleGetBoundingBox, libs, "graphene_triangle_get_bounding_box")
-	core.PuregoSafeRegister(&xTriangleGetMidpoint, libs, "graphene_triangle_get_midpoint")
-	core.PuregoSafeRegister(&xTriangleGetNormal, libs, "graphene_triangle_get_normal")
-	core.PuregoSafeRegister(&xTriangleGetPlane, libs, "graphene_triangle_get_plane")
-	core.PuregoSafeRegister(&xTriangleGetPoints, libs, "graphene_triangle_get_points")
-	core.PuregoSafeRegister(&xTriangleGetUv, libs, "graphene_triangle_get_uv")
-	core.PuregoSafeRegister(&xTriangleGetVertices, libs, "graphene_triangle_get_vertices")
-	core.PuregoSafeRegister(&xTriangleInitFromFloat, libs, "graphene_triangle_init_from_float")
-	core.PuregoSafeRegister(&xTriangleInitFromPoint3d, libs, "graphene_triangle_init_from_point3d")
-	core.PuregoSafeRegister(&xTriangleInitFromVec3, libs, "graphene_triangle_init_from_vec3")
+	core.PuregoRegisterSince(&xTriangleAlloc, libs, "graphene_triangle_alloc", "GRAPHENE", "1.2")
+
+	core.PuregoRegisterSince(&xTriangleContainsPoint, libs, "graphene_triangle_contains_point", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleEqual, libs, "graphene_triangle_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleFree, libs, "graphene_triangle_free", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetArea, libs, "graphene_triangle_get_area", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetBarycoords, libs, "graphene_triangle_get_barycoords", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetBoundingBox, libs, "graphene_triangle_get_bounding_box", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetMidpoint, libs, "graphene_triangle_get_midpoint", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetNormal, libs, "graphene_triangle_get_normal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetPlane, libs, "graphene_triangle_get_plane", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetPoints, libs, "graphene_triangle_get_points", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleGetUv, libs, "graphene_triangle_get_uv", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xTriangleGetVertices, libs, "graphene_triangle_get_vertices", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleInitFromFloat, libs, "graphene_triangle_init_from_float", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xTriangleInitFromPoint3d, libs, "graphene_triangle_init_from_point3d", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xTriangleInitFromVec3, libs, "graphene_triangle_init_from_vec3", "GRAPHENE", "1.2")
 
 }
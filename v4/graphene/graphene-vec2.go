@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type Vec2 struct {
 	_ structs.HostLayout
 
-	Value uintptr
+	Value Simd4F
 }
 
 var xVec2GLibType func() types.GType
@@ -30,13 +30,23 @@ func (x *Vec2) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Vec2 made with g_boxed_copy, release it with Free.
+func (x *Vec2) Copy() *Vec2 {
+	cret := gobject.BoxedCopy(Vec2GLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Vec2)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xVec2Alloc func() *Vec2
 
 // Allocates a new #graphene_vec2_t structure.
 //
 // The contents of the returned structure are undefined.
 //
-// Use graphene_vec2_init() to initialize the vector.
+// Use [Vec2.Init] to initialize the vector.
 func Vec2Alloc() *Vec2 {
 
 	cret := xVec2Alloc()
@@ -46,7 +56,7 @@ func Vec2Alloc() *Vec2 {
 var xVec2Add func(uintptr, *Vec2, *Vec2)
 
 // Adds each component of the two passed vectors and places
-// each result into the components of @res.
+// each result into the components of res.
 func (x *Vec2) Add(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Add(x.GoPointer(), BVar, ResVar)
@@ -55,9 +65,9 @@ func (x *Vec2) Add(BVar *Vec2, ResVar *Vec2) {
 
 var xVec2Divide func(uintptr, *Vec2, *Vec2)
 
-// Divides each component of the first operand @a by the corresponding
-// component of the second operand @b, and places the results into the
-// vector @res.
+// Divides each component of the first operand a by the corresponding
+// component of the second operand b, and places the results into the
+// vector res.
 func (x *Vec2) Divide(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Divide(x.GoPointer(), BVar, ResVar)
@@ -84,7 +94,7 @@ func (x *Vec2) Equal(V2Var *Vec2) bool {
 
 var xVec2Free func(uintptr)
 
-// Frees the resources allocated by @v
+// Frees the resources allocated by v
 func (x *Vec2) Free() {
 
 	xVec2Free(x.GoPointer())
@@ -122,7 +132,7 @@ func (x *Vec2) Init(XVar float32, YVar float32) *Vec2 {
 
 var xVec2InitFromFloat func(uintptr, [2]float32) *Vec2
 
-// Initializes @v with the contents of the given array.
+// Initializes v with the contents of the given array.
 func (x *Vec2) InitFromFloat(SrcVar [2]float32) *Vec2 {
 
 	cret := xVec2InitFromFloat(x.GoPointer(), SrcVar)
@@ -131,7 +141,7 @@ func (x *Vec2) InitFromFloat(SrcVar [2]float32) *Vec2 {
 
 var xVec2InitFromVec2 func(uintptr, *Vec2) *Vec2
 
-// Copies the contents of @src into @v.
+// Copies the contents of src into v.
 func (x *Vec2) InitFromVec2(SrcVar *Vec2) *Vec2 {
 
 	cret := xVec2InitFromVec2(x.GoPointer(), SrcVar)
@@ -140,7 +150,7 @@ func (x *Vec2) InitFromVec2(SrcVar *Vec2) *Vec2 {
 
 var xVec2Interpolate func(uintptr, *Vec2, float64, *Vec2)
 
-// Linearly interpolates @v1 and @v2 using the given @factor.
+// Linearly interpolates v1 and v2 using the given factor.
 func (x *Vec2) Interpolate(V2Var *Vec2, FactorVar float64, ResVar *Vec2) {
 
 	xVec2Interpolate(x.GoPointer(), V2Var, FactorVar, ResVar)
@@ -159,7 +169,7 @@ func (x *Vec2) Length() float32 {
 var xVec2Max func(uintptr, *Vec2, *Vec2)
 
 // Compares the two given vectors and places the maximum
-// values of each component into @res.
+// values of each component into res.
 func (x *Vec2) Max(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Max(x.GoPointer(), BVar, ResVar)
@@ -169,7 +179,7 @@ func (x *Vec2) Max(BVar *Vec2, ResVar *Vec2) {
 var xVec2Min func(uintptr, *Vec2, *Vec2)
 
 // Compares the two given vectors and places the minimum
-// values of each component into @res.
+// values of each component into res.
 func (x *Vec2) Min(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Min(x.GoPointer(), BVar, ResVar)
@@ -179,7 +189,7 @@ func (x *Vec2) Min(BVar *Vec2, ResVar *Vec2) {
 var xVec2Multiply func(uintptr, *Vec2, *Vec2)
 
 // Multiplies each component of the two passed vectors and places
-// each result into the components of @res.
+// each result into the components of res.
 func (x *Vec2) Multiply(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Multiply(x.GoPointer(), BVar, ResVar)
@@ -189,7 +199,7 @@ func (x *Vec2) Multiply(BVar *Vec2, ResVar *Vec2) {
 var xVec2Near func(uintptr, *Vec2, float32) bool
 
 // Compares the two given #graphene_vec2_t vectors and checks
-// whether their values are within the given @epsilon.
+// whether their values are within the given epsilon.
 func (x *Vec2) Near(V2Var *Vec2, EpsilonVar float32) bool {
 
 	cret := xVec2Near(x.GoPointer(), V2Var, EpsilonVar)
@@ -207,7 +217,7 @@ func (x *Vec2) Negate(ResVar *Vec2) {
 
 var xVec2Normalize func(uintptr, *Vec2)
 
-// Computes the normalized vector for the given vector @v.
+// Computes the normalized vector for the given vector v.
 func (x *Vec2) Normalize(ResVar *Vec2) {
 
 	xVec2Normalize(x.GoPointer(), ResVar)
@@ -216,7 +226,7 @@ func (x *Vec2) Normalize(ResVar *Vec2) {
 
 var xVec2Scale func(uintptr, float32, *Vec2)
 
-// Multiplies all components of the given vector with the given scalar @factor.
+// Multiplies all components of the given vector with the given scalar factor.
 func (x *Vec2) Scale(FactorVar float32, ResVar *Vec2) {
 
 	xVec2Scale(x.GoPointer(), FactorVar, ResVar)
@@ -225,9 +235,9 @@ func (x *Vec2) Scale(FactorVar float32, ResVar *Vec2) {
 
 var xVec2Subtract func(uintptr, *Vec2, *Vec2)
 
-// Subtracts from each component of the first operand @a the
-// corresponding component of the second operand @b and places
-// each result into the components of @res.
+// Subtracts from each component of the first operand a the
+// corresponding component of the second operand b and places
+// each result into the components of res.
 func (x *Vec2) Subtract(BVar *Vec2, ResVar *Vec2) {
 
 	xVec2Subtract(x.GoPointer(), BVar, ResVar)
@@ -236,7 +246,7 @@ func (x *Vec2) Subtract(BVar *Vec2, ResVar *Vec2) {
 
 var xVec2ToFloat func(uintptr, *[2]float32)
 
-// Stores the components of @v into an array.
+// Stores the components of v into an array.
 func (x *Vec2) ToFloat(DestVar *[2]float32) {
 
 	xVec2ToFloat(x.GoPointer(), DestVar)
@@ -284,42 +294,42 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVec2One, libs, "graphene_vec2_one")
-	core.PuregoSafeRegister(&xVec2XAxis, libs, "graphene_vec2_x_axis")
-	core.PuregoSafeRegister(&xVec2YAxis, libs, "graphene_vec2_y_axis")
-	core.PuregoSafeRegister(&xVec2Zero, libs, "graphene_vec2_zero")
+	core.PuregoRegisterSince(&xVec2One, libs, "graphene_vec2_one", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2XAxis, libs, "graphene_vec2_x_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2YAxis, libs, "graphene_vec2_y_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Zero, libs, "graphene_vec2_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xVec2GLibType, libs, "graphene_vec2_get_type")
 
-	core.PuregoSafeRegister(&xVec2Alloc, libs, "graphene_vec2_alloc")
-
-	core.PuregoSafeRegister(&xVec2Add, libs, "graphene_vec2_add")
-	core.PuregoSafeRegister(&xVec2Divide, libs, "graphene_vec2_divide")
-	core.PuregoSafeRegister(&xVec2Dot, libs, "graphene_vec2_dot")
-	core.PuregoSafeRegister(&xVec2Equal, libs, "graphene_vec2_equal")
-	core.PuregoSafeRegister(&xVec2Free, libs, "graphene_vec2_free")
-	core.PuregoSafeRegister(&xVec2GetX, libs, "graphene_vec2_get_x")
-	core.PuregoSafeRegister(&xVec2GetY, libs, "graphene_vec2_get_y")
-	core.PuregoSafeRegister(&xVec2Init, libs, "graphene_vec2_init")
-	core.PuregoSafeRegister(&xVec2InitFromFloat, libs, "graphene_vec2_init_from_float")
-	core.PuregoSafeRegister(&xVec2InitFromVec2, libs, "graphene_vec2_init_from_vec2")
-	core.PuregoSafeRegister(&xVec2Interpolate, libs, "graphene_vec2_interpolate")
-	core.PuregoSafeRegister(&xVec2Length, libs, "graphene_vec2_length")
-	core.PuregoSafeRegister(&xVec2Max, libs, "graphene_vec2_max")
-	core.PuregoSafeRegister(&xVec2Min, libs, "graphene_vec2_min")
-	core.PuregoSafeRegister(&xVec2Multiply, libs, "graphene_vec2_multiply")
-	core.PuregoSafeRegister(&xVec2Near, libs, "graphene_vec2_near")
-	core.PuregoSafeRegister(&xVec2Negate, libs, "graphene_vec2_negate")
-	core.PuregoSafeRegister(&xVec2Normalize, libs, "graphene_vec2_normalize")
-	core.PuregoSafeRegister(&xVec2Scale, libs, "graphene_vec2_scale")
-	core.PuregoSafeRegister(&xVec2Subtract, libs, "graphene_vec2_subtract")
-	core.PuregoSafeRegister(&xVec2ToFloat, libs, "graphene_vec2_to_float")
+	core.PuregoRegisterSince(&xVec2Alloc, libs, "graphene_vec2_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xVec2Add, libs, "graphene_vec2_add", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Divide, libs, "graphene_vec2_divide", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Dot, libs, "graphene_vec2_dot", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Equal, libs, "graphene_vec2_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec2Free, libs, "graphene_vec2_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2GetX, libs, "graphene_vec2_get_x", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2GetY, libs, "graphene_vec2_get_y", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Init, libs, "graphene_vec2_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2InitFromFloat, libs, "graphene_vec2_init_from_float", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2InitFromVec2, libs, "graphene_vec2_init_from_vec2", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Interpolate, libs, "graphene_vec2_interpolate", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xVec2Length, libs, "graphene_vec2_length", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Max, libs, "graphene_vec2_max", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Min, libs, "graphene_vec2_min", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Multiply, libs, "graphene_vec2_multiply", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Near, libs, "graphene_vec2_near", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec2Negate, libs, "graphene_vec2_negate", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec2Normalize, libs, "graphene_vec2_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2Scale, libs, "graphene_vec2_scale", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec2Subtract, libs, "graphene_vec2_subtract", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec2ToFloat, libs, "graphene_vec2_to_float", "GRAPHENE", "1.0")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type Vec3 struct {
 	_ structs.HostLayout
 
-	Value uintptr
+	Value Simd4F
 }
 
 var xVec3GLibType func() types.GType
@@ -30,13 +30,23 @@ func (x *Vec3) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Vec3 made with g_boxed_copy, release it with Free.
+func (x *Vec3) Copy() *Vec3 {
+	cret := gobject.BoxedCopy(Vec3GLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Vec3)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xVec3Alloc func() *Vec3
 
 // Allocates a new #graphene_vec3_t structure.
 //
 // The contents of the returned structure are undefined.
 //
-// Use graphene_vec3_init() to initialize the vector.
+// Use [Vec3.Init] to initialize the vector.
 func Vec3Alloc() *Vec3 {
 
 	cret := xVec3Alloc()
@@ -63,9 +73,9 @@ func (x *Vec3) Cross(BVar *Vec3, ResVar *Vec3) {
 
 var xVec3Divide func(uintptr, *Vec3, *Vec3)
 
-// Divides each component of the first operand @a by the corresponding
-// component of the second operand @b, and places the results into the
-// vector @res.
+// Divides each component of the first operand a by the corresponding
+// component of the second operand b, and places the results into the
+// vector res.
 func (x *Vec3) Divide(BVar *Vec3, ResVar *Vec3) {
 
 	xVec3Divide(x.GoPointer(), BVar, ResVar)
@@ -92,7 +102,7 @@ func (x *Vec3) Equal(V2Var *Vec3) bool {
 
 var xVec3Free func(uintptr)
 
-// Frees the resources allocated by @v
+// Frees the resources allocated by v
 func (x *Vec3) Free() {
 
 	xVec3Free(x.GoPointer())
@@ -101,7 +111,7 @@ func (x *Vec3) Free() {
 
 var xVec3GetX func(uintptr) float32
 
-// Retrieves the first component of the given vector @v.
+// Retrieves the first component of the given vector v.
 func (x *Vec3) GetX() float32 {
 
 	cret := xVec3GetX(x.GoPointer())
@@ -150,7 +160,7 @@ func (x *Vec3) GetXyz1(ResVar *Vec4) {
 
 var xVec3GetXyzw func(uintptr, float32, *Vec4)
 
-// Converts a #graphene_vec3_t in a #graphene_vec4_t using @w as
+// Converts a #graphene_vec3_t in a #graphene_vec4_t using w as
 // the value of the fourth component of the resulting vector.
 func (x *Vec3) GetXyzw(WVar float32, ResVar *Vec4) {
 
@@ -160,7 +170,7 @@ func (x *Vec3) GetXyzw(WVar float32, ResVar *Vec4) {
 
 var xVec3GetY func(uintptr) float32
 
-// Retrieves the second component of the given vector @v.
+// Retrieves the second component of the given vector v.
 func (x *Vec3) GetY() float32 {
 
 	cret := xVec3GetY(x.GoPointer())
@@ -169,7 +179,7 @@ func (x *Vec3) GetY() float32 {
 
 var xVec3GetZ func(uintptr) float32
 
-// Retrieves the third component of the given vector @v.
+// Retrieves the third component of the given vector v.
 func (x *Vec3) GetZ() float32 {
 
 	cret := xVec3GetZ(x.GoPointer())
@@ -208,7 +218,7 @@ func (x *Vec3) InitFromVec3(SrcVar *Vec3) *Vec3 {
 
 var xVec3Interpolate func(uintptr, *Vec3, float64, *Vec3)
 
-// Linearly interpolates @v1 and @v2 using the given @factor.
+// Linearly interpolates v1 and v2 using the given factor.
 func (x *Vec3) Interpolate(V2Var *Vec3, FactorVar float64, ResVar *Vec3) {
 
 	xVec3Interpolate(x.GoPointer(), V2Var, FactorVar, ResVar)
@@ -217,7 +227,7 @@ func (x *Vec3) Interpolate(V2Var *Vec3, FactorVar float64, ResVar *Vec3) {
 
 var xVec3Length func(uintptr) float32
 
-// Retrieves the length of the given vector @v.
+// Retrieves the length of the given vector v.
 func (x *Vec3) Length() float32 {
 
 	cret := xVec3Length(x.GoPointer())
@@ -256,7 +266,7 @@ func (x *Vec3) Multiply(BVar *Vec3, ResVar *Vec3) {
 var xVec3Near func(uintptr, *Vec3, float32) bool
 
 // Compares the two given #graphene_vec3_t vectors and checks
-// whether their values are within the given @epsilon.
+// whether their values are within the given epsilon.
 func (x *Vec3) Near(V2Var *Vec3, EpsilonVar float32) bool {
 
 	cret := xVec3Near(x.GoPointer(), V2Var, EpsilonVar)
@@ -283,7 +293,7 @@ func (x *Vec3) Normalize(ResVar *Vec3) {
 
 var xVec3Scale func(uintptr, float32, *Vec3)
 
-// Multiplies all components of the given vector with the given scalar @factor.
+// Multiplies all components of the given vector with the given scalar factor.
 func (x *Vec3) Scale(FactorVar float32, ResVar *Vec3) {
 
 	xVec3Scale(x.GoPointer(), FactorVar, ResVar)
@@ -292,9 +302,9 @@ func (x *Vec3) Scale(FactorVar float32, ResVar *Vec3) {
 
 var xVec3Subtract func(uintptr, *Vec3, *Vec3)
 
-// Subtracts from each component of the first operand @a the
-// corresponding component of the second operand @b and places
-// each result into the components of @res.
+// Subtracts from each component of the first operand a the
+// corresponding component of the second operand b and places
+// each result into the components of res.
 func (x *Vec3) Subtract(BVar *Vec3, ResVar *Vec3) {
 
 	xVec3Subtract(x.GoPointer(), BVar, ResVar)
@@ -365,50 +375,50 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVec3One, libs, "graphene_vec3_one")
-	core.PuregoSafeRegister(&xVec3XAxis, libs, "graphene_vec3_x_axis")
-	core.PuregoSafeRegister(&xVec3YAxis, libs, "graphene_vec3_y_axis")
-	core.PuregoSafeRegister(&xVec3ZAxis, libs, "graphene_vec3_z_axis")
-	core.PuregoSafeRegister(&xVec3Zero, libs, "graphene_vec3_zero")
+	core.PuregoRegisterSince(&xVec3One, libs, "graphene_vec3_one", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3XAxis, libs, "graphene_vec3_x_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3YAxis, libs, "graphene_vec3_y_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3ZAxis, libs, "graphene_vec3_z_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Zero, libs, "graphene_vec3_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xVec3GLibType, libs, "graphene_vec3_get_type")
 
-	core.PuregoSafeRegister(&xVec3Alloc, libs, "graphene_vec3_alloc")
-
-	core.PuregoSafeRegister(&xVec3Add, libs, "graphene_vec3_add")
-	core.PuregoSafeRegister(&xVec3Cross, libs, "graphene_vec3_cross")
-	core.PuregoSafeRegister(&xVec3Divide, libs, "graphene_vec3_divide")
-	core.PuregoSafeRegister(&xVec3Dot, libs, "graphene_vec3_dot")
-	core.PuregoSafeRegister(&xVec3Equal, libs, "graphene_vec3_equal")
-	core.PuregoSafeRegister(&xVec3Free, libs, "graphene_vec3_free")
-	core.PuregoSafeRegister(&xVec3GetX, libs, "graphene_vec3_get_x")
-	core.PuregoSafeRegister(&xVec3GetXy, libs, "graphene_vec3_get_xy")
-	core.PuregoSafeRegister(&xVec3GetXy0, libs, "graphene_vec3_get_xy0")
-	core.PuregoSafeRegister(&xVec3GetXyz0, libs, "graphene_vec3_get_xyz0")
-	core.PuregoSafeRegister(&xVec3GetXyz1, libs, "graphene_vec3_get_xyz1")
-	core.PuregoSafeRegister(&xVec3GetXyzw, libs, "graphene_vec3_get_xyzw")
-	core.PuregoSafeRegister(&xVec3GetY, libs, "graphene_vec3_get_y")
-	core.PuregoSafeRegister(&xVec3GetZ, libs, "graphene_vec3_get_z")
-	core.PuregoSafeRegister(&xVec3Init, libs, "graphene_vec3_init")
-	core.PuregoSafeRegister(&xVec3InitFromFloat, libs, "graphene_vec3_init_from_float")
-	core.PuregoSafeRegister(&xVec3InitFromVec3, libs, "graphene_vec3_init_from_vec3")
-	core.PuregoSafeRegister(&xVec3Interpolate, libs, "graphene_vec3_interpolate")
-	core.PuregoSafeRegister(&xVec3Length, libs, "graphene_vec3_length")
-	core.PuregoSafeRegister(&xVec3Max, libs, "graphene_vec3_max")
-	core.PuregoSafeRegister(&xVec3Min, libs, "graphene_vec3_min")
-	core.PuregoSafeRegister(&xVec3Multiply, libs, "graphene_vec3_multiply")
-	core.PuregoSafeRegister(&xVec3Near, libs, "graphene_vec3_near")
-	core.PuregoSafeRegister(&xVec3Negate, libs, "graphene_vec3_negate")
-	core.PuregoSafeRegister(&xVec3Normalize, libs, "graphene_vec3_normalize")
-	core.PuregoSafeRegister(&xVec3Scale, libs, "graphene_vec3_scale")
-	core.PuregoSafeRegister(&xVec3Subtract, libs, "graphene_vec3_subtract")
-	core.PuregoSafeRegister(&xVec3ToFloat, libs, "graphene_vec3_to_float")
+	core.PuregoRegisterSince(&xVec3Alloc, libs, "graphene_vec3_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xVec3Add, libs, "graphene_vec3_add", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Cross, libs, "graphene_vec3_cross", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Divide, libs, "graphene_vec3_divide", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Dot, libs, "graphene_vec3_dot", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Equal, libs, "graphene_vec3_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec3Free, libs, "graphene_vec3_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetX, libs, "graphene_vec3_get_x", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetXy, libs, "graphene_vec3_get_xy", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetXy0, libs, "graphene_vec3_get_xy0", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetXyz0, libs, "graphene_vec3_get_xyz0", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetXyz1, libs, "graphene_vec3_get_xyz1", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetXyzw, libs, "graphene_vec3_get_xyzw", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetY, libs, "graphene_vec3_get_y", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3GetZ, libs, "graphene_vec3_get_z", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Init, libs, "graphene_vec3_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3InitFromFloat, libs, "graphene_vec3_init_from_float", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3InitFromVec3, libs, "graphene_vec3_init_from_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Interpolate, libs, "graphene_vec3_interpolate", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xVec3Length, libs, "graphene_vec3_length", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Max, libs, "graphene_vec3_max", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Min, libs, "graphene_vec3_min", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Multiply, libs, "graphene_vec3_multiply", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Near, libs, "graphene_vec3_near", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec3Negate, libs, "graphene_vec3_negate", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec3Normalize, libs, "graphene_vec3_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3Scale, libs, "graphene_vec3_scale", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec3Subtract, libs, "graphene_vec3_subtract", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec3ToFloat, libs, "graphene_vec3_to_float", "GRAPHENE", "1.0")
 
 }
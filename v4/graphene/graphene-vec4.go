@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +17,7 @@ import (
 type Vec4 struct {
 	_ structs.HostLayout
 
-	Value uintptr
+	Value Simd4F
 }
 
 var xVec4GLibType func() types.GType
@@ -30,13 +30,23 @@ func (x *Vec4) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Vec4 made with g_boxed_copy, release it with Free.
+func (x *Vec4) Copy() *Vec4 {
+	cret := gobject.BoxedCopy(Vec4GLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Vec4)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xVec4Alloc func() *Vec4
 
 // Allocates a new #graphene_vec4_t structure.
 //
 // The contents of the returned structure are undefined.
 //
-// Use graphene_vec4_init() to initialize the vector.
+// Use [Vec4.Init] to initialize the vector.
 func Vec4Alloc() *Vec4 {
 
 	cret := xVec4Alloc()
@@ -54,9 +64,9 @@ func (x *Vec4) Add(BVar *Vec4, ResVar *Vec4) {
 
 var xVec4Divide func(uintptr, *Vec4, *Vec4)
 
-// Divides each component of the first operand @a by the corresponding
-// component of the second operand @b, and places the results into the
-// vector @res.
+// Divides each component of the first operand a by the corresponding
+// component of the second operand b, and places the results into the
+// vector res.
 func (x *Vec4) Divide(BVar *Vec4, ResVar *Vec4) {
 
 	xVec4Divide(x.GoPointer(), BVar, ResVar)
@@ -83,7 +93,7 @@ func (x *Vec4) Equal(V2Var *Vec4) bool {
 
 var xVec4Free func(uintptr)
 
-// Frees the resources allocated by @v
+// Frees the resources allocated by v
 func (x *Vec4) Free() {
 
 	xVec4Free(x.GoPointer())
@@ -169,7 +179,7 @@ func (x *Vec4) InitFromFloat(SrcVar [4]float32) *Vec4 {
 var xVec4InitFromVec2 func(uintptr, *Vec2, float32, float32) *Vec4
 
 // Initializes a #graphene_vec4_t using the components of a
-// #graphene_vec2_t and the values of @z and @w.
+// #graphene_vec2_t and the values of z and w.
 func (x *Vec4) InitFromVec2(SrcVar *Vec2, ZVar float32, WVar float32) *Vec4 {
 
 	cret := xVec4InitFromVec2(x.GoPointer(), SrcVar, ZVar, WVar)
@@ -179,7 +189,7 @@ func (x *Vec4) InitFromVec2(SrcVar *Vec2, ZVar float32, WVar float32) *Vec4 {
 var xVec4InitFromVec3 func(uintptr, *Vec3, float32) *Vec4
 
 // Initializes a #graphene_vec4_t using the components of a
-// #graphene_vec3_t and the value of @w.
+// #graphene_vec3_t and the value of w.
 func (x *Vec4) InitFromVec3(SrcVar *Vec3, WVar float32) *Vec4 {
 
 	cret := xVec4InitFromVec3(x.GoPointer(), SrcVar, WVar)
@@ -198,7 +208,7 @@ func (x *Vec4) InitFromVec4(SrcVar *Vec4) *Vec4 {
 
 var xVec4Interpolate func(uintptr, *Vec4, float64, *Vec4)
 
-// Linearly interpolates @v1 and @v2 using the given @factor.
+// Linearly interpolates v1 and v2 using the given factor.
 func (x *Vec4) Interpolate(V2Var *Vec4, FactorVar float64, ResVar *Vec4) {
 
 	xVec4Interpolate(x.GoPointer(), V2Var, FactorVar, ResVar)
@@ -246,7 +256,7 @@ func (x *Vec4) Multiply(BVar *Vec4, ResVar *Vec4) {
 var xVec4Near func(uintptr, *Vec4, float32) bool
 
 // Compares the two given #graphene_vec4_t vectors and checks
-// whether their values are within the given @epsilon.
+// whether their values are within the given epsilon.
 func (x *Vec4) Near(V2Var *Vec4, EpsilonVar float32) bool {
 
 	cret := xVec4Near(x.GoPointer(), V2Var, EpsilonVar)
@@ -273,7 +283,7 @@ func (x *Vec4) Normalize(ResVar *Vec4) {
 
 var xVec4Scale func(uintptr, float32, *Vec4)
 
-// Multiplies all components of the given vector with the given scalar @factor.
+// Multiplies all components of the given vector with the given scalar factor.
 func (x *Vec4) Scale(FactorVar float32, ResVar *Vec4) {
 
 	xVec4Scale(x.GoPointer(), FactorVar, ResVar)
@@ -282,9 +292,9 @@ func (x *Vec4) Scale(FactorVar float32, ResVar *Vec4) {
 
 var xVec4Subtract func(uintptr, *Vec4, *Vec4)
 
-// Subtracts from each component of the first operand @a the
-// corresponding component of the second operand @b and places
-// each result into the components of @res.
+// Subtracts from each component of the first operand a the
+// corresponding component of the second operand b and places
+// each result into the components of res.
 func (x *Vec4) Subtract(BVar *Vec4, ResVar *Vec4) {
 
 	xVec4Subtract(x.GoPointer(), BVar, ResVar)
@@ -366,50 +376,50 @@ func init() {
 	core.SetSharedLibraries("GRAPHENE", []string{"libgraphene-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GRAPHENE") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xVec4One, libs, "graphene_vec4_one")
-	core.PuregoSafeRegister(&xVec4WAxis, libs, "graphene_vec4_w_axis")
-	core.PuregoSafeRegister(&xVec4XAxis, libs, "graphene_vec4_x_axis")
-	core.PuregoSafeRegister(&xVec4YAxis, libs, "graphene_vec4_y_axis")
-	core.PuregoSafeRegister(&xVec4ZAxis, libs, "graphene_vec4_z_axis")
-	core.PuregoSafeRegister(&xVec4Zero, libs, "graphene_vec4_zero")
+	core.PuregoRegisterSince(&xVec4One, libs, "graphene_vec4_one", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4WAxis, libs, "graphene_vec4_w_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4XAxis, libs, "graphene_vec4_x_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4YAxis, libs, "graphene_vec4_y_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4ZAxis, libs, "graphene_vec4_z_axis", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Zero, libs, "graphene_vec4_zero", "GRAPHENE", "1.0")
 
 	core.PuregoSafeRegister(&xVec4GLibType, libs, "graphene_vec4_get_type")
 
-	core.PuregoSafeRegister(&xVec4Alloc, libs, "graphene_vec4_alloc")
-
-	core.PuregoSafeRegister(&xVec4Add, libs, "graphene_vec4_add")
-	core.PuregoSafeRegister(&xVec4Divide, libs, "graphene_vec4_divide")
-	core.PuregoSafeRegister(&xVec4Dot, libs, "graphene_vec4_dot")
-	core.PuregoSafeRegister(&xVec4Equal, libs, "graphene_vec4_equal")
-	core.PuregoSafeRegister(&xVec4Free, libs, "graphene_vec4_free")
-	core.PuregoSafeRegister(&xVec4GetW, libs, "graphene_vec4_get_w")
-	core.PuregoSafeRegister(&xVec4GetX, libs, "graphene_vec4_get_x")
-	core.PuregoSafeRegister(&xVec4GetXy, libs, "graphene_vec4_get_xy")
-	core.PuregoSafeRegister(&xVec4GetXyz, libs, "graphene_vec4_get_xyz")
-	core.PuregoSafeRegister(&xVec4GetY, libs, "graphene_vec4_get_y")
-	core.PuregoSafeRegister(&xVec4GetZ, libs, "graphene_vec4_get_z")
-	core.PuregoSafeRegister(&xVec4Init, libs, "graphene_vec4_init")
-	core.PuregoSafeRegister(&xVec4InitFromFloat, libs, "graphene_vec4_init_from_float")
-	core.PuregoSafeRegister(&xVec4InitFromVec2, libs, "graphene_vec4_init_from_vec2")
-	core.PuregoSafeRegister(&xVec4InitFromVec3, libs, "graphene_vec4_init_from_vec3")
-	core.PuregoSafeRegister(&xVec4InitFromVec4, libs, "graphene_vec4_init_from_vec4")
-	core.PuregoSafeRegister(&xVec4Interpolate, libs, "graphene_vec4_interpolate")
-	core.PuregoSafeRegister(&xVec4Length, libs, "graphene_vec4_length")
-	core.PuregoSafeRegister(&xVec4Max, libs, "graphene_vec4_max")
-	core.PuregoSafeRegister(&xVec4Min, libs, "graphene_vec4_min")
-	core.PuregoSafeRegister(&xVec4Multiply, libs, "graphene_vec4_multiply")
-	core.PuregoSafeRegister(&xVec4Near, libs, "graphene_vec4_near")
-	core.PuregoSafeRegister(&xVec4Negate, libs, "graphene_vec4_negate")
-	core.PuregoSafeRegister(&xVec4Normalize, libs, "graphene_vec4_normalize")
-	core.PuregoSafeRegister(&xVec4Scale, libs, "graphene_vec4_scale")
-	core.PuregoSafeRegister(&xVec4Subtract, libs, "graphene_vec4_subtract")
-	core.PuregoSafeRegister(&xVec4ToFloat, libs, "graphene_vec4_to_float")
+	core.PuregoRegisterSince(&xVec4Alloc, libs, "graphene_vec4_alloc", "GRAPHENE", "1.0")
+
+	core.PuregoRegisterSince(&xVec4Add, libs, "graphene_vec4_add", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Divide, libs, "graphene_vec4_divide", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Dot, libs, "graphene_vec4_dot", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Equal, libs, "graphene_vec4_equal", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec4Free, libs, "graphene_vec4_free", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetW, libs, "graphene_vec4_get_w", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetX, libs, "graphene_vec4_get_x", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetXy, libs, "graphene_vec4_get_xy", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetXyz, libs, "graphene_vec4_get_xyz", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetY, libs, "graphene_vec4_get_y", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4GetZ, libs, "graphene_vec4_get_z", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Init, libs, "graphene_vec4_init", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4InitFromFloat, libs, "graphene_vec4_init_from_float", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4InitFromVec2, libs, "graphene_vec4_init_from_vec2", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4InitFromVec3, libs, "graphene_vec4_init_from_vec3", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4InitFromVec4, libs, "graphene_vec4_init_from_vec4", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Interpolate, libs, "graphene_vec4_interpolate", "GRAPHENE", "1.10")
+	core.PuregoRegisterSince(&xVec4Length, libs, "graphene_vec4_length", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Max, libs, "graphene_vec4_max", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Min, libs, "graphene_vec4_min", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Multiply, libs, "graphene_vec4_multiply", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Near, libs, "graphene_vec4_near", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec4Negate, libs, "graphene_vec4_negate", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec4Normalize, libs, "graphene_vec4_normalize", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4Scale, libs, "graphene_vec4_scale", "GRAPHENE", "1.2")
+	core.PuregoRegisterSince(&xVec4Subtract, libs, "graphene_vec4_subtract", "GRAPHENE", "1.0")
+	core.PuregoRegisterSince(&xVec4ToFloat, libs, "graphene_vec4_to_float", "GRAPHENE", "1.0")
 
 }
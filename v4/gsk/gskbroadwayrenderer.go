@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -20,7 +18,7 @@ func (x *BroadwayRendererClass) GoPointer() uintptr {
 
 // A Broadway based renderer.
 //
-// See [class@Gsk.Renderer].
+// See [Renderer].
 type BroadwayRenderer struct {
 	Renderer
 }
@@ -37,29 +35,6 @@ func BroadwayRendererNewFromInternalPtr(ptr uintptr) *BroadwayRenderer {
 	return cls
 }
 
-var xNewBroadwayRenderer func() uintptr
-
-// Creates a new Broadway renderer.
-//
-// The Broadway renderer is the default renderer for the broadway backend.
-// It will only work with broadway surfaces, otherwise it will fail the
-// call to gsk_renderer_realize().
-//
-// This function is only available when GTK was compiled with Broadway
-// support.
-func NewBroadwayRenderer() *BroadwayRenderer {
-	var cls *BroadwayRenderer
-
-	cret := xNewBroadwayRenderer()
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &BroadwayRenderer{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *BroadwayRenderer) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -70,21 +45,3 @@ func (c *BroadwayRenderer) GoPointer() uintptr {
 func (c *BroadwayRenderer) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
-
-func init() {
-	core.SetPackageName("GSK", "gtk4")
-	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xBroadwayRendererGLibType, libs, "gsk_broadway_renderer_get_type")
-
-	core.PuregoSafeRegister(&xNewBroadwayRenderer, libs, "gsk_broadway_renderer_new")
-
-}
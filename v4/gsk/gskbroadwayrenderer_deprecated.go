@@ -0,0 +1,49 @@
+//go:build !puregotk_no_deprecated
+
+// Package gsk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gsk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewBroadwayRenderer func() uintptr
+
+// Creates a new Broadway renderer.
+//
+// The Broadway renderer is the default renderer for the broadway backend.
+// It will only work with broadway surfaces, otherwise it will fail the
+// call to [Renderer.Realize].
+//
+// This function is only available when GTK was compiled with Broadway
+// support.
+//
+// Deprecated: since 4.20. Broadway will be retired in GTK 5
+func NewBroadwayRenderer() *BroadwayRenderer {
+	var cls *BroadwayRenderer
+
+	cret := xNewBroadwayRenderer()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &BroadwayRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GSK", "gtk4")
+	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewBroadwayRenderer, libs, "gsk_broadway_renderer_new", "GSK", "")
+
+}
@@ -2,7 +2,6 @@
 package gsk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -20,13 +19,13 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xComponentTransferEqual, libs, "gsk_component_transfer_equal")
+	core.PuregoRegisterSince(&xComponentTransferEqual, libs, "gsk_component_transfer_equal", "GSK", "4.20")
 
 }
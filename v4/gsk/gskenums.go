@@ -1,14 +1,19 @@
 // Package gsk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gsk
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 // The blend modes available for render nodes.
 //
 // The implementation of each blend mode is deferred to the
 // rendering pipeline.
 //
-// See &lt;https://www.w3.org/TR/compositing-1/#blending&gt; for more information
+// See <https://www.w3.org/TR/compositing-1/#blending> for more information
 // on blending and blend modes.
 type BlendMode int
 
@@ -57,6 +62,123 @@ const (
 	BlendModeLuminosityValue BlendMode = 15
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x BlendMode) String() string {
+	switch x {
+	case BlendModeDefaultValue:
+		return "GSK_BLEND_MODE_DEFAULT"
+	case BlendModeMultiplyValue:
+		return "GSK_BLEND_MODE_MULTIPLY"
+	case BlendModeScreenValue:
+		return "GSK_BLEND_MODE_SCREEN"
+	case BlendModeOverlayValue:
+		return "GSK_BLEND_MODE_OVERLAY"
+	case BlendModeDarkenValue:
+		return "GSK_BLEND_MODE_DARKEN"
+	case BlendModeLightenValue:
+		return "GSK_BLEND_MODE_LIGHTEN"
+	case BlendModeColorDodgeValue:
+		return "GSK_BLEND_MODE_COLOR_DODGE"
+	case BlendModeColorBurnValue:
+		return "GSK_BLEND_MODE_COLOR_BURN"
+	case BlendModeHardLightValue:
+		return "GSK_BLEND_MODE_HARD_LIGHT"
+	case BlendModeSoftLightValue:
+		return "GSK_BLEND_MODE_SOFT_LIGHT"
+	case BlendModeDifferenceValue:
+		return "GSK_BLEND_MODE_DIFFERENCE"
+	case BlendModeExclusionValue:
+		return "GSK_BLEND_MODE_EXCLUSION"
+	case BlendModeColorValue:
+		return "GSK_BLEND_MODE_COLOR"
+	case BlendModeHueValue:
+		return "GSK_BLEND_MODE_HUE"
+	case BlendModeSaturationValue:
+		return "GSK_BLEND_MODE_SATURATION"
+	case BlendModeLuminosityValue:
+		return "GSK_BLEND_MODE_LUMINOSITY"
+	}
+	return fmt.Sprintf("BlendMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BlendMode) Nick() string {
+	switch x {
+	case BlendModeDefaultValue:
+		return "default"
+	case BlendModeMultiplyValue:
+		return "multiply"
+	case BlendModeScreenValue:
+		return "screen"
+	case BlendModeOverlayValue:
+		return "overlay"
+	case BlendModeDarkenValue:
+		return "darken"
+	case BlendModeLightenValue:
+		return "lighten"
+	case BlendModeColorDodgeValue:
+		return "color-dodge"
+	case BlendModeColorBurnValue:
+		return "color-burn"
+	case BlendModeHardLightValue:
+		return "hard-light"
+	case BlendModeSoftLightValue:
+		return "soft-light"
+	case BlendModeDifferenceValue:
+		return "difference"
+	case BlendModeExclusionValue:
+		return "exclusion"
+	case BlendModeColorValue:
+		return "color"
+	case BlendModeHueValue:
+		return "hue"
+	case BlendModeSaturationValue:
+		return "saturation"
+	case BlendModeLuminosityValue:
+		return "luminosity"
+	}
+	return ""
+}
+
+// BlendModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BlendModeFromString(s string) (BlendMode, bool) {
+	switch s {
+	case "GSK_BLEND_MODE_DEFAULT", "default":
+		return BlendModeDefaultValue, true
+	case "GSK_BLEND_MODE_MULTIPLY", "multiply":
+		return BlendModeMultiplyValue, true
+	case "GSK_BLEND_MODE_SCREEN", "screen":
+		return BlendModeScreenValue, true
+	case "GSK_BLEND_MODE_OVERLAY", "overlay":
+		return BlendModeOverlayValue, true
+	case "GSK_BLEND_MODE_DARKEN", "darken":
+		return BlendModeDarkenValue, true
+	case "GSK_BLEND_MODE_LIGHTEN", "lighten":
+		return BlendModeLightenValue, true
+	case "GSK_BLEND_MODE_COLOR_DODGE", "color-dodge":
+		return BlendModeColorDodgeValue, true
+	case "GSK_BLEND_MODE_COLOR_BURN", "color-burn":
+		return BlendModeColorBurnValue, true
+	case "GSK_BLEND_MODE_HARD_LIGHT", "hard-light":
+		return BlendModeHardLightValue, true
+	case "GSK_BLEND_MODE_SOFT_LIGHT", "soft-light":
+		return BlendModeSoftLightValue, true
+	case "GSK_BLEND_MODE_DIFFERENCE", "difference":
+		return BlendModeDifferenceValue, true
+	case "GSK_BLEND_MODE_EXCLUSION", "exclusion":
+		return BlendModeExclusionValue, true
+	case "GSK_BLEND_MODE_COLOR", "color":
+		return BlendModeColorValue, true
+	case "GSK_BLEND_MODE_HUE", "hue":
+		return BlendModeHueValue, true
+	case "GSK_BLEND_MODE_SATURATION", "saturation":
+		return BlendModeSaturationValue, true
+	case "GSK_BLEND_MODE_LUMINOSITY", "luminosity":
+		return BlendModeLuminosityValue, true
+	}
+	return 0, false
+}
+
 // The corner indices used by `GskRoundedRect`.
 type Corner int
 
@@ -78,6 +200,51 @@ const (
 	CornerBottomLeftValue Corner = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Corner) String() string {
+	switch x {
+	case CornerTopLeftValue:
+		return "GSK_CORNER_TOP_LEFT"
+	case CornerTopRightValue:
+		return "GSK_CORNER_TOP_RIGHT"
+	case CornerBottomRightValue:
+		return "GSK_CORNER_BOTTOM_RIGHT"
+	case CornerBottomLeftValue:
+		return "GSK_CORNER_BOTTOM_LEFT"
+	}
+	return fmt.Sprintf("Corner(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Corner) Nick() string {
+	switch x {
+	case CornerTopLeftValue:
+		return "top-left"
+	case CornerTopRightValue:
+		return "top-right"
+	case CornerBottomRightValue:
+		return "bottom-right"
+	case CornerBottomLeftValue:
+		return "bottom-left"
+	}
+	return ""
+}
+
+// CornerFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CornerFromString(s string) (Corner, bool) {
+	switch s {
+	case "GSK_CORNER_TOP_LEFT", "top-left":
+		return CornerTopLeftValue, true
+	case "GSK_CORNER_TOP_RIGHT", "top-right":
+		return CornerTopRightValue, true
+	case "GSK_CORNER_BOTTOM_RIGHT", "bottom-right":
+		return CornerBottomRightValue, true
+	case "GSK_CORNER_BOTTOM_LEFT", "bottom-left":
+		return CornerBottomLeftValue, true
+	}
+	return 0, false
+}
+
 // Specifies how paths are filled.
 //
 // Whether or not a point is included in the fill is determined by taking
@@ -113,10 +280,45 @@ const (
 	FillRuleEvenOddValue FillRule = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FillRule) String() string {
+	switch x {
+	case FillRuleWindingValue:
+		return "GSK_FILL_RULE_WINDING"
+	case FillRuleEvenOddValue:
+		return "GSK_FILL_RULE_EVEN_ODD"
+	}
+	return fmt.Sprintf("FillRule(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FillRule) Nick() string {
+	switch x {
+	case FillRuleWindingValue:
+		return "winding"
+	case FillRuleEvenOddValue:
+		return "even-odd"
+	}
+	return ""
+}
+
+// FillRuleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FillRuleFromString(s string) (FillRule, bool) {
+	switch s {
+	case "GSK_FILL_RULE_WINDING", "winding":
+		return FillRuleWindingValue, true
+	case "GSK_FILL_RULE_EVEN_ODD", "even-odd":
+		return FillRuleEvenOddValue, true
+	}
+	return 0, false
+}
+
 // Defines the types of the uniforms that `GskGLShaders` declare.
 //
 // It defines both what the type is called in the GLSL shader
 // code, and what the corresponding C type is on the Gtk side.
+//
+// Deprecated: since 4.16. do not use it in new code.
 type GLUniformType int
 
 var xGLUniformTypeGLibType func() types.GType
@@ -145,6 +347,75 @@ const (
 	GlUniformTypeVec4Value GLUniformType = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x GLUniformType) String() string {
+	switch x {
+	case GlUniformTypeNoneValue:
+		return "GSK_GL_UNIFORM_TYPE_NONE"
+	case GlUniformTypeFloatValue:
+		return "GSK_GL_UNIFORM_TYPE_FLOAT"
+	case GlUniformTypeIntValue:
+		return "GSK_GL_UNIFORM_TYPE_INT"
+	case GlUniformTypeUintValue:
+		return "GSK_GL_UNIFORM_TYPE_UINT"
+	case GlUniformTypeBoolValue:
+		return "GSK_GL_UNIFORM_TYPE_BOOL"
+	case GlUniformTypeVec2Value:
+		return "GSK_GL_UNIFORM_TYPE_VEC2"
+	case GlUniformTypeVec3Value:
+		return "GSK_GL_UNIFORM_TYPE_VEC3"
+	case GlUniformTypeVec4Value:
+		return "GSK_GL_UNIFORM_TYPE_VEC4"
+	}
+	return fmt.Sprintf("GLUniformType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x GLUniformType) Nick() string {
+	switch x {
+	case GlUniformTypeNoneValue:
+		return "none"
+	case GlUniformTypeFloatValue:
+		return "float"
+	case GlUniformTypeIntValue:
+		return "int"
+	case GlUniformTypeUintValue:
+		return "uint"
+	case GlUniformTypeBoolValue:
+		return "bool"
+	case GlUniformTypeVec2Value:
+		return "vec2"
+	case GlUniformTypeVec3Value:
+		return "vec3"
+	case GlUniformTypeVec4Value:
+		return "vec4"
+	}
+	return ""
+}
+
+// GLUniformTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GLUniformTypeFromString(s string) (GLUniformType, bool) {
+	switch s {
+	case "GSK_GL_UNIFORM_TYPE_NONE", "none":
+		return GlUniformTypeNoneValue, true
+	case "GSK_GL_UNIFORM_TYPE_FLOAT", "float":
+		return GlUniformTypeFloatValue, true
+	case "GSK_GL_UNIFORM_TYPE_INT", "int":
+		return GlUniformTypeIntValue, true
+	case "GSK_GL_UNIFORM_TYPE_UINT", "uint":
+		return GlUniformTypeUintValue, true
+	case "GSK_GL_UNIFORM_TYPE_BOOL", "bool":
+		return GlUniformTypeBoolValue, true
+	case "GSK_GL_UNIFORM_TYPE_VEC2", "vec2":
+		return GlUniformTypeVec2Value, true
+	case "GSK_GL_UNIFORM_TYPE_VEC3", "vec3":
+		return GlUniformTypeVec3Value, true
+	case "GSK_GL_UNIFORM_TYPE_VEC4", "vec4":
+		return GlUniformTypeVec4Value, true
+	}
+	return 0, false
+}
+
 // Specifies how to render the start and end points of contours or
 // dashes when stroking.
 //
@@ -152,15 +423,11 @@ const (
 //
 // New entries may be added in future versions.
 //
-// &lt;figure&gt;
+// <figure>
 //
-//	&lt;picture&gt;
-//	  &lt;source srcset="caps-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img alt="Line Cap Styles" src="caps-light.png"&gt;
-//	&lt;/picture&gt;
-//	&lt;figcaption&gt;GSK_LINE_CAP_BUTT, GSK_LINE_CAP_ROUND, GSK_LINE_CAP_SQUARE&lt;/figcaption&gt;
+//	<figcaption>GSK_LINE_CAP_BUTT, GSK_LINE_CAP_ROUND, GSK_LINE_CAP_SQUARE</figcaption>
 //
-// &lt;/figure&gt;
+// </figure>
 type LineCap int
 
 var xLineCapGLibType func() types.GType
@@ -182,21 +449,56 @@ const (
 	LineCapSquareValue LineCap = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LineCap) String() string {
+	switch x {
+	case LineCapButtValue:
+		return "GSK_LINE_CAP_BUTT"
+	case LineCapRoundValue:
+		return "GSK_LINE_CAP_ROUND"
+	case LineCapSquareValue:
+		return "GSK_LINE_CAP_SQUARE"
+	}
+	return fmt.Sprintf("LineCap(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LineCap) Nick() string {
+	switch x {
+	case LineCapButtValue:
+		return "butt"
+	case LineCapRoundValue:
+		return "round"
+	case LineCapSquareValue:
+		return "square"
+	}
+	return ""
+}
+
+// LineCapFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LineCapFromString(s string) (LineCap, bool) {
+	switch s {
+	case "GSK_LINE_CAP_BUTT", "butt":
+		return LineCapButtValue, true
+	case "GSK_LINE_CAP_ROUND", "round":
+		return LineCapRoundValue, true
+	case "GSK_LINE_CAP_SQUARE", "square":
+		return LineCapSquareValue, true
+	}
+	return 0, false
+}
+
 // Specifies how to render the junction of two lines when stroking.
 //
 // The default line join style is `GSK_LINE_JOIN_MITER`.
 //
 // New entries may be added in future versions.
 //
-// &lt;figure&gt;
+// <figure>
 //
-//	&lt;picture&gt;
-//	  &lt;source srcset="join-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	  &lt;img alt="Line Join Styles" src="join-light.png"&gt;
-//	&lt;/picture&gt;
-//	&lt;figcaption&gt;GSK_LINE_JOINT_MITER, GSK_LINE_JOINT_ROUND, GSK_LINE_JOIN_BEVEL&lt;/figcaption&gt;
+//	<figcaption>GSK_LINE_JOINT_MITER, GSK_LINE_JOINT_ROUND, GSK_LINE_JOIN_BEVEL</figcaption>
 //
-// &lt;/figure&gt;
+// </figure>
 type LineJoin int
 
 var xLineJoinGLibType func() types.GType
@@ -217,6 +519,45 @@ const (
 	LineJoinBevelValue LineJoin = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LineJoin) String() string {
+	switch x {
+	case LineJoinMiterValue:
+		return "GSK_LINE_JOIN_MITER"
+	case LineJoinRoundValue:
+		return "GSK_LINE_JOIN_ROUND"
+	case LineJoinBevelValue:
+		return "GSK_LINE_JOIN_BEVEL"
+	}
+	return fmt.Sprintf("LineJoin(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LineJoin) Nick() string {
+	switch x {
+	case LineJoinMiterValue:
+		return "miter"
+	case LineJoinRoundValue:
+		return "round"
+	case LineJoinBevelValue:
+		return "bevel"
+	}
+	return ""
+}
+
+// LineJoinFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LineJoinFromString(s string) (LineJoin, bool) {
+	switch s {
+	case "GSK_LINE_JOIN_MITER", "miter":
+		return LineJoinMiterValue, true
+	case "GSK_LINE_JOIN_ROUND", "round":
+		return LineJoinRoundValue, true
+	case "GSK_LINE_JOIN_BEVEL", "bevel":
+		return LineJoinBevelValue, true
+	}
+	return 0, false
+}
+
 // The mask modes available for mask nodes.
 type MaskMode int
 
@@ -240,18 +581,56 @@ const (
 	MaskModeInvertedLuminanceValue MaskMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x MaskMode) String() string {
+	switch x {
+	case MaskModeAlphaValue:
+		return "GSK_MASK_MODE_ALPHA"
+	case MaskModeInvertedAlphaValue:
+		return "GSK_MASK_MODE_INVERTED_ALPHA"
+	case MaskModeLuminanceValue:
+		return "GSK_MASK_MODE_LUMINANCE"
+	case MaskModeInvertedLuminanceValue:
+		return "GSK_MASK_MODE_INVERTED_LUMINANCE"
+	}
+	return fmt.Sprintf("MaskMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MaskMode) Nick() string {
+	switch x {
+	case MaskModeAlphaValue:
+		return "alpha"
+	case MaskModeInvertedAlphaValue:
+		return "inverted-alpha"
+	case MaskModeLuminanceValue:
+		return "luminance"
+	case MaskModeInvertedLuminanceValue:
+		return "inverted-luminance"
+	}
+	return ""
+}
+
+// MaskModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MaskModeFromString(s string) (MaskMode, bool) {
+	switch s {
+	case "GSK_MASK_MODE_ALPHA", "alpha":
+		return MaskModeAlphaValue, true
+	case "GSK_MASK_MODE_INVERTED_ALPHA", "inverted-alpha":
+		return MaskModeInvertedAlphaValue, true
+	case "GSK_MASK_MODE_LUMINANCE", "luminance":
+		return MaskModeLuminanceValue, true
+	case "GSK_MASK_MODE_INVERTED_LUMINANCE", "inverted-luminance":
+		return MaskModeInvertedLuminanceValue, true
+	}
+	return 0, false
+}
+
 // Used to pick one of the four tangents at a given point on the path.
 //
 // Note that the directions for @GSK_PATH_FROM_START/@GSK_PATH_TO_END and
 // @GSK_PATH_TO_START/@GSK_PATH_FROM_END will coincide for smooth points.
 // Only sharp turns will exhibit four different directions.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="directions-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Path Tangents" src="directions-light.png"&gt;
-//
-// &lt;/picture&gt;
 type PathDirection int
 
 var xPathDirectionGLibType func() types.GType
@@ -276,6 +655,51 @@ const (
 	PathFromEndValue PathDirection = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PathDirection) String() string {
+	switch x {
+	case PathFromStartValue:
+		return "GSK_PATH_FROM_START"
+	case PathToStartValue:
+		return "GSK_PATH_TO_START"
+	case PathToEndValue:
+		return "GSK_PATH_TO_END"
+	case PathFromEndValue:
+		return "GSK_PATH_FROM_END"
+	}
+	return fmt.Sprintf("PathDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PathDirection) Nick() string {
+	switch x {
+	case PathFromStartValue:
+		return "from-start"
+	case PathToStartValue:
+		return "to-start"
+	case PathToEndValue:
+		return "to-end"
+	case PathFromEndValue:
+		return "from-end"
+	}
+	return ""
+}
+
+// PathDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PathDirectionFromString(s string) (PathDirection, bool) {
+	switch s {
+	case "GSK_PATH_FROM_START", "from-start":
+		return PathFromStartValue, true
+	case "GSK_PATH_TO_START", "to-start":
+		return PathToStartValue, true
+	case "GSK_PATH_TO_END", "to-end":
+		return PathToEndValue, true
+	case "GSK_PATH_FROM_END", "from-end":
+		return PathFromEndValue, true
+	}
+	return 0, false
+}
+
 // Describes the segments of a `GskPath`.
 //
 // More values may be added in the future.
@@ -311,6 +735,63 @@ const (
 	PathConicValue PathOperation = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PathOperation) String() string {
+	switch x {
+	case PathMoveValue:
+		return "GSK_PATH_MOVE"
+	case PathCloseValue:
+		return "GSK_PATH_CLOSE"
+	case PathLineValue:
+		return "GSK_PATH_LINE"
+	case PathQuadValue:
+		return "GSK_PATH_QUAD"
+	case PathCubicValue:
+		return "GSK_PATH_CUBIC"
+	case PathConicValue:
+		return "GSK_PATH_CONIC"
+	}
+	return fmt.Sprintf("PathOperation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PathOperation) Nick() string {
+	switch x {
+	case PathMoveValue:
+		return "move"
+	case PathCloseValue:
+		return "close"
+	case PathLineValue:
+		return "line"
+	case PathQuadValue:
+		return "quad"
+	case PathCubicValue:
+		return "cubic"
+	case PathConicValue:
+		return "conic"
+	}
+	return ""
+}
+
+// PathOperationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PathOperationFromString(s string) (PathOperation, bool) {
+	switch s {
+	case "GSK_PATH_MOVE", "move":
+		return PathMoveValue, true
+	case "GSK_PATH_CLOSE", "close":
+		return PathCloseValue, true
+	case "GSK_PATH_LINE", "line":
+		return PathLineValue, true
+	case "GSK_PATH_QUAD", "quad":
+		return PathQuadValue, true
+	case "GSK_PATH_CUBIC", "cubic":
+		return PathCubicValue, true
+	case "GSK_PATH_CONIC", "conic":
+		return PathConicValue, true
+	}
+	return 0, false
+}
+
 // The type of a node determines what the node is rendering.
 type RenderNodeType int
 
@@ -388,6 +869,219 @@ const (
 	ComponentTransferNodeValue RenderNodeType = 31
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x RenderNodeType) String() string {
+	switch x {
+	case NotARenderNodeValue:
+		return "GSK_NOT_A_RENDER_NODE"
+	case ContainerNodeValue:
+		return "GSK_CONTAINER_NODE"
+	case CairoNodeValue:
+		return "GSK_CAIRO_NODE"
+	case ColorNodeValue:
+		return "GSK_COLOR_NODE"
+	case LinearGradientNodeValue:
+		return "GSK_LINEAR_GRADIENT_NODE"
+	case RepeatingLinearGradientNodeValue:
+		return "GSK_REPEATING_LINEAR_GRADIENT_NODE"
+	case RadialGradientNodeValue:
+		return "GSK_RADIAL_GRADIENT_NODE"
+	case RepeatingRadialGradientNodeValue:
+		return "GSK_REPEATING_RADIAL_GRADIENT_NODE"
+	case ConicGradientNodeValue:
+		return "GSK_CONIC_GRADIENT_NODE"
+	case BorderNodeValue:
+		return "GSK_BORDER_NODE"
+	case TextureNodeValue:
+		return "GSK_TEXTURE_NODE"
+	case InsetShadowNodeValue:
+		return "GSK_INSET_SHADOW_NODE"
+	case OutsetShadowNodeValue:
+		return "GSK_OUTSET_SHADOW_NODE"
+	case TransformNodeValue:
+		return "GSK_TRANSFORM_NODE"
+	case OpacityNodeValue:
+		return "GSK_OPACITY_NODE"
+	case ColorMatrixNodeValue:
+		return "GSK_COLOR_MATRIX_NODE"
+	case RepeatNodeValue:
+		return "GSK_REPEAT_NODE"
+	case ClipNodeValue:
+		return "GSK_CLIP_NODE"
+	case RoundedClipNodeValue:
+		return "GSK_ROUNDED_CLIP_NODE"
+	case ShadowNodeValue:
+		return "GSK_SHADOW_NODE"
+	case BlendNodeValue:
+		return "GSK_BLEND_NODE"
+	case CrossFadeNodeValue:
+		return "GSK_CROSS_FADE_NODE"
+	case TextNodeValue:
+		return "GSK_TEXT_NODE"
+	case BlurNodeValue:
+		return "GSK_BLUR_NODE"
+	case DebugNodeValue:
+		return "GSK_DEBUG_NODE"
+	case GlShaderNodeValue:
+		return "GSK_GL_SHADER_NODE"
+	case TextureScaleNodeValue:
+		return "GSK_TEXTURE_SCALE_NODE"
+	case MaskNodeValue:
+		return "GSK_MASK_NODE"
+	case FillNodeValue:
+		return "GSK_FILL_NODE"
+	case StrokeNodeValue:
+		return "GSK_STROKE_NODE"
+	case SubsurfaceNodeValue:
+		return "GSK_SUBSURFACE_NODE"
+	case ComponentTransferNodeValue:
+		return "GSK_COMPONENT_TRANSFER_NODE"
+	}
+	return fmt.Sprintf("RenderNodeType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RenderNodeType) Nick() string {
+	switch x {
+	case NotARenderNodeValue:
+		return "not-a-render-node"
+	case ContainerNodeValue:
+		return "container-node"
+	case CairoNodeValue:
+		return "cairo-node"
+	case ColorNodeValue:
+		return "color-node"
+	case LinearGradientNodeValue:
+		return "linear-gradient-node"
+	case RepeatingLinearGradientNodeValue:
+		return "repeating-linear-gradient-node"
+	case RadialGradientNodeValue:
+		return "radial-gradient-node"
+	case RepeatingRadialGradientNodeValue:
+		return "repeating-radial-gradient-node"
+	case ConicGradientNodeValue:
+		return "conic-gradient-node"
+	case BorderNodeValue:
+		return "border-node"
+	case TextureNodeValue:
+		return "texture-node"
+	case InsetShadowNodeValue:
+		return "inset-shadow-node"
+	case OutsetShadowNodeValue:
+		return "outset-shadow-node"
+	case TransformNodeValue:
+		return "transform-node"
+	case OpacityNodeValue:
+		return "opacity-node"
+	case ColorMatrixNodeValue:
+		return "color-matrix-node"
+	case RepeatNodeValue:
+		return "repeat-node"
+	case ClipNodeValue:
+		return "clip-node"
+	case RoundedClipNodeValue:
+		return "rounded-clip-node"
+	case ShadowNodeValue:
+		return "shadow-node"
+	case BlendNodeValue:
+		return "blend-node"
+	case CrossFadeNodeValue:
+		return "cross-fade-node"
+	case TextNodeValue:
+		return "text-node"
+	case BlurNodeValue:
+		return "blur-node"
+	case DebugNodeValue:
+		return "debug-node"
+	case GlShaderNodeValue:
+		return "gl-shader-node"
+	case TextureScaleNodeValue:
+		return "texture-scale-node"
+	case MaskNodeValue:
+		return "mask-node"
+	case FillNodeValue:
+		return "fill-node"
+	case StrokeNodeValue:
+		return "stroke-node"
+	case SubsurfaceNodeValue:
+		return "subsurface-node"
+	case ComponentTransferNodeValue:
+		return "component-transfer-node"
+	}
+	return ""
+}
+
+// RenderNodeTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RenderNodeTypeFromString(s string) (RenderNodeType, bool) {
+	switch s {
+	case "GSK_NOT_A_RENDER_NODE", "not-a-render-node":
+		return NotARenderNodeValue, true
+	case "GSK_CONTAINER_NODE", "container-node":
+		return ContainerNodeValue, true
+	case "GSK_CAIRO_NODE", "cairo-node":
+		return CairoNodeValue, true
+	case "GSK_COLOR_NODE", "color-node":
+		return ColorNodeValue, true
+	case "GSK_LINEAR_GRADIENT_NODE", "linear-gradient-node":
+		return LinearGradientNodeValue, true
+	case "GSK_REPEATING_LINEAR_GRADIENT_NODE", "repeating-linear-gradient-node":
+		return RepeatingLinearGradientNodeValue, true
+	case "GSK_RADIAL_GRADIENT_NODE", "radial-gradient-node":
+		return RadialGradientNodeValue, true
+	case "GSK_REPEATING_RADIAL_GRADIENT_NODE", "repeating-radial-gradient-node":
+		return RepeatingRadialGradientNodeValue, true
+	case "GSK_CONIC_GRADIENT_NODE", "conic-gradient-node":
+		return ConicGradientNodeValue, true
+	case "GSK_BORDER_NODE", "border-node":
+		return BorderNodeValue, true
+	case "GSK_TEXTURE_NODE", "texture-node":
+		return TextureNodeValue, true
+	case "GSK_INSET_SHADOW_NODE", "inset-shadow-node":
+		return InsetShadowNodeValue, true
+	case "GSK_OUTSET_SHADOW_NODE", "outset-shadow-node":
+		return OutsetShadowNodeValue, true
+	case "GSK_TRANSFORM_NODE", "transform-node":
+		return TransformNodeValue, true
+	case "GSK_OPACITY_NODE", "opacity-node":
+		return OpacityNodeValue, true
+	case "GSK_COLOR_MATRIX_NODE", "color-matrix-node":
+		return ColorMatrixNodeValue, true
+	case "GSK_REPEAT_NODE", "repeat-node":
+		return RepeatNodeValue, true
+	case "GSK_CLIP_NODE", "clip-node":
+		return ClipNodeValue, true
+	case "GSK_ROUNDED_CLIP_NODE", "rounded-clip-node":
+		return RoundedClipNodeValue, true
+	case "GSK_SHADOW_NODE", "shadow-node":
+		return ShadowNodeValue, true
+	case "GSK_BLEND_NODE", "blend-node":
+		return BlendNodeValue, true
+	case "GSK_CROSS_FADE_NODE", "cross-fade-node":
+		return CrossFadeNodeValue, true
+	case "GSK_TEXT_NODE", "text-node":
+		return TextNodeValue, true
+	case "GSK_BLUR_NODE", "blur-node":
+		return BlurNodeValue, true
+	case "GSK_DEBUG_NODE", "debug-node":
+		return DebugNodeValue, true
+	case "GSK_GL_SHADER_NODE", "gl-shader-node":
+		return GlShaderNodeValue, true
+	case "GSK_TEXTURE_SCALE_NODE", "texture-scale-node":
+		return TextureScaleNodeValue, true
+	case "GSK_MASK_NODE", "mask-node":
+		return MaskNodeValue, true
+	case "GSK_FILL_NODE", "fill-node":
+		return FillNodeValue, true
+	case "GSK_STROKE_NODE", "stroke-node":
+		return StrokeNodeValue, true
+	case "GSK_SUBSURFACE_NODE", "subsurface-node":
+		return SubsurfaceNodeValue, true
+	case "GSK_COMPONENT_TRANSFER_NODE", "component-transfer-node":
+		return ComponentTransferNodeValue, true
+	}
+	return 0, false
+}
+
 // The filters used when scaling texture data.
 //
 // The actual implementation of each filter is deferred to the
@@ -412,6 +1106,45 @@ const (
 	ScalingFilterTrilinearValue ScalingFilter = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScalingFilter) String() string {
+	switch x {
+	case ScalingFilterLinearValue:
+		return "GSK_SCALING_FILTER_LINEAR"
+	case ScalingFilterNearestValue:
+		return "GSK_SCALING_FILTER_NEAREST"
+	case ScalingFilterTrilinearValue:
+		return "GSK_SCALING_FILTER_TRILINEAR"
+	}
+	return fmt.Sprintf("ScalingFilter(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScalingFilter) Nick() string {
+	switch x {
+	case ScalingFilterLinearValue:
+		return "linear"
+	case ScalingFilterNearestValue:
+		return "nearest"
+	case ScalingFilterTrilinearValue:
+		return "trilinear"
+	}
+	return ""
+}
+
+// ScalingFilterFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScalingFilterFromString(s string) (ScalingFilter, bool) {
+	switch s {
+	case "GSK_SCALING_FILTER_LINEAR", "linear":
+		return ScalingFilterLinearValue, true
+	case "GSK_SCALING_FILTER_NEAREST", "nearest":
+		return ScalingFilterNearestValue, true
+	case "GSK_SCALING_FILTER_TRILINEAR", "trilinear":
+		return ScalingFilterTrilinearValue, true
+	}
+	return 0, false
+}
+
 // Errors that can happen during (de)serialization.
 type SerializationError int
 
@@ -433,11 +1166,68 @@ const (
 	SerializationInvalidDataValue SerializationError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (SerializationError) ErrorDomain() string {
+	return "gsk-serialization-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x SerializationError) Error() string {
+	switch x {
+	case SerializationUnsupportedFormatValue:
+		return "GSK_SERIALIZATION_UNSUPPORTED_FORMAT"
+	case SerializationUnsupportedVersionValue:
+		return "GSK_SERIALIZATION_UNSUPPORTED_VERSION"
+	case SerializationInvalidDataValue:
+		return "GSK_SERIALIZATION_INVALID_DATA"
+	}
+	return fmt.Sprintf("gsk-serialization-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x SerializationError) String() string {
+	switch x {
+	case SerializationUnsupportedFormatValue:
+		return "GSK_SERIALIZATION_UNSUPPORTED_FORMAT"
+	case SerializationUnsupportedVersionValue:
+		return "GSK_SERIALIZATION_UNSUPPORTED_VERSION"
+	case SerializationInvalidDataValue:
+		return "GSK_SERIALIZATION_INVALID_DATA"
+	}
+	return fmt.Sprintf("SerializationError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SerializationError) Nick() string {
+	switch x {
+	case SerializationUnsupportedFormatValue:
+		return "unsupported-format"
+	case SerializationUnsupportedVersionValue:
+		return "unsupported-version"
+	case SerializationInvalidDataValue:
+		return "invalid-data"
+	}
+	return ""
+}
+
+// SerializationErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SerializationErrorFromString(s string) (SerializationError, bool) {
+	switch s {
+	case "GSK_SERIALIZATION_UNSUPPORTED_FORMAT", "unsupported-format":
+		return SerializationUnsupportedFormatValue, true
+	case "GSK_SERIALIZATION_UNSUPPORTED_VERSION", "unsupported-version":
+		return SerializationUnsupportedVersionValue, true
+	case "GSK_SERIALIZATION_INVALID_DATA", "invalid-data":
+		return SerializationInvalidDataValue, true
+	}
+	return 0, false
+}
+
 // The categories of matrices relevant for GSK and GTK.
 //
 // Note that any category includes matrices of all later categories.
 // So if you want to for example check if a matrix is a 2D matrix,
-// `category &gt;= GSK_TRANSFORM_CATEGORY_2D` is the way to do this.
+// `category >= GSK_TRANSFORM_CATEGORY_2D` is the way to do this.
 //
 // Also keep in mind that rounding errors may cause matrices to not
 // conform to their categories. Otherwise, matrix operations done via
@@ -463,7 +1253,7 @@ const (
 	//   the w column (the last column) has the values (0, 0, 0, 1).
 	TransformCategory3dValue TransformCategory = 2
 	// The matrix is a 2D matrix. This is equivalent
-	//   to graphene_matrix_is_2d() returning %TRUE. In particular, this
+	//   to [graphene.Matrix.Is2d] returning true. In particular, this
 	//   means that Cairo can deal with the matrix.
 	TransformCategory2dValue TransformCategory = 3
 	// The matrix is a combination of 2D scale
@@ -475,3 +1265,106 @@ const (
 	// The matrix is the identity matrix.
 	TransformCategoryIdentityValue TransformCategory = 6
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x TransformCategory) String() string {
+	switch x {
+	case TransformCategoryUnknownValue:
+		return "GSK_TRANSFORM_CATEGORY_UNKNOWN"
+	case TransformCategoryAnyValue:
+		return "GSK_TRANSFORM_CATEGORY_ANY"
+	case TransformCategory3dValue:
+		return "GSK_TRANSFORM_CATEGORY_3D"
+	case TransformCategory2dValue:
+		return "GSK_TRANSFORM_CATEGORY_2D"
+	case TransformCategory2dAffineValue:
+		return "GSK_TRANSFORM_CATEGORY_2D_AFFINE"
+	case TransformCategory2dTranslateValue:
+		return "GSK_TRANSFORM_CATEGORY_2D_TRANSLATE"
+	case TransformCategoryIdentityValue:
+		return "GSK_TRANSFORM_CATEGORY_IDENTITY"
+	}
+	return fmt.Sprintf("TransformCategory(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TransformCategory) Nick() string {
+	switch x {
+	case TransformCategoryUnknownValue:
+		return "unknown"
+	case TransformCategoryAnyValue:
+		return "any"
+	case TransformCategory3dValue:
+		return "3d"
+	case TransformCategory2dValue:
+		return "2d"
+	case TransformCategory2dAffineValue:
+		return "2d-affine"
+	case TransformCategory2dTranslateValue:
+		return "2d-translate"
+	case TransformCategoryIdentityValue:
+		return "identity"
+	}
+	return ""
+}
+
+// TransformCategoryFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TransformCategoryFromString(s string) (TransformCategory, bool) {
+	switch s {
+	case "GSK_TRANSFORM_CATEGORY_UNKNOWN", "unknown":
+		return TransformCategoryUnknownValue, true
+	case "GSK_TRANSFORM_CATEGORY_ANY", "any":
+		return TransformCategoryAnyValue, true
+	case "GSK_TRANSFORM_CATEGORY_3D", "3d":
+		return TransformCategory3dValue, true
+	case "GSK_TRANSFORM_CATEGORY_2D", "2d":
+		return TransformCategory2dValue, true
+	case "GSK_TRANSFORM_CATEGORY_2D_AFFINE", "2d-affine":
+		return TransformCategory2dAffineValue, true
+	case "GSK_TRANSFORM_CATEGORY_2D_TRANSLATE", "2d-translate":
+		return TransformCategory2dTranslateValue, true
+	case "GSK_TRANSFORM_CATEGORY_IDENTITY", "identity":
+		return TransformCategoryIdentityValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("GSK", "gtk4")
+	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xBlendModeGLibType, libs, "gsk_blend_mode_get_type")
+
+	core.PuregoSafeRegister(&xCornerGLibType, libs, "gsk_corner_get_type")
+
+	core.PuregoSafeRegister(&xFillRuleGLibType, libs, "gsk_fill_rule_get_type")
+
+	core.PuregoSafeRegister(&xGLUniformTypeGLibType, libs, "gsk_gl_uniform_type_get_type")
+
+	core.PuregoSafeRegister(&xLineCapGLibType, libs, "gsk_line_cap_get_type")
+
+	core.PuregoSafeRegister(&xLineJoinGLibType, libs, "gsk_line_join_get_type")
+
+	core.PuregoSafeRegister(&xMaskModeGLibType, libs, "gsk_mask_mode_get_type")
+
+	core.PuregoSafeRegister(&xPathDirectionGLibType, libs, "gsk_path_direction_get_type")
+
+	core.PuregoSafeRegister(&xPathOperationGLibType, libs, "gsk_path_operation_get_type")
+
+	core.PuregoSafeRegister(&xRenderNodeTypeGLibType, libs, "gsk_render_node_type_get_type")
+
+	core.PuregoSafeRegister(&xScalingFilterGLibType, libs, "gsk_scaling_filter_get_type")
+
+	core.PuregoSafeRegister(&xSerializationErrorGLibType, libs, "gsk_serialization_error_get_type")
+
+	core.PuregoSafeRegister(&xTransformCategoryGLibType, libs, "gsk_transform_category_get_type")
+
+}
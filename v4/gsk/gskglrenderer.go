@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -20,7 +19,7 @@ func (x *GLRendererClass) GoPointer() uintptr {
 
 // Renders a GSK rendernode tree with OpenGL.
 //
-// See [class@Gsk.Renderer].
+// See [Renderer].
 type GLRenderer struct {
 	Renderer
 }
@@ -66,7 +65,7 @@ func (c *GLRenderer) SetGoPointer(ptr uintptr) {
 
 // A GL based renderer.
 //
-// See [class@Gsk.Renderer].
+// See [Renderer].
 type NglRenderer struct {
 	Renderer
 }
@@ -83,22 +82,6 @@ func NglRendererNewFromInternalPtr(ptr uintptr) *NglRenderer {
 	return cls
 }
 
-var xNewNglRenderer func() uintptr
-
-// Same as gsk_gl_renderer_new().
-func NewNglRenderer() *NglRenderer {
-	var cls *NglRenderer
-
-	cret := xNewNglRenderer()
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &NglRenderer{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *NglRenderer) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -115,7 +98,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -124,10 +107,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xGLRendererGLibType, libs, "gsk_gl_renderer_get_type")
 
-	core.PuregoSafeRegister(&xNewGLRenderer, libs, "gsk_gl_renderer_new")
+	core.PuregoRegisterSince(&xNewGLRenderer, libs, "gsk_gl_renderer_new", "GSK", "4.2")
 
 	core.PuregoSafeRegister(&xNglRendererGLibType, libs, "gsk_ngl_renderer_get_type")
 
-	core.PuregoSafeRegister(&xNewNglRenderer, libs, "gsk_ngl_renderer_new")
-
 }
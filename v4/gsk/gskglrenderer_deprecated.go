@@ -0,0 +1,42 @@
+//go:build !puregotk_no_deprecated
+
+// Package gsk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gsk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewNglRenderer func() uintptr
+
+// Same as [NewGLRenderer].
+//
+// Deprecated: since 4.18. Use [NewGLRenderer]
+func NewNglRenderer() *NglRenderer {
+	var cls *NglRenderer
+
+	cret := xNewNglRenderer()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &NglRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GSK", "gtk4")
+	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewNglRenderer, libs, "gsk_ngl_renderer_new", "GSK", "")
+
+}
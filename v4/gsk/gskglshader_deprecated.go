@@ -0,0 +1,605 @@
+//go:build !puregotk_no_deprecated
+
+// Package gsk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gsk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+)
+
+var xNewShaderArgsBuilder func(uintptr, *glib.Bytes) *ShaderArgsBuilder
+
+// Allocates a builder that can be used to construct a new uniform data
+// chunk.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func NewShaderArgsBuilder(ShaderVar *GLShader, InitialValuesVar *glib.Bytes) *ShaderArgsBuilder {
+
+	cret := xNewShaderArgsBuilder(ShaderVar.GoPointer(), InitialValuesVar)
+	return cret
+}
+
+var xShaderArgsBuilderFreeToArgs func(uintptr) *glib.Bytes
+
+// Creates a new `GBytes` args from the current state of the
+// given builder, and frees the builder instance.
+//
+// Any uniforms of the shader that have not been explicitly set
+// on the builder are zero-initialized.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) FreeToArgs() *glib.Bytes {
+
+	cret := xShaderArgsBuilderFreeToArgs(x.GoPointer())
+	return cret
+}
+
+var xShaderArgsBuilderRef func(uintptr) *ShaderArgsBuilder
+
+// Increases the reference count of a `GskShaderArgsBuilder` by one.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) Ref() *ShaderArgsBuilder {
+
+	cret := xShaderArgsBuilderRef(x.GoPointer())
+	return cret
+}
+
+var xShaderArgsBuilderSetBool func(uintptr, int, bool)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of bool type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetBool(IdxVar int, ValueVar bool) {
+
+	xShaderArgsBuilderSetBool(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderSetInt func(uintptr, int, int32)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of int type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetInt(IdxVar int, ValueVar int32) {
+
+	xShaderArgsBuilderSetInt(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderSetUint func(uintptr, int, uint32)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of uint type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetUint(IdxVar int, ValueVar uint32) {
+
+	xShaderArgsBuilderSetUint(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderSetVec2 func(uintptr, int, *graphene.Vec2)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of vec2 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetVec2(IdxVar int, ValueVar *graphene.Vec2) {
+
+	xShaderArgsBuilderSetVec2(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderSetVec3 func(uintptr, int, *graphene.Vec3)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of vec3 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetVec3(IdxVar int, ValueVar *graphene.Vec3) {
+
+	xShaderArgsBuilderSetVec3(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderSetVec4 func(uintptr, int, *graphene.Vec4)
+
+// Sets the value of the uniform idx.
+//
+// The uniform must be of vec4 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) SetVec4(IdxVar int, ValueVar *graphene.Vec4) {
+
+	xShaderArgsBuilderSetVec4(x.GoPointer(), IdxVar, ValueVar)
+
+}
+
+var xShaderArgsBuilderToArgs func(uintptr) *glib.Bytes
+
+// Creates a new `GBytes` args from the current state of the
+// given builder.
+//
+// Any uniforms of the shader that have not been explicitly set on
+// the builder are zero-initialized.
+//
+// The given `GskShaderArgsBuilder` is reset once this function returns;
+// you cannot call this function multiple times on the same builder instance.
+//
+// This function is intended primarily for bindings. C code should use
+// [ShaderArgsBuilder.FreeToArgs].
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) ToArgs() *glib.Bytes {
+
+	cret := xShaderArgsBuilderToArgs(x.GoPointer())
+	return cret
+}
+
+var xShaderArgsBuilderUnref func(uintptr)
+
+// Decreases the reference count of a `GskShaderArgBuilder` by one.
+//
+// If the resulting reference count is zero, frees the builder.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *ShaderArgsBuilder) Unref() {
+
+	xShaderArgsBuilderUnref(x.GoPointer())
+
+}
+
+var xNewGLShaderFromBytes func(*glib.Bytes) uintptr
+
+// Creates a `GskGLShader` that will render pixels using the specified code.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func NewGLShaderFromBytes(SourcecodeVar *glib.Bytes) *GLShader {
+	var cls *GLShader
+
+	cret := xNewGLShaderFromBytes(SourcecodeVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &GLShader{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewGLShaderFromResource func(string) uintptr
+
+// Creates a `GskGLShader` that will render pixels using the specified code.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func NewGLShaderFromResource(ResourcePathVar string) *GLShader {
+	var cls *GLShader
+
+	cret := xNewGLShaderFromResource(ResourcePathVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &GLShader{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xGLShaderCompile func(uintptr, uintptr, **glib.Error) bool
+
+// Tries to compile the shader for the given renderer.
+//
+// If there is a problem, this function returns false and reports
+// an error. You should use this function before relying on the shader
+// for rendering and use a fallback with a simpler shader or without
+// shaders if it fails.
+//
+// Note that this will modify the rendering state (for example
+// change the current GL context) and requires the renderer to be
+// set up. This means that the widget has to be realized. Commonly you
+// want to call this from the realize signal of a widget, or during
+// widget snapshot.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) Compile(RendererVar *Renderer) (bool, error) {
+	var cerr *glib.Error
+
+	cret := xGLShaderCompile(x.GoPointer(), RendererVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xGLShaderFindUniformByName func(uintptr, string) int
+
+// Looks for a uniform by the name name, and returns the index
+// of the uniform, or -1 if it was not found.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) FindUniformByName(NameVar string) int {
+
+	cret := xGLShaderFindUniformByName(x.GoPointer(), NameVar)
+	return cret
+}
+
+var xGLShaderFormatArgs func(uintptr, ...interface{}) *glib.Bytes
+
+// Formats the uniform data as needed for feeding the named uniforms
+// values into the shader.
+//
+// The argument list is a list of pairs of names, and values for the types
+// that match the declared uniforms (i.e. double/int/guint/gboolean for
+// primitive values and `graphene_vecN_t *` for vecN uniforms).
+//
+// Any uniforms of the shader that are not included in the argument list
+// are zero-initialized.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) FormatArgs(varArgs ...interface{}) *glib.Bytes {
+
+	cret := xGLShaderFormatArgs(x.GoPointer(), varArgs...)
+	return cret
+}
+
+var xGLShaderFormatArgsVa func(uintptr, []interface{}) *glib.Bytes
+
+// Formats the uniform data as needed for feeding the named uniforms
+// values into the shader.
+//
+// The argument list is a list of pairs of names, and values for the
+// types that match the declared uniforms (i.e. double/int/guint/gboolean
+// for primitive values and `graphene_vecN_t *` for vecN uniforms).
+//
+// It is an error to pass a uniform name that is not declared by the shader.
+//
+// Any uniforms of the shader that are not included in the argument list
+// are zero-initialized.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) FormatArgsVa(UniformsVar []interface{}) *glib.Bytes {
+
+	cret := xGLShaderFormatArgsVa(x.GoPointer(), UniformsVar)
+	return cret
+}
+
+var xGLShaderGetArgBool func(uintptr, *glib.Bytes, int) bool
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of bool type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgBool(ArgsVar *glib.Bytes, IdxVar int) bool {
+
+	cret := xGLShaderGetArgBool(x.GoPointer(), ArgsVar, IdxVar)
+	return cret
+}
+
+var xGLShaderGetArgFloat func(uintptr, *glib.Bytes, int) float32
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of float type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgFloat(ArgsVar *glib.Bytes, IdxVar int) float32 {
+
+	cret := xGLShaderGetArgFloat(x.GoPointer(), ArgsVar, IdxVar)
+	return cret
+}
+
+var xGLShaderGetArgInt func(uintptr, *glib.Bytes, int) int32
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of int type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgInt(ArgsVar *glib.Bytes, IdxVar int) int32 {
+
+	cret := xGLShaderGetArgInt(x.GoPointer(), ArgsVar, IdxVar)
+	return cret
+}
+
+var xGLShaderGetArgUint func(uintptr, *glib.Bytes, int) uint32
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of uint type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgUint(ArgsVar *glib.Bytes, IdxVar int) uint32 {
+
+	cret := xGLShaderGetArgUint(x.GoPointer(), ArgsVar, IdxVar)
+	return cret
+}
+
+var xGLShaderGetArgVec2 func(uintptr, *glib.Bytes, int, *graphene.Vec2)
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of vec2 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgVec2(ArgsVar *glib.Bytes, IdxVar int, OutValueVar *graphene.Vec2) {
+
+	xGLShaderGetArgVec2(x.GoPointer(), ArgsVar, IdxVar, OutValueVar)
+
+}
+
+var xGLShaderGetArgVec3 func(uintptr, *glib.Bytes, int, *graphene.Vec3)
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of vec3 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgVec3(ArgsVar *glib.Bytes, IdxVar int, OutValueVar *graphene.Vec3) {
+
+	xGLShaderGetArgVec3(x.GoPointer(), ArgsVar, IdxVar, OutValueVar)
+
+}
+
+var xGLShaderGetArgVec4 func(uintptr, *glib.Bytes, int, *graphene.Vec4)
+
+// Gets the value of the uniform idx in the args block.
+//
+// The uniform must be of vec4 type.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgVec4(ArgsVar *glib.Bytes, IdxVar int, OutValueVar *graphene.Vec4) {
+
+	xGLShaderGetArgVec4(x.GoPointer(), ArgsVar, IdxVar, OutValueVar)
+
+}
+
+var xGLShaderGetArgsSize func(uintptr) uint
+
+// Get the size of the data block used to specify arguments for this shader.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetArgsSize() uint {
+
+	cret := xGLShaderGetArgsSize(x.GoPointer())
+	return cret
+}
+
+var xGLShaderGetNTextures func(uintptr) int
+
+// Returns the number of textures that the shader requires.
+//
+// This can be used to check that the a passed shader works
+// in your usecase. It is determined by looking at the highest
+// u_textureN value that the shader defines.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetNTextures() int {
+
+	cret := xGLShaderGetNTextures(x.GoPointer())
+	return cret
+}
+
+var xGLShaderGetNUniforms func(uintptr) int
+
+// Get the number of declared uniforms for this shader.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetNUniforms() int {
+
+	cret := xGLShaderGetNUniforms(x.GoPointer())
+	return cret
+}
+
+var xGLShaderGetResource func(uintptr) string
+
+// Gets the resource path for the GLSL sourcecode being used
+// to render this shader.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetResource() string {
+
+	cret := xGLShaderGetResource(x.GoPointer())
+	return cret
+}
+
+var xGLShaderGetSource func(uintptr) *glib.Bytes
+
+// Gets the GLSL sourcecode being used to render this shader.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetSource() *glib.Bytes {
+
+	cret := xGLShaderGetSource(x.GoPointer())
+	return cret
+}
+
+var xGLShaderGetUniformName func(uintptr, int) string
+
+// Get the name of the declared uniform for this shader at index idx.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetUniformName(IdxVar int) string {
+
+	cret := xGLShaderGetUniformName(x.GoPointer(), IdxVar)
+	return cret
+}
+
+var xGLShaderGetUniformOffset func(uintptr, int) int
+
+// Get the offset into the data block where data for this uniforms is stored.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetUniformOffset(IdxVar int) int {
+
+	cret := xGLShaderGetUniformOffset(x.GoPointer(), IdxVar)
+	return cret
+}
+
+var xGLShaderGetUniformType func(uintptr, int) GLUniformType
+
+// Get the type of the declared uniform for this shader at index idx.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShader) GetUniformType(IdxVar int) GLUniformType {
+
+	cret := xGLShaderGetUniformType(x.GoPointer(), IdxVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GSK", "gtk4")
+	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewShaderArgsBuilder, libs, "gsk_shader_args_builder_new", "GSK", "")
+
+	core.PuregoRegisterSince(&xShaderArgsBuilderFreeToArgs, libs, "gsk_shader_args_builder_free_to_args", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderRef, libs, "gsk_shader_args_builder_ref", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetBool, libs, "gsk_shader_args_builder_set_bool", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetInt, libs, "gsk_shader_args_builder_set_int", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetUint, libs, "gsk_shader_args_builder_set_uint", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetVec2, libs, "gsk_shader_args_builder_set_vec2", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetVec3, libs, "gsk_shader_args_builder_set_vec3", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderSetVec4, libs, "gsk_shader_args_builder_set_vec4", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderToArgs, libs, "gsk_shader_args_builder_to_args", "GSK", "")
+	core.PuregoRegisterSince(&xShaderArgsBuilderUnref, libs, "gsk_shader_args_builder_unref", "GSK", "")
+
+	core.PuregoRegisterSince(&xNewGLShaderFromBytes, libs, "gsk_gl_shader_new_from_bytes", "GSK", "")
+	core.PuregoRegisterSince(&xNewGLShaderFromResource, libs, "gsk_gl_shader_new_from_resource", "GSK", "")
+
+	core.PuregoRegisterSince(&xGLShaderCompile, libs, "gsk_gl_shader_compile", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderFindUniformByName, libs, "gsk_gl_shader_find_uniform_by_name", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderFormatArgs, libs, "gsk_gl_shader_format_args", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderFormatArgsVa, libs, "gsk_gl_shader_format_args_va", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgBool, libs, "gsk_gl_shader_get_arg_bool", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgFloat, libs, "gsk_gl_shader_get_arg_float", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgInt, libs, "gsk_gl_shader_get_arg_int", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgUint, libs, "gsk_gl_shader_get_arg_uint", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgVec2, libs, "gsk_gl_shader_get_arg_vec2", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgVec3, libs, "gsk_gl_shader_get_arg_vec3", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgVec4, libs, "gsk_gl_shader_get_arg_vec4", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetArgsSize, libs, "gsk_gl_shader_get_args_size", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetNTextures, libs, "gsk_gl_shader_get_n_textures", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetNUniforms, libs, "gsk_gl_shader_get_n_uniforms", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetResource, libs, "gsk_gl_shader_get_resource", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetSource, libs, "gsk_gl_shader_get_source", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetUniformName, libs, "gsk_gl_shader_get_uniform_name", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetUniformOffset, libs, "gsk_gl_shader_get_uniform_offset", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderGetUniformType, libs, "gsk_gl_shader_get_uniform_type", "GSK", "")
+
+}
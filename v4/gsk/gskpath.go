@@ -2,7 +2,9 @@
 package gsk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+	"strings"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/graphene"
@@ -10,9 +12,9 @@ import (
 
 // Type of the callback to iterate through the operations of a path.
 //
-// For each operation, the callback is given the @op itself, the points
-// that the operation is applied to in @pts, and a @weight for conic
-// curves. The @n_pts argument is somewhat redundant, since the number
+// For each operation, the callback is given the op itself, the points
+// that the operation is applied to in pts, and a weight for conic
+// curves. The n_pts argument is somewhat redundant, since the number
 // of points can be inferred from the operation.
 //
 // Each contour of the path starts with a @GSK_PATH_MOVE operation.
@@ -23,14 +25,14 @@ type PathForeachFunc func(PathOperation, []graphene.Point, uint, float32, uintpt
 // intersections of two paths.
 type PathIntersectionFunc func(*Path, *PathPoint, *Path, *PathPoint, PathIntersection, uintptr) bool
 
-// Flags that can be passed to gsk_path_foreach() to influence what
+// Flags that can be passed to [Path.Foreach] to influence what
 // kinds of operations the path is decomposed into.
 //
-// By default, [method@Gsk.Path.foreach] will only emit a path with all
+// By default, [Path.Foreach] will only emit a path with all
 // operations flattened to straight lines to allow for maximum compatibility.
 // The only operations emitted will be `GSK_PATH_MOVE`, `GSK_PATH_LINE` and
 // `GSK_PATH_CLOSE`.
-type PathForeachFlags int
+type PathForeachFlags uint32
 
 var xPathForeachFlagsGLibType func() types.GType
 
@@ -50,6 +52,51 @@ const (
 	PathForeachAllowConicValue PathForeachFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (PathForeachFlags) GLibType() types.GType {
+	return xPathForeachFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x PathForeachFlags) Has(flags PathForeachFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PathForeachFlags) Set(flags PathForeachFlags) PathForeachFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PathForeachFlags) Clear(flags PathForeachFlags) PathForeachFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PathForeachFlags) String() string {
+	if x == 0 {
+		return "GSK_PATH_FOREACH_ALLOW_ONLY_LINES"
+	}
+	var names []string
+	rest := x
+	if rest&PathForeachAllowQuadValue != 0 && x&PathForeachAllowQuadValue == PathForeachAllowQuadValue {
+		names = append(names, "GSK_PATH_FOREACH_ALLOW_QUAD")
+		rest &^= PathForeachAllowQuadValue
+	}
+	if rest&PathForeachAllowCubicValue != 0 && x&PathForeachAllowCubicValue == PathForeachAllowCubicValue {
+		names = append(names, "GSK_PATH_FOREACH_ALLOW_CUBIC")
+		rest &^= PathForeachAllowCubicValue
+	}
+	if rest&PathForeachAllowConicValue != 0 && x&PathForeachAllowConicValue == PathForeachAllowConicValue {
+		names = append(names, "GSK_PATH_FOREACH_ALLOW_CONIC")
+		rest &^= PathForeachAllowConicValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The values of this enumeration classify intersections
 // between paths.
 type PathIntersection int
@@ -73,13 +120,58 @@ const (
 	PathIntersectionEndValue PathIntersection = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PathIntersection) String() string {
+	switch x {
+	case PathIntersectionNoneValue:
+		return "GSK_PATH_INTERSECTION_NONE"
+	case PathIntersectionNormalValue:
+		return "GSK_PATH_INTERSECTION_NORMAL"
+	case PathIntersectionStartValue:
+		return "GSK_PATH_INTERSECTION_START"
+	case PathIntersectionEndValue:
+		return "GSK_PATH_INTERSECTION_END"
+	}
+	return fmt.Sprintf("PathIntersection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PathIntersection) Nick() string {
+	switch x {
+	case PathIntersectionNoneValue:
+		return "none"
+	case PathIntersectionNormalValue:
+		return "normal"
+	case PathIntersectionStartValue:
+		return "start"
+	case PathIntersectionEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// PathIntersectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PathIntersectionFromString(s string) (PathIntersection, bool) {
+	switch s {
+	case "GSK_PATH_INTERSECTION_NONE", "none":
+		return PathIntersectionNoneValue, true
+	case "GSK_PATH_INTERSECTION_NORMAL", "normal":
+		return PathIntersectionNormalValue, true
+	case "GSK_PATH_INTERSECTION_START", "start":
+		return PathIntersectionStartValue, true
+	case "GSK_PATH_INTERSECTION_END", "end":
+		return PathIntersectionEndValue, true
+	}
+	return 0, false
+}
+
 var xPathParse func(string) *Path
 
 // Constructs a path from a serialized form.
 //
 // The string is expected to be in (a superset of)
-// [SVG path syntax](https://www.w3.org/TR/SVG11/paths.html#PathData),
-// as e.g. produced by [method@Gsk.Path.to_string].
+// [SVG path syntax],
+// as e.g. produced by [Path.ToString].
 //
 // A high-level summary of the syntax:
 //
@@ -99,6 +191,8 @@ var xPathParse func(string) *Path
 // relative to the current point.
 //
 // The `O` command is an extension that is not supported in SVG.
+//
+// [SVG path syntax]: https://www.w3.org/TR/SVG11/paths.html#PathData
 func PathParse(StringVar string) *Path {
 
 	cret := xPathParse(StringVar)
@@ -110,7 +204,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -121,6 +215,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xPathIntersectionGLibType, libs, "gsk_path_intersection_get_type")
 
-	core.PuregoSafeRegister(&xPathParse, libs, "gsk_path_parse")
+	core.PuregoRegisterSince(&xPathParse, libs, "gsk_path_parse", "GSK", "4.14")
 
 }
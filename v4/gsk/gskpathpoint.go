@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/graphene"
@@ -16,9 +15,9 @@ import (
 // It can be queried for properties of the path at that point,
 // such as its tangent or its curvature.
 //
-// To obtain a `GskPathPoint`, use [method@Gsk.Path.get_closest_point],
-// [method@Gsk.Path.get_start_point], [method@Gsk.Path.get_end_point]
-// or [method@Gsk.PathMeasure.get_point].
+// To obtain a `GskPathPoint`, use [Path.GetClosestPoint],
+// [Path.GetStartPoint], [Path.GetEndPoint]
+// or [PathMeasure.GetPoint].
 //
 // Note that `GskPathPoint` structs are meant to be stack-allocated,
 // and don't hold a reference to the path object they are obtained from.
@@ -38,9 +37,17 @@ func (x *PathPoint) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewPathPointAlloc returns a zeroed PathPoint allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated PathPoint. Release it with Free.
+func NewPathPointAlloc() *PathPoint {
+	cret := core.GMalloc0(max(unsafe.Sizeof(PathPoint{}), 64))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*PathPoint)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xPathPointCompare func(uintptr, *PathPoint) int
 
-// Returns whether @point1 is before or after @point2.
+// Returns whether point1 is before or after point2.
 func (x *PathPoint) Compare(Point2Var *PathPoint) int {
 
 	cret := xPathPointCompare(x.GoPointer(), Point2Var)
@@ -63,7 +70,7 @@ var xPathPointEqual func(uintptr, *PathPoint) bool
 //
 // Note that the start- and endpoint of a closed contour
 // will compare nonequal according to this definition.
-// Use [method@Gsk.Path.is_closed] to find out if the
+// Use [Path.IsClosed] to find out if the
 // start- and endpoint of a concrete path refer to the
 // same location.
 func (x *PathPoint) Equal(Point2Var *PathPoint) bool {
@@ -74,7 +81,7 @@ func (x *PathPoint) Equal(Point2Var *PathPoint) bool {
 
 var xPathPointFree func(uintptr)
 
-// Frees a path point copied by [method@Gsk.PathPoint.copy].
+// Frees a path point copied by [PathPoint.Copy].
 func (x *PathPoint) Free() {
 
 	xPathPointFree(x.GoPointer())
@@ -89,22 +96,15 @@ var xPathPointGetCurvature func(uintptr, *Path, PathDirection, *graphene.Point)
 // The curvature is the inverse of the radius of the osculating circle.
 //
 // Lines have a curvature of zero (indicating an osculating circle of
-// infinite radius). In this case, the @center is not modified.
+// infinite radius). In this case, the center is not modified.
 //
 // # Circles with a radius of zero have `INFINITY` as curvature
 //
 // Note that certain points on a path may not have a single curvature,
 // such as sharp turns. At such points, there are two curvatures — the
 // (limit of) the curvature of the path going into the point, and the
-// (limit of) the curvature of the path coming out of it. The @direction
+// (limit of) the curvature of the path coming out of it. The direction
 // argument lets you choose which one to get.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="curvature-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Osculating circle" src="curvature-light.png"&gt;
-//
-// &lt;/picture&gt;
 func (x *PathPoint) GetCurvature(PathVar *Path, DirectionVar PathDirection, CenterVar *graphene.Point) float32 {
 
 	cret := xPathPointGetCurvature(x.GoPointer(), PathVar, DirectionVar, CenterVar)
@@ -134,10 +134,10 @@ var xPathPointGetRotation func(uintptr, *Path, PathDirection) float32
 
 // Gets the direction of the tangent at a given point.
 //
-// This is a convenience variant of [method@Gsk.PathPoint.get_tangent]
+// This is a convenience variant of [PathPoint.GetTangent]
 // that returns the angle between the tangent and the X axis. The angle
 // can e.g. be used in
-// [gtk_snapshot_rotate()](../gtk4/method.Snapshot.rotate.html).
+// [[gtk.Snapshot.Rotate]](../gtk4/method.Snapshot.rotate.html).
 func (x *PathPoint) GetRotation(PathVar *Path, DirectionVar PathDirection) float32 {
 
 	cret := xPathPointGetRotation(x.GoPointer(), PathVar, DirectionVar)
@@ -151,14 +151,14 @@ var xPathPointGetTangent func(uintptr, *Path, PathDirection, *graphene.Vec2)
 // Note that certain points on a path may not have a single
 // tangent, such as sharp turns. At such points, there are
 // two tangents — the direction of the path going into the
-// point, and the direction coming out of it. The @direction
+// point, and the direction coming out of it. The direction
 // argument lets you choose which one to get.
 //
 // If the path is just a single point (e.g. a circle with
 // radius zero), then the tangent is set to `0, 0`.
 //
 // If you want to orient something in the direction of the
-// path, [method@Gsk.PathPoint.get_rotation] may be more
+// path, [PathPoint.GetRotation] may be more
 // convenient to use.
 func (x *PathPoint) GetTangent(PathVar *Path, DirectionVar PathDirection, TangentVar *graphene.Vec2) {
 
@@ -171,7 +171,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -180,14 +180,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xPathPointGLibType, libs, "gsk_path_point_get_type")
 
-	core.PuregoSafeRegister(&xPathPointCompare, libs, "gsk_path_point_compare")
-	core.PuregoSafeRegister(&xPathPointCopy, libs, "gsk_path_point_copy")
-	core.PuregoSafeRegister(&xPathPointEqual, libs, "gsk_path_point_equal")
-	core.PuregoSafeRegister(&xPathPointFree, libs, "gsk_path_point_free")
-	core.PuregoSafeRegister(&xPathPointGetCurvature, libs, "gsk_path_point_get_curvature")
-	core.PuregoSafeRegister(&xPathPointGetDistance, libs, "gsk_path_point_get_distance")
-	core.PuregoSafeRegister(&xPathPointGetPosition, libs, "gsk_path_point_get_position")
-	core.PuregoSafeRegister(&xPathPointGetRotation, libs, "gsk_path_point_get_rotation")
-	core.PuregoSafeRegister(&xPathPointGetTangent, libs, "gsk_path_point_get_tangent")
+	core.PuregoRegisterSince(&xPathPointCompare, libs, "gsk_path_point_compare", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointCopy, libs, "gsk_path_point_copy", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointEqual, libs, "gsk_path_point_equal", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointFree, libs, "gsk_path_point_free", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointGetCurvature, libs, "gsk_path_point_get_curvature", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointGetDistance, libs, "gsk_path_point_get_distance", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointGetPosition, libs, "gsk_path_point_get_position", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointGetRotation, libs, "gsk_path_point_get_rotation", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPointGetTangent, libs, "gsk_path_point_get_tangent", "GSK", "4.14")
 
 }
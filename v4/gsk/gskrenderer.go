@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
@@ -23,14 +22,14 @@ func (x *RendererClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Renders a scene graph defined via a tree of [class@Gsk.RenderNode] instances.
+// Renders a scene graph defined via a tree of [RenderNode] instances.
 //
 // Typically you will use a `GskRenderer` instance to repeatedly call
-// [method@Gsk.Renderer.render] to update the contents of its associated
-// [class@Gdk.Surface].
+// [Renderer.Render] to update the contents of its associated
+// [gdk.Surface].
 //
 // It is necessary to realize a `GskRenderer` instance using
-// [method@Gsk.Renderer.realize] before calling [method@Gsk.Renderer.render],
+// [Renderer.Realize] before calling [Renderer.Render],
 // in order to create the appropriate windowing system resources needed
 // to render the scene.
 type Renderer struct {
@@ -105,17 +104,17 @@ var xRendererRealize func(uintptr, uintptr, **glib.Error) bool
 //
 // Since GTK 4.6, the surface may be `NULL`, which allows using
 // renderers without having to create a surface. Since GTK 4.14,
-// it is recommended to use [method@Gsk.Renderer.realize_for_display]
+// it is recommended to use [Renderer.RealizeForDisplay]
 // for this case.
 //
-// Note that it is mandatory to call [method@Gsk.Renderer.unrealize]
+// Note that it is mandatory to call [Renderer.Unrealize]
 // before destroying the renderer.
 func (x *Renderer) Realize(SurfaceVar *gdk.Surface) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xRendererRealize(x.GoPointer(), SurfaceVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -125,14 +124,14 @@ var xRendererRealizeForDisplay func(uintptr, uintptr, **glib.Error) bool
 
 // Creates the resources needed by the renderer.
 //
-// Note that it is mandatory to call [method@Gsk.Renderer.unrealize]
+// Note that it is mandatory to call [Renderer.Unrealize]
 // before destroying the renderer.
 func (x *Renderer) RealizeForDisplay(DisplayVar *gdk.Display) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xRendererRealizeForDisplay(x.GoPointer(), DisplayVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -145,9 +144,9 @@ var xRendererRender func(uintptr, uintptr, *cairo.Region)
 //
 // If the renderer has no associated surface, this function does nothing.
 //
-// Renderers must ensure that changes of the contents given by the @root
-// node as well as the area given by @region are redrawn. They are however
-// free to not redraw any pixel outside of @region if they can guarantee that
+// Renderers must ensure that changes of the contents given by the root
+// node as well as the area given by region are redrawn. They are however
+// free to not redraw any pixel outside of region if they can guarantee that
 // it didn't change.
 //
 // The renderer will acquire a reference on the `GskRenderNode` tree while
@@ -166,7 +165,7 @@ var xRendererRenderTexture func(uintptr, uintptr, *graphene.Rect) uintptr
 // The renderer will acquire a reference on the `GskRenderNode` tree while
 // the rendering is in progress.
 //
-// If you want to apply any transformations to @root, you should put it into a
+// If you want to apply any transformations to root, you should put it into a
 // transform node and pass that node instead.
 func (x *Renderer) RenderTexture(RootVar *RenderNode, ViewportVar *graphene.Rect) *gdk.Texture {
 	var cls *gdk.Texture
@@ -183,7 +182,7 @@ func (x *Renderer) RenderTexture(RootVar *RenderNode, ViewportVar *graphene.Rect
 
 var xRendererUnrealize func(uintptr)
 
-// Releases all the resources created by [method@Gsk.Renderer.realize].
+// Releases all the resources created by [Renderer.Realize].
 func (x *Renderer) Unrealize() {
 
 	xRendererUnrealize(x.GoPointer())
@@ -214,7 +213,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -223,14 +222,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xRendererGLibType, libs, "gsk_renderer_get_type")
 
-	core.PuregoSafeRegister(&xNewRendererForSurface, libs, "gsk_renderer_new_for_surface")
+	core.PuregoRegisterSince(&xNewRendererForSurface, libs, "gsk_renderer_new_for_surface", "GSK", "")
 
-	core.PuregoSafeRegister(&xRendererGetSurface, libs, "gsk_renderer_get_surface")
-	core.PuregoSafeRegister(&xRendererIsRealized, libs, "gsk_renderer_is_realized")
-	core.PuregoSafeRegister(&xRendererRealize, libs, "gsk_renderer_realize")
-	core.PuregoSafeRegister(&xRendererRealizeForDisplay, libs, "gsk_renderer_realize_for_display")
-	core.PuregoSafeRegister(&xRendererRender, libs, "gsk_renderer_render")
-	core.PuregoSafeRegister(&xRendererRenderTexture, libs, "gsk_renderer_render_texture")
-	core.PuregoSafeRegister(&xRendererUnrealize, libs, "gsk_renderer_unrealize")
+	core.PuregoRegisterSince(&xRendererGetSurface, libs, "gsk_renderer_get_surface", "GSK", "")
+	core.PuregoRegisterSince(&xRendererIsRealized, libs, "gsk_renderer_is_realized", "GSK", "")
+	core.PuregoRegisterSince(&xRendererRealize, libs, "gsk_renderer_realize", "GSK", "")
+	core.PuregoRegisterSince(&xRendererRealizeForDisplay, libs, "gsk_renderer_realize_for_display", "GSK", "4.14")
+	core.PuregoRegisterSince(&xRendererRender, libs, "gsk_renderer_render", "GSK", "")
+	core.PuregoRegisterSince(&xRendererRenderTexture, libs, "gsk_renderer_render_texture", "GSK", "")
+	core.PuregoRegisterSince(&xRendererUnrealize, libs, "gsk_renderer_unrealize", "GSK", "")
 
 }
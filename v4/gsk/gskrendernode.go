@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -54,7 +54,7 @@ func (x *ParseLocation) GoPointer() uintptr {
 type Shadow struct {
 	_ structs.HostLayout
 
-	Color uintptr
+	Color gdk.RGBA
 
 	Dx float32
 
@@ -69,7 +69,7 @@ func (x *Shadow) GoPointer() uintptr {
 
 var xSerializationErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gsk.RenderNode] errors.
+// Registers an error quark for [RenderNode] errors.
 func SerializationErrorQuark() glib.Quark {
 
 	cret := xSerializationErrorQuark()
@@ -114,7 +114,7 @@ var xValueSetRenderNode func(*gobject.Value, uintptr)
 
 // Stores the given render node inside a `GValue`.
 //
-// The [struct@GObject.Value] will acquire a reference
+// The [gobject.Value] will acquire a reference
 // to the render node.
 func ValueSetRenderNode(ValueVar *gobject.Value, NodeVar *RenderNode) {
 
@@ -134,7 +134,7 @@ func ValueTakeRenderNode(ValueVar *gobject.Value, NodeVar *RenderNode) {
 
 }
 
-// The basic block in a scene graph to be rendered using [class@Gsk.Renderer].
+// The basic block in a scene graph to be rendered using [Renderer].
 //
 // Each node has a parent, except the top-level node; each node may have
 // children nodes.
@@ -143,8 +143,8 @@ func ValueTakeRenderNode(ValueVar *gobject.Value, NodeVar *RenderNode) {
 // the rectangle set when creating it.
 //
 // Render nodes are meant to be transient; once they have been associated
-// to a [class@Gsk.Renderer] it's safe to release any reference you have on
-// them. All [class@Gsk.RenderNode]s are immutable, you can only specify their
+// to a [Renderer] it's safe to release any reference you have on
+// them. All [RenderNode]s are immutable, you can only specify their
 // properties during construction.
 type RenderNode struct {
 	Ptr uintptr
@@ -168,7 +168,7 @@ var xRenderNodeDraw func(uintptr, *cairo.Context)
 //
 // Typically, you'll use this function to implement fallback rendering
 // of render nodes on an intermediate Cairo context, instead of using
-// the drawing context associated to a [class@Gdk.Surface]'s rendering buffer.
+// the drawing context associated to a [gdk.Surface]'s rendering buffer.
 //
 // For advanced nodes that cannot be supported using Cairo, in particular
 // for nodes doing 3D operations, this function may fail.
@@ -180,7 +180,7 @@ func (x *RenderNode) Draw(CrVar *cairo.Context) {
 
 var xRenderNodeGetBounds func(uintptr, *graphene.Rect)
 
-// Retrieves the boundaries of the @node.
+// Retrieves the boundaries of the node.
 //
 // The node will not draw outside of its boundaries.
 func (x *RenderNode) GetBounds(BoundsVar *graphene.Rect) {
@@ -232,11 +232,11 @@ func (x *RenderNode) Ref() *RenderNode {
 
 var xRenderNodeSerialize func(uintptr) *glib.Bytes
 
-// Serializes the @node for later deserialization via
-// gsk_render_node_deserialize(). No guarantees are made about the format
+// Serializes the node for later deserialization via
+// [RenderNodeDeserialize]. No guarantees are made about the format
 // used other than that the same version of GTK will be able to deserialize
-// the result of a call to gsk_render_node_serialize() and
-// gsk_render_node_deserialize() will correctly reject files it cannot open
+// the result of a call to [RenderNode.Serialize] and
+// [RenderNodeDeserialize] will correctly reject files it cannot open
 // that were created with previous versions of GTK.
 //
 // The intended use of this functions is testing, benchmarking and debugging.
@@ -251,7 +251,7 @@ var xRenderNodeUnref func(uintptr)
 
 // Releases a reference on the given `GskRenderNode`.
 //
-// If the reference was the last, the resources associated to the @node are
+// If the reference was the last, the resources associated to the node are
 // freed.
 func (x *RenderNode) Unref() {
 
@@ -261,8 +261,8 @@ func (x *RenderNode) Unref() {
 
 var xRenderNodeWriteToFile func(uintptr, string, **glib.Error) bool
 
-// This function is equivalent to calling [method@Gsk.RenderNode.serialize]
-// followed by [func@GLib.file_set_contents].
+// This function is equivalent to calling [RenderNode.Serialize]
+// followed by [glib.FileSetContents].
 //
 // See those two functions for details on the arguments.
 //
@@ -273,7 +273,7 @@ func (x *RenderNode) WriteToFile(FilenameVar string) (bool, error) {
 
 	cret := xRenderNodeWriteToFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -292,7 +292,7 @@ func (c *RenderNode) SetGoPointer(ptr uintptr) {
 
 var xRenderNodeDeserialize func(*glib.Bytes, uintptr, uintptr) uintptr
 
-// Loads data previously created via [method@Gsk.RenderNode.serialize].
+// Loads data previously created via [RenderNode.Serialize].
 //
 // For a discussion of the supported format, see that function.
 func RenderNodeDeserialize(BytesVar *glib.Bytes, ErrorFuncVar *ParseErrorFunc, UserDataVar uintptr) *RenderNode {
@@ -305,11 +305,12 @@ func RenderNodeDeserialize(BytesVar *glib.Bytes, ErrorFuncVar *ParseErrorFunc, U
 			ErrorFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *ParseLocation, arg1 *ParseLocation, arg2 *glib.Error, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *ErrorFuncVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			ErrorFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ErrorFuncVarPtr, ErrorFuncVarRef, ErrorFuncVar)
+			ErrorFuncVarRef = core.NewCallback(fcb)
+			ErrorFuncVarRef = glib.SaveCallbackWithClosure(ErrorFuncVarPtr, ErrorFuncVarRef, ErrorFuncVar)
 		}
 	}
 
@@ -328,30 +329,30 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xSerializationErrorQuark, libs, "gsk_serialization_error_quark")
-	core.PuregoSafeRegister(&xValueDupRenderNode, libs, "gsk_value_dup_render_node")
-	core.PuregoSafeRegister(&xValueGetRenderNode, libs, "gsk_value_get_render_node")
-	core.PuregoSafeRegister(&xValueSetRenderNode, libs, "gsk_value_set_render_node")
-	core.PuregoSafeRegister(&xValueTakeRenderNode, libs, "gsk_value_take_render_node")
+	core.PuregoRegisterSince(&xSerializationErrorQuark, libs, "gsk_serialization_error_quark", "GSK", "")
+	core.PuregoRegisterSince(&xValueDupRenderNode, libs, "gsk_value_dup_render_node", "GSK", "4.6")
+	core.PuregoRegisterSince(&xValueGetRenderNode, libs, "gsk_value_get_render_node", "GSK", "4.6")
+	core.PuregoRegisterSince(&xValueSetRenderNode, libs, "gsk_value_set_render_node", "GSK", "4.6")
+	core.PuregoRegisterSince(&xValueTakeRenderNode, libs, "gsk_value_take_render_node", "GSK", "4.6")
 
 	core.PuregoSafeRegister(&xRenderNodeGLibType, libs, "gsk_render_node_get_type")
 
-	core.PuregoSafeRegister(&xRenderNodeDraw, libs, "gsk_render_node_draw")
-	core.PuregoSafeRegister(&xRenderNodeGetBounds, libs, "gsk_render_node_get_bounds")
-	core.PuregoSafeRegister(&xRenderNodeGetNodeType, libs, "gsk_render_node_get_node_type")
-	core.PuregoSafeRegister(&xRenderNodeGetOpaqueRect, libs, "gsk_render_node_get_opaque_rect")
-	core.PuregoSafeRegister(&xRenderNodeRef, libs, "gsk_render_node_ref")
-	core.PuregoSafeRegister(&xRenderNodeSerialize, libs, "gsk_render_node_serialize")
-	core.PuregoSafeRegister(&xRenderNodeUnref, libs, "gsk_render_node_unref")
-	core.PuregoSafeRegister(&xRenderNodeWriteToFile, libs, "gsk_render_node_write_to_file")
+	core.PuregoRegisterSince(&xRenderNodeDraw, libs, "gsk_render_node_draw", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeGetBounds, libs, "gsk_render_node_get_bounds", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeGetNodeType, libs, "gsk_render_node_get_node_type", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeGetOpaqueRect, libs, "gsk_render_node_get_opaque_rect", "GSK", "4.16")
+	core.PuregoRegisterSince(&xRenderNodeRef, libs, "gsk_render_node_ref", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeSerialize, libs, "gsk_render_node_serialize", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeUnref, libs, "gsk_render_node_unref", "GSK", "")
+	core.PuregoRegisterSince(&xRenderNodeWriteToFile, libs, "gsk_render_node_write_to_file", "GSK", "")
 
-	core.PuregoSafeRegister(&xRenderNodeDeserialize, libs, "gsk_render_node_deserialize")
+	core.PuregoRegisterSince(&xRenderNodeDeserialize, libs, "gsk_render_node_deserialize", "GSK", "")
 
 }
@@ -2,11 +2,9 @@
 package gsk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
-	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/graphene"
@@ -32,8 +30,8 @@ func BlendNodeNewFromInternalPtr(ptr uintptr) *BlendNode {
 
 var xNewBlendNode func(uintptr, uintptr, BlendMode) uintptr
 
-// Creates a `GskRenderNode` that will use @blend_mode to blend the @top
-// node onto the @bottom node.
+// Creates a `GskRenderNode` that will use blend_mode to blend the top
+// node onto the bottom node.
 func NewBlendNode(BottomVar *RenderNode, TopVar *RenderNode, BlendModeVar BlendMode) *BlendNode {
 	var cls *BlendNode
 
@@ -49,7 +47,7 @@ func NewBlendNode(BottomVar *RenderNode, TopVar *RenderNode, BlendModeVar BlendM
 
 var xBlendNodeGetBlendMode func(uintptr) BlendMode
 
-// Retrieves the blend mode used by @node.
+// Retrieves the blend mode used by node.
 func (x *BlendNode) GetBlendMode() BlendMode {
 
 	cret := xBlendNodeGetBlendMode(x.GoPointer())
@@ -58,7 +56,7 @@ func (x *BlendNode) GetBlendMode() BlendMode {
 
 var xBlendNodeGetBottomChild func(uintptr) uintptr
 
-// Retrieves the bottom `GskRenderNode` child of the @node.
+// Retrieves the bottom `GskRenderNode` child of the node.
 func (x *BlendNode) GetBottomChild() *RenderNode {
 	var cls *RenderNode
 
@@ -75,7 +73,7 @@ func (x *BlendNode) GetBottomChild() *RenderNode {
 
 var xBlendNodeGetTopChild func(uintptr) uintptr
 
-// Retrieves the top `GskRenderNode` child of the @node.
+// Retrieves the top `GskRenderNode` child of the node.
 func (x *BlendNode) GetTopChild() *RenderNode {
 	var cls *RenderNode
 
@@ -136,7 +134,7 @@ func NewBlurNode(ChildVar *RenderNode, RadiusVar float32) *BlurNode {
 
 var xBlurNodeGetChild func(uintptr) uintptr
 
-// Retrieves the child `GskRenderNode` of the blur @node.
+// Retrieves the child `GskRenderNode` of the blur node.
 func (x *BlurNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -153,7 +151,7 @@ func (x *BlurNode) GetChild() *RenderNode {
 
 var xBlurNodeGetRadius func(uintptr) float32
 
-// Retrieves the blur radius of the @node.
+// Retrieves the blur radius of the node.
 func (x *BlurNode) GetRadius() float32 {
 
 	cret := xBlurNodeGetRadius(x.GoPointer())
@@ -191,7 +189,7 @@ func BorderNodeNewFromInternalPtr(ptr uintptr) *BorderNode {
 var xNewBorderNode func(*RoundedRect, [4]float32, [4]gdk.RGBA) uintptr
 
 // Creates a `GskRenderNode` that will stroke a border rectangle inside the
-// given @outline.
+// given outline.
 //
 // The 4 sides of the border can have different widths and colors.
 func NewBorderNode(OutlineVar *RoundedRect, BorderWidthVar [4]float32, BorderColorVar [4]gdk.RGBA) *BorderNode {
@@ -265,9 +263,9 @@ func CairoNodeNewFromInternalPtr(ptr uintptr) *CairoNode {
 var xNewCairoNode func(*graphene.Rect) uintptr
 
 // Creates a `GskRenderNode` that will render a cairo surface
-// into the area given by @bounds.
+// into the area given by bounds.
 //
-// You can draw to the cairo surface using [method@Gsk.CairoNode.get_draw_context].
+// You can draw to the cairo surface using [CairoNode.GetDrawContext].
 func NewCairoNode(BoundsVar *graphene.Rect) *CairoNode {
 	var cls *CairoNode
 
@@ -287,7 +285,7 @@ var xCairoNodeGetDrawContext func(uintptr) *cairo.Context
 // to the render node.
 //
 // If no surface exists yet, a surface will be created optimized for
-// rendering to @renderer.
+// rendering to renderer.
 func (x *CairoNode) GetDrawContext() *cairo.Context {
 
 	cret := xCairoNodeGetDrawContext(x.GoPointer())
@@ -333,8 +331,8 @@ func ClipNodeNewFromInternalPtr(ptr uintptr) *ClipNode {
 
 var xNewClipNode func(uintptr, *graphene.Rect) uintptr
 
-// Creates a `GskRenderNode` that will clip the @child to the area
-// given by @clip.
+// Creates a `GskRenderNode` that will clip the child to the area
+// given by clip.
 func NewClipNode(ChildVar *RenderNode, ClipVar *graphene.Rect) *ClipNode {
 	var cls *ClipNode
 
@@ -350,7 +348,7 @@ func NewClipNode(ChildVar *RenderNode, ClipVar *graphene.Rect) *ClipNode {
 
 var xClipNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting clipped by the given @node.
+// Gets the child node that is getting clipped by the given node.
 func (x *ClipNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -367,7 +365,7 @@ func (x *ClipNode) GetChild() *RenderNode {
 
 var xClipNodeGetClip func(uintptr) *graphene.Rect
 
-// Retrieves the clip rectangle for @node.
+// Retrieves the clip rectangle for node.
 func (x *ClipNode) GetClip() *graphene.Rect {
 
 	cret := xClipNodeGetClip(x.GoPointer())
@@ -404,8 +402,8 @@ func ColorMatrixNodeNewFromInternalPtr(ptr uintptr) *ColorMatrixNode {
 
 var xNewColorMatrixNode func(uintptr, *graphene.Matrix, *graphene.Vec4) uintptr
 
-// Creates a `GskRenderNode` that will drawn the @child with
-// @color_matrix.
+// Creates a `GskRenderNode` that will drawn the child with
+// color_matrix.
 //
 // In particular, the node will transform colors by applying
 //
@@ -428,7 +426,7 @@ func NewColorMatrixNode(ChildVar *RenderNode, ColorMatrixVar *graphene.Matrix, C
 
 var xColorMatrixNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting its colors modified by the given @node.
+// Gets the child node that is getting its colors modified by the given node.
 func (x *ColorMatrixNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -445,7 +443,7 @@ func (x *ColorMatrixNode) GetChild() *RenderNode {
 
 var xColorMatrixNodeGetColorMatrix func(uintptr) *graphene.Matrix
 
-// Retrieves the color matrix used by the @node.
+// Retrieves the color matrix used by the node.
 func (x *ColorMatrixNode) GetColorMatrix() *graphene.Matrix {
 
 	cret := xColorMatrixNodeGetColorMatrix(x.GoPointer())
@@ -454,7 +452,7 @@ func (x *ColorMatrixNode) GetColorMatrix() *graphene.Matrix {
 
 var xColorMatrixNodeGetColorOffset func(uintptr) *graphene.Vec4
 
-// Retrieves the color offset used by the @node.
+// Retrieves the color offset used by the node.
 func (x *ColorMatrixNode) GetColorOffset() *graphene.Vec4 {
 
 	cret := xColorMatrixNodeGetColorOffset(x.GoPointer())
@@ -491,8 +489,8 @@ func ColorNodeNewFromInternalPtr(ptr uintptr) *ColorNode {
 
 var xNewColorNode func(*gdk.RGBA, *graphene.Rect) uintptr
 
-// Creates a `GskRenderNode` that will render the color specified by @rgba into
-// the area given by @bounds.
+// Creates a `GskRenderNode` that will render the color specified by rgba into
+// the area given by bounds.
 func NewColorNode(RgbaVar *gdk.RGBA, BoundsVar *graphene.Rect) *ColorNode {
 	var cls *ColorNode
 
@@ -508,7 +506,7 @@ func NewColorNode(RgbaVar *gdk.RGBA, BoundsVar *graphene.Rect) *ColorNode {
 
 var xColorNodeGetColor func(uintptr) *gdk.RGBA
 
-// Retrieves the color of the given @node.
+// Retrieves the color of the given node.
 //
 // The value returned by this function will not be correct
 // if the render node was created for a non-sRGB color.
@@ -551,7 +549,7 @@ var xNewConicGradientNode func(*graphene.Rect, *graphene.Point, float32, []Color
 // Creates a `GskRenderNode` that draws a conic gradient.
 //
 // The conic gradient
-// starts around @center in the direction of @rotation. A rotation of 0 means
+// starts around center in the direction of rotation. A rotation of 0 means
 // that the gradient points up. Color stops are then added clockwise.
 func NewConicGradientNode(BoundsVar *graphene.Rect, CenterVar *graphene.Point, RotationVar float32, ColorStopsVar []ColorStop, NColorStopsVar uint) *ConicGradientNode {
 	var cls *ConicGradientNode
@@ -573,7 +571,7 @@ var xConicGradientNodeGetAngle func(uintptr) float32
 // The angle is starting at the top and going clockwise, as expressed
 // in the css specification:
 //
-//	angle = 90 - gsk_conic_gradient_node_get_rotation()
+//	angle = 90 - [ConicGradientNode.GetRotation]
 func (x *ConicGradientNode) GetAngle() float32 {
 
 	cret := xConicGradientNodeGetAngle(x.GoPointer())
@@ -646,7 +644,7 @@ func ContainerNodeNewFromInternalPtr(ptr uintptr) *ContainerNode {
 
 var xNewContainerNode func(uintptr, uint) uintptr
 
-// Creates a new `GskRenderNode` instance for holding the given @children.
+// Creates a new `GskRenderNode` instance for holding the given children.
 //
 // The new node will acquire a reference to each of the children.
 func NewContainerNode(ChildrenVar uintptr, NChildrenVar uint) *ContainerNode {
@@ -664,7 +662,7 @@ func NewContainerNode(ChildrenVar uintptr, NChildrenVar uint) *ContainerNode {
 
 var xContainerNodeGetChild func(uintptr, uint) uintptr
 
-// Gets one of the children of @container.
+// Gets one of the children of container.
 func (x *ContainerNode) GetChild(IdxVar uint) *RenderNode {
 	var cls *RenderNode
 
@@ -681,7 +679,7 @@ func (x *ContainerNode) GetChild(IdxVar uint) *RenderNode {
 
 var xContainerNodeGetNChildren func(uintptr) uint
 
-// Retrieves the number of direct children of @node.
+// Retrieves the number of direct children of node.
 func (x *ContainerNode) GetNChildren() uint {
 
 	cret := xContainerNodeGetNChildren(x.GoPointer())
@@ -718,7 +716,7 @@ func CrossFadeNodeNewFromInternalPtr(ptr uintptr) *CrossFadeNode {
 
 var xNewCrossFadeNode func(uintptr, uintptr, float32) uintptr
 
-// Creates a `GskRenderNode` that will do a cross-fade between @start and @end.
+// Creates a `GskRenderNode` that will do a cross-fade between start and end.
 func NewCrossFadeNode(StartVar *RenderNode, EndVar *RenderNode, ProgressVar float32) *CrossFadeNode {
 	var cls *CrossFadeNode
 
@@ -807,7 +805,7 @@ func DebugNodeNewFromInternalPtr(ptr uintptr) *DebugNode {
 var xNewDebugNode func(uintptr, uintptr) uintptr
 
 // Creates a `GskRenderNode` that will add debug information about
-// the given @child.
+// the given child.
 //
 // Adding this node has no visual effect.
 func NewDebugNode(ChildVar *RenderNode, MessageVar string) *DebugNode {
@@ -825,7 +823,7 @@ func NewDebugNode(ChildVar *RenderNode, MessageVar string) *DebugNode {
 
 var xDebugNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting drawn by the given @node.
+// Gets the child node that is getting drawn by the given node.
 func (x *DebugNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -860,8 +858,8 @@ func (c *DebugNode) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// A render node filling the area given by [struct@Gsk.Path]
-// and [enum@Gsk.FillRule] with the child node.
+// A render node filling the area given by [Path]
+// and [FillRule] with the child node.
 type FillNode struct {
 	RenderNode
 }
@@ -880,8 +878,8 @@ func FillNodeNewFromInternalPtr(ptr uintptr) *FillNode {
 
 var xNewFillNode func(uintptr, *Path, FillRule) uintptr
 
-// Creates a `GskRenderNode` that will fill the @child in the area
-// given by @path and @fill_rule.
+// Creates a `GskRenderNode` that will fill the child in the area
+// given by path and fill_rule.
 func NewFillNode(ChildVar *RenderNode, PathVar *Path, FillRuleVar FillRule) *FillNode {
 	var cls *FillNode
 
@@ -898,7 +896,7 @@ func NewFillNode(ChildVar *RenderNode, PathVar *Path, FillRuleVar FillRule) *Fil
 
 var xFillNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting drawn by the given @node.
+// Gets the child node that is getting drawn by the given node.
 func (x *FillNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -925,7 +923,7 @@ func (x *FillNode) GetFillRule() FillRule {
 var xFillNodeGetPath func(uintptr) *Path
 
 // Retrieves the path used to describe the area filled with the contents of
-// the @node.
+// the node.
 func (x *FillNode) GetPath() *Path {
 
 	cret := xFillNodeGetPath(x.GoPointer())
@@ -960,73 +958,6 @@ func GLShaderNodeNewFromInternalPtr(ptr uintptr) *GLShaderNode {
 	return cls
 }
 
-var xNewGLShaderNode func(uintptr, *graphene.Rect, *glib.Bytes, uintptr, uint) uintptr
-
-// Creates a `GskRenderNode` that will render the given @shader into the
-// area given by @bounds.
-//
-// The @args is a block of data to use for uniform input, as per types and
-// offsets defined by the @shader. Normally this is generated by
-// [method@Gsk.GLShader.format_args] or [struct@Gsk.ShaderArgsBuilder].
-//
-// See [class@Gsk.GLShader] for details about how the shader should be written.
-//
-// All the children will be rendered into textures (if they aren't already
-// `GskTextureNodes`, which will be used directly). These textures will be
-// sent as input to the shader.
-//
-// If the renderer doesn't support GL shaders, or if there is any problem
-// when compiling the shader, then the node will draw pink. You should use
-// [method@Gsk.GLShader.compile] to ensure the @shader will work for the
-// renderer before using it.
-func NewGLShaderNode(ShaderVar *GLShader, BoundsVar *graphene.Rect, ArgsVar *glib.Bytes, ChildrenVar uintptr, NChildrenVar uint) *GLShaderNode {
-	var cls *GLShaderNode
-
-	cret := xNewGLShaderNode(ShaderVar.GoPointer(), BoundsVar, ArgsVar, ChildrenVar, NChildrenVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &GLShaderNode{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xGLShaderNodeGetArgs func(uintptr) *glib.Bytes
-
-// Gets args for the node.
-func (x *GLShaderNode) GetArgs() *glib.Bytes {
-
-	cret := xGLShaderNodeGetArgs(x.GoPointer())
-	return cret
-}
-
-var xGLShaderNodeGetChild func(uintptr, uint) uintptr
-
-// Gets one of the children.
-func (x *GLShaderNode) GetChild(IdxVar uint) *RenderNode {
-	var cls *RenderNode
-
-	cret := xGLShaderNodeGetChild(x.GoPointer(), IdxVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &RenderNode{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xGLShaderNodeGetNChildren func(uintptr) uint
-
-// Returns the number of children
-func (x *GLShaderNode) GetNChildren() uint {
-
-	cret := xGLShaderNodeGetNChildren(x.GoPointer())
-	return cret
-}
-
 var xGLShaderNodeGetShader func(uintptr) uintptr
 
 // Gets shader code for the node.
@@ -1075,7 +1006,7 @@ func InsetShadowNodeNewFromInternalPtr(ptr uintptr) *InsetShadowNode {
 var xNewInsetShadowNode func(*RoundedRect, *gdk.RGBA, float32, float32, float32, float32) uintptr
 
 // Creates a `GskRenderNode` that will render an inset shadow
-// into the box given by @outline.
+// into the box given by outline.
 func NewInsetShadowNode(OutlineVar *RoundedRect, ColorVar *gdk.RGBA, DxVar float32, DyVar float32, SpreadVar float32, BlurRadiusVar float32) *InsetShadowNode {
 	var cls *InsetShadowNode
 
@@ -1177,7 +1108,7 @@ func LinearGradientNodeNewFromInternalPtr(ptr uintptr) *LinearGradientNode {
 var xNewLinearGradientNode func(*graphene.Rect, *graphene.Point, *graphene.Point, []ColorStop, uint) uintptr
 
 // Creates a `GskRenderNode` that will create a linear gradient from the given
-// points and color stops, and render that into the area given by @bounds.
+// points and color stops, and render that into the area given by bounds.
 func NewLinearGradientNode(BoundsVar *graphene.Rect, StartVar *graphene.Point, EndVar *graphene.Point, ColorStopsVar []ColorStop, NColorStopsVar uint) *LinearGradientNode {
 	var cls *LinearGradientNode
 
@@ -1259,8 +1190,8 @@ var xNewMaskNode func(uintptr, uintptr, MaskMode) uintptr
 
 // Creates a `GskRenderNode` that will mask a given node by another.
 //
-// The @mask_mode determines how the 'mask values' are derived from
-// the colors of the @mask. Applying the mask consists of multiplying
+// The mask_mode determines how the 'mask values' are derived from
+// the colors of the mask. Applying the mask consists of multiplying
 // the 'mask value' with the alpha of the source.
 func NewMaskNode(SourceVar *RenderNode, MaskVar *RenderNode, MaskModeVar MaskMode) *MaskNode {
 	var cls *MaskNode
@@ -1277,7 +1208,7 @@ func NewMaskNode(SourceVar *RenderNode, MaskVar *RenderNode, MaskModeVar MaskMod
 
 var xMaskNodeGetMask func(uintptr) uintptr
 
-// Retrieves the mask `GskRenderNode` child of the @node.
+// Retrieves the mask `GskRenderNode` child of the node.
 func (x *MaskNode) GetMask() *RenderNode {
 	var cls *RenderNode
 
@@ -1294,7 +1225,7 @@ func (x *MaskNode) GetMask() *RenderNode {
 
 var xMaskNodeGetMaskMode func(uintptr) MaskMode
 
-// Retrieves the mask mode used by @node.
+// Retrieves the mask mode used by node.
 func (x *MaskNode) GetMaskMode() MaskMode {
 
 	cret := xMaskNodeGetMaskMode(x.GoPointer())
@@ -1303,7 +1234,7 @@ func (x *MaskNode) GetMaskMode() MaskMode {
 
 var xMaskNodeGetSource func(uintptr) uintptr
 
-// Retrieves the source `GskRenderNode` child of the @node.
+// Retrieves the source `GskRenderNode` child of the node.
 func (x *MaskNode) GetSource() *RenderNode {
 	var cls *RenderNode
 
@@ -1348,8 +1279,8 @@ func OpacityNodeNewFromInternalPtr(ptr uintptr) *OpacityNode {
 
 var xNewOpacityNode func(uintptr, float32) uintptr
 
-// Creates a `GskRenderNode` that will drawn the @child with reduced
-// @opacity.
+// Creates a `GskRenderNode` that will drawn the child with reduced
+// opacity.
 func NewOpacityNode(ChildVar *RenderNode, OpacityVar float32) *OpacityNode {
 	var cls *OpacityNode
 
@@ -1365,7 +1296,7 @@ func NewOpacityNode(ChildVar *RenderNode, OpacityVar float32) *OpacityNode {
 
 var xOpacityNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting opacityed by the given @node.
+// Gets the child node that is getting opacityed by the given node.
 func (x *OpacityNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -1420,7 +1351,7 @@ func OutsetShadowNodeNewFromInternalPtr(ptr uintptr) *OutsetShadowNode {
 var xNewOutsetShadowNode func(*RoundedRect, *gdk.RGBA, float32, float32, float32, float32) uintptr
 
 // Creates a `GskRenderNode` that will render an outset shadow
-// around the box given by @outline.
+// around the box given by outline.
 func NewOutsetShadowNode(OutlineVar *RoundedRect, ColorVar *gdk.RGBA, DxVar float32, DyVar float32, SpreadVar float32, BlurRadiusVar float32) *OutsetShadowNode {
 	var cls *OutsetShadowNode
 
@@ -1524,8 +1455,8 @@ var xNewRadialGradientNode func(*graphene.Rect, *graphene.Point, float32, float3
 // Creates a `GskRenderNode` that draws a radial gradient.
 //
 // The radial gradient
-// starts around @center. The size of the gradient is dictated by @hradius
-// in horizontal orientation and by @vradius in vertical orientation.
+// starts around center. The size of the gradient is dictated by hradius
+// in horizontal orientation and by vradius in vertical orientation.
 func NewRadialGradientNode(BoundsVar *graphene.Rect, CenterVar *graphene.Point, HradiusVar float32, VradiusVar float32, StartVar float32, EndVar float32, ColorStopsVar []ColorStop, NColorStopsVar uint) *RadialGradientNode {
 	var cls *RadialGradientNode
 
@@ -1632,8 +1563,8 @@ func RepeatNodeNewFromInternalPtr(ptr uintptr) *RepeatNode {
 
 var xNewRepeatNode func(*graphene.Rect, uintptr, *graphene.Rect) uintptr
 
-// Creates a `GskRenderNode` that will repeat the drawing of @child across
-// the given @bounds.
+// Creates a `GskRenderNode` that will repeat the drawing of child across
+// the given bounds.
 func NewRepeatNode(BoundsVar *graphene.Rect, ChildVar *RenderNode, ChildBoundsVar *graphene.Rect) *RepeatNode {
 	var cls *RepeatNode
 
@@ -1649,7 +1580,7 @@ func NewRepeatNode(BoundsVar *graphene.Rect, ChildVar *RenderNode, ChildBoundsVa
 
 var xRepeatNodeGetChild func(uintptr) uintptr
 
-// Retrieves the child of @node.
+// Retrieves the child of node.
 func (x *RepeatNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -1666,7 +1597,7 @@ func (x *RepeatNode) GetChild() *RenderNode {
 
 var xRepeatNodeGetChildBounds func(uintptr) *graphene.Rect
 
-// Retrieves the bounding rectangle of the child of @node.
+// Retrieves the bounding rectangle of the child of node.
 func (x *RepeatNode) GetChildBounds() *graphene.Rect {
 
 	cret := xRepeatNodeGetChildBounds(x.GoPointer())
@@ -1705,7 +1636,7 @@ var xNewRepeatingLinearGradientNode func(*graphene.Rect, *graphene.Point, *graph
 
 // Creates a `GskRenderNode` that will create a repeating linear gradient
 // from the given points and color stops, and render that into the area
-// given by @bounds.
+// given by bounds.
 func NewRepeatingLinearGradientNode(BoundsVar *graphene.Rect, StartVar *graphene.Point, EndVar *graphene.Point, ColorStopsVar []ColorStop, NColorStopsVar uint) *RepeatingLinearGradientNode {
 	var cls *RepeatingLinearGradientNode
 
@@ -1751,8 +1682,8 @@ var xNewRepeatingRadialGradientNode func(*graphene.Rect, *graphene.Point, float3
 
 // Creates a `GskRenderNode` that draws a repeating radial gradient.
 //
-// The radial gradient starts around @center. The size of the gradient
-// is dictated by @hradius in horizontal orientation and by @vradius
+// The radial gradient starts around center. The size of the gradient
+// is dictated by hradius in horizontal orientation and by vradius
 // in vertical orientation.
 func NewRepeatingRadialGradientNode(BoundsVar *graphene.Rect, CenterVar *graphene.Point, HradiusVar float32, VradiusVar float32, StartVar float32, EndVar float32, ColorStopsVar []ColorStop, NColorStopsVar uint) *RepeatingRadialGradientNode {
 	var cls *RepeatingRadialGradientNode
@@ -1797,8 +1728,8 @@ func RoundedClipNodeNewFromInternalPtr(ptr uintptr) *RoundedClipNode {
 
 var xNewRoundedClipNode func(uintptr, *RoundedRect) uintptr
 
-// Creates a `GskRenderNode` that will clip the @child to the area
-// given by @clip.
+// Creates a `GskRenderNode` that will clip the child to the area
+// given by clip.
 func NewRoundedClipNode(ChildVar *RenderNode, ClipVar *RoundedRect) *RoundedClipNode {
 	var cls *RoundedClipNode
 
@@ -1814,7 +1745,7 @@ func NewRoundedClipNode(ChildVar *RenderNode, ClipVar *RoundedRect) *RoundedClip
 
 var xRoundedClipNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting clipped by the given @node.
+// Gets the child node that is getting clipped by the given node.
 func (x *RoundedClipNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -1831,7 +1762,7 @@ func (x *RoundedClipNode) GetChild() *RenderNode {
 
 var xRoundedClipNodeGetClip func(uintptr) *RoundedRect
 
-// Retrieves the rounded rectangle used to clip the contents of the @node.
+// Retrieves the rounded rectangle used to clip the contents of the node.
 func (x *RoundedClipNode) GetClip() *RoundedRect {
 
 	cret := xRoundedClipNodeGetClip(x.GoPointer())
@@ -1868,8 +1799,8 @@ func ShadowNodeNewFromInternalPtr(ptr uintptr) *ShadowNode {
 
 var xNewShadowNode func(uintptr, []Shadow, uint) uintptr
 
-// Creates a `GskRenderNode` that will draw a @child with the given
-// @shadows below it.
+// Creates a `GskRenderNode` that will draw a child with the given
+// shadows below it.
 func NewShadowNode(ChildVar *RenderNode, ShadowsVar []Shadow, NShadowsVar uint) *ShadowNode {
 	var cls *ShadowNode
 
@@ -1885,7 +1816,7 @@ func NewShadowNode(ChildVar *RenderNode, ShadowsVar []Shadow, NShadowsVar uint)
 
 var xShadowNodeGetChild func(uintptr) uintptr
 
-// Retrieves the child `GskRenderNode` of the shadow @node.
+// Retrieves the child `GskRenderNode` of the shadow node.
 func (x *ShadowNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -1902,7 +1833,7 @@ func (x *ShadowNode) GetChild() *RenderNode {
 
 var xShadowNodeGetNShadows func(uintptr) uint
 
-// Retrieves the number of shadows in the @node.
+// Retrieves the number of shadows in the node.
 func (x *ShadowNode) GetNShadows() uint {
 
 	cret := xShadowNodeGetNShadows(x.GoPointer())
@@ -1911,7 +1842,7 @@ func (x *ShadowNode) GetNShadows() uint {
 
 var xShadowNodeGetShadow func(uintptr, uint) *Shadow
 
-// Retrieves the shadow data at the given index @i.
+// Retrieves the shadow data at the given index i.
 func (x *ShadowNode) GetShadow(IVar uint) *Shadow {
 
 	cret := xShadowNodeGetShadow(x.GoPointer(), IVar)
@@ -1930,7 +1861,7 @@ func (c *ShadowNode) SetGoPointer(ptr uintptr) {
 }
 
 // A render node that will fill the area determined by stroking the the given
-// [struct@Gsk.Path] using the [struct@Gsk.Stroke] attributes.
+// [Path] using the [Stroke] attributes.
 type StrokeNode struct {
 	RenderNode
 }
@@ -1949,10 +1880,10 @@ func StrokeNodeNewFromInternalPtr(ptr uintptr) *StrokeNode {
 
 var xNewStrokeNode func(uintptr, *Path, *Stroke) uintptr
 
-// Creates a #GskRenderNode that will fill the outline generated by stroking
-// the given @path using the attributes defined in @stroke.
+// Creates a [RenderNode] that will fill the outline generated by stroking
+// the given path using the attributes defined in stroke.
 //
-// The area is filled with @child.
+// The area is filled with child.
 func NewStrokeNode(ChildVar *RenderNode, PathVar *Path, StrokeVar *Stroke) *StrokeNode {
 	var cls *StrokeNode
 
@@ -1969,7 +1900,7 @@ func NewStrokeNode(ChildVar *RenderNode, PathVar *Path, StrokeVar *Stroke) *Stro
 
 var xStrokeNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting drawn by the given @node.
+// Gets the child node that is getting drawn by the given node.
 func (x *StrokeNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -1987,7 +1918,7 @@ func (x *StrokeNode) GetChild() *RenderNode {
 var xStrokeNodeGetPath func(uintptr) *Path
 
 // Retrieves the path that will be stroked with the contents of
-// the @node.
+// the node.
 func (x *StrokeNode) GetPath() *Path {
 
 	cret := xStrokeNodeGetPath(x.GoPointer())
@@ -1996,7 +1927,7 @@ func (x *StrokeNode) GetPath() *Path {
 
 var xStrokeNodeGetStroke func(uintptr) *Stroke
 
-// Retrieves the stroke attributes used in this @node.
+// Retrieves the stroke attributes used in this node.
 func (x *StrokeNode) GetStroke() *Stroke {
 
 	cret := xStrokeNodeGetStroke(x.GoPointer())
@@ -2038,7 +1969,7 @@ var xNewSubsurfaceNode func(uintptr, uintptr) uintptr
 //
 // Note: Since subsurfaces are currently private, these nodes cannot
 // currently be created outside of GTK. See
-// [GtkGraphicsOffload](../gtk4/class.GraphicsOffload.html).
+// GtkGraphicsOffload.
 func NewSubsurfaceNode(ChildVar *RenderNode, SubsurfaceVar uintptr) *SubsurfaceNode {
 	var cls *SubsurfaceNode
 
@@ -2054,7 +1985,7 @@ func NewSubsurfaceNode(ChildVar *RenderNode, SubsurfaceVar uintptr) *SubsurfaceN
 
 var xSubsurfaceNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting drawn by the given @node.
+// Gets the child node that is getting drawn by the given node.
 func (x *SubsurfaceNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -2110,7 +2041,7 @@ var xNewTextNode func(uintptr, *pango.GlyphString, *gdk.RGBA, *graphene.Point) u
 
 // Creates a render node that renders the given glyphs.
 //
-// Note that @color may not be used if the font contains
+// Note that color may not be used if the font contains
 // color glyphs.
 func NewTextNode(FontVar *pango.Font, GlyphsVar *pango.GlyphString, ColorVar *gdk.RGBA, OffsetVar *graphene.Point) *TextNode {
 	var cls *TextNode
@@ -2127,7 +2058,7 @@ func NewTextNode(FontVar *pango.Font, GlyphsVar *pango.GlyphString, ColorVar *gd
 
 var xTextNodeGetColor func(uintptr) *gdk.RGBA
 
-// Retrieves the color used by the text @node.
+// Retrieves the color used by the text node.
 //
 // The value returned by this function will not be correct
 // if the render node was created for a non-sRGB color.
@@ -2139,7 +2070,7 @@ func (x *TextNode) GetColor() *gdk.RGBA {
 
 var xTextNodeGetFont func(uintptr) uintptr
 
-// Returns the font used by the text @node.
+// Returns the font used by the text node.
 func (x *TextNode) GetFont() *pango.Font {
 	var cls *pango.Font
 
@@ -2156,7 +2087,7 @@ func (x *TextNode) GetFont() *pango.Font {
 
 var xTextNodeGetGlyphs func(uintptr, *uint) uintptr
 
-// Retrieves the glyph information in the @node.
+// Retrieves the glyph information in the node.
 func (x *TextNode) GetGlyphs(NGlyphsVar *uint) uintptr {
 
 	cret := xTextNodeGetGlyphs(x.GoPointer(), NGlyphsVar)
@@ -2183,7 +2114,7 @@ func (x *TextNode) GetOffset() *graphene.Point {
 
 var xTextNodeHasColorGlyphs func(uintptr) bool
 
-// Checks whether the text @node has color glyphs.
+// Checks whether the text node has color glyphs.
 func (x *TextNode) HasColorGlyphs() bool {
 
 	cret := xTextNodeHasColorGlyphs(x.GoPointer())
@@ -2221,10 +2152,10 @@ func TextureNodeNewFromInternalPtr(ptr uintptr) *TextureNode {
 var xNewTextureNode func(uintptr, *graphene.Rect) uintptr
 
 // Creates a `GskRenderNode` that will render the given
-// @texture into the area given by @bounds.
+// texture into the area given by bounds.
 //
 // Note that GSK applies linear filtering when textures are
-// scaled and transformed. See [class@Gsk.TextureScaleNode]
+// scaled and transformed. See [TextureScaleNode]
 // for a way to influence filtering.
 func NewTextureNode(TextureVar *gdk.Texture, BoundsVar *graphene.Rect) *TextureNode {
 	var cls *TextureNode
@@ -2367,8 +2298,8 @@ func TransformNodeNewFromInternalPtr(ptr uintptr) *TransformNode {
 
 var xNewTransformNode func(uintptr, *Transform) uintptr
 
-// Creates a `GskRenderNode` that will transform the given @child
-// with the given @transform.
+// Creates a `GskRenderNode` that will transform the given child
+// with the given transform.
 func NewTransformNode(ChildVar *RenderNode, TransformVar *Transform) *TransformNode {
 	var cls *TransformNode
 
@@ -2384,7 +2315,7 @@ func NewTransformNode(ChildVar *RenderNode, TransformVar *Transform) *TransformN
 
 var xTransformNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting transformed by the given @node.
+// Gets the child node that is getting transformed by the given node.
 func (x *TransformNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -2401,7 +2332,7 @@ func (x *TransformNode) GetChild() *RenderNode {
 
 var xTransformNodeGetTransform func(uintptr) *Transform
 
-// Retrieves the `GskTransform` used by the @node.
+// Retrieves the `GskTransform` used by the node.
 func (x *TransformNode) GetTransform() *Transform {
 
 	cret := xTransformNodeGetTransform(x.GoPointer())
@@ -2424,7 +2355,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2433,237 +2364,232 @@ func init() {
 
 	core.PuregoSafeRegister(&xBlendNodeGLibType, libs, "gsk_blend_node_get_type")
 
-	core.PuregoSafeRegister(&xNewBlendNode, libs, "gsk_blend_node_new")
+	core.PuregoRegisterSince(&xNewBlendNode, libs, "gsk_blend_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xBlendNodeGetBlendMode, libs, "gsk_blend_node_get_blend_mode")
-	core.PuregoSafeRegister(&xBlendNodeGetBottomChild, libs, "gsk_blend_node_get_bottom_child")
-	core.PuregoSafeRegister(&xBlendNodeGetTopChild, libs, "gsk_blend_node_get_top_child")
+	core.PuregoRegisterSince(&xBlendNodeGetBlendMode, libs, "gsk_blend_node_get_blend_mode", "GSK", "")
+	core.PuregoRegisterSince(&xBlendNodeGetBottomChild, libs, "gsk_blend_node_get_bottom_child", "GSK", "")
+	core.PuregoRegisterSince(&xBlendNodeGetTopChild, libs, "gsk_blend_node_get_top_child", "GSK", "")
 
 	core.PuregoSafeRegister(&xBlurNodeGLibType, libs, "gsk_blur_node_get_type")
 
-	core.PuregoSafeRegister(&xNewBlurNode, libs, "gsk_blur_node_new")
+	core.PuregoRegisterSince(&xNewBlurNode, libs, "gsk_blur_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xBlurNodeGetChild, libs, "gsk_blur_node_get_child")
-	core.PuregoSafeRegister(&xBlurNodeGetRadius, libs, "gsk_blur_node_get_radius")
+	core.PuregoRegisterSince(&xBlurNodeGetChild, libs, "gsk_blur_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xBlurNodeGetRadius, libs, "gsk_blur_node_get_radius", "GSK", "")
 
 	core.PuregoSafeRegister(&xBorderNodeGLibType, libs, "gsk_border_node_get_type")
 
-	core.PuregoSafeRegister(&xNewBorderNode, libs, "gsk_border_node_new")
+	core.PuregoRegisterSince(&xNewBorderNode, libs, "gsk_border_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xBorderNodeGetColors, libs, "gsk_border_node_get_colors")
-	core.PuregoSafeRegister(&xBorderNodeGetOutline, libs, "gsk_border_node_get_outline")
-	core.PuregoSafeRegister(&xBorderNodeGetWidths, libs, "gsk_border_node_get_widths")
+	core.PuregoRegisterSince(&xBorderNodeGetColors, libs, "gsk_border_node_get_colors", "GSK", "")
+	core.PuregoRegisterSince(&xBorderNodeGetOutline, libs, "gsk_border_node_get_outline", "GSK", "")
+	core.PuregoRegisterSince(&xBorderNodeGetWidths, libs, "gsk_border_node_get_widths", "GSK", "")
 
 	core.PuregoSafeRegister(&xCairoNodeGLibType, libs, "gsk_cairo_node_get_type")
 
-	core.PuregoSafeRegister(&xNewCairoNode, libs, "gsk_cairo_node_new")
+	core.PuregoRegisterSince(&xNewCairoNode, libs, "gsk_cairo_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xCairoNodeGetDrawContext, libs, "gsk_cairo_node_get_draw_context")
-	core.PuregoSafeRegister(&xCairoNodeGetSurface, libs, "gsk_cairo_node_get_surface")
+	core.PuregoRegisterSince(&xCairoNodeGetDrawContext, libs, "gsk_cairo_node_get_draw_context", "GSK", "")
+	core.PuregoRegisterSince(&xCairoNodeGetSurface, libs, "gsk_cairo_node_get_surface", "GSK", "")
 
 	core.PuregoSafeRegister(&xClipNodeGLibType, libs, "gsk_clip_node_get_type")
 
-	core.PuregoSafeRegister(&xNewClipNode, libs, "gsk_clip_node_new")
+	core.PuregoRegisterSince(&xNewClipNode, libs, "gsk_clip_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xClipNodeGetChild, libs, "gsk_clip_node_get_child")
-	core.PuregoSafeRegister(&xClipNodeGetClip, libs, "gsk_clip_node_get_clip")
+	core.PuregoRegisterSince(&xClipNodeGetChild, libs, "gsk_clip_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xClipNodeGetClip, libs, "gsk_clip_node_get_clip", "GSK", "")
 
 	core.PuregoSafeRegister(&xColorMatrixNodeGLibType, libs, "gsk_color_matrix_node_get_type")
 
-	core.PuregoSafeRegister(&xNewColorMatrixNode, libs, "gsk_color_matrix_node_new")
+	core.PuregoRegisterSince(&xNewColorMatrixNode, libs, "gsk_color_matrix_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xColorMatrixNodeGetChild, libs, "gsk_color_matrix_node_get_child")
-	core.PuregoSafeRegister(&xColorMatrixNodeGetColorMatrix, libs, "gsk_color_matrix_node_get_color_matrix")
-	core.PuregoSafeRegister(&xColorMatrixNodeGetColorOffset, libs, "gsk_color_matrix_node_get_color_offset")
+	core.PuregoRegisterSince(&xColorMatrixNodeGetChild, libs, "gsk_color_matrix_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xColorMatrixNodeGetColorMatrix, libs, "gsk_color_matrix_node_get_color_matrix", "GSK", "")
+	core.PuregoRegisterSince(&xColorMatrixNodeGetColorOffset, libs, "gsk_color_matrix_node_get_color_offset", "GSK", "")
 
 	core.PuregoSafeRegister(&xColorNodeGLibType, libs, "gsk_color_node_get_type")
 
-	core.PuregoSafeRegister(&xNewColorNode, libs, "gsk_color_node_new")
+	core.PuregoRegisterSince(&xNewColorNode, libs, "gsk_color_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xColorNodeGetColor, libs, "gsk_color_node_get_color")
+	core.PuregoRegisterSince(&xColorNodeGetColor, libs, "gsk_color_node_get_color", "GSK", "")
 
 	core.PuregoSafeRegister(&xConicGradientNodeGLibType, libs, "gsk_conic_gradient_node_get_type")
 
-	core.PuregoSafeRegister(&xNewConicGradientNode, libs, "gsk_conic_gradient_node_new")
+	core.PuregoRegisterSince(&xNewConicGradientNode, libs, "gsk_conic_gradient_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xConicGradientNodeGetAngle, libs, "gsk_conic_gradient_node_get_angle")
-	core.PuregoSafeRegister(&xConicGradientNodeGetCenter, libs, "gsk_conic_gradient_node_get_center")
-	core.PuregoSafeRegister(&xConicGradientNodeGetColorStops, libs, "gsk_conic_gradient_node_get_color_stops")
-	core.PuregoSafeRegister(&xConicGradientNodeGetNColorStops, libs, "gsk_conic_gradient_node_get_n_color_stops")
-	core.PuregoSafeRegister(&xConicGradientNodeGetRotation, libs, "gsk_conic_gradient_node_get_rotation")
+	core.PuregoRegisterSince(&xConicGradientNodeGetAngle, libs, "gsk_conic_gradient_node_get_angle", "GSK", "4.2")
+	core.PuregoRegisterSince(&xConicGradientNodeGetCenter, libs, "gsk_conic_gradient_node_get_center", "GSK", "")
+	core.PuregoRegisterSince(&xConicGradientNodeGetColorStops, libs, "gsk_conic_gradient_node_get_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xConicGradientNodeGetNColorStops, libs, "gsk_conic_gradient_node_get_n_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xConicGradientNodeGetRotation, libs, "gsk_conic_gradient_node_get_rotation", "GSK", "")
 
 	core.PuregoSafeRegister(&xContainerNodeGLibType, libs, "gsk_container_node_get_type")
 
-	core.PuregoSafeRegister(&xNewContainerNode, libs, "gsk_container_node_new")
+	core.PuregoRegisterSince(&xNewContainerNode, libs, "gsk_container_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xContainerNodeGetChild, libs, "gsk_container_node_get_child")
-	core.PuregoSafeRegister(&xContainerNodeGetNChildren, libs, "gsk_container_node_get_n_children")
+	core.PuregoRegisterSince(&xContainerNodeGetChild, libs, "gsk_container_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xContainerNodeGetNChildren, libs, "gsk_container_node_get_n_children", "GSK", "")
 
 	core.PuregoSafeRegister(&xCrossFadeNodeGLibType, libs, "gsk_cross_fade_node_get_type")
 
-	core.PuregoSafeRegister(&xNewCrossFadeNode, libs, "gsk_cross_fade_node_new")
+	core.PuregoRegisterSince(&xNewCrossFadeNode, libs, "gsk_cross_fade_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xCrossFadeNodeGetEndChild, libs, "gsk_cross_fade_node_get_end_child")
-	core.PuregoSafeRegister(&xCrossFadeNodeGetProgress, libs, "gsk_cross_fade_node_get_progress")
-	core.PuregoSafeRegister(&xCrossFadeNodeGetStartChild, libs, "gsk_cross_fade_node_get_start_child")
+	core.PuregoRegisterSince(&xCrossFadeNodeGetEndChild, libs, "gsk_cross_fade_node_get_end_child", "GSK", "")
+	core.PuregoRegisterSince(&xCrossFadeNodeGetProgress, libs, "gsk_cross_fade_node_get_progress", "GSK", "")
+	core.PuregoRegisterSince(&xCrossFadeNodeGetStartChild, libs, "gsk_cross_fade_node_get_start_child", "GSK", "")
 
 	core.PuregoSafeRegister(&xDebugNodeGLibType, libs, "gsk_debug_node_get_type")
 
-	core.PuregoSafeRegister(&xNewDebugNode, libs, "gsk_debug_node_new")
+	core.PuregoRegisterSince(&xNewDebugNode, libs, "gsk_debug_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xDebugNodeGetChild, libs, "gsk_debug_node_get_child")
-	core.PuregoSafeRegister(&xDebugNodeGetMessage, libs, "gsk_debug_node_get_message")
+	core.PuregoRegisterSince(&xDebugNodeGetChild, libs, "gsk_debug_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xDebugNodeGetMessage, libs, "gsk_debug_node_get_message", "GSK", "")
 
 	core.PuregoSafeRegister(&xFillNodeGLibType, libs, "gsk_fill_node_get_type")
 
-	core.PuregoSafeRegister(&xNewFillNode, libs, "gsk_fill_node_new")
+	core.PuregoRegisterSince(&xNewFillNode, libs, "gsk_fill_node_new", "GSK", "4.14")
 
-	core.PuregoSafeRegister(&xFillNodeGetChild, libs, "gsk_fill_node_get_child")
-	core.PuregoSafeRegister(&xFillNodeGetFillRule, libs, "gsk_fill_node_get_fill_rule")
-	core.PuregoSafeRegister(&xFillNodeGetPath, libs, "gsk_fill_node_get_path")
+	core.PuregoRegisterSince(&xFillNodeGetChild, libs, "gsk_fill_node_get_child", "GSK", "4.14")
+	core.PuregoRegisterSince(&xFillNodeGetFillRule, libs, "gsk_fill_node_get_fill_rule", "GSK", "4.14")
+	core.PuregoRegisterSince(&xFillNodeGetPath, libs, "gsk_fill_node_get_path", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xGLShaderNodeGLibType, libs, "gsk_gl_shader_node_get_type")
 
-	core.PuregoSafeRegister(&xNewGLShaderNode, libs, "gsk_gl_shader_node_new")
-
-	core.PuregoSafeRegister(&xGLShaderNodeGetArgs, libs, "gsk_gl_shader_node_get_args")
-	core.PuregoSafeRegister(&xGLShaderNodeGetChild, libs, "gsk_gl_shader_node_get_child")
-	core.PuregoSafeRegister(&xGLShaderNodeGetNChildren, libs, "gsk_gl_shader_node_get_n_children")
-	core.PuregoSafeRegister(&xGLShaderNodeGetShader, libs, "gsk_gl_shader_node_get_shader")
+	core.PuregoRegisterSince(&xGLShaderNodeGetShader, libs, "gsk_gl_shader_node_get_shader", "GSK", "")
 
 	core.PuregoSafeRegister(&xInsetShadowNodeGLibType, libs, "gsk_inset_shadow_node_get_type")
 
-	core.PuregoSafeRegister(&xNewInsetShadowNode, libs, "gsk_inset_shadow_node_new")
+	core.PuregoRegisterSince(&xNewInsetShadowNode, libs, "gsk_inset_shadow_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xInsetShadowNodeGetBlurRadius, libs, "gsk_inset_shadow_node_get_blur_radius")
-	core.PuregoSafeRegister(&xInsetShadowNodeGetColor, libs, "gsk_inset_shadow_node_get_color")
-	core.PuregoSafeRegister(&xInsetShadowNodeGetDx, libs, "gsk_inset_shadow_node_get_dx")
-	core.PuregoSafeRegister(&xInsetShadowNodeGetDy, libs, "gsk_inset_shadow_node_get_dy")
-	core.PuregoSafeRegister(&xInsetShadowNodeGetOutline, libs, "gsk_inset_shadow_node_get_outline")
-	core.PuregoSafeRegister(&xInsetShadowNodeGetSpread, libs, "gsk_inset_shadow_node_get_spread")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetBlurRadius, libs, "gsk_inset_shadow_node_get_blur_radius", "GSK", "")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetColor, libs, "gsk_inset_shadow_node_get_color", "GSK", "")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetDx, libs, "gsk_inset_shadow_node_get_dx", "GSK", "")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetDy, libs, "gsk_inset_shadow_node_get_dy", "GSK", "")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetOutline, libs, "gsk_inset_shadow_node_get_outline", "GSK", "")
+	core.PuregoRegisterSince(&xInsetShadowNodeGetSpread, libs, "gsk_inset_shadow_node_get_spread", "GSK", "")
 
 	core.PuregoSafeRegister(&xLinearGradientNodeGLibType, libs, "gsk_linear_gradient_node_get_type")
 
-	core.PuregoSafeRegister(&xNewLinearGradientNode, libs, "gsk_linear_gradient_node_new")
+	core.PuregoRegisterSince(&xNewLinearGradientNode, libs, "gsk_linear_gradient_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xLinearGradientNodeGetColorStops, libs, "gsk_linear_gradient_node_get_color_stops")
-	core.PuregoSafeRegister(&xLinearGradientNodeGetEnd, libs, "gsk_linear_gradient_node_get_end")
-	core.PuregoSafeRegister(&xLinearGradientNodeGetNColorStops, libs, "gsk_linear_gradient_node_get_n_color_stops")
-	core.PuregoSafeRegister(&xLinearGradientNodeGetStart, libs, "gsk_linear_gradient_node_get_start")
+	core.PuregoRegisterSince(&xLinearGradientNodeGetColorStops, libs, "gsk_linear_gradient_node_get_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xLinearGradientNodeGetEnd, libs, "gsk_linear_gradient_node_get_end", "GSK", "")
+	core.PuregoRegisterSince(&xLinearGradientNodeGetNColorStops, libs, "gsk_linear_gradient_node_get_n_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xLinearGradientNodeGetStart, libs, "gsk_linear_gradient_node_get_start", "GSK", "")
 
 	core.PuregoSafeRegister(&xMaskNodeGLibType, libs, "gsk_mask_node_get_type")
 
-	core.PuregoSafeRegister(&xNewMaskNode, libs, "gsk_mask_node_new")
+	core.PuregoRegisterSince(&xNewMaskNode, libs, "gsk_mask_node_new", "GSK", "4.10")
 
-	core.PuregoSafeRegister(&xMaskNodeGetMask, libs, "gsk_mask_node_get_mask")
-	core.PuregoSafeRegister(&xMaskNodeGetMaskMode, libs, "gsk_mask_node_get_mask_mode")
-	core.PuregoSafeRegister(&xMaskNodeGetSource, libs, "gsk_mask_node_get_source")
+	core.PuregoRegisterSince(&xMaskNodeGetMask, libs, "gsk_mask_node_get_mask", "GSK", "4.10")
+	core.PuregoRegisterSince(&xMaskNodeGetMaskMode, libs, "gsk_mask_node_get_mask_mode", "GSK", "4.10")
+	core.PuregoRegisterSince(&xMaskNodeGetSource, libs, "gsk_mask_node_get_source", "GSK", "4.10")
 
 	core.PuregoSafeRegister(&xOpacityNodeGLibType, libs, "gsk_opacity_node_get_type")
 
-	core.PuregoSafeRegister(&xNewOpacityNode, libs, "gsk_opacity_node_new")
+	core.PuregoRegisterSince(&xNewOpacityNode, libs, "gsk_opacity_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xOpacityNodeGetChild, libs, "gsk_opacity_node_get_child")
-	core.PuregoSafeRegister(&xOpacityNodeGetOpacity, libs, "gsk_opacity_node_get_opacity")
+	core.PuregoRegisterSince(&xOpacityNodeGetChild, libs, "gsk_opacity_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xOpacityNodeGetOpacity, libs, "gsk_opacity_node_get_opacity", "GSK", "")
 
 	core.PuregoSafeRegister(&xOutsetShadowNodeGLibType, libs, "gsk_outset_shadow_node_get_type")
 
-	core.PuregoSafeRegister(&xNewOutsetShadowNode, libs, "gsk_outset_shadow_node_new")
+	core.PuregoRegisterSince(&xNewOutsetShadowNode, libs, "gsk_outset_shadow_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetBlurRadius, libs, "gsk_outset_shadow_node_get_blur_radius")
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetColor, libs, "gsk_outset_shadow_node_get_color")
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetDx, libs, "gsk_outset_shadow_node_get_dx")
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetDy, libs, "gsk_outset_shadow_node_get_dy")
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetOutline, libs, "gsk_outset_shadow_node_get_outline")
-	core.PuregoSafeRegister(&xOutsetShadowNodeGetSpread, libs, "gsk_outset_shadow_node_get_spread")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetBlurRadius, libs, "gsk_outset_shadow_node_get_blur_radius", "GSK", "")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetColor, libs, "gsk_outset_shadow_node_get_color", "GSK", "")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetDx, libs, "gsk_outset_shadow_node_get_dx", "GSK", "")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetDy, libs, "gsk_outset_shadow_node_get_dy", "GSK", "")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetOutline, libs, "gsk_outset_shadow_node_get_outline", "GSK", "")
+	core.PuregoRegisterSince(&xOutsetShadowNodeGetSpread, libs, "gsk_outset_shadow_node_get_spread", "GSK", "")
 
 	core.PuregoSafeRegister(&xRadialGradientNodeGLibType, libs, "gsk_radial_gradient_node_get_type")
 
-	core.PuregoSafeRegister(&xNewRadialGradientNode, libs, "gsk_radial_gradient_node_new")
+	core.PuregoRegisterSince(&xNewRadialGradientNode, libs, "gsk_radial_gradient_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xRadialGradientNodeGetCenter, libs, "gsk_radial_gradient_node_get_center")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetColorStops, libs, "gsk_radial_gradient_node_get_color_stops")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetEnd, libs, "gsk_radial_gradient_node_get_end")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetHradius, libs, "gsk_radial_gradient_node_get_hradius")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetNColorStops, libs, "gsk_radial_gradient_node_get_n_color_stops")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetStart, libs, "gsk_radial_gradient_node_get_start")
-	core.PuregoSafeRegister(&xRadialGradientNodeGetVradius, libs, "gsk_radial_gradient_node_get_vradius")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetCenter, libs, "gsk_radial_gradient_node_get_center", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetColorStops, libs, "gsk_radial_gradient_node_get_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetEnd, libs, "gsk_radial_gradient_node_get_end", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetHradius, libs, "gsk_radial_gradient_node_get_hradius", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetNColorStops, libs, "gsk_radial_gradient_node_get_n_color_stops", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetStart, libs, "gsk_radial_gradient_node_get_start", "GSK", "")
+	core.PuregoRegisterSince(&xRadialGradientNodeGetVradius, libs, "gsk_radial_gradient_node_get_vradius", "GSK", "")
 
 	core.PuregoSafeRegister(&xRepeatNodeGLibType, libs, "gsk_repeat_node_get_type")
 
-	core.PuregoSafeRegister(&xNewRepeatNode, libs, "gsk_repeat_node_new")
+	core.PuregoRegisterSince(&xNewRepeatNode, libs, "gsk_repeat_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xRepeatNodeGetChild, libs, "gsk_repeat_node_get_child")
-	core.PuregoSafeRegister(&xRepeatNodeGetChildBounds, libs, "gsk_repeat_node_get_child_bounds")
+	core.PuregoRegisterSince(&xRepeatNodeGetChild, libs, "gsk_repeat_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xRepeatNodeGetChildBounds, libs, "gsk_repeat_node_get_child_bounds", "GSK", "")
 
 	core.PuregoSafeRegister(&xRepeatingLinearGradientNodeGLibType, libs, "gsk_repeating_linear_gradient_node_get_type")
 
-	core.PuregoSafeRegister(&xNewRepeatingLinearGradientNode, libs, "gsk_repeating_linear_gradient_node_new")
+	core.PuregoRegisterSince(&xNewRepeatingLinearGradientNode, libs, "gsk_repeating_linear_gradient_node_new", "GSK", "")
 
 	core.PuregoSafeRegister(&xRepeatingRadialGradientNodeGLibType, libs, "gsk_repeating_radial_gradient_node_get_type")
 
-	core.PuregoSafeRegister(&xNewRepeatingRadialGradientNode, libs, "gsk_repeating_radial_gradient_node_new")
+	core.PuregoRegisterSince(&xNewRepeatingRadialGradientNode, libs, "gsk_repeating_radial_gradient_node_new", "GSK", "")
 
 	core.PuregoSafeRegister(&xRoundedClipNodeGLibType, libs, "gsk_rounded_clip_node_get_type")
 
-	core.PuregoSafeRegister(&xNewRoundedClipNode, libs, "gsk_rounded_clip_node_new")
+	core.PuregoRegisterSince(&xNewRoundedClipNode, libs, "gsk_rounded_clip_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xRoundedClipNodeGetChild, libs, "gsk_rounded_clip_node_get_child")
-	core.PuregoSafeRegister(&xRoundedClipNodeGetClip, libs, "gsk_rounded_clip_node_get_clip")
+	core.PuregoRegisterSince(&xRoundedClipNodeGetChild, libs, "gsk_rounded_clip_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedClipNodeGetClip, libs, "gsk_rounded_clip_node_get_clip", "GSK", "")
 
 	core.PuregoSafeRegister(&xShadowNodeGLibType, libs, "gsk_shadow_node_get_type")
 
-	core.PuregoSafeRegister(&xNewShadowNode, libs, "gsk_shadow_node_new")
+	core.PuregoRegisterSince(&xNewShadowNode, libs, "gsk_shadow_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xShadowNodeGetChild, libs, "gsk_shadow_node_get_child")
-	core.PuregoSafeRegister(&xShadowNodeGetNShadows, libs, "gsk_shadow_node_get_n_shadows")
-	core.PuregoSafeRegister(&xShadowNodeGetShadow, libs, "gsk_shadow_node_get_shadow")
+	core.PuregoRegisterSince(&xShadowNodeGetChild, libs, "gsk_shadow_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xShadowNodeGetNShadows, libs, "gsk_shadow_node_get_n_shadows", "GSK", "")
+	core.PuregoRegisterSince(&xShadowNodeGetShadow, libs, "gsk_shadow_node_get_shadow", "GSK", "")
 
 	core.PuregoSafeRegister(&xStrokeNodeGLibType, libs, "gsk_stroke_node_get_type")
 
-	core.PuregoSafeRegister(&xNewStrokeNode, libs, "gsk_stroke_node_new")
+	core.PuregoRegisterSince(&xNewStrokeNode, libs, "gsk_stroke_node_new", "GSK", "4.14")
 
-	core.PuregoSafeRegister(&xStrokeNodeGetChild, libs, "gsk_stroke_node_get_child")
-	core.PuregoSafeRegister(&xStrokeNodeGetPath, libs, "gsk_stroke_node_get_path")
-	core.PuregoSafeRegister(&xStrokeNodeGetStroke, libs, "gsk_stroke_node_get_stroke")
+	core.PuregoRegisterSince(&xStrokeNodeGetChild, libs, "gsk_stroke_node_get_child", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeNodeGetPath, libs, "gsk_stroke_node_get_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeNodeGetStroke, libs, "gsk_stroke_node_get_stroke", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xSubsurfaceNodeGLibType, libs, "gsk_subsurface_node_get_type")
 
-	core.PuregoSafeRegister(&xNewSubsurfaceNode, libs, "gsk_subsurface_node_new")
+	core.PuregoRegisterSince(&xNewSubsurfaceNode, libs, "gsk_subsurface_node_new", "GSK", "4.14")
 
-	core.PuregoSafeRegister(&xSubsurfaceNodeGetChild, libs, "gsk_subsurface_node_get_child")
+	core.PuregoRegisterSince(&xSubsurfaceNodeGetChild, libs, "gsk_subsurface_node_get_child", "GSK", "4.14")
 
-	core.PuregoSafeRegister(&xSubsurfaceNodeGetSubsurface, libs, "gsk_subsurface_node_get_subsurface")
+	core.PuregoRegisterSince(&xSubsurfaceNodeGetSubsurface, libs, "gsk_subsurface_node_get_subsurface", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xTextNodeGLibType, libs, "gsk_text_node_get_type")
 
-	core.PuregoSafeRegister(&xNewTextNode, libs, "gsk_text_node_new")
+	core.PuregoRegisterSince(&xNewTextNode, libs, "gsk_text_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xTextNodeGetColor, libs, "gsk_text_node_get_color")
-	core.PuregoSafeRegister(&xTextNodeGetFont, libs, "gsk_text_node_get_font")
-	core.PuregoSafeRegister(&xTextNodeGetGlyphs, libs, "gsk_text_node_get_glyphs")
-	core.PuregoSafeRegister(&xTextNodeGetNumGlyphs, libs, "gsk_text_node_get_num_glyphs")
-	core.PuregoSafeRegister(&xTextNodeGetOffset, libs, "gsk_text_node_get_offset")
-	core.PuregoSafeRegister(&xTextNodeHasColorGlyphs, libs, "gsk_text_node_has_color_glyphs")
+	core.PuregoRegisterSince(&xTextNodeGetColor, libs, "gsk_text_node_get_color", "GSK", "")
+	core.PuregoRegisterSince(&xTextNodeGetFont, libs, "gsk_text_node_get_font", "GSK", "")
+	core.PuregoRegisterSince(&xTextNodeGetGlyphs, libs, "gsk_text_node_get_glyphs", "GSK", "")
+	core.PuregoRegisterSince(&xTextNodeGetNumGlyphs, libs, "gsk_text_node_get_num_glyphs", "GSK", "")
+	core.PuregoRegisterSince(&xTextNodeGetOffset, libs, "gsk_text_node_get_offset", "GSK", "")
+	core.PuregoRegisterSince(&xTextNodeHasColorGlyphs, libs, "gsk_text_node_has_color_glyphs", "GSK", "4.2")
 
 	core.PuregoSafeRegister(&xTextureNodeGLibType, libs, "gsk_texture_node_get_type")
 
-	core.PuregoSafeRegister(&xNewTextureNode, libs, "gsk_texture_node_new")
+	core.PuregoRegisterSince(&xNewTextureNode, libs, "gsk_texture_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xTextureNodeGetTexture, libs, "gsk_texture_node_get_texture")
+	core.PuregoRegisterSince(&xTextureNodeGetTexture, libs, "gsk_texture_node_get_texture", "GSK", "")
 
 	core.PuregoSafeRegister(&xTextureScaleNodeGLibType, libs, "gsk_texture_scale_node_get_type")
 
-	core.PuregoSafeRegister(&xNewTextureScaleNode, libs, "gsk_texture_scale_node_new")
+	core.PuregoRegisterSince(&xNewTextureScaleNode, libs, "gsk_texture_scale_node_new", "GSK", "4.10")
 
-	core.PuregoSafeRegister(&xTextureScaleNodeGetFilter, libs, "gsk_texture_scale_node_get_filter")
-	core.PuregoSafeRegister(&xTextureScaleNodeGetTexture, libs, "gsk_texture_scale_node_get_texture")
+	core.PuregoRegisterSince(&xTextureScaleNodeGetFilter, libs, "gsk_texture_scale_node_get_filter", "GSK", "4.10")
+	core.PuregoRegisterSince(&xTextureScaleNodeGetTexture, libs, "gsk_texture_scale_node_get_texture", "GSK", "4.10")
 
 	core.PuregoSafeRegister(&xTransformNodeGLibType, libs, "gsk_transform_node_get_type")
 
-	core.PuregoSafeRegister(&xNewTransformNode, libs, "gsk_transform_node_new")
+	core.PuregoRegisterSince(&xNewTransformNode, libs, "gsk_transform_node_new", "GSK", "")
 
-	core.PuregoSafeRegister(&xTransformNodeGetChild, libs, "gsk_transform_node_get_child")
-	core.PuregoSafeRegister(&xTransformNodeGetTransform, libs, "gsk_transform_node_get_transform")
+	core.PuregoRegisterSince(&xTransformNodeGetChild, libs, "gsk_transform_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xTransformNodeGetTransform, libs, "gsk_transform_node_get_transform", "GSK", "")
 
 }
@@ -0,0 +1,118 @@
+//go:build !puregotk_no_deprecated
+
+// Package gsk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gsk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+)
+
+var xNewGLShaderNode func(uintptr, *graphene.Rect, *glib.Bytes, uintptr, uint) uintptr
+
+// Creates a `GskRenderNode` that will render the given shader into the
+// area given by bounds.
+//
+// The args is a block of data to use for uniform input, as per types and
+// offsets defined by the shader. Normally this is generated by
+// [GLShader.FormatArgs] or [ShaderArgsBuilder].
+//
+// See [GLShader] for details about how the shader should be written.
+//
+// All the children will be rendered into textures (if they aren't already
+// `GskTextureNodes`, which will be used directly). These textures will be
+// sent as input to the shader.
+//
+// If the renderer doesn't support GL shaders, or if there is any problem
+// when compiling the shader, then the node will draw pink. You should use
+// [GLShader.Compile] to ensure the shader will work for the
+// renderer before using it.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func NewGLShaderNode(ShaderVar *GLShader, BoundsVar *graphene.Rect, ArgsVar *glib.Bytes, ChildrenVar uintptr, NChildrenVar uint) *GLShaderNode {
+	var cls *GLShaderNode
+
+	cret := xNewGLShaderNode(ShaderVar.GoPointer(), BoundsVar, ArgsVar, ChildrenVar, NChildrenVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &GLShaderNode{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xGLShaderNodeGetArgs func(uintptr) *glib.Bytes
+
+// Gets args for the node.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShaderNode) GetArgs() *glib.Bytes {
+
+	cret := xGLShaderNodeGetArgs(x.GoPointer())
+	return cret
+}
+
+var xGLShaderNodeGetChild func(uintptr, uint) uintptr
+
+// Gets one of the children.
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShaderNode) GetChild(IdxVar uint) *RenderNode {
+	var cls *RenderNode
+
+	cret := xGLShaderNodeGetChild(x.GoPointer(), IdxVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &RenderNode{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xGLShaderNodeGetNChildren func(uintptr) uint
+
+// Returns the number of children
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use GtkGLArea
+//	for OpenGL rendering.
+func (x *GLShaderNode) GetNChildren() uint {
+
+	cret := xGLShaderNodeGetNChildren(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GSK", "gtk4")
+	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewGLShaderNode, libs, "gsk_gl_shader_node_new", "GSK", "")
+
+	core.PuregoRegisterSince(&xGLShaderNodeGetArgs, libs, "gsk_gl_shader_node_get_args", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderNodeGetChild, libs, "gsk_gl_shader_node_get_child", "GSK", "")
+	core.PuregoRegisterSince(&xGLShaderNodeGetNChildren, libs, "gsk_gl_shader_node_get_n_children", "GSK", "")
+
+}
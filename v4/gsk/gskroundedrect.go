@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/graphene"
 )
@@ -13,7 +12,7 @@ import (
 // A rectangular region with rounded corners.
 //
 // Application code should normalize rectangles using
-// [method@Gsk.RoundedRect.normalize]; this function will ensure that
+// [RoundedRect.Normalize]; this function will ensure that
 // the bounds of the rectangle are normalized and ensure that the corner
 // values are positive and the corners do not overlap.
 //
@@ -22,11 +21,13 @@ import (
 // will always return a normalized one.
 //
 // The algorithm used for normalizing corner sizes is described in
-// [the CSS specification](https://drafts.csswg.org/css-backgrounds-3/#border-radius).
+// [the CSS specification].
+//
+// [the CSS specification]: https://drafts.csswg.org/css-backgrounds-3/#border-radius
 type RoundedRect struct {
 	_ structs.HostLayout
 
-	Bounds uintptr
+	Bounds graphene.Rect
 
 	Corner [4]graphene.Size
 }
@@ -102,8 +103,8 @@ var xRoundedRectIsRectilinear func(uintptr) bool
 // Checks if all corners of a rounded rectangle are right angles
 // and the rectangle covers all of its bounds.
 //
-// This information can be used to decide if [ctor@Gsk.ClipNode.new]
-// or [ctor@Gsk.RoundedClipNode.new] should be called.
+// This information can be used to decide if [NewClipNode]
+// or [NewRoundedClipNode] should be called.
 func (x *RoundedRect) IsRectilinear() bool {
 
 	cret := xRoundedRectIsRectilinear(x.GoPointer())
@@ -125,7 +126,7 @@ func (x *RoundedRect) Normalize() *RoundedRect {
 
 var xRoundedRectOffset func(uintptr, float32, float32) *RoundedRect
 
-// Offsets the rounded rectangle's origin by @dx and @dy.
+// Offsets the rounded rectangle's origin by dx and dy.
 //
 // The size and corners of the rounded rectangle are unchanged.
 func (x *RoundedRect) Offset(DxVar float32, DyVar float32) *RoundedRect {
@@ -143,7 +144,7 @@ var xRoundedRectShrink func(uintptr, float32, float32, float32, float32) *Rounde
 // the center of the corner circle intact. This emulates CSS behavior.
 //
 // This function also works for growing rounded rectangles
-// if you pass negative values for the @top, @right, @bottom or @left.
+// if you pass negative values for the top, right, bottom or left.
 func (x *RoundedRect) Shrink(TopVar float32, RightVar float32, BottomVar float32, LeftVar float32) *RoundedRect {
 
 	cret := xRoundedRectShrink(x.GoPointer(), TopVar, RightVar, BottomVar, LeftVar)
@@ -155,22 +156,22 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xRoundedRectContainsPoint, libs, "gsk_rounded_rect_contains_point")
-	core.PuregoSafeRegister(&xRoundedRectContainsRect, libs, "gsk_rounded_rect_contains_rect")
-	core.PuregoSafeRegister(&xRoundedRectInit, libs, "gsk_rounded_rect_init")
-	core.PuregoSafeRegister(&xRoundedRectInitCopy, libs, "gsk_rounded_rect_init_copy")
-	core.PuregoSafeRegister(&xRoundedRectInitFromRect, libs, "gsk_rounded_rect_init_from_rect")
-	core.PuregoSafeRegister(&xRoundedRectIntersectsRect, libs, "gsk_rounded_rect_intersects_rect")
-	core.PuregoSafeRegister(&xRoundedRectIsRectilinear, libs, "gsk_rounded_rect_is_rectilinear")
-	core.PuregoSafeRegister(&xRoundedRectNormalize, libs, "gsk_rounded_rect_normalize")
-	core.PuregoSafeRegister(&xRoundedRectOffset, libs, "gsk_rounded_rect_offset")
-	core.PuregoSafeRegister(&xRoundedRectShrink, libs, "gsk_rounded_rect_shrink")
+	core.PuregoRegisterSince(&xRoundedRectContainsPoint, libs, "gsk_rounded_rect_contains_point", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectContainsRect, libs, "gsk_rounded_rect_contains_rect", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectInit, libs, "gsk_rounded_rect_init", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectInitCopy, libs, "gsk_rounded_rect_init_copy", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectInitFromRect, libs, "gsk_rounded_rect_init_from_rect", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectIntersectsRect, libs, "gsk_rounded_rect_intersects_rect", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectIsRectilinear, libs, "gsk_rounded_rect_is_rectilinear", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectNormalize, libs, "gsk_rounded_rect_normalize", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectOffset, libs, "gsk_rounded_rect_offset", "GSK", "")
+	core.PuregoRegisterSince(&xRoundedRectShrink, libs, "gsk_rounded_rect_shrink", "GSK", "")
 
 }
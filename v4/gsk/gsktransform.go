@@ -2,7 +2,6 @@
 package gsk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -10,11 +9,11 @@ var xTransformParse func(string, **Transform) bool
 
 // Parses a given into a transform.
 //
-// Strings printed via [method@Gsk.Transform.to_string]
+// Strings printed via [Transform.ToString]
 // can be read in again successfully using this function.
 //
-// If @string does not describe a valid transform, false
-// is returned and `NULL` is put in @out_transform.
+// If string does not describe a valid transform, false
+// is returned and `NULL` is put in out_transform.
 func TransformParse(StringVar string, OutTransformVar **Transform) bool {
 
 	cret := xTransformParse(StringVar, OutTransformVar)
@@ -26,13 +25,13 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTransformParse, libs, "gsk_transform_parse")
+	core.PuregoRegisterSince(&xTransformParse, libs, "gsk_transform_parse", "GSK", "")
 
 }
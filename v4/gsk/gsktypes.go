@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -47,16 +46,10 @@ var xNewComponentTransferDiscrete func(uint, []float32) *ComponentTransfer
 //
 // where k is the smallest value such that
 //
-//	k / n &lt;= C &lt; (k + 1) / n
+//	k / n <= C < (k + 1) / n
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="discrete-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: discrete" src="discrete-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferDiscrete(NVar uint, ValuesVar []float32) *ComponentTransfer {
 
 	cret := xNewComponentTransferDiscrete(NVar, ValuesVar)
@@ -72,14 +65,8 @@ var xNewComponentTransferGamma func(float32, float32, float32) *ComponentTransfe
 //
 //	C' = amp * pow (C, exp) + ofs
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="gamma-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: gamma" src="gamma-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferGamma(AmpVar float32, ExpVar float32, OfsVar float32) *ComponentTransfer {
 
 	cret := xNewComponentTransferGamma(AmpVar, ExpVar, OfsVar)
@@ -91,14 +78,8 @@ var xNewComponentTransferIdentity func() *ComponentTransfer
 // Creates a new component transfer that doesn't
 // change the component value.
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="identity-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: identity" src="identity-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferIdentity() *ComponentTransfer {
 
 	cret := xNewComponentTransferIdentity()
@@ -114,14 +95,8 @@ var xNewComponentTransferLevels func(float32) *ComponentTransfer
 //
 //	C' = (floor (C * n) + 0.5) / n
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="levels-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: levels" src="levels-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferLevels(NVar float32) *ComponentTransfer {
 
 	cret := xNewComponentTransferLevels(NVar)
@@ -137,14 +112,8 @@ var xNewComponentTransferLinear func(float32, float32) *ComponentTransfer
 //
 //	C' = C * m + b
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="linear-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: linear" src="linear-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferLinear(MVar float32, BVar float32) *ComponentTransfer {
 
 	cret := xNewComponentTransferLinear(MVar, BVar)
@@ -162,16 +131,10 @@ var xNewComponentTransferTable func(uint, []float32) *ComponentTransfer
 //
 // where k is the smallest value such that
 //
-//	k / (n - 1) &lt;= C &lt; (k + 1) / (n - 1)
+//	k / (n - 1) <= C < (k + 1) / (n - 1)
 //
-// &lt;figure&gt;
-//
-//	&lt;picture&gt;
-//	  &lt;source srcset="table-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	    &lt;img alt="Component transfer: table" src="table-light.png"&gt;
-//	&lt;/picture&gt;
-//
-// &lt;/figure&gt;
+// <figure>
+// </figure>
 func NewComponentTransferTable(NVar uint, ValuesVar []float32) *ComponentTransfer {
 
 	cret := xNewComponentTransferTable(NVar, ValuesVar)
@@ -180,7 +143,7 @@ func NewComponentTransferTable(NVar uint, ValuesVar []float32) *ComponentTransfe
 
 var xComponentTransferCopy func(uintptr) *ComponentTransfer
 
-// Creates a copy of @other.
+// Creates a copy of other.
 func (x *ComponentTransfer) Copy() *ComponentTransfer {
 
 	cret := xComponentTransferCopy(x.GoPointer())
@@ -203,19 +166,12 @@ func (x *ComponentTransfer) Free() {
 //
 // `GskPath` is an immutable, opaque, reference-counted struct.
 // After creation, you cannot change the types it represents. Instead,
-// new `GskPath` objects have to be created. The [struct@Gsk.PathBuilder]
+// new `GskPath` objects have to be created. The [PathBuilder]
 // structure is meant to help in this endeavor.
 //
 // Conceptually, a path consists of zero or more contours (continuous, connected
 // curves), each of which may or may not be closed. Contours are typically
 // constructed from Bézier segments.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="path-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="A Path" src="path-light.png"&gt;
-//
-// &lt;/picture&gt;
 type Path struct {
 	_ structs.HostLayout
 }
@@ -232,18 +188,18 @@ func (x *Path) GoPointer() uintptr {
 
 var xPathForeach func(uintptr, PathForeachFlags, uintptr, uintptr) bool
 
-// Calls @func for every operation of the path.
+// Calls func for every operation of the path.
 //
-// Note that this may only approximate @self, because paths can contain
+// Note that this may only approximate self, because paths can contain
 // optimizations for various specialized contours, and depending on the
-// @flags, the path may be decomposed into simpler curves than the ones
+// flags, the path may be decomposed into simpler curves than the ones
 // that it contained originally.
 //
 // This function serves two purposes:
 //
-//   - When the @flags allow everything, it provides access to the raw,
+//   - When the flags allow everything, it provides access to the raw,
 //     unmodified data of the path.
-//   - When the @flags disallow certain operations, it provides
+//   - When the flags disallow certain operations, it provides
 //     an approximation of the path using just the allowed operations.
 func (x *Path) Foreach(FlagsVar PathForeachFlags, FuncVar *PathForeachFunc, UserDataVar uintptr) bool {
 
@@ -254,11 +210,12 @@ func (x *Path) Foreach(FlagsVar PathForeachFlags, FuncVar *PathForeachFunc, User
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 PathOperation, arg1 []graphene.Point, arg2 uint, arg3 float32, arg4 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2, arg3, arg4)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -270,11 +227,11 @@ var xPathForeachIntersection func(uintptr, *Path, uintptr, uintptr) bool
 
 // Finds intersections between two paths.
 //
-// This function finds intersections between @path1 and @path2,
-// and calls @func for each of them, in increasing order for @path1.
+// This function finds intersections between path1 and path2,
+// and calls func for each of them, in increasing order for path1.
 //
-// If @path2 is not provided or equal to @path1, the function finds
-// non-trivial self-intersections of @path1.
+// If path2 is not provided or equal to path1, the function finds
+// non-trivial self-intersections of path1.
 //
 // When segments of the paths coincide, the callback is called once
 // for the start of the segment, with @GSK_PATH_INTERSECTION_START, and
@@ -282,7 +239,7 @@ var xPathForeachIntersection func(uintptr, *Path, uintptr, uintptr) bool
 // Note that other intersections may occur between the start and end
 // of such a segment.
 //
-// If @func returns `FALSE`, the iteration is stopped.
+// If func returns `FALSE`, the iteration is stopped.
 func (x *Path) ForeachIntersection(Path2Var *Path, FuncVar *PathIntersectionFunc, UserDataVar uintptr) bool {
 
 	var FuncVarRef uintptr
@@ -292,11 +249,12 @@ func (x *Path) ForeachIntersection(Path2Var *Path, FuncVar *PathIntersectionFunc
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Path, arg1 *PathPoint, arg2 *Path, arg3 *PathPoint, arg4 PathIntersection, arg5 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2, arg3, arg4, arg5)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -316,9 +274,9 @@ var xPathGetBounds func(uintptr, *graphene.Rect) bool
 // This can happen when the path only describes a point or an
 // axis-aligned line.
 //
-// If the path is empty, false is returned and @bounds are set to
-// graphene_rect_zero(). This is different from the case where the path
-// is a single point at the origin, where the @bounds will also be set to
+// If the path is empty, false is returned and bounds are set to
+// [graphene.RectZero]. This is different from the case where the path
+// is a single point at the origin, where the bounds will also be set to
 // the zero rectangle but true will be returned.
 func (x *Path) GetBounds(BoundsVar *graphene.Rect) bool {
 
@@ -412,8 +370,10 @@ var xPathPrint func(uintptr, *glib.String)
 // Converts the path into a human-readable representation.
 //
 // The string is compatible with (a superset of)
-// [SVG path syntax](https://www.w3.org/TR/SVG11/paths.html#PathData),
-// see [func@Gsk.Path.parse] for a summary of the syntax.
+// [SVG path syntax],
+// see [PathParse] for a summary of the syntax.
+//
+// [SVG path syntax]: https://www.w3.org/TR/SVG11/paths.html#PathData
 func (x *Path) Print(StringVar *glib.String) {
 
 	xPathPrint(x.GoPointer(), StringVar)
@@ -451,7 +411,7 @@ var xPathToString func(uintptr) string
 // You can use this function in a debugger to get a quick overview
 // of the path.
 //
-// This is a wrapper around [method@Gsk.Path.print], see that function
+// This is a wrapper around [Path.Print], see that function
 // for details.
 func (x *Path) ToString() string {
 
@@ -474,10 +434,8 @@ func (x *Path) Unref() {
 //
 // A path is constructed like this:
 //
-// ```c
-// GskPath *
-// construct_path (void)
-//
+//	GskPath *
+//	construct_path (void)
 //	{
 //	  GskPathBuilder *builder;
 //
@@ -487,23 +445,21 @@ func (x *Path) Unref() {
 //
 //	  return gsk_path_builder_free_to_path (builder);
 //
-// ```
-//
 // Adding contours to the path can be done in two ways.
 // The easiest option is to use the `gsk_path_builder_add_*` group
 // of functions that add predefined contours to the current path,
-// either common shapes like [method@Gsk.PathBuilder.add_circle]
-// or by adding from other paths like [method@Gsk.PathBuilder.add_path].
+// either common shapes like [PathBuilder.AddCircle]
+// or by adding from other paths like [PathBuilder.AddPath].
 //
 // The `gsk_path_builder_add_*` methods always add complete contours,
 // and do not use or modify the current point.
 //
 // The other option is to define each line and curve manually with
 // the `gsk_path_builder_*_to` group of functions. You start with
-// a call to [method@Gsk.PathBuilder.move_to] to set the starting point
+// a call to [PathBuilder.MoveTo] to set the starting point
 // and then use multiple calls to any of the drawing functions to
 // move the pen along the plane. Once you are done, you can call
-// [method@Gsk.PathBuilder.close] to close the path by connecting it
+// [PathBuilder.Close] to close the path by connecting it
 // back with a line to the starting point.
 //
 // This is similar to how paths are drawn in Cairo.
@@ -554,7 +510,7 @@ var xPathBuilderAddCircle func(uintptr, *graphene.Point, float32)
 //
 // The path is going around the circle in clockwise direction.
 //
-// If @radius is zero, the contour will be a closed point.
+// If radius is zero, the contour will be a closed point.
 func (x *PathBuilder) AddCircle(CenterVar *graphene.Point, RadiusVar float32) {
 
 	xPathBuilderAddCircle(x.GoPointer(), CenterVar, RadiusVar)
@@ -563,7 +519,7 @@ func (x *PathBuilder) AddCircle(CenterVar *graphene.Point, RadiusVar float32) {
 
 var xPathBuilderAddLayout func(uintptr, uintptr)
 
-// Adds the outlines for the glyphs in @layout to the builder.
+// Adds the outlines for the glyphs in layout to the builder.
 func (x *PathBuilder) AddLayout(LayoutVar *pango.Layout) {
 
 	xPathBuilderAddLayout(x.GoPointer(), LayoutVar.GoPointer())
@@ -572,7 +528,7 @@ func (x *PathBuilder) AddLayout(LayoutVar *pango.Layout) {
 
 var xPathBuilderAddPath func(uintptr, *Path)
 
-// Appends all of @path to the builder.
+// Appends all of path to the builder.
 func (x *PathBuilder) AddPath(PathVar *Path) {
 
 	xPathBuilderAddPath(x.GoPointer(), PathVar)
@@ -595,7 +551,7 @@ func (x *PathBuilder) AddRect(RectVar *graphene.Rect) {
 
 var xPathBuilderAddReversePath func(uintptr, *Path)
 
-// Appends all of @path to the builder, in reverse order.
+// Appends all of path to the builder, in reverse order.
 func (x *PathBuilder) AddReversePath(PathVar *Path) {
 
 	xPathBuilderAddReversePath(x.GoPointer(), PathVar)
@@ -617,13 +573,13 @@ var xPathBuilderAddSegment func(uintptr, *Path, *PathPoint, *PathPoint)
 
 // Adds a segment of a path to the builder.
 //
-// If @start is equal to or after @end, the path will first add the
-// segment from @start to the end of the path, and then add the segment
-// from the beginning to @end. If the path is closed, these segments
+// If start is equal to or after end, the path will first add the
+// segment from start to the end of the path, and then add the segment
+// from the beginning to end. If the path is closed, these segments
 // will be connected.
 //
 // Note that this method always adds a path with the given start point
-// and end point. To add a closed path, use [method@Gsk.PathBuilder.add_path].
+// and end point. To add a closed path, use [PathBuilder.AddPath].
 func (x *PathBuilder) AddSegment(PathVar *Path, StartVar *PathPoint, EndVar *PathPoint) {
 
 	xPathBuilderAddSegment(x.GoPointer(), PathVar, StartVar, EndVar)
@@ -632,22 +588,15 @@ func (x *PathBuilder) AddSegment(PathVar *Path, StartVar *PathPoint, EndVar *Pat
 
 var xPathBuilderArcTo func(uintptr, float32, float32, float32, float32)
 
-// Adds an elliptical arc from the current point to @x2, @y2
-// with @x1, @y1 determining the tangent directions.
+// Adds an elliptical arc from the current point to x2, y2
+// with x1, y1 determining the tangent directions.
 //
-// After this, @x2, @y2 will be the new current point.
+// After this, x2, y2 will be the new current point.
 //
 // Note: Two points and their tangents do not determine
 // a unique ellipse, so GSK just picks one. If you need more
-// precise control, use [method@Gsk.PathBuilder.conic_to]
-// or [method@Gsk.PathBuilder.svg_arc_to].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="arc-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Arc To" src="arc-light.png"&gt;
-//
-// &lt;/picture&gt;
+// precise control, use [PathBuilder.ConicTo]
+// or [PathBuilder.SvgArcTo].
 func (x *PathBuilder) ArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32) {
 
 	xPathBuilderArcTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var)
@@ -658,7 +607,7 @@ var xPathBuilderClose func(uintptr)
 
 // Ends the current contour with a line back to the start point.
 //
-// Note that this is different from calling [method@Gsk.PathBuilder.line_to]
+// Note that this is different from calling [PathBuilder.LineTo]
 // with the start point in that the contour will be closed. A closed
 // contour behaves differently from an open one. When stroking, its
 // start and end point are considered connected, so they will be
@@ -671,8 +620,8 @@ func (x *PathBuilder) Close() {
 
 var xPathBuilderConicTo func(uintptr, float32, float32, float32, float32, float32)
 
-// Adds a [conic curve](https://en.wikipedia.org/wiki/Non-uniform_rational_B-spline)
-// from the current point to @x2, @y2 with the given @weight and @x1, @y1 as the
+// Adds a [conic curve]
+// from the current point to x2, y2 with the given weight and x1, y1 as the
 // control point.
 //
 // The weight determines how strongly the curve is pulled towards the control point.
@@ -681,14 +630,9 @@ var xPathBuilderConicTo func(uintptr, float32, float32, float32, float32, float3
 // Conic curves can be used to draw ellipses and circles. They are also known as
 // rational quadratic Bézier curves.
 //
-// After this, @x2, @y2 will be the new current point.
-//
-// &lt;picture&gt;
+// After this, x2, y2 will be the new current point.
 //
-//	&lt;source srcset="conic-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Conic To" src="conic-light.png"&gt;
-//
-// &lt;/picture&gt;
+// [conic curve]: https://en.wikipedia.org/wiki/Non-uniform_rational_B-spline
 func (x *PathBuilder) ConicTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, WeightVar float32) {
 
 	xPathBuilderConicTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, WeightVar)
@@ -697,18 +641,13 @@ func (x *PathBuilder) ConicTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var
 
 var xPathBuilderCubicTo func(uintptr, float32, float32, float32, float32, float32, float32)
 
-// Adds a [cubic Bézier curve](https://en.wikipedia.org/wiki/B%C3%A9zier_curve)
-// from the current point to @x3, @y3 with @x1, @y1 and @x2, @y2 as the control
+// Adds a [cubic Bézier curve]
+// from the current point to x3, y3 with x1, y1 and x2, y2 as the control
 // points.
 //
-// After this, @x3, @y3 will be the new current point.
-//
-// &lt;picture&gt;
+// After this, x3, y3 will be the new current point.
 //
-//	&lt;source srcset="cubic-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Cubic To" src="cubic-light.png"&gt;
-//
-// &lt;/picture&gt;
+// [cubic Bézier curve]: https://en.wikipedia.org/wiki/BC3%A9zier_curve
 func (x *PathBuilder) CubicTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, X3Var float32, Y3Var float32) {
 
 	xPathBuilderCubicTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, X3Var, Y3Var)
@@ -746,12 +685,14 @@ var xPathBuilderHtmlArcTo func(uintptr, float32, float32, float32, float32, floa
 // Implements arc-to according to the HTML Canvas spec.
 //
 // A convenience function that implements the
-// [HTML arc_to](https://html.spec.whatwg.org/multipage/canvas.html#dom-context-2d-arcto-dev)
+// [HTML arc_to]
 // functionality.
 //
 // After this, the current point will be the point where
 // the circle with the given radius touches the line from
-// @x1, @y1 to @x2, @y2.
+// x1, y1 to x2, y2.
+//
+// [HTML arc_to]: https://html.spec.whatwg.org/multipage/canvas.html#dom-context-2d-arcto-dev
 func (x *PathBuilder) HtmlArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, RadiusVar float32) {
 
 	xPathBuilderHtmlArcTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, RadiusVar)
@@ -760,15 +701,8 @@ func (x *PathBuilder) HtmlArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2V
 
 var xPathBuilderLineTo func(uintptr, float32, float32)
 
-// Draws a line from the current point to @x, @y and makes it
+// Draws a line from the current point to x, y and makes it
 // the new current point.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="line-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Line To" src="line-light.png"&gt;
-//
-// &lt;/picture&gt;
 func (x *PathBuilder) LineTo(XVar float32, YVar float32) {
 
 	xPathBuilderLineTo(x.GoPointer(), XVar, YVar)
@@ -777,7 +711,7 @@ func (x *PathBuilder) LineTo(XVar float32, YVar float32) {
 
 var xPathBuilderMoveTo func(uintptr, float32, float32)
 
-// Starts a new contour by placing the pen at @x, @y.
+// Starts a new contour by placing the pen at x, y.
 //
 // If this function is called twice in succession, the first
 // call will result in a contour made up of a single point.
@@ -790,17 +724,12 @@ func (x *PathBuilder) MoveTo(XVar float32, YVar float32) {
 
 var xPathBuilderQuadTo func(uintptr, float32, float32, float32, float32)
 
-// Adds a [quadratic Bézier curve](https://en.wikipedia.org/wiki/B%C3%A9zier_curve)
-// from the current point to @x2, @y2 with @x1, @y1 as the control point.
-//
-// After this, @x2, @y2 will be the new current point.
-//
-// &lt;picture&gt;
+// Adds a [quadratic Bézier curve]
+// from the current point to x2, y2 with x1, y1 as the control point.
 //
-//	&lt;source srcset="quad-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Quad To" src="quad-light.png"&gt;
+// After this, x2, y2 will be the new current point.
 //
-// &lt;/picture&gt;
+// [quadratic Bézier curve]: https://en.wikipedia.org/wiki/BC3%A9zier_curve
 func (x *PathBuilder) QuadTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32) {
 
 	xPathBuilderQuadTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var)
@@ -821,12 +750,12 @@ func (x *PathBuilder) Ref() *PathBuilder {
 
 var xPathBuilderRelArcTo func(uintptr, float32, float32, float32, float32)
 
-// Adds an elliptical arc from the current point to @x2, @y2
-// with @x1, @y1 determining the tangent directions.
+// Adds an elliptical arc from the current point to x2, y2
+// with x1, y1 determining the tangent directions.
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.arc_to].
+// This is the relative version of [PathBuilder.ArcTo].
 func (x *PathBuilder) RelArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32) {
 
 	xPathBuilderRelArcTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var)
@@ -835,13 +764,15 @@ func (x *PathBuilder) RelArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2Va
 
 var xPathBuilderRelConicTo func(uintptr, float32, float32, float32, float32, float32)
 
-// Adds a [conic curve](https://en.wikipedia.org/wiki/Non-uniform_rational_B-spline)
-// from the current point to @x2, @y2 with the given @weight and @x1, @y1 as the
+// Adds a [conic curve]
+// from the current point to x2, y2 with the given weight and x1, y1 as the
 // control point.
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.conic_to].
+// This is the relative version of [PathBuilder.ConicTo].
+//
+// [conic curve]: https://en.wikipedia.org/wiki/Non-uniform_rational_B-spline
 func (x *PathBuilder) RelConicTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, WeightVar float32) {
 
 	xPathBuilderRelConicTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, WeightVar)
@@ -850,13 +781,15 @@ func (x *PathBuilder) RelConicTo(X1Var float32, Y1Var float32, X2Var float32, Y2
 
 var xPathBuilderRelCubicTo func(uintptr, float32, float32, float32, float32, float32, float32)
 
-// Adds a [cubic Bézier curve](https://en.wikipedia.org/wiki/B%C3%A9zier_curve)
-// from the current point to @x3, @y3 with @x1, @y1 and @x2, @y2 as the control
+// Adds a [cubic Bézier curve]
+// from the current point to x3, y3 with x1, y1 and x2, y2 as the control
 // points.
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.cubic_to].
+// This is the relative version of [PathBuilder.CubicTo].
+//
+// [cubic Bézier curve]: https://en.wikipedia.org/wiki/BC3%A9zier_curve
 func (x *PathBuilder) RelCubicTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, X3Var float32, Y3Var float32) {
 
 	xPathBuilderRelCubicTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, X3Var, Y3Var)
@@ -869,7 +802,7 @@ var xPathBuilderRelHtmlArcTo func(uintptr, float32, float32, float32, float32, f
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.html_arc_to].
+// This is the relative version of [PathBuilder.HtmlArcTo].
 func (x *PathBuilder) RelHtmlArcTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32, RadiusVar float32) {
 
 	xPathBuilderRelHtmlArcTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var, RadiusVar)
@@ -879,9 +812,9 @@ func (x *PathBuilder) RelHtmlArcTo(X1Var float32, Y1Var float32, X2Var float32,
 var xPathBuilderRelLineTo func(uintptr, float32, float32)
 
 // Draws a line from the current point to a point offset from it
-// by @x, @y and makes it the new current point.
+// by x, y and makes it the new current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.line_to].
+// This is the relative version of [PathBuilder.LineTo].
 func (x *PathBuilder) RelLineTo(XVar float32, YVar float32) {
 
 	xPathBuilderRelLineTo(x.GoPointer(), XVar, YVar)
@@ -890,10 +823,10 @@ func (x *PathBuilder) RelLineTo(XVar float32, YVar float32) {
 
 var xPathBuilderRelMoveTo func(uintptr, float32, float32)
 
-// Starts a new contour by placing the pen at @x, @y
+// Starts a new contour by placing the pen at x, y
 // relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.move_to].
+// This is the relative version of [PathBuilder.MoveTo].
 func (x *PathBuilder) RelMoveTo(XVar float32, YVar float32) {
 
 	xPathBuilderRelMoveTo(x.GoPointer(), XVar, YVar)
@@ -902,12 +835,14 @@ func (x *PathBuilder) RelMoveTo(XVar float32, YVar float32) {
 
 var xPathBuilderRelQuadTo func(uintptr, float32, float32, float32, float32)
 
-// Adds a [quadratic Bézier curve](https://en.wikipedia.org/wiki/B%C3%A9zier_curve)
-// from the current point to @x2, @y2 with @x1, @y1 the control point.
+// Adds a [quadratic Bézier curve]
+// from the current point to x2, y2 with x1, y1 the control point.
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.quad_to].
+// This is the relative version of [PathBuilder.QuadTo].
+//
+// [quadratic Bézier curve]: https://en.wikipedia.org/wiki/BC3%A9zier_curve
 func (x *PathBuilder) RelQuadTo(X1Var float32, Y1Var float32, X2Var float32, Y2Var float32) {
 
 	xPathBuilderRelQuadTo(x.GoPointer(), X1Var, Y1Var, X2Var, Y2Var)
@@ -920,7 +855,7 @@ var xPathBuilderRelSvgArcTo func(uintptr, float32, float32, float32, bool, bool,
 //
 // All coordinates are given relative to the current point.
 //
-// This is the relative version of [method@Gsk.PathBuilder.svg_arc_to].
+// This is the relative version of [PathBuilder.SvgArcTo].
 func (x *PathBuilder) RelSvgArcTo(RxVar float32, RyVar float32, XAxisRotationVar float32, LargeArcVar bool, PositiveSweepVar bool, XVar float32, YVar float32) {
 
 	xPathBuilderRelSvgArcTo(x.GoPointer(), RxVar, RyVar, XAxisRotationVar, LargeArcVar, PositiveSweepVar, XVar, YVar)
@@ -932,10 +867,12 @@ var xPathBuilderSvgArcTo func(uintptr, float32, float32, float32, bool, bool, fl
 // Implements arc-to according to the SVG spec.
 //
 // A convenience function that implements the
-// [SVG arc_to](https://www.w3.org/TR/SVG11/paths.html#PathDataEllipticalArcCommands)
+// [SVG arc_to]
 // functionality.
 //
-// After this, @x, @y will be the new current point.
+// After this, x, y will be the new current point.
+//
+// [SVG arc_to]: https://www.w3.org/TR/SVG11/paths.html#PathDataEllipticalArcCommands
 func (x *PathBuilder) SvgArcTo(RxVar float32, RyVar float32, XAxisRotationVar float32, LargeArcVar bool, PositiveSweepVar bool, XVar float32, YVar float32) {
 
 	xPathBuilderSvgArcTo(x.GoPointer(), RxVar, RyVar, XAxisRotationVar, LargeArcVar, PositiveSweepVar, XVar, YVar)
@@ -951,7 +888,7 @@ var xPathBuilderToPath func(uintptr) *Path
 // instance.
 //
 // This function is intended primarily for language bindings.
-// C code should use [method@Gsk.PathBuilder.free_to_path].
+// C code should use [PathBuilder.FreeToPath].
 func (x *PathBuilder) ToPath() *Path {
 
 	cret := xPathBuilderToPath(x.GoPointer())
@@ -992,7 +929,7 @@ func (x *PathMeasure) GoPointer() uintptr {
 
 var xNewPathMeasure func(*Path) *PathMeasure
 
-// Creates a measure object for the given @path with the
+// Creates a measure object for the given path with the
 // default tolerance.
 func NewPathMeasure(PathVar *Path) *PathMeasure {
 
@@ -1002,7 +939,7 @@ func NewPathMeasure(PathVar *Path) *PathMeasure {
 
 var xNewPathMeasureWithTolerance func(*Path, float32) *PathMeasure
 
-// Creates a measure object for the given @path and @tolerance.
+// Creates a measure object for the given path and tolerance.
 func NewPathMeasureWithTolerance(PathVar *Path, ToleranceVar float32) *PathMeasure {
 
 	cret := xNewPathMeasureWithTolerance(PathVar, ToleranceVar)
@@ -1086,7 +1023,7 @@ func (x *Stroke) GoPointer() uintptr {
 
 var xNewStroke func(float32) *Stroke
 
-// Creates a new `GskStroke` with the given @line_width.
+// Creates a new `GskStroke` with the given line_width.
 func NewStroke(LineWidthVar float32) *Stroke {
 
 	cret := xNewStroke(LineWidthVar)
@@ -1133,7 +1070,7 @@ var xStrokeGetLineCap func(uintptr) LineCap
 
 // Gets the line cap used.
 //
-// See [enum@Gsk.LineCap] for details.
+// See [LineCap] for details.
 func (x *Stroke) GetLineCap() LineCap {
 
 	cret := xStrokeGetLineCap(x.GoPointer())
@@ -1144,7 +1081,7 @@ var xStrokeGetLineJoin func(uintptr) LineJoin
 
 // Gets the line join used.
 //
-// See [enum@Gsk.LineJoin] for details.
+// See [LineJoin] for details.
 func (x *Stroke) GetLineJoin() LineJoin {
 
 	cret := xStrokeGetLineJoin(x.GoPointer())
@@ -1179,21 +1116,21 @@ var xStrokeSetDash func(uintptr, []float32, uint)
 //
 // Each "on" segment will have caps applied as if the segment were a
 // separate contour. In particular, it is valid to use an "on" length
-// of 0 with [enum@Gsk.LineCap.round] or [enum@Gsk.LineCap.square]
+// of 0 with [LineCapRoundValue] or [LineCapSquareValue]
 // to draw dots or squares along a path.
 //
-// If @n_dash is 0, if all elements in @dash are 0, or if there are
-// negative values in @dash, then dashing is disabled.
+// If n_dash is 0, if all elements in dash are 0, or if there are
+// negative values in dash, then dashing is disabled.
 //
-// If @n_dash is 1, an alternating "on" and "off" pattern with the
+// If n_dash is 1, an alternating "on" and "off" pattern with the
 // single dash length provided is assumed.
 //
-// If @n_dash is uneven, the dash array will be used with the first
-// element in @dash defining an "on" or "off" in alternating passes
+// If n_dash is uneven, the dash array will be used with the first
+// element in dash defining an "on" or "off" in alternating passes
 // through the array.
 //
 // You can specify a starting offset into the dash with
-// [method@Gsk.Stroke.set_dash_offset].
+// [Stroke.SetDashOffset].
 func (x *Stroke) SetDash(DashVar []float32, NDashVar uint) {
 
 	xStrokeSetDash(x.GoPointer(), DashVar, NDashVar)
@@ -1207,7 +1144,7 @@ var xStrokeSetDashOffset func(uintptr, float32)
 // This is an offset into the length of the path, not an index into
 // the array values of the dash array.
 //
-// See [method@Gsk.Stroke.set_dash] for more details on dashing.
+// See [Stroke.SetDash] for more details on dashing.
 func (x *Stroke) SetDashOffset(OffsetVar float32) {
 
 	xStrokeSetDashOffset(x.GoPointer(), OffsetVar)
@@ -1218,7 +1155,7 @@ var xStrokeSetLineCap func(uintptr, LineCap)
 
 // Sets the line cap to be used when stroking.
 //
-// See [enum@Gsk.LineCap] for details.
+// See [LineCap] for details.
 func (x *Stroke) SetLineCap(LineCapVar LineCap) {
 
 	xStrokeSetLineCap(x.GoPointer(), LineCapVar)
@@ -1229,7 +1166,7 @@ var xStrokeSetLineJoin func(uintptr, LineJoin)
 
 // Sets the line join to be used when stroking.
 //
-// See [enum@Gsk.LineJoin] for details.
+// See [LineJoin] for details.
 func (x *Stroke) SetLineJoin(LineJoinVar LineJoin) {
 
 	xStrokeSetLineJoin(x.GoPointer(), LineJoinVar)
@@ -1240,7 +1177,7 @@ var xStrokeSetLineWidth func(uintptr, float32)
 
 // Sets the line width to be used when stroking.
 //
-// The line width must be &gt;= 0.
+// The line width must be >= 0.
 func (x *Stroke) SetLineWidth(LineWidthVar float32) {
 
 	xStrokeSetLineWidth(x.GoPointer(), LineWidthVar)
@@ -1256,8 +1193,8 @@ var xStrokeSetMiterLimit func(uintptr, float32)
 //
 // The limit is specfied in units of line width and must be non-negative.
 //
-// For joins of type [enum@Gsk.LineJoin.miter] that exceed the miter limit,
-// the join gets rendered as if it was of type [enum@Gsk.LineJoin.bevel].
+// For joins of type [LineJoinMiterValue] that exceed the miter limit,
+// the join gets rendered as if it was of type [LineJoinBevelValue].
 func (x *Stroke) SetMiterLimit(LimitVar float32) {
 
 	xStrokeSetMiterLimit(x.GoPointer(), LimitVar)
@@ -1331,13 +1268,13 @@ var xTransformInvert func(uintptr) *Transform
 
 // Inverts the given transform.
 //
-// If @self is not invertible, `NULL` is returned.
+// If self is not invertible, `NULL` is returned.
 // Note that inverting `NULL` also returns `NULL`, which is
 // the correct inverse of `NULL`. If you need to differentiate
-// between those cases, you should check @self is not `NULL`
+// between those cases, you should check self is not `NULL`
 // before calling this function.
 //
-// This function consumes @self. Use [method@Gsk.Transform.ref] first
+// This function consumes self. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Invert() *Transform {
 
@@ -1347,9 +1284,9 @@ func (x *Transform) Invert() *Transform {
 
 var xTransformMatrix func(uintptr, *graphene.Matrix) *Transform
 
-// Multiplies @next with the given @matrix.
+// Multiplies next with the given matrix.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Matrix(MatrixVar *graphene.Matrix) *Transform {
 
@@ -1359,13 +1296,13 @@ func (x *Transform) Matrix(MatrixVar *graphene.Matrix) *Transform {
 
 var xTransformMatrix2d func(uintptr, float32, float32, float32, float32, float32, float32) *Transform
 
-// Multiplies @next with the matrix [ xx yx x0; xy yy y0; 0 0 1 ].
+// Multiplies next with the matrix [ xx yx x0; xy yy y0; 0 0 1 ].
 //
-// The result of calling [method@Gsk.Transform.to_2d] on the returned
-// [struct@Gsk.Transform] should match the input passed to this
+// The result of calling [Transform.To2d] on the returned
+// [Transform] should match the input passed to this
 // function.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Matrix2d(XxVar float32, YxVar float32, XyVar float32, YyVar float32, DxVar float32, DyVar float32) *Transform {
 
@@ -1382,7 +1319,7 @@ var xTransformPerspective func(uintptr, float32) *Transform
 // those with negative Z values towards the origin. Points
 // on the z=0 plane are unchanged.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Perspective(DepthVar float32) *Transform {
 
@@ -1395,7 +1332,7 @@ var xTransformPrint func(uintptr, *glib.String)
 // Converts the transform into a human-readable representation.
 //
 // The result of this function can later be parsed with
-// [func@Gsk.Transform.parse].
+// [TransformParse].
 func (x *Transform) Print(StringVar *glib.String) {
 
 	xTransformPrint(x.GoPointer(), StringVar)
@@ -1413,11 +1350,11 @@ func (x *Transform) Ref() *Transform {
 
 var xTransformRotate func(uintptr, float32) *Transform
 
-// Rotates @next by an angle around the Z axis.
+// Rotates next by an angle around the Z axis.
 //
 // The rotation happens around the origin point of (0, 0).
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Rotate(AngleVar float32) *Transform {
 
@@ -1427,11 +1364,11 @@ func (x *Transform) Rotate(AngleVar float32) *Transform {
 
 var xTransformRotate3d func(uintptr, float32, *graphene.Vec3) *Transform
 
-// Rotates @next @angle degrees around @axis.
+// Rotates next angle degrees around axis.
 //
-// For a rotation in 2D space, use [method@Gsk.Transform.rotate]
+// For a rotation in 2D space, use [Transform.Rotate]
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Rotate3d(AngleVar float32, AxisVar *graphene.Vec3) *Transform {
 
@@ -1441,11 +1378,11 @@ func (x *Transform) Rotate3d(AngleVar float32, AxisVar *graphene.Vec3) *Transfor
 
 var xTransformScale func(uintptr, float32, float32) *Transform
 
-// Scales @next in 2-dimensional space by the given factors.
+// Scales next in 2-dimensional space by the given factors.
 //
-// Use [method@Gsk.Transform.scale_3d] to scale in all 3 dimensions.
+// Use [Transform.Scale3d] to scale in all 3 dimensions.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Scale(FactorXVar float32, FactorYVar float32) *Transform {
 
@@ -1455,9 +1392,9 @@ func (x *Transform) Scale(FactorXVar float32, FactorYVar float32) *Transform {
 
 var xTransformScale3d func(uintptr, float32, float32, float32) *Transform
 
-// Scales @next by the given factors.
+// Scales next by the given factors.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Scale3d(FactorXVar float32, FactorYVar float32, FactorZVar float32) *Transform {
 
@@ -1469,7 +1406,7 @@ var xTransformSkew func(uintptr, float32, float32) *Transform
 
 // Applies a skew transform.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Skew(SkewXVar float32, SkewYVar float32) *Transform {
 
@@ -1481,25 +1418,21 @@ var xTransformTo2d func(uintptr, *float32, *float32, *float32, *float32, *float3
 
 // Converts a transform to a 2D transformation matrix.
 //
-// @self must be a 2D transformation. If you are not
+// self must be a 2D transformation. If you are not
 // sure, use
 //
-//	gsk_transform_get_category() &gt;= GSK_TRANSFORM_CATEGORY_2D
+//	[Transform.GetCategory] >= GSK_TRANSFORM_CATEGORY_2D
 //
 // to check.
 //
 // The returned values are a subset of the full 4x4 matrix that
-// is computed by [method@Gsk.Transform.to_matrix] and have the
+// is computed by [Transform.ToMatrix] and have the
 // following layout:
 //
-// ```
-//
 //	| xx yx |   |  a  b  0 |
 //	| xy yy | = |  c  d  0 |
 //	| dx dy |   | tx ty  1 |
 //
-// ```
-//
 // This function can be used to convert between a `GskTransform`
 // and a matrix type from other 2D drawing libraries, in particular
 // Cairo.
@@ -1519,14 +1452,14 @@ var xTransformTo2dComponents func(uintptr, *float32, *float32, *float32, *float3
 //	gsk_transform_skew (
 //	    gsk_transform_scale (
 //	        gsk_transform_rotate (
-//	            gsk_transform_translate (NULL, &amp;GRAPHENE_POINT_T (dx, dy)),
+//	            gsk_transform_translate (NULL, &GRAPHENE_POINT_T (dx, dy)),
 //	            angle),
 //	        scale_x, scale_y),
 //	    skew_x, skew_y)
 //
-// @self must be a 2D transformation. If you are not sure, use
+// self must be a 2D transformation. If you are not sure, use
 //
-//	gsk_transform_get_category() &gt;= GSK_TRANSFORM_CATEGORY_2D
+//	[Transform.GetCategory] >= GSK_TRANSFORM_CATEGORY_2D
 //
 // to check.
 func (x *Transform) To2dComponents(OutSkewXVar *float32, OutSkewYVar *float32, OutScaleXVar *float32, OutScaleYVar *float32, OutAngleVar *float32, OutDxVar *float32, OutDyVar *float32) {
@@ -1545,13 +1478,13 @@ var xTransformToAffine func(uintptr, *float32, *float32, *float32, *float32)
 //	gsk_transform_scale (
 //	    gsk_transform_translate (
 //	        NULL,
-//	        &amp;GRAPHENE_POINT_T (dx, dy)),
+//	        &GRAPHENE_POINT_T (dx, dy)),
 //	    sx, sy)
 //
-// @self must be a 2D affine transformation. If you are not
+// self must be a 2D affine transformation. If you are not
 // sure, use
 //
-//	gsk_transform_get_category() &gt;= GSK_TRANSFORM_CATEGORY_2D_AFFINE
+//	[Transform.GetCategory] >= GSK_TRANSFORM_CATEGORY_2D_AFFINE
 //
 // to check.
 func (x *Transform) ToAffine(OutScaleXVar *float32, OutScaleYVar *float32, OutDxVar *float32, OutDyVar *float32) {
@@ -1564,7 +1497,7 @@ var xTransformToMatrix func(uintptr, *graphene.Matrix)
 
 // Computes the 4x4 matrix for the transform.
 //
-// The previous value of @out_matrix will be ignored.
+// The previous value of out_matrix will be ignored.
 func (x *Transform) ToMatrix(OutMatrixVar *graphene.Matrix) {
 
 	xTransformToMatrix(x.GoPointer(), OutMatrixVar)
@@ -1575,9 +1508,9 @@ var xTransformToString func(uintptr) string
 
 // Converts the transform into a human-readable string.
 //
-// The resulting string can be parsed with [func@Gsk.Transform.parse].
+// The resulting string can be parsed with [TransformParse].
 //
-// This is a wrapper around [method@Gsk.Transform.print].
+// This is a wrapper around [Transform.Print].
 func (x *Transform) ToString() string {
 
 	cret := xTransformToString(x.GoPointer())
@@ -1588,10 +1521,10 @@ var xTransformToTranslate func(uintptr, *float32, *float32)
 
 // Converts a transform to a translation operation.
 //
-// @self must be a 2D transformation. If you are not
+// self must be a 2D transformation. If you are not
 // sure, use
 //
-//	gsk_transform_get_category() &gt;= GSK_TRANSFORM_CATEGORY_2D_TRANSLATE
+//	[Transform.GetCategory] >= GSK_TRANSFORM_CATEGORY_2D_TRANSLATE
 //
 // to check.
 func (x *Transform) ToTranslate(OutDxVar *float32, OutDyVar *float32) {
@@ -1602,9 +1535,9 @@ func (x *Transform) ToTranslate(OutDxVar *float32, OutDyVar *float32) {
 
 var xTransformTransform func(uintptr, *Transform) *Transform
 
-// Applies all the operations from @other to @next.
+// Applies all the operations from other to next.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Transform(OtherVar *Transform) *Transform {
 
@@ -1634,9 +1567,9 @@ func (x *Transform) TransformPoint(PointVar *graphene.Point, OutPointVar *graphe
 
 var xTransformTranslate func(uintptr, *graphene.Point) *Transform
 
-// Translates @next in 2-dimensional space by @point.
+// Translates next in 2-dimensional space by point.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Translate(PointVar *graphene.Point) *Transform {
 
@@ -1646,9 +1579,9 @@ func (x *Transform) Translate(PointVar *graphene.Point) *Transform {
 
 var xTransformTranslate3d func(uintptr, *graphene.Point3D) *Transform
 
-// Translates @next by @point.
+// Translates next by point.
 //
-// This function consumes @next. Use [method@Gsk.Transform.ref] first
+// This function consumes next. Use [Transform.Ref] first
 // if you want to keep it around.
 func (x *Transform) Translate3d(PointVar *graphene.Point3D) *Transform {
 
@@ -1660,7 +1593,7 @@ var xTransformUnref func(uintptr)
 
 // Releases a reference on the given transform.
 //
-// If the reference was the last, the resources associated to the @self are
+// If the reference was the last, the resources associated to the self are
 // freed.
 func (x *Transform) Unref() {
 
@@ -1673,7 +1606,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1682,129 +1615,129 @@ func init() {
 
 	core.PuregoSafeRegister(&xComponentTransferGLibType, libs, "gsk_component_transfer_get_type")
 
-	core.PuregoSafeRegister(&xNewComponentTransferDiscrete, libs, "gsk_component_transfer_new_discrete")
-	core.PuregoSafeRegister(&xNewComponentTransferGamma, libs, "gsk_component_transfer_new_gamma")
-	core.PuregoSafeRegister(&xNewComponentTransferIdentity, libs, "gsk_component_transfer_new_identity")
-	core.PuregoSafeRegister(&xNewComponentTransferLevels, libs, "gsk_component_transfer_new_levels")
-	core.PuregoSafeRegister(&xNewComponentTransferLinear, libs, "gsk_component_transfer_new_linear")
-	core.PuregoSafeRegister(&xNewComponentTransferTable, libs, "gsk_component_transfer_new_table")
+	core.PuregoRegisterSince(&xNewComponentTransferDiscrete, libs, "gsk_component_transfer_new_discrete", "GSK", "4.20")
+	core.PuregoRegisterSince(&xNewComponentTransferGamma, libs, "gsk_component_transfer_new_gamma", "GSK", "4.20")
+	core.PuregoRegisterSince(&xNewComponentTransferIdentity, libs, "gsk_component_transfer_new_identity", "GSK", "4.20")
+	core.PuregoRegisterSince(&xNewComponentTransferLevels, libs, "gsk_component_transfer_new_levels", "GSK", "4.20")
+	core.PuregoRegisterSince(&xNewComponentTransferLinear, libs, "gsk_component_transfer_new_linear", "GSK", "4.20")
+	core.PuregoRegisterSince(&xNewComponentTransferTable, libs, "gsk_component_transfer_new_table", "GSK", "4.20")
 
-	core.PuregoSafeRegister(&xComponentTransferCopy, libs, "gsk_component_transfer_copy")
-	core.PuregoSafeRegister(&xComponentTransferFree, libs, "gsk_component_transfer_free")
+	core.PuregoRegisterSince(&xComponentTransferCopy, libs, "gsk_component_transfer_copy", "GSK", "4.20")
+	core.PuregoRegisterSince(&xComponentTransferFree, libs, "gsk_component_transfer_free", "GSK", "4.20")
 
 	core.PuregoSafeRegister(&xPathGLibType, libs, "gsk_path_get_type")
 
-	core.PuregoSafeRegister(&xPathForeach, libs, "gsk_path_foreach")
-	core.PuregoSafeRegister(&xPathForeachIntersection, libs, "gsk_path_foreach_intersection")
-	core.PuregoSafeRegister(&xPathGetBounds, libs, "gsk_path_get_bounds")
-	core.PuregoSafeRegister(&xPathGetClosestPoint, libs, "gsk_path_get_closest_point")
-	core.PuregoSafeRegister(&xPathGetEndPoint, libs, "gsk_path_get_end_point")
-	core.PuregoSafeRegister(&xPathGetStartPoint, libs, "gsk_path_get_start_point")
-	core.PuregoSafeRegister(&xPathGetStrokeBounds, libs, "gsk_path_get_stroke_bounds")
-	core.PuregoSafeRegister(&xPathInFill, libs, "gsk_path_in_fill")
-	core.PuregoSafeRegister(&xPathIsClosed, libs, "gsk_path_is_closed")
-	core.PuregoSafeRegister(&xPathIsEmpty, libs, "gsk_path_is_empty")
-	core.PuregoSafeRegister(&xPathPrint, libs, "gsk_path_print")
-	core.PuregoSafeRegister(&xPathRef, libs, "gsk_path_ref")
-	core.PuregoSafeRegister(&xPathToCairo, libs, "gsk_path_to_cairo")
-	core.PuregoSafeRegister(&xPathToString, libs, "gsk_path_to_string")
-	core.PuregoSafeRegister(&xPathUnref, libs, "gsk_path_unref")
+	core.PuregoRegisterSince(&xPathForeach, libs, "gsk_path_foreach", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathForeachIntersection, libs, "gsk_path_foreach_intersection", "GSK", "4.20")
+	core.PuregoRegisterSince(&xPathGetBounds, libs, "gsk_path_get_bounds", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathGetClosestPoint, libs, "gsk_path_get_closest_point", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathGetEndPoint, libs, "gsk_path_get_end_point", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathGetStartPoint, libs, "gsk_path_get_start_point", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathGetStrokeBounds, libs, "gsk_path_get_stroke_bounds", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathInFill, libs, "gsk_path_in_fill", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathIsClosed, libs, "gsk_path_is_closed", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathIsEmpty, libs, "gsk_path_is_empty", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathPrint, libs, "gsk_path_print", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathRef, libs, "gsk_path_ref", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathToCairo, libs, "gsk_path_to_cairo", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathToString, libs, "gsk_path_to_string", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathUnref, libs, "gsk_path_unref", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xPathBuilderGLibType, libs, "gsk_path_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewPathBuilder, libs, "gsk_path_builder_new")
-
-	core.PuregoSafeRegister(&xPathBuilderAddCairoPath, libs, "gsk_path_builder_add_cairo_path")
-	core.PuregoSafeRegister(&xPathBuilderAddCircle, libs, "gsk_path_builder_add_circle")
-	core.PuregoSafeRegister(&xPathBuilderAddLayout, libs, "gsk_path_builder_add_layout")
-	core.PuregoSafeRegister(&xPathBuilderAddPath, libs, "gsk_path_builder_add_path")
-	core.PuregoSafeRegister(&xPathBuilderAddRect, libs, "gsk_path_builder_add_rect")
-	core.PuregoSafeRegister(&xPathBuilderAddReversePath, libs, "gsk_path_builder_add_reverse_path")
-	core.PuregoSafeRegister(&xPathBuilderAddRoundedRect, libs, "gsk_path_builder_add_rounded_rect")
-	core.PuregoSafeRegister(&xPathBuilderAddSegment, libs, "gsk_path_builder_add_segment")
-	core.PuregoSafeRegister(&xPathBuilderArcTo, libs, "gsk_path_builder_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderClose, libs, "gsk_path_builder_close")
-	core.PuregoSafeRegister(&xPathBuilderConicTo, libs, "gsk_path_builder_conic_to")
-	core.PuregoSafeRegister(&xPathBuilderCubicTo, libs, "gsk_path_builder_cubic_to")
-	core.PuregoSafeRegister(&xPathBuilderFreeToPath, libs, "gsk_path_builder_free_to_path")
-	core.PuregoSafeRegister(&xPathBuilderGetCurrentPoint, libs, "gsk_path_builder_get_current_point")
-	core.PuregoSafeRegister(&xPathBuilderHtmlArcTo, libs, "gsk_path_builder_html_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderLineTo, libs, "gsk_path_builder_line_to")
-	core.PuregoSafeRegister(&xPathBuilderMoveTo, libs, "gsk_path_builder_move_to")
-	core.PuregoSafeRegister(&xPathBuilderQuadTo, libs, "gsk_path_builder_quad_to")
-	core.PuregoSafeRegister(&xPathBuilderRef, libs, "gsk_path_builder_ref")
-	core.PuregoSafeRegister(&xPathBuilderRelArcTo, libs, "gsk_path_builder_rel_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderRelConicTo, libs, "gsk_path_builder_rel_conic_to")
-	core.PuregoSafeRegister(&xPathBuilderRelCubicTo, libs, "gsk_path_builder_rel_cubic_to")
-	core.PuregoSafeRegister(&xPathBuilderRelHtmlArcTo, libs, "gsk_path_builder_rel_html_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderRelLineTo, libs, "gsk_path_builder_rel_line_to")
-	core.PuregoSafeRegister(&xPathBuilderRelMoveTo, libs, "gsk_path_builder_rel_move_to")
-	core.PuregoSafeRegister(&xPathBuilderRelQuadTo, libs, "gsk_path_builder_rel_quad_to")
-	core.PuregoSafeRegister(&xPathBuilderRelSvgArcTo, libs, "gsk_path_builder_rel_svg_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderSvgArcTo, libs, "gsk_path_builder_svg_arc_to")
-	core.PuregoSafeRegister(&xPathBuilderToPath, libs, "gsk_path_builder_to_path")
-	core.PuregoSafeRegister(&xPathBuilderUnref, libs, "gsk_path_builder_unref")
+	core.PuregoRegisterSince(&xNewPathBuilder, libs, "gsk_path_builder_new", "GSK", "4.14")
+
+	core.PuregoRegisterSince(&xPathBuilderAddCairoPath, libs, "gsk_path_builder_add_cairo_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddCircle, libs, "gsk_path_builder_add_circle", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddLayout, libs, "gsk_path_builder_add_layout", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddPath, libs, "gsk_path_builder_add_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddRect, libs, "gsk_path_builder_add_rect", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddReversePath, libs, "gsk_path_builder_add_reverse_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddRoundedRect, libs, "gsk_path_builder_add_rounded_rect", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderAddSegment, libs, "gsk_path_builder_add_segment", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderArcTo, libs, "gsk_path_builder_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderClose, libs, "gsk_path_builder_close", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderConicTo, libs, "gsk_path_builder_conic_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderCubicTo, libs, "gsk_path_builder_cubic_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderFreeToPath, libs, "gsk_path_builder_free_to_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderGetCurrentPoint, libs, "gsk_path_builder_get_current_point", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderHtmlArcTo, libs, "gsk_path_builder_html_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderLineTo, libs, "gsk_path_builder_line_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderMoveTo, libs, "gsk_path_builder_move_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderQuadTo, libs, "gsk_path_builder_quad_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRef, libs, "gsk_path_builder_ref", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelArcTo, libs, "gsk_path_builder_rel_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelConicTo, libs, "gsk_path_builder_rel_conic_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelCubicTo, libs, "gsk_path_builder_rel_cubic_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelHtmlArcTo, libs, "gsk_path_builder_rel_html_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelLineTo, libs, "gsk_path_builder_rel_line_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelMoveTo, libs, "gsk_path_builder_rel_move_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelQuadTo, libs, "gsk_path_builder_rel_quad_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderRelSvgArcTo, libs, "gsk_path_builder_rel_svg_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderSvgArcTo, libs, "gsk_path_builder_svg_arc_to", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderToPath, libs, "gsk_path_builder_to_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathBuilderUnref, libs, "gsk_path_builder_unref", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xPathMeasureGLibType, libs, "gsk_path_measure_get_type")
 
-	core.PuregoSafeRegister(&xNewPathMeasure, libs, "gsk_path_measure_new")
-	core.PuregoSafeRegister(&xNewPathMeasureWithTolerance, libs, "gsk_path_measure_new_with_tolerance")
+	core.PuregoRegisterSince(&xNewPathMeasure, libs, "gsk_path_measure_new", "GSK", "4.14")
+	core.PuregoRegisterSince(&xNewPathMeasureWithTolerance, libs, "gsk_path_measure_new_with_tolerance", "GSK", "4.14")
 
-	core.PuregoSafeRegister(&xPathMeasureGetLength, libs, "gsk_path_measure_get_length")
-	core.PuregoSafeRegister(&xPathMeasureGetPath, libs, "gsk_path_measure_get_path")
-	core.PuregoSafeRegister(&xPathMeasureGetPoint, libs, "gsk_path_measure_get_point")
-	core.PuregoSafeRegister(&xPathMeasureGetTolerance, libs, "gsk_path_measure_get_tolerance")
-	core.PuregoSafeRegister(&xPathMeasureRef, libs, "gsk_path_measure_ref")
-	core.PuregoSafeRegister(&xPathMeasureUnref, libs, "gsk_path_measure_unref")
+	core.PuregoRegisterSince(&xPathMeasureGetLength, libs, "gsk_path_measure_get_length", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathMeasureGetPath, libs, "gsk_path_measure_get_path", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathMeasureGetPoint, libs, "gsk_path_measure_get_point", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathMeasureGetTolerance, libs, "gsk_path_measure_get_tolerance", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathMeasureRef, libs, "gsk_path_measure_ref", "GSK", "4.14")
+	core.PuregoRegisterSince(&xPathMeasureUnref, libs, "gsk_path_measure_unref", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xStrokeGLibType, libs, "gsk_stroke_get_type")
 
-	core.PuregoSafeRegister(&xNewStroke, libs, "gsk_stroke_new")
-
-	core.PuregoSafeRegister(&xStrokeCopy, libs, "gsk_stroke_copy")
-	core.PuregoSafeRegister(&xStrokeFree, libs, "gsk_stroke_free")
-	core.PuregoSafeRegister(&xStrokeGetDash, libs, "gsk_stroke_get_dash")
-	core.PuregoSafeRegister(&xStrokeGetDashOffset, libs, "gsk_stroke_get_dash_offset")
-	core.PuregoSafeRegister(&xStrokeGetLineCap, libs, "gsk_stroke_get_line_cap")
-	core.PuregoSafeRegister(&xStrokeGetLineJoin, libs, "gsk_stroke_get_line_join")
-	core.PuregoSafeRegister(&xStrokeGetLineWidth, libs, "gsk_stroke_get_line_width")
-	core.PuregoSafeRegister(&xStrokeGetMiterLimit, libs, "gsk_stroke_get_miter_limit")
-	core.PuregoSafeRegister(&xStrokeSetDash, libs, "gsk_stroke_set_dash")
-	core.PuregoSafeRegister(&xStrokeSetDashOffset, libs, "gsk_stroke_set_dash_offset")
-	core.PuregoSafeRegister(&xStrokeSetLineCap, libs, "gsk_stroke_set_line_cap")
-	core.PuregoSafeRegister(&xStrokeSetLineJoin, libs, "gsk_stroke_set_line_join")
-	core.PuregoSafeRegister(&xStrokeSetLineWidth, libs, "gsk_stroke_set_line_width")
-	core.PuregoSafeRegister(&xStrokeSetMiterLimit, libs, "gsk_stroke_set_miter_limit")
-	core.PuregoSafeRegister(&xStrokeToCairo, libs, "gsk_stroke_to_cairo")
+	core.PuregoRegisterSince(&xNewStroke, libs, "gsk_stroke_new", "GSK", "4.14")
+
+	core.PuregoRegisterSince(&xStrokeCopy, libs, "gsk_stroke_copy", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeFree, libs, "gsk_stroke_free", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetDash, libs, "gsk_stroke_get_dash", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetDashOffset, libs, "gsk_stroke_get_dash_offset", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetLineCap, libs, "gsk_stroke_get_line_cap", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetLineJoin, libs, "gsk_stroke_get_line_join", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetLineWidth, libs, "gsk_stroke_get_line_width", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeGetMiterLimit, libs, "gsk_stroke_get_miter_limit", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetDash, libs, "gsk_stroke_set_dash", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetDashOffset, libs, "gsk_stroke_set_dash_offset", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetLineCap, libs, "gsk_stroke_set_line_cap", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetLineJoin, libs, "gsk_stroke_set_line_join", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetLineWidth, libs, "gsk_stroke_set_line_width", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeSetMiterLimit, libs, "gsk_stroke_set_miter_limit", "GSK", "4.14")
+	core.PuregoRegisterSince(&xStrokeToCairo, libs, "gsk_stroke_to_cairo", "GSK", "4.14")
 
 	core.PuregoSafeRegister(&xTransformGLibType, libs, "gsk_transform_get_type")
 
-	core.PuregoSafeRegister(&xNewTransform, libs, "gsk_transform_new")
-
-	core.PuregoSafeRegister(&xTransformEqual, libs, "gsk_transform_equal")
-	core.PuregoSafeRegister(&xTransformGetCategory, libs, "gsk_transform_get_category")
-	core.PuregoSafeRegister(&xTransformInvert, libs, "gsk_transform_invert")
-	core.PuregoSafeRegister(&xTransformMatrix, libs, "gsk_transform_matrix")
-	core.PuregoSafeRegister(&xTransformMatrix2d, libs, "gsk_transform_matrix_2d")
-	core.PuregoSafeRegister(&xTransformPerspective, libs, "gsk_transform_perspective")
-	core.PuregoSafeRegister(&xTransformPrint, libs, "gsk_transform_print")
-	core.PuregoSafeRegister(&xTransformRef, libs, "gsk_transform_ref")
-	core.PuregoSafeRegister(&xTransformRotate, libs, "gsk_transform_rotate")
-	core.PuregoSafeRegister(&xTransformRotate3d, libs, "gsk_transform_rotate_3d")
-	core.PuregoSafeRegister(&xTransformScale, libs, "gsk_transform_scale")
-	core.PuregoSafeRegister(&xTransformScale3d, libs, "gsk_transform_scale_3d")
-	core.PuregoSafeRegister(&xTransformSkew, libs, "gsk_transform_skew")
-	core.PuregoSafeRegister(&xTransformTo2d, libs, "gsk_transform_to_2d")
-	core.PuregoSafeRegister(&xTransformTo2dComponents, libs, "gsk_transform_to_2d_components")
-	core.PuregoSafeRegister(&xTransformToAffine, libs, "gsk_transform_to_affine")
-	core.PuregoSafeRegister(&xTransformToMatrix, libs, "gsk_transform_to_matrix")
-	core.PuregoSafeRegister(&xTransformToString, libs, "gsk_transform_to_string")
-	core.PuregoSafeRegister(&xTransformToTranslate, libs, "gsk_transform_to_translate")
-	core.PuregoSafeRegister(&xTransformTransform, libs, "gsk_transform_transform")
-	core.PuregoSafeRegister(&xTransformTransformBounds, libs, "gsk_transform_transform_bounds")
-	core.PuregoSafeRegister(&xTransformTransformPoint, libs, "gsk_transform_transform_point")
-	core.PuregoSafeRegister(&xTransformTranslate, libs, "gsk_transform_translate")
-	core.PuregoSafeRegister(&xTransformTranslate3d, libs, "gsk_transform_translate_3d")
-	core.PuregoSafeRegister(&xTransformUnref, libs, "gsk_transform_unref")
+	core.PuregoRegisterSince(&xNewTransform, libs, "gsk_transform_new", "GSK", "")
+
+	core.PuregoRegisterSince(&xTransformEqual, libs, "gsk_transform_equal", "GSK", "")
+	core.PuregoRegisterSince(&xTransformGetCategory, libs, "gsk_transform_get_category", "GSK", "")
+	core.PuregoRegisterSince(&xTransformInvert, libs, "gsk_transform_invert", "GSK", "")
+	core.PuregoRegisterSince(&xTransformMatrix, libs, "gsk_transform_matrix", "GSK", "")
+	core.PuregoRegisterSince(&xTransformMatrix2d, libs, "gsk_transform_matrix_2d", "GSK", "4.20")
+	core.PuregoRegisterSince(&xTransformPerspective, libs, "gsk_transform_perspective", "GSK", "")
+	core.PuregoRegisterSince(&xTransformPrint, libs, "gsk_transform_print", "GSK", "")
+	core.PuregoRegisterSince(&xTransformRef, libs, "gsk_transform_ref", "GSK", "")
+	core.PuregoRegisterSince(&xTransformRotate, libs, "gsk_transform_rotate", "GSK", "")
+	core.PuregoRegisterSince(&xTransformRotate3d, libs, "gsk_transform_rotate_3d", "GSK", "")
+	core.PuregoRegisterSince(&xTransformScale, libs, "gsk_transform_scale", "GSK", "")
+	core.PuregoRegisterSince(&xTransformScale3d, libs, "gsk_transform_scale_3d", "GSK", "")
+	core.PuregoRegisterSince(&xTransformSkew, libs, "gsk_transform_skew", "GSK", "4.6")
+	core.PuregoRegisterSince(&xTransformTo2d, libs, "gsk_transform_to_2d", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTo2dComponents, libs, "gsk_transform_to_2d_components", "GSK", "4.6")
+	core.PuregoRegisterSince(&xTransformToAffine, libs, "gsk_transform_to_affine", "GSK", "")
+	core.PuregoRegisterSince(&xTransformToMatrix, libs, "gsk_transform_to_matrix", "GSK", "")
+	core.PuregoRegisterSince(&xTransformToString, libs, "gsk_transform_to_string", "GSK", "")
+	core.PuregoRegisterSince(&xTransformToTranslate, libs, "gsk_transform_to_translate", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTransform, libs, "gsk_transform_transform", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTransformBounds, libs, "gsk_transform_transform_bounds", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTransformPoint, libs, "gsk_transform_transform_point", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTranslate, libs, "gsk_transform_translate", "GSK", "")
+	core.PuregoRegisterSince(&xTransformTranslate3d, libs, "gsk_transform_translate_3d", "GSK", "")
+	core.PuregoRegisterSince(&xTransformUnref, libs, "gsk_transform_unref", "GSK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -75,7 +74,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -84,6 +83,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xVulkanRendererGLibType, libs, "gsk_vulkan_renderer_get_type")
 
-	core.PuregoSafeRegister(&xNewVulkanRenderer, libs, "gsk_vulkan_renderer_new")
+	core.PuregoRegisterSince(&xNewVulkanRenderer, libs, "gsk_vulkan_renderer_new", "GSK", "")
 
 }
@@ -2,7 +2,6 @@
 package gsk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -43,7 +42,7 @@ func NewComponentTransferNode(ChildVar *RenderNode, RVar *ComponentTransfer, GVa
 
 var xComponentTransferNodeGetChild func(uintptr) uintptr
 
-// Gets the child node that is getting drawn by the given @node.
+// Gets the child node that is getting drawn by the given node.
 func (x *ComponentTransferNode) GetChild() *RenderNode {
 	var cls *RenderNode
 
@@ -83,7 +82,7 @@ func init() {
 	core.SetSharedLibraries("GSK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GSK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -92,9 +91,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xComponentTransferNodeGLibType, libs, "gsk_component_transfer_node_get_type")
 
-	core.PuregoSafeRegister(&xNewComponentTransferNode, libs, "gsk_component_transfer_node_new")
+	core.PuregoRegisterSince(&xNewComponentTransferNode, libs, "gsk_component_transfer_node_new", "GSK", "4.20")
 
-	core.PuregoSafeRegister(&xComponentTransferNodeGetChild, libs, "gsk_component_transfer_node_get_child")
-	core.PuregoSafeRegister(&xComponentTransferNodeGetTransfer, libs, "gsk_component_transfer_node_get_transfer")
+	core.PuregoRegisterSince(&xComponentTransferNodeGetChild, libs, "gsk_component_transfer_node_get_child", "GSK", "4.20")
+	core.PuregoRegisterSince(&xComponentTransferNodeGetTransfer, libs, "gsk_component_transfer_node_get_transfer", "GSK", "4.20")
 
 }
@@ -0,0 +1,260 @@
+package gsk
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// SerializeBytes serializes the node into the text format of GSK, see Serialize.
+func (x *RenderNode) SerializeBytes() []byte {
+	bytes := x.Serialize()
+	if bytes == nil {
+		return nil
+	}
+	defer bytes.Unref()
+	var size uint
+	ptr := bytes.GetData(&size)
+	if size == 0 {
+		return []byte{}
+	}
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	src := unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), size)
+	return append([]byte(nil), src...)
+}
+
+// RenderNodeParseError is an error in serialized render node data.
+type RenderNodeParseError struct {
+	// Start and End are the location of the error in the data
+	Start, End ParseLocation
+	Message    string
+}
+
+func (e *RenderNodeParseError) Error() string {
+	return fmt.Sprintf("gsk: %d:%d: %s", e.Start.Lines+1, e.Start.LineChars+1, e.Message)
+}
+
+// RenderNodeParseErrors are all errors found while deserializing render node data.
+type RenderNodeParseErrors []*RenderNodeParseError
+
+func (e RenderNodeParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// parseErrorTrampoline is the single callback given to every deserialization
+// the errors are collected by the user data such that only one callback is allocated
+var parseErrorTrampoline ParseErrorFunc = func(start *ParseLocation, end *ParseLocation, err *glib.Error, id uintptr) {
+	errs, ok := glib.LookupUserData[*RenderNodeParseErrors](id)
+	if !ok {
+		return
+	}
+	e := &RenderNodeParseError{Message: err.MessageGo()}
+	if start != nil {
+		e.Start = *start
+	}
+	if end != nil {
+		e.End = *end
+	}
+	*errs = append(*errs, e)
+}
+
+// DeserializeRenderNode loads a node from data created by SerializeBytes or the GTK inspector.
+// Parsing is tolerant, so a node can be returned together with RenderNodeParseErrors
+// that list the parts of data that were skipped.
+func DeserializeRenderNode(data []byte) (*RenderNode, error) {
+	var errs RenderNodeParseErrors
+	id := glib.RegisterUserData(&errs)
+	defer glib.UnregisterUserData(id)
+
+	bytes := glib.NewBytes(data, uint(len(data)))
+	defer bytes.Unref()
+	node := RenderNodeDeserialize(bytes, &parseErrorTrampoline, id)
+	if len(errs) > 0 {
+		return node, errs
+	}
+	if node == nil {
+		return nil, fmt.Errorf("gsk: failed to deserialize render node")
+	}
+	return node, nil
+}
+
+// renderNodeChildGetters are the getters of the children of every node type with a fixed number of children
+// they are registered directly as the generated getters treat render nodes as objects
+var renderNodeChildGetters = map[RenderNodeType][]*func(uintptr) uintptr{}
+
+var (
+	xInspectContainerNodeGetNChildren func(uintptr) uint
+	xInspectContainerNodeGetChild     func(uintptr, uint) uintptr
+	xInspectGLShaderNodeGetNChildren  func(uintptr) uint
+	xInspectGLShaderNodeGetChild      func(uintptr, uint) uintptr
+	xInspectRenderNodeGetBounds       func(uintptr, *bounds)
+	xInspectDebugNodeGetMessage       func(uintptr) string
+)
+
+// bounds is the C layout of graphene_rect_t
+type bounds struct {
+	X, Y, Width, Height float32
+}
+
+// Children returns the direct children of the node in drawing order.
+// The children are owned by the node and are valid as long as the node is.
+func (x *RenderNode) Children() []*RenderNode {
+	var ret []*RenderNode
+	child := func(ptr uintptr) {
+		if ptr != 0 {
+			ret = append(ret, &RenderNode{Ptr: ptr})
+		}
+	}
+	switch t := x.GetNodeType(); t {
+	case ContainerNodeValue:
+		if xInspectContainerNodeGetNChildren == nil {
+			return nil
+		}
+		n := xInspectContainerNodeGetNChildren(x.Ptr)
+		for i := uint(0); i < n; i++ {
+			child(xInspectContainerNodeGetChild(x.Ptr, i))
+		}
+	case GlShaderNodeValue:
+		if xInspectGLShaderNodeGetNChildren == nil {
+			return nil
+		}
+		n := xInspectGLShaderNodeGetNChildren(x.Ptr)
+		for i := uint(0); i < n; i++ {
+			child(xInspectGLShaderNodeGetChild(x.Ptr, i))
+		}
+	default:
+		for _, get := range renderNodeChildGetters[t] {
+			if *get != nil {
+				child((*get)(x.Ptr))
+			}
+		}
+	}
+	return ret
+}
+
+// Walk calls fn for the node and all its descendants depth first, with the depth below the node.
+// The children of a node are skipped if fn returns false.
+func (x *RenderNode) Walk(fn func(node *RenderNode, depth int) bool) {
+	x.walk(fn, 0)
+}
+
+func (x *RenderNode) walk(fn func(node *RenderNode, depth int) bool, depth int) {
+	if !fn(x, depth) {
+		return
+	}
+	for _, c := range x.Children() {
+		c.walk(fn, depth+1)
+	}
+}
+
+// BoundsGo returns the x, y, width and height of the rectangle that the node draws in.
+func (x *RenderNode) BoundsGo() (float32, float32, float32, float32) {
+	var b bounds
+	xInspectRenderNodeGetBounds(x.Ptr, &b)
+	return b.X, b.Y, b.Width, b.Height
+}
+
+var renderNodeTypeNames = map[RenderNodeType]string{
+	ContainerNodeValue:               "container",
+	CairoNodeValue:                   "cairo",
+	ColorNodeValue:                   "color",
+	LinearGradientNodeValue:          "linear-gradient",
+	RepeatingLinearGradientNodeValue: "repeating-linear-gradient",
+	RadialGradientNodeValue:          "radial-gradient",
+	RepeatingRadialGradientNodeValue: "repeating-radial-gradient",
+	ConicGradientNodeValue:           "conic-gradient",
+	BorderNodeValue:                  "border",
+	TextureNodeValue:                 "texture",
+	InsetShadowNodeValue:             "inset-shadow",
+	OutsetShadowNodeValue:            "outset-shadow",
+	TransformNodeValue:               "transform",
+	OpacityNodeValue:                 "opacity",
+	ColorMatrixNodeValue:             "color-matrix",
+	RepeatNodeValue:                  "repeat",
+	ClipNodeValue:                    "clip",
+	RoundedClipNodeValue:             "rounded-clip",
+	ShadowNodeValue:                  "shadow",
+	BlendNodeValue:                   "blend",
+	CrossFadeNodeValue:               "cross-fade",
+	TextNodeValue:                    "text",
+	BlurNodeValue:                    "blur",
+	DebugNodeValue:                   "debug",
+	GlShaderNodeValue:                "glshader",
+	TextureScaleNodeValue:            "texture-scale",
+	MaskNodeValue:                    "mask",
+	FillNodeValue:                    "fill",
+	StrokeNodeValue:                  "stroke",
+	SubsurfaceNodeValue:              "subsurface",
+	ComponentTransferNodeValue:       "component-transfer",
+}
+
+// Dump returns the node tree as indented lines of node type and bounds, e.g. for test failures.
+// Debug nodes include their message.
+func (x *RenderNode) Dump() string {
+	var sb strings.Builder
+	x.Walk(func(node *RenderNode, depth int) bool {
+		t := node.GetNodeType()
+		name, ok := renderNodeTypeNames[t]
+		if !ok {
+			name = fmt.Sprintf("node-%d", t)
+		}
+		bx, by, bw, bh := node.BoundsGo()
+		fmt.Fprintf(&sb, "%s%s %g %g %g %g", strings.Repeat("  ", depth), name, bx, by, bw, bh)
+		if t == DebugNodeValue && xInspectDebugNodeGetMessage != nil {
+			fmt.Fprintf(&sb, " %q", xInspectDebugNodeGetMessage(node.Ptr))
+		}
+		sb.WriteByte('\n')
+		return true
+	})
+	return sb.String()
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GSK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xInspectContainerNodeGetNChildren, libs, "gsk_container_node_get_n_children")
+	core.PuregoSafeRegister(&xInspectContainerNodeGetChild, libs, "gsk_container_node_get_child")
+	core.PuregoSafeRegister(&xInspectGLShaderNodeGetNChildren, libs, "gsk_gl_shader_node_get_n_children")
+	core.PuregoSafeRegister(&xInspectGLShaderNodeGetChild, libs, "gsk_gl_shader_node_get_child")
+	core.PuregoSafeRegister(&xInspectRenderNodeGetBounds, libs, "gsk_render_node_get_bounds")
+	core.PuregoSafeRegister(&xInspectDebugNodeGetMessage, libs, "gsk_debug_node_get_message")
+
+	for t, symbols := range map[RenderNodeType][]string{
+		TransformNodeValue:         {"gsk_transform_node_get_child"},
+		OpacityNodeValue:           {"gsk_opacity_node_get_child"},
+		ColorMatrixNodeValue:       {"gsk_color_matrix_node_get_child"},
+		RepeatNodeValue:            {"gsk_repeat_node_get_child"},
+		ClipNodeValue:              {"gsk_clip_node_get_child"},
+		RoundedClipNodeValue:       {"gsk_rounded_clip_node_get_child"},
+		ShadowNodeValue:            {"gsk_shadow_node_get_child"},
+		BlendNodeValue:             {"gsk_blend_node_get_bottom_child", "gsk_blend_node_get_top_child"},
+		CrossFadeNodeValue:         {"gsk_cross_fade_node_get_start_child", "gsk_cross_fade_node_get_end_child"},
+		BlurNodeValue:              {"gsk_blur_node_get_child"},
+		DebugNodeValue:             {"gsk_debug_node_get_child"},
+		MaskNodeValue:              {"gsk_mask_node_get_source", "gsk_mask_node_get_mask"},
+		FillNodeValue:              {"gsk_fill_node_get_child"},
+		StrokeNodeValue:            {"gsk_stroke_node_get_child"},
+		SubsurfaceNodeValue:        {"gsk_subsurface_node_get_child"},
+		ComponentTransferNodeValue: {"gsk_component_transfer_node_get_child"},
+	} {
+		for _, sym := range symbols {
+			get := new(func(uintptr) uintptr)
+			core.PuregoSafeRegister(get, libs, sym)
+			renderNodeChildGetters[t] = append(renderNodeChildGetters[t], get)
+		}
+	}
+}
@@ -0,0 +1,3174 @@
+// Package gst was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gst
+
+import (
+	"fmt"
+	"strings"
+	"structs"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// Specifies the type of function passed to gst_bus_add_watch() or [Bus.AddWatchFull], which is called from the mainloop when a message is available on the bus.
+//
+// The message passed to the function will be unreffed after execution of this function so it should not be freed in the function.
+//
+// Note that this function is used as a [glib.SourceFunc] which means that returning false will remove the [glib.Source] from the mainloop.
+type BusFunc func(uintptr, *Message, uintptr) bool
+
+// Caps (capabilities) are lightweight refcounted objects describing media types. They are composed of an array of [Structure].
+//
+// Caps are exposed on GstPadTemplate to describe all possible types a given pad can handle. They are also stored in the GstRegistry along with a description of the [Element].
+//
+// Caps are exposed on the element pads using the [Pad.QueryCaps] pad function. This function describes the possible types that the pad can handle or produce at runtime.
+type Caps struct {
+	_ structs.HostLayout
+
+	MiniObject MiniObject
+}
+
+var xCapsGLibType func() types.GType
+
+func CapsGLibType() types.GType {
+	return xCapsGLibType()
+}
+
+func (x *Caps) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xNewCapsAny func() *Caps
+
+// Creates a new [Caps] that indicates that it is compatible with any media format.
+func NewCapsAny() *Caps {
+
+	cret := xNewCapsAny()
+	return cret
+}
+
+var xNewCapsEmpty func() *Caps
+
+// Creates a new [Caps] that is empty. That is, the returned [Caps] contains no media formats. The [Caps] is guaranteed to be writable.
+func NewCapsEmpty() *Caps {
+
+	cret := xNewCapsEmpty()
+	return cret
+}
+
+var xNewCapsEmptySimple func(string) *Caps
+
+// Creates a new [Caps] that contains one [Structure] with name media_type.
+func NewCapsEmptySimple(MediaTypeVar string) *Caps {
+
+	cret := xNewCapsEmptySimple(MediaTypeVar)
+	return cret
+}
+
+var xCapsAppendStructure func(uintptr, uintptr)
+
+// Appends structure to caps. The structure is not copied; caps becomes the owner of structure.
+func (x *Caps) AppendStructure(StructureVar *Structure) {
+
+	xCapsAppendStructure(x.GoPointer(), gobject.BoxedCopy(StructureGLibType(), StructureVar.GoPointer()))
+
+}
+
+var xCapsCanIntersect func(uintptr, *Caps) bool
+
+// Tries intersecting caps1 and caps2 and reports whether the result would not be empty
+func (x *Caps) CanIntersect(Caps2Var *Caps) bool {
+
+	cret := xCapsCanIntersect(x.GoPointer(), Caps2Var)
+	return cret
+}
+
+var xCapsCopy func(uintptr) *Caps
+
+// Creates a new [Caps] as a copy of the old caps. The new caps will have a refcount of 1, owned by the caller. The structures are copied as well.
+//
+// Note that this function is the semantic equivalent of a [Caps.Ref] followed by a gst_caps_make_writable(). If you only want to hold on to a reference to the data, you should use [Caps.Ref].
+func (x *Caps) Copy() *Caps {
+
+	cret := xCapsCopy(x.GoPointer())
+	return cret
+}
+
+var xCapsGetSize func(uintptr) uint
+
+// Gets the number of structures contained in caps.
+func (x *Caps) GetSize() uint {
+
+	cret := xCapsGetSize(x.GoPointer())
+	return cret
+}
+
+var xCapsGetStructure func(uintptr, uint) *Structure
+
+// Finds the structure in caps at index, and returns it.
+//
+// WARNING: This function takes a `const GstCaps *`, but returns a non-const `GstStructure *`. This is for programming convenience -- the caller should be aware that structures inside a constant [Caps] should not be modified. However, if you know the caps are writable, either because you have just copied them or made them writable with gst_caps_make_writable(), you may modify the structure returned in the usual way, e.g. with functions like gst_structure_set().
+func (x *Caps) GetStructure(IndexVar uint) *Structure {
+
+	cret := xCapsGetStructure(x.GoPointer(), IndexVar)
+	return cret
+}
+
+var xCapsIntersect func(uintptr, *Caps) *Caps
+
+// Creates a new [Caps] that contains all the formats that are common to both caps1 and caps2. Defaults to GST_CAPS_INTERSECT_ZIG_ZAG mode.
+func (x *Caps) Intersect(Caps2Var *Caps) *Caps {
+
+	cret := xCapsIntersect(x.GoPointer(), Caps2Var)
+	return cret
+}
+
+var xCapsIsAny func(uintptr) bool
+
+// Determines if caps represents any media format.
+func (x *Caps) IsAny() bool {
+
+	cret := xCapsIsAny(x.GoPointer())
+	return cret
+}
+
+var xCapsIsEmpty func(uintptr) bool
+
+// Determines if caps represents no media formats.
+func (x *Caps) IsEmpty() bool {
+
+	cret := xCapsIsEmpty(x.GoPointer())
+	return cret
+}
+
+var xCapsIsEqual func(uintptr, *Caps) bool
+
+// Checks if the given caps represent the same set of caps.
+func (x *Caps) IsEqual(Caps2Var *Caps) bool {
+
+	cret := xCapsIsEqual(x.GoPointer(), Caps2Var)
+	return cret
+}
+
+var xCapsIsFixed func(uintptr) bool
+
+// Fixed [Caps] describe exactly one format, that is, they have exactly one structure, and each field in the structure describes a fixed type. Examples of non-fixed types are GST_TYPE_INT_RANGE and GST_TYPE_LIST.
+func (x *Caps) IsFixed() bool {
+
+	cret := xCapsIsFixed(x.GoPointer())
+	return cret
+}
+
+var xCapsRef func(uintptr) *Caps
+
+// Adds a reference to a [Caps] object.
+//
+// From this point on, until the caller calls [Caps.Unref] or gst_caps_make_writable(), it is guaranteed that the caps object will not change. This means its structures won't change, etc. To use a [Caps] object, you must always have a refcount on it -- either the one made implicitly by e.g. gst_caps_new_simple(), or via taking one explicitly with this function.
+func (x *Caps) Ref() *Caps {
+
+	cret := xCapsRef(x.GoPointer())
+	return cret
+}
+
+var xCapsToString func(uintptr) string
+
+// Converts caps to a string representation. This string representation can be converted back to a [Caps] by [CapsFromString].
+//
+// For debugging purposes its easier to do something like this:
+//
+//	GST_LOG ("caps are %" GST_PTR_FORMAT, caps);
+//
+// This prints the caps in human readable form.
+//
+// The implementation of serialization up to 1.20 would lead to unexpected results when there were nested [Caps] / [Structure] deeper than one level.
+func (x *Caps) ToString() string {
+
+	cret := xCapsToString(x.GoPointer())
+	return cret
+}
+
+var xCapsUnref func(uintptr)
+
+// Unrefs a [Caps] and frees all its structures and the structures' values when the refcount reaches 0.
+func (x *Caps) Unref() {
+
+	xCapsUnref(x.GoPointer())
+
+}
+
+// Messages are implemented as a subclass of [MiniObject] with a generic [Structure] as the content. This allows for writing custom messages without requiring an API change while allowing a wide range of different types of messages.
+//
+// Messages are posted by objects in the pipeline and are passed to the application using the [Bus].
+//
+// The basic use pattern of posting a message on a [Bus] is as follows:
+//
+//	gst_bus_post (bus, gst_message_new_eos());
+//
+// A [Element] usually posts messages on the bus provided by the parent container using [Element.PostMessage].
+type Message struct {
+	_ structs.HostLayout
+
+	MiniObject MiniObject
+
+	Type MessageType
+
+	Timestamp uint64
+
+	Src *Object
+
+	Seqnum uint32
+
+	Lock glib.Mutex
+
+	Cond uintptr
+}
+
+var xMessageGLibType func() types.GType
+
+func MessageGLibType() types.GType {
+	return xMessageGLibType()
+}
+
+func (x *Message) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xNewMessageApplication func(uintptr, uintptr) *Message
+
+// Create a new application-typed message. GStreamer will never create these messages; they are a gift from us to you. Enjoy.
+func NewMessageApplication(SrcVar *Object, StructureVar *Structure) *Message {
+
+	cret := xNewMessageApplication(SrcVar.GoPointer(), gobject.BoxedCopy(StructureGLibType(), StructureVar.GoPointer()))
+	return cret
+}
+
+var xNewMessageEos func(uintptr) *Message
+
+// Create a new eos message. This message is generated and posted in the sink elements of a GstBin. The bin will only forward the EOS message to the application if all sinks have posted an EOS message.
+func NewMessageEos(SrcVar *Object) *Message {
+
+	cret := xNewMessageEos(SrcVar.GoPointer())
+	return cret
+}
+
+var xMessageGetSeqnum func(uintptr) uint32
+
+// Retrieve the sequence number of a message.
+//
+// Messages have ever-incrementing sequence numbers, which may also be set explicitly via gst_message_set_seqnum(). Sequence numbers are typically used to indicate that a message corresponds to some other set of messages or events, for example a SEGMENT_DONE message corresponding to a SEEK event. It is considered good practice to make this correspondence when possible, though it is not required.
+func (x *Message) GetSeqnum() uint32 {
+
+	cret := xMessageGetSeqnum(x.GoPointer())
+	return cret
+}
+
+var xMessageGetStructure func(uintptr) *Structure
+
+// Access the structure of the message.
+func (x *Message) GetStructure() *Structure {
+
+	cret := xMessageGetStructure(x.GoPointer())
+	return cret
+}
+
+var xMessageParseBuffering func(uintptr, *int)
+
+// Extracts the buffering percent from the GstMessage. See also gst_message_new_buffering().
+//
+// MT safe.
+func (x *Message) ParseBuffering(PercentVar *int) {
+
+	xMessageParseBuffering(x.GoPointer(), PercentVar)
+
+}
+
+var xMessageParseError func(uintptr, **glib.Error, *string)
+
+// Extracts the GError and debug string from the GstMessage. The values returned in the output arguments are copies; the caller must free them when done.
+//
+// MT safe.
+func (x *Message) ParseError(GerrorVar **glib.Error, DebugVar *string) {
+
+	xMessageParseError(x.GoPointer(), GerrorVar, DebugVar)
+
+}
+
+var xMessageParseInfo func(uintptr, **glib.Error, *string)
+
+// Extracts the GError and debug string from the GstMessage. The values returned in the output arguments are copies; the caller must free them when done.
+//
+// MT safe.
+func (x *Message) ParseInfo(GerrorVar **glib.Error, DebugVar *string) {
+
+	xMessageParseInfo(x.GoPointer(), GerrorVar, DebugVar)
+
+}
+
+var xMessageParseStateChanged func(uintptr, *State, *State, *State)
+
+// Extracts the old and new states from the GstMessage.
+//
+// MT safe.
+func (x *Message) ParseStateChanged(OldstateVar *State, NewstateVar *State, PendingVar *State) {
+
+	xMessageParseStateChanged(x.GoPointer(), OldstateVar, NewstateVar, PendingVar)
+
+}
+
+var xMessageParseWarning func(uintptr, **glib.Error, *string)
+
+// Extracts the GError and debug string from the GstMessage. The values returned in the output arguments are copies; the caller must free them when done.
+//
+// MT safe.
+func (x *Message) ParseWarning(GerrorVar **glib.Error, DebugVar *string) {
+
+	xMessageParseWarning(x.GoPointer(), GerrorVar, DebugVar)
+
+}
+
+var xMessageRef func(uintptr) *Message
+
+// Convenience macro to increase the reference count of the message.
+func (x *Message) Ref() *Message {
+
+	cret := xMessageRef(x.GoPointer())
+	return cret
+}
+
+var xMessageUnref func(uintptr)
+
+// Convenience macro to decrease the reference count of the message, possibly freeing it.
+func (x *Message) Unref() {
+
+	xMessageUnref(x.GoPointer())
+
+}
+
+// [MiniObject] is a simple structure that can be used to implement refcounted types.
+//
+// Subclasses will include [MiniObject] as the first member in their structure and then call gst_mini_object_init() to initialize the [MiniObject] fields.
+type MiniObject struct {
+	_ structs.HostLayout
+
+	Type types.GType
+
+	Refcount int32
+
+	Lockstate int32
+
+	Flags uint
+
+	Copy uintptr
+
+	Dispose uintptr
+
+	Free uintptr
+
+	PrivUint uint
+
+	PrivPointer uintptr
+}
+
+var xMiniObjectGLibType func() types.GType
+
+func MiniObjectGLibType() types.GType {
+	return xMiniObjectGLibType()
+}
+
+func (x *MiniObject) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xMiniObjectIsWritable func(uintptr) bool
+
+// If mini_object has the LOCKABLE flag set, check if the current EXCLUSIVE lock on object is the only one, this means that changes to the object will not be visible to any other object.
+//
+// If the LOCKABLE flag is not set, check if the refcount of mini_object is exactly 1, meaning that no other reference exists to the object and that the object is therefore writable.
+//
+// Modification of a mini-object should only be done after verifying that it is writable.
+func (x *MiniObject) IsWritable() bool {
+
+	cret := xMiniObjectIsWritable(x.GoPointer())
+	return cret
+}
+
+var xMiniObjectRef func(uintptr) *MiniObject
+
+// Increase the reference count of the mini-object.
+//
+// Note that the refcount affects the writability of mini-object, see [MiniObject.IsWritable]. It is important to note that keeping additional references to GstMiniObject instances can potentially increase the number of memcpy operations in a pipeline, especially if the miniobject is a GstBuffer.
+func (x *MiniObject) Ref() *MiniObject {
+
+	cret := xMiniObjectRef(x.GoPointer())
+	return cret
+}
+
+var xMiniObjectUnref func(uintptr)
+
+// Decreases the reference count of the mini-object, possibly freeing the mini-object.
+func (x *MiniObject) Unref() {
+
+	xMiniObjectUnref(x.GoPointer())
+
+}
+
+// A [Structure] is a collection of key/value pairs. The keys are expressed as GQuarks and the values can be of any GType.
+//
+// In addition to the key/value pairs, a [Structure] also has a name. The name starts with a letter and can be filled by letters, numbers and any of "/-_.:".
+//
+// [Structure] is used by various GStreamer subsystems to store information in a flexible and extensible way, e.g. in the caps of a pad or in the messages of a bus.
+type Structure struct {
+	_ structs.HostLayout
+
+	Type types.GType
+
+	Name glib.Quark
+}
+
+var xStructureGLibType func() types.GType
+
+func StructureGLibType() types.GType {
+	return xStructureGLibType()
+}
+
+func (x *Structure) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xNewStructureEmpty func(string) *Structure
+
+// Creates a new, empty [Structure] with the given name.
+//
+// See gst_structure_set_name() for constraints on the name parameter.
+//
+// Free-function: gst_structure_free
+func NewStructureEmpty(NameVar string) *Structure {
+
+	cret := xNewStructureEmpty(NameVar)
+	return cret
+}
+
+var xStructureCopy func(uintptr) *Structure
+
+// Duplicates a [Structure] and all its fields and values.
+//
+// Free-function: gst_structure_free
+func (x *Structure) Copy() *Structure {
+
+	cret := xStructureCopy(x.GoPointer())
+	return cret
+}
+
+var xStructureFree func(uintptr)
+
+// Frees a [Structure] and all its fields and values. The structure must not have a parent when this function is called.
+func (x *Structure) Free() {
+
+	xStructureFree(x.GoPointer())
+
+}
+
+var xStructureGetBoolean func(uintptr, string, *bool) bool
+
+// Sets the boolean pointed to by value corresponding to the value of the given field. Caller is responsible for making sure the field exists and has the correct type.
+func (x *Structure) GetBoolean(FieldnameVar string, ValueVar *bool) bool {
+
+	cret := xStructureGetBoolean(x.GoPointer(), FieldnameVar, ValueVar)
+	return cret
+}
+
+var xStructureGetDouble func(uintptr, string, *float64) bool
+
+// Sets the double pointed to by value corresponding to the value of the given field. Caller is responsible for making sure the field exists and has the correct type.
+func (x *Structure) GetDouble(FieldnameVar string, ValueVar *float64) bool {
+
+	cret := xStructureGetDouble(x.GoPointer(), FieldnameVar, ValueVar)
+	return cret
+}
+
+var xStructureGetInt func(uintptr, string, *int) bool
+
+// Sets the int pointed to by value corresponding to the value of the given field. Caller is responsible for making sure the field exists and has the correct type.
+func (x *Structure) GetInt(FieldnameVar string, ValueVar *int) bool {
+
+	cret := xStructureGetInt(x.GoPointer(), FieldnameVar, ValueVar)
+	return cret
+}
+
+var xStructureGetName func(uintptr) string
+
+// Get the name of structure as a string.
+func (x *Structure) GetName() string {
+
+	cret := xStructureGetName(x.GoPointer())
+	return cret
+}
+
+var xStructureGetString func(uintptr, string) string
+
+// Finds the field corresponding to fieldname, and returns the string contained in the field's value. Caller is responsible for making sure the field exists and has the correct type.
+//
+// The string should not be modified, and remains valid until the next call to a gst_structure_*() function with the given structure.
+func (x *Structure) GetString(FieldnameVar string) string {
+
+	cret := xStructureGetString(x.GoPointer(), FieldnameVar)
+	return cret
+}
+
+var xStructureGetUint func(uintptr, string, *uint) bool
+
+// Sets the uint pointed to by value corresponding to the value of the given field. Caller is responsible for making sure the field exists and has the correct type.
+func (x *Structure) GetUint(FieldnameVar string, ValueVar *uint) bool {
+
+	cret := xStructureGetUint(x.GoPointer(), FieldnameVar, ValueVar)
+	return cret
+}
+
+var xStructureGetValue func(uintptr, string) *gobject.Value
+
+// Get the value of the field with name fieldname.
+func (x *Structure) GetValue(FieldnameVar string) *gobject.Value {
+
+	cret := xStructureGetValue(x.GoPointer(), FieldnameVar)
+	return cret
+}
+
+var xStructureHasField func(uintptr, string) bool
+
+// Check if structure contains a field named fieldname.
+func (x *Structure) HasField(FieldnameVar string) bool {
+
+	cret := xStructureHasField(x.GoPointer(), FieldnameVar)
+	return cret
+}
+
+var xStructureHasName func(uintptr, string) bool
+
+// Checks if the structure has the given name
+func (x *Structure) HasName(NameVar string) bool {
+
+	cret := xStructureHasName(x.GoPointer(), NameVar)
+	return cret
+}
+
+var xStructureNFields func(uintptr) int
+
+// Get the number of fields in the structure.
+func (x *Structure) NFields() int {
+
+	cret := xStructureNFields(x.GoPointer())
+	return cret
+}
+
+var xStructureNthFieldName func(uintptr, uint) string
+
+// Get the name of the given field number, counting from 0 onwards.
+func (x *Structure) NthFieldName(IndexVar uint) string {
+
+	cret := xStructureNthFieldName(x.GoPointer(), IndexVar)
+	return cret
+}
+
+var xStructureSetValue func(uintptr, string, *gobject.Value)
+
+// Sets the field with the given name field to value. If the field does not exist, it is created. If the field exists, the previous value is replaced and freed.
+func (x *Structure) SetValue(FieldnameVar string, ValueVar *gobject.Value) {
+
+	xStructureSetValue(x.GoPointer(), FieldnameVar, ValueVar)
+
+}
+
+var xStructureToString func(uintptr) string
+
+// Converts structure to a human-readable string representation.
+//
+// For debugging purposes its easier to do something like this:
+//
+//	GST_LOG ("structure is %" GST_PTR_FORMAT, structure);
+//
+// This prints the structure in human readable form.
+//
+// Free-function: g_free
+func (x *Structure) ToString() string {
+
+	cret := xStructureToString(x.GoPointer())
+	return cret
+}
+
+// A datatype to hold a time, measured in nanoseconds.
+type ClockTime = uint64
+
+// A datatype to hold a time difference, measured in nanoseconds.
+type ClockTimeDiff = int64
+
+const (
+	// Constant to define an undefined clock time.
+	CLOCK_TIME_NONE ClockTime = 18446744073709551615
+	// Constant that defines one GStreamer millisecond.
+	MSECOND ClockTimeDiff = 1000000
+	// Constant that defines one GStreamer nanosecond.
+	NSECOND ClockTimeDiff = 1
+	// Constant that defines one GStreamer second.
+	SECOND ClockTimeDiff = 1000000000
+	// Constant that defines one GStreamer microsecond.
+	USECOND ClockTimeDiff = 1000
+)
+
+// The different message types that are available.
+type MessageType uint32
+
+var xMessageTypeGLibType func() types.GType
+
+func MessageTypeGLibType() types.GType {
+	return xMessageTypeGLibType()
+}
+
+const (
+
+	// an undefined message
+	MessageUnknownValue MessageType = 0
+	// end-of-stream reached in a pipeline. The application will only receive this message in the PLAYING state and every time it sets a pipeline to PLAYING that is in the EOS state.
+	MessageEosValue MessageType = 1
+	// an error occurred. When the application receives an error message it should stop playback of the pipeline and not assume that more data will be played.
+	MessageErrorValue MessageType = 2
+	// a warning occurred.
+	MessageWarningValue MessageType = 4
+	// an info message occurred
+	MessageInfoValue MessageType = 8
+	// a tag was found.
+	MessageTagValue MessageType = 16
+	// the pipeline is buffering. When the application receives a buffering message in the PLAYING state for a non-live pipeline it must PAUSE the pipeline until the buffering completes, when the percentage field in the message is 100%.
+	MessageBufferingValue MessageType = 32
+	// a state change happened
+	MessageStateChangedValue MessageType = 64
+	// an element changed state in a streaming thread. This message is deprecated.
+	MessageStateDirtyValue MessageType = 128
+	// a stepping operation finished.
+	MessageStepDoneValue MessageType = 256
+	// an element notifies its capability of providing a clock.
+	MessageClockProvideValue MessageType = 512
+	// The current clock as selected by the pipeline became unusable. The pipeline will select a new clock on the next PLAYING state change.
+	MessageClockLostValue MessageType = 1024
+	// a new clock was selected in the pipeline.
+	MessageNewClockValue MessageType = 2048
+	// the structure of the pipeline changed.
+	MessageStructureChangeValue MessageType = 4096
+	// status about a stream, emitted when it starts, stops, errors, etc..
+	MessageStreamStatusValue MessageType = 8192
+	// message posted by the application, possibly via an application-specific element.
+	MessageApplicationValue MessageType = 16384
+	// element-specific message, see the specific element's documentation
+	MessageElementValue MessageType = 32768
+	// pipeline started playback of a segment.
+	MessageSegmentStartValue MessageType = 65536
+	// pipeline completed playback of a segment.
+	MessageSegmentDoneValue MessageType = 131072
+	// The duration of a pipeline changed. The application can get the new duration with a duration query.
+	MessageDurationChangedValue MessageType = 262144
+	// Posted by elements when their latency changes. The application should recalculate and distribute a new latency.
+	MessageLatencyValue MessageType = 524288
+	// Posted by elements when they start an ASYNC GstStateChange. This message is not forwarded to the application but is used internally.
+	MessageAsyncStartValue MessageType = 1048576
+	// Posted by elements when they complete an ASYNC GstStateChange. The application will only receive this message from the toplevel pipeline.
+	MessageAsyncDoneValue MessageType = 2097152
+	// Posted by elements when they want the pipeline to change state. This message is a suggestion to the application which can decide to perform the state change on (part of) the pipeline.
+	MessageRequestStateValue MessageType = 4194304
+	// A stepping operation was started.
+	MessageStepStartValue MessageType = 8388608
+	// A buffer was dropped or an element changed its processing strategy for Quality of Service reasons.
+	MessageQosValue MessageType = 16777216
+	// A progress message.
+	MessageProgressValue MessageType = 33554432
+	// A new table of contents (TOC) was found or previously found TOC was updated.
+	MessageTocValue MessageType = 67108864
+	// Message to request resetting the pipeline's running time from the pipeline. This is an internal message which applications will likely never receive.
+	MessageResetTimeValue MessageType = 134217728
+	// Message indicating start of a new stream. Useful e.g. when using playbin in gapless playback mode, to get notified when the next title actually starts playing (which will be some time after the URI for the next title has been set).
+	MessageStreamStartValue MessageType = 268435456
+	// Message indicating that an element wants a specific context
+	MessageNeedContextValue MessageType = 536870912
+	// Message indicating that an element created a context
+	MessageHaveContextValue MessageType = 1073741824
+	// Message is an extended message type (see below). These extended message IDs can't be used directly with mask-based API like gst_bus_poll() or [Bus.TimedPopFiltered], but you can still filter for GST_MESSAGE_EXTENDED and then check the result for the specific type.
+	MessageExtendedValue MessageType = 2147483648
+	// Message indicating a GstDevice was added to a GstDeviceProvider
+	MessageDeviceAddedValue MessageType = 2147483649
+	// Message indicating a GstDevice was removed from a GstDeviceProvider
+	MessageDeviceRemovedValue MessageType = 2147483650
+	// Message indicating a [gobject.Object] property has changed
+	MessagePropertyNotifyValue MessageType = 2147483651
+	// Message indicating a new GstStreamCollection is available
+	MessageStreamCollectionValue MessageType = 2147483652
+	// Message indicating the active selection of GstStreams has changed
+	MessageStreamsSelectedValue MessageType = 2147483653
+	// Message indicating to request the application to try to play the given URL(s). Useful if for example a HTTP 302/303 response is received with a non-HTTP URL inside.
+	MessageRedirectValue MessageType = 2147483654
+	// Message indicating a GstDevice was changed a GstDeviceProvider
+	MessageDeviceChangedValue MessageType = 2147483655
+	// Message sent by elements to request the running time offset of an instant rate change.
+	MessageInstantRateRequestValue MessageType = 2147483656
+	// mask for all of the above messages.
+	MessageAnyValue MessageType = 4294967295
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (MessageType) GLibType() types.GType {
+	return xMessageTypeGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x MessageType) Has(flags MessageType) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x MessageType) Set(flags MessageType) MessageType {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x MessageType) Clear(flags MessageType) MessageType {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x MessageType) String() string {
+	if x == 0 {
+		return "GST_MESSAGE_UNKNOWN"
+	}
+	var names []string
+	rest := x
+	if rest&MessageEosValue != 0 && x&MessageEosValue == MessageEosValue {
+		names = append(names, "GST_MESSAGE_EOS")
+		rest &^= MessageEosValue
+	}
+	if rest&MessageErrorValue != 0 && x&MessageErrorValue == MessageErrorValue {
+		names = append(names, "GST_MESSAGE_ERROR")
+		rest &^= MessageErrorValue
+	}
+	if rest&MessageWarningValue != 0 && x&MessageWarningValue == MessageWarningValue {
+		names = append(names, "GST_MESSAGE_WARNING")
+		rest &^= MessageWarningValue
+	}
+	if rest&MessageInfoValue != 0 && x&MessageInfoValue == MessageInfoValue {
+		names = append(names, "GST_MESSAGE_INFO")
+		rest &^= MessageInfoValue
+	}
+	if rest&MessageTagValue != 0 && x&MessageTagValue == MessageTagValue {
+		names = append(names, "GST_MESSAGE_TAG")
+		rest &^= MessageTagValue
+	}
+	if rest&MessageBufferingValue != 0 && x&MessageBufferingValue == MessageBufferingValue {
+		names = append(names, "GST_MESSAGE_BUFFERING")
+		rest &^= MessageBufferingValue
+	}
+	if rest&MessageStateChangedValue != 0 && x&MessageStateChangedValue == MessageStateChangedValue {
+		names = append(names, "GST_MESSAGE_STATE_CHANGED")
+		rest &^= MessageStateChangedValue
+	}
+	if rest&MessageStateDirtyValue != 0 && x&MessageStateDirtyValue == MessageStateDirtyValue {
+		names = append(names, "GST_MESSAGE_STATE_DIRTY")
+		rest &^= MessageStateDirtyValue
+	}
+	if rest&MessageStepDoneValue != 0 && x&MessageStepDoneValue == MessageStepDoneValue {
+		names = append(names, "GST_MESSAGE_STEP_DONE")
+		rest &^= MessageStepDoneValue
+	}
+	if rest&MessageClockProvideValue != 0 && x&MessageClockProvideValue == MessageClockProvideValue {
+		names = append(names, "GST_MESSAGE_CLOCK_PROVIDE")
+		rest &^= MessageClockProvideValue
+	}
+	if rest&MessageClockLostValue != 0 && x&MessageClockLostValue == MessageClockLostValue {
+		names = append(names, "GST_MESSAGE_CLOCK_LOST")
+		rest &^= MessageClockLostValue
+	}
+	if rest&MessageNewClockValue != 0 && x&MessageNewClockValue == MessageNewClockValue {
+		names = append(names, "GST_MESSAGE_NEW_CLOCK")
+		rest &^= MessageNewClockValue
+	}
+	if rest&MessageStructureChangeValue != 0 && x&MessageStructureChangeValue == MessageStructureChangeValue {
+		names = append(names, "GST_MESSAGE_STRUCTURE_CHANGE")
+		rest &^= MessageStructureChangeValue
+	}
+	if rest&MessageStreamStatusValue != 0 && x&MessageStreamStatusValue == MessageStreamStatusValue {
+		names = append(names, "GST_MESSAGE_STREAM_STATUS")
+		rest &^= MessageStreamStatusValue
+	}
+	if rest&MessageApplicationValue != 0 && x&MessageApplicationValue == MessageApplicationValue {
+		names = append(names, "GST_MESSAGE_APPLICATION")
+		rest &^= MessageApplicationValue
+	}
+	if rest&MessageElementValue != 0 && x&MessageElementValue == MessageElementValue {
+		names = append(names, "GST_MESSAGE_ELEMENT")
+		rest &^= MessageElementValue
+	}
+	if rest&MessageSegmentStartValue != 0 && x&MessageSegmentStartValue == MessageSegmentStartValue {
+		names = append(names, "GST_MESSAGE_SEGMENT_START")
+		rest &^= MessageSegmentStartValue
+	}
+	if rest&MessageSegmentDoneValue != 0 && x&MessageSegmentDoneValue == MessageSegmentDoneValue {
+		names = append(names, "GST_MESSAGE_SEGMENT_DONE")
+		rest &^= MessageSegmentDoneValue
+	}
+	if rest&MessageDurationChangedValue != 0 && x&MessageDurationChangedValue == MessageDurationChangedValue {
+		names = append(names, "GST_MESSAGE_DURATION_CHANGED")
+		rest &^= MessageDurationChangedValue
+	}
+	if rest&MessageLatencyValue != 0 && x&MessageLatencyValue == MessageLatencyValue {
+		names = append(names, "GST_MESSAGE_LATENCY")
+		rest &^= MessageLatencyValue
+	}
+	if rest&MessageAsyncStartValue != 0 && x&MessageAsyncStartValue == MessageAsyncStartValue {
+		names = append(names, "GST_MESSAGE_ASYNC_START")
+		rest &^= MessageAsyncStartValue
+	}
+	if rest&MessageAsyncDoneValue != 0 && x&MessageAsyncDoneValue == MessageAsyncDoneValue {
+		names = append(names, "GST_MESSAGE_ASYNC_DONE")
+		rest &^= MessageAsyncDoneValue
+	}
+	if rest&MessageRequestStateValue != 0 && x&MessageRequestStateValue == MessageRequestStateValue {
+		names = append(names, "GST_MESSAGE_REQUEST_STATE")
+		rest &^= MessageRequestStateValue
+	}
+	if rest&MessageStepStartValue != 0 && x&MessageStepStartValue == MessageStepStartValue {
+		names = append(names, "GST_MESSAGE_STEP_START")
+		rest &^= MessageStepStartValue
+	}
+	if rest&MessageQosValue != 0 && x&MessageQosValue == MessageQosValue {
+		names = append(names, "GST_MESSAGE_QOS")
+		rest &^= MessageQosValue
+	}
+	if rest&MessageProgressValue != 0 && x&MessageProgressValue == MessageProgressValue {
+		names = append(names, "GST_MESSAGE_PROGRESS")
+		rest &^= MessageProgressValue
+	}
+	if rest&MessageTocValue != 0 && x&MessageTocValue == MessageTocValue {
+		names = append(names, "GST_MESSAGE_TOC")
+		rest &^= MessageTocValue
+	}
+	if rest&MessageResetTimeValue != 0 && x&MessageResetTimeValue == MessageResetTimeValue {
+		names = append(names, "GST_MESSAGE_RESET_TIME")
+		rest &^= MessageResetTimeValue
+	}
+	if rest&MessageStreamStartValue != 0 && x&MessageStreamStartValue == MessageStreamStartValue {
+		names = append(names, "GST_MESSAGE_STREAM_START")
+		rest &^= MessageStreamStartValue
+	}
+	if rest&MessageNeedContextValue != 0 && x&MessageNeedContextValue == MessageNeedContextValue {
+		names = append(names, "GST_MESSAGE_NEED_CONTEXT")
+		rest &^= MessageNeedContextValue
+	}
+	if rest&MessageHaveContextValue != 0 && x&MessageHaveContextValue == MessageHaveContextValue {
+		names = append(names, "GST_MESSAGE_HAVE_CONTEXT")
+		rest &^= MessageHaveContextValue
+	}
+	if rest&MessageExtendedValue != 0 && x&MessageExtendedValue == MessageExtendedValue {
+		names = append(names, "GST_MESSAGE_EXTENDED")
+		rest &^= MessageExtendedValue
+	}
+	if rest&MessageDeviceAddedValue != 0 && x&MessageDeviceAddedValue == MessageDeviceAddedValue {
+		names = append(names, "GST_MESSAGE_DEVICE_ADDED")
+		rest &^= MessageDeviceAddedValue
+	}
+	if rest&MessageDeviceRemovedValue != 0 && x&MessageDeviceRemovedValue == MessageDeviceRemovedValue {
+		names = append(names, "GST_MESSAGE_DEVICE_REMOVED")
+		rest &^= MessageDeviceRemovedValue
+	}
+	if rest&MessagePropertyNotifyValue != 0 && x&MessagePropertyNotifyValue == MessagePropertyNotifyValue {
+		names = append(names, "GST_MESSAGE_PROPERTY_NOTIFY")
+		rest &^= MessagePropertyNotifyValue
+	}
+	if rest&MessageStreamCollectionValue != 0 && x&MessageStreamCollectionValue == MessageStreamCollectionValue {
+		names = append(names, "GST_MESSAGE_STREAM_COLLECTION")
+		rest &^= MessageStreamCollectionValue
+	}
+	if rest&MessageStreamsSelectedValue != 0 && x&MessageStreamsSelectedValue == MessageStreamsSelectedValue {
+		names = append(names, "GST_MESSAGE_STREAMS_SELECTED")
+		rest &^= MessageStreamsSelectedValue
+	}
+	if rest&MessageRedirectValue != 0 && x&MessageRedirectValue == MessageRedirectValue {
+		names = append(names, "GST_MESSAGE_REDIRECT")
+		rest &^= MessageRedirectValue
+	}
+	if rest&MessageDeviceChangedValue != 0 && x&MessageDeviceChangedValue == MessageDeviceChangedValue {
+		names = append(names, "GST_MESSAGE_DEVICE_CHANGED")
+		rest &^= MessageDeviceChangedValue
+	}
+	if rest&MessageInstantRateRequestValue != 0 && x&MessageInstantRateRequestValue == MessageInstantRateRequestValue {
+		names = append(names, "GST_MESSAGE_INSTANT_RATE_REQUEST")
+		rest &^= MessageInstantRateRequestValue
+	}
+	if rest&MessageAnyValue != 0 && x&MessageAnyValue == MessageAnyValue {
+		names = append(names, "GST_MESSAGE_ANY")
+		rest &^= MessageAnyValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags to be used with gst_element_seek() or gst_event_new_seek().
+type SeekFlags uint32
+
+var xSeekFlagsGLibType func() types.GType
+
+func SeekFlagsGLibType() types.GType {
+	return xSeekFlagsGLibType()
+}
+
+const (
+
+	// no flag
+	SeekFlagNoneValue SeekFlags = 0
+	// flush pipeline
+	SeekFlagFlushValue SeekFlags = 1
+	// accurate position is requested, this might be considerably slower for some formats.
+	SeekFlagAccurateValue SeekFlags = 2
+	// seek to the nearest keyframe. This might be faster but less accurate.
+	SeekFlagKeyUnitValue SeekFlags = 4
+	// perform a segment seek.
+	SeekFlagSegmentValue SeekFlags = 8
+	// when doing fast forward or fast reverse playback, allow elements to skip frames instead of generating all frames.
+	SeekFlagTrickmodeValue SeekFlags = 16
+	// Deprecated backward compatibility flag, replaced by [SeekFlagTrickmodeValue]
+	SeekFlagSkipValue SeekFlags = 16
+	// go to a location before the requested position, if [SeekFlagKeyUnitValue] this means the keyframe at or before the requested position the one at or before the seek target.
+	SeekFlagSnapBeforeValue SeekFlags = 32
+	// go to a location after the requested position, if [SeekFlagKeyUnitValue] this means the keyframe at of after the requested position.
+	SeekFlagSnapAfterValue SeekFlags = 64
+	// go to a position near the requested position, if [SeekFlagKeyUnitValue] this means the keyframe closest to the requested position, if both keyframes are at an equal distance, behaves like [SeekFlagSnapBeforeValue].
+	SeekFlagSnapNearestValue SeekFlags = 96
+	// when doing fast forward or fast reverse playback, request that elements only decode keyframes and skip all other content, for formats that have keyframes.
+	SeekFlagTrickmodeKeyUnitsValue SeekFlags = 128
+	// when doing fast forward or fast reverse playback, request that audio decoder elements skip decoding and output only gap events or silence.
+	SeekFlagTrickmodeNoAudioValue SeekFlags = 256
+	// When doing fast forward or fast reverse playback, request that elements only decode keyframes and forward predicted frames and skip all other content (for example B-Frames), for formats that have keyframes and forward predicted frames.
+	SeekFlagTrickmodeForwardPredictedValue SeekFlags = 512
+	// Signals that a rate change should be applied immediately. Only valid if start/stop position are GST_CLOCK_TIME_NONE, the playback direction does not change and the seek is not flushing.
+	SeekFlagInstantRateChangeValue SeekFlags = 1024
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SeekFlags) GLibType() types.GType {
+	return xSeekFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SeekFlags) Has(flags SeekFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SeekFlags) Set(flags SeekFlags) SeekFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SeekFlags) Clear(flags SeekFlags) SeekFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SeekFlags) String() string {
+	if x == 0 {
+		return "GST_SEEK_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&SeekFlagFlushValue != 0 && x&SeekFlagFlushValue == SeekFlagFlushValue {
+		names = append(names, "GST_SEEK_FLAG_FLUSH")
+		rest &^= SeekFlagFlushValue
+	}
+	if rest&SeekFlagAccurateValue != 0 && x&SeekFlagAccurateValue == SeekFlagAccurateValue {
+		names = append(names, "GST_SEEK_FLAG_ACCURATE")
+		rest &^= SeekFlagAccurateValue
+	}
+	if rest&SeekFlagKeyUnitValue != 0 && x&SeekFlagKeyUnitValue == SeekFlagKeyUnitValue {
+		names = append(names, "GST_SEEK_FLAG_KEY_UNIT")
+		rest &^= SeekFlagKeyUnitValue
+	}
+	if rest&SeekFlagSegmentValue != 0 && x&SeekFlagSegmentValue == SeekFlagSegmentValue {
+		names = append(names, "GST_SEEK_FLAG_SEGMENT")
+		rest &^= SeekFlagSegmentValue
+	}
+	if rest&SeekFlagTrickmodeValue != 0 && x&SeekFlagTrickmodeValue == SeekFlagTrickmodeValue {
+		names = append(names, "GST_SEEK_FLAG_TRICKMODE")
+		rest &^= SeekFlagTrickmodeValue
+	}
+	if rest&SeekFlagSkipValue != 0 && x&SeekFlagSkipValue == SeekFlagSkipValue {
+		names = append(names, "GST_SEEK_FLAG_SKIP")
+		rest &^= SeekFlagSkipValue
+	}
+	if rest&SeekFlagSnapBeforeValue != 0 && x&SeekFlagSnapBeforeValue == SeekFlagSnapBeforeValue {
+		names = append(names, "GST_SEEK_FLAG_SNAP_BEFORE")
+		rest &^= SeekFlagSnapBeforeValue
+	}
+	if rest&SeekFlagSnapAfterValue != 0 && x&SeekFlagSnapAfterValue == SeekFlagSnapAfterValue {
+		names = append(names, "GST_SEEK_FLAG_SNAP_AFTER")
+		rest &^= SeekFlagSnapAfterValue
+	}
+	if rest&SeekFlagSnapNearestValue != 0 && x&SeekFlagSnapNearestValue == SeekFlagSnapNearestValue {
+		names = append(names, "GST_SEEK_FLAG_SNAP_NEAREST")
+		rest &^= SeekFlagSnapNearestValue
+	}
+	if rest&SeekFlagTrickmodeKeyUnitsValue != 0 && x&SeekFlagTrickmodeKeyUnitsValue == SeekFlagTrickmodeKeyUnitsValue {
+		names = append(names, "GST_SEEK_FLAG_TRICKMODE_KEY_UNITS")
+		rest &^= SeekFlagTrickmodeKeyUnitsValue
+	}
+	if rest&SeekFlagTrickmodeNoAudioValue != 0 && x&SeekFlagTrickmodeNoAudioValue == SeekFlagTrickmodeNoAudioValue {
+		names = append(names, "GST_SEEK_FLAG_TRICKMODE_NO_AUDIO")
+		rest &^= SeekFlagTrickmodeNoAudioValue
+	}
+	if rest&SeekFlagTrickmodeForwardPredictedValue != 0 && x&SeekFlagTrickmodeForwardPredictedValue == SeekFlagTrickmodeForwardPredictedValue {
+		names = append(names, "GST_SEEK_FLAG_TRICKMODE_FORWARD_PREDICTED")
+		rest &^= SeekFlagTrickmodeForwardPredictedValue
+	}
+	if rest&SeekFlagInstantRateChangeValue != 0 && x&SeekFlagInstantRateChangeValue == SeekFlagInstantRateChangeValue {
+		names = append(names, "GST_SEEK_FLAG_INSTANT_RATE_CHANGE")
+		rest &^= SeekFlagInstantRateChangeValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Standard predefined formats
+type Format int
+
+var xFormatGLibType func() types.GType
+
+func FormatGLibType() types.GType {
+	return xFormatGLibType()
+}
+
+const (
+
+	// undefined format
+	FormatUndefinedValue Format = 0
+	// the default format of the pad/element, e.g. samples for raw audio, frames for raw video.
+	FormatDefaultValue Format = 1
+	// bytes
+	FormatBytesValue Format = 2
+	// time in nanoseconds
+	FormatTimeValue Format = 3
+	// buffers (few, if any, elements implement this as of May 2009)
+	FormatBuffersValue Format = 4
+	// percentage of stream (few, if any, elements implement this as of May 2009)
+	FormatPercentValue Format = 5
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x Format) String() string {
+	switch x {
+	case FormatUndefinedValue:
+		return "GST_FORMAT_UNDEFINED"
+	case FormatDefaultValue:
+		return "GST_FORMAT_DEFAULT"
+	case FormatBytesValue:
+		return "GST_FORMAT_BYTES"
+	case FormatTimeValue:
+		return "GST_FORMAT_TIME"
+	case FormatBuffersValue:
+		return "GST_FORMAT_BUFFERS"
+	case FormatPercentValue:
+		return "GST_FORMAT_PERCENT"
+	}
+	return fmt.Sprintf("Format(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Format) Nick() string {
+	switch x {
+	case FormatUndefinedValue:
+		return "undefined"
+	case FormatDefaultValue:
+		return "default"
+	case FormatBytesValue:
+		return "bytes"
+	case FormatTimeValue:
+		return "time"
+	case FormatBuffersValue:
+		return "buffers"
+	case FormatPercentValue:
+		return "percent"
+	}
+	return ""
+}
+
+// FormatFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FormatFromString(s string) (Format, bool) {
+	switch s {
+	case "GST_FORMAT_UNDEFINED", "undefined":
+		return FormatUndefinedValue, true
+	case "GST_FORMAT_DEFAULT", "default":
+		return FormatDefaultValue, true
+	case "GST_FORMAT_BYTES", "bytes":
+		return FormatBytesValue, true
+	case "GST_FORMAT_TIME", "time":
+		return FormatTimeValue, true
+	case "GST_FORMAT_BUFFERS", "buffers":
+		return FormatBuffersValue, true
+	case "GST_FORMAT_PERCENT", "percent":
+		return FormatPercentValue, true
+	}
+	return 0, false
+}
+
+// The direction of a pad.
+type PadDirection int
+
+var xPadDirectionGLibType func() types.GType
+
+func PadDirectionGLibType() types.GType {
+	return xPadDirectionGLibType()
+}
+
+const (
+
+	// direction is unknown.
+	PadUnknownValue PadDirection = 0
+	// the pad is a source pad.
+	PadSrcValue PadDirection = 1
+	// the pad is a sink pad.
+	PadSinkValue PadDirection = 2
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x PadDirection) String() string {
+	switch x {
+	case PadUnknownValue:
+		return "GST_PAD_UNKNOWN"
+	case PadSrcValue:
+		return "GST_PAD_SRC"
+	case PadSinkValue:
+		return "GST_PAD_SINK"
+	}
+	return fmt.Sprintf("PadDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PadDirection) Nick() string {
+	switch x {
+	case PadUnknownValue:
+		return "unknown"
+	case PadSrcValue:
+		return "src"
+	case PadSinkValue:
+		return "sink"
+	}
+	return ""
+}
+
+// PadDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PadDirectionFromString(s string) (PadDirection, bool) {
+	switch s {
+	case "GST_PAD_UNKNOWN", "unknown":
+		return PadUnknownValue, true
+	case "GST_PAD_SRC", "src":
+		return PadSrcValue, true
+	case "GST_PAD_SINK", "sink":
+		return PadSinkValue, true
+	}
+	return 0, false
+}
+
+// Result values from gst_pad_link and friends.
+type PadLinkReturn int
+
+var xPadLinkReturnGLibType func() types.GType
+
+func PadLinkReturnGLibType() types.GType {
+	return xPadLinkReturnGLibType()
+}
+
+const (
+
+	// link succeeded
+	PadLinkOkValue PadLinkReturn = 0
+	// pads have no common grandparent
+	PadLinkWrongHierarchyValue PadLinkReturn = -1
+	// pad was already linked
+	PadLinkWasLinkedValue PadLinkReturn = -2
+	// pads have wrong direction
+	PadLinkWrongDirectionValue PadLinkReturn = -3
+	// pads do not have common format
+	PadLinkNoformatValue PadLinkReturn = -4
+	// pads cannot cooperate in scheduling
+	PadLinkNoschedValue PadLinkReturn = -5
+	// refused for some reason
+	PadLinkRefusedValue PadLinkReturn = -6
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x PadLinkReturn) String() string {
+	switch x {
+	case PadLinkOkValue:
+		return "GST_PAD_LINK_OK"
+	case PadLinkWrongHierarchyValue:
+		return "GST_PAD_LINK_WRONG_HIERARCHY"
+	case PadLinkWasLinkedValue:
+		return "GST_PAD_LINK_WAS_LINKED"
+	case PadLinkWrongDirectionValue:
+		return "GST_PAD_LINK_WRONG_DIRECTION"
+	case PadLinkNoformatValue:
+		return "GST_PAD_LINK_NOFORMAT"
+	case PadLinkNoschedValue:
+		return "GST_PAD_LINK_NOSCHED"
+	case PadLinkRefusedValue:
+		return "GST_PAD_LINK_REFUSED"
+	}
+	return fmt.Sprintf("PadLinkReturn(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PadLinkReturn) Nick() string {
+	switch x {
+	case PadLinkOkValue:
+		return "ok"
+	case PadLinkWrongHierarchyValue:
+		return "wrong-hierarchy"
+	case PadLinkWasLinkedValue:
+		return "was-linked"
+	case PadLinkWrongDirectionValue:
+		return "wrong-direction"
+	case PadLinkNoformatValue:
+		return "noformat"
+	case PadLinkNoschedValue:
+		return "nosched"
+	case PadLinkRefusedValue:
+		return "refused"
+	}
+	return ""
+}
+
+// PadLinkReturnFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PadLinkReturnFromString(s string) (PadLinkReturn, bool) {
+	switch s {
+	case "GST_PAD_LINK_OK", "ok":
+		return PadLinkOkValue, true
+	case "GST_PAD_LINK_WRONG_HIERARCHY", "wrong-hierarchy":
+		return PadLinkWrongHierarchyValue, true
+	case "GST_PAD_LINK_WAS_LINKED", "was-linked":
+		return PadLinkWasLinkedValue, true
+	case "GST_PAD_LINK_WRONG_DIRECTION", "wrong-direction":
+		return PadLinkWrongDirectionValue, true
+	case "GST_PAD_LINK_NOFORMAT", "noformat":
+		return PadLinkNoformatValue, true
+	case "GST_PAD_LINK_NOSCHED", "nosched":
+		return PadLinkNoschedValue, true
+	case "GST_PAD_LINK_REFUSED", "refused":
+		return PadLinkRefusedValue, true
+	}
+	return 0, false
+}
+
+// The possible states an element can be in.
+type State int
+
+var xStateGLibType func() types.GType
+
+func StateGLibType() types.GType {
+	return xStateGLibType()
+}
+
+const (
+
+	// no pending state.
+	StateVoidPendingValue State = 0
+	// the NULL state or initial state of an element.
+	StateNullValue State = 1
+	// the element is ready to go to PAUSED.
+	StateReadyValue State = 2
+	// the element is PAUSED, it is ready to accept and process data.
+	StatePausedValue State = 3
+	// the element is PLAYING, the GstClock is running and the data is flowing.
+	StatePlayingValue State = 4
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x State) String() string {
+	switch x {
+	case StateVoidPendingValue:
+		return "GST_STATE_VOID_PENDING"
+	case StateNullValue:
+		return "GST_STATE_NULL"
+	case StateReadyValue:
+		return "GST_STATE_READY"
+	case StatePausedValue:
+		return "GST_STATE_PAUSED"
+	case StatePlayingValue:
+		return "GST_STATE_PLAYING"
+	}
+	return fmt.Sprintf("State(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x State) Nick() string {
+	switch x {
+	case StateVoidPendingValue:
+		return "void-pending"
+	case StateNullValue:
+		return "null"
+	case StateReadyValue:
+		return "ready"
+	case StatePausedValue:
+		return "paused"
+	case StatePlayingValue:
+		return "playing"
+	}
+	return ""
+}
+
+// StateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StateFromString(s string) (State, bool) {
+	switch s {
+	case "GST_STATE_VOID_PENDING", "void-pending":
+		return StateVoidPendingValue, true
+	case "GST_STATE_NULL", "null":
+		return StateNullValue, true
+	case "GST_STATE_READY", "ready":
+		return StateReadyValue, true
+	case "GST_STATE_PAUSED", "paused":
+		return StatePausedValue, true
+	case "GST_STATE_PLAYING", "playing":
+		return StatePlayingValue, true
+	}
+	return 0, false
+}
+
+// The possible return values from a state change function such as [Element.SetState].
+type StateChangeReturn int
+
+var xStateChangeReturnGLibType func() types.GType
+
+func StateChangeReturnGLibType() types.GType {
+	return xStateChangeReturnGLibType()
+}
+
+const (
+
+	// the state change failed
+	StateChangeFailureValue StateChangeReturn = 0
+	// the state change succeeded
+	StateChangeSuccessValue StateChangeReturn = 1
+	// the state change will happen asynchronously
+	StateChangeAsyncValue StateChangeReturn = 2
+	// the state change succeeded but the element cannot produce data in [StatePausedValue].
+	StateChangeNoPrerollValue StateChangeReturn = 3
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x StateChangeReturn) String() string {
+	switch x {
+	case StateChangeFailureValue:
+		return "GST_STATE_CHANGE_FAILURE"
+	case StateChangeSuccessValue:
+		return "GST_STATE_CHANGE_SUCCESS"
+	case StateChangeAsyncValue:
+		return "GST_STATE_CHANGE_ASYNC"
+	case StateChangeNoPrerollValue:
+		return "GST_STATE_CHANGE_NO_PREROLL"
+	}
+	return fmt.Sprintf("StateChangeReturn(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x StateChangeReturn) Nick() string {
+	switch x {
+	case StateChangeFailureValue:
+		return "failure"
+	case StateChangeSuccessValue:
+		return "success"
+	case StateChangeAsyncValue:
+		return "async"
+	case StateChangeNoPrerollValue:
+		return "no-preroll"
+	}
+	return ""
+}
+
+// StateChangeReturnFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StateChangeReturnFromString(s string) (StateChangeReturn, bool) {
+	switch s {
+	case "GST_STATE_CHANGE_FAILURE", "failure":
+		return StateChangeFailureValue, true
+	case "GST_STATE_CHANGE_SUCCESS", "success":
+		return StateChangeSuccessValue, true
+	case "GST_STATE_CHANGE_ASYNC", "async":
+		return StateChangeAsyncValue, true
+	case "GST_STATE_CHANGE_NO_PREROLL", "no-preroll":
+		return StateChangeNoPrerollValue, true
+	}
+	return 0, false
+}
+
+var xCapsFromString func(string) *Caps
+
+// Converts caps from a string representation.
+//
+// The implementation of serialization up to 1.20 would lead to unexpected results when there were nested [Caps] / [Structure] deeper than one level.
+func CapsFromString(StringVar string) *Caps {
+
+	cret := xCapsFromString(StringVar)
+	return cret
+}
+
+var xDeinit func()
+
+// Clean up any resources created by GStreamer in [Init].
+//
+// It is normally not needed to call this function in a normal application as the resources will automatically be freed when the program terminates. This function is therefore mostly used by testsuites and other memory profiling tools.
+//
+// After this call GStreamer (including this method) should not be used anymore.
+func Deinit() {
+
+	xDeinit()
+
+}
+
+var xInit func(uintptr, uintptr)
+
+// Initializes the GStreamer library, setting up internal path lists, registering built-in elements, and loading standard plugins.
+//
+// Unless the plugin registry is disabled at compile time, the registry will be loaded. By default this will also check if the registry cache needs to be updated and rescan all plugins if needed. See gst_update_registry() for details and section <link linkend="gst-running">Running GStreamer Applications</link> for how to disable automatic registry updates.
+//
+// > This function will terminate your program if it was unable to initialize
+// > GStreamer for some reason. If you want your program to fall back,
+// > use [InitCheck] instead.
+func Init(ArgcVar uintptr, ArgvVar uintptr) {
+
+	xInit(ArgcVar, ArgvVar)
+
+}
+
+var xInitCheck func(uintptr, uintptr, **glib.Error) bool
+
+// Initializes the GStreamer library, setting up internal path lists, registering built-in elements, and loading standard plugins.
+//
+// This function will return false if GStreamer could not be initialized for some reason. If you want your program to fail fatally, use [Init] instead.
+func InitCheck(ArgcVar uintptr, ArgvVar uintptr) (bool, error) {
+	var cerr *glib.Error
+
+	cret := xInitCheck(ArgcVar, ArgvVar, &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xIsInitialized func() bool
+
+// Use this function to check if GStreamer has been initialized with [Init] or [InitCheck].
+func IsInitialized() bool {
+
+	cret := xIsInitialized()
+	return cret
+}
+
+var xMessageTypeGetName func(MessageType) string
+
+// Get a printable name for the given message type. Do not modify or free.
+func MessageTypeGetName(TypeVar MessageType) string {
+
+	cret := xMessageTypeGetName(TypeVar)
+	return cret
+}
+
+var xStructureFromString func(string, *string) *Structure
+
+// Creates a [Structure] from a string representation. If end is not nil, a pointer to the place inside the given string where parsing ended will be returned.
+//
+// Free-function: gst_structure_free
+func StructureFromString(StringVar string, EndVar *string) *Structure {
+
+	cret := xStructureFromString(StringVar, EndVar)
+	return cret
+}
+
+var xVersion func(*uint, *uint, *uint, *uint)
+
+// Gets the version number of the GStreamer library.
+func Version(MajorVar *uint, MinorVar *uint, MicroVar *uint, NanoVar *uint) {
+
+	xVersion(MajorVar, MinorVar, MicroVar, NanoVar)
+
+}
+
+var xVersionString func() string
+
+// This function returns a string that is useful for describing this version of GStreamer to the outside world: user agent strings, logging, ...
+func VersionString() string {
+
+	cret := xVersionString()
+	return cret
+}
+
+// [Bin] is an element that can contain other [Element], allowing them to be managed as a group. Pads from the child elements can be ghosted to the bin, see GstGhostPad. This makes the bin look like any other elements and enables creation of higher-level abstraction elements.
+//
+// A new [Bin] is created with [NewBin]. Use a [Pipeline] instead if you want to create a toplevel bin because a normal bin doesn't have a bus or handle clock distribution of its own.
+//
+// After the bin has been created you will typically add elements to it with [Bin.Add]. You can remove elements with [Bin.Remove].
+//
+// An element can be retrieved from a bin with [Bin.GetByName], using the elements name. gst_bin_get_by_name_recurse_up() is mainly used for internal purposes and will query the parent bins when the element is not found in the current bin.
+type Bin struct {
+	Element
+}
+
+var xBinGLibType func() types.GType
+
+func BinGLibType() types.GType {
+	return xBinGLibType()
+}
+
+func BinNewFromInternalPtr(ptr uintptr) *Bin {
+	cls := &Bin{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewBin func(uintptr) uintptr
+
+// Creates a new bin with the given name.
+func NewBin(NameVar *string) *Bin {
+	var cls *Bin
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	cret := xNewBin(NameVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Bin{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xBinAdd func(uintptr, uintptr) bool
+
+// Adds the given element to the bin. Sets the element's parent, and thus takes ownership of the element. An element can only be added to one bin.
+//
+// If the element's pads are linked to other pads, the pads will be unlinked before the element is added to the bin.
+//
+// > When you add an element to an already-running pipeline, you will have to
+// > take care to set the state of the newly-added element to the desired
+// > state (usually PLAYING or PAUSED, same you set the pipeline to originally)
+// > with [Element.SetState], or use [Element.SyncStateWithParent].
+// > The bin or pipeline will not take care of this for you.
+func (x *Bin) Add(ElementVar *Element) bool {
+
+	cret := xBinAdd(x.GoPointer(), ElementVar.GoPointer())
+	return cret
+}
+
+var xBinGetByName func(uintptr, string) uintptr
+
+// Gets the element with the given name from a bin. This function recurses into child bins.
+func (x *Bin) GetByName(NameVar string) *Element {
+	var cls *Element
+
+	cret := xBinGetByName(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Element{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xBinRemove func(uintptr, uintptr) bool
+
+// Removes the element from the bin, unparenting it as well. Unparenting the element means that the element will be dereferenced, so if the bin holds the only reference to the element, the element will be freed in the process of removing it from the bin. If you want the element to still exist after removing, you need to call gst_object_ref() before removing it from the bin.
+//
+// If the element's pads are linked to other pads, the pads will be unlinked before the element is removed from the bin.
+func (x *Bin) Remove(ElementVar *Element) bool {
+
+	cret := xBinRemove(x.GoPointer(), ElementVar.GoPointer())
+	return cret
+}
+
+func (c *Bin) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Bin) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// Will be emitted after the element was added to sub_bin.
+//
+// Deprecated: use ConnectDeepElementAddedFunc, which also accepts method values and closures.
+func (x *Bin) ConnectDeepElementAdded(cb *func(Bin, uintptr, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SubBinVarp uintptr, ElementVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bin{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SubBinVarp, ElementVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "deep-element-added", cbRefPtr, cbPtr)
+}
+
+var xBinDeepElementAddedTrampoline uintptr
+
+func xBinDeepElementAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SubBinVarp uintptr, ElementVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Bin, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Bin{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, SubBinVarp, ElementVarp)
+
+	}
+}
+
+// ConnectDeepElementAddedFunc connects cb to the "deep-element-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Bin) ConnectDeepElementAddedFunc(cb func(Bin, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "deep-element-added", &xBinDeepElementAddedTrampoline, xBinDeepElementAddedNewTrampoline, cb)
+}
+
+// Will be emitted after the element was added to the bin.
+//
+// Deprecated: use ConnectElementAddedFunc, which also accepts method values and closures.
+func (x *Bin) ConnectElementAdded(cb *func(Bin, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ElementVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bin{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ElementVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "element-added", cbRefPtr, cbPtr)
+}
+
+var xBinElementAddedTrampoline uintptr
+
+func xBinElementAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ElementVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Bin, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Bin{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, ElementVarp)
+
+	}
+}
+
+// ConnectElementAddedFunc connects cb to the "element-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Bin) ConnectElementAddedFunc(cb func(Bin, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "element-added", &xBinElementAddedTrampoline, xBinElementAddedNewTrampoline, cb)
+}
+
+// Will be emitted after the element was removed from the bin.
+//
+// Deprecated: use ConnectElementRemovedFunc, which also accepts method values and closures.
+func (x *Bin) ConnectElementRemoved(cb *func(Bin, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ElementVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bin{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ElementVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "element-removed", cbRefPtr, cbPtr)
+}
+
+var xBinElementRemovedTrampoline uintptr
+
+func xBinElementRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ElementVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Bin, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Bin{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, ElementVarp)
+
+	}
+}
+
+// ConnectElementRemovedFunc connects cb to the "element-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Bin) ConnectElementRemovedFunc(cb func(Bin, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "element-removed", &xBinElementRemovedTrampoline, xBinElementRemovedNewTrampoline, cb)
+}
+
+// The [Bus] is an object responsible for delivering [Message] packets in a first-in first-out way from the streaming threads (see GstTask) to the application.
+//
+// Since the application typically only wants to deal with delivery of these messages from one thread, the GstBus will marshall the messages between different threads. This is important since the actual streaming of media is done in another thread than the application.
+//
+// The GstBus provides support for [glib.Source] based notifications. This makes it possible to handle the delivery in the glib [glib.MainLoop].
+//
+// The [glib.Source] callback function gst_bus_async_signal_func() can be used to convert all bus messages into signal emissions.
+//
+// A message is posted on the bus with the [Bus.Post] method. With the gst_bus_peek() and [Bus.Pop] methods one can look at or retrieve a previously posted message.
+//
+// The bus can be polled with the gst_bus_poll() method. This methods blocks up to the specified timeout value until one of the specified messages types is posted on the bus. The application can then [Bus.Pop] the messages from the bus to handle them. Alternatively the application can register an asynchronous bus function using [Bus.AddWatchFull] or gst_bus_add_watch(). This function will install a [glib.Source] in the default glib main loop and will deliver messages a short while after they have been posted. Note that the main loop should be running for the asynchronous callbacks.
+//
+// It is also possible to get messages from the bus without any thread marshalling with the gst_bus_set_sync_handler() method. This makes it possible to react to a message in the same thread that posted the message on the bus. This should only be used if the application is able to deal with messages from different threads.
+//
+// Every [Pipeline] has one bus.
+//
+// Note that a [Pipeline] will set its bus into flushing state when changing from READY to NULL state.
+type Bus struct {
+	Object
+}
+
+var xBusGLibType func() types.GType
+
+func BusGLibType() types.GType {
+	return xBusGLibType()
+}
+
+func BusNewFromInternalPtr(ptr uintptr) *Bus {
+	cls := &Bus{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewBus func() uintptr
+
+// Creates a new [Bus] instance.
+func NewBus() *Bus {
+	var cls *Bus
+
+	cret := xNewBus()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Bus{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xBusAddSignalWatch func(uintptr)
+
+// Adds a bus signal watch to the default main context with the default priority ( [glib.PRIORITY_DEFAULT] ). It is also possible to use a non-default main context set up using [glib.MainContext.PushThreadDefault] (before one had to create a bus watch source and attach it to the desired main context 'manually').
+//
+// After calling this statement, the bus will emit the "message" signal for each message posted on the bus.
+//
+// This function may be called multiple times. To clean up, the caller is responsible for calling [Bus.RemoveSignalWatch] as many times as this function is called.
+//
+// MT safe.
+func (x *Bus) AddSignalWatch() {
+
+	xBusAddSignalWatch(x.GoPointer())
+
+}
+
+var xBusAddWatchFull func(uintptr, int, uintptr, uintptr, uintptr) uint
+
+// Adds a bus watch to the default main context with the given priority (e.g. [glib.PRIORITY_DEFAULT]). It is also possible to use a non-default main context set up using [glib.MainContext.PushThreadDefault] (before one had to create a bus watch source and attach it to the desired main context 'manually').
+//
+// This function is used to receive asynchronous messages in the main loop. There can only be a single bus watch per bus, you must remove it before you can set a new one.
+//
+// The bus watch will only work if a [glib.MainLoop] is being run.
+//
+// When func is called, the message belongs to the caller; if you want to keep a copy of it, call [Message.Ref] before leaving func.
+//
+// The watch can be removed using [Bus.RemoveWatch] or by returning false from func. If the watch was added to the default main context it is also possible to remove the watch using [glib.SourceRemove].
+//
+// The bus watch will take its own reference to the bus, so it is safe to unref bus using gst_object_unref() after setting the bus watch.
+func (x *Bus) AddWatchFull(PriorityVar int, FuncVar *BusFunc, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) uint {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *Message, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var NotifyVarRef uintptr
+	if NotifyVar != nil {
+		NotifyVarPtr := uintptr(unsafe.Pointer(NotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(NotifyVarPtr); ok {
+			NotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *NotifyVar
+				cbFn(arg0)
+			}
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+		}
+	}
+
+	cret := xBusAddWatchFull(x.GoPointer(), PriorityVar, FuncVarRef, UserDataVar, NotifyVarRef)
+	return cret
+}
+
+var xBusHavePending func(uintptr) bool
+
+// Checks if there are pending messages on the bus that should be handled.
+func (x *Bus) HavePending() bool {
+
+	cret := xBusHavePending(x.GoPointer())
+	return cret
+}
+
+var xBusPop func(uintptr) *Message
+
+// Gets a message from the bus.
+func (x *Bus) Pop() *Message {
+
+	cret := xBusPop(x.GoPointer())
+	return cret
+}
+
+var xBusPopFiltered func(uintptr, MessageType) *Message
+
+// Gets a message matching type from the bus.  Will discard all messages on the bus that do not match type and that have been posted before the first message that does match type.  If there is no message matching type on the bus, all messages will be discarded. It is not possible to use message enums beyond GST_MESSAGE_EXTENDED in the events mask.
+func (x *Bus) PopFiltered(TypesVar MessageType) *Message {
+
+	cret := xBusPopFiltered(x.GoPointer(), TypesVar)
+	return cret
+}
+
+var xBusPost func(uintptr, uintptr) bool
+
+// Posts a message on the given bus. Ownership of the message is taken by the bus.
+func (x *Bus) Post(MessageVar *Message) bool {
+
+	cret := xBusPost(x.GoPointer(), gobject.BoxedCopy(MessageGLibType(), MessageVar.GoPointer()))
+	return cret
+}
+
+var xBusRemoveSignalWatch func(uintptr)
+
+// Removes a signal watch previously added with [Bus.AddSignalWatch].
+//
+// MT safe.
+func (x *Bus) RemoveSignalWatch() {
+
+	xBusRemoveSignalWatch(x.GoPointer())
+
+}
+
+var xBusRemoveWatch func(uintptr) bool
+
+// Removes an installed bus watch from bus.
+func (x *Bus) RemoveWatch() bool {
+
+	cret := xBusRemoveWatch(x.GoPointer())
+	return cret
+}
+
+var xBusSetFlushing func(uintptr, bool)
+
+// If flushing, flushes out and unrefs any messages queued in the bus. Releases references to the message origin objects. Will flush future messages until [Bus.SetFlushing] sets flushing to false.
+//
+// MT safe.
+func (x *Bus) SetFlushing(FlushingVar bool) {
+
+	xBusSetFlushing(x.GoPointer(), FlushingVar)
+
+}
+
+var xBusTimedPop func(uintptr, ClockTime) *Message
+
+// Gets a message from the bus, waiting up to the specified timeout.
+//
+// If timeout is 0, this function behaves like [Bus.Pop]. If timeout is GST_CLOCK_TIME_NONE, this function will block forever until a message was posted on the bus.
+//
+// MT safe.
+func (x *Bus) TimedPop(TimeoutVar ClockTime) *Message {
+
+	cret := xBusTimedPop(x.GoPointer(), TimeoutVar)
+	return cret
+}
+
+var xBusTimedPopFiltered func(uintptr, ClockTime, MessageType) *Message
+
+// Gets a message from the bus whose type matches the message type mask types, waiting up to the specified timeout (and discarding any messages that do not match the mask provided).
+//
+// If timeout is 0, this function behaves like [Bus.PopFiltered]. If timeout is GST_CLOCK_TIME_NONE, this function will block forever until a matching message was posted on the bus.
+func (x *Bus) TimedPopFiltered(TimeoutVar ClockTime, TypesVar MessageType) *Message {
+
+	cret := xBusTimedPopFiltered(x.GoPointer(), TimeoutVar, TypesVar)
+	return cret
+}
+
+func (c *Bus) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Bus) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// A message has been posted on the bus. This signal is emitted from a [glib.Source] added to the mainloop. this signal will only be emitted when there is a [glib.MainLoop] running.
+//
+// Deprecated: use ConnectMessageFunc, which also accepts method values and closures.
+func (x *Bus) ConnectMessage(cb *func(Bus, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "message", cbRefPtr, cbPtr)
+}
+
+// ConnectMessageWithDetail connects to the "message" signal with a detail string.
+// The detail is appended as "message::<detail>".
+//
+// Deprecated: use ConnectMessageWithDetailFunc, which also accepts method values and closures.
+func (x *Bus) ConnectMessageWithDetail(detail string, cb *func(Bus, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	signalName := fmt.Sprintf("message::%s", detail)
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xBusMessageTrampoline uintptr
+
+func xBusMessageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Bus, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Bus{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, MessageVarp)
+
+	}
+}
+
+// ConnectMessageFunc connects cb to the "message" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Bus) ConnectMessageFunc(cb func(Bus, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "message", &xBusMessageTrampoline, xBusMessageNewTrampoline, cb)
+}
+
+// ConnectMessageWithDetailFunc connects cb to the "message" signal with a detail string, see ConnectMessageFunc.
+// The detail is appended as "message::<detail>".
+func (x *Bus) ConnectMessageWithDetailFunc(detail string, cb func(Bus, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("message::%s", detail), &xBusMessageTrampoline, xBusMessageNewTrampoline, cb)
+}
+
+// A message has been posted on the bus. This signal is emitted from the thread that posted the message so one has to be careful with locking.
+//
+// This signal will not be emitted by default, you have to call gst_bus_enable_sync_message_emission() before.
+//
+// Deprecated: use ConnectSyncMessageFunc, which also accepts method values and closures.
+func (x *Bus) ConnectSyncMessage(cb *func(Bus, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "sync-message", cbRefPtr, cbPtr)
+}
+
+// ConnectSyncMessageWithDetail connects to the "sync-message" signal with a detail string.
+// The detail is appended as "sync-message::<detail>".
+//
+// Deprecated: use ConnectSyncMessageWithDetailFunc, which also accepts method values and closures.
+func (x *Bus) ConnectSyncMessageWithDetail(detail string, cb *func(Bus, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	signalName := fmt.Sprintf("sync-message::%s", detail)
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MessageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Bus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MessageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xBusSyncMessageTrampoline uintptr
+
+func xBusSyncMessageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MessageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Bus, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Bus{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, MessageVarp)
+
+	}
+}
+
+// ConnectSyncMessageFunc connects cb to the "sync-message" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Bus) ConnectSyncMessageFunc(cb func(Bus, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "sync-message", &xBusSyncMessageTrampoline, xBusSyncMessageNewTrampoline, cb)
+}
+
+// ConnectSyncMessageWithDetailFunc connects cb to the "sync-message" signal with a detail string, see ConnectSyncMessageFunc.
+// The detail is appended as "sync-message::<detail>".
+func (x *Bus) ConnectSyncMessageWithDetailFunc(detail string, cb func(Bus, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("sync-message::%s", detail), &xBusSyncMessageTrampoline, xBusSyncMessageNewTrampoline, cb)
+}
+
+// GstElement is the abstract base class needed to construct an element that can be used in a GStreamer pipeline. Please refer to the plugin writers guide for more information on creating [Element] subclasses.
+//
+// The name of a [Element] can be get with gst_element_get_name() and set with gst_element_set_name(). For speed, GST_ELEMENT_NAME() can be used in the core when using the appropriate locking. Do not use this in plug-ins or applications in order to retain ABI compatibility.
+//
+// Elements can have pads (of the type [Pad]). These pads link to pads on other elements. GstBuffer flow between these linked pads. A [Element] has a [glib.List] of [Pad] structures for all their input (or sink) and output (or source) pads.
+//
+// Elements can be linked through their pads. If the link is straightforward, use the [Element.Link] convenience function to link two elements, or gst_element_link_many() for more elements in a row.
+//
+// Each element has a state (see [State]). You can get and set the state of an element with [Element.GetState] and [Element.SetState].
+type Element struct {
+	Object
+}
+
+var xElementGLibType func() types.GType
+
+func ElementGLibType() types.GType {
+	return xElementGLibType()
+}
+
+func ElementNewFromInternalPtr(ptr uintptr) *Element {
+	cls := &Element{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xElementGetBus func(uintptr) uintptr
+
+// Returns the bus of the element. Note that only a [Pipeline] will provide a bus for the application.
+func (x *Element) GetBus() *Bus {
+	var cls *Bus
+
+	cret := xElementGetBus(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Bus{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementGetFactory func(uintptr) uintptr
+
+// Retrieves the factory that was used to create this element.
+func (x *Element) GetFactory() *ElementFactory {
+	var cls *ElementFactory
+
+	cret := xElementGetFactory(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ElementFactory{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementGetState func(uintptr, *State, *State, ClockTime) StateChangeReturn
+
+// Gets the state of the element.
+//
+// For elements that performed an ASYNC state change, as reported by [Element.SetState], this function will block up to the specified timeout value for the state change to complete. If the element completes the state change or goes into an error, this function returns immediately with a return value of [StateChangeSuccessValue] or [StateChangeFailureValue] respectively.
+//
+// For elements that did not return [StateChangeAsyncValue], this function returns the current and pending state immediately.
+//
+// This function returns [StateChangeNoPrerollValue] if the element successfully changed its state but is not able to provide data yet. This mostly happens for live sources that only produce data in [StatePlayingValue]. While the state change return is equivalent to [StateChangeSuccessValue], it is returned to the application to signal that some sink elements might not be able to complete their state change because an element is not producing data to complete the preroll. When setting the element to playing, the preroll will complete and playback will start.
+func (x *Element) GetState(StateVar *State, PendingVar *State, TimeoutVar ClockTime) StateChangeReturn {
+
+	cret := xElementGetState(x.GoPointer(), StateVar, PendingVar, TimeoutVar)
+	return cret
+}
+
+var xElementGetStaticPad func(uintptr, string) uintptr
+
+// Retrieves a pad from element by name. This version only retrieves already-existing (i.e. 'static') pads.
+func (x *Element) GetStaticPad(NameVar string) *Pad {
+	var cls *Pad
+
+	cret := xElementGetStaticPad(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Pad{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementLink func(uintptr, uintptr) bool
+
+// Links src to dest. The link must be from source to destination; the other direction will not be tried. The function looks for existing pads that aren't linked yet. It will request new pads if necessary. Such pads need to be released manually when unlinking. If multiple links are possible, only one is established.
+//
+// Make sure you have added your elements to a bin or pipeline with [Bin.Add] before trying to link them.
+func (x *Element) Link(DestVar *Element) bool {
+
+	cret := xElementLink(x.GoPointer(), DestVar.GoPointer())
+	return cret
+}
+
+var xElementLinkFiltered func(uintptr, uintptr, *Caps) bool
+
+// Links src to dest using the given caps as filtercaps. The link must be from source to destination; the other direction will not be tried. The function looks for existing pads that aren't linked yet. It will request new pads if necessary. If multiple links are possible, only one is established.
+//
+// Make sure you have added your elements to a bin or pipeline with [Bin.Add] before trying to link them.
+func (x *Element) LinkFiltered(DestVar *Element, FilterVar *Caps) bool {
+
+	cret := xElementLinkFiltered(x.GoPointer(), DestVar.GoPointer(), FilterVar)
+	return cret
+}
+
+var xElementPostMessage func(uintptr, uintptr) bool
+
+// Post a message on the element's [Bus]. This function takes ownership of the message; if you want to access the message after this call, you should add an additional reference before calling.
+func (x *Element) PostMessage(MessageVar *Message) bool {
+
+	cret := xElementPostMessage(x.GoPointer(), gobject.BoxedCopy(MessageGLibType(), MessageVar.GoPointer()))
+	return cret
+}
+
+var xElementQueryDuration func(uintptr, Format, *int64) bool
+
+// Queries an element (usually top-level pipeline or playbin element) for the total stream duration in nanoseconds. This query will only work once the pipeline is prerolled (i.e. reached PAUSED or PLAYING state). The application will receive an ASYNC_DONE message on the pipeline bus when that is the case.
+//
+// If the duration changes for some reason, you will get a DURATION_CHANGED message on the pipeline bus, in which case you should re-query the duration using this function.
+func (x *Element) QueryDuration(FormatVar Format, DurationVar *int64) bool {
+
+	cret := xElementQueryDuration(x.GoPointer(), FormatVar, DurationVar)
+	return cret
+}
+
+var xElementQueryPosition func(uintptr, Format, *int64) bool
+
+// Queries an element (usually top-level pipeline or playbin element) for the stream position in nanoseconds. This will be a value between 0 and the stream duration (if the stream duration is known). This query will usually only work once the pipeline is prerolled (i.e. reached PAUSED or PLAYING state). The application will receive an ASYNC_DONE message on the pipeline bus when that is the case.
+//
+// If one repeatedly calls this function one can also create a query and reuse it in gst_element_query().
+func (x *Element) QueryPosition(FormatVar Format, CurVar *int64) bool {
+
+	cret := xElementQueryPosition(x.GoPointer(), FormatVar, CurVar)
+	return cret
+}
+
+var xElementReleaseRequestPad func(uintptr, uintptr)
+
+// Makes the element free the previously requested pad as obtained with gst_element_request_pad().
+//
+// This does not unref the pad. If the pad was created by using gst_element_request_pad(), [Element.ReleaseRequestPad] needs to be followed by gst_object_unref() to free the pad.
+//
+// MT safe.
+func (x *Element) ReleaseRequestPad(PadVar *Pad) {
+
+	xElementReleaseRequestPad(x.GoPointer(), PadVar.GoPointer())
+
+}
+
+var xElementRequestPadSimple func(uintptr, string) uintptr
+
+// Retrieves a pad from the element by name (e.g. "src_\%d"). This version only retrieves request pads. The pad should be released with [Element.ReleaseRequestPad].
+//
+// This method is slower than manually getting the pad template and calling gst_element_request_pad() if the pads should have a specific name (e.g. name is "src_1" instead of "src_\%u").
+//
+// Note that this function was introduced in GStreamer 1.20 in order to provide a better name to gst_element_get_request_pad(). Prior to 1.20, users should use gst_element_get_request_pad() which provides the same functionality.
+func (x *Element) RequestPadSimple(NameVar string) *Pad {
+	var cls *Pad
+
+	cret := xElementRequestPadSimple(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Pad{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementSeekSimple func(uintptr, Format, SeekFlags, int64) bool
+
+// Simple API to perform a seek on the given element, meaning it just seeks to the given position relative to the start of the stream. For more complex operations like segment seeks (e.g. for looping) or changing the playback rate or seeking relative to the last configured playback segment you should use gst_element_seek().
+//
+// In a completely prerolled PAUSED or PLAYING pipeline, seeking is always guaranteed to return true on a seekable media type or false when the media type is certainly not seekable (such as a live stream).
+//
+// Some elements allow for seeking in the READY state, in this case they will store the seek event and execute it when they are put to PAUSED. If the element supports seek in READY, it will always return true when it receives the event in the READY state.
+func (x *Element) SeekSimple(FormatVar Format, SeekFlagsVar SeekFlags, SeekPosVar int64) bool {
+
+	cret := xElementSeekSimple(x.GoPointer(), FormatVar, SeekFlagsVar, SeekPosVar)
+	return cret
+}
+
+var xElementSetState func(uintptr, State) StateChangeReturn
+
+// Sets the state of the element. This function will try to set the requested state by going through all the intermediary states and calling the class's state change function for each.
+//
+// This function can return GST_STATE_CHANGE_ASYNC, in which case the element will perform the remainder of the state change asynchronously in another thread. An application can use [Element.GetState] to wait for the completion of the state change or it can wait for a [MessageAsyncDoneValue] or [MessageStateChangedValue] on the bus.
+//
+// State changes to [StateReadyValue] or [StateNullValue] never return GST_STATE_CHANGE_ASYNC.
+func (x *Element) SetState(StateVar State) StateChangeReturn {
+
+	cret := xElementSetState(x.GoPointer(), StateVar)
+	return cret
+}
+
+var xElementSyncStateWithParent func(uintptr) bool
+
+// Tries to change the state of the element to the same as its parent. If this function returns false, the state of element is undefined.
+func (x *Element) SyncStateWithParent() bool {
+
+	cret := xElementSyncStateWithParent(x.GoPointer())
+	return cret
+}
+
+var xElementUnlink func(uintptr, uintptr)
+
+// Unlinks all source pads of the source element with all sink pads of the sink element to which they are linked.
+//
+// If the link has been made using [Element.Link], it could have created an requestpad, which has to be released using [Element.ReleaseRequestPad].
+func (x *Element) Unlink(DestVar *Element) {
+
+	xElementUnlink(x.GoPointer(), DestVar.GoPointer())
+
+}
+
+func (c *Element) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Element) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// This signals that the element will not generate more dynamic pads. Note that this signal will usually be emitted from the context of the streaming thread.
+//
+// Deprecated: use ConnectNoMorePadsFunc, which also accepts method values and closures.
+func (x *Element) ConnectNoMorePads(cb *func(Element)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Element{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "no-more-pads", cbRefPtr, cbPtr)
+}
+
+var xElementNoMorePadsTrampoline uintptr
+
+func xElementNoMorePadsNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Element))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Element{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa)
+
+	}
+}
+
+// ConnectNoMorePadsFunc connects cb to the "no-more-pads" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Element) ConnectNoMorePadsFunc(cb func(Element)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "no-more-pads", &xElementNoMorePadsTrampoline, xElementNoMorePadsNewTrampoline, cb)
+}
+
+// a new [Pad] has been added to the element. Note that this signal will usually be emitted from the context of the streaming thread. Also keep in mind that if you add new elements to the pipeline in the signal handler you will need to set them to the desired target state with [Element.SetState] or [Element.SyncStateWithParent].
+//
+// Deprecated: use ConnectPadAddedFunc, which also accepts method values and closures.
+func (x *Element) ConnectPadAdded(cb *func(Element, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NewPadVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Element{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, NewPadVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pad-added", cbRefPtr, cbPtr)
+}
+
+var xElementPadAddedTrampoline uintptr
+
+func xElementPadAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NewPadVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Element, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Element{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, NewPadVarp)
+
+	}
+}
+
+// ConnectPadAddedFunc connects cb to the "pad-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Element) ConnectPadAddedFunc(cb func(Element, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pad-added", &xElementPadAddedTrampoline, xElementPadAddedNewTrampoline, cb)
+}
+
+// a [Pad] has been removed from the element
+//
+// Deprecated: use ConnectPadRemovedFunc, which also accepts method values and closures.
+func (x *Element) ConnectPadRemoved(cb *func(Element, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OldPadVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Element{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, OldPadVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pad-removed", cbRefPtr, cbPtr)
+}
+
+var xElementPadRemovedTrampoline uintptr
+
+func xElementPadRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OldPadVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Element, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Element{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, OldPadVarp)
+
+	}
+}
+
+// ConnectPadRemovedFunc connects cb to the "pad-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Element) ConnectPadRemovedFunc(cb func(Element, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pad-removed", &xElementPadRemovedTrampoline, xElementPadRemovedNewTrampoline, cb)
+}
+
+var xElementStateChangeReturnGetName func(StateChangeReturn) string
+
+// Gets a string representing the given state change result.
+func ElementStateChangeReturnGetName(StateRetVar StateChangeReturn) string {
+
+	cret := xElementStateChangeReturnGetName(StateRetVar)
+	return cret
+}
+
+var xElementStateGetName func(State) string
+
+// Gets a string representing the given state.
+func ElementStateGetName(StateVar State) string {
+
+	cret := xElementStateGetName(StateVar)
+	return cret
+}
+
+// [ElementFactory] is used to create instances of elements. A GstElementFactory can be added to a GstPlugin as it is also a [PluginFeature].
+//
+// Use the [ElementFactoryFind] and [ElementFactory.Create] functions to create element instances or use [ElementFactoryMake] as a convenient shortcut.
+type ElementFactory struct {
+	PluginFeature
+}
+
+var xElementFactoryGLibType func() types.GType
+
+func ElementFactoryGLibType() types.GType {
+	return xElementFactoryGLibType()
+}
+
+func ElementFactoryNewFromInternalPtr(ptr uintptr) *ElementFactory {
+	cls := &ElementFactory{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xElementFactoryCreate func(uintptr, uintptr) uintptr
+
+// Create a new element of the type defined by the given elementfactory. It will be given the name supplied, since all elements require a name as their first argument.
+func (x *ElementFactory) Create(NameVar *string) *Element {
+	var cls *Element
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	cret := xElementFactoryCreate(x.GoPointer(), NameVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Element{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementFactoryGetMetadata func(uintptr, string) string
+
+// Get the metadata on factory with key.
+func (x *ElementFactory) GetMetadata(KeyVar string) string {
+
+	cret := xElementFactoryGetMetadata(x.GoPointer(), KeyVar)
+	return cret
+}
+
+func (c *ElementFactory) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *ElementFactory) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+var xElementFactoryFind func(string) uintptr
+
+// Search for an element factory of the given name. Refs the returned element factory; caller is responsible for unreffing.
+func ElementFactoryFind(NameVar string) *ElementFactory {
+	var cls *ElementFactory
+
+	cret := xElementFactoryFind(NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &ElementFactory{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xElementFactoryMake func(string, uintptr) uintptr
+
+// Create a new element of the type defined by the given element factory. If name is nil, then the element will receive a guaranteed unique name, consisting of the element factory name and a number. If name is given, it will be given the name supplied.
+func ElementFactoryMake(FactorynameVar string, NameVar *string) *Element {
+	var cls *Element
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	cret := xElementFactoryMake(FactorynameVar, NameVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Element{}
+	cls.Ptr = cret
+	return cls
+}
+
+// [Object] provides a root for the object hierarchy tree filed in by the GStreamer library. It is currently a thin wrapper on top of [gobject.InitiallyUnowned]. It is an abstract class that is not very usable on its own.
+//
+// [Object] gives us basic refcounting, parenting functionality and locking. Most of the functions are just extended for special GStreamer needs and can be found under the same name in the base class of [Object] which is [gobject.Object] (e.g. [gobject.Object.Ref] becomes gst_object_ref()).
+type Object struct {
+	gobject.InitiallyUnowned
+}
+
+var xObjectGLibType func() types.GType
+
+func ObjectGLibType() types.GType {
+	return xObjectGLibType()
+}
+
+func ObjectNewFromInternalPtr(ptr uintptr) *Object {
+	cls := &Object{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xObjectGetName func(uintptr) string
+
+// Returns a copy of the name of object. Caller should [glib.Free] the return value after usage. For a nameless object, this returns nil, which you can safely [glib.Free] as well.
+//
+// Free-function: g_free
+func (x *Object) GetName() string {
+
+	cret := xObjectGetName(x.GoPointer())
+	return cret
+}
+
+var xObjectGetParent func(uintptr) uintptr
+
+// Returns the parent of object. This function increases the refcount of the parent object so you should gst_object_unref() it after usage.
+func (x *Object) GetParent() *Object {
+	var cls *Object
+
+	cret := xObjectGetParent(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Object{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xObjectGetPathString func(uintptr) string
+
+// Generates a string describing the path of object in the object hierarchy. Only useful (or used) for debugging.
+//
+// Free-function: g_free
+func (x *Object) GetPathString() string {
+
+	cret := xObjectGetPathString(x.GoPointer())
+	return cret
+}
+
+var xObjectSetName func(uintptr, uintptr) bool
+
+// Sets the name of object, or gives object a guaranteed unique name (if name is nil). This function makes a copy of the provided name, so the caller retains ownership of the name it sent.
+func (x *Object) SetName(NameVar *string) bool {
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	cret := xObjectSetName(x.GoPointer(), NameVarPtr)
+	return cret
+}
+
+func (c *Object) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Object) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// A [Element] is linked to other elements via "pads", which are extremely light-weight generic link points.
+//
+// Pads have a [PadDirection], source pads produce data, sink pads consume data.
+//
+// Pads are typically created from a GstPadTemplate with gst_pad_new_from_template() and are then added to a [Element]. This usually happens when the element is created but it can also happen dynamically based on the data that the element is processing or based on the pads that the application requests.
+//
+// Pads without pad templates can be created with gst_pad_new(), which takes a direction and a name as an argument. If the name is nil, then a guaranteed unique name will be assigned to it.
+//
+// A [Element] creating a pad will typically use the various gst_pad_set_*_function() calls to register callbacks for events, queries or dataflow on the pads.
+//
+// gst_pad_get_parent() will retrieve the [Element] that owns the pad.
+//
+// After two pads are retrieved from an element by [Element.GetStaticPad], the pads can be linked with [Pad.Link]. (For quick links, you can also use [Element.Link], which will make the obvious link for you if it's straightforward.). Pads can be unlinked again with [Pad.Unlink]. [Pad.GetPeer] can be used to check what the pad is linked to.
+type Pad struct {
+	Object
+}
+
+var xPadGLibType func() types.GType
+
+func PadGLibType() types.GType {
+	return xPadGLibType()
+}
+
+func PadNewFromInternalPtr(ptr uintptr) *Pad {
+	cls := &Pad{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xPadGetCurrentCaps func(uintptr) *Caps
+
+// Gets the capabilities currently configured on pad with the last GST_EVENT_CAPS event.
+func (x *Pad) GetCurrentCaps() *Caps {
+
+	cret := xPadGetCurrentCaps(x.GoPointer())
+	return cret
+}
+
+var xPadGetDirection func(uintptr) PadDirection
+
+// Gets the direction of the pad. The direction of the pad is decided at construction time so this function does not take the LOCK.
+func (x *Pad) GetDirection() PadDirection {
+
+	cret := xPadGetDirection(x.GoPointer())
+	return cret
+}
+
+var xPadGetParentElement func(uintptr) uintptr
+
+// Gets the parent of pad, cast to a [Element]. If a pad has no parent or its parent is not an element, return nil.
+func (x *Pad) GetParentElement() *Element {
+	var cls *Element
+
+	cret := xPadGetParentElement(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Element{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPadGetPeer func(uintptr) uintptr
+
+// Gets the peer of pad. This function refs the peer pad so you need to unref it after use.
+func (x *Pad) GetPeer() *Pad {
+	var cls *Pad
+
+	cret := xPadGetPeer(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Pad{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPadIsLinked func(uintptr) bool
+
+// Checks if a pad is linked to another pad or not.
+func (x *Pad) IsLinked() bool {
+
+	cret := xPadIsLinked(x.GoPointer())
+	return cret
+}
+
+var xPadLink func(uintptr, uintptr) PadLinkReturn
+
+// Links the source pad and the sink pad.
+func (x *Pad) Link(SinkpadVar *Pad) PadLinkReturn {
+
+	cret := xPadLink(x.GoPointer(), SinkpadVar.GoPointer())
+	return cret
+}
+
+var xPadQueryCaps func(uintptr, *Caps) *Caps
+
+// Gets the capabilities this pad can produce or consume. Note that this method doesn't necessarily return the caps set by sending a gst_event_new_caps() - use [Pad.GetCurrentCaps] for that instead. gst_pad_query_caps returns all possible caps a pad can operate with, using the pad's CAPS query function, If the query fails, this function will return filter, if not nil, otherwise ANY.
+//
+// When called on sinkpads filter contains the caps that upstream could produce in the order preferred by upstream. When called on srcpads filter contains the caps accepted by downstream in the preferred order. filter might be nil but if it is not nil the returned caps will be a subset of filter.
+//
+// Note that this function does not return writable [Caps], use gst_caps_make_writable() before modifying the caps.
+func (x *Pad) QueryCaps(FilterVar *Caps) *Caps {
+
+	cret := xPadQueryCaps(x.GoPointer(), FilterVar)
+	return cret
+}
+
+var xPadUnlink func(uintptr, uintptr) bool
+
+// Unlinks the source pad from the sink pad. Will emit the [Pad.ConnectUnlinkedFunc] signal on both pads.
+func (x *Pad) Unlink(SinkpadVar *Pad) bool {
+
+	cret := xPadUnlink(x.GoPointer(), SinkpadVar.GoPointer())
+	return cret
+}
+
+func (c *Pad) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Pad) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// Signals that a pad has been linked to the peer pad.
+//
+// Deprecated: use ConnectLinkedFunc, which also accepts method values and closures.
+func (x *Pad) ConnectLinked(cb *func(Pad, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PeerVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Pad{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PeerVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "linked", cbRefPtr, cbPtr)
+}
+
+var xPadLinkedTrampoline uintptr
+
+func xPadLinkedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PeerVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Pad, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Pad{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, PeerVarp)
+
+	}
+}
+
+// ConnectLinkedFunc connects cb to the "linked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Pad) ConnectLinkedFunc(cb func(Pad, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "linked", &xPadLinkedTrampoline, xPadLinkedNewTrampoline, cb)
+}
+
+// Signals that a pad has been unlinked from the peer pad.
+//
+// Deprecated: use ConnectUnlinkedFunc, which also accepts method values and closures.
+func (x *Pad) ConnectUnlinked(cb *func(Pad, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PeerVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Pad{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PeerVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unlinked", cbRefPtr, cbPtr)
+}
+
+var xPadUnlinkedTrampoline uintptr
+
+func xPadUnlinkedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PeerVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Pad, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Pad{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, PeerVarp)
+
+	}
+}
+
+// ConnectUnlinkedFunc connects cb to the "unlinked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Pad) ConnectUnlinkedFunc(cb func(Pad, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unlinked", &xPadUnlinkedTrampoline, xPadUnlinkedNewTrampoline, cb)
+}
+
+var xPadLinkGetName func(PadLinkReturn) string
+
+// Gets a string representing the given pad-link return.
+func PadLinkGetName(RetVar PadLinkReturn) string {
+
+	cret := xPadLinkGetName(RetVar)
+	return cret
+}
+
+// A [Pipeline] is a special [Bin] used as the toplevel container in the filter graph. The [Pipeline] will manage the selection and distribution of a global GstClock as well as provide a [Bus] to the application.
+//
+// [NewPipeline] is used to create a pipeline. when you are done with the pipeline, use gst_object_unref() to free its resources including all added [Element] objects (if not otherwise referenced).
+//
+// Elements are added and removed from the pipeline using the [Bin] methods like [Bin.Add] and [Bin.Remove] (see [Bin]).
+//
+// Before changing the state of the [Pipeline] (see [Element]) a [Bus] should be retrieved with gst_pipeline_get_bus(). This [Bus] should then be used to receive [Message] from the elements in the pipeline. Listening to the [Bus] is necessary for retrieving error messages from the [Pipeline] and otherwise the [Pipeline] might stop without any indication, why.
+type Pipeline struct {
+	Bin
+}
+
+var xPipelineGLibType func() types.GType
+
+func PipelineGLibType() types.GType {
+	return xPipelineGLibType()
+}
+
+func PipelineNewFromInternalPtr(ptr uintptr) *Pipeline {
+	cls := &Pipeline{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewPipeline func(uintptr) uintptr
+
+// Create a new pipeline with the given name.
+func NewPipeline(NameVar *string) *Pipeline {
+	var cls *Pipeline
+
+	NameVarPtr := core.GStrdupNullable(NameVar)
+	defer core.GFreeNullable(NameVarPtr)
+
+	cret := xNewPipeline(NameVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Pipeline{}
+	cls.Ptr = cret
+	return cls
+}
+
+func (c *Pipeline) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Pipeline) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// This is a base class for anything that can be added to a GstPlugin.
+type PluginFeature struct {
+	Object
+}
+
+var xPluginFeatureGLibType func() types.GType
+
+func PluginFeatureGLibType() types.GType {
+	return xPluginFeatureGLibType()
+}
+
+func PluginFeatureNewFromInternalPtr(ptr uintptr) *PluginFeature {
+	cls := &PluginFeature{}
+	cls.Ptr = ptr
+	return cls
+}
+
+func (c *PluginFeature) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *PluginFeature) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+func init() {
+	core.SetPackageName("GST", "gstreamer-1.0")
+	core.SetSharedLibraries("GST", []string{"libgstreamer-1.0.so.0"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("GST")
+	if err != nil {
+		core.SetLibraryError("GST", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("GST", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xMessageTypeGLibType, libs, "gst_message_type_get_type")
+
+	core.PuregoSafeRegister(&xSeekFlagsGLibType, libs, "gst_seek_flags_get_type")
+
+	core.PuregoSafeRegister(&xFormatGLibType, libs, "gst_format_get_type")
+
+	core.PuregoSafeRegister(&xPadDirectionGLibType, libs, "gst_pad_direction_get_type")
+
+	core.PuregoSafeRegister(&xPadLinkReturnGLibType, libs, "gst_pad_link_return_get_type")
+
+	core.PuregoSafeRegister(&xStateGLibType, libs, "gst_state_get_type")
+
+	core.PuregoSafeRegister(&xStateChangeReturnGLibType, libs, "gst_state_change_return_get_type")
+
+	core.PuregoRegisterSince(&xCapsFromString, libs, "gst_caps_from_string", "GST", "")
+	core.PuregoRegisterSince(&xDeinit, libs, "gst_deinit", "GST", "")
+	core.PuregoRegisterSince(&xInit, libs, "gst_init", "GST", "")
+	core.PuregoRegisterSince(&xInitCheck, libs, "gst_init_check", "GST", "")
+	core.PuregoRegisterSince(&xIsInitialized, libs, "gst_is_initialized", "GST", "")
+	core.PuregoRegisterSince(&xMessageTypeGetName, libs, "gst_message_type_get_name", "GST", "")
+	core.PuregoRegisterSince(&xStructureFromString, libs, "gst_structure_from_string", "GST", "")
+	core.PuregoRegisterSince(&xVersion, libs, "gst_version", "GST", "")
+	core.PuregoRegisterSince(&xVersionString, libs, "gst_version_string", "GST", "")
+
+	core.PuregoSafeRegister(&xCapsGLibType, libs, "gst_caps_get_type")
+
+	core.PuregoRegisterSince(&xNewCapsAny, libs, "gst_caps_new_any", "GST", "")
+	core.PuregoRegisterSince(&xNewCapsEmpty, libs, "gst_caps_new_empty", "GST", "")
+	core.PuregoRegisterSince(&xNewCapsEmptySimple, libs, "gst_caps_new_empty_simple", "GST", "")
+
+	core.PuregoRegisterSince(&xCapsAppendStructure, libs, "gst_caps_append_structure", "GST", "")
+	core.PuregoRegisterSince(&xCapsCanIntersect, libs, "gst_caps_can_intersect", "GST", "")
+	core.PuregoRegisterSince(&xCapsCopy, libs, "gst_caps_copy", "GST", "")
+	core.PuregoRegisterSince(&xCapsGetSize, libs, "gst_caps_get_size", "GST", "")
+	core.PuregoRegisterSince(&xCapsGetStructure, libs, "gst_caps_get_structure", "GST", "")
+	core.PuregoRegisterSince(&xCapsIntersect, libs, "gst_caps_intersect", "GST", "")
+	core.PuregoRegisterSince(&xCapsIsAny, libs, "gst_caps_is_any", "GST", "")
+	core.PuregoRegisterSince(&xCapsIsEmpty, libs, "gst_caps_is_empty", "GST", "")
+	core.PuregoRegisterSince(&xCapsIsEqual, libs, "gst_caps_is_equal", "GST", "")
+	core.PuregoRegisterSince(&xCapsIsFixed, libs, "gst_caps_is_fixed", "GST", "")
+	core.PuregoRegisterSince(&xCapsRef, libs, "gst_caps_ref", "GST", "")
+	core.PuregoRegisterSince(&xCapsToString, libs, "gst_caps_to_string", "GST", "")
+	core.PuregoRegisterSince(&xCapsUnref, libs, "gst_caps_unref", "GST", "")
+
+	core.PuregoSafeRegister(&xMessageGLibType, libs, "gst_message_get_type")
+
+	core.PuregoRegisterSince(&xNewMessageApplication, libs, "gst_message_new_application", "GST", "")
+	core.PuregoRegisterSince(&xNewMessageEos, libs, "gst_message_new_eos", "GST", "")
+
+	core.PuregoRegisterSince(&xMessageGetSeqnum, libs, "gst_message_get_seqnum", "GST", "")
+	core.PuregoRegisterSince(&xMessageGetStructure, libs, "gst_message_get_structure", "GST", "")
+	core.PuregoRegisterSince(&xMessageParseBuffering, libs, "gst_message_parse_buffering", "GST", "")
+	core.PuregoRegisterSince(&xMessageParseError, libs, "gst_message_parse_error", "GST", "")
+	core.PuregoRegisterSince(&xMessageParseInfo, libs, "gst_message_parse_info", "GST", "")
+	core.PuregoRegisterSince(&xMessageParseStateChanged, libs, "gst_message_parse_state_changed", "GST", "")
+	core.PuregoRegisterSince(&xMessageParseWarning, libs, "gst_message_parse_warning", "GST", "")
+	core.PuregoRegisterSince(&xMessageRef, libs, "gst_message_ref", "GST", "")
+	core.PuregoRegisterSince(&xMessageUnref, libs, "gst_message_unref", "GST", "")
+
+	core.PuregoSafeRegister(&xMiniObjectGLibType, libs, "gst_mini_object_get_type")
+
+	core.PuregoRegisterSince(&xMiniObjectIsWritable, libs, "gst_mini_object_is_writable", "GST", "")
+	core.PuregoRegisterSince(&xMiniObjectRef, libs, "gst_mini_object_ref", "GST", "")
+	core.PuregoRegisterSince(&xMiniObjectUnref, libs, "gst_mini_object_unref", "GST", "")
+
+	core.PuregoSafeRegister(&xStructureGLibType, libs, "gst_structure_get_type")
+
+	core.PuregoRegisterSince(&xNewStructureEmpty, libs, "gst_structure_new_empty", "GST", "")
+
+	core.PuregoRegisterSince(&xStructureCopy, libs, "gst_structure_copy", "GST", "")
+	core.PuregoRegisterSince(&xStructureFree, libs, "gst_structure_free", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetBoolean, libs, "gst_structure_get_boolean", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetDouble, libs, "gst_structure_get_double", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetInt, libs, "gst_structure_get_int", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetName, libs, "gst_structure_get_name", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetString, libs, "gst_structure_get_string", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetUint, libs, "gst_structure_get_uint", "GST", "")
+	core.PuregoRegisterSince(&xStructureGetValue, libs, "gst_structure_get_value", "GST", "")
+	core.PuregoRegisterSince(&xStructureHasField, libs, "gst_structure_has_field", "GST", "")
+	core.PuregoRegisterSince(&xStructureHasName, libs, "gst_structure_has_name", "GST", "")
+	core.PuregoRegisterSince(&xStructureNFields, libs, "gst_structure_n_fields", "GST", "")
+	core.PuregoRegisterSince(&xStructureNthFieldName, libs, "gst_structure_nth_field_name", "GST", "")
+	core.PuregoRegisterSince(&xStructureSetValue, libs, "gst_structure_set_value", "GST", "")
+	core.PuregoRegisterSince(&xStructureToString, libs, "gst_structure_to_string", "GST", "")
+
+	core.PuregoSafeRegister(&xBinGLibType, libs, "gst_bin_get_type")
+
+	core.PuregoRegisterSince(&xNewBin, libs, "gst_bin_new", "GST", "")
+
+	core.PuregoRegisterSince(&xBinAdd, libs, "gst_bin_add", "GST", "")
+	core.PuregoRegisterSince(&xBinGetByName, libs, "gst_bin_get_by_name", "GST", "")
+	core.PuregoRegisterSince(&xBinRemove, libs, "gst_bin_remove", "GST", "")
+
+	core.PuregoSafeRegister(&xBusGLibType, libs, "gst_bus_get_type")
+
+	core.PuregoRegisterSince(&xNewBus, libs, "gst_bus_new", "GST", "")
+
+	core.PuregoRegisterSince(&xBusAddSignalWatch, libs, "gst_bus_add_signal_watch", "GST", "")
+	core.PuregoRegisterSince(&xBusAddWatchFull, libs, "gst_bus_add_watch_full", "GST", "")
+	core.PuregoRegisterSince(&xBusHavePending, libs, "gst_bus_have_pending", "GST", "")
+	core.PuregoRegisterSince(&xBusPop, libs, "gst_bus_pop", "GST", "")
+	core.PuregoRegisterSince(&xBusPopFiltered, libs, "gst_bus_pop_filtered", "GST", "")
+	core.PuregoRegisterSince(&xBusPost, libs, "gst_bus_post", "GST", "")
+	core.PuregoRegisterSince(&xBusRemoveSignalWatch, libs, "gst_bus_remove_signal_watch", "GST", "")
+	core.PuregoRegisterSince(&xBusRemoveWatch, libs, "gst_bus_remove_watch", "GST", "1.6")
+	core.PuregoRegisterSince(&xBusSetFlushing, libs, "gst_bus_set_flushing", "GST", "")
+	core.PuregoRegisterSince(&xBusTimedPop, libs, "gst_bus_timed_pop", "GST", "")
+	core.PuregoRegisterSince(&xBusTimedPopFiltered, libs, "gst_bus_timed_pop_filtered", "GST", "")
+
+	core.PuregoSafeRegister(&xElementGLibType, libs, "gst_element_get_type")
+
+	core.PuregoRegisterSince(&xElementGetBus, libs, "gst_element_get_bus", "GST", "")
+	core.PuregoRegisterSince(&xElementGetFactory, libs, "gst_element_get_factory", "GST", "")
+	core.PuregoRegisterSince(&xElementGetState, libs, "gst_element_get_state", "GST", "")
+	core.PuregoRegisterSince(&xElementGetStaticPad, libs, "gst_element_get_static_pad", "GST", "")
+	core.PuregoRegisterSince(&xElementLink, libs, "gst_element_link", "GST", "")
+	core.PuregoRegisterSince(&xElementLinkFiltered, libs, "gst_element_link_filtered", "GST", "")
+	core.PuregoRegisterSince(&xElementPostMessage, libs, "gst_element_post_message", "GST", "")
+	core.PuregoRegisterSince(&xElementQueryDuration, libs, "gst_element_query_duration", "GST", "")
+	core.PuregoRegisterSince(&xElementQueryPosition, libs, "gst_element_query_position", "GST", "")
+	core.PuregoRegisterSince(&xElementReleaseRequestPad, libs, "gst_element_release_request_pad", "GST", "")
+	core.PuregoRegisterSince(&xElementRequestPadSimple, libs, "gst_element_request_pad_simple", "GST", "1.20")
+	core.PuregoRegisterSince(&xElementSeekSimple, libs, "gst_element_seek_simple", "GST", "")
+	core.PuregoRegisterSince(&xElementSetState, libs, "gst_element_set_state", "GST", "")
+	core.PuregoRegisterSince(&xElementSyncStateWithParent, libs, "gst_element_sync_state_with_parent", "GST", "")
+	core.PuregoRegisterSince(&xElementUnlink, libs, "gst_element_unlink", "GST", "")
+
+	core.PuregoRegisterSince(&xElementStateChangeReturnGetName, libs, "gst_element_state_change_return_get_name", "GST", "")
+	core.PuregoRegisterSince(&xElementStateGetName, libs, "gst_element_state_get_name", "GST", "")
+
+	core.PuregoSafeRegister(&xElementFactoryGLibType, libs, "gst_element_factory_get_type")
+
+	core.PuregoRegisterSince(&xElementFactoryCreate, libs, "gst_element_factory_create", "GST", "")
+	core.PuregoRegisterSince(&xElementFactoryGetMetadata, libs, "gst_element_factory_get_metadata", "GST", "")
+
+	core.PuregoRegisterSince(&xElementFactoryFind, libs, "gst_element_factory_find", "GST", "")
+	core.PuregoRegisterSince(&xElementFactoryMake, libs, "gst_element_factory_make", "GST", "")
+
+	core.PuregoSafeRegister(&xObjectGLibType, libs, "gst_object_get_type")
+
+	core.PuregoRegisterSince(&xObjectGetName, libs, "gst_object_get_name", "GST", "")
+	core.PuregoRegisterSince(&xObjectGetParent, libs, "gst_object_get_parent", "GST", "")
+	core.PuregoRegisterSince(&xObjectGetPathString, libs, "gst_object_get_path_string", "GST", "")
+	core.PuregoRegisterSince(&xObjectSetName, libs, "gst_object_set_name", "GST", "")
+
+	core.PuregoSafeRegister(&xPadGLibType, libs, "gst_pad_get_type")
+
+	core.PuregoRegisterSince(&xPadGetCurrentCaps, libs, "gst_pad_get_current_caps", "GST", "")
+	core.PuregoRegisterSince(&xPadGetDirection, libs, "gst_pad_get_direction", "GST", "")
+	core.PuregoRegisterSince(&xPadGetParentElement, libs, "gst_pad_get_parent_element", "GST", "")
+	core.PuregoRegisterSince(&xPadGetPeer, libs, "gst_pad_get_peer", "GST", "")
+	core.PuregoRegisterSince(&xPadIsLinked, libs, "gst_pad_is_linked", "GST", "")
+	core.PuregoRegisterSince(&xPadLink, libs, "gst_pad_link", "GST", "")
+	core.PuregoRegisterSince(&xPadQueryCaps, libs, "gst_pad_query_caps", "GST", "")
+	core.PuregoRegisterSince(&xPadUnlink, libs, "gst_pad_unlink", "GST", "")
+
+	core.PuregoRegisterSince(&xPadLinkGetName, libs, "gst_pad_link_get_name", "GST", "1.4")
+
+	core.PuregoSafeRegister(&xPipelineGLibType, libs, "gst_pipeline_get_type")
+
+	core.PuregoRegisterSince(&xNewPipeline, libs, "gst_pipeline_new", "GST", "")
+
+	core.PuregoSafeRegister(&xPluginFeatureGLibType, libs, "gst_plugin_feature_get_type")
+
+}
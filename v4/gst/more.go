@@ -0,0 +1,62 @@
+// Package gst binds the core of GStreamer: pipelines, elements, pads, caps and the bus.
+//
+//	gst.Init(0, 0)
+//	pipeline := gst.NewPipeline(nil)
+//	src := gst.ElementFactoryMake("videotestsrc", nil)
+//	sink := gst.ElementFactoryMake("gtk4paintablesink", nil)
+//	pipeline.Add(src)
+//	pipeline.Add(sink)
+//	src.Link(sink)
+//	bus := pipeline.GetBus()
+//	bus.AddWatchFunc(func(msg *gst.Message) bool {
+//		...
+//		return true
+//	})
+//	pipeline.SetState(gst.StatePlayingValue)
+//
+// Elements that are not part of the bindings, e.g. playbin or gtk4paintablesink, are created by name
+// with ElementFactoryMake and configured through their properties, the paintable of gtk4paintablesink
+// is read with GetProperty and shown with a gtk.Picture.
+//
+// The library is optional: if it is not installed, importing the package does not fail
+// and the functions panic with a *core.MissingSymbolError.
+// core.LibraryError("GST") returns why it could not be loaded.
+package gst
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// busWatchTrampoline is shared by all bus watches
+// the function is looked up by the user data such that only one callback is allocated
+var busWatchTrampoline BusFunc = func(_ uintptr, msg *Message, id uintptr) bool {
+	if fn, ok := glib.LookupUserData[func(msg *Message) bool](id); ok {
+		return fn(msg)
+	}
+	return false
+}
+
+// AddWatchFunc adds a watch to the default main context that calls fn with the messages posted on the bus.
+// The message belongs to the bus, call Ref on it to keep it after fn returns.
+// The watch is removed when fn returns false or with RemoveWatch, then fn is released.
+// A bus has at most one watch, it returns 0 if the bus already has one.
+func (x *Bus) AddWatchFunc(fn func(msg *Message) bool) uint {
+	id := glib.RegisterUserData(fn)
+	source := x.AddWatchFull(glib.PRIORITY_DEFAULT, &busWatchTrampoline, id, glib.UserDataDestroyNotify())
+	if source == 0 {
+		// the destroy notify is not called if the watch was not added
+		glib.UnregisterUserData(id)
+	}
+	return source
+}
+
+// Source returns the object that posted the message, or nil if the message has no source.
+// The object belongs to the message.
+func (x *Message) Source() *Object {
+	if x.Src == nil {
+		return nil
+	}
+	return ObjectNewFromInternalPtr(uintptr(unsafe.Pointer(x.Src)))
+}
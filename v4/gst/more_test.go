@@ -0,0 +1,45 @@
+package gst_test
+
+import (
+	"testing"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gst"
+)
+
+func TestBusWatchFunc(t *testing.T) {
+	if err := core.LibraryError("GST"); err != nil {
+		t.Skip("GStreamer is not installed:", err)
+	}
+	gst.Init(0, 0)
+	before := glib.CallbackStats()
+
+	bus := gst.NewBus()
+	defer bus.Unref()
+	var got []gst.MessageType
+	if bus.AddWatchFunc(func(msg *gst.Message) bool {
+		got = append(got, msg.Type)
+		return msg.Type != gst.MessageEosValue
+	}) == 0 {
+		t.Fatal("the watch was not added")
+	}
+	if bus.AddWatchFunc(func(*gst.Message) bool { return true }) != 0 {
+		t.Fatal("a second watch was added")
+	}
+	if after := glib.CallbackStats(); after.UserData != before.UserData+1 {
+		t.Fatalf("%d user data are registered for one watch", after.UserData-before.UserData)
+	}
+
+	bus.Post(gst.NewMessageApplication(nil, gst.NewStructureEmpty("test")))
+	bus.Post(gst.NewMessageEos(nil))
+	for i := 0; i < 10 && len(got) < 2; i++ {
+		glib.MainContextDefault().Iteration(false)
+	}
+	if len(got) != 2 || got[0] != gst.MessageApplicationValue || got[1] != gst.MessageEosValue {
+		t.Fatalf("the watch got %v", got)
+	}
+	if after := glib.CallbackStats(); after.UserData != before.UserData {
+		t.Fatalf("%d user data are left after the watch is removed", after.UserData-before.UserData)
+	}
+}
@@ -0,0 +1,298 @@
+// Package gstbase was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gstbase
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gst"
+)
+
+// [BaseSink] is the base class for sink elements in GStreamer, such as xvimagesink or filesink. It is a layer on top of [gst.Element] that provides a simplified interface to plugin writers. [BaseSink] handles many details for you, for example: preroll, clock synchronization, state changes, activation in push or pull mode, and queries.
+//
+// In most cases, when writing sink elements, there is no need to implement class methods from [gst.Element] or to set functions on pads, because the [BaseSink] infrastructure should be sufficient.
+type BaseSink struct {
+	gst.Element
+}
+
+var xBaseSinkGLibType func() types.GType
+
+func BaseSinkGLibType() types.GType {
+	return xBaseSinkGLibType()
+}
+
+func BaseSinkNewFromInternalPtr(ptr uintptr) *BaseSink {
+	cls := &BaseSink{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xBaseSinkGetMaxLateness func(uintptr) int64
+
+// Gets the max lateness value. See [BaseSink.SetMaxLateness] for more details.
+func (x *BaseSink) GetMaxLateness() int64 {
+
+	cret := xBaseSinkGetMaxLateness(x.GoPointer())
+	return cret
+}
+
+var xBaseSinkGetSync func(uintptr) bool
+
+// Checks if sink is currently configured to synchronize against the clock.
+func (x *BaseSink) GetSync() bool {
+
+	cret := xBaseSinkGetSync(x.GoPointer())
+	return cret
+}
+
+var xBaseSinkIsQosEnabled func(uintptr) bool
+
+// Checks if sink is currently configured to send Quality-of-Service events upstream.
+func (x *BaseSink) IsQosEnabled() bool {
+
+	cret := xBaseSinkIsQosEnabled(x.GoPointer())
+	return cret
+}
+
+var xBaseSinkSetMaxLateness func(uintptr, int64)
+
+// Sets the new max lateness value to max_lateness. This value is used to decide if a buffer should be dropped or not based on the buffer timestamp and the current clock time. A value of -1 means an unlimited time.
+func (x *BaseSink) SetMaxLateness(MaxLatenessVar int64) {
+
+	xBaseSinkSetMaxLateness(x.GoPointer(), MaxLatenessVar)
+
+}
+
+var xBaseSinkSetQosEnabled func(uintptr, bool)
+
+// Configures sink to send Quality-of-Service events upstream.
+func (x *BaseSink) SetQosEnabled(EnabledVar bool) {
+
+	xBaseSinkSetQosEnabled(x.GoPointer(), EnabledVar)
+
+}
+
+var xBaseSinkSetSync func(uintptr, bool)
+
+// Configures sink to synchronize on the clock or not. When sync is false, incoming samples will be played as fast as possible. If sync is true, the timestamps of the incoming buffers will be used to schedule the exact render time of its contents.
+func (x *BaseSink) SetSync(SyncVar bool) {
+
+	xBaseSinkSetSync(x.GoPointer(), SyncVar)
+
+}
+
+func (c *BaseSink) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *BaseSink) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// This is a generic base class for source elements. The following types of sources are supported:
+//
+//   - random access sources like files
+//   - seekable sources
+//   - live sources
+//
+// The source can be configured to operate in any [gst.Format] with the [BaseSrc.SetFormat] method. The currently set format determines the format of the internal GstSegment and any GST_EVENT_SEGMENT events. The default format for [BaseSrc] is [gst.FormatBytesValue].
+//
+// [BaseSrc] always supports push mode scheduling. If the following conditions are met, it also supports pull mode scheduling:
+//
+//   - The format is set to [gst.FormatBytesValue] (default).
+//   - GstBaseSrcClass::is_seekable returns true.
+type BaseSrc struct {
+	gst.Element
+}
+
+var xBaseSrcGLibType func() types.GType
+
+func BaseSrcGLibType() types.GType {
+	return xBaseSrcGLibType()
+}
+
+func BaseSrcNewFromInternalPtr(ptr uintptr) *BaseSrc {
+	cls := &BaseSrc{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xBaseSrcGetDoTimestamp func(uintptr) bool
+
+// Query if src timestamps outgoing buffers based on the current running_time.
+func (x *BaseSrc) GetDoTimestamp() bool {
+
+	cret := xBaseSrcGetDoTimestamp(x.GoPointer())
+	return cret
+}
+
+var xBaseSrcIsLive func(uintptr) bool
+
+// Check if an element is in live mode.
+func (x *BaseSrc) IsLive() bool {
+
+	cret := xBaseSrcIsLive(x.GoPointer())
+	return cret
+}
+
+var xBaseSrcSetDoTimestamp func(uintptr, bool)
+
+// Configure src to automatically timestamp outgoing buffers based on the current running_time of the pipeline. This property is mostly useful for live sources.
+func (x *BaseSrc) SetDoTimestamp(TimestampVar bool) {
+
+	xBaseSrcSetDoTimestamp(x.GoPointer(), TimestampVar)
+
+}
+
+var xBaseSrcSetFormat func(uintptr, gst.Format)
+
+// Sets the default format of the source. This will be the format used for sending SEGMENT events and for performing seeks.
+//
+// If a format of GST_FORMAT_BYTES is set, the element will be able to operate in pull mode if the GstBaseSrcClass::is_seekable returns true.
+//
+// This function must only be called in states < [gst.StatePausedValue].
+func (x *BaseSrc) SetFormat(FormatVar gst.Format) {
+
+	xBaseSrcSetFormat(x.GoPointer(), FormatVar)
+
+}
+
+var xBaseSrcSetLive func(uintptr, bool)
+
+// If the element listens to a live source, live should be set to true.
+//
+// A live source will not produce data in the PAUSED state and will therefore not be able to participate in the PREROLL phase of a pipeline. To signal this fact to the application and the pipeline, the state change return value of the live source will be GST_STATE_CHANGE_NO_PREROLL.
+func (x *BaseSrc) SetLive(LiveVar bool) {
+
+	xBaseSrcSetLive(x.GoPointer(), LiveVar)
+
+}
+
+func (c *BaseSrc) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *BaseSrc) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// This base class is for filter elements that process data. Elements that are suitable for implementation using [BaseTransform] are ones where the size and caps of the output is known entirely from the input caps and buffer sizes. These include elements that directly transform one buffer into another, modify the contents of a buffer in-place, as well as elements that collate multiple input buffers into one output buffer, or that expand one input buffer into multiple output buffers.
+type BaseTransform struct {
+	gst.Element
+}
+
+var xBaseTransformGLibType func() types.GType
+
+func BaseTransformGLibType() types.GType {
+	return xBaseTransformGLibType()
+}
+
+func BaseTransformNewFromInternalPtr(ptr uintptr) *BaseTransform {
+	cls := &BaseTransform{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xBaseTransformIsInPlace func(uintptr) bool
+
+// See if trans is configured as a in_place transform.
+func (x *BaseTransform) IsInPlace() bool {
+
+	cret := xBaseTransformIsInPlace(x.GoPointer())
+	return cret
+}
+
+var xBaseTransformIsPassthrough func(uintptr) bool
+
+// See if trans is configured as a passthrough transform.
+func (x *BaseTransform) IsPassthrough() bool {
+
+	cret := xBaseTransformIsPassthrough(x.GoPointer())
+	return cret
+}
+
+var xBaseTransformSetInPlace func(uintptr, bool)
+
+// Determines whether a non-writable buffer will be copied before passing to the transform_ip function.
+//
+//   - Always true if no transform function is implemented.
+//   - Always false if ONLY transform function is implemented.
+//
+// MT safe.
+func (x *BaseTransform) SetInPlace(InPlaceVar bool) {
+
+	xBaseTransformSetInPlace(x.GoPointer(), InPlaceVar)
+
+}
+
+var xBaseTransformSetPassthrough func(uintptr, bool)
+
+// Set passthrough mode for this filter by default. This is mostly useful for filters that do not care about negotiation.
+//
+// Always true for filters which don't implement either a transform or transform_ip or generate_output method.
+//
+// MT safe.
+func (x *BaseTransform) SetPassthrough(PassthroughVar bool) {
+
+	xBaseTransformSetPassthrough(x.GoPointer(), PassthroughVar)
+
+}
+
+func (c *BaseTransform) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *BaseTransform) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+func init() {
+	core.SetPackageName("GSTBASE", "gstreamer-base-1.0")
+	core.SetSharedLibraries("GSTBASE", []string{"libgstbase-1.0.so.0"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("GSTBASE")
+	if err != nil {
+		core.SetLibraryError("GSTBASE", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("GSTBASE", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xBaseSinkGLibType, libs, "gst_base_sink_get_type")
+
+	core.PuregoRegisterSince(&xBaseSinkGetMaxLateness, libs, "gst_base_sink_get_max_lateness", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSinkGetSync, libs, "gst_base_sink_get_sync", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSinkIsQosEnabled, libs, "gst_base_sink_is_qos_enabled", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSinkSetMaxLateness, libs, "gst_base_sink_set_max_lateness", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSinkSetQosEnabled, libs, "gst_base_sink_set_qos_enabled", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSinkSetSync, libs, "gst_base_sink_set_sync", "GSTBASE", "")
+
+	core.PuregoSafeRegister(&xBaseSrcGLibType, libs, "gst_base_src_get_type")
+
+	core.PuregoRegisterSince(&xBaseSrcGetDoTimestamp, libs, "gst_base_src_get_do_timestamp", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSrcIsLive, libs, "gst_base_src_is_live", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSrcSetDoTimestamp, libs, "gst_base_src_set_do_timestamp", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSrcSetFormat, libs, "gst_base_src_set_format", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseSrcSetLive, libs, "gst_base_src_set_live", "GSTBASE", "")
+
+	core.PuregoSafeRegister(&xBaseTransformGLibType, libs, "gst_base_transform_get_type")
+
+	core.PuregoRegisterSince(&xBaseTransformIsInPlace, libs, "gst_base_transform_is_in_place", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseTransformIsPassthrough, libs, "gst_base_transform_is_passthrough", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseTransformSetInPlace, libs, "gst_base_transform_set_in_place", "GSTBASE", "")
+	core.PuregoRegisterSince(&xBaseTransformSetPassthrough, libs, "gst_base_transform_set_passthrough", "GSTBASE", "")
+
+}
@@ -0,0 +1,131 @@
+// Package busy overlays a spinner over content while it is loading, and short toast messages when it is done.
+//
+//	b := busy.Wrap(&list.Widget)
+//	window.SetChild(b.Widget())
+//
+//	b.Start("Loading…")
+//	load(func() { // called on the main thread when loading is done
+//		b.Stop()
+//		b.Toast("Loaded", 2*time.Second)
+//	})
+//
+// All methods must be called on the main thread.
+package busy
+
+import (
+	"time"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Busy is the controller of the busy state of a content widget, created by Wrap.
+type Busy struct {
+	overlay *gtk.Overlay
+	content *gtk.Widget
+
+	// blocker covers the content while busy, so that it does not receive pointer input
+	blocker *gtk.Box
+	spinner *gtk.Spinner
+	label   *gtk.Label
+
+	toast      *gtk.Label
+	toastTimer uint
+
+	busy bool
+	// sensitive is the sensitivity of the content before Start
+	sensitive bool
+}
+
+// Wrap puts the content widget, which must not have a parent yet, into an overlay that shows the busy state.
+// Add the overlay returned by Widget to the window in place of the content.
+func Wrap(content *gtk.Widget) *Busy {
+	b := &Busy{
+		overlay: gtk.NewOverlay(),
+		content: content,
+		blocker: gtk.NewBox(gtk.OrientationVerticalValue, 12),
+		spinner: gtk.NewSpinner(),
+		label:   gtk.NewLabel(nil),
+		toast:   gtk.NewLabel(nil),
+	}
+	b.overlay.SetChild(content)
+
+	b.blocker.SetHalign(gtk.AlignFillValue)
+	b.blocker.SetValign(gtk.AlignFillValue)
+	b.blocker.AddCssClass("busy")
+	inner := gtk.NewBox(gtk.OrientationVerticalValue, 12)
+	inner.SetHalign(gtk.AlignCenterValue)
+	inner.SetValign(gtk.AlignCenterValue)
+	inner.SetVexpand(true)
+	inner.AddCssClass("osd")
+	b.spinner.SetSizeRequest(32, 32)
+	inner.Append(&b.spinner.Widget)
+	b.label.SetWrap(true)
+	b.label.SetJustify(gtk.JustifyCenterValue)
+	inner.Append(&b.label.Widget)
+	b.blocker.Append(&inner.Widget)
+	b.blocker.SetVisible(false)
+	b.overlay.AddOverlay(&b.blocker.Widget)
+
+	b.toast.SetHalign(gtk.AlignCenterValue)
+	b.toast.SetValign(gtk.AlignEndValue)
+	b.toast.SetMarginBottom(24)
+	b.toast.AddCssClass("osd")
+	b.toast.AddCssClass("toast")
+	b.toast.SetCanTarget(false)
+	b.toast.SetVisible(false)
+	b.overlay.AddOverlay(&b.toast.Widget)
+	return b
+}
+
+// Widget returns the overlay with the content, add it to the window in place of the content.
+func (b *Busy) Widget() *gtk.Widget {
+	return &b.overlay.Widget
+}
+
+// Start shows the spinner with msg below it, or without a label if msg is empty,
+// and makes the content insensitive until Stop is called.
+// Calling Start while busy only changes the message.
+func (b *Busy) Start(msg string) {
+	b.label.SetText(msg)
+	b.label.SetVisible(msg != "")
+	if b.busy {
+		return
+	}
+	b.busy = true
+	b.sensitive = b.content.GetSensitive()
+	b.content.SetSensitive(false)
+	b.spinner.Start()
+	b.blocker.SetVisible(true)
+}
+
+// Stop hides the spinner and restores the sensitivity of the content.
+func (b *Busy) Stop() {
+	if !b.busy {
+		return
+	}
+	b.busy = false
+	b.blocker.SetVisible(false)
+	b.spinner.Stop()
+	b.content.SetSensitive(b.sensitive)
+}
+
+// Busy returns whether the spinner is shown.
+func (b *Busy) Busy() bool {
+	return b.busy
+}
+
+// Toast shows msg at the bottom of the content for the duration, replacing a toast that is still shown.
+func (b *Busy) Toast(msg string, d time.Duration) {
+	if b.toastTimer != 0 {
+		glib.SourceRemove(b.toastTimer)
+		b.toastTimer = 0
+	}
+	b.toast.SetText(msg)
+	b.toast.SetVisible(true)
+	hide := glib.SourceOnceFunc(func(uintptr) {
+		b.toastTimer = 0
+		b.toast.SetVisible(false)
+	})
+	b.toastTimer = glib.TimeoutAddOnce(uint(d.Milliseconds()), &hide, 0)
+}
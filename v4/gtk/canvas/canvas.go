@@ -0,0 +1,470 @@
+// Package canvas is a widget that places its children at free positions, for diagram and node editors.
+// The user selects children by clicking them or by dragging a rubber band around them, moves the selection by dragging
+// and zooms with Ctrl and the scroll wheel:
+//
+//	c := canvas.New()
+//	c.Put(&node.Widget, 40, 80)
+//	c.OnMoved(func(child *gtk.Widget, x, y float64) {
+//		save(child, x, y)
+//	})
+//	scrolled.SetChild(c.Widget())
+//
+// Positions are in canvas units, which are pixels at a zoom of 1. Selected children have the "selected" CSS class
+// and the rubber band is a "rubberband" CSS node, which themes style like the rubber band of a GtkListView.
+// The children are laid out by a layout manager that is registered as a GLib type in Go.
+// All functions must be called on the main thread.
+package canvas
+
+import (
+	"math"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+	"github.com/jwijenbergh/puregotk/v4/gsk"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+const (
+	minZoom = 0.1
+	maxZoom = 10
+	// zoomStep is the factor of one step of the scroll wheel
+	zoomStep = 1.1
+)
+
+// item is a child of a canvas
+type item struct {
+	widget *gtk.Widget
+	// x and y are the position in canvas units
+	x, y float64
+	// width and height are the size of the last allocation in canvas units
+	width, height float64
+	selected      bool
+	// startX and startY are the position when the selection started to move
+	startX, startY float64
+}
+
+type dragMode int
+
+const (
+	dragNone dragMode = iota
+	dragMove
+	dragBand
+)
+
+// Canvas is a widget with freely positioned children, created by New.
+type Canvas struct {
+	widget *gtk.Widget
+	items  map[uintptr]*item
+	zoom   float64
+
+	mode dragMode
+	// startX and startY are where the drag started in widget coordinates
+	startX, startY float64
+	band           *gtk.Widget
+	bandX, bandY   float64
+	bandW, bandH   float64
+	// bandBase is the selection that the rubber band adds to
+	bandBase map[uintptr]bool
+
+	selectionChanged func(selection []*gtk.Widget)
+	moved            func(child *gtk.Widget, x, y float64)
+}
+
+// canvases are the canvases by the pointer of their widget, for the layout manager
+var canvases = make(map[uintptr]*Canvas)
+
+// typeQuery is the C layout of GTypeQuery, the sizes are guint
+type typeQuery struct {
+	gtype        types.GType
+	typeName     uintptr
+	classSize    uint32
+	instanceSize uint32
+}
+
+// registerType registers a final subclass of parent without fields of its own
+func registerType(parent types.GType, name string, classInit *gobject.ClassInitFunc) types.GType {
+	var q typeQuery
+	gobject.NewTypeQuery(parent, (*gobject.TypeQuery)(unsafe.Pointer(&q)))
+	return gobject.TypeRegisterStaticSimple(parent, name, uint(q.classSize), classInit, uint(q.instanceSize), nil, gobject.GTypeFlagFinalValue)
+}
+
+var (
+	registerOnce   sync.Once
+	layoutType     types.GType
+	rubberbandType types.GType
+)
+
+// layoutClassInit and rubberbandClassInit stay referenced as the class init functions of the types
+var layoutClassInit gobject.ClassInitFunc = func(class *gobject.TypeClass, _ uintptr) {
+	lm := (*gtk.LayoutManagerClass)(unsafe.Pointer(class))
+	lm.OverrideMeasure(func(_ *gtk.LayoutManager, widget *gtk.Widget, orientation gtk.Orientation, _ int, minimum, natural, minimumBaseline, naturalBaseline *int) {
+		size := 0
+		if c := canvases[widget.GoPointer()]; c != nil {
+			size = c.extent(orientation)
+		}
+		setInt(minimum, 0)
+		setInt(natural, size)
+		setInt(minimumBaseline, -1)
+		setInt(naturalBaseline, -1)
+	})
+	lm.OverrideAllocate(func(_ *gtk.LayoutManager, widget *gtk.Widget, _, _, _ int) {
+		if c := canvases[widget.GoPointer()]; c != nil {
+			c.allocate()
+		}
+	})
+}
+
+var rubberbandClassInit gobject.ClassInitFunc = func(class *gobject.TypeClass, _ uintptr) {
+	(*gtk.WidgetClass)(unsafe.Pointer(class)).SetCssName("rubberband")
+}
+
+func registerTypes() {
+	registerOnce.Do(func() {
+		layoutType = registerType(gtk.LayoutManagerGLibType(), "PuregotkCanvasLayout", &layoutClassInit)
+		rubberbandType = registerType(gtk.WidgetGLibType(), "PuregotkCanvasRubberband", &rubberbandClassInit)
+	})
+}
+
+// setInt stores v in the C int that p points to, the callbacks of the layout manager pass the C ints as *int
+func setInt(p *int, v int) {
+	if p != nil {
+		*(*int32)(unsafe.Pointer(p)) = int32(v)
+	}
+}
+
+// New returns an empty canvas at a zoom of 1.
+func New() *Canvas {
+	registerTypes()
+	// GtkFixed unparents the children when it is destroyed, its own layout manager is replaced,
+	// so its functions to place children must not be used
+	fixed := gtk.NewFixed()
+	c := &Canvas{
+		widget: &fixed.Widget,
+		items:  make(map[uintptr]*item),
+		zoom:   1,
+	}
+	layout := gobject.NewObjectWithProperties(layoutType, 0, nil, nil)
+	// the widget takes the reference of the layout manager
+	c.widget.SetLayoutManager(gtk.LayoutManagerNewFromInternalPtr(layout.Ptr))
+	ptr := c.widget.GoPointer()
+	canvases[ptr] = c
+	c.widget.ConnectDestroyFunc(func(gtk.Widget) {
+		delete(canvases, ptr)
+	})
+
+	var drag *gtk.GestureDrag
+	drag = c.widget.OnDrag(func(x, y float64) {
+		c.dragBegin(x, y, drag.GetCurrentEventState())
+	}, c.dragUpdate, c.dragEnd)
+	var scroll *gtk.EventControllerScroll
+	scroll = c.widget.OnScroll(gtk.EventControllerScrollVerticalValue, func(_, dy float64) bool {
+		if !scroll.GetCurrentEventState().Has(gdk.ControlMaskValue) {
+			return false
+		}
+		c.SetZoom(c.zoom * math.Pow(zoomStep, -dy))
+		return true
+	})
+	return c
+}
+
+// Widget returns the widget of the canvas, e.g. to put it into a GtkScrolledWindow.
+func (c *Canvas) Widget() *gtk.Widget {
+	return c.widget
+}
+
+// Put adds child at the position x, y on top of the other children.
+func (c *Canvas) Put(child *gtk.Widget, x, y float64) {
+	c.items[child.GoPointer()] = &item{widget: child, x: x, y: y}
+	if c.band != nil {
+		// the rubber band stays on top
+		child.InsertBefore(c.widget, c.band)
+	} else {
+		child.SetParent(c.widget)
+	}
+}
+
+// Move moves child to the position x, y.
+func (c *Canvas) Move(child *gtk.Widget, x, y float64) {
+	if it := c.items[child.GoPointer()]; it != nil {
+		it.x, it.y = x, y
+		c.widget.QueueResize()
+	}
+}
+
+// Position returns the position of child, ok is false if it is not a child of the canvas.
+func (c *Canvas) Position(child *gtk.Widget) (x, y float64, ok bool) {
+	it := c.items[child.GoPointer()]
+	if it == nil {
+		return 0, 0, false
+	}
+	return it.x, it.y, true
+}
+
+// Remove removes child from the canvas.
+func (c *Canvas) Remove(child *gtk.Widget) {
+	it := c.items[child.GoPointer()]
+	if it == nil {
+		return
+	}
+	delete(c.items, child.GoPointer())
+	child.RemoveCssClass("selected")
+	child.Unparent()
+	if it.selected {
+		c.emitSelectionChanged()
+	}
+}
+
+// Children returns the children from bottom to top.
+func (c *Canvas) Children() []*gtk.Widget {
+	var children []*gtk.Widget
+	for child := c.widget.GetFirstChild(); child != nil; child = child.GetNextSibling() {
+		if it := c.items[child.GoPointer()]; it != nil {
+			children = append(children, it.widget)
+		}
+	}
+	return children
+}
+
+// Selection returns the selected children from bottom to top.
+func (c *Canvas) Selection() []*gtk.Widget {
+	var selection []*gtk.Widget
+	for child := c.widget.GetFirstChild(); child != nil; child = child.GetNextSibling() {
+		if it := c.items[child.GoPointer()]; it != nil && it.selected {
+			selection = append(selection, it.widget)
+		}
+	}
+	return selection
+}
+
+// SetSelection selects the children and unselects all others.
+func (c *Canvas) SetSelection(children ...*gtk.Widget) {
+	selected := make(map[uintptr]bool, len(children))
+	for _, child := range children {
+		selected[child.GoPointer()] = true
+	}
+	if c.selectOnly(selected) {
+		c.emitSelectionChanged()
+	}
+}
+
+// selectOnly selects the children in selected and unselects the others, it reports whether the selection changed
+func (c *Canvas) selectOnly(selected map[uintptr]bool) bool {
+	changed := false
+	for ptr, it := range c.items {
+		if c.setSelected(it, selected[ptr]) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (c *Canvas) setSelected(it *item, selected bool) bool {
+	if it.selected == selected {
+		return false
+	}
+	it.selected = selected
+	if selected {
+		it.widget.AddCssClass("selected")
+	} else {
+		it.widget.RemoveCssClass("selected")
+	}
+	return true
+}
+
+func (c *Canvas) emitSelectionChanged() {
+	if c.selectionChanged != nil {
+		c.selectionChanged(c.Selection())
+	}
+}
+
+// Zoom returns the zoom factor, 1 shows the children at their natural size.
+func (c *Canvas) Zoom() float64 {
+	return c.zoom
+}
+
+// SetZoom scales the children by zoom, which is clamped to the range from 0.1 to 10.
+func (c *Canvas) SetZoom(zoom float64) {
+	zoom = math.Max(minZoom, math.Min(maxZoom, zoom))
+	if zoom == c.zoom {
+		return
+	}
+	c.zoom = zoom
+	c.widget.QueueResize()
+}
+
+// ToCanvas converts a position relative to the widget of the canvas, e.g. of a drop, to canvas units.
+func (c *Canvas) ToCanvas(x, y float64) (float64, float64) {
+	return x / c.zoom, y / c.zoom
+}
+
+// OnSelectionChanged calls fn with the selected children when the user or SetSelection changes the selection.
+func (c *Canvas) OnSelectionChanged(fn func(selection []*gtk.Widget)) {
+	c.selectionChanged = fn
+}
+
+// OnMoved calls fn with the new position of every child the user moved when the user stops dragging.
+func (c *Canvas) OnMoved(fn func(child *gtk.Widget, x, y float64)) {
+	c.moved = fn
+}
+
+// itemAt returns the child of the canvas at the position in widget coordinates, or nil for the background
+func (c *Canvas) itemAt(x, y float64) *item {
+	picked := c.widget.Pick(x, y, gtk.PickDefaultValue)
+	for w := picked; w != nil; w = w.GetParent() {
+		if it := c.items[w.GoPointer()]; it != nil {
+			return it
+		}
+		if w.GoPointer() == c.widget.GoPointer() {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *Canvas) dragBegin(x, y float64, state gdk.ModifierType) {
+	c.startX, c.startY = x, y
+	toggle := state.Has(gdk.ControlMaskValue) || state.Has(gdk.ShiftMaskValue)
+	if it := c.itemAt(x, y); it != nil {
+		changed := false
+		switch {
+		case toggle:
+			changed = c.setSelected(it, !it.selected)
+		case !it.selected:
+			changed = c.selectOnly(map[uintptr]bool{it.widget.GoPointer(): true})
+		}
+		if changed {
+			c.emitSelectionChanged()
+		}
+		if !it.selected {
+			c.mode = dragNone
+			return
+		}
+		c.mode = dragMove
+		for _, other := range c.items {
+			other.startX, other.startY = other.x, other.y
+		}
+		return
+	}
+	c.mode = dragBand
+	c.bandBase = make(map[uintptr]bool)
+	if toggle {
+		for ptr, it := range c.items {
+			if it.selected {
+				c.bandBase[ptr] = true
+			}
+		}
+	} else if c.selectOnly(nil) {
+		c.emitSelectionChanged()
+	}
+	c.bandX, c.bandY, c.bandW, c.bandH = x, y, 0, 0
+	band := gobject.NewObjectWithProperties(rubberbandType, 0, nil, nil)
+	c.band = gtk.WidgetNewFromInternalPtr(band.Ptr)
+	c.band.SetParent(c.widget)
+}
+
+func (c *Canvas) dragUpdate(dx, dy float64) {
+	switch c.mode {
+	case dragMove:
+		for _, it := range c.items {
+			if it.selected {
+				it.x, it.y = it.startX+dx/c.zoom, it.startY+dy/c.zoom
+			}
+		}
+		c.widget.QueueResize()
+	case dragBand:
+		c.bandX, c.bandW = math.Min(c.startX, c.startX+dx), math.Abs(dx)
+		c.bandY, c.bandH = math.Min(c.startY, c.startY+dy), math.Abs(dy)
+		selected := make(map[uintptr]bool, len(c.bandBase))
+		for ptr := range c.bandBase {
+			selected[ptr] = true
+		}
+		for ptr, it := range c.items {
+			if c.inBand(it) {
+				selected[ptr] = true
+			}
+		}
+		if c.selectOnly(selected) {
+			c.emitSelectionChanged()
+		}
+		c.widget.QueueAllocate()
+	}
+}
+
+// inBand reports whether the child overlaps the rubber band
+func (c *Canvas) inBand(it *item) bool {
+	x, y := it.x*c.zoom, it.y*c.zoom
+	w, h := it.width*c.zoom, it.height*c.zoom
+	return x < c.bandX+c.bandW && c.bandX < x+w && y < c.bandY+c.bandH && c.bandY < y+h
+}
+
+func (c *Canvas) dragEnd(dx, dy float64) {
+	mode := c.mode
+	c.mode = dragNone
+	switch mode {
+	case dragMove:
+		if (dx == 0 && dy == 0) || c.moved == nil {
+			return
+		}
+		for _, child := range c.Selection() {
+			it := c.items[child.GoPointer()]
+			c.moved(child, it.x, it.y)
+		}
+	case dragBand:
+		c.band.Unparent()
+		c.band = nil
+		c.bandBase = nil
+	}
+}
+
+// naturalSize returns the natural size of the child in canvas units
+func naturalSize(child *gtk.Widget) (int, int) {
+	var width, height int
+	child.Measure(gtk.OrientationHorizontalValue, -1, nil, &width, nil, nil)
+	child.Measure(gtk.OrientationVerticalValue, width, nil, &height, nil, nil)
+	return width, height
+}
+
+// extent returns the size of the canvas in the orientation that shows all children
+func (c *Canvas) extent(orientation gtk.Orientation) int {
+	size := 0.0
+	for _, it := range c.items {
+		if !it.widget.ShouldLayout() {
+			continue
+		}
+		width, height := naturalSize(it.widget)
+		if orientation == gtk.OrientationHorizontalValue {
+			size = math.Max(size, (it.x+float64(width))*c.zoom)
+		} else {
+			size = math.Max(size, (it.y+float64(height))*c.zoom)
+		}
+	}
+	return int(math.Ceil(size))
+}
+
+// allocate places the children at their positions scaled by the zoom, and the rubber band over them
+func (c *Canvas) allocate() {
+	for child := c.widget.GetFirstChild(); child != nil; child = child.GetNextSibling() {
+		it := c.items[child.GoPointer()]
+		if it == nil || !child.ShouldLayout() {
+			continue
+		}
+		width, height := naturalSize(child)
+		it.width, it.height = float64(width), float64(height)
+		// the transform is copied by Allocate
+		t := gsk.NewTransform().Translate(&graphene.Point{X: float32(it.x * c.zoom), Y: float32(it.y * c.zoom)}).Scale(float32(c.zoom), float32(c.zoom))
+		child.Allocate(width, height, -1, t)
+		t.Unref()
+	}
+	if c.band != nil {
+		var minWidth, minHeight int
+		c.band.Measure(gtk.OrientationHorizontalValue, -1, &minWidth, nil, nil, nil)
+		c.band.Measure(gtk.OrientationVerticalValue, -1, &minHeight, nil, nil, nil)
+		t := gsk.NewTransform().Translate(&graphene.Point{X: float32(c.bandX), Y: float32(c.bandY)})
+		c.band.Allocate(max(int(c.bandW), minWidth), max(int(c.bandH), minHeight), -1, t)
+		t.Unref()
+	}
+}
@@ -0,0 +1,98 @@
+// Package css has helpers for the conditional styling of widgets with CSS classes and state flags:
+//
+//	css.Toggle(&entry.Widget, "error", !valid)
+//	css.OneOf(&label.Widget, level, "success", "warning", "error")
+//	css.SetState(&row.Widget, gtk.StateFlagSelectedValue, selected)
+package css
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Toggle adds class to the widget if on is true and removes it otherwise.
+func Toggle(w *gtk.Widget, class string, on bool) {
+	if on {
+		w.AddCssClass(class)
+	} else {
+		w.RemoveCssClass(class)
+	}
+}
+
+// Swap replaces the class old by new.
+// new is added even if the widget does not have old.
+func Swap(w *gtk.Widget, old, new string) {
+	if old == new {
+		w.AddCssClass(new)
+		return
+	}
+	w.RemoveCssClass(old)
+	w.AddCssClass(new)
+}
+
+// OneOf adds class and removes all other classes of group, e.g. to pick one of "success", "warning" and "error".
+// An empty class removes all classes of group.
+func OneOf(w *gtk.Widget, class string, group ...string) {
+	for _, c := range group {
+		if c != class {
+			w.RemoveCssClass(c)
+		}
+	}
+	if class != "" {
+		w.AddCssClass(class)
+	}
+}
+
+// Has returns whether the widget has all classes.
+func Has(w *gtk.Widget, classes ...string) bool {
+	for _, c := range classes {
+		if !w.HasCssClass(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Add adds all classes to the widget.
+func Add(w *gtk.Widget, classes ...string) {
+	for _, c := range classes {
+		w.AddCssClass(c)
+	}
+}
+
+// Remove removes all classes from the widget.
+func Remove(w *gtk.Widget, classes ...string) {
+	for _, c := range classes {
+		w.RemoveCssClass(c)
+	}
+}
+
+// Set replaces all classes of the widget by classes.
+func Set(w *gtk.Widget, classes ...string) {
+	w.SetCssClasses(classes)
+}
+
+// Classes returns the classes of the widget.
+func Classes(w *gtk.Widget) []string {
+	return w.GetCssClasses()
+}
+
+// SetName sets the name of the widget, which is matched by #name selectors.
+func SetName(w *gtk.Widget, name string) {
+	w.SetName(name)
+}
+
+// SetState sets the state flags, e.g. gtk.StateFlagSelectedValue for the :selected pseudo-class,
+// if on is true and unsets them otherwise.
+// Other flags of the widget are kept.
+func SetState(w *gtk.Widget, flags gtk.StateFlags, on bool) {
+	if on {
+		w.SetStateFlags(flags, false)
+	} else {
+		w.UnsetStateFlags(flags)
+	}
+}
+
+// HasState returns whether all flags are set on the widget.
+func HasState(w *gtk.Widget, flags gtk.StateFlags) bool {
+	return w.GetStateFlags()&flags == flags
+}
@@ -0,0 +1,213 @@
+// Package focus helps with keyboard navigation: moving the focus, giving widgets the focus when they are shown
+// and custom tab orders, which GTK 4 no longer has an API for.
+//
+//	focus.OnMap(&entry.Widget)
+//	chain := focus.ChainFor(&form.Widget, &name.Widget, &email.Widget, &submit.Widget)
+package focus
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Direction is the direction to move the focus in.
+type Direction = gtk.DirectionType
+
+const (
+	// Forward moves the focus to the next widget in tab order, like Tab
+	Forward Direction = gtk.DirTabForwardValue
+	// Backward moves the focus to the previous widget in tab order, like Shift+Tab
+	Backward Direction = gtk.DirTabBackwardValue
+	// Up moves the focus to the widget above, like the up arrow key
+	Up Direction = gtk.DirUpValue
+	// Down moves the focus to the widget below, like the down arrow key
+	Down Direction = gtk.DirDownValue
+	// Left moves the focus to the widget to the left, like the left arrow key
+	Left Direction = gtk.DirLeftValue
+	// Right moves the focus to the widget to the right, like the right arrow key
+	Right Direction = gtk.DirRightValue
+)
+
+// root returns the toplevel of the widget as widget, it is nil if the widget is not in a window
+func root(w *gtk.Widget) *gtk.Widget {
+	r := w.GetRoot()
+	if r == nil {
+		return nil
+	}
+	(&gobject.Object{Ptr: r.Ptr}).Unref()
+	return gtk.WidgetNewFromInternalPtr(r.Ptr)
+}
+
+// Focused returns the widget that has the focus in the window of w, or nil.
+func Focused(w *gtk.Widget) *gtk.Widget {
+	r := w.GetRoot()
+	if r == nil {
+		return nil
+	}
+	defer (&gobject.Object{Ptr: r.Ptr}).Unref()
+	f := r.GetFocus()
+	if f == nil {
+		return nil
+	}
+	f.Unref()
+	return f
+}
+
+// Move moves the focus in the window of w in the direction, like the keyboard navigation of GTK does.
+// It wraps around at the first and last widget and returns false if no widget can take the focus.
+func Move(w *gtk.Widget, dir Direction) bool {
+	r := root(w)
+	if r == nil {
+		return false
+	}
+	if r.ChildFocus(dir) {
+		return true
+	}
+	// the end of the window was reached, start over from the other end
+	rb := &gtk.RootBase{Ptr: r.Ptr}
+	rb.SetFocus(nil)
+	return r.ChildFocus(dir)
+}
+
+// Next moves the focus in the window of w to the next widget in tab order.
+func Next(w *gtk.Widget) bool {
+	return Move(w, Forward)
+}
+
+// Previous moves the focus in the window of w to the previous widget in tab order.
+func Previous(w *gtk.Widget) bool {
+	return Move(w, Backward)
+}
+
+// Grab gives the widget the focus, if it is not mapped yet it gets the focus once it is.
+// GrabFocus itself fails for widgets that are not shown yet, e.g. while building a window before presenting it.
+func Grab(w *gtk.Widget) {
+	if w.GetMapped() {
+		w.GrabFocus()
+		return
+	}
+	var id uint
+	id = w.ConnectMapFunc(func(gtk.Widget) {
+		gobject.SignalHandlerDisconnect(&w.Object, types.ULong(id))
+		w.GrabFocus()
+	})
+}
+
+// OnMap gives the widget the focus every time it is mapped,
+// e.g. the search entry of a page whenever the page is shown in a stack.
+// It returns the handler id to stop with gobject.SignalHandlerDisconnect.
+func OnMap(w *gtk.Widget) uint {
+	if w.GetMapped() {
+		w.GrabFocus()
+	}
+	return w.ConnectMapFunc(func(gtk.Widget) {
+		w.GrabFocus()
+	})
+}
+
+// Save remembers the focus widget of the window of w and returns a function that gives it the focus again,
+// e.g. to return the focus to where it was after closing a popup.
+func Save(w *gtk.Widget) (restore func()) {
+	f := Focused(w)
+	if f == nil {
+		return func() {}
+	}
+	f.Ref()
+	return func() {
+		if f == nil {
+			return
+		}
+		f.GrabFocus()
+		f.Unref()
+		f = nil
+	}
+}
+
+// Chain is a custom tab order set up by ChainFor.
+type Chain struct {
+	container  *gtk.Widget
+	widgets    []*gtk.Widget
+	controller *gtk.EventControllerKey
+}
+
+// ChainFor makes Tab and Shift+Tab move the focus through widgets in the given order while the focus is inside container.
+// The chain wraps around, so the focus cannot leave the container with Tab, which also suits modal areas.
+// Widgets that cannot take the focus, e.g. because they are hidden or insensitive, are skipped.
+// A widget in the chain that is a container holds the focus while any of its descendants has it.
+func ChainFor(container *gtk.Widget, widgets ...*gtk.Widget) *Chain {
+	c := &Chain{
+		container: container,
+		widgets:   append([]*gtk.Widget(nil), widgets...),
+	}
+	c.controller = container.OnKeyPressed(func(keyval, _ uint, state gdk.ModifierType) bool {
+		if state&(gdk.ControlMaskValue|gdk.AltMaskValue) != 0 {
+			return false
+		}
+		switch int(keyval) {
+		case gdk.KEY_Tab, gdk.KEY_KP_Tab:
+			if state&gdk.ShiftMaskValue != 0 {
+				return c.Move(Backward)
+			}
+			return c.Move(Forward)
+		case gdk.KEY_ISO_Left_Tab:
+			return c.Move(Backward)
+		}
+		return false
+	})
+	// handle the keys before the focused widget, which would move the focus by widget order
+	c.controller.SetPropagationPhase(gtk.PhaseCaptureValue)
+	return c
+}
+
+// current returns the index of the widget in the chain that holds the focus or -1
+func (c *Chain) current() int {
+	f := Focused(c.container)
+	if f == nil {
+		return -1
+	}
+	for i, w := range c.widgets {
+		if f.Ptr == w.Ptr || f.IsAncestor(w) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Move moves the focus to the next or previous widget of the chain that can take the focus.
+// Any direction other than Backward moves forward.
+// It returns false if no widget of the chain can take the focus.
+func (c *Chain) Move(dir Direction) bool {
+	n := len(c.widgets)
+	if n == 0 {
+		return false
+	}
+	step := 1
+	if dir == Backward {
+		step = n - 1
+	}
+	i := c.current()
+	if i < 0 {
+		// enter the chain at its start or end
+		i = 0
+		if dir == Backward {
+			i = n - 1
+		}
+	} else {
+		i = (i + step) % n
+	}
+	for tries := 0; tries < n; tries++ {
+		w := c.widgets[i]
+		if w.IsVisible() && w.IsSensitive() && w.GrabFocus() {
+			return true
+		}
+		i = (i + step) % n
+	}
+	return false
+}
+
+// Remove removes the custom tab order, the container uses the default order again.
+func (c *Chain) Remove() {
+	c.container.RemoveController(&c.controller.EventController)
+}
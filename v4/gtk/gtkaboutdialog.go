@@ -2,9 +2,9 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -67,6 +67,141 @@ const (
 	License0bsdValue License = 18
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x License) String() string {
+	switch x {
+	case LicenseUnknownValue:
+		return "GTK_LICENSE_UNKNOWN"
+	case LicenseCustomValue:
+		return "GTK_LICENSE_CUSTOM"
+	case LicenseGpl20Value:
+		return "GTK_LICENSE_GPL_2_0"
+	case LicenseGpl30Value:
+		return "GTK_LICENSE_GPL_3_0"
+	case LicenseLgpl21Value:
+		return "GTK_LICENSE_LGPL_2_1"
+	case LicenseLgpl30Value:
+		return "GTK_LICENSE_LGPL_3_0"
+	case LicenseBsdValue:
+		return "GTK_LICENSE_BSD"
+	case LicenseMitX11Value:
+		return "GTK_LICENSE_MIT_X11"
+	case LicenseArtisticValue:
+		return "GTK_LICENSE_ARTISTIC"
+	case LicenseGpl20OnlyValue:
+		return "GTK_LICENSE_GPL_2_0_ONLY"
+	case LicenseGpl30OnlyValue:
+		return "GTK_LICENSE_GPL_3_0_ONLY"
+	case LicenseLgpl21OnlyValue:
+		return "GTK_LICENSE_LGPL_2_1_ONLY"
+	case LicenseLgpl30OnlyValue:
+		return "GTK_LICENSE_LGPL_3_0_ONLY"
+	case LicenseAgpl30Value:
+		return "GTK_LICENSE_AGPL_3_0"
+	case LicenseAgpl30OnlyValue:
+		return "GTK_LICENSE_AGPL_3_0_ONLY"
+	case LicenseBsd3Value:
+		return "GTK_LICENSE_BSD_3"
+	case LicenseApache20Value:
+		return "GTK_LICENSE_APACHE_2_0"
+	case LicenseMpl20Value:
+		return "GTK_LICENSE_MPL_2_0"
+	case License0bsdValue:
+		return "GTK_LICENSE_0BSD"
+	}
+	return fmt.Sprintf("License(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x License) Nick() string {
+	switch x {
+	case LicenseUnknownValue:
+		return "unknown"
+	case LicenseCustomValue:
+		return "custom"
+	case LicenseGpl20Value:
+		return "gpl-2-0"
+	case LicenseGpl30Value:
+		return "gpl-3-0"
+	case LicenseLgpl21Value:
+		return "lgpl-2-1"
+	case LicenseLgpl30Value:
+		return "lgpl-3-0"
+	case LicenseBsdValue:
+		return "bsd"
+	case LicenseMitX11Value:
+		return "mit-x11"
+	case LicenseArtisticValue:
+		return "artistic"
+	case LicenseGpl20OnlyValue:
+		return "gpl-2-0-only"
+	case LicenseGpl30OnlyValue:
+		return "gpl-3-0-only"
+	case LicenseLgpl21OnlyValue:
+		return "lgpl-2-1-only"
+	case LicenseLgpl30OnlyValue:
+		return "lgpl-3-0-only"
+	case LicenseAgpl30Value:
+		return "agpl-3-0"
+	case LicenseAgpl30OnlyValue:
+		return "agpl-3-0-only"
+	case LicenseBsd3Value:
+		return "bsd-3"
+	case LicenseApache20Value:
+		return "apache-2-0"
+	case LicenseMpl20Value:
+		return "mpl-2-0"
+	case License0bsdValue:
+		return "0bsd"
+	}
+	return ""
+}
+
+// LicenseFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LicenseFromString(s string) (License, bool) {
+	switch s {
+	case "GTK_LICENSE_UNKNOWN", "unknown":
+		return LicenseUnknownValue, true
+	case "GTK_LICENSE_CUSTOM", "custom":
+		return LicenseCustomValue, true
+	case "GTK_LICENSE_GPL_2_0", "gpl-2-0":
+		return LicenseGpl20Value, true
+	case "GTK_LICENSE_GPL_3_0", "gpl-3-0":
+		return LicenseGpl30Value, true
+	case "GTK_LICENSE_LGPL_2_1", "lgpl-2-1":
+		return LicenseLgpl21Value, true
+	case "GTK_LICENSE_LGPL_3_0", "lgpl-3-0":
+		return LicenseLgpl30Value, true
+	case "GTK_LICENSE_BSD", "bsd":
+		return LicenseBsdValue, true
+	case "GTK_LICENSE_MIT_X11", "mit-x11":
+		return LicenseMitX11Value, true
+	case "GTK_LICENSE_ARTISTIC", "artistic":
+		return LicenseArtisticValue, true
+	case "GTK_LICENSE_GPL_2_0_ONLY", "gpl-2-0-only":
+		return LicenseGpl20OnlyValue, true
+	case "GTK_LICENSE_GPL_3_0_ONLY", "gpl-3-0-only":
+		return LicenseGpl30OnlyValue, true
+	case "GTK_LICENSE_LGPL_2_1_ONLY", "lgpl-2-1-only":
+		return LicenseLgpl21OnlyValue, true
+	case "GTK_LICENSE_LGPL_3_0_ONLY", "lgpl-3-0-only":
+		return LicenseLgpl30OnlyValue, true
+	case "GTK_LICENSE_AGPL_3_0", "agpl-3-0":
+		return LicenseAgpl30Value, true
+	case "GTK_LICENSE_AGPL_3_0_ONLY", "agpl-3-0-only":
+		return LicenseAgpl30OnlyValue, true
+	case "GTK_LICENSE_BSD_3", "bsd-3":
+		return LicenseBsd3Value, true
+	case "GTK_LICENSE_APACHE_2_0", "apache-2-0":
+		return LicenseApache20Value, true
+	case "GTK_LICENSE_MPL_2_0", "mpl-2-0":
+		return LicenseMpl20Value, true
+	case "GTK_LICENSE_0BSD", "0bsd":
+		return License0bsdValue, true
+	}
+	return 0, false
+}
+
 var xShowAboutDialog func(uintptr, string, ...interface{})
 
 // A convenience function for showing an application’s about dialog.
@@ -88,24 +223,17 @@ func ShowAboutDialog(ParentVar *Window, FirstPropertyNameVar string, varArgs ...
 // An about dialog is typically opened when the user selects the `About`
 // option from the `Help` menu. All parts of the dialog are optional.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="aboutdialot-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkAboutDialog" src="aboutdialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // About dialogs often contain links and email addresses. `GtkAboutDialog`
-// displays these as clickable links. By default, it calls [method@Gtk.FileLauncher.launch]
+// displays these as clickable links. By default, it calls [FileLauncher.Launch]
 // when a user clicks one. The behaviour can be overridden with the
-// [signal@Gtk.AboutDialog::activate-link] signal.
+// [AboutDialog.ConnectActivateLinkFunc] signal.
 //
 // To specify a person with an email address, use a string like
-// `Edgar Allan Poe &lt;edgar@poe.com&gt;`. To specify a website with a title,
+// `Edgar Allan Poe <edgar@poe.com>`. To specify a website with a title,
 // use a string like `GTK team https://www.gtk.org`.
 //
 // To make constructing an about dialog as convenient as possible, you can
-// use the function [func@Gtk.show_about_dialog] which constructs and shows
+// use the function [ShowAboutDialog] which constructs and shows
 // a dialog and keeps it around so that it can be shown again.
 //
 // Note that GTK sets a default title of `_("About %s")` on the dialog
@@ -114,27 +242,23 @@ func ShowAboutDialog(ParentVar *Window, FirstPropertyNameVar string, varArgs ...
 // set the title property explicitly when constructing an about dialog,
 // as shown in the following example:
 //
-// ```c
-// GFile *logo_file = g_file_new_for_path ("./logo.png");
-// GdkTexture *example_logo = gdk_texture_new_from_file (logo_file, NULL);
-// g_object_unref (logo_file);
-//
-// gtk_show_about_dialog (NULL,
+//	GFile *logo_file = g_file_new_for_path ("./logo.png");
+//	GdkTexture *example_logo = gdk_texture_new_from_file (logo_file, NULL);
+//	g_object_unref (logo_file);
 //
-//	"program-name", "ExampleCode",
-//	"logo", example_logo,
-//	"title", _("About ExampleCode"),
-//	NULL);
+//	gtk_show_about_dialog (NULL,
+//	                       "program-name", "ExampleCode",
+//	                       "logo", example_logo,
+//	                       "title", _("About ExampleCode"),
+//	                       NULL);
 //
-// ```
-//
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // `GtkAboutDialog` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Escape&lt;/kbd&gt; closes the window.
+// - Escape closes the window.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkAboutDialog` has a single CSS node with the name `window` and style
 // class `.aboutdialog`.
@@ -416,7 +540,7 @@ var xAboutDialogSetLicenseType func(uintptr, License)
 // from a list of known licenses.
 //
 // This function overrides the license set using
-// [method@Gtk.AboutDialog.set_license].
+// [AboutDialog.SetLicense].
 func (x *AboutDialog) SetLicenseType(LicenseTypeVar License) {
 
 	xAboutDialogSetLicenseType(x.GoPointer(), LicenseTypeVar)
@@ -449,7 +573,7 @@ var xAboutDialogSetProgramName func(uintptr, uintptr)
 // Sets the name to display in the about dialog.
 //
 // If `name` is not set, the string returned
-// by `g_get_application_name()` is used.
+// by `[glib.GetApplicationName]` is used.
 func (x *AboutDialog) SetProgramName(NameVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -467,7 +591,7 @@ var xAboutDialogSetSystemInformation func(uintptr, uintptr)
 // If `system_information` is `NULL`, the system information
 // page is hidden.
 //
-// See [property@Gtk.AboutDialog:system-information].
+// See [AboutDialog.GetPropertySystemInformation].
 func (x *AboutDialog) SetSystemInformation(SystemInformationVar *string) {
 
 	SystemInformationVarPtr := core.GStrdupNullable(SystemInformationVar)
@@ -487,13 +611,9 @@ var xAboutDialogSetTranslatorCredits func(uintptr, uintptr)
 // Using `gettext()`, a simple way to achieve that is to mark the
 // string for translation:
 //
-// ```c
-// GtkWidget *about = gtk_about_dialog_new ();
-//
-//	gtk_about_dialog_set_translator_credits (GTK_ABOUT_DIALOG (about),
-//	                                         _("translator-credits"));
-//
-// ```
+//	GtkWidget *about = gtk_about_dialog_new ();
+//	 gtk_about_dialog_set_translator_credits (GTK_ABOUT_DIALOG (about),
+//	                                          _("translator-credits"));
 //
 // It is a good idea to use the customary `msgid` “translator-credits”
 // for this purpose, since translators will already know the purpose of
@@ -682,11 +802,11 @@ func (x *AboutDialog) GetPropertyDocumenters() []string {
 // otherwise the text itself must contain the intended linebreaks.
 //
 // When setting this property to a non-`NULL` value, the
-// [property@Gtk.AboutDialog:license-type] property is set to
-// [enum@Gtk.License.custom] as a side effect.
+// AboutDialog:license-type property is set to
+// [LicenseCustomValue] as a side effect.
 //
-// The text may contain links in this format `&lt;http://www.some.place/&gt;`
-// and email references in the form `&lt;mail-to@some.body&gt;`, and these will
+// The text may contain links in this format `<http://www.some.place/>`
+// and email references in the form `<mail-to@some.body>`, and these will
 // be converted into clickable links.
 func (x *AboutDialog) SetPropertyLicense(value string) {
 	var v gobject.Value
@@ -704,11 +824,11 @@ func (x *AboutDialog) SetPropertyLicense(value string) {
 // otherwise the text itself must contain the intended linebreaks.
 //
 // When setting this property to a non-`NULL` value, the
-// [property@Gtk.AboutDialog:license-type] property is set to
-// [enum@Gtk.License.custom] as a side effect.
+// AboutDialog:license-type property is set to
+// [LicenseCustomValue] as a side effect.
 //
-// The text may contain links in this format `&lt;http://www.some.place/&gt;`
-// and email references in the form `&lt;mail-to@some.body&gt;`, and these will
+// The text may contain links in this format `<http://www.some.place/>`
+// and email references in the form `<mail-to@some.body>`, and these will
 // be converted into clickable links.
 func (x *AboutDialog) GetPropertyLicense() string {
 	var v gobject.Value
@@ -719,7 +839,7 @@ func (x *AboutDialog) GetPropertyLicense() string {
 // SetPropertyLogoIconName sets the "logo-icon-name" property.
 // A named icon to use as the logo for the about box.
 //
-// This property overrides the [property@Gtk.AboutDialog:logo] property.
+// This property overrides the AboutDialog:logo property.
 func (x *AboutDialog) SetPropertyLogoIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -730,7 +850,7 @@ func (x *AboutDialog) SetPropertyLogoIconName(value string) {
 // GetPropertyLogoIconName gets the "logo-icon-name" property.
 // A named icon to use as the logo for the about box.
 //
-// This property overrides the [property@Gtk.AboutDialog:logo] property.
+// This property overrides the AboutDialog:logo property.
 func (x *AboutDialog) GetPropertyLogoIconName() string {
 	var v gobject.Value
 	x.GetProperty("logo-icon-name", &v)
@@ -741,7 +861,7 @@ func (x *AboutDialog) GetPropertyLogoIconName() string {
 // The name of the program.
 //
 // If this is not set, it defaults to the value returned by
-// [func@GLib.get_application_name].
+// [glib.GetApplicationName].
 func (x *AboutDialog) SetPropertyProgramName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -753,7 +873,7 @@ func (x *AboutDialog) SetPropertyProgramName(value string) {
 // The name of the program.
 //
 // If this is not set, it defaults to the value returned by
-// [func@GLib.get_application_name].
+// [glib.GetApplicationName].
 func (x *AboutDialog) GetPropertyProgramName() string {
 	var v gobject.Value
 	x.GetProperty("program-name", &v)
@@ -767,8 +887,8 @@ func (x *AboutDialog) GetPropertyProgramName() string {
 // to use a long multi-paragraph text. Note that the text should contain
 // the intended linebreaks.
 //
-// The text may contain links in this format `&lt;http://www.some.place/&gt;`
-// and email references in the form `&lt;mail-to@some.body&gt;`, and these will
+// The text may contain links in this format `<http://www.some.place/>`
+// and email references in the form `<mail-to@some.body>`, and these will
 // be converted into clickable links.
 func (x *AboutDialog) SetPropertySystemInformation(value string) {
 	var v gobject.Value
@@ -784,8 +904,8 @@ func (x *AboutDialog) SetPropertySystemInformation(value string) {
 // to use a long multi-paragraph text. Note that the text should contain
 // the intended linebreaks.
 //
-// The text may contain links in this format `&lt;http://www.some.place/&gt;`
-// and email references in the form `&lt;mail-to@some.body&gt;`, and these will
+// The text may contain links in this format `<http://www.some.place/>`
+// and email references in the form `<mail-to@some.body>`, and these will
 // be converted into clickable links.
 func (x *AboutDialog) GetPropertySystemInformation() string {
 	var v gobject.Value
@@ -895,28 +1015,50 @@ func (x *AboutDialog) GetPropertyWrapLicense() bool {
 // Emitted every time a URL is activated.
 //
 // Applications may connect to it to override the default behaviour,
-// which is to call [method@Gtk.FileLauncher.launch].
+// which is to call [FileLauncher.Launch].
+//
+// Deprecated: use ConnectActivateLinkFunc, which also accepts method values and closures.
 func (x *AboutDialog) ConnectActivateLink(cb *func(AboutDialog, string) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, UriVarp string) bool {
+			defer core.RecoverPanic()
+			fa := AboutDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, UriVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-link", cbRefPtr, cbPtr)
+}
+
+var xAboutDialogActivateLinkTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, UriVarp string) bool {
+func xAboutDialogActivateLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, UriVarp string, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AboutDialog, string) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AboutDialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, UriVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateLinkFunc connects cb to the "activate-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AboutDialog) ConnectActivateLinkFunc(cb func(AboutDialog, string) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-link", &xAboutDialogActivateLinkTrampoline, xAboutDialogActivateLinkNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1080,7 +1222,7 @@ func (x *AboutDialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1088,14 +1230,13 @@ func (x *AboutDialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1119,19 +1260,17 @@ func (x *AboutDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1152,7 +1291,7 @@ func (x *AboutDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1161,14 +1300,12 @@ func (x *AboutDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AboutDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1187,10 +1324,10 @@ func (x *AboutDialog) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AboutDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1227,10 +1364,10 @@ func (x *AboutDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *AboutDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -1274,7 +1411,7 @@ func (x *AboutDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *AboutDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -1290,13 +1427,13 @@ func (x *AboutDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *AboutDialog) SetFocus(FocusVar *Widget) {
 
@@ -1309,7 +1446,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1318,44 +1455,44 @@ func init() {
 
 	core.PuregoSafeRegister(&xLicenseGLibType, libs, "gtk_license_get_type")
 
-	core.PuregoSafeRegister(&xShowAboutDialog, libs, "gtk_show_about_dialog")
+	core.PuregoRegisterSince(&xShowAboutDialog, libs, "gtk_show_about_dialog", "GTK", "")
 
 	core.PuregoSafeRegister(&xAboutDialogGLibType, libs, "gtk_about_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewAboutDialog, libs, "gtk_about_dialog_new")
-
-	core.PuregoSafeRegister(&xAboutDialogAddCreditSection, libs, "gtk_about_dialog_add_credit_section")
-	core.PuregoSafeRegister(&xAboutDialogGetArtists, libs, "gtk_about_dialog_get_artists")
-	core.PuregoSafeRegister(&xAboutDialogGetAuthors, libs, "gtk_about_dialog_get_authors")
-	core.PuregoSafeRegister(&xAboutDialogGetComments, libs, "gtk_about_dialog_get_comments")
-	core.PuregoSafeRegister(&xAboutDialogGetCopyright, libs, "gtk_about_dialog_get_copyright")
-	core.PuregoSafeRegister(&xAboutDialogGetDocumenters, libs, "gtk_about_dialog_get_documenters")
-	core.PuregoSafeRegister(&xAboutDialogGetLicense, libs, "gtk_about_dialog_get_license")
-	core.PuregoSafeRegister(&xAboutDialogGetLicenseType, libs, "gtk_about_dialog_get_license_type")
-	core.PuregoSafeRegister(&xAboutDialogGetLogo, libs, "gtk_about_dialog_get_logo")
-	core.PuregoSafeRegister(&xAboutDialogGetLogoIconName, libs, "gtk_about_dialog_get_logo_icon_name")
-	core.PuregoSafeRegister(&xAboutDialogGetProgramName, libs, "gtk_about_dialog_get_program_name")
-	core.PuregoSafeRegister(&xAboutDialogGetSystemInformation, libs, "gtk_about_dialog_get_system_information")
-	core.PuregoSafeRegister(&xAboutDialogGetTranslatorCredits, libs, "gtk_about_dialog_get_translator_credits")
-	core.PuregoSafeRegister(&xAboutDialogGetVersion, libs, "gtk_about_dialog_get_version")
-	core.PuregoSafeRegister(&xAboutDialogGetWebsite, libs, "gtk_about_dialog_get_website")
-	core.PuregoSafeRegister(&xAboutDialogGetWebsiteLabel, libs, "gtk_about_dialog_get_website_label")
-	core.PuregoSafeRegister(&xAboutDialogGetWrapLicense, libs, "gtk_about_dialog_get_wrap_license")
-	core.PuregoSafeRegister(&xAboutDialogSetArtists, libs, "gtk_about_dialog_set_artists")
-	core.PuregoSafeRegister(&xAboutDialogSetAuthors, libs, "gtk_about_dialog_set_authors")
-	core.PuregoSafeRegister(&xAboutDialogSetComments, libs, "gtk_about_dialog_set_comments")
-	core.PuregoSafeRegister(&xAboutDialogSetCopyright, libs, "gtk_about_dialog_set_copyright")
-	core.PuregoSafeRegister(&xAboutDialogSetDocumenters, libs, "gtk_about_dialog_set_documenters")
-	core.PuregoSafeRegister(&xAboutDialogSetLicense, libs, "gtk_about_dialog_set_license")
-	core.PuregoSafeRegister(&xAboutDialogSetLicenseType, libs, "gtk_about_dialog_set_license_type")
-	core.PuregoSafeRegister(&xAboutDialogSetLogo, libs, "gtk_about_dialog_set_logo")
-	core.PuregoSafeRegister(&xAboutDialogSetLogoIconName, libs, "gtk_about_dialog_set_logo_icon_name")
-	core.PuregoSafeRegister(&xAboutDialogSetProgramName, libs, "gtk_about_dialog_set_program_name")
-	core.PuregoSafeRegister(&xAboutDialogSetSystemInformation, libs, "gtk_about_dialog_set_system_information")
-	core.PuregoSafeRegister(&xAboutDialogSetTranslatorCredits, libs, "gtk_about_dialog_set_translator_credits")
-	core.PuregoSafeRegister(&xAboutDialogSetVersion, libs, "gtk_about_dialog_set_version")
-	core.PuregoSafeRegister(&xAboutDialogSetWebsite, libs, "gtk_about_dialog_set_website")
-	core.PuregoSafeRegister(&xAboutDialogSetWebsiteLabel, libs, "gtk_about_dialog_set_website_label")
-	core.PuregoSafeRegister(&xAboutDialogSetWrapLicense, libs, "gtk_about_dialog_set_wrap_license")
+	core.PuregoRegisterSince(&xNewAboutDialog, libs, "gtk_about_dialog_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAboutDialogAddCreditSection, libs, "gtk_about_dialog_add_credit_section", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetArtists, libs, "gtk_about_dialog_get_artists", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetAuthors, libs, "gtk_about_dialog_get_authors", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetComments, libs, "gtk_about_dialog_get_comments", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetCopyright, libs, "gtk_about_dialog_get_copyright", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetDocumenters, libs, "gtk_about_dialog_get_documenters", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetLicense, libs, "gtk_about_dialog_get_license", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetLicenseType, libs, "gtk_about_dialog_get_license_type", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetLogo, libs, "gtk_about_dialog_get_logo", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetLogoIconName, libs, "gtk_about_dialog_get_logo_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetProgramName, libs, "gtk_about_dialog_get_program_name", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetSystemInformation, libs, "gtk_about_dialog_get_system_information", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetTranslatorCredits, libs, "gtk_about_dialog_get_translator_credits", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetVersion, libs, "gtk_about_dialog_get_version", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetWebsite, libs, "gtk_about_dialog_get_website", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetWebsiteLabel, libs, "gtk_about_dialog_get_website_label", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogGetWrapLicense, libs, "gtk_about_dialog_get_wrap_license", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetArtists, libs, "gtk_about_dialog_set_artists", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetAuthors, libs, "gtk_about_dialog_set_authors", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetComments, libs, "gtk_about_dialog_set_comments", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetCopyright, libs, "gtk_about_dialog_set_copyright", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetDocumenters, libs, "gtk_about_dialog_set_documenters", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetLicense, libs, "gtk_about_dialog_set_license", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetLicenseType, libs, "gtk_about_dialog_set_license_type", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetLogo, libs, "gtk_about_dialog_set_logo", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetLogoIconName, libs, "gtk_about_dialog_set_logo_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetProgramName, libs, "gtk_about_dialog_set_program_name", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetSystemInformation, libs, "gtk_about_dialog_set_system_information", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetTranslatorCredits, libs, "gtk_about_dialog_set_translator_credits", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetVersion, libs, "gtk_about_dialog_set_version", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetWebsite, libs, "gtk_about_dialog_set_website", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetWebsiteLabel, libs, "gtk_about_dialog_set_website_label", "GTK", "")
+	core.PuregoRegisterSince(&xAboutDialogSetWrapLicense, libs, "gtk_about_dialog_set_wrap_license", "GTK", "")
 
 }
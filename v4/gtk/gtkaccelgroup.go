@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 )
@@ -37,9 +36,9 @@ var xAcceleratorGetLabelWithKeycode func(uintptr, uint, uint, gdk.ModifierType)
 //
 // The string may be translated.
 //
-// This function is similar to [func@Gtk.accelerator_get_label],
+// This function is similar to [AcceleratorGetLabel],
 // but handling keycodes. This is only useful for system-level
-// components, applications should use [func@Gtk.accelerator_get_label]
+// components, applications should use [AcceleratorGetLabel]
 // instead.
 func AcceleratorGetLabelWithKeycode(DisplayVar *gdk.Display, AcceleratorKeyVar uint, KeycodeVar uint, AcceleratorModsVar gdk.ModifierType) string {
 
@@ -50,13 +49,13 @@ func AcceleratorGetLabelWithKeycode(DisplayVar *gdk.Display, AcceleratorKeyVar u
 var xAcceleratorName func(uint, gdk.ModifierType) string
 
 // Converts an accelerator keyval and modifier mask into a string
-// that can be parsed by [func@Gtk.accelerator_parse].
+// that can be parsed by [AcceleratorParse].
 //
 // For example, if you pass in `GDK_KEY_q` and `GDK_CONTROL_MASK`,
-// this function returns `&lt;Control&gt;q`.
+// this function returns `<Control>q`.
 //
 // If you need to display accelerators in the user interface,
-// see [func@Gtk.accelerator_get_label].
+// see [AcceleratorGetLabel].
 func AcceleratorName(AcceleratorKeyVar uint, AcceleratorModsVar gdk.ModifierType) string {
 
 	cret := xAcceleratorName(AcceleratorKeyVar, AcceleratorModsVar)
@@ -66,11 +65,11 @@ func AcceleratorName(AcceleratorKeyVar uint, AcceleratorModsVar gdk.ModifierType
 var xAcceleratorNameWithKeycode func(uintptr, uint, uint, gdk.ModifierType) string
 
 // Converts an accelerator keyval and modifier mask
-// into a string that can be parsed by [func@Gtk.accelerator_parse_with_keycode].
+// into a string that can be parsed by [AcceleratorParseWithKeycode].
 //
-// This is similar to [func@Gtk.accelerator_name] but handling keycodes.
+// This is similar to [AcceleratorName] but handling keycodes.
 // This is only useful for system-level components, applications
-// should use [func@Gtk.accelerator_name] instead.
+// should use [AcceleratorName] instead.
 func AcceleratorNameWithKeycode(DisplayVar *gdk.Display, AcceleratorKeyVar uint, KeycodeVar uint, AcceleratorModsVar gdk.ModifierType) string {
 
 	cret := xAcceleratorNameWithKeycode(DisplayVar.GoPointer(), AcceleratorKeyVar, KeycodeVar, AcceleratorModsVar)
@@ -81,26 +80,26 @@ var xAcceleratorParse func(string, *uint, *gdk.ModifierType) bool
 
 // Parses a string representing an accelerator.
 //
-// The format looks like “`&lt;Control&gt;a`” or “`&lt;Shift&gt;&lt;Alt&gt;F1`”.
+// The format looks like “`<Control>a`” or “`<Shift><Alt>F1`”.
 //
 // The parser is fairly liberal and allows lower or upper case, and also
-// abbreviations such as “`&lt;Ctl&gt;`” and “`&lt;Ctrl&gt;`”.
+// abbreviations such as “`<Ctl>`” and “`<Ctrl>`”.
 //
-// Key names are parsed using [func@Gdk.keyval_from_name]. For character keys
+// Key names are parsed using [gdk.KeyvalFromName]. For character keys
 // the name is not the symbol, but the lowercase name, e.g. one would use
-// “`&lt;Ctrl&gt;minus`” instead of “`&lt;Ctrl&gt;-`”.
+// “`<Ctrl>minus`” instead of “`<Ctrl>-`”.
 //
-// Modifiers are enclosed in angular brackets `&lt;&gt;`, and match the
-// [flags@Gdk.ModifierType] mask:
+// Modifiers are enclosed in angular brackets `<>`, and match the
+// [gdk.ModifierType] mask:
 //
-// - `&lt;Shift&gt;` for `GDK_SHIFT_MASK`
-// - `&lt;Ctrl&gt;` for `GDK_CONTROL_MASK`
-// - `&lt;Alt&gt;` for `GDK_ALT_MASK`
-// - `&lt;Meta&gt;` for `GDK_META_MASK`
-// - `&lt;Super&gt;` for `GDK_SUPER_MASK`
-// - `&lt;Hyper&gt;` for `GDK_HYPER_MASK`
+// - `<Shift>` for `GDK_SHIFT_MASK`
+// - `<Ctrl>` for `GDK_CONTROL_MASK`
+// - `<Alt>` for `GDK_ALT_MASK`
+// - `<Meta>` for `GDK_META_MASK`
+// - `<Super>` for `GDK_SUPER_MASK`
+// - `<Hyper>` for `GDK_HYPER_MASK`
 //
-// If the parse operation fails, @accelerator_key and @accelerator_mods will
+// If the parse operation fails, accelerator_key and accelerator_mods will
 // be set to 0 (zero).
 func AcceleratorParse(AcceleratorVar string, AcceleratorKeyVar *uint, AcceleratorModsVar *gdk.ModifierType) bool {
 
@@ -112,18 +111,18 @@ var xAcceleratorParseWithKeycode func(string, uintptr, *uint, *[]uint, *gdk.Modi
 
 // Parses a string representing an accelerator.
 //
-// This is similar to [func@Gtk.accelerator_parse] but handles keycodes as
+// This is similar to [AcceleratorParse] but handles keycodes as
 // well. This is only useful for system-level components, applications should
-// use [func@Gtk.accelerator_parse] instead.
+// use [AcceleratorParse] instead.
 //
-// If @accelerator_codes is given and the result stored in it is non-%NULL,
-// the result must be freed with g_free().
+// If accelerator_codes is given and the result stored in it is non-nil,
+// the result must be freed with [glib.Free].
 //
-// If a keycode is present in the accelerator and no @accelerator_codes
+// If a keycode is present in the accelerator and no accelerator_codes
 // is given, the parse will fail.
 //
-// If the parse fails, @accelerator_key, @accelerator_mods and
-// @accelerator_codes will be set to 0 (zero).
+// If the parse fails, accelerator_key, accelerator_mods and
+// accelerator_codes will be set to 0 (zero).
 func AcceleratorParseWithKeycode(AcceleratorVar string, DisplayVar *gdk.Display, AcceleratorKeyVar *uint, AcceleratorCodesVar *[]uint, AcceleratorModsVar *gdk.ModifierType) bool {
 
 	cret := xAcceleratorParseWithKeycode(AcceleratorVar, DisplayVar.GoPointer(), AcceleratorKeyVar, AcceleratorCodesVar, AcceleratorModsVar)
@@ -149,20 +148,20 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAcceleratorGetDefaultModMask, libs, "gtk_accelerator_get_default_mod_mask")
-	core.PuregoSafeRegister(&xAcceleratorGetLabel, libs, "gtk_accelerator_get_label")
-	core.PuregoSafeRegister(&xAcceleratorGetLabelWithKeycode, libs, "gtk_accelerator_get_label_with_keycode")
-	core.PuregoSafeRegister(&xAcceleratorName, libs, "gtk_accelerator_name")
-	core.PuregoSafeRegister(&xAcceleratorNameWithKeycode, libs, "gtk_accelerator_name_with_keycode")
-	core.PuregoSafeRegister(&xAcceleratorParse, libs, "gtk_accelerator_parse")
-	core.PuregoSafeRegister(&xAcceleratorParseWithKeycode, libs, "gtk_accelerator_parse_with_keycode")
-	core.PuregoSafeRegister(&xAcceleratorValid, libs, "gtk_accelerator_valid")
+	core.PuregoRegisterSince(&xAcceleratorGetDefaultModMask, libs, "gtk_accelerator_get_default_mod_mask", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorGetLabel, libs, "gtk_accelerator_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorGetLabelWithKeycode, libs, "gtk_accelerator_get_label_with_keycode", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorName, libs, "gtk_accelerator_name", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorNameWithKeycode, libs, "gtk_accelerator_name_with_keycode", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorParse, libs, "gtk_accelerator_parse", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorParseWithKeycode, libs, "gtk_accelerator_parse_with_keycode", "GTK", "")
+	core.PuregoRegisterSince(&xAcceleratorValid, libs, "gtk_accelerator_valid", "GTK", "")
 
 }
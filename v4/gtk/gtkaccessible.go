@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,7 +16,7 @@ import (
 type AccessibleInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetAtContext uintptr
 
@@ -43,7 +43,8 @@ func (x *AccessibleInterface) OverrideGetAtContext(cb func(Accessible) *ATContex
 	if cb == nil {
 		x.xGetAtContext = 0
 	} else {
-		x.xGetAtContext = purego.NewCallback(func(SelfVarp uintptr) uintptr {
+		x.xGetAtContext = core.NewCallback(func(SelfVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AccessibleBase{Ptr: SelfVarp})
 			if ret == nil {
 				return 0
@@ -62,7 +63,7 @@ func (x *AccessibleInterface) GetGetAtContext() func(Accessible) *ATContext {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetAtContext)
+	core.RegisterFunc(&rawCallback, x.xGetAtContext)
 	return func(SelfVar Accessible) *ATContext {
 		rawRet := rawCallback(SelfVar.GoPointer())
 		if rawRet == 0 {
@@ -80,7 +81,8 @@ func (x *AccessibleInterface) OverrideGetPlatformState(cb func(Accessible, Acces
 	if cb == nil {
 		x.xGetPlatformState = 0
 	} else {
-		x.xGetPlatformState = purego.NewCallback(func(SelfVarp uintptr, StateVarp AccessiblePlatformState) bool {
+		x.xGetPlatformState = core.NewCallback(func(SelfVarp uintptr, StateVarp AccessiblePlatformState) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleBase{Ptr: SelfVarp}, StateVarp)
 		})
 	}
@@ -93,7 +95,7 @@ func (x *AccessibleInterface) GetGetPlatformState() func(Accessible, AccessibleP
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, StateVarp AccessiblePlatformState) bool
-	purego.RegisterFunc(&rawCallback, x.xGetPlatformState)
+	core.RegisterFunc(&rawCallback, x.xGetPlatformState)
 	return func(SelfVar Accessible, StateVar AccessiblePlatformState) bool {
 		return rawCallback(SelfVar.GoPointer(), StateVar)
 	}
@@ -104,7 +106,8 @@ func (x *AccessibleInterface) OverrideGetAccessibleParent(cb func(Accessible) *A
 	if cb == nil {
 		x.xGetAccessibleParent = 0
 	} else {
-		x.xGetAccessibleParent = purego.NewCallback(func(SelfVarp uintptr) uintptr {
+		x.xGetAccessibleParent = core.NewCallback(func(SelfVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AccessibleBase{Ptr: SelfVarp})
 			if ret == nil {
 				return 0
@@ -120,7 +123,7 @@ func (x *AccessibleInterface) GetGetAccessibleParent() func(Accessible) *Accessi
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetAccessibleParent)
+	core.RegisterFunc(&rawCallback, x.xGetAccessibleParent)
 	return func(SelfVar Accessible) *AccessibleBase {
 		rawRet := rawCallback(SelfVar.GoPointer())
 		if rawRet == 0 {
@@ -137,7 +140,8 @@ func (x *AccessibleInterface) OverrideGetFirstAccessibleChild(cb func(Accessible
 	if cb == nil {
 		x.xGetFirstAccessibleChild = 0
 	} else {
-		x.xGetFirstAccessibleChild = purego.NewCallback(func(SelfVarp uintptr) uintptr {
+		x.xGetFirstAccessibleChild = core.NewCallback(func(SelfVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AccessibleBase{Ptr: SelfVarp})
 			if ret == nil {
 				return 0
@@ -153,7 +157,7 @@ func (x *AccessibleInterface) GetGetFirstAccessibleChild() func(Accessible) *Acc
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFirstAccessibleChild)
+	core.RegisterFunc(&rawCallback, x.xGetFirstAccessibleChild)
 	return func(SelfVar Accessible) *AccessibleBase {
 		rawRet := rawCallback(SelfVar.GoPointer())
 		if rawRet == 0 {
@@ -170,7 +174,8 @@ func (x *AccessibleInterface) OverrideGetNextAccessibleSibling(cb func(Accessibl
 	if cb == nil {
 		x.xGetNextAccessibleSibling = 0
 	} else {
-		x.xGetNextAccessibleSibling = purego.NewCallback(func(SelfVarp uintptr) uintptr {
+		x.xGetNextAccessibleSibling = core.NewCallback(func(SelfVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&AccessibleBase{Ptr: SelfVarp})
 			if ret == nil {
 				return 0
@@ -186,7 +191,7 @@ func (x *AccessibleInterface) GetGetNextAccessibleSibling() func(Accessible) *Ac
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetNextAccessibleSibling)
+	core.RegisterFunc(&rawCallback, x.xGetNextAccessibleSibling)
 	return func(SelfVar Accessible) *AccessibleBase {
 		rawRet := rawCallback(SelfVar.GoPointer())
 		if rawRet == 0 {
@@ -203,7 +208,8 @@ func (x *AccessibleInterface) OverrideGetBounds(cb func(Accessible, *int, *int,
 	if cb == nil {
 		x.xGetBounds = 0
 	} else {
-		x.xGetBounds = purego.NewCallback(func(SelfVarp uintptr, XVarp *int, YVarp *int, WidthVarp *int, HeightVarp *int) bool {
+		x.xGetBounds = core.NewCallback(func(SelfVarp uintptr, XVarp *int, YVarp *int, WidthVarp *int, HeightVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleBase{Ptr: SelfVarp}, XVarp, YVarp, WidthVarp, HeightVarp)
 		})
 	}
@@ -215,13 +221,13 @@ func (x *AccessibleInterface) GetGetBounds() func(Accessible, *int, *int, *int,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, XVarp *int, YVarp *int, WidthVarp *int, HeightVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xGetBounds)
+	core.RegisterFunc(&rawCallback, x.xGetBounds)
 	return func(SelfVar Accessible, XVar *int, YVar *int, WidthVar *int, HeightVar *int) bool {
 		return rawCallback(SelfVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
 	}
 }
 
-// Wraps a list of references to [iface@Gtk.Accessible] objects.
+// Wraps a list of references to [Accessible] objects.
 type AccessibleList struct {
 	_ structs.HostLayout
 }
@@ -236,6 +242,21 @@ func (x *AccessibleList) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the AccessibleList made with g_boxed_copy, release it with Free.
+func (x *AccessibleList) Copy() *AccessibleList {
+	cret := gobject.BoxedCopy(AccessibleListGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*AccessibleList)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the AccessibleList with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *AccessibleList) Free() {
+	gobject.BoxedFree(AccessibleListGLibType(), x.GoPointer())
+}
+
 var xNewAccessibleListFromArray func(uintptr, uint) *AccessibleList
 
 // Allocates a new list of accessible objects.
@@ -268,23 +289,23 @@ func (x *AccessibleList) GetObjects() *glib.List {
 //
 // Every accessible implementation has:
 //
-//   - a “role”, represented by a value of the [enum@Gtk.AccessibleRole] enumeration
-//   - “attributes”, represented by a set of [enum@Gtk.AccessibleState],
-//     [enum@Gtk.AccessibleProperty] and [enum@Gtk.AccessibleRelation] values
+//   - a “role”, represented by a value of the [AccessibleRole] enumeration
+//   - “attributes”, represented by a set of [AccessibleState],
+//     [AccessibleProperty] and [AccessibleRelation] values
 //
 // The role cannot be changed after instantiating a `GtkAccessible`
 // implementation.
 //
 // The attributes are updated every time a UI element's state changes in
 // a way that should be reflected by assistive technologies. For instance,
-// if a `GtkWidget` visibility changes, the %GTK_ACCESSIBLE_STATE_HIDDEN
-// state will also change to reflect the [property@Gtk.Widget:visible] property.
+// if a `GtkWidget` visibility changes, the [AccessibleStateHiddenValue]
+// state will also change to reflect the [Widget.GetPropertyVisible] property.
 //
 // Every accessible implementation is part of a tree of accessible objects.
 // Normally, this tree corresponds to the widget tree, but can be customized
-// by reimplementing the [vfunc@Gtk.Accessible.get_accessible_parent],
-// [vfunc@Gtk.Accessible.get_first_accessible_child] and
-// [vfunc@Gtk.Accessible.get_next_accessible_sibling] virtual functions.
+// by reimplementing the Accessible.get_accessible_parent,
+// Accessible.get_first_accessible_child and
+// Accessible.get_next_accessible_sibling virtual functions.
 //
 // Note that you can not create a top-level accessible object as of now,
 // which means that you must always have a parent accessible object.
@@ -292,8 +313,8 @@ func (x *AccessibleList) GetObjects() *glib.List {
 // Also note that when an accessible object does not correspond to a widget,
 // and it has children, whose implementation you don't control,
 // it is necessary to ensure the correct shape of the a11y tree
-// by calling [method@Gtk.Accessible.set_accessible_parent] and
-// updating the sibling by [method@Gtk.Accessible.update_next_accessible_sibling].
+// by calling [Accessible.SetAccessibleParent] and
+// updating the sibling by [Accessible.UpdateNextAccessibleSibling].
 type Accessible interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -340,6 +361,69 @@ func (x *AccessibleBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementAccessible adds the Accessible interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetAtContext() *ATContext
+//   - GetPlatformState(AccessiblePlatformState) bool
+//   - GetAccessibleParent() *AccessibleBase
+//   - GetFirstAccessibleChild() *AccessibleBase
+//   - GetNextAccessibleSibling() *AccessibleBase
+//   - GetBounds(*int, *int, *int, *int) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AccessibleInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAccessible[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AccessibleGLibType(), func(iface *AccessibleInterface) {
+		if _, ok := any(zero).(interface{ GetAtContext() *ATContext }); ok {
+			iface.OverrideGetAtContext(func(self Accessible) *ATContext {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetAtContext() *ATContext })
+				return impl.GetAtContext()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetPlatformState(AccessiblePlatformState) bool
+		}); ok {
+			iface.OverrideGetPlatformState(func(self Accessible, arg0 AccessiblePlatformState) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetPlatformState(AccessiblePlatformState) bool
+				})
+				return impl.GetPlatformState(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetAccessibleParent() *AccessibleBase }); ok {
+			iface.OverrideGetAccessibleParent(func(self Accessible) *AccessibleBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetAccessibleParent() *AccessibleBase })
+				return impl.GetAccessibleParent()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFirstAccessibleChild() *AccessibleBase }); ok {
+			iface.OverrideGetFirstAccessibleChild(func(self Accessible) *AccessibleBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFirstAccessibleChild() *AccessibleBase })
+				return impl.GetFirstAccessibleChild()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetNextAccessibleSibling() *AccessibleBase }); ok {
+			iface.OverrideGetNextAccessibleSibling(func(self Accessible) *AccessibleBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetNextAccessibleSibling() *AccessibleBase })
+				return impl.GetNextAccessibleSibling()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetBounds(*int, *int, *int, *int) bool
+		}); ok {
+			iface.OverrideGetBounds(func(self Accessible, arg0 *int, arg1 *int, arg2 *int, arg3 *int) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetBounds(*int, *int, *int, *int) bool
+				})
+				return impl.GetBounds(arg0, arg1, arg2, arg3)
+			})
+		}
+	})
+}
+
 // Requests the user's screen reader to announce the given message.
 //
 // This kind of notification is useful for messages that
@@ -501,7 +585,7 @@ func (x *AccessibleBase) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -509,14 +593,13 @@ func (x *AccessibleBase) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AccessibleBase) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -540,19 +623,17 @@ func (x *AccessibleBase) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AccessibleBase) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -573,7 +654,7 @@ func (x *AccessibleBase) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -582,14 +663,12 @@ func (x *AccessibleBase) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AccessibleBase) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -630,7 +709,7 @@ var XGtkAccessibleUpdateState func(uintptr, AccessibleState, ...interface{})
 var XGtkAccessibleUpdateStateValue func(uintptr, int, []AccessibleState, []gobject.Value)
 
 // The various platform states which can be queried
-// using [method@Gtk.Accessible.get_platform_state].
+// using [Accessible.GetPlatformState].
 type AccessiblePlatformState int
 
 var xAccessiblePlatformStateGLibType func() types.GType
@@ -649,12 +728,51 @@ const (
 	AccessiblePlatformStateActiveValue AccessiblePlatformState = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessiblePlatformState) String() string {
+	switch x {
+	case AccessiblePlatformStateFocusableValue:
+		return "GTK_ACCESSIBLE_PLATFORM_STATE_FOCUSABLE"
+	case AccessiblePlatformStateFocusedValue:
+		return "GTK_ACCESSIBLE_PLATFORM_STATE_FOCUSED"
+	case AccessiblePlatformStateActiveValue:
+		return "GTK_ACCESSIBLE_PLATFORM_STATE_ACTIVE"
+	}
+	return fmt.Sprintf("AccessiblePlatformState(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessiblePlatformState) Nick() string {
+	switch x {
+	case AccessiblePlatformStateFocusableValue:
+		return "focusable"
+	case AccessiblePlatformStateFocusedValue:
+		return "focused"
+	case AccessiblePlatformStateActiveValue:
+		return "active"
+	}
+	return ""
+}
+
+// AccessiblePlatformStateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessiblePlatformStateFromString(s string) (AccessiblePlatformState, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_PLATFORM_STATE_FOCUSABLE", "focusable":
+		return AccessiblePlatformStateFocusableValue, true
+	case "GTK_ACCESSIBLE_PLATFORM_STATE_FOCUSED", "focused":
+		return AccessiblePlatformStateFocusedValue, true
+	case "GTK_ACCESSIBLE_PLATFORM_STATE_ACTIVE", "active":
+		return AccessiblePlatformStateActiveValue, true
+	}
+	return 0, false
+}
+
 var xAccessiblePropertyInitValue func(AccessibleProperty, *gobject.Value)
 
-// Initializes @value with the appropriate type for the @property.
+// Initializes value with the appropriate type for the property.
 //
 // This function is mostly meant for language bindings, in conjunction
-// with gtk_accessible_update_property_value().
+// with [Accessible.UpdatePropertyValue].
 func AccessiblePropertyInitValue(PropertyVar AccessibleProperty, ValueVar *gobject.Value) {
 
 	xAccessiblePropertyInitValue(PropertyVar, ValueVar)
@@ -663,10 +781,10 @@ func AccessiblePropertyInitValue(PropertyVar AccessibleProperty, ValueVar *gobje
 
 var xAccessibleRelationInitValue func(AccessibleRelation, *gobject.Value)
 
-// Initializes @value with the appropriate type for the @relation.
+// Initializes value with the appropriate type for the relation.
 //
 // This function is mostly meant for language bindings, in conjunction
-// with gtk_accessible_update_relation_value().
+// with [Accessible.UpdateRelationValue].
 func AccessibleRelationInitValue(RelationVar AccessibleRelation, ValueVar *gobject.Value) {
 
 	xAccessibleRelationInitValue(RelationVar, ValueVar)
@@ -675,7 +793,7 @@ func AccessibleRelationInitValue(RelationVar AccessibleRelation, ValueVar *gobje
 
 var xAccessibleStateInitValue func(AccessibleState, *gobject.Value)
 
-// Initializes @value with the appropriate type for the @state.
+// Initializes value with the appropriate type for the state.
 //
 // This function is mostly meant for language bindings, in conjunction
 // with gtk_accessible_update_relation_state().
@@ -690,7 +808,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -699,38 +817,40 @@ func init() {
 
 	core.PuregoSafeRegister(&xAccessiblePlatformStateGLibType, libs, "gtk_accessible_platform_state_get_type")
 
-	core.PuregoSafeRegister(&xAccessiblePropertyInitValue, libs, "gtk_accessible_property_init_value")
-	core.PuregoSafeRegister(&xAccessibleRelationInitValue, libs, "gtk_accessible_relation_init_value")
-	core.PuregoSafeRegister(&xAccessibleStateInitValue, libs, "gtk_accessible_state_init_value")
+	core.PuregoRegisterSince(&xAccessiblePropertyInitValue, libs, "gtk_accessible_property_init_value", "GTK", "")
+	core.PuregoRegisterSince(&xAccessibleRelationInitValue, libs, "gtk_accessible_relation_init_value", "GTK", "")
+	core.PuregoRegisterSince(&xAccessibleStateInitValue, libs, "gtk_accessible_state_init_value", "GTK", "")
 
 	core.PuregoSafeRegister(&xAccessibleListGLibType, libs, "gtk_accessible_list_get_type")
 
-	core.PuregoSafeRegister(&xNewAccessibleListFromArray, libs, "gtk_accessible_list_new_from_array")
-	core.PuregoSafeRegister(&xNewAccessibleListFromList, libs, "gtk_accessible_list_new_from_list")
+	core.PuregoRegisterSince(&xNewAccessibleListFromArray, libs, "gtk_accessible_list_new_from_array", "GTK", "4.14")
+	core.PuregoRegisterSince(&xNewAccessibleListFromList, libs, "gtk_accessible_list_new_from_list", "GTK", "4.14")
 
-	core.PuregoSafeRegister(&xAccessibleListGetObjects, libs, "gtk_accessible_list_get_objects")
+	core.PuregoRegisterSince(&xAccessibleListGetObjects, libs, "gtk_accessible_list_get_objects", "GTK", "4.14")
 
 	core.PuregoSafeRegister(&xAccessibleGLibType, libs, "gtk_accessible_get_type")
 
-	core.PuregoSafeRegister(&XGtkAccessibleAnnounce, libs, "gtk_accessible_announce")
-	core.PuregoSafeRegister(&XGtkAccessibleGetAccessibleParent, libs, "gtk_accessible_get_accessible_parent")
-	core.PuregoSafeRegister(&XGtkAccessibleGetAccessibleRole, libs, "gtk_accessible_get_accessible_role")
-	core.PuregoSafeRegister(&XGtkAccessibleGetAtContext, libs, "gtk_accessible_get_at_context")
-	core.PuregoSafeRegister(&XGtkAccessibleGetBounds, libs, "gtk_accessible_get_bounds")
-	core.PuregoSafeRegister(&XGtkAccessibleGetFirstAccessibleChild, libs, "gtk_accessible_get_first_accessible_child")
-	core.PuregoSafeRegister(&XGtkAccessibleGetNextAccessibleSibling, libs, "gtk_accessible_get_next_accessible_sibling")
-	core.PuregoSafeRegister(&XGtkAccessibleGetPlatformState, libs, "gtk_accessible_get_platform_state")
-	core.PuregoSafeRegister(&XGtkAccessibleResetProperty, libs, "gtk_accessible_reset_property")
-	core.PuregoSafeRegister(&XGtkAccessibleResetRelation, libs, "gtk_accessible_reset_relation")
-	core.PuregoSafeRegister(&XGtkAccessibleResetState, libs, "gtk_accessible_reset_state")
-	core.PuregoSafeRegister(&XGtkAccessibleSetAccessibleParent, libs, "gtk_accessible_set_accessible_parent")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateNextAccessibleSibling, libs, "gtk_accessible_update_next_accessible_sibling")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdatePlatformState, libs, "gtk_accessible_update_platform_state")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateProperty, libs, "gtk_accessible_update_property")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdatePropertyValue, libs, "gtk_accessible_update_property_value")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateRelation, libs, "gtk_accessible_update_relation")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateRelationValue, libs, "gtk_accessible_update_relation_value")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateState, libs, "gtk_accessible_update_state")
-	core.PuregoSafeRegister(&XGtkAccessibleUpdateStateValue, libs, "gtk_accessible_update_state_value")
+	gobject.CheckClassSize("GtkAccessibleInterface", xAccessibleGLibType, unsafe.Sizeof(AccessibleInterface{}))
+
+	core.PuregoRegisterSince(&XGtkAccessibleAnnounce, libs, "gtk_accessible_announce", "GTK", "4.14")
+	core.PuregoRegisterSince(&XGtkAccessibleGetAccessibleParent, libs, "gtk_accessible_get_accessible_parent", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleGetAccessibleRole, libs, "gtk_accessible_get_accessible_role", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleGetAtContext, libs, "gtk_accessible_get_at_context", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleGetBounds, libs, "gtk_accessible_get_bounds", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleGetFirstAccessibleChild, libs, "gtk_accessible_get_first_accessible_child", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleGetNextAccessibleSibling, libs, "gtk_accessible_get_next_accessible_sibling", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleGetPlatformState, libs, "gtk_accessible_get_platform_state", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleResetProperty, libs, "gtk_accessible_reset_property", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleResetRelation, libs, "gtk_accessible_reset_relation", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleResetState, libs, "gtk_accessible_reset_state", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleSetAccessibleParent, libs, "gtk_accessible_set_accessible_parent", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateNextAccessibleSibling, libs, "gtk_accessible_update_next_accessible_sibling", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdatePlatformState, libs, "gtk_accessible_update_platform_state", "GTK", "4.18")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateProperty, libs, "gtk_accessible_update_property", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdatePropertyValue, libs, "gtk_accessible_update_property_value", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateRelation, libs, "gtk_accessible_update_relation", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateRelationValue, libs, "gtk_accessible_update_relation_value", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateState, libs, "gtk_accessible_update_state", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAccessibleUpdateStateValue, libs, "gtk_accessible_update_state_value", "GTK", "")
 
 }
@@ -5,14 +5,15 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 type AccessibleRangeInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSetCurrentValue uintptr
 }
@@ -26,7 +27,8 @@ func (x *AccessibleRangeInterface) OverrideSetCurrentValue(cb func(AccessibleRan
 	if cb == nil {
 		x.xSetCurrentValue = 0
 	} else {
-		x.xSetCurrentValue = purego.NewCallback(func(SelfVarp uintptr, ValueVarp float64) bool {
+		x.xSetCurrentValue = core.NewCallback(func(SelfVarp uintptr, ValueVarp float64) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleRangeBase{Ptr: SelfVarp}, ValueVarp)
 		})
 	}
@@ -38,7 +40,7 @@ func (x *AccessibleRangeInterface) GetSetCurrentValue() func(AccessibleRange, fl
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ValueVarp float64) bool
-	purego.RegisterFunc(&rawCallback, x.xSetCurrentValue)
+	core.RegisterFunc(&rawCallback, x.xSetCurrentValue)
 	return func(SelfVar AccessibleRange, ValueVar float64) bool {
 		return rawCallback(SelfVar.GoPointer(), ValueVar)
 	}
@@ -94,3 +96,23 @@ func (x *AccessibleRangeBase) GoPointer() uintptr {
 func (x *AccessibleRangeBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
+
+// ImplementAccessibleRange adds the AccessibleRange interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - SetCurrentValue(float64) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AccessibleRangeInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAccessibleRange[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AccessibleRangeGLibType(), func(iface *AccessibleRangeInterface) {
+		if _, ok := any(zero).(interface{ SetCurrentValue(float64) bool }); ok {
+			iface.OverrideSetCurrentValue(func(self AccessibleRange, arg0 float64) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetCurrentValue(float64) bool })
+				return impl.SetCurrentValue(arg0)
+			})
+		}
+	})
+}
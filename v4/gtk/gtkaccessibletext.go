@@ -2,12 +2,13 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 	"github.com/jwijenbergh/puregotk/v4/graphene"
 )
@@ -16,7 +17,7 @@ import (
 type AccessibleTextInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetContents uintptr
 
@@ -44,7 +45,8 @@ func (x *AccessibleTextInterface) OverrideGetContents(cb func(AccessibleText, ui
 	if cb == nil {
 		x.xGetContents = 0
 	} else {
-		x.xGetContents = purego.NewCallback(func(SelfVarp uintptr, StartVarp uint, EndVarp uint) *glib.Bytes {
+		x.xGetContents = core.NewCallback(func(SelfVarp uintptr, StartVarp uint, EndVarp uint) *glib.Bytes {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, StartVarp, EndVarp)
 		})
 	}
@@ -56,7 +58,7 @@ func (x *AccessibleTextInterface) GetGetContents() func(AccessibleText, uint, ui
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, StartVarp uint, EndVarp uint) *glib.Bytes
-	purego.RegisterFunc(&rawCallback, x.xGetContents)
+	core.RegisterFunc(&rawCallback, x.xGetContents)
 	return func(SelfVar AccessibleText, StartVar uint, EndVar uint) *glib.Bytes {
 		return rawCallback(SelfVar.GoPointer(), StartVar, EndVar)
 	}
@@ -67,7 +69,8 @@ func (x *AccessibleTextInterface) OverrideGetContentsAt(cb func(AccessibleText,
 	if cb == nil {
 		x.xGetContentsAt = 0
 	} else {
-		x.xGetContentsAt = purego.NewCallback(func(SelfVarp uintptr, OffsetVarp uint, GranularityVarp AccessibleTextGranularity, StartVarp *uint, EndVarp *uint) *glib.Bytes {
+		x.xGetContentsAt = core.NewCallback(func(SelfVarp uintptr, OffsetVarp uint, GranularityVarp AccessibleTextGranularity, StartVarp *uint, EndVarp *uint) *glib.Bytes {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, OffsetVarp, GranularityVarp, StartVarp, EndVarp)
 		})
 	}
@@ -79,7 +82,7 @@ func (x *AccessibleTextInterface) GetGetContentsAt() func(AccessibleText, uint,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, OffsetVarp uint, GranularityVarp AccessibleTextGranularity, StartVarp *uint, EndVarp *uint) *glib.Bytes
-	purego.RegisterFunc(&rawCallback, x.xGetContentsAt)
+	core.RegisterFunc(&rawCallback, x.xGetContentsAt)
 	return func(SelfVar AccessibleText, OffsetVar uint, GranularityVar AccessibleTextGranularity, StartVar *uint, EndVar *uint) *glib.Bytes {
 		return rawCallback(SelfVar.GoPointer(), OffsetVar, GranularityVar, StartVar, EndVar)
 	}
@@ -90,7 +93,8 @@ func (x *AccessibleTextInterface) OverrideGetCaretPosition(cb func(AccessibleTex
 	if cb == nil {
 		x.xGetCaretPosition = 0
 	} else {
-		x.xGetCaretPosition = purego.NewCallback(func(SelfVarp uintptr) uint {
+		x.xGetCaretPosition = core.NewCallback(func(SelfVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp})
 		})
 	}
@@ -102,7 +106,7 @@ func (x *AccessibleTextInterface) GetGetCaretPosition() func(AccessibleText) uin
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xGetCaretPosition)
+	core.RegisterFunc(&rawCallback, x.xGetCaretPosition)
 	return func(SelfVar AccessibleText) uint {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -113,7 +117,8 @@ func (x *AccessibleTextInterface) OverrideGetSelection(cb func(AccessibleText, *
 	if cb == nil {
 		x.xGetSelection = 0
 	} else {
-		x.xGetSelection = purego.NewCallback(func(SelfVarp uintptr, NRangesVarp *uint, RangesVarp *uintptr) bool {
+		x.xGetSelection = core.NewCallback(func(SelfVarp uintptr, NRangesVarp *uint, RangesVarp *uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, NRangesVarp, RangesVarp)
 		})
 	}
@@ -125,7 +130,7 @@ func (x *AccessibleTextInterface) GetGetSelection() func(AccessibleText, *uint,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, NRangesVarp *uint, RangesVarp *uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xGetSelection)
+	core.RegisterFunc(&rawCallback, x.xGetSelection)
 	return func(SelfVar AccessibleText, NRangesVar *uint, RangesVar *uintptr) bool {
 		return rawCallback(SelfVar.GoPointer(), NRangesVar, RangesVar)
 	}
@@ -136,7 +141,8 @@ func (x *AccessibleTextInterface) OverrideGetAttributes(cb func(AccessibleText,
 	if cb == nil {
 		x.xGetAttributes = 0
 	} else {
-		x.xGetAttributes = purego.NewCallback(func(SelfVarp uintptr, OffsetVarp uint, NRangesVarp *uint, RangesVarp *uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string) bool {
+		x.xGetAttributes = core.NewCallback(func(SelfVarp uintptr, OffsetVarp uint, NRangesVarp *uint, RangesVarp *uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, OffsetVarp, NRangesVarp, RangesVarp, AttributeNamesVarp, AttributeValuesVarp)
 		})
 	}
@@ -148,7 +154,7 @@ func (x *AccessibleTextInterface) GetGetAttributes() func(AccessibleText, uint,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, OffsetVarp uint, NRangesVarp *uint, RangesVarp *uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string) bool
-	purego.RegisterFunc(&rawCallback, x.xGetAttributes)
+	core.RegisterFunc(&rawCallback, x.xGetAttributes)
 	return func(SelfVar AccessibleText, OffsetVar uint, NRangesVar *uint, RangesVar *uintptr, AttributeNamesVar *[]string, AttributeValuesVar *[]string) bool {
 		return rawCallback(SelfVar.GoPointer(), OffsetVar, NRangesVar, RangesVar, AttributeNamesVar, AttributeValuesVar)
 	}
@@ -159,7 +165,8 @@ func (x *AccessibleTextInterface) OverrideGetDefaultAttributes(cb func(Accessibl
 	if cb == nil {
 		x.xGetDefaultAttributes = 0
 	} else {
-		x.xGetDefaultAttributes = purego.NewCallback(func(SelfVarp uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string) {
+		x.xGetDefaultAttributes = core.NewCallback(func(SelfVarp uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string) {
+			defer core.RecoverPanic()
 			cb(&AccessibleTextBase{Ptr: SelfVarp}, AttributeNamesVarp, AttributeValuesVarp)
 		})
 	}
@@ -171,7 +178,7 @@ func (x *AccessibleTextInterface) GetGetDefaultAttributes() func(AccessibleText,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, AttributeNamesVarp *[]string, AttributeValuesVarp *[]string)
-	purego.RegisterFunc(&rawCallback, x.xGetDefaultAttributes)
+	core.RegisterFunc(&rawCallback, x.xGetDefaultAttributes)
 	return func(SelfVar AccessibleText, AttributeNamesVar *[]string, AttributeValuesVar *[]string) {
 		rawCallback(SelfVar.GoPointer(), AttributeNamesVar, AttributeValuesVar)
 	}
@@ -182,7 +189,8 @@ func (x *AccessibleTextInterface) OverrideGetExtents(cb func(AccessibleText, uin
 	if cb == nil {
 		x.xGetExtents = 0
 	} else {
-		x.xGetExtents = purego.NewCallback(func(SelfVarp uintptr, StartVarp uint, EndVarp uint, ExtentsVarp *graphene.Rect) bool {
+		x.xGetExtents = core.NewCallback(func(SelfVarp uintptr, StartVarp uint, EndVarp uint, ExtentsVarp *graphene.Rect) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, StartVarp, EndVarp, ExtentsVarp)
 		})
 	}
@@ -194,7 +202,7 @@ func (x *AccessibleTextInterface) GetGetExtents() func(AccessibleText, uint, uin
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, StartVarp uint, EndVarp uint, ExtentsVarp *graphene.Rect) bool
-	purego.RegisterFunc(&rawCallback, x.xGetExtents)
+	core.RegisterFunc(&rawCallback, x.xGetExtents)
 	return func(SelfVar AccessibleText, StartVar uint, EndVar uint, ExtentsVar *graphene.Rect) bool {
 		return rawCallback(SelfVar.GoPointer(), StartVar, EndVar, ExtentsVar)
 	}
@@ -205,7 +213,8 @@ func (x *AccessibleTextInterface) OverrideGetOffset(cb func(AccessibleText, *gra
 	if cb == nil {
 		x.xGetOffset = 0
 	} else {
-		x.xGetOffset = purego.NewCallback(func(SelfVarp uintptr, PointVarp *graphene.Point, OffsetVarp *uint) bool {
+		x.xGetOffset = core.NewCallback(func(SelfVarp uintptr, PointVarp *graphene.Point, OffsetVarp *uint) bool {
+			defer core.RecoverPanic()
 			return cb(&AccessibleTextBase{Ptr: SelfVarp}, PointVarp, OffsetVarp)
 		})
 	}
@@ -217,7 +226,7 @@ func (x *AccessibleTextInterface) GetGetOffset() func(AccessibleText, *graphene.
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, PointVarp *graphene.Point, OffsetVarp *uint) bool
-	purego.RegisterFunc(&rawCallback, x.xGetOffset)
+	core.RegisterFunc(&rawCallback, x.xGetOffset)
 	return func(SelfVar AccessibleText, PointVar *graphene.Point, OffsetVar *uint) bool {
 		return rawCallback(SelfVar.GoPointer(), PointVar, OffsetVar)
 	}
@@ -241,8 +250,8 @@ func (x *AccessibleTextRange) GoPointer() uintptr {
 // The `GtkAccessibleText` interfaces is meant to be implemented by accessible
 // objects that have text formatted with attributes, or non-trivial text contents.
 //
-// You should use the [enum@Gtk.AccessibleProperty.LABEL] or the
-// [enum@Gtk.AccessibleProperty.DESCRIPTION] properties for accessible
+// You should use the AccessibleProperty.LABEL or the
+// AccessibleProperty.DESCRIPTION properties for accessible
 // objects containing simple, unformatted text.
 type AccessibleText interface {
 	GoPointer() uintptr
@@ -273,6 +282,91 @@ func (x *AccessibleTextBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementAccessibleText adds the AccessibleText interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetContents(uint, uint) *glib.Bytes
+//   - GetContentsAt(uint, AccessibleTextGranularity, *uint, *uint) *glib.Bytes
+//   - GetCaretPosition() uint
+//   - GetSelection(*uint, *uintptr) bool
+//   - GetAttributes(uint, *uint, *uintptr, *[]string, *[]string) bool
+//   - GetDefaultAttributes(*[]string, *[]string)
+//   - GetExtents(uint, uint, *graphene.Rect) bool
+//   - GetOffset(*graphene.Point, *uint) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see AccessibleTextInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementAccessibleText[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, AccessibleTextGLibType(), func(iface *AccessibleTextInterface) {
+		if _, ok := any(zero).(interface{ GetContents(uint, uint) *glib.Bytes }); ok {
+			iface.OverrideGetContents(func(self AccessibleText, arg0 uint, arg1 uint) *glib.Bytes {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetContents(uint, uint) *glib.Bytes })
+				return impl.GetContents(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetContentsAt(uint, AccessibleTextGranularity, *uint, *uint) *glib.Bytes
+		}); ok {
+			iface.OverrideGetContentsAt(func(self AccessibleText, arg0 uint, arg1 AccessibleTextGranularity, arg2 *uint, arg3 *uint) *glib.Bytes {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetContentsAt(uint, AccessibleTextGranularity, *uint, *uint) *glib.Bytes
+				})
+				return impl.GetContentsAt(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetCaretPosition() uint }); ok {
+			iface.OverrideGetCaretPosition(func(self AccessibleText) uint {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetCaretPosition() uint })
+				return impl.GetCaretPosition()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSelection(*uint, *uintptr) bool }); ok {
+			iface.OverrideGetSelection(func(self AccessibleText, arg0 *uint, arg1 *uintptr) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSelection(*uint, *uintptr) bool })
+				return impl.GetSelection(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetAttributes(uint, *uint, *uintptr, *[]string, *[]string) bool
+		}); ok {
+			iface.OverrideGetAttributes(func(self AccessibleText, arg0 uint, arg1 *uint, arg2 *uintptr, arg3 *[]string, arg4 *[]string) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetAttributes(uint, *uint, *uintptr, *[]string, *[]string) bool
+				})
+				return impl.GetAttributes(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDefaultAttributes(*[]string, *[]string) }); ok {
+			iface.OverrideGetDefaultAttributes(func(self AccessibleText, arg0 *[]string, arg1 *[]string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDefaultAttributes(*[]string, *[]string) })
+				impl.GetDefaultAttributes(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetExtents(uint, uint, *graphene.Rect) bool
+		}); ok {
+			iface.OverrideGetExtents(func(self AccessibleText, arg0 uint, arg1 uint, arg2 *graphene.Rect) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetExtents(uint, uint, *graphene.Rect) bool
+				})
+				return impl.GetExtents(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetOffset(*graphene.Point, *uint) bool
+		}); ok {
+			iface.OverrideGetOffset(func(self AccessibleText, arg0 *graphene.Point, arg1 *uint) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetOffset(*graphene.Point, *uint) bool
+				})
+				return impl.GetOffset(arg0, arg1)
+			})
+		}
+	})
+}
+
 // Updates the position of the caret.
 //
 // Implementations of the `GtkAccessibleText` interface should call this
@@ -327,12 +421,12 @@ const (
 	//
 	// Possible values are:
 	//
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_OVERLINE_NONE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_OVERLINE_SINGLE]
+	// - [ACCESSIBLE_ATTRIBUTE_OVERLINE_NONE]
+	// - [ACCESSIBLE_ATTRIBUTE_OVERLINE_SINGLE]
 	ACCESSIBLE_ATTRIBUTE_OVERLINE string = "overline"
-	// The "none" overline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_OVERLINE].
+	// The "none" overline value for [ACCESSIBLE_ATTRIBUTE_OVERLINE].
 	ACCESSIBLE_ATTRIBUTE_OVERLINE_NONE string = "none"
-	// The "single" overline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_OVERLINE].
+	// The "single" overline value for [ACCESSIBLE_ATTRIBUTE_OVERLINE].
 	ACCESSIBLE_ATTRIBUTE_OVERLINE_SINGLE string = "single"
 	// An attribute for the font size, expressed in points.
 	ACCESSIBLE_ATTRIBUTE_SIZE string = "size"
@@ -340,28 +434,28 @@ const (
 	//
 	// Possible values are:
 	//
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH_ULTRA_CONDENSED]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH_EXTRA_CONDENSED]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH_CONDENSED]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH_SEMI_CONDENSED]
+	// - [ACCESSIBLE_ATTRIBUTE_STRETCH_ULTRA_CONDENSED]
+	// - [ACCESSIBLE_ATTRIBUTE_STRETCH_EXTRA_CONDENSED]
+	// - [ACCESSIBLE_ATTRIBUTE_STRETCH_CONDENSED]
+	// - [ACCESSIBLE_ATTRIBUTE_STRETCH_SEMI_CONDENSED]
 	ACCESSIBLE_ATTRIBUTE_STRETCH string = "stretch"
-	// The "condensed" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "condensed" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_CONDENSED string = "condensed"
-	// The "expanded" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "expanded" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_EXPANDED string = "expanded"
-	// The "extra condensed" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "extra condensed" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_EXTRA_CONDENSED string = "extra_condensed"
-	// The "extra expanded" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "extra expanded" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_EXTRA_EXPANDED string = "extra_expanded"
-	// The "normal" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "normal" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_NORMAL string = "normal"
-	// The "semi condensed" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "semi condensed" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_SEMI_CONDENSED string = "semi_condensed"
-	// The "semi expanded" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "semi expanded" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_SEMI_EXPANDED string = "semi_expanded"
-	// The "ultra condensed" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "ultra condensed" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_ULTRA_CONDENSED string = "ultra_condensed"
-	// The "ultra expanded" stretch value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STRETCH].
+	// The "ultra expanded" stretch value for [ACCESSIBLE_ATTRIBUTE_STRETCH].
 	ACCESSIBLE_ATTRIBUTE_STRETCH_ULTRA_EXPANDED string = "ultra_expanded"
 	// An attribute for strikethrough text.
 	//
@@ -371,55 +465,55 @@ const (
 	//
 	// Possible values are:
 	//
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE_NORMAL]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE_OBLIQUE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE_ITALIC]
+	// - [ACCESSIBLE_ATTRIBUTE_STYLE_NORMAL]
+	// - [ACCESSIBLE_ATTRIBUTE_STYLE_OBLIQUE]
+	// - [ACCESSIBLE_ATTRIBUTE_STYLE_ITALIC]
 	ACCESSIBLE_ATTRIBUTE_STYLE string = "style"
-	// The "italic" style value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE].
+	// The "italic" style value for [ACCESSIBLE_ATTRIBUTE_STYLE].
 	ACCESSIBLE_ATTRIBUTE_STYLE_ITALIC string = "italic"
-	// The "normal" style value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE].
+	// The "normal" style value for [ACCESSIBLE_ATTRIBUTE_STYLE].
 	ACCESSIBLE_ATTRIBUTE_STYLE_NORMAL string = "normal"
-	// The "oblique" style value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_STYLE].
+	// The "oblique" style value for [ACCESSIBLE_ATTRIBUTE_STYLE].
 	ACCESSIBLE_ATTRIBUTE_STYLE_OBLIQUE string = "oblique"
 	// An attribute for the underline style.
 	//
 	// Possible values are:
 	//
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE_NONE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE_SINGLE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE_DOUBLE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE_ERROR]
+	// - [ACCESSIBLE_ATTRIBUTE_UNDERLINE_NONE]
+	// - [ACCESSIBLE_ATTRIBUTE_UNDERLINE_SINGLE]
+	// - [ACCESSIBLE_ATTRIBUTE_UNDERLINE_DOUBLE]
+	// - [ACCESSIBLE_ATTRIBUTE_UNDERLINE_ERROR]
 	ACCESSIBLE_ATTRIBUTE_UNDERLINE string = "underline"
-	// The "double" underline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE].
+	// The "double" underline value for [ACCESSIBLE_ATTRIBUTE_UNDERLINE].
 	ACCESSIBLE_ATTRIBUTE_UNDERLINE_DOUBLE string = "double"
-	// The "error" underline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE].
+	// The "error" underline value for [ACCESSIBLE_ATTRIBUTE_UNDERLINE].
 	ACCESSIBLE_ATTRIBUTE_UNDERLINE_ERROR string = "error"
-	// The "none" underline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE].
+	// The "none" underline value for [ACCESSIBLE_ATTRIBUTE_UNDERLINE].
 	ACCESSIBLE_ATTRIBUTE_UNDERLINE_NONE string = "none"
-	// The "single" underline value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_UNDERLINE].
+	// The "single" underline value for [ACCESSIBLE_ATTRIBUTE_UNDERLINE].
 	ACCESSIBLE_ATTRIBUTE_UNDERLINE_SINGLE string = "single"
 	// An attribute for the font variant.
 	//
 	// Possible values are:
 	//
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_SMALL_CAPS]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_SMALL_CAPS]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_PETITE_CAPS]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_PETITE_CAPS]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_UNICASE]
-	// - [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT_TITLE_CAPS]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_SMALL_CAPS]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_SMALL_CAPS]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_PETITE_CAPS]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_PETITE_CAPS]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_UNICASE]
+	// - [ACCESSIBLE_ATTRIBUTE_VARIANT_TITLE_CAPS]
 	ACCESSIBLE_ATTRIBUTE_VARIANT string = "variant"
-	// The "all petite caps" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "all petite caps" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_PETITE_CAPS string = "all-petite-caps"
-	// The "all small caps" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "all small caps" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_ALL_SMALL_CAPS string = "all-small-caps"
-	// The "petite caps" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "petite caps" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_PETITE_CAPS string = "petite-caps"
-	// The "small caps" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "small caps" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_SMALL_CAPS string = "small-caps"
-	// The "title caps" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "title caps" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_TITLE_CAPS string = "title-caps"
-	// The "unicase" variant value for [const@Gtk.ACCESSIBLE_ATTRIBUTE_VARIANT].
+	// The "unicase" variant value for [ACCESSIBLE_ATTRIBUTE_VARIANT].
 	ACCESSIBLE_ATTRIBUTE_VARIANT_UNICASE string = "unicase"
 	// An attribute for the font weight.
 	ACCESSIBLE_ATTRIBUTE_WEIGHT string = "weight"
@@ -444,7 +538,40 @@ const (
 	AccessibleTextContentChangeRemoveValue AccessibleTextContentChange = 1
 )
 
-// The granularity for queries about the text contents of a [iface@Gtk.AccessibleText]
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleTextContentChange) String() string {
+	switch x {
+	case AccessibleTextContentChangeInsertValue:
+		return "GTK_ACCESSIBLE_TEXT_CONTENT_CHANGE_INSERT"
+	case AccessibleTextContentChangeRemoveValue:
+		return "GTK_ACCESSIBLE_TEXT_CONTENT_CHANGE_REMOVE"
+	}
+	return fmt.Sprintf("AccessibleTextContentChange(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleTextContentChange) Nick() string {
+	switch x {
+	case AccessibleTextContentChangeInsertValue:
+		return "insert"
+	case AccessibleTextContentChangeRemoveValue:
+		return "remove"
+	}
+	return ""
+}
+
+// AccessibleTextContentChangeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleTextContentChangeFromString(s string) (AccessibleTextContentChange, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_TEXT_CONTENT_CHANGE_INSERT", "insert":
+		return AccessibleTextContentChangeInsertValue, true
+	case "GTK_ACCESSIBLE_TEXT_CONTENT_CHANGE_REMOVE", "remove":
+		return AccessibleTextContentChangeRemoveValue, true
+	}
+	return 0, false
+}
+
+// The granularity for queries about the text contents of a [AccessibleText]
 // implementation.
 type AccessibleTextGranularity int
 
@@ -477,12 +604,63 @@ const (
 	AccessibleTextGranularityParagraphValue AccessibleTextGranularity = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleTextGranularity) String() string {
+	switch x {
+	case AccessibleTextGranularityCharacterValue:
+		return "GTK_ACCESSIBLE_TEXT_GRANULARITY_CHARACTER"
+	case AccessibleTextGranularityWordValue:
+		return "GTK_ACCESSIBLE_TEXT_GRANULARITY_WORD"
+	case AccessibleTextGranularitySentenceValue:
+		return "GTK_ACCESSIBLE_TEXT_GRANULARITY_SENTENCE"
+	case AccessibleTextGranularityLineValue:
+		return "GTK_ACCESSIBLE_TEXT_GRANULARITY_LINE"
+	case AccessibleTextGranularityParagraphValue:
+		return "GTK_ACCESSIBLE_TEXT_GRANULARITY_PARAGRAPH"
+	}
+	return fmt.Sprintf("AccessibleTextGranularity(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleTextGranularity) Nick() string {
+	switch x {
+	case AccessibleTextGranularityCharacterValue:
+		return "character"
+	case AccessibleTextGranularityWordValue:
+		return "word"
+	case AccessibleTextGranularitySentenceValue:
+		return "sentence"
+	case AccessibleTextGranularityLineValue:
+		return "line"
+	case AccessibleTextGranularityParagraphValue:
+		return "paragraph"
+	}
+	return ""
+}
+
+// AccessibleTextGranularityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleTextGranularityFromString(s string) (AccessibleTextGranularity, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_TEXT_GRANULARITY_CHARACTER", "character":
+		return AccessibleTextGranularityCharacterValue, true
+	case "GTK_ACCESSIBLE_TEXT_GRANULARITY_WORD", "word":
+		return AccessibleTextGranularityWordValue, true
+	case "GTK_ACCESSIBLE_TEXT_GRANULARITY_SENTENCE", "sentence":
+		return AccessibleTextGranularitySentenceValue, true
+	case "GTK_ACCESSIBLE_TEXT_GRANULARITY_LINE", "line":
+		return AccessibleTextGranularityLineValue, true
+	case "GTK_ACCESSIBLE_TEXT_GRANULARITY_PARAGRAPH", "paragraph":
+		return AccessibleTextGranularityParagraphValue, true
+	}
+	return 0, false
+}
+
 func init() {
 	core.SetPackageName("GTK", "gtk4")
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -495,8 +673,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xAccessibleTextGLibType, libs, "gtk_accessible_text_get_type")
 
-	core.PuregoSafeRegister(&XGtkAccessibleTextUpdateCaretPosition, libs, "gtk_accessible_text_update_caret_position")
-	core.PuregoSafeRegister(&XGtkAccessibleTextUpdateContents, libs, "gtk_accessible_text_update_contents")
-	core.PuregoSafeRegister(&XGtkAccessibleTextUpdateSelectionBound, libs, "gtk_accessible_text_update_selection_bound")
+	gobject.CheckClassSize("GtkAccessibleTextInterface", xAccessibleTextGLibType, unsafe.Sizeof(AccessibleTextInterface{}))
+
+	core.PuregoRegisterSince(&XGtkAccessibleTextUpdateCaretPosition, libs, "gtk_accessible_text_update_caret_position", "GTK", "4.14")
+	core.PuregoRegisterSince(&XGtkAccessibleTextUpdateContents, libs, "gtk_accessible_text_update_contents", "GTK", "4.14")
+	core.PuregoRegisterSince(&XGtkAccessibleTextUpdateSelectionBound, libs, "gtk_accessible_text_update_selection_bound", "GTK", "4.14")
 
 }
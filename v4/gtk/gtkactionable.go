@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,7 +15,7 @@ import (
 type ActionableInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetActionName uintptr
 
@@ -32,100 +31,104 @@ func (x *ActionableInterface) GoPointer() uintptr {
 }
 
 // OverrideGetActionName sets the "get_action_name" callback function.
-// virtual function for [method@Actionable.get_action_name]
+// virtual function for get_action_name
 func (x *ActionableInterface) OverrideGetActionName(cb func(Actionable) string) {
 	if cb == nil {
 		x.xGetActionName = 0
 	} else {
-		x.xGetActionName = purego.NewCallback(func(ActionableVarp uintptr) string {
+		x.xGetActionName = core.NewCallback(func(ActionableVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&ActionableBase{Ptr: ActionableVarp})
 		})
 	}
 }
 
 // GetGetActionName gets the "get_action_name" callback function.
-// virtual function for [method@Actionable.get_action_name]
+// virtual function for get_action_name
 func (x *ActionableInterface) GetGetActionName() func(Actionable) string {
 	if x.xGetActionName == 0 {
 		return nil
 	}
 	var rawCallback func(ActionableVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetActionName)
+	core.RegisterFunc(&rawCallback, x.xGetActionName)
 	return func(ActionableVar Actionable) string {
 		return rawCallback(ActionableVar.GoPointer())
 	}
 }
 
 // OverrideSetActionName sets the "set_action_name" callback function.
-// virtual function for [method@Actionable.set_action_name]
+// virtual function for set_action_name
 func (x *ActionableInterface) OverrideSetActionName(cb func(Actionable, string)) {
 	if cb == nil {
 		x.xSetActionName = 0
 	} else {
-		x.xSetActionName = purego.NewCallback(func(ActionableVarp uintptr, ActionNameVarp string) {
+		x.xSetActionName = core.NewCallback(func(ActionableVarp uintptr, ActionNameVarp string) {
+			defer core.RecoverPanic()
 			cb(&ActionableBase{Ptr: ActionableVarp}, ActionNameVarp)
 		})
 	}
 }
 
 // GetSetActionName gets the "set_action_name" callback function.
-// virtual function for [method@Actionable.set_action_name]
+// virtual function for set_action_name
 func (x *ActionableInterface) GetSetActionName() func(Actionable, string) {
 	if x.xSetActionName == 0 {
 		return nil
 	}
 	var rawCallback func(ActionableVarp uintptr, ActionNameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xSetActionName)
+	core.RegisterFunc(&rawCallback, x.xSetActionName)
 	return func(ActionableVar Actionable, ActionNameVar string) {
 		rawCallback(ActionableVar.GoPointer(), ActionNameVar)
 	}
 }
 
 // OverrideGetActionTargetValue sets the "get_action_target_value" callback function.
-// virtual function for [method@Actionable.get_action_target_value]
+// virtual function for get_action_target_value
 func (x *ActionableInterface) OverrideGetActionTargetValue(cb func(Actionable) *glib.Variant) {
 	if cb == nil {
 		x.xGetActionTargetValue = 0
 	} else {
-		x.xGetActionTargetValue = purego.NewCallback(func(ActionableVarp uintptr) *glib.Variant {
+		x.xGetActionTargetValue = core.NewCallback(func(ActionableVarp uintptr) *glib.Variant {
+			defer core.RecoverPanic()
 			return cb(&ActionableBase{Ptr: ActionableVarp})
 		})
 	}
 }
 
 // GetGetActionTargetValue gets the "get_action_target_value" callback function.
-// virtual function for [method@Actionable.get_action_target_value]
+// virtual function for get_action_target_value
 func (x *ActionableInterface) GetGetActionTargetValue() func(Actionable) *glib.Variant {
 	if x.xGetActionTargetValue == 0 {
 		return nil
 	}
 	var rawCallback func(ActionableVarp uintptr) *glib.Variant
-	purego.RegisterFunc(&rawCallback, x.xGetActionTargetValue)
+	core.RegisterFunc(&rawCallback, x.xGetActionTargetValue)
 	return func(ActionableVar Actionable) *glib.Variant {
 		return rawCallback(ActionableVar.GoPointer())
 	}
 }
 
 // OverrideSetActionTargetValue sets the "set_action_target_value" callback function.
-// virtual function for [method@Actionable.set_action_target_value]
+// virtual function for set_action_target_value
 func (x *ActionableInterface) OverrideSetActionTargetValue(cb func(Actionable, *glib.Variant)) {
 	if cb == nil {
 		x.xSetActionTargetValue = 0
 	} else {
-		x.xSetActionTargetValue = purego.NewCallback(func(ActionableVarp uintptr, TargetValueVarp *glib.Variant) {
+		x.xSetActionTargetValue = core.NewCallback(func(ActionableVarp uintptr, TargetValueVarp *glib.Variant) {
+			defer core.RecoverPanic()
 			cb(&ActionableBase{Ptr: ActionableVarp}, TargetValueVarp)
 		})
 	}
 }
 
 // GetSetActionTargetValue gets the "set_action_target_value" callback function.
-// virtual function for [method@Actionable.set_action_target_value]
+// virtual function for set_action_target_value
 func (x *ActionableInterface) GetSetActionTargetValue() func(Actionable, *glib.Variant) {
 	if x.xSetActionTargetValue == 0 {
 		return nil
 	}
 	var rawCallback func(ActionableVarp uintptr, TargetValueVarp *glib.Variant)
-	purego.RegisterFunc(&rawCallback, x.xSetActionTargetValue)
+	core.RegisterFunc(&rawCallback, x.xSetActionTargetValue)
 	return func(ActionableVar Actionable, TargetValueVar *glib.Variant) {
 		rawCallback(ActionableVar.GoPointer(), TargetValueVar)
 	}
@@ -133,15 +136,15 @@ func (x *ActionableInterface) GetSetActionTargetValue() func(Actionable, *glib.V
 
 // Provides a way to associate widgets with actions.
 //
-// It primarily consists of two properties: [property@Gtk.Actionable:action-name]
-// and [property@Gtk.Actionable:action-target]. There are also some convenience
+// It primarily consists of two properties: [ActionableBase.GetPropertyActionName]
+// and [ActionableBase.GetPropertyActionTarget]. There are also some convenience
 // APIs for setting these properties.
 //
 // The action will be looked up in action groups that are found among
 // the widgets ancestors. Most commonly, these will be the actions with
 // the “win.” or “app.” prefix that are associated with the
 // `GtkApplicationWindow` or `GtkApplication`, but other action groups that
-// are added with [method@Gtk.Widget.insert_action_group] will be consulted
+// are added with [Widget.InsertActionGroup] will be consulted
 // as well.
 type Actionable interface {
 	GoPointer() uintptr
@@ -175,14 +178,55 @@ func (x *ActionableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the action name for @actionable.
+// ImplementActionable adds the Actionable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetActionName() string
+//   - SetActionName(string)
+//   - GetActionTargetValue() *glib.Variant
+//   - SetActionTargetValue(*glib.Variant)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ActionableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementActionable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ActionableGLibType(), func(iface *ActionableInterface) {
+		if _, ok := any(zero).(interface{ GetActionName() string }); ok {
+			iface.OverrideGetActionName(func(self Actionable) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActionName() string })
+				return impl.GetActionName()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetActionName(string) }); ok {
+			iface.OverrideSetActionName(func(self Actionable, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetActionName(string) })
+				impl.SetActionName(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetActionTargetValue() *glib.Variant }); ok {
+			iface.OverrideGetActionTargetValue(func(self Actionable) *glib.Variant {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetActionTargetValue() *glib.Variant })
+				return impl.GetActionTargetValue()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetActionTargetValue(*glib.Variant) }); ok {
+			iface.OverrideSetActionTargetValue(func(self Actionable, arg0 *glib.Variant) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetActionTargetValue(*glib.Variant) })
+				impl.SetActionTargetValue(arg0)
+			})
+		}
+	})
+}
+
+// Gets the action name for actionable.
 func (x *ActionableBase) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ActionableBase) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -192,15 +236,15 @@ func (x *ActionableBase) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ActionableBase) SetActionName(ActionNameVar *string) {
 
@@ -213,13 +257,13 @@ func (x *ActionableBase) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *ActionableBase) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -228,14 +272,14 @@ func (x *ActionableBase) SetActionTarget(FormatStringVar string, varArgs ...inte
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -253,8 +297,8 @@ func (x *ActionableBase) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ActionableBase) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
@@ -315,7 +359,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -324,11 +368,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xActionableGLibType, libs, "gtk_actionable_get_type")
 
-	core.PuregoSafeRegister(&XGtkActionableGetActionName, libs, "gtk_actionable_get_action_name")
-	core.PuregoSafeRegister(&XGtkActionableGetActionTargetValue, libs, "gtk_actionable_get_action_target_value")
-	core.PuregoSafeRegister(&XGtkActionableSetActionName, libs, "gtk_actionable_set_action_name")
-	core.PuregoSafeRegister(&XGtkActionableSetActionTarget, libs, "gtk_actionable_set_action_target")
-	core.PuregoSafeRegister(&XGtkActionableSetActionTargetValue, libs, "gtk_actionable_set_action_target_value")
-	core.PuregoSafeRegister(&XGtkActionableSetDetailedActionName, libs, "gtk_actionable_set_detailed_action_name")
+	gobject.CheckClassSize("GtkActionableInterface", xActionableGLibType, unsafe.Sizeof(ActionableInterface{}))
+
+	core.PuregoRegisterSince(&XGtkActionableGetActionName, libs, "gtk_actionable_get_action_name", "GTK", "")
+	core.PuregoRegisterSince(&XGtkActionableGetActionTargetValue, libs, "gtk_actionable_get_action_target_value", "GTK", "")
+	core.PuregoRegisterSince(&XGtkActionableSetActionName, libs, "gtk_actionable_set_action_name", "GTK", "")
+	core.PuregoRegisterSince(&XGtkActionableSetActionTarget, libs, "gtk_actionable_set_action_target", "GTK", "")
+	core.PuregoRegisterSince(&XGtkActionableSetActionTargetValue, libs, "gtk_actionable_set_action_target_value", "GTK", "")
+	core.PuregoRegisterSince(&XGtkActionableSetDetailedActionName, libs, "gtk_actionable_set_detailed_action_name", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,13 +9,6 @@ import (
 
 // Presents contextual actions.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="action-bar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkActionBar" src="action-bar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // `GtkActionBar` is expected to be displayed below the content and expand
 // horizontally to fill the area.
 //
@@ -29,23 +21,19 @@ import (
 //
 // The `GtkActionBar` implementation of the `GtkBuildable` interface supports
 // adding children at the start or end sides by specifying “start” or “end” as
-// the “type” attribute of a `&lt;child&gt;` element, or setting the center widget
+// the “type” attribute of a `<child>` element, or setting the center widget
 // by specifying “center” value.
 //
 // # CSS nodes
 //
-// ```
-// actionbar
-// ╰── revealer
-//
-//	╰── box
-//	    ├── box.start
-//	    │   ╰── [start children]
-//	    ├── [center widget]
-//	    ╰── box.end
-//	        ╰── [end children]
-//
-// ```
+//	actionbar
+//	╰── revealer
+//	    ╰── box
+//	        ├── box.start
+//	        │   ╰── [start children]
+//	        ├── [center widget]
+//	        ╰── box.end
+//	            ╰── [end children]
 //
 // A `GtkActionBar`'s CSS node is called `actionbar`. It contains a `revealer`
 // subnode, which contains a `box` subnode, which contains two `box` subnodes at
@@ -155,7 +143,7 @@ var xActionBarSetRevealed func(uintptr, bool)
 // Reveals or conceals the content of the action bar.
 //
 // Note: this does not show or hide the action bar in the
-// [property@Gtk.Widget:visible] sense, so revealing has
+// [Widget.GetPropertyVisible] sense, so revealing has
 // no effect if the action bar is hidden.
 func (x *ActionBar) SetRevealed(RevealedVar bool) {
 
@@ -352,7 +340,7 @@ func (x *ActionBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -360,14 +348,13 @@ func (x *ActionBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ActionBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -391,19 +378,17 @@ func (x *ActionBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ActionBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -424,7 +409,7 @@ func (x *ActionBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -433,14 +418,12 @@ func (x *ActionBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ActionBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -459,10 +442,10 @@ func (x *ActionBar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ActionBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -474,7 +457,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -483,14 +466,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xActionBarGLibType, libs, "gtk_action_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewActionBar, libs, "gtk_action_bar_new")
+	core.PuregoRegisterSince(&xNewActionBar, libs, "gtk_action_bar_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xActionBarGetCenterWidget, libs, "gtk_action_bar_get_center_widget")
-	core.PuregoSafeRegister(&xActionBarGetRevealed, libs, "gtk_action_bar_get_revealed")
-	core.PuregoSafeRegister(&xActionBarPackEnd, libs, "gtk_action_bar_pack_end")
-	core.PuregoSafeRegister(&xActionBarPackStart, libs, "gtk_action_bar_pack_start")
-	core.PuregoSafeRegister(&xActionBarRemove, libs, "gtk_action_bar_remove")
-	core.PuregoSafeRegister(&xActionBarSetCenterWidget, libs, "gtk_action_bar_set_center_widget")
-	core.PuregoSafeRegister(&xActionBarSetRevealed, libs, "gtk_action_bar_set_revealed")
+	core.PuregoRegisterSince(&xActionBarGetCenterWidget, libs, "gtk_action_bar_get_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarGetRevealed, libs, "gtk_action_bar_get_revealed", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarPackEnd, libs, "gtk_action_bar_pack_end", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarPackStart, libs, "gtk_action_bar_pack_start", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarRemove, libs, "gtk_action_bar_remove", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarSetCenterWidget, libs, "gtk_action_bar_set_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xActionBarSetRevealed, libs, "gtk_action_bar_set_revealed", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,7 +38,8 @@ func (x *AdjustmentClass) OverrideChanged(cb func(*Adjustment)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(AdjustmentVarp uintptr) {
+		x.xChanged = core.NewCallback(func(AdjustmentVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(AdjustmentNewFromInternalPtr(AdjustmentVarp))
 		})
 	}
@@ -51,7 +51,7 @@ func (x *AdjustmentClass) GetChanged() func(*Adjustment) {
 		return nil
 	}
 	var rawCallback func(AdjustmentVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(AdjustmentVar *Adjustment) {
 		rawCallback(AdjustmentVar.GoPointer())
 	}
@@ -62,7 +62,8 @@ func (x *AdjustmentClass) OverrideValueChanged(cb func(*Adjustment)) {
 	if cb == nil {
 		x.xValueChanged = 0
 	} else {
-		x.xValueChanged = purego.NewCallback(func(AdjustmentVarp uintptr) {
+		x.xValueChanged = core.NewCallback(func(AdjustmentVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(AdjustmentNewFromInternalPtr(AdjustmentVarp))
 		})
 	}
@@ -74,7 +75,7 @@ func (x *AdjustmentClass) GetValueChanged() func(*Adjustment) {
 		return nil
 	}
 	var rawCallback func(AdjustmentVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xValueChanged)
+	core.RegisterFunc(&rawCallback, x.xValueChanged)
 	return func(AdjustmentVar *Adjustment) {
 		rawCallback(AdjustmentVar.GoPointer())
 	}
@@ -85,7 +86,8 @@ func (x *AdjustmentClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -97,7 +99,7 @@ func (x *AdjustmentClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -108,7 +110,8 @@ func (x *AdjustmentClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -120,7 +123,7 @@ func (x *AdjustmentClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -131,7 +134,8 @@ func (x *AdjustmentClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -143,7 +147,7 @@ func (x *AdjustmentClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -154,7 +158,8 @@ func (x *AdjustmentClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -166,7 +171,7 @@ func (x *AdjustmentClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -178,8 +183,8 @@ func (x *AdjustmentClass) GetGtkReserved4() func() {
 // It also contains step and page increments, and a page size.
 //
 // Adjustments are used within several GTK widgets, including
-// [class@Gtk.SpinButton], [class@Gtk.Viewport], [class@Gtk.Scrollbar]
-// and [class@Gtk.Scale].
+// [SpinButton], [Viewport], [Scrollbar]
+// and [Scale].
 //
 // The `GtkAdjustment` object does not update the value itself. Instead
 // it is left up to the owner of the `GtkAdjustment` to control the value.
@@ -225,7 +230,7 @@ var xAdjustmentClampPage func(uintptr, float64, float64)
 // If the range is larger than the page size, then only the
 // start of it will be in the current page.
 //
-// A [signal@Gtk.Adjustment::value-changed] signal will be emitted
+// A [Adjustment.ConnectValueChangedFunc] signal will be emitted
 // if the value is changed.
 func (x *Adjustment) ClampPage(LowerVar float64, UpperVar float64) {
 
@@ -238,10 +243,10 @@ var xAdjustmentConfigure func(uintptr, float64, float64, float64, float64, float
 // Sets all properties of the adjustment at once.
 //
 // Use this function to avoid multiple emissions of the
-// [signal@Gtk.Adjustment::changed] signal. See
-// [method@Gtk.Adjustment.set_lower] for an alternative
+// [Adjustment.ConnectChangedFunc] signal. See
+// [Adjustment.SetLower] for an alternative
 // way of compressing multiple emissions of
-// [signal@Gtk.Adjustment::changed] into one.
+// [Adjustment.ConnectChangedFunc] into one.
 func (x *Adjustment) Configure(ValueVar float64, LowerVar float64, UpperVar float64, StepIncrementVar float64, PageIncrementVar float64, PageSizeVar float64) {
 
 	xAdjustmentConfigure(x.GoPointer(), ValueVar, LowerVar, UpperVar, StepIncrementVar, PageIncrementVar, PageSizeVar)
@@ -316,16 +321,16 @@ var xAdjustmentSetLower func(uintptr, float64)
 // Sets the minimum value of the adjustment.
 //
 // When setting multiple adjustment properties via their individual
-// setters, multiple [signal@Gtk.Adjustment::changed] signals will
+// setters, multiple [Adjustment.ConnectChangedFunc] signals will
 // be emitted. However, since the emission of the
-// [signal@Gtk.Adjustment::changed] signal is tied to the emission
+// [Adjustment.ConnectChangedFunc] signal is tied to the emission
 // of the ::notify signals of the changed properties, it’s possible
-// to compress the [signal@Gtk.Adjustment::changed] signals into one
-// by calling g_object_freeze_notify() and g_object_thaw_notify()
+// to compress the [Adjustment.ConnectChangedFunc] signals into one
+// by calling [gobject.Object.FreezeNotify] and [gobject.Object.ThawNotify]
 // around the calls to the individual setters.
 //
-// Alternatively, using a single g_object_set() for all the properties
-// to change, or using [method@Gtk.Adjustment.configure] has the same effect.
+// Alternatively, using a single [gobject.Object.Set] for all the properties
+// to change, or using [Adjustment.Configure] has the same effect.
 func (x *Adjustment) SetLower(LowerVar float64) {
 
 	xAdjustmentSetLower(x.GoPointer(), LowerVar)
@@ -336,8 +341,8 @@ var xAdjustmentSetPageIncrement func(uintptr, float64)
 
 // Sets the page increment of the adjustment.
 //
-// See [method@Gtk.Adjustment.set_lower] about how to compress
-// multiple emissions of the [signal@Gtk.Adjustment::changed]
+// See [Adjustment.SetLower] about how to compress
+// multiple emissions of the [Adjustment.ConnectChangedFunc]
 // signal when setting multiple adjustment properties.
 func (x *Adjustment) SetPageIncrement(PageIncrementVar float64) {
 
@@ -349,8 +354,8 @@ var xAdjustmentSetPageSize func(uintptr, float64)
 
 // Sets the page size of the adjustment.
 //
-// See [method@Gtk.Adjustment.set_lower] about how to compress
-// multiple emissions of the [signal@Gtk.Adjustment::changed]
+// See [Adjustment.SetLower] about how to compress
+// multiple emissions of the [Adjustment.ConnectChangedFunc]
 // signal when setting multiple adjustment properties.
 func (x *Adjustment) SetPageSize(PageSizeVar float64) {
 
@@ -362,8 +367,8 @@ var xAdjustmentSetStepIncrement func(uintptr, float64)
 
 // Sets the step increment of the adjustment.
 //
-// See [method@Gtk.Adjustment.set_lower] about how to compress
-// multiple emissions of the [signal@Gtk.Adjustment::changed]
+// See [Adjustment.SetLower] about how to compress
+// multiple emissions of the [Adjustment.ConnectChangedFunc]
 // signal when setting multiple adjustment properties.
 func (x *Adjustment) SetStepIncrement(StepIncrementVar float64) {
 
@@ -378,8 +383,8 @@ var xAdjustmentSetUpper func(uintptr, float64)
 // Note that values will be restricted by `upper - page-size`
 // if the page-size property is nonzero.
 //
-// See [method@Gtk.Adjustment.set_lower] about how to compress
-// multiple emissions of the [signal@Gtk.Adjustment::changed]
+// See [Adjustment.SetLower] about how to compress
+// multiple emissions of the [Adjustment.ConnectChangedFunc]
 // signal when setting multiple adjustment properties.
 func (x *Adjustment) SetUpper(UpperVar float64) {
 
@@ -391,13 +396,13 @@ var xAdjustmentSetValue func(uintptr, float64)
 
 // Sets the `GtkAdjustment` value.
 //
-// The value is clamped to lie between [property@Gtk.Adjustment:lower]
-// and [property@Gtk.Adjustment:upper].
+// The value is clamped to lie between [Adjustment.GetPropertyLower]
+// and [Adjustment.GetPropertyUpper].
 //
 // Note that for adjustments which are used in a `GtkScrollbar`,
 // the effective range of allowed values goes from
-// [property@Gtk.Adjustment:lower] to
-// [property@Gtk.Adjustment:upper] - [property@Gtk.Adjustment:page-size].
+// [Adjustment.GetPropertyLower] to
+// [Adjustment.GetPropertyUpper] - [Adjustment.GetPropertyPageSize].
 func (x *Adjustment) SetValue(ValueVar float64) {
 
 	xAdjustmentSetValue(x.GoPointer(), ValueVar)
@@ -534,53 +539,97 @@ func (x *Adjustment) GetPropertyValue() float64 {
 // Emitted when one or more of the `GtkAdjustment` properties have been
 // changed.
 //
-// Note that the [property@Gtk.Adjustment:value] property is
-// covered by the [signal@Gtk.Adjustment::value-changed] signal.
+// Note that the [Adjustment.GetPropertyValue] property is
+// covered by the [Adjustment.ConnectValueChangedFunc] signal.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Adjustment) ConnectChanged(cb *func(Adjustment)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Adjustment{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xAdjustmentChangedTrampoline uintptr
+
+func xAdjustmentChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Adjustment))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Adjustment{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Adjustment) ConnectChangedFunc(cb func(Adjustment)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xAdjustmentChangedTrampoline, xAdjustmentChangedNewTrampoline, cb)
 }
 
 // Emitted when the value has been changed.
+//
+// Deprecated: use ConnectValueChangedFunc, which also accepts method values and closures.
 func (x *Adjustment) ConnectValueChanged(cb *func(Adjustment)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Adjustment{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "value-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xAdjustmentValueChangedTrampoline uintptr
+
+func xAdjustmentValueChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Adjustment))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Adjustment{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectValueChangedFunc connects cb to the "value-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Adjustment) ConnectValueChangedFunc(cb func(Adjustment)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "value-changed", &xAdjustmentValueChangedTrampoline, xAdjustmentValueChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -588,7 +637,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -597,22 +646,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xAdjustmentGLibType, libs, "gtk_adjustment_get_type")
 
-	core.PuregoSafeRegister(&xNewAdjustment, libs, "gtk_adjustment_new")
-
-	core.PuregoSafeRegister(&xAdjustmentClampPage, libs, "gtk_adjustment_clamp_page")
-	core.PuregoSafeRegister(&xAdjustmentConfigure, libs, "gtk_adjustment_configure")
-	core.PuregoSafeRegister(&xAdjustmentGetLower, libs, "gtk_adjustment_get_lower")
-	core.PuregoSafeRegister(&xAdjustmentGetMinimumIncrement, libs, "gtk_adjustment_get_minimum_increment")
-	core.PuregoSafeRegister(&xAdjustmentGetPageIncrement, libs, "gtk_adjustment_get_page_increment")
-	core.PuregoSafeRegister(&xAdjustmentGetPageSize, libs, "gtk_adjustment_get_page_size")
-	core.PuregoSafeRegister(&xAdjustmentGetStepIncrement, libs, "gtk_adjustment_get_step_increment")
-	core.PuregoSafeRegister(&xAdjustmentGetUpper, libs, "gtk_adjustment_get_upper")
-	core.PuregoSafeRegister(&xAdjustmentGetValue, libs, "gtk_adjustment_get_value")
-	core.PuregoSafeRegister(&xAdjustmentSetLower, libs, "gtk_adjustment_set_lower")
-	core.PuregoSafeRegister(&xAdjustmentSetPageIncrement, libs, "gtk_adjustment_set_page_increment")
-	core.PuregoSafeRegister(&xAdjustmentSetPageSize, libs, "gtk_adjustment_set_page_size")
-	core.PuregoSafeRegister(&xAdjustmentSetStepIncrement, libs, "gtk_adjustment_set_step_increment")
-	core.PuregoSafeRegister(&xAdjustmentSetUpper, libs, "gtk_adjustment_set_upper")
-	core.PuregoSafeRegister(&xAdjustmentSetValue, libs, "gtk_adjustment_set_value")
+	gobject.CheckClassSize("GtkAdjustmentClass", xAdjustmentGLibType, unsafe.Sizeof(AdjustmentClass{}))
+
+	core.PuregoRegisterSince(&xNewAdjustment, libs, "gtk_adjustment_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAdjustmentClampPage, libs, "gtk_adjustment_clamp_page", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentConfigure, libs, "gtk_adjustment_configure", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetLower, libs, "gtk_adjustment_get_lower", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetMinimumIncrement, libs, "gtk_adjustment_get_minimum_increment", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetPageIncrement, libs, "gtk_adjustment_get_page_increment", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetPageSize, libs, "gtk_adjustment_get_page_size", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetStepIncrement, libs, "gtk_adjustment_get_step_increment", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetUpper, libs, "gtk_adjustment_get_upper", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentGetValue, libs, "gtk_adjustment_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetLower, libs, "gtk_adjustment_set_lower", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetPageIncrement, libs, "gtk_adjustment_set_page_increment", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetPageSize, libs, "gtk_adjustment_set_page_size", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetStepIncrement, libs, "gtk_adjustment_set_step_increment", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetUpper, libs, "gtk_adjustment_set_upper", "GTK", "")
+	core.PuregoRegisterSince(&xAdjustmentSetValue, libs, "gtk_adjustment_set_value", "GTK", "")
 
 }
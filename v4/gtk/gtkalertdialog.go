@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -25,11 +25,11 @@ func (x *AlertDialogClass) GoPointer() uintptr {
 
 // Collects the arguments that are needed to present a message to the user.
 //
-// The message is shown with the [method@Gtk.AlertDialog.choose]
+// The message is shown with the [AlertDialog.Choose]
 // function.
 //
 // If you don't need to wait for a button to be clicked, you can use
-// [method@Gtk.AlertDialog.show].
+// [AlertDialog.Show].
 type AlertDialog struct {
 	gobject.Object
 }
@@ -52,10 +52,12 @@ var xNewAlertDialog func(string, ...interface{}) uintptr
 //
 // The message will be set to the formatted string
 // resulting from the arguments.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func NewAlertDialog(FormatVar string, varArgs ...interface{}) *AlertDialog {
 	var cls *AlertDialog
 
-	cret := xNewAlertDialog(FormatVar, varArgs...)
+	cret := xNewAlertDialog("%s", fmt.Sprintf(FormatVar, varArgs...))
 
 	if cret == 0 {
 		return nil
@@ -71,7 +73,7 @@ var xAlertDialogChoose func(uintptr, uintptr, uintptr, uintptr, uintptr)
 //
 // It is ok to pass `NULL` for the callback if the alert
 // does not have more than one button. A simpler API for
-// this case is [method@Gtk.AlertDialog.show].
+// this case is [AlertDialog.Show].
 func (x *AlertDialog) Choose(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -81,11 +83,12 @@ func (x *AlertDialog) Choose(ParentVar *Window, CancellableVar *gio.Cancellable,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -95,13 +98,13 @@ func (x *AlertDialog) Choose(ParentVar *Window, CancellableVar *gio.Cancellable,
 
 var xAlertDialogChooseFinish func(uintptr, uintptr, **glib.Error) int
 
-// Finishes the [method@Gtk.AlertDialog.choose] call.
+// Finishes the [AlertDialog.Choose] call.
 func (x *AlertDialog) ChooseFinish(ResultVar gio.AsyncResult) (int, error) {
 	var cerr *glib.Error
 
 	cret := xAlertDialogChooseFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -175,7 +178,7 @@ var xAlertDialogSetCancelButton func(uintptr, int)
 
 // Sets the index of the cancel button.
 //
-// See [property@Gtk.AlertDialog:cancel-button] for
+// See [AlertDialog.GetPropertyCancelButton] for
 // details of how this value is used.
 func (x *AlertDialog) SetCancelButton(ButtonVar int) {
 
@@ -187,7 +190,7 @@ var xAlertDialogSetDefaultButton func(uintptr, int)
 
 // Sets the index of the default button.
 //
-// See [property@Gtk.AlertDialog:default-button] for
+// See [AlertDialog.GetPropertyDefaultButton] for
 // details of how this value is used.
 func (x *AlertDialog) SetDefaultButton(ButtonVar int) {
 
@@ -227,12 +230,12 @@ var xAlertDialogShow func(uintptr, uintptr)
 
 // Shows the alert to the user.
 //
-// This function is a simpler version of [method@Gtk.AlertDialog.choose]
+// This function is a simpler version of [AlertDialog.Choose]
 // intended for dialogs with a single button.
 //
 // If you want to cancel the dialog or if the alert has more than one
 // button, you should use that function instead and provide it with a
-// [class@Gio.Cancellable] and callback respectively.
+// [gio.Cancellable] and callback respectively.
 func (x *AlertDialog) Show(ParentVar *Window) {
 
 	xAlertDialogShow(x.GoPointer(), ParentVar.GoPointer())
@@ -280,10 +283,10 @@ func (x *AlertDialog) GetPropertyButtons() []string {
 }
 
 // SetPropertyCancelButton sets the "cancel-button" property.
-// Determines what happens when the &lt;kbd&gt;Escape&lt;/kbd&gt; key is pressed
+// Determines what happens when the Escape key is pressed
 // while the alert is shown.
 //
-// If this property holds the index of a button in [property@Gtk.AlertDialog:buttons],
+// If this property holds the index of a button in [AlertDialog.GetPropertyButtons],
 // then pressing Escape is treated as if that button was pressed. If it is -1
 // or not a valid index for the `buttons` array, then an error is returned.
 //
@@ -297,10 +300,10 @@ func (x *AlertDialog) SetPropertyCancelButton(value int) {
 }
 
 // GetPropertyCancelButton gets the "cancel-button" property.
-// Determines what happens when the &lt;kbd&gt;Escape&lt;/kbd&gt; key is pressed
+// Determines what happens when the Escape key is pressed
 // while the alert is shown.
 //
-// If this property holds the index of a button in [property@Gtk.AlertDialog:buttons],
+// If this property holds the index of a button in [AlertDialog.GetPropertyButtons],
 // then pressing Escape is treated as if that button was pressed. If it is -1
 // or not a valid index for the `buttons` array, then an error is returned.
 //
@@ -313,10 +316,10 @@ func (x *AlertDialog) GetPropertyCancelButton() int {
 }
 
 // SetPropertyDefaultButton sets the "default-button" property.
-// Determines what happens when the &lt;kbd&gt;Return&lt;/kbd&gt; key is pressed
+// Determines what happens when the Return key is pressed
 // while the alert is shown.
 //
-// If this property holds the index of a button in [property@Gtk.AlertDialog:buttons],
+// If this property holds the index of a button in [AlertDialog.GetPropertyButtons],
 // then pressing Return is treated as if that button was pressed. If it is -1
 // or not a valid index for the `buttons` array, then nothing happens.
 //
@@ -330,10 +333,10 @@ func (x *AlertDialog) SetPropertyDefaultButton(value int) {
 }
 
 // GetPropertyDefaultButton gets the "default-button" property.
-// Determines what happens when the &lt;kbd&gt;Return&lt;/kbd&gt; key is pressed
+// Determines what happens when the Return key is pressed
 // while the alert is shown.
 //
-// If this property holds the index of a button in [property@Gtk.AlertDialog:buttons],
+// If this property holds the index of a button in [AlertDialog.GetPropertyButtons],
 // then pressing Return is treated as if that button was pressed. If it is -1
 // or not a valid index for the `buttons` array, then nothing happens.
 //
@@ -401,7 +404,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -410,22 +413,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xAlertDialogGLibType, libs, "gtk_alert_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewAlertDialog, libs, "gtk_alert_dialog_new")
-
-	core.PuregoSafeRegister(&xAlertDialogChoose, libs, "gtk_alert_dialog_choose")
-	core.PuregoSafeRegister(&xAlertDialogChooseFinish, libs, "gtk_alert_dialog_choose_finish")
-	core.PuregoSafeRegister(&xAlertDialogGetButtons, libs, "gtk_alert_dialog_get_buttons")
-	core.PuregoSafeRegister(&xAlertDialogGetCancelButton, libs, "gtk_alert_dialog_get_cancel_button")
-	core.PuregoSafeRegister(&xAlertDialogGetDefaultButton, libs, "gtk_alert_dialog_get_default_button")
-	core.PuregoSafeRegister(&xAlertDialogGetDetail, libs, "gtk_alert_dialog_get_detail")
-	core.PuregoSafeRegister(&xAlertDialogGetMessage, libs, "gtk_alert_dialog_get_message")
-	core.PuregoSafeRegister(&xAlertDialogGetModal, libs, "gtk_alert_dialog_get_modal")
-	core.PuregoSafeRegister(&xAlertDialogSetButtons, libs, "gtk_alert_dialog_set_buttons")
-	core.PuregoSafeRegister(&xAlertDialogSetCancelButton, libs, "gtk_alert_dialog_set_cancel_button")
-	core.PuregoSafeRegister(&xAlertDialogSetDefaultButton, libs, "gtk_alert_dialog_set_default_button")
-	core.PuregoSafeRegister(&xAlertDialogSetDetail, libs, "gtk_alert_dialog_set_detail")
-	core.PuregoSafeRegister(&xAlertDialogSetMessage, libs, "gtk_alert_dialog_set_message")
-	core.PuregoSafeRegister(&xAlertDialogSetModal, libs, "gtk_alert_dialog_set_modal")
-	core.PuregoSafeRegister(&xAlertDialogShow, libs, "gtk_alert_dialog_show")
+	gobject.CheckClassSize("GtkAlertDialogClass", xAlertDialogGLibType, unsafe.Sizeof(AlertDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewAlertDialog, libs, "gtk_alert_dialog_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xAlertDialogChoose, libs, "gtk_alert_dialog_choose", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogChooseFinish, libs, "gtk_alert_dialog_choose_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetButtons, libs, "gtk_alert_dialog_get_buttons", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetCancelButton, libs, "gtk_alert_dialog_get_cancel_button", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetDefaultButton, libs, "gtk_alert_dialog_get_default_button", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetDetail, libs, "gtk_alert_dialog_get_detail", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetMessage, libs, "gtk_alert_dialog_get_message", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogGetModal, libs, "gtk_alert_dialog_get_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetButtons, libs, "gtk_alert_dialog_set_buttons", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetCancelButton, libs, "gtk_alert_dialog_set_cancel_button", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetDefaultButton, libs, "gtk_alert_dialog_set_default_button", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetDetail, libs, "gtk_alert_dialog_set_detail", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetMessage, libs, "gtk_alert_dialog_set_message", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogSetModal, libs, "gtk_alert_dialog_set_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xAlertDialogShow, libs, "gtk_alert_dialog_show", "GTK", "4.10")
 
 }
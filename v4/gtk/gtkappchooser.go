@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,8 +12,8 @@ import (
 // choose an application.
 //
 // The main objects that implement this interface are
-// [class@Gtk.AppChooserWidget],
-// [class@Gtk.AppChooserDialog] and [class@Gtk.AppChooserButton].
+// [AppChooserWidget],
+// [AppChooserDialog] and [AppChooserButton].
 //
 // Applications are represented by GIO `GAppInfo` objects here.
 // GIO has a concept of recommended and fallback applications for a
@@ -27,7 +26,12 @@ import (
 // recommended or fallback applications.
 //
 // To obtain the application that has been selected in a `GtkAppChooser`,
-// use [method@Gtk.AppChooser.get_app_info].
+// use [AppChooser.GetAppInfo].
+//
+// Deprecated: since 4.10. The application selection widgets should be
+//
+//	implemented according to the design of each platform and/or
+//	application requiring them.
 type AppChooser interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -58,6 +62,8 @@ func (x *AppChooserBase) SetGoPointer(ptr uintptr) {
 }
 
 // Returns the currently selected application.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserBase) GetAppInfo() *gio.AppInfoBase {
 	var cls *gio.AppInfoBase
 
@@ -73,6 +79,8 @@ func (x *AppChooserBase) GetAppInfo() *gio.AppInfoBase {
 
 // Returns the content type for which the `GtkAppChooser`
 // shows applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserBase) GetContentType() string {
 
 	cret := XGtkAppChooserGetContentType(x.GoPointer())
@@ -80,6 +88,8 @@ func (x *AppChooserBase) GetContentType() string {
 }
 
 // Reloads the list of applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserBase) Refresh() {
 
 	XGtkAppChooserRefresh(x.GoPointer())
@@ -120,7 +130,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -129,8 +139,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xAppChooserGLibType, libs, "gtk_app_chooser_get_type")
 
-	core.PuregoSafeRegister(&XGtkAppChooserGetAppInfo, libs, "gtk_app_chooser_get_app_info")
-	core.PuregoSafeRegister(&XGtkAppChooserGetContentType, libs, "gtk_app_chooser_get_content_type")
-	core.PuregoSafeRegister(&XGtkAppChooserRefresh, libs, "gtk_app_chooser_refresh")
+	core.PuregoRegisterSince(&XGtkAppChooserGetAppInfo, libs, "gtk_app_chooser_get_app_info", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAppChooserGetContentType, libs, "gtk_app_chooser_get_content_type", "GTK", "")
+	core.PuregoRegisterSince(&XGtkAppChooserRefresh, libs, "gtk_app_chooser_refresh", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -15,36 +14,34 @@ import (
 
 // The `GtkAppChooserButton` lets the user select an application.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="appchooserbutton-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkAppChooserButton" src="appchooserbutton.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Initially, a `GtkAppChooserButton` selects the first application
 // in its list, which will either be the most-recently used application
-// or, if [property@Gtk.AppChooserButton:show-default-item] is %TRUE, the
+// or, if [AppChooserButton.GetPropertyShowDefaultItem] is true, the
 // default application.
 //
 // The list of applications shown in a `GtkAppChooserButton` includes
 // the recommended applications for the given content type. When
-// [property@Gtk.AppChooserButton:show-default-item] is set, the default
+// [AppChooserButton.GetPropertyShowDefaultItem] is set, the default
 // application is also included. To let the user chooser other applications,
-// you can set the [property@Gtk.AppChooserButton:show-dialog-item] property,
-// which allows to open a full [class@Gtk.AppChooserDialog].
+// you can set the [AppChooserButton.GetPropertyShowDialogItem] property,
+// which allows to open a full [AppChooserDialog].
 //
 // It is possible to add custom items to the list, using
-// [method@Gtk.AppChooserButton.append_custom_item]. These items cause
-// the [signal@Gtk.AppChooserButton::custom-item-activated] signal to be
+// [AppChooserButton.AppendCustomItem]. These items cause
+// the [AppChooserButton.ConnectCustomItemActivatedFunc] signal to be
 // emitted when they are selected.
 //
 // To track changes in the selected application, use the
-// [signal@Gtk.AppChooserButton::changed] signal.
+// [AppChooserButton.ConnectChangedFunc] signal.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkAppChooserButton` has a single CSS node with the name “appchooserbutton”.
+//
+// Deprecated: since 4.10. The application selection widgets should be
+//
+//	implemented according to the design of each platform and/or
+//	application requiring them.
 type AppChooserButton struct {
 	Widget
 }
@@ -61,143 +58,6 @@ func AppChooserButtonNewFromInternalPtr(ptr uintptr) *AppChooserButton {
 	return cls
 }
 
-var xNewAppChooserButton func(string) uintptr
-
-// Creates a new `GtkAppChooserButton` for applications
-// that can handle content of the given type.
-func NewAppChooserButton(ContentTypeVar string) *AppChooserButton {
-	var cls *AppChooserButton
-
-	cret := xNewAppChooserButton(ContentTypeVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AppChooserButton{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAppChooserButtonAppendCustomItem func(uintptr, string, string, uintptr)
-
-// Appends a custom item to the list of applications that is shown
-// in the popup.
-//
-// The item name must be unique per-widget. Clients can use the
-// provided name as a detail for the
-// [signal@Gtk.AppChooserButton::custom-item-activated] signal, to add a
-// callback for the activation of a particular custom item in the list.
-//
-// See also [method@Gtk.AppChooserButton.append_separator].
-func (x *AppChooserButton) AppendCustomItem(NameVar string, LabelVar string, IconVar gio.Icon) {
-
-	xAppChooserButtonAppendCustomItem(x.GoPointer(), NameVar, LabelVar, IconVar.GoPointer())
-
-}
-
-var xAppChooserButtonAppendSeparator func(uintptr)
-
-// Appends a separator to the list of applications that is shown
-// in the popup.
-func (x *AppChooserButton) AppendSeparator() {
-
-	xAppChooserButtonAppendSeparator(x.GoPointer())
-
-}
-
-var xAppChooserButtonGetHeading func(uintptr) string
-
-// Returns the text to display at the top of the dialog.
-func (x *AppChooserButton) GetHeading() string {
-
-	cret := xAppChooserButtonGetHeading(x.GoPointer())
-	return cret
-}
-
-var xAppChooserButtonGetModal func(uintptr) bool
-
-// Gets whether the dialog is modal.
-func (x *AppChooserButton) GetModal() bool {
-
-	cret := xAppChooserButtonGetModal(x.GoPointer())
-	return cret
-}
-
-var xAppChooserButtonGetShowDefaultItem func(uintptr) bool
-
-// Returns whether the dropdown menu should show the default
-// application at the top.
-func (x *AppChooserButton) GetShowDefaultItem() bool {
-
-	cret := xAppChooserButtonGetShowDefaultItem(x.GoPointer())
-	return cret
-}
-
-var xAppChooserButtonGetShowDialogItem func(uintptr) bool
-
-// Returns whether the dropdown menu shows an item
-// for a `GtkAppChooserDialog`.
-func (x *AppChooserButton) GetShowDialogItem() bool {
-
-	cret := xAppChooserButtonGetShowDialogItem(x.GoPointer())
-	return cret
-}
-
-var xAppChooserButtonSetActiveCustomItem func(uintptr, string)
-
-// Selects a custom item.
-//
-// See [method@Gtk.AppChooserButton.append_custom_item].
-//
-// Use [method@Gtk.AppChooser.refresh] to bring the selection
-// to its initial state.
-func (x *AppChooserButton) SetActiveCustomItem(NameVar string) {
-
-	xAppChooserButtonSetActiveCustomItem(x.GoPointer(), NameVar)
-
-}
-
-var xAppChooserButtonSetHeading func(uintptr, string)
-
-// Sets the text to display at the top of the dialog.
-//
-// If the heading is not set, the dialog displays a default text.
-func (x *AppChooserButton) SetHeading(HeadingVar string) {
-
-	xAppChooserButtonSetHeading(x.GoPointer(), HeadingVar)
-
-}
-
-var xAppChooserButtonSetModal func(uintptr, bool)
-
-// Sets whether the dialog should be modal.
-func (x *AppChooserButton) SetModal(ModalVar bool) {
-
-	xAppChooserButtonSetModal(x.GoPointer(), ModalVar)
-
-}
-
-var xAppChooserButtonSetShowDefaultItem func(uintptr, bool)
-
-// Sets whether the dropdown menu of this button should show the
-// default application for the given content type at top.
-func (x *AppChooserButton) SetShowDefaultItem(SettingVar bool) {
-
-	xAppChooserButtonSetShowDefaultItem(x.GoPointer(), SettingVar)
-
-}
-
-var xAppChooserButtonSetShowDialogItem func(uintptr, bool)
-
-// Sets whether the dropdown menu of this button should show an
-// entry to trigger a `GtkAppChooserDialog`.
-func (x *AppChooserButton) SetShowDialogItem(SettingVar bool) {
-
-	xAppChooserButtonSetShowDialogItem(x.GoPointer(), SettingVar)
-
-}
-
 func (c *AppChooserButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -291,104 +151,173 @@ func (x *AppChooserButton) GetPropertyShowDialogItem() bool {
 //
 // The `::activate` signal on `GtkAppChooserButton` is an action signal and
 // emitting it causes the button to pop up its dialog.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *AppChooserButton) ConnectActivate(cb *func(AppChooserButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := AppChooserButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xAppChooserButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xAppChooserButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppChooserButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppChooserButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppChooserButton) ConnectActivateFunc(cb func(AppChooserButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xAppChooserButtonActivateTrampoline, xAppChooserButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the active application changes.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *AppChooserButton) ConnectChanged(cb *func(AppChooserButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := AppChooserButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xAppChooserButtonChangedTrampoline uintptr
+
+func xAppChooserButtonChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppChooserButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppChooserButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppChooserButton) ConnectChangedFunc(cb func(AppChooserButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xAppChooserButtonChangedTrampoline, xAppChooserButtonChangedNewTrampoline, cb)
 }
 
 // Emitted when a custom item is activated.
 //
-// Use [method@Gtk.AppChooserButton.append_custom_item],
+// Use [AppChooserButton.AppendCustomItem],
 // to add custom items.
+//
+// Deprecated: use ConnectCustomItemActivatedFunc, which also accepts method values and closures.
 func (x *AppChooserButton) ConnectCustomItemActivated(cb *func(AppChooserButton, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "custom-item-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
-
-	fcb := func(clsPtr uintptr, ItemNameVarp string) {
-		fa := AppChooserButton{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ItemNameVarp string) {
+			defer core.RecoverPanic()
+			fa := AppChooserButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-		cbFn(fa, ItemNameVarp)
+			cbFn(fa, ItemNameVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "custom-item-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "custom-item-activated", cbRefPtr, cbPtr)
 }
 
 // ConnectCustomItemActivatedWithDetail connects to the "custom-item-activated" signal with a detail string.
 // The detail is appended as "custom-item-activated::<detail>".
+//
+// Deprecated: use ConnectCustomItemActivatedWithDetailFunc, which also accepts method values and closures.
 func (x *AppChooserButton) ConnectCustomItemActivatedWithDetail(detail string, cb *func(AppChooserButton, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("custom-item-activated::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ItemNameVarp string) {
+			defer core.RecoverPanic()
+			fa := AppChooserButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ItemNameVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xAppChooserButtonCustomItemActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ItemNameVarp string) {
+func xAppChooserButtonCustomItemActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ItemNameVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppChooserButton, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppChooserButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ItemNameVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCustomItemActivatedFunc connects cb to the "custom-item-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppChooserButton) ConnectCustomItemActivatedFunc(cb func(AppChooserButton, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "custom-item-activated", &xAppChooserButtonCustomItemActivatedTrampoline, xAppChooserButtonCustomItemActivatedNewTrampoline, cb)
+}
+
+// ConnectCustomItemActivatedWithDetailFunc connects cb to the "custom-item-activated" signal with a detail string, see ConnectCustomItemActivatedFunc.
+// The detail is appended as "custom-item-activated::<detail>".
+func (x *AppChooserButton) ConnectCustomItemActivatedWithDetailFunc(detail string, cb func(AppChooserButton, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("custom-item-activated::%s", detail), &xAppChooserButtonCustomItemActivatedTrampoline, xAppChooserButtonCustomItemActivatedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -552,7 +481,7 @@ func (x *AppChooserButton) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -560,14 +489,13 @@ func (x *AppChooserButton) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -591,19 +519,17 @@ func (x *AppChooserButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -624,7 +550,7 @@ func (x *AppChooserButton) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -633,14 +559,12 @@ func (x *AppChooserButton) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -660,6 +584,8 @@ func (x *AppChooserButton) UpdateStateValue(NStatesVar int, StatesVar []Accessib
 }
 
 // Returns the currently selected application.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserButton) GetAppInfo() *gio.AppInfoBase {
 	var cls *gio.AppInfoBase
 
@@ -675,6 +601,8 @@ func (x *AppChooserButton) GetAppInfo() *gio.AppInfoBase {
 
 // Returns the content type for which the `GtkAppChooser`
 // shows applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserButton) GetContentType() string {
 
 	cret := XGtkAppChooserGetContentType(x.GoPointer())
@@ -682,48 +610,20 @@ func (x *AppChooserButton) GetContentType() string {
 }
 
 // Reloads the list of applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserButton) Refresh() {
 
 	XGtkAppChooserRefresh(x.GoPointer())
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AppChooserButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xAppChooserButtonGLibType, libs, "gtk_app_chooser_button_get_type")
-
-	core.PuregoSafeRegister(&xNewAppChooserButton, libs, "gtk_app_chooser_button_new")
-
-	core.PuregoSafeRegister(&xAppChooserButtonAppendCustomItem, libs, "gtk_app_chooser_button_append_custom_item")
-	core.PuregoSafeRegister(&xAppChooserButtonAppendSeparator, libs, "gtk_app_chooser_button_append_separator")
-	core.PuregoSafeRegister(&xAppChooserButtonGetHeading, libs, "gtk_app_chooser_button_get_heading")
-	core.PuregoSafeRegister(&xAppChooserButtonGetModal, libs, "gtk_app_chooser_button_get_modal")
-	core.PuregoSafeRegister(&xAppChooserButtonGetShowDefaultItem, libs, "gtk_app_chooser_button_get_show_default_item")
-	core.PuregoSafeRegister(&xAppChooserButtonGetShowDialogItem, libs, "gtk_app_chooser_button_get_show_dialog_item")
-	core.PuregoSafeRegister(&xAppChooserButtonSetActiveCustomItem, libs, "gtk_app_chooser_button_set_active_custom_item")
-	core.PuregoSafeRegister(&xAppChooserButtonSetHeading, libs, "gtk_app_chooser_button_set_heading")
-	core.PuregoSafeRegister(&xAppChooserButtonSetModal, libs, "gtk_app_chooser_button_set_modal")
-	core.PuregoSafeRegister(&xAppChooserButtonSetShowDefaultItem, libs, "gtk_app_chooser_button_set_show_default_item")
-	core.PuregoSafeRegister(&xAppChooserButtonSetShowDialogItem, libs, "gtk_app_chooser_button_set_show_dialog_item")
-
-}
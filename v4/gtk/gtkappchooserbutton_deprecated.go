@@ -0,0 +1,199 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewAppChooserButton func(string) uintptr
+
+// Creates a new `GtkAppChooserButton` for applications
+// that can handle content of the given type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewAppChooserButton(ContentTypeVar string) *AppChooserButton {
+	var cls *AppChooserButton
+
+	cret := xNewAppChooserButton(ContentTypeVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AppChooserButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAppChooserButtonAppendCustomItem func(uintptr, string, string, uintptr)
+
+// Appends a custom item to the list of applications that is shown
+// in the popup.
+//
+// The item name must be unique per-widget. Clients can use the
+// provided name as a detail for the
+// [AppChooserButton.ConnectCustomItemActivatedFunc] signal, to add a
+// callback for the activation of a particular custom item in the list.
+//
+// See also [AppChooserButton.AppendSeparator].
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) AppendCustomItem(NameVar string, LabelVar string, IconVar gio.Icon) {
+
+	xAppChooserButtonAppendCustomItem(x.GoPointer(), NameVar, LabelVar, IconVar.GoPointer())
+
+}
+
+var xAppChooserButtonAppendSeparator func(uintptr)
+
+// Appends a separator to the list of applications that is shown
+// in the popup.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) AppendSeparator() {
+
+	xAppChooserButtonAppendSeparator(x.GoPointer())
+
+}
+
+var xAppChooserButtonGetHeading func(uintptr) string
+
+// Returns the text to display at the top of the dialog.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) GetHeading() string {
+
+	cret := xAppChooserButtonGetHeading(x.GoPointer())
+	return cret
+}
+
+var xAppChooserButtonGetModal func(uintptr) bool
+
+// Gets whether the dialog is modal.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) GetModal() bool {
+
+	cret := xAppChooserButtonGetModal(x.GoPointer())
+	return cret
+}
+
+var xAppChooserButtonGetShowDefaultItem func(uintptr) bool
+
+// Returns whether the dropdown menu should show the default
+// application at the top.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) GetShowDefaultItem() bool {
+
+	cret := xAppChooserButtonGetShowDefaultItem(x.GoPointer())
+	return cret
+}
+
+var xAppChooserButtonGetShowDialogItem func(uintptr) bool
+
+// Returns whether the dropdown menu shows an item
+// for a `GtkAppChooserDialog`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) GetShowDialogItem() bool {
+
+	cret := xAppChooserButtonGetShowDialogItem(x.GoPointer())
+	return cret
+}
+
+var xAppChooserButtonSetActiveCustomItem func(uintptr, string)
+
+// Selects a custom item.
+//
+// See [AppChooserButton.AppendCustomItem].
+//
+// Use [AppChooser.Refresh] to bring the selection
+// to its initial state.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) SetActiveCustomItem(NameVar string) {
+
+	xAppChooserButtonSetActiveCustomItem(x.GoPointer(), NameVar)
+
+}
+
+var xAppChooserButtonSetHeading func(uintptr, string)
+
+// Sets the text to display at the top of the dialog.
+//
+// If the heading is not set, the dialog displays a default text.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) SetHeading(HeadingVar string) {
+
+	xAppChooserButtonSetHeading(x.GoPointer(), HeadingVar)
+
+}
+
+var xAppChooserButtonSetModal func(uintptr, bool)
+
+// Sets whether the dialog should be modal.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) SetModal(ModalVar bool) {
+
+	xAppChooserButtonSetModal(x.GoPointer(), ModalVar)
+
+}
+
+var xAppChooserButtonSetShowDefaultItem func(uintptr, bool)
+
+// Sets whether the dropdown menu of this button should show the
+// default application for the given content type at top.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) SetShowDefaultItem(SettingVar bool) {
+
+	xAppChooserButtonSetShowDefaultItem(x.GoPointer(), SettingVar)
+
+}
+
+var xAppChooserButtonSetShowDialogItem func(uintptr, bool)
+
+// Sets whether the dropdown menu of this button should show an
+// entry to trigger a `GtkAppChooserDialog`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserButton) SetShowDialogItem(SettingVar bool) {
+
+	xAppChooserButtonSetShowDialogItem(x.GoPointer(), SettingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewAppChooserButton, libs, "gtk_app_chooser_button_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAppChooserButtonAppendCustomItem, libs, "gtk_app_chooser_button_append_custom_item", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonAppendSeparator, libs, "gtk_app_chooser_button_append_separator", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonGetHeading, libs, "gtk_app_chooser_button_get_heading", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonGetModal, libs, "gtk_app_chooser_button_get_modal", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonGetShowDefaultItem, libs, "gtk_app_chooser_button_get_show_default_item", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonGetShowDialogItem, libs, "gtk_app_chooser_button_get_show_dialog_item", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonSetActiveCustomItem, libs, "gtk_app_chooser_button_set_active_custom_item", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonSetHeading, libs, "gtk_app_chooser_button_set_heading", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonSetModal, libs, "gtk_app_chooser_button_set_modal", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonSetShowDefaultItem, libs, "gtk_app_chooser_button_set_show_default_item", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserButtonSetShowDialogItem, libs, "gtk_app_chooser_button_set_show_dialog_item", "GTK", "")
+
+}
@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,26 +11,24 @@ import (
 
 // `GtkAppChooserDialog` shows a `GtkAppChooserWidget` inside a `GtkDialog`.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="appchooserdialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkAppChooserDialog" src="appchooserdialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Note that `GtkAppChooserDialog` does not have any interesting methods
 // of its own. Instead, you should get the embedded `GtkAppChooserWidget`
-// using [method@Gtk.AppChooserDialog.get_widget] and call its methods if
-// the generic [iface@Gtk.AppChooser] interface is not sufficient for
+// using [AppChooserDialog.GetWidget] and call its methods if
+// the generic [AppChooser] interface is not sufficient for
 // your needs.
 //
 // To set the heading that is shown above the `GtkAppChooserWidget`,
-// use [method@Gtk.AppChooserDialog.set_heading].
+// use [AppChooserDialog.SetHeading].
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkAppChooserDialog` has a single CSS node with the name `window` and style
 // class `.appchooser`.
+//
+// Deprecated: since 4.10. The application selection widgets should be
+//
+//	implemented according to the design of each platform and/or
+//	application requiring them.
 type AppChooserDialog struct {
 	Dialog
 }
@@ -49,81 +45,6 @@ func AppChooserDialogNewFromInternalPtr(ptr uintptr) *AppChooserDialog {
 	return cls
 }
 
-var xNewAppChooserDialog func(uintptr, DialogFlags, uintptr) uintptr
-
-// Creates a new `GtkAppChooserDialog` for the provided `GFile`.
-//
-// The dialog will show applications that can open the file.
-func NewAppChooserDialog(ParentVar *Window, FlagsVar DialogFlags, FileVar gio.File) *AppChooserDialog {
-	var cls *AppChooserDialog
-
-	cret := xNewAppChooserDialog(ParentVar.GoPointer(), FlagsVar, FileVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AppChooserDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewAppChooserDialogForContentType func(uintptr, DialogFlags, string) uintptr
-
-// Creates a new `GtkAppChooserDialog` for the provided content type.
-//
-// The dialog will show applications that can open the content type.
-func NewAppChooserDialogForContentType(ParentVar *Window, FlagsVar DialogFlags, ContentTypeVar string) *AppChooserDialog {
-	var cls *AppChooserDialog
-
-	cret := xNewAppChooserDialogForContentType(ParentVar.GoPointer(), FlagsVar, ContentTypeVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AppChooserDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAppChooserDialogGetHeading func(uintptr) string
-
-// Returns the text to display at the top of the dialog.
-func (x *AppChooserDialog) GetHeading() string {
-
-	cret := xAppChooserDialogGetHeading(x.GoPointer())
-	return cret
-}
-
-var xAppChooserDialogGetWidget func(uintptr) uintptr
-
-// Returns the `GtkAppChooserWidget` of this dialog.
-func (x *AppChooserDialog) GetWidget() *Widget {
-	var cls *Widget
-
-	cret := xAppChooserDialogGetWidget(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAppChooserDialogSetHeading func(uintptr, string)
-
-// Sets the text to display at the top of the dialog.
-//
-// If the heading is not set, the dialog displays a default text.
-func (x *AppChooserDialog) SetHeading(HeadingVar string) {
-
-	xAppChooserDialogSetHeading(x.GoPointer(), HeadingVar)
-
-}
-
 func (c *AppChooserDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -317,7 +238,7 @@ func (x *AppChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -325,14 +246,13 @@ func (x *AppChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -356,19 +276,17 @@ func (x *AppChooserDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -389,7 +307,7 @@ func (x *AppChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -398,14 +316,12 @@ func (x *AppChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -425,6 +341,8 @@ func (x *AppChooserDialog) UpdateStateValue(NStatesVar int, StatesVar []Accessib
 }
 
 // Returns the currently selected application.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserDialog) GetAppInfo() *gio.AppInfoBase {
 	var cls *gio.AppInfoBase
 
@@ -440,6 +358,8 @@ func (x *AppChooserDialog) GetAppInfo() *gio.AppInfoBase {
 
 // Returns the content type for which the `GtkAppChooser`
 // shows applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserDialog) GetContentType() string {
 
 	cret := XGtkAppChooserGetContentType(x.GoPointer())
@@ -447,16 +367,18 @@ func (x *AppChooserDialog) GetContentType() string {
 }
 
 // Reloads the list of applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserDialog) Refresh() {
 
 	XGtkAppChooserRefresh(x.GoPointer())
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AppChooserDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -493,10 +415,10 @@ func (x *AppChooserDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *AppChooserDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -540,7 +462,7 @@ func (x *AppChooserDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *AppChooserDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -556,39 +478,16 @@ func (x *AppChooserDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *AppChooserDialog) SetFocus(FocusVar *Widget) {
 
 	XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xAppChooserDialogGLibType, libs, "gtk_app_chooser_dialog_get_type")
-
-	core.PuregoSafeRegister(&xNewAppChooserDialog, libs, "gtk_app_chooser_dialog_new")
-	core.PuregoSafeRegister(&xNewAppChooserDialogForContentType, libs, "gtk_app_chooser_dialog_new_for_content_type")
-
-	core.PuregoSafeRegister(&xAppChooserDialogGetHeading, libs, "gtk_app_chooser_dialog_get_heading")
-	core.PuregoSafeRegister(&xAppChooserDialogGetWidget, libs, "gtk_app_chooser_dialog_get_widget")
-	core.PuregoSafeRegister(&xAppChooserDialogSetHeading, libs, "gtk_app_chooser_dialog_set_heading")
-
-}
@@ -0,0 +1,116 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewAppChooserDialog func(uintptr, DialogFlags, uintptr) uintptr
+
+// Creates a new `GtkAppChooserDialog` for the provided `GFile`.
+//
+// The dialog will show applications that can open the file.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewAppChooserDialog(ParentVar *Window, FlagsVar DialogFlags, FileVar gio.File) *AppChooserDialog {
+	var cls *AppChooserDialog
+
+	cret := xNewAppChooserDialog(ParentVar.GoPointer(), FlagsVar, FileVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AppChooserDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewAppChooserDialogForContentType func(uintptr, DialogFlags, string) uintptr
+
+// Creates a new `GtkAppChooserDialog` for the provided content type.
+//
+// The dialog will show applications that can open the content type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewAppChooserDialogForContentType(ParentVar *Window, FlagsVar DialogFlags, ContentTypeVar string) *AppChooserDialog {
+	var cls *AppChooserDialog
+
+	cret := xNewAppChooserDialogForContentType(ParentVar.GoPointer(), FlagsVar, ContentTypeVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AppChooserDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAppChooserDialogGetHeading func(uintptr) string
+
+// Returns the text to display at the top of the dialog.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserDialog) GetHeading() string {
+
+	cret := xAppChooserDialogGetHeading(x.GoPointer())
+	return cret
+}
+
+var xAppChooserDialogGetWidget func(uintptr) uintptr
+
+// Returns the `GtkAppChooserWidget` of this dialog.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserDialog) GetWidget() *Widget {
+	var cls *Widget
+
+	cret := xAppChooserDialogGetWidget(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAppChooserDialogSetHeading func(uintptr, string)
+
+// Sets the text to display at the top of the dialog.
+//
+// If the heading is not set, the dialog displays a default text.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserDialog) SetHeading(HeadingVar string) {
+
+	xAppChooserDialogSetHeading(x.GoPointer(), HeadingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewAppChooserDialog, libs, "gtk_app_chooser_dialog_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewAppChooserDialogForContentType, libs, "gtk_app_chooser_dialog_new_for_content_type", "GTK", "")
+
+	core.PuregoRegisterSince(&xAppChooserDialogGetHeading, libs, "gtk_app_chooser_dialog_get_heading", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserDialogGetWidget, libs, "gtk_app_chooser_dialog_get_widget", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserDialogSetHeading, libs, "gtk_app_chooser_dialog_set_heading", "GTK", "")
+
+}
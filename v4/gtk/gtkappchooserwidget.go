@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,27 +13,32 @@ import (
 
 // `GtkAppChooserWidget` is a widget for selecting applications.
 //
-// It is the main building block for [class@Gtk.AppChooserDialog].
+// It is the main building block for [AppChooserDialog].
 // Most applications only need to use the latter; but you can use
 // this widget as part of a larger widget if you have special needs.
 //
 // `GtkAppChooserWidget` offers detailed control over what applications
 // are shown, using the
-// [property@Gtk.AppChooserWidget:show-default],
-// [property@Gtk.AppChooserWidget:show-recommended],
-// [property@Gtk.AppChooserWidget:show-fallback],
-// [property@Gtk.AppChooserWidget:show-other] and
-// [property@Gtk.AppChooserWidget:show-all] properties. See the
-// [iface@Gtk.AppChooser] documentation for more information about these
+// [AppChooserWidget.GetPropertyShowDefault],
+// [AppChooserWidget.GetPropertyShowRecommended],
+// [AppChooserWidget.GetPropertyShowFallback],
+// [AppChooserWidget.GetPropertyShowOther] and
+// [AppChooserWidget.GetPropertyShowAll] properties. See the
+// [AppChooser] documentation for more information about these
 // groups of applications.
 //
 // To keep track of the selected application, use the
-// [signal@Gtk.AppChooserWidget::application-selected] and
-// [signal@Gtk.AppChooserWidget::application-activated] signals.
+// [AppChooserWidget.ConnectApplicationSelectedFunc] and
+// [AppChooserWidget.ConnectApplicationActivatedFunc] signals.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkAppChooserWidget` has a single CSS node with name appchooser.
+//
+// Deprecated: since 4.10. The application selection widgets should be
+//
+//	implemented according to the design of each platform and/or
+//	application requiring them.
 type AppChooserWidget struct {
 	Widget
 }
@@ -51,144 +55,6 @@ func AppChooserWidgetNewFromInternalPtr(ptr uintptr) *AppChooserWidget {
 	return cls
 }
 
-var xNewAppChooserWidget func(string) uintptr
-
-// Creates a new `GtkAppChooserWidget` for applications
-// that can handle content of the given type.
-func NewAppChooserWidget(ContentTypeVar string) *AppChooserWidget {
-	var cls *AppChooserWidget
-
-	cret := xNewAppChooserWidget(ContentTypeVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AppChooserWidget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAppChooserWidgetGetDefaultText func(uintptr) string
-
-// Returns the text that is shown if there are not applications
-// that can handle the content type.
-func (x *AppChooserWidget) GetDefaultText() string {
-
-	cret := xAppChooserWidgetGetDefaultText(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetGetShowAll func(uintptr) bool
-
-// Gets whether the app chooser should show all applications
-// in a flat list.
-func (x *AppChooserWidget) GetShowAll() bool {
-
-	cret := xAppChooserWidgetGetShowAll(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetGetShowDefault func(uintptr) bool
-
-// Gets whether the app chooser should show the default handler
-// for the content type in a separate section.
-func (x *AppChooserWidget) GetShowDefault() bool {
-
-	cret := xAppChooserWidgetGetShowDefault(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetGetShowFallback func(uintptr) bool
-
-// Gets whether the app chooser should show related applications
-// for the content type in a separate section.
-func (x *AppChooserWidget) GetShowFallback() bool {
-
-	cret := xAppChooserWidgetGetShowFallback(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetGetShowOther func(uintptr) bool
-
-// Gets whether the app chooser should show applications
-// which are unrelated to the content type.
-func (x *AppChooserWidget) GetShowOther() bool {
-
-	cret := xAppChooserWidgetGetShowOther(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetGetShowRecommended func(uintptr) bool
-
-// Gets whether the app chooser should show recommended applications
-// for the content type in a separate section.
-func (x *AppChooserWidget) GetShowRecommended() bool {
-
-	cret := xAppChooserWidgetGetShowRecommended(x.GoPointer())
-	return cret
-}
-
-var xAppChooserWidgetSetDefaultText func(uintptr, string)
-
-// Sets the text that is shown if there are not applications
-// that can handle the content type.
-func (x *AppChooserWidget) SetDefaultText(TextVar string) {
-
-	xAppChooserWidgetSetDefaultText(x.GoPointer(), TextVar)
-
-}
-
-var xAppChooserWidgetSetShowAll func(uintptr, bool)
-
-// Sets whether the app chooser should show all applications
-// in a flat list.
-func (x *AppChooserWidget) SetShowAll(SettingVar bool) {
-
-	xAppChooserWidgetSetShowAll(x.GoPointer(), SettingVar)
-
-}
-
-var xAppChooserWidgetSetShowDefault func(uintptr, bool)
-
-// Sets whether the app chooser should show the default handler
-// for the content type in a separate section.
-func (x *AppChooserWidget) SetShowDefault(SettingVar bool) {
-
-	xAppChooserWidgetSetShowDefault(x.GoPointer(), SettingVar)
-
-}
-
-var xAppChooserWidgetSetShowFallback func(uintptr, bool)
-
-// Sets whether the app chooser should show related applications
-// for the content type in a separate section.
-func (x *AppChooserWidget) SetShowFallback(SettingVar bool) {
-
-	xAppChooserWidgetSetShowFallback(x.GoPointer(), SettingVar)
-
-}
-
-var xAppChooserWidgetSetShowOther func(uintptr, bool)
-
-// Sets whether the app chooser should show applications
-// which are unrelated to the content type.
-func (x *AppChooserWidget) SetShowOther(SettingVar bool) {
-
-	xAppChooserWidgetSetShowOther(x.GoPointer(), SettingVar)
-
-}
-
-var xAppChooserWidgetSetShowRecommended func(uintptr, bool)
-
-// Sets whether the app chooser should show recommended applications
-// for the content type in a separate section.
-func (x *AppChooserWidget) SetShowRecommended(SettingVar bool) {
-
-	xAppChooserWidgetSetShowRecommended(x.GoPointer(), SettingVar)
-
-}
-
 func (c *AppChooserWidget) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -220,7 +86,7 @@ func (x *AppChooserWidget) GetPropertyDefaultText() string {
 }
 
 // SetPropertyShowAll sets the "show-all" property.
-// If %TRUE, the app chooser presents all applications
+// If true, the app chooser presents all applications
 // in a single list, without subsections for default,
 // recommended or related applications.
 func (x *AppChooserWidget) SetPropertyShowAll(value bool) {
@@ -231,7 +97,7 @@ func (x *AppChooserWidget) SetPropertyShowAll(value bool) {
 }
 
 // GetPropertyShowAll gets the "show-all" property.
-// If %TRUE, the app chooser presents all applications
+// If true, the app chooser presents all applications
 // in a single list, without subsections for default,
 // recommended or related applications.
 func (x *AppChooserWidget) GetPropertyShowAll() bool {
@@ -244,7 +110,7 @@ func (x *AppChooserWidget) GetPropertyShowAll() bool {
 // Determines whether the app chooser should show the default
 // handler for the content type in a separate section.
 //
-// If %FALSE, the default handler is listed among the recommended
+// If false, the default handler is listed among the recommended
 // applications.
 func (x *AppChooserWidget) SetPropertyShowDefault(value bool) {
 	var v gobject.Value
@@ -257,7 +123,7 @@ func (x *AppChooserWidget) SetPropertyShowDefault(value bool) {
 // Determines whether the app chooser should show the default
 // handler for the content type in a separate section.
 //
-// If %FALSE, the default handler is listed among the recommended
+// If false, the default handler is listed among the recommended
 // applications.
 func (x *AppChooserWidget) GetPropertyShowDefault() bool {
 	var v gobject.Value
@@ -269,7 +135,7 @@ func (x *AppChooserWidget) GetPropertyShowDefault() bool {
 // Determines whether the app chooser should show a section
 // for fallback applications.
 //
-// If %FALSE, the fallback applications are listed among the
+// If false, the fallback applications are listed among the
 // other applications.
 func (x *AppChooserWidget) SetPropertyShowFallback(value bool) {
 	var v gobject.Value
@@ -282,7 +148,7 @@ func (x *AppChooserWidget) SetPropertyShowFallback(value bool) {
 // Determines whether the app chooser should show a section
 // for fallback applications.
 //
-// If %FALSE, the fallback applications are listed among the
+// If false, the fallback applications are listed among the
 // other applications.
 func (x *AppChooserWidget) GetPropertyShowFallback() bool {
 	var v gobject.Value
@@ -313,7 +179,7 @@ func (x *AppChooserWidget) GetPropertyShowOther() bool {
 // Determines whether the app chooser should show a section
 // for recommended applications.
 //
-// If %FALSE, the recommended applications are listed
+// If false, the recommended applications are listed
 // among the other applications.
 func (x *AppChooserWidget) SetPropertyShowRecommended(value bool) {
 	var v gobject.Value
@@ -326,7 +192,7 @@ func (x *AppChooserWidget) SetPropertyShowRecommended(value bool) {
 // Determines whether the app chooser should show a section
 // for recommended applications.
 //
-// If %FALSE, the recommended applications are listed
+// If false, the recommended applications are listed
 // among the other applications.
 func (x *AppChooserWidget) GetPropertyShowRecommended() bool {
 	var v gobject.Value
@@ -339,51 +205,95 @@ func (x *AppChooserWidget) GetPropertyShowRecommended() bool {
 // This usually happens when the user double clicks an item, or an item
 // is selected and the user presses one of the keys Space, Shift+Space,
 // Return or Enter.
+//
+// Deprecated: use ConnectApplicationActivatedFunc, which also accepts method values and closures.
 func (x *AppChooserWidget) ConnectApplicationActivated(cb *func(AppChooserWidget, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "application-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := AppChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ApplicationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "application-activated", cbRefPtr, cbPtr)
+}
+
+var xAppChooserWidgetApplicationActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ApplicationVarp uintptr) {
+func xAppChooserWidgetApplicationActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ApplicationVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppChooserWidget, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ApplicationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "application-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplicationActivatedFunc connects cb to the "application-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppChooserWidget) ConnectApplicationActivatedFunc(cb func(AppChooserWidget, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "application-activated", &xAppChooserWidgetApplicationActivatedTrampoline, xAppChooserWidgetApplicationActivatedNewTrampoline, cb)
 }
 
 // Emitted when an application item is selected from the widget's list.
+//
+// Deprecated: use ConnectApplicationSelectedFunc, which also accepts method values and closures.
 func (x *AppChooserWidget) ConnectApplicationSelected(cb *func(AppChooserWidget, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "application-selected", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ApplicationVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := AppChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ApplicationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "application-selected", cbRefPtr, cbPtr)
+}
+
+var xAppChooserWidgetApplicationSelectedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ApplicationVarp uintptr) {
+func xAppChooserWidgetApplicationSelectedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ApplicationVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(AppChooserWidget, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := AppChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ApplicationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "application-selected", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplicationSelectedFunc connects cb to the "application-selected" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *AppChooserWidget) ConnectApplicationSelectedFunc(cb func(AppChooserWidget, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "application-selected", &xAppChooserWidgetApplicationSelectedTrampoline, xAppChooserWidgetApplicationSelectedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -547,7 +457,7 @@ func (x *AppChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -555,14 +465,13 @@ func (x *AppChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserWidget) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -586,19 +495,17 @@ func (x *AppChooserWidget) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserWidget) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -619,7 +526,7 @@ func (x *AppChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -628,14 +535,12 @@ func (x *AppChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AppChooserWidget) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -655,6 +560,8 @@ func (x *AppChooserWidget) UpdateStateValue(NStatesVar int, StatesVar []Accessib
 }
 
 // Returns the currently selected application.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserWidget) GetAppInfo() *gio.AppInfoBase {
 	var cls *gio.AppInfoBase
 
@@ -670,6 +577,8 @@ func (x *AppChooserWidget) GetAppInfo() *gio.AppInfoBase {
 
 // Returns the content type for which the `GtkAppChooser`
 // shows applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserWidget) GetContentType() string {
 
 	cret := XGtkAppChooserGetContentType(x.GoPointer())
@@ -677,49 +586,20 @@ func (x *AppChooserWidget) GetContentType() string {
 }
 
 // Reloads the list of applications.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *AppChooserWidget) Refresh() {
 
 	XGtkAppChooserRefresh(x.GoPointer())
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AppChooserWidget) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xAppChooserWidgetGLibType, libs, "gtk_app_chooser_widget_get_type")
-
-	core.PuregoSafeRegister(&xNewAppChooserWidget, libs, "gtk_app_chooser_widget_new")
-
-	core.PuregoSafeRegister(&xAppChooserWidgetGetDefaultText, libs, "gtk_app_chooser_widget_get_default_text")
-	core.PuregoSafeRegister(&xAppChooserWidgetGetShowAll, libs, "gtk_app_chooser_widget_get_show_all")
-	core.PuregoSafeRegister(&xAppChooserWidgetGetShowDefault, libs, "gtk_app_chooser_widget_get_show_default")
-	core.PuregoSafeRegister(&xAppChooserWidgetGetShowFallback, libs, "gtk_app_chooser_widget_get_show_fallback")
-	core.PuregoSafeRegister(&xAppChooserWidgetGetShowOther, libs, "gtk_app_chooser_widget_get_show_other")
-	core.PuregoSafeRegister(&xAppChooserWidgetGetShowRecommended, libs, "gtk_app_chooser_widget_get_show_recommended")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetDefaultText, libs, "gtk_app_chooser_widget_set_default_text")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetShowAll, libs, "gtk_app_chooser_widget_set_show_all")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetShowDefault, libs, "gtk_app_chooser_widget_set_show_default")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetShowFallback, libs, "gtk_app_chooser_widget_set_show_fallback")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetShowOther, libs, "gtk_app_chooser_widget_set_show_other")
-	core.PuregoSafeRegister(&xAppChooserWidgetSetShowRecommended, libs, "gtk_app_chooser_widget_set_show_recommended")
-
-}
@@ -0,0 +1,202 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewAppChooserWidget func(string) uintptr
+
+// Creates a new `GtkAppChooserWidget` for applications
+// that can handle content of the given type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewAppChooserWidget(ContentTypeVar string) *AppChooserWidget {
+	var cls *AppChooserWidget
+
+	cret := xNewAppChooserWidget(ContentTypeVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AppChooserWidget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAppChooserWidgetGetDefaultText func(uintptr) string
+
+// Returns the text that is shown if there are not applications
+// that can handle the content type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetDefaultText() string {
+
+	cret := xAppChooserWidgetGetDefaultText(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetGetShowAll func(uintptr) bool
+
+// Gets whether the app chooser should show all applications
+// in a flat list.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetShowAll() bool {
+
+	cret := xAppChooserWidgetGetShowAll(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetGetShowDefault func(uintptr) bool
+
+// Gets whether the app chooser should show the default handler
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetShowDefault() bool {
+
+	cret := xAppChooserWidgetGetShowDefault(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetGetShowFallback func(uintptr) bool
+
+// Gets whether the app chooser should show related applications
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetShowFallback() bool {
+
+	cret := xAppChooserWidgetGetShowFallback(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetGetShowOther func(uintptr) bool
+
+// Gets whether the app chooser should show applications
+// which are unrelated to the content type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetShowOther() bool {
+
+	cret := xAppChooserWidgetGetShowOther(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetGetShowRecommended func(uintptr) bool
+
+// Gets whether the app chooser should show recommended applications
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) GetShowRecommended() bool {
+
+	cret := xAppChooserWidgetGetShowRecommended(x.GoPointer())
+	return cret
+}
+
+var xAppChooserWidgetSetDefaultText func(uintptr, string)
+
+// Sets the text that is shown if there are not applications
+// that can handle the content type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetDefaultText(TextVar string) {
+
+	xAppChooserWidgetSetDefaultText(x.GoPointer(), TextVar)
+
+}
+
+var xAppChooserWidgetSetShowAll func(uintptr, bool)
+
+// Sets whether the app chooser should show all applications
+// in a flat list.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetShowAll(SettingVar bool) {
+
+	xAppChooserWidgetSetShowAll(x.GoPointer(), SettingVar)
+
+}
+
+var xAppChooserWidgetSetShowDefault func(uintptr, bool)
+
+// Sets whether the app chooser should show the default handler
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetShowDefault(SettingVar bool) {
+
+	xAppChooserWidgetSetShowDefault(x.GoPointer(), SettingVar)
+
+}
+
+var xAppChooserWidgetSetShowFallback func(uintptr, bool)
+
+// Sets whether the app chooser should show related applications
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetShowFallback(SettingVar bool) {
+
+	xAppChooserWidgetSetShowFallback(x.GoPointer(), SettingVar)
+
+}
+
+var xAppChooserWidgetSetShowOther func(uintptr, bool)
+
+// Sets whether the app chooser should show applications
+// which are unrelated to the content type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetShowOther(SettingVar bool) {
+
+	xAppChooserWidgetSetShowOther(x.GoPointer(), SettingVar)
+
+}
+
+var xAppChooserWidgetSetShowRecommended func(uintptr, bool)
+
+// Sets whether the app chooser should show recommended applications
+// for the content type in a separate section.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AppChooserWidget) SetShowRecommended(SettingVar bool) {
+
+	xAppChooserWidgetSetShowRecommended(x.GoPointer(), SettingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewAppChooserWidget, libs, "gtk_app_chooser_widget_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAppChooserWidgetGetDefaultText, libs, "gtk_app_chooser_widget_get_default_text", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetGetShowAll, libs, "gtk_app_chooser_widget_get_show_all", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetGetShowDefault, libs, "gtk_app_chooser_widget_get_show_default", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetGetShowFallback, libs, "gtk_app_chooser_widget_get_show_fallback", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetGetShowOther, libs, "gtk_app_chooser_widget_get_show_other", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetGetShowRecommended, libs, "gtk_app_chooser_widget_get_show_recommended", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetDefaultText, libs, "gtk_app_chooser_widget_set_default_text", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetShowAll, libs, "gtk_app_chooser_widget_set_show_all", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetShowDefault, libs, "gtk_app_chooser_widget_set_show_default", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetShowFallback, libs, "gtk_app_chooser_widget_set_show_fallback", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetShowOther, libs, "gtk_app_chooser_widget_set_show_other", "GTK", "")
+	core.PuregoRegisterSince(&xAppChooserWidgetSetShowRecommended, libs, "gtk_app_chooser_widget_set_show_recommended", "GTK", "")
+
+}
@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -32,12 +33,13 @@ func (x *ApplicationClass) GoPointer() uintptr {
 // OverrideWindowAdded sets the "window_added" callback function.
 // Signal emitted when a `GtkWindow` is added to
 //
-//	application through gtk_application_add_window().
+//	application through [Application.AddWindow].
 func (x *ApplicationClass) OverrideWindowAdded(cb func(*Application, *Window)) {
 	if cb == nil {
 		x.xWindowAdded = 0
 	} else {
-		x.xWindowAdded = purego.NewCallback(func(ApplicationVarp uintptr, WindowVarp uintptr) {
+		x.xWindowAdded = core.NewCallback(func(ApplicationVarp uintptr, WindowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -46,13 +48,13 @@ func (x *ApplicationClass) OverrideWindowAdded(cb func(*Application, *Window)) {
 // GetWindowAdded gets the "window_added" callback function.
 // Signal emitted when a `GtkWindow` is added to
 //
-//	application through gtk_application_add_window().
+//	application through [Application.AddWindow].
 func (x *ApplicationClass) GetWindowAdded() func(*Application, *Window) {
 	if x.xWindowAdded == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, WindowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xWindowAdded)
+	core.RegisterFunc(&rawCallback, x.xWindowAdded)
 	return func(ApplicationVar *Application, WindowVar *Window) {
 		rawCallback(ApplicationVar.GoPointer(), WindowVar.GoPointer())
 	}
@@ -62,12 +64,13 @@ func (x *ApplicationClass) GetWindowAdded() func(*Application, *Window) {
 // Signal emitted when a `GtkWindow` is removed from
 //
 //	application, either as a side-effect of being destroyed or
-//	explicitly through gtk_application_remove_window().
+//	explicitly through [Application.RemoveWindow].
 func (x *ApplicationClass) OverrideWindowRemoved(cb func(*Application, *Window)) {
 	if cb == nil {
 		x.xWindowRemoved = 0
 	} else {
-		x.xWindowRemoved = purego.NewCallback(func(ApplicationVarp uintptr, WindowVarp uintptr) {
+		x.xWindowRemoved = core.NewCallback(func(ApplicationVarp uintptr, WindowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ApplicationNewFromInternalPtr(ApplicationVarp), WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -77,13 +80,13 @@ func (x *ApplicationClass) OverrideWindowRemoved(cb func(*Application, *Window))
 // Signal emitted when a `GtkWindow` is removed from
 //
 //	application, either as a side-effect of being destroyed or
-//	explicitly through gtk_application_remove_window().
+//	explicitly through [Application.RemoveWindow].
 func (x *ApplicationClass) GetWindowRemoved() func(*Application, *Window) {
 	if x.xWindowRemoved == 0 {
 		return nil
 	}
 	var rawCallback func(ApplicationVarp uintptr, WindowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xWindowRemoved)
+	core.RegisterFunc(&rawCallback, x.xWindowRemoved)
 	return func(ApplicationVar *Application, WindowVar *Window) {
 		rawCallback(ApplicationVar.GoPointer(), WindowVar.GoPointer())
 	}
@@ -91,8 +94,8 @@ func (x *ApplicationClass) GetWindowRemoved() func(*Application, *Window) {
 
 // Types of user actions that may be blocked by `GtkApplication`.
 //
-// See [method@Gtk.Application.inhibit].
-type ApplicationInhibitFlags int
+// See [Application.Inhibit].
+type ApplicationInhibitFlags uint32
 
 var xApplicationInhibitFlagsGLibType func() types.GType
 
@@ -115,6 +118,55 @@ const (
 	ApplicationInhibitIdleValue ApplicationInhibitFlags = 8
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ApplicationInhibitFlags) GLibType() types.GType {
+	return xApplicationInhibitFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ApplicationInhibitFlags) Has(flags ApplicationInhibitFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ApplicationInhibitFlags) Set(flags ApplicationInhibitFlags) ApplicationInhibitFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ApplicationInhibitFlags) Clear(flags ApplicationInhibitFlags) ApplicationInhibitFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ApplicationInhibitFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&ApplicationInhibitLogoutValue != 0 && x&ApplicationInhibitLogoutValue == ApplicationInhibitLogoutValue {
+		names = append(names, "GTK_APPLICATION_INHIBIT_LOGOUT")
+		rest &^= ApplicationInhibitLogoutValue
+	}
+	if rest&ApplicationInhibitSwitchValue != 0 && x&ApplicationInhibitSwitchValue == ApplicationInhibitSwitchValue {
+		names = append(names, "GTK_APPLICATION_INHIBIT_SWITCH")
+		rest &^= ApplicationInhibitSwitchValue
+	}
+	if rest&ApplicationInhibitSuspendValue != 0 && x&ApplicationInhibitSuspendValue == ApplicationInhibitSuspendValue {
+		names = append(names, "GTK_APPLICATION_INHIBIT_SUSPEND")
+		rest &^= ApplicationInhibitSuspendValue
+	}
+	if rest&ApplicationInhibitIdleValue != 0 && x&ApplicationInhibitIdleValue == ApplicationInhibitIdleValue {
+		names = append(names, "GTK_APPLICATION_INHIBIT_IDLE")
+		rest &^= ApplicationInhibitIdleValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // A high-level API for writing applications.
 //
 // `GtkApplication` supports many aspects of writing a GTK application
@@ -125,17 +177,17 @@ const (
 // by exporting actions and menus and manages a list of toplevel windows whose
 // life-cycle is automatically tied to the life-cycle of your application.
 //
-// While `GtkApplication` works fine with plain [class@Gtk.Window]s,
-// it is recommended to use it together with [class@Gtk.ApplicationWindow].
+// While `GtkApplication` works fine with plain [Window]s,
+// it is recommended to use it together with [ApplicationWindow].
 //
-// ## Automatic resources
+// # Automatic resources
 //
 // `GtkApplication` will automatically load menus from the `GtkBuilder`
 // resource located at "gtk/menus.ui", relative to the application's
-// resource base path (see [method@Gio.Application.set_resource_base_path]).
+// resource base path (see [gio.Application.SetResourceBasePath]).
 // The menu with the ID "menubar" is taken as the application's
 // menubar. Additional menus (most interesting submenus) can be named
-// and accessed via [method@Gtk.Application.get_menu_by_id] which allows for
+// and accessed via [Application.GetMenuById] which allows for
 // dynamic population of a part of the menu structure.
 //
 // Note that automatic resource loading uses the resource base path
@@ -143,46 +195,49 @@ const (
 // base path is changed at a later time.
 //
 // It is also possible to provide the menubar manually using
-// [method@Gtk.Application.set_menubar].
+// [Application.SetMenubar].
 //
 // `GtkApplication` will also automatically setup an icon search path for
 // the default icon theme by appending "icons" to the resource base
 // path. This allows your application to easily store its icons as
-// resources. See [method@Gtk.IconTheme.add_resource_path] for more
+// resources. See [IconTheme.AddResourcePath] for more
 // information.
 //
 // If there is a resource located at `gtk/help-overlay.ui` which
-// defines a [class@Gtk.ShortcutsWindow] with ID `help_overlay` then
+// defines a [ShortcutsWindow] with ID `help_overlay` then
 // `GtkApplication` associates an instance of this shortcuts window with
-// each [class@Gtk.ApplicationWindow] and sets up the keyboard accelerator
-// &lt;kbd&gt;Control&lt;/kbd&gt;+&lt;kbd&gt;?&lt;/kbd&gt; to open it. To create a menu item that
+// each [ApplicationWindow] and sets up the keyboard accelerator
+// Control+? to open it. To create a menu item that
 // displays the shortcuts window, associate the item with the action
 // `win.show-help-overlay`.
 //
 // `GtkApplication` will also automatically set the application id as the
-// default window icon. Use [func@Gtk.Window.set_default_icon_name] or
-// [property@Gtk.Window:icon-name] to override that behavior.
+// default window icon. Use [WindowSetDefaultIconName] or
+// [Window.GetPropertyIconName] to override that behavior.
 //
-// ## A simple application
+// # A simple application
 //
-// [A simple example](https://gitlab.gnome.org/GNOME/gtk/tree/main/examples/bp/bloatpad.c)
+// [A simple example]
 // is available in the GTK source code repository
 //
 // `GtkApplication` registers with a session manager if possible and
 // offers various functionality related to the session life-cycle.
 //
 // An application can block various ways to end the session with
-// the [method@Gtk.Application.inhibit] function. Typical use cases for
+// the [Application.Inhibit] function. Typical use cases for
 // this kind of inhibiting are long-running, uninterruptible operations,
 // such as burning a CD or performing a disk backup. The session
 // manager may not honor the inhibitor, but it can be expected to
 // inform the user about the negative consequences of ending the
 // session while inhibitors are present.
 //
-// ## See Also
+// # See Also
+//
+// - [Using GtkApplication]
+// - Getting Started with GTK: Basics
 //
-// - [Using GtkApplication](https://developer.gnome.org/documentation/tutorials/application.html)
-// - [Getting Started with GTK: Basics](getting_started.html#basics)
+// [A simple example]: https://gitlab.gnome.org/GNOME/gtk/tree/main/examples/bp/bloatpad.c
+// [Using GtkApplication]: https://developer.gnome.org/documentation/tutorials/application.html
 type Application struct {
 	gio.Application
 }
@@ -203,19 +258,19 @@ var xNewApplication func(uintptr, gio.ApplicationFlags) uintptr
 
 // Creates a new application instance.
 //
-// When using `GtkApplication`, it is not necessary to call [func@Gtk.init]
+// When using `GtkApplication`, it is not necessary to call [Init]
 // manually. It is called as soon as the application gets registered as
 // the primary instance.
 //
-// Concretely, [func@Gtk.init] is called in the default handler for the
+// Concretely, [Init] is called in the default handler for the
 // `GApplication::startup` signal. Therefore, `GtkApplication` subclasses
-// should always chain up in their [vfunc@GIO.Application.startup] handler
+// should always chain up in their Application.startup handler
 // before using any GTK API.
 //
-// Note that commandline arguments are not passed to [func@Gtk.init].
+// Note that commandline arguments are not passed to [Init].
 //
 // If `application_id` is not `NULL`, then it must be valid. See
-// [func@Gio.Application.id_is_valid].
+// [gio.ApplicationIdIsValid].
 //
 // If no application ID is given then some features (most notably application
 // uniqueness) will be disabled.
@@ -241,14 +296,14 @@ var xApplicationAddWindow func(uintptr, uintptr)
 //
 // This call can only happen after the application has started;
 // typically, you should add new application windows in response
-// to the emission of the [signal@GIO.Application::activate] signal.
+// to the emission of the Application::activate signal.
 //
-// This call is equivalent to setting the [property@Gtk.Window:application]
-// property of the window to @application.
+// This call is equivalent to setting the Window:application
+// property of the window to application.
 //
 // Normally, the connection between the application and the window
 // will remain until the window is destroyed, but you can explicitly
-// remove it with [method@Gtk.Application.remove_window].
+// remove it with [Application.RemoveWindow].
 //
 // GTK will keep the application running as long as it has any windows.
 func (x *Application) AddWindow(WindowVar *Window) {
@@ -257,17 +312,17 @@ func (x *Application) AddWindow(WindowVar *Window) {
 
 }
 
-var xApplicationGetAccelsForAction func(uintptr, string) []string
+var xApplicationGetAccelsForAction func(uintptr, string) uintptr
 
 // Gets the accelerators that are currently associated with
 // the given action.
 func (x *Application) GetAccelsForAction(DetailedActionNameVar string) []string {
 
 	cret := xApplicationGetAccelsForAction(x.GoPointer(), DetailedActionNameVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xApplicationGetActionsForAccel func(uintptr, string) []string
+var xApplicationGetActionsForAccel func(uintptr, string) uintptr
 
 // Returns the list of actions (possibly empty) that the accelerator maps to.
 //
@@ -285,11 +340,11 @@ var xApplicationGetActionsForAccel func(uintptr, string) []string
 //
 // It is a programmer error to pass an invalid accelerator string.
 //
-// If you are unsure, check it with [func@Gtk.accelerator_parse] first.
+// If you are unsure, check it with [AcceleratorParse] first.
 func (x *Application) GetActionsForAccel(AccelVar string) []string {
 
 	cret := xApplicationGetActionsForAccel(x.GoPointer(), AccelVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xApplicationGetActiveWindow func(uintptr) uintptr
@@ -318,7 +373,7 @@ var xApplicationGetMenuById func(uintptr, string) uintptr
 
 // Gets a menu from automatically loaded resources.
 //
-// See [the section on Automatic resources](class.Application.html#automatic-resources)
+// See the section on Automatic resources
 // for more information.
 func (x *Application) GetMenuById(IdVar string) *gio.Menu {
 	var cls *gio.Menu
@@ -356,7 +411,7 @@ var xApplicationGetWindowById func(uintptr, uint) uintptr
 // Returns the window with the given ID.
 //
 // The ID of a `GtkApplicationWindow` can be retrieved with
-// [method@Gtk.ApplicationWindow.get_id].
+// [ApplicationWindow.GetId].
 func (x *Application) GetWindowById(IdVar uint) *Window {
 	var cls *Window
 
@@ -398,9 +453,9 @@ var xApplicationInhibit func(uintptr, uintptr, ApplicationInhibitFlags, uintptr)
 //
 // Applications should invoke this method when they begin an operation
 // that should not be interrupted, such as creating a CD or DVD. The
-// types of actions that may be blocked are specified by the @flags
+// types of actions that may be blocked are specified by the flags
 // parameter. When the application completes the operation it should
-// call [method@Gtk.Application.uninhibit] to remove the inhibitor. Note
+// call [Application.Uninhibit] to remove the inhibitor. Note
 // that an application can have multiple inhibitors, and all of them must
 // be individually removed. Inhibitors are also cleared when the
 // application exits.
@@ -409,13 +464,13 @@ var xApplicationInhibit func(uintptr, uintptr, ApplicationInhibitFlags, uintptr)
 // the action. In most cases, users will be given the option to force
 // the action to take place.
 //
-// The @reason message should be short and to the point.
+// The reason message should be short and to the point.
 //
 // If a window is given, the session manager may point the user to
 // this window to find out more about why the action is inhibited.
 //
 // The cookie that is returned by this function  should be used as an
-// argument to [method@Gtk.Application.uninhibit] in order to remove
+// argument to [Application.Uninhibit] in order to remove
 // the request.
 func (x *Application) Inhibit(WindowVar *Window, FlagsVar ApplicationInhibitFlags, ReasonVar *string) uint {
 
@@ -426,15 +481,15 @@ func (x *Application) Inhibit(WindowVar *Window, FlagsVar ApplicationInhibitFlag
 	return cret
 }
 
-var xApplicationListActionDescriptions func(uintptr) []string
+var xApplicationListActionDescriptions func(uintptr) uintptr
 
 // Lists the detailed action names which have associated accelerators.
 //
-// See [method@Gtk.Application.set_accels_for_action].
+// See [Application.SetAccelsForAction].
 func (x *Application) ListActionDescriptions() []string {
 
 	cret := xApplicationListActionDescriptions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xApplicationRemoveWindow func(uintptr, uintptr)
@@ -442,7 +497,7 @@ var xApplicationRemoveWindow func(uintptr, uintptr)
 // Remove a window from the application.
 //
 // If the window belongs to the application then this call is
-// equivalent to setting the [property@Gtk.Window:application]
+// equivalent to setting the Window:application
 // property of the window to `NULL`.
 //
 // The application may stop running as a result of a call to this
@@ -458,13 +513,13 @@ var xApplicationSetAccelsForAction func(uintptr, string, []string)
 // Sets zero or more keyboard accelerators that will trigger the
 // given action.
 //
-// The first item in @accels will be the primary accelerator,
+// The first item in accels will be the primary accelerator,
 // which may be displayed in the UI.
 //
 // To remove all accelerators for an action, use an empty,
-// zero-terminated array for @accels.
+// zero-terminated array for accels.
 //
-// For the @detailed_action_name, see [func@Gio.Action.parse_detailed_name]
+// For the detailed_action_name, see [gio.ActionParseDetailedName]
 // and [Gio.Action.print_detailed_name].
 func (x *Application) SetAccelsForAction(DetailedActionNameVar string, AccelsVar []string) {
 
@@ -479,7 +534,7 @@ var xApplicationSetMenubar func(uintptr, uintptr)
 // This is a menubar in the traditional sense.
 //
 // This can only be done in the primary instance of the application,
-// after it has been registered. [vfunc@GIO.Application.startup] is
+// after it has been registered. Application.startup is
 // a good place to call this.
 //
 // Depending on the desktop environment, this may appear at the top of
@@ -502,7 +557,7 @@ var xApplicationUninhibit func(uintptr, uint)
 
 // Removes an inhibitor that has been previously established.
 //
-// See [method@Gtk.Application.inhibit].
+// See [Application.Inhibit].
 //
 // Inhibitors are also cleared when the application exits.
 func (x *Application) Uninhibit(CookieVar uint) {
@@ -526,7 +581,10 @@ func (c *Application) SetGoPointer(ptr uintptr) {
 // Set this property to true to register with the session manager.
 //
 // This will make GTK track the session state (such as the
-// [property@Gtk.Application:screensaver-active] property).
+// [Application.GetPropertyScreensaverActive] property).
+//
+// Deprecated: since 4.20. This property is ignored. GTK always registers
+// with the session manager
 func (x *Application) SetPropertyRegisterSession(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -538,7 +596,10 @@ func (x *Application) SetPropertyRegisterSession(value bool) {
 // Set this property to true to register with the session manager.
 //
 // This will make GTK track the session state (such as the
-// [property@Gtk.Application:screensaver-active] property).
+// [Application.GetPropertyScreensaverActive] property).
+//
+// Deprecated: since 4.20. This property is ignored. GTK always registers
+// with the session manager
 func (x *Application) GetPropertyRegisterSession() bool {
 	var v gobject.Value
 	x.GetProperty("register-session", &v)
@@ -560,177 +621,241 @@ func (x *Application) GetPropertyScreensaverActive() bool {
 // Emitted when the session manager is about to end the session.
 //
 // Applications can connect to this signal and call
-// [method@Gtk.Application.inhibit] with [flags@Gtk.ApplicationInhibitFlags.logout]
+// [Application.Inhibit] with [ApplicationInhibitLogoutValue]
 // to delay the end of the session until state has been saved.
+//
+// Deprecated: use ConnectQueryEndFunc, which also accepts method values and closures.
 func (x *Application) ConnectQueryEnd(cb *func(Application)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "query-end", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "query-end", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xApplicationQueryEndTrampoline uintptr
+
+func xApplicationQueryEndNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "query-end", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectQueryEndFunc connects cb to the "query-end" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectQueryEndFunc(cb func(Application)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "query-end", &xApplicationQueryEndTrampoline, xApplicationQueryEndNewTrampoline, cb)
 }
 
 // Emitted when a window is added to an application.
 //
-// See [method@Gtk.Application.add_window].
+// See [Application.AddWindow].
+//
+// Deprecated: use ConnectWindowAddedFunc, which also accepts method values and closures.
 func (x *Application) ConnectWindowAdded(cb *func(Application, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "window-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WindowVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WindowVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "window-added", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WindowVarp uintptr) {
+var xApplicationWindowAddedTrampoline uintptr
+
+func xApplicationWindowAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WindowVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WindowVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "window-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectWindowAddedFunc connects cb to the "window-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectWindowAddedFunc(cb func(Application, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "window-added", &xApplicationWindowAddedTrampoline, xApplicationWindowAddedNewTrampoline, cb)
 }
 
 // Emitted when a window is removed from an application.
 //
 // This can happen as a side-effect of the window being destroyed
-// or explicitly through [method@Gtk.Application.remove_window].
+// or explicitly through [Application.RemoveWindow].
+//
+// Deprecated: use ConnectWindowRemovedFunc, which also accepts method values and closures.
 func (x *Application) ConnectWindowRemoved(cb *func(Application, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "window-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WindowVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Application{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WindowVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "window-removed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WindowVarp uintptr) {
+var xApplicationWindowRemovedTrampoline uintptr
+
+func xApplicationWindowRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WindowVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Application, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Application{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WindowVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "window-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// ConnectWindowRemovedFunc connects cb to the "window-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Application) ConnectWindowRemovedFunc(cb func(Application, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "window-removed", &xApplicationWindowRemovedTrampoline, xApplicationWindowRemovedNewTrampoline, cb)
+}
+
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionAdded(ActionNameVar string) {
 
 	gio.XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	gio.XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionRemoved(ActionNameVar string) {
 
 	gio.XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *Application) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	gio.XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [gio.ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [gio.ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [gio.DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [gio.DBusConnection.Flush] is called
+// before `exit()`. Without `[gio.DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *Application) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	gio.XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [gio.ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [gio.ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *Application) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	gio.XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -741,14 +866,14 @@ func (x *Application) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [gio.ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -759,14 +884,14 @@ func (x *Application) GetActionParameterType(ActionNameVar string) *glib.Variant
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [gio.ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -774,14 +899,14 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -790,7 +915,7 @@ func (x *Application) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -798,17 +923,17 @@ func (x *Application) GetActionStateHint(ActionNameVar string) *glib.Variant {
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [gio.ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [gio.ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [gio.ActionGroup.GetActionState] will return `NULL`
+// and you must not call [gio.ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -819,38 +944,38 @@ func (x *Application) GetActionStateType(ActionNameVar string) *glib.VariantType
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *Application) HasAction(ActionNameVar string) bool {
 
 	cret := gio.XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *Application) ListActions() []string {
 
 	cret := gio.XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [gio.ActionGroup.HasAction], [gio.ActionGroup.GetActionEnabled],
+// [gio.ActionGroup.GetActionParameterType],
+// [gio.ActionGroup.GetActionStateType],
+// [gio.ActionGroup.GetActionStateHint] and
+// [gio.ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [gio.ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -869,47 +994,41 @@ func (x *Application) QueryAction(ActionNameVar string, EnabledVar *bool, Parame
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *Application) AddAction(ActionVar gio.Action) {
 
 	gio.XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
+// A convenience function for creating multiple [gio.SimpleAction]
+// instances and adding them to a [gio.ActionMap].
 //
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [gio.ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -922,15 +1041,13 @@ func (x *Application) AddAction(ActionVar gio.Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *Application) AddActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	gio.XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *Application) LookupAction(ActionNameVar string) *gio.ActionBase {
@@ -956,31 +1073,25 @@ func (x *Application) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [gio.ActionMap]. This is meant as the reverse of
+// [gio.ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *Application) RemoveActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int) {
 
 	gio.XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -992,7 +1103,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1003,21 +1114,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationGLibType, libs, "gtk_application_get_type")
 
-	core.PuregoSafeRegister(&xNewApplication, libs, "gtk_application_new")
-
-	core.PuregoSafeRegister(&xApplicationAddWindow, libs, "gtk_application_add_window")
-	core.PuregoSafeRegister(&xApplicationGetAccelsForAction, libs, "gtk_application_get_accels_for_action")
-	core.PuregoSafeRegister(&xApplicationGetActionsForAccel, libs, "gtk_application_get_actions_for_accel")
-	core.PuregoSafeRegister(&xApplicationGetActiveWindow, libs, "gtk_application_get_active_window")
-	core.PuregoSafeRegister(&xApplicationGetMenuById, libs, "gtk_application_get_menu_by_id")
-	core.PuregoSafeRegister(&xApplicationGetMenubar, libs, "gtk_application_get_menubar")
-	core.PuregoSafeRegister(&xApplicationGetWindowById, libs, "gtk_application_get_window_by_id")
-	core.PuregoSafeRegister(&xApplicationGetWindows, libs, "gtk_application_get_windows")
-	core.PuregoSafeRegister(&xApplicationInhibit, libs, "gtk_application_inhibit")
-	core.PuregoSafeRegister(&xApplicationListActionDescriptions, libs, "gtk_application_list_action_descriptions")
-	core.PuregoSafeRegister(&xApplicationRemoveWindow, libs, "gtk_application_remove_window")
-	core.PuregoSafeRegister(&xApplicationSetAccelsForAction, libs, "gtk_application_set_accels_for_action")
-	core.PuregoSafeRegister(&xApplicationSetMenubar, libs, "gtk_application_set_menubar")
-	core.PuregoSafeRegister(&xApplicationUninhibit, libs, "gtk_application_uninhibit")
+	gobject.CheckClassSize("GtkApplicationClass", xApplicationGLibType, unsafe.Sizeof(ApplicationClass{}))
+
+	core.PuregoRegisterSince(&xNewApplication, libs, "gtk_application_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xApplicationAddWindow, libs, "gtk_application_add_window", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetAccelsForAction, libs, "gtk_application_get_accels_for_action", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetActionsForAccel, libs, "gtk_application_get_actions_for_accel", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetActiveWindow, libs, "gtk_application_get_active_window", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetMenuById, libs, "gtk_application_get_menu_by_id", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetMenubar, libs, "gtk_application_get_menubar", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetWindowById, libs, "gtk_application_get_window_by_id", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationGetWindows, libs, "gtk_application_get_windows", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationInhibit, libs, "gtk_application_inhibit", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationListActionDescriptions, libs, "gtk_application_list_action_descriptions", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationRemoveWindow, libs, "gtk_application_remove_window", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationSetAccelsForAction, libs, "gtk_application_set_accels_for_action", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationSetMenubar, libs, "gtk_application_set_menubar", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationUninhibit, libs, "gtk_application_uninhibit", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -31,9 +30,9 @@ func (x *ApplicationWindowClass) GoPointer() uintptr {
 //
 // Notably, `GtkApplicationWindow` can handle an application menubar.
 //
-// This class implements the [iface@Gio.ActionGroup] and [iface@Gio.ActionMap]
+// This class implements the [gio.ActionGroup] and [gio.ActionMap]
 // interfaces, to let you add window-specific actions that will be exported
-// by the associated [class@Gtk.Application], together with its application-wide
+// by the associated [Application], together with its application-wide
 // actions. Window-specific actions are prefixed with the “win.”
 // prefix and application-wide actions are prefixed with the “app.”
 // prefix. Actions must be addressed with the prefixed name when
@@ -41,10 +40,10 @@ func (x *ApplicationWindowClass) GoPointer() uintptr {
 //
 // Note that widgets that are placed inside a `GtkApplicationWindow`
 // can also activate these actions, if they implement the
-// [iface@Gtk.Actionable] interface.
+// [Actionable] interface.
 //
-// The settings [property@Gtk.Settings:gtk-shell-shows-app-menu] and
-// [property@Gtk.Settings:gtk-shell-shows-menubar] tell GTK whether the
+// The settings [Settings.GetPropertyGtkShellShowsAppMenu] and
+// [Settings.GetPropertyGtkShellShowsMenubar] tell GTK whether the
 // desktop environment is showing the application menu and menubar
 // models outside the application as part of the desktop shell.
 // For instance, on OS X, both menus will be displayed remotely;
@@ -52,51 +51,48 @@ func (x *ApplicationWindowClass) GoPointer() uintptr {
 //
 // If the desktop environment does not display the menubar, it can be shown in
 // the `GtkApplicationWindow` by setting the
-// [property@Gtk.ApplicationWindow:show-menubar] property to true. If the
+// [ApplicationWindow.GetPropertyShowMenubar] property to true. If the
 // desktop environment does not display the application menu, then it will
 // automatically be included in the menubar or in the window’s client-side
 // decorations.
 //
-// See [class@Gtk.PopoverMenu] for information about the XML language
+// See [PopoverMenu] for information about the XML language
 // used by `GtkBuilder` for menu models.
 //
-// See also: [method@Gtk.Application.set_menubar].
+// See also: [Application.SetMenubar].
 //
-// ## A GtkApplicationWindow with a menubar
+// # A GtkApplicationWindow with a menubar
 //
 // The code sample below shows how to set up a `GtkApplicationWindow`
-// with a menu bar defined on the [class@Gtk.Application]:
-//
-// ```c
-// GtkApplication *app = gtk_application_new ("org.gtk.test", 0);
-//
-// GtkBuilder *builder = gtk_builder_new_from_string (
-//
-//	"&lt;interface&gt;"
-//	"  &lt;menu id='menubar'&gt;"
-//	"    &lt;submenu&gt;"
-//	"      &lt;attribute name='label' translatable='yes'&gt;_Edit&lt;/attribute&gt;"
-//	"      &lt;item&gt;"
-//	"        &lt;attribute name='label' translatable='yes'&gt;_Copy&lt;/attribute&gt;"
-//	"        &lt;attribute name='action'&gt;win.copy&lt;/attribute&gt;"
-//	"      &lt;/item&gt;"
-//	"      &lt;item&gt;"
-//	"        &lt;attribute name='label' translatable='yes'&gt;_Paste&lt;/attribute&gt;"
-//	"        &lt;attribute name='action'&gt;win.paste&lt;/attribute&gt;"
-//	"      &lt;/item&gt;"
-//	"    &lt;/submenu&gt;"
-//	"  &lt;/menu&gt;"
-//	"&lt;/interface&gt;",
-//	-1);
-//
-// GMenuModel *menubar = G_MENU_MODEL (gtk_builder_get_object (builder, "menubar"));
-// gtk_application_set_menubar (GTK_APPLICATION (app), menubar);
-// g_object_unref (builder);
-//
-// // ...
-//
-// GtkWidget *window = gtk_application_window_new (app);
-// ```
+// with a menu bar defined on the [Application]:
+//
+//	GtkApplication *app = gtk_application_new ("org.gtk.test", 0);
+//
+//	GtkBuilder *builder = gtk_builder_new_from_string (
+//	    "<interface>"
+//	    "  <menu id='menubar'>"
+//	    "    <submenu>"
+//	    "      <attribute name='label' translatable='yes'>_Edit</attribute>"
+//	    "      <item>"
+//	    "        <attribute name='label' translatable='yes'>_Copy</attribute>"
+//	    "        <attribute name='action'>win.copy</attribute>"
+//	    "      </item>"
+//	    "      <item>"
+//	    "        <attribute name='label' translatable='yes'>_Paste</attribute>"
+//	    "        <attribute name='action'>win.paste</attribute>"
+//	    "      </item>"
+//	    "    </submenu>"
+//	    "  </menu>"
+//	    "</interface>",
+//	    -1);
+//
+//	GMenuModel *menubar = G_MENU_MODEL (gtk_builder_get_object (builder, "menubar"));
+//	gtk_application_set_menubar (GTK_APPLICATION (app), menubar);
+//	g_object_unref (builder);
+//
+//	// ...
+//
+//	GtkWidget *window = gtk_application_window_new (app);
 type ApplicationWindow struct {
 	Window
 }
@@ -130,25 +126,6 @@ func NewApplicationWindow(ApplicationVar *Application) *ApplicationWindow {
 	return cls
 }
 
-var xApplicationWindowGetHelpOverlay func(uintptr) uintptr
-
-// Gets the `GtkShortcutsWindow` that is associated with @window.
-//
-// See [method@Gtk.ApplicationWindow.set_help_overlay].
-func (x *ApplicationWindow) GetHelpOverlay() *ShortcutsWindow {
-	var cls *ShortcutsWindow
-
-	cret := xApplicationWindowGetHelpOverlay(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ShortcutsWindow{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xApplicationWindowGetId func(uintptr) uint
 
 // Returns the unique ID of the window.
@@ -170,20 +147,6 @@ func (x *ApplicationWindow) GetShowMenubar() bool {
 	return cret
 }
 
-var xApplicationWindowSetHelpOverlay func(uintptr, uintptr)
-
-// Associates a shortcuts window with the application window.
-//
-// Additionally, sets up an action with the name
-// `win.show-help-overlay` to present it.
-//
-// The window takes responsibility for destroying the help overlay.
-func (x *ApplicationWindow) SetHelpOverlay(HelpOverlayVar *ShortcutsWindow) {
-
-	xApplicationWindowSetHelpOverlay(x.GoPointer(), HelpOverlayVar.GoPointer())
-
-}
-
 var xApplicationWindowSetShowMenubar func(uintptr, bool)
 
 // Sets whether the window will display a menubar for the app menu
@@ -209,7 +172,7 @@ func (c *ApplicationWindow) SetGoPointer(ptr uintptr) {
 // If this property is true, the window will display a menubar
 // unless it is shown by the desktop shell.
 //
-// See [method@Gtk.Application.set_menubar].
+// See [Application.SetMenubar].
 //
 // If false, the window will not display a menubar, regardless
 // of whether the desktop shell is showing it or not.
@@ -224,7 +187,7 @@ func (x *ApplicationWindow) SetPropertyShowMenubar(value bool) {
 // If this property is true, the window will display a menubar
 // unless it is shown by the desktop shell.
 //
-// See [method@Gtk.Application.set_menubar].
+// See [Application.SetMenubar].
 //
 // If false, the window will not display a menubar, regardless
 // of whether the desktop shell is showing it or not.
@@ -234,99 +197,97 @@ func (x *ApplicationWindow) GetPropertyShowMenubar() bool {
 	return v.GetBoolean()
 }
 
-// Emits the [signal@Gio.ActionGroup::action-added] signal on @action_group.
+// Emits the ActionGroup::action-added signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionAdded(ActionNameVar string) {
 
 	gio.XGActionGroupActionAdded(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-enabled-changed] signal on @action_group.
+// Emits the ActionGroup::action-enabled-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionEnabledChanged(ActionNameVar string, EnabledVar bool) {
 
 	gio.XGActionGroupActionEnabledChanged(x.GoPointer(), ActionNameVar, EnabledVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-removed] signal on @action_group.
+// Emits the ActionGroup::action-removed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionRemoved(ActionNameVar string) {
 
 	gio.XGActionGroupActionRemoved(x.GoPointer(), ActionNameVar)
 
 }
 
-// Emits the [signal@Gio.ActionGroup::action-state-changed] signal on @action_group.
+// Emits the ActionGroup::action-state-changed signal on action_group.
 //
-// This function should only be called by [type@Gio.ActionGroup] implementations.
+// This function should only be called by [gio.ActionGroup] implementations.
 func (x *ApplicationWindow) ActionStateChanged(ActionNameVar string, StateVar *glib.Variant) {
 
 	gio.XGActionGroupActionStateChanged(x.GoPointer(), ActionNameVar, StateVar)
 
 }
 
-// Activate the named action within @action_group.
+// Activate the named action within action_group.
 //
 // If the action is expecting a parameter, then the correct type of
-// parameter must be given as @parameter.  If the action is expecting no
-// parameters then @parameter must be `NULL`.  See
-// [method@Gio.ActionGroup.get_action_parameter_type].
+// parameter must be given as parameter.  If the action is expecting no
+// parameters then parameter must be `NULL`.  See
+// [gio.ActionGroup.GetActionParameterType].
 //
-// If the [type@Gio.ActionGroup] implementation supports asynchronous remote
+// If the [gio.ActionGroup] implementation supports asynchronous remote
 // activation over D-Bus, this call may return before the relevant
 // D-Bus traffic has been sent, or any replies have been received. In
 // order to block on such asynchronous activation calls,
-// [method@Gio.DBusConnection.flush] should be called prior to the code, which
+// [gio.DBusConnection.Flush] should be called prior to the code, which
 // depends on the result of the action activation. Without flushing
 // the D-Bus connection, there is no guarantee that the action would
 // have been activated.
 //
 // The following code which runs in a remote app instance, shows an
 // example of a ‘quit’ action being activated on the primary app
-// instance over D-Bus. Here [method@Gio.DBusConnection.flush] is called
-// before `exit()`. Without `g_dbus_connection_flush()`, the ‘quit’ action
+// instance over D-Bus. Here [gio.DBusConnection.Flush] is called
+// before `exit()`. Without `[gio.DBusConnection.Flush]`, the ‘quit’ action
 // may fail to be activated on the primary instance.
 //
-// ```c
-// // call ‘quit’ action on primary instance
-// g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
+//	// call ‘quit’ action on primary instance
+//	g_action_group_activate_action (G_ACTION_GROUP (app), "quit", NULL);
 //
-// // make sure the action is activated now
-// g_dbus_connection_flush (…);
+//	// make sure the action is activated now
+//	g_dbus_connection_flush (…);
 //
-// g_debug ("Application has been terminated. Exiting.");
+//	g_debug ("Application has been terminated. Exiting.");
 //
-// exit (0);
-// ```
+//	exit (0);
 func (x *ApplicationWindow) ActivateAction(ActionNameVar string, ParameterVar *glib.Variant) {
 
 	gio.XGActionGroupActivateAction(x.GoPointer(), ActionNameVar, ParameterVar)
 
 }
 
-// Request for the state of the named action within @action_group to be
-// changed to @value.
+// Request for the state of the named action within action_group to be
+// changed to value.
 //
-// The action must be stateful and @value must be of the correct type.
-// See [method@Gio.ActionGroup.get_action_state_type].
+// The action must be stateful and value must be of the correct type.
+// See [gio.ActionGroup.GetActionStateType].
 //
 // This call merely requests a change.  The action may refuse to change
-// its state or may change its state to something other than @value.
-// See [method@Gio.ActionGroup.get_action_state_hint].
+// its state or may change its state to something other than value.
+// See [gio.ActionGroup.GetActionStateHint].
 //
-// If the @value GVariant is floating, it is consumed.
+// If the value GVariant is floating, it is consumed.
 func (x *ApplicationWindow) ChangeActionState(ActionNameVar string, ValueVar *glib.Variant) {
 
 	gio.XGActionGroupChangeActionState(x.GoPointer(), ActionNameVar, ValueVar)
 
 }
 
-// Checks if the named action within @action_group is currently enabled.
+// Checks if the named action within action_group is currently enabled.
 //
 // An action must be enabled in order to be activated or in order to
 // have its state changed from outside callers.
@@ -337,14 +298,14 @@ func (x *ApplicationWindow) GetActionEnabled(ActionNameVar string) bool {
 }
 
 // Queries the type of the parameter that must be given when activating
-// the named action within @action_group.
+// the named action within action_group.
 //
-// When activating the action using [method@Gio.ActionGroup.activate_action],
-// the [type@GLib.Variant] given to that function must be of the type returned
+// When activating the action using [gio.ActionGroup.ActivateAction],
+// the [glib.Variant] given to that function must be of the type returned
 // by this function.
 //
 // In the case that this function returns `NULL`, you must not give any
-// [type@GLib.Variant], but `NULL` instead.
+// [glib.Variant], but `NULL` instead.
 //
 // The parameter type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -355,14 +316,14 @@ func (x *ApplicationWindow) GetActionParameterType(ActionNameVar string) *glib.V
 	return cret
 }
 
-// Queries the current state of the named action within @action_group.
+// Queries the current state of the named action within action_group.
 //
 // If the action is not stateful then `NULL` will be returned.  If the
 // action is stateful then the type of the return value is the type
-// given by [method@Gio.ActionGroup.get_action_state_type].
+// given by [gio.ActionGroup.GetActionStateType].
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionState(x.GoPointer(), ActionNameVar)
@@ -370,14 +331,14 @@ func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 }
 
 // Requests a hint about the valid range of values for the state of the
-// named action within @action_group.
+// named action within action_group.
 //
 // If `NULL` is returned it either means that the action is not stateful
 // or that there is no hint about the valid range of values for the
 // state of the action.
 //
-// If a [type@GLib.Variant] array is returned then each item in the array is a
-// possible value for the state.  If a [type@GLib.Variant] pair (ie: two-tuple) is
+// If a [glib.Variant] array is returned then each item in the array is a
+// possible value for the state.  If a [glib.Variant] pair (ie: two-tuple) is
 // returned then the tuple specifies the inclusive lower and upper bound
 // of valid values for the state.
 //
@@ -386,7 +347,7 @@ func (x *ApplicationWindow) GetActionState(ActionNameVar string) *glib.Variant {
 // within the range may fail.
 //
 // The return value (if non-`NULL`) should be freed with
-// [method@GLib.Variant.unref] when it is no longer required.
+// [glib.Variant.Unref] when it is no longer required.
 func (x *ApplicationWindow) GetActionStateHint(ActionNameVar string) *glib.Variant {
 
 	cret := gio.XGActionGroupGetActionStateHint(x.GoPointer(), ActionNameVar)
@@ -394,17 +355,17 @@ func (x *ApplicationWindow) GetActionStateHint(ActionNameVar string) *glib.Varia
 }
 
 // Queries the type of the state of the named action within
-// @action_group.
+// action_group.
 //
 // If the action is stateful then this function returns the
-// [type@GLib.VariantType] of the state.  All calls to
-// [method@Gio.ActionGroup.change_action_state] must give a [type@GLib.Variant] of this
-// type and [method@Gio.ActionGroup.get_action_state] will return a [type@GLib.Variant]
+// [glib.VariantType] of the state.  All calls to
+// [gio.ActionGroup.ChangeActionState] must give a [glib.Variant] of this
+// type and [gio.ActionGroup.GetActionState] will return a [glib.Variant]
 // of the same type.
 //
 // If the action is not stateful then this function will return `NULL`.
-// In that case, [method@Gio.ActionGroup.get_action_state] will return `NULL`
-// and you must not call [method@Gio.ActionGroup.change_action_state].
+// In that case, [gio.ActionGroup.GetActionState] will return `NULL`
+// and you must not call [gio.ActionGroup.ChangeActionState].
 //
 // The state type of a particular action will never change but it is
 // possible for an action to be removed and for a new action to be added
@@ -415,38 +376,38 @@ func (x *ApplicationWindow) GetActionStateType(ActionNameVar string) *glib.Varia
 	return cret
 }
 
-// Checks if the named action exists within @action_group.
+// Checks if the named action exists within action_group.
 func (x *ApplicationWindow) HasAction(ActionNameVar string) bool {
 
 	cret := gio.XGActionGroupHasAction(x.GoPointer(), ActionNameVar)
 	return cret
 }
 
-// Lists the actions contained within @action_group.
+// Lists the actions contained within action_group.
 //
-// The caller is responsible for freeing the list with [func@GLib.strfreev] when
+// The caller is responsible for freeing the list with [glib.Strfreev] when
 // it is no longer required.
 func (x *ApplicationWindow) ListActions() []string {
 
 	cret := gio.XGActionGroupListActions(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-// Queries all aspects of the named action within an @action_group.
+// Queries all aspects of the named action within an action_group.
 //
 // This function acquires the information available from
-// [method@Gio.ActionGroup.has_action], [method@Gio.ActionGroup.get_action_enabled],
-// [method@Gio.ActionGroup.get_action_parameter_type],
-// [method@Gio.ActionGroup.get_action_state_type],
-// [method@Gio.ActionGroup.get_action_state_hint] and
-// [method@Gio.ActionGroup.get_action_state] with a single function call.
+// [gio.ActionGroup.HasAction], [gio.ActionGroup.GetActionEnabled],
+// [gio.ActionGroup.GetActionParameterType],
+// [gio.ActionGroup.GetActionStateType],
+// [gio.ActionGroup.GetActionStateHint] and
+// [gio.ActionGroup.GetActionState] with a single function call.
 //
 // This provides two main benefits.
 //
 // The first is the improvement in efficiency that comes with not having
 // to perform repeated lookups of the action in order to discover
 // different things about it.  The second is that implementing
-// [type@Gio.ActionGroup] can now be done by only overriding this one virtual
+// [gio.ActionGroup] can now be done by only overriding this one virtual
 // function.
 //
 // The interface provides a default implementation of this function that
@@ -465,47 +426,41 @@ func (x *ApplicationWindow) QueryAction(ActionNameVar string, EnabledVar *bool,
 	return cret
 }
 
-// Adds an action to the @action_map.
+// Adds an action to the action_map.
 //
 // If the action map already contains an action with the same name
-// as @action then the old action is dropped from the action map.
+// as action then the old action is dropped from the action map.
 //
-// The action map takes its own reference on @action.
+// The action map takes its own reference on action.
 func (x *ApplicationWindow) AddAction(ActionVar gio.Action) {
 
 	gio.XGActionMapAddAction(x.GoPointer(), ActionVar.GoPointer())
 
 }
 
-// A convenience function for creating multiple [class@Gio.SimpleAction]
-// instances and adding them to a [iface@Gio.ActionMap].
-//
-// Each action is constructed as per one [struct@Gio.ActionEntry].
+// A convenience function for creating multiple [gio.SimpleAction]
+// instances and adding them to a [gio.ActionMap].
 //
-// ```c
-// static void
-// activate_quit (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
+// Each action is constructed as per one [gio.ActionEntry].
 //
+//	static void
+//	activate_quit (GSimpleAction *simple,
+//	               GVariant      *parameter,
+//	               gpointer       user_data)
 //	{
 //	  exit (0);
 //	}
 //
-// static void
-// activate_print_string (GSimpleAction *simple,
-//
-//	GVariant      *parameter,
-//	gpointer       user_data)
-//
+//	static void
+//	activate_print_string (GSimpleAction *simple,
+//	                       GVariant      *parameter,
+//	                       gpointer       user_data)
 //	{
 //	  g_print ("%s\n", g_variant_get_string (parameter, NULL));
 //	}
 //
-// static GActionGroup *
-// create_action_group (void)
-//
+//	static GActionGroup *
+//	create_action_group (void)
 //	{
 //	  const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
@@ -518,15 +473,13 @@ func (x *ApplicationWindow) AddAction(ActionVar gio.Action) {
 //
 //	  return G_ACTION_GROUP (group);
 //	}
-//
-// ```
 func (x *ApplicationWindow) AddActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int, UserDataVar uintptr) {
 
 	gio.XGActionMapAddActionEntries(x.GoPointer(), EntriesVar, NEntriesVar, UserDataVar)
 
 }
 
-// Looks up the action with the name @action_name in @action_map.
+// Looks up the action with the name action_name in action_map.
 //
 // If no such action exists, returns `NULL`.
 func (x *ApplicationWindow) LookupAction(ActionNameVar string) *gio.ActionBase {
@@ -552,31 +505,25 @@ func (x *ApplicationWindow) RemoveAction(ActionNameVar string) {
 
 }
 
-// Remove actions from a [iface@Gio.ActionMap]. This is meant as the reverse of
-// [method@Gio.ActionMap.add_action_entries].
-//
-// ```c
+// Remove actions from a [gio.ActionMap]. This is meant as the reverse of
+// [gio.ActionMap.AddActionEntries].
 //
 //	static const GActionEntry entries[] = {
 //	    { "quit",         activate_quit              },
 //	    { "print-string", activate_print_string, "s" }
 //	};
 //
-// void
-// add_actions (GActionMap *map)
-//
+//	void
+//	add_actions (GActionMap *map)
 //	{
 //	  g_action_map_add_action_entries (map, entries, G_N_ELEMENTS (entries), NULL);
 //	}
 //
-// void
-// remove_actions (GActionMap *map)
-//
+//	void
+//	remove_actions (GActionMap *map)
 //	{
 //	  g_action_map_remove_action_entries (map, entries, G_N_ELEMENTS (entries));
 //	}
-//
-// ```
 func (x *ApplicationWindow) RemoveActionEntries(EntriesVar []gio.ActionEntry, NEntriesVar int) {
 
 	gio.XGActionMapRemoveActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -744,7 +691,7 @@ func (x *ApplicationWindow) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -752,14 +699,13 @@ func (x *ApplicationWindow) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -783,19 +729,17 @@ func (x *ApplicationWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -816,7 +760,7 @@ func (x *ApplicationWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -825,14 +769,12 @@ func (x *ApplicationWindow) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ApplicationWindow) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -851,10 +793,10 @@ func (x *ApplicationWindow) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ApplicationWindow) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -891,10 +833,10 @@ func (x *ApplicationWindow) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *ApplicationWindow) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -938,7 +880,7 @@ func (x *ApplicationWindow) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *ApplicationWindow) GetFocus() *Widget {
 	var cls *Widget
@@ -954,13 +896,13 @@ func (x *ApplicationWindow) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *ApplicationWindow) SetFocus(FocusVar *Widget) {
 
@@ -973,7 +915,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -982,12 +924,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xApplicationWindowGLibType, libs, "gtk_application_window_get_type")
 
-	core.PuregoSafeRegister(&xNewApplicationWindow, libs, "gtk_application_window_new")
+	gobject.CheckClassSize("GtkApplicationWindowClass", xApplicationWindowGLibType, unsafe.Sizeof(ApplicationWindowClass{}))
+
+	core.PuregoRegisterSince(&xNewApplicationWindow, libs, "gtk_application_window_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xApplicationWindowGetHelpOverlay, libs, "gtk_application_window_get_help_overlay")
-	core.PuregoSafeRegister(&xApplicationWindowGetId, libs, "gtk_application_window_get_id")
-	core.PuregoSafeRegister(&xApplicationWindowGetShowMenubar, libs, "gtk_application_window_get_show_menubar")
-	core.PuregoSafeRegister(&xApplicationWindowSetHelpOverlay, libs, "gtk_application_window_set_help_overlay")
-	core.PuregoSafeRegister(&xApplicationWindowSetShowMenubar, libs, "gtk_application_window_set_show_menubar")
+	core.PuregoRegisterSince(&xApplicationWindowGetId, libs, "gtk_application_window_get_id", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationWindowGetShowMenubar, libs, "gtk_application_window_get_show_menubar", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationWindowSetShowMenubar, libs, "gtk_application_window_set_show_menubar", "GTK", "")
 
 }
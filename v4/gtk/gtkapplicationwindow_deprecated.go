@@ -0,0 +1,63 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xApplicationWindowGetHelpOverlay func(uintptr) uintptr
+
+// Gets the `GtkShortcutsWindow` that is associated with window.
+//
+// See [ApplicationWindow.SetHelpOverlay].
+//
+// Deprecated: since 4.18. `GtkShortcutsWindow` will be removed in GTK 5
+func (x *ApplicationWindow) GetHelpOverlay() *ShortcutsWindow {
+	var cls *ShortcutsWindow
+
+	cret := xApplicationWindowGetHelpOverlay(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ShortcutsWindow{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xApplicationWindowSetHelpOverlay func(uintptr, uintptr)
+
+// Associates a shortcuts window with the application window.
+//
+// Additionally, sets up an action with the name
+// `win.show-help-overlay` to present it.
+//
+// The window takes responsibility for destroying the help overlay.
+//
+// Deprecated: since 4.18. `GtkShortcutsWindow` will be removed in GTK 5
+func (x *ApplicationWindow) SetHelpOverlay(HelpOverlayVar *ShortcutsWindow) {
+
+	xApplicationWindowSetHelpOverlay(x.GoPointer(), HelpOverlayVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xApplicationWindowGetHelpOverlay, libs, "gtk_application_window_get_help_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xApplicationWindowSetHelpOverlay, libs, "gtk_application_window_set_help_overlay", "GTK", "")
+
+}
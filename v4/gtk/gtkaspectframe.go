@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -19,9 +18,9 @@ import (
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkAspectFrame` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkAspectFrame` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkAspectFrame` uses the [enum@Gtk.AccessibleRole.generic] role.
+// Starting from GTK 4.12, `GtkAspectFrame` uses the [AccessibleRoleGenericValue] role.
 type AspectFrame struct {
 	Widget
 }
@@ -57,7 +56,7 @@ func NewAspectFrame(XalignVar float32, YalignVar float32, RatioVar float32, Obey
 
 var xAspectFrameGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *AspectFrame) GetChild() *Widget {
 	var cls *Widget
 
@@ -113,7 +112,7 @@ func (x *AspectFrame) GetYalign() float32 {
 
 var xAspectFrameSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *AspectFrame) SetChild(ChildVar *Widget) {
 
 	xAspectFrameSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -192,7 +191,7 @@ func (x *AspectFrame) GetPropertyObeyChild() bool {
 // The aspect ratio to be used by the `GtkAspectFrame`.
 //
 // This property is only used if
-// [property@Gtk.AspectFrame:obey-child] is set to %FALSE.
+// [AspectFrame.GetPropertyObeyChild] is set to false.
 func (x *AspectFrame) SetPropertyRatio(value float32) {
 	var v gobject.Value
 	v.Init(gobject.TypeFloatVal)
@@ -204,7 +203,7 @@ func (x *AspectFrame) SetPropertyRatio(value float32) {
 // The aspect ratio to be used by the `GtkAspectFrame`.
 //
 // This property is only used if
-// [property@Gtk.AspectFrame:obey-child] is set to %FALSE.
+// [AspectFrame.GetPropertyObeyChild] is set to false.
 func (x *AspectFrame) GetPropertyRatio() float32 {
 	var v gobject.Value
 	x.GetProperty("ratio", &v)
@@ -406,7 +405,7 @@ func (x *AspectFrame) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -414,14 +413,13 @@ func (x *AspectFrame) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *AspectFrame) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -445,19 +443,17 @@ func (x *AspectFrame) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *AspectFrame) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -478,7 +474,7 @@ func (x *AspectFrame) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -487,14 +483,12 @@ func (x *AspectFrame) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *AspectFrame) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -513,10 +507,10 @@ func (x *AspectFrame) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AspectFrame) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -528,7 +522,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -537,17 +531,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xAspectFrameGLibType, libs, "gtk_aspect_frame_get_type")
 
-	core.PuregoSafeRegister(&xNewAspectFrame, libs, "gtk_aspect_frame_new")
-
-	core.PuregoSafeRegister(&xAspectFrameGetChild, libs, "gtk_aspect_frame_get_child")
-	core.PuregoSafeRegister(&xAspectFrameGetObeyChild, libs, "gtk_aspect_frame_get_obey_child")
-	core.PuregoSafeRegister(&xAspectFrameGetRatio, libs, "gtk_aspect_frame_get_ratio")
-	core.PuregoSafeRegister(&xAspectFrameGetXalign, libs, "gtk_aspect_frame_get_xalign")
-	core.PuregoSafeRegister(&xAspectFrameGetYalign, libs, "gtk_aspect_frame_get_yalign")
-	core.PuregoSafeRegister(&xAspectFrameSetChild, libs, "gtk_aspect_frame_set_child")
-	core.PuregoSafeRegister(&xAspectFrameSetObeyChild, libs, "gtk_aspect_frame_set_obey_child")
-	core.PuregoSafeRegister(&xAspectFrameSetRatio, libs, "gtk_aspect_frame_set_ratio")
-	core.PuregoSafeRegister(&xAspectFrameSetXalign, libs, "gtk_aspect_frame_set_xalign")
-	core.PuregoSafeRegister(&xAspectFrameSetYalign, libs, "gtk_aspect_frame_set_yalign")
+	core.PuregoRegisterSince(&xNewAspectFrame, libs, "gtk_aspect_frame_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAspectFrameGetChild, libs, "gtk_aspect_frame_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameGetObeyChild, libs, "gtk_aspect_frame_get_obey_child", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameGetRatio, libs, "gtk_aspect_frame_get_ratio", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameGetXalign, libs, "gtk_aspect_frame_get_xalign", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameGetYalign, libs, "gtk_aspect_frame_get_yalign", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameSetChild, libs, "gtk_aspect_frame_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameSetObeyChild, libs, "gtk_aspect_frame_set_obey_child", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameSetRatio, libs, "gtk_aspect_frame_set_ratio", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameSetXalign, libs, "gtk_aspect_frame_set_xalign", "GTK", "")
+	core.PuregoRegisterSince(&xAspectFrameSetYalign, libs, "gtk_aspect_frame_set_yalign", "GTK", "")
 
 }
@@ -2,12 +2,11 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
-	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -19,7 +18,7 @@ import (
 // It’s called both for computing the next page when the user presses the
 // “forward” button and for handling the behavior of the “last” button.
 //
-// See [method@Gtk.Assistant.set_forward_page_func].
+// See [Assistant.SetForwardPageFunc].
 type AssistantPageFunc func(int, uintptr) int
 
 // Determines the role of a page inside a `GtkAssistant`.
@@ -27,11 +26,13 @@ type AssistantPageFunc func(int, uintptr) int
 // The role is used to handle buttons sensitivity and visibility.
 //
 // Note that an assistant needs to end its page flow with a page of type
-// %GTK_ASSISTANT_PAGE_CONFIRM, %GTK_ASSISTANT_PAGE_SUMMARY or
-// %GTK_ASSISTANT_PAGE_PROGRESS to be correct.
+// [AssistantPageConfirmValue], [AssistantPageSummaryValue] or
+// [AssistantPageProgressValue] to be correct.
 //
 // The Cancel button will only be shown if the page isn’t “committed”.
-// See gtk_assistant_commit() for details.
+// See [Assistant.Commit] for details.
+//
+// Deprecated: since 4.10. `GtkAssistant` will be removed in GTK 5
 type AssistantPageType int
 
 var xAssistantPageTypeGLibType func() types.GType
@@ -61,40 +62,90 @@ const (
 	AssistantPageProgressValue AssistantPageType = 4
 	// Used for when other page types are not
 	//  appropriate. No buttons will be shown, and the application must
-	//  add its own buttons through gtk_assistant_add_action_widget().
+	//  add its own buttons through [Assistant.AddActionWidget].
 	AssistantPageCustomValue AssistantPageType = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AssistantPageType) String() string {
+	switch x {
+	case AssistantPageContentValue:
+		return "GTK_ASSISTANT_PAGE_CONTENT"
+	case AssistantPageIntroValue:
+		return "GTK_ASSISTANT_PAGE_INTRO"
+	case AssistantPageConfirmValue:
+		return "GTK_ASSISTANT_PAGE_CONFIRM"
+	case AssistantPageSummaryValue:
+		return "GTK_ASSISTANT_PAGE_SUMMARY"
+	case AssistantPageProgressValue:
+		return "GTK_ASSISTANT_PAGE_PROGRESS"
+	case AssistantPageCustomValue:
+		return "GTK_ASSISTANT_PAGE_CUSTOM"
+	}
+	return fmt.Sprintf("AssistantPageType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AssistantPageType) Nick() string {
+	switch x {
+	case AssistantPageContentValue:
+		return "content"
+	case AssistantPageIntroValue:
+		return "intro"
+	case AssistantPageConfirmValue:
+		return "confirm"
+	case AssistantPageSummaryValue:
+		return "summary"
+	case AssistantPageProgressValue:
+		return "progress"
+	case AssistantPageCustomValue:
+		return "custom"
+	}
+	return ""
+}
+
+// AssistantPageTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AssistantPageTypeFromString(s string) (AssistantPageType, bool) {
+	switch s {
+	case "GTK_ASSISTANT_PAGE_CONTENT", "content":
+		return AssistantPageContentValue, true
+	case "GTK_ASSISTANT_PAGE_INTRO", "intro":
+		return AssistantPageIntroValue, true
+	case "GTK_ASSISTANT_PAGE_CONFIRM", "confirm":
+		return AssistantPageConfirmValue, true
+	case "GTK_ASSISTANT_PAGE_SUMMARY", "summary":
+		return AssistantPageSummaryValue, true
+	case "GTK_ASSISTANT_PAGE_PROGRESS", "progress":
+		return AssistantPageProgressValue, true
+	case "GTK_ASSISTANT_PAGE_CUSTOM", "custom":
+		return AssistantPageCustomValue, true
+	}
+	return 0, false
+}
+
 // `GtkAssistant` is used to represent a complex as a series of steps.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="assistant-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkAssistant" src="assistant.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Each step consists of one or more pages. `GtkAssistant` guides the user
 // through the pages, and controls the page flow to collect the data needed
 // for the operation.
 //
 // `GtkAssistant` handles which buttons to show and to make sensitive based
-// on page sequence knowledge and the [enum@Gtk.AssistantPageType] of each
+// on page sequence knowledge and the [AssistantPageType] of each
 // page in addition to state information like the *completed* and *committed*
 // page statuses.
 //
 // If you have a case that doesn’t quite fit in `GtkAssistant`s way of
-// handling buttons, you can use the %GTK_ASSISTANT_PAGE_CUSTOM page
+// handling buttons, you can use the [AssistantPageCustomValue] page
 // type and handle buttons yourself.
 //
 // `GtkAssistant` maintains a `GtkAssistantPage` object for each added
 // child, which holds additional per-child properties. You
-// obtain the `GtkAssistantPage` for a child with [method@Gtk.Assistant.get_page].
+// obtain the `GtkAssistantPage` for a child with [Assistant.GetPage].
 //
 // # GtkAssistant as GtkBuildable
 //
 // The `GtkAssistant` implementation of the `GtkBuildable` interface
-// exposes the @action_area as internal children with the name
+// exposes the action_area as internal children with the name
 // “action_area”.
 //
 // To add pages to an assistant in `GtkBuilder`, simply add it as a
@@ -106,6 +157,8 @@ const (
 //
 // `GtkAssistant` has a single CSS node with the name window and style
 // class .assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 type Assistant struct {
 	Window
 }
@@ -122,330 +175,6 @@ func AssistantNewFromInternalPtr(ptr uintptr) *Assistant {
 	return cls
 }
 
-var xNewAssistant func() uintptr
-
-// Creates a new `GtkAssistant`.
-func NewAssistant() *Assistant {
-	var cls *Assistant
-
-	cret := xNewAssistant()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Assistant{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAssistantAddActionWidget func(uintptr, uintptr)
-
-// Adds a widget to the action area of a `GtkAssistant`.
-func (x *Assistant) AddActionWidget(ChildVar *Widget) {
-
-	xAssistantAddActionWidget(x.GoPointer(), ChildVar.GoPointer())
-
-}
-
-var xAssistantAppendPage func(uintptr, uintptr) int
-
-// Appends a page to the @assistant.
-func (x *Assistant) AppendPage(PageVar *Widget) int {
-
-	cret := xAssistantAppendPage(x.GoPointer(), PageVar.GoPointer())
-	return cret
-}
-
-var xAssistantCommit func(uintptr)
-
-// Erases the visited page history.
-//
-// GTK will then hide the back button on the current page,
-// and removes the cancel button from subsequent pages.
-//
-// Use this when the information provided up to the current
-// page is hereafter deemed permanent and cannot be modified
-// or undone. For example, showing a progress page to track
-// a long-running, unreversible operation after the user has
-// clicked apply on a confirmation page.
-func (x *Assistant) Commit() {
-
-	xAssistantCommit(x.GoPointer())
-
-}
-
-var xAssistantGetCurrentPage func(uintptr) int
-
-// Returns the page number of the current page.
-func (x *Assistant) GetCurrentPage() int {
-
-	cret := xAssistantGetCurrentPage(x.GoPointer())
-	return cret
-}
-
-var xAssistantGetNPages func(uintptr) int
-
-// Returns the number of pages in the @assistant
-func (x *Assistant) GetNPages() int {
-
-	cret := xAssistantGetNPages(x.GoPointer())
-	return cret
-}
-
-var xAssistantGetNthPage func(uintptr, int) uintptr
-
-// Returns the child widget contained in page number @page_num.
-func (x *Assistant) GetNthPage(PageNumVar int) *Widget {
-	var cls *Widget
-
-	cret := xAssistantGetNthPage(x.GoPointer(), PageNumVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAssistantGetPage func(uintptr, uintptr) uintptr
-
-// Returns the `GtkAssistantPage` object for @child.
-func (x *Assistant) GetPage(ChildVar *Widget) *AssistantPage {
-	var cls *AssistantPage
-
-	cret := xAssistantGetPage(x.GoPointer(), ChildVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &AssistantPage{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAssistantGetPageComplete func(uintptr, uintptr) bool
-
-// Gets whether @page is complete.
-func (x *Assistant) GetPageComplete(PageVar *Widget) bool {
-
-	cret := xAssistantGetPageComplete(x.GoPointer(), PageVar.GoPointer())
-	return cret
-}
-
-var xAssistantGetPageTitle func(uintptr, uintptr) string
-
-// Gets the title for @page.
-func (x *Assistant) GetPageTitle(PageVar *Widget) string {
-
-	cret := xAssistantGetPageTitle(x.GoPointer(), PageVar.GoPointer())
-	return cret
-}
-
-var xAssistantGetPageType func(uintptr, uintptr) AssistantPageType
-
-// Gets the page type of @page.
-func (x *Assistant) GetPageType(PageVar *Widget) AssistantPageType {
-
-	cret := xAssistantGetPageType(x.GoPointer(), PageVar.GoPointer())
-	return cret
-}
-
-var xAssistantGetPages func(uintptr) uintptr
-
-// Gets a list model of the assistant pages.
-func (x *Assistant) GetPages() *gio.ListModelBase {
-	var cls *gio.ListModelBase
-
-	cret := xAssistantGetPages(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &gio.ListModelBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xAssistantInsertPage func(uintptr, uintptr, int) int
-
-// Inserts a page in the @assistant at a given position.
-func (x *Assistant) InsertPage(PageVar *Widget, PositionVar int) int {
-
-	cret := xAssistantInsertPage(x.GoPointer(), PageVar.GoPointer(), PositionVar)
-	return cret
-}
-
-var xAssistantNextPage func(uintptr)
-
-// Navigate to the next page.
-//
-// It is a programming error to call this function when
-// there is no next page.
-//
-// This function is for use when creating pages of the
-// %GTK_ASSISTANT_PAGE_CUSTOM type.
-func (x *Assistant) NextPage() {
-
-	xAssistantNextPage(x.GoPointer())
-
-}
-
-var xAssistantPrependPage func(uintptr, uintptr) int
-
-// Prepends a page to the @assistant.
-func (x *Assistant) PrependPage(PageVar *Widget) int {
-
-	cret := xAssistantPrependPage(x.GoPointer(), PageVar.GoPointer())
-	return cret
-}
-
-var xAssistantPreviousPage func(uintptr)
-
-// Navigate to the previous visited page.
-//
-// It is a programming error to call this function when
-// no previous page is available.
-//
-// This function is for use when creating pages of the
-// %GTK_ASSISTANT_PAGE_CUSTOM type.
-func (x *Assistant) PreviousPage() {
-
-	xAssistantPreviousPage(x.GoPointer())
-
-}
-
-var xAssistantRemoveActionWidget func(uintptr, uintptr)
-
-// Removes a widget from the action area of a `GtkAssistant`.
-func (x *Assistant) RemoveActionWidget(ChildVar *Widget) {
-
-	xAssistantRemoveActionWidget(x.GoPointer(), ChildVar.GoPointer())
-
-}
-
-var xAssistantRemovePage func(uintptr, int)
-
-// Removes the @page_num’s page from @assistant.
-func (x *Assistant) RemovePage(PageNumVar int) {
-
-	xAssistantRemovePage(x.GoPointer(), PageNumVar)
-
-}
-
-var xAssistantSetCurrentPage func(uintptr, int)
-
-// Switches the page to @page_num.
-//
-// Note that this will only be necessary in custom buttons,
-// as the @assistant flow can be set with
-// gtk_assistant_set_forward_page_func().
-func (x *Assistant) SetCurrentPage(PageNumVar int) {
-
-	xAssistantSetCurrentPage(x.GoPointer(), PageNumVar)
-
-}
-
-var xAssistantSetForwardPageFunc func(uintptr, uintptr, uintptr, uintptr)
-
-// Sets the page forwarding function to be @page_func.
-//
-// This function will be used to determine what will be
-// the next page when the user presses the forward button.
-// Setting @page_func to %NULL will make the assistant to
-// use the default forward function, which just goes to the
-// next visible page.
-func (x *Assistant) SetForwardPageFunc(PageFuncVar *AssistantPageFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
-
-	var PageFuncVarRef uintptr
-	if PageFuncVar != nil {
-		PageFuncVarPtr := uintptr(unsafe.Pointer(PageFuncVar))
-		if cbRefPtr, ok := glib.GetCallback(PageFuncVarPtr); ok {
-			PageFuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 int, arg1 uintptr) int {
-				cbFn := *PageFuncVar
-				return cbFn(arg0, arg1)
-			}
-			PageFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(PageFuncVarPtr, PageFuncVarRef, PageFuncVar)
-		}
-	}
-
-	var DestroyVarRef uintptr
-	if DestroyVar != nil {
-		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
-		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
-			DestroyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *DestroyVar
-				cbFn(arg0)
-			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
-		}
-	}
-
-	xAssistantSetForwardPageFunc(x.GoPointer(), PageFuncVarRef, DataVar, DestroyVarRef)
-
-}
-
-var xAssistantSetPageComplete func(uintptr, uintptr, bool)
-
-// Sets whether @page contents are complete.
-//
-// This will make @assistant update the buttons state
-// to be able to continue the task.
-func (x *Assistant) SetPageComplete(PageVar *Widget, CompleteVar bool) {
-
-	xAssistantSetPageComplete(x.GoPointer(), PageVar.GoPointer(), CompleteVar)
-
-}
-
-var xAssistantSetPageTitle func(uintptr, uintptr, string)
-
-// Sets a title for @page.
-//
-// The title is displayed in the header area of the assistant
-// when @page is the current page.
-func (x *Assistant) SetPageTitle(PageVar *Widget, TitleVar string) {
-
-	xAssistantSetPageTitle(x.GoPointer(), PageVar.GoPointer(), TitleVar)
-
-}
-
-var xAssistantSetPageType func(uintptr, uintptr, AssistantPageType)
-
-// Sets the page type for @page.
-//
-// The page type determines the page behavior in the @assistant.
-func (x *Assistant) SetPageType(PageVar *Widget, TypeVar AssistantPageType) {
-
-	xAssistantSetPageType(x.GoPointer(), PageVar.GoPointer(), TypeVar)
-
-}
-
-var xAssistantUpdateButtonsState func(uintptr)
-
-// Forces @assistant to recompute the buttons state.
-//
-// GTK automatically takes care of this in most situations,
-// e.g. when the user goes to a different page, or when the
-// visibility or completeness of a page changes.
-//
-// One situation where it can be necessary to call this
-// function is when changing a value on the current page
-// affects the future page flow of the assistant.
-func (x *Assistant) UpdateButtonsState() {
-
-	xAssistantUpdateButtonsState(x.GoPointer())
-
-}
-
 func (c *Assistant) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -458,11 +187,13 @@ func (c *Assistant) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyUseHeaderBar sets the "use-header-bar" property.
-// %TRUE if the assistant uses a `GtkHeaderBar` for action buttons
+// true if the assistant uses a `GtkHeaderBar` for action buttons
 // instead of the action-area.
 //
 // For technical reasons, this property is declared as an integer
-// property, but you should only set it to %TRUE or %FALSE.
+// property, but you should only set it to true or false.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) SetPropertyUseHeaderBar(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -471,11 +202,13 @@ func (x *Assistant) SetPropertyUseHeaderBar(value int) {
 }
 
 // GetPropertyUseHeaderBar gets the "use-header-bar" property.
-// %TRUE if the assistant uses a `GtkHeaderBar` for action buttons
+// true if the assistant uses a `GtkHeaderBar` for action buttons
 // instead of the action-area.
 //
 // For technical reasons, this property is declared as an integer
-// property, but you should only set it to %TRUE or %FALSE.
+// property, but you should only set it to true or false.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) GetPropertyUseHeaderBar() int {
 	var v gobject.Value
 	x.GetProperty("use-header-bar", &v)
@@ -490,132 +223,252 @@ func (x *Assistant) GetPropertyUseHeaderBar() int {
 // A handler for the ::apply signal should carry out the actions for
 // which the wizard has collected data. If the action takes a long time
 // to complete, you might consider putting a page of type
-// %GTK_ASSISTANT_PAGE_PROGRESS after the confirmation page and handle
-// this operation within the [signal@Gtk.Assistant::prepare] signal of
+// [AssistantPageProgressValue] after the confirmation page and handle
+// this operation within the [Assistant.ConnectPrepareFunc] signal of
 // the progress page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) ConnectApply(cb *func(Assistant)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Assistant{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "apply", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xAssistantApplyTrampoline uintptr
+
+func xAssistantApplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Assistant))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Assistant{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "apply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplyFunc connects cb to the "apply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "apply" signal is deprecated, see ConnectApply.
+func (x *Assistant) ConnectApplyFunc(cb func(Assistant)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "apply", &xAssistantApplyTrampoline, xAssistantApplyNewTrampoline, cb)
 }
 
 // Emitted when then the cancel button is clicked.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) ConnectCancel(cb *func(Assistant)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cancel", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Assistant{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cancel", cbRefPtr, cbPtr)
+}
+
+var xAssistantCancelTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xAssistantCancelNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Assistant))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Assistant{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cancel", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCancelFunc connects cb to the "cancel" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "cancel" signal is deprecated, see ConnectCancel.
+func (x *Assistant) ConnectCancelFunc(cb func(Assistant)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cancel", &xAssistantCancelTrampoline, xAssistantCancelNewTrampoline, cb)
 }
 
 // Emitted either when the close button of a summary page is clicked,
 // or when the apply button in the last page in the flow (of type
-// %GTK_ASSISTANT_PAGE_CONFIRM) is clicked.
+// [AssistantPageConfirmValue]) is clicked.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) ConnectClose(cb *func(Assistant)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Assistant{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close", cbRefPtr, cbPtr)
+}
+
+var xAssistantCloseTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xAssistantCloseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Assistant))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Assistant{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCloseFunc connects cb to the "close" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "close" signal is deprecated, see ConnectClose.
+func (x *Assistant) ConnectCloseFunc(cb func(Assistant)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close", &xAssistantCloseTrampoline, xAssistantCloseNewTrampoline, cb)
 }
 
 // The action signal for the Escape binding.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) ConnectEscape(cb *func(Assistant)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "escape", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Assistant{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "escape", cbRefPtr, cbPtr)
+}
+
+var xAssistantEscapeTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xAssistantEscapeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Assistant))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Assistant{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "escape", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEscapeFunc connects cb to the "escape" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "escape" signal is deprecated, see ConnectEscape.
+func (x *Assistant) ConnectEscapeFunc(cb func(Assistant)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "escape", &xAssistantEscapeTrampoline, xAssistantEscapeNewTrampoline, cb)
 }
 
 // Emitted when a new page is set as the assistant's current page,
 // before making the new page visible.
 //
 // A handler for this signal can do any preparations which are
-// necessary before showing @page.
+// necessary before showing page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Assistant) ConnectPrepare(cb *func(Assistant, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Assistant{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "prepare", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) {
+var xAssistantPrepareTrampoline uintptr
+
+func xAssistantPrepareNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Assistant, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Assistant{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPrepareFunc connects cb to the "prepare" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "prepare" signal is deprecated, see ConnectPrepare.
+func (x *Assistant) ConnectPrepareFunc(cb func(Assistant, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "prepare", &xAssistantPrepareTrampoline, xAssistantPrepareNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -779,7 +632,7 @@ func (x *Assistant) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -787,14 +640,13 @@ func (x *Assistant) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Assistant) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -818,19 +670,17 @@ func (x *Assistant) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Assistant) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -851,7 +701,7 @@ func (x *Assistant) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -860,14 +710,12 @@ func (x *Assistant) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Assistant) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -886,10 +734,10 @@ func (x *Assistant) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Assistant) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -926,10 +774,10 @@ func (x *Assistant) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *Assistant) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -973,7 +821,7 @@ func (x *Assistant) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *Assistant) GetFocus() *Widget {
 	var cls *Widget
@@ -989,13 +837,13 @@ func (x *Assistant) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *Assistant) SetFocus(FocusVar *Widget) {
 
@@ -1004,6 +852,8 @@ func (x *Assistant) SetFocus(FocusVar *Widget) {
 }
 
 // `GtkAssistantPage` is an auxiliary object used by `GtkAssistant`.
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 type AssistantPage struct {
 	gobject.Object
 }
@@ -1020,23 +870,6 @@ func AssistantPageNewFromInternalPtr(ptr uintptr) *AssistantPage {
 	return cls
 }
 
-var xAssistantPageGetChild func(uintptr) uintptr
-
-// Returns the child to which @page belongs.
-func (x *AssistantPage) GetChild() *Widget {
-	var cls *Widget
-
-	cret := xAssistantPageGetChild(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *AssistantPage) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -1053,6 +886,8 @@ func (c *AssistantPage) SetGoPointer(ptr uintptr) {
 //
 // GTK uses this information to control the sensitivity
 // of the navigation buttons.
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *AssistantPage) SetPropertyComplete(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1065,6 +900,8 @@ func (x *AssistantPage) SetPropertyComplete(value bool) {
 //
 // GTK uses this information to control the sensitivity
 // of the navigation buttons.
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *AssistantPage) GetPropertyComplete() bool {
 	var v gobject.Value
 	x.GetProperty("complete", &v)
@@ -1073,6 +910,8 @@ func (x *AssistantPage) GetPropertyComplete() bool {
 
 // SetPropertyTitle sets the "title" property.
 // The title of the page.
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *AssistantPage) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1082,6 +921,8 @@ func (x *AssistantPage) SetPropertyTitle(value string) {
 
 // GetPropertyTitle gets the "title" property.
 // The title of the page.
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *AssistantPage) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -1093,7 +934,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1104,34 +945,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xAssistantGLibType, libs, "gtk_assistant_get_type")
 
-	core.PuregoSafeRegister(&xNewAssistant, libs, "gtk_assistant_new")
-
-	core.PuregoSafeRegister(&xAssistantAddActionWidget, libs, "gtk_assistant_add_action_widget")
-	core.PuregoSafeRegister(&xAssistantAppendPage, libs, "gtk_assistant_append_page")
-	core.PuregoSafeRegister(&xAssistantCommit, libs, "gtk_assistant_commit")
-	core.PuregoSafeRegister(&xAssistantGetCurrentPage, libs, "gtk_assistant_get_current_page")
-	core.PuregoSafeRegister(&xAssistantGetNPages, libs, "gtk_assistant_get_n_pages")
-	core.PuregoSafeRegister(&xAssistantGetNthPage, libs, "gtk_assistant_get_nth_page")
-	core.PuregoSafeRegister(&xAssistantGetPage, libs, "gtk_assistant_get_page")
-	core.PuregoSafeRegister(&xAssistantGetPageComplete, libs, "gtk_assistant_get_page_complete")
-	core.PuregoSafeRegister(&xAssistantGetPageTitle, libs, "gtk_assistant_get_page_title")
-	core.PuregoSafeRegister(&xAssistantGetPageType, libs, "gtk_assistant_get_page_type")
-	core.PuregoSafeRegister(&xAssistantGetPages, libs, "gtk_assistant_get_pages")
-	core.PuregoSafeRegister(&xAssistantInsertPage, libs, "gtk_assistant_insert_page")
-	core.PuregoSafeRegister(&xAssistantNextPage, libs, "gtk_assistant_next_page")
-	core.PuregoSafeRegister(&xAssistantPrependPage, libs, "gtk_assistant_prepend_page")
-	core.PuregoSafeRegister(&xAssistantPreviousPage, libs, "gtk_assistant_previous_page")
-	core.PuregoSafeRegister(&xAssistantRemoveActionWidget, libs, "gtk_assistant_remove_action_widget")
-	core.PuregoSafeRegister(&xAssistantRemovePage, libs, "gtk_assistant_remove_page")
-	core.PuregoSafeRegister(&xAssistantSetCurrentPage, libs, "gtk_assistant_set_current_page")
-	core.PuregoSafeRegister(&xAssistantSetForwardPageFunc, libs, "gtk_assistant_set_forward_page_func")
-	core.PuregoSafeRegister(&xAssistantSetPageComplete, libs, "gtk_assistant_set_page_complete")
-	core.PuregoSafeRegister(&xAssistantSetPageTitle, libs, "gtk_assistant_set_page_title")
-	core.PuregoSafeRegister(&xAssistantSetPageType, libs, "gtk_assistant_set_page_type")
-	core.PuregoSafeRegister(&xAssistantUpdateButtonsState, libs, "gtk_assistant_update_buttons_state")
-
 	core.PuregoSafeRegister(&xAssistantPageGLibType, libs, "gtk_assistant_page_get_type")
 
-	core.PuregoSafeRegister(&xAssistantPageGetChild, libs, "gtk_assistant_page_get_child")
-
 }
@@ -0,0 +1,448 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewAssistant func() uintptr
+
+// Creates a new `GtkAssistant`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewAssistant() *Assistant {
+	var cls *Assistant
+
+	cret := xNewAssistant()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Assistant{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAssistantAddActionWidget func(uintptr, uintptr)
+
+// Adds a widget to the action area of a `GtkAssistant`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) AddActionWidget(ChildVar *Widget) {
+
+	xAssistantAddActionWidget(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xAssistantAppendPage func(uintptr, uintptr) int
+
+// Appends a page to the assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) AppendPage(PageVar *Widget) int {
+
+	cret := xAssistantAppendPage(x.GoPointer(), PageVar.GoPointer())
+	return cret
+}
+
+var xAssistantCommit func(uintptr)
+
+// Erases the visited page history.
+//
+// GTK will then hide the back button on the current page,
+// and removes the cancel button from subsequent pages.
+//
+// Use this when the information provided up to the current
+// page is hereafter deemed permanent and cannot be modified
+// or undone. For example, showing a progress page to track
+// a long-running, unreversible operation after the user has
+// clicked apply on a confirmation page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) Commit() {
+
+	xAssistantCommit(x.GoPointer())
+
+}
+
+var xAssistantGetCurrentPage func(uintptr) int
+
+// Returns the page number of the current page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetCurrentPage() int {
+
+	cret := xAssistantGetCurrentPage(x.GoPointer())
+	return cret
+}
+
+var xAssistantGetNPages func(uintptr) int
+
+// Returns the number of pages in the assistant
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetNPages() int {
+
+	cret := xAssistantGetNPages(x.GoPointer())
+	return cret
+}
+
+var xAssistantGetNthPage func(uintptr, int) uintptr
+
+// Returns the child widget contained in page number page_num.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetNthPage(PageNumVar int) *Widget {
+	var cls *Widget
+
+	cret := xAssistantGetNthPage(x.GoPointer(), PageNumVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAssistantGetPage func(uintptr, uintptr) uintptr
+
+// Returns the `GtkAssistantPage` object for child.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetPage(ChildVar *Widget) *AssistantPage {
+	var cls *AssistantPage
+
+	cret := xAssistantGetPage(x.GoPointer(), ChildVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &AssistantPage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAssistantGetPageComplete func(uintptr, uintptr) bool
+
+// Gets whether page is complete.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetPageComplete(PageVar *Widget) bool {
+
+	cret := xAssistantGetPageComplete(x.GoPointer(), PageVar.GoPointer())
+	return cret
+}
+
+var xAssistantGetPageTitle func(uintptr, uintptr) string
+
+// Gets the title for page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetPageTitle(PageVar *Widget) string {
+
+	cret := xAssistantGetPageTitle(x.GoPointer(), PageVar.GoPointer())
+	return cret
+}
+
+var xAssistantGetPageType func(uintptr, uintptr) AssistantPageType
+
+// Gets the page type of page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetPageType(PageVar *Widget) AssistantPageType {
+
+	cret := xAssistantGetPageType(x.GoPointer(), PageVar.GoPointer())
+	return cret
+}
+
+var xAssistantGetPages func(uintptr) uintptr
+
+// Gets a list model of the assistant pages.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) GetPages() *gio.ListModelBase {
+	var cls *gio.ListModelBase
+
+	cret := xAssistantGetPages(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gio.ListModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xAssistantInsertPage func(uintptr, uintptr, int) int
+
+// Inserts a page in the assistant at a given position.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) InsertPage(PageVar *Widget, PositionVar int) int {
+
+	cret := xAssistantInsertPage(x.GoPointer(), PageVar.GoPointer(), PositionVar)
+	return cret
+}
+
+var xAssistantNextPage func(uintptr)
+
+// Navigate to the next page.
+//
+// It is a programming error to call this function when
+// there is no next page.
+//
+// This function is for use when creating pages of the
+// [AssistantPageCustomValue] type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) NextPage() {
+
+	xAssistantNextPage(x.GoPointer())
+
+}
+
+var xAssistantPrependPage func(uintptr, uintptr) int
+
+// Prepends a page to the assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) PrependPage(PageVar *Widget) int {
+
+	cret := xAssistantPrependPage(x.GoPointer(), PageVar.GoPointer())
+	return cret
+}
+
+var xAssistantPreviousPage func(uintptr)
+
+// Navigate to the previous visited page.
+//
+// It is a programming error to call this function when
+// no previous page is available.
+//
+// This function is for use when creating pages of the
+// [AssistantPageCustomValue] type.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) PreviousPage() {
+
+	xAssistantPreviousPage(x.GoPointer())
+
+}
+
+var xAssistantRemoveActionWidget func(uintptr, uintptr)
+
+// Removes a widget from the action area of a `GtkAssistant`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) RemoveActionWidget(ChildVar *Widget) {
+
+	xAssistantRemoveActionWidget(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xAssistantRemovePage func(uintptr, int)
+
+// Removes the page_num’s page from assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) RemovePage(PageNumVar int) {
+
+	xAssistantRemovePage(x.GoPointer(), PageNumVar)
+
+}
+
+var xAssistantSetCurrentPage func(uintptr, int)
+
+// Switches the page to page_num.
+//
+// Note that this will only be necessary in custom buttons,
+// as the assistant flow can be set with
+// [Assistant.SetForwardPageFunc].
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) SetCurrentPage(PageNumVar int) {
+
+	xAssistantSetCurrentPage(x.GoPointer(), PageNumVar)
+
+}
+
+var xAssistantSetForwardPageFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the page forwarding function to be page_func.
+//
+// This function will be used to determine what will be
+// the next page when the user presses the forward button.
+// Setting page_func to nil will make the assistant to
+// use the default forward function, which just goes to the
+// next visible page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) SetForwardPageFunc(PageFuncVar *AssistantPageFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var PageFuncVarRef uintptr
+	if PageFuncVar != nil {
+		PageFuncVarPtr := uintptr(unsafe.Pointer(PageFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(PageFuncVarPtr); ok {
+			PageFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 int, arg1 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *PageFuncVar
+				return cbFn(arg0, arg1)
+			}
+			PageFuncVarRef = core.NewCallback(fcb)
+			PageFuncVarRef = glib.SaveCallbackWithClosure(PageFuncVarPtr, PageFuncVarRef, PageFuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xAssistantSetForwardPageFunc(x.GoPointer(), PageFuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+var xAssistantSetPageComplete func(uintptr, uintptr, bool)
+
+// Sets whether page contents are complete.
+//
+// This will make assistant update the buttons state
+// to be able to continue the task.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) SetPageComplete(PageVar *Widget, CompleteVar bool) {
+
+	xAssistantSetPageComplete(x.GoPointer(), PageVar.GoPointer(), CompleteVar)
+
+}
+
+var xAssistantSetPageTitle func(uintptr, uintptr, string)
+
+// Sets a title for page.
+//
+// The title is displayed in the header area of the assistant
+// when page is the current page.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) SetPageTitle(PageVar *Widget, TitleVar string) {
+
+	xAssistantSetPageTitle(x.GoPointer(), PageVar.GoPointer(), TitleVar)
+
+}
+
+var xAssistantSetPageType func(uintptr, uintptr, AssistantPageType)
+
+// Sets the page type for page.
+//
+// The page type determines the page behavior in the assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) SetPageType(PageVar *Widget, TypeVar AssistantPageType) {
+
+	xAssistantSetPageType(x.GoPointer(), PageVar.GoPointer(), TypeVar)
+
+}
+
+var xAssistantUpdateButtonsState func(uintptr)
+
+// Forces assistant to recompute the buttons state.
+//
+// GTK automatically takes care of this in most situations,
+// e.g. when the user goes to a different page, or when the
+// visibility or completeness of a page changes.
+//
+// One situation where it can be necessary to call this
+// function is when changing a value on the current page
+// affects the future page flow of the assistant.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Assistant) UpdateButtonsState() {
+
+	xAssistantUpdateButtonsState(x.GoPointer())
+
+}
+
+var xAssistantPageGetChild func(uintptr) uintptr
+
+// Returns the child to which page belongs.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *AssistantPage) GetChild() *Widget {
+	var cls *Widget
+
+	cret := xAssistantPageGetChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewAssistant, libs, "gtk_assistant_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xAssistantAddActionWidget, libs, "gtk_assistant_add_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantAppendPage, libs, "gtk_assistant_append_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantCommit, libs, "gtk_assistant_commit", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetCurrentPage, libs, "gtk_assistant_get_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetNPages, libs, "gtk_assistant_get_n_pages", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetNthPage, libs, "gtk_assistant_get_nth_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetPage, libs, "gtk_assistant_get_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetPageComplete, libs, "gtk_assistant_get_page_complete", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetPageTitle, libs, "gtk_assistant_get_page_title", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetPageType, libs, "gtk_assistant_get_page_type", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantGetPages, libs, "gtk_assistant_get_pages", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantInsertPage, libs, "gtk_assistant_insert_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantNextPage, libs, "gtk_assistant_next_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantPrependPage, libs, "gtk_assistant_prepend_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantPreviousPage, libs, "gtk_assistant_previous_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantRemoveActionWidget, libs, "gtk_assistant_remove_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantRemovePage, libs, "gtk_assistant_remove_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantSetCurrentPage, libs, "gtk_assistant_set_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantSetForwardPageFunc, libs, "gtk_assistant_set_forward_page_func", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantSetPageComplete, libs, "gtk_assistant_set_page_complete", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantSetPageTitle, libs, "gtk_assistant_set_page_title", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantSetPageType, libs, "gtk_assistant_set_page_type", "GTK", "")
+	core.PuregoRegisterSince(&xAssistantUpdateButtonsState, libs, "gtk_assistant_update_buttons_state", "GTK", "")
+
+	core.PuregoRegisterSince(&xAssistantPageGetChild, libs, "gtk_assistant_page_get_child", "GTK", "")
+
+}
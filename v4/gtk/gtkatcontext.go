@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -101,27 +100,49 @@ func (c *ATContext) SetGoPointer(ptr uintptr) {
 
 // Emitted when the attributes of the accessible for the
 // `GtkATContext` instance change.
+//
+// Deprecated: use ConnectStateChangeFunc, which also accepts method values and closures.
 func (x *ATContext) ConnectStateChange(cb *func(ATContext)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "state-change", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ATContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "state-change", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xATContextStateChangeTrampoline uintptr
+
+func xATContextStateChangeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ATContext))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ATContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "state-change", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStateChangeFunc connects cb to the "state-change" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ATContext) ConnectStateChangeFunc(cb func(ATContext)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "state-change", &xATContextStateChangeTrampoline, xATContextStateChangeNewTrampoline, cb)
 }
 
 func init() {
@@ -129,7 +150,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -138,9 +159,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xATContextGLibType, libs, "gtk_at_context_get_type")
 
-	core.PuregoSafeRegister(&xATContextCreate, libs, "gtk_at_context_create")
+	core.PuregoRegisterSince(&xATContextCreate, libs, "gtk_at_context_create", "GTK", "")
 
-	core.PuregoSafeRegister(&xATContextGetAccessible, libs, "gtk_at_context_get_accessible")
-	core.PuregoSafeRegister(&xATContextGetAccessibleRole, libs, "gtk_at_context_get_accessible_role")
+	core.PuregoRegisterSince(&xATContextGetAccessible, libs, "gtk_at_context_get_accessible", "GTK", "")
+	core.PuregoRegisterSince(&xATContextGetAccessibleRole, libs, "gtk_at_context_get_accessible_role", "GTK", "")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -23,8 +23,8 @@ func (x *BinLayoutClass) GoPointer() uintptr {
 // A layout manager for widgets with a single child.
 //
 // `GtkBinLayout` will stack each child of a widget on top of each other,
-// using the [property@Gtk.Widget:hexpand], [property@Gtk.Widget:vexpand],
-// [property@Gtk.Widget:halign], and [property@Gtk.Widget:valign] properties
+// using the [Widget.GetPropertyHexpand], [Widget.GetPropertyVexpand],
+// Widget:halign, and Widget:valign properties
 // of each child to determine where they should be positioned.
 type BinLayout struct {
 	LayoutManager
@@ -74,7 +74,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -83,6 +83,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xBinLayoutGLibType, libs, "gtk_bin_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewBinLayout, libs, "gtk_bin_layout_new")
+	gobject.CheckClassSize("GtkBinLayoutClass", xBinLayoutGLibType, unsafe.Sizeof(BinLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewBinLayout, libs, "gtk_bin_layout_new", "GTK", "")
 
 }
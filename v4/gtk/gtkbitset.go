@@ -5,18 +5,18 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Iterates over the elements of a [struct@Gtk.Bitset].
+// Iterates over the elements of a [Bitset].
 //
 // `GtkBitSetIter is an opaque, stack-allocated struct.
 //
 // Before a `GtkBitsetIter` can be used, it needs to be initialized with
-// [func@Gtk.BitsetIter.init_first], [func@Gtk.BitsetIter.init_last]
-// or [func@Gtk.BitsetIter.init_at].
+// [BitsetIterInitFirst], [BitsetIterInitLast]
+// or [BitsetIterInitAt].
 type BitsetIter struct {
 	_ structs.HostLayout
 
@@ -33,12 +33,35 @@ func (x *BitsetIter) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the BitsetIter made with g_boxed_copy, release it with Free.
+func (x *BitsetIter) Copy() *BitsetIter {
+	cret := gobject.BoxedCopy(BitsetIterGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*BitsetIter)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the BitsetIter with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *BitsetIter) Free() {
+	gobject.BoxedFree(BitsetIterGLibType(), x.GoPointer())
+}
+
+// NewBitsetIterAlloc returns a zeroed BitsetIter allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated BitsetIter. Release it with Free.
+func NewBitsetIterAlloc() *BitsetIter {
+	cret := core.GMalloc0(max(unsafe.Sizeof(BitsetIter{}), 80))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*BitsetIter)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xBitsetIterGetValue func(uintptr) uint
 
-// Gets the current value that @iter points to.
+// Gets the current value that iter points to.
 //
-// If @iter is not valid and [method@Gtk.BitsetIter.is_valid]
-// returns %FALSE, this function returns 0.
+// If iter is not valid and [BitsetIter.IsValid]
+// returns false, this function returns 0.
 func (x *BitsetIter) GetValue() uint {
 
 	cret := xBitsetIterGetValue(x.GoPointer())
@@ -47,7 +70,7 @@ func (x *BitsetIter) GetValue() uint {
 
 var xBitsetIterIsValid func(uintptr) bool
 
-// Checks if @iter points to a valid value.
+// Checks if iter points to a valid value.
 func (x *BitsetIter) IsValid() bool {
 
 	cret := xBitsetIterIsValid(x.GoPointer())
@@ -56,10 +79,10 @@ func (x *BitsetIter) IsValid() bool {
 
 var xBitsetIterNext func(uintptr, *uint) bool
 
-// Moves @iter to the next value in the set.
+// Moves iter to the next value in the set.
 //
 // If it was already pointing to the last value in the set,
-// %FALSE is returned and @iter is invalidated.
+// false is returned and iter is invalidated.
 func (x *BitsetIter) Next(ValueVar *uint) bool {
 
 	cret := xBitsetIterNext(x.GoPointer(), ValueVar)
@@ -68,10 +91,10 @@ func (x *BitsetIter) Next(ValueVar *uint) bool {
 
 var xBitsetIterPrevious func(uintptr, *uint) bool
 
-// Moves @iter to the previous value in the set.
+// Moves iter to the previous value in the set.
 //
 // If it was already pointing to the first value in the set,
-// %FALSE is returned and @iter is invalidated.
+// false is returned and iter is invalidated.
 func (x *BitsetIter) Previous(ValueVar *uint) bool {
 
 	cret := xBitsetIterPrevious(x.GoPointer(), ValueVar)
@@ -80,10 +103,10 @@ func (x *BitsetIter) Previous(ValueVar *uint) bool {
 
 var xBitsetIterInitAt func(*BitsetIter, *Bitset, uint, *uint) bool
 
-// Initializes @iter to point to @target.
+// Initializes iter to point to target.
 //
-// If @target is not found, finds the next value after it.
-// If no value &gt;= @target exists in @set, this function returns %FALSE.
+// If target is not found, finds the next value after it.
+// If no value >= target exists in set, this function returns false.
 func BitsetIterInitAt(IterVar *BitsetIter, SetVar *Bitset, TargetVar uint, ValueVar *uint) bool {
 
 	cret := xBitsetIterInitAt(IterVar, SetVar, TargetVar, ValueVar)
@@ -92,10 +115,10 @@ func BitsetIterInitAt(IterVar *BitsetIter, SetVar *Bitset, TargetVar uint, Value
 
 var xBitsetIterInitFirst func(*BitsetIter, *Bitset, *uint) bool
 
-// Initializes an iterator for @set and points it to the first
-// value in @set.
+// Initializes an iterator for set and points it to the first
+// value in set.
 //
-// If @set is empty, %FALSE is returned and @value is set to %G_MAXUINT.
+// If set is empty, false is returned and value is set to G_MAXUINT.
 func BitsetIterInitFirst(IterVar *BitsetIter, SetVar *Bitset, ValueVar *uint) bool {
 
 	cret := xBitsetIterInitFirst(IterVar, SetVar, ValueVar)
@@ -104,10 +127,10 @@ func BitsetIterInitFirst(IterVar *BitsetIter, SetVar *Bitset, ValueVar *uint) bo
 
 var xBitsetIterInitLast func(*BitsetIter, *Bitset, *uint) bool
 
-// Initializes an iterator for @set and points it to the last
-// value in @set.
+// Initializes an iterator for set and points it to the last
+// value in set.
 //
-// If @set is empty, %FALSE is returned.
+// If set is empty, false is returned.
 func BitsetIterInitLast(IterVar *BitsetIter, SetVar *Bitset, ValueVar *uint) bool {
 
 	cret := xBitsetIterInitLast(IterVar, SetVar, ValueVar)
@@ -119,22 +142,22 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBitsetIterInitAt, libs, "gtk_bitset_iter_init_at")
-	core.PuregoSafeRegister(&xBitsetIterInitFirst, libs, "gtk_bitset_iter_init_first")
-	core.PuregoSafeRegister(&xBitsetIterInitLast, libs, "gtk_bitset_iter_init_last")
+	core.PuregoRegisterSince(&xBitsetIterInitAt, libs, "gtk_bitset_iter_init_at", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIterInitFirst, libs, "gtk_bitset_iter_init_first", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIterInitLast, libs, "gtk_bitset_iter_init_last", "GTK", "")
 
 	core.PuregoSafeRegister(&xBitsetIterGLibType, libs, "gtk_bitset_iter_get_type")
 
-	core.PuregoSafeRegister(&xBitsetIterGetValue, libs, "gtk_bitset_iter_get_value")
-	core.PuregoSafeRegister(&xBitsetIterIsValid, libs, "gtk_bitset_iter_is_valid")
-	core.PuregoSafeRegister(&xBitsetIterNext, libs, "gtk_bitset_iter_next")
-	core.PuregoSafeRegister(&xBitsetIterPrevious, libs, "gtk_bitset_iter_previous")
+	core.PuregoRegisterSince(&xBitsetIterGetValue, libs, "gtk_bitset_iter_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIterIsValid, libs, "gtk_bitset_iter_is_valid", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIterNext, libs, "gtk_bitset_iter_next", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIterPrevious, libs, "gtk_bitset_iter_previous", "GTK", "")
 
 }
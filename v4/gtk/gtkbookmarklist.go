@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -48,7 +47,7 @@ func BookmarkListNewFromInternalPtr(ptr uintptr) *BookmarkList {
 
 var xNewBookmarkList func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkBookmarkList` with the given @attributes.
+// Creates a new `GtkBookmarkList` with the given attributes.
 func NewBookmarkList(FilenameVar *string, AttributesVar *string) *BookmarkList {
 	var cls *BookmarkList
 
@@ -98,9 +97,9 @@ func (x *BookmarkList) GetIoPriority() int {
 
 var xBookmarkListIsLoading func(uintptr) bool
 
-// Returns %TRUE if the files are currently being loaded.
+// Returns true if the files are currently being loaded.
 //
-// Files will be added to @self from time to time while loading is
+// Files will be added to self from time to time while loading is
 // going on. The order in which are added is undefined and may change
 // in between runs.
 func (x *BookmarkList) IsLoading() bool {
@@ -111,9 +110,9 @@ func (x *BookmarkList) IsLoading() bool {
 
 var xBookmarkListSetAttributes func(uintptr, uintptr)
 
-// Sets the @attributes to be enumerated and starts the enumeration.
+// Sets the attributes to be enumerated and starts the enumeration.
 //
-// If @attributes is %NULL, no attributes will be queried, but a list
+// If attributes is nil, no attributes will be queried, but a list
 // of `GFileInfo`s will still be created.
 func (x *BookmarkList) SetAttributes(AttributesVar *string) {
 
@@ -128,7 +127,7 @@ var xBookmarkListSetIoPriority func(uintptr, int)
 
 // Sets the IO priority to use while loading files.
 //
-// The default IO priority is %G_PRIORITY_DEFAULT.
+// The default IO priority is [glib.PRIORITY_DEFAULT].
 func (x *BookmarkList) SetIoPriority(IoPriorityVar int) {
 
 	xBookmarkListSetIoPriority(x.GoPointer(), IoPriorityVar)
@@ -198,7 +197,7 @@ func (x *BookmarkList) GetPropertyIoPriority() int {
 }
 
 // GetPropertyLoading gets the "loading" property.
-// %TRUE if files are being loaded.
+// true if files are being loaded.
 func (x *BookmarkList) GetPropertyLoading() bool {
 	var v gobject.Value
 	x.GetProperty("loading", &v)
@@ -206,35 +205,37 @@ func (x *BookmarkList) GetPropertyLoading() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *BookmarkList) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *BookmarkList) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *BookmarkList) GetItemType() types.GType {
 
@@ -242,29 +243,29 @@ func (x *BookmarkList) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *BookmarkList) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *BookmarkList) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -278,20 +279,20 @@ func (x *BookmarkList) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -309,7 +310,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -318,13 +319,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xBookmarkListGLibType, libs, "gtk_bookmark_list_get_type")
 
-	core.PuregoSafeRegister(&xNewBookmarkList, libs, "gtk_bookmark_list_new")
+	gobject.CheckClassSize("GtkBookmarkListClass", xBookmarkListGLibType, unsafe.Sizeof(BookmarkListClass{}))
+
+	core.PuregoRegisterSince(&xNewBookmarkList, libs, "gtk_bookmark_list_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xBookmarkListGetAttributes, libs, "gtk_bookmark_list_get_attributes")
-	core.PuregoSafeRegister(&xBookmarkListGetFilename, libs, "gtk_bookmark_list_get_filename")
-	core.PuregoSafeRegister(&xBookmarkListGetIoPriority, libs, "gtk_bookmark_list_get_io_priority")
-	core.PuregoSafeRegister(&xBookmarkListIsLoading, libs, "gtk_bookmark_list_is_loading")
-	core.PuregoSafeRegister(&xBookmarkListSetAttributes, libs, "gtk_bookmark_list_set_attributes")
-	core.PuregoSafeRegister(&xBookmarkListSetIoPriority, libs, "gtk_bookmark_list_set_io_priority")
+	core.PuregoRegisterSince(&xBookmarkListGetAttributes, libs, "gtk_bookmark_list_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xBookmarkListGetFilename, libs, "gtk_bookmark_list_get_filename", "GTK", "")
+	core.PuregoRegisterSince(&xBookmarkListGetIoPriority, libs, "gtk_bookmark_list_get_io_priority", "GTK", "")
+	core.PuregoRegisterSince(&xBookmarkListIsLoading, libs, "gtk_bookmark_list_is_loading", "GTK", "")
+	core.PuregoRegisterSince(&xBookmarkListSetAttributes, libs, "gtk_bookmark_list_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xBookmarkListSetIoPriority, libs, "gtk_bookmark_list_set_io_priority", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -135,7 +134,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -144,11 +143,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xBoolFilterGLibType, libs, "gtk_bool_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewBoolFilter, libs, "gtk_bool_filter_new")
+	gobject.CheckClassSize("GtkBoolFilterClass", xBoolFilterGLibType, unsafe.Sizeof(BoolFilterClass{}))
 
-	core.PuregoSafeRegister(&xBoolFilterGetExpression, libs, "gtk_bool_filter_get_expression")
-	core.PuregoSafeRegister(&xBoolFilterGetInvert, libs, "gtk_bool_filter_get_invert")
-	core.PuregoSafeRegister(&xBoolFilterSetExpression, libs, "gtk_bool_filter_set_expression")
-	core.PuregoSafeRegister(&xBoolFilterSetInvert, libs, "gtk_bool_filter_set_invert")
+	core.PuregoRegisterSince(&xNewBoolFilter, libs, "gtk_bool_filter_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xBoolFilterGetExpression, libs, "gtk_bool_filter_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xBoolFilterGetInvert, libs, "gtk_bool_filter_get_invert", "GTK", "")
+	core.PuregoRegisterSince(&xBoolFilterSetExpression, libs, "gtk_bool_filter_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xBoolFilterSetInvert, libs, "gtk_bool_filter_set_invert", "GTK", "")
 
 }
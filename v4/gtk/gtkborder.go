@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -35,6 +34,14 @@ func (x *Border) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewBorderAlloc returns a zeroed Border allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated Border. Release it with Free.
+func NewBorderAlloc() *Border {
+	cret := core.GMalloc0(max(unsafe.Sizeof(Border{}), 8))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Border)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xNewBorder func() *Border
 
 // Allocates a new `GtkBorder` struct and initializes its elements to zero.
@@ -67,7 +74,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -76,9 +83,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xBorderGLibType, libs, "gtk_border_get_type")
 
-	core.PuregoSafeRegister(&xNewBorder, libs, "gtk_border_new")
+	core.PuregoRegisterSince(&xNewBorder, libs, "gtk_border_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xBorderCopy, libs, "gtk_border_copy")
-	core.PuregoSafeRegister(&xBorderFree, libs, "gtk_border_free")
+	core.PuregoRegisterSince(&xBorderCopy, libs, "gtk_border_copy", "GTK", "")
+	core.PuregoRegisterSince(&xBorderFree, libs, "gtk_border_free", "GTK", "")
 
 }
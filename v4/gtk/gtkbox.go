@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -25,32 +24,25 @@ func (x *BoxClass) GoPointer() uintptr {
 
 // Arranges child widgets into a single row or column.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkBox" src="box.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Whether it is a row or column depends on the value of its
-// [property@Gtk.Orientable:orientation] property. Within the other
+// Orientable:orientation property. Within the other
 // dimension, all children are allocated the same size. The
-// [property@Gtk.Widget:halign] and [property@Gtk.Widget:valign]
+// Widget:halign and Widget:valign
 // properties can be used on the children to influence their allocation.
 //
-// Use repeated calls to [method@Gtk.Box.append] to pack widgets into a
-// `GtkBox` from start to end. Use [method@Gtk.Box.remove] to remove widgets
-// from the `GtkBox`. [method@Gtk.Box.insert_child_after] can be used to add
+// Use repeated calls to [Box.Append] to pack widgets into a
+// `GtkBox` from start to end. Use [Box.Remove] to remove widgets
+// from the `GtkBox`. [Box.InsertChildAfter] can be used to add
 // a child at a particular position.
 //
-// Use [method@Gtk.Box.set_homogeneous] to specify whether or not all children
+// Use [Box.SetHomogeneous] to specify whether or not all children
 // of the `GtkBox` are forced to get the same amount of space.
 //
-// Use [method@Gtk.Box.set_spacing] to determine how much space will be minimally
+// Use [Box.SetSpacing] to determine how much space will be minimally
 // placed between all children in the `GtkBox`. Note that spacing is added
 // *between* the children.
 //
-// Use [method@Gtk.Box.reorder_child_after] to move a child to a different
+// Use [Box.ReorderChildAfter] to move a child to a different
 // place in the box.
 //
 // # CSS nodes
@@ -59,9 +51,9 @@ func (x *BoxClass) GoPointer() uintptr {
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkBox` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkBox` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkBox` uses the [enum@Gtk.AccessibleRole.generic] role.
+// Starting from GTK 4.12, `GtkBox` uses the [AccessibleRoleGenericValue] role.
 type Box struct {
 	Widget
 }
@@ -106,7 +98,7 @@ func (x *Box) Append(ChildVar *Widget) {
 
 var xBoxGetBaselineChild func(uintptr) int
 
-// Gets the value set by [method@Gtk.Box.set_baseline_child].
+// Gets the value set by [Box.SetBaselineChild].
 func (x *Box) GetBaselineChild() int {
 
 	cret := xBoxGetBaselineChild(x.GoPointer())
@@ -115,7 +107,7 @@ func (x *Box) GetBaselineChild() int {
 
 var xBoxGetBaselinePosition func(uintptr) BaselinePosition
 
-// Gets the value set by [method@Gtk.Box.set_baseline_position].
+// Gets the value set by [Box.SetBaselinePosition].
 func (x *Box) GetBaselinePosition() BaselinePosition {
 
 	cret := xBoxGetBaselinePosition(x.GoPointer())
@@ -135,7 +127,7 @@ func (x *Box) GetHomogeneous() bool {
 
 var xBoxGetSpacing func(uintptr) int
 
-// Gets the value set by [method@Gtk.Box.set_spacing].
+// Gets the value set by [Box.SetSpacing].
 func (x *Box) GetSpacing() int {
 
 	cret := xBoxGetSpacing(x.GoPointer())
@@ -146,9 +138,9 @@ var xBoxInsertChildAfter func(uintptr, uintptr, uintptr)
 
 // Inserts a child at a specific position.
 //
-// The child is added after @sibling in the list of @box children.
+// The child is added after sibling in the list of box children.
 //
-// If @sibling is `NULL`, the @child is placed at the beginning.
+// If sibling is `NULL`, the child is placed at the beginning.
 func (x *Box) InsertChildAfter(ChildVar *Widget, SiblingVar *Widget) {
 
 	xBoxInsertChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
@@ -169,8 +161,8 @@ var xBoxRemove func(uintptr, uintptr)
 // Removes a child widget from the box.
 //
 // The child must have been added before with
-// [method@Gtk.Box.append], [method@Gtk.Box.prepend], or
-// [method@Gtk.Box.insert_child_after].
+// [Box.Append], [Box.Prepend], or
+// [Box.InsertChildAfter].
 func (x *Box) Remove(ChildVar *Widget) {
 
 	xBoxRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -181,10 +173,10 @@ var xBoxReorderChildAfter func(uintptr, uintptr, uintptr)
 
 // Moves a child to a different position.
 //
-// The child is moved to the position after @sibling in the list
-// of @box children.
+// The child is moved to the position after sibling in the list
+// of box children.
 //
-// If @sibling is `NULL`, the child is placed at the beginning.
+// If sibling is `NULL`, the child is placed at the beginning.
 func (x *Box) ReorderChildAfter(ChildVar *Widget, SiblingVar *Widget) {
 
 	xBoxReorderChildAfter(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer())
@@ -209,7 +201,7 @@ var xBoxSetBaselinePosition func(uintptr, BaselinePosition)
 // This affects only horizontal boxes with at least one baseline
 // aligned child. If there is more vertical space available than
 // requested, and the baseline is not allocated by the parent then
-// @position is used to allocate the baseline with respect to the
+// position is used to allocate the baseline with respect to the
 // extra space available.
 func (x *Box) SetBaselinePosition(PositionVar BaselinePosition) {
 
@@ -463,7 +455,7 @@ func (x *Box) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -471,14 +463,13 @@ func (x *Box) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Box) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -502,19 +493,17 @@ func (x *Box) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessible
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Box) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -535,7 +524,7 @@ func (x *Box) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleRe
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -544,14 +533,12 @@ func (x *Box) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleRe
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Box) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -570,24 +557,24 @@ func (x *Box) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Valu
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Box) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Box) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Box) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -599,7 +586,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -608,20 +595,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xBoxGLibType, libs, "gtk_box_get_type")
 
-	core.PuregoSafeRegister(&xNewBox, libs, "gtk_box_new")
-
-	core.PuregoSafeRegister(&xBoxAppend, libs, "gtk_box_append")
-	core.PuregoSafeRegister(&xBoxGetBaselineChild, libs, "gtk_box_get_baseline_child")
-	core.PuregoSafeRegister(&xBoxGetBaselinePosition, libs, "gtk_box_get_baseline_position")
-	core.PuregoSafeRegister(&xBoxGetHomogeneous, libs, "gtk_box_get_homogeneous")
-	core.PuregoSafeRegister(&xBoxGetSpacing, libs, "gtk_box_get_spacing")
-	core.PuregoSafeRegister(&xBoxInsertChildAfter, libs, "gtk_box_insert_child_after")
-	core.PuregoSafeRegister(&xBoxPrepend, libs, "gtk_box_prepend")
-	core.PuregoSafeRegister(&xBoxRemove, libs, "gtk_box_remove")
-	core.PuregoSafeRegister(&xBoxReorderChildAfter, libs, "gtk_box_reorder_child_after")
-	core.PuregoSafeRegister(&xBoxSetBaselineChild, libs, "gtk_box_set_baseline_child")
-	core.PuregoSafeRegister(&xBoxSetBaselinePosition, libs, "gtk_box_set_baseline_position")
-	core.PuregoSafeRegister(&xBoxSetHomogeneous, libs, "gtk_box_set_homogeneous")
-	core.PuregoSafeRegister(&xBoxSetSpacing, libs, "gtk_box_set_spacing")
+	gobject.CheckClassSize("GtkBoxClass", xBoxGLibType, unsafe.Sizeof(BoxClass{}))
+
+	core.PuregoRegisterSince(&xNewBox, libs, "gtk_box_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xBoxAppend, libs, "gtk_box_append", "GTK", "")
+	core.PuregoRegisterSince(&xBoxGetBaselineChild, libs, "gtk_box_get_baseline_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xBoxGetBaselinePosition, libs, "gtk_box_get_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xBoxGetHomogeneous, libs, "gtk_box_get_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xBoxGetSpacing, libs, "gtk_box_get_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xBoxInsertChildAfter, libs, "gtk_box_insert_child_after", "GTK", "")
+	core.PuregoRegisterSince(&xBoxPrepend, libs, "gtk_box_prepend", "GTK", "")
+	core.PuregoRegisterSince(&xBoxRemove, libs, "gtk_box_remove", "GTK", "")
+	core.PuregoRegisterSince(&xBoxReorderChildAfter, libs, "gtk_box_reorder_child_after", "GTK", "")
+	core.PuregoRegisterSince(&xBoxSetBaselineChild, libs, "gtk_box_set_baseline_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xBoxSetBaselinePosition, libs, "gtk_box_set_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xBoxSetHomogeneous, libs, "gtk_box_set_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xBoxSetSpacing, libs, "gtk_box_set_spacing", "GTK", "")
 
 }
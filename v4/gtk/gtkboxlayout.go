@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,16 +23,16 @@ func (x *BoxLayoutClass) GoPointer() uintptr {
 // Arranges children in a single row or column.
 //
 // Whether it is a row or column depends on the value of its
-// [property@Gtk.Orientable:orientation] property. Within the other dimension
+// Orientable:orientation property. Within the other dimension
 // all children all allocated the same size. The `GtkBoxLayout` will respect
-// the [property@Gtk.Widget:halign] and [property@Gtk.Widget:valign]
+// the Widget:halign and Widget:valign
 // properties of each child widget.
 //
 // If you want all children to be assigned the same size, you can use
-// the [property@Gtk.BoxLayout:homogeneous] property.
+// the [BoxLayout.GetPropertyHomogeneous] property.
 //
 // If you want to specify the amount of space placed between each child,
-// you can use the [property@Gtk.BoxLayout:spacing] property.
+// you can use the [BoxLayout.GetPropertySpacing] property.
 type BoxLayout struct {
 	LayoutManager
 }
@@ -68,7 +67,7 @@ func NewBoxLayout(OrientationVar Orientation) *BoxLayout {
 
 var xBoxLayoutGetBaselineChild func(uintptr) int
 
-// Gets the value set by gtk_box_layout_set_baseline_child().
+// Gets the value set by [BoxLayout.SetBaselineChild].
 func (x *BoxLayout) GetBaselineChild() int {
 
 	cret := xBoxLayoutGetBaselineChild(x.GoPointer())
@@ -77,7 +76,7 @@ func (x *BoxLayout) GetBaselineChild() int {
 
 var xBoxLayoutGetBaselinePosition func(uintptr) BaselinePosition
 
-// Gets the value set by gtk_box_layout_set_baseline_position().
+// Gets the value set by [BoxLayout.SetBaselinePosition].
 func (x *BoxLayout) GetBaselinePosition() BaselinePosition {
 
 	cret := xBoxLayoutGetBaselinePosition(x.GoPointer())
@@ -95,7 +94,7 @@ func (x *BoxLayout) GetHomogeneous() bool {
 
 var xBoxLayoutGetSpacing func(uintptr) uint
 
-// Returns the space that @box_layout puts between children.
+// Returns the space that box_layout puts between children.
 func (x *BoxLayout) GetSpacing() uint {
 
 	cret := xBoxLayoutGetSpacing(x.GoPointer())
@@ -119,7 +118,7 @@ var xBoxLayoutSetBaselinePosition func(uintptr, BaselinePosition)
 // The baseline position affects only horizontal boxes with at least one
 // baseline aligned child. If there is more vertical space available than
 // requested, and the baseline is not allocated by the parent then the
-// given @position is used to allocate the baseline within the extra
+// given position is used to allocate the baseline within the extra
 // space available.
 func (x *BoxLayout) SetBaselinePosition(PositionVar BaselinePosition) {
 
@@ -220,14 +219,14 @@ func (x *BoxLayout) GetPropertySpacing() int {
 	return v.GetInt()
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *BoxLayout) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *BoxLayout) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -239,7 +238,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -248,15 +247,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xBoxLayoutGLibType, libs, "gtk_box_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewBoxLayout, libs, "gtk_box_layout_new")
+	gobject.CheckClassSize("GtkBoxLayoutClass", xBoxLayoutGLibType, unsafe.Sizeof(BoxLayoutClass{}))
 
-	core.PuregoSafeRegister(&xBoxLayoutGetBaselineChild, libs, "gtk_box_layout_get_baseline_child")
-	core.PuregoSafeRegister(&xBoxLayoutGetBaselinePosition, libs, "gtk_box_layout_get_baseline_position")
-	core.PuregoSafeRegister(&xBoxLayoutGetHomogeneous, libs, "gtk_box_layout_get_homogeneous")
-	core.PuregoSafeRegister(&xBoxLayoutGetSpacing, libs, "gtk_box_layout_get_spacing")
-	core.PuregoSafeRegister(&xBoxLayoutSetBaselineChild, libs, "gtk_box_layout_set_baseline_child")
-	core.PuregoSafeRegister(&xBoxLayoutSetBaselinePosition, libs, "gtk_box_layout_set_baseline_position")
-	core.PuregoSafeRegister(&xBoxLayoutSetHomogeneous, libs, "gtk_box_layout_set_homogeneous")
-	core.PuregoSafeRegister(&xBoxLayoutSetSpacing, libs, "gtk_box_layout_set_spacing")
+	core.PuregoRegisterSince(&xNewBoxLayout, libs, "gtk_box_layout_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xBoxLayoutGetBaselineChild, libs, "gtk_box_layout_get_baseline_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xBoxLayoutGetBaselinePosition, libs, "gtk_box_layout_get_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xBoxLayoutGetHomogeneous, libs, "gtk_box_layout_get_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xBoxLayoutGetSpacing, libs, "gtk_box_layout_get_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xBoxLayoutSetBaselineChild, libs, "gtk_box_layout_set_baseline_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xBoxLayoutSetBaselinePosition, libs, "gtk_box_layout_set_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xBoxLayoutSetHomogeneous, libs, "gtk_box_layout_set_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xBoxLayoutSetSpacing, libs, "gtk_box_layout_set_spacing", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -17,7 +16,7 @@ import (
 type BuildableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSetId uintptr
 
@@ -54,7 +53,8 @@ func (x *BuildableIface) OverrideSetId(cb func(Buildable, string)) {
 	if cb == nil {
 		x.xSetId = 0
 	} else {
-		x.xSetId = purego.NewCallback(func(BuildableVarp uintptr, IdVarp string) {
+		x.xSetId = core.NewCallback(func(BuildableVarp uintptr, IdVarp string) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, IdVarp)
 		})
 	}
@@ -71,71 +71,73 @@ func (x *BuildableIface) GetSetId() func(Buildable, string) {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, IdVarp string)
-	purego.RegisterFunc(&rawCallback, x.xSetId)
+	core.RegisterFunc(&rawCallback, x.xSetId)
 	return func(BuildableVar Buildable, IdVar string) {
 		rawCallback(BuildableVar.GoPointer(), IdVar)
 	}
 }
 
 // OverrideGetId sets the "get_id" callback function.
-// The getter corresponding to @set_id. Implement this
+// The getter corresponding to set_id. Implement this
 //
-//	if you implement @set_id.
+//	if you implement set_id.
 func (x *BuildableIface) OverrideGetId(cb func(Buildable) string) {
 	if cb == nil {
 		x.xGetId = 0
 	} else {
-		x.xGetId = purego.NewCallback(func(BuildableVarp uintptr) string {
+		x.xGetId = core.NewCallback(func(BuildableVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&BuildableBase{Ptr: BuildableVarp})
 		})
 	}
 }
 
 // GetGetId gets the "get_id" callback function.
-// The getter corresponding to @set_id. Implement this
+// The getter corresponding to set_id. Implement this
 //
-//	if you implement @set_id.
+//	if you implement set_id.
 func (x *BuildableIface) GetGetId() func(Buildable) string {
 	if x.xGetId == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetId)
+	core.RegisterFunc(&rawCallback, x.xGetId)
 	return func(BuildableVar Buildable) string {
 		return rawCallback(BuildableVar.GoPointer())
 	}
 }
 
 // OverrideAddChild sets the "add_child" callback function.
-// Adds a child. The @type parameter can be used to
+// Adds a child. The type parameter can be used to
 //
 //	differentiate the kind of child. `GtkWidget` implements this
 //	to add event controllers to the widget, `GtkNotebook` uses
-//	the @type to distinguish between page labels (of type "page-label")
+//	the type to distinguish between page labels (of type "page-label")
 //	and normal children.
 func (x *BuildableIface) OverrideAddChild(cb func(Buildable, *Builder, *gobject.Object, string)) {
 	if cb == nil {
 		x.xAddChild = 0
 	} else {
-		x.xAddChild = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TypeVarp string) {
+		x.xAddChild = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TypeVarp string) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), gobject.ObjectNewFromInternalPtr(ChildVarp), TypeVarp)
 		})
 	}
 }
 
 // GetAddChild gets the "add_child" callback function.
-// Adds a child. The @type parameter can be used to
+// Adds a child. The type parameter can be used to
 //
 //	differentiate the kind of child. `GtkWidget` implements this
 //	to add event controllers to the widget, `GtkNotebook` uses
-//	the @type to distinguish between page labels (of type "page-label")
+//	the type to distinguish between page labels (of type "page-label")
 //	and normal children.
 func (x *BuildableIface) GetAddChild() func(Buildable, *Builder, *gobject.Object, string) {
 	if x.xAddChild == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TypeVarp string)
-	purego.RegisterFunc(&rawCallback, x.xAddChild)
+	core.RegisterFunc(&rawCallback, x.xAddChild)
 	return func(BuildableVar Buildable, BuilderVar *Builder, ChildVar *gobject.Object, TypeVar string) {
 		rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), ChildVar.GoPointer(), TypeVar)
 	}
@@ -144,15 +146,16 @@ func (x *BuildableIface) GetAddChild() func(Buildable, *Builder, *gobject.Object
 // OverrideSetBuildableProperty sets the "set_buildable_property" callback function.
 // Sets a property of a buildable object.
 //
-//	It is normally not necessary to implement this, g_object_set_property()
+//	It is normally not necessary to implement this, [gobject.Object.SetProperty]
 //	is used by default. `GtkWindow` implements this to delay showing itself
-//	(i.e. setting the [property@Gtk.Widget:visible] property) until the whole
+//	(i.e. setting the [Widget.GetPropertyVisible] property) until the whole
 //	interface is created.
 func (x *BuildableIface) OverrideSetBuildableProperty(cb func(Buildable, *Builder, string, *gobject.Value)) {
 	if cb == nil {
 		x.xSetBuildableProperty = 0
 	} else {
-		x.xSetBuildableProperty = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string, ValueVarp *gobject.Value) {
+		x.xSetBuildableProperty = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string, ValueVarp *gobject.Value) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), NameVarp, ValueVarp)
 		})
 	}
@@ -161,16 +164,16 @@ func (x *BuildableIface) OverrideSetBuildableProperty(cb func(Buildable, *Builde
 // GetSetBuildableProperty gets the "set_buildable_property" callback function.
 // Sets a property of a buildable object.
 //
-//	It is normally not necessary to implement this, g_object_set_property()
+//	It is normally not necessary to implement this, [gobject.Object.SetProperty]
 //	is used by default. `GtkWindow` implements this to delay showing itself
-//	(i.e. setting the [property@Gtk.Widget:visible] property) until the whole
+//	(i.e. setting the [Widget.GetPropertyVisible] property) until the whole
 //	interface is created.
 func (x *BuildableIface) GetSetBuildableProperty() func(Buildable, *Builder, string, *gobject.Value) {
 	if x.xSetBuildableProperty == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string, ValueVarp *gobject.Value)
-	purego.RegisterFunc(&rawCallback, x.xSetBuildableProperty)
+	core.RegisterFunc(&rawCallback, x.xSetBuildableProperty)
 	return func(BuildableVar Buildable, BuilderVar *Builder, NameVar string, ValueVar *gobject.Value) {
 		rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), NameVar, ValueVar)
 	}
@@ -180,14 +183,15 @@ func (x *BuildableIface) GetSetBuildableProperty() func(Buildable, *Builder, str
 // Constructs a child of a buildable that has been
 //
 //	specified as “constructor” in the UI definition. This can be used to
-//	reference a widget created in a `&lt;ui&gt;` tag which is outside
+//	reference a widget created in a `<ui>` tag which is outside
 //	of the normal GtkBuilder UI definition hierarchy.  A reference to the
 //	constructed object is returned and becomes owned by the caller.
 func (x *BuildableIface) OverrideConstructChild(cb func(Buildable, *Builder, string) *gobject.Object) {
 	if cb == nil {
 		x.xConstructChild = 0
 	} else {
-		x.xConstructChild = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string) uintptr {
+		x.xConstructChild = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), NameVarp)
 			if ret == nil {
 				return 0
@@ -201,7 +205,7 @@ func (x *BuildableIface) OverrideConstructChild(cb func(Buildable, *Builder, str
 // Constructs a child of a buildable that has been
 //
 //	specified as “constructor” in the UI definition. This can be used to
-//	reference a widget created in a `&lt;ui&gt;` tag which is outside
+//	reference a widget created in a `<ui>` tag which is outside
 //	of the normal GtkBuilder UI definition hierarchy.  A reference to the
 //	constructed object is returned and becomes owned by the caller.
 func (x *BuildableIface) GetConstructChild() func(Buildable, *Builder, string) *gobject.Object {
@@ -209,7 +213,7 @@ func (x *BuildableIface) GetConstructChild() func(Buildable, *Builder, string) *
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, NameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xConstructChild)
+	core.RegisterFunc(&rawCallback, x.xConstructChild)
 	return func(BuildableVar Buildable, BuilderVar *Builder, NameVar string) *gobject.Object {
 		rawRet := rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), NameVar)
 		if rawRet == 0 {
@@ -224,16 +228,17 @@ func (x *BuildableIface) GetConstructChild() func(Buildable, *Builder, string) *
 // OverrideCustomTagStart sets the "custom_tag_start" callback function.
 // Implement this if the buildable needs to parse
 //
-//	content below `&lt;child&gt;`. To handle an element, the implementation
-//	must fill in the @parser and @user_data and return %TRUE.
+//	content below `<child>`. To handle an element, the implementation
+//	must fill in the parser and user_data and return true.
 //	`GtkWidget` implements this to parse accessible attributes specified
-//	in `&lt;accessibility&gt;` elements.
-//	Note that @user_data must be freed in @custom_tag_end or @custom_finished.
+//	in `<accessibility>` elements.
+//	Note that user_data must be freed in custom_tag_end or custom_finished.
 func (x *BuildableIface) OverrideCustomTagStart(cb func(Buildable, *Builder, *gobject.Object, string, *BuildableParser, *uintptr) bool) {
 	if cb == nil {
 		x.xCustomTagStart = 0
 	} else {
-		x.xCustomTagStart = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, ParserVarp *BuildableParser, DataVarp *uintptr) bool {
+		x.xCustomTagStart = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, ParserVarp *BuildableParser, DataVarp *uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), gobject.ObjectNewFromInternalPtr(ChildVarp), TagnameVarp, ParserVarp, DataVarp)
 		})
 	}
@@ -242,17 +247,17 @@ func (x *BuildableIface) OverrideCustomTagStart(cb func(Buildable, *Builder, *go
 // GetCustomTagStart gets the "custom_tag_start" callback function.
 // Implement this if the buildable needs to parse
 //
-//	content below `&lt;child&gt;`. To handle an element, the implementation
-//	must fill in the @parser and @user_data and return %TRUE.
+//	content below `<child>`. To handle an element, the implementation
+//	must fill in the parser and user_data and return true.
 //	`GtkWidget` implements this to parse accessible attributes specified
-//	in `&lt;accessibility&gt;` elements.
-//	Note that @user_data must be freed in @custom_tag_end or @custom_finished.
+//	in `<accessibility>` elements.
+//	Note that user_data must be freed in custom_tag_end or custom_finished.
 func (x *BuildableIface) GetCustomTagStart() func(Buildable, *Builder, *gobject.Object, string, *BuildableParser, *uintptr) bool {
 	if x.xCustomTagStart == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, ParserVarp *BuildableParser, DataVarp *uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCustomTagStart)
+	core.RegisterFunc(&rawCallback, x.xCustomTagStart)
 	return func(BuildableVar Buildable, BuilderVar *Builder, ChildVar *gobject.Object, TagnameVar string, ParserVar *BuildableParser, DataVar *uintptr) bool {
 		return rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), ChildVar.GoPointer(), TagnameVar, ParserVar, DataVar)
 	}
@@ -261,12 +266,13 @@ func (x *BuildableIface) GetCustomTagStart() func(Buildable, *Builder, *gobject.
 // OverrideCustomTagEnd sets the "custom_tag_end" callback function.
 // Called for the end tag of each custom element that is
 //
-//	handled by the buildable (see @custom_tag_start).
+//	handled by the buildable (see custom_tag_start).
 func (x *BuildableIface) OverrideCustomTagEnd(cb func(Buildable, *Builder, *gobject.Object, string, uintptr)) {
 	if cb == nil {
 		x.xCustomTagEnd = 0
 	} else {
-		x.xCustomTagEnd = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr) {
+		x.xCustomTagEnd = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), gobject.ObjectNewFromInternalPtr(ChildVarp), TagnameVarp, DataVarp)
 		})
 	}
@@ -275,13 +281,13 @@ func (x *BuildableIface) OverrideCustomTagEnd(cb func(Buildable, *Builder, *gobj
 // GetCustomTagEnd gets the "custom_tag_end" callback function.
 // Called for the end tag of each custom element that is
 //
-//	handled by the buildable (see @custom_tag_start).
+//	handled by the buildable (see custom_tag_start).
 func (x *BuildableIface) GetCustomTagEnd() func(Buildable, *Builder, *gobject.Object, string, uintptr) {
 	if x.xCustomTagEnd == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCustomTagEnd)
+	core.RegisterFunc(&rawCallback, x.xCustomTagEnd)
 	return func(BuildableVar Buildable, BuilderVar *Builder, ChildVar *gobject.Object, TagnameVar string, DataVar uintptr) {
 		rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), ChildVar.GoPointer(), TagnameVar, DataVar)
 	}
@@ -290,12 +296,13 @@ func (x *BuildableIface) GetCustomTagEnd() func(Buildable, *Builder, *gobject.Ob
 // OverrideCustomFinished sets the "custom_finished" callback function.
 // Called for each custom tag handled by the buildable
 //
-//	when the builder finishes parsing (see @custom_tag_start)
+//	when the builder finishes parsing (see custom_tag_start)
 func (x *BuildableIface) OverrideCustomFinished(cb func(Buildable, *Builder, *gobject.Object, string, uintptr)) {
 	if cb == nil {
 		x.xCustomFinished = 0
 	} else {
-		x.xCustomFinished = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr) {
+		x.xCustomFinished = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), gobject.ObjectNewFromInternalPtr(ChildVarp), TagnameVarp, DataVarp)
 		})
 	}
@@ -304,13 +311,13 @@ func (x *BuildableIface) OverrideCustomFinished(cb func(Buildable, *Builder, *go
 // GetCustomFinished gets the "custom_finished" callback function.
 // Called for each custom tag handled by the buildable
 //
-//	when the builder finishes parsing (see @custom_tag_start)
+//	when the builder finishes parsing (see custom_tag_start)
 func (x *BuildableIface) GetCustomFinished() func(Buildable, *Builder, *gobject.Object, string, uintptr) {
 	if x.xCustomFinished == 0 {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, ChildVarp uintptr, TagnameVarp string, DataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCustomFinished)
+	core.RegisterFunc(&rawCallback, x.xCustomFinished)
 	return func(BuildableVar Buildable, BuilderVar *Builder, ChildVar *gobject.Object, TagnameVar string, DataVar uintptr) {
 		rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), ChildVar.GoPointer(), TagnameVar, DataVar)
 	}
@@ -326,7 +333,8 @@ func (x *BuildableIface) OverrideParserFinished(cb func(Buildable, *Builder)) {
 	if cb == nil {
 		x.xParserFinished = 0
 	} else {
-		x.xParserFinished = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr) {
+		x.xParserFinished = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp))
 		})
 	}
@@ -343,7 +351,7 @@ func (x *BuildableIface) GetParserFinished() func(Buildable, *Builder) {
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xParserFinished)
+	core.RegisterFunc(&rawCallback, x.xParserFinished)
 	return func(BuildableVar Buildable, BuilderVar *Builder) {
 		rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer())
 	}
@@ -352,7 +360,7 @@ func (x *BuildableIface) GetParserFinished() func(Buildable, *Builder) {
 // OverrideGetInternalChild sets the "get_internal_child" callback function.
 // Returns an internal child of a buildable.
 //
-//	`GtkDialog` implements this to give access to its @vbox, making
+//	`GtkDialog` implements this to give access to its vbox, making
 //	it possible to add children to the vbox in a UI definition.
 //	Implement this if the buildable has internal children that may
 //	need to be accessed from a UI definition.
@@ -360,7 +368,8 @@ func (x *BuildableIface) OverrideGetInternalChild(cb func(Buildable, *Builder, s
 	if cb == nil {
 		x.xGetInternalChild = 0
 	} else {
-		x.xGetInternalChild = purego.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildnameVarp string) uintptr {
+		x.xGetInternalChild = core.NewCallback(func(BuildableVarp uintptr, BuilderVarp uintptr, ChildnameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&BuildableBase{Ptr: BuildableVarp}, BuilderNewFromInternalPtr(BuilderVarp), ChildnameVarp)
 			if ret == nil {
 				return 0
@@ -373,7 +382,7 @@ func (x *BuildableIface) OverrideGetInternalChild(cb func(Buildable, *Builder, s
 // GetGetInternalChild gets the "get_internal_child" callback function.
 // Returns an internal child of a buildable.
 //
-//	`GtkDialog` implements this to give access to its @vbox, making
+//	`GtkDialog` implements this to give access to its vbox, making
 //	it possible to add children to the vbox in a UI definition.
 //	Implement this if the buildable has internal children that may
 //	need to be accessed from a UI definition.
@@ -382,7 +391,7 @@ func (x *BuildableIface) GetGetInternalChild() func(Buildable, *Builder, string)
 		return nil
 	}
 	var rawCallback func(BuildableVarp uintptr, BuilderVarp uintptr, ChildnameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetInternalChild)
+	core.RegisterFunc(&rawCallback, x.xGetInternalChild)
 	return func(BuildableVar Buildable, BuilderVar *Builder, ChildnameVar string) *gobject.Object {
 		rawRet := rawCallback(BuildableVar.GoPointer(), BuilderVar.GoPointer(), ChildnameVar)
 		if rawRet == 0 {
@@ -411,7 +420,7 @@ var xBuildableParseContextGetElement func(uintptr) string
 //
 // If called from the start_element or end_element handlers this will
 // give the element_name as passed to those functions. For the parent
-// elements, see gtk_buildable_parse_context_get_element_stack().
+// elements, see [BuildableParseContext.GetElementStack].
 func (x *BuildableParseContext) GetElement() string {
 
 	cret := xBuildableParseContextGetElement(x.GoPointer())
@@ -424,11 +433,11 @@ var xBuildableParseContextGetElementStack func(uintptr) []string
 //
 // The returned `GPtrArray` is an array of strings where the last item is
 // the currently open tag (as would be returned by
-// gtk_buildable_parse_context_get_element()) and the previous item is its
+// [BuildableParseContext.GetElement]) and the previous item is its
 // immediate parent.
 //
 // This function is intended to be used in the start_element and
-// end_element handlers where gtk_buildable_parse_context_get_element()
+// end_element handlers where [BuildableParseContext.GetElement]
 // would merely return the name of the element that is being
 // processed.
 func (x *BuildableParseContext) GetElementStack() []string {
@@ -454,11 +463,11 @@ var xBuildableParseContextPop func(uintptr) uintptr
 // Completes the process of a temporary sub-parser redirection.
 //
 // This function exists to collect the user_data allocated by a
-// matching call to gtk_buildable_parse_context_push(). It must be called
+// matching call to [BuildableParseContext.Push]. It must be called
 // in the end_element handler corresponding to the start_element
-// handler during which gtk_buildable_parse_context_push() was called.
+// handler during which [BuildableParseContext.Push] was called.
 // You must not call this function from the error callback -- the
-// @user_data is provided directly to the callback in that case.
+// user_data is provided directly to the callback in that case.
 //
 // This function is not intended to be directly called by users
 // interested in invoking subparsers. Instead, it is intended to
@@ -476,22 +485,22 @@ var xBuildableParseContextPush func(uintptr, *BuildableParser, uintptr)
 //
 // This function may only be called from the start_element handler of
 // a `GtkBuildableParser`. It must be matched with a corresponding call to
-// gtk_buildable_parse_context_pop() in the matching end_element handler
+// [BuildableParseContext.Pop] in the matching end_element handler
 // (except in the case that the parser aborts due to an error).
 //
 // All tags, text and other data between the matching tags is
-// redirected to the subparser given by @parser. @user_data is used
-// as the user_data for that parser. @user_data is also passed to the
+// redirected to the subparser given by parser. user_data is used
+// as the user_data for that parser. user_data is also passed to the
 // error callback in the event that an error occurs. This includes
 // errors that occur in subparsers of the subparser.
 //
 // The end tag matching the start tag for which this call was made is
 // handled by the previous parser (which is given its own user_data)
-// which is why gtk_buildable_parse_context_pop() is provided to allow "one
-// last access" to the @user_data provided to this function. In the
-// case of error, the @user_data provided here is passed directly to
-// the error callback of the subparser and gtk_buildable_parse_context_pop()
-// should not be called. In either case, if @user_data was allocated
+// which is why [BuildableParseContext.Pop] is provided to allow "one
+// last access" to the user_data provided to this function. In the
+// case of error, the user_data provided here is passed directly to
+// the error callback of the subparser and [BuildableParseContext.Pop]
+// should not be called. In either case, if user_data was allocated
 // then it ought to be freed from both of these locations.
 //
 // This function is not intended to be directly called by users
@@ -499,7 +508,7 @@ var xBuildableParseContextPush func(uintptr, *BuildableParser, uintptr)
 // used by the subparsers themselves to implement a higher-level
 // interface.
 //
-// For an example of how to use this, see g_markup_parse_context_push() which
+// For an example of how to use this, see [glib.MarkupParseContext.Push] which
 // has the same kind of API.
 func (x *BuildableParseContext) Push(ParserVar *BuildableParser, UserDataVar uintptr) {
 
@@ -532,7 +541,8 @@ func (x *BuildableParser) OverrideStartElement(cb func(*BuildableParseContext, s
 	if cb == nil {
 		x.xStartElement = 0
 	} else {
-		x.xStartElement = purego.NewCallback(func(ContextVarp *BuildableParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr) {
+		x.xStartElement = core.NewCallback(func(ContextVarp *BuildableParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ElementNameVarp, AttributeNamesVarp, AttributeValuesVarp, UserDataVarp)
 		})
 	}
@@ -545,7 +555,7 @@ func (x *BuildableParser) GetStartElement() func(*BuildableParseContext, string,
 		return nil
 	}
 	var rawCallback func(ContextVarp *BuildableParseContext, ElementNameVarp string, AttributeNamesVarp string, AttributeValuesVarp string, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStartElement)
+	core.RegisterFunc(&rawCallback, x.xStartElement)
 	return func(ContextVar *BuildableParseContext, ElementNameVar string, AttributeNamesVar string, AttributeValuesVar string, UserDataVar uintptr) {
 		rawCallback(ContextVar, ElementNameVar, AttributeNamesVar, AttributeValuesVar, UserDataVar)
 	}
@@ -557,7 +567,8 @@ func (x *BuildableParser) OverrideEndElement(cb func(*BuildableParseContext, str
 	if cb == nil {
 		x.xEndElement = 0
 	} else {
-		x.xEndElement = purego.NewCallback(func(ContextVarp *BuildableParseContext, ElementNameVarp string, UserDataVarp uintptr) {
+		x.xEndElement = core.NewCallback(func(ContextVarp *BuildableParseContext, ElementNameVarp string, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ElementNameVarp, UserDataVarp)
 		})
 	}
@@ -570,7 +581,7 @@ func (x *BuildableParser) GetEndElement() func(*BuildableParseContext, string, u
 		return nil
 	}
 	var rawCallback func(ContextVarp *BuildableParseContext, ElementNameVarp string, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEndElement)
+	core.RegisterFunc(&rawCallback, x.xEndElement)
 	return func(ContextVar *BuildableParseContext, ElementNameVar string, UserDataVar uintptr) {
 		rawCallback(ContextVar, ElementNameVar, UserDataVar)
 	}
@@ -582,7 +593,8 @@ func (x *BuildableParser) OverrideText(cb func(*BuildableParseContext, string, u
 	if cb == nil {
 		x.xText = 0
 	} else {
-		x.xText = purego.NewCallback(func(ContextVarp *BuildableParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+		x.xText = core.NewCallback(func(ContextVarp *BuildableParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, TextVarp, TextLenVarp, UserDataVarp)
 		})
 	}
@@ -595,7 +607,7 @@ func (x *BuildableParser) GetText() func(*BuildableParseContext, string, uint, u
 		return nil
 	}
 	var rawCallback func(ContextVarp *BuildableParseContext, TextVarp string, TextLenVarp uint, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xText)
+	core.RegisterFunc(&rawCallback, x.xText)
 	return func(ContextVar *BuildableParseContext, TextVar string, TextLenVar uint, UserDataVar uintptr) {
 		rawCallback(ContextVar, TextVar, TextLenVar, UserDataVar)
 	}
@@ -607,7 +619,8 @@ func (x *BuildableParser) OverrideError(cb func(*BuildableParseContext, *glib.Er
 	if cb == nil {
 		x.xError = 0
 	} else {
-		x.xError = purego.NewCallback(func(ContextVarp *BuildableParseContext, ErrorVarp *glib.Error, UserDataVarp uintptr) {
+		x.xError = core.NewCallback(func(ContextVarp *BuildableParseContext, ErrorVarp *glib.Error, UserDataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ContextVarp, ErrorVarp, UserDataVarp)
 		})
 	}
@@ -620,7 +633,7 @@ func (x *BuildableParser) GetError() func(*BuildableParseContext, *glib.Error, u
 		return nil
 	}
 	var rawCallback func(ContextVarp *BuildableParseContext, ErrorVarp *glib.Error, UserDataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xError)
+	core.RegisterFunc(&rawCallback, x.xError)
 	return func(ContextVar *BuildableParseContext, ErrorVar *glib.Error, UserDataVar uintptr) {
 		rawCallback(ContextVar, ErrorVar, UserDataVar)
 	}
@@ -632,7 +645,7 @@ func (x *BuildableParser) GetError() func(*BuildableParseContext, *glib.Error, u
 // properties of objects, parsing custom tags and constructing child objects.
 //
 // It is implemented by all widgets and many of the non-widget objects that are
-// provided by GTK. The main user of this interface is [class@Gtk.Builder].
+// provided by GTK. The main user of this interface is [Builder].
 // There should be very little need for applications to call any of these
 // functions directly.
 //
@@ -665,10 +678,121 @@ func (x *BuildableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the ID of the @buildable object.
+// ImplementBuildable adds the Buildable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - SetId(string)
+//   - GetId() string
+//   - AddChild(*Builder, *gobject.Object, string)
+//   - SetBuildableProperty(*Builder, string, *gobject.Value)
+//   - ConstructChild(*Builder, string) *gobject.Object
+//   - CustomTagStart(*Builder, *gobject.Object, string, *BuildableParser, *uintptr) bool
+//   - CustomTagEnd(*Builder, *gobject.Object, string, uintptr)
+//   - CustomFinished(*Builder, *gobject.Object, string, uintptr)
+//   - ParserFinished(*Builder)
+//   - GetInternalChild(*Builder, string) *gobject.Object
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see BuildableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementBuildable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, BuildableGLibType(), func(iface *BuildableIface) {
+		if _, ok := any(zero).(interface{ SetId(string) }); ok {
+			iface.OverrideSetId(func(self Buildable, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetId(string) })
+				impl.SetId(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetId() string }); ok {
+			iface.OverrideGetId(func(self Buildable) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetId() string })
+				return impl.GetId()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AddChild(*Builder, *gobject.Object, string)
+		}); ok {
+			iface.OverrideAddChild(func(self Buildable, arg0 *Builder, arg1 *gobject.Object, arg2 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AddChild(*Builder, *gobject.Object, string)
+				})
+				impl.AddChild(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetBuildableProperty(*Builder, string, *gobject.Value)
+		}); ok {
+			iface.OverrideSetBuildableProperty(func(self Buildable, arg0 *Builder, arg1 string, arg2 *gobject.Value) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetBuildableProperty(*Builder, string, *gobject.Value)
+				})
+				impl.SetBuildableProperty(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			ConstructChild(*Builder, string) *gobject.Object
+		}); ok {
+			iface.OverrideConstructChild(func(self Buildable, arg0 *Builder, arg1 string) *gobject.Object {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					ConstructChild(*Builder, string) *gobject.Object
+				})
+				return impl.ConstructChild(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CustomTagStart(*Builder, *gobject.Object, string, *BuildableParser, *uintptr) bool
+		}); ok {
+			iface.OverrideCustomTagStart(func(self Buildable, arg0 *Builder, arg1 *gobject.Object, arg2 string, arg3 *BuildableParser, arg4 *uintptr) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CustomTagStart(*Builder, *gobject.Object, string, *BuildableParser, *uintptr) bool
+				})
+				return impl.CustomTagStart(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CustomTagEnd(*Builder, *gobject.Object, string, uintptr)
+		}); ok {
+			iface.OverrideCustomTagEnd(func(self Buildable, arg0 *Builder, arg1 *gobject.Object, arg2 string, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CustomTagEnd(*Builder, *gobject.Object, string, uintptr)
+				})
+				impl.CustomTagEnd(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CustomFinished(*Builder, *gobject.Object, string, uintptr)
+		}); ok {
+			iface.OverrideCustomFinished(func(self Buildable, arg0 *Builder, arg1 *gobject.Object, arg2 string, arg3 uintptr) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CustomFinished(*Builder, *gobject.Object, string, uintptr)
+				})
+				impl.CustomFinished(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ ParserFinished(*Builder) }); ok {
+			iface.OverrideParserFinished(func(self Buildable, arg0 *Builder) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ParserFinished(*Builder) })
+				impl.ParserFinished(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetInternalChild(*Builder, string) *gobject.Object
+		}); ok {
+			iface.OverrideGetInternalChild(func(self Buildable, arg0 *Builder, arg1 string) *gobject.Object {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetInternalChild(*Builder, string) *gobject.Object
+				})
+				return impl.GetInternalChild(arg0, arg1)
+			})
+		}
+	})
+}
+
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *BuildableBase) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -682,21 +806,23 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xBuildableParseContextGetElement, libs, "gtk_buildable_parse_context_get_element")
-	core.PuregoSafeRegister(&xBuildableParseContextGetElementStack, libs, "gtk_buildable_parse_context_get_element_stack")
-	core.PuregoSafeRegister(&xBuildableParseContextGetPosition, libs, "gtk_buildable_parse_context_get_position")
-	core.PuregoSafeRegister(&xBuildableParseContextPop, libs, "gtk_buildable_parse_context_pop")
-	core.PuregoSafeRegister(&xBuildableParseContextPush, libs, "gtk_buildable_parse_context_push")
+	core.PuregoRegisterSince(&xBuildableParseContextGetElement, libs, "gtk_buildable_parse_context_get_element", "GTK", "")
+	core.PuregoRegisterSince(&xBuildableParseContextGetElementStack, libs, "gtk_buildable_parse_context_get_element_stack", "GTK", "")
+	core.PuregoRegisterSince(&xBuildableParseContextGetPosition, libs, "gtk_buildable_parse_context_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xBuildableParseContextPop, libs, "gtk_buildable_parse_context_pop", "GTK", "")
+	core.PuregoRegisterSince(&xBuildableParseContextPush, libs, "gtk_buildable_parse_context_push", "GTK", "")
 
 	core.PuregoSafeRegister(&xBuildableGLibType, libs, "gtk_buildable_get_type")
 
-	core.PuregoSafeRegister(&XGtkBuildableGetBuildableId, libs, "gtk_buildable_get_buildable_id")
+	gobject.CheckClassSize("GtkBuildableIface", xBuildableGLibType, unsafe.Sizeof(BuildableIface{}))
+
+	core.PuregoRegisterSince(&XGtkBuildableGetBuildableId, libs, "gtk_buildable_get_buildable_id", "GTK", "")
 
 }
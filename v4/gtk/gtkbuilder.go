@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -75,9 +75,162 @@ const (
 	BuilderErrorInvalidFunctionValue BuilderError = 14
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (BuilderError) ErrorDomain() string {
+	return "gtk-builder-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x BuilderError) Error() string {
+	switch x {
+	case BuilderErrorInvalidTypeFunctionValue:
+		return "GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION"
+	case BuilderErrorUnhandledTagValue:
+		return "GTK_BUILDER_ERROR_UNHANDLED_TAG"
+	case BuilderErrorMissingAttributeValue:
+		return "GTK_BUILDER_ERROR_MISSING_ATTRIBUTE"
+	case BuilderErrorInvalidAttributeValue:
+		return "GTK_BUILDER_ERROR_INVALID_ATTRIBUTE"
+	case BuilderErrorInvalidTagValue:
+		return "GTK_BUILDER_ERROR_INVALID_TAG"
+	case BuilderErrorMissingPropertyValueValue:
+		return "GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE"
+	case BuilderErrorInvalidValueValue:
+		return "GTK_BUILDER_ERROR_INVALID_VALUE"
+	case BuilderErrorVersionMismatchValue:
+		return "GTK_BUILDER_ERROR_VERSION_MISMATCH"
+	case BuilderErrorDuplicateIdValue:
+		return "GTK_BUILDER_ERROR_DUPLICATE_ID"
+	case BuilderErrorObjectTypeRefusedValue:
+		return "GTK_BUILDER_ERROR_OBJECT_TYPE_REFUSED"
+	case BuilderErrorTemplateMismatchValue:
+		return "GTK_BUILDER_ERROR_TEMPLATE_MISMATCH"
+	case BuilderErrorInvalidPropertyValue:
+		return "GTK_BUILDER_ERROR_INVALID_PROPERTY"
+	case BuilderErrorInvalidSignalValue:
+		return "GTK_BUILDER_ERROR_INVALID_SIGNAL"
+	case BuilderErrorInvalidIdValue:
+		return "GTK_BUILDER_ERROR_INVALID_ID"
+	case BuilderErrorInvalidFunctionValue:
+		return "GTK_BUILDER_ERROR_INVALID_FUNCTION"
+	}
+	return fmt.Sprintf("gtk-builder-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x BuilderError) String() string {
+	switch x {
+	case BuilderErrorInvalidTypeFunctionValue:
+		return "GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION"
+	case BuilderErrorUnhandledTagValue:
+		return "GTK_BUILDER_ERROR_UNHANDLED_TAG"
+	case BuilderErrorMissingAttributeValue:
+		return "GTK_BUILDER_ERROR_MISSING_ATTRIBUTE"
+	case BuilderErrorInvalidAttributeValue:
+		return "GTK_BUILDER_ERROR_INVALID_ATTRIBUTE"
+	case BuilderErrorInvalidTagValue:
+		return "GTK_BUILDER_ERROR_INVALID_TAG"
+	case BuilderErrorMissingPropertyValueValue:
+		return "GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE"
+	case BuilderErrorInvalidValueValue:
+		return "GTK_BUILDER_ERROR_INVALID_VALUE"
+	case BuilderErrorVersionMismatchValue:
+		return "GTK_BUILDER_ERROR_VERSION_MISMATCH"
+	case BuilderErrorDuplicateIdValue:
+		return "GTK_BUILDER_ERROR_DUPLICATE_ID"
+	case BuilderErrorObjectTypeRefusedValue:
+		return "GTK_BUILDER_ERROR_OBJECT_TYPE_REFUSED"
+	case BuilderErrorTemplateMismatchValue:
+		return "GTK_BUILDER_ERROR_TEMPLATE_MISMATCH"
+	case BuilderErrorInvalidPropertyValue:
+		return "GTK_BUILDER_ERROR_INVALID_PROPERTY"
+	case BuilderErrorInvalidSignalValue:
+		return "GTK_BUILDER_ERROR_INVALID_SIGNAL"
+	case BuilderErrorInvalidIdValue:
+		return "GTK_BUILDER_ERROR_INVALID_ID"
+	case BuilderErrorInvalidFunctionValue:
+		return "GTK_BUILDER_ERROR_INVALID_FUNCTION"
+	}
+	return fmt.Sprintf("BuilderError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BuilderError) Nick() string {
+	switch x {
+	case BuilderErrorInvalidTypeFunctionValue:
+		return "invalid-type-function"
+	case BuilderErrorUnhandledTagValue:
+		return "unhandled-tag"
+	case BuilderErrorMissingAttributeValue:
+		return "missing-attribute"
+	case BuilderErrorInvalidAttributeValue:
+		return "invalid-attribute"
+	case BuilderErrorInvalidTagValue:
+		return "invalid-tag"
+	case BuilderErrorMissingPropertyValueValue:
+		return "missing-property-value"
+	case BuilderErrorInvalidValueValue:
+		return "invalid-value"
+	case BuilderErrorVersionMismatchValue:
+		return "version-mismatch"
+	case BuilderErrorDuplicateIdValue:
+		return "duplicate-id"
+	case BuilderErrorObjectTypeRefusedValue:
+		return "object-type-refused"
+	case BuilderErrorTemplateMismatchValue:
+		return "template-mismatch"
+	case BuilderErrorInvalidPropertyValue:
+		return "invalid-property"
+	case BuilderErrorInvalidSignalValue:
+		return "invalid-signal"
+	case BuilderErrorInvalidIdValue:
+		return "invalid-id"
+	case BuilderErrorInvalidFunctionValue:
+		return "invalid-function"
+	}
+	return ""
+}
+
+// BuilderErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BuilderErrorFromString(s string) (BuilderError, bool) {
+	switch s {
+	case "GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION", "invalid-type-function":
+		return BuilderErrorInvalidTypeFunctionValue, true
+	case "GTK_BUILDER_ERROR_UNHANDLED_TAG", "unhandled-tag":
+		return BuilderErrorUnhandledTagValue, true
+	case "GTK_BUILDER_ERROR_MISSING_ATTRIBUTE", "missing-attribute":
+		return BuilderErrorMissingAttributeValue, true
+	case "GTK_BUILDER_ERROR_INVALID_ATTRIBUTE", "invalid-attribute":
+		return BuilderErrorInvalidAttributeValue, true
+	case "GTK_BUILDER_ERROR_INVALID_TAG", "invalid-tag":
+		return BuilderErrorInvalidTagValue, true
+	case "GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE", "missing-property-value":
+		return BuilderErrorMissingPropertyValueValue, true
+	case "GTK_BUILDER_ERROR_INVALID_VALUE", "invalid-value":
+		return BuilderErrorInvalidValueValue, true
+	case "GTK_BUILDER_ERROR_VERSION_MISMATCH", "version-mismatch":
+		return BuilderErrorVersionMismatchValue, true
+	case "GTK_BUILDER_ERROR_DUPLICATE_ID", "duplicate-id":
+		return BuilderErrorDuplicateIdValue, true
+	case "GTK_BUILDER_ERROR_OBJECT_TYPE_REFUSED", "object-type-refused":
+		return BuilderErrorObjectTypeRefusedValue, true
+	case "GTK_BUILDER_ERROR_TEMPLATE_MISMATCH", "template-mismatch":
+		return BuilderErrorTemplateMismatchValue, true
+	case "GTK_BUILDER_ERROR_INVALID_PROPERTY", "invalid-property":
+		return BuilderErrorInvalidPropertyValue, true
+	case "GTK_BUILDER_ERROR_INVALID_SIGNAL", "invalid-signal":
+		return BuilderErrorInvalidSignalValue, true
+	case "GTK_BUILDER_ERROR_INVALID_ID", "invalid-id":
+		return BuilderErrorInvalidIdValue, true
+	case "GTK_BUILDER_ERROR_INVALID_FUNCTION", "invalid-function":
+		return BuilderErrorInvalidFunctionValue, true
+	}
+	return 0, false
+}
+
 var xBuilderErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gtk.Builder] errors.
+// Registers an error quark for [Builder] errors.
 func BuilderErrorQuark() glib.Quark {
 
 	cret := xBuilderErrorQuark()
@@ -87,115 +240,103 @@ func BuilderErrorQuark() glib.Quark {
 // Reads XML descriptions of a user interface and instantiates the described objects.
 //
 // To create a `GtkBuilder` from a user interface description, call
-// [ctor@Gtk.Builder.new_from_file], [ctor@Gtk.Builder.new_from_resource]
-// or [ctor@Gtk.Builder.new_from_string].
+// [NewBuilderFromFile], [NewBuilderFromResource]
+// or [NewBuilderFromString].
 //
 // In the (unusual) case that you want to add user interface
 // descriptions from multiple sources to the same `GtkBuilder` you can
-// call [ctor@Gtk.Builder.new] to get an empty builder and populate it by
-// (multiple) calls to [method@Gtk.Builder.add_from_file],
-// [method@Gtk.Builder.add_from_resource] or
-// [method@Gtk.Builder.add_from_string].
+// call [NewBuilder] to get an empty builder and populate it by
+// (multiple) calls to [Builder.AddFromFile],
+// [Builder.AddFromResource] or
+// [Builder.AddFromString].
 //
 // A `GtkBuilder` holds a reference to all objects that it has constructed
 // and drops these references when it is finalized. This finalization can
 // cause the destruction of non-widget objects or widgets which are not
 // contained in a toplevel window. For toplevel windows constructed by a
 // builder, it is the responsibility of the user to call
-// [method@Gtk.Window.destroy] to get rid of them and all the widgets
+// [Window.Destroy] to get rid of them and all the widgets
 // they contain.
 //
-// The functions [method@Gtk.Builder.get_object] and
-// [method@Gtk.Builder.get_objects] can be used to access the widgets in
+// The functions [Builder.GetObject] and
+// [Builder.GetObjects] can be used to access the widgets in
 // the interface by the names assigned to them inside the UI description.
 // Toplevel windows returned by these functions will stay around until the
-// user explicitly destroys them with [method@Gtk.Window.destroy]. Other
+// user explicitly destroys them with [Window.Destroy]. Other
 // widgets will either be part of a larger hierarchy constructed by the
 // builder (in which case you should not have to worry about their lifecycle),
 // or without a parent, in which case they have to be added to some container
 // to make use of them. Non-widget objects need to be reffed with
-// g_object_ref() to keep them beyond the lifespan of the builder.
+// [gobject.Object.Ref] to keep them beyond the lifespan of the builder.
 //
-// ## GtkBuilder UI Definitions
+// # GtkBuilder UI Definitions
 //
 // `GtkBuilder` parses textual descriptions of user interfaces which are
 // specified in XML format. We refer to these descriptions as “GtkBuilder
 // UI definitions” or just “UI definitions” if the context is clear.
 //
-// ### Structure of UI definitions
+// # Structure of UI definitions
 //
 // UI definition files are always encoded in UTF-8.
 //
-// The toplevel element is `&lt;interface&gt;`. It optionally takes a “domain”
+// The toplevel element is `<interface>`. It optionally takes a “domain”
 // attribute, which will make the builder look for translated strings
 // using `dgettext()` in the domain specified. This can also be done by
-// calling [method@Gtk.Builder.set_translation_domain] on the builder.
+// calling [Builder.SetTranslationDomain] on the builder.
 // For example:
 //
-// ```xml
-// &lt;?xml version="1.0" encoding="UTF-8"?&gt;
-// &lt;interface domain="your-app"&gt;
-//
-//	...
-//
-// &lt;/interface&gt;
-// ```
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<interface domain="your-app">
+//	  ...
+//	</interface>
 //
-// ### Requirements
+// # Requirements
 //
-// The target toolkit version(s) are described by `&lt;requires&gt;` elements,
+// The target toolkit version(s) are described by `<requires>` elements,
 // the “lib” attribute specifies the widget library in question (currently
 // the only supported value is “gtk”) and the “version” attribute specifies
-// the target version in the form “`&lt;major&gt;`.`&lt;minor&gt;`”. `GtkBuilder` will
+// the target version in the form “`<major>`.`<minor>`”. `GtkBuilder` will
 // error out if the version requirements are not met. For example:
 //
-// ```xml
-// &lt;?xml version="1.0" encoding="UTF-8"?&gt;
-// &lt;interface domain="your-app"&gt;
+//	<?xml version="1.0" encoding="UTF-8"?>
+//	<interface domain="your-app">
+//	  <requires lib="gtk" version="4.0" />
+//	</interface>
 //
-//	&lt;requires lib="gtk" version="4.0" /&gt;
+// # Objects
 //
-// &lt;/interface&gt;
-// ```
+// Objects are defined as children of the `<interface>` element.
 //
-// ### Objects
-//
-// Objects are defined as children of the `&lt;interface&gt;` element.
-//
-// Objects are described by `&lt;object&gt;` elements, which can contain
-// `&lt;property&gt;` elements to set properties, `&lt;signal&gt;` elements which
-// connect signals to handlers, and `&lt;child&gt;` elements, which describe
+// Objects are described by `<object>` elements, which can contain
+// `<property>` elements to set properties, `<signal>` elements which
+// connect signals to handlers, and `<child>` elements, which describe
 // child objects.
 //
-// Typically, the specific kind of object represented by an `&lt;object&gt;`
+// Typically, the specific kind of object represented by an `<object>`
 // element is specified by the “class” attribute. If the type has not
 // been loaded yet, GTK tries to find the `get_type()` function from the
 // class name by applying heuristics. This works in most cases, but if
 // necessary, it is possible to specify the name of the `get_type()`
 // function explicitly with the "type-func" attribute. If your UI definition
 // is referencing internal types, you should make sure to call
-// `g_type_ensure()` for each object type before parsing the UI definition.
+// `[gobject.TypeEnsure]` for each object type before parsing the UI definition.
 //
 // Objects may be given a name with the “id” attribute, which allows the
 // application to retrieve them from the builder with
-// [method@Gtk.Builder.get_object]. An id is also necessary to use the
+// [Builder.GetObject]. An id is also necessary to use the
 // object as property value in other parts of the UI definition. GTK
 // reserves ids starting and ending with `___` (three consecutive
 // underscores) for its own purposes.
 //
-// ### Properties
+// # Properties
 //
 // Setting properties of objects is pretty straightforward with the
-// `&lt;property&gt;` element: the “name” attribute specifies the name of the
+// `<property>` element: the “name” attribute specifies the name of the
 // property, and the content of the element specifies the value:
 //
-// ```xml
-// &lt;object class="GtkButton"&gt;
-//
-//	&lt;property name="label"&gt;Hello, world&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkButton">
+//	  <property name="label">Hello, world</property>
+//	</object>
 //
 // If the “translatable” attribute is set to a true value, GTK uses
 // `gettext()` (or `dgettext()` if the builder has a translation domain set)
@@ -205,16 +346,12 @@ func BuilderErrorQuark() glib.Quark {
 // specify a context to disambiguate short strings, and comments which
 // may help the translators:
 //
-// ```xml
-// &lt;object class="GtkButton"&gt;
-//
-//	&lt;property name="label"
-//	          translatable="yes"
-//	          context="button"
-//	          comments="A classic"&gt;Hello, world&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkButton">
+//	  <property name="label"
+//	            translatable="yes"
+//	            context="button"
+//	            comments="A classic">Hello, world</property>
+//	</object>
 //
 // The xgettext tool that is part of gettext can extract these strings,
 // but note that it only looks for translatable="yes".
@@ -235,151 +372,135 @@ func BuilderErrorQuark() glib.Quark {
 //   - flag types (can be specified by their C identifier or short name,
 //     optionally combined with “|” for bitwise OR, or a single integer value
 //     e.g., “GTK_INPUT_HINT_EMOJI|GTK_INPUT_HINT_LOWERCASE”, or “emoji|lowercase” or 520).
-//   - colors (in the format understood by [method@Gdk.RGBA.parse])
-//   - transforms (in the format understood by [func@Gsk.Transform.parse])
-//   - Pango attribute lists (in the format understood by [method@Pango.AttrList.to_string])
-//   - Pango tab arrays (in the format understood by [method@Pango.TabArray.to_string])
-//   - Pango font descriptions (in the format understood by [func@Pango.FontDescription.from_string])
-//   - `GVariant` (in the format understood by [func@GLib.Variant.parse])
-//   - textures (can be specified as an object id, a resource path or a filename of an image file to load relative to the Builder file or the CWD if [method@Gtk.Builder.add_from_string] was used)
-//   - GFile (like textures, can be specified as an object id, a URI or a filename of a file to load relative to the Builder file or the CWD if [method@Gtk.Builder.add_from_string] was used)
+//   - colors (in the format understood by [gdk.RGBA.Parse])
+//   - transforms (in the format understood by [gsk.TransformParse])
+//   - Pango attribute lists (in the format understood by [pango.AttrList.ToString])
+//   - Pango tab arrays (in the format understood by [pango.TabArray.ToString])
+//   - Pango font descriptions (in the format understood by [pango.FontDescriptionFromString])
+//   - `GVariant` (in the format understood by [glib.VariantParse])
+//   - textures (can be specified as an object id, a resource path or a filename of an image file to load relative to the Builder file or the CWD if [Builder.AddFromString] was used)
+//   - GFile (like textures, can be specified as an object id, a URI or a filename of a file to load relative to the Builder file or the CWD if [Builder.AddFromString] was used)
 //
 // Objects can be referred to by their name and by default refer to
 // objects declared in the local XML fragment and objects exposed via
-// [method@Gtk.Builder.expose_object]. In general, `GtkBuilder` allows
+// [Builder.ExposeObject]. In general, `GtkBuilder` allows
 // forward references to objects declared in the local XML; an object
 // doesn’t have to be constructed before it can be referred to. The
 // exception to this rule is that an object has to be constructed before
 // it can be used as the value of a construct-only property.
 //
-// ### Child objects
+// # Child objects
 //
 // Many widgets have properties for child widgets, such as
-// [property@Gtk.Expander:child]. In this case, the preferred way to
+// Expander:child. In this case, the preferred way to
 // specify the child widget in a ui file is to simply set the property:
 //
-// ```xml
-// &lt;object class="GtkExpander"&gt;
-//
-//	&lt;property name="child"&gt;
-//	  &lt;object class="GtkLabel"&gt;
-//	  ...
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkExpander">
+//	  <property name="child">
+//	    <object class="GtkLabel">
+//	    ...
+//	    </object>
+//	  </property>
+//	</object>
 //
 // Generic containers that can contain an arbitrary number of children,
-// such as [class@Gtk.Box] instead use the `&lt;child&gt;` element. A `&lt;child&gt;`
-// element contains an `&lt;object&gt;` element which describes the child object.
+// such as [Box] instead use the `<child>` element. A `<child>`
+// element contains an `<object>` element which describes the child object.
 // Most often, child objects are widgets inside a container, but they can
 // also be, e.g., actions in an action group, or columns in a tree model.
 //
-// Any object type that implements the [iface@Gtk.Buildable] interface can
+// Any object type that implements the [Buildable] interface can
 // specify how children may be added to it. Since many objects and widgets that
 // are included with GTK already implement the `GtkBuildable` interface,
-// typically child objects can be added using the `&lt;child&gt;` element without
+// typically child objects can be added using the `<child>` element without
 // having to be concerned about the underlying implementation.
 //
-// See the [`GtkWidget` documentation](class.Widget.html#gtkwidget-as-gtkbuildable)
+// See the GtkWidget documentation
 // for many examples of using `GtkBuilder` with widgets, including setting
-// child objects using the `&lt;child&gt;` element.
+// child objects using the `<child>` element.
 //
 // A noteworthy special case to the general rule that only objects implementing
-// `GtkBuildable` may specify how to handle the `&lt;child&gt;` element is that
+// `GtkBuildable` may specify how to handle the `<child>` element is that
 // `GtkBuilder` provides special support for adding objects to a
-// [class@Gio.ListStore] by using the `&lt;child&gt;` element. For instance:
+// [gio.ListStore] by using the `<child>` element. For instance:
 //
-// ```xml
-// &lt;object class="GListStore"&gt;
-//
-//	&lt;property name="item-type"&gt;MyObject&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="MyObject" /&gt;
-//	&lt;/child&gt;
-//	...
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GListStore">
+//	  <property name="item-type">MyObject</property>
+//	  <child>
+//	    <object class="MyObject" />
+//	  </child>
+//	  ...
+//	</object>
 //
-// ### Property bindings
+// # Property bindings
 //
 // It is also possible to bind a property value to another object's
 // property value using the attributes "bind-source" to specify the
 // source object of the binding, and optionally, "bind-property" and
 // "bind-flags" to specify the source property and source binding flags
 // respectively. Internally, `GtkBuilder` implements this using
-// [class@GObject.Binding] objects.
+// [gobject.Binding] objects.
 //
 // For instance, in the example below the “label” property of the
 // `bottom_label` widget is bound to the “label” property of the
 // `top_button` widget:
 //
-// ```xml
-// &lt;object class="GtkBox"&gt;
-//
-//	&lt;property name="orientation"&gt;vertical&lt;/property&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkButton" id="top_button"&gt;
-//	    &lt;property name="label"&gt;Hello, world&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkLabel" id="bottom_label"&gt;
-//	    &lt;property name="label"
-//	              bind-source="top_button"
-//	              bind-property="label"
-//	              bind-flags="sync-create" /&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkBox">
+//	  <property name="orientation">vertical</property>
+//	  <child>
+//	    <object class="GtkButton" id="top_button">
+//	      <property name="label">Hello, world</property>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="GtkLabel" id="bottom_label">
+//	      <property name="label"
+//	                bind-source="top_button"
+//	                bind-property="label"
+//	                bind-flags="sync-create" />
+//	    </object>
+//	  </child>
+//	</object>
 //
 // For more information, see the documentation of the
-// [method@GObject.Object.bind_property] method.
+// [gobject.Object.BindProperty] method.
 //
 // Please note that another way to set up bindings between objects in .ui files
 // is to use the `GtkExpression` methodology. See the
-// [`GtkExpression` documentation](class.Expression.html#gtkexpression-in-ui-files)
+// GtkExpression documentation
 // for more information.
 //
-// ### Internal children
+// # Internal children
 //
 // Sometimes it is necessary to refer to widgets which have implicitly
 // been constructed by GTK as part of a composite widget, to set
 // properties on them or to add further children (e.g. the content area
 // of a `GtkDialog`). This can be achieved by setting the “internal-child”
-// property of the `&lt;child&gt;` element to a true value. Note that `GtkBuilder`
-// still requires an `&lt;object&gt;` element for the internal child, even if it
+// property of the `<child>` element to a true value. Note that `GtkBuilder`
+// still requires an `<object>` element for the internal child, even if it
 // has already been constructed.
 //
-// ### Specialized children
+// # Specialized children
 //
 // A number of widgets have different places where a child can be added
 // (e.g. tabs vs. page content in notebooks). This can be reflected in
-// a UI definition by specifying the “type” attribute on a `&lt;child&gt;`
+// a UI definition by specifying the “type” attribute on a `<child>`
 // The possible values for the “type” attribute are described in the
 // sections describing the widget-specific portions of UI definitions.
 //
-// ### Signal handlers and function pointers
+// # Signal handlers and function pointers
 //
-// Signal handlers are set up with the `&lt;signal&gt;` element. The “name”
+// Signal handlers are set up with the `<signal>` element. The “name”
 // attribute specifies the name of the signal, and the “handler” attribute
 // specifies the function to connect to the signal.
 //
-// ```xml
-// &lt;object class="GtkButton" id="hello_button"&gt;
-//
-//	&lt;signal name="clicked" handler="hello_button__clicked" /&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkButton" id="hello_button">
+//	  <signal name="clicked" handler="hello_button__clicked" />
+//	</object>
 //
 // The remaining attributes, “after”, “swapped” and “object”, have the
 // same meaning as the corresponding parameters of the
-// [func@GObject.signal_connect_object] or [func@GObject.signal_connect_data]
+// [gobject.SignalConnectObject] or [gobject.SignalConnectData]
 // functions:
 //
 //   - “after” matches the `G_CONNECT_AFTER` flag, and will ensure that the
@@ -397,70 +518,61 @@ func BuilderErrorQuark() glib.Quark {
 // with the `G_MODULE_EXPORT` decorator, or they will not be put in the symbol
 // table:
 //
-// ```c
-// G_MODULE_EXPORT void
-// hello_button__clicked (GtkButton *button,
-//
-//	gpointer data)
-//
+//	G_MODULE_EXPORT void
+//	hello_button__clicked (GtkButton *button,
+//	                       gpointer data)
 //	{
 //	  // ...
 //	}
 //
-// ```
-//
 // On Linux and Unix, this is not necessary; applications should instead
 // be compiled with the `-Wl,--export-dynamic` argument inside their compiler
 // flags, and linked against `gmodule-export-2.0`.
 //
-// ## Example UI Definition
-//
-// ```xml
-// &lt;interface&gt;
-//
-//	&lt;object class="GtkDialog" id="dialog1"&gt;
-//	  &lt;child internal-child="content_area"&gt;
-//	    &lt;object class="GtkBox"&gt;
-//	      &lt;child internal-child="action_area"&gt;
-//	        &lt;object class="GtkBox"&gt;
-//	          &lt;child&gt;
-//	            &lt;object class="GtkButton" id="ok_button"&gt;
-//	              &lt;property name="label" translatable="yes"&gt;_Ok&lt;/property&gt;
-//	              &lt;property name="use-underline"&gt;True&lt;/property&gt;
-//	              &lt;signal name="clicked" handler="ok_button_clicked"/&gt;
-//	            &lt;/object&gt;
-//	          &lt;/child&gt;
-//	        &lt;/object&gt;
-//	      &lt;/child&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	&lt;/object&gt;
-//
-// &lt;/interface&gt;
-// ```
-//
-// ## Using GtkBuildable for extending UI definitions
-//
-// Objects can implement the [iface@Gtk.Buildable] interface to add custom
+// # Example UI Definition
+//
+//	<interface>
+//	  <object class="GtkDialog" id="dialog1">
+//	    <child internal-child="content_area">
+//	      <object class="GtkBox">
+//	        <child internal-child="action_area">
+//	          <object class="GtkBox">
+//	            <child>
+//	              <object class="GtkButton" id="ok_button">
+//	                <property name="label" translatable="yes">_Ok</property>
+//	                <property name="use-underline">True</property>
+//	                <signal name="clicked" handler="ok_button_clicked"/>
+//	              </object>
+//	            </child>
+//	          </object>
+//	        </child>
+//	      </object>
+//	    </child>
+//	  </object>
+//	</interface>
+//
+// # Using GtkBuildable for extending UI definitions
+//
+// Objects can implement the [Buildable] interface to add custom
 // elements and attributes to the XML. Typically, any extension will be
 // documented in each type that implements the interface.
 //
-// ## Menus
+// # Menus
 //
-// In addition to objects with properties that are created with `&lt;object&gt;` and
-// `&lt;property&gt;` elements, `GtkBuilder` also allows to parse XML menu definitions
-// as used by [class@Gio.Menu] when exporting menu models over D-Bus, and as
-// described in the [class@Gtk.PopoverMenu] documentation. Menus can be defined
+// In addition to objects with properties that are created with `<object>` and
+// `<property>` elements, `GtkBuilder` also allows to parse XML menu definitions
+// as used by [gio.Menu] when exporting menu models over D-Bus, and as
+// described in the [PopoverMenu] documentation. Menus can be defined
 // as toplevel elements, or as property values for properties of type `GMenuModel`.
 //
-// ## Templates
+// # Templates
 //
-// When describing a [class@Gtk.Widget], you can use the `&lt;template&gt;` tag to
+// When describing a [Widget], you can use the `<template>` tag to
 // describe a UI bound to a specific widget type. GTK will automatically load
 // the UI definition when instantiating the type, and bind children and
 // signal handlers to instance fields and function symbols.
 //
-// For more information, see the [`GtkWidget` documentation](class.Widget.html#building-composite-widgets-from-template-xml)
+// For more information, see the GtkWidget documentation
 // for details.
 type Builder struct {
 	gobject.Object
@@ -483,8 +595,8 @@ var xNewBuilder func() uintptr
 // Creates a new empty builder object.
 //
 // This function is only useful if you intend to make multiple calls
-// to [method@Gtk.Builder.add_from_file], [method@Gtk.Builder.add_from_resource]
-// or [method@Gtk.Builder.add_from_string] in order to merge multiple UI
+// to [Builder.AddFromFile], [Builder.AddFromResource]
+// or [Builder.AddFromString] in order to merge multiple UI
 // descriptions into a single builder.
 func NewBuilder() *Builder {
 	var cls *Builder
@@ -501,7 +613,7 @@ func NewBuilder() *Builder {
 
 var xNewBuilderFromFile func(string) uintptr
 
-// Parses the UI definition in the file @filename.
+// Parses the UI definition in the file filename.
 //
 // If there is an error opening the file or parsing the description then
 // the program will be aborted. You should only ever attempt to parse
@@ -521,7 +633,7 @@ func NewBuilderFromFile(FilenameVar string) *Builder {
 
 var xNewBuilderFromResource func(string) uintptr
 
-// Parses the UI definition at @resource_path.
+// Parses the UI definition at resource_path.
 //
 // If there is an error locating the resource or parsing the
 // description, then the program will be aborted.
@@ -540,12 +652,12 @@ func NewBuilderFromResource(ResourcePathVar string) *Builder {
 
 var xNewBuilderFromString func(string, int) uintptr
 
-// Parses the UI definition in @string.
+// Parses the UI definition in string.
 //
-// If @string is %NULL-terminated, then @length should be -1.
-// If @length is not -1, then it is the length of @string.
+// If string is nil-terminated, then length should be -1.
+// If length is not -1, then it is the length of string.
 //
-// If there is an error parsing @string then the program will be
+// If there is an error parsing string then the program will be
 // aborted. You should not attempt to parse user interface description
 // from untrusted sources.
 func NewBuilderFromString(StringVar string, LengthVar int) *Builder {
@@ -564,14 +676,14 @@ func NewBuilderFromString(StringVar string, LengthVar int) *Builder {
 var xBuilderAddFromFile func(uintptr, string, **glib.Error) bool
 
 // Parses a file containing a UI definition and merges it with
-// the current contents of @builder.
+// the current contents of builder.
 //
 // This function is useful if you need to call
-// [method@Gtk.Builder.set_current_object]) to add user data to
+// [Builder.SetCurrentObject]) to add user data to
 // callbacks before loading GtkBuilder UI. Otherwise, you probably
-// want [ctor@Gtk.Builder.new_from_file] instead.
+// want [NewBuilderFromFile] instead.
 //
-// If an error occurs, 0 will be returned and @error will be assigned a
+// If an error occurs, 0 will be returned and error will be assigned a
 // `GError` from the `GTK_BUILDER_ERROR`, `G_MARKUP_ERROR` or `G_FILE_ERROR`
 // domains.
 //
@@ -586,7 +698,7 @@ func (x *Builder) AddFromFile(FilenameVar string) (bool, error) {
 
 	cret := xBuilderAddFromFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -595,15 +707,15 @@ func (x *Builder) AddFromFile(FilenameVar string) (bool, error) {
 var xBuilderAddFromResource func(uintptr, string, **glib.Error) bool
 
 // Parses a resource file containing a UI definition
-// and merges it with the current contents of @builder.
+// and merges it with the current contents of builder.
 //
 // This function is useful if you need to call
-// [method@Gtk.Builder.set_current_object] to add user data to
+// [Builder.SetCurrentObject] to add user data to
 // callbacks before loading GtkBuilder UI. Otherwise, you probably
-// want [ctor@Gtk.Builder.new_from_resource] instead.
+// want [NewBuilderFromResource] instead.
 //
-// If an error occurs, 0 will be returned and @error will be assigned a
-// `GError` from the %GTK_BUILDER_ERROR, %G_MARKUP_ERROR or %G_RESOURCE_ERROR
+// If an error occurs, 0 will be returned and error will be assigned a
+// `GError` from the GTK_BUILDER_ERROR, G_MARKUP_ERROR or G_RESOURCE_ERROR
 // domain.
 //
 // It’s not really reasonable to attempt to handle failures of this
@@ -614,7 +726,7 @@ func (x *Builder) AddFromResource(ResourcePathVar string) (bool, error) {
 
 	cret := xBuilderAddFromResource(x.GoPointer(), ResourcePathVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -623,16 +735,16 @@ func (x *Builder) AddFromResource(ResourcePathVar string) (bool, error) {
 var xBuilderAddFromString func(uintptr, string, int, **glib.Error) bool
 
 // Parses a string containing a UI definition and merges it
-// with the current contents of @builder.
+// with the current contents of builder.
 //
 // This function is useful if you need to call
-// [method@Gtk.Builder.set_current_object] to add user data to
+// [Builder.SetCurrentObject] to add user data to
 // callbacks before loading `GtkBuilder` UI. Otherwise, you probably
-// want [ctor@Gtk.Builder.new_from_string] instead.
+// want [NewBuilderFromString] instead.
 //
-// Upon errors %FALSE will be returned and @error will be assigned a
-// `GError` from the %GTK_BUILDER_ERROR, %G_MARKUP_ERROR or
-// %G_VARIANT_PARSE_ERROR domain.
+// Upon errors false will be returned and error will be assigned a
+// `GError` from the GTK_BUILDER_ERROR, G_MARKUP_ERROR or
+// G_VARIANT_PARSE_ERROR domain.
 //
 // It’s not really reasonable to attempt to handle failures of this
 // call.  The only reasonable thing to do when an error is detected is
@@ -642,7 +754,7 @@ func (x *Builder) AddFromString(BufferVar string, LengthVar int) (bool, error) {
 
 	cret := xBuilderAddFromString(x.GoPointer(), BufferVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -652,21 +764,21 @@ var xBuilderAddObjectsFromFile func(uintptr, string, []string, **glib.Error) boo
 
 // Parses a file containing a UI definition building only the
 // requested objects and merges them with the current contents
-// of @builder.
+// of builder.
 //
-// Upon errors, 0 will be returned and @error will be assigned a
-// `GError` from the %GTK_BUILDER_ERROR, %G_MARKUP_ERROR or %G_FILE_ERROR
+// Upon errors, 0 will be returned and error will be assigned a
+// `GError` from the GTK_BUILDER_ERROR, G_MARKUP_ERROR or G_FILE_ERROR
 // domain.
 //
 // If you are adding an object that depends on an object that is not
 // its child (for instance a `GtkTreeView` that depends on its
-// `GtkTreeModel`), you have to explicitly list all of them in @object_ids.
+// `GtkTreeModel`), you have to explicitly list all of them in object_ids.
 func (x *Builder) AddObjectsFromFile(FilenameVar string, ObjectIdsVar []string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderAddObjectsFromFile(x.GoPointer(), FilenameVar, ObjectIdsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -676,21 +788,21 @@ var xBuilderAddObjectsFromResource func(uintptr, string, []string, **glib.Error)
 
 // Parses a resource file containing a UI definition, building
 // only the requested objects and merges them with the current
-// contents of @builder.
+// contents of builder.
 //
-// Upon errors, 0 will be returned and @error will be assigned a
-// `GError` from the %GTK_BUILDER_ERROR, %G_MARKUP_ERROR or %G_RESOURCE_ERROR
+// Upon errors, 0 will be returned and error will be assigned a
+// `GError` from the GTK_BUILDER_ERROR, G_MARKUP_ERROR or G_RESOURCE_ERROR
 // domain.
 //
 // If you are adding an object that depends on an object that is not
 // its child (for instance a `GtkTreeView` that depends on its
-// `GtkTreeModel`), you have to explicitly list all of them in @object_ids.
+// `GtkTreeModel`), you have to explicitly list all of them in object_ids.
 func (x *Builder) AddObjectsFromResource(ResourcePathVar string, ObjectIdsVar []string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderAddObjectsFromResource(x.GoPointer(), ResourcePathVar, ObjectIdsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -700,20 +812,20 @@ var xBuilderAddObjectsFromString func(uintptr, string, int, []string, **glib.Err
 
 // Parses a string containing a UI definition, building only the
 // requested objects and merges them with the current contents of
-// @builder.
+// builder.
 //
-// Upon errors %FALSE will be returned and @error will be assigned a
-// `GError` from the %GTK_BUILDER_ERROR or %G_MARKUP_ERROR domain.
+// Upon errors false will be returned and error will be assigned a
+// `GError` from the GTK_BUILDER_ERROR or G_MARKUP_ERROR domain.
 //
 // If you are adding an object that depends on an object that is not
 // its child (for instance a `GtkTreeView` that depends on its
-// `GtkTreeModel`), you have to explicitly list all of them in @object_ids.
+// `GtkTreeModel`), you have to explicitly list all of them in object_ids.
 func (x *Builder) AddObjectsFromString(BufferVar string, LengthVar int, ObjectIdsVar []string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderAddObjectsFromString(x.GoPointer(), BufferVar, LengthVar, ObjectIdsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -721,19 +833,19 @@ func (x *Builder) AddObjectsFromString(BufferVar string, LengthVar int, ObjectId
 
 var xBuilderCreateClosure func(uintptr, string, BuilderClosureFlags, uintptr, **glib.Error) *gobject.Closure
 
-// Creates a closure to invoke the function called @function_name.
+// Creates a closure to invoke the function called function_name.
 //
-// This is using the create_closure() implementation of @builder's
-// [iface@Gtk.BuilderScope].
+// This is using the create_closure() implementation of builder's
+// [BuilderScope].
 //
-// If no closure could be created, %NULL will be returned and @error
+// If no closure could be created, nil will be returned and error
 // will be set.
 func (x *Builder) CreateClosure(FunctionNameVar string, FlagsVar BuilderClosureFlags, ObjectVar *gobject.Object) (*gobject.Closure, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderCreateClosure(x.GoPointer(), FunctionNameVar, FlagsVar, ObjectVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -741,13 +853,13 @@ func (x *Builder) CreateClosure(FunctionNameVar string, FlagsVar BuilderClosureF
 
 var xBuilderExposeObject func(uintptr, string, uintptr)
 
-// Add @object to the @builder object pool so it can be
+// Add object to the builder object pool so it can be
 // referenced just like any other object built by builder.
 //
-// Only a single object may be added using @name. However,
+// Only a single object may be added using name. However,
 // it is not an error to expose the same object under multiple
-// names. `gtk_builder_get_object()` may be used to determine
-// if an object has already been added with @name.
+// names. `[Builder.GetObject]` may be used to determine
+// if an object has already been added with name.
 func (x *Builder) ExposeObject(NameVar string, ObjectVar *gobject.Object) {
 
 	xBuilderExposeObject(x.GoPointer(), NameVar, ObjectVar.GoPointer())
@@ -766,7 +878,7 @@ func (x *Builder) ExtendWithTemplate(ObjectVar *gobject.Object, TemplateTypeVar
 
 	cret := xBuilderExtendWithTemplate(x.GoPointer(), ObjectVar.GoPointer(), TemplateTypeVar, BufferVar, LengthVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -774,7 +886,7 @@ func (x *Builder) ExtendWithTemplate(ObjectVar *gobject.Object, TemplateTypeVar
 
 var xBuilderGetCurrentObject func(uintptr) uintptr
 
-// Gets the current object set via gtk_builder_set_current_object().
+// Gets the current object set via [Builder.SetCurrentObject].
 func (x *Builder) GetCurrentObject() *gobject.Object {
 	var cls *gobject.Object
 
@@ -791,7 +903,7 @@ func (x *Builder) GetCurrentObject() *gobject.Object {
 
 var xBuilderGetObject func(uintptr, string) uintptr
 
-// Gets the object named @name.
+// Gets the object named name.
 //
 // Note that this function does not increment the reference count
 // of the returned object.
@@ -811,7 +923,7 @@ func (x *Builder) GetObject(NameVar string) *gobject.Object {
 
 var xBuilderGetObjects func(uintptr) *glib.SList
 
-// Gets all objects that have been constructed by @builder.
+// Gets all objects that have been constructed by builder.
 //
 // Note that this function does not increment the reference
 // counts of the returned objects.
@@ -823,7 +935,7 @@ func (x *Builder) GetObjects() *glib.SList {
 
 var xBuilderGetScope func(uintptr) uintptr
 
-// Gets the scope in use that was set via gtk_builder_set_scope().
+// Gets the scope in use that was set via [Builder.SetScope].
 func (x *Builder) GetScope() *BuilderScopeBase {
 	var cls *BuilderScopeBase
 
@@ -840,7 +952,7 @@ func (x *Builder) GetScope() *BuilderScopeBase {
 
 var xBuilderGetTranslationDomain func(uintptr) string
 
-// Gets the translation domain of @builder.
+// Gets the translation domain of builder.
 func (x *Builder) GetTranslationDomain() string {
 
 	cret := xBuilderGetTranslationDomain(x.GoPointer())
@@ -862,15 +974,15 @@ func (x *Builder) GetTypeFromName(TypeNameVar string) types.GType {
 
 var xBuilderSetCurrentObject func(uintptr, uintptr)
 
-// Sets the current object for the @builder.
+// Sets the current object for the builder.
 //
 // The current object can be thought of as the `this` object that the
 // builder is working for and will often be used as the default object
 // when an object is optional.
 //
-// [method@Gtk.Widget.init_template] for example will set the current
+// [Widget.InitTemplate] for example will set the current
 // object to the widget the template is inited for. For functions like
-// [ctor@Gtk.Builder.new_from_resource], the current object will be %NULL.
+// [NewBuilderFromResource], the current object will be nil.
 func (x *Builder) SetCurrentObject(CurrentObjectVar *gobject.Object) {
 
 	xBuilderSetCurrentObject(x.GoPointer(), CurrentObjectVar.GoPointer())
@@ -881,7 +993,7 @@ var xBuilderSetScope func(uintptr, uintptr)
 
 // Sets the scope the builder should operate in.
 //
-// If @scope is %NULL, a new [class@Gtk.BuilderCScope] will be created.
+// If scope is nil, a new [BuilderCScope] will be created.
 func (x *Builder) SetScope(ScopeVar BuilderScope) {
 
 	xBuilderSetScope(x.GoPointer(), ScopeVar.GoPointer())
@@ -890,7 +1002,7 @@ func (x *Builder) SetScope(ScopeVar BuilderScope) {
 
 var xBuilderSetTranslationDomain func(uintptr, uintptr)
 
-// Sets the translation domain of @builder.
+// Sets the translation domain of builder.
 func (x *Builder) SetTranslationDomain(DomainVar *string) {
 
 	DomainVarPtr := core.GStrdupNullable(DomainVar)
@@ -904,21 +1016,21 @@ var xBuilderValueFromString func(uintptr, uintptr, string, *gobject.Value, **gli
 
 // Demarshals a value from a string.
 //
-// This function calls g_value_init() on the @value argument,
+// This function calls [gobject.Value.Init] on the value argument,
 // so it need not be initialised beforehand.
 //
 // Can handle char, uchar, boolean, int, uint, long,
 // ulong, enum, flags, float, double, string, `GdkRGBA` and
 // `GtkAdjustment` type values.
 //
-// Upon errors %FALSE will be returned and @error will be
-// assigned a `GError` from the %GTK_BUILDER_ERROR domain.
+// Upon errors false will be returned and error will be
+// assigned a `GError` from the GTK_BUILDER_ERROR domain.
 func (x *Builder) ValueFromString(PspecVar *gobject.ParamSpec, StringVar string, ValueVar *gobject.Value) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderValueFromString(x.GoPointer(), PspecVar.GoPointer(), StringVar, ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -928,20 +1040,20 @@ var xBuilderValueFromStringType func(uintptr, types.GType, string, *gobject.Valu
 
 // Demarshals a value from a string.
 //
-// Unlike [method@Gtk.Builder.value_from_string], this function
+// Unlike [Builder.ValueFromString], this function
 // takes a `GType` instead of `GParamSpec`.
 //
-// Calls g_value_init() on the @value argument, so it
+// Calls [gobject.Value.Init] on the value argument, so it
 // need not be initialised beforehand.
 //
-// Upon errors %FALSE will be returned and @error will be
-// assigned a `GError` from the %GTK_BUILDER_ERROR domain.
+// Upon errors false will be returned and error will be
+// assigned a `GError` from the GTK_BUILDER_ERROR domain.
 func (x *Builder) ValueFromStringType(TypeVar types.GType, StringVar string, ValueVar *gobject.Value) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xBuilderValueFromStringType(x.GoPointer(), TypeVar, StringVar, ValueVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -962,8 +1074,8 @@ func (c *Builder) SetGoPointer(ptr uintptr) {
 // The translation domain used when translating property values that
 // have been marked as translatable.
 //
-// If the translation domain is %NULL, `GtkBuilder` uses gettext(),
-// otherwise g_dgettext().
+// If the translation domain is nil, `GtkBuilder` uses gettext(),
+// otherwise [glib.Dgettext].
 func (x *Builder) SetPropertyTranslationDomain(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -975,8 +1087,8 @@ func (x *Builder) SetPropertyTranslationDomain(value string) {
 // The translation domain used when translating property values that
 // have been marked as translatable.
 //
-// If the translation domain is %NULL, `GtkBuilder` uses gettext(),
-// otherwise g_dgettext().
+// If the translation domain is nil, `GtkBuilder` uses gettext(),
+// otherwise [glib.Dgettext].
 func (x *Builder) GetPropertyTranslationDomain() string {
 	var v gobject.Value
 	x.GetProperty("translation-domain", &v)
@@ -988,7 +1100,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -997,34 +1109,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xBuilderErrorGLibType, libs, "gtk_builder_error_get_type")
 
-	core.PuregoSafeRegister(&xBuilderErrorQuark, libs, "gtk_builder_error_quark")
+	core.PuregoRegisterSince(&xBuilderErrorQuark, libs, "gtk_builder_error_quark", "GTK", "")
 
 	core.PuregoSafeRegister(&xBuilderGLibType, libs, "gtk_builder_get_type")
 
-	core.PuregoSafeRegister(&xNewBuilder, libs, "gtk_builder_new")
-	core.PuregoSafeRegister(&xNewBuilderFromFile, libs, "gtk_builder_new_from_file")
-	core.PuregoSafeRegister(&xNewBuilderFromResource, libs, "gtk_builder_new_from_resource")
-	core.PuregoSafeRegister(&xNewBuilderFromString, libs, "gtk_builder_new_from_string")
-
-	core.PuregoSafeRegister(&xBuilderAddFromFile, libs, "gtk_builder_add_from_file")
-	core.PuregoSafeRegister(&xBuilderAddFromResource, libs, "gtk_builder_add_from_resource")
-	core.PuregoSafeRegister(&xBuilderAddFromString, libs, "gtk_builder_add_from_string")
-	core.PuregoSafeRegister(&xBuilderAddObjectsFromFile, libs, "gtk_builder_add_objects_from_file")
-	core.PuregoSafeRegister(&xBuilderAddObjectsFromResource, libs, "gtk_builder_add_objects_from_resource")
-	core.PuregoSafeRegister(&xBuilderAddObjectsFromString, libs, "gtk_builder_add_objects_from_string")
-	core.PuregoSafeRegister(&xBuilderCreateClosure, libs, "gtk_builder_create_closure")
-	core.PuregoSafeRegister(&xBuilderExposeObject, libs, "gtk_builder_expose_object")
-	core.PuregoSafeRegister(&xBuilderExtendWithTemplate, libs, "gtk_builder_extend_with_template")
-	core.PuregoSafeRegister(&xBuilderGetCurrentObject, libs, "gtk_builder_get_current_object")
-	core.PuregoSafeRegister(&xBuilderGetObject, libs, "gtk_builder_get_object")
-	core.PuregoSafeRegister(&xBuilderGetObjects, libs, "gtk_builder_get_objects")
-	core.PuregoSafeRegister(&xBuilderGetScope, libs, "gtk_builder_get_scope")
-	core.PuregoSafeRegister(&xBuilderGetTranslationDomain, libs, "gtk_builder_get_translation_domain")
-	core.PuregoSafeRegister(&xBuilderGetTypeFromName, libs, "gtk_builder_get_type_from_name")
-	core.PuregoSafeRegister(&xBuilderSetCurrentObject, libs, "gtk_builder_set_current_object")
-	core.PuregoSafeRegister(&xBuilderSetScope, libs, "gtk_builder_set_scope")
-	core.PuregoSafeRegister(&xBuilderSetTranslationDomain, libs, "gtk_builder_set_translation_domain")
-	core.PuregoSafeRegister(&xBuilderValueFromString, libs, "gtk_builder_value_from_string")
-	core.PuregoSafeRegister(&xBuilderValueFromStringType, libs, "gtk_builder_value_from_string_type")
+	core.PuregoRegisterSince(&xNewBuilder, libs, "gtk_builder_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewBuilderFromFile, libs, "gtk_builder_new_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewBuilderFromResource, libs, "gtk_builder_new_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xNewBuilderFromString, libs, "gtk_builder_new_from_string", "GTK", "")
+
+	core.PuregoRegisterSince(&xBuilderAddFromFile, libs, "gtk_builder_add_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderAddFromResource, libs, "gtk_builder_add_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderAddFromString, libs, "gtk_builder_add_from_string", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderAddObjectsFromFile, libs, "gtk_builder_add_objects_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderAddObjectsFromResource, libs, "gtk_builder_add_objects_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderAddObjectsFromString, libs, "gtk_builder_add_objects_from_string", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderCreateClosure, libs, "gtk_builder_create_closure", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderExposeObject, libs, "gtk_builder_expose_object", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderExtendWithTemplate, libs, "gtk_builder_extend_with_template", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetCurrentObject, libs, "gtk_builder_get_current_object", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetObject, libs, "gtk_builder_get_object", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetObjects, libs, "gtk_builder_get_objects", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetScope, libs, "gtk_builder_get_scope", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetTranslationDomain, libs, "gtk_builder_get_translation_domain", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderGetTypeFromName, libs, "gtk_builder_get_type_from_name", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderSetCurrentObject, libs, "gtk_builder_set_current_object", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderSetScope, libs, "gtk_builder_set_scope", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderSetTranslationDomain, libs, "gtk_builder_set_translation_domain", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderValueFromString, libs, "gtk_builder_value_from_string", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderValueFromStringType, libs, "gtk_builder_value_from_string_type", "GTK", "")
 
 }
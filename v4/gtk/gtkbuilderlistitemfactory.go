@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,31 +22,28 @@ func (x *BuilderListItemFactoryClass) GoPointer() uintptr {
 // Creates widgets by instantiating `GtkBuilder` UI templates.
 //
 // The templates must extend the class that the parent widget expects.
-// For example, a factory provided to [property@Gtk.ListView:factory] must have
-// a template that extends [class@Gtk.ListItem].
+// For example, a factory provided to ListView:factory must have
+// a template that extends [ListItem].
 //
-// Templates typically use [class@Gtk.Expression] to obtain data from the items
+// Templates typically use [Expression] to obtain data from the items
 // in the model.
 //
 // Example:
-// ```xml
 //
-//	&lt;interface&gt;
-//	  &lt;template class="GtkListItem"&gt;
-//	    &lt;property name="child"&gt;
-//	      &lt;object class="GtkLabel"&gt;
-//	        &lt;property name="xalign"&gt;0&lt;/property&gt;
-//	        &lt;binding name="label"&gt;
-//	          &lt;lookup name="name" type="SettingsKey"&gt;
-//	            &lt;lookup name="item"&gt;GtkListItem&lt;/lookup&gt;
-//	          &lt;/lookup&gt;
-//	        &lt;/binding&gt;
-//	      &lt;/object&gt;
-//	    &lt;/property&gt;
-//	  &lt;/template&gt;
-//	&lt;/interface&gt;
-//
-// ```
+//	<interface>
+//	  <template class="GtkListItem">
+//	    <property name="child">
+//	      <object class="GtkLabel">
+//	        <property name="xalign">0</property>
+//	        <binding name="label">
+//	          <lookup name="name" type="SettingsKey">
+//	            <lookup name="item">GtkListItem</lookup>
+//	          </lookup>
+//	        </binding>
+//	      </object>
+//	    </property>
+//	  </template>
+//	</interface>
 //
 // A common approach is to embed such templates as CDATA marked sections into
 // a surrounding UI file. Note that if you use this approach, extracting
@@ -72,7 +68,7 @@ func BuilderListItemFactoryNewFromInternalPtr(ptr uintptr) *BuilderListItemFacto
 var xNewBuilderListItemFactoryFromBytes func(uintptr, *glib.Bytes) uintptr
 
 // Creates a new `GtkBuilderListItemFactory` that instantiates widgets
-// using @bytes as the data to pass to `GtkBuilder`.
+// using bytes as the data to pass to `GtkBuilder`.
 func NewBuilderListItemFactoryFromBytes(ScopeVar BuilderScope, BytesVar *glib.Bytes) *BuilderListItemFactory {
 	var cls *BuilderListItemFactory
 
@@ -89,7 +85,7 @@ func NewBuilderListItemFactoryFromBytes(ScopeVar BuilderScope, BytesVar *glib.By
 var xNewBuilderListItemFactoryFromResource func(uintptr, string) uintptr
 
 // Creates a new `GtkBuilderListItemFactory` that instantiates widgets
-// using data read from the given @resource_path to pass to `GtkBuilder`.
+// using data read from the given resource_path to pass to `GtkBuilder`.
 func NewBuilderListItemFactoryFromResource(ScopeVar BuilderScope, ResourcePathVar string) *BuilderListItemFactory {
 	var cls *BuilderListItemFactory
 
@@ -189,7 +185,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -198,11 +194,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xBuilderListItemFactoryGLibType, libs, "gtk_builder_list_item_factory_get_type")
 
-	core.PuregoSafeRegister(&xNewBuilderListItemFactoryFromBytes, libs, "gtk_builder_list_item_factory_new_from_bytes")
-	core.PuregoSafeRegister(&xNewBuilderListItemFactoryFromResource, libs, "gtk_builder_list_item_factory_new_from_resource")
+	core.PuregoRegisterSince(&xNewBuilderListItemFactoryFromBytes, libs, "gtk_builder_list_item_factory_new_from_bytes", "GTK", "")
+	core.PuregoRegisterSince(&xNewBuilderListItemFactoryFromResource, libs, "gtk_builder_list_item_factory_new_from_resource", "GTK", "")
 
-	core.PuregoSafeRegister(&xBuilderListItemFactoryGetBytes, libs, "gtk_builder_list_item_factory_get_bytes")
-	core.PuregoSafeRegister(&xBuilderListItemFactoryGetResource, libs, "gtk_builder_list_item_factory_get_resource")
-	core.PuregoSafeRegister(&xBuilderListItemFactoryGetScope, libs, "gtk_builder_list_item_factory_get_scope")
+	core.PuregoRegisterSince(&xBuilderListItemFactoryGetBytes, libs, "gtk_builder_list_item_factory_get_bytes", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderListItemFactoryGetResource, libs, "gtk_builder_list_item_factory_get_resource", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderListItemFactoryGetScope, libs, "gtk_builder_list_item_factory_get_scope", "GTK", "")
 
 }
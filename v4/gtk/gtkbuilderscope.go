@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -28,7 +29,7 @@ func (x *BuilderCScopeClass) GoPointer() uintptr {
 type BuilderScopeInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetTypeFromName uintptr
 
@@ -44,15 +45,16 @@ func (x *BuilderScopeInterface) GoPointer() uintptr {
 // OverrideGetTypeFromName sets the "get_type_from_name" callback function.
 // Try to lookup a `GType` via the its name. See
 //
-//	gtk_builder_get_type_from_name() for more details.
-//	The C implementation will use g_type_from_name() and if that fails try to guess the
+//	[Builder.GetTypeFromName] for more details.
+//	The C implementation will use [gobject.TypeFromName] and if that fails try to guess the
 //	correct function name for registering the type and then use dlsym() to load it.
-//	The default implementation just tries g_type_from_name() and otherwise fails.
+//	The default implementation just tries [gobject.TypeFromName] and otherwise fails.
 func (x *BuilderScopeInterface) OverrideGetTypeFromName(cb func(BuilderScope, *Builder, string) types.GType) {
 	if cb == nil {
 		x.xGetTypeFromName = 0
 	} else {
-		x.xGetTypeFromName = purego.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, TypeNameVarp string) types.GType {
+		x.xGetTypeFromName = core.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, TypeNameVarp string) types.GType {
+			defer core.RecoverPanic()
 			return cb(&BuilderScopeBase{Ptr: SelfVarp}, BuilderNewFromInternalPtr(BuilderVarp), TypeNameVarp)
 		})
 	}
@@ -61,16 +63,16 @@ func (x *BuilderScopeInterface) OverrideGetTypeFromName(cb func(BuilderScope, *B
 // GetGetTypeFromName gets the "get_type_from_name" callback function.
 // Try to lookup a `GType` via the its name. See
 //
-//	gtk_builder_get_type_from_name() for more details.
-//	The C implementation will use g_type_from_name() and if that fails try to guess the
+//	[Builder.GetTypeFromName] for more details.
+//	The C implementation will use [gobject.TypeFromName] and if that fails try to guess the
 //	correct function name for registering the type and then use dlsym() to load it.
-//	The default implementation just tries g_type_from_name() and otherwise fails.
+//	The default implementation just tries [gobject.TypeFromName] and otherwise fails.
 func (x *BuilderScopeInterface) GetGetTypeFromName() func(BuilderScope, *Builder, string) types.GType {
 	if x.xGetTypeFromName == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, BuilderVarp uintptr, TypeNameVarp string) types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetTypeFromName)
+	core.RegisterFunc(&rawCallback, x.xGetTypeFromName)
 	return func(SelfVar BuilderScope, BuilderVar *Builder, TypeNameVar string) types.GType {
 		return rawCallback(SelfVar.GoPointer(), BuilderVar.GoPointer(), TypeNameVar)
 	}
@@ -79,15 +81,16 @@ func (x *BuilderScopeInterface) GetGetTypeFromName() func(BuilderScope, *Builder
 // OverrideGetTypeFromFunction sets the "get_type_from_function" callback function.
 // Try to lookup a `GType` via the given function name, specified
 //
-//	explicitly in a GtkBuilder file, like via the "type-func" attribute in the `&lt;object&gt;` tag.
+//	explicitly in a GtkBuilder file, like via the "type-func" attribute in the `<object>` tag.
 //	This function is very rarely used.
 //	The C implementation will use dlsym() and call the resulting function as a `GTypeFunc`.
-//	The default implementation will fail and just return %G_TYPE_INVALID.
+//	The default implementation will fail and just return G_TYPE_INVALID.
 func (x *BuilderScopeInterface) OverrideGetTypeFromFunction(cb func(BuilderScope, *Builder, string) types.GType) {
 	if cb == nil {
 		x.xGetTypeFromFunction = 0
 	} else {
-		x.xGetTypeFromFunction = purego.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string) types.GType {
+		x.xGetTypeFromFunction = core.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string) types.GType {
+			defer core.RecoverPanic()
 			return cb(&BuilderScopeBase{Ptr: SelfVarp}, BuilderNewFromInternalPtr(BuilderVarp), FunctionNameVarp)
 		})
 	}
@@ -96,51 +99,52 @@ func (x *BuilderScopeInterface) OverrideGetTypeFromFunction(cb func(BuilderScope
 // GetGetTypeFromFunction gets the "get_type_from_function" callback function.
 // Try to lookup a `GType` via the given function name, specified
 //
-//	explicitly in a GtkBuilder file, like via the "type-func" attribute in the `&lt;object&gt;` tag.
+//	explicitly in a GtkBuilder file, like via the "type-func" attribute in the `<object>` tag.
 //	This function is very rarely used.
 //	The C implementation will use dlsym() and call the resulting function as a `GTypeFunc`.
-//	The default implementation will fail and just return %G_TYPE_INVALID.
+//	The default implementation will fail and just return G_TYPE_INVALID.
 func (x *BuilderScopeInterface) GetGetTypeFromFunction() func(BuilderScope, *Builder, string) types.GType {
 	if x.xGetTypeFromFunction == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string) types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetTypeFromFunction)
+	core.RegisterFunc(&rawCallback, x.xGetTypeFromFunction)
 	return func(SelfVar BuilderScope, BuilderVar *Builder, FunctionNameVar string) types.GType {
 		return rawCallback(SelfVar.GoPointer(), BuilderVar.GoPointer(), FunctionNameVar)
 	}
 }
 
 // OverrideCreateClosure sets the "create_closure" callback function.
-// Create a closure with the given arguments. See gtk_builder_create_closure()
+// Create a closure with the given arguments. See [Builder.CreateClosure]
 //
 //	for more details on those.
 //	The C implementation will try to use dlsym() to locate the function name and then
-//	g_cclosure_new() to create a closure for the symbol.
-//	The default implementation just fails and returns %NULL.
+//	[gobject.CClosureNew] to create a closure for the symbol.
+//	The default implementation just fails and returns nil.
 func (x *BuilderScopeInterface) OverrideCreateClosure(cb func(BuilderScope, *Builder, string, BuilderClosureFlags, *gobject.Object) *gobject.Closure) {
 	if cb == nil {
 		x.xCreateClosure = 0
 	} else {
-		x.xCreateClosure = purego.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string, FlagsVarp BuilderClosureFlags, ObjectVarp uintptr) *gobject.Closure {
+		x.xCreateClosure = core.NewCallback(func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string, FlagsVarp BuilderClosureFlags, ObjectVarp uintptr) *gobject.Closure {
+			defer core.RecoverPanic()
 			return cb(&BuilderScopeBase{Ptr: SelfVarp}, BuilderNewFromInternalPtr(BuilderVarp), FunctionNameVarp, FlagsVarp, gobject.ObjectNewFromInternalPtr(ObjectVarp))
 		})
 	}
 }
 
 // GetCreateClosure gets the "create_closure" callback function.
-// Create a closure with the given arguments. See gtk_builder_create_closure()
+// Create a closure with the given arguments. See [Builder.CreateClosure]
 //
 //	for more details on those.
 //	The C implementation will try to use dlsym() to locate the function name and then
-//	g_cclosure_new() to create a closure for the symbol.
-//	The default implementation just fails and returns %NULL.
+//	[gobject.CClosureNew] to create a closure for the symbol.
+//	The default implementation just fails and returns nil.
 func (x *BuilderScopeInterface) GetCreateClosure() func(BuilderScope, *Builder, string, BuilderClosureFlags, *gobject.Object) *gobject.Closure {
 	if x.xCreateClosure == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, BuilderVarp uintptr, FunctionNameVarp string, FlagsVarp BuilderClosureFlags, ObjectVarp uintptr) *gobject.Closure
-	purego.RegisterFunc(&rawCallback, x.xCreateClosure)
+	core.RegisterFunc(&rawCallback, x.xCreateClosure)
 	return func(SelfVar BuilderScope, BuilderVar *Builder, FunctionNameVar string, FlagsVar BuilderClosureFlags, ObjectVar *gobject.Object) *gobject.Closure {
 		return rawCallback(SelfVar.GoPointer(), BuilderVar.GoPointer(), FunctionNameVar, FlagsVar, ObjectVar.GoPointer())
 	}
@@ -158,10 +162,10 @@ func (x *BuilderScopeInterface) GetCreateClosure() func(BuilderScope, *Builder,
 // even at once.
 //
 // By default, GTK will use its own implementation of `GtkBuilderScope`
-// for the C language which can be created via [ctor@Gtk.BuilderCScope.new].
+// for the C language which can be created via [NewBuilderCScope].
 //
 // If you implement `GtkBuilderScope` for a language binding, you
-// may want to (partially) derive from or fall back to a [class@Gtk.BuilderCScope],
+// may want to (partially) derive from or fall back to a [BuilderCScope],
 // as that class implements support for automatic lookups from C symbols.
 type BuilderScope interface {
 	GoPointer() uintptr
@@ -189,13 +193,59 @@ func (x *BuilderScopeBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// The list of flags that can be passed to gtk_builder_create_closure().
+// ImplementBuilderScope adds the BuilderScope interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetTypeFromName(*Builder, string) types.GType
+//   - GetTypeFromFunction(*Builder, string) types.GType
+//   - CreateClosure(*Builder, string, BuilderClosureFlags, *gobject.Object) *gobject.Closure
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see BuilderScopeInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementBuilderScope[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, BuilderScopeGLibType(), func(iface *BuilderScopeInterface) {
+		if _, ok := any(zero).(interface {
+			GetTypeFromName(*Builder, string) types.GType
+		}); ok {
+			iface.OverrideGetTypeFromName(func(self BuilderScope, arg0 *Builder, arg1 string) types.GType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetTypeFromName(*Builder, string) types.GType
+				})
+				return impl.GetTypeFromName(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetTypeFromFunction(*Builder, string) types.GType
+		}); ok {
+			iface.OverrideGetTypeFromFunction(func(self BuilderScope, arg0 *Builder, arg1 string) types.GType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetTypeFromFunction(*Builder, string) types.GType
+				})
+				return impl.GetTypeFromFunction(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			CreateClosure(*Builder, string, BuilderClosureFlags, *gobject.Object) *gobject.Closure
+		}); ok {
+			iface.OverrideCreateClosure(func(self BuilderScope, arg0 *Builder, arg1 string, arg2 BuilderClosureFlags, arg3 *gobject.Object) *gobject.Closure {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					CreateClosure(*Builder, string, BuilderClosureFlags, *gobject.Object) *gobject.Closure
+				})
+				return impl.CreateClosure(arg0, arg1, arg2, arg3)
+			})
+		}
+	})
+}
+
+// The list of flags that can be passed to [Builder.CreateClosure].
 //
 // New values may be added in the future for new features, so external
-// implementations of [iface@Gtk.BuilderScope] should test the flags
-// for unknown values and raise a %GTK_BUILDER_ERROR_INVALID_ATTRIBUTE error
+// implementations of [BuilderScope] should test the flags
+// for unknown values and raise a [BuilderErrorInvalidAttributeValue] error
 // when they encounter one.
-type BuilderClosureFlags int
+type BuilderClosureFlags uint32
 
 var xBuilderClosureFlagsGLibType func() types.GType
 
@@ -206,24 +256,61 @@ func BuilderClosureFlagsGLibType() types.GType {
 const (
 
 	// The closure should be created swapped. See
-	//   g_cclosure_new_swap() for details.
+	//   [gobject.CClosureNewSwap] for details.
 	BuilderClosureSwappedValue BuilderClosureFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (BuilderClosureFlags) GLibType() types.GType {
+	return xBuilderClosureFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x BuilderClosureFlags) Has(flags BuilderClosureFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x BuilderClosureFlags) Set(flags BuilderClosureFlags) BuilderClosureFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x BuilderClosureFlags) Clear(flags BuilderClosureFlags) BuilderClosureFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x BuilderClosureFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&BuilderClosureSwappedValue != 0 && x&BuilderClosureSwappedValue == BuilderClosureSwappedValue {
+		names = append(names, "GTK_BUILDER_CLOSURE_SWAPPED")
+		rest &^= BuilderClosureSwappedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // A `GtkBuilderScope` implementation for the C language.
 //
-// `GtkBuilderCScope` instances use symbols explicitly added to @builder
-// with prior calls to [method@Gtk.BuilderCScope.add_callback_symbol].
+// `GtkBuilderCScope` instances use symbols explicitly added to builder
+// with prior calls to [BuilderCScope.AddCallbackSymbol].
 // If developers want to do that, they are encouraged to create their
 // own scopes for that purpose.
 //
 // In the case that symbols are not explicitly added; GTK will uses
-// `GModule`’s introspective features (by opening the module %NULL) to
+// `GModule`’s introspective features (by opening the module nil) to
 // look at the application’s symbol table. From here it tries to match
 // the signal function names given in the interface description with
 // symbols in the application.
 //
-// Note that unless [method@Gtk.BuilderCScope.add_callback_symbol] is
+// Note that unless [BuilderCScope.AddCallbackSymbol] is
 // called for all signal callbacks which are referenced by the loaded XML,
 // this functionality will require that `GModule` be supported on the platform.
 type BuilderCScope struct {
@@ -248,7 +335,7 @@ var xNewBuilderCScope func() uintptr
 // `GtkBuilder` instances.
 //
 // Calling this function is only necessary if you want to add
-// custom callbacks via [method@Gtk.BuilderCScope.add_callback_symbol].
+// custom callbacks via [BuilderCScope.AddCallbackSymbol].
 func NewBuilderCScope() *BuilderCScope {
 	var cls *BuilderCScope
 
@@ -264,11 +351,11 @@ func NewBuilderCScope() *BuilderCScope {
 
 var xBuilderCScopeAddCallbackSymbol func(uintptr, string, uintptr)
 
-// Adds the @callback_symbol to the scope of @builder under the
-// given @callback_name.
+// Adds the callback_symbol to the scope of builder under the
+// given callback_name.
 //
 // Using this function overrides the behavior of
-// [method@Gtk.Builder.create_closure] for any callback symbols that
+// [Builder.CreateClosure] for any callback symbols that
 // are added. Using this method allows for better encapsulation as it
 // does not require that callback symbols be declared in the global
 // namespace.
@@ -281,11 +368,12 @@ func (x *BuilderCScope) AddCallbackSymbol(CallbackNameVar string, CallbackSymbol
 			CallbackSymbolVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackSymbolVar
 				cbFn()
 			}
-			CallbackSymbolVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackSymbolVarPtr, CallbackSymbolVarRef, CallbackSymbolVar)
+			CallbackSymbolVarRef = core.NewCallback(fcb)
+			CallbackSymbolVarRef = glib.SaveCallbackWithClosure(CallbackSymbolVarPtr, CallbackSymbolVarRef, CallbackSymbolVar)
 		}
 	}
 
@@ -297,7 +385,7 @@ var xBuilderCScopeAddCallbackSymbols func(uintptr, string, uintptr, ...interface
 
 // A convenience function to add many callbacks.
 //
-// This is equivalent to calling [method@Gtk.BuilderCScope.add_callback_symbol]
+// This is equivalent to calling [BuilderCScope.AddCallbackSymbol]
 // for each symbol.
 func (x *BuilderCScope) AddCallbackSymbols(FirstCallbackNameVar string, FirstCallbackSymbolVar *gobject.Callback, varArgs ...interface{}) {
 
@@ -308,11 +396,12 @@ func (x *BuilderCScope) AddCallbackSymbols(FirstCallbackNameVar string, FirstCal
 			FirstCallbackSymbolVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *FirstCallbackSymbolVar
 				cbFn()
 			}
-			FirstCallbackSymbolVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FirstCallbackSymbolVarPtr, FirstCallbackSymbolVarRef, FirstCallbackSymbolVar)
+			FirstCallbackSymbolVarRef = core.NewCallback(fcb)
+			FirstCallbackSymbolVarRef = glib.SaveCallbackWithClosure(FirstCallbackSymbolVarPtr, FirstCallbackSymbolVarRef, FirstCallbackSymbolVar)
 		}
 	}
 
@@ -323,7 +412,7 @@ func (x *BuilderCScope) AddCallbackSymbols(FirstCallbackNameVar string, FirstCal
 var xBuilderCScopeLookupCallbackSymbol func(uintptr, string) uintptr
 
 // Fetches a symbol previously added with
-// gtk_builder_cscope_add_callback_symbol().
+// [BuilderCScope.AddCallbackSymbol].
 func (x *BuilderCScope) LookupCallbackSymbol(CallbackNameVar string) uintptr {
 
 	cret := xBuilderCScopeLookupCallbackSymbol(x.GoPointer(), CallbackNameVar)
@@ -346,7 +435,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -357,12 +446,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xBuilderCScopeGLibType, libs, "gtk_builder_cscope_get_type")
 
-	core.PuregoSafeRegister(&xNewBuilderCScope, libs, "gtk_builder_cscope_new")
+	gobject.CheckClassSize("GtkBuilderCScopeClass", xBuilderCScopeGLibType, unsafe.Sizeof(BuilderCScopeClass{}))
 
-	core.PuregoSafeRegister(&xBuilderCScopeAddCallbackSymbol, libs, "gtk_builder_cscope_add_callback_symbol")
-	core.PuregoSafeRegister(&xBuilderCScopeAddCallbackSymbols, libs, "gtk_builder_cscope_add_callback_symbols")
-	core.PuregoSafeRegister(&xBuilderCScopeLookupCallbackSymbol, libs, "gtk_builder_cscope_lookup_callback_symbol")
+	core.PuregoRegisterSince(&xNewBuilderCScope, libs, "gtk_builder_cscope_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xBuilderCScopeAddCallbackSymbol, libs, "gtk_builder_cscope_add_callback_symbol", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderCScopeAddCallbackSymbols, libs, "gtk_builder_cscope_add_callback_symbols", "GTK", "")
+	core.PuregoRegisterSince(&xBuilderCScopeLookupCallbackSymbol, libs, "gtk_builder_cscope_lookup_callback_symbol", "GTK", "")
 
 	core.PuregoSafeRegister(&xBuilderScopeGLibType, libs, "gtk_builder_scope_get_type")
 
+	gobject.CheckClassSize("GtkBuilderScopeInterface", xBuilderScopeGLibType, unsafe.Sizeof(BuilderScopeInterface{}))
+
 }
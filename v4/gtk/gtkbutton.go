@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -34,7 +33,8 @@ func (x *ButtonClass) OverrideClicked(cb func(*Button)) {
 	if cb == nil {
 		x.xClicked = 0
 	} else {
-		x.xClicked = purego.NewCallback(func(ButtonVarp uintptr) {
+		x.xClicked = core.NewCallback(func(ButtonVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ButtonNewFromInternalPtr(ButtonVarp))
 		})
 	}
@@ -47,7 +47,7 @@ func (x *ButtonClass) GetClicked() func(*Button) {
 		return nil
 	}
 	var rawCallback func(ButtonVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClicked)
+	core.RegisterFunc(&rawCallback, x.xClicked)
 	return func(ButtonVar *Button) {
 		rawCallback(ButtonVar.GoPointer())
 	}
@@ -57,12 +57,13 @@ func (x *ButtonClass) GetClicked() func(*Button) {
 // Signal that causes the button to animate press then
 //
 //	release. Applications should never connect to this signal, but use
-//	the @clicked signal.
+//	the clicked signal.
 func (x *ButtonClass) OverrideActivate(cb func(*Button)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(ButtonVarp uintptr) {
+		x.xActivate = core.NewCallback(func(ButtonVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ButtonNewFromInternalPtr(ButtonVarp))
 		})
 	}
@@ -72,13 +73,13 @@ func (x *ButtonClass) OverrideActivate(cb func(*Button)) {
 // Signal that causes the button to animate press then
 //
 //	release. Applications should never connect to this signal, but use
-//	the @clicked signal.
+//	the clicked signal.
 func (x *ButtonClass) GetActivate() func(*Button) {
 	if x.xActivate == 0 {
 		return nil
 	}
 	var rawCallback func(ButtonVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(ButtonVar *Button) {
 		rawCallback(ButtonVar.GoPointer())
 	}
@@ -94,13 +95,6 @@ func (x *ButtonPrivate) GoPointer() uintptr {
 
 // Calls a callback function when the button is clicked.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkButton" src="button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The `GtkButton` widget can hold any valid child widget. That is, it can hold
 // almost any other standard `GtkWidget`. The most commonly used child is the
 // `GtkLabel`.
@@ -109,7 +103,7 @@ func (x *ButtonPrivate) GoPointer() uintptr {
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.Button::activate]
+// - [Button.ConnectActivateFunc]
 //
 // # CSS nodes
 //
@@ -123,15 +117,15 @@ func (x *ButtonPrivate) GoPointer() uintptr {
 // .suggested-action and .destructive-action. In special cases, buttons
 // can be made round by adding the .circular style class.
 //
-// Button-like widgets like [class@Gtk.ToggleButton], [class@Gtk.MenuButton],
-// [class@Gtk.VolumeButton], [class@Gtk.LockButton], [class@Gtk.ColorButton]
-// or [class@Gtk.FontButton] use style classes such as .toggle, .popup, .scale,
+// Button-like widgets like [ToggleButton], [MenuButton],
+// [VolumeButton], [LockButton], [ColorButton]
+// or [FontButton] use style classes such as .toggle, .popup, .scale,
 // .lock, .color on the button node to differentiate themselves from a plain
 // `GtkButton`.
 //
 // # Accessibility
 //
-// `GtkButton` uses the [enum@Gtk.AccessibleRole.button] role.
+// `GtkButton` uses the [AccessibleRoleButtonValue] role.
 type Button struct {
 	Widget
 }
@@ -152,7 +146,7 @@ var xNewButton func() uintptr
 
 // Creates a new `GtkButton` widget.
 //
-// To add a child widget to the button, use [method@Gtk.Button.set_child].
+// To add a child widget to the button, use [Button.SetChild].
 func NewButton() *Button {
 	var cls *Button
 
@@ -209,10 +203,10 @@ var xNewButtonWithMnemonic func(string) uintptr
 
 // Creates a new `GtkButton` containing a label.
 //
-// If characters in @label are preceded by an underscore, they are underlined.
+// If characters in label are preceded by an underscore, they are underlined.
 // If you need a literal underscore character in a label, use “__” (two
 // underscores). The first underlined character represents a keyboard
-// accelerator called a mnemonic. Pressing &lt;kbd&gt;Alt&lt;/kbd&gt; and that key
+// accelerator called a mnemonic. Pressing Alt and that key
 // activates the button.
 func NewButtonWithMnemonic(LabelVar string) *Button {
 	var cls *Button
@@ -240,7 +234,7 @@ func (x *Button) GetCanShrink() bool {
 
 var xButtonGetChild func(uintptr) uintptr
 
-// Gets the child widget of @button.
+// Gets the child widget of button.
 func (x *Button) GetChild() *Widget {
 	var cls *Widget
 
@@ -268,9 +262,9 @@ var xButtonGetIconName func(uintptr) string
 
 // Returns the icon name of the button.
 //
-// If the icon name has not been set with [method@Gtk.Button.set_icon_name]
-// the return value will be %NULL. This will be the case if you create
-// an empty button with [ctor@Gtk.Button.new] to use as a container.
+// If the icon name has not been set with [Button.SetIconName]
+// the return value will be nil. This will be the case if you create
+// an empty button with [NewButton] to use as a container.
 func (x *Button) GetIconName() string {
 
 	cret := xButtonGetIconName(x.GoPointer())
@@ -281,9 +275,9 @@ var xButtonGetLabel func(uintptr) string
 
 // Fetches the text from the label of the button.
 //
-// If the label text has not been set with [method@Gtk.Button.set_label]
-// the return value will be %NULL. This will be the case if you create
-// an empty button with [ctor@Gtk.Button.new] to use as a container.
+// If the label text has not been set with [Button.SetLabel]
+// the return value will be nil. This will be the case if you create
+// an empty button with [NewButton] to use as a container.
 func (x *Button) GetLabel() string {
 
 	cret := xButtonGetLabel(x.GoPointer())
@@ -294,7 +288,7 @@ var xButtonGetUseUnderline func(uintptr) bool
 
 // gets whether underlines are interpreted as mnemonics.
 //
-// See [method@Gtk.Button.set_use_underline].
+// See [Button.SetUseUnderline].
 func (x *Button) GetUseUnderline() bool {
 
 	cret := xButtonGetUseUnderline(x.GoPointer())
@@ -306,7 +300,7 @@ var xButtonSetCanShrink func(uintptr, bool)
 // Sets whether the button size can be smaller than the natural size of
 // its contents.
 //
-// For text buttons, setting @can_shrink to true will ellipsize the label.
+// For text buttons, setting can_shrink to true will ellipsize the label.
 //
 // For icons and custom children, this function has no effect.
 func (x *Button) SetCanShrink(CanShrinkVar bool) {
@@ -317,13 +311,13 @@ func (x *Button) SetCanShrink(CanShrinkVar bool) {
 
 var xButtonSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @button.
+// Sets the child widget of button.
 //
 // Note that by using this API, you take full responsibility for setting
-// up the proper accessibility label and description information for @button.
+// up the proper accessibility label and description information for button.
 // Most likely, you'll either set the accessibility label or description
-// for @button explicitly, or you'll set a labelled-by or described-by
-// relations from @child to @button.
+// for button explicitly, or you'll set a labelled-by or described-by
+// relations from child to button.
 func (x *Button) SetChild(ChildVar *Widget) {
 
 	xButtonSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -345,7 +339,7 @@ var xButtonSetIconName func(uintptr, string)
 
 // Adds a `GtkImage` with the given icon name as a child.
 //
-// If @button already contains a child widget, that child widget will
+// If button already contains a child widget, that child widget will
 // be removed and replaced with the image.
 func (x *Button) SetIconName(IconNameVar string) {
 
@@ -355,7 +349,7 @@ func (x *Button) SetIconName(IconNameVar string) {
 
 var xButtonSetLabel func(uintptr, string)
 
-// Sets the text of the label of the button to @label.
+// Sets the text of the label of the button to label.
 //
 // This will also clear any previously set labels.
 func (x *Button) SetLabel(LabelVar string) {
@@ -489,55 +483,99 @@ func (x *Button) GetPropertyUseUnderline() bool {
 // Emitted to animate press then release.
 //
 // This is an action signal. Applications should never connect
-// to this signal, but use the [signal@Gtk.Button::clicked] signal.
+// to this signal, but use the [Button.ConnectClickedFunc] signal.
 //
 // The default bindings for this signal are all forms of the
-// &lt;kbd&gt;␣&lt;/kbd&gt; and &lt;kbd&gt;Enter&lt;/kbd&gt; keys.
+// ␣ and Enter keys.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Button) ConnectActivate(cb *func(Button)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Button{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Button))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Button{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Button) ConnectActivateFunc(cb func(Button)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xButtonActivateTrampoline, xButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the button has been activated (pressed and released).
+//
+// Deprecated: use ConnectClickedFunc, which also accepts method values and closures.
 func (x *Button) ConnectClicked(cb *func(Button)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Button{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "clicked", cbRefPtr, cbPtr)
+}
+
+var xButtonClickedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xButtonClickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Button))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Button{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "clicked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClickedFunc connects cb to the "clicked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Button) ConnectClickedFunc(cb func(Button)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "clicked", &xButtonClickedTrampoline, xButtonClickedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -701,7 +739,7 @@ func (x *Button) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -709,14 +747,13 @@ func (x *Button) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Button) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -740,19 +777,17 @@ func (x *Button) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Button) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -773,7 +808,7 @@ func (x *Button) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -782,14 +817,12 @@ func (x *Button) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Button) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -808,14 +841,14 @@ func (x *Button) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *Button) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *Button) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -825,15 +858,15 @@ func (x *Button) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *Button) SetActionName(ActionNameVar *string) {
 
@@ -846,13 +879,13 @@ func (x *Button) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *Button) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -861,14 +894,14 @@ func (x *Button) SetActionTarget(FormatStringVar string, varArgs ...interface{})
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -886,18 +919,18 @@ func (x *Button) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *Button) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Button) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -909,7 +942,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -918,22 +951,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xButtonGLibType, libs, "gtk_button_get_type")
 
-	core.PuregoSafeRegister(&xNewButton, libs, "gtk_button_new")
-	core.PuregoSafeRegister(&xNewButtonFromIconName, libs, "gtk_button_new_from_icon_name")
-	core.PuregoSafeRegister(&xNewButtonWithLabel, libs, "gtk_button_new_with_label")
-	core.PuregoSafeRegister(&xNewButtonWithMnemonic, libs, "gtk_button_new_with_mnemonic")
-
-	core.PuregoSafeRegister(&xButtonGetCanShrink, libs, "gtk_button_get_can_shrink")
-	core.PuregoSafeRegister(&xButtonGetChild, libs, "gtk_button_get_child")
-	core.PuregoSafeRegister(&xButtonGetHasFrame, libs, "gtk_button_get_has_frame")
-	core.PuregoSafeRegister(&xButtonGetIconName, libs, "gtk_button_get_icon_name")
-	core.PuregoSafeRegister(&xButtonGetLabel, libs, "gtk_button_get_label")
-	core.PuregoSafeRegister(&xButtonGetUseUnderline, libs, "gtk_button_get_use_underline")
-	core.PuregoSafeRegister(&xButtonSetCanShrink, libs, "gtk_button_set_can_shrink")
-	core.PuregoSafeRegister(&xButtonSetChild, libs, "gtk_button_set_child")
-	core.PuregoSafeRegister(&xButtonSetHasFrame, libs, "gtk_button_set_has_frame")
-	core.PuregoSafeRegister(&xButtonSetIconName, libs, "gtk_button_set_icon_name")
-	core.PuregoSafeRegister(&xButtonSetLabel, libs, "gtk_button_set_label")
-	core.PuregoSafeRegister(&xButtonSetUseUnderline, libs, "gtk_button_set_use_underline")
+	gobject.CheckClassSize("GtkButtonClass", xButtonGLibType, unsafe.Sizeof(ButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewButton, libs, "gtk_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewButtonFromIconName, libs, "gtk_button_new_from_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xNewButtonWithLabel, libs, "gtk_button_new_with_label", "GTK", "")
+	core.PuregoRegisterSince(&xNewButtonWithMnemonic, libs, "gtk_button_new_with_mnemonic", "GTK", "")
+
+	core.PuregoRegisterSince(&xButtonGetCanShrink, libs, "gtk_button_get_can_shrink", "GTK", "4.12")
+	core.PuregoRegisterSince(&xButtonGetChild, libs, "gtk_button_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xButtonGetHasFrame, libs, "gtk_button_get_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xButtonGetIconName, libs, "gtk_button_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xButtonGetLabel, libs, "gtk_button_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xButtonGetUseUnderline, libs, "gtk_button_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xButtonSetCanShrink, libs, "gtk_button_set_can_shrink", "GTK", "4.12")
+	core.PuregoRegisterSince(&xButtonSetChild, libs, "gtk_button_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xButtonSetHasFrame, libs, "gtk_button_set_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xButtonSetIconName, libs, "gtk_button_set_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xButtonSetLabel, libs, "gtk_button_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xButtonSetUseUnderline, libs, "gtk_button_set_use_underline", "GTK", "")
 
 }
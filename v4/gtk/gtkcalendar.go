@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,23 +12,16 @@ import (
 
 // Displays a Gregorian calendar, one month at a time.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="calendar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkCalendar" src="calendar.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// A `GtkCalendar` can be created with [ctor@Gtk.Calendar.new].
+// A `GtkCalendar` can be created with [NewCalendar].
 //
 // The selected date can be retrieved from a `GtkCalendar` using
-// [method@Gtk.Calendar.get_date].
-// It can be altered with [method@Gtk.Calendar.set_date].
+// [Calendar.GetDate].
+// It can be altered with [Calendar.SetDate].
 //
 // To place a visual marker on a particular day, use
-// [method@Gtk.Calendar.mark_day] and to remove the marker,
-// [method@Gtk.Calendar.unmark_day]. Alternative, all
-// marks can be cleared with [method@Gtk.Calendar.clear_marks].
+// [Calendar.MarkDay] and to remove the marker,
+// [Calendar.UnmarkDay]. Alternative, all
+// marks can be cleared with [Calendar.ClearMarks].
 //
 // Users should be aware that, although the Gregorian calendar is the
 // legal calendar in most countries, it was adopted progressively
@@ -45,20 +37,16 @@ import (
 //
 // # CSS nodes
 //
-// ```
-// calendar.view
-// ├── header
-// │   ├── button
-// │   ├── stack.month
-// │   ├── button
-// │   ├── button
-// │   ├── label.year
-// │   ╰── button
-// ╰── grid
-//
-//	╰── label[.day-name][.week-number][.day-number][.other-month][.today]
-//
-// ```
+//	calendar.view
+//	├── header
+//	│   ├── button
+//	│   ├── stack.month
+//	│   ├── button
+//	│   ├── button
+//	│   ├── label.year
+//	│   ╰── button
+//	╰── grid
+//	    ╰── label[.day-name][.week-number][.day-number][.other-month][.today]
 //
 // `GtkCalendar` has a main node with name calendar. It contains a subnode
 // called header containing the widgets for switching between years and months.
@@ -136,7 +124,7 @@ func (x *Calendar) GetDay() int {
 
 var xCalendarGetDayIsMarked func(uintptr, uint) bool
 
-// Returns if the @day of the @calendar is already marked.
+// Returns if the day of the calendar is already marked.
 func (x *Calendar) GetDayIsMarked(DayVar uint) bool {
 
 	cret := xCalendarGetDayIsMarked(x.GoPointer(), DayVar)
@@ -154,10 +142,10 @@ func (x *Calendar) GetMonth() int {
 
 var xCalendarGetShowDayNames func(uintptr) bool
 
-// Returns whether @self is currently showing the names
+// Returns whether self is currently showing the names
 // of the week days.
 //
-// This is the value of the [property@Gtk.Calendar:show-day-names]
+// This is the value of the [Calendar.GetPropertyShowDayNames]
 // property.
 func (x *Calendar) GetShowDayNames() bool {
 
@@ -167,9 +155,9 @@ func (x *Calendar) GetShowDayNames() bool {
 
 var xCalendarGetShowHeading func(uintptr) bool
 
-// Returns whether @self is currently showing the heading.
+// Returns whether self is currently showing the heading.
 //
-// This is the value of the [property@Gtk.Calendar:show-heading]
+// This is the value of the [Calendar.GetPropertyShowHeading]
 // property.
 func (x *Calendar) GetShowHeading() bool {
 
@@ -179,10 +167,10 @@ func (x *Calendar) GetShowHeading() bool {
 
 var xCalendarGetShowWeekNumbers func(uintptr) bool
 
-// Returns whether @self is showing week numbers right
+// Returns whether self is showing week numbers right
 // now.
 //
-// This is the value of the [property@Gtk.Calendar:show-week-numbers]
+// This is the value of the [Calendar.GetPropertyShowWeekNumbers]
 // property.
 func (x *Calendar) GetShowWeekNumbers() bool {
 
@@ -208,18 +196,9 @@ func (x *Calendar) MarkDay(DayVar uint) {
 
 }
 
-var xCalendarSelectDay func(uintptr, *glib.DateTime)
-
-// Switches to @date's year and month and select its day.
-func (x *Calendar) SelectDay(DateVar *glib.DateTime) {
-
-	xCalendarSelectDay(x.GoPointer(), DateVar)
-
-}
-
 var xCalendarSetDate func(uintptr, *glib.DateTime)
 
-// Switches to @date's year and month and selects its day.
+// Switches to date's year and month and selects its day.
 func (x *Calendar) SetDate(DateVar *glib.DateTime) {
 
 	xCalendarSetDate(x.GoPointer(), DateVar)
@@ -335,6 +314,10 @@ func (x *Calendar) GetPropertyDate() uintptr {
 
 // SetPropertyDay sets the "day" property.
 // The selected day (as a number between 1 and 31).
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) SetPropertyDay(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -344,6 +327,10 @@ func (x *Calendar) SetPropertyDay(value int) {
 
 // GetPropertyDay gets the "day" property.
 // The selected day (as a number between 1 and 31).
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) GetPropertyDay() int {
 	var v gobject.Value
 	x.GetProperty("day", &v)
@@ -354,6 +341,10 @@ func (x *Calendar) GetPropertyDay() int {
 // The selected month (as a number between 0 and 11).
 //
 // This property gets initially set to the current month.
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) SetPropertyMonth(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -365,6 +356,10 @@ func (x *Calendar) SetPropertyMonth(value int) {
 // The selected month (as a number between 0 and 11).
 //
 // This property gets initially set to the current month.
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) GetPropertyMonth() int {
 	var v gobject.Value
 	x.GetProperty("month", &v)
@@ -426,6 +421,10 @@ func (x *Calendar) GetPropertyShowWeekNumbers() bool {
 // The selected year.
 //
 // This property gets initially set to the current year.
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) SetPropertyYear(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -437,6 +436,10 @@ func (x *Calendar) SetPropertyYear(value int) {
 // The selected year.
 //
 // This property gets initially set to the current year.
+//
+// Deprecated: since 4.20. This property will be removed in GTK 5.
+//
+//	Use Calendar:date instead.
 func (x *Calendar) GetPropertyYear() int {
 	var v gobject.Value
 	x.GetProperty("year", &v)
@@ -444,123 +447,233 @@ func (x *Calendar) GetPropertyYear() int {
 }
 
 // Emitted when the user selects a day.
+//
+// Deprecated: use ConnectDaySelectedFunc, which also accepts method values and closures.
 func (x *Calendar) ConnectDaySelected(cb *func(Calendar)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "day-selected", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Calendar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "day-selected", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xCalendarDaySelectedTrampoline uintptr
+
+func xCalendarDaySelectedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Calendar))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Calendar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "day-selected", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDaySelectedFunc connects cb to the "day-selected" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Calendar) ConnectDaySelectedFunc(cb func(Calendar)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "day-selected", &xCalendarDaySelectedTrampoline, xCalendarDaySelectedNewTrampoline, cb)
 }
 
 // Emitted when the user switches to the next month.
+//
+// Deprecated: use ConnectNextMonthFunc, which also accepts method values and closures.
 func (x *Calendar) ConnectNextMonth(cb *func(Calendar)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "next-month", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Calendar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "next-month", cbRefPtr, cbPtr)
+}
+
+var xCalendarNextMonthTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCalendarNextMonthNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Calendar))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Calendar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "next-month", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNextMonthFunc connects cb to the "next-month" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Calendar) ConnectNextMonthFunc(cb func(Calendar)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "next-month", &xCalendarNextMonthTrampoline, xCalendarNextMonthNewTrampoline, cb)
 }
 
 // Emitted when user switches to the next year.
+//
+// Deprecated: use ConnectNextYearFunc, which also accepts method values and closures.
 func (x *Calendar) ConnectNextYear(cb *func(Calendar)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "next-year", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Calendar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "next-year", cbRefPtr, cbPtr)
+}
+
+var xCalendarNextYearTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCalendarNextYearNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Calendar))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Calendar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "next-year", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNextYearFunc connects cb to the "next-year" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Calendar) ConnectNextYearFunc(cb func(Calendar)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "next-year", &xCalendarNextYearTrampoline, xCalendarNextYearNewTrampoline, cb)
 }
 
 // Emitted when the user switches to the previous month.
+//
+// Deprecated: use ConnectPrevMonthFunc, which also accepts method values and closures.
 func (x *Calendar) ConnectPrevMonth(cb *func(Calendar)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "prev-month", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Calendar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "prev-month", cbRefPtr, cbPtr)
+}
+
+var xCalendarPrevMonthTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCalendarPrevMonthNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Calendar))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Calendar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "prev-month", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPrevMonthFunc connects cb to the "prev-month" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Calendar) ConnectPrevMonthFunc(cb func(Calendar)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "prev-month", &xCalendarPrevMonthTrampoline, xCalendarPrevMonthNewTrampoline, cb)
 }
 
 // Emitted when user switches to the previous year.
+//
+// Deprecated: use ConnectPrevYearFunc, which also accepts method values and closures.
 func (x *Calendar) ConnectPrevYear(cb *func(Calendar)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "prev-year", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Calendar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "prev-year", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xCalendarPrevYearTrampoline uintptr
+
+func xCalendarPrevYearNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Calendar))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Calendar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "prev-year", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPrevYearFunc connects cb to the "prev-year" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Calendar) ConnectPrevYearFunc(cb func(Calendar)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "prev-year", &xCalendarPrevYearTrampoline, xCalendarPrevYearNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -724,7 +837,7 @@ func (x *Calendar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -732,14 +845,13 @@ func (x *Calendar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Calendar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -763,19 +875,17 @@ func (x *Calendar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Calendar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -796,7 +906,7 @@ func (x *Calendar) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -805,14 +915,12 @@ func (x *Calendar) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Calendar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -831,10 +939,10 @@ func (x *Calendar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Calendar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -846,7 +954,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -855,26 +963,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xCalendarGLibType, libs, "gtk_calendar_get_type")
 
-	core.PuregoSafeRegister(&xNewCalendar, libs, "gtk_calendar_new")
-
-	core.PuregoSafeRegister(&xCalendarClearMarks, libs, "gtk_calendar_clear_marks")
-	core.PuregoSafeRegister(&xCalendarGetDate, libs, "gtk_calendar_get_date")
-	core.PuregoSafeRegister(&xCalendarGetDay, libs, "gtk_calendar_get_day")
-	core.PuregoSafeRegister(&xCalendarGetDayIsMarked, libs, "gtk_calendar_get_day_is_marked")
-	core.PuregoSafeRegister(&xCalendarGetMonth, libs, "gtk_calendar_get_month")
-	core.PuregoSafeRegister(&xCalendarGetShowDayNames, libs, "gtk_calendar_get_show_day_names")
-	core.PuregoSafeRegister(&xCalendarGetShowHeading, libs, "gtk_calendar_get_show_heading")
-	core.PuregoSafeRegister(&xCalendarGetShowWeekNumbers, libs, "gtk_calendar_get_show_week_numbers")
-	core.PuregoSafeRegister(&xCalendarGetYear, libs, "gtk_calendar_get_year")
-	core.PuregoSafeRegister(&xCalendarMarkDay, libs, "gtk_calendar_mark_day")
-	core.PuregoSafeRegister(&xCalendarSelectDay, libs, "gtk_calendar_select_day")
-	core.PuregoSafeRegister(&xCalendarSetDate, libs, "gtk_calendar_set_date")
-	core.PuregoSafeRegister(&xCalendarSetDay, libs, "gtk_calendar_set_day")
-	core.PuregoSafeRegister(&xCalendarSetMonth, libs, "gtk_calendar_set_month")
-	core.PuregoSafeRegister(&xCalendarSetShowDayNames, libs, "gtk_calendar_set_show_day_names")
-	core.PuregoSafeRegister(&xCalendarSetShowHeading, libs, "gtk_calendar_set_show_heading")
-	core.PuregoSafeRegister(&xCalendarSetShowWeekNumbers, libs, "gtk_calendar_set_show_week_numbers")
-	core.PuregoSafeRegister(&xCalendarSetYear, libs, "gtk_calendar_set_year")
-	core.PuregoSafeRegister(&xCalendarUnmarkDay, libs, "gtk_calendar_unmark_day")
+	core.PuregoRegisterSince(&xNewCalendar, libs, "gtk_calendar_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCalendarClearMarks, libs, "gtk_calendar_clear_marks", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetDate, libs, "gtk_calendar_get_date", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetDay, libs, "gtk_calendar_get_day", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarGetDayIsMarked, libs, "gtk_calendar_get_day_is_marked", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetMonth, libs, "gtk_calendar_get_month", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarGetShowDayNames, libs, "gtk_calendar_get_show_day_names", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetShowHeading, libs, "gtk_calendar_get_show_heading", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetShowWeekNumbers, libs, "gtk_calendar_get_show_week_numbers", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarGetYear, libs, "gtk_calendar_get_year", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarMarkDay, libs, "gtk_calendar_mark_day", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarSetDate, libs, "gtk_calendar_set_date", "GTK", "4.20")
+	core.PuregoRegisterSince(&xCalendarSetDay, libs, "gtk_calendar_set_day", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarSetMonth, libs, "gtk_calendar_set_month", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarSetShowDayNames, libs, "gtk_calendar_set_show_day_names", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarSetShowHeading, libs, "gtk_calendar_set_show_heading", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarSetShowWeekNumbers, libs, "gtk_calendar_set_show_week_numbers", "GTK", "")
+	core.PuregoRegisterSince(&xCalendarSetYear, libs, "gtk_calendar_set_year", "GTK", "4.14")
+	core.PuregoRegisterSince(&xCalendarUnmarkDay, libs, "gtk_calendar_unmark_day", "GTK", "")
 
 }
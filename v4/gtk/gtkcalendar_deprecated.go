@@ -0,0 +1,36 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var xCalendarSelectDay func(uintptr, *glib.DateTime)
+
+// Switches to date's year and month and select its day.
+//
+// Deprecated: since 4.20. Use set_date instead.
+func (x *Calendar) SelectDay(DateVar *glib.DateTime) {
+
+	xCalendarSelectDay(x.GoPointer(), DateVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCalendarSelectDay, libs, "gtk_calendar_select_day", "GTK", "")
+
+}
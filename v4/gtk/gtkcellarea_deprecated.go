@@ -0,0 +1,742 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xCellAreaClassFindCellProperty func(uintptr, string) uintptr
+
+// Finds a cell property of a cell area class by name.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaClass) FindCellProperty(PropertyNameVar string) *gobject.ParamSpec {
+	var cls *gobject.ParamSpec
+
+	cret := xCellAreaClassFindCellProperty(x.GoPointer(), PropertyNameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gobject.ParamSpec{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaClassInstallCellProperty func(uintptr, uint, uintptr)
+
+// Installs a cell property on a cell area class.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaClass) InstallCellProperty(PropertyIdVar uint, PspecVar *gobject.ParamSpec) {
+
+	xCellAreaClassInstallCellProperty(x.GoPointer(), PropertyIdVar, PspecVar.GoPointer())
+
+}
+
+var xCellAreaClassListCellProperties func(uintptr, *uint) uintptr
+
+// Returns all cell properties of a cell area class.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaClass) ListCellProperties(NPropertiesVar *uint) uintptr {
+
+	cret := xCellAreaClassListCellProperties(x.GoPointer(), NPropertiesVar)
+	return cret
+}
+
+var xCellAreaActivate func(uintptr, uintptr, uintptr, *gdk.Rectangle, CellRendererState, bool) bool
+
+// Activates area, usually by activating the currently focused
+// cell, however some subclasses which embed widgets in the area
+// can also activate a widget if it currently has the focus.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Activate(ContextVar *CellAreaContext, WidgetVar *Widget, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState, EditOnlyVar bool) bool {
+
+	cret := xCellAreaActivate(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), CellAreaVar, FlagsVar, EditOnlyVar)
+	return cret
+}
+
+var xCellAreaActivateCell func(uintptr, uintptr, uintptr, uintptr, *gdk.Rectangle, CellRendererState) bool
+
+// This is used by `GtkCellArea` subclasses when handling events
+// to activate cells, the base `GtkCellArea` class activates cells
+// for keyboard events for free in its own GtkCellArea->activate()
+// implementation.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) ActivateCell(WidgetVar *Widget, RendererVar *CellRenderer, EventVar *gdk.Event, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) bool {
+
+	cret := xCellAreaActivateCell(x.GoPointer(), WidgetVar.GoPointer(), RendererVar.GoPointer(), EventVar.GoPointer(), CellAreaVar, FlagsVar)
+	return cret
+}
+
+var xCellAreaAdd func(uintptr, uintptr)
+
+// Adds renderer to area with the default child cell properties.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Add(RendererVar *CellRenderer) {
+
+	xCellAreaAdd(x.GoPointer(), RendererVar.GoPointer())
+
+}
+
+var xCellAreaAddFocusSibling func(uintptr, uintptr, uintptr)
+
+// Adds sibling to renderer’s focusable area, focus will be drawn
+// around renderer and all of its siblings if renderer can
+// focus for a given row.
+//
+// Events handled by focus siblings can also activate the given
+// focusable renderer.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) AddFocusSibling(RendererVar *CellRenderer, SiblingVar *CellRenderer) {
+
+	xCellAreaAddFocusSibling(x.GoPointer(), RendererVar.GoPointer(), SiblingVar.GoPointer())
+
+}
+
+var xCellAreaAddWithProperties func(uintptr, uintptr, string, ...interface{})
+
+// Adds renderer to area, setting cell properties at the same time.
+// See [CellArea.Add] and [CellArea.CellSet] for more details.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) AddWithProperties(RendererVar *CellRenderer, FirstPropNameVar string, varArgs ...interface{}) {
+
+	xCellAreaAddWithProperties(x.GoPointer(), RendererVar.GoPointer(), FirstPropNameVar, varArgs...)
+
+}
+
+var xCellAreaApplyAttributes func(uintptr, uintptr, *TreeIter, bool, bool)
+
+// Applies any connected attributes to the renderers in
+// area by pulling the values from tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) ApplyAttributes(TreeModelVar TreeModel, IterVar *TreeIter, IsExpanderVar bool, IsExpandedVar bool) {
+
+	xCellAreaApplyAttributes(x.GoPointer(), TreeModelVar.GoPointer(), IterVar, IsExpanderVar, IsExpandedVar)
+
+}
+
+var xCellAreaAttributeConnect func(uintptr, uintptr, string, int)
+
+// Connects an attribute to apply values from column for the
+// `GtkTreeModel` in use.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) AttributeConnect(RendererVar *CellRenderer, AttributeVar string, ColumnVar int) {
+
+	xCellAreaAttributeConnect(x.GoPointer(), RendererVar.GoPointer(), AttributeVar, ColumnVar)
+
+}
+
+var xCellAreaAttributeDisconnect func(uintptr, uintptr, string)
+
+// Disconnects attribute for the renderer in area so that
+// attribute will no longer be updated with values from the
+// model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) AttributeDisconnect(RendererVar *CellRenderer, AttributeVar string) {
+
+	xCellAreaAttributeDisconnect(x.GoPointer(), RendererVar.GoPointer(), AttributeVar)
+
+}
+
+var xCellAreaAttributeGetColumn func(uintptr, uintptr, string) int
+
+// Returns the model column that an attribute has been mapped to,
+// or -1 if the attribute is not mapped.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) AttributeGetColumn(RendererVar *CellRenderer, AttributeVar string) int {
+
+	cret := xCellAreaAttributeGetColumn(x.GoPointer(), RendererVar.GoPointer(), AttributeVar)
+	return cret
+}
+
+var xCellAreaCellGet func(uintptr, uintptr, string, ...interface{})
+
+// Gets the values of one or more cell properties for renderer in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellGet(RendererVar *CellRenderer, FirstPropNameVar string, varArgs ...interface{}) {
+
+	xCellAreaCellGet(x.GoPointer(), RendererVar.GoPointer(), FirstPropNameVar, varArgs...)
+
+}
+
+var xCellAreaCellGetProperty func(uintptr, uintptr, string, *gobject.Value)
+
+// Gets the value of a cell property for renderer in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellGetProperty(RendererVar *CellRenderer, PropertyNameVar string, ValueVar *gobject.Value) {
+
+	xCellAreaCellGetProperty(x.GoPointer(), RendererVar.GoPointer(), PropertyNameVar, ValueVar)
+
+}
+
+var xCellAreaCellGetValist func(uintptr, uintptr, string, []interface{})
+
+// Gets the values of one or more cell properties for renderer in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellGetValist(RendererVar *CellRenderer, FirstPropertyNameVar string, VarArgsVar []interface{}) {
+
+	xCellAreaCellGetValist(x.GoPointer(), RendererVar.GoPointer(), FirstPropertyNameVar, VarArgsVar)
+
+}
+
+var xCellAreaCellSet func(uintptr, uintptr, string, ...interface{})
+
+// Sets one or more cell properties for cell in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellSet(RendererVar *CellRenderer, FirstPropNameVar string, varArgs ...interface{}) {
+
+	xCellAreaCellSet(x.GoPointer(), RendererVar.GoPointer(), FirstPropNameVar, varArgs...)
+
+}
+
+var xCellAreaCellSetProperty func(uintptr, uintptr, string, *gobject.Value)
+
+// Sets a cell property for renderer in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellSetProperty(RendererVar *CellRenderer, PropertyNameVar string, ValueVar *gobject.Value) {
+
+	xCellAreaCellSetProperty(x.GoPointer(), RendererVar.GoPointer(), PropertyNameVar, ValueVar)
+
+}
+
+var xCellAreaCellSetValist func(uintptr, uintptr, string, []interface{})
+
+// Sets one or more cell properties for renderer in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CellSetValist(RendererVar *CellRenderer, FirstPropertyNameVar string, VarArgsVar []interface{}) {
+
+	xCellAreaCellSetValist(x.GoPointer(), RendererVar.GoPointer(), FirstPropertyNameVar, VarArgsVar)
+
+}
+
+var xCellAreaCopyContext func(uintptr, uintptr) uintptr
+
+// This is sometimes needed for cases where rows need to share
+// alignments in one orientation but may be separately grouped
+// in the opposing orientation.
+//
+// For instance, `GtkIconView` creates all icons (rows) to have
+// the same width and the cells theirin to have the same
+// horizontal alignments. However each row of icons may have
+// a separate collective height. `GtkIconView` uses this to
+// request the heights of each row based on a context which
+// was already used to request all the row widths that are
+// to be displayed.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CopyContext(ContextVar *CellAreaContext) *CellAreaContext {
+	var cls *CellAreaContext
+
+	cret := xCellAreaCopyContext(x.GoPointer(), ContextVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &CellAreaContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaCreateContext func(uintptr) uintptr
+
+// Creates a `GtkCellArea`Context to be used with area for
+// all purposes. `GtkCellArea`Context stores geometry information
+// for rows for which it was operated on, it is important to use
+// the same context for the same row of data at all times (i.e.
+// one should render and handle events with the same `GtkCellArea`Context
+// which was used to request the size of those rows of data).
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) CreateContext() *CellAreaContext {
+	var cls *CellAreaContext
+
+	cret := xCellAreaCreateContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &CellAreaContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaEvent func(uintptr, uintptr, uintptr, uintptr, *gdk.Rectangle, CellRendererState) int
+
+// Delegates event handling to a `GtkCellArea`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Event(ContextVar *CellAreaContext, WidgetVar *Widget, EventVar *gdk.Event, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) int {
+
+	cret := xCellAreaEvent(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), EventVar.GoPointer(), CellAreaVar, FlagsVar)
+	return cret
+}
+
+var xCellAreaFocus func(uintptr, DirectionType) bool
+
+// This should be called by the area’s owning layout widget
+// when focus is to be passed to area, or moved within area
+// for a given direction and row data.
+//
+// Implementing `GtkCellArea` classes should implement this
+// method to receive and navigate focus in its own way particular
+// to how it lays out cells.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Focus(DirectionVar DirectionType) bool {
+
+	cret := xCellAreaFocus(x.GoPointer(), DirectionVar)
+	return cret
+}
+
+var xCellAreaForeach func(uintptr, uintptr, uintptr)
+
+// Calls callback for every `GtkCellRenderer` in area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Foreach(CallbackVar *CellCallback, CallbackDataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				return cbFn(arg0, arg1)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xCellAreaForeach(x.GoPointer(), CallbackVarRef, CallbackDataVar)
+
+}
+
+var xCellAreaGetCellAllocation func(uintptr, uintptr, uintptr, uintptr, *gdk.Rectangle, *gdk.Rectangle)
+
+// Derives the allocation of renderer inside area if area
+// were to be rendered in cell_area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetCellAllocation(ContextVar *CellAreaContext, WidgetVar *Widget, RendererVar *CellRenderer, CellAreaVar *gdk.Rectangle, AllocationVar *gdk.Rectangle) {
+
+	xCellAreaGetCellAllocation(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), RendererVar.GoPointer(), CellAreaVar, AllocationVar)
+
+}
+
+var xCellAreaGetCellAtPosition func(uintptr, uintptr, uintptr, *gdk.Rectangle, int, int, *gdk.Rectangle) uintptr
+
+// Gets the `GtkCellRenderer` at x and y coordinates inside area and optionally
+// returns the full cell allocation for it inside cell_area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetCellAtPosition(ContextVar *CellAreaContext, WidgetVar *Widget, CellAreaVar *gdk.Rectangle, XVar int, YVar int, AllocAreaVar *gdk.Rectangle) *CellRenderer {
+	var cls *CellRenderer
+
+	cret := xCellAreaGetCellAtPosition(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), CellAreaVar, XVar, YVar, AllocAreaVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaGetEditWidget func(uintptr) uintptr
+
+// Gets the `GtkCellEditable` widget currently used
+// to edit the currently edited cell.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetEditWidget() *CellEditableBase {
+	var cls *CellEditableBase
+
+	cret := xCellAreaGetEditWidget(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellEditableBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaGetEditedCell func(uintptr) uintptr
+
+// Gets the `GtkCellRenderer` in area that is currently
+// being edited.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetEditedCell() *CellRenderer {
+	var cls *CellRenderer
+
+	cret := xCellAreaGetEditedCell(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaGetFocusCell func(uintptr) uintptr
+
+// Retrieves the currently focused cell for area
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetFocusCell() *CellRenderer {
+	var cls *CellRenderer
+
+	cret := xCellAreaGetFocusCell(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaGetFocusFromSibling func(uintptr, uintptr) uintptr
+
+// Gets the `GtkCellRenderer` which is expected to be focusable
+// for which renderer is, or may be a sibling.
+//
+// This is handy for `GtkCellArea` subclasses when handling events,
+// after determining the renderer at the event location it can
+// then chose to activate the focus cell for which the event
+// cell may have been a sibling.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetFocusFromSibling(RendererVar *CellRenderer) *CellRenderer {
+	var cls *CellRenderer
+
+	cret := xCellAreaGetFocusFromSibling(x.GoPointer(), RendererVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRenderer{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaGetFocusSiblings func(uintptr, uintptr) *glib.List
+
+// Gets the focus sibling cell renderers for renderer.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetFocusSiblings(RendererVar *CellRenderer) *glib.List {
+
+	cret := xCellAreaGetFocusSiblings(x.GoPointer(), RendererVar.GoPointer())
+	return cret
+}
+
+var xCellAreaGetPreferredHeight func(uintptr, uintptr, uintptr, *int, *int)
+
+// Retrieves a cell area’s initial minimum and natural height.
+//
+// area will store some geometrical information in context along the way;
+// when requesting sizes over an arbitrary number of rows, it’s not important
+// to check the minimum_height and natural_height of this call but rather to
+// consult [CellAreaContext.GetPreferredHeight] after a series of
+// requests.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetPreferredHeight(ContextVar *CellAreaContext, WidgetVar *Widget, MinimumHeightVar *int, NaturalHeightVar *int) {
+
+	xCellAreaGetPreferredHeight(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), MinimumHeightVar, NaturalHeightVar)
+
+}
+
+var xCellAreaGetPreferredHeightForWidth func(uintptr, uintptr, uintptr, int, *int, *int)
+
+// Retrieves a cell area’s minimum and natural height if it would be given
+// the specified width.
+//
+// area stores some geometrical information in context along the way
+// while calling [CellArea.GetPreferredWidth]. It’s important to
+// perform a series of [CellArea.GetPreferredWidth] requests with
+// context first and then call [CellArea.GetPreferredHeightForWidth]
+// on each cell area individually to get the height for width of each
+// fully requested row.
+//
+// If at some point, the width of a single row changes, it should be
+// requested with [CellArea.GetPreferredWidth] again and then
+// the full width of the requested rows checked again with
+// [CellAreaContext.GetPreferredWidth].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetPreferredHeightForWidth(ContextVar *CellAreaContext, WidgetVar *Widget, WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
+
+	xCellAreaGetPreferredHeightForWidth(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
+
+}
+
+var xCellAreaGetPreferredWidth func(uintptr, uintptr, uintptr, *int, *int)
+
+// Retrieves a cell area’s initial minimum and natural width.
+//
+// area will store some geometrical information in context along the way;
+// when requesting sizes over an arbitrary number of rows, it’s not important
+// to check the minimum_width and natural_width of this call but rather to
+// consult [CellAreaContext.GetPreferredWidth] after a series of
+// requests.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetPreferredWidth(ContextVar *CellAreaContext, WidgetVar *Widget, MinimumWidthVar *int, NaturalWidthVar *int) {
+
+	xCellAreaGetPreferredWidth(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), MinimumWidthVar, NaturalWidthVar)
+
+}
+
+var xCellAreaGetPreferredWidthForHeight func(uintptr, uintptr, uintptr, int, *int, *int)
+
+// Retrieves a cell area’s minimum and natural width if it would be given
+// the specified height.
+//
+// area stores some geometrical information in context along the way
+// while calling [CellArea.GetPreferredHeight]. It’s important to
+// perform a series of [CellArea.GetPreferredHeight] requests with
+// context first and then call [CellArea.GetPreferredWidthForHeight]
+// on each cell area individually to get the height for width of each
+// fully requested row.
+//
+// If at some point, the height of a single row changes, it should be
+// requested with [CellArea.GetPreferredHeight] again and then
+// the full height of the requested rows checked again with
+// [CellAreaContext.GetPreferredHeight].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) GetPreferredWidthForHeight(ContextVar *CellAreaContext, WidgetVar *Widget, HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
+
+	xCellAreaGetPreferredWidthForHeight(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
+
+}
+
+var xCellAreaHasRenderer func(uintptr, uintptr) bool
+
+// Checks if area contains renderer.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) HasRenderer(RendererVar *CellRenderer) bool {
+
+	cret := xCellAreaHasRenderer(x.GoPointer(), RendererVar.GoPointer())
+	return cret
+}
+
+var xCellAreaInnerCellArea func(uintptr, uintptr, *gdk.Rectangle, *gdk.Rectangle)
+
+// This is a convenience function for `GtkCellArea` implementations
+// to get the inner area where a given `GtkCellRenderer` will be
+// rendered. It removes any padding previously added by [CellArea.RequestRenderer].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) InnerCellArea(WidgetVar *Widget, CellAreaVar *gdk.Rectangle, InnerAreaVar *gdk.Rectangle) {
+
+	xCellAreaInnerCellArea(x.GoPointer(), WidgetVar.GoPointer(), CellAreaVar, InnerAreaVar)
+
+}
+
+var xCellAreaIsActivatable func(uintptr) bool
+
+// Returns whether the area can do anything when activated,
+// after applying new attributes to area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) IsActivatable() bool {
+
+	cret := xCellAreaIsActivatable(x.GoPointer())
+	return cret
+}
+
+var xCellAreaIsFocusSibling func(uintptr, uintptr, uintptr) bool
+
+// Returns whether sibling is one of renderer’s focus siblings
+// (see [CellArea.AddFocusSibling]).
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) IsFocusSibling(RendererVar *CellRenderer, SiblingVar *CellRenderer) bool {
+
+	cret := xCellAreaIsFocusSibling(x.GoPointer(), RendererVar.GoPointer(), SiblingVar.GoPointer())
+	return cret
+}
+
+var xCellAreaRemove func(uintptr, uintptr)
+
+// Removes renderer from area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Remove(RendererVar *CellRenderer) {
+
+	xCellAreaRemove(x.GoPointer(), RendererVar.GoPointer())
+
+}
+
+var xCellAreaRemoveFocusSibling func(uintptr, uintptr, uintptr)
+
+// Removes sibling from renderer’s focus sibling list
+// (see [CellArea.AddFocusSibling]).
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) RemoveFocusSibling(RendererVar *CellRenderer, SiblingVar *CellRenderer) {
+
+	xCellAreaRemoveFocusSibling(x.GoPointer(), RendererVar.GoPointer(), SiblingVar.GoPointer())
+
+}
+
+var xCellAreaRequestRenderer func(uintptr, uintptr, Orientation, uintptr, int, *int, *int)
+
+// This is a convenience function for `GtkCellArea` implementations
+// to request size for cell renderers. It’s important to use this
+// function to request size and then use [CellArea.InnerCellArea]
+// at render and event time since this function will add padding
+// around the cell for focus painting.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) RequestRenderer(RendererVar *CellRenderer, OrientationVar Orientation, WidgetVar *Widget, ForSizeVar int, MinimumSizeVar *int, NaturalSizeVar *int) {
+
+	xCellAreaRequestRenderer(x.GoPointer(), RendererVar.GoPointer(), OrientationVar, WidgetVar.GoPointer(), ForSizeVar, MinimumSizeVar, NaturalSizeVar)
+
+}
+
+var xCellAreaSetFocusCell func(uintptr, uintptr)
+
+// Explicitly sets the currently focused cell to renderer.
+//
+// This is generally called by implementations of
+// `GtkCellAreaClass.focus()` or `GtkCellAreaClass.event()`,
+// however it can also be used to implement functions such
+// as [TreeView.SetCursorOnCell].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) SetFocusCell(RendererVar *CellRenderer) {
+
+	xCellAreaSetFocusCell(x.GoPointer(), RendererVar.GoPointer())
+
+}
+
+var xCellAreaSnapshot func(uintptr, uintptr, uintptr, uintptr, *gdk.Rectangle, *gdk.Rectangle, CellRendererState, bool)
+
+// Snapshots area’s cells according to area’s layout onto at
+// the given coordinates.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) Snapshot(ContextVar *CellAreaContext, WidgetVar *Widget, SnapshotVar *Snapshot, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState, PaintFocusVar bool) {
+
+	xCellAreaSnapshot(x.GoPointer(), ContextVar.GoPointer(), WidgetVar.GoPointer(), SnapshotVar.GoPointer(), BackgroundAreaVar, CellAreaVar, FlagsVar, PaintFocusVar)
+
+}
+
+var xCellAreaStopEditing func(uintptr, bool)
+
+// Explicitly stops the editing of the currently edited cell.
+//
+// If canceled is true, the currently edited cell renderer
+// will emit the ::editing-canceled signal, otherwise the
+// the ::editing-done signal will be emitted on the current
+// edit widget.
+//
+// See [CellArea.GetEditedCell] and [CellArea.GetEditWidget].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellArea) StopEditing(CanceledVar bool) {
+
+	xCellAreaStopEditing(x.GoPointer(), CanceledVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCellAreaClassFindCellProperty, libs, "gtk_cell_area_class_find_cell_property", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaClassInstallCellProperty, libs, "gtk_cell_area_class_install_cell_property", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaClassListCellProperties, libs, "gtk_cell_area_class_list_cell_properties", "GTK", "")
+
+	core.PuregoRegisterSince(&xCellAreaActivate, libs, "gtk_cell_area_activate", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaActivateCell, libs, "gtk_cell_area_activate_cell", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAdd, libs, "gtk_cell_area_add", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAddFocusSibling, libs, "gtk_cell_area_add_focus_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAddWithProperties, libs, "gtk_cell_area_add_with_properties", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaApplyAttributes, libs, "gtk_cell_area_apply_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAttributeConnect, libs, "gtk_cell_area_attribute_connect", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAttributeDisconnect, libs, "gtk_cell_area_attribute_disconnect", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaAttributeGetColumn, libs, "gtk_cell_area_attribute_get_column", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellGet, libs, "gtk_cell_area_cell_get", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellGetProperty, libs, "gtk_cell_area_cell_get_property", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellGetValist, libs, "gtk_cell_area_cell_get_valist", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellSet, libs, "gtk_cell_area_cell_set", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellSetProperty, libs, "gtk_cell_area_cell_set_property", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCellSetValist, libs, "gtk_cell_area_cell_set_valist", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCopyContext, libs, "gtk_cell_area_copy_context", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaCreateContext, libs, "gtk_cell_area_create_context", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaEvent, libs, "gtk_cell_area_event", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaFocus, libs, "gtk_cell_area_focus", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaForeach, libs, "gtk_cell_area_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetCellAllocation, libs, "gtk_cell_area_get_cell_allocation", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetCellAtPosition, libs, "gtk_cell_area_get_cell_at_position", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetEditWidget, libs, "gtk_cell_area_get_edit_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetEditedCell, libs, "gtk_cell_area_get_edited_cell", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetFocusCell, libs, "gtk_cell_area_get_focus_cell", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetFocusFromSibling, libs, "gtk_cell_area_get_focus_from_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetFocusSiblings, libs, "gtk_cell_area_get_focus_siblings", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetPreferredHeight, libs, "gtk_cell_area_get_preferred_height", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetPreferredHeightForWidth, libs, "gtk_cell_area_get_preferred_height_for_width", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetPreferredWidth, libs, "gtk_cell_area_get_preferred_width", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaGetPreferredWidthForHeight, libs, "gtk_cell_area_get_preferred_width_for_height", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaHasRenderer, libs, "gtk_cell_area_has_renderer", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaInnerCellArea, libs, "gtk_cell_area_inner_cell_area", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaIsActivatable, libs, "gtk_cell_area_is_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaIsFocusSibling, libs, "gtk_cell_area_is_focus_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaRemove, libs, "gtk_cell_area_remove", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaRemoveFocusSibling, libs, "gtk_cell_area_remove_focus_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaRequestRenderer, libs, "gtk_cell_area_request_renderer", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaSetFocusCell, libs, "gtk_cell_area_set_focus_cell", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaSnapshot, libs, "gtk_cell_area_snapshot", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaStopEditing, libs, "gtk_cell_area_stop_editing", "GTK", "")
+
+}
@@ -0,0 +1,99 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellAreaBox func() uintptr
+
+// Creates a new `GtkCellAreaBox`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellAreaBox() *CellAreaBox {
+	var cls *CellAreaBox
+
+	cret := xNewCellAreaBox()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellAreaBox{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellAreaBoxGetSpacing func(uintptr) int
+
+// Gets the spacing added between cell renderers.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaBox) GetSpacing() int {
+
+	cret := xCellAreaBoxGetSpacing(x.GoPointer())
+	return cret
+}
+
+var xCellAreaBoxPackEnd func(uintptr, uintptr, bool, bool, bool)
+
+// Adds renderer to box, packed with reference to the end of box.
+//
+// The renderer is packed after (away from end of) any other
+// `GtkCellRenderer` packed with reference to the end of box.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaBox) PackEnd(RendererVar *CellRenderer, ExpandVar bool, AlignVar bool, FixedVar bool) {
+
+	xCellAreaBoxPackEnd(x.GoPointer(), RendererVar.GoPointer(), ExpandVar, AlignVar, FixedVar)
+
+}
+
+var xCellAreaBoxPackStart func(uintptr, uintptr, bool, bool, bool)
+
+// Adds renderer to box, packed with reference to the start of box.
+//
+// The renderer is packed after any other `GtkCellRenderer` packed
+// with reference to the start of box.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaBox) PackStart(RendererVar *CellRenderer, ExpandVar bool, AlignVar bool, FixedVar bool) {
+
+	xCellAreaBoxPackStart(x.GoPointer(), RendererVar.GoPointer(), ExpandVar, AlignVar, FixedVar)
+
+}
+
+var xCellAreaBoxSetSpacing func(uintptr, int)
+
+// Sets the spacing to add between cell renderers in box.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellAreaBox) SetSpacing(SpacingVar int) {
+
+	xCellAreaBoxSetSpacing(x.GoPointer(), SpacingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellAreaBox, libs, "gtk_cell_area_box_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCellAreaBoxGetSpacing, libs, "gtk_cell_area_box_get_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaBoxPackEnd, libs, "gtk_cell_area_box_pack_end", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaBoxPackStart, libs, "gtk_cell_area_box_pack_start", "GTK", "")
+	core.PuregoRegisterSince(&xCellAreaBoxSetSpacing, libs, "gtk_cell_area_box_set_spacing", "GTK", "")
+
+}
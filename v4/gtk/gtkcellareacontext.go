@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -41,7 +40,8 @@ func (x *CellAreaContextClass) OverrideAllocate(cb func(*CellAreaContext, int, i
 	if cb == nil {
 		x.xAllocate = 0
 	} else {
-		x.xAllocate = purego.NewCallback(func(ContextVarp uintptr, WidthVarp int, HeightVarp int) {
+		x.xAllocate = core.NewCallback(func(ContextVarp uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(CellAreaContextNewFromInternalPtr(ContextVarp), WidthVarp, HeightVarp)
 		})
 	}
@@ -58,7 +58,7 @@ func (x *CellAreaContextClass) GetAllocate() func(*CellAreaContext, int, int) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xAllocate)
+	core.RegisterFunc(&rawCallback, x.xAllocate)
 	return func(ContextVar *CellAreaContext, WidthVar int, HeightVar int) {
 		rawCallback(ContextVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -72,7 +72,8 @@ func (x *CellAreaContextClass) OverrideReset(cb func(*CellAreaContext)) {
 	if cb == nil {
 		x.xReset = 0
 	} else {
-		x.xReset = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xReset = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(CellAreaContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -87,7 +88,7 @@ func (x *CellAreaContextClass) GetReset() func(*CellAreaContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReset)
+	core.RegisterFunc(&rawCallback, x.xReset)
 	return func(ContextVar *CellAreaContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
@@ -101,7 +102,8 @@ func (x *CellAreaContextClass) OverrideGetPreferredHeightForWidth(cb func(*CellA
 	if cb == nil {
 		x.xGetPreferredHeightForWidth = 0
 	} else {
-		x.xGetPreferredHeightForWidth = purego.NewCallback(func(ContextVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int) {
+		x.xGetPreferredHeightForWidth = core.NewCallback(func(ContextVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellAreaContextNewFromInternalPtr(ContextVarp), WidthVarp, MinimumHeightVarp, NaturalHeightVarp)
 		})
 	}
@@ -116,7 +118,7 @@ func (x *CellAreaContextClass) GetGetPreferredHeightForWidth() func(*CellAreaCon
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredHeightForWidth)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredHeightForWidth)
 	return func(ContextVar *CellAreaContext, WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
 		rawCallback(ContextVar.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
 	}
@@ -130,7 +132,8 @@ func (x *CellAreaContextClass) OverrideGetPreferredWidthForHeight(cb func(*CellA
 	if cb == nil {
 		x.xGetPreferredWidthForHeight = 0
 	} else {
-		x.xGetPreferredWidthForHeight = purego.NewCallback(func(ContextVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int) {
+		x.xGetPreferredWidthForHeight = core.NewCallback(func(ContextVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellAreaContextNewFromInternalPtr(ContextVarp), HeightVarp, MinimumWidthVarp, NaturalWidthVarp)
 		})
 	}
@@ -145,7 +148,7 @@ func (x *CellAreaContextClass) GetGetPreferredWidthForHeight() func(*CellAreaCon
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredWidthForHeight)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredWidthForHeight)
 	return func(ContextVar *CellAreaContext, HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
 		rawCallback(ContextVar.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
 	}
@@ -170,7 +173,9 @@ func (x *CellAreaContextPrivate) GoPointer() uintptr {
 // request and render groups of data rows. However, it’s important that the
 // same context which was used to request sizes for a given `GtkTreeModel`
 // row also be used for the same row when calling other `GtkCellArea` APIs
-// such as gtk_cell_area_render() and gtk_cell_area_event().
+// such as gtk_cell_area_render() and [CellArea.Event].
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 type CellAreaContext struct {
 	gobject.Object
 }
@@ -187,175 +192,6 @@ func CellAreaContextNewFromInternalPtr(ptr uintptr) *CellAreaContext {
 	return cls
 }
 
-var xCellAreaContextAllocate func(uintptr, int, int)
-
-// Allocates a width and/or a height for all rows which are to be
-// rendered with @context.
-//
-// Usually allocation is performed only horizontally or sometimes
-// vertically since a group of rows are usually rendered side by
-// side vertically or horizontally and share either the same width
-// or the same height. Sometimes they are allocated in both horizontal
-// and vertical orientations producing a homogeneous effect of the
-// rows. This is generally the case for `GtkTreeView` when
-// `GtkTreeView:fixed-height-mode` is enabled.
-func (x *CellAreaContext) Allocate(WidthVar int, HeightVar int) {
-
-	xCellAreaContextAllocate(x.GoPointer(), WidthVar, HeightVar)
-
-}
-
-var xCellAreaContextGetAllocation func(uintptr, *int, *int)
-
-// Fetches the current allocation size for @context.
-//
-// If the context was not allocated in width or height, or if the
-// context was recently reset with gtk_cell_area_context_reset(),
-// the returned value will be -1.
-func (x *CellAreaContext) GetAllocation(WidthVar *int, HeightVar *int) {
-
-	xCellAreaContextGetAllocation(x.GoPointer(), WidthVar, HeightVar)
-
-}
-
-var xCellAreaContextGetArea func(uintptr) uintptr
-
-// Fetches the `GtkCellArea` this @context was created by.
-//
-// This is generally unneeded by layouting widgets; however,
-// it is important for the context implementation itself to
-// fetch information about the area it is being used for.
-//
-// For instance at `GtkCellAreaContextClass.allocate()` time
-// it’s important to know details about any cell spacing
-// that the `GtkCellArea` is configured with in order to
-// compute a proper allocation.
-func (x *CellAreaContext) GetArea() *CellArea {
-	var cls *CellArea
-
-	cret := xCellAreaContextGetArea(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellArea{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xCellAreaContextGetPreferredHeight func(uintptr, *int, *int)
-
-// Gets the accumulative preferred height for all rows which have been
-// requested with this context.
-//
-// After gtk_cell_area_context_reset() is called and/or before ever
-// requesting the size of a `GtkCellArea`, the returned values are 0.
-func (x *CellAreaContext) GetPreferredHeight(MinimumHeightVar *int, NaturalHeightVar *int) {
-
-	xCellAreaContextGetPreferredHeight(x.GoPointer(), MinimumHeightVar, NaturalHeightVar)
-
-}
-
-var xCellAreaContextGetPreferredHeightForWidth func(uintptr, int, *int, *int)
-
-// Gets the accumulative preferred height for @width for all rows
-// which have been requested for the same said @width with this context.
-//
-// After gtk_cell_area_context_reset() is called and/or before ever
-// requesting the size of a `GtkCellArea`, the returned values are -1.
-func (x *CellAreaContext) GetPreferredHeightForWidth(WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
-
-	xCellAreaContextGetPreferredHeightForWidth(x.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
-
-}
-
-var xCellAreaContextGetPreferredWidth func(uintptr, *int, *int)
-
-// Gets the accumulative preferred width for all rows which have been
-// requested with this context.
-//
-// After gtk_cell_area_context_reset() is called and/or before ever
-// requesting the size of a `GtkCellArea`, the returned values are 0.
-func (x *CellAreaContext) GetPreferredWidth(MinimumWidthVar *int, NaturalWidthVar *int) {
-
-	xCellAreaContextGetPreferredWidth(x.GoPointer(), MinimumWidthVar, NaturalWidthVar)
-
-}
-
-var xCellAreaContextGetPreferredWidthForHeight func(uintptr, int, *int, *int)
-
-// Gets the accumulative preferred width for @height for all rows which
-// have been requested for the same said @height with this context.
-//
-// After gtk_cell_area_context_reset() is called and/or before ever
-// requesting the size of a `GtkCellArea`, the returned values are -1.
-func (x *CellAreaContext) GetPreferredWidthForHeight(HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
-
-	xCellAreaContextGetPreferredWidthForHeight(x.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
-
-}
-
-var xCellAreaContextPushPreferredHeight func(uintptr, int, int)
-
-// Causes the minimum and/or natural height to grow if the new
-// proposed sizes exceed the current minimum and natural height.
-//
-// This is used by `GtkCellAreaContext` implementations during
-// the request process over a series of `GtkTreeModel` rows to
-// progressively push the requested height over a series of
-// gtk_cell_area_get_preferred_height() requests.
-func (x *CellAreaContext) PushPreferredHeight(MinimumHeightVar int, NaturalHeightVar int) {
-
-	xCellAreaContextPushPreferredHeight(x.GoPointer(), MinimumHeightVar, NaturalHeightVar)
-
-}
-
-var xCellAreaContextPushPreferredWidth func(uintptr, int, int)
-
-// Causes the minimum and/or natural width to grow if the new
-// proposed sizes exceed the current minimum and natural width.
-//
-// This is used by `GtkCellAreaContext` implementations during
-// the request process over a series of `GtkTreeModel` rows to
-// progressively push the requested width over a series of
-// gtk_cell_area_get_preferred_width() requests.
-func (x *CellAreaContext) PushPreferredWidth(MinimumWidthVar int, NaturalWidthVar int) {
-
-	xCellAreaContextPushPreferredWidth(x.GoPointer(), MinimumWidthVar, NaturalWidthVar)
-
-}
-
-var xCellAreaContextReset func(uintptr)
-
-// Resets any previously cached request and allocation
-// data.
-//
-// When underlying `GtkTreeModel` data changes its
-// important to reset the context if the content
-// size is allowed to shrink. If the content size
-// is only allowed to grow (this is usually an option
-// for views rendering large data stores as a measure
-// of optimization), then only the row that changed
-// or was inserted needs to be (re)requested with
-// gtk_cell_area_get_preferred_width().
-//
-// When the new overall size of the context requires
-// that the allocated size changes (or whenever this
-// allocation changes at all), the variable row
-// sizes need to be re-requested for every row.
-//
-// For instance, if the rows are displayed all with
-// the same width from top to bottom then a change
-// in the allocated width necessitates a recalculation
-// of all the displayed row heights using
-// gtk_cell_area_get_preferred_height_for_width().
-func (x *CellAreaContext) Reset() {
-
-	xCellAreaContextReset(x.GoPointer())
-
-}
-
 func (c *CellAreaContext) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -370,7 +206,9 @@ func (c *CellAreaContext) SetGoPointer(ptr uintptr) {
 // GetPropertyMinimumHeight gets the "minimum-height" property.
 // The minimum height for the `GtkCellArea` in this context
 // for all `GtkTreeModel` rows that this context was requested
-// for using gtk_cell_area_get_preferred_height().
+// for using [CellArea.GetPreferredHeight].
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *CellAreaContext) GetPropertyMinimumHeight() int {
 	var v gobject.Value
 	x.GetProperty("minimum-height", &v)
@@ -380,7 +218,9 @@ func (x *CellAreaContext) GetPropertyMinimumHeight() int {
 // GetPropertyMinimumWidth gets the "minimum-width" property.
 // The minimum width for the `GtkCellArea` in this context
 // for all `GtkTreeModel` rows that this context was requested
-// for using gtk_cell_area_get_preferred_width().
+// for using [CellArea.GetPreferredWidth].
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *CellAreaContext) GetPropertyMinimumWidth() int {
 	var v gobject.Value
 	x.GetProperty("minimum-width", &v)
@@ -390,7 +230,9 @@ func (x *CellAreaContext) GetPropertyMinimumWidth() int {
 // GetPropertyNaturalHeight gets the "natural-height" property.
 // The natural height for the `GtkCellArea` in this context
 // for all `GtkTreeModel` rows that this context was requested
-// for using gtk_cell_area_get_preferred_height().
+// for using [CellArea.GetPreferredHeight].
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *CellAreaContext) GetPropertyNaturalHeight() int {
 	var v gobject.Value
 	x.GetProperty("natural-height", &v)
@@ -400,36 +242,11 @@ func (x *CellAreaContext) GetPropertyNaturalHeight() int {
 // GetPropertyNaturalWidth gets the "natural-width" property.
 // The natural width for the `GtkCellArea` in this context
 // for all `GtkTreeModel` rows that this context was requested
-// for using gtk_cell_area_get_preferred_width().
+// for using [CellArea.GetPreferredWidth].
+//
+// Deprecated: since 4.10. This object will be removed in GTK 5
 func (x *CellAreaContext) GetPropertyNaturalWidth() int {
 	var v gobject.Value
 	x.GetProperty("natural-width", &v)
 	return v.GetInt()
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellAreaContextGLibType, libs, "gtk_cell_area_context_get_type")
-
-	core.PuregoSafeRegister(&xCellAreaContextAllocate, libs, "gtk_cell_area_context_allocate")
-	core.PuregoSafeRegister(&xCellAreaContextGetAllocation, libs, "gtk_cell_area_context_get_allocation")
-	core.PuregoSafeRegister(&xCellAreaContextGetArea, libs, "gtk_cell_area_context_get_area")
-	core.PuregoSafeRegister(&xCellAreaContextGetPreferredHeight, libs, "gtk_cell_area_context_get_preferred_height")
-	core.PuregoSafeRegister(&xCellAreaContextGetPreferredHeightForWidth, libs, "gtk_cell_area_context_get_preferred_height_for_width")
-	core.PuregoSafeRegister(&xCellAreaContextGetPreferredWidth, libs, "gtk_cell_area_context_get_preferred_width")
-	core.PuregoSafeRegister(&xCellAreaContextGetPreferredWidthForHeight, libs, "gtk_cell_area_context_get_preferred_width_for_height")
-	core.PuregoSafeRegister(&xCellAreaContextPushPreferredHeight, libs, "gtk_cell_area_context_push_preferred_height")
-	core.PuregoSafeRegister(&xCellAreaContextPushPreferredWidth, libs, "gtk_cell_area_context_push_preferred_width")
-	core.PuregoSafeRegister(&xCellAreaContextReset, libs, "gtk_cell_area_context_reset")
-
-}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 type CellEditableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xEditingDone uintptr
 
@@ -36,7 +35,8 @@ func (x *CellEditableIface) OverrideEditingDone(cb func(CellEditable)) {
 	if cb == nil {
 		x.xEditingDone = 0
 	} else {
-		x.xEditingDone = purego.NewCallback(func(CellEditableVarp uintptr) {
+		x.xEditingDone = core.NewCallback(func(CellEditableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellEditableBase{Ptr: CellEditableVarp})
 		})
 	}
@@ -51,7 +51,7 @@ func (x *CellEditableIface) GetEditingDone() func(CellEditable) {
 		return nil
 	}
 	var rawCallback func(CellEditableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEditingDone)
+	core.RegisterFunc(&rawCallback, x.xEditingDone)
 	return func(CellEditableVar CellEditable) {
 		rawCallback(CellEditableVar.GoPointer())
 	}
@@ -65,7 +65,8 @@ func (x *CellEditableIface) OverrideRemoveWidget(cb func(CellEditable)) {
 	if cb == nil {
 		x.xRemoveWidget = 0
 	} else {
-		x.xRemoveWidget = purego.NewCallback(func(CellEditableVarp uintptr) {
+		x.xRemoveWidget = core.NewCallback(func(CellEditableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellEditableBase{Ptr: CellEditableVarp})
 		})
 	}
@@ -80,7 +81,7 @@ func (x *CellEditableIface) GetRemoveWidget() func(CellEditable) {
 		return nil
 	}
 	var rawCallback func(CellEditableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRemoveWidget)
+	core.RegisterFunc(&rawCallback, x.xRemoveWidget)
 	return func(CellEditableVar CellEditable) {
 		rawCallback(CellEditableVar.GoPointer())
 	}
@@ -92,7 +93,8 @@ func (x *CellEditableIface) OverrideStartEditing(cb func(CellEditable, *gdk.Even
 	if cb == nil {
 		x.xStartEditing = 0
 	} else {
-		x.xStartEditing = purego.NewCallback(func(CellEditableVarp uintptr, EventVarp uintptr) {
+		x.xStartEditing = core.NewCallback(func(CellEditableVarp uintptr, EventVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellEditableBase{Ptr: CellEditableVarp}, gdk.EventNewFromInternalPtr(EventVarp))
 		})
 	}
@@ -105,7 +107,7 @@ func (x *CellEditableIface) GetStartEditing() func(CellEditable, *gdk.Event) {
 		return nil
 	}
 	var rawCallback func(CellEditableVarp uintptr, EventVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStartEditing)
+	core.RegisterFunc(&rawCallback, x.xStartEditing)
 	return func(CellEditableVar CellEditable, EventVar *gdk.Event) {
 		rawCallback(CellEditableVar.GoPointer(), EventVar.GoPointer())
 	}
@@ -116,6 +118,10 @@ func (x *CellEditableIface) GetStartEditing() func(CellEditable, *gdk.Event) {
 // The `GtkCellEditable` interface must be implemented for widgets to be usable
 // to edit the contents of a `GtkTreeView` cell. It provides a way to specify how
 // temporary widgets should be configured for editing, get the new value, etc.
+//
+// Deprecated: since 4.10. List views use widgets for displaying their
+//
+//	contents. See [Editable] for editable text widgets
 type CellEditable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -145,7 +151,43 @@ func (x *CellEditableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementCellEditable adds the CellEditable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - EditingDone()
+//   - RemoveWidget()
+//   - StartEditing(*gdk.Event)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see CellEditableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementCellEditable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, CellEditableGLibType(), func(iface *CellEditableIface) {
+		if _, ok := any(zero).(interface{ EditingDone() }); ok {
+			iface.OverrideEditingDone(func(self CellEditable) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EditingDone() })
+				impl.EditingDone()
+			})
+		}
+		if _, ok := any(zero).(interface{ RemoveWidget() }); ok {
+			iface.OverrideRemoveWidget(func(self CellEditable) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RemoveWidget() })
+				impl.RemoveWidget()
+			})
+		}
+		if _, ok := any(zero).(interface{ StartEditing(*gdk.Event) }); ok {
+			iface.OverrideStartEditing(func(self CellEditable, arg0 *gdk.Event) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ StartEditing(*gdk.Event) })
+				impl.StartEditing(arg0)
+			})
+		}
+	})
+}
+
 // Emits the `GtkCellEditable::editing-done` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellEditableBase) EditingDone() {
 
 	XGtkCellEditableEditingDone(x.GoPointer())
@@ -153,21 +195,23 @@ func (x *CellEditableBase) EditingDone() {
 }
 
 // Emits the `GtkCellEditable::remove-widget` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellEditableBase) RemoveWidget() {
 
 	XGtkCellEditableRemoveWidget(x.GoPointer())
 
 }
 
-// Begins editing on a @cell_editable.
+// Begins editing on a cell_editable.
 //
 // The `GtkCellRenderer` for the cell creates and returns a `GtkCellEditable` from
-// gtk_cell_renderer_start_editing(), configured for the `GtkCellRenderer` type.
+// [CellRenderer.StartEditing], configured for the `GtkCellRenderer` type.
 //
-// gtk_cell_editable_start_editing() can then set up @cell_editable suitably for
+// [CellEditable.StartEditing] can then set up cell_editable suitably for
 // editing a cell, e.g. making the Esc key emit `GtkCellEditable::editing-done`.
 //
-// Note that the @cell_editable is created on-demand for the current edit; its
+// Note that the cell_editable is created on-demand for the current edit; its
 // lifetime is temporary and does not persist across other edits and/or cells.
 func (x *CellEditableBase) StartEditing(EventVar *gdk.Event) {
 
@@ -205,7 +249,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -214,8 +258,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xCellEditableGLibType, libs, "gtk_cell_editable_get_type")
 
-	core.PuregoSafeRegister(&XGtkCellEditableEditingDone, libs, "gtk_cell_editable_editing_done")
-	core.PuregoSafeRegister(&XGtkCellEditableRemoveWidget, libs, "gtk_cell_editable_remove_widget")
-	core.PuregoSafeRegister(&XGtkCellEditableStartEditing, libs, "gtk_cell_editable_start_editing")
+	gobject.CheckClassSize("GtkCellEditableIface", xCellEditableGLibType, unsafe.Sizeof(CellEditableIface{}))
+
+	core.PuregoRegisterSince(&XGtkCellEditableEditingDone, libs, "gtk_cell_editable_editing_done", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellEditableRemoveWidget, libs, "gtk_cell_editable_remove_widget", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellEditableStartEditing, libs, "gtk_cell_editable_start_editing", "GTK", "")
 
 }
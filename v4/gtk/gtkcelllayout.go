@@ -5,21 +5,22 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A function which should set the value of @cell_layout’s cell renderer(s)
+// A function which should set the value of cell_layout’s cell renderer(s)
 // as appropriate.
+//
+// Deprecated: since 4.20. There is no replacement
 type CellLayoutDataFunc func(uintptr, uintptr, uintptr, *TreeIter, uintptr)
 
 type CellLayoutIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xPackStart uintptr
 
@@ -50,7 +51,8 @@ func (x *CellLayoutIface) OverridePackStart(cb func(CellLayout, *CellRenderer, b
 	if cb == nil {
 		x.xPackStart = 0
 	} else {
-		x.xPackStart = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool) {
+		x.xPackStart = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp), ExpandVarp)
 		})
 	}
@@ -63,7 +65,7 @@ func (x *CellLayoutIface) GetPackStart() func(CellLayout, *CellRenderer, bool) {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xPackStart)
+	core.RegisterFunc(&rawCallback, x.xPackStart)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer, ExpandVar bool) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer(), ExpandVar)
 	}
@@ -75,7 +77,8 @@ func (x *CellLayoutIface) OverridePackEnd(cb func(CellLayout, *CellRenderer, boo
 	if cb == nil {
 		x.xPackEnd = 0
 	} else {
-		x.xPackEnd = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool) {
+		x.xPackEnd = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp), ExpandVarp)
 		})
 	}
@@ -88,7 +91,7 @@ func (x *CellLayoutIface) GetPackEnd() func(CellLayout, *CellRenderer, bool) {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr, ExpandVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xPackEnd)
+	core.RegisterFunc(&rawCallback, x.xPackEnd)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer, ExpandVar bool) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer(), ExpandVar)
 	}
@@ -102,7 +105,8 @@ func (x *CellLayoutIface) OverrideClear(cb func(CellLayout)) {
 	if cb == nil {
 		x.xClear = 0
 	} else {
-		x.xClear = purego.NewCallback(func(CellLayoutVarp uintptr) {
+		x.xClear = core.NewCallback(func(CellLayoutVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp})
 		})
 	}
@@ -117,7 +121,7 @@ func (x *CellLayoutIface) GetClear() func(CellLayout) {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClear)
+	core.RegisterFunc(&rawCallback, x.xClear)
 	return func(CellLayoutVar CellLayout) {
 		rawCallback(CellLayoutVar.GoPointer())
 	}
@@ -131,7 +135,8 @@ func (x *CellLayoutIface) OverrideAddAttribute(cb func(CellLayout, *CellRenderer
 	if cb == nil {
 		x.xAddAttribute = 0
 	} else {
-		x.xAddAttribute = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, AttributeVarp string, ColumnVarp int) {
+		x.xAddAttribute = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, AttributeVarp string, ColumnVarp int) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp), AttributeVarp, ColumnVarp)
 		})
 	}
@@ -146,7 +151,7 @@ func (x *CellLayoutIface) GetAddAttribute() func(CellLayout, *CellRenderer, stri
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr, AttributeVarp string, ColumnVarp int)
-	purego.RegisterFunc(&rawCallback, x.xAddAttribute)
+	core.RegisterFunc(&rawCallback, x.xAddAttribute)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer, AttributeVar string, ColumnVar int) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer(), AttributeVar, ColumnVar)
 	}
@@ -160,7 +165,8 @@ func (x *CellLayoutIface) OverrideSetCellDataFunc(cb func(CellLayout, *CellRende
 	if cb == nil {
 		x.xSetCellDataFunc = 0
 	} else {
-		x.xSetCellDataFunc = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, FuncVarp uintptr, FuncDataVarp uintptr, DestroyVarp uintptr) {
+		x.xSetCellDataFunc = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, FuncVarp uintptr, FuncDataVarp uintptr, DestroyVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp), (*CellLayoutDataFunc)(unsafe.Pointer(FuncVarp)), FuncDataVarp, (*glib.DestroyNotify)(unsafe.Pointer(DestroyVarp)))
 		})
 	}
@@ -175,7 +181,7 @@ func (x *CellLayoutIface) GetSetCellDataFunc() func(CellLayout, *CellRenderer, *
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr, FuncVarp uintptr, FuncDataVarp uintptr, DestroyVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetCellDataFunc)
+	core.RegisterFunc(&rawCallback, x.xSetCellDataFunc)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer, FuncVar *CellLayoutDataFunc, FuncDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer(), glib.NewCallbackNullable(FuncVar), FuncDataVar, glib.NewCallback(DestroyVar))
 	}
@@ -184,12 +190,13 @@ func (x *CellLayoutIface) GetSetCellDataFunc() func(CellLayout, *CellRenderer, *
 // OverrideClearAttributes sets the "clear_attributes" callback function.
 // Clears all existing attributes previously set
 //
-//	with gtk_cell_layout_set_attributes().
+//	with [CellLayout.SetAttributes].
 func (x *CellLayoutIface) OverrideClearAttributes(cb func(CellLayout, *CellRenderer)) {
 	if cb == nil {
 		x.xClearAttributes = 0
 	} else {
-		x.xClearAttributes = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr) {
+		x.xClearAttributes = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp))
 		})
 	}
@@ -198,13 +205,13 @@ func (x *CellLayoutIface) OverrideClearAttributes(cb func(CellLayout, *CellRende
 // GetClearAttributes gets the "clear_attributes" callback function.
 // Clears all existing attributes previously set
 //
-//	with gtk_cell_layout_set_attributes().
+//	with [CellLayout.SetAttributes].
 func (x *CellLayoutIface) GetClearAttributes() func(CellLayout, *CellRenderer) {
 	if x.xClearAttributes == 0 {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClearAttributes)
+	core.RegisterFunc(&rawCallback, x.xClearAttributes)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer())
 	}
@@ -216,7 +223,8 @@ func (x *CellLayoutIface) OverrideReorder(cb func(CellLayout, *CellRenderer, int
 	if cb == nil {
 		x.xReorder = 0
 	} else {
-		x.xReorder = purego.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, PositionVarp int) {
+		x.xReorder = core.NewCallback(func(CellLayoutVarp uintptr, CellVarp uintptr, PositionVarp int) {
+			defer core.RecoverPanic()
 			cb(&CellLayoutBase{Ptr: CellLayoutVarp}, CellRendererNewFromInternalPtr(CellVarp), PositionVarp)
 		})
 	}
@@ -229,7 +237,7 @@ func (x *CellLayoutIface) GetReorder() func(CellLayout, *CellRenderer, int) {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr, CellVarp uintptr, PositionVarp int)
-	purego.RegisterFunc(&rawCallback, x.xReorder)
+	core.RegisterFunc(&rawCallback, x.xReorder)
 	return func(CellLayoutVar CellLayout, CellVar *CellRenderer, PositionVar int) {
 		rawCallback(CellLayoutVar.GoPointer(), CellVar.GoPointer(), PositionVar)
 	}
@@ -243,7 +251,8 @@ func (x *CellLayoutIface) OverrideGetCells(cb func(CellLayout) *glib.List) {
 	if cb == nil {
 		x.xGetCells = 0
 	} else {
-		x.xGetCells = purego.NewCallback(func(CellLayoutVarp uintptr) *glib.List {
+		x.xGetCells = core.NewCallback(func(CellLayoutVarp uintptr) *glib.List {
+			defer core.RecoverPanic()
 			return cb(&CellLayoutBase{Ptr: CellLayoutVarp})
 		})
 	}
@@ -258,7 +267,7 @@ func (x *CellLayoutIface) GetGetCells() func(CellLayout) *glib.List {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr) *glib.List
-	purego.RegisterFunc(&rawCallback, x.xGetCells)
+	core.RegisterFunc(&rawCallback, x.xGetCells)
 	return func(CellLayoutVar CellLayout) *glib.List {
 		return rawCallback(CellLayoutVar.GoPointer())
 	}
@@ -273,7 +282,8 @@ func (x *CellLayoutIface) OverrideGetArea(cb func(CellLayout) *CellArea) {
 	if cb == nil {
 		x.xGetArea = 0
 	} else {
-		x.xGetArea = purego.NewCallback(func(CellLayoutVarp uintptr) uintptr {
+		x.xGetArea = core.NewCallback(func(CellLayoutVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&CellLayoutBase{Ptr: CellLayoutVarp})
 			if ret == nil {
 				return 0
@@ -293,7 +303,7 @@ func (x *CellLayoutIface) GetGetArea() func(CellLayout) *CellArea {
 		return nil
 	}
 	var rawCallback func(CellLayoutVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetArea)
+	core.RegisterFunc(&rawCallback, x.xGetArea)
 	return func(CellLayoutVar CellLayout) *CellArea {
 		rawRet := rawCallback(CellLayoutVar.GoPointer())
 		if rawRet == 0 {
@@ -315,61 +325,53 @@ func (x *CellLayoutIface) GetGetArea() func(CellLayout) *CellArea {
 // `GtkCellLayout` are attributes. Attributes let you set the properties
 // in flexible ways. They can just be set to constant values like regular
 // properties. But they can also be mapped to a column of the underlying
-// tree model with gtk_cell_layout_set_attributes(), which means that the value
+// tree model with [CellLayout.SetAttributes], which means that the value
 // of the attribute can change from cell to cell as they are rendered by
 // the cell renderer. Finally, it is possible to specify a function with
-// gtk_cell_layout_set_cell_data_func() that is called to determine the
+// [CellLayout.SetCellDataFunc] that is called to determine the
 // value of the attribute for each cell that is rendered.
 //
-// ## GtkCellLayouts as GtkBuildable
+// # GtkCellLayouts as GtkBuildable
 //
 // Implementations of GtkCellLayout which also implement the GtkBuildable
 // interface (`GtkCellView`, `GtkIconView`, `GtkComboBox`,
 // `GtkEntryCompletion`, `GtkTreeViewColumn`) accept `GtkCellRenderer` objects
-// as `&lt;child&gt;` elements in UI definitions. They support a custom `&lt;attributes&gt;`
-// element for their children, which can contain multiple `&lt;attribute&gt;`
-// elements. Each `&lt;attribute&gt;` element has a name attribute which specifies
+// as `<child>` elements in UI definitions. They support a custom `<attributes>`
+// element for their children, which can contain multiple `<attribute>`
+// elements. Each `<attribute>` element has a name attribute which specifies
 // a property of the cell renderer; the content of the element is the
 // attribute value.
 //
 // This is an example of a UI definition fragment specifying attributes:
 //
-// ```xml
-// &lt;object class="GtkCellView"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="GtkCellRendererText"/&gt;
-//	  &lt;attributes&gt;
-//	    &lt;attribute name="text"&gt;0&lt;/attribute&gt;
-//	  &lt;/attributes&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkCellView">
+//	  <child>
+//	    <object class="GtkCellRendererText"/>
+//	    <attributes>
+//	      <attribute name="text">0</attribute>
+//	    </attributes>
+//	  </child>
+//	</object>
 //
 // Furthermore for implementations of `GtkCellLayout` that use a `GtkCellArea`
 // to lay out cells (all `GtkCellLayout`s in GTK use a `GtkCellArea`)
-// [cell properties](class.CellArea.html#cell-properties) can also be defined
-// in the format by specifying the custom `&lt;cell-packing&gt;` attribute which can
-// contain multiple `&lt;property&gt;` elements.
+// cell properties can also be defined
+// in the format by specifying the custom `<cell-packing>` attribute which can
+// contain multiple `<property>` elements.
 //
 // Here is a UI definition fragment specifying cell properties:
 //
-// ```xml
-// &lt;object class="GtkTreeViewColumn"&gt;
+//	<object class="GtkTreeViewColumn">
+//	  <child>
+//	    <object class="GtkCellRendererText"/>
+//	    <cell-packing>
+//	      <property name="align">True</property>
+//	      <property name="expand">False</property>
+//	    </cell-packing>
+//	  </child>
+//	</object>
 //
-//	&lt;child&gt;
-//	  &lt;object class="GtkCellRendererText"/&gt;
-//	  &lt;cell-packing&gt;
-//	    &lt;property name="align"&gt;True&lt;/property&gt;
-//	    &lt;property name="expand"&gt;False&lt;/property&gt;
-//	  &lt;/cell-packing&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ## Subclassing GtkCellLayout implementations
+// # Subclassing GtkCellLayout implementations
 //
 // When subclassing a widget that implements `GtkCellLayout` like
 // `GtkIconView` or `GtkComboBox`, there are some considerations related
@@ -377,12 +379,8 @@ func (x *CellLayoutIface) GetGetArea() func(CellLayout) *CellArea {
 // The cell area is exposed as a construct-only property by these
 // widgets. This means that it is possible to e.g. do
 //
-// ```c
-// GtkWIdget *combo =
-//
-//	g_object_new (GTK_TYPE_COMBO_BOX, "cell-area", my_cell_area, NULL);
-//
-// ```
+//	GtkWIdget *combo =
+//	  g_object_new (GTK_TYPE_COMBO_BOX, "cell-area", my_cell_area, NULL);
 //
 // to use a custom cell area with a combo box. But construct properties
 // are only initialized after instance `init()`
@@ -392,10 +390,8 @@ func (x *CellLayoutIface) GetGetArea() func(CellLayout) *CellArea {
 // construct property value will be ignored (with a warning, to alert
 // you to the problem).
 //
-// ```c
-// static void
-// my_combo_box_init (MyComboBox *b)
-//
+//	static void
+//	my_combo_box_init (MyComboBox *b)
 //	{
 //	  GtkCellRenderer *cell;
 //
@@ -407,21 +403,22 @@ func (x *CellLayoutIface) GetGetArea() func(CellLayout) *CellArea {
 //	  ...
 //	}
 //
-// GtkWidget *
-// my_combo_box_new (GtkCellArea *area)
-//
+//	GtkWidget *
+//	my_combo_box_new (GtkCellArea *area)
 //	{
 //	  // This call is going to cause a warning about area being ignored
 //	  return g_object_new (MY_TYPE_COMBO_BOX, "cell-area", area, NULL);
 //	}
 //
-// ```
-//
 // If supporting alternative cell areas with your derived widget is
 // not important, then this does not have to concern you. If you want
 // to support alternative cell areas, you can do so by moving the
 // problematic calls out of `init()` and into a `constructor()`
 // for your class.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	See [LayoutManager] for layout manager delegate objects
 type CellLayout interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -458,21 +455,109 @@ func (x *CellLayoutBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Adds an attribute mapping to the list in @cell_layout.
+// ImplementCellLayout adds the CellLayout interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - PackStart(*CellRenderer, bool)
+//   - PackEnd(*CellRenderer, bool)
+//   - Clear()
+//   - AddAttribute(*CellRenderer, string, int)
+//   - SetCellDataFunc(*CellRenderer, *CellLayoutDataFunc, uintptr, *glib.DestroyNotify)
+//   - ClearAttributes(*CellRenderer)
+//   - Reorder(*CellRenderer, int)
+//   - GetCells() *glib.List
+//   - GetArea() *CellArea
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see CellLayoutIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementCellLayout[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, CellLayoutGLibType(), func(iface *CellLayoutIface) {
+		if _, ok := any(zero).(interface{ PackStart(*CellRenderer, bool) }); ok {
+			iface.OverridePackStart(func(self CellLayout, arg0 *CellRenderer, arg1 bool) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PackStart(*CellRenderer, bool) })
+				impl.PackStart(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ PackEnd(*CellRenderer, bool) }); ok {
+			iface.OverridePackEnd(func(self CellLayout, arg0 *CellRenderer, arg1 bool) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ PackEnd(*CellRenderer, bool) })
+				impl.PackEnd(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ Clear() }); ok {
+			iface.OverrideClear(func(self CellLayout) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Clear() })
+				impl.Clear()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AddAttribute(*CellRenderer, string, int)
+		}); ok {
+			iface.OverrideAddAttribute(func(self CellLayout, arg0 *CellRenderer, arg1 string, arg2 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AddAttribute(*CellRenderer, string, int)
+				})
+				impl.AddAttribute(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetCellDataFunc(*CellRenderer, *CellLayoutDataFunc, uintptr, *glib.DestroyNotify)
+		}); ok {
+			iface.OverrideSetCellDataFunc(func(self CellLayout, arg0 *CellRenderer, arg1 *CellLayoutDataFunc, arg2 uintptr, arg3 *glib.DestroyNotify) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetCellDataFunc(*CellRenderer, *CellLayoutDataFunc, uintptr, *glib.DestroyNotify)
+				})
+				impl.SetCellDataFunc(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ ClearAttributes(*CellRenderer) }); ok {
+			iface.OverrideClearAttributes(func(self CellLayout, arg0 *CellRenderer) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ClearAttributes(*CellRenderer) })
+				impl.ClearAttributes(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ Reorder(*CellRenderer, int) }); ok {
+			iface.OverrideReorder(func(self CellLayout, arg0 *CellRenderer, arg1 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Reorder(*CellRenderer, int) })
+				impl.Reorder(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetCells() *glib.List }); ok {
+			iface.OverrideGetCells(func(self CellLayout) *glib.List {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetCells() *glib.List })
+				return impl.GetCells()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetArea() *CellArea }); ok {
+			iface.OverrideGetArea(func(self CellLayout) *CellArea {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetArea() *CellArea })
+				return impl.GetArea()
+			})
+		}
+	})
+}
+
+// Adds an attribute mapping to the list in cell_layout.
 //
-// The @column is the column of the model to get a value from, and the
-// @attribute is the property on @cell to be set from that value. So for
+// The column is the column of the model to get a value from, and the
+// attribute is the property on cell to be set from that value. So for
 // example if column 2 of the model contains strings, you could have the
 // “text” attribute of a `GtkCellRendererText` get its values from column 2.
 // In this context "attribute" and "property" are used interchangeably.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) AddAttribute(CellVar *CellRenderer, AttributeVar string, ColumnVar int) {
 
 	XGtkCellLayoutAddAttribute(x.GoPointer(), CellVar.GoPointer(), AttributeVar, ColumnVar)
 
 }
 
-// Unsets all the mappings on all renderers on @cell_layout and
-// removes all renderers from @cell_layout.
+// Unsets all the mappings on all renderers on cell_layout and
+// removes all renderers from cell_layout.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) Clear() {
 
 	XGtkCellLayoutClear(x.GoPointer())
@@ -480,16 +565,20 @@ func (x *CellLayoutBase) Clear() {
 }
 
 // Clears all existing attributes previously set with
-// gtk_cell_layout_set_attributes().
+// [CellLayout.SetAttributes].
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) ClearAttributes(CellVar *CellRenderer) {
 
 	XGtkCellLayoutClearAttributes(x.GoPointer(), CellVar.GoPointer())
 
 }
 
-// Returns the underlying `GtkCellArea` which might be @cell_layout
-// if called on a `GtkCellArea` or might be %NULL if no `GtkCellArea`
-// is used by @cell_layout.
+// Returns the underlying `GtkCellArea` which might be cell_layout
+// if called on a `GtkCellArea` or might be nil if no `GtkCellArea`
+// is used by cell_layout.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) GetArea() *CellArea {
 	var cls *CellArea
 
@@ -504,39 +593,47 @@ func (x *CellLayoutBase) GetArea() *CellArea {
 	return cls
 }
 
-// Returns the cell renderers which have been added to @cell_layout.
+// Returns the cell renderers which have been added to cell_layout.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) GetCells() *glib.List {
 
 	cret := XGtkCellLayoutGetCells(x.GoPointer())
 	return cret
 }
 
-// Adds the @cell to the end of @cell_layout. If @expand is %FALSE, then the
-// @cell is allocated no more space than it needs. Any unused space is
-// divided evenly between cells for which @expand is %TRUE.
+// Adds the cell to the end of cell_layout. If expand is false, then the
+// cell is allocated no more space than it needs. Any unused space is
+// divided evenly between cells for which expand is true.
 //
 // Note that reusing the same cell renderer is not supported.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) PackEnd(CellVar *CellRenderer, ExpandVar bool) {
 
 	XGtkCellLayoutPackEnd(x.GoPointer(), CellVar.GoPointer(), ExpandVar)
 
 }
 
-// Packs the @cell into the beginning of @cell_layout. If @expand is %FALSE,
-// then the @cell is allocated no more space than it needs. Any unused space
-// is divided evenly between cells for which @expand is %TRUE.
+// Packs the cell into the beginning of cell_layout. If expand is false,
+// then the cell is allocated no more space than it needs. Any unused space
+// is divided evenly between cells for which expand is true.
 //
 // Note that reusing the same cell renderer is not supported.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) PackStart(CellVar *CellRenderer, ExpandVar bool) {
 
 	XGtkCellLayoutPackStart(x.GoPointer(), CellVar.GoPointer(), ExpandVar)
 
 }
 
-// Re-inserts @cell at @position.
+// Re-inserts cell at position.
 //
-// Note that @cell has already to be packed into @cell_layout
+// Note that cell has already to be packed into cell_layout
 // for this to function properly.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) Reorder(CellVar *CellRenderer, PositionVar int) {
 
 	XGtkCellLayoutReorder(x.GoPointer(), CellVar.GoPointer(), PositionVar)
@@ -544,29 +641,65 @@ func (x *CellLayoutBase) Reorder(CellVar *CellRenderer, PositionVar int) {
 }
 
 // Sets the attributes in the parameter list as the attributes
-// of @cell_layout.
+// of cell_layout.
 //
-// See [method@Gtk.CellLayout.add_attribute] for more details.
+// See [CellLayout.AddAttribute] for more details.
 //
 // The attributes should be in attribute/column order, as in
-// gtk_cell_layout_add_attribute(). All existing attributes are
+// [CellLayout.AddAttribute]. All existing attributes are
 // removed, and replaced with the new attributes.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) SetAttributes(CellVar *CellRenderer, varArgs ...interface{}) {
 
 	XGtkCellLayoutSetAttributes(x.GoPointer(), CellVar.GoPointer(), varArgs...)
 
 }
 
-// Sets the `GtkCellLayout`DataFunc to use for @cell_layout.
+// Sets the `GtkCellLayout`DataFunc to use for cell_layout.
 //
 // This function is used instead of the standard attributes mapping
-// for setting the column value, and should set the value of @cell_layout’s
+// for setting the column value, and should set the value of cell_layout’s
 // cell renderer(s) as appropriate.
 //
-// @func may be %NULL to remove a previously set function.
+// func may be nil to remove a previously set function.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *CellLayoutBase) SetCellDataFunc(CellVar *CellRenderer, FuncVar *CellLayoutDataFunc, FuncDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
-	XGtkCellLayoutSetCellDataFunc(x.GoPointer(), CellVar.GoPointer(), glib.NewCallbackNullable(FuncVar), FuncDataVar, glib.NewCallback(DestroyVar))
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 *TreeIter, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	XGtkCellLayoutSetCellDataFunc(x.GoPointer(), CellVar.GoPointer(), FuncVarRef, FuncDataVar, DestroyVarRef)
 
 }
 
@@ -586,7 +719,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -595,15 +728,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xCellLayoutGLibType, libs, "gtk_cell_layout_get_type")
 
-	core.PuregoSafeRegister(&XGtkCellLayoutAddAttribute, libs, "gtk_cell_layout_add_attribute")
-	core.PuregoSafeRegister(&XGtkCellLayoutClear, libs, "gtk_cell_layout_clear")
-	core.PuregoSafeRegister(&XGtkCellLayoutClearAttributes, libs, "gtk_cell_layout_clear_attributes")
-	core.PuregoSafeRegister(&XGtkCellLayoutGetArea, libs, "gtk_cell_layout_get_area")
-	core.PuregoSafeRegister(&XGtkCellLayoutGetCells, libs, "gtk_cell_layout_get_cells")
-	core.PuregoSafeRegister(&XGtkCellLayoutPackEnd, libs, "gtk_cell_layout_pack_end")
-	core.PuregoSafeRegister(&XGtkCellLayoutPackStart, libs, "gtk_cell_layout_pack_start")
-	core.PuregoSafeRegister(&XGtkCellLayoutReorder, libs, "gtk_cell_layout_reorder")
-	core.PuregoSafeRegister(&XGtkCellLayoutSetAttributes, libs, "gtk_cell_layout_set_attributes")
-	core.PuregoSafeRegister(&XGtkCellLayoutSetCellDataFunc, libs, "gtk_cell_layout_set_cell_data_func")
+	gobject.CheckClassSize("GtkCellLayoutIface", xCellLayoutGLibType, unsafe.Sizeof(CellLayoutIface{}))
+
+	core.PuregoRegisterSince(&XGtkCellLayoutAddAttribute, libs, "gtk_cell_layout_add_attribute", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutClear, libs, "gtk_cell_layout_clear", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutClearAttributes, libs, "gtk_cell_layout_clear_attributes", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutGetArea, libs, "gtk_cell_layout_get_area", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutGetCells, libs, "gtk_cell_layout_get_cells", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutPackEnd, libs, "gtk_cell_layout_pack_end", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutPackStart, libs, "gtk_cell_layout_pack_start", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutReorder, libs, "gtk_cell_layout_reorder", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutSetAttributes, libs, "gtk_cell_layout_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&XGtkCellLayoutSetCellDataFunc, libs, "gtk_cell_layout_set_cell_data_func", "GTK", "")
 
 }
@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -55,7 +56,8 @@ func (x *CellRendererClass) OverrideGetRequestMode(cb func(*CellRenderer) SizeRe
 	if cb == nil {
 		x.xGetRequestMode = 0
 	} else {
-		x.xGetRequestMode = purego.NewCallback(func(CellVarp uintptr) SizeRequestMode {
+		x.xGetRequestMode = core.NewCallback(func(CellVarp uintptr) SizeRequestMode {
+			defer core.RecoverPanic()
 			return cb(CellRendererNewFromInternalPtr(CellVarp))
 		})
 	}
@@ -70,7 +72,7 @@ func (x *CellRendererClass) GetGetRequestMode() func(*CellRenderer) SizeRequestM
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr) SizeRequestMode
-	purego.RegisterFunc(&rawCallback, x.xGetRequestMode)
+	core.RegisterFunc(&rawCallback, x.xGetRequestMode)
 	return func(CellVar *CellRenderer) SizeRequestMode {
 		return rawCallback(CellVar.GoPointer())
 	}
@@ -82,7 +84,8 @@ func (x *CellRendererClass) OverrideGetPreferredWidth(cb func(*CellRenderer, *Wi
 	if cb == nil {
 		x.xGetPreferredWidth = 0
 	} else {
-		x.xGetPreferredWidth = purego.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int) {
+		x.xGetPreferredWidth = core.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), WidgetNewFromInternalPtr(WidgetVarp), MinimumSizeVarp, NaturalSizeVarp)
 		})
 	}
@@ -95,7 +98,7 @@ func (x *CellRendererClass) GetGetPreferredWidth() func(*CellRenderer, *Widget,
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredWidth)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredWidth)
 	return func(CellVar *CellRenderer, WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
 		rawCallback(CellVar.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
 	}
@@ -107,7 +110,8 @@ func (x *CellRendererClass) OverrideGetPreferredHeightForWidth(cb func(*CellRend
 	if cb == nil {
 		x.xGetPreferredHeightForWidth = 0
 	} else {
-		x.xGetPreferredHeightForWidth = purego.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int) {
+		x.xGetPreferredHeightForWidth = core.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), WidgetNewFromInternalPtr(WidgetVarp), WidthVarp, MinimumHeightVarp, NaturalHeightVarp)
 		})
 	}
@@ -120,7 +124,7 @@ func (x *CellRendererClass) GetGetPreferredHeightForWidth() func(*CellRenderer,
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, WidgetVarp uintptr, WidthVarp int, MinimumHeightVarp *int, NaturalHeightVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredHeightForWidth)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredHeightForWidth)
 	return func(CellVar *CellRenderer, WidgetVar *Widget, WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
 		rawCallback(CellVar.GoPointer(), WidgetVar.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
 	}
@@ -132,7 +136,8 @@ func (x *CellRendererClass) OverrideGetPreferredHeight(cb func(*CellRenderer, *W
 	if cb == nil {
 		x.xGetPreferredHeight = 0
 	} else {
-		x.xGetPreferredHeight = purego.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int) {
+		x.xGetPreferredHeight = core.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), WidgetNewFromInternalPtr(WidgetVarp), MinimumSizeVarp, NaturalSizeVarp)
 		})
 	}
@@ -145,7 +150,7 @@ func (x *CellRendererClass) GetGetPreferredHeight() func(*CellRenderer, *Widget,
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, WidgetVarp uintptr, MinimumSizeVarp *int, NaturalSizeVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredHeight)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredHeight)
 	return func(CellVar *CellRenderer, WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
 		rawCallback(CellVar.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
 	}
@@ -157,7 +162,8 @@ func (x *CellRendererClass) OverrideGetPreferredWidthForHeight(cb func(*CellRend
 	if cb == nil {
 		x.xGetPreferredWidthForHeight = 0
 	} else {
-		x.xGetPreferredWidthForHeight = purego.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int) {
+		x.xGetPreferredWidthForHeight = core.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), WidgetNewFromInternalPtr(WidgetVarp), HeightVarp, MinimumWidthVarp, NaturalWidthVarp)
 		})
 	}
@@ -170,32 +176,33 @@ func (x *CellRendererClass) GetGetPreferredWidthForHeight() func(*CellRenderer,
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, WidgetVarp uintptr, HeightVarp int, MinimumWidthVarp *int, NaturalWidthVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreferredWidthForHeight)
+	core.RegisterFunc(&rawCallback, x.xGetPreferredWidthForHeight)
 	return func(CellVar *CellRenderer, WidgetVar *Widget, HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
 		rawCallback(CellVar.GoPointer(), WidgetVar.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
 	}
 }
 
 // OverrideGetAlignedArea sets the "get_aligned_area" callback function.
-// Called to get the aligned area used by @cell inside @cell_area.
+// Called to get the aligned area used by cell inside cell_area.
 func (x *CellRendererClass) OverrideGetAlignedArea(cb func(*CellRenderer, *Widget, CellRendererState, *gdk.Rectangle, *gdk.Rectangle)) {
 	if cb == nil {
 		x.xGetAlignedArea = 0
 	} else {
-		x.xGetAlignedArea = purego.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, FlagsVarp CellRendererState, CellAreaVarp *gdk.Rectangle, AlignedAreaVarp *gdk.Rectangle) {
+		x.xGetAlignedArea = core.NewCallback(func(CellVarp uintptr, WidgetVarp uintptr, FlagsVarp CellRendererState, CellAreaVarp *gdk.Rectangle, AlignedAreaVarp *gdk.Rectangle) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), WidgetNewFromInternalPtr(WidgetVarp), FlagsVarp, CellAreaVarp, AlignedAreaVarp)
 		})
 	}
 }
 
 // GetGetAlignedArea gets the "get_aligned_area" callback function.
-// Called to get the aligned area used by @cell inside @cell_area.
+// Called to get the aligned area used by cell inside cell_area.
 func (x *CellRendererClass) GetGetAlignedArea() func(*CellRenderer, *Widget, CellRendererState, *gdk.Rectangle, *gdk.Rectangle) {
 	if x.xGetAlignedArea == 0 {
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, WidgetVarp uintptr, FlagsVarp CellRendererState, CellAreaVarp *gdk.Rectangle, AlignedAreaVarp *gdk.Rectangle)
-	purego.RegisterFunc(&rawCallback, x.xGetAlignedArea)
+	core.RegisterFunc(&rawCallback, x.xGetAlignedArea)
 	return func(CellVar *CellRenderer, WidgetVar *Widget, FlagsVar CellRendererState, CellAreaVar *gdk.Rectangle, AlignedAreaVar *gdk.Rectangle) {
 		rawCallback(CellVar.GoPointer(), WidgetVar.GoPointer(), FlagsVar, CellAreaVar, AlignedAreaVar)
 	}
@@ -207,7 +214,8 @@ func (x *CellRendererClass) OverrideSnapshot(cb func(*CellRenderer, *Snapshot, *
 	if cb == nil {
 		x.xSnapshot = 0
 	} else {
-		x.xSnapshot = purego.NewCallback(func(CellVarp uintptr, SnapshotVarp uintptr, WidgetVarp uintptr, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) {
+		x.xSnapshot = core.NewCallback(func(CellVarp uintptr, SnapshotVarp uintptr, WidgetVarp uintptr, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), SnapshotNewFromInternalPtr(SnapshotVarp), WidgetNewFromInternalPtr(WidgetVarp), BackgroundAreaVarp, CellAreaVarp, FlagsVarp)
 		})
 	}
@@ -220,7 +228,7 @@ func (x *CellRendererClass) GetSnapshot() func(*CellRenderer, *Snapshot, *Widget
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, SnapshotVarp uintptr, WidgetVarp uintptr, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState)
-	purego.RegisterFunc(&rawCallback, x.xSnapshot)
+	core.RegisterFunc(&rawCallback, x.xSnapshot)
 	return func(CellVar *CellRenderer, SnapshotVar *Snapshot, WidgetVar *Widget, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) {
 		rawCallback(CellVar.GoPointer(), SnapshotVar.GoPointer(), WidgetVar.GoPointer(), BackgroundAreaVar, CellAreaVar, FlagsVar)
 	}
@@ -232,7 +240,8 @@ func (x *CellRendererClass) OverrideActivate(cb func(*CellRenderer, *gdk.Event,
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) bool {
+		x.xActivate = core.NewCallback(func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) bool {
+			defer core.RecoverPanic()
 			return cb(CellRendererNewFromInternalPtr(CellVarp), gdk.EventNewFromInternalPtr(EventVarp), WidgetNewFromInternalPtr(WidgetVarp), PathVarp, BackgroundAreaVarp, CellAreaVarp, FlagsVarp)
 		})
 	}
@@ -245,7 +254,7 @@ func (x *CellRendererClass) GetActivate() func(*CellRenderer, *gdk.Event, *Widge
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) bool
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(CellVar *CellRenderer, EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) bool {
 		return rawCallback(CellVar.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
 	}
@@ -257,7 +266,8 @@ func (x *CellRendererClass) OverrideStartEditing(cb func(*CellRenderer, *gdk.Eve
 	if cb == nil {
 		x.xStartEditing = 0
 	} else {
-		x.xStartEditing = purego.NewCallback(func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) uintptr {
+		x.xStartEditing = core.NewCallback(func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(CellRendererNewFromInternalPtr(CellVarp), gdk.EventNewFromInternalPtr(EventVarp), WidgetNewFromInternalPtr(WidgetVarp), PathVarp, BackgroundAreaVarp, CellAreaVarp, FlagsVarp)
 			if ret == nil {
 				return 0
@@ -274,7 +284,7 @@ func (x *CellRendererClass) GetStartEditing() func(*CellRenderer, *gdk.Event, *W
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, EventVarp uintptr, WidgetVarp uintptr, PathVarp string, BackgroundAreaVarp *gdk.Rectangle, CellAreaVarp *gdk.Rectangle, FlagsVarp CellRendererState) uintptr
-	purego.RegisterFunc(&rawCallback, x.xStartEditing)
+	core.RegisterFunc(&rawCallback, x.xStartEditing)
 	return func(CellVar *CellRenderer, EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) *CellEditableBase {
 		rawRet := rawCallback(CellVar.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
 		if rawRet == 0 {
@@ -292,7 +302,8 @@ func (x *CellRendererClass) OverrideEditingCanceled(cb func(*CellRenderer)) {
 	if cb == nil {
 		x.xEditingCanceled = 0
 	} else {
-		x.xEditingCanceled = purego.NewCallback(func(CellVarp uintptr) {
+		x.xEditingCanceled = core.NewCallback(func(CellVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp))
 		})
 	}
@@ -305,7 +316,7 @@ func (x *CellRendererClass) GetEditingCanceled() func(*CellRenderer) {
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEditingCanceled)
+	core.RegisterFunc(&rawCallback, x.xEditingCanceled)
 	return func(CellVar *CellRenderer) {
 		rawCallback(CellVar.GoPointer())
 	}
@@ -317,7 +328,8 @@ func (x *CellRendererClass) OverrideEditingStarted(cb func(*CellRenderer, CellEd
 	if cb == nil {
 		x.xEditingStarted = 0
 	} else {
-		x.xEditingStarted = purego.NewCallback(func(CellVarp uintptr, EditableVarp uintptr, PathVarp string) {
+		x.xEditingStarted = core.NewCallback(func(CellVarp uintptr, EditableVarp uintptr, PathVarp string) {
+			defer core.RecoverPanic()
 			cb(CellRendererNewFromInternalPtr(CellVarp), &CellEditableBase{Ptr: EditableVarp}, PathVarp)
 		})
 	}
@@ -330,7 +342,7 @@ func (x *CellRendererClass) GetEditingStarted() func(*CellRenderer, CellEditable
 		return nil
 	}
 	var rawCallback func(CellVarp uintptr, EditableVarp uintptr, PathVarp string)
-	purego.RegisterFunc(&rawCallback, x.xEditingStarted)
+	core.RegisterFunc(&rawCallback, x.xEditingStarted)
 	return func(CellVar *CellRenderer, EditableVar CellEditable, PathVar string) {
 		rawCallback(CellVar.GoPointer(), EditableVar.GoPointer(), PathVar)
 	}
@@ -353,7 +365,9 @@ func (x *CellRendererPrivate) GoPointer() uintptr {
 }
 
 // Tells how a cell is to be rendered.
-type CellRendererState int
+//
+// Deprecated: since 4.20. There is no replacement.
+type CellRendererState uint32
 
 var xCellRendererStateGLibType func() types.GType
 
@@ -380,7 +394,70 @@ const (
 	CellRendererExpandedValue CellRendererState = 64
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (CellRendererState) GLibType() types.GType {
+	return xCellRendererStateGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x CellRendererState) Has(flags CellRendererState) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x CellRendererState) Set(flags CellRendererState) CellRendererState {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x CellRendererState) Clear(flags CellRendererState) CellRendererState {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x CellRendererState) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&CellRendererSelectedValue != 0 && x&CellRendererSelectedValue == CellRendererSelectedValue {
+		names = append(names, "GTK_CELL_RENDERER_SELECTED")
+		rest &^= CellRendererSelectedValue
+	}
+	if rest&CellRendererPrelitValue != 0 && x&CellRendererPrelitValue == CellRendererPrelitValue {
+		names = append(names, "GTK_CELL_RENDERER_PRELIT")
+		rest &^= CellRendererPrelitValue
+	}
+	if rest&CellRendererInsensitiveValue != 0 && x&CellRendererInsensitiveValue == CellRendererInsensitiveValue {
+		names = append(names, "GTK_CELL_RENDERER_INSENSITIVE")
+		rest &^= CellRendererInsensitiveValue
+	}
+	if rest&CellRendererSortedValue != 0 && x&CellRendererSortedValue == CellRendererSortedValue {
+		names = append(names, "GTK_CELL_RENDERER_SORTED")
+		rest &^= CellRendererSortedValue
+	}
+	if rest&CellRendererFocusedValue != 0 && x&CellRendererFocusedValue == CellRendererFocusedValue {
+		names = append(names, "GTK_CELL_RENDERER_FOCUSED")
+		rest &^= CellRendererFocusedValue
+	}
+	if rest&CellRendererExpandableValue != 0 && x&CellRendererExpandableValue == CellRendererExpandableValue {
+		names = append(names, "GTK_CELL_RENDERER_EXPANDABLE")
+		rest &^= CellRendererExpandableValue
+	}
+	if rest&CellRendererExpandedValue != 0 && x&CellRendererExpandedValue == CellRendererExpandedValue {
+		names = append(names, "GTK_CELL_RENDERER_EXPANDED")
+		rest &^= CellRendererExpandedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Identifies how the user can interact with a particular cell.
+//
+// Deprecated: since 4.20. There is no replacement.
 type CellRendererMode int
 
 var xCellRendererModeGLibType func() types.GType
@@ -402,6 +479,45 @@ const (
 	CellRendererModeEditableValue CellRendererMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CellRendererMode) String() string {
+	switch x {
+	case CellRendererModeInertValue:
+		return "GTK_CELL_RENDERER_MODE_INERT"
+	case CellRendererModeActivatableValue:
+		return "GTK_CELL_RENDERER_MODE_ACTIVATABLE"
+	case CellRendererModeEditableValue:
+		return "GTK_CELL_RENDERER_MODE_EDITABLE"
+	}
+	return fmt.Sprintf("CellRendererMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CellRendererMode) Nick() string {
+	switch x {
+	case CellRendererModeInertValue:
+		return "inert"
+	case CellRendererModeActivatableValue:
+		return "activatable"
+	case CellRendererModeEditableValue:
+		return "editable"
+	}
+	return ""
+}
+
+// CellRendererModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CellRendererModeFromString(s string) (CellRendererMode, bool) {
+	switch s {
+	case "GTK_CELL_RENDERER_MODE_INERT", "inert":
+		return CellRendererModeInertValue, true
+	case "GTK_CELL_RENDERER_MODE_ACTIVATABLE", "activatable":
+		return CellRendererModeActivatableValue, true
+	case "GTK_CELL_RENDERER_MODE_EDITABLE", "editable":
+		return CellRendererModeEditableValue, true
+	}
+	return 0, false
+}
+
 // An object for rendering a single cell
 //
 // The `GtkCellRenderer` is a base class of a set of objects used for
@@ -415,8 +531,8 @@ const (
 // draw many cells on the screen.  To this extent, it isn’t expected that a
 // CellRenderer keep any permanent state around.  Instead, any state is set
 // just prior to use using `GObject`s property system.  Then, the
-// cell is measured using gtk_cell_renderer_get_preferred_size(). Finally, the cell
-// is rendered in the correct location using gtk_cell_renderer_snapshot().
+// cell is measured using [CellRenderer.GetPreferredSize]. Finally, the cell
+// is rendered in the correct location using [CellRenderer.Snapshot].
 //
 // There are a number of rules that must be followed when writing a new
 // `GtkCellRenderer`.  First and foremost, it’s important that a certain set
@@ -439,6 +555,10 @@ const (
 // corresponding “set” property, e.g. “cell-background-set” corresponds
 // to “cell-background”. These “set” properties reflect whether a property
 // has been set or not. You should not set them independently.
+//
+// Deprecated: since 4.10. List views use widgets for displaying their
+//
+//	contents
 type CellRenderer struct {
 	gobject.InitiallyUnowned
 }
@@ -455,279 +575,6 @@ func CellRendererNewFromInternalPtr(ptr uintptr) *CellRenderer {
 	return cls
 }
 
-var xCellRendererActivate func(uintptr, uintptr, uintptr, string, *gdk.Rectangle, *gdk.Rectangle, CellRendererState) bool
-
-// Passes an activate event to the cell renderer for possible processing.
-// Some cell renderers may use events; for example, `GtkCellRendererToggle`
-// toggles when it gets a mouse click.
-func (x *CellRenderer) Activate(EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) bool {
-
-	cret := xCellRendererActivate(x.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
-	return cret
-}
-
-var xCellRendererGetAlignedArea func(uintptr, uintptr, CellRendererState, *gdk.Rectangle, *gdk.Rectangle)
-
-// Gets the aligned area used by @cell inside @cell_area. Used for finding
-// the appropriate edit and focus rectangle.
-func (x *CellRenderer) GetAlignedArea(WidgetVar *Widget, FlagsVar CellRendererState, CellAreaVar *gdk.Rectangle, AlignedAreaVar *gdk.Rectangle) {
-
-	xCellRendererGetAlignedArea(x.GoPointer(), WidgetVar.GoPointer(), FlagsVar, CellAreaVar, AlignedAreaVar)
-
-}
-
-var xCellRendererGetAlignment func(uintptr, *float32, *float32)
-
-// Fills in @xalign and @yalign with the appropriate values of @cell.
-func (x *CellRenderer) GetAlignment(XalignVar *float32, YalignVar *float32) {
-
-	xCellRendererGetAlignment(x.GoPointer(), XalignVar, YalignVar)
-
-}
-
-var xCellRendererGetFixedSize func(uintptr, *int, *int)
-
-// Fills in @width and @height with the appropriate size of @cell.
-func (x *CellRenderer) GetFixedSize(WidthVar *int, HeightVar *int) {
-
-	xCellRendererGetFixedSize(x.GoPointer(), WidthVar, HeightVar)
-
-}
-
-var xCellRendererGetIsExpanded func(uintptr) bool
-
-// Checks whether the given `GtkCellRenderer` is expanded.
-func (x *CellRenderer) GetIsExpanded() bool {
-
-	cret := xCellRendererGetIsExpanded(x.GoPointer())
-	return cret
-}
-
-var xCellRendererGetIsExpander func(uintptr) bool
-
-// Checks whether the given `GtkCellRenderer` is an expander.
-func (x *CellRenderer) GetIsExpander() bool {
-
-	cret := xCellRendererGetIsExpander(x.GoPointer())
-	return cret
-}
-
-var xCellRendererGetPadding func(uintptr, *int, *int)
-
-// Fills in @xpad and @ypad with the appropriate values of @cell.
-func (x *CellRenderer) GetPadding(XpadVar *int, YpadVar *int) {
-
-	xCellRendererGetPadding(x.GoPointer(), XpadVar, YpadVar)
-
-}
-
-var xCellRendererGetPreferredHeight func(uintptr, uintptr, *int, *int)
-
-// Retrieves a renderer’s natural size when rendered to @widget.
-func (x *CellRenderer) GetPreferredHeight(WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
-
-	xCellRendererGetPreferredHeight(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
-
-}
-
-var xCellRendererGetPreferredHeightForWidth func(uintptr, uintptr, int, *int, *int)
-
-// Retrieves a cell renderers’s minimum and natural height if it were rendered to
-// @widget with the specified @width.
-func (x *CellRenderer) GetPreferredHeightForWidth(WidgetVar *Widget, WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
-
-	xCellRendererGetPreferredHeightForWidth(x.GoPointer(), WidgetVar.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
-
-}
-
-var xCellRendererGetPreferredSize func(uintptr, uintptr, *Requisition, *Requisition)
-
-// Retrieves the minimum and natural size of a cell taking
-// into account the widget’s preference for height-for-width management.
-func (x *CellRenderer) GetPreferredSize(WidgetVar *Widget, MinimumSizeVar *Requisition, NaturalSizeVar *Requisition) {
-
-	xCellRendererGetPreferredSize(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
-
-}
-
-var xCellRendererGetPreferredWidth func(uintptr, uintptr, *int, *int)
-
-// Retrieves a renderer’s natural size when rendered to @widget.
-func (x *CellRenderer) GetPreferredWidth(WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
-
-	xCellRendererGetPreferredWidth(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
-
-}
-
-var xCellRendererGetPreferredWidthForHeight func(uintptr, uintptr, int, *int, *int)
-
-// Retrieves a cell renderers’s minimum and natural width if it were rendered to
-// @widget with the specified @height.
-func (x *CellRenderer) GetPreferredWidthForHeight(WidgetVar *Widget, HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
-
-	xCellRendererGetPreferredWidthForHeight(x.GoPointer(), WidgetVar.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
-
-}
-
-var xCellRendererGetRequestMode func(uintptr) SizeRequestMode
-
-// Gets whether the cell renderer prefers a height-for-width layout
-// or a width-for-height layout.
-func (x *CellRenderer) GetRequestMode() SizeRequestMode {
-
-	cret := xCellRendererGetRequestMode(x.GoPointer())
-	return cret
-}
-
-var xCellRendererGetSensitive func(uintptr) bool
-
-// Returns the cell renderer’s sensitivity.
-func (x *CellRenderer) GetSensitive() bool {
-
-	cret := xCellRendererGetSensitive(x.GoPointer())
-	return cret
-}
-
-var xCellRendererGetState func(uintptr, uintptr, CellRendererState) StateFlags
-
-// Translates the cell renderer state to `GtkStateFlags`,
-// based on the cell renderer and widget sensitivity, and
-// the given `GtkCellRenderer`State.
-func (x *CellRenderer) GetState(WidgetVar *Widget, CellStateVar CellRendererState) StateFlags {
-
-	cret := xCellRendererGetState(x.GoPointer(), WidgetVar.GoPointer(), CellStateVar)
-	return cret
-}
-
-var xCellRendererGetVisible func(uintptr) bool
-
-// Returns the cell renderer’s visibility.
-func (x *CellRenderer) GetVisible() bool {
-
-	cret := xCellRendererGetVisible(x.GoPointer())
-	return cret
-}
-
-var xCellRendererIsActivatable func(uintptr) bool
-
-// Checks whether the cell renderer can do something when activated.
-func (x *CellRenderer) IsActivatable() bool {
-
-	cret := xCellRendererIsActivatable(x.GoPointer())
-	return cret
-}
-
-var xCellRendererSetAlignment func(uintptr, float32, float32)
-
-// Sets the renderer’s alignment within its available space.
-func (x *CellRenderer) SetAlignment(XalignVar float32, YalignVar float32) {
-
-	xCellRendererSetAlignment(x.GoPointer(), XalignVar, YalignVar)
-
-}
-
-var xCellRendererSetFixedSize func(uintptr, int, int)
-
-// Sets the renderer size to be explicit, independent of the properties set.
-func (x *CellRenderer) SetFixedSize(WidthVar int, HeightVar int) {
-
-	xCellRendererSetFixedSize(x.GoPointer(), WidthVar, HeightVar)
-
-}
-
-var xCellRendererSetIsExpanded func(uintptr, bool)
-
-// Sets whether the given `GtkCellRenderer` is expanded.
-func (x *CellRenderer) SetIsExpanded(IsExpandedVar bool) {
-
-	xCellRendererSetIsExpanded(x.GoPointer(), IsExpandedVar)
-
-}
-
-var xCellRendererSetIsExpander func(uintptr, bool)
-
-// Sets whether the given `GtkCellRenderer` is an expander.
-func (x *CellRenderer) SetIsExpander(IsExpanderVar bool) {
-
-	xCellRendererSetIsExpander(x.GoPointer(), IsExpanderVar)
-
-}
-
-var xCellRendererSetPadding func(uintptr, int, int)
-
-// Sets the renderer’s padding.
-func (x *CellRenderer) SetPadding(XpadVar int, YpadVar int) {
-
-	xCellRendererSetPadding(x.GoPointer(), XpadVar, YpadVar)
-
-}
-
-var xCellRendererSetSensitive func(uintptr, bool)
-
-// Sets the cell renderer’s sensitivity.
-func (x *CellRenderer) SetSensitive(SensitiveVar bool) {
-
-	xCellRendererSetSensitive(x.GoPointer(), SensitiveVar)
-
-}
-
-var xCellRendererSetVisible func(uintptr, bool)
-
-// Sets the cell renderer’s visibility.
-func (x *CellRenderer) SetVisible(VisibleVar bool) {
-
-	xCellRendererSetVisible(x.GoPointer(), VisibleVar)
-
-}
-
-var xCellRendererSnapshot func(uintptr, uintptr, uintptr, *gdk.Rectangle, *gdk.Rectangle, CellRendererState)
-
-// Invokes the virtual render function of the `GtkCellRenderer`. The three
-// passed-in rectangles are areas in @cr. Most renderers will draw within
-// @cell_area; the xalign, yalign, xpad, and ypad fields of the `GtkCellRenderer`
-// should be honored with respect to @cell_area. @background_area includes the
-// blank space around the cell, and also the area containing the tree expander;
-// so the @background_area rectangles for all cells tile to cover the entire
-// @window.
-func (x *CellRenderer) Snapshot(SnapshotVar *Snapshot, WidgetVar *Widget, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) {
-
-	xCellRendererSnapshot(x.GoPointer(), SnapshotVar.GoPointer(), WidgetVar.GoPointer(), BackgroundAreaVar, CellAreaVar, FlagsVar)
-
-}
-
-var xCellRendererStartEditing func(uintptr, uintptr, uintptr, string, *gdk.Rectangle, *gdk.Rectangle, CellRendererState) uintptr
-
-// Starts editing the contents of this @cell, through a new `GtkCellEditable`
-// widget created by the `GtkCellRenderer`Class.start_editing virtual function.
-func (x *CellRenderer) StartEditing(EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) *CellEditableBase {
-	var cls *CellEditableBase
-
-	cret := xCellRendererStartEditing(x.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellEditableBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xCellRendererStopEditing func(uintptr, bool)
-
-// Informs the cell renderer that the editing is stopped.
-// If @canceled is %TRUE, the cell renderer will emit the
-// `GtkCellRenderer`::editing-canceled signal.
-//
-// This function should be called by cell renderer implementations
-// in response to the `GtkCellEditable::editing-done` signal of
-// `GtkCellEditable`.
-func (x *CellRenderer) StopEditing(CanceledVar bool) {
-
-	xCellRendererStopEditing(x.GoPointer(), CanceledVar)
-
-}
-
 func (c *CellRenderer) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -940,51 +787,70 @@ func (x *CellRenderer) GetPropertyYpad() uint {
 // cell.  For example, an editable cell renderer could be written to cancel
 // editing when the user presses Escape.
 //
-// See also: gtk_cell_renderer_stop_editing().
+// See also: [CellRenderer.StopEditing].
+//
+// Deprecated: use ConnectEditingCanceledFunc, which also accepts method values and closures.
 func (x *CellRenderer) ConnectEditingCanceled(cb *func(CellRenderer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "editing-canceled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := CellRenderer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "editing-canceled", cbRefPtr, cbPtr)
+}
+
+var xCellRendererEditingCanceledTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCellRendererEditingCanceledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRenderer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRenderer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "editing-canceled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEditingCanceledFunc connects cb to the "editing-canceled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRenderer) ConnectEditingCanceledFunc(cb func(CellRenderer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "editing-canceled", &xCellRendererEditingCanceledTrampoline, xCellRendererEditingCanceledNewTrampoline, cb)
 }
 
 // This signal gets emitted when a cell starts to be edited.
 // The intended use of this signal is to do special setup
-// on @editable, e.g. adding a `GtkEntryCompletion` or setting
+// on editable, e.g. adding a `GtkEntryCompletion` or setting
 // up additional columns in a `GtkComboBox`.
 //
-// See gtk_cell_editable_start_editing() for information on the lifecycle of
-// the @editable and a way to do setup that doesn’t depend on the @renderer.
+// See [CellEditable.StartEditing] for information on the lifecycle of
+// the editable and a way to do setup that doesn’t depend on the renderer.
 //
 // Note that GTK doesn't guarantee that cell renderers will
 // continue to use the same kind of widget for editing in future
-// releases, therefore you should check the type of @editable
+// releases, therefore you should check the type of editable
 // before doing any specific setup, as in the following example:
 //
-// ```c
-// static void
-// text_editing_started (GtkCellRenderer *cell,
-//
-//	GtkCellEditable *editable,
-//	const char      *path,
-//	gpointer         data)
-//
+//	static void
+//	text_editing_started (GtkCellRenderer *cell,
+//	                      GtkCellEditable *editable,
+//	                      const char      *path,
+//	                      gpointer         data)
 //	{
 //	  if (GTK_IS_ENTRY (editable))
 //	    {
@@ -996,28 +862,48 @@ func (x *CellRenderer) ConnectEditingCanceled(cb *func(CellRenderer)) uint {
 //	    }
 //	}
 //
-// ```
+// Deprecated: use ConnectEditingStartedFunc, which also accepts method values and closures.
 func (x *CellRenderer) ConnectEditingStarted(cb *func(CellRenderer, uintptr, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "editing-started", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, EditableVarp uintptr, PathVarp string) {
+			defer core.RecoverPanic()
+			fa := CellRenderer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, EditableVarp, PathVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "editing-started", cbRefPtr, cbPtr)
+}
+
+var xCellRendererEditingStartedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, EditableVarp uintptr, PathVarp string) {
+func xCellRendererEditingStartedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, EditableVarp uintptr, PathVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRenderer, uintptr, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRenderer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, EditableVarp, PathVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "editing-started", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEditingStartedFunc connects cb to the "editing-started" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRenderer) ConnectEditingStartedFunc(cb func(CellRenderer, uintptr, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "editing-started", &xCellRendererEditingStartedTrampoline, xCellRendererEditingStartedNewTrampoline, cb)
 }
 
 func init() {
@@ -1025,7 +911,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1038,32 +924,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xCellRendererGLibType, libs, "gtk_cell_renderer_get_type")
 
-	core.PuregoSafeRegister(&xCellRendererActivate, libs, "gtk_cell_renderer_activate")
-	core.PuregoSafeRegister(&xCellRendererGetAlignedArea, libs, "gtk_cell_renderer_get_aligned_area")
-	core.PuregoSafeRegister(&xCellRendererGetAlignment, libs, "gtk_cell_renderer_get_alignment")
-	core.PuregoSafeRegister(&xCellRendererGetFixedSize, libs, "gtk_cell_renderer_get_fixed_size")
-	core.PuregoSafeRegister(&xCellRendererGetIsExpanded, libs, "gtk_cell_renderer_get_is_expanded")
-	core.PuregoSafeRegister(&xCellRendererGetIsExpander, libs, "gtk_cell_renderer_get_is_expander")
-	core.PuregoSafeRegister(&xCellRendererGetPadding, libs, "gtk_cell_renderer_get_padding")
-	core.PuregoSafeRegister(&xCellRendererGetPreferredHeight, libs, "gtk_cell_renderer_get_preferred_height")
-	core.PuregoSafeRegister(&xCellRendererGetPreferredHeightForWidth, libs, "gtk_cell_renderer_get_preferred_height_for_width")
-	core.PuregoSafeRegister(&xCellRendererGetPreferredSize, libs, "gtk_cell_renderer_get_preferred_size")
-	core.PuregoSafeRegister(&xCellRendererGetPreferredWidth, libs, "gtk_cell_renderer_get_preferred_width")
-	core.PuregoSafeRegister(&xCellRendererGetPreferredWidthForHeight, libs, "gtk_cell_renderer_get_preferred_width_for_height")
-	core.PuregoSafeRegister(&xCellRendererGetRequestMode, libs, "gtk_cell_renderer_get_request_mode")
-	core.PuregoSafeRegister(&xCellRendererGetSensitive, libs, "gtk_cell_renderer_get_sensitive")
-	core.PuregoSafeRegister(&xCellRendererGetState, libs, "gtk_cell_renderer_get_state")
-	core.PuregoSafeRegister(&xCellRendererGetVisible, libs, "gtk_cell_renderer_get_visible")
-	core.PuregoSafeRegister(&xCellRendererIsActivatable, libs, "gtk_cell_renderer_is_activatable")
-	core.PuregoSafeRegister(&xCellRendererSetAlignment, libs, "gtk_cell_renderer_set_alignment")
-	core.PuregoSafeRegister(&xCellRendererSetFixedSize, libs, "gtk_cell_renderer_set_fixed_size")
-	core.PuregoSafeRegister(&xCellRendererSetIsExpanded, libs, "gtk_cell_renderer_set_is_expanded")
-	core.PuregoSafeRegister(&xCellRendererSetIsExpander, libs, "gtk_cell_renderer_set_is_expander")
-	core.PuregoSafeRegister(&xCellRendererSetPadding, libs, "gtk_cell_renderer_set_padding")
-	core.PuregoSafeRegister(&xCellRendererSetSensitive, libs, "gtk_cell_renderer_set_sensitive")
-	core.PuregoSafeRegister(&xCellRendererSetVisible, libs, "gtk_cell_renderer_set_visible")
-	core.PuregoSafeRegister(&xCellRendererSnapshot, libs, "gtk_cell_renderer_snapshot")
-	core.PuregoSafeRegister(&xCellRendererStartEditing, libs, "gtk_cell_renderer_start_editing")
-	core.PuregoSafeRegister(&xCellRendererStopEditing, libs, "gtk_cell_renderer_stop_editing")
+	gobject.CheckClassSize("GtkCellRendererClass", xCellRendererGLibType, unsafe.Sizeof(CellRendererClass{}))
 
 }
@@ -0,0 +1,379 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xCellRendererActivate func(uintptr, uintptr, uintptr, string, *gdk.Rectangle, *gdk.Rectangle, CellRendererState) bool
+
+// Passes an activate event to the cell renderer for possible processing.
+// Some cell renderers may use events; for example, `GtkCellRendererToggle`
+// toggles when it gets a mouse click.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) Activate(EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) bool {
+
+	cret := xCellRendererActivate(x.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
+	return cret
+}
+
+var xCellRendererGetAlignedArea func(uintptr, uintptr, CellRendererState, *gdk.Rectangle, *gdk.Rectangle)
+
+// Gets the aligned area used by cell inside cell_area. Used for finding
+// the appropriate edit and focus rectangle.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetAlignedArea(WidgetVar *Widget, FlagsVar CellRendererState, CellAreaVar *gdk.Rectangle, AlignedAreaVar *gdk.Rectangle) {
+
+	xCellRendererGetAlignedArea(x.GoPointer(), WidgetVar.GoPointer(), FlagsVar, CellAreaVar, AlignedAreaVar)
+
+}
+
+var xCellRendererGetAlignment func(uintptr, *float32, *float32)
+
+// Fills in xalign and yalign with the appropriate values of cell.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetAlignment(XalignVar *float32, YalignVar *float32) {
+
+	xCellRendererGetAlignment(x.GoPointer(), XalignVar, YalignVar)
+
+}
+
+var xCellRendererGetFixedSize func(uintptr, *int, *int)
+
+// Fills in width and height with the appropriate size of cell.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetFixedSize(WidthVar *int, HeightVar *int) {
+
+	xCellRendererGetFixedSize(x.GoPointer(), WidthVar, HeightVar)
+
+}
+
+var xCellRendererGetIsExpanded func(uintptr) bool
+
+// Checks whether the given `GtkCellRenderer` is expanded.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetIsExpanded() bool {
+
+	cret := xCellRendererGetIsExpanded(x.GoPointer())
+	return cret
+}
+
+var xCellRendererGetIsExpander func(uintptr) bool
+
+// Checks whether the given `GtkCellRenderer` is an expander.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetIsExpander() bool {
+
+	cret := xCellRendererGetIsExpander(x.GoPointer())
+	return cret
+}
+
+var xCellRendererGetPadding func(uintptr, *int, *int)
+
+// Fills in xpad and ypad with the appropriate values of cell.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPadding(XpadVar *int, YpadVar *int) {
+
+	xCellRendererGetPadding(x.GoPointer(), XpadVar, YpadVar)
+
+}
+
+var xCellRendererGetPreferredHeight func(uintptr, uintptr, *int, *int)
+
+// Retrieves a renderer’s natural size when rendered to widget.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPreferredHeight(WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
+
+	xCellRendererGetPreferredHeight(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
+
+}
+
+var xCellRendererGetPreferredHeightForWidth func(uintptr, uintptr, int, *int, *int)
+
+// Retrieves a cell renderers’s minimum and natural height if it were rendered to
+// widget with the specified width.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPreferredHeightForWidth(WidgetVar *Widget, WidthVar int, MinimumHeightVar *int, NaturalHeightVar *int) {
+
+	xCellRendererGetPreferredHeightForWidth(x.GoPointer(), WidgetVar.GoPointer(), WidthVar, MinimumHeightVar, NaturalHeightVar)
+
+}
+
+var xCellRendererGetPreferredSize func(uintptr, uintptr, *Requisition, *Requisition)
+
+// Retrieves the minimum and natural size of a cell taking
+// into account the widget’s preference for height-for-width management.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPreferredSize(WidgetVar *Widget, MinimumSizeVar *Requisition, NaturalSizeVar *Requisition) {
+
+	xCellRendererGetPreferredSize(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
+
+}
+
+var xCellRendererGetPreferredWidth func(uintptr, uintptr, *int, *int)
+
+// Retrieves a renderer’s natural size when rendered to widget.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPreferredWidth(WidgetVar *Widget, MinimumSizeVar *int, NaturalSizeVar *int) {
+
+	xCellRendererGetPreferredWidth(x.GoPointer(), WidgetVar.GoPointer(), MinimumSizeVar, NaturalSizeVar)
+
+}
+
+var xCellRendererGetPreferredWidthForHeight func(uintptr, uintptr, int, *int, *int)
+
+// Retrieves a cell renderers’s minimum and natural width if it were rendered to
+// widget with the specified height.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetPreferredWidthForHeight(WidgetVar *Widget, HeightVar int, MinimumWidthVar *int, NaturalWidthVar *int) {
+
+	xCellRendererGetPreferredWidthForHeight(x.GoPointer(), WidgetVar.GoPointer(), HeightVar, MinimumWidthVar, NaturalWidthVar)
+
+}
+
+var xCellRendererGetRequestMode func(uintptr) SizeRequestMode
+
+// Gets whether the cell renderer prefers a height-for-width layout
+// or a width-for-height layout.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetRequestMode() SizeRequestMode {
+
+	cret := xCellRendererGetRequestMode(x.GoPointer())
+	return cret
+}
+
+var xCellRendererGetSensitive func(uintptr) bool
+
+// Returns the cell renderer’s sensitivity.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetSensitive() bool {
+
+	cret := xCellRendererGetSensitive(x.GoPointer())
+	return cret
+}
+
+var xCellRendererGetState func(uintptr, uintptr, CellRendererState) StateFlags
+
+// Translates the cell renderer state to `GtkStateFlags`,
+// based on the cell renderer and widget sensitivity, and
+// the given `GtkCellRenderer`State.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetState(WidgetVar *Widget, CellStateVar CellRendererState) StateFlags {
+
+	cret := xCellRendererGetState(x.GoPointer(), WidgetVar.GoPointer(), CellStateVar)
+	return cret
+}
+
+var xCellRendererGetVisible func(uintptr) bool
+
+// Returns the cell renderer’s visibility.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) GetVisible() bool {
+
+	cret := xCellRendererGetVisible(x.GoPointer())
+	return cret
+}
+
+var xCellRendererIsActivatable func(uintptr) bool
+
+// Checks whether the cell renderer can do something when activated.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) IsActivatable() bool {
+
+	cret := xCellRendererIsActivatable(x.GoPointer())
+	return cret
+}
+
+var xCellRendererSetAlignment func(uintptr, float32, float32)
+
+// Sets the renderer’s alignment within its available space.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetAlignment(XalignVar float32, YalignVar float32) {
+
+	xCellRendererSetAlignment(x.GoPointer(), XalignVar, YalignVar)
+
+}
+
+var xCellRendererSetFixedSize func(uintptr, int, int)
+
+// Sets the renderer size to be explicit, independent of the properties set.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetFixedSize(WidthVar int, HeightVar int) {
+
+	xCellRendererSetFixedSize(x.GoPointer(), WidthVar, HeightVar)
+
+}
+
+var xCellRendererSetIsExpanded func(uintptr, bool)
+
+// Sets whether the given `GtkCellRenderer` is expanded.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetIsExpanded(IsExpandedVar bool) {
+
+	xCellRendererSetIsExpanded(x.GoPointer(), IsExpandedVar)
+
+}
+
+var xCellRendererSetIsExpander func(uintptr, bool)
+
+// Sets whether the given `GtkCellRenderer` is an expander.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetIsExpander(IsExpanderVar bool) {
+
+	xCellRendererSetIsExpander(x.GoPointer(), IsExpanderVar)
+
+}
+
+var xCellRendererSetPadding func(uintptr, int, int)
+
+// Sets the renderer’s padding.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetPadding(XpadVar int, YpadVar int) {
+
+	xCellRendererSetPadding(x.GoPointer(), XpadVar, YpadVar)
+
+}
+
+var xCellRendererSetSensitive func(uintptr, bool)
+
+// Sets the cell renderer’s sensitivity.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetSensitive(SensitiveVar bool) {
+
+	xCellRendererSetSensitive(x.GoPointer(), SensitiveVar)
+
+}
+
+var xCellRendererSetVisible func(uintptr, bool)
+
+// Sets the cell renderer’s visibility.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) SetVisible(VisibleVar bool) {
+
+	xCellRendererSetVisible(x.GoPointer(), VisibleVar)
+
+}
+
+var xCellRendererSnapshot func(uintptr, uintptr, uintptr, *gdk.Rectangle, *gdk.Rectangle, CellRendererState)
+
+// Invokes the virtual render function of the `GtkCellRenderer`. The three
+// passed-in rectangles are areas in cr. Most renderers will draw within
+// cell_area; the xalign, yalign, xpad, and ypad fields of the `GtkCellRenderer`
+// should be honored with respect to cell_area. background_area includes the
+// blank space around the cell, and also the area containing the tree expander;
+// so the background_area rectangles for all cells tile to cover the entire
+// window.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) Snapshot(SnapshotVar *Snapshot, WidgetVar *Widget, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) {
+
+	xCellRendererSnapshot(x.GoPointer(), SnapshotVar.GoPointer(), WidgetVar.GoPointer(), BackgroundAreaVar, CellAreaVar, FlagsVar)
+
+}
+
+var xCellRendererStartEditing func(uintptr, uintptr, uintptr, string, *gdk.Rectangle, *gdk.Rectangle, CellRendererState) uintptr
+
+// Starts editing the contents of this cell, through a new `GtkCellEditable`
+// widget created by the `GtkCellRenderer`Class.start_editing virtual function.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) StartEditing(EventVar *gdk.Event, WidgetVar *Widget, PathVar string, BackgroundAreaVar *gdk.Rectangle, CellAreaVar *gdk.Rectangle, FlagsVar CellRendererState) *CellEditableBase {
+	var cls *CellEditableBase
+
+	cret := xCellRendererStartEditing(x.GoPointer(), EventVar.GoPointer(), WidgetVar.GoPointer(), PathVar, BackgroundAreaVar, CellAreaVar, FlagsVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellEditableBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellRendererStopEditing func(uintptr, bool)
+
+// Informs the cell renderer that the editing is stopped.
+// If canceled is true, the cell renderer will emit the
+// `GtkCellRenderer`::editing-canceled signal.
+//
+// This function should be called by cell renderer implementations
+// in response to the `GtkCellEditable::editing-done` signal of
+// `GtkCellEditable`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRenderer) StopEditing(CanceledVar bool) {
+
+	xCellRendererStopEditing(x.GoPointer(), CanceledVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCellRendererActivate, libs, "gtk_cell_renderer_activate", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetAlignedArea, libs, "gtk_cell_renderer_get_aligned_area", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetAlignment, libs, "gtk_cell_renderer_get_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetFixedSize, libs, "gtk_cell_renderer_get_fixed_size", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetIsExpanded, libs, "gtk_cell_renderer_get_is_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetIsExpander, libs, "gtk_cell_renderer_get_is_expander", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPadding, libs, "gtk_cell_renderer_get_padding", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPreferredHeight, libs, "gtk_cell_renderer_get_preferred_height", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPreferredHeightForWidth, libs, "gtk_cell_renderer_get_preferred_height_for_width", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPreferredSize, libs, "gtk_cell_renderer_get_preferred_size", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPreferredWidth, libs, "gtk_cell_renderer_get_preferred_width", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetPreferredWidthForHeight, libs, "gtk_cell_renderer_get_preferred_width_for_height", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetRequestMode, libs, "gtk_cell_renderer_get_request_mode", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetSensitive, libs, "gtk_cell_renderer_get_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetState, libs, "gtk_cell_renderer_get_state", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererGetVisible, libs, "gtk_cell_renderer_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererIsActivatable, libs, "gtk_cell_renderer_is_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetAlignment, libs, "gtk_cell_renderer_set_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetFixedSize, libs, "gtk_cell_renderer_set_fixed_size", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetIsExpanded, libs, "gtk_cell_renderer_set_is_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetIsExpander, libs, "gtk_cell_renderer_set_is_expander", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetPadding, libs, "gtk_cell_renderer_set_padding", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetSensitive, libs, "gtk_cell_renderer_set_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSetVisible, libs, "gtk_cell_renderer_set_visible", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererSnapshot, libs, "gtk_cell_renderer_snapshot", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererStartEditing, libs, "gtk_cell_renderer_start_editing", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererStopEditing, libs, "gtk_cell_renderer_stop_editing", "GTK", "")
+
+}
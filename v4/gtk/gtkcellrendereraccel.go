@@ -2,9 +2,9 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -12,7 +12,9 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The available modes for [property@Gtk.CellRendererAccel:accel-mode].
+// The available modes for CellRendererAccel:accel-mode.
+//
+// Deprecated: since 4.20. There is no replacement
 type CellRendererAccelMode int
 
 var xCellRendererAccelModeGLibType func() types.GType
@@ -29,11 +31,49 @@ const (
 	CellRendererAccelModeOtherValue CellRendererAccelMode = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CellRendererAccelMode) String() string {
+	switch x {
+	case CellRendererAccelModeGtkValue:
+		return "GTK_CELL_RENDERER_ACCEL_MODE_GTK"
+	case CellRendererAccelModeOtherValue:
+		return "GTK_CELL_RENDERER_ACCEL_MODE_OTHER"
+	}
+	return fmt.Sprintf("CellRendererAccelMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CellRendererAccelMode) Nick() string {
+	switch x {
+	case CellRendererAccelModeGtkValue:
+		return "gtk"
+	case CellRendererAccelModeOtherValue:
+		return "other"
+	}
+	return ""
+}
+
+// CellRendererAccelModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CellRendererAccelModeFromString(s string) (CellRendererAccelMode, bool) {
+	switch s {
+	case "GTK_CELL_RENDERER_ACCEL_MODE_GTK", "gtk":
+		return CellRendererAccelModeGtkValue, true
+	case "GTK_CELL_RENDERER_ACCEL_MODE_OTHER", "other":
+		return CellRendererAccelModeOtherValue, true
+	}
+	return 0, false
+}
+
 // Renders a keyboard accelerator in a cell
 //
 // `GtkCellRendererAccel` displays a keyboard accelerator (i.e. a key
 // combination like `Control + a`). If the cell renderer is editable,
 // the accelerator can be changed by simply typing the new combination.
+//
+// Deprecated: since 4.10. Applications editing keyboard accelerators should
+//
+//	provide their own implementation according to platform design
+//	guidelines
 type CellRendererAccel struct {
 	CellRendererText
 }
@@ -50,23 +90,6 @@ func CellRendererAccelNewFromInternalPtr(ptr uintptr) *CellRendererAccel {
 	return cls
 }
 
-var xNewCellRendererAccel func() uintptr
-
-// Creates a new `GtkCellRendererAccel`.
-func NewCellRendererAccel() *CellRendererAccel {
-	var cls *CellRendererAccel
-
-	cret := xNewCellRendererAccel()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererAccel{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererAccel) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -117,51 +140,95 @@ func (x *CellRendererAccel) GetPropertyKeycode() uint {
 }
 
 // Gets emitted when the user has removed the accelerator.
+//
+// Deprecated: use ConnectAccelClearedFunc, which also accepts method values and closures.
 func (x *CellRendererAccel) ConnectAccelCleared(cb *func(CellRendererAccel, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accel-cleared", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathStringVarp string) {
+			defer core.RecoverPanic()
+			fa := CellRendererAccel{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathStringVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accel-cleared", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PathStringVarp string) {
+var xCellRendererAccelAccelClearedTrampoline uintptr
+
+func xCellRendererAccelAccelClearedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathStringVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRendererAccel, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRendererAccel{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathStringVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accel-cleared", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAccelClearedFunc connects cb to the "accel-cleared" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRendererAccel) ConnectAccelClearedFunc(cb func(CellRendererAccel, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accel-cleared", &xCellRendererAccelAccelClearedTrampoline, xCellRendererAccelAccelClearedNewTrampoline, cb)
 }
 
 // Gets emitted when the user has selected a new accelerator.
+//
+// Deprecated: use ConnectAccelEditedFunc, which also accepts method values and closures.
 func (x *CellRendererAccel) ConnectAccelEdited(cb *func(CellRendererAccel, string, uint, gdk.ModifierType, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accel-edited", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathStringVarp string, AccelKeyVarp uint, AccelModsVarp gdk.ModifierType, HardwareKeycodeVarp uint) {
+			defer core.RecoverPanic()
+			fa := CellRendererAccel{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathStringVarp, AccelKeyVarp, AccelModsVarp, HardwareKeycodeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accel-edited", cbRefPtr, cbPtr)
+}
+
+var xCellRendererAccelAccelEditedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PathStringVarp string, AccelKeyVarp uint, AccelModsVarp gdk.ModifierType, HardwareKeycodeVarp uint) {
+func xCellRendererAccelAccelEditedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathStringVarp string, AccelKeyVarp uint, AccelModsVarp gdk.ModifierType, HardwareKeycodeVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRendererAccel, string, uint, gdk.ModifierType, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRendererAccel{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathStringVarp, AccelKeyVarp, AccelModsVarp, HardwareKeycodeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accel-edited", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAccelEditedFunc connects cb to the "accel-edited" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRendererAccel) ConnectAccelEditedFunc(cb func(CellRendererAccel, string, uint, gdk.ModifierType, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accel-edited", &xCellRendererAccelAccelEditedTrampoline, xCellRendererAccelAccelEditedNewTrampoline, cb)
 }
 
 func init() {
@@ -169,7 +236,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -180,6 +247,4 @@ func init() {
 
 	core.PuregoSafeRegister(&xCellRendererAccelGLibType, libs, "gtk_cell_renderer_accel_get_type")
 
-	core.PuregoSafeRegister(&xNewCellRendererAccel, libs, "gtk_cell_renderer_accel_new")
-
 }
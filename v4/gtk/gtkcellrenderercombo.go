@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,6 +22,10 @@ import (
 // box and sets it to display the column specified by its
 // `GtkCellRendererCombo`:text-column property. Further properties of the combo box
 // can be set in a handler for the `GtkCellRenderer::editing-started` signal.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents. You
+//
+//	should use [DropDown] instead
 type CellRendererCombo struct {
 	CellRendererText
 }
@@ -39,29 +42,6 @@ func CellRendererComboNewFromInternalPtr(ptr uintptr) *CellRendererCombo {
 	return cls
 }
 
-var xNewCellRendererCombo func() uintptr
-
-// Creates a new `GtkCellRendererCombo`.
-// Adjust how text is drawn using object properties.
-// Object properties can be set globally (with g_object_set()).
-// Also, with `GtkTreeViewColumn`, you can bind a property to a value
-// in a `GtkTreeModel`. For example, you can bind the “text” property
-// on the cell renderer to a string value in the model, thus rendering
-// a different string in each row of the `GtkTreeView`.
-func NewCellRendererCombo() *CellRendererCombo {
-	var cls *CellRendererCombo
-
-	cret := xNewCellRendererCombo()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererCombo{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererCombo) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -74,7 +54,7 @@ func (c *CellRendererCombo) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyHasEntry sets the "has-entry" property.
-// If %TRUE, the cell renderer will include an entry and allow to enter
+// If true, the cell renderer will include an entry and allow to enter
 // values other than the ones in the popup list.
 func (x *CellRendererCombo) SetPropertyHasEntry(value bool) {
 	var v gobject.Value
@@ -84,7 +64,7 @@ func (x *CellRendererCombo) SetPropertyHasEntry(value bool) {
 }
 
 // GetPropertyHasEntry gets the "has-entry" property.
-// If %TRUE, the cell renderer will include an entry and allow to enter
+// If true, the cell renderer will include an entry and allow to enter
 // values other than the ones in the popup list.
 func (x *CellRendererCombo) GetPropertyHasEntry() bool {
 	var v gobject.Value
@@ -128,7 +108,7 @@ func (x *CellRendererCombo) GetPropertyTextColumn() int {
 // This signal is emitted each time after the user selected an item in
 // the combo box, either by using the mouse or the arrow keys.  Contrary
 // to GtkComboBox, GtkCellRendererCombo::changed is not emitted for
-// changes made to a selected item in the entry.  The argument @new_iter
+// changes made to a selected item in the entry.  The argument new_iter
 // corresponds to the newly selected item in the combo box and it is relative
 // to the GtkTreeModel set via the model property on GtkCellRendererCombo.
 //
@@ -136,43 +116,47 @@ func (x *CellRendererCombo) GetPropertyTextColumn() int {
 // the tree view will immediately cease the editing operating.  This
 // means that you most probably want to refrain from changing the model
 // until the combo cell renderer emits the edited or editing_canceled signal.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *CellRendererCombo) ConnectChanged(cb *func(CellRendererCombo, string, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathStringVarp string, NewIterVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := CellRendererCombo{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathStringVarp, NewIterVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PathStringVarp string, NewIterVarp uintptr) {
+var xCellRendererComboChangedTrampoline uintptr
+
+func xCellRendererComboChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathStringVarp string, NewIterVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRendererCombo, string, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRendererCombo{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathStringVarp, NewIterVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererComboGLibType, libs, "gtk_cell_renderer_combo_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererCombo, libs, "gtk_cell_renderer_combo_new")
-
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRendererCombo) ConnectChangedFunc(cb func(CellRendererCombo, string, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xCellRendererComboChangedTrampoline, xCellRendererComboChangedNewTrampoline, cb)
 }
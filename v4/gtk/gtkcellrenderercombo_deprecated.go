@@ -0,0 +1,50 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellRendererCombo func() uintptr
+
+// Creates a new `GtkCellRendererCombo`.
+// Adjust how text is drawn using object properties.
+// Object properties can be set globally (with [gobject.Object.Set]).
+// Also, with `GtkTreeViewColumn`, you can bind a property to a value
+// in a `GtkTreeModel`. For example, you can bind the “text” property
+// on the cell renderer to a string value in the model, thus rendering
+// a different string in each row of the `GtkTreeView`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellRendererCombo() *CellRendererCombo {
+	var cls *CellRendererCombo
+
+	cret := xNewCellRendererCombo()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRendererCombo{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellRendererCombo, libs, "gtk_cell_renderer_combo_new", "GTK", "")
+
+}
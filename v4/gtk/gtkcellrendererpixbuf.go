@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -16,12 +14,16 @@ import (
 // `GtkCellRendererPixbuf:icon-name` property).
 //
 // To support the tree view, `GtkCellRendererPixbuf` also supports rendering two
-// alternative pixbufs, when the `GtkCellRenderer:is-expander` property is %TRUE.
-// If the `GtkCellRenderer:is-expanded property` is %TRUE and the
+// alternative pixbufs, when the `GtkCellRenderer:is-expander` property is true.
+// If the `GtkCellRenderer:is-expanded property` is true and the
 // `GtkCellRendererPixbuf:pixbuf-expander-open` property is set to a pixbuf, it
-// renders that pixbuf, if the `GtkCellRenderer:is-expanded` property is %FALSE
+// renders that pixbuf, if the `GtkCellRenderer:is-expanded` property is false
 // and the `GtkCellRendererPixbuf:pixbuf-expander-closed` property is set to a
 // pixbuf, it renders that one.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents. You
+//
+//	should use [Image] for icons, and [Picture] for images
 type CellRendererPixbuf struct {
 	CellRenderer
 }
@@ -38,29 +40,6 @@ func CellRendererPixbufNewFromInternalPtr(ptr uintptr) *CellRendererPixbuf {
 	return cls
 }
 
-var xNewCellRendererPixbuf func() uintptr
-
-// Creates a new `GtkCellRendererPixbuf`. Adjust rendering
-// parameters using object properties. Object properties can be set
-// globally (with g_object_set()). Also, with `GtkTreeViewColumn`, you
-// can bind a property to a value in a `GtkTreeModel`. For example, you
-// can bind the “pixbuf” property on the cell renderer to a pixbuf value
-// in the model, thus rendering a different image in each row of the
-// `GtkTreeView`.
-func NewCellRendererPixbuf() *CellRendererPixbuf {
-	var cls *CellRendererPixbuf
-
-	cret := xNewCellRendererPixbuf()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererPixbuf{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererPixbuf) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -90,21 +69,3 @@ func (x *CellRendererPixbuf) GetPropertyIconName() string {
 	x.GetProperty("icon-name", &v)
 	return v.GetString()
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererPixbufGLibType, libs, "gtk_cell_renderer_pixbuf_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererPixbuf, libs, "gtk_cell_renderer_pixbuf_new")
-
-}
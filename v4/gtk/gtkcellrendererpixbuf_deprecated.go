@@ -0,0 +1,50 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellRendererPixbuf func() uintptr
+
+// Creates a new `GtkCellRendererPixbuf`. Adjust rendering
+// parameters using object properties. Object properties can be set
+// globally (with [gobject.Object.Set]). Also, with `GtkTreeViewColumn`, you
+// can bind a property to a value in a `GtkTreeModel`. For example, you
+// can bind the “pixbuf” property on the cell renderer to a pixbuf value
+// in the model, thus rendering a different image in each row of the
+// `GtkTreeView`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellRendererPixbuf() *CellRendererPixbuf {
+	var cls *CellRendererPixbuf
+
+	cret := xNewCellRendererPixbuf()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRendererPixbuf{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellRendererPixbuf, libs, "gtk_cell_renderer_pixbuf_new", "GTK", "")
+
+}
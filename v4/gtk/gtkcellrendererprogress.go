@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -12,6 +10,10 @@ import (
 //
 // `GtkCellRendererProgress` renders a numeric value as a progress par in a cell.
 // Additionally, it can display a text on top of the progress bar.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You should use [ProgressBar] instead
 type CellRendererProgress struct {
 	CellRenderer
 }
@@ -28,23 +30,6 @@ func CellRendererProgressNewFromInternalPtr(ptr uintptr) *CellRendererProgress {
 	return cls
 }
 
-var xNewCellRendererProgress func() uintptr
-
-// Creates a new `GtkCellRendererProgress`.
-func NewCellRendererProgress() *CellRendererProgress {
-	var cls *CellRendererProgress
-
-	cret := xNewCellRendererProgress()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererProgress{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererProgress) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -83,7 +68,7 @@ func (x *CellRendererProgress) GetPropertyInverted() bool {
 // bit.
 //
 // To indicate that the activity has not started yet, set the property
-// to zero. To indicate completion, set the property to %G_MAXINT.
+// to zero. To indicate completion, set the property to G_MAXINT.
 func (x *CellRendererProgress) SetPropertyPulse(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -101,7 +86,7 @@ func (x *CellRendererProgress) SetPropertyPulse(value int) {
 // bit.
 //
 // To indicate that the activity has not started yet, set the property
-// to zero. To indicate completion, set the property to %G_MAXINT.
+// to zero. To indicate completion, set the property to G_MAXINT.
 func (x *CellRendererProgress) GetPropertyPulse() int {
 	var v gobject.Value
 	x.GetProperty("pulse", &v)
@@ -110,7 +95,7 @@ func (x *CellRendererProgress) GetPropertyPulse() int {
 
 // SetPropertyText sets the "text" property.
 // The "text" property determines the label which will be drawn
-// over the progress bar. Setting this property to %NULL causes the default
+// over the progress bar. Setting this property to nil causes the default
 // label to be displayed. Setting this property to an empty string causes
 // no label to be displayed.
 func (x *CellRendererProgress) SetPropertyText(value string) {
@@ -122,7 +107,7 @@ func (x *CellRendererProgress) SetPropertyText(value string) {
 
 // GetPropertyText gets the "text" property.
 // The "text" property determines the label which will be drawn
-// over the progress bar. Setting this property to %NULL causes the default
+// over the progress bar. Setting this property to nil causes the default
 // label to be displayed. Setting this property to an empty string causes
 // no label to be displayed.
 func (x *CellRendererProgress) GetPropertyText() string {
@@ -192,34 +177,16 @@ func (x *CellRendererProgress) GetPropertyValue() int {
 	return v.GetInt()
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *CellRendererProgress) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *CellRendererProgress) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererProgressGLibType, libs, "gtk_cell_renderer_progress_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererProgress, libs, "gtk_cell_renderer_progress_new")
-
-}
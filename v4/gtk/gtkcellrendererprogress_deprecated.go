@@ -0,0 +1,44 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellRendererProgress func() uintptr
+
+// Creates a new `GtkCellRendererProgress`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellRendererProgress() *CellRendererProgress {
+	var cls *CellRendererProgress
+
+	cret := xNewCellRendererProgress()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRendererProgress{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellRendererProgress, libs, "gtk_cell_renderer_progress_new", "GTK", "")
+
+}
@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -21,6 +19,10 @@ import (
 // also has properties for the `GtkCellRendererSpin:climb-rate` and the number
 // of `GtkCellRendererSpin:digits` to display. Other `GtkSpinButton` properties
 // can be set in a handler for the `GtkCellRenderer::editing-started` signal.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You should use [SpinButton] instead
 type CellRendererSpin struct {
 	CellRendererText
 }
@@ -37,23 +39,6 @@ func CellRendererSpinNewFromInternalPtr(ptr uintptr) *CellRendererSpin {
 	return cls
 }
 
-var xNewCellRendererSpin func() uintptr
-
-// Creates a new `GtkCellRendererSpin`.
-func NewCellRendererSpin() *CellRendererSpin {
-	var cls *CellRendererSpin
-
-	cret := xNewCellRendererSpin()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererSpin{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererSpin) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -98,21 +83,3 @@ func (x *CellRendererSpin) GetPropertyDigits() uint {
 	x.GetProperty("digits", &v)
 	return v.GetUint()
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererSpinGLibType, libs, "gtk_cell_renderer_spin_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererSpin, libs, "gtk_cell_renderer_spin_new")
-
-}
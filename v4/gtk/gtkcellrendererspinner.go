@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -16,10 +14,14 @@ import (
 // instead of actual progress.
 //
 // To start the animation in a cell, set the `GtkCellRendererSpinner:active`
-// property to %TRUE and increment the `GtkCellRendererSpinner:pulse` property
+// property to true and increment the `GtkCellRendererSpinner:pulse` property
 // at regular intervals. The usual way to set the cell renderer properties
 // for each cell is to bind them to columns in your tree model using e.g.
-// gtk_tree_view_column_add_attribute().
+// [TreeViewColumn.AddAttribute].
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You should use [Spinner] instead
 type CellRendererSpinner struct {
 	CellRenderer
 }
@@ -36,24 +38,6 @@ func CellRendererSpinnerNewFromInternalPtr(ptr uintptr) *CellRendererSpinner {
 	return cls
 }
 
-var xNewCellRendererSpinner func() uintptr
-
-// Returns a new cell renderer which will show a spinner to indicate
-// activity.
-func NewCellRendererSpinner() *CellRendererSpinner {
-	var cls *CellRendererSpinner
-
-	cret := xNewCellRendererSpinner()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererSpinner{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *CellRendererSpinner) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -106,21 +90,3 @@ func (x *CellRendererSpinner) GetPropertyPulse() uint {
 	x.GetProperty("pulse", &v)
 	return v.GetUint()
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererSpinnerGLibType, libs, "gtk_cell_renderer_spinner_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererSpinner, libs, "gtk_cell_renderer_spinner_new")
-
-}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +30,8 @@ func (x *CellRendererTextClass) OverrideEdited(cb func(*CellRendererText, string
 	if cb == nil {
 		x.xEdited = 0
 	} else {
-		x.xEdited = purego.NewCallback(func(CellRendererTextVarp uintptr, PathVarp string, NewTextVarp string) {
+		x.xEdited = core.NewCallback(func(CellRendererTextVarp uintptr, PathVarp string, NewTextVarp string) {
+			defer core.RecoverPanic()
 			cb(CellRendererTextNewFromInternalPtr(CellRendererTextVarp), PathVarp, NewTextVarp)
 		})
 	}
@@ -43,7 +43,7 @@ func (x *CellRendererTextClass) GetEdited() func(*CellRendererText, string, stri
 		return nil
 	}
 	var rawCallback func(CellRendererTextVarp uintptr, PathVarp string, NewTextVarp string)
-	purego.RegisterFunc(&rawCallback, x.xEdited)
+	core.RegisterFunc(&rawCallback, x.xEdited)
 	return func(CellRendererTextVar *CellRendererText, PathVar string, NewTextVar string) {
 		rawCallback(CellRendererTextVar.GoPointer(), PathVar, NewTextVar)
 	}
@@ -55,8 +55,12 @@ func (x *CellRendererTextClass) GetEdited() func(*CellRendererText, string, stri
 // style information provided by its properties. The text will be ellipsized if it is
 // too long and the `GtkCellRendererText:ellipsize` property allows it.
 //
-// If the `GtkCellRenderer:mode` is %GTK_CELL_RENDERER_MODE_EDITABLE,
+// If the `GtkCellRenderer:mode` is [CellRendererModeEditableValue],
 // the `GtkCellRendererText` allows to edit its text using an entry.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You should use [Inscription] or [Label] instead
 type CellRendererText struct {
 	CellRenderer
 }
@@ -73,44 +77,6 @@ func CellRendererTextNewFromInternalPtr(ptr uintptr) *CellRendererText {
 	return cls
 }
 
-var xNewCellRendererText func() uintptr
-
-// Creates a new `GtkCellRendererText`. Adjust how text is drawn using
-// object properties. Object properties can be
-// set globally (with g_object_set()). Also, with `GtkTreeViewColumn`,
-// you can bind a property to a value in a `GtkTreeModel`. For example,
-// you can bind the “text” property on the cell renderer to a string
-// value in the model, thus rendering a different string in each row
-// of the `GtkTreeView`.
-func NewCellRendererText() *CellRendererText {
-	var cls *CellRendererText
-
-	cret := xNewCellRendererText()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererText{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xCellRendererTextSetFixedHeightFromFont func(uintptr, int)
-
-// Sets the height of a renderer to explicitly be determined by the “font” and
-// “y_pad” property set on it.  Further changes in these properties do not
-// affect the height, so they must be accompanied by a subsequent call to this
-// function.  Using this function is inflexible, and should really only be used
-// if calculating the size of a cell is too slow (ie, a massive number of cells
-// displayed).  If @number_of_rows is -1, then the fixed height is unset, and
-// the height is determined by the properties again.
-func (x *CellRendererText) SetFixedHeightFromFont(NumberOfRowsVar int) {
-
-	xCellRendererTextSetFixedHeightFromFont(x.GoPointer(), NumberOfRowsVar)
-
-}
-
 func (c *CellRendererText) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -408,7 +374,7 @@ func (x *CellRendererText) GetPropertyMaxWidthChars() int {
 
 // SetPropertyPlaceholderText sets the "placeholder-text" property.
 // The text that will be displayed in the `GtkCellRenderer` if
-// `GtkCellRendererText:editable` is %TRUE and the cell is empty.
+// `GtkCellRendererText:editable` is true and the cell is empty.
 func (x *CellRendererText) SetPropertyPlaceholderText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -418,7 +384,7 @@ func (x *CellRendererText) SetPropertyPlaceholderText(value string) {
 
 // GetPropertyPlaceholderText gets the "placeholder-text" property.
 // The text that will be displayed in the `GtkCellRenderer` if
-// `GtkCellRendererText:editable` is %TRUE and the cell is empty.
+// `GtkCellRendererText:editable` is true and the cell is empty.
 func (x *CellRendererText) GetPropertyPlaceholderText() string {
 	var v gobject.Value
 	x.GetProperty("placeholder-text", &v)
@@ -722,49 +688,51 @@ func (x *CellRendererText) GetPropertyWrapWidth() int {
 	return v.GetInt()
 }
 
-// This signal is emitted after @renderer has been edited.
+// This signal is emitted after renderer has been edited.
 //
 // It is the responsibility of the application to update the model
-// and store @new_text at the position indicated by @path.
+// and store new_text at the position indicated by path.
+//
+// Deprecated: use ConnectEditedFunc, which also accepts method values and closures.
 func (x *CellRendererText) ConnectEdited(cb *func(CellRendererText, string, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "edited", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathVarp string, NewTextVarp string) {
+			defer core.RecoverPanic()
+			fa := CellRendererText{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathVarp, NewTextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "edited", cbRefPtr, cbPtr)
+}
+
+var xCellRendererTextEditedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PathVarp string, NewTextVarp string) {
+func xCellRendererTextEditedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathVarp string, NewTextVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRendererText, string, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRendererText{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathVarp, NewTextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "edited", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
-}
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererTextGLibType, libs, "gtk_cell_renderer_text_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererText, libs, "gtk_cell_renderer_text_new")
-
-	core.PuregoSafeRegister(&xCellRendererTextSetFixedHeightFromFont, libs, "gtk_cell_renderer_text_set_fixed_height_from_font")
+}
 
+// ConnectEditedFunc connects cb to the "edited" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRendererText) ConnectEditedFunc(cb func(CellRendererText, string, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "edited", &xCellRendererTextEditedTrampoline, xCellRendererTextEditedNewTrampoline, cb)
 }
@@ -0,0 +1,69 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellRendererText func() uintptr
+
+// Creates a new `GtkCellRendererText`. Adjust how text is drawn using
+// object properties. Object properties can be
+// set globally (with [gobject.Object.Set]). Also, with `GtkTreeViewColumn`,
+// you can bind a property to a value in a `GtkTreeModel`. For example,
+// you can bind the “text” property on the cell renderer to a string
+// value in the model, thus rendering a different string in each row
+// of the `GtkTreeView`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellRendererText() *CellRendererText {
+	var cls *CellRendererText
+
+	cret := xNewCellRendererText()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRendererText{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellRendererTextSetFixedHeightFromFont func(uintptr, int)
+
+// Sets the height of a renderer to explicitly be determined by the “font” and
+// “y_pad” property set on it.  Further changes in these properties do not
+// affect the height, so they must be accompanied by a subsequent call to this
+// function.  Using this function is inflexible, and should really only be used
+// if calculating the size of a cell is too slow (ie, a massive number of cells
+// displayed).  If number_of_rows is -1, then the fixed height is unset, and
+// the height is determined by the properties again.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererText) SetFixedHeightFromFont(NumberOfRowsVar int) {
+
+	xCellRendererTextSetFixedHeightFromFont(x.GoPointer(), NumberOfRowsVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellRendererText, libs, "gtk_cell_renderer_text_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCellRendererTextSetFixedHeightFromFont, libs, "gtk_cell_renderer_text_set_fixed_height_from_font", "GTK", "")
+
+}
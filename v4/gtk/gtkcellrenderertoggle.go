@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -17,6 +16,10 @@ import (
 // button is drawn as a radio or a checkbutton, depending on the
 // `GtkCellRendererToggle:radio` property.
 // When activated, it emits the `GtkCellRendererToggle::toggled` signal.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You should use [ToggleButton] instead
 type CellRendererToggle struct {
 	CellRenderer
 }
@@ -33,91 +36,6 @@ func CellRendererToggleNewFromInternalPtr(ptr uintptr) *CellRendererToggle {
 	return cls
 }
 
-var xNewCellRendererToggle func() uintptr
-
-// Creates a new `GtkCellRendererToggle`. Adjust rendering
-// parameters using object properties. Object properties can be set
-// globally (with g_object_set()). Also, with `GtkTreeViewColumn`, you
-// can bind a property to a value in a `GtkTreeModel`. For example, you
-// can bind the “active” property on the cell renderer to a boolean value
-// in the model, thus causing the check button to reflect the state of
-// the model.
-func NewCellRendererToggle() *CellRendererToggle {
-	var cls *CellRendererToggle
-
-	cret := xNewCellRendererToggle()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &CellRendererToggle{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xCellRendererToggleGetActivatable func(uintptr) bool
-
-// Returns whether the cell renderer is activatable. See
-// gtk_cell_renderer_toggle_set_activatable().
-func (x *CellRendererToggle) GetActivatable() bool {
-
-	cret := xCellRendererToggleGetActivatable(x.GoPointer())
-	return cret
-}
-
-var xCellRendererToggleGetActive func(uintptr) bool
-
-// Returns whether the cell renderer is active. See
-// gtk_cell_renderer_toggle_set_active().
-func (x *CellRendererToggle) GetActive() bool {
-
-	cret := xCellRendererToggleGetActive(x.GoPointer())
-	return cret
-}
-
-var xCellRendererToggleGetRadio func(uintptr) bool
-
-// Returns whether we’re rendering radio toggles rather than checkboxes.
-func (x *CellRendererToggle) GetRadio() bool {
-
-	cret := xCellRendererToggleGetRadio(x.GoPointer())
-	return cret
-}
-
-var xCellRendererToggleSetActivatable func(uintptr, bool)
-
-// Makes the cell renderer activatable.
-func (x *CellRendererToggle) SetActivatable(SettingVar bool) {
-
-	xCellRendererToggleSetActivatable(x.GoPointer(), SettingVar)
-
-}
-
-var xCellRendererToggleSetActive func(uintptr, bool)
-
-// Activates or deactivates a cell renderer.
-func (x *CellRendererToggle) SetActive(SettingVar bool) {
-
-	xCellRendererToggleSetActive(x.GoPointer(), SettingVar)
-
-}
-
-var xCellRendererToggleSetRadio func(uintptr, bool)
-
-// If @radio is %TRUE, the cell renderer renders a radio toggle
-// (i.e. a toggle in a group of mutually-exclusive toggles).
-// If %FALSE, it renders a check toggle (a standalone boolean option).
-// This can be set globally for the cell renderer, or changed just
-// before rendering each cell in the model (for `GtkTreeView`, you set
-// up a per-row setting using `GtkTreeViewColumn` to associate model
-// columns with cell renderer properties).
-func (x *CellRendererToggle) SetRadio(RadioVar bool) {
-
-	xCellRendererToggleSetRadio(x.GoPointer(), RadioVar)
-
-}
-
 func (c *CellRendererToggle) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -192,52 +110,49 @@ func (x *CellRendererToggle) GetPropertyRadio() bool {
 // The ::toggled signal is emitted when the cell is toggled.
 //
 // It is the responsibility of the application to update the model
-// with the correct value to store at @path.  Often this is simply the
-// opposite of the value currently stored at @path.
+// with the correct value to store at path.  Often this is simply the
+// opposite of the value currently stored at path.
+//
+// Deprecated: use ConnectToggledFunc, which also accepts method values and closures.
 func (x *CellRendererToggle) ConnectToggled(cb *func(CellRendererToggle, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathVarp string) {
+			defer core.RecoverPanic()
+			fa := CellRendererToggle{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggled", cbRefPtr, cbPtr)
+}
+
+var xCellRendererToggleToggledTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PathVarp string) {
+func xCellRendererToggleToggledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CellRendererToggle, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CellRendererToggle{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
-}
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xCellRendererToggleGLibType, libs, "gtk_cell_renderer_toggle_get_type")
-
-	core.PuregoSafeRegister(&xNewCellRendererToggle, libs, "gtk_cell_renderer_toggle_new")
-
-	core.PuregoSafeRegister(&xCellRendererToggleGetActivatable, libs, "gtk_cell_renderer_toggle_get_activatable")
-	core.PuregoSafeRegister(&xCellRendererToggleGetActive, libs, "gtk_cell_renderer_toggle_get_active")
-	core.PuregoSafeRegister(&xCellRendererToggleGetRadio, libs, "gtk_cell_renderer_toggle_get_radio")
-	core.PuregoSafeRegister(&xCellRendererToggleSetActivatable, libs, "gtk_cell_renderer_toggle_set_activatable")
-	core.PuregoSafeRegister(&xCellRendererToggleSetActive, libs, "gtk_cell_renderer_toggle_set_active")
-	core.PuregoSafeRegister(&xCellRendererToggleSetRadio, libs, "gtk_cell_renderer_toggle_set_radio")
+}
 
+// ConnectToggledFunc connects cb to the "toggled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CellRendererToggle) ConnectToggledFunc(cb func(CellRendererToggle, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggled", &xCellRendererToggleToggledTrampoline, xCellRendererToggleToggledNewTrampoline, cb)
 }
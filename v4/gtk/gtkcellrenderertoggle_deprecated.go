@@ -0,0 +1,131 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellRendererToggle func() uintptr
+
+// Creates a new `GtkCellRendererToggle`. Adjust rendering
+// parameters using object properties. Object properties can be set
+// globally (with [gobject.Object.Set]). Also, with `GtkTreeViewColumn`, you
+// can bind a property to a value in a `GtkTreeModel`. For example, you
+// can bind the “active” property on the cell renderer to a boolean value
+// in the model, thus causing the check button to reflect the state of
+// the model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellRendererToggle() *CellRendererToggle {
+	var cls *CellRendererToggle
+
+	cret := xNewCellRendererToggle()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellRendererToggle{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellRendererToggleGetActivatable func(uintptr) bool
+
+// Returns whether the cell renderer is activatable. See
+// [CellRendererToggle.SetActivatable].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) GetActivatable() bool {
+
+	cret := xCellRendererToggleGetActivatable(x.GoPointer())
+	return cret
+}
+
+var xCellRendererToggleGetActive func(uintptr) bool
+
+// Returns whether the cell renderer is active. See
+// [CellRendererToggle.SetActive].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) GetActive() bool {
+
+	cret := xCellRendererToggleGetActive(x.GoPointer())
+	return cret
+}
+
+var xCellRendererToggleGetRadio func(uintptr) bool
+
+// Returns whether we’re rendering radio toggles rather than checkboxes.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) GetRadio() bool {
+
+	cret := xCellRendererToggleGetRadio(x.GoPointer())
+	return cret
+}
+
+var xCellRendererToggleSetActivatable func(uintptr, bool)
+
+// Makes the cell renderer activatable.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) SetActivatable(SettingVar bool) {
+
+	xCellRendererToggleSetActivatable(x.GoPointer(), SettingVar)
+
+}
+
+var xCellRendererToggleSetActive func(uintptr, bool)
+
+// Activates or deactivates a cell renderer.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) SetActive(SettingVar bool) {
+
+	xCellRendererToggleSetActive(x.GoPointer(), SettingVar)
+
+}
+
+var xCellRendererToggleSetRadio func(uintptr, bool)
+
+// If radio is true, the cell renderer renders a radio toggle
+// (i.e. a toggle in a group of mutually-exclusive toggles).
+// If false, it renders a check toggle (a standalone boolean option).
+// This can be set globally for the cell renderer, or changed just
+// before rendering each cell in the model (for `GtkTreeView`, you set
+// up a per-row setting using `GtkTreeViewColumn` to associate model
+// columns with cell renderer properties).
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellRendererToggle) SetRadio(RadioVar bool) {
+
+	xCellRendererToggleSetRadio(x.GoPointer(), RadioVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellRendererToggle, libs, "gtk_cell_renderer_toggle_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCellRendererToggleGetActivatable, libs, "gtk_cell_renderer_toggle_get_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererToggleGetActive, libs, "gtk_cell_renderer_toggle_get_active", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererToggleGetRadio, libs, "gtk_cell_renderer_toggle_get_radio", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererToggleSetActivatable, libs, "gtk_cell_renderer_toggle_set_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererToggleSetActive, libs, "gtk_cell_renderer_toggle_set_active", "GTK", "")
+	core.PuregoRegisterSince(&xCellRendererToggleSetRadio, libs, "gtk_cell_renderer_toggle_set_radio", "GTK", "")
+
+}
@@ -0,0 +1,261 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewCellView func() uintptr
+
+// Creates a new `GtkCellView` widget.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellView() *CellView {
+	var cls *CellView
+
+	cret := xNewCellView()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewCellViewWithContext func(uintptr, uintptr) uintptr
+
+// Creates a new `GtkCellView` widget with a specific `GtkCellArea`
+// to layout cells and a specific `GtkCellAreaContext`.
+//
+// Specifying the same context for a handful of cells lets
+// the underlying area synchronize the geometry for those cells,
+// in this way alignments with cellviews for other rows are
+// possible.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellViewWithContext(AreaVar *CellArea, ContextVar *CellAreaContext) *CellView {
+	var cls *CellView
+
+	cret := xNewCellViewWithContext(AreaVar.GoPointer(), ContextVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewCellViewWithMarkup func(string) uintptr
+
+// Creates a new `GtkCellView` widget, adds a `GtkCellRendererText`
+// to it, and makes it show markup. The text can be marked up with
+// the [Pango text markup language].
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// [Pango text markup language]: https://docs.gtk.org/Pango/pango_markup.html
+func NewCellViewWithMarkup(MarkupVar string) *CellView {
+	var cls *CellView
+
+	cret := xNewCellViewWithMarkup(MarkupVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewCellViewWithText func(string) uintptr
+
+// Creates a new `GtkCellView` widget, adds a `GtkCellRendererText`
+// to it, and makes it show text.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellViewWithText(TextVar string) *CellView {
+	var cls *CellView
+
+	cret := xNewCellViewWithText(TextVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewCellViewWithTexture func(uintptr) uintptr
+
+// Creates a new `GtkCellView` widget, adds a `GtkCellRendererPixbuf`
+// to it, and makes it show texture.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewCellViewWithTexture(TextureVar *gdk.Texture) *CellView {
+	var cls *CellView
+
+	cret := xNewCellViewWithTexture(TextureVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &CellView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellViewGetDisplayedRow func(uintptr) *TreePath
+
+// Returns a `GtkTreePath` referring to the currently
+// displayed row. If no row is currently displayed,
+// nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) GetDisplayedRow() *TreePath {
+
+	cret := xCellViewGetDisplayedRow(x.GoPointer())
+	return cret
+}
+
+var xCellViewGetDrawSensitive func(uintptr) bool
+
+// Gets whether cell_view is configured to draw all of its
+// cells in a sensitive state.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) GetDrawSensitive() bool {
+
+	cret := xCellViewGetDrawSensitive(x.GoPointer())
+	return cret
+}
+
+var xCellViewGetFitModel func(uintptr) bool
+
+// Gets whether cell_view is configured to request space
+// to fit the entire `GtkTreeModel`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) GetFitModel() bool {
+
+	cret := xCellViewGetFitModel(x.GoPointer())
+	return cret
+}
+
+var xCellViewGetModel func(uintptr) uintptr
+
+// Returns the model for cell_view. If no model is used nil is
+// returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xCellViewGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCellViewSetDisplayedRow func(uintptr, *TreePath)
+
+// Sets the row of the model that is currently displayed
+// by the `GtkCellView`. If the path is unset, then the
+// contents of the cellview “stick” at their last value;
+// this is not normally a desired result, but may be
+// a needed intermediate state if say, the model for
+// the `GtkCellView` becomes temporarily empty.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) SetDisplayedRow(PathVar *TreePath) {
+
+	xCellViewSetDisplayedRow(x.GoPointer(), PathVar)
+
+}
+
+var xCellViewSetDrawSensitive func(uintptr, bool)
+
+// Sets whether cell_view should draw all of its
+// cells in a sensitive state, this is used by `GtkComboBox` menus
+// to ensure that rows with insensitive cells that contain
+// children appear sensitive in the parent menu item.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) SetDrawSensitive(DrawSensitiveVar bool) {
+
+	xCellViewSetDrawSensitive(x.GoPointer(), DrawSensitiveVar)
+
+}
+
+var xCellViewSetFitModel func(uintptr, bool)
+
+// Sets whether cell_view should request space to fit the entire `GtkTreeModel`.
+//
+// This is used by `GtkComboBox` to ensure that the cell view displayed on
+// the combo box’s button always gets enough space and does not resize
+// when selection changes.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) SetFitModel(FitModelVar bool) {
+
+	xCellViewSetFitModel(x.GoPointer(), FitModelVar)
+
+}
+
+var xCellViewSetModel func(uintptr, uintptr)
+
+// Sets the model for cell_view.  If cell_view already has a model
+// set, it will remove it before setting the new model.  If model is
+// nil, then it will unset the old model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *CellView) SetModel(ModelVar TreeModel) {
+
+	xCellViewSetModel(x.GoPointer(), ModelVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewCellView, libs, "gtk_cell_view_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewCellViewWithContext, libs, "gtk_cell_view_new_with_context", "GTK", "")
+	core.PuregoRegisterSince(&xNewCellViewWithMarkup, libs, "gtk_cell_view_new_with_markup", "GTK", "")
+	core.PuregoRegisterSince(&xNewCellViewWithText, libs, "gtk_cell_view_new_with_text", "GTK", "")
+	core.PuregoRegisterSince(&xNewCellViewWithTexture, libs, "gtk_cell_view_new_with_texture", "GTK", "")
+
+	core.PuregoRegisterSince(&xCellViewGetDisplayedRow, libs, "gtk_cell_view_get_displayed_row", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewGetDrawSensitive, libs, "gtk_cell_view_get_draw_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewGetFitModel, libs, "gtk_cell_view_get_fit_model", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewGetModel, libs, "gtk_cell_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewSetDisplayedRow, libs, "gtk_cell_view_set_displayed_row", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewSetDrawSensitive, libs, "gtk_cell_view_set_draw_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewSetFitModel, libs, "gtk_cell_view_set_fit_model", "GTK", "")
+	core.PuregoRegisterSince(&xCellViewSetModel, libs, "gtk_cell_view_set_model", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,16 +21,9 @@ func (x *CenterBoxClass) GoPointer() uintptr {
 // Arranges three children in a row, keeping the middle child
 // centered as well as possible.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="centerbox-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkCenterBox" src="centerbox.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// To add children to `GtkCenterBox`, use [method@Gtk.CenterBox.set_start_widget],
-// [method@Gtk.CenterBox.set_center_widget] and
-// [method@Gtk.CenterBox.set_end_widget].
+// To add children to `GtkCenterBox`, use [CenterBox.SetStartWidget],
+// [CenterBox.SetCenterWidget] and
+// [CenterBox.SetEndWidget].
 //
 // The sizing and positioning of children can be influenced with the
 // align and expand properties of the children.
@@ -40,7 +32,7 @@ func (x *CenterBoxClass) GoPointer() uintptr {
 //
 // The `GtkCenterBox` implementation of the `GtkBuildable` interface
 // supports placing children in the 3 positions by specifying “start”, “center”
-// or “end” as the “type” attribute of a `&lt;child&gt;` element.
+// or “end” as the “type” attribute of a `<child>` element.
 //
 // # CSS nodes
 //
@@ -55,9 +47,9 @@ func (x *CenterBoxClass) GoPointer() uintptr {
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkCenterBox` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkCenterBox` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkCenterBox` uses the [enum@Gtk.AccessibleRole.generic]
+// Starting from GTK 4.12, `GtkCenterBox` uses the [AccessibleRoleGenericValue]
 // role.
 type CenterBox struct {
 	Widget
@@ -96,7 +88,7 @@ var xCenterBoxGetBaselinePosition func(uintptr) BaselinePosition
 
 // Gets the baseline position of the center box.
 //
-// See [method@Gtk.CenterBox.set_baseline_position].
+// See [CenterBox.SetBaselinePosition].
 func (x *CenterBox) GetBaselinePosition() BaselinePosition {
 
 	cret := xCenterBoxGetBaselinePosition(x.GoPointer())
@@ -170,7 +162,7 @@ var xCenterBoxSetBaselinePosition func(uintptr, BaselinePosition)
 // This affects only horizontal boxes with at least one baseline
 // aligned child. If there is more vertical space available than
 // requested, and the baseline is not allocated by the parent then
-// @position is used to allocate the baseline with respect to the
+// position is used to allocate the baseline with respect to the
 // extra space available.
 func (x *CenterBox) SetBaselinePosition(PositionVar BaselinePosition) {
 
@@ -208,7 +200,7 @@ var xCenterBoxSetShrinkCenterLast func(uintptr, bool)
 // natural widths, the start and end widgets start shrinking and the
 // center child keeps natural width until they reach minimum width.
 //
-// If @shrink_center_last is false, start and end widgets keep natural
+// If shrink_center_last is false, start and end widgets keep natural
 // width and the center widget starts shrinking instead.
 func (x *CenterBox) SetShrinkCenterLast(ShrinkCenterLastVar bool) {
 
@@ -430,7 +422,7 @@ func (x *CenterBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -438,14 +430,13 @@ func (x *CenterBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *CenterBox) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -469,19 +460,17 @@ func (x *CenterBox) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *CenterBox) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -502,7 +491,7 @@ func (x *CenterBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -511,14 +500,12 @@ func (x *CenterBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *CenterBox) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -537,24 +524,24 @@ func (x *CenterBox) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *CenterBox) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *CenterBox) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *CenterBox) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -566,7 +553,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -575,17 +562,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xCenterBoxGLibType, libs, "gtk_center_box_get_type")
 
-	core.PuregoSafeRegister(&xNewCenterBox, libs, "gtk_center_box_new")
-
-	core.PuregoSafeRegister(&xCenterBoxGetBaselinePosition, libs, "gtk_center_box_get_baseline_position")
-	core.PuregoSafeRegister(&xCenterBoxGetCenterWidget, libs, "gtk_center_box_get_center_widget")
-	core.PuregoSafeRegister(&xCenterBoxGetEndWidget, libs, "gtk_center_box_get_end_widget")
-	core.PuregoSafeRegister(&xCenterBoxGetShrinkCenterLast, libs, "gtk_center_box_get_shrink_center_last")
-	core.PuregoSafeRegister(&xCenterBoxGetStartWidget, libs, "gtk_center_box_get_start_widget")
-	core.PuregoSafeRegister(&xCenterBoxSetBaselinePosition, libs, "gtk_center_box_set_baseline_position")
-	core.PuregoSafeRegister(&xCenterBoxSetCenterWidget, libs, "gtk_center_box_set_center_widget")
-	core.PuregoSafeRegister(&xCenterBoxSetEndWidget, libs, "gtk_center_box_set_end_widget")
-	core.PuregoSafeRegister(&xCenterBoxSetShrinkCenterLast, libs, "gtk_center_box_set_shrink_center_last")
-	core.PuregoSafeRegister(&xCenterBoxSetStartWidget, libs, "gtk_center_box_set_start_widget")
+	core.PuregoRegisterSince(&xNewCenterBox, libs, "gtk_center_box_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCenterBoxGetBaselinePosition, libs, "gtk_center_box_get_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxGetCenterWidget, libs, "gtk_center_box_get_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxGetEndWidget, libs, "gtk_center_box_get_end_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxGetShrinkCenterLast, libs, "gtk_center_box_get_shrink_center_last", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCenterBoxGetStartWidget, libs, "gtk_center_box_get_start_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxSetBaselinePosition, libs, "gtk_center_box_set_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxSetCenterWidget, libs, "gtk_center_box_set_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxSetEndWidget, libs, "gtk_center_box_set_end_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterBoxSetShrinkCenterLast, libs, "gtk_center_box_set_shrink_center_last", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCenterBoxSetStartWidget, libs, "gtk_center_box_set_start_widget", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -114,7 +113,7 @@ func (x *CenterLayout) GetOrientation() Orientation {
 
 var xCenterLayoutGetShrinkCenterLast func(uintptr) bool
 
-// Gets whether @self shrinks the center widget after other children.
+// Gets whether self shrinks the center widget after other children.
 func (x *CenterLayout) GetShrinkCenterLast() bool {
 
 	cret := xCenterLayoutGetShrinkCenterLast(x.GoPointer())
@@ -140,7 +139,7 @@ func (x *CenterLayout) GetStartWidget() *Widget {
 
 var xCenterLayoutSetBaselinePosition func(uintptr, BaselinePosition)
 
-// Sets the new baseline position of @self
+// Sets the new baseline position of self
 func (x *CenterLayout) SetBaselinePosition(BaselinePositionVar BaselinePosition) {
 
 	xCenterLayoutSetBaselinePosition(x.GoPointer(), BaselinePositionVar)
@@ -149,9 +148,9 @@ func (x *CenterLayout) SetBaselinePosition(BaselinePositionVar BaselinePosition)
 
 var xCenterLayoutSetCenterWidget func(uintptr, uintptr)
 
-// Sets the new center widget of @self.
+// Sets the new center widget of self.
 //
-// To remove the existing center widget, pass %NULL.
+// To remove the existing center widget, pass nil.
 func (x *CenterLayout) SetCenterWidget(WidgetVar *Widget) {
 
 	xCenterLayoutSetCenterWidget(x.GoPointer(), WidgetVar.GoPointer())
@@ -160,9 +159,9 @@ func (x *CenterLayout) SetCenterWidget(WidgetVar *Widget) {
 
 var xCenterLayoutSetEndWidget func(uintptr, uintptr)
 
-// Sets the new end widget of @self.
+// Sets the new end widget of self.
 //
-// To remove the existing center widget, pass %NULL.
+// To remove the existing center widget, pass nil.
 func (x *CenterLayout) SetEndWidget(WidgetVar *Widget) {
 
 	xCenterLayoutSetEndWidget(x.GoPointer(), WidgetVar.GoPointer())
@@ -171,7 +170,7 @@ func (x *CenterLayout) SetEndWidget(WidgetVar *Widget) {
 
 var xCenterLayoutSetOrientation func(uintptr, Orientation)
 
-// Sets the orientation of @self.
+// Sets the orientation of self.
 func (x *CenterLayout) SetOrientation(OrientationVar Orientation) {
 
 	xCenterLayoutSetOrientation(x.GoPointer(), OrientationVar)
@@ -196,9 +195,9 @@ func (x *CenterLayout) SetShrinkCenterLast(ShrinkCenterLastVar bool) {
 
 var xCenterLayoutSetStartWidget func(uintptr, uintptr)
 
-// Sets the new start widget of @self.
+// Sets the new start widget of self.
 //
-// To remove the existing start widget, pass %NULL.
+// To remove the existing start widget, pass nil.
 func (x *CenterLayout) SetStartWidget(WidgetVar *Widget) {
 
 	xCenterLayoutSetStartWidget(x.GoPointer(), WidgetVar.GoPointer())
@@ -252,7 +251,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -261,19 +260,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xCenterLayoutGLibType, libs, "gtk_center_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewCenterLayout, libs, "gtk_center_layout_new")
-
-	core.PuregoSafeRegister(&xCenterLayoutGetBaselinePosition, libs, "gtk_center_layout_get_baseline_position")
-	core.PuregoSafeRegister(&xCenterLayoutGetCenterWidget, libs, "gtk_center_layout_get_center_widget")
-	core.PuregoSafeRegister(&xCenterLayoutGetEndWidget, libs, "gtk_center_layout_get_end_widget")
-	core.PuregoSafeRegister(&xCenterLayoutGetOrientation, libs, "gtk_center_layout_get_orientation")
-	core.PuregoSafeRegister(&xCenterLayoutGetShrinkCenterLast, libs, "gtk_center_layout_get_shrink_center_last")
-	core.PuregoSafeRegister(&xCenterLayoutGetStartWidget, libs, "gtk_center_layout_get_start_widget")
-	core.PuregoSafeRegister(&xCenterLayoutSetBaselinePosition, libs, "gtk_center_layout_set_baseline_position")
-	core.PuregoSafeRegister(&xCenterLayoutSetCenterWidget, libs, "gtk_center_layout_set_center_widget")
-	core.PuregoSafeRegister(&xCenterLayoutSetEndWidget, libs, "gtk_center_layout_set_end_widget")
-	core.PuregoSafeRegister(&xCenterLayoutSetOrientation, libs, "gtk_center_layout_set_orientation")
-	core.PuregoSafeRegister(&xCenterLayoutSetShrinkCenterLast, libs, "gtk_center_layout_set_shrink_center_last")
-	core.PuregoSafeRegister(&xCenterLayoutSetStartWidget, libs, "gtk_center_layout_set_start_widget")
+	gobject.CheckClassSize("GtkCenterLayoutClass", xCenterLayoutGLibType, unsafe.Sizeof(CenterLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewCenterLayout, libs, "gtk_center_layout_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCenterLayoutGetBaselinePosition, libs, "gtk_center_layout_get_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutGetCenterWidget, libs, "gtk_center_layout_get_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutGetEndWidget, libs, "gtk_center_layout_get_end_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutGetOrientation, libs, "gtk_center_layout_get_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutGetShrinkCenterLast, libs, "gtk_center_layout_get_shrink_center_last", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCenterLayoutGetStartWidget, libs, "gtk_center_layout_get_start_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutSetBaselinePosition, libs, "gtk_center_layout_set_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutSetCenterWidget, libs, "gtk_center_layout_set_center_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutSetEndWidget, libs, "gtk_center_layout_set_end_widget", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutSetOrientation, libs, "gtk_center_layout_set_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xCenterLayoutSetShrinkCenterLast, libs, "gtk_center_layout_set_shrink_center_last", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCenterLayoutSetStartWidget, libs, "gtk_center_layout_set_start_widget", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -33,7 +32,8 @@ func (x *CheckButtonClass) OverrideToggled(cb func(*CheckButton)) {
 	if cb == nil {
 		x.xToggled = 0
 	} else {
-		x.xToggled = purego.NewCallback(func(CheckButtonVarp uintptr) {
+		x.xToggled = core.NewCallback(func(CheckButtonVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(CheckButtonNewFromInternalPtr(CheckButtonVarp))
 		})
 	}
@@ -45,7 +45,7 @@ func (x *CheckButtonClass) GetToggled() func(*CheckButton) {
 		return nil
 	}
 	var rawCallback func(CheckButtonVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xToggled)
+	core.RegisterFunc(&rawCallback, x.xToggled)
 	return func(CheckButtonVar *CheckButton) {
 		rawCallback(CheckButtonVar.GoPointer())
 	}
@@ -56,7 +56,8 @@ func (x *CheckButtonClass) OverrideActivate(cb func(*CheckButton)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(CheckButtonVarp uintptr) {
+		x.xActivate = core.NewCallback(func(CheckButtonVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(CheckButtonNewFromInternalPtr(CheckButtonVarp))
 		})
 	}
@@ -68,7 +69,7 @@ func (x *CheckButtonClass) GetActivate() func(*CheckButton) {
 		return nil
 	}
 	var rawCallback func(CheckButtonVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(CheckButtonVar *CheckButton) {
 		rawCallback(CheckButtonVar.GoPointer())
 	}
@@ -76,19 +77,12 @@ func (x *CheckButtonClass) GetActivate() func(*CheckButton) {
 
 // Places a label next to an indicator.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="check-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Example GtkCheckButtons" src="check-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// A `GtkCheckButton` is created by calling either [ctor@Gtk.CheckButton.new]
-// or [ctor@Gtk.CheckButton.new_with_label].
+// A `GtkCheckButton` is created by calling either [NewCheckButton]
+// or [NewCheckButtonWithLabel].
 //
 // The state of a `GtkCheckButton` can be set specifically using
-// [method@Gtk.CheckButton.set_active], and retrieved using
-// [method@Gtk.CheckButton.get_active].
+// [CheckButton.SetActive], and retrieved using
+// [CheckButton.GetActive].
 //
 // # Inconsistent state
 //
@@ -99,7 +93,7 @@ func (x *CheckButtonClass) GetActivate() func(*CheckButton) {
 // and the current values in that range are inconsistent.
 //
 // To set a `GtkCheckButton` to inconsistent state, use
-// [method@Gtk.CheckButton.set_inconsistent].
+// [CheckButton.SetInconsistent].
 //
 // # Grouping
 //
@@ -110,14 +104,7 @@ func (x *CheckButtonClass) GetActivate() func(*CheckButton) {
 // Grouped check buttons use a different indicator, and are commonly referred
 // to as *radio buttons*.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="radio-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Example GtkRadioButtons" src="radio-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// To add a `GtkCheckButton` to a group, use [method@Gtk.CheckButton.set_group].
+// To add a `GtkCheckButton` to a group, use [CheckButton.SetGroup].
 //
 // When the code must keep track of the state of a group of radio buttons, it
 // is recommended to keep track of such state through a stateful
@@ -128,25 +115,23 @@ func (x *CheckButtonClass) GetActivate() func(*CheckButton) {
 //
 // `GtkCheckButton` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;␣&lt;/kbd&gt; or &lt;kbd&gt;Enter&lt;/kbd&gt; activates the button.
+// - ␣ or Enter activates the button.
 //
 // # CSS nodes
 //
-// ```
-// checkbutton[.text-button][.grouped]
-// ├── check
-// ╰── [label]
-// ```
+//	checkbutton[.text-button][.grouped]
+//	├── check
+//	╰── [label]
 //
 // A `GtkCheckButton` has a main node with name checkbutton. If the
-// [property@Gtk.CheckButton:label] or [property@Gtk.CheckButton:child]
+// [CheckButton.GetPropertyLabel] or CheckButton:child
 // properties are set, it contains a child widget. The indicator node
 // is named check when no group is set, and radio if the checkbutton
 // is grouped together with other checkbuttons.
 //
 // # Accessibility
 //
-// `GtkCheckButton` uses the [enum@Gtk.AccessibleRole.checkbox] role.
+// `GtkCheckButton` uses the [AccessibleRoleCheckboxValue] role.
 type CheckButton struct {
 	Widget
 }
@@ -231,7 +216,7 @@ func (x *CheckButton) GetActive() bool {
 
 var xCheckButtonGetChild func(uintptr) uintptr
 
-// Gets the child widget of @button or `NULL` if [property@CheckButton:label] is set.
+// Gets the child widget of button or `NULL` if CheckButton:label is set.
 func (x *CheckButton) GetChild() *Widget {
 	var cls *Widget
 
@@ -257,7 +242,7 @@ func (x *CheckButton) GetInconsistent() bool {
 
 var xCheckButtonGetLabel func(uintptr) string
 
-// Returns the label of the check button or `NULL` if [property@CheckButton:child] is set.
+// Returns the label of the check button or `NULL` if CheckButton:child is set.
 func (x *CheckButton) GetLabel() string {
 
 	cret := xCheckButtonGetLabel(x.GoPointer())
@@ -284,13 +269,13 @@ func (x *CheckButton) SetActive(SettingVar bool) {
 
 var xCheckButtonSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @button.
+// Sets the child widget of button.
 //
 // Note that by using this API, you take full responsibility for setting
-// up the proper accessibility label and description information for @button.
+// up the proper accessibility label and description information for button.
 // Most likely, you'll either set the accessibility label or description
-// for @button explicitly, or you'll set a labelled-by or described-by
-// relations from @child to @button.
+// for button explicitly, or you'll set a labelled-by or described-by
+// relations from child to button.
 func (x *CheckButton) SetChild(ChildVar *Widget) {
 
 	xCheckButtonSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -299,7 +284,7 @@ func (x *CheckButton) SetChild(ChildVar *Widget) {
 
 var xCheckButtonSetGroup func(uintptr, uintptr)
 
-// Adds @self to the group of @group.
+// Adds self to the group of group.
 //
 // In a group of multiple check buttons, only one button can be active
 // at a time. The behavior of a checkbutton in a group is also commonly
@@ -310,7 +295,7 @@ var xCheckButtonSetGroup func(uintptr, uintptr)
 //
 // Setting up groups in a cycle leads to undefined behavior.
 //
-// Note that the same effect can be achieved via the [iface@Gtk.Actionable]
+// Note that the same effect can be achieved via the [Actionable]
 // API, by using the same action with parameter type and state type 's'
 // for all buttons in the group, and giving each button its own target
 // value.
@@ -334,11 +319,11 @@ func (x *CheckButton) SetInconsistent(InconsistentVar bool) {
 
 var xCheckButtonSetLabel func(uintptr, uintptr)
 
-// Sets the text of @self.
+// Sets the text of self.
 //
-// If [property@Gtk.CheckButton:use-underline] is %TRUE, an underscore
-// in @label is interpreted as mnemonic indicator, see
-// [method@Gtk.CheckButton.set_use_underline] for details on this behavior.
+// If [CheckButton.GetPropertyUseUnderline] is true, an underscore
+// in label is interpreted as mnemonic indicator, see
+// [CheckButton.SetUseUnderline] for details on this behavior.
 func (x *CheckButton) SetLabel(LabelVar *string) {
 
 	LabelVarPtr := core.GStrdupNullable(LabelVar)
@@ -352,9 +337,9 @@ var xCheckButtonSetUseUnderline func(uintptr, bool)
 
 // Sets whether underlines in the label indicate mnemonics.
 //
-// If @setting is %TRUE, an underscore character in @self's label
+// If setting is true, an underscore character in self's label
 // indicates a mnemonic accelerator key. This behavior is similar
-// to [property@Gtk.Label:use-underline].
+// to [Label.GetPropertyUseUnderline].
 func (x *CheckButton) SetUseUnderline(SettingVar bool) {
 
 	xCheckButtonSetUseUnderline(x.GoPointer(), SettingVar)
@@ -375,7 +360,7 @@ func (c *CheckButton) SetGoPointer(ptr uintptr) {
 // SetPropertyActive sets the "active" property.
 // If the check button is active.
 //
-// Setting `active` to %TRUE will add the `:checked:` state to both
+// Setting `active` to true will add the `:checked:` state to both
 // the check button and the indicator CSS node.
 func (x *CheckButton) SetPropertyActive(value bool) {
 	var v gobject.Value
@@ -387,7 +372,7 @@ func (x *CheckButton) SetPropertyActive(value bool) {
 // GetPropertyActive gets the "active" property.
 // If the check button is active.
 //
-// Setting `active` to %TRUE will add the `:checked:` state to both
+// Setting `active` to true will add the `:checked:` state to both
 // the check button and the indicator CSS node.
 func (x *CheckButton) GetPropertyActive() bool {
 	var v gobject.Value
@@ -460,56 +445,100 @@ func (x *CheckButton) GetPropertyUseUnderline() bool {
 // emitting it causes the button to animate press then release.
 //
 // Applications should never connect to this signal, but use the
-// [signal@Gtk.CheckButton::toggled] signal.
+// [CheckButton.ConnectToggledFunc] signal.
 //
 // The default bindings for this signal are all forms of the
-// &lt;kbd&gt;␣&lt;/kbd&gt; and &lt;kbd&gt;Enter&lt;/kbd&gt; keys.
+// ␣ and Enter keys.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *CheckButton) ConnectActivate(cb *func(CheckButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := CheckButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xCheckButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xCheckButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CheckButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CheckButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the buttons's [property@Gtk.CheckButton:active]
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CheckButton) ConnectActivateFunc(cb func(CheckButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xCheckButtonActivateTrampoline, xCheckButtonActivateNewTrampoline, cb)
+}
+
+// Emitted when the buttons's [CheckButton.GetPropertyActive]
 // property changes.
+//
+// Deprecated: use ConnectToggledFunc, which also accepts method values and closures.
 func (x *CheckButton) ConnectToggled(cb *func(CheckButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := CheckButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggled", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xCheckButtonToggledTrampoline uintptr
+
+func xCheckButtonToggledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CheckButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CheckButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggledFunc connects cb to the "toggled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CheckButton) ConnectToggledFunc(cb func(CheckButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggled", &xCheckButtonToggledTrampoline, xCheckButtonToggledNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -673,7 +702,7 @@ func (x *CheckButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -681,14 +710,13 @@ func (x *CheckButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *CheckButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -712,19 +740,17 @@ func (x *CheckButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *CheckButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -745,7 +771,7 @@ func (x *CheckButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -754,14 +780,12 @@ func (x *CheckButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *CheckButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -780,14 +804,14 @@ func (x *CheckButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *CheckButton) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *CheckButton) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -797,15 +821,15 @@ func (x *CheckButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *CheckButton) SetActionName(ActionNameVar *string) {
 
@@ -818,13 +842,13 @@ func (x *CheckButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *CheckButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -833,14 +857,14 @@ func (x *CheckButton) SetActionTarget(FormatStringVar string, varArgs ...interfa
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -858,18 +882,18 @@ func (x *CheckButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *CheckButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *CheckButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -881,7 +905,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -890,20 +914,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xCheckButtonGLibType, libs, "gtk_check_button_get_type")
 
-	core.PuregoSafeRegister(&xNewCheckButton, libs, "gtk_check_button_new")
-	core.PuregoSafeRegister(&xNewCheckButtonWithLabel, libs, "gtk_check_button_new_with_label")
-	core.PuregoSafeRegister(&xNewCheckButtonWithMnemonic, libs, "gtk_check_button_new_with_mnemonic")
-
-	core.PuregoSafeRegister(&xCheckButtonGetActive, libs, "gtk_check_button_get_active")
-	core.PuregoSafeRegister(&xCheckButtonGetChild, libs, "gtk_check_button_get_child")
-	core.PuregoSafeRegister(&xCheckButtonGetInconsistent, libs, "gtk_check_button_get_inconsistent")
-	core.PuregoSafeRegister(&xCheckButtonGetLabel, libs, "gtk_check_button_get_label")
-	core.PuregoSafeRegister(&xCheckButtonGetUseUnderline, libs, "gtk_check_button_get_use_underline")
-	core.PuregoSafeRegister(&xCheckButtonSetActive, libs, "gtk_check_button_set_active")
-	core.PuregoSafeRegister(&xCheckButtonSetChild, libs, "gtk_check_button_set_child")
-	core.PuregoSafeRegister(&xCheckButtonSetGroup, libs, "gtk_check_button_set_group")
-	core.PuregoSafeRegister(&xCheckButtonSetInconsistent, libs, "gtk_check_button_set_inconsistent")
-	core.PuregoSafeRegister(&xCheckButtonSetLabel, libs, "gtk_check_button_set_label")
-	core.PuregoSafeRegister(&xCheckButtonSetUseUnderline, libs, "gtk_check_button_set_use_underline")
+	gobject.CheckClassSize("GtkCheckButtonClass", xCheckButtonGLibType, unsafe.Sizeof(CheckButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewCheckButton, libs, "gtk_check_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewCheckButtonWithLabel, libs, "gtk_check_button_new_with_label", "GTK", "")
+	core.PuregoRegisterSince(&xNewCheckButtonWithMnemonic, libs, "gtk_check_button_new_with_mnemonic", "GTK", "")
+
+	core.PuregoRegisterSince(&xCheckButtonGetActive, libs, "gtk_check_button_get_active", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonGetChild, libs, "gtk_check_button_get_child", "GTK", "4.8")
+	core.PuregoRegisterSince(&xCheckButtonGetInconsistent, libs, "gtk_check_button_get_inconsistent", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonGetLabel, libs, "gtk_check_button_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonGetUseUnderline, libs, "gtk_check_button_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonSetActive, libs, "gtk_check_button_set_active", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonSetChild, libs, "gtk_check_button_set_child", "GTK", "4.8")
+	core.PuregoRegisterSince(&xCheckButtonSetGroup, libs, "gtk_check_button_set_group", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonSetInconsistent, libs, "gtk_check_button_set_inconsistent", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonSetLabel, libs, "gtk_check_button_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xCheckButtonSetUseUnderline, libs, "gtk_check_button_set_use_underline", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -15,28 +14,19 @@ import (
 // The `GtkColorButton` allows to open a color chooser dialog to change
 // the color.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="color-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkColorButton" src="color-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is suitable widget for selecting a color in a preference dialog.
 //
 // # CSS nodes
 //
-// ```
-// colorbutton
-// ╰── button.color
-//
-//	╰── [content]
-//
-// ```
+//	colorbutton
+//	╰── button.color
+//	    ╰── [content]
 //
 // `GtkColorButton` has a single CSS node with name colorbutton which
 // contains a button node. To differentiate it from a plain `GtkButton`,
 // it gets the .color style class.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
 type ColorButton struct {
 	Widget
 }
@@ -53,29 +43,6 @@ func ColorButtonNewFromInternalPtr(ptr uintptr) *ColorButton {
 	return cls
 }
 
-var xNewColorButton func() uintptr
-
-// Creates a new color button.
-//
-// This returns a widget in the form of a small button containing
-// a swatch representing the current selected color. When the button
-// is clicked, a color chooser dialog will open, allowing the user
-// to select a color. The swatch will be updated to reflect the new
-// color when the user finishes.
-func NewColorButton() *ColorButton {
-	var cls *ColorButton
-
-	cret := xNewColorButton()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ColorButton{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xNewColorButtonWithRgba func(*gdk.RGBA) uintptr
 
 // Creates a new color button showing the given color.
@@ -93,42 +60,6 @@ func NewColorButtonWithRgba(RgbaVar *gdk.RGBA) *ColorButton {
 	return cls
 }
 
-var xColorButtonGetModal func(uintptr) bool
-
-// Gets whether the dialog is modal.
-func (x *ColorButton) GetModal() bool {
-
-	cret := xColorButtonGetModal(x.GoPointer())
-	return cret
-}
-
-var xColorButtonGetTitle func(uintptr) string
-
-// Gets the title of the color chooser dialog.
-func (x *ColorButton) GetTitle() string {
-
-	cret := xColorButtonGetTitle(x.GoPointer())
-	return cret
-}
-
-var xColorButtonSetModal func(uintptr, bool)
-
-// Sets whether the dialog should be modal.
-func (x *ColorButton) SetModal(ModalVar bool) {
-
-	xColorButtonSetModal(x.GoPointer(), ModalVar)
-
-}
-
-var xColorButtonSetTitle func(uintptr, string)
-
-// Sets the title for the color chooser dialog.
-func (x *ColorButton) SetTitle(TitleVar string) {
-
-	xColorButtonSetTitle(x.GoPointer(), TitleVar)
-
-}
-
 func (c *ColorButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -203,58 +134,102 @@ func (x *ColorButton) GetPropertyTitle() string {
 //
 // The `::activate` signal on `GtkMenuButton` is an action signal and
 // emitting it causes the button to pop up its dialog.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *ColorButton) ConnectActivate(cb *func(ColorButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ColorButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xColorButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xColorButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ColorButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ColorButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ColorButton) ConnectActivateFunc(cb func(ColorButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xColorButtonActivateTrampoline, xColorButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the user selects a color.
 //
-// When handling this signal, use [method@Gtk.ColorChooser.get_rgba]
+// When handling this signal, use [ColorChooser.GetRgba]
 // to find out which color was just selected.
 //
 // Note that this signal is only emitted when the user changes the color.
 // If you need to react to programmatic color changes as well, use
 // the notify::rgba signal.
+//
+// Deprecated: use ConnectColorSetFunc, which also accepts method values and closures.
 func (x *ColorButton) ConnectColorSet(cb *func(ColorButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "color-set", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ColorButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "color-set", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xColorButtonColorSetTrampoline uintptr
+
+func xColorButtonColorSetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ColorButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ColorButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "color-set", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectColorSetFunc connects cb to the "color-set" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ColorButton) ConnectColorSetFunc(cb func(ColorButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "color-set", &xColorButtonColorSetTrampoline, xColorButtonColorSetNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -418,7 +393,7 @@ func (x *ColorButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -426,14 +401,13 @@ func (x *ColorButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ColorButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -457,19 +431,17 @@ func (x *ColorButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ColorButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -490,7 +462,7 @@ func (x *ColorButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -499,14 +471,12 @@ func (x *ColorButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ColorButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -525,10 +495,10 @@ func (x *ColorButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ColorButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -537,11 +507,11 @@ func (x *ColorButton) GetBuildableId() string {
 
 // Adds a palette to the color chooser.
 //
-// If @orientation is horizontal, the colors are grouped in rows,
-// with @colors_per_line colors in each row. If @horizontal is %FALSE,
+// If orientation is horizontal, the colors are grouped in rows,
+// with colors_per_line colors in each row. If horizontal is false,
 // the colors are grouped in columns instead.
 //
-// The default color palette of [class@Gtk.ColorChooserWidget] has
+// The default color palette of [ColorChooserWidget] has
 // 45 colors, organized in columns of 5 colors (this includes some
 // grays).
 //
@@ -551,7 +521,9 @@ func (x *ColorButton) GetBuildableId() string {
 // Calling this function for the first time has the side effect
 // of removing the default color palette from the color chooser.
 //
-// If @colors is %NULL, removes all previously added palettes.
+// If colors is nil, removes all previously added palettes.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorButton) AddPalette(OrientationVar Orientation, ColorsPerLineVar int, NColorsVar int, ColorsVar []gdk.RGBA) {
 
 	XGtkColorChooserAddPalette(x.GoPointer(), OrientationVar, ColorsPerLineVar, NColorsVar, ColorsVar)
@@ -559,6 +531,8 @@ func (x *ColorButton) AddPalette(OrientationVar Orientation, ColorsPerLineVar in
 }
 
 // Gets the currently-selected color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorButton) GetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserGetRgba(x.GoPointer(), ColorVar)
@@ -566,6 +540,8 @@ func (x *ColorButton) GetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Returns whether the color chooser shows the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorButton) GetUseAlpha() bool {
 
 	cret := XGtkColorChooserGetUseAlpha(x.GoPointer())
@@ -573,6 +549,8 @@ func (x *ColorButton) GetUseAlpha() bool {
 }
 
 // Sets the color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorButton) SetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserSetRgba(x.GoPointer(), ColorVar)
@@ -580,6 +558,8 @@ func (x *ColorButton) SetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Sets whether or not the color chooser should use the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorButton) SetUseAlpha(UseAlphaVar bool) {
 
 	XGtkColorChooserSetUseAlpha(x.GoPointer(), UseAlphaVar)
@@ -591,7 +571,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -600,12 +580,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorButtonGLibType, libs, "gtk_color_button_get_type")
 
-	core.PuregoSafeRegister(&xNewColorButton, libs, "gtk_color_button_new")
-	core.PuregoSafeRegister(&xNewColorButtonWithRgba, libs, "gtk_color_button_new_with_rgba")
-
-	core.PuregoSafeRegister(&xColorButtonGetModal, libs, "gtk_color_button_get_modal")
-	core.PuregoSafeRegister(&xColorButtonGetTitle, libs, "gtk_color_button_get_title")
-	core.PuregoSafeRegister(&xColorButtonSetModal, libs, "gtk_color_button_set_modal")
-	core.PuregoSafeRegister(&xColorButtonSetTitle, libs, "gtk_color_button_set_title")
+	core.PuregoRegisterSince(&xNewColorButtonWithRgba, libs, "gtk_color_button_new_with_rgba", "GTK", "")
 
 }
@@ -0,0 +1,99 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewColorButton func() uintptr
+
+// Creates a new color button.
+//
+// This returns a widget in the form of a small button containing
+// a swatch representing the current selected color. When the button
+// is clicked, a color chooser dialog will open, allowing the user
+// to select a color. The swatch will be updated to reflect the new
+// color when the user finishes.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
+func NewColorButton() *ColorButton {
+	var cls *ColorButton
+
+	cret := xNewColorButton()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ColorButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xColorButtonGetModal func(uintptr) bool
+
+// Gets whether the dialog is modal.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
+func (x *ColorButton) GetModal() bool {
+
+	cret := xColorButtonGetModal(x.GoPointer())
+	return cret
+}
+
+var xColorButtonGetTitle func(uintptr) string
+
+// Gets the title of the color chooser dialog.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
+func (x *ColorButton) GetTitle() string {
+
+	cret := xColorButtonGetTitle(x.GoPointer())
+	return cret
+}
+
+var xColorButtonSetModal func(uintptr, bool)
+
+// Sets whether the dialog should be modal.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
+func (x *ColorButton) SetModal(ModalVar bool) {
+
+	xColorButtonSetModal(x.GoPointer(), ModalVar)
+
+}
+
+var xColorButtonSetTitle func(uintptr, string)
+
+// Sets the title for the color chooser dialog.
+//
+// Deprecated: since 4.10. Use [ColorDialogButton] instead
+func (x *ColorButton) SetTitle(TitleVar string) {
+
+	xColorButtonSetTitle(x.GoPointer(), TitleVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewColorButton, libs, "gtk_color_button_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xColorButtonGetModal, libs, "gtk_color_button_get_modal", "GTK", "")
+	core.PuregoRegisterSince(&xColorButtonGetTitle, libs, "gtk_color_button_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xColorButtonSetModal, libs, "gtk_color_button_set_modal", "GTK", "")
+	core.PuregoRegisterSince(&xColorButtonSetTitle, libs, "gtk_color_button_set_title", "GTK", "")
+
+}
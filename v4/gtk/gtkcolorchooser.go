@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 type ColorChooserInterface struct {
 	_ structs.HostLayout
 
-	BaseInterface uintptr
+	BaseInterface gobject.TypeInterface
 
 	xGetRgba uintptr
 
@@ -37,7 +36,8 @@ func (x *ColorChooserInterface) OverrideGetRgba(cb func(ColorChooser, *gdk.RGBA)
 	if cb == nil {
 		x.xGetRgba = 0
 	} else {
-		x.xGetRgba = purego.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+		x.xGetRgba = core.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+			defer core.RecoverPanic()
 			cb(&ColorChooserBase{Ptr: ChooserVarp}, ColorVarp)
 		})
 	}
@@ -49,7 +49,7 @@ func (x *ColorChooserInterface) GetGetRgba() func(ColorChooser, *gdk.RGBA) {
 		return nil
 	}
 	var rawCallback func(ChooserVarp uintptr, ColorVarp *gdk.RGBA)
-	purego.RegisterFunc(&rawCallback, x.xGetRgba)
+	core.RegisterFunc(&rawCallback, x.xGetRgba)
 	return func(ChooserVar ColorChooser, ColorVar *gdk.RGBA) {
 		rawCallback(ChooserVar.GoPointer(), ColorVar)
 	}
@@ -60,7 +60,8 @@ func (x *ColorChooserInterface) OverrideSetRgba(cb func(ColorChooser, *gdk.RGBA)
 	if cb == nil {
 		x.xSetRgba = 0
 	} else {
-		x.xSetRgba = purego.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+		x.xSetRgba = core.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+			defer core.RecoverPanic()
 			cb(&ColorChooserBase{Ptr: ChooserVarp}, ColorVarp)
 		})
 	}
@@ -72,7 +73,7 @@ func (x *ColorChooserInterface) GetSetRgba() func(ColorChooser, *gdk.RGBA) {
 		return nil
 	}
 	var rawCallback func(ChooserVarp uintptr, ColorVarp *gdk.RGBA)
-	purego.RegisterFunc(&rawCallback, x.xSetRgba)
+	core.RegisterFunc(&rawCallback, x.xSetRgba)
 	return func(ChooserVar ColorChooser, ColorVar *gdk.RGBA) {
 		rawCallback(ChooserVar.GoPointer(), ColorVar)
 	}
@@ -83,7 +84,8 @@ func (x *ColorChooserInterface) OverrideAddPalette(cb func(ColorChooser, Orienta
 	if cb == nil {
 		x.xAddPalette = 0
 	} else {
-		x.xAddPalette = purego.NewCallback(func(ChooserVarp uintptr, OrientationVarp Orientation, ColorsPerLineVarp int, NColorsVarp int, ColorsVarp []gdk.RGBA) {
+		x.xAddPalette = core.NewCallback(func(ChooserVarp uintptr, OrientationVarp Orientation, ColorsPerLineVarp int, NColorsVarp int, ColorsVarp []gdk.RGBA) {
+			defer core.RecoverPanic()
 			cb(&ColorChooserBase{Ptr: ChooserVarp}, OrientationVarp, ColorsPerLineVarp, NColorsVarp, ColorsVarp)
 		})
 	}
@@ -95,7 +97,7 @@ func (x *ColorChooserInterface) GetAddPalette() func(ColorChooser, Orientation,
 		return nil
 	}
 	var rawCallback func(ChooserVarp uintptr, OrientationVarp Orientation, ColorsPerLineVarp int, NColorsVarp int, ColorsVarp []gdk.RGBA)
-	purego.RegisterFunc(&rawCallback, x.xAddPalette)
+	core.RegisterFunc(&rawCallback, x.xAddPalette)
 	return func(ChooserVar ColorChooser, OrientationVar Orientation, ColorsPerLineVar int, NColorsVar int, ColorsVar []gdk.RGBA) {
 		rawCallback(ChooserVar.GoPointer(), OrientationVar, ColorsPerLineVar, NColorsVar, ColorsVar)
 	}
@@ -106,7 +108,8 @@ func (x *ColorChooserInterface) OverrideColorActivated(cb func(ColorChooser, *gd
 	if cb == nil {
 		x.xColorActivated = 0
 	} else {
-		x.xColorActivated = purego.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+		x.xColorActivated = core.NewCallback(func(ChooserVarp uintptr, ColorVarp *gdk.RGBA) {
+			defer core.RecoverPanic()
 			cb(&ColorChooserBase{Ptr: ChooserVarp}, ColorVarp)
 		})
 	}
@@ -118,7 +121,7 @@ func (x *ColorChooserInterface) GetColorActivated() func(ColorChooser, *gdk.RGBA
 		return nil
 	}
 	var rawCallback func(ChooserVarp uintptr, ColorVarp *gdk.RGBA)
-	purego.RegisterFunc(&rawCallback, x.xColorActivated)
+	core.RegisterFunc(&rawCallback, x.xColorActivated)
 	return func(ChooserVar ColorChooser, ColorVar *gdk.RGBA) {
 		rawCallback(ChooserVar.GoPointer(), ColorVar)
 	}
@@ -130,8 +133,12 @@ func (x *ColorChooserInterface) GetColorActivated() func(ColorChooser, *gdk.RGBA
 // Depending on the situation, colors may be allowed to have alpha (translucency).
 //
 // In GTK, the main widgets that implement this interface are
-// [class@Gtk.ColorChooserWidget], [class@Gtk.ColorChooserDialog] and
-// [class@Gtk.ColorButton].
+// [ColorChooserWidget], [ColorChooserDialog] and
+// [ColorButton].
+//
+// Deprecated: since 4.10. Use [ColorDialog] and [ColorDialogButton]
+//
+//	instead of widgets implementing `GtkColorChooser`
 type ColorChooser interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -163,13 +170,58 @@ func (x *ColorChooserBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementColorChooser adds the ColorChooser interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetRgba(*gdk.RGBA)
+//   - SetRgba(*gdk.RGBA)
+//   - AddPalette(Orientation, int, int, []gdk.RGBA)
+//   - ColorActivated(*gdk.RGBA)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ColorChooserInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementColorChooser[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ColorChooserGLibType(), func(iface *ColorChooserInterface) {
+		if _, ok := any(zero).(interface{ GetRgba(*gdk.RGBA) }); ok {
+			iface.OverrideGetRgba(func(self ColorChooser, arg0 *gdk.RGBA) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetRgba(*gdk.RGBA) })
+				impl.GetRgba(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetRgba(*gdk.RGBA) }); ok {
+			iface.OverrideSetRgba(func(self ColorChooser, arg0 *gdk.RGBA) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetRgba(*gdk.RGBA) })
+				impl.SetRgba(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			AddPalette(Orientation, int, int, []gdk.RGBA)
+		}); ok {
+			iface.OverrideAddPalette(func(self ColorChooser, arg0 Orientation, arg1 int, arg2 int, arg3 []gdk.RGBA) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					AddPalette(Orientation, int, int, []gdk.RGBA)
+				})
+				impl.AddPalette(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface{ ColorActivated(*gdk.RGBA) }); ok {
+			iface.OverrideColorActivated(func(self ColorChooser, arg0 *gdk.RGBA) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ ColorActivated(*gdk.RGBA) })
+				impl.ColorActivated(arg0)
+			})
+		}
+	})
+}
+
 // Adds a palette to the color chooser.
 //
-// If @orientation is horizontal, the colors are grouped in rows,
-// with @colors_per_line colors in each row. If @horizontal is %FALSE,
+// If orientation is horizontal, the colors are grouped in rows,
+// with colors_per_line colors in each row. If horizontal is false,
 // the colors are grouped in columns instead.
 //
-// The default color palette of [class@Gtk.ColorChooserWidget] has
+// The default color palette of [ColorChooserWidget] has
 // 45 colors, organized in columns of 5 colors (this includes some
 // grays).
 //
@@ -179,7 +231,9 @@ func (x *ColorChooserBase) SetGoPointer(ptr uintptr) {
 // Calling this function for the first time has the side effect
 // of removing the default color palette from the color chooser.
 //
-// If @colors is %NULL, removes all previously added palettes.
+// If colors is nil, removes all previously added palettes.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserBase) AddPalette(OrientationVar Orientation, ColorsPerLineVar int, NColorsVar int, ColorsVar []gdk.RGBA) {
 
 	XGtkColorChooserAddPalette(x.GoPointer(), OrientationVar, ColorsPerLineVar, NColorsVar, ColorsVar)
@@ -187,6 +241,8 @@ func (x *ColorChooserBase) AddPalette(OrientationVar Orientation, ColorsPerLineV
 }
 
 // Gets the currently-selected color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserBase) GetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserGetRgba(x.GoPointer(), ColorVar)
@@ -194,6 +250,8 @@ func (x *ColorChooserBase) GetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Returns whether the color chooser shows the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserBase) GetUseAlpha() bool {
 
 	cret := XGtkColorChooserGetUseAlpha(x.GoPointer())
@@ -201,6 +259,8 @@ func (x *ColorChooserBase) GetUseAlpha() bool {
 }
 
 // Sets the color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserBase) SetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserSetRgba(x.GoPointer(), ColorVar)
@@ -208,6 +268,8 @@ func (x *ColorChooserBase) SetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Sets whether or not the color chooser should use the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserBase) SetUseAlpha(UseAlphaVar bool) {
 
 	XGtkColorChooserSetUseAlpha(x.GoPointer(), UseAlphaVar)
@@ -219,6 +281,10 @@ func (x *ColorChooserBase) SetUseAlpha(UseAlphaVar bool) {
 //
 // The property can be set to change the current selection
 // programmatically.
+//
+// Deprecated: since 4.10. Use [ColorDialog] and [ColorDialogButton]
+//
+//	instead of widgets implementing `GtkColorChooser`
 func (x *ColorChooserBase) SetPropertyRgba(value uintptr) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -233,6 +299,10 @@ func (x *ColorChooserBase) SetPropertyRgba(value uintptr) {
 //
 // The property can be set to change the current selection
 // programmatically.
+//
+// Deprecated: since 4.10. Use [ColorDialog] and [ColorDialogButton]
+//
+//	instead of widgets implementing `GtkColorChooser`
 func (x *ColorChooserBase) GetPropertyRgba() uintptr {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -244,12 +314,16 @@ func (x *ColorChooserBase) GetPropertyRgba() uintptr {
 // SetPropertyUseAlpha sets the "use-alpha" property.
 // Whether colors may have alpha (translucency).
 //
-// When ::use-alpha is %FALSE, the `GdkRGBA` struct obtained
-// via the [property@Gtk.ColorChooser:rgba] property will be
+// When ::use-alpha is false, the `GdkRGBA` struct obtained
+// via the [ColorChooserBase.GetPropertyRgba] property will be
 // forced to have alpha == 1.
 //
 // Implementations are expected to show alpha by rendering the color
 // over a non-uniform background (like a checkerboard pattern).
+//
+// Deprecated: since 4.10. Use [ColorDialog] and [ColorDialogButton]
+//
+//	instead of widgets implementing `GtkColorChooser`
 func (x *ColorChooserBase) SetPropertyUseAlpha(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -262,12 +336,16 @@ func (x *ColorChooserBase) SetPropertyUseAlpha(value bool) {
 // GetPropertyUseAlpha gets the "use-alpha" property.
 // Whether colors may have alpha (translucency).
 //
-// When ::use-alpha is %FALSE, the `GdkRGBA` struct obtained
-// via the [property@Gtk.ColorChooser:rgba] property will be
+// When ::use-alpha is false, the `GdkRGBA` struct obtained
+// via the [ColorChooserBase.GetPropertyRgba] property will be
 // forced to have alpha == 1.
 //
 // Implementations are expected to show alpha by rendering the color
 // over a non-uniform background (like a checkerboard pattern).
+//
+// Deprecated: since 4.10. Use [ColorDialog] and [ColorDialogButton]
+//
+//	instead of widgets implementing `GtkColorChooser`
 func (x *ColorChooserBase) GetPropertyUseAlpha() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -287,7 +365,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -296,10 +374,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorChooserGLibType, libs, "gtk_color_chooser_get_type")
 
-	core.PuregoSafeRegister(&XGtkColorChooserAddPalette, libs, "gtk_color_chooser_add_palette")
-	core.PuregoSafeRegister(&XGtkColorChooserGetRgba, libs, "gtk_color_chooser_get_rgba")
-	core.PuregoSafeRegister(&XGtkColorChooserGetUseAlpha, libs, "gtk_color_chooser_get_use_alpha")
-	core.PuregoSafeRegister(&XGtkColorChooserSetRgba, libs, "gtk_color_chooser_set_rgba")
-	core.PuregoSafeRegister(&XGtkColorChooserSetUseAlpha, libs, "gtk_color_chooser_set_use_alpha")
+	gobject.CheckClassSize("GtkColorChooserInterface", xColorChooserGLibType, unsafe.Sizeof(ColorChooserInterface{}))
+
+	core.PuregoRegisterSince(&XGtkColorChooserAddPalette, libs, "gtk_color_chooser_add_palette", "GTK", "")
+	core.PuregoRegisterSince(&XGtkColorChooserGetRgba, libs, "gtk_color_chooser_get_rgba", "GTK", "")
+	core.PuregoRegisterSince(&XGtkColorChooserGetUseAlpha, libs, "gtk_color_chooser_get_use_alpha", "GTK", "")
+	core.PuregoRegisterSince(&XGtkColorChooserSetRgba, libs, "gtk_color_chooser_set_rgba", "GTK", "")
+	core.PuregoRegisterSince(&XGtkColorChooserSetUseAlpha, libs, "gtk_color_chooser_set_use_alpha", "GTK", "")
 
 }
@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -12,28 +10,23 @@ import (
 
 // A dialog for choosing a color.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="colorchooser-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkColorChooserDialog" src="colorchooser.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// `GtkColorChooserDialog` implements the [iface@Gtk.ColorChooser] interface
+// `GtkColorChooserDialog` implements the [ColorChooser] interface
 // and does not provide much API of its own.
 //
-// To create a `GtkColorChooserDialog`, use [ctor@Gtk.ColorChooserDialog.new].
+// To create a `GtkColorChooserDialog`, use [NewColorChooserDialog].
 //
 // To change the initially selected color, use
-// [method@Gtk.ColorChooser.set_rgba]. To get the selected color use
-// [method@Gtk.ColorChooser.get_rgba].
+// [ColorChooser.SetRgba]. To get the selected color use
+// [ColorChooser.GetRgba].
 //
-// `GtkColorChooserDialog` has been deprecated in favor of [class@Gtk.ColorDialog].
+// `GtkColorChooserDialog` has been deprecated in favor of [ColorDialog].
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkColorChooserDialog` has a single CSS node with the name `window` and style
 // class `.colorchooser`.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 type ColorChooserDialog struct {
 	Dialog
 }
@@ -50,26 +43,6 @@ func ColorChooserDialogNewFromInternalPtr(ptr uintptr) *ColorChooserDialog {
 	return cls
 }
 
-var xNewColorChooserDialog func(uintptr, uintptr) uintptr
-
-// Creates a new `GtkColorChooserDialog`.
-func NewColorChooserDialog(TitleVar *string, ParentVar *Window) *ColorChooserDialog {
-	var cls *ColorChooserDialog
-
-	TitleVarPtr := core.GStrdupNullable(TitleVar)
-	defer core.GFreeNullable(TitleVarPtr)
-
-	cret := xNewColorChooserDialog(TitleVarPtr, ParentVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ColorChooserDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *ColorChooserDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -263,7 +236,7 @@ func (x *ColorChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformStat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -271,14 +244,13 @@ func (x *ColorChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformStat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -302,19 +274,17 @@ func (x *ColorChooserDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesV
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -335,7 +305,7 @@ func (x *ColorChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -344,14 +314,12 @@ func (x *ColorChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -370,10 +338,10 @@ func (x *ColorChooserDialog) UpdateStateValue(NStatesVar int, StatesVar []Access
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ColorChooserDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -382,11 +350,11 @@ func (x *ColorChooserDialog) GetBuildableId() string {
 
 // Adds a palette to the color chooser.
 //
-// If @orientation is horizontal, the colors are grouped in rows,
-// with @colors_per_line colors in each row. If @horizontal is %FALSE,
+// If orientation is horizontal, the colors are grouped in rows,
+// with colors_per_line colors in each row. If horizontal is false,
 // the colors are grouped in columns instead.
 //
-// The default color palette of [class@Gtk.ColorChooserWidget] has
+// The default color palette of [ColorChooserWidget] has
 // 45 colors, organized in columns of 5 colors (this includes some
 // grays).
 //
@@ -396,7 +364,9 @@ func (x *ColorChooserDialog) GetBuildableId() string {
 // Calling this function for the first time has the side effect
 // of removing the default color palette from the color chooser.
 //
-// If @colors is %NULL, removes all previously added palettes.
+// If colors is nil, removes all previously added palettes.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserDialog) AddPalette(OrientationVar Orientation, ColorsPerLineVar int, NColorsVar int, ColorsVar []gdk.RGBA) {
 
 	XGtkColorChooserAddPalette(x.GoPointer(), OrientationVar, ColorsPerLineVar, NColorsVar, ColorsVar)
@@ -404,6 +374,8 @@ func (x *ColorChooserDialog) AddPalette(OrientationVar Orientation, ColorsPerLin
 }
 
 // Gets the currently-selected color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserDialog) GetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserGetRgba(x.GoPointer(), ColorVar)
@@ -411,6 +383,8 @@ func (x *ColorChooserDialog) GetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Returns whether the color chooser shows the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserDialog) GetUseAlpha() bool {
 
 	cret := XGtkColorChooserGetUseAlpha(x.GoPointer())
@@ -418,6 +392,8 @@ func (x *ColorChooserDialog) GetUseAlpha() bool {
 }
 
 // Sets the color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserDialog) SetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserSetRgba(x.GoPointer(), ColorVar)
@@ -425,6 +401,8 @@ func (x *ColorChooserDialog) SetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Sets whether or not the color chooser should use the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserDialog) SetUseAlpha(UseAlphaVar bool) {
 
 	XGtkColorChooserSetUseAlpha(x.GoPointer(), UseAlphaVar)
@@ -461,10 +439,10 @@ func (x *ColorChooserDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *ColorChooserDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -508,7 +486,7 @@ func (x *ColorChooserDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *ColorChooserDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -524,34 +502,16 @@ func (x *ColorChooserDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *ColorChooserDialog) SetFocus(FocusVar *Widget) {
 
 	XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xColorChooserDialogGLibType, libs, "gtk_color_chooser_dialog_get_type")
-
-	core.PuregoSafeRegister(&xNewColorChooserDialog, libs, "gtk_color_chooser_dialog_new")
-
-}
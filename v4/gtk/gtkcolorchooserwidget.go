@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -21,13 +20,13 @@ import (
 // The chooser automatically remembers the last selection, as well
 // as custom colors.
 //
-// To create a `GtkColorChooserWidget`, use [ctor@Gtk.ColorChooserWidget.new].
+// To create a `GtkColorChooserWidget`, use [NewColorChooserWidget].
 //
 // To change the initially selected color, use
-// [method@Gtk.ColorChooser.set_rgba]. To get the selected color use
-// [method@Gtk.ColorChooser.get_rgba].
+// [ColorChooser.SetRgba]. To get the selected color use
+// [ColorChooser.GetRgba].
 //
-// The `GtkColorChooserWidget` is used in the [class@Gtk.ColorChooserDialog]
+// The `GtkColorChooserWidget` is used in the [ColorChooserDialog]
 // to provide a dialog for selecting colors.
 //
 // # Actions
@@ -35,12 +34,14 @@ import (
 // `GtkColorChooserWidget` defines a set of built-in actions:
 //
 //   - `color.customize` activates the color editor for the given color.
-//   - `color.select` emits the [signal@Gtk.ColorChooser::color-activated] signal
+//   - `color.select` emits the ColorChooser::color-activated signal
 //     for the given color.
 //
 // # CSS names
 //
 // `GtkColorChooserWidget` has a single CSS node with name colorchooser.
+//
+// Deprecated: since 4.10. Direct use of `GtkColorChooserWidget` is deprecated.
 type ColorChooserWidget struct {
 	Widget
 }
@@ -86,7 +87,7 @@ func (c *ColorChooserWidget) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyShowEditor sets the "show-editor" property.
-// %TRUE when the color chooser is showing the single-color editor.
+// true when the color chooser is showing the single-color editor.
 //
 // It can be set to switch the color chooser into single-color editing mode.
 func (x *ColorChooserWidget) SetPropertyShowEditor(value bool) {
@@ -97,7 +98,7 @@ func (x *ColorChooserWidget) SetPropertyShowEditor(value bool) {
 }
 
 // GetPropertyShowEditor gets the "show-editor" property.
-// %TRUE when the color chooser is showing the single-color editor.
+// true when the color chooser is showing the single-color editor.
 //
 // It can be set to switch the color chooser into single-color editing mode.
 func (x *ColorChooserWidget) GetPropertyShowEditor() bool {
@@ -267,7 +268,7 @@ func (x *ColorChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformStat
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -275,14 +276,13 @@ func (x *ColorChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformStat
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserWidget) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -306,19 +306,17 @@ func (x *ColorChooserWidget) UpdatePropertyValue(NPropertiesVar int, PropertiesV
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserWidget) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -339,7 +337,7 @@ func (x *ColorChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -348,14 +346,12 @@ func (x *ColorChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ColorChooserWidget) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -374,10 +370,10 @@ func (x *ColorChooserWidget) UpdateStateValue(NStatesVar int, StatesVar []Access
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ColorChooserWidget) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -386,11 +382,11 @@ func (x *ColorChooserWidget) GetBuildableId() string {
 
 // Adds a palette to the color chooser.
 //
-// If @orientation is horizontal, the colors are grouped in rows,
-// with @colors_per_line colors in each row. If @horizontal is %FALSE,
+// If orientation is horizontal, the colors are grouped in rows,
+// with colors_per_line colors in each row. If horizontal is false,
 // the colors are grouped in columns instead.
 //
-// The default color palette of [class@Gtk.ColorChooserWidget] has
+// The default color palette of [ColorChooserWidget] has
 // 45 colors, organized in columns of 5 colors (this includes some
 // grays).
 //
@@ -400,7 +396,9 @@ func (x *ColorChooserWidget) GetBuildableId() string {
 // Calling this function for the first time has the side effect
 // of removing the default color palette from the color chooser.
 //
-// If @colors is %NULL, removes all previously added palettes.
+// If colors is nil, removes all previously added palettes.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserWidget) AddPalette(OrientationVar Orientation, ColorsPerLineVar int, NColorsVar int, ColorsVar []gdk.RGBA) {
 
 	XGtkColorChooserAddPalette(x.GoPointer(), OrientationVar, ColorsPerLineVar, NColorsVar, ColorsVar)
@@ -408,6 +406,8 @@ func (x *ColorChooserWidget) AddPalette(OrientationVar Orientation, ColorsPerLin
 }
 
 // Gets the currently-selected color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserWidget) GetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserGetRgba(x.GoPointer(), ColorVar)
@@ -415,6 +415,8 @@ func (x *ColorChooserWidget) GetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Returns whether the color chooser shows the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserWidget) GetUseAlpha() bool {
 
 	cret := XGtkColorChooserGetUseAlpha(x.GoPointer())
@@ -422,6 +424,8 @@ func (x *ColorChooserWidget) GetUseAlpha() bool {
 }
 
 // Sets the color.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserWidget) SetRgba(ColorVar *gdk.RGBA) {
 
 	XGtkColorChooserSetRgba(x.GoPointer(), ColorVar)
@@ -429,6 +433,8 @@ func (x *ColorChooserWidget) SetRgba(ColorVar *gdk.RGBA) {
 }
 
 // Sets whether or not the color chooser should use the alpha channel.
+//
+// Deprecated: since 4.10. Use [ColorDialog] instead
 func (x *ColorChooserWidget) SetUseAlpha(UseAlphaVar bool) {
 
 	XGtkColorChooserSetUseAlpha(x.GoPointer(), UseAlphaVar)
@@ -440,7 +446,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -449,6 +455,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorChooserWidgetGLibType, libs, "gtk_color_chooser_widget_get_type")
 
-	core.PuregoSafeRegister(&xNewColorChooserWidget, libs, "gtk_color_chooser_widget_new")
+	core.PuregoRegisterSince(&xNewColorChooserWidget, libs, "gtk_color_chooser_widget_new", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -30,10 +29,10 @@ func (x *ColorDialogClass) GoPointer() uintptr {
 // the dialog to the user, such as a title for the dialog and whether
 // it should be modal.
 //
-// The dialog is shown with the [method@Gtk.ColorDialog.choose_rgba]
+// The dialog is shown with the [ColorDialog.ChooseRgba]
 // function.
 //
-// See [class@Gtk.ColorDialogButton] for a convenient control
+// See [ColorDialogButton] for a convenient control
 // that uses `GtkColorDialog` and presents the results.
 type ColorDialog struct {
 	gobject.Object
@@ -79,11 +78,12 @@ func (x *ColorDialog) ChooseRgba(ParentVar *Window, InitialColorVar *gdk.RGBA, C
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -93,16 +93,16 @@ func (x *ColorDialog) ChooseRgba(ParentVar *Window, InitialColorVar *gdk.RGBA, C
 
 var xColorDialogChooseRgbaFinish func(uintptr, uintptr, **glib.Error) *gdk.RGBA
 
-// Finishes the [method@Gtk.ColorDialog.choose_rgba] call
+// Finishes the [ColorDialog.ChooseRgba] call
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *ColorDialog) ChooseRgbaFinish(ResultVar gio.AsyncResult) (*gdk.RGBA, error) {
 	var cerr *glib.Error
 
 	cret := xColorDialogChooseRgbaFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -241,7 +241,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -250,15 +250,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorDialogGLibType, libs, "gtk_color_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewColorDialog, libs, "gtk_color_dialog_new")
+	gobject.CheckClassSize("GtkColorDialogClass", xColorDialogGLibType, unsafe.Sizeof(ColorDialogClass{}))
 
-	core.PuregoSafeRegister(&xColorDialogChooseRgba, libs, "gtk_color_dialog_choose_rgba")
-	core.PuregoSafeRegister(&xColorDialogChooseRgbaFinish, libs, "gtk_color_dialog_choose_rgba_finish")
-	core.PuregoSafeRegister(&xColorDialogGetModal, libs, "gtk_color_dialog_get_modal")
-	core.PuregoSafeRegister(&xColorDialogGetTitle, libs, "gtk_color_dialog_get_title")
-	core.PuregoSafeRegister(&xColorDialogGetWithAlpha, libs, "gtk_color_dialog_get_with_alpha")
-	core.PuregoSafeRegister(&xColorDialogSetModal, libs, "gtk_color_dialog_set_modal")
-	core.PuregoSafeRegister(&xColorDialogSetTitle, libs, "gtk_color_dialog_set_title")
-	core.PuregoSafeRegister(&xColorDialogSetWithAlpha, libs, "gtk_color_dialog_set_with_alpha")
+	core.PuregoRegisterSince(&xNewColorDialog, libs, "gtk_color_dialog_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xColorDialogChooseRgba, libs, "gtk_color_dialog_choose_rgba", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogChooseRgbaFinish, libs, "gtk_color_dialog_choose_rgba_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogGetModal, libs, "gtk_color_dialog_get_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogGetTitle, libs, "gtk_color_dialog_get_title", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogGetWithAlpha, libs, "gtk_color_dialog_get_with_alpha", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogSetModal, libs, "gtk_color_dialog_set_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogSetTitle, libs, "gtk_color_dialog_set_title", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogSetWithAlpha, libs, "gtk_color_dialog_set_with_alpha", "GTK", "4.10")
 
 }
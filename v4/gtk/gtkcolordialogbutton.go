@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -25,24 +24,13 @@ func (x *ColorDialogButtonClass) GoPointer() uintptr {
 
 // Opens a color chooser dialog to select a color.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="color-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkColorDialogButton" src="color-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is suitable widget for selecting a color in a preference dialog.
 //
 // # CSS nodes
 //
-// ```
-// colorbutton
-// ╰── button.color
-//
-//	╰── [content]
-//
-// ```
+//	colorbutton
+//	╰── button.color
+//	    ╰── [content]
 //
 // `GtkColorDialogButton` has a single CSS node with name colorbutton which
 // contains a button node. To differentiate it from a plain `GtkButton`,
@@ -86,7 +74,7 @@ func NewColorDialogButton(DialogVar *ColorDialog) *ColorDialogButton {
 
 var xColorDialogButtonGetDialog func(uintptr) uintptr
 
-// Returns the `GtkColorDialog` of @self.
+// Returns the `GtkColorDialog` of self.
 func (x *ColorDialogButton) GetDialog() *ColorDialog {
 	var cls *ColorDialog
 
@@ -180,27 +168,49 @@ func (x *ColorDialogButton) GetPropertyRgba() uintptr {
 //
 // The `::activate` signal on `GtkColorDialogButton` is an action signal
 // and emitting it causes the button to pop up its dialog.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *ColorDialogButton) ConnectActivate(cb *func(ColorDialogButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ColorDialogButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xColorDialogButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xColorDialogButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ColorDialogButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ColorDialogButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ColorDialogButton) ConnectActivateFunc(cb func(ColorDialogButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xColorDialogButtonActivateTrampoline, xColorDialogButtonActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -364,7 +374,7 @@ func (x *ColorDialogButton) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -372,14 +382,13 @@ func (x *ColorDialogButton) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ColorDialogButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -403,19 +412,17 @@ func (x *ColorDialogButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ColorDialogButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -436,7 +443,7 @@ func (x *ColorDialogButton) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -445,14 +452,12 @@ func (x *ColorDialogButton) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ColorDialogButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -471,10 +476,10 @@ func (x *ColorDialogButton) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ColorDialogButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -486,7 +491,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -495,11 +500,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorDialogButtonGLibType, libs, "gtk_color_dialog_button_get_type")
 
-	core.PuregoSafeRegister(&xNewColorDialogButton, libs, "gtk_color_dialog_button_new")
+	gobject.CheckClassSize("GtkColorDialogButtonClass", xColorDialogButtonGLibType, unsafe.Sizeof(ColorDialogButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewColorDialogButton, libs, "gtk_color_dialog_button_new", "GTK", "4.10")
 
-	core.PuregoSafeRegister(&xColorDialogButtonGetDialog, libs, "gtk_color_dialog_button_get_dialog")
-	core.PuregoSafeRegister(&xColorDialogButtonGetRgba, libs, "gtk_color_dialog_button_get_rgba")
-	core.PuregoSafeRegister(&xColorDialogButtonSetDialog, libs, "gtk_color_dialog_button_set_dialog")
-	core.PuregoSafeRegister(&xColorDialogButtonSetRgba, libs, "gtk_color_dialog_button_set_rgba")
+	core.PuregoRegisterSince(&xColorDialogButtonGetDialog, libs, "gtk_color_dialog_button_get_dialog", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogButtonGetRgba, libs, "gtk_color_dialog_button_get_rgba", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogButtonSetDialog, libs, "gtk_color_dialog_button_set_dialog", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColorDialogButtonSetRgba, libs, "gtk_color_dialog_button_set_rgba", "GTK", "4.10")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -35,14 +34,14 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xHsvToRgb, libs, "gtk_hsv_to_rgb")
-	core.PuregoSafeRegister(&xRgbToHsv, libs, "gtk_rgb_to_hsv")
+	core.PuregoRegisterSince(&xHsvToRgb, libs, "gtk_hsv_to_rgb", "GTK", "")
+	core.PuregoRegisterSince(&xRgbToHsv, libs, "gtk_rgb_to_hsv", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -27,64 +26,62 @@ func (x *ColumnViewClass) GoPointer() uintptr {
 // each column, for each visible item and displays them together as the row for
 // this item.
 //
-// The [property@Gtk.ColumnView:show-row-separators] and
-// [property@Gtk.ColumnView:show-column-separators] properties offer a simple way
+// The [ColumnView.GetPropertyShowRowSeparators] and
+// [ColumnView.GetPropertyShowColumnSeparators] properties offer a simple way
 // to display separators between the rows or columns.
 //
 // `GtkColumnView` allows the user to select items according to the selection
 // characteristics of the model. For models that allow multiple selected items,
 // it is possible to turn on *rubberband selection*, using
-// [property@Gtk.ColumnView:enable-rubberband].
+// [ColumnView.GetPropertyEnableRubberband].
 //
 // The column view supports sorting that can be customized by the user by
 // clicking on column headers. To set this up, the `GtkSorter` returned by
-// [method@Gtk.ColumnView.get_sorter] must be attached to a sort model for the
+// [ColumnView.GetSorter] must be attached to a sort model for the
 // data that the view is showing, and the columns must have sorters attached to
-// them by calling [method@Gtk.ColumnViewColumn.set_sorter]. The initial sort
-// order can be set with [method@Gtk.ColumnView.sort_by_column].
+// them by calling [ColumnViewColumn.SetSorter]. The initial sort
+// order can be set with [ColumnView.SortByColumn].
 //
 // The column view also supports interactive resizing and reordering of
 // columns, via Drag-and-Drop of the column headers. This can be enabled or
-// disabled with the [property@Gtk.ColumnView:reorderable] and
-// [property@Gtk.ColumnViewColumn:resizable] properties.
+// disabled with the [ColumnView.GetPropertyReorderable] and
+// [ColumnViewColumn.GetPropertyResizable] properties.
 //
 // To learn more about the list widget framework, see the
-// [overview](section-list-widget.html).
+// overview.
 //
 // # CSS nodes
 //
-// ```
-// columnview[.column-separators][.rich-list][.navigation-sidebar][.data-table]
-// ├── header
-// │   ├── &lt;column header&gt;
-// ┊   ┊
-// │   ╰── &lt;column header&gt;
-// │
-// ├── listview
-// │
-// ┊
-// ╰── [rubberband]
-// ```
+//	columnview[.column-separators][.rich-list][.navigation-sidebar][.data-table]
+//	├── header
+//	│   ├── <column header>
+//	┊   ┊
+//	│   ╰── <column header>
+//	│
+//	├── listview
+//	│
+//	┊
+//	╰── [rubberband]
 //
 // `GtkColumnView` uses a single CSS node named columnview. It may carry the
-// .column-separators style class, when [property@Gtk.ColumnView:show-column-separators]
+// .column-separators style class, when [ColumnView.GetPropertyShowColumnSeparators]
 // property is set. Header widgets appear below a node with name header.
 // The rows are contained in a `GtkListView` widget, so there is a listview
 // node with the same structure as for a standalone `GtkListView` widget.
-// If [property@Gtk.ColumnView:show-row-separators] is set, it will be passed
+// If [ColumnView.GetPropertyShowRowSeparators] is set, it will be passed
 // on to the list view, causing its CSS node to carry the .separators style class.
 // For rubberband selection, a node with name rubberband is used.
 //
 // The main columnview node may also carry style classes to select
-// the style of [list presentation](section-list-widget.html#list-styles):
+// the style of list presentation:
 // .rich-list, .navigation-sidebar or .data-table.
 //
 // # Accessibility
 //
-// `GtkColumnView` uses the [enum@Gtk.AccessibleRole.tree_grid] role, header title
-// widgets are using the [enum@Gtk.AccessibleRole.column_header] role. The row widgets
-// are using the [enum@Gtk.AccessibleRole.row] role, and individual cells are using
-// the [enum@Gtk.AccessibleRole.grid_cell] role
+// `GtkColumnView` uses the [AccessibleRoleTreeGridValue] role, header title
+// widgets are using the [AccessibleRoleColumnHeaderValue] role. The row widgets
+// are using the [AccessibleRoleRowValue] role, and individual cells are using
+// the [AccessibleRoleGridCellValue] role
 type ColumnView struct {
 	Widget
 }
@@ -105,7 +102,7 @@ var xNewColumnView func(uintptr) uintptr
 
 // Creates a new `GtkColumnView`.
 //
-// You most likely want to call [method@Gtk.ColumnView.append_column]
+// You most likely want to call [ColumnView.AppendColumn]
 // to add columns next.
 func NewColumnView(ModelVar SelectionModel) *ColumnView {
 	var cls *ColumnView
@@ -123,7 +120,7 @@ func NewColumnView(ModelVar SelectionModel) *ColumnView {
 
 var xColumnViewAppendColumn func(uintptr, uintptr)
 
-// Appends the @column to the end of the columns in @self.
+// Appends the column to the end of the columns in self.
 func (x *ColumnView) AppendColumn(ColumnVar *ColumnViewColumn) {
 
 	xColumnViewAppendColumn(x.GoPointer(), ColumnVar.GoPointer())
@@ -134,9 +131,9 @@ var xColumnViewGetColumns func(uintptr) uintptr
 
 // Gets the list of columns in this column view.
 //
-// This list is constant over the lifetime of @self and can be used to
-// monitor changes to the columns of @self by connecting to the
-// [signal@Gio.ListModel::items-changed] signal.
+// This list is constant over the lifetime of self and can be used to
+// monitor changes to the columns of self by connecting to the
+// ListModel::items-changed signal.
 func (x *ColumnView) GetColumns() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -205,7 +202,7 @@ func (x *ColumnView) GetReorderable() bool {
 
 var xColumnViewGetRowFactory func(uintptr) uintptr
 
-// Gets the factory set via [method@Gtk.ColumnView.set_row_factory].
+// Gets the factory set via [ColumnView.SetRowFactory].
 func (x *ColumnView) GetRowFactory() *ListItemFactory {
 	var cls *ListItemFactory
 
@@ -257,18 +254,17 @@ var xColumnViewGetSorter func(uintptr) uintptr
 // headers, this sorter needs to be set on the sort model underneath
 // the model that is displayed by the view.
 //
-// See [method@Gtk.ColumnViewColumn.set_sorter] for setting up
+// See [ColumnViewColumn.SetSorter] for setting up
 // per-column sorting.
 //
 // Here is an example:
-// ```c
-// gtk_column_view_column_set_sorter (column, sorter);
-// gtk_column_view_append_column (view, column);
-// sorter = g_object_ref (gtk_column_view_get_sorter (view)));
-// model = gtk_sort_list_model_new (store, sorter);
-// selection = gtk_no_selection_new (model);
-// gtk_column_view_set_model (view, selection);
-// ```
+//
+//	gtk_column_view_column_set_sorter (column, sorter);
+//	gtk_column_view_append_column (view, column);
+//	sorter = g_object_ref (gtk_column_view_get_sorter (view)));
+//	model = gtk_sort_list_model_new (store, sorter);
+//	selection = gtk_no_selection_new (model);
+//	gtk_column_view_set_model (view, selection);
 func (x *ColumnView) GetSorter() *Sorter {
 	var cls *Sorter
 
@@ -285,7 +281,7 @@ func (x *ColumnView) GetSorter() *Sorter {
 
 var xColumnViewGetTabBehavior func(uintptr) ListTabBehavior
 
-// Gets the behavior set for the &lt;kbd&gt;Tab&lt;/kbd&gt; key.
+// Gets the behavior set for the Tab key.
 func (x *ColumnView) GetTabBehavior() ListTabBehavior {
 
 	cret := xColumnViewGetTabBehavior(x.GoPointer())
@@ -294,9 +290,9 @@ func (x *ColumnView) GetTabBehavior() ListTabBehavior {
 
 var xColumnViewInsertColumn func(uintptr, uint, uintptr)
 
-// Inserts a column at the given position in the columns of @self.
+// Inserts a column at the given position in the columns of self.
 //
-// If @column is already a column of @self, it will be repositioned.
+// If column is already a column of self, it will be repositioned.
 func (x *ColumnView) InsertColumn(PositionVar uint, ColumnVar *ColumnViewColumn) {
 
 	xColumnViewInsertColumn(x.GoPointer(), PositionVar, ColumnVar.GoPointer())
@@ -305,23 +301,23 @@ func (x *ColumnView) InsertColumn(PositionVar uint, ColumnVar *ColumnViewColumn)
 
 var xColumnViewRemoveColumn func(uintptr, uintptr)
 
-// Removes the @column from the list of columns of @self.
+// Removes the column from the list of columns of self.
 func (x *ColumnView) RemoveColumn(ColumnVar *ColumnViewColumn) {
 
 	xColumnViewRemoveColumn(x.GoPointer(), ColumnVar.GoPointer())
 
 }
 
-var xColumnViewScrollTo func(uintptr, uint, uintptr, ListScrollFlags, *ScrollInfo)
+var xColumnViewScrollTo func(uintptr, uint, uintptr, ListScrollFlags, uintptr)
 
 // Scroll to the row at the given position - or cell if a column is
-// given - and performs the actions specified in @flags.
+// given - and performs the actions specified in flags.
 //
 // This function works no matter if the columnview is shown or focused.
 // If it isn't, then the changes will take effect once that happens.
 func (x *ColumnView) ScrollTo(PosVar uint, ColumnVar *ColumnViewColumn, FlagsVar ListScrollFlags, ScrollVar *ScrollInfo) {
 
-	xColumnViewScrollTo(x.GoPointer(), PosVar, ColumnVar.GoPointer(), FlagsVar, ScrollVar)
+	xColumnViewScrollTo(x.GoPointer(), PosVar, ColumnVar.GoPointer(), FlagsVar, gobject.BoxedCopyNullable(ScrollInfoGLibType(), ScrollVar.GoPointer()))
 
 }
 
@@ -337,7 +333,7 @@ func (x *ColumnView) SetEnableRubberband(EnableRubberbandVar bool) {
 var xColumnViewSetHeaderFactory func(uintptr, uintptr)
 
 // Sets the factory to use for populating the
-// [class@Gtk.ListHeader] objects used in section headers.
+// [ListHeader] objects used in section headers.
 //
 // If this factory is set to `NULL`, the list will not show
 // section headers.
@@ -351,7 +347,7 @@ var xColumnViewSetModel func(uintptr, uintptr)
 
 // Sets the model to use.
 //
-// This must be a [iface@Gtk.SelectionModel].
+// This must be a [SelectionModel].
 func (x *ColumnView) SetModel(ModelVar SelectionModel) {
 
 	xColumnViewSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -371,14 +367,14 @@ var xColumnViewSetRowFactory func(uintptr, uintptr)
 
 // Sets the factory used for configuring rows.
 //
-// The factory must be for configuring [class@Gtk.ColumnViewRow] objects.
+// The factory must be for configuring [ColumnViewRow] objects.
 //
 // If this factory is not set - which is the default - then the defaults
 // will be used.
 //
 // This factory is not used to set the widgets displayed in the individual
-// cells. For that see [method@GtkColumnViewColumn.set_factory] and
-// [class@GtkColumnViewCell].
+// cells. For that see set_factory and
+// GtkColumnViewCell.
 func (x *ColumnView) SetRowFactory(FactoryVar *ListItemFactory) {
 
 	xColumnViewSetRowFactory(x.GoPointer(), FactoryVar.GoPointer())
@@ -415,10 +411,10 @@ func (x *ColumnView) SetSingleClickActivate(SingleClickActivateVar bool) {
 
 var xColumnViewSetTabBehavior func(uintptr, ListTabBehavior)
 
-// Sets the &lt;kbd&gt;Tab&lt;/kbd&gt; key behavior.
+// Sets the Tab key behavior.
 //
-// This influences how the &lt;kbd&gt;Tab&lt;/kbd&gt; and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; keys move the
+// This influences how the Tab and
+// Shift+Tab keys move the
 // focus in the columnview.
 func (x *ColumnView) SetTabBehavior(TabBehaviorVar ListTabBehavior) {
 
@@ -435,11 +431,11 @@ var xColumnViewSortByColumn func(uintptr, uintptr, SortType)
 // by clicking on the list headers.
 //
 // This call only has an effect if the sorter returned by
-// [method@Gtk.ColumnView.get_sorter] is set on a sort model,
-// and [method@Gtk.ColumnViewColumn.set_sorter] has been called
-// on @column to associate a sorter with the column.
+// [ColumnView.GetSorter] is set on a sort model,
+// and [ColumnViewColumn.SetSorter] has been called
+// on column to associate a sorter with the column.
 //
-// If @column is unset, the view will be unsorted.
+// If column is unset, the view will be unsorted.
 func (x *ColumnView) SortByColumn(ColumnVar *ColumnViewColumn, DirectionVar SortType) {
 
 	xColumnViewSortByColumn(x.GoPointer(), ColumnVar.GoPointer(), DirectionVar)
@@ -546,29 +542,51 @@ func (x *ColumnView) GetPropertySingleClickActivate() bool {
 // the GtkListBase|list.activate-item action.
 //
 // This allows for a convenient way to handle activation in a columnview.
-// See [method@Gtk.ListItem.set_activatable] for details on how to use this
+// See [ListItem.SetActivatable] for details on how to use this
 // signal.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *ColumnView) ConnectActivate(cb *func(ColumnView, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp uint) {
+			defer core.RecoverPanic()
+			fa := ColumnView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PositionVarp uint) {
+var xColumnViewActivateTrampoline uintptr
+
+func xColumnViewActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ColumnView, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ColumnView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ColumnView) ConnectActivateFunc(cb func(ColumnView, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xColumnViewActivateTrampoline, xColumnViewActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -732,7 +750,7 @@ func (x *ColumnView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -740,14 +758,13 @@ func (x *ColumnView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ColumnView) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -771,19 +788,17 @@ func (x *ColumnView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ColumnView) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -804,7 +819,7 @@ func (x *ColumnView) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -813,14 +828,12 @@ func (x *ColumnView) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ColumnView) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -839,10 +852,10 @@ func (x *ColumnView) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ColumnView) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -944,7 +957,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -953,32 +966,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xColumnViewGLibType, libs, "gtk_column_view_get_type")
 
-	core.PuregoSafeRegister(&xNewColumnView, libs, "gtk_column_view_new")
-
-	core.PuregoSafeRegister(&xColumnViewAppendColumn, libs, "gtk_column_view_append_column")
-	core.PuregoSafeRegister(&xColumnViewGetColumns, libs, "gtk_column_view_get_columns")
-	core.PuregoSafeRegister(&xColumnViewGetEnableRubberband, libs, "gtk_column_view_get_enable_rubberband")
-	core.PuregoSafeRegister(&xColumnViewGetHeaderFactory, libs, "gtk_column_view_get_header_factory")
-	core.PuregoSafeRegister(&xColumnViewGetModel, libs, "gtk_column_view_get_model")
-	core.PuregoSafeRegister(&xColumnViewGetReorderable, libs, "gtk_column_view_get_reorderable")
-	core.PuregoSafeRegister(&xColumnViewGetRowFactory, libs, "gtk_column_view_get_row_factory")
-	core.PuregoSafeRegister(&xColumnViewGetShowColumnSeparators, libs, "gtk_column_view_get_show_column_separators")
-	core.PuregoSafeRegister(&xColumnViewGetShowRowSeparators, libs, "gtk_column_view_get_show_row_separators")
-	core.PuregoSafeRegister(&xColumnViewGetSingleClickActivate, libs, "gtk_column_view_get_single_click_activate")
-	core.PuregoSafeRegister(&xColumnViewGetSorter, libs, "gtk_column_view_get_sorter")
-	core.PuregoSafeRegister(&xColumnViewGetTabBehavior, libs, "gtk_column_view_get_tab_behavior")
-	core.PuregoSafeRegister(&xColumnViewInsertColumn, libs, "gtk_column_view_insert_column")
-	core.PuregoSafeRegister(&xColumnViewRemoveColumn, libs, "gtk_column_view_remove_column")
-	core.PuregoSafeRegister(&xColumnViewScrollTo, libs, "gtk_column_view_scroll_to")
-	core.PuregoSafeRegister(&xColumnViewSetEnableRubberband, libs, "gtk_column_view_set_enable_rubberband")
-	core.PuregoSafeRegister(&xColumnViewSetHeaderFactory, libs, "gtk_column_view_set_header_factory")
-	core.PuregoSafeRegister(&xColumnViewSetModel, libs, "gtk_column_view_set_model")
-	core.PuregoSafeRegister(&xColumnViewSetReorderable, libs, "gtk_column_view_set_reorderable")
-	core.PuregoSafeRegister(&xColumnViewSetRowFactory, libs, "gtk_column_view_set_row_factory")
-	core.PuregoSafeRegister(&xColumnViewSetShowColumnSeparators, libs, "gtk_column_view_set_show_column_separators")
-	core.PuregoSafeRegister(&xColumnViewSetShowRowSeparators, libs, "gtk_column_view_set_show_row_separators")
-	core.PuregoSafeRegister(&xColumnViewSetSingleClickActivate, libs, "gtk_column_view_set_single_click_activate")
-	core.PuregoSafeRegister(&xColumnViewSetTabBehavior, libs, "gtk_column_view_set_tab_behavior")
-	core.PuregoSafeRegister(&xColumnViewSortByColumn, libs, "gtk_column_view_sort_by_column")
+	core.PuregoRegisterSince(&xNewColumnView, libs, "gtk_column_view_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xColumnViewAppendColumn, libs, "gtk_column_view_append_column", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetColumns, libs, "gtk_column_view_get_columns", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetEnableRubberband, libs, "gtk_column_view_get_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetHeaderFactory, libs, "gtk_column_view_get_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewGetModel, libs, "gtk_column_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetReorderable, libs, "gtk_column_view_get_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetRowFactory, libs, "gtk_column_view_get_row_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewGetShowColumnSeparators, libs, "gtk_column_view_get_show_column_separators", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetShowRowSeparators, libs, "gtk_column_view_get_show_row_separators", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetSingleClickActivate, libs, "gtk_column_view_get_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetSorter, libs, "gtk_column_view_get_sorter", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewGetTabBehavior, libs, "gtk_column_view_get_tab_behavior", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewInsertColumn, libs, "gtk_column_view_insert_column", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewRemoveColumn, libs, "gtk_column_view_remove_column", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewScrollTo, libs, "gtk_column_view_scroll_to", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewSetEnableRubberband, libs, "gtk_column_view_set_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetHeaderFactory, libs, "gtk_column_view_set_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewSetModel, libs, "gtk_column_view_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetReorderable, libs, "gtk_column_view_set_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetRowFactory, libs, "gtk_column_view_set_row_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewSetShowColumnSeparators, libs, "gtk_column_view_set_show_column_separators", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetShowRowSeparators, libs, "gtk_column_view_set_show_row_separators", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetSingleClickActivate, libs, "gtk_column_view_set_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewSetTabBehavior, libs, "gtk_column_view_set_tab_behavior", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewSortByColumn, libs, "gtk_column_view_sort_by_column", "GTK", "")
 
 }
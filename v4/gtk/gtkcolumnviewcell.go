@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -19,21 +18,21 @@ func (x *ColumnViewCellClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Represents items in a cell in [class@Gtk.ColumnView].
+// Represents items in a cell in [ColumnView].
 //
-// The `GtkColumnViewCell`s are managed by the [class@Gtk.ColumnView]
+// The `GtkColumnViewCell`s are managed by the [ColumnView]
 // widget (with its factory) and cannot be created by applications, but
 // they need to be populated by application code. This is done by calling
-// [method@Gtk.ColumnViewCell.set_child].
+// [ColumnViewCell.SetChild].
 //
 // `GtkColumnViewCell`s exist in 2 stages:
 //
 //  1. The unbound stage where the listitem is not currently connected to
-//     an item in the list. In that case, the [property@Gtk.ColumnViewCell:item]
-//     property is set to %NULL.
+//     an item in the list. In that case, the ColumnViewCell:item
+//     property is set to nil.
 //
 //  2. The bound stage where the listitem references an item from the list.
-//     The [property@Gtk.ColumnViewCell:item] property is not %NULL.
+//     The ColumnViewCell:item property is not nil.
 type ColumnViewCell struct {
 	ListItem
 }
@@ -52,8 +51,8 @@ func ColumnViewCellNewFromInternalPtr(ptr uintptr) *ColumnViewCell {
 
 var xColumnViewCellGetChild func(uintptr) uintptr
 
-// Gets the child previously set via gtk_column_view_cell_set_child() or
-// %NULL if none was set.
+// Gets the child previously set via [ColumnViewCell.SetChild] or
+// nil if none was set.
 func (x *ColumnViewCell) GetChild() *Widget {
 	var cls *Widget
 
@@ -71,7 +70,7 @@ func (x *ColumnViewCell) GetChild() *Widget {
 var xColumnViewCellGetFocusable func(uintptr) bool
 
 // Checks if a list item has been set to be focusable via
-// gtk_column_view_cell_set_focusable().
+// [ColumnViewCell.SetFocusable].
 func (x *ColumnViewCell) GetFocusable() bool {
 
 	cret := xColumnViewCellGetFocusable(x.GoPointer())
@@ -80,9 +79,9 @@ func (x *ColumnViewCell) GetFocusable() bool {
 
 var xColumnViewCellGetItem func(uintptr) uintptr
 
-// Gets the model item that associated with @self.
+// Gets the model item that associated with self.
 //
-// If @self is unbound, this function returns %NULL.
+// If self is unbound, this function returns nil.
 func (x *ColumnViewCell) GetItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -99,9 +98,9 @@ func (x *ColumnViewCell) GetItem() *gobject.Object {
 
 var xColumnViewCellGetPosition func(uintptr) uint
 
-// Gets the position in the model that @self currently displays.
+// Gets the position in the model that self currently displays.
 //
-// If @self is unbound, %GTK_INVALID_LIST_POSITION is returned.
+// If self is unbound, [INVALID_LIST_POSITION] is returned.
 func (x *ColumnViewCell) GetPosition() uint {
 
 	cret := xColumnViewCellGetPosition(x.GoPointer())
@@ -135,10 +134,10 @@ func (x *ColumnViewCell) SetChild(ChildVar *Widget) {
 
 var xColumnViewCellSetFocusable func(uintptr, bool)
 
-// Sets @self to be focusable.
+// Sets self to be focusable.
 //
 // If an item is focusable, it can be focused using the keyboard.
-// This works similar to [method@Gtk.Widget.set_focusable].
+// This works similar to [Widget.SetFocusable].
 //
 // Note that if items are not focusable, the keyboard cannot be used to activate
 // them and selecting only works if one of the listitem's children is focusable.
@@ -199,7 +198,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -208,12 +207,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xColumnViewCellGLibType, libs, "gtk_column_view_cell_get_type")
 
-	core.PuregoSafeRegister(&xColumnViewCellGetChild, libs, "gtk_column_view_cell_get_child")
-	core.PuregoSafeRegister(&xColumnViewCellGetFocusable, libs, "gtk_column_view_cell_get_focusable")
-	core.PuregoSafeRegister(&xColumnViewCellGetItem, libs, "gtk_column_view_cell_get_item")
-	core.PuregoSafeRegister(&xColumnViewCellGetPosition, libs, "gtk_column_view_cell_get_position")
-	core.PuregoSafeRegister(&xColumnViewCellGetSelected, libs, "gtk_column_view_cell_get_selected")
-	core.PuregoSafeRegister(&xColumnViewCellSetChild, libs, "gtk_column_view_cell_set_child")
-	core.PuregoSafeRegister(&xColumnViewCellSetFocusable, libs, "gtk_column_view_cell_set_focusable")
+	core.PuregoRegisterSince(&xColumnViewCellGetChild, libs, "gtk_column_view_cell_get_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellGetFocusable, libs, "gtk_column_view_cell_get_focusable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellGetItem, libs, "gtk_column_view_cell_get_item", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellGetPosition, libs, "gtk_column_view_cell_get_position", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellGetSelected, libs, "gtk_column_view_cell_get_selected", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellSetChild, libs, "gtk_column_view_cell_set_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewCellSetFocusable, libs, "gtk_column_view_cell_set_focusable", "GTK", "4.12")
 
 }
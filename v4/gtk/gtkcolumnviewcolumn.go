@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -27,10 +26,10 @@ func (x *ColumnViewColumnClass) GoPointer() uintptr {
 // the model.
 //
 // Columns have a title, and can optionally have a header menu set
-// with [method@Gtk.ColumnViewColumn.set_header_menu].
+// with [ColumnViewColumn.SetHeaderMenu].
 //
 // A sorter can be associated with a column using
-// [method@Gtk.ColumnViewColumn.set_sorter], to let users influence sorting
+// [ColumnViewColumn.SetSorter], to let users influence sorting
 // by clicking on the column header.
 type ColumnViewColumn struct {
 	gobject.Object
@@ -50,19 +49,15 @@ func ColumnViewColumnNewFromInternalPtr(ptr uintptr) *ColumnViewColumn {
 
 var xNewColumnViewColumn func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkColumnViewColumn` that uses the given @factory for
+// Creates a new `GtkColumnViewColumn` that uses the given factory for
 // mapping items to widgets.
 //
-// You most likely want to call [method@Gtk.ColumnView.append_column] next.
+// You most likely want to call [ColumnView.AppendColumn] next.
 //
 // The function takes ownership of the argument, so you can write code like:
 //
-// ```c
-// column = gtk_column_view_column_new (_("Name"),
-//
-//	gtk_builder_list_item_factory_new_from_resource ("/name.ui"));
-//
-// ```
+//	column = gtk_column_view_column_new (_("Name"),
+//	  gtk_builder_list_item_factory_new_from_resource ("/name.ui"));
 func NewColumnViewColumn(TitleVar *string, FactoryVar *ListItemFactory) *ColumnViewColumn {
 	var cls *ColumnViewColumn
 
@@ -83,7 +78,7 @@ var xColumnViewColumnGetColumnView func(uintptr) uintptr
 
 // Gets the column view that's currently displaying this column.
 //
-// If @self has not been added to a column view yet, `NULL` is returned.
+// If self has not been added to a column view yet, `NULL` is returned.
 func (x *ColumnViewColumn) GetColumnView() *ColumnView {
 	var cls *ColumnView
 
@@ -154,7 +149,7 @@ func (x *ColumnViewColumn) GetHeaderMenu() *gio.MenuModel {
 
 var xColumnViewColumnGetId func(uintptr) string
 
-// Returns the ID set with [method@Gtk.ColumnViewColumn.set_id].
+// Returns the ID set with [ColumnViewColumn.SetId].
 func (x *ColumnViewColumn) GetId() string {
 
 	cret := xColumnViewColumnGetId(x.GoPointer())
@@ -189,7 +184,7 @@ func (x *ColumnViewColumn) GetSorter() *Sorter {
 
 var xColumnViewColumnGetTitle func(uintptr) string
 
-// Returns the title set with [method@Gtk.ColumnViewColumn.set_title].
+// Returns the title set with [ColumnViewColumn.SetTitle].
 func (x *ColumnViewColumn) GetTitle() string {
 
 	cret := xColumnViewColumnGetTitle(x.GoPointer())
@@ -231,7 +226,7 @@ var xColumnViewColumnSetFixedWidth func(uintptr, int)
 
 // Sets the fixed width of the column.
 //
-// If @fixed_width is -1, the fixed width of the column is unset.
+// If fixed_width is -1, the fixed width of the column is unset.
 //
 // Setting a fixed width overrides the automatically calculated
 // width. Interactive resizing also sets the “fixed-width” property.
@@ -281,14 +276,14 @@ var xColumnViewColumnSetSorter func(uintptr, uintptr)
 
 // Associates a sorter with the column.
 //
-// If @sorter is unset, the column will not let users change
+// If sorter is unset, the column will not let users change
 // the sorting by clicking on its header.
 //
 // This sorter can be made active by clicking on the column
-// header, or by calling [method@Gtk.ColumnView.sort_by_column].
+// header, or by calling [ColumnView.SortByColumn].
 //
-// See [method@Gtk.ColumnView.get_sorter] for the necessary steps
-// for setting up customizable sorting for [class@Gtk.ColumnView].
+// See [ColumnView.GetSorter] for the necessary steps
+// for setting up customizable sorting for [ColumnView].
 func (x *ColumnViewColumn) SetSorter(SorterVar *Sorter) {
 
 	xColumnViewColumnSetSorter(x.GoPointer(), SorterVar.GoPointer())
@@ -452,7 +447,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -461,26 +456,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xColumnViewColumnGLibType, libs, "gtk_column_view_column_get_type")
 
-	core.PuregoSafeRegister(&xNewColumnViewColumn, libs, "gtk_column_view_column_new")
-
-	core.PuregoSafeRegister(&xColumnViewColumnGetColumnView, libs, "gtk_column_view_column_get_column_view")
-	core.PuregoSafeRegister(&xColumnViewColumnGetExpand, libs, "gtk_column_view_column_get_expand")
-	core.PuregoSafeRegister(&xColumnViewColumnGetFactory, libs, "gtk_column_view_column_get_factory")
-	core.PuregoSafeRegister(&xColumnViewColumnGetFixedWidth, libs, "gtk_column_view_column_get_fixed_width")
-	core.PuregoSafeRegister(&xColumnViewColumnGetHeaderMenu, libs, "gtk_column_view_column_get_header_menu")
-	core.PuregoSafeRegister(&xColumnViewColumnGetId, libs, "gtk_column_view_column_get_id")
-	core.PuregoSafeRegister(&xColumnViewColumnGetResizable, libs, "gtk_column_view_column_get_resizable")
-	core.PuregoSafeRegister(&xColumnViewColumnGetSorter, libs, "gtk_column_view_column_get_sorter")
-	core.PuregoSafeRegister(&xColumnViewColumnGetTitle, libs, "gtk_column_view_column_get_title")
-	core.PuregoSafeRegister(&xColumnViewColumnGetVisible, libs, "gtk_column_view_column_get_visible")
-	core.PuregoSafeRegister(&xColumnViewColumnSetExpand, libs, "gtk_column_view_column_set_expand")
-	core.PuregoSafeRegister(&xColumnViewColumnSetFactory, libs, "gtk_column_view_column_set_factory")
-	core.PuregoSafeRegister(&xColumnViewColumnSetFixedWidth, libs, "gtk_column_view_column_set_fixed_width")
-	core.PuregoSafeRegister(&xColumnViewColumnSetHeaderMenu, libs, "gtk_column_view_column_set_header_menu")
-	core.PuregoSafeRegister(&xColumnViewColumnSetId, libs, "gtk_column_view_column_set_id")
-	core.PuregoSafeRegister(&xColumnViewColumnSetResizable, libs, "gtk_column_view_column_set_resizable")
-	core.PuregoSafeRegister(&xColumnViewColumnSetSorter, libs, "gtk_column_view_column_set_sorter")
-	core.PuregoSafeRegister(&xColumnViewColumnSetTitle, libs, "gtk_column_view_column_set_title")
-	core.PuregoSafeRegister(&xColumnViewColumnSetVisible, libs, "gtk_column_view_column_set_visible")
+	core.PuregoRegisterSince(&xNewColumnViewColumn, libs, "gtk_column_view_column_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xColumnViewColumnGetColumnView, libs, "gtk_column_view_column_get_column_view", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetExpand, libs, "gtk_column_view_column_get_expand", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetFactory, libs, "gtk_column_view_column_get_factory", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetFixedWidth, libs, "gtk_column_view_column_get_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetHeaderMenu, libs, "gtk_column_view_column_get_header_menu", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetId, libs, "gtk_column_view_column_get_id", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColumnViewColumnGetResizable, libs, "gtk_column_view_column_get_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetSorter, libs, "gtk_column_view_column_get_sorter", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetTitle, libs, "gtk_column_view_column_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnGetVisible, libs, "gtk_column_view_column_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetExpand, libs, "gtk_column_view_column_set_expand", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetFactory, libs, "gtk_column_view_column_set_factory", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetFixedWidth, libs, "gtk_column_view_column_set_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetHeaderMenu, libs, "gtk_column_view_column_set_header_menu", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetId, libs, "gtk_column_view_column_set_id", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColumnViewColumnSetResizable, libs, "gtk_column_view_column_set_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetSorter, libs, "gtk_column_view_column_set_sorter", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetTitle, libs, "gtk_column_view_column_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xColumnViewColumnSetVisible, libs, "gtk_column_view_column_set_visible", "GTK", "")
 
 }
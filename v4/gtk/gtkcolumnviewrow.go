@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -19,10 +18,10 @@ func (x *ColumnViewRowClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Configures how rows are displayed in a [class@Gtk.ColumnView].
+// Configures how rows are displayed in a [ColumnView].
 //
 // It is not used to set the widgets displayed in the individual cells. For that
-// see [method@GtkColumnViewColumn.set_factory] and [class@GtkColumnViewCell].
+// see set_factory and GtkColumnViewCell.
 type ColumnViewRow struct {
 	gobject.Object
 }
@@ -41,7 +40,7 @@ func ColumnViewRowNewFromInternalPtr(ptr uintptr) *ColumnViewRow {
 
 var xColumnViewRowGetAccessibleDescription func(uintptr) string
 
-// Gets the accessible description of @self.
+// Gets the accessible description of self.
 func (x *ColumnViewRow) GetAccessibleDescription() string {
 
 	cret := xColumnViewRowGetAccessibleDescription(x.GoPointer())
@@ -50,7 +49,7 @@ func (x *ColumnViewRow) GetAccessibleDescription() string {
 
 var xColumnViewRowGetAccessibleLabel func(uintptr) string
 
-// Gets the accessible label of @self.
+// Gets the accessible label of self.
 func (x *ColumnViewRow) GetAccessibleLabel() string {
 
 	cret := xColumnViewRowGetAccessibleLabel(x.GoPointer())
@@ -60,7 +59,7 @@ func (x *ColumnViewRow) GetAccessibleLabel() string {
 var xColumnViewRowGetActivatable func(uintptr) bool
 
 // Checks if the row has been set to be activatable via
-// gtk_column_view_row_set_activatable().
+// [ColumnViewRow.SetActivatable].
 func (x *ColumnViewRow) GetActivatable() bool {
 
 	cret := xColumnViewRowGetActivatable(x.GoPointer())
@@ -70,7 +69,7 @@ func (x *ColumnViewRow) GetActivatable() bool {
 var xColumnViewRowGetFocusable func(uintptr) bool
 
 // Checks if a row item has been set to be focusable via
-// gtk_column_view_row_set_focusable().
+// [ColumnViewRow.SetFocusable].
 func (x *ColumnViewRow) GetFocusable() bool {
 
 	cret := xColumnViewRowGetFocusable(x.GoPointer())
@@ -79,9 +78,9 @@ func (x *ColumnViewRow) GetFocusable() bool {
 
 var xColumnViewRowGetItem func(uintptr) uintptr
 
-// Gets the model item that associated with @self.
+// Gets the model item that associated with self.
 //
-// If @self is unbound, this function returns %NULL.
+// If self is unbound, this function returns nil.
 func (x *ColumnViewRow) GetItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -98,9 +97,9 @@ func (x *ColumnViewRow) GetItem() *gobject.Object {
 
 var xColumnViewRowGetPosition func(uintptr) uint
 
-// Gets the position in the model that @self currently displays.
+// Gets the position in the model that self currently displays.
 //
-// If @self is unbound, %GTK_INVALID_LIST_POSITION is returned.
+// If self is unbound, [INVALID_LIST_POSITION] is returned.
 func (x *ColumnViewRow) GetPosition() uint {
 
 	cret := xColumnViewRowGetPosition(x.GoPointer())
@@ -110,9 +109,9 @@ func (x *ColumnViewRow) GetPosition() uint {
 var xColumnViewRowGetSelectable func(uintptr) bool
 
 // Checks if the row has been set to be selectable via
-// gtk_column_view_row_set_selectable().
+// [ColumnViewRow.SetSelectable].
 //
-// Do not confuse this function with [method@Gtk.ColumnViewRow.get_selected].
+// Do not confuse this function with [ColumnViewRow.GetSelected].
 func (x *ColumnViewRow) GetSelectable() bool {
 
 	cret := xColumnViewRowGetSelectable(x.GoPointer())
@@ -153,12 +152,12 @@ func (x *ColumnViewRow) SetAccessibleLabel(LabelVar string) {
 
 var xColumnViewRowSetActivatable func(uintptr, bool)
 
-// Sets @self to be activatable.
+// Sets self to be activatable.
 //
 // If a row is activatable, double-clicking on the row, using
-// the Return key or calling gtk_widget_activate() will activate
+// the Return key or calling [Widget.Activate] will activate
 // the row. Activating instructs the containing columnview to
-// emit the [signal@Gtk.ColumnView::activate] signal.
+// emit the [ColumnView.ConnectActivateFunc] signal.
 //
 // By default, row are activatable.
 func (x *ColumnViewRow) SetActivatable(ActivatableVar bool) {
@@ -169,10 +168,10 @@ func (x *ColumnViewRow) SetActivatable(ActivatableVar bool) {
 
 var xColumnViewRowSetFocusable func(uintptr, bool)
 
-// Sets @self to be focusable.
+// Sets self to be focusable.
 //
 // If a row is focusable, it can be focused using the keyboard.
-// This works similar to [method@Gtk.Widget.set_focusable].
+// This works similar to [Widget.SetFocusable].
 //
 // Note that if row are not focusable, the contents of cells can still be focused if
 // they are focusable.
@@ -186,7 +185,7 @@ func (x *ColumnViewRow) SetFocusable(FocusableVar bool) {
 
 var xColumnViewRowSetSelectable func(uintptr, bool)
 
-// Sets @self to be selectable.
+// Sets self to be selectable.
 //
 // If a row is selectable, clicking on the row or using the keyboard
 // will try to select or unselect the row. Whether this succeeds is up to
@@ -320,7 +319,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -329,18 +328,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xColumnViewRowGLibType, libs, "gtk_column_view_row_get_type")
 
-	core.PuregoSafeRegister(&xColumnViewRowGetAccessibleDescription, libs, "gtk_column_view_row_get_accessible_description")
-	core.PuregoSafeRegister(&xColumnViewRowGetAccessibleLabel, libs, "gtk_column_view_row_get_accessible_label")
-	core.PuregoSafeRegister(&xColumnViewRowGetActivatable, libs, "gtk_column_view_row_get_activatable")
-	core.PuregoSafeRegister(&xColumnViewRowGetFocusable, libs, "gtk_column_view_row_get_focusable")
-	core.PuregoSafeRegister(&xColumnViewRowGetItem, libs, "gtk_column_view_row_get_item")
-	core.PuregoSafeRegister(&xColumnViewRowGetPosition, libs, "gtk_column_view_row_get_position")
-	core.PuregoSafeRegister(&xColumnViewRowGetSelectable, libs, "gtk_column_view_row_get_selectable")
-	core.PuregoSafeRegister(&xColumnViewRowGetSelected, libs, "gtk_column_view_row_get_selected")
-	core.PuregoSafeRegister(&xColumnViewRowSetAccessibleDescription, libs, "gtk_column_view_row_set_accessible_description")
-	core.PuregoSafeRegister(&xColumnViewRowSetAccessibleLabel, libs, "gtk_column_view_row_set_accessible_label")
-	core.PuregoSafeRegister(&xColumnViewRowSetActivatable, libs, "gtk_column_view_row_set_activatable")
-	core.PuregoSafeRegister(&xColumnViewRowSetFocusable, libs, "gtk_column_view_row_set_focusable")
-	core.PuregoSafeRegister(&xColumnViewRowSetSelectable, libs, "gtk_column_view_row_set_selectable")
+	core.PuregoRegisterSince(&xColumnViewRowGetAccessibleDescription, libs, "gtk_column_view_row_get_accessible_description", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetAccessibleLabel, libs, "gtk_column_view_row_get_accessible_label", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetActivatable, libs, "gtk_column_view_row_get_activatable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetFocusable, libs, "gtk_column_view_row_get_focusable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetItem, libs, "gtk_column_view_row_get_item", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetPosition, libs, "gtk_column_view_row_get_position", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetSelectable, libs, "gtk_column_view_row_get_selectable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowGetSelected, libs, "gtk_column_view_row_get_selected", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowSetAccessibleDescription, libs, "gtk_column_view_row_set_accessible_description", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowSetAccessibleLabel, libs, "gtk_column_view_row_set_accessible_label", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowSetActivatable, libs, "gtk_column_view_row_set_activatable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowSetFocusable, libs, "gtk_column_view_row_set_focusable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xColumnViewRowSetSelectable, libs, "gtk_column_view_row_set_selectable", "GTK", "4.12")
 
 }
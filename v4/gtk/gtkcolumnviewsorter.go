@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -21,9 +20,9 @@ func (x *ColumnViewSorterClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Sorts [class@Gtk.ColumnView] columns.
+// Sorts [ColumnView] columns.
 //
-// The sorter returned by [method@Gtk.ColumnView.get_sorter] is
+// The sorter returned by [ColumnView.GetSorter] is
 // a `GtkColumnViewSorter`.
 //
 // In column views, sorting can be configured by associating
@@ -33,27 +32,23 @@ func (x *ColumnViewSorterClass) GoPointer() uintptr {
 //
 // If you are only interested in the primary sort column (i.e. the
 // column where a sort indicator is shown in the header), then
-// you can just look at [property@Gtk.ColumnViewSorter:primary-sort-column]
-// and [property@Gtk.ColumnViewSorter:primary-sort-order].
+// you can just look at ColumnViewSorter:primary-sort-column
+// and ColumnViewSorter:primary-sort-order.
 //
 // If you want to store the full sort configuration, including
 // secondary sort columns that are used for tie breaking, then
-// you can use [method@Gtk.ColumnViewSorter.get_nth_sort_column].
-// To get notified about changes, use [signal@Gtk.Sorter::changed].
+// you can use [ColumnViewSorter.GetNthSortColumn].
+// To get notified about changes, use [Sorter.ConnectChangedFunc].
 //
 // To restore a saved sort configuration on a `GtkColumnView`,
 // use code like:
 //
-// ```
-// sorter = gtk_column_view_get_sorter (view);
-// for (i = gtk_column_view_sorter_get_n_sort_columns (sorter) - 1; i &gt;= 0; i--)
-//
-//	{
-//	  column = gtk_column_view_sorter_get_nth_sort_column (sorter, i, &amp;order);
-//	  gtk_column_view_sort_by_column (view, column, order);
-//	}
-//
-// ```
+//	sorter = gtk_column_view_get_sorter (view);
+//	for (i = gtk_column_view_sorter_get_n_sort_columns (sorter) - 1; i >= 0; i--)
+//	  {
+//	    column = gtk_column_view_sorter_get_nth_sort_column (sorter, i, &order);
+//	    gtk_column_view_sort_by_column (view, column, order);
+//	  }
 type ColumnViewSorter struct {
 	Sorter
 }
@@ -78,7 +73,7 @@ var xColumnViewSorterGetNSortColumns func(uintptr) uint
 // a total order, then the secondary sorters are consulted to
 // break the ties.
 //
-// Use the [signal@Gtk.Sorter::changed] signal to get notified
+// Use the [Sorter.ConnectChangedFunc] signal to get notified
 // when the number of sort columns changes.
 func (x *ColumnViewSorter) GetNSortColumns() uint {
 
@@ -88,9 +83,9 @@ func (x *ColumnViewSorter) GetNSortColumns() uint {
 
 var xColumnViewSorterGetNthSortColumn func(uintptr, uint, *SortType) uintptr
 
-// Gets the @position'th sort column and its associated sort order.
+// Gets the position'th sort column and its associated sort order.
 //
-// Use the [signal@Gtk.Sorter::changed] signal to get notified
+// Use the [Sorter.ConnectChangedFunc] signal to get notified
 // when sort columns change.
 func (x *ColumnViewSorter) GetNthSortColumn(PositionVar uint, SortOrderVar *SortType) *ColumnViewColumn {
 	var cls *ColumnViewColumn
@@ -158,7 +153,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -167,9 +162,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xColumnViewSorterGLibType, libs, "gtk_column_view_sorter_get_type")
 
-	core.PuregoSafeRegister(&xColumnViewSorterGetNSortColumns, libs, "gtk_column_view_sorter_get_n_sort_columns")
-	core.PuregoSafeRegister(&xColumnViewSorterGetNthSortColumn, libs, "gtk_column_view_sorter_get_nth_sort_column")
-	core.PuregoSafeRegister(&xColumnViewSorterGetPrimarySortColumn, libs, "gtk_column_view_sorter_get_primary_sort_column")
-	core.PuregoSafeRegister(&xColumnViewSorterGetPrimarySortOrder, libs, "gtk_column_view_sorter_get_primary_sort_order")
+	gobject.CheckClassSize("GtkColumnViewSorterClass", xColumnViewSorterGLibType, unsafe.Sizeof(ColumnViewSorterClass{}))
+
+	core.PuregoRegisterSince(&xColumnViewSorterGetNSortColumns, libs, "gtk_column_view_sorter_get_n_sort_columns", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColumnViewSorterGetNthSortColumn, libs, "gtk_column_view_sorter_get_nth_sort_column", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColumnViewSorterGetPrimarySortColumn, libs, "gtk_column_view_sorter_get_primary_sort_column", "GTK", "4.10")
+	core.PuregoRegisterSince(&xColumnViewSorterGetPrimarySortOrder, libs, "gtk_column_view_sorter_get_primary_sort_order", "GTK", "4.10")
 
 }
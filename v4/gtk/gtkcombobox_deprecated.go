@@ -0,0 +1,528 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewComboBox func() uintptr
+
+// Creates a new empty `GtkComboBox`.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBox() *ComboBox {
+	var cls *ComboBox
+
+	cret := xNewComboBox()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBox{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewComboBoxWithEntry func() uintptr
+
+// Creates a new empty `GtkComboBox` with an entry.
+//
+// In order to use a combo box with entry, you need to tell it
+// which column of the model contains the text for the entry
+// by calling [ComboBox.SetEntryTextColumn].
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBoxWithEntry() *ComboBox {
+	var cls *ComboBox
+
+	cret := xNewComboBoxWithEntry()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBox{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewComboBoxWithModel func(uintptr) uintptr
+
+// Creates a new `GtkComboBox` with a model.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBoxWithModel(ModelVar TreeModel) *ComboBox {
+	var cls *ComboBox
+
+	cret := xNewComboBoxWithModel(ModelVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBox{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewComboBoxWithModelAndEntry func(uintptr) uintptr
+
+// Creates a new empty `GtkComboBox` with an entry and a model.
+//
+// See also [NewComboBoxWithEntry].
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBoxWithModelAndEntry(ModelVar TreeModel) *ComboBox {
+	var cls *ComboBox
+
+	cret := xNewComboBoxWithModelAndEntry(ModelVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBox{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xComboBoxGetActive func(uintptr) int
+
+// Returns the index of the currently active item.
+//
+// If the model is a non-flat treemodel, and the active item is not
+// an immediate child of the root of the tree, this function returns
+// `gtk_tree_path_get_indices (path)[0]`, where `path` is the
+// [TreePath] of the active item.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetActive() int {
+
+	cret := xComboBoxGetActive(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetActiveId func(uintptr) string
+
+// Returns the ID of the active row of combo_box.
+//
+// This value is taken from the active row and the column specified
+// by the [ComboBox.GetPropertyIdColumn] property of combo_box
+// (see [ComboBox.SetIdColumn]).
+//
+// The returned value is an interned string which means that you can
+// compare the pointer by value to other interned strings and that you
+// must not free it.
+//
+// If the [ComboBox.GetPropertyIdColumn] property of combo_box is
+// not set, or if no row is active, or if the active row has a nil
+// ID value, then nil is returned.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetActiveId() string {
+
+	cret := xComboBoxGetActiveId(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetActiveIter func(uintptr, *TreeIter) bool
+
+// Sets iter to point to the currently active item.
+//
+// If no item is active, iter is left unchanged.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetActiveIter(IterVar *TreeIter) bool {
+
+	cret := xComboBoxGetActiveIter(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xComboBoxGetButtonSensitivity func(uintptr) SensitivityType
+
+// Returns whether the combo box sets the dropdown button
+// sensitive or not when there are no items in the model.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetButtonSensitivity() SensitivityType {
+
+	cret := xComboBoxGetButtonSensitivity(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetChild func(uintptr) uintptr
+
+// Gets the child widget of combo_box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetChild() *Widget {
+	var cls *Widget
+
+	cret := xComboBoxGetChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xComboBoxGetEntryTextColumn func(uintptr) int
+
+// Returns the column which combo_box is using to get the strings
+// from to display in the internal entry.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetEntryTextColumn() int {
+
+	cret := xComboBoxGetEntryTextColumn(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetHasEntry func(uintptr) bool
+
+// Returns whether the combo box has an entry.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetHasEntry() bool {
+
+	cret := xComboBoxGetHasEntry(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetIdColumn func(uintptr) int
+
+// Returns the column which combo_box is using to get string IDs
+// for values from.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetIdColumn() int {
+
+	cret := xComboBoxGetIdColumn(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetModel func(uintptr) uintptr
+
+// Returns the `GtkTreeModel` of combo_box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xComboBoxGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xComboBoxGetPopupFixedWidth func(uintptr) bool
+
+// Gets whether the popup uses a fixed width.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetPopupFixedWidth() bool {
+
+	cret := xComboBoxGetPopupFixedWidth(x.GoPointer())
+	return cret
+}
+
+var xComboBoxGetRowSeparatorFunc func(uintptr) uintptr
+
+// Returns the current row separator function.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) GetRowSeparatorFunc() uintptr {
+
+	cret := xComboBoxGetRowSeparatorFunc(x.GoPointer())
+	return cret
+}
+
+var xComboBoxPopdown func(uintptr)
+
+// Hides the menu or dropdown list of combo_box.
+//
+// This function is mostly intended for use by accessibility technologies;
+// applications should have little use for it.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) Popdown() {
+
+	xComboBoxPopdown(x.GoPointer())
+
+}
+
+var xComboBoxPopup func(uintptr)
+
+// Pops up the menu or dropdown list of combo_box.
+//
+// This function is mostly intended for use by accessibility technologies;
+// applications should have little use for it.
+//
+// Before calling this, combo_box must be mapped, or nothing will happen.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) Popup() {
+
+	xComboBoxPopup(x.GoPointer())
+
+}
+
+var xComboBoxPopupForDevice func(uintptr, uintptr)
+
+// Pops up the menu of combo_box.
+//
+// Note that currently this does not do anything with the device, as it was
+// previously only used for list-mode combo boxes, and those were removed
+// in GTK 4. However, it is retained in case similar functionality is added
+// back later.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) PopupForDevice(DeviceVar *gdk.Device) {
+
+	xComboBoxPopupForDevice(x.GoPointer(), DeviceVar.GoPointer())
+
+}
+
+var xComboBoxSetActive func(uintptr, int)
+
+// Sets the active item of combo_box to be the item at index.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetActive(IndexVar int) {
+
+	xComboBoxSetActive(x.GoPointer(), IndexVar)
+
+}
+
+var xComboBoxSetActiveId func(uintptr, uintptr) bool
+
+// Changes the active row of combo_box to the one that has an ID equal to
+// active_id.
+//
+// If active_id is nil, the active row is unset. Rows having
+// a nil ID string cannot be made active by this function.
+//
+// If the [ComboBox.GetPropertyIdColumn] property of combo_box is
+// unset or if no row has the given ID then the function does nothing
+// and returns false.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetActiveId(ActiveIdVar *string) bool {
+
+	ActiveIdVarPtr := core.GStrdupNullable(ActiveIdVar)
+	defer core.GFreeNullable(ActiveIdVarPtr)
+
+	cret := xComboBoxSetActiveId(x.GoPointer(), ActiveIdVarPtr)
+	return cret
+}
+
+var xComboBoxSetActiveIter func(uintptr, *TreeIter)
+
+// Sets the current active item to be the one referenced by iter.
+//
+// If iter is nil, the active item is unset.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetActiveIter(IterVar *TreeIter) {
+
+	xComboBoxSetActiveIter(x.GoPointer(), IterVar)
+
+}
+
+var xComboBoxSetButtonSensitivity func(uintptr, SensitivityType)
+
+// Sets whether the dropdown button of the combo box should update
+// its sensitivity depending on the model contents.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetButtonSensitivity(SensitivityVar SensitivityType) {
+
+	xComboBoxSetButtonSensitivity(x.GoPointer(), SensitivityVar)
+
+}
+
+var xComboBoxSetChild func(uintptr, uintptr)
+
+// Sets the child widget of combo_box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetChild(ChildVar *Widget) {
+
+	xComboBoxSetChild(x.GoPointer(), ChildVar.GoPointer())
+
+}
+
+var xComboBoxSetEntryTextColumn func(uintptr, int)
+
+// Sets the model column which combo_box should use to get strings
+// from to be text_column.
+//
+// For this column no separate
+// [CellRenderer] is needed.
+//
+// The column text_column in the model of combo_box must be of
+// type G_TYPE_STRING.
+//
+// This is only relevant if combo_box has been created with
+// [ComboBox.GetPropertyHasEntry] as true.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetEntryTextColumn(TextColumnVar int) {
+
+	xComboBoxSetEntryTextColumn(x.GoPointer(), TextColumnVar)
+
+}
+
+var xComboBoxSetIdColumn func(uintptr, int)
+
+// Sets the model column which combo_box should use to get string IDs
+// for values from.
+//
+// The column id_column in the model of combo_box must be of type
+// G_TYPE_STRING.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetIdColumn(IdColumnVar int) {
+
+	xComboBoxSetIdColumn(x.GoPointer(), IdColumnVar)
+
+}
+
+var xComboBoxSetModel func(uintptr, uintptr)
+
+// Sets the model used by combo_box to be model.
+//
+// Will unset a previously set model (if applicable). If model is nil,
+// then it will unset the model.
+//
+// Note that this function does not clear the cell renderers, you have to
+// call [CellLayout.Clear] yourself if you need to set up different
+// cell renderers for the new model.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetModel(ModelVar TreeModel) {
+
+	xComboBoxSetModel(x.GoPointer(), ModelVar.GoPointer())
+
+}
+
+var xComboBoxSetPopupFixedWidth func(uintptr, bool)
+
+// Specifies whether the popup’s width should be a fixed width.
+//
+// If fixed is true, the popup's width is set to match the
+// allocated width of the combo box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetPopupFixedWidth(FixedVar bool) {
+
+	xComboBoxSetPopupFixedWidth(x.GoPointer(), FixedVar)
+
+}
+
+var xComboBoxSetRowSeparatorFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the row separator function, which is used to determine
+// whether a row should be drawn as a separator.
+//
+// If the row separator function is nil, no separators are drawn.
+// This is the default value.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBox) SetRowSeparatorFunc(FuncVar *TreeViewRowSeparatorFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xComboBoxSetRowSeparatorFunc(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewComboBox, libs, "gtk_combo_box_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewComboBoxWithEntry, libs, "gtk_combo_box_new_with_entry", "GTK", "")
+	core.PuregoRegisterSince(&xNewComboBoxWithModel, libs, "gtk_combo_box_new_with_model", "GTK", "")
+	core.PuregoRegisterSince(&xNewComboBoxWithModelAndEntry, libs, "gtk_combo_box_new_with_model_and_entry", "GTK", "")
+
+	core.PuregoRegisterSince(&xComboBoxGetActive, libs, "gtk_combo_box_get_active", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetActiveId, libs, "gtk_combo_box_get_active_id", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetActiveIter, libs, "gtk_combo_box_get_active_iter", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetButtonSensitivity, libs, "gtk_combo_box_get_button_sensitivity", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetChild, libs, "gtk_combo_box_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetEntryTextColumn, libs, "gtk_combo_box_get_entry_text_column", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetHasEntry, libs, "gtk_combo_box_get_has_entry", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetIdColumn, libs, "gtk_combo_box_get_id_column", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetModel, libs, "gtk_combo_box_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetPopupFixedWidth, libs, "gtk_combo_box_get_popup_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxGetRowSeparatorFunc, libs, "gtk_combo_box_get_row_separator_func", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxPopdown, libs, "gtk_combo_box_popdown", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxPopup, libs, "gtk_combo_box_popup", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxPopupForDevice, libs, "gtk_combo_box_popup_for_device", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetActive, libs, "gtk_combo_box_set_active", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetActiveId, libs, "gtk_combo_box_set_active_id", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetActiveIter, libs, "gtk_combo_box_set_active_iter", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetButtonSensitivity, libs, "gtk_combo_box_set_button_sensitivity", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetChild, libs, "gtk_combo_box_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetEntryTextColumn, libs, "gtk_combo_box_set_entry_text_column", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetIdColumn, libs, "gtk_combo_box_set_id_column", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetModel, libs, "gtk_combo_box_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetPopupFixedWidth, libs, "gtk_combo_box_set_popup_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxSetRowSeparatorFunc, libs, "gtk_combo_box_set_row_separator_func", "GTK", "")
+
+}
@@ -0,0 +1,213 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewComboBoxText func() uintptr
+
+// Creates a new `GtkComboBoxText`.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBoxText() *ComboBoxText {
+	var cls *ComboBoxText
+
+	cret := xNewComboBoxText()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBoxText{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewComboBoxTextWithEntry func() uintptr
+
+// Creates a new `GtkComboBoxText` with an entry.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func NewComboBoxTextWithEntry() *ComboBoxText {
+	var cls *ComboBoxText
+
+	cret := xNewComboBoxTextWithEntry()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ComboBoxText{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xComboBoxTextAppend func(uintptr, uintptr, string)
+
+// Appends text to the list of strings stored in combo_box.
+//
+// If id is non-nil then it is used as the ID of the row.
+//
+// This is the same as calling [ComboBoxText.Insert]
+// with a position of -1.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) Append(IdVar *string, TextVar string) {
+
+	IdVarPtr := core.GStrdupNullable(IdVar)
+	defer core.GFreeNullable(IdVarPtr)
+
+	xComboBoxTextAppend(x.GoPointer(), IdVarPtr, TextVar)
+
+}
+
+var xComboBoxTextAppendText func(uintptr, string)
+
+// Appends text to the list of strings stored in combo_box.
+//
+// This is the same as calling [ComboBoxText.InsertText]
+// with a position of -1.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) AppendText(TextVar string) {
+
+	xComboBoxTextAppendText(x.GoPointer(), TextVar)
+
+}
+
+var xComboBoxTextGetActiveText func(uintptr) string
+
+// Returns the currently active string in combo_box.
+//
+// If no row is currently selected, nil is returned.
+// If combo_box contains an entry, this function will
+// return its contents (which will not necessarily
+// be an item from the list).
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) GetActiveText() string {
+
+	cret := xComboBoxTextGetActiveText(x.GoPointer())
+	return cret
+}
+
+var xComboBoxTextInsert func(uintptr, int, uintptr, string)
+
+// Inserts text at position in the list of strings stored in combo_box.
+//
+// If id is non-nil then it is used as the ID of the row.
+// See [ComboBox.GetPropertyIdColumn].
+//
+// If position is negative then text is appended.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) Insert(PositionVar int, IdVar *string, TextVar string) {
+
+	IdVarPtr := core.GStrdupNullable(IdVar)
+	defer core.GFreeNullable(IdVarPtr)
+
+	xComboBoxTextInsert(x.GoPointer(), PositionVar, IdVarPtr, TextVar)
+
+}
+
+var xComboBoxTextInsertText func(uintptr, int, string)
+
+// Inserts text at position in the list of strings stored in combo_box.
+//
+// If position is negative then text is appended.
+//
+// This is the same as calling [ComboBoxText.Insert]
+// with a nil ID string.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) InsertText(PositionVar int, TextVar string) {
+
+	xComboBoxTextInsertText(x.GoPointer(), PositionVar, TextVar)
+
+}
+
+var xComboBoxTextPrepend func(uintptr, uintptr, string)
+
+// Prepends text to the list of strings stored in combo_box.
+//
+// If id is non-nil then it is used as the ID of the row.
+//
+// This is the same as calling [ComboBoxText.Insert]
+// with a position of 0.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) Prepend(IdVar *string, TextVar string) {
+
+	IdVarPtr := core.GStrdupNullable(IdVar)
+	defer core.GFreeNullable(IdVarPtr)
+
+	xComboBoxTextPrepend(x.GoPointer(), IdVarPtr, TextVar)
+
+}
+
+var xComboBoxTextPrependText func(uintptr, string)
+
+// Prepends text to the list of strings stored in combo_box.
+//
+// This is the same as calling [ComboBoxText.InsertText]
+// with a position of 0.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) PrependText(TextVar string) {
+
+	xComboBoxTextPrependText(x.GoPointer(), TextVar)
+
+}
+
+var xComboBoxTextRemove func(uintptr, int)
+
+// Removes the string at position from combo_box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) Remove(PositionVar int) {
+
+	xComboBoxTextRemove(x.GoPointer(), PositionVar)
+
+}
+
+var xComboBoxTextRemoveAll func(uintptr)
+
+// Removes all the text entries from the combo box.
+//
+// Deprecated: since 4.10. Use [DropDown]
+func (x *ComboBoxText) RemoveAll() {
+
+	xComboBoxTextRemoveAll(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewComboBoxText, libs, "gtk_combo_box_text_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewComboBoxTextWithEntry, libs, "gtk_combo_box_text_new_with_entry", "GTK", "")
+
+	core.PuregoRegisterSince(&xComboBoxTextAppend, libs, "gtk_combo_box_text_append", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextAppendText, libs, "gtk_combo_box_text_append_text", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextGetActiveText, libs, "gtk_combo_box_text_get_active_text", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextInsert, libs, "gtk_combo_box_text_insert", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextInsertText, libs, "gtk_combo_box_text_insert_text", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextPrepend, libs, "gtk_combo_box_text_prepend", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextPrependText, libs, "gtk_combo_box_text_prepend_text", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextRemove, libs, "gtk_combo_box_text_remove", "GTK", "")
+	core.PuregoRegisterSince(&xComboBoxTextRemoveAll, libs, "gtk_combo_box_text_remove_all", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -30,7 +29,7 @@ func (x *ConstraintTargetInterface) GoPointer() uintptr {
 }
 
 // Makes it possible to use an object as source or target in a
-// [class@Gtk.Constraint].
+// [Constraint].
 //
 // Besides `GtkWidget`, it is also implemented by `GtkConstraintGuide`.
 type ConstraintTarget interface {
@@ -65,14 +64,10 @@ func (x *ConstraintTargetBase) SetGoPointer(ptr uintptr) {
 //
 // The typical equation for a constraint is:
 //
-// ```
-//
 //	target.target_attr = source.source_attr × multiplier + constant
 //
-// ```
-//
 // Each `GtkConstraint` is part of a system that will be solved by a
-// [class@Gtk.ConstraintLayout] in order to allocate and position each
+// [ConstraintLayout] in order to allocate and position each
 // child widget or guide.
 //
 // The source and target, as well as their attributes, of a `GtkConstraint`
@@ -157,11 +152,11 @@ func (x *Constraint) GetRelation() ConstraintRelation {
 
 var xConstraintGetSource func(uintptr) uintptr
 
-// Retrieves the [iface@Gtk.ConstraintTarget] used as the source for the
+// Retrieves the [ConstraintTarget] used as the source for the
 // constraint.
 //
 // If the source is set to `NULL` at creation, the constraint will use
-// the widget using the [class@Gtk.ConstraintLayout] as the source.
+// the widget using the [ConstraintLayout] as the source.
 func (x *Constraint) GetSource() *ConstraintTargetBase {
 	var cls *ConstraintTargetBase
 
@@ -196,11 +191,11 @@ func (x *Constraint) GetStrength() int {
 
 var xConstraintGetTarget func(uintptr) uintptr
 
-// Retrieves the [iface@Gtk.ConstraintTarget] used as the target for
+// Retrieves the [ConstraintTarget] used as the target for
 // the constraint.
 //
 // If the targe is set to `NULL` at creation, the constraint will use
-// the widget using the [class@Gtk.ConstraintLayout] as the target.
+// the widget using the [ConstraintLayout] as the target.
 func (x *Constraint) GetTarget() *ConstraintTargetBase {
 	var cls *ConstraintTargetBase
 
@@ -226,7 +221,7 @@ func (x *Constraint) GetTargetAttribute() ConstraintAttribute {
 
 var xConstraintIsAttached func(uintptr) bool
 
-// Checks whether the constraint is attached to a [class@Gtk.ConstraintLayout],
+// Checks whether the constraint is attached to a [ConstraintLayout],
 // and it is contributing to the layout.
 func (x *Constraint) IsAttached() bool {
 
@@ -237,7 +232,7 @@ func (x *Constraint) IsAttached() bool {
 var xConstraintIsConstant func(uintptr) bool
 
 // Checks whether the constraint describes a relation between an attribute
-// on the [property@Gtk.Constraint:target] and a constant value.
+// on the Constraint:target and a constant value.
 func (x *Constraint) IsConstant() bool {
 
 	cret := xConstraintIsConstant(x.GoPointer())
@@ -266,7 +261,7 @@ func (c *Constraint) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyConstant sets the "constant" property.
-// The constant value to be added to the [property@Gtk.Constraint:source-attribute].
+// The constant value to be added to the Constraint:source-attribute.
 func (x *Constraint) SetPropertyConstant(value float64) {
 	var v gobject.Value
 	v.Init(gobject.TypeDoubleVal)
@@ -275,7 +270,7 @@ func (x *Constraint) SetPropertyConstant(value float64) {
 }
 
 // GetPropertyConstant gets the "constant" property.
-// The constant value to be added to the [property@Gtk.Constraint:source-attribute].
+// The constant value to be added to the Constraint:source-attribute.
 func (x *Constraint) GetPropertyConstant() float64 {
 	var v gobject.Value
 	x.GetProperty("constant", &v)
@@ -284,7 +279,7 @@ func (x *Constraint) GetPropertyConstant() float64 {
 
 // SetPropertyMultiplier sets the "multiplier" property.
 // The multiplication factor to be applied to
-// the [property@Gtk.Constraint:source-attribute].
+// the Constraint:source-attribute.
 func (x *Constraint) SetPropertyMultiplier(value float64) {
 	var v gobject.Value
 	v.Init(gobject.TypeDoubleVal)
@@ -294,7 +289,7 @@ func (x *Constraint) SetPropertyMultiplier(value float64) {
 
 // GetPropertyMultiplier gets the "multiplier" property.
 // The multiplication factor to be applied to
-// the [property@Gtk.Constraint:source-attribute].
+// the Constraint:source-attribute.
 func (x *Constraint) GetPropertyMultiplier() float64 {
 	var v gobject.Value
 	x.GetProperty("multiplier", &v)
@@ -305,7 +300,7 @@ func (x *Constraint) GetPropertyMultiplier() float64 {
 // The strength of the constraint.
 //
 // The strength can be expressed either using one of the symbolic values
-// of the [enum@Gtk.ConstraintStrength] enumeration, or any positive integer
+// of the [ConstraintStrength] enumeration, or any positive integer
 // value.
 func (x *Constraint) SetPropertyStrength(value int) {
 	var v gobject.Value
@@ -318,7 +313,7 @@ func (x *Constraint) SetPropertyStrength(value int) {
 // The strength of the constraint.
 //
 // The strength can be expressed either using one of the symbolic values
-// of the [enum@Gtk.ConstraintStrength] enumeration, or any positive integer
+// of the [ConstraintStrength] enumeration, or any positive integer
 // value.
 func (x *Constraint) GetPropertyStrength() int {
 	var v gobject.Value
@@ -331,7 +326,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -340,20 +335,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xConstraintGLibType, libs, "gtk_constraint_get_type")
 
-	core.PuregoSafeRegister(&xNewConstraint, libs, "gtk_constraint_new")
-	core.PuregoSafeRegister(&xNewConstraintConstant, libs, "gtk_constraint_new_constant")
-
-	core.PuregoSafeRegister(&xConstraintGetConstant, libs, "gtk_constraint_get_constant")
-	core.PuregoSafeRegister(&xConstraintGetMultiplier, libs, "gtk_constraint_get_multiplier")
-	core.PuregoSafeRegister(&xConstraintGetRelation, libs, "gtk_constraint_get_relation")
-	core.PuregoSafeRegister(&xConstraintGetSource, libs, "gtk_constraint_get_source")
-	core.PuregoSafeRegister(&xConstraintGetSourceAttribute, libs, "gtk_constraint_get_source_attribute")
-	core.PuregoSafeRegister(&xConstraintGetStrength, libs, "gtk_constraint_get_strength")
-	core.PuregoSafeRegister(&xConstraintGetTarget, libs, "gtk_constraint_get_target")
-	core.PuregoSafeRegister(&xConstraintGetTargetAttribute, libs, "gtk_constraint_get_target_attribute")
-	core.PuregoSafeRegister(&xConstraintIsAttached, libs, "gtk_constraint_is_attached")
-	core.PuregoSafeRegister(&xConstraintIsConstant, libs, "gtk_constraint_is_constant")
-	core.PuregoSafeRegister(&xConstraintIsRequired, libs, "gtk_constraint_is_required")
+	gobject.CheckClassSize("GtkConstraintClass", xConstraintGLibType, unsafe.Sizeof(ConstraintClass{}))
+
+	core.PuregoRegisterSince(&xNewConstraint, libs, "gtk_constraint_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewConstraintConstant, libs, "gtk_constraint_new_constant", "GTK", "")
+
+	core.PuregoRegisterSince(&xConstraintGetConstant, libs, "gtk_constraint_get_constant", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetMultiplier, libs, "gtk_constraint_get_multiplier", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetRelation, libs, "gtk_constraint_get_relation", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetSource, libs, "gtk_constraint_get_source", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetSourceAttribute, libs, "gtk_constraint_get_source_attribute", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetStrength, libs, "gtk_constraint_get_strength", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetTarget, libs, "gtk_constraint_get_target", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGetTargetAttribute, libs, "gtk_constraint_get_target_attribute", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintIsAttached, libs, "gtk_constraint_is_attached", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintIsConstant, libs, "gtk_constraint_is_constant", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintIsRequired, libs, "gtk_constraint_is_required", "GTK", "")
 
 	core.PuregoSafeRegister(&xConstraintTargetGLibType, libs, "gtk_constraint_target_get_type")
 
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -66,7 +65,7 @@ func NewConstraintGuide() *ConstraintGuide {
 
 var xConstraintGuideGetMaxSize func(uintptr, *int, *int)
 
-// Gets the maximum size of @guide.
+// Gets the maximum size of guide.
 func (x *ConstraintGuide) GetMaxSize(WidthVar *int, HeightVar *int) {
 
 	xConstraintGuideGetMaxSize(x.GoPointer(), WidthVar, HeightVar)
@@ -75,7 +74,7 @@ func (x *ConstraintGuide) GetMaxSize(WidthVar *int, HeightVar *int) {
 
 var xConstraintGuideGetMinSize func(uintptr, *int, *int)
 
-// Gets the minimum size of @guide.
+// Gets the minimum size of guide.
 func (x *ConstraintGuide) GetMinSize(WidthVar *int, HeightVar *int) {
 
 	xConstraintGuideGetMinSize(x.GoPointer(), WidthVar, HeightVar)
@@ -84,7 +83,7 @@ func (x *ConstraintGuide) GetMinSize(WidthVar *int, HeightVar *int) {
 
 var xConstraintGuideGetName func(uintptr) string
 
-// Retrieves the name set using gtk_constraint_guide_set_name().
+// Retrieves the name set using [ConstraintGuide.SetName].
 func (x *ConstraintGuide) GetName() string {
 
 	cret := xConstraintGuideGetName(x.GoPointer())
@@ -93,7 +92,7 @@ func (x *ConstraintGuide) GetName() string {
 
 var xConstraintGuideGetNatSize func(uintptr, *int, *int)
 
-// Gets the natural size of @guide.
+// Gets the natural size of guide.
 func (x *ConstraintGuide) GetNatSize(WidthVar *int, HeightVar *int) {
 
 	xConstraintGuideGetNatSize(x.GoPointer(), WidthVar, HeightVar)
@@ -102,7 +101,7 @@ func (x *ConstraintGuide) GetNatSize(WidthVar *int, HeightVar *int) {
 
 var xConstraintGuideGetStrength func(uintptr) ConstraintStrength
 
-// Retrieves the strength set using gtk_constraint_guide_set_strength().
+// Retrieves the strength set using [ConstraintGuide.SetStrength].
 func (x *ConstraintGuide) GetStrength() ConstraintStrength {
 
 	cret := xConstraintGuideGetStrength(x.GoPointer())
@@ -111,9 +110,9 @@ func (x *ConstraintGuide) GetStrength() ConstraintStrength {
 
 var xConstraintGuideSetMaxSize func(uintptr, int, int)
 
-// Sets the maximum size of @guide.
+// Sets the maximum size of guide.
 //
-// If @guide is attached to a `GtkConstraintLayout`,
+// If guide is attached to a `GtkConstraintLayout`,
 // the constraints will be updated to reflect the new size.
 func (x *ConstraintGuide) SetMaxSize(WidthVar int, HeightVar int) {
 
@@ -123,9 +122,9 @@ func (x *ConstraintGuide) SetMaxSize(WidthVar int, HeightVar int) {
 
 var xConstraintGuideSetMinSize func(uintptr, int, int)
 
-// Sets the minimum size of @guide.
+// Sets the minimum size of guide.
 //
-// If @guide is attached to a `GtkConstraintLayout`,
+// If guide is attached to a `GtkConstraintLayout`,
 // the constraints will be updated to reflect the new size.
 func (x *ConstraintGuide) SetMinSize(WidthVar int, HeightVar int) {
 
@@ -149,9 +148,9 @@ func (x *ConstraintGuide) SetName(NameVar *string) {
 
 var xConstraintGuideSetNatSize func(uintptr, int, int)
 
-// Sets the natural size of @guide.
+// Sets the natural size of guide.
 //
-// If @guide is attached to a `GtkConstraintLayout`,
+// If guide is attached to a `GtkConstraintLayout`,
 // the constraints will be updated to reflect the new size.
 func (x *ConstraintGuide) SetNatSize(WidthVar int, HeightVar int) {
 
@@ -304,7 +303,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -313,17 +312,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xConstraintGuideGLibType, libs, "gtk_constraint_guide_get_type")
 
-	core.PuregoSafeRegister(&xNewConstraintGuide, libs, "gtk_constraint_guide_new")
-
-	core.PuregoSafeRegister(&xConstraintGuideGetMaxSize, libs, "gtk_constraint_guide_get_max_size")
-	core.PuregoSafeRegister(&xConstraintGuideGetMinSize, libs, "gtk_constraint_guide_get_min_size")
-	core.PuregoSafeRegister(&xConstraintGuideGetName, libs, "gtk_constraint_guide_get_name")
-	core.PuregoSafeRegister(&xConstraintGuideGetNatSize, libs, "gtk_constraint_guide_get_nat_size")
-	core.PuregoSafeRegister(&xConstraintGuideGetStrength, libs, "gtk_constraint_guide_get_strength")
-	core.PuregoSafeRegister(&xConstraintGuideSetMaxSize, libs, "gtk_constraint_guide_set_max_size")
-	core.PuregoSafeRegister(&xConstraintGuideSetMinSize, libs, "gtk_constraint_guide_set_min_size")
-	core.PuregoSafeRegister(&xConstraintGuideSetName, libs, "gtk_constraint_guide_set_name")
-	core.PuregoSafeRegister(&xConstraintGuideSetNatSize, libs, "gtk_constraint_guide_set_nat_size")
-	core.PuregoSafeRegister(&xConstraintGuideSetStrength, libs, "gtk_constraint_guide_set_strength")
+	gobject.CheckClassSize("GtkConstraintGuideClass", xConstraintGuideGLibType, unsafe.Sizeof(ConstraintGuideClass{}))
+
+	core.PuregoRegisterSince(&xNewConstraintGuide, libs, "gtk_constraint_guide_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xConstraintGuideGetMaxSize, libs, "gtk_constraint_guide_get_max_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideGetMinSize, libs, "gtk_constraint_guide_get_min_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideGetName, libs, "gtk_constraint_guide_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideGetNatSize, libs, "gtk_constraint_guide_get_nat_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideGetStrength, libs, "gtk_constraint_guide_get_strength", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideSetMaxSize, libs, "gtk_constraint_guide_set_max_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideSetMinSize, libs, "gtk_constraint_guide_set_min_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideSetName, libs, "gtk_constraint_guide_set_name", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideSetNatSize, libs, "gtk_constraint_guide_set_nat_size", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintGuideSetStrength, libs, "gtk_constraint_guide_set_strength", "GTK", "")
 
 }
@@ -5,10 +5,10 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -44,13 +44,13 @@ func ConstraintVflParserErrorQuark() glib.Quark {
 // Uses constraints to describe relations between widgets.
 //
 // `GtkConstraintLayout` is a layout manager that uses relations between
-// widget attributes, expressed via [class@Gtk.Constraint] instances, to
+// widget attributes, expressed via [Constraint] instances, to
 // measure and allocate widgets.
 //
-// ### How do constraints work
+// # How do constraints work
 //
 // Constraints are objects defining the relationship between attributes
-// of a widget; you can read the description of the [class@Gtk.Constraint]
+// of a widget; you can read the description of the [Constraint]
 // class to have a more in depth definition.
 //
 // By taking multiple constraints and applying them to the children of
@@ -74,34 +74,30 @@ func ConstraintVflParserErrorQuark() glib.Quark {
 // is undefined.
 //
 // A constraint-based layout with conflicting constraints may be unsolvable,
-// and lead to an unstable layout. You can use the [property@Gtk.Constraint:strength]
-// property of [class@Gtk.Constraint] to "nudge" the layout towards a solution.
+// and lead to an unstable layout. You can use the [Constraint.GetPropertyStrength]
+// property of [Constraint] to "nudge" the layout towards a solution.
 //
-// ### GtkConstraintLayout as GtkBuildable
+// # GtkConstraintLayout as GtkBuildable
 //
-// `GtkConstraintLayout` implements the [iface@Gtk.Buildable] interface and
+// `GtkConstraintLayout` implements the [Buildable] interface and
 // has a custom "constraints" element which allows describing constraints in
-// a [class@Gtk.Builder] UI file.
+// a [Builder] UI file.
 //
 // An example of a UI definition fragment specifying a constraint:
 //
-// ```xml
-//
-//	&lt;object class="GtkConstraintLayout"&gt;
-//	  &lt;constraints&gt;
-//	    &lt;constraint target="button" target-attribute="start"
+//	<object class="GtkConstraintLayout">
+//	  <constraints>
+//	    <constraint target="button" target-attribute="start"
 //	                relation="eq"
 //	                source="super" source-attribute="start"
 //	                constant="12"
-//	                strength="required" /&gt;
-//	    &lt;constraint target="button" target-attribute="width"
+//	                strength="required" />
+//	    <constraint target="button" target-attribute="width"
 //	                relation="ge"
 //	                constant="250"
-//	                strength="strong" /&gt;
-//	  &lt;/constraints&gt;
-//	&lt;/object&gt;
-//
-// ```
+//	                strength="strong" />
+//	  </constraints>
+//	</object>
 //
 // The definition above will add two constraints to the GtkConstraintLayout:
 //
@@ -131,14 +127,10 @@ func ConstraintVflParserErrorQuark() glib.Quark {
 // Additionally, the "constraints" element can also contain a description
 // of the `GtkConstraintGuides` used by the layout:
 //
-// ```xml
-//
-//	&lt;constraints&gt;
-//	  &lt;guide min-width="100" max-width="500" name="hspace"/&gt;
-//	  &lt;guide min-height="64" nat-height="128" name="vspace" strength="strong"/&gt;
-//	&lt;/constraints&gt;
-//
-// ```
+//	<constraints>
+//	  <guide min-width="100" max-width="500" name="hspace"/>
+//	  <guide min-height="64" nat-height="128" name="vspace" strength="strong"/>
+//	</constraints>
 //
 // The "guide" element has the following optional attributes:
 //
@@ -151,7 +143,7 @@ func ConstraintVflParserErrorQuark() glib.Quark {
 //     have a medium strength
 //   - "name" describes a name for the guide, useful when debugging
 //
-// ### Using the Visual Format Language
+// # Using the Visual Format Language
 //
 // Complex constraints can be described using a compact syntax called VFL,
 // or *Visual Format Language*.
@@ -159,65 +151,45 @@ func ConstraintVflParserErrorQuark() glib.Quark {
 // The Visual Format Language describes all the constraints on a row or
 // column, typically starting from the leading edge towards the trailing
 // one. Each element of the layout is composed by "views", which identify
-// a [iface@Gtk.ConstraintTarget].
+// a [ConstraintTarget].
 //
 // For instance:
 //
-// ```
-//
 //	[button]-[textField]
 //
-// ```
-//
 // Describes a constraint that binds the trailing edge of "button" to the
 // leading edge of "textField", leaving a default space between the two.
 //
 // Using VFL is also possible to specify predicates that describe constraints
 // on attributes like width and height:
 //
-// ```
-//
 //	// Width must be greater than, or equal to 50
-//	[button(&gt;=50)]
+//	[button(>=50)]
 //
 //	// Width of button1 must be equal to width of button2
 //	[button1(==button2)]
 //
-// ```
-//
 // The default orientation for a VFL description is horizontal, unless
 // otherwise specified:
 //
-// ```
-//
 //	// horizontal orientation, default attribute: width
-//	H:[button(&gt;=150)]
+//	H:[button(>=150)]
 //
 //	// vertical orientation, default attribute: height
 //	V:[button1(==button2)]
 //
-// ```
-//
 // It's also possible to specify multiple predicates, as well as their
 // strength:
 //
-// ```
-//
 //	// minimum width of button must be 150
 //	// natural width of button can be 250
-//	[button(&gt;=150@required, ==250@medium)]
-//
-// ```
+//	[button(>=150@required, ==250@medium)]
 //
 // Finally, it's also possible to use simple arithmetic operators:
 //
-// ```
-//
 //	// width of button1 must be equal to width of button2
 //	// divided by 2 plus 12
 //	[button1(button2 / 2 + 12)]
-//
-// ```
 type ConstraintLayout struct {
 	LayoutManager
 }
@@ -254,16 +226,16 @@ var xConstraintLayoutAddConstraint func(uintptr, uintptr)
 
 // Adds a constraint to the layout manager.
 //
-// The [property@Gtk.Constraint:source] and [property@Gtk.Constraint:target]
+// The Constraint:source and Constraint:target
 // properties of `constraint` can be:
 //
 //   - set to `NULL` to indicate that the constraint refers to the
 //     widget using `layout`
-//   - set to the [class@Gtk.Widget] using `layout`
-//   - set to a child of the [class@Gtk.Widget] using `layout`
-//   - set to a [class@Gtk.ConstraintGuide] that is part of `layout`
+//   - set to the [Widget] using `layout`
+//   - set to a child of the [Widget] using `layout`
+//   - set to a [ConstraintGuide] that is part of `layout`
 //
-// The @layout acquires the ownership of @constraint after calling
+// The layout acquires the ownership of constraint after calling
 // this function.
 func (x *ConstraintLayout) AddConstraint(ConstraintVar *Constraint) {
 
@@ -276,8 +248,10 @@ var xConstraintLayoutAddConstraintsFromDescription func(uintptr, []string, uint,
 // Creates a list of constraints from a VFL description.
 //
 // This function is a convenience wrapper around
-// [method@Gtk.ConstraintLayout.add_constraints_from_descriptionv], using
+// [ConstraintLayout.AddConstraintsFromDescriptionv], using
 // variadic arguments to populate the view/target map.
+//
+// Deprecated: use [AddConstraintsFromDescriptionv], the version of this function that language bindings are meant to use.
 func (x *ConstraintLayout) AddConstraintsFromDescription(LinesVar []string, NLinesVar uint, HspacingVar int, VspacingVar int, ErrorVar **glib.Error, FirstViewVar string, varArgs ...interface{}) *glib.List {
 
 	cret := xConstraintLayoutAddConstraintsFromDescription(x.GoPointer(), LinesVar, NLinesVar, HspacingVar, VspacingVar, ErrorVar, FirstViewVar, varArgs...)
@@ -288,41 +262,37 @@ var xConstraintLayoutAddConstraintsFromDescriptionv func(uintptr, []string, uint
 
 // Creates a list of constraints from a VFL description.
 //
-// The Visual Format Language, VFL, is based on Apple's AutoLayout [VFL](https://developer.apple.com/library/content/documentation/UserExperience/Conceptual/AutolayoutPG/VisualFormatLanguage.html).
+// The Visual Format Language, VFL, is based on Apple's AutoLayout [VFL].
 //
-// The `views` dictionary is used to match [iface@Gtk.ConstraintTarget]
+// The `views` dictionary is used to match [ConstraintTarget]
 // instances to the symbolic view name inside the VFL.
 //
 // The VFL grammar is:
 //
-// ```
-//
-//	     &lt;visualFormatString&gt; = (&lt;orientation&gt;)?
-//	                            (&lt;superview&gt;&lt;connection&gt;)?
-//	                            &lt;view&gt;(&lt;connection&gt;&lt;view&gt;)*
-//	                            (&lt;connection&gt;&lt;superview&gt;)?
-//	            &lt;orientation&gt; = 'H' | 'V'
-//	              &lt;superview&gt; = '|'
-//	             &lt;connection&gt; = '' | '-' &lt;predicateList&gt; '-' | '-'
-//	          &lt;predicateList&gt; = &lt;simplePredicate&gt; | &lt;predicateListWithParens&gt;
-//	        &lt;simplePredicate&gt; = &lt;metricName&gt; | &lt;positiveNumber&gt;
-//	&lt;predicateListWithParens&gt; = '(' &lt;predicate&gt; (',' &lt;predicate&gt;)* ')'
-//	              &lt;predicate&gt; = (&lt;relation&gt;)? &lt;objectOfPredicate&gt; (&lt;operatorList&gt;)? ('@' &lt;priority&gt;)?
-//	               &lt;relation&gt; = '==' | '&lt;=' | '&gt;='
-//	      &lt;objectOfPredicate&gt; = &lt;constant&gt; | &lt;viewName&gt; | ('.' &lt;attributeName&gt;)?
-//	               &lt;priority&gt; = &lt;positiveNumber&gt; | 'required' | 'strong' | 'medium' | 'weak'
-//	               &lt;constant&gt; = &lt;number&gt;
-//	           &lt;operatorList&gt; = (&lt;multiplyOperator&gt;)? (&lt;addOperator&gt;)?
-//	       &lt;multiplyOperator&gt; = [ '*' | '/' ] &lt;positiveNumber&gt;
-//	            &lt;addOperator&gt; = [ '+' | '-' ] &lt;positiveNumber&gt;
-//	               &lt;viewName&gt; = [A-Za-z_]([A-Za-z0-9_]*) // A C identifier
-//	             &lt;metricName&gt; = [A-Za-z_]([A-Za-z0-9_]*) // A C identifier
-//	          &lt;attributeName&gt; = 'top' | 'bottom' | 'left' | 'right' | 'width' | 'height' |
+//	     <visualFormatString> = (<orientation>)?
+//	                            (<superview><connection>)?
+//	                            <view>(<connection><view>)*
+//	                            (<connection><superview>)?
+//	            <orientation> = 'H' | 'V'
+//	              <superview> = '|'
+//	             <connection> = '' | '-' <predicateList> '-' | '-'
+//	          <predicateList> = <simplePredicate> | <predicateListWithParens>
+//	        <simplePredicate> = <metricName> | <positiveNumber>
+//	<predicateListWithParens> = '(' <predicate> (',' <predicate>)* ')'
+//	              <predicate> = (<relation>)? <objectOfPredicate> (<operatorList>)? ('@' <priority>)?
+//	               <relation> = '==' | '<=' | '>='
+//	      <objectOfPredicate> = <constant> | <viewName> | ('.' <attributeName>)?
+//	               <priority> = <positiveNumber> | 'required' | 'strong' | 'medium' | 'weak'
+//	               <constant> = <number>
+//	           <operatorList> = (<multiplyOperator>)? (<addOperator>)?
+//	       <multiplyOperator> = [ '*' | '/' ] <positiveNumber>
+//	            <addOperator> = [ '+' | '-' ] <positiveNumber>
+//	               <viewName> = [A-Za-z_]([A-Za-z0-9_]*) // A C identifier
+//	             <metricName> = [A-Za-z_]([A-Za-z0-9_]*) // A C identifier
+//	          <attributeName> = 'top' | 'bottom' | 'left' | 'right' | 'width' | 'height' |
 //	                            'start' | 'end' | 'centerX' | 'centerY' | 'baseline'
-//	         &lt;positiveNumber&gt; // A positive real number parseable by g_ascii_strtod()
-//	                 &lt;number&gt; // A real number parseable by g_ascii_strtod()
-//
-// ```
+//	         <positiveNumber> // A positive real number parseable by g_ascii_strtod()
+//	                 <number> // A real number parseable by g_ascii_strtod()
 //
 // **Note**: The VFL grammar used by GTK is slightly different than the one
 // defined by Apple, as it can use symbolic values for the constraint's
@@ -331,13 +301,11 @@ var xConstraintLayoutAddConstraintsFromDescriptionv func(uintptr, []string, uint
 //
 // Examples of VFL descriptions are:
 //
-// ```
-//
 //	// Default spacing
 //	[button]-[textField]
 //
 //	// Width constraint
-//	[button(&gt;=50)]
+//	[button(>=50)]
 //
 //	// Connection to super view
 //	|-50-[purpleBox]-50-|
@@ -355,10 +323,10 @@ var xConstraintLayoutAddConstraintsFromDescriptionv func(uintptr, []string, uint
 //	[button1(==button2)]
 //
 //	// Multiple predicates
-//	[flexibleButton(&gt;=70,&lt;=100)]
+//	[flexibleButton(>=70,<=100)]
 //
 //	// A complete line of layout
-//	|-[find]-[findNext]-[findField(&gt;=20)]-|
+//	|-[find]-[findNext]-[findField(>=20)]-|
 //
 //	// Operators
 //	[button1(button2 / 3 + 50)]
@@ -366,13 +334,13 @@ var xConstraintLayoutAddConstraintsFromDescriptionv func(uintptr, []string, uint
 //	// Named attributes
 //	[button1(==button2.height)]
 //
-// ```
+// [VFL]: https://developer.apple.com/library/content/documentation/UserExperience/Conceptual/AutolayoutPG/VisualFormatLanguage.html
 func (x *ConstraintLayout) AddConstraintsFromDescriptionv(LinesVar []string, NLinesVar uint, HspacingVar int, VspacingVar int, ViewsVar *glib.HashTable) (*glib.List, error) {
 	var cerr *glib.Error
 
 	cret := xConstraintLayoutAddConstraintsFromDescriptionv(x.GoPointer(), LinesVar, NLinesVar, HspacingVar, VspacingVar, ViewsVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -481,10 +449,10 @@ func (c *ConstraintLayout) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ConstraintLayout) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -524,29 +492,33 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xConstraintVflParserErrorQuark, libs, "gtk_constraint_vfl_parser_error_quark")
+	core.PuregoRegisterSince(&xConstraintVflParserErrorQuark, libs, "gtk_constraint_vfl_parser_error_quark", "GTK", "")
 
 	core.PuregoSafeRegister(&xConstraintLayoutGLibType, libs, "gtk_constraint_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewConstraintLayout, libs, "gtk_constraint_layout_new")
+	gobject.CheckClassSize("GtkConstraintLayoutClass", xConstraintLayoutGLibType, unsafe.Sizeof(ConstraintLayoutClass{}))
 
-	core.PuregoSafeRegister(&xConstraintLayoutAddConstraint, libs, "gtk_constraint_layout_add_constraint")
-	core.PuregoSafeRegister(&xConstraintLayoutAddConstraintsFromDescription, libs, "gtk_constraint_layout_add_constraints_from_description")
-	core.PuregoSafeRegister(&xConstraintLayoutAddConstraintsFromDescriptionv, libs, "gtk_constraint_layout_add_constraints_from_descriptionv")
-	core.PuregoSafeRegister(&xConstraintLayoutAddGuide, libs, "gtk_constraint_layout_add_guide")
-	core.PuregoSafeRegister(&xConstraintLayoutObserveConstraints, libs, "gtk_constraint_layout_observe_constraints")
-	core.PuregoSafeRegister(&xConstraintLayoutObserveGuides, libs, "gtk_constraint_layout_observe_guides")
-	core.PuregoSafeRegister(&xConstraintLayoutRemoveAllConstraints, libs, "gtk_constraint_layout_remove_all_constraints")
-	core.PuregoSafeRegister(&xConstraintLayoutRemoveConstraint, libs, "gtk_constraint_layout_remove_constraint")
-	core.PuregoSafeRegister(&xConstraintLayoutRemoveGuide, libs, "gtk_constraint_layout_remove_guide")
+	core.PuregoRegisterSince(&xNewConstraintLayout, libs, "gtk_constraint_layout_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xConstraintLayoutAddConstraint, libs, "gtk_constraint_layout_add_constraint", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutAddConstraintsFromDescription, libs, "gtk_constraint_layout_add_constraints_from_description", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutAddConstraintsFromDescriptionv, libs, "gtk_constraint_layout_add_constraints_from_descriptionv", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutAddGuide, libs, "gtk_constraint_layout_add_guide", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutObserveConstraints, libs, "gtk_constraint_layout_observe_constraints", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutObserveGuides, libs, "gtk_constraint_layout_observe_guides", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutRemoveAllConstraints, libs, "gtk_constraint_layout_remove_all_constraints", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutRemoveConstraint, libs, "gtk_constraint_layout_remove_constraint", "GTK", "")
+	core.PuregoRegisterSince(&xConstraintLayoutRemoveGuide, libs, "gtk_constraint_layout_remove_guide", "GTK", "")
 
 	core.PuregoSafeRegister(&xConstraintLayoutChildGLibType, libs, "gtk_constraint_layout_child_get_type")
 
+	gobject.CheckClassSize("GtkConstraintLayoutChildClass", xConstraintLayoutChildGLibType, unsafe.Sizeof(ConstraintLayoutChildClass{}))
+
 }
@@ -1,6 +1,8 @@
 // Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package gtk
 
+import "fmt"
+
 // Errors that can occur while parsing CSS.
 //
 // These errors are unexpected and will cause parts of the given CSS
@@ -21,6 +23,79 @@ const (
 	CssParserErrorUnknownValueValue CssParserError = 4
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (CssParserError) ErrorDomain() string {
+	return "gtk-css-parser-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x CssParserError) Error() string {
+	switch x {
+	case CssParserErrorFailedValue:
+		return "GTK_CSS_PARSER_ERROR_FAILED"
+	case CssParserErrorSyntaxValue:
+		return "GTK_CSS_PARSER_ERROR_SYNTAX"
+	case CssParserErrorImportValue:
+		return "GTK_CSS_PARSER_ERROR_IMPORT"
+	case CssParserErrorNameValue:
+		return "GTK_CSS_PARSER_ERROR_NAME"
+	case CssParserErrorUnknownValueValue:
+		return "GTK_CSS_PARSER_ERROR_UNKNOWN_VALUE"
+	}
+	return fmt.Sprintf("gtk-css-parser-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x CssParserError) String() string {
+	switch x {
+	case CssParserErrorFailedValue:
+		return "GTK_CSS_PARSER_ERROR_FAILED"
+	case CssParserErrorSyntaxValue:
+		return "GTK_CSS_PARSER_ERROR_SYNTAX"
+	case CssParserErrorImportValue:
+		return "GTK_CSS_PARSER_ERROR_IMPORT"
+	case CssParserErrorNameValue:
+		return "GTK_CSS_PARSER_ERROR_NAME"
+	case CssParserErrorUnknownValueValue:
+		return "GTK_CSS_PARSER_ERROR_UNKNOWN_VALUE"
+	}
+	return fmt.Sprintf("CssParserError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CssParserError) Nick() string {
+	switch x {
+	case CssParserErrorFailedValue:
+		return "failed"
+	case CssParserErrorSyntaxValue:
+		return "syntax"
+	case CssParserErrorImportValue:
+		return "import"
+	case CssParserErrorNameValue:
+		return "name"
+	case CssParserErrorUnknownValueValue:
+		return "unknown-value"
+	}
+	return ""
+}
+
+// CssParserErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CssParserErrorFromString(s string) (CssParserError, bool) {
+	switch s {
+	case "GTK_CSS_PARSER_ERROR_FAILED", "failed":
+		return CssParserErrorFailedValue, true
+	case "GTK_CSS_PARSER_ERROR_SYNTAX", "syntax":
+		return CssParserErrorSyntaxValue, true
+	case "GTK_CSS_PARSER_ERROR_IMPORT", "import":
+		return CssParserErrorImportValue, true
+	case "GTK_CSS_PARSER_ERROR_NAME", "name":
+		return CssParserErrorNameValue, true
+	case "GTK_CSS_PARSER_ERROR_UNKNOWN_VALUE", "unknown-value":
+		return CssParserErrorUnknownValueValue, true
+	}
+	return 0, false
+}
+
 // Warnings that can occur while parsing CSS.
 //
 // Unlike `GtkCssParserError`s, warnings do not cause the parser to
@@ -38,3 +113,42 @@ const (
 	// A feature is not implemented
 	CssParserWarningUnimplementedValue CssParserWarning = 2
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x CssParserWarning) String() string {
+	switch x {
+	case CssParserWarningDeprecatedValue:
+		return "GTK_CSS_PARSER_WARNING_DEPRECATED"
+	case CssParserWarningSyntaxValue:
+		return "GTK_CSS_PARSER_WARNING_SYNTAX"
+	case CssParserWarningUnimplementedValue:
+		return "GTK_CSS_PARSER_WARNING_UNIMPLEMENTED"
+	}
+	return fmt.Sprintf("CssParserWarning(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CssParserWarning) Nick() string {
+	switch x {
+	case CssParserWarningDeprecatedValue:
+		return "deprecated"
+	case CssParserWarningSyntaxValue:
+		return "syntax"
+	case CssParserWarningUnimplementedValue:
+		return "unimplemented"
+	}
+	return ""
+}
+
+// CssParserWarningFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CssParserWarningFromString(s string) (CssParserWarning, bool) {
+	switch s {
+	case "GTK_CSS_PARSER_WARNING_DEPRECATED", "deprecated":
+		return CssParserWarningDeprecatedValue, true
+	case "GTK_CSS_PARSER_WARNING_SYNTAX", "syntax":
+		return CssParserWarningSyntaxValue, true
+	case "GTK_CSS_PARSER_WARNING_UNIMPLEMENTED", "unimplemented":
+		return CssParserWarningUnimplementedValue, true
+	}
+	return 0, false
+}
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 )
@@ -30,14 +29,14 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCssParserErrorQuark, libs, "gtk_css_parser_error_quark")
-	core.PuregoSafeRegister(&xCssParserWarningQuark, libs, "gtk_css_parser_warning_quark")
+	core.PuregoRegisterSince(&xCssParserErrorQuark, libs, "gtk_css_parser_error_quark", "GTK", "")
+	core.PuregoRegisterSince(&xCssParserWarningQuark, libs, "gtk_css_parser_warning_quark", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -34,10 +33,10 @@ func (x *CssProviderPrivate) GoPointer() uintptr {
 // It is able to parse CSS-like input in order to style widgets.
 //
 // An application can make GTK parse a specific CSS style sheet by calling
-// [method@Gtk.CssProvider.load_from_file] or
-// [method@Gtk.CssProvider.load_from_resource]
-// and adding the provider with [method@Gtk.StyleContext.add_provider] or
-// [func@Gtk.StyleContext.add_provider_for_display].
+// [CssProvider.LoadFromFile] or
+// [CssProvider.LoadFromResource]
+// and adding the provider with [StyleContext.AddProvider] or
+// [StyleContextAddProviderForDisplay].
 //
 // In addition, certain files will be read when GTK is initialized.
 // First, the file `$XDG_CONFIG_HOME/gtk-4.0/gtk.css` is loaded if it
@@ -47,16 +46,16 @@ func (x *CssProviderPrivate) GoPointer() uintptr {
 // `$XDG_DATA_DIRS/themes/THEME/gtk-VERSION/gtk-VARIANT.css` and
 // `DATADIR/share/themes/THEME/gtk-VERSION/gtk-VARIANT.css`,
 // where `THEME` is the name of the current theme (see the
-// [property@Gtk.Settings:gtk-theme-name] setting), `VARIANT` is the
+// [Settings.GetPropertyGtkThemeName] setting), `VARIANT` is the
 // variant to load (see the
-// [property@Gtk.Settings:gtk-application-prefer-dark-theme] setting),
+// [Settings.GetPropertyGtkApplicationPreferDarkTheme] setting),
 // `DATADIR` is the prefix configured when GTK was compiled (unless
 // overridden by the `GTK_DATA_PREFIX` environment variable), and
 // `VERSION` is the GTK version number. If no file is found for the
 // current version, GTK tries older versions all the way back to 4.0.
 //
 // To track errors while loading CSS, connect to the
-// [signal@Gtk.CssProvider::parsing-error] signal.
+// [CssProvider.ConnectParsingErrorFunc] signal.
 type CssProvider struct {
 	gobject.Object
 }
@@ -91,29 +90,21 @@ func NewCssProvider() *CssProvider {
 
 var xCssProviderLoadFromBytes func(uintptr, *glib.Bytes)
 
-// Loads @data into @css_provider.
+// Loads data into css_provider.
 //
 // This clears any previously loaded information.
-func (x *CssProvider) LoadFromBytes(DataVar *glib.Bytes) {
+func (x *CssProvider) LoadFromBytes(DataVar []byte) {
 
-	xCssProviderLoadFromBytes(x.GoPointer(), DataVar)
+	DataVarBytes := glib.NewBytesFromSlice(DataVar)
+	defer DataVarBytes.Unref()
 
-}
-
-var xCssProviderLoadFromData func(uintptr, string, int)
-
-// Loads @data into @css_provider.
-//
-// This clears any previously loaded information.
-func (x *CssProvider) LoadFromData(DataVar string, LengthVar int) {
-
-	xCssProviderLoadFromData(x.GoPointer(), DataVar, LengthVar)
+	xCssProviderLoadFromBytes(x.GoPointer(), DataVarBytes)
 
 }
 
 var xCssProviderLoadFromFile func(uintptr, uintptr)
 
-// Loads the data contained in @file into @css_provider.
+// Loads the data contained in file into css_provider.
 //
 // This clears any previously loaded information.
 func (x *CssProvider) LoadFromFile(FileVar gio.File) {
@@ -124,7 +115,7 @@ func (x *CssProvider) LoadFromFile(FileVar gio.File) {
 
 var xCssProviderLoadFromPath func(uintptr, string)
 
-// Loads the data contained in @path into @css_provider.
+// Loads the data contained in path into css_provider.
 //
 // This clears any previously loaded information.
 func (x *CssProvider) LoadFromPath(PathVar string) {
@@ -135,8 +126,8 @@ func (x *CssProvider) LoadFromPath(PathVar string) {
 
 var xCssProviderLoadFromResource func(uintptr, string)
 
-// Loads the data contained in the resource at @resource_path into
-// the @css_provider.
+// Loads the data contained in the resource at resource_path into
+// the css_provider.
 //
 // This clears any previously loaded information.
 func (x *CssProvider) LoadFromResource(ResourcePathVar string) {
@@ -147,7 +138,7 @@ func (x *CssProvider) LoadFromResource(ResourcePathVar string) {
 
 var xCssProviderLoadFromString func(uintptr, string)
 
-// Loads @string into @css_provider.
+// Loads string into css_provider.
 //
 // This clears any previously loaded information.
 func (x *CssProvider) LoadFromString(StringVar string) {
@@ -156,31 +147,15 @@ func (x *CssProvider) LoadFromString(StringVar string) {
 
 }
 
-var xCssProviderLoadNamed func(uintptr, string, uintptr)
-
-// Loads a theme from the usual theme paths.
-//
-// The actual process of finding the theme might change between
-// releases, but it is guaranteed that this function uses the same
-// mechanism to load the theme that GTK uses for loading its own theme.
-func (x *CssProvider) LoadNamed(NameVar string, VariantVar *string) {
-
-	VariantVarPtr := core.GStrdupNullable(VariantVar)
-	defer core.GFreeNullable(VariantVarPtr)
-
-	xCssProviderLoadNamed(x.GoPointer(), NameVar, VariantVarPtr)
-
-}
-
 var xCssProviderToString func(uintptr) string
 
-// Converts the @provider into a string representation in CSS
+// Converts the provider into a string representation in CSS
 // format.
 //
-// Using [method@Gtk.CssProvider.load_from_string] with the return
+// Using [CssProvider.LoadFromString] with the return
 // value from this function on a new provider created with
-// [ctor@Gtk.CssProvider.new] will basically create a duplicate
-// of this @provider.
+// [NewCssProvider] will basically create a duplicate
+// of this provider.
 func (x *CssProvider) ToString() string {
 
 	cret := xCssProviderToString(x.GoPointer())
@@ -200,10 +175,10 @@ func (c *CssProvider) SetGoPointer(ptr uintptr) {
 
 // Signals that a parsing error occurred.
 //
-// The expected error values are in the [error@Gtk.CssParserError]
-// and [enum@Gtk.CssParserWarning] enumerations.
+// The expected error values are in the [CssParserError]
+// and [CssParserWarning] enumerations.
 //
-// The @path, @line and @position describe the actual location of
+// The path, line and position describe the actual location of
 // the error as accurately as possible.
 //
 // Parsing errors are never fatal, so the parsing will resume after
@@ -211,33 +186,55 @@ func (c *CssProvider) SetGoPointer(ptr uintptr) {
 // even all of it to not be parsed at all. So it is a useful idea
 // to check that the parsing succeeds by connecting to this signal.
 //
-// Errors in the [enum@Gtk.CssParserWarning] enumeration should not
+// Errors in the [CssParserWarning] enumeration should not
 // be treated as fatal errors.
 //
 // Note that this signal may be emitted at any time as the css provider
 // may opt to defer parsing parts or all of the input to a later time
 // than when a loading function was called.
+//
+// Deprecated: use ConnectParsingErrorFunc, which also accepts method values and closures.
 func (x *CssProvider) ConnectParsingError(cb *func(CssProvider, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "parsing-error", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SectionVarp uintptr, ErrorVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := CssProvider{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SectionVarp, ErrorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "parsing-error", cbRefPtr, cbPtr)
+}
+
+var xCssProviderParsingErrorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, SectionVarp uintptr, ErrorVarp uintptr) {
+func xCssProviderParsingErrorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SectionVarp uintptr, ErrorVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(CssProvider, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := CssProvider{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SectionVarp, ErrorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "parsing-error", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectParsingErrorFunc connects cb to the "parsing-error" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *CssProvider) ConnectParsingErrorFunc(cb func(CssProvider, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "parsing-error", &xCssProviderParsingErrorTrampoline, xCssProviderParsingErrorNewTrampoline, cb)
 }
 
 func init() {
@@ -245,7 +242,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -254,15 +251,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xCssProviderGLibType, libs, "gtk_css_provider_get_type")
 
-	core.PuregoSafeRegister(&xNewCssProvider, libs, "gtk_css_provider_new")
+	core.PuregoRegisterSince(&xNewCssProvider, libs, "gtk_css_provider_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xCssProviderLoadFromBytes, libs, "gtk_css_provider_load_from_bytes")
-	core.PuregoSafeRegister(&xCssProviderLoadFromData, libs, "gtk_css_provider_load_from_data")
-	core.PuregoSafeRegister(&xCssProviderLoadFromFile, libs, "gtk_css_provider_load_from_file")
-	core.PuregoSafeRegister(&xCssProviderLoadFromPath, libs, "gtk_css_provider_load_from_path")
-	core.PuregoSafeRegister(&xCssProviderLoadFromResource, libs, "gtk_css_provider_load_from_resource")
-	core.PuregoSafeRegister(&xCssProviderLoadFromString, libs, "gtk_css_provider_load_from_string")
-	core.PuregoSafeRegister(&xCssProviderLoadNamed, libs, "gtk_css_provider_load_named")
-	core.PuregoSafeRegister(&xCssProviderToString, libs, "gtk_css_provider_to_string")
+	core.PuregoRegisterSince(&xCssProviderLoadFromBytes, libs, "gtk_css_provider_load_from_bytes", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCssProviderLoadFromFile, libs, "gtk_css_provider_load_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xCssProviderLoadFromPath, libs, "gtk_css_provider_load_from_path", "GTK", "")
+	core.PuregoRegisterSince(&xCssProviderLoadFromResource, libs, "gtk_css_provider_load_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xCssProviderLoadFromString, libs, "gtk_css_provider_load_from_string", "GTK", "4.12")
+	core.PuregoRegisterSince(&xCssProviderToString, libs, "gtk_css_provider_to_string", "GTK", "")
 
 }
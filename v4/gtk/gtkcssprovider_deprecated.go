@@ -0,0 +1,58 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xCssProviderLoadFromData func(uintptr, string, int)
+
+// Loads data into css_provider.
+//
+// This clears any previously loaded information.
+//
+// Deprecated: since 4.12. Use [CssProvider.LoadFromString]
+//
+//	or [CssProvider.LoadFromBytes] instead
+func (x *CssProvider) LoadFromData(DataVar string, LengthVar int) {
+
+	xCssProviderLoadFromData(x.GoPointer(), DataVar, LengthVar)
+
+}
+
+var xCssProviderLoadNamed func(uintptr, string, uintptr)
+
+// Loads a theme from the usual theme paths.
+//
+// The actual process of finding the theme might change between
+// releases, but it is guaranteed that this function uses the same
+// mechanism to load the theme that GTK uses for loading its own theme.
+//
+// Deprecated: since 4.20. Using any of the other theme loaders, combine with media queries.
+func (x *CssProvider) LoadNamed(NameVar string, VariantVar *string) {
+
+	VariantVarPtr := core.GStrdupNullable(VariantVar)
+	defer core.GFreeNullable(VariantVarPtr)
+
+	xCssProviderLoadNamed(x.GoPointer(), NameVar, VariantVarPtr)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCssProviderLoadFromData, libs, "gtk_css_provider_load_from_data", "GTK", "")
+	core.PuregoRegisterSince(&xCssProviderLoadNamed, libs, "gtk_css_provider_load_named", "GTK", "")
+
+}
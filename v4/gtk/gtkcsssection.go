@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -16,7 +15,7 @@ import (
 // Defines a part of a CSS document.
 //
 // Because sections are nested into one another, you can use
-// [method@CssSection.get_parent] to get the containing region.
+// get_parent to get the containing region.
 type CssSection struct {
 	_ structs.HostLayout
 }
@@ -55,7 +54,7 @@ func NewCssSectionWithBytes(FileVar gio.File, BytesVar *glib.Bytes, StartVar *Cs
 
 var xCssSectionGetBytes func(uintptr) *glib.Bytes
 
-// Gets the bytes that @section was parsed from.
+// Gets the bytes that section was parsed from.
 func (x *CssSection) GetBytes() *glib.Bytes {
 
 	cret := xCssSectionGetBytes(x.GoPointer())
@@ -73,10 +72,10 @@ func (x *CssSection) GetEndLocation() *CssLocation {
 
 var xCssSectionGetFile func(uintptr) uintptr
 
-// Gets the file that @section was parsed from.
+// Gets the file that section was parsed from.
 //
 // If no such file exists, for example because the CSS was loaded via
-// [method@Gtk.CssProvider.load_from_data], then `NULL` is returned.
+// [CssProvider.LoadFromData], then `NULL` is returned.
 func (x *CssSection) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -98,8 +97,8 @@ var xCssSectionGetParent func(uintptr) *CssSection
 // The parent section is the section that contains this `section`. A special
 // case are sections of  type `GTK_CSS_SECTION_DOCUMENT`. Their parent will
 // either be `NULL` if they are the original CSS document that was loaded by
-// [method@Gtk.CssProvider.load_from_file] or a section of type
-// `GTK_CSS_SECTION_IMPORT` if it was loaded with an `@import` rule from
+// [CssProvider.LoadFromFile] or a section of type
+// `GTK_CSS_SECTION_IMPORT` if it was loaded with an `import` rule from
 // a different file.
 func (x *CssSection) GetParent() *CssSection {
 
@@ -140,7 +139,7 @@ func (x *CssSection) Ref() *CssSection {
 var xCssSectionToString func(uintptr) string
 
 // Prints the section into a human-readable text form using
-// [method@Gtk.CssSection.print].
+// [CssSection.Print].
 func (x *CssSection) ToString() string {
 
 	cret := xCssSectionToString(x.GoPointer())
@@ -162,7 +161,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -171,17 +170,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xCssSectionGLibType, libs, "gtk_css_section_get_type")
 
-	core.PuregoSafeRegister(&xNewCssSection, libs, "gtk_css_section_new")
-	core.PuregoSafeRegister(&xNewCssSectionWithBytes, libs, "gtk_css_section_new_with_bytes")
-
-	core.PuregoSafeRegister(&xCssSectionGetBytes, libs, "gtk_css_section_get_bytes")
-	core.PuregoSafeRegister(&xCssSectionGetEndLocation, libs, "gtk_css_section_get_end_location")
-	core.PuregoSafeRegister(&xCssSectionGetFile, libs, "gtk_css_section_get_file")
-	core.PuregoSafeRegister(&xCssSectionGetParent, libs, "gtk_css_section_get_parent")
-	core.PuregoSafeRegister(&xCssSectionGetStartLocation, libs, "gtk_css_section_get_start_location")
-	core.PuregoSafeRegister(&xCssSectionPrint, libs, "gtk_css_section_print")
-	core.PuregoSafeRegister(&xCssSectionRef, libs, "gtk_css_section_ref")
-	core.PuregoSafeRegister(&xCssSectionToString, libs, "gtk_css_section_to_string")
-	core.PuregoSafeRegister(&xCssSectionUnref, libs, "gtk_css_section_unref")
+	core.PuregoRegisterSince(&xNewCssSection, libs, "gtk_css_section_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewCssSectionWithBytes, libs, "gtk_css_section_new_with_bytes", "GTK", "4.16")
+
+	core.PuregoRegisterSince(&xCssSectionGetBytes, libs, "gtk_css_section_get_bytes", "GTK", "4.16")
+	core.PuregoRegisterSince(&xCssSectionGetEndLocation, libs, "gtk_css_section_get_end_location", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionGetFile, libs, "gtk_css_section_get_file", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionGetParent, libs, "gtk_css_section_get_parent", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionGetStartLocation, libs, "gtk_css_section_get_start_location", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionPrint, libs, "gtk_css_section_print", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionRef, libs, "gtk_css_section_ref", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionToString, libs, "gtk_css_section_to_string", "GTK", "")
+	core.PuregoRegisterSince(&xCssSectionUnref, libs, "gtk_css_section_unref", "GTK", "")
 
 }
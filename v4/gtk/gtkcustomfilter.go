@@ -5,13 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// User function that is called to determine if the @item should be matched.
+// User function that is called to determine if the item should be matched.
 //
 // If the filter matches the item, this function must return true.
 // If the item should be filtered out, false must be returned.
@@ -48,10 +48,10 @@ var xNewCustomFilter func(uintptr, uintptr, uintptr) uintptr
 
 // Creates a new filter using the given function to filter items.
 //
-// If @match_func is `NULL`, the filter matches all items.
+// If match_func is `NULL`, the filter matches all items.
 //
 // If the filter func changes its filtering behavior,
-// [method@Gtk.Filter.changed] needs to be called.
+// [Filter.Changed] needs to be called.
 func NewCustomFilter(MatchFuncVar *CustomFilterFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) *CustomFilter {
 	var cls *CustomFilter
 
@@ -62,11 +62,12 @@ func NewCustomFilter(MatchFuncVar *CustomFilterFunc, UserDataVar uintptr, UserDe
 			MatchFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *MatchFuncVar
 				return cbFn(arg0, arg1)
 			}
-			MatchFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MatchFuncVarPtr, MatchFuncVarRef, MatchFuncVar)
+			MatchFuncVarRef = core.NewCallback(fcb)
+			MatchFuncVarRef = glib.SaveCallbackWithClosure(MatchFuncVarPtr, MatchFuncVarRef, MatchFuncVar)
 		}
 	}
 
@@ -77,11 +78,12 @@ func NewCustomFilter(MatchFuncVar *CustomFilterFunc, UserDataVar uintptr, UserDe
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -99,12 +101,12 @@ var xCustomFilterSetFilterFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets the function used for filtering items.
 //
-// If @match_func is `NULL`, the filter matches all items.
+// If match_func is `NULL`, the filter matches all items.
 //
 // If the filter func changes its filtering behavior,
-// [method@Gtk.Filter.changed] needs to be called.
+// [Filter.Changed] needs to be called.
 //
-// If a previous function was set, its @user_destroy
+// If a previous function was set, its user_destroy
 // will be called.
 func (x *CustomFilter) SetFilterFunc(MatchFuncVar *CustomFilterFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) {
 
@@ -115,11 +117,12 @@ func (x *CustomFilter) SetFilterFunc(MatchFuncVar *CustomFilterFunc, UserDataVar
 			MatchFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *MatchFuncVar
 				return cbFn(arg0, arg1)
 			}
-			MatchFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MatchFuncVarPtr, MatchFuncVarRef, MatchFuncVar)
+			MatchFuncVarRef = core.NewCallback(fcb)
+			MatchFuncVarRef = glib.SaveCallbackWithClosure(MatchFuncVarPtr, MatchFuncVarRef, MatchFuncVar)
 		}
 	}
 
@@ -130,11 +133,12 @@ func (x *CustomFilter) SetFilterFunc(MatchFuncVar *CustomFilterFunc, UserDataVar
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -158,7 +162,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -167,8 +171,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xCustomFilterGLibType, libs, "gtk_custom_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewCustomFilter, libs, "gtk_custom_filter_new")
+	gobject.CheckClassSize("GtkCustomFilterClass", xCustomFilterGLibType, unsafe.Sizeof(CustomFilterClass{}))
 
-	core.PuregoSafeRegister(&xCustomFilterSetFilterFunc, libs, "gtk_custom_filter_set_filter_func")
+	core.PuregoRegisterSince(&xNewCustomFilter, libs, "gtk_custom_filter_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCustomFilterSetFilterFunc, libs, "gtk_custom_filter_set_filter_func", "GTK", "")
 
 }
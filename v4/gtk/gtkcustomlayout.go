@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -69,11 +69,12 @@ func NewCustomLayout(RequestModeVar *CustomRequestModeFunc, MeasureVar *CustomMe
 			RequestModeVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) SizeRequestMode {
+				defer core.RecoverPanic()
 				cbFn := *RequestModeVar
 				return cbFn(arg0)
 			}
-			RequestModeVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(RequestModeVarPtr, RequestModeVarRef, RequestModeVar)
+			RequestModeVarRef = core.NewCallback(fcb)
+			RequestModeVarRef = glib.SaveCallbackWithClosure(RequestModeVarPtr, RequestModeVarRef, RequestModeVar)
 		}
 	}
 
@@ -84,11 +85,12 @@ func NewCustomLayout(RequestModeVar *CustomRequestModeFunc, MeasureVar *CustomMe
 			MeasureVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 Orientation, arg2 int, arg3 *int, arg4 *int, arg5 *int, arg6 *int) {
+				defer core.RecoverPanic()
 				cbFn := *MeasureVar
 				cbFn(arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 			}
-			MeasureVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MeasureVarPtr, MeasureVarRef, MeasureVar)
+			MeasureVarRef = core.NewCallback(fcb)
+			MeasureVarRef = glib.SaveCallbackWithClosure(MeasureVarPtr, MeasureVarRef, MeasureVar)
 		}
 	}
 
@@ -99,11 +101,12 @@ func NewCustomLayout(RequestModeVar *CustomRequestModeFunc, MeasureVar *CustomMe
 			AllocateVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 int, arg2 int, arg3 int) {
+				defer core.RecoverPanic()
 				cbFn := *AllocateVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			AllocateVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(AllocateVarPtr, AllocateVarRef, AllocateVar)
+			AllocateVarRef = core.NewCallback(fcb)
+			AllocateVarRef = glib.SaveCallbackWithClosure(AllocateVarPtr, AllocateVarRef, AllocateVar)
 		}
 	}
 
@@ -133,7 +136,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -142,6 +145,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xCustomLayoutGLibType, libs, "gtk_custom_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewCustomLayout, libs, "gtk_custom_layout_new")
+	gobject.CheckClassSize("GtkCustomLayoutClass", xCustomLayoutGLibType, unsafe.Sizeof(CustomLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewCustomLayout, libs, "gtk_custom_layout_new", "GTK", "")
 
 }
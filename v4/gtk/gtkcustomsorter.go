@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -41,9 +41,9 @@ func CustomSorterNewFromInternalPtr(ptr uintptr) *CustomSorter {
 var xNewCustomSorter func(uintptr, uintptr, uintptr) uintptr
 
 // Creates a new `GtkSorter` that works by calling
-// @sort_func to compare items.
+// sort_func to compare items.
 //
-// If @sort_func is %NULL, all items are considered equal.
+// If sort_func is nil, all items are considered equal.
 func NewCustomSorter(SortFuncVar *glib.CompareDataFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) *CustomSorter {
 	var cls *CustomSorter
 
@@ -54,11 +54,12 @@ func NewCustomSorter(SortFuncVar *glib.CompareDataFunc, UserDataVar uintptr, Use
 			SortFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SortFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			SortFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
 		}
 	}
 
@@ -69,11 +70,12 @@ func NewCustomSorter(SortFuncVar *glib.CompareDataFunc, UserDataVar uintptr, Use
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -91,12 +93,12 @@ var xCustomSorterSetSortFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets (or unsets) the function used for sorting items.
 //
-// If @sort_func is %NULL, all items are considered equal.
+// If sort_func is nil, all items are considered equal.
 //
 // If the sort func changes its sorting behavior,
-// gtk_sorter_changed() needs to be called.
+// [Sorter.Changed] needs to be called.
 //
-// If a previous function was set, its @user_destroy will be
+// If a previous function was set, its user_destroy will be
 // called now.
 func (x *CustomSorter) SetSortFunc(SortFuncVar *glib.CompareDataFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) {
 
@@ -107,11 +109,12 @@ func (x *CustomSorter) SetSortFunc(SortFuncVar *glib.CompareDataFunc, UserDataVa
 			SortFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SortFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			SortFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
 		}
 	}
 
@@ -122,11 +125,12 @@ func (x *CustomSorter) SetSortFunc(SortFuncVar *glib.CompareDataFunc, UserDataVa
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -150,7 +154,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -159,8 +163,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xCustomSorterGLibType, libs, "gtk_custom_sorter_get_type")
 
-	core.PuregoSafeRegister(&xNewCustomSorter, libs, "gtk_custom_sorter_new")
+	gobject.CheckClassSize("GtkCustomSorterClass", xCustomSorterGLibType, unsafe.Sizeof(CustomSorterClass{}))
 
-	core.PuregoSafeRegister(&xCustomSorterSetSortFunc, libs, "gtk_custom_sorter_set_sort_func")
+	core.PuregoRegisterSince(&xNewCustomSorter, libs, "gtk_custom_sorter_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xCustomSorterSetSortFunc, libs, "gtk_custom_sorter_set_sort_func", "GTK", "")
 
 }
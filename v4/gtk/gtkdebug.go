@@ -2,17 +2,19 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+	"strings"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Flags to use with gtk_set_debug_flags().
+// Flags to use with [SetDebugFlags].
 //
 // Settings these flags causes GTK to print out different
 // types of debugging information. Some of these flags are
 // only available when GTK has been configured with `-Ddebug=true`.
-type DebugFlags int
+type DebugFlags uint32
 
 var xDebugFlagsGLibType func() types.GType
 
@@ -68,6 +70,127 @@ const (
 	DebugBuilderValue DebugFlags = 2097152
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DebugFlags) GLibType() types.GType {
+	return xDebugFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DebugFlags) Has(flags DebugFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DebugFlags) Set(flags DebugFlags) DebugFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DebugFlags) Clear(flags DebugFlags) DebugFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DebugFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&DebugTextValue != 0 && x&DebugTextValue == DebugTextValue {
+		names = append(names, "GTK_DEBUG_TEXT")
+		rest &^= DebugTextValue
+	}
+	if rest&DebugTreeValue != 0 && x&DebugTreeValue == DebugTreeValue {
+		names = append(names, "GTK_DEBUG_TREE")
+		rest &^= DebugTreeValue
+	}
+	if rest&DebugKeybindingsValue != 0 && x&DebugKeybindingsValue == DebugKeybindingsValue {
+		names = append(names, "GTK_DEBUG_KEYBINDINGS")
+		rest &^= DebugKeybindingsValue
+	}
+	if rest&DebugModulesValue != 0 && x&DebugModulesValue == DebugModulesValue {
+		names = append(names, "GTK_DEBUG_MODULES")
+		rest &^= DebugModulesValue
+	}
+	if rest&DebugGeometryValue != 0 && x&DebugGeometryValue == DebugGeometryValue {
+		names = append(names, "GTK_DEBUG_GEOMETRY")
+		rest &^= DebugGeometryValue
+	}
+	if rest&DebugIconthemeValue != 0 && x&DebugIconthemeValue == DebugIconthemeValue {
+		names = append(names, "GTK_DEBUG_ICONTHEME")
+		rest &^= DebugIconthemeValue
+	}
+	if rest&DebugPrintingValue != 0 && x&DebugPrintingValue == DebugPrintingValue {
+		names = append(names, "GTK_DEBUG_PRINTING")
+		rest &^= DebugPrintingValue
+	}
+	if rest&DebugBuilderTraceValue != 0 && x&DebugBuilderTraceValue == DebugBuilderTraceValue {
+		names = append(names, "GTK_DEBUG_BUILDER_TRACE")
+		rest &^= DebugBuilderTraceValue
+	}
+	if rest&DebugSizeRequestValue != 0 && x&DebugSizeRequestValue == DebugSizeRequestValue {
+		names = append(names, "GTK_DEBUG_SIZE_REQUEST")
+		rest &^= DebugSizeRequestValue
+	}
+	if rest&DebugNoCssCacheValue != 0 && x&DebugNoCssCacheValue == DebugNoCssCacheValue {
+		names = append(names, "GTK_DEBUG_NO_CSS_CACHE")
+		rest &^= DebugNoCssCacheValue
+	}
+	if rest&DebugInteractiveValue != 0 && x&DebugInteractiveValue == DebugInteractiveValue {
+		names = append(names, "GTK_DEBUG_INTERACTIVE")
+		rest &^= DebugInteractiveValue
+	}
+	if rest&DebugTouchscreenValue != 0 && x&DebugTouchscreenValue == DebugTouchscreenValue {
+		names = append(names, "GTK_DEBUG_TOUCHSCREEN")
+		rest &^= DebugTouchscreenValue
+	}
+	if rest&DebugActionsValue != 0 && x&DebugActionsValue == DebugActionsValue {
+		names = append(names, "GTK_DEBUG_ACTIONS")
+		rest &^= DebugActionsValue
+	}
+	if rest&DebugLayoutValue != 0 && x&DebugLayoutValue == DebugLayoutValue {
+		names = append(names, "GTK_DEBUG_LAYOUT")
+		rest &^= DebugLayoutValue
+	}
+	if rest&DebugSnapshotValue != 0 && x&DebugSnapshotValue == DebugSnapshotValue {
+		names = append(names, "GTK_DEBUG_SNAPSHOT")
+		rest &^= DebugSnapshotValue
+	}
+	if rest&DebugConstraintsValue != 0 && x&DebugConstraintsValue == DebugConstraintsValue {
+		names = append(names, "GTK_DEBUG_CONSTRAINTS")
+		rest &^= DebugConstraintsValue
+	}
+	if rest&DebugBuilderObjectsValue != 0 && x&DebugBuilderObjectsValue == DebugBuilderObjectsValue {
+		names = append(names, "GTK_DEBUG_BUILDER_OBJECTS")
+		rest &^= DebugBuilderObjectsValue
+	}
+	if rest&DebugA11yValue != 0 && x&DebugA11yValue == DebugA11yValue {
+		names = append(names, "GTK_DEBUG_A11Y")
+		rest &^= DebugA11yValue
+	}
+	if rest&DebugIconfallbackValue != 0 && x&DebugIconfallbackValue == DebugIconfallbackValue {
+		names = append(names, "GTK_DEBUG_ICONFALLBACK")
+		rest &^= DebugIconfallbackValue
+	}
+	if rest&DebugInvertTextDirValue != 0 && x&DebugInvertTextDirValue == DebugInvertTextDirValue {
+		names = append(names, "GTK_DEBUG_INVERT_TEXT_DIR")
+		rest &^= DebugInvertTextDirValue
+	}
+	if rest&DebugCssValue != 0 && x&DebugCssValue == DebugCssValue {
+		names = append(names, "GTK_DEBUG_CSS")
+		rest &^= DebugCssValue
+	}
+	if rest&DebugBuilderValue != 0 && x&DebugBuilderValue == DebugBuilderValue {
+		names = append(names, "GTK_DEBUG_BUILDER")
+		rest &^= DebugBuilderValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xGetDebugFlags func() DebugFlags
 
 // Returns the GTK debug flags that are currently active.
@@ -94,7 +217,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -103,7 +226,7 @@ func init() {
 
 	core.PuregoSafeRegister(&xDebugFlagsGLibType, libs, "gtk_debug_flags_get_type")
 
-	core.PuregoSafeRegister(&xGetDebugFlags, libs, "gtk_get_debug_flags")
-	core.PuregoSafeRegister(&xSetDebugFlags, libs, "gtk_set_debug_flags")
+	core.PuregoRegisterSince(&xGetDebugFlags, libs, "gtk_get_debug_flags", "GTK", "")
+	core.PuregoRegisterSince(&xSetDebugFlags, libs, "gtk_set_debug_flags", "GTK", "")
 
 }
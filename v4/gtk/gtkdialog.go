@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -36,7 +37,8 @@ func (x *DialogClass) OverrideResponse(cb func(*Dialog, int)) {
 	if cb == nil {
 		x.xResponse = 0
 	} else {
-		x.xResponse = purego.NewCallback(func(DialogVarp uintptr, ResponseIdVarp int) {
+		x.xResponse = core.NewCallback(func(DialogVarp uintptr, ResponseIdVarp int) {
+			defer core.RecoverPanic()
 			cb(DialogNewFromInternalPtr(DialogVarp), ResponseIdVarp)
 		})
 	}
@@ -49,7 +51,7 @@ func (x *DialogClass) GetResponse() func(*Dialog, int) {
 		return nil
 	}
 	var rawCallback func(DialogVarp uintptr, ResponseIdVarp int)
-	purego.RegisterFunc(&rawCallback, x.xResponse)
+	core.RegisterFunc(&rawCallback, x.xResponse)
 	return func(DialogVar *Dialog, ResponseIdVar int) {
 		rawCallback(DialogVar.GoPointer(), ResponseIdVar)
 	}
@@ -61,7 +63,8 @@ func (x *DialogClass) OverrideClose(cb func(*Dialog)) {
 	if cb == nil {
 		x.xClose = 0
 	} else {
-		x.xClose = purego.NewCallback(func(DialogVarp uintptr) {
+		x.xClose = core.NewCallback(func(DialogVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(DialogNewFromInternalPtr(DialogVarp))
 		})
 	}
@@ -74,14 +77,16 @@ func (x *DialogClass) GetClose() func(*Dialog) {
 		return nil
 	}
 	var rawCallback func(DialogVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClose)
+	core.RegisterFunc(&rawCallback, x.xClose)
 	return func(DialogVar *Dialog) {
 		rawCallback(DialogVar.GoPointer())
 	}
 }
 
 // Flags used to influence dialog construction.
-type DialogFlags int
+//
+// Deprecated: since 4.20. There is no replacement.
+type DialogFlags uint32
 
 var xDialogFlagsGLibType func() types.GType
 
@@ -100,10 +105,57 @@ const (
 	DialogUseHeaderBarValue DialogFlags = 4
 )
 
-// Predefined values for use as response ids in gtk_dialog_add_button().
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (DialogFlags) GLibType() types.GType {
+	return xDialogFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x DialogFlags) Has(flags DialogFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x DialogFlags) Set(flags DialogFlags) DialogFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x DialogFlags) Clear(flags DialogFlags) DialogFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x DialogFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&DialogModalValue != 0 && x&DialogModalValue == DialogModalValue {
+		names = append(names, "GTK_DIALOG_MODAL")
+		rest &^= DialogModalValue
+	}
+	if rest&DialogDestroyWithParentValue != 0 && x&DialogDestroyWithParentValue == DialogDestroyWithParentValue {
+		names = append(names, "GTK_DIALOG_DESTROY_WITH_PARENT")
+		rest &^= DialogDestroyWithParentValue
+	}
+	if rest&DialogUseHeaderBarValue != 0 && x&DialogUseHeaderBarValue == DialogUseHeaderBarValue {
+		names = append(names, "GTK_DIALOG_USE_HEADER_BAR")
+		rest &^= DialogUseHeaderBarValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Predefined values for use as response ids in [Dialog.AddButton].
 //
 // All predefined values are negative; GTK leaves values of 0 or greater for
 // application-defined response ids.
+//
+// Deprecated: since 4.20. There is no replacement.
 type ResponseType int
 
 var xResponseTypeGLibType func() types.GType
@@ -139,16 +191,96 @@ const (
 	ResponseHelpValue ResponseType = -11
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ResponseType) String() string {
+	switch x {
+	case ResponseNoneValue:
+		return "GTK_RESPONSE_NONE"
+	case ResponseRejectValue:
+		return "GTK_RESPONSE_REJECT"
+	case ResponseAcceptValue:
+		return "GTK_RESPONSE_ACCEPT"
+	case ResponseDeleteEventValue:
+		return "GTK_RESPONSE_DELETE_EVENT"
+	case ResponseOkValue:
+		return "GTK_RESPONSE_OK"
+	case ResponseCancelValue:
+		return "GTK_RESPONSE_CANCEL"
+	case ResponseCloseValue:
+		return "GTK_RESPONSE_CLOSE"
+	case ResponseYesValue:
+		return "GTK_RESPONSE_YES"
+	case ResponseNoValue:
+		return "GTK_RESPONSE_NO"
+	case ResponseApplyValue:
+		return "GTK_RESPONSE_APPLY"
+	case ResponseHelpValue:
+		return "GTK_RESPONSE_HELP"
+	}
+	return fmt.Sprintf("ResponseType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ResponseType) Nick() string {
+	switch x {
+	case ResponseNoneValue:
+		return "none"
+	case ResponseRejectValue:
+		return "reject"
+	case ResponseAcceptValue:
+		return "accept"
+	case ResponseDeleteEventValue:
+		return "delete-event"
+	case ResponseOkValue:
+		return "ok"
+	case ResponseCancelValue:
+		return "cancel"
+	case ResponseCloseValue:
+		return "close"
+	case ResponseYesValue:
+		return "yes"
+	case ResponseNoValue:
+		return "no"
+	case ResponseApplyValue:
+		return "apply"
+	case ResponseHelpValue:
+		return "help"
+	}
+	return ""
+}
+
+// ResponseTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ResponseTypeFromString(s string) (ResponseType, bool) {
+	switch s {
+	case "GTK_RESPONSE_NONE", "none":
+		return ResponseNoneValue, true
+	case "GTK_RESPONSE_REJECT", "reject":
+		return ResponseRejectValue, true
+	case "GTK_RESPONSE_ACCEPT", "accept":
+		return ResponseAcceptValue, true
+	case "GTK_RESPONSE_DELETE_EVENT", "delete-event":
+		return ResponseDeleteEventValue, true
+	case "GTK_RESPONSE_OK", "ok":
+		return ResponseOkValue, true
+	case "GTK_RESPONSE_CANCEL", "cancel":
+		return ResponseCancelValue, true
+	case "GTK_RESPONSE_CLOSE", "close":
+		return ResponseCloseValue, true
+	case "GTK_RESPONSE_YES", "yes":
+		return ResponseYesValue, true
+	case "GTK_RESPONSE_NO", "no":
+		return ResponseNoValue, true
+	case "GTK_RESPONSE_APPLY", "apply":
+		return ResponseApplyValue, true
+	case "GTK_RESPONSE_HELP", "help":
+		return ResponseHelpValue, true
+	}
+	return 0, false
+}
+
 // Dialogs are a convenient way to prompt the user for a small amount
 // of input.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="dialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkDialog" src="dialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Typical uses are to display a message, ask a question, or anything else
 // that does not require extensive effort on the user’s part.
 //
@@ -160,44 +292,42 @@ const (
 // action widgets are buttons. Depending on the platform, action widgets may
 // be presented in the header bar at the top of the window, or at the bottom
 // of the window. To add action widgets, create your `GtkDialog` using
-// [ctor@Gtk.Dialog.new_with_buttons], or use
-// [method@Gtk.Dialog.add_button], [method@Gtk.Dialog.add_buttons],
-// or [method@Gtk.Dialog.add_action_widget].
+// [NewDialogWithButtons], or use
+// [Dialog.AddButton], [Dialog.AddButtons],
+// or [Dialog.AddActionWidget].
 //
 // `GtkDialogs` uses some heuristics to decide whether to add a close
 // button to the window decorations. If any of the action buttons use
-// the response ID %GTK_RESPONSE_CLOSE or %GTK_RESPONSE_CANCEL, the
+// the response ID [ResponseCloseValue] or [ResponseCancelValue], the
 // close button is omitted.
 //
 // Clicking a button that was added as an action widget will emit the
-// [signal@Gtk.Dialog::response] signal with a response ID that you specified.
+// [Dialog.ConnectResponseFunc] signal with a response ID that you specified.
 // GTK will never assign a meaning to positive response IDs; these are
 // entirely user-defined. But for convenience, you can use the response
-// IDs in the [enum@Gtk.ResponseType] enumeration (these all have values
+// IDs in the [ResponseType] enumeration (these all have values
 // less than zero). If a dialog receives a delete event, the
-// [signal@Gtk.Dialog::response] signal will be emitted with the
-// %GTK_RESPONSE_DELETE_EVENT response ID.
+// [Dialog.ConnectResponseFunc] signal will be emitted with the
+// [ResponseDeleteEventValue] response ID.
 //
-// Dialogs are created with a call to [ctor@Gtk.Dialog.new] or
-// [ctor@Gtk.Dialog.new_with_buttons]. The latter is recommended; it allows
+// Dialogs are created with a call to [NewDialog] or
+// [NewDialogWithButtons]. The latter is recommended; it allows
 // you to set the dialog title, some convenient flags, and add buttons.
 //
 // A “modal” dialog (that is, one which freezes the rest of the application
-// from user input), can be created by calling [method@Gtk.Window.set_modal]
-// on the dialog. When using [ctor@Gtk.Dialog.new_with_buttons], you can also
-// pass the %GTK_DIALOG_MODAL flag to make a dialog modal.
+// from user input), can be created by calling [Window.SetModal]
+// on the dialog. When using [NewDialogWithButtons], you can also
+// pass the [DialogModalValue] flag to make a dialog modal.
 //
-// For the simple dialog in the following example, a [class@Gtk.MessageDialog]
+// For the simple dialog in the following example, a [MessageDialog]
 // would save some effort. But you’d need to create the dialog contents manually
 // if you had more than a simple message in the dialog.
 //
 // An example for simple `GtkDialog` usage:
 //
-// ```c
-// // Function to open a dialog box with a message
-// void
-// quick_message (GtkWindow *parent, char *message)
-//
+//	// Function to open a dialog box with a message
+//	void
+//	quick_message (GtkWindow *parent, char *message)
 //	{
 //	 GtkWidget *dialog, *label, *content_area;
 //	 GtkDialogFlags flags;
@@ -226,49 +356,45 @@ const (
 //	 gtk_widget_show (dialog);
 //	}
 //
-// ```
-//
 // # GtkDialog as GtkBuildable
 //
 // The `GtkDialog` implementation of the `GtkBuildable` interface exposes the
-// @content_area as an internal child with the name “content_area”.
+// content_area as an internal child with the name “content_area”.
 //
-// `GtkDialog` supports a custom `&lt;action-widgets&gt;` element, which can contain
-// multiple `&lt;action-widget&gt;` elements. The “response” attribute specifies a
+// `GtkDialog` supports a custom `<action-widgets>` element, which can contain
+// multiple `<action-widget>` elements. The “response” attribute specifies a
 // numeric response, and the content of the element is the id of widget
-// (which should be a child of the dialogs @action_area). To mark a response
-// as default, set the “default” attribute of the `&lt;action-widget&gt;` element
+// (which should be a child of the dialogs action_area). To mark a response
+// as default, set the “default” attribute of the `<action-widget>` element
 // to true.
 //
 // `GtkDialog` supports adding action widgets by specifying “action” as
-// the “type” attribute of a `&lt;child&gt;` element. The widget will be added
+// the “type” attribute of a `<child>` element. The widget will be added
 // either to the action area or the headerbar of the dialog, depending
 // on the “use-header-bar” property. The response id has to be associated
-// with the action widget using the `&lt;action-widgets&gt;` element.
+// with the action widget using the `<action-widgets>` element.
 //
 // An example of a `GtkDialog` UI definition fragment:
 //
-// ```xml
-// &lt;object class="GtkDialog" id="dialog1"&gt;
-//
-//	&lt;child type="action"&gt;
-//	  &lt;object class="GtkButton" id="button_cancel"/&gt;
-//	&lt;/child&gt;
-//	&lt;child type="action"&gt;
-//	  &lt;object class="GtkButton" id="button_ok"&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;action-widgets&gt;
-//	  &lt;action-widget response="cancel"&gt;button_cancel&lt;/action-widget&gt;
-//	  &lt;action-widget response="ok" default="true"&gt;button_ok&lt;/action-widget&gt;
-//	&lt;/action-widgets&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkDialog" id="dialog1">
+//	  <child type="action">
+//	    <object class="GtkButton" id="button_cancel"/>
+//	  </child>
+//	  <child type="action">
+//	    <object class="GtkButton" id="button_ok">
+//	    </object>
+//	  </child>
+//	  <action-widgets>
+//	    <action-widget response="cancel">button_cancel</action-widget>
+//	    <action-widget response="ok" default="true">button_ok</action-widget>
+//	  </action-widgets>
+//	</object>
 //
 // # Accessibility
 //
-// `GtkDialog` uses the %GTK_ACCESSIBLE_ROLE_DIALOG role.
+// `GtkDialog` uses the [AccessibleRoleDialogValue] role.
+//
+// Deprecated: since 4.10. Use [Window] instead
 type Dialog struct {
 	Window
 }
@@ -285,236 +411,6 @@ func DialogNewFromInternalPtr(ptr uintptr) *Dialog {
 	return cls
 }
 
-var xNewDialog func() uintptr
-
-// Creates a new dialog box.
-//
-// Widgets should not be packed into the `GtkWindow`
-// directly, but into the @content_area and @action_area,
-// as described above.
-func NewDialog() *Dialog {
-	var cls *Dialog
-
-	cret := xNewDialog()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Dialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewDialogWithButtons func(uintptr, uintptr, DialogFlags, uintptr, ...interface{}) uintptr
-
-// Creates a new `GtkDialog` with the given title and transient parent.
-//
-// The @flags argument can be used to make the dialog modal, have it
-// destroyed along with its transient parent, or make it use a headerbar.
-//
-// Button text/response ID pairs should be listed in pairs, with a %NULL
-// pointer ending the list. Button text can be arbitrary text. A response
-// ID can be any positive number, or one of the values in the
-// [enum@Gtk.ResponseType] enumeration. If the user clicks one of these
-// buttons, `GtkDialog` will emit the [signal@Gtk.Dialog::response] signal
-// with the corresponding response ID.
-//
-// If a `GtkDialog` receives a delete event, it will emit ::response with a
-// response ID of %GTK_RESPONSE_DELETE_EVENT.
-//
-// However, destroying a dialog does not emit the ::response signal;
-// so be careful relying on ::response when using the
-// %GTK_DIALOG_DESTROY_WITH_PARENT flag.
-//
-// Here’s a simple example:
-// ```c
-// GtkWindow *main_app_window; // Window the dialog should show up on
-// GtkWidget *dialog;
-// GtkDialogFlags flags = GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT;
-// dialog = gtk_dialog_new_with_buttons ("My dialog",
-//
-//	main_app_window,
-//	flags,
-//	_("_OK"),
-//	GTK_RESPONSE_ACCEPT,
-//	_("_Cancel"),
-//	GTK_RESPONSE_REJECT,
-//	NULL);
-//
-// ```
-func NewDialogWithButtons(TitleVar *string, ParentVar *Window, FlagsVar DialogFlags, FirstButtonTextVar *string, varArgs ...interface{}) *Dialog {
-	var cls *Dialog
-
-	TitleVarPtr := core.GStrdupNullable(TitleVar)
-	defer core.GFreeNullable(TitleVarPtr)
-
-	FirstButtonTextVarPtr := core.GStrdupNullable(FirstButtonTextVar)
-	defer core.GFreeNullable(FirstButtonTextVarPtr)
-
-	cret := xNewDialogWithButtons(TitleVarPtr, ParentVar.GoPointer(), FlagsVar, FirstButtonTextVarPtr, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Dialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xDialogAddActionWidget func(uintptr, uintptr, int)
-
-// Adds an activatable widget to the action area of a `GtkDialog`.
-//
-// GTK connects a signal handler that will emit the
-// [signal@Gtk.Dialog::response] signal on the dialog when the widget
-// is activated. The widget is appended to the end of the dialog’s action
-// area.
-//
-// If you want to add a non-activatable widget, simply pack it into
-// the @action_area field of the `GtkDialog` struct.
-func (x *Dialog) AddActionWidget(ChildVar *Widget, ResponseIdVar int) {
-
-	xDialogAddActionWidget(x.GoPointer(), ChildVar.GoPointer(), ResponseIdVar)
-
-}
-
-var xDialogAddButton func(uintptr, string, int) uintptr
-
-// Adds a button with the given text.
-//
-// GTK arranges things so that clicking the button will emit the
-// [signal@Gtk.Dialog::response] signal with the given @response_id.
-// The button is appended to the end of the dialog’s action area.
-// The button widget is returned, but usually you don’t need it.
-func (x *Dialog) AddButton(ButtonTextVar string, ResponseIdVar int) *Widget {
-	var cls *Widget
-
-	cret := xDialogAddButton(x.GoPointer(), ButtonTextVar, ResponseIdVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xDialogAddButtons func(uintptr, string, ...interface{})
-
-// Adds multiple buttons.
-//
-// This is the same as calling [method@Gtk.Dialog.add_button]
-// repeatedly. The variable argument list should be %NULL-terminated
-// as with [ctor@Gtk.Dialog.new_with_buttons]. Each button must have both
-// text and response ID.
-func (x *Dialog) AddButtons(FirstButtonTextVar string, varArgs ...interface{}) {
-
-	xDialogAddButtons(x.GoPointer(), FirstButtonTextVar, varArgs...)
-
-}
-
-var xDialogGetContentArea func(uintptr) uintptr
-
-// Returns the content area of @dialog.
-func (x *Dialog) GetContentArea() *Box {
-	var cls *Box
-
-	cret := xDialogGetContentArea(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Box{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xDialogGetHeaderBar func(uintptr) uintptr
-
-// Returns the header bar of @dialog.
-//
-// Note that the headerbar is only used by the dialog if the
-// [property@Gtk.Dialog:use-header-bar] property is %TRUE.
-func (x *Dialog) GetHeaderBar() *HeaderBar {
-	var cls *HeaderBar
-
-	cret := xDialogGetHeaderBar(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &HeaderBar{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xDialogGetResponseForWidget func(uintptr, uintptr) int
-
-// Gets the response id of a widget in the action area
-// of a dialog.
-func (x *Dialog) GetResponseForWidget(WidgetVar *Widget) int {
-
-	cret := xDialogGetResponseForWidget(x.GoPointer(), WidgetVar.GoPointer())
-	return cret
-}
-
-var xDialogGetWidgetForResponse func(uintptr, int) uintptr
-
-// Gets the widget button that uses the given response ID in the action area
-// of a dialog.
-func (x *Dialog) GetWidgetForResponse(ResponseIdVar int) *Widget {
-	var cls *Widget
-
-	cret := xDialogGetWidgetForResponse(x.GoPointer(), ResponseIdVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xDialogResponse func(uintptr, int)
-
-// Emits the ::response signal with the given response ID.
-//
-// Used to indicate that the user has responded to the dialog in some way.
-func (x *Dialog) Response(ResponseIdVar int) {
-
-	xDialogResponse(x.GoPointer(), ResponseIdVar)
-
-}
-
-var xDialogSetDefaultResponse func(uintptr, int)
-
-// Sets the default widget for the dialog based on the response ID.
-//
-// Pressing “Enter” normally activates the default widget.
-func (x *Dialog) SetDefaultResponse(ResponseIdVar int) {
-
-	xDialogSetDefaultResponse(x.GoPointer(), ResponseIdVar)
-
-}
-
-var xDialogSetResponseSensitive func(uintptr, int, bool)
-
-// A convenient way to sensitize/desensitize dialog buttons.
-//
-// Calls `gtk_widget_set_sensitive (widget, @setting)`
-// for each widget in the dialog’s action area with the given @response_id.
-func (x *Dialog) SetResponseSensitive(ResponseIdVar int, SettingVar bool) {
-
-	xDialogSetResponseSensitive(x.GoPointer(), ResponseIdVar, SettingVar)
-
-}
-
 func (c *Dialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -527,24 +423,24 @@ func (c *Dialog) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyUseHeaderBar sets the "use-header-bar" property.
-// %TRUE if the dialog uses a headerbar for action buttons
+// true if the dialog uses a headerbar for action buttons
 // instead of the action-area.
 //
 // For technical reasons, this property is declared as an integer
-// property, but you should only set it to %TRUE or %FALSE.
+// property, but you should only set it to true or false.
 //
-// ## Creating a dialog with headerbar
+// # Creating a dialog with headerbar
 //
-// Builtin `GtkDialog` subclasses such as [class@Gtk.ColorChooserDialog]
+// Builtin `GtkDialog` subclasses such as [ColorChooserDialog]
 // set this property according to platform conventions (using the
-// [property@Gtk.Settings:gtk-dialogs-use-header] setting).
+// [Settings.GetPropertyGtkDialogsUseHeader] setting).
 //
 // Here is how you can achieve the same:
 //
-// ```c
-// g_object_get (settings, "gtk-dialogs-use-header", &amp;header, NULL);
-// dialog = g_object_new (GTK_TYPE_DIALOG, header, TRUE, NULL);
-// ```
+//	g_object_get (settings, "gtk-dialogs-use-header", &header, NULL);
+//	dialog = g_object_new (GTK_TYPE_DIALOG, header, TRUE, NULL);
+//
+// Deprecated: since 4.10. Use [Window] instead
 func (x *Dialog) SetPropertyUseHeaderBar(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -553,24 +449,24 @@ func (x *Dialog) SetPropertyUseHeaderBar(value int) {
 }
 
 // GetPropertyUseHeaderBar gets the "use-header-bar" property.
-// %TRUE if the dialog uses a headerbar for action buttons
+// true if the dialog uses a headerbar for action buttons
 // instead of the action-area.
 //
 // For technical reasons, this property is declared as an integer
-// property, but you should only set it to %TRUE or %FALSE.
+// property, but you should only set it to true or false.
 //
-// ## Creating a dialog with headerbar
+// # Creating a dialog with headerbar
 //
-// Builtin `GtkDialog` subclasses such as [class@Gtk.ColorChooserDialog]
+// Builtin `GtkDialog` subclasses such as [ColorChooserDialog]
 // set this property according to platform conventions (using the
-// [property@Gtk.Settings:gtk-dialogs-use-header] setting).
+// [Settings.GetPropertyGtkDialogsUseHeader] setting).
 //
 // Here is how you can achieve the same:
 //
-// ```c
-// g_object_get (settings, "gtk-dialogs-use-header", &amp;header, NULL);
-// dialog = g_object_new (GTK_TYPE_DIALOG, header, TRUE, NULL);
-// ```
+//	g_object_get (settings, "gtk-dialogs-use-header", &header, NULL);
+//	dialog = g_object_new (GTK_TYPE_DIALOG, header, TRUE, NULL);
+//
+// Deprecated: since 4.10. Use [Window] instead
 func (x *Dialog) GetPropertyUseHeaderBar() int {
 	var v gobject.Value
 	x.GetProperty("use-header-bar", &v)
@@ -579,59 +475,107 @@ func (x *Dialog) GetPropertyUseHeaderBar() int {
 
 // Emitted when the user uses a keybinding to close the dialog.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default binding for this signal is the Escape key.
+//
+// Deprecated: since 4.10. Use [Window] instead
 func (x *Dialog) ConnectClose(cb *func(Dialog)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Dialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close", cbRefPtr, cbPtr)
+}
+
+var xDialogCloseTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xDialogCloseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Dialog))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Dialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCloseFunc connects cb to the "close" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "close" signal is deprecated, see ConnectClose.
+func (x *Dialog) ConnectCloseFunc(cb func(Dialog)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close", &xDialogCloseTrampoline, xDialogCloseNewTrampoline, cb)
 }
 
 // Emitted when an action widget is clicked.
 //
 // The signal is also emitted when the dialog receives a
-// delete event, and when [method@Gtk.Dialog.response] is called.
-// On a delete event, the response ID is %GTK_RESPONSE_DELETE_EVENT.
+// delete event, and when [Dialog.Response] is called.
+// On a delete event, the response ID is [ResponseDeleteEventValue].
 // Otherwise, it depends on which action widget was clicked.
+//
+// Deprecated: since 4.10. Use [Window] instead
 func (x *Dialog) ConnectResponse(cb *func(Dialog, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseIdVarp int) {
+			defer core.RecoverPanic()
+			fa := Dialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResponseIdVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "response", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ResponseIdVarp int) {
+var xDialogResponseTrampoline uintptr
+
+func xDialogResponseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResponseIdVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Dialog, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Dialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResponseIdVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResponseFunc connects cb to the "response" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "response" signal is deprecated, see ConnectResponse.
+func (x *Dialog) ConnectResponseFunc(cb func(Dialog, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "response", &xDialogResponseTrampoline, xDialogResponseNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -795,7 +739,7 @@ func (x *Dialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -803,14 +747,13 @@ func (x *Dialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -834,19 +777,17 @@ func (x *Dialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -867,7 +808,7 @@ func (x *Dialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -876,14 +817,12 @@ func (x *Dialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Dialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -902,10 +841,10 @@ func (x *Dialog) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Dialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -942,10 +881,10 @@ func (x *Dialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *Dialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -989,7 +928,7 @@ func (x *Dialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *Dialog) GetFocus() *Widget {
 	var cls *Widget
@@ -1005,13 +944,13 @@ func (x *Dialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *Dialog) SetFocus(FocusVar *Widget) {
 
@@ -1024,7 +963,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1037,18 +976,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDialogGLibType, libs, "gtk_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewDialog, libs, "gtk_dialog_new")
-	core.PuregoSafeRegister(&xNewDialogWithButtons, libs, "gtk_dialog_new_with_buttons")
-
-	core.PuregoSafeRegister(&xDialogAddActionWidget, libs, "gtk_dialog_add_action_widget")
-	core.PuregoSafeRegister(&xDialogAddButton, libs, "gtk_dialog_add_button")
-	core.PuregoSafeRegister(&xDialogAddButtons, libs, "gtk_dialog_add_buttons")
-	core.PuregoSafeRegister(&xDialogGetContentArea, libs, "gtk_dialog_get_content_area")
-	core.PuregoSafeRegister(&xDialogGetHeaderBar, libs, "gtk_dialog_get_header_bar")
-	core.PuregoSafeRegister(&xDialogGetResponseForWidget, libs, "gtk_dialog_get_response_for_widget")
-	core.PuregoSafeRegister(&xDialogGetWidgetForResponse, libs, "gtk_dialog_get_widget_for_response")
-	core.PuregoSafeRegister(&xDialogResponse, libs, "gtk_dialog_response")
-	core.PuregoSafeRegister(&xDialogSetDefaultResponse, libs, "gtk_dialog_set_default_response")
-	core.PuregoSafeRegister(&xDialogSetResponseSensitive, libs, "gtk_dialog_set_response_sensitive")
+	gobject.CheckClassSize("GtkDialogClass", xDialogGLibType, unsafe.Sizeof(DialogClass{}))
 
 }
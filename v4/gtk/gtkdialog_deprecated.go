@@ -0,0 +1,270 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewDialog func() uintptr
+
+// Creates a new dialog box.
+//
+// Widgets should not be packed into the `GtkWindow`
+// directly, but into the content_area and action_area,
+// as described above.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func NewDialog() *Dialog {
+	var cls *Dialog
+
+	cret := xNewDialog()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Dialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewDialogWithButtons func(uintptr, uintptr, DialogFlags, uintptr, ...interface{}) uintptr
+
+// Creates a new `GtkDialog` with the given title and transient parent.
+//
+// The flags argument can be used to make the dialog modal, have it
+// destroyed along with its transient parent, or make it use a headerbar.
+//
+// Button text/response ID pairs should be listed in pairs, with a nil
+// pointer ending the list. Button text can be arbitrary text. A response
+// ID can be any positive number, or one of the values in the
+// [ResponseType] enumeration. If the user clicks one of these
+// buttons, `GtkDialog` will emit the [Dialog.ConnectResponseFunc] signal
+// with the corresponding response ID.
+//
+// If a `GtkDialog` receives a delete event, it will emit ::response with a
+// response ID of [ResponseDeleteEventValue].
+//
+// However, destroying a dialog does not emit the ::response signal;
+// so be careful relying on ::response when using the
+// [DialogDestroyWithParentValue] flag.
+//
+// Here’s a simple example:
+//
+//	GtkWindow *main_app_window; // Window the dialog should show up on
+//	GtkWidget *dialog;
+//	GtkDialogFlags flags = GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT;
+//	dialog = gtk_dialog_new_with_buttons ("My dialog",
+//	                                      main_app_window,
+//	                                      flags,
+//	                                      _("_OK"),
+//	                                      GTK_RESPONSE_ACCEPT,
+//	                                      _("_Cancel"),
+//	                                      GTK_RESPONSE_REJECT,
+//	                                      NULL);
+//
+// Deprecated: since 4.10. Use [Window] instead
+func NewDialogWithButtons(TitleVar *string, ParentVar *Window, FlagsVar DialogFlags, FirstButtonTextVar *string, varArgs ...interface{}) *Dialog {
+	return newDialogWithButtonsVarArgs(TitleVar, ParentVar, FlagsVar, FirstButtonTextVar, varArgs...)
+}
+
+var xDialogAddActionWidget func(uintptr, uintptr, int)
+
+// Adds an activatable widget to the action area of a `GtkDialog`.
+//
+// GTK connects a signal handler that will emit the
+// [Dialog.ConnectResponseFunc] signal on the dialog when the widget
+// is activated. The widget is appended to the end of the dialog’s action
+// area.
+//
+// If you want to add a non-activatable widget, simply pack it into
+// the action_area field of the `GtkDialog` struct.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) AddActionWidget(ChildVar *Widget, ResponseIdVar int) {
+
+	xDialogAddActionWidget(x.GoPointer(), ChildVar.GoPointer(), ResponseIdVar)
+
+}
+
+var xDialogAddButton func(uintptr, string, int) uintptr
+
+// Adds a button with the given text.
+//
+// GTK arranges things so that clicking the button will emit the
+// [Dialog.ConnectResponseFunc] signal with the given response_id.
+// The button is appended to the end of the dialog’s action area.
+// The button widget is returned, but usually you don’t need it.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) AddButton(ButtonTextVar string, ResponseIdVar int) *Widget {
+	var cls *Widget
+
+	cret := xDialogAddButton(x.GoPointer(), ButtonTextVar, ResponseIdVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xDialogAddButtons func(uintptr, string, ...interface{})
+
+// Adds multiple buttons.
+//
+// This is the same as calling [Dialog.AddButton]
+// repeatedly. The variable argument list should be nil-terminated
+// as with [NewDialogWithButtons]. Each button must have both
+// text and response ID.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) AddButtons(FirstButtonTextVar string, varArgs ...interface{}) {
+	dialogAddButtonsVarArgs(x, FirstButtonTextVar, varArgs...)
+}
+
+var xDialogGetContentArea func(uintptr) uintptr
+
+// Returns the content area of dialog.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) GetContentArea() *Box {
+	var cls *Box
+
+	cret := xDialogGetContentArea(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Box{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xDialogGetHeaderBar func(uintptr) uintptr
+
+// Returns the header bar of dialog.
+//
+// Note that the headerbar is only used by the dialog if the
+// [Dialog.GetPropertyUseHeaderBar] property is true.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) GetHeaderBar() *HeaderBar {
+	var cls *HeaderBar
+
+	cret := xDialogGetHeaderBar(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &HeaderBar{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xDialogGetResponseForWidget func(uintptr, uintptr) int
+
+// Gets the response id of a widget in the action area
+// of a dialog.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) GetResponseForWidget(WidgetVar *Widget) int {
+
+	cret := xDialogGetResponseForWidget(x.GoPointer(), WidgetVar.GoPointer())
+	return cret
+}
+
+var xDialogGetWidgetForResponse func(uintptr, int) uintptr
+
+// Gets the widget button that uses the given response ID in the action area
+// of a dialog.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) GetWidgetForResponse(ResponseIdVar int) *Widget {
+	var cls *Widget
+
+	cret := xDialogGetWidgetForResponse(x.GoPointer(), ResponseIdVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xDialogResponse func(uintptr, int)
+
+// Emits the ::response signal with the given response ID.
+//
+// Used to indicate that the user has responded to the dialog in some way.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) Response(ResponseIdVar int) {
+
+	xDialogResponse(x.GoPointer(), ResponseIdVar)
+
+}
+
+var xDialogSetDefaultResponse func(uintptr, int)
+
+// Sets the default widget for the dialog based on the response ID.
+//
+// Pressing “Enter” normally activates the default widget.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) SetDefaultResponse(ResponseIdVar int) {
+
+	xDialogSetDefaultResponse(x.GoPointer(), ResponseIdVar)
+
+}
+
+var xDialogSetResponseSensitive func(uintptr, int, bool)
+
+// A convenient way to sensitize/desensitize dialog buttons.
+//
+// Calls `gtk_widget_set_sensitive (widget, setting)`
+// for each widget in the dialog’s action area with the given response_id.
+//
+// Deprecated: since 4.10. Use [Window] instead
+func (x *Dialog) SetResponseSensitive(ResponseIdVar int, SettingVar bool) {
+
+	xDialogSetResponseSensitive(x.GoPointer(), ResponseIdVar, SettingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewDialog, libs, "gtk_dialog_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewDialogWithButtons, libs, "gtk_dialog_new_with_buttons", "GTK", "")
+
+	core.PuregoRegisterSince(&xDialogAddActionWidget, libs, "gtk_dialog_add_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xDialogAddButton, libs, "gtk_dialog_add_button", "GTK", "")
+	core.PuregoRegisterSince(&xDialogAddButtons, libs, "gtk_dialog_add_buttons", "GTK", "")
+	core.PuregoRegisterSince(&xDialogGetContentArea, libs, "gtk_dialog_get_content_area", "GTK", "")
+	core.PuregoRegisterSince(&xDialogGetHeaderBar, libs, "gtk_dialog_get_header_bar", "GTK", "")
+	core.PuregoRegisterSince(&xDialogGetResponseForWidget, libs, "gtk_dialog_get_response_for_widget", "GTK", "")
+	core.PuregoRegisterSince(&xDialogGetWidgetForResponse, libs, "gtk_dialog_get_widget_for_response", "GTK", "")
+	core.PuregoRegisterSince(&xDialogResponse, libs, "gtk_dialog_response", "GTK", "")
+	core.PuregoRegisterSince(&xDialogSetDefaultResponse, libs, "gtk_dialog_set_default_response", "GTK", "")
+	core.PuregoRegisterSince(&xDialogSetResponseSensitive, libs, "gtk_dialog_set_response_sensitive", "GTK", "")
+
+}
@@ -2,7 +2,8 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -31,6 +32,63 @@ const (
 	DialogErrorDismissedValue DialogError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (DialogError) ErrorDomain() string {
+	return "gtk-dialog-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x DialogError) Error() string {
+	switch x {
+	case DialogErrorFailedValue:
+		return "GTK_DIALOG_ERROR_FAILED"
+	case DialogErrorCancelledValue:
+		return "GTK_DIALOG_ERROR_CANCELLED"
+	case DialogErrorDismissedValue:
+		return "GTK_DIALOG_ERROR_DISMISSED"
+	}
+	return fmt.Sprintf("gtk-dialog-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x DialogError) String() string {
+	switch x {
+	case DialogErrorFailedValue:
+		return "GTK_DIALOG_ERROR_FAILED"
+	case DialogErrorCancelledValue:
+		return "GTK_DIALOG_ERROR_CANCELLED"
+	case DialogErrorDismissedValue:
+		return "GTK_DIALOG_ERROR_DISMISSED"
+	}
+	return fmt.Sprintf("DialogError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DialogError) Nick() string {
+	switch x {
+	case DialogErrorFailedValue:
+		return "failed"
+	case DialogErrorCancelledValue:
+		return "cancelled"
+	case DialogErrorDismissedValue:
+		return "dismissed"
+	}
+	return ""
+}
+
+// DialogErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DialogErrorFromString(s string) (DialogError, bool) {
+	switch s {
+	case "GTK_DIALOG_ERROR_FAILED", "failed":
+		return DialogErrorFailedValue, true
+	case "GTK_DIALOG_ERROR_CANCELLED", "cancelled":
+		return DialogErrorCancelledValue, true
+	case "GTK_DIALOG_ERROR_DISMISSED", "dismissed":
+		return DialogErrorDismissedValue, true
+	}
+	return 0, false
+}
+
 var xDialogErrorQuark func() glib.Quark
 
 // Registers an error quark for an operation that requires a dialog if
@@ -46,7 +104,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -55,6 +113,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xDialogErrorGLibType, libs, "gtk_dialog_error_get_type")
 
-	core.PuregoSafeRegister(&xDialogErrorQuark, libs, "gtk_dialog_error_quark")
+	core.PuregoRegisterSince(&xDialogErrorQuark, libs, "gtk_dialog_error_quark", "GTK", "")
 
 }
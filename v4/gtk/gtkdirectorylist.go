@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -23,25 +22,25 @@ func (x *DirectoryListClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A list model that wraps [method@Gio.File.enumerate_children_async].
+// A list model that wraps [gio.File.EnumerateChildrenAsync].
 //
 // It presents a `GListModel` and fills it asynchronously with the `GFileInfo`s
 // returned from that function.
 //
 // Enumeration will start automatically when the
-// [property@Gtk.DirectoryList:file] property is set.
+// DirectoryList:file property is set.
 //
 // While the `GtkDirectoryList` is being filled, the
-// [property@Gtk.DirectoryList:loading] property will be set to %TRUE. You can
+// [DirectoryList.GetPropertyLoading] property will be set to true. You can
 // listen to that property if you want to show information like a `GtkSpinner`
 // or a "Loading..." text.
 //
-// If loading fails at any point, the [property@Gtk.DirectoryList:error]
+// If loading fails at any point, the [DirectoryList.GetPropertyError]
 // property will be set to give more indication about the failure.
 //
 // The `GFileInfo`s returned from a `GtkDirectoryList` have the "standard::file"
 // attribute set to the `GFile` they refer to. This way you can get at the file
-// that is referred to in the same way you would via g_file_enumerator_get_child().
+// that is referred to in the same way you would via [gio.FileEnumerator.GetChild].
 // This means you do not need access to the `GtkDirectoryList`, but can access
 // the `GFile` directly from the `GFileInfo` when operating with a `GtkListView`
 // or similar.
@@ -65,8 +64,8 @@ var xNewDirectoryList func(uintptr, uintptr) uintptr
 
 // Creates a new `GtkDirectoryList`.
 //
-// The `GtkDirectoryList` is querying the given @file
-// with the given @attributes.
+// The `GtkDirectoryList` is querying the given file
+// with the given attributes.
 func NewDirectoryList(AttributesVar *string, FileVar gio.File) *DirectoryList {
 	var cls *DirectoryList
 
@@ -127,7 +126,7 @@ func (x *DirectoryList) GetFile() *gio.FileBase {
 
 var xDirectoryListGetIoPriority func(uintptr) int
 
-// Gets the IO priority set via gtk_directory_list_set_io_priority().
+// Gets the IO priority set via [DirectoryList.SetIoPriority].
 func (x *DirectoryList) GetIoPriority() int {
 
 	cret := xDirectoryListGetIoPriority(x.GoPointer())
@@ -146,10 +145,10 @@ func (x *DirectoryList) GetMonitored() bool {
 
 var xDirectoryListIsLoading func(uintptr) bool
 
-// Returns %TRUE if the children enumeration is currently in
+// Returns true if the children enumeration is currently in
 // progress.
 //
-// Files will be added to @self from time to time while loading is
+// Files will be added to self from time to time while loading is
 // going on. The order in which are added is undefined and may change
 // in between runs.
 func (x *DirectoryList) IsLoading() bool {
@@ -160,9 +159,9 @@ func (x *DirectoryList) IsLoading() bool {
 
 var xDirectoryListSetAttributes func(uintptr, uintptr)
 
-// Sets the @attributes to be enumerated and starts the enumeration.
+// Sets the attributes to be enumerated and starts the enumeration.
 //
-// If @attributes is %NULL, the list of file infos will still be created, it will just
+// If attributes is nil, the list of file infos will still be created, it will just
 // not contain any extra attributes.
 func (x *DirectoryList) SetAttributes(AttributesVar *string) {
 
@@ -175,9 +174,9 @@ func (x *DirectoryList) SetAttributes(AttributesVar *string) {
 
 var xDirectoryListSetFile func(uintptr, uintptr)
 
-// Sets the @file to be enumerated and starts the enumeration.
+// Sets the file to be enumerated and starts the enumeration.
 //
-// If @file is %NULL, the result will be an empty list.
+// If file is nil, the result will be an empty list.
 func (x *DirectoryList) SetFile(FileVar gio.File) {
 
 	xDirectoryListSetFile(x.GoPointer(), FileVar.GoPointer())
@@ -188,12 +187,12 @@ var xDirectoryListSetIoPriority func(uintptr, int)
 
 // Sets the IO priority to use while loading directories.
 //
-// Setting the priority while @self is loading will reprioritize the
+// Setting the priority while self is loading will reprioritize the
 // ongoing load as soon as possible.
 //
-// The default IO priority is %G_PRIORITY_DEFAULT, which is higher than
+// The default IO priority is [glib.PRIORITY_DEFAULT], which is higher than
 // the GTK redraw priority. If you are loading a lot of directories in
-// parallel, lowering it to something like %G_PRIORITY_DEFAULT_IDLE
+// parallel, lowering it to something like [glib.PRIORITY_DEFAULT_IDLE]
 // may increase responsiveness.
 func (x *DirectoryList) SetIoPriority(IoPriorityVar int) {
 
@@ -273,7 +272,7 @@ func (x *DirectoryList) GetPropertyIoPriority() int {
 }
 
 // GetPropertyLoading gets the "loading" property.
-// %TRUE if files are being loaded.
+// true if files are being loaded.
 func (x *DirectoryList) GetPropertyLoading() bool {
 	var v gobject.Value
 	x.GetProperty("loading", &v)
@@ -281,7 +280,7 @@ func (x *DirectoryList) GetPropertyLoading() bool {
 }
 
 // SetPropertyMonitored sets the "monitored" property.
-// %TRUE if the directory is monitored for changed.
+// true if the directory is monitored for changed.
 func (x *DirectoryList) SetPropertyMonitored(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -290,7 +289,7 @@ func (x *DirectoryList) SetPropertyMonitored(value bool) {
 }
 
 // GetPropertyMonitored gets the "monitored" property.
-// %TRUE if the directory is monitored for changed.
+// true if the directory is monitored for changed.
 func (x *DirectoryList) GetPropertyMonitored() bool {
 	var v gobject.Value
 	x.GetProperty("monitored", &v)
@@ -298,35 +297,37 @@ func (x *DirectoryList) GetPropertyMonitored() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *DirectoryList) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *DirectoryList) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *DirectoryList) GetItemType() types.GType {
 
@@ -334,29 +335,29 @@ func (x *DirectoryList) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *DirectoryList) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *DirectoryList) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -370,20 +371,20 @@ func (x *DirectoryList) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -401,7 +402,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -410,17 +411,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xDirectoryListGLibType, libs, "gtk_directory_list_get_type")
 
-	core.PuregoSafeRegister(&xNewDirectoryList, libs, "gtk_directory_list_new")
-
-	core.PuregoSafeRegister(&xDirectoryListGetAttributes, libs, "gtk_directory_list_get_attributes")
-	core.PuregoSafeRegister(&xDirectoryListGetError, libs, "gtk_directory_list_get_error")
-	core.PuregoSafeRegister(&xDirectoryListGetFile, libs, "gtk_directory_list_get_file")
-	core.PuregoSafeRegister(&xDirectoryListGetIoPriority, libs, "gtk_directory_list_get_io_priority")
-	core.PuregoSafeRegister(&xDirectoryListGetMonitored, libs, "gtk_directory_list_get_monitored")
-	core.PuregoSafeRegister(&xDirectoryListIsLoading, libs, "gtk_directory_list_is_loading")
-	core.PuregoSafeRegister(&xDirectoryListSetAttributes, libs, "gtk_directory_list_set_attributes")
-	core.PuregoSafeRegister(&xDirectoryListSetFile, libs, "gtk_directory_list_set_file")
-	core.PuregoSafeRegister(&xDirectoryListSetIoPriority, libs, "gtk_directory_list_set_io_priority")
-	core.PuregoSafeRegister(&xDirectoryListSetMonitored, libs, "gtk_directory_list_set_monitored")
+	gobject.CheckClassSize("GtkDirectoryListClass", xDirectoryListGLibType, unsafe.Sizeof(DirectoryListClass{}))
+
+	core.PuregoRegisterSince(&xNewDirectoryList, libs, "gtk_directory_list_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xDirectoryListGetAttributes, libs, "gtk_directory_list_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListGetError, libs, "gtk_directory_list_get_error", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListGetFile, libs, "gtk_directory_list_get_file", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListGetIoPriority, libs, "gtk_directory_list_get_io_priority", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListGetMonitored, libs, "gtk_directory_list_get_monitored", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListIsLoading, libs, "gtk_directory_list_is_loading", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListSetAttributes, libs, "gtk_directory_list_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListSetFile, libs, "gtk_directory_list_set_file", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListSetIoPriority, libs, "gtk_directory_list_set_io_priority", "GTK", "")
+	core.PuregoRegisterSince(&xDirectoryListSetMonitored, libs, "gtk_directory_list_set_monitored", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -29,8 +28,8 @@ func (x *DragIconClass) GoPointer() uintptr {
 // and is destroyed when the drag ends.
 //
 // To set up a drag icon and associate it with an ongoing drag operation,
-// use [ctor@Gtk.DragIcon.get_for_drag] to get the icon for a drag. You can
-// then use it like any other widget and use [method@Gtk.DragIcon.set_child]
+// use [DragIconGetForDrag] to get the icon for a drag. You can
+// then use it like any other widget and use [DragIcon.SetChild]
 // to set whatever widget should be used for the drag icon.
 //
 // Keep in mind that drag icons do not allow user input.
@@ -52,7 +51,7 @@ func DragIconNewFromInternalPtr(ptr uintptr) *DragIcon {
 
 var xDragIconGetForDrag func(uintptr) uintptr
 
-// Gets the `GtkDragIcon` in use with @drag.
+// Gets the `GtkDragIcon` in use with drag.
 //
 // If no drag icon exists yet, a new one will be created
 // and shown.
@@ -268,7 +267,7 @@ func (x *DragIcon) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -276,14 +275,13 @@ func (x *DragIcon) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *DragIcon) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -307,19 +305,17 @@ func (x *DragIcon) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *DragIcon) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -340,7 +336,7 @@ func (x *DragIcon) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -349,14 +345,12 @@ func (x *DragIcon) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *DragIcon) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -375,10 +369,10 @@ func (x *DragIcon) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *DragIcon) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -415,10 +409,10 @@ func (x *DragIcon) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *DragIcon) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -462,7 +456,7 @@ func (x *DragIcon) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *DragIcon) GetFocus() *Widget {
 	var cls *Widget
@@ -478,13 +472,13 @@ func (x *DragIcon) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *DragIcon) SetFocus(FocusVar *Widget) {
 
@@ -495,11 +489,11 @@ func (x *DragIcon) SetFocus(FocusVar *Widget) {
 var xDragIconCreateWidgetForValue func(*gobject.Value) uintptr
 
 // Creates a widget that can be used as a drag icon for the given
-// @value.
+// value.
 //
 // Supported types include strings, `GdkRGBA` and `GtkTextBuffer`.
 // If GTK does not know how to create a widget for a given value,
-// it will return %NULL.
+// it will return nil.
 //
 // This method is used to set the default drag icon on drag-and-drop
 // operations started by `GtkDragSource`, so you don't need to set
@@ -519,7 +513,7 @@ func DragIconCreateWidgetForValue(ValueVar *gobject.Value) *Widget {
 
 var xDragIconSetFromPaintable func(uintptr, uintptr, int, int)
 
-// Creates a `GtkDragIcon` that shows @paintable, and associates
+// Creates a `GtkDragIcon` that shows paintable, and associates
 // it with the drag operation.
 //
 // The hotspot position on the paintable is aligned with the
@@ -535,7 +529,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -544,12 +538,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xDragIconGLibType, libs, "gtk_drag_icon_get_type")
 
-	core.PuregoSafeRegister(&xDragIconGetForDrag, libs, "gtk_drag_icon_get_for_drag")
+	gobject.CheckClassSize("GtkDragIconClass", xDragIconGLibType, unsafe.Sizeof(DragIconClass{}))
+
+	core.PuregoRegisterSince(&xDragIconGetForDrag, libs, "gtk_drag_icon_get_for_drag", "GTK", "")
 
-	core.PuregoSafeRegister(&xDragIconGetChild, libs, "gtk_drag_icon_get_child")
-	core.PuregoSafeRegister(&xDragIconSetChild, libs, "gtk_drag_icon_set_child")
+	core.PuregoRegisterSince(&xDragIconGetChild, libs, "gtk_drag_icon_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xDragIconSetChild, libs, "gtk_drag_icon_set_child", "GTK", "")
 
-	core.PuregoSafeRegister(&xDragIconCreateWidgetForValue, libs, "gtk_drag_icon_create_widget_for_value")
-	core.PuregoSafeRegister(&xDragIconSetFromPaintable, libs, "gtk_drag_icon_set_from_paintable")
+	core.PuregoRegisterSince(&xDragIconCreateWidgetForValue, libs, "gtk_drag_icon_create_widget_for_value", "GTK", "")
+	core.PuregoRegisterSince(&xDragIconSetFromPaintable, libs, "gtk_drag_icon_set_from_paintable", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -26,15 +25,13 @@ func (x *DragSourceClass) GoPointer() uintptr {
 // `GtkDragSource` can be set up with the necessary
 // ingredients for a DND operation ahead of time. This includes
 // the source for the data that is being transferred, in the form
-// of a [class@Gdk.ContentProvider], the desired action, and the icon to
+// of a [gdk.ContentProvider], the desired action, and the icon to
 // use during the drag operation. After setting it up, the drag
 // source must be added to a widget as an event controller, using
-// [method@Gtk.Widget.add_controller].
-//
-// ```c
-// static void
-// my_widget_init (MyWidget *self)
+// [Widget.AddController].
 //
+//	static void
+//	my_widget_init (MyWidget *self)
 //	{
 //	  GtkDragSource *drag_source = gtk_drag_source_new ();
 //
@@ -44,26 +41,21 @@ func (x *DragSourceClass) GoPointer() uintptr {
 //	  gtk_widget_add_controller (GTK_WIDGET (self), GTK_EVENT_CONTROLLER (drag_source));
 //	}
 //
-// ```
-//
 // Setting up the content provider and icon ahead of time only makes
 // sense when the data does not change. More commonly, you will want
 // to set them up just in time. To do so, `GtkDragSource` has
-// [signal@Gtk.DragSource::prepare] and [signal@Gtk.DragSource::drag-begin]
+// [DragSource.ConnectPrepareFunc] and [DragSource.ConnectDragBeginFunc]
 // signals.
 //
 // The ::prepare signal is emitted before a drag is started, and
 // can be used to set the content provider and actions that the
 // drag should be started with.
 //
-// ```c
-// static GdkContentProvider *
-// on_drag_prepare (GtkDragSource *source,
-//
-//	double         x,
-//	double         y,
-//	MyWidget      *self)
-//
+//	static GdkContentProvider *
+//	on_drag_prepare (GtkDragSource *source,
+//	                 double         x,
+//	                 double         y,
+//	                 MyWidget      *self)
 //	{
 //	  // This widget supports two types of content: GFile objects
 //	  // and GdkPixbuf objects; GTK will handle the serialization
@@ -77,18 +69,13 @@ func (x *DragSourceClass) GoPointer() uintptr {
 //	    }, 2);
 //	}
 //
-// ```
-//
 // The ::drag-begin signal is emitted after the `GdkDrag` object has
 // been created, and can be used to set up the drag icon.
 //
-// ```c
-// static void
-// on_drag_begin (GtkDragSource *source,
-//
-//	GdkDrag       *drag,
-//	MyWidget      *self)
-//
+//	static void
+//	on_drag_begin (GtkDragSource *source,
+//	               GdkDrag       *drag,
+//	               MyWidget      *self)
 //	{
 //	  // Set the widget as the drag icon
 //	  GdkPaintable *paintable = gtk_widget_paintable_new (GTK_WIDGET (self));
@@ -96,14 +83,12 @@ func (x *DragSourceClass) GoPointer() uintptr {
 //	  g_object_unref (paintable);
 //	}
 //
-// ```
-//
 // During the DND operation, `GtkDragSource` emits signals that
 // can be used to obtain updates about the status of the operation,
 // but it is not normally necessary to connect to any signals,
 // except for one case: when the supported actions include
-// %GDK_ACTION_MOVE, you need to listen for the
-// [signal@Gtk.DragSource::drag-end] signal and delete the
+// [gdk.ActionMoveValue], you need to listen for the
+// [DragSource.ConnectDragEndFunc] signal and delete the
 // data after it has been transferred.
 type DragSource struct {
 	GestureSingle
@@ -194,12 +179,12 @@ var xDragSourceSetActions func(uintptr, gdk.DragAction)
 // Sets the actions on the `GtkDragSource`.
 //
 // During a DND operation, the actions are offered to potential
-// drop targets. If @actions include %GDK_ACTION_MOVE, you need
-// to listen to the [signal@Gtk.DragSource::drag-end] signal and
-// handle @delete_data being %TRUE.
+// drop targets. If actions include [gdk.ActionMoveValue], you need
+// to listen to the [DragSource.ConnectDragEndFunc] signal and
+// handle delete_data being true.
 //
 // This function can be called before a drag is started,
-// or in a handler for the [signal@Gtk.DragSource::prepare] signal.
+// or in a handler for the [DragSource.ConnectPrepareFunc] signal.
 func (x *DragSource) SetActions(ActionsVar gdk.DragAction) {
 
 	xDragSourceSetActions(x.GoPointer(), ActionsVar)
@@ -214,10 +199,10 @@ var xDragSourceSetContent func(uintptr, uintptr)
 // it will be obtained from the content provider.
 //
 // This function can be called before a drag is started,
-// or in a handler for the [signal@Gtk.DragSource::prepare] signal.
+// or in a handler for the [DragSource.ConnectPrepareFunc] signal.
 //
 // You may consider setting the content provider back to
-// %NULL in a [signal@Gtk.DragSource::drag-end] signal handler.
+// nil in a [DragSource.ConnectDragEndFunc] signal handler.
 func (x *DragSource) SetContent(ContentVar *gdk.ContentProvider) {
 
 	xDragSourceSetContent(x.GoPointer(), ContentVar.GoPointer())
@@ -231,11 +216,11 @@ var xDragSourceSetIcon func(uintptr, uintptr, int, int)
 // The hotspot coordinates determine the point on the icon
 // that gets aligned with the hotspot of the cursor.
 //
-// If @paintable is %NULL, a default icon is used.
+// If paintable is nil, a default icon is used.
 //
 // This function can be called before a drag is started, or in
-// a [signal@Gtk.DragSource::prepare] or
-// [signal@Gtk.DragSource::drag-begin] signal handler.
+// a [DragSource.ConnectPrepareFunc] or
+// [DragSource.ConnectDragBeginFunc] signal handler.
 func (x *DragSource) SetIcon(PaintableVar gdk.Paintable, HotXVar int, HotYVar int) {
 
 	xDragSourceSetIcon(x.GoPointer(), PaintableVar.GoPointer(), HotXVar, HotYVar)
@@ -256,114 +241,203 @@ func (c *DragSource) SetGoPointer(ptr uintptr) {
 // Emitted on the drag source when a drag is started.
 //
 // It can be used to e.g. set a custom drag icon with
-// [method@Gtk.DragSource.set_icon].
+// [DragSource.SetIcon].
+//
+// Deprecated: use ConnectDragBeginFunc, which also accepts method values and closures.
 func (x *DragSource) ConnectDragBegin(cb *func(DragSource, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-begin", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DragVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DragSource{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DragVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-begin", cbRefPtr, cbPtr)
+}
+
+var xDragSourceDragBeginTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DragVarp uintptr) {
+func xDragSourceDragBeginNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DragVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DragSource, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DragSource{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DragVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-begin", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragBeginFunc connects cb to the "drag-begin" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DragSource) ConnectDragBeginFunc(cb func(DragSource, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-begin", &xDragSourceDragBeginTrampoline, xDragSourceDragBeginNewTrampoline, cb)
 }
 
 // Emitted on the drag source when a drag has failed.
 //
 // The signal handler may handle a failed drag operation based on
-// the type of error. It should return %TRUE if the failure has been handled
+// the type of error. It should return true if the failure has been handled
 // and the default "drag operation failed" animation should not be shown.
+//
+// Deprecated: use ConnectDragCancelFunc, which also accepts method values and closures.
 func (x *DragSource) ConnectDragCancel(cb *func(DragSource, uintptr, gdk.DragCancelReason) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-cancel", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DragVarp uintptr, ReasonVarp gdk.DragCancelReason) bool {
+			defer core.RecoverPanic()
+			fa := DragSource{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DragVarp, ReasonVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-cancel", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DragVarp uintptr, ReasonVarp gdk.DragCancelReason) bool {
+var xDragSourceDragCancelTrampoline uintptr
+
+func xDragSourceDragCancelNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DragVarp uintptr, ReasonVarp gdk.DragCancelReason, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DragSource, uintptr, gdk.DragCancelReason) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DragSource{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DragVarp, ReasonVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-cancel", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragCancelFunc connects cb to the "drag-cancel" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DragSource) ConnectDragCancelFunc(cb func(DragSource, uintptr, gdk.DragCancelReason) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-cancel", &xDragSourceDragCancelTrampoline, xDragSourceDragCancelNewTrampoline, cb)
 }
 
 // Emitted on the drag source when a drag is finished.
 //
 // A typical reason to connect to this signal is to undo
-// things done in [signal@Gtk.DragSource::prepare] or
-// [signal@Gtk.DragSource::drag-begin] handlers.
+// things done in [DragSource.ConnectPrepareFunc] or
+// [DragSource.ConnectDragBeginFunc] handlers.
+//
+// Deprecated: use ConnectDragEndFunc, which also accepts method values and closures.
 func (x *DragSource) ConnectDragEnd(cb *func(DragSource, uintptr, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-end", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DragVarp uintptr, DeleteDataVarp bool) {
+			defer core.RecoverPanic()
+			fa := DragSource{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DragVarp, DeleteDataVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-end", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DragVarp uintptr, DeleteDataVarp bool) {
+var xDragSourceDragEndTrampoline uintptr
+
+func xDragSourceDragEndNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DragVarp uintptr, DeleteDataVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DragSource, uintptr, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DragSource{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DragVarp, DeleteDataVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-end", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragEndFunc connects cb to the "drag-end" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DragSource) ConnectDragEndFunc(cb func(DragSource, uintptr, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-end", &xDragSourceDragEndTrampoline, xDragSourceDragEndNewTrampoline, cb)
 }
 
 // Emitted when a drag is about to be initiated.
 //
 // It returns the `GdkContentProvider` to use for the drag that is about
 // to start. The default handler for this signal returns the value of
-// the [property@Gtk.DragSource:content] property, so if you set up that
+// the DragSource:content property, so if you set up that
 // property ahead of time, you don't need to connect to this signal.
+//
+// Deprecated: use ConnectPrepareFunc, which also accepts method values and closures.
 func (x *DragSource) ConnectPrepare(cb *func(DragSource, float64, float64) gdk.ContentProvider) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) uintptr {
+			defer core.RecoverPanic()
+			fa := DragSource{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			PrepareCls := cbFn(fa, XVarp, YVarp)
+			return PrepareCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "prepare", cbRefPtr, cbPtr)
+}
+
+var xDragSourcePrepareTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) uintptr {
+func xDragSourcePrepareNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DragSource, float64, float64) gdk.ContentProvider)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DragSource{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		PrepareCls := cbFn(fa, XVarp, YVarp)
 		return PrepareCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "prepare", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPrepareFunc connects cb to the "prepare" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DragSource) ConnectPrepareFunc(cb func(DragSource, float64, float64) gdk.ContentProvider) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "prepare", &xDragSourcePrepareTrampoline, xDragSourcePrepareNewTrampoline, cb)
 }
 
 func init() {
@@ -371,7 +445,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -380,14 +454,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xDragSourceGLibType, libs, "gtk_drag_source_get_type")
 
-	core.PuregoSafeRegister(&xNewDragSource, libs, "gtk_drag_source_new")
+	core.PuregoRegisterSince(&xNewDragSource, libs, "gtk_drag_source_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xDragSourceDragCancel, libs, "gtk_drag_source_drag_cancel")
-	core.PuregoSafeRegister(&xDragSourceGetActions, libs, "gtk_drag_source_get_actions")
-	core.PuregoSafeRegister(&xDragSourceGetContent, libs, "gtk_drag_source_get_content")
-	core.PuregoSafeRegister(&xDragSourceGetDrag, libs, "gtk_drag_source_get_drag")
-	core.PuregoSafeRegister(&xDragSourceSetActions, libs, "gtk_drag_source_set_actions")
-	core.PuregoSafeRegister(&xDragSourceSetContent, libs, "gtk_drag_source_set_content")
-	core.PuregoSafeRegister(&xDragSourceSetIcon, libs, "gtk_drag_source_set_icon")
+	core.PuregoRegisterSince(&xDragSourceDragCancel, libs, "gtk_drag_source_drag_cancel", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceGetActions, libs, "gtk_drag_source_get_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceGetContent, libs, "gtk_drag_source_get_content", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceGetDrag, libs, "gtk_drag_source_get_drag", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceSetActions, libs, "gtk_drag_source_set_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceSetContent, libs, "gtk_drag_source_set_content", "GTK", "")
+	core.PuregoRegisterSince(&xDragSourceSetIcon, libs, "gtk_drag_source_set_icon", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -13,7 +12,7 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Whenever @drawing_area needs to redraw, this function will be called.
+// Whenever drawing_area needs to redraw, this function will be called.
 //
 // This function should exclusively redraw the contents of the drawing area
 // and must not call any widget functions that cause changes.
@@ -38,7 +37,8 @@ func (x *DrawingAreaClass) OverrideResize(cb func(*DrawingArea, int, int)) {
 	if cb == nil {
 		x.xResize = 0
 	} else {
-		x.xResize = purego.NewCallback(func(AreaVarp uintptr, WidthVarp int, HeightVarp int) {
+		x.xResize = core.NewCallback(func(AreaVarp uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(DrawingAreaNewFromInternalPtr(AreaVarp), WidthVarp, HeightVarp)
 		})
 	}
@@ -50,7 +50,7 @@ func (x *DrawingAreaClass) GetResize() func(*DrawingArea, int, int) {
 		return nil
 	}
 	var rawCallback func(AreaVarp uintptr, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xResize)
+	core.RegisterFunc(&rawCallback, x.xResize)
 	return func(AreaVar *DrawingArea, WidthVar int, HeightVar int) {
 		rawCallback(AreaVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -58,41 +58,31 @@ func (x *DrawingAreaClass) GetResize() func(*DrawingArea, int, int) {
 
 // Allows drawing with cairo.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="drawingarea-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkDrawingArea" src="drawingarea.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It’s essentially a blank widget; you can draw on it. After
 // creating a drawing area, the application may want to connect to:
 //
-//   - The [signal@Gtk.Widget::realize] signal to take any necessary actions
+//   - The [Widget.ConnectRealizeFunc] signal to take any necessary actions
 //     when the widget is instantiated on a particular display.
 //     (Create GDK resources in response to this signal.)
 //
-//   - The [signal@Gtk.DrawingArea::resize] signal to take any necessary
+//   - The [DrawingArea.ConnectResizeFunc] signal to take any necessary
 //     actions when the widget changes size.
 //
-//   - Call [method@Gtk.DrawingArea.set_draw_func] to handle redrawing the
+//   - Call [DrawingArea.SetDrawFunc] to handle redrawing the
 //     contents of the widget.
 //
 // The following code portion demonstrates using a drawing
 // area to display a circle in the normal widget foreground
 // color.
 //
-// ## Simple GtkDrawingArea usage
-//
-// ```c
-// static void
-// draw_function (GtkDrawingArea *area,
-//
-//	cairo_t        *cr,
-//	int             width,
-//	int             height,
-//	gpointer        data)
+// # Simple GtkDrawingArea usage
 //
+//	static void
+//	draw_function (GtkDrawingArea *area,
+//	               cairo_t        *cr,
+//	               int             width,
+//	               int             height,
+//	               gpointer        data)
 //	{
 //	  GdkRGBA color;
 //
@@ -102,15 +92,14 @@ func (x *DrawingAreaClass) GetResize() func(*DrawingArea, int, int) {
 //	             0, 2 * G_PI);
 //
 //	  gtk_widget_get_color (GTK_WIDGET (area),
-//	                        &amp;color);
-//	  gdk_cairo_set_source_rgba (cr, &amp;color);
+//	                        &color);
+//	  gdk_cairo_set_source_rgba (cr, &color);
 //
 //	  cairo_fill (cr);
 //	}
 //
-// int
-// main (int argc, char **argv)
-//
+//	int
+//	main (int argc, char **argv)
 //	{
 //	  gtk_init ();
 //
@@ -123,18 +112,16 @@ func (x *DrawingAreaClass) GetResize() func(*DrawingArea, int, int) {
 //	  return 0;
 //	}
 //
-// ```
-//
 // The draw function is normally called when a drawing area first comes
 // onscreen, or when it’s covered by another window and then uncovered.
 // You can also force a redraw by adding to the “damage region” of the
-// drawing area’s window using [method@Gtk.Widget.queue_draw].
+// drawing area’s window using [Widget.QueueDraw].
 // This will cause the drawing area to call the draw function again.
 //
 // The available routines for drawing are documented in the
-// [Cairo documentation](https://www.cairographics.org/manual/); GDK
-// offers additional API to integrate with Cairo, like [func@Gdk.cairo_set_source_rgba]
-// or [func@Gdk.cairo_set_source_pixbuf].
+// [Cairo documentation]; GDK
+// offers additional API to integrate with Cairo, like [gdk.CairoSetSourceRgba]
+// or [gdk.CairoSetSourcePixbuf].
 //
 // To receive mouse events on a drawing area, you will need to use
 // event controllers. To receive keyboard events, you will need to set
@@ -143,6 +130,8 @@ func (x *DrawingAreaClass) GetResize() func(*DrawingArea, int, int) {
 //
 // If you need more complex control over your widget, you should consider
 // creating your own `GtkWidget` subclass.
+//
+// [Cairo documentation]: https://www.cairographics.org/manual/
 type DrawingArea struct {
 	Widget
 }
@@ -201,7 +190,7 @@ var xDrawingAreaSetContentHeight func(uintptr, int)
 // Note that because widgets may be allocated larger sizes than they
 // requested, it is possible that the actual height passed to your draw
 // function is larger than the height set here. You can use
-// [method@Gtk.Widget.set_valign] to avoid that.
+// [Widget.SetValign] to avoid that.
 //
 // If the height is set to 0 (the default), the drawing area may disappear.
 func (x *DrawingArea) SetContentHeight(HeightVar int) {
@@ -217,7 +206,7 @@ var xDrawingAreaSetContentWidth func(uintptr, int)
 // Note that because widgets may be allocated larger sizes than they
 // requested, it is possible that the actual width passed to your draw
 // function is larger than the width set here. You can use
-// [method@Gtk.Widget.set_halign] to avoid that.
+// [Widget.SetHalign] to avoid that.
 //
 // If the width is set to 0 (the default), the drawing area may disappear.
 func (x *DrawingArea) SetContentWidth(WidthVar int) {
@@ -240,8 +229,8 @@ var xDrawingAreaSetDrawFunc func(uintptr, uintptr, uintptr, uintptr)
 // to be changed. You should restrict yourself exclusively to drawing
 // your contents in the draw function.
 //
-// If what you are drawing does change, call [method@Gtk.Widget.queue_draw]
-// on the drawing area. This will cause a redraw and will call @draw_func again.
+// If what you are drawing does change, call [Widget.QueueDraw]
+// on the drawing area. This will cause a redraw and will call draw_func again.
 func (x *DrawingArea) SetDrawFunc(DrawFuncVar *DrawingAreaDrawFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var DrawFuncVarRef uintptr
@@ -251,11 +240,12 @@ func (x *DrawingArea) SetDrawFunc(DrawFuncVar *DrawingAreaDrawFunc, UserDataVar
 			DrawFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *cairo.Context, arg2 int, arg3 int, arg4 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DrawFuncVar
 				cbFn(arg0, arg1, arg2, arg3, arg4)
 			}
-			DrawFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DrawFuncVarPtr, DrawFuncVarRef, DrawFuncVar)
+			DrawFuncVarRef = core.NewCallback(fcb)
+			DrawFuncVarRef = glib.SaveCallbackWithClosure(DrawFuncVarPtr, DrawFuncVarRef, DrawFuncVar)
 		}
 	}
 
@@ -266,11 +256,12 @@ func (x *DrawingArea) SetDrawFunc(DrawFuncVar *DrawingAreaDrawFunc, UserDataVar
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -328,27 +319,49 @@ func (x *DrawingArea) GetPropertyContentWidth() int {
 //
 // This is useful in order to keep state up to date with the widget size,
 // like for instance a backing surface.
+//
+// Deprecated: use ConnectResizeFunc, which also accepts method values and closures.
 func (x *DrawingArea) ConnectResize(cb *func(DrawingArea, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "resize", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
+			fa := DrawingArea{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidthVarp, HeightVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "resize", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+var xDrawingAreaResizeTrampoline uintptr
+
+func xDrawingAreaResizeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidthVarp int, HeightVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DrawingArea, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DrawingArea{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidthVarp, HeightVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "resize", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResizeFunc connects cb to the "resize" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DrawingArea) ConnectResizeFunc(cb func(DrawingArea, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "resize", &xDrawingAreaResizeTrampoline, xDrawingAreaResizeNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -512,7 +525,7 @@ func (x *DrawingArea) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -520,14 +533,13 @@ func (x *DrawingArea) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *DrawingArea) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -551,19 +563,17 @@ func (x *DrawingArea) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *DrawingArea) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -584,7 +594,7 @@ func (x *DrawingArea) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -593,14 +603,12 @@ func (x *DrawingArea) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *DrawingArea) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -619,10 +627,10 @@ func (x *DrawingArea) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *DrawingArea) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -634,7 +642,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -643,12 +651,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xDrawingAreaGLibType, libs, "gtk_drawing_area_get_type")
 
-	core.PuregoSafeRegister(&xNewDrawingArea, libs, "gtk_drawing_area_new")
+	gobject.CheckClassSize("GtkDrawingAreaClass", xDrawingAreaGLibType, unsafe.Sizeof(DrawingAreaClass{}))
+
+	core.PuregoRegisterSince(&xNewDrawingArea, libs, "gtk_drawing_area_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xDrawingAreaGetContentHeight, libs, "gtk_drawing_area_get_content_height")
-	core.PuregoSafeRegister(&xDrawingAreaGetContentWidth, libs, "gtk_drawing_area_get_content_width")
-	core.PuregoSafeRegister(&xDrawingAreaSetContentHeight, libs, "gtk_drawing_area_set_content_height")
-	core.PuregoSafeRegister(&xDrawingAreaSetContentWidth, libs, "gtk_drawing_area_set_content_width")
-	core.PuregoSafeRegister(&xDrawingAreaSetDrawFunc, libs, "gtk_drawing_area_set_draw_func")
+	core.PuregoRegisterSince(&xDrawingAreaGetContentHeight, libs, "gtk_drawing_area_get_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xDrawingAreaGetContentWidth, libs, "gtk_drawing_area_get_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xDrawingAreaSetContentHeight, libs, "gtk_drawing_area_set_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xDrawingAreaSetContentWidth, libs, "gtk_drawing_area_set_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xDrawingAreaSetDrawFunc, libs, "gtk_drawing_area_set_draw_func", "GTK", "")
 
 }
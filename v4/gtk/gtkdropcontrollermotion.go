@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -23,10 +22,10 @@ func (x *DropControllerMotionClass) GoPointer() uintptr {
 
 // An event controller tracking the pointer during Drag-and-Drop operations.
 //
-// It is modeled after [class@Gtk.EventControllerMotion] so if you
+// It is modeled after [EventControllerMotion] so if you
 // have used that, this should feel really familiar.
 //
-// This controller is not able to accept drops, use [class@Gtk.DropTarget]
+// This controller is not able to accept drops, use [DropTarget]
 // for that purpose.
 type DropControllerMotion struct {
 	EventController
@@ -64,7 +63,7 @@ func NewDropControllerMotion() *DropControllerMotion {
 var xDropControllerMotionContainsPointer func(uintptr) bool
 
 // Returns if a Drag-and-Drop operation is within the widget
-// @self or one of its children.
+// self or one of its children.
 func (x *DropControllerMotion) ContainsPointer() bool {
 
 	cret := xDropControllerMotionContainsPointer(x.GoPointer())
@@ -74,7 +73,7 @@ func (x *DropControllerMotion) ContainsPointer() bool {
 var xDropControllerMotionGetDrop func(uintptr) uintptr
 
 // Returns the `GdkDrop` of a current Drag-and-Drop operation
-// over the widget of @self.
+// over the widget of self.
 func (x *DropControllerMotion) GetDrop() *gdk.Drop {
 	var cls *gdk.Drop
 
@@ -92,7 +91,7 @@ func (x *DropControllerMotion) GetDrop() *gdk.Drop {
 var xDropControllerMotionIsPointer func(uintptr) bool
 
 // Returns if a Drag-and-Drop operation is within the widget
-// @self, not one of its children.
+// self, not one of its children.
 func (x *DropControllerMotion) IsPointer() bool {
 
 	cret := xDropControllerMotionIsPointer(x.GoPointer())
@@ -114,11 +113,11 @@ func (c *DropControllerMotion) SetGoPointer(ptr uintptr) {
 // Whether the pointer of a Drag-and-Drop operation is in
 // the controller's widget or a descendant.
 //
-// See also [property@Gtk.DropControllerMotion:is-pointer].
+// See also [DropControllerMotion.GetPropertyIsPointer].
 //
 // When handling crossing events, this property is updated
-// before [signal@Gtk.DropControllerMotion::enter], but after
-// [signal@Gtk.DropControllerMotion::leave] is emitted.
+// before [DropControllerMotion.ConnectEnterFunc], but after
+// [DropControllerMotion.ConnectLeaveFunc] is emitted.
 func (x *DropControllerMotion) GetPropertyContainsPointer() bool {
 	var v gobject.Value
 	x.GetProperty("contains-pointer", &v)
@@ -129,11 +128,11 @@ func (x *DropControllerMotion) GetPropertyContainsPointer() bool {
 // Whether the pointer is in the controllers widget itself,
 // as opposed to in a descendent widget.
 //
-// See also [property@Gtk.DropControllerMotion:contains-pointer].
+// See also [DropControllerMotion.GetPropertyContainsPointer].
 //
 // When handling crossing events, this property is updated
-// before [signal@Gtk.DropControllerMotion::enter], but after
-// [signal@Gtk.DropControllerMotion::leave] is emitted.
+// before [DropControllerMotion.ConnectEnterFunc], but after
+// [DropControllerMotion.ConnectLeaveFunc] is emitted.
 func (x *DropControllerMotion) GetPropertyIsPointer() bool {
 	var v gobject.Value
 	x.GetProperty("is-pointer", &v)
@@ -141,75 +140,141 @@ func (x *DropControllerMotion) GetPropertyIsPointer() bool {
 }
 
 // Signals that the pointer has entered the widget.
+//
+// Deprecated: use ConnectEnterFunc, which also accepts method values and closures.
 func (x *DropControllerMotion) ConnectEnter(cb *func(DropControllerMotion, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := DropControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enter", cbRefPtr, cbPtr)
+}
+
+var xDropControllerMotionEnterTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+func xDropControllerMotionEnterNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropControllerMotion, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEnterFunc connects cb to the "enter" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropControllerMotion) ConnectEnterFunc(cb func(DropControllerMotion, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enter", &xDropControllerMotionEnterTrampoline, xDropControllerMotionEnterNewTrampoline, cb)
 }
 
 // Signals that the pointer has left the widget.
+//
+// Deprecated: use ConnectLeaveFunc, which also accepts method values and closures.
 func (x *DropControllerMotion) ConnectLeave(cb *func(DropControllerMotion)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := DropControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "leave", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDropControllerMotionLeaveTrampoline uintptr
+
+func xDropControllerMotionLeaveNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropControllerMotion))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLeaveFunc connects cb to the "leave" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropControllerMotion) ConnectLeaveFunc(cb func(DropControllerMotion)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "leave", &xDropControllerMotionLeaveTrampoline, xDropControllerMotionLeaveNewTrampoline, cb)
 }
 
 // Emitted when the pointer moves inside the widget.
+//
+// Deprecated: use ConnectMotionFunc, which also accepts method values and closures.
 func (x *DropControllerMotion) ConnectMotion(cb *func(DropControllerMotion, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := DropControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "motion", cbRefPtr, cbPtr)
+}
+
+var xDropControllerMotionMotionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+func xDropControllerMotionMotionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropControllerMotion, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMotionFunc connects cb to the "motion" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropControllerMotion) ConnectMotionFunc(cb func(DropControllerMotion, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "motion", &xDropControllerMotionMotionTrampoline, xDropControllerMotionMotionNewTrampoline, cb)
 }
 
 func init() {
@@ -217,7 +282,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -226,10 +291,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xDropControllerMotionGLibType, libs, "gtk_drop_controller_motion_get_type")
 
-	core.PuregoSafeRegister(&xNewDropControllerMotion, libs, "gtk_drop_controller_motion_new")
+	core.PuregoRegisterSince(&xNewDropControllerMotion, libs, "gtk_drop_controller_motion_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xDropControllerMotionContainsPointer, libs, "gtk_drop_controller_motion_contains_pointer")
-	core.PuregoSafeRegister(&xDropControllerMotionGetDrop, libs, "gtk_drop_controller_motion_get_drop")
-	core.PuregoSafeRegister(&xDropControllerMotionIsPointer, libs, "gtk_drop_controller_motion_is_pointer")
+	core.PuregoRegisterSince(&xDropControllerMotionContainsPointer, libs, "gtk_drop_controller_motion_contains_pointer", "GTK", "")
+	core.PuregoRegisterSince(&xDropControllerMotionGetDrop, libs, "gtk_drop_controller_motion_get_drop", "GTK", "")
+	core.PuregoRegisterSince(&xDropControllerMotionIsPointer, libs, "gtk_drop_controller_motion_is_pointer", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -25,66 +24,55 @@ func (x *DropDownClass) GoPointer() uintptr {
 
 // Allows the user to choose an item from a list of options.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="drop-down-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkDropDown" src="drop-down.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// The `GtkDropDown` displays the [selected][property@Gtk.DropDown:selected]
+// The `GtkDropDown` displays the [selected][DropDown.GetPropertySelected]
 // choice.
 //
 // The options are given to `GtkDropDown` in the form of `GListModel`
 // and how the individual options are represented is determined by
-// a [class@Gtk.ListItemFactory]. The default factory displays simple strings,
+// a [ListItemFactory]. The default factory displays simple strings,
 // and adds a checkmark to the selected item in the popup.
 //
-// To set your own factory, use [method@Gtk.DropDown.set_factory]. It is
+// To set your own factory, use [DropDown.SetFactory]. It is
 // possible to use a separate factory for the items in the popup, with
-// [method@Gtk.DropDown.set_list_factory].
+// [DropDown.SetListFactory].
 //
 // `GtkDropDown` knows how to obtain strings from the items in a
-// [class@Gtk.StringList]; for other models, you have to provide an expression
-// to find the strings via [method@Gtk.DropDown.set_expression].
+// [StringList]; for other models, you have to provide an expression
+// to find the strings via [DropDown.SetExpression].
 //
 // `GtkDropDown` can optionally allow search in the popup, which is
 // useful if the list of options is long. To enable the search entry,
-// use [method@Gtk.DropDown.set_enable_search].
+// use [DropDown.SetEnableSearch].
 //
 // Here is a UI definition example for `GtkDropDown` with a simple model:
 //
-// ```xml
-// &lt;object class="GtkDropDown"&gt;
-//
-//	&lt;property name="model"&gt;
-//	  &lt;object class="GtkStringList"&gt;
-//	    &lt;items&gt;
-//	      &lt;item translatable="yes"&gt;Factory&lt;/item&gt;
-//	      &lt;item translatable="yes"&gt;Home&lt;/item&gt;
-//	      &lt;item translatable="yes"&gt;Subway&lt;/item&gt;
-//	    &lt;/items&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkDropDown">
+//	  <property name="model">
+//	    <object class="GtkStringList">
+//	      <items>
+//	        <item translatable="yes">Factory</item>
+//	        <item translatable="yes">Home</item>
+//	        <item translatable="yes">Subway</item>
+//	      </items>
+//	    </object>
+//	  </property>
+//	</object>
 //
 // If a `GtkDropDown` is created in this manner, or with
-// [ctor@Gtk.DropDown.new_from_strings], for instance, the object returned from
-// [method@Gtk.DropDown.get_selected_item] will be a [class@Gtk.StringObject].
+// [NewDropDownFromStrings], for instance, the object returned from
+// [DropDown.GetSelectedItem] will be a [StringObject].
 //
 // To learn more about the list widget framework, see the
-// [overview](section-list-widget.html).
+// overview.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkDropDown` has a single CSS node with name dropdown,
 // with the button and popover nodes as children.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkDropDown` uses the [enum@Gtk.AccessibleRole.combo_box] role.
+// `GtkDropDown` uses the [AccessibleRoleComboBoxValue] role.
 type DropDown struct {
 	Widget
 }
@@ -105,7 +93,7 @@ var xNewDropDown func(uintptr, uintptr) uintptr
 
 // Creates a new `GtkDropDown`.
 //
-// You may want to call [method@Gtk.DropDown.set_factory]
+// You may want to call [DropDown.SetFactory]
 // to set up a way to map its items to widgets.
 func NewDropDown(ModelVar gio.ListModel, ExpressionVar *Expression) *DropDown {
 	var cls *DropDown
@@ -152,7 +140,7 @@ var xDropDownGetExpression func(uintptr) uintptr
 
 // Gets the expression set that is used to obtain strings from items.
 //
-// See [method@Gtk.DropDown.set_expression].
+// See [DropDown.SetExpression].
 func (x *DropDown) GetExpression() *Expression {
 	var cls *Expression
 
@@ -173,7 +161,7 @@ var xDropDownGetFactory func(uintptr) uintptr
 //
 // The factory returned by this function is always used for the
 // item in the button. It is also used for items in the popup
-// if [property@Gtk.DropDown:list-factory] is not set.
+// if DropDown:list-factory is not set.
 func (x *DropDown) GetFactory() *ListItemFactory {
 	var cls *ListItemFactory
 
@@ -259,7 +247,7 @@ func (x *DropDown) GetSelected() uint {
 
 var xDropDownGetSelectedItem func(uintptr) uintptr
 
-// Gets the selected item. If no item is selected, %NULL is returned.
+// Gets the selected item. If no item is selected, nil is returned.
 func (x *DropDown) GetSelectedItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -288,7 +276,7 @@ var xDropDownSetEnableSearch func(uintptr, bool)
 // Sets whether a search entry will be shown in the popup that
 // allows to search for items in the list.
 //
-// Note that [property@Gtk.DropDown:expression] must be set for
+// Note that DropDown:expression must be set for
 // search to work.
 func (x *DropDown) SetEnableSearch(EnableSearchVar bool) {
 
@@ -301,7 +289,7 @@ var xDropDownSetExpression func(uintptr, uintptr)
 // Sets the expression that gets evaluated to obtain strings from items.
 //
 // This is used for search in the popup. The expression must have
-// a value type of %G_TYPE_STRING.
+// a value type of G_TYPE_STRING.
 func (x *DropDown) SetExpression(ExpressionVar *Expression) {
 
 	xDropDownSetExpression(x.GoPointer(), ExpressionVar.GoPointer())
@@ -385,7 +373,7 @@ func (c *DropDown) SetGoPointer(ptr uintptr) {
 // SetPropertyEnableSearch sets the "enable-search" property.
 // Whether to show a search entry in the popup.
 //
-// Note that search requires [property@Gtk.DropDown:expression]
+// Note that search requires DropDown:expression
 // to be set.
 func (x *DropDown) SetPropertyEnableSearch(value bool) {
 	var v gobject.Value
@@ -397,7 +385,7 @@ func (x *DropDown) SetPropertyEnableSearch(value bool) {
 // GetPropertyEnableSearch gets the "enable-search" property.
 // Whether to show a search entry in the popup.
 //
-// Note that search requires [property@Gtk.DropDown:expression]
+// Note that search requires DropDown:expression
 // to be set.
 func (x *DropDown) GetPropertyEnableSearch() bool {
 	var v gobject.Value
@@ -409,7 +397,7 @@ func (x *DropDown) GetPropertyEnableSearch() bool {
 // The position of the selected item.
 //
 // If no item is selected, the property has the value
-// %GTK_INVALID_LIST_POSITION.
+// [INVALID_LIST_POSITION].
 func (x *DropDown) SetPropertySelected(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -421,7 +409,7 @@ func (x *DropDown) SetPropertySelected(value uint) {
 // The position of the selected item.
 //
 // If no item is selected, the property has the value
-// %GTK_INVALID_LIST_POSITION.
+// [INVALID_LIST_POSITION].
 func (x *DropDown) GetPropertySelected() uint {
 	var v gobject.Value
 	x.GetProperty("selected", &v)
@@ -449,27 +437,49 @@ func (x *DropDown) GetPropertyShowArrow() bool {
 //
 // The `::activate` signal on `GtkDropDown` is an action signal and
 // emitting it causes the drop down to pop up its dropdown.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *DropDown) ConnectActivate(cb *func(DropDown)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := DropDown{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xDropDownActivateTrampoline uintptr
+
+func xDropDownActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropDown))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropDown{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropDown) ConnectActivateFunc(cb func(DropDown)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xDropDownActivateTrampoline, xDropDownActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -633,7 +643,7 @@ func (x *DropDown) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -641,14 +651,13 @@ func (x *DropDown) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *DropDown) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -672,19 +681,17 @@ func (x *DropDown) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *DropDown) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -705,7 +712,7 @@ func (x *DropDown) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -714,14 +721,12 @@ func (x *DropDown) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *DropDown) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -740,10 +745,10 @@ func (x *DropDown) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *DropDown) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -755,7 +760,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -764,27 +769,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xDropDownGLibType, libs, "gtk_drop_down_get_type")
 
-	core.PuregoSafeRegister(&xNewDropDown, libs, "gtk_drop_down_new")
-	core.PuregoSafeRegister(&xNewDropDownFromStrings, libs, "gtk_drop_down_new_from_strings")
-
-	core.PuregoSafeRegister(&xDropDownGetEnableSearch, libs, "gtk_drop_down_get_enable_search")
-	core.PuregoSafeRegister(&xDropDownGetExpression, libs, "gtk_drop_down_get_expression")
-	core.PuregoSafeRegister(&xDropDownGetFactory, libs, "gtk_drop_down_get_factory")
-	core.PuregoSafeRegister(&xDropDownGetHeaderFactory, libs, "gtk_drop_down_get_header_factory")
-	core.PuregoSafeRegister(&xDropDownGetListFactory, libs, "gtk_drop_down_get_list_factory")
-	core.PuregoSafeRegister(&xDropDownGetModel, libs, "gtk_drop_down_get_model")
-	core.PuregoSafeRegister(&xDropDownGetSearchMatchMode, libs, "gtk_drop_down_get_search_match_mode")
-	core.PuregoSafeRegister(&xDropDownGetSelected, libs, "gtk_drop_down_get_selected")
-	core.PuregoSafeRegister(&xDropDownGetSelectedItem, libs, "gtk_drop_down_get_selected_item")
-	core.PuregoSafeRegister(&xDropDownGetShowArrow, libs, "gtk_drop_down_get_show_arrow")
-	core.PuregoSafeRegister(&xDropDownSetEnableSearch, libs, "gtk_drop_down_set_enable_search")
-	core.PuregoSafeRegister(&xDropDownSetExpression, libs, "gtk_drop_down_set_expression")
-	core.PuregoSafeRegister(&xDropDownSetFactory, libs, "gtk_drop_down_set_factory")
-	core.PuregoSafeRegister(&xDropDownSetHeaderFactory, libs, "gtk_drop_down_set_header_factory")
-	core.PuregoSafeRegister(&xDropDownSetListFactory, libs, "gtk_drop_down_set_list_factory")
-	core.PuregoSafeRegister(&xDropDownSetModel, libs, "gtk_drop_down_set_model")
-	core.PuregoSafeRegister(&xDropDownSetSearchMatchMode, libs, "gtk_drop_down_set_search_match_mode")
-	core.PuregoSafeRegister(&xDropDownSetSelected, libs, "gtk_drop_down_set_selected")
-	core.PuregoSafeRegister(&xDropDownSetShowArrow, libs, "gtk_drop_down_set_show_arrow")
+	gobject.CheckClassSize("GtkDropDownClass", xDropDownGLibType, unsafe.Sizeof(DropDownClass{}))
+
+	core.PuregoRegisterSince(&xNewDropDown, libs, "gtk_drop_down_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewDropDownFromStrings, libs, "gtk_drop_down_new_from_strings", "GTK", "")
+
+	core.PuregoRegisterSince(&xDropDownGetEnableSearch, libs, "gtk_drop_down_get_enable_search", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetExpression, libs, "gtk_drop_down_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetFactory, libs, "gtk_drop_down_get_factory", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetHeaderFactory, libs, "gtk_drop_down_get_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xDropDownGetListFactory, libs, "gtk_drop_down_get_list_factory", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetModel, libs, "gtk_drop_down_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetSearchMatchMode, libs, "gtk_drop_down_get_search_match_mode", "GTK", "4.12")
+	core.PuregoRegisterSince(&xDropDownGetSelected, libs, "gtk_drop_down_get_selected", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetSelectedItem, libs, "gtk_drop_down_get_selected_item", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownGetShowArrow, libs, "gtk_drop_down_get_show_arrow", "GTK", "4.6")
+	core.PuregoRegisterSince(&xDropDownSetEnableSearch, libs, "gtk_drop_down_set_enable_search", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetExpression, libs, "gtk_drop_down_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetFactory, libs, "gtk_drop_down_set_factory", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetHeaderFactory, libs, "gtk_drop_down_set_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xDropDownSetListFactory, libs, "gtk_drop_down_set_list_factory", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetModel, libs, "gtk_drop_down_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetSearchMatchMode, libs, "gtk_drop_down_set_search_match_mode", "GTK", "4.12")
+	core.PuregoRegisterSince(&xDropDownSetSelected, libs, "gtk_drop_down_set_selected", "GTK", "")
+	core.PuregoRegisterSince(&xDropDownSetShowArrow, libs, "gtk_drop_down_set_show_arrow", "GTK", "4.6")
 
 }
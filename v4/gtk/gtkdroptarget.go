@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,19 +23,16 @@ func (x *DropTargetClass) GoPointer() uintptr {
 // An event controller to receive Drag-and-Drop operations.
 //
 // The most basic way to use a `GtkDropTarget` to receive drops on a
-// widget is to create it via [ctor@Gtk.DropTarget.new], passing in the
+// widget is to create it via [NewDropTarget], passing in the
 // `GType` of the data you want to receive and connect to the
-// [signal@Gtk.DropTarget::drop] signal to receive the data:
-//
-// ```c
-// static gboolean
-// on_drop (GtkDropTarget *target,
-//
-//	const GValue  *value,
-//	double         x,
-//	double         y,
-//	gpointer       data)
-//
+// [DropTarget.ConnectDropFunc] signal to receive the data:
+//
+//	static gboolean
+//	on_drop (GtkDropTarget *target,
+//	         const GValue  *value,
+//	         double         x,
+//	         double         y,
+//	         gpointer       data)
 //	{
 //	  MyWidget *self = data;
 //
@@ -52,9 +48,8 @@ func (x *DropTargetClass) GoPointer() uintptr {
 //	  return TRUE;
 //	}
 //
-// static void
-// my_widget_init (MyWidget *self)
-//
+//	static void
+//	my_widget_init (MyWidget *self)
 //	{
 //	  GtkDropTarget *target =
 //	    gtk_drop_target_new (G_TYPE_INVALID, GDK_ACTION_COPY);
@@ -70,32 +65,30 @@ func (x *DropTargetClass) GoPointer() uintptr {
 //	  gtk_widget_add_controller (GTK_WIDGET (self), GTK_EVENT_CONTROLLER (target));
 //	}
 //
-// ```
-//
 // `GtkDropTarget` supports more options, such as:
 //
-//   - rejecting potential drops via the [signal@Gtk.DropTarget::accept] signal
-//     and the [method@Gtk.DropTarget.reject] function to let other drop
+//   - rejecting potential drops via the [DropTarget.ConnectAcceptFunc] signal
+//     and the [DropTarget.Reject] function to let other drop
 //     targets handle the drop
 //   - tracking an ongoing drag operation before the drop via the
-//     [signal@Gtk.DropTarget::enter], [signal@Gtk.DropTarget::motion] and
-//     [signal@Gtk.DropTarget::leave] signals
+//     [DropTarget.ConnectEnterFunc], [DropTarget.ConnectMotionFunc] and
+//     [DropTarget.ConnectLeaveFunc] signals
 //   - configuring how to receive data by setting the
-//     [property@Gtk.DropTarget:preload] property and listening for its
-//     availability via the [property@Gtk.DropTarget:value] property
+//     [DropTarget.GetPropertyPreload] property and listening for its
+//     availability via the [DropTarget.GetPropertyValue] property
 //
 // However, `GtkDropTarget` is ultimately modeled in a synchronous way
 // and only supports data transferred via `GType`. If you want full control
-// over an ongoing drop, the [class@Gtk.DropTargetAsync] object gives you
+// over an ongoing drop, the [DropTargetAsync] object gives you
 // this ability.
 //
 // While a pointer is dragged over the drop target's widget and the drop
 // has not been rejected, that widget will receive the
-// %GTK_STATE_FLAG_DROP_ACTIVE state, which can be used to style the widget.
+// [StateFlagDropActiveValue] state, which can be used to style the widget.
 //
 // If you are not interested in receiving the drop, but just want to update
 // UI state during a Drag-and-Drop operation (e.g. switching tabs), you can
-// use [class@Gtk.DropControllerMotion].
+// use [DropControllerMotion].
 type DropTarget struct {
 	EventController
 }
@@ -117,8 +110,8 @@ var xNewDropTarget func(types.GType, gdk.DragAction) uintptr
 // Creates a new `GtkDropTarget` object.
 //
 // If the drop target should support more than 1 type, pass
-// %G_TYPE_INVALID for @type and then call
-// [method@Gtk.DropTarget.set_gtypes].
+// G_TYPE_INVALID for type and then call
+// [DropTarget.SetGtypes].
 func NewDropTarget(TypeVar types.GType, ActionsVar gdk.DragAction) *DropTarget {
 	var cls *DropTarget
 
@@ -145,7 +138,7 @@ var xDropTargetGetCurrentDrop func(uintptr) uintptr
 
 // Gets the currently handled drop operation.
 //
-// If no drop operation is going on, %NULL is returned.
+// If no drop operation is going on, nil is returned.
 func (x *DropTarget) GetCurrentDrop() *gdk.Drop {
 	var cls *gdk.Drop
 
@@ -160,30 +153,11 @@ func (x *DropTarget) GetCurrentDrop() *gdk.Drop {
 	return cls
 }
 
-var xDropTargetGetDrop func(uintptr) uintptr
-
-// Gets the currently handled drop operation.
-//
-// If no drop operation is going on, %NULL is returned.
-func (x *DropTarget) GetDrop() *gdk.Drop {
-	var cls *gdk.Drop
-
-	cret := xDropTargetGetDrop(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gdk.Drop{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xDropTargetGetFormats func(uintptr) *gdk.ContentFormats
 
 // Gets the data formats that this drop target accepts.
 //
-// If the result is %NULL, all formats are expected to be supported.
+// If the result is nil, all formats are expected to be supported.
 func (x *DropTarget) GetFormats() *gdk.ContentFormats {
 
 	cret := xDropTargetGetFormats(x.GoPointer())
@@ -223,8 +197,8 @@ var xDropTargetReject func(uintptr)
 
 // Rejects the ongoing drop operation.
 //
-// If no drop operation is ongoing, i.e when [property@Gtk.DropTarget:current-drop]
-// is %NULL, this function does nothing.
+// If no drop operation is ongoing, i.e when DropTarget:current-drop
+// is nil, this function does nothing.
 //
 // This function should be used when delaying the decision
 // on whether to accept a drag or not until after reading
@@ -297,7 +271,7 @@ func (x *DropTarget) GetPropertyFormats() uintptr {
 // Setting this property allows finer grained reaction to an ongoing
 // drop at the cost of loading more data.
 //
-// The default value for this property is %FALSE to avoid downloading
+// The default value for this property is false to avoid downloading
 // huge amounts of data by accident.
 //
 // For example, if somebody drags a full document of gigabytes of text
@@ -306,7 +280,7 @@ func (x *DropTarget) GetPropertyFormats() uintptr {
 // elsewhere.
 //
 // For a lot of data formats, the amount of data is very small (like
-// %GDK_TYPE_RGBA), so enabling this property does not hurt at all.
+// GDK_TYPE_RGBA), so enabling this property does not hurt at all.
 // And for local-only Drag-and-Drop operations, no data transfer is done,
 // so enabling it there is free.
 func (x *DropTarget) SetPropertyPreload(value bool) {
@@ -323,7 +297,7 @@ func (x *DropTarget) SetPropertyPreload(value bool) {
 // Setting this property allows finer grained reaction to an ongoing
 // drop at the cost of loading more data.
 //
-// The default value for this property is %FALSE to avoid downloading
+// The default value for this property is false to avoid downloading
 // huge amounts of data by accident.
 //
 // For example, if somebody drags a full document of gigabytes of text
@@ -332,7 +306,7 @@ func (x *DropTarget) SetPropertyPreload(value bool) {
 // elsewhere.
 //
 // For a lot of data formats, the amount of data is very small (like
-// %GDK_TYPE_RGBA), so enabling this property does not hurt at all.
+// GDK_TYPE_RGBA), so enabling this property does not hurt at all.
 // And for local-only Drag-and-Drop operations, no data transfer is done,
 // so enabling it there is free.
 func (x *DropTarget) GetPropertyPreload() bool {
@@ -344,11 +318,11 @@ func (x *DropTarget) GetPropertyPreload() bool {
 // GetPropertyValue gets the "value" property.
 // The value for this drop operation.
 //
-// This is %NULL if the data has not been loaded yet or no drop
+// This is nil if the data has not been loaded yet or no drop
 // operation is going on.
 //
-// Data may be available before the [signal@Gtk.DropTarget::drop]
-// signal gets emitted - for example when the [property@Gtk.DropTarget:preload]
+// Data may be available before the [DropTarget.ConnectDropFunc]
+// signal gets emitted - for example when the [DropTarget.GetPropertyPreload]
 // property is set. You can use the ::notify signal to be notified
 // of available data.
 func (x *DropTarget) GetPropertyValue() uintptr {
@@ -359,150 +333,260 @@ func (x *DropTarget) GetPropertyValue() uintptr {
 
 // Emitted on the drop site when a drop operation is about to begin.
 //
-// If the drop is not accepted, %FALSE will be returned and the drop target
-// will ignore the drop. If %TRUE is returned, the drop is accepted for now
-// but may be rejected later via a call to [method@Gtk.DropTarget.reject]
-// or ultimately by returning %FALSE from a [signal@Gtk.DropTarget::drop]
+// If the drop is not accepted, false will be returned and the drop target
+// will ignore the drop. If true is returned, the drop is accepted for now
+// but may be rejected later via a call to [DropTarget.Reject]
+// or ultimately by returning false from a [DropTarget.ConnectDropFunc]
 // handler.
 //
 // The default handler for this signal decides whether to accept the drop
-// based on the formats provided by the @drop.
+// based on the formats provided by the drop.
 //
 // If the decision whether the drop will be accepted or rejected depends
-// on the data, this function should return %TRUE, the
-// [property@Gtk.DropTarget:preload] property should be set and the value
+// on the data, this function should return true, the
+// [DropTarget.GetPropertyPreload] property should be set and the value
 // should be inspected via the ::notify:value signal, calling
-// [method@Gtk.DropTarget.reject] if required.
+// [DropTarget.Reject] if required.
+//
+// Deprecated: use ConnectAcceptFunc, which also accepts method values and closures.
 func (x *DropTarget) ConnectAccept(cb *func(DropTarget, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accept", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DropTarget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DropVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accept", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr) bool {
+var xDropTargetAcceptTrampoline uintptr
+
+func xDropTargetAcceptNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTarget, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTarget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DropVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accept", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAcceptFunc connects cb to the "accept" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTarget) ConnectAcceptFunc(cb func(DropTarget, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accept", &xDropTargetAcceptTrampoline, xDropTargetAcceptNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the user drops the data onto the widget.
 //
 // The signal handler must determine whether the pointer position is in
-// a drop zone or not. If it is not in a drop zone, it returns %FALSE
+// a drop zone or not. If it is not in a drop zone, it returns false
 // and no further processing is necessary.
 //
-// Otherwise, the handler returns %TRUE. In this case, this handler will
-// accept the drop. The handler is responsible for using the given @value
+// Otherwise, the handler returns true. In this case, this handler will
+// accept the drop. The handler is responsible for using the given value
 // and performing the drop operation.
+//
+// Deprecated: use ConnectDropFunc, which also accepts method values and closures.
 func (x *DropTarget) ConnectDrop(cb *func(DropTarget, uintptr, float64, float64) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drop", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp uintptr, XVarp float64, YVarp float64) bool {
+			defer core.RecoverPanic()
+			fa := DropTarget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ValueVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drop", cbRefPtr, cbPtr)
+}
+
+var xDropTargetDropTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ValueVarp uintptr, XVarp float64, YVarp float64) bool {
+func xDropTargetDropNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ValueVarp uintptr, XVarp float64, YVarp float64, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTarget, uintptr, float64, float64) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTarget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ValueVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drop", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDropFunc connects cb to the "drop" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTarget) ConnectDropFunc(cb func(DropTarget, uintptr, float64, float64) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drop", &xDropTargetDropTrampoline, xDropTargetDropNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the pointer enters the widget.
 //
 // It can be used to set up custom highlighting.
+//
+// Deprecated: use ConnectEnterFunc, which also accepts method values and closures.
 func (x *DropTarget) ConnectEnter(cb *func(DropTarget, float64, float64) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := DropTarget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enter", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+var xDropTargetEnterTrampoline uintptr
+
+func xDropTargetEnterNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTarget, float64, float64) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTarget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEnterFunc connects cb to the "enter" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTarget) ConnectEnterFunc(cb func(DropTarget, float64, float64) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enter", &xDropTargetEnterTrampoline, xDropTargetEnterNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the pointer leaves the widget.
 //
 // Its main purpose it to undo things done in
-// [signal@Gtk.DropTarget::enter].
+// [DropTarget.ConnectEnterFunc].
+//
+// Deprecated: use ConnectLeaveFunc, which also accepts method values and closures.
 func (x *DropTarget) ConnectLeave(cb *func(DropTarget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := DropTarget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "leave", cbRefPtr, cbPtr)
+}
+
+var xDropTargetLeaveTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xDropTargetLeaveNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTarget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTarget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLeaveFunc connects cb to the "leave" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTarget) ConnectLeaveFunc(cb func(DropTarget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "leave", &xDropTargetLeaveTrampoline, xDropTargetLeaveNewTrampoline, cb)
 }
 
 // Emitted while the pointer is moving over the drop target.
+//
+// Deprecated: use ConnectMotionFunc, which also accepts method values and closures.
 func (x *DropTarget) ConnectMotion(cb *func(DropTarget, float64, float64) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := DropTarget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "motion", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+var xDropTargetMotionTrampoline uintptr
+
+func xDropTargetMotionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTarget, float64, float64) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTarget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMotionFunc connects cb to the "motion" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTarget) ConnectMotionFunc(cb func(DropTarget, float64, float64) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "motion", &xDropTargetMotionTrampoline, xDropTargetMotionNewTrampoline, cb)
 }
 
 func init() {
@@ -510,7 +594,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -519,18 +603,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xDropTargetGLibType, libs, "gtk_drop_target_get_type")
 
-	core.PuregoSafeRegister(&xNewDropTarget, libs, "gtk_drop_target_new")
-
-	core.PuregoSafeRegister(&xDropTargetGetActions, libs, "gtk_drop_target_get_actions")
-	core.PuregoSafeRegister(&xDropTargetGetCurrentDrop, libs, "gtk_drop_target_get_current_drop")
-	core.PuregoSafeRegister(&xDropTargetGetDrop, libs, "gtk_drop_target_get_drop")
-	core.PuregoSafeRegister(&xDropTargetGetFormats, libs, "gtk_drop_target_get_formats")
-	core.PuregoSafeRegister(&xDropTargetGetGtypes, libs, "gtk_drop_target_get_gtypes")
-	core.PuregoSafeRegister(&xDropTargetGetPreload, libs, "gtk_drop_target_get_preload")
-	core.PuregoSafeRegister(&xDropTargetGetValue, libs, "gtk_drop_target_get_value")
-	core.PuregoSafeRegister(&xDropTargetReject, libs, "gtk_drop_target_reject")
-	core.PuregoSafeRegister(&xDropTargetSetActions, libs, "gtk_drop_target_set_actions")
-	core.PuregoSafeRegister(&xDropTargetSetGtypes, libs, "gtk_drop_target_set_gtypes")
-	core.PuregoSafeRegister(&xDropTargetSetPreload, libs, "gtk_drop_target_set_preload")
+	core.PuregoRegisterSince(&xNewDropTarget, libs, "gtk_drop_target_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xDropTargetGetActions, libs, "gtk_drop_target_get_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetGetCurrentDrop, libs, "gtk_drop_target_get_current_drop", "GTK", "4.4")
+	core.PuregoRegisterSince(&xDropTargetGetFormats, libs, "gtk_drop_target_get_formats", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetGetGtypes, libs, "gtk_drop_target_get_gtypes", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetGetPreload, libs, "gtk_drop_target_get_preload", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetGetValue, libs, "gtk_drop_target_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetReject, libs, "gtk_drop_target_reject", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetSetActions, libs, "gtk_drop_target_set_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetSetGtypes, libs, "gtk_drop_target_set_gtypes", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetSetPreload, libs, "gtk_drop_target_set_preload", "GTK", "")
 
 }
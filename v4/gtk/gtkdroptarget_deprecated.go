@@ -0,0 +1,47 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xDropTargetGetDrop func(uintptr) uintptr
+
+// Gets the currently handled drop operation.
+//
+// If no drop operation is going on, nil is returned.
+//
+// Deprecated: since 4.4. Use [DropTarget.GetCurrentDrop] instead
+func (x *DropTarget) GetDrop() *gdk.Drop {
+	var cls *gdk.Drop
+
+	cret := xDropTargetGetDrop(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gdk.Drop{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xDropTargetGetDrop, libs, "gtk_drop_target_get_drop", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,35 +23,35 @@ func (x *DropTargetAsyncClass) GoPointer() uintptr {
 // An event controller to receive Drag-and-Drop operations, asynchronously.
 //
 // It is the more complete but also more complex method of handling drop
-// operations compared to [class@Gtk.DropTarget], and you should only use
+// operations compared to [DropTarget], and you should only use
 // it if `GtkDropTarget` doesn't provide all the features you need.
 //
 // To use a `GtkDropTargetAsync` to receive drops on a widget, you create
 // a `GtkDropTargetAsync` object, configure which data formats and actions
 // you support, connect to its signals, and then attach it to the widget
-// with [method@Gtk.Widget.add_controller].
+// with [Widget.AddController].
 //
 // During a drag operation, the first signal that a `GtkDropTargetAsync`
-// emits is [signal@Gtk.DropTargetAsync::accept], which is meant to determine
+// emits is [DropTargetAsync.ConnectAcceptFunc], which is meant to determine
 // whether the target is a possible drop site for the ongoing drop. The
 // default handler for the ::accept signal accepts the drop if it finds
 // a compatible data format and an action that is supported on both sides.
 //
 // If it is, and the widget becomes a target, you will receive a
-// [signal@Gtk.DropTargetAsync::drag-enter] signal, followed by
-// [signal@Gtk.DropTargetAsync::drag-motion] signals as the pointer moves,
-// optionally a [signal@Gtk.DropTargetAsync::drop] signal when a drop happens,
-// and finally a [signal@Gtk.DropTargetAsync::drag-leave] signal when the
+// [DropTargetAsync.ConnectDragEnterFunc] signal, followed by
+// [DropTargetAsync.ConnectDragMotionFunc] signals as the pointer moves,
+// optionally a [DropTargetAsync.ConnectDropFunc] signal when a drop happens,
+// and finally a [DropTargetAsync.ConnectDragLeaveFunc] signal when the
 // pointer moves off the widget.
 //
 // The ::drag-enter and ::drag-motion handler return a `GdkDragAction`
 // to update the status of the ongoing operation. The ::drop handler
 // should decide if it ultimately accepts the drop and if it does, it
 // should initiate the data transfer and finish the operation by calling
-// [method@Gdk.Drop.finish].
+// [gdk.Drop.Finish].
 //
 // Between the ::drag-enter and ::drag-leave signals the widget is a
-// current drop target, and will receive the %GTK_STATE_FLAG_DROP_ACTIVE
+// current drop target, and will receive the [StateFlagDropActiveValue]
 // state, which can be used by themes to style the widget as a drop target.
 type DropTargetAsync struct {
 	EventController
@@ -70,13 +69,13 @@ func DropTargetAsyncNewFromInternalPtr(ptr uintptr) *DropTargetAsync {
 	return cls
 }
 
-var xNewDropTargetAsync func(*gdk.ContentFormats, gdk.DragAction) uintptr
+var xNewDropTargetAsync func(uintptr, gdk.DragAction) uintptr
 
 // Creates a new `GtkDropTargetAsync` object.
 func NewDropTargetAsync(FormatsVar *gdk.ContentFormats, ActionsVar gdk.DragAction) *DropTargetAsync {
 	var cls *DropTargetAsync
 
-	cret := xNewDropTargetAsync(FormatsVar, ActionsVar)
+	cret := xNewDropTargetAsync(gobject.BoxedCopyNullable(gdk.ContentFormatsGLibType(), FormatsVar.GoPointer()), ActionsVar)
 
 	if cret == 0 {
 		return nil
@@ -99,7 +98,7 @@ var xDropTargetAsyncGetFormats func(uintptr) *gdk.ContentFormats
 
 // Gets the data formats that this drop target accepts.
 //
-// If the result is %NULL, all formats are expected to be supported.
+// If the result is nil, all formats are expected to be supported.
 func (x *DropTargetAsync) GetFormats() *gdk.ContentFormats {
 
 	cret := xDropTargetAsyncGetFormats(x.GoPointer())
@@ -108,7 +107,7 @@ func (x *DropTargetAsync) GetFormats() *gdk.ContentFormats {
 
 var xDropTargetAsyncRejectDrop func(uintptr, uintptr)
 
-// Sets the @drop as not accepted on this drag site.
+// Sets the drop as not accepted on this drag site.
 //
 // This function should be used when delaying the decision
 // on whether to accept a drag or not until after reading
@@ -167,154 +166,264 @@ func (x *DropTargetAsync) GetPropertyFormats() uintptr {
 
 // Emitted on the drop site when a drop operation is about to begin.
 //
-// If the drop is not accepted, %FALSE will be returned and the drop target
-// will ignore the drop. If %TRUE is returned, the drop is accepted for now
-// but may be rejected later via a call to [method@Gtk.DropTargetAsync.reject_drop]
-// or ultimately by returning %FALSE from a [signal@Gtk.DropTargetAsync::drop]
+// If the drop is not accepted, false will be returned and the drop target
+// will ignore the drop. If true is returned, the drop is accepted for now
+// but may be rejected later via a call to [DropTargetAsync.RejectDrop]
+// or ultimately by returning false from a [DropTargetAsync.ConnectDropFunc]
 // handler.
 //
 // The default handler for this signal decides whether to accept the drop
-// based on the formats provided by the @drop.
+// based on the formats provided by the drop.
 //
 // If the decision whether the drop will be accepted or rejected needs
 // further processing, such as inspecting the data, this function should
-// return %TRUE and proceed as is @drop was accepted and if it decides to
-// reject the drop later, it should call [method@Gtk.DropTargetAsync.reject_drop].
+// return true and proceed as is drop was accepted and if it decides to
+// reject the drop later, it should call [DropTargetAsync.RejectDrop].
+//
+// Deprecated: use ConnectAcceptFunc, which also accepts method values and closures.
 func (x *DropTargetAsync) ConnectAccept(cb *func(DropTargetAsync, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accept", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := DropTargetAsync{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DropVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accept", cbRefPtr, cbPtr)
+}
+
+var xDropTargetAsyncAcceptTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr) bool {
+func xDropTargetAsyncAcceptNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTargetAsync, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTargetAsync{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DropVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accept", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAcceptFunc connects cb to the "accept" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTargetAsync) ConnectAcceptFunc(cb func(DropTargetAsync, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accept", &xDropTargetAsyncAcceptTrampoline, xDropTargetAsyncAcceptNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the pointer enters the widget.
 //
 // It can be used to set up custom highlighting.
+//
+// Deprecated: use ConnectDragEnterFunc, which also accepts method values and closures.
 func (x *DropTargetAsync) ConnectDragEnter(cb *func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-enter", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := DropTargetAsync{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DropVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-enter", cbRefPtr, cbPtr)
+}
+
+var xDropTargetAsyncDragEnterTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+func xDropTargetAsyncDragEnterNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTargetAsync{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DropVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-enter", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragEnterFunc connects cb to the "drag-enter" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTargetAsync) ConnectDragEnterFunc(cb func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-enter", &xDropTargetAsyncDragEnterTrampoline, xDropTargetAsyncDragEnterNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the pointer leaves the widget.
 //
 // Its main purpose it to undo things done in
 // `GtkDropTargetAsync`::drag-enter.
+//
+// Deprecated: use ConnectDragLeaveFunc, which also accepts method values and closures.
 func (x *DropTargetAsync) ConnectDragLeave(cb *func(DropTargetAsync, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-leave", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := DropTargetAsync{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DropVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-leave", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr) {
+var xDropTargetAsyncDragLeaveTrampoline uintptr
+
+func xDropTargetAsyncDragLeaveNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTargetAsync, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTargetAsync{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DropVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-leave", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragLeaveFunc connects cb to the "drag-leave" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTargetAsync) ConnectDragLeaveFunc(cb func(DropTargetAsync, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-leave", &xDropTargetAsyncDragLeaveTrampoline, xDropTargetAsyncDragLeaveNewTrampoline, cb)
 }
 
 // Emitted while the pointer is moving over the drop target.
+//
+// Deprecated: use ConnectDragMotionFunc, which also accepts method values and closures.
 func (x *DropTargetAsync) ConnectDragMotion(cb *func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-motion", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+			defer core.RecoverPanic()
+			fa := DropTargetAsync{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DropVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-motion", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) gdk.DragAction {
+var xDropTargetAsyncDragMotionTrampoline uintptr
+
+func xDropTargetAsyncDragMotionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64, data uintptr) (ret gdk.DragAction) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTargetAsync{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DropVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-motion", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragMotionFunc connects cb to the "drag-motion" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTargetAsync) ConnectDragMotionFunc(cb func(DropTargetAsync, uintptr, float64, float64) gdk.DragAction) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-motion", &xDropTargetAsyncDragMotionTrampoline, xDropTargetAsyncDragMotionNewTrampoline, cb)
 }
 
 // Emitted on the drop site when the user drops the data onto the widget.
 //
 // The signal handler must determine whether the pointer position is in a
-// drop zone or not. If it is not in a drop zone, it returns %FALSE and no
+// drop zone or not. If it is not in a drop zone, it returns false and no
 // further processing is necessary.
 //
-// Otherwise, the handler returns %TRUE. In this case, this handler will
-// accept the drop. The handler must ensure that [method@Gdk.Drop.finish]
+// Otherwise, the handler returns true. In this case, this handler will
+// accept the drop. The handler must ensure that [gdk.Drop.Finish]
 // is called to let the source know that the drop is done. The call to
-// [method@Gdk.Drop.finish] must only be done when all data has been received.
+// [gdk.Drop.Finish] must only be done when all data has been received.
 //
 // To receive the data, use one of the read functions provided by
-// [class@Gdk.Drop] such as [method@Gdk.Drop.read_async] or
-// [method@Gdk.Drop.read_value_async].
+// [gdk.Drop] such as [gdk.Drop.ReadAsync] or
+// [gdk.Drop.ReadValueAsync].
+//
+// Deprecated: use ConnectDropFunc, which also accepts method values and closures.
 func (x *DropTargetAsync) ConnectDrop(cb *func(DropTargetAsync, uintptr, float64, float64) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drop", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) bool {
+			defer core.RecoverPanic()
+			fa := DropTargetAsync{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DropVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drop", cbRefPtr, cbPtr)
+}
+
+var xDropTargetAsyncDropTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64) bool {
+func xDropTargetAsyncDropNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DropVarp uintptr, XVarp float64, YVarp float64, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(DropTargetAsync, uintptr, float64, float64) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := DropTargetAsync{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DropVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drop", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDropFunc connects cb to the "drop" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *DropTargetAsync) ConnectDropFunc(cb func(DropTargetAsync, uintptr, float64, float64) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drop", &xDropTargetAsyncDropTrampoline, xDropTargetAsyncDropNewTrampoline, cb)
 }
 
 func init() {
@@ -322,7 +431,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -331,12 +440,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xDropTargetAsyncGLibType, libs, "gtk_drop_target_async_get_type")
 
-	core.PuregoSafeRegister(&xNewDropTargetAsync, libs, "gtk_drop_target_async_new")
+	core.PuregoRegisterSince(&xNewDropTargetAsync, libs, "gtk_drop_target_async_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xDropTargetAsyncGetActions, libs, "gtk_drop_target_async_get_actions")
-	core.PuregoSafeRegister(&xDropTargetAsyncGetFormats, libs, "gtk_drop_target_async_get_formats")
-	core.PuregoSafeRegister(&xDropTargetAsyncRejectDrop, libs, "gtk_drop_target_async_reject_drop")
-	core.PuregoSafeRegister(&xDropTargetAsyncSetActions, libs, "gtk_drop_target_async_set_actions")
-	core.PuregoSafeRegister(&xDropTargetAsyncSetFormats, libs, "gtk_drop_target_async_set_formats")
+	core.PuregoRegisterSince(&xDropTargetAsyncGetActions, libs, "gtk_drop_target_async_get_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetAsyncGetFormats, libs, "gtk_drop_target_async_get_formats", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetAsyncRejectDrop, libs, "gtk_drop_target_async_reject_drop", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetAsyncSetActions, libs, "gtk_drop_target_async_set_actions", "GTK", "")
+	core.PuregoRegisterSince(&xDropTargetAsyncSetFormats, libs, "gtk_drop_target_async_set_formats", "GTK", "")
 
 }
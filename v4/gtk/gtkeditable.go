@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -14,7 +14,7 @@ import (
 type EditableInterface struct {
 	_ structs.HostLayout
 
-	BaseIface uintptr
+	BaseIface gobject.TypeInterface
 
 	xInsertText uintptr
 
@@ -44,7 +44,8 @@ func (x *EditableInterface) OverrideInsertText(cb func(Editable, string, int, in
 	if cb == nil {
 		x.xInsertText = 0
 	} else {
-		x.xInsertText = purego.NewCallback(func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int) {
+		x.xInsertText = core.NewCallback(func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp}, TextVarp, LengthVarp, PositionVarp)
 		})
 	}
@@ -56,7 +57,7 @@ func (x *EditableInterface) GetInsertText() func(Editable, string, int, int) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int)
-	purego.RegisterFunc(&rawCallback, x.xInsertText)
+	core.RegisterFunc(&rawCallback, x.xInsertText)
 	return func(EditableVar Editable, TextVar string, LengthVar int, PositionVar int) {
 		rawCallback(EditableVar.GoPointer(), TextVar, LengthVar, PositionVar)
 	}
@@ -67,7 +68,8 @@ func (x *EditableInterface) OverrideDeleteText(cb func(Editable, int, int)) {
 	if cb == nil {
 		x.xDeleteText = 0
 	} else {
-		x.xDeleteText = purego.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+		x.xDeleteText = core.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp}, StartPosVarp, EndPosVarp)
 		})
 	}
@@ -79,7 +81,7 @@ func (x *EditableInterface) GetDeleteText() func(Editable, int, int) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDeleteText)
+	core.RegisterFunc(&rawCallback, x.xDeleteText)
 	return func(EditableVar Editable, StartPosVar int, EndPosVar int) {
 		rawCallback(EditableVar.GoPointer(), StartPosVar, EndPosVar)
 	}
@@ -90,7 +92,8 @@ func (x *EditableInterface) OverrideChanged(cb func(Editable)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(EditableVarp uintptr) {
+		x.xChanged = core.NewCallback(func(EditableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp})
 		})
 	}
@@ -102,7 +105,7 @@ func (x *EditableInterface) GetChanged() func(Editable) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(EditableVar Editable) {
 		rawCallback(EditableVar.GoPointer())
 	}
@@ -113,7 +116,8 @@ func (x *EditableInterface) OverrideGetText(cb func(Editable) string) {
 	if cb == nil {
 		x.xGetText = 0
 	} else {
-		x.xGetText = purego.NewCallback(func(EditableVarp uintptr) string {
+		x.xGetText = core.NewCallback(func(EditableVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(&EditableBase{Ptr: EditableVarp})
 		})
 	}
@@ -125,7 +129,7 @@ func (x *EditableInterface) GetGetText() func(Editable) string {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetText)
+	core.RegisterFunc(&rawCallback, x.xGetText)
 	return func(EditableVar Editable) string {
 		return rawCallback(EditableVar.GoPointer())
 	}
@@ -136,7 +140,8 @@ func (x *EditableInterface) OverrideDoInsertText(cb func(Editable, string, int,
 	if cb == nil {
 		x.xDoInsertText = 0
 	} else {
-		x.xDoInsertText = purego.NewCallback(func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int) {
+		x.xDoInsertText = core.NewCallback(func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp}, TextVarp, LengthVarp, PositionVarp)
 		})
 	}
@@ -148,7 +153,7 @@ func (x *EditableInterface) GetDoInsertText() func(Editable, string, int, int) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, TextVarp string, LengthVarp int, PositionVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDoInsertText)
+	core.RegisterFunc(&rawCallback, x.xDoInsertText)
 	return func(EditableVar Editable, TextVar string, LengthVar int, PositionVar int) {
 		rawCallback(EditableVar.GoPointer(), TextVar, LengthVar, PositionVar)
 	}
@@ -159,7 +164,8 @@ func (x *EditableInterface) OverrideDoDeleteText(cb func(Editable, int, int)) {
 	if cb == nil {
 		x.xDoDeleteText = 0
 	} else {
-		x.xDoDeleteText = purego.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+		x.xDoDeleteText = core.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp}, StartPosVarp, EndPosVarp)
 		})
 	}
@@ -171,7 +177,7 @@ func (x *EditableInterface) GetDoDeleteText() func(Editable, int, int) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDoDeleteText)
+	core.RegisterFunc(&rawCallback, x.xDoDeleteText)
 	return func(EditableVar Editable, StartPosVar int, EndPosVar int) {
 		rawCallback(EditableVar.GoPointer(), StartPosVar, EndPosVar)
 	}
@@ -182,7 +188,8 @@ func (x *EditableInterface) OverrideGetSelectionBounds(cb func(Editable, *int, *
 	if cb == nil {
 		x.xGetSelectionBounds = 0
 	} else {
-		x.xGetSelectionBounds = purego.NewCallback(func(EditableVarp uintptr, StartPosVarp *int, EndPosVarp *int) bool {
+		x.xGetSelectionBounds = core.NewCallback(func(EditableVarp uintptr, StartPosVarp *int, EndPosVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(&EditableBase{Ptr: EditableVarp}, StartPosVarp, EndPosVarp)
 		})
 	}
@@ -194,7 +201,7 @@ func (x *EditableInterface) GetGetSelectionBounds() func(Editable, *int, *int) b
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, StartPosVarp *int, EndPosVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xGetSelectionBounds)
+	core.RegisterFunc(&rawCallback, x.xGetSelectionBounds)
 	return func(EditableVar Editable, StartPosVar *int, EndPosVar *int) bool {
 		return rawCallback(EditableVar.GoPointer(), StartPosVar, EndPosVar)
 	}
@@ -205,7 +212,8 @@ func (x *EditableInterface) OverrideSetSelectionBounds(cb func(Editable, int, in
 	if cb == nil {
 		x.xSetSelectionBounds = 0
 	} else {
-		x.xSetSelectionBounds = purego.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+		x.xSetSelectionBounds = core.NewCallback(func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int) {
+			defer core.RecoverPanic()
 			cb(&EditableBase{Ptr: EditableVarp}, StartPosVarp, EndPosVarp)
 		})
 	}
@@ -217,7 +225,7 @@ func (x *EditableInterface) GetSetSelectionBounds() func(Editable, int, int) {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr, StartPosVarp int, EndPosVarp int)
-	purego.RegisterFunc(&rawCallback, x.xSetSelectionBounds)
+	core.RegisterFunc(&rawCallback, x.xSetSelectionBounds)
 	return func(EditableVar Editable, StartPosVar int, EndPosVar int) {
 		rawCallback(EditableVar.GoPointer(), StartPosVar, EndPosVar)
 	}
@@ -228,7 +236,8 @@ func (x *EditableInterface) OverrideGetDelegate(cb func(Editable) *EditableBase)
 	if cb == nil {
 		x.xGetDelegate = 0
 	} else {
-		x.xGetDelegate = purego.NewCallback(func(EditableVarp uintptr) uintptr {
+		x.xGetDelegate = core.NewCallback(func(EditableVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&EditableBase{Ptr: EditableVarp})
 			if ret == nil {
 				return 0
@@ -244,7 +253,7 @@ func (x *EditableInterface) GetGetDelegate() func(Editable) *EditableBase {
 		return nil
 	}
 	var rawCallback func(EditableVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetDelegate)
+	core.RegisterFunc(&rawCallback, x.xGetDelegate)
 	return func(EditableVar Editable) *EditableBase {
 		rawRet := rawCallback(EditableVar.GoPointer())
 		if rawRet == 0 {
@@ -258,29 +267,26 @@ func (x *EditableInterface) GetGetDelegate() func(Editable) *EditableBase {
 
 // Interface for single-line text editing widgets.
 //
-// Typical examples of editable widgets are [class@Gtk.Entry] and
-// [class@Gtk.SpinButton]. It contains functions for generically manipulating
+// Typical examples of editable widgets are [Entry] and
+// [SpinButton]. It contains functions for generically manipulating
 // an editable widget, a large number of action signals used for key bindings,
 // and several signals that an application can connect to modify the behavior
 // of a widget.
 //
 // As an example of the latter usage, by connecting the following handler to
-// [signal@Gtk.Editable::insert-text], an application can convert all entry
+// Editable::insert-text, an application can convert all entry
 // into a widget into uppercase.
 //
-// ## Forcing entry to uppercase.
+// # Forcing entry to uppercase.
 //
-// ```c
-// #include &lt;ctype.h&gt;
-//
-// void
-// insert_text_handler (GtkEditable *editable,
-//
-//	const char  *text,
-//	int          length,
-//	int         *position,
-//	gpointer     data)
+//	#include <ctype.h>
 //
+//	void
+//	insert_text_handler (GtkEditable *editable,
+//	                     const char  *text,
+//	                     int          length,
+//	                     int         *position,
+//	                     gpointer     data)
 //	{
 //	  char *result = g_utf8_strup (text, length);
 //
@@ -295,22 +301,18 @@ func (x *EditableInterface) GetGetDelegate() func(Editable) *EditableBase {
 //	  g_free (result);
 //	}
 //
-// ```
-//
-// ## Implementing GtkEditable
+// # Implementing GtkEditable
 //
 // The most likely scenario for implementing `GtkEditable` on your own widget
 // is that you will embed a `GtkText` inside a complex widget, and want to
 // delegate the editable functionality to that text widget. `GtkEditable`
 // provides some utility functions to make this easy.
 //
-// In your class_init function, call [func@Gtk.Editable.install_properties],
+// In your class_init function, call [EditableInstallProperties],
 // passing the first available property ID:
 //
-// ```c
-// static void
-// my_class_init (MyClass *class)
-//
+//	static void
+//	my_class_init (MyClass *class)
 //	{
 //	  ...
 //	  g_object_class_install_properties (object_class, NUM_PROPERTIES, props);
@@ -318,69 +320,52 @@ func (x *EditableInterface) GetGetDelegate() func(Editable) *EditableBase {
 //	  ...
 //	}
 //
-// ```
-//
 // In your interface_init function for the `GtkEditable` interface, provide
 // an implementation for the get_delegate vfunc that returns your text widget:
 //
-// ```c
-// GtkEditable *
-// get_editable_delegate (GtkEditable *editable)
-//
+//	GtkEditable *
+//	get_editable_delegate (GtkEditable *editable)
 //	{
-//	  return GTK_EDITABLE (MY_WIDGET (editable)-&gt;text_widget);
+//	  return GTK_EDITABLE (MY_WIDGET (editable)->text_widget);
 //	}
 //
-// static void
-// my_editable_init (GtkEditableInterface *iface)
-//
+//	static void
+//	my_editable_init (GtkEditableInterface *iface)
 //	{
-//	  iface-&gt;get_delegate = get_editable_delegate;
+//	  iface->get_delegate = get_editable_delegate;
 //	}
 //
-// ```
-//
 // You don't need to provide any other vfuncs. The default implementations
 // work by forwarding to the delegate that the GtkEditableInterface.get_delegate()
 // vfunc returns.
 //
 // In your instance_init function, create your text widget, and then call
-// [method@Gtk.Editable.init_delegate]:
-//
-// ```c
-// static void
-// my_widget_init (MyWidget *self)
+// [Editable.InitDelegate]:
 //
+//	static void
+//	my_widget_init (MyWidget *self)
 //	{
 //	  ...
-//	  self-&gt;text_widget = gtk_text_new ();
+//	  self->text_widget = gtk_text_new ();
 //	  gtk_editable_init_delegate (GTK_EDITABLE (self));
 //	  ...
 //	}
 //
-// ```
-//
-// In your dispose function, call [method@Gtk.Editable.finish_delegate] before
+// In your dispose function, call [Editable.FinishDelegate] before
 // destroying your text widget:
 //
-// ```c
-// static void
-// my_widget_dispose (GObject *object)
-//
+//	static void
+//	my_widget_dispose (GObject *object)
 //	{
 //	  ...
 //	  gtk_editable_finish_delegate (GTK_EDITABLE (self));
-//	  g_clear_pointer (&amp;self-&gt;text_widget, gtk_widget_unparent);
+//	  g_clear_pointer (&self->text_widget, gtk_widget_unparent);
 //	  ...
 //	}
 //
-// ```
-//
-// Finally, use [func@Gtk.Editable.delegate_set_property] in your `set_property`
+// Finally, use [EditableDelegateSetProperty] in your `set_property`
 // function (and similar for `get_property`), to set the editable properties:
 //
-// ```c
-//
 //	...
 //	if (gtk_editable_delegate_set_property (object, prop_id, value, pspec))
 //	  return;
@@ -388,16 +373,14 @@ func (x *EditableInterface) GetGetDelegate() func(Editable) *EditableBase {
 //	switch (prop_id)
 //	...
 //
-// ```
-//
 // It is important to note that if you create a `GtkEditable` that uses
-// a delegate, the low level [signal@Gtk.Editable::insert-text] and
-// [signal@Gtk.Editable::delete-text] signals will be propagated from the
+// a delegate, the low level Editable::insert-text and
+// Editable::delete-text signals will be propagated from the
 // "wrapper" editable to the delegate, but they will not be propagated from
 // the delegate to the "wrapper" editable, as they would cause an infinite
-// recursion. If you wish to connect to the [signal@Gtk.Editable::insert-text]
-// and [signal@Gtk.Editable::delete-text] signals, you will need to connect
-// to them on the delegate obtained via [method@Gtk.Editable.get_delegate].
+// recursion. If you wish to connect to the Editable::insert-text
+// and Editable::delete-text signals, you will need to connect
+// to them on the delegate obtained via [Editable.GetDelegate].
 type Editable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -448,41 +431,111 @@ func (x *EditableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementEditable adds the Editable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - InsertText(string, int, int)
+//   - DeleteText(int, int)
+//   - Changed()
+//   - GetText() string
+//   - DoInsertText(string, int, int)
+//   - DoDeleteText(int, int)
+//   - GetSelectionBounds(*int, *int) bool
+//   - SetSelectionBounds(int, int)
+//   - GetDelegate() *EditableBase
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see EditableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementEditable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, EditableGLibType(), func(iface *EditableInterface) {
+		if _, ok := any(zero).(interface{ InsertText(string, int, int) }); ok {
+			iface.OverrideInsertText(func(self Editable, arg0 string, arg1 int, arg2 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ InsertText(string, int, int) })
+				impl.InsertText(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ DeleteText(int, int) }); ok {
+			iface.OverrideDeleteText(func(self Editable, arg0 int, arg1 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DeleteText(int, int) })
+				impl.DeleteText(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ Changed() }); ok {
+			iface.OverrideChanged(func(self Editable) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Changed() })
+				impl.Changed()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetText() string }); ok {
+			iface.OverrideGetText(func(self Editable) string {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetText() string })
+				return impl.GetText()
+			})
+		}
+		if _, ok := any(zero).(interface{ DoInsertText(string, int, int) }); ok {
+			iface.OverrideDoInsertText(func(self Editable, arg0 string, arg1 int, arg2 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DoInsertText(string, int, int) })
+				impl.DoInsertText(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ DoDeleteText(int, int) }); ok {
+			iface.OverrideDoDeleteText(func(self Editable, arg0 int, arg1 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DoDeleteText(int, int) })
+				impl.DoDeleteText(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSelectionBounds(*int, *int) bool }); ok {
+			iface.OverrideGetSelectionBounds(func(self Editable, arg0 *int, arg1 *int) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSelectionBounds(*int, *int) bool })
+				return impl.GetSelectionBounds(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetSelectionBounds(int, int) }); ok {
+			iface.OverrideSetSelectionBounds(func(self Editable, arg0 int, arg1 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetSelectionBounds(int, int) })
+				impl.SetSelectionBounds(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetDelegate() *EditableBase }); ok {
+			iface.OverrideGetDelegate(func(self Editable) *EditableBase {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetDelegate() *EditableBase })
+				return impl.GetDelegate()
+			})
+		}
+	})
+}
+
 // Retrieves the accessible platform state from the editable delegate.
 //
 // This is an helper function to retrieve the accessible state for
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *EditableBase) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -502,8 +555,8 @@ func (x *EditableBase) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -513,7 +566,7 @@ func (x *EditableBase) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -533,8 +586,8 @@ func (x *EditableBase) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -544,10 +597,10 @@ func (x *EditableBase) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *EditableBase) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -562,21 +615,21 @@ func (x *EditableBase) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *EditableBase) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *EditableBase) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *EditableBase) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -595,9 +648,9 @@ func (x *EditableBase) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EditableBase) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -606,7 +659,7 @@ func (x *EditableBase) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *EditableBase) GetText() string {
@@ -626,7 +679,7 @@ func (x *EditableBase) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -636,11 +689,11 @@ func (x *EditableBase) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *EditableBase) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -651,9 +704,9 @@ func (x *EditableBase) InsertText(TextVar string, LengthVar int, PositionVar int
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EditableBase) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -679,19 +732,19 @@ func (x *EditableBase) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *EditableBase) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *EditableBase) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -704,7 +757,7 @@ func (x *EditableBase) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *EditableBase) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -721,11 +774,11 @@ func (x *EditableBase) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *EditableBase) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -907,9 +960,9 @@ var XGtkEditableSetPosition func(uintptr, int)
 var XGtkEditableSetText func(uintptr, string)
 var XGtkEditableSetWidthChars func(uintptr, int)
 
-// The identifiers for [iface@Gtk.Editable] properties.
+// The identifiers for [Editable] properties.
 //
-// See [func@Gtk.Editable.install_properties] for details on how to
+// See [EditableInstallProperties] for details on how to
 // implement the `GtkEditable` interface.
 type EditableProperties int
 
@@ -921,29 +974,104 @@ func EditablePropertiesGLibType() types.GType {
 
 const (
 
-	// the property id for [property@Gtk.Editable:text]
+	// the property id for [EditableBase.GetPropertyText]
 	EditablePropTextValue EditableProperties = 0
-	// the property id for [property@Gtk.Editable:cursor-position]
+	// the property id for [EditableBase.GetPropertyCursorPosition]
 	EditablePropCursorPositionValue EditableProperties = 1
-	// the property id for [property@Gtk.Editable:selection-bound]
+	// the property id for [EditableBase.GetPropertySelectionBound]
 	EditablePropSelectionBoundValue EditableProperties = 2
-	// the property id for [property@Gtk.Editable:editable]
+	// the property id for [EditableBase.GetPropertyEditable]
 	EditablePropEditableValue EditableProperties = 3
-	// the property id for [property@Gtk.Editable:width-chars]
+	// the property id for [EditableBase.GetPropertyWidthChars]
 	EditablePropWidthCharsValue EditableProperties = 4
-	// the property id for [property@Gtk.Editable:max-width-chars]
+	// the property id for [EditableBase.GetPropertyMaxWidthChars]
 	EditablePropMaxWidthCharsValue EditableProperties = 5
-	// the property id for [property@Gtk.Editable:xalign]
+	// the property id for [EditableBase.GetPropertyXalign]
 	EditablePropXalignValue EditableProperties = 6
-	// the property id for [property@Gtk.Editable:enable-undo]
+	// the property id for [EditableBase.GetPropertyEnableUndo]
 	EditablePropEnableUndoValue EditableProperties = 7
 	// the number of properties
 	EditableNumPropertiesValue EditableProperties = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x EditableProperties) String() string {
+	switch x {
+	case EditablePropTextValue:
+		return "GTK_EDITABLE_PROP_TEXT"
+	case EditablePropCursorPositionValue:
+		return "GTK_EDITABLE_PROP_CURSOR_POSITION"
+	case EditablePropSelectionBoundValue:
+		return "GTK_EDITABLE_PROP_SELECTION_BOUND"
+	case EditablePropEditableValue:
+		return "GTK_EDITABLE_PROP_EDITABLE"
+	case EditablePropWidthCharsValue:
+		return "GTK_EDITABLE_PROP_WIDTH_CHARS"
+	case EditablePropMaxWidthCharsValue:
+		return "GTK_EDITABLE_PROP_MAX_WIDTH_CHARS"
+	case EditablePropXalignValue:
+		return "GTK_EDITABLE_PROP_XALIGN"
+	case EditablePropEnableUndoValue:
+		return "GTK_EDITABLE_PROP_ENABLE_UNDO"
+	case EditableNumPropertiesValue:
+		return "GTK_EDITABLE_NUM_PROPERTIES"
+	}
+	return fmt.Sprintf("EditableProperties(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EditableProperties) Nick() string {
+	switch x {
+	case EditablePropTextValue:
+		return "prop-text"
+	case EditablePropCursorPositionValue:
+		return "prop-cursor-position"
+	case EditablePropSelectionBoundValue:
+		return "prop-selection-bound"
+	case EditablePropEditableValue:
+		return "prop-editable"
+	case EditablePropWidthCharsValue:
+		return "prop-width-chars"
+	case EditablePropMaxWidthCharsValue:
+		return "prop-max-width-chars"
+	case EditablePropXalignValue:
+		return "prop-xalign"
+	case EditablePropEnableUndoValue:
+		return "prop-enable-undo"
+	case EditableNumPropertiesValue:
+		return "num-properties"
+	}
+	return ""
+}
+
+// EditablePropertiesFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EditablePropertiesFromString(s string) (EditableProperties, bool) {
+	switch s {
+	case "GTK_EDITABLE_PROP_TEXT", "prop-text":
+		return EditablePropTextValue, true
+	case "GTK_EDITABLE_PROP_CURSOR_POSITION", "prop-cursor-position":
+		return EditablePropCursorPositionValue, true
+	case "GTK_EDITABLE_PROP_SELECTION_BOUND", "prop-selection-bound":
+		return EditablePropSelectionBoundValue, true
+	case "GTK_EDITABLE_PROP_EDITABLE", "prop-editable":
+		return EditablePropEditableValue, true
+	case "GTK_EDITABLE_PROP_WIDTH_CHARS", "prop-width-chars":
+		return EditablePropWidthCharsValue, true
+	case "GTK_EDITABLE_PROP_MAX_WIDTH_CHARS", "prop-max-width-chars":
+		return EditablePropMaxWidthCharsValue, true
+	case "GTK_EDITABLE_PROP_XALIGN", "prop-xalign":
+		return EditablePropXalignValue, true
+	case "GTK_EDITABLE_PROP_ENABLE_UNDO", "prop-enable-undo":
+		return EditablePropEnableUndoValue, true
+	case "GTK_EDITABLE_NUM_PROPERTIES", "num-properties":
+		return EditableNumPropertiesValue, true
+	}
+	return 0, false
+}
+
 var xEditableDelegateGetProperty func(uintptr, uint, *gobject.Value, uintptr) bool
 
-// Gets a property of the `GtkEditable` delegate for @object.
+// Gets a property of the `GtkEditable` delegate for object.
 //
 // This is helper function that should be called in the `get_property`
 // function of your `GtkEditable` implementation, before handling your
@@ -956,7 +1084,7 @@ func EditableDelegateGetProperty(ObjectVar *gobject.Object, PropIdVar uint, Valu
 
 var xEditableDelegateSetProperty func(uintptr, uint, *gobject.Value, uintptr) bool
 
-// Sets a property on the `GtkEditable` delegate for @object.
+// Sets a property on the `GtkEditable` delegate for object.
 //
 // This is a helper function that should be called in the `set_property`
 // function of your `GtkEditable` implementation, before handling your
@@ -969,7 +1097,7 @@ func EditableDelegateSetProperty(ObjectVar *gobject.Object, PropIdVar uint, Valu
 
 var xEditableInstallProperties func(*gobject.ObjectClass, uint) uint
 
-// Overrides the `GtkEditable` properties for @class.
+// Overrides the `GtkEditable` properties for class.
 //
 // This is a helper function that should be called in class_init,
 // after installing your own properties.
@@ -979,10 +1107,10 @@ var xEditableInstallProperties func(*gobject.ObjectClass, uint) uint
 // "xalign" and "enable-undo" properties for this function to work.
 //
 // To handle the properties in your set_property and get_property
-// functions, you can either use [func@Gtk.Editable.delegate_set_property]
-// and [func@Gtk.Editable.delegate_get_property] (if you are using
-// a delegate), or remember the @first_prop offset and add it to the
-// values in the [enum@Gtk.EditableProperties] enumeration to get the
+// functions, you can either use [EditableDelegateSetProperty]
+// and [EditableDelegateGetProperty] (if you are using
+// a delegate), or remember the first_prop offset and add it to the
+// values in the [EditableProperties] enumeration to get the
 // property IDs for these properties.
 func EditableInstallProperties(ObjectClassVar *gobject.ObjectClass, FirstPropVar uint) uint {
 
@@ -995,7 +1123,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1004,35 +1132,37 @@ func init() {
 
 	core.PuregoSafeRegister(&xEditablePropertiesGLibType, libs, "gtk_editable_properties_get_type")
 
-	core.PuregoSafeRegister(&xEditableDelegateGetProperty, libs, "gtk_editable_delegate_get_property")
-	core.PuregoSafeRegister(&xEditableDelegateSetProperty, libs, "gtk_editable_delegate_set_property")
-	core.PuregoSafeRegister(&xEditableInstallProperties, libs, "gtk_editable_install_properties")
+	core.PuregoRegisterSince(&xEditableDelegateGetProperty, libs, "gtk_editable_delegate_get_property", "GTK", "")
+	core.PuregoRegisterSince(&xEditableDelegateSetProperty, libs, "gtk_editable_delegate_set_property", "GTK", "")
+	core.PuregoRegisterSince(&xEditableInstallProperties, libs, "gtk_editable_install_properties", "GTK", "")
 
 	core.PuregoSafeRegister(&xEditableGLibType, libs, "gtk_editable_get_type")
 
-	core.PuregoSafeRegister(&XGtkEditableDelegateGetAccessiblePlatformState, libs, "gtk_editable_delegate_get_accessible_platform_state")
-	core.PuregoSafeRegister(&XGtkEditableDeleteSelection, libs, "gtk_editable_delete_selection")
-	core.PuregoSafeRegister(&XGtkEditableDeleteText, libs, "gtk_editable_delete_text")
-	core.PuregoSafeRegister(&XGtkEditableFinishDelegate, libs, "gtk_editable_finish_delegate")
-	core.PuregoSafeRegister(&XGtkEditableGetAlignment, libs, "gtk_editable_get_alignment")
-	core.PuregoSafeRegister(&XGtkEditableGetChars, libs, "gtk_editable_get_chars")
-	core.PuregoSafeRegister(&XGtkEditableGetDelegate, libs, "gtk_editable_get_delegate")
-	core.PuregoSafeRegister(&XGtkEditableGetEditable, libs, "gtk_editable_get_editable")
-	core.PuregoSafeRegister(&XGtkEditableGetEnableUndo, libs, "gtk_editable_get_enable_undo")
-	core.PuregoSafeRegister(&XGtkEditableGetMaxWidthChars, libs, "gtk_editable_get_max_width_chars")
-	core.PuregoSafeRegister(&XGtkEditableGetPosition, libs, "gtk_editable_get_position")
-	core.PuregoSafeRegister(&XGtkEditableGetSelectionBounds, libs, "gtk_editable_get_selection_bounds")
-	core.PuregoSafeRegister(&XGtkEditableGetText, libs, "gtk_editable_get_text")
-	core.PuregoSafeRegister(&XGtkEditableGetWidthChars, libs, "gtk_editable_get_width_chars")
-	core.PuregoSafeRegister(&XGtkEditableInitDelegate, libs, "gtk_editable_init_delegate")
-	core.PuregoSafeRegister(&XGtkEditableInsertText, libs, "gtk_editable_insert_text")
-	core.PuregoSafeRegister(&XGtkEditableSelectRegion, libs, "gtk_editable_select_region")
-	core.PuregoSafeRegister(&XGtkEditableSetAlignment, libs, "gtk_editable_set_alignment")
-	core.PuregoSafeRegister(&XGtkEditableSetEditable, libs, "gtk_editable_set_editable")
-	core.PuregoSafeRegister(&XGtkEditableSetEnableUndo, libs, "gtk_editable_set_enable_undo")
-	core.PuregoSafeRegister(&XGtkEditableSetMaxWidthChars, libs, "gtk_editable_set_max_width_chars")
-	core.PuregoSafeRegister(&XGtkEditableSetPosition, libs, "gtk_editable_set_position")
-	core.PuregoSafeRegister(&XGtkEditableSetText, libs, "gtk_editable_set_text")
-	core.PuregoSafeRegister(&XGtkEditableSetWidthChars, libs, "gtk_editable_set_width_chars")
+	gobject.CheckClassSize("GtkEditableInterface", xEditableGLibType, unsafe.Sizeof(EditableInterface{}))
+
+	core.PuregoRegisterSince(&XGtkEditableDelegateGetAccessiblePlatformState, libs, "gtk_editable_delegate_get_accessible_platform_state", "GTK", "4.10")
+	core.PuregoRegisterSince(&XGtkEditableDeleteSelection, libs, "gtk_editable_delete_selection", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableDeleteText, libs, "gtk_editable_delete_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableFinishDelegate, libs, "gtk_editable_finish_delegate", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetAlignment, libs, "gtk_editable_get_alignment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetChars, libs, "gtk_editable_get_chars", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetDelegate, libs, "gtk_editable_get_delegate", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetEditable, libs, "gtk_editable_get_editable", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetEnableUndo, libs, "gtk_editable_get_enable_undo", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetMaxWidthChars, libs, "gtk_editable_get_max_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetPosition, libs, "gtk_editable_get_position", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetSelectionBounds, libs, "gtk_editable_get_selection_bounds", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetText, libs, "gtk_editable_get_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableGetWidthChars, libs, "gtk_editable_get_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableInitDelegate, libs, "gtk_editable_init_delegate", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableInsertText, libs, "gtk_editable_insert_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSelectRegion, libs, "gtk_editable_select_region", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetAlignment, libs, "gtk_editable_set_alignment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetEditable, libs, "gtk_editable_set_editable", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetEnableUndo, libs, "gtk_editable_set_enable_undo", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetMaxWidthChars, libs, "gtk_editable_set_max_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetPosition, libs, "gtk_editable_set_position", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetText, libs, "gtk_editable_set_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkEditableSetWidthChars, libs, "gtk_editable_set_width_chars", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -23,15 +22,8 @@ func (x *EditableLabelClass) GoPointer() uintptr {
 
 // Allows users to edit the displayed text by switching to an “edit mode”.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="editable-label-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkEditableLabel" src="editable-label.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // `GtkEditableLabel` does not have API of its own, but it
-// implements the [iface@Gtk.Editable] interface.
+// implements the [Editable] interface.
 //
 // The default bindings for activating the edit mode is
 // to click or press the Enter key. The default bindings
@@ -42,8 +34,8 @@ func (x *EditableLabelClass) GoPointer() uintptr {
 //
 // `GtkEditableLabel` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Enter&lt;/kbd&gt; starts editing.
-// - &lt;kbd&gt;Escape&lt;/kbd&gt; stops editing.
+// - Enter starts editing.
+// - Escape stops editing.
 //
 // # Actions
 //
@@ -54,21 +46,17 @@ func (x *EditableLabelClass) GoPointer() uintptr {
 //
 // # CSS nodes
 //
-// ```
-// editablelabel[.editing]
-// ╰── stack
-//
-//	├── label
-//	╰── text
-//
-// ```
+//	editablelabel[.editing]
+//	╰── stack
+//	    ├── label
+//	    ╰── text
 //
 // `GtkEditableLabel` has a main node with the name editablelabel.
 // When the entry is in editing mode, it gets the .editing style
 // class.
 //
 // For all the subnodes added to the text node in various situations,
-// see [class@Gtk.Text].
+// see [Text].
 type EditableLabel struct {
 	Widget
 }
@@ -124,10 +112,10 @@ var xEditableLabelStopEditing func(uintptr, bool)
 
 // Switches the label out of “editing mode”.
 //
-// If @commit is %TRUE, the resulting text is kept as the
-// [property@Gtk.Editable:text] property value, otherwise the
+// If commit is true, the resulting text is kept as the
+// [EditableBase.GetPropertyText] property value, otherwise the
 // resulting text is discarded and the label will keep its
-// previous [property@Gtk.Editable:text] property value.
+// previous [EditableBase.GetPropertyText] property value.
 func (x *EditableLabel) StopEditing(CommitVar bool) {
 
 	xEditableLabelStopEditing(x.GoPointer(), CommitVar)
@@ -146,7 +134,7 @@ func (c *EditableLabel) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyEditing sets the "editing" property.
-// This property is %TRUE while the widget is in edit mode.
+// This property is true while the widget is in edit mode.
 func (x *EditableLabel) SetPropertyEditing(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -155,7 +143,7 @@ func (x *EditableLabel) SetPropertyEditing(value bool) {
 }
 
 // GetPropertyEditing gets the "editing" property.
-// This property is %TRUE while the widget is in edit mode.
+// This property is true while the widget is in edit mode.
 func (x *EditableLabel) GetPropertyEditing() bool {
 	var v gobject.Value
 	x.GetProperty("editing", &v)
@@ -323,7 +311,7 @@ func (x *EditableLabel) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -331,14 +319,13 @@ func (x *EditableLabel) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *EditableLabel) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -362,19 +349,17 @@ func (x *EditableLabel) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *EditableLabel) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -395,7 +380,7 @@ func (x *EditableLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -404,14 +389,12 @@ func (x *EditableLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *EditableLabel) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -430,10 +413,10 @@ func (x *EditableLabel) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *EditableLabel) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -446,35 +429,29 @@ func (x *EditableLabel) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *EditableLabel) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -494,8 +471,8 @@ func (x *EditableLabel) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -505,7 +482,7 @@ func (x *EditableLabel) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -525,8 +502,8 @@ func (x *EditableLabel) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -536,10 +513,10 @@ func (x *EditableLabel) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *EditableLabel) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -554,21 +531,21 @@ func (x *EditableLabel) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *EditableLabel) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *EditableLabel) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *EditableLabel) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -587,9 +564,9 @@ func (x *EditableLabel) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EditableLabel) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -598,7 +575,7 @@ func (x *EditableLabel) GetSelectionBounds(StartPosVar *int, EndPosVar *int) boo
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *EditableLabel) GetText() string {
@@ -618,7 +595,7 @@ func (x *EditableLabel) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -628,11 +605,11 @@ func (x *EditableLabel) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *EditableLabel) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -643,9 +620,9 @@ func (x *EditableLabel) InsertText(TextVar string, LengthVar int, PositionVar in
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *EditableLabel) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -671,19 +648,19 @@ func (x *EditableLabel) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *EditableLabel) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *EditableLabel) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -696,7 +673,7 @@ func (x *EditableLabel) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *EditableLabel) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -713,11 +690,11 @@ func (x *EditableLabel) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *EditableLabel) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -729,7 +706,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -738,10 +715,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xEditableLabelGLibType, libs, "gtk_editable_label_get_type")
 
-	core.PuregoSafeRegister(&xNewEditableLabel, libs, "gtk_editable_label_new")
+	gobject.CheckClassSize("GtkEditableLabelClass", xEditableLabelGLibType, unsafe.Sizeof(EditableLabelClass{}))
+
+	core.PuregoRegisterSince(&xNewEditableLabel, libs, "gtk_editable_label_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xEditableLabelGetEditing, libs, "gtk_editable_label_get_editing")
-	core.PuregoSafeRegister(&xEditableLabelStartEditing, libs, "gtk_editable_label_start_editing")
-	core.PuregoSafeRegister(&xEditableLabelStopEditing, libs, "gtk_editable_label_stop_editing")
+	core.PuregoRegisterSince(&xEditableLabelGetEditing, libs, "gtk_editable_label_get_editing", "GTK", "")
+	core.PuregoRegisterSince(&xEditableLabelStartEditing, libs, "gtk_editable_label_start_editing", "GTK", "")
+	core.PuregoRegisterSince(&xEditableLabelStopEditing, libs, "gtk_editable_label_stop_editing", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,22 +23,15 @@ func (x *EmojiChooserClass) GoPointer() uintptr {
 
 // Used by text widgets to let users insert Emoji characters.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="emojichooser-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkEmojiChooser" src="emojichooser.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// `GtkEmojiChooser` emits the [signal@Gtk.EmojiChooser::emoji-picked]
+// `GtkEmojiChooser` emits the [EmojiChooser.ConnectEmojiPickedFunc]
 // signal when an Emoji is selected.
 //
 // # Shortcuts and Gestures
 //
 // `GtkEmojiChooser` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;N&lt;/kbd&gt; scrolls th the next section.
-// - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;P&lt;/kbd&gt; scrolls th the previous section.
+// - Ctrl+N scrolls th the next section.
+// - Ctrl+P scrolls th the previous section.
 //
 // # Actions
 //
@@ -49,17 +41,13 @@ func (x *EmojiChooserClass) GoPointer() uintptr {
 //
 // # CSS nodes
 //
-// ```
-// popover
-// ├── box.emoji-searchbar
-// │   ╰── entry.search
-// ╰── box.emoji-toolbar
-//
-//	├── button.image-button.emoji-section
-//	├── ...
-//	╰── button.image-button.emoji-section
-//
-// ```
+//	popover
+//	├── box.emoji-searchbar
+//	│   ╰── entry.search
+//	╰── box.emoji-toolbar
+//	    ├── button.image-button.emoji-section
+//	    ├── ...
+//	    ╰── button.image-button.emoji-section
 //
 // Every `GtkEmojiChooser` consists of a main node called popover.
 // The contents of the popover are largely implementation defined
@@ -114,27 +102,49 @@ func (c *EmojiChooser) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when the user selects an Emoji.
+//
+// Deprecated: use ConnectEmojiPickedFunc, which also accepts method values and closures.
 func (x *EmojiChooser) ConnectEmojiPicked(cb *func(EmojiChooser, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "emoji-picked", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TextVarp string) {
+			defer core.RecoverPanic()
+			fa := EmojiChooser{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "emoji-picked", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, TextVarp string) {
+var xEmojiChooserEmojiPickedTrampoline uintptr
+
+func xEmojiChooserEmojiPickedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TextVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EmojiChooser, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EmojiChooser{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "emoji-picked", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEmojiPickedFunc connects cb to the "emoji-picked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EmojiChooser) ConnectEmojiPickedFunc(cb func(EmojiChooser, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "emoji-picked", &xEmojiChooserEmojiPickedTrampoline, xEmojiChooserEmojiPickedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -298,7 +308,7 @@ func (x *EmojiChooser) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -306,14 +316,13 @@ func (x *EmojiChooser) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *EmojiChooser) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -337,19 +346,17 @@ func (x *EmojiChooser) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *EmojiChooser) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -370,7 +377,7 @@ func (x *EmojiChooser) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -379,14 +386,12 @@ func (x *EmojiChooser) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *EmojiChooser) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -405,10 +410,10 @@ func (x *EmojiChooser) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *EmojiChooser) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -445,10 +450,10 @@ func (x *EmojiChooser) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *EmojiChooser) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -478,7 +483,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -487,6 +492,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xEmojiChooserGLibType, libs, "gtk_emoji_chooser_get_type")
 
-	core.PuregoSafeRegister(&xNewEmojiChooser, libs, "gtk_emoji_chooser_new")
+	core.PuregoRegisterSince(&xNewEmojiChooser, libs, "gtk_emoji_chooser_new", "GTK", "")
 
 }
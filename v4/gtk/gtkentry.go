@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -17,8 +17,8 @@ import (
 
 // Class structure for `GtkEntry`. All virtual functions have a default
 // implementation. Derived classes may set the virtual function pointers for the
-// signal handlers to %NULL, but must keep @get_text_area_size and
-// @get_frame_size non-%NULL; either use the default implementation, or provide
+// signal handlers to nil, but must keep get_text_area_size and
+// get_frame_size non-nil; either use the default implementation, or provide
 // a custom one.
 type EntryClass struct {
 	_ structs.HostLayout
@@ -42,7 +42,8 @@ func (x *EntryClass) OverrideActivate(cb func(*Entry)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(EntryVarp uintptr) {
+		x.xActivate = core.NewCallback(func(EntryVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(EntryNewFromInternalPtr(EntryVarp))
 		})
 	}
@@ -57,7 +58,7 @@ func (x *EntryClass) GetActivate() func(*Entry) {
 		return nil
 	}
 	var rawCallback func(EntryVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(EntryVar *Entry) {
 		rawCallback(EntryVar.GoPointer())
 	}
@@ -80,40 +81,66 @@ const (
 	EntryIconSecondaryValue EntryIconPosition = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x EntryIconPosition) String() string {
+	switch x {
+	case EntryIconPrimaryValue:
+		return "GTK_ENTRY_ICON_PRIMARY"
+	case EntryIconSecondaryValue:
+		return "GTK_ENTRY_ICON_SECONDARY"
+	}
+	return fmt.Sprintf("EntryIconPosition(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EntryIconPosition) Nick() string {
+	switch x {
+	case EntryIconPrimaryValue:
+		return "primary"
+	case EntryIconSecondaryValue:
+		return "secondary"
+	}
+	return ""
+}
+
+// EntryIconPositionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EntryIconPositionFromString(s string) (EntryIconPosition, bool) {
+	switch s {
+	case "GTK_ENTRY_ICON_PRIMARY", "primary":
+		return EntryIconPrimaryValue, true
+	case "GTK_ENTRY_ICON_SECONDARY", "secondary":
+		return EntryIconSecondaryValue, true
+	}
+	return 0, false
+}
+
 // A single-line text entry widget.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="entry-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkEntry" src="entry.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // A fairly large set of key bindings are supported by default. If the
 // entered text is longer than the allocation of the widget, the widget
 // will scroll so that the cursor position is visible.
 //
 // When using an entry for passwords and other sensitive information, it
-// can be put into “password mode” using [method@Gtk.Entry.set_visibility].
+// can be put into “password mode” using [Entry.SetVisibility].
 // In this mode, entered text is displayed using a “invisible” character.
 // By default, GTK picks the best invisible character that is available
 // in the current font, but it can be changed with
-// [method@Gtk.Entry.set_invisible_char].
+// [Entry.SetInvisibleChar].
 //
 // `GtkEntry` has the ability to display progress or activity
 // information behind the text. To make an entry display such information,
-// use [method@Gtk.Entry.set_progress_fraction] or
-// [method@Gtk.Entry.set_progress_pulse_step].
+// use [Entry.SetProgressFraction] or
+// [Entry.SetProgressPulseStep].
 //
 // Additionally, `GtkEntry` can show icons at either side of the entry.
 // These icons can be activatable by clicking, can be set up as drag source
 // and can have tooltips. To add an icon, use
-// [method@Gtk.Entry.set_icon_from_gicon] or one of the various other functions
+// [Entry.SetIconFromGicon] or one of the various other functions
 // that set an icon from an icon name or a paintable. To trigger an action when
-// the user clicks an icon, connect to the [signal@Gtk.Entry::icon-press] signal.
+// the user clicks an icon, connect to the [Entry.ConnectIconPressFunc] signal.
 // To allow DND operations from an icon, use
-// [method@Gtk.Entry.set_icon_drag_source]. To set a tooltip on an icon, use
-// [method@Gtk.Entry.set_icon_tooltip_text] or the corresponding function
+// [Entry.SetIconDragSource]. To set a tooltip on an icon, use
+// [Entry.SetIconTooltipText] or the corresponding function
 // for markup.
 //
 // Note that functionality or information that is only available by clicking
@@ -124,13 +151,11 @@ const (
 //
 // # CSS nodes
 //
-// ```
-// entry[.flat][.warning][.error]
-// ├── text[.readonly]
-// ├── image.left
-// ├── image.right
-// ╰── [progress[.pulse]]
-// ```
+//	entry[.flat][.warning][.error]
+//	├── text[.readonly]
+//	├── image.left
+//	├── image.right
+//	╰── [progress[.pulse]]
 //
 // `GtkEntry` has a main node with the name entry. Depending on the properties
 // of the entry, the style classes .read-only and .flat may appear. The style
@@ -143,27 +168,24 @@ const (
 // The node has the style class .pulse when the shown progress is pulsing.
 //
 // For all the subnodes added to the text node in various situations,
-// see [class@Gtk.Text].
+// see [Text].
 //
 // # GtkEntry as GtkBuildable
 //
 // The `GtkEntry` implementation of the `GtkBuildable` interface supports a
-// custom `&lt;attributes&gt;` element, which supports any number of `&lt;attribute&gt;`
-// elements. The `&lt;attribute&gt;` element has attributes named “name“, “value“,
+// custom `<attributes>` element, which supports any number of `<attribute>`
+// elements. The `<attribute>` element has attributes named “name“, “value“,
 // “start“ and “end“ and allows you to specify `PangoAttribute` values for
 // this label.
 //
 // An example of a UI definition fragment specifying Pango attributes:
-// ```xml
-// &lt;object class="GtkEntry"&gt;
-//
-//	&lt;attributes&gt;
-//	  &lt;attribute name="weight" value="PANGO_WEIGHT_BOLD"/&gt;
-//	  &lt;attribute name="background" value="red" start="5" end="10"/&gt;
-//	&lt;/attributes&gt;
 //
-// &lt;/object&gt;
-// ```
+//	<object class="GtkEntry">
+//	  <attributes>
+//	    <attribute name="weight" value="PANGO_WEIGHT_BOLD"/>
+//	    <attribute name="background" value="red" start="5" end="10"/>
+//	  </attributes>
+//	</object>
 //
 // The start and end attributes specify the range of characters to which the
 // Pango attribute applies. If start and end are not specified, the attribute
@@ -173,7 +195,7 @@ const (
 //
 // # Accessibility
 //
-// `GtkEntry` uses the [enum@Gtk.AccessibleRole.text_box] role.
+// `GtkEntry` uses the [AccessibleRoleTextBoxValue] role.
 type Entry struct {
 	Widget
 }
@@ -226,7 +248,7 @@ func NewEntryWithBuffer(BufferVar *EntryBuffer) *Entry {
 
 var xEntryGetActivatesDefault func(uintptr) bool
 
-// Retrieves the value set by gtk_entry_set_activates_default().
+// Retrieves the value set by [Entry.SetActivatesDefault].
 func (x *Entry) GetActivatesDefault() bool {
 
 	cret := xEntryGetActivatesDefault(x.GoPointer())
@@ -235,9 +257,9 @@ func (x *Entry) GetActivatesDefault() bool {
 
 var xEntryGetAlignment func(uintptr) float32
 
-// Gets the value set by gtk_entry_set_alignment().
+// Gets the value set by [Entry.SetAlignment].
 //
-// See also: [property@Gtk.Editable:xalign]
+// See also: [EditableBase.GetPropertyXalign]
 func (x *Entry) GetAlignment() float32 {
 
 	cret := xEntryGetAlignment(x.GoPointer())
@@ -248,7 +270,7 @@ var xEntryGetAttributes func(uintptr) *pango.AttrList
 
 // Gets the attribute list of the `GtkEntry`.
 //
-// See [method@Gtk.Entry.set_attributes].
+// See [Entry.SetAttributes].
 func (x *Entry) GetAttributes() *pango.AttrList {
 
 	cret := xEntryGetAttributes(x.GoPointer())
@@ -273,24 +295,6 @@ func (x *Entry) GetBuffer() *EntryBuffer {
 	return cls
 }
 
-var xEntryGetCompletion func(uintptr) uintptr
-
-// Returns the auxiliary completion object currently
-// in use by @entry.
-func (x *Entry) GetCompletion() *EntryCompletion {
-	var cls *EntryCompletion
-
-	cret := xEntryGetCompletion(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &EntryCompletion{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xEntryGetCurrentIconDragSource func(uintptr) int
 
 // Returns the index of the icon which is the source of the
@@ -303,7 +307,7 @@ func (x *Entry) GetCurrentIconDragSource() int {
 
 var xEntryGetExtraMenu func(uintptr) uintptr
 
-// Gets the menu model set with gtk_entry_set_extra_menu().
+// Gets the menu model set with [Entry.SetExtraMenu].
 func (x *Entry) GetExtraMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -320,7 +324,7 @@ func (x *Entry) GetExtraMenu() *gio.MenuModel {
 
 var xEntryGetHasFrame func(uintptr) bool
 
-// Gets the value set by gtk_entry_set_has_frame().
+// Gets the value set by [Entry.SetHasFrame].
 func (x *Entry) GetHasFrame() bool {
 
 	cret := xEntryGetHasFrame(x.GoPointer())
@@ -338,15 +342,15 @@ func (x *Entry) GetIconActivatable(IconPosVar EntryIconPosition) bool {
 
 var xEntryGetIconArea func(uintptr, EntryIconPosition, *gdk.Rectangle)
 
-// Gets the area where entry’s icon at @icon_pos is drawn.
+// Gets the area where entry’s icon at icon_pos is drawn.
 //
 // This function is useful when drawing something to the
 // entry in a draw callback.
 //
 // If the entry is not realized or has no icon at the given
-// position, @icon_area is filled with zeros. Otherwise,
-// @icon_area will be filled with the icon's allocation,
-// relative to @entry's allocation.
+// position, icon_area is filled with zeros. Otherwise,
+// icon_area will be filled with the icon's allocation,
+// relative to entry's allocation.
 func (x *Entry) GetIconArea(IconPosVar EntryIconPosition, IconAreaVar *gdk.Rectangle) {
 
 	xEntryGetIconArea(x.GoPointer(), IconPosVar, IconAreaVar)
@@ -357,10 +361,10 @@ var xEntryGetIconAtPos func(uintptr, int, int) int
 
 // Finds the icon at the given position and return its index.
 //
-// The position’s coordinates are relative to the @entry’s
-// top left corner. If @x, @y doesn’t lie inside an icon,
+// The position’s coordinates are relative to the entry’s
+// top left corner. If x, y doesn’t lie inside an icon,
 // -1 is returned. This function is intended for use in a
-// [signal@Gtk.Widget::query-tooltip] signal handler.
+// [Widget.ConnectQueryTooltipFunc] signal handler.
 func (x *Entry) GetIconAtPos(XVar int, YVar int) int {
 
 	cret := xEntryGetIconAtPos(x.GoPointer(), XVar, YVar)
@@ -371,7 +375,7 @@ var xEntryGetIconGicon func(uintptr, EntryIconPosition) uintptr
 
 // Retrieves the `GIcon` used for the icon.
 //
-// %NULL will be returned if there is no icon or if the icon was
+// nil will be returned if there is no icon or if the icon was
 // set by some other method (e.g., by `GdkPaintable` or icon name).
 func (x *Entry) GetIconGicon(IconPosVar EntryIconPosition) *gio.IconBase {
 	var cls *gio.IconBase
@@ -391,7 +395,7 @@ var xEntryGetIconName func(uintptr, EntryIconPosition) string
 
 // Retrieves the icon name used for the icon.
 //
-// %NULL is returned if there is no icon or if the icon was set
+// nil is returned if there is no icon or if the icon was set
 // by some other method (e.g., by `GdkPaintable` or gicon).
 func (x *Entry) GetIconName(IconPosVar EntryIconPosition) string {
 
@@ -403,7 +407,7 @@ var xEntryGetIconPaintable func(uintptr, EntryIconPosition) uintptr
 
 // Retrieves the `GdkPaintable` used for the icon.
 //
-// If no `GdkPaintable` was used for the icon, %NULL is returned.
+// If no `GdkPaintable` was used for the icon, nil is returned.
 func (x *Entry) GetIconPaintable(IconPosVar EntryIconPosition) *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -433,7 +437,7 @@ var xEntryGetIconStorageType func(uintptr, EntryIconPosition) ImageType
 // to store image data.
 //
 // If the icon has no image data, the return value will
-// be %GTK_IMAGE_EMPTY.
+// be [ImageEmptyValue].
 func (x *Entry) GetIconStorageType(IconPosVar EntryIconPosition) ImageType {
 
 	cret := xEntryGetIconStorageType(x.GoPointer(), IconPosVar)
@@ -443,7 +447,7 @@ func (x *Entry) GetIconStorageType(IconPosVar EntryIconPosition) ImageType {
 var xEntryGetIconTooltipMarkup func(uintptr, EntryIconPosition) string
 
 // Gets the contents of the tooltip on the icon at the specified
-// position in @entry.
+// position in entry.
 func (x *Entry) GetIconTooltipMarkup(IconPosVar EntryIconPosition) string {
 
 	cret := xEntryGetIconTooltipMarkup(x.GoPointer(), IconPosVar)
@@ -453,7 +457,7 @@ func (x *Entry) GetIconTooltipMarkup(IconPosVar EntryIconPosition) string {
 var xEntryGetIconTooltipText func(uintptr, EntryIconPosition) string
 
 // Gets the contents of the tooltip on the icon at the specified
-// position in @entry.
+// position in entry.
 func (x *Entry) GetIconTooltipText(IconPosVar EntryIconPosition) string {
 
 	cret := xEntryGetIconTooltipText(x.GoPointer(), IconPosVar)
@@ -478,11 +482,11 @@ func (x *Entry) GetInputPurpose() InputPurpose {
 	return cret
 }
 
-var xEntryGetInvisibleChar func(uintptr) uint32
+var xEntryGetInvisibleChar func(uintptr) rune
 
 // Retrieves the character displayed in place of the actual text
 // in “password mode”.
-func (x *Entry) GetInvisibleChar() uint32 {
+func (x *Entry) GetInvisibleChar() rune {
 
 	cret := xEntryGetInvisibleChar(x.GoPointer())
 	return cret
@@ -490,9 +494,9 @@ func (x *Entry) GetInvisibleChar() uint32 {
 
 var xEntryGetMaxLength func(uintptr) int
 
-// Retrieves the maximum allowed length of the text in @entry.
+// Retrieves the maximum allowed length of the text in entry.
 //
-// See [method@Gtk.Entry.set_max_length].
+// See [Entry.SetMaxLength].
 func (x *Entry) GetMaxLength() int {
 
 	cret := xEntryGetMaxLength(x.GoPointer())
@@ -524,7 +528,7 @@ func (x *Entry) GetOverwriteMode() bool {
 
 var xEntryGetPlaceholderText func(uintptr) string
 
-// Retrieves the text that will be displayed when @entry
+// Retrieves the text that will be displayed when entry
 // is empty and unfocused
 func (x *Entry) GetPlaceholderText() string {
 
@@ -536,7 +540,7 @@ var xEntryGetProgressFraction func(uintptr) float64
 
 // Returns the current fraction of the task that’s been completed.
 //
-// See [method@Gtk.Entry.set_progress_fraction].
+// See [Entry.SetProgressFraction].
 func (x *Entry) GetProgressFraction() float64 {
 
 	cret := xEntryGetProgressFraction(x.GoPointer())
@@ -546,7 +550,7 @@ func (x *Entry) GetProgressFraction() float64 {
 var xEntryGetProgressPulseStep func(uintptr) float64
 
 // Retrieves the pulse step set with
-// gtk_entry_set_progress_pulse_step().
+// [Entry.SetProgressPulseStep].
 func (x *Entry) GetProgressPulseStep() float64 {
 
 	cret := xEntryGetProgressPulseStep(x.GoPointer())
@@ -557,7 +561,7 @@ var xEntryGetTabs func(uintptr) *pango.TabArray
 
 // Gets the tabstops of the `GtkEntry`.
 //
-// See [method@Gtk.Entry.set_tabs].
+// See [Entry.SetTabs].
 func (x *Entry) GetTabs() *pango.TabArray {
 
 	cret := xEntryGetTabs(x.GoPointer())
@@ -566,10 +570,10 @@ func (x *Entry) GetTabs() *pango.TabArray {
 
 var xEntryGetTextLength func(uintptr) uint16
 
-// Retrieves the current length of the text in @entry.
+// Retrieves the current length of the text in entry.
 //
-// This is equivalent to getting @entry's `GtkEntryBuffer`
-// and calling [method@Gtk.EntryBuffer.get_length] on it.
+// This is equivalent to getting entry's `GtkEntryBuffer`
+// and calling [EntryBuffer.GetLength] on it.
 func (x *Entry) GetTextLength() uint16 {
 
 	cret := xEntryGetTextLength(x.GoPointer())
@@ -578,9 +582,9 @@ func (x *Entry) GetTextLength() uint16 {
 
 var xEntryGetVisibility func(uintptr) bool
 
-// Retrieves whether the text in @entry is visible.
+// Retrieves whether the text in entry is visible.
 //
-// See [method@Gtk.Entry.set_visibility].
+// See [Entry.SetVisibility].
 func (x *Entry) GetVisibility() bool {
 
 	cret := xEntryGetVisibility(x.GoPointer())
@@ -589,9 +593,9 @@ func (x *Entry) GetVisibility() bool {
 
 var xEntryGrabFocusWithoutSelecting func(uintptr) bool
 
-// Causes @entry to have keyboard focus.
+// Causes entry to have keyboard focus.
 //
-// It behaves like [method@Gtk.Widget.grab_focus], except that it doesn't
+// It behaves like [Widget.GrabFocus], except that it doesn't
 // select the contents of the entry. You only want to call this on some
 // special entries which the user usually doesn't want to replace all text
 // in, such as search-as-you-type entries.
@@ -608,9 +612,9 @@ var xEntryProgressPulse func(uintptr)
 //
 // Causes the entry’s progress indicator to enter “activity
 // mode”, where a block bounces back and forth. Each call to
-// gtk_entry_progress_pulse() causes the block to move by a
+// [Entry.ProgressPulse] causes the block to move by a
 // little bit (the amount of movement per pulse is determined
-// by [method@Gtk.Entry.set_progress_pulse_step]).
+// by [Entry.SetProgressPulseStep]).
 func (x *Entry) ProgressPulse() {
 
 	xEntryProgressPulse(x.GoPointer())
@@ -631,7 +635,7 @@ func (x *Entry) ResetImContext() {
 
 var xEntrySetActivatesDefault func(uintptr, bool)
 
-// Sets whether pressing Enter in the @entry will activate the default
+// Sets whether pressing Enter in the entry will activate the default
 // widget for the window containing the entry.
 //
 // This usually means that the dialog containing the entry will be closed,
@@ -649,7 +653,7 @@ var xEntrySetAlignment func(uintptr, float32)
 // This controls the horizontal positioning of the contents when
 // the displayed text is shorter than the width of the entry.
 //
-// See also: [property@Gtk.Editable:xalign]
+// See also: [EditableBase.GetPropertyXalign]
 func (x *Entry) SetAlignment(XalignVar float32) {
 
 	xEntrySetAlignment(x.GoPointer(), XalignVar)
@@ -681,24 +685,10 @@ func (x *Entry) SetBuffer(BufferVar *EntryBuffer) {
 
 }
 
-var xEntrySetCompletion func(uintptr, uintptr)
-
-// Sets @completion to be the auxiliary completion object
-// to use with @entry.
-//
-// All further configuration of the completion mechanism is
-// done on @completion using the `GtkEntryCompletion` API.
-// Completion is disabled if @completion is set to %NULL.
-func (x *Entry) SetCompletion(CompletionVar *EntryCompletion) {
-
-	xEntrySetCompletion(x.GoPointer(), CompletionVar.GoPointer())
-
-}
-
 var xEntrySetExtraMenu func(uintptr, uintptr)
 
 // Sets a menu model to add when constructing
-// the context menu for @entry.
+// the context menu for entry.
 func (x *Entry) SetExtraMenu(ModelVar *gio.MenuModel) {
 
 	xEntrySetExtraMenu(x.GoPointer(), ModelVar.GoPointer())
@@ -743,7 +733,7 @@ var xEntrySetIconFromGicon func(uintptr, EntryIconPosition, uintptr)
 // If the icon isn’t known, a “broken image” icon will be
 // displayed instead.
 //
-// If @icon is %NULL, no icon will be shown in the
+// If icon is nil, no icon will be shown in the
 // specified position.
 func (x *Entry) SetIconFromGicon(IconPosVar EntryIconPosition, IconVar gio.Icon) {
 
@@ -759,7 +749,7 @@ var xEntrySetIconFromIconName func(uintptr, EntryIconPosition, uintptr)
 // If the icon name isn’t known, a “broken image” icon will be
 // displayed instead.
 //
-// If @icon_name is %NULL, no icon will be shown in the
+// If icon_name is nil, no icon will be shown in the
 // specified position.
 func (x *Entry) SetIconFromIconName(IconPosVar EntryIconPosition, IconNameVar *string) {
 
@@ -774,7 +764,7 @@ var xEntrySetIconFromPaintable func(uintptr, EntryIconPosition, uintptr)
 
 // Sets the icon shown in the specified position using a `GdkPaintable`.
 //
-// If @paintable is %NULL, no icon will be shown in the specified position.
+// If paintable is nil, no icon will be shown in the specified position.
 func (x *Entry) SetIconFromPaintable(IconPosVar EntryIconPosition, PaintableVar gdk.Paintable) {
 
 	xEntrySetIconFromPaintable(x.GoPointer(), IconPosVar, PaintableVar.GoPointer())
@@ -792,15 +782,15 @@ func (x *Entry) SetIconSensitive(IconPosVar EntryIconPosition, SensitiveVar bool
 
 var xEntrySetIconTooltipMarkup func(uintptr, EntryIconPosition, uintptr)
 
-// Sets @tooltip as the contents of the tooltip for the icon at
+// Sets tooltip as the contents of the tooltip for the icon at
 // the specified position.
 //
-// @tooltip is assumed to be marked up with Pango Markup.
+// tooltip is assumed to be marked up with Pango Markup.
 //
-// Use %NULL for @tooltip to remove an existing tooltip.
+// Use nil for tooltip to remove an existing tooltip.
 //
-// See also [method@Gtk.Widget.set_tooltip_markup] and
-// [method@Gtk.Entry.set_icon_tooltip_text].
+// See also [Widget.SetTooltipMarkup] and
+// [Entry.SetIconTooltipText].
 func (x *Entry) SetIconTooltipMarkup(IconPosVar EntryIconPosition, TooltipVar *string) {
 
 	TooltipVarPtr := core.GStrdupNullable(TooltipVar)
@@ -812,21 +802,21 @@ func (x *Entry) SetIconTooltipMarkup(IconPosVar EntryIconPosition, TooltipVar *s
 
 var xEntrySetIconTooltipText func(uintptr, EntryIconPosition, uintptr)
 
-// Sets @tooltip as the contents of the tooltip for the icon
+// Sets tooltip as the contents of the tooltip for the icon
 // at the specified position.
 //
-// Use %NULL for @tooltip to remove an existing tooltip.
+// Use nil for tooltip to remove an existing tooltip.
 //
-// See also [method@Gtk.Widget.set_tooltip_text] and
-// [method@Gtk.Entry.set_icon_tooltip_markup].
+// See also [Widget.SetTooltipText] and
+// [Entry.SetIconTooltipMarkup].
 //
 // If you unset the widget tooltip via
-// [method@Gtk.Widget.set_tooltip_text] or
-// [method@Gtk.Widget.set_tooltip_markup], this sets
-// [property@Gtk.Widget:has-tooltip] to %FALSE, which suppresses
+// [Widget.SetTooltipText] or
+// [Widget.SetTooltipMarkup], this sets
+// [Widget.GetPropertyHasTooltip] to false, which suppresses
 // icon tooltips too. You can resolve this by then calling
-// [method@Gtk.Widget.set_has_tooltip] to set
-// [property@Gtk.Widget:has-tooltip] back to %TRUE, or
+// [Widget.SetHasTooltip] to set
+// [Widget.GetPropertyHasTooltip] back to true, or
 // setting at least one non-empty tooltip on any icon
 // achieves the same result.
 func (x *Entry) SetIconTooltipText(IconPosVar EntryIconPosition, TooltipVar *string) {
@@ -858,19 +848,19 @@ func (x *Entry) SetInputPurpose(PurposeVar InputPurpose) {
 
 }
 
-var xEntrySetInvisibleChar func(uintptr, uint32)
+var xEntrySetInvisibleChar func(uintptr, rune)
 
 // Sets the character to use in place of the actual text
 // in “password mode”.
 //
-// See [method@Gtk.Entry.set_visibility] for how to enable
+// See [Entry.SetVisibility] for how to enable
 // “password mode”.
 //
 // By default, GTK picks the best invisible char available in
 // the current font. If you set the invisible char to 0, then
 // the user will get no feedback at all; there will be no text
 // on the screen as they type.
-func (x *Entry) SetInvisibleChar(ChVar uint32) {
+func (x *Entry) SetInvisibleChar(ChVar rune) {
 
 	xEntrySetInvisibleChar(x.GoPointer(), ChVar)
 
@@ -883,8 +873,8 @@ var xEntrySetMaxLength func(uintptr, int)
 // If the current contents are longer than the given length, then
 // they will be truncated to fit. The length is in characters.
 //
-// This is equivalent to getting @entry's `GtkEntryBuffer` and
-// calling [method@Gtk.EntryBuffer.set_max_length] on it.
+// This is equivalent to getting entry's `GtkEntryBuffer` and
+// calling [EntryBuffer.SetMaxLength] on it.
 func (x *Entry) SetMaxLength(MaxVar int) {
 
 	xEntrySetMaxLength(x.GoPointer(), MaxVar)
@@ -916,7 +906,7 @@ func (x *Entry) SetOverwriteMode(OverwriteVar bool) {
 
 var xEntrySetPlaceholderText func(uintptr, uintptr)
 
-// Sets text to be displayed in @entry when it is empty.
+// Sets text to be displayed in entry when it is empty.
 //
 // This can be used to give a visual hint of the expected
 // contents of the `GtkEntry`.
@@ -946,7 +936,7 @@ var xEntrySetProgressPulseStep func(uintptr, float64)
 // Sets the fraction of total entry width to move the progress
 // bouncing block for each pulse.
 //
-// Use [method@Gtk.Entry.progress_pulse] to pulse
+// Use [Entry.ProgressPulse] to pulse
 // the progress.
 func (x *Entry) SetProgressPulseStep(FractionVar float64) {
 
@@ -969,18 +959,18 @@ var xEntrySetVisibility func(uintptr, bool)
 
 // Sets whether the contents of the entry are visible or not.
 //
-// When visibility is set to %FALSE, characters are displayed
+// When visibility is set to false, characters are displayed
 // as the invisible char, and will also appear that way when
 // the text in the entry widget is copied elsewhere.
 //
 // By default, GTK picks the best invisible character available
 // in the current font, but it can be changed with
-// [method@Gtk.Entry.set_invisible_char].
+// [Entry.SetInvisibleChar].
 //
-// Note that you probably want to set [property@Gtk.Entry:input-purpose]
-// to %GTK_INPUT_PURPOSE_PASSWORD or %GTK_INPUT_PURPOSE_PIN to
+// Note that you probably want to set Entry:input-purpose
+// to [InputPurposePasswordValue] or [InputPurposePinValue] to
 // inform input methods about the purpose of this entry,
-// in addition to setting visibility to %FALSE.
+// in addition to setting visibility to false.
 func (x *Entry) SetVisibility(VisibleVar bool) {
 
 	xEntrySetVisibility(x.GoPointer(), VisibleVar)
@@ -990,7 +980,7 @@ func (x *Entry) SetVisibility(VisibleVar bool) {
 var xEntryUnsetInvisibleChar func(uintptr)
 
 // Unsets the invisible char, so that the default invisible char
-// is used again. See [method@Gtk.Entry.set_invisible_char].
+// is used again. See [Entry.SetInvisibleChar].
 func (x *Entry) UnsetInvisibleChar() {
 
 	xEntryUnsetInvisibleChar(x.GoPointer())
@@ -1030,8 +1020,8 @@ func (x *Entry) GetPropertyActivatesDefault() bool {
 //
 // This is mainly useful to change the size or weight of the text.
 //
-// The `PangoAttribute`'s @start_index and @end_index must refer to the
-// [class@Gtk.EntryBuffer] text, i.e. without the preedit string.
+// The `PangoAttribute`'s start_index and end_index must refer to the
+// [EntryBuffer] text, i.e. without the preedit string.
 func (x *Entry) SetPropertyAttributes(value uintptr) {
 	var v gobject.Value
 	v.Init(gobject.TypePointerVal)
@@ -1044,8 +1034,8 @@ func (x *Entry) SetPropertyAttributes(value uintptr) {
 //
 // This is mainly useful to change the size or weight of the text.
 //
-// The `PangoAttribute`'s @start_index and @end_index must refer to the
-// [class@Gtk.EntryBuffer] text, i.e. without the preedit string.
+// The `PangoAttribute`'s start_index and end_index must refer to the
+// [EntryBuffer] text, i.e. without the preedit string.
 func (x *Entry) GetPropertyAttributes() uintptr {
 	var v gobject.Value
 	x.GetProperty("attributes", &v)
@@ -1091,10 +1081,10 @@ func (x *Entry) GetPropertyHasFrame() bool {
 // SetPropertyImModule sets the "im-module" property.
 // Which IM (input method) module should be used for this entry.
 //
-// See [class@Gtk.IMContext].
+// See [IMContext].
 //
-// Setting this to a non-%NULL value overrides the system-wide IM
-// module setting. See the GtkSettings [property@Gtk.Settings:gtk-im-module]
+// Setting this to a non-nil value overrides the system-wide IM
+// module setting. See the GtkSettings [Settings.GetPropertyGtkImModule]
 // property.
 func (x *Entry) SetPropertyImModule(value string) {
 	var v gobject.Value
@@ -1106,10 +1096,10 @@ func (x *Entry) SetPropertyImModule(value string) {
 // GetPropertyImModule gets the "im-module" property.
 // Which IM (input method) module should be used for this entry.
 //
-// See [class@Gtk.IMContext].
+// See [IMContext].
 //
-// Setting this to a non-%NULL value overrides the system-wide IM
-// module setting. See the GtkSettings [property@Gtk.Settings:gtk-im-module]
+// Setting this to a non-nil value overrides the system-wide IM
+// module setting. See the GtkSettings [Settings.GetPropertyGtkImModule]
 // property.
 func (x *Entry) GetPropertyImModule() string {
 	var v gobject.Value
@@ -1285,8 +1275,8 @@ func (x *Entry) GetPropertyPlaceholderText() string {
 // SetPropertyPrimaryIconActivatable sets the "primary-icon-activatable" property.
 // Whether the primary icon is activatable.
 //
-// GTK emits the [signal@Gtk.Entry::icon-press] and
-// [signal@Gtk.Entry::icon-release] signals only on sensitive,
+// GTK emits the [Entry.ConnectIconPressFunc] and
+// [Entry.ConnectIconReleaseFunc] signals only on sensitive,
 // activatable icons.
 //
 // Sensitive, but non-activatable icons can be used for purely
@@ -1301,8 +1291,8 @@ func (x *Entry) SetPropertyPrimaryIconActivatable(value bool) {
 // GetPropertyPrimaryIconActivatable gets the "primary-icon-activatable" property.
 // Whether the primary icon is activatable.
 //
-// GTK emits the [signal@Gtk.Entry::icon-press] and
-// [signal@Gtk.Entry::icon-release] signals only on sensitive,
+// GTK emits the [Entry.ConnectIconPressFunc] and
+// [Entry.ConnectIconReleaseFunc] signals only on sensitive,
 // activatable icons.
 //
 // Sensitive, but non-activatable icons can be used for purely
@@ -1334,7 +1324,7 @@ func (x *Entry) GetPropertyPrimaryIconName() string {
 // Whether the primary icon is sensitive.
 //
 // An insensitive icon appears grayed out. GTK does not emit the
-// [signal@Gtk.Entry::icon-press] and [signal@Gtk.Entry::icon-release]
+// [Entry.ConnectIconPressFunc] and [Entry.ConnectIconReleaseFunc]
 // signals and does not allow DND from insensitive icons.
 //
 // An icon should be set insensitive if the action that would trigger
@@ -1350,7 +1340,7 @@ func (x *Entry) SetPropertyPrimaryIconSensitive(value bool) {
 // Whether the primary icon is sensitive.
 //
 // An insensitive icon appears grayed out. GTK does not emit the
-// [signal@Gtk.Entry::icon-press] and [signal@Gtk.Entry::icon-release]
+// [Entry.ConnectIconPressFunc] and [Entry.ConnectIconReleaseFunc]
 // signals and does not allow DND from insensitive icons.
 //
 // An icon should be set insensitive if the action that would trigger
@@ -1364,7 +1354,7 @@ func (x *Entry) GetPropertyPrimaryIconSensitive() bool {
 // SetPropertyPrimaryIconTooltipMarkup sets the "primary-icon-tooltip-markup" property.
 // The contents of the tooltip on the primary icon, with markup.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_markup].
+// Also see [Entry.SetIconTooltipMarkup].
 func (x *Entry) SetPropertyPrimaryIconTooltipMarkup(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1375,7 +1365,7 @@ func (x *Entry) SetPropertyPrimaryIconTooltipMarkup(value string) {
 // GetPropertyPrimaryIconTooltipMarkup gets the "primary-icon-tooltip-markup" property.
 // The contents of the tooltip on the primary icon, with markup.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_markup].
+// Also see [Entry.SetIconTooltipMarkup].
 func (x *Entry) GetPropertyPrimaryIconTooltipMarkup() string {
 	var v gobject.Value
 	x.GetProperty("primary-icon-tooltip-markup", &v)
@@ -1385,7 +1375,7 @@ func (x *Entry) GetPropertyPrimaryIconTooltipMarkup() string {
 // SetPropertyPrimaryIconTooltipText sets the "primary-icon-tooltip-text" property.
 // The contents of the tooltip on the primary icon.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_text].
+// Also see [Entry.SetIconTooltipText].
 func (x *Entry) SetPropertyPrimaryIconTooltipText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1396,7 +1386,7 @@ func (x *Entry) SetPropertyPrimaryIconTooltipText(value string) {
 // GetPropertyPrimaryIconTooltipText gets the "primary-icon-tooltip-text" property.
 // The contents of the tooltip on the primary icon.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_text].
+// Also see [Entry.SetIconTooltipText].
 func (x *Entry) GetPropertyPrimaryIconTooltipText() string {
 	var v gobject.Value
 	x.GetProperty("primary-icon-tooltip-text", &v)
@@ -1424,7 +1414,7 @@ func (x *Entry) GetPropertyProgressFraction() float64 {
 // The fraction of total entry width to move the progress
 // bouncing block for each pulse.
 //
-// See [method@Gtk.Entry.progress_pulse].
+// See [Entry.ProgressPulse].
 func (x *Entry) SetPropertyProgressPulseStep(value float64) {
 	var v gobject.Value
 	v.Init(gobject.TypeDoubleVal)
@@ -1436,7 +1426,7 @@ func (x *Entry) SetPropertyProgressPulseStep(value float64) {
 // The fraction of total entry width to move the progress
 // bouncing block for each pulse.
 //
-// See [method@Gtk.Entry.progress_pulse].
+// See [Entry.ProgressPulse].
 func (x *Entry) GetPropertyProgressPulseStep() float64 {
 	var v gobject.Value
 	x.GetProperty("progress-pulse-step", &v)
@@ -1454,8 +1444,8 @@ func (x *Entry) GetPropertyScrollOffset() int {
 // SetPropertySecondaryIconActivatable sets the "secondary-icon-activatable" property.
 // Whether the secondary icon is activatable.
 //
-// GTK emits the [signal@Gtk.Entry::icon-press] and
-// [signal@Gtk.Entry::icon-release] signals only on sensitive,
+// GTK emits the [Entry.ConnectIconPressFunc] and
+// [Entry.ConnectIconReleaseFunc] signals only on sensitive,
 // activatable icons.
 //
 // Sensitive, but non-activatable icons can be used for purely
@@ -1470,8 +1460,8 @@ func (x *Entry) SetPropertySecondaryIconActivatable(value bool) {
 // GetPropertySecondaryIconActivatable gets the "secondary-icon-activatable" property.
 // Whether the secondary icon is activatable.
 //
-// GTK emits the [signal@Gtk.Entry::icon-press] and
-// [signal@Gtk.Entry::icon-release] signals only on sensitive,
+// GTK emits the [Entry.ConnectIconPressFunc] and
+// [Entry.ConnectIconReleaseFunc] signals only on sensitive,
 // activatable icons.
 //
 // Sensitive, but non-activatable icons can be used for purely
@@ -1503,7 +1493,7 @@ func (x *Entry) GetPropertySecondaryIconName() string {
 // Whether the secondary icon is sensitive.
 //
 // An insensitive icon appears grayed out. GTK does not emit the
-// [signal@Gtk.Entry::icon-press[ and [signal@Gtk.Entry::icon-release]
+// [signal@Gtk.Entry::icon-press[ and [Entry.ConnectIconReleaseFunc]
 // signals and does not allow DND from insensitive icons.
 //
 // An icon should be set insensitive if the action that would trigger
@@ -1519,7 +1509,7 @@ func (x *Entry) SetPropertySecondaryIconSensitive(value bool) {
 // Whether the secondary icon is sensitive.
 //
 // An insensitive icon appears grayed out. GTK does not emit the
-// [signal@Gtk.Entry::icon-press[ and [signal@Gtk.Entry::icon-release]
+// [signal@Gtk.Entry::icon-press[ and [Entry.ConnectIconReleaseFunc]
 // signals and does not allow DND from insensitive icons.
 //
 // An icon should be set insensitive if the action that would trigger
@@ -1533,7 +1523,7 @@ func (x *Entry) GetPropertySecondaryIconSensitive() bool {
 // SetPropertySecondaryIconTooltipMarkup sets the "secondary-icon-tooltip-markup" property.
 // The contents of the tooltip on the secondary icon, with markup.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_markup].
+// Also see [Entry.SetIconTooltipMarkup].
 func (x *Entry) SetPropertySecondaryIconTooltipMarkup(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1544,7 +1534,7 @@ func (x *Entry) SetPropertySecondaryIconTooltipMarkup(value string) {
 // GetPropertySecondaryIconTooltipMarkup gets the "secondary-icon-tooltip-markup" property.
 // The contents of the tooltip on the secondary icon, with markup.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_markup].
+// Also see [Entry.SetIconTooltipMarkup].
 func (x *Entry) GetPropertySecondaryIconTooltipMarkup() string {
 	var v gobject.Value
 	x.GetProperty("secondary-icon-tooltip-markup", &v)
@@ -1554,7 +1544,7 @@ func (x *Entry) GetPropertySecondaryIconTooltipMarkup() string {
 // SetPropertySecondaryIconTooltipText sets the "secondary-icon-tooltip-text" property.
 // The contents of the tooltip on the secondary icon.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_text].
+// Also see [Entry.SetIconTooltipText].
 func (x *Entry) SetPropertySecondaryIconTooltipText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1565,7 +1555,7 @@ func (x *Entry) SetPropertySecondaryIconTooltipText(value string) {
 // GetPropertySecondaryIconTooltipText gets the "secondary-icon-tooltip-text" property.
 // The contents of the tooltip on the secondary icon.
 //
-// Also see [method@Gtk.Entry.set_icon_tooltip_text].
+// Also see [Entry.SetIconTooltipText].
 func (x *Entry) GetPropertySecondaryIconTooltipText() string {
 	var v gobject.Value
 	x.GetProperty("secondary-icon-tooltip-text", &v)
@@ -1617,7 +1607,7 @@ func (x *Entry) GetPropertyTextLength() uint {
 }
 
 // SetPropertyTruncateMultiline sets the "truncate-multiline" property.
-// When %TRUE, pasted multi-line text is truncated to the first line.
+// When true, pasted multi-line text is truncated to the first line.
 func (x *Entry) SetPropertyTruncateMultiline(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1626,7 +1616,7 @@ func (x *Entry) SetPropertyTruncateMultiline(value bool) {
 }
 
 // GetPropertyTruncateMultiline gets the "truncate-multiline" property.
-// When %TRUE, pasted multi-line text is truncated to the first line.
+// When true, pasted multi-line text is truncated to the first line.
 func (x *Entry) GetPropertyTruncateMultiline() bool {
 	var v gobject.Value
 	x.GetProperty("truncate-multiline", &v)
@@ -1655,76 +1645,142 @@ func (x *Entry) GetPropertyVisibility() bool {
 // Emitted when the entry is activated.
 //
 // The keybindings for this signal are all forms of the Enter key.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Entry) ConnectActivate(cb *func(Entry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Entry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEntryActivateTrampoline uintptr
+
+func xEntryActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Entry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Entry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Entry) ConnectActivateFunc(cb func(Entry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xEntryActivateTrampoline, xEntryActivateNewTrampoline, cb)
 }
 
 // Emitted when an activatable icon is clicked.
+//
+// Deprecated: use ConnectIconPressFunc, which also accepts method values and closures.
 func (x *Entry) ConnectIconPress(cb *func(Entry, EntryIconPosition)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "icon-press", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, IconPosVarp EntryIconPosition) {
+			defer core.RecoverPanic()
+			fa := Entry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, IconPosVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "icon-press", cbRefPtr, cbPtr)
+}
+
+var xEntryIconPressTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, IconPosVarp EntryIconPosition) {
+func xEntryIconPressNewTrampoline() interface{} {
+	return func(clsPtr uintptr, IconPosVarp EntryIconPosition, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Entry, EntryIconPosition))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Entry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, IconPosVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "icon-press", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectIconPressFunc connects cb to the "icon-press" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Entry) ConnectIconPressFunc(cb func(Entry, EntryIconPosition)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "icon-press", &xEntryIconPressTrampoline, xEntryIconPressNewTrampoline, cb)
 }
 
 // Emitted on the button release from a mouse click
 // over an activatable icon.
+//
+// Deprecated: use ConnectIconReleaseFunc, which also accepts method values and closures.
 func (x *Entry) ConnectIconRelease(cb *func(Entry, EntryIconPosition)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "icon-release", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, IconPosVarp EntryIconPosition) {
+			defer core.RecoverPanic()
+			fa := Entry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, IconPosVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "icon-release", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, IconPosVarp EntryIconPosition) {
+var xEntryIconReleaseTrampoline uintptr
+
+func xEntryIconReleaseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, IconPosVarp EntryIconPosition, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Entry, EntryIconPosition))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Entry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, IconPosVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "icon-release", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectIconReleaseFunc connects cb to the "icon-release" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Entry) ConnectIconReleaseFunc(cb func(Entry, EntryIconPosition)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "icon-release", &xEntryIconReleaseTrampoline, xEntryIconReleaseNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1888,7 +1944,7 @@ func (x *Entry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1896,14 +1952,13 @@ func (x *Entry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Entry) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1927,19 +1982,17 @@ func (x *Entry) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Entry) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1960,7 +2013,7 @@ func (x *Entry) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1969,14 +2022,12 @@ func (x *Entry) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Entry) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1995,10 +2046,10 @@ func (x *Entry) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Entry) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -2006,6 +2057,8 @@ func (x *Entry) GetBuildableId() string {
 }
 
 // Emits the `GtkCellEditable::editing-done` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *Entry) EditingDone() {
 
 	XGtkCellEditableEditingDone(x.GoPointer())
@@ -2013,21 +2066,23 @@ func (x *Entry) EditingDone() {
 }
 
 // Emits the `GtkCellEditable::remove-widget` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *Entry) RemoveWidget() {
 
 	XGtkCellEditableRemoveWidget(x.GoPointer())
 
 }
 
-// Begins editing on a @cell_editable.
+// Begins editing on a cell_editable.
 //
 // The `GtkCellRenderer` for the cell creates and returns a `GtkCellEditable` from
-// gtk_cell_renderer_start_editing(), configured for the `GtkCellRenderer` type.
+// [CellRenderer.StartEditing], configured for the `GtkCellRenderer` type.
 //
-// gtk_cell_editable_start_editing() can then set up @cell_editable suitably for
+// [CellEditable.StartEditing] can then set up cell_editable suitably for
 // editing a cell, e.g. making the Esc key emit `GtkCellEditable::editing-done`.
 //
-// Note that the @cell_editable is created on-demand for the current edit; its
+// Note that the cell_editable is created on-demand for the current edit; its
 // lifetime is temporary and does not persist across other edits and/or cells.
 func (x *Entry) StartEditing(EventVar *gdk.Event) {
 
@@ -2041,35 +2096,29 @@ func (x *Entry) StartEditing(EventVar *gdk.Event) {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *Entry) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -2089,8 +2138,8 @@ func (x *Entry) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -2100,7 +2149,7 @@ func (x *Entry) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -2113,8 +2162,8 @@ func (x *Entry) FinishDelegate() {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -2124,10 +2173,10 @@ func (x *Entry) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *Entry) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -2142,21 +2191,21 @@ func (x *Entry) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *Entry) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *Entry) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *Entry) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -2175,9 +2224,9 @@ func (x *Entry) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *Entry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -2186,7 +2235,7 @@ func (x *Entry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *Entry) GetText() string {
@@ -2206,7 +2255,7 @@ func (x *Entry) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -2216,11 +2265,11 @@ func (x *Entry) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *Entry) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -2231,9 +2280,9 @@ func (x *Entry) InsertText(TextVar string, LengthVar int, PositionVar int) {
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *Entry) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -2249,19 +2298,19 @@ func (x *Entry) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *Entry) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *Entry) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -2274,7 +2323,7 @@ func (x *Entry) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *Entry) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -2291,11 +2340,11 @@ func (x *Entry) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *Entry) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -2307,7 +2356,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2318,68 +2367,68 @@ func init() {
 
 	core.PuregoSafeRegister(&xEntryGLibType, libs, "gtk_entry_get_type")
 
-	core.PuregoSafeRegister(&xNewEntry, libs, "gtk_entry_new")
-	core.PuregoSafeRegister(&xNewEntryWithBuffer, libs, "gtk_entry_new_with_buffer")
-
-	core.PuregoSafeRegister(&xEntryGetActivatesDefault, libs, "gtk_entry_get_activates_default")
-	core.PuregoSafeRegister(&xEntryGetAlignment, libs, "gtk_entry_get_alignment")
-	core.PuregoSafeRegister(&xEntryGetAttributes, libs, "gtk_entry_get_attributes")
-	core.PuregoSafeRegister(&xEntryGetBuffer, libs, "gtk_entry_get_buffer")
-	core.PuregoSafeRegister(&xEntryGetCompletion, libs, "gtk_entry_get_completion")
-	core.PuregoSafeRegister(&xEntryGetCurrentIconDragSource, libs, "gtk_entry_get_current_icon_drag_source")
-	core.PuregoSafeRegister(&xEntryGetExtraMenu, libs, "gtk_entry_get_extra_menu")
-	core.PuregoSafeRegister(&xEntryGetHasFrame, libs, "gtk_entry_get_has_frame")
-	core.PuregoSafeRegister(&xEntryGetIconActivatable, libs, "gtk_entry_get_icon_activatable")
-	core.PuregoSafeRegister(&xEntryGetIconArea, libs, "gtk_entry_get_icon_area")
-	core.PuregoSafeRegister(&xEntryGetIconAtPos, libs, "gtk_entry_get_icon_at_pos")
-	core.PuregoSafeRegister(&xEntryGetIconGicon, libs, "gtk_entry_get_icon_gicon")
-	core.PuregoSafeRegister(&xEntryGetIconName, libs, "gtk_entry_get_icon_name")
-	core.PuregoSafeRegister(&xEntryGetIconPaintable, libs, "gtk_entry_get_icon_paintable")
-	core.PuregoSafeRegister(&xEntryGetIconSensitive, libs, "gtk_entry_get_icon_sensitive")
-	core.PuregoSafeRegister(&xEntryGetIconStorageType, libs, "gtk_entry_get_icon_storage_type")
-	core.PuregoSafeRegister(&xEntryGetIconTooltipMarkup, libs, "gtk_entry_get_icon_tooltip_markup")
-	core.PuregoSafeRegister(&xEntryGetIconTooltipText, libs, "gtk_entry_get_icon_tooltip_text")
-	core.PuregoSafeRegister(&xEntryGetInputHints, libs, "gtk_entry_get_input_hints")
-	core.PuregoSafeRegister(&xEntryGetInputPurpose, libs, "gtk_entry_get_input_purpose")
-	core.PuregoSafeRegister(&xEntryGetInvisibleChar, libs, "gtk_entry_get_invisible_char")
-	core.PuregoSafeRegister(&xEntryGetMaxLength, libs, "gtk_entry_get_max_length")
-	core.PuregoSafeRegister(&xEntryGetMenuEntryIconText, libs, "gtk_entry_get_menu_entry_icon_text")
-	core.PuregoSafeRegister(&xEntryGetOverwriteMode, libs, "gtk_entry_get_overwrite_mode")
-	core.PuregoSafeRegister(&xEntryGetPlaceholderText, libs, "gtk_entry_get_placeholder_text")
-	core.PuregoSafeRegister(&xEntryGetProgressFraction, libs, "gtk_entry_get_progress_fraction")
-	core.PuregoSafeRegister(&xEntryGetProgressPulseStep, libs, "gtk_entry_get_progress_pulse_step")
-	core.PuregoSafeRegister(&xEntryGetTabs, libs, "gtk_entry_get_tabs")
-	core.PuregoSafeRegister(&xEntryGetTextLength, libs, "gtk_entry_get_text_length")
-	core.PuregoSafeRegister(&xEntryGetVisibility, libs, "gtk_entry_get_visibility")
-	core.PuregoSafeRegister(&xEntryGrabFocusWithoutSelecting, libs, "gtk_entry_grab_focus_without_selecting")
-	core.PuregoSafeRegister(&xEntryProgressPulse, libs, "gtk_entry_progress_pulse")
-	core.PuregoSafeRegister(&xEntryResetImContext, libs, "gtk_entry_reset_im_context")
-	core.PuregoSafeRegister(&xEntrySetActivatesDefault, libs, "gtk_entry_set_activates_default")
-	core.PuregoSafeRegister(&xEntrySetAlignment, libs, "gtk_entry_set_alignment")
-	core.PuregoSafeRegister(&xEntrySetAttributes, libs, "gtk_entry_set_attributes")
-	core.PuregoSafeRegister(&xEntrySetBuffer, libs, "gtk_entry_set_buffer")
-	core.PuregoSafeRegister(&xEntrySetCompletion, libs, "gtk_entry_set_completion")
-	core.PuregoSafeRegister(&xEntrySetExtraMenu, libs, "gtk_entry_set_extra_menu")
-	core.PuregoSafeRegister(&xEntrySetHasFrame, libs, "gtk_entry_set_has_frame")
-	core.PuregoSafeRegister(&xEntrySetIconActivatable, libs, "gtk_entry_set_icon_activatable")
-	core.PuregoSafeRegister(&xEntrySetIconDragSource, libs, "gtk_entry_set_icon_drag_source")
-	core.PuregoSafeRegister(&xEntrySetIconFromGicon, libs, "gtk_entry_set_icon_from_gicon")
-	core.PuregoSafeRegister(&xEntrySetIconFromIconName, libs, "gtk_entry_set_icon_from_icon_name")
-	core.PuregoSafeRegister(&xEntrySetIconFromPaintable, libs, "gtk_entry_set_icon_from_paintable")
-	core.PuregoSafeRegister(&xEntrySetIconSensitive, libs, "gtk_entry_set_icon_sensitive")
-	core.PuregoSafeRegister(&xEntrySetIconTooltipMarkup, libs, "gtk_entry_set_icon_tooltip_markup")
-	core.PuregoSafeRegister(&xEntrySetIconTooltipText, libs, "gtk_entry_set_icon_tooltip_text")
-	core.PuregoSafeRegister(&xEntrySetInputHints, libs, "gtk_entry_set_input_hints")
-	core.PuregoSafeRegister(&xEntrySetInputPurpose, libs, "gtk_entry_set_input_purpose")
-	core.PuregoSafeRegister(&xEntrySetInvisibleChar, libs, "gtk_entry_set_invisible_char")
-	core.PuregoSafeRegister(&xEntrySetMaxLength, libs, "gtk_entry_set_max_length")
-	core.PuregoSafeRegister(&xEntrySetMenuEntryIconText, libs, "gtk_entry_set_menu_entry_icon_text")
-	core.PuregoSafeRegister(&xEntrySetOverwriteMode, libs, "gtk_entry_set_overwrite_mode")
-	core.PuregoSafeRegister(&xEntrySetPlaceholderText, libs, "gtk_entry_set_placeholder_text")
-	core.PuregoSafeRegister(&xEntrySetProgressFraction, libs, "gtk_entry_set_progress_fraction")
-	core.PuregoSafeRegister(&xEntrySetProgressPulseStep, libs, "gtk_entry_set_progress_pulse_step")
-	core.PuregoSafeRegister(&xEntrySetTabs, libs, "gtk_entry_set_tabs")
-	core.PuregoSafeRegister(&xEntrySetVisibility, libs, "gtk_entry_set_visibility")
-	core.PuregoSafeRegister(&xEntryUnsetInvisibleChar, libs, "gtk_entry_unset_invisible_char")
+	gobject.CheckClassSize("GtkEntryClass", xEntryGLibType, unsafe.Sizeof(EntryClass{}))
+
+	core.PuregoRegisterSince(&xNewEntry, libs, "gtk_entry_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewEntryWithBuffer, libs, "gtk_entry_new_with_buffer", "GTK", "")
+
+	core.PuregoRegisterSince(&xEntryGetActivatesDefault, libs, "gtk_entry_get_activates_default", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetAlignment, libs, "gtk_entry_get_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetAttributes, libs, "gtk_entry_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetBuffer, libs, "gtk_entry_get_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetCurrentIconDragSource, libs, "gtk_entry_get_current_icon_drag_source", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetExtraMenu, libs, "gtk_entry_get_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetHasFrame, libs, "gtk_entry_get_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconActivatable, libs, "gtk_entry_get_icon_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconArea, libs, "gtk_entry_get_icon_area", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconAtPos, libs, "gtk_entry_get_icon_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconGicon, libs, "gtk_entry_get_icon_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconName, libs, "gtk_entry_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconPaintable, libs, "gtk_entry_get_icon_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconSensitive, libs, "gtk_entry_get_icon_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconStorageType, libs, "gtk_entry_get_icon_storage_type", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconTooltipMarkup, libs, "gtk_entry_get_icon_tooltip_markup", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetIconTooltipText, libs, "gtk_entry_get_icon_tooltip_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetInputHints, libs, "gtk_entry_get_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetInputPurpose, libs, "gtk_entry_get_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetInvisibleChar, libs, "gtk_entry_get_invisible_char", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetMaxLength, libs, "gtk_entry_get_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetMenuEntryIconText, libs, "gtk_entry_get_menu_entry_icon_text", "GTK", "4.20")
+	core.PuregoRegisterSince(&xEntryGetOverwriteMode, libs, "gtk_entry_get_overwrite_mode", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetPlaceholderText, libs, "gtk_entry_get_placeholder_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetProgressFraction, libs, "gtk_entry_get_progress_fraction", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetProgressPulseStep, libs, "gtk_entry_get_progress_pulse_step", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetTabs, libs, "gtk_entry_get_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetTextLength, libs, "gtk_entry_get_text_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGetVisibility, libs, "gtk_entry_get_visibility", "GTK", "")
+	core.PuregoRegisterSince(&xEntryGrabFocusWithoutSelecting, libs, "gtk_entry_grab_focus_without_selecting", "GTK", "")
+	core.PuregoRegisterSince(&xEntryProgressPulse, libs, "gtk_entry_progress_pulse", "GTK", "")
+	core.PuregoRegisterSince(&xEntryResetImContext, libs, "gtk_entry_reset_im_context", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetActivatesDefault, libs, "gtk_entry_set_activates_default", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetAlignment, libs, "gtk_entry_set_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetAttributes, libs, "gtk_entry_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetBuffer, libs, "gtk_entry_set_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetExtraMenu, libs, "gtk_entry_set_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetHasFrame, libs, "gtk_entry_set_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconActivatable, libs, "gtk_entry_set_icon_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconDragSource, libs, "gtk_entry_set_icon_drag_source", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconFromGicon, libs, "gtk_entry_set_icon_from_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconFromIconName, libs, "gtk_entry_set_icon_from_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconFromPaintable, libs, "gtk_entry_set_icon_from_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconSensitive, libs, "gtk_entry_set_icon_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconTooltipMarkup, libs, "gtk_entry_set_icon_tooltip_markup", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetIconTooltipText, libs, "gtk_entry_set_icon_tooltip_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetInputHints, libs, "gtk_entry_set_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetInputPurpose, libs, "gtk_entry_set_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetInvisibleChar, libs, "gtk_entry_set_invisible_char", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetMaxLength, libs, "gtk_entry_set_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetMenuEntryIconText, libs, "gtk_entry_set_menu_entry_icon_text", "GTK", "4.20")
+	core.PuregoRegisterSince(&xEntrySetOverwriteMode, libs, "gtk_entry_set_overwrite_mode", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetPlaceholderText, libs, "gtk_entry_set_placeholder_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetProgressFraction, libs, "gtk_entry_set_progress_fraction", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetProgressPulseStep, libs, "gtk_entry_set_progress_pulse_step", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetTabs, libs, "gtk_entry_set_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetVisibility, libs, "gtk_entry_set_visibility", "GTK", "")
+	core.PuregoRegisterSince(&xEntryUnsetInvisibleChar, libs, "gtk_entry_unset_invisible_char", "GTK", "")
 
 }
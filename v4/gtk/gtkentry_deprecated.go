@@ -0,0 +1,62 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xEntryGetCompletion func(uintptr) uintptr
+
+// Returns the auxiliary completion object currently
+// in use by entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *Entry) GetCompletion() *EntryCompletion {
+	var cls *EntryCompletion
+
+	cret := xEntryGetCompletion(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &EntryCompletion{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xEntrySetCompletion func(uintptr, uintptr)
+
+// Sets completion to be the auxiliary completion object
+// to use with entry.
+//
+// All further configuration of the completion mechanism is
+// done on completion using the `GtkEntryCompletion` API.
+// Completion is disabled if completion is set to nil.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *Entry) SetCompletion(CompletionVar *EntryCompletion) {
+
+	xEntrySetCompletion(x.GoPointer(), CompletionVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xEntryGetCompletion, libs, "gtk_entry_get_completion", "GTK", "")
+	core.PuregoRegisterSince(&xEntrySetCompletion, libs, "gtk_entry_set_completion", "GTK", "")
+
+}
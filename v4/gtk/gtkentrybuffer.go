@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -55,7 +54,8 @@ func (x *EntryBufferClass) OverrideInsertedText(cb func(*EntryBuffer, uint, stri
 	if cb == nil {
 		x.xInsertedText = 0
 	} else {
-		x.xInsertedText = purego.NewCallback(func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) {
+		x.xInsertedText = core.NewCallback(func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) {
+			defer core.RecoverPanic()
 			cb(EntryBufferNewFromInternalPtr(BufferVarp), PositionVarp, CharsVarp, NCharsVarp)
 		})
 	}
@@ -67,7 +67,7 @@ func (x *EntryBufferClass) GetInsertedText() func(*EntryBuffer, uint, string, ui
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint)
-	purego.RegisterFunc(&rawCallback, x.xInsertedText)
+	core.RegisterFunc(&rawCallback, x.xInsertedText)
 	return func(BufferVar *EntryBuffer, PositionVar uint, CharsVar string, NCharsVar uint) {
 		rawCallback(BufferVar.GoPointer(), PositionVar, CharsVar, NCharsVar)
 	}
@@ -78,7 +78,8 @@ func (x *EntryBufferClass) OverrideDeletedText(cb func(*EntryBuffer, uint, uint)
 	if cb == nil {
 		x.xDeletedText = 0
 	} else {
-		x.xDeletedText = purego.NewCallback(func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint) {
+		x.xDeletedText = core.NewCallback(func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint) {
+			defer core.RecoverPanic()
 			cb(EntryBufferNewFromInternalPtr(BufferVarp), PositionVarp, NCharsVarp)
 		})
 	}
@@ -90,7 +91,7 @@ func (x *EntryBufferClass) GetDeletedText() func(*EntryBuffer, uint, uint) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint)
-	purego.RegisterFunc(&rawCallback, x.xDeletedText)
+	core.RegisterFunc(&rawCallback, x.xDeletedText)
 	return func(BufferVar *EntryBuffer, PositionVar uint, NCharsVar uint) {
 		rawCallback(BufferVar.GoPointer(), PositionVar, NCharsVar)
 	}
@@ -101,7 +102,8 @@ func (x *EntryBufferClass) OverrideGetText(cb func(*EntryBuffer, uint) string) {
 	if cb == nil {
 		x.xGetText = 0
 	} else {
-		x.xGetText = purego.NewCallback(func(BufferVarp uintptr, NBytesVarp uint) string {
+		x.xGetText = core.NewCallback(func(BufferVarp uintptr, NBytesVarp uint) string {
+			defer core.RecoverPanic()
 			return cb(EntryBufferNewFromInternalPtr(BufferVarp), NBytesVarp)
 		})
 	}
@@ -113,7 +115,7 @@ func (x *EntryBufferClass) GetGetText() func(*EntryBuffer, uint) string {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, NBytesVarp uint) string
-	purego.RegisterFunc(&rawCallback, x.xGetText)
+	core.RegisterFunc(&rawCallback, x.xGetText)
 	return func(BufferVar *EntryBuffer, NBytesVar uint) string {
 		return rawCallback(BufferVar.GoPointer(), NBytesVar)
 	}
@@ -124,7 +126,8 @@ func (x *EntryBufferClass) OverrideGetLength(cb func(*EntryBuffer) uint) {
 	if cb == nil {
 		x.xGetLength = 0
 	} else {
-		x.xGetLength = purego.NewCallback(func(BufferVarp uintptr) uint {
+		x.xGetLength = core.NewCallback(func(BufferVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(EntryBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -136,7 +139,7 @@ func (x *EntryBufferClass) GetGetLength() func(*EntryBuffer) uint {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xGetLength)
+	core.RegisterFunc(&rawCallback, x.xGetLength)
 	return func(BufferVar *EntryBuffer) uint {
 		return rawCallback(BufferVar.GoPointer())
 	}
@@ -147,7 +150,8 @@ func (x *EntryBufferClass) OverrideInsertText(cb func(*EntryBuffer, uint, string
 	if cb == nil {
 		x.xInsertText = 0
 	} else {
-		x.xInsertText = purego.NewCallback(func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) uint {
+		x.xInsertText = core.NewCallback(func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) uint {
+			defer core.RecoverPanic()
 			return cb(EntryBufferNewFromInternalPtr(BufferVarp), PositionVarp, CharsVarp, NCharsVarp)
 		})
 	}
@@ -159,7 +163,7 @@ func (x *EntryBufferClass) GetInsertText() func(*EntryBuffer, uint, string, uint
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) uint
-	purego.RegisterFunc(&rawCallback, x.xInsertText)
+	core.RegisterFunc(&rawCallback, x.xInsertText)
 	return func(BufferVar *EntryBuffer, PositionVar uint, CharsVar string, NCharsVar uint) uint {
 		return rawCallback(BufferVar.GoPointer(), PositionVar, CharsVar, NCharsVar)
 	}
@@ -170,7 +174,8 @@ func (x *EntryBufferClass) OverrideDeleteText(cb func(*EntryBuffer, uint, uint)
 	if cb == nil {
 		x.xDeleteText = 0
 	} else {
-		x.xDeleteText = purego.NewCallback(func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint) uint {
+		x.xDeleteText = core.NewCallback(func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint) uint {
+			defer core.RecoverPanic()
 			return cb(EntryBufferNewFromInternalPtr(BufferVarp), PositionVarp, NCharsVarp)
 		})
 	}
@@ -182,7 +187,7 @@ func (x *EntryBufferClass) GetDeleteText() func(*EntryBuffer, uint, uint) uint {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, PositionVarp uint, NCharsVarp uint) uint
-	purego.RegisterFunc(&rawCallback, x.xDeleteText)
+	core.RegisterFunc(&rawCallback, x.xDeleteText)
 	return func(BufferVar *EntryBuffer, PositionVar uint, NCharsVar uint) uint {
 		return rawCallback(BufferVar.GoPointer(), PositionVar, NCharsVar)
 	}
@@ -193,7 +198,8 @@ func (x *EntryBufferClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -205,7 +211,7 @@ func (x *EntryBufferClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -216,7 +222,8 @@ func (x *EntryBufferClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -228,7 +235,7 @@ func (x *EntryBufferClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -239,7 +246,8 @@ func (x *EntryBufferClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -251,7 +259,7 @@ func (x *EntryBufferClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -262,7 +270,8 @@ func (x *EntryBufferClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -274,7 +283,7 @@ func (x *EntryBufferClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -285,7 +294,8 @@ func (x *EntryBufferClass) OverrideGtkReserved5(cb func()) {
 	if cb == nil {
 		x.xGtkReserved5 = 0
 	} else {
-		x.xGtkReserved5 = purego.NewCallback(func() {
+		x.xGtkReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -297,7 +307,7 @@ func (x *EntryBufferClass) GetGtkReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved5)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -308,7 +318,8 @@ func (x *EntryBufferClass) OverrideGtkReserved6(cb func()) {
 	if cb == nil {
 		x.xGtkReserved6 = 0
 	} else {
-		x.xGtkReserved6 = purego.NewCallback(func() {
+		x.xGtkReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -320,7 +331,7 @@ func (x *EntryBufferClass) GetGtkReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved6)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -331,7 +342,8 @@ func (x *EntryBufferClass) OverrideGtkReserved7(cb func()) {
 	if cb == nil {
 		x.xGtkReserved7 = 0
 	} else {
-		x.xGtkReserved7 = purego.NewCallback(func() {
+		x.xGtkReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -343,7 +355,7 @@ func (x *EntryBufferClass) GetGtkReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved7)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -354,7 +366,8 @@ func (x *EntryBufferClass) OverrideGtkReserved8(cb func()) {
 	if cb == nil {
 		x.xGtkReserved8 = 0
 	} else {
-		x.xGtkReserved8 = purego.NewCallback(func() {
+		x.xGtkReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -366,7 +379,7 @@ func (x *EntryBufferClass) GetGtkReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved8)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -423,11 +436,11 @@ var xEntryBufferDeleteText func(uintptr, uint, int) uint
 
 // Deletes a sequence of characters from the buffer.
 //
-// @n_chars characters are deleted starting at @position.
-// If @n_chars is negative, then all characters until the
+// n_chars characters are deleted starting at position.
+// If n_chars is negative, then all characters until the
 // end of the text are deleted.
 //
-// If @position or @n_chars are out of bounds, then they
+// If position or n_chars are out of bounds, then they
 // are coerced to sane values.
 //
 // Note that the positions are specified in characters,
@@ -460,7 +473,7 @@ var xEntryBufferGetBytes func(uintptr) uint
 
 // Retrieves the length in bytes of the buffer.
 //
-// See [method@Gtk.EntryBuffer.get_length].
+// See [EntryBuffer.GetLength].
 func (x *EntryBuffer) GetBytes() uint {
 
 	cret := xEntryBufferGetBytes(x.GoPointer())
@@ -478,7 +491,7 @@ func (x *EntryBuffer) GetLength() uint {
 
 var xEntryBufferGetMaxLength func(uintptr) int
 
-// Retrieves the maximum allowed length of the text in @buffer.
+// Retrieves the maximum allowed length of the text in buffer.
 func (x *EntryBuffer) GetMaxLength() int {
 
 	cret := xEntryBufferGetMaxLength(x.GoPointer())
@@ -499,11 +512,11 @@ func (x *EntryBuffer) GetText() string {
 
 var xEntryBufferInsertText func(uintptr, uint, string, int) uint
 
-// Inserts @n_chars characters of @chars into the contents of the
-// buffer, at position @position.
+// Inserts n_chars characters of chars into the contents of the
+// buffer, at position position.
 //
-// If @n_chars is negative, then characters from chars will be inserted
-// until a null-terminator is found. If @position or @n_chars are out of
+// If n_chars is negative, then characters from chars will be inserted
+// until a null-terminator is found. If position or n_chars are out of
 // bounds, or the maximum buffer text length is exceeded, then they are
 // coerced to sane values.
 //
@@ -531,10 +544,10 @@ var xEntryBufferSetText func(uintptr, string, int)
 // Sets the text in the buffer.
 //
 // This is roughly equivalent to calling
-// [method@Gtk.EntryBuffer.delete_text] and
-// [method@Gtk.EntryBuffer.insert_text].
+// [EntryBuffer.DeleteText] and
+// [EntryBuffer.InsertText].
 //
-// Note that @n_chars is in characters, not in bytes.
+// Note that n_chars is in characters, not in bytes.
 func (x *EntryBuffer) SetText(CharsVar string, NCharsVar int) {
 
 	xEntryBufferSetText(x.GoPointer(), CharsVar, NCharsVar)
@@ -597,52 +610,96 @@ func (x *EntryBuffer) GetPropertyText() string {
 // The text is altered in the default handler for this signal.
 //
 // If you want access to the text after the text has been modified,
-// use %G_CONNECT_AFTER.
+// use [gobject.GConnectAfterValue].
+//
+// Deprecated: use ConnectDeletedTextFunc, which also accepts method values and closures.
 func (x *EntryBuffer) ConnectDeletedText(cb *func(EntryBuffer, uint, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "deleted-text", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp uint, NCharsVarp uint) {
+			defer core.RecoverPanic()
+			fa := EntryBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp, NCharsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "deleted-text", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PositionVarp uint, NCharsVarp uint) {
+var xEntryBufferDeletedTextTrampoline uintptr
+
+func xEntryBufferDeletedTextNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp uint, NCharsVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EntryBuffer, uint, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EntryBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp, NCharsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "deleted-text", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeletedTextFunc connects cb to the "deleted-text" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EntryBuffer) ConnectDeletedTextFunc(cb func(EntryBuffer, uint, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "deleted-text", &xEntryBufferDeletedTextTrampoline, xEntryBufferDeletedTextNewTrampoline, cb)
 }
 
 // This signal is emitted after text is inserted into the buffer.
+//
+// Deprecated: use ConnectInsertedTextFunc, which also accepts method values and closures.
 func (x *EntryBuffer) ConnectInsertedText(cb *func(EntryBuffer, uint, string, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "inserted-text", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) {
+			defer core.RecoverPanic()
+			fa := EntryBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp, CharsVarp, NCharsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "inserted-text", cbRefPtr, cbPtr)
+}
+
+var xEntryBufferInsertedTextTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint) {
+func xEntryBufferInsertedTextNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp uint, CharsVarp string, NCharsVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EntryBuffer, uint, string, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EntryBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp, CharsVarp, NCharsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "inserted-text", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertedTextFunc connects cb to the "inserted-text" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EntryBuffer) ConnectInsertedTextFunc(cb func(EntryBuffer, uint, string, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "inserted-text", &xEntryBufferInsertedTextTrampoline, xEntryBufferInsertedTextNewTrampoline, cb)
 }
 
 func init() {
@@ -650,7 +707,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -659,17 +716,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xEntryBufferGLibType, libs, "gtk_entry_buffer_get_type")
 
-	core.PuregoSafeRegister(&xNewEntryBuffer, libs, "gtk_entry_buffer_new")
-
-	core.PuregoSafeRegister(&xEntryBufferDeleteText, libs, "gtk_entry_buffer_delete_text")
-	core.PuregoSafeRegister(&xEntryBufferEmitDeletedText, libs, "gtk_entry_buffer_emit_deleted_text")
-	core.PuregoSafeRegister(&xEntryBufferEmitInsertedText, libs, "gtk_entry_buffer_emit_inserted_text")
-	core.PuregoSafeRegister(&xEntryBufferGetBytes, libs, "gtk_entry_buffer_get_bytes")
-	core.PuregoSafeRegister(&xEntryBufferGetLength, libs, "gtk_entry_buffer_get_length")
-	core.PuregoSafeRegister(&xEntryBufferGetMaxLength, libs, "gtk_entry_buffer_get_max_length")
-	core.PuregoSafeRegister(&xEntryBufferGetText, libs, "gtk_entry_buffer_get_text")
-	core.PuregoSafeRegister(&xEntryBufferInsertText, libs, "gtk_entry_buffer_insert_text")
-	core.PuregoSafeRegister(&xEntryBufferSetMaxLength, libs, "gtk_entry_buffer_set_max_length")
-	core.PuregoSafeRegister(&xEntryBufferSetText, libs, "gtk_entry_buffer_set_text")
+	gobject.CheckClassSize("GtkEntryBufferClass", xEntryBufferGLibType, unsafe.Sizeof(EntryBufferClass{}))
+
+	core.PuregoRegisterSince(&xNewEntryBuffer, libs, "gtk_entry_buffer_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xEntryBufferDeleteText, libs, "gtk_entry_buffer_delete_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferEmitDeletedText, libs, "gtk_entry_buffer_emit_deleted_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferEmitInsertedText, libs, "gtk_entry_buffer_emit_inserted_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferGetBytes, libs, "gtk_entry_buffer_get_bytes", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferGetLength, libs, "gtk_entry_buffer_get_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferGetMaxLength, libs, "gtk_entry_buffer_get_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferGetText, libs, "gtk_entry_buffer_get_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferInsertText, libs, "gtk_entry_buffer_insert_text", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferSetMaxLength, libs, "gtk_entry_buffer_set_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryBufferSetText, libs, "gtk_entry_buffer_set_text", "GTK", "")
 
 }
@@ -0,0 +1,425 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewEntryCompletion func() uintptr
+
+// Creates a new `GtkEntryCompletion` object.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func NewEntryCompletion() *EntryCompletion {
+	var cls *EntryCompletion
+
+	cret := xNewEntryCompletion()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &EntryCompletion{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewEntryCompletionWithArea func(uintptr) uintptr
+
+// Creates a new `GtkEntryCompletion` object using the
+// specified area.
+//
+// The `GtkCellArea` is used to layout cells in the underlying
+// `GtkTreeViewColumn` for the drop-down menu.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func NewEntryCompletionWithArea(AreaVar *CellArea) *EntryCompletion {
+	var cls *EntryCompletion
+
+	cret := xNewEntryCompletionWithArea(AreaVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &EntryCompletion{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xEntryCompletionComplete func(uintptr)
+
+// Requests a completion operation, or in other words a refiltering of the
+// current list with completions, using the current key.
+//
+// The completion list view will be updated accordingly.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) Complete() {
+
+	xEntryCompletionComplete(x.GoPointer())
+
+}
+
+var xEntryCompletionComputePrefix func(uintptr, string) string
+
+// Computes the common prefix that is shared by all rows in completion
+// that start with key.
+//
+// If no row matches key, nil will be returned.
+// Note that a text column must have been set for this function to work,
+// see [EntryCompletion.SetTextColumn] for details.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) ComputePrefix(KeyVar string) string {
+
+	cret := xEntryCompletionComputePrefix(x.GoPointer(), KeyVar)
+	return cret
+}
+
+var xEntryCompletionGetCompletionPrefix func(uintptr) string
+
+// Get the original text entered by the user that triggered
+// the completion or nil if there’s no completion ongoing.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetCompletionPrefix() string {
+
+	cret := xEntryCompletionGetCompletionPrefix(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetEntry func(uintptr) uintptr
+
+// Gets the entry completion has been attached to.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetEntry() *Widget {
+	var cls *Widget
+
+	cret := xEntryCompletionGetEntry(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xEntryCompletionGetInlineCompletion func(uintptr) bool
+
+// Returns whether the common prefix of the possible completions should
+// be automatically inserted in the entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetInlineCompletion() bool {
+
+	cret := xEntryCompletionGetInlineCompletion(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetInlineSelection func(uintptr) bool
+
+// Returns true if inline-selection mode is turned on.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetInlineSelection() bool {
+
+	cret := xEntryCompletionGetInlineSelection(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetMinimumKeyLength func(uintptr) int
+
+// Returns the minimum key length as set for completion.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetMinimumKeyLength() int {
+
+	cret := xEntryCompletionGetMinimumKeyLength(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetModel func(uintptr) uintptr
+
+// Returns the model the `GtkEntryCompletion` is using as data source.
+//
+// Returns nil if the model is unset.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xEntryCompletionGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xEntryCompletionGetPopupCompletion func(uintptr) bool
+
+// Returns whether the completions should be presented in a popup window.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetPopupCompletion() bool {
+
+	cret := xEntryCompletionGetPopupCompletion(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetPopupSetWidth func(uintptr) bool
+
+// Returns whether the completion popup window will be resized to the
+// width of the entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetPopupSetWidth() bool {
+
+	cret := xEntryCompletionGetPopupSetWidth(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetPopupSingleMatch func(uintptr) bool
+
+// Returns whether the completion popup window will appear even if there is
+// only a single match.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetPopupSingleMatch() bool {
+
+	cret := xEntryCompletionGetPopupSingleMatch(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionGetTextColumn func(uintptr) int
+
+// Returns the column in the model of completion to get strings from.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) GetTextColumn() int {
+
+	cret := xEntryCompletionGetTextColumn(x.GoPointer())
+	return cret
+}
+
+var xEntryCompletionInsertPrefix func(uintptr)
+
+// Requests a prefix insertion.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) InsertPrefix() {
+
+	xEntryCompletionInsertPrefix(x.GoPointer())
+
+}
+
+var xEntryCompletionSetInlineCompletion func(uintptr, bool)
+
+// Sets whether the common prefix of the possible completions should
+// be automatically inserted in the entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetInlineCompletion(InlineCompletionVar bool) {
+
+	xEntryCompletionSetInlineCompletion(x.GoPointer(), InlineCompletionVar)
+
+}
+
+var xEntryCompletionSetInlineSelection func(uintptr, bool)
+
+// Sets whether it is possible to cycle through the possible completions
+// inside the entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetInlineSelection(InlineSelectionVar bool) {
+
+	xEntryCompletionSetInlineSelection(x.GoPointer(), InlineSelectionVar)
+
+}
+
+var xEntryCompletionSetMatchFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the match function for completion to be func.
+//
+// The match function is used to determine if a row should or
+// should not be in the completion list.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetMatchFunc(FuncVar *EntryCompletionMatchFunc, FuncDataVar uintptr, FuncNotifyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 *TreeIter, arg3 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, core.GoString(arg1), arg2, arg3)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var FuncNotifyVarRef uintptr
+	if FuncNotifyVar != nil {
+		FuncNotifyVarPtr := uintptr(unsafe.Pointer(FuncNotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncNotifyVarPtr); ok {
+			FuncNotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncNotifyVar
+				cbFn(arg0)
+			}
+			FuncNotifyVarRef = core.NewCallback(fcb)
+			FuncNotifyVarRef = glib.SaveCallbackWithClosure(FuncNotifyVarPtr, FuncNotifyVarRef, FuncNotifyVar)
+		}
+	}
+
+	xEntryCompletionSetMatchFunc(x.GoPointer(), FuncVarRef, FuncDataVar, FuncNotifyVarRef)
+
+}
+
+var xEntryCompletionSetMinimumKeyLength func(uintptr, int)
+
+// Requires the length of the search key for completion to be at least
+// length.
+//
+// This is useful for long lists, where completing using a small
+// key takes a lot of time and will come up with meaningless results anyway
+// (ie, a too large dataset).
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetMinimumKeyLength(LengthVar int) {
+
+	xEntryCompletionSetMinimumKeyLength(x.GoPointer(), LengthVar)
+
+}
+
+var xEntryCompletionSetModel func(uintptr, uintptr)
+
+// Sets the model for a `GtkEntryCompletion`.
+//
+// If completion already has a model set, it will remove it
+// before setting the new model. If model is nil, then it
+// will unset the model.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetModel(ModelVar TreeModel) {
+
+	xEntryCompletionSetModel(x.GoPointer(), ModelVar.GoPointer())
+
+}
+
+var xEntryCompletionSetPopupCompletion func(uintptr, bool)
+
+// Sets whether the completions should be presented in a popup window.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetPopupCompletion(PopupCompletionVar bool) {
+
+	xEntryCompletionSetPopupCompletion(x.GoPointer(), PopupCompletionVar)
+
+}
+
+var xEntryCompletionSetPopupSetWidth func(uintptr, bool)
+
+// Sets whether the completion popup window will be resized to be the same
+// width as the entry.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetPopupSetWidth(PopupSetWidthVar bool) {
+
+	xEntryCompletionSetPopupSetWidth(x.GoPointer(), PopupSetWidthVar)
+
+}
+
+var xEntryCompletionSetPopupSingleMatch func(uintptr, bool)
+
+// Sets whether the completion popup window will appear even if there is
+// only a single match.
+//
+// You may want to set this to false if you
+// are using [EntryCompletion.GetPropertyInlineCompletion].
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetPopupSingleMatch(PopupSingleMatchVar bool) {
+
+	xEntryCompletionSetPopupSingleMatch(x.GoPointer(), PopupSingleMatchVar)
+
+}
+
+var xEntryCompletionSetTextColumn func(uintptr, int)
+
+// Convenience function for setting up the most used case of this code: a
+// completion list with just strings.
+//
+// This function will set up completion
+// to have a list displaying all (and just) strings in the completion list,
+// and to get those strings from column in the model of completion.
+//
+// This functions creates and adds a `GtkCellRendererText` for the selected
+// column. If you need to set the text column, but don't want the cell
+// renderer, use [gobject.Object.Set] to set the
+// [EntryCompletion.GetPropertyTextColumn] property directly.
+//
+// Deprecated: since 4.10. GtkEntryCompletion will be removed in GTK 5.
+func (x *EntryCompletion) SetTextColumn(ColumnVar int) {
+
+	xEntryCompletionSetTextColumn(x.GoPointer(), ColumnVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewEntryCompletion, libs, "gtk_entry_completion_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewEntryCompletionWithArea, libs, "gtk_entry_completion_new_with_area", "GTK", "")
+
+	core.PuregoRegisterSince(&xEntryCompletionComplete, libs, "gtk_entry_completion_complete", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionComputePrefix, libs, "gtk_entry_completion_compute_prefix", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetCompletionPrefix, libs, "gtk_entry_completion_get_completion_prefix", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetEntry, libs, "gtk_entry_completion_get_entry", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetInlineCompletion, libs, "gtk_entry_completion_get_inline_completion", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetInlineSelection, libs, "gtk_entry_completion_get_inline_selection", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetMinimumKeyLength, libs, "gtk_entry_completion_get_minimum_key_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetModel, libs, "gtk_entry_completion_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetPopupCompletion, libs, "gtk_entry_completion_get_popup_completion", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetPopupSetWidth, libs, "gtk_entry_completion_get_popup_set_width", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetPopupSingleMatch, libs, "gtk_entry_completion_get_popup_single_match", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionGetTextColumn, libs, "gtk_entry_completion_get_text_column", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionInsertPrefix, libs, "gtk_entry_completion_insert_prefix", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetInlineCompletion, libs, "gtk_entry_completion_set_inline_completion", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetInlineSelection, libs, "gtk_entry_completion_set_inline_selection", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetMatchFunc, libs, "gtk_entry_completion_set_match_func", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetMinimumKeyLength, libs, "gtk_entry_completion_set_minimum_key_length", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetModel, libs, "gtk_entry_completion_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetPopupCompletion, libs, "gtk_entry_completion_set_popup_completion", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetPopupSetWidth, libs, "gtk_entry_completion_set_popup_set_width", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetPopupSingleMatch, libs, "gtk_entry_completion_set_popup_single_match", "GTK", "")
+	core.PuregoRegisterSince(&xEntryCompletionSetTextColumn, libs, "gtk_entry_completion_set_text_column", "GTK", "")
+
+}
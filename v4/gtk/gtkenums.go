@@ -2,7 +2,9 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+	"strings"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -17,14 +19,14 @@ const (
 // or applications.
 //
 // Note that input methods may already tailor their behaviour according
-// to the [enum@InputPurpose] of the entry.
+// to the InputPurpose of the entry.
 //
 // Some common sense is expected when using these flags - mixing
-// %GTK_INPUT_HINT_LOWERCASE with any of the uppercase hints makes no sense.
+// [InputHintLowercaseValue] with any of the uppercase hints makes no sense.
 //
 // This enumeration may be extended in the future; input methods should
 // ignore unknown values.
-type InputHints int
+type InputHints uint32
 
 var xInputHintsGLibType func() types.GType
 
@@ -66,9 +68,90 @@ const (
 	InputHintPrivateValue InputHints = 2048
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (InputHints) GLibType() types.GType {
+	return xInputHintsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x InputHints) Has(flags InputHints) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x InputHints) Set(flags InputHints) InputHints {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x InputHints) Clear(flags InputHints) InputHints {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x InputHints) String() string {
+	if x == 0 {
+		return "GTK_INPUT_HINT_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&InputHintSpellcheckValue != 0 && x&InputHintSpellcheckValue == InputHintSpellcheckValue {
+		names = append(names, "GTK_INPUT_HINT_SPELLCHECK")
+		rest &^= InputHintSpellcheckValue
+	}
+	if rest&InputHintNoSpellcheckValue != 0 && x&InputHintNoSpellcheckValue == InputHintNoSpellcheckValue {
+		names = append(names, "GTK_INPUT_HINT_NO_SPELLCHECK")
+		rest &^= InputHintNoSpellcheckValue
+	}
+	if rest&InputHintWordCompletionValue != 0 && x&InputHintWordCompletionValue == InputHintWordCompletionValue {
+		names = append(names, "GTK_INPUT_HINT_WORD_COMPLETION")
+		rest &^= InputHintWordCompletionValue
+	}
+	if rest&InputHintLowercaseValue != 0 && x&InputHintLowercaseValue == InputHintLowercaseValue {
+		names = append(names, "GTK_INPUT_HINT_LOWERCASE")
+		rest &^= InputHintLowercaseValue
+	}
+	if rest&InputHintUppercaseCharsValue != 0 && x&InputHintUppercaseCharsValue == InputHintUppercaseCharsValue {
+		names = append(names, "GTK_INPUT_HINT_UPPERCASE_CHARS")
+		rest &^= InputHintUppercaseCharsValue
+	}
+	if rest&InputHintUppercaseWordsValue != 0 && x&InputHintUppercaseWordsValue == InputHintUppercaseWordsValue {
+		names = append(names, "GTK_INPUT_HINT_UPPERCASE_WORDS")
+		rest &^= InputHintUppercaseWordsValue
+	}
+	if rest&InputHintUppercaseSentencesValue != 0 && x&InputHintUppercaseSentencesValue == InputHintUppercaseSentencesValue {
+		names = append(names, "GTK_INPUT_HINT_UPPERCASE_SENTENCES")
+		rest &^= InputHintUppercaseSentencesValue
+	}
+	if rest&InputHintInhibitOskValue != 0 && x&InputHintInhibitOskValue == InputHintInhibitOskValue {
+		names = append(names, "GTK_INPUT_HINT_INHIBIT_OSK")
+		rest &^= InputHintInhibitOskValue
+	}
+	if rest&InputHintVerticalWritingValue != 0 && x&InputHintVerticalWritingValue == InputHintVerticalWritingValue {
+		names = append(names, "GTK_INPUT_HINT_VERTICAL_WRITING")
+		rest &^= InputHintVerticalWritingValue
+	}
+	if rest&InputHintEmojiValue != 0 && x&InputHintEmojiValue == InputHintEmojiValue {
+		names = append(names, "GTK_INPUT_HINT_EMOJI")
+		rest &^= InputHintEmojiValue
+	}
+	if rest&InputHintNoEmojiValue != 0 && x&InputHintNoEmojiValue == InputHintNoEmojiValue {
+		names = append(names, "GTK_INPUT_HINT_NO_EMOJI")
+		rest &^= InputHintNoEmojiValue
+	}
+	if rest&InputHintPrivateValue != 0 && x&InputHintPrivateValue == InputHintPrivateValue {
+		names = append(names, "GTK_INPUT_HINT_PRIVATE")
+		rest &^= InputHintPrivateValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // List of actions to perform when scrolling to items in
 // a list widget.
-type ListScrollFlags int
+type ListScrollFlags uint32
 
 var xListScrollFlagsGLibType func() types.GType
 
@@ -87,8 +170,49 @@ const (
 	ListScrollSelectValue ListScrollFlags = 2
 )
 
-// Flags that influence the behavior of [method@Widget.pick].
-type PickFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ListScrollFlags) GLibType() types.GType {
+	return xListScrollFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ListScrollFlags) Has(flags ListScrollFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ListScrollFlags) Set(flags ListScrollFlags) ListScrollFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ListScrollFlags) Clear(flags ListScrollFlags) ListScrollFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ListScrollFlags) String() string {
+	if x == 0 {
+		return "GTK_LIST_SCROLL_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&ListScrollFocusValue != 0 && x&ListScrollFocusValue == ListScrollFocusValue {
+		names = append(names, "GTK_LIST_SCROLL_FOCUS")
+		rest &^= ListScrollFocusValue
+	}
+	if rest&ListScrollSelectValue != 0 && x&ListScrollSelectValue == ListScrollSelectValue {
+		names = append(names, "GTK_LIST_SCROLL_SELECT")
+		rest &^= ListScrollSelectValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags that influence the behavior of pick.
+type PickFlags uint32
 
 var xPickFlagsGLibType func() types.GType
 
@@ -102,13 +226,54 @@ const (
 	PickDefaultValue PickFlags = 0
 	// Include widgets that are insensitive
 	PickInsensitiveValue PickFlags = 1
-	// Include widgets that are marked as non-targetable. See [property@Widget:can-target]
+	// Include widgets that are marked as non-targetable. See Widget:can-target
 	PickNonTargetableValue PickFlags = 2
 )
 
-// Flags that affect how [class@Gtk.PopoverMenu] widgets built from
-// a [class@Gio.MenuModel] are created and displayed.
-type PopoverMenuFlags int
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (PickFlags) GLibType() types.GType {
+	return xPickFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x PickFlags) Has(flags PickFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PickFlags) Set(flags PickFlags) PickFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PickFlags) Clear(flags PickFlags) PickFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PickFlags) String() string {
+	if x == 0 {
+		return "GTK_PICK_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&PickInsensitiveValue != 0 && x&PickInsensitiveValue == PickInsensitiveValue {
+		names = append(names, "GTK_PICK_INSENSITIVE")
+		rest &^= PickInsensitiveValue
+	}
+	if rest&PickNonTargetableValue != 0 && x&PickNonTargetableValue == PickNonTargetableValue {
+		names = append(names, "GTK_PICK_NON_TARGETABLE")
+		rest &^= PickNonTargetableValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags that affect how [PopoverMenu] widgets built from
+// a [gio.MenuModel] are created and displayed.
+type PopoverMenuFlags uint32
 
 var xPopoverMenuFlagsGLibType func() types.GType
 
@@ -125,12 +290,49 @@ const (
 	PopoverMenuNestedValue PopoverMenuFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (PopoverMenuFlags) GLibType() types.GType {
+	return xPopoverMenuFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x PopoverMenuFlags) Has(flags PopoverMenuFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PopoverMenuFlags) Set(flags PopoverMenuFlags) PopoverMenuFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PopoverMenuFlags) Clear(flags PopoverMenuFlags) PopoverMenuFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PopoverMenuFlags) String() string {
+	if x == 0 {
+		return "GTK_POPOVER_MENU_SLIDING"
+	}
+	var names []string
+	rest := x
+	if rest&PopoverMenuNestedValue != 0 && x&PopoverMenuNestedValue == PopoverMenuNestedValue {
+		names = append(names, "GTK_POPOVER_MENU_NESTED")
+		rest &^= PopoverMenuNestedValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Describes a widget state.
 //
 // Widget states are used to match the widget against CSS pseudo-classes.
 // Note that GTK extends the regular CSS classes and sometimes uses
 // different names.
-type StateFlags int
+type StateFlags uint32
 
 var xStateFlagsGLibType func() types.GType
 
@@ -174,9 +376,102 @@ const (
 	StateFlagFocusWithinValue StateFlags = 16384
 )
 
-// Values for [callback@Gtk.TextBufferCommitNotify] to denote the
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (StateFlags) GLibType() types.GType {
+	return xStateFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x StateFlags) Has(flags StateFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x StateFlags) Set(flags StateFlags) StateFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x StateFlags) Clear(flags StateFlags) StateFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x StateFlags) String() string {
+	if x == 0 {
+		return "GTK_STATE_FLAG_NORMAL"
+	}
+	var names []string
+	rest := x
+	if rest&StateFlagActiveValue != 0 && x&StateFlagActiveValue == StateFlagActiveValue {
+		names = append(names, "GTK_STATE_FLAG_ACTIVE")
+		rest &^= StateFlagActiveValue
+	}
+	if rest&StateFlagPrelightValue != 0 && x&StateFlagPrelightValue == StateFlagPrelightValue {
+		names = append(names, "GTK_STATE_FLAG_PRELIGHT")
+		rest &^= StateFlagPrelightValue
+	}
+	if rest&StateFlagSelectedValue != 0 && x&StateFlagSelectedValue == StateFlagSelectedValue {
+		names = append(names, "GTK_STATE_FLAG_SELECTED")
+		rest &^= StateFlagSelectedValue
+	}
+	if rest&StateFlagInsensitiveValue != 0 && x&StateFlagInsensitiveValue == StateFlagInsensitiveValue {
+		names = append(names, "GTK_STATE_FLAG_INSENSITIVE")
+		rest &^= StateFlagInsensitiveValue
+	}
+	if rest&StateFlagInconsistentValue != 0 && x&StateFlagInconsistentValue == StateFlagInconsistentValue {
+		names = append(names, "GTK_STATE_FLAG_INCONSISTENT")
+		rest &^= StateFlagInconsistentValue
+	}
+	if rest&StateFlagFocusedValue != 0 && x&StateFlagFocusedValue == StateFlagFocusedValue {
+		names = append(names, "GTK_STATE_FLAG_FOCUSED")
+		rest &^= StateFlagFocusedValue
+	}
+	if rest&StateFlagBackdropValue != 0 && x&StateFlagBackdropValue == StateFlagBackdropValue {
+		names = append(names, "GTK_STATE_FLAG_BACKDROP")
+		rest &^= StateFlagBackdropValue
+	}
+	if rest&StateFlagDirLtrValue != 0 && x&StateFlagDirLtrValue == StateFlagDirLtrValue {
+		names = append(names, "GTK_STATE_FLAG_DIR_LTR")
+		rest &^= StateFlagDirLtrValue
+	}
+	if rest&StateFlagDirRtlValue != 0 && x&StateFlagDirRtlValue == StateFlagDirRtlValue {
+		names = append(names, "GTK_STATE_FLAG_DIR_RTL")
+		rest &^= StateFlagDirRtlValue
+	}
+	if rest&StateFlagLinkValue != 0 && x&StateFlagLinkValue == StateFlagLinkValue {
+		names = append(names, "GTK_STATE_FLAG_LINK")
+		rest &^= StateFlagLinkValue
+	}
+	if rest&StateFlagVisitedValue != 0 && x&StateFlagVisitedValue == StateFlagVisitedValue {
+		names = append(names, "GTK_STATE_FLAG_VISITED")
+		rest &^= StateFlagVisitedValue
+	}
+	if rest&StateFlagCheckedValue != 0 && x&StateFlagCheckedValue == StateFlagCheckedValue {
+		names = append(names, "GTK_STATE_FLAG_CHECKED")
+		rest &^= StateFlagCheckedValue
+	}
+	if rest&StateFlagDropActiveValue != 0 && x&StateFlagDropActiveValue == StateFlagDropActiveValue {
+		names = append(names, "GTK_STATE_FLAG_DROP_ACTIVE")
+		rest &^= StateFlagDropActiveValue
+	}
+	if rest&StateFlagFocusVisibleValue != 0 && x&StateFlagFocusVisibleValue == StateFlagFocusVisibleValue {
+		names = append(names, "GTK_STATE_FLAG_FOCUS_VISIBLE")
+		rest &^= StateFlagFocusVisibleValue
+	}
+	if rest&StateFlagFocusWithinValue != 0 && x&StateFlagFocusWithinValue == StateFlagFocusWithinValue {
+		names = append(names, "GTK_STATE_FLAG_FOCUS_WITHIN")
+		rest &^= StateFlagFocusWithinValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Values for [TextBufferCommitNotify] to denote the
 // point of the notification.
-type TextBufferNotifyFlags int
+type TextBufferNotifyFlags uint32
 
 var xTextBufferNotifyFlagsGLibType func() types.GType
 
@@ -200,6 +495,55 @@ const (
 	TextBufferNotifyAfterDeleteValue TextBufferNotifyFlags = 8
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TextBufferNotifyFlags) GLibType() types.GType {
+	return xTextBufferNotifyFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TextBufferNotifyFlags) Has(flags TextBufferNotifyFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TextBufferNotifyFlags) Set(flags TextBufferNotifyFlags) TextBufferNotifyFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TextBufferNotifyFlags) Clear(flags TextBufferNotifyFlags) TextBufferNotifyFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TextBufferNotifyFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&TextBufferNotifyBeforeInsertValue != 0 && x&TextBufferNotifyBeforeInsertValue == TextBufferNotifyBeforeInsertValue {
+		names = append(names, "GTK_TEXT_BUFFER_NOTIFY_BEFORE_INSERT")
+		rest &^= TextBufferNotifyBeforeInsertValue
+	}
+	if rest&TextBufferNotifyAfterInsertValue != 0 && x&TextBufferNotifyAfterInsertValue == TextBufferNotifyAfterInsertValue {
+		names = append(names, "GTK_TEXT_BUFFER_NOTIFY_AFTER_INSERT")
+		rest &^= TextBufferNotifyAfterInsertValue
+	}
+	if rest&TextBufferNotifyBeforeDeleteValue != 0 && x&TextBufferNotifyBeforeDeleteValue == TextBufferNotifyBeforeDeleteValue {
+		names = append(names, "GTK_TEXT_BUFFER_NOTIFY_BEFORE_DELETE")
+		rest &^= TextBufferNotifyBeforeDeleteValue
+	}
+	if rest&TextBufferNotifyAfterDeleteValue != 0 && x&TextBufferNotifyAfterDeleteValue == TextBufferNotifyAfterDeleteValue {
+		names = append(names, "GTK_TEXT_BUFFER_NOTIFY_AFTER_DELETE")
+		rest &^= TextBufferNotifyAfterDeleteValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The priority of an accessibility announcement.
 type AccessibleAnnouncementPriority int
 
@@ -227,7 +571,46 @@ const (
 	AccessibleAnnouncementPriorityHighValue AccessibleAnnouncementPriority = 2
 )
 
-// The possible values for the %GTK_ACCESSIBLE_PROPERTY_AUTOCOMPLETE
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleAnnouncementPriority) String() string {
+	switch x {
+	case AccessibleAnnouncementPriorityLowValue:
+		return "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_LOW"
+	case AccessibleAnnouncementPriorityMediumValue:
+		return "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_MEDIUM"
+	case AccessibleAnnouncementPriorityHighValue:
+		return "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_HIGH"
+	}
+	return fmt.Sprintf("AccessibleAnnouncementPriority(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleAnnouncementPriority) Nick() string {
+	switch x {
+	case AccessibleAnnouncementPriorityLowValue:
+		return "low"
+	case AccessibleAnnouncementPriorityMediumValue:
+		return "medium"
+	case AccessibleAnnouncementPriorityHighValue:
+		return "high"
+	}
+	return ""
+}
+
+// AccessibleAnnouncementPriorityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleAnnouncementPriorityFromString(s string) (AccessibleAnnouncementPriority, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_LOW", "low":
+		return AccessibleAnnouncementPriorityLowValue, true
+	case "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_MEDIUM", "medium":
+		return AccessibleAnnouncementPriorityMediumValue, true
+	case "GTK_ACCESSIBLE_ANNOUNCEMENT_PRIORITY_HIGH", "high":
+		return AccessibleAnnouncementPriorityHighValue, true
+	}
+	return 0, false
+}
+
+// The possible values for the [AccessiblePropertyAutocompleteValue]
 // accessible property.
 type AccessibleAutocomplete int
 
@@ -257,12 +640,57 @@ const (
 	AccessibleAutocompleteBothValue AccessibleAutocomplete = 3
 )
 
-// The possible values for the %GTK_ACCESSIBLE_STATE_INVALID
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleAutocomplete) String() string {
+	switch x {
+	case AccessibleAutocompleteNoneValue:
+		return "GTK_ACCESSIBLE_AUTOCOMPLETE_NONE"
+	case AccessibleAutocompleteInlineValue:
+		return "GTK_ACCESSIBLE_AUTOCOMPLETE_INLINE"
+	case AccessibleAutocompleteListValue:
+		return "GTK_ACCESSIBLE_AUTOCOMPLETE_LIST"
+	case AccessibleAutocompleteBothValue:
+		return "GTK_ACCESSIBLE_AUTOCOMPLETE_BOTH"
+	}
+	return fmt.Sprintf("AccessibleAutocomplete(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleAutocomplete) Nick() string {
+	switch x {
+	case AccessibleAutocompleteNoneValue:
+		return "none"
+	case AccessibleAutocompleteInlineValue:
+		return "inline"
+	case AccessibleAutocompleteListValue:
+		return "list"
+	case AccessibleAutocompleteBothValue:
+		return "both"
+	}
+	return ""
+}
+
+// AccessibleAutocompleteFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleAutocompleteFromString(s string) (AccessibleAutocomplete, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_AUTOCOMPLETE_NONE", "none":
+		return AccessibleAutocompleteNoneValue, true
+	case "GTK_ACCESSIBLE_AUTOCOMPLETE_INLINE", "inline":
+		return AccessibleAutocompleteInlineValue, true
+	case "GTK_ACCESSIBLE_AUTOCOMPLETE_LIST", "list":
+		return AccessibleAutocompleteListValue, true
+	case "GTK_ACCESSIBLE_AUTOCOMPLETE_BOTH", "both":
+		return AccessibleAutocompleteBothValue, true
+	}
+	return 0, false
+}
+
+// The possible values for the [AccessibleStateInvalidValue]
 // accessible state.
 //
-// Note that the %GTK_ACCESSIBLE_INVALID_FALSE and
-// %GTK_ACCESSIBLE_INVALID_TRUE have the same values
-// as %FALSE and %TRUE.
+// Note that the [AccessibleInvalidFalseValue] and
+// [AccessibleInvalidTrueValue] have the same values
+// as false and true.
 type AccessibleInvalidState int
 
 var xAccessibleInvalidStateGLibType func() types.GType
@@ -283,7 +711,52 @@ const (
 	AccessibleInvalidSpellingValue AccessibleInvalidState = 3
 )
 
-// The possible accessible properties of a [iface@Accessible].
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleInvalidState) String() string {
+	switch x {
+	case AccessibleInvalidFalseValue:
+		return "GTK_ACCESSIBLE_INVALID_FALSE"
+	case AccessibleInvalidTrueValue:
+		return "GTK_ACCESSIBLE_INVALID_TRUE"
+	case AccessibleInvalidGrammarValue:
+		return "GTK_ACCESSIBLE_INVALID_GRAMMAR"
+	case AccessibleInvalidSpellingValue:
+		return "GTK_ACCESSIBLE_INVALID_SPELLING"
+	}
+	return fmt.Sprintf("AccessibleInvalidState(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleInvalidState) Nick() string {
+	switch x {
+	case AccessibleInvalidFalseValue:
+		return "false"
+	case AccessibleInvalidTrueValue:
+		return "true"
+	case AccessibleInvalidGrammarValue:
+		return "grammar"
+	case AccessibleInvalidSpellingValue:
+		return "spelling"
+	}
+	return ""
+}
+
+// AccessibleInvalidStateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleInvalidStateFromString(s string) (AccessibleInvalidState, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_INVALID_FALSE", "false":
+		return AccessibleInvalidFalseValue, true
+	case "GTK_ACCESSIBLE_INVALID_TRUE", "true":
+		return AccessibleInvalidTrueValue, true
+	case "GTK_ACCESSIBLE_INVALID_GRAMMAR", "grammar":
+		return AccessibleInvalidGrammarValue, true
+	case "GTK_ACCESSIBLE_INVALID_SPELLING", "spelling":
+		return AccessibleInvalidSpellingValue, true
+	}
+	return 0, false
+}
+
+// The possible accessible properties of a Accessible.
 type AccessibleProperty int
 
 var xAccessiblePropertyGLibType func() types.GType
@@ -297,7 +770,7 @@ const (
 	// Indicates whether inputting text
 	//    could trigger display of one or more predictions of the user's intended
 	//    value for a combobox, searchbox, or textbox and specifies how predictions
-	//    would be presented if they were made. Value type: [enum@AccessibleAutocomplete]
+	//    would be presented if they were made. Value type: AccessibleAutocomplete
 	AccessiblePropertyAutocompleteValue AccessibleProperty = 0
 	// Defines a string value that describes
 	//    or annotates the current element. Value type: string
@@ -331,7 +804,7 @@ const (
 	AccessiblePropertyMultiSelectableValue AccessibleProperty = 8
 	// Indicates whether the element's
 	//    orientation is horizontal, vertical, or unknown/ambiguous. Value type:
-	//    [enum@Orientation]
+	//    Orientation
 	AccessiblePropertyOrientationValue AccessibleProperty = 9
 	// Defines a short hint (a word or short
 	//    phrase) intended to aid the user with data entry when the control has no
@@ -348,7 +821,7 @@ const (
 	//    author-localized description for the role of an element. Value type: string
 	AccessiblePropertyRoleDescriptionValue AccessibleProperty = 13
 	// Indicates if items in a table or grid are
-	//    sorted in ascending or descending order. Value type: [enum@AccessibleSort]
+	//    sorted in ascending or descending order. Value type: AccessibleSort
 	AccessiblePropertySortValue AccessibleProperty = 14
 	// Defines the maximum allowed value for a
 	//    range widget. Value type: double
@@ -360,14 +833,155 @@ const (
 	//    Value type: double
 	AccessiblePropertyValueNowValue AccessibleProperty = 17
 	// Defines the human readable text alternative
-	//    of [enum@Gtk.AccessibleProperty.VALUE_NOW] for a range widget. Value type: string
+	//    of AccessibleProperty.VALUE_NOW for a range widget. Value type: string
 	AccessiblePropertyValueTextValue AccessibleProperty = 18
 	// Defines a string value that provides a description of non-standard keyboard
 	// interactions of the current element. Value type: string
 	AccessiblePropertyHelpTextValue AccessibleProperty = 19
 )
 
-// The possible accessible relations of a [iface@Accessible].
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleProperty) String() string {
+	switch x {
+	case AccessiblePropertyAutocompleteValue:
+		return "GTK_ACCESSIBLE_PROPERTY_AUTOCOMPLETE"
+	case AccessiblePropertyDescriptionValue:
+		return "GTK_ACCESSIBLE_PROPERTY_DESCRIPTION"
+	case AccessiblePropertyHasPopupValue:
+		return "GTK_ACCESSIBLE_PROPERTY_HAS_POPUP"
+	case AccessiblePropertyKeyShortcutsValue:
+		return "GTK_ACCESSIBLE_PROPERTY_KEY_SHORTCUTS"
+	case AccessiblePropertyLabelValue:
+		return "GTK_ACCESSIBLE_PROPERTY_LABEL"
+	case AccessiblePropertyLevelValue:
+		return "GTK_ACCESSIBLE_PROPERTY_LEVEL"
+	case AccessiblePropertyModalValue:
+		return "GTK_ACCESSIBLE_PROPERTY_MODAL"
+	case AccessiblePropertyMultiLineValue:
+		return "GTK_ACCESSIBLE_PROPERTY_MULTI_LINE"
+	case AccessiblePropertyMultiSelectableValue:
+		return "GTK_ACCESSIBLE_PROPERTY_MULTI_SELECTABLE"
+	case AccessiblePropertyOrientationValue:
+		return "GTK_ACCESSIBLE_PROPERTY_ORIENTATION"
+	case AccessiblePropertyPlaceholderValue:
+		return "GTK_ACCESSIBLE_PROPERTY_PLACEHOLDER"
+	case AccessiblePropertyReadOnlyValue:
+		return "GTK_ACCESSIBLE_PROPERTY_READ_ONLY"
+	case AccessiblePropertyRequiredValue:
+		return "GTK_ACCESSIBLE_PROPERTY_REQUIRED"
+	case AccessiblePropertyRoleDescriptionValue:
+		return "GTK_ACCESSIBLE_PROPERTY_ROLE_DESCRIPTION"
+	case AccessiblePropertySortValue:
+		return "GTK_ACCESSIBLE_PROPERTY_SORT"
+	case AccessiblePropertyValueMaxValue:
+		return "GTK_ACCESSIBLE_PROPERTY_VALUE_MAX"
+	case AccessiblePropertyValueMinValue:
+		return "GTK_ACCESSIBLE_PROPERTY_VALUE_MIN"
+	case AccessiblePropertyValueNowValue:
+		return "GTK_ACCESSIBLE_PROPERTY_VALUE_NOW"
+	case AccessiblePropertyValueTextValue:
+		return "GTK_ACCESSIBLE_PROPERTY_VALUE_TEXT"
+	case AccessiblePropertyHelpTextValue:
+		return "GTK_ACCESSIBLE_PROPERTY_HELP_TEXT"
+	}
+	return fmt.Sprintf("AccessibleProperty(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleProperty) Nick() string {
+	switch x {
+	case AccessiblePropertyAutocompleteValue:
+		return "autocomplete"
+	case AccessiblePropertyDescriptionValue:
+		return "description"
+	case AccessiblePropertyHasPopupValue:
+		return "has-popup"
+	case AccessiblePropertyKeyShortcutsValue:
+		return "key-shortcuts"
+	case AccessiblePropertyLabelValue:
+		return "label"
+	case AccessiblePropertyLevelValue:
+		return "level"
+	case AccessiblePropertyModalValue:
+		return "modal"
+	case AccessiblePropertyMultiLineValue:
+		return "multi-line"
+	case AccessiblePropertyMultiSelectableValue:
+		return "multi-selectable"
+	case AccessiblePropertyOrientationValue:
+		return "orientation"
+	case AccessiblePropertyPlaceholderValue:
+		return "placeholder"
+	case AccessiblePropertyReadOnlyValue:
+		return "read-only"
+	case AccessiblePropertyRequiredValue:
+		return "required"
+	case AccessiblePropertyRoleDescriptionValue:
+		return "role-description"
+	case AccessiblePropertySortValue:
+		return "sort"
+	case AccessiblePropertyValueMaxValue:
+		return "value-max"
+	case AccessiblePropertyValueMinValue:
+		return "value-min"
+	case AccessiblePropertyValueNowValue:
+		return "value-now"
+	case AccessiblePropertyValueTextValue:
+		return "value-text"
+	case AccessiblePropertyHelpTextValue:
+		return "help-text"
+	}
+	return ""
+}
+
+// AccessiblePropertyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessiblePropertyFromString(s string) (AccessibleProperty, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_PROPERTY_AUTOCOMPLETE", "autocomplete":
+		return AccessiblePropertyAutocompleteValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_DESCRIPTION", "description":
+		return AccessiblePropertyDescriptionValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_HAS_POPUP", "has-popup":
+		return AccessiblePropertyHasPopupValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_KEY_SHORTCUTS", "key-shortcuts":
+		return AccessiblePropertyKeyShortcutsValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_LABEL", "label":
+		return AccessiblePropertyLabelValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_LEVEL", "level":
+		return AccessiblePropertyLevelValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_MODAL", "modal":
+		return AccessiblePropertyModalValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_MULTI_LINE", "multi-line":
+		return AccessiblePropertyMultiLineValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_MULTI_SELECTABLE", "multi-selectable":
+		return AccessiblePropertyMultiSelectableValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_ORIENTATION", "orientation":
+		return AccessiblePropertyOrientationValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_PLACEHOLDER", "placeholder":
+		return AccessiblePropertyPlaceholderValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_READ_ONLY", "read-only":
+		return AccessiblePropertyReadOnlyValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_REQUIRED", "required":
+		return AccessiblePropertyRequiredValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_ROLE_DESCRIPTION", "role-description":
+		return AccessiblePropertyRoleDescriptionValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_SORT", "sort":
+		return AccessiblePropertySortValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_VALUE_MAX", "value-max":
+		return AccessiblePropertyValueMaxValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_VALUE_MIN", "value-min":
+		return AccessiblePropertyValueMinValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_VALUE_NOW", "value-now":
+		return AccessiblePropertyValueNowValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_VALUE_TEXT", "value-text":
+		return AccessiblePropertyValueTextValue, true
+	case "GTK_ACCESSIBLE_PROPERTY_HELP_TEXT", "help-text":
+		return AccessiblePropertyHelpTextValue, true
+	}
+	return 0, false
+}
+
+// The possible accessible relations of a Accessible.
 //
 // Accessible relations can be references to other widgets,
 // integers or strings.
@@ -393,7 +1007,7 @@ const (
 	//    grid, or treegrid. Value type: integer
 	AccessibleRelationColIndexValue AccessibleRelation = 2
 	// Defines a human readable text
-	//   alternative of %GTK_ACCESSIBLE_RELATION_COL_INDEX. Value type: string
+	//   alternative of [AccessibleRelationColIndexValue]. Value type: string
 	AccessibleRelationColIndexTextValue AccessibleRelation = 3
 	// Defines the number of columns spanned
 	//   by a cell or gridcell within a table, grid, or treegrid. Value type: integer
@@ -434,7 +1048,7 @@ const (
 	//    Value type: integer
 	AccessibleRelationRowIndexValue AccessibleRelation = 14
 	// Defines a human readable text
-	//    alternative of [enum@Gtk.AccessibleRelation.ROW_INDEX]. Value type: string
+	//    alternative of AccessibleRelation.ROW_INDEX. Value type: string
 	AccessibleRelationRowIndexTextValue AccessibleRelation = 15
 	// Defines the number of rows spanned by a
 	//    cell or gridcell within a table, grid, or treegrid. Value type: integer
@@ -476,7 +1090,172 @@ const (
 	AccessibleRelationFlowFromValue AccessibleRelation = 23
 )
 
-// The accessible role for a [iface@Accessible] implementation.
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleRelation) String() string {
+	switch x {
+	case AccessibleRelationActiveDescendantValue:
+		return "GTK_ACCESSIBLE_RELATION_ACTIVE_DESCENDANT"
+	case AccessibleRelationColCountValue:
+		return "GTK_ACCESSIBLE_RELATION_COL_COUNT"
+	case AccessibleRelationColIndexValue:
+		return "GTK_ACCESSIBLE_RELATION_COL_INDEX"
+	case AccessibleRelationColIndexTextValue:
+		return "GTK_ACCESSIBLE_RELATION_COL_INDEX_TEXT"
+	case AccessibleRelationColSpanValue:
+		return "GTK_ACCESSIBLE_RELATION_COL_SPAN"
+	case AccessibleRelationControlsValue:
+		return "GTK_ACCESSIBLE_RELATION_CONTROLS"
+	case AccessibleRelationDescribedByValue:
+		return "GTK_ACCESSIBLE_RELATION_DESCRIBED_BY"
+	case AccessibleRelationDetailsValue:
+		return "GTK_ACCESSIBLE_RELATION_DETAILS"
+	case AccessibleRelationErrorMessageValue:
+		return "GTK_ACCESSIBLE_RELATION_ERROR_MESSAGE"
+	case AccessibleRelationFlowToValue:
+		return "GTK_ACCESSIBLE_RELATION_FLOW_TO"
+	case AccessibleRelationLabelledByValue:
+		return "GTK_ACCESSIBLE_RELATION_LABELLED_BY"
+	case AccessibleRelationOwnsValue:
+		return "GTK_ACCESSIBLE_RELATION_OWNS"
+	case AccessibleRelationPosInSetValue:
+		return "GTK_ACCESSIBLE_RELATION_POS_IN_SET"
+	case AccessibleRelationRowCountValue:
+		return "GTK_ACCESSIBLE_RELATION_ROW_COUNT"
+	case AccessibleRelationRowIndexValue:
+		return "GTK_ACCESSIBLE_RELATION_ROW_INDEX"
+	case AccessibleRelationRowIndexTextValue:
+		return "GTK_ACCESSIBLE_RELATION_ROW_INDEX_TEXT"
+	case AccessibleRelationRowSpanValue:
+		return "GTK_ACCESSIBLE_RELATION_ROW_SPAN"
+	case AccessibleRelationSetSizeValue:
+		return "GTK_ACCESSIBLE_RELATION_SET_SIZE"
+	case AccessibleRelationLabelForValue:
+		return "GTK_ACCESSIBLE_RELATION_LABEL_FOR"
+	case AccessibleRelationDescriptionForValue:
+		return "GTK_ACCESSIBLE_RELATION_DESCRIPTION_FOR"
+	case AccessibleRelationControlledByValue:
+		return "GTK_ACCESSIBLE_RELATION_CONTROLLED_BY"
+	case AccessibleRelationDetailsForValue:
+		return "GTK_ACCESSIBLE_RELATION_DETAILS_FOR"
+	case AccessibleRelationErrorMessageForValue:
+		return "GTK_ACCESSIBLE_RELATION_ERROR_MESSAGE_FOR"
+	case AccessibleRelationFlowFromValue:
+		return "GTK_ACCESSIBLE_RELATION_FLOW_FROM"
+	}
+	return fmt.Sprintf("AccessibleRelation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleRelation) Nick() string {
+	switch x {
+	case AccessibleRelationActiveDescendantValue:
+		return "active-descendant"
+	case AccessibleRelationColCountValue:
+		return "col-count"
+	case AccessibleRelationColIndexValue:
+		return "col-index"
+	case AccessibleRelationColIndexTextValue:
+		return "col-index-text"
+	case AccessibleRelationColSpanValue:
+		return "col-span"
+	case AccessibleRelationControlsValue:
+		return "controls"
+	case AccessibleRelationDescribedByValue:
+		return "described-by"
+	case AccessibleRelationDetailsValue:
+		return "details"
+	case AccessibleRelationErrorMessageValue:
+		return "error-message"
+	case AccessibleRelationFlowToValue:
+		return "flow-to"
+	case AccessibleRelationLabelledByValue:
+		return "labelled-by"
+	case AccessibleRelationOwnsValue:
+		return "owns"
+	case AccessibleRelationPosInSetValue:
+		return "pos-in-set"
+	case AccessibleRelationRowCountValue:
+		return "row-count"
+	case AccessibleRelationRowIndexValue:
+		return "row-index"
+	case AccessibleRelationRowIndexTextValue:
+		return "row-index-text"
+	case AccessibleRelationRowSpanValue:
+		return "row-span"
+	case AccessibleRelationSetSizeValue:
+		return "set-size"
+	case AccessibleRelationLabelForValue:
+		return "label-for"
+	case AccessibleRelationDescriptionForValue:
+		return "description-for"
+	case AccessibleRelationControlledByValue:
+		return "controlled-by"
+	case AccessibleRelationDetailsForValue:
+		return "details-for"
+	case AccessibleRelationErrorMessageForValue:
+		return "error-message-for"
+	case AccessibleRelationFlowFromValue:
+		return "flow-from"
+	}
+	return ""
+}
+
+// AccessibleRelationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleRelationFromString(s string) (AccessibleRelation, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_RELATION_ACTIVE_DESCENDANT", "active-descendant":
+		return AccessibleRelationActiveDescendantValue, true
+	case "GTK_ACCESSIBLE_RELATION_COL_COUNT", "col-count":
+		return AccessibleRelationColCountValue, true
+	case "GTK_ACCESSIBLE_RELATION_COL_INDEX", "col-index":
+		return AccessibleRelationColIndexValue, true
+	case "GTK_ACCESSIBLE_RELATION_COL_INDEX_TEXT", "col-index-text":
+		return AccessibleRelationColIndexTextValue, true
+	case "GTK_ACCESSIBLE_RELATION_COL_SPAN", "col-span":
+		return AccessibleRelationColSpanValue, true
+	case "GTK_ACCESSIBLE_RELATION_CONTROLS", "controls":
+		return AccessibleRelationControlsValue, true
+	case "GTK_ACCESSIBLE_RELATION_DESCRIBED_BY", "described-by":
+		return AccessibleRelationDescribedByValue, true
+	case "GTK_ACCESSIBLE_RELATION_DETAILS", "details":
+		return AccessibleRelationDetailsValue, true
+	case "GTK_ACCESSIBLE_RELATION_ERROR_MESSAGE", "error-message":
+		return AccessibleRelationErrorMessageValue, true
+	case "GTK_ACCESSIBLE_RELATION_FLOW_TO", "flow-to":
+		return AccessibleRelationFlowToValue, true
+	case "GTK_ACCESSIBLE_RELATION_LABELLED_BY", "labelled-by":
+		return AccessibleRelationLabelledByValue, true
+	case "GTK_ACCESSIBLE_RELATION_OWNS", "owns":
+		return AccessibleRelationOwnsValue, true
+	case "GTK_ACCESSIBLE_RELATION_POS_IN_SET", "pos-in-set":
+		return AccessibleRelationPosInSetValue, true
+	case "GTK_ACCESSIBLE_RELATION_ROW_COUNT", "row-count":
+		return AccessibleRelationRowCountValue, true
+	case "GTK_ACCESSIBLE_RELATION_ROW_INDEX", "row-index":
+		return AccessibleRelationRowIndexValue, true
+	case "GTK_ACCESSIBLE_RELATION_ROW_INDEX_TEXT", "row-index-text":
+		return AccessibleRelationRowIndexTextValue, true
+	case "GTK_ACCESSIBLE_RELATION_ROW_SPAN", "row-span":
+		return AccessibleRelationRowSpanValue, true
+	case "GTK_ACCESSIBLE_RELATION_SET_SIZE", "set-size":
+		return AccessibleRelationSetSizeValue, true
+	case "GTK_ACCESSIBLE_RELATION_LABEL_FOR", "label-for":
+		return AccessibleRelationLabelForValue, true
+	case "GTK_ACCESSIBLE_RELATION_DESCRIPTION_FOR", "description-for":
+		return AccessibleRelationDescriptionForValue, true
+	case "GTK_ACCESSIBLE_RELATION_CONTROLLED_BY", "controlled-by":
+		return AccessibleRelationControlledByValue, true
+	case "GTK_ACCESSIBLE_RELATION_DETAILS_FOR", "details-for":
+		return AccessibleRelationDetailsForValue, true
+	case "GTK_ACCESSIBLE_RELATION_ERROR_MESSAGE_FOR", "error-message-for":
+		return AccessibleRelationErrorMessageForValue, true
+	case "GTK_ACCESSIBLE_RELATION_FLOW_FROM", "flow-from":
+		return AccessibleRelationFlowFromValue, true
+	}
+	return 0, false
+}
+
+// The accessible role for a Accessible implementation.
 //
 // Abstract roles are only used as part of the ontology; application
 // developers must not use abstract roles in their code.
@@ -537,7 +1316,7 @@ const (
 	// An item in a grid or tree grid.
 	AccessibleRoleGridCellValue AccessibleRole = 17
 	// An element that groups multiple related widgets. GTK uses
-	//   this role for various containers, like [class@Gtk.HeaderBar] or [class@Gtk.Notebook].
+	//   this role for various containers, like [HeaderBar] or [Notebook].
 	AccessibleRoleGroupValue AccessibleRole = 18
 	// Unused
 	AccessibleRoleHeadingValue AccessibleRole = 19
@@ -690,7 +1469,538 @@ const (
 	AccessibleRoleTerminalValue AccessibleRole = 84
 )
 
-// The possible values for the %GTK_ACCESSIBLE_PROPERTY_SORT
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleRole) String() string {
+	switch x {
+	case AccessibleRoleAlertValue:
+		return "GTK_ACCESSIBLE_ROLE_ALERT"
+	case AccessibleRoleAlertDialogValue:
+		return "GTK_ACCESSIBLE_ROLE_ALERT_DIALOG"
+	case AccessibleRoleBannerValue:
+		return "GTK_ACCESSIBLE_ROLE_BANNER"
+	case AccessibleRoleButtonValue:
+		return "GTK_ACCESSIBLE_ROLE_BUTTON"
+	case AccessibleRoleCaptionValue:
+		return "GTK_ACCESSIBLE_ROLE_CAPTION"
+	case AccessibleRoleCellValue:
+		return "GTK_ACCESSIBLE_ROLE_CELL"
+	case AccessibleRoleCheckboxValue:
+		return "GTK_ACCESSIBLE_ROLE_CHECKBOX"
+	case AccessibleRoleColumnHeaderValue:
+		return "GTK_ACCESSIBLE_ROLE_COLUMN_HEADER"
+	case AccessibleRoleComboBoxValue:
+		return "GTK_ACCESSIBLE_ROLE_COMBO_BOX"
+	case AccessibleRoleCommandValue:
+		return "GTK_ACCESSIBLE_ROLE_COMMAND"
+	case AccessibleRoleCompositeValue:
+		return "GTK_ACCESSIBLE_ROLE_COMPOSITE"
+	case AccessibleRoleDialogValue:
+		return "GTK_ACCESSIBLE_ROLE_DIALOG"
+	case AccessibleRoleDocumentValue:
+		return "GTK_ACCESSIBLE_ROLE_DOCUMENT"
+	case AccessibleRoleFeedValue:
+		return "GTK_ACCESSIBLE_ROLE_FEED"
+	case AccessibleRoleFormValue:
+		return "GTK_ACCESSIBLE_ROLE_FORM"
+	case AccessibleRoleGenericValue:
+		return "GTK_ACCESSIBLE_ROLE_GENERIC"
+	case AccessibleRoleGridValue:
+		return "GTK_ACCESSIBLE_ROLE_GRID"
+	case AccessibleRoleGridCellValue:
+		return "GTK_ACCESSIBLE_ROLE_GRID_CELL"
+	case AccessibleRoleGroupValue:
+		return "GTK_ACCESSIBLE_ROLE_GROUP"
+	case AccessibleRoleHeadingValue:
+		return "GTK_ACCESSIBLE_ROLE_HEADING"
+	case AccessibleRoleImgValue:
+		return "GTK_ACCESSIBLE_ROLE_IMG"
+	case AccessibleRoleInputValue:
+		return "GTK_ACCESSIBLE_ROLE_INPUT"
+	case AccessibleRoleLabelValue:
+		return "GTK_ACCESSIBLE_ROLE_LABEL"
+	case AccessibleRoleLandmarkValue:
+		return "GTK_ACCESSIBLE_ROLE_LANDMARK"
+	case AccessibleRoleLegendValue:
+		return "GTK_ACCESSIBLE_ROLE_LEGEND"
+	case AccessibleRoleLinkValue:
+		return "GTK_ACCESSIBLE_ROLE_LINK"
+	case AccessibleRoleListValue:
+		return "GTK_ACCESSIBLE_ROLE_LIST"
+	case AccessibleRoleListBoxValue:
+		return "GTK_ACCESSIBLE_ROLE_LIST_BOX"
+	case AccessibleRoleListItemValue:
+		return "GTK_ACCESSIBLE_ROLE_LIST_ITEM"
+	case AccessibleRoleLogValue:
+		return "GTK_ACCESSIBLE_ROLE_LOG"
+	case AccessibleRoleMainValue:
+		return "GTK_ACCESSIBLE_ROLE_MAIN"
+	case AccessibleRoleMarqueeValue:
+		return "GTK_ACCESSIBLE_ROLE_MARQUEE"
+	case AccessibleRoleMathValue:
+		return "GTK_ACCESSIBLE_ROLE_MATH"
+	case AccessibleRoleMeterValue:
+		return "GTK_ACCESSIBLE_ROLE_METER"
+	case AccessibleRoleMenuValue:
+		return "GTK_ACCESSIBLE_ROLE_MENU"
+	case AccessibleRoleMenuBarValue:
+		return "GTK_ACCESSIBLE_ROLE_MENU_BAR"
+	case AccessibleRoleMenuItemValue:
+		return "GTK_ACCESSIBLE_ROLE_MENU_ITEM"
+	case AccessibleRoleMenuItemCheckboxValue:
+		return "GTK_ACCESSIBLE_ROLE_MENU_ITEM_CHECKBOX"
+	case AccessibleRoleMenuItemRadioValue:
+		return "GTK_ACCESSIBLE_ROLE_MENU_ITEM_RADIO"
+	case AccessibleRoleNavigationValue:
+		return "GTK_ACCESSIBLE_ROLE_NAVIGATION"
+	case AccessibleRoleNoneValue:
+		return "GTK_ACCESSIBLE_ROLE_NONE"
+	case AccessibleRoleNoteValue:
+		return "GTK_ACCESSIBLE_ROLE_NOTE"
+	case AccessibleRoleOptionValue:
+		return "GTK_ACCESSIBLE_ROLE_OPTION"
+	case AccessibleRolePresentationValue:
+		return "GTK_ACCESSIBLE_ROLE_PRESENTATION"
+	case AccessibleRoleProgressBarValue:
+		return "GTK_ACCESSIBLE_ROLE_PROGRESS_BAR"
+	case AccessibleRoleRadioValue:
+		return "GTK_ACCESSIBLE_ROLE_RADIO"
+	case AccessibleRoleRadioGroupValue:
+		return "GTK_ACCESSIBLE_ROLE_RADIO_GROUP"
+	case AccessibleRoleRangeValue:
+		return "GTK_ACCESSIBLE_ROLE_RANGE"
+	case AccessibleRoleRegionValue:
+		return "GTK_ACCESSIBLE_ROLE_REGION"
+	case AccessibleRoleRowValue:
+		return "GTK_ACCESSIBLE_ROLE_ROW"
+	case AccessibleRoleRowGroupValue:
+		return "GTK_ACCESSIBLE_ROLE_ROW_GROUP"
+	case AccessibleRoleRowHeaderValue:
+		return "GTK_ACCESSIBLE_ROLE_ROW_HEADER"
+	case AccessibleRoleScrollbarValue:
+		return "GTK_ACCESSIBLE_ROLE_SCROLLBAR"
+	case AccessibleRoleSearchValue:
+		return "GTK_ACCESSIBLE_ROLE_SEARCH"
+	case AccessibleRoleSearchBoxValue:
+		return "GTK_ACCESSIBLE_ROLE_SEARCH_BOX"
+	case AccessibleRoleSectionValue:
+		return "GTK_ACCESSIBLE_ROLE_SECTION"
+	case AccessibleRoleSectionHeadValue:
+		return "GTK_ACCESSIBLE_ROLE_SECTION_HEAD"
+	case AccessibleRoleSelectValue:
+		return "GTK_ACCESSIBLE_ROLE_SELECT"
+	case AccessibleRoleSeparatorValue:
+		return "GTK_ACCESSIBLE_ROLE_SEPARATOR"
+	case AccessibleRoleSliderValue:
+		return "GTK_ACCESSIBLE_ROLE_SLIDER"
+	case AccessibleRoleSpinButtonValue:
+		return "GTK_ACCESSIBLE_ROLE_SPIN_BUTTON"
+	case AccessibleRoleStatusValue:
+		return "GTK_ACCESSIBLE_ROLE_STATUS"
+	case AccessibleRoleStructureValue:
+		return "GTK_ACCESSIBLE_ROLE_STRUCTURE"
+	case AccessibleRoleSwitchValue:
+		return "GTK_ACCESSIBLE_ROLE_SWITCH"
+	case AccessibleRoleTabValue:
+		return "GTK_ACCESSIBLE_ROLE_TAB"
+	case AccessibleRoleTableValue:
+		return "GTK_ACCESSIBLE_ROLE_TABLE"
+	case AccessibleRoleTabListValue:
+		return "GTK_ACCESSIBLE_ROLE_TAB_LIST"
+	case AccessibleRoleTabPanelValue:
+		return "GTK_ACCESSIBLE_ROLE_TAB_PANEL"
+	case AccessibleRoleTextBoxValue:
+		return "GTK_ACCESSIBLE_ROLE_TEXT_BOX"
+	case AccessibleRoleTimeValue:
+		return "GTK_ACCESSIBLE_ROLE_TIME"
+	case AccessibleRoleTimerValue:
+		return "GTK_ACCESSIBLE_ROLE_TIMER"
+	case AccessibleRoleToolbarValue:
+		return "GTK_ACCESSIBLE_ROLE_TOOLBAR"
+	case AccessibleRoleTooltipValue:
+		return "GTK_ACCESSIBLE_ROLE_TOOLTIP"
+	case AccessibleRoleTreeValue:
+		return "GTK_ACCESSIBLE_ROLE_TREE"
+	case AccessibleRoleTreeGridValue:
+		return "GTK_ACCESSIBLE_ROLE_TREE_GRID"
+	case AccessibleRoleTreeItemValue:
+		return "GTK_ACCESSIBLE_ROLE_TREE_ITEM"
+	case AccessibleRoleWidgetValue:
+		return "GTK_ACCESSIBLE_ROLE_WIDGET"
+	case AccessibleRoleWindowValue:
+		return "GTK_ACCESSIBLE_ROLE_WINDOW"
+	case AccessibleRoleToggleButtonValue:
+		return "GTK_ACCESSIBLE_ROLE_TOGGLE_BUTTON"
+	case AccessibleRoleApplicationValue:
+		return "GTK_ACCESSIBLE_ROLE_APPLICATION"
+	case AccessibleRoleParagraphValue:
+		return "GTK_ACCESSIBLE_ROLE_PARAGRAPH"
+	case AccessibleRoleBlockQuoteValue:
+		return "GTK_ACCESSIBLE_ROLE_BLOCK_QUOTE"
+	case AccessibleRoleArticleValue:
+		return "GTK_ACCESSIBLE_ROLE_ARTICLE"
+	case AccessibleRoleCommentValue:
+		return "GTK_ACCESSIBLE_ROLE_COMMENT"
+	case AccessibleRoleTerminalValue:
+		return "GTK_ACCESSIBLE_ROLE_TERMINAL"
+	}
+	return fmt.Sprintf("AccessibleRole(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleRole) Nick() string {
+	switch x {
+	case AccessibleRoleAlertValue:
+		return "alert"
+	case AccessibleRoleAlertDialogValue:
+		return "alert-dialog"
+	case AccessibleRoleBannerValue:
+		return "banner"
+	case AccessibleRoleButtonValue:
+		return "button"
+	case AccessibleRoleCaptionValue:
+		return "caption"
+	case AccessibleRoleCellValue:
+		return "cell"
+	case AccessibleRoleCheckboxValue:
+		return "checkbox"
+	case AccessibleRoleColumnHeaderValue:
+		return "column-header"
+	case AccessibleRoleComboBoxValue:
+		return "combo-box"
+	case AccessibleRoleCommandValue:
+		return "command"
+	case AccessibleRoleCompositeValue:
+		return "composite"
+	case AccessibleRoleDialogValue:
+		return "dialog"
+	case AccessibleRoleDocumentValue:
+		return "document"
+	case AccessibleRoleFeedValue:
+		return "feed"
+	case AccessibleRoleFormValue:
+		return "form"
+	case AccessibleRoleGenericValue:
+		return "generic"
+	case AccessibleRoleGridValue:
+		return "grid"
+	case AccessibleRoleGridCellValue:
+		return "grid-cell"
+	case AccessibleRoleGroupValue:
+		return "group"
+	case AccessibleRoleHeadingValue:
+		return "heading"
+	case AccessibleRoleImgValue:
+		return "img"
+	case AccessibleRoleInputValue:
+		return "input"
+	case AccessibleRoleLabelValue:
+		return "label"
+	case AccessibleRoleLandmarkValue:
+		return "landmark"
+	case AccessibleRoleLegendValue:
+		return "legend"
+	case AccessibleRoleLinkValue:
+		return "link"
+	case AccessibleRoleListValue:
+		return "list"
+	case AccessibleRoleListBoxValue:
+		return "list-box"
+	case AccessibleRoleListItemValue:
+		return "list-item"
+	case AccessibleRoleLogValue:
+		return "log"
+	case AccessibleRoleMainValue:
+		return "main"
+	case AccessibleRoleMarqueeValue:
+		return "marquee"
+	case AccessibleRoleMathValue:
+		return "math"
+	case AccessibleRoleMeterValue:
+		return "meter"
+	case AccessibleRoleMenuValue:
+		return "menu"
+	case AccessibleRoleMenuBarValue:
+		return "menu-bar"
+	case AccessibleRoleMenuItemValue:
+		return "menu-item"
+	case AccessibleRoleMenuItemCheckboxValue:
+		return "menu-item-checkbox"
+	case AccessibleRoleMenuItemRadioValue:
+		return "menu-item-radio"
+	case AccessibleRoleNavigationValue:
+		return "navigation"
+	case AccessibleRoleNoneValue:
+		return "none"
+	case AccessibleRoleNoteValue:
+		return "note"
+	case AccessibleRoleOptionValue:
+		return "option"
+	case AccessibleRolePresentationValue:
+		return "presentation"
+	case AccessibleRoleProgressBarValue:
+		return "progress-bar"
+	case AccessibleRoleRadioValue:
+		return "radio"
+	case AccessibleRoleRadioGroupValue:
+		return "radio-group"
+	case AccessibleRoleRangeValue:
+		return "range"
+	case AccessibleRoleRegionValue:
+		return "region"
+	case AccessibleRoleRowValue:
+		return "row"
+	case AccessibleRoleRowGroupValue:
+		return "row-group"
+	case AccessibleRoleRowHeaderValue:
+		return "row-header"
+	case AccessibleRoleScrollbarValue:
+		return "scrollbar"
+	case AccessibleRoleSearchValue:
+		return "search"
+	case AccessibleRoleSearchBoxValue:
+		return "search-box"
+	case AccessibleRoleSectionValue:
+		return "section"
+	case AccessibleRoleSectionHeadValue:
+		return "section-head"
+	case AccessibleRoleSelectValue:
+		return "select"
+	case AccessibleRoleSeparatorValue:
+		return "separator"
+	case AccessibleRoleSliderValue:
+		return "slider"
+	case AccessibleRoleSpinButtonValue:
+		return "spin-button"
+	case AccessibleRoleStatusValue:
+		return "status"
+	case AccessibleRoleStructureValue:
+		return "structure"
+	case AccessibleRoleSwitchValue:
+		return "switch"
+	case AccessibleRoleTabValue:
+		return "tab"
+	case AccessibleRoleTableValue:
+		return "table"
+	case AccessibleRoleTabListValue:
+		return "tab-list"
+	case AccessibleRoleTabPanelValue:
+		return "tab-panel"
+	case AccessibleRoleTextBoxValue:
+		return "text-box"
+	case AccessibleRoleTimeValue:
+		return "time"
+	case AccessibleRoleTimerValue:
+		return "timer"
+	case AccessibleRoleToolbarValue:
+		return "toolbar"
+	case AccessibleRoleTooltipValue:
+		return "tooltip"
+	case AccessibleRoleTreeValue:
+		return "tree"
+	case AccessibleRoleTreeGridValue:
+		return "tree-grid"
+	case AccessibleRoleTreeItemValue:
+		return "tree-item"
+	case AccessibleRoleWidgetValue:
+		return "widget"
+	case AccessibleRoleWindowValue:
+		return "window"
+	case AccessibleRoleToggleButtonValue:
+		return "toggle-button"
+	case AccessibleRoleApplicationValue:
+		return "application"
+	case AccessibleRoleParagraphValue:
+		return "paragraph"
+	case AccessibleRoleBlockQuoteValue:
+		return "block-quote"
+	case AccessibleRoleArticleValue:
+		return "article"
+	case AccessibleRoleCommentValue:
+		return "comment"
+	case AccessibleRoleTerminalValue:
+		return "terminal"
+	}
+	return ""
+}
+
+// AccessibleRoleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleRoleFromString(s string) (AccessibleRole, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_ROLE_ALERT", "alert":
+		return AccessibleRoleAlertValue, true
+	case "GTK_ACCESSIBLE_ROLE_ALERT_DIALOG", "alert-dialog":
+		return AccessibleRoleAlertDialogValue, true
+	case "GTK_ACCESSIBLE_ROLE_BANNER", "banner":
+		return AccessibleRoleBannerValue, true
+	case "GTK_ACCESSIBLE_ROLE_BUTTON", "button":
+		return AccessibleRoleButtonValue, true
+	case "GTK_ACCESSIBLE_ROLE_CAPTION", "caption":
+		return AccessibleRoleCaptionValue, true
+	case "GTK_ACCESSIBLE_ROLE_CELL", "cell":
+		return AccessibleRoleCellValue, true
+	case "GTK_ACCESSIBLE_ROLE_CHECKBOX", "checkbox":
+		return AccessibleRoleCheckboxValue, true
+	case "GTK_ACCESSIBLE_ROLE_COLUMN_HEADER", "column-header":
+		return AccessibleRoleColumnHeaderValue, true
+	case "GTK_ACCESSIBLE_ROLE_COMBO_BOX", "combo-box":
+		return AccessibleRoleComboBoxValue, true
+	case "GTK_ACCESSIBLE_ROLE_COMMAND", "command":
+		return AccessibleRoleCommandValue, true
+	case "GTK_ACCESSIBLE_ROLE_COMPOSITE", "composite":
+		return AccessibleRoleCompositeValue, true
+	case "GTK_ACCESSIBLE_ROLE_DIALOG", "dialog":
+		return AccessibleRoleDialogValue, true
+	case "GTK_ACCESSIBLE_ROLE_DOCUMENT", "document":
+		return AccessibleRoleDocumentValue, true
+	case "GTK_ACCESSIBLE_ROLE_FEED", "feed":
+		return AccessibleRoleFeedValue, true
+	case "GTK_ACCESSIBLE_ROLE_FORM", "form":
+		return AccessibleRoleFormValue, true
+	case "GTK_ACCESSIBLE_ROLE_GENERIC", "generic":
+		return AccessibleRoleGenericValue, true
+	case "GTK_ACCESSIBLE_ROLE_GRID", "grid":
+		return AccessibleRoleGridValue, true
+	case "GTK_ACCESSIBLE_ROLE_GRID_CELL", "grid-cell":
+		return AccessibleRoleGridCellValue, true
+	case "GTK_ACCESSIBLE_ROLE_GROUP", "group":
+		return AccessibleRoleGroupValue, true
+	case "GTK_ACCESSIBLE_ROLE_HEADING", "heading":
+		return AccessibleRoleHeadingValue, true
+	case "GTK_ACCESSIBLE_ROLE_IMG", "img":
+		return AccessibleRoleImgValue, true
+	case "GTK_ACCESSIBLE_ROLE_INPUT", "input":
+		return AccessibleRoleInputValue, true
+	case "GTK_ACCESSIBLE_ROLE_LABEL", "label":
+		return AccessibleRoleLabelValue, true
+	case "GTK_ACCESSIBLE_ROLE_LANDMARK", "landmark":
+		return AccessibleRoleLandmarkValue, true
+	case "GTK_ACCESSIBLE_ROLE_LEGEND", "legend":
+		return AccessibleRoleLegendValue, true
+	case "GTK_ACCESSIBLE_ROLE_LINK", "link":
+		return AccessibleRoleLinkValue, true
+	case "GTK_ACCESSIBLE_ROLE_LIST", "list":
+		return AccessibleRoleListValue, true
+	case "GTK_ACCESSIBLE_ROLE_LIST_BOX", "list-box":
+		return AccessibleRoleListBoxValue, true
+	case "GTK_ACCESSIBLE_ROLE_LIST_ITEM", "list-item":
+		return AccessibleRoleListItemValue, true
+	case "GTK_ACCESSIBLE_ROLE_LOG", "log":
+		return AccessibleRoleLogValue, true
+	case "GTK_ACCESSIBLE_ROLE_MAIN", "main":
+		return AccessibleRoleMainValue, true
+	case "GTK_ACCESSIBLE_ROLE_MARQUEE", "marquee":
+		return AccessibleRoleMarqueeValue, true
+	case "GTK_ACCESSIBLE_ROLE_MATH", "math":
+		return AccessibleRoleMathValue, true
+	case "GTK_ACCESSIBLE_ROLE_METER", "meter":
+		return AccessibleRoleMeterValue, true
+	case "GTK_ACCESSIBLE_ROLE_MENU", "menu":
+		return AccessibleRoleMenuValue, true
+	case "GTK_ACCESSIBLE_ROLE_MENU_BAR", "menu-bar":
+		return AccessibleRoleMenuBarValue, true
+	case "GTK_ACCESSIBLE_ROLE_MENU_ITEM", "menu-item":
+		return AccessibleRoleMenuItemValue, true
+	case "GTK_ACCESSIBLE_ROLE_MENU_ITEM_CHECKBOX", "menu-item-checkbox":
+		return AccessibleRoleMenuItemCheckboxValue, true
+	case "GTK_ACCESSIBLE_ROLE_MENU_ITEM_RADIO", "menu-item-radio":
+		return AccessibleRoleMenuItemRadioValue, true
+	case "GTK_ACCESSIBLE_ROLE_NAVIGATION", "navigation":
+		return AccessibleRoleNavigationValue, true
+	case "GTK_ACCESSIBLE_ROLE_NONE", "none":
+		return AccessibleRoleNoneValue, true
+	case "GTK_ACCESSIBLE_ROLE_NOTE", "note":
+		return AccessibleRoleNoteValue, true
+	case "GTK_ACCESSIBLE_ROLE_OPTION", "option":
+		return AccessibleRoleOptionValue, true
+	case "GTK_ACCESSIBLE_ROLE_PRESENTATION", "presentation":
+		return AccessibleRolePresentationValue, true
+	case "GTK_ACCESSIBLE_ROLE_PROGRESS_BAR", "progress-bar":
+		return AccessibleRoleProgressBarValue, true
+	case "GTK_ACCESSIBLE_ROLE_RADIO", "radio":
+		return AccessibleRoleRadioValue, true
+	case "GTK_ACCESSIBLE_ROLE_RADIO_GROUP", "radio-group":
+		return AccessibleRoleRadioGroupValue, true
+	case "GTK_ACCESSIBLE_ROLE_RANGE", "range":
+		return AccessibleRoleRangeValue, true
+	case "GTK_ACCESSIBLE_ROLE_REGION", "region":
+		return AccessibleRoleRegionValue, true
+	case "GTK_ACCESSIBLE_ROLE_ROW", "row":
+		return AccessibleRoleRowValue, true
+	case "GTK_ACCESSIBLE_ROLE_ROW_GROUP", "row-group":
+		return AccessibleRoleRowGroupValue, true
+	case "GTK_ACCESSIBLE_ROLE_ROW_HEADER", "row-header":
+		return AccessibleRoleRowHeaderValue, true
+	case "GTK_ACCESSIBLE_ROLE_SCROLLBAR", "scrollbar":
+		return AccessibleRoleScrollbarValue, true
+	case "GTK_ACCESSIBLE_ROLE_SEARCH", "search":
+		return AccessibleRoleSearchValue, true
+	case "GTK_ACCESSIBLE_ROLE_SEARCH_BOX", "search-box":
+		return AccessibleRoleSearchBoxValue, true
+	case "GTK_ACCESSIBLE_ROLE_SECTION", "section":
+		return AccessibleRoleSectionValue, true
+	case "GTK_ACCESSIBLE_ROLE_SECTION_HEAD", "section-head":
+		return AccessibleRoleSectionHeadValue, true
+	case "GTK_ACCESSIBLE_ROLE_SELECT", "select":
+		return AccessibleRoleSelectValue, true
+	case "GTK_ACCESSIBLE_ROLE_SEPARATOR", "separator":
+		return AccessibleRoleSeparatorValue, true
+	case "GTK_ACCESSIBLE_ROLE_SLIDER", "slider":
+		return AccessibleRoleSliderValue, true
+	case "GTK_ACCESSIBLE_ROLE_SPIN_BUTTON", "spin-button":
+		return AccessibleRoleSpinButtonValue, true
+	case "GTK_ACCESSIBLE_ROLE_STATUS", "status":
+		return AccessibleRoleStatusValue, true
+	case "GTK_ACCESSIBLE_ROLE_STRUCTURE", "structure":
+		return AccessibleRoleStructureValue, true
+	case "GTK_ACCESSIBLE_ROLE_SWITCH", "switch":
+		return AccessibleRoleSwitchValue, true
+	case "GTK_ACCESSIBLE_ROLE_TAB", "tab":
+		return AccessibleRoleTabValue, true
+	case "GTK_ACCESSIBLE_ROLE_TABLE", "table":
+		return AccessibleRoleTableValue, true
+	case "GTK_ACCESSIBLE_ROLE_TAB_LIST", "tab-list":
+		return AccessibleRoleTabListValue, true
+	case "GTK_ACCESSIBLE_ROLE_TAB_PANEL", "tab-panel":
+		return AccessibleRoleTabPanelValue, true
+	case "GTK_ACCESSIBLE_ROLE_TEXT_BOX", "text-box":
+		return AccessibleRoleTextBoxValue, true
+	case "GTK_ACCESSIBLE_ROLE_TIME", "time":
+		return AccessibleRoleTimeValue, true
+	case "GTK_ACCESSIBLE_ROLE_TIMER", "timer":
+		return AccessibleRoleTimerValue, true
+	case "GTK_ACCESSIBLE_ROLE_TOOLBAR", "toolbar":
+		return AccessibleRoleToolbarValue, true
+	case "GTK_ACCESSIBLE_ROLE_TOOLTIP", "tooltip":
+		return AccessibleRoleTooltipValue, true
+	case "GTK_ACCESSIBLE_ROLE_TREE", "tree":
+		return AccessibleRoleTreeValue, true
+	case "GTK_ACCESSIBLE_ROLE_TREE_GRID", "tree-grid":
+		return AccessibleRoleTreeGridValue, true
+	case "GTK_ACCESSIBLE_ROLE_TREE_ITEM", "tree-item":
+		return AccessibleRoleTreeItemValue, true
+	case "GTK_ACCESSIBLE_ROLE_WIDGET", "widget":
+		return AccessibleRoleWidgetValue, true
+	case "GTK_ACCESSIBLE_ROLE_WINDOW", "window":
+		return AccessibleRoleWindowValue, true
+	case "GTK_ACCESSIBLE_ROLE_TOGGLE_BUTTON", "toggle-button":
+		return AccessibleRoleToggleButtonValue, true
+	case "GTK_ACCESSIBLE_ROLE_APPLICATION", "application":
+		return AccessibleRoleApplicationValue, true
+	case "GTK_ACCESSIBLE_ROLE_PARAGRAPH", "paragraph":
+		return AccessibleRoleParagraphValue, true
+	case "GTK_ACCESSIBLE_ROLE_BLOCK_QUOTE", "block-quote":
+		return AccessibleRoleBlockQuoteValue, true
+	case "GTK_ACCESSIBLE_ROLE_ARTICLE", "article":
+		return AccessibleRoleArticleValue, true
+	case "GTK_ACCESSIBLE_ROLE_COMMENT", "comment":
+		return AccessibleRoleCommentValue, true
+	case "GTK_ACCESSIBLE_ROLE_TERMINAL", "terminal":
+		return AccessibleRoleTerminalValue, true
+	}
+	return 0, false
+}
+
+// The possible values for the [AccessiblePropertySortValue]
 // accessible property.
 type AccessibleSort int
 
@@ -713,7 +2023,52 @@ const (
 	AccessibleSortOtherValue AccessibleSort = 3
 )
 
-// The possible accessible states of a [iface@Accessible].
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleSort) String() string {
+	switch x {
+	case AccessibleSortNoneValue:
+		return "GTK_ACCESSIBLE_SORT_NONE"
+	case AccessibleSortAscendingValue:
+		return "GTK_ACCESSIBLE_SORT_ASCENDING"
+	case AccessibleSortDescendingValue:
+		return "GTK_ACCESSIBLE_SORT_DESCENDING"
+	case AccessibleSortOtherValue:
+		return "GTK_ACCESSIBLE_SORT_OTHER"
+	}
+	return fmt.Sprintf("AccessibleSort(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleSort) Nick() string {
+	switch x {
+	case AccessibleSortNoneValue:
+		return "none"
+	case AccessibleSortAscendingValue:
+		return "ascending"
+	case AccessibleSortDescendingValue:
+		return "descending"
+	case AccessibleSortOtherValue:
+		return "other"
+	}
+	return ""
+}
+
+// AccessibleSortFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleSortFromString(s string) (AccessibleSort, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_SORT_NONE", "none":
+		return AccessibleSortNoneValue, true
+	case "GTK_ACCESSIBLE_SORT_ASCENDING", "ascending":
+		return AccessibleSortAscendingValue, true
+	case "GTK_ACCESSIBLE_SORT_DESCENDING", "descending":
+		return AccessibleSortDescendingValue, true
+	case "GTK_ACCESSIBLE_SORT_OTHER", "other":
+		return AccessibleSortOtherValue, true
+	}
+	return 0, false
+}
+
+// The possible accessible states of a Accessible.
 type AccessibleState int
 
 var xAccessibleStateGLibType func() types.GType
@@ -727,27 +2082,27 @@ const (
 	// A “busy” state. This state has boolean values
 	AccessibleStateBusyValue AccessibleState = 0
 	// A “checked” state; indicates the current
-	//   state of a [class@CheckButton]. Value type: [enum@AccessibleTristate]
+	//   state of a CheckButton. Value type: AccessibleTristate
 	AccessibleStateCheckedValue AccessibleState = 1
 	// A “disabled” state; corresponds to the
-	//   [property@Widget:sensitive] property. It indicates a UI element
+	//   Widget:sensitive property. It indicates a UI element
 	//   that is perceivable, but not editable or operable. Value type: boolean
 	AccessibleStateDisabledValue AccessibleState = 2
 	// An “expanded” state; corresponds to the
-	//   [property@Expander:expanded] property. Value type: boolean
+	//   Expander:expanded property. Value type: boolean
 	//   or undefined
 	AccessibleStateExpandedValue AccessibleState = 3
 	// A “hidden” state; corresponds to the
-	//   [property@Widget:visible] property. You can use this state
+	//   Widget:visible property. You can use this state
 	//   explicitly on UI elements that should not be exposed to an assistive
 	//   technology. Value type: boolean
-	//   See also: %GTK_ACCESSIBLE_STATE_DISABLED
+	//   See also: [AccessibleStateDisabledValue]
 	AccessibleStateHiddenValue AccessibleState = 4
 	// An “invalid” state; set when a widget
-	//   is showing an error. Value type: [enum@AccessibleInvalidState]
+	//   is showing an error. Value type: AccessibleInvalidState
 	AccessibleStateInvalidValue AccessibleState = 5
 	// A “pressed” state; indicates the current
-	//   state of a [class@ToggleButton]. Value type: [enum@AccessibleTristate]
+	//   state of a ToggleButton. Value type: AccessibleTristate
 	//   enumeration
 	AccessibleStatePressedValue AccessibleState = 6
 	// A “selected” state; set when a widget
@@ -758,12 +2113,87 @@ const (
 	AccessibleStateVisitedValue AccessibleState = 8
 )
 
-// The possible values for the %GTK_ACCESSIBLE_STATE_PRESSED
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleState) String() string {
+	switch x {
+	case AccessibleStateBusyValue:
+		return "GTK_ACCESSIBLE_STATE_BUSY"
+	case AccessibleStateCheckedValue:
+		return "GTK_ACCESSIBLE_STATE_CHECKED"
+	case AccessibleStateDisabledValue:
+		return "GTK_ACCESSIBLE_STATE_DISABLED"
+	case AccessibleStateExpandedValue:
+		return "GTK_ACCESSIBLE_STATE_EXPANDED"
+	case AccessibleStateHiddenValue:
+		return "GTK_ACCESSIBLE_STATE_HIDDEN"
+	case AccessibleStateInvalidValue:
+		return "GTK_ACCESSIBLE_STATE_INVALID"
+	case AccessibleStatePressedValue:
+		return "GTK_ACCESSIBLE_STATE_PRESSED"
+	case AccessibleStateSelectedValue:
+		return "GTK_ACCESSIBLE_STATE_SELECTED"
+	case AccessibleStateVisitedValue:
+		return "GTK_ACCESSIBLE_STATE_VISITED"
+	}
+	return fmt.Sprintf("AccessibleState(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleState) Nick() string {
+	switch x {
+	case AccessibleStateBusyValue:
+		return "busy"
+	case AccessibleStateCheckedValue:
+		return "checked"
+	case AccessibleStateDisabledValue:
+		return "disabled"
+	case AccessibleStateExpandedValue:
+		return "expanded"
+	case AccessibleStateHiddenValue:
+		return "hidden"
+	case AccessibleStateInvalidValue:
+		return "invalid"
+	case AccessibleStatePressedValue:
+		return "pressed"
+	case AccessibleStateSelectedValue:
+		return "selected"
+	case AccessibleStateVisitedValue:
+		return "visited"
+	}
+	return ""
+}
+
+// AccessibleStateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleStateFromString(s string) (AccessibleState, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_STATE_BUSY", "busy":
+		return AccessibleStateBusyValue, true
+	case "GTK_ACCESSIBLE_STATE_CHECKED", "checked":
+		return AccessibleStateCheckedValue, true
+	case "GTK_ACCESSIBLE_STATE_DISABLED", "disabled":
+		return AccessibleStateDisabledValue, true
+	case "GTK_ACCESSIBLE_STATE_EXPANDED", "expanded":
+		return AccessibleStateExpandedValue, true
+	case "GTK_ACCESSIBLE_STATE_HIDDEN", "hidden":
+		return AccessibleStateHiddenValue, true
+	case "GTK_ACCESSIBLE_STATE_INVALID", "invalid":
+		return AccessibleStateInvalidValue, true
+	case "GTK_ACCESSIBLE_STATE_PRESSED", "pressed":
+		return AccessibleStatePressedValue, true
+	case "GTK_ACCESSIBLE_STATE_SELECTED", "selected":
+		return AccessibleStateSelectedValue, true
+	case "GTK_ACCESSIBLE_STATE_VISITED", "visited":
+		return AccessibleStateVisitedValue, true
+	}
+	return 0, false
+}
+
+// The possible values for the [AccessibleStatePressedValue]
 // accessible state.
 //
-// Note that the %GTK_ACCESSIBLE_TRISTATE_FALSE and
-// %GTK_ACCESSIBLE_TRISTATE_TRUE have the same values
-// as %FALSE and %TRUE.
+// Note that the [AccessibleTristateFalseValue] and
+// [AccessibleTristateTrueValue] have the same values
+// as false and true.
 type AccessibleTristate int
 
 var xAccessibleTristateGLibType func() types.GType
@@ -782,11 +2212,50 @@ const (
 	AccessibleTristateMixedValue AccessibleTristate = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AccessibleTristate) String() string {
+	switch x {
+	case AccessibleTristateFalseValue:
+		return "GTK_ACCESSIBLE_TRISTATE_FALSE"
+	case AccessibleTristateTrueValue:
+		return "GTK_ACCESSIBLE_TRISTATE_TRUE"
+	case AccessibleTristateMixedValue:
+		return "GTK_ACCESSIBLE_TRISTATE_MIXED"
+	}
+	return fmt.Sprintf("AccessibleTristate(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AccessibleTristate) Nick() string {
+	switch x {
+	case AccessibleTristateFalseValue:
+		return "false"
+	case AccessibleTristateTrueValue:
+		return "true"
+	case AccessibleTristateMixedValue:
+		return "mixed"
+	}
+	return ""
+}
+
+// AccessibleTristateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AccessibleTristateFromString(s string) (AccessibleTristate, bool) {
+	switch s {
+	case "GTK_ACCESSIBLE_TRISTATE_FALSE", "false":
+		return AccessibleTristateFalseValue, true
+	case "GTK_ACCESSIBLE_TRISTATE_TRUE", "true":
+		return AccessibleTristateTrueValue, true
+	case "GTK_ACCESSIBLE_TRISTATE_MIXED", "mixed":
+		return AccessibleTristateMixedValue, true
+	}
+	return 0, false
+}
+
 // Controls how a widget deals with extra space in a single dimension.
 //
 // Alignment only matters if the widget receives a “too large” allocation,
-// for example if you packed the widget with the [property@Gtk.Widget:hexpand]
-// property inside a [class@Box], then the widget might get extra space.
+// for example if you packed the widget with the [Widget.GetPropertyHexpand]
+// property inside a Box, then the widget might get extra space.
 // If you have for example a 16x16 icon inside a 32x32 space, the icon
 // could be scaled and stretched, it could be centered, or it could be
 // positioned to one side of the space.
@@ -820,11 +2289,72 @@ const (
 	// a different name for `GTK_ALIGN_BASELINE`.
 	AlignBaselineFillValue Align = 4
 	// align the widget according to the baseline.
+	//
+	// Deprecated: since 4.12. Use `GTK_ALIGN_BASELINE_FILL` instead
 	AlignBaselineValue Align = 4
 	// stretch to fill all space, but align the baseline.
 	AlignBaselineCenterValue Align = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Align) String() string {
+	switch x {
+	case AlignFillValue:
+		return "GTK_ALIGN_FILL"
+	case AlignStartValue:
+		return "GTK_ALIGN_START"
+	case AlignEndValue:
+		return "GTK_ALIGN_END"
+	case AlignCenterValue:
+		return "GTK_ALIGN_CENTER"
+	case AlignBaselineFillValue:
+		return "GTK_ALIGN_BASELINE_FILL"
+	case AlignBaselineCenterValue:
+		return "GTK_ALIGN_BASELINE_CENTER"
+	}
+	return fmt.Sprintf("Align(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Align) Nick() string {
+	switch x {
+	case AlignFillValue:
+		return "fill"
+	case AlignStartValue:
+		return "start"
+	case AlignEndValue:
+		return "end"
+	case AlignCenterValue:
+		return "center"
+	case AlignBaselineFillValue:
+		return "baseline-fill"
+	case AlignBaselineCenterValue:
+		return "baseline-center"
+	}
+	return ""
+}
+
+// AlignFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AlignFromString(s string) (Align, bool) {
+	switch s {
+	case "GTK_ALIGN_FILL", "fill":
+		return AlignFillValue, true
+	case "GTK_ALIGN_START", "start":
+		return AlignStartValue, true
+	case "GTK_ALIGN_END", "end":
+		return AlignEndValue, true
+	case "GTK_ALIGN_CENTER", "center":
+		return AlignCenterValue, true
+	case "GTK_ALIGN_BASELINE_FILL", "baseline-fill":
+		return AlignBaselineFillValue, true
+	case "GTK_ALIGN_BASELINE", "baseline":
+		return AlignBaselineValue, true
+	case "GTK_ALIGN_BASELINE_CENTER", "baseline-center":
+		return AlignBaselineCenterValue, true
+	}
+	return 0, false
+}
+
 // Indicates the direction in which an arrow should point.
 type ArrowType int
 
@@ -848,6 +2378,57 @@ const (
 	ArrowNoneValue ArrowType = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ArrowType) String() string {
+	switch x {
+	case ArrowUpValue:
+		return "GTK_ARROW_UP"
+	case ArrowDownValue:
+		return "GTK_ARROW_DOWN"
+	case ArrowLeftValue:
+		return "GTK_ARROW_LEFT"
+	case ArrowRightValue:
+		return "GTK_ARROW_RIGHT"
+	case ArrowNoneValue:
+		return "GTK_ARROW_NONE"
+	}
+	return fmt.Sprintf("ArrowType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ArrowType) Nick() string {
+	switch x {
+	case ArrowUpValue:
+		return "up"
+	case ArrowDownValue:
+		return "down"
+	case ArrowLeftValue:
+		return "left"
+	case ArrowRightValue:
+		return "right"
+	case ArrowNoneValue:
+		return "none"
+	}
+	return ""
+}
+
+// ArrowTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ArrowTypeFromString(s string) (ArrowType, bool) {
+	switch s {
+	case "GTK_ARROW_UP", "up":
+		return ArrowUpValue, true
+	case "GTK_ARROW_DOWN", "down":
+		return ArrowDownValue, true
+	case "GTK_ARROW_LEFT", "left":
+		return ArrowLeftValue, true
+	case "GTK_ARROW_RIGHT", "right":
+		return ArrowRightValue, true
+	case "GTK_ARROW_NONE", "none":
+		return ArrowNoneValue, true
+	}
+	return 0, false
+}
+
 // Baseline position in a row of widgets.
 //
 // Whenever a container has some form of natural row it may align
@@ -874,6 +2455,45 @@ const (
 	BaselinePositionBottomValue BaselinePosition = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x BaselinePosition) String() string {
+	switch x {
+	case BaselinePositionTopValue:
+		return "GTK_BASELINE_POSITION_TOP"
+	case BaselinePositionCenterValue:
+		return "GTK_BASELINE_POSITION_CENTER"
+	case BaselinePositionBottomValue:
+		return "GTK_BASELINE_POSITION_BOTTOM"
+	}
+	return fmt.Sprintf("BaselinePosition(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BaselinePosition) Nick() string {
+	switch x {
+	case BaselinePositionTopValue:
+		return "top"
+	case BaselinePositionCenterValue:
+		return "center"
+	case BaselinePositionBottomValue:
+		return "bottom"
+	}
+	return ""
+}
+
+// BaselinePositionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BaselinePositionFromString(s string) (BaselinePosition, bool) {
+	switch s {
+	case "GTK_BASELINE_POSITION_TOP", "top":
+		return BaselinePositionTopValue, true
+	case "GTK_BASELINE_POSITION_CENTER", "center":
+		return BaselinePositionCenterValue, true
+	case "GTK_BASELINE_POSITION_BOTTOM", "bottom":
+		return BaselinePositionBottomValue, true
+	}
+	return 0, false
+}
+
 // Describes how the border of a UI element should be rendered.
 type BorderStyle int
 
@@ -887,7 +2507,7 @@ const (
 
 	// No visible border
 	BorderStyleNoneValue BorderStyle = 0
-	// Same as %GTK_BORDER_STYLE_NONE
+	// Same as [BorderStyleNoneValue]
 	BorderStyleHiddenValue BorderStyle = 1
 	// A single line segment
 	BorderStyleSolidValue BorderStyle = 2
@@ -907,7 +2527,88 @@ const (
 	BorderStyleRidgeValue BorderStyle = 9
 )
 
-// The widget attributes that can be used when creating a [class@Constraint].
+// String returns the C name of the value, e.g. for debugging output
+func (x BorderStyle) String() string {
+	switch x {
+	case BorderStyleNoneValue:
+		return "GTK_BORDER_STYLE_NONE"
+	case BorderStyleHiddenValue:
+		return "GTK_BORDER_STYLE_HIDDEN"
+	case BorderStyleSolidValue:
+		return "GTK_BORDER_STYLE_SOLID"
+	case BorderStyleInsetValue:
+		return "GTK_BORDER_STYLE_INSET"
+	case BorderStyleOutsetValue:
+		return "GTK_BORDER_STYLE_OUTSET"
+	case BorderStyleDottedValue:
+		return "GTK_BORDER_STYLE_DOTTED"
+	case BorderStyleDashedValue:
+		return "GTK_BORDER_STYLE_DASHED"
+	case BorderStyleDoubleValue:
+		return "GTK_BORDER_STYLE_DOUBLE"
+	case BorderStyleGrooveValue:
+		return "GTK_BORDER_STYLE_GROOVE"
+	case BorderStyleRidgeValue:
+		return "GTK_BORDER_STYLE_RIDGE"
+	}
+	return fmt.Sprintf("BorderStyle(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BorderStyle) Nick() string {
+	switch x {
+	case BorderStyleNoneValue:
+		return "none"
+	case BorderStyleHiddenValue:
+		return "hidden"
+	case BorderStyleSolidValue:
+		return "solid"
+	case BorderStyleInsetValue:
+		return "inset"
+	case BorderStyleOutsetValue:
+		return "outset"
+	case BorderStyleDottedValue:
+		return "dotted"
+	case BorderStyleDashedValue:
+		return "dashed"
+	case BorderStyleDoubleValue:
+		return "double"
+	case BorderStyleGrooveValue:
+		return "groove"
+	case BorderStyleRidgeValue:
+		return "ridge"
+	}
+	return ""
+}
+
+// BorderStyleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BorderStyleFromString(s string) (BorderStyle, bool) {
+	switch s {
+	case "GTK_BORDER_STYLE_NONE", "none":
+		return BorderStyleNoneValue, true
+	case "GTK_BORDER_STYLE_HIDDEN", "hidden":
+		return BorderStyleHiddenValue, true
+	case "GTK_BORDER_STYLE_SOLID", "solid":
+		return BorderStyleSolidValue, true
+	case "GTK_BORDER_STYLE_INSET", "inset":
+		return BorderStyleInsetValue, true
+	case "GTK_BORDER_STYLE_OUTSET", "outset":
+		return BorderStyleOutsetValue, true
+	case "GTK_BORDER_STYLE_DOTTED", "dotted":
+		return BorderStyleDottedValue, true
+	case "GTK_BORDER_STYLE_DASHED", "dashed":
+		return BorderStyleDashedValue, true
+	case "GTK_BORDER_STYLE_DOUBLE", "double":
+		return BorderStyleDoubleValue, true
+	case "GTK_BORDER_STYLE_GROOVE", "groove":
+		return BorderStyleGrooveValue, true
+	case "GTK_BORDER_STYLE_RIDGE", "ridge":
+		return BorderStyleRidgeValue, true
+	}
+	return 0, false
+}
+
+// The widget attributes that can be used when creating a Constraint.
 type ConstraintAttribute int
 
 var xConstraintAttributeGLibType func() types.GType
@@ -932,12 +2633,12 @@ const (
 	// The bottom edge of a widget
 	ConstraintAttributeBottomValue ConstraintAttribute = 4
 	// The leading edge of a widget, depending
-	//   on text direction; equivalent to %GTK_CONSTRAINT_ATTRIBUTE_LEFT for LTR
-	//   languages, and %GTK_CONSTRAINT_ATTRIBUTE_RIGHT for RTL ones
+	//   on text direction; equivalent to [ConstraintAttributeLeftValue] for LTR
+	//   languages, and [ConstraintAttributeRightValue] for RTL ones
 	ConstraintAttributeStartValue ConstraintAttribute = 5
 	// The trailing edge of a widget, depending
-	//   on text direction; equivalent to %GTK_CONSTRAINT_ATTRIBUTE_RIGHT for LTR
-	//   languages, and %GTK_CONSTRAINT_ATTRIBUTE_LEFT for RTL ones
+	//   on text direction; equivalent to [ConstraintAttributeRightValue] for LTR
+	//   languages, and [ConstraintAttributeLeftValue] for RTL ones
 	ConstraintAttributeEndValue ConstraintAttribute = 6
 	// The width of a widget
 	ConstraintAttributeWidthValue ConstraintAttribute = 7
@@ -953,6 +2654,99 @@ const (
 	ConstraintAttributeBaselineValue ConstraintAttribute = 11
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ConstraintAttribute) String() string {
+	switch x {
+	case ConstraintAttributeNoneValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_NONE"
+	case ConstraintAttributeLeftValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_LEFT"
+	case ConstraintAttributeRightValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_RIGHT"
+	case ConstraintAttributeTopValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_TOP"
+	case ConstraintAttributeBottomValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_BOTTOM"
+	case ConstraintAttributeStartValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_START"
+	case ConstraintAttributeEndValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_END"
+	case ConstraintAttributeWidthValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_WIDTH"
+	case ConstraintAttributeHeightValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_HEIGHT"
+	case ConstraintAttributeCenterXValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_CENTER_X"
+	case ConstraintAttributeCenterYValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_CENTER_Y"
+	case ConstraintAttributeBaselineValue:
+		return "GTK_CONSTRAINT_ATTRIBUTE_BASELINE"
+	}
+	return fmt.Sprintf("ConstraintAttribute(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConstraintAttribute) Nick() string {
+	switch x {
+	case ConstraintAttributeNoneValue:
+		return "none"
+	case ConstraintAttributeLeftValue:
+		return "left"
+	case ConstraintAttributeRightValue:
+		return "right"
+	case ConstraintAttributeTopValue:
+		return "top"
+	case ConstraintAttributeBottomValue:
+		return "bottom"
+	case ConstraintAttributeStartValue:
+		return "start"
+	case ConstraintAttributeEndValue:
+		return "end"
+	case ConstraintAttributeWidthValue:
+		return "width"
+	case ConstraintAttributeHeightValue:
+		return "height"
+	case ConstraintAttributeCenterXValue:
+		return "center-x"
+	case ConstraintAttributeCenterYValue:
+		return "center-y"
+	case ConstraintAttributeBaselineValue:
+		return "baseline"
+	}
+	return ""
+}
+
+// ConstraintAttributeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConstraintAttributeFromString(s string) (ConstraintAttribute, bool) {
+	switch s {
+	case "GTK_CONSTRAINT_ATTRIBUTE_NONE", "none":
+		return ConstraintAttributeNoneValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_LEFT", "left":
+		return ConstraintAttributeLeftValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_RIGHT", "right":
+		return ConstraintAttributeRightValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_TOP", "top":
+		return ConstraintAttributeTopValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_BOTTOM", "bottom":
+		return ConstraintAttributeBottomValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_START", "start":
+		return ConstraintAttributeStartValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_END", "end":
+		return ConstraintAttributeEndValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_WIDTH", "width":
+		return ConstraintAttributeWidthValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_HEIGHT", "height":
+		return ConstraintAttributeHeightValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_CENTER_X", "center-x":
+		return ConstraintAttributeCenterXValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_CENTER_Y", "center-y":
+		return ConstraintAttributeCenterYValue, true
+	case "GTK_CONSTRAINT_ATTRIBUTE_BASELINE", "baseline":
+		return ConstraintAttributeBaselineValue, true
+	}
+	return 0, false
+}
+
 // The relation between two terms of a constraint.
 type ConstraintRelation int
 
@@ -972,9 +2766,48 @@ const (
 	ConstraintRelationGeValue ConstraintRelation = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ConstraintRelation) String() string {
+	switch x {
+	case ConstraintRelationLeValue:
+		return "GTK_CONSTRAINT_RELATION_LE"
+	case ConstraintRelationEqValue:
+		return "GTK_CONSTRAINT_RELATION_EQ"
+	case ConstraintRelationGeValue:
+		return "GTK_CONSTRAINT_RELATION_GE"
+	}
+	return fmt.Sprintf("ConstraintRelation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConstraintRelation) Nick() string {
+	switch x {
+	case ConstraintRelationLeValue:
+		return "le"
+	case ConstraintRelationEqValue:
+		return "eq"
+	case ConstraintRelationGeValue:
+		return "ge"
+	}
+	return ""
+}
+
+// ConstraintRelationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConstraintRelationFromString(s string) (ConstraintRelation, bool) {
+	switch s {
+	case "GTK_CONSTRAINT_RELATION_LE", "le":
+		return ConstraintRelationLeValue, true
+	case "GTK_CONSTRAINT_RELATION_EQ", "eq":
+		return ConstraintRelationEqValue, true
+	case "GTK_CONSTRAINT_RELATION_GE", "ge":
+		return ConstraintRelationGeValue, true
+	}
+	return 0, false
+}
+
 // The strength of a constraint, expressed as a symbolic constant.
 //
-// The strength of a [class@Constraint] can be expressed with any positive
+// The strength of a Constraint can be expressed with any positive
 // integer; the values of this enumeration can be used for readability.
 type ConstraintStrength int
 
@@ -996,10 +2829,55 @@ const (
 	ConstraintStrengthWeakValue ConstraintStrength = 1
 )
 
-// Domain for VFL parsing errors.
-type ConstraintVflParserError int
+// String returns the C name of the value, e.g. for debugging output
+func (x ConstraintStrength) String() string {
+	switch x {
+	case ConstraintStrengthRequiredValue:
+		return "GTK_CONSTRAINT_STRENGTH_REQUIRED"
+	case ConstraintStrengthStrongValue:
+		return "GTK_CONSTRAINT_STRENGTH_STRONG"
+	case ConstraintStrengthMediumValue:
+		return "GTK_CONSTRAINT_STRENGTH_MEDIUM"
+	case ConstraintStrengthWeakValue:
+		return "GTK_CONSTRAINT_STRENGTH_WEAK"
+	}
+	return fmt.Sprintf("ConstraintStrength(%d)", int(x))
+}
 
-var xConstraintVflParserErrorGLibType func() types.GType
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConstraintStrength) Nick() string {
+	switch x {
+	case ConstraintStrengthRequiredValue:
+		return "required"
+	case ConstraintStrengthStrongValue:
+		return "strong"
+	case ConstraintStrengthMediumValue:
+		return "medium"
+	case ConstraintStrengthWeakValue:
+		return "weak"
+	}
+	return ""
+}
+
+// ConstraintStrengthFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConstraintStrengthFromString(s string) (ConstraintStrength, bool) {
+	switch s {
+	case "GTK_CONSTRAINT_STRENGTH_REQUIRED", "required":
+		return ConstraintStrengthRequiredValue, true
+	case "GTK_CONSTRAINT_STRENGTH_STRONG", "strong":
+		return ConstraintStrengthStrongValue, true
+	case "GTK_CONSTRAINT_STRENGTH_MEDIUM", "medium":
+		return ConstraintStrengthMediumValue, true
+	case "GTK_CONSTRAINT_STRENGTH_WEAK", "weak":
+		return ConstraintStrengthWeakValue, true
+	}
+	return 0, false
+}
+
+// Domain for VFL parsing errors.
+type ConstraintVflParserError int
+
+var xConstraintVflParserErrorGLibType func() types.GType
 
 func ConstraintVflParserErrorGLibType() types.GType {
 	return xConstraintVflParserErrorGLibType()
@@ -1021,6 +2899,87 @@ const (
 	ConstraintVflParserErrorInvalidRelationValue ConstraintVflParserError = 5
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (ConstraintVflParserError) ErrorDomain() string {
+	return "gtk-constraint-vfl-parser-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x ConstraintVflParserError) Error() string {
+	switch x {
+	case ConstraintVflParserErrorInvalidSymbolValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_SYMBOL"
+	case ConstraintVflParserErrorInvalidAttributeValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_ATTRIBUTE"
+	case ConstraintVflParserErrorInvalidViewValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_VIEW"
+	case ConstraintVflParserErrorInvalidMetricValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_METRIC"
+	case ConstraintVflParserErrorInvalidPriorityValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_PRIORITY"
+	case ConstraintVflParserErrorInvalidRelationValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_RELATION"
+	}
+	return fmt.Sprintf("gtk-constraint-vfl-parser-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x ConstraintVflParserError) String() string {
+	switch x {
+	case ConstraintVflParserErrorInvalidSymbolValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_SYMBOL"
+	case ConstraintVflParserErrorInvalidAttributeValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_ATTRIBUTE"
+	case ConstraintVflParserErrorInvalidViewValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_VIEW"
+	case ConstraintVflParserErrorInvalidMetricValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_METRIC"
+	case ConstraintVflParserErrorInvalidPriorityValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_PRIORITY"
+	case ConstraintVflParserErrorInvalidRelationValue:
+		return "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_RELATION"
+	}
+	return fmt.Sprintf("ConstraintVflParserError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ConstraintVflParserError) Nick() string {
+	switch x {
+	case ConstraintVflParserErrorInvalidSymbolValue:
+		return "symbol"
+	case ConstraintVflParserErrorInvalidAttributeValue:
+		return "attribute"
+	case ConstraintVflParserErrorInvalidViewValue:
+		return "view"
+	case ConstraintVflParserErrorInvalidMetricValue:
+		return "metric"
+	case ConstraintVflParserErrorInvalidPriorityValue:
+		return "priority"
+	case ConstraintVflParserErrorInvalidRelationValue:
+		return "relation"
+	}
+	return ""
+}
+
+// ConstraintVflParserErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ConstraintVflParserErrorFromString(s string) (ConstraintVflParserError, bool) {
+	switch s {
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_SYMBOL", "symbol":
+		return ConstraintVflParserErrorInvalidSymbolValue, true
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_ATTRIBUTE", "attribute":
+		return ConstraintVflParserErrorInvalidAttributeValue, true
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_VIEW", "view":
+		return ConstraintVflParserErrorInvalidViewValue, true
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_METRIC", "metric":
+		return ConstraintVflParserErrorInvalidMetricValue, true
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_PRIORITY", "priority":
+		return ConstraintVflParserErrorInvalidPriorityValue, true
+	case "GTK_CONSTRAINT_VFL_PARSER_ERROR_INVALID_RELATION", "relation":
+		return ConstraintVflParserErrorInvalidRelationValue, true
+	}
+	return 0, false
+}
+
 // Controls how a content should be made to fit inside an allocation.
 type ContentFit int
 
@@ -1052,6 +3011,51 @@ const (
 	ContentFitScaleDownValue ContentFit = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ContentFit) String() string {
+	switch x {
+	case ContentFitFillValue:
+		return "GTK_CONTENT_FIT_FILL"
+	case ContentFitContainValue:
+		return "GTK_CONTENT_FIT_CONTAIN"
+	case ContentFitCoverValue:
+		return "GTK_CONTENT_FIT_COVER"
+	case ContentFitScaleDownValue:
+		return "GTK_CONTENT_FIT_SCALE_DOWN"
+	}
+	return fmt.Sprintf("ContentFit(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ContentFit) Nick() string {
+	switch x {
+	case ContentFitFillValue:
+		return "fill"
+	case ContentFitContainValue:
+		return "contain"
+	case ContentFitCoverValue:
+		return "cover"
+	case ContentFitScaleDownValue:
+		return "scale-down"
+	}
+	return ""
+}
+
+// ContentFitFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ContentFitFromString(s string) (ContentFit, bool) {
+	switch s {
+	case "GTK_CONTENT_FIT_FILL", "fill":
+		return ContentFitFillValue, true
+	case "GTK_CONTENT_FIT_CONTAIN", "contain":
+		return ContentFitContainValue, true
+	case "GTK_CONTENT_FIT_COVER", "cover":
+		return ContentFitCoverValue, true
+	case "GTK_CONTENT_FIT_SCALE_DOWN", "scale-down":
+		return ContentFitScaleDownValue, true
+	}
+	return 0, false
+}
+
 // Passed to various keybinding signals for deleting text.
 type DeleteType int
 
@@ -1087,6 +3091,75 @@ const (
 	DeleteWhitespaceValue DeleteType = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x DeleteType) String() string {
+	switch x {
+	case DeleteCharsValue:
+		return "GTK_DELETE_CHARS"
+	case DeleteWordEndsValue:
+		return "GTK_DELETE_WORD_ENDS"
+	case DeleteWordsValue:
+		return "GTK_DELETE_WORDS"
+	case DeleteDisplayLinesValue:
+		return "GTK_DELETE_DISPLAY_LINES"
+	case DeleteDisplayLineEndsValue:
+		return "GTK_DELETE_DISPLAY_LINE_ENDS"
+	case DeleteParagraphEndsValue:
+		return "GTK_DELETE_PARAGRAPH_ENDS"
+	case DeleteParagraphsValue:
+		return "GTK_DELETE_PARAGRAPHS"
+	case DeleteWhitespaceValue:
+		return "GTK_DELETE_WHITESPACE"
+	}
+	return fmt.Sprintf("DeleteType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DeleteType) Nick() string {
+	switch x {
+	case DeleteCharsValue:
+		return "chars"
+	case DeleteWordEndsValue:
+		return "word-ends"
+	case DeleteWordsValue:
+		return "words"
+	case DeleteDisplayLinesValue:
+		return "display-lines"
+	case DeleteDisplayLineEndsValue:
+		return "display-line-ends"
+	case DeleteParagraphEndsValue:
+		return "paragraph-ends"
+	case DeleteParagraphsValue:
+		return "paragraphs"
+	case DeleteWhitespaceValue:
+		return "whitespace"
+	}
+	return ""
+}
+
+// DeleteTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DeleteTypeFromString(s string) (DeleteType, bool) {
+	switch s {
+	case "GTK_DELETE_CHARS", "chars":
+		return DeleteCharsValue, true
+	case "GTK_DELETE_WORD_ENDS", "word-ends":
+		return DeleteWordEndsValue, true
+	case "GTK_DELETE_WORDS", "words":
+		return DeleteWordsValue, true
+	case "GTK_DELETE_DISPLAY_LINES", "display-lines":
+		return DeleteDisplayLinesValue, true
+	case "GTK_DELETE_DISPLAY_LINE_ENDS", "display-line-ends":
+		return DeleteDisplayLineEndsValue, true
+	case "GTK_DELETE_PARAGRAPH_ENDS", "paragraph-ends":
+		return DeleteParagraphEndsValue, true
+	case "GTK_DELETE_PARAGRAPHS", "paragraphs":
+		return DeleteParagraphsValue, true
+	case "GTK_DELETE_WHITESPACE", "whitespace":
+		return DeleteWhitespaceValue, true
+	}
+	return 0, false
+}
+
 // Focus movement types.
 type DirectionType int
 
@@ -1112,7 +3185,64 @@ const (
 	DirRightValue DirectionType = 5
 )
 
-// Describes the state of a [struct@Gdk.EventSequence] in a [class@Gesture].
+// String returns the C name of the value, e.g. for debugging output
+func (x DirectionType) String() string {
+	switch x {
+	case DirTabForwardValue:
+		return "GTK_DIR_TAB_FORWARD"
+	case DirTabBackwardValue:
+		return "GTK_DIR_TAB_BACKWARD"
+	case DirUpValue:
+		return "GTK_DIR_UP"
+	case DirDownValue:
+		return "GTK_DIR_DOWN"
+	case DirLeftValue:
+		return "GTK_DIR_LEFT"
+	case DirRightValue:
+		return "GTK_DIR_RIGHT"
+	}
+	return fmt.Sprintf("DirectionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x DirectionType) Nick() string {
+	switch x {
+	case DirTabForwardValue:
+		return "tab-forward"
+	case DirTabBackwardValue:
+		return "tab-backward"
+	case DirUpValue:
+		return "up"
+	case DirDownValue:
+		return "down"
+	case DirLeftValue:
+		return "left"
+	case DirRightValue:
+		return "right"
+	}
+	return ""
+}
+
+// DirectionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DirectionTypeFromString(s string) (DirectionType, bool) {
+	switch s {
+	case "GTK_DIR_TAB_FORWARD", "tab-forward":
+		return DirTabForwardValue, true
+	case "GTK_DIR_TAB_BACKWARD", "tab-backward":
+		return DirTabBackwardValue, true
+	case "GTK_DIR_UP", "up":
+		return DirUpValue, true
+	case "GTK_DIR_DOWN", "down":
+		return DirDownValue, true
+	case "GTK_DIR_LEFT", "left":
+		return DirLeftValue, true
+	case "GTK_DIR_RIGHT", "right":
+		return DirRightValue, true
+	}
+	return 0, false
+}
+
+// Describes the state of a [gdk.EventSequence] in a Gesture.
 type EventSequenceState int
 
 var xEventSequenceStateGLibType func() types.GType
@@ -1131,7 +3261,46 @@ const (
 	EventSequenceDeniedValue EventSequenceState = 2
 )
 
-// Values for the [property@Gtk.Settings:gtk-font-rendering] setting
+// String returns the C name of the value, e.g. for debugging output
+func (x EventSequenceState) String() string {
+	switch x {
+	case EventSequenceNoneValue:
+		return "GTK_EVENT_SEQUENCE_NONE"
+	case EventSequenceClaimedValue:
+		return "GTK_EVENT_SEQUENCE_CLAIMED"
+	case EventSequenceDeniedValue:
+		return "GTK_EVENT_SEQUENCE_DENIED"
+	}
+	return fmt.Sprintf("EventSequenceState(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EventSequenceState) Nick() string {
+	switch x {
+	case EventSequenceNoneValue:
+		return "none"
+	case EventSequenceClaimedValue:
+		return "claimed"
+	case EventSequenceDeniedValue:
+		return "denied"
+	}
+	return ""
+}
+
+// EventSequenceStateFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EventSequenceStateFromString(s string) (EventSequenceState, bool) {
+	switch s {
+	case "GTK_EVENT_SEQUENCE_NONE", "none":
+		return EventSequenceNoneValue, true
+	case "GTK_EVENT_SEQUENCE_CLAIMED", "claimed":
+		return EventSequenceClaimedValue, true
+	case "GTK_EVENT_SEQUENCE_DENIED", "denied":
+		return EventSequenceDeniedValue, true
+	}
+	return 0, false
+}
+
+// Values for the Settings:gtk-font-rendering setting
 // that influence how GTK renders fonts.
 type FontRendering int
 
@@ -1151,6 +3320,39 @@ const (
 	FontRenderingManualValue FontRendering = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontRendering) String() string {
+	switch x {
+	case FontRenderingAutomaticValue:
+		return "GTK_FONT_RENDERING_AUTOMATIC"
+	case FontRenderingManualValue:
+		return "GTK_FONT_RENDERING_MANUAL"
+	}
+	return fmt.Sprintf("FontRendering(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontRendering) Nick() string {
+	switch x {
+	case FontRenderingAutomaticValue:
+		return "automatic"
+	case FontRenderingManualValue:
+		return "manual"
+	}
+	return ""
+}
+
+// FontRenderingFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontRenderingFromString(s string) (FontRendering, bool) {
+	switch s {
+	case "GTK_FONT_RENDERING_AUTOMATIC", "automatic":
+		return FontRenderingAutomaticValue, true
+	case "GTK_FONT_RENDERING_MANUAL", "manual":
+		return FontRenderingManualValue, true
+	}
+	return 0, false
+}
+
 // Built-in icon sizes.
 //
 // Icon sizes default to being inherited. Where they cannot be
@@ -1178,6 +3380,45 @@ const (
 	IconSizeLargeValue IconSize = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x IconSize) String() string {
+	switch x {
+	case IconSizeInheritValue:
+		return "GTK_ICON_SIZE_INHERIT"
+	case IconSizeNormalValue:
+		return "GTK_ICON_SIZE_NORMAL"
+	case IconSizeLargeValue:
+		return "GTK_ICON_SIZE_LARGE"
+	}
+	return fmt.Sprintf("IconSize(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IconSize) Nick() string {
+	switch x {
+	case IconSizeInheritValue:
+		return "inherit"
+	case IconSizeNormalValue:
+		return "normal"
+	case IconSizeLargeValue:
+		return "large"
+	}
+	return ""
+}
+
+// IconSizeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IconSizeFromString(s string) (IconSize, bool) {
+	switch s {
+	case "GTK_ICON_SIZE_INHERIT", "inherit":
+		return IconSizeInheritValue, true
+	case "GTK_ICON_SIZE_NORMAL", "normal":
+		return IconSizeNormalValue, true
+	case "GTK_ICON_SIZE_LARGE", "large":
+		return IconSizeLargeValue, true
+	}
+	return 0, false
+}
+
 // Describes primary purpose of the input widget.
 //
 // This information is useful for on-screen keyboards and similar input
@@ -1190,8 +3431,8 @@ const (
 // application is expected to validate the entry contents, even if
 // it specified a purpose.
 //
-// The difference between %GTK_INPUT_PURPOSE_DIGITS and
-// %GTK_INPUT_PURPOSE_NUMBER is that the former accepts only digits
+// The difference between [InputPurposeDigitsValue] and
+// [InputPurposeNumberValue] is that the former accepts only digits
 // while the latter also some punctuation (like commas or points, plus,
 // minus) and “e” or “E” as in 3.14E+000.
 //
@@ -1223,16 +3464,103 @@ const (
 	InputPurposeEmailValue InputPurpose = 6
 	// Edited field expects the name of a person
 	InputPurposeNameValue InputPurpose = 7
-	// Like %GTK_INPUT_PURPOSE_FREE_FORM, but characters are hidden
+	// Like [InputPurposeFreeFormValue], but characters are hidden
 	InputPurposePasswordValue InputPurpose = 8
-	// Like %GTK_INPUT_PURPOSE_DIGITS, but characters are hidden
+	// Like [InputPurposeDigitsValue], but characters are hidden
 	InputPurposePinValue InputPurpose = 9
 	// Allow any character, in addition to control codes
 	InputPurposeTerminalValue InputPurpose = 10
 )
 
-// Values for the [property@Gtk.Settings:gtk-interface-color-scheme]
-// and [property@Gtk.CssProvider:prefers-color-scheme] properties
+// String returns the C name of the value, e.g. for debugging output
+func (x InputPurpose) String() string {
+	switch x {
+	case InputPurposeFreeFormValue:
+		return "GTK_INPUT_PURPOSE_FREE_FORM"
+	case InputPurposeAlphaValue:
+		return "GTK_INPUT_PURPOSE_ALPHA"
+	case InputPurposeDigitsValue:
+		return "GTK_INPUT_PURPOSE_DIGITS"
+	case InputPurposeNumberValue:
+		return "GTK_INPUT_PURPOSE_NUMBER"
+	case InputPurposePhoneValue:
+		return "GTK_INPUT_PURPOSE_PHONE"
+	case InputPurposeUrlValue:
+		return "GTK_INPUT_PURPOSE_URL"
+	case InputPurposeEmailValue:
+		return "GTK_INPUT_PURPOSE_EMAIL"
+	case InputPurposeNameValue:
+		return "GTK_INPUT_PURPOSE_NAME"
+	case InputPurposePasswordValue:
+		return "GTK_INPUT_PURPOSE_PASSWORD"
+	case InputPurposePinValue:
+		return "GTK_INPUT_PURPOSE_PIN"
+	case InputPurposeTerminalValue:
+		return "GTK_INPUT_PURPOSE_TERMINAL"
+	}
+	return fmt.Sprintf("InputPurpose(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InputPurpose) Nick() string {
+	switch x {
+	case InputPurposeFreeFormValue:
+		return "free-form"
+	case InputPurposeAlphaValue:
+		return "alpha"
+	case InputPurposeDigitsValue:
+		return "digits"
+	case InputPurposeNumberValue:
+		return "number"
+	case InputPurposePhoneValue:
+		return "phone"
+	case InputPurposeUrlValue:
+		return "url"
+	case InputPurposeEmailValue:
+		return "email"
+	case InputPurposeNameValue:
+		return "name"
+	case InputPurposePasswordValue:
+		return "password"
+	case InputPurposePinValue:
+		return "pin"
+	case InputPurposeTerminalValue:
+		return "terminal"
+	}
+	return ""
+}
+
+// InputPurposeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InputPurposeFromString(s string) (InputPurpose, bool) {
+	switch s {
+	case "GTK_INPUT_PURPOSE_FREE_FORM", "free-form":
+		return InputPurposeFreeFormValue, true
+	case "GTK_INPUT_PURPOSE_ALPHA", "alpha":
+		return InputPurposeAlphaValue, true
+	case "GTK_INPUT_PURPOSE_DIGITS", "digits":
+		return InputPurposeDigitsValue, true
+	case "GTK_INPUT_PURPOSE_NUMBER", "number":
+		return InputPurposeNumberValue, true
+	case "GTK_INPUT_PURPOSE_PHONE", "phone":
+		return InputPurposePhoneValue, true
+	case "GTK_INPUT_PURPOSE_URL", "url":
+		return InputPurposeUrlValue, true
+	case "GTK_INPUT_PURPOSE_EMAIL", "email":
+		return InputPurposeEmailValue, true
+	case "GTK_INPUT_PURPOSE_NAME", "name":
+		return InputPurposeNameValue, true
+	case "GTK_INPUT_PURPOSE_PASSWORD", "password":
+		return InputPurposePasswordValue, true
+	case "GTK_INPUT_PURPOSE_PIN", "pin":
+		return InputPurposePinValue, true
+	case "GTK_INPUT_PURPOSE_TERMINAL", "terminal":
+		return InputPurposeTerminalValue, true
+	}
+	return 0, false
+}
+
+// Values for the Settings:gtk-interface-color-scheme
+// and CssProvider:prefers-color-scheme properties
 // that indicates what color scheme is used.
 //
 // This information can be used inside CSS via media queries.
@@ -1259,8 +3587,53 @@ const (
 	InterfaceColorSchemeLightValue InterfaceColorScheme = 3
 )
 
-// Values for the [property@Gtk.Settings:gtk-interface-contrast]
-// and [property@Gtk.CssProvider:prefers-contrast] properties
+// String returns the C name of the value, e.g. for debugging output
+func (x InterfaceColorScheme) String() string {
+	switch x {
+	case InterfaceColorSchemeUnsupportedValue:
+		return "GTK_INTERFACE_COLOR_SCHEME_UNSUPPORTED"
+	case InterfaceColorSchemeDefaultValue:
+		return "GTK_INTERFACE_COLOR_SCHEME_DEFAULT"
+	case InterfaceColorSchemeDarkValue:
+		return "GTK_INTERFACE_COLOR_SCHEME_DARK"
+	case InterfaceColorSchemeLightValue:
+		return "GTK_INTERFACE_COLOR_SCHEME_LIGHT"
+	}
+	return fmt.Sprintf("InterfaceColorScheme(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InterfaceColorScheme) Nick() string {
+	switch x {
+	case InterfaceColorSchemeUnsupportedValue:
+		return "unsupported"
+	case InterfaceColorSchemeDefaultValue:
+		return "default"
+	case InterfaceColorSchemeDarkValue:
+		return "dark"
+	case InterfaceColorSchemeLightValue:
+		return "light"
+	}
+	return ""
+}
+
+// InterfaceColorSchemeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InterfaceColorSchemeFromString(s string) (InterfaceColorScheme, bool) {
+	switch s {
+	case "GTK_INTERFACE_COLOR_SCHEME_UNSUPPORTED", "unsupported":
+		return InterfaceColorSchemeUnsupportedValue, true
+	case "GTK_INTERFACE_COLOR_SCHEME_DEFAULT", "default":
+		return InterfaceColorSchemeDefaultValue, true
+	case "GTK_INTERFACE_COLOR_SCHEME_DARK", "dark":
+		return InterfaceColorSchemeDarkValue, true
+	case "GTK_INTERFACE_COLOR_SCHEME_LIGHT", "light":
+		return InterfaceColorSchemeLightValue, true
+	}
+	return 0, false
+}
+
+// Values for the Settings:gtk-interface-contrast
+// and CssProvider:prefers-contrast properties
 // that indicates the preferred level of contrast.
 //
 // This information can be used inside CSS via media queries.
@@ -1287,7 +3660,52 @@ const (
 	InterfaceContrastLessValue InterfaceContrast = 3
 )
 
-// Used for justifying the text inside a [class@Label] widget.
+// String returns the C name of the value, e.g. for debugging output
+func (x InterfaceContrast) String() string {
+	switch x {
+	case InterfaceContrastUnsupportedValue:
+		return "GTK_INTERFACE_CONTRAST_UNSUPPORTED"
+	case InterfaceContrastNoPreferenceValue:
+		return "GTK_INTERFACE_CONTRAST_NO_PREFERENCE"
+	case InterfaceContrastMoreValue:
+		return "GTK_INTERFACE_CONTRAST_MORE"
+	case InterfaceContrastLessValue:
+		return "GTK_INTERFACE_CONTRAST_LESS"
+	}
+	return fmt.Sprintf("InterfaceContrast(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InterfaceContrast) Nick() string {
+	switch x {
+	case InterfaceContrastUnsupportedValue:
+		return "unsupported"
+	case InterfaceContrastNoPreferenceValue:
+		return "no-preference"
+	case InterfaceContrastMoreValue:
+		return "more"
+	case InterfaceContrastLessValue:
+		return "less"
+	}
+	return ""
+}
+
+// InterfaceContrastFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InterfaceContrastFromString(s string) (InterfaceContrast, bool) {
+	switch s {
+	case "GTK_INTERFACE_CONTRAST_UNSUPPORTED", "unsupported":
+		return InterfaceContrastUnsupportedValue, true
+	case "GTK_INTERFACE_CONTRAST_NO_PREFERENCE", "no-preference":
+		return InterfaceContrastNoPreferenceValue, true
+	case "GTK_INTERFACE_CONTRAST_MORE", "more":
+		return InterfaceContrastMoreValue, true
+	case "GTK_INTERFACE_CONTRAST_LESS", "less":
+		return InterfaceContrastLessValue, true
+	}
+	return 0, false
+}
+
+// Used for justifying the text inside a Label widget.
 type Justification int
 
 var xJustificationGLibType func() types.GType
@@ -1308,7 +3726,52 @@ const (
 	JustifyFillValue Justification = 3
 )
 
-// Describes how [class@LevelBar] contents should be rendered.
+// String returns the C name of the value, e.g. for debugging output
+func (x Justification) String() string {
+	switch x {
+	case JustifyLeftValue:
+		return "GTK_JUSTIFY_LEFT"
+	case JustifyRightValue:
+		return "GTK_JUSTIFY_RIGHT"
+	case JustifyCenterValue:
+		return "GTK_JUSTIFY_CENTER"
+	case JustifyFillValue:
+		return "GTK_JUSTIFY_FILL"
+	}
+	return fmt.Sprintf("Justification(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Justification) Nick() string {
+	switch x {
+	case JustifyLeftValue:
+		return "left"
+	case JustifyRightValue:
+		return "right"
+	case JustifyCenterValue:
+		return "center"
+	case JustifyFillValue:
+		return "fill"
+	}
+	return ""
+}
+
+// JustificationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func JustificationFromString(s string) (Justification, bool) {
+	switch s {
+	case "GTK_JUSTIFY_LEFT", "left":
+		return JustifyLeftValue, true
+	case "GTK_JUSTIFY_RIGHT", "right":
+		return JustifyRightValue, true
+	case "GTK_JUSTIFY_CENTER", "center":
+		return JustifyCenterValue, true
+	case "GTK_JUSTIFY_FILL", "fill":
+		return JustifyFillValue, true
+	}
+	return 0, false
+}
+
+// Describes how LevelBar contents should be rendered.
 //
 // Note that this enumeration could be extended with additional modes
 // in the future.
@@ -1328,9 +3791,42 @@ const (
 	LevelBarModeDiscreteValue LevelBarMode = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x LevelBarMode) String() string {
+	switch x {
+	case LevelBarModeContinuousValue:
+		return "GTK_LEVEL_BAR_MODE_CONTINUOUS"
+	case LevelBarModeDiscreteValue:
+		return "GTK_LEVEL_BAR_MODE_DISCRETE"
+	}
+	return fmt.Sprintf("LevelBarMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LevelBarMode) Nick() string {
+	switch x {
+	case LevelBarModeContinuousValue:
+		return "continuous"
+	case LevelBarModeDiscreteValue:
+		return "discrete"
+	}
+	return ""
+}
+
+// LevelBarModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LevelBarModeFromString(s string) (LevelBarMode, bool) {
+	switch s {
+	case "GTK_LEVEL_BAR_MODE_CONTINUOUS", "continuous":
+		return LevelBarModeContinuousValue, true
+	case "GTK_LEVEL_BAR_MODE_DISCRETE", "discrete":
+		return LevelBarModeDiscreteValue, true
+	}
+	return 0, false
+}
+
 // Used to configure the focus behavior in the `GTK_DIR_TAB_FORWARD`
-// and `GTK_DIR_TAB_BACKWARD` direction, like the &lt;kbd&gt;Tab&lt;/kbd&gt; key
-// in a [class@Gtk.ListView].
+// and `GTK_DIR_TAB_BACKWARD` direction, like the Tab key
+// in a [ListView].
 type ListTabBehavior int
 
 var xListTabBehaviorGLibType func() types.GType
@@ -1350,12 +3846,51 @@ const (
 	// Cycle only through a single cell, then
 	//   move focus out of the list. Moving focus between cells needs to
 	//   be done with the arrow keys. This is only relevant for
-	//   cell-based widgets like #GtkColumnView, otherwise it behaves
+	//   cell-based widgets like [ColumnView], otherwise it behaves
 	//   like `GTK_LIST_TAB_ITEM`.
 	ListTabCellValue ListTabBehavior = 2
 )
 
-// The type of message being displayed in a [class@MessageDialog].
+// String returns the C name of the value, e.g. for debugging output
+func (x ListTabBehavior) String() string {
+	switch x {
+	case ListTabAllValue:
+		return "GTK_LIST_TAB_ALL"
+	case ListTabItemValue:
+		return "GTK_LIST_TAB_ITEM"
+	case ListTabCellValue:
+		return "GTK_LIST_TAB_CELL"
+	}
+	return fmt.Sprintf("ListTabBehavior(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ListTabBehavior) Nick() string {
+	switch x {
+	case ListTabAllValue:
+		return "all"
+	case ListTabItemValue:
+		return "item"
+	case ListTabCellValue:
+		return "cell"
+	}
+	return ""
+}
+
+// ListTabBehaviorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ListTabBehaviorFromString(s string) (ListTabBehavior, bool) {
+	switch s {
+	case "GTK_LIST_TAB_ALL", "all":
+		return ListTabAllValue, true
+	case "GTK_LIST_TAB_ITEM", "item":
+		return ListTabItemValue, true
+	case "GTK_LIST_TAB_CELL", "cell":
+		return ListTabCellValue, true
+	}
+	return 0, false
+}
+
+// The type of message being displayed in a MessageDialog.
 type MessageType int
 
 var xMessageTypeGLibType func() types.GType
@@ -1378,6 +3913,57 @@ const (
 	MessageOtherValue MessageType = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x MessageType) String() string {
+	switch x {
+	case MessageInfoValue:
+		return "GTK_MESSAGE_INFO"
+	case MessageWarningValue:
+		return "GTK_MESSAGE_WARNING"
+	case MessageQuestionValue:
+		return "GTK_MESSAGE_QUESTION"
+	case MessageErrorValue:
+		return "GTK_MESSAGE_ERROR"
+	case MessageOtherValue:
+		return "GTK_MESSAGE_OTHER"
+	}
+	return fmt.Sprintf("MessageType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MessageType) Nick() string {
+	switch x {
+	case MessageInfoValue:
+		return "info"
+	case MessageWarningValue:
+		return "warning"
+	case MessageQuestionValue:
+		return "question"
+	case MessageErrorValue:
+		return "error"
+	case MessageOtherValue:
+		return "other"
+	}
+	return ""
+}
+
+// MessageTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MessageTypeFromString(s string) (MessageType, bool) {
+	switch s {
+	case "GTK_MESSAGE_INFO", "info":
+		return MessageInfoValue, true
+	case "GTK_MESSAGE_WARNING", "warning":
+		return MessageWarningValue, true
+	case "GTK_MESSAGE_QUESTION", "question":
+		return MessageQuestionValue, true
+	case "GTK_MESSAGE_ERROR", "error":
+		return MessageErrorValue, true
+	case "GTK_MESSAGE_OTHER", "other":
+		return MessageOtherValue, true
+	}
+	return 0, false
+}
+
 // Passed as argument to various keybinding signals for moving the
 // cursor position.
 type MovementStep int
@@ -1412,10 +3998,91 @@ const (
 	MovementHorizontalPagesValue MovementStep = 9
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x MovementStep) String() string {
+	switch x {
+	case MovementLogicalPositionsValue:
+		return "GTK_MOVEMENT_LOGICAL_POSITIONS"
+	case MovementVisualPositionsValue:
+		return "GTK_MOVEMENT_VISUAL_POSITIONS"
+	case MovementWordsValue:
+		return "GTK_MOVEMENT_WORDS"
+	case MovementDisplayLinesValue:
+		return "GTK_MOVEMENT_DISPLAY_LINES"
+	case MovementDisplayLineEndsValue:
+		return "GTK_MOVEMENT_DISPLAY_LINE_ENDS"
+	case MovementParagraphsValue:
+		return "GTK_MOVEMENT_PARAGRAPHS"
+	case MovementParagraphEndsValue:
+		return "GTK_MOVEMENT_PARAGRAPH_ENDS"
+	case MovementPagesValue:
+		return "GTK_MOVEMENT_PAGES"
+	case MovementBufferEndsValue:
+		return "GTK_MOVEMENT_BUFFER_ENDS"
+	case MovementHorizontalPagesValue:
+		return "GTK_MOVEMENT_HORIZONTAL_PAGES"
+	}
+	return fmt.Sprintf("MovementStep(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x MovementStep) Nick() string {
+	switch x {
+	case MovementLogicalPositionsValue:
+		return "logical-positions"
+	case MovementVisualPositionsValue:
+		return "visual-positions"
+	case MovementWordsValue:
+		return "words"
+	case MovementDisplayLinesValue:
+		return "display-lines"
+	case MovementDisplayLineEndsValue:
+		return "display-line-ends"
+	case MovementParagraphsValue:
+		return "paragraphs"
+	case MovementParagraphEndsValue:
+		return "paragraph-ends"
+	case MovementPagesValue:
+		return "pages"
+	case MovementBufferEndsValue:
+		return "buffer-ends"
+	case MovementHorizontalPagesValue:
+		return "horizontal-pages"
+	}
+	return ""
+}
+
+// MovementStepFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func MovementStepFromString(s string) (MovementStep, bool) {
+	switch s {
+	case "GTK_MOVEMENT_LOGICAL_POSITIONS", "logical-positions":
+		return MovementLogicalPositionsValue, true
+	case "GTK_MOVEMENT_VISUAL_POSITIONS", "visual-positions":
+		return MovementVisualPositionsValue, true
+	case "GTK_MOVEMENT_WORDS", "words":
+		return MovementWordsValue, true
+	case "GTK_MOVEMENT_DISPLAY_LINES", "display-lines":
+		return MovementDisplayLinesValue, true
+	case "GTK_MOVEMENT_DISPLAY_LINE_ENDS", "display-line-ends":
+		return MovementDisplayLineEndsValue, true
+	case "GTK_MOVEMENT_PARAGRAPHS", "paragraphs":
+		return MovementParagraphsValue, true
+	case "GTK_MOVEMENT_PARAGRAPH_ENDS", "paragraph-ends":
+		return MovementParagraphEndsValue, true
+	case "GTK_MOVEMENT_PAGES", "pages":
+		return MovementPagesValue, true
+	case "GTK_MOVEMENT_BUFFER_ENDS", "buffer-ends":
+		return MovementBufferEndsValue, true
+	case "GTK_MOVEMENT_HORIZONTAL_PAGES", "horizontal-pages":
+		return MovementHorizontalPagesValue, true
+	}
+	return 0, false
+}
+
 // Options for selecting a different wrap mode for natural size
 // requests.
 //
-// See for example the [property@Gtk.Label:natural-wrap-mode] property.
+// See for example the Label:natural-wrap-mode property.
 type NaturalWrapMode int
 
 var xNaturalWrapModeGLibType func() types.GType
@@ -1427,18 +4094,57 @@ func NaturalWrapModeGLibType() types.GType {
 const (
 
 	// Inherit the minimum size request.
-	//   In particular, this should be used with %PANGO_WRAP_CHAR.
+	//   In particular, this should be used with [pango.WrapCharValue].
 	NaturalWrapInheritValue NaturalWrapMode = 0
 	// Try not to wrap the text. This mode is the
 	//   closest to GTK3's behavior but can lead to a wide label leaving
 	//   lots of empty space below the text.
 	NaturalWrapNoneValue NaturalWrapMode = 1
 	// Attempt to wrap at word boundaries. This
-	//   is useful in particular when using %PANGO_WRAP_WORD_CHAR as the
+	//   is useful in particular when using [pango.WrapWordCharValue] as the
 	//   wrap mode.
 	NaturalWrapWordValue NaturalWrapMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x NaturalWrapMode) String() string {
+	switch x {
+	case NaturalWrapInheritValue:
+		return "GTK_NATURAL_WRAP_INHERIT"
+	case NaturalWrapNoneValue:
+		return "GTK_NATURAL_WRAP_NONE"
+	case NaturalWrapWordValue:
+		return "GTK_NATURAL_WRAP_WORD"
+	}
+	return fmt.Sprintf("NaturalWrapMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NaturalWrapMode) Nick() string {
+	switch x {
+	case NaturalWrapInheritValue:
+		return "inherit"
+	case NaturalWrapNoneValue:
+		return "none"
+	case NaturalWrapWordValue:
+		return "word"
+	}
+	return ""
+}
+
+// NaturalWrapModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NaturalWrapModeFromString(s string) (NaturalWrapMode, bool) {
+	switch s {
+	case "GTK_NATURAL_WRAP_INHERIT", "inherit":
+		return NaturalWrapInheritValue, true
+	case "GTK_NATURAL_WRAP_NONE", "none":
+		return NaturalWrapNoneValue, true
+	case "GTK_NATURAL_WRAP_WORD", "word":
+		return NaturalWrapWordValue, true
+	}
+	return 0, false
+}
+
 // Used to determine the layout of pages on a sheet when printing
 // multiple pages per sheet.
 type NumberUpLayout int
@@ -1450,31 +4156,91 @@ func NumberUpLayoutGLibType() types.GType {
 }
 
 const (
-
-	// ![](layout-lrtb.png)
 	NumberUpLayoutLeftToRightTopToBottomValue NumberUpLayout = 0
-	// ![](layout-lrbt.png)
 	NumberUpLayoutLeftToRightBottomToTopValue NumberUpLayout = 1
-	// ![](layout-rltb.png)
 	NumberUpLayoutRightToLeftTopToBottomValue NumberUpLayout = 2
-	// ![](layout-rlbt.png)
 	NumberUpLayoutRightToLeftBottomToTopValue NumberUpLayout = 3
-	// ![](layout-tblr.png)
 	NumberUpLayoutTopToBottomLeftToRightValue NumberUpLayout = 4
-	// ![](layout-tbrl.png)
 	NumberUpLayoutTopToBottomRightToLeftValue NumberUpLayout = 5
-	// ![](layout-btlr.png)
 	NumberUpLayoutBottomToTopLeftToRightValue NumberUpLayout = 6
-	// ![](layout-btrl.png)
 	NumberUpLayoutBottomToTopRightToLeftValue NumberUpLayout = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x NumberUpLayout) String() string {
+	switch x {
+	case NumberUpLayoutLeftToRightTopToBottomValue:
+		return "GTK_NUMBER_UP_LAYOUT_LEFT_TO_RIGHT_TOP_TO_BOTTOM"
+	case NumberUpLayoutLeftToRightBottomToTopValue:
+		return "GTK_NUMBER_UP_LAYOUT_LEFT_TO_RIGHT_BOTTOM_TO_TOP"
+	case NumberUpLayoutRightToLeftTopToBottomValue:
+		return "GTK_NUMBER_UP_LAYOUT_RIGHT_TO_LEFT_TOP_TO_BOTTOM"
+	case NumberUpLayoutRightToLeftBottomToTopValue:
+		return "GTK_NUMBER_UP_LAYOUT_RIGHT_TO_LEFT_BOTTOM_TO_TOP"
+	case NumberUpLayoutTopToBottomLeftToRightValue:
+		return "GTK_NUMBER_UP_LAYOUT_TOP_TO_BOTTOM_LEFT_TO_RIGHT"
+	case NumberUpLayoutTopToBottomRightToLeftValue:
+		return "GTK_NUMBER_UP_LAYOUT_TOP_TO_BOTTOM_RIGHT_TO_LEFT"
+	case NumberUpLayoutBottomToTopLeftToRightValue:
+		return "GTK_NUMBER_UP_LAYOUT_BOTTOM_TO_TOP_LEFT_TO_RIGHT"
+	case NumberUpLayoutBottomToTopRightToLeftValue:
+		return "GTK_NUMBER_UP_LAYOUT_BOTTOM_TO_TOP_RIGHT_TO_LEFT"
+	}
+	return fmt.Sprintf("NumberUpLayout(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NumberUpLayout) Nick() string {
+	switch x {
+	case NumberUpLayoutLeftToRightTopToBottomValue:
+		return "lrtb"
+	case NumberUpLayoutLeftToRightBottomToTopValue:
+		return "lrbt"
+	case NumberUpLayoutRightToLeftTopToBottomValue:
+		return "rltb"
+	case NumberUpLayoutRightToLeftBottomToTopValue:
+		return "rlbt"
+	case NumberUpLayoutTopToBottomLeftToRightValue:
+		return "tblr"
+	case NumberUpLayoutTopToBottomRightToLeftValue:
+		return "tbrl"
+	case NumberUpLayoutBottomToTopLeftToRightValue:
+		return "btlr"
+	case NumberUpLayoutBottomToTopRightToLeftValue:
+		return "btrl"
+	}
+	return ""
+}
+
+// NumberUpLayoutFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NumberUpLayoutFromString(s string) (NumberUpLayout, bool) {
+	switch s {
+	case "GTK_NUMBER_UP_LAYOUT_LEFT_TO_RIGHT_TOP_TO_BOTTOM", "lrtb":
+		return NumberUpLayoutLeftToRightTopToBottomValue, true
+	case "GTK_NUMBER_UP_LAYOUT_LEFT_TO_RIGHT_BOTTOM_TO_TOP", "lrbt":
+		return NumberUpLayoutLeftToRightBottomToTopValue, true
+	case "GTK_NUMBER_UP_LAYOUT_RIGHT_TO_LEFT_TOP_TO_BOTTOM", "rltb":
+		return NumberUpLayoutRightToLeftTopToBottomValue, true
+	case "GTK_NUMBER_UP_LAYOUT_RIGHT_TO_LEFT_BOTTOM_TO_TOP", "rlbt":
+		return NumberUpLayoutRightToLeftBottomToTopValue, true
+	case "GTK_NUMBER_UP_LAYOUT_TOP_TO_BOTTOM_LEFT_TO_RIGHT", "tblr":
+		return NumberUpLayoutTopToBottomLeftToRightValue, true
+	case "GTK_NUMBER_UP_LAYOUT_TOP_TO_BOTTOM_RIGHT_TO_LEFT", "tbrl":
+		return NumberUpLayoutTopToBottomRightToLeftValue, true
+	case "GTK_NUMBER_UP_LAYOUT_BOTTOM_TO_TOP_LEFT_TO_RIGHT", "btlr":
+		return NumberUpLayoutBottomToTopLeftToRightValue, true
+	case "GTK_NUMBER_UP_LAYOUT_BOTTOM_TO_TOP_RIGHT_TO_LEFT", "btrl":
+		return NumberUpLayoutBottomToTopRightToLeftValue, true
+	}
+	return 0, false
+}
+
 // Describes the way two values can be compared.
 //
-// These values can be used with a [callback@GLib.CompareFunc]. However,
+// These values can be used with a [glib.CompareFunc]. However,
 // a `GCompareFunc` is allowed to return any integer values.
 // For converting such a value to a `GtkOrdering` value, use
-// [func@Gtk.Ordering.from_cmpfunc].
+// [OrderingFromCmpfunc].
 type Ordering int
 
 var xOrderingGLibType func() types.GType
@@ -1493,9 +4259,48 @@ const (
 	OrderingLargerValue Ordering = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Ordering) String() string {
+	switch x {
+	case OrderingSmallerValue:
+		return "GTK_ORDERING_SMALLER"
+	case OrderingEqualValue:
+		return "GTK_ORDERING_EQUAL"
+	case OrderingLargerValue:
+		return "GTK_ORDERING_LARGER"
+	}
+	return fmt.Sprintf("Ordering(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Ordering) Nick() string {
+	switch x {
+	case OrderingSmallerValue:
+		return "smaller"
+	case OrderingEqualValue:
+		return "equal"
+	case OrderingLargerValue:
+		return "larger"
+	}
+	return ""
+}
+
+// OrderingFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OrderingFromString(s string) (Ordering, bool) {
+	switch s {
+	case "GTK_ORDERING_SMALLER", "smaller":
+		return OrderingSmallerValue, true
+	case "GTK_ORDERING_EQUAL", "equal":
+		return OrderingEqualValue, true
+	case "GTK_ORDERING_LARGER", "larger":
+		return OrderingLargerValue, true
+	}
+	return 0, false
+}
+
 // Represents the orientation of widgets and other objects.
 //
-// Typical examples are [class@Box] or [class@GesturePan].
+// Typical examples are Box or GesturePan.
 type Orientation int
 
 var xOrientationGLibType func() types.GType
@@ -1512,10 +4317,43 @@ const (
 	OrientationVerticalValue Orientation = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Orientation) String() string {
+	switch x {
+	case OrientationHorizontalValue:
+		return "GTK_ORIENTATION_HORIZONTAL"
+	case OrientationVerticalValue:
+		return "GTK_ORIENTATION_VERTICAL"
+	}
+	return fmt.Sprintf("Orientation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Orientation) Nick() string {
+	switch x {
+	case OrientationHorizontalValue:
+		return "horizontal"
+	case OrientationVerticalValue:
+		return "vertical"
+	}
+	return ""
+}
+
+// OrientationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OrientationFromString(s string) (Orientation, bool) {
+	switch s {
+	case "GTK_ORIENTATION_HORIZONTAL", "horizontal":
+		return OrientationHorizontalValue, true
+	case "GTK_ORIENTATION_VERTICAL", "vertical":
+		return OrientationVerticalValue, true
+	}
+	return 0, false
+}
+
 // Defines how content overflowing a given area should be handled.
 //
-// This is used in [method@Gtk.Widget.set_overflow]. The
-// [property@Gtk.Widget:overflow] property is modeled after the
+// This is used in [Widget.SetOverflow]. The
+// Widget:overflow property is modeled after the
 // CSS overflow property, but implements it only partially.
 type Overflow int
 
@@ -1535,9 +4373,42 @@ const (
 	OverflowHiddenValue Overflow = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Overflow) String() string {
+	switch x {
+	case OverflowVisibleValue:
+		return "GTK_OVERFLOW_VISIBLE"
+	case OverflowHiddenValue:
+		return "GTK_OVERFLOW_HIDDEN"
+	}
+	return fmt.Sprintf("Overflow(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Overflow) Nick() string {
+	switch x {
+	case OverflowVisibleValue:
+		return "visible"
+	case OverflowHiddenValue:
+		return "hidden"
+	}
+	return ""
+}
+
+// OverflowFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OverflowFromString(s string) (Overflow, bool) {
+	switch s {
+	case "GTK_OVERFLOW_VISIBLE", "visible":
+		return OverflowVisibleValue, true
+	case "GTK_OVERFLOW_HIDDEN", "hidden":
+		return OverflowHiddenValue, true
+	}
+	return 0, false
+}
+
 // Represents the packing location of a children in its parent.
 //
-// See [class@WindowControls] for example.
+// See WindowControls for example.
 type PackType int
 
 var xPackTypeGLibType func() types.GType
@@ -1554,7 +4425,40 @@ const (
 	PackEndValue PackType = 1
 )
 
-// See also gtk_print_settings_set_orientation().
+// String returns the C name of the value, e.g. for debugging output
+func (x PackType) String() string {
+	switch x {
+	case PackStartValue:
+		return "GTK_PACK_START"
+	case PackEndValue:
+		return "GTK_PACK_END"
+	}
+	return fmt.Sprintf("PackType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PackType) Nick() string {
+	switch x {
+	case PackStartValue:
+		return "start"
+	case PackEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// PackTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PackTypeFromString(s string) (PackType, bool) {
+	switch s {
+	case "GTK_PACK_START", "start":
+		return PackStartValue, true
+	case "GTK_PACK_END", "end":
+		return PackEndValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintSettings.SetOrientation].
 type PageOrientation int
 
 var xPageOrientationGLibType func() types.GType
@@ -1575,7 +4479,52 @@ const (
 	PageOrientationReverseLandscapeValue PageOrientation = 3
 )
 
-// See also gtk_print_job_set_page_set().
+// String returns the C name of the value, e.g. for debugging output
+func (x PageOrientation) String() string {
+	switch x {
+	case PageOrientationPortraitValue:
+		return "GTK_PAGE_ORIENTATION_PORTRAIT"
+	case PageOrientationLandscapeValue:
+		return "GTK_PAGE_ORIENTATION_LANDSCAPE"
+	case PageOrientationReversePortraitValue:
+		return "GTK_PAGE_ORIENTATION_REVERSE_PORTRAIT"
+	case PageOrientationReverseLandscapeValue:
+		return "GTK_PAGE_ORIENTATION_REVERSE_LANDSCAPE"
+	}
+	return fmt.Sprintf("PageOrientation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PageOrientation) Nick() string {
+	switch x {
+	case PageOrientationPortraitValue:
+		return "portrait"
+	case PageOrientationLandscapeValue:
+		return "landscape"
+	case PageOrientationReversePortraitValue:
+		return "reverse-portrait"
+	case PageOrientationReverseLandscapeValue:
+		return "reverse-landscape"
+	}
+	return ""
+}
+
+// PageOrientationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PageOrientationFromString(s string) (PageOrientation, bool) {
+	switch s {
+	case "GTK_PAGE_ORIENTATION_PORTRAIT", "portrait":
+		return PageOrientationPortraitValue, true
+	case "GTK_PAGE_ORIENTATION_LANDSCAPE", "landscape":
+		return PageOrientationLandscapeValue, true
+	case "GTK_PAGE_ORIENTATION_REVERSE_PORTRAIT", "reverse-portrait":
+		return PageOrientationReversePortraitValue, true
+	case "GTK_PAGE_ORIENTATION_REVERSE_LANDSCAPE", "reverse-landscape":
+		return PageOrientationReverseLandscapeValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintJob.SetPageSet].
 type PageSet int
 
 var xPageSetGLibType func() types.GType
@@ -1594,7 +4543,46 @@ const (
 	PageSetOddValue PageSet = 2
 )
 
-// Describes the panning direction of a [class@GesturePan].
+// String returns the C name of the value, e.g. for debugging output
+func (x PageSet) String() string {
+	switch x {
+	case PageSetAllValue:
+		return "GTK_PAGE_SET_ALL"
+	case PageSetEvenValue:
+		return "GTK_PAGE_SET_EVEN"
+	case PageSetOddValue:
+		return "GTK_PAGE_SET_ODD"
+	}
+	return fmt.Sprintf("PageSet(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PageSet) Nick() string {
+	switch x {
+	case PageSetAllValue:
+		return "all"
+	case PageSetEvenValue:
+		return "even"
+	case PageSetOddValue:
+		return "odd"
+	}
+	return ""
+}
+
+// PageSetFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PageSetFromString(s string) (PageSet, bool) {
+	switch s {
+	case "GTK_PAGE_SET_ALL", "all":
+		return PageSetAllValue, true
+	case "GTK_PAGE_SET_EVEN", "even":
+		return PageSetEvenValue, true
+	case "GTK_PAGE_SET_ODD", "odd":
+		return PageSetOddValue, true
+	}
+	return 0, false
+}
+
+// Describes the panning direction of a GesturePan.
 type PanDirection int
 
 var xPanDirectionGLibType func() types.GType
@@ -1615,10 +4603,55 @@ const (
 	PanDirectionDownValue PanDirection = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PanDirection) String() string {
+	switch x {
+	case PanDirectionLeftValue:
+		return "GTK_PAN_DIRECTION_LEFT"
+	case PanDirectionRightValue:
+		return "GTK_PAN_DIRECTION_RIGHT"
+	case PanDirectionUpValue:
+		return "GTK_PAN_DIRECTION_UP"
+	case PanDirectionDownValue:
+		return "GTK_PAN_DIRECTION_DOWN"
+	}
+	return fmt.Sprintf("PanDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PanDirection) Nick() string {
+	switch x {
+	case PanDirectionLeftValue:
+		return "left"
+	case PanDirectionRightValue:
+		return "right"
+	case PanDirectionUpValue:
+		return "up"
+	case PanDirectionDownValue:
+		return "down"
+	}
+	return ""
+}
+
+// PanDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PanDirectionFromString(s string) (PanDirection, bool) {
+	switch s {
+	case "GTK_PAN_DIRECTION_LEFT", "left":
+		return PanDirectionLeftValue, true
+	case "GTK_PAN_DIRECTION_RIGHT", "right":
+		return PanDirectionRightValue, true
+	case "GTK_PAN_DIRECTION_UP", "up":
+		return PanDirectionUpValue, true
+	case "GTK_PAN_DIRECTION_DOWN", "down":
+		return PanDirectionDownValue, true
+	}
+	return 0, false
+}
+
 // Describes which edge of a widget a certain feature is positioned at.
 //
-// For examples, see the tabs of a [class@Notebook], or the label
-// of a [class@Scale].
+// For examples, see the tabs of a Notebook, or the label
+// of a Scale.
 type PositionType int
 
 var xPositionTypeGLibType func() types.GType
@@ -1639,7 +4672,52 @@ const (
 	PosBottomValue PositionType = 3
 )
 
-// See also gtk_print_settings_set_duplex().
+// String returns the C name of the value, e.g. for debugging output
+func (x PositionType) String() string {
+	switch x {
+	case PosLeftValue:
+		return "GTK_POS_LEFT"
+	case PosRightValue:
+		return "GTK_POS_RIGHT"
+	case PosTopValue:
+		return "GTK_POS_TOP"
+	case PosBottomValue:
+		return "GTK_POS_BOTTOM"
+	}
+	return fmt.Sprintf("PositionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PositionType) Nick() string {
+	switch x {
+	case PosLeftValue:
+		return "left"
+	case PosRightValue:
+		return "right"
+	case PosTopValue:
+		return "top"
+	case PosBottomValue:
+		return "bottom"
+	}
+	return ""
+}
+
+// PositionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PositionTypeFromString(s string) (PositionType, bool) {
+	switch s {
+	case "GTK_POS_LEFT", "left":
+		return PosLeftValue, true
+	case "GTK_POS_RIGHT", "right":
+		return PosRightValue, true
+	case "GTK_POS_TOP", "top":
+		return PosTopValue, true
+	case "GTK_POS_BOTTOM", "bottom":
+		return PosBottomValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintSettings.SetDuplex].
 type PrintDuplex int
 
 var xPrintDuplexGLibType func() types.GType
@@ -1658,7 +4736,46 @@ const (
 	PrintDuplexVerticalValue PrintDuplex = 2
 )
 
-// See also gtk_print_job_set_pages()
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintDuplex) String() string {
+	switch x {
+	case PrintDuplexSimplexValue:
+		return "GTK_PRINT_DUPLEX_SIMPLEX"
+	case PrintDuplexHorizontalValue:
+		return "GTK_PRINT_DUPLEX_HORIZONTAL"
+	case PrintDuplexVerticalValue:
+		return "GTK_PRINT_DUPLEX_VERTICAL"
+	}
+	return fmt.Sprintf("PrintDuplex(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintDuplex) Nick() string {
+	switch x {
+	case PrintDuplexSimplexValue:
+		return "simplex"
+	case PrintDuplexHorizontalValue:
+		return "horizontal"
+	case PrintDuplexVerticalValue:
+		return "vertical"
+	}
+	return ""
+}
+
+// PrintDuplexFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintDuplexFromString(s string) (PrintDuplex, bool) {
+	switch s {
+	case "GTK_PRINT_DUPLEX_SIMPLEX", "simplex":
+		return PrintDuplexSimplexValue, true
+	case "GTK_PRINT_DUPLEX_HORIZONTAL", "horizontal":
+		return PrintDuplexHorizontalValue, true
+	case "GTK_PRINT_DUPLEX_VERTICAL", "vertical":
+		return PrintDuplexVerticalValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintJob.SetPages]
 type PrintPages int
 
 var xPrintPagesGLibType func() types.GType
@@ -1679,7 +4796,52 @@ const (
 	PrintPagesSelectionValue PrintPages = 3
 )
 
-// See also gtk_print_settings_set_quality().
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintPages) String() string {
+	switch x {
+	case PrintPagesAllValue:
+		return "GTK_PRINT_PAGES_ALL"
+	case PrintPagesCurrentValue:
+		return "GTK_PRINT_PAGES_CURRENT"
+	case PrintPagesRangesValue:
+		return "GTK_PRINT_PAGES_RANGES"
+	case PrintPagesSelectionValue:
+		return "GTK_PRINT_PAGES_SELECTION"
+	}
+	return fmt.Sprintf("PrintPages(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintPages) Nick() string {
+	switch x {
+	case PrintPagesAllValue:
+		return "all"
+	case PrintPagesCurrentValue:
+		return "current"
+	case PrintPagesRangesValue:
+		return "ranges"
+	case PrintPagesSelectionValue:
+		return "selection"
+	}
+	return ""
+}
+
+// PrintPagesFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintPagesFromString(s string) (PrintPages, bool) {
+	switch s {
+	case "GTK_PRINT_PAGES_ALL", "all":
+		return PrintPagesAllValue, true
+	case "GTK_PRINT_PAGES_CURRENT", "current":
+		return PrintPagesCurrentValue, true
+	case "GTK_PRINT_PAGES_RANGES", "ranges":
+		return PrintPagesRangesValue, true
+	case "GTK_PRINT_PAGES_SELECTION", "selection":
+		return PrintPagesSelectionValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintSettings.SetQuality].
 type PrintQuality int
 
 var xPrintQualityGLibType func() types.GType
@@ -1700,7 +4862,52 @@ const (
 	PrintQualityDraftValue PrintQuality = 3
 )
 
-// Describes limits of a [class@EventController] for handling events
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintQuality) String() string {
+	switch x {
+	case PrintQualityLowValue:
+		return "GTK_PRINT_QUALITY_LOW"
+	case PrintQualityNormalValue:
+		return "GTK_PRINT_QUALITY_NORMAL"
+	case PrintQualityHighValue:
+		return "GTK_PRINT_QUALITY_HIGH"
+	case PrintQualityDraftValue:
+		return "GTK_PRINT_QUALITY_DRAFT"
+	}
+	return fmt.Sprintf("PrintQuality(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintQuality) Nick() string {
+	switch x {
+	case PrintQualityLowValue:
+		return "low"
+	case PrintQualityNormalValue:
+		return "normal"
+	case PrintQualityHighValue:
+		return "high"
+	case PrintQualityDraftValue:
+		return "draft"
+	}
+	return ""
+}
+
+// PrintQualityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintQualityFromString(s string) (PrintQuality, bool) {
+	switch s {
+	case "GTK_PRINT_QUALITY_LOW", "low":
+		return PrintQualityLowValue, true
+	case "GTK_PRINT_QUALITY_NORMAL", "normal":
+		return PrintQualityNormalValue, true
+	case "GTK_PRINT_QUALITY_HIGH", "high":
+		return PrintQualityHighValue, true
+	case "GTK_PRINT_QUALITY_DRAFT", "draft":
+		return PrintQualityDraftValue, true
+	}
+	return 0, false
+}
+
+// Describes limits of a EventController for handling events
 // targeting other widgets.
 type PropagationLimit int
 
@@ -1716,13 +4923,46 @@ const (
 	//   target is.
 	LimitNoneValue PropagationLimit = 0
 	// Events are only handled if their target is in
-	//   the same [iface@Native] (or widget with [property@Gtk.Widget:limit-events]
+	//   the same Native (or widget with [Widget.GetPropertyLimitEvents]
 	//   set) as the event controllers widget.
 	//   Note that some event types have two targets (origin and destination).
 	LimitSameNativeValue PropagationLimit = 1
 )
 
-// Describes the stage at which events are fed into a [class@EventController].
+// String returns the C name of the value, e.g. for debugging output
+func (x PropagationLimit) String() string {
+	switch x {
+	case LimitNoneValue:
+		return "GTK_LIMIT_NONE"
+	case LimitSameNativeValue:
+		return "GTK_LIMIT_SAME_NATIVE"
+	}
+	return fmt.Sprintf("PropagationLimit(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PropagationLimit) Nick() string {
+	switch x {
+	case LimitNoneValue:
+		return "none"
+	case LimitSameNativeValue:
+		return "same-native"
+	}
+	return ""
+}
+
+// PropagationLimitFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PropagationLimitFromString(s string) (PropagationLimit, bool) {
+	switch s {
+	case "GTK_LIMIT_NONE", "none":
+		return LimitNoneValue, true
+	case "GTK_LIMIT_SAME_NATIVE", "same-native":
+		return LimitSameNativeValue, true
+	}
+	return 0, false
+}
+
+// Describes the stage at which events are fed into a EventController.
 type PropagationPhase int
 
 var xPropagationPhaseGLibType func() types.GType
@@ -1750,6 +4990,51 @@ const (
 	PhaseTargetValue PropagationPhase = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PropagationPhase) String() string {
+	switch x {
+	case PhaseNoneValue:
+		return "GTK_PHASE_NONE"
+	case PhaseCaptureValue:
+		return "GTK_PHASE_CAPTURE"
+	case PhaseBubbleValue:
+		return "GTK_PHASE_BUBBLE"
+	case PhaseTargetValue:
+		return "GTK_PHASE_TARGET"
+	}
+	return fmt.Sprintf("PropagationPhase(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PropagationPhase) Nick() string {
+	switch x {
+	case PhaseNoneValue:
+		return "none"
+	case PhaseCaptureValue:
+		return "capture"
+	case PhaseBubbleValue:
+		return "bubble"
+	case PhaseTargetValue:
+		return "target"
+	}
+	return ""
+}
+
+// PropagationPhaseFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PropagationPhaseFromString(s string) (PropagationPhase, bool) {
+	switch s {
+	case "GTK_PHASE_NONE", "none":
+		return PhaseNoneValue, true
+	case "GTK_PHASE_CAPTURE", "capture":
+		return PhaseCaptureValue, true
+	case "GTK_PHASE_BUBBLE", "bubble":
+		return PhaseBubbleValue, true
+	case "GTK_PHASE_TARGET", "target":
+		return PhaseTargetValue, true
+	}
+	return 0, false
+}
+
 // Passed as argument to various keybinding signals.
 type ScrollStep int
 
@@ -1775,6 +5060,63 @@ const (
 	ScrollHorizontalEndsValue ScrollStep = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollStep) String() string {
+	switch x {
+	case ScrollStepsValue:
+		return "GTK_SCROLL_STEPS"
+	case ScrollPagesValue:
+		return "GTK_SCROLL_PAGES"
+	case ScrollEndsValue:
+		return "GTK_SCROLL_ENDS"
+	case ScrollHorizontalStepsValue:
+		return "GTK_SCROLL_HORIZONTAL_STEPS"
+	case ScrollHorizontalPagesValue:
+		return "GTK_SCROLL_HORIZONTAL_PAGES"
+	case ScrollHorizontalEndsValue:
+		return "GTK_SCROLL_HORIZONTAL_ENDS"
+	}
+	return fmt.Sprintf("ScrollStep(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollStep) Nick() string {
+	switch x {
+	case ScrollStepsValue:
+		return "steps"
+	case ScrollPagesValue:
+		return "pages"
+	case ScrollEndsValue:
+		return "ends"
+	case ScrollHorizontalStepsValue:
+		return "horizontal-steps"
+	case ScrollHorizontalPagesValue:
+		return "horizontal-pages"
+	case ScrollHorizontalEndsValue:
+		return "horizontal-ends"
+	}
+	return ""
+}
+
+// ScrollStepFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollStepFromString(s string) (ScrollStep, bool) {
+	switch s {
+	case "GTK_SCROLL_STEPS", "steps":
+		return ScrollStepsValue, true
+	case "GTK_SCROLL_PAGES", "pages":
+		return ScrollPagesValue, true
+	case "GTK_SCROLL_ENDS", "ends":
+		return ScrollEndsValue, true
+	case "GTK_SCROLL_HORIZONTAL_STEPS", "horizontal-steps":
+		return ScrollHorizontalStepsValue, true
+	case "GTK_SCROLL_HORIZONTAL_PAGES", "horizontal-pages":
+		return ScrollHorizontalPagesValue, true
+	case "GTK_SCROLL_HORIZONTAL_ENDS", "horizontal-ends":
+		return ScrollHorizontalEndsValue, true
+	}
+	return 0, false
+}
+
 // Scrolling types.
 type ScrollType int
 
@@ -1820,6 +5162,123 @@ const (
 	ScrollEndValue ScrollType = 15
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollType) String() string {
+	switch x {
+	case ScrollNoneValue:
+		return "GTK_SCROLL_NONE"
+	case ScrollJumpValue:
+		return "GTK_SCROLL_JUMP"
+	case ScrollStepBackwardValue:
+		return "GTK_SCROLL_STEP_BACKWARD"
+	case ScrollStepForwardValue:
+		return "GTK_SCROLL_STEP_FORWARD"
+	case ScrollPageBackwardValue:
+		return "GTK_SCROLL_PAGE_BACKWARD"
+	case ScrollPageForwardValue:
+		return "GTK_SCROLL_PAGE_FORWARD"
+	case ScrollStepUpValue:
+		return "GTK_SCROLL_STEP_UP"
+	case ScrollStepDownValue:
+		return "GTK_SCROLL_STEP_DOWN"
+	case ScrollPageUpValue:
+		return "GTK_SCROLL_PAGE_UP"
+	case ScrollPageDownValue:
+		return "GTK_SCROLL_PAGE_DOWN"
+	case ScrollStepLeftValue:
+		return "GTK_SCROLL_STEP_LEFT"
+	case ScrollStepRightValue:
+		return "GTK_SCROLL_STEP_RIGHT"
+	case ScrollPageLeftValue:
+		return "GTK_SCROLL_PAGE_LEFT"
+	case ScrollPageRightValue:
+		return "GTK_SCROLL_PAGE_RIGHT"
+	case ScrollStartValue:
+		return "GTK_SCROLL_START"
+	case ScrollEndValue:
+		return "GTK_SCROLL_END"
+	}
+	return fmt.Sprintf("ScrollType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollType) Nick() string {
+	switch x {
+	case ScrollNoneValue:
+		return "none"
+	case ScrollJumpValue:
+		return "jump"
+	case ScrollStepBackwardValue:
+		return "step-backward"
+	case ScrollStepForwardValue:
+		return "step-forward"
+	case ScrollPageBackwardValue:
+		return "page-backward"
+	case ScrollPageForwardValue:
+		return "page-forward"
+	case ScrollStepUpValue:
+		return "step-up"
+	case ScrollStepDownValue:
+		return "step-down"
+	case ScrollPageUpValue:
+		return "page-up"
+	case ScrollPageDownValue:
+		return "page-down"
+	case ScrollStepLeftValue:
+		return "step-left"
+	case ScrollStepRightValue:
+		return "step-right"
+	case ScrollPageLeftValue:
+		return "page-left"
+	case ScrollPageRightValue:
+		return "page-right"
+	case ScrollStartValue:
+		return "start"
+	case ScrollEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// ScrollTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollTypeFromString(s string) (ScrollType, bool) {
+	switch s {
+	case "GTK_SCROLL_NONE", "none":
+		return ScrollNoneValue, true
+	case "GTK_SCROLL_JUMP", "jump":
+		return ScrollJumpValue, true
+	case "GTK_SCROLL_STEP_BACKWARD", "step-backward":
+		return ScrollStepBackwardValue, true
+	case "GTK_SCROLL_STEP_FORWARD", "step-forward":
+		return ScrollStepForwardValue, true
+	case "GTK_SCROLL_PAGE_BACKWARD", "page-backward":
+		return ScrollPageBackwardValue, true
+	case "GTK_SCROLL_PAGE_FORWARD", "page-forward":
+		return ScrollPageForwardValue, true
+	case "GTK_SCROLL_STEP_UP", "step-up":
+		return ScrollStepUpValue, true
+	case "GTK_SCROLL_STEP_DOWN", "step-down":
+		return ScrollStepDownValue, true
+	case "GTK_SCROLL_PAGE_UP", "page-up":
+		return ScrollPageUpValue, true
+	case "GTK_SCROLL_PAGE_DOWN", "page-down":
+		return ScrollPageDownValue, true
+	case "GTK_SCROLL_STEP_LEFT", "step-left":
+		return ScrollStepLeftValue, true
+	case "GTK_SCROLL_STEP_RIGHT", "step-right":
+		return ScrollStepRightValue, true
+	case "GTK_SCROLL_PAGE_LEFT", "page-left":
+		return ScrollPageLeftValue, true
+	case "GTK_SCROLL_PAGE_RIGHT", "page-right":
+		return ScrollPageRightValue, true
+	case "GTK_SCROLL_START", "start":
+		return ScrollStartValue, true
+	case "GTK_SCROLL_END", "end":
+		return ScrollEndValue, true
+	}
+	return 0, false
+}
+
 // Defines the policy to be used in a scrollable widget when updating
 // the scrolled window adjustments in a given orientation.
 type ScrollablePolicy int
@@ -1838,6 +5297,39 @@ const (
 	ScrollNaturalValue ScrollablePolicy = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ScrollablePolicy) String() string {
+	switch x {
+	case ScrollMinimumValue:
+		return "GTK_SCROLL_MINIMUM"
+	case ScrollNaturalValue:
+		return "GTK_SCROLL_NATURAL"
+	}
+	return fmt.Sprintf("ScrollablePolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ScrollablePolicy) Nick() string {
+	switch x {
+	case ScrollMinimumValue:
+		return "minimum"
+	case ScrollNaturalValue:
+		return "natural"
+	}
+	return ""
+}
+
+// ScrollablePolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScrollablePolicyFromString(s string) (ScrollablePolicy, bool) {
+	switch s {
+	case "GTK_SCROLL_MINIMUM", "minimum":
+		return ScrollMinimumValue, true
+	case "GTK_SCROLL_NATURAL", "natural":
+		return ScrollNaturalValue, true
+	}
+	return 0, false
+}
+
 // Used to control what selections users are allowed to make.
 type SelectionMode int
 
@@ -1856,7 +5348,7 @@ const (
 	// Exactly one element is selected.
 	//   In some circumstances, such as initially or during a search
 	//   operation, it’s possible for no element to be selected with
-	//   %GTK_SELECTION_BROWSE. What is really enforced is that the user
+	//   [SelectionBrowseValue]. What is really enforced is that the user
 	//   can’t deselect a currently selected element except by selecting
 	//   another element.
 	SelectionBrowseValue SelectionMode = 2
@@ -1867,6 +5359,51 @@ const (
 	SelectionMultipleValue SelectionMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SelectionMode) String() string {
+	switch x {
+	case SelectionNoneValue:
+		return "GTK_SELECTION_NONE"
+	case SelectionSingleValue:
+		return "GTK_SELECTION_SINGLE"
+	case SelectionBrowseValue:
+		return "GTK_SELECTION_BROWSE"
+	case SelectionMultipleValue:
+		return "GTK_SELECTION_MULTIPLE"
+	}
+	return fmt.Sprintf("SelectionMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SelectionMode) Nick() string {
+	switch x {
+	case SelectionNoneValue:
+		return "none"
+	case SelectionSingleValue:
+		return "single"
+	case SelectionBrowseValue:
+		return "browse"
+	case SelectionMultipleValue:
+		return "multiple"
+	}
+	return ""
+}
+
+// SelectionModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SelectionModeFromString(s string) (SelectionMode, bool) {
+	switch s {
+	case "GTK_SELECTION_NONE", "none":
+		return SelectionNoneValue, true
+	case "GTK_SELECTION_SINGLE", "single":
+		return SelectionSingleValue, true
+	case "GTK_SELECTION_BROWSE", "browse":
+		return SelectionBrowseValue, true
+	case "GTK_SELECTION_MULTIPLE", "multiple":
+		return SelectionMultipleValue, true
+	}
+	return 0, false
+}
+
 // Determines how GTK handles the sensitivity of various controls,
 // such as combo box buttons.
 type SensitivityType int
@@ -1888,8 +5425,47 @@ const (
 	SensitivityOffValue SensitivityType = 2
 )
 
-// Describes where [class@Shortcut]s added to a
-// [class@ShortcutController] get handled.
+// String returns the C name of the value, e.g. for debugging output
+func (x SensitivityType) String() string {
+	switch x {
+	case SensitivityAutoValue:
+		return "GTK_SENSITIVITY_AUTO"
+	case SensitivityOnValue:
+		return "GTK_SENSITIVITY_ON"
+	case SensitivityOffValue:
+		return "GTK_SENSITIVITY_OFF"
+	}
+	return fmt.Sprintf("SensitivityType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SensitivityType) Nick() string {
+	switch x {
+	case SensitivityAutoValue:
+		return "auto"
+	case SensitivityOnValue:
+		return "on"
+	case SensitivityOffValue:
+		return "off"
+	}
+	return ""
+}
+
+// SensitivityTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SensitivityTypeFromString(s string) (SensitivityType, bool) {
+	switch s {
+	case "GTK_SENSITIVITY_AUTO", "auto":
+		return SensitivityAutoValue, true
+	case "GTK_SENSITIVITY_ON", "on":
+		return SensitivityOnValue, true
+	case "GTK_SENSITIVITY_OFF", "off":
+		return SensitivityOffValue, true
+	}
+	return 0, false
+}
+
+// Describes where Shortcuts added to a
+// ShortcutController get handled.
 type ShortcutScope int
 
 var xShortcutScopeGLibType func() types.GType
@@ -1904,13 +5480,52 @@ const (
 	//   the widget the controller belongs to.
 	ShortcutScopeLocalValue ShortcutScope = 0
 	// Shortcuts are handled by
-	//   the first ancestor that is a [iface@ShortcutManager]
+	//   the first ancestor that is a ShortcutManager
 	ShortcutScopeManagedValue ShortcutScope = 1
 	// Shortcuts are handled by
 	//   the root widget.
 	ShortcutScopeGlobalValue ShortcutScope = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ShortcutScope) String() string {
+	switch x {
+	case ShortcutScopeLocalValue:
+		return "GTK_SHORTCUT_SCOPE_LOCAL"
+	case ShortcutScopeManagedValue:
+		return "GTK_SHORTCUT_SCOPE_MANAGED"
+	case ShortcutScopeGlobalValue:
+		return "GTK_SHORTCUT_SCOPE_GLOBAL"
+	}
+	return fmt.Sprintf("ShortcutScope(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ShortcutScope) Nick() string {
+	switch x {
+	case ShortcutScopeLocalValue:
+		return "local"
+	case ShortcutScopeManagedValue:
+		return "managed"
+	case ShortcutScopeGlobalValue:
+		return "global"
+	}
+	return ""
+}
+
+// ShortcutScopeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ShortcutScopeFromString(s string) (ShortcutScope, bool) {
+	switch s {
+	case "GTK_SHORTCUT_SCOPE_LOCAL", "local":
+		return ShortcutScopeLocalValue, true
+	case "GTK_SHORTCUT_SCOPE_MANAGED", "managed":
+		return ShortcutScopeManagedValue, true
+	case "GTK_SHORTCUT_SCOPE_GLOBAL", "global":
+		return ShortcutScopeGlobalValue, true
+	}
+	return 0, false
+}
+
 // The mode of the size group determines the directions in which the size
 // group affects the requested sizes of its component widgets.
 type SizeGroupMode int
@@ -1933,6 +5548,51 @@ const (
 	SizeGroupBothValue SizeGroupMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SizeGroupMode) String() string {
+	switch x {
+	case SizeGroupNoneValue:
+		return "GTK_SIZE_GROUP_NONE"
+	case SizeGroupHorizontalValue:
+		return "GTK_SIZE_GROUP_HORIZONTAL"
+	case SizeGroupVerticalValue:
+		return "GTK_SIZE_GROUP_VERTICAL"
+	case SizeGroupBothValue:
+		return "GTK_SIZE_GROUP_BOTH"
+	}
+	return fmt.Sprintf("SizeGroupMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SizeGroupMode) Nick() string {
+	switch x {
+	case SizeGroupNoneValue:
+		return "none"
+	case SizeGroupHorizontalValue:
+		return "horizontal"
+	case SizeGroupVerticalValue:
+		return "vertical"
+	case SizeGroupBothValue:
+		return "both"
+	}
+	return ""
+}
+
+// SizeGroupModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SizeGroupModeFromString(s string) (SizeGroupMode, bool) {
+	switch s {
+	case "GTK_SIZE_GROUP_NONE", "none":
+		return SizeGroupNoneValue, true
+	case "GTK_SIZE_GROUP_HORIZONTAL", "horizontal":
+		return SizeGroupHorizontalValue, true
+	case "GTK_SIZE_GROUP_VERTICAL", "vertical":
+		return SizeGroupVerticalValue, true
+	case "GTK_SIZE_GROUP_BOTH", "both":
+		return SizeGroupBothValue, true
+	}
+	return 0, false
+}
+
 // Specifies a preference for height-for-width or
 // width-for-height geometry management.
 type SizeRequestMode int
@@ -1953,6 +5613,45 @@ const (
 	SizeRequestConstantSizeValue SizeRequestMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SizeRequestMode) String() string {
+	switch x {
+	case SizeRequestHeightForWidthValue:
+		return "GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH"
+	case SizeRequestWidthForHeightValue:
+		return "GTK_SIZE_REQUEST_WIDTH_FOR_HEIGHT"
+	case SizeRequestConstantSizeValue:
+		return "GTK_SIZE_REQUEST_CONSTANT_SIZE"
+	}
+	return fmt.Sprintf("SizeRequestMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SizeRequestMode) Nick() string {
+	switch x {
+	case SizeRequestHeightForWidthValue:
+		return "height-for-width"
+	case SizeRequestWidthForHeightValue:
+		return "width-for-height"
+	case SizeRequestConstantSizeValue:
+		return "constant-size"
+	}
+	return ""
+}
+
+// SizeRequestModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SizeRequestModeFromString(s string) (SizeRequestMode, bool) {
+	switch s {
+	case "GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH", "height-for-width":
+		return SizeRequestHeightForWidthValue, true
+	case "GTK_SIZE_REQUEST_WIDTH_FOR_HEIGHT", "width-for-height":
+		return SizeRequestWidthForHeightValue, true
+	case "GTK_SIZE_REQUEST_CONSTANT_SIZE", "constant-size":
+		return SizeRequestConstantSizeValue, true
+	}
+	return 0, false
+}
+
 // Determines the direction of a sort.
 type SortType int
 
@@ -1970,8 +5669,41 @@ const (
 	SortDescendingValue SortType = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SortType) String() string {
+	switch x {
+	case SortAscendingValue:
+		return "GTK_SORT_ASCENDING"
+	case SortDescendingValue:
+		return "GTK_SORT_DESCENDING"
+	}
+	return fmt.Sprintf("SortType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SortType) Nick() string {
+	switch x {
+	case SortAscendingValue:
+		return "ascending"
+	case SortDescendingValue:
+		return "descending"
+	}
+	return ""
+}
+
+// SortTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SortTypeFromString(s string) (SortType, bool) {
+	switch s {
+	case "GTK_SORT_ASCENDING", "ascending":
+		return SortAscendingValue, true
+	case "GTK_SORT_DESCENDING", "descending":
+		return SortDescendingValue, true
+	}
+	return 0, false
+}
+
 // The indexes of colors passed to symbolic color rendering, such as
-// [vfunc@Gtk.SymbolicPaintable.snapshot_symbolic].
+// SymbolicPaintable.snapshot_symbolic.
 //
 // More values may be added over time.
 type SymbolicColor int
@@ -1994,13 +5726,58 @@ const (
 	SymbolicColorSuccessValue SymbolicColor = 3
 )
 
-// Values that can be passed to the [vfunc@Gtk.Widget.system_setting_changed]
+// String returns the C name of the value, e.g. for debugging output
+func (x SymbolicColor) String() string {
+	switch x {
+	case SymbolicColorForegroundValue:
+		return "GTK_SYMBOLIC_COLOR_FOREGROUND"
+	case SymbolicColorErrorValue:
+		return "GTK_SYMBOLIC_COLOR_ERROR"
+	case SymbolicColorWarningValue:
+		return "GTK_SYMBOLIC_COLOR_WARNING"
+	case SymbolicColorSuccessValue:
+		return "GTK_SYMBOLIC_COLOR_SUCCESS"
+	}
+	return fmt.Sprintf("SymbolicColor(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SymbolicColor) Nick() string {
+	switch x {
+	case SymbolicColorForegroundValue:
+		return "foreground"
+	case SymbolicColorErrorValue:
+		return "error"
+	case SymbolicColorWarningValue:
+		return "warning"
+	case SymbolicColorSuccessValue:
+		return "success"
+	}
+	return ""
+}
+
+// SymbolicColorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SymbolicColorFromString(s string) (SymbolicColor, bool) {
+	switch s {
+	case "GTK_SYMBOLIC_COLOR_FOREGROUND", "foreground":
+		return SymbolicColorForegroundValue, true
+	case "GTK_SYMBOLIC_COLOR_ERROR", "error":
+		return SymbolicColorErrorValue, true
+	case "GTK_SYMBOLIC_COLOR_WARNING", "warning":
+		return SymbolicColorWarningValue, true
+	case "GTK_SYMBOLIC_COLOR_SUCCESS", "success":
+		return SymbolicColorSuccessValue, true
+	}
+	return 0, false
+}
+
+// Values that can be passed to the Widget.system_setting_changed
 // vfunc.
 //
 // The values indicate which system setting has changed.
 // Widgets may need to drop caches, or react otherwise.
 //
-// Most of the values correspond to [class@Settings] properties.
+// Most of the values correspond to Settings properties.
 //
 // More values may be added over time.
 type SystemSetting int
@@ -2013,17 +5790,17 @@ func SystemSettingGLibType() types.GType {
 
 const (
 
-	// the [property@Gtk.Settings:gtk-xft-dpi] setting has changed
+	// the [Settings.GetPropertyGtkXftDpi] setting has changed
 	SystemSettingDpiValue SystemSetting = 0
-	// The [property@Gtk.Settings:gtk-font-name] setting has changed
+	// The [Settings.GetPropertyGtkFontName] setting has changed
 	SystemSettingFontNameValue SystemSetting = 1
 	// The font configuration has changed in a way that
 	//   requires text to be redrawn. This can be any of the
-	//   [property@Gtk.Settings:gtk-xft-antialias],
-	//   [property@Gtk.Settings:gtk-xft-hinting],
-	//   [property@Gtk.Settings:gtk-xft-hintstyle],
-	//   [property@Gtk.Settings:gtk-xft-rgba] or
-	//   [property@Gtk.Settings:gtk-fontconfig-timestamp] settings
+	//   [Settings.GetPropertyGtkXftAntialias],
+	//   [Settings.GetPropertyGtkXftHinting],
+	//   [Settings.GetPropertyGtkXftHintstyle],
+	//   [Settings.GetPropertyGtkXftRgba] or
+	//   [Settings.GetPropertyGtkFontconfigTimestamp] settings
 	SystemSettingFontConfigValue SystemSetting = 2
 	// The display has changed
 	SystemSettingDisplayValue SystemSetting = 3
@@ -2032,6 +5809,57 @@ const (
 	SystemSettingIconThemeValue SystemSetting = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SystemSetting) String() string {
+	switch x {
+	case SystemSettingDpiValue:
+		return "GTK_SYSTEM_SETTING_DPI"
+	case SystemSettingFontNameValue:
+		return "GTK_SYSTEM_SETTING_FONT_NAME"
+	case SystemSettingFontConfigValue:
+		return "GTK_SYSTEM_SETTING_FONT_CONFIG"
+	case SystemSettingDisplayValue:
+		return "GTK_SYSTEM_SETTING_DISPLAY"
+	case SystemSettingIconThemeValue:
+		return "GTK_SYSTEM_SETTING_ICON_THEME"
+	}
+	return fmt.Sprintf("SystemSetting(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SystemSetting) Nick() string {
+	switch x {
+	case SystemSettingDpiValue:
+		return "dpi"
+	case SystemSettingFontNameValue:
+		return "font-name"
+	case SystemSettingFontConfigValue:
+		return "font-config"
+	case SystemSettingDisplayValue:
+		return "display"
+	case SystemSettingIconThemeValue:
+		return "icon-theme"
+	}
+	return ""
+}
+
+// SystemSettingFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SystemSettingFromString(s string) (SystemSetting, bool) {
+	switch s {
+	case "GTK_SYSTEM_SETTING_DPI", "dpi":
+		return SystemSettingDpiValue, true
+	case "GTK_SYSTEM_SETTING_FONT_NAME", "font-name":
+		return SystemSettingFontNameValue, true
+	case "GTK_SYSTEM_SETTING_FONT_CONFIG", "font-config":
+		return SystemSettingFontConfigValue, true
+	case "GTK_SYSTEM_SETTING_DISPLAY", "display":
+		return SystemSettingDisplayValue, true
+	case "GTK_SYSTEM_SETTING_ICON_THEME", "icon-theme":
+		return SystemSettingIconThemeValue, true
+	}
+	return 0, false
+}
+
 // Reading directions for text.
 type TextDirection int
 
@@ -2051,7 +5879,48 @@ const (
 	TextDirRtlValue TextDirection = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TextDirection) String() string {
+	switch x {
+	case TextDirNoneValue:
+		return "GTK_TEXT_DIR_NONE"
+	case TextDirLtrValue:
+		return "GTK_TEXT_DIR_LTR"
+	case TextDirRtlValue:
+		return "GTK_TEXT_DIR_RTL"
+	}
+	return fmt.Sprintf("TextDirection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextDirection) Nick() string {
+	switch x {
+	case TextDirNoneValue:
+		return "none"
+	case TextDirLtrValue:
+		return "ltr"
+	case TextDirRtlValue:
+		return "rtl"
+	}
+	return ""
+}
+
+// TextDirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextDirectionFromString(s string) (TextDirection, bool) {
+	switch s {
+	case "GTK_TEXT_DIR_NONE", "none":
+		return TextDirNoneValue, true
+	case "GTK_TEXT_DIR_LTR", "ltr":
+		return TextDirLtrValue, true
+	case "GTK_TEXT_DIR_RTL", "rtl":
+		return TextDirRtlValue, true
+	}
+	return 0, false
+}
+
 // Used to indicate which grid lines to draw in a tree view.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeViewGridLines int
 
 var xTreeViewGridLinesGLibType func() types.GType
@@ -2072,7 +5941,52 @@ const (
 	TreeViewGridLinesBothValue TreeViewGridLines = 3
 )
 
-// See also gtk_print_settings_set_paper_width().
+// String returns the C name of the value, e.g. for debugging output
+func (x TreeViewGridLines) String() string {
+	switch x {
+	case TreeViewGridLinesNoneValue:
+		return "GTK_TREE_VIEW_GRID_LINES_NONE"
+	case TreeViewGridLinesHorizontalValue:
+		return "GTK_TREE_VIEW_GRID_LINES_HORIZONTAL"
+	case TreeViewGridLinesVerticalValue:
+		return "GTK_TREE_VIEW_GRID_LINES_VERTICAL"
+	case TreeViewGridLinesBothValue:
+		return "GTK_TREE_VIEW_GRID_LINES_BOTH"
+	}
+	return fmt.Sprintf("TreeViewGridLines(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TreeViewGridLines) Nick() string {
+	switch x {
+	case TreeViewGridLinesNoneValue:
+		return "none"
+	case TreeViewGridLinesHorizontalValue:
+		return "horizontal"
+	case TreeViewGridLinesVerticalValue:
+		return "vertical"
+	case TreeViewGridLinesBothValue:
+		return "both"
+	}
+	return ""
+}
+
+// TreeViewGridLinesFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TreeViewGridLinesFromString(s string) (TreeViewGridLines, bool) {
+	switch s {
+	case "GTK_TREE_VIEW_GRID_LINES_NONE", "none":
+		return TreeViewGridLinesNoneValue, true
+	case "GTK_TREE_VIEW_GRID_LINES_HORIZONTAL", "horizontal":
+		return TreeViewGridLinesHorizontalValue, true
+	case "GTK_TREE_VIEW_GRID_LINES_VERTICAL", "vertical":
+		return TreeViewGridLinesVerticalValue, true
+	case "GTK_TREE_VIEW_GRID_LINES_BOTH", "both":
+		return TreeViewGridLinesBothValue, true
+	}
+	return 0, false
+}
+
+// See also [PrintSettings.SetPaperWidth].
 type Unit int
 
 var xUnitGLibType func() types.GType
@@ -2093,6 +6007,51 @@ const (
 	UnitMmValue Unit = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Unit) String() string {
+	switch x {
+	case UnitNoneValue:
+		return "GTK_UNIT_NONE"
+	case UnitPointsValue:
+		return "GTK_UNIT_POINTS"
+	case UnitInchValue:
+		return "GTK_UNIT_INCH"
+	case UnitMmValue:
+		return "GTK_UNIT_MM"
+	}
+	return fmt.Sprintf("Unit(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Unit) Nick() string {
+	switch x {
+	case UnitNoneValue:
+		return "none"
+	case UnitPointsValue:
+		return "points"
+	case UnitInchValue:
+		return "inch"
+	case UnitMmValue:
+		return "mm"
+	}
+	return ""
+}
+
+// UnitFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnitFromString(s string) (Unit, bool) {
+	switch s {
+	case "GTK_UNIT_NONE", "none":
+		return UnitNoneValue, true
+	case "GTK_UNIT_POINTS", "points":
+		return UnitPointsValue, true
+	case "GTK_UNIT_INCH", "inch":
+		return UnitInchValue, true
+	case "GTK_UNIT_MM", "mm":
+		return UnitMmValue, true
+	}
+	return 0, false
+}
+
 // Describes a type of line wrapping.
 type WrapMode int
 
@@ -2108,7 +6067,7 @@ const (
 	WrapNoneValue WrapMode = 0
 	// wrap text, breaking lines anywhere the cursor can
 	//   appear (between characters, usually - if you want to be technical,
-	//   between graphemes, see pango_get_log_attrs())
+	//   between graphemes, see [pango.GetLogAttrs])
 	WrapCharValue WrapMode = 1
 	// wrap text, breaking lines in between words
 	WrapWordValue WrapMode = 2
@@ -2117,6 +6076,51 @@ const (
 	WrapWordCharValue WrapMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x WrapMode) String() string {
+	switch x {
+	case WrapNoneValue:
+		return "GTK_WRAP_NONE"
+	case WrapCharValue:
+		return "GTK_WRAP_CHAR"
+	case WrapWordValue:
+		return "GTK_WRAP_WORD"
+	case WrapWordCharValue:
+		return "GTK_WRAP_WORD_CHAR"
+	}
+	return fmt.Sprintf("WrapMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x WrapMode) Nick() string {
+	switch x {
+	case WrapNoneValue:
+		return "none"
+	case WrapCharValue:
+		return "char"
+	case WrapWordValue:
+		return "word"
+	case WrapWordCharValue:
+		return "word-char"
+	}
+	return ""
+}
+
+// WrapModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func WrapModeFromString(s string) (WrapMode, bool) {
+	switch s {
+	case "GTK_WRAP_NONE", "none":
+		return WrapNoneValue, true
+	case "GTK_WRAP_CHAR", "char":
+		return WrapCharValue, true
+	case "GTK_WRAP_WORD", "word":
+		return WrapWordValue, true
+	case "GTK_WRAP_WORD_CHAR", "word-char":
+		return WrapWordCharValue, true
+	}
+	return 0, false
+}
+
 var xOrderingFromCmpfunc func(int) Ordering
 
 // Converts the result of a `GCompareFunc` like strcmp() to a
@@ -2132,7 +6136,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2273,6 +6277,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xWrapModeGLibType, libs, "gtk_wrap_mode_get_type")
 
-	core.PuregoSafeRegister(&xOrderingFromCmpfunc, libs, "gtk_ordering_from_cmpfunc")
+	core.PuregoRegisterSince(&xOrderingFromCmpfunc, libs, "gtk_ordering_from_cmpfunc", "GTK", "4.2")
 
 }
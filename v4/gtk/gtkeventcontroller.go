@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -26,10 +25,10 @@ func (x *EventControllerClass) GoPointer() uintptr {
 // to `GdkEvents`, and possibly trigger actions as a consequence.
 //
 // Event controllers are added to a widget with
-// [method@Gtk.Widget.add_controller]. It is rarely necessary to
-// explicitly remove a controller with [method@Gtk.Widget.remove_controller].
+// [Widget.AddController]. It is rarely necessary to
+// explicitly remove a controller with [Widget.RemoveController].
 //
-// See the chapter on [input handling](input-handling.html) for
+// See the chapter on input handling for
 // an overview of the basic concepts, such as the capture and bubble
 // phases of event propagation.
 type EventController struct {
@@ -52,7 +51,7 @@ var xEventControllerGetCurrentEvent func(uintptr) uintptr
 
 // Returns the event that is currently being handled by the controller.
 //
-// At other times, %NULL is returned.
+// At other times, nil is returned.
 func (x *EventController) GetCurrentEvent() *gdk.Event {
 	var cls *gdk.Event
 
@@ -72,7 +71,7 @@ var xEventControllerGetCurrentEventDevice func(uintptr) uintptr
 // Returns the device of the event that is currently being
 // handled by the controller.
 //
-// At other times, %NULL is returned.
+// At other times, nil is returned.
 func (x *EventController) GetCurrentEventDevice() *gdk.Device {
 	var cls *gdk.Device
 
@@ -113,7 +112,7 @@ func (x *EventController) GetCurrentEventTime() uint32 {
 
 var xEventControllerGetName func(uintptr) string
 
-// Gets the name of @controller.
+// Gets the name of controller.
 func (x *EventController) GetName() string {
 
 	cret := xEventControllerGetName(x.GoPointer())
@@ -131,7 +130,7 @@ func (x *EventController) GetPropagationLimit() PropagationLimit {
 
 var xEventControllerGetPropagationPhase func(uintptr) PropagationPhase
 
-// Gets the propagation phase at which @controller handles events.
+// Gets the propagation phase at which controller handles events.
 func (x *EventController) GetPropagationPhase() PropagationPhase {
 
 	cret := xEventControllerGetPropagationPhase(x.GoPointer())
@@ -157,7 +156,7 @@ func (x *EventController) GetWidget() *Widget {
 
 var xEventControllerReset func(uintptr)
 
-// Resets the @controller to a clean state.
+// Resets the controller to a clean state.
 func (x *EventController) Reset() {
 
 	xEventControllerReset(x.GoPointer())
@@ -180,7 +179,7 @@ var xEventControllerSetPropagationLimit func(uintptr, PropagationLimit)
 
 // Sets the event propagation limit on the event controller.
 //
-// If the limit is set to %GTK_LIMIT_SAME_NATIVE, the controller
+// If the limit is set to [LimitSameNativeValue], the controller
 // won't handle events that are targeted at widgets on a different
 // surface, such as popovers.
 func (x *EventController) SetPropagationLimit(LimitVar PropagationLimit) {
@@ -193,7 +192,7 @@ var xEventControllerSetPropagationPhase func(uintptr, PropagationPhase)
 
 // Sets the propagation phase at which a controller handles events.
 //
-// If @phase is %GTK_PHASE_NONE, no automatic event handling will be
+// If phase is [PhaseNoneValue], no automatic event handling will be
 // performed, but other additional gesture maintenance will.
 func (x *EventController) SetPropagationPhase(PhaseVar PropagationPhase) {
 
@@ -246,7 +245,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -255,18 +254,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerGLibType, libs, "gtk_event_controller_get_type")
 
-	core.PuregoSafeRegister(&xEventControllerGetCurrentEvent, libs, "gtk_event_controller_get_current_event")
-	core.PuregoSafeRegister(&xEventControllerGetCurrentEventDevice, libs, "gtk_event_controller_get_current_event_device")
-	core.PuregoSafeRegister(&xEventControllerGetCurrentEventState, libs, "gtk_event_controller_get_current_event_state")
-	core.PuregoSafeRegister(&xEventControllerGetCurrentEventTime, libs, "gtk_event_controller_get_current_event_time")
-	core.PuregoSafeRegister(&xEventControllerGetName, libs, "gtk_event_controller_get_name")
-	core.PuregoSafeRegister(&xEventControllerGetPropagationLimit, libs, "gtk_event_controller_get_propagation_limit")
-	core.PuregoSafeRegister(&xEventControllerGetPropagationPhase, libs, "gtk_event_controller_get_propagation_phase")
-	core.PuregoSafeRegister(&xEventControllerGetWidget, libs, "gtk_event_controller_get_widget")
-	core.PuregoSafeRegister(&xEventControllerReset, libs, "gtk_event_controller_reset")
-	core.PuregoSafeRegister(&xEventControllerSetName, libs, "gtk_event_controller_set_name")
-	core.PuregoSafeRegister(&xEventControllerSetPropagationLimit, libs, "gtk_event_controller_set_propagation_limit")
-	core.PuregoSafeRegister(&xEventControllerSetPropagationPhase, libs, "gtk_event_controller_set_propagation_phase")
-	core.PuregoSafeRegister(&xEventControllerSetStaticName, libs, "gtk_event_controller_set_static_name")
+	core.PuregoRegisterSince(&xEventControllerGetCurrentEvent, libs, "gtk_event_controller_get_current_event", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetCurrentEventDevice, libs, "gtk_event_controller_get_current_event_device", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetCurrentEventState, libs, "gtk_event_controller_get_current_event_state", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetCurrentEventTime, libs, "gtk_event_controller_get_current_event_time", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetName, libs, "gtk_event_controller_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetPropagationLimit, libs, "gtk_event_controller_get_propagation_limit", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetPropagationPhase, libs, "gtk_event_controller_get_propagation_phase", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerGetWidget, libs, "gtk_event_controller_get_widget", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerReset, libs, "gtk_event_controller_reset", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerSetName, libs, "gtk_event_controller_set_name", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerSetPropagationLimit, libs, "gtk_event_controller_set_propagation_limit", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerSetPropagationPhase, libs, "gtk_event_controller_set_propagation_phase", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerSetStaticName, libs, "gtk_event_controller_set_static_name", "GTK", "4.8")
 
 }
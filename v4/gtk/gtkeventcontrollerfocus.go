@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,10 +21,10 @@ func (x *EventControllerFocusClass) GoPointer() uintptr {
 
 // Tracks keyboard focus.
 //
-// The event controller offers [signal@Gtk.EventControllerFocus::enter]
-// and [signal@Gtk.EventControllerFocus::leave] signals, as well as
-// [property@Gtk.EventControllerFocus:is-focus] and
-// [property@Gtk.EventControllerFocus:contains-focus] properties
+// The event controller offers [EventControllerFocus.ConnectEnterFunc]
+// and [EventControllerFocus.ConnectLeaveFunc] signals, as well as
+// [EventControllerFocus.GetPropertyIsFocus] and
+// [EventControllerFocus.GetPropertyContainsFocus] properties
 // which are updated to reflect focus changes inside the widget hierarchy
 // that is rooted at the controllers widget.
 type EventControllerFocus struct {
@@ -62,7 +61,7 @@ func NewEventControllerFocus() *EventControllerFocus {
 
 var xEventControllerFocusContainsFocus func(uintptr) bool
 
-// Returns %TRUE if focus is within @self or one of its children.
+// Returns true if focus is within self or one of its children.
 func (x *EventControllerFocus) ContainsFocus() bool {
 
 	cret := xEventControllerFocusContainsFocus(x.GoPointer())
@@ -71,7 +70,7 @@ func (x *EventControllerFocus) ContainsFocus() bool {
 
 var xEventControllerFocusIsFocus func(uintptr) bool
 
-// Returns %TRUE if focus is within @self, but not one of its children.
+// Returns true if focus is within self, but not one of its children.
 func (x *EventControllerFocus) IsFocus() bool {
 
 	cret := xEventControllerFocusIsFocus(x.GoPointer())
@@ -90,14 +89,14 @@ func (c *EventControllerFocus) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyContainsFocus gets the "contains-focus" property.
-// %TRUE if focus is contained in the controllers widget.
+// true if focus is contained in the controllers widget.
 //
-// See [property@Gtk.EventControllerFocus:is-focus] for whether
+// See [EventControllerFocus.GetPropertyIsFocus] for whether
 // the focus is in the widget itself or inside a descendent.
 //
 // When handling focus events, this property is updated
-// before [signal@Gtk.EventControllerFocus::enter] or
-// [signal@Gtk.EventControllerFocus::leave] are emitted.
+// before [EventControllerFocus.ConnectEnterFunc] or
+// [EventControllerFocus.ConnectLeaveFunc] are emitted.
 func (x *EventControllerFocus) GetPropertyContainsFocus() bool {
 	var v gobject.Value
 	x.GetProperty("contains-focus", &v)
@@ -105,14 +104,14 @@ func (x *EventControllerFocus) GetPropertyContainsFocus() bool {
 }
 
 // GetPropertyIsFocus gets the "is-focus" property.
-// %TRUE if focus is in the controllers widget itself,
+// true if focus is in the controllers widget itself,
 // as opposed to in a descendent widget.
 //
-// See also [property@Gtk.EventControllerFocus:contains-focus].
+// See also [EventControllerFocus.GetPropertyContainsFocus].
 //
 // When handling focus events, this property is updated
-// before [signal@Gtk.EventControllerFocus::enter] or
-// [signal@Gtk.EventControllerFocus::leave] are emitted.
+// before [EventControllerFocus.ConnectEnterFunc] or
+// [EventControllerFocus.ConnectLeaveFunc] are emitted.
 func (x *EventControllerFocus) GetPropertyIsFocus() bool {
 	var v gobject.Value
 	x.GetProperty("is-focus", &v)
@@ -127,29 +126,51 @@ func (x *EventControllerFocus) GetPropertyIsFocus() bool {
 // certain cases (such as when the focus moves from a descendent
 // of the widget to the widget itself). If you are interested
 // in these cases, you can monitor the
-// [property@Gtk.EventControllerFocus:is-focus]
+// [EventControllerFocus.GetPropertyIsFocus]
 // property for changes.
+//
+// Deprecated: use ConnectEnterFunc, which also accepts method values and closures.
 func (x *EventControllerFocus) ConnectEnter(cb *func(EventControllerFocus)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerFocus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enter", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEventControllerFocusEnterTrampoline uintptr
+
+func xEventControllerFocusEnterNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerFocus))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerFocus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEnterFunc connects cb to the "enter" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerFocus) ConnectEnterFunc(cb func(EventControllerFocus)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enter", &xEventControllerFocusEnterTrampoline, xEventControllerFocusEnterNewTrampoline, cb)
 }
 
 // Emitted whenever the focus leaves the widget hierarchy
@@ -159,29 +180,51 @@ func (x *EventControllerFocus) ConnectEnter(cb *func(EventControllerFocus)) uint
 // even though the focus moves away from the widget, in
 // certain cases (such as when the focus moves from the widget
 // to a descendent). If you are interested in these cases, you
-// can monitor the [property@Gtk.EventControllerFocus:is-focus]
+// can monitor the [EventControllerFocus.GetPropertyIsFocus]
 // property for changes.
+//
+// Deprecated: use ConnectLeaveFunc, which also accepts method values and closures.
 func (x *EventControllerFocus) ConnectLeave(cb *func(EventControllerFocus)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerFocus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "leave", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEventControllerFocusLeaveTrampoline uintptr
+
+func xEventControllerFocusLeaveNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerFocus))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerFocus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLeaveFunc connects cb to the "leave" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerFocus) ConnectLeaveFunc(cb func(EventControllerFocus)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "leave", &xEventControllerFocusLeaveTrampoline, xEventControllerFocusLeaveNewTrampoline, cb)
 }
 
 func init() {
@@ -189,7 +232,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -198,9 +241,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerFocusGLibType, libs, "gtk_event_controller_focus_get_type")
 
-	core.PuregoSafeRegister(&xNewEventControllerFocus, libs, "gtk_event_controller_focus_new")
+	core.PuregoRegisterSince(&xNewEventControllerFocus, libs, "gtk_event_controller_focus_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xEventControllerFocusContainsFocus, libs, "gtk_event_controller_focus_contains_focus")
-	core.PuregoSafeRegister(&xEventControllerFocusIsFocus, libs, "gtk_event_controller_focus_is_focus")
+	core.PuregoRegisterSince(&xEventControllerFocusContainsFocus, libs, "gtk_event_controller_focus_contains_focus", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerFocusIsFocus, libs, "gtk_event_controller_focus_is_focus", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -56,12 +55,12 @@ func NewEventControllerKey() *EventControllerKey {
 
 var xEventControllerKeyForward func(uintptr, uintptr) bool
 
-// Forwards the current event of this @controller to a @widget.
+// Forwards the current event of this controller to a widget.
 //
 // This function can only be used in handlers for the
-// [signal@Gtk.EventControllerKey::key-pressed],
-// [signal@Gtk.EventControllerKey::key-released]
-// or [signal@Gtk.EventControllerKey::modifiers] signals.
+// [EventControllerKey.ConnectKeyPressedFunc],
+// [EventControllerKey.ConnectKeyReleasedFunc]
+// or [EventControllerKey.ConnectModifiersFunc] signals.
 func (x *EventControllerKey) Forward(WidgetVar *Widget) bool {
 
 	cret := xEventControllerKeyForward(x.GoPointer(), WidgetVar.GoPointer())
@@ -70,9 +69,9 @@ func (x *EventControllerKey) Forward(WidgetVar *Widget) bool {
 
 var xEventControllerKeyGetGroup func(uintptr) uint
 
-// Gets the key group of the current event of this @controller.
+// Gets the key group of the current event of this controller.
 //
-// See [method@Gdk.KeyEvent.get_layout].
+// See [gdk.KeyEvent.GetLayout].
 func (x *EventControllerKey) GetGroup() uint {
 
 	cret := xEventControllerKeyGetGroup(x.GoPointer())
@@ -81,7 +80,7 @@ func (x *EventControllerKey) GetGroup() uint {
 
 var xEventControllerKeyGetImContext func(uintptr) uintptr
 
-// Gets the input method context of the key @controller.
+// Gets the input method context of the key controller.
 func (x *EventControllerKey) GetImContext() *IMContext {
 	var cls *IMContext
 
@@ -98,7 +97,7 @@ func (x *EventControllerKey) GetImContext() *IMContext {
 
 var xEventControllerKeySetImContext func(uintptr, uintptr)
 
-// Sets the input method context of the key @controller.
+// Sets the input method context of the key controller.
 func (x *EventControllerKey) SetImContext(ImContextVar *IMContext) {
 
 	xEventControllerKeySetImContext(x.GoPointer(), ImContextVar.GoPointer())
@@ -117,103 +116,191 @@ func (c *EventControllerKey) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted whenever the input method context filters away
-// a keypress and prevents the @controller receiving it.
+// a keypress and prevents the controller receiving it.
 //
-// See [method@Gtk.EventControllerKey.set_im_context] and
-// [method@Gtk.IMContext.filter_keypress].
+// See [EventControllerKey.SetImContext] and
+// [IMContext.FilterKeypress].
+//
+// Deprecated: use ConnectImUpdateFunc, which also accepts method values and closures.
 func (x *EventControllerKey) ConnectImUpdate(cb *func(EventControllerKey)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "im-update", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerKey{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "im-update", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEventControllerKeyImUpdateTrampoline uintptr
+
+func xEventControllerKeyImUpdateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerKey))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerKey{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "im-update", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectImUpdateFunc connects cb to the "im-update" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerKey) ConnectImUpdateFunc(cb func(EventControllerKey)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "im-update", &xEventControllerKeyImUpdateTrampoline, xEventControllerKeyImUpdateNewTrampoline, cb)
 }
 
 // Emitted whenever a key is pressed.
+//
+// Deprecated: use ConnectKeyPressedFunc, which also accepts method values and closures.
 func (x *EventControllerKey) ConnectKeyPressed(cb *func(EventControllerKey, uint, uint, gdk.ModifierType) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "key-pressed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType) bool {
+			defer core.RecoverPanic()
+			fa := EventControllerKey{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, KeyvalVarp, KeycodeVarp, StateVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "key-pressed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType) bool {
+var xEventControllerKeyKeyPressedTrampoline uintptr
+
+func xEventControllerKeyKeyPressedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerKey, uint, uint, gdk.ModifierType) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerKey{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, KeyvalVarp, KeycodeVarp, StateVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "key-pressed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectKeyPressedFunc connects cb to the "key-pressed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerKey) ConnectKeyPressedFunc(cb func(EventControllerKey, uint, uint, gdk.ModifierType) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "key-pressed", &xEventControllerKeyKeyPressedTrampoline, xEventControllerKeyKeyPressedNewTrampoline, cb)
 }
 
 // Emitted whenever a key is released.
+//
+// Deprecated: use ConnectKeyReleasedFunc, which also accepts method values and closures.
 func (x *EventControllerKey) ConnectKeyReleased(cb *func(EventControllerKey, uint, uint, gdk.ModifierType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "key-released", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType) {
+			defer core.RecoverPanic()
+			fa := EventControllerKey{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, KeyvalVarp, KeycodeVarp, StateVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "key-released", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType) {
+var xEventControllerKeyKeyReleasedTrampoline uintptr
+
+func xEventControllerKeyKeyReleasedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, KeyvalVarp uint, KeycodeVarp uint, StateVarp gdk.ModifierType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerKey, uint, uint, gdk.ModifierType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerKey{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, KeyvalVarp, KeycodeVarp, StateVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "key-released", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectKeyReleasedFunc connects cb to the "key-released" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerKey) ConnectKeyReleasedFunc(cb func(EventControllerKey, uint, uint, gdk.ModifierType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "key-released", &xEventControllerKeyKeyReleasedTrampoline, xEventControllerKeyKeyReleasedNewTrampoline, cb)
 }
 
 // Emitted whenever the state of modifier keys and pointer buttons change.
+//
+// Deprecated: use ConnectModifiersFunc, which also accepts method values and closures.
 func (x *EventControllerKey) ConnectModifiers(cb *func(EventControllerKey, gdk.ModifierType) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "modifiers", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StateVarp gdk.ModifierType) bool {
+			defer core.RecoverPanic()
+			fa := EventControllerKey{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, StateVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "modifiers", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StateVarp gdk.ModifierType) bool {
+var xEventControllerKeyModifiersTrampoline uintptr
+
+func xEventControllerKeyModifiersNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StateVarp gdk.ModifierType, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerKey, gdk.ModifierType) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerKey{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, StateVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "modifiers", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectModifiersFunc connects cb to the "modifiers" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerKey) ConnectModifiersFunc(cb func(EventControllerKey, gdk.ModifierType) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "modifiers", &xEventControllerKeyModifiersTrampoline, xEventControllerKeyModifiersNewTrampoline, cb)
 }
 
 func init() {
@@ -221,7 +308,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -230,11 +317,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerKeyGLibType, libs, "gtk_event_controller_key_get_type")
 
-	core.PuregoSafeRegister(&xNewEventControllerKey, libs, "gtk_event_controller_key_new")
+	core.PuregoRegisterSince(&xNewEventControllerKey, libs, "gtk_event_controller_key_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xEventControllerKeyForward, libs, "gtk_event_controller_key_forward")
-	core.PuregoSafeRegister(&xEventControllerKeyGetGroup, libs, "gtk_event_controller_key_get_group")
-	core.PuregoSafeRegister(&xEventControllerKeyGetImContext, libs, "gtk_event_controller_key_get_im_context")
-	core.PuregoSafeRegister(&xEventControllerKeySetImContext, libs, "gtk_event_controller_key_set_im_context")
+	core.PuregoRegisterSince(&xEventControllerKeyForward, libs, "gtk_event_controller_key_forward", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerKeyGetGroup, libs, "gtk_event_controller_key_get_group", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerKeyGetImContext, libs, "gtk_event_controller_key_get_im_context", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerKeySetImContext, libs, "gtk_event_controller_key_set_im_context", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -67,28 +66,50 @@ func (c *EventControllerLegacy) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Emitted for each GDK event delivered to @controller.
+// Emitted for each GDK event delivered to controller.
+//
+// Deprecated: use ConnectEventFunc, which also accepts method values and closures.
 func (x *EventControllerLegacy) ConnectEvent(cb *func(EventControllerLegacy, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, EventVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := EventControllerLegacy{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, EventVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "event", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, EventVarp uintptr) bool {
+var xEventControllerLegacyEventTrampoline uintptr
+
+func xEventControllerLegacyEventNewTrampoline() interface{} {
+	return func(clsPtr uintptr, EventVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerLegacy, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerLegacy{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, EventVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "event", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEventFunc connects cb to the "event" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerLegacy) ConnectEventFunc(cb func(EventControllerLegacy, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "event", &xEventControllerLegacyEventTrampoline, xEventControllerLegacyEventNewTrampoline, cb)
 }
 
 func init() {
@@ -96,7 +117,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -105,6 +126,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerLegacyGLibType, libs, "gtk_event_controller_legacy_get_type")
 
-	core.PuregoSafeRegister(&xNewEventControllerLegacy, libs, "gtk_event_controller_legacy_new")
+	core.PuregoRegisterSince(&xNewEventControllerLegacy, libs, "gtk_event_controller_legacy_new", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -22,10 +21,10 @@ func (x *EventControllerMotionClass) GoPointer() uintptr {
 
 // Tracks the pointer position.
 //
-// The event controller offers [signal@Gtk.EventControllerMotion::enter]
-// and [signal@Gtk.EventControllerMotion::leave] signals, as well as
-// [property@Gtk.EventControllerMotion:is-pointer] and
-// [property@Gtk.EventControllerMotion:contains-pointer] properties
+// The event controller offers [EventControllerMotion.ConnectEnterFunc]
+// and [EventControllerMotion.ConnectLeaveFunc] signals, as well as
+// [EventControllerMotion.GetPropertyIsPointer] and
+// [EventControllerMotion.GetPropertyContainsPointer] properties
 // which are updated to reflect changes in the pointer position as it
 // moves over the widget.
 type EventControllerMotion struct {
@@ -62,7 +61,7 @@ func NewEventControllerMotion() *EventControllerMotion {
 
 var xEventControllerMotionContainsPointer func(uintptr) bool
 
-// Returns if a pointer is within @self or one of its children.
+// Returns if a pointer is within self or one of its children.
 func (x *EventControllerMotion) ContainsPointer() bool {
 
 	cret := xEventControllerMotionContainsPointer(x.GoPointer())
@@ -71,7 +70,7 @@ func (x *EventControllerMotion) ContainsPointer() bool {
 
 var xEventControllerMotionIsPointer func(uintptr) bool
 
-// Returns if a pointer is within @self, but not one of its children.
+// Returns if a pointer is within self, but not one of its children.
 func (x *EventControllerMotion) IsPointer() bool {
 
 	cret := xEventControllerMotionIsPointer(x.GoPointer())
@@ -92,11 +91,11 @@ func (c *EventControllerMotion) SetGoPointer(ptr uintptr) {
 // GetPropertyContainsPointer gets the "contains-pointer" property.
 // Whether the pointer is in the controllers widget or a descendant.
 //
-// See also [property@Gtk.EventControllerMotion:is-pointer].
+// See also [EventControllerMotion.GetPropertyIsPointer].
 //
 // When handling crossing events, this property is updated
-// before [signal@Gtk.EventControllerMotion::enter], but after
-// [signal@Gtk.EventControllerMotion::leave] is emitted.
+// before [EventControllerMotion.ConnectEnterFunc], but after
+// [EventControllerMotion.ConnectLeaveFunc] is emitted.
 func (x *EventControllerMotion) GetPropertyContainsPointer() bool {
 	var v gobject.Value
 	x.GetProperty("contains-pointer", &v)
@@ -107,11 +106,11 @@ func (x *EventControllerMotion) GetPropertyContainsPointer() bool {
 // Whether the pointer is in the controllers widget itself,
 // as opposed to in a descendent widget.
 //
-// See also [property@Gtk.EventControllerMotion:contains-pointer].
+// See also [EventControllerMotion.GetPropertyContainsPointer].
 //
 // When handling crossing events, this property is updated
-// before [signal@Gtk.EventControllerMotion::enter], but after
-// [signal@Gtk.EventControllerMotion::leave] is emitted.
+// before [EventControllerMotion.ConnectEnterFunc], but after
+// [EventControllerMotion.ConnectLeaveFunc] is emitted.
 func (x *EventControllerMotion) GetPropertyIsPointer() bool {
 	var v gobject.Value
 	x.GetProperty("is-pointer", &v)
@@ -119,75 +118,141 @@ func (x *EventControllerMotion) GetPropertyIsPointer() bool {
 }
 
 // Signals that the pointer has entered the widget.
+//
+// Deprecated: use ConnectEnterFunc, which also accepts method values and closures.
 func (x *EventControllerMotion) ConnectEnter(cb *func(EventControllerMotion, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := EventControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enter", cbRefPtr, cbPtr)
+}
+
+var xEventControllerMotionEnterTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+func xEventControllerMotionEnterNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerMotion, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enter", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEnterFunc connects cb to the "enter" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerMotion) ConnectEnterFunc(cb func(EventControllerMotion, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enter", &xEventControllerMotionEnterTrampoline, xEventControllerMotionEnterNewTrampoline, cb)
 }
 
 // Signals that the pointer has left the widget.
+//
+// Deprecated: use ConnectLeaveFunc, which also accepts method values and closures.
 func (x *EventControllerMotion) ConnectLeave(cb *func(EventControllerMotion)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "leave", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEventControllerMotionLeaveTrampoline uintptr
+
+func xEventControllerMotionLeaveNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerMotion))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "leave", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLeaveFunc connects cb to the "leave" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerMotion) ConnectLeaveFunc(cb func(EventControllerMotion)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "leave", &xEventControllerMotionLeaveTrampoline, xEventControllerMotionLeaveNewTrampoline, cb)
 }
 
 // Emitted when the pointer moves inside the widget.
+//
+// Deprecated: use ConnectMotionFunc, which also accepts method values and closures.
 func (x *EventControllerMotion) ConnectMotion(cb *func(EventControllerMotion, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := EventControllerMotion{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "motion", cbRefPtr, cbPtr)
+}
+
+var xEventControllerMotionMotionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+func xEventControllerMotionMotionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerMotion, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerMotion{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMotionFunc connects cb to the "motion" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerMotion) ConnectMotionFunc(cb func(EventControllerMotion, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "motion", &xEventControllerMotionMotionTrampoline, xEventControllerMotionMotionNewTrampoline, cb)
 }
 
 func init() {
@@ -195,7 +260,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -204,9 +269,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerMotionGLibType, libs, "gtk_event_controller_motion_get_type")
 
-	core.PuregoSafeRegister(&xNewEventControllerMotion, libs, "gtk_event_controller_motion_new")
+	core.PuregoRegisterSince(&xNewEventControllerMotion, libs, "gtk_event_controller_motion_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xEventControllerMotionContainsPointer, libs, "gtk_event_controller_motion_contains_pointer")
-	core.PuregoSafeRegister(&xEventControllerMotionIsPointer, libs, "gtk_event_controller_motion_is_pointer")
+	core.PuregoRegisterSince(&xEventControllerMotionContainsPointer, libs, "gtk_event_controller_motion_contains_pointer", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerMotionIsPointer, libs, "gtk_event_controller_motion_is_pointer", "GTK", "")
 
 }
@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -22,7 +23,7 @@ func (x *EventControllerScrollClass) GoPointer() uintptr {
 }
 
 // Describes the behavior of a `GtkEventControllerScroll`.
-type EventControllerScrollFlags int
+type EventControllerScrollFlags uint32
 
 var xEventControllerScrollFlagsGLibType func() types.GType
 
@@ -42,44 +43,101 @@ const (
 	EventControllerScrollDiscreteValue EventControllerScrollFlags = 4
 	// Emit ::decelerate after continuous scroll finishes.
 	EventControllerScrollKineticValue EventControllerScrollFlags = 8
-	// A #GtkEventControllerScrollFlags value to prefer physical direction over
+	// A [EventControllerScrollFlags] value to prefer physical direction over
 	// logical direction (i.e. oblivious to natural scroll).
 	EventControllerScrollPhysicalDirectionValue EventControllerScrollFlags = 16
 	// Emit scroll on both axes.
 	EventControllerScrollBothAxesValue EventControllerScrollFlags = 3
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (EventControllerScrollFlags) GLibType() types.GType {
+	return xEventControllerScrollFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x EventControllerScrollFlags) Has(flags EventControllerScrollFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x EventControllerScrollFlags) Set(flags EventControllerScrollFlags) EventControllerScrollFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x EventControllerScrollFlags) Clear(flags EventControllerScrollFlags) EventControllerScrollFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x EventControllerScrollFlags) String() string {
+	if x == 0 {
+		return "GTK_EVENT_CONTROLLER_SCROLL_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&EventControllerScrollVerticalValue != 0 && x&EventControllerScrollVerticalValue == EventControllerScrollVerticalValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_VERTICAL")
+		rest &^= EventControllerScrollVerticalValue
+	}
+	if rest&EventControllerScrollHorizontalValue != 0 && x&EventControllerScrollHorizontalValue == EventControllerScrollHorizontalValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_HORIZONTAL")
+		rest &^= EventControllerScrollHorizontalValue
+	}
+	if rest&EventControllerScrollDiscreteValue != 0 && x&EventControllerScrollDiscreteValue == EventControllerScrollDiscreteValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_DISCRETE")
+		rest &^= EventControllerScrollDiscreteValue
+	}
+	if rest&EventControllerScrollKineticValue != 0 && x&EventControllerScrollKineticValue == EventControllerScrollKineticValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_KINETIC")
+		rest &^= EventControllerScrollKineticValue
+	}
+	if rest&EventControllerScrollPhysicalDirectionValue != 0 && x&EventControllerScrollPhysicalDirectionValue == EventControllerScrollPhysicalDirectionValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_PHYSICAL_DIRECTION")
+		rest &^= EventControllerScrollPhysicalDirectionValue
+	}
+	if rest&EventControllerScrollBothAxesValue != 0 && x&EventControllerScrollBothAxesValue == EventControllerScrollBothAxesValue {
+		names = append(names, "GTK_EVENT_CONTROLLER_SCROLL_BOTH_AXES")
+		rest &^= EventControllerScrollBothAxesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Handles scroll events.
 //
 // It is capable of handling both discrete and continuous scroll
 // events from mice or touchpads, abstracting them both with the
-// [signal@Gtk.EventControllerScroll::scroll] signal. Deltas in
+// [EventControllerScroll.ConnectScrollFunc] signal. Deltas in
 // the discrete case are multiples of 1.
 //
 // In the case of continuous scroll events, `GtkEventControllerScroll`
-// encloses all [signal@Gtk.EventControllerScroll::scroll] emissions
-// between two [signal@Gtk.EventControllerScroll::scroll-begin] and
-// [signal@Gtk.EventControllerScroll::scroll-end] signals.
+// encloses all [EventControllerScroll.ConnectScrollFunc] emissions
+// between two [EventControllerScroll.ConnectScrollBeginFunc] and
+// [EventControllerScroll.ConnectScrollEndFunc] signals.
 //
 // The behavior of the event controller can be modified by the flags
 // given at creation time, or modified at a later point through
-// [method@Gtk.EventControllerScroll.set_flags] (e.g. because the scrolling
+// [EventControllerScroll.SetFlags] (e.g. because the scrolling
 // conditions of the widget changed).
 //
 // The controller can be set up to emit motion for either/both vertical
-// and horizontal scroll events through %GTK_EVENT_CONTROLLER_SCROLL_VERTICAL,
-// %GTK_EVENT_CONTROLLER_SCROLL_HORIZONTAL and %GTK_EVENT_CONTROLLER_SCROLL_BOTH_AXES.
-// If any axis is disabled, the respective [signal@Gtk.EventControllerScroll::scroll]
+// and horizontal scroll events through [EventControllerScrollVerticalValue],
+// [EventControllerScrollHorizontalValue] and [EventControllerScrollBothAxesValue].
+// If any axis is disabled, the respective [EventControllerScroll.ConnectScrollFunc]
 // delta will be 0. Vertical scroll events will be translated to horizontal
 // motion for the devices incapable of horizontal scrolling.
 //
 // The event controller can also be forced to emit discrete events on all
-// devices through %GTK_EVENT_CONTROLLER_SCROLL_DISCRETE. This can be used
+// devices through [EventControllerScrollDiscreteValue]. This can be used
 // to implement discrete actions triggered through scroll events (e.g.
 // switching across combobox options).
 //
-// The %GTK_EVENT_CONTROLLER_SCROLL_KINETIC flag toggles the emission of the
-// [signal@Gtk.EventControllerScroll::decelerate] signal, emitted at the end
+// The [EventControllerScrollKineticValue] flag toggles the emission of the
+// [EventControllerScroll.ConnectDecelerateFunc] signal, emitted at the end
 // of scrolling with two X/Y velocity arguments that are consistent with the
 // motion that was received.
 type EventControllerScroll struct {
@@ -126,10 +184,10 @@ func (x *EventControllerScroll) GetFlags() EventControllerScrollFlags {
 var xEventControllerScrollGetUnit func(uintptr) gdk.ScrollUnit
 
 // Gets the scroll unit of the last
-// [signal@Gtk.EventControllerScroll::scroll] signal received.
+// [EventControllerScroll.ConnectScrollFunc] signal received.
 //
-// Always returns %GDK_SCROLL_UNIT_WHEEL if the
-// %GTK_EVENT_CONTROLLER_SCROLL_DISCRETE flag is set.
+// Always returns [gdk.ScrollUnitWheelValue] if the
+// [EventControllerScrollDiscreteValue] flag is set.
 func (x *EventControllerScroll) GetUnit() gdk.ScrollUnit {
 
 	cret := xEventControllerScrollGetUnit(x.GoPointer())
@@ -157,112 +215,200 @@ func (c *EventControllerScroll) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted after scroll is finished if the
-// %GTK_EVENT_CONTROLLER_SCROLL_KINETIC flag is set.
+// [EventControllerScrollKineticValue] flag is set.
 //
-// @vel_x and @vel_y express the initial velocity that was
-// imprinted by the scroll events. @vel_x and @vel_y are expressed in
+// vel_x and vel_y express the initial velocity that was
+// imprinted by the scroll events. vel_x and vel_y are expressed in
 // pixels/ms.
+//
+// Deprecated: use ConnectDecelerateFunc, which also accepts method values and closures.
 func (x *EventControllerScroll) ConnectDecelerate(cb *func(EventControllerScroll, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "decelerate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VelXVarp float64, VelYVarp float64) {
+			defer core.RecoverPanic()
+			fa := EventControllerScroll{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VelXVarp, VelYVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "decelerate", cbRefPtr, cbPtr)
+}
+
+var xEventControllerScrollDecelerateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, VelXVarp float64, VelYVarp float64) {
+func xEventControllerScrollDecelerateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VelXVarp float64, VelYVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerScroll, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerScroll{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VelXVarp, VelYVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "decelerate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDecelerateFunc connects cb to the "decelerate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerScroll) ConnectDecelerateFunc(cb func(EventControllerScroll, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "decelerate", &xEventControllerScrollDecelerateTrampoline, xEventControllerScrollDecelerateNewTrampoline, cb)
 }
 
 // Signals that the widget should scroll by the
-// amount specified by @dx and @dy.
+// amount specified by dx and dy.
 //
 // For the representation unit of the deltas, see
-// [method@Gtk.EventControllerScroll.get_unit].
+// [EventControllerScroll.GetUnit].
+//
+// Deprecated: use ConnectScrollFunc, which also accepts method values and closures.
 func (x *EventControllerScroll) ConnectScroll(cb *func(EventControllerScroll, float64, float64) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "scroll", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DxVarp float64, DyVarp float64) bool {
+			defer core.RecoverPanic()
+			fa := EventControllerScroll{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DxVarp, DyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "scroll", cbRefPtr, cbPtr)
+}
+
+var xEventControllerScrollScrollTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DxVarp float64, DyVarp float64) bool {
+func xEventControllerScrollScrollNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DxVarp float64, DyVarp float64, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerScroll, float64, float64) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerScroll{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DxVarp, DyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "scroll", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectScrollFunc connects cb to the "scroll" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerScroll) ConnectScrollFunc(cb func(EventControllerScroll, float64, float64) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "scroll", &xEventControllerScrollScrollTrampoline, xEventControllerScrollScrollNewTrampoline, cb)
 }
 
 // Signals that a new scrolling operation has begun.
 //
 // It will only be emitted on devices capable of it.
+//
+// Deprecated: use ConnectScrollBeginFunc, which also accepts method values and closures.
 func (x *EventControllerScroll) ConnectScrollBegin(cb *func(EventControllerScroll)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-begin", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerScroll{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "scroll-begin", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xEventControllerScrollScrollBeginTrampoline uintptr
+
+func xEventControllerScrollScrollBeginNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerScroll))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerScroll{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-begin", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectScrollBeginFunc connects cb to the "scroll-begin" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerScroll) ConnectScrollBeginFunc(cb func(EventControllerScroll)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "scroll-begin", &xEventControllerScrollScrollBeginTrampoline, xEventControllerScrollScrollBeginNewTrampoline, cb)
 }
 
 // Signals that a scrolling operation has finished.
 //
 // It will only be emitted on devices capable of it.
+//
+// Deprecated: use ConnectScrollEndFunc, which also accepts method values and closures.
 func (x *EventControllerScroll) ConnectScrollEnd(cb *func(EventControllerScroll)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-end", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := EventControllerScroll{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "scroll-end", cbRefPtr, cbPtr)
+}
+
+var xEventControllerScrollScrollEndTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xEventControllerScrollScrollEndNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(EventControllerScroll))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := EventControllerScroll{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-end", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectScrollEndFunc connects cb to the "scroll-end" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *EventControllerScroll) ConnectScrollEndFunc(cb func(EventControllerScroll)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "scroll-end", &xEventControllerScrollScrollEndTrampoline, xEventControllerScrollScrollEndNewTrampoline, cb)
 }
 
 func init() {
@@ -270,7 +416,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -281,10 +427,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xEventControllerScrollGLibType, libs, "gtk_event_controller_scroll_get_type")
 
-	core.PuregoSafeRegister(&xNewEventControllerScroll, libs, "gtk_event_controller_scroll_new")
+	core.PuregoRegisterSince(&xNewEventControllerScroll, libs, "gtk_event_controller_scroll_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xEventControllerScrollGetFlags, libs, "gtk_event_controller_scroll_get_flags")
-	core.PuregoSafeRegister(&xEventControllerScrollGetUnit, libs, "gtk_event_controller_scroll_get_unit")
-	core.PuregoSafeRegister(&xEventControllerScrollSetFlags, libs, "gtk_event_controller_scroll_set_flags")
+	core.PuregoRegisterSince(&xEventControllerScrollGetFlags, libs, "gtk_event_controller_scroll_get_flags", "GTK", "")
+	core.PuregoRegisterSince(&xEventControllerScrollGetUnit, libs, "gtk_event_controller_scroll_get_unit", "GTK", "4.8")
+	core.PuregoRegisterSince(&xEventControllerScrollSetFlags, libs, "gtk_event_controller_scroll_set_flags", "GTK", "")
 
 }
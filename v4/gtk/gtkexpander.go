@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,17 +12,10 @@ import (
 
 // Allows the user to reveal or conceal a child widget.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="expander-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkExpander" src="expander.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // This is similar to the triangles used in a `GtkTreeView`.
 //
 // Normally you use an expander as you would use a frame; you create
-// the child widget and use [method@Gtk.Expander.set_child] to add it
+// the child widget and use [Expander.SetChild] to add it
 // to the expander. When the expander is toggled, it will take care of
 // showing and hiding the child automatically.
 //
@@ -33,17 +25,14 @@ import (
 // expanded widget yourself, such as when you want to actually create
 // the widget at expansion time. In this case, create a `GtkExpander`
 // but do not add a child to it. The expander widget has an
-// [property@Gtk.Expander:expanded] property which can be used to
+// [Expander.GetPropertyExpanded] property which can be used to
 // monitor its expansion state. You should watch this property with
 // a signal connection as follows:
 //
-// ```c
-// static void
-// expander_callback (GObject    *object,
-//
-//	GParamSpec *param_spec,
-//	gpointer    user_data)
-//
+//	static void
+//	expander_callback (GObject    *object,
+//	                   GParamSpec *param_spec,
+//	                   gpointer    user_data)
 //	{
 //	  GtkExpander *expander;
 //
@@ -59,9 +48,8 @@ import (
 //	    }
 //	}
 //
-// static void
-// create_expander (void)
-//
+//	static void
+//	create_expander (void)
 //	{
 //	  GtkWidget *expander = gtk_expander_new_with_mnemonic ("_More Options");
 //	  g_signal_connect (expander, "notify::expanded",
@@ -70,37 +58,27 @@ import (
 //	  // ...
 //	}
 //
-// ```
-//
 // # GtkExpander as GtkBuildable
 //
 // An example of a UI definition fragment with GtkExpander:
 //
-// ```xml
-// &lt;object class="GtkExpander"&gt;
-//
-//	&lt;property name="label-widget"&gt;
-//	  &lt;object class="GtkLabel" id="expander-label"/&gt;
-//	&lt;/property&gt;
-//	&lt;property name="child"&gt;
-//	  &lt;object class="GtkEntry" id="expander-content"/&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkExpander">
+//	  <property name="label-widget">
+//	    <object class="GtkLabel" id="expander-label"/>
+//	  </property>
+//	  <property name="child">
+//	    <object class="GtkEntry" id="expander-content"/>
+//	  </property>
+//	</object>
 //
 // # CSS nodes
 //
-// ```
-// expander-widget
-// ╰── box
-//
-//	├── title
-//	│   ├── expander
-//	│   ╰── &lt;label widget&gt;
-//	╰── &lt;child&gt;
-//
-// ```
+//	expander-widget
+//	╰── box
+//	    ├── title
+//	    │   ├── expander
+//	    │   ╰── <label widget>
+//	    ╰── <child>
 //
 // `GtkExpander` has a main node `expander-widget`, and subnode `box` containing
 // the title and child widget. The box subnode `title` contains node `expander`,
@@ -109,7 +87,7 @@ import (
 //
 // # Accessibility
 //
-// `GtkExpander` uses the [enum@Gtk.AccessibleRole.button] role.
+// `GtkExpander` uses the [AccessibleRoleButtonValue] role.
 type Expander struct {
 	Widget
 }
@@ -128,7 +106,7 @@ func ExpanderNewFromInternalPtr(ptr uintptr) *Expander {
 
 var xNewExpander func(uintptr) uintptr
 
-// Creates a new expander using @label as the text of the label.
+// Creates a new expander using label as the text of the label.
 func NewExpander(LabelVar *string) *Expander {
 	var cls *Expander
 
@@ -148,9 +126,9 @@ func NewExpander(LabelVar *string) *Expander {
 
 var xNewExpanderWithMnemonic func(uintptr) uintptr
 
-// Creates a new expander using @label as the text of the label.
+// Creates a new expander using label as the text of the label.
 //
-// If characters in @label are preceded by an underscore, they are
+// If characters in label are preceded by an underscore, they are
 // underlined. If you need a literal underscore character in a label,
 // use “__” (two underscores). The first underlined character represents
 // a keyboard accelerator called a mnemonic.
@@ -175,7 +153,7 @@ func NewExpanderWithMnemonic(LabelVar *string) *Expander {
 
 var xExpanderGetChild func(uintptr) uintptr
 
-// Gets the child widget of @expander.
+// Gets the child widget of expander.
 func (x *Expander) GetChild() *Widget {
 	var cls *Widget
 
@@ -194,7 +172,7 @@ var xExpanderGetExpanded func(uintptr) bool
 
 // Queries a `GtkExpander` and returns its current state.
 //
-// Returns %TRUE if the child widget is revealed.
+// Returns true if the child widget is revealed.
 func (x *Expander) GetExpanded() bool {
 
 	cret := xExpanderGetExpanded(x.GoPointer())
@@ -206,9 +184,9 @@ var xExpanderGetLabel func(uintptr) string
 // Fetches the text from a label widget.
 //
 // This is including any embedded underlines indicating mnemonics and
-// Pango markup, as set by [method@Gtk.Expander.set_label]. If the label
-// text has not been set the return value will be %NULL. This will be the
-// case if you create an empty button with gtk_button_new() to use as a
+// Pango markup, as set by [Expander.SetLabel]. If the label
+// text has not been set the return value will be nil. This will be the
+// case if you create an empty button with [NewButton] to use as a
 // container.
 func (x *Expander) GetLabel() string {
 
@@ -263,7 +241,7 @@ func (x *Expander) GetUseUnderline() bool {
 
 var xExpanderSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @expander.
+// Sets the child widget of expander.
 func (x *Expander) SetChild(ChildVar *Widget) {
 
 	xExpanderSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -274,8 +252,8 @@ var xExpanderSetExpanded func(uintptr, bool)
 
 // Sets the state of the expander.
 //
-// Set to %TRUE, if you want the child widget to be revealed,
-// and %FALSE if you want the child widget to be hidden.
+// Set to true, if you want the child widget to be revealed,
+// and false if you want the child widget to be hidden.
 func (x *Expander) SetExpanded(ExpandedVar bool) {
 
 	xExpanderSetExpanded(x.GoPointer(), ExpandedVar)
@@ -284,7 +262,7 @@ func (x *Expander) SetExpanded(ExpandedVar bool) {
 
 var xExpanderSetLabel func(uintptr, uintptr)
 
-// Sets the text of the label of the expander to @label.
+// Sets the text of the label of the expander to label.
 //
 // This will also clear any previously set labels.
 func (x *Expander) SetLabel(LabelVar *string) {
@@ -382,7 +360,7 @@ func (x *Expander) GetPropertyLabel() string {
 }
 
 // SetPropertyResizeToplevel sets the "resize-toplevel" property.
-// When this property is %TRUE, the expander will resize the toplevel
+// When this property is true, the expander will resize the toplevel
 // widget containing the expander upon expanding and collapsing.
 func (x *Expander) SetPropertyResizeToplevel(value bool) {
 	var v gobject.Value
@@ -392,7 +370,7 @@ func (x *Expander) SetPropertyResizeToplevel(value bool) {
 }
 
 // GetPropertyResizeToplevel gets the "resize-toplevel" property.
-// When this property is %TRUE, the expander will resize the toplevel
+// When this property is true, the expander will resize the toplevel
 // widget containing the expander upon expanding and collapsing.
 func (x *Expander) GetPropertyResizeToplevel() bool {
 	var v gobject.Value
@@ -435,27 +413,49 @@ func (x *Expander) GetPropertyUseUnderline() bool {
 }
 
 // Activates the `GtkExpander`.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Expander) ConnectActivate(cb *func(Expander)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Expander{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xExpanderActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xExpanderActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Expander))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Expander{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Expander) ConnectActivateFunc(cb func(Expander)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xExpanderActivateTrampoline, xExpanderActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -619,7 +619,7 @@ func (x *Expander) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -627,14 +627,13 @@ func (x *Expander) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Expander) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -658,19 +657,17 @@ func (x *Expander) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Expander) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -691,7 +688,7 @@ func (x *Expander) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -700,14 +697,12 @@ func (x *Expander) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Expander) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -726,10 +721,10 @@ func (x *Expander) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Expander) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -741,7 +736,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -750,22 +745,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xExpanderGLibType, libs, "gtk_expander_get_type")
 
-	core.PuregoSafeRegister(&xNewExpander, libs, "gtk_expander_new")
-	core.PuregoSafeRegister(&xNewExpanderWithMnemonic, libs, "gtk_expander_new_with_mnemonic")
-
-	core.PuregoSafeRegister(&xExpanderGetChild, libs, "gtk_expander_get_child")
-	core.PuregoSafeRegister(&xExpanderGetExpanded, libs, "gtk_expander_get_expanded")
-	core.PuregoSafeRegister(&xExpanderGetLabel, libs, "gtk_expander_get_label")
-	core.PuregoSafeRegister(&xExpanderGetLabelWidget, libs, "gtk_expander_get_label_widget")
-	core.PuregoSafeRegister(&xExpanderGetResizeToplevel, libs, "gtk_expander_get_resize_toplevel")
-	core.PuregoSafeRegister(&xExpanderGetUseMarkup, libs, "gtk_expander_get_use_markup")
-	core.PuregoSafeRegister(&xExpanderGetUseUnderline, libs, "gtk_expander_get_use_underline")
-	core.PuregoSafeRegister(&xExpanderSetChild, libs, "gtk_expander_set_child")
-	core.PuregoSafeRegister(&xExpanderSetExpanded, libs, "gtk_expander_set_expanded")
-	core.PuregoSafeRegister(&xExpanderSetLabel, libs, "gtk_expander_set_label")
-	core.PuregoSafeRegister(&xExpanderSetLabelWidget, libs, "gtk_expander_set_label_widget")
-	core.PuregoSafeRegister(&xExpanderSetResizeToplevel, libs, "gtk_expander_set_resize_toplevel")
-	core.PuregoSafeRegister(&xExpanderSetUseMarkup, libs, "gtk_expander_set_use_markup")
-	core.PuregoSafeRegister(&xExpanderSetUseUnderline, libs, "gtk_expander_set_use_underline")
+	core.PuregoRegisterSince(&xNewExpander, libs, "gtk_expander_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewExpanderWithMnemonic, libs, "gtk_expander_new_with_mnemonic", "GTK", "")
+
+	core.PuregoRegisterSince(&xExpanderGetChild, libs, "gtk_expander_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetExpanded, libs, "gtk_expander_get_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetLabel, libs, "gtk_expander_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetLabelWidget, libs, "gtk_expander_get_label_widget", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetResizeToplevel, libs, "gtk_expander_get_resize_toplevel", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetUseMarkup, libs, "gtk_expander_get_use_markup", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderGetUseUnderline, libs, "gtk_expander_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetChild, libs, "gtk_expander_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetExpanded, libs, "gtk_expander_set_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetLabel, libs, "gtk_expander_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetLabelWidget, libs, "gtk_expander_set_label_widget", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetResizeToplevel, libs, "gtk_expander_set_resize_toplevel", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetUseMarkup, libs, "gtk_expander_set_use_markup", "GTK", "")
+	core.PuregoRegisterSince(&xExpanderSetUseUnderline, libs, "gtk_expander_set_use_underline", "GTK", "")
 
 }
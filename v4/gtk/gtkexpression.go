@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Callback called by gtk_expression_watch() when the
+// Callback called by [Expression.Watch] when the
 // expression value changes.
 type ExpressionNotify func(uintptr)
 
@@ -39,7 +38,7 @@ var xExpressionWatchEvaluate func(uintptr, *gobject.Value) bool
 // Evaluates the watched expression and on success stores the result
 // in `value`.
 //
-// This is equivalent to calling [method@Gtk.Expression.evaluate] with the
+// This is equivalent to calling [Expression.Evaluate] with the
 // expression and this pointer originally used to create `watch`.
 func (x *ExpressionWatch) Evaluate(ValueVar *gobject.Value) bool {
 
@@ -72,7 +71,7 @@ var xExpressionWatchUnwatch func(uintptr)
 
 // Stops watching an expression.
 //
-// See [method@Gtk.Expression.watch] for how the watch
+// See [Expression.Watch] for how the watch
 // was established.
 func (x *ExpressionWatch) Unwatch() {
 
@@ -84,7 +83,7 @@ var xNewParamSpecExpression func(string, string, string, gobject.ParamFlags) uin
 
 // Creates a new `GParamSpec` instance for a property holding a `GtkExpression`.
 //
-// See `g_param_spec_internal()` for details on the property strings.
+// See `[gobject.ParamSpecInternal]` for details on the property strings.
 func NewParamSpecExpression(NameVar string, NickVar string, BlurbVar string, FlagsVar gobject.ParamFlags) *gobject.ParamSpec {
 	var cls *gobject.ParamSpec
 
@@ -175,8 +174,8 @@ var xNewCClosureExpression func(types.GType, uintptr, uint, uintptr, uintptr, ui
 
 // Creates a `GtkExpression` that calls `callback_func` when it is evaluated.
 //
-// This function is a variant of [ctor@Gtk.ClosureExpression.new] that
-// creates a `GClosure` by calling g_cclosure_new() with the given
+// This function is a variant of [NewClosureExpression] that
+// creates a `GClosure` by calling [gobject.CClosureNew] with the given
 // `callback_func`, `user_data` and `user_destroy`.
 func NewCClosureExpression(ValueTypeVar types.GType, MarshalVar *gobject.ClosureMarshal, NParamsVar uint, ParamsVar uintptr, CallbackFuncVar *gobject.Callback, UserDataVar uintptr, UserDestroyVar *gobject.ClosureNotify) *CClosureExpression {
 	var cls *CClosureExpression
@@ -188,11 +187,12 @@ func NewCClosureExpression(ValueTypeVar types.GType, MarshalVar *gobject.Closure
 			MarshalVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *gobject.Closure, arg1 *gobject.Value, arg2 uint, arg3 []gobject.Value, arg4 uintptr, arg5 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *MarshalVar
 				cbFn(arg0, arg1, arg2, arg3, arg4, arg5)
 			}
-			MarshalVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MarshalVarPtr, MarshalVarRef, MarshalVar)
+			MarshalVarRef = core.NewCallback(fcb)
+			MarshalVarRef = glib.SaveCallbackWithClosure(MarshalVarPtr, MarshalVarRef, MarshalVar)
 		}
 	}
 
@@ -203,11 +203,12 @@ func NewCClosureExpression(ValueTypeVar types.GType, MarshalVar *gobject.Closure
 			CallbackFuncVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackFuncVar
 				cbFn()
 			}
-			CallbackFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
+			CallbackFuncVarRef = core.NewCallback(fcb)
+			CallbackFuncVarRef = glib.SaveCallbackWithClosure(CallbackFuncVarPtr, CallbackFuncVarRef, CallbackFuncVar)
 		}
 	}
 
@@ -218,11 +219,12 @@ func NewCClosureExpression(ValueTypeVar types.GType, MarshalVar *gobject.Closure
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *gobject.Closure) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0, arg1)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -380,36 +382,28 @@ func (c *ConstantExpression) SetGoPointer(ptr uintptr) {
 // refers to. An evaluation always happens in the context of a current object
 // called `this` (it mirrors the behavior of object-oriented languages),
 // which may or may not influence the result of the evaluation. Use
-// [method@Gtk.Expression.evaluate] for evaluating an expression.
+// [Expression.Evaluate] for evaluating an expression.
 //
 // Various methods for defining expressions exist, from simple constants via
-// [ctor@Gtk.ConstantExpression.new] to looking up properties in a `GObject`
-// (even recursively) via [ctor@Gtk.PropertyExpression.new] or providing
+// [NewConstantExpression] to looking up properties in a `GObject`
+// (even recursively) via [NewPropertyExpression] or providing
 // custom functions to transform and combine expressions via
-// [ctor@Gtk.ClosureExpression.new].
+// [NewClosureExpression].
 //
 // Here is an example of a complex expression:
 //
-// ```c
-//
 //	color_expr = gtk_property_expression_new (GTK_TYPE_LIST_ITEM,
 //	                                          NULL, "item");
 //	expression = gtk_property_expression_new (GTK_TYPE_COLOR,
 //	                                          color_expr, "name");
 //
-// ```
-//
 // when evaluated with `this` being a `GtkListItem`, it will obtain the
 // "item" property from the `GtkListItem`, and then obtain the "name" property
 // from the resulting object (which is assumed to be of type `GTK_TYPE_COLOR`).
 //
-// # A more concise way to describe this would be
-//
-// ```
+// A more concise way to describe this would be
 //
-//	this-&gt;item-&gt;name
-//
-// ```
+//	this->item->name
 //
 // The most likely place where you will encounter expressions is in the context
 // of list models and list widgets using them. For example, `GtkDropDown` is
@@ -419,39 +413,33 @@ func (c *ConstantExpression) SetGoPointer(ptr uintptr) {
 //
 // By default, expressions are not paying attention to changes and evaluation is
 // just a snapshot of the current state at a given time. To get informed about
-// changes, an expression needs to be "watched" via a [struct@Gtk.ExpressionWatch],
+// changes, an expression needs to be "watched" via a [ExpressionWatch],
 // which will cause a callback to be called whenever the value of the expression may
-// have changed; [method@Gtk.Expression.watch] starts watching an expression, and
-// [method@Gtk.ExpressionWatch.unwatch] stops.
+// have changed; [Expression.Watch] starts watching an expression, and
+// [ExpressionWatch.Unwatch] stops.
 //
 // Watches can be created for automatically updating the property of an object,
-// similar to GObject's `GBinding` mechanism, by using [method@Gtk.Expression.bind].
+// similar to GObject's `GBinding` mechanism, by using [Expression.Bind].
 //
-// ## GtkExpression in GObject properties
+// # GtkExpression in GObject properties
 //
 // In order to use a `GtkExpression` as a `GObject` property, you must use the
-// [func@Gtk.param_spec_expression] when creating a `GParamSpec` to install in the
+// [NewParamSpecExpression] when creating a `GParamSpec` to install in the
 // `GObject` class being defined; for instance:
 //
-// ```c
-// obj_props[PROP_EXPRESSION] =
-//
-//	gtk_param_spec_expression ("expression",
-//	                           "Expression",
-//	                           "The expression used by the widget",
-//	                           G_PARAM_READWRITE |
-//	                           G_PARAM_STATIC_STRINGS |
-//	                           G_PARAM_EXPLICIT_NOTIFY);
-//
-// ```
+//	obj_props[PROP_EXPRESSION] =
+//	  gtk_param_spec_expression ("expression",
+//	                             "Expression",
+//	                             "The expression used by the widget",
+//	                             G_PARAM_READWRITE |
+//	                             G_PARAM_STATIC_STRINGS |
+//	                             G_PARAM_EXPLICIT_NOTIFY);
 //
 // When implementing the `GObjectClass.set_property` and `GObjectClass.get_property`
-// virtual functions, you must use [func@Gtk.value_get_expression], to retrieve the
-// stored `GtkExpression` from the `GValue` container, and [func@Gtk.value_set_expression],
+// virtual functions, you must use [ValueGetExpression], to retrieve the
+// stored `GtkExpression` from the `GValue` container, and [ValueSetExpression],
 // to store the `GtkExpression` into the `GValue`; for instance:
 //
-// ```c
-//
 //	// in set_property()...
 //	case PROP_EXPRESSION:
 //	  foo_widget_set_expression (foo, gtk_value_get_expression (value));
@@ -459,100 +447,74 @@ func (c *ConstantExpression) SetGoPointer(ptr uintptr) {
 //
 //	// in get_property()...
 //	case PROP_EXPRESSION:
-//	  gtk_value_set_expression (value, foo-&gt;expression);
+//	  gtk_value_set_expression (value, foo->expression);
 //	  break;
 //
-// ```
-//
-// ## GtkExpression in .ui files
+// # GtkExpression in .ui files
 //
 // `GtkBuilder` has support for creating expressions. The syntax here can be used where
-// a `GtkExpression` object is needed like in a `&lt;property&gt;` tag for an expression
-// property, or in a `&lt;binding name="property"&gt;` tag to bind a property to an expression.
+// a `GtkExpression` object is needed like in a `<property>` tag for an expression
+// property, or in a `<binding name="property">` tag to bind a property to an expression.
 //
-// To create a property expression, use the `&lt;lookup&gt;` element. It can have a `type`
+// To create a property expression, use the `<lookup>` element. It can have a `type`
 // attribute to specify the object type, and a `name` attribute to specify the property
-// to look up. The content of `&lt;lookup&gt;` can either be a string that specifies the name
+// to look up. The content of `<lookup>` can either be a string that specifies the name
 // of the object to use, an element specifying an expression to provide an object, or
 // empty to use the `this` object.
 //
 // Example:
 //
-// ```xml
-//
-//	&lt;lookup name='search'&gt;string_filter&lt;/lookup&gt;
-//
-// ```
+//	<lookup name='search'>string_filter</lookup>
 //
-// Since the `&lt;lookup&gt;` element creates an expression and its element content can
-// itself be an expression, this means that `&lt;lookup&gt;` tags can also be nested.
+// Since the `<lookup>` element creates an expression and its element content can
+// itself be an expression, this means that `<lookup>` tags can also be nested.
 // This is a common idiom when dealing with `GtkListItem`s. See
-// [class@Gtk.BuilderListItemFactory] for an example of this technique.
+// [BuilderListItemFactory] for an example of this technique.
 //
-// To create a constant expression, use the `&lt;constant&gt;` element. If the type attribute
+// To create a constant expression, use the `<constant>` element. If the type attribute
 // is specified, the element content is interpreted as a value of that type. Otherwise,
 // it is assumed to be an object. For instance:
 //
-// ```xml
-//
-//	&lt;constant&gt;string_filter&lt;/constant&gt;
-//	&lt;constant type='gchararray'&gt;Hello, world&lt;/constant&gt;
-//
-// ```
+//	<constant>string_filter</constant>
+//	<constant type='gchararray'>Hello, world</constant>
 //
 // String (`type='gchararray'`) constants can be marked for translation with the
 // `translatable=` attribute, and will then be looked up in the
-// [property@Gtk.Builder:translation-domain] when the expression is constructed.
-//
-// ```xml
+// [Builder.GetPropertyTranslationDomain] when the expression is constructed.
 //
-//	&lt;constant type='gchararray' translatable='yes'&gt;I'm translatable!&lt;/constant&gt;
+//	<constant type='gchararray' translatable='yes'>I'm translatable!</constant>
 //
-// ```
-//
-// As with other translatable strings in [type@Gtk.Builder], constants can
+// As with other translatable strings in [Builder], constants can
 // also have a context and/or translation comment:
 //
-// ```xml
-//
-//	&lt;constant type='gchararray'
+//	<constant type='gchararray'
 //	          translatable='yes'
 //	          context='example'
-//	          comments='A sample string'&gt;I'm translatable!&lt;/constant&gt;
+//	          comments='A sample string'>I'm translatable!</constant>
 //
-// ```
-//
-// To create a closure expression, use the `&lt;closure&gt;` element. The `function`
+// To create a closure expression, use the `<closure>` element. The `function`
 // attribute specifies what function to use for the closure, and the `type`
 // attribute specifies its return type. The content of the element contains the
 // expressions for the parameters. For instance:
 //
-// ```xml
-//
-//	&lt;closure type='gchararray' function='combine_args_somehow'&gt;
-//	  &lt;constant type='gchararray'&gt;File size:&lt;/constant&gt;
-//	  &lt;lookup type='GFile' name='size'&gt;myfile&lt;/lookup&gt;
-//	&lt;/closure&gt;
-//
-// ```
+//	<closure type='gchararray' function='combine_args_somehow'>
+//	  <constant type='gchararray'>File size:</constant>
+//	  <lookup type='GFile' name='size'>myfile</lookup>
+//	</closure>
 //
-// To create a property binding, use the `&lt;binding&gt;` element in place of where a
-// `&lt;property&gt;` tag would ordinarily be used. The `name` and `object` attributes are
+// To create a property binding, use the `<binding>` element in place of where a
+// `<property>` tag would ordinarily be used. The `name` and `object` attributes are
 // supported. The `name` attribute is required, and pertains to the applicable property
 // name. The `object` attribute is optional. If provided, it will use the specified object
 // as the `this` object when the expression is evaluated. Here is an example in which the
 // `label` property of a `GtkLabel` is bound to the `string` property of another arbitrary
 // object:
 //
-// ```xml
-//
-//	&lt;object class='GtkLabel'&gt;
-//	  &lt;binding name='label'&gt;
-//	    &lt;lookup name='string'&gt;some_other_object&lt;/lookup&gt;
-//	  &lt;/binding&gt;
-//	&lt;/object&gt;
-//
-// ```
+//	<object class='GtkLabel'>
+//	  <binding name='label'>
+//	    <lookup name='string'>some_other_object</lookup>
+//	  </binding>
+//	</object>
 type Expression struct {
 	Ptr uintptr
 }
@@ -573,7 +535,7 @@ var xExpressionBind func(uintptr, uintptr, string, uintptr) *ExpressionWatch
 
 // Bind `target`'s property named `property` to `self`.
 //
-// The value that `self` evaluates to is set via `g_object_set()` on
+// The value that `self` evaluates to is set via `[gobject.Object.Set]` on
 // `target`. This is repeated whenever `self` changes to ensure that
 // the object's property stays synchronized with `self`.
 //
@@ -582,7 +544,7 @@ var xExpressionBind func(uintptr, uintptr, string, uintptr) *ExpressionWatch
 // expression.
 //
 // Note that this function takes ownership of `self`. If you want
-// to keep it around, you should [method@Gtk.Expression.ref] it beforehand.
+// to keep it around, you should [Expression.Ref] it beforehand.
 func (x *Expression) Bind(TargetVar *gobject.Object, PropertyVar string, ThisVar *gobject.Object) *ExpressionWatch {
 
 	cret := xExpressionBind(x.GoPointer(), TargetVar.GoPointer(), PropertyVar, ThisVar.GoPointer())
@@ -592,10 +554,10 @@ func (x *Expression) Bind(TargetVar *gobject.Object, PropertyVar string, ThisVar
 var xExpressionEvaluate func(uintptr, uintptr, *gobject.Value) bool
 
 // Evaluates the given expression and on success stores the result
-// in @value.
+// in value.
 //
 // The `GType` of `value` will be the type given by
-// [method@Gtk.Expression.get_value_type].
+// [Expression.GetValueType].
 //
 // It is possible that expressions cannot be evaluated - for example
 // when the expression references objects that have been destroyed or
@@ -624,9 +586,9 @@ var xExpressionIsStatic func(uintptr) bool
 // Checks if the expression is static.
 //
 // A static expression will never change its result when
-// [method@Gtk.Expression.evaluate] is called on it with the same arguments.
+// [Expression.Evaluate] is called on it with the same arguments.
 //
-// That means a call to [method@Gtk.Expression.watch] is not necessary because
+// That means a call to [Expression.Watch] is not necessary because
 // it will never trigger a notify.
 func (x *Expression) IsStatic() bool {
 
@@ -666,12 +628,12 @@ var xExpressionWatch func(uintptr, uintptr, uintptr, uintptr, uintptr) *Expressi
 
 // Watch the given `expression` for changes.
 //
-// The @notify function will be called whenever the evaluation of `self`
+// The notify function will be called whenever the evaluation of `self`
 // may have changed.
 //
-// GTK cannot guarantee that the evaluation did indeed change when the @notify
+// GTK cannot guarantee that the evaluation did indeed change when the notify
 // gets invoked, but it guarantees the opposite: When it did in fact change,
-// the @notify will be invoked.
+// the notify will be invoked.
 func (x *Expression) Watch(ThisVar *gobject.Object, NotifyVar *ExpressionNotify, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) *ExpressionWatch {
 
 	var NotifyVarRef uintptr
@@ -681,11 +643,12 @@ func (x *Expression) Watch(ThisVar *gobject.Object, NotifyVar *ExpressionNotify,
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -696,11 +659,12 @@ func (x *Expression) Watch(ThisVar *gobject.Object, NotifyVar *ExpressionNotify,
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -744,7 +708,7 @@ var xNewObjectExpression func(uintptr) uintptr
 //
 // This expression is meant to break reference cycles.
 //
-// If you want to keep a reference to `object`, use [ctor@Gtk.ConstantExpression.new].
+// If you want to keep a reference to `object`, use [NewConstantExpression].
 func NewObjectExpression(ObjectVar *gobject.Object) *ObjectExpression {
 	var cls *ObjectExpression
 
@@ -931,65 +895,65 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xNewParamSpecExpression, libs, "gtk_param_spec_expression")
-	core.PuregoSafeRegister(&xValueDupExpression, libs, "gtk_value_dup_expression")
-	core.PuregoSafeRegister(&xValueGetExpression, libs, "gtk_value_get_expression")
-	core.PuregoSafeRegister(&xValueSetExpression, libs, "gtk_value_set_expression")
-	core.PuregoSafeRegister(&xValueTakeExpression, libs, "gtk_value_take_expression")
+	core.PuregoRegisterSince(&xNewParamSpecExpression, libs, "gtk_param_spec_expression", "GTK", "")
+	core.PuregoRegisterSince(&xValueDupExpression, libs, "gtk_value_dup_expression", "GTK", "")
+	core.PuregoRegisterSince(&xValueGetExpression, libs, "gtk_value_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xValueSetExpression, libs, "gtk_value_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xValueTakeExpression, libs, "gtk_value_take_expression", "GTK", "")
 
 	core.PuregoSafeRegister(&xExpressionWatchGLibType, libs, "gtk_expression_watch_get_type")
 
-	core.PuregoSafeRegister(&xExpressionWatchEvaluate, libs, "gtk_expression_watch_evaluate")
-	core.PuregoSafeRegister(&xExpressionWatchRef, libs, "gtk_expression_watch_ref")
-	core.PuregoSafeRegister(&xExpressionWatchUnref, libs, "gtk_expression_watch_unref")
-	core.PuregoSafeRegister(&xExpressionWatchUnwatch, libs, "gtk_expression_watch_unwatch")
+	core.PuregoRegisterSince(&xExpressionWatchEvaluate, libs, "gtk_expression_watch_evaluate", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionWatchRef, libs, "gtk_expression_watch_ref", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionWatchUnref, libs, "gtk_expression_watch_unref", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionWatchUnwatch, libs, "gtk_expression_watch_unwatch", "GTK", "")
 
 	core.PuregoSafeRegister(&xCClosureExpressionGLibType, libs, "gtk_cclosure_expression_get_type")
 
-	core.PuregoSafeRegister(&xNewCClosureExpression, libs, "gtk_cclosure_expression_new")
+	core.PuregoRegisterSince(&xNewCClosureExpression, libs, "gtk_cclosure_expression_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xClosureExpressionGLibType, libs, "gtk_closure_expression_get_type")
 
-	core.PuregoSafeRegister(&xNewClosureExpression, libs, "gtk_closure_expression_new")
+	core.PuregoRegisterSince(&xNewClosureExpression, libs, "gtk_closure_expression_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xConstantExpressionGLibType, libs, "gtk_constant_expression_get_type")
 
-	core.PuregoSafeRegister(&xNewConstantExpression, libs, "gtk_constant_expression_new")
-	core.PuregoSafeRegister(&xNewConstantExpressionForValue, libs, "gtk_constant_expression_new_for_value")
+	core.PuregoRegisterSince(&xNewConstantExpression, libs, "gtk_constant_expression_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewConstantExpressionForValue, libs, "gtk_constant_expression_new_for_value", "GTK", "")
 
-	core.PuregoSafeRegister(&xConstantExpressionGetValue, libs, "gtk_constant_expression_get_value")
+	core.PuregoRegisterSince(&xConstantExpressionGetValue, libs, "gtk_constant_expression_get_value", "GTK", "")
 
 	core.PuregoSafeRegister(&xExpressionGLibType, libs, "gtk_expression_get_type")
 
-	core.PuregoSafeRegister(&xExpressionBind, libs, "gtk_expression_bind")
-	core.PuregoSafeRegister(&xExpressionEvaluate, libs, "gtk_expression_evaluate")
-	core.PuregoSafeRegister(&xExpressionGetValueType, libs, "gtk_expression_get_value_type")
-	core.PuregoSafeRegister(&xExpressionIsStatic, libs, "gtk_expression_is_static")
-	core.PuregoSafeRegister(&xExpressionRef, libs, "gtk_expression_ref")
-	core.PuregoSafeRegister(&xExpressionUnref, libs, "gtk_expression_unref")
-	core.PuregoSafeRegister(&xExpressionWatch, libs, "gtk_expression_watch")
+	core.PuregoRegisterSince(&xExpressionBind, libs, "gtk_expression_bind", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionEvaluate, libs, "gtk_expression_evaluate", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionGetValueType, libs, "gtk_expression_get_value_type", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionIsStatic, libs, "gtk_expression_is_static", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionRef, libs, "gtk_expression_ref", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionUnref, libs, "gtk_expression_unref", "GTK", "")
+	core.PuregoRegisterSince(&xExpressionWatch, libs, "gtk_expression_watch", "GTK", "")
 
 	core.PuregoSafeRegister(&xObjectExpressionGLibType, libs, "gtk_object_expression_get_type")
 
-	core.PuregoSafeRegister(&xNewObjectExpression, libs, "gtk_object_expression_new")
+	core.PuregoRegisterSince(&xNewObjectExpression, libs, "gtk_object_expression_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xObjectExpressionGetObject, libs, "gtk_object_expression_get_object")
+	core.PuregoRegisterSince(&xObjectExpressionGetObject, libs, "gtk_object_expression_get_object", "GTK", "")
 
 	core.PuregoSafeRegister(&xParamSpecExpressionGLibType, libs, "gtk_param_expression_get_type")
 
 	core.PuregoSafeRegister(&xPropertyExpressionGLibType, libs, "gtk_property_expression_get_type")
 
-	core.PuregoSafeRegister(&xNewPropertyExpression, libs, "gtk_property_expression_new")
-	core.PuregoSafeRegister(&xNewPropertyExpressionForPspec, libs, "gtk_property_expression_new_for_pspec")
+	core.PuregoRegisterSince(&xNewPropertyExpression, libs, "gtk_property_expression_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewPropertyExpressionForPspec, libs, "gtk_property_expression_new_for_pspec", "GTK", "")
 
-	core.PuregoSafeRegister(&xPropertyExpressionGetExpression, libs, "gtk_property_expression_get_expression")
-	core.PuregoSafeRegister(&xPropertyExpressionGetPspec, libs, "gtk_property_expression_get_pspec")
+	core.PuregoRegisterSince(&xPropertyExpressionGetExpression, libs, "gtk_property_expression_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xPropertyExpressionGetPspec, libs, "gtk_property_expression_get_pspec", "GTK", "")
 
 }
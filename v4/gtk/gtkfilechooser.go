@@ -2,7 +2,8 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,7 +15,7 @@ import (
 // selection widgets.
 //
 // In GTK, the main objects that implement this interface are
-// [class@Gtk.FileChooserWidget] and [class@Gtk.FileChooserDialog].
+// [FileChooserWidget] and [FileChooserDialog].
 //
 // You do not need to write an object that implements the `GtkFileChooser`
 // interface unless you are trying to adapt an existing file selector to
@@ -45,11 +46,13 @@ import (
 //
 // You can add extra widgets to a file chooser to provide options
 // that are not present in the default design, by using
-// [method@Gtk.FileChooser.add_choice]. Each choice has an identifier and
+// [FileChooser.AddChoice]. Each choice has an identifier and
 // a user visible label; additionally, each choice can have multiple
 // options. If a choice has no option, it will be rendered as a
 // check button with the given label; if a choice has options, it will
 // be rendered as a combo box.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 type FileChooser interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -105,23 +108,27 @@ func (x *FileChooserBase) SetGoPointer(ptr uintptr) {
 //
 // This is typically implemented as a combobox or, for boolean choices,
 // as a checkbutton. You can select a value using
-// [method@Gtk.FileChooser.set_choice] before the dialog is shown,
+// [FileChooser.SetChoice] before the dialog is shown,
 // and you can obtain the user-selected value in the
-// [signal@Gtk.Dialog::response] signal handler using
-// [method@Gtk.FileChooser.get_choice].
+// [Dialog.ConnectResponseFunc] signal handler using
+// [FileChooser.GetChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) AddChoice(IdVar string, LabelVar string, OptionsVar []string, OptionLabelsVar []string) {
 
 	XGtkFileChooserAddChoice(x.GoPointer(), IdVar, LabelVar, OptionsVar, OptionLabelsVar)
 
 }
 
-// Adds @filter to the list of filters that the user can select between.
+// Adds filter to the list of filters that the user can select between.
 //
 // When a filter is selected, only files that are passed by that
 // filter are displayed.
 //
-// Note that the @chooser takes ownership of the filter if it is floating,
+// Note that the chooser takes ownership of the filter if it is floating,
 // so you have to ref and sink it if you want to keep a reference.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) AddFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserAddFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -130,18 +137,22 @@ func (x *FileChooserBase) AddFilter(FilterVar *FileFilter) {
 
 // Adds a folder to be displayed with the shortcut folders
 // in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) AddShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserAddShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets the type of operation that the file chooser is performing.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetAction() FileChooserAction {
 
 	cret := XGtkFileChooserGetAction(x.GoPointer())
@@ -149,6 +160,8 @@ func (x *FileChooserBase) GetAction() FileChooserAction {
 }
 
 // Gets the currently selected option in the 'choice' with the given ID.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetChoice(IdVar string) string {
 
 	cret := XGtkFileChooserGetChoice(x.GoPointer(), IdVar)
@@ -156,13 +169,17 @@ func (x *FileChooserBase) GetChoice(IdVar string) string {
 }
 
 // Gets whether file chooser will offer to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetCreateFolders() bool {
 
 	cret := XGtkFileChooserGetCreateFolders(x.GoPointer())
 	return cret
 }
 
-// Gets the current folder of @chooser as `GFile`.
+// Gets the current folder of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetCurrentFolder() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -180,6 +197,8 @@ func (x *FileChooserBase) GetCurrentFolder() *gio.FileBase {
 //
 // This is meant to be used in save dialogs, to get the currently typed
 // filename when the file itself does not exist yet.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetCurrentName() string {
 
 	cret := XGtkFileChooserGetCurrentName(x.GoPointer())
@@ -194,6 +213,8 @@ func (x *FileChooserBase) GetCurrentName() string {
 //
 // If the file chooser is in folder mode, this function returns
 // the selected folder.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -208,7 +229,9 @@ func (x *FileChooserBase) GetFile() *gio.FileBase {
 }
 
 // Lists all the selected files and subfolders in the current folder
-// of @chooser as `GFile`.
+// of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetFiles() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -223,6 +246,8 @@ func (x *FileChooserBase) GetFiles() *gio.ListModelBase {
 }
 
 // Gets the current filter.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetFilter() *FileFilter {
 	var cls *FileFilter
 
@@ -239,11 +264,13 @@ func (x *FileChooserBase) GetFilter() *FileFilter {
 
 // Gets the current set of user-selectable filters, as a list model.
 //
-// See [method@Gtk.FileChooser.add_filter] and
-// [method@Gtk.FileChooser.remove_filter] for changing individual filters.
+// See [FileChooser.AddFilter] and
+// [FileChooser.RemoveFilter] for changing individual filters.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetFilters() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -259,6 +286,8 @@ func (x *FileChooserBase) GetFilters() *gio.ListModelBase {
 
 // Gets whether multiple files can be selected in the file
 // chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetSelectMultiple() bool {
 
 	cret := XGtkFileChooserGetSelectMultiple(x.GoPointer())
@@ -268,7 +297,9 @@ func (x *FileChooserBase) GetSelectMultiple() bool {
 // Queries the list of shortcut folders in the file chooser.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetShortcutFolders() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -282,14 +313,18 @@ func (x *FileChooserBase) GetShortcutFolders() *gio.ListModelBase {
 	return cls
 }
 
-// Removes a 'choice' that has been added with gtk_file_chooser_add_choice().
+// Removes a 'choice' that has been added with [FileChooser.AddChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) RemoveChoice(IdVar string) {
 
 	XGtkFileChooserRemoveChoice(x.GoPointer(), IdVar)
 
 }
 
-// Removes @filter from the list of filters that the user can select between.
+// Removes filter from the list of filters that the user can select between.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) RemoveFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserRemoveFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -297,12 +332,14 @@ func (x *FileChooserBase) RemoveFilter(FilterVar *FileFilter) {
 }
 
 // Removes a folder from the shortcut folders in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) RemoveShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserRemoveShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -313,8 +350,10 @@ func (x *FileChooserBase) RemoveShortcutFolder(FolderVar gio.File) (bool, error)
 // The user interface is adapted to suit the selected action.
 //
 // For example, an option to create a new folder might be shown
-// if the action is %GTK_FILE_CHOOSER_ACTION_SAVE but not if the
-// action is %GTK_FILE_CHOOSER_ACTION_OPEN.
+// if the action is [FileChooserActionSaveValue] but not if the
+// action is [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetAction(ActionVar FileChooserAction) {
 
 	XGtkFileChooserSetAction(x.GoPointer(), ActionVar)
@@ -322,9 +361,11 @@ func (x *FileChooserBase) SetAction(ActionVar FileChooserAction) {
 }
 
 // Selects an option in a 'choice' that has been added with
-// gtk_file_chooser_add_choice().
+// [FileChooser.AddChoice].
 //
 // For a boolean choice, the possible options are "true" and "false".
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetChoice(IdVar string, OptionVar string) {
 
 	XGtkFileChooserSetChoice(x.GoPointer(), IdVar, OptionVar)
@@ -334,20 +375,24 @@ func (x *FileChooserBase) SetChoice(IdVar string, OptionVar string) {
 // Sets whether file chooser will offer to create new folders.
 //
 // This is only relevant if the action is not set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN.
+// [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetCreateFolders(CreateFoldersVar bool) {
 
 	XGtkFileChooserSetCreateFolders(x.GoPointer(), CreateFoldersVar)
 
 }
 
-// Sets the current folder for @chooser from a `GFile`.
+// Sets the current folder for chooser from a `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetCurrentFolder(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetCurrentFolder(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -359,27 +404,29 @@ func (x *FileChooserBase) SetCurrentFolder(FileVar gio.File) (bool, error) {
 // Note that the name passed in here is a UTF-8 string rather
 // than a filename. This function is meant for such uses as a
 // suggested name in a “Save As...” dialog.  You can pass
-// “Untitled.doc” or a similarly suitable suggestion for the @name.
+// “Untitled.doc” or a similarly suitable suggestion for the name.
 //
 // If you want to preselect a particular existing file, you should
-// use [method@Gtk.FileChooser.set_file] instead.
+// use [FileChooser.SetFile] instead.
 //
 // Please see the documentation for those functions for an example
-// of using [method@Gtk.FileChooser.set_current_name] as well.
+// of using [FileChooser.SetCurrentName] as well.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetCurrentName(NameVar string) {
 
 	XGtkFileChooserSetCurrentName(x.GoPointer(), NameVar)
 
 }
 
-// Sets @file as the current filename for the file chooser.
+// Sets file as the current filename for the file chooser.
 //
 // This includes changing to the file’s parent folder and actually selecting
-// the file in list. If the @chooser is in %GTK_FILE_CHOOSER_ACTION_SAVE mode,
+// the file in list. If the chooser is in [FileChooserActionSaveValue] mode,
 // the file’s base name will also appear in the dialog’s file name entry.
 //
-// If the file name isn’t in the current folder of @chooser, then the current
-// folder of @chooser will be changed to the folder containing @file.
+// If the file name isn’t in the current folder of chooser, then the current
+// folder of chooser will be changed to the folder containing file.
 //
 // Note that the file must exist, or nothing will be done except
 // for the directory change.
@@ -392,12 +439,9 @@ func (x *FileChooserBase) SetCurrentName(NameVar string) {
 //
 // Instead, use something similar to this:
 //
-// ```c
-// static void
-// prepare_file_chooser (GtkFileChooser *chooser,
-//
-//	GFile          *existing_file)
-//
+//	static void
+//	prepare_file_chooser (GtkFileChooser *chooser,
+//	                      GFile          *existing_file)
 //	{
 //	  gboolean document_is_new = (existing_file == NULL);
 //
@@ -416,13 +460,13 @@ func (x *FileChooserBase) SetCurrentName(NameVar string) {
 //	    }
 //	}
 //
-// ```
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetFile(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetFile(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -437,6 +481,8 @@ func (x *FileChooserBase) SetFile(FileVar gio.File) (bool, error) {
 // Setting the current filter when the list of filters is
 // empty is useful if you want to restrict the displayed
 // set of files without letting the user change it.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserSetFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -446,8 +492,10 @@ func (x *FileChooserBase) SetFilter(FilterVar *FileFilter) {
 // Sets whether multiple files can be selected in the file chooser.
 //
 // This is only relevant if the action is set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN or
-// %GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER.
+// [FileChooserActionOpenValue] or
+// [FileChooserActionSelectFolderValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetSelectMultiple(SelectMultipleVar bool) {
 
 	XGtkFileChooserSetSelectMultiple(x.GoPointer(), SelectMultipleVar)
@@ -455,8 +503,10 @@ func (x *FileChooserBase) SetSelectMultiple(SelectMultipleVar bool) {
 }
 
 // SetPropertyCreateFolders sets the "create-folders" property.
-// Whether a file chooser not in %GTK_FILE_CHOOSER_ACTION_OPEN mode
+// Whether a file chooser not in [FileChooserActionOpenValue] mode
 // will offer the user to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetPropertyCreateFolders(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -467,8 +517,10 @@ func (x *FileChooserBase) SetPropertyCreateFolders(value bool) {
 }
 
 // GetPropertyCreateFolders gets the "create-folders" property.
-// Whether a file chooser not in %GTK_FILE_CHOOSER_ACTION_OPEN mode
+// Whether a file chooser not in [FileChooserActionOpenValue] mode
 // will offer the user to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetPropertyCreateFolders() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -479,6 +531,8 @@ func (x *FileChooserBase) GetPropertyCreateFolders() bool {
 
 // SetPropertySelectMultiple sets the "select-multiple" property.
 // Whether to allow multiple files to be selected.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) SetPropertySelectMultiple(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -490,6 +544,8 @@ func (x *FileChooserBase) SetPropertySelectMultiple(value bool) {
 
 // GetPropertySelectMultiple gets the "select-multiple" property.
 // Whether to allow multiple files to be selected.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserBase) GetPropertySelectMultiple() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -549,8 +605,49 @@ const (
 	FileChooserActionSelectFolderValue FileChooserAction = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FileChooserAction) String() string {
+	switch x {
+	case FileChooserActionOpenValue:
+		return "GTK_FILE_CHOOSER_ACTION_OPEN"
+	case FileChooserActionSaveValue:
+		return "GTK_FILE_CHOOSER_ACTION_SAVE"
+	case FileChooserActionSelectFolderValue:
+		return "GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER"
+	}
+	return fmt.Sprintf("FileChooserAction(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileChooserAction) Nick() string {
+	switch x {
+	case FileChooserActionOpenValue:
+		return "open"
+	case FileChooserActionSaveValue:
+		return "save"
+	case FileChooserActionSelectFolderValue:
+		return "select-folder"
+	}
+	return ""
+}
+
+// FileChooserActionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileChooserActionFromString(s string) (FileChooserAction, bool) {
+	switch s {
+	case "GTK_FILE_CHOOSER_ACTION_OPEN", "open":
+		return FileChooserActionOpenValue, true
+	case "GTK_FILE_CHOOSER_ACTION_SAVE", "save":
+		return FileChooserActionSaveValue, true
+	case "GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER", "select-folder":
+		return FileChooserActionSelectFolderValue, true
+	}
+	return 0, false
+}
+
 // These identify the various errors that can occur while calling
 // `GtkFileChooser` functions.
+//
+// Deprecated: since 4.20. There is no replacement
 type FileChooserError int
 
 var xFileChooserErrorGLibType func() types.GType
@@ -573,6 +670,71 @@ const (
 	FileChooserErrorIncompleteHostnameValue FileChooserError = 3
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (FileChooserError) ErrorDomain() string {
+	return "gtk-file-chooser-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x FileChooserError) Error() string {
+	switch x {
+	case FileChooserErrorNonexistentValue:
+		return "GTK_FILE_CHOOSER_ERROR_NONEXISTENT"
+	case FileChooserErrorBadFilenameValue:
+		return "GTK_FILE_CHOOSER_ERROR_BAD_FILENAME"
+	case FileChooserErrorAlreadyExistsValue:
+		return "GTK_FILE_CHOOSER_ERROR_ALREADY_EXISTS"
+	case FileChooserErrorIncompleteHostnameValue:
+		return "GTK_FILE_CHOOSER_ERROR_INCOMPLETE_HOSTNAME"
+	}
+	return fmt.Sprintf("gtk-file-chooser-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x FileChooserError) String() string {
+	switch x {
+	case FileChooserErrorNonexistentValue:
+		return "GTK_FILE_CHOOSER_ERROR_NONEXISTENT"
+	case FileChooserErrorBadFilenameValue:
+		return "GTK_FILE_CHOOSER_ERROR_BAD_FILENAME"
+	case FileChooserErrorAlreadyExistsValue:
+		return "GTK_FILE_CHOOSER_ERROR_ALREADY_EXISTS"
+	case FileChooserErrorIncompleteHostnameValue:
+		return "GTK_FILE_CHOOSER_ERROR_INCOMPLETE_HOSTNAME"
+	}
+	return fmt.Sprintf("FileChooserError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FileChooserError) Nick() string {
+	switch x {
+	case FileChooserErrorNonexistentValue:
+		return "nonexistent"
+	case FileChooserErrorBadFilenameValue:
+		return "bad-filename"
+	case FileChooserErrorAlreadyExistsValue:
+		return "already-exists"
+	case FileChooserErrorIncompleteHostnameValue:
+		return "incomplete-hostname"
+	}
+	return ""
+}
+
+// FileChooserErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FileChooserErrorFromString(s string) (FileChooserError, bool) {
+	switch s {
+	case "GTK_FILE_CHOOSER_ERROR_NONEXISTENT", "nonexistent":
+		return FileChooserErrorNonexistentValue, true
+	case "GTK_FILE_CHOOSER_ERROR_BAD_FILENAME", "bad-filename":
+		return FileChooserErrorBadFilenameValue, true
+	case "GTK_FILE_CHOOSER_ERROR_ALREADY_EXISTS", "already-exists":
+		return FileChooserErrorAlreadyExistsValue, true
+	case "GTK_FILE_CHOOSER_ERROR_INCOMPLETE_HOSTNAME", "incomplete-hostname":
+		return FileChooserErrorIncompleteHostnameValue, true
+	}
+	return 0, false
+}
+
 var xFileChooserErrorQuark func() glib.Quark
 
 // Registers an error quark for `GtkFileChooser` errors.
@@ -587,7 +749,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -598,34 +760,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileChooserErrorGLibType, libs, "gtk_file_chooser_error_get_type")
 
-	core.PuregoSafeRegister(&xFileChooserErrorQuark, libs, "gtk_file_chooser_error_quark")
+	core.PuregoRegisterSince(&xFileChooserErrorQuark, libs, "gtk_file_chooser_error_quark", "GTK", "")
 
 	core.PuregoSafeRegister(&xFileChooserGLibType, libs, "gtk_file_chooser_get_type")
 
-	core.PuregoSafeRegister(&XGtkFileChooserAddChoice, libs, "gtk_file_chooser_add_choice")
-	core.PuregoSafeRegister(&XGtkFileChooserAddFilter, libs, "gtk_file_chooser_add_filter")
-	core.PuregoSafeRegister(&XGtkFileChooserAddShortcutFolder, libs, "gtk_file_chooser_add_shortcut_folder")
-	core.PuregoSafeRegister(&XGtkFileChooserGetAction, libs, "gtk_file_chooser_get_action")
-	core.PuregoSafeRegister(&XGtkFileChooserGetChoice, libs, "gtk_file_chooser_get_choice")
-	core.PuregoSafeRegister(&XGtkFileChooserGetCreateFolders, libs, "gtk_file_chooser_get_create_folders")
-	core.PuregoSafeRegister(&XGtkFileChooserGetCurrentFolder, libs, "gtk_file_chooser_get_current_folder")
-	core.PuregoSafeRegister(&XGtkFileChooserGetCurrentName, libs, "gtk_file_chooser_get_current_name")
-	core.PuregoSafeRegister(&XGtkFileChooserGetFile, libs, "gtk_file_chooser_get_file")
-	core.PuregoSafeRegister(&XGtkFileChooserGetFiles, libs, "gtk_file_chooser_get_files")
-	core.PuregoSafeRegister(&XGtkFileChooserGetFilter, libs, "gtk_file_chooser_get_filter")
-	core.PuregoSafeRegister(&XGtkFileChooserGetFilters, libs, "gtk_file_chooser_get_filters")
-	core.PuregoSafeRegister(&XGtkFileChooserGetSelectMultiple, libs, "gtk_file_chooser_get_select_multiple")
-	core.PuregoSafeRegister(&XGtkFileChooserGetShortcutFolders, libs, "gtk_file_chooser_get_shortcut_folders")
-	core.PuregoSafeRegister(&XGtkFileChooserRemoveChoice, libs, "gtk_file_chooser_remove_choice")
-	core.PuregoSafeRegister(&XGtkFileChooserRemoveFilter, libs, "gtk_file_chooser_remove_filter")
-	core.PuregoSafeRegister(&XGtkFileChooserRemoveShortcutFolder, libs, "gtk_file_chooser_remove_shortcut_folder")
-	core.PuregoSafeRegister(&XGtkFileChooserSetAction, libs, "gtk_file_chooser_set_action")
-	core.PuregoSafeRegister(&XGtkFileChooserSetChoice, libs, "gtk_file_chooser_set_choice")
-	core.PuregoSafeRegister(&XGtkFileChooserSetCreateFolders, libs, "gtk_file_chooser_set_create_folders")
-	core.PuregoSafeRegister(&XGtkFileChooserSetCurrentFolder, libs, "gtk_file_chooser_set_current_folder")
-	core.PuregoSafeRegister(&XGtkFileChooserSetCurrentName, libs, "gtk_file_chooser_set_current_name")
-	core.PuregoSafeRegister(&XGtkFileChooserSetFile, libs, "gtk_file_chooser_set_file")
-	core.PuregoSafeRegister(&XGtkFileChooserSetFilter, libs, "gtk_file_chooser_set_filter")
-	core.PuregoSafeRegister(&XGtkFileChooserSetSelectMultiple, libs, "gtk_file_chooser_set_select_multiple")
+	core.PuregoRegisterSince(&XGtkFileChooserAddChoice, libs, "gtk_file_chooser_add_choice", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserAddFilter, libs, "gtk_file_chooser_add_filter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserAddShortcutFolder, libs, "gtk_file_chooser_add_shortcut_folder", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetAction, libs, "gtk_file_chooser_get_action", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetChoice, libs, "gtk_file_chooser_get_choice", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetCreateFolders, libs, "gtk_file_chooser_get_create_folders", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetCurrentFolder, libs, "gtk_file_chooser_get_current_folder", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetCurrentName, libs, "gtk_file_chooser_get_current_name", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetFile, libs, "gtk_file_chooser_get_file", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetFiles, libs, "gtk_file_chooser_get_files", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetFilter, libs, "gtk_file_chooser_get_filter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetFilters, libs, "gtk_file_chooser_get_filters", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetSelectMultiple, libs, "gtk_file_chooser_get_select_multiple", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserGetShortcutFolders, libs, "gtk_file_chooser_get_shortcut_folders", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserRemoveChoice, libs, "gtk_file_chooser_remove_choice", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserRemoveFilter, libs, "gtk_file_chooser_remove_filter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserRemoveShortcutFolder, libs, "gtk_file_chooser_remove_shortcut_folder", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetAction, libs, "gtk_file_chooser_set_action", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetChoice, libs, "gtk_file_chooser_set_choice", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetCreateFolders, libs, "gtk_file_chooser_set_create_folders", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetCurrentFolder, libs, "gtk_file_chooser_set_current_folder", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetCurrentName, libs, "gtk_file_chooser_set_current_name", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetFile, libs, "gtk_file_chooser_set_file", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetFilter, libs, "gtk_file_chooser_set_filter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFileChooserSetSelectMultiple, libs, "gtk_file_chooser_set_select_multiple", "GTK", "")
 
 }
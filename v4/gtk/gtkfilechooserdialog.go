@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -15,33 +14,28 @@ import (
 // `GtkFileChooserDialog` is a dialog suitable for use with
 // “File Open” or “File Save” commands.
 //
-// ![An example GtkFileChooserDialog](filechooser.png)
-//
-// This widget works by putting a [class@Gtk.FileChooserWidget]
-// inside a [class@Gtk.Dialog]. It exposes the [iface@Gtk.FileChooser]
-// interface, so you can use all of the [iface@Gtk.FileChooser] functions
-// on the file chooser dialog as well as those for [class@Gtk.Dialog].
+// This widget works by putting a [FileChooserWidget]
+// inside a [Dialog]. It exposes the [FileChooser]
+// interface, so you can use all of the [FileChooser] functions
+// on the file chooser dialog as well as those for [Dialog].
 //
 // Note that `GtkFileChooserDialog` does not have any methods of its
 // own. Instead, you should use the functions that work on a
-// [iface@Gtk.FileChooser].
+// [FileChooser].
 //
 // If you want to integrate well with the platform you should use the
-// [class@Gtk.FileChooserNative] API, which will use a platform-specific
+// [FileChooserNative] API, which will use a platform-specific
 // dialog if available and fall back to `GtkFileChooserDialog`
 // otherwise.
 //
-// ## Typical usage
+// # Typical usage
 //
 // In the simplest of cases, you can the following code to use
 // `GtkFileChooserDialog` to select a file for opening:
 //
-// ```c
-// static void
-// on_open_response (GtkDialog *dialog,
-//
-//	int        response)
-//
+//	static void
+//	on_open_response (GtkDialog *dialog,
+//	                  int        response)
 //	{
 //	  if (response == GTK_RESPONSE_ACCEPT)
 //	    {
@@ -55,35 +49,30 @@ import (
 //	  gtk_window_destroy (GTK_WINDOW (dialog));
 //	}
 //
-//	// ...
-//	GtkWidget *dialog;
-//	GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
-//
-//	dialog = gtk_file_chooser_dialog_new ("Open File",
-//	                                      parent_window,
-//	                                      action,
-//	                                      _("_Cancel"),
-//	                                      GTK_RESPONSE_CANCEL,
-//	                                      _("_Open"),
-//	                                      GTK_RESPONSE_ACCEPT,
-//	                                      NULL);
+//	  // ...
+//	  GtkWidget *dialog;
+//	  GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
 //
-//	gtk_window_present (GTK_WINDOW (dialog));
+//	  dialog = gtk_file_chooser_dialog_new ("Open File",
+//	                                        parent_window,
+//	                                        action,
+//	                                        _("_Cancel"),
+//	                                        GTK_RESPONSE_CANCEL,
+//	                                        _("_Open"),
+//	                                        GTK_RESPONSE_ACCEPT,
+//	                                        NULL);
 //
-//	g_signal_connect (dialog, "response",
-//	                  G_CALLBACK (on_open_response),
-//	                  NULL);
+//	  gtk_window_present (GTK_WINDOW (dialog));
 //
-// ```
+//	  g_signal_connect (dialog, "response",
+//	                    G_CALLBACK (on_open_response),
+//	                    NULL);
 //
 // To use a dialog for saving, you can use this:
 //
-// ```c
-// static void
-// on_save_response (GtkDialog *dialog,
-//
-//	int        response)
-//
+//	static void
+//	on_save_response (GtkDialog *dialog,
+//	                  int        response)
 //	{
 //	  if (response == GTK_RESPONSE_ACCEPT)
 //	    {
@@ -97,88 +86,82 @@ import (
 //	  gtk_window_destroy (GTK_WINDOW (dialog));
 //	}
 //
-//	// ...
-//	GtkWidget *dialog;
-//	GtkFileChooser *chooser;
-//	GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_SAVE;
-//
-//	dialog = gtk_file_chooser_dialog_new ("Save File",
-//	                                      parent_window,
-//	                                      action,
-//	                                      _("_Cancel"),
-//	                                      GTK_RESPONSE_CANCEL,
-//	                                      _("_Save"),
-//	                                      GTK_RESPONSE_ACCEPT,
-//	                                      NULL);
-//	chooser = GTK_FILE_CHOOSER (dialog);
-//
-//	if (user_edited_a_new_document)
-//	  gtk_file_chooser_set_current_name (chooser, _("Untitled document"));
-//	else
-//	  gtk_file_chooser_set_file (chooser, existing_filename);
-//
-//	gtk_window_present (GTK_WINDOW (dialog));
+//	  // ...
+//	  GtkWidget *dialog;
+//	  GtkFileChooser *chooser;
+//	  GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_SAVE;
+//
+//	  dialog = gtk_file_chooser_dialog_new ("Save File",
+//	                                        parent_window,
+//	                                        action,
+//	                                        _("_Cancel"),
+//	                                        GTK_RESPONSE_CANCEL,
+//	                                        _("_Save"),
+//	                                        GTK_RESPONSE_ACCEPT,
+//	                                        NULL);
+//	  chooser = GTK_FILE_CHOOSER (dialog);
+//
+//	  if (user_edited_a_new_document)
+//	    gtk_file_chooser_set_current_name (chooser, _("Untitled document"));
+//	  else
+//	    gtk_file_chooser_set_file (chooser, existing_filename);
 //
-//	g_signal_connect (dialog, "response",
-//	                  G_CALLBACK (on_save_response),
-//	                  NULL);
+//	  gtk_window_present (GTK_WINDOW (dialog));
 //
-// ```
+//	  g_signal_connect (dialog, "response",
+//	                    G_CALLBACK (on_save_response),
+//	                    NULL);
 //
-// ## Setting up a file chooser dialog
+// # Setting up a file chooser dialog
 //
 // There are various cases in which you may need to use a `GtkFileChooserDialog`:
 //
-// - To select a file for opening, use %GTK_FILE_CHOOSER_ACTION_OPEN.
+// - To select a file for opening, use [FileChooserActionOpenValue].
 //
-//   - To save a file for the first time, use %GTK_FILE_CHOOSER_ACTION_SAVE,
+//   - To save a file for the first time, use [FileChooserActionSaveValue],
 //     and suggest a name such as “Untitled” with
-//     [method@Gtk.FileChooser.set_current_name].
+//     [FileChooser.SetCurrentName].
 //
-//   - To save a file under a different name, use %GTK_FILE_CHOOSER_ACTION_SAVE,
-//     and set the existing file with [method@Gtk.FileChooser.set_file].
+//   - To save a file under a different name, use [FileChooserActionSaveValue],
+//     and set the existing file with [FileChooser.SetFile].
 //
-// - To choose a folder instead of a file, use %GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER.
+// - To choose a folder instead of a file, use [FileChooserActionSelectFolderValue].
 //
 // In general, you should only cause the file chooser to show a specific
-// folder when it is appropriate to use [method@Gtk.FileChooser.set_file],
+// folder when it is appropriate to use [FileChooser.SetFile],
 // i.e. when you are doing a “Save As” command and you already have a file
 // saved somewhere.
 //
-// ## Response Codes
-//
-// `GtkFileChooserDialog` inherits from [class@Gtk.Dialog], so buttons that
-// go in its action area have response codes such as %GTK_RESPONSE_ACCEPT and
-// %GTK_RESPONSE_CANCEL. For example, you could call
-// [ctor@Gtk.FileChooserDialog.new] as follows:
+// # Response Codes
 //
-// ```c
-// GtkWidget *dialog;
-// GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
+// `GtkFileChooserDialog` inherits from [Dialog], so buttons that
+// go in its action area have response codes such as [ResponseAcceptValue] and
+// [ResponseCancelValue]. For example, you could call
+// [NewFileChooserDialog] as follows:
 //
-// dialog = gtk_file_chooser_dialog_new ("Open File",
-//
-//	parent_window,
-//	action,
-//	_("_Cancel"),
-//	GTK_RESPONSE_CANCEL,
-//	_("_Open"),
-//	GTK_RESPONSE_ACCEPT,
-//	NULL);
+//	GtkWidget *dialog;
+//	GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
 //
-// ```
+//	dialog = gtk_file_chooser_dialog_new ("Open File",
+//	                                      parent_window,
+//	                                      action,
+//	                                      _("_Cancel"),
+//	                                      GTK_RESPONSE_CANCEL,
+//	                                      _("_Open"),
+//	                                      GTK_RESPONSE_ACCEPT,
+//	                                      NULL);
 //
 // This will create buttons for “Cancel” and “Open” that use predefined
-// response identifiers from [enum@Gtk.ResponseType].  For most dialog
+// response identifiers from [ResponseType].  For most dialog
 // boxes you can use your own custom response codes rather than the
-// ones in [enum@Gtk.ResponseType], but `GtkFileChooserDialog` assumes that
+// ones in [ResponseType], but `GtkFileChooserDialog` assumes that
 // its “accept”-type action, e.g. an “Open” or “Save” button,
 // will have one of the following response codes:
 //
-// - %GTK_RESPONSE_ACCEPT
-// - %GTK_RESPONSE_OK
-// - %GTK_RESPONSE_YES
-// - %GTK_RESPONSE_APPLY
+// - [ResponseAcceptValue]
+// - [ResponseOkValue]
+// - [ResponseYesValue]
+// - [ResponseApplyValue]
 //
 // This is because `GtkFileChooserDialog` must intercept responses and switch
 // to folders if appropriate, rather than letting the dialog terminate — the
@@ -188,10 +171,12 @@ import (
 // To summarize, make sure you use a predefined response code
 // when you use `GtkFileChooserDialog` to ensure proper operation.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkFileChooserDialog` has a single CSS node with the name `window` and style
 // class `.filechooser`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 type FileChooserDialog struct {
 	Dialog
 }
@@ -208,31 +193,6 @@ func FileChooserDialogNewFromInternalPtr(ptr uintptr) *FileChooserDialog {
 	return cls
 }
 
-var xNewFileChooserDialog func(uintptr, uintptr, FileChooserAction, uintptr, ...interface{}) uintptr
-
-// Creates a new `GtkFileChooserDialog`.
-//
-// This function is analogous to [ctor@Gtk.Dialog.new_with_buttons].
-func NewFileChooserDialog(TitleVar *string, ParentVar *Window, ActionVar FileChooserAction, FirstButtonTextVar *string, varArgs ...interface{}) *FileChooserDialog {
-	var cls *FileChooserDialog
-
-	TitleVarPtr := core.GStrdupNullable(TitleVar)
-	defer core.GFreeNullable(TitleVarPtr)
-
-	FirstButtonTextVarPtr := core.GStrdupNullable(FirstButtonTextVar)
-	defer core.GFreeNullable(FirstButtonTextVarPtr)
-
-	cret := xNewFileChooserDialog(TitleVarPtr, ParentVar.GoPointer(), ActionVar, FirstButtonTextVarPtr, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FileChooserDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *FileChooserDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -405,7 +365,7 @@ func (x *FileChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -413,14 +373,13 @@ func (x *FileChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -444,19 +403,17 @@ func (x *FileChooserDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -477,7 +434,7 @@ func (x *FileChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -486,14 +443,12 @@ func (x *FileChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -512,10 +467,10 @@ func (x *FileChooserDialog) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FileChooserDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -526,23 +481,27 @@ func (x *FileChooserDialog) GetBuildableId() string {
 //
 // This is typically implemented as a combobox or, for boolean choices,
 // as a checkbutton. You can select a value using
-// [method@Gtk.FileChooser.set_choice] before the dialog is shown,
+// [FileChooser.SetChoice] before the dialog is shown,
 // and you can obtain the user-selected value in the
-// [signal@Gtk.Dialog::response] signal handler using
-// [method@Gtk.FileChooser.get_choice].
+// [Dialog.ConnectResponseFunc] signal handler using
+// [FileChooser.GetChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) AddChoice(IdVar string, LabelVar string, OptionsVar []string, OptionLabelsVar []string) {
 
 	XGtkFileChooserAddChoice(x.GoPointer(), IdVar, LabelVar, OptionsVar, OptionLabelsVar)
 
 }
 
-// Adds @filter to the list of filters that the user can select between.
+// Adds filter to the list of filters that the user can select between.
 //
 // When a filter is selected, only files that are passed by that
 // filter are displayed.
 //
-// Note that the @chooser takes ownership of the filter if it is floating,
+// Note that the chooser takes ownership of the filter if it is floating,
 // so you have to ref and sink it if you want to keep a reference.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) AddFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserAddFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -551,18 +510,22 @@ func (x *FileChooserDialog) AddFilter(FilterVar *FileFilter) {
 
 // Adds a folder to be displayed with the shortcut folders
 // in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) AddShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserAddShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets the type of operation that the file chooser is performing.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetAction() FileChooserAction {
 
 	cret := XGtkFileChooserGetAction(x.GoPointer())
@@ -570,6 +533,8 @@ func (x *FileChooserDialog) GetAction() FileChooserAction {
 }
 
 // Gets the currently selected option in the 'choice' with the given ID.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetChoice(IdVar string) string {
 
 	cret := XGtkFileChooserGetChoice(x.GoPointer(), IdVar)
@@ -577,13 +542,17 @@ func (x *FileChooserDialog) GetChoice(IdVar string) string {
 }
 
 // Gets whether file chooser will offer to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetCreateFolders() bool {
 
 	cret := XGtkFileChooserGetCreateFolders(x.GoPointer())
 	return cret
 }
 
-// Gets the current folder of @chooser as `GFile`.
+// Gets the current folder of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetCurrentFolder() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -601,6 +570,8 @@ func (x *FileChooserDialog) GetCurrentFolder() *gio.FileBase {
 //
 // This is meant to be used in save dialogs, to get the currently typed
 // filename when the file itself does not exist yet.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetCurrentName() string {
 
 	cret := XGtkFileChooserGetCurrentName(x.GoPointer())
@@ -615,6 +586,8 @@ func (x *FileChooserDialog) GetCurrentName() string {
 //
 // If the file chooser is in folder mode, this function returns
 // the selected folder.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -629,7 +602,9 @@ func (x *FileChooserDialog) GetFile() *gio.FileBase {
 }
 
 // Lists all the selected files and subfolders in the current folder
-// of @chooser as `GFile`.
+// of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetFiles() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -644,6 +619,8 @@ func (x *FileChooserDialog) GetFiles() *gio.ListModelBase {
 }
 
 // Gets the current filter.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetFilter() *FileFilter {
 	var cls *FileFilter
 
@@ -660,11 +637,13 @@ func (x *FileChooserDialog) GetFilter() *FileFilter {
 
 // Gets the current set of user-selectable filters, as a list model.
 //
-// See [method@Gtk.FileChooser.add_filter] and
-// [method@Gtk.FileChooser.remove_filter] for changing individual filters.
+// See [FileChooser.AddFilter] and
+// [FileChooser.RemoveFilter] for changing individual filters.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetFilters() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -680,6 +659,8 @@ func (x *FileChooserDialog) GetFilters() *gio.ListModelBase {
 
 // Gets whether multiple files can be selected in the file
 // chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetSelectMultiple() bool {
 
 	cret := XGtkFileChooserGetSelectMultiple(x.GoPointer())
@@ -689,7 +670,9 @@ func (x *FileChooserDialog) GetSelectMultiple() bool {
 // Queries the list of shortcut folders in the file chooser.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) GetShortcutFolders() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -703,14 +686,18 @@ func (x *FileChooserDialog) GetShortcutFolders() *gio.ListModelBase {
 	return cls
 }
 
-// Removes a 'choice' that has been added with gtk_file_chooser_add_choice().
+// Removes a 'choice' that has been added with [FileChooser.AddChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) RemoveChoice(IdVar string) {
 
 	XGtkFileChooserRemoveChoice(x.GoPointer(), IdVar)
 
 }
 
-// Removes @filter from the list of filters that the user can select between.
+// Removes filter from the list of filters that the user can select between.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) RemoveFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserRemoveFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -718,12 +705,14 @@ func (x *FileChooserDialog) RemoveFilter(FilterVar *FileFilter) {
 }
 
 // Removes a folder from the shortcut folders in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) RemoveShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserRemoveShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -734,8 +723,10 @@ func (x *FileChooserDialog) RemoveShortcutFolder(FolderVar gio.File) (bool, erro
 // The user interface is adapted to suit the selected action.
 //
 // For example, an option to create a new folder might be shown
-// if the action is %GTK_FILE_CHOOSER_ACTION_SAVE but not if the
-// action is %GTK_FILE_CHOOSER_ACTION_OPEN.
+// if the action is [FileChooserActionSaveValue] but not if the
+// action is [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetAction(ActionVar FileChooserAction) {
 
 	XGtkFileChooserSetAction(x.GoPointer(), ActionVar)
@@ -743,9 +734,11 @@ func (x *FileChooserDialog) SetAction(ActionVar FileChooserAction) {
 }
 
 // Selects an option in a 'choice' that has been added with
-// gtk_file_chooser_add_choice().
+// [FileChooser.AddChoice].
 //
 // For a boolean choice, the possible options are "true" and "false".
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetChoice(IdVar string, OptionVar string) {
 
 	XGtkFileChooserSetChoice(x.GoPointer(), IdVar, OptionVar)
@@ -755,20 +748,24 @@ func (x *FileChooserDialog) SetChoice(IdVar string, OptionVar string) {
 // Sets whether file chooser will offer to create new folders.
 //
 // This is only relevant if the action is not set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN.
+// [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetCreateFolders(CreateFoldersVar bool) {
 
 	XGtkFileChooserSetCreateFolders(x.GoPointer(), CreateFoldersVar)
 
 }
 
-// Sets the current folder for @chooser from a `GFile`.
+// Sets the current folder for chooser from a `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetCurrentFolder(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetCurrentFolder(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -780,27 +777,29 @@ func (x *FileChooserDialog) SetCurrentFolder(FileVar gio.File) (bool, error) {
 // Note that the name passed in here is a UTF-8 string rather
 // than a filename. This function is meant for such uses as a
 // suggested name in a “Save As...” dialog.  You can pass
-// “Untitled.doc” or a similarly suitable suggestion for the @name.
+// “Untitled.doc” or a similarly suitable suggestion for the name.
 //
 // If you want to preselect a particular existing file, you should
-// use [method@Gtk.FileChooser.set_file] instead.
+// use [FileChooser.SetFile] instead.
 //
 // Please see the documentation for those functions for an example
-// of using [method@Gtk.FileChooser.set_current_name] as well.
+// of using [FileChooser.SetCurrentName] as well.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetCurrentName(NameVar string) {
 
 	XGtkFileChooserSetCurrentName(x.GoPointer(), NameVar)
 
 }
 
-// Sets @file as the current filename for the file chooser.
+// Sets file as the current filename for the file chooser.
 //
 // This includes changing to the file’s parent folder and actually selecting
-// the file in list. If the @chooser is in %GTK_FILE_CHOOSER_ACTION_SAVE mode,
+// the file in list. If the chooser is in [FileChooserActionSaveValue] mode,
 // the file’s base name will also appear in the dialog’s file name entry.
 //
-// If the file name isn’t in the current folder of @chooser, then the current
-// folder of @chooser will be changed to the folder containing @file.
+// If the file name isn’t in the current folder of chooser, then the current
+// folder of chooser will be changed to the folder containing file.
 //
 // Note that the file must exist, or nothing will be done except
 // for the directory change.
@@ -813,12 +812,9 @@ func (x *FileChooserDialog) SetCurrentName(NameVar string) {
 //
 // Instead, use something similar to this:
 //
-// ```c
-// static void
-// prepare_file_chooser (GtkFileChooser *chooser,
-//
-//	GFile          *existing_file)
-//
+//	static void
+//	prepare_file_chooser (GtkFileChooser *chooser,
+//	                      GFile          *existing_file)
 //	{
 //	  gboolean document_is_new = (existing_file == NULL);
 //
@@ -837,13 +833,13 @@ func (x *FileChooserDialog) SetCurrentName(NameVar string) {
 //	    }
 //	}
 //
-// ```
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetFile(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetFile(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -858,6 +854,8 @@ func (x *FileChooserDialog) SetFile(FileVar gio.File) (bool, error) {
 // Setting the current filter when the list of filters is
 // empty is useful if you want to restrict the displayed
 // set of files without letting the user change it.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserSetFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -867,8 +865,10 @@ func (x *FileChooserDialog) SetFilter(FilterVar *FileFilter) {
 // Sets whether multiple files can be selected in the file chooser.
 //
 // This is only relevant if the action is set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN or
-// %GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER.
+// [FileChooserActionOpenValue] or
+// [FileChooserActionSelectFolderValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserDialog) SetSelectMultiple(SelectMultipleVar bool) {
 
 	XGtkFileChooserSetSelectMultiple(x.GoPointer(), SelectMultipleVar)
@@ -905,10 +905,10 @@ func (x *FileChooserDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *FileChooserDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -952,7 +952,7 @@ func (x *FileChooserDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *FileChooserDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -968,34 +968,16 @@ func (x *FileChooserDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *FileChooserDialog) SetFocus(FocusVar *Widget) {
 
 	XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFileChooserDialogGLibType, libs, "gtk_file_chooser_dialog_get_type")
-
-	core.PuregoSafeRegister(&xNewFileChooserDialog, libs, "gtk_file_chooser_dialog_new")
-
-}
@@ -0,0 +1,35 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewFileChooserDialog func(uintptr, uintptr, FileChooserAction, uintptr, ...interface{}) uintptr
+
+// Creates a new `GtkFileChooserDialog`.
+//
+// This function is analogous to [NewDialogWithButtons].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func NewFileChooserDialog(TitleVar *string, ParentVar *Window, ActionVar FileChooserAction, FirstButtonTextVar *string, varArgs ...interface{}) *FileChooserDialog {
+	return newFileChooserDialogVarArgs(TitleVar, ParentVar, ActionVar, FirstButtonTextVar, varArgs...)
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFileChooserDialog, libs, "gtk_file_chooser_dialog_new", "GTK", "")
+
+}
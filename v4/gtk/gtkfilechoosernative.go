@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -40,24 +39,21 @@ func (x *FileChooserNativeClass) GoPointer() uintptr {
 // platform native dialog.
 //
 // Showing, hiding and running the dialog is handled by the
-// [class@Gtk.NativeDialog] functions.
+// [NativeDialog] functions.
 //
 // Note that unlike `GtkFileChooserDialog`, `GtkFileChooserNative` objects
 // are not toplevel widgets, and GTK does not keep them alive. It is your
 // responsibility to keep a reference until you are done with the
 // object.
 //
-// ## Typical usage
+// # Typical usage
 //
 // In the simplest of cases, you can the following code to use
 // `GtkFileChooserNative` to select a file for opening:
 //
-// ```c
-// static void
-// on_response (GtkNativeDialog *native,
-//
-//	int              response)
-//
+//	static void
+//	on_response (GtkNativeDialog *native,
+//	             int              response)
 //	{
 //	  if (response == GTK_RESPONSE_ACCEPT)
 //	    {
@@ -72,29 +68,24 @@ func (x *FileChooserNativeClass) GoPointer() uintptr {
 //	  g_object_unref (native);
 //	}
 //
-//	// ...
-//	GtkFileChooserNative *native;
-//	GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
-//
-//	native = gtk_file_chooser_native_new ("Open File",
-//	                                      parent_window,
-//	                                      action,
-//	                                      "_Open",
-//	                                      "_Cancel");
+//	  // ...
+//	  GtkFileChooserNative *native;
+//	  GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_OPEN;
 //
-//	g_signal_connect (native, "response", G_CALLBACK (on_response), NULL);
-//	gtk_native_dialog_show (GTK_NATIVE_DIALOG (native));
+//	  native = gtk_file_chooser_native_new ("Open File",
+//	                                        parent_window,
+//	                                        action,
+//	                                        "_Open",
+//	                                        "_Cancel");
 //
-// ```
+//	  g_signal_connect (native, "response", G_CALLBACK (on_response), NULL);
+//	  gtk_native_dialog_show (GTK_NATIVE_DIALOG (native));
 //
 // To use a `GtkFileChooserNative` for saving, you can use this:
 //
-// ```c
-// static void
-// on_response (GtkNativeDialog *native,
-//
-//	int              response)
-//
+//	static void
+//	on_response (GtkNativeDialog *native,
+//	             int              response)
 //	{
 //	  if (response == GTK_RESPONSE_ACCEPT)
 //	    {
@@ -109,72 +100,72 @@ func (x *FileChooserNativeClass) GoPointer() uintptr {
 //	  g_object_unref (native);
 //	}
 //
-//	// ...
-//	GtkFileChooserNative *native;
-//	GtkFileChooser *chooser;
-//	GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_SAVE;
+//	  // ...
+//	  GtkFileChooserNative *native;
+//	  GtkFileChooser *chooser;
+//	  GtkFileChooserAction action = GTK_FILE_CHOOSER_ACTION_SAVE;
 //
-//	native = gtk_file_chooser_native_new ("Save File",
-//	                                      parent_window,
-//	                                      action,
-//	                                      "_Save",
-//	                                      "_Cancel");
-//	chooser = GTK_FILE_CHOOSER (native);
+//	  native = gtk_file_chooser_native_new ("Save File",
+//	                                        parent_window,
+//	                                        action,
+//	                                        "_Save",
+//	                                        "_Cancel");
+//	  chooser = GTK_FILE_CHOOSER (native);
 //
-//	if (user_edited_a_new_document)
-//	  gtk_file_chooser_set_current_name (chooser, _("Untitled document"));
-//	else
-//	  gtk_file_chooser_set_file (chooser, existing_file, NULL);
-//
-//	g_signal_connect (native, "response", G_CALLBACK (on_response), NULL);
-//	gtk_native_dialog_show (GTK_NATIVE_DIALOG (native));
+//	  if (user_edited_a_new_document)
+//	    gtk_file_chooser_set_current_name (chooser, _("Untitled document"));
+//	  else
+//	    gtk_file_chooser_set_file (chooser, existing_file, NULL);
 //
-// ```
+//	  g_signal_connect (native, "response", G_CALLBACK (on_response), NULL);
+//	  gtk_native_dialog_show (GTK_NATIVE_DIALOG (native));
 //
 // For more information on how to best set up a file dialog,
-// see the [class@Gtk.FileChooserDialog] documentation.
+// see the [FileChooserDialog] documentation.
 //
-// ## Response Codes
+// # Response Codes
 //
-// `GtkFileChooserNative` inherits from [class@Gtk.NativeDialog],
-// which means it will return %GTK_RESPONSE_ACCEPT if the user accepted,
-// and %GTK_RESPONSE_CANCEL if he pressed cancel. It can also return
-// %GTK_RESPONSE_DELETE_EVENT if the window was unexpectedly closed.
+// `GtkFileChooserNative` inherits from [NativeDialog],
+// which means it will return [ResponseAcceptValue] if the user accepted,
+// and [ResponseCancelValue] if he pressed cancel. It can also return
+// [ResponseDeleteEventValue] if the window was unexpectedly closed.
 //
-// ## Differences from `GtkFileChooserDialog`
+// # Differences from `GtkFileChooserDialog`
 //
-// There are a few things in the [iface@Gtk.FileChooser] interface that
+// There are a few things in the [FileChooser] interface that
 // are not possible to use with `GtkFileChooserNative`, as such use would
 // prohibit the use of a native dialog.
 //
 // No operations that change the dialog work while the dialog is visible.
 // Set all the properties that are required before showing the dialog.
 //
-// ## Win32 details
+// # Win32 details
 //
 // On windows the `IFileDialog` implementation (added in Windows Vista) is
 // used. It supports many of the features that `GtkFileChooser` has, but
 // there are some things it does not handle:
 //
-// * Any [class@Gtk.FileFilter] added using a mimetype
+// * Any [FileFilter] added using a mimetype
 //
 // If any of these features are used the regular `GtkFileChooserDialog`
 // will be used in place of the native one.
 //
-// ## Portal details
+// # Portal details
 //
 // When the `org.freedesktop.portal.FileChooser` portal is available on
 // the session bus, it is used to bring up an out-of-process file chooser.
 // Depending on the kind of session the application is running in, this may
 // or may not be a GTK file chooser.
 //
-// ## macOS details
+// # macOS details
 //
 // On macOS the `NSSavePanel` and `NSOpenPanel` classes are used to provide
 // native file chooser dialogs. Some features provided by `GtkFileChooser`
 // are not supported:
 //
 // * Shortcut folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 type FileChooserNative struct {
 	NativeDialog
 }
@@ -191,87 +182,6 @@ func FileChooserNativeNewFromInternalPtr(ptr uintptr) *FileChooserNative {
 	return cls
 }
 
-var xNewFileChooserNative func(uintptr, uintptr, FileChooserAction, uintptr, uintptr) uintptr
-
-// Creates a new `GtkFileChooserNative`.
-func NewFileChooserNative(TitleVar *string, ParentVar *Window, ActionVar FileChooserAction, AcceptLabelVar *string, CancelLabelVar *string) *FileChooserNative {
-	var cls *FileChooserNative
-
-	TitleVarPtr := core.GStrdupNullable(TitleVar)
-	defer core.GFreeNullable(TitleVarPtr)
-
-	AcceptLabelVarPtr := core.GStrdupNullable(AcceptLabelVar)
-	defer core.GFreeNullable(AcceptLabelVarPtr)
-
-	CancelLabelVarPtr := core.GStrdupNullable(CancelLabelVar)
-	defer core.GFreeNullable(CancelLabelVarPtr)
-
-	cret := xNewFileChooserNative(TitleVarPtr, ParentVar.GoPointer(), ActionVar, AcceptLabelVarPtr, CancelLabelVarPtr)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &FileChooserNative{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFileChooserNativeGetAcceptLabel func(uintptr) string
-
-// Retrieves the custom label text for the accept button.
-func (x *FileChooserNative) GetAcceptLabel() string {
-
-	cret := xFileChooserNativeGetAcceptLabel(x.GoPointer())
-	return cret
-}
-
-var xFileChooserNativeGetCancelLabel func(uintptr) string
-
-// Retrieves the custom label text for the cancel button.
-func (x *FileChooserNative) GetCancelLabel() string {
-
-	cret := xFileChooserNativeGetCancelLabel(x.GoPointer())
-	return cret
-}
-
-var xFileChooserNativeSetAcceptLabel func(uintptr, uintptr)
-
-// Sets the custom label text for the accept button.
-//
-// If characters in @label are preceded by an underscore, they are
-// underlined. If you need a literal underscore character in a label,
-// use “__” (two underscores). The first underlined character represents
-// a keyboard accelerator called a mnemonic.
-//
-// Pressing Alt and that key should activate the button.
-func (x *FileChooserNative) SetAcceptLabel(AcceptLabelVar *string) {
-
-	AcceptLabelVarPtr := core.GStrdupNullable(AcceptLabelVar)
-	defer core.GFreeNullable(AcceptLabelVarPtr)
-
-	xFileChooserNativeSetAcceptLabel(x.GoPointer(), AcceptLabelVarPtr)
-
-}
-
-var xFileChooserNativeSetCancelLabel func(uintptr, uintptr)
-
-// Sets the custom label text for the cancel button.
-//
-// If characters in @label are preceded by an underscore, they are
-// underlined. If you need a literal underscore character in a label,
-// use “__” (two underscores). The first underlined character represents
-// a keyboard accelerator called a mnemonic.
-//
-// Pressing Alt and that key should activate the button.
-func (x *FileChooserNative) SetCancelLabel(CancelLabelVar *string) {
-
-	CancelLabelVarPtr := core.GStrdupNullable(CancelLabelVar)
-	defer core.GFreeNullable(CancelLabelVarPtr)
-
-	xFileChooserNativeSetCancelLabel(x.GoPointer(), CancelLabelVarPtr)
-
-}
-
 func (c *FileChooserNative) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -285,7 +195,7 @@ func (c *FileChooserNative) SetGoPointer(ptr uintptr) {
 
 // SetPropertyAcceptLabel sets the "accept-label" property.
 // The text used for the label on the accept button in the dialog, or
-// %NULL to use the default text.
+// nil to use the default text.
 func (x *FileChooserNative) SetPropertyAcceptLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -295,7 +205,7 @@ func (x *FileChooserNative) SetPropertyAcceptLabel(value string) {
 
 // GetPropertyAcceptLabel gets the "accept-label" property.
 // The text used for the label on the accept button in the dialog, or
-// %NULL to use the default text.
+// nil to use the default text.
 func (x *FileChooserNative) GetPropertyAcceptLabel() string {
 	var v gobject.Value
 	x.GetProperty("accept-label", &v)
@@ -304,7 +214,7 @@ func (x *FileChooserNative) GetPropertyAcceptLabel() string {
 
 // SetPropertyCancelLabel sets the "cancel-label" property.
 // The text used for the label on the cancel button in the dialog, or
-// %NULL to use the default text.
+// nil to use the default text.
 func (x *FileChooserNative) SetPropertyCancelLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -314,7 +224,7 @@ func (x *FileChooserNative) SetPropertyCancelLabel(value string) {
 
 // GetPropertyCancelLabel gets the "cancel-label" property.
 // The text used for the label on the cancel button in the dialog, or
-// %NULL to use the default text.
+// nil to use the default text.
 func (x *FileChooserNative) GetPropertyCancelLabel() string {
 	var v gobject.Value
 	x.GetProperty("cancel-label", &v)
@@ -325,23 +235,27 @@ func (x *FileChooserNative) GetPropertyCancelLabel() string {
 //
 // This is typically implemented as a combobox or, for boolean choices,
 // as a checkbutton. You can select a value using
-// [method@Gtk.FileChooser.set_choice] before the dialog is shown,
+// [FileChooser.SetChoice] before the dialog is shown,
 // and you can obtain the user-selected value in the
-// [signal@Gtk.Dialog::response] signal handler using
-// [method@Gtk.FileChooser.get_choice].
+// [Dialog.ConnectResponseFunc] signal handler using
+// [FileChooser.GetChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) AddChoice(IdVar string, LabelVar string, OptionsVar []string, OptionLabelsVar []string) {
 
 	XGtkFileChooserAddChoice(x.GoPointer(), IdVar, LabelVar, OptionsVar, OptionLabelsVar)
 
 }
 
-// Adds @filter to the list of filters that the user can select between.
+// Adds filter to the list of filters that the user can select between.
 //
 // When a filter is selected, only files that are passed by that
 // filter are displayed.
 //
-// Note that the @chooser takes ownership of the filter if it is floating,
+// Note that the chooser takes ownership of the filter if it is floating,
 // so you have to ref and sink it if you want to keep a reference.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) AddFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserAddFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -350,18 +264,22 @@ func (x *FileChooserNative) AddFilter(FilterVar *FileFilter) {
 
 // Adds a folder to be displayed with the shortcut folders
 // in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) AddShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserAddShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets the type of operation that the file chooser is performing.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetAction() FileChooserAction {
 
 	cret := XGtkFileChooserGetAction(x.GoPointer())
@@ -369,6 +287,8 @@ func (x *FileChooserNative) GetAction() FileChooserAction {
 }
 
 // Gets the currently selected option in the 'choice' with the given ID.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetChoice(IdVar string) string {
 
 	cret := XGtkFileChooserGetChoice(x.GoPointer(), IdVar)
@@ -376,13 +296,17 @@ func (x *FileChooserNative) GetChoice(IdVar string) string {
 }
 
 // Gets whether file chooser will offer to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetCreateFolders() bool {
 
 	cret := XGtkFileChooserGetCreateFolders(x.GoPointer())
 	return cret
 }
 
-// Gets the current folder of @chooser as `GFile`.
+// Gets the current folder of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetCurrentFolder() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -400,6 +324,8 @@ func (x *FileChooserNative) GetCurrentFolder() *gio.FileBase {
 //
 // This is meant to be used in save dialogs, to get the currently typed
 // filename when the file itself does not exist yet.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetCurrentName() string {
 
 	cret := XGtkFileChooserGetCurrentName(x.GoPointer())
@@ -414,6 +340,8 @@ func (x *FileChooserNative) GetCurrentName() string {
 //
 // If the file chooser is in folder mode, this function returns
 // the selected folder.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -428,7 +356,9 @@ func (x *FileChooserNative) GetFile() *gio.FileBase {
 }
 
 // Lists all the selected files and subfolders in the current folder
-// of @chooser as `GFile`.
+// of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetFiles() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -443,6 +373,8 @@ func (x *FileChooserNative) GetFiles() *gio.ListModelBase {
 }
 
 // Gets the current filter.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetFilter() *FileFilter {
 	var cls *FileFilter
 
@@ -459,11 +391,13 @@ func (x *FileChooserNative) GetFilter() *FileFilter {
 
 // Gets the current set of user-selectable filters, as a list model.
 //
-// See [method@Gtk.FileChooser.add_filter] and
-// [method@Gtk.FileChooser.remove_filter] for changing individual filters.
+// See [FileChooser.AddFilter] and
+// [FileChooser.RemoveFilter] for changing individual filters.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetFilters() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -479,6 +413,8 @@ func (x *FileChooserNative) GetFilters() *gio.ListModelBase {
 
 // Gets whether multiple files can be selected in the file
 // chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetSelectMultiple() bool {
 
 	cret := XGtkFileChooserGetSelectMultiple(x.GoPointer())
@@ -488,7 +424,9 @@ func (x *FileChooserNative) GetSelectMultiple() bool {
 // Queries the list of shortcut folders in the file chooser.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) GetShortcutFolders() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -502,14 +440,18 @@ func (x *FileChooserNative) GetShortcutFolders() *gio.ListModelBase {
 	return cls
 }
 
-// Removes a 'choice' that has been added with gtk_file_chooser_add_choice().
+// Removes a 'choice' that has been added with [FileChooser.AddChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) RemoveChoice(IdVar string) {
 
 	XGtkFileChooserRemoveChoice(x.GoPointer(), IdVar)
 
 }
 
-// Removes @filter from the list of filters that the user can select between.
+// Removes filter from the list of filters that the user can select between.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) RemoveFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserRemoveFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -517,12 +459,14 @@ func (x *FileChooserNative) RemoveFilter(FilterVar *FileFilter) {
 }
 
 // Removes a folder from the shortcut folders in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) RemoveShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserRemoveShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -533,8 +477,10 @@ func (x *FileChooserNative) RemoveShortcutFolder(FolderVar gio.File) (bool, erro
 // The user interface is adapted to suit the selected action.
 //
 // For example, an option to create a new folder might be shown
-// if the action is %GTK_FILE_CHOOSER_ACTION_SAVE but not if the
-// action is %GTK_FILE_CHOOSER_ACTION_OPEN.
+// if the action is [FileChooserActionSaveValue] but not if the
+// action is [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetAction(ActionVar FileChooserAction) {
 
 	XGtkFileChooserSetAction(x.GoPointer(), ActionVar)
@@ -542,9 +488,11 @@ func (x *FileChooserNative) SetAction(ActionVar FileChooserAction) {
 }
 
 // Selects an option in a 'choice' that has been added with
-// gtk_file_chooser_add_choice().
+// [FileChooser.AddChoice].
 //
 // For a boolean choice, the possible options are "true" and "false".
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetChoice(IdVar string, OptionVar string) {
 
 	XGtkFileChooserSetChoice(x.GoPointer(), IdVar, OptionVar)
@@ -554,20 +502,24 @@ func (x *FileChooserNative) SetChoice(IdVar string, OptionVar string) {
 // Sets whether file chooser will offer to create new folders.
 //
 // This is only relevant if the action is not set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN.
+// [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetCreateFolders(CreateFoldersVar bool) {
 
 	XGtkFileChooserSetCreateFolders(x.GoPointer(), CreateFoldersVar)
 
 }
 
-// Sets the current folder for @chooser from a `GFile`.
+// Sets the current folder for chooser from a `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetCurrentFolder(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetCurrentFolder(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -579,27 +531,29 @@ func (x *FileChooserNative) SetCurrentFolder(FileVar gio.File) (bool, error) {
 // Note that the name passed in here is a UTF-8 string rather
 // than a filename. This function is meant for such uses as a
 // suggested name in a “Save As...” dialog.  You can pass
-// “Untitled.doc” or a similarly suitable suggestion for the @name.
+// “Untitled.doc” or a similarly suitable suggestion for the name.
 //
 // If you want to preselect a particular existing file, you should
-// use [method@Gtk.FileChooser.set_file] instead.
+// use [FileChooser.SetFile] instead.
 //
 // Please see the documentation for those functions for an example
-// of using [method@Gtk.FileChooser.set_current_name] as well.
+// of using [FileChooser.SetCurrentName] as well.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetCurrentName(NameVar string) {
 
 	XGtkFileChooserSetCurrentName(x.GoPointer(), NameVar)
 
 }
 
-// Sets @file as the current filename for the file chooser.
+// Sets file as the current filename for the file chooser.
 //
 // This includes changing to the file’s parent folder and actually selecting
-// the file in list. If the @chooser is in %GTK_FILE_CHOOSER_ACTION_SAVE mode,
+// the file in list. If the chooser is in [FileChooserActionSaveValue] mode,
 // the file’s base name will also appear in the dialog’s file name entry.
 //
-// If the file name isn’t in the current folder of @chooser, then the current
-// folder of @chooser will be changed to the folder containing @file.
+// If the file name isn’t in the current folder of chooser, then the current
+// folder of chooser will be changed to the folder containing file.
 //
 // Note that the file must exist, or nothing will be done except
 // for the directory change.
@@ -612,12 +566,9 @@ func (x *FileChooserNative) SetCurrentName(NameVar string) {
 //
 // Instead, use something similar to this:
 //
-// ```c
-// static void
-// prepare_file_chooser (GtkFileChooser *chooser,
-//
-//	GFile          *existing_file)
-//
+//	static void
+//	prepare_file_chooser (GtkFileChooser *chooser,
+//	                      GFile          *existing_file)
 //	{
 //	  gboolean document_is_new = (existing_file == NULL);
 //
@@ -636,13 +587,13 @@ func (x *FileChooserNative) SetCurrentName(NameVar string) {
 //	    }
 //	}
 //
-// ```
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetFile(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetFile(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -657,6 +608,8 @@ func (x *FileChooserNative) SetFile(FileVar gio.File) (bool, error) {
 // Setting the current filter when the list of filters is
 // empty is useful if you want to restrict the displayed
 // set of files without letting the user change it.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserSetFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -666,33 +619,12 @@ func (x *FileChooserNative) SetFilter(FilterVar *FileFilter) {
 // Sets whether multiple files can be selected in the file chooser.
 //
 // This is only relevant if the action is set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN or
-// %GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER.
+// [FileChooserActionOpenValue] or
+// [FileChooserActionSelectFolderValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserNative) SetSelectMultiple(SelectMultipleVar bool) {
 
 	XGtkFileChooserSetSelectMultiple(x.GoPointer(), SelectMultipleVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFileChooserNativeGLibType, libs, "gtk_file_chooser_native_get_type")
-
-	core.PuregoSafeRegister(&xNewFileChooserNative, libs, "gtk_file_chooser_native_new")
-
-	core.PuregoSafeRegister(&xFileChooserNativeGetAcceptLabel, libs, "gtk_file_chooser_native_get_accept_label")
-	core.PuregoSafeRegister(&xFileChooserNativeGetCancelLabel, libs, "gtk_file_chooser_native_get_cancel_label")
-	core.PuregoSafeRegister(&xFileChooserNativeSetAcceptLabel, libs, "gtk_file_chooser_native_set_accept_label")
-	core.PuregoSafeRegister(&xFileChooserNativeSetCancelLabel, libs, "gtk_file_chooser_native_set_cancel_label")
-
-}
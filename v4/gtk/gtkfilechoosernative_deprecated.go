@@ -0,0 +1,120 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xNewFileChooserNative func(uintptr, uintptr, FileChooserAction, uintptr, uintptr) uintptr
+
+// Creates a new `GtkFileChooserNative`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func NewFileChooserNative(TitleVar *string, ParentVar *Window, ActionVar FileChooserAction, AcceptLabelVar *string, CancelLabelVar *string) *FileChooserNative {
+	var cls *FileChooserNative
+
+	TitleVarPtr := core.GStrdupNullable(TitleVar)
+	defer core.GFreeNullable(TitleVarPtr)
+
+	AcceptLabelVarPtr := core.GStrdupNullable(AcceptLabelVar)
+	defer core.GFreeNullable(AcceptLabelVarPtr)
+
+	CancelLabelVarPtr := core.GStrdupNullable(CancelLabelVar)
+	defer core.GFreeNullable(CancelLabelVarPtr)
+
+	cret := xNewFileChooserNative(TitleVarPtr, ParentVar.GoPointer(), ActionVar, AcceptLabelVarPtr, CancelLabelVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &FileChooserNative{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFileChooserNativeGetAcceptLabel func(uintptr) string
+
+// Retrieves the custom label text for the accept button.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func (x *FileChooserNative) GetAcceptLabel() string {
+
+	cret := xFileChooserNativeGetAcceptLabel(x.GoPointer())
+	return cret
+}
+
+var xFileChooserNativeGetCancelLabel func(uintptr) string
+
+// Retrieves the custom label text for the cancel button.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func (x *FileChooserNative) GetCancelLabel() string {
+
+	cret := xFileChooserNativeGetCancelLabel(x.GoPointer())
+	return cret
+}
+
+var xFileChooserNativeSetAcceptLabel func(uintptr, uintptr)
+
+// Sets the custom label text for the accept button.
+//
+// If characters in label are preceded by an underscore, they are
+// underlined. If you need a literal underscore character in a label,
+// use “__” (two underscores). The first underlined character represents
+// a keyboard accelerator called a mnemonic.
+//
+// Pressing Alt and that key should activate the button.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func (x *FileChooserNative) SetAcceptLabel(AcceptLabelVar *string) {
+
+	AcceptLabelVarPtr := core.GStrdupNullable(AcceptLabelVar)
+	defer core.GFreeNullable(AcceptLabelVarPtr)
+
+	xFileChooserNativeSetAcceptLabel(x.GoPointer(), AcceptLabelVarPtr)
+
+}
+
+var xFileChooserNativeSetCancelLabel func(uintptr, uintptr)
+
+// Sets the custom label text for the cancel button.
+//
+// If characters in label are preceded by an underscore, they are
+// underlined. If you need a literal underscore character in a label,
+// use “__” (two underscores). The first underlined character represents
+// a keyboard accelerator called a mnemonic.
+//
+// Pressing Alt and that key should activate the button.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
+func (x *FileChooserNative) SetCancelLabel(CancelLabelVar *string) {
+
+	CancelLabelVarPtr := core.GStrdupNullable(CancelLabelVar)
+	defer core.GFreeNullable(CancelLabelVarPtr)
+
+	xFileChooserNativeSetCancelLabel(x.GoPointer(), CancelLabelVarPtr)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFileChooserNative, libs, "gtk_file_chooser_native_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xFileChooserNativeGetAcceptLabel, libs, "gtk_file_chooser_native_get_accept_label", "GTK", "")
+	core.PuregoRegisterSince(&xFileChooserNativeGetCancelLabel, libs, "gtk_file_chooser_native_get_cancel_label", "GTK", "")
+	core.PuregoRegisterSince(&xFileChooserNativeSetAcceptLabel, libs, "gtk_file_chooser_native_set_accept_label", "GTK", "")
+	core.PuregoRegisterSince(&xFileChooserNativeSetCancelLabel, libs, "gtk_file_chooser_native_set_cancel_label", "GTK", "")
+
+}
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,7 +13,7 @@ import (
 
 // `GtkFileChooserWidget` is a widget for choosing files.
 //
-// It exposes the [iface@Gtk.FileChooser] interface, and you should
+// It exposes the [FileChooser] interface, and you should
 // use the methods of this interface to interact with the
 // widget.
 //
@@ -22,26 +21,28 @@ import (
 //
 // `GtkFileChooserWidget` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
+// - Shift+F10 or Menu opens the context menu.
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.FileChooserWidget::desktop-folder]
-// - [signal@Gtk.FileChooserWidget::down-folder]
-// - [signal@Gtk.FileChooserWidget::home-folder]
-// - [signal@Gtk.FileChooserWidget::location-popup]
-// - [signal@Gtk.FileChooserWidget::location-popup-on-paste]
-// - [signal@Gtk.FileChooserWidget::location-toggle-popup]
-// - [signal@Gtk.FileChooserWidget::places-shortcut]
-// - [signal@Gtk.FileChooserWidget::quick-bookmark]
-// - [signal@Gtk.FileChooserWidget::recent-shortcut]
-// - [signal@Gtk.FileChooserWidget::search-shortcut]
-// - [signal@Gtk.FileChooserWidget::show-hidden]
-// - [signal@Gtk.FileChooserWidget::up-folder]
+// - [FileChooserWidget.ConnectDesktopFolderFunc]
+// - [FileChooserWidget.ConnectDownFolderFunc]
+// - [FileChooserWidget.ConnectHomeFolderFunc]
+// - [FileChooserWidget.ConnectLocationPopupFunc]
+// - [FileChooserWidget.ConnectLocationPopupOnPasteFunc]
+// - [FileChooserWidget.ConnectLocationTogglePopupFunc]
+// - [FileChooserWidget.ConnectPlacesShortcutFunc]
+// - [FileChooserWidget.ConnectQuickBookmarkFunc]
+// - [FileChooserWidget.ConnectRecentShortcutFunc]
+// - [FileChooserWidget.ConnectSearchShortcutFunc]
+// - [FileChooserWidget.ConnectShowHiddenFunc]
+// - [FileChooserWidget.ConnectUpFolderFunc]
 //
 // # CSS nodes
 //
 // `GtkFileChooserWidget` has a single CSS node with name filechooser.
+//
+// Deprecated: since 4.10. Direct use of `GtkFileChooserWidget` is deprecated
 type FileChooserWidget struct {
 	Widget
 }
@@ -58,27 +59,6 @@ func FileChooserWidgetNewFromInternalPtr(ptr uintptr) *FileChooserWidget {
 	return cls
 }
 
-var xNewFileChooserWidget func(FileChooserAction) uintptr
-
-// Creates a new `GtkFileChooserWidget`.
-//
-// This is a file chooser widget that can be embedded in custom
-// windows, and it is the same widget that is used by
-// `GtkFileChooserDialog`.
-func NewFileChooserWidget(ActionVar FileChooserAction) *FileChooserWidget {
-	var cls *FileChooserWidget
-
-	cret := xNewFileChooserWidget(ActionVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FileChooserWidget{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *FileChooserWidget) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -125,38 +105,60 @@ func (x *FileChooserWidget) GetPropertySubtitle() string {
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show the user's Desktop
 // folder in the file list.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;D&lt;/kbd&gt;.
+// The default binding for this signal is Alt-D.
+//
+// Deprecated: use ConnectDesktopFolderFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectDesktopFolder(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "desktop-folder", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "desktop-folder", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFileChooserWidgetDesktopFolderTrampoline uintptr
+
+func xFileChooserWidgetDesktopFolderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "desktop-folder", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDesktopFolderFunc connects cb to the "desktop-folder" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectDesktopFolderFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "desktop-folder", &xFileChooserWidgetDesktopFolderTrampoline, xFileChooserWidgetDesktopFolderNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser go to a child of the
 // current folder in the file hierarchy. The subfolder that will
@@ -165,346 +167,588 @@ func (x *FileChooserWidget) ConnectDesktopFolder(cb *func(FileChooserWidget)) ui
 // currently displayed, then this will cause the file chooser to
 // switch to the "baz" subfolder.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;Down&lt;/kbd&gt;.
+// The default binding for this signal is Alt-Down.
+//
+// Deprecated: use ConnectDownFolderFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectDownFolder(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "down-folder", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "down-folder", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetDownFolderTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFileChooserWidgetDownFolderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "down-folder", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDownFolderFunc connects cb to the "down-folder" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectDownFolderFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "down-folder", &xFileChooserWidgetDownFolderTrampoline, xFileChooserWidgetDownFolderNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show the user's home
 // folder in the file list.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;Home&lt;/kbd&gt;.
+// The default binding for this signal is Alt-Home.
+//
+// Deprecated: use ConnectHomeFolderFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectHomeFolder(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "home-folder", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "home-folder", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetHomeFolderTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFileChooserWidgetHomeFolderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "home-folder", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectHomeFolderFunc connects cb to the "home-folder" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectHomeFolderFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "home-folder", &xFileChooserWidgetHomeFolderTrampoline, xFileChooserWidgetHomeFolderNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show a "Location" prompt which
 // the user can use to manually type the name of the file he wishes to select.
 //
-// The default bindings for this signal are &lt;kbd&gt;Control&lt;/kbd&gt;-&lt;kbd&gt;L&lt;/kbd&gt;
-// with a @path string of "" (the empty string). It is also bound to
-// &lt;kbd&gt;/&lt;/kbd&gt; with a @path string of "`/`" (a slash):  this lets you
+// The default bindings for this signal are Control-L
+// with a path string of "" (the empty string). It is also bound to
+// / with a path string of "`/`" (a slash):  this lets you
 // type `/` and immediately type a path name. On Unix systems, this is
-// bound to &lt;kbd&gt;~&lt;/kbd&gt; (tilde) with a @path string of "~" itself for
+// bound to ~ (tilde) with a path string of "~" itself for
 // access to home directories.
+//
+// Deprecated: use ConnectLocationPopupFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectLocationPopup(cb *func(FileChooserWidget, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "location-popup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PathVarp string) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PathVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "location-popup", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetLocationPopupTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PathVarp string) {
+func xFileChooserWidgetLocationPopupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PathVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PathVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "location-popup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLocationPopupFunc connects cb to the "location-popup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectLocationPopupFunc(cb func(FileChooserWidget, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "location-popup", &xFileChooserWidgetLocationPopupTrampoline, xFileChooserWidgetLocationPopupNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show a "Location" prompt
 // when the user pastes into a `GtkFileChooserWidget`.
 //
-// The default binding for this signal is &lt;kbd&gt;Control&lt;/kbd&gt;-&lt;kbd&gt;V&lt;/kbd&gt;.
+// The default binding for this signal is Control-V.
+//
+// Deprecated: use ConnectLocationPopupOnPasteFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectLocationPopupOnPaste(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "location-popup-on-paste", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "location-popup-on-paste", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFileChooserWidgetLocationPopupOnPasteTrampoline uintptr
+
+func xFileChooserWidgetLocationPopupOnPasteNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "location-popup-on-paste", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLocationPopupOnPasteFunc connects cb to the "location-popup-on-paste" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectLocationPopupOnPasteFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "location-popup-on-paste", &xFileChooserWidgetLocationPopupOnPasteTrampoline, xFileChooserWidgetLocationPopupOnPasteNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to toggle the visibility of a "Location" prompt
 // which the user can use to manually type the name of the file
 // he wishes to select.
 //
-// The default binding for this signal is &lt;kbd&gt;Control&lt;/kbd&gt;-&lt;kbd&gt;L&lt;/kbd&gt;.
+// The default binding for this signal is Control-L.
+//
+// Deprecated: use ConnectLocationTogglePopupFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectLocationTogglePopup(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "location-toggle-popup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "location-toggle-popup", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetLocationTogglePopupTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFileChooserWidgetLocationTogglePopupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "location-toggle-popup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectLocationTogglePopupFunc connects cb to the "location-toggle-popup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectLocationTogglePopupFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "location-toggle-popup", &xFileChooserWidgetLocationTogglePopupTrampoline, xFileChooserWidgetLocationTogglePopupNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to move the focus to the places sidebar.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;P&lt;/kbd&gt;.
+// The default binding for this signal is Alt-P.
+//
+// Deprecated: use ConnectPlacesShortcutFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectPlacesShortcut(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "places-shortcut", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "places-shortcut", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFileChooserWidgetPlacesShortcutTrampoline uintptr
+
+func xFileChooserWidgetPlacesShortcutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "places-shortcut", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPlacesShortcutFunc connects cb to the "places-shortcut" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectPlacesShortcutFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "places-shortcut", &xFileChooserWidgetPlacesShortcutTrampoline, xFileChooserWidgetPlacesShortcutNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser switch to the bookmark
-// specified in the @bookmark_index parameter. For example, if
+// specified in the bookmark_index parameter. For example, if
 // you have three bookmarks, you can pass 0, 1, 2 to this signal
 // to switch to each of them, respectively.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;1&lt;/kbd&gt;,
-// &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;2&lt;/kbd&gt;, etc. until &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;0&lt;/kbd&gt;.
-// Note that in the default binding, that &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;1&lt;/kbd&gt; is
+// The default binding for this signal is Alt-1,
+// Alt-2, etc. until Alt-0.
+// Note that in the default binding, that Alt-1 is
 // actually defined to switch to the bookmark at index 0, and so on
 // successively.
+//
+// Deprecated: use ConnectQuickBookmarkFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectQuickBookmark(cb *func(FileChooserWidget, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "quick-bookmark", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, BookmarkIndexVarp int) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, BookmarkIndexVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "quick-bookmark", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, BookmarkIndexVarp int) {
+var xFileChooserWidgetQuickBookmarkTrampoline uintptr
+
+func xFileChooserWidgetQuickBookmarkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, BookmarkIndexVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, BookmarkIndexVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "quick-bookmark", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectQuickBookmarkFunc connects cb to the "quick-bookmark" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectQuickBookmarkFunc(cb func(FileChooserWidget, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "quick-bookmark", &xFileChooserWidgetQuickBookmarkTrampoline, xFileChooserWidgetQuickBookmarkNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show the Recent location.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;R&lt;/kbd&gt;.
+// The default binding for this signal is Alt-R.
+//
+// Deprecated: use ConnectRecentShortcutFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectRecentShortcut(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "recent-shortcut", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "recent-shortcut", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetRecentShortcutTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFileChooserWidgetRecentShortcutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "recent-shortcut", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRecentShortcutFunc connects cb to the "recent-shortcut" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectRecentShortcutFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "recent-shortcut", &xFileChooserWidgetRecentShortcutTrampoline, xFileChooserWidgetRecentShortcutNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser show the search entry.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;S&lt;/kbd&gt;.
+// The default binding for this signal is Alt-S.
+//
+// Deprecated: use ConnectSearchShortcutFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectSearchShortcut(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "search-shortcut", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "search-shortcut", cbRefPtr, cbPtr)
+}
+
+var xFileChooserWidgetSearchShortcutTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFileChooserWidgetSearchShortcutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "search-shortcut", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSearchShortcutFunc connects cb to the "search-shortcut" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectSearchShortcutFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "search-shortcut", &xFileChooserWidgetSearchShortcutTrampoline, xFileChooserWidgetSearchShortcutNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser display hidden files.
 //
-// The default binding for this signal is &lt;kbd&gt;Control&lt;/kbd&gt;-&lt;kbd&gt;H&lt;/kbd&gt;.
+// The default binding for this signal is Control-H.
+//
+// Deprecated: use ConnectShowHiddenFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectShowHidden(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "show-hidden", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "show-hidden", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFileChooserWidgetShowHiddenTrampoline uintptr
+
+func xFileChooserWidgetShowHiddenNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "show-hidden", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShowHiddenFunc connects cb to the "show-hidden" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectShowHiddenFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "show-hidden", &xFileChooserWidgetShowHiddenTrampoline, xFileChooserWidgetShowHiddenNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This is used to make the file chooser go to the parent
 // of the current folder in the file hierarchy.
 //
-// The default binding for this signal is &lt;kbd&gt;Alt&lt;/kbd&gt;-&lt;kbd&gt;Up&lt;/kbd&gt;.
+// The default binding for this signal is Alt-Up.
+//
+// Deprecated: use ConnectUpFolderFunc, which also accepts method values and closures.
 func (x *FileChooserWidget) ConnectUpFolder(cb *func(FileChooserWidget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "up-folder", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FileChooserWidget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "up-folder", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFileChooserWidgetUpFolderTrampoline uintptr
+
+func xFileChooserWidgetUpFolderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FileChooserWidget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FileChooserWidget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "up-folder", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUpFolderFunc connects cb to the "up-folder" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FileChooserWidget) ConnectUpFolderFunc(cb func(FileChooserWidget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "up-folder", &xFileChooserWidgetUpFolderTrampoline, xFileChooserWidgetUpFolderNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -668,7 +912,7 @@ func (x *FileChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -676,14 +920,13 @@ func (x *FileChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserWidget) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -707,19 +950,17 @@ func (x *FileChooserWidget) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserWidget) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -740,7 +981,7 @@ func (x *FileChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -749,14 +990,12 @@ func (x *FileChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FileChooserWidget) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -775,10 +1014,10 @@ func (x *FileChooserWidget) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FileChooserWidget) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -789,23 +1028,27 @@ func (x *FileChooserWidget) GetBuildableId() string {
 //
 // This is typically implemented as a combobox or, for boolean choices,
 // as a checkbutton. You can select a value using
-// [method@Gtk.FileChooser.set_choice] before the dialog is shown,
+// [FileChooser.SetChoice] before the dialog is shown,
 // and you can obtain the user-selected value in the
-// [signal@Gtk.Dialog::response] signal handler using
-// [method@Gtk.FileChooser.get_choice].
+// [Dialog.ConnectResponseFunc] signal handler using
+// [FileChooser.GetChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) AddChoice(IdVar string, LabelVar string, OptionsVar []string, OptionLabelsVar []string) {
 
 	XGtkFileChooserAddChoice(x.GoPointer(), IdVar, LabelVar, OptionsVar, OptionLabelsVar)
 
 }
 
-// Adds @filter to the list of filters that the user can select between.
+// Adds filter to the list of filters that the user can select between.
 //
 // When a filter is selected, only files that are passed by that
 // filter are displayed.
 //
-// Note that the @chooser takes ownership of the filter if it is floating,
+// Note that the chooser takes ownership of the filter if it is floating,
 // so you have to ref and sink it if you want to keep a reference.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) AddFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserAddFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -814,18 +1057,22 @@ func (x *FileChooserWidget) AddFilter(FilterVar *FileFilter) {
 
 // Adds a folder to be displayed with the shortcut folders
 // in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) AddShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserAddShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
 }
 
 // Gets the type of operation that the file chooser is performing.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetAction() FileChooserAction {
 
 	cret := XGtkFileChooserGetAction(x.GoPointer())
@@ -833,6 +1080,8 @@ func (x *FileChooserWidget) GetAction() FileChooserAction {
 }
 
 // Gets the currently selected option in the 'choice' with the given ID.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetChoice(IdVar string) string {
 
 	cret := XGtkFileChooserGetChoice(x.GoPointer(), IdVar)
@@ -840,13 +1089,17 @@ func (x *FileChooserWidget) GetChoice(IdVar string) string {
 }
 
 // Gets whether file chooser will offer to create new folders.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetCreateFolders() bool {
 
 	cret := XGtkFileChooserGetCreateFolders(x.GoPointer())
 	return cret
 }
 
-// Gets the current folder of @chooser as `GFile`.
+// Gets the current folder of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetCurrentFolder() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -864,6 +1117,8 @@ func (x *FileChooserWidget) GetCurrentFolder() *gio.FileBase {
 //
 // This is meant to be used in save dialogs, to get the currently typed
 // filename when the file itself does not exist yet.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetCurrentName() string {
 
 	cret := XGtkFileChooserGetCurrentName(x.GoPointer())
@@ -878,6 +1133,8 @@ func (x *FileChooserWidget) GetCurrentName() string {
 //
 // If the file chooser is in folder mode, this function returns
 // the selected folder.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -892,7 +1149,9 @@ func (x *FileChooserWidget) GetFile() *gio.FileBase {
 }
 
 // Lists all the selected files and subfolders in the current folder
-// of @chooser as `GFile`.
+// of chooser as `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetFiles() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -907,6 +1166,8 @@ func (x *FileChooserWidget) GetFiles() *gio.ListModelBase {
 }
 
 // Gets the current filter.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetFilter() *FileFilter {
 	var cls *FileFilter
 
@@ -923,11 +1184,13 @@ func (x *FileChooserWidget) GetFilter() *FileFilter {
 
 // Gets the current set of user-selectable filters, as a list model.
 //
-// See [method@Gtk.FileChooser.add_filter] and
-// [method@Gtk.FileChooser.remove_filter] for changing individual filters.
+// See [FileChooser.AddFilter] and
+// [FileChooser.RemoveFilter] for changing individual filters.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetFilters() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -943,6 +1206,8 @@ func (x *FileChooserWidget) GetFilters() *gio.ListModelBase {
 
 // Gets whether multiple files can be selected in the file
 // chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetSelectMultiple() bool {
 
 	cret := XGtkFileChooserGetSelectMultiple(x.GoPointer())
@@ -952,7 +1217,9 @@ func (x *FileChooserWidget) GetSelectMultiple() bool {
 // Queries the list of shortcut folders in the file chooser.
 //
 // You should not modify the returned list model. Future changes to
-// @chooser may or may not affect the returned model.
+// chooser may or may not affect the returned model.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) GetShortcutFolders() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -966,14 +1233,18 @@ func (x *FileChooserWidget) GetShortcutFolders() *gio.ListModelBase {
 	return cls
 }
 
-// Removes a 'choice' that has been added with gtk_file_chooser_add_choice().
+// Removes a 'choice' that has been added with [FileChooser.AddChoice].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) RemoveChoice(IdVar string) {
 
 	XGtkFileChooserRemoveChoice(x.GoPointer(), IdVar)
 
 }
 
-// Removes @filter from the list of filters that the user can select between.
+// Removes filter from the list of filters that the user can select between.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) RemoveFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserRemoveFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -981,12 +1252,14 @@ func (x *FileChooserWidget) RemoveFilter(FilterVar *FileFilter) {
 }
 
 // Removes a folder from the shortcut folders in a file chooser.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) RemoveShortcutFolder(FolderVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserRemoveShortcutFolder(x.GoPointer(), FolderVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -997,8 +1270,10 @@ func (x *FileChooserWidget) RemoveShortcutFolder(FolderVar gio.File) (bool, erro
 // The user interface is adapted to suit the selected action.
 //
 // For example, an option to create a new folder might be shown
-// if the action is %GTK_FILE_CHOOSER_ACTION_SAVE but not if the
-// action is %GTK_FILE_CHOOSER_ACTION_OPEN.
+// if the action is [FileChooserActionSaveValue] but not if the
+// action is [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetAction(ActionVar FileChooserAction) {
 
 	XGtkFileChooserSetAction(x.GoPointer(), ActionVar)
@@ -1006,9 +1281,11 @@ func (x *FileChooserWidget) SetAction(ActionVar FileChooserAction) {
 }
 
 // Selects an option in a 'choice' that has been added with
-// gtk_file_chooser_add_choice().
+// [FileChooser.AddChoice].
 //
 // For a boolean choice, the possible options are "true" and "false".
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetChoice(IdVar string, OptionVar string) {
 
 	XGtkFileChooserSetChoice(x.GoPointer(), IdVar, OptionVar)
@@ -1018,20 +1295,24 @@ func (x *FileChooserWidget) SetChoice(IdVar string, OptionVar string) {
 // Sets whether file chooser will offer to create new folders.
 //
 // This is only relevant if the action is not set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN.
+// [FileChooserActionOpenValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetCreateFolders(CreateFoldersVar bool) {
 
 	XGtkFileChooserSetCreateFolders(x.GoPointer(), CreateFoldersVar)
 
 }
 
-// Sets the current folder for @chooser from a `GFile`.
+// Sets the current folder for chooser from a `GFile`.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetCurrentFolder(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetCurrentFolder(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1043,27 +1324,29 @@ func (x *FileChooserWidget) SetCurrentFolder(FileVar gio.File) (bool, error) {
 // Note that the name passed in here is a UTF-8 string rather
 // than a filename. This function is meant for such uses as a
 // suggested name in a “Save As...” dialog.  You can pass
-// “Untitled.doc” or a similarly suitable suggestion for the @name.
+// “Untitled.doc” or a similarly suitable suggestion for the name.
 //
 // If you want to preselect a particular existing file, you should
-// use [method@Gtk.FileChooser.set_file] instead.
+// use [FileChooser.SetFile] instead.
 //
 // Please see the documentation for those functions for an example
-// of using [method@Gtk.FileChooser.set_current_name] as well.
+// of using [FileChooser.SetCurrentName] as well.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetCurrentName(NameVar string) {
 
 	XGtkFileChooserSetCurrentName(x.GoPointer(), NameVar)
 
 }
 
-// Sets @file as the current filename for the file chooser.
+// Sets file as the current filename for the file chooser.
 //
 // This includes changing to the file’s parent folder and actually selecting
-// the file in list. If the @chooser is in %GTK_FILE_CHOOSER_ACTION_SAVE mode,
+// the file in list. If the chooser is in [FileChooserActionSaveValue] mode,
 // the file’s base name will also appear in the dialog’s file name entry.
 //
-// If the file name isn’t in the current folder of @chooser, then the current
-// folder of @chooser will be changed to the folder containing @file.
+// If the file name isn’t in the current folder of chooser, then the current
+// folder of chooser will be changed to the folder containing file.
 //
 // Note that the file must exist, or nothing will be done except
 // for the directory change.
@@ -1076,12 +1359,9 @@ func (x *FileChooserWidget) SetCurrentName(NameVar string) {
 //
 // Instead, use something similar to this:
 //
-// ```c
-// static void
-// prepare_file_chooser (GtkFileChooser *chooser,
-//
-//	GFile          *existing_file)
-//
+//	static void
+//	prepare_file_chooser (GtkFileChooser *chooser,
+//	                      GFile          *existing_file)
 //	{
 //	  gboolean document_is_new = (existing_file == NULL);
 //
@@ -1100,13 +1380,13 @@ func (x *FileChooserWidget) SetCurrentName(NameVar string) {
 //	    }
 //	}
 //
-// ```
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetFile(FileVar gio.File) (bool, error) {
 	var cerr *glib.Error
 
 	cret := XGtkFileChooserSetFile(x.GoPointer(), FileVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1121,6 +1401,8 @@ func (x *FileChooserWidget) SetFile(FileVar gio.File) (bool, error) {
 // Setting the current filter when the list of filters is
 // empty is useful if you want to restrict the displayed
 // set of files without letting the user change it.
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetFilter(FilterVar *FileFilter) {
 
 	XGtkFileChooserSetFilter(x.GoPointer(), FilterVar.GoPointer())
@@ -1130,28 +1412,12 @@ func (x *FileChooserWidget) SetFilter(FilterVar *FileFilter) {
 // Sets whether multiple files can be selected in the file chooser.
 //
 // This is only relevant if the action is set to be
-// %GTK_FILE_CHOOSER_ACTION_OPEN or
-// %GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER.
+// [FileChooserActionOpenValue] or
+// [FileChooserActionSelectFolderValue].
+//
+// Deprecated: since 4.10. Use [FileDialog] instead
 func (x *FileChooserWidget) SetSelectMultiple(SelectMultipleVar bool) {
 
 	XGtkFileChooserSetSelectMultiple(x.GoPointer(), SelectMultipleVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFileChooserWidgetGLibType, libs, "gtk_file_chooser_widget_get_type")
-
-	core.PuregoSafeRegister(&xNewFileChooserWidget, libs, "gtk_file_chooser_widget_new")
-
-}
@@ -0,0 +1,48 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewFileChooserWidget func(FileChooserAction) uintptr
+
+// Creates a new `GtkFileChooserWidget`.
+//
+// This is a file chooser widget that can be embedded in custom
+// windows, and it is the same widget that is used by
+// `GtkFileChooserDialog`.
+//
+// Deprecated: since 4.10. Direct use of `GtkFileChooserWidget` is deprecated
+func NewFileChooserWidget(ActionVar FileChooserAction) *FileChooserWidget {
+	var cls *FileChooserWidget
+
+	cret := xNewFileChooserWidget(ActionVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &FileChooserWidget{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFileChooserWidget, libs, "gtk_file_chooser_widget_new", "GTK", "")
+
+}
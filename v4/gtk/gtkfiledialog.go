@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -29,8 +28,8 @@ func (x *FileDialogClass) GoPointer() uintptr {
 // the dialog to the user, such as a title for the dialog and whether
 // it should be modal.
 //
-// The dialog is shown with [method@Gtk.FileDialog.open],
-// [method@Gtk.FileDialog.save], etc.
+// The dialog is shown with [FileDialog.Open],
+// [FileDialog.Save], etc.
 type FileDialog struct {
 	gobject.Object
 }
@@ -178,7 +177,7 @@ var xFileDialogOpen func(uintptr, uintptr, uintptr, uintptr, uintptr)
 //
 // The file chooser dialog will be set up to select a single file.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) Open(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -188,11 +187,12 @@ func (x *FileDialog) Open(ParentVar *Window, CancellableVar *gio.Cancellable, Ca
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -202,9 +202,9 @@ func (x *FileDialog) Open(ParentVar *Window, CancellableVar *gio.Cancellable, Ca
 
 var xFileDialogOpenFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.open] call.
+// Finishes the [FileDialog.Open] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) OpenFinish(ResultVar gio.AsyncResult) (*gio.FileBase, error) {
 	var cls *gio.FileBase
@@ -213,12 +213,15 @@ func (x *FileDialog) OpenFinish(ResultVar gio.AsyncResult) (*gio.FileBase, error
 	cret := xFileDialogOpenFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -231,9 +234,9 @@ var xFileDialogOpenMultiple func(uintptr, uintptr, uintptr, uintptr, uintptr)
 // The file chooser dialog will be set up to select multiple files.
 //
 // The file chooser dialog will initially be opened in the directory
-// [property@Gtk.FileDialog:initial-folder].
+// FileDialog:initial-folder.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) OpenMultiple(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -243,11 +246,12 @@ func (x *FileDialog) OpenMultiple(ParentVar *Window, CancellableVar *gio.Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -257,9 +261,9 @@ func (x *FileDialog) OpenMultiple(ParentVar *Window, CancellableVar *gio.Cancell
 
 var xFileDialogOpenMultipleFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.open] call.
+// Finishes the [FileDialog.Open] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) OpenMultipleFinish(ResultVar gio.AsyncResult) (*gio.ListModelBase, error) {
 	var cls *gio.ListModelBase
@@ -268,12 +272,15 @@ func (x *FileDialog) OpenMultipleFinish(ResultVar gio.AsyncResult) (*gio.ListMod
 	cret := xFileDialogOpenMultipleFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.ListModelBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -286,12 +293,12 @@ var xFileDialogOpenMultipleTextFiles func(uintptr, uintptr, uintptr, uintptr, ui
 // The file chooser dialog will be set up to select multiple files.
 //
 // The file chooser dialog will initially be opened in the directory
-// [property@Gtk.FileDialog:initial-folder].
+// FileDialog:initial-folder.
 //
-// In contrast to [method@Gtk.FileDialog.open], this function
+// In contrast to [FileDialog.Open], this function
 // lets the user select the text encoding for the files, if possible.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) OpenMultipleTextFiles(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -301,11 +308,12 @@ func (x *FileDialog) OpenMultipleTextFiles(ParentVar *Window, CancellableVar *gi
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -315,9 +323,9 @@ func (x *FileDialog) OpenMultipleTextFiles(ParentVar *Window, CancellableVar *gi
 
 var xFileDialogOpenMultipleTextFilesFinish func(uintptr, uintptr, *string, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.open] call.
+// Finishes the [FileDialog.Open] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) OpenMultipleTextFilesFinish(ResultVar gio.AsyncResult, EncodingVar *string) (*gio.ListModelBase, error) {
 	var cls *gio.ListModelBase
@@ -326,12 +334,15 @@ func (x *FileDialog) OpenMultipleTextFilesFinish(ResultVar gio.AsyncResult, Enco
 	cret := xFileDialogOpenMultipleTextFilesFinish(x.GoPointer(), ResultVar.GoPointer(), EncodingVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.ListModelBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -342,10 +353,10 @@ var xFileDialogOpenTextFile func(uintptr, uintptr, uintptr, uintptr, uintptr)
 // Initiates a file selection operation by presenting a file chooser
 // dialog to the user.
 //
-// In contrast to [method@Gtk.FileDialog.open], this function
+// In contrast to [FileDialog.Open], this function
 // lets the user select the text encoding for the file, if possible.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) OpenTextFile(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -355,11 +366,12 @@ func (x *FileDialog) OpenTextFile(ParentVar *Window, CancellableVar *gio.Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -369,15 +381,15 @@ func (x *FileDialog) OpenTextFile(ParentVar *Window, CancellableVar *gio.Cancell
 
 var xFileDialogOpenTextFileFinish func(uintptr, uintptr, *string, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.open_text_file] call
+// Finishes the [FileDialog.OpenTextFile] call
 // and returns the resulting file and text encoding.
 //
 // If the user has explicitly selected a text encoding to use
-// for the file, then @encoding will be set to a codeset name that
+// for the file, then encoding will be set to a codeset name that
 // is suitable for passing to iconv_open(). Otherwise, it will
 // be `NULL`.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) OpenTextFileFinish(ResultVar gio.AsyncResult, EncodingVar *string) (*gio.FileBase, error) {
 	var cls *gio.FileBase
@@ -386,12 +398,15 @@ func (x *FileDialog) OpenTextFileFinish(ResultVar gio.AsyncResult, EncodingVar *
 	cret := xFileDialogOpenTextFileFinish(x.GoPointer(), ResultVar.GoPointer(), EncodingVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -403,7 +418,7 @@ var xFileDialogSave func(uintptr, uintptr, uintptr, uintptr, uintptr)
 //
 // The file chooser dialog will be save mode.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) Save(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -413,11 +428,12 @@ func (x *FileDialog) Save(ParentVar *Window, CancellableVar *gio.Cancellable, Ca
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -427,9 +443,9 @@ func (x *FileDialog) Save(ParentVar *Window, CancellableVar *gio.Cancellable, Ca
 
 var xFileDialogSaveFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.save] call.
+// Finishes the [FileDialog.Save] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) SaveFinish(ResultVar gio.AsyncResult) (*gio.FileBase, error) {
 	var cls *gio.FileBase
@@ -438,12 +454,15 @@ func (x *FileDialog) SaveFinish(ResultVar gio.AsyncResult) (*gio.FileBase, error
 	cret := xFileDialogSaveFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -454,11 +473,11 @@ var xFileDialogSaveTextFile func(uintptr, uintptr, uintptr, uintptr, uintptr)
 // Initiates a file save operation by presenting a file chooser
 // dialog to the user.
 //
-// In contrast to [method@Gtk.FileDialog.save], this function
+// In contrast to [FileDialog.Save], this function
 // lets the user select the text encoding and line endings for
 // the text file, if possible.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) SaveTextFile(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -468,11 +487,12 @@ func (x *FileDialog) SaveTextFile(ParentVar *Window, CancellableVar *gio.Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -482,18 +502,18 @@ func (x *FileDialog) SaveTextFile(ParentVar *Window, CancellableVar *gio.Cancell
 
 var xFileDialogSaveTextFileFinish func(uintptr, uintptr, *string, *string, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.save_text_file] call
+// Finishes the [FileDialog.SaveTextFile] call
 // and returns the resulting file, text encoding and line endings.
 //
 // If the user has explicitly selected a text encoding to use
-// for the file, then @encoding will be set to a codeset name that
+// for the file, then encoding will be set to a codeset name that
 // is suitable for passing to iconv_open(). Otherwise, it will
 // be `NULL`.
 //
-// The @line_ending will be set to one of "\n", "\r\n", "\r" or "",
+// The line_ending will be set to one of "\n", "\r\n", "\r" or "",
 // where the latter means to preserve existing line endings.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) SaveTextFileFinish(ResultVar gio.AsyncResult, EncodingVar *string, LineEndingVar *string) (*gio.FileBase, error) {
 	var cls *gio.FileBase
@@ -502,12 +522,15 @@ func (x *FileDialog) SaveTextFileFinish(ResultVar gio.AsyncResult, EncodingVar *
 	cret := xFileDialogSaveTextFileFinish(x.GoPointer(), ResultVar.GoPointer(), EncodingVar, LineEndingVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -519,11 +542,11 @@ var xFileDialogSelectFolder func(uintptr, uintptr, uintptr, uintptr, uintptr)
 //
 // The file chooser dialog will be set up to select a single folder.
 //
-// If you pass @initial_folder, the file chooser dialog will initially
+// If you pass initial_folder, the file chooser dialog will initially
 // be opened in the parent directory of that folder, otherwise, it
-// will be in the directory [property@Gtk.FileDialog:initial-folder].
+// will be in the directory FileDialog:initial-folder.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) SelectFolder(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -533,11 +556,12 @@ func (x *FileDialog) SelectFolder(ParentVar *Window, CancellableVar *gio.Cancell
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -547,9 +571,9 @@ func (x *FileDialog) SelectFolder(ParentVar *Window, CancellableVar *gio.Cancell
 
 var xFileDialogSelectFolderFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.select_folder] call.
+// Finishes the [FileDialog.SelectFolder] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) SelectFolderFinish(ResultVar gio.AsyncResult) (*gio.FileBase, error) {
 	var cls *gio.FileBase
@@ -558,12 +582,15 @@ func (x *FileDialog) SelectFolderFinish(ResultVar gio.AsyncResult) (*gio.FileBas
 	cret := xFileDialogSelectFolderFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.FileBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -577,9 +604,9 @@ var xFileDialogSelectMultipleFolders func(uintptr, uintptr, uintptr, uintptr, ui
 // multiple folders.
 //
 // The file chooser dialog will initially be opened in the
-// directory [property@Gtk.FileDialog:initial-folder].
+// directory FileDialog:initial-folder.
 //
-// The @callback will be called when the dialog is closed.
+// The callback will be called when the dialog is closed.
 func (x *FileDialog) SelectMultipleFolders(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -589,11 +616,12 @@ func (x *FileDialog) SelectMultipleFolders(ParentVar *Window, CancellableVar *gi
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -603,9 +631,9 @@ func (x *FileDialog) SelectMultipleFolders(ParentVar *Window, CancellableVar *gi
 
 var xFileDialogSelectMultipleFoldersFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FileDialog.select_multiple_folders] call.
+// Finishes the [FileDialog.SelectMultipleFolders] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FileDialog) SelectMultipleFoldersFinish(ResultVar gio.AsyncResult) (*gio.ListModelBase, error) {
 	var cls *gio.ListModelBase
@@ -614,12 +642,15 @@ func (x *FileDialog) SelectMultipleFoldersFinish(ResultVar gio.AsyncResult) (*gi
 	cret := xFileDialogSelectMultipleFoldersFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.ListModelBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -646,7 +677,7 @@ var xFileDialogSetDefaultFilter func(uintptr, uintptr)
 // Sets the filter that will be selected by default
 // in the file chooser dialog.
 //
-// If set to `NULL`, the first item in [property@Gtk.FileDialog:filters]
+// If set to `NULL`, the first item in FileDialog:filters
 // will be used as the default filter. If that list is empty, the dialog
 // will be unfiltered.
 func (x *FileDialog) SetDefaultFilter(FilterVar *FileFilter) {
@@ -671,9 +702,9 @@ var xFileDialogSetInitialFile func(uintptr, uintptr)
 // the file chooser dialog.
 //
 // This function is a shortcut for calling both
-// [method@Gtk.FileDialog.set_initial_folder] and
-// [method@Gtk.FileDialog.set_initial_name] with the
-// directory and name of @file, respectively.
+// [FileDialog.SetInitialFolder] and
+// [FileDialog.SetInitialName] with the
+// directory and name of file, respectively.
 func (x *FileDialog) SetInitialFile(FileVar gio.File) {
 
 	xFileDialogSetInitialFile(x.GoPointer(), FileVar.GoPointer())
@@ -694,11 +725,11 @@ var xFileDialogSetInitialName func(uintptr, uintptr)
 
 // Sets the filename that will be initially selected.
 //
-// For save dialogs, @name will usually be pre-entered into the
+// For save dialogs, name will usually be pre-entered into the
 // name field.
 //
 // If a file with this name already exists in the directory set
-// via [property@Gtk.FileDialog:initial-folder], the dialog will
+// via FileDialog:initial-folder, the dialog will
 // preselect it.
 func (x *FileDialog) SetInitialName(NameVar *string) {
 
@@ -816,7 +847,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -825,39 +856,41 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileDialogGLibType, libs, "gtk_file_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewFileDialog, libs, "gtk_file_dialog_new")
-
-	core.PuregoSafeRegister(&xFileDialogGetAcceptLabel, libs, "gtk_file_dialog_get_accept_label")
-	core.PuregoSafeRegister(&xFileDialogGetDefaultFilter, libs, "gtk_file_dialog_get_default_filter")
-	core.PuregoSafeRegister(&xFileDialogGetFilters, libs, "gtk_file_dialog_get_filters")
-	core.PuregoSafeRegister(&xFileDialogGetInitialFile, libs, "gtk_file_dialog_get_initial_file")
-	core.PuregoSafeRegister(&xFileDialogGetInitialFolder, libs, "gtk_file_dialog_get_initial_folder")
-	core.PuregoSafeRegister(&xFileDialogGetInitialName, libs, "gtk_file_dialog_get_initial_name")
-	core.PuregoSafeRegister(&xFileDialogGetModal, libs, "gtk_file_dialog_get_modal")
-	core.PuregoSafeRegister(&xFileDialogGetTitle, libs, "gtk_file_dialog_get_title")
-	core.PuregoSafeRegister(&xFileDialogOpen, libs, "gtk_file_dialog_open")
-	core.PuregoSafeRegister(&xFileDialogOpenFinish, libs, "gtk_file_dialog_open_finish")
-	core.PuregoSafeRegister(&xFileDialogOpenMultiple, libs, "gtk_file_dialog_open_multiple")
-	core.PuregoSafeRegister(&xFileDialogOpenMultipleFinish, libs, "gtk_file_dialog_open_multiple_finish")
-	core.PuregoSafeRegister(&xFileDialogOpenMultipleTextFiles, libs, "gtk_file_dialog_open_multiple_text_files")
-	core.PuregoSafeRegister(&xFileDialogOpenMultipleTextFilesFinish, libs, "gtk_file_dialog_open_multiple_text_files_finish")
-	core.PuregoSafeRegister(&xFileDialogOpenTextFile, libs, "gtk_file_dialog_open_text_file")
-	core.PuregoSafeRegister(&xFileDialogOpenTextFileFinish, libs, "gtk_file_dialog_open_text_file_finish")
-	core.PuregoSafeRegister(&xFileDialogSave, libs, "gtk_file_dialog_save")
-	core.PuregoSafeRegister(&xFileDialogSaveFinish, libs, "gtk_file_dialog_save_finish")
-	core.PuregoSafeRegister(&xFileDialogSaveTextFile, libs, "gtk_file_dialog_save_text_file")
-	core.PuregoSafeRegister(&xFileDialogSaveTextFileFinish, libs, "gtk_file_dialog_save_text_file_finish")
-	core.PuregoSafeRegister(&xFileDialogSelectFolder, libs, "gtk_file_dialog_select_folder")
-	core.PuregoSafeRegister(&xFileDialogSelectFolderFinish, libs, "gtk_file_dialog_select_folder_finish")
-	core.PuregoSafeRegister(&xFileDialogSelectMultipleFolders, libs, "gtk_file_dialog_select_multiple_folders")
-	core.PuregoSafeRegister(&xFileDialogSelectMultipleFoldersFinish, libs, "gtk_file_dialog_select_multiple_folders_finish")
-	core.PuregoSafeRegister(&xFileDialogSetAcceptLabel, libs, "gtk_file_dialog_set_accept_label")
-	core.PuregoSafeRegister(&xFileDialogSetDefaultFilter, libs, "gtk_file_dialog_set_default_filter")
-	core.PuregoSafeRegister(&xFileDialogSetFilters, libs, "gtk_file_dialog_set_filters")
-	core.PuregoSafeRegister(&xFileDialogSetInitialFile, libs, "gtk_file_dialog_set_initial_file")
-	core.PuregoSafeRegister(&xFileDialogSetInitialFolder, libs, "gtk_file_dialog_set_initial_folder")
-	core.PuregoSafeRegister(&xFileDialogSetInitialName, libs, "gtk_file_dialog_set_initial_name")
-	core.PuregoSafeRegister(&xFileDialogSetModal, libs, "gtk_file_dialog_set_modal")
-	core.PuregoSafeRegister(&xFileDialogSetTitle, libs, "gtk_file_dialog_set_title")
+	gobject.CheckClassSize("GtkFileDialogClass", xFileDialogGLibType, unsafe.Sizeof(FileDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewFileDialog, libs, "gtk_file_dialog_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xFileDialogGetAcceptLabel, libs, "gtk_file_dialog_get_accept_label", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetDefaultFilter, libs, "gtk_file_dialog_get_default_filter", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetFilters, libs, "gtk_file_dialog_get_filters", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetInitialFile, libs, "gtk_file_dialog_get_initial_file", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetInitialFolder, libs, "gtk_file_dialog_get_initial_folder", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetInitialName, libs, "gtk_file_dialog_get_initial_name", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetModal, libs, "gtk_file_dialog_get_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogGetTitle, libs, "gtk_file_dialog_get_title", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogOpen, libs, "gtk_file_dialog_open", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogOpenFinish, libs, "gtk_file_dialog_open_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogOpenMultiple, libs, "gtk_file_dialog_open_multiple", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogOpenMultipleFinish, libs, "gtk_file_dialog_open_multiple_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogOpenMultipleTextFiles, libs, "gtk_file_dialog_open_multiple_text_files", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogOpenMultipleTextFilesFinish, libs, "gtk_file_dialog_open_multiple_text_files_finish", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogOpenTextFile, libs, "gtk_file_dialog_open_text_file", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogOpenTextFileFinish, libs, "gtk_file_dialog_open_text_file_finish", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogSave, libs, "gtk_file_dialog_save", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSaveFinish, libs, "gtk_file_dialog_save_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSaveTextFile, libs, "gtk_file_dialog_save_text_file", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogSaveTextFileFinish, libs, "gtk_file_dialog_save_text_file_finish", "GTK", "4.18")
+	core.PuregoRegisterSince(&xFileDialogSelectFolder, libs, "gtk_file_dialog_select_folder", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSelectFolderFinish, libs, "gtk_file_dialog_select_folder_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSelectMultipleFolders, libs, "gtk_file_dialog_select_multiple_folders", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSelectMultipleFoldersFinish, libs, "gtk_file_dialog_select_multiple_folders_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetAcceptLabel, libs, "gtk_file_dialog_set_accept_label", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetDefaultFilter, libs, "gtk_file_dialog_set_default_filter", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetFilters, libs, "gtk_file_dialog_set_filters", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetInitialFile, libs, "gtk_file_dialog_set_initial_file", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetInitialFolder, libs, "gtk_file_dialog_set_initial_folder", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetInitialName, libs, "gtk_file_dialog_set_initial_name", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetModal, libs, "gtk_file_dialog_set_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileDialogSetTitle, libs, "gtk_file_dialog_set_title", "GTK", "4.10")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,8 +14,8 @@ import (
 //
 // `GtkFileFilter` can be used to restrict the files being shown in a
 // file chooser. Files can be filtered based on their name (with
-// [method@Gtk.FileFilter.add_pattern] or [method@Gtk.FileFilter.add_suffix])
-// or on their mime type (with [method@Gtk.FileFilter.add_mime_type]).
+// [FileFilter.AddPattern] or [FileFilter.AddSuffix])
+// or on their mime type (with [FileFilter.AddMimeType]).
 //
 // Filtering by mime types handles aliasing and subclassing of mime
 // types; e.g. a filter for text/plain also matches a file with mime
@@ -25,40 +24,37 @@ import (
 // subtype of a mime type, so you can e.g. filter for image/\*.
 //
 // Normally, file filters are used by adding them to a file chooser
-// (see [method@Gtk.FileDialog.set_filters]), but it is also possible to
-// manually use a file filter on any [class@Gtk.FilterListModel] containing
+// (see [FileDialog.SetFilters]), but it is also possible to
+// manually use a file filter on any [FilterListModel] containing
 // `GFileInfo` objects.
 //
 // # GtkFileFilter as GtkBuildable
 //
 // The `GtkFileFilter` implementation of the `GtkBuildable` interface
-// supports adding rules using the `&lt;mime-types&gt;` and `&lt;patterns&gt;` and
-// `&lt;suffixes&gt;` elements and listing the rules within. Specifying a
-// `&lt;mime-type&gt;` or `&lt;pattern&gt;` or `&lt;suffix&gt;` has the same effect as
+// supports adding rules using the `<mime-types>` and `<patterns>` and
+// `<suffixes>` elements and listing the rules within. Specifying a
+// `<mime-type>` or `<pattern>` or `<suffix>` has the same effect as
 // as calling
-// [method@Gtk.FileFilter.add_mime_type] or
-// [method@Gtk.FileFilter.add_pattern] or
-// [method@Gtk.FileFilter.add_suffix].
+// [FileFilter.AddMimeType] or
+// [FileFilter.AddPattern] or
+// [FileFilter.AddSuffix].
 //
 // An example of a UI definition fragment specifying `GtkFileFilter`
 // rules:
-// ```xml
-// &lt;object class="GtkFileFilter"&gt;
 //
-//	&lt;property name="name" translatable="yes"&gt;Text and Images&lt;/property&gt;
-//	&lt;mime-types&gt;
-//	  &lt;mime-type&gt;text/plain&lt;/mime-type&gt;
-//	  &lt;mime-type&gt;image/ *&lt;/mime-type&gt;
-//	&lt;/mime-types&gt;
-//	&lt;patterns&gt;
-//	  &lt;pattern&gt;*.txt&lt;/pattern&gt;
-//	&lt;/patterns&gt;
-//	&lt;suffixes&gt;
-//	  &lt;suffix&gt;png&lt;/suffix&gt;
-//	&lt;/suffixes&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkFileFilter">
+//	  <property name="name" translatable="yes">Text and Images</property>
+//	  <mime-types>
+//	    <mime-type>text/plain</mime-type>
+//	    <mime-type>image/ *</mime-type>
+//	  </mime-types>
+//	  <patterns>
+//	    <pattern>*.txt</pattern>
+//	  </patterns>
+//	  <suffixes>
+//	    <suffix>png</suffix>
+//	  </suffixes>
+//	</object>
 type FileFilter struct {
 	Filter
 }
@@ -81,16 +77,15 @@ var xNewFileFilter func() uintptr
 //
 // Such a filter doesn’t accept any files, so is not
 // particularly useful until you add rules with
-// [method@Gtk.FileFilter.add_mime_type],
-// [method@Gtk.FileFilter.add_pattern],
-// [method@Gtk.FileFilter.add_suffix] or
-// [method@Gtk.FileFilter.add_pixbuf_formats].
+// [FileFilter.AddMimeType],
+// [FileFilter.AddPattern],
+// [FileFilter.AddSuffix] or
+// [FileFilter.AddPixbufFormats].
 //
 // To create a filter that accepts any file, use:
-// ```c
-// GtkFileFilter *filter = gtk_file_filter_new ();
-// gtk_file_filter_add_pattern (filter, "*");
-// ```
+//
+//	GtkFileFilter *filter = gtk_file_filter_new ();
+//	gtk_file_filter_add_pattern (filter, "*");
 func NewFileFilter() *FileFilter {
 	var cls *FileFilter
 
@@ -109,7 +104,7 @@ var xNewFileFilterFromGvariant func(*glib.Variant) uintptr
 // Deserialize a file filter from a `GVariant`.
 //
 // The variant must be in the format produced by
-// [method@Gtk.FileFilter.to_gvariant].
+// [FileFilter.ToGvariant].
 func NewFileFilterFromGvariant(VariantVar *glib.Variant) *FileFilter {
 	var cls *FileFilter
 
@@ -145,18 +140,6 @@ func (x *FileFilter) AddPattern(PatternVar string) {
 
 }
 
-var xFileFilterAddPixbufFormats func(uintptr)
-
-// Adds a rule allowing image files in the formats supported by `GdkPixbuf`.
-//
-// This is equivalent to calling [method@Gtk.FileFilter.add_mime_type]
-// for all the supported mime types.
-func (x *FileFilter) AddPixbufFormats() {
-
-	xFileFilterAddPixbufFormats(x.GoPointer())
-
-}
-
 var xFileFilterAddSuffix func(uintptr, string)
 
 // Adds a suffix match rule to a filter.
@@ -164,9 +147,8 @@ var xFileFilterAddSuffix func(uintptr, string)
 // This is similar to adding a match for the pattern "*.@suffix"
 //
 // An exaple to filter files with the suffix ".sub":
-// ```c
-// gtk_file_filter_add_suffix (filter, "sub");
-// ```
+//
+//	gtk_file_filter_add_suffix (filter, "sub");
 //
 // Filters with multiple dots are allowed.
 //
@@ -195,7 +177,7 @@ var xFileFilterGetName func(uintptr) string
 
 // Gets the human-readable name for the filter.
 //
-// See [method@Gtk.FileFilter.set_name].
+// See [FileFilter.SetName].
 func (x *FileFilter) GetName() string {
 
 	cret := xFileFilterGetName(x.GoPointer())
@@ -287,10 +269,10 @@ func (x *FileFilter) SetPropertySuffixes(value []string) {
 	x.SetProperty("suffixes", &v)
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FileFilter) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -302,7 +284,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -311,16 +293,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileFilterGLibType, libs, "gtk_file_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewFileFilter, libs, "gtk_file_filter_new")
-	core.PuregoSafeRegister(&xNewFileFilterFromGvariant, libs, "gtk_file_filter_new_from_gvariant")
-
-	core.PuregoSafeRegister(&xFileFilterAddMimeType, libs, "gtk_file_filter_add_mime_type")
-	core.PuregoSafeRegister(&xFileFilterAddPattern, libs, "gtk_file_filter_add_pattern")
-	core.PuregoSafeRegister(&xFileFilterAddPixbufFormats, libs, "gtk_file_filter_add_pixbuf_formats")
-	core.PuregoSafeRegister(&xFileFilterAddSuffix, libs, "gtk_file_filter_add_suffix")
-	core.PuregoSafeRegister(&xFileFilterGetAttributes, libs, "gtk_file_filter_get_attributes")
-	core.PuregoSafeRegister(&xFileFilterGetName, libs, "gtk_file_filter_get_name")
-	core.PuregoSafeRegister(&xFileFilterSetName, libs, "gtk_file_filter_set_name")
-	core.PuregoSafeRegister(&xFileFilterToGvariant, libs, "gtk_file_filter_to_gvariant")
+	core.PuregoRegisterSince(&xNewFileFilter, libs, "gtk_file_filter_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewFileFilterFromGvariant, libs, "gtk_file_filter_new_from_gvariant", "GTK", "")
+
+	core.PuregoRegisterSince(&xFileFilterAddMimeType, libs, "gtk_file_filter_add_mime_type", "GTK", "")
+	core.PuregoRegisterSince(&xFileFilterAddPattern, libs, "gtk_file_filter_add_pattern", "GTK", "")
+	core.PuregoRegisterSince(&xFileFilterAddSuffix, libs, "gtk_file_filter_add_suffix", "GTK", "4.4")
+	core.PuregoRegisterSince(&xFileFilterGetAttributes, libs, "gtk_file_filter_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xFileFilterGetName, libs, "gtk_file_filter_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xFileFilterSetName, libs, "gtk_file_filter_set_name", "GTK", "")
+	core.PuregoRegisterSince(&xFileFilterToGvariant, libs, "gtk_file_filter_to_gvariant", "GTK", "")
 
 }
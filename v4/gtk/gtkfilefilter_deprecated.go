@@ -0,0 +1,40 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xFileFilterAddPixbufFormats func(uintptr)
+
+// Adds a rule allowing image files in the formats supported by `GdkPixbuf`.
+//
+// This is equivalent to calling [FileFilter.AddMimeType]
+// for all the supported mime types.
+//
+// Deprecated: since 4.20. Use the api of your image loading framework (e.g. glycin)
+//
+//	to enumerate supported formats
+func (x *FileFilter) AddPixbufFormats() {
+
+	xFileFilterAddPixbufFormats(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xFileFilterAddPixbufFormats, libs, "gtk_file_filter_add_pixbuf_formats", "GTK", "")
+
+}
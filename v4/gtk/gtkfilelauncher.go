@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -31,9 +30,9 @@ func (x *FileLauncherClass) GoPointer() uintptr {
 // may or may not show an app chooser dialog or launch the default application
 // right away.
 //
-// The operation is started with the [method@Gtk.FileLauncher.launch] function.
+// The operation is started with the [FileLauncher.Launch] function.
 //
-// To launch uris that don't represent files, use [class@Gtk.UriLauncher].
+// To launch uris that don't represent files, use [UriLauncher].
 type FileLauncher struct {
 	gobject.Object
 }
@@ -115,11 +114,12 @@ func (x *FileLauncher) Launch(ParentVar *Window, CancellableVar *gio.Cancellable
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -129,14 +129,14 @@ func (x *FileLauncher) Launch(ParentVar *Window, CancellableVar *gio.Cancellable
 
 var xFileLauncherLaunchFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes the [method@Gtk.FileLauncher.launch] call and
+// Finishes the [FileLauncher.Launch] call and
 // returns the result.
 func (x *FileLauncher) LaunchFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xFileLauncherLaunchFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -146,7 +146,7 @@ var xFileLauncherOpenContainingFolder func(uintptr, uintptr, uintptr, uintptr, u
 
 // Launches a file manager to show the file in its parent directory.
 //
-// This is only supported for native files. It will fail if @file
+// This is only supported for native files. It will fail if file
 // is e.g. a http:// uri.
 func (x *FileLauncher) OpenContainingFolder(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -157,11 +157,12 @@ func (x *FileLauncher) OpenContainingFolder(ParentVar *Window, CancellableVar *g
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -171,14 +172,14 @@ func (x *FileLauncher) OpenContainingFolder(ParentVar *Window, CancellableVar *g
 
 var xFileLauncherOpenContainingFolderFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes the [method@Gtk.FileLauncher.open_containing_folder]
+// Finishes the [FileLauncher.OpenContainingFolder]
 // call and returns the result.
 func (x *FileLauncher) OpenContainingFolderFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xFileLauncherOpenContainingFolderFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -266,7 +267,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -275,17 +276,19 @@ func init() {
 
 	core.PuregoSafeRegister(&xFileLauncherGLibType, libs, "gtk_file_launcher_get_type")
 
-	core.PuregoSafeRegister(&xNewFileLauncher, libs, "gtk_file_launcher_new")
-
-	core.PuregoSafeRegister(&xFileLauncherGetAlwaysAsk, libs, "gtk_file_launcher_get_always_ask")
-	core.PuregoSafeRegister(&xFileLauncherGetFile, libs, "gtk_file_launcher_get_file")
-	core.PuregoSafeRegister(&xFileLauncherGetWritable, libs, "gtk_file_launcher_get_writable")
-	core.PuregoSafeRegister(&xFileLauncherLaunch, libs, "gtk_file_launcher_launch")
-	core.PuregoSafeRegister(&xFileLauncherLaunchFinish, libs, "gtk_file_launcher_launch_finish")
-	core.PuregoSafeRegister(&xFileLauncherOpenContainingFolder, libs, "gtk_file_launcher_open_containing_folder")
-	core.PuregoSafeRegister(&xFileLauncherOpenContainingFolderFinish, libs, "gtk_file_launcher_open_containing_folder_finish")
-	core.PuregoSafeRegister(&xFileLauncherSetAlwaysAsk, libs, "gtk_file_launcher_set_always_ask")
-	core.PuregoSafeRegister(&xFileLauncherSetFile, libs, "gtk_file_launcher_set_file")
-	core.PuregoSafeRegister(&xFileLauncherSetWritable, libs, "gtk_file_launcher_set_writable")
+	gobject.CheckClassSize("GtkFileLauncherClass", xFileLauncherGLibType, unsafe.Sizeof(FileLauncherClass{}))
+
+	core.PuregoRegisterSince(&xNewFileLauncher, libs, "gtk_file_launcher_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xFileLauncherGetAlwaysAsk, libs, "gtk_file_launcher_get_always_ask", "GTK", "4.12")
+	core.PuregoRegisterSince(&xFileLauncherGetFile, libs, "gtk_file_launcher_get_file", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherGetWritable, libs, "gtk_file_launcher_get_writable", "GTK", "4.14")
+	core.PuregoRegisterSince(&xFileLauncherLaunch, libs, "gtk_file_launcher_launch", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherLaunchFinish, libs, "gtk_file_launcher_launch_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherOpenContainingFolder, libs, "gtk_file_launcher_open_containing_folder", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherOpenContainingFolderFinish, libs, "gtk_file_launcher_open_containing_folder_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherSetAlwaysAsk, libs, "gtk_file_launcher_set_always_ask", "GTK", "4.12")
+	core.PuregoRegisterSince(&xFileLauncherSetFile, libs, "gtk_file_launcher_set_file", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFileLauncherSetWritable, libs, "gtk_file_launcher_set_writable", "GTK", "4.14")
 
 }
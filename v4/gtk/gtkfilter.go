@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -47,7 +47,8 @@ func (x *FilterClass) OverrideMatch(cb func(*Filter, *gobject.Object) bool) {
 	if cb == nil {
 		x.xMatch = 0
 	} else {
-		x.xMatch = purego.NewCallback(func(SelfVarp uintptr, ItemVarp uintptr) bool {
+		x.xMatch = core.NewCallback(func(SelfVarp uintptr, ItemVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FilterNewFromInternalPtr(SelfVarp), gobject.ObjectNewFromInternalPtr(ItemVarp))
 		})
 	}
@@ -59,7 +60,7 @@ func (x *FilterClass) GetMatch() func(*Filter, *gobject.Object) bool {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ItemVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xMatch)
+	core.RegisterFunc(&rawCallback, x.xMatch)
 	return func(SelfVar *Filter, ItemVar *gobject.Object) bool {
 		return rawCallback(SelfVar.GoPointer(), ItemVar.GoPointer())
 	}
@@ -70,7 +71,8 @@ func (x *FilterClass) OverrideGetStrictness(cb func(*Filter) FilterMatch) {
 	if cb == nil {
 		x.xGetStrictness = 0
 	} else {
-		x.xGetStrictness = purego.NewCallback(func(SelfVarp uintptr) FilterMatch {
+		x.xGetStrictness = core.NewCallback(func(SelfVarp uintptr) FilterMatch {
+			defer core.RecoverPanic()
 			return cb(FilterNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -82,7 +84,7 @@ func (x *FilterClass) GetGetStrictness() func(*Filter) FilterMatch {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) FilterMatch
-	purego.RegisterFunc(&rawCallback, x.xGetStrictness)
+	core.RegisterFunc(&rawCallback, x.xGetStrictness)
 	return func(SelfVar *Filter) FilterMatch {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -93,7 +95,8 @@ func (x *FilterClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -105,7 +108,7 @@ func (x *FilterClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -116,7 +119,8 @@ func (x *FilterClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -128,7 +132,7 @@ func (x *FilterClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -139,7 +143,8 @@ func (x *FilterClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -151,7 +156,7 @@ func (x *FilterClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -162,7 +167,8 @@ func (x *FilterClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -174,7 +180,7 @@ func (x *FilterClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -185,7 +191,8 @@ func (x *FilterClass) OverrideGtkReserved5(cb func()) {
 	if cb == nil {
 		x.xGtkReserved5 = 0
 	} else {
-		x.xGtkReserved5 = purego.NewCallback(func() {
+		x.xGtkReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -197,7 +204,7 @@ func (x *FilterClass) GetGtkReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved5)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -208,7 +215,8 @@ func (x *FilterClass) OverrideGtkReserved6(cb func()) {
 	if cb == nil {
 		x.xGtkReserved6 = 0
 	} else {
-		x.xGtkReserved6 = purego.NewCallback(func() {
+		x.xGtkReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -220,7 +228,7 @@ func (x *FilterClass) GetGtkReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved6)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -231,7 +239,8 @@ func (x *FilterClass) OverrideGtkReserved7(cb func()) {
 	if cb == nil {
 		x.xGtkReserved7 = 0
 	} else {
-		x.xGtkReserved7 = purego.NewCallback(func() {
+		x.xGtkReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -243,7 +252,7 @@ func (x *FilterClass) GetGtkReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved7)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -254,7 +263,8 @@ func (x *FilterClass) OverrideGtkReserved8(cb func()) {
 	if cb == nil {
 		x.xGtkReserved8 = 0
 	} else {
-		x.xGtkReserved8 = purego.NewCallback(func() {
+		x.xGtkReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -266,7 +276,7 @@ func (x *FilterClass) GetGtkReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved8)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -301,23 +311,80 @@ const (
 	//   it was before: All items that it used to return false
 	//   still return false, others now may, too.
 	FilterChangeMoreStrictValue FilterChange = 2
-	// Similar to [enum@Gtk.FilterChange.DIFFERENT],
+	// Similar to FilterChange.DIFFERENT,
 	// but signs that item watches should be recreated. This is used by
-	// [class@Gtk.FilterListModel] to keep the list up-to-date when items
+	// [FilterListModel] to keep the list up-to-date when items
 	// change.
 	FilterChangeDifferentRewatchValue FilterChange = 3
-	// Similar to [enum@Gtk.FilterChange.LESS_STRICT],
+	// Similar to FilterChange.LESS_STRICT,
 	// but signs that item watches should be recreated. This is used by
-	// [class@Gtk.FilterListModel] to keep the list up-to-date when items
+	// [FilterListModel] to keep the list up-to-date when items
 	// change.
 	FilterChangeLessStrictRewatchValue FilterChange = 4
-	// Similar to [enum@Gtk.FilterChange.MORE_STRICT],
+	// Similar to FilterChange.MORE_STRICT,
 	// but signs that item watches should be recreated. This is used by
-	// [class@Gtk.FilterListModel] to keep the list up-to-date when items
+	// [FilterListModel] to keep the list up-to-date when items
 	// change.
 	FilterChangeMoreStrictRewatchValue FilterChange = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FilterChange) String() string {
+	switch x {
+	case FilterChangeDifferentValue:
+		return "GTK_FILTER_CHANGE_DIFFERENT"
+	case FilterChangeLessStrictValue:
+		return "GTK_FILTER_CHANGE_LESS_STRICT"
+	case FilterChangeMoreStrictValue:
+		return "GTK_FILTER_CHANGE_MORE_STRICT"
+	case FilterChangeDifferentRewatchValue:
+		return "GTK_FILTER_CHANGE_DIFFERENT_REWATCH"
+	case FilterChangeLessStrictRewatchValue:
+		return "GTK_FILTER_CHANGE_LESS_STRICT_REWATCH"
+	case FilterChangeMoreStrictRewatchValue:
+		return "GTK_FILTER_CHANGE_MORE_STRICT_REWATCH"
+	}
+	return fmt.Sprintf("FilterChange(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FilterChange) Nick() string {
+	switch x {
+	case FilterChangeDifferentValue:
+		return "different"
+	case FilterChangeLessStrictValue:
+		return "less-strict"
+	case FilterChangeMoreStrictValue:
+		return "more-strict"
+	case FilterChangeDifferentRewatchValue:
+		return "different-rewatch"
+	case FilterChangeLessStrictRewatchValue:
+		return "less-strict-rewatch"
+	case FilterChangeMoreStrictRewatchValue:
+		return "more-strict-rewatch"
+	}
+	return ""
+}
+
+// FilterChangeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FilterChangeFromString(s string) (FilterChange, bool) {
+	switch s {
+	case "GTK_FILTER_CHANGE_DIFFERENT", "different":
+		return FilterChangeDifferentValue, true
+	case "GTK_FILTER_CHANGE_LESS_STRICT", "less-strict":
+		return FilterChangeLessStrictValue, true
+	case "GTK_FILTER_CHANGE_MORE_STRICT", "more-strict":
+		return FilterChangeMoreStrictValue, true
+	case "GTK_FILTER_CHANGE_DIFFERENT_REWATCH", "different-rewatch":
+		return FilterChangeDifferentRewatchValue, true
+	case "GTK_FILTER_CHANGE_LESS_STRICT_REWATCH", "less-strict-rewatch":
+		return FilterChangeLessStrictRewatchValue, true
+	case "GTK_FILTER_CHANGE_MORE_STRICT_REWATCH", "more-strict-rewatch":
+		return FilterChangeMoreStrictRewatchValue, true
+	}
+	return 0, false
+}
+
 // Describes the known strictness of a filter.
 //
 // Note that for filters where the strictness is not known,
@@ -334,26 +401,65 @@ func FilterMatchGLibType() types.GType {
 const (
 
 	// The filter matches some items,
-	//   [method@Gtk.Filter.match] may return true or false
+	//   [Filter.Match] may return true or false
 	FilterMatchSomeValue FilterMatch = 0
 	// The filter does not match any item,
-	//   [method@Gtk.Filter.match] will always return false
+	//   [Filter.Match] will always return false
 	FilterMatchNoneValue FilterMatch = 1
 	// The filter matches all items,
-	//   [method@Gtk.Filter.match] will alays return true
+	//   [Filter.Match] will alays return true
 	FilterMatchAllValue FilterMatch = 2
 )
 
-// Describes the filtering to be performed by a [class@Gtk.FilterListModel].
+// String returns the C name of the value, e.g. for debugging output
+func (x FilterMatch) String() string {
+	switch x {
+	case FilterMatchSomeValue:
+		return "GTK_FILTER_MATCH_SOME"
+	case FilterMatchNoneValue:
+		return "GTK_FILTER_MATCH_NONE"
+	case FilterMatchAllValue:
+		return "GTK_FILTER_MATCH_ALL"
+	}
+	return fmt.Sprintf("FilterMatch(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FilterMatch) Nick() string {
+	switch x {
+	case FilterMatchSomeValue:
+		return "some"
+	case FilterMatchNoneValue:
+		return "none"
+	case FilterMatchAllValue:
+		return "all"
+	}
+	return ""
+}
+
+// FilterMatchFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FilterMatchFromString(s string) (FilterMatch, bool) {
+	switch s {
+	case "GTK_FILTER_MATCH_SOME", "some":
+		return FilterMatchSomeValue, true
+	case "GTK_FILTER_MATCH_NONE", "none":
+		return FilterMatchNoneValue, true
+	case "GTK_FILTER_MATCH_ALL", "all":
+		return FilterMatchAllValue, true
+	}
+	return 0, false
+}
+
+// Describes the filtering to be performed by a [FilterListModel].
 //
 // The model will use the filter to determine if it should include items
-// or not by calling [method@Gtk.Filter.match] for each item and only
+// or not by calling [Filter.Match] for each item and only
 // keeping the ones that the function returns true for.
 //
 // Filters may change what items they match through their lifetime. In that
-// case, they will emit the [signal@Gtk.Filter::changed] signal to notify
+// case, they will emit the [Filter.ConnectChangedFunc] signal to notify
 // that previous filter results are no longer valid and that items should
-// be checked again via [method@Gtk.Filter.match].
+// be checked again via [Filter.Match].
 //
 // GTK provides various pre-made filter implementations for common filtering
 // operations. These filters often include properties that can be linked to
@@ -381,12 +487,12 @@ var xFilterChanged func(uintptr, FilterChange)
 
 // Notifies all users of the filter that it has changed.
 //
-// This emits the [signal@Gtk.Filter::changed] signal. Users
+// This emits the [Filter.ConnectChangedFunc] signal. Users
 // of the filter should then check items again via
-// [method@Gtk.Filter.match].
+// [Filter.Match].
 //
-// Depending on the @change parameter, not all items need to
-// be changed, but only some. Refer to the [enum@Gtk.FilterChange]
+// Depending on the change parameter, not all items need to
+// be changed, but only some. Refer to the [FilterChange]
 // documentation for details.
 //
 // This function is intended for implementers of `GtkFilter`
@@ -401,9 +507,9 @@ var xFilterGetStrictness func(uintptr) FilterMatch
 
 // Gets the known strictness of a filter.
 //
-// If the strictness is not known, [enum@Gtk.FilterMatch.some] is returned.
+// If the strictness is not known, [FilterMatchSomeValue] is returned.
 //
-// This value may change after emission of the [signal@Gtk.Filter::changed]
+// This value may change after emission of the [Filter.ConnectChangedFunc]
 // signal.
 //
 // This function is meant purely for optimization purposes. Filters can
@@ -416,7 +522,7 @@ func (x *Filter) GetStrictness() FilterMatch {
 
 var xFilterMatch func(uintptr, uintptr) bool
 
-// Checks if the given @item is matched by the filter or not.
+// Checks if the given item is matched by the filter or not.
 func (x *Filter) Match(ItemVar *gobject.Object) bool {
 
 	cret := xFilterMatch(x.GoPointer(), ItemVar.GoPointer())
@@ -437,34 +543,56 @@ func (c *Filter) SetGoPointer(ptr uintptr) {
 // Emitted whenever the filter changed.
 //
 // Users of the filter should then check items again via
-// [method@Gtk.Filter.match].
+// [Filter.Match].
 //
 // `GtkFilterListModel` handles this signal automatically.
 //
-// Depending on the @change parameter, not all items need
-// to be checked, but only some. Refer to the [enum@Gtk.FilterChange]
+// Depending on the change parameter, not all items need
+// to be checked, but only some. Refer to the [FilterChange]
 // documentation for details.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Filter) ConnectChanged(cb *func(Filter, FilterChange)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChangeVarp FilterChange) {
+			defer core.RecoverPanic()
+			fa := Filter{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChangeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ChangeVarp FilterChange) {
+var xFilterChangedTrampoline uintptr
+
+func xFilterChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChangeVarp FilterChange, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Filter, FilterChange))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Filter{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChangeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Filter) ConnectChangedFunc(cb func(Filter, FilterChange)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xFilterChangedTrampoline, xFilterChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -472,7 +600,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -485,8 +613,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xFilterGLibType, libs, "gtk_filter_get_type")
 
-	core.PuregoSafeRegister(&xFilterChanged, libs, "gtk_filter_changed")
-	core.PuregoSafeRegister(&xFilterGetStrictness, libs, "gtk_filter_get_strictness")
-	core.PuregoSafeRegister(&xFilterMatch, libs, "gtk_filter_match")
+	gobject.CheckClassSize("GtkFilterClass", xFilterGLibType, unsafe.Sizeof(FilterClass{}))
+
+	core.PuregoRegisterSince(&xFilterChanged, libs, "gtk_filter_changed", "GTK", "")
+	core.PuregoRegisterSince(&xFilterGetStrictness, libs, "gtk_filter_get_strictness", "GTK", "")
+	core.PuregoRegisterSince(&xFilterMatch, libs, "gtk_filter_match", "GTK", "")
 
 }
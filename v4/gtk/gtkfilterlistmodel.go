@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -29,7 +28,7 @@ func (x *FilterListModelClass) GoPointer() uintptr {
 //
 // The model can be set up to do incremental filtering, so that
 // filtering long lists doesn't block the UI. See
-// [method@Gtk.FilterListModel.set_incremental] for details.
+// [FilterListModel.SetIncremental] for details.
 //
 // `GtkFilterListModel` passes through sections from the underlying model.
 type FilterListModel struct {
@@ -50,8 +49,8 @@ func FilterListModelNewFromInternalPtr(ptr uintptr) *FilterListModel {
 
 var xNewFilterListModel func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkFilterListModel` that will filter @model using the given
-// @filter.
+// Creates a new `GtkFilterListModel` that will filter model using the given
+// filter.
 func NewFilterListModel(ModelVar gio.ListModel, FilterVar *Filter) *FilterListModel {
 	var cls *FilterListModel
 
@@ -67,7 +66,7 @@ func NewFilterListModel(ModelVar gio.ListModel, FilterVar *Filter) *FilterListMo
 
 var xFilterListModelGetFilter func(uintptr) uintptr
 
-// Gets the `GtkFilter` currently set on @self.
+// Gets the `GtkFilter` currently set on self.
 func (x *FilterListModel) GetFilter() *Filter {
 	var cls *Filter
 
@@ -86,7 +85,7 @@ var xFilterListModelGetIncremental func(uintptr) bool
 
 // Returns whether incremental filtering is enabled.
 //
-// See [method@Gtk.FilterListModel.set_incremental].
+// See [FilterListModel.SetIncremental].
 func (x *FilterListModel) GetIncremental() bool {
 
 	cret := xFilterListModelGetIncremental(x.GoPointer())
@@ -95,7 +94,7 @@ func (x *FilterListModel) GetIncremental() bool {
 
 var xFilterListModelGetModel func(uintptr) uintptr
 
-// Gets the model currently filtered or %NULL if none.
+// Gets the model currently filtered or nil if none.
 func (x *FilterListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -114,19 +113,17 @@ var xFilterListModelGetPending func(uintptr) uint
 
 // Returns the number of items that have not been filtered yet.
 //
-// You can use this value to check if @self is busy filtering by
+// You can use this value to check if self is busy filtering by
 // comparing the return value to 0 or you can compute the percentage
 // of the filter remaining by dividing the return value by the total
 // number of items in the underlying model:
 //
-// ```c
-// pending = gtk_filter_list_model_get_pending (self);
-// model = gtk_filter_list_model_get_model (self);
-// percentage = pending / (double) g_list_model_get_n_items (model);
-// ```
+//	pending = gtk_filter_list_model_get_pending (self);
+//	model = gtk_filter_list_model_get_model (self);
+//	percentage = pending / (double) g_list_model_get_n_items (model);
 //
 // If no filter operation is ongoing - in particular when
-// [property@Gtk.FilterListModel:incremental] is %FALSE - this
+// [FilterListModel.GetPropertyIncremental] is false - this
 // function returns 0.
 func (x *FilterListModel) GetPending() uint {
 
@@ -138,7 +135,7 @@ var xFilterListModelGetWatchItems func(uintptr) bool
 
 // Returns whether watching items is enabled.
 //
-// See [method@Gtk.FilterListModel.set_watch_items].
+// See [FilterListModel.SetWatchItems].
 func (x *FilterListModel) GetWatchItems() bool {
 
 	cret := xFilterListModelGetWatchItems(x.GoPointer())
@@ -170,7 +167,7 @@ var xFilterListModelSetIncremental func(uintptr, bool)
 //
 // By default, incremental filtering is disabled.
 //
-// See [method@Gtk.FilterListModel.get_pending] for progress information
+// See [FilterListModel.GetPending] for progress information
 // about an ongoing incremental filtering operation.
 func (x *FilterListModel) SetIncremental(IncrementalVar bool) {
 
@@ -182,8 +179,8 @@ var xFilterListModelSetModel func(uintptr, uintptr)
 
 // Sets the model to be filtered.
 //
-// Note that GTK makes no effort to ensure that @model conforms to
-// the item type of @self. It assumes that the caller knows what they
+// Note that GTK makes no effort to ensure that model conforms to
+// the item type of self. It assumes that the caller knows what they
 // are doing and have set up an appropriate filter to ensure that item
 // types match.
 func (x *FilterListModel) SetModel(ModelVar gio.ListModel) {
@@ -196,7 +193,7 @@ var xFilterListModelSetWatchItems func(uintptr, bool)
 
 // Sets the filter model to monitor properties of its items.
 //
-// This allows implementations of [class@Gtk.Filter] that support expression
+// This allows implementations of [Filter] that support expression
 // watching to react to property changes. This property has no effect if the
 // current filter doesn't support watching items.
 //
@@ -236,7 +233,7 @@ func (x *FilterListModel) GetPropertyIncremental() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *FilterListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -268,28 +265,30 @@ func (x *FilterListModel) GetPropertyWatchItems() bool {
 	return v.GetBoolean()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *FilterListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *FilterListModel) GetItemType() types.GType {
 
@@ -297,29 +296,29 @@ func (x *FilterListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *FilterListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *FilterListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -333,20 +332,20 @@ func (x *FilterListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -370,20 +369,20 @@ func (x *FilterListModel) GetSection(PositionVar uint, OutStartVar *uint, OutEnd
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *FilterListModel) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -396,7 +395,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -405,16 +404,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xFilterListModelGLibType, libs, "gtk_filter_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewFilterListModel, libs, "gtk_filter_list_model_new")
-
-	core.PuregoSafeRegister(&xFilterListModelGetFilter, libs, "gtk_filter_list_model_get_filter")
-	core.PuregoSafeRegister(&xFilterListModelGetIncremental, libs, "gtk_filter_list_model_get_incremental")
-	core.PuregoSafeRegister(&xFilterListModelGetModel, libs, "gtk_filter_list_model_get_model")
-	core.PuregoSafeRegister(&xFilterListModelGetPending, libs, "gtk_filter_list_model_get_pending")
-	core.PuregoSafeRegister(&xFilterListModelGetWatchItems, libs, "gtk_filter_list_model_get_watch_items")
-	core.PuregoSafeRegister(&xFilterListModelSetFilter, libs, "gtk_filter_list_model_set_filter")
-	core.PuregoSafeRegister(&xFilterListModelSetIncremental, libs, "gtk_filter_list_model_set_incremental")
-	core.PuregoSafeRegister(&xFilterListModelSetModel, libs, "gtk_filter_list_model_set_model")
-	core.PuregoSafeRegister(&xFilterListModelSetWatchItems, libs, "gtk_filter_list_model_set_watch_items")
+	gobject.CheckClassSize("GtkFilterListModelClass", xFilterListModelGLibType, unsafe.Sizeof(FilterListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewFilterListModel, libs, "gtk_filter_list_model_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xFilterListModelGetFilter, libs, "gtk_filter_list_model_get_filter", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelGetIncremental, libs, "gtk_filter_list_model_get_incremental", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelGetModel, libs, "gtk_filter_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelGetPending, libs, "gtk_filter_list_model_get_pending", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelGetWatchItems, libs, "gtk_filter_list_model_get_watch_items", "GTK", "4.20")
+	core.PuregoRegisterSince(&xFilterListModelSetFilter, libs, "gtk_filter_list_model_set_filter", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelSetIncremental, libs, "gtk_filter_list_model_set_incremental", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelSetModel, libs, "gtk_filter_list_model_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xFilterListModelSetWatchItems, libs, "gtk_filter_list_model_set_watch_items", "GTK", "4.20")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -99,7 +98,7 @@ var xFixedGetChildPosition func(uintptr, uintptr, *float64, *float64)
 // Retrieves the translation transformation of the
 // given child `GtkWidget` in the `GtkFixed`.
 //
-// See also: [method@Gtk.Fixed.get_child_transform].
+// See also: [Fixed.GetChildTransform].
 func (x *Fixed) GetChildPosition(WidgetVar *Widget, XVar *float64, YVar *float64) {
 
 	xFixedGetChildPosition(x.GoPointer(), WidgetVar.GoPointer(), XVar, YVar)
@@ -108,8 +107,8 @@ func (x *Fixed) GetChildPosition(WidgetVar *Widget, XVar *float64, YVar *float64
 
 var xFixedGetChildTransform func(uintptr, uintptr) *gsk.Transform
 
-// Retrieves the transformation for @widget set using
-// gtk_fixed_set_child_transform().
+// Retrieves the transformation for widget set using
+// [Fixed.SetChildTransform].
 func (x *Fixed) GetChildTransform(WidgetVar *Widget) *gsk.Transform {
 
 	cret := xFixedGetChildTransform(x.GoPointer(), WidgetVar.GoPointer())
@@ -118,8 +117,8 @@ func (x *Fixed) GetChildTransform(WidgetVar *Widget) *gsk.Transform {
 
 var xFixedMove func(uintptr, uintptr, float64, float64)
 
-// Sets a translation transformation to the given @x and @y
-// coordinates to the child @widget of the `GtkFixed`.
+// Sets a translation transformation to the given x and y
+// coordinates to the child widget of the `GtkFixed`.
 func (x *Fixed) Move(WidgetVar *Widget, XVar float64, YVar float64) {
 
 	xFixedMove(x.GoPointer(), WidgetVar.GoPointer(), XVar, YVar)
@@ -137,7 +136,7 @@ func (x *Fixed) Put(WidgetVar *Widget, XVar float64, YVar float64) {
 
 var xFixedRemove func(uintptr, uintptr)
 
-// Removes a child from @fixed.
+// Removes a child from fixed.
 func (x *Fixed) Remove(WidgetVar *Widget) {
 
 	xFixedRemove(x.GoPointer(), WidgetVar.GoPointer())
@@ -146,11 +145,11 @@ func (x *Fixed) Remove(WidgetVar *Widget) {
 
 var xFixedSetChildTransform func(uintptr, uintptr, *gsk.Transform)
 
-// Sets the transformation for @widget.
+// Sets the transformation for widget.
 //
 // This is a convenience function that retrieves the
-// [class@Gtk.FixedLayoutChild] instance associated to
-// @widget and calls [method@Gtk.FixedLayoutChild.set_transform].
+// [FixedLayoutChild] instance associated to
+// widget and calls [FixedLayoutChild.SetTransform].
 func (x *Fixed) SetChildTransform(WidgetVar *Widget, TransformVar *gsk.Transform) {
 
 	xFixedSetChildTransform(x.GoPointer(), WidgetVar.GoPointer(), TransformVar)
@@ -329,7 +328,7 @@ func (x *Fixed) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -337,14 +336,13 @@ func (x *Fixed) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Fixed) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -368,19 +366,17 @@ func (x *Fixed) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Fixed) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -401,7 +397,7 @@ func (x *Fixed) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -410,14 +406,12 @@ func (x *Fixed) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Fixed) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -436,10 +430,10 @@ func (x *Fixed) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Fixed) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -451,7 +445,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -460,13 +454,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xFixedGLibType, libs, "gtk_fixed_get_type")
 
-	core.PuregoSafeRegister(&xNewFixed, libs, "gtk_fixed_new")
+	gobject.CheckClassSize("GtkFixedClass", xFixedGLibType, unsafe.Sizeof(FixedClass{}))
+
+	core.PuregoRegisterSince(&xNewFixed, libs, "gtk_fixed_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xFixedGetChildPosition, libs, "gtk_fixed_get_child_position")
-	core.PuregoSafeRegister(&xFixedGetChildTransform, libs, "gtk_fixed_get_child_transform")
-	core.PuregoSafeRegister(&xFixedMove, libs, "gtk_fixed_move")
-	core.PuregoSafeRegister(&xFixedPut, libs, "gtk_fixed_put")
-	core.PuregoSafeRegister(&xFixedRemove, libs, "gtk_fixed_remove")
-	core.PuregoSafeRegister(&xFixedSetChildTransform, libs, "gtk_fixed_set_child_transform")
+	core.PuregoRegisterSince(&xFixedGetChildPosition, libs, "gtk_fixed_get_child_position", "GTK", "")
+	core.PuregoRegisterSince(&xFixedGetChildTransform, libs, "gtk_fixed_get_child_transform", "GTK", "")
+	core.PuregoRegisterSince(&xFixedMove, libs, "gtk_fixed_move", "GTK", "")
+	core.PuregoRegisterSince(&xFixedPut, libs, "gtk_fixed_put", "GTK", "")
+	core.PuregoRegisterSince(&xFixedRemove, libs, "gtk_fixed_remove", "GTK", "")
+	core.PuregoRegisterSince(&xFixedSetChildTransform, libs, "gtk_fixed_set_child_transform", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -173,7 +172,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -182,11 +181,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xFixedLayoutGLibType, libs, "gtk_fixed_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewFixedLayout, libs, "gtk_fixed_layout_new")
+	gobject.CheckClassSize("GtkFixedLayoutClass", xFixedLayoutGLibType, unsafe.Sizeof(FixedLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewFixedLayout, libs, "gtk_fixed_layout_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xFixedLayoutChildGLibType, libs, "gtk_fixed_layout_child_get_type")
 
-	core.PuregoSafeRegister(&xFixedLayoutChildGetTransform, libs, "gtk_fixed_layout_child_get_transform")
-	core.PuregoSafeRegister(&xFixedLayoutChildSetTransform, libs, "gtk_fixed_layout_child_set_transform")
+	gobject.CheckClassSize("GtkFixedLayoutChildClass", xFixedLayoutChildGLibType, unsafe.Sizeof(FixedLayoutChildClass{}))
+
+	core.PuregoRegisterSince(&xFixedLayoutChildGetTransform, libs, "gtk_fixed_layout_child_get_transform", "GTK", "")
+	core.PuregoRegisterSince(&xFixedLayoutChildSetTransform, libs, "gtk_fixed_layout_child_set_transform", "GTK", "")
 
 }
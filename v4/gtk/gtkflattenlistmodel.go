@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -44,7 +43,7 @@ func FlattenListModelNewFromInternalPtr(ptr uintptr) *FlattenListModel {
 
 var xNewFlattenListModel func(uintptr) uintptr
 
-// Creates a new `GtkFlattenListModel` that flattens @list.
+// Creates a new `GtkFlattenListModel` that flattens list.
 func NewFlattenListModel(ModelVar gio.ListModel) *FlattenListModel {
 	var cls *FlattenListModel
 
@@ -60,7 +59,7 @@ func NewFlattenListModel(ModelVar gio.ListModel) *FlattenListModel {
 
 var xFlattenListModelGetModel func(uintptr) uintptr
 
-// Gets the model set via gtk_flatten_list_model_set_model().
+// Gets the model set via [FlattenListModel.SetModel].
 func (x *FlattenListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -113,35 +112,37 @@ func (c *FlattenListModel) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *FlattenListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *FlattenListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *FlattenListModel) GetItemType() types.GType {
 
@@ -149,29 +150,29 @@ func (x *FlattenListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *FlattenListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *FlattenListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -185,20 +186,20 @@ func (x *FlattenListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -222,20 +223,20 @@ func (x *FlattenListModel) GetSection(PositionVar uint, OutStartVar *uint, OutEn
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *FlattenListModel) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -248,7 +249,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -257,10 +258,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xFlattenListModelGLibType, libs, "gtk_flatten_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewFlattenListModel, libs, "gtk_flatten_list_model_new")
+	gobject.CheckClassSize("GtkFlattenListModelClass", xFlattenListModelGLibType, unsafe.Sizeof(FlattenListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewFlattenListModel, libs, "gtk_flatten_list_model_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xFlattenListModelGetModel, libs, "gtk_flatten_list_model_get_model")
-	core.PuregoSafeRegister(&xFlattenListModelGetModelForItem, libs, "gtk_flatten_list_model_get_model_for_item")
-	core.PuregoSafeRegister(&xFlattenListModelSetModel, libs, "gtk_flatten_list_model_set_model")
+	core.PuregoRegisterSince(&xFlattenListModelGetModel, libs, "gtk_flatten_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xFlattenListModelGetModelForItem, libs, "gtk_flatten_list_model_get_model_for_item", "GTK", "")
+	core.PuregoRegisterSince(&xFlattenListModelSetModel, libs, "gtk_flatten_list_model_set_model", "GTK", "")
 
 }
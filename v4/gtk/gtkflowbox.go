@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -24,9 +23,9 @@ type FlowBoxCreateWidgetFunc func(uintptr, uintptr) uintptr
 // It lets you control if the child should be visible or not.
 type FlowBoxFilterFunc func(uintptr, uintptr) bool
 
-// A function used by gtk_flow_box_selected_foreach().
+// A function used by [FlowBox.SelectedForeach].
 //
-// It will be called on every selected child of the @box.
+// It will be called on every selected child of the box.
 type FlowBoxForeachFunc func(uintptr, uintptr, uintptr)
 
 // A function to compare two children to determine which
@@ -52,7 +51,8 @@ func (x *FlowBoxChildClass) OverrideActivate(cb func(*FlowBoxChild)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(ChildVarp uintptr) {
+		x.xActivate = core.NewCallback(func(ChildVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FlowBoxChildNewFromInternalPtr(ChildVarp))
 		})
 	}
@@ -64,7 +64,7 @@ func (x *FlowBoxChildClass) GetActivate() func(*FlowBoxChild) {
 		return nil
 	}
 	var rawCallback func(ChildVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(ChildVar *FlowBoxChild) {
 		rawCallback(ChildVar.GoPointer())
 	}
@@ -72,13 +72,6 @@ func (x *FlowBoxChildClass) GetActivate() func(*FlowBoxChild) {
 
 // Puts child widgets in a reflowing grid.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="flow-box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkFlowBox" src="flow-box.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // For instance, with the horizontal orientation, the widgets will be
 // arranged from left to right, starting a new row under the previous
 // row when necessary. Reducing the width in this case will require more
@@ -97,32 +90,30 @@ func (x *FlowBoxChildClass) GetActivate() func(*FlowBoxChild) {
 // The children of a `GtkFlowBox` can be dynamically sorted and filtered.
 //
 // Although a `GtkFlowBox` must have only `GtkFlowBoxChild` children, you
-// can add any kind of widget to it via [method@Gtk.FlowBox.insert], and a
+// can add any kind of widget to it via [FlowBox.Insert], and a
 // `GtkFlowBoxChild` widget will automatically be inserted between the box
 // and the widget.
 //
-// Also see [class@Gtk.ListBox].
+// Also see [ListBox].
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.FlowBox::move-cursor]
-// - [signal@Gtk.FlowBox::select-all]
-// - [signal@Gtk.FlowBox::toggle-cursor-child]
-// - [signal@Gtk.FlowBox::unselect-all]
+// - [FlowBox.ConnectMoveCursorFunc]
+// - [FlowBox.ConnectSelectAllFunc]
+// - [FlowBox.ConnectToggleCursorChildFunc]
+// - [FlowBox.ConnectUnselectAllFunc]
 //
 // # CSS nodes
 //
-// ```
-// flowbox
-// ├── flowboxchild
-// │   ╰── &lt;child&gt;
-// ├── flowboxchild
-// │   ╰── &lt;child&gt;
-// ┊
-// ╰── [rubberband]
-// ```
+//	flowbox
+//	├── flowboxchild
+//	│   ╰── <child>
+//	├── flowboxchild
+//	│   ╰── <child>
+//	┊
+//	╰── [rubberband]
 //
 // `GtkFlowBox` uses a single CSS node with name flowbox. `GtkFlowBoxChild`
 // uses a single CSS node with name flowboxchild. For rubberband selection,
@@ -130,8 +121,8 @@ func (x *FlowBoxChildClass) GetActivate() func(*FlowBoxChild) {
 //
 // # Accessibility
 //
-// `GtkFlowBox` uses the [enum@Gtk.AccessibleRole.grid] role, and `GtkFlowBoxChild`
-// uses the [enum@Gtk.AccessibleRole.grid_cell] role.
+// `GtkFlowBox` uses the [AccessibleRoleGridValue] role, and `GtkFlowBoxChild`
+// uses the [AccessibleRoleGridCellValue] role.
 type FlowBox struct {
 	Widget
 }
@@ -167,12 +158,12 @@ func NewFlowBox() *FlowBox {
 
 var xFlowBoxAppend func(uintptr, uintptr)
 
-// Adds @child to the end of @self.
+// Adds child to the end of self.
 //
 // If a sort function is set, the widget will
 // actually be inserted at the calculated position.
 //
-// See also: [method@Gtk.FlowBox.insert].
+// See also: [FlowBox.Insert].
 func (x *FlowBox) Append(ChildVar *Widget) {
 
 	xFlowBoxAppend(x.GoPointer(), ChildVar.GoPointer())
@@ -181,17 +172,17 @@ func (x *FlowBox) Append(ChildVar *Widget) {
 
 var xFlowBoxBindModel func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Binds @model to @box.
+// Binds model to box.
 //
-// If @box was already bound to a model, that previous binding is
+// If box was already bound to a model, that previous binding is
 // destroyed.
 //
-// The contents of @box are cleared and then filled with widgets that
-// represent items from @model. @box is updated whenever @model changes.
-// If @model is %NULL, @box is left empty.
+// The contents of box are cleared and then filled with widgets that
+// represent items from model. box is updated whenever model changes.
+// If model is nil, box is left empty.
 //
 // It is undefined to add or remove widgets directly (for example, with
-// [method@Gtk.FlowBox.insert]) while @box is bound to a model.
+// [FlowBox.Insert]) while box is bound to a model.
 //
 // Note that using a model is incompatible with the filtering and sorting
 // functionality in `GtkFlowBox`. When using a model, filtering and sorting
@@ -205,11 +196,12 @@ func (x *FlowBox) BindModel(ModelVar gio.ListModel, CreateWidgetFuncVar *FlowBox
 			CreateWidgetFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CreateWidgetFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CreateWidgetFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CreateWidgetFuncVarPtr, CreateWidgetFuncVarRef, CreateWidgetFuncVar)
+			CreateWidgetFuncVarRef = core.NewCallback(fcb)
+			CreateWidgetFuncVarRef = glib.SaveCallbackWithClosure(CreateWidgetFuncVarPtr, CreateWidgetFuncVarRef, CreateWidgetFuncVar)
 		}
 	}
 
@@ -220,11 +212,12 @@ func (x *FlowBox) BindModel(ModelVar gio.ListModel, CreateWidgetFuncVar *FlowBox
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -243,7 +236,7 @@ func (x *FlowBox) GetActivateOnSingleClick() bool {
 
 var xFlowBoxGetChildAtIndex func(uintptr, int) uintptr
 
-// Gets the nth child in the @box.
+// Gets the nth child in the box.
 func (x *FlowBox) GetChildAtIndex(IdxVar int) *FlowBoxChild {
 	var cls *FlowBoxChild
 
@@ -260,9 +253,9 @@ func (x *FlowBox) GetChildAtIndex(IdxVar int) *FlowBoxChild {
 
 var xFlowBoxGetChildAtPos func(uintptr, int, int) uintptr
 
-// Gets the child in the (@x, @y) position.
+// Gets the child in the (x, y) position.
 //
-// Both @x and @y are assumed to be relative to the origin of @box.
+// Both x and y are assumed to be relative to the origin of box.
 func (x *FlowBox) GetChildAtPos(XVar int, YVar int) *FlowBoxChild {
 	var cls *FlowBoxChild
 
@@ -333,7 +326,7 @@ func (x *FlowBox) GetSelectedChildren() *glib.List {
 
 var xFlowBoxGetSelectionMode func(uintptr) SelectionMode
 
-// Gets the selection mode of @box.
+// Gets the selection mode of box.
 func (x *FlowBox) GetSelectionMode() SelectionMode {
 
 	cret := xFlowBoxGetSelectionMode(x.GoPointer())
@@ -342,13 +335,13 @@ func (x *FlowBox) GetSelectionMode() SelectionMode {
 
 var xFlowBoxInsert func(uintptr, uintptr, int)
 
-// Inserts the @widget into @box at @position.
+// Inserts the widget into box at position.
 //
 // If a sort function is set, the widget will actually be inserted
 // at the calculated position.
 //
-// If @position is -1, or larger than the total number of children
-// in the @box, then the @widget will be appended to the end.
+// If position is -1, or larger than the total number of children
+// in the box, then the widget will be appended to the end.
 func (x *FlowBox) Insert(WidgetVar *Widget, PositionVar int) {
 
 	xFlowBoxInsert(x.GoPointer(), WidgetVar.GoPointer(), PositionVar)
@@ -360,7 +353,7 @@ var xFlowBoxInvalidateFilter func(uintptr)
 // Updates the filtering for all children.
 //
 // Call this function when the result of the filter
-// function on the @box is changed due to an external
+// function on the box is changed due to an external
 // factor. For instance, this would be used if the
 // filter function just looked for a specific search
 // term, and the entry with the string has changed.
@@ -375,7 +368,7 @@ var xFlowBoxInvalidateSort func(uintptr)
 // Updates the sorting for all children.
 //
 // Call this when the result of the sort function on
-// @box is changed due to an external factor.
+// box is changed due to an external factor.
 func (x *FlowBox) InvalidateSort() {
 
 	xFlowBoxInvalidateSort(x.GoPointer())
@@ -384,12 +377,12 @@ func (x *FlowBox) InvalidateSort() {
 
 var xFlowBoxPrepend func(uintptr, uintptr)
 
-// Adds @child to the start of @self.
+// Adds child to the start of self.
 //
 // If a sort function is set, the widget will
 // actually be inserted at the calculated position.
 //
-// See also: [method@Gtk.FlowBox.insert].
+// See also: [FlowBox.Insert].
 func (x *FlowBox) Prepend(ChildVar *Widget) {
 
 	xFlowBoxPrepend(x.GoPointer(), ChildVar.GoPointer())
@@ -398,7 +391,7 @@ func (x *FlowBox) Prepend(ChildVar *Widget) {
 
 var xFlowBoxRemove func(uintptr, uintptr)
 
-// Removes a child from @box.
+// Removes a child from box.
 func (x *FlowBox) Remove(WidgetVar *Widget) {
 
 	xFlowBoxRemove(x.GoPointer(), WidgetVar.GoPointer())
@@ -407,9 +400,9 @@ func (x *FlowBox) Remove(WidgetVar *Widget) {
 
 var xFlowBoxRemoveAll func(uintptr)
 
-// Removes all children from @box.
+// Removes all children from box.
 //
-// This function does nothing if @box is backed by a model.
+// This function does nothing if box is backed by a model.
 func (x *FlowBox) RemoveAll() {
 
 	xFlowBoxRemoveAll(x.GoPointer())
@@ -418,7 +411,7 @@ func (x *FlowBox) RemoveAll() {
 
 var xFlowBoxSelectAll func(uintptr)
 
-// Select all children of @box, if the selection
+// Select all children of box, if the selection
 // mode allows it.
 func (x *FlowBox) SelectAll() {
 
@@ -428,7 +421,7 @@ func (x *FlowBox) SelectAll() {
 
 var xFlowBoxSelectChild func(uintptr, uintptr)
 
-// Selects a single child of @box, if the selection
+// Selects a single child of box, if the selection
 // mode allows it.
 func (x *FlowBox) SelectChild(ChildVar *FlowBoxChild) {
 
@@ -451,11 +444,12 @@ func (x *FlowBox) SelectedForeach(FuncVar *FlowBoxForeachFunc, DataVar uintptr)
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -465,7 +459,7 @@ func (x *FlowBox) SelectedForeach(FuncVar *FlowBoxForeachFunc, DataVar uintptr)
 
 var xFlowBoxSetActivateOnSingleClick func(uintptr, bool)
 
-// If @single is %TRUE, children will be activated when you click
+// If single is true, children will be activated when you click
 // on them, otherwise you need to double-click.
 func (x *FlowBox) SetActivateOnSingleClick(SingleVar bool) {
 
@@ -484,19 +478,19 @@ func (x *FlowBox) SetColumnSpacing(SpacingVar uint) {
 
 var xFlowBoxSetFilterFunc func(uintptr, uintptr, uintptr, uintptr)
 
-// By setting a filter function on the @box one can decide dynamically
+// By setting a filter function on the box one can decide dynamically
 // which of the children to show.
 //
 // For instance, to implement a search function that only shows the
 // children matching the search terms.
 //
-// The @filter_func will be called for each child after the call, and
+// The filter_func will be called for each child after the call, and
 // it will continue to be called each time a child changes (via
-// [method@Gtk.FlowBoxChild.changed]) or when
-// [method@Gtk.FlowBox.invalidate_filter] is called.
+// [FlowBoxChild.Changed]) or when
+// [FlowBox.InvalidateFilter] is called.
 //
 // Note that using a filter function is incompatible with using a model
-// (see [method@Gtk.FlowBox.bind_model]).
+// (see [FlowBox.BindModel]).
 func (x *FlowBox) SetFilterFunc(FilterFuncVar *FlowBoxFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var FilterFuncVarRef uintptr
@@ -506,11 +500,12 @@ func (x *FlowBox) SetFilterFunc(FilterFuncVar *FlowBoxFilterFunc, UserDataVar ui
 			FilterFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FilterFuncVar
 				return cbFn(arg0, arg1)
 			}
-			FilterFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FilterFuncVarPtr, FilterFuncVarRef, FilterFuncVar)
+			FilterFuncVarRef = core.NewCallback(fcb)
+			FilterFuncVarRef = glib.SaveCallbackWithClosure(FilterFuncVarPtr, FilterFuncVarRef, FilterFuncVar)
 		}
 	}
 
@@ -521,11 +516,12 @@ func (x *FlowBox) SetFilterFunc(FilterFuncVar *FlowBoxFilterFunc, UserDataVar ui
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -535,12 +531,12 @@ func (x *FlowBox) SetFilterFunc(FilterFuncVar *FlowBoxFilterFunc, UserDataVar ui
 
 var xFlowBoxSetHadjustment func(uintptr, uintptr)
 
-// Hooks up an adjustment to focus handling in @box.
+// Hooks up an adjustment to focus handling in box.
 //
 // The adjustment is also used for autoscrolling during
-// rubberband selection. See [method@Gtk.ScrolledWindow.get_hadjustment]
+// rubberband selection. See [ScrolledWindow.GetHadjustment]
 // for a typical way of obtaining the adjustment, and
-// [method@Gtk.FlowBox.set_vadjustment] for setting the vertical
+// [FlowBox.SetVadjustment] for setting the vertical
 // adjustment.
 //
 // The adjustments have to be in pixel units and in the same
@@ -554,7 +550,7 @@ func (x *FlowBox) SetHadjustment(AdjustmentVar *Adjustment) {
 
 var xFlowBoxSetHomogeneous func(uintptr, bool)
 
-// Sets whether or not all children of @box are given
+// Sets whether or not all children of box are given
 // equal space in the box.
 func (x *FlowBox) SetHomogeneous(HomogeneousVar bool) {
 
@@ -565,11 +561,11 @@ func (x *FlowBox) SetHomogeneous(HomogeneousVar bool) {
 var xFlowBoxSetMaxChildrenPerLine func(uintptr, uint)
 
 // Sets the maximum number of children to request and
-// allocate space for in @box’s orientation.
+// allocate space for in box’s orientation.
 //
 // Setting the maximum number of children per line
 // limits the overall natural size request to be no more
-// than @n_children children long in the given orientation.
+// than n_children children long in the given orientation.
 func (x *FlowBox) SetMaxChildrenPerLine(NChildrenVar uint) {
 
 	xFlowBoxSetMaxChildrenPerLine(x.GoPointer(), NChildrenVar)
@@ -579,7 +575,7 @@ func (x *FlowBox) SetMaxChildrenPerLine(NChildrenVar uint) {
 var xFlowBoxSetMinChildrenPerLine func(uintptr, uint)
 
 // Sets the minimum number of children to line up
-// in @box’s orientation before flowing.
+// in box’s orientation before flowing.
 func (x *FlowBox) SetMinChildrenPerLine(NChildrenVar uint) {
 
 	xFlowBoxSetMinChildrenPerLine(x.GoPointer(), NChildrenVar)
@@ -597,7 +593,7 @@ func (x *FlowBox) SetRowSpacing(SpacingVar uint) {
 
 var xFlowBoxSetSelectionMode func(uintptr, SelectionMode)
 
-// Sets how selection works in @box.
+// Sets how selection works in box.
 func (x *FlowBox) SetSelectionMode(ModeVar SelectionMode) {
 
 	xFlowBoxSetSelectionMode(x.GoPointer(), ModeVar)
@@ -606,17 +602,17 @@ func (x *FlowBox) SetSelectionMode(ModeVar SelectionMode) {
 
 var xFlowBoxSetSortFunc func(uintptr, uintptr, uintptr, uintptr)
 
-// By setting a sort function on the @box, one can dynamically
+// By setting a sort function on the box, one can dynamically
 // reorder the children of the box, based on the contents of
 // the children.
 //
-// The @sort_func will be called for each child after the call,
+// The sort_func will be called for each child after the call,
 // and will continue to be called each time a child changes (via
-// [method@Gtk.FlowBoxChild.changed]) and when
-// [method@Gtk.FlowBox.invalidate_sort] is called.
+// [FlowBoxChild.Changed]) and when
+// [FlowBox.InvalidateSort] is called.
 //
 // Note that using a sort function is incompatible with using a model
-// (see [method@Gtk.FlowBox.bind_model]).
+// (see [FlowBox.BindModel]).
 func (x *FlowBox) SetSortFunc(SortFuncVar *FlowBoxSortFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var SortFuncVarRef uintptr
@@ -626,11 +622,12 @@ func (x *FlowBox) SetSortFunc(SortFuncVar *FlowBoxSortFunc, UserDataVar uintptr,
 			SortFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SortFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			SortFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
 		}
 	}
 
@@ -641,11 +638,12 @@ func (x *FlowBox) SetSortFunc(SortFuncVar *FlowBoxSortFunc, UserDataVar uintptr,
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -655,12 +653,12 @@ func (x *FlowBox) SetSortFunc(SortFuncVar *FlowBoxSortFunc, UserDataVar uintptr,
 
 var xFlowBoxSetVadjustment func(uintptr, uintptr)
 
-// Hooks up an adjustment to focus handling in @box.
+// Hooks up an adjustment to focus handling in box.
 //
 // The adjustment is also used for autoscrolling during
-// rubberband selection. See [method@Gtk.ScrolledWindow.get_vadjustment]
+// rubberband selection. See [ScrolledWindow.GetVadjustment]
 // for a typical way of obtaining the adjustment, and
-// [method@Gtk.FlowBox.set_hadjustment] for setting the horizontal
+// [FlowBox.SetHadjustment] for setting the horizontal
 // adjustment.
 //
 // The adjustments have to be in pixel units and in the same
@@ -674,7 +672,7 @@ func (x *FlowBox) SetVadjustment(AdjustmentVar *Adjustment) {
 
 var xFlowBoxUnselectAll func(uintptr)
 
-// Unselect all children of @box, if the selection
+// Unselect all children of box, if the selection
 // mode allows it.
 func (x *FlowBox) UnselectAll() {
 
@@ -684,7 +682,7 @@ func (x *FlowBox) UnselectAll() {
 
 var xFlowBoxUnselectChild func(uintptr, uintptr)
 
-// Unselects a single child of @box, if the selection
+// Unselects a single child of box, if the selection
 // mode allows it.
 func (x *FlowBox) UnselectChild(ChildVar *FlowBoxChild) {
 
@@ -838,61 +836,105 @@ func (x *FlowBox) GetPropertyRowSpacing() uint {
 	return v.GetUint()
 }
 
-// Emitted when the user activates the @box.
+// Emitted when the user activates the box.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// Deprecated: use ConnectActivateCursorChildFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectActivateCursorChild(cb *func(FlowBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-cursor-child", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-cursor-child", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFlowBoxActivateCursorChildTrampoline uintptr
+
+func xFlowBoxActivateCursorChildNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-cursor-child", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateCursorChildFunc connects cb to the "activate-cursor-child" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectActivateCursorChildFunc(cb func(FlowBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-cursor-child", &xFlowBoxActivateCursorChildTrampoline, xFlowBoxActivateCursorChildNewTrampoline, cb)
 }
 
 // Emitted when a child has been activated by the user.
+//
+// Deprecated: use ConnectChildActivatedFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectChildActivated(cb *func(FlowBox, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "child-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChildVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChildVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "child-activated", cbRefPtr, cbPtr)
+}
+
+var xFlowBoxChildActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ChildVarp uintptr) {
+func xFlowBoxChildActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChildVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChildVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "child-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChildActivatedFunc connects cb to the "child-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectChildActivatedFunc(cb func(FlowBox, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "child-activated", &xFlowBoxChildActivatedTrampoline, xFlowBoxChildActivatedNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a cursor movement.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 // Applications should not connect to it, but may emit it with
-// g_signal_emit_by_name() if they need to control the cursor
+// [gobject.SignalEmitByName] if they need to control the cursor
 // programmatically.
 //
 // The default bindings for this signal come in two variants,
@@ -900,145 +942,255 @@ func (x *FlowBox) ConnectChildActivated(cb *func(FlowBox, uintptr)) uint {
 // the variant without the Shift modifier does not.
 // There are too many key combinations to list them all here.
 //
-//   - &lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;
+//   - ←, →, ↑, ↓
 //     move by individual children
-//   - &lt;kbd&gt;Home&lt;/kbd&gt;, &lt;kbd&gt;End&lt;/kbd&gt; move to the ends of the box
-//   - &lt;kbd&gt;PgUp&lt;/kbd&gt;, &lt;kbd&gt;PgDn&lt;/kbd&gt; move vertically by pages
+//   - Home, End move to the ends of the box
+//   - PgUp, PgDn move vertically by pages
+//
+// Deprecated: use ConnectMoveCursorFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectMoveCursor(cb *func(FlowBox, MovementStep, int, bool, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, StepVarp, CountVarp, ExtendVarp, ModifyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-cursor", cbRefPtr, cbPtr)
+}
+
+var xFlowBoxMoveCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool) bool {
+func xFlowBoxMoveCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox, MovementStep, int, bool, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, StepVarp, CountVarp, ExtendVarp, ModifyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveCursorFunc connects cb to the "move-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectMoveCursorFunc(cb func(FlowBox, MovementStep, int, bool, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-cursor", &xFlowBoxMoveCursorTrampoline, xFlowBoxMoveCursorNewTrampoline, cb)
 }
 
 // Emitted to select all children of the box,
 // if the selection mode permits it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default bindings for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;a&lt;/kbd&gt;.
+// The default bindings for this signal is Ctrl-a.
+//
+// Deprecated: use ConnectSelectAllFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectSelectAll(cb *func(FlowBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "select-all", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFlowBoxSelectAllTrampoline uintptr
+
+func xFlowBoxSelectAllNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectAllFunc connects cb to the "select-all" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectSelectAllFunc(cb func(FlowBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "select-all", &xFlowBoxSelectAllTrampoline, xFlowBoxSelectAllNewTrampoline, cb)
 }
 
 // Emitted when the set of selected children changes.
 //
-// Use [method@Gtk.FlowBox.selected_foreach] or
-// [method@Gtk.FlowBox.get_selected_children] to obtain the
+// Use [FlowBox.SelectedForeach] or
+// [FlowBox.GetSelectedChildren] to obtain the
 // selected children.
+//
+// Deprecated: use ConnectSelectedChildrenChangedFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectSelectedChildrenChanged(cb *func(FlowBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "selected-children-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "selected-children-changed", cbRefPtr, cbPtr)
+}
+
+var xFlowBoxSelectedChildrenChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFlowBoxSelectedChildrenChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "selected-children-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectedChildrenChangedFunc connects cb to the "selected-children-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectSelectedChildrenChangedFunc(cb func(FlowBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "selected-children-changed", &xFlowBoxSelectedChildrenChangedTrampoline, xFlowBoxSelectedChildrenChangedNewTrampoline, cb)
 }
 
 // Emitted to toggle the selection of the child that has the focus.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;Space&lt;/kbd&gt;.
+// The default binding for this signal is Ctrl-Space.
+//
+// Deprecated: use ConnectToggleCursorChildFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectToggleCursorChild(cb *func(FlowBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-child", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-cursor-child", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFlowBoxToggleCursorChildTrampoline uintptr
+
+func xFlowBoxToggleCursorChildNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-child", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleCursorChildFunc connects cb to the "toggle-cursor-child" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectToggleCursorChildFunc(cb func(FlowBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-cursor-child", &xFlowBoxToggleCursorChildTrampoline, xFlowBoxToggleCursorChildNewTrampoline, cb)
 }
 
 // Emitted to unselect all children of the box,
 // if the selection mode permits it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default bindings for this signal is Ctrl-Shift-a.
 //
-// The default bindings for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;Shift&lt;/kbd&gt;-&lt;kbd&gt;a&lt;/kbd&gt;.
+// Deprecated: use ConnectUnselectAllFunc, which also accepts method values and closures.
 func (x *FlowBox) ConnectUnselectAll(cb *func(FlowBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unselect-all", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unselect-all", cbRefPtr, cbPtr)
+}
+
+var xFlowBoxUnselectAllTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFlowBoxUnselectAllNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unselect-all", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnselectAllFunc connects cb to the "unselect-all" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBox) ConnectUnselectAllFunc(cb func(FlowBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unselect-all", &xFlowBoxUnselectAllTrampoline, xFlowBoxUnselectAllNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1202,7 +1354,7 @@ func (x *FlowBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1210,14 +1362,13 @@ func (x *FlowBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FlowBox) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1241,19 +1392,17 @@ func (x *FlowBox) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FlowBox) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1274,7 +1423,7 @@ func (x *FlowBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1283,14 +1432,12 @@ func (x *FlowBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FlowBox) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1309,24 +1456,24 @@ func (x *FlowBox) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FlowBox) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *FlowBox) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *FlowBox) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -1335,7 +1482,7 @@ func (x *FlowBox) SetOrientation(OrientationVar Orientation) {
 
 // The kind of widget that can be added to a `GtkFlowBox`.
 //
-// [class@Gtk.FlowBox] will automatically wrap its children in a `GtkFlowBoxChild`
+// [FlowBox] will automatically wrap its children in a `GtkFlowBoxChild`
 // when necessary.
 type FlowBoxChild struct {
 	Widget
@@ -1374,7 +1521,7 @@ func NewFlowBoxChild() *FlowBoxChild {
 
 var xFlowBoxChildChanged func(uintptr)
 
-// Marks @child as changed, causing any state that depends on this
+// Marks child as changed, causing any state that depends on this
 // to be updated.
 //
 // This affects sorting and filtering.
@@ -1383,7 +1530,7 @@ var xFlowBoxChildChanged func(uintptr)
 // used for the sorting and filtering functions. For instance, if
 // the list is mirroring some external data set, and *two* children
 // changed in the external data set when you call
-// gtk_flow_box_child_changed() on the first child, the sort function
+// [FlowBoxChild.Changed] on the first child, the sort function
 // must only read the new data for the first of the two changed
 // children, otherwise the resorting of the children will be wrong.
 //
@@ -1391,7 +1538,7 @@ var xFlowBoxChildChanged func(uintptr)
 // model, you have to duplicate the data that affects the sorting
 // and filtering functions into the widgets themselves.
 //
-// Another alternative is to call [method@Gtk.FlowBox.invalidate_sort]
+// Another alternative is to call [FlowBox.InvalidateSort]
 // on any model change, but that is more expensive.
 func (x *FlowBoxChild) Changed() {
 
@@ -1401,7 +1548,7 @@ func (x *FlowBoxChild) Changed() {
 
 var xFlowBoxChildGetChild func(uintptr) uintptr
 
-// Gets the child widget of @self.
+// Gets the child widget of self.
 func (x *FlowBoxChild) GetChild() *Widget {
 	var cls *Widget
 
@@ -1418,7 +1565,7 @@ func (x *FlowBoxChild) GetChild() *Widget {
 
 var xFlowBoxChildGetIndex func(uintptr) int
 
-// Gets the current index of the @child in its `GtkFlowBox` container.
+// Gets the current index of the child in its `GtkFlowBox` container.
 func (x *FlowBoxChild) GetIndex() int {
 
 	cret := xFlowBoxChildGetIndex(x.GoPointer())
@@ -1427,7 +1574,7 @@ func (x *FlowBoxChild) GetIndex() int {
 
 var xFlowBoxChildIsSelected func(uintptr) bool
 
-// Returns whether the @child is currently selected in its
+// Returns whether the child is currently selected in its
 // `GtkFlowBox` container.
 func (x *FlowBoxChild) IsSelected() bool {
 
@@ -1437,7 +1584,7 @@ func (x *FlowBoxChild) IsSelected() bool {
 
 var xFlowBoxChildSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *FlowBoxChild) SetChild(ChildVar *Widget) {
 
 	xFlowBoxChildSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -1460,31 +1607,53 @@ func (c *FlowBoxChild) SetGoPointer(ptr uintptr) {
 // This can happen either by clicking or double-clicking,
 // or via a keybinding.
 //
-// This is a [keybinding signal](class.SignalAction.html),
+// This is a keybinding signal,
 // but it can be used by applications for their own purposes.
 //
-// The default bindings are &lt;kbd&gt;Space&lt;/kbd&gt; and &lt;kbd&gt;Enter&lt;/kbd&gt;.
+// The default bindings are Space and Enter.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *FlowBoxChild) ConnectActivate(cb *func(FlowBoxChild)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FlowBoxChild{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFlowBoxChildActivateTrampoline uintptr
+
+func xFlowBoxChildActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FlowBoxChild))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FlowBoxChild{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FlowBoxChild) ConnectActivateFunc(cb func(FlowBoxChild)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xFlowBoxChildActivateTrampoline, xFlowBoxChildActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1648,7 +1817,7 @@ func (x *FlowBoxChild) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1656,14 +1825,13 @@ func (x *FlowBoxChild) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FlowBoxChild) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1687,19 +1855,17 @@ func (x *FlowBoxChild) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FlowBoxChild) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1720,7 +1886,7 @@ func (x *FlowBoxChild) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1729,14 +1895,12 @@ func (x *FlowBoxChild) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FlowBoxChild) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1755,10 +1919,10 @@ func (x *FlowBoxChild) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FlowBoxChild) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1770,7 +1934,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1779,51 +1943,53 @@ func init() {
 
 	core.PuregoSafeRegister(&xFlowBoxGLibType, libs, "gtk_flow_box_get_type")
 
-	core.PuregoSafeRegister(&xNewFlowBox, libs, "gtk_flow_box_new")
-
-	core.PuregoSafeRegister(&xFlowBoxAppend, libs, "gtk_flow_box_append")
-	core.PuregoSafeRegister(&xFlowBoxBindModel, libs, "gtk_flow_box_bind_model")
-	core.PuregoSafeRegister(&xFlowBoxGetActivateOnSingleClick, libs, "gtk_flow_box_get_activate_on_single_click")
-	core.PuregoSafeRegister(&xFlowBoxGetChildAtIndex, libs, "gtk_flow_box_get_child_at_index")
-	core.PuregoSafeRegister(&xFlowBoxGetChildAtPos, libs, "gtk_flow_box_get_child_at_pos")
-	core.PuregoSafeRegister(&xFlowBoxGetColumnSpacing, libs, "gtk_flow_box_get_column_spacing")
-	core.PuregoSafeRegister(&xFlowBoxGetHomogeneous, libs, "gtk_flow_box_get_homogeneous")
-	core.PuregoSafeRegister(&xFlowBoxGetMaxChildrenPerLine, libs, "gtk_flow_box_get_max_children_per_line")
-	core.PuregoSafeRegister(&xFlowBoxGetMinChildrenPerLine, libs, "gtk_flow_box_get_min_children_per_line")
-	core.PuregoSafeRegister(&xFlowBoxGetRowSpacing, libs, "gtk_flow_box_get_row_spacing")
-	core.PuregoSafeRegister(&xFlowBoxGetSelectedChildren, libs, "gtk_flow_box_get_selected_children")
-	core.PuregoSafeRegister(&xFlowBoxGetSelectionMode, libs, "gtk_flow_box_get_selection_mode")
-	core.PuregoSafeRegister(&xFlowBoxInsert, libs, "gtk_flow_box_insert")
-	core.PuregoSafeRegister(&xFlowBoxInvalidateFilter, libs, "gtk_flow_box_invalidate_filter")
-	core.PuregoSafeRegister(&xFlowBoxInvalidateSort, libs, "gtk_flow_box_invalidate_sort")
-	core.PuregoSafeRegister(&xFlowBoxPrepend, libs, "gtk_flow_box_prepend")
-	core.PuregoSafeRegister(&xFlowBoxRemove, libs, "gtk_flow_box_remove")
-	core.PuregoSafeRegister(&xFlowBoxRemoveAll, libs, "gtk_flow_box_remove_all")
-	core.PuregoSafeRegister(&xFlowBoxSelectAll, libs, "gtk_flow_box_select_all")
-	core.PuregoSafeRegister(&xFlowBoxSelectChild, libs, "gtk_flow_box_select_child")
-	core.PuregoSafeRegister(&xFlowBoxSelectedForeach, libs, "gtk_flow_box_selected_foreach")
-	core.PuregoSafeRegister(&xFlowBoxSetActivateOnSingleClick, libs, "gtk_flow_box_set_activate_on_single_click")
-	core.PuregoSafeRegister(&xFlowBoxSetColumnSpacing, libs, "gtk_flow_box_set_column_spacing")
-	core.PuregoSafeRegister(&xFlowBoxSetFilterFunc, libs, "gtk_flow_box_set_filter_func")
-	core.PuregoSafeRegister(&xFlowBoxSetHadjustment, libs, "gtk_flow_box_set_hadjustment")
-	core.PuregoSafeRegister(&xFlowBoxSetHomogeneous, libs, "gtk_flow_box_set_homogeneous")
-	core.PuregoSafeRegister(&xFlowBoxSetMaxChildrenPerLine, libs, "gtk_flow_box_set_max_children_per_line")
-	core.PuregoSafeRegister(&xFlowBoxSetMinChildrenPerLine, libs, "gtk_flow_box_set_min_children_per_line")
-	core.PuregoSafeRegister(&xFlowBoxSetRowSpacing, libs, "gtk_flow_box_set_row_spacing")
-	core.PuregoSafeRegister(&xFlowBoxSetSelectionMode, libs, "gtk_flow_box_set_selection_mode")
-	core.PuregoSafeRegister(&xFlowBoxSetSortFunc, libs, "gtk_flow_box_set_sort_func")
-	core.PuregoSafeRegister(&xFlowBoxSetVadjustment, libs, "gtk_flow_box_set_vadjustment")
-	core.PuregoSafeRegister(&xFlowBoxUnselectAll, libs, "gtk_flow_box_unselect_all")
-	core.PuregoSafeRegister(&xFlowBoxUnselectChild, libs, "gtk_flow_box_unselect_child")
+	core.PuregoRegisterSince(&xNewFlowBox, libs, "gtk_flow_box_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xFlowBoxAppend, libs, "gtk_flow_box_append", "GTK", "4.6")
+	core.PuregoRegisterSince(&xFlowBoxBindModel, libs, "gtk_flow_box_bind_model", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetActivateOnSingleClick, libs, "gtk_flow_box_get_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetChildAtIndex, libs, "gtk_flow_box_get_child_at_index", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetChildAtPos, libs, "gtk_flow_box_get_child_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetColumnSpacing, libs, "gtk_flow_box_get_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetHomogeneous, libs, "gtk_flow_box_get_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetMaxChildrenPerLine, libs, "gtk_flow_box_get_max_children_per_line", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetMinChildrenPerLine, libs, "gtk_flow_box_get_min_children_per_line", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetRowSpacing, libs, "gtk_flow_box_get_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetSelectedChildren, libs, "gtk_flow_box_get_selected_children", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxGetSelectionMode, libs, "gtk_flow_box_get_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxInsert, libs, "gtk_flow_box_insert", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxInvalidateFilter, libs, "gtk_flow_box_invalidate_filter", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxInvalidateSort, libs, "gtk_flow_box_invalidate_sort", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxPrepend, libs, "gtk_flow_box_prepend", "GTK", "4.6")
+	core.PuregoRegisterSince(&xFlowBoxRemove, libs, "gtk_flow_box_remove", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxRemoveAll, libs, "gtk_flow_box_remove_all", "GTK", "4.12")
+	core.PuregoRegisterSince(&xFlowBoxSelectAll, libs, "gtk_flow_box_select_all", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSelectChild, libs, "gtk_flow_box_select_child", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSelectedForeach, libs, "gtk_flow_box_selected_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetActivateOnSingleClick, libs, "gtk_flow_box_set_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetColumnSpacing, libs, "gtk_flow_box_set_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetFilterFunc, libs, "gtk_flow_box_set_filter_func", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetHadjustment, libs, "gtk_flow_box_set_hadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetHomogeneous, libs, "gtk_flow_box_set_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetMaxChildrenPerLine, libs, "gtk_flow_box_set_max_children_per_line", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetMinChildrenPerLine, libs, "gtk_flow_box_set_min_children_per_line", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetRowSpacing, libs, "gtk_flow_box_set_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetSelectionMode, libs, "gtk_flow_box_set_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetSortFunc, libs, "gtk_flow_box_set_sort_func", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxSetVadjustment, libs, "gtk_flow_box_set_vadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxUnselectAll, libs, "gtk_flow_box_unselect_all", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxUnselectChild, libs, "gtk_flow_box_unselect_child", "GTK", "")
 
 	core.PuregoSafeRegister(&xFlowBoxChildGLibType, libs, "gtk_flow_box_child_get_type")
 
-	core.PuregoSafeRegister(&xNewFlowBoxChild, libs, "gtk_flow_box_child_new")
+	gobject.CheckClassSize("GtkFlowBoxChildClass", xFlowBoxChildGLibType, unsafe.Sizeof(FlowBoxChildClass{}))
+
+	core.PuregoRegisterSince(&xNewFlowBoxChild, libs, "gtk_flow_box_child_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xFlowBoxChildChanged, libs, "gtk_flow_box_child_changed")
-	core.PuregoSafeRegister(&xFlowBoxChildGetChild, libs, "gtk_flow_box_child_get_child")
-	core.PuregoSafeRegister(&xFlowBoxChildGetIndex, libs, "gtk_flow_box_child_get_index")
-	core.PuregoSafeRegister(&xFlowBoxChildIsSelected, libs, "gtk_flow_box_child_is_selected")
-	core.PuregoSafeRegister(&xFlowBoxChildSetChild, libs, "gtk_flow_box_child_set_child")
+	core.PuregoRegisterSince(&xFlowBoxChildChanged, libs, "gtk_flow_box_child_changed", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxChildGetChild, libs, "gtk_flow_box_child_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxChildGetIndex, libs, "gtk_flow_box_child_get_index", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxChildIsSelected, libs, "gtk_flow_box_child_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&xFlowBoxChildSetChild, libs, "gtk_flow_box_child_set_child", "GTK", "")
 
 }
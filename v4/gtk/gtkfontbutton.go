@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,27 +14,18 @@ import (
 // The `GtkFontButton` allows to open a font chooser dialog to change
 // the font.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="font-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkFontButton" src="font-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is suitable widget for selecting a font in a preference dialog.
 //
 // # CSS nodes
 //
-// ```
-// fontbutton
-// ╰── button.font
-//
-//	╰── [content]
-//
-// ```
+//	fontbutton
+//	╰── button.font
+//	    ╰── [content]
 //
 // `GtkFontButton` has a single CSS node with name fontbutton which
 // contains a button node with the .font style class.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
 type FontButton struct {
 	Widget
 }
@@ -52,114 +42,6 @@ func FontButtonNewFromInternalPtr(ptr uintptr) *FontButton {
 	return cls
 }
 
-var xNewFontButton func() uintptr
-
-// Creates a new font picker widget.
-func NewFontButton() *FontButton {
-	var cls *FontButton
-
-	cret := xNewFontButton()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FontButton{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewFontButtonWithFont func(string) uintptr
-
-// Creates a new font picker widget showing the given font.
-func NewFontButtonWithFont(FontnameVar string) *FontButton {
-	var cls *FontButton
-
-	cret := xNewFontButtonWithFont(FontnameVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FontButton{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xFontButtonGetModal func(uintptr) bool
-
-// Gets whether the dialog is modal.
-func (x *FontButton) GetModal() bool {
-
-	cret := xFontButtonGetModal(x.GoPointer())
-	return cret
-}
-
-var xFontButtonGetTitle func(uintptr) string
-
-// Retrieves the title of the font chooser dialog.
-func (x *FontButton) GetTitle() string {
-
-	cret := xFontButtonGetTitle(x.GoPointer())
-	return cret
-}
-
-var xFontButtonGetUseFont func(uintptr) bool
-
-// Returns whether the selected font is used in the label.
-func (x *FontButton) GetUseFont() bool {
-
-	cret := xFontButtonGetUseFont(x.GoPointer())
-	return cret
-}
-
-var xFontButtonGetUseSize func(uintptr) bool
-
-// Returns whether the selected size is used in the label.
-func (x *FontButton) GetUseSize() bool {
-
-	cret := xFontButtonGetUseSize(x.GoPointer())
-	return cret
-}
-
-var xFontButtonSetModal func(uintptr, bool)
-
-// Sets whether the dialog should be modal.
-func (x *FontButton) SetModal(ModalVar bool) {
-
-	xFontButtonSetModal(x.GoPointer(), ModalVar)
-
-}
-
-var xFontButtonSetTitle func(uintptr, string)
-
-// Sets the title for the font chooser dialog.
-func (x *FontButton) SetTitle(TitleVar string) {
-
-	xFontButtonSetTitle(x.GoPointer(), TitleVar)
-
-}
-
-var xFontButtonSetUseFont func(uintptr, bool)
-
-// If @use_font is %TRUE, the font name will be written
-// using the selected font.
-func (x *FontButton) SetUseFont(UseFontVar bool) {
-
-	xFontButtonSetUseFont(x.GoPointer(), UseFontVar)
-
-}
-
-var xFontButtonSetUseSize func(uintptr, bool)
-
-// If @use_size is %TRUE, the font name will be written using
-// the selected size.
-func (x *FontButton) SetUseSize(UseSizeVar bool) {
-
-	xFontButtonSetUseSize(x.GoPointer(), UseSizeVar)
-
-}
-
 func (c *FontButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -243,58 +125,102 @@ func (x *FontButton) GetPropertyUseSize() bool {
 //
 // The `::activate` signal on `GtkFontButton` is an action signal and
 // emitting it causes the button to present its dialog.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *FontButton) ConnectActivate(cb *func(FontButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FontButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFontButtonActivateTrampoline uintptr
+
+func xFontButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FontButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FontButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FontButton) ConnectActivateFunc(cb func(FontButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xFontButtonActivateTrampoline, xFontButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the user selects a font.
 //
-// When handling this signal, use [method@Gtk.FontChooser.get_font]
+// When handling this signal, use [FontChooser.GetFont]
 // to find out which font was just selected.
 //
 // Note that this signal is only emitted when the user changes the font.
 // If you need to react to programmatic font changes as well, use
 // the notify::font signal.
+//
+// Deprecated: use ConnectFontSetFunc, which also accepts method values and closures.
 func (x *FontButton) ConnectFontSet(cb *func(FontButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "font-set", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FontButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "font-set", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xFontButtonFontSetTrampoline uintptr
+
+func xFontButtonFontSetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FontButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FontButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "font-set", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectFontSetFunc connects cb to the "font-set" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FontButton) ConnectFontSetFunc(cb func(FontButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "font-set", &xFontButtonFontSetTrampoline, xFontButtonFontSetNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -458,7 +384,7 @@ func (x *FontButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -466,14 +392,13 @@ func (x *FontButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FontButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -497,19 +422,17 @@ func (x *FontButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FontButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -530,7 +453,7 @@ func (x *FontButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -539,14 +462,12 @@ func (x *FontButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FontButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -565,10 +486,10 @@ func (x *FontButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FontButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -578,13 +499,16 @@ func (x *FontButton) GetBuildableId() string {
 // Gets the currently-selected font name.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFont() string {
 
 	cret := XGtkFontChooserGetFont(x.GoPointer())
@@ -594,13 +518,16 @@ func (x *FontButton) GetFont() string {
 // Gets the currently-selected font.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFontDesc() *pango.FontDescription {
 
 	cret := XGtkFontChooserGetFontDesc(x.GoPointer())
@@ -610,7 +537,10 @@ func (x *FontButton) GetFontDesc() *pango.FontDescription {
 // Gets the `PangoFontFace` representing the selected font group
 // details (i.e. family, slant, weight, width, etc).
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFontFace() *pango.FontFace {
 	var cls *pango.FontFace
 
@@ -629,7 +559,10 @@ func (x *FontButton) GetFontFace() *pango.FontFace {
 //
 // Font families are a collection of font faces.
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFontFamily() *pango.FontFamily {
 	var cls *pango.FontFamily
 
@@ -647,8 +580,13 @@ func (x *FontButton) GetFontFamily() *pango.FontFamily {
 // Gets the currently-selected font features.
 //
 // The format of the returned string is compatible with the
-// [CSS font-feature-settings property](https://www.w3.org/TR/css-fonts-4/#font-rend-desc).
-// It can be passed to [func@Pango.AttrFontFeatures.new].
+// [CSS font-feature-settings property].
+// It can be passed to [pango.AttrFontFeaturesNew].
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
+//
+// [CSS font-feature-settings property]: https://www.w3.org/TR/css-fonts-4/#font-rend-desc
 func (x *FontButton) GetFontFeatures() string {
 
 	cret := XGtkFontChooserGetFontFeatures(x.GoPointer())
@@ -656,7 +594,10 @@ func (x *FontButton) GetFontFeatures() string {
 }
 
 // Gets the custom font map of this font chooser widget,
-// or %NULL if it does not have one.
+// or nil if it does not have one.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFontMap() *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -671,6 +612,9 @@ func (x *FontButton) GetFontMap() *pango.FontMap {
 }
 
 // The selected font size.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetFontSize() int {
 
 	cret := XGtkFontChooserGetFontSize(x.GoPointer())
@@ -678,6 +622,9 @@ func (x *FontButton) GetFontSize() int {
 }
 
 // Gets the language that is used for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetLanguage() string {
 
 	cret := XGtkFontChooserGetLanguage(x.GoPointer())
@@ -685,6 +632,9 @@ func (x *FontButton) GetLanguage() string {
 }
 
 // Returns the current level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetLevel() FontChooserLevel {
 
 	cret := XGtkFontChooserGetLevel(x.GoPointer())
@@ -692,6 +642,9 @@ func (x *FontButton) GetLevel() FontChooserLevel {
 }
 
 // Gets the text displayed in the preview area.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetPreviewText() string {
 
 	cret := XGtkFontChooserGetPreviewText(x.GoPointer())
@@ -699,6 +652,9 @@ func (x *FontButton) GetPreviewText() string {
 }
 
 // Returns whether the preview entry is shown or not.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) GetShowPreviewEntry() bool {
 
 	cret := XGtkFontChooserGetShowPreviewEntry(x.GoPointer())
@@ -707,6 +663,9 @@ func (x *FontButton) GetShowPreviewEntry() bool {
 
 // Adds a filter function that decides which fonts to display
 // in the font chooser.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	XGtkFontChooserSetFilterFunc(x.GoPointer(), glib.NewCallbackNullable(FilterVar), UserDataVar, glib.NewCallback(DestroyVar))
@@ -714,13 +673,19 @@ func (x *FontButton) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar uintpt
 }
 
 // Sets the currently-selected font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetFont(FontnameVar string) {
 
 	XGtkFontChooserSetFont(x.GoPointer(), FontnameVar)
 
 }
 
-// Sets the currently-selected font from @font_desc.
+// Sets the currently-selected font from font_desc.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetFontDesc(FontDescVar *pango.FontDescription) {
 
 	XGtkFontChooserSetFontDesc(x.GoPointer(), FontDescVar)
@@ -732,26 +697,25 @@ func (x *FontButton) SetFontDesc(FontDescVar *pango.FontDescription) {
 // A custom font map can be used to present application-specific
 // fonts instead of or in addition to the normal system fonts.
 //
-// ```c
-// FcConfig *config;
-// PangoFontMap *fontmap;
+//	FcConfig *config;
+//	PangoFontMap *fontmap;
 //
-// config = FcInitLoadConfigAndFonts ();
-// FcConfigAppFontAddFile (config, my_app_font_file);
+//	config = FcInitLoadConfigAndFonts ();
+//	FcConfigAppFontAddFile (config, my_app_font_file);
 //
-// fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
-// pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
+//	fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
+//	pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
 //
-// gtk_font_chooser_set_font_map (font_chooser, fontmap);
-// ```
+//	gtk_font_chooser_set_font_map (font_chooser, fontmap);
 //
 // Note that other GTK widgets will only be able to use the
 // application-specific font if it is present in the font map they use:
 //
-// ```c
-// context = gtk_widget_get_pango_context (label);
-// pango_context_set_font_map (context, fontmap);
-// ```
+//	context = gtk_widget_get_pango_context (label);
+//	pango_context_set_font_map (context, fontmap);
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetFontMap(FontmapVar *pango.FontMap) {
 
 	XGtkFontChooserSetFontMap(x.GoPointer(), FontmapVar.GoPointer())
@@ -759,6 +723,9 @@ func (x *FontButton) SetFontMap(FontmapVar *pango.FontMap) {
 }
 
 // Sets the language to use for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetLanguage(LanguageVar string) {
 
 	XGtkFontChooserSetLanguage(x.GoPointer(), LanguageVar)
@@ -766,6 +733,9 @@ func (x *FontButton) SetLanguage(LanguageVar string) {
 }
 
 // Sets the desired level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetLevel(LevelVar FontChooserLevel) {
 
 	XGtkFontChooserSetLevel(x.GoPointer(), LevelVar)
@@ -774,7 +744,10 @@ func (x *FontButton) SetLevel(LevelVar FontChooserLevel) {
 
 // Sets the text displayed in the preview area.
 //
-// The @text is used to show how the selected font looks.
+// The text is used to show how the selected font looks.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetPreviewText(TextVar string) {
 
 	XGtkFontChooserSetPreviewText(x.GoPointer(), TextVar)
@@ -782,36 +755,11 @@ func (x *FontButton) SetPreviewText(TextVar string) {
 }
 
 // Shows or hides the editable preview entry.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontButton) SetShowPreviewEntry(ShowPreviewEntryVar bool) {
 
 	XGtkFontChooserSetShowPreviewEntry(x.GoPointer(), ShowPreviewEntryVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFontButtonGLibType, libs, "gtk_font_button_get_type")
-
-	core.PuregoSafeRegister(&xNewFontButton, libs, "gtk_font_button_new")
-	core.PuregoSafeRegister(&xNewFontButtonWithFont, libs, "gtk_font_button_new_with_font")
-
-	core.PuregoSafeRegister(&xFontButtonGetModal, libs, "gtk_font_button_get_modal")
-	core.PuregoSafeRegister(&xFontButtonGetTitle, libs, "gtk_font_button_get_title")
-	core.PuregoSafeRegister(&xFontButtonGetUseFont, libs, "gtk_font_button_get_use_font")
-	core.PuregoSafeRegister(&xFontButtonGetUseSize, libs, "gtk_font_button_get_use_size")
-	core.PuregoSafeRegister(&xFontButtonSetModal, libs, "gtk_font_button_set_modal")
-	core.PuregoSafeRegister(&xFontButtonSetTitle, libs, "gtk_font_button_set_title")
-	core.PuregoSafeRegister(&xFontButtonSetUseFont, libs, "gtk_font_button_set_use_font")
-	core.PuregoSafeRegister(&xFontButtonSetUseSize, libs, "gtk_font_button_set_use_size")
-
-}
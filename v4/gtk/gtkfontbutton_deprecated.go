@@ -0,0 +1,163 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewFontButton func() uintptr
+
+// Creates a new font picker widget.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func NewFontButton() *FontButton {
+	var cls *FontButton
+
+	cret := xNewFontButton()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &FontButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewFontButtonWithFont func(string) uintptr
+
+// Creates a new font picker widget showing the given font.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func NewFontButtonWithFont(FontnameVar string) *FontButton {
+	var cls *FontButton
+
+	cret := xNewFontButtonWithFont(FontnameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &FontButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xFontButtonGetModal func(uintptr) bool
+
+// Gets whether the dialog is modal.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) GetModal() bool {
+
+	cret := xFontButtonGetModal(x.GoPointer())
+	return cret
+}
+
+var xFontButtonGetTitle func(uintptr) string
+
+// Retrieves the title of the font chooser dialog.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) GetTitle() string {
+
+	cret := xFontButtonGetTitle(x.GoPointer())
+	return cret
+}
+
+var xFontButtonGetUseFont func(uintptr) bool
+
+// Returns whether the selected font is used in the label.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) GetUseFont() bool {
+
+	cret := xFontButtonGetUseFont(x.GoPointer())
+	return cret
+}
+
+var xFontButtonGetUseSize func(uintptr) bool
+
+// Returns whether the selected size is used in the label.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) GetUseSize() bool {
+
+	cret := xFontButtonGetUseSize(x.GoPointer())
+	return cret
+}
+
+var xFontButtonSetModal func(uintptr, bool)
+
+// Sets whether the dialog should be modal.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) SetModal(ModalVar bool) {
+
+	xFontButtonSetModal(x.GoPointer(), ModalVar)
+
+}
+
+var xFontButtonSetTitle func(uintptr, string)
+
+// Sets the title for the font chooser dialog.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) SetTitle(TitleVar string) {
+
+	xFontButtonSetTitle(x.GoPointer(), TitleVar)
+
+}
+
+var xFontButtonSetUseFont func(uintptr, bool)
+
+// If use_font is true, the font name will be written
+// using the selected font.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) SetUseFont(UseFontVar bool) {
+
+	xFontButtonSetUseFont(x.GoPointer(), UseFontVar)
+
+}
+
+var xFontButtonSetUseSize func(uintptr, bool)
+
+// If use_size is true, the font name will be written using
+// the selected size.
+//
+// Deprecated: since 4.10. Use [FontDialogButton] instead
+func (x *FontButton) SetUseSize(UseSizeVar bool) {
+
+	xFontButtonSetUseSize(x.GoPointer(), UseSizeVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFontButton, libs, "gtk_font_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewFontButtonWithFont, libs, "gtk_font_button_new_with_font", "GTK", "")
+
+	core.PuregoRegisterSince(&xFontButtonGetModal, libs, "gtk_font_button_get_modal", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonGetTitle, libs, "gtk_font_button_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonGetUseFont, libs, "gtk_font_button_get_use_font", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonGetUseSize, libs, "gtk_font_button_get_use_size", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonSetModal, libs, "gtk_font_button_set_modal", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonSetTitle, libs, "gtk_font_button_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonSetUseFont, libs, "gtk_font_button_set_use_font", "GTK", "")
+	core.PuregoRegisterSince(&xFontButtonSetUseSize, libs, "gtk_font_button_set_use_size", "GTK", "")
+
+}
@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,13 +17,15 @@ import (
 // The type of function that is used for deciding what fonts get
 // shown in a `GtkFontChooser`.
 //
-// See [method@Gtk.FontChooser.set_filter_func].
+// See [FontChooser.SetFilterFunc].
+//
+// Deprecated: since 4.20. There is no replacement
 type FontFilterFunc func(uintptr, uintptr, uintptr) bool
 
 type FontChooserIface struct {
 	_ structs.HostLayout
 
-	BaseIface uintptr
+	BaseIface gobject.TypeInterface
 
 	xGetFontFamily uintptr
 
@@ -50,7 +53,8 @@ func (x *FontChooserIface) OverrideGetFontFamily(cb func(FontChooser) *pango.Fon
 	if cb == nil {
 		x.xGetFontFamily = 0
 	} else {
-		x.xGetFontFamily = purego.NewCallback(func(FontchooserVarp uintptr) uintptr {
+		x.xGetFontFamily = core.NewCallback(func(FontchooserVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FontChooserBase{Ptr: FontchooserVarp})
 			if ret == nil {
 				return 0
@@ -66,7 +70,7 @@ func (x *FontChooserIface) GetGetFontFamily() func(FontChooser) *pango.FontFamil
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFontFamily)
+	core.RegisterFunc(&rawCallback, x.xGetFontFamily)
 	return func(FontchooserVar FontChooser) *pango.FontFamily {
 		rawRet := rawCallback(FontchooserVar.GoPointer())
 		if rawRet == 0 {
@@ -83,7 +87,8 @@ func (x *FontChooserIface) OverrideGetFontFace(cb func(FontChooser) *pango.FontF
 	if cb == nil {
 		x.xGetFontFace = 0
 	} else {
-		x.xGetFontFace = purego.NewCallback(func(FontchooserVarp uintptr) uintptr {
+		x.xGetFontFace = core.NewCallback(func(FontchooserVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FontChooserBase{Ptr: FontchooserVarp})
 			if ret == nil {
 				return 0
@@ -99,7 +104,7 @@ func (x *FontChooserIface) GetGetFontFace() func(FontChooser) *pango.FontFace {
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFontFace)
+	core.RegisterFunc(&rawCallback, x.xGetFontFace)
 	return func(FontchooserVar FontChooser) *pango.FontFace {
 		rawRet := rawCallback(FontchooserVar.GoPointer())
 		if rawRet == 0 {
@@ -116,7 +121,8 @@ func (x *FontChooserIface) OverrideGetFontSize(cb func(FontChooser) int) {
 	if cb == nil {
 		x.xGetFontSize = 0
 	} else {
-		x.xGetFontSize = purego.NewCallback(func(FontchooserVarp uintptr) int {
+		x.xGetFontSize = core.NewCallback(func(FontchooserVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&FontChooserBase{Ptr: FontchooserVarp})
 		})
 	}
@@ -128,7 +134,7 @@ func (x *FontChooserIface) GetGetFontSize() func(FontChooser) int {
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetFontSize)
+	core.RegisterFunc(&rawCallback, x.xGetFontSize)
 	return func(FontchooserVar FontChooser) int {
 		return rawCallback(FontchooserVar.GoPointer())
 	}
@@ -139,7 +145,8 @@ func (x *FontChooserIface) OverrideSetFilterFunc(cb func(FontChooser, *FontFilte
 	if cb == nil {
 		x.xSetFilterFunc = 0
 	} else {
-		x.xSetFilterFunc = purego.NewCallback(func(FontchooserVarp uintptr, FilterVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+		x.xSetFilterFunc = core.NewCallback(func(FontchooserVarp uintptr, FilterVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FontChooserBase{Ptr: FontchooserVarp}, (*FontFilterFunc)(unsafe.Pointer(FilterVarp)), UserDataVarp, (*glib.DestroyNotify)(unsafe.Pointer(DestroyVarp)))
 		})
 	}
@@ -151,7 +158,7 @@ func (x *FontChooserIface) GetSetFilterFunc() func(FontChooser, *FontFilterFunc,
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr, FilterVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetFilterFunc)
+	core.RegisterFunc(&rawCallback, x.xSetFilterFunc)
 	return func(FontchooserVar FontChooser, FilterVar *FontFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 		rawCallback(FontchooserVar.GoPointer(), glib.NewCallbackNullable(FilterVar), UserDataVar, glib.NewCallback(DestroyVar))
 	}
@@ -162,7 +169,8 @@ func (x *FontChooserIface) OverrideFontActivated(cb func(FontChooser, string)) {
 	if cb == nil {
 		x.xFontActivated = 0
 	} else {
-		x.xFontActivated = purego.NewCallback(func(ChooserVarp uintptr, FontnameVarp string) {
+		x.xFontActivated = core.NewCallback(func(ChooserVarp uintptr, FontnameVarp string) {
+			defer core.RecoverPanic()
 			cb(&FontChooserBase{Ptr: ChooserVarp}, FontnameVarp)
 		})
 	}
@@ -174,7 +182,7 @@ func (x *FontChooserIface) GetFontActivated() func(FontChooser, string) {
 		return nil
 	}
 	var rawCallback func(ChooserVarp uintptr, FontnameVarp string)
-	purego.RegisterFunc(&rawCallback, x.xFontActivated)
+	core.RegisterFunc(&rawCallback, x.xFontActivated)
 	return func(ChooserVar FontChooser, FontnameVar string) {
 		rawCallback(ChooserVar.GoPointer(), FontnameVar)
 	}
@@ -185,7 +193,8 @@ func (x *FontChooserIface) OverrideSetFontMap(cb func(FontChooser, *pango.FontMa
 	if cb == nil {
 		x.xSetFontMap = 0
 	} else {
-		x.xSetFontMap = purego.NewCallback(func(FontchooserVarp uintptr, FontmapVarp uintptr) {
+		x.xSetFontMap = core.NewCallback(func(FontchooserVarp uintptr, FontmapVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&FontChooserBase{Ptr: FontchooserVarp}, pango.FontMapNewFromInternalPtr(FontmapVarp))
 		})
 	}
@@ -197,7 +206,7 @@ func (x *FontChooserIface) GetSetFontMap() func(FontChooser, *pango.FontMap) {
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr, FontmapVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetFontMap)
+	core.RegisterFunc(&rawCallback, x.xSetFontMap)
 	return func(FontchooserVar FontChooser, FontmapVar *pango.FontMap) {
 		rawCallback(FontchooserVar.GoPointer(), FontmapVar.GoPointer())
 	}
@@ -208,7 +217,8 @@ func (x *FontChooserIface) OverrideGetFontMap(cb func(FontChooser) *pango.FontMa
 	if cb == nil {
 		x.xGetFontMap = 0
 	} else {
-		x.xGetFontMap = purego.NewCallback(func(FontchooserVarp uintptr) uintptr {
+		x.xGetFontMap = core.NewCallback(func(FontchooserVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&FontChooserBase{Ptr: FontchooserVarp})
 			if ret == nil {
 				return 0
@@ -224,7 +234,7 @@ func (x *FontChooserIface) GetGetFontMap() func(FontChooser) *pango.FontMap {
 		return nil
 	}
 	var rawCallback func(FontchooserVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFontMap)
+	core.RegisterFunc(&rawCallback, x.xGetFontMap)
 	return func(FontchooserVar FontChooser) *pango.FontMap {
 		rawRet := rawCallback(FontchooserVar.GoPointer())
 		if rawRet == 0 {
@@ -240,8 +250,11 @@ func (x *FontChooserIface) GetGetFontMap() func(FontChooser) *pango.FontMap {
 // for choosing fonts.
 //
 // In GTK, the main objects that implement this interface are
-// [class@Gtk.FontChooserWidget], [class@Gtk.FontChooserDialog] and
-// [class@Gtk.FontButton].
+// [FontChooserWidget], [FontChooserDialog] and
+// [FontButton].
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 type FontChooser interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -287,16 +300,85 @@ func (x *FontChooserBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementFontChooser adds the FontChooser interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetFontFamily() *pango.FontFamily
+//   - GetFontFace() *pango.FontFace
+//   - GetFontSize() int
+//   - SetFilterFunc(*FontFilterFunc, uintptr, *glib.DestroyNotify)
+//   - FontActivated(string)
+//   - SetFontMap(*pango.FontMap)
+//   - GetFontMap() *pango.FontMap
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see FontChooserIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementFontChooser[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, FontChooserGLibType(), func(iface *FontChooserIface) {
+		if _, ok := any(zero).(interface{ GetFontFamily() *pango.FontFamily }); ok {
+			iface.OverrideGetFontFamily(func(self FontChooser) *pango.FontFamily {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFontFamily() *pango.FontFamily })
+				return impl.GetFontFamily()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFontFace() *pango.FontFace }); ok {
+			iface.OverrideGetFontFace(func(self FontChooser) *pango.FontFace {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFontFace() *pango.FontFace })
+				return impl.GetFontFace()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFontSize() int }); ok {
+			iface.OverrideGetFontSize(func(self FontChooser) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFontSize() int })
+				return impl.GetFontSize()
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetFilterFunc(*FontFilterFunc, uintptr, *glib.DestroyNotify)
+		}); ok {
+			iface.OverrideSetFilterFunc(func(self FontChooser, arg0 *FontFilterFunc, arg1 uintptr, arg2 *glib.DestroyNotify) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetFilterFunc(*FontFilterFunc, uintptr, *glib.DestroyNotify)
+				})
+				impl.SetFilterFunc(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ FontActivated(string) }); ok {
+			iface.OverrideFontActivated(func(self FontChooser, arg0 string) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ FontActivated(string) })
+				impl.FontActivated(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetFontMap(*pango.FontMap) }); ok {
+			iface.OverrideSetFontMap(func(self FontChooser, arg0 *pango.FontMap) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetFontMap(*pango.FontMap) })
+				impl.SetFontMap(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFontMap() *pango.FontMap }); ok {
+			iface.OverrideGetFontMap(func(self FontChooser) *pango.FontMap {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFontMap() *pango.FontMap })
+				return impl.GetFontMap()
+			})
+		}
+	})
+}
+
 // Gets the currently-selected font name.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFont() string {
 
 	cret := XGtkFontChooserGetFont(x.GoPointer())
@@ -306,13 +388,16 @@ func (x *FontChooserBase) GetFont() string {
 // Gets the currently-selected font.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFontDesc() *pango.FontDescription {
 
 	cret := XGtkFontChooserGetFontDesc(x.GoPointer())
@@ -322,7 +407,10 @@ func (x *FontChooserBase) GetFontDesc() *pango.FontDescription {
 // Gets the `PangoFontFace` representing the selected font group
 // details (i.e. family, slant, weight, width, etc).
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFontFace() *pango.FontFace {
 	var cls *pango.FontFace
 
@@ -341,7 +429,10 @@ func (x *FontChooserBase) GetFontFace() *pango.FontFace {
 //
 // Font families are a collection of font faces.
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFontFamily() *pango.FontFamily {
 	var cls *pango.FontFamily
 
@@ -359,8 +450,13 @@ func (x *FontChooserBase) GetFontFamily() *pango.FontFamily {
 // Gets the currently-selected font features.
 //
 // The format of the returned string is compatible with the
-// [CSS font-feature-settings property](https://www.w3.org/TR/css-fonts-4/#font-rend-desc).
-// It can be passed to [func@Pango.AttrFontFeatures.new].
+// [CSS font-feature-settings property].
+// It can be passed to [pango.AttrFontFeaturesNew].
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
+//
+// [CSS font-feature-settings property]: https://www.w3.org/TR/css-fonts-4/#font-rend-desc
 func (x *FontChooserBase) GetFontFeatures() string {
 
 	cret := XGtkFontChooserGetFontFeatures(x.GoPointer())
@@ -368,7 +464,10 @@ func (x *FontChooserBase) GetFontFeatures() string {
 }
 
 // Gets the custom font map of this font chooser widget,
-// or %NULL if it does not have one.
+// or nil if it does not have one.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFontMap() *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -383,6 +482,9 @@ func (x *FontChooserBase) GetFontMap() *pango.FontMap {
 }
 
 // The selected font size.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetFontSize() int {
 
 	cret := XGtkFontChooserGetFontSize(x.GoPointer())
@@ -390,6 +492,9 @@ func (x *FontChooserBase) GetFontSize() int {
 }
 
 // Gets the language that is used for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetLanguage() string {
 
 	cret := XGtkFontChooserGetLanguage(x.GoPointer())
@@ -397,6 +502,9 @@ func (x *FontChooserBase) GetLanguage() string {
 }
 
 // Returns the current level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetLevel() FontChooserLevel {
 
 	cret := XGtkFontChooserGetLevel(x.GoPointer())
@@ -404,6 +512,9 @@ func (x *FontChooserBase) GetLevel() FontChooserLevel {
 }
 
 // Gets the text displayed in the preview area.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetPreviewText() string {
 
 	cret := XGtkFontChooserGetPreviewText(x.GoPointer())
@@ -411,6 +522,9 @@ func (x *FontChooserBase) GetPreviewText() string {
 }
 
 // Returns whether the preview entry is shown or not.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) GetShowPreviewEntry() bool {
 
 	cret := XGtkFontChooserGetShowPreviewEntry(x.GoPointer())
@@ -419,20 +533,61 @@ func (x *FontChooserBase) GetShowPreviewEntry() bool {
 
 // Adds a filter function that decides which fonts to display
 // in the font chooser.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
-	XGtkFontChooserSetFilterFunc(x.GoPointer(), glib.NewCallbackNullable(FilterVar), UserDataVar, glib.NewCallback(DestroyVar))
+	var FilterVarRef uintptr
+	if FilterVar != nil {
+		FilterVarPtr := uintptr(unsafe.Pointer(FilterVar))
+		if cbRefPtr, ok := glib.GetCallback(FilterVarPtr); ok {
+			FilterVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FilterVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			FilterVarRef = core.NewCallback(fcb)
+			FilterVarRef = glib.SaveCallbackWithClosure(FilterVarPtr, FilterVarRef, FilterVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	XGtkFontChooserSetFilterFunc(x.GoPointer(), FilterVarRef, UserDataVar, DestroyVarRef)
 
 }
 
 // Sets the currently-selected font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetFont(FontnameVar string) {
 
 	XGtkFontChooserSetFont(x.GoPointer(), FontnameVar)
 
 }
 
-// Sets the currently-selected font from @font_desc.
+// Sets the currently-selected font from font_desc.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetFontDesc(FontDescVar *pango.FontDescription) {
 
 	XGtkFontChooserSetFontDesc(x.GoPointer(), FontDescVar)
@@ -444,26 +599,25 @@ func (x *FontChooserBase) SetFontDesc(FontDescVar *pango.FontDescription) {
 // A custom font map can be used to present application-specific
 // fonts instead of or in addition to the normal system fonts.
 //
-// ```c
-// FcConfig *config;
-// PangoFontMap *fontmap;
+//	FcConfig *config;
+//	PangoFontMap *fontmap;
 //
-// config = FcInitLoadConfigAndFonts ();
-// FcConfigAppFontAddFile (config, my_app_font_file);
+//	config = FcInitLoadConfigAndFonts ();
+//	FcConfigAppFontAddFile (config, my_app_font_file);
 //
-// fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
-// pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
+//	fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
+//	pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
 //
-// gtk_font_chooser_set_font_map (font_chooser, fontmap);
-// ```
+//	gtk_font_chooser_set_font_map (font_chooser, fontmap);
 //
 // Note that other GTK widgets will only be able to use the
 // application-specific font if it is present in the font map they use:
 //
-// ```c
-// context = gtk_widget_get_pango_context (label);
-// pango_context_set_font_map (context, fontmap);
-// ```
+//	context = gtk_widget_get_pango_context (label);
+//	pango_context_set_font_map (context, fontmap);
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetFontMap(FontmapVar *pango.FontMap) {
 
 	XGtkFontChooserSetFontMap(x.GoPointer(), FontmapVar.GoPointer())
@@ -471,6 +625,9 @@ func (x *FontChooserBase) SetFontMap(FontmapVar *pango.FontMap) {
 }
 
 // Sets the language to use for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetLanguage(LanguageVar string) {
 
 	XGtkFontChooserSetLanguage(x.GoPointer(), LanguageVar)
@@ -478,6 +635,9 @@ func (x *FontChooserBase) SetLanguage(LanguageVar string) {
 }
 
 // Sets the desired level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetLevel(LevelVar FontChooserLevel) {
 
 	XGtkFontChooserSetLevel(x.GoPointer(), LevelVar)
@@ -486,7 +646,10 @@ func (x *FontChooserBase) SetLevel(LevelVar FontChooserLevel) {
 
 // Sets the text displayed in the preview area.
 //
-// The @text is used to show how the selected font looks.
+// The text is used to show how the selected font looks.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetPreviewText(TextVar string) {
 
 	XGtkFontChooserSetPreviewText(x.GoPointer(), TextVar)
@@ -494,6 +657,9 @@ func (x *FontChooserBase) SetPreviewText(TextVar string) {
 }
 
 // Shows or hides the editable preview entry.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserBase) SetShowPreviewEntry(ShowPreviewEntryVar bool) {
 
 	XGtkFontChooserSetShowPreviewEntry(x.GoPointer(), ShowPreviewEntryVar)
@@ -502,6 +668,8 @@ func (x *FontChooserBase) SetShowPreviewEntry(ShowPreviewEntryVar bool) {
 
 // SetPropertyFont sets the "font" property.
 // The font description as a string, e.g. "Sans Italic 12".
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) SetPropertyFont(value string) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -513,6 +681,8 @@ func (x *FontChooserBase) SetPropertyFont(value string) {
 
 // GetPropertyFont gets the "font" property.
 // The font description as a string, e.g. "Sans Italic 12".
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyFont() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -523,6 +693,8 @@ func (x *FontChooserBase) GetPropertyFont() string {
 
 // SetPropertyFontDesc sets the "font-desc" property.
 // The font description as a `PangoFontDescription`.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) SetPropertyFontDesc(value uintptr) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -534,6 +706,8 @@ func (x *FontChooserBase) SetPropertyFontDesc(value uintptr) {
 
 // GetPropertyFontDesc gets the "font-desc" property.
 // The font description as a `PangoFontDescription`.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyFontDesc() uintptr {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -547,6 +721,8 @@ func (x *FontChooserBase) GetPropertyFontDesc() uintptr {
 //
 // The format of the string is compatible with
 // CSS and with Pango attributes.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyFontFeatures() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -557,6 +733,8 @@ func (x *FontChooserBase) GetPropertyFontFeatures() string {
 
 // SetPropertyLanguage sets the "language" property.
 // The language for which the font features were selected.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) SetPropertyLanguage(value string) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -568,6 +746,8 @@ func (x *FontChooserBase) SetPropertyLanguage(value string) {
 
 // GetPropertyLanguage gets the "language" property.
 // The language for which the font features were selected.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyLanguage() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -578,6 +758,8 @@ func (x *FontChooserBase) GetPropertyLanguage() string {
 
 // SetPropertyPreviewText sets the "preview-text" property.
 // The string with which to preview the font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) SetPropertyPreviewText(value string) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -589,6 +771,8 @@ func (x *FontChooserBase) SetPropertyPreviewText(value string) {
 
 // GetPropertyPreviewText gets the "preview-text" property.
 // The string with which to preview the font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyPreviewText() string {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -599,6 +783,8 @@ func (x *FontChooserBase) GetPropertyPreviewText() string {
 
 // SetPropertyShowPreviewEntry sets the "show-preview-entry" property.
 // Whether to show an entry to change the preview text.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) SetPropertyShowPreviewEntry(value bool) {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -610,6 +796,8 @@ func (x *FontChooserBase) SetPropertyShowPreviewEntry(value bool) {
 
 // GetPropertyShowPreviewEntry gets the "show-preview-entry" property.
 // Whether to show an entry to change the preview text.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton] instead
 func (x *FontChooserBase) GetPropertyShowPreviewEntry() bool {
 	obj := gobject.Object{}
 	obj.Ptr = x.GoPointer()
@@ -643,7 +831,9 @@ var XGtkFontChooserSetShowPreviewEntry func(uintptr, bool)
 //
 // This enumeration may be extended in the future; applications should
 // ignore unknown values.
-type FontChooserLevel int
+//
+// Deprecated: since 4.20. There is no replacement.
+type FontChooserLevel uint32
 
 var xFontChooserLevelGLibType func() types.GType
 
@@ -665,12 +855,61 @@ const (
 	FontChooserLevelFeaturesValue FontChooserLevel = 8
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FontChooserLevel) GLibType() types.GType {
+	return xFontChooserLevelGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FontChooserLevel) Has(flags FontChooserLevel) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FontChooserLevel) Set(flags FontChooserLevel) FontChooserLevel {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FontChooserLevel) Clear(flags FontChooserLevel) FontChooserLevel {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FontChooserLevel) String() string {
+	if x == 0 {
+		return "GTK_FONT_CHOOSER_LEVEL_FAMILY"
+	}
+	var names []string
+	rest := x
+	if rest&FontChooserLevelStyleValue != 0 && x&FontChooserLevelStyleValue == FontChooserLevelStyleValue {
+		names = append(names, "GTK_FONT_CHOOSER_LEVEL_STYLE")
+		rest &^= FontChooserLevelStyleValue
+	}
+	if rest&FontChooserLevelSizeValue != 0 && x&FontChooserLevelSizeValue == FontChooserLevelSizeValue {
+		names = append(names, "GTK_FONT_CHOOSER_LEVEL_SIZE")
+		rest &^= FontChooserLevelSizeValue
+	}
+	if rest&FontChooserLevelVariationsValue != 0 && x&FontChooserLevelVariationsValue == FontChooserLevelVariationsValue {
+		names = append(names, "GTK_FONT_CHOOSER_LEVEL_VARIATIONS")
+		rest &^= FontChooserLevelVariationsValue
+	}
+	if rest&FontChooserLevelFeaturesValue != 0 && x&FontChooserLevelFeaturesValue == FontChooserLevelFeaturesValue {
+		names = append(names, "GTK_FONT_CHOOSER_LEVEL_FEATURES")
+		rest &^= FontChooserLevelFeaturesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 func init() {
 	core.SetPackageName("GTK", "gtk4")
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -681,24 +920,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontChooserGLibType, libs, "gtk_font_chooser_get_type")
 
-	core.PuregoSafeRegister(&XGtkFontChooserGetFont, libs, "gtk_font_chooser_get_font")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontDesc, libs, "gtk_font_chooser_get_font_desc")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontFace, libs, "gtk_font_chooser_get_font_face")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontFamily, libs, "gtk_font_chooser_get_font_family")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontFeatures, libs, "gtk_font_chooser_get_font_features")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontMap, libs, "gtk_font_chooser_get_font_map")
-	core.PuregoSafeRegister(&XGtkFontChooserGetFontSize, libs, "gtk_font_chooser_get_font_size")
-	core.PuregoSafeRegister(&XGtkFontChooserGetLanguage, libs, "gtk_font_chooser_get_language")
-	core.PuregoSafeRegister(&XGtkFontChooserGetLevel, libs, "gtk_font_chooser_get_level")
-	core.PuregoSafeRegister(&XGtkFontChooserGetPreviewText, libs, "gtk_font_chooser_get_preview_text")
-	core.PuregoSafeRegister(&XGtkFontChooserGetShowPreviewEntry, libs, "gtk_font_chooser_get_show_preview_entry")
-	core.PuregoSafeRegister(&XGtkFontChooserSetFilterFunc, libs, "gtk_font_chooser_set_filter_func")
-	core.PuregoSafeRegister(&XGtkFontChooserSetFont, libs, "gtk_font_chooser_set_font")
-	core.PuregoSafeRegister(&XGtkFontChooserSetFontDesc, libs, "gtk_font_chooser_set_font_desc")
-	core.PuregoSafeRegister(&XGtkFontChooserSetFontMap, libs, "gtk_font_chooser_set_font_map")
-	core.PuregoSafeRegister(&XGtkFontChooserSetLanguage, libs, "gtk_font_chooser_set_language")
-	core.PuregoSafeRegister(&XGtkFontChooserSetLevel, libs, "gtk_font_chooser_set_level")
-	core.PuregoSafeRegister(&XGtkFontChooserSetPreviewText, libs, "gtk_font_chooser_set_preview_text")
-	core.PuregoSafeRegister(&XGtkFontChooserSetShowPreviewEntry, libs, "gtk_font_chooser_set_show_preview_entry")
+	gobject.CheckClassSize("GtkFontChooserIface", xFontChooserGLibType, unsafe.Sizeof(FontChooserIface{}))
+
+	core.PuregoRegisterSince(&XGtkFontChooserGetFont, libs, "gtk_font_chooser_get_font", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontDesc, libs, "gtk_font_chooser_get_font_desc", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontFace, libs, "gtk_font_chooser_get_font_face", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontFamily, libs, "gtk_font_chooser_get_font_family", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontFeatures, libs, "gtk_font_chooser_get_font_features", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontMap, libs, "gtk_font_chooser_get_font_map", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetFontSize, libs, "gtk_font_chooser_get_font_size", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetLanguage, libs, "gtk_font_chooser_get_language", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetLevel, libs, "gtk_font_chooser_get_level", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetPreviewText, libs, "gtk_font_chooser_get_preview_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserGetShowPreviewEntry, libs, "gtk_font_chooser_get_show_preview_entry", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetFilterFunc, libs, "gtk_font_chooser_set_filter_func", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetFont, libs, "gtk_font_chooser_set_font", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetFontDesc, libs, "gtk_font_chooser_set_font_desc", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetFontMap, libs, "gtk_font_chooser_set_font_map", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetLanguage, libs, "gtk_font_chooser_set_language", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetLevel, libs, "gtk_font_chooser_set_level", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetPreviewText, libs, "gtk_font_chooser_set_preview_text", "GTK", "")
+	core.PuregoRegisterSince(&XGtkFontChooserSetShowPreviewEntry, libs, "gtk_font_chooser_set_show_preview_entry", "GTK", "")
 
 }
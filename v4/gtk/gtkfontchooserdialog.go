@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -14,17 +12,10 @@ import (
 
 // The `GtkFontChooserDialog` widget is a dialog for selecting a font.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="fontchooser-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkFontChooserDialog" src="fontchooser.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// `GtkFontChooserDialog` implements the [iface@Gtk.FontChooser] interface
+// `GtkFontChooserDialog` implements the [FontChooser] interface
 // and does not provide much API of its own.
 //
-// To create a `GtkFontChooserDialog`, use [ctor@Gtk.FontChooserDialog.new].
+// To create a `GtkFontChooserDialog`, use [NewFontChooserDialog].
 //
 // # GtkFontChooserDialog as GtkBuildable
 //
@@ -32,10 +23,12 @@ import (
 // interface exposes the buttons with the names “select_button”
 // and “cancel_button”.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkFontChooserDialog` has a single CSS node with the name `window` and style
 // class `.fontchooser`.
+//
+// Deprecated: since 4.10. Use [FontDialog] instead
 type FontChooserDialog struct {
 	Dialog
 }
@@ -52,26 +45,6 @@ func FontChooserDialogNewFromInternalPtr(ptr uintptr) *FontChooserDialog {
 	return cls
 }
 
-var xNewFontChooserDialog func(uintptr, uintptr) uintptr
-
-// Creates a new `GtkFontChooserDialog`.
-func NewFontChooserDialog(TitleVar *string, ParentVar *Window) *FontChooserDialog {
-	var cls *FontChooserDialog
-
-	TitleVarPtr := core.GStrdupNullable(TitleVar)
-	defer core.GFreeNullable(TitleVarPtr)
-
-	cret := xNewFontChooserDialog(TitleVarPtr, ParentVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FontChooserDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *FontChooserDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -244,7 +217,7 @@ func (x *FontChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -252,14 +225,13 @@ func (x *FontChooserDialog) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -283,19 +255,17 @@ func (x *FontChooserDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -316,7 +286,7 @@ func (x *FontChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -325,14 +295,12 @@ func (x *FontChooserDialog) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -351,10 +319,10 @@ func (x *FontChooserDialog) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FontChooserDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -364,13 +332,16 @@ func (x *FontChooserDialog) GetBuildableId() string {
 // Gets the currently-selected font name.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFont() string {
 
 	cret := XGtkFontChooserGetFont(x.GoPointer())
@@ -380,13 +351,16 @@ func (x *FontChooserDialog) GetFont() string {
 // Gets the currently-selected font.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFontDesc() *pango.FontDescription {
 
 	cret := XGtkFontChooserGetFontDesc(x.GoPointer())
@@ -396,7 +370,10 @@ func (x *FontChooserDialog) GetFontDesc() *pango.FontDescription {
 // Gets the `PangoFontFace` representing the selected font group
 // details (i.e. family, slant, weight, width, etc).
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFontFace() *pango.FontFace {
 	var cls *pango.FontFace
 
@@ -415,7 +392,10 @@ func (x *FontChooserDialog) GetFontFace() *pango.FontFace {
 //
 // Font families are a collection of font faces.
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFontFamily() *pango.FontFamily {
 	var cls *pango.FontFamily
 
@@ -433,8 +413,13 @@ func (x *FontChooserDialog) GetFontFamily() *pango.FontFamily {
 // Gets the currently-selected font features.
 //
 // The format of the returned string is compatible with the
-// [CSS font-feature-settings property](https://www.w3.org/TR/css-fonts-4/#font-rend-desc).
-// It can be passed to [func@Pango.AttrFontFeatures.new].
+// [CSS font-feature-settings property].
+// It can be passed to [pango.AttrFontFeaturesNew].
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
+//
+// [CSS font-feature-settings property]: https://www.w3.org/TR/css-fonts-4/#font-rend-desc
 func (x *FontChooserDialog) GetFontFeatures() string {
 
 	cret := XGtkFontChooserGetFontFeatures(x.GoPointer())
@@ -442,7 +427,10 @@ func (x *FontChooserDialog) GetFontFeatures() string {
 }
 
 // Gets the custom font map of this font chooser widget,
-// or %NULL if it does not have one.
+// or nil if it does not have one.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFontMap() *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -457,6 +445,9 @@ func (x *FontChooserDialog) GetFontMap() *pango.FontMap {
 }
 
 // The selected font size.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetFontSize() int {
 
 	cret := XGtkFontChooserGetFontSize(x.GoPointer())
@@ -464,6 +455,9 @@ func (x *FontChooserDialog) GetFontSize() int {
 }
 
 // Gets the language that is used for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetLanguage() string {
 
 	cret := XGtkFontChooserGetLanguage(x.GoPointer())
@@ -471,6 +465,9 @@ func (x *FontChooserDialog) GetLanguage() string {
 }
 
 // Returns the current level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetLevel() FontChooserLevel {
 
 	cret := XGtkFontChooserGetLevel(x.GoPointer())
@@ -478,6 +475,9 @@ func (x *FontChooserDialog) GetLevel() FontChooserLevel {
 }
 
 // Gets the text displayed in the preview area.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetPreviewText() string {
 
 	cret := XGtkFontChooserGetPreviewText(x.GoPointer())
@@ -485,6 +485,9 @@ func (x *FontChooserDialog) GetPreviewText() string {
 }
 
 // Returns whether the preview entry is shown or not.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) GetShowPreviewEntry() bool {
 
 	cret := XGtkFontChooserGetShowPreviewEntry(x.GoPointer())
@@ -493,6 +496,9 @@ func (x *FontChooserDialog) GetShowPreviewEntry() bool {
 
 // Adds a filter function that decides which fonts to display
 // in the font chooser.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	XGtkFontChooserSetFilterFunc(x.GoPointer(), glib.NewCallbackNullable(FilterVar), UserDataVar, glib.NewCallback(DestroyVar))
@@ -500,13 +506,19 @@ func (x *FontChooserDialog) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar
 }
 
 // Sets the currently-selected font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetFont(FontnameVar string) {
 
 	XGtkFontChooserSetFont(x.GoPointer(), FontnameVar)
 
 }
 
-// Sets the currently-selected font from @font_desc.
+// Sets the currently-selected font from font_desc.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetFontDesc(FontDescVar *pango.FontDescription) {
 
 	XGtkFontChooserSetFontDesc(x.GoPointer(), FontDescVar)
@@ -518,26 +530,25 @@ func (x *FontChooserDialog) SetFontDesc(FontDescVar *pango.FontDescription) {
 // A custom font map can be used to present application-specific
 // fonts instead of or in addition to the normal system fonts.
 //
-// ```c
-// FcConfig *config;
-// PangoFontMap *fontmap;
+//	FcConfig *config;
+//	PangoFontMap *fontmap;
 //
-// config = FcInitLoadConfigAndFonts ();
-// FcConfigAppFontAddFile (config, my_app_font_file);
+//	config = FcInitLoadConfigAndFonts ();
+//	FcConfigAppFontAddFile (config, my_app_font_file);
 //
-// fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
-// pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
+//	fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
+//	pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
 //
-// gtk_font_chooser_set_font_map (font_chooser, fontmap);
-// ```
+//	gtk_font_chooser_set_font_map (font_chooser, fontmap);
 //
 // Note that other GTK widgets will only be able to use the
 // application-specific font if it is present in the font map they use:
 //
-// ```c
-// context = gtk_widget_get_pango_context (label);
-// pango_context_set_font_map (context, fontmap);
-// ```
+//	context = gtk_widget_get_pango_context (label);
+//	pango_context_set_font_map (context, fontmap);
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetFontMap(FontmapVar *pango.FontMap) {
 
 	XGtkFontChooserSetFontMap(x.GoPointer(), FontmapVar.GoPointer())
@@ -545,6 +556,9 @@ func (x *FontChooserDialog) SetFontMap(FontmapVar *pango.FontMap) {
 }
 
 // Sets the language to use for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetLanguage(LanguageVar string) {
 
 	XGtkFontChooserSetLanguage(x.GoPointer(), LanguageVar)
@@ -552,6 +566,9 @@ func (x *FontChooserDialog) SetLanguage(LanguageVar string) {
 }
 
 // Sets the desired level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetLevel(LevelVar FontChooserLevel) {
 
 	XGtkFontChooserSetLevel(x.GoPointer(), LevelVar)
@@ -560,7 +577,10 @@ func (x *FontChooserDialog) SetLevel(LevelVar FontChooserLevel) {
 
 // Sets the text displayed in the preview area.
 //
-// The @text is used to show how the selected font looks.
+// The text is used to show how the selected font looks.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetPreviewText(TextVar string) {
 
 	XGtkFontChooserSetPreviewText(x.GoPointer(), TextVar)
@@ -568,6 +588,9 @@ func (x *FontChooserDialog) SetPreviewText(TextVar string) {
 }
 
 // Shows or hides the editable preview entry.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserDialog) SetShowPreviewEntry(ShowPreviewEntryVar bool) {
 
 	XGtkFontChooserSetShowPreviewEntry(x.GoPointer(), ShowPreviewEntryVar)
@@ -604,10 +627,10 @@ func (x *FontChooserDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *FontChooserDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -651,7 +674,7 @@ func (x *FontChooserDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *FontChooserDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -667,34 +690,16 @@ func (x *FontChooserDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *FontChooserDialog) SetFocus(FocusVar *Widget) {
 
 	XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFontChooserDialogGLibType, libs, "gtk_font_chooser_dialog_get_type")
-
-	core.PuregoSafeRegister(&xNewFontChooserDialog, libs, "gtk_font_chooser_dialog_new")
-
-}
@@ -0,0 +1,47 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewFontChooserDialog func(uintptr, uintptr) uintptr
+
+// Creates a new `GtkFontChooserDialog`.
+//
+// Deprecated: since 4.10. Use [FontDialog] instead
+func NewFontChooserDialog(TitleVar *string, ParentVar *Window) *FontChooserDialog {
+	var cls *FontChooserDialog
+
+	TitleVarPtr := core.GStrdupNullable(TitleVar)
+	defer core.GFreeNullable(TitleVarPtr)
+
+	cret := xNewFontChooserDialog(TitleVarPtr, ParentVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &FontChooserDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFontChooserDialog, libs, "gtk_font_chooser_dialog_new", "GTK", "")
+
+}
@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -16,17 +14,19 @@ import (
 // dialog for selecting fonts.
 //
 // To set the font which is initially selected, use
-// [method@Gtk.FontChooser.set_font] or [method@Gtk.FontChooser.set_font_desc].
+// [FontChooser.SetFont] or [FontChooser.SetFontDesc].
 //
-// To get the selected font use [method@Gtk.FontChooser.get_font] or
-// [method@Gtk.FontChooser.get_font_desc].
+// To get the selected font use [FontChooser.GetFont] or
+// [FontChooser.GetFontDesc].
 //
 // To change the text which is shown in the preview area, use
-// [method@Gtk.FontChooser.set_preview_text].
+// [FontChooser.SetPreviewText].
 //
 // # CSS nodes
 //
 // `GtkFontChooserWidget` has a single CSS node with name fontchooser.
+//
+// Deprecated: since 4.10. Direct use of `GtkFontChooserWidget` is deprecated.
 type FontChooserWidget struct {
 	Widget
 }
@@ -43,23 +43,6 @@ func FontChooserWidgetNewFromInternalPtr(ptr uintptr) *FontChooserWidget {
 	return cls
 }
 
-var xNewFontChooserWidget func() uintptr
-
-// Creates a new `GtkFontChooserWidget`.
-func NewFontChooserWidget() *FontChooserWidget {
-	var cls *FontChooserWidget
-
-	cret := xNewFontChooserWidget()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &FontChooserWidget{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *FontChooserWidget) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -232,7 +215,7 @@ func (x *FontChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -240,14 +223,13 @@ func (x *FontChooserWidget) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserWidget) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -271,19 +253,17 @@ func (x *FontChooserWidget) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserWidget) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -304,7 +284,7 @@ func (x *FontChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -313,14 +293,12 @@ func (x *FontChooserWidget) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FontChooserWidget) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -339,10 +317,10 @@ func (x *FontChooserWidget) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FontChooserWidget) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -352,13 +330,16 @@ func (x *FontChooserWidget) GetBuildableId() string {
 // Gets the currently-selected font name.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFont() string {
 
 	cret := XGtkFontChooserGetFont(x.GoPointer())
@@ -368,13 +349,16 @@ func (x *FontChooserWidget) GetFont() string {
 // Gets the currently-selected font.
 //
 // Note that this can be a different string than what you set with
-// [method@Gtk.FontChooser.set_font], as the font chooser widget may
+// [FontChooser.SetFont], as the font chooser widget may
 // normalize font names and thus return a string with a different
 // structure. For example, “Helvetica Italic Bold 12” could be
 // normalized to “Helvetica Bold Italic 12”.
 //
-// Use [method@Pango.FontDescription.equal] if you want to compare two
+// Use [pango.FontDescription.Equal] if you want to compare two
 // font descriptions.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFontDesc() *pango.FontDescription {
 
 	cret := XGtkFontChooserGetFontDesc(x.GoPointer())
@@ -384,7 +368,10 @@ func (x *FontChooserWidget) GetFontDesc() *pango.FontDescription {
 // Gets the `PangoFontFace` representing the selected font group
 // details (i.e. family, slant, weight, width, etc).
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFontFace() *pango.FontFace {
 	var cls *pango.FontFace
 
@@ -403,7 +390,10 @@ func (x *FontChooserWidget) GetFontFace() *pango.FontFace {
 //
 // Font families are a collection of font faces.
 //
-// If the selected font is not installed, returns %NULL.
+// If the selected font is not installed, returns nil.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFontFamily() *pango.FontFamily {
 	var cls *pango.FontFamily
 
@@ -421,8 +411,13 @@ func (x *FontChooserWidget) GetFontFamily() *pango.FontFamily {
 // Gets the currently-selected font features.
 //
 // The format of the returned string is compatible with the
-// [CSS font-feature-settings property](https://www.w3.org/TR/css-fonts-4/#font-rend-desc).
-// It can be passed to [func@Pango.AttrFontFeatures.new].
+// [CSS font-feature-settings property].
+// It can be passed to [pango.AttrFontFeaturesNew].
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
+//
+// [CSS font-feature-settings property]: https://www.w3.org/TR/css-fonts-4/#font-rend-desc
 func (x *FontChooserWidget) GetFontFeatures() string {
 
 	cret := XGtkFontChooserGetFontFeatures(x.GoPointer())
@@ -430,7 +425,10 @@ func (x *FontChooserWidget) GetFontFeatures() string {
 }
 
 // Gets the custom font map of this font chooser widget,
-// or %NULL if it does not have one.
+// or nil if it does not have one.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFontMap() *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -445,6 +443,9 @@ func (x *FontChooserWidget) GetFontMap() *pango.FontMap {
 }
 
 // The selected font size.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetFontSize() int {
 
 	cret := XGtkFontChooserGetFontSize(x.GoPointer())
@@ -452,6 +453,9 @@ func (x *FontChooserWidget) GetFontSize() int {
 }
 
 // Gets the language that is used for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetLanguage() string {
 
 	cret := XGtkFontChooserGetLanguage(x.GoPointer())
@@ -459,6 +463,9 @@ func (x *FontChooserWidget) GetLanguage() string {
 }
 
 // Returns the current level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetLevel() FontChooserLevel {
 
 	cret := XGtkFontChooserGetLevel(x.GoPointer())
@@ -466,6 +473,9 @@ func (x *FontChooserWidget) GetLevel() FontChooserLevel {
 }
 
 // Gets the text displayed in the preview area.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetPreviewText() string {
 
 	cret := XGtkFontChooserGetPreviewText(x.GoPointer())
@@ -473,6 +483,9 @@ func (x *FontChooserWidget) GetPreviewText() string {
 }
 
 // Returns whether the preview entry is shown or not.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) GetShowPreviewEntry() bool {
 
 	cret := XGtkFontChooserGetShowPreviewEntry(x.GoPointer())
@@ -481,6 +494,9 @@ func (x *FontChooserWidget) GetShowPreviewEntry() bool {
 
 // Adds a filter function that decides which fonts to display
 // in the font chooser.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	XGtkFontChooserSetFilterFunc(x.GoPointer(), glib.NewCallbackNullable(FilterVar), UserDataVar, glib.NewCallback(DestroyVar))
@@ -488,13 +504,19 @@ func (x *FontChooserWidget) SetFilterFunc(FilterVar *FontFilterFunc, UserDataVar
 }
 
 // Sets the currently-selected font.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetFont(FontnameVar string) {
 
 	XGtkFontChooserSetFont(x.GoPointer(), FontnameVar)
 
 }
 
-// Sets the currently-selected font from @font_desc.
+// Sets the currently-selected font from font_desc.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetFontDesc(FontDescVar *pango.FontDescription) {
 
 	XGtkFontChooserSetFontDesc(x.GoPointer(), FontDescVar)
@@ -506,26 +528,25 @@ func (x *FontChooserWidget) SetFontDesc(FontDescVar *pango.FontDescription) {
 // A custom font map can be used to present application-specific
 // fonts instead of or in addition to the normal system fonts.
 //
-// ```c
-// FcConfig *config;
-// PangoFontMap *fontmap;
+//	FcConfig *config;
+//	PangoFontMap *fontmap;
 //
-// config = FcInitLoadConfigAndFonts ();
-// FcConfigAppFontAddFile (config, my_app_font_file);
+//	config = FcInitLoadConfigAndFonts ();
+//	FcConfigAppFontAddFile (config, my_app_font_file);
 //
-// fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
-// pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
+//	fontmap = pango_cairo_font_map_new_for_font_type (CAIRO_FONT_TYPE_FT);
+//	pango_fc_font_map_set_config (PANGO_FC_FONT_MAP (fontmap), config);
 //
-// gtk_font_chooser_set_font_map (font_chooser, fontmap);
-// ```
+//	gtk_font_chooser_set_font_map (font_chooser, fontmap);
 //
 // Note that other GTK widgets will only be able to use the
 // application-specific font if it is present in the font map they use:
 //
-// ```c
-// context = gtk_widget_get_pango_context (label);
-// pango_context_set_font_map (context, fontmap);
-// ```
+//	context = gtk_widget_get_pango_context (label);
+//	pango_context_set_font_map (context, fontmap);
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetFontMap(FontmapVar *pango.FontMap) {
 
 	XGtkFontChooserSetFontMap(x.GoPointer(), FontmapVar.GoPointer())
@@ -533,6 +554,9 @@ func (x *FontChooserWidget) SetFontMap(FontmapVar *pango.FontMap) {
 }
 
 // Sets the language to use for font features.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetLanguage(LanguageVar string) {
 
 	XGtkFontChooserSetLanguage(x.GoPointer(), LanguageVar)
@@ -540,6 +564,9 @@ func (x *FontChooserWidget) SetLanguage(LanguageVar string) {
 }
 
 // Sets the desired level of granularity for selecting fonts.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetLevel(LevelVar FontChooserLevel) {
 
 	XGtkFontChooserSetLevel(x.GoPointer(), LevelVar)
@@ -548,7 +575,10 @@ func (x *FontChooserWidget) SetLevel(LevelVar FontChooserLevel) {
 
 // Sets the text displayed in the preview area.
 //
-// The @text is used to show how the selected font looks.
+// The text is used to show how the selected font looks.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetPreviewText(TextVar string) {
 
 	XGtkFontChooserSetPreviewText(x.GoPointer(), TextVar)
@@ -556,26 +586,11 @@ func (x *FontChooserWidget) SetPreviewText(TextVar string) {
 }
 
 // Shows or hides the editable preview entry.
+//
+// Deprecated: since 4.10. Use [FontDialog] and [FontDialogButton]
+// instead
 func (x *FontChooserWidget) SetShowPreviewEntry(ShowPreviewEntryVar bool) {
 
 	XGtkFontChooserSetShowPreviewEntry(x.GoPointer(), ShowPreviewEntryVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xFontChooserWidgetGLibType, libs, "gtk_font_chooser_widget_get_type")
-
-	core.PuregoSafeRegister(&xNewFontChooserWidget, libs, "gtk_font_chooser_widget_new")
-
-}
@@ -0,0 +1,44 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewFontChooserWidget func() uintptr
+
+// Creates a new `GtkFontChooserWidget`.
+//
+// Deprecated: since 4.10. Direct use of `GtkFontChooserWidget` is deprecated.
+func NewFontChooserWidget() *FontChooserWidget {
+	var cls *FontChooserWidget
+
+	cret := xNewFontChooserWidget()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &FontChooserWidget{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewFontChooserWidget, libs, "gtk_font_chooser_widget_new", "GTK", "")
+
+}
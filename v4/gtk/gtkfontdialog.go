@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -30,10 +29,10 @@ func (x *FontDialogClass) GoPointer() uintptr {
 // the dialog to the user, such as a title for the dialog and whether
 // it should be modal.
 //
-// The dialog is shown with the [method@Gtk.FontDialog.choose_font]
+// The dialog is shown with the [FontDialog.ChooseFont]
 // function or its variants.
 //
-// See [class@Gtk.FontDialogButton] for a convenient control
+// See [FontDialogButton] for a convenient control
 // that uses `GtkFontDialog` and presents the results.
 type FontDialog struct {
 	gobject.Object
@@ -83,11 +82,12 @@ func (x *FontDialog) ChooseFace(ParentVar *Window, InitialValueVar *pango.FontFa
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -97,9 +97,9 @@ func (x *FontDialog) ChooseFace(ParentVar *Window, InitialValueVar *pango.FontFa
 
 var xFontDialogChooseFaceFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FontDialog.choose_face] call.
+// Finishes the [FontDialog.ChooseFace] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FontDialog) ChooseFaceFinish(ResultVar gio.AsyncResult) (*pango.FontFace, error) {
 	var cls *pango.FontFace
@@ -108,12 +108,15 @@ func (x *FontDialog) ChooseFaceFinish(ResultVar gio.AsyncResult) (*pango.FontFac
 	cret := xFontDialogChooseFaceFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &pango.FontFace{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -133,11 +136,12 @@ func (x *FontDialog) ChooseFamily(ParentVar *Window, InitialValueVar *pango.Font
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -147,9 +151,9 @@ func (x *FontDialog) ChooseFamily(ParentVar *Window, InitialValueVar *pango.Font
 
 var xFontDialogChooseFamilyFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.FontDialog.choose_family] call.
+// Finishes the [FontDialog.ChooseFamily] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FontDialog) ChooseFamilyFinish(ResultVar gio.AsyncResult) (*pango.FontFamily, error) {
 	var cls *pango.FontFamily
@@ -158,12 +162,15 @@ func (x *FontDialog) ChooseFamilyFinish(ResultVar gio.AsyncResult) (*pango.FontF
 	cret := xFontDialogChooseFamilyFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &pango.FontFamily{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -176,7 +183,7 @@ var xFontDialogChooseFont func(uintptr, uintptr, *pango.FontDescription, uintptr
 // The font chooser dialog will be set up for selecting a font.
 //
 // If you want to let the user select font features as well,
-// use [method@Gtk.FontDialog.choose_font_and_features] instead.
+// use [FontDialog.ChooseFontAndFeatures] instead.
 func (x *FontDialog) ChooseFont(ParentVar *Window, InitialValueVar *pango.FontDescription, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -186,11 +193,12 @@ func (x *FontDialog) ChooseFont(ParentVar *Window, InitialValueVar *pango.FontDe
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -216,11 +224,12 @@ func (x *FontDialog) ChooseFontAndFeatures(ParentVar *Window, InitialValueVar *p
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -230,19 +239,19 @@ func (x *FontDialog) ChooseFontAndFeatures(ParentVar *Window, InitialValueVar *p
 
 var xFontDialogChooseFontAndFeaturesFinish func(uintptr, uintptr, **pango.FontDescription, *string, **pango.Language, **glib.Error) bool
 
-// Finishes the [method@Gtk.FontDialog.choose_font_and_features] call.
+// Finishes the [FontDialog.ChooseFontAndFeatures] call.
 //
-// The selected font and features are returned in @font_desc and
-// @font_features.
+// The selected font and features are returned in font_desc and
+// font_features.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FontDialog) ChooseFontAndFeaturesFinish(ResultVar gio.AsyncResult, FontDescVar **pango.FontDescription, FontFeaturesVar *string, LanguageVar **pango.Language) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xFontDialogChooseFontAndFeaturesFinish(x.GoPointer(), ResultVar.GoPointer(), FontDescVar, FontFeaturesVar, LanguageVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -250,16 +259,16 @@ func (x *FontDialog) ChooseFontAndFeaturesFinish(ResultVar gio.AsyncResult, Font
 
 var xFontDialogChooseFontFinish func(uintptr, uintptr, **glib.Error) *pango.FontDescription
 
-// Finishes the [method@Gtk.FontDialog.choose_font] call.
+// Finishes the [FontDialog.ChooseFont] call.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *FontDialog) ChooseFontFinish(ResultVar gio.AsyncResult) (*pango.FontDescription, error) {
 	var cerr *glib.Error
 
 	cret := xFontDialogChooseFontFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -346,7 +355,7 @@ var xFontDialogSetFontMap func(uintptr, uintptr)
 
 // Sets the fontmap from which fonts are selected.
 //
-// If @fontmap is `NULL`, the default fontmap is used.
+// If fontmap is `NULL`, the default fontmap is used.
 func (x *FontDialog) SetFontMap(FontmapVar *pango.FontMap) {
 
 	xFontDialogSetFontMap(x.GoPointer(), FontmapVar.GoPointer())
@@ -428,7 +437,7 @@ func (x *FontDialog) GetPropertyModal() bool {
 
 // SetPropertyTitle sets the "title" property.
 // A title that may be shown on the font chooser
-// dialog that is presented by [method@Gtk.FontDialog.choose_font].
+// dialog that is presented by [FontDialog.ChooseFont].
 func (x *FontDialog) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -438,7 +447,7 @@ func (x *FontDialog) SetPropertyTitle(value string) {
 
 // GetPropertyTitle gets the "title" property.
 // A title that may be shown on the font chooser
-// dialog that is presented by [method@Gtk.FontDialog.choose_font].
+// dialog that is presented by [FontDialog.ChooseFont].
 func (x *FontDialog) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -450,7 +459,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -459,25 +468,27 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontDialogGLibType, libs, "gtk_font_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewFontDialog, libs, "gtk_font_dialog_new")
-
-	core.PuregoSafeRegister(&xFontDialogChooseFace, libs, "gtk_font_dialog_choose_face")
-	core.PuregoSafeRegister(&xFontDialogChooseFaceFinish, libs, "gtk_font_dialog_choose_face_finish")
-	core.PuregoSafeRegister(&xFontDialogChooseFamily, libs, "gtk_font_dialog_choose_family")
-	core.PuregoSafeRegister(&xFontDialogChooseFamilyFinish, libs, "gtk_font_dialog_choose_family_finish")
-	core.PuregoSafeRegister(&xFontDialogChooseFont, libs, "gtk_font_dialog_choose_font")
-	core.PuregoSafeRegister(&xFontDialogChooseFontAndFeatures, libs, "gtk_font_dialog_choose_font_and_features")
-	core.PuregoSafeRegister(&xFontDialogChooseFontAndFeaturesFinish, libs, "gtk_font_dialog_choose_font_and_features_finish")
-	core.PuregoSafeRegister(&xFontDialogChooseFontFinish, libs, "gtk_font_dialog_choose_font_finish")
-	core.PuregoSafeRegister(&xFontDialogGetFilter, libs, "gtk_font_dialog_get_filter")
-	core.PuregoSafeRegister(&xFontDialogGetFontMap, libs, "gtk_font_dialog_get_font_map")
-	core.PuregoSafeRegister(&xFontDialogGetLanguage, libs, "gtk_font_dialog_get_language")
-	core.PuregoSafeRegister(&xFontDialogGetModal, libs, "gtk_font_dialog_get_modal")
-	core.PuregoSafeRegister(&xFontDialogGetTitle, libs, "gtk_font_dialog_get_title")
-	core.PuregoSafeRegister(&xFontDialogSetFilter, libs, "gtk_font_dialog_set_filter")
-	core.PuregoSafeRegister(&xFontDialogSetFontMap, libs, "gtk_font_dialog_set_font_map")
-	core.PuregoSafeRegister(&xFontDialogSetLanguage, libs, "gtk_font_dialog_set_language")
-	core.PuregoSafeRegister(&xFontDialogSetModal, libs, "gtk_font_dialog_set_modal")
-	core.PuregoSafeRegister(&xFontDialogSetTitle, libs, "gtk_font_dialog_set_title")
+	gobject.CheckClassSize("GtkFontDialogClass", xFontDialogGLibType, unsafe.Sizeof(FontDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewFontDialog, libs, "gtk_font_dialog_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xFontDialogChooseFace, libs, "gtk_font_dialog_choose_face", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFaceFinish, libs, "gtk_font_dialog_choose_face_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFamily, libs, "gtk_font_dialog_choose_family", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFamilyFinish, libs, "gtk_font_dialog_choose_family_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFont, libs, "gtk_font_dialog_choose_font", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFontAndFeatures, libs, "gtk_font_dialog_choose_font_and_features", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFontAndFeaturesFinish, libs, "gtk_font_dialog_choose_font_and_features_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogChooseFontFinish, libs, "gtk_font_dialog_choose_font_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogGetFilter, libs, "gtk_font_dialog_get_filter", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogGetFontMap, libs, "gtk_font_dialog_get_font_map", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogGetLanguage, libs, "gtk_font_dialog_get_language", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogGetModal, libs, "gtk_font_dialog_get_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogGetTitle, libs, "gtk_font_dialog_get_title", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogSetFilter, libs, "gtk_font_dialog_set_filter", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogSetFontMap, libs, "gtk_font_dialog_set_font_map", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogSetLanguage, libs, "gtk_font_dialog_set_language", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogSetModal, libs, "gtk_font_dialog_set_modal", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogSetTitle, libs, "gtk_font_dialog_set_title", "GTK", "4.10")
 
 }
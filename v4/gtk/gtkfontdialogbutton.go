@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -26,7 +26,7 @@ func (x *FontDialogButtonClass) GoPointer() uintptr {
 // The level of granularity for the font selection.
 //
 // Depending on this value, the `PangoFontDescription` that
-// is returned by [method@Gtk.FontDialogButton.get_font_desc]
+// is returned by [FontDialogButton.GetFontDesc]
 // will have more or less fields set.
 type FontLevel int
 
@@ -48,26 +48,60 @@ const (
 	FontLevelFeaturesValue FontLevel = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontLevel) String() string {
+	switch x {
+	case FontLevelFamilyValue:
+		return "GTK_FONT_LEVEL_FAMILY"
+	case FontLevelFaceValue:
+		return "GTK_FONT_LEVEL_FACE"
+	case FontLevelFontValue:
+		return "GTK_FONT_LEVEL_FONT"
+	case FontLevelFeaturesValue:
+		return "GTK_FONT_LEVEL_FEATURES"
+	}
+	return fmt.Sprintf("FontLevel(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontLevel) Nick() string {
+	switch x {
+	case FontLevelFamilyValue:
+		return "family"
+	case FontLevelFaceValue:
+		return "face"
+	case FontLevelFontValue:
+		return "font"
+	case FontLevelFeaturesValue:
+		return "features"
+	}
+	return ""
+}
+
+// FontLevelFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontLevelFromString(s string) (FontLevel, bool) {
+	switch s {
+	case "GTK_FONT_LEVEL_FAMILY", "family":
+		return FontLevelFamilyValue, true
+	case "GTK_FONT_LEVEL_FACE", "face":
+		return FontLevelFaceValue, true
+	case "GTK_FONT_LEVEL_FONT", "font":
+		return FontLevelFontValue, true
+	case "GTK_FONT_LEVEL_FEATURES", "features":
+		return FontLevelFeaturesValue, true
+	}
+	return 0, false
+}
+
 // Opens a font chooser dialog to select a font.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="font-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkFontDialogButton" src="font-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is suitable widget for selecting a font in a preference dialog.
 //
 // # CSS nodes
 //
-// ```
-// fontbutton
-// ╰── button.font
-//
-//	╰── [content]
-//
-// ```
+//	fontbutton
+//	╰── button.font
+//	    ╰── [content]
 //
 // `GtkFontDialogButton` has a single CSS node with name fontbutton which
 // contains a button node with the .font style class.
@@ -110,7 +144,7 @@ func NewFontDialogButton(DialogVar *FontDialog) *FontDialogButton {
 
 var xFontDialogButtonGetDialog func(uintptr) uintptr
 
-// Returns the `GtkFontDialog` of @self.
+// Returns the `GtkFontDialog` of self.
 func (x *FontDialogButton) GetDialog() *FontDialog {
 	var cls *FontDialog
 
@@ -147,7 +181,7 @@ var xFontDialogButtonGetFontFeatures func(uintptr) string
 // to "notify::font-features".
 //
 // Note that the button will only let users choose font features
-// if [property@Gtk.FontDialogButton:level] is set to
+// if FontDialogButton:level is set to
 // `GTK_FONT_LEVEL_FEATURES`.
 func (x *FontDialogButton) GetFontFeatures() string {
 
@@ -245,7 +279,7 @@ func (x *FontDialogButton) SetLevel(LevelVar FontLevel) {
 
 var xFontDialogButtonSetUseFont func(uintptr, bool)
 
-// If @use_font is `TRUE`, the font name will be written
+// If use_font is `TRUE`, the font name will be written
 // using the selected font.
 func (x *FontDialogButton) SetUseFont(UseFontVar bool) {
 
@@ -255,7 +289,7 @@ func (x *FontDialogButton) SetUseFont(UseFontVar bool) {
 
 var xFontDialogButtonSetUseSize func(uintptr, bool)
 
-// If @use_size is `TRUE`, the font name will be written
+// If use_size is `TRUE`, the font name will be written
 // using the selected font size.
 func (x *FontDialogButton) SetUseSize(UseSizeVar bool) {
 
@@ -401,27 +435,49 @@ func (x *FontDialogButton) GetPropertyUseSize() bool {
 //
 // The `::activate` signal on `GtkFontDialogButton` is an action signal
 // and emitting it causes the button to pop up its dialog.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *FontDialogButton) ConnectActivate(cb *func(FontDialogButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := FontDialogButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xFontDialogButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xFontDialogButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(FontDialogButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := FontDialogButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *FontDialogButton) ConnectActivateFunc(cb func(FontDialogButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xFontDialogButtonActivateTrampoline, xFontDialogButtonActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -585,7 +641,7 @@ func (x *FontDialogButton) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -593,14 +649,13 @@ func (x *FontDialogButton) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *FontDialogButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -624,19 +679,17 @@ func (x *FontDialogButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *FontDialogButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -657,7 +710,7 @@ func (x *FontDialogButton) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -666,14 +719,12 @@ func (x *FontDialogButton) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *FontDialogButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -692,10 +743,10 @@ func (x *FontDialogButton) UpdateStateValue(NStatesVar int, StatesVar []Accessib
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *FontDialogButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -707,7 +758,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -718,21 +769,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontDialogButtonGLibType, libs, "gtk_font_dialog_button_get_type")
 
-	core.PuregoSafeRegister(&xNewFontDialogButton, libs, "gtk_font_dialog_button_new")
-
-	core.PuregoSafeRegister(&xFontDialogButtonGetDialog, libs, "gtk_font_dialog_button_get_dialog")
-	core.PuregoSafeRegister(&xFontDialogButtonGetFontDesc, libs, "gtk_font_dialog_button_get_font_desc")
-	core.PuregoSafeRegister(&xFontDialogButtonGetFontFeatures, libs, "gtk_font_dialog_button_get_font_features")
-	core.PuregoSafeRegister(&xFontDialogButtonGetLanguage, libs, "gtk_font_dialog_button_get_language")
-	core.PuregoSafeRegister(&xFontDialogButtonGetLevel, libs, "gtk_font_dialog_button_get_level")
-	core.PuregoSafeRegister(&xFontDialogButtonGetUseFont, libs, "gtk_font_dialog_button_get_use_font")
-	core.PuregoSafeRegister(&xFontDialogButtonGetUseSize, libs, "gtk_font_dialog_button_get_use_size")
-	core.PuregoSafeRegister(&xFontDialogButtonSetDialog, libs, "gtk_font_dialog_button_set_dialog")
-	core.PuregoSafeRegister(&xFontDialogButtonSetFontDesc, libs, "gtk_font_dialog_button_set_font_desc")
-	core.PuregoSafeRegister(&xFontDialogButtonSetFontFeatures, libs, "gtk_font_dialog_button_set_font_features")
-	core.PuregoSafeRegister(&xFontDialogButtonSetLanguage, libs, "gtk_font_dialog_button_set_language")
-	core.PuregoSafeRegister(&xFontDialogButtonSetLevel, libs, "gtk_font_dialog_button_set_level")
-	core.PuregoSafeRegister(&xFontDialogButtonSetUseFont, libs, "gtk_font_dialog_button_set_use_font")
-	core.PuregoSafeRegister(&xFontDialogButtonSetUseSize, libs, "gtk_font_dialog_button_set_use_size")
+	gobject.CheckClassSize("GtkFontDialogButtonClass", xFontDialogButtonGLibType, unsafe.Sizeof(FontDialogButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewFontDialogButton, libs, "gtk_font_dialog_button_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xFontDialogButtonGetDialog, libs, "gtk_font_dialog_button_get_dialog", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetFontDesc, libs, "gtk_font_dialog_button_get_font_desc", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetFontFeatures, libs, "gtk_font_dialog_button_get_font_features", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetLanguage, libs, "gtk_font_dialog_button_get_language", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetLevel, libs, "gtk_font_dialog_button_get_level", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetUseFont, libs, "gtk_font_dialog_button_get_use_font", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonGetUseSize, libs, "gtk_font_dialog_button_get_use_size", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetDialog, libs, "gtk_font_dialog_button_set_dialog", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetFontDesc, libs, "gtk_font_dialog_button_set_font_desc", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetFontFeatures, libs, "gtk_font_dialog_button_set_font_features", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetLanguage, libs, "gtk_font_dialog_button_set_language", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetLevel, libs, "gtk_font_dialog_button_set_level", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetUseFont, libs, "gtk_font_dialog_button_set_use_font", "GTK", "4.10")
+	core.PuregoRegisterSince(&xFontDialogButtonSetUseSize, libs, "gtk_font_dialog_button_set_use_size", "GTK", "4.10")
 
 }
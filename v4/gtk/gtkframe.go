@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -30,7 +29,8 @@ func (x *FrameClass) OverrideComputeChildAllocation(cb func(*Frame, *Allocation)
 	if cb == nil {
 		x.xComputeChildAllocation = 0
 	} else {
-		x.xComputeChildAllocation = purego.NewCallback(func(FrameVarp uintptr, AllocationVarp *Allocation) {
+		x.xComputeChildAllocation = core.NewCallback(func(FrameVarp uintptr, AllocationVarp *Allocation) {
+			defer core.RecoverPanic()
 			cb(FrameNewFromInternalPtr(FrameVarp), AllocationVarp)
 		})
 	}
@@ -42,7 +42,7 @@ func (x *FrameClass) GetComputeChildAllocation() func(*Frame, *Allocation) {
 		return nil
 	}
 	var rawCallback func(FrameVarp uintptr, AllocationVarp *Allocation)
-	purego.RegisterFunc(&rawCallback, x.xComputeChildAllocation)
+	core.RegisterFunc(&rawCallback, x.xComputeChildAllocation)
 	return func(FrameVar *Frame, AllocationVar *Allocation) {
 		rawCallback(FrameVar.GoPointer(), AllocationVar)
 	}
@@ -50,16 +50,9 @@ func (x *FrameClass) GetComputeChildAllocation() func(*Frame, *Allocation) {
 
 // Surrounds its child with a decorative frame and an optional label.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="frame-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkFrame" src="frame.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // If present, the label is drawn inside the top edge of the frame.
 // The horizontal position of the label can be controlled with
-// [method@Gtk.Frame.set_label_align].
+// [Frame.SetLabelAlign].
 //
 // `GtkFrame` clips its child. You can use this to add rounded corners
 // to widgets, but be aware that it also cuts off shadows.
@@ -68,26 +61,20 @@ func (x *FrameClass) GetComputeChildAllocation() func(*Frame, *Allocation) {
 //
 // An example of a UI definition fragment with GtkFrame:
 //
-// ```xml
-// &lt;object class="GtkFrame"&gt;
-//
-//	&lt;property name="label-widget"&gt;
-//	  &lt;object class="GtkLabel" id="frame_label"/&gt;
-//	&lt;/property&gt;
-//	&lt;property name="child"&gt;
-//	  &lt;object class="GtkEntry" id="frame_content"/&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkFrame">
+//	  <property name="label-widget">
+//	    <object class="GtkLabel" id="frame_label"/>
+//	  </property>
+//	  <property name="child">
+//	    <object class="GtkEntry" id="frame_content"/>
+//	  </property>
+//	</object>
 //
 // # CSS nodes
 //
-// ```
-// frame
-// ├── &lt;label widget&gt;
-// ╰── &lt;child&gt;
-// ```
+//	frame
+//	├── <label widget>
+//	╰── <child>
 //
 // `GtkFrame` has a main CSS node with name “frame”, which is used to draw the
 // visible border. You can set the appearance of the border using CSS properties
@@ -95,7 +82,7 @@ func (x *FrameClass) GetComputeChildAllocation() func(*Frame, *Allocation) {
 //
 // # Accessibility
 //
-// `GtkFrame` uses the [enum@Gtk.AccessibleRole.group] role.
+// `GtkFrame` uses the [AccessibleRoleGroupValue] role.
 type Frame struct {
 	Widget
 }
@@ -114,9 +101,9 @@ func FrameNewFromInternalPtr(ptr uintptr) *Frame {
 
 var xNewFrame func(uintptr) uintptr
 
-// Creates a new `GtkFrame`, with optional label @label.
+// Creates a new `GtkFrame`, with optional label label.
 //
-// If @label is %NULL, the label is omitted.
+// If label is nil, the label is omitted.
 func NewFrame(LabelVar *string) *Frame {
 	var cls *Frame
 
@@ -136,7 +123,7 @@ func NewFrame(LabelVar *string) *Frame {
 
 var xFrameGetChild func(uintptr) uintptr
 
-// Gets the child widget of @frame.
+// Gets the child widget of frame.
 func (x *Frame) GetChild() *Widget {
 	var cls *Widget
 
@@ -155,7 +142,7 @@ var xFrameGetLabel func(uintptr) string
 
 // Returns the frame labels text.
 //
-// If the frame's label widget is not a `GtkLabel`, %NULL
+// If the frame's label widget is not a `GtkLabel`, nil
 // is returned.
 func (x *Frame) GetLabel() string {
 
@@ -191,7 +178,7 @@ func (x *Frame) GetLabelWidget() *Widget {
 
 var xFrameSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @frame.
+// Sets the child widget of frame.
 func (x *Frame) SetChild(ChildVar *Widget) {
 
 	xFrameSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -200,7 +187,7 @@ func (x *Frame) SetChild(ChildVar *Widget) {
 
 var xFrameSetLabel func(uintptr, uintptr)
 
-// Creates a new `GtkLabel` with the @label and sets it as the frame's
+// Creates a new `GtkLabel` with the label and sets it as the frame's
 // label widget.
 func (x *Frame) SetLabel(LabelVar *string) {
 
@@ -440,7 +427,7 @@ func (x *Frame) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -448,14 +435,13 @@ func (x *Frame) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Frame) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -479,19 +465,17 @@ func (x *Frame) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Frame) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -512,7 +496,7 @@ func (x *Frame) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -521,14 +505,12 @@ func (x *Frame) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Frame) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -547,10 +529,10 @@ func (x *Frame) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Frame) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -562,7 +544,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -571,15 +553,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xFrameGLibType, libs, "gtk_frame_get_type")
 
-	core.PuregoSafeRegister(&xNewFrame, libs, "gtk_frame_new")
+	gobject.CheckClassSize("GtkFrameClass", xFrameGLibType, unsafe.Sizeof(FrameClass{}))
+
+	core.PuregoRegisterSince(&xNewFrame, libs, "gtk_frame_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xFrameGetChild, libs, "gtk_frame_get_child")
-	core.PuregoSafeRegister(&xFrameGetLabel, libs, "gtk_frame_get_label")
-	core.PuregoSafeRegister(&xFrameGetLabelAlign, libs, "gtk_frame_get_label_align")
-	core.PuregoSafeRegister(&xFrameGetLabelWidget, libs, "gtk_frame_get_label_widget")
-	core.PuregoSafeRegister(&xFrameSetChild, libs, "gtk_frame_set_child")
-	core.PuregoSafeRegister(&xFrameSetLabel, libs, "gtk_frame_set_label")
-	core.PuregoSafeRegister(&xFrameSetLabelAlign, libs, "gtk_frame_set_label_align")
-	core.PuregoSafeRegister(&xFrameSetLabelWidget, libs, "gtk_frame_set_label_widget")
+	core.PuregoRegisterSince(&xFrameGetChild, libs, "gtk_frame_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xFrameGetLabel, libs, "gtk_frame_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xFrameGetLabelAlign, libs, "gtk_frame_get_label_align", "GTK", "")
+	core.PuregoRegisterSince(&xFrameGetLabelWidget, libs, "gtk_frame_get_label_widget", "GTK", "")
+	core.PuregoRegisterSince(&xFrameSetChild, libs, "gtk_frame_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xFrameSetLabel, libs, "gtk_frame_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xFrameSetLabelAlign, libs, "gtk_frame_set_label_align", "GTK", "")
+	core.PuregoRegisterSince(&xFrameSetLabelWidget, libs, "gtk_frame_set_label_widget", "GTK", "")
 
 }
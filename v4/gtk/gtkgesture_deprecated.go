@@ -0,0 +1,75 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+)
+
+var xGestureSetSequenceState func(uintptr, *gdk.EventSequence, EventSequenceState) bool
+
+// Sets the state of sequence in gesture.
+//
+// Sequences start in state [EventSequenceNoneValue], and whenever
+// they change state, they can never go back to that state. Likewise,
+// sequences in state [EventSequenceDeniedValue] cannot turn back to
+// a not denied state. With these rules, the lifetime of an event
+// sequence is constrained to the next four:
+//
+// * None
+// * None → Denied
+// * None → Claimed
+// * None → Claimed → Denied
+//
+// Note: Due to event handling ordering, it may be unsafe to set the
+// state on another gesture within a [Gesture.ConnectBeginFunc] signal
+// handler, as the callback might be executed before the other gesture
+// knows about the sequence. A safe way to perform this could be:
+//
+//	static void
+//	first_gesture_begin_cb (GtkGesture       *first_gesture,
+//	                        GdkEventSequence *sequence,
+//	                        gpointer          user_data)
+//	{
+//	  gtk_gesture_set_sequence_state (first_gesture, sequence, GTK_EVENT_SEQUENCE_CLAIMED);
+//	  gtk_gesture_set_sequence_state (second_gesture, sequence, GTK_EVENT_SEQUENCE_DENIED);
+//	}
+//
+//	static void
+//	second_gesture_begin_cb (GtkGesture       *second_gesture,
+//	                         GdkEventSequence *sequence,
+//	                         gpointer          user_data)
+//	{
+//	  if (gtk_gesture_get_sequence_state (first_gesture, sequence) == GTK_EVENT_SEQUENCE_CLAIMED)
+//	    gtk_gesture_set_sequence_state (second_gesture, sequence, GTK_EVENT_SEQUENCE_DENIED);
+//	}
+//
+// If both gestures are in the same group, just set the state on
+// the gesture emitting the event, the sequence will be already
+// be initialized to the group's global state when the second
+// gesture processes the event.
+//
+// Deprecated: since 4.10.. Use [Gesture.SetState]
+func (x *Gesture) SetSequenceState(SequenceVar *gdk.EventSequence, StateVar EventSequenceState) bool {
+
+	cret := xGestureSetSequenceState(x.GoPointer(), SequenceVar, StateVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xGestureSetSequenceState, libs, "gtk_gesture_set_sequence_state", "GTK", "")
+
+}
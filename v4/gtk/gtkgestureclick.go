@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,9 +22,9 @@ func (x *GestureClickClass) GoPointer() uintptr {
 // Recognizes click gestures.
 //
 // It is able to recognize multiple clicks on a nearby zone, which
-// can be listened for through the [signal@Gtk.GestureClick::pressed]
+// can be listened for through the [GestureClick.ConnectPressedFunc]
 // signal. Whenever time or distance between clicks exceed the GTK
-// defaults, [signal@Gtk.GestureClick::stopped] is emitted, and the
+// defaults, [GestureClick.ConnectStoppedFunc] is emitted, and the
 // click counter is reset.
 type GestureClick struct {
 	GestureSingle
@@ -72,80 +71,146 @@ func (c *GestureClick) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted whenever a button or touch press happens.
+//
+// Deprecated: use ConnectPressedFunc, which also accepts method values and closures.
 func (x *GestureClick) ConnectPressed(cb *func(GestureClick, int, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "pressed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureClick{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, NPressVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pressed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64) {
+var xGestureClickPressedTrampoline uintptr
+
+func xGestureClickPressedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureClick, int, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureClick{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, NPressVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "pressed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPressedFunc connects cb to the "pressed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureClick) ConnectPressedFunc(cb func(GestureClick, int, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pressed", &xGestureClickPressedTrampoline, xGestureClickPressedNewTrampoline, cb)
 }
 
 // Emitted when a button or touch is released.
 //
-// @n_press will report the number of press that is paired to
-// this event, note that [signal@Gtk.GestureClick::stopped] may
-// have been emitted between the press and its release, @n_press
+// n_press will report the number of press that is paired to
+// this event, note that [GestureClick.ConnectStoppedFunc] may
+// have been emitted between the press and its release, n_press
 // will only start over at the next press.
+//
+// Deprecated: use ConnectReleasedFunc, which also accepts method values and closures.
 func (x *GestureClick) ConnectReleased(cb *func(GestureClick, int, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "released", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureClick{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, NPressVarp, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "released", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64) {
+var xGestureClickReleasedTrampoline uintptr
+
+func xGestureClickReleasedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NPressVarp int, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureClick, int, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureClick{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, NPressVarp, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "released", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectReleasedFunc connects cb to the "released" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureClick) ConnectReleasedFunc(cb func(GestureClick, int, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "released", &xGestureClickReleasedTrampoline, xGestureClickReleasedNewTrampoline, cb)
 }
 
 // Emitted whenever any time/distance threshold has been exceeded.
+//
+// Deprecated: use ConnectStoppedFunc, which also accepts method values and closures.
 func (x *GestureClick) ConnectStopped(cb *func(GestureClick)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "stopped", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := GestureClick{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "stopped", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xGestureClickStoppedTrampoline uintptr
+
+func xGestureClickStoppedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureClick))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureClick{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "stopped", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStoppedFunc connects cb to the "stopped" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureClick) ConnectStoppedFunc(cb func(GestureClick)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "stopped", &xGestureClickStoppedTrampoline, xGestureClickStoppedNewTrampoline, cb)
 }
 
 // Emitted whenever the gesture receives a release
@@ -154,27 +219,49 @@ func (x *GestureClick) ConnectStopped(cb *func(GestureClick)) uint {
 // Due to implicit grabs, this can only happen on situations
 // where input is grabbed elsewhere mid-press or the pressed
 // widget voluntarily relinquishes its implicit grab.
+//
+// Deprecated: use ConnectUnpairedReleaseFunc, which also accepts method values and closures.
 func (x *GestureClick) ConnectUnpairedRelease(cb *func(GestureClick, float64, float64, uint, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unpaired-release", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64, ButtonVarp uint, SequenceVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := GestureClick{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp, ButtonVarp, SequenceVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unpaired-release", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64, ButtonVarp uint, SequenceVarp uintptr) {
+var xGestureClickUnpairedReleaseTrampoline uintptr
+
+func xGestureClickUnpairedReleaseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, ButtonVarp uint, SequenceVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureClick, float64, float64, uint, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureClick{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp, ButtonVarp, SequenceVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unpaired-release", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnpairedReleaseFunc connects cb to the "unpaired-release" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureClick) ConnectUnpairedReleaseFunc(cb func(GestureClick, float64, float64, uint, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unpaired-release", &xGestureClickUnpairedReleaseTrampoline, xGestureClickUnpairedReleaseNewTrampoline, cb)
 }
 
 func init() {
@@ -182,7 +269,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -191,6 +278,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureClickGLibType, libs, "gtk_gesture_click_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureClick, libs, "gtk_gesture_click_new")
+	core.PuregoRegisterSince(&xNewGestureClick, libs, "gtk_gesture_click_new", "GTK", "")
 
 }
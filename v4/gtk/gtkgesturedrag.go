@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,12 +22,12 @@ func (x *GestureDragClass) GoPointer() uintptr {
 // Recognizes drag gestures.
 //
 // The drag operation itself can be tracked throughout the
-// [signal@Gtk.GestureDrag::drag-begin],
-// [signal@Gtk.GestureDrag::drag-update] and
-// [signal@Gtk.GestureDrag::drag-end] signals, and the relevant
+// [GestureDrag.ConnectDragBeginFunc],
+// [GestureDrag.ConnectDragUpdateFunc] and
+// [GestureDrag.ConnectDragEndFunc] signals, and the relevant
 // coordinates can be extracted through
-// [method@Gtk.GestureDrag.get_offset] and
-// [method@Gtk.GestureDrag.get_start_point].
+// [GestureDrag.GetOffset] and
+// [GestureDrag.GetStartPoint].
 type GestureDrag struct {
 	GestureSingle
 }
@@ -65,8 +64,8 @@ var xGestureDragGetOffset func(uintptr, *float64, *float64) bool
 
 // Gets the offset from the start point.
 //
-// If the @gesture is active, this function returns %TRUE and
-// fills in @x and @y with the coordinates of the current point,
+// If the gesture is active, this function returns true and
+// fills in x and y with the coordinates of the current point,
 // as an offset to the starting drag point.
 func (x *GestureDrag) GetOffset(XVar *float64, YVar *float64) bool {
 
@@ -78,8 +77,8 @@ var xGestureDragGetStartPoint func(uintptr, *float64, *float64) bool
 
 // Gets the point where the drag started.
 //
-// If the @gesture is active, this function returns %TRUE
-// and fills in @x and @y with the drag start coordinates,
+// If the gesture is active, this function returns true
+// and fills in x and y with the drag start coordinates,
 // in widget-relative coordinates.
 func (x *GestureDrag) GetStartPoint(XVar *float64, YVar *float64) bool {
 
@@ -99,75 +98,141 @@ func (c *GestureDrag) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted whenever dragging starts.
+//
+// Deprecated: use ConnectDragBeginFunc, which also accepts method values and closures.
 func (x *GestureDrag) ConnectDragBegin(cb *func(GestureDrag, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-begin", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StartXVarp float64, StartYVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureDrag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StartXVarp, StartYVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-begin", cbRefPtr, cbPtr)
+}
+
+var xGestureDragDragBeginTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StartXVarp float64, StartYVarp float64) {
+func xGestureDragDragBeginNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StartXVarp float64, StartYVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureDrag, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureDrag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StartXVarp, StartYVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-begin", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragBeginFunc connects cb to the "drag-begin" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureDrag) ConnectDragBeginFunc(cb func(GestureDrag, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-begin", &xGestureDragDragBeginTrampoline, xGestureDragDragBeginNewTrampoline, cb)
 }
 
 // Emitted whenever the dragging is finished.
+//
+// Deprecated: use ConnectDragEndFunc, which also accepts method values and closures.
 func (x *GestureDrag) ConnectDragEnd(cb *func(GestureDrag, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-end", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureDrag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, OffsetXVarp, OffsetYVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-end", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64) {
+var xGestureDragDragEndTrampoline uintptr
+
+func xGestureDragDragEndNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureDrag, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureDrag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, OffsetXVarp, OffsetYVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-end", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragEndFunc connects cb to the "drag-end" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureDrag) ConnectDragEndFunc(cb func(GestureDrag, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-end", &xGestureDragDragEndTrampoline, xGestureDragDragEndNewTrampoline, cb)
 }
 
 // Emitted whenever the dragging point moves.
+//
+// Deprecated: use ConnectDragUpdateFunc, which also accepts method values and closures.
 func (x *GestureDrag) ConnectDragUpdate(cb *func(GestureDrag, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "drag-update", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureDrag{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, OffsetXVarp, OffsetYVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "drag-update", cbRefPtr, cbPtr)
+}
+
+var xGestureDragDragUpdateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64) {
+func xGestureDragDragUpdateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OffsetXVarp float64, OffsetYVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureDrag, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureDrag{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, OffsetXVarp, OffsetYVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "drag-update", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDragUpdateFunc connects cb to the "drag-update" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureDrag) ConnectDragUpdateFunc(cb func(GestureDrag, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "drag-update", &xGestureDragDragUpdateTrampoline, xGestureDragDragUpdateNewTrampoline, cb)
 }
 
 func init() {
@@ -175,7 +240,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -184,9 +249,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureDragGLibType, libs, "gtk_gesture_drag_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureDrag, libs, "gtk_gesture_drag_new")
+	core.PuregoRegisterSince(&xNewGestureDrag, libs, "gtk_gesture_drag_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureDragGetOffset, libs, "gtk_gesture_drag_get_offset")
-	core.PuregoSafeRegister(&xGestureDragGetStartPoint, libs, "gtk_gesture_drag_get_start_point")
+	core.PuregoRegisterSince(&xGestureDragGetOffset, libs, "gtk_gesture_drag_get_offset", "GTK", "")
+	core.PuregoRegisterSince(&xGestureDragGetStartPoint, libs, "gtk_gesture_drag_get_start_point", "GTK", "")
 
 }
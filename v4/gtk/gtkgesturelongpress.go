@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,15 +24,15 @@ func (x *GestureLongPressClass) GoPointer() uintptr {
 // This gesture is also known as “Press and Hold”.
 //
 // When the timeout is exceeded, the gesture is triggering the
-// [signal@Gtk.GestureLongPress::pressed] signal.
+// [GestureLongPress.ConnectPressedFunc] signal.
 //
 // If the touchpoint is lifted before the timeout passes, or if
 // it drifts too far of the initial press point, the
-// [signal@Gtk.GestureLongPress::cancelled] signal will be emitted.
+// [GestureLongPress.ConnectCancelledFunc] signal will be emitted.
 //
 // How long the timeout is before the ::pressed signal gets emitted is
-// determined by the [property@Gtk.Settings:gtk-long-press-time] setting.
-// It can be modified by the [property@Gtk.GestureLongPress:delay-factor]
+// determined by the [Settings.GetPropertyGtkLongPressTime] setting.
+// It can be modified by the [GestureLongPress.GetPropertyDelayFactor]
 // property.
 type GestureLongPress struct {
 	GestureSingle
@@ -117,53 +116,97 @@ func (x *GestureLongPress) GetPropertyDelayFactor() float64 {
 }
 
 // Emitted whenever a press moved too far, or was released
-// before [signal@Gtk.GestureLongPress::pressed] happened.
+// before [GestureLongPress.ConnectPressedFunc] happened.
+//
+// Deprecated: use ConnectCancelledFunc, which also accepts method values and closures.
 func (x *GestureLongPress) ConnectCancelled(cb *func(GestureLongPress)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cancelled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := GestureLongPress{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cancelled", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xGestureLongPressCancelledTrampoline uintptr
+
+func xGestureLongPressCancelledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureLongPress))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureLongPress{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cancelled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCancelledFunc connects cb to the "cancelled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureLongPress) ConnectCancelledFunc(cb func(GestureLongPress)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cancelled", &xGestureLongPressCancelledTrampoline, xGestureLongPressCancelledNewTrampoline, cb)
 }
 
 // Emitted whenever a press goes unmoved/unreleased longer than
 // what the GTK defaults tell.
+//
+// Deprecated: use ConnectPressedFunc, which also accepts method values and closures.
 func (x *GestureLongPress) ConnectPressed(cb *func(GestureLongPress, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "pressed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureLongPress{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pressed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+var xGestureLongPressPressedTrampoline uintptr
+
+func xGestureLongPressPressedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureLongPress, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureLongPress{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "pressed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPressedFunc connects cb to the "pressed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureLongPress) ConnectPressedFunc(cb func(GestureLongPress, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pressed", &xGestureLongPressPressedTrampoline, xGestureLongPressPressedNewTrampoline, cb)
 }
 
 func init() {
@@ -171,7 +214,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -180,9 +223,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureLongPressGLibType, libs, "gtk_gesture_long_press_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureLongPress, libs, "gtk_gesture_long_press_new")
+	core.PuregoRegisterSince(&xNewGestureLongPress, libs, "gtk_gesture_long_press_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureLongPressGetDelayFactor, libs, "gtk_gesture_long_press_get_delay_factor")
-	core.PuregoSafeRegister(&xGestureLongPressSetDelayFactor, libs, "gtk_gesture_long_press_set_delay_factor")
+	core.PuregoRegisterSince(&xGestureLongPressGetDelayFactor, libs, "gtk_gesture_long_press_get_delay_factor", "GTK", "")
+	core.PuregoRegisterSince(&xGestureLongPressSetDelayFactor, libs, "gtk_gesture_long_press_set_delay_factor", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,7 +23,7 @@ func (x *GesturePanClass) GoPointer() uintptr {
 //
 // These are drags that are locked to happen along one axis. The axis
 // that a `GtkGesturePan` handles is defined at construct time, and
-// can be changed through [method@Gtk.GesturePan.set_orientation].
+// can be changed through [GesturePan.SetOrientation].
 //
 // When the gesture starts to be recognized, `GtkGesturePan` will
 // attempt to determine as early as possible whether the sequence
@@ -32,7 +31,7 @@ func (x *GesturePanClass) GoPointer() uintptr {
 // this does not happen.
 //
 // Once a panning gesture along the expected axis is recognized,
-// the [signal@Gtk.GesturePan::pan] signal will be emitted as input
+// the [GesturePan.ConnectPanFunc] signal will be emitted as input
 // events are received, containing the offset in the given axis.
 type GesturePan struct {
 	GestureDrag
@@ -68,7 +67,7 @@ func NewGesturePan(OrientationVar Orientation) *GesturePan {
 
 var xGesturePanGetOrientation func(uintptr) Orientation
 
-// Returns the orientation of the pan gestures that this @gesture expects.
+// Returns the orientation of the pan gestures that this gesture expects.
 func (x *GesturePan) GetOrientation() Orientation {
 
 	cret := xGesturePanGetOrientation(x.GoPointer())
@@ -96,27 +95,49 @@ func (c *GesturePan) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted once a panning gesture along the expected axis is detected.
+//
+// Deprecated: use ConnectPanFunc, which also accepts method values and closures.
 func (x *GesturePan) ConnectPan(cb *func(GesturePan, PanDirection, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "pan", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp PanDirection, OffsetVarp float64) {
+			defer core.RecoverPanic()
+			fa := GesturePan{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DirectionVarp, OffsetVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "pan", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DirectionVarp PanDirection, OffsetVarp float64) {
+var xGesturePanPanTrampoline uintptr
+
+func xGesturePanPanNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp PanDirection, OffsetVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GesturePan, PanDirection, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GesturePan{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DirectionVarp, OffsetVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "pan", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPanFunc connects cb to the "pan" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GesturePan) ConnectPanFunc(cb func(GesturePan, PanDirection, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "pan", &xGesturePanPanTrampoline, xGesturePanPanNewTrampoline, cb)
 }
 
 func init() {
@@ -124,7 +145,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -133,9 +154,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xGesturePanGLibType, libs, "gtk_gesture_pan_get_type")
 
-	core.PuregoSafeRegister(&xNewGesturePan, libs, "gtk_gesture_pan_new")
+	core.PuregoRegisterSince(&xNewGesturePan, libs, "gtk_gesture_pan_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGesturePanGetOrientation, libs, "gtk_gesture_pan_get_orientation")
-	core.PuregoSafeRegister(&xGesturePanSetOrientation, libs, "gtk_gesture_pan_set_orientation")
+	core.PuregoRegisterSince(&xGesturePanGetOrientation, libs, "gtk_gesture_pan_get_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xGesturePanSetOrientation, libs, "gtk_gesture_pan_set_orientation", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +22,7 @@ func (x *GestureRotateClass) GoPointer() uintptr {
 // Recognizes 2-finger rotation gestures.
 //
 // Whenever the angle between both handled sequences changes, the
-// [signal@Gtk.GestureRotate::angle-changed] signal is emitted.
+// [GestureRotate.ConnectAngleChangedFunc] signal is emitted.
 type GestureRotate struct {
 	Gesture
 }
@@ -61,8 +60,8 @@ var xGestureRotateGetAngleDelta func(uintptr) float64
 
 // Gets the angle delta in radians.
 //
-// If @gesture is active, this function returns the angle difference
-// in radians since the gesture was first recognized. If @gesture is
+// If gesture is active, this function returns the angle difference
+// in radians since the gesture was first recognized. If gesture is
 // not active, 0 is returned.
 func (x *GestureRotate) GetAngleDelta() float64 {
 
@@ -82,27 +81,49 @@ func (c *GestureRotate) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted when the angle between both tracked points changes.
+//
+// Deprecated: use ConnectAngleChangedFunc, which also accepts method values and closures.
 func (x *GestureRotate) ConnectAngleChanged(cb *func(GestureRotate, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "angle-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, AngleVarp float64, AngleDeltaVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureRotate{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, AngleVarp, AngleDeltaVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "angle-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, AngleVarp float64, AngleDeltaVarp float64) {
+var xGestureRotateAngleChangedTrampoline uintptr
+
+func xGestureRotateAngleChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, AngleVarp float64, AngleDeltaVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureRotate, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureRotate{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, AngleVarp, AngleDeltaVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "angle-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAngleChangedFunc connects cb to the "angle-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureRotate) ConnectAngleChangedFunc(cb func(GestureRotate, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "angle-changed", &xGestureRotateAngleChangedTrampoline, xGestureRotateAngleChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -110,7 +131,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -119,8 +140,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureRotateGLibType, libs, "gtk_gesture_rotate_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureRotate, libs, "gtk_gesture_rotate_new")
+	core.PuregoRegisterSince(&xNewGestureRotate, libs, "gtk_gesture_rotate_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureRotateGetAngleDelta, libs, "gtk_gesture_rotate_get_angle_delta")
+	core.PuregoRegisterSince(&xGestureRotateGetAngleDelta, libs, "gtk_gesture_rotate_get_angle_delta", "GTK", "")
 
 }
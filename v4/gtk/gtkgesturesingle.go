@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,16 +22,16 @@ func (x *GestureSingleClass) GoPointer() uintptr {
 // A `GtkGesture` subclass optimized for singe-touch and mouse gestures.
 //
 // Under interaction, these gestures stick to the first interacting sequence,
-// which is accessible through [method@Gtk.GestureSingle.get_current_sequence]
+// which is accessible through [GestureSingle.GetCurrentSequence]
 // while the gesture is being interacted with.
 //
-// By default gestures react to both %GDK_BUTTON_PRIMARY and touch events.
-// [method@Gtk.GestureSingle.set_touch_only] can be used to change the
+// By default gestures react to both [gdk.BUTTON_PRIMARY] and touch events.
+// [GestureSingle.SetTouchOnly] can be used to change the
 // touch behavior. Callers may also specify a different mouse button number
-// to interact with through [method@Gtk.GestureSingle.set_button], or react
+// to interact with through [GestureSingle.SetButton], or react
 // to any mouse button by setting it to 0. While the gesture is active, the
 // button being currently pressed can be known through
-// [method@Gtk.GestureSingle.get_current_button].
+// [GestureSingle.GetCurrentButton].
 type GestureSingle struct {
 	Gesture
 }
@@ -51,7 +50,7 @@ func GestureSingleNewFromInternalPtr(ptr uintptr) *GestureSingle {
 
 var xGestureSingleGetButton func(uintptr) uint
 
-// Returns the button number @gesture listens for.
+// Returns the button number gesture listens for.
 //
 // If this is 0, the gesture reacts to any button press.
 func (x *GestureSingle) GetButton() uint {
@@ -63,7 +62,7 @@ func (x *GestureSingle) GetButton() uint {
 var xGestureSingleGetCurrentButton func(uintptr) uint
 
 // Returns the button number currently interacting
-// with @gesture, or 0 if there is none.
+// with gesture, or 0 if there is none.
 func (x *GestureSingle) GetCurrentButton() uint {
 
 	cret := xGestureSingleGetCurrentButton(x.GoPointer())
@@ -72,10 +71,10 @@ func (x *GestureSingle) GetCurrentButton() uint {
 
 var xGestureSingleGetCurrentSequence func(uintptr) *gdk.EventSequence
 
-// Returns the event sequence currently interacting with @gesture.
+// Returns the event sequence currently interacting with gesture.
 //
-// This is only meaningful if [method@Gtk.Gesture.is_active]
-// returns %TRUE.
+// This is only meaningful if [Gesture.IsActive]
+// returns true.
 func (x *GestureSingle) GetCurrentSequence() *gdk.EventSequence {
 
 	cret := xGestureSingleGetCurrentSequence(x.GoPointer())
@@ -86,7 +85,7 @@ var xGestureSingleGetExclusive func(uintptr) bool
 
 // Gets whether a gesture is exclusive.
 //
-// For more information, see [method@Gtk.GestureSingle.set_exclusive].
+// For more information, see [GestureSingle.SetExclusive].
 func (x *GestureSingle) GetExclusive() bool {
 
 	cret := xGestureSingleGetExclusive(x.GoPointer())
@@ -95,7 +94,7 @@ func (x *GestureSingle) GetExclusive() bool {
 
 var xGestureSingleGetTouchOnly func(uintptr) bool
 
-// Returns %TRUE if the gesture is only triggered by touch events.
+// Returns true if the gesture is only triggered by touch events.
 func (x *GestureSingle) GetTouchOnly() bool {
 
 	cret := xGestureSingleGetTouchOnly(x.GoPointer())
@@ -104,7 +103,7 @@ func (x *GestureSingle) GetTouchOnly() bool {
 
 var xGestureSingleSetButton func(uintptr, uint)
 
-// Sets the button number @gesture listens to.
+// Sets the button number gesture listens to.
 //
 // If non-0, every button press from a different button
 // number will be ignored. Touch events implicitly match
@@ -117,7 +116,7 @@ func (x *GestureSingle) SetButton(ButtonVar uint) {
 
 var xGestureSingleSetExclusive func(uintptr, bool)
 
-// Sets whether @gesture is exclusive.
+// Sets whether gesture is exclusive.
 //
 // An exclusive gesture will only handle pointer and "pointer emulated"
 // touch events, so at any given time, there is only one sequence able
@@ -132,8 +131,8 @@ var xGestureSingleSetTouchOnly func(uintptr, bool)
 
 // Sets whether to handle only touch events.
 //
-// If @touch_only is %TRUE, @gesture will only handle events of type
-// %GDK_TOUCH_BEGIN, %GDK_TOUCH_UPDATE or %GDK_TOUCH_END. If %FALSE,
+// If touch_only is true, gesture will only handle events of type
+// [gdk.TouchBeginValue], [gdk.TouchUpdateValue] or [gdk.TouchEndValue]. If false,
 // mouse events will be handled too.
 func (x *GestureSingle) SetTouchOnly(TouchOnlyVar bool) {
 
@@ -212,7 +211,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -221,13 +220,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureSingleGLibType, libs, "gtk_gesture_single_get_type")
 
-	core.PuregoSafeRegister(&xGestureSingleGetButton, libs, "gtk_gesture_single_get_button")
-	core.PuregoSafeRegister(&xGestureSingleGetCurrentButton, libs, "gtk_gesture_single_get_current_button")
-	core.PuregoSafeRegister(&xGestureSingleGetCurrentSequence, libs, "gtk_gesture_single_get_current_sequence")
-	core.PuregoSafeRegister(&xGestureSingleGetExclusive, libs, "gtk_gesture_single_get_exclusive")
-	core.PuregoSafeRegister(&xGestureSingleGetTouchOnly, libs, "gtk_gesture_single_get_touch_only")
-	core.PuregoSafeRegister(&xGestureSingleSetButton, libs, "gtk_gesture_single_set_button")
-	core.PuregoSafeRegister(&xGestureSingleSetExclusive, libs, "gtk_gesture_single_set_exclusive")
-	core.PuregoSafeRegister(&xGestureSingleSetTouchOnly, libs, "gtk_gesture_single_set_touch_only")
+	core.PuregoRegisterSince(&xGestureSingleGetButton, libs, "gtk_gesture_single_get_button", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleGetCurrentButton, libs, "gtk_gesture_single_get_current_button", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleGetCurrentSequence, libs, "gtk_gesture_single_get_current_sequence", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleGetExclusive, libs, "gtk_gesture_single_get_exclusive", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleGetTouchOnly, libs, "gtk_gesture_single_get_touch_only", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleSetButton, libs, "gtk_gesture_single_set_button", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleSetExclusive, libs, "gtk_gesture_single_set_exclusive", "GTK", "")
+	core.PuregoRegisterSince(&xGestureSingleSetTouchOnly, libs, "gtk_gesture_single_set_touch_only", "GTK", "")
 
 }
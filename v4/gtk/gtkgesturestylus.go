@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -59,11 +58,11 @@ func NewGestureStylus() *GestureStylus {
 
 var xGestureStylusGetAxes func(uintptr, []gdk.AxisUse, *[]float64) bool
 
-// Returns the current values for the requested @axes.
+// Returns the current values for the requested axes.
 //
 // This function must be called from the handler of one of the
-// [signal@Gtk.GestureStylus::down], [signal@Gtk.GestureStylus::motion],
-// [signal@Gtk.GestureStylus::up] or [signal@Gtk.GestureStylus::proximity]
+// [GestureStylus.ConnectDownFunc], [GestureStylus.ConnectMotionFunc],
+// [GestureStylus.ConnectUpFunc] or [GestureStylus.ConnectProximityFunc]
 // signals.
 func (x *GestureStylus) GetAxes(AxesVar []gdk.AxisUse, ValuesVar *[]float64) bool {
 
@@ -73,11 +72,11 @@ func (x *GestureStylus) GetAxes(AxesVar []gdk.AxisUse, ValuesVar *[]float64) boo
 
 var xGestureStylusGetAxis func(uintptr, gdk.AxisUse, *float64) bool
 
-// Returns the current value for the requested @axis.
+// Returns the current value for the requested axis.
 //
 // This function must be called from the handler of one of the
-// [signal@Gtk.GestureStylus::down], [signal@Gtk.GestureStylus::motion],
-// [signal@Gtk.GestureStylus::up] or [signal@Gtk.GestureStylus::proximity]
+// [GestureStylus.ConnectDownFunc], [GestureStylus.ConnectMotionFunc],
+// [GestureStylus.ConnectUpFunc] or [GestureStylus.ConnectProximityFunc]
 // signals.
 func (x *GestureStylus) GetAxis(AxisVar gdk.AxisUse, ValueVar *float64) bool {
 
@@ -94,12 +93,12 @@ var xGestureStylusGetBacklog func(uintptr, *uintptr, *uint) bool
 // accumulated coordinate/timing state before the emission of the
 // current [Gtk.GestureStylus::motion] signal.
 //
-// This function may only be called within a [signal@Gtk.GestureStylus::motion]
+// This function may only be called within a [GestureStylus.ConnectMotionFunc]
 // signal handler, the state given in this signal and obtainable through
-// [method@Gtk.GestureStylus.get_axis] express the latest (most up-to-date)
+// [GestureStylus.GetAxis] express the latest (most up-to-date)
 // state in motion history.
 //
-// The @backlog is provided in chronological order.
+// The backlog is provided in chronological order.
 func (x *GestureStylus) GetBacklog(BacklogVar *uintptr, NElemsVar *uint) bool {
 
 	cret := xGestureStylusGetBacklog(x.GoPointer(), BacklogVar, NElemsVar)
@@ -111,8 +110,8 @@ var xGestureStylusGetDeviceTool func(uintptr) uintptr
 // Returns the `GdkDeviceTool` currently driving input through this gesture.
 //
 // This function must be called from the handler of one of the
-// [signal@Gtk.GestureStylus::down], [signal@Gtk.GestureStylus::motion],
-// [signal@Gtk.GestureStylus::up] or [signal@Gtk.GestureStylus::proximity]
+// [GestureStylus.ConnectDownFunc], [GestureStylus.ConnectMotionFunc],
+// [GestureStylus.ConnectUpFunc] or [GestureStylus.ConnectProximityFunc]
 // signals.
 func (x *GestureStylus) GetDeviceTool() *gdk.DeviceTool {
 	var cls *gdk.DeviceTool
@@ -181,99 +180,187 @@ func (x *GestureStylus) GetPropertyStylusOnly() bool {
 }
 
 // Emitted when the stylus touches the device.
+//
+// Deprecated: use ConnectDownFunc, which also accepts method values and closures.
 func (x *GestureStylus) ConnectDown(cb *func(GestureStylus, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "down", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureStylus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "down", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+var xGestureStylusDownTrampoline uintptr
+
+func xGestureStylusDownNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureStylus, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureStylus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "down", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDownFunc connects cb to the "down" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureStylus) ConnectDownFunc(cb func(GestureStylus, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "down", &xGestureStylusDownTrampoline, xGestureStylusDownNewTrampoline, cb)
 }
 
 // Emitted when the stylus moves while touching the device.
+//
+// Deprecated: use ConnectMotionFunc, which also accepts method values and closures.
 func (x *GestureStylus) ConnectMotion(cb *func(GestureStylus, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureStylus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "motion", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+var xGestureStylusMotionTrampoline uintptr
+
+func xGestureStylusMotionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureStylus, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureStylus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "motion", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMotionFunc connects cb to the "motion" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureStylus) ConnectMotionFunc(cb func(GestureStylus, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "motion", &xGestureStylusMotionTrampoline, xGestureStylusMotionNewTrampoline, cb)
 }
 
 // Emitted when the stylus is in proximity of the device.
+//
+// Deprecated: use ConnectProximityFunc, which also accepts method values and closures.
 func (x *GestureStylus) ConnectProximity(cb *func(GestureStylus, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "proximity", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureStylus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "proximity", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+var xGestureStylusProximityTrampoline uintptr
+
+func xGestureStylusProximityNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureStylus, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureStylus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "proximity", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectProximityFunc connects cb to the "proximity" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureStylus) ConnectProximityFunc(cb func(GestureStylus, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "proximity", &xGestureStylusProximityTrampoline, xGestureStylusProximityNewTrampoline, cb)
 }
 
 // Emitted when the stylus no longer touches the device.
+//
+// Deprecated: use ConnectUpFunc, which also accepts method values and closures.
 func (x *GestureStylus) ConnectUp(cb *func(GestureStylus, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "up", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureStylus{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, XVarp, YVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "up", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp float64, YVarp float64) {
+var xGestureStylusUpTrampoline uintptr
+
+func xGestureStylusUpNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp float64, YVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureStylus, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureStylus{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, XVarp, YVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "up", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUpFunc connects cb to the "up" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureStylus) ConnectUpFunc(cb func(GestureStylus, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "up", &xGestureStylusUpTrampoline, xGestureStylusUpNewTrampoline, cb)
 }
 
 func init() {
@@ -281,7 +368,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -290,13 +377,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureStylusGLibType, libs, "gtk_gesture_stylus_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureStylus, libs, "gtk_gesture_stylus_new")
+	core.PuregoRegisterSince(&xNewGestureStylus, libs, "gtk_gesture_stylus_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureStylusGetAxes, libs, "gtk_gesture_stylus_get_axes")
-	core.PuregoSafeRegister(&xGestureStylusGetAxis, libs, "gtk_gesture_stylus_get_axis")
-	core.PuregoSafeRegister(&xGestureStylusGetBacklog, libs, "gtk_gesture_stylus_get_backlog")
-	core.PuregoSafeRegister(&xGestureStylusGetDeviceTool, libs, "gtk_gesture_stylus_get_device_tool")
-	core.PuregoSafeRegister(&xGestureStylusGetStylusOnly, libs, "gtk_gesture_stylus_get_stylus_only")
-	core.PuregoSafeRegister(&xGestureStylusSetStylusOnly, libs, "gtk_gesture_stylus_set_stylus_only")
+	core.PuregoRegisterSince(&xGestureStylusGetAxes, libs, "gtk_gesture_stylus_get_axes", "GTK", "")
+	core.PuregoRegisterSince(&xGestureStylusGetAxis, libs, "gtk_gesture_stylus_get_axis", "GTK", "")
+	core.PuregoRegisterSince(&xGestureStylusGetBacklog, libs, "gtk_gesture_stylus_get_backlog", "GTK", "")
+	core.PuregoRegisterSince(&xGestureStylusGetDeviceTool, libs, "gtk_gesture_stylus_get_device_tool", "GTK", "")
+	core.PuregoRegisterSince(&xGestureStylusGetStylusOnly, libs, "gtk_gesture_stylus_get_stylus_only", "GTK", "4.10")
+	core.PuregoRegisterSince(&xGestureStylusSetStylusOnly, libs, "gtk_gesture_stylus_set_stylus_only", "GTK", "4.10")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,13 +22,13 @@ func (x *GestureSwipeClass) GoPointer() uintptr {
 // Recognizes swipe gestures.
 //
 // After a press/move/.../move/release sequence happens, the
-// [signal@Gtk.GestureSwipe::swipe] signal will be emitted,
+// [GestureSwipe.ConnectSwipeFunc] signal will be emitted,
 // providing the velocity and directionality of the sequence
 // at the time it was lifted.
 //
 // If the velocity is desired in intermediate points,
-// [method@Gtk.GestureSwipe.get_velocity] can be called in a
-// [signal@Gtk.Gesture::update] handler.
+// [GestureSwipe.GetVelocity] can be called in a
+// [Gesture.ConnectUpdateFunc] handler.
 //
 // All velocities are reported in pixels/sec units.
 type GestureSwipe struct {
@@ -68,8 +67,8 @@ var xGestureSwipeGetVelocity func(uintptr, *float64, *float64) bool
 
 // Gets the current velocity.
 //
-// If the gesture is recognized, this function returns %TRUE and fills
-// in @velocity_x and @velocity_y with the recorded velocity, as per the
+// If the gesture is recognized, this function returns true and fills
+// in velocity_x and velocity_y with the recorded velocity, as per the
 // last events processed.
 func (x *GestureSwipe) GetVelocity(VelocityXVar *float64, VelocityYVar *float64) bool {
 
@@ -91,27 +90,49 @@ func (c *GestureSwipe) SetGoPointer(ptr uintptr) {
 // Emitted when the recognized gesture is finished.
 //
 // Velocity and direction are a product of previously recorded events.
+//
+// Deprecated: use ConnectSwipeFunc, which also accepts method values and closures.
 func (x *GestureSwipe) ConnectSwipe(cb *func(GestureSwipe, float64, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "swipe", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, VelocityXVarp float64, VelocityYVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureSwipe{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, VelocityXVarp, VelocityYVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "swipe", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, VelocityXVarp float64, VelocityYVarp float64) {
+var xGestureSwipeSwipeTrampoline uintptr
+
+func xGestureSwipeSwipeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, VelocityXVarp float64, VelocityYVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureSwipe, float64, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureSwipe{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, VelocityXVarp, VelocityYVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "swipe", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSwipeFunc connects cb to the "swipe" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureSwipe) ConnectSwipeFunc(cb func(GestureSwipe, float64, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "swipe", &xGestureSwipeSwipeTrampoline, xGestureSwipeSwipeNewTrampoline, cb)
 }
 
 func init() {
@@ -119,7 +140,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -128,8 +149,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureSwipeGLibType, libs, "gtk_gesture_swipe_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureSwipe, libs, "gtk_gesture_swipe_new")
+	core.PuregoRegisterSince(&xNewGestureSwipe, libs, "gtk_gesture_swipe_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureSwipeGetVelocity, libs, "gtk_gesture_swipe_get_velocity")
+	core.PuregoRegisterSince(&xGestureSwipeGetVelocity, libs, "gtk_gesture_swipe_get_velocity", "GTK", "")
 
 }
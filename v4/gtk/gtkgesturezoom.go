@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +22,7 @@ func (x *GestureZoomClass) GoPointer() uintptr {
 // Recognizes 2-finger pinch/zoom gestures.
 //
 // Whenever the distance between both tracked sequences changes, the
-// [signal@Gtk.GestureZoom::scale-changed] signal is emitted to report
+// [GestureZoom.ConnectScaleChangedFunc] signal is emitted to report
 // the scale factor.
 type GestureZoom struct {
 	Gesture
@@ -62,9 +61,9 @@ var xGestureZoomGetScaleDelta func(uintptr) float64
 
 // Gets the scale delta.
 //
-// If @gesture is active, this function returns the zooming
+// If gesture is active, this function returns the zooming
 // difference since the gesture was recognized (hence the
-// starting point is considered 1:1). If @gesture is not
+// starting point is considered 1:1). If gesture is not
 // active, 1 is returned.
 func (x *GestureZoom) GetScaleDelta() float64 {
 
@@ -84,27 +83,49 @@ func (c *GestureZoom) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted whenever the distance between both tracked sequences changes.
+//
+// Deprecated: use ConnectScaleChangedFunc, which also accepts method values and closures.
 func (x *GestureZoom) ConnectScaleChanged(cb *func(GestureZoom, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "scale-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ScaleVarp float64) {
+			defer core.RecoverPanic()
+			fa := GestureZoom{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ScaleVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "scale-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ScaleVarp float64) {
+var xGestureZoomScaleChangedTrampoline uintptr
+
+func xGestureZoomScaleChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ScaleVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GestureZoom, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GestureZoom{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ScaleVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "scale-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectScaleChangedFunc connects cb to the "scale-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GestureZoom) ConnectScaleChangedFunc(cb func(GestureZoom, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "scale-changed", &xGestureZoomScaleChangedTrampoline, xGestureZoomScaleChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -112,7 +133,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -121,8 +142,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xGestureZoomGLibType, libs, "gtk_gesture_zoom_get_type")
 
-	core.PuregoSafeRegister(&xNewGestureZoom, libs, "gtk_gesture_zoom_new")
+	core.PuregoRegisterSince(&xNewGestureZoom, libs, "gtk_gesture_zoom_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xGestureZoomGetScaleDelta, libs, "gtk_gesture_zoom_get_scale_delta")
+	core.PuregoRegisterSince(&xGestureZoomGetScaleDelta, libs, "gtk_gesture_zoom_get_scale_delta", "GTK", "")
 
 }
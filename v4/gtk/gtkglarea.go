@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -38,7 +37,8 @@ func (x *GLAreaClass) OverrideRender(cb func(*GLArea, *gdk.GLContext) bool) {
 	if cb == nil {
 		x.xRender = 0
 	} else {
-		x.xRender = purego.NewCallback(func(AreaVarp uintptr, ContextVarp uintptr) bool {
+		x.xRender = core.NewCallback(func(AreaVarp uintptr, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(GLAreaNewFromInternalPtr(AreaVarp), gdk.GLContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -51,7 +51,7 @@ func (x *GLAreaClass) GetRender() func(*GLArea, *gdk.GLContext) bool {
 		return nil
 	}
 	var rawCallback func(AreaVarp uintptr, ContextVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xRender)
+	core.RegisterFunc(&rawCallback, x.xRender)
 	return func(AreaVar *GLArea, ContextVar *gdk.GLContext) bool {
 		return rawCallback(AreaVar.GoPointer(), ContextVar.GoPointer())
 	}
@@ -63,7 +63,8 @@ func (x *GLAreaClass) OverrideResize(cb func(*GLArea, int, int)) {
 	if cb == nil {
 		x.xResize = 0
 	} else {
-		x.xResize = purego.NewCallback(func(AreaVarp uintptr, WidthVarp int, HeightVarp int) {
+		x.xResize = core.NewCallback(func(AreaVarp uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(GLAreaNewFromInternalPtr(AreaVarp), WidthVarp, HeightVarp)
 		})
 	}
@@ -76,7 +77,7 @@ func (x *GLAreaClass) GetResize() func(*GLArea, int, int) {
 		return nil
 	}
 	var rawCallback func(AreaVarp uintptr, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xResize)
+	core.RegisterFunc(&rawCallback, x.xResize)
 	return func(AreaVar *GLArea, WidthVar int, HeightVar int) {
 		rawCallback(AreaVar.GoPointer(), WidthVar, HeightVar)
 	}
@@ -88,7 +89,8 @@ func (x *GLAreaClass) OverrideCreateContext(cb func(*GLArea) *gdk.GLContext) {
 	if cb == nil {
 		x.xCreateContext = 0
 	} else {
-		x.xCreateContext = purego.NewCallback(func(AreaVarp uintptr) uintptr {
+		x.xCreateContext = core.NewCallback(func(AreaVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(GLAreaNewFromInternalPtr(AreaVarp))
 			if ret == nil {
 				return 0
@@ -105,7 +107,7 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 		return nil
 	}
 	var rawCallback func(AreaVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateContext)
+	core.RegisterFunc(&rawCallback, x.xCreateContext)
 	return func(AreaVar *GLArea) *gdk.GLContext {
 		rawRet := rawCallback(AreaVar.GoPointer())
 		if rawRet == 0 {
@@ -119,20 +121,13 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 
 // Allows drawing with OpenGL.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="glarea-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkGLArea" src="glarea.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// `GtkGLArea` sets up its own [class@Gdk.GLContext], and creates a custom
+// `GtkGLArea` sets up its own [gdk.GLContext], and creates a custom
 // GL framebuffer that the widget will do GL rendering onto. It also ensures
 // that this framebuffer is the default GL rendering target when rendering.
 // The completed rendering is integrated into the larger GTK scene graph as
 // a texture.
 //
-// In order to draw, you have to connect to the [signal@Gtk.GLArea::render]
+// In order to draw, you have to connect to the [GLArea.ConnectRenderFunc]
 // signal, or subclass `GtkGLArea` and override the GtkGLAreaClass.render
 // virtual function.
 //
@@ -140,20 +135,18 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 // the widget's drawing area, and it is kept updated when the size and
 // position of the drawing area changes.
 //
-// ## Drawing with GtkGLArea
+// # Drawing with GtkGLArea
 //
 // The simplest way to draw using OpenGL commands in a `GtkGLArea` is to
-// create a widget instance and connect to the [signal@Gtk.GLArea::render] signal:
+// create a widget instance and connect to the [GLArea.ConnectRenderFunc] signal:
 //
 // The `render()` function will be called when the `GtkGLArea` is ready
 // for you to draw its content:
 //
 // The initial contents of the framebuffer are transparent.
 //
-// ```c
-// static gboolean
-// render (GtkGLArea *area, GdkGLContext *context)
-//
+//	static gboolean
+//	render (GtkGLArea *area, GdkGLContext *context)
 //	{
 //	  // inside this function it's safe to use GL; the given
 //	  // GdkGLContext has been made current to the drawable
@@ -169,7 +162,7 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	  // we, for instance, intend on utilizing the results of an
 //	  // intermediate render texture pass
 //	  GLuint screen_fb = 0;
-//	  glGetIntegerv (GL_FRAMEBUFFER_BINDING, &amp;screen_fb);
+//	  glGetIntegerv (GL_FRAMEBUFFER_BINDING, &screen_fb);
 //
 //	  // draw your object
 //	  // draw_an_object ();
@@ -180,8 +173,7 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	  return TRUE;
 //	}
 //
-// void setup_glarea (void)
-//
+//	void setup_glarea (void)
 //	{
 //	  // create a GtkGLArea instance
 //	  GtkWidget *gl_area = gtk_gl_area_new ();
@@ -190,20 +182,16 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	  g_signal_connect (gl_area, "render", G_CALLBACK (render), NULL);
 //	}
 //
-// ```
-//
 // If you need to initialize OpenGL state, e.g. buffer objects or
-// shaders, you should use the [signal@Gtk.Widget::realize] signal;
-// you can use the [signal@Gtk.Widget::unrealize] signal to clean up.
+// shaders, you should use the [Widget.ConnectRealizeFunc] signal;
+// you can use the [Widget.ConnectUnrealizeFunc] signal to clean up.
 // Since the `GdkGLContext` creation and initialization may fail, you
-// will need to check for errors, using [method@Gtk.GLArea.get_error].
+// will need to check for errors, using [GLArea.GetError].
 //
 // An example of how to safely initialize the GL state is:
 //
-// ```c
-// static void
-// on_realize (GtkGLArea *area)
-//
+//	static void
+//	on_realize (GtkGLArea *area)
 //	{
 //	  // We need to make the context current if we want to
 //	  // call GL API
@@ -219,7 +207,7 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	  // to show eventual initialization errors on the
 //	  // GtkGLArea widget itself
 //	  GError *internal_error = NULL;
-//	  init_buffer_objects (&amp;error);
+//	  init_buffer_objects (&error);
 //	  if (error != NULL)
 //	    {
 //	      gtk_gl_area_set_error (area, error);
@@ -227,7 +215,7 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	      return;
 //	    }
 //
-//	  init_shaders (&amp;error);
+//	  init_shaders (&error);
 //	  if (error != NULL)
 //	    {
 //	      gtk_gl_area_set_error (area, error);
@@ -236,10 +224,8 @@ func (x *GLAreaClass) GetCreateContext() func(*GLArea) *gdk.GLContext {
 //	    }
 //	}
 //
-// ```
-//
 // If you need to change the options for creating the `GdkGLContext`
-// you should use the [signal@Gtk.GLArea::create-context] signal.
+// you should use the [GLArea.ConnectCreateContextFunc] signal.
 type GLArea struct {
 	Widget
 }
@@ -277,12 +263,12 @@ var xGLAreaAttachBuffers func(uintptr)
 
 // Binds buffers to the framebuffer.
 //
-// Ensures that the @area framebuffer object is made the current draw
-// and read target, and that all the required buffers for the @area
+// Ensures that the area framebuffer object is made the current draw
+// and read target, and that all the required buffers for the area
 // are created and bound to the framebuffer.
 //
 // This function is automatically called before emitting the
-// [signal@Gtk.GLArea::render] signal, and doesn't normally need to be
+// [GLArea.ConnectRenderFunc] signal, and doesn't normally need to be
 // called by application code.
 func (x *GLArea) AttachBuffers() {
 
@@ -294,7 +280,7 @@ var xGLAreaGetAllowedApis func(uintptr) gdk.GLAPI
 
 // Gets the allowed APIs.
 //
-// See [method@Gtk.GLArea.set_allowed_apis].
+// See [GLArea.SetAllowedApis].
 func (x *GLArea) GetAllowedApis() gdk.GLAPI {
 
 	cret := xGLAreaGetAllowedApis(x.GoPointer())
@@ -323,7 +309,7 @@ func (x *GLArea) GetAutoRender() bool {
 
 var xGLAreaGetContext func(uintptr) uintptr
 
-// Retrieves the `GdkGLContext` used by @area.
+// Retrieves the `GdkGLContext` used by area.
 func (x *GLArea) GetContext() *gdk.GLContext {
 	var cls *gdk.GLContext
 
@@ -340,7 +326,7 @@ func (x *GLArea) GetContext() *gdk.GLContext {
 
 var xGLAreaGetError func(uintptr) *glib.Error
 
-// Gets the current error set on the @area.
+// Gets the current error set on the area.
 func (x *GLArea) GetError() *glib.Error {
 
 	cret := xGLAreaGetError(x.GoPointer())
@@ -369,31 +355,20 @@ var xGLAreaGetRequiredVersion func(uintptr, *int, *int)
 
 // Retrieves the required version of OpenGL.
 //
-// See [method@Gtk.GLArea.set_required_version].
+// See [GLArea.SetRequiredVersion].
 func (x *GLArea) GetRequiredVersion(MajorVar *int, MinorVar *int) {
 
 	xGLAreaGetRequiredVersion(x.GoPointer(), MajorVar, MinorVar)
 
 }
 
-var xGLAreaGetUseEs func(uintptr) bool
-
-// Returns whether the `GtkGLArea` should use OpenGL ES.
-//
-// See [method@Gtk.GLArea.set_use_es].
-func (x *GLArea) GetUseEs() bool {
-
-	cret := xGLAreaGetUseEs(x.GoPointer())
-	return cret
-}
-
 var xGLAreaMakeCurrent func(uintptr)
 
-// Ensures that the `GdkGLContext` used by @area is associated with
+// Ensures that the `GdkGLContext` used by area is associated with
 // the `GtkGLArea`.
 //
 // This function is automatically called before emitting the
-// [signal@Gtk.GLArea::render] signal, and doesn't normally need
+// [GLArea.ConnectRenderFunc] signal, and doesn't normally need
 // to be called by application code.
 func (x *GLArea) MakeCurrent() {
 
@@ -406,12 +381,12 @@ var xGLAreaQueueRender func(uintptr)
 // Marks the currently rendered data (if any) as invalid, and queues
 // a redraw of the widget.
 //
-// This ensures that the [signal@Gtk.GLArea::render] signal
+// This ensures that the [GLArea.ConnectRenderFunc] signal
 // is emitted during the draw.
 //
-// This is only needed when [method@Gtk.GLArea.set_auto_render] has
-// been called with a %FALSE value. The default behaviour is to
-// emit [signal@Gtk.GLArea::render] on each draw.
+// This is only needed when [GLArea.SetAutoRender] has
+// been called with a false value. The default behaviour is to
+// emit [GLArea.ConnectRenderFunc] on each draw.
 func (x *GLArea) QueueRender() {
 
 	xGLAreaQueueRender(x.GoPointer())
@@ -422,7 +397,7 @@ var xGLAreaSetAllowedApis func(uintptr, gdk.GLAPI)
 
 // Sets the allowed APIs to create a context with.
 //
-// You should check [property@Gtk.GLArea:api] before drawing
+// You should check GLArea:api before drawing
 // with either API.
 //
 // By default, all APIs are allowed.
@@ -436,14 +411,14 @@ var xGLAreaSetAutoRender func(uintptr, bool)
 
 // Sets whether the `GtkGLArea` is in auto render mode.
 //
-// If @auto_render is %TRUE the [signal@Gtk.GLArea::render] signal will
+// If auto_render is true the [GLArea.ConnectRenderFunc] signal will
 // be emitted every time the widget draws. This is the default and is
 // useful if drawing the widget is faster.
 //
-// If @auto_render is %FALSE the data from previous rendering is kept
+// If auto_render is false the data from previous rendering is kept
 // around and will be used for drawing the widget the next time,
 // unless the window is resized. In order to force a rendering
-// [method@Gtk.GLArea.queue_render] must be called. This mode is
+// [GLArea.QueueRender] must be called. This mode is
 // useful when the scene changes seldom, but takes a long time to redraw.
 func (x *GLArea) SetAutoRender(AutoRenderVar bool) {
 
@@ -456,7 +431,7 @@ var xGLAreaSetError func(uintptr, *glib.Error)
 // Sets an error on the area which will be shown instead of the
 // GL rendering.
 //
-// This is useful in the [signal@Gtk.GLArea::create-context]
+// This is useful in the [GLArea.ConnectCreateContextFunc]
 // signal if GL context creation fails.
 func (x *GLArea) SetError(ErrorVar *glib.Error) {
 
@@ -468,7 +443,7 @@ var xGLAreaSetHasDepthBuffer func(uintptr, bool)
 
 // Sets whether the `GtkGLArea` should use a depth buffer.
 //
-// If @has_depth_buffer is %TRUE the widget will allocate and
+// If has_depth_buffer is true the widget will allocate and
 // enable a depth buffer for the target framebuffer. Otherwise
 // there will be none.
 func (x *GLArea) SetHasDepthBuffer(HasDepthBufferVar bool) {
@@ -481,7 +456,7 @@ var xGLAreaSetHasStencilBuffer func(uintptr, bool)
 
 // Sets whether the `GtkGLArea` should use a stencil buffer.
 //
-// If @has_stencil_buffer is %TRUE the widget will allocate and
+// If has_stencil_buffer is true the widget will allocate and
 // enable a stencil buffer for the target framebuffer. Otherwise
 // there will be none.
 func (x *GLArea) SetHasStencilBuffer(HasStencilBufferVar bool) {
@@ -502,18 +477,6 @@ func (x *GLArea) SetRequiredVersion(MajorVar int, MinorVar int) {
 
 }
 
-var xGLAreaSetUseEs func(uintptr, bool)
-
-// Sets whether the @area should create an OpenGL or an OpenGL ES context.
-//
-// You should check the capabilities of the `GdkGLContext` before drawing
-// with either API.
-func (x *GLArea) SetUseEs(UseEsVar bool) {
-
-	xGLAreaSetUseEs(x.GoPointer(), UseEsVar)
-
-}
-
 func (c *GLArea) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -526,14 +489,14 @@ func (c *GLArea) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyAutoRender sets the "auto-render" property.
-// If set to %TRUE the ::render signal will be emitted every time
+// If set to true the ::render signal will be emitted every time
 // the widget draws.
 //
 // This is the default and is useful if drawing the widget is faster.
 //
-// If set to %FALSE the data from previous rendering is kept around and will
+// If set to false the data from previous rendering is kept around and will
 // be used for drawing the widget the next time, unless the window is resized.
-// In order to force a rendering [method@Gtk.GLArea.queue_render] must be called.
+// In order to force a rendering [GLArea.QueueRender] must be called.
 // This mode is useful when the scene changes seldom, but takes a long time
 // to redraw.
 func (x *GLArea) SetPropertyAutoRender(value bool) {
@@ -544,14 +507,14 @@ func (x *GLArea) SetPropertyAutoRender(value bool) {
 }
 
 // GetPropertyAutoRender gets the "auto-render" property.
-// If set to %TRUE the ::render signal will be emitted every time
+// If set to true the ::render signal will be emitted every time
 // the widget draws.
 //
 // This is the default and is useful if drawing the widget is faster.
 //
-// If set to %FALSE the data from previous rendering is kept around and will
+// If set to false the data from previous rendering is kept around and will
 // be used for drawing the widget the next time, unless the window is resized.
-// In order to force a rendering [method@Gtk.GLArea.queue_render] must be called.
+// In order to force a rendering [GLArea.QueueRender] must be called.
 // This mode is useful when the scene changes seldom, but takes a long time
 // to redraw.
 func (x *GLArea) GetPropertyAutoRender() bool {
@@ -561,7 +524,7 @@ func (x *GLArea) GetPropertyAutoRender() bool {
 }
 
 // SetPropertyHasDepthBuffer sets the "has-depth-buffer" property.
-// If set to %TRUE the widget will allocate and enable a depth buffer for the
+// If set to true the widget will allocate and enable a depth buffer for the
 // target framebuffer.
 //
 // Setting this property will enable GL's depth testing as a side effect. If
@@ -575,7 +538,7 @@ func (x *GLArea) SetPropertyHasDepthBuffer(value bool) {
 }
 
 // GetPropertyHasDepthBuffer gets the "has-depth-buffer" property.
-// If set to %TRUE the widget will allocate and enable a depth buffer for the
+// If set to true the widget will allocate and enable a depth buffer for the
 // target framebuffer.
 //
 // Setting this property will enable GL's depth testing as a side effect. If
@@ -588,7 +551,7 @@ func (x *GLArea) GetPropertyHasDepthBuffer() bool {
 }
 
 // SetPropertyHasStencilBuffer sets the "has-stencil-buffer" property.
-// If set to %TRUE the widget will allocate and enable a stencil buffer for the
+// If set to true the widget will allocate and enable a stencil buffer for the
 // target framebuffer.
 func (x *GLArea) SetPropertyHasStencilBuffer(value bool) {
 	var v gobject.Value
@@ -598,7 +561,7 @@ func (x *GLArea) SetPropertyHasStencilBuffer(value bool) {
 }
 
 // GetPropertyHasStencilBuffer gets the "has-stencil-buffer" property.
-// If set to %TRUE the widget will allocate and enable a stencil buffer for the
+// If set to true the widget will allocate and enable a stencil buffer for the
 // target framebuffer.
 func (x *GLArea) GetPropertyHasStencilBuffer() bool {
 	var v gobject.Value
@@ -607,8 +570,10 @@ func (x *GLArea) GetPropertyHasStencilBuffer() bool {
 }
 
 // SetPropertyUseEs sets the "use-es" property.
-// If set to %TRUE the widget will try to create a `GdkGLContext` using
+// If set to true the widget will try to create a `GdkGLContext` using
 // OpenGL ES instead of OpenGL.
+//
+// Deprecated: since 4.12. Use GLArea:allowed-apis
 func (x *GLArea) SetPropertyUseEs(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -617,8 +582,10 @@ func (x *GLArea) SetPropertyUseEs(value bool) {
 }
 
 // GetPropertyUseEs gets the "use-es" property.
-// If set to %TRUE the widget will try to create a `GdkGLContext` using
+// If set to true the widget will try to create a `GdkGLContext` using
 // OpenGL ES instead of OpenGL.
+//
+// Deprecated: since 4.12. Use GLArea:allowed-apis
 func (x *GLArea) GetPropertyUseEs() bool {
 	var v gobject.Value
 	x.GetProperty("use-es", &v)
@@ -632,57 +599,102 @@ func (x *GLArea) GetPropertyUseEs() bool {
 // or if you want to try creating different kinds of GL options.
 //
 // If context creation fails then the signal handler can use
-// [method@Gtk.GLArea.set_error] to register a more detailed error
+// [GLArea.SetError] to register a more detailed error
 // of how the construction failed.
+//
+// Deprecated: use ConnectCreateContextFunc, which also accepts method values and closures.
 func (x *GLArea) ConnectCreateContext(cb *func(GLArea) gdk.GLContext) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "create-context", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := GLArea{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			CreateContextCls := cbFn(fa)
+			return CreateContextCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "create-context", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) uintptr {
+var xGLAreaCreateContextTrampoline uintptr
+
+func xGLAreaCreateContextNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GLArea) gdk.GLContext)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GLArea{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		CreateContextCls := cbFn(fa)
 		return CreateContextCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "create-context", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCreateContextFunc connects cb to the "create-context" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GLArea) ConnectCreateContextFunc(cb func(GLArea) gdk.GLContext) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "create-context", &xGLAreaCreateContextTrampoline, xGLAreaCreateContextNewTrampoline, cb)
 }
 
 // Emitted every time the contents of the `GtkGLArea` should be redrawn.
 //
-// The @context is bound to the @area prior to emitting this function,
+// The context is bound to the area prior to emitting this function,
 // and the buffers are painted to the window once the emission terminates.
+//
+// Deprecated: use ConnectRenderFunc, which also accepts method values and closures.
 func (x *GLArea) ConnectRender(cb *func(GLArea, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "render", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := GLArea{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ContextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "render", cbRefPtr, cbPtr)
+}
+
+var xGLAreaRenderTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr) bool {
+func xGLAreaRenderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GLArea, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GLArea{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ContextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "render", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRenderFunc connects cb to the "render" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GLArea) ConnectRenderFunc(cb func(GLArea, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "render", &xGLAreaRenderTrampoline, xGLAreaRenderNewTrampoline, cb)
 }
 
 // Emitted once when the widget is realized, and then each time the widget
@@ -696,27 +708,49 @@ func (x *GLArea) ConnectRender(cb *func(GLArea, uintptr) bool) uint {
 // is emitted.
 //
 // The default handler sets up the GL viewport.
+//
+// Deprecated: use ConnectResizeFunc, which also accepts method values and closures.
 func (x *GLArea) ConnectResize(cb *func(GLArea, int, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "resize", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
+			fa := GLArea{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidthVarp, HeightVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "resize", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WidthVarp int, HeightVarp int) {
+var xGLAreaResizeTrampoline uintptr
+
+func xGLAreaResizeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidthVarp int, HeightVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GLArea, int, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GLArea{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidthVarp, HeightVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "resize", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResizeFunc connects cb to the "resize" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GLArea) ConnectResizeFunc(cb func(GLArea, int, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "resize", &xGLAreaResizeTrampoline, xGLAreaResizeNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -880,7 +914,7 @@ func (x *GLArea) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -888,14 +922,13 @@ func (x *GLArea) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *GLArea) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -919,19 +952,17 @@ func (x *GLArea) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *GLArea) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -952,7 +983,7 @@ func (x *GLArea) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -961,14 +992,12 @@ func (x *GLArea) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *GLArea) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -987,10 +1016,10 @@ func (x *GLArea) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *GLArea) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1002,7 +1031,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1011,26 +1040,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xGLAreaGLibType, libs, "gtk_gl_area_get_type")
 
-	core.PuregoSafeRegister(&xNewGLArea, libs, "gtk_gl_area_new")
-
-	core.PuregoSafeRegister(&xGLAreaAttachBuffers, libs, "gtk_gl_area_attach_buffers")
-	core.PuregoSafeRegister(&xGLAreaGetAllowedApis, libs, "gtk_gl_area_get_allowed_apis")
-	core.PuregoSafeRegister(&xGLAreaGetApi, libs, "gtk_gl_area_get_api")
-	core.PuregoSafeRegister(&xGLAreaGetAutoRender, libs, "gtk_gl_area_get_auto_render")
-	core.PuregoSafeRegister(&xGLAreaGetContext, libs, "gtk_gl_area_get_context")
-	core.PuregoSafeRegister(&xGLAreaGetError, libs, "gtk_gl_area_get_error")
-	core.PuregoSafeRegister(&xGLAreaGetHasDepthBuffer, libs, "gtk_gl_area_get_has_depth_buffer")
-	core.PuregoSafeRegister(&xGLAreaGetHasStencilBuffer, libs, "gtk_gl_area_get_has_stencil_buffer")
-	core.PuregoSafeRegister(&xGLAreaGetRequiredVersion, libs, "gtk_gl_area_get_required_version")
-	core.PuregoSafeRegister(&xGLAreaGetUseEs, libs, "gtk_gl_area_get_use_es")
-	core.PuregoSafeRegister(&xGLAreaMakeCurrent, libs, "gtk_gl_area_make_current")
-	core.PuregoSafeRegister(&xGLAreaQueueRender, libs, "gtk_gl_area_queue_render")
-	core.PuregoSafeRegister(&xGLAreaSetAllowedApis, libs, "gtk_gl_area_set_allowed_apis")
-	core.PuregoSafeRegister(&xGLAreaSetAutoRender, libs, "gtk_gl_area_set_auto_render")
-	core.PuregoSafeRegister(&xGLAreaSetError, libs, "gtk_gl_area_set_error")
-	core.PuregoSafeRegister(&xGLAreaSetHasDepthBuffer, libs, "gtk_gl_area_set_has_depth_buffer")
-	core.PuregoSafeRegister(&xGLAreaSetHasStencilBuffer, libs, "gtk_gl_area_set_has_stencil_buffer")
-	core.PuregoSafeRegister(&xGLAreaSetRequiredVersion, libs, "gtk_gl_area_set_required_version")
-	core.PuregoSafeRegister(&xGLAreaSetUseEs, libs, "gtk_gl_area_set_use_es")
+	gobject.CheckClassSize("GtkGLAreaClass", xGLAreaGLibType, unsafe.Sizeof(GLAreaClass{}))
+
+	core.PuregoRegisterSince(&xNewGLArea, libs, "gtk_gl_area_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xGLAreaAttachBuffers, libs, "gtk_gl_area_attach_buffers", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetAllowedApis, libs, "gtk_gl_area_get_allowed_apis", "GTK", "4.12")
+	core.PuregoRegisterSince(&xGLAreaGetApi, libs, "gtk_gl_area_get_api", "GTK", "4.12")
+	core.PuregoRegisterSince(&xGLAreaGetAutoRender, libs, "gtk_gl_area_get_auto_render", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetContext, libs, "gtk_gl_area_get_context", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetError, libs, "gtk_gl_area_get_error", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetHasDepthBuffer, libs, "gtk_gl_area_get_has_depth_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetHasStencilBuffer, libs, "gtk_gl_area_get_has_stencil_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaGetRequiredVersion, libs, "gtk_gl_area_get_required_version", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaMakeCurrent, libs, "gtk_gl_area_make_current", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaQueueRender, libs, "gtk_gl_area_queue_render", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetAllowedApis, libs, "gtk_gl_area_set_allowed_apis", "GTK", "4.12")
+	core.PuregoRegisterSince(&xGLAreaSetAutoRender, libs, "gtk_gl_area_set_auto_render", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetError, libs, "gtk_gl_area_set_error", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetHasDepthBuffer, libs, "gtk_gl_area_set_has_depth_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetHasStencilBuffer, libs, "gtk_gl_area_set_has_stencil_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetRequiredVersion, libs, "gtk_gl_area_set_required_version", "GTK", "")
 
 }
@@ -0,0 +1,52 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xGLAreaGetUseEs func(uintptr) bool
+
+// Returns whether the `GtkGLArea` should use OpenGL ES.
+//
+// See [GLArea.SetUseEs].
+//
+// Deprecated: since 4.12. Use [GLArea.GetApi]
+func (x *GLArea) GetUseEs() bool {
+
+	cret := xGLAreaGetUseEs(x.GoPointer())
+	return cret
+}
+
+var xGLAreaSetUseEs func(uintptr, bool)
+
+// Sets whether the area should create an OpenGL or an OpenGL ES context.
+//
+// You should check the capabilities of the `GdkGLContext` before drawing
+// with either API.
+//
+// Deprecated: since 4.12. Use [GLArea.SetAllowedApis]
+func (x *GLArea) SetUseEs(UseEsVar bool) {
+
+	xGLAreaSetUseEs(x.GoPointer(), UseEsVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xGLAreaGetUseEs, libs, "gtk_gl_area_get_use_es", "GTK", "")
+	core.PuregoRegisterSince(&xGLAreaSetUseEs, libs, "gtk_gl_area_set_use_es", "GTK", "")
+
+}
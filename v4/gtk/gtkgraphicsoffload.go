@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -38,6 +38,39 @@ const (
 	GraphicsOffloadDisabledValue GraphicsOffloadEnabled = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x GraphicsOffloadEnabled) String() string {
+	switch x {
+	case GraphicsOffloadEnabledValue:
+		return "GTK_GRAPHICS_OFFLOAD_ENABLED"
+	case GraphicsOffloadDisabledValue:
+		return "GTK_GRAPHICS_OFFLOAD_DISABLED"
+	}
+	return fmt.Sprintf("GraphicsOffloadEnabled(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x GraphicsOffloadEnabled) Nick() string {
+	switch x {
+	case GraphicsOffloadEnabledValue:
+		return "enabled"
+	case GraphicsOffloadDisabledValue:
+		return "disabled"
+	}
+	return ""
+}
+
+// GraphicsOffloadEnabledFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GraphicsOffloadEnabledFromString(s string) (GraphicsOffloadEnabled, bool) {
+	switch s {
+	case "GTK_GRAPHICS_OFFLOAD_ENABLED", "enabled":
+		return GraphicsOffloadEnabledValue, true
+	case "GTK_GRAPHICS_OFFLOAD_DISABLED", "disabled":
+		return GraphicsOffloadDisabledValue, true
+	}
+	return 0, false
+}
+
 // Bypasses gsk rendering by passing the content of its child directly to the compositor.
 //
 // Graphics offload is an optimization to reduce overhead and battery use that is
@@ -49,7 +82,7 @@ const (
 //
 // You should consider using graphics offload for your main widget if it shows
 // frequently changing content (such as a video, or a VM display) and you provide
-// the content in the form of dmabuf textures (see [class@Gdk.DmabufTextureBuilder]),
+// the content in the form of dmabuf textures (see [gdk.DmabufTextureBuilder]),
 // in particular if it may be fullscreen.
 //
 // Numerous factors can prohibit graphics offload:
@@ -58,7 +91,7 @@ const (
 //
 // - Clipping, such as rounded corners that cause the video content to not be rectangular
 //
-// - Unsupported dmabuf formats (see [method@Gdk.Display.get_dmabuf_formats])
+// - Unsupported dmabuf formats (see [gdk.Display.GetDmabufFormats])
 //
 // - Translucent video content (content with an alpha channel, even if it isn't used)
 //
@@ -110,7 +143,7 @@ var xGraphicsOffloadGetBlackBackground func(uintptr) bool
 
 // Returns whether the widget draws a black background.
 //
-// See [method@Gtk.GraphicsOffload.set_black_background].
+// See [GraphicsOffload.SetBlackBackground].
 func (x *GraphicsOffload) GetBlackBackground() bool {
 
 	cret := xGraphicsOffloadGetBlackBackground(x.GoPointer())
@@ -119,7 +152,7 @@ func (x *GraphicsOffload) GetBlackBackground() bool {
 
 var xGraphicsOffloadGetChild func(uintptr) uintptr
 
-// Gets the child of @self.
+// Gets the child of self.
 func (x *GraphicsOffload) GetChild() *Widget {
 	var cls *Widget
 
@@ -136,7 +169,7 @@ func (x *GraphicsOffload) GetChild() *Widget {
 
 var xGraphicsOffloadGetEnabled func(uintptr) GraphicsOffloadEnabled
 
-// Returns whether offload is enabled for @self.
+// Returns whether offload is enabled for self.
 func (x *GraphicsOffload) GetEnabled() GraphicsOffloadEnabled {
 
 	cret := xGraphicsOffloadGetEnabled(x.GoPointer())
@@ -157,8 +190,10 @@ var xGraphicsOffloadSetBlackBackground func(uintptr, bool)
 // extra copies in the compositor.
 //
 // On Wayland, this is implemented using the
-// [single-pixel buffer](https://wayland.app/protocols/single-pixel-buffer-v1)
+// [single-pixel buffer]
 // protocol.
+//
+// [single-pixel buffer]: https://wayland.app/protocols/single-pixel-buffer-v1
 func (x *GraphicsOffload) SetBlackBackground(ValueVar bool) {
 
 	xGraphicsOffloadSetBlackBackground(x.GoPointer(), ValueVar)
@@ -167,7 +202,7 @@ func (x *GraphicsOffload) SetBlackBackground(ValueVar bool) {
 
 var xGraphicsOffloadSetChild func(uintptr, uintptr)
 
-// Sets the child of @self.
+// Sets the child of self.
 func (x *GraphicsOffload) SetChild(ChildVar *Widget) {
 
 	xGraphicsOffloadSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -373,7 +408,7 @@ func (x *GraphicsOffload) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -381,14 +416,13 @@ func (x *GraphicsOffload) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *GraphicsOffload) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -412,19 +446,17 @@ func (x *GraphicsOffload) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *GraphicsOffload) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -445,7 +477,7 @@ func (x *GraphicsOffload) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -454,14 +486,12 @@ func (x *GraphicsOffload) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *GraphicsOffload) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -480,10 +510,10 @@ func (x *GraphicsOffload) UpdateStateValue(NStatesVar int, StatesVar []Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *GraphicsOffload) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -495,7 +525,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -506,13 +536,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xGraphicsOffloadGLibType, libs, "gtk_graphics_offload_get_type")
 
-	core.PuregoSafeRegister(&xNewGraphicsOffload, libs, "gtk_graphics_offload_new")
+	gobject.CheckClassSize("GtkGraphicsOffloadClass", xGraphicsOffloadGLibType, unsafe.Sizeof(GraphicsOffloadClass{}))
+
+	core.PuregoRegisterSince(&xNewGraphicsOffload, libs, "gtk_graphics_offload_new", "GTK", "4.14")
 
-	core.PuregoSafeRegister(&xGraphicsOffloadGetBlackBackground, libs, "gtk_graphics_offload_get_black_background")
-	core.PuregoSafeRegister(&xGraphicsOffloadGetChild, libs, "gtk_graphics_offload_get_child")
-	core.PuregoSafeRegister(&xGraphicsOffloadGetEnabled, libs, "gtk_graphics_offload_get_enabled")
-	core.PuregoSafeRegister(&xGraphicsOffloadSetBlackBackground, libs, "gtk_graphics_offload_set_black_background")
-	core.PuregoSafeRegister(&xGraphicsOffloadSetChild, libs, "gtk_graphics_offload_set_child")
-	core.PuregoSafeRegister(&xGraphicsOffloadSetEnabled, libs, "gtk_graphics_offload_set_enabled")
+	core.PuregoRegisterSince(&xGraphicsOffloadGetBlackBackground, libs, "gtk_graphics_offload_get_black_background", "GTK", "4.16")
+	core.PuregoRegisterSince(&xGraphicsOffloadGetChild, libs, "gtk_graphics_offload_get_child", "GTK", "4.14")
+	core.PuregoRegisterSince(&xGraphicsOffloadGetEnabled, libs, "gtk_graphics_offload_get_enabled", "GTK", "4.14")
+	core.PuregoRegisterSince(&xGraphicsOffloadSetBlackBackground, libs, "gtk_graphics_offload_set_black_background", "GTK", "4.16")
+	core.PuregoRegisterSince(&xGraphicsOffloadSetChild, libs, "gtk_graphics_offload_set_child", "GTK", "4.14")
+	core.PuregoRegisterSince(&xGraphicsOffloadSetEnabled, libs, "gtk_graphics_offload_set_enabled", "GTK", "4.14")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -25,78 +24,67 @@ func (x *GridClass) GoPointer() uintptr {
 
 // Arranges its child widgets in rows and columns.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="grid-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkGrid" src="grid.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It supports arbitrary positions and horizontal/vertical spans.
 //
-// Children are added using [method@Gtk.Grid.attach]. They can span multiple
+// Children are added using [Grid.Attach]. They can span multiple
 // rows or columns. It is also possible to add a child next to an existing
-// child, using [method@Gtk.Grid.attach_next_to]. To remove a child from the
-// grid, use [method@Gtk.Grid.remove].
+// child, using [Grid.AttachNextTo]. To remove a child from the
+// grid, use [Grid.Remove].
 //
 // The behaviour of `GtkGrid` when several children occupy the same grid
 // cell is undefined.
 //
 // # GtkGrid as GtkBuildable
 //
-// Every child in a `GtkGrid` has access to a custom [iface@Gtk.Buildable]
-// element, called `&lt;layout&gt;`. It can by used to specify a position in the
-// grid and optionally spans. All properties that can be used in the `&lt;layout&gt;`
-// element are implemented by [class@Gtk.GridLayoutChild].
+// Every child in a `GtkGrid` has access to a custom [Buildable]
+// element, called `<layout>`. It can by used to specify a position in the
+// grid and optionally spans. All properties that can be used in the `<layout>`
+// element are implemented by [GridLayoutChild].
 //
-// It is implemented by `GtkWidget` using [class@Gtk.LayoutManager].
+// It is implemented by `GtkWidget` using [LayoutManager].
 //
 // To showcase it, here is a simple example:
 //
-// ```xml
-// &lt;object class="GtkGrid" id="my_grid"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="GtkButton" id="button1"&gt;
-//	    &lt;property name="label"&gt;Button 1&lt;/property&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;0&lt;/property&gt;
-//	      &lt;property name="row"&gt;0&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkButton" id="button2"&gt;
-//	    &lt;property name="label"&gt;Button 2&lt;/property&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;1&lt;/property&gt;
-//	      &lt;property name="row"&gt;0&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkButton" id="button3"&gt;
-//	    &lt;property name="label"&gt;Button 3&lt;/property&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;2&lt;/property&gt;
-//	      &lt;property name="row"&gt;0&lt;/property&gt;
-//	      &lt;property name="row-span"&gt;2&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkButton" id="button4"&gt;
-//	    &lt;property name="label"&gt;Button 4&lt;/property&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;0&lt;/property&gt;
-//	      &lt;property name="row"&gt;1&lt;/property&gt;
-//	      &lt;property name="column-span"&gt;2&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkGrid" id="my_grid">
+//	  <child>
+//	    <object class="GtkButton" id="button1">
+//	      <property name="label">Button 1</property>
+//	      <layout>
+//	        <property name="column">0</property>
+//	        <property name="row">0</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="GtkButton" id="button2">
+//	      <property name="label">Button 2</property>
+//	      <layout>
+//	        <property name="column">1</property>
+//	        <property name="row">0</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="GtkButton" id="button3">
+//	      <property name="label">Button 3</property>
+//	      <layout>
+//	        <property name="column">2</property>
+//	        <property name="row">0</property>
+//	        <property name="row-span">2</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="GtkButton" id="button4">
+//	      <property name="label">Button 4</property>
+//	      <layout>
+//	        <property name="column">0</property>
+//	        <property name="row">1</property>
+//	        <property name="column-span">2</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // It organizes the first two buttons side-by-side in one cell each.
 // The third button is in the last column but spans across two rows.
@@ -110,9 +98,9 @@ func (x *GridClass) GoPointer() uintptr {
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkGrid` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkGrid` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkGrid` uses the [enum@Gtk.AccessibleRole.generic] role.
+// Starting from GTK 4.12, `GtkGrid` uses the [AccessibleRoleGenericValue] role.
 type Grid struct {
 	Widget
 }
@@ -150,9 +138,9 @@ var xGridAttach func(uintptr, uintptr, int, int, int, int)
 
 // Adds a widget to the grid.
 //
-// The position of @child is determined by @column and @row.
-// The number of “cells” that @child will occupy is determined
-// by @width and @height.
+// The position of child is determined by column and row.
+// The number of “cells” that child will occupy is determined
+// by width and height.
 func (x *Grid) Attach(ChildVar *Widget, ColumnVar int, RowVar int, WidthVar int, HeightVar int) {
 
 	xGridAttach(x.GoPointer(), ChildVar.GoPointer(), ColumnVar, RowVar, WidthVar, HeightVar)
@@ -163,13 +151,13 @@ var xGridAttachNextTo func(uintptr, uintptr, uintptr, PositionType, int, int)
 
 // Adds a widget to the grid.
 //
-// The widget is placed next to @sibling, on the side determined by
-// @side. When @sibling is %NULL, the widget is placed in row (for
+// The widget is placed next to sibling, on the side determined by
+// side. When sibling is nil, the widget is placed in row (for
 // left or right placement) or column 0 (for top or bottom placement),
-// at the end indicated by @side.
+// at the end indicated by side.
 //
-// Attaching widgets labeled `[1]`, `[2]`, `[3]` with `@sibling == %NULL` and
-// `@side == %GTK_POS_LEFT` yields a layout of `[3][2][1]`.
+// Attaching widgets labeled `[1]`, `[2]`, `[3]` with `sibling == nil` and
+// `side == [PosLeftValue]` yields a layout of `[3][2][1]`.
 func (x *Grid) AttachNextTo(ChildVar *Widget, SiblingVar *Widget, SideVar PositionType, WidthVar int, HeightVar int) {
 
 	xGridAttachNextTo(x.GoPointer(), ChildVar.GoPointer(), SiblingVar.GoPointer(), SideVar, WidthVar, HeightVar)
@@ -178,7 +166,7 @@ func (x *Grid) AttachNextTo(ChildVar *Widget, SiblingVar *Widget, SideVar Positi
 
 var xGridGetBaselineRow func(uintptr) int
 
-// Returns which row defines the global baseline of @grid.
+// Returns which row defines the global baseline of grid.
 func (x *Grid) GetBaselineRow() int {
 
 	cret := xGridGetBaselineRow(x.GoPointer())
@@ -187,8 +175,8 @@ func (x *Grid) GetBaselineRow() int {
 
 var xGridGetChildAt func(uintptr, int, int) uintptr
 
-// Gets the child of @grid whose area covers the grid
-// cell at @column, @row.
+// Gets the child of grid whose area covers the grid
+// cell at column, row.
 func (x *Grid) GetChildAt(ColumnVar int, RowVar int) *Widget {
 	var cls *Widget
 
@@ -205,7 +193,7 @@ func (x *Grid) GetChildAt(ColumnVar int, RowVar int) *Widget {
 
 var xGridGetColumnHomogeneous func(uintptr) bool
 
-// Returns whether all columns of @grid have the same width.
+// Returns whether all columns of grid have the same width.
 func (x *Grid) GetColumnHomogeneous() bool {
 
 	cret := xGridGetColumnHomogeneous(x.GoPointer())
@@ -214,7 +202,7 @@ func (x *Grid) GetColumnHomogeneous() bool {
 
 var xGridGetColumnSpacing func(uintptr) uint
 
-// Returns the amount of space between the columns of @grid.
+// Returns the amount of space between the columns of grid.
 func (x *Grid) GetColumnSpacing() uint {
 
 	cret := xGridGetColumnSpacing(x.GoPointer())
@@ -223,9 +211,9 @@ func (x *Grid) GetColumnSpacing() uint {
 
 var xGridGetRowBaselinePosition func(uintptr, int) BaselinePosition
 
-// Returns the baseline position of @row.
+// Returns the baseline position of row.
 //
-// See [method@Gtk.Grid.set_row_baseline_position].
+// See [Grid.SetRowBaselinePosition].
 func (x *Grid) GetRowBaselinePosition(RowVar int) BaselinePosition {
 
 	cret := xGridGetRowBaselinePosition(x.GoPointer(), RowVar)
@@ -234,7 +222,7 @@ func (x *Grid) GetRowBaselinePosition(RowVar int) BaselinePosition {
 
 var xGridGetRowHomogeneous func(uintptr) bool
 
-// Returns whether all rows of @grid have the same height.
+// Returns whether all rows of grid have the same height.
 func (x *Grid) GetRowHomogeneous() bool {
 
 	cret := xGridGetRowHomogeneous(x.GoPointer())
@@ -243,7 +231,7 @@ func (x *Grid) GetRowHomogeneous() bool {
 
 var xGridGetRowSpacing func(uintptr) uint
 
-// Returns the amount of space between the rows of @grid.
+// Returns the amount of space between the rows of grid.
 func (x *Grid) GetRowSpacing() uint {
 
 	cret := xGridGetRowSpacing(x.GoPointer())
@@ -267,9 +255,9 @@ var xGridInsertNextTo func(uintptr, uintptr, PositionType)
 
 // Inserts a row or column at the specified position.
 //
-// The new row or column is placed next to @sibling, on the side
-// determined by @side. If @side is %GTK_POS_TOP or %GTK_POS_BOTTOM,
-// a row is inserted. If @side is %GTK_POS_LEFT of %GTK_POS_RIGHT,
+// The new row or column is placed next to sibling, on the side
+// determined by side. If side is [PosTopValue] or [PosBottomValue],
+// a row is inserted. If side is [PosLeftValue] of [PosRightValue],
 // a column is inserted.
 func (x *Grid) InsertNextTo(SiblingVar *Widget, SideVar PositionType) {
 
@@ -292,7 +280,7 @@ func (x *Grid) InsertRow(PositionVar int) {
 
 var xGridQueryChild func(uintptr, uintptr, *int, *int, *int, *int)
 
-// Queries the attach points and spans of @child inside the given `GtkGrid`.
+// Queries the attach points and spans of child inside the given `GtkGrid`.
 func (x *Grid) QueryChild(ChildVar *Widget, ColumnVar *int, RowVar *int, WidthVar *int, HeightVar *int) {
 
 	xGridQueryChild(x.GoPointer(), ChildVar.GoPointer(), ColumnVar, RowVar, WidthVar, HeightVar)
@@ -301,10 +289,10 @@ func (x *Grid) QueryChild(ChildVar *Widget, ColumnVar *int, RowVar *int, WidthVa
 
 var xGridRemove func(uintptr, uintptr)
 
-// Removes a child from @grid.
+// Removes a child from grid.
 //
 // The child must have been added with
-// [method@Gtk.Grid.attach] or [method@Gtk.Grid.attach_next_to].
+// [Grid.Attach] or [Grid.AttachNextTo].
 func (x *Grid) Remove(ChildVar *Widget) {
 
 	xGridRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -345,7 +333,7 @@ var xGridSetBaselineRow func(uintptr, int)
 //
 // Each row in the grid can have its own local baseline, but only
 // one of those is global, meaning it will be the baseline in the
-// parent of the @grid.
+// parent of the grid.
 func (x *Grid) SetBaselineRow(RowVar int) {
 
 	xGridSetBaselineRow(x.GoPointer(), RowVar)
@@ -354,7 +342,7 @@ func (x *Grid) SetBaselineRow(RowVar int) {
 
 var xGridSetColumnHomogeneous func(uintptr, bool)
 
-// Sets whether all columns of @grid will have the same width.
+// Sets whether all columns of grid will have the same width.
 func (x *Grid) SetColumnHomogeneous(HomogeneousVar bool) {
 
 	xGridSetColumnHomogeneous(x.GoPointer(), HomogeneousVar)
@@ -363,7 +351,7 @@ func (x *Grid) SetColumnHomogeneous(HomogeneousVar bool) {
 
 var xGridSetColumnSpacing func(uintptr, uint)
 
-// Sets the amount of space between columns of @grid.
+// Sets the amount of space between columns of grid.
 func (x *Grid) SetColumnSpacing(SpacingVar uint) {
 
 	xGridSetColumnSpacing(x.GoPointer(), SpacingVar)
@@ -372,10 +360,10 @@ func (x *Grid) SetColumnSpacing(SpacingVar uint) {
 
 var xGridSetRowBaselinePosition func(uintptr, int, BaselinePosition)
 
-// Sets how the baseline should be positioned on @row of the
+// Sets how the baseline should be positioned on row of the
 // grid, in case that row is assigned more space than is requested.
 //
-// The default baseline position is %GTK_BASELINE_POSITION_CENTER.
+// The default baseline position is [BaselinePositionCenterValue].
 func (x *Grid) SetRowBaselinePosition(RowVar int, PosVar BaselinePosition) {
 
 	xGridSetRowBaselinePosition(x.GoPointer(), RowVar, PosVar)
@@ -384,7 +372,7 @@ func (x *Grid) SetRowBaselinePosition(RowVar int, PosVar BaselinePosition) {
 
 var xGridSetRowHomogeneous func(uintptr, bool)
 
-// Sets whether all rows of @grid will have the same height.
+// Sets whether all rows of grid will have the same height.
 func (x *Grid) SetRowHomogeneous(HomogeneousVar bool) {
 
 	xGridSetRowHomogeneous(x.GoPointer(), HomogeneousVar)
@@ -393,7 +381,7 @@ func (x *Grid) SetRowHomogeneous(HomogeneousVar bool) {
 
 var xGridSetRowSpacing func(uintptr, uint)
 
-// Sets the amount of space between rows of @grid.
+// Sets the amount of space between rows of grid.
 func (x *Grid) SetRowSpacing(SpacingVar uint) {
 
 	xGridSetRowSpacing(x.GoPointer(), SpacingVar)
@@ -429,7 +417,7 @@ func (x *Grid) GetPropertyBaselineRow() int {
 }
 
 // SetPropertyColumnHomogeneous sets the "column-homogeneous" property.
-// If %TRUE, the columns are all the same width.
+// If true, the columns are all the same width.
 func (x *Grid) SetPropertyColumnHomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -438,7 +426,7 @@ func (x *Grid) SetPropertyColumnHomogeneous(value bool) {
 }
 
 // GetPropertyColumnHomogeneous gets the "column-homogeneous" property.
-// If %TRUE, the columns are all the same width.
+// If true, the columns are all the same width.
 func (x *Grid) GetPropertyColumnHomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("column-homogeneous", &v)
@@ -463,7 +451,7 @@ func (x *Grid) GetPropertyColumnSpacing() int {
 }
 
 // SetPropertyRowHomogeneous sets the "row-homogeneous" property.
-// If %TRUE, the rows are all the same height.
+// If true, the rows are all the same height.
 func (x *Grid) SetPropertyRowHomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -472,7 +460,7 @@ func (x *Grid) SetPropertyRowHomogeneous(value bool) {
 }
 
 // GetPropertyRowHomogeneous gets the "row-homogeneous" property.
-// If %TRUE, the rows are all the same height.
+// If true, the rows are all the same height.
 func (x *Grid) GetPropertyRowHomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("row-homogeneous", &v)
@@ -657,7 +645,7 @@ func (x *Grid) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -665,14 +653,13 @@ func (x *Grid) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Grid) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -696,19 +683,17 @@ func (x *Grid) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessibl
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Grid) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -729,7 +714,7 @@ func (x *Grid) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleR
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -738,14 +723,12 @@ func (x *Grid) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleR
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Grid) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -764,24 +747,24 @@ func (x *Grid) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Val
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Grid) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Grid) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Grid) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -793,7 +776,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -802,29 +785,31 @@ func init() {
 
 	core.PuregoSafeRegister(&xGridGLibType, libs, "gtk_grid_get_type")
 
-	core.PuregoSafeRegister(&xNewGrid, libs, "gtk_grid_new")
-
-	core.PuregoSafeRegister(&xGridAttach, libs, "gtk_grid_attach")
-	core.PuregoSafeRegister(&xGridAttachNextTo, libs, "gtk_grid_attach_next_to")
-	core.PuregoSafeRegister(&xGridGetBaselineRow, libs, "gtk_grid_get_baseline_row")
-	core.PuregoSafeRegister(&xGridGetChildAt, libs, "gtk_grid_get_child_at")
-	core.PuregoSafeRegister(&xGridGetColumnHomogeneous, libs, "gtk_grid_get_column_homogeneous")
-	core.PuregoSafeRegister(&xGridGetColumnSpacing, libs, "gtk_grid_get_column_spacing")
-	core.PuregoSafeRegister(&xGridGetRowBaselinePosition, libs, "gtk_grid_get_row_baseline_position")
-	core.PuregoSafeRegister(&xGridGetRowHomogeneous, libs, "gtk_grid_get_row_homogeneous")
-	core.PuregoSafeRegister(&xGridGetRowSpacing, libs, "gtk_grid_get_row_spacing")
-	core.PuregoSafeRegister(&xGridInsertColumn, libs, "gtk_grid_insert_column")
-	core.PuregoSafeRegister(&xGridInsertNextTo, libs, "gtk_grid_insert_next_to")
-	core.PuregoSafeRegister(&xGridInsertRow, libs, "gtk_grid_insert_row")
-	core.PuregoSafeRegister(&xGridQueryChild, libs, "gtk_grid_query_child")
-	core.PuregoSafeRegister(&xGridRemove, libs, "gtk_grid_remove")
-	core.PuregoSafeRegister(&xGridRemoveColumn, libs, "gtk_grid_remove_column")
-	core.PuregoSafeRegister(&xGridRemoveRow, libs, "gtk_grid_remove_row")
-	core.PuregoSafeRegister(&xGridSetBaselineRow, libs, "gtk_grid_set_baseline_row")
-	core.PuregoSafeRegister(&xGridSetColumnHomogeneous, libs, "gtk_grid_set_column_homogeneous")
-	core.PuregoSafeRegister(&xGridSetColumnSpacing, libs, "gtk_grid_set_column_spacing")
-	core.PuregoSafeRegister(&xGridSetRowBaselinePosition, libs, "gtk_grid_set_row_baseline_position")
-	core.PuregoSafeRegister(&xGridSetRowHomogeneous, libs, "gtk_grid_set_row_homogeneous")
-	core.PuregoSafeRegister(&xGridSetRowSpacing, libs, "gtk_grid_set_row_spacing")
+	gobject.CheckClassSize("GtkGridClass", xGridGLibType, unsafe.Sizeof(GridClass{}))
+
+	core.PuregoRegisterSince(&xNewGrid, libs, "gtk_grid_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xGridAttach, libs, "gtk_grid_attach", "GTK", "")
+	core.PuregoRegisterSince(&xGridAttachNextTo, libs, "gtk_grid_attach_next_to", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetBaselineRow, libs, "gtk_grid_get_baseline_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetChildAt, libs, "gtk_grid_get_child_at", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetColumnHomogeneous, libs, "gtk_grid_get_column_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetColumnSpacing, libs, "gtk_grid_get_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetRowBaselinePosition, libs, "gtk_grid_get_row_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetRowHomogeneous, libs, "gtk_grid_get_row_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridGetRowSpacing, libs, "gtk_grid_get_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridInsertColumn, libs, "gtk_grid_insert_column", "GTK", "")
+	core.PuregoRegisterSince(&xGridInsertNextTo, libs, "gtk_grid_insert_next_to", "GTK", "")
+	core.PuregoRegisterSince(&xGridInsertRow, libs, "gtk_grid_insert_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridQueryChild, libs, "gtk_grid_query_child", "GTK", "")
+	core.PuregoRegisterSince(&xGridRemove, libs, "gtk_grid_remove", "GTK", "")
+	core.PuregoRegisterSince(&xGridRemoveColumn, libs, "gtk_grid_remove_column", "GTK", "")
+	core.PuregoRegisterSince(&xGridRemoveRow, libs, "gtk_grid_remove_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetBaselineRow, libs, "gtk_grid_set_baseline_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetColumnHomogeneous, libs, "gtk_grid_set_column_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetColumnSpacing, libs, "gtk_grid_set_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetRowBaselinePosition, libs, "gtk_grid_set_row_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetRowHomogeneous, libs, "gtk_grid_set_row_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridSetRowSpacing, libs, "gtk_grid_set_row_spacing", "GTK", "")
 
 }
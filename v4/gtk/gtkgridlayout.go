@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -36,7 +35,7 @@ func (x *GridLayoutClass) GoPointer() uintptr {
 // Children have an "attach point" defined by the horizontal and vertical
 // index of the cell they occupy; children can span multiple rows or columns.
 // The layout properties for setting the attach points and spans are set
-// using the [class@Gtk.GridLayoutChild] associated to each child widget.
+// using the [GridLayoutChild] associated to each child widget.
 //
 // The behaviour of `GtkGridLayout` when several children occupy the same
 // grid cell is undefined.
@@ -78,7 +77,7 @@ func NewGridLayout() *GridLayout {
 
 var xGridLayoutGetBaselineRow func(uintptr) int
 
-// Retrieves the row set with gtk_grid_layout_set_baseline_row().
+// Retrieves the row set with [GridLayout.SetBaselineRow].
 func (x *GridLayout) GetBaselineRow() int {
 
 	cret := xGridLayoutGetBaselineRow(x.GoPointer())
@@ -87,7 +86,7 @@ func (x *GridLayout) GetBaselineRow() int {
 
 var xGridLayoutGetColumnHomogeneous func(uintptr) bool
 
-// Checks whether all columns of @grid should have the same width.
+// Checks whether all columns of grid should have the same width.
 func (x *GridLayout) GetColumnHomogeneous() bool {
 
 	cret := xGridLayoutGetColumnHomogeneous(x.GoPointer())
@@ -96,7 +95,7 @@ func (x *GridLayout) GetColumnHomogeneous() bool {
 
 var xGridLayoutGetColumnSpacing func(uintptr) uint
 
-// Retrieves the spacing set with gtk_grid_layout_set_column_spacing().
+// Retrieves the spacing set with [GridLayout.SetColumnSpacing].
 func (x *GridLayout) GetColumnSpacing() uint {
 
 	cret := xGridLayoutGetColumnSpacing(x.GoPointer())
@@ -105,11 +104,11 @@ func (x *GridLayout) GetColumnSpacing() uint {
 
 var xGridLayoutGetRowBaselinePosition func(uintptr, int) BaselinePosition
 
-// Returns the baseline position of @row.
+// Returns the baseline position of row.
 //
 // If no value has been set with
-// [method@Gtk.GridLayout.set_row_baseline_position],
-// the default value of %GTK_BASELINE_POSITION_CENTER
+// [GridLayout.SetRowBaselinePosition],
+// the default value of [BaselinePositionCenterValue]
 // is returned.
 func (x *GridLayout) GetRowBaselinePosition(RowVar int) BaselinePosition {
 
@@ -119,7 +118,7 @@ func (x *GridLayout) GetRowBaselinePosition(RowVar int) BaselinePosition {
 
 var xGridLayoutGetRowHomogeneous func(uintptr) bool
 
-// Checks whether all rows of @grid should have the same height.
+// Checks whether all rows of grid should have the same height.
 func (x *GridLayout) GetRowHomogeneous() bool {
 
 	cret := xGridLayoutGetRowHomogeneous(x.GoPointer())
@@ -128,7 +127,7 @@ func (x *GridLayout) GetRowHomogeneous() bool {
 
 var xGridLayoutGetRowSpacing func(uintptr) uint
 
-// Retrieves the spacing set with gtk_grid_layout_set_row_spacing().
+// Retrieves the spacing set with [GridLayout.SetRowSpacing].
 func (x *GridLayout) GetRowSpacing() uint {
 
 	cret := xGridLayoutGetRowSpacing(x.GoPointer())
@@ -141,7 +140,7 @@ var xGridLayoutSetBaselineRow func(uintptr, int)
 //
 // Each row in the grid can have its own local baseline, but only
 // one of those is global, meaning it will be the baseline in the
-// parent of the @grid.
+// parent of the grid.
 func (x *GridLayout) SetBaselineRow(RowVar int) {
 
 	xGridLayoutSetBaselineRow(x.GoPointer(), RowVar)
@@ -150,7 +149,7 @@ func (x *GridLayout) SetBaselineRow(RowVar int) {
 
 var xGridLayoutSetColumnHomogeneous func(uintptr, bool)
 
-// Sets whether all columns of @grid should have the same width.
+// Sets whether all columns of grid should have the same width.
 func (x *GridLayout) SetColumnHomogeneous(HomogeneousVar bool) {
 
 	xGridLayoutSetColumnHomogeneous(x.GoPointer(), HomogeneousVar)
@@ -168,7 +167,7 @@ func (x *GridLayout) SetColumnSpacing(SpacingVar uint) {
 
 var xGridLayoutSetRowBaselinePosition func(uintptr, int, BaselinePosition)
 
-// Sets how the baseline should be positioned on @row of the
+// Sets how the baseline should be positioned on row of the
 // grid, in case that row is assigned more space than is requested.
 func (x *GridLayout) SetRowBaselinePosition(RowVar int, PosVar BaselinePosition) {
 
@@ -178,7 +177,7 @@ func (x *GridLayout) SetRowBaselinePosition(RowVar int, PosVar BaselinePosition)
 
 var xGridLayoutSetRowHomogeneous func(uintptr, bool)
 
-// Sets whether all rows of @grid should have the same height.
+// Sets whether all rows of grid should have the same height.
 func (x *GridLayout) SetRowHomogeneous(HomogeneousVar bool) {
 
 	xGridLayoutSetRowHomogeneous(x.GoPointer(), HomogeneousVar)
@@ -207,7 +206,7 @@ func (c *GridLayout) SetGoPointer(ptr uintptr) {
 
 // SetPropertyBaselineRow sets the "baseline-row" property.
 // The row to align to the baseline, when `GtkWidget:valign` is set
-// to %GTK_ALIGN_BASELINE.
+// to [AlignBaselineValue].
 func (x *GridLayout) SetPropertyBaselineRow(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -217,7 +216,7 @@ func (x *GridLayout) SetPropertyBaselineRow(value int) {
 
 // GetPropertyBaselineRow gets the "baseline-row" property.
 // The row to align to the baseline, when `GtkWidget:valign` is set
-// to %GTK_ALIGN_BASELINE.
+// to [AlignBaselineValue].
 func (x *GridLayout) GetPropertyBaselineRow() int {
 	var v gobject.Value
 	x.GetProperty("baseline-row", &v)
@@ -311,7 +310,7 @@ func GridLayoutChildNewFromInternalPtr(ptr uintptr) *GridLayoutChild {
 
 var xGridLayoutChildGetColumn func(uintptr) int
 
-// Retrieves the column number to which @child attaches its left side.
+// Retrieves the column number to which child attaches its left side.
 func (x *GridLayoutChild) GetColumn() int {
 
 	cret := xGridLayoutChildGetColumn(x.GoPointer())
@@ -320,7 +319,7 @@ func (x *GridLayoutChild) GetColumn() int {
 
 var xGridLayoutChildGetColumnSpan func(uintptr) int
 
-// Retrieves the number of columns that @child spans to.
+// Retrieves the number of columns that child spans to.
 func (x *GridLayoutChild) GetColumnSpan() int {
 
 	cret := xGridLayoutChildGetColumnSpan(x.GoPointer())
@@ -329,7 +328,7 @@ func (x *GridLayoutChild) GetColumnSpan() int {
 
 var xGridLayoutChildGetRow func(uintptr) int
 
-// Retrieves the row number to which @child attaches its top side.
+// Retrieves the row number to which child attaches its top side.
 func (x *GridLayoutChild) GetRow() int {
 
 	cret := xGridLayoutChildGetRow(x.GoPointer())
@@ -338,7 +337,7 @@ func (x *GridLayoutChild) GetRow() int {
 
 var xGridLayoutChildGetRowSpan func(uintptr) int
 
-// Retrieves the number of rows that @child spans to.
+// Retrieves the number of rows that child spans to.
 func (x *GridLayoutChild) GetRowSpan() int {
 
 	cret := xGridLayoutChildGetRowSpan(x.GoPointer())
@@ -347,7 +346,7 @@ func (x *GridLayoutChild) GetRowSpan() int {
 
 var xGridLayoutChildSetColumn func(uintptr, int)
 
-// Sets the column number to attach the left side of @child.
+// Sets the column number to attach the left side of child.
 func (x *GridLayoutChild) SetColumn(ColumnVar int) {
 
 	xGridLayoutChildSetColumn(x.GoPointer(), ColumnVar)
@@ -356,7 +355,7 @@ func (x *GridLayoutChild) SetColumn(ColumnVar int) {
 
 var xGridLayoutChildSetColumnSpan func(uintptr, int)
 
-// Sets the number of columns @child spans to.
+// Sets the number of columns child spans to.
 func (x *GridLayoutChild) SetColumnSpan(SpanVar int) {
 
 	xGridLayoutChildSetColumnSpan(x.GoPointer(), SpanVar)
@@ -365,7 +364,7 @@ func (x *GridLayoutChild) SetColumnSpan(SpanVar int) {
 
 var xGridLayoutChildSetRow func(uintptr, int)
 
-// Sets the row to place @child in.
+// Sets the row to place child in.
 func (x *GridLayoutChild) SetRow(RowVar int) {
 
 	xGridLayoutChildSetRow(x.GoPointer(), RowVar)
@@ -374,7 +373,7 @@ func (x *GridLayoutChild) SetRow(RowVar int) {
 
 var xGridLayoutChildSetRowSpan func(uintptr, int)
 
-// Sets the number of rows @child spans to.
+// Sets the number of rows child spans to.
 func (x *GridLayoutChild) SetRowSpan(SpanVar int) {
 
 	xGridLayoutChildSetRowSpan(x.GoPointer(), SpanVar)
@@ -465,7 +464,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -474,30 +473,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xGridLayoutGLibType, libs, "gtk_grid_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewGridLayout, libs, "gtk_grid_layout_new")
-
-	core.PuregoSafeRegister(&xGridLayoutGetBaselineRow, libs, "gtk_grid_layout_get_baseline_row")
-	core.PuregoSafeRegister(&xGridLayoutGetColumnHomogeneous, libs, "gtk_grid_layout_get_column_homogeneous")
-	core.PuregoSafeRegister(&xGridLayoutGetColumnSpacing, libs, "gtk_grid_layout_get_column_spacing")
-	core.PuregoSafeRegister(&xGridLayoutGetRowBaselinePosition, libs, "gtk_grid_layout_get_row_baseline_position")
-	core.PuregoSafeRegister(&xGridLayoutGetRowHomogeneous, libs, "gtk_grid_layout_get_row_homogeneous")
-	core.PuregoSafeRegister(&xGridLayoutGetRowSpacing, libs, "gtk_grid_layout_get_row_spacing")
-	core.PuregoSafeRegister(&xGridLayoutSetBaselineRow, libs, "gtk_grid_layout_set_baseline_row")
-	core.PuregoSafeRegister(&xGridLayoutSetColumnHomogeneous, libs, "gtk_grid_layout_set_column_homogeneous")
-	core.PuregoSafeRegister(&xGridLayoutSetColumnSpacing, libs, "gtk_grid_layout_set_column_spacing")
-	core.PuregoSafeRegister(&xGridLayoutSetRowBaselinePosition, libs, "gtk_grid_layout_set_row_baseline_position")
-	core.PuregoSafeRegister(&xGridLayoutSetRowHomogeneous, libs, "gtk_grid_layout_set_row_homogeneous")
-	core.PuregoSafeRegister(&xGridLayoutSetRowSpacing, libs, "gtk_grid_layout_set_row_spacing")
+	gobject.CheckClassSize("GtkGridLayoutClass", xGridLayoutGLibType, unsafe.Sizeof(GridLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewGridLayout, libs, "gtk_grid_layout_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xGridLayoutGetBaselineRow, libs, "gtk_grid_layout_get_baseline_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutGetColumnHomogeneous, libs, "gtk_grid_layout_get_column_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutGetColumnSpacing, libs, "gtk_grid_layout_get_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutGetRowBaselinePosition, libs, "gtk_grid_layout_get_row_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutGetRowHomogeneous, libs, "gtk_grid_layout_get_row_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutGetRowSpacing, libs, "gtk_grid_layout_get_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetBaselineRow, libs, "gtk_grid_layout_set_baseline_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetColumnHomogeneous, libs, "gtk_grid_layout_set_column_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetColumnSpacing, libs, "gtk_grid_layout_set_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetRowBaselinePosition, libs, "gtk_grid_layout_set_row_baseline_position", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetRowHomogeneous, libs, "gtk_grid_layout_set_row_homogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutSetRowSpacing, libs, "gtk_grid_layout_set_row_spacing", "GTK", "")
 
 	core.PuregoSafeRegister(&xGridLayoutChildGLibType, libs, "gtk_grid_layout_child_get_type")
 
-	core.PuregoSafeRegister(&xGridLayoutChildGetColumn, libs, "gtk_grid_layout_child_get_column")
-	core.PuregoSafeRegister(&xGridLayoutChildGetColumnSpan, libs, "gtk_grid_layout_child_get_column_span")
-	core.PuregoSafeRegister(&xGridLayoutChildGetRow, libs, "gtk_grid_layout_child_get_row")
-	core.PuregoSafeRegister(&xGridLayoutChildGetRowSpan, libs, "gtk_grid_layout_child_get_row_span")
-	core.PuregoSafeRegister(&xGridLayoutChildSetColumn, libs, "gtk_grid_layout_child_set_column")
-	core.PuregoSafeRegister(&xGridLayoutChildSetColumnSpan, libs, "gtk_grid_layout_child_set_column_span")
-	core.PuregoSafeRegister(&xGridLayoutChildSetRow, libs, "gtk_grid_layout_child_set_row")
-	core.PuregoSafeRegister(&xGridLayoutChildSetRowSpan, libs, "gtk_grid_layout_child_set_row_span")
+	gobject.CheckClassSize("GtkGridLayoutChildClass", xGridLayoutChildGLibType, unsafe.Sizeof(GridLayoutChildClass{}))
+
+	core.PuregoRegisterSince(&xGridLayoutChildGetColumn, libs, "gtk_grid_layout_child_get_column", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildGetColumnSpan, libs, "gtk_grid_layout_child_get_column_span", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildGetRow, libs, "gtk_grid_layout_child_get_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildGetRowSpan, libs, "gtk_grid_layout_child_get_row_span", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildSetColumn, libs, "gtk_grid_layout_child_set_column", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildSetColumnSpan, libs, "gtk_grid_layout_child_set_column_span", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildSetRow, libs, "gtk_grid_layout_child_set_row", "GTK", "")
+	core.PuregoRegisterSince(&xGridLayoutChildSetRowSpan, libs, "gtk_grid_layout_child_set_row_span", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -29,39 +28,37 @@ func (x *GridViewClass) GoPointer() uintptr {
 // `GtkGridView` allows the user to select items according to the selection
 // characteristics of the model. For models that allow multiple selected items,
 // it is possible to turn on _rubberband selection_, using
-// [property@Gtk.GridView:enable-rubberband].
+// [GridView.GetPropertyEnableRubberband].
 //
 // To learn more about the list widget framework, see the
-// [overview](section-list-widget.html).
+// overview.
 //
 // # Actions
 //
 // `GtkGridView` defines a set of built-in actions:
 //
 //   - `list.activate-item` activates the item at given position by emitting the
-//     the [signal@Gtk.GridView::activate] signal.
+//     the [GridView.ConnectActivateFunc] signal.
 //
 // # CSS nodes
 //
-// ```
-// gridview
-// ├── child[.activatable]
-// │
-// ├── child[.activatable]
-// │
-// ┊
-// ╰── [rubberband]
-// ```
+//	gridview
+//	├── child[.activatable]
+//	│
+//	├── child[.activatable]
+//	│
+//	┊
+//	╰── [rubberband]
 //
 // `GtkGridView` uses a single CSS node with name `gridview`. Each child uses
-// a single CSS node with name `child`. If the [property@Gtk.ListItem:activatable]
+// a single CSS node with name `child`. If the [ListItem.GetPropertyActivatable]
 // property is set, the corresponding row will have the `.activatable` style
 // class. For rubberband selection, a subnode with name `rubberband` is used.
 //
 // # Accessibility
 //
-// `GtkGridView` uses the [enum@Gtk.AccessibleRole.grid] role, and the items
-// use the [enum@Gtk.AccessibleRole.grid_cell] role.
+// `GtkGridView` uses the [AccessibleRoleGridValue] role, and the items
+// use the [AccessibleRoleGridCellValue] role.
 type GridView struct {
 	ListBase
 }
@@ -80,17 +77,14 @@ func GridViewNewFromInternalPtr(ptr uintptr) *GridView {
 
 var xNewGridView func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkGridView` that uses the given @factory for
+// Creates a new `GtkGridView` that uses the given factory for
 // mapping items to widgets.
 //
 // The function takes ownership of the
 // arguments, so you can write code like
-// ```c
-// grid_view = gtk_grid_view_new (create_model (),
 //
-//	gtk_builder_list_item_factory_new_from_resource ("/resource.ui"));
-//
-// ```
+//	grid_view = gtk_grid_view_new (create_model (),
+//	  gtk_builder_list_item_factory_new_from_resource ("/resource.ui"));
 func NewGridView(ModelVar SelectionModel, FactoryVar *ListItemFactory) *GridView {
 	var cls *GridView
 
@@ -178,23 +172,23 @@ func (x *GridView) GetSingleClickActivate() bool {
 
 var xGridViewGetTabBehavior func(uintptr) ListTabBehavior
 
-// Gets the behavior set for the &lt;kbd&gt;Tab&lt;/kbd&gt; key.
+// Gets the behavior set for the Tab key.
 func (x *GridView) GetTabBehavior() ListTabBehavior {
 
 	cret := xGridViewGetTabBehavior(x.GoPointer())
 	return cret
 }
 
-var xGridViewScrollTo func(uintptr, uint, ListScrollFlags, *ScrollInfo)
+var xGridViewScrollTo func(uintptr, uint, ListScrollFlags, uintptr)
 
 // Scrolls to the item at the given position and performs the actions
-// specified in @flags.
+// specified in flags.
 //
 // This function works no matter if the gridview is shown or focused.
 // If it isn't, then the changes will take effect once that happens.
 func (x *GridView) ScrollTo(PosVar uint, FlagsVar ListScrollFlags, ScrollVar *ScrollInfo) {
 
-	xGridViewScrollTo(x.GoPointer(), PosVar, FlagsVar, ScrollVar)
+	xGridViewScrollTo(x.GoPointer(), PosVar, FlagsVar, gobject.BoxedCopyNullable(ScrollInfoGLibType(), ScrollVar.GoPointer()))
 
 }
 
@@ -222,8 +216,8 @@ var xGridViewSetMaxColumns func(uintptr, uint)
 //
 // This number must be at least 1.
 //
-// If @max_columns is smaller than the minimum set via
-// [method@Gtk.GridView.set_min_columns], that value is used instead.
+// If max_columns is smaller than the minimum set via
+// [GridView.SetMinColumns], that value is used instead.
 func (x *GridView) SetMaxColumns(MaxColumnsVar uint) {
 
 	xGridViewSetMaxColumns(x.GoPointer(), MaxColumnsVar)
@@ -236,8 +230,8 @@ var xGridViewSetMinColumns func(uintptr, uint)
 //
 // This number must be at least 1.
 //
-// If @min_columns is smaller than the minimum set via
-// [method@Gtk.GridView.set_max_columns], that value is ignored.
+// If min_columns is smaller than the minimum set via
+// [GridView.SetMaxColumns], that value is ignored.
 func (x *GridView) SetMinColumns(MinColumnsVar uint) {
 
 	xGridViewSetMinColumns(x.GoPointer(), MinColumnsVar)
@@ -248,7 +242,7 @@ var xGridViewSetModel func(uintptr, uintptr)
 
 // Sets the model to use.
 //
-// This must be a [iface@Gtk.SelectionModel].
+// This must be a [SelectionModel].
 func (x *GridView) SetModel(ModelVar SelectionModel) {
 
 	xGridViewSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -267,7 +261,7 @@ func (x *GridView) SetSingleClickActivate(SingleClickActivateVar bool) {
 
 var xGridViewSetTabBehavior func(uintptr, ListTabBehavior)
 
-// Sets the behavior of the &lt;kbd&gt;Tab&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; keys.
+// Sets the behavior of the Tab and Shift+Tab keys.
 func (x *GridView) SetTabBehavior(TabBehaviorVar ListTabBehavior) {
 
 	xGridViewSetTabBehavior(x.GoPointer(), TabBehaviorVar)
@@ -305,7 +299,7 @@ func (x *GridView) GetPropertyEnableRubberband() bool {
 // SetPropertyMaxColumns sets the "max-columns" property.
 // Maximum number of columns per row.
 //
-// If this number is smaller than [property@Gtk.GridView:min-columns],
+// If this number is smaller than [GridView.GetPropertyMinColumns],
 // that value is used instead.
 func (x *GridView) SetPropertyMaxColumns(value uint) {
 	var v gobject.Value
@@ -317,7 +311,7 @@ func (x *GridView) SetPropertyMaxColumns(value uint) {
 // GetPropertyMaxColumns gets the "max-columns" property.
 // Maximum number of columns per row.
 //
-// If this number is smaller than [property@Gtk.GridView:min-columns],
+// If this number is smaller than [GridView.GetPropertyMinColumns],
 // that value is used instead.
 func (x *GridView) GetPropertyMaxColumns() uint {
 	var v gobject.Value
@@ -363,29 +357,51 @@ func (x *GridView) GetPropertySingleClickActivate() bool {
 // usually via activating the GtkGridView|list.activate-item action.
 //
 // This allows for a convenient way to handle activation in a gridview.
-// See [property@Gtk.ListItem:activatable] for details on how to use
+// See [ListItem.GetPropertyActivatable] for details on how to use
 // this signal.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *GridView) ConnectActivate(cb *func(GridView, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp uint) {
+			defer core.RecoverPanic()
+			fa := GridView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PositionVarp uint) {
+var xGridViewActivateTrampoline uintptr
+
+func xGridViewActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(GridView, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := GridView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *GridView) ConnectActivateFunc(cb func(GridView, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xGridViewActivateTrampoline, xGridViewActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -549,7 +565,7 @@ func (x *GridView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -557,14 +573,13 @@ func (x *GridView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *GridView) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -588,19 +603,17 @@ func (x *GridView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *GridView) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -621,7 +634,7 @@ func (x *GridView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -630,14 +643,12 @@ func (x *GridView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *GridView) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -656,24 +667,24 @@ func (x *GridView) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *GridView) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *GridView) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *GridView) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -775,7 +786,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -784,22 +795,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xGridViewGLibType, libs, "gtk_grid_view_get_type")
 
-	core.PuregoSafeRegister(&xNewGridView, libs, "gtk_grid_view_new")
-
-	core.PuregoSafeRegister(&xGridViewGetEnableRubberband, libs, "gtk_grid_view_get_enable_rubberband")
-	core.PuregoSafeRegister(&xGridViewGetFactory, libs, "gtk_grid_view_get_factory")
-	core.PuregoSafeRegister(&xGridViewGetMaxColumns, libs, "gtk_grid_view_get_max_columns")
-	core.PuregoSafeRegister(&xGridViewGetMinColumns, libs, "gtk_grid_view_get_min_columns")
-	core.PuregoSafeRegister(&xGridViewGetModel, libs, "gtk_grid_view_get_model")
-	core.PuregoSafeRegister(&xGridViewGetSingleClickActivate, libs, "gtk_grid_view_get_single_click_activate")
-	core.PuregoSafeRegister(&xGridViewGetTabBehavior, libs, "gtk_grid_view_get_tab_behavior")
-	core.PuregoSafeRegister(&xGridViewScrollTo, libs, "gtk_grid_view_scroll_to")
-	core.PuregoSafeRegister(&xGridViewSetEnableRubberband, libs, "gtk_grid_view_set_enable_rubberband")
-	core.PuregoSafeRegister(&xGridViewSetFactory, libs, "gtk_grid_view_set_factory")
-	core.PuregoSafeRegister(&xGridViewSetMaxColumns, libs, "gtk_grid_view_set_max_columns")
-	core.PuregoSafeRegister(&xGridViewSetMinColumns, libs, "gtk_grid_view_set_min_columns")
-	core.PuregoSafeRegister(&xGridViewSetModel, libs, "gtk_grid_view_set_model")
-	core.PuregoSafeRegister(&xGridViewSetSingleClickActivate, libs, "gtk_grid_view_set_single_click_activate")
-	core.PuregoSafeRegister(&xGridViewSetTabBehavior, libs, "gtk_grid_view_set_tab_behavior")
+	core.PuregoRegisterSince(&xNewGridView, libs, "gtk_grid_view_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xGridViewGetEnableRubberband, libs, "gtk_grid_view_get_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetFactory, libs, "gtk_grid_view_get_factory", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetMaxColumns, libs, "gtk_grid_view_get_max_columns", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetMinColumns, libs, "gtk_grid_view_get_min_columns", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetModel, libs, "gtk_grid_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetSingleClickActivate, libs, "gtk_grid_view_get_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewGetTabBehavior, libs, "gtk_grid_view_get_tab_behavior", "GTK", "4.12")
+	core.PuregoRegisterSince(&xGridViewScrollTo, libs, "gtk_grid_view_scroll_to", "GTK", "4.12")
+	core.PuregoRegisterSince(&xGridViewSetEnableRubberband, libs, "gtk_grid_view_set_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetFactory, libs, "gtk_grid_view_set_factory", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetMaxColumns, libs, "gtk_grid_view_set_max_columns", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetMinColumns, libs, "gtk_grid_view_set_min_columns", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetModel, libs, "gtk_grid_view_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetSingleClickActivate, libs, "gtk_grid_view_set_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xGridViewSetTabBehavior, libs, "gtk_grid_view_set_tab_behavior", "GTK", "4.12")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,13 +9,6 @@ import (
 
 // Creates a custom titlebar for a window.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="headerbar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkHeaderBar" src="headerbar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // `GtkHeaderBar` is similar to a horizontal `GtkCenterBox`. It allows
 // children to be placed at the start or the end. In addition, it allows
 // the window title to be displayed. The title will be centered with respect
@@ -27,55 +19,47 @@ import (
 // maximize and close buttons, or the window icon.
 //
 // For these reasons, `GtkHeaderBar` is the natural choice for use as the
-// custom titlebar widget of a `GtkWindow` (see [method@Gtk.Window.set_titlebar]),
+// custom titlebar widget of a `GtkWindow` (see [Window.SetTitlebar]),
 // as it gives features typical of titlebars while allowing the addition of
 // child widgets.
 //
-// ## GtkHeaderBar as GtkBuildable
+// # GtkHeaderBar as GtkBuildable
 //
 // The `GtkHeaderBar` implementation of the `GtkBuildable` interface supports
 // adding children at the start or end sides by specifying “start” or “end” as
-// the “type” attribute of a `&lt;child&gt;` element, or setting the title widget by
+// the “type” attribute of a `<child>` element, or setting the title widget by
 // specifying “title” value.
 //
 // By default the `GtkHeaderBar` uses a `GtkLabel` displaying the title of the
 // window it is contained in as the title widget, equivalent to the following
 // UI definition:
 //
-// ```xml
-// &lt;object class="GtkHeaderBar"&gt;
-//
-//	&lt;property name="title-widget"&gt;
-//	  &lt;object class="GtkLabel"&gt;
-//	    &lt;property name="label" translatable="yes"&gt;Label&lt;/property&gt;
-//	    &lt;property name="single-line-mode"&gt;True&lt;/property&gt;
-//	    &lt;property name="ellipsize"&gt;end&lt;/property&gt;
-//	    &lt;property name="width-chars"&gt;5&lt;/property&gt;
-//	    &lt;style&gt;
-//	      &lt;class name="title"/&gt;
-//	    &lt;/style&gt;
-//	  &lt;/object&gt;
-//	&lt;/property&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkHeaderBar">
+//	  <property name="title-widget">
+//	    <object class="GtkLabel">
+//	      <property name="label" translatable="yes">Label</property>
+//	      <property name="single-line-mode">True</property>
+//	      <property name="ellipsize">end</property>
+//	      <property name="width-chars">5</property>
+//	      <style>
+//	        <class name="title"/>
+//	      </style>
+//	    </object>
+//	  </property>
+//	</object>
 //
 // # CSS nodes
 //
-// ```
-// headerbar
-// ╰── windowhandle
-//
-//	╰── box
-//	    ├── box.start
-//	    │   ├── windowcontrols.start
-//	    │   ╰── [other children]
-//	    ├── [Title Widget]
-//	    ╰── box.end
-//	        ├── [other children]
-//	        ╰── windowcontrols.end
-//
-// ```
+//	headerbar
+//	╰── windowhandle
+//	    ╰── box
+//	        ├── box.start
+//	        │   ├── windowcontrols.start
+//	        │   ╰── [other children]
+//	        ├── [Title Widget]
+//	        ╰── box.end
+//	            ├── [other children]
+//	            ╰── windowcontrols.end
 //
 // A `GtkHeaderBar`'s CSS node is called `headerbar`. It contains a `windowhandle`
 // subnode, which contains a `box` subnode, which contains two `box` subnodes at
@@ -83,11 +67,11 @@ import (
 // the title.
 //
 // Each of the boxes contains a `windowcontrols` subnode, see
-// [class@Gtk.WindowControls] for details, as well as other children.
+// [WindowControls] for details, as well as other children.
 //
 // # Accessibility
 //
-// `GtkHeaderBar` uses the [enum@Gtk.AccessibleRole.group] role.
+// `GtkHeaderBar` uses the [AccessibleRoleGroupValue] role.
 type HeaderBar struct {
 	Widget
 }
@@ -144,7 +128,7 @@ var xHeaderBarGetTitleWidget func(uintptr) uintptr
 
 // Retrieves the title widget of the header bar.
 //
-// See [method@Gtk.HeaderBar.set_title_widget].
+// See [HeaderBar.SetTitleWidget].
 func (x *HeaderBar) GetTitleWidget() *Widget {
 	var cls *Widget
 
@@ -192,9 +176,9 @@ var xHeaderBarRemove func(uintptr, uintptr)
 // Removes a child from the header bar.
 //
 // The child must have been added with
-// [method@Gtk.HeaderBar.pack_start],
-// [method@Gtk.HeaderBar.pack_end] or
-// [method@Gtk.HeaderBar.set_title_widget].
+// [HeaderBar.PackStart],
+// [HeaderBar.PackEnd] or
+// [HeaderBar.SetTitleWidget].
 func (x *HeaderBar) Remove(ChildVar *Widget) {
 
 	xHeaderBarRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -206,7 +190,7 @@ var xHeaderBarSetDecorationLayout func(uintptr, uintptr)
 // Sets the decoration layout for this header bar.
 //
 // This property overrides the
-// [property@Gtk.Settings:gtk-decoration-layout] setting.
+// [Settings.GetPropertyGtkDecorationLayout] setting.
 //
 // There can be valid reasons for overriding the setting, such
 // as a header bar design that does not allow for buttons to take
@@ -265,7 +249,7 @@ var xHeaderBarSetUseNativeControls func(uintptr, bool)
 // This option shows the "stoplight" buttons on macOS.
 // For Linux, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *HeaderBar) SetUseNativeControls(SettingVar bool) {
 
 	xHeaderBarSetUseNativeControls(x.GoPointer(), SettingVar)
@@ -287,7 +271,7 @@ func (c *HeaderBar) SetGoPointer(ptr uintptr) {
 // The decoration layout for buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [Settings.GetPropertyGtkDecorationLayout] setting is used.
 func (x *HeaderBar) SetPropertyDecorationLayout(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -299,7 +283,7 @@ func (x *HeaderBar) SetPropertyDecorationLayout(value string) {
 // The decoration layout for buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [Settings.GetPropertyGtkDecorationLayout] setting is used.
 func (x *HeaderBar) GetPropertyDecorationLayout() string {
 	var v gobject.Value
 	x.GetProperty("decoration-layout", &v)
@@ -310,7 +294,7 @@ func (x *HeaderBar) GetPropertyDecorationLayout() string {
 // Whether to show title buttons like close, minimize, maximize.
 //
 // Which buttons are actually shown and where is determined
-// by the [property@Gtk.HeaderBar:decoration-layout] property,
+// by the [HeaderBar.GetPropertyDecorationLayout] property,
 // and by the state of the window (e.g. a close button will not
 // be shown if the window can't be closed).
 func (x *HeaderBar) SetPropertyShowTitleButtons(value bool) {
@@ -324,7 +308,7 @@ func (x *HeaderBar) SetPropertyShowTitleButtons(value bool) {
 // Whether to show title buttons like close, minimize, maximize.
 //
 // Which buttons are actually shown and where is determined
-// by the [property@Gtk.HeaderBar:decoration-layout] property,
+// by the [HeaderBar.GetPropertyDecorationLayout] property,
 // and by the state of the window (e.g. a close button will not
 // be shown if the window can't be closed).
 func (x *HeaderBar) GetPropertyShowTitleButtons() bool {
@@ -336,12 +320,12 @@ func (x *HeaderBar) GetPropertyShowTitleButtons() bool {
 // SetPropertyUseNativeControls sets the "use-native-controls" property.
 // Whether to show platform native close/minimize/maximize buttons.
 //
-// For macOS, the [property@Gtk.HeaderBar:decoration-layout] property
+// For macOS, the [HeaderBar.GetPropertyDecorationLayout] property
 // can be used to enable/disable controls.
 //
 // On Linux, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *HeaderBar) SetPropertyUseNativeControls(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -352,12 +336,12 @@ func (x *HeaderBar) SetPropertyUseNativeControls(value bool) {
 // GetPropertyUseNativeControls gets the "use-native-controls" property.
 // Whether to show platform native close/minimize/maximize buttons.
 //
-// For macOS, the [property@Gtk.HeaderBar:decoration-layout] property
+// For macOS, the [HeaderBar.GetPropertyDecorationLayout] property
 // can be used to enable/disable controls.
 //
 // On Linux, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *HeaderBar) GetPropertyUseNativeControls() bool {
 	var v gobject.Value
 	x.GetProperty("use-native-controls", &v)
@@ -525,7 +509,7 @@ func (x *HeaderBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -533,14 +517,13 @@ func (x *HeaderBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -564,19 +547,17 @@ func (x *HeaderBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -597,7 +578,7 @@ func (x *HeaderBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -606,14 +587,12 @@ func (x *HeaderBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *HeaderBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -632,10 +611,10 @@ func (x *HeaderBar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *HeaderBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -647,7 +626,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -656,18 +635,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xHeaderBarGLibType, libs, "gtk_header_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewHeaderBar, libs, "gtk_header_bar_new")
-
-	core.PuregoSafeRegister(&xHeaderBarGetDecorationLayout, libs, "gtk_header_bar_get_decoration_layout")
-	core.PuregoSafeRegister(&xHeaderBarGetShowTitleButtons, libs, "gtk_header_bar_get_show_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarGetTitleWidget, libs, "gtk_header_bar_get_title_widget")
-	core.PuregoSafeRegister(&xHeaderBarGetUseNativeControls, libs, "gtk_header_bar_get_use_native_controls")
-	core.PuregoSafeRegister(&xHeaderBarPackEnd, libs, "gtk_header_bar_pack_end")
-	core.PuregoSafeRegister(&xHeaderBarPackStart, libs, "gtk_header_bar_pack_start")
-	core.PuregoSafeRegister(&xHeaderBarRemove, libs, "gtk_header_bar_remove")
-	core.PuregoSafeRegister(&xHeaderBarSetDecorationLayout, libs, "gtk_header_bar_set_decoration_layout")
-	core.PuregoSafeRegister(&xHeaderBarSetShowTitleButtons, libs, "gtk_header_bar_set_show_title_buttons")
-	core.PuregoSafeRegister(&xHeaderBarSetTitleWidget, libs, "gtk_header_bar_set_title_widget")
-	core.PuregoSafeRegister(&xHeaderBarSetUseNativeControls, libs, "gtk_header_bar_set_use_native_controls")
+	core.PuregoRegisterSince(&xNewHeaderBar, libs, "gtk_header_bar_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xHeaderBarGetDecorationLayout, libs, "gtk_header_bar_get_decoration_layout", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarGetShowTitleButtons, libs, "gtk_header_bar_get_show_title_buttons", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarGetTitleWidget, libs, "gtk_header_bar_get_title_widget", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarGetUseNativeControls, libs, "gtk_header_bar_get_use_native_controls", "GTK", "4.18")
+	core.PuregoRegisterSince(&xHeaderBarPackEnd, libs, "gtk_header_bar_pack_end", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarPackStart, libs, "gtk_header_bar_pack_start", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarRemove, libs, "gtk_header_bar_remove", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarSetDecorationLayout, libs, "gtk_header_bar_set_decoration_layout", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarSetShowTitleButtons, libs, "gtk_header_bar_set_show_title_buttons", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarSetTitleWidget, libs, "gtk_header_bar_set_title_widget", "GTK", "")
+	core.PuregoRegisterSince(&xHeaderBarSetUseNativeControls, libs, "gtk_header_bar_set_use_native_controls", "GTK", "4.18")
 
 }
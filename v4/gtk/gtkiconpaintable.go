@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -65,7 +64,7 @@ var xIconPaintableGetFile func(uintptr) uintptr
 
 // Gets the `GFile` that was used to load the icon.
 //
-// Returns %NULL if the icon was not loaded from a file.
+// Returns nil if the icon was not loaded from a file.
 func (x *IconPaintable) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -79,35 +78,6 @@ func (x *IconPaintable) GetFile() *gio.FileBase {
 	return cls
 }
 
-var xIconPaintableGetIconName func(uintptr) string
-
-// Get the icon name being used for this icon.
-//
-// When an icon looked up in the icon theme was not available, the
-// icon theme may use fallback icons - either those specified to
-// gtk_icon_theme_lookup_icon() or the always-available
-// "image-missing". The icon chosen is returned by this function.
-//
-// If the icon was created without an icon theme, this function
-// returns %NULL.
-func (x *IconPaintable) GetIconName() string {
-
-	cret := xIconPaintableGetIconName(x.GoPointer())
-	return cret
-}
-
-var xIconPaintableIsSymbolic func(uintptr) bool
-
-// Checks if the icon is symbolic or not.
-//
-// This currently uses only the file name and not the file contents
-// for determining this. This behaviour may change in the future.
-func (x *IconPaintable) IsSymbolic() bool {
-
-	cret := xIconPaintableIsSymbolic(x.GoPointer())
-	return cret
-}
-
 func (c *IconPaintable) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -121,6 +91,8 @@ func (c *IconPaintable) SetGoPointer(ptr uintptr) {
 
 // SetPropertyIconName sets the "icon-name" property.
 // The icon name that was chosen during lookup.
+//
+// Deprecated: since 4.20. do not use it in new code.
 func (x *IconPaintable) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -130,6 +102,8 @@ func (x *IconPaintable) SetPropertyIconName(value string) {
 
 // GetPropertyIconName gets the "icon-name" property.
 // The icon name that was chosen during lookup.
+//
+// Deprecated: since 4.20. do not use it in new code.
 func (x *IconPaintable) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -138,6 +112,8 @@ func (x *IconPaintable) GetPropertyIconName() string {
 
 // SetPropertyIsSymbolic sets the "is-symbolic" property.
 // Whether the icon is symbolic or not.
+//
+// Deprecated: since 4.20. do not use it in new code.
 func (x *IconPaintable) SetPropertyIsSymbolic(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -147,6 +123,8 @@ func (x *IconPaintable) SetPropertyIsSymbolic(value bool) {
 
 // GetPropertyIsSymbolic gets the "is-symbolic" property.
 // Whether the icon is symbolic or not.
+//
+// Deprecated: since 4.20. do not use it in new code.
 func (x *IconPaintable) GetPropertyIsSymbolic() bool {
 	var v gobject.Value
 	x.GetProperty("is-symbolic", &v)
@@ -186,25 +164,27 @@ func (x *IconPaintable) GetPropertySize() int {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *IconPaintable) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	gdk.XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *IconPaintable) GetCurrentImage() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -222,29 +202,29 @@ func (x *IconPaintable) GetCurrentImage() *gdk.PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [gdk.PaintableFlags] for the flags and what they mean.
 func (x *IconPaintable) GetFlags() gdk.PaintableFlags {
 
 	cret := gdk.XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [gdk.Paintable.GetIntrinsicWidth] and
+// [gdk.Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *IconPaintable) GetIntrinsicAspectRatio() float64 {
 
@@ -252,15 +232,15 @@ func (x *IconPaintable) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *IconPaintable) GetIntrinsicHeight() int {
 
@@ -268,15 +248,15 @@ func (x *IconPaintable) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *IconPaintable) GetIntrinsicWidth() int {
 
@@ -287,12 +267,12 @@ func (x *IconPaintable) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [gdk.Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [gdk.PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *IconPaintable) InvalidateContents() {
 
@@ -302,13 +282,13 @@ func (x *IconPaintable) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [gdk.PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *IconPaintable) InvalidateSize() {
 
@@ -316,10 +296,10 @@ func (x *IconPaintable) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *IconPaintable) Snapshot(SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -342,7 +322,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -351,10 +331,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xIconPaintableGLibType, libs, "gtk_icon_paintable_get_type")
 
-	core.PuregoSafeRegister(&xNewIconPaintableForFile, libs, "gtk_icon_paintable_new_for_file")
+	gobject.CheckClassSize("GtkIconPaintableClass", xIconPaintableGLibType, unsafe.Sizeof(IconPaintableClass{}))
+
+	core.PuregoRegisterSince(&xNewIconPaintableForFile, libs, "gtk_icon_paintable_new_for_file", "GTK", "")
 
-	core.PuregoSafeRegister(&xIconPaintableGetFile, libs, "gtk_icon_paintable_get_file")
-	core.PuregoSafeRegister(&xIconPaintableGetIconName, libs, "gtk_icon_paintable_get_icon_name")
-	core.PuregoSafeRegister(&xIconPaintableIsSymbolic, libs, "gtk_icon_paintable_is_symbolic")
+	core.PuregoRegisterSince(&xIconPaintableGetFile, libs, "gtk_icon_paintable_get_file", "GTK", "")
 
 }
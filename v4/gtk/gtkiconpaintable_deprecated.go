@@ -0,0 +1,58 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xIconPaintableGetIconName func(uintptr) string
+
+// Get the icon name being used for this icon.
+//
+// When an icon looked up in the icon theme was not available, the
+// icon theme may use fallback icons - either those specified to
+// [IconTheme.LookupIcon] or the always-available
+// "image-missing". The icon chosen is returned by this function.
+//
+// If the icon was created without an icon theme, this function
+// returns nil.
+//
+// Deprecated: since 4.20. do not use it in new code.
+func (x *IconPaintable) GetIconName() string {
+
+	cret := xIconPaintableGetIconName(x.GoPointer())
+	return cret
+}
+
+var xIconPaintableIsSymbolic func(uintptr) bool
+
+// Checks if the icon is symbolic or not.
+//
+// This currently uses only the file name and not the file contents
+// for determining this. This behaviour may change in the future.
+//
+// Deprecated: since 4.20. do not use it in new code.
+func (x *IconPaintable) IsSymbolic() bool {
+
+	cret := xIconPaintableIsSymbolic(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIconPaintableGetIconName, libs, "gtk_icon_paintable_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xIconPaintableIsSymbolic, libs, "gtk_icon_paintable_is_symbolic", "GTK", "")
+
+}
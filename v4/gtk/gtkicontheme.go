@@ -2,9 +2,10 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -13,8 +14,8 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Used to specify options for gtk_icon_theme_lookup_icon().
-type IconLookupFlags int
+// Used to specify options for [IconTheme.LookupIcon].
+type IconLookupFlags uint32
 
 var xIconLookupFlagsGLibType func() types.GType
 
@@ -37,6 +38,51 @@ const (
 	IconLookupPreloadValue IconLookupFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (IconLookupFlags) GLibType() types.GType {
+	return xIconLookupFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x IconLookupFlags) Has(flags IconLookupFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x IconLookupFlags) Set(flags IconLookupFlags) IconLookupFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x IconLookupFlags) Clear(flags IconLookupFlags) IconLookupFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x IconLookupFlags) String() string {
+	if x == 0 {
+		return "GTK_ICON_LOOKUP_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&IconLookupForceRegularValue != 0 && x&IconLookupForceRegularValue == IconLookupForceRegularValue {
+		names = append(names, "GTK_ICON_LOOKUP_FORCE_REGULAR")
+		rest &^= IconLookupForceRegularValue
+	}
+	if rest&IconLookupForceSymbolicValue != 0 && x&IconLookupForceSymbolicValue == IconLookupForceSymbolicValue {
+		names = append(names, "GTK_ICON_LOOKUP_FORCE_SYMBOLIC")
+		rest &^= IconLookupForceSymbolicValue
+	}
+	if rest&IconLookupPreloadValue != 0 && x&IconLookupPreloadValue == IconLookupPreloadValue {
+		names = append(names, "GTK_ICON_LOOKUP_PRELOAD")
+		rest &^= IconLookupPreloadValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Error codes for `GtkIconTheme` operations.
 type IconThemeError int
 
@@ -54,9 +100,58 @@ const (
 	IconThemeFailedValue IconThemeError = 1
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (IconThemeError) ErrorDomain() string {
+	return "gtk-icon-theme-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x IconThemeError) Error() string {
+	switch x {
+	case IconThemeNotFoundValue:
+		return "GTK_ICON_THEME_NOT_FOUND"
+	case IconThemeFailedValue:
+		return "GTK_ICON_THEME_FAILED"
+	}
+	return fmt.Sprintf("gtk-icon-theme-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x IconThemeError) String() string {
+	switch x {
+	case IconThemeNotFoundValue:
+		return "GTK_ICON_THEME_NOT_FOUND"
+	case IconThemeFailedValue:
+		return "GTK_ICON_THEME_FAILED"
+	}
+	return fmt.Sprintf("IconThemeError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x IconThemeError) Nick() string {
+	switch x {
+	case IconThemeNotFoundValue:
+		return "not-found"
+	case IconThemeFailedValue:
+		return "failed"
+	}
+	return ""
+}
+
+// IconThemeErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func IconThemeErrorFromString(s string) (IconThemeError, bool) {
+	switch s {
+	case "GTK_ICON_THEME_NOT_FOUND", "not-found":
+		return IconThemeNotFoundValue, true
+	case "GTK_ICON_THEME_FAILED", "failed":
+		return IconThemeFailedValue, true
+	}
+	return 0, false
+}
+
 var xIconThemeErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@Gtk.IconTheme] errors.
+// Registers an error quark for [IconTheme] errors.
 func IconThemeErrorQuark() glib.Quark {
 
 	cret := xIconThemeErrorQuark()
@@ -68,35 +163,33 @@ func IconThemeErrorQuark() glib.Quark {
 // The main reason for using a name rather than simply providing a filename
 // is to allow different icons to be used depending on what “icon theme” is
 // selected by the user. The operation of icon themes on Linux and Unix
-// follows the [Icon Theme Specification](http://www.freedesktop.org/Standards/icon-theme-spec)
+// follows the [Icon Theme Specification]
 // There is a fallback icon theme, named `hicolor`, where applications
 // should install their icons, but additional icon themes can be installed
 // as operating system vendors and users choose.
 //
-// In many cases, named themes are used indirectly, via [class@Gtk.Image]
+// In many cases, named themes are used indirectly, via [Image]
 // rather than directly, but looking up icons directly is also simple. The
 // `GtkIconTheme` object acts as a database of all the icons in the current
 // theme. You can create new `GtkIconTheme` objects, but it’s much more
 // efficient to use the standard icon theme of the `GtkWidget` so that the
 // icon information is shared with other people looking up icons.
 //
-// ```c
-// GtkIconTheme *icon_theme;
-// GtkIconPaintable *icon;
-// GdkPaintable *paintable;
-//
-// icon_theme = gtk_icon_theme_get_for_display (gtk_widget_get_display (my_widget));
-// icon = gtk_icon_theme_lookup_icon (icon_theme,
+//	GtkIconTheme *icon_theme;
+//	GtkIconPaintable *icon;
+//	GdkPaintable *paintable;
 //
-//	"my-icon-name", // icon name
-//	48, // icon size
-//	1,  // scale
-//	0,  // flags);
+//	icon_theme = gtk_icon_theme_get_for_display (gtk_widget_get_display (my_widget));
+//	icon = gtk_icon_theme_lookup_icon (icon_theme,
+//	                                   "my-icon-name", // icon name
+//	                                   48, // icon size
+//	                                   1,  // scale
+//	                                   0,  // flags);
+//	paintable = GDK_PAINTABLE (icon);
+//	// Use the paintable
+//	g_object_unref (icon);
 //
-// paintable = GDK_PAINTABLE (icon);
-// // Use the paintable
-// g_object_unref (icon);
-// ```
+// [Icon Theme Specification]: http://www.freedesktop.org/Standards/icon-theme-spec
 type IconTheme struct {
 	gobject.Object
 }
@@ -119,7 +212,7 @@ var xNewIconTheme func() uintptr
 //
 // Icon theme objects are used to lookup up an icon by name
 // in a particular icon theme. Usually, you’ll want to use
-// [func@Gtk.IconTheme.get_for_display] rather than creating
+// [IconThemeGetForDisplay] rather than creating
 // a new icon theme object for scratch.
 func NewIconTheme() *IconTheme {
 	var cls *IconTheme
@@ -139,7 +232,7 @@ var xIconThemeAddResourcePath func(uintptr, string)
 // Adds a resource path that will be looked at when looking
 // for icons, similar to search paths.
 //
-// See [method@Gtk.IconTheme.set_resource_path].
+// See [IconTheme.SetResourcePath].
 //
 // This function should be used to make application-specific icons
 // available as part of the icon theme.
@@ -153,7 +246,7 @@ var xIconThemeAddSearchPath func(uintptr, string)
 
 // Appends a directory to the search path.
 //
-// See [method@Gtk.IconTheme.set_search_path].
+// See [IconTheme.SetSearchPath].
 func (x *IconTheme) AddSearchPath(PathVar string) {
 
 	xIconThemeAddSearchPath(x.GoPointer(), PathVar)
@@ -178,13 +271,13 @@ func (x *IconTheme) GetDisplay() *gdk.Display {
 	return cls
 }
 
-var xIconThemeGetIconNames func(uintptr) []string
+var xIconThemeGetIconNames func(uintptr) uintptr
 
 // Lists the names of icons in the current icon theme.
 func (x *IconTheme) GetIconNames() []string {
 
 	cret := xIconThemeGetIconNames(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xIconThemeGetIconSizes func(uintptr, string) uintptr
@@ -200,26 +293,26 @@ func (x *IconTheme) GetIconSizes(IconNameVar string) uintptr {
 	return cret
 }
 
-var xIconThemeGetResourcePath func(uintptr) []string
+var xIconThemeGetResourcePath func(uintptr) uintptr
 
 // Gets the current resource path.
 //
-// See [method@Gtk.IconTheme.set_resource_path].
+// See [IconTheme.SetResourcePath].
 func (x *IconTheme) GetResourcePath() []string {
 
 	cret := xIconThemeGetResourcePath(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
-var xIconThemeGetSearchPath func(uintptr) []string
+var xIconThemeGetSearchPath func(uintptr) uintptr
 
 // Gets the current search path.
 //
-// See [method@Gtk.IconTheme.set_search_path].
+// See [IconTheme.SetSearchPath].
 func (x *IconTheme) GetSearchPath() []string {
 
 	cret := xIconThemeGetSearchPath(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xIconThemeGetThemeName func(uintptr) string
@@ -278,12 +371,12 @@ var xIconThemeLookupIcon func(uintptr, string, []string, int, int, TextDirection
 // The icon can then be rendered by using it as a `GdkPaintable`,
 // or you can get information such as the filename and size.
 //
-// If the available @icon_name is not available and @fallbacks are
+// If the available icon_name is not available and fallbacks are
 // provided, they will be tried in order.
 //
 // If no matching icon is found, then a paintable that renders the
 // "missing icon" icon is returned. If you need to do something else
-// for missing icons you need to use [method@Gtk.IconTheme.has_icon].
+// for missing icons you need to use [IconTheme.HasIcon].
 //
 // Note that you probably want to listen for icon theme changes and
 // update the icon. This is usually done by overriding the
@@ -308,8 +401,8 @@ var xIconThemeSetResourcePath func(uintptr, []string)
 //
 // The resources are considered as part of the hicolor icon theme
 // and must be located in subdirectories that are defined in the
-// hicolor icon theme, such as `@path/16x16/actions/run.png`
-// or `@path/scalable/actions/run.svg`.
+// hicolor icon theme, such as `path/16x16/actions/run.png`
+// or `path/scalable/actions/run.svg`.
 //
 // Icons that are directly placed in the resource path instead
 // of a subdirectory are also considered as ultimate fallback,
@@ -325,7 +418,7 @@ var xIconThemeSetSearchPath func(uintptr, []string)
 // Sets the search path for the icon theme object.
 //
 // When looking for an icon theme, GTK will search for a subdirectory
-// of one or more of the directories in @path with the same name
+// of one or more of the directories in path with the same name
 // as the icon theme containing an index.theme file. (Themes from
 // multiple of the path elements are combined to allow themes to be
 // extended by adding icons in the user’s home directory.)
@@ -333,7 +426,7 @@ var xIconThemeSetSearchPath func(uintptr, []string)
 // In addition if an icon found isn’t found either in the current
 // icon theme or the default icon theme, and an image file with
 // the right name is found directly in one of the elements of
-// @path, then that image will be used for the icon name.
+// path, then that image will be used for the icon name.
 // (This is legacy feature, and new icons should be put
 // into the fallback icon theme, which is called hicolor,
 // rather than directly on the icon path.)
@@ -349,7 +442,7 @@ var xIconThemeSetThemeName func(uintptr, uintptr)
 // overriding system configuration.
 //
 // This function cannot be called on the icon theme objects returned
-// from [func@Gtk.IconTheme.get_for_display].
+// from [IconThemeGetForDisplay].
 func (x *IconTheme) SetThemeName(ThemeNameVar *string) {
 
 	ThemeNameVarPtr := core.GStrdupNullable(ThemeNameVar)
@@ -384,7 +477,7 @@ func (x *IconTheme) GetPropertyIconNames() []string {
 //
 // The resources are considered as part of the hicolor icon theme
 // and must be located in subdirectories that are defined in the
-// hicolor icon theme, such as `@path/16x16/actions/run.png`.
+// hicolor icon theme, such as `path/16x16/actions/run.png`.
 // Icons that are directly placed in the resource path instead
 // of a subdirectory are also considered as ultimate fallback.
 func (x *IconTheme) SetPropertyResourcePath(value []string) {
@@ -400,7 +493,7 @@ func (x *IconTheme) SetPropertyResourcePath(value []string) {
 //
 // The resources are considered as part of the hicolor icon theme
 // and must be located in subdirectories that are defined in the
-// hicolor icon theme, such as `@path/16x16/actions/run.png`.
+// hicolor icon theme, such as `path/16x16/actions/run.png`.
 // Icons that are directly placed in the resource path instead
 // of a subdirectory are also considered as ultimate fallback.
 func (x *IconTheme) GetPropertyResourcePath() []string {
@@ -468,38 +561,60 @@ func (x *IconTheme) GetPropertyThemeName() string {
 // This can happen because current icon theme is switched or
 // because GTK detects that a change has occurred in the
 // contents of the current icon theme.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *IconTheme) ConnectChanged(cb *func(IconTheme)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := IconTheme{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
+
+var xIconThemeChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xIconThemeChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IconTheme))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IconTheme{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IconTheme) ConnectChangedFunc(cb func(IconTheme)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xIconThemeChangedTrampoline, xIconThemeChangedNewTrampoline, cb)
 }
 
 var xIconThemeGetForDisplay func(uintptr) uintptr
 
-// Gets the icon theme object associated with @display.
+// Gets the icon theme object associated with display.
 //
 // If this function has not previously been called for the given
 // display, a new icon theme object will be created and associated
 // with the display. Icon theme objects are fairly expensive to create,
 // so using this function is usually a better choice than calling
-// [ctor@Gtk.IconTheme.new] and setting the display yourself; by using
+// [NewIconTheme] and setting the display yourself; by using
 // this function a single icon theme object will be shared between users.
 func IconThemeGetForDisplay(DisplayVar *gdk.Display) *IconTheme {
 	var cls *IconTheme
@@ -520,7 +635,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -531,28 +646,28 @@ func init() {
 
 	core.PuregoSafeRegister(&xIconThemeErrorGLibType, libs, "gtk_icon_theme_error_get_type")
 
-	core.PuregoSafeRegister(&xIconThemeErrorQuark, libs, "gtk_icon_theme_error_quark")
+	core.PuregoRegisterSince(&xIconThemeErrorQuark, libs, "gtk_icon_theme_error_quark", "GTK", "")
 
 	core.PuregoSafeRegister(&xIconThemeGLibType, libs, "gtk_icon_theme_get_type")
 
-	core.PuregoSafeRegister(&xNewIconTheme, libs, "gtk_icon_theme_new")
-
-	core.PuregoSafeRegister(&xIconThemeAddResourcePath, libs, "gtk_icon_theme_add_resource_path")
-	core.PuregoSafeRegister(&xIconThemeAddSearchPath, libs, "gtk_icon_theme_add_search_path")
-	core.PuregoSafeRegister(&xIconThemeGetDisplay, libs, "gtk_icon_theme_get_display")
-	core.PuregoSafeRegister(&xIconThemeGetIconNames, libs, "gtk_icon_theme_get_icon_names")
-	core.PuregoSafeRegister(&xIconThemeGetIconSizes, libs, "gtk_icon_theme_get_icon_sizes")
-	core.PuregoSafeRegister(&xIconThemeGetResourcePath, libs, "gtk_icon_theme_get_resource_path")
-	core.PuregoSafeRegister(&xIconThemeGetSearchPath, libs, "gtk_icon_theme_get_search_path")
-	core.PuregoSafeRegister(&xIconThemeGetThemeName, libs, "gtk_icon_theme_get_theme_name")
-	core.PuregoSafeRegister(&xIconThemeHasGicon, libs, "gtk_icon_theme_has_gicon")
-	core.PuregoSafeRegister(&xIconThemeHasIcon, libs, "gtk_icon_theme_has_icon")
-	core.PuregoSafeRegister(&xIconThemeLookupByGicon, libs, "gtk_icon_theme_lookup_by_gicon")
-	core.PuregoSafeRegister(&xIconThemeLookupIcon, libs, "gtk_icon_theme_lookup_icon")
-	core.PuregoSafeRegister(&xIconThemeSetResourcePath, libs, "gtk_icon_theme_set_resource_path")
-	core.PuregoSafeRegister(&xIconThemeSetSearchPath, libs, "gtk_icon_theme_set_search_path")
-	core.PuregoSafeRegister(&xIconThemeSetThemeName, libs, "gtk_icon_theme_set_theme_name")
-
-	core.PuregoSafeRegister(&xIconThemeGetForDisplay, libs, "gtk_icon_theme_get_for_display")
+	core.PuregoRegisterSince(&xNewIconTheme, libs, "gtk_icon_theme_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xIconThemeAddResourcePath, libs, "gtk_icon_theme_add_resource_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeAddSearchPath, libs, "gtk_icon_theme_add_search_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetDisplay, libs, "gtk_icon_theme_get_display", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetIconNames, libs, "gtk_icon_theme_get_icon_names", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetIconSizes, libs, "gtk_icon_theme_get_icon_sizes", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetResourcePath, libs, "gtk_icon_theme_get_resource_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetSearchPath, libs, "gtk_icon_theme_get_search_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeGetThemeName, libs, "gtk_icon_theme_get_theme_name", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeHasGicon, libs, "gtk_icon_theme_has_gicon", "GTK", "4.2")
+	core.PuregoRegisterSince(&xIconThemeHasIcon, libs, "gtk_icon_theme_has_icon", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeLookupByGicon, libs, "gtk_icon_theme_lookup_by_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeLookupIcon, libs, "gtk_icon_theme_lookup_icon", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeSetResourcePath, libs, "gtk_icon_theme_set_resource_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeSetSearchPath, libs, "gtk_icon_theme_set_search_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconThemeSetThemeName, libs, "gtk_icon_theme_set_theme_name", "GTK", "")
+
+	core.PuregoRegisterSince(&xIconThemeGetForDisplay, libs, "gtk_icon_theme_get_for_display", "GTK", "")
 
 }
@@ -0,0 +1,956 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewIconView func() uintptr
+
+// Creates a new `GtkIconView` widget
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func NewIconView() *IconView {
+	var cls *IconView
+
+	cret := xNewIconView()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &IconView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewIconViewWithArea func(uintptr) uintptr
+
+// Creates a new `GtkIconView` widget using the
+// specified area to layout cells inside the icons.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func NewIconViewWithArea(AreaVar *CellArea) *IconView {
+	var cls *IconView
+
+	cret := xNewIconViewWithArea(AreaVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &IconView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewIconViewWithModel func(uintptr) uintptr
+
+// Creates a new `GtkIconView` widget with the model model.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func NewIconViewWithModel(ModelVar TreeModel) *IconView {
+	var cls *IconView
+
+	cret := xNewIconViewWithModel(ModelVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &IconView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xIconViewCreateDragIcon func(uintptr, *TreePath) uintptr
+
+// Creates a `GdkPaintable` representation of the item at path.
+// This image is used for a drag icon.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) CreateDragIcon(PathVar *TreePath) *gdk.PaintableBase {
+	var cls *gdk.PaintableBase
+
+	cret := xIconViewCreateDragIcon(x.GoPointer(), PathVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gdk.PaintableBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xIconViewEnableModelDragDest func(uintptr, *gdk.ContentFormats, gdk.DragAction)
+
+// Turns icon_view into a drop destination for automatic DND. Calling this
+// method sets `GtkIconView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) EnableModelDragDest(FormatsVar *gdk.ContentFormats, ActionsVar gdk.DragAction) {
+
+	xIconViewEnableModelDragDest(x.GoPointer(), FormatsVar, ActionsVar)
+
+}
+
+var xIconViewEnableModelDragSource func(uintptr, gdk.ModifierType, *gdk.ContentFormats, gdk.DragAction)
+
+// Turns icon_view into a drag source for automatic DND. Calling this
+// method sets `GtkIconView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) EnableModelDragSource(StartButtonMaskVar gdk.ModifierType, FormatsVar *gdk.ContentFormats, ActionsVar gdk.DragAction) {
+
+	xIconViewEnableModelDragSource(x.GoPointer(), StartButtonMaskVar, FormatsVar, ActionsVar)
+
+}
+
+var xIconViewGetActivateOnSingleClick func(uintptr) bool
+
+// Gets the setting set by [IconView.SetActivateOnSingleClick].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetActivateOnSingleClick() bool {
+
+	cret := xIconViewGetActivateOnSingleClick(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetCellRect func(uintptr, *TreePath, uintptr, *gdk.Rectangle) bool
+
+// Fills the bounding rectangle in widget coordinates for the cell specified by
+// path and cell. If cell is nil the main cell area is used.
+//
+// This function is only valid if icon_view is realized.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetCellRect(PathVar *TreePath, CellVar *CellRenderer, RectVar *gdk.Rectangle) bool {
+
+	cret := xIconViewGetCellRect(x.GoPointer(), PathVar, CellVar.GoPointer(), RectVar)
+	return cret
+}
+
+var xIconViewGetColumnSpacing func(uintptr) int
+
+// Returns the value of the ::column-spacing property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetColumnSpacing() int {
+
+	cret := xIconViewGetColumnSpacing(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetColumns func(uintptr) int
+
+// Returns the value of the ::columns property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetColumns() int {
+
+	cret := xIconViewGetColumns(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetCursor func(uintptr, **TreePath, **CellRenderer) bool
+
+// Fills in path and cell with the current cursor path and cell.
+// If the cursor isn’t currently set, then *path will be nil.
+// If no cell currently has focus, then *cell will be nil.
+//
+// The returned `GtkTreePath` must be freed with [TreePath.Free].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetCursor(PathVar **TreePath, CellVar **CellRenderer) bool {
+
+	cret := xIconViewGetCursor(x.GoPointer(), PathVar, CellVar)
+	return cret
+}
+
+var xIconViewGetDestItemAtPos func(uintptr, int, int, **TreePath, *IconViewDropPosition) bool
+
+// Determines the destination item for a given position.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetDestItemAtPos(DragXVar int, DragYVar int, PathVar **TreePath, PosVar *IconViewDropPosition) bool {
+
+	cret := xIconViewGetDestItemAtPos(x.GoPointer(), DragXVar, DragYVar, PathVar, PosVar)
+	return cret
+}
+
+var xIconViewGetDragDestItem func(uintptr, **TreePath, *IconViewDropPosition)
+
+// Gets information about the item that is highlighted for feedback.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetDragDestItem(PathVar **TreePath, PosVar *IconViewDropPosition) {
+
+	xIconViewGetDragDestItem(x.GoPointer(), PathVar, PosVar)
+
+}
+
+var xIconViewGetItemAtPos func(uintptr, int, int, **TreePath, **CellRenderer) bool
+
+// Gets the path and cell for the icon at the given position.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemAtPos(XVar int, YVar int, PathVar **TreePath, CellVar **CellRenderer) bool {
+
+	cret := xIconViewGetItemAtPos(x.GoPointer(), XVar, YVar, PathVar, CellVar)
+	return cret
+}
+
+var xIconViewGetItemColumn func(uintptr, *TreePath) int
+
+// Gets the column in which the item path is currently
+// displayed. Column numbers start at 0.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemColumn(PathVar *TreePath) int {
+
+	cret := xIconViewGetItemColumn(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xIconViewGetItemOrientation func(uintptr) Orientation
+
+// Returns the value of the ::item-orientation property which determines
+// whether the labels are drawn beside the icons instead of below.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemOrientation() Orientation {
+
+	cret := xIconViewGetItemOrientation(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetItemPadding func(uintptr) int
+
+// Returns the value of the ::item-padding property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemPadding() int {
+
+	cret := xIconViewGetItemPadding(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetItemRow func(uintptr, *TreePath) int
+
+// Gets the row in which the item path is currently
+// displayed. Row numbers start at 0.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemRow(PathVar *TreePath) int {
+
+	cret := xIconViewGetItemRow(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xIconViewGetItemWidth func(uintptr) int
+
+// Returns the value of the ::item-width property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetItemWidth() int {
+
+	cret := xIconViewGetItemWidth(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetMargin func(uintptr) int
+
+// Returns the value of the ::margin property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetMargin() int {
+
+	cret := xIconViewGetMargin(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetMarkupColumn func(uintptr) int
+
+// Returns the column with markup text for icon_view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetMarkupColumn() int {
+
+	cret := xIconViewGetMarkupColumn(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetModel func(uintptr) uintptr
+
+// Returns the model the `GtkIconView` is based on.  Returns nil if the
+// model is unset.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xIconViewGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xIconViewGetPathAtPos func(uintptr, int, int) *TreePath
+
+// Gets the path for the icon at the given position.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetPathAtPos(XVar int, YVar int) *TreePath {
+
+	cret := xIconViewGetPathAtPos(x.GoPointer(), XVar, YVar)
+	return cret
+}
+
+var xIconViewGetPixbufColumn func(uintptr) int
+
+// Returns the column with pixbufs for icon_view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetPixbufColumn() int {
+
+	cret := xIconViewGetPixbufColumn(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetReorderable func(uintptr) bool
+
+// Retrieves whether the user can reorder the list via drag-and-drop.
+// See [IconView.SetReorderable].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetReorderable() bool {
+
+	cret := xIconViewGetReorderable(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetRowSpacing func(uintptr) int
+
+// Returns the value of the ::row-spacing property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetRowSpacing() int {
+
+	cret := xIconViewGetRowSpacing(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetSelectedItems func(uintptr) *glib.List
+
+// Creates a list of paths of all selected items. Additionally, if you are
+// planning on modifying the model after calling this function, you may
+// want to convert the returned list into a list of `GtkTreeRowReferences`.
+// To do this, you can use [NewTreeRowReference].
+//
+// To free the return value, use `g_list_free_full`:
+//
+//	GtkWidget *icon_view = gtk_icon_view_new ();
+//	// Use icon_view
+//
+//	GList *list = gtk_icon_view_get_selected_items (GTK_ICON_VIEW (icon_view));
+//
+//	// use list
+//
+//	g_list_free_full (list, (GDestroyNotify) gtk_tree_path_free);
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetSelectedItems() *glib.List {
+
+	cret := xIconViewGetSelectedItems(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetSelectionMode func(uintptr) SelectionMode
+
+// Gets the selection mode of the icon_view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetSelectionMode() SelectionMode {
+
+	cret := xIconViewGetSelectionMode(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetSpacing func(uintptr) int
+
+// Returns the value of the ::spacing property.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetSpacing() int {
+
+	cret := xIconViewGetSpacing(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetTextColumn func(uintptr) int
+
+// Returns the column with text for icon_view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetTextColumn() int {
+
+	cret := xIconViewGetTextColumn(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetTooltipColumn func(uintptr) int
+
+// Returns the column of icon_view’s model which is being used for
+// displaying tooltips on icon_view’s rows.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetTooltipColumn() int {
+
+	cret := xIconViewGetTooltipColumn(x.GoPointer())
+	return cret
+}
+
+var xIconViewGetTooltipContext func(uintptr, int, int, bool, **TreeModel, **TreePath, *TreeIter) bool
+
+// This function is supposed to be used in a `GtkWidget::query-tooltip`
+// signal handler for `GtkIconView`. The x, y and keyboard_tip values
+// which are received in the signal handler, should be passed to this
+// function without modification.
+//
+// The return value indicates whether there is an icon view item at the given
+// coordinates (true) or not (false) for mouse tooltips. For keyboard
+// tooltips the item returned will be the cursor item. When true, then any of
+// model, path and iter which have been provided will be set to point to
+// that row and the corresponding model.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetTooltipContext(XVar int, YVar int, KeyboardTipVar bool, ModelVar **TreeModel, PathVar **TreePath, IterVar *TreeIter) bool {
+
+	cret := xIconViewGetTooltipContext(x.GoPointer(), XVar, YVar, KeyboardTipVar, ModelVar, PathVar, IterVar)
+	return cret
+}
+
+var xIconViewGetVisibleRange func(uintptr, **TreePath, **TreePath) bool
+
+// Sets start_path and end_path to be the first and last visible path.
+// Note that there may be invisible paths in between.
+//
+// Both paths should be freed with [TreePath.Free] after use.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) GetVisibleRange(StartPathVar **TreePath, EndPathVar **TreePath) bool {
+
+	cret := xIconViewGetVisibleRange(x.GoPointer(), StartPathVar, EndPathVar)
+	return cret
+}
+
+var xIconViewItemActivated func(uintptr, *TreePath)
+
+// Activates the item determined by path.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) ItemActivated(PathVar *TreePath) {
+
+	xIconViewItemActivated(x.GoPointer(), PathVar)
+
+}
+
+var xIconViewPathIsSelected func(uintptr, *TreePath) bool
+
+// Returns true if the icon pointed to by path is currently
+// selected. If path does not point to a valid location, false is returned.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) PathIsSelected(PathVar *TreePath) bool {
+
+	cret := xIconViewPathIsSelected(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xIconViewScrollToPath func(uintptr, *TreePath, bool, float32, float32)
+
+// Moves the alignments of icon_view to the position specified by path.
+// row_align determines where the row is placed, and col_align determines
+// where column is placed.  Both are expected to be between 0.0 and 1.0.
+// 0.0 means left/top alignment, 1.0 means right/bottom alignment, 0.5 means
+// center.
+//
+// If use_align is false, then the alignment arguments are ignored, and the
+// tree does the minimum amount of work to scroll the item onto the screen.
+// This means that the item will be scrolled to the edge closest to its current
+// position.  If the item is currently visible on the screen, nothing is done.
+//
+// This function only works if the model is set, and path is a valid row on
+// the model. If the model changes before the icon_view is realized, the
+// centered path will be modified to reflect this change.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) ScrollToPath(PathVar *TreePath, UseAlignVar bool, RowAlignVar float32, ColAlignVar float32) {
+
+	xIconViewScrollToPath(x.GoPointer(), PathVar, UseAlignVar, RowAlignVar, ColAlignVar)
+
+}
+
+var xIconViewSelectAll func(uintptr)
+
+// Selects all the icons. icon_view must has its selection mode set
+// to [SelectionMultipleValue].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SelectAll() {
+
+	xIconViewSelectAll(x.GoPointer())
+
+}
+
+var xIconViewSelectPath func(uintptr, *TreePath)
+
+// Selects the row at path.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SelectPath(PathVar *TreePath) {
+
+	xIconViewSelectPath(x.GoPointer(), PathVar)
+
+}
+
+var xIconViewSelectedForeach func(uintptr, uintptr, uintptr)
+
+// Calls a function for each selected icon. Note that the model or
+// selection cannot be modified from within this function.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SelectedForeach(FuncVar *IconViewForeachFunc, DataVar uintptr) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreePath, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	xIconViewSelectedForeach(x.GoPointer(), FuncVarRef, DataVar)
+
+}
+
+var xIconViewSetActivateOnSingleClick func(uintptr, bool)
+
+// Causes the `GtkIconView`::item-activated signal to be emitted on
+// a single click instead of a double click.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetActivateOnSingleClick(SingleVar bool) {
+
+	xIconViewSetActivateOnSingleClick(x.GoPointer(), SingleVar)
+
+}
+
+var xIconViewSetColumnSpacing func(uintptr, int)
+
+// Sets the ::column-spacing property which specifies the space
+// which is inserted between the columns of the icon view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetColumnSpacing(ColumnSpacingVar int) {
+
+	xIconViewSetColumnSpacing(x.GoPointer(), ColumnSpacingVar)
+
+}
+
+var xIconViewSetColumns func(uintptr, int)
+
+// Sets the ::columns property which determines in how
+// many columns the icons are arranged. If columns is
+// -1, the number of columns will be chosen automatically
+// to fill the available area.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetColumns(ColumnsVar int) {
+
+	xIconViewSetColumns(x.GoPointer(), ColumnsVar)
+
+}
+
+var xIconViewSetCursor func(uintptr, *TreePath, uintptr, bool)
+
+// Sets the current keyboard focus to be at path, and selects it.  This is
+// useful when you want to focus the user’s attention on a particular item.
+// If cell is not nil, then focus is given to the cell specified by
+// it. Additionally, if start_editing is true, then editing should be
+// started in the specified cell.
+//
+// This function is often followed by `gtk_widget_grab_focus
+// (icon_view)` in order to give keyboard focus to the widget.
+// Please note that editing can only happen when the widget is realized.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetCursor(PathVar *TreePath, CellVar *CellRenderer, StartEditingVar bool) {
+
+	xIconViewSetCursor(x.GoPointer(), PathVar, CellVar.GoPointer(), StartEditingVar)
+
+}
+
+var xIconViewSetDragDestItem func(uintptr, *TreePath, IconViewDropPosition)
+
+// Sets the item that is highlighted for feedback.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetDragDestItem(PathVar *TreePath, PosVar IconViewDropPosition) {
+
+	xIconViewSetDragDestItem(x.GoPointer(), PathVar, PosVar)
+
+}
+
+var xIconViewSetItemOrientation func(uintptr, Orientation)
+
+// Sets the ::item-orientation property which determines whether the labels
+// are drawn beside the icons instead of below.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetItemOrientation(OrientationVar Orientation) {
+
+	xIconViewSetItemOrientation(x.GoPointer(), OrientationVar)
+
+}
+
+var xIconViewSetItemPadding func(uintptr, int)
+
+// Sets the `GtkIconView`:item-padding property which specifies the padding
+// around each of the icon view’s items.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetItemPadding(ItemPaddingVar int) {
+
+	xIconViewSetItemPadding(x.GoPointer(), ItemPaddingVar)
+
+}
+
+var xIconViewSetItemWidth func(uintptr, int)
+
+// Sets the ::item-width property which specifies the width
+// to use for each item. If it is set to -1, the icon view will
+// automatically determine a suitable item size.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetItemWidth(ItemWidthVar int) {
+
+	xIconViewSetItemWidth(x.GoPointer(), ItemWidthVar)
+
+}
+
+var xIconViewSetMargin func(uintptr, int)
+
+// Sets the ::margin property which specifies the space
+// which is inserted at the top, bottom, left and right
+// of the icon view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetMargin(MarginVar int) {
+
+	xIconViewSetMargin(x.GoPointer(), MarginVar)
+
+}
+
+var xIconViewSetMarkupColumn func(uintptr, int)
+
+// Sets the column with markup information for icon_view to be
+// column. The markup column must be of type `G_TYPE_STRING`.
+// If the markup column is set to something, it overrides
+// the text column set by [IconView.SetTextColumn].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetMarkupColumn(ColumnVar int) {
+
+	xIconViewSetMarkupColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xIconViewSetModel func(uintptr, uintptr)
+
+// Sets the model for a `GtkIconView`.
+// If the icon_view already has a model set, it will remove
+// it before setting the new model.  If model is nil, then
+// it will unset the old model.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetModel(ModelVar TreeModel) {
+
+	xIconViewSetModel(x.GoPointer(), ModelVar.GoPointer())
+
+}
+
+var xIconViewSetPixbufColumn func(uintptr, int)
+
+// Sets the column with pixbufs for icon_view to be column. The pixbuf
+// column must be of type `GDK_TYPE_PIXBUF`
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetPixbufColumn(ColumnVar int) {
+
+	xIconViewSetPixbufColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xIconViewSetReorderable func(uintptr, bool)
+
+// This function is a convenience function to allow you to reorder models that
+// support the `GtkTreeDragSourceIface` and the `GtkTreeDragDestIface`. Both
+// `GtkTreeStore` and `GtkListStore` support these. If reorderable is true, then
+// the user can reorder the model by dragging and dropping rows.  The
+// developer can listen to these changes by connecting to the model's
+// row_inserted and row_deleted signals. The reordering is implemented by setting up
+// the icon view as a drag source and destination. Therefore, drag and
+// drop can not be used in a reorderable view for any other purpose.
+//
+// This function does not give you any degree of control over the order -- any
+// reordering is allowed.  If more control is needed, you should probably
+// handle drag and drop manually.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetReorderable(ReorderableVar bool) {
+
+	xIconViewSetReorderable(x.GoPointer(), ReorderableVar)
+
+}
+
+var xIconViewSetRowSpacing func(uintptr, int)
+
+// Sets the ::row-spacing property which specifies the space
+// which is inserted between the rows of the icon view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetRowSpacing(RowSpacingVar int) {
+
+	xIconViewSetRowSpacing(x.GoPointer(), RowSpacingVar)
+
+}
+
+var xIconViewSetSelectionMode func(uintptr, SelectionMode)
+
+// Sets the selection mode of the icon_view.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetSelectionMode(ModeVar SelectionMode) {
+
+	xIconViewSetSelectionMode(x.GoPointer(), ModeVar)
+
+}
+
+var xIconViewSetSpacing func(uintptr, int)
+
+// Sets the ::spacing property which specifies the space
+// which is inserted between the cells (i.e. the icon and
+// the text) of an item.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetSpacing(SpacingVar int) {
+
+	xIconViewSetSpacing(x.GoPointer(), SpacingVar)
+
+}
+
+var xIconViewSetTextColumn func(uintptr, int)
+
+// Sets the column with text for icon_view to be column. The text
+// column must be of type `G_TYPE_STRING`.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetTextColumn(ColumnVar int) {
+
+	xIconViewSetTextColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xIconViewSetTooltipCell func(uintptr, uintptr, *TreePath, uintptr)
+
+// Sets the tip area of tooltip to the area which cell occupies in
+// the item pointed to by path. See also [Tooltip.SetTipArea].
+//
+// See also [IconView.SetTooltipColumn] for a simpler alternative.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetTooltipCell(TooltipVar *Tooltip, PathVar *TreePath, CellVar *CellRenderer) {
+
+	xIconViewSetTooltipCell(x.GoPointer(), TooltipVar.GoPointer(), PathVar, CellVar.GoPointer())
+
+}
+
+var xIconViewSetTooltipColumn func(uintptr, int)
+
+// If you only plan to have simple (text-only) tooltips on full items, you
+// can use this function to have `GtkIconView` handle these automatically
+// for you. column should be set to the column in icon_view’s model
+// containing the tooltip texts, or -1 to disable this feature.
+//
+// When enabled, `GtkWidget:has-tooltip` will be set to true and
+// icon_view will connect a `GtkWidget::query-tooltip` signal handler.
+//
+// Note that the signal handler sets the text with [Tooltip.SetMarkup],
+// so &, <, etc have to be escaped in the text.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetTooltipColumn(ColumnVar int) {
+
+	xIconViewSetTooltipColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xIconViewSetTooltipItem func(uintptr, uintptr, *TreePath)
+
+// Sets the tip area of tooltip to be the area covered by the item at path.
+// See also [IconView.SetTooltipColumn] for a simpler alternative.
+// See also [Tooltip.SetTipArea].
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) SetTooltipItem(TooltipVar *Tooltip, PathVar *TreePath) {
+
+	xIconViewSetTooltipItem(x.GoPointer(), TooltipVar.GoPointer(), PathVar)
+
+}
+
+var xIconViewUnselectAll func(uintptr)
+
+// Unselects all the icons.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) UnselectAll() {
+
+	xIconViewUnselectAll(x.GoPointer())
+
+}
+
+var xIconViewUnselectPath func(uintptr, *TreePath)
+
+// Unselects the row at path.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) UnselectPath(PathVar *TreePath) {
+
+	xIconViewUnselectPath(x.GoPointer(), PathVar)
+
+}
+
+var xIconViewUnsetModelDragDest func(uintptr)
+
+// Undoes the effect of [IconView.EnableModelDragDest]. Calling this
+// method sets `GtkIconView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) UnsetModelDragDest() {
+
+	xIconViewUnsetModelDragDest(x.GoPointer())
+
+}
+
+var xIconViewUnsetModelDragSource func(uintptr)
+
+// Undoes the effect of [IconView.EnableModelDragSource]. Calling this
+// method sets `GtkIconView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [GridView] instead
+func (x *IconView) UnsetModelDragSource() {
+
+	xIconViewUnsetModelDragSource(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewIconView, libs, "gtk_icon_view_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewIconViewWithArea, libs, "gtk_icon_view_new_with_area", "GTK", "")
+	core.PuregoRegisterSince(&xNewIconViewWithModel, libs, "gtk_icon_view_new_with_model", "GTK", "")
+
+	core.PuregoRegisterSince(&xIconViewCreateDragIcon, libs, "gtk_icon_view_create_drag_icon", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewEnableModelDragDest, libs, "gtk_icon_view_enable_model_drag_dest", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewEnableModelDragSource, libs, "gtk_icon_view_enable_model_drag_source", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetActivateOnSingleClick, libs, "gtk_icon_view_get_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetCellRect, libs, "gtk_icon_view_get_cell_rect", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetColumnSpacing, libs, "gtk_icon_view_get_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetColumns, libs, "gtk_icon_view_get_columns", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetCursor, libs, "gtk_icon_view_get_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetDestItemAtPos, libs, "gtk_icon_view_get_dest_item_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetDragDestItem, libs, "gtk_icon_view_get_drag_dest_item", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemAtPos, libs, "gtk_icon_view_get_item_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemColumn, libs, "gtk_icon_view_get_item_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemOrientation, libs, "gtk_icon_view_get_item_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemPadding, libs, "gtk_icon_view_get_item_padding", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemRow, libs, "gtk_icon_view_get_item_row", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetItemWidth, libs, "gtk_icon_view_get_item_width", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetMargin, libs, "gtk_icon_view_get_margin", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetMarkupColumn, libs, "gtk_icon_view_get_markup_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetModel, libs, "gtk_icon_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetPathAtPos, libs, "gtk_icon_view_get_path_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetPixbufColumn, libs, "gtk_icon_view_get_pixbuf_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetReorderable, libs, "gtk_icon_view_get_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetRowSpacing, libs, "gtk_icon_view_get_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetSelectedItems, libs, "gtk_icon_view_get_selected_items", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetSelectionMode, libs, "gtk_icon_view_get_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetSpacing, libs, "gtk_icon_view_get_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetTextColumn, libs, "gtk_icon_view_get_text_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetTooltipColumn, libs, "gtk_icon_view_get_tooltip_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetTooltipContext, libs, "gtk_icon_view_get_tooltip_context", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewGetVisibleRange, libs, "gtk_icon_view_get_visible_range", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewItemActivated, libs, "gtk_icon_view_item_activated", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewPathIsSelected, libs, "gtk_icon_view_path_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewScrollToPath, libs, "gtk_icon_view_scroll_to_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSelectAll, libs, "gtk_icon_view_select_all", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSelectPath, libs, "gtk_icon_view_select_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSelectedForeach, libs, "gtk_icon_view_selected_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetActivateOnSingleClick, libs, "gtk_icon_view_set_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetColumnSpacing, libs, "gtk_icon_view_set_column_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetColumns, libs, "gtk_icon_view_set_columns", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetCursor, libs, "gtk_icon_view_set_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetDragDestItem, libs, "gtk_icon_view_set_drag_dest_item", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetItemOrientation, libs, "gtk_icon_view_set_item_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetItemPadding, libs, "gtk_icon_view_set_item_padding", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetItemWidth, libs, "gtk_icon_view_set_item_width", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetMargin, libs, "gtk_icon_view_set_margin", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetMarkupColumn, libs, "gtk_icon_view_set_markup_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetModel, libs, "gtk_icon_view_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetPixbufColumn, libs, "gtk_icon_view_set_pixbuf_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetReorderable, libs, "gtk_icon_view_set_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetRowSpacing, libs, "gtk_icon_view_set_row_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetSelectionMode, libs, "gtk_icon_view_set_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetSpacing, libs, "gtk_icon_view_set_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetTextColumn, libs, "gtk_icon_view_set_text_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetTooltipCell, libs, "gtk_icon_view_set_tooltip_cell", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetTooltipColumn, libs, "gtk_icon_view_set_tooltip_column", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewSetTooltipItem, libs, "gtk_icon_view_set_tooltip_item", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewUnselectAll, libs, "gtk_icon_view_unselect_all", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewUnselectPath, libs, "gtk_icon_view_unselect_path", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewUnsetModelDragDest, libs, "gtk_icon_view_unset_model_drag_dest", "GTK", "")
+	core.PuregoRegisterSince(&xIconViewUnsetModelDragSource, libs, "gtk_icon_view_unset_model_drag_source", "GTK", "")
+
+}
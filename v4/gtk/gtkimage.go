@@ -2,23 +2,23 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
-	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Describes the image data representation used by a [class@Gtk.Image].
+// Describes the image data representation used by a [Image].
 //
 // If you want to get the image from the widget, you can only get the
-// currently-stored representation; for instance, if the gtk_image_get_storage_type()
-// returns %GTK_IMAGE_PAINTABLE, then you can call gtk_image_get_paintable().
+// currently-stored representation; for instance, if the [Image.GetStorageType]
+// returns [ImagePaintableValue], then you can call [Image.GetPaintable].
 //
 // For empty images, you can request any storage type (call any of the "get"
-// functions), but they will all return %NULL values.
+// functions), but they will all return nil values.
 type ImageType int
 
 var xImageTypeGLibType func() types.GType
@@ -39,22 +39,58 @@ const (
 	ImagePaintableValue ImageType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ImageType) String() string {
+	switch x {
+	case ImageEmptyValue:
+		return "GTK_IMAGE_EMPTY"
+	case ImageIconNameValue:
+		return "GTK_IMAGE_ICON_NAME"
+	case ImageGiconValue:
+		return "GTK_IMAGE_GICON"
+	case ImagePaintableValue:
+		return "GTK_IMAGE_PAINTABLE"
+	}
+	return fmt.Sprintf("ImageType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ImageType) Nick() string {
+	switch x {
+	case ImageEmptyValue:
+		return "empty"
+	case ImageIconNameValue:
+		return "icon-name"
+	case ImageGiconValue:
+		return "gicon"
+	case ImagePaintableValue:
+		return "paintable"
+	}
+	return ""
+}
+
+// ImageTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ImageTypeFromString(s string) (ImageType, bool) {
+	switch s {
+	case "GTK_IMAGE_EMPTY", "empty":
+		return ImageEmptyValue, true
+	case "GTK_IMAGE_ICON_NAME", "icon-name":
+		return ImageIconNameValue, true
+	case "GTK_IMAGE_GICON", "gicon":
+		return ImageGiconValue, true
+	case "GTK_IMAGE_PAINTABLE", "paintable":
+		return ImagePaintableValue, true
+	}
+	return 0, false
+}
+
 // Displays an image.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="image-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkImage" src="image.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Various kinds of object can be displayed as an image; most typically,
 // you would load a `GdkTexture` from a file, using the convenience function
-// [ctor@Gtk.Image.new_from_file], for instance:
+// [NewImageFromFile], for instance:
 //
-// ```c
-// GtkWidget *image = gtk_image_new_from_file ("myfile.png");
-// ```
+//	GtkWidget *image = gtk_image_new_from_file ("myfile.png");
 //
 // If the file isn’t loaded successfully, the image will contain a
 // “broken image” icon similar to that used in many web browsers.
@@ -62,27 +98,27 @@ const (
 // If you want to handle errors in loading the file yourself, for example
 // by displaying an error message, then load the image with an image
 // loading framework such as libglycin, then create the `GtkImage` with
-// [ctor@Gtk.Image.new_from_paintable].
+// [NewImageFromPaintable].
 //
 // Sometimes an application will want to avoid depending on external data
 // files, such as image files. See the documentation of `GResource` inside
-// GIO, for details. In this case, [property@Gtk.Image:resource],
-// [ctor@Gtk.Image.new_from_resource], and [method@Gtk.Image.set_from_resource]
+// GIO, for details. In this case, [Image.GetPropertyResource],
+// [NewImageFromResource], and [Image.SetFromResource]
 // should be used.
 //
 // `GtkImage` displays its image as an icon, with a size that is determined
-// by the application. See [class@Gtk.Picture] if you want to show an image
+// by the application. See [Picture] if you want to show an image
 // at is actual size.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkImage` has a single CSS node with the name `image`. The style classes
 // `.normal-icons` or `.large-icons` may appear, depending on the
-// [property@Gtk.Image:icon-size] property.
+// Image:icon-size property.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkImage` uses the [enum@Gtk.AccessibleRole.img] role.
+// `GtkImage` uses the [AccessibleRoleImgValue] role.
 type Image struct {
 	Widget
 }
@@ -118,17 +154,17 @@ func NewImage() *Image {
 
 var xNewImageFromFile func(string) uintptr
 
-// Creates a new `GtkImage` displaying the file @filename.
+// Creates a new `GtkImage` displaying the file filename.
 //
 // If the file isn’t found or can’t be loaded, the resulting `GtkImage`
-// will display a “broken image” icon. This function never returns %NULL,
+// will display a “broken image” icon. This function never returns nil,
 // it always returns a valid `GtkImage` widget.
 //
 // If you need to detect failures to load the file, use an
 // image loading framework such as libglycin to load the file
 // yourself, then create the `GtkImage` from the texture.
 //
-// The storage type (see [method@Gtk.Image.get_storage_type])
+// The storage type (see [Image.GetStorageType])
 // of the returned image is not defined, it will be whatever
 // is appropriate for displaying the file.
 func NewImageFromFile(FilenameVar string) *Image {
@@ -192,20 +228,20 @@ func NewImageFromIconName(IconNameVar *string) *Image {
 
 var xNewImageFromPaintable func(uintptr) uintptr
 
-// Creates a new `GtkImage` displaying @paintable.
+// Creates a new `GtkImage` displaying paintable.
 //
 // The `GtkImage` does not assume a reference to the paintable; you still
 // need to unref it if you own references. `GtkImage` will add its own
 // reference rather than adopting yours.
 //
-// The `GtkImage` will track changes to the @paintable and update
+// The `GtkImage` will track changes to the paintable and update
 // its size and contents in response to it.
 //
 // Note that paintables are still subject to the icon size that is
 // set on the image. If you want to display a paintable at its intrinsic
-// size, use [class@Gtk.Picture] instead.
+// size, use [Picture] instead.
 //
-// If @paintable is a [iface@Gtk.SymbolicPaintable], then it will be
+// If paintable is a [SymbolicPaintable], then it will be
 // recolored with the symbolic palette from the theme.
 func NewImageFromPaintable(PaintableVar gdk.Paintable) *Image {
 	var cls *Image
@@ -221,47 +257,19 @@ func NewImageFromPaintable(PaintableVar gdk.Paintable) *Image {
 	return cls
 }
 
-var xNewImageFromPixbuf func(uintptr) uintptr
-
-// Creates a new `GtkImage` displaying @pixbuf.
-//
-// The `GtkImage` does not assume a reference to the pixbuf; you still
-// need to unref it if you own references. `GtkImage` will add its own
-// reference rather than adopting yours.
-//
-// This is a helper for [ctor@Gtk.Image.new_from_paintable], and you can't
-// get back the exact pixbuf once this is called, only a texture.
-//
-// Note that this function just creates an `GtkImage` from the pixbuf.
-// The `GtkImage` created will not react to state changes. Should you
-// want that, you should use [ctor@Gtk.Image.new_from_icon_name].
-func NewImageFromPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Image {
-	var cls *Image
-
-	cret := xNewImageFromPixbuf(PixbufVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Image{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xNewImageFromResource func(string) uintptr
 
-// Creates a new `GtkImage` displaying the resource file @resource_path.
+// Creates a new `GtkImage` displaying the resource file resource_path.
 //
 // If the file isn’t found or can’t be loaded, the resulting `GtkImage` will
-// display a “broken image” icon. This function never returns %NULL,
+// display a “broken image” icon. This function never returns nil,
 // it always returns a valid `GtkImage` widget.
 //
 // If you need to detect failures to load the file, use an
 // image loading framework such as libglycin to load the file
 // yourself, then create the `GtkImage` from the texture.
 //
-// The storage type (see [method@Gtk.Image.get_storage_type]) of
+// The storage type (see [Image.GetStorageType]) of
 // the returned image is not defined, it will be whatever is
 // appropriate for displaying the file.
 func NewImageFromResource(ResourcePathVar string) *Image {
@@ -291,8 +299,8 @@ var xImageGetGicon func(uintptr) uintptr
 
 // Gets the `GIcon` being displayed by the `GtkImage`.
 //
-// The storage type of the image must be %GTK_IMAGE_EMPTY or
-// %GTK_IMAGE_GICON (see [method@Gtk.Image.get_storage_type]).
+// The storage type of the image must be [ImageEmptyValue] or
+// [ImageGiconValue] (see [Image.GetStorageType]).
 // The caller of this function does not own a reference to the
 // returned `GIcon`.
 func (x *Image) GetGicon() *gio.IconBase {
@@ -313,8 +321,8 @@ var xImageGetIconName func(uintptr) string
 
 // Gets the icon name and size being displayed by the `GtkImage`.
 //
-// The storage type of the image must be %GTK_IMAGE_EMPTY or
-// %GTK_IMAGE_ICON_NAME (see [method@Gtk.Image.get_storage_type]).
+// The storage type of the image must be [ImageEmptyValue] or
+// [ImageIconNameValue] (see [Image.GetStorageType]).
 // The returned string is owned by the `GtkImage` and should not
 // be freed.
 func (x *Image) GetIconName() string {
@@ -325,7 +333,7 @@ func (x *Image) GetIconName() string {
 
 var xImageGetIconSize func(uintptr) IconSize
 
-// Gets the icon size used by the @image when rendering icons.
+// Gets the icon size used by the image when rendering icons.
 func (x *Image) GetIconSize() IconSize {
 
 	cret := xImageGetIconSize(x.GoPointer())
@@ -336,8 +344,8 @@ var xImageGetPaintable func(uintptr) uintptr
 
 // Gets the image `GdkPaintable` being displayed by the `GtkImage`.
 //
-// The storage type of the image must be %GTK_IMAGE_EMPTY or
-// %GTK_IMAGE_PAINTABLE (see [method@Gtk.Image.get_storage_type]).
+// The storage type of the image must be [ImageEmptyValue] or
+// [ImagePaintableValue] (see [Image.GetStorageType]).
 // The caller of this function does not own a reference to the
 // returned paintable.
 func (x *Image) GetPaintable() *gdk.PaintableBase {
@@ -369,7 +377,7 @@ var xImageGetStorageType func(uintptr) ImageType
 // to store image data.
 //
 // If the `GtkImage` has no image data, the return value will
-// be %GTK_IMAGE_EMPTY.
+// be [ImageEmptyValue].
 func (x *Image) GetStorageType() ImageType {
 
 	cret := xImageGetStorageType(x.GoPointer())
@@ -380,14 +388,14 @@ var xImageSetFromFile func(uintptr, uintptr)
 
 // Sets a `GtkImage` to show a file.
 //
-// See [ctor@Gtk.Image.new_from_file] for details.
+// See [NewImageFromFile] for details.
 //
 // ::: warning
 //
 //	Note that this function should not be used with untrusted data.
 //	Use a proper image loading framework such as libglycin, which can
 //	load many image formats into a `GdkTexture`, and then use
-//	[method@Gtk.Image.set_from_paintable].
+//	[Image.SetFromPaintable].
 func (x *Image) SetFromFile(FilenameVar *string) {
 
 	FilenameVarPtr := core.GStrdupNullable(FilenameVar)
@@ -401,7 +409,7 @@ var xImageSetFromGicon func(uintptr, uintptr)
 
 // Sets a `GtkImage` to show a `GIcon`.
 //
-// See [ctor@Gtk.Image.new_from_gicon] for details.
+// See [NewImageFromGicon] for details.
 func (x *Image) SetFromGicon(IconVar gio.Icon) {
 
 	xImageSetFromGicon(x.GoPointer(), IconVar.GoPointer())
@@ -412,7 +420,7 @@ var xImageSetFromIconName func(uintptr, uintptr)
 
 // Sets a `GtkImage` to show a named icon.
 //
-// See [ctor@Gtk.Image.new_from_icon_name] for details.
+// See [NewImageFromIconName] for details.
 func (x *Image) SetFromIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -426,33 +434,18 @@ var xImageSetFromPaintable func(uintptr, uintptr)
 
 // Sets a `GtkImage` to show a `GdkPaintable`.
 //
-// See [ctor@Gtk.Image.new_from_paintable] for details.
+// See [NewImageFromPaintable] for details.
 func (x *Image) SetFromPaintable(PaintableVar gdk.Paintable) {
 
 	xImageSetFromPaintable(x.GoPointer(), PaintableVar.GoPointer())
 
 }
 
-var xImageSetFromPixbuf func(uintptr, uintptr)
-
-// Sets a `GtkImage` to show a `GdkPixbuf`.
-//
-// See [ctor@Gtk.Image.new_from_pixbuf] for details.
-//
-// Note: This is a helper for [method@Gtk.Image.set_from_paintable],
-// and you can't get back the exact pixbuf once this is called,
-// only a paintable.
-func (x *Image) SetFromPixbuf(PixbufVar *gdkpixbuf.Pixbuf) {
-
-	xImageSetFromPixbuf(x.GoPointer(), PixbufVar.GoPointer())
-
-}
-
 var xImageSetFromResource func(uintptr, uintptr)
 
 // Sets a `GtkImage` to show a resource.
 //
-// See [ctor@Gtk.Image.new_from_resource] for details.
+// See [NewImageFromResource] for details.
 func (x *Image) SetFromResource(ResourcePathVar *string) {
 
 	ResourcePathVarPtr := core.GStrdupNullable(ResourcePathVar)
@@ -476,7 +469,7 @@ var xImageSetPixelSize func(uintptr, int)
 // Sets the pixel size to use for named icons.
 //
 // If the pixel size is set to a value != -1, it is used instead
-// of the icon size set by [method@Gtk.Image.set_icon_size].
+// of the icon size set by [Image.SetIconSize].
 func (x *Image) SetPixelSize(PixelSizeVar int) {
 
 	xImageSetPixelSize(x.GoPointer(), PixelSizeVar)
@@ -536,7 +529,7 @@ func (x *Image) GetPropertyIconName() string {
 // The size in pixels to display icons at.
 //
 // If set to a value != -1, this property overrides the
-// [property@Gtk.Image:icon-size] property for images of type
+// Image:icon-size property for images of type
 // `GTK_IMAGE_ICON_NAME`.
 func (x *Image) SetPropertyPixelSize(value int) {
 	var v gobject.Value
@@ -549,7 +542,7 @@ func (x *Image) SetPropertyPixelSize(value int) {
 // The size in pixels to display icons at.
 //
 // If set to a value != -1, this property overrides the
-// [property@Gtk.Image:icon-size] property for images of type
+// Image:icon-size property for images of type
 // `GTK_IMAGE_ICON_NAME`.
 func (x *Image) GetPropertyPixelSize() int {
 	var v gobject.Value
@@ -579,7 +572,7 @@ func (x *Image) GetPropertyResource() string {
 // standard icon names fallback.
 //
 // The value of this property is only relevant for images of type
-// %GTK_IMAGE_ICON_NAME and %GTK_IMAGE_GICON.
+// [ImageIconNameValue] and [ImageGiconValue].
 func (x *Image) SetPropertyUseFallback(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -592,7 +585,7 @@ func (x *Image) SetPropertyUseFallback(value bool) {
 // standard icon names fallback.
 //
 // The value of this property is only relevant for images of type
-// %GTK_IMAGE_ICON_NAME and %GTK_IMAGE_GICON.
+// [ImageIconNameValue] and [ImageGiconValue].
 func (x *Image) GetPropertyUseFallback() bool {
 	var v gobject.Value
 	x.GetProperty("use-fallback", &v)
@@ -760,7 +753,7 @@ func (x *Image) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -768,14 +761,13 @@ func (x *Image) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Image) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -799,19 +791,17 @@ func (x *Image) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Image) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -832,7 +822,7 @@ func (x *Image) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -841,14 +831,12 @@ func (x *Image) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Image) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -867,10 +855,10 @@ func (x *Image) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Image) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -882,7 +870,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -893,28 +881,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xImageGLibType, libs, "gtk_image_get_type")
 
-	core.PuregoSafeRegister(&xNewImage, libs, "gtk_image_new")
-	core.PuregoSafeRegister(&xNewImageFromFile, libs, "gtk_image_new_from_file")
-	core.PuregoSafeRegister(&xNewImageFromGicon, libs, "gtk_image_new_from_gicon")
-	core.PuregoSafeRegister(&xNewImageFromIconName, libs, "gtk_image_new_from_icon_name")
-	core.PuregoSafeRegister(&xNewImageFromPaintable, libs, "gtk_image_new_from_paintable")
-	core.PuregoSafeRegister(&xNewImageFromPixbuf, libs, "gtk_image_new_from_pixbuf")
-	core.PuregoSafeRegister(&xNewImageFromResource, libs, "gtk_image_new_from_resource")
-
-	core.PuregoSafeRegister(&xImageClear, libs, "gtk_image_clear")
-	core.PuregoSafeRegister(&xImageGetGicon, libs, "gtk_image_get_gicon")
-	core.PuregoSafeRegister(&xImageGetIconName, libs, "gtk_image_get_icon_name")
-	core.PuregoSafeRegister(&xImageGetIconSize, libs, "gtk_image_get_icon_size")
-	core.PuregoSafeRegister(&xImageGetPaintable, libs, "gtk_image_get_paintable")
-	core.PuregoSafeRegister(&xImageGetPixelSize, libs, "gtk_image_get_pixel_size")
-	core.PuregoSafeRegister(&xImageGetStorageType, libs, "gtk_image_get_storage_type")
-	core.PuregoSafeRegister(&xImageSetFromFile, libs, "gtk_image_set_from_file")
-	core.PuregoSafeRegister(&xImageSetFromGicon, libs, "gtk_image_set_from_gicon")
-	core.PuregoSafeRegister(&xImageSetFromIconName, libs, "gtk_image_set_from_icon_name")
-	core.PuregoSafeRegister(&xImageSetFromPaintable, libs, "gtk_image_set_from_paintable")
-	core.PuregoSafeRegister(&xImageSetFromPixbuf, libs, "gtk_image_set_from_pixbuf")
-	core.PuregoSafeRegister(&xImageSetFromResource, libs, "gtk_image_set_from_resource")
-	core.PuregoSafeRegister(&xImageSetIconSize, libs, "gtk_image_set_icon_size")
-	core.PuregoSafeRegister(&xImageSetPixelSize, libs, "gtk_image_set_pixel_size")
+	core.PuregoRegisterSince(&xNewImage, libs, "gtk_image_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewImageFromFile, libs, "gtk_image_new_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewImageFromGicon, libs, "gtk_image_new_from_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xNewImageFromIconName, libs, "gtk_image_new_from_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xNewImageFromPaintable, libs, "gtk_image_new_from_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xNewImageFromResource, libs, "gtk_image_new_from_resource", "GTK", "")
+
+	core.PuregoRegisterSince(&xImageClear, libs, "gtk_image_clear", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetGicon, libs, "gtk_image_get_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetIconName, libs, "gtk_image_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetIconSize, libs, "gtk_image_get_icon_size", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetPaintable, libs, "gtk_image_get_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetPixelSize, libs, "gtk_image_get_pixel_size", "GTK", "")
+	core.PuregoRegisterSince(&xImageGetStorageType, libs, "gtk_image_get_storage_type", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetFromFile, libs, "gtk_image_set_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetFromGicon, libs, "gtk_image_set_from_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetFromIconName, libs, "gtk_image_set_from_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetFromPaintable, libs, "gtk_image_set_from_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetFromResource, libs, "gtk_image_set_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetIconSize, libs, "gtk_image_set_icon_size", "GTK", "")
+	core.PuregoRegisterSince(&xImageSetPixelSize, libs, "gtk_image_set_pixel_size", "GTK", "")
 
 }
@@ -0,0 +1,77 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewImageFromPixbuf func(uintptr) uintptr
+
+// Creates a new `GtkImage` displaying pixbuf.
+//
+// The `GtkImage` does not assume a reference to the pixbuf; you still
+// need to unref it if you own references. `GtkImage` will add its own
+// reference rather than adopting yours.
+//
+// This is a helper for [NewImageFromPaintable], and you can't
+// get back the exact pixbuf once this is called, only a texture.
+//
+// Note that this function just creates an `GtkImage` from the pixbuf.
+// The `GtkImage` created will not react to state changes. Should you
+// want that, you should use [NewImageFromIconName].
+//
+// Deprecated: since 4.12. Use [NewImageFromPaintable] and
+//
+//	[gdk.NewTextureForPixbuf] instead
+func NewImageFromPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Image {
+	var cls *Image
+
+	cret := xNewImageFromPixbuf(PixbufVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Image{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xImageSetFromPixbuf func(uintptr, uintptr)
+
+// Sets a `GtkImage` to show a `GdkPixbuf`.
+//
+// See [NewImageFromPixbuf] for details.
+//
+// Note: This is a helper for [Image.SetFromPaintable],
+// and you can't get back the exact pixbuf once this is called,
+// only a paintable.
+//
+// Deprecated: since 4.12. Use [Image.SetFromPaintable] instead
+func (x *Image) SetFromPixbuf(PixbufVar *gdkpixbuf.Pixbuf) {
+
+	xImageSetFromPixbuf(x.GoPointer(), PixbufVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewImageFromPixbuf, libs, "gtk_image_new_from_pixbuf", "GTK", "")
+
+	core.PuregoRegisterSince(&xImageSetFromPixbuf, libs, "gtk_image_set_from_pixbuf", "GTK", "")
+
+}
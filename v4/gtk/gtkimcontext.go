@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -71,71 +70,74 @@ func (x *IMContextClass) GoPointer() uintptr {
 }
 
 // OverridePreeditStart sets the "preedit_start" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-start] signal.
+// Default handler of the [IMContext.ConnectPreeditStartFunc] signal.
 func (x *IMContextClass) OverridePreeditStart(cb func(*IMContext)) {
 	if cb == nil {
 		x.xPreeditStart = 0
 	} else {
-		x.xPreeditStart = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xPreeditStart = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetPreeditStart gets the "preedit_start" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-start] signal.
+// Default handler of the [IMContext.ConnectPreeditStartFunc] signal.
 func (x *IMContextClass) GetPreeditStart() func(*IMContext) {
 	if x.xPreeditStart == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPreeditStart)
+	core.RegisterFunc(&rawCallback, x.xPreeditStart)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverridePreeditEnd sets the "preedit_end" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-end] signal.
+// Default handler of the [IMContext.ConnectPreeditEndFunc] signal.
 func (x *IMContextClass) OverridePreeditEnd(cb func(*IMContext)) {
 	if cb == nil {
 		x.xPreeditEnd = 0
 	} else {
-		x.xPreeditEnd = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xPreeditEnd = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetPreeditEnd gets the "preedit_end" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-end] signal.
+// Default handler of the [IMContext.ConnectPreeditEndFunc] signal.
 func (x *IMContextClass) GetPreeditEnd() func(*IMContext) {
 	if x.xPreeditEnd == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPreeditEnd)
+	core.RegisterFunc(&rawCallback, x.xPreeditEnd)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverridePreeditChanged sets the "preedit_changed" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-changed]
+// Default handler of the [IMContext.ConnectPreeditChangedFunc]
 //
 //	signal.
 func (x *IMContextClass) OverridePreeditChanged(cb func(*IMContext)) {
 	if cb == nil {
 		x.xPreeditChanged = 0
 	} else {
-		x.xPreeditChanged = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xPreeditChanged = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetPreeditChanged gets the "preedit_changed" callback function.
-// Default handler of the [signal@Gtk.IMContext::preedit-changed]
+// Default handler of the [IMContext.ConnectPreeditChangedFunc]
 //
 //	signal.
 func (x *IMContextClass) GetPreeditChanged() func(*IMContext) {
@@ -143,32 +145,33 @@ func (x *IMContextClass) GetPreeditChanged() func(*IMContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPreeditChanged)
+	core.RegisterFunc(&rawCallback, x.xPreeditChanged)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverrideCommit sets the "commit" callback function.
-// Default handler of the [signal@Gtk.IMContext::commit] signal.
+// Default handler of the [IMContext.ConnectCommitFunc] signal.
 func (x *IMContextClass) OverrideCommit(cb func(*IMContext, string)) {
 	if cb == nil {
 		x.xCommit = 0
 	} else {
-		x.xCommit = purego.NewCallback(func(ContextVarp uintptr, StrVarp string) {
+		x.xCommit = core.NewCallback(func(ContextVarp uintptr, StrVarp string) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), StrVarp)
 		})
 	}
 }
 
 // GetCommit gets the "commit" callback function.
-// Default handler of the [signal@Gtk.IMContext::commit] signal.
+// Default handler of the [IMContext.ConnectCommitFunc] signal.
 func (x *IMContextClass) GetCommit() func(*IMContext, string) {
 	if x.xCommit == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, StrVarp string)
-	purego.RegisterFunc(&rawCallback, x.xCommit)
+	core.RegisterFunc(&rawCallback, x.xCommit)
 	return func(ContextVar *IMContext, StrVar string) {
 		rawCallback(ContextVar.GoPointer(), StrVar)
 	}
@@ -177,12 +180,13 @@ func (x *IMContextClass) GetCommit() func(*IMContext, string) {
 // OverrideRetrieveSurrounding sets the "retrieve_surrounding" callback function.
 // Default handler of the
 //
-//	[signal@Gtk.IMContext::retrieve-surrounding] signal.
+//	[IMContext.ConnectRetrieveSurroundingFunc] signal.
 func (x *IMContextClass) OverrideRetrieveSurrounding(cb func(*IMContext) bool) {
 	if cb == nil {
 		x.xRetrieveSurrounding = 0
 	} else {
-		x.xRetrieveSurrounding = purego.NewCallback(func(ContextVarp uintptr) bool {
+		x.xRetrieveSurrounding = core.NewCallback(func(ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -191,13 +195,13 @@ func (x *IMContextClass) OverrideRetrieveSurrounding(cb func(*IMContext) bool) {
 // GetRetrieveSurrounding gets the "retrieve_surrounding" callback function.
 // Default handler of the
 //
-//	[signal@Gtk.IMContext::retrieve-surrounding] signal.
+//	[IMContext.ConnectRetrieveSurroundingFunc] signal.
 func (x *IMContextClass) GetRetrieveSurrounding() func(*IMContext) bool {
 	if x.xRetrieveSurrounding == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xRetrieveSurrounding)
+	core.RegisterFunc(&rawCallback, x.xRetrieveSurrounding)
 	return func(ContextVar *IMContext) bool {
 		return rawCallback(ContextVar.GoPointer())
 	}
@@ -206,12 +210,13 @@ func (x *IMContextClass) GetRetrieveSurrounding() func(*IMContext) bool {
 // OverrideDeleteSurrounding sets the "delete_surrounding" callback function.
 // Default handler of the
 //
-//	[signal@Gtk.IMContext::delete-surrounding] signal.
+//	[IMContext.ConnectDeleteSurroundingFunc] signal.
 func (x *IMContextClass) OverrideDeleteSurrounding(cb func(*IMContext, int, int) bool) {
 	if cb == nil {
 		x.xDeleteSurrounding = 0
 	} else {
-		x.xDeleteSurrounding = purego.NewCallback(func(ContextVarp uintptr, OffsetVarp int, NCharsVarp int) bool {
+		x.xDeleteSurrounding = core.NewCallback(func(ContextVarp uintptr, OffsetVarp int, NCharsVarp int) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp), OffsetVarp, NCharsVarp)
 		})
 	}
@@ -220,20 +225,20 @@ func (x *IMContextClass) OverrideDeleteSurrounding(cb func(*IMContext, int, int)
 // GetDeleteSurrounding gets the "delete_surrounding" callback function.
 // Default handler of the
 //
-//	[signal@Gtk.IMContext::delete-surrounding] signal.
+//	[IMContext.ConnectDeleteSurroundingFunc] signal.
 func (x *IMContextClass) GetDeleteSurrounding() func(*IMContext, int, int) bool {
 	if x.xDeleteSurrounding == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, OffsetVarp int, NCharsVarp int) bool
-	purego.RegisterFunc(&rawCallback, x.xDeleteSurrounding)
+	core.RegisterFunc(&rawCallback, x.xDeleteSurrounding)
 	return func(ContextVar *IMContext, OffsetVar int, NCharsVar int) bool {
 		return rawCallback(ContextVar.GoPointer(), OffsetVar, NCharsVar)
 	}
 }
 
 // OverrideSetClientWidget sets the "set_client_widget" callback function.
-// Called via [method@Gtk.IMContext.set_client_widget] when
+// Called via [IMContext.SetClientWidget] when
 //
 //	the input window where the entered text will appear changes. Override this
 //	to keep track of the current input window, for instance for the purpose of
@@ -242,14 +247,15 @@ func (x *IMContextClass) OverrideSetClientWidget(cb func(*IMContext, *Widget)) {
 	if cb == nil {
 		x.xSetClientWidget = 0
 	} else {
-		x.xSetClientWidget = purego.NewCallback(func(ContextVarp uintptr, WidgetVarp uintptr) {
+		x.xSetClientWidget = core.NewCallback(func(ContextVarp uintptr, WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
 }
 
 // GetSetClientWidget gets the "set_client_widget" callback function.
-// Called via [method@Gtk.IMContext.set_client_widget] when
+// Called via [IMContext.SetClientWidget] when
 //
 //	the input window where the entered text will appear changes. Override this
 //	to keep track of the current input window, for instance for the purpose of
@@ -259,14 +265,14 @@ func (x *IMContextClass) GetSetClientWidget() func(*IMContext, *Widget) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetClientWidget)
+	core.RegisterFunc(&rawCallback, x.xSetClientWidget)
 	return func(ContextVar *IMContext, WidgetVar *Widget) {
 		rawCallback(ContextVar.GoPointer(), WidgetVar.GoPointer())
 	}
 }
 
 // OverrideGetPreeditString sets the "get_preedit_string" callback function.
-// Called via [method@Gtk.IMContext.get_preedit_string]
+// Called via [IMContext.GetPreeditString]
 //
 //	to retrieve the text currently being preedited for display at the cursor
 //	position. Any input method which composes complex characters or any
@@ -276,14 +282,15 @@ func (x *IMContextClass) OverrideGetPreeditString(cb func(*IMContext, *string, *
 	if cb == nil {
 		x.xGetPreeditString = 0
 	} else {
-		x.xGetPreeditString = purego.NewCallback(func(ContextVarp uintptr, StrVarp *string, AttrsVarp **pango.AttrList, CursorPosVarp *int) {
+		x.xGetPreeditString = core.NewCallback(func(ContextVarp uintptr, StrVarp *string, AttrsVarp **pango.AttrList, CursorPosVarp *int) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), StrVarp, AttrsVarp, CursorPosVarp)
 		})
 	}
 }
 
 // GetGetPreeditString gets the "get_preedit_string" callback function.
-// Called via [method@Gtk.IMContext.get_preedit_string]
+// Called via [IMContext.GetPreeditString]
 //
 //	to retrieve the text currently being preedited for display at the cursor
 //	position. Any input method which composes complex characters or any
@@ -294,21 +301,21 @@ func (x *IMContextClass) GetGetPreeditString() func(*IMContext, *string, **pango
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, StrVarp *string, AttrsVarp **pango.AttrList, CursorPosVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetPreeditString)
+	core.RegisterFunc(&rawCallback, x.xGetPreeditString)
 	return func(ContextVar *IMContext, StrVar *string, AttrsVar **pango.AttrList, CursorPosVar *int) {
 		rawCallback(ContextVar.GoPointer(), StrVar, AttrsVar, CursorPosVar)
 	}
 }
 
 // OverrideFilterKeypress sets the "filter_keypress" callback function.
-// Called via [method@Gtk.IMContext.filter_keypress] on every
+// Called via [IMContext.FilterKeypress] on every
 //
 //	key press or release event. Every non-trivial input method needs to
 //	override this in order to implement the mapping from key events to text.
-//	A return value of %TRUE indicates to the caller that the event was
-//	consumed by the input method. In that case, the [signal@Gtk.IMContext::commit]
+//	A return value of true indicates to the caller that the event was
+//	consumed by the input method. In that case, the [IMContext.ConnectCommitFunc]
 //	signal should be emitted upon completion of a key sequence to pass the
-//	resulting text back to the input widget. Alternatively, %FALSE may be
+//	resulting text back to the input widget. Alternatively, false may be
 //	returned to indicate that the event wasn’t handled by the input method.
 //	If a builtin mapping exists for the key, it is used to produce a
 //	character.
@@ -316,21 +323,22 @@ func (x *IMContextClass) OverrideFilterKeypress(cb func(*IMContext, *gdk.Event)
 	if cb == nil {
 		x.xFilterKeypress = 0
 	} else {
-		x.xFilterKeypress = purego.NewCallback(func(ContextVarp uintptr, EventVarp uintptr) bool {
+		x.xFilterKeypress = core.NewCallback(func(ContextVarp uintptr, EventVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp), gdk.EventNewFromInternalPtr(EventVarp))
 		})
 	}
 }
 
 // GetFilterKeypress gets the "filter_keypress" callback function.
-// Called via [method@Gtk.IMContext.filter_keypress] on every
+// Called via [IMContext.FilterKeypress] on every
 //
 //	key press or release event. Every non-trivial input method needs to
 //	override this in order to implement the mapping from key events to text.
-//	A return value of %TRUE indicates to the caller that the event was
-//	consumed by the input method. In that case, the [signal@Gtk.IMContext::commit]
+//	A return value of true indicates to the caller that the event was
+//	consumed by the input method. In that case, the [IMContext.ConnectCommitFunc]
 //	signal should be emitted upon completion of a key sequence to pass the
-//	resulting text back to the input widget. Alternatively, %FALSE may be
+//	resulting text back to the input widget. Alternatively, false may be
 //	returned to indicate that the event wasn’t handled by the input method.
 //	If a builtin mapping exists for the key, it is used to produce a
 //	character.
@@ -339,28 +347,29 @@ func (x *IMContextClass) GetFilterKeypress() func(*IMContext, *gdk.Event) bool {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, EventVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xFilterKeypress)
+	core.RegisterFunc(&rawCallback, x.xFilterKeypress)
 	return func(ContextVar *IMContext, EventVar *gdk.Event) bool {
 		return rawCallback(ContextVar.GoPointer(), EventVar.GoPointer())
 	}
 }
 
 // OverrideFocusIn sets the "focus_in" callback function.
-// Called via [method@Gtk.IMContext.focus_in] when the input widget
+// Called via [IMContext.FocusIn] when the input widget
 //
 //	has gained focus. May be overridden to keep track of the current focus.
 func (x *IMContextClass) OverrideFocusIn(cb func(*IMContext)) {
 	if cb == nil {
 		x.xFocusIn = 0
 	} else {
-		x.xFocusIn = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xFocusIn = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetFocusIn gets the "focus_in" callback function.
-// Called via [method@Gtk.IMContext.focus_in] when the input widget
+// Called via [IMContext.FocusIn] when the input widget
 //
 //	has gained focus. May be overridden to keep track of the current focus.
 func (x *IMContextClass) GetFocusIn() func(*IMContext) {
@@ -368,28 +377,29 @@ func (x *IMContextClass) GetFocusIn() func(*IMContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFocusIn)
+	core.RegisterFunc(&rawCallback, x.xFocusIn)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverrideFocusOut sets the "focus_out" callback function.
-// Called via [method@Gtk.IMContext.focus_out] when the input widget
+// Called via [IMContext.FocusOut] when the input widget
 //
 //	has lost focus. May be overridden to keep track of the current focus.
 func (x *IMContextClass) OverrideFocusOut(cb func(*IMContext)) {
 	if cb == nil {
 		x.xFocusOut = 0
 	} else {
-		x.xFocusOut = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xFocusOut = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetFocusOut gets the "focus_out" callback function.
-// Called via [method@Gtk.IMContext.focus_out] when the input widget
+// Called via [IMContext.FocusOut] when the input widget
 //
 //	has lost focus. May be overridden to keep track of the current focus.
 func (x *IMContextClass) GetFocusOut() func(*IMContext) {
@@ -397,14 +407,14 @@ func (x *IMContextClass) GetFocusOut() func(*IMContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xFocusOut)
+	core.RegisterFunc(&rawCallback, x.xFocusOut)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverrideReset sets the "reset" callback function.
-// Called via [method@Gtk.IMContext.reset] to signal a change such as a
+// Called via [IMContext.Reset] to signal a change such as a
 //
 //	change in cursor position. An input method that implements preediting
 //	should override this method to clear the preedit state on reset.
@@ -412,14 +422,15 @@ func (x *IMContextClass) OverrideReset(cb func(*IMContext)) {
 	if cb == nil {
 		x.xReset = 0
 	} else {
-		x.xReset = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xReset = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
 }
 
 // GetReset gets the "reset" callback function.
-// Called via [method@Gtk.IMContext.reset] to signal a change such as a
+// Called via [IMContext.Reset] to signal a change such as a
 //
 //	change in cursor position. An input method that implements preediting
 //	should override this method to clear the preedit state on reset.
@@ -428,14 +439,14 @@ func (x *IMContextClass) GetReset() func(*IMContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReset)
+	core.RegisterFunc(&rawCallback, x.xReset)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
 }
 
 // OverrideSetCursorLocation sets the "set_cursor_location" callback function.
-// Called via [method@Gtk.IMContext.set_cursor_location]
+// Called via [IMContext.SetCursorLocation]
 //
 //	to inform the input method of the current cursor location relative to
 //	the client window. May be overridden to implement the display of popup
@@ -444,14 +455,15 @@ func (x *IMContextClass) OverrideSetCursorLocation(cb func(*IMContext, *gdk.Rect
 	if cb == nil {
 		x.xSetCursorLocation = 0
 	} else {
-		x.xSetCursorLocation = purego.NewCallback(func(ContextVarp uintptr, AreaVarp *gdk.Rectangle) {
+		x.xSetCursorLocation = core.NewCallback(func(ContextVarp uintptr, AreaVarp *gdk.Rectangle) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), AreaVarp)
 		})
 	}
 }
 
 // GetSetCursorLocation gets the "set_cursor_location" callback function.
-// Called via [method@Gtk.IMContext.set_cursor_location]
+// Called via [IMContext.SetCursorLocation]
 //
 //	to inform the input method of the current cursor location relative to
 //	the client window. May be overridden to implement the display of popup
@@ -461,14 +473,14 @@ func (x *IMContextClass) GetSetCursorLocation() func(*IMContext, *gdk.Rectangle)
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, AreaVarp *gdk.Rectangle)
-	purego.RegisterFunc(&rawCallback, x.xSetCursorLocation)
+	core.RegisterFunc(&rawCallback, x.xSetCursorLocation)
 	return func(ContextVar *IMContext, AreaVar *gdk.Rectangle) {
 		rawCallback(ContextVar.GoPointer(), AreaVar)
 	}
 }
 
 // OverrideSetUsePreedit sets the "set_use_preedit" callback function.
-// Called via [method@Gtk.IMContext.set_use_preedit] to control
+// Called via [IMContext.SetUsePreedit] to control
 //
 //	the use of the preedit string. Override this to display feedback by some
 //	other means if turned off.
@@ -476,14 +488,15 @@ func (x *IMContextClass) OverrideSetUsePreedit(cb func(*IMContext, bool)) {
 	if cb == nil {
 		x.xSetUsePreedit = 0
 	} else {
-		x.xSetUsePreedit = purego.NewCallback(func(ContextVarp uintptr, UsePreeditVarp bool) {
+		x.xSetUsePreedit = core.NewCallback(func(ContextVarp uintptr, UsePreeditVarp bool) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), UsePreeditVarp)
 		})
 	}
 }
 
 // GetSetUsePreedit gets the "set_use_preedit" callback function.
-// Called via [method@Gtk.IMContext.set_use_preedit] to control
+// Called via [IMContext.SetUsePreedit] to control
 //
 //	the use of the preedit string. Override this to display feedback by some
 //	other means if turned off.
@@ -492,81 +505,83 @@ func (x *IMContextClass) GetSetUsePreedit() func(*IMContext, bool) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, UsePreeditVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xSetUsePreedit)
+	core.RegisterFunc(&rawCallback, x.xSetUsePreedit)
 	return func(ContextVar *IMContext, UsePreeditVar bool) {
 		rawCallback(ContextVar.GoPointer(), UsePreeditVar)
 	}
 }
 
 // OverrideSetSurrounding sets the "set_surrounding" callback function.
-// Called via [method@Gtk.IMContext.set_surrounding] in
+// Called via [IMContext.SetSurrounding] in
 //
-//	response to [signal@Gtk.IMContext::retrieve-surrounding] signal to update
+//	response to [IMContext.ConnectRetrieveSurroundingFunc] signal to update
 //	the input method’s idea of the context around the cursor. It is not necessary
 //	to override this method even with input methods which implement
 //	context-dependent behavior. The base implementation is sufficient for
-//	[method@Gtk.IMContext.get_surrounding] to work.
+//	[IMContext.GetSurrounding] to work.
 func (x *IMContextClass) OverrideSetSurrounding(cb func(*IMContext, string, int, int)) {
 	if cb == nil {
 		x.xSetSurrounding = 0
 	} else {
-		x.xSetSurrounding = purego.NewCallback(func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int) {
+		x.xSetSurrounding = core.NewCallback(func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), TextVarp, LenVarp, CursorIndexVarp)
 		})
 	}
 }
 
 // GetSetSurrounding gets the "set_surrounding" callback function.
-// Called via [method@Gtk.IMContext.set_surrounding] in
+// Called via [IMContext.SetSurrounding] in
 //
-//	response to [signal@Gtk.IMContext::retrieve-surrounding] signal to update
+//	response to [IMContext.ConnectRetrieveSurroundingFunc] signal to update
 //	the input method’s idea of the context around the cursor. It is not necessary
 //	to override this method even with input methods which implement
 //	context-dependent behavior. The base implementation is sufficient for
-//	[method@Gtk.IMContext.get_surrounding] to work.
+//	[IMContext.GetSurrounding] to work.
 func (x *IMContextClass) GetSetSurrounding() func(*IMContext, string, int, int) {
 	if x.xSetSurrounding == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int)
-	purego.RegisterFunc(&rawCallback, x.xSetSurrounding)
+	core.RegisterFunc(&rawCallback, x.xSetSurrounding)
 	return func(ContextVar *IMContext, TextVar string, LenVar int, CursorIndexVar int) {
 		rawCallback(ContextVar.GoPointer(), TextVar, LenVar, CursorIndexVar)
 	}
 }
 
 // OverrideGetSurrounding sets the "get_surrounding" callback function.
-// Called via [method@Gtk.IMContext.get_surrounding] to update
+// Called via [IMContext.GetSurrounding] to update
 //
 //	the context around the cursor location. It is not necessary to override this
 //	method even with input methods which implement context-dependent behavior.
-//	The base implementation emits [signal@Gtk.IMContext::retrieve-surrounding]
+//	The base implementation emits [IMContext.ConnectRetrieveSurroundingFunc]
 //	and records the context received by the subsequent invocation of
-//	[vfunc@Gtk.IMContext.get_surrounding].
+//	IMContext.get_surrounding.
 func (x *IMContextClass) OverrideGetSurrounding(cb func(*IMContext, *string, *int) bool) {
 	if cb == nil {
 		x.xGetSurrounding = 0
 	} else {
-		x.xGetSurrounding = purego.NewCallback(func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int) bool {
+		x.xGetSurrounding = core.NewCallback(func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp), TextVarp, CursorIndexVarp)
 		})
 	}
 }
 
 // GetGetSurrounding gets the "get_surrounding" callback function.
-// Called via [method@Gtk.IMContext.get_surrounding] to update
+// Called via [IMContext.GetSurrounding] to update
 //
 //	the context around the cursor location. It is not necessary to override this
 //	method even with input methods which implement context-dependent behavior.
-//	The base implementation emits [signal@Gtk.IMContext::retrieve-surrounding]
+//	The base implementation emits [IMContext.ConnectRetrieveSurroundingFunc]
 //	and records the context received by the subsequent invocation of
-//	[vfunc@Gtk.IMContext.get_surrounding].
+//	IMContext.get_surrounding.
 func (x *IMContextClass) GetGetSurrounding() func(*IMContext, *string, *int) bool {
 	if x.xGetSurrounding == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xGetSurrounding)
+	core.RegisterFunc(&rawCallback, x.xGetSurrounding)
 	return func(ContextVar *IMContext, TextVar *string, CursorIndexVar *int) bool {
 		return rawCallback(ContextVar.GoPointer(), TextVar, CursorIndexVar)
 	}
@@ -575,17 +590,18 @@ func (x *IMContextClass) GetGetSurrounding() func(*IMContext, *string, *int) boo
 // OverrideSetSurroundingWithSelection sets the "set_surrounding_with_selection" callback function.
 // Called via
 //
-//	[method@Gtk.IMContext.set_surrounding_with_selection] in response to the
-//	[signal@Gtk.IMContext::retrieve-surrounding] signal to update the input
+//	[IMContext.SetSurroundingWithSelection] in response to the
+//	[IMContext.ConnectRetrieveSurroundingFunc] signal to update the input
 //	method’s idea of the context around the cursor. It is not necessary to
 //	override this method even with input methods which implement
 //	context-dependent behavior. The base implementation is sufficient for
-//	[method@Gtk.IMContext.get_surrounding] to work.
+//	[IMContext.GetSurrounding] to work.
 func (x *IMContextClass) OverrideSetSurroundingWithSelection(cb func(*IMContext, string, int, int, int)) {
 	if cb == nil {
 		x.xSetSurroundingWithSelection = 0
 	} else {
-		x.xSetSurroundingWithSelection = purego.NewCallback(func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int, AnchorIndexVarp int) {
+		x.xSetSurroundingWithSelection = core.NewCallback(func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int, AnchorIndexVarp int) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp), TextVarp, LenVarp, CursorIndexVarp, AnchorIndexVarp)
 		})
 	}
@@ -594,18 +610,18 @@ func (x *IMContextClass) OverrideSetSurroundingWithSelection(cb func(*IMContext,
 // GetSetSurroundingWithSelection gets the "set_surrounding_with_selection" callback function.
 // Called via
 //
-//	[method@Gtk.IMContext.set_surrounding_with_selection] in response to the
-//	[signal@Gtk.IMContext::retrieve-surrounding] signal to update the input
+//	[IMContext.SetSurroundingWithSelection] in response to the
+//	[IMContext.ConnectRetrieveSurroundingFunc] signal to update the input
 //	method’s idea of the context around the cursor. It is not necessary to
 //	override this method even with input methods which implement
 //	context-dependent behavior. The base implementation is sufficient for
-//	[method@Gtk.IMContext.get_surrounding] to work.
+//	[IMContext.GetSurrounding] to work.
 func (x *IMContextClass) GetSetSurroundingWithSelection() func(*IMContext, string, int, int, int) {
 	if x.xSetSurroundingWithSelection == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, TextVarp string, LenVarp int, CursorIndexVarp int, AnchorIndexVarp int)
-	purego.RegisterFunc(&rawCallback, x.xSetSurroundingWithSelection)
+	core.RegisterFunc(&rawCallback, x.xSetSurroundingWithSelection)
 	return func(ContextVar *IMContext, TextVar string, LenVar int, CursorIndexVar int, AnchorIndexVar int) {
 		rawCallback(ContextVar.GoPointer(), TextVar, LenVar, CursorIndexVar, AnchorIndexVar)
 	}
@@ -614,17 +630,18 @@ func (x *IMContextClass) GetSetSurroundingWithSelection() func(*IMContext, strin
 // OverrideGetSurroundingWithSelection sets the "get_surrounding_with_selection" callback function.
 // Called via
 //
-//	[method@Gtk.IMContext.get_surrounding_with_selection] to update the
+//	[IMContext.GetSurroundingWithSelection] to update the
 //	context around the cursor location. It is not necessary to override
 //	this method even with input methods which implement context-dependent
 //	behavior. The base implementation emits
-//	[signal@Gtk.IMContext::retrieve-surrounding] and records the context
-//	received by the subsequent invocation of [vfunc@Gtk.IMContext.get_surrounding].
+//	[IMContext.ConnectRetrieveSurroundingFunc] and records the context
+//	received by the subsequent invocation of IMContext.get_surrounding.
 func (x *IMContextClass) OverrideGetSurroundingWithSelection(cb func(*IMContext, *string, *int, *int) bool) {
 	if cb == nil {
 		x.xGetSurroundingWithSelection = 0
 	} else {
-		x.xGetSurroundingWithSelection = purego.NewCallback(func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int, AnchorIndexVarp *int) bool {
+		x.xGetSurroundingWithSelection = core.NewCallback(func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int, AnchorIndexVarp *int) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp), TextVarp, CursorIndexVarp, AnchorIndexVarp)
 		})
 	}
@@ -633,18 +650,18 @@ func (x *IMContextClass) OverrideGetSurroundingWithSelection(cb func(*IMContext,
 // GetGetSurroundingWithSelection gets the "get_surrounding_with_selection" callback function.
 // Called via
 //
-//	[method@Gtk.IMContext.get_surrounding_with_selection] to update the
+//	[IMContext.GetSurroundingWithSelection] to update the
 //	context around the cursor location. It is not necessary to override
 //	this method even with input methods which implement context-dependent
 //	behavior. The base implementation emits
-//	[signal@Gtk.IMContext::retrieve-surrounding] and records the context
-//	received by the subsequent invocation of [vfunc@Gtk.IMContext.get_surrounding].
+//	[IMContext.ConnectRetrieveSurroundingFunc] and records the context
+//	received by the subsequent invocation of IMContext.get_surrounding.
 func (x *IMContextClass) GetGetSurroundingWithSelection() func(*IMContext, *string, *int, *int) bool {
 	if x.xGetSurroundingWithSelection == 0 {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, TextVarp *string, CursorIndexVarp *int, AnchorIndexVarp *int) bool
-	purego.RegisterFunc(&rawCallback, x.xGetSurroundingWithSelection)
+	core.RegisterFunc(&rawCallback, x.xGetSurroundingWithSelection)
 	return func(ContextVar *IMContext, TextVar *string, CursorIndexVar *int, AnchorIndexVar *int) bool {
 		return rawCallback(ContextVar.GoPointer(), TextVar, CursorIndexVar, AnchorIndexVar)
 	}
@@ -655,7 +672,8 @@ func (x *IMContextClass) OverrideActivateOsk(cb func(*IMContext)) {
 	if cb == nil {
 		x.xActivateOsk = 0
 	} else {
-		x.xActivateOsk = purego.NewCallback(func(ContextVarp uintptr) {
+		x.xActivateOsk = core.NewCallback(func(ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(IMContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -667,7 +685,7 @@ func (x *IMContextClass) GetActivateOsk() func(*IMContext) {
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivateOsk)
+	core.RegisterFunc(&rawCallback, x.xActivateOsk)
 	return func(ContextVar *IMContext) {
 		rawCallback(ContextVar.GoPointer())
 	}
@@ -678,7 +696,8 @@ func (x *IMContextClass) OverrideActivateOskWithEvent(cb func(*IMContext, *gdk.E
 	if cb == nil {
 		x.xActivateOskWithEvent = 0
 	} else {
-		x.xActivateOskWithEvent = purego.NewCallback(func(ContextVarp uintptr, EventVarp uintptr) bool {
+		x.xActivateOskWithEvent = core.NewCallback(func(ContextVarp uintptr, EventVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(IMContextNewFromInternalPtr(ContextVarp), gdk.EventNewFromInternalPtr(EventVarp))
 		})
 	}
@@ -690,7 +709,7 @@ func (x *IMContextClass) GetActivateOskWithEvent() func(*IMContext, *gdk.Event)
 		return nil
 	}
 	var rawCallback func(ContextVarp uintptr, EventVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xActivateOskWithEvent)
+	core.RegisterFunc(&rawCallback, x.xActivateOskWithEvent)
 	return func(ContextVar *IMContext, EventVar *gdk.Event) bool {
 		return rawCallback(ContextVar.GoPointer(), EventVar.GoPointer())
 	}
@@ -701,7 +720,8 @@ func (x *IMContextClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -713,7 +733,7 @@ func (x *IMContextClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -724,7 +744,8 @@ func (x *IMContextClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -736,7 +757,7 @@ func (x *IMContextClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -747,7 +768,8 @@ func (x *IMContextClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -759,7 +781,7 @@ func (x *IMContextClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -774,14 +796,14 @@ func (x *IMContextClass) GetGtkReserved4() func() {
 // outputting the composed result. This is called *preediting*, and an input
 // method may provide feedback about this process by displaying the intermediate
 // composition states as preedit text. To do so, the `GtkIMContext` will emit
-// [signal@Gtk.IMContext::preedit-start], [signal@Gtk.IMContext::preedit-changed]
-// and [signal@Gtk.IMContext::preedit-end] signals.
+// [IMContext.ConnectPreeditStartFunc], [IMContext.ConnectPreeditChangedFunc]
+// and [IMContext.ConnectPreeditEndFunc] signals.
 //
-// For instance, the built-in GTK input method [class@Gtk.IMContextSimple]
+// For instance, the built-in GTK input method [IMContextSimple]
 // implements the input of arbitrary Unicode code points by holding down the
-// &lt;kbd&gt;Control&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt; keys and then typing &lt;kbd&gt;u&lt;/kbd&gt;
+// Control and Shift keys and then typing u
 // followed by the hexadecimal digits of the code point. When releasing the
-// &lt;kbd&gt;Control&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt; keys, preediting ends and the
+// Control and Shift keys, preediting ends and the
 // character is inserted as text. For example,
 //
 //	Ctrl+Shift+u 2 0 A C
@@ -793,7 +815,7 @@ func (x *IMContextClass) GetGtkReserved4() func() {
 // provides a `GIOExtension` for the extension point named "gtk-im-module".
 //
 // To connect a widget to the users preferred input method, you should use
-// [class@Gtk.IMMulticontext].
+// [IMMulticontext].
 type IMContext struct {
 	gobject.Object
 }
@@ -814,7 +836,7 @@ var xIMContextActivateOsk func(uintptr, uintptr) bool
 
 // Requests the platform to show an on-screen keyboard for user input.
 //
-// This method will return %TRUE if this request was actually performed
+// This method will return true if this request was actually performed
 // to the platform, other environmental factors may result in an on-screen
 // keyboard effectively not showing up.
 func (x *IMContext) ActivateOsk(EventVar *gdk.Event) bool {
@@ -829,11 +851,11 @@ var xIMContextDeleteSurrounding func(uintptr, int, int) bool
 // characters around the cursor position by emitting the
 // `::delete_surrounding` signal.
 //
-// Note that @offset and @n_chars are in characters not in bytes
+// Note that offset and n_chars are in characters not in bytes
 // which differs from the usage other places in `GtkIMContext`.
 //
 // In order to use this function, you should first call
-// [method@Gtk.IMContext.get_surrounding] to get the current context,
+// [IMContext.GetSurrounding] to get the current context,
 // and call this function immediately afterwards to make sure that you
 // know what you are deleting. You should also account for the fact
 // that even if the signal was handled, the input context might not
@@ -864,7 +886,7 @@ var xIMContextFilterKeypress func(uintptr, uintptr) bool
 // Allow an input method to internally handle key press and release
 // events.
 //
-// If this function returns %TRUE, then no further processing
+// If this function returns true, then no further processing
 // should be done for this key event.
 func (x *IMContext) FilterKeypress(EventVar *gdk.Event) bool {
 
@@ -910,29 +932,6 @@ func (x *IMContext) GetPreeditString(StrVar *string, AttrsVar **pango.AttrList,
 
 }
 
-var xIMContextGetSurrounding func(uintptr, *string, *int) bool
-
-// Retrieves context around the insertion point.
-//
-// Input methods typically want context in order to constrain input text
-// based on existing text; this is important for languages such as Thai
-// where only some sequences of characters are allowed.
-//
-// This function is implemented by emitting the
-// [signal@Gtk.IMContext::retrieve-surrounding] signal on the input method;
-// in response to this signal, a widget should provide as much context as
-// is available, up to an entire paragraph, by calling
-// [method@Gtk.IMContext.set_surrounding].
-//
-// Note that there is no obligation for a widget to respond to the
-// `::retrieve-surrounding` signal, so input methods must be prepared to
-// function without context.
-func (x *IMContext) GetSurrounding(TextVar *string, CursorIndexVar *int) bool {
-
-	cret := xIMContextGetSurrounding(x.GoPointer(), TextVar, CursorIndexVar)
-	return cret
-}
-
 var xIMContextGetSurroundingWithSelection func(uintptr, *string, *int, *int) bool
 
 // Retrieves context around the insertion point.
@@ -942,10 +941,10 @@ var xIMContextGetSurroundingWithSelection func(uintptr, *string, *int, *int) boo
 // as Thai where only some sequences of characters are allowed.
 //
 // This function is implemented by emitting the
-// [signal@Gtk.IMContext::retrieve-surrounding] signal on the input method;
+// [IMContext.ConnectRetrieveSurroundingFunc] signal on the input method;
 // in response to this signal, a widget should provide as much context as
 // is available, up to an entire paragraph, by calling
-// [method@Gtk.IMContext.set_surrounding_with_selection].
+// [IMContext.SetSurroundingWithSelection].
 //
 // Note that there is no obligation for a widget to respond to the
 // `::retrieve-surrounding` signal, so input methods must be prepared to
@@ -993,25 +992,11 @@ func (x *IMContext) SetCursorLocation(AreaVar *gdk.Rectangle) {
 
 }
 
-var xIMContextSetSurrounding func(uintptr, string, int, int)
-
-// Sets surrounding context around the insertion point and preedit
-// string.
-//
-// This function is expected to be called in response to the
-// [signal@Gtk.IMContext::retrieve-surrounding] signal, and will
-// likely have no effect if called at other times.
-func (x *IMContext) SetSurrounding(TextVar string, LenVar int, CursorIndexVar int) {
-
-	xIMContextSetSurrounding(x.GoPointer(), TextVar, LenVar, CursorIndexVar)
-
-}
-
 var xIMContextSetSurroundingWithSelection func(uintptr, string, int, int, int)
 
 // Sets surrounding context around the insertion point and preedit
 // string. This function is expected to be called in response to the
-// [signal@Gtk.IMContext::retrieve_surrounding] signal, and will likely
+// IMContext::retrieve_surrounding signal, and will likely
 // have no effect if called at other times.
 func (x *IMContext) SetSurroundingWithSelection(TextVar string, LenVar int, CursorIndexVar int, AnchorIndexVar int) {
 
@@ -1024,7 +1009,7 @@ var xIMContextSetUsePreedit func(uintptr, bool)
 // Sets whether the IM context should use the preedit string
 // to display feedback.
 //
-// If @use_preedit is %FALSE (default is %TRUE), then the IM context
+// If use_preedit is false (default is true), then the IM context
 // may use some other method to display feedback, such as displaying
 // it in a child of the root window.
 func (x *IMContext) SetUsePreedit(UsePreeditVar bool) {
@@ -1052,158 +1037,290 @@ func (c *IMContext) SetGoPointer(ptr uintptr) {
 //
 // This can be a single character immediately after a key press or
 // the final result of preediting.
+//
+// Deprecated: use ConnectCommitFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectCommit(cb *func(IMContext, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "commit", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StrVarp string) {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StrVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "commit", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StrVarp string) {
+var xIMContextCommitTrampoline uintptr
+
+func xIMContextCommitNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StrVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StrVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "commit", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCommitFunc connects cb to the "commit" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectCommitFunc(cb func(IMContext, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "commit", &xIMContextCommitTrampoline, xIMContextCommitNewTrampoline, cb)
 }
 
 // The ::delete-surrounding signal is emitted when the input method
 // needs to delete all or part of the context surrounding the cursor.
+//
+// Deprecated: use ConnectDeleteSurroundingFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectDeleteSurrounding(cb *func(IMContext, int, int) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "delete-surrounding", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OffsetVarp int, NCharsVarp int) bool {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, OffsetVarp, NCharsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "delete-surrounding", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, OffsetVarp int, NCharsVarp int) bool {
+var xIMContextDeleteSurroundingTrampoline uintptr
+
+func xIMContextDeleteSurroundingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OffsetVarp int, NCharsVarp int, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext, int, int) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, OffsetVarp, NCharsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "delete-surrounding", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeleteSurroundingFunc connects cb to the "delete-surrounding" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectDeleteSurroundingFunc(cb func(IMContext, int, int) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "delete-surrounding", &xIMContextDeleteSurroundingTrampoline, xIMContextDeleteSurroundingNewTrampoline, cb)
 }
 
 // The ::preedit-changed signal is emitted whenever the preedit sequence
 // currently being entered has changed.
 //
 // It is also emitted at the end of a preedit sequence, in which case
-// [method@Gtk.IMContext.get_preedit_string] returns the empty string.
+// [IMContext.GetPreeditString] returns the empty string.
+//
+// Deprecated: use ConnectPreeditChangedFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectPreeditChanged(cb *func(IMContext)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preedit-changed", cbRefPtr, cbPtr)
+}
+
+var xIMContextPreeditChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xIMContextPreeditChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreeditChangedFunc connects cb to the "preedit-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectPreeditChangedFunc(cb func(IMContext)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preedit-changed", &xIMContextPreeditChangedTrampoline, xIMContextPreeditChangedNewTrampoline, cb)
 }
 
 // The ::preedit-end signal is emitted when a preediting sequence
 // has been completed or canceled.
+//
+// Deprecated: use ConnectPreeditEndFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectPreeditEnd(cb *func(IMContext)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-end", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preedit-end", cbRefPtr, cbPtr)
+}
+
+var xIMContextPreeditEndTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xIMContextPreeditEndNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-end", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreeditEndFunc connects cb to the "preedit-end" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectPreeditEndFunc(cb func(IMContext)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preedit-end", &xIMContextPreeditEndTrampoline, xIMContextPreeditEndNewTrampoline, cb)
 }
 
 // The ::preedit-start signal is emitted when a new preediting sequence
 // starts.
+//
+// Deprecated: use ConnectPreeditStartFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectPreeditStart(cb *func(IMContext)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-start", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preedit-start", cbRefPtr, cbPtr)
+}
+
+var xIMContextPreeditStartTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xIMContextPreeditStartNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-start", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreeditStartFunc connects cb to the "preedit-start" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectPreeditStartFunc(cb func(IMContext)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preedit-start", &xIMContextPreeditStartTrampoline, xIMContextPreeditStartNewTrampoline, cb)
 }
 
 // The ::retrieve-surrounding signal is emitted when the input method
 // requires the context surrounding the cursor.
 //
 // The callback should set the input method surrounding context by
-// calling the [method@Gtk.IMContext.set_surrounding] method.
+// calling the [IMContext.SetSurrounding] method.
+//
+// Deprecated: use ConnectRetrieveSurroundingFunc, which also accepts method values and closures.
 func (x *IMContext) ConnectRetrieveSurrounding(cb *func(IMContext) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "retrieve-surrounding", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := IMContext{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "retrieve-surrounding", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xIMContextRetrieveSurroundingTrampoline uintptr
+
+func xIMContextRetrieveSurroundingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(IMContext) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := IMContext{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "retrieve-surrounding", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRetrieveSurroundingFunc connects cb to the "retrieve-surrounding" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *IMContext) ConnectRetrieveSurroundingFunc(cb func(IMContext) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "retrieve-surrounding", &xIMContextRetrieveSurroundingTrampoline, xIMContextRetrieveSurroundingNewTrampoline, cb)
 }
 
 func init() {
@@ -1211,7 +1328,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1220,20 +1337,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xIMContextGLibType, libs, "gtk_im_context_get_type")
 
-	core.PuregoSafeRegister(&xIMContextActivateOsk, libs, "gtk_im_context_activate_osk")
-	core.PuregoSafeRegister(&xIMContextDeleteSurrounding, libs, "gtk_im_context_delete_surrounding")
-	core.PuregoSafeRegister(&xIMContextFilterKey, libs, "gtk_im_context_filter_key")
-	core.PuregoSafeRegister(&xIMContextFilterKeypress, libs, "gtk_im_context_filter_keypress")
-	core.PuregoSafeRegister(&xIMContextFocusIn, libs, "gtk_im_context_focus_in")
-	core.PuregoSafeRegister(&xIMContextFocusOut, libs, "gtk_im_context_focus_out")
-	core.PuregoSafeRegister(&xIMContextGetPreeditString, libs, "gtk_im_context_get_preedit_string")
-	core.PuregoSafeRegister(&xIMContextGetSurrounding, libs, "gtk_im_context_get_surrounding")
-	core.PuregoSafeRegister(&xIMContextGetSurroundingWithSelection, libs, "gtk_im_context_get_surrounding_with_selection")
-	core.PuregoSafeRegister(&xIMContextReset, libs, "gtk_im_context_reset")
-	core.PuregoSafeRegister(&xIMContextSetClientWidget, libs, "gtk_im_context_set_client_widget")
-	core.PuregoSafeRegister(&xIMContextSetCursorLocation, libs, "gtk_im_context_set_cursor_location")
-	core.PuregoSafeRegister(&xIMContextSetSurrounding, libs, "gtk_im_context_set_surrounding")
-	core.PuregoSafeRegister(&xIMContextSetSurroundingWithSelection, libs, "gtk_im_context_set_surrounding_with_selection")
-	core.PuregoSafeRegister(&xIMContextSetUsePreedit, libs, "gtk_im_context_set_use_preedit")
+	gobject.CheckClassSize("GtkIMContextClass", xIMContextGLibType, unsafe.Sizeof(IMContextClass{}))
+
+	core.PuregoRegisterSince(&xIMContextActivateOsk, libs, "gtk_im_context_activate_osk", "GTK", "4.14")
+	core.PuregoRegisterSince(&xIMContextDeleteSurrounding, libs, "gtk_im_context_delete_surrounding", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextFilterKey, libs, "gtk_im_context_filter_key", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextFilterKeypress, libs, "gtk_im_context_filter_keypress", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextFocusIn, libs, "gtk_im_context_focus_in", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextFocusOut, libs, "gtk_im_context_focus_out", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextGetPreeditString, libs, "gtk_im_context_get_preedit_string", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextGetSurroundingWithSelection, libs, "gtk_im_context_get_surrounding_with_selection", "GTK", "4.2")
+	core.PuregoRegisterSince(&xIMContextReset, libs, "gtk_im_context_reset", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextSetClientWidget, libs, "gtk_im_context_set_client_widget", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextSetCursorLocation, libs, "gtk_im_context_set_cursor_location", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextSetSurroundingWithSelection, libs, "gtk_im_context_set_surrounding_with_selection", "GTK", "4.2")
+	core.PuregoRegisterSince(&xIMContextSetUsePreedit, libs, "gtk_im_context_set_use_preedit", "GTK", "")
 
 }
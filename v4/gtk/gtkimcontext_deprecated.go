@@ -0,0 +1,66 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xIMContextGetSurrounding func(uintptr, *string, *int) bool
+
+// Retrieves context around the insertion point.
+//
+// Input methods typically want context in order to constrain input text
+// based on existing text; this is important for languages such as Thai
+// where only some sequences of characters are allowed.
+//
+// This function is implemented by emitting the
+// [IMContext.ConnectRetrieveSurroundingFunc] signal on the input method;
+// in response to this signal, a widget should provide as much context as
+// is available, up to an entire paragraph, by calling
+// [IMContext.SetSurrounding].
+//
+// Note that there is no obligation for a widget to respond to the
+// `::retrieve-surrounding` signal, so input methods must be prepared to
+// function without context.
+//
+// Deprecated: since 4.2. Use [IMContext.GetSurroundingWithSelection] instead.
+func (x *IMContext) GetSurrounding(TextVar *string, CursorIndexVar *int) bool {
+
+	cret := xIMContextGetSurrounding(x.GoPointer(), TextVar, CursorIndexVar)
+	return cret
+}
+
+var xIMContextSetSurrounding func(uintptr, string, int, int)
+
+// Sets surrounding context around the insertion point and preedit
+// string.
+//
+// This function is expected to be called in response to the
+// [IMContext.ConnectRetrieveSurroundingFunc] signal, and will
+// likely have no effect if called at other times.
+//
+// Deprecated: since 4.2. Use [IMContext.SetSurroundingWithSelection] instead
+func (x *IMContext) SetSurrounding(TextVar string, LenVar int, CursorIndexVar int) {
+
+	xIMContextSetSurrounding(x.GoPointer(), TextVar, LenVar, CursorIndexVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIMContextGetSurrounding, libs, "gtk_im_context_get_surrounding", "GTK", "")
+	core.PuregoRegisterSince(&xIMContextSetSurrounding, libs, "gtk_im_context_set_surrounding", "GTK", "")
+
+}
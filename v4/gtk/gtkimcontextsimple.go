@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -37,13 +37,13 @@ const (
 
 // Supports compose sequences, dead keys and numeric Unicode input.
 //
-// ## Compose sequences
+// # Compose sequences
 //
 // `GtkIMContextSimple` reads compose sequences from the first of the
 // following files that is found: ~/.config/gtk-4.0/Compose, ~/.XCompose,
 // /usr/share/X11/locale/$locale/Compose (for locales that have a nontrivial
 // Compose file). A subset of the file syntax described in the Compose(5)
-// manual page is supported. Additionally, `include "%L"` loads GTK’s built-in
+// manual page is supported. Additionally, `include "L"` loads GTK’s built-in
 // table of compose sequences rather than the locale-specific one from X11.
 //
 // If none of these files is found, `GtkIMContextSimple` uses a built-in table
@@ -53,10 +53,10 @@ const (
 // often not available as a dedicated key on keyboards. Keyboard layouts may
 // map this keysym to other keys, such as the right Control key.
 //
-// ## Unicode characters
+// # Unicode characters
 //
 // `GtkIMContextSimple` also supports numeric entry of Unicode characters
-// by typing &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;Shift&lt;/kbd&gt;-&lt;kbd&gt;u&lt;/kbd&gt;, followed by a
+// by typing Ctrl-Shift-u, followed by a
 // hexadecimal Unicode codepoint.
 //
 // For example,
@@ -65,7 +65,7 @@ const (
 //
 // yields U+0123 LATIN SMALL LETTER G WITH CEDILLA, i.e. ģ.
 //
-// ## Dead keys
+// # Dead keys
 //
 // `GtkIMContextSimple` supports dead keys. For example, typing
 //
@@ -114,23 +114,6 @@ func (x *IMContextSimple) AddComposeFile(ComposeFileVar string) {
 
 }
 
-var xIMContextSimpleAddTable func(uintptr, []uint16, int, int)
-
-// Adds an additional table to search to the input context.
-// Each row of the table consists of @max_seq_len key symbols
-// followed by two #guint16 interpreted as the high and low
-// words of a #gunicode value. Tables are searched starting
-// from the last added.
-//
-// The table must be sorted in dictionary order on the
-// numeric value of the key symbol fields. (Values beyond
-// the length of the sequence should be zero.)
-func (x *IMContextSimple) AddTable(DataVar []uint16, MaxSeqLenVar int, NSeqsVar int) {
-
-	xIMContextSimpleAddTable(x.GoPointer(), DataVar, MaxSeqLenVar, NSeqsVar)
-
-}
-
 func (c *IMContextSimple) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -147,7 +130,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -156,9 +139,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xIMContextSimpleGLibType, libs, "gtk_im_context_simple_get_type")
 
-	core.PuregoSafeRegister(&xNewIMContextSimple, libs, "gtk_im_context_simple_new")
+	gobject.CheckClassSize("GtkIMContextSimpleClass", xIMContextSimpleGLibType, unsafe.Sizeof(IMContextSimpleClass{}))
+
+	core.PuregoRegisterSince(&xNewIMContextSimple, libs, "gtk_im_context_simple_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xIMContextSimpleAddComposeFile, libs, "gtk_im_context_simple_add_compose_file")
-	core.PuregoSafeRegister(&xIMContextSimpleAddTable, libs, "gtk_im_context_simple_add_table")
+	core.PuregoRegisterSince(&xIMContextSimpleAddComposeFile, libs, "gtk_im_context_simple_add_compose_file", "GTK", "")
 
 }
@@ -0,0 +1,43 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xIMContextSimpleAddTable func(uintptr, []uint16, int, int)
+
+// Adds an additional table to search to the input context.
+// Each row of the table consists of max_seq_len key symbols
+// followed by two #guint16 interpreted as the high and low
+// words of a #gunicode value. Tables are searched starting
+// from the last added.
+//
+// The table must be sorted in dictionary order on the
+// numeric value of the key symbol fields. (Values beyond
+// the length of the sequence should be zero.)
+//
+// Deprecated: since 4.4. Use [IMContextSimple.AddComposeFile]
+func (x *IMContextSimple) AddTable(DataVar []uint16, MaxSeqLenVar int, NSeqsVar int) {
+
+	xIMContextSimpleAddTable(x.GoPointer(), DataVar, MaxSeqLenVar, NSeqsVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xIMContextSimpleAddTable, libs, "gtk_im_context_simple_add_table", "GTK", "")
+
+}
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -33,7 +33,8 @@ func (x *IMMulticontextClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -45,7 +46,7 @@ func (x *IMMulticontextClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -56,7 +57,8 @@ func (x *IMMulticontextClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -68,7 +70,7 @@ func (x *IMMulticontextClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -79,7 +81,8 @@ func (x *IMMulticontextClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -91,7 +94,7 @@ func (x *IMMulticontextClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -102,7 +105,8 @@ func (x *IMMulticontextClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -114,7 +118,7 @@ func (x *IMMulticontextClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -167,7 +171,7 @@ func NewIMMulticontext() *IMMulticontext {
 
 var xIMMulticontextGetContextId func(uintptr) string
 
-// Gets the id of the currently active delegate of the @context.
+// Gets the id of the currently active delegate of the context.
 func (x *IMMulticontext) GetContextId() string {
 
 	cret := xIMMulticontextGetContextId(x.GoPointer())
@@ -176,13 +180,13 @@ func (x *IMMulticontext) GetContextId() string {
 
 var xIMMulticontextSetContextId func(uintptr, uintptr)
 
-// Sets the context id for @context.
+// Sets the context id for context.
 //
-// This causes the currently active delegate of @context to be
+// This causes the currently active delegate of context to be
 // replaced by the delegate corresponding to the new context id.
 //
-// Setting this to a non-%NULL value overrides the system-wide
-// IM module setting. See the [property@Gtk.Settings:gtk-im-module]
+// Setting this to a non-nil value overrides the system-wide
+// IM module setting. See the [Settings.GetPropertyGtkImModule]
 // property.
 func (x *IMMulticontext) SetContextId(ContextIdVar *string) {
 
@@ -209,7 +213,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -218,9 +222,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xIMMulticontextGLibType, libs, "gtk_im_multicontext_get_type")
 
-	core.PuregoSafeRegister(&xNewIMMulticontext, libs, "gtk_im_multicontext_new")
+	gobject.CheckClassSize("GtkIMMulticontextClass", xIMMulticontextGLibType, unsafe.Sizeof(IMMulticontextClass{}))
 
-	core.PuregoSafeRegister(&xIMMulticontextGetContextId, libs, "gtk_im_multicontext_get_context_id")
-	core.PuregoSafeRegister(&xIMMulticontextSetContextId, libs, "gtk_im_multicontext_set_context_id")
+	core.PuregoRegisterSince(&xNewIMMulticontext, libs, "gtk_im_multicontext_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xIMMulticontextGetContextId, libs, "gtk_im_multicontext_get_context_id", "GTK", "")
+	core.PuregoRegisterSince(&xIMMulticontextSetContextId, libs, "gtk_im_multicontext_set_context_id", "GTK", "")
 
 }
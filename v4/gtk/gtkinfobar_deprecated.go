@@ -0,0 +1,287 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewInfoBar func() uintptr
+
+// Creates a new `GtkInfoBar` object.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewInfoBar() *InfoBar {
+	var cls *InfoBar
+
+	cret := xNewInfoBar()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &InfoBar{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewInfoBarWithButtons func(uintptr, ...interface{}) uintptr
+
+// Creates a new `GtkInfoBar` with buttons.
+//
+// Button text/response ID pairs should be listed, with a nil pointer
+// ending the list. A response ID can be any positive number,
+// or one of the values in the `GtkResponseType` enumeration. If the
+// user clicks one of these dialog buttons, GtkInfoBar will emit
+// the [InfoBar.ConnectResponseFunc] signal with the corresponding
+// response ID.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewInfoBarWithButtons(FirstButtonTextVar *string, varArgs ...interface{}) *InfoBar {
+	return newInfoBarWithButtonsVarArgs(FirstButtonTextVar, varArgs...)
+}
+
+var xInfoBarAddActionWidget func(uintptr, uintptr, int)
+
+// Add an activatable widget to the action area of a `GtkInfoBar`.
+//
+// This also connects a signal handler that will emit the
+// [InfoBar.ConnectResponseFunc] signal on the message area
+// when the widget is activated. The widget is appended to the
+// end of the message areas action area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) AddActionWidget(ChildVar *Widget, ResponseIdVar int) {
+
+	xInfoBarAddActionWidget(x.GoPointer(), ChildVar.GoPointer(), ResponseIdVar)
+
+}
+
+var xInfoBarAddButton func(uintptr, string, int) uintptr
+
+// Adds a button with the given text.
+//
+// Clicking the button will emit the [InfoBar.ConnectResponseFunc]
+// signal with the given response_id. The button is appended to the
+// end of the info bar's action area. The button widget is returned,
+// but usually you don't need it.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) AddButton(ButtonTextVar string, ResponseIdVar int) *Button {
+	var cls *Button
+
+	cret := xInfoBarAddButton(x.GoPointer(), ButtonTextVar, ResponseIdVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Button{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xInfoBarAddButtons func(uintptr, string, ...interface{})
+
+// Adds multiple buttons.
+//
+// This is the same as calling [InfoBar.AddButton]
+// repeatedly. The variable argument list should be nil-terminated
+// as with [NewInfoBarWithButtons]. Each button must have both
+// text and response ID.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) AddButtons(FirstButtonTextVar string, varArgs ...interface{}) {
+	infoBarAddButtonsVarArgs(x, FirstButtonTextVar, varArgs...)
+}
+
+var xInfoBarAddChild func(uintptr, uintptr)
+
+// Adds a widget to the content area of the info bar.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) AddChild(WidgetVar *Widget) {
+
+	xInfoBarAddChild(x.GoPointer(), WidgetVar.GoPointer())
+
+}
+
+var xInfoBarGetMessageType func(uintptr) MessageType
+
+// Returns the message type of the message area.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) GetMessageType() MessageType {
+
+	cret := xInfoBarGetMessageType(x.GoPointer())
+	return cret
+}
+
+var xInfoBarGetRevealed func(uintptr) bool
+
+// Returns whether the info bar is currently revealed.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) GetRevealed() bool {
+
+	cret := xInfoBarGetRevealed(x.GoPointer())
+	return cret
+}
+
+var xInfoBarGetShowCloseButton func(uintptr) bool
+
+// Returns whether the widget will display a standard close button.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) GetShowCloseButton() bool {
+
+	cret := xInfoBarGetShowCloseButton(x.GoPointer())
+	return cret
+}
+
+var xInfoBarRemoveActionWidget func(uintptr, uintptr)
+
+// Removes a widget from the action area of info_bar.
+//
+// The widget must have been put there by a call to
+// [InfoBar.AddActionWidget] or [InfoBar.AddButton].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) RemoveActionWidget(WidgetVar *Widget) {
+
+	xInfoBarRemoveActionWidget(x.GoPointer(), WidgetVar.GoPointer())
+
+}
+
+var xInfoBarRemoveChild func(uintptr, uintptr)
+
+// Removes a widget from the content area of the info bar.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) RemoveChild(WidgetVar *Widget) {
+
+	xInfoBarRemoveChild(x.GoPointer(), WidgetVar.GoPointer())
+
+}
+
+var xInfoBarResponse func(uintptr, int)
+
+// Emits the “response” signal with the given response_id.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) Response(ResponseIdVar int) {
+
+	xInfoBarResponse(x.GoPointer(), ResponseIdVar)
+
+}
+
+var xInfoBarSetDefaultResponse func(uintptr, int)
+
+// Sets the last widget in the info bar’s action area with
+// the given response_id as the default widget for the dialog.
+//
+// Pressing “Enter” normally activates the default widget.
+//
+// Note that this function currently requires info_bar to
+// be added to a widget hierarchy.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) SetDefaultResponse(ResponseIdVar int) {
+
+	xInfoBarSetDefaultResponse(x.GoPointer(), ResponseIdVar)
+
+}
+
+var xInfoBarSetMessageType func(uintptr, MessageType)
+
+// Sets the message type of the message area.
+//
+// GTK uses this type to determine how the message is displayed.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) SetMessageType(MessageTypeVar MessageType) {
+
+	xInfoBarSetMessageType(x.GoPointer(), MessageTypeVar)
+
+}
+
+var xInfoBarSetResponseSensitive func(uintptr, int, bool)
+
+// Sets the sensitivity of action widgets for response_id.
+//
+// Calls `gtk_widget_set_sensitive (widget, setting)` for each
+// widget in the info bars’s action area with the given response_id.
+// A convenient way to sensitize/desensitize buttons.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) SetResponseSensitive(ResponseIdVar int, SettingVar bool) {
+
+	xInfoBarSetResponseSensitive(x.GoPointer(), ResponseIdVar, SettingVar)
+
+}
+
+var xInfoBarSetRevealed func(uintptr, bool)
+
+// Sets whether the `GtkInfoBar` is revealed.
+//
+// Changing this will make info_bar reveal or conceal
+// itself via a sliding transition.
+//
+// Note: this does not show or hide info_bar in the
+// [Widget.GetPropertyVisible] sense, so revealing has no effect
+// if [Widget.GetPropertyVisible] is false.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) SetRevealed(RevealedVar bool) {
+
+	xInfoBarSetRevealed(x.GoPointer(), RevealedVar)
+
+}
+
+var xInfoBarSetShowCloseButton func(uintptr, bool)
+
+// If true, a standard close button is shown.
+//
+// When clicked it emits the response [ResponseCloseValue].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *InfoBar) SetShowCloseButton(SettingVar bool) {
+
+	xInfoBarSetShowCloseButton(x.GoPointer(), SettingVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewInfoBar, libs, "gtk_info_bar_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewInfoBarWithButtons, libs, "gtk_info_bar_new_with_buttons", "GTK", "")
+
+	core.PuregoRegisterSince(&xInfoBarAddActionWidget, libs, "gtk_info_bar_add_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarAddButton, libs, "gtk_info_bar_add_button", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarAddButtons, libs, "gtk_info_bar_add_buttons", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarAddChild, libs, "gtk_info_bar_add_child", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarGetMessageType, libs, "gtk_info_bar_get_message_type", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarGetRevealed, libs, "gtk_info_bar_get_revealed", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarGetShowCloseButton, libs, "gtk_info_bar_get_show_close_button", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarRemoveActionWidget, libs, "gtk_info_bar_remove_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarRemoveChild, libs, "gtk_info_bar_remove_child", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarResponse, libs, "gtk_info_bar_response", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarSetDefaultResponse, libs, "gtk_info_bar_set_default_response", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarSetMessageType, libs, "gtk_info_bar_set_message_type", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarSetResponseSensitive, libs, "gtk_info_bar_set_response_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarSetRevealed, libs, "gtk_info_bar_set_revealed", "GTK", "")
+	core.PuregoRegisterSince(&xInfoBarSetShowCloseButton, libs, "gtk_info_bar_set_show_close_button", "GTK", "")
+
+}
@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -22,7 +22,7 @@ func (x *InscriptionClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// The different methods to handle text in #GtkInscription when it doesn't
+// The different methods to handle text in [Inscription] when it doesn't
 // fit the available space.
 type InscriptionOverflow int
 
@@ -44,6 +44,51 @@ const (
 	InscriptionOverflowEllipsizeEndValue InscriptionOverflow = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x InscriptionOverflow) String() string {
+	switch x {
+	case InscriptionOverflowClipValue:
+		return "GTK_INSCRIPTION_OVERFLOW_CLIP"
+	case InscriptionOverflowEllipsizeStartValue:
+		return "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_START"
+	case InscriptionOverflowEllipsizeMiddleValue:
+		return "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_MIDDLE"
+	case InscriptionOverflowEllipsizeEndValue:
+		return "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_END"
+	}
+	return fmt.Sprintf("InscriptionOverflow(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x InscriptionOverflow) Nick() string {
+	switch x {
+	case InscriptionOverflowClipValue:
+		return "clip"
+	case InscriptionOverflowEllipsizeStartValue:
+		return "ellipsize-start"
+	case InscriptionOverflowEllipsizeMiddleValue:
+		return "ellipsize-middle"
+	case InscriptionOverflowEllipsizeEndValue:
+		return "ellipsize-end"
+	}
+	return ""
+}
+
+// InscriptionOverflowFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func InscriptionOverflowFromString(s string) (InscriptionOverflow, bool) {
+	switch s {
+	case "GTK_INSCRIPTION_OVERFLOW_CLIP", "clip":
+		return InscriptionOverflowClipValue, true
+	case "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_START", "ellipsize-start":
+		return InscriptionOverflowEllipsizeStartValue, true
+	case "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_MIDDLE", "ellipsize-middle":
+		return InscriptionOverflowEllipsizeMiddleValue, true
+	case "GTK_INSCRIPTION_OVERFLOW_ELLIPSIZE_END", "ellipsize-end":
+		return InscriptionOverflowEllipsizeEndValue, true
+	}
+	return 0, false
+}
+
 // Shows text in a predefined area.
 //
 // You likely want to use `GtkLabel` instead as this widget is intended only
@@ -57,7 +102,7 @@ const (
 // Users of this widget should take care to plan behaviour for the common case
 // where the text doesn't fit exactly in the allocated space.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkInscription` has a single CSS node with the name label.
 type Inscription struct {
@@ -109,7 +154,7 @@ var xInscriptionGetMinChars func(uintptr) uint
 
 // Gets the `min-chars` of the inscription.
 //
-// See the [property@Gtk.Inscription:min-chars] property.
+// See the [Inscription.GetPropertyMinChars] property.
 func (x *Inscription) GetMinChars() uint {
 
 	cret := xInscriptionGetMinChars(x.GoPointer())
@@ -120,7 +165,7 @@ var xInscriptionGetMinLines func(uintptr) uint
 
 // Gets the `min-lines` of the inscription.
 //
-// See the [property@Gtk.Inscription:min-lines] property.
+// See the [Inscription.GetPropertyMinLines] property.
 func (x *Inscription) GetMinLines() uint {
 
 	cret := xInscriptionGetMinLines(x.GoPointer())
@@ -131,7 +176,7 @@ var xInscriptionGetNatChars func(uintptr) uint
 
 // Gets the `nat-chars` of the inscription.
 //
-// See the [property@Gtk.Inscription:nat-chars] property.
+// See the [Inscription.GetPropertyNatChars] property.
 func (x *Inscription) GetNatChars() uint {
 
 	cret := xInscriptionGetNatChars(x.GoPointer())
@@ -142,7 +187,7 @@ var xInscriptionGetNatLines func(uintptr) uint
 
 // Gets the `nat-lines` of the inscription.
 //
-// See the [property@Gtk.Inscription:nat-lines] property.
+// See the [Inscription.GetPropertyNatLines] property.
 func (x *Inscription) GetNatLines() uint {
 
 	cret := xInscriptionGetNatLines(x.GoPointer())
@@ -171,7 +216,7 @@ var xInscriptionGetWrapMode func(uintptr) pango.WrapMode
 
 // Returns line wrap mode used by the inscription.
 //
-// See [method@Gtk.Inscription.set_wrap_mode].
+// See [Inscription.SetWrapMode].
 func (x *Inscription) GetWrapMode() pango.WrapMode {
 
 	cret := xInscriptionGetWrapMode(x.GoPointer())
@@ -182,7 +227,7 @@ var xInscriptionGetXalign func(uintptr) float32
 
 // Gets the `xalign` of the inscription.
 //
-// See the [property@Gtk.Inscription:xalign] property.
+// See the [Inscription.GetPropertyXalign] property.
 func (x *Inscription) GetXalign() float32 {
 
 	cret := xInscriptionGetXalign(x.GoPointer())
@@ -193,7 +238,7 @@ var xInscriptionGetYalign func(uintptr) float32
 
 // Gets the `yalign` of the inscription.
 //
-// See the [property@Gtk.Inscription:yalign] property.
+// See the [Inscription.GetPropertyYalign] property.
 func (x *Inscription) GetYalign() float32 {
 
 	cret := xInscriptionGetYalign(x.GoPointer())
@@ -215,7 +260,7 @@ var xInscriptionSetMarkup func(uintptr, uintptr)
 
 // Utility function to set the text and attributes to be displayed.
 //
-// See the [property@Gtk.Inscription:markup] property.
+// See the [Inscription.SetPropertyMarkup] property.
 func (x *Inscription) SetMarkup(MarkupVar *string) {
 
 	MarkupVarPtr := core.GStrdupNullable(MarkupVar)
@@ -229,7 +274,7 @@ var xInscriptionSetMinChars func(uintptr, uint)
 
 // Sets the `min-chars` of the inscription.
 //
-// See the [property@Gtk.Inscription:min-chars] property.
+// See the [Inscription.GetPropertyMinChars] property.
 func (x *Inscription) SetMinChars(MinCharsVar uint) {
 
 	xInscriptionSetMinChars(x.GoPointer(), MinCharsVar)
@@ -240,7 +285,7 @@ var xInscriptionSetMinLines func(uintptr, uint)
 
 // Sets the `min-lines` of the inscription.
 //
-// See the [property@Gtk.Inscription:min-lines] property.
+// See the [Inscription.GetPropertyMinLines] property.
 func (x *Inscription) SetMinLines(MinLinesVar uint) {
 
 	xInscriptionSetMinLines(x.GoPointer(), MinLinesVar)
@@ -251,7 +296,7 @@ var xInscriptionSetNatChars func(uintptr, uint)
 
 // Sets the `nat-chars` of the inscription.
 //
-// See the [property@Gtk.Inscription:nat-chars] property.
+// See the [Inscription.GetPropertyNatChars] property.
 func (x *Inscription) SetNatChars(NatCharsVar uint) {
 
 	xInscriptionSetNatChars(x.GoPointer(), NatCharsVar)
@@ -262,7 +307,7 @@ var xInscriptionSetNatLines func(uintptr, uint)
 
 // Sets the `nat-lines` of the inscription.
 //
-// See the [property@Gtk.Inscription:nat-lines] property.
+// See the [Inscription.GetPropertyNatLines] property.
 func (x *Inscription) SetNatLines(NatLinesVar uint) {
 
 	xInscriptionSetNatLines(x.GoPointer(), NatLinesVar)
@@ -303,7 +348,7 @@ var xInscriptionSetXalign func(uintptr, float32)
 
 // Sets the `xalign` of the inscription.
 //
-// See the [property@Gtk.Inscription:xalign] property.
+// See the [Inscription.GetPropertyXalign] property.
 func (x *Inscription) SetXalign(XalignVar float32) {
 
 	xInscriptionSetXalign(x.GoPointer(), XalignVar)
@@ -314,7 +359,7 @@ var xInscriptionSetYalign func(uintptr, float32)
 
 // Sets the `yalign` of the inscription.
 //
-// See the [property@Gtk.Inscription:yalign] property.
+// See the [Inscription.GetPropertyYalign] property.
 func (x *Inscription) SetYalign(YalignVar float32) {
 
 	xInscriptionSetYalign(x.GoPointer(), YalignVar)
@@ -350,13 +395,13 @@ func (x *Inscription) GetPropertyAttributes() uintptr {
 }
 
 // SetPropertyMarkup sets the "markup" property.
-// Utility property that sets both the [property@Gtk.Inscription:text] and
-// [property@Gtk.Inscription:attributes] properties, mainly intended for use in
+// Utility property that sets both the [Inscription.GetPropertyText] and
+// [Inscription.GetPropertyAttributes] properties, mainly intended for use in
 // GtkBuilder ui files to ease translation support and bindings.
 //
-// This function uses [func@Pango.parse_markup] to parse the markup into text and
+// This function uses [pango.ParseMarkup] to parse the markup into text and
 // attributes. The markup must be valid. If you cannot ensure that, consider using
-// [func@Pango.parse_markup] and setting the two properties yourself.
+// [pango.ParseMarkup] and setting the two properties yourself.
 func (x *Inscription) SetPropertyMarkup(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -442,7 +487,7 @@ func (x *Inscription) GetPropertyMinLines() uint {
 // This influences the requested width, not the width actually given to the widget.
 // The widget might turn out larger as well as smaller.
 //
-// If this property is set to a value smaller than [property@Gtk.Inscription:min-chars],
+// If this property is set to a value smaller than [Inscription.GetPropertyMinChars],
 // that value will be used. In particular, for the default value of 0, this will always
 // be the case.
 func (x *Inscription) SetPropertyNatChars(value uint) {
@@ -458,7 +503,7 @@ func (x *Inscription) SetPropertyNatChars(value uint) {
 // This influences the requested width, not the width actually given to the widget.
 // The widget might turn out larger as well as smaller.
 //
-// If this property is set to a value smaller than [property@Gtk.Inscription:min-chars],
+// If this property is set to a value smaller than [Inscription.GetPropertyMinChars],
 // that value will be used. In particular, for the default value of 0, this will always
 // be the case.
 func (x *Inscription) GetPropertyNatChars() uint {
@@ -473,7 +518,7 @@ func (x *Inscription) GetPropertyNatChars() uint {
 // This influences the requested height, not the height actually given to the widget.
 // The widget might turn out larger as well as smaller.
 //
-// If this property is set to a value smaller than [property@Gtk.Inscription:min-lines],
+// If this property is set to a value smaller than [Inscription.GetPropertyMinLines],
 // that value will be used. In particular, for the default value of 0, this will always
 // be the case.
 func (x *Inscription) SetPropertyNatLines(value uint) {
@@ -489,7 +534,7 @@ func (x *Inscription) SetPropertyNatLines(value uint) {
 // This influences the requested height, not the height actually given to the widget.
 // The widget might turn out larger as well as smaller.
 //
-// If this property is set to a value smaller than [property@Gtk.Inscription:min-lines],
+// If this property is set to a value smaller than [Inscription.GetPropertyMinLines],
 // that value will be used. In particular, for the default value of 0, this will always
 // be the case.
 func (x *Inscription) GetPropertyNatLines() uint {
@@ -518,7 +563,7 @@ func (x *Inscription) GetPropertyText() string {
 // SetPropertyXalign sets the "xalign" property.
 // The horizontal alignment of the text inside the allocated size.
 //
-// Compare this to [property@Gtk.Widget:halign], which determines how the
+// Compare this to Widget:halign, which determines how the
 // inscription's size allocation is positioned in the available space.
 func (x *Inscription) SetPropertyXalign(value float32) {
 	var v gobject.Value
@@ -530,7 +575,7 @@ func (x *Inscription) SetPropertyXalign(value float32) {
 // GetPropertyXalign gets the "xalign" property.
 // The horizontal alignment of the text inside the allocated size.
 //
-// Compare this to [property@Gtk.Widget:halign], which determines how the
+// Compare this to Widget:halign, which determines how the
 // inscription's size allocation is positioned in the available space.
 func (x *Inscription) GetPropertyXalign() float32 {
 	var v gobject.Value
@@ -541,7 +586,7 @@ func (x *Inscription) GetPropertyXalign() float32 {
 // SetPropertyYalign sets the "yalign" property.
 // The vertical alignment of the text inside the allocated size.
 //
-// Compare this to [property@Gtk.Widget:valign], which determines how the
+// Compare this to Widget:valign, which determines how the
 // inscription's size allocation is positioned in the available space.
 func (x *Inscription) SetPropertyYalign(value float32) {
 	var v gobject.Value
@@ -553,7 +598,7 @@ func (x *Inscription) SetPropertyYalign(value float32) {
 // GetPropertyYalign gets the "yalign" property.
 // The vertical alignment of the text inside the allocated size.
 //
-// Compare this to [property@Gtk.Widget:valign], which determines how the
+// Compare this to Widget:valign, which determines how the
 // inscription's size allocation is positioned in the available space.
 func (x *Inscription) GetPropertyYalign() float32 {
 	var v gobject.Value
@@ -722,7 +767,7 @@ func (x *Inscription) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -730,14 +775,13 @@ func (x *Inscription) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Inscription) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -761,19 +805,17 @@ func (x *Inscription) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Inscription) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -794,7 +836,7 @@ func (x *Inscription) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -803,14 +845,12 @@ func (x *Inscription) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Inscription) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -866,10 +906,10 @@ func (x *Inscription) UpdateSelectionBound() {
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Inscription) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -881,7 +921,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -892,28 +932,30 @@ func init() {
 
 	core.PuregoSafeRegister(&xInscriptionGLibType, libs, "gtk_inscription_get_type")
 
-	core.PuregoSafeRegister(&xNewInscription, libs, "gtk_inscription_new")
-
-	core.PuregoSafeRegister(&xInscriptionGetAttributes, libs, "gtk_inscription_get_attributes")
-	core.PuregoSafeRegister(&xInscriptionGetMinChars, libs, "gtk_inscription_get_min_chars")
-	core.PuregoSafeRegister(&xInscriptionGetMinLines, libs, "gtk_inscription_get_min_lines")
-	core.PuregoSafeRegister(&xInscriptionGetNatChars, libs, "gtk_inscription_get_nat_chars")
-	core.PuregoSafeRegister(&xInscriptionGetNatLines, libs, "gtk_inscription_get_nat_lines")
-	core.PuregoSafeRegister(&xInscriptionGetText, libs, "gtk_inscription_get_text")
-	core.PuregoSafeRegister(&xInscriptionGetTextOverflow, libs, "gtk_inscription_get_text_overflow")
-	core.PuregoSafeRegister(&xInscriptionGetWrapMode, libs, "gtk_inscription_get_wrap_mode")
-	core.PuregoSafeRegister(&xInscriptionGetXalign, libs, "gtk_inscription_get_xalign")
-	core.PuregoSafeRegister(&xInscriptionGetYalign, libs, "gtk_inscription_get_yalign")
-	core.PuregoSafeRegister(&xInscriptionSetAttributes, libs, "gtk_inscription_set_attributes")
-	core.PuregoSafeRegister(&xInscriptionSetMarkup, libs, "gtk_inscription_set_markup")
-	core.PuregoSafeRegister(&xInscriptionSetMinChars, libs, "gtk_inscription_set_min_chars")
-	core.PuregoSafeRegister(&xInscriptionSetMinLines, libs, "gtk_inscription_set_min_lines")
-	core.PuregoSafeRegister(&xInscriptionSetNatChars, libs, "gtk_inscription_set_nat_chars")
-	core.PuregoSafeRegister(&xInscriptionSetNatLines, libs, "gtk_inscription_set_nat_lines")
-	core.PuregoSafeRegister(&xInscriptionSetText, libs, "gtk_inscription_set_text")
-	core.PuregoSafeRegister(&xInscriptionSetTextOverflow, libs, "gtk_inscription_set_text_overflow")
-	core.PuregoSafeRegister(&xInscriptionSetWrapMode, libs, "gtk_inscription_set_wrap_mode")
-	core.PuregoSafeRegister(&xInscriptionSetXalign, libs, "gtk_inscription_set_xalign")
-	core.PuregoSafeRegister(&xInscriptionSetYalign, libs, "gtk_inscription_set_yalign")
+	gobject.CheckClassSize("GtkInscriptionClass", xInscriptionGLibType, unsafe.Sizeof(InscriptionClass{}))
+
+	core.PuregoRegisterSince(&xNewInscription, libs, "gtk_inscription_new", "GTK", "4.8")
+
+	core.PuregoRegisterSince(&xInscriptionGetAttributes, libs, "gtk_inscription_get_attributes", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetMinChars, libs, "gtk_inscription_get_min_chars", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetMinLines, libs, "gtk_inscription_get_min_lines", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetNatChars, libs, "gtk_inscription_get_nat_chars", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetNatLines, libs, "gtk_inscription_get_nat_lines", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetText, libs, "gtk_inscription_get_text", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetTextOverflow, libs, "gtk_inscription_get_text_overflow", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetWrapMode, libs, "gtk_inscription_get_wrap_mode", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetXalign, libs, "gtk_inscription_get_xalign", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionGetYalign, libs, "gtk_inscription_get_yalign", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetAttributes, libs, "gtk_inscription_set_attributes", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetMarkup, libs, "gtk_inscription_set_markup", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetMinChars, libs, "gtk_inscription_set_min_chars", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetMinLines, libs, "gtk_inscription_set_min_lines", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetNatChars, libs, "gtk_inscription_set_nat_chars", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetNatLines, libs, "gtk_inscription_set_nat_lines", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetText, libs, "gtk_inscription_set_text", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetTextOverflow, libs, "gtk_inscription_set_text_overflow", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetWrapMode, libs, "gtk_inscription_set_wrap_mode", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetXalign, libs, "gtk_inscription_set_xalign", "GTK", "4.8")
+	core.PuregoRegisterSince(&xInscriptionSetYalign, libs, "gtk_inscription_set_yalign", "GTK", "4.8")
 
 }
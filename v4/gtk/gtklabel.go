@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -15,33 +14,26 @@ import (
 
 // Displays a small amount of text.
 //
-// Most labels are used to label another widget (such as an [class@Entry]).
+// Most labels are used to label another widget (such as an Entry).
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="label-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkLabel" src="label.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // `GtkLabel` supports the following keyboard shortcuts, when the cursor is
 // visible:
 //
-//   - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;A&lt;/kbd&gt; or &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;&amp;sol;&lt;/kbd&gt;
+//   - Shift+F10 or Menu opens the context menu.
+//   - Ctrl+A or Ctrl+&sol;
 //     selects all.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;A&lt;/kbd&gt; or
-//     &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;&amp;bsol;&lt;/kbd&gt; unselects all.
+//   - Ctrl+Shift+A or
+//     Ctrl+&bsol; unselects all.
 //
 // Additionally, the following signals have default keybindings:
 //
-// - [signal@Gtk.Label::activate-current-link]
-// - [signal@Gtk.Label::copy-clipboard]
-// - [signal@Gtk.Label::move-cursor]
+// - [Label.ConnectActivateCurrentLinkFunc]
+// - [Label.ConnectCopyClipboardFunc]
+// - [Label.ConnectMoveCursorFunc]
 //
-// ## Actions
+// # Actions
 //
 // `GtkLabel` defines a set of built-in actions:
 //
@@ -58,15 +50,13 @@ import (
 //   - `selection.select-all` selects all of the text, if the label allows
 //     selection.
 //
-// ## CSS nodes
+// # CSS nodes
 //
-// ```
-// label
-// ├── [selection]
-// ├── [link]
-// ┊
-// ╰── [link]
-// ```
+//	label
+//	├── [selection]
+//	├── [link]
+//	┊
+//	╰── [link]
 //
 // `GtkLabel` has a single CSS node with the name label. A wide variety
 // of style classes may be applied to labels, such as .title, .subtitle,
@@ -79,26 +69,22 @@ import (
 // carry the link or visited state depending on whether they have been
 // visited. In this case, label node also gets a .link style class.
 //
-// ## GtkLabel as GtkBuildable
+// # GtkLabel as GtkBuildable
 //
 // The GtkLabel implementation of the GtkBuildable interface supports a
-// custom `&lt;attributes&gt;` element, which supports any number of `&lt;attribute&gt;`
-// elements. The `&lt;attribute&gt;` element has attributes named “name“, “value“,
-// “start“ and “end“ and allows you to specify [struct@Pango.Attribute]
+// custom `<attributes>` element, which supports any number of `<attribute>`
+// elements. The `<attribute>` element has attributes named “name“, “value“,
+// “start“ and “end“ and allows you to specify [pango.Attribute]
 // values for this label.
 //
 // An example of a UI definition fragment specifying Pango attributes:
 //
-// ```xml
-// &lt;object class="GtkLabel"&gt;
-//
-//	&lt;attributes&gt;
-//	  &lt;attribute name="weight" value="PANGO_WEIGHT_BOLD"/&gt;
-//	  &lt;attribute name="background" value="red" start="5" end="10"/&gt;
-//	&lt;/attributes&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkLabel">
+//	  <attributes>
+//	    <attribute name="weight" value="PANGO_WEIGHT_BOLD"/>
+//	    <attribute name="background" value="red" start="5" end="10"/>
+//	  </attributes>
+//	</object>
 //
 // The start and end attributes specify the range of characters to which the
 // Pango attribute applies. If start and end are not specified, the attribute is
@@ -106,102 +92,95 @@ import (
 // sense with translatable attributes. Use markup embedded in the translatable
 // content instead.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkLabel` uses the [enum@Gtk.AccessibleRole.label] role.
+// `GtkLabel` uses the [AccessibleRoleLabelValue] role.
 //
-// ## Mnemonics
+// # Mnemonics
 //
 // Labels may contain “mnemonics”. Mnemonics are underlined characters in the
 // label, used for keyboard navigation. Mnemonics are created by providing a
 // string with an underscore before the mnemonic character, such as `"_File"`,
-// to the functions [ctor@Gtk.Label.new_with_mnemonic] or
-// [method@Gtk.Label.set_text_with_mnemonic].
+// to the functions [NewLabelWithMnemonic] or
+// [Label.SetTextWithMnemonic].
 //
 // Mnemonics automatically activate any activatable widget the label is
-// inside, such as a [class@Gtk.Button]; if the label is not inside the
+// inside, such as a [Button]; if the label is not inside the
 // mnemonic’s target widget, you have to tell the label about the target
-// using [method@Gtk.Label.set_mnemonic_widget].
+// using [Label.SetMnemonicWidget].
 //
 // Here’s a simple example where the label is inside a button:
 //
-// ```c
-// // Pressing Alt+H will activate this button
-// GtkWidget *button = gtk_button_new ();
-// GtkWidget *label = gtk_label_new_with_mnemonic ("_Hello");
-// gtk_button_set_child (GTK_BUTTON (button), label);
-// ```
+//	// Pressing Alt+H will activate this button
+//	GtkWidget *button = gtk_button_new ();
+//	GtkWidget *label = gtk_label_new_with_mnemonic ("_Hello");
+//	gtk_button_set_child (GTK_BUTTON (button), label);
 //
 // There’s a convenience function to create buttons with a mnemonic label
 // already inside:
 //
-// ```c
-// // Pressing Alt+H will activate this button
-// GtkWidget *button = gtk_button_new_with_mnemonic ("_Hello");
-// ```
+//	// Pressing Alt+H will activate this button
+//	GtkWidget *button = gtk_button_new_with_mnemonic ("_Hello");
 //
 // To create a mnemonic for a widget alongside the label, such as a
-// [class@Gtk.Entry], you have to point the label at the entry with
-// [method@Gtk.Label.set_mnemonic_widget]:
+// [Entry], you have to point the label at the entry with
+// [Label.SetMnemonicWidget]:
 //
-// ```c
-// // Pressing Alt+H will focus the entry
-// GtkWidget *entry = gtk_entry_new ();
-// GtkWidget *label = gtk_label_new_with_mnemonic ("_Hello");
-// gtk_label_set_mnemonic_widget (GTK_LABEL (label), entry);
-// ```
+//	// Pressing Alt+H will focus the entry
+//	GtkWidget *entry = gtk_entry_new ();
+//	GtkWidget *label = gtk_label_new_with_mnemonic ("_Hello");
+//	gtk_label_set_mnemonic_widget (GTK_LABEL (label), entry);
 //
-// ## Markup (styled text)
+// # Markup (styled text)
 //
 // To make it easy to format text in a label (changing colors, fonts, etc.),
 // label text can be provided in a simple markup format:
 //
 // Here’s how to create a label with a small font:
-// ```c
-// GtkWidget *label = gtk_label_new (NULL);
-// gtk_label_set_markup (GTK_LABEL (label), "&lt;small&gt;Small text&lt;/small&gt;");
-// ```
+//
+//	GtkWidget *label = gtk_label_new (NULL);
+//	gtk_label_set_markup (GTK_LABEL (label), "<small>Small text</small>");
 //
 // (See the Pango manual for complete documentation] of available
-// tags, [func@Pango.parse_markup])
+// tags, [pango.ParseMarkup])
 //
-// The markup passed to [method@Gtk.Label.set_markup] must be valid XML; for example,
-// literal `&lt;`, `&gt;` and `&amp;` characters must be escaped as `&amp;lt;`, `&amp;gt;`, and `&amp;amp;`.
+// The markup passed to [Label.SetMarkup] must be valid XML; for example,
+// literal `<`, `>` and `&` characters must be escaped as `&lt;`, `&gt;`, and `&amp;`.
 // If you pass text obtained from the user, file, or a network to
-// [method@Gtk.Label.set_markup], you’ll want to escape it with
-// [func@GLib.markup_escape_text] or [func@GLib.markup_printf_escaped].
+// [Label.SetMarkup], you’ll want to escape it with
+// [glib.MarkupEscapeText] or [glib.MarkupPrintfEscaped].
 //
-// Markup strings are just a convenient way to set the [struct@Pango.AttrList]
-// on a label; [method@Gtk.Label.set_attributes] may be a simpler way to set
-// attributes in some cases. Be careful though; [struct@Pango.AttrList] tends
+// Markup strings are just a convenient way to set the [pango.AttrList]
+// on a label; [Label.SetAttributes] may be a simpler way to set
+// attributes in some cases. Be careful though; [pango.AttrList] tends
 // to cause internationalization problems, unless you’re applying attributes
 // to the entire string (i.e. unless you set the range of each attribute
 // to [0, `G_MAXINT`)). The reason is that specifying the `start_index` and
-// `end_index` for a [struct@Pango.Attribute] requires knowledge of the exact
+// `end_index` for a [pango.Attribute] requires knowledge of the exact
 // string being displayed, so translations will cause problems.
 //
-// ## Selectable labels
+// # Selectable labels
 //
-// Labels can be made selectable with [method@Gtk.Label.set_selectable].
+// Labels can be made selectable with [Label.SetSelectable].
 // Selectable labels allow the user to copy the label contents to the
 // clipboard. Only labels that contain useful-to-copy information — such
 // as error messages — should be made selectable.
 //
-// ## Text layout
+// # Text layout
 //
 // A label can contain any number of paragraphs, but will have
 // performance problems if it contains more than a small number.
 // Paragraphs are separated by newlines or other paragraph separators
 // understood by Pango.
 //
-// Labels can automatically wrap text if you call [method@Gtk.Label.set_wrap].
+// Labels can automatically wrap text if you call [Label.SetWrap].
 //
-// [method@Gtk.Label.set_justify] sets how the lines in a label align
+// [Label.SetJustify] sets how the lines in a label align
 // with one another. If you want to set how the label as a whole aligns
-// in its available space, see the [property@Gtk.Widget:halign] and
-// [property@Gtk.Widget:valign] properties.
+// in its available space, see the Widget:halign and
+// Widget:valign properties.
 //
-// The [property@Gtk.Label:width-chars] and [property@Gtk.Label:max-width-chars]
+// The [Label.GetPropertyWidthChars] and [Label.GetPropertyMaxWidthChars]
 // properties can be used to control the size allocation of ellipsized or
 // wrapped labels. For ellipsizing labels, if either is specified (and less
 // than the actual text size), it is used as the minimum width, and the actual
@@ -210,10 +189,10 @@ import (
 // is used as the natural width. Even if max-width-chars specified, wrapping
 // labels will be rewrapped to use all of the available width.
 //
-// ## Links
+// # Links
 //
 // GTK supports markup for clickable hyperlinks in addition to regular Pango
-// markup. The markup for links is borrowed from HTML, using the `&lt;a&gt;` tag
+// markup. The markup for links is borrowed from HTML, using the `<a>` tag
 // with “href“, “title“ and “class“ attributes. GTK renders links similar to
 // the way they appear in web browsers, with colored, underlined text. The
 // “title“ attribute is displayed as a tooltip on the link. The “class“
@@ -221,18 +200,16 @@ import (
 //
 // An example of inline links looks like this:
 //
-// ```c
-// const char *text =
-// "Go to the "
-// "&lt;a href=\"https://www.gtk.org\" title=\"&amp;lt;i&amp;gt;Our&amp;lt;/i&amp;gt; website\"&gt;"
-// "GTK website&lt;/a&gt; for more...";
-// GtkWidget *label = gtk_label_new (NULL);
-// gtk_label_set_markup (GTK_LABEL (label), text);
-// ```
+//	const char *text =
+//	"Go to the "
+//	"<a href=\"https://www.gtk.org\" title=\"&lt;i&gt;Our&lt;/i&gt; website\">"
+//	"GTK website</a> for more...";
+//	GtkWidget *label = gtk_label_new (NULL);
+//	gtk_label_set_markup (GTK_LABEL (label), text);
 //
 // It is possible to implement custom handling for links and their tooltips
-// with the [signal@Gtk.Label::activate-link] signal and the
-// [method@Gtk.Label.get_current_uri] function.
+// with the [Label.ConnectActivateLinkFunc] signal and the
+// [Label.GetCurrentUri] function.
 type Label struct {
 	Widget
 }
@@ -275,14 +252,14 @@ var xNewLabelWithMnemonic func(uintptr) uintptr
 
 // Creates a new label with the given text inside it, and a mnemonic.
 //
-// If characters in @str are preceded by an underscore, they are
+// If characters in str are preceded by an underscore, they are
 // underlined. If you need a literal underscore character in a label, use
 // '__' (two underscores). The first underlined character represents a
 // keyboard accelerator called a mnemonic. The mnemonic key can be used
 // to activate another widget, chosen automatically, or explicitly using
-// [method@Gtk.Label.set_mnemonic_widget].
+// [Label.SetMnemonicWidget].
 //
-// If [method@Gtk.Label.set_mnemonic_widget] is not called, then the first
+// If [Label.SetMnemonicWidget] is not called, then the first
 // activatable ancestor of the label will be chosen as the mnemonic
 // widget. For instance, if the label is inside a button or menu item,
 // the button or menu item will automatically become the mnemonic widget
@@ -308,10 +285,10 @@ var xLabelGetAttributes func(uintptr) *pango.AttrList
 
 // Gets the label's attribute list.
 //
-// This is the [struct@Pango.AttrList] that was set on the label using
-// [method@Gtk.Label.set_attributes], if any. This function does not
+// This is the [pango.AttrList] that was set on the label using
+// [Label.SetAttributes], if any. This function does not
 // reflect attributes that come from the label's markup (see
-// [method@Gtk.Label.set_markup]). If you want to get the effective
+// [Label.SetMarkup]). If you want to get the effective
 // attributes for the label, use
 // `pango_layout_get_attributes (gtk_label_get_layout (self))`.
 func (x *Label) GetAttributes() *pango.AttrList {
@@ -328,8 +305,8 @@ var xLabelGetCurrentUri func(uintptr) string
 // selectable label, the link in which the text cursor is currently
 // positioned.
 //
-// This function is intended for use in a [signal@Gtk.Label::activate-link]
-// handler or for use in a [signal@Gtk.Widget::query-tooltip] handler.
+// This function is intended for use in a [Label.ConnectActivateLinkFunc]
+// handler or for use in a [Widget.ConnectQueryTooltipFunc] handler.
 func (x *Label) GetCurrentUri() string {
 
 	cret := xLabelGetCurrentUri(x.GoPointer())
@@ -340,7 +317,7 @@ var xLabelGetEllipsize func(uintptr) pango.EllipsizeMode
 
 // Returns the ellipsization mode of the label.
 //
-// See [method@Gtk.Label.set_ellipsize].
+// See [Label.SetEllipsize].
 func (x *Label) GetEllipsize() pango.EllipsizeMode {
 
 	cret := xLabelGetEllipsize(x.GoPointer())
@@ -351,7 +328,7 @@ var xLabelGetExtraMenu func(uintptr) uintptr
 
 // Gets the extra menu model of the label.
 //
-// See [method@Gtk.Label.set_extra_menu].
+// See [Label.SetExtraMenu].
 func (x *Label) GetExtraMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -370,7 +347,7 @@ var xLabelGetJustify func(uintptr) Justification
 
 // Returns the justification of the label.
 //
-// See [method@Gtk.Label.set_justify].
+// See [Label.SetJustify].
 func (x *Label) GetJustify() Justification {
 
 	cret := xLabelGetJustify(x.GoPointer())
@@ -382,7 +359,7 @@ var xLabelGetLabel func(uintptr) string
 // Fetches the text from a label.
 //
 // The returned text includes any embedded underlines indicating
-// mnemonics and Pango markup. (See [method@Gtk.Label.get_text]).
+// mnemonics and Pango markup. (See [Label.GetText]).
 func (x *Label) GetLabel() string {
 
 	cret := xLabelGetLabel(x.GoPointer())
@@ -394,9 +371,9 @@ var xLabelGetLayout func(uintptr) uintptr
 // Gets the Pango layout used to display the label.
 //
 // The layout is useful to e.g. convert text positions to pixel
-// positions, in combination with [method@Gtk.Label.get_layout_offsets].
-// The returned layout is owned by the @label so need not be
-// freed by the caller. The @label is free to recreate its layout
+// positions, in combination with [Label.GetLayoutOffsets].
+// The returned layout is owned by the label so need not be
+// freed by the caller. The label is free to recreate its layout
 // at any time, so it should be considered read-only.
 func (x *Label) GetLayout() *pango.Layout {
 	var cls *pango.Layout
@@ -417,10 +394,10 @@ var xLabelGetLayoutOffsets func(uintptr, *int, *int)
 // Obtains the coordinates where the label will draw its Pango layout.
 //
 // The coordinates are useful to convert mouse events into coordinates
-// inside the [class@Pango.Layout], e.g. to take some action if some part
-// of the label is clicked. Remember when using the [class@Pango.Layout]
+// inside the [pango.Layout], e.g. to take some action if some part
+// of the label is clicked. Remember when using the [pango.Layout]
 // functions you need to convert to and from pixels using `PANGO_PIXELS()`
-// or [const@Pango.SCALE].
+// or [pango.SCALE].
 func (x *Label) GetLayoutOffsets(XVar *int, YVar *int) {
 
 	xLabelGetLayoutOffsets(x.GoPointer(), XVar, YVar)
@@ -432,7 +409,7 @@ var xLabelGetLines func(uintptr) int
 // Gets the number of lines to which an ellipsized, wrapping
 // label should be limited.
 //
-// See [method@Gtk.Label.set_lines].
+// See [Label.SetLines].
 func (x *Label) GetLines() int {
 
 	cret := xLabelGetLines(x.GoPointer())
@@ -443,7 +420,7 @@ var xLabelGetMaxWidthChars func(uintptr) int
 
 // Retrieves the maximum width of the label in characters.
 //
-// See [method@Gtk.Label.set_width_chars].
+// See [Label.SetWidthChars].
 func (x *Label) GetMaxWidthChars() int {
 
 	cret := xLabelGetMaxWidthChars(x.GoPointer())
@@ -467,7 +444,7 @@ var xLabelGetMnemonicWidget func(uintptr) uintptr
 
 // Retrieves the mnemonic target of this label.
 //
-// See [method@Gtk.Label.set_mnemonic_widget].
+// See [Label.SetMnemonicWidget].
 func (x *Label) GetMnemonicWidget() *Widget {
 	var cls *Widget
 
@@ -486,7 +463,7 @@ var xLabelGetNaturalWrapMode func(uintptr) NaturalWrapMode
 
 // Returns natural line wrap mode used by the label.
 //
-// See [method@Gtk.Label.set_natural_wrap_mode].
+// See [Label.SetNaturalWrapMode].
 func (x *Label) GetNaturalWrapMode() NaturalWrapMode {
 
 	cret := xLabelGetNaturalWrapMode(x.GoPointer())
@@ -506,7 +483,7 @@ var xLabelGetSelectionBounds func(uintptr, *int, *int) bool
 
 // Gets the selected range of characters in the label.
 //
-// The returned @start and @end positions are in characters.
+// The returned start and end positions are in characters.
 func (x *Label) GetSelectionBounds(StartVar *int, EndVar *int) bool {
 
 	cret := xLabelGetSelectionBounds(x.GoPointer(), StartVar, EndVar)
@@ -539,7 +516,7 @@ var xLabelGetText func(uintptr) string
 //
 // The returned text is as it appears on screen. This does not include
 // any embedded underlines indicating mnemonics or Pango markup. (See
-// [method@Gtk.Label.get_label])
+// [Label.GetLabel])
 func (x *Label) GetText() string {
 
 	cret := xLabelGetText(x.GoPointer())
@@ -550,7 +527,7 @@ var xLabelGetUseMarkup func(uintptr) bool
 
 // Returns whether the label’s text is interpreted as Pango markup.
 //
-// See [method@Gtk.Label.set_use_markup].
+// See [Label.SetUseMarkup].
 func (x *Label) GetUseMarkup() bool {
 
 	cret := xLabelGetUseMarkup(x.GoPointer())
@@ -561,7 +538,7 @@ var xLabelGetUseUnderline func(uintptr) bool
 
 // Returns whether underlines in the label indicate mnemonics.
 //
-// See [method@Gtk.Label.set_use_underline].
+// See [Label.SetUseUnderline].
 func (x *Label) GetUseUnderline() bool {
 
 	cret := xLabelGetUseUnderline(x.GoPointer())
@@ -572,7 +549,7 @@ var xLabelGetWidthChars func(uintptr) int
 
 // Retrieves the desired width of the label in characters.
 //
-// See [method@Gtk.Label.set_width_chars].
+// See [Label.SetWidthChars].
 func (x *Label) GetWidthChars() int {
 
 	cret := xLabelGetWidthChars(x.GoPointer())
@@ -583,7 +560,7 @@ var xLabelGetWrap func(uintptr) bool
 
 // Returns whether lines in the label are automatically wrapped.
 //
-// See [method@Gtk.Label.set_wrap].
+// See [Label.SetWrap].
 func (x *Label) GetWrap() bool {
 
 	cret := xLabelGetWrap(x.GoPointer())
@@ -594,7 +571,7 @@ var xLabelGetWrapMode func(uintptr) pango.WrapMode
 
 // Returns line wrap mode used by the label.
 //
-// See [method@Gtk.Label.set_wrap_mode].
+// See [Label.SetWrapMode].
 func (x *Label) GetWrapMode() pango.WrapMode {
 
 	cret := xLabelGetWrapMode(x.GoPointer())
@@ -605,7 +582,7 @@ var xLabelGetXalign func(uintptr) float32
 
 // Gets the `xalign` of the label.
 //
-// See the [property@Gtk.Label:xalign] property.
+// See the [Label.GetPropertyXalign] property.
 func (x *Label) GetXalign() float32 {
 
 	cret := xLabelGetXalign(x.GoPointer())
@@ -616,7 +593,7 @@ var xLabelGetYalign func(uintptr) float32
 
 // Gets the `yalign` of the label.
 //
-// See the [property@Gtk.Label:yalign] property.
+// See the [Label.GetPropertyYalign] property.
 func (x *Label) GetYalign() float32 {
 
 	cret := xLabelGetYalign(x.GoPointer())
@@ -627,9 +604,9 @@ var xLabelSelectRegion func(uintptr, int, int)
 
 // Selects a range of characters in the label, if the label is selectable.
 //
-// See [method@Gtk.Label.set_selectable]. If the label is not selectable,
-// this function has no effect. If @start_offset or
-// @end_offset are -1, then the end of the label will be substituted.
+// See [Label.SetSelectable]. If the label is not selectable,
+// this function has no effect. If start_offset or
+// end_offset are -1, then the end of the label will be substituted.
 func (x *Label) SelectRegion(StartOffsetVar int, EndOffsetVar int) {
 
 	xLabelSelectRegion(x.GoPointer(), StartOffsetVar, EndOffsetVar)
@@ -642,7 +619,7 @@ var xLabelSetAttributes func(uintptr, *pango.AttrList)
 //
 // The attributes set with this function will be applied and merged with
 // any other attributes previously effected by way of the
-// [property@Gtk.Label:use-underline] or [property@Gtk.Label:use-markup]
+// [Label.GetPropertyUseUnderline] or [Label.GetPropertyUseMarkup]
 // properties
 //
 // While it is not recommended to mix markup strings with manually set
@@ -681,11 +658,11 @@ var xLabelSetJustify func(uintptr, Justification)
 //
 // This function has no effect on labels containing only a single line.
 //
-// [enum@Gtk.Justification.left] is the default value when the widget
-// is first created with [ctor@Gtk.Label.new].
+// [JustifyLeftValue] is the default value when the widget
+// is first created with [NewLabel].
 //
 // If you instead want to set the alignment of the label as a whole,
-// use [method@Gtk.Widget.set_halign] instead.
+// use [Widget.SetHalign] instead.
 func (x *Label) SetJustify(JtypeVar Justification) {
 
 	xLabelSetJustify(x.GoPointer(), JtypeVar)
@@ -697,8 +674,8 @@ var xLabelSetLabel func(uintptr, string)
 // Sets the text of the label.
 //
 // The label is interpreted as including embedded underlines and/or Pango
-// markup depending on the values of the [property@Gtk.Label:use-underline]
-// and [property@Gtk.Label:use-markup] properties.
+// markup depending on the values of the [Label.GetPropertyUseUnderline]
+// and [Label.GetPropertyUseMarkup] properties.
 func (x *Label) SetLabel(StrVar string) {
 
 	xLabelSetLabel(x.GoPointer(), StrVar)
@@ -723,26 +700,24 @@ var xLabelSetMarkup func(uintptr, string)
 // Sets the labels text and attributes from markup.
 //
 // The string must be marked up with Pango markup
-// (see [func@Pango.parse_markup]).
+// (see [pango.ParseMarkup]).
 //
-// If @str is external data, you may need to escape it
-// with [func@GLib.markup_escape_text] or [func@GLib.markup_printf_escaped]:
+// If str is external data, you may need to escape it
+// with [glib.MarkupEscapeText] or [glib.MarkupPrintfEscaped]:
 //
-// ```c
-// GtkWidget *self = gtk_label_new (NULL);
-// const char *str = "...";
-// const char *format = "&lt;span style=\"italic\"&gt;\%s&lt;/span&gt;";
-// char *markup;
+//	GtkWidget *self = gtk_label_new (NULL);
+//	const char *str = "...";
+//	const char *format = "<span style=\"italic\">\%s</span>";
+//	char *markup;
 //
-// markup = g_markup_printf_escaped (format, str);
-// gtk_label_set_markup (GTK_LABEL (self), markup);
-// g_free (markup);
-// ```
+//	markup = g_markup_printf_escaped (format, str);
+//	gtk_label_set_markup (GTK_LABEL (self), markup);
+//	g_free (markup);
 //
-// This function sets the [property@Gtk.Label:use-markup] property
+// This function sets the [Label.GetPropertyUseMarkup] property
 // to true.
 //
-// Also see [method@Gtk.Label.set_text].
+// Also see [Label.SetText].
 func (x *Label) SetMarkup(StrVar string) {
 
 	xLabelSetMarkup(x.GoPointer(), StrVar)
@@ -753,13 +728,13 @@ var xLabelSetMarkupWithMnemonic func(uintptr, string)
 
 // Sets the labels text, attributes and mnemonic from markup.
 //
-// Parses @str which is marked up with Pango markup (see [func@Pango.parse_markup]),
+// Parses str which is marked up with Pango markup (see [pango.ParseMarkup]),
 // setting the label’s text and attribute list based on the parse results.
-// If characters in @str are preceded by an underscore, they are underlined
+// If characters in str are preceded by an underscore, they are underlined
 // indicating that they represent a keyboard accelerator called a mnemonic.
 //
 // The mnemonic key can be used to activate another widget, chosen
-// automatically, or explicitly using [method@Gtk.Label.set_mnemonic_widget].
+// automatically, or explicitly using [Label.SetMnemonicWidget].
 func (x *Label) SetMarkupWithMnemonic(StrVar string) {
 
 	xLabelSetMarkupWithMnemonic(x.GoPointer(), StrVar)
@@ -780,18 +755,18 @@ var xLabelSetMnemonicWidget func(uintptr, uintptr)
 // Associate the label with its mnemonic target.
 //
 // If the label has been set so that it has a mnemonic key (using
-// i.e. [method@Gtk.Label.set_markup_with_mnemonic],
-// [method@Gtk.Label.set_text_with_mnemonic],
-// [ctor@Gtk.Label.new_with_mnemonic]
-// or the [property@Gtk.Label:use_underline] property) the label can
+// i.e. [Label.SetMarkupWithMnemonic],
+// [Label.SetTextWithMnemonic],
+// [NewLabelWithMnemonic]
+// or the Label:use_underline property) the label can
 // be associated with a widget that is the target of the mnemonic.
-// When the label is inside a widget (like a [class@Gtk.Button] or a
-// [class@Gtk.Notebook] tab) it is automatically associated with the
-// correct widget, but sometimes (i.e. when the target is a [class@Gtk.Entry]
+// When the label is inside a widget (like a [Button] or a
+// [Notebook] tab) it is automatically associated with the
+// correct widget, but sometimes (i.e. when the target is a [Entry]
 // next to the label) you need to set it explicitly using this function.
 //
 // The target widget will be accelerated by emitting the
-// [signal@Gtk.Widget::mnemonic-activate] signal on it. The default handler
+// [Widget.ConnectMnemonicActivateFunc] signal on it. The default handler
 // for this signal will activate the widget if there are no mnemonic
 // collisions and toggle focus between the colliding widgets otherwise.
 func (x *Label) SetMnemonicWidget(WidgetVar *Widget) {
@@ -805,7 +780,7 @@ var xLabelSetNaturalWrapMode func(uintptr, NaturalWrapMode)
 // Selects the line wrapping for the natural size request.
 //
 // This only affects the natural size requested, for the actual wrapping used,
-// see the [property@Gtk.Label:wrap-mode] property.
+// see the Label:wrap-mode property.
 func (x *Label) SetNaturalWrapMode(WrapModeVar NaturalWrapMode) {
 
 	xLabelSetNaturalWrapMode(x.GoPointer(), WrapModeVar)
@@ -848,10 +823,10 @@ var xLabelSetText func(uintptr, string)
 //
 // It overwrites any text that was there before and clears any
 // previously set mnemonic accelerators, and sets the
-// [property@Gtk.Label:use-underline] and
-// [property@Gtk.Label:use-markup] properties to false.
+// [Label.GetPropertyUseUnderline] and
+// [Label.GetPropertyUseMarkup] properties to false.
 //
-// Also see [method@Gtk.Label.set_markup].
+// Also see [Label.SetMarkup].
 func (x *Label) SetText(StrVar string) {
 
 	xLabelSetText(x.GoPointer(), StrVar)
@@ -862,10 +837,10 @@ var xLabelSetTextWithMnemonic func(uintptr, string)
 
 // Sets the text for the label, with mnemonics.
 //
-// If characters in @str are preceded by an underscore, they are underlined
+// If characters in str are preceded by an underscore, they are underlined
 // indicating that they represent a keyboard accelerator called a mnemonic.
 // The mnemonic key can be used to activate another widget, chosen
-// automatically, or explicitly using [method@Gtk.Label.set_mnemonic_widget].
+// automatically, or explicitly using [Label.SetMnemonicWidget].
 func (x *Label) SetTextWithMnemonic(StrVar string) {
 
 	xLabelSetTextWithMnemonic(x.GoPointer(), StrVar)
@@ -876,7 +851,7 @@ var xLabelSetUseMarkup func(uintptr, bool)
 
 // Sets whether the text of the label contains markup.
 //
-// See [method@Gtk.Label.set_markup].
+// See [Label.SetMarkup].
 func (x *Label) SetUseMarkup(SettingVar bool) {
 
 	xLabelSetUseMarkup(x.GoPointer(), SettingVar)
@@ -913,7 +888,7 @@ var xLabelSetWrap func(uintptr, bool)
 // wrap at its parent widget’s width, because GTK widgets conceptually
 // can’t make their requisition depend on the parent  widget’s size.
 // For a label that wraps at a specific position, set the label’s width
-// using [method@Gtk.Widget.set_size_request].
+// using [Widget.SetSizeRequest].
 func (x *Label) SetWrap(WrapVar bool) {
 
 	xLabelSetWrap(x.GoPointer(), WrapVar)
@@ -925,13 +900,13 @@ var xLabelSetWrapMode func(uintptr, pango.WrapMode)
 // Controls how line wrapping is done.
 //
 // This only affects the label if line wrapping is on. (See
-// [method@Gtk.Label.set_wrap])
+// [Label.SetWrap])
 //
-// The default is [enum@Pango.WrapMode.word], which means
+// The default is [pango.WrapWordValue], which means
 // wrap on word boundaries.
 //
 // For sizing behavior, also consider the
-// [property@Gtk.Label:natural-wrap-mode] property.
+// Label:natural-wrap-mode property.
 func (x *Label) SetWrapMode(WrapModeVar pango.WrapMode) {
 
 	xLabelSetWrapMode(x.GoPointer(), WrapModeVar)
@@ -942,7 +917,7 @@ var xLabelSetXalign func(uintptr, float32)
 
 // Sets the `xalign` of the label.
 //
-// See the [property@Gtk.Label:xalign] property.
+// See the [Label.GetPropertyXalign] property.
 func (x *Label) SetXalign(XalignVar float32) {
 
 	xLabelSetXalign(x.GoPointer(), XalignVar)
@@ -953,7 +928,7 @@ var xLabelSetYalign func(uintptr, float32)
 
 // Sets the `yalign` of the label.
 //
-// See the [property@Gtk.Label:yalign] property.
+// See the [Label.GetPropertyYalign] property.
 func (x *Label) SetYalign(YalignVar float32) {
 
 	xLabelSetYalign(x.GoPointer(), YalignVar)
@@ -991,15 +966,15 @@ func (x *Label) GetPropertyAttributes() uintptr {
 // SetPropertyLabel sets the "label" property.
 // The contents of the label.
 //
-// If the string contains Pango markup (see [func@Pango.parse_markup]),
-// you will have to set the [property@Gtk.Label:use-markup] property to
+// If the string contains Pango markup (see [pango.ParseMarkup]),
+// you will have to set the [Label.GetPropertyUseMarkup] property to
 // true in order for the label to display the markup attributes. See also
-// [method@Gtk.Label.set_markup] for a convenience function that sets both
-// this property and the [property@Gtk.Label:use-markup] property at the
+// [Label.SetMarkup] for a convenience function that sets both
+// this property and the [Label.GetPropertyUseMarkup] property at the
 // same time.
 //
 // If the string contains underlines acting as mnemonics, you will have to
-// set the [property@Gtk.Label:use-underline] property to true in order
+// set the [Label.GetPropertyUseUnderline] property to true in order
 // for the label to display them.
 func (x *Label) SetPropertyLabel(value string) {
 	var v gobject.Value
@@ -1011,15 +986,15 @@ func (x *Label) SetPropertyLabel(value string) {
 // GetPropertyLabel gets the "label" property.
 // The contents of the label.
 //
-// If the string contains Pango markup (see [func@Pango.parse_markup]),
-// you will have to set the [property@Gtk.Label:use-markup] property to
+// If the string contains Pango markup (see [pango.ParseMarkup]),
+// you will have to set the [Label.GetPropertyUseMarkup] property to
 // true in order for the label to display the markup attributes. See also
-// [method@Gtk.Label.set_markup] for a convenience function that sets both
-// this property and the [property@Gtk.Label:use-markup] property at the
+// [Label.SetMarkup] for a convenience function that sets both
+// this property and the [Label.GetPropertyUseMarkup] property at the
 // same time.
 //
 // If the string contains underlines acting as mnemonics, you will have to
-// set the [property@Gtk.Label:use-underline] property to true in order
+// set the [Label.GetPropertyUseUnderline] property to true in order
 // for the label to display them.
 func (x *Label) GetPropertyLabel() string {
 	var v gobject.Value
@@ -1079,8 +1054,8 @@ func (x *Label) GetPropertyLines() int {
 //
 // If this property is set to -1, the width will be calculated automatically.
 //
-// See the section on [text layout](class.Label.html#text-layout) for details
-// of how [property@Gtk.Label:width-chars] and [property@Gtk.Label:max-width-chars]
+// See the section on text layout for details
+// of how [Label.GetPropertyWidthChars] and [Label.GetPropertyMaxWidthChars]
 // determine the width of ellipsized and wrapped labels.
 func (x *Label) SetPropertyMaxWidthChars(value int) {
 	var v gobject.Value
@@ -1094,8 +1069,8 @@ func (x *Label) SetPropertyMaxWidthChars(value int) {
 //
 // If this property is set to -1, the width will be calculated automatically.
 //
-// See the section on [text layout](class.Label.html#text-layout) for details
-// of how [property@Gtk.Label:width-chars] and [property@Gtk.Label:max-width-chars]
+// See the section on text layout for details
+// of how [Label.GetPropertyWidthChars] and [Label.GetPropertyMaxWidthChars]
 // determine the width of ellipsized and wrapped labels.
 func (x *Label) GetPropertyMaxWidthChars() int {
 	var v gobject.Value
@@ -1175,7 +1150,7 @@ func (x *Label) GetPropertyTabs() uintptr {
 // SetPropertyUseMarkup sets the "use-markup" property.
 // True if the text of the label includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *Label) SetPropertyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1186,7 +1161,7 @@ func (x *Label) SetPropertyUseMarkup(value bool) {
 // GetPropertyUseMarkup gets the "use-markup" property.
 // True if the text of the label includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *Label) GetPropertyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("use-markup", &v)
@@ -1217,8 +1192,8 @@ func (x *Label) GetPropertyUseUnderline() bool {
 //
 // If this property is set to -1, the width will be calculated automatically.
 //
-// See the section on [text layout](class.Label.html#text-layout) for details
-// of how [property@Gtk.Label:width-chars] and [property@Gtk.Label:max-width-chars]
+// See the section on text layout for details
+// of how [Label.GetPropertyWidthChars] and [Label.GetPropertyMaxWidthChars]
 // determine the width of ellipsized and wrapped labels.
 func (x *Label) SetPropertyWidthChars(value int) {
 	var v gobject.Value
@@ -1232,8 +1207,8 @@ func (x *Label) SetPropertyWidthChars(value int) {
 //
 // If this property is set to -1, the width will be calculated automatically.
 //
-// See the section on [text layout](class.Label.html#text-layout) for details
-// of how [property@Gtk.Label:width-chars] and [property@Gtk.Label:max-width-chars]
+// See the section on text layout for details
+// of how [Label.GetPropertyWidthChars] and [Label.GetPropertyMaxWidthChars]
 // determine the width of ellipsized and wrapped labels.
 func (x *Label) GetPropertyWidthChars() int {
 	var v gobject.Value
@@ -1261,7 +1236,7 @@ func (x *Label) GetPropertyWrap() bool {
 // SetPropertyXalign sets the "xalign" property.
 // The horizontal alignment of the label text inside its size allocation.
 //
-// Compare this to [property@Gtk.Widget:halign], which determines how the
+// Compare this to Widget:halign, which determines how the
 // labels size allocation is positioned in the space available for the label.
 func (x *Label) SetPropertyXalign(value float32) {
 	var v gobject.Value
@@ -1273,7 +1248,7 @@ func (x *Label) SetPropertyXalign(value float32) {
 // GetPropertyXalign gets the "xalign" property.
 // The horizontal alignment of the label text inside its size allocation.
 //
-// Compare this to [property@Gtk.Widget:halign], which determines how the
+// Compare this to Widget:halign, which determines how the
 // labels size allocation is positioned in the space available for the label.
 func (x *Label) GetPropertyXalign() float32 {
 	var v gobject.Value
@@ -1284,7 +1259,7 @@ func (x *Label) GetPropertyXalign() float32 {
 // SetPropertyYalign sets the "yalign" property.
 // The vertical alignment of the label text inside its size allocation.
 //
-// Compare this to [property@Gtk.Widget:valign], which determines how the
+// Compare this to Widget:valign, which determines how the
 // labels size allocation is positioned in the space available for the label.
 func (x *Label) SetPropertyYalign(value float32) {
 	var v gobject.Value
@@ -1296,7 +1271,7 @@ func (x *Label) SetPropertyYalign(value float32) {
 // GetPropertyYalign gets the "yalign" property.
 // The vertical alignment of the label text inside its size allocation.
 //
-// Compare this to [property@Gtk.Widget:valign], which determines how the
+// Compare this to Widget:valign, which determines how the
 // labels size allocation is positioned in the space available for the label.
 func (x *Label) GetPropertyYalign() float32 {
 	var v gobject.Value
@@ -1306,130 +1281,218 @@ func (x *Label) GetPropertyYalign() float32 {
 
 // Gets emitted when the user activates a link in the label.
 //
-// The `::activate-current-link` is a [keybinding signal](class.SignalAction.html).
+// The `::activate-current-link` is a keybinding signal.
 //
-// Applications may also emit the signal with g_signal_emit_by_name()
+// Applications may also emit the signal with [gobject.SignalEmitByName]
 // if they need to control activation of URIs programmatically.
 //
-// The default bindings for this signal are all forms of the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// The default bindings for this signal are all forms of the Enter key.
+//
+// Deprecated: use ConnectActivateCurrentLinkFunc, which also accepts method values and closures.
 func (x *Label) ConnectActivateCurrentLink(cb *func(Label)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-current-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Label{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-current-link", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xLabelActivateCurrentLinkTrampoline uintptr
+
+func xLabelActivateCurrentLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Label))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Label{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-current-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateCurrentLinkFunc connects cb to the "activate-current-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Label) ConnectActivateCurrentLinkFunc(cb func(Label)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-current-link", &xLabelActivateCurrentLinkTrampoline, xLabelActivateCurrentLinkNewTrampoline, cb)
 }
 
 // Gets emitted to activate a URI.
 //
 // Applications may connect to it to override the default behaviour,
-// which is to call [method@Gtk.FileLauncher.launch].
+// which is to call [FileLauncher.Launch].
+//
+// Deprecated: use ConnectActivateLinkFunc, which also accepts method values and closures.
 func (x *Label) ConnectActivateLink(cb *func(Label, string) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, UriVarp string) bool {
+			defer core.RecoverPanic()
+			fa := Label{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, UriVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-link", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, UriVarp string) bool {
+var xLabelActivateLinkTrampoline uintptr
+
+func xLabelActivateLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, UriVarp string, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Label, string) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Label{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, UriVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateLinkFunc connects cb to the "activate-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Label) ConnectActivateLinkFunc(cb func(Label, string) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-link", &xLabelActivateLinkTrampoline, xLabelActivateLinkNewTrampoline, cb)
 }
 
 // Gets emitted to copy the selection to the clipboard.
 //
-// The `::copy-clipboard` signal is a [keybinding signal](class.SignalAction.html).
+// The `::copy-clipboard` signal is a keybinding signal.
+//
+// The default binding for this signal is Ctrl+c.
 //
-// The default binding for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;c&lt;/kbd&gt;.
+// Deprecated: use ConnectCopyClipboardFunc, which also accepts method values and closures.
 func (x *Label) ConnectCopyClipboard(cb *func(Label)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Label{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "copy-clipboard", cbRefPtr, cbPtr)
+}
+
+var xLabelCopyClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xLabelCopyClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Label))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Label{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCopyClipboardFunc connects cb to the "copy-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Label) ConnectCopyClipboardFunc(cb func(Label)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "copy-clipboard", &xLabelCopyClipboardTrampoline, xLabelCopyClipboardNewTrampoline, cb)
 }
 
 // Gets emitted when the user initiates a cursor movement.
 //
-// The `::move-cursor` signal is a [keybinding signal](class.SignalAction.html).
-// If the cursor is not visible in @entry, this signal causes the viewport to
+// The `::move-cursor` signal is a keybinding signal.
+// If the cursor is not visible in entry, this signal causes the viewport to
 // be moved instead.
 //
 // Applications should not connect to it, but may emit it with
-// [func@GObject.signal_emit_by_name] if they need to control
+// [gobject.SignalEmitByName] if they need to control
 // the cursor programmatically.
 //
 // The default bindings for this signal come in two variants, the
-// variant with the &lt;kbd&gt;Shift&lt;/kbd&gt; modifier extends the selection,
-// the variant without the &lt;kbd&gt;Shift&lt;/kbd&gt; modifier does not.
+// variant with the Shift modifier extends the selection,
+// the variant without the Shift modifier does not.
 // There are too many key combinations to list them all here.
 //
-//   - &lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;
+//   - ←, →, ↑, ↓
 //     move by individual characters/lines
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, etc. move by words/paragraphs
-//   - &lt;kbd&gt;Home&lt;/kbd&gt; and &lt;kbd&gt;End&lt;/kbd&gt; move to the ends of the buffer
+//   - Ctrl+←, etc. move by words/paragraphs
+//   - Home and End move to the ends of the buffer
+//
+// Deprecated: use ConnectMoveCursorFunc, which also accepts method values and closures.
 func (x *Label) ConnectMoveCursor(cb *func(Label, MovementStep, int, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+			defer core.RecoverPanic()
+			fa := Label{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp, CountVarp, ExtendSelectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-cursor", cbRefPtr, cbPtr)
+}
+
+var xLabelMoveCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+func xLabelMoveCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Label, MovementStep, int, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Label{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp, CountVarp, ExtendSelectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveCursorFunc connects cb to the "move-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Label) ConnectMoveCursorFunc(cb func(Label, MovementStep, int, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-cursor", &xLabelMoveCursorTrampoline, xLabelMoveCursorNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1593,7 +1656,7 @@ func (x *Label) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1601,14 +1664,13 @@ func (x *Label) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Label) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1632,19 +1694,17 @@ func (x *Label) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Label) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1665,7 +1725,7 @@ func (x *Label) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1674,14 +1734,12 @@ func (x *Label) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Label) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1737,10 +1795,10 @@ func (x *Label) UpdateSelectionBound() {
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Label) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1752,7 +1810,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1761,57 +1819,57 @@ func init() {
 
 	core.PuregoSafeRegister(&xLabelGLibType, libs, "gtk_label_get_type")
 
-	core.PuregoSafeRegister(&xNewLabel, libs, "gtk_label_new")
-	core.PuregoSafeRegister(&xNewLabelWithMnemonic, libs, "gtk_label_new_with_mnemonic")
-
-	core.PuregoSafeRegister(&xLabelGetAttributes, libs, "gtk_label_get_attributes")
-	core.PuregoSafeRegister(&xLabelGetCurrentUri, libs, "gtk_label_get_current_uri")
-	core.PuregoSafeRegister(&xLabelGetEllipsize, libs, "gtk_label_get_ellipsize")
-	core.PuregoSafeRegister(&xLabelGetExtraMenu, libs, "gtk_label_get_extra_menu")
-	core.PuregoSafeRegister(&xLabelGetJustify, libs, "gtk_label_get_justify")
-	core.PuregoSafeRegister(&xLabelGetLabel, libs, "gtk_label_get_label")
-	core.PuregoSafeRegister(&xLabelGetLayout, libs, "gtk_label_get_layout")
-	core.PuregoSafeRegister(&xLabelGetLayoutOffsets, libs, "gtk_label_get_layout_offsets")
-	core.PuregoSafeRegister(&xLabelGetLines, libs, "gtk_label_get_lines")
-	core.PuregoSafeRegister(&xLabelGetMaxWidthChars, libs, "gtk_label_get_max_width_chars")
-	core.PuregoSafeRegister(&xLabelGetMnemonicKeyval, libs, "gtk_label_get_mnemonic_keyval")
-	core.PuregoSafeRegister(&xLabelGetMnemonicWidget, libs, "gtk_label_get_mnemonic_widget")
-	core.PuregoSafeRegister(&xLabelGetNaturalWrapMode, libs, "gtk_label_get_natural_wrap_mode")
-	core.PuregoSafeRegister(&xLabelGetSelectable, libs, "gtk_label_get_selectable")
-	core.PuregoSafeRegister(&xLabelGetSelectionBounds, libs, "gtk_label_get_selection_bounds")
-	core.PuregoSafeRegister(&xLabelGetSingleLineMode, libs, "gtk_label_get_single_line_mode")
-	core.PuregoSafeRegister(&xLabelGetTabs, libs, "gtk_label_get_tabs")
-	core.PuregoSafeRegister(&xLabelGetText, libs, "gtk_label_get_text")
-	core.PuregoSafeRegister(&xLabelGetUseMarkup, libs, "gtk_label_get_use_markup")
-	core.PuregoSafeRegister(&xLabelGetUseUnderline, libs, "gtk_label_get_use_underline")
-	core.PuregoSafeRegister(&xLabelGetWidthChars, libs, "gtk_label_get_width_chars")
-	core.PuregoSafeRegister(&xLabelGetWrap, libs, "gtk_label_get_wrap")
-	core.PuregoSafeRegister(&xLabelGetWrapMode, libs, "gtk_label_get_wrap_mode")
-	core.PuregoSafeRegister(&xLabelGetXalign, libs, "gtk_label_get_xalign")
-	core.PuregoSafeRegister(&xLabelGetYalign, libs, "gtk_label_get_yalign")
-	core.PuregoSafeRegister(&xLabelSelectRegion, libs, "gtk_label_select_region")
-	core.PuregoSafeRegister(&xLabelSetAttributes, libs, "gtk_label_set_attributes")
-	core.PuregoSafeRegister(&xLabelSetEllipsize, libs, "gtk_label_set_ellipsize")
-	core.PuregoSafeRegister(&xLabelSetExtraMenu, libs, "gtk_label_set_extra_menu")
-	core.PuregoSafeRegister(&xLabelSetJustify, libs, "gtk_label_set_justify")
-	core.PuregoSafeRegister(&xLabelSetLabel, libs, "gtk_label_set_label")
-	core.PuregoSafeRegister(&xLabelSetLines, libs, "gtk_label_set_lines")
-	core.PuregoSafeRegister(&xLabelSetMarkup, libs, "gtk_label_set_markup")
-	core.PuregoSafeRegister(&xLabelSetMarkupWithMnemonic, libs, "gtk_label_set_markup_with_mnemonic")
-	core.PuregoSafeRegister(&xLabelSetMaxWidthChars, libs, "gtk_label_set_max_width_chars")
-	core.PuregoSafeRegister(&xLabelSetMnemonicWidget, libs, "gtk_label_set_mnemonic_widget")
-	core.PuregoSafeRegister(&xLabelSetNaturalWrapMode, libs, "gtk_label_set_natural_wrap_mode")
-	core.PuregoSafeRegister(&xLabelSetSelectable, libs, "gtk_label_set_selectable")
-	core.PuregoSafeRegister(&xLabelSetSingleLineMode, libs, "gtk_label_set_single_line_mode")
-	core.PuregoSafeRegister(&xLabelSetTabs, libs, "gtk_label_set_tabs")
-	core.PuregoSafeRegister(&xLabelSetText, libs, "gtk_label_set_text")
-	core.PuregoSafeRegister(&xLabelSetTextWithMnemonic, libs, "gtk_label_set_text_with_mnemonic")
-	core.PuregoSafeRegister(&xLabelSetUseMarkup, libs, "gtk_label_set_use_markup")
-	core.PuregoSafeRegister(&xLabelSetUseUnderline, libs, "gtk_label_set_use_underline")
-	core.PuregoSafeRegister(&xLabelSetWidthChars, libs, "gtk_label_set_width_chars")
-	core.PuregoSafeRegister(&xLabelSetWrap, libs, "gtk_label_set_wrap")
-	core.PuregoSafeRegister(&xLabelSetWrapMode, libs, "gtk_label_set_wrap_mode")
-	core.PuregoSafeRegister(&xLabelSetXalign, libs, "gtk_label_set_xalign")
-	core.PuregoSafeRegister(&xLabelSetYalign, libs, "gtk_label_set_yalign")
+	core.PuregoRegisterSince(&xNewLabel, libs, "gtk_label_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewLabelWithMnemonic, libs, "gtk_label_new_with_mnemonic", "GTK", "")
+
+	core.PuregoRegisterSince(&xLabelGetAttributes, libs, "gtk_label_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetCurrentUri, libs, "gtk_label_get_current_uri", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetEllipsize, libs, "gtk_label_get_ellipsize", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetExtraMenu, libs, "gtk_label_get_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetJustify, libs, "gtk_label_get_justify", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetLabel, libs, "gtk_label_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetLayout, libs, "gtk_label_get_layout", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetLayoutOffsets, libs, "gtk_label_get_layout_offsets", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetLines, libs, "gtk_label_get_lines", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetMaxWidthChars, libs, "gtk_label_get_max_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetMnemonicKeyval, libs, "gtk_label_get_mnemonic_keyval", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetMnemonicWidget, libs, "gtk_label_get_mnemonic_widget", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetNaturalWrapMode, libs, "gtk_label_get_natural_wrap_mode", "GTK", "4.6")
+	core.PuregoRegisterSince(&xLabelGetSelectable, libs, "gtk_label_get_selectable", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetSelectionBounds, libs, "gtk_label_get_selection_bounds", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetSingleLineMode, libs, "gtk_label_get_single_line_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetTabs, libs, "gtk_label_get_tabs", "GTK", "4.8")
+	core.PuregoRegisterSince(&xLabelGetText, libs, "gtk_label_get_text", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetUseMarkup, libs, "gtk_label_get_use_markup", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetUseUnderline, libs, "gtk_label_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetWidthChars, libs, "gtk_label_get_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetWrap, libs, "gtk_label_get_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetWrapMode, libs, "gtk_label_get_wrap_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetXalign, libs, "gtk_label_get_xalign", "GTK", "")
+	core.PuregoRegisterSince(&xLabelGetYalign, libs, "gtk_label_get_yalign", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSelectRegion, libs, "gtk_label_select_region", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetAttributes, libs, "gtk_label_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetEllipsize, libs, "gtk_label_set_ellipsize", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetExtraMenu, libs, "gtk_label_set_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetJustify, libs, "gtk_label_set_justify", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetLabel, libs, "gtk_label_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetLines, libs, "gtk_label_set_lines", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetMarkup, libs, "gtk_label_set_markup", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetMarkupWithMnemonic, libs, "gtk_label_set_markup_with_mnemonic", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetMaxWidthChars, libs, "gtk_label_set_max_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetMnemonicWidget, libs, "gtk_label_set_mnemonic_widget", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetNaturalWrapMode, libs, "gtk_label_set_natural_wrap_mode", "GTK", "4.6")
+	core.PuregoRegisterSince(&xLabelSetSelectable, libs, "gtk_label_set_selectable", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetSingleLineMode, libs, "gtk_label_set_single_line_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetTabs, libs, "gtk_label_set_tabs", "GTK", "4.8")
+	core.PuregoRegisterSince(&xLabelSetText, libs, "gtk_label_set_text", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetTextWithMnemonic, libs, "gtk_label_set_text_with_mnemonic", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetUseMarkup, libs, "gtk_label_set_use_markup", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetUseUnderline, libs, "gtk_label_set_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetWidthChars, libs, "gtk_label_set_width_chars", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetWrap, libs, "gtk_label_set_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetWrapMode, libs, "gtk_label_set_wrap_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetXalign, libs, "gtk_label_set_xalign", "GTK", "")
+	core.PuregoRegisterSince(&xLabelSetYalign, libs, "gtk_label_set_yalign", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -47,7 +46,7 @@ func LayoutChildNewFromInternalPtr(ptr uintptr) *LayoutChild {
 
 var xLayoutChildGetChildWidget func(uintptr) uintptr
 
-// Retrieves the `GtkWidget` associated to the given @layout_child.
+// Retrieves the `GtkWidget` associated to the given layout_child.
 func (x *LayoutChild) GetChildWidget() *Widget {
 	var cls *Widget
 
@@ -65,7 +64,7 @@ func (x *LayoutChild) GetChildWidget() *Widget {
 var xLayoutChildGetLayoutManager func(uintptr) uintptr
 
 // Retrieves the `GtkLayoutManager` instance that created the
-// given @layout_child.
+// given layout_child.
 func (x *LayoutChild) GetLayoutManager() *LayoutManager {
 	var cls *LayoutManager
 
@@ -96,7 +95,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -105,7 +104,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xLayoutChildGLibType, libs, "gtk_layout_child_get_type")
 
-	core.PuregoSafeRegister(&xLayoutChildGetChildWidget, libs, "gtk_layout_child_get_child_widget")
-	core.PuregoSafeRegister(&xLayoutChildGetLayoutManager, libs, "gtk_layout_child_get_layout_manager")
+	gobject.CheckClassSize("GtkLayoutChildClass", xLayoutChildGLibType, unsafe.Sizeof(LayoutChildClass{}))
+
+	core.PuregoRegisterSince(&xLayoutChildGetChildWidget, libs, "gtk_layout_child_get_child_widget", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutChildGetLayoutManager, libs, "gtk_layout_child_get_layout_manager", "GTK", "")
 
 }
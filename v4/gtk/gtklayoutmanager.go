@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -49,7 +48,8 @@ func (x *LayoutManagerClass) OverrideGetRequestMode(cb func(*LayoutManager, *Wid
 	if cb == nil {
 		x.xGetRequestMode = 0
 	} else {
-		x.xGetRequestMode = purego.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr) SizeRequestMode {
+		x.xGetRequestMode = core.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr) SizeRequestMode {
+			defer core.RecoverPanic()
 			return cb(LayoutManagerNewFromInternalPtr(ManagerVarp), WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -65,7 +65,7 @@ func (x *LayoutManagerClass) GetGetRequestMode() func(*LayoutManager, *Widget) S
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, WidgetVarp uintptr) SizeRequestMode
-	purego.RegisterFunc(&rawCallback, x.xGetRequestMode)
+	core.RegisterFunc(&rawCallback, x.xGetRequestMode)
 	return func(ManagerVar *LayoutManager, WidgetVar *Widget) SizeRequestMode {
 		return rawCallback(ManagerVar.GoPointer(), WidgetVar.GoPointer())
 	}
@@ -79,7 +79,8 @@ func (x *LayoutManagerClass) OverrideMeasure(cb func(*LayoutManager, *Widget, Or
 	if cb == nil {
 		x.xMeasure = 0
 	} else {
-		x.xMeasure = purego.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int) {
+		x.xMeasure = core.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int) {
+			defer core.RecoverPanic()
 			cb(LayoutManagerNewFromInternalPtr(ManagerVarp), WidgetNewFromInternalPtr(WidgetVarp), OrientationVarp, ForSizeVarp, MinimumVarp, NaturalVarp, MinimumBaselineVarp, NaturalBaselineVarp)
 		})
 	}
@@ -94,7 +95,7 @@ func (x *LayoutManagerClass) GetMeasure() func(*LayoutManager, *Widget, Orientat
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xMeasure)
+	core.RegisterFunc(&rawCallback, x.xMeasure)
 	return func(ManagerVar *LayoutManager, WidgetVar *Widget, OrientationVar Orientation, ForSizeVar int, MinimumVar *int, NaturalVar *int, MinimumBaselineVar *int, NaturalBaselineVar *int) {
 		rawCallback(ManagerVar.GoPointer(), WidgetVar.GoPointer(), OrientationVar, ForSizeVar, MinimumVar, NaturalVar, MinimumBaselineVar, NaturalBaselineVar)
 	}
@@ -108,7 +109,8 @@ func (x *LayoutManagerClass) OverrideAllocate(cb func(*LayoutManager, *Widget, i
 	if cb == nil {
 		x.xAllocate = 0
 	} else {
-		x.xAllocate = purego.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int) {
+		x.xAllocate = core.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int) {
+			defer core.RecoverPanic()
 			cb(LayoutManagerNewFromInternalPtr(ManagerVarp), WidgetNewFromInternalPtr(WidgetVarp), WidthVarp, HeightVarp, BaselineVarp)
 		})
 	}
@@ -123,7 +125,7 @@ func (x *LayoutManagerClass) GetAllocate() func(*LayoutManager, *Widget, int, in
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int)
-	purego.RegisterFunc(&rawCallback, x.xAllocate)
+	core.RegisterFunc(&rawCallback, x.xAllocate)
 	return func(ManagerVar *LayoutManager, WidgetVar *Widget, WidthVar int, HeightVar int, BaselineVar int) {
 		rawCallback(ManagerVar.GoPointer(), WidgetVar.GoPointer(), WidthVar, HeightVar, BaselineVar)
 	}
@@ -137,7 +139,8 @@ func (x *LayoutManagerClass) OverrideCreateLayoutChild(cb func(*LayoutManager, *
 	if cb == nil {
 		x.xCreateLayoutChild = 0
 	} else {
-		x.xCreateLayoutChild = purego.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, ForChildVarp uintptr) uintptr {
+		x.xCreateLayoutChild = core.NewCallback(func(ManagerVarp uintptr, WidgetVarp uintptr, ForChildVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(LayoutManagerNewFromInternalPtr(ManagerVarp), WidgetNewFromInternalPtr(WidgetVarp), WidgetNewFromInternalPtr(ForChildVarp))
 			if ret == nil {
 				return 0
@@ -156,7 +159,7 @@ func (x *LayoutManagerClass) GetCreateLayoutChild() func(*LayoutManager, *Widget
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr, WidgetVarp uintptr, ForChildVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateLayoutChild)
+	core.RegisterFunc(&rawCallback, x.xCreateLayoutChild)
 	return func(ManagerVar *LayoutManager, WidgetVar *Widget, ForChildVar *Widget) *LayoutChild {
 		rawRet := rawCallback(ManagerVar.GoPointer(), WidgetVar.GoPointer(), ForChildVar.GoPointer())
 		if rawRet == 0 {
@@ -176,7 +179,8 @@ func (x *LayoutManagerClass) OverrideRoot(cb func(*LayoutManager)) {
 	if cb == nil {
 		x.xRoot = 0
 	} else {
-		x.xRoot = purego.NewCallback(func(ManagerVarp uintptr) {
+		x.xRoot = core.NewCallback(func(ManagerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(LayoutManagerNewFromInternalPtr(ManagerVarp))
 		})
 	}
@@ -191,7 +195,7 @@ func (x *LayoutManagerClass) GetRoot() func(*LayoutManager) {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRoot)
+	core.RegisterFunc(&rawCallback, x.xRoot)
 	return func(ManagerVar *LayoutManager) {
 		rawCallback(ManagerVar.GoPointer())
 	}
@@ -205,7 +209,8 @@ func (x *LayoutManagerClass) OverrideUnroot(cb func(*LayoutManager)) {
 	if cb == nil {
 		x.xUnroot = 0
 	} else {
-		x.xUnroot = purego.NewCallback(func(ManagerVarp uintptr) {
+		x.xUnroot = core.NewCallback(func(ManagerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(LayoutManagerNewFromInternalPtr(ManagerVarp))
 		})
 	}
@@ -220,7 +225,7 @@ func (x *LayoutManagerClass) GetUnroot() func(*LayoutManager) {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnroot)
+	core.RegisterFunc(&rawCallback, x.xUnroot)
 	return func(ManagerVar *LayoutManager) {
 		rawCallback(ManagerVar.GoPointer())
 	}
@@ -234,27 +239,24 @@ func (x *LayoutManagerClass) GetUnroot() func(*LayoutManager) {
 //
 // Each `GtkWidget` can only have a `GtkLayoutManager` instance associated
 // to it at any given time; it is possible, though, to replace the layout
-// manager instance using [method@Gtk.Widget.set_layout_manager].
+// manager instance using [Widget.SetLayoutManager].
 //
-// ## Layout properties
+// # Layout properties
 //
 // A layout manager can expose properties for controlling the layout of
-// each child, by creating an object type derived from [class@Gtk.LayoutChild]
+// each child, by creating an object type derived from [LayoutChild]
 // and installing the properties on it as normal `GObject` properties.
 //
 // Each `GtkLayoutChild` instance storing the layout properties for a
-// specific child is created through the [method@Gtk.LayoutManager.get_layout_child]
+// specific child is created through the [LayoutManager.GetLayoutChild]
 // method; a `GtkLayoutManager` controls the creation of its `GtkLayoutChild`
 // instances by overriding the GtkLayoutManagerClass.create_layout_child()
 // virtual function. The typical implementation should look like:
 //
-// ```c
-// static GtkLayoutChild *
-// create_layout_child (GtkLayoutManager *manager,
-//
-//	GtkWidget        *container,
-//	GtkWidget        *child)
-//
+//	static GtkLayoutChild *
+//	create_layout_child (GtkLayoutManager *manager,
+//	                     GtkWidget        *container,
+//	                     GtkWidget        *child)
 //	{
 //	  return g_object_new (your_layout_child_get_type (),
 //	                       "layout-manager", manager,
@@ -262,19 +264,17 @@ func (x *LayoutManagerClass) GetUnroot() func(*LayoutManager) {
 //	                       NULL);
 //	}
 //
-// ```
-//
-// The [property@Gtk.LayoutChild:layout-manager] and
-// [property@Gtk.LayoutChild:child-widget] properties
+// The LayoutChild:layout-manager and
+// LayoutChild:child-widget properties
 // on the newly created `GtkLayoutChild` instance are mandatory. The
 // `GtkLayoutManager` will cache the newly created `GtkLayoutChild` instance
 // until the widget is removed from its parent, or the parent removes the
 // layout manager.
 //
 // Each `GtkLayoutManager` instance creating a `GtkLayoutChild` should use
-// [method@Gtk.LayoutManager.get_layout_child] every time it needs to query
+// [LayoutManager.GetLayoutChild] every time it needs to query
 // the layout properties; each `GtkLayoutChild` instance should call
-// [method@Gtk.LayoutManager.layout_changed] every time a property is
+// [LayoutManager.LayoutChanged] every time a property is
 // updated, in order to queue a new size measuring and allocation.
 type LayoutManager struct {
 	gobject.Object
@@ -294,9 +294,9 @@ func LayoutManagerNewFromInternalPtr(ptr uintptr) *LayoutManager {
 
 var xLayoutManagerAllocate func(uintptr, uintptr, int, int, int)
 
-// Assigns the given @width, @height, and @baseline to
-// a @widget, and computes the position and sizes of the children of
-// the @widget using the layout management policy of @manager.
+// Assigns the given width, height, and baseline to
+// a widget, and computes the position and sizes of the children of
+// the widget using the layout management policy of manager.
 func (x *LayoutManager) Allocate(WidgetVar *Widget, WidthVar int, HeightVar int, BaselineVar int) {
 
 	xLayoutManagerAllocate(x.GoPointer(), WidgetVar.GoPointer(), WidthVar, HeightVar, BaselineVar)
@@ -308,10 +308,10 @@ var xLayoutManagerGetLayoutChild func(uintptr, uintptr) uintptr
 // Retrieves a `GtkLayoutChild` instance for the `GtkLayoutManager`,
 // creating one if necessary.
 //
-// The @child widget must be a child of the widget using @manager.
+// The child widget must be a child of the widget using manager.
 //
 // The `GtkLayoutChild` instance is owned by the `GtkLayoutManager`,
-// and is guaranteed to exist as long as @child is a child of the
+// and is guaranteed to exist as long as child is a child of the
 // `GtkWidget` using the given `GtkLayoutManager`.
 func (x *LayoutManager) GetLayoutChild(ChildVar *Widget) *LayoutChild {
 	var cls *LayoutChild
@@ -329,7 +329,7 @@ func (x *LayoutManager) GetLayoutChild(ChildVar *Widget) *LayoutChild {
 
 var xLayoutManagerGetRequestMode func(uintptr) SizeRequestMode
 
-// Retrieves the request mode of @manager.
+// Retrieves the request mode of manager.
 func (x *LayoutManager) GetRequestMode() SizeRequestMode {
 
 	cret := xLayoutManagerGetRequestMode(x.GoPointer())
@@ -355,7 +355,7 @@ func (x *LayoutManager) GetWidget() *Widget {
 
 var xLayoutManagerLayoutChanged func(uintptr)
 
-// Queues a resize on the `GtkWidget` using @manager, if any.
+// Queues a resize on the `GtkWidget` using manager, if any.
 //
 // This function should be called by subclasses of `GtkLayoutManager`
 // in response to changes to their layout management policies.
@@ -367,10 +367,10 @@ func (x *LayoutManager) LayoutChanged() {
 
 var xLayoutManagerMeasure func(uintptr, uintptr, Orientation, int, *int, *int, *int, *int)
 
-// Measures the size of the @widget using @manager, for the
-// given @orientation and size.
+// Measures the size of the widget using manager, for the
+// given orientation and size.
 //
-// See the [class@Gtk.Widget] documentation on layout management for
+// See the [Widget] documentation on layout management for
 // more details.
 func (x *LayoutManager) Measure(WidgetVar *Widget, OrientationVar Orientation, ForSizeVar int, MinimumVar *int, NaturalVar *int, MinimumBaselineVar *int, NaturalBaselineVar *int) {
 
@@ -394,7 +394,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -403,11 +403,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xLayoutManagerGLibType, libs, "gtk_layout_manager_get_type")
 
-	core.PuregoSafeRegister(&xLayoutManagerAllocate, libs, "gtk_layout_manager_allocate")
-	core.PuregoSafeRegister(&xLayoutManagerGetLayoutChild, libs, "gtk_layout_manager_get_layout_child")
-	core.PuregoSafeRegister(&xLayoutManagerGetRequestMode, libs, "gtk_layout_manager_get_request_mode")
-	core.PuregoSafeRegister(&xLayoutManagerGetWidget, libs, "gtk_layout_manager_get_widget")
-	core.PuregoSafeRegister(&xLayoutManagerLayoutChanged, libs, "gtk_layout_manager_layout_changed")
-	core.PuregoSafeRegister(&xLayoutManagerMeasure, libs, "gtk_layout_manager_measure")
+	gobject.CheckClassSize("GtkLayoutManagerClass", xLayoutManagerGLibType, unsafe.Sizeof(LayoutManagerClass{}))
+
+	core.PuregoRegisterSince(&xLayoutManagerAllocate, libs, "gtk_layout_manager_allocate", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutManagerGetLayoutChild, libs, "gtk_layout_manager_get_layout_child", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutManagerGetRequestMode, libs, "gtk_layout_manager_get_request_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutManagerGetWidget, libs, "gtk_layout_manager_get_widget", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutManagerLayoutChanged, libs, "gtk_layout_manager_layout_changed", "GTK", "")
+	core.PuregoRegisterSince(&xLayoutManagerMeasure, libs, "gtk_layout_manager_measure", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -26,30 +25,21 @@ const (
 // Typical use cases are displaying the strength of a password, or
 // showing the charge level of a battery.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="levelbar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkLevelBar" src="levelbar.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// Use [method@Gtk.LevelBar.set_value] to set the current value, and
-// [method@Gtk.LevelBar.add_offset_value] to set the value offsets at which
+// Use [LevelBar.SetValue] to set the current value, and
+// [LevelBar.AddOffsetValue] to set the value offsets at which
 // the bar will be considered in a different state. GTK will add a few
-// offsets by default on the level bar: %GTK_LEVEL_BAR_OFFSET_LOW,
-// %GTK_LEVEL_BAR_OFFSET_HIGH and %GTK_LEVEL_BAR_OFFSET_FULL, with
+// offsets by default on the level bar: [LEVEL_BAR_OFFSET_LOW],
+// [LEVEL_BAR_OFFSET_HIGH] and [LEVEL_BAR_OFFSET_FULL], with
 // values 0.25, 0.75 and 1.0 respectively.
 //
 // Note that it is your responsibility to update preexisting offsets
 // when changing the minimum or maximum value. GTK will simply clamp
 // them to the new range.
 //
-// ## Adding a custom offset on the bar
-//
-// ```c
-// static GtkWidget *
-// create_level_bar (void)
+// # Adding a custom offset on the bar
 //
+//	static GtkWidget *
+//	create_level_bar (void)
 //	{
 //	  GtkWidget *widget;
 //	  GtkLevelBar *bar;
@@ -78,14 +68,12 @@ const (
 //	  return widget;
 //	}
 //
-// ```
-//
 // The default interval of values is between zero and one, but it’s possible
-// to modify the interval using [method@Gtk.LevelBar.set_min_value] and
-// [method@Gtk.LevelBar.set_max_value]. The value will be always drawn in
+// to modify the interval using [LevelBar.SetMinValue] and
+// [LevelBar.SetMaxValue]. The value will be always drawn in
 // proportion to the admissible interval, i.e. a value of 15 with a specified
 // interval between 10 and 20 is equivalent to a value of 0.5 with an interval
-// between 0 and 1. When %GTK_LEVEL_BAR_MODE_DISCRETE is used, the bar level
+// between 0 and 1. When [LevelBarModeDiscreteValue] is used, the bar level
 // is rendered as a finite number of separated blocks instead of a single one.
 // The number of blocks that will be rendered is equal to the number of units
 // specified by the admissible interval.
@@ -97,21 +85,17 @@ const (
 // # GtkLevelBar as GtkBuildable
 //
 // The `GtkLevelBar` implementation of the `GtkBuildable` interface supports a
-// custom `&lt;offsets&gt;` element, which can contain any number of `&lt;offset&gt;` elements,
+// custom `<offsets>` element, which can contain any number of `<offset>` elements,
 // each of which must have "name" and "value" attributes.
 //
 // # CSS nodes
 //
-// ```
-// levelbar[.discrete]
-// ╰── trough
-//
-//	├── block.filled.level-name
-//	┊
-//	├── block.empty
-//	┊
-//
-// ```
+//	levelbar[.discrete]
+//	╰── trough
+//	    ├── block.filled.level-name
+//	    ┊
+//	    ├── block.empty
+//	    ┊
 //
 // `GtkLevelBar` has a main CSS node with name levelbar and one of the style
 // classes .discrete or .continuous and a subnode with name trough. Below the
@@ -126,7 +110,7 @@ const (
 //
 // # Accessibility
 //
-// `GtkLevelBar` uses the [enum@Gtk.AccessibleRole.meter] role.
+// `GtkLevelBar` uses the [AccessibleRoleMeterValue] role.
 type LevelBar struct {
 	Widget
 }
@@ -179,15 +163,15 @@ func NewLevelBarForInterval(MinValueVar float64, MaxValueVar float64) *LevelBar
 
 var xLevelBarAddOffsetValue func(uintptr, string, float64)
 
-// Adds a new offset marker on @self at the position specified by @value.
+// Adds a new offset marker on self at the position specified by value.
 //
-// When the bar value is in the interval topped by @value (or between @value
-// and [property@Gtk.LevelBar:max-value] in case the offset is the last one
-// on the bar) a style class named `level-`@name will be applied
+// When the bar value is in the interval topped by value (or between value
+// and [LevelBar.GetPropertyMaxValue] in case the offset is the last one
+// on the bar) a style class named `level-`name will be applied
 // when rendering the level bar fill.
 //
-// If another offset marker named @name exists, its value will be
-// replaced by @value.
+// If another offset marker named name exists, its value will be
+// replaced by value.
 func (x *LevelBar) AddOffsetValue(NameVar string, ValueVar float64) {
 
 	xLevelBarAddOffsetValue(x.GoPointer(), NameVar, ValueVar)
@@ -232,7 +216,7 @@ func (x *LevelBar) GetMode() LevelBarMode {
 
 var xLevelBarGetOffsetValue func(uintptr, uintptr, *float64) bool
 
-// Fetches the value specified for the offset marker @name in @self.
+// Fetches the value specified for the offset marker name in self.
 func (x *LevelBar) GetOffsetValue(NameVar *string, ValueVar *float64) bool {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -256,7 +240,7 @@ var xLevelBarRemoveOffsetValue func(uintptr, uintptr)
 // Removes an offset marker from a `GtkLevelBar`.
 //
 // The marker must have been previously added with
-// [method@Gtk.LevelBar.add_offset_value].
+// [LevelBar.AddOffsetValue].
 func (x *LevelBar) RemoveOffsetValue(NameVar *string) {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -404,59 +388,84 @@ func (x *LevelBar) GetPropertyValue() float64 {
 
 // Emitted when an offset specified on the bar changes value.
 //
-// This typically is the result of a [method@Gtk.LevelBar.add_offset_value]
+// This typically is the result of a [LevelBar.AddOffsetValue]
 // call.
 //
 // The signal supports detailed connections; you can connect to the
 // detailed signal "changed::x" in order to only receive callbacks when
 // the value of offset "x" changes.
+//
+// Deprecated: use ConnectOffsetChangedFunc, which also accepts method values and closures.
 func (x *LevelBar) ConnectOffsetChanged(cb *func(LevelBar, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "offset-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
-	}
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NameVarp string) {
+			defer core.RecoverPanic()
+			fa := LevelBar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
 
-	fcb := func(clsPtr uintptr, NameVarp string) {
-		fa := LevelBar{}
-		fa.Ptr = clsPtr
-		cbFn := *cb
-
-		cbFn(fa, NameVarp)
+			cbFn(fa, NameVarp)
 
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "offset-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+	return gobject.SignalConnectCallback(x.GoPointer(), "offset-changed", cbRefPtr, cbPtr)
 }
 
 // ConnectOffsetChangedWithDetail connects to the "offset-changed" signal with a detail string.
 // The detail is appended as "offset-changed::<detail>".
+//
+// Deprecated: use ConnectOffsetChangedWithDetailFunc, which also accepts method values and closures.
 func (x *LevelBar) ConnectOffsetChangedWithDetail(detail string, cb *func(LevelBar, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
 	signalName := fmt.Sprintf("offset-changed::%s", detail)
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NameVarp string) {
+			defer core.RecoverPanic()
+			fa := LevelBar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, NameVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xLevelBarOffsetChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, NameVarp string) {
+func xLevelBarOffsetChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NameVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(LevelBar, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := LevelBar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, NameVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), signalName, cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectOffsetChangedFunc connects cb to the "offset-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *LevelBar) ConnectOffsetChangedFunc(cb func(LevelBar, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "offset-changed", &xLevelBarOffsetChangedTrampoline, xLevelBarOffsetChangedNewTrampoline, cb)
+}
+
+// ConnectOffsetChangedWithDetailFunc connects cb to the "offset-changed" signal with a detail string, see ConnectOffsetChangedFunc.
+// The detail is appended as "offset-changed::<detail>".
+func (x *LevelBar) ConnectOffsetChangedWithDetailFunc(detail string, cb func(LevelBar, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("offset-changed::%s", detail), &xLevelBarOffsetChangedTrampoline, xLevelBarOffsetChangedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -620,7 +629,7 @@ func (x *LevelBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -628,14 +637,13 @@ func (x *LevelBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *LevelBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -659,19 +667,17 @@ func (x *LevelBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *LevelBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -692,7 +698,7 @@ func (x *LevelBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -701,14 +707,12 @@ func (x *LevelBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *LevelBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -727,24 +731,24 @@ func (x *LevelBar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *LevelBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *LevelBar) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *LevelBar) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -756,7 +760,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -765,21 +769,21 @@ func init() {
 
 	core.PuregoSafeRegister(&xLevelBarGLibType, libs, "gtk_level_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewLevelBar, libs, "gtk_level_bar_new")
-	core.PuregoSafeRegister(&xNewLevelBarForInterval, libs, "gtk_level_bar_new_for_interval")
-
-	core.PuregoSafeRegister(&xLevelBarAddOffsetValue, libs, "gtk_level_bar_add_offset_value")
-	core.PuregoSafeRegister(&xLevelBarGetInverted, libs, "gtk_level_bar_get_inverted")
-	core.PuregoSafeRegister(&xLevelBarGetMaxValue, libs, "gtk_level_bar_get_max_value")
-	core.PuregoSafeRegister(&xLevelBarGetMinValue, libs, "gtk_level_bar_get_min_value")
-	core.PuregoSafeRegister(&xLevelBarGetMode, libs, "gtk_level_bar_get_mode")
-	core.PuregoSafeRegister(&xLevelBarGetOffsetValue, libs, "gtk_level_bar_get_offset_value")
-	core.PuregoSafeRegister(&xLevelBarGetValue, libs, "gtk_level_bar_get_value")
-	core.PuregoSafeRegister(&xLevelBarRemoveOffsetValue, libs, "gtk_level_bar_remove_offset_value")
-	core.PuregoSafeRegister(&xLevelBarSetInverted, libs, "gtk_level_bar_set_inverted")
-	core.PuregoSafeRegister(&xLevelBarSetMaxValue, libs, "gtk_level_bar_set_max_value")
-	core.PuregoSafeRegister(&xLevelBarSetMinValue, libs, "gtk_level_bar_set_min_value")
-	core.PuregoSafeRegister(&xLevelBarSetMode, libs, "gtk_level_bar_set_mode")
-	core.PuregoSafeRegister(&xLevelBarSetValue, libs, "gtk_level_bar_set_value")
+	core.PuregoRegisterSince(&xNewLevelBar, libs, "gtk_level_bar_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewLevelBarForInterval, libs, "gtk_level_bar_new_for_interval", "GTK", "")
+
+	core.PuregoRegisterSince(&xLevelBarAddOffsetValue, libs, "gtk_level_bar_add_offset_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetInverted, libs, "gtk_level_bar_get_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetMaxValue, libs, "gtk_level_bar_get_max_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetMinValue, libs, "gtk_level_bar_get_min_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetMode, libs, "gtk_level_bar_get_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetOffsetValue, libs, "gtk_level_bar_get_offset_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarGetValue, libs, "gtk_level_bar_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarRemoveOffsetValue, libs, "gtk_level_bar_remove_offset_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarSetInverted, libs, "gtk_level_bar_set_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarSetMaxValue, libs, "gtk_level_bar_set_max_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarSetMinValue, libs, "gtk_level_bar_set_min_value", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarSetMode, libs, "gtk_level_bar_set_mode", "GTK", "")
+	core.PuregoRegisterSince(&xLevelBarSetValue, libs, "gtk_level_bar_set_value", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,32 +12,25 @@ import (
 
 // A button with a hyperlink.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="link-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkLinkButton" src="link-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is useful to show quick links to resources.
 //
-// A link button is created by calling either [ctor@Gtk.LinkButton.new] or
-// [ctor@Gtk.LinkButton.new_with_label]. If using the former, the URI you
+// A link button is created by calling either [NewLinkButton] or
+// [NewLinkButtonWithLabel]. If using the former, the URI you
 // pass to the constructor is used as a label for the widget.
 //
 // The URI bound to a `GtkLinkButton` can be set specifically using
-// [method@Gtk.LinkButton.set_uri].
+// [LinkButton.SetUri].
 //
-// By default, `GtkLinkButton` calls [method@Gtk.FileLauncher.launch] when the button
+// By default, `GtkLinkButton` calls [FileLauncher.Launch] when the button
 // is clicked. This behaviour can be overridden by connecting to the
-// [signal@Gtk.LinkButton::activate-link] signal and returning %TRUE from
+// [LinkButton.ConnectActivateLinkFunc] signal and returning true from
 // the signal handler.
 //
 // # Shortcuts and Gestures
 //
 // `GtkLinkButton` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
+// - Shift+F10 or Menu opens the context menu.
 //
 // # Actions
 //
@@ -54,7 +46,7 @@ import (
 //
 // # Accessibility
 //
-// `GtkLinkButton` uses the [enum@Gtk.AccessibleRole.link] role.
+// `GtkLinkButton` uses the [AccessibleRoleLinkValue] role.
 type LinkButton struct {
 	Button
 }
@@ -124,7 +116,7 @@ var xLinkButtonGetVisited func(uintptr) bool
 // The button becomes visited when it is clicked. If the URI
 // is changed on the button, the “visited” state is unset again.
 //
-// The state may also be changed using [method@Gtk.LinkButton.set_visited].
+// The state may also be changed using [LinkButton.SetVisited].
 func (x *LinkButton) GetVisited() bool {
 
 	cret := xLinkButtonGetVisited(x.GoPointer())
@@ -133,7 +125,7 @@ func (x *LinkButton) GetVisited() bool {
 
 var xLinkButtonSetUri func(uintptr, string)
 
-// Sets @uri as the URI where the `GtkLinkButton` points.
+// Sets uri as the URI where the `GtkLinkButton` points.
 //
 // As a side-effect this unsets the “visited” state of the button.
 func (x *LinkButton) SetUri(UriVar string) {
@@ -146,7 +138,7 @@ var xLinkButtonSetVisited func(uintptr, bool)
 
 // Sets the “visited” state of the `GtkLinkButton`.
 //
-// See [method@Gtk.LinkButton.get_visited] for more details.
+// See [LinkButton.GetVisited] for more details.
 func (x *LinkButton) SetVisited(VisitedVar bool) {
 
 	xLinkButtonSetVisited(x.GoPointer(), VisitedVar)
@@ -204,33 +196,55 @@ func (x *LinkButton) GetPropertyVisited() bool {
 
 // Emitted each time the `GtkLinkButton` is clicked.
 //
-// The default handler will call [method@Gtk.FileLauncher.launch] with the URI
-// stored inside the [property@Gtk.LinkButton:uri] property.
+// The default handler will call [FileLauncher.Launch] with the URI
+// stored inside the [LinkButton.GetPropertyUri] property.
 //
 // To override the default behavior, you can connect to the
 // ::activate-link signal and stop the propagation of the signal
-// by returning %TRUE from your handler.
+// by returning true from your handler.
+//
+// Deprecated: use ConnectActivateLinkFunc, which also accepts method values and closures.
 func (x *LinkButton) ConnectActivateLink(cb *func(LinkButton) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := LinkButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-link", cbRefPtr, cbPtr)
+}
+
+var xLinkButtonActivateLinkTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) bool {
+func xLinkButtonActivateLinkNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(LinkButton) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := LinkButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-link", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateLinkFunc connects cb to the "activate-link" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *LinkButton) ConnectActivateLinkFunc(cb func(LinkButton) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-link", &xLinkButtonActivateLinkTrampoline, xLinkButtonActivateLinkNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -394,7 +408,7 @@ func (x *LinkButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -402,14 +416,13 @@ func (x *LinkButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *LinkButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -433,19 +446,17 @@ func (x *LinkButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *LinkButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -466,7 +477,7 @@ func (x *LinkButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -475,14 +486,12 @@ func (x *LinkButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *LinkButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -501,14 +510,14 @@ func (x *LinkButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *LinkButton) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *LinkButton) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -518,15 +527,15 @@ func (x *LinkButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *LinkButton) SetActionName(ActionNameVar *string) {
 
@@ -539,13 +548,13 @@ func (x *LinkButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *LinkButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -554,14 +563,14 @@ func (x *LinkButton) SetActionTarget(FormatStringVar string, varArgs ...interfac
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -579,18 +588,18 @@ func (x *LinkButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *LinkButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *LinkButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -602,7 +611,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -611,12 +620,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xLinkButtonGLibType, libs, "gtk_link_button_get_type")
 
-	core.PuregoSafeRegister(&xNewLinkButton, libs, "gtk_link_button_new")
-	core.PuregoSafeRegister(&xNewLinkButtonWithLabel, libs, "gtk_link_button_new_with_label")
+	core.PuregoRegisterSince(&xNewLinkButton, libs, "gtk_link_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewLinkButtonWithLabel, libs, "gtk_link_button_new_with_label", "GTK", "")
 
-	core.PuregoSafeRegister(&xLinkButtonGetUri, libs, "gtk_link_button_get_uri")
-	core.PuregoSafeRegister(&xLinkButtonGetVisited, libs, "gtk_link_button_get_visited")
-	core.PuregoSafeRegister(&xLinkButtonSetUri, libs, "gtk_link_button_set_uri")
-	core.PuregoSafeRegister(&xLinkButtonSetVisited, libs, "gtk_link_button_set_visited")
+	core.PuregoRegisterSince(&xLinkButtonGetUri, libs, "gtk_link_button_get_uri", "GTK", "")
+	core.PuregoRegisterSince(&xLinkButtonGetVisited, libs, "gtk_link_button_get_visited", "GTK", "")
+	core.PuregoRegisterSince(&xLinkButtonSetUri, libs, "gtk_link_button_set_uri", "GTK", "")
+	core.PuregoRegisterSince(&xLinkButtonSetVisited, libs, "gtk_link_button_set_visited", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,28 +13,28 @@ import (
 )
 
 // Called for list boxes that are bound to a `GListModel` with
-// gtk_list_box_bind_model() for each item that gets added to the model.
+// [ListBox.BindModel] for each item that gets added to the model.
 //
-// If the widget returned is not a #GtkListBoxRow widget, then the widget
-// will be inserted as the child of an intermediate #GtkListBoxRow.
+// If the widget returned is not a [ListBoxRow] widget, then the widget
+// will be inserted as the child of an intermediate [ListBoxRow].
 type ListBoxCreateWidgetFunc func(uintptr, uintptr) uintptr
 
 // Will be called whenever the row changes or is added and lets you control
 // if the row should be visible or not.
 type ListBoxFilterFunc func(uintptr, uintptr) bool
 
-// A function used by gtk_list_box_selected_foreach().
+// A function used by [ListBox.SelectedForeach].
 //
-// It will be called on every selected child of the @box.
+// It will be called on every selected child of the box.
 type ListBoxForeachFunc func(uintptr, uintptr, uintptr)
 
 // Compare two rows to determine which should be first.
 type ListBoxSortFunc func(uintptr, uintptr, uintptr) int
 
-// Whenever @row changes or which row is before @row changes this
-// is called, which lets you update the header on @row.
+// Whenever row changes or which row is before row changes this
+// is called, which lets you update the header on row.
 //
-// You may remove or set a new one via [method@Gtk.ListBoxRow.set_header]
+// You may remove or set a new one via [ListBoxRow.SetHeader]
 // or just change the state of the current header widget.
 type ListBoxUpdateHeaderFunc func(uintptr, uintptr, uintptr)
 
@@ -58,7 +57,8 @@ func (x *ListBoxRowClass) OverrideActivate(cb func(*ListBoxRow)) {
 	if cb == nil {
 		x.xActivate = 0
 	} else {
-		x.xActivate = purego.NewCallback(func(RowVarp uintptr) {
+		x.xActivate = core.NewCallback(func(RowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ListBoxRowNewFromInternalPtr(RowVarp))
 		})
 	}
@@ -70,7 +70,7 @@ func (x *ListBoxRowClass) GetActivate() func(*ListBoxRow) {
 		return nil
 	}
 	var rawCallback func(RowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivate)
+	core.RegisterFunc(&rawCallback, x.xActivate)
 	return func(RowVar *ListBoxRow) {
 		rawCallback(RowVar.GoPointer())
 	}
@@ -78,13 +78,6 @@ func (x *ListBoxRowClass) GetActivate() func(*ListBoxRow) {
 
 // Shows a vertical list.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="list-box-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkListBox" src="list-box.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // A `GtkListBox` only contains `GtkListBoxRow` children. These rows can
 // by dynamically sorted and filtered, and headers can be added dynamically
 // depending on the row content. It also allows keyboard and mouse navigation
@@ -96,13 +89,13 @@ func (x *ListBoxRowClass) GetActivate() func(*ListBoxRow) {
 // button in it).
 //
 // Although a `GtkListBox` must have only `GtkListBoxRow` children, you can
-// add any kind of widget to it via [method@Gtk.ListBox.prepend],
-// [method@Gtk.ListBox.append] and [method@Gtk.ListBox.insert] and a
+// add any kind of widget to it via [ListBox.Prepend],
+// [ListBox.Append] and [ListBox.Insert] and a
 // `GtkListBoxRow` widget will automatically be inserted between the list
 // and the widget.
 //
 // `GtkListBoxRows` can be marked as activatable or selectable. If a row is
-// activatable, [signal@Gtk.ListBox::row-activated] will be emitted for it when
+// activatable, [ListBox.ConnectRowActivatedFunc] will be emitted for it when
 // the user tries to activate it. If it is selectable, the row will be marked
 // as selected when the user tries to select it.
 //
@@ -110,27 +103,25 @@ func (x *ListBoxRowClass) GetActivate() func(*ListBoxRow) {
 //
 // The `GtkListBox` implementation of the `GtkBuildable` interface supports
 // setting a child as the placeholder by specifying “placeholder” as the “type”
-// attribute of a `&lt;child&gt;` element. See [method@Gtk.ListBox.set_placeholder]
+// attribute of a `<child>` element. See [ListBox.SetPlaceholder]
 // for info.
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.ListBox::move-cursor]
-// - [signal@Gtk.ListBox::select-all]
-// - [signal@Gtk.ListBox::toggle-cursor-row]
-// - [signal@Gtk.ListBox::unselect-all]
+// - [ListBox.ConnectMoveCursorFunc]
+// - [ListBox.ConnectSelectAllFunc]
+// - [ListBox.ConnectToggleCursorRowFunc]
+// - [ListBox.ConnectUnselectAllFunc]
 //
 // # CSS nodes
 //
-// ```
-// list[.separators][.rich-list][.navigation-sidebar][.boxed-list]
-// ╰── row[.activatable]
-// ```
+//	list[.separators][.rich-list][.navigation-sidebar][.boxed-list]
+//	╰── row[.activatable]
 //
 // `GtkListBox` uses a single CSS node named list. It may carry the .separators
-// style class, when the [property@Gtk.ListBox:show-separators] property is set.
+// style class, when the [ListBox.GetPropertyShowSeparators] property is set.
 // Each `GtkListBoxRow` uses a single CSS node named row. The row nodes get the
 // .activatable style class added when appropriate.
 //
@@ -138,13 +129,13 @@ func (x *ListBoxRowClass) GetActivate() func(*ListBoxRow) {
 // automatically surrounded by a frame and have separators.
 //
 // The main list node may also carry style classes to select
-// the style of [list presentation](section-list-widget.html#list-styles):
+// the style of list presentation:
 // .rich-list, .navigation-sidebar or .data-table.
 //
 // # Accessibility
 //
-// `GtkListBox` uses the [enum@Gtk.AccessibleRole.list] role and `GtkListBoxRow` uses
-// the [enum@Gtk.AccessibleRole.list_item] role.
+// `GtkListBox` uses the [AccessibleRoleListValue] role and `GtkListBoxRow` uses
+// the [AccessibleRoleListItemValue] role.
 type ListBox struct {
 	Widget
 }
@@ -192,17 +183,17 @@ func (x *ListBox) Append(ChildVar *Widget) {
 
 var xListBoxBindModel func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Binds @model to @box.
+// Binds model to box.
 //
-// If @box was already bound to a model, that previous binding is
+// If box was already bound to a model, that previous binding is
 // destroyed.
 //
-// The contents of @box are cleared and then filled with widgets that
-// represent items from @model. @box is updated whenever @model changes.
-// If @model is %NULL, @box is left empty.
+// The contents of box are cleared and then filled with widgets that
+// represent items from model. box is updated whenever model changes.
+// If model is nil, box is left empty.
 //
 // It is undefined to add or remove widgets directly (for example, with
-// [method@Gtk.ListBox.insert]) while @box is bound to a model.
+// [ListBox.Insert]) while box is bound to a model.
 //
 // Note that using a model is incompatible with the filtering and sorting
 // functionality in `GtkListBox`. When using a model, filtering and sorting
@@ -216,11 +207,12 @@ func (x *ListBox) BindModel(ModelVar gio.ListModel, CreateWidgetFuncVar *ListBox
 			CreateWidgetFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CreateWidgetFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CreateWidgetFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CreateWidgetFuncVarPtr, CreateWidgetFuncVarRef, CreateWidgetFuncVar)
+			CreateWidgetFuncVarRef = core.NewCallback(fcb)
+			CreateWidgetFuncVarRef = glib.SaveCallbackWithClosure(CreateWidgetFuncVarPtr, CreateWidgetFuncVarRef, CreateWidgetFuncVar)
 		}
 	}
 
@@ -231,11 +223,12 @@ func (x *ListBox) BindModel(ModelVar gio.ListModel, CreateWidgetFuncVar *ListBox
 			UserDataFreeFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDataFreeFuncVar
 				cbFn(arg0)
 			}
-			UserDataFreeFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
+			UserDataFreeFuncVarRef = core.NewCallback(fcb)
+			UserDataFreeFuncVarRef = glib.SaveCallbackWithClosure(UserDataFreeFuncVarPtr, UserDataFreeFuncVarRef, UserDataFreeFuncVar)
 		}
 	}
 
@@ -248,8 +241,8 @@ var xListBoxDragHighlightRow func(uintptr, uintptr)
 // Add a drag highlight to a row.
 //
 // This is a helper function for implementing DnD onto a `GtkListBox`.
-// The passed in @row will be highlighted by setting the
-// %GTK_STATE_FLAG_DROP_ACTIVE state and any previously highlighted
+// The passed in row will be highlighted by setting the
+// [StateFlagDropActiveValue] state and any previously highlighted
 // row will be unhighlighted.
 //
 // The row will also be unhighlighted when the widget gets
@@ -262,7 +255,7 @@ func (x *ListBox) DragHighlightRow(RowVar *ListBoxRow) {
 
 var xListBoxDragUnhighlightRow func(uintptr)
 
-// If a row has previously been highlighted via gtk_list_box_drag_highlight_row(),
+// If a row has previously been highlighted via [ListBox.DragHighlightRow],
 // it will have the highlight removed.
 func (x *ListBox) DragUnhighlightRow() {
 
@@ -301,8 +294,8 @@ var xListBoxGetRowAtIndex func(uintptr, int) uintptr
 
 // Gets the n-th child in the list (not counting headers).
 //
-// If @index_ is negative or larger than the number of items in the
-// list, %NULL is returned.
+// If index_ is negative or larger than the number of items in the
+// list, nil is returned.
 func (x *ListBox) GetRowAtIndex(IndexVar int) *ListBoxRow {
 	var cls *ListBoxRow
 
@@ -319,7 +312,7 @@ func (x *ListBox) GetRowAtIndex(IndexVar int) *ListBoxRow {
 
 var xListBoxGetRowAtY func(uintptr, int) uintptr
 
-// Gets the row at the @y position.
+// Gets the row at the y position.
 func (x *ListBox) GetRowAtY(YVar int) *ListBoxRow {
 	var cls *ListBoxRow
 
@@ -336,10 +329,10 @@ func (x *ListBox) GetRowAtY(YVar int) *ListBoxRow {
 
 var xListBoxGetSelectedRow func(uintptr) uintptr
 
-// Gets the selected row, or %NULL if no rows are selected.
+// Gets the selected row, or nil if no rows are selected.
 //
 // Note that the box may allow multiple selection, in which
-// case you should use [method@Gtk.ListBox.selected_foreach] to
+// case you should use [ListBox.SelectedForeach] to
 // find all selected rows.
 func (x *ListBox) GetSelectedRow() *ListBoxRow {
 	var cls *ListBoxRow
@@ -385,7 +378,7 @@ func (x *ListBox) GetShowSeparators() bool {
 
 var xListBoxGetTabBehavior func(uintptr) ListTabBehavior
 
-// Returns the behavior of the &lt;kbd&gt;Tab&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; keys.
+// Returns the behavior of the Tab and Shift+Tab keys.
 func (x *ListBox) GetTabBehavior() ListTabBehavior {
 
 	cret := xListBoxGetTabBehavior(x.GoPointer())
@@ -394,13 +387,13 @@ func (x *ListBox) GetTabBehavior() ListTabBehavior {
 
 var xListBoxInsert func(uintptr, uintptr, int)
 
-// Insert the @child into the @box at @position.
+// Insert the child into the box at position.
 //
 // If a sort function is
 // set, the widget will actually be inserted at the calculated position.
 //
-// If @position is -1, or larger than the total number of items in the
-// @box, then the @child will be appended to the end.
+// If position is -1, or larger than the total number of items in the
+// box, then the child will be appended to the end.
 func (x *ListBox) Insert(ChildVar *Widget, PositionVar int) {
 
 	xListBoxInsert(x.GoPointer(), ChildVar.GoPointer(), PositionVar)
@@ -412,7 +405,7 @@ var xListBoxInvalidateFilter func(uintptr)
 // Update the filtering for all rows.
 //
 // Call this when result
-// of the filter function on the @box is changed due
+// of the filter function on the box is changed due
 // to an external factor. For instance, this would be used
 // if the filter function just looked for a specific search
 // string and the entry with the search string has changed.
@@ -427,7 +420,7 @@ var xListBoxInvalidateHeaders func(uintptr)
 // Update the separators for all rows.
 //
 // Call this when result
-// of the header function on the @box is changed due
+// of the header function on the box is changed due
 // to an external factor.
 func (x *ListBox) InvalidateHeaders() {
 
@@ -440,7 +433,7 @@ var xListBoxInvalidateSort func(uintptr)
 // Update the sorting for all rows.
 //
 // Call this when result
-// of the sort function on the @box is changed due
+// of the sort function on the box is changed due
 // to an external factor.
 func (x *ListBox) InvalidateSort() {
 
@@ -462,7 +455,7 @@ func (x *ListBox) Prepend(ChildVar *Widget) {
 
 var xListBoxRemove func(uintptr, uintptr)
 
-// Removes a child from @box.
+// Removes a child from box.
 func (x *ListBox) Remove(ChildVar *Widget) {
 
 	xListBoxRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -471,9 +464,9 @@ func (x *ListBox) Remove(ChildVar *Widget) {
 
 var xListBoxRemoveAll func(uintptr)
 
-// Removes all rows from @box.
+// Removes all rows from box.
 //
-// This function does nothing if @box is backed by a model.
+// This function does nothing if box is backed by a model.
 func (x *ListBox) RemoveAll() {
 
 	xListBoxRemoveAll(x.GoPointer())
@@ -482,7 +475,7 @@ func (x *ListBox) RemoveAll() {
 
 var xListBoxSelectAll func(uintptr)
 
-// Select all children of @box, if the selection mode allows it.
+// Select all children of box, if the selection mode allows it.
 func (x *ListBox) SelectAll() {
 
 	xListBoxSelectAll(x.GoPointer())
@@ -491,7 +484,7 @@ func (x *ListBox) SelectAll() {
 
 var xListBoxSelectRow func(uintptr, uintptr)
 
-// Make @row the currently selected row.
+// Make row the currently selected row.
 func (x *ListBox) SelectRow(RowVar *ListBoxRow) {
 
 	xListBoxSelectRow(x.GoPointer(), RowVar.GoPointer())
@@ -512,11 +505,12 @@ func (x *ListBox) SelectedForeach(FuncVar *ListBoxForeachFunc, DataVar uintptr)
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -526,7 +520,7 @@ func (x *ListBox) SelectedForeach(FuncVar *ListBoxForeachFunc, DataVar uintptr)
 
 var xListBoxSetActivateOnSingleClick func(uintptr, bool)
 
-// If @single is %TRUE, rows will be activated when you click on them,
+// If single is true, rows will be activated when you click on them,
 // otherwise you need to double-click.
 func (x *ListBox) SetActivateOnSingleClick(SingleVar bool) {
 
@@ -542,7 +536,7 @@ var xListBoxSetAdjustment func(uintptr, uintptr)
 // For instance, this is used to get the page size for
 // PageUp/Down key handling.
 //
-// In the normal case when the @box is packed inside
+// In the normal case when the box is packed inside
 // a `GtkScrolledWindow` the adjustment from that will
 // be picked up automatically, so there is no need
 // to manually do that.
@@ -554,19 +548,19 @@ func (x *ListBox) SetAdjustment(AdjustmentVar *Adjustment) {
 
 var xListBoxSetFilterFunc func(uintptr, uintptr, uintptr, uintptr)
 
-// By setting a filter function on the @box one can decide dynamically which
+// By setting a filter function on the box one can decide dynamically which
 // of the rows to show.
 //
 // For instance, to implement a search function on a list that
 // filters the original list to only show the matching rows.
 //
-// The @filter_func will be called for each row after the call, and
+// The filter_func will be called for each row after the call, and
 // it will continue to be called each time a row changes (via
-// [method@Gtk.ListBoxRow.changed]) or when [method@Gtk.ListBox.invalidate_filter]
+// [ListBoxRow.Changed]) or when [ListBox.InvalidateFilter]
 // is called.
 //
 // Note that using a filter function is incompatible with using a model
-// (see [method@Gtk.ListBox.bind_model]).
+// (see [ListBox.BindModel]).
 func (x *ListBox) SetFilterFunc(FilterFuncVar *ListBoxFilterFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var FilterFuncVarRef uintptr
@@ -576,11 +570,12 @@ func (x *ListBox) SetFilterFunc(FilterFuncVar *ListBoxFilterFunc, UserDataVar ui
 			FilterFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FilterFuncVar
 				return cbFn(arg0, arg1)
 			}
-			FilterFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FilterFuncVarPtr, FilterFuncVarRef, FilterFuncVar)
+			FilterFuncVarRef = core.NewCallback(fcb)
+			FilterFuncVarRef = glib.SaveCallbackWithClosure(FilterFuncVarPtr, FilterFuncVarRef, FilterFuncVar)
 		}
 	}
 
@@ -591,11 +586,12 @@ func (x *ListBox) SetFilterFunc(FilterFuncVar *ListBoxFilterFunc, UserDataVar ui
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -607,29 +603,29 @@ var xListBoxSetHeaderFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets a header function.
 //
-// By setting a header function on the @box one can dynamically add headers
+// By setting a header function on the box one can dynamically add headers
 // in front of rows, depending on the contents of the row and its position
 // in the list.
 //
 // For instance, one could use it to add headers in front of the first item
 // of a new kind, in a list sorted by the kind.
 //
-// The @update_header can look at the current header widget using
-// [method@Gtk.ListBoxRow.get_header] and either update the state of the widget
-// as needed, or set a new one using [method@Gtk.ListBoxRow.set_header]. If no
-// header is needed, set the header to %NULL.
+// The update_header can look at the current header widget using
+// [ListBoxRow.GetHeader] and either update the state of the widget
+// as needed, or set a new one using [ListBoxRow.SetHeader]. If no
+// header is needed, set the header to nil.
 //
-// Note that you may get many calls @update_header to this for a particular
+// Note that you may get many calls update_header to this for a particular
 // row when e.g. changing things that don’t affect the header. In this case
 // it is important for performance to not blindly replace an existing header
 // with an identical one.
 //
-// The @update_header function will be called for each row after the call,
+// The update_header function will be called for each row after the call,
 // and it will continue to be called each time a row changes (via
-// [method@Gtk.ListBoxRow.changed]) and when the row before changes (either
-// by [method@Gtk.ListBoxRow.changed] on the previous row, or when the previous
+// [ListBoxRow.Changed]) and when the row before changes (either
+// by [ListBoxRow.Changed] on the previous row, or when the previous
 // row becomes a different row). It is also called for all rows when
-// [method@Gtk.ListBox.invalidate_headers] is called.
+// [ListBox.InvalidateHeaders] is called.
 func (x *ListBox) SetHeaderFunc(UpdateHeaderVar *ListBoxUpdateHeaderFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var UpdateHeaderVarRef uintptr
@@ -639,11 +635,12 @@ func (x *ListBox) SetHeaderFunc(UpdateHeaderVar *ListBoxUpdateHeaderFunc, UserDa
 			UpdateHeaderVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UpdateHeaderVar
 				cbFn(arg0, arg1, arg2)
 			}
-			UpdateHeaderVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UpdateHeaderVarPtr, UpdateHeaderVarRef, UpdateHeaderVar)
+			UpdateHeaderVarRef = core.NewCallback(fcb)
+			UpdateHeaderVarRef = glib.SaveCallbackWithClosure(UpdateHeaderVarPtr, UpdateHeaderVarRef, UpdateHeaderVar)
 		}
 	}
 
@@ -654,11 +651,12 @@ func (x *ListBox) SetHeaderFunc(UpdateHeaderVar *ListBoxUpdateHeaderFunc, UserDa
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -699,16 +697,16 @@ var xListBoxSetSortFunc func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets a sort function.
 //
-// By setting a sort function on the @box one can dynamically reorder
+// By setting a sort function on the box one can dynamically reorder
 // the rows of the list, based on the contents of the rows.
 //
-// The @sort_func will be called for each row after the call, and will
+// The sort_func will be called for each row after the call, and will
 // continue to be called each time a row changes (via
-// [method@Gtk.ListBoxRow.changed]) and when [method@Gtk.ListBox.invalidate_sort]
+// [ListBoxRow.Changed]) and when [ListBox.InvalidateSort]
 // is called.
 //
 // Note that using a sort function is incompatible with using a model
-// (see [method@Gtk.ListBox.bind_model]).
+// (see [ListBox.BindModel]).
 func (x *ListBox) SetSortFunc(SortFuncVar *ListBoxSortFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	var SortFuncVarRef uintptr
@@ -718,11 +716,12 @@ func (x *ListBox) SetSortFunc(SortFuncVar *ListBoxSortFunc, UserDataVar uintptr,
 			SortFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) int {
+				defer core.RecoverPanic()
 				cbFn := *SortFuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			SortFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
 		}
 	}
 
@@ -733,11 +732,12 @@ func (x *ListBox) SetSortFunc(SortFuncVar *ListBoxSortFunc, UserDataVar uintptr,
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -747,7 +747,7 @@ func (x *ListBox) SetSortFunc(SortFuncVar *ListBoxSortFunc, UserDataVar uintptr,
 
 var xListBoxSetTabBehavior func(uintptr, ListTabBehavior)
 
-// Sets the behavior of the &lt;kbd&gt;Tab&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; keys.
+// Sets the behavior of the Tab and Shift+Tab keys.
 func (x *ListBox) SetTabBehavior(BehaviorVar ListTabBehavior) {
 
 	xListBoxSetTabBehavior(x.GoPointer(), BehaviorVar)
@@ -756,7 +756,7 @@ func (x *ListBox) SetTabBehavior(BehaviorVar ListTabBehavior) {
 
 var xListBoxUnselectAll func(uintptr)
 
-// Unselect all children of @box, if the selection mode allows it.
+// Unselect all children of box, if the selection mode allows it.
 func (x *ListBox) UnselectAll() {
 
 	xListBoxUnselectAll(x.GoPointer())
@@ -765,7 +765,7 @@ func (x *ListBox) UnselectAll() {
 
 var xListBoxUnselectRow func(uintptr, uintptr)
 
-// Unselects a single row of @box, if the selection mode allows it.
+// Unselects a single row of box, if the selection mode allows it.
 func (x *ListBox) UnselectRow(RowVar *ListBoxRow) {
 
 	xListBoxUnselectRow(x.GoPointer(), RowVar.GoPointer())
@@ -837,27 +837,49 @@ func (x *ListBox) GetPropertyShowSeparators() bool {
 }
 
 // Emitted when the cursor row is activated.
+//
+// Deprecated: use ConnectActivateCursorRowFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectActivateCursorRow(cb *func(ListBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-cursor-row", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-cursor-row", cbRefPtr, cbPtr)
+}
+
+var xListBoxActivateCursorRowTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xListBoxActivateCursorRowNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-cursor-row", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateCursorRowFunc connects cb to the "activate-cursor-row" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectActivateCursorRowFunc(cb func(ListBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-cursor-row", &xListBoxActivateCursorRowTrampoline, xListBoxActivateCursorRowNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a cursor movement.
@@ -867,193 +889,347 @@ func (x *ListBox) ConnectActivateCursorRow(cb *func(ListBox)) uint {
 // modifier does not. There are too many key combinations to list them all
 // here.
 //
-//   - &lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;
+//   - ←, →, ↑, ↓
 //     move by individual children
-//   - &lt;kbd&gt;Home&lt;/kbd&gt;, &lt;kbd&gt;End&lt;/kbd&gt; move to the ends of the box
-//   - &lt;kbd&gt;PgUp&lt;/kbd&gt;, &lt;kbd&gt;PgDn&lt;/kbd&gt; move vertically by pages
+//   - Home, End move to the ends of the box
+//   - PgUp, PgDn move vertically by pages
+//
+// Deprecated: use ConnectMoveCursorFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectMoveCursor(cb *func(ListBox, MovementStep, int, bool, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp, CountVarp, ExtendVarp, ModifyVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-cursor", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool) {
+var xListBoxMoveCursorTrampoline uintptr
+
+func xListBoxMoveCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, ModifyVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox, MovementStep, int, bool, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp, CountVarp, ExtendVarp, ModifyVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveCursorFunc connects cb to the "move-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectMoveCursorFunc(cb func(ListBox, MovementStep, int, bool, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-cursor", &xListBoxMoveCursorTrampoline, xListBoxMoveCursorNewTrampoline, cb)
 }
 
 // Emitted when a row has been activated by the user.
+//
+// Deprecated: use ConnectRowActivatedFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectRowActivated(cb *func(ListBox, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "row-activated", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, RowVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, RowVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "row-activated", cbRefPtr, cbPtr)
+}
+
+var xListBoxRowActivatedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, RowVarp uintptr) {
+func xListBoxRowActivatedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, RowVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, RowVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "row-activated", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when a new row is selected, or (with a %NULL @row)
+// ConnectRowActivatedFunc connects cb to the "row-activated" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectRowActivatedFunc(cb func(ListBox, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "row-activated", &xListBoxRowActivatedTrampoline, xListBoxRowActivatedNewTrampoline, cb)
+}
+
+// Emitted when a new row is selected, or (with a nil row)
 // when the selection is cleared.
 //
-// When the @box is using %GTK_SELECTION_MULTIPLE, this signal will not
+// When the box is using [SelectionMultipleValue], this signal will not
 // give you the full picture of selection changes, and you should use
-// the [signal@Gtk.ListBox::selected-rows-changed] signal instead.
+// the [ListBox.ConnectSelectedRowsChangedFunc] signal instead.
+//
+// Deprecated: use ConnectRowSelectedFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectRowSelected(cb *func(ListBox, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "row-selected", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, RowVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, RowVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "row-selected", cbRefPtr, cbPtr)
+}
+
+var xListBoxRowSelectedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, RowVarp uintptr) {
+func xListBoxRowSelectedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, RowVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, RowVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "row-selected", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRowSelectedFunc connects cb to the "row-selected" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectRowSelectedFunc(cb func(ListBox, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "row-selected", &xListBoxRowSelectedTrampoline, xListBoxRowSelectedNewTrampoline, cb)
 }
 
 // Emitted to select all children of the box, if the selection
 // mode permits it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;a&lt;/kbd&gt;.
+// The default binding for this signal is Ctrl-a.
+//
+// Deprecated: use ConnectSelectAllFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectSelectAll(cb *func(ListBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "select-all", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xListBoxSelectAllTrampoline uintptr
+
+func xListBoxSelectAllNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectAllFunc connects cb to the "select-all" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectSelectAllFunc(cb func(ListBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "select-all", &xListBoxSelectAllTrampoline, xListBoxSelectAllNewTrampoline, cb)
 }
 
 // Emitted when the set of selected rows changes.
+//
+// Deprecated: use ConnectSelectedRowsChangedFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectSelectedRowsChanged(cb *func(ListBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "selected-rows-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "selected-rows-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xListBoxSelectedRowsChangedTrampoline uintptr
+
+func xListBoxSelectedRowsChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "selected-rows-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectedRowsChangedFunc connects cb to the "selected-rows-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectSelectedRowsChangedFunc(cb func(ListBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "selected-rows-changed", &xListBoxSelectedRowsChangedTrampoline, xListBoxSelectedRowsChangedNewTrampoline, cb)
 }
 
 // Emitted when the cursor row is toggled.
 //
-// The default bindings for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;␣&lt;/kbd&gt;.
+// The default bindings for this signal is Ctrl+␣.
+//
+// Deprecated: use ConnectToggleCursorRowFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectToggleCursorRow(cb *func(ListBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-row", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-cursor-row", cbRefPtr, cbPtr)
+}
+
+var xListBoxToggleCursorRowTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xListBoxToggleCursorRowNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-row", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleCursorRowFunc connects cb to the "toggle-cursor-row" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectToggleCursorRowFunc(cb func(ListBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-cursor-row", &xListBoxToggleCursorRowTrampoline, xListBoxToggleCursorRowNewTrampoline, cb)
 }
 
 // Emitted to unselect all children of the box, if the selection
 // mode permits it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default binding for this signal is
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;-&lt;kbd&gt;Shift&lt;/kbd&gt;-&lt;kbd&gt;a&lt;/kbd&gt;.
+// Ctrl-Shift-a.
+//
+// Deprecated: use ConnectUnselectAllFunc, which also accepts method values and closures.
 func (x *ListBox) ConnectUnselectAll(cb *func(ListBox)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unselect-all", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBox{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unselect-all", cbRefPtr, cbPtr)
+}
+
+var xListBoxUnselectAllTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xListBoxUnselectAllNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBox))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBox{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unselect-all", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnselectAllFunc connects cb to the "unselect-all" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBox) ConnectUnselectAllFunc(cb func(ListBox)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unselect-all", &xListBoxUnselectAllTrampoline, xListBoxUnselectAllNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1217,7 +1393,7 @@ func (x *ListBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1225,14 +1401,13 @@ func (x *ListBox) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ListBox) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1256,19 +1431,17 @@ func (x *ListBox) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ListBox) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1289,7 +1462,7 @@ func (x *ListBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1298,14 +1471,12 @@ func (x *ListBox) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ListBox) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1324,10 +1495,10 @@ func (x *ListBox) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ListBox) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1336,7 +1507,7 @@ func (x *ListBox) GetBuildableId() string {
 
 // The kind of widget that can be added to a `GtkListBox`.
 //
-// [class@Gtk.ListBox] will automatically wrap its children in a `GtkListboxRow`
+// [ListBox] will automatically wrap its children in a `GtkListboxRow`
 // when necessary.
 type ListBoxRow struct {
 	Widget
@@ -1373,7 +1544,7 @@ func NewListBoxRow() *ListBoxRow {
 
 var xListBoxRowChanged func(uintptr)
 
-// Marks @row as changed, causing any state that depends on this
+// Marks row as changed, causing any state that depends on this
 // to be updated.
 //
 // This affects sorting, filtering and headers.
@@ -1381,7 +1552,7 @@ var xListBoxRowChanged func(uintptr)
 // Note that calls to this method must be in sync with the data
 // used for the row functions. For instance, if the list is
 // mirroring some external data set, and *two* rows changed in the
-// external data set then when you call gtk_list_box_row_changed()
+// external data set then when you call [ListBoxRow.Changed]
 // on the first row the sort function must only read the new data
 // for the first of the two changed rows, otherwise the resorting
 // of the rows will be wrong.
@@ -1389,7 +1560,7 @@ var xListBoxRowChanged func(uintptr)
 // This generally means that if you don’t fully control the data
 // model you have to duplicate the data that affects the listbox
 // row functions into the row widgets themselves. Another alternative
-// is to call [method@Gtk.ListBox.invalidate_sort] on any model change,
+// is to call [ListBox.InvalidateSort] on any model change,
 // but that is more expensive.
 func (x *ListBoxRow) Changed() {
 
@@ -1408,7 +1579,7 @@ func (x *ListBoxRow) GetActivatable() bool {
 
 var xListBoxRowGetChild func(uintptr) uintptr
 
-// Gets the child widget of @row.
+// Gets the child widget of row.
 func (x *ListBoxRow) GetChild() *Widget {
 	var cls *Widget
 
@@ -1425,10 +1596,10 @@ func (x *ListBoxRow) GetChild() *Widget {
 
 var xListBoxRowGetHeader func(uintptr) uintptr
 
-// Returns the current header of the @row.
+// Returns the current header of the row.
 //
 // This can be used
-// in a [callback@Gtk.ListBoxUpdateHeaderFunc] to see if
+// in a [ListBoxUpdateHeaderFunc] to see if
 // there is a header set already, and if so to update
 // the state of it.
 func (x *ListBoxRow) GetHeader() *Widget {
@@ -1447,7 +1618,7 @@ func (x *ListBoxRow) GetHeader() *Widget {
 
 var xListBoxRowGetIndex func(uintptr) int
 
-// Gets the current index of the @row in its `GtkListBox` container.
+// Gets the current index of the row in its `GtkListBox` container.
 func (x *ListBoxRow) GetIndex() int {
 
 	cret := xListBoxRowGetIndex(x.GoPointer())
@@ -1484,7 +1655,7 @@ func (x *ListBoxRow) SetActivatable(ActivatableVar bool) {
 
 var xListBoxRowSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @self.
+// Sets the child widget of self.
 func (x *ListBoxRow) SetChild(ChildVar *Widget) {
 
 	xListBoxRowSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -1493,10 +1664,10 @@ func (x *ListBoxRow) SetChild(ChildVar *Widget) {
 
 var xListBoxRowSetHeader func(uintptr, uintptr)
 
-// Sets the current header of the @row.
+// Sets the current header of the row.
 //
 // This is only allowed to be called
-// from a [callback@Gtk.ListBoxUpdateHeaderFunc].
+// from a [ListBoxUpdateHeaderFunc].
 // It will replace any existing header in the row,
 // and be shown in front of the row in the listbox.
 func (x *ListBoxRow) SetHeader(HeaderVar *Widget) {
@@ -1564,29 +1735,51 @@ func (x *ListBoxRow) GetPropertySelectable() bool {
 // This is a keybinding signal, which will cause this row to be activated.
 //
 // If you want to be notified when the user activates a row (by key or not),
-// use the [signal@Gtk.ListBox::row-activated] signal on the row’s parent
+// use the [ListBox.ConnectRowActivatedFunc] signal on the row’s parent
 // `GtkListBox`.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *ListBoxRow) ConnectActivate(cb *func(ListBoxRow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ListBoxRow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xListBoxRowActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xListBoxRowActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListBoxRow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListBoxRow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListBoxRow) ConnectActivateFunc(cb func(ListBoxRow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xListBoxRowActivateTrampoline, xListBoxRowActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1750,7 +1943,7 @@ func (x *ListBoxRow) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1758,14 +1951,13 @@ func (x *ListBoxRow) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ListBoxRow) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1789,19 +1981,17 @@ func (x *ListBoxRow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ListBoxRow) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1822,7 +2012,7 @@ func (x *ListBoxRow) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1831,14 +2021,12 @@ func (x *ListBoxRow) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ListBoxRow) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1857,14 +2045,14 @@ func (x *ListBoxRow) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ListBoxRow) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ListBoxRow) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -1874,15 +2062,15 @@ func (x *ListBoxRow) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ListBoxRow) SetActionName(ActionNameVar *string) {
 
@@ -1895,13 +2083,13 @@ func (x *ListBoxRow) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *ListBoxRow) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -1910,14 +2098,14 @@ func (x *ListBoxRow) SetActionTarget(FormatStringVar string, varArgs ...interfac
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -1935,18 +2123,18 @@ func (x *ListBoxRow) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ListBoxRow) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ListBoxRow) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1958,7 +2146,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1967,57 +2155,59 @@ func init() {
 
 	core.PuregoSafeRegister(&xListBoxGLibType, libs, "gtk_list_box_get_type")
 
-	core.PuregoSafeRegister(&xNewListBox, libs, "gtk_list_box_new")
-
-	core.PuregoSafeRegister(&xListBoxAppend, libs, "gtk_list_box_append")
-	core.PuregoSafeRegister(&xListBoxBindModel, libs, "gtk_list_box_bind_model")
-	core.PuregoSafeRegister(&xListBoxDragHighlightRow, libs, "gtk_list_box_drag_highlight_row")
-	core.PuregoSafeRegister(&xListBoxDragUnhighlightRow, libs, "gtk_list_box_drag_unhighlight_row")
-	core.PuregoSafeRegister(&xListBoxGetActivateOnSingleClick, libs, "gtk_list_box_get_activate_on_single_click")
-	core.PuregoSafeRegister(&xListBoxGetAdjustment, libs, "gtk_list_box_get_adjustment")
-	core.PuregoSafeRegister(&xListBoxGetRowAtIndex, libs, "gtk_list_box_get_row_at_index")
-	core.PuregoSafeRegister(&xListBoxGetRowAtY, libs, "gtk_list_box_get_row_at_y")
-	core.PuregoSafeRegister(&xListBoxGetSelectedRow, libs, "gtk_list_box_get_selected_row")
-	core.PuregoSafeRegister(&xListBoxGetSelectedRows, libs, "gtk_list_box_get_selected_rows")
-	core.PuregoSafeRegister(&xListBoxGetSelectionMode, libs, "gtk_list_box_get_selection_mode")
-	core.PuregoSafeRegister(&xListBoxGetShowSeparators, libs, "gtk_list_box_get_show_separators")
-	core.PuregoSafeRegister(&xListBoxGetTabBehavior, libs, "gtk_list_box_get_tab_behavior")
-	core.PuregoSafeRegister(&xListBoxInsert, libs, "gtk_list_box_insert")
-	core.PuregoSafeRegister(&xListBoxInvalidateFilter, libs, "gtk_list_box_invalidate_filter")
-	core.PuregoSafeRegister(&xListBoxInvalidateHeaders, libs, "gtk_list_box_invalidate_headers")
-	core.PuregoSafeRegister(&xListBoxInvalidateSort, libs, "gtk_list_box_invalidate_sort")
-	core.PuregoSafeRegister(&xListBoxPrepend, libs, "gtk_list_box_prepend")
-	core.PuregoSafeRegister(&xListBoxRemove, libs, "gtk_list_box_remove")
-	core.PuregoSafeRegister(&xListBoxRemoveAll, libs, "gtk_list_box_remove_all")
-	core.PuregoSafeRegister(&xListBoxSelectAll, libs, "gtk_list_box_select_all")
-	core.PuregoSafeRegister(&xListBoxSelectRow, libs, "gtk_list_box_select_row")
-	core.PuregoSafeRegister(&xListBoxSelectedForeach, libs, "gtk_list_box_selected_foreach")
-	core.PuregoSafeRegister(&xListBoxSetActivateOnSingleClick, libs, "gtk_list_box_set_activate_on_single_click")
-	core.PuregoSafeRegister(&xListBoxSetAdjustment, libs, "gtk_list_box_set_adjustment")
-	core.PuregoSafeRegister(&xListBoxSetFilterFunc, libs, "gtk_list_box_set_filter_func")
-	core.PuregoSafeRegister(&xListBoxSetHeaderFunc, libs, "gtk_list_box_set_header_func")
-	core.PuregoSafeRegister(&xListBoxSetPlaceholder, libs, "gtk_list_box_set_placeholder")
-	core.PuregoSafeRegister(&xListBoxSetSelectionMode, libs, "gtk_list_box_set_selection_mode")
-	core.PuregoSafeRegister(&xListBoxSetShowSeparators, libs, "gtk_list_box_set_show_separators")
-	core.PuregoSafeRegister(&xListBoxSetSortFunc, libs, "gtk_list_box_set_sort_func")
-	core.PuregoSafeRegister(&xListBoxSetTabBehavior, libs, "gtk_list_box_set_tab_behavior")
-	core.PuregoSafeRegister(&xListBoxUnselectAll, libs, "gtk_list_box_unselect_all")
-	core.PuregoSafeRegister(&xListBoxUnselectRow, libs, "gtk_list_box_unselect_row")
+	core.PuregoRegisterSince(&xNewListBox, libs, "gtk_list_box_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xListBoxAppend, libs, "gtk_list_box_append", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxBindModel, libs, "gtk_list_box_bind_model", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxDragHighlightRow, libs, "gtk_list_box_drag_highlight_row", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxDragUnhighlightRow, libs, "gtk_list_box_drag_unhighlight_row", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetActivateOnSingleClick, libs, "gtk_list_box_get_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetAdjustment, libs, "gtk_list_box_get_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetRowAtIndex, libs, "gtk_list_box_get_row_at_index", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetRowAtY, libs, "gtk_list_box_get_row_at_y", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetSelectedRow, libs, "gtk_list_box_get_selected_row", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetSelectedRows, libs, "gtk_list_box_get_selected_rows", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetSelectionMode, libs, "gtk_list_box_get_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetShowSeparators, libs, "gtk_list_box_get_show_separators", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxGetTabBehavior, libs, "gtk_list_box_get_tab_behavior", "GTK", "4.18")
+	core.PuregoRegisterSince(&xListBoxInsert, libs, "gtk_list_box_insert", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxInvalidateFilter, libs, "gtk_list_box_invalidate_filter", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxInvalidateHeaders, libs, "gtk_list_box_invalidate_headers", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxInvalidateSort, libs, "gtk_list_box_invalidate_sort", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxPrepend, libs, "gtk_list_box_prepend", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRemove, libs, "gtk_list_box_remove", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRemoveAll, libs, "gtk_list_box_remove_all", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListBoxSelectAll, libs, "gtk_list_box_select_all", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSelectRow, libs, "gtk_list_box_select_row", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSelectedForeach, libs, "gtk_list_box_selected_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetActivateOnSingleClick, libs, "gtk_list_box_set_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetAdjustment, libs, "gtk_list_box_set_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetFilterFunc, libs, "gtk_list_box_set_filter_func", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetHeaderFunc, libs, "gtk_list_box_set_header_func", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetPlaceholder, libs, "gtk_list_box_set_placeholder", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetSelectionMode, libs, "gtk_list_box_set_selection_mode", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetShowSeparators, libs, "gtk_list_box_set_show_separators", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetSortFunc, libs, "gtk_list_box_set_sort_func", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxSetTabBehavior, libs, "gtk_list_box_set_tab_behavior", "GTK", "4.18")
+	core.PuregoRegisterSince(&xListBoxUnselectAll, libs, "gtk_list_box_unselect_all", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxUnselectRow, libs, "gtk_list_box_unselect_row", "GTK", "")
 
 	core.PuregoSafeRegister(&xListBoxRowGLibType, libs, "gtk_list_box_row_get_type")
 
-	core.PuregoSafeRegister(&xNewListBoxRow, libs, "gtk_list_box_row_new")
-
-	core.PuregoSafeRegister(&xListBoxRowChanged, libs, "gtk_list_box_row_changed")
-	core.PuregoSafeRegister(&xListBoxRowGetActivatable, libs, "gtk_list_box_row_get_activatable")
-	core.PuregoSafeRegister(&xListBoxRowGetChild, libs, "gtk_list_box_row_get_child")
-	core.PuregoSafeRegister(&xListBoxRowGetHeader, libs, "gtk_list_box_row_get_header")
-	core.PuregoSafeRegister(&xListBoxRowGetIndex, libs, "gtk_list_box_row_get_index")
-	core.PuregoSafeRegister(&xListBoxRowGetSelectable, libs, "gtk_list_box_row_get_selectable")
-	core.PuregoSafeRegister(&xListBoxRowIsSelected, libs, "gtk_list_box_row_is_selected")
-	core.PuregoSafeRegister(&xListBoxRowSetActivatable, libs, "gtk_list_box_row_set_activatable")
-	core.PuregoSafeRegister(&xListBoxRowSetChild, libs, "gtk_list_box_row_set_child")
-	core.PuregoSafeRegister(&xListBoxRowSetHeader, libs, "gtk_list_box_row_set_header")
-	core.PuregoSafeRegister(&xListBoxRowSetSelectable, libs, "gtk_list_box_row_set_selectable")
+	gobject.CheckClassSize("GtkListBoxRowClass", xListBoxRowGLibType, unsafe.Sizeof(ListBoxRowClass{}))
+
+	core.PuregoRegisterSince(&xNewListBoxRow, libs, "gtk_list_box_row_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xListBoxRowChanged, libs, "gtk_list_box_row_changed", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowGetActivatable, libs, "gtk_list_box_row_get_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowGetChild, libs, "gtk_list_box_row_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowGetHeader, libs, "gtk_list_box_row_get_header", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowGetIndex, libs, "gtk_list_box_row_get_index", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowGetSelectable, libs, "gtk_list_box_row_get_selectable", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowIsSelected, libs, "gtk_list_box_row_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowSetActivatable, libs, "gtk_list_box_row_set_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowSetChild, libs, "gtk_list_box_row_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowSetHeader, libs, "gtk_list_box_row_set_header", "GTK", "")
+	core.PuregoRegisterSince(&xListBoxRowSetSelectable, libs, "gtk_list_box_row_set_selectable", "GTK", "")
 
 }
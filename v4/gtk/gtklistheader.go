@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -21,7 +20,7 @@ func (x *ListHeaderClass) GoPointer() uintptr {
 
 // Used by list widgets to represent the headers they display.
 //
-// `GtkListHeader` objects are managed just like [class@Gtk.ListItem]
+// `GtkListHeader` objects are managed just like [ListItem]
 // objects via their factory, but provide a different set of properties suitable
 // for managing the header instead of individual items.
 type ListHeader struct {
@@ -42,8 +41,8 @@ func ListHeaderNewFromInternalPtr(ptr uintptr) *ListHeader {
 
 var xListHeaderGetChild func(uintptr) uintptr
 
-// Gets the child previously set via gtk_list_header_set_child() or
-// %NULL if none was set.
+// Gets the child previously set via [ListHeader.SetChild] or
+// nil if none was set.
 func (x *ListHeader) GetChild() *Widget {
 	var cls *Widget
 
@@ -60,10 +59,10 @@ func (x *ListHeader) GetChild() *Widget {
 
 var xListHeaderGetEnd func(uintptr) uint
 
-// Gets the end position in the model of the section that @self is
+// Gets the end position in the model of the section that self is
 // currently the header for.
 //
-// If @self is unbound, %GTK_INVALID_LIST_POSITION is returned.
+// If self is unbound, [INVALID_LIST_POSITION] is returned.
 func (x *ListHeader) GetEnd() uint {
 
 	cret := xListHeaderGetEnd(x.GoPointer())
@@ -74,9 +73,9 @@ var xListHeaderGetItem func(uintptr) uintptr
 
 // Gets the model item at the start of the section.
 // This is the item that occupies the list model at position
-// [property@Gtk.ListHeader:start].
+// [ListHeader.GetPropertyStart].
 //
-// If @self is unbound, this function returns %NULL.
+// If self is unbound, this function returns nil.
 func (x *ListHeader) GetItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -95,7 +94,7 @@ var xListHeaderGetNItems func(uintptr) uint
 
 // Gets the the number of items in the section.
 //
-// If @self is unbound, 0 is returned.
+// If self is unbound, 0 is returned.
 func (x *ListHeader) GetNItems() uint {
 
 	cret := xListHeaderGetNItems(x.GoPointer())
@@ -104,10 +103,10 @@ func (x *ListHeader) GetNItems() uint {
 
 var xListHeaderGetStart func(uintptr) uint
 
-// Gets the start position in the model of the section that @self is
+// Gets the start position in the model of the section that self is
 // currently the header for.
 //
-// If @self is unbound, %GTK_INVALID_LIST_POSITION is returned.
+// If self is unbound, [INVALID_LIST_POSITION] is returned.
 func (x *ListHeader) GetStart() uint {
 
 	cret := xListHeaderGetStart(x.GoPointer())
@@ -167,7 +166,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -176,11 +175,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xListHeaderGLibType, libs, "gtk_list_header_get_type")
 
-	core.PuregoSafeRegister(&xListHeaderGetChild, libs, "gtk_list_header_get_child")
-	core.PuregoSafeRegister(&xListHeaderGetEnd, libs, "gtk_list_header_get_end")
-	core.PuregoSafeRegister(&xListHeaderGetItem, libs, "gtk_list_header_get_item")
-	core.PuregoSafeRegister(&xListHeaderGetNItems, libs, "gtk_list_header_get_n_items")
-	core.PuregoSafeRegister(&xListHeaderGetStart, libs, "gtk_list_header_get_start")
-	core.PuregoSafeRegister(&xListHeaderSetChild, libs, "gtk_list_header_set_child")
+	core.PuregoRegisterSince(&xListHeaderGetChild, libs, "gtk_list_header_get_child", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListHeaderGetEnd, libs, "gtk_list_header_get_end", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListHeaderGetItem, libs, "gtk_list_header_get_item", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListHeaderGetNItems, libs, "gtk_list_header_get_n_items", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListHeaderGetStart, libs, "gtk_list_header_get_start", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListHeaderSetChild, libs, "gtk_list_header_set_child", "GTK", "4.12")
 
 }
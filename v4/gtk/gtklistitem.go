@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -19,20 +18,20 @@ func (x *ListItemClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Used by list widgets to represent items in a [iface@Gio.ListModel].
+// Used by list widgets to represent items in a [gio.ListModel].
 //
 // `GtkListItem` objects are managed by the list widget (with its factory)
 // and cannot be created by applications, but they need to be populated
-// by application code. This is done by calling [method@Gtk.ListItem.set_child].
+// by application code. This is done by calling [ListItem.SetChild].
 //
 // `GtkListItem` objects exist in 2 stages:
 //
 //  1. The unbound stage where the listitem is not currently connected to
-//     an item in the list. In that case, the [property@Gtk.ListItem:item]
+//     an item in the list. In that case, the ListItem:item
 //     property is set to `NULL`.
 //
 //  2. The bound stage where the listitem references an item from the list.
-//     The [property@Gtk.ListItem:item] property is not `NULL`.
+//     The ListItem:item property is not `NULL`.
 type ListItem struct {
 	gobject.Object
 }
@@ -51,7 +50,7 @@ func ListItemNewFromInternalPtr(ptr uintptr) *ListItem {
 
 var xListItemGetAccessibleDescription func(uintptr) string
 
-// Gets the accessible description of @self.
+// Gets the accessible description of self.
 func (x *ListItem) GetAccessibleDescription() string {
 
 	cret := xListItemGetAccessibleDescription(x.GoPointer())
@@ -60,7 +59,7 @@ func (x *ListItem) GetAccessibleDescription() string {
 
 var xListItemGetAccessibleLabel func(uintptr) string
 
-// Gets the accessible label of @self.
+// Gets the accessible label of self.
 func (x *ListItem) GetAccessibleLabel() string {
 
 	cret := xListItemGetAccessibleLabel(x.GoPointer())
@@ -70,7 +69,7 @@ func (x *ListItem) GetAccessibleLabel() string {
 var xListItemGetActivatable func(uintptr) bool
 
 // Checks if a listitem has been set to be activatable via
-// [method@Gtk.ListItem.set_activatable].
+// [ListItem.SetActivatable].
 func (x *ListItem) GetActivatable() bool {
 
 	cret := xListItemGetActivatable(x.GoPointer())
@@ -79,7 +78,7 @@ func (x *ListItem) GetActivatable() bool {
 
 var xListItemGetChild func(uintptr) uintptr
 
-// Gets the child previously set via [method@Gtk.ListItem.set_child]
+// Gets the child previously set via [ListItem.SetChild]
 // or `NULL` if none was set.
 func (x *ListItem) GetChild() *Widget {
 	var cls *Widget
@@ -98,7 +97,7 @@ func (x *ListItem) GetChild() *Widget {
 var xListItemGetFocusable func(uintptr) bool
 
 // Checks if a listitem has been set to be focusable via
-// [method@Gtk.ListItem.set_focusable].
+// [ListItem.SetFocusable].
 func (x *ListItem) GetFocusable() bool {
 
 	cret := xListItemGetFocusable(x.GoPointer())
@@ -107,9 +106,9 @@ func (x *ListItem) GetFocusable() bool {
 
 var xListItemGetItem func(uintptr) uintptr
 
-// Gets the model item that associated with @self.
+// Gets the model item that associated with self.
 //
-// If @self is unbound, this function returns `NULL`.
+// If self is unbound, this function returns `NULL`.
 func (x *ListItem) GetItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -126,9 +125,9 @@ func (x *ListItem) GetItem() *gobject.Object {
 
 var xListItemGetPosition func(uintptr) uint
 
-// Gets the position in the model that @self currently displays.
+// Gets the position in the model that self currently displays.
 //
-// If @self is unbound, `GTK_INVALID_LIST_POSITION` is returned.
+// If self is unbound, `GTK_INVALID_LIST_POSITION` is returned.
 func (x *ListItem) GetPosition() uint {
 
 	cret := xListItemGetPosition(x.GoPointer())
@@ -138,9 +137,9 @@ func (x *ListItem) GetPosition() uint {
 var xListItemGetSelectable func(uintptr) bool
 
 // Checks if a listitem has been set to be selectable via
-// [method@Gtk.ListItem.set_selectable].
+// [ListItem.SetSelectable].
 //
-// Do not confuse this function with [method@Gtk.ListItem.get_selected].
+// Do not confuse this function with [ListItem.GetSelected].
 func (x *ListItem) GetSelectable() bool {
 
 	cret := xListItemGetSelectable(x.GoPointer())
@@ -183,13 +182,13 @@ func (x *ListItem) SetAccessibleLabel(LabelVar string) {
 
 var xListItemSetActivatable func(uintptr, bool)
 
-// Sets @self to be activatable.
+// Sets self to be activatable.
 //
 // If an item is activatable, double-clicking on the item, using
-// the Return key or calling [method@Gtk.Widget.activate] will activate
+// the Return key or calling [Widget.Activate] will activate
 // the item. Activating instructs the containing view to handle
 // activation. `GtkListView` for example will be emitting the
-// [signal@Gtk.ListView::activate] signal.
+// [ListView.ConnectActivateFunc] signal.
 //
 // By default, listitems are activatable.
 func (x *ListItem) SetActivatable(ActivatableVar bool) {
@@ -213,10 +212,10 @@ func (x *ListItem) SetChild(ChildVar *Widget) {
 
 var xListItemSetFocusable func(uintptr, bool)
 
-// Sets @self to be focusable.
+// Sets self to be focusable.
 //
 // If an item is focusable, it can be focused using the keyboard.
-// This works similar to [method@Gtk.Widget.set_focusable].
+// This works similar to [Widget.SetFocusable].
 //
 // Note that if items are not focusable, the keyboard cannot be used to activate
 // them and selecting only works if one of the listitem's children is focusable.
@@ -230,7 +229,7 @@ func (x *ListItem) SetFocusable(FocusableVar bool) {
 
 var xListItemSetSelectable func(uintptr, bool)
 
-// Sets @self to be selectable.
+// Sets self to be selectable.
 //
 // If an item is selectable, clicking on the item or using the keyboard
 // will try to select or unselect the item. If this succeeds is up to
@@ -365,7 +364,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -374,20 +373,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xListItemGLibType, libs, "gtk_list_item_get_type")
 
-	core.PuregoSafeRegister(&xListItemGetAccessibleDescription, libs, "gtk_list_item_get_accessible_description")
-	core.PuregoSafeRegister(&xListItemGetAccessibleLabel, libs, "gtk_list_item_get_accessible_label")
-	core.PuregoSafeRegister(&xListItemGetActivatable, libs, "gtk_list_item_get_activatable")
-	core.PuregoSafeRegister(&xListItemGetChild, libs, "gtk_list_item_get_child")
-	core.PuregoSafeRegister(&xListItemGetFocusable, libs, "gtk_list_item_get_focusable")
-	core.PuregoSafeRegister(&xListItemGetItem, libs, "gtk_list_item_get_item")
-	core.PuregoSafeRegister(&xListItemGetPosition, libs, "gtk_list_item_get_position")
-	core.PuregoSafeRegister(&xListItemGetSelectable, libs, "gtk_list_item_get_selectable")
-	core.PuregoSafeRegister(&xListItemGetSelected, libs, "gtk_list_item_get_selected")
-	core.PuregoSafeRegister(&xListItemSetAccessibleDescription, libs, "gtk_list_item_set_accessible_description")
-	core.PuregoSafeRegister(&xListItemSetAccessibleLabel, libs, "gtk_list_item_set_accessible_label")
-	core.PuregoSafeRegister(&xListItemSetActivatable, libs, "gtk_list_item_set_activatable")
-	core.PuregoSafeRegister(&xListItemSetChild, libs, "gtk_list_item_set_child")
-	core.PuregoSafeRegister(&xListItemSetFocusable, libs, "gtk_list_item_set_focusable")
-	core.PuregoSafeRegister(&xListItemSetSelectable, libs, "gtk_list_item_set_selectable")
+	core.PuregoRegisterSince(&xListItemGetAccessibleDescription, libs, "gtk_list_item_get_accessible_description", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemGetAccessibleLabel, libs, "gtk_list_item_get_accessible_label", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemGetActivatable, libs, "gtk_list_item_get_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xListItemGetChild, libs, "gtk_list_item_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xListItemGetFocusable, libs, "gtk_list_item_get_focusable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemGetItem, libs, "gtk_list_item_get_item", "GTK", "")
+	core.PuregoRegisterSince(&xListItemGetPosition, libs, "gtk_list_item_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xListItemGetSelectable, libs, "gtk_list_item_get_selectable", "GTK", "")
+	core.PuregoRegisterSince(&xListItemGetSelected, libs, "gtk_list_item_get_selected", "GTK", "")
+	core.PuregoRegisterSince(&xListItemSetAccessibleDescription, libs, "gtk_list_item_set_accessible_description", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemSetAccessibleLabel, libs, "gtk_list_item_set_accessible_label", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemSetActivatable, libs, "gtk_list_item_set_activatable", "GTK", "")
+	core.PuregoRegisterSince(&xListItemSetChild, libs, "gtk_list_item_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xListItemSetFocusable, libs, "gtk_list_item_set_focusable", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListItemSetSelectable, libs, "gtk_list_item_set_selectable", "GTK", "")
 
 }
@@ -20,7 +20,7 @@ func (x *ListItemFactoryClass) GoPointer() uintptr {
 // Creates widgets for the items taken from a `GListModel`.
 //
 // This is one of the core concepts of handling list widgets such
-// as [class@Gtk.ListView] or [class@Gtk.GridView].
+// as [ListView] or [GridView].
 //
 // The `GtkListItemFactory` is tasked with creating widgets for items
 // taken from the model when the views need them and updating them as
@@ -45,13 +45,13 @@ func (x *ListItemFactoryClass) GoPointer() uintptr {
 // and hand it to your code to set up a widget for. This list item will provide
 // various properties with information about what item to display and provide
 // you with some opportunities to configure its behavior. See the
-// [class@Gtk.ListItem] documentation for further details.
+// [ListItem] documentation for further details.
 //
 // Various implementations of `GtkListItemFactory` exist to allow you different
 // ways to provide those widgets. The most common implementations are
-// [class@Gtk.BuilderListItemFactory] which takes a `GtkBuilder` .ui file
+// [BuilderListItemFactory] which takes a `GtkBuilder` .ui file
 // and then creates widgets and manages everything automatically from the
-// information in that file and [class@Gtk.SignalListItemFactory] which allows
+// information in that file and [SignalListItemFactory] which allows
 // you to connect to signals with your own code and retain full control over
 // how the widgets are setup and managed.
 //
@@ -62,7 +62,7 @@ func (x *ListItemFactoryClass) GoPointer() uintptr {
 // a new `GtkListItemFactory` which will allow the views to recreate its widgets.
 //
 // Once you have chosen your factory and created it, you need to set it on the view
-// widget you want to use it with, such as via [method@Gtk.ListView.set_factory].
+// widget you want to use it with, such as via [ListView.SetFactory].
 // Reusing factories across different views is allowed, but very uncommon.
 type ListItemFactory struct {
 	gobject.Object
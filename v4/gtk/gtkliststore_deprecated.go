@@ -0,0 +1,379 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xNewListStore func(int, ...interface{}) uintptr
+
+// Creates a new list store.
+//
+// The list store will have n_columns columns, with each column using
+// the given type passed to this function.
+//
+// Note that only types derived from standard GObject fundamental types
+// are supported.
+//
+// As an example:
+//
+//	gtk_list_store_new (3, G_TYPE_INT, G_TYPE_STRING, GDK_TYPE_TEXTURE);
+//
+// will create a new `GtkListStore` with three columns, of type `int`,
+// `gchararray` and `GdkTexture`, respectively.
+//
+// Deprecated: since 4.10. Use [gio.ListStore] instead
+//
+// See [NewListStorev] for the version of this function that language bindings are meant to use.
+func NewListStore(NColumnsVar int, varArgs ...interface{}) *ListStore {
+	return newListStoreVarArgs(NColumnsVar, varArgs...)
+}
+
+var xNewListStorev func(int, []types.GType) uintptr
+
+// Creates a new `GtkListStore`.
+//
+// This function is meant to be used by language bindings.
+//
+// Deprecated: since 4.10. Use [gio.ListStore] instead
+func NewListStorev(NColumnsVar int, TypesVar []types.GType) *ListStore {
+	var cls *ListStore
+
+	cret := xNewListStorev(NColumnsVar, TypesVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &ListStore{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xListStoreAppend func(uintptr, *TreeIter)
+
+// Appends a new row to list_store.  iter will be changed to point to this new
+// row.  The row will be empty after this function is called.  To fill in
+// values, you need to call [ListStore.Set] or [ListStore.SetValue].
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Append(IterVar *TreeIter) {
+
+	xListStoreAppend(x.GoPointer(), IterVar)
+
+}
+
+var xListStoreClear func(uintptr)
+
+// Removes all rows from the list store.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Clear() {
+
+	xListStoreClear(x.GoPointer())
+
+}
+
+var xListStoreInsert func(uintptr, *TreeIter, int)
+
+// Creates a new row at position.  iter will be changed to point to this new
+// row.  If position is -1 or is larger than the number of rows on the list,
+// then the new row will be appended to the list. The row will be empty after
+// this function is called.  To fill in values, you need to call
+// [ListStore.Set] or [ListStore.SetValue].
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Insert(IterVar *TreeIter, PositionVar int) {
+
+	xListStoreInsert(x.GoPointer(), IterVar, PositionVar)
+
+}
+
+var xListStoreInsertAfter func(uintptr, *TreeIter, *TreeIter)
+
+// Inserts a new row after sibling. If sibling is nil, then the row will be
+// prepended to the beginning of the list. iter will be changed to point to
+// this new row. The row will be empty after this function is called. To fill
+// in values, you need to call [ListStore.Set] or [ListStore.SetValue].
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) InsertAfter(IterVar *TreeIter, SiblingVar *TreeIter) {
+
+	xListStoreInsertAfter(x.GoPointer(), IterVar, SiblingVar)
+
+}
+
+var xListStoreInsertBefore func(uintptr, *TreeIter, *TreeIter)
+
+// Inserts a new row before sibling. If sibling is nil, then the row will
+// be appended to the end of the list. iter will be changed to point to this
+// new row. The row will be empty after this function is called. To fill in
+// values, you need to call [ListStore.Set] or [ListStore.SetValue].
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) InsertBefore(IterVar *TreeIter, SiblingVar *TreeIter) {
+
+	xListStoreInsertBefore(x.GoPointer(), IterVar, SiblingVar)
+
+}
+
+var xListStoreInsertWithValues func(uintptr, *TreeIter, int, ...interface{})
+
+// Creates a new row at position. iter will be changed to point to this new
+// row. If position is -1, or larger than the number of rows in the list, then
+// the new row will be appended to the list. The row will be filled with the
+// values given to this function.
+//
+// Calling
+// `gtk_list_store_insert_with_values (list_store, iter, position...)`
+// has the same effect as calling:
+//
+//	static void
+//	insert_value (GtkListStore *list_store,
+//	              GtkTreeIter  *iter,
+//	              int           position)
+//	{
+//	  gtk_list_store_insert (list_store, iter, position);
+//	  gtk_list_store_set (list_store,
+//	                      iter
+//	                      // ...
+//	                      );
+//	}
+//
+// with the difference that the former will only emit `GtkTreeModel`::row-inserted
+// once, while the latter will emit `GtkTreeModel`::row-inserted,
+// `GtkTreeModel`::row-changed and, if the list store is sorted,
+// `GtkTreeModel`::rows-reordered for every inserted value.
+//
+// Since emitting the `GtkTreeModel::rows-reordered` signal repeatedly can
+// affect the performance of the program, [ListStore.InsertWithValues]
+// should generally be preferred when inserting rows in a sorted list store.
+//
+// Deprecated: since 4.10. Use list models
+//
+// See [InsertWithValuesv] for the version of this function that language bindings are meant to use.
+func (x *ListStore) InsertWithValues(IterVar *TreeIter, PositionVar int, varArgs ...interface{}) {
+	listStoreInsertWithValuesVarArgs(x, IterVar, PositionVar, varArgs...)
+}
+
+var xListStoreInsertWithValuesv func(uintptr, *TreeIter, int, []int, []gobject.Value, int)
+
+// A variant of [ListStore.InsertWithValues] which
+// takes the columns and values as two arrays, instead of
+// varargs.
+//
+// This function is mainly intended for language-bindings.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) InsertWithValuesv(IterVar *TreeIter, PositionVar int, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
+
+	xListStoreInsertWithValuesv(x.GoPointer(), IterVar, PositionVar, ColumnsVar, ValuesVar, NValuesVar)
+
+}
+
+var xListStoreIterIsValid func(uintptr, *TreeIter) bool
+
+// Checks if the given iter is a valid iter for this `GtkListStore`.
+//
+// This function is slow. Only use it for debugging and/or testing
+// purposes.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) IterIsValid(IterVar *TreeIter) bool {
+
+	cret := xListStoreIterIsValid(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xListStoreMoveAfter func(uintptr, *TreeIter, *TreeIter)
+
+// Moves iter in store to the position after position. Note that this
+// function only works with unsorted stores. If position is nil, iter
+// will be moved to the start of the list.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) MoveAfter(IterVar *TreeIter, PositionVar *TreeIter) {
+
+	xListStoreMoveAfter(x.GoPointer(), IterVar, PositionVar)
+
+}
+
+var xListStoreMoveBefore func(uintptr, *TreeIter, *TreeIter)
+
+// Moves iter in store to the position before position. Note that this
+// function only works with unsorted stores. If position is nil, iter
+// will be moved to the end of the list.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) MoveBefore(IterVar *TreeIter, PositionVar *TreeIter) {
+
+	xListStoreMoveBefore(x.GoPointer(), IterVar, PositionVar)
+
+}
+
+var xListStorePrepend func(uintptr, *TreeIter)
+
+// Prepends a new row to list_store. iter will be changed to point to this new
+// row. The row will be empty after this function is called. To fill in
+// values, you need to call [ListStore.Set] or [ListStore.SetValue].
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Prepend(IterVar *TreeIter) {
+
+	xListStorePrepend(x.GoPointer(), IterVar)
+
+}
+
+var xListStoreRemove func(uintptr, *TreeIter) bool
+
+// Removes the given row from the list store.  After being removed,
+// iter is set to be the next valid row, or invalidated if it pointed
+// to the last row in list_store.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Remove(IterVar *TreeIter) bool {
+
+	cret := xListStoreRemove(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xListStoreReorder func(uintptr, []int)
+
+// Reorders store to follow the order indicated by new_order. Note that
+// this function only works with unsorted stores.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Reorder(NewOrderVar []int) {
+
+	xListStoreReorder(x.GoPointer(), NewOrderVar)
+
+}
+
+var xListStoreSet func(uintptr, *TreeIter, ...interface{})
+
+// Sets the value of one or more cells in the row referenced by iter.
+// The variable argument list should contain integer column numbers,
+// each column number followed by the value to be set.
+// The list is terminated by a -1. For example, to set column 0 with type
+// G_TYPE_STRING to “Foo”, you would write `gtk_list_store_set (store, iter,
+// 0, "Foo", -1)`.
+//
+// The value will be referenced by the store if it is a G_TYPE_OBJECT, and it
+// will be copied if it is a G_TYPE_STRING or G_TYPE_BOXED.
+//
+// Deprecated: since 4.10. Use list models
+//
+// See [SetValuesv] for the version of this function that language bindings are meant to use.
+func (x *ListStore) Set(IterVar *TreeIter, varArgs ...interface{}) {
+	listStoreSetVarArgs(x, IterVar, varArgs...)
+}
+
+var xListStoreSetColumnTypes func(uintptr, int, []types.GType)
+
+// Sets the types of the columns of a list store.
+//
+// This function is meant primarily for objects that inherit
+// from `GtkListStore`, and should only be used when constructing
+// a new instance.
+//
+// This function cannot be called after a row has been added, or
+// a method on the `GtkTreeModel` interface is called.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) SetColumnTypes(NColumnsVar int, TypesVar []types.GType) {
+
+	xListStoreSetColumnTypes(x.GoPointer(), NColumnsVar, TypesVar)
+
+}
+
+var xListStoreSetValist func(uintptr, *TreeIter, []interface{})
+
+// See [ListStore.Set]; this version takes a va_list for use by language
+// bindings.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) SetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
+
+	xListStoreSetValist(x.GoPointer(), IterVar, VarArgsVar)
+
+}
+
+var xListStoreSetValue func(uintptr, *TreeIter, int, *gobject.Value)
+
+// Sets the data in the cell specified by iter and column.
+// The type of value must be convertible to the type of the
+// column.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) SetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
+
+	xListStoreSetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
+
+}
+
+var xListStoreSetValuesv func(uintptr, *TreeIter, []int, []gobject.Value, int)
+
+// A variant of [ListStore.SetValist] which
+// takes the columns and values as two arrays, instead of
+// varargs. This function is mainly intended for
+// language-bindings and in case the number of columns to
+// change is not known until run-time.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) SetValuesv(IterVar *TreeIter, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
+
+	xListStoreSetValuesv(x.GoPointer(), IterVar, ColumnsVar, ValuesVar, NValuesVar)
+
+}
+
+var xListStoreSwap func(uintptr, *TreeIter, *TreeIter)
+
+// Swaps a and b in store. Note that this function only works with
+// unsorted stores.
+//
+// Deprecated: since 4.10. Use list models
+func (x *ListStore) Swap(AVar *TreeIter, BVar *TreeIter) {
+
+	xListStoreSwap(x.GoPointer(), AVar, BVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewListStore, libs, "gtk_list_store_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewListStorev, libs, "gtk_list_store_newv", "GTK", "")
+
+	core.PuregoRegisterSince(&xListStoreAppend, libs, "gtk_list_store_append", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreClear, libs, "gtk_list_store_clear", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreInsert, libs, "gtk_list_store_insert", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreInsertAfter, libs, "gtk_list_store_insert_after", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreInsertBefore, libs, "gtk_list_store_insert_before", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreInsertWithValues, libs, "gtk_list_store_insert_with_values", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreInsertWithValuesv, libs, "gtk_list_store_insert_with_valuesv", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreIterIsValid, libs, "gtk_list_store_iter_is_valid", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreMoveAfter, libs, "gtk_list_store_move_after", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreMoveBefore, libs, "gtk_list_store_move_before", "GTK", "")
+	core.PuregoRegisterSince(&xListStorePrepend, libs, "gtk_list_store_prepend", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreRemove, libs, "gtk_list_store_remove", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreReorder, libs, "gtk_list_store_reorder", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSet, libs, "gtk_list_store_set", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSetColumnTypes, libs, "gtk_list_store_set_column_types", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSetValist, libs, "gtk_list_store_set_valist", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSetValue, libs, "gtk_list_store_set_value", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSetValuesv, libs, "gtk_list_store_set_valuesv", "GTK", "")
+	core.PuregoRegisterSince(&xListStoreSwap, libs, "gtk_list_store_swap", "GTK", "")
+
+}
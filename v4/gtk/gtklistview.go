@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,26 +24,24 @@ func (x *ListViewClass) GoPointer() uintptr {
 // `GtkListView` uses its factory to generate one row widget for each visible
 // item and shows them in a linear display, either vertically or horizontally.
 //
-// The [property@Gtk.ListView:show-separators] property offers a simple way to
+// The [ListView.GetPropertyShowSeparators] property offers a simple way to
 // display separators between the rows.
 //
 // `GtkListView` allows the user to select items according to the selection
 // characteristics of the model. For models that allow multiple selected items,
 // it is possible to turn on _rubberband selection_, using
-// [property@Gtk.ListView:enable-rubberband].
+// [ListView.GetPropertyEnableRubberband].
 //
-// If you need multiple columns with headers, see [class@Gtk.ColumnView].
+// If you need multiple columns with headers, see [ColumnView].
 //
 // To learn more about the list widget framework, see the
-// [overview](section-list-widget.html).
+// overview.
 //
 // An example of using `GtkListView`:
-// ```c
-// static void
-// setup_listitem_cb (GtkListItemFactory *factory,
-//
-//	GtkListItem        *list_item)
 //
+//	static void
+//	setup_listitem_cb (GtkListItemFactory *factory,
+//	                   GtkListItem        *list_item)
 //	{
 //	  GtkWidget *image;
 //
@@ -53,11 +50,9 @@ func (x *ListViewClass) GoPointer() uintptr {
 //	  gtk_list_item_set_child (list_item, image);
 //	}
 //
-// static void
-// bind_listitem_cb (GtkListItemFactory *factory,
-//
-//	GtkListItem        *list_item)
-//
+//	static void
+//	bind_listitem_cb (GtkListItemFactory *factory,
+//	                  GtkListItem        *list_item)
 //	{
 //	  GtkWidget *image;
 //	  GAppInfo *app_info;
@@ -67,12 +62,10 @@ func (x *ListViewClass) GoPointer() uintptr {
 //	  gtk_image_set_from_gicon (GTK_IMAGE (image), g_app_info_get_icon (app_info));
 //	}
 //
-// static void
-// activate_cb (GtkListView  *list,
-//
-//	guint         position,
-//	gpointer      unused)
-//
+//	static void
+//	activate_cb (GtkListView  *list,
+//	             guint         position,
+//	             gpointer      unused)
 //	{
 //	  GAppInfo *app_info;
 //
@@ -81,56 +74,52 @@ func (x *ListViewClass) GoPointer() uintptr {
 //	  g_object_unref (app_info);
 //	}
 //
-// ...
+//	...
 //
-//	model = create_application_list ();
+//	  model = create_application_list ();
 //
-//	factory = gtk_signal_list_item_factory_new ();
-//	g_signal_connect (factory, "setup", G_CALLBACK (setup_listitem_cb), NULL);
-//	g_signal_connect (factory, "bind", G_CALLBACK (bind_listitem_cb), NULL);
+//	  factory = gtk_signal_list_item_factory_new ();
+//	  g_signal_connect (factory, "setup", G_CALLBACK (setup_listitem_cb), NULL);
+//	  g_signal_connect (factory, "bind", G_CALLBACK (bind_listitem_cb), NULL);
 //
-//	list = gtk_list_view_new (GTK_SELECTION_MODEL (gtk_single_selection_new (model)), factory);
+//	  list = gtk_list_view_new (GTK_SELECTION_MODEL (gtk_single_selection_new (model)), factory);
 //
-//	g_signal_connect (list, "activate", G_CALLBACK (activate_cb), NULL);
+//	  g_signal_connect (list, "activate", G_CALLBACK (activate_cb), NULL);
 //
-//	gtk_scrolled_window_set_child (GTK_SCROLLED_WINDOW (sw), list);
-//
-// ```
+//	  gtk_scrolled_window_set_child (GTK_SCROLLED_WINDOW (sw), list);
 //
 // # Actions
 //
 // `GtkListView` defines a set of built-in actions:
 //
 //   - `list.activate-item` activates the item at given position by emitting
-//     the [signal@Gtk.ListView::activate] signal.
+//     the [ListView.ConnectActivateFunc] signal.
 //
 // # CSS nodes
 //
-// ```
-// listview[.separators][.rich-list][.navigation-sidebar][.data-table]
-// ├── row[.activatable]
-// │
-// ├── row[.activatable]
-// │
-// ┊
-// ╰── [rubberband]
-// ```
+//	listview[.separators][.rich-list][.navigation-sidebar][.data-table]
+//	├── row[.activatable]
+//	│
+//	├── row[.activatable]
+//	│
+//	┊
+//	╰── [rubberband]
 //
 // `GtkListView` uses a single CSS node named `listview`. It may carry the
-// `.separators` style class, when [property@Gtk.ListView:show-separators]
+// `.separators` style class, when [ListView.GetPropertyShowSeparators]
 // property is set. Each child widget uses a single CSS node named `row`.
-// If the [property@Gtk.ListItem:activatable] property is set, the
+// If the [ListItem.GetPropertyActivatable] property is set, the
 // corresponding row will have the `.activatable` style class. For
 // rubberband selection, a node with name `rubberband` is used.
 //
 // The main listview node may also carry style classes to select
-// the style of [list presentation](section-list-widget.html#list-styles):
+// the style of list presentation:
 // .rich-list, .navigation-sidebar or .data-table.
 //
 // # Accessibility
 //
-// `GtkListView` uses the [enum@Gtk.AccessibleRole.list] role, and the list
-// items use the [enum@Gtk.AccessibleRole.list_item] role.
+// `GtkListView` uses the [AccessibleRoleListValue] role, and the list
+// items use the [AccessibleRoleListItemValue] role.
 type ListView struct {
 	ListBase
 }
@@ -149,17 +138,14 @@ func ListViewNewFromInternalPtr(ptr uintptr) *ListView {
 
 var xNewListView func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkListView` that uses the given @factory for
+// Creates a new `GtkListView` that uses the given factory for
 // mapping items to widgets.
 //
 // The function takes ownership of the
 // arguments, so you can write code like
-// ```c
-// list_view = gtk_list_view_new (create_model (),
-//
-//	gtk_builder_list_item_factory_new_from_resource ("/resource.ui"));
 //
-// ```
+//	list_view = gtk_list_view_new (create_model (),
+//	  gtk_builder_list_item_factory_new_from_resource ("/resource.ui"));
 func NewListView(ModelVar SelectionModel, FactoryVar *ListItemFactory) *ListView {
 	var cls *ListView
 
@@ -256,23 +242,23 @@ func (x *ListView) GetSingleClickActivate() bool {
 
 var xListViewGetTabBehavior func(uintptr) ListTabBehavior
 
-// Gets the behavior set for the &lt;kbd&gt;Tab&lt;/kbd&gt; key.
+// Gets the behavior set for the Tab key.
 func (x *ListView) GetTabBehavior() ListTabBehavior {
 
 	cret := xListViewGetTabBehavior(x.GoPointer())
 	return cret
 }
 
-var xListViewScrollTo func(uintptr, uint, ListScrollFlags, *ScrollInfo)
+var xListViewScrollTo func(uintptr, uint, ListScrollFlags, uintptr)
 
 // Scrolls to the item at the given position and performs the actions
-// specified in @flags.
+// specified in flags.
 //
 // This function works no matter if the listview is shown or focused.
 // If it isn't, then the changes will take effect once that happens.
 func (x *ListView) ScrollTo(PosVar uint, FlagsVar ListScrollFlags, ScrollVar *ScrollInfo) {
 
-	xListViewScrollTo(x.GoPointer(), PosVar, FlagsVar, ScrollVar)
+	xListViewScrollTo(x.GoPointer(), PosVar, FlagsVar, gobject.BoxedCopyNullable(ScrollInfoGLibType(), ScrollVar.GoPointer()))
 
 }
 
@@ -297,7 +283,7 @@ func (x *ListView) SetFactory(FactoryVar *ListItemFactory) {
 var xListViewSetHeaderFactory func(uintptr, uintptr)
 
 // Sets the `GtkListItemFactory` to use for populating the
-// [class@Gtk.ListHeader] objects used in section headers.
+// [ListHeader] objects used in section headers.
 //
 // If this factory is set to `NULL`, the list will not show
 // section headers.
@@ -311,7 +297,7 @@ var xListViewSetModel func(uintptr, uintptr)
 
 // Sets the model to use.
 //
-// This must be a [iface@Gtk.SelectionModel] to use.
+// This must be a [SelectionModel] to use.
 func (x *ListView) SetModel(ModelVar SelectionModel) {
 
 	xListViewSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -340,10 +326,10 @@ func (x *ListView) SetSingleClickActivate(SingleClickActivateVar bool) {
 
 var xListViewSetTabBehavior func(uintptr, ListTabBehavior)
 
-// Sets the &lt;kbd&gt;Tab&lt;/kbd&gt; key behavior.
+// Sets the Tab key behavior.
 //
-// This influences how the &lt;kbd&gt;Tab&lt;/kbd&gt; and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; keys move the
+// This influences how the Tab and
+// Shift+Tab keys move the
 // focus in the listview.
 func (x *ListView) SetTabBehavior(TabBehaviorVar ListTabBehavior) {
 
@@ -419,29 +405,51 @@ func (x *ListView) GetPropertySingleClickActivate() bool {
 // the `GtkListView`.
 //
 // This allows for a convenient way to handle activation in a listview.
-// See [method@Gtk.ListItem.set_activatable] for details on how to use
+// See [ListItem.SetActivatable] for details on how to use
 // this signal.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *ListView) ConnectActivate(cb *func(ListView, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PositionVarp uint) {
+			defer core.RecoverPanic()
+			fa := ListView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PositionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PositionVarp uint) {
+var xListViewActivateTrampoline uintptr
+
+func xListViewActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PositionVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ListView, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ListView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PositionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ListView) ConnectActivateFunc(cb func(ListView, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xListViewActivateTrampoline, xListViewActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -605,7 +613,7 @@ func (x *ListView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -613,14 +621,13 @@ func (x *ListView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ListView) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -644,19 +651,17 @@ func (x *ListView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ListView) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -677,7 +682,7 @@ func (x *ListView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -686,14 +691,12 @@ func (x *ListView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ListView) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -712,24 +715,24 @@ func (x *ListView) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ListView) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ListView) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ListView) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -831,7 +834,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -840,22 +843,22 @@ func init() {
 
 	core.PuregoSafeRegister(&xListViewGLibType, libs, "gtk_list_view_get_type")
 
-	core.PuregoSafeRegister(&xNewListView, libs, "gtk_list_view_new")
-
-	core.PuregoSafeRegister(&xListViewGetEnableRubberband, libs, "gtk_list_view_get_enable_rubberband")
-	core.PuregoSafeRegister(&xListViewGetFactory, libs, "gtk_list_view_get_factory")
-	core.PuregoSafeRegister(&xListViewGetHeaderFactory, libs, "gtk_list_view_get_header_factory")
-	core.PuregoSafeRegister(&xListViewGetModel, libs, "gtk_list_view_get_model")
-	core.PuregoSafeRegister(&xListViewGetShowSeparators, libs, "gtk_list_view_get_show_separators")
-	core.PuregoSafeRegister(&xListViewGetSingleClickActivate, libs, "gtk_list_view_get_single_click_activate")
-	core.PuregoSafeRegister(&xListViewGetTabBehavior, libs, "gtk_list_view_get_tab_behavior")
-	core.PuregoSafeRegister(&xListViewScrollTo, libs, "gtk_list_view_scroll_to")
-	core.PuregoSafeRegister(&xListViewSetEnableRubberband, libs, "gtk_list_view_set_enable_rubberband")
-	core.PuregoSafeRegister(&xListViewSetFactory, libs, "gtk_list_view_set_factory")
-	core.PuregoSafeRegister(&xListViewSetHeaderFactory, libs, "gtk_list_view_set_header_factory")
-	core.PuregoSafeRegister(&xListViewSetModel, libs, "gtk_list_view_set_model")
-	core.PuregoSafeRegister(&xListViewSetShowSeparators, libs, "gtk_list_view_set_show_separators")
-	core.PuregoSafeRegister(&xListViewSetSingleClickActivate, libs, "gtk_list_view_set_single_click_activate")
-	core.PuregoSafeRegister(&xListViewSetTabBehavior, libs, "gtk_list_view_set_tab_behavior")
+	core.PuregoRegisterSince(&xNewListView, libs, "gtk_list_view_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xListViewGetEnableRubberband, libs, "gtk_list_view_get_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xListViewGetFactory, libs, "gtk_list_view_get_factory", "GTK", "")
+	core.PuregoRegisterSince(&xListViewGetHeaderFactory, libs, "gtk_list_view_get_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListViewGetModel, libs, "gtk_list_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xListViewGetShowSeparators, libs, "gtk_list_view_get_show_separators", "GTK", "")
+	core.PuregoRegisterSince(&xListViewGetSingleClickActivate, libs, "gtk_list_view_get_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xListViewGetTabBehavior, libs, "gtk_list_view_get_tab_behavior", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListViewScrollTo, libs, "gtk_list_view_scroll_to", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListViewSetEnableRubberband, libs, "gtk_list_view_set_enable_rubberband", "GTK", "")
+	core.PuregoRegisterSince(&xListViewSetFactory, libs, "gtk_list_view_set_factory", "GTK", "")
+	core.PuregoRegisterSince(&xListViewSetHeaderFactory, libs, "gtk_list_view_set_header_factory", "GTK", "4.12")
+	core.PuregoRegisterSince(&xListViewSetModel, libs, "gtk_list_view_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xListViewSetShowSeparators, libs, "gtk_list_view_set_show_separators", "GTK", "")
+	core.PuregoRegisterSince(&xListViewSetSingleClickActivate, libs, "gtk_list_view_set_single_click_activate", "GTK", "")
+	core.PuregoRegisterSince(&xListViewSetTabBehavior, libs, "gtk_list_view_set_tab_behavior", "GTK", "4.12")
 
 }
@@ -2,9 +2,7 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
-	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -13,13 +11,6 @@ import (
 // `GtkLockButton` is a widget to obtain and revoke authorizations
 // needed to operate the controls.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="lockbutton-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkLockButton" src="lockbutton.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is typically used in preference dialogs or control panels.
 //
 // The required authorization is represented by a `GPermission` object.
@@ -30,43 +21,24 @@ import (
 // If the user is not currently allowed to perform the action, but can
 // obtain the permission, the widget looks like this:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="lockbutton-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An locked GtkLockButton" src="lockbutton.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // and the user can click the button to request the permission. Depending
 // on the platform, this may pop up an authentication dialog or ask the user
 // to authenticate in some other way. Once the user has obtained the permission,
 // the widget changes to this:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="lockbutton-unlocked-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An unlocked GtkLockButton" src="lockbutton-unlocked.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // and the permission can be dropped again by clicking the button. If the user
 // is not able to obtain the permission at all, the widget looks like this:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="lockbutton-sorry-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An unobtainable GtkLockButton" src="lockbutton-sorry.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // If the user has the permission and cannot drop it, the button is hidden.
 //
 // The text (and tooltips) that are shown in the various cases can be adjusted
-// with the [property@Gtk.LockButton:text-lock],
-// [property@Gtk.LockButton:text-unlock],
-// [property@Gtk.LockButton:tooltip-lock],
-// [property@Gtk.LockButton:tooltip-unlock] and
-// [property@Gtk.LockButton:tooltip-not-authorized] properties.
+// with the [LockButton.GetPropertyTextLock],
+// [LockButton.GetPropertyTextUnlock],
+// [LockButton.GetPropertyTooltipLock],
+// [LockButton.GetPropertyTooltipUnlock] and
+// [LockButton.GetPropertyTooltipNotAuthorized] properties.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 type LockButton struct {
 	Button
 }
@@ -83,49 +55,6 @@ func LockButtonNewFromInternalPtr(ptr uintptr) *LockButton {
 	return cls
 }
 
-var xNewLockButton func(uintptr) uintptr
-
-// Creates a new lock button which reflects the @permission.
-func NewLockButton(PermissionVar *gio.Permission) *LockButton {
-	var cls *LockButton
-
-	cret := xNewLockButton(PermissionVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &LockButton{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLockButtonGetPermission func(uintptr) uintptr
-
-// Obtains the `GPermission` object that controls @button.
-func (x *LockButton) GetPermission() *gio.Permission {
-	var cls *gio.Permission
-
-	cret := xLockButtonGetPermission(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &gio.Permission{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xLockButtonSetPermission func(uintptr, uintptr)
-
-// Sets the `GPermission` object that controls @button.
-func (x *LockButton) SetPermission(PermissionVar *gio.Permission) {
-
-	xLockButtonSetPermission(x.GoPointer(), PermissionVar.GoPointer())
-
-}
-
 func (c *LockButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -139,6 +68,8 @@ func (c *LockButton) SetGoPointer(ptr uintptr) {
 
 // SetPropertyTextLock sets the "text-lock" property.
 // The text to display when prompting the user to lock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) SetPropertyTextLock(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -148,6 +79,8 @@ func (x *LockButton) SetPropertyTextLock(value string) {
 
 // GetPropertyTextLock gets the "text-lock" property.
 // The text to display when prompting the user to lock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) GetPropertyTextLock() string {
 	var v gobject.Value
 	x.GetProperty("text-lock", &v)
@@ -156,6 +89,8 @@ func (x *LockButton) GetPropertyTextLock() string {
 
 // SetPropertyTextUnlock sets the "text-unlock" property.
 // The text to display when prompting the user to unlock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) SetPropertyTextUnlock(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -165,6 +100,8 @@ func (x *LockButton) SetPropertyTextUnlock(value string) {
 
 // GetPropertyTextUnlock gets the "text-unlock" property.
 // The text to display when prompting the user to unlock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) GetPropertyTextUnlock() string {
 	var v gobject.Value
 	x.GetProperty("text-unlock", &v)
@@ -173,6 +110,8 @@ func (x *LockButton) GetPropertyTextUnlock() string {
 
 // SetPropertyTooltipLock sets the "tooltip-lock" property.
 // The tooltip to display when prompting the user to lock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) SetPropertyTooltipLock(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -182,6 +121,8 @@ func (x *LockButton) SetPropertyTooltipLock(value string) {
 
 // GetPropertyTooltipLock gets the "tooltip-lock" property.
 // The tooltip to display when prompting the user to lock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) GetPropertyTooltipLock() string {
 	var v gobject.Value
 	x.GetProperty("tooltip-lock", &v)
@@ -190,6 +131,8 @@ func (x *LockButton) GetPropertyTooltipLock() string {
 
 // SetPropertyTooltipNotAuthorized sets the "tooltip-not-authorized" property.
 // The tooltip to display when the user cannot obtain authorization.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) SetPropertyTooltipNotAuthorized(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -199,6 +142,8 @@ func (x *LockButton) SetPropertyTooltipNotAuthorized(value string) {
 
 // GetPropertyTooltipNotAuthorized gets the "tooltip-not-authorized" property.
 // The tooltip to display when the user cannot obtain authorization.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) GetPropertyTooltipNotAuthorized() string {
 	var v gobject.Value
 	x.GetProperty("tooltip-not-authorized", &v)
@@ -207,6 +152,8 @@ func (x *LockButton) GetPropertyTooltipNotAuthorized() string {
 
 // SetPropertyTooltipUnlock sets the "tooltip-unlock" property.
 // The tooltip to display when prompting the user to unlock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) SetPropertyTooltipUnlock(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -216,6 +163,8 @@ func (x *LockButton) SetPropertyTooltipUnlock(value string) {
 
 // GetPropertyTooltipUnlock gets the "tooltip-unlock" property.
 // The tooltip to display when prompting the user to unlock.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *LockButton) GetPropertyTooltipUnlock() string {
 	var v gobject.Value
 	x.GetProperty("tooltip-unlock", &v)
@@ -383,7 +332,7 @@ func (x *LockButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -391,14 +340,13 @@ func (x *LockButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *LockButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -422,19 +370,17 @@ func (x *LockButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *LockButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -455,7 +401,7 @@ func (x *LockButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -464,14 +410,12 @@ func (x *LockButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *LockButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -490,14 +434,14 @@ func (x *LockButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *LockButton) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *LockButton) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -507,15 +451,15 @@ func (x *LockButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *LockButton) SetActionName(ActionNameVar *string) {
 
@@ -528,13 +472,13 @@ func (x *LockButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *LockButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -543,14 +487,14 @@ func (x *LockButton) SetActionTarget(FormatStringVar string, varArgs ...interfac
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -568,41 +512,20 @@ func (x *LockButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *LockButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *LockButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xLockButtonGLibType, libs, "gtk_lock_button_get_type")
-
-	core.PuregoSafeRegister(&xNewLockButton, libs, "gtk_lock_button_new")
-
-	core.PuregoSafeRegister(&xLockButtonGetPermission, libs, "gtk_lock_button_get_permission")
-	core.PuregoSafeRegister(&xLockButtonSetPermission, libs, "gtk_lock_button_set_permission")
-
-}
@@ -0,0 +1,78 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewLockButton func(uintptr) uintptr
+
+// Creates a new lock button which reflects the permission.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewLockButton(PermissionVar *gio.Permission) *LockButton {
+	var cls *LockButton
+
+	cret := xNewLockButton(PermissionVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &LockButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLockButtonGetPermission func(uintptr) uintptr
+
+// Obtains the `GPermission` object that controls button.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *LockButton) GetPermission() *gio.Permission {
+	var cls *gio.Permission
+
+	cret := xLockButtonGetPermission(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gio.Permission{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xLockButtonSetPermission func(uintptr, uintptr)
+
+// Sets the `GPermission` object that controls button.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *LockButton) SetPermission(PermissionVar *gio.Permission) {
+
+	xLockButtonSetPermission(x.GoPointer(), PermissionVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewLockButton, libs, "gtk_lock_button_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xLockButtonGetPermission, libs, "gtk_lock_button_get_permission", "GTK", "")
+	core.PuregoRegisterSince(&xLockButtonSetPermission, libs, "gtk_lock_button_set_permission", "GTK", "")
+
+}
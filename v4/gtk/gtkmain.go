@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/pango"
 )
@@ -11,7 +10,7 @@ const (
 	// Use this priority for functionality related to size allocation.
 	//
 	// It is used internally by GTK+ to compute the sizes of widgets.
-	// This priority is higher than %GDK_PRIORITY_REDRAW to avoid
+	// This priority is higher than [gdk.PRIORITY_REDRAW] to avoid
 	// resizing a widget which was just redrawn.
 	PRIORITY_RESIZE int = 110
 )
@@ -31,7 +30,7 @@ func DisablePortals() {
 
 var xDisableSetlocale func()
 
-// Prevents [func@Gtk.init] and [func@Gtk.init_check] from calling `setlocale()`.
+// Prevents [Init] and [InitCheck] from calling `setlocale()`.
 //
 // You would want to use this function if you wanted to set the locale for
 // your program to something other than the user’s locale, or if you wanted
@@ -56,7 +55,7 @@ var xGetDefaultLanguage func() *pango.Language
 // locale. It determines, for example, whether GTK uses
 // the right-to-left or left-to-right text direction.
 //
-// This function is equivalent to [func@Pango.Language.get_default].
+// This function is equivalent to [pango.LanguageGetDefault].
 // See that function for details.
 func GetDefaultLanguage() *pango.Language {
 
@@ -75,25 +74,21 @@ var xGetLocaleDirection func() TextDirection
 // direction otherwise. `GTK_TEXT_DIR_NONE` will never be returned.
 //
 // GTK sets the default text direction according to the locale during
-// [func@Gtk.init], and you should normally use [method@Gtk.Widget.get_direction]
-// or [func@Gtk.Widget.get_default_direction] to obtain the current direction.
+// [Init], and you should normally use [Widget.GetDirection]
+// or [WidgetGetDefaultDirection] to obtain the current direction.
 //
 // This function is only needed rare cases when the locale is
 // changed after GTK has already been initialized. In this case,
 // you can use it to update the default text direction as follows:
 //
-// ```c
-// #include &lt;locale.h&gt;
-//
-// static void
-// update_locale (const char *new_locale)
+//	#include <locale.h>
 //
+//	static void
+//	update_locale (const char *new_locale)
 //	{
 //	  setlocale (LC_ALL, new_locale);
 //	  gtk_widget_set_default_direction (gtk_get_locale_direction ());
 //	}
-//
-// ```
 func GetLocaleDirection() TextDirection {
 
 	cret := xGetLocaleDirection()
@@ -108,21 +103,21 @@ var xInit func()
 // in your GUI applications.
 //
 // It will initialize everything needed to operate the toolkit. In particular,
-// it will open the default display (see [func@Gdk.Display.get_default]).
+// it will open the default display (see [gdk.DisplayGetDefault]).
 //
-// If you are using [class@Gtk.Application], you usually don't have to call this
-// function; the [vfunc@Gio.Application.startup] handler does it for you. Though,
+// If you are using [Application], you usually don't have to call this
+// function; the Application.startup handler does it for you. Though,
 // if you are using `GApplication` methods that will be invoked before `startup`,
 // such as `local_command_line`, you may need to initialize GTK explicitly.
 //
 // This function will terminate your program if it was unable to initialize
 // the windowing system for some reason. If you want your program to fall back
-// to a textual interface, call [func@Gtk.init_check] instead.
+// to a textual interface, call [InitCheck] instead.
 //
 // GTK calls `signal (SIGPIPE, SIG_IGN)` during initialization, to ignore
 // SIGPIPE signals, since these are almost never wanted in graphical
 // applications. If you do need to handle SIGPIPE for some reason, reset
-// the handler after gtk_init(), but notice that other libraries (e.g.
+// the handler after [Init], but notice that other libraries (e.g.
 // libdbus or gvfs) might do similar things.
 func Init() {
 
@@ -134,7 +129,7 @@ var xInitCheck func() bool
 
 // Initializes GTK.
 //
-// This function does the same work as [func@Gtk.init] with only a
+// This function does the same work as [Init] with only a
 // single change: It does not terminate the program if the windowing
 // system can’t be initialized. Instead it returns false on failure.
 //
@@ -151,7 +146,7 @@ var xIsInitialized func() bool
 
 // Returns whether GTK has been initialized.
 //
-// See [func@Gtk.init].
+// See [Init].
 func IsInitialized() bool {
 
 	cret := xIsInitialized()
@@ -163,19 +158,19 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDisablePortals, libs, "gtk_disable_portals")
-	core.PuregoSafeRegister(&xDisableSetlocale, libs, "gtk_disable_setlocale")
-	core.PuregoSafeRegister(&xGetDefaultLanguage, libs, "gtk_get_default_language")
-	core.PuregoSafeRegister(&xGetLocaleDirection, libs, "gtk_get_locale_direction")
-	core.PuregoSafeRegister(&xInit, libs, "gtk_init")
-	core.PuregoSafeRegister(&xInitCheck, libs, "gtk_init_check")
-	core.PuregoSafeRegister(&xIsInitialized, libs, "gtk_is_initialized")
+	core.PuregoRegisterSince(&xDisablePortals, libs, "gtk_disable_portals", "GTK", "4.18")
+	core.PuregoRegisterSince(&xDisableSetlocale, libs, "gtk_disable_setlocale", "GTK", "")
+	core.PuregoRegisterSince(&xGetDefaultLanguage, libs, "gtk_get_default_language", "GTK", "")
+	core.PuregoRegisterSince(&xGetLocaleDirection, libs, "gtk_get_locale_direction", "GTK", "")
+	core.PuregoRegisterSince(&xInit, libs, "gtk_init", "GTK", "")
+	core.PuregoRegisterSince(&xInitCheck, libs, "gtk_init_check", "GTK", "")
+	core.PuregoRegisterSince(&xIsInitialized, libs, "gtk_is_initialized", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -13,7 +12,7 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// User function that is called to map an @item of the original model to
+// User function that is called to map an item of the original model to
 // an item expected by the map model.
 //
 // The returned items must conform to the item type of the model they are
@@ -32,33 +31,27 @@ func (x *MapListModelClass) GoPointer() uintptr {
 
 // A list model that maps the items in another model to different items.
 //
-// `GtkMapListModel` uses a [callback@Gtk.MapListModelMapFunc].
+// `GtkMapListModel` uses a [MapListModelMapFunc].
 //
 // Example: Create a list of `GtkEventControllers`
-// ```c
-// static gpointer
-// map_to_controllers (gpointer widget,
-//
-//	gpointer data)
 //
+//	static gpointer
+//	map_to_controllers (gpointer widget,
+//	                    gpointer data)
 //	{
 //	  gpointer result = gtk_widget_observe_controllers (widget);
 //	  g_object_unref (widget);
 //	  return result;
 //	}
 //
-// widgets = gtk_widget_observe_children (widget);
-//
-// controllers = gtk_map_list_model_new (widgets,
-//
-//	map_to_controllers,
-//	NULL, NULL);
+//	widgets = gtk_widget_observe_children (widget);
 //
-// model = gtk_flatten_list_model_new (GTK_TYPE_EVENT_CONTROLLER,
+//	controllers = gtk_map_list_model_new (widgets,
+//	                                      map_to_controllers,
+//	                                      NULL, NULL);
 //
-//	controllers);
-//
-// ```
+//	model = gtk_flatten_list_model_new (GTK_TYPE_EVENT_CONTROLLER,
+//	                                    controllers);
 //
 // `GtkMapListModel` will attempt to discard the mapped objects as soon as
 // they are no longer needed and recreate them if necessary.
@@ -93,11 +86,12 @@ func NewMapListModel(ModelVar gio.ListModel, MapFuncVar *MapListModelMapFunc, Us
 			MapFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *MapFuncVar
 				return cbFn(arg0, arg1)
 			}
-			MapFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MapFuncVarPtr, MapFuncVarRef, MapFuncVar)
+			MapFuncVarRef = core.NewCallback(fcb)
+			MapFuncVarRef = glib.SaveCallbackWithClosure(MapFuncVarPtr, MapFuncVarRef, MapFuncVar)
 		}
 	}
 
@@ -108,11 +102,12 @@ func NewMapListModel(ModelVar gio.ListModel, MapFuncVar *MapListModelMapFunc, Us
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -128,7 +123,7 @@ func NewMapListModel(ModelVar gio.ListModel, MapFuncVar *MapListModelMapFunc, Us
 
 var xMapListModelGetModel func(uintptr) uintptr
 
-// Gets the model that is currently being mapped or %NULL if none.
+// Gets the model that is currently being mapped or nil if none.
 func (x *MapListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -145,7 +140,7 @@ func (x *MapListModel) GetModel() *gio.ListModelBase {
 
 var xMapListModelHasMap func(uintptr) bool
 
-// Checks if a map function is currently set on @self.
+// Checks if a map function is currently set on self.
 func (x *MapListModel) HasMap() bool {
 
 	cret := xMapListModelHasMap(x.GoPointer())
@@ -162,8 +157,8 @@ var xMapListModelSetMapFunc func(uintptr, uintptr, uintptr, uintptr)
 // Note that `GtkMapListModel` may call this function multiple times
 // on the same item, because it may delete items it doesn't need anymore.
 //
-// GTK makes no effort to ensure that @map_func conforms to the item type
-// of @self. It assumes that the caller knows what they are doing and the map
+// GTK makes no effort to ensure that map_func conforms to the item type
+// of self. It assumes that the caller knows what they are doing and the map
 // function returns items of the appropriate type.
 func (x *MapListModel) SetMapFunc(MapFuncVar *MapListModelMapFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) {
 
@@ -174,11 +169,12 @@ func (x *MapListModel) SetMapFunc(MapFuncVar *MapListModelMapFunc, UserDataVar u
 			MapFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *MapFuncVar
 				return cbFn(arg0, arg1)
 			}
-			MapFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(MapFuncVarPtr, MapFuncVarRef, MapFuncVar)
+			MapFuncVarRef = core.NewCallback(fcb)
+			MapFuncVarRef = glib.SaveCallbackWithClosure(MapFuncVarPtr, MapFuncVarRef, MapFuncVar)
 		}
 	}
 
@@ -189,11 +185,12 @@ func (x *MapListModel) SetMapFunc(MapFuncVar *MapListModelMapFunc, UserDataVar u
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -205,7 +202,7 @@ var xMapListModelSetModel func(uintptr, uintptr)
 
 // Sets the model to be mapped.
 //
-// GTK makes no effort to ensure that @model conforms to the item type
+// GTK makes no effort to ensure that model conforms to the item type
 // expected by the map function. It assumes that the caller knows what
 // they are doing and have set up an appropriate map function.
 func (x *MapListModel) SetModel(ModelVar gio.ListModel) {
@@ -234,35 +231,37 @@ func (x *MapListModel) GetPropertyHasMap() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *MapListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *MapListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *MapListModel) GetItemType() types.GType {
 
@@ -270,29 +269,29 @@ func (x *MapListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *MapListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *MapListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -306,20 +305,20 @@ func (x *MapListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -343,20 +342,20 @@ func (x *MapListModel) GetSection(PositionVar uint, OutStartVar *uint, OutEndVar
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *MapListModel) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -369,7 +368,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -378,11 +377,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xMapListModelGLibType, libs, "gtk_map_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewMapListModel, libs, "gtk_map_list_model_new")
+	gobject.CheckClassSize("GtkMapListModelClass", xMapListModelGLibType, unsafe.Sizeof(MapListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewMapListModel, libs, "gtk_map_list_model_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xMapListModelGetModel, libs, "gtk_map_list_model_get_model")
-	core.PuregoSafeRegister(&xMapListModelHasMap, libs, "gtk_map_list_model_has_map")
-	core.PuregoSafeRegister(&xMapListModelSetMapFunc, libs, "gtk_map_list_model_set_map_func")
-	core.PuregoSafeRegister(&xMapListModelSetModel, libs, "gtk_map_list_model_set_model")
+	core.PuregoRegisterSince(&xMapListModelGetModel, libs, "gtk_map_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xMapListModelHasMap, libs, "gtk_map_list_model_has_map", "GTK", "")
+	core.PuregoRegisterSince(&xMapListModelSetMapFunc, libs, "gtk_map_list_model_set_map_func", "GTK", "")
+	core.PuregoRegisterSince(&xMapListModelSetModel, libs, "gtk_map_list_model_set_model", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -23,14 +22,7 @@ func (x *MediaControlsClass) GoPointer() uintptr {
 
 // Shows controls for video playback.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="media-controls-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkMediaControls" src="media-controls.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// Usually, `GtkMediaControls` is used as part of [class@Gtk.Video].
+// Usually, `GtkMediaControls` is used as part of [Video].
 type MediaControls struct {
 	Widget
 }
@@ -49,7 +41,7 @@ func MediaControlsNewFromInternalPtr(ptr uintptr) *MediaControls {
 
 var xNewMediaControls func(uintptr) uintptr
 
-// Creates a new `GtkMediaControls` managing the @stream passed to it.
+// Creates a new `GtkMediaControls` managing the stream passed to it.
 func NewMediaControls(StreamVar *MediaStream) *MediaControls {
 	var cls *MediaControls
 
@@ -66,7 +58,7 @@ func NewMediaControls(StreamVar *MediaStream) *MediaControls {
 
 var xMediaControlsGetMediaStream func(uintptr) uintptr
 
-// Gets the media stream managed by @controls or %NULL if none.
+// Gets the media stream managed by controls or nil if none.
 func (x *MediaControls) GetMediaStream() *MediaStream {
 	var cls *MediaStream
 
@@ -83,7 +75,7 @@ func (x *MediaControls) GetMediaStream() *MediaStream {
 
 var xMediaControlsSetMediaStream func(uintptr, uintptr)
 
-// Sets the stream that is controlled by @controls.
+// Sets the stream that is controlled by controls.
 func (x *MediaControls) SetMediaStream(StreamVar *MediaStream) {
 
 	xMediaControlsSetMediaStream(x.GoPointer(), StreamVar.GoPointer())
@@ -262,7 +254,7 @@ func (x *MediaControls) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -270,14 +262,13 @@ func (x *MediaControls) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *MediaControls) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -301,19 +292,17 @@ func (x *MediaControls) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *MediaControls) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -334,7 +323,7 @@ func (x *MediaControls) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -343,14 +332,12 @@ func (x *MediaControls) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *MediaControls) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -369,10 +356,10 @@ func (x *MediaControls) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MediaControls) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -384,7 +371,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -393,9 +380,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xMediaControlsGLibType, libs, "gtk_media_controls_get_type")
 
-	core.PuregoSafeRegister(&xNewMediaControls, libs, "gtk_media_controls_new")
+	gobject.CheckClassSize("GtkMediaControlsClass", xMediaControlsGLibType, unsafe.Sizeof(MediaControlsClass{}))
+
+	core.PuregoRegisterSince(&xNewMediaControls, libs, "gtk_media_controls_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xMediaControlsGetMediaStream, libs, "gtk_media_controls_get_media_stream")
-	core.PuregoSafeRegister(&xMediaControlsSetMediaStream, libs, "gtk_media_controls_set_media_stream")
+	core.PuregoRegisterSince(&xMediaControlsGetMediaStream, libs, "gtk_media_controls_get_media_stream", "GTK", "")
+	core.PuregoRegisterSince(&xMediaControlsSetMediaStream, libs, "gtk_media_controls_set_media_stream", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -40,7 +39,8 @@ func (x *MediaFileClass) OverrideOpen(cb func(*MediaFile)) {
 	if cb == nil {
 		x.xOpen = 0
 	} else {
-		x.xOpen = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xOpen = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MediaFileNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -52,7 +52,7 @@ func (x *MediaFileClass) GetOpen() func(*MediaFile) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xOpen)
+	core.RegisterFunc(&rawCallback, x.xOpen)
 	return func(SelfVar *MediaFile) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -63,7 +63,8 @@ func (x *MediaFileClass) OverrideClose(cb func(*MediaFile)) {
 	if cb == nil {
 		x.xClose = 0
 	} else {
-		x.xClose = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xClose = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MediaFileNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -75,7 +76,7 @@ func (x *MediaFileClass) GetClose() func(*MediaFile) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClose)
+	core.RegisterFunc(&rawCallback, x.xClose)
 	return func(SelfVar *MediaFile) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -86,7 +87,8 @@ func (x *MediaFileClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -98,7 +100,7 @@ func (x *MediaFileClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -109,7 +111,8 @@ func (x *MediaFileClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -121,7 +124,7 @@ func (x *MediaFileClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -132,7 +135,8 @@ func (x *MediaFileClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -144,7 +148,7 @@ func (x *MediaFileClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -155,7 +159,8 @@ func (x *MediaFileClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -167,7 +172,7 @@ func (x *MediaFileClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -220,7 +225,7 @@ func NewMediaFile() *MediaFile {
 
 var xNewMediaFileForFile func(uintptr) uintptr
 
-// Creates a new media file to play @file.
+// Creates a new media file to play file.
 func NewMediaFileForFile(FileVar gio.File) *MediaFile {
 	var cls *MediaFile
 
@@ -238,8 +243,8 @@ var xNewMediaFileForFilename func(string) uintptr
 
 // Creates a new media file for the given filename.
 //
-// This is a utility function that converts the given @filename
-// to a `GFile` and calls [ctor@Gtk.MediaFile.new_for_file].
+// This is a utility function that converts the given filename
+// to a `GFile` and calls [NewMediaFileForFile].
 func NewMediaFileForFilename(FilenameVar string) *MediaFile {
 	var cls *MediaFile
 
@@ -255,7 +260,7 @@ func NewMediaFileForFilename(FilenameVar string) *MediaFile {
 
 var xNewMediaFileForInputStream func(uintptr) uintptr
 
-// Creates a new media file to play @stream.
+// Creates a new media file to play stream.
 //
 // If you want the resulting media to be seekable,
 // the stream should implement the `GSeekable` interface.
@@ -276,8 +281,8 @@ var xNewMediaFileForResource func(string) uintptr
 
 // Creates a new new media file for the given resource.
 //
-// This is a utility function that converts the given @resource
-// to a `GFile` and calls [ctor@Gtk.MediaFile.new_for_file].
+// This is a utility function that converts the given resource
+// to a `GFile` and calls [NewMediaFileForFile].
 func NewMediaFileForResource(ResourcePathVar string) *MediaFile {
 	var cls *MediaFile
 
@@ -302,10 +307,10 @@ func (x *MediaFile) Clear() {
 
 var xMediaFileGetFile func(uintptr) uintptr
 
-// Returns the file that @self is currently playing from.
+// Returns the file that self is currently playing from.
 //
-// When @self is not playing or not playing from a file,
-// %NULL is returned.
+// When self is not playing or not playing from a file,
+// nil is returned.
 func (x *MediaFile) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -322,10 +327,10 @@ func (x *MediaFile) GetFile() *gio.FileBase {
 
 var xMediaFileGetInputStream func(uintptr) uintptr
 
-// Returns the stream that @self is currently playing from.
+// Returns the stream that self is currently playing from.
 //
-// When @self is not playing or not playing from a stream,
-// %NULL is returned.
+// When self is not playing or not playing from a stream,
+// nil is returned.
 func (x *MediaFile) GetInputStream() *gio.InputStream {
 	var cls *gio.InputStream
 
@@ -355,8 +360,8 @@ var xMediaFileSetFilename func(uintptr, uintptr)
 
 // Sets the `GtkMediaFile` to play the given file.
 //
-// This is a utility function that converts the given @filename
-// to a `GFile` and calls [method@Gtk.MediaFile.set_file].
+// This is a utility function that converts the given filename
+// to a `GFile` and calls [MediaFile.SetFile].
 func (x *MediaFile) SetFilename(FilenameVar *string) {
 
 	FilenameVarPtr := core.GStrdupNullable(FilenameVar)
@@ -372,7 +377,7 @@ var xMediaFileSetInputStream func(uintptr, uintptr)
 //
 // If anything is still playing, stop playing it.
 //
-// Full control about the @stream is assumed for the duration of
+// Full control about the stream is assumed for the duration of
 // playback. The stream will not be closed.
 func (x *MediaFile) SetInputStream(StreamVar *gio.InputStream) {
 
@@ -384,8 +389,8 @@ var xMediaFileSetResource func(uintptr, uintptr)
 
 // Sets the `GtkMediaFile` to play the given resource.
 //
-// This is a utility function that converts the given @resource_path
-// to a `GFile` and calls [method@Gtk.MediaFile.set_file].
+// This is a utility function that converts the given resource_path
+// to a `GFile` and calls [MediaFile.SetFile].
 func (x *MediaFile) SetResource(ResourcePathVar *string) {
 
 	ResourcePathVarPtr := core.GStrdupNullable(ResourcePathVar)
@@ -409,25 +414,27 @@ func (c *MediaFile) SetGoPointer(ptr uintptr) {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *MediaFile) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	gdk.XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *MediaFile) GetCurrentImage() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -445,29 +452,29 @@ func (x *MediaFile) GetCurrentImage() *gdk.PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [gdk.PaintableFlags] for the flags and what they mean.
 func (x *MediaFile) GetFlags() gdk.PaintableFlags {
 
 	cret := gdk.XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [gdk.Paintable.GetIntrinsicWidth] and
+// [gdk.Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *MediaFile) GetIntrinsicAspectRatio() float64 {
 
@@ -475,15 +482,15 @@ func (x *MediaFile) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *MediaFile) GetIntrinsicHeight() int {
 
@@ -491,15 +498,15 @@ func (x *MediaFile) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *MediaFile) GetIntrinsicWidth() int {
 
@@ -510,12 +517,12 @@ func (x *MediaFile) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [gdk.Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [gdk.PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *MediaFile) InvalidateContents() {
 
@@ -525,13 +532,13 @@ func (x *MediaFile) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [gdk.PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *MediaFile) InvalidateSize() {
 
@@ -539,10 +546,10 @@ func (x *MediaFile) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *MediaFile) Snapshot(SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -555,7 +562,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -564,18 +571,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xMediaFileGLibType, libs, "gtk_media_file_get_type")
 
-	core.PuregoSafeRegister(&xNewMediaFile, libs, "gtk_media_file_new")
-	core.PuregoSafeRegister(&xNewMediaFileForFile, libs, "gtk_media_file_new_for_file")
-	core.PuregoSafeRegister(&xNewMediaFileForFilename, libs, "gtk_media_file_new_for_filename")
-	core.PuregoSafeRegister(&xNewMediaFileForInputStream, libs, "gtk_media_file_new_for_input_stream")
-	core.PuregoSafeRegister(&xNewMediaFileForResource, libs, "gtk_media_file_new_for_resource")
-
-	core.PuregoSafeRegister(&xMediaFileClear, libs, "gtk_media_file_clear")
-	core.PuregoSafeRegister(&xMediaFileGetFile, libs, "gtk_media_file_get_file")
-	core.PuregoSafeRegister(&xMediaFileGetInputStream, libs, "gtk_media_file_get_input_stream")
-	core.PuregoSafeRegister(&xMediaFileSetFile, libs, "gtk_media_file_set_file")
-	core.PuregoSafeRegister(&xMediaFileSetFilename, libs, "gtk_media_file_set_filename")
-	core.PuregoSafeRegister(&xMediaFileSetInputStream, libs, "gtk_media_file_set_input_stream")
-	core.PuregoSafeRegister(&xMediaFileSetResource, libs, "gtk_media_file_set_resource")
+	gobject.CheckClassSize("GtkMediaFileClass", xMediaFileGLibType, unsafe.Sizeof(MediaFileClass{}))
+
+	core.PuregoRegisterSince(&xNewMediaFile, libs, "gtk_media_file_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewMediaFileForFile, libs, "gtk_media_file_new_for_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewMediaFileForFilename, libs, "gtk_media_file_new_for_filename", "GTK", "")
+	core.PuregoRegisterSince(&xNewMediaFileForInputStream, libs, "gtk_media_file_new_for_input_stream", "GTK", "")
+	core.PuregoRegisterSince(&xNewMediaFileForResource, libs, "gtk_media_file_new_for_resource", "GTK", "")
+
+	core.PuregoRegisterSince(&xMediaFileClear, libs, "gtk_media_file_clear", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileGetFile, libs, "gtk_media_file_get_file", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileGetInputStream, libs, "gtk_media_file_get_input_stream", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileSetFile, libs, "gtk_media_file_set_file", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileSetFilename, libs, "gtk_media_file_set_filename", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileSetInputStream, libs, "gtk_media_file_set_input_stream", "GTK", "")
+	core.PuregoRegisterSince(&xMediaFileSetResource, libs, "gtk_media_file_set_resource", "GTK", "")
 
 }
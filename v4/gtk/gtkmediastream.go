@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -56,7 +56,8 @@ func (x *MediaStreamClass) OverridePlay(cb func(*MediaStream) bool) {
 	if cb == nil {
 		x.xPlay = 0
 	} else {
-		x.xPlay = purego.NewCallback(func(SelfVarp uintptr) bool {
+		x.xPlay = core.NewCallback(func(SelfVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(MediaStreamNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -68,7 +69,7 @@ func (x *MediaStreamClass) GetPlay() func(*MediaStream) bool {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPlay)
+	core.RegisterFunc(&rawCallback, x.xPlay)
 	return func(SelfVar *MediaStream) bool {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -79,7 +80,8 @@ func (x *MediaStreamClass) OverridePause(cb func(*MediaStream)) {
 	if cb == nil {
 		x.xPause = 0
 	} else {
-		x.xPause = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xPause = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MediaStreamNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -91,7 +93,7 @@ func (x *MediaStreamClass) GetPause() func(*MediaStream) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPause)
+	core.RegisterFunc(&rawCallback, x.xPause)
 	return func(SelfVar *MediaStream) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -102,7 +104,8 @@ func (x *MediaStreamClass) OverrideSeek(cb func(*MediaStream, int64)) {
 	if cb == nil {
 		x.xSeek = 0
 	} else {
-		x.xSeek = purego.NewCallback(func(SelfVarp uintptr, TimestampVarp int64) {
+		x.xSeek = core.NewCallback(func(SelfVarp uintptr, TimestampVarp int64) {
+			defer core.RecoverPanic()
 			cb(MediaStreamNewFromInternalPtr(SelfVarp), TimestampVarp)
 		})
 	}
@@ -114,7 +117,7 @@ func (x *MediaStreamClass) GetSeek() func(*MediaStream, int64) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, TimestampVarp int64)
-	purego.RegisterFunc(&rawCallback, x.xSeek)
+	core.RegisterFunc(&rawCallback, x.xSeek)
 	return func(SelfVar *MediaStream, TimestampVar int64) {
 		rawCallback(SelfVar.GoPointer(), TimestampVar)
 	}
@@ -125,7 +128,8 @@ func (x *MediaStreamClass) OverrideUpdateAudio(cb func(*MediaStream, bool, float
 	if cb == nil {
 		x.xUpdateAudio = 0
 	} else {
-		x.xUpdateAudio = purego.NewCallback(func(SelfVarp uintptr, MutedVarp bool, VolumeVarp float64) {
+		x.xUpdateAudio = core.NewCallback(func(SelfVarp uintptr, MutedVarp bool, VolumeVarp float64) {
+			defer core.RecoverPanic()
 			cb(MediaStreamNewFromInternalPtr(SelfVarp), MutedVarp, VolumeVarp)
 		})
 	}
@@ -137,7 +141,7 @@ func (x *MediaStreamClass) GetUpdateAudio() func(*MediaStream, bool, float64) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, MutedVarp bool, VolumeVarp float64)
-	purego.RegisterFunc(&rawCallback, x.xUpdateAudio)
+	core.RegisterFunc(&rawCallback, x.xUpdateAudio)
 	return func(SelfVar *MediaStream, MutedVar bool, VolumeVar float64) {
 		rawCallback(SelfVar.GoPointer(), MutedVar, VolumeVar)
 	}
@@ -148,7 +152,8 @@ func (x *MediaStreamClass) OverrideRealize(cb func(*MediaStream, *gdk.Surface))
 	if cb == nil {
 		x.xRealize = 0
 	} else {
-		x.xRealize = purego.NewCallback(func(SelfVarp uintptr, SurfaceVarp uintptr) {
+		x.xRealize = core.NewCallback(func(SelfVarp uintptr, SurfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MediaStreamNewFromInternalPtr(SelfVarp), gdk.SurfaceNewFromInternalPtr(SurfaceVarp))
 		})
 	}
@@ -160,7 +165,7 @@ func (x *MediaStreamClass) GetRealize() func(*MediaStream, *gdk.Surface) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, SurfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRealize)
+	core.RegisterFunc(&rawCallback, x.xRealize)
 	return func(SelfVar *MediaStream, SurfaceVar *gdk.Surface) {
 		rawCallback(SelfVar.GoPointer(), SurfaceVar.GoPointer())
 	}
@@ -171,7 +176,8 @@ func (x *MediaStreamClass) OverrideUnrealize(cb func(*MediaStream, *gdk.Surface)
 	if cb == nil {
 		x.xUnrealize = 0
 	} else {
-		x.xUnrealize = purego.NewCallback(func(SelfVarp uintptr, SurfaceVarp uintptr) {
+		x.xUnrealize = core.NewCallback(func(SelfVarp uintptr, SurfaceVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(MediaStreamNewFromInternalPtr(SelfVarp), gdk.SurfaceNewFromInternalPtr(SurfaceVarp))
 		})
 	}
@@ -183,7 +189,7 @@ func (x *MediaStreamClass) GetUnrealize() func(*MediaStream, *gdk.Surface) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, SurfaceVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnrealize)
+	core.RegisterFunc(&rawCallback, x.xUnrealize)
 	return func(SelfVar *MediaStream, SurfaceVar *gdk.Surface) {
 		rawCallback(SelfVar.GoPointer(), SurfaceVar.GoPointer())
 	}
@@ -194,7 +200,8 @@ func (x *MediaStreamClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -206,7 +213,7 @@ func (x *MediaStreamClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -217,7 +224,8 @@ func (x *MediaStreamClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -229,7 +237,7 @@ func (x *MediaStreamClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -240,7 +248,8 @@ func (x *MediaStreamClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -252,7 +261,7 @@ func (x *MediaStreamClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -263,7 +272,8 @@ func (x *MediaStreamClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -275,7 +285,7 @@ func (x *MediaStreamClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -286,7 +296,8 @@ func (x *MediaStreamClass) OverrideGtkReserved5(cb func()) {
 	if cb == nil {
 		x.xGtkReserved5 = 0
 	} else {
-		x.xGtkReserved5 = purego.NewCallback(func() {
+		x.xGtkReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -298,7 +309,7 @@ func (x *MediaStreamClass) GetGtkReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved5)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -309,7 +320,8 @@ func (x *MediaStreamClass) OverrideGtkReserved6(cb func()) {
 	if cb == nil {
 		x.xGtkReserved6 = 0
 	} else {
-		x.xGtkReserved6 = purego.NewCallback(func() {
+		x.xGtkReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -321,7 +333,7 @@ func (x *MediaStreamClass) GetGtkReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved6)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -332,7 +344,8 @@ func (x *MediaStreamClass) OverrideGtkReserved7(cb func()) {
 	if cb == nil {
 		x.xGtkReserved7 = 0
 	} else {
-		x.xGtkReserved7 = purego.NewCallback(func() {
+		x.xGtkReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -344,7 +357,7 @@ func (x *MediaStreamClass) GetGtkReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved7)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -355,7 +368,8 @@ func (x *MediaStreamClass) OverrideGtkReserved8(cb func()) {
 	if cb == nil {
 		x.xGtkReserved8 = 0
 	} else {
-		x.xGtkReserved8 = purego.NewCallback(func() {
+		x.xGtkReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -367,7 +381,7 @@ func (x *MediaStreamClass) GetGtkReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved8)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -376,20 +390,20 @@ func (x *MediaStreamClass) GetGtkReserved8() func() {
 // The integration point for media playback inside GTK.
 //
 // GTK provides an implementation of the `GtkMediaStream` interface that
-// is called [class@Gtk.MediaFile].
+// is called [MediaFile].
 //
 // Apart from application-facing API for stream playback, `GtkMediaStream`
 // has a number of APIs that are only useful for implementations and should
 // not be used in applications:
-// [method@Gtk.MediaStream.prepared],
-// [method@Gtk.MediaStream.unprepared],
-// [method@Gtk.MediaStream.update],
-// [method@Gtk.MediaStream.ended],
-// [method@Gtk.MediaStream.seek_success],
-// [method@Gtk.MediaStream.seek_failed],
-// [method@Gtk.MediaStream.gerror],
-// [method@Gtk.MediaStream.error],
-// [method@Gtk.MediaStream.error_valist].
+// [MediaStream.Prepared],
+// [MediaStream.Unprepared],
+// [MediaStream.Update],
+// [MediaStream.Ended],
+// [MediaStream.SeekSuccess],
+// [MediaStream.SeekFailed],
+// [MediaStream.Gerror],
+// [MediaStream.Error],
+// [MediaStream.ErrorValist].
 type MediaStream struct {
 	gobject.Object
 }
@@ -406,37 +420,25 @@ func MediaStreamNewFromInternalPtr(ptr uintptr) *MediaStream {
 	return cls
 }
 
-var xMediaStreamEnded func(uintptr)
-
-// Pauses the media stream and marks it as ended.
-//
-// This is a hint only, calls to [method@Gtk.MediaStream.play]
-// may still happen.
-//
-// The media stream must be prepared when this function is called.
-func (x *MediaStream) Ended() {
-
-	xMediaStreamEnded(x.GoPointer())
-
-}
-
 var xMediaStreamError func(uintptr, glib.Quark, int, string, ...interface{})
 
-// Sets @self into an error state using a printf()-style format string.
+// Sets self into an error state using a printf()-style format string.
 //
-// This is a utility function that calls [method@Gtk.MediaStream.gerror].
+// This is a utility function that calls [MediaStream.Gerror].
 // See that function for details.
+//
+// FormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
 func (x *MediaStream) Error(DomainVar glib.Quark, CodeVar int, FormatVar string, varArgs ...interface{}) {
 
-	xMediaStreamError(x.GoPointer(), DomainVar, CodeVar, FormatVar, varArgs...)
+	xMediaStreamError(x.GoPointer(), DomainVar, CodeVar, "%s", fmt.Sprintf(FormatVar, varArgs...))
 
 }
 
 var xMediaStreamErrorValist func(uintptr, glib.Quark, int, string, []interface{})
 
-// Sets @self into an error state using a printf()-style format string.
+// Sets self into an error state using a printf()-style format string.
 //
-// This is a utility function that calls [method@Gtk.MediaStream.gerror].
+// This is a utility function that calls [MediaStream.Gerror].
 // See that function for details.
 func (x *MediaStream) ErrorValist(DomainVar glib.Quark, CodeVar int, FormatVar string, ArgsVar []interface{}) {
 
@@ -444,12 +446,12 @@ func (x *MediaStream) ErrorValist(DomainVar glib.Quark, CodeVar int, FormatVar s
 
 }
 
-var xMediaStreamGerror func(uintptr, *glib.Error)
+var xMediaStreamGerror func(uintptr, uintptr)
 
-// Sets @self into an error state.
+// Sets self into an error state.
 //
 // This will pause the stream (you can check for an error
-// via [method@Gtk.MediaStream.get_error] in your
+// via [MediaStream.GetError] in your
 // GtkMediaStream.pause() implementation), abort pending
 // seeks and mark the stream as prepared.
 //
@@ -457,10 +459,10 @@ var xMediaStreamGerror func(uintptr, *glib.Error)
 // will be ignored and the existing error will be retained.
 //
 // To unset an error, the stream must be reset via a call to
-// [method@Gtk.MediaStream.unprepared].
+// [MediaStream.Unprepared].
 func (x *MediaStream) Gerror(ErrorVar *glib.Error) {
 
-	xMediaStreamGerror(x.GoPointer(), ErrorVar)
+	xMediaStreamGerror(x.GoPointer(), gobject.BoxedCopy(glib.ErrorGLibType(), ErrorVar.GoPointer()))
 
 }
 
@@ -493,13 +495,13 @@ var xMediaStreamGetError func(uintptr) *glib.Error
 // implementation of the media stream.
 //
 // A media stream in an error cannot be operated on, calls
-// like [method@Gtk.MediaStream.play] or
-// [method@Gtk.MediaStream.seek] will not have any effect.
+// like [MediaStream.Play] or
+// [MediaStream.Seek] will not have any effect.
 //
 // `GtkMediaStream` itself does not provide a way to unset
 // an error, but implementations may provide options. For example,
-// a [class@Gtk.MediaFile] will unset errors when a new source is
-// set, e.g. with [method@Gtk.MediaFile.set_file].
+// a [MediaFile] will unset errors when a new source is
+// set, e.g. with [MediaFile.SetFile].
 func (x *MediaStream) GetError() *glib.Error {
 
 	cret := xMediaStreamGetError(x.GoPointer())
@@ -510,7 +512,7 @@ var xMediaStreamGetLoop func(uintptr) bool
 
 // Returns whether the stream is set to loop.
 //
-// See [method@Gtk.MediaStream.set_loop] for details.
+// See [MediaStream.SetLoop] for details.
 func (x *MediaStream) GetLoop() bool {
 
 	cret := xMediaStreamGetLoop(x.GoPointer())
@@ -521,7 +523,7 @@ var xMediaStreamGetMuted func(uintptr) bool
 
 // Returns whether the audio for the stream is muted.
 //
-// See [method@Gtk.MediaStream.set_muted] for details.
+// See [MediaStream.SetMuted] for details.
 func (x *MediaStream) GetMuted() bool {
 
 	cret := xMediaStreamGetMuted(x.GoPointer())
@@ -550,7 +552,7 @@ var xMediaStreamGetVolume func(uintptr) float64
 
 // Returns the volume of the audio for the stream.
 //
-// See [method@Gtk.MediaStream.set_volume] for details.
+// See [MediaStream.SetVolume] for details.
 func (x *MediaStream) GetVolume() float64 {
 
 	cret := xMediaStreamGetVolume(x.GoPointer())
@@ -591,11 +593,11 @@ var xMediaStreamIsSeekable func(uintptr) bool
 // Checks if a stream may be seekable.
 //
 // This is meant to be a hint. Streams may not allow seeking even if
-// this function returns %TRUE. However, if this function returns
-// %FALSE, streams are guaranteed to not be seekable and user interfaces
+// this function returns true. However, if this function returns
+// false, streams are guaranteed to not be seekable and user interfaces
 // may hide controls that allow seeking.
 //
-// It is allowed to call [method@Gtk.MediaStream.seek] on a non-seekable
+// It is allowed to call [MediaStream.Seek] on a non-seekable
 // stream, though it will not do anything.
 func (x *MediaStream) IsSeekable() bool {
 
@@ -634,31 +636,22 @@ func (x *MediaStream) Play() {
 
 }
 
-var xMediaStreamPrepared func(uintptr, bool, bool, bool, int64)
-
-// Same as gtk_media_stream_stream_prepared().
-func (x *MediaStream) Prepared(HasAudioVar bool, HasVideoVar bool, SeekableVar bool, DurationVar int64) {
-
-	xMediaStreamPrepared(x.GoPointer(), HasAudioVar, HasVideoVar, SeekableVar, DurationVar)
-
-}
-
 var xMediaStreamRealize func(uintptr, uintptr)
 
 // Called by users to attach the media stream to a `GdkSurface` they manage.
 //
-// The stream can then access the resources of @surface for its
+// The stream can then access the resources of surface for its
 // rendering purposes. In particular, media streams might want to
 // create a `GdkGLContext` or sync to the `GdkFrameClock`.
 //
 // Whoever calls this function is responsible for calling
-// [method@Gtk.MediaStream.unrealize] before either the stream
-// or @surface get destroyed.
+// [MediaStream.Unrealize] before either the stream
+// or surface get destroyed.
 //
 // Multiple calls to this function may happen from different
-// users of the video, even with the same @surface. Each of these
+// users of the video, even with the same surface. Each of these
 // calls must be followed by its own call to
-// [method@Gtk.MediaStream.unrealize].
+// [MediaStream.Unrealize].
 //
 // It is not required to call this function to make a media stream work.
 func (x *MediaStream) Realize(SurfaceVar *gdk.Surface) {
@@ -669,15 +662,15 @@ func (x *MediaStream) Realize(SurfaceVar *gdk.Surface) {
 
 var xMediaStreamSeek func(uintptr, int64)
 
-// Start a seek operation on @self to @timestamp.
+// Start a seek operation on self to timestamp.
 //
-// If @timestamp is out of range, it will be clamped.
+// If timestamp is out of range, it will be clamped.
 //
 // Seek operations may not finish instantly. While a
-// seek operation is in process, the [property@Gtk.MediaStream:seeking]
+// seek operation is in process, the [MediaStream.GetPropertySeeking]
 // property will be set.
 //
-// When calling gtk_media_stream_seek() during an
+// When calling [MediaStream.Seek] during an
 // ongoing seek operation, the new seek will override
 // any pending seek.
 func (x *MediaStream) Seek(TimestampVar int64) {
@@ -693,7 +686,7 @@ var xMediaStreamSeekFailed func(uintptr)
 // This will not cause an error on the stream and will assume that
 // playback continues as if no seek had happened.
 //
-// See [method@Gtk.MediaStream.seek_success] for the other way of
+// See [MediaStream.SeekSuccess] for the other way of
 // ending a seek.
 func (x *MediaStream) SeekFailed() {
 
@@ -708,7 +701,7 @@ var xMediaStreamSeekSuccess func(uintptr)
 // This function will unset the GtkMediaStream:ended property
 // if it was set.
 //
-// See [method@Gtk.MediaStream.seek_failed] for the other way of
+// See [MediaStream.SeekFailed] for the other way of
 // ending a seek.
 func (x *MediaStream) SeekSuccess() {
 
@@ -763,7 +756,7 @@ var xMediaStreamSetVolume func(uintptr, float64)
 //
 // This function call will work even if the stream is muted.
 //
-// The given @volume should range from 0.0 for silence to 1.0
+// The given volume should range from 0.0 for silence to 1.0
 // for as loud as possible. Values outside of this range will
 // be clamped to the nearest value.
 //
@@ -780,7 +773,7 @@ var xMediaStreamStreamEnded func(uintptr)
 
 // Pauses the media stream and marks it as ended.
 //
-// This is a hint only, calls to [method@Gtk.MediaStream.play]
+// This is a hint only, calls to [MediaStream.Play]
 // may still happen.
 //
 // The media stream must be prepared when this function is called.
@@ -797,11 +790,11 @@ var xMediaStreamStreamPrepared func(uintptr, bool, bool, bool, int64)
 //
 // Note that the arguments are hints. If the stream implementation
 // cannot determine the correct values, it is better to err on the
-// side of caution and return %TRUE. User interfaces will use those
+// side of caution and return true. User interfaces will use those
 // values to determine what controls to show.
 //
 // This function may not be called again until the stream has been
-// reset via [method@Gtk.MediaStream.stream_unprepared].
+// reset via [MediaStream.StreamUnprepared].
 func (x *MediaStream) StreamPrepared(HasAudioVar bool, HasVideoVar bool, SeekableVar bool, DurationVar int64) {
 
 	xMediaStreamStreamPrepared(x.GoPointer(), HasAudioVar, HasVideoVar, SeekableVar, DurationVar)
@@ -812,7 +805,7 @@ var xMediaStreamStreamUnprepared func(uintptr)
 
 // Resets a given media stream implementation.
 //
-// [method@Gtk.MediaStream.stream_prepared] can then be called again.
+// [MediaStream.StreamPrepared] can then be called again.
 //
 // This function will also reset any error state the stream was in.
 func (x *MediaStream) StreamUnprepared() {
@@ -821,21 +814,12 @@ func (x *MediaStream) StreamUnprepared() {
 
 }
 
-var xMediaStreamUnprepared func(uintptr)
-
-// Same as gtk_media_stream_stream_unprepared().
-func (x *MediaStream) Unprepared() {
-
-	xMediaStreamUnprepared(x.GoPointer())
-
-}
-
 var xMediaStreamUnrealize func(uintptr, uintptr)
 
-// Undoes a previous call to gtk_media_stream_realize().
+// Undoes a previous call to [MediaStream.Realize].
 //
 // This causes the stream to release all resources it had
-// allocated from @surface.
+// allocated from surface.
 func (x *MediaStream) Unrealize(SurfaceVar *gdk.Surface) {
 
 	xMediaStreamUnrealize(x.GoPointer(), SurfaceVar.GoPointer())
@@ -885,7 +869,7 @@ func (x *MediaStream) GetPropertyEnded() bool {
 }
 
 // GetPropertyError gets the "error" property.
-// %NULL for a properly working stream or the `GError`
+// nil for a properly working stream or the `GError`
 // that the stream is in.
 func (x *MediaStream) GetPropertyError() uintptr {
 	var v gobject.Value
@@ -1013,25 +997,27 @@ func (x *MediaStream) GetPropertyVolume() float64 {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *MediaStream) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	gdk.XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *MediaStream) GetCurrentImage() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -1049,29 +1035,29 @@ func (x *MediaStream) GetCurrentImage() *gdk.PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [gdk.PaintableFlags] for the flags and what they mean.
 func (x *MediaStream) GetFlags() gdk.PaintableFlags {
 
 	cret := gdk.XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [gdk.Paintable.GetIntrinsicWidth] and
+// [gdk.Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *MediaStream) GetIntrinsicAspectRatio() float64 {
 
@@ -1079,15 +1065,15 @@ func (x *MediaStream) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *MediaStream) GetIntrinsicHeight() int {
 
@@ -1095,15 +1081,15 @@ func (x *MediaStream) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *MediaStream) GetIntrinsicWidth() int {
 
@@ -1114,12 +1100,12 @@ func (x *MediaStream) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [gdk.Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [gdk.PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *MediaStream) InvalidateContents() {
 
@@ -1129,13 +1115,13 @@ func (x *MediaStream) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [gdk.PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *MediaStream) InvalidateSize() {
 
@@ -1143,10 +1129,10 @@ func (x *MediaStream) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *MediaStream) Snapshot(SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -1159,7 +1145,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1168,39 +1154,38 @@ func init() {
 
 	core.PuregoSafeRegister(&xMediaStreamGLibType, libs, "gtk_media_stream_get_type")
 
-	core.PuregoSafeRegister(&xMediaStreamEnded, libs, "gtk_media_stream_ended")
-	core.PuregoSafeRegister(&xMediaStreamError, libs, "gtk_media_stream_error")
-	core.PuregoSafeRegister(&xMediaStreamErrorValist, libs, "gtk_media_stream_error_valist")
-	core.PuregoSafeRegister(&xMediaStreamGerror, libs, "gtk_media_stream_gerror")
-	core.PuregoSafeRegister(&xMediaStreamGetDuration, libs, "gtk_media_stream_get_duration")
-	core.PuregoSafeRegister(&xMediaStreamGetEnded, libs, "gtk_media_stream_get_ended")
-	core.PuregoSafeRegister(&xMediaStreamGetError, libs, "gtk_media_stream_get_error")
-	core.PuregoSafeRegister(&xMediaStreamGetLoop, libs, "gtk_media_stream_get_loop")
-	core.PuregoSafeRegister(&xMediaStreamGetMuted, libs, "gtk_media_stream_get_muted")
-	core.PuregoSafeRegister(&xMediaStreamGetPlaying, libs, "gtk_media_stream_get_playing")
-	core.PuregoSafeRegister(&xMediaStreamGetTimestamp, libs, "gtk_media_stream_get_timestamp")
-	core.PuregoSafeRegister(&xMediaStreamGetVolume, libs, "gtk_media_stream_get_volume")
-	core.PuregoSafeRegister(&xMediaStreamHasAudio, libs, "gtk_media_stream_has_audio")
-	core.PuregoSafeRegister(&xMediaStreamHasVideo, libs, "gtk_media_stream_has_video")
-	core.PuregoSafeRegister(&xMediaStreamIsPrepared, libs, "gtk_media_stream_is_prepared")
-	core.PuregoSafeRegister(&xMediaStreamIsSeekable, libs, "gtk_media_stream_is_seekable")
-	core.PuregoSafeRegister(&xMediaStreamIsSeeking, libs, "gtk_media_stream_is_seeking")
-	core.PuregoSafeRegister(&xMediaStreamPause, libs, "gtk_media_stream_pause")
-	core.PuregoSafeRegister(&xMediaStreamPlay, libs, "gtk_media_stream_play")
-	core.PuregoSafeRegister(&xMediaStreamPrepared, libs, "gtk_media_stream_prepared")
-	core.PuregoSafeRegister(&xMediaStreamRealize, libs, "gtk_media_stream_realize")
-	core.PuregoSafeRegister(&xMediaStreamSeek, libs, "gtk_media_stream_seek")
-	core.PuregoSafeRegister(&xMediaStreamSeekFailed, libs, "gtk_media_stream_seek_failed")
-	core.PuregoSafeRegister(&xMediaStreamSeekSuccess, libs, "gtk_media_stream_seek_success")
-	core.PuregoSafeRegister(&xMediaStreamSetLoop, libs, "gtk_media_stream_set_loop")
-	core.PuregoSafeRegister(&xMediaStreamSetMuted, libs, "gtk_media_stream_set_muted")
-	core.PuregoSafeRegister(&xMediaStreamSetPlaying, libs, "gtk_media_stream_set_playing")
-	core.PuregoSafeRegister(&xMediaStreamSetVolume, libs, "gtk_media_stream_set_volume")
-	core.PuregoSafeRegister(&xMediaStreamStreamEnded, libs, "gtk_media_stream_stream_ended")
-	core.PuregoSafeRegister(&xMediaStreamStreamPrepared, libs, "gtk_media_stream_stream_prepared")
-	core.PuregoSafeRegister(&xMediaStreamStreamUnprepared, libs, "gtk_media_stream_stream_unprepared")
-	core.PuregoSafeRegister(&xMediaStreamUnprepared, libs, "gtk_media_stream_unprepared")
-	core.PuregoSafeRegister(&xMediaStreamUnrealize, libs, "gtk_media_stream_unrealize")
-	core.PuregoSafeRegister(&xMediaStreamUpdate, libs, "gtk_media_stream_update")
+	gobject.CheckClassSize("GtkMediaStreamClass", xMediaStreamGLibType, unsafe.Sizeof(MediaStreamClass{}))
+
+	core.PuregoRegisterSince(&xMediaStreamError, libs, "gtk_media_stream_error", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamErrorValist, libs, "gtk_media_stream_error_valist", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGerror, libs, "gtk_media_stream_gerror", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetDuration, libs, "gtk_media_stream_get_duration", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetEnded, libs, "gtk_media_stream_get_ended", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetError, libs, "gtk_media_stream_get_error", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetLoop, libs, "gtk_media_stream_get_loop", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetMuted, libs, "gtk_media_stream_get_muted", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetPlaying, libs, "gtk_media_stream_get_playing", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetTimestamp, libs, "gtk_media_stream_get_timestamp", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamGetVolume, libs, "gtk_media_stream_get_volume", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamHasAudio, libs, "gtk_media_stream_has_audio", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamHasVideo, libs, "gtk_media_stream_has_video", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamIsPrepared, libs, "gtk_media_stream_is_prepared", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamIsSeekable, libs, "gtk_media_stream_is_seekable", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamIsSeeking, libs, "gtk_media_stream_is_seeking", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamPause, libs, "gtk_media_stream_pause", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamPlay, libs, "gtk_media_stream_play", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamRealize, libs, "gtk_media_stream_realize", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSeek, libs, "gtk_media_stream_seek", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSeekFailed, libs, "gtk_media_stream_seek_failed", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSeekSuccess, libs, "gtk_media_stream_seek_success", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSetLoop, libs, "gtk_media_stream_set_loop", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSetMuted, libs, "gtk_media_stream_set_muted", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSetPlaying, libs, "gtk_media_stream_set_playing", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamSetVolume, libs, "gtk_media_stream_set_volume", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamStreamEnded, libs, "gtk_media_stream_stream_ended", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMediaStreamStreamPrepared, libs, "gtk_media_stream_stream_prepared", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMediaStreamStreamUnprepared, libs, "gtk_media_stream_stream_unprepared", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMediaStreamUnrealize, libs, "gtk_media_stream_unrealize", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamUpdate, libs, "gtk_media_stream_update", "GTK", "")
 
 }
@@ -0,0 +1,64 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xMediaStreamEnded func(uintptr)
+
+// Pauses the media stream and marks it as ended.
+//
+// This is a hint only, calls to [MediaStream.Play]
+// may still happen.
+//
+// The media stream must be prepared when this function is called.
+//
+// Deprecated: since 4.4. Use [MediaStream.StreamEnded] instead
+func (x *MediaStream) Ended() {
+
+	xMediaStreamEnded(x.GoPointer())
+
+}
+
+var xMediaStreamPrepared func(uintptr, bool, bool, bool, int64)
+
+// Same as [MediaStream.StreamPrepared].
+//
+// Deprecated: since 4.4. Use [MediaStream.StreamPrepared] instead.
+func (x *MediaStream) Prepared(HasAudioVar bool, HasVideoVar bool, SeekableVar bool, DurationVar int64) {
+
+	xMediaStreamPrepared(x.GoPointer(), HasAudioVar, HasVideoVar, SeekableVar, DurationVar)
+
+}
+
+var xMediaStreamUnprepared func(uintptr)
+
+// Same as [MediaStream.StreamUnprepared].
+//
+// Deprecated: since 4.4. Use [MediaStream.StreamUnprepared] instead.
+func (x *MediaStream) Unprepared() {
+
+	xMediaStreamUnprepared(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xMediaStreamEnded, libs, "gtk_media_stream_ended", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamPrepared, libs, "gtk_media_stream_prepared", "GTK", "")
+	core.PuregoRegisterSince(&xMediaStreamUnprepared, libs, "gtk_media_stream_unprepared", "GTK", "")
+
+}
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -15,36 +14,29 @@ import (
 // User-provided callback function to create a popup for a
 // `GtkMenuButton` on demand.
 //
-// This function is called when the popup of @menu_button is shown,
-// but none has been provided via [method@Gtk.MenuButton.set_popover]
-// or [method@Gtk.MenuButton.set_menu_model].
+// This function is called when the popup of menu_button is shown,
+// but none has been provided via [MenuButton.SetPopover]
+// or [MenuButton.SetMenuModel].
 type MenuButtonCreatePopupFunc func(uintptr, uintptr)
 
 // Displays a popup when clicked.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="menu-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkMenuButton" src="menu-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // This popup can be provided either as a `GtkPopover` or as an abstract
 // `GMenuModel`.
 //
 // The `GtkMenuButton` widget can show either an icon (set with the
-// [property@Gtk.MenuButton:icon-name] property) or a label (set with the
-// [property@Gtk.MenuButton:label] property). If neither is explicitly set,
-// a [class@Gtk.Image] is automatically created, using an arrow image oriented
-// according to [property@Gtk.MenuButton:direction] or the generic
+// [MenuButton.GetPropertyIconName] property) or a label (set with the
+// [MenuButton.GetPropertyLabel] property). If neither is explicitly set,
+// a [Image] is automatically created, using an arrow image oriented
+// according to MenuButton:direction or the generic
 // “open-menu-symbolic” icon if the direction is not set.
 //
 // The positioning of the popup is determined by the
-// [property@Gtk.MenuButton:direction] property of the menu button.
+// MenuButton:direction property of the menu button.
 //
-// For menus, the [property@Gtk.Widget:halign] and [property@Gtk.Widget:valign]
+// For menus, the Widget:halign and Widget:valign
 // properties of the menu are also taken into account. For example, when the
-// direction is %GTK_ARROW_DOWN and the horizontal alignment is %GTK_ALIGN_START,
+// direction is [ArrowDownValue] and the horizontal alignment is [AlignStartValue],
 // the menu will be positioned below the button, with the starting edge
 // (depending on the text direction) of the menu aligned with the starting
 // edge of the button. If there is not enough space below the button, the
@@ -53,21 +45,17 @@ type MenuButtonCreatePopupFunc func(uintptr, uintptr)
 //
 // |           | start                | center                | end                |
 // | -         | ---                  | ---                   | ---                |
-// | **down**  | ![](down-start.png)  | ![](down-center.png)  | ![](down-end.png)  |
-// | **up**    | ![](up-start.png)    | ![](up-center.png)    | ![](up-end.png)    |
-// | **left**  | ![](left-start.png)  | ![](left-center.png)  | ![](left-end.png)  |
-// | **right** | ![](right-start.png) | ![](right-center.png) | ![](right-end.png) |
+// | **down**  |   |   |   |
+// | **up**    |     |     |     |
+// | **left**  |   |   |   |
+// | **right** |  |  |  |
 //
 // # CSS nodes
 //
-// ```
-// menubutton
-// ╰── button.toggle
-//
-//	╰── &lt;content&gt;
-//	     ╰── [arrow]
-//
-// ```
+//	menubutton
+//	╰── button.toggle
+//	    ╰── <content>
+//	         ╰── [arrow]
 //
 // `GtkMenuButton` has a single CSS node with name `menubutton`
 // which contains a `button` node with a `.toggle` style class.
@@ -88,7 +76,7 @@ type MenuButtonCreatePopupFunc func(uintptr, uintptr)
 //
 // # Accessibility
 //
-// `GtkMenuButton` uses the [enum@Gtk.AccessibleRole.button] role.
+// `GtkMenuButton` uses the [AccessibleRoleButtonValue] role.
 type MenuButton struct {
 	Widget
 }
@@ -157,7 +145,7 @@ func (x *MenuButton) GetCanShrink() bool {
 
 var xMenuButtonGetChild func(uintptr) uintptr
 
-// Gets the child widget of @menu_button.
+// Gets the child widget of menu_button.
 func (x *MenuButton) GetChild() *Widget {
 	var cls *Widget
 
@@ -230,7 +218,7 @@ var xMenuButtonGetPopover func(uintptr) uintptr
 // Returns the `GtkPopover` that pops out of the button.
 //
 // If the button is not using a `GtkPopover`, this function
-// returns %NULL.
+// returns nil.
 func (x *MenuButton) GetPopover() *Popover {
 	var cls *Popover
 
@@ -306,7 +294,7 @@ var xMenuButtonSetCanShrink func(uintptr, bool)
 // Sets whether the button size can be smaller than the natural size of
 // its contents.
 //
-// For text buttons, setting @can_shrink to true will ellipsize the label.
+// For text buttons, setting can_shrink to true will ellipsize the label.
 //
 // For icon buttons, this function has no effect.
 func (x *MenuButton) SetCanShrink(CanShrinkVar bool) {
@@ -317,13 +305,13 @@ func (x *MenuButton) SetCanShrink(CanShrinkVar bool) {
 
 var xMenuButtonSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @menu_button.
+// Sets the child widget of menu_button.
 //
-// Setting a child resets [property@Gtk.MenuButton:label] and
-// [property@Gtk.MenuButton:icon-name].
+// Setting a child resets [MenuButton.GetPropertyLabel] and
+// [MenuButton.GetPropertyIconName].
 //
-// If [property@Gtk.MenuButton:always-show-arrow] is set to `TRUE` and
-// [property@Gtk.MenuButton:direction] is not `GTK_ARROW_NONE`, a dropdown arrow
+// If [MenuButton.GetPropertyAlwaysShowArrow] is set to `TRUE` and
+// MenuButton:direction is not `GTK_ARROW_NONE`, a dropdown arrow
 // will be shown next to the child.
 func (x *MenuButton) SetChild(ChildVar *Widget) {
 
@@ -333,18 +321,18 @@ func (x *MenuButton) SetChild(ChildVar *Widget) {
 
 var xMenuButtonSetCreatePopupFunc func(uintptr, uintptr, uintptr, uintptr)
 
-// Sets @func to be called when a popup is about to be shown.
+// Sets func to be called when a popup is about to be shown.
 //
-// @func should use one of
+// func should use one of
 //
-//   - [method@Gtk.MenuButton.set_popover]
-//   - [method@Gtk.MenuButton.set_menu_model]
+//   - [MenuButton.SetPopover]
+//   - [MenuButton.SetMenuModel]
 //
-// to set a popup for @menu_button.
-// If @func is non-%NULL, @menu_button will always be sensitive.
+// to set a popup for menu_button.
+// If func is non-nil, menu_button will always be sensitive.
 //
 // Using this function will not reset the menu widget attached to
-// @menu_button. Instead, this can be done manually in @func.
+// menu_button. Instead, this can be done manually in func.
 func (x *MenuButton) SetCreatePopupFunc(FuncVar *MenuButtonCreatePopupFunc, UserDataVar uintptr, DestroyNotifyVar *glib.DestroyNotify) {
 
 	var FuncVarRef uintptr
@@ -354,11 +342,12 @@ func (x *MenuButton) SetCreatePopupFunc(FuncVar *MenuButtonCreatePopupFunc, User
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -369,11 +358,12 @@ func (x *MenuButton) SetCreatePopupFunc(FuncVar *MenuButtonCreatePopupFunc, User
 			DestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyNotifyVar
 				cbFn(arg0)
 			}
-			DestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
+			DestroyNotifyVarRef = core.NewCallback(fcb)
+			DestroyNotifyVarRef = glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
 		}
 	}
 
@@ -391,8 +381,8 @@ var xMenuButtonSetDirection func(uintptr, ArrowType)
 // If the does not fit in the available space in the given direction,
 // GTK will its best to keep it inside the screen and fully visible.
 //
-// If you pass %GTK_ARROW_NONE for a @direction, the popup will behave
-// as if you passed %GTK_ARROW_DOWN (although you won’t see any arrows).
+// If you pass [ArrowNoneValue] for a direction, the popup will behave
+// as if you passed [ArrowDownValue] (although you won’t see any arrows).
 func (x *MenuButton) SetDirection(DirectionVar ArrowType) {
 
 	xMenuButtonSetDirection(x.GoPointer(), DirectionVar)
@@ -412,11 +402,11 @@ var xMenuButtonSetIconName func(uintptr, string)
 
 // Sets the name of an icon to show inside the menu button.
 //
-// Setting icon name resets [property@Gtk.MenuButton:label] and
-// [property@Gtk.MenuButton:child].
+// Setting icon name resets [MenuButton.GetPropertyLabel] and
+// MenuButton:child.
 //
-// If [property@Gtk.MenuButton:always-show-arrow] is set to `TRUE` and
-// [property@Gtk.MenuButton:direction] is not `GTK_ARROW_NONE`, a dropdown arrow
+// If [MenuButton.GetPropertyAlwaysShowArrow] is set to `TRUE` and
+// MenuButton:direction is not `GTK_ARROW_NONE`, a dropdown arrow
 // will be shown next to the icon.
 func (x *MenuButton) SetIconName(IconNameVar string) {
 
@@ -428,10 +418,10 @@ var xMenuButtonSetLabel func(uintptr, string)
 
 // Sets the label to show inside the menu button.
 //
-// Setting a label resets [property@Gtk.MenuButton:icon-name] and
-// [property@Gtk.MenuButton:child].
+// Setting a label resets [MenuButton.GetPropertyIconName] and
+// MenuButton:child.
 //
-// If [property@Gtk.MenuButton:direction] is not `GTK_ARROW_NONE`, a dropdown
+// If MenuButton:direction is not `GTK_ARROW_NONE`, a dropdown
 // arrow will be shown next to the label.
 func (x *MenuButton) SetLabel(LabelVar string) {
 
@@ -443,14 +433,14 @@ var xMenuButtonSetMenuModel func(uintptr, uintptr)
 
 // Sets the `GMenuModel` from which the popup will be constructed.
 //
-// If @menu_model is %NULL, the button is disabled.
+// If menu_model is nil, the button is disabled.
 //
-// A [class@Gtk.Popover] will be created from the menu model with
-// [ctor@Gtk.PopoverMenu.new_from_model]. Actions will be connected
+// A [Popover] will be created from the menu model with
+// [NewPopoverMenuFromModel]. Actions will be connected
 // as documented for this function.
 //
-// If [property@Gtk.MenuButton:popover] is already set, it will be
-// dissociated from the @menu_button, and the property is set to %NULL.
+// If MenuButton:popover is already set, it will be
+// dissociated from the menu_button, and the property is set to nil.
 func (x *MenuButton) SetMenuModel(MenuModelVar *gio.MenuModel) {
 
 	xMenuButtonSetMenuModel(x.GoPointer(), MenuModelVar.GoPointer())
@@ -459,12 +449,12 @@ func (x *MenuButton) SetMenuModel(MenuModelVar *gio.MenuModel) {
 
 var xMenuButtonSetPopover func(uintptr, uintptr)
 
-// Sets the `GtkPopover` that will be popped up when the @menu_button is clicked.
+// Sets the `GtkPopover` that will be popped up when the menu_button is clicked.
 //
-// If @popover is %NULL, the button is disabled.
+// If popover is nil, the button is disabled.
 //
-// If [property@Gtk.MenuButton:menu-model] is set, the menu model is dissociated
-// from the @menu_button, and the property is set to %NULL.
+// If MenuButton:menu-model is set, the menu model is dissociated
+// from the menu_button, and the property is set to nil.
 func (x *MenuButton) SetPopover(PopoverVar *Popover) {
 
 	xMenuButtonSetPopover(x.GoPointer(), PopoverVar.GoPointer())
@@ -475,7 +465,7 @@ var xMenuButtonSetPrimary func(uintptr, bool)
 
 // Sets whether menu button acts as a primary menu.
 //
-// Primary menus can be opened with the &lt;kbd&gt;F10&lt;/kbd&gt; key.
+// Primary menus can be opened with the F10 key.
 func (x *MenuButton) SetPrimary(PrimaryVar bool) {
 
 	xMenuButtonSetPrimary(x.GoPointer(), PrimaryVar)
@@ -609,7 +599,7 @@ func (x *MenuButton) GetPropertyLabel() string {
 // SetPropertyPrimary sets the "primary" property.
 // Whether the menu button acts as a primary menu.
 //
-// Primary menus can be opened using the &lt;kbd&gt;F10&lt;/kbd&gt; key
+// Primary menus can be opened using the F10 key
 func (x *MenuButton) SetPropertyPrimary(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -620,7 +610,7 @@ func (x *MenuButton) SetPropertyPrimary(value bool) {
 // GetPropertyPrimary gets the "primary" property.
 // Whether the menu button acts as a primary menu.
 //
-// Primary menus can be opened using the &lt;kbd&gt;F10&lt;/kbd&gt; key
+// Primary menus can be opened using the F10 key
 func (x *MenuButton) GetPropertyPrimary() bool {
 	var v gobject.Value
 	x.GetProperty("primary", &v)
@@ -648,27 +638,49 @@ func (x *MenuButton) GetPropertyUseUnderline() bool {
 //
 // The `::activate` signal on `GtkMenuButton` is an action signal and
 // emitting it causes the button to pop up its menu.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *MenuButton) ConnectActivate(cb *func(MenuButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := MenuButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xMenuButtonActivateTrampoline uintptr
+
+func xMenuButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(MenuButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := MenuButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *MenuButton) ConnectActivateFunc(cb func(MenuButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xMenuButtonActivateTrampoline, xMenuButtonActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -832,7 +844,7 @@ func (x *MenuButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -840,14 +852,13 @@ func (x *MenuButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *MenuButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -871,19 +882,17 @@ func (x *MenuButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *MenuButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -904,7 +913,7 @@ func (x *MenuButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -913,14 +922,12 @@ func (x *MenuButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *MenuButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -939,10 +946,10 @@ func (x *MenuButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MenuButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -954,7 +961,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -963,34 +970,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xMenuButtonGLibType, libs, "gtk_menu_button_get_type")
 
-	core.PuregoSafeRegister(&xNewMenuButton, libs, "gtk_menu_button_new")
-
-	core.PuregoSafeRegister(&xMenuButtonGetActive, libs, "gtk_menu_button_get_active")
-	core.PuregoSafeRegister(&xMenuButtonGetAlwaysShowArrow, libs, "gtk_menu_button_get_always_show_arrow")
-	core.PuregoSafeRegister(&xMenuButtonGetCanShrink, libs, "gtk_menu_button_get_can_shrink")
-	core.PuregoSafeRegister(&xMenuButtonGetChild, libs, "gtk_menu_button_get_child")
-	core.PuregoSafeRegister(&xMenuButtonGetDirection, libs, "gtk_menu_button_get_direction")
-	core.PuregoSafeRegister(&xMenuButtonGetHasFrame, libs, "gtk_menu_button_get_has_frame")
-	core.PuregoSafeRegister(&xMenuButtonGetIconName, libs, "gtk_menu_button_get_icon_name")
-	core.PuregoSafeRegister(&xMenuButtonGetLabel, libs, "gtk_menu_button_get_label")
-	core.PuregoSafeRegister(&xMenuButtonGetMenuModel, libs, "gtk_menu_button_get_menu_model")
-	core.PuregoSafeRegister(&xMenuButtonGetPopover, libs, "gtk_menu_button_get_popover")
-	core.PuregoSafeRegister(&xMenuButtonGetPrimary, libs, "gtk_menu_button_get_primary")
-	core.PuregoSafeRegister(&xMenuButtonGetUseUnderline, libs, "gtk_menu_button_get_use_underline")
-	core.PuregoSafeRegister(&xMenuButtonPopdown, libs, "gtk_menu_button_popdown")
-	core.PuregoSafeRegister(&xMenuButtonPopup, libs, "gtk_menu_button_popup")
-	core.PuregoSafeRegister(&xMenuButtonSetActive, libs, "gtk_menu_button_set_active")
-	core.PuregoSafeRegister(&xMenuButtonSetAlwaysShowArrow, libs, "gtk_menu_button_set_always_show_arrow")
-	core.PuregoSafeRegister(&xMenuButtonSetCanShrink, libs, "gtk_menu_button_set_can_shrink")
-	core.PuregoSafeRegister(&xMenuButtonSetChild, libs, "gtk_menu_button_set_child")
-	core.PuregoSafeRegister(&xMenuButtonSetCreatePopupFunc, libs, "gtk_menu_button_set_create_popup_func")
-	core.PuregoSafeRegister(&xMenuButtonSetDirection, libs, "gtk_menu_button_set_direction")
-	core.PuregoSafeRegister(&xMenuButtonSetHasFrame, libs, "gtk_menu_button_set_has_frame")
-	core.PuregoSafeRegister(&xMenuButtonSetIconName, libs, "gtk_menu_button_set_icon_name")
-	core.PuregoSafeRegister(&xMenuButtonSetLabel, libs, "gtk_menu_button_set_label")
-	core.PuregoSafeRegister(&xMenuButtonSetMenuModel, libs, "gtk_menu_button_set_menu_model")
-	core.PuregoSafeRegister(&xMenuButtonSetPopover, libs, "gtk_menu_button_set_popover")
-	core.PuregoSafeRegister(&xMenuButtonSetPrimary, libs, "gtk_menu_button_set_primary")
-	core.PuregoSafeRegister(&xMenuButtonSetUseUnderline, libs, "gtk_menu_button_set_use_underline")
+	core.PuregoRegisterSince(&xNewMenuButton, libs, "gtk_menu_button_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xMenuButtonGetActive, libs, "gtk_menu_button_get_active", "GTK", "4.10")
+	core.PuregoRegisterSince(&xMenuButtonGetAlwaysShowArrow, libs, "gtk_menu_button_get_always_show_arrow", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMenuButtonGetCanShrink, libs, "gtk_menu_button_get_can_shrink", "GTK", "4.12")
+	core.PuregoRegisterSince(&xMenuButtonGetChild, libs, "gtk_menu_button_get_child", "GTK", "4.6")
+	core.PuregoRegisterSince(&xMenuButtonGetDirection, libs, "gtk_menu_button_get_direction", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetHasFrame, libs, "gtk_menu_button_get_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetIconName, libs, "gtk_menu_button_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetLabel, libs, "gtk_menu_button_get_label", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetMenuModel, libs, "gtk_menu_button_get_menu_model", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetPopover, libs, "gtk_menu_button_get_popover", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonGetPrimary, libs, "gtk_menu_button_get_primary", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMenuButtonGetUseUnderline, libs, "gtk_menu_button_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonPopdown, libs, "gtk_menu_button_popdown", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonPopup, libs, "gtk_menu_button_popup", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetActive, libs, "gtk_menu_button_set_active", "GTK", "4.10")
+	core.PuregoRegisterSince(&xMenuButtonSetAlwaysShowArrow, libs, "gtk_menu_button_set_always_show_arrow", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMenuButtonSetCanShrink, libs, "gtk_menu_button_set_can_shrink", "GTK", "4.12")
+	core.PuregoRegisterSince(&xMenuButtonSetChild, libs, "gtk_menu_button_set_child", "GTK", "4.6")
+	core.PuregoRegisterSince(&xMenuButtonSetCreatePopupFunc, libs, "gtk_menu_button_set_create_popup_func", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetDirection, libs, "gtk_menu_button_set_direction", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetHasFrame, libs, "gtk_menu_button_set_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetIconName, libs, "gtk_menu_button_set_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetLabel, libs, "gtk_menu_button_set_label", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetMenuModel, libs, "gtk_menu_button_set_menu_model", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetPopover, libs, "gtk_menu_button_set_popover", "GTK", "")
+	core.PuregoRegisterSince(&xMenuButtonSetPrimary, libs, "gtk_menu_button_set_primary", "GTK", "4.4")
+	core.PuregoRegisterSince(&xMenuButtonSetUseUnderline, libs, "gtk_menu_button_set_use_underline", "GTK", "")
 
 }
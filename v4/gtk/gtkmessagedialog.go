@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,11 +24,13 @@ func (x *MessageDialogClass) GoPointer() uintptr {
 // Prebuilt sets of buttons for `GtkDialog`.
 //
 // If none of these choices are appropriate, simply use
-// %GTK_BUTTONS_NONE and call [method@Gtk.Dialog.add_buttons].
+// [ButtonsNoneValue] and call [Dialog.AddButtons].
 //
-// &gt; Please note that %GTK_BUTTONS_OK, %GTK_BUTTONS_YES_NO
-// &gt; and %GTK_BUTTONS_OK_CANCEL are discouraged by the
-// &gt; [GNOME Human Interface Guidelines](https://developer.gnome.org/hig/).
+// > Please note that [ButtonsOkValue], [ButtonsYesNoValue]
+// > and [ButtonsOkCancelValue] are discouraged by the
+// > [GNOME Human Interface Guidelines].
+//
+// [GNOME Human Interface Guidelines]: https://developer.gnome.org/hig/
 type ButtonsType int
 
 var xButtonsTypeGLibType func() types.GType
@@ -53,74 +55,116 @@ const (
 	ButtonsOkCancelValue ButtonsType = 5
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ButtonsType) String() string {
+	switch x {
+	case ButtonsNoneValue:
+		return "GTK_BUTTONS_NONE"
+	case ButtonsOkValue:
+		return "GTK_BUTTONS_OK"
+	case ButtonsCloseValue:
+		return "GTK_BUTTONS_CLOSE"
+	case ButtonsCancelValue:
+		return "GTK_BUTTONS_CANCEL"
+	case ButtonsYesNoValue:
+		return "GTK_BUTTONS_YES_NO"
+	case ButtonsOkCancelValue:
+		return "GTK_BUTTONS_OK_CANCEL"
+	}
+	return fmt.Sprintf("ButtonsType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ButtonsType) Nick() string {
+	switch x {
+	case ButtonsNoneValue:
+		return "none"
+	case ButtonsOkValue:
+		return "ok"
+	case ButtonsCloseValue:
+		return "close"
+	case ButtonsCancelValue:
+		return "cancel"
+	case ButtonsYesNoValue:
+		return "yes-no"
+	case ButtonsOkCancelValue:
+		return "ok-cancel"
+	}
+	return ""
+}
+
+// ButtonsTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ButtonsTypeFromString(s string) (ButtonsType, bool) {
+	switch s {
+	case "GTK_BUTTONS_NONE", "none":
+		return ButtonsNoneValue, true
+	case "GTK_BUTTONS_OK", "ok":
+		return ButtonsOkValue, true
+	case "GTK_BUTTONS_CLOSE", "close":
+		return ButtonsCloseValue, true
+	case "GTK_BUTTONS_CANCEL", "cancel":
+		return ButtonsCancelValue, true
+	case "GTK_BUTTONS_YES_NO", "yes-no":
+		return ButtonsYesNoValue, true
+	case "GTK_BUTTONS_OK_CANCEL", "ok-cancel":
+		return ButtonsOkCancelValue, true
+	}
+	return 0, false
+}
+
 // `GtkMessageDialog` presents a dialog with some message text.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="messagedialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkMessageDialog" src="messagedialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It’s simply a convenience widget; you could construct the equivalent of
 // `GtkMessageDialog` from `GtkDialog` without too much effort, but
 // `GtkMessageDialog` saves typing.
 //
-// The easiest way to do a modal message dialog is to use the %GTK_DIALOG_MODAL
-// flag, which will call [method@Gtk.Window.set_modal] internally. The dialog will
+// The easiest way to do a modal message dialog is to use the [DialogModalValue]
+// flag, which will call [Window.SetModal] internally. The dialog will
 // prevent interaction with the parent window until it's hidden or destroyed.
-// You can use the [signal@Gtk.Dialog::response] signal to know when the user
+// You can use the [Dialog.ConnectResponseFunc] signal to know when the user
 // dismissed the dialog.
 //
 // An example for using a modal dialog:
-// ```c
-// GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT | GTK_DIALOG_MODAL;
-// dialog = gtk_message_dialog_new (parent_window,
-//
-//	flags,
-//	GTK_MESSAGE_ERROR,
-//	GTK_BUTTONS_CLOSE,
-//	"Error reading “%s”: %s",
-//	filename,
-//	g_strerror (errno));
-//
-// // Destroy the dialog when the user responds to it
-// // (e.g. clicks a button)
-//
-// g_signal_connect (dialog, "response",
 //
-//	G_CALLBACK (gtk_window_destroy),
-//	NULL);
-//
-// ```
+//	GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT | GTK_DIALOG_MODAL;
+//	dialog = gtk_message_dialog_new (parent_window,
+//	                                 flags,
+//	                                 GTK_MESSAGE_ERROR,
+//	                                 GTK_BUTTONS_CLOSE,
+//	                                 "Error reading “%s”: %s",
+//	                                 filename,
+//	                                 g_strerror (errno));
+//	// Destroy the dialog when the user responds to it
+//	// (e.g. clicks a button)
+//
+//	g_signal_connect (dialog, "response",
+//	                  G_CALLBACK (gtk_window_destroy),
+//	                  NULL);
 //
 // You might do a non-modal `GtkMessageDialog` simply by omitting the
-// %GTK_DIALOG_MODAL flag:
-//
-// ```c
-// GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT;
-// dialog = gtk_message_dialog_new (parent_window,
-//
-//	flags,
-//	GTK_MESSAGE_ERROR,
-//	GTK_BUTTONS_CLOSE,
-//	"Error reading “%s”: %s",
-//	filename,
-//	g_strerror (errno));
-//
-// // Destroy the dialog when the user responds to it
-// // (e.g. clicks a button)
-// g_signal_connect (dialog, "response",
-//
-//	G_CALLBACK (gtk_window_destroy),
-//	NULL);
-//
-// ```
+// [DialogModalValue] flag:
+//
+//	GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT;
+//	dialog = gtk_message_dialog_new (parent_window,
+//	                                 flags,
+//	                                 GTK_MESSAGE_ERROR,
+//	                                 GTK_BUTTONS_CLOSE,
+//	                                 "Error reading “%s”: %s",
+//	                                 filename,
+//	                                 g_strerror (errno));
+//
+//	// Destroy the dialog when the user responds to it
+//	// (e.g. clicks a button)
+//	g_signal_connect (dialog, "response",
+//	                  G_CALLBACK (gtk_window_destroy),
+//	                  NULL);
 //
 // # GtkMessageDialog as GtkBuildable
 //
 // The `GtkMessageDialog` implementation of the `GtkBuildable` interface exposes
 // the message area as an internal child with the name “message_area”.
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
 type MessageDialog struct {
 	Dialog
 }
@@ -137,152 +181,6 @@ func MessageDialogNewFromInternalPtr(ptr uintptr) *MessageDialog {
 	return cls
 }
 
-var xNewMessageDialog func(uintptr, DialogFlags, MessageType, ButtonsType, uintptr, ...interface{}) uintptr
-
-// Creates a new message dialog.
-//
-// This is a simple dialog with some text the user may want to see.
-// When the user clicks a button a “response” signal is emitted with
-// response IDs from [enum@Gtk.ResponseType]. See [class@Gtk.Dialog]
-// for more details.
-func NewMessageDialog(ParentVar *Window, FlagsVar DialogFlags, TypeVar MessageType, ButtonsVar ButtonsType, MessageFormatVar *string, varArgs ...interface{}) *MessageDialog {
-	var cls *MessageDialog
-
-	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
-	defer core.GFreeNullable(MessageFormatVarPtr)
-
-	cret := xNewMessageDialog(ParentVar.GoPointer(), FlagsVar, TypeVar, ButtonsVar, MessageFormatVarPtr, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &MessageDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewMessageDialogWithMarkup func(uintptr, DialogFlags, MessageType, ButtonsType, uintptr, ...interface{}) uintptr
-
-// Creates a new message dialog.
-//
-// This is a simple dialog with some text that is marked up with
-// Pango markup. When the user clicks a button a “response” signal
-// is emitted with response IDs from [enum@Gtk.ResponseType]. See
-// [class@Gtk.Dialog] for more details.
-//
-// Special XML characters in the printf() arguments passed to this
-// function will automatically be escaped as necessary.
-// (See g_markup_printf_escaped() for how this is implemented.)
-// Usually this is what you want, but if you have an existing
-// Pango markup string that you want to use literally as the
-// label, then you need to use [method@Gtk.MessageDialog.set_markup]
-// instead, since you can’t pass the markup string either
-// as the format (it might contain “%” characters) or as a string
-// argument.
-//
-// ```c
-// GtkWidget *dialog;
-// GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT;
-// dialog = gtk_message_dialog_new (parent_window,
-//
-//	flags,
-//	GTK_MESSAGE_ERROR,
-//	GTK_BUTTONS_CLOSE,
-//	NULL);
-//
-// gtk_message_dialog_set_markup (GTK_MESSAGE_DIALOG (dialog),
-//
-//	markup);
-//
-// ```
-func NewMessageDialogWithMarkup(ParentVar *Window, FlagsVar DialogFlags, TypeVar MessageType, ButtonsVar ButtonsType, MessageFormatVar *string, varArgs ...interface{}) *MessageDialog {
-	var cls *MessageDialog
-
-	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
-	defer core.GFreeNullable(MessageFormatVarPtr)
-
-	cret := xNewMessageDialogWithMarkup(ParentVar.GoPointer(), FlagsVar, TypeVar, ButtonsVar, MessageFormatVarPtr, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &MessageDialog{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xMessageDialogFormatSecondaryMarkup func(uintptr, string, ...interface{})
-
-// Sets the secondary text of the message dialog.
-//
-// The @message_format is assumed to contain Pango markup.
-//
-// Due to an oversight, this function does not escape special
-// XML characters like [ctor@Gtk.MessageDialog.new_with_markup]
-// does. Thus, if the arguments may contain special XML characters,
-// you should use g_markup_printf_escaped() to escape it.
-//
-// ```c
-// char *msg;
-//
-// msg = g_markup_printf_escaped (message_format, ...);
-// gtk_message_dialog_format_secondary_markup (message_dialog,
-//
-//	"%s", msg);
-//
-// g_free (msg);
-// ```
-func (x *MessageDialog) FormatSecondaryMarkup(MessageFormatVar string, varArgs ...interface{}) {
-
-	xMessageDialogFormatSecondaryMarkup(x.GoPointer(), MessageFormatVar, varArgs...)
-
-}
-
-var xMessageDialogFormatSecondaryText func(uintptr, uintptr, ...interface{})
-
-// Sets the secondary text of the message dialog.
-func (x *MessageDialog) FormatSecondaryText(MessageFormatVar *string, varArgs ...interface{}) {
-
-	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
-	defer core.GFreeNullable(MessageFormatVarPtr)
-
-	xMessageDialogFormatSecondaryText(x.GoPointer(), MessageFormatVarPtr, varArgs...)
-
-}
-
-var xMessageDialogGetMessageArea func(uintptr) uintptr
-
-// Returns the message area of the dialog.
-//
-// This is the box where the dialog’s primary and secondary labels
-// are packed. You can add your own extra content to that box and it
-// will appear below those labels. See [method@Gtk.Dialog.get_content_area]
-// for the corresponding function in the parent [class@Gtk.Dialog].
-func (x *MessageDialog) GetMessageArea() *Widget {
-	var cls *Widget
-
-	cret := xMessageDialogGetMessageArea(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Widget{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xMessageDialogSetMarkup func(uintptr, string)
-
-// Sets the text of the message dialog.
-func (x *MessageDialog) SetMarkup(StrVar string) {
-
-	xMessageDialogSetMarkup(x.GoPointer(), StrVar)
-
-}
-
 func (c *MessageDialog) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -312,9 +210,9 @@ func (x *MessageDialog) GetPropertySecondaryText() string {
 }
 
 // SetPropertySecondaryUseMarkup sets the "secondary-use-markup" property.
-// %TRUE if the secondary text of the dialog includes Pango markup.
+// true if the secondary text of the dialog includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *MessageDialog) SetPropertySecondaryUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -323,9 +221,9 @@ func (x *MessageDialog) SetPropertySecondaryUseMarkup(value bool) {
 }
 
 // GetPropertySecondaryUseMarkup gets the "secondary-use-markup" property.
-// %TRUE if the secondary text of the dialog includes Pango markup.
+// true if the secondary text of the dialog includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *MessageDialog) GetPropertySecondaryUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("secondary-use-markup", &v)
@@ -354,9 +252,9 @@ func (x *MessageDialog) GetPropertyText() string {
 }
 
 // SetPropertyUseMarkup sets the "use-markup" property.
-// %TRUE if the primary text of the dialog includes Pango markup.
+// true if the primary text of the dialog includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *MessageDialog) SetPropertyUseMarkup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -365,9 +263,9 @@ func (x *MessageDialog) SetPropertyUseMarkup(value bool) {
 }
 
 // GetPropertyUseMarkup gets the "use-markup" property.
-// %TRUE if the primary text of the dialog includes Pango markup.
+// true if the primary text of the dialog includes Pango markup.
 //
-// See [func@Pango.parse_markup].
+// See [pango.ParseMarkup].
 func (x *MessageDialog) GetPropertyUseMarkup() bool {
 	var v gobject.Value
 	x.GetProperty("use-markup", &v)
@@ -535,7 +433,7 @@ func (x *MessageDialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -543,14 +441,13 @@ func (x *MessageDialog) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -574,19 +471,17 @@ func (x *MessageDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -607,7 +502,7 @@ func (x *MessageDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -616,14 +511,12 @@ func (x *MessageDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *MessageDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -642,10 +535,10 @@ func (x *MessageDialog) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MessageDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -682,10 +575,10 @@ func (x *MessageDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *MessageDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -729,7 +622,7 @@ func (x *MessageDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *MessageDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -745,13 +638,13 @@ func (x *MessageDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *MessageDialog) SetFocus(FocusVar *Widget) {
 
@@ -764,7 +657,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -775,12 +668,4 @@ func init() {
 
 	core.PuregoSafeRegister(&xMessageDialogGLibType, libs, "gtk_message_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewMessageDialog, libs, "gtk_message_dialog_new")
-	core.PuregoSafeRegister(&xNewMessageDialogWithMarkup, libs, "gtk_message_dialog_new_with_markup")
-
-	core.PuregoSafeRegister(&xMessageDialogFormatSecondaryMarkup, libs, "gtk_message_dialog_format_secondary_markup")
-	core.PuregoSafeRegister(&xMessageDialogFormatSecondaryText, libs, "gtk_message_dialog_format_secondary_text")
-	core.PuregoSafeRegister(&xMessageDialogGetMessageArea, libs, "gtk_message_dialog_get_message_area")
-	core.PuregoSafeRegister(&xMessageDialogSetMarkup, libs, "gtk_message_dialog_set_markup")
-
 }
@@ -0,0 +1,183 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewMessageDialog func(uintptr, DialogFlags, MessageType, ButtonsType, uintptr, ...interface{}) uintptr
+
+// Creates a new message dialog.
+//
+// This is a simple dialog with some text the user may want to see.
+// When the user clicks a button a “response” signal is emitted with
+// response IDs from [ResponseType]. See [Dialog]
+// for more details.
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+func NewMessageDialog(ParentVar *Window, FlagsVar DialogFlags, TypeVar MessageType, ButtonsVar ButtonsType, MessageFormatVar *string, varArgs ...interface{}) *MessageDialog {
+	var cls *MessageDialog
+
+	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
+	defer core.GFreeNullable(MessageFormatVarPtr)
+
+	cret := xNewMessageDialog(ParentVar.GoPointer(), FlagsVar, TypeVar, ButtonsVar, MessageFormatVarPtr, varArgs...)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &MessageDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewMessageDialogWithMarkup func(uintptr, DialogFlags, MessageType, ButtonsType, uintptr, ...interface{}) uintptr
+
+// Creates a new message dialog.
+//
+// This is a simple dialog with some text that is marked up with
+// Pango markup. When the user clicks a button a “response” signal
+// is emitted with response IDs from [ResponseType]. See
+// [Dialog] for more details.
+//
+// Special XML characters in the printf() arguments passed to this
+// function will automatically be escaped as necessary.
+// (See [glib.MarkupPrintfEscaped] for how this is implemented.)
+// Usually this is what you want, but if you have an existing
+// Pango markup string that you want to use literally as the
+// label, then you need to use [MessageDialog.SetMarkup]
+// instead, since you can’t pass the markup string either
+// as the format (it might contain “%” characters) or as a string
+// argument.
+//
+//	GtkWidget *dialog;
+//	GtkDialogFlags flags = GTK_DIALOG_DESTROY_WITH_PARENT;
+//	dialog = gtk_message_dialog_new (parent_window,
+//	                                 flags,
+//	                                 GTK_MESSAGE_ERROR,
+//	                                 GTK_BUTTONS_CLOSE,
+//	                                 NULL);
+//	gtk_message_dialog_set_markup (GTK_MESSAGE_DIALOG (dialog),
+//	                               markup);
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+func NewMessageDialogWithMarkup(ParentVar *Window, FlagsVar DialogFlags, TypeVar MessageType, ButtonsVar ButtonsType, MessageFormatVar *string, varArgs ...interface{}) *MessageDialog {
+	var cls *MessageDialog
+
+	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
+	defer core.GFreeNullable(MessageFormatVarPtr)
+
+	cret := xNewMessageDialogWithMarkup(ParentVar.GoPointer(), FlagsVar, TypeVar, ButtonsVar, MessageFormatVarPtr, varArgs...)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &MessageDialog{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xMessageDialogFormatSecondaryMarkup func(uintptr, string, ...interface{})
+
+// Sets the secondary text of the message dialog.
+//
+// The message_format is assumed to contain Pango markup.
+//
+// Due to an oversight, this function does not escape special
+// XML characters like [NewMessageDialogWithMarkup]
+// does. Thus, if the arguments may contain special XML characters,
+// you should use [glib.MarkupPrintfEscaped] to escape it.
+//
+//	char *msg;
+//
+//	msg = g_markup_printf_escaped (message_format, ...);
+//	gtk_message_dialog_format_secondary_markup (message_dialog,
+//	                                            "%s", msg);
+//	g_free (msg);
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+//
+// MessageFormatVar is a Go format, the arguments are formatted with fmt.Sprintf.
+func (x *MessageDialog) FormatSecondaryMarkup(MessageFormatVar string, varArgs ...interface{}) {
+
+	xMessageDialogFormatSecondaryMarkup(x.GoPointer(), "%s", fmt.Sprintf(MessageFormatVar, varArgs...))
+
+}
+
+var xMessageDialogFormatSecondaryText func(uintptr, uintptr, ...interface{})
+
+// Sets the secondary text of the message dialog.
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+func (x *MessageDialog) FormatSecondaryText(MessageFormatVar *string, varArgs ...interface{}) {
+
+	MessageFormatVarPtr := core.GStrdupNullable(MessageFormatVar)
+	defer core.GFreeNullable(MessageFormatVarPtr)
+
+	xMessageDialogFormatSecondaryText(x.GoPointer(), MessageFormatVarPtr, varArgs...)
+
+}
+
+var xMessageDialogGetMessageArea func(uintptr) uintptr
+
+// Returns the message area of the dialog.
+//
+// This is the box where the dialog’s primary and secondary labels
+// are packed. You can add your own extra content to that box and it
+// will appear below those labels. See [Dialog.GetContentArea]
+// for the corresponding function in the parent [Dialog].
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+func (x *MessageDialog) GetMessageArea() *Widget {
+	var cls *Widget
+
+	cret := xMessageDialogGetMessageArea(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xMessageDialogSetMarkup func(uintptr, string)
+
+// Sets the text of the message dialog.
+//
+// Deprecated: since 4.10. Use [AlertDialog] instead
+func (x *MessageDialog) SetMarkup(StrVar string) {
+
+	xMessageDialogSetMarkup(x.GoPointer(), StrVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewMessageDialog, libs, "gtk_message_dialog_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewMessageDialogWithMarkup, libs, "gtk_message_dialog_new_with_markup", "GTK", "")
+
+	core.PuregoRegisterSince(&xMessageDialogFormatSecondaryMarkup, libs, "gtk_message_dialog_format_secondary_markup", "GTK", "")
+	core.PuregoRegisterSince(&xMessageDialogFormatSecondaryText, libs, "gtk_message_dialog_format_secondary_text", "GTK", "")
+	core.PuregoRegisterSince(&xMessageDialogGetMessageArea, libs, "gtk_message_dialog_get_message_area", "GTK", "")
+	core.PuregoRegisterSince(&xMessageDialogSetMarkup, libs, "gtk_message_dialog_set_markup", "GTK", "")
+
+}
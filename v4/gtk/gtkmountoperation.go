@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -36,7 +35,8 @@ func (x *MountOperationClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -48,7 +48,7 @@ func (x *MountOperationClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -59,7 +59,8 @@ func (x *MountOperationClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -71,7 +72,7 @@ func (x *MountOperationClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -82,7 +83,8 @@ func (x *MountOperationClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -94,7 +96,7 @@ func (x *MountOperationClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -105,7 +107,8 @@ func (x *MountOperationClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -117,7 +120,7 @@ func (x *MountOperationClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -137,10 +140,10 @@ func (x *MountOperationPrivate) GoPointer() uintptr {
 // `GtkMountOperation` is needed when mounting volumes:
 // It is an implementation of `GMountOperation` that can be used with
 // GIO functions for mounting volumes such as
-// [method@Gio.File.mount_enclosing_volume],
-// [method@Gio.File.mount_mountable],
-// [method@Gio.Volume.mount],
-// [method@Gio.Mount.unmount_with_operation] and others.
+// [gio.File.MountEnclosingVolume],
+// [gio.File.MountMountable],
+// [gio.Volume.Mount],
+// [gio.Mount.UnmountWithOperation] and others.
 //
 // When necessary, `GtkMountOperation` shows dialogs to let the user
 // enter passwords, ask questions or show processes blocking unmount.
@@ -264,7 +267,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -273,12 +276,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xMountOperationGLibType, libs, "gtk_mount_operation_get_type")
 
-	core.PuregoSafeRegister(&xNewMountOperation, libs, "gtk_mount_operation_new")
+	gobject.CheckClassSize("GtkMountOperationClass", xMountOperationGLibType, unsafe.Sizeof(MountOperationClass{}))
 
-	core.PuregoSafeRegister(&xMountOperationGetDisplay, libs, "gtk_mount_operation_get_display")
-	core.PuregoSafeRegister(&xMountOperationGetParent, libs, "gtk_mount_operation_get_parent")
-	core.PuregoSafeRegister(&xMountOperationIsShowing, libs, "gtk_mount_operation_is_showing")
-	core.PuregoSafeRegister(&xMountOperationSetDisplay, libs, "gtk_mount_operation_set_display")
-	core.PuregoSafeRegister(&xMountOperationSetParent, libs, "gtk_mount_operation_set_parent")
+	core.PuregoRegisterSince(&xNewMountOperation, libs, "gtk_mount_operation_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xMountOperationGetDisplay, libs, "gtk_mount_operation_get_display", "GTK", "")
+	core.PuregoRegisterSince(&xMountOperationGetParent, libs, "gtk_mount_operation_get_parent", "GTK", "")
+	core.PuregoRegisterSince(&xMountOperationIsShowing, libs, "gtk_mount_operation_is_showing", "GTK", "")
+	core.PuregoRegisterSince(&xMountOperationSetDisplay, libs, "gtk_mount_operation_set_display", "GTK", "")
+	core.PuregoRegisterSince(&xMountOperationSetParent, libs, "gtk_mount_operation_set_parent", "GTK", "")
 
 }
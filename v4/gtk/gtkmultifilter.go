@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -38,7 +37,7 @@ func (x *MultiFilterClass) GoPointer() uintptr {
 
 // Matches an item when at least one of its filters matches.
 //
-// To add filters to a `GtkAnyFilter`, use [method@Gtk.MultiFilter.append].
+// To add filters to a `GtkAnyFilter`, use [MultiFilter.Append].
 type AnyFilter struct {
 	MultiFilter
 }
@@ -59,7 +58,7 @@ var xNewAnyFilter func() uintptr
 
 // Creates a new empty "any" filter.
 //
-// Use [method@Gtk.MultiFilter.append] to add filters to it.
+// Use [MultiFilter.Append] to add filters to it.
 //
 // This filter matches an item if any of the filters added to it
 // matches the item. In particular, this means that if no filter
@@ -88,28 +87,30 @@ func (c *AnyFilter) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *AnyFilter) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *AnyFilter) GetItemType() types.GType {
 
@@ -117,29 +118,29 @@ func (x *AnyFilter) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *AnyFilter) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *AnyFilter) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -153,20 +154,20 @@ func (x *AnyFilter) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -179,10 +180,10 @@ func (x *AnyFilter) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar uin
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *AnyFilter) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -191,7 +192,7 @@ func (x *AnyFilter) GetBuildableId() string {
 
 // Matches an item when each of its filters matches.
 //
-// To add filters to a `GtkEveryFilter`, use [method@Gtk.MultiFilter.append].
+// To add filters to a `GtkEveryFilter`, use [MultiFilter.Append].
 type EveryFilter struct {
 	MultiFilter
 }
@@ -212,7 +213,7 @@ var xNewEveryFilter func() uintptr
 
 // Creates a new empty "every" filter.
 //
-// Use [method@Gtk.MultiFilter.append] to add filters to it.
+// Use [MultiFilter.Append] to add filters to it.
 //
 // This filter matches an item if each of the filters added to it
 // matches the item. In particular, this means that if no filter
@@ -241,28 +242,30 @@ func (c *EveryFilter) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *EveryFilter) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *EveryFilter) GetItemType() types.GType {
 
@@ -270,29 +273,29 @@ func (x *EveryFilter) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *EveryFilter) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *EveryFilter) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -306,20 +309,20 @@ func (x *EveryFilter) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -332,10 +335,10 @@ func (x *EveryFilter) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar u
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *EveryFilter) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -372,7 +375,7 @@ var xMultiFilterRemove func(uintptr, uint)
 
 // Removes a filter.
 //
-// If @position is larger than the number of filters,
+// If position is larger than the number of filters,
 // nothing happens.
 func (x *MultiFilter) Remove(PositionVar uint) {
 
@@ -394,35 +397,37 @@ func (c *MultiFilter) SetGoPointer(ptr uintptr) {
 // GetPropertyNItems gets the "n-items" property.
 // The number of items.
 //
-// See [method@Gio.ListModel.get_n_items].
+// See [gio.ListModel.GetNItems].
 func (x *MultiFilter) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *MultiFilter) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *MultiFilter) GetItemType() types.GType {
 
@@ -430,29 +435,29 @@ func (x *MultiFilter) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *MultiFilter) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *MultiFilter) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -466,20 +471,20 @@ func (x *MultiFilter) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -492,10 +497,10 @@ func (x *MultiFilter) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar u
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MultiFilter) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -507,7 +512,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -516,15 +521,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xAnyFilterGLibType, libs, "gtk_any_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewAnyFilter, libs, "gtk_any_filter_new")
+	core.PuregoRegisterSince(&xNewAnyFilter, libs, "gtk_any_filter_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xEveryFilterGLibType, libs, "gtk_every_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewEveryFilter, libs, "gtk_every_filter_new")
+	core.PuregoRegisterSince(&xNewEveryFilter, libs, "gtk_every_filter_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xMultiFilterGLibType, libs, "gtk_multi_filter_get_type")
 
-	core.PuregoSafeRegister(&xMultiFilterAppend, libs, "gtk_multi_filter_append")
-	core.PuregoSafeRegister(&xMultiFilterRemove, libs, "gtk_multi_filter_remove")
+	core.PuregoRegisterSince(&xMultiFilterAppend, libs, "gtk_multi_filter_append", "GTK", "")
+	core.PuregoRegisterSince(&xMultiFilterRemove, libs, "gtk_multi_filter_remove", "GTK", "")
 
 }
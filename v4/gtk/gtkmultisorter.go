@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -65,10 +64,10 @@ func NewMultiSorter() *MultiSorter {
 
 var xMultiSorterAppend func(uintptr, uintptr)
 
-// Add @sorter to @self to use for sorting at the end.
+// Add sorter to self to use for sorting at the end.
 //
-// @self will consult all existing sorters before it will
-// sort with the given @sorter.
+// self will consult all existing sorters before it will
+// sort with the given sorter.
 func (x *MultiSorter) Append(SorterVar *Sorter) {
 
 	xMultiSorterAppend(x.GoPointer(), SorterVar.GoPointer())
@@ -77,10 +76,10 @@ func (x *MultiSorter) Append(SorterVar *Sorter) {
 
 var xMultiSorterRemove func(uintptr, uint)
 
-// Removes the sorter at the given @position from the list of sorter
-// used by @self.
+// Removes the sorter at the given position from the list of sorter
+// used by self.
 //
-// If @position is larger than the number of sorters, nothing happens.
+// If position is larger than the number of sorters, nothing happens.
 func (x *MultiSorter) Remove(PositionVar uint) {
 
 	xMultiSorterRemove(x.GoPointer(), PositionVar)
@@ -99,35 +98,37 @@ func (c *MultiSorter) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *MultiSorter) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *MultiSorter) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *MultiSorter) GetItemType() types.GType {
 
@@ -135,29 +136,29 @@ func (x *MultiSorter) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *MultiSorter) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *MultiSorter) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -171,20 +172,20 @@ func (x *MultiSorter) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -197,10 +198,10 @@ func (x *MultiSorter) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar u
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *MultiSorter) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -212,7 +213,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -221,9 +222,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xMultiSorterGLibType, libs, "gtk_multi_sorter_get_type")
 
-	core.PuregoSafeRegister(&xNewMultiSorter, libs, "gtk_multi_sorter_new")
+	gobject.CheckClassSize("GtkMultiSorterClass", xMultiSorterGLibType, unsafe.Sizeof(MultiSorterClass{}))
+
+	core.PuregoRegisterSince(&xNewMultiSorter, libs, "gtk_multi_sorter_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xMultiSorterAppend, libs, "gtk_multi_sorter_append")
-	core.PuregoSafeRegister(&xMultiSorterRemove, libs, "gtk_multi_sorter_remove")
+	core.PuregoRegisterSince(&xMultiSorterAppend, libs, "gtk_multi_sorter_append", "GTK", "")
+	core.PuregoRegisterSince(&xMultiSorterRemove, libs, "gtk_multi_sorter_remove", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -21,20 +20,20 @@ func (x *NativeInterface) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// An interface for widgets that have their own [class@Gdk.Surface].
+// An interface for widgets that have their own [gdk.Surface].
 //
 // The obvious example of a `GtkNative` is `GtkWindow`.
 //
 // Every widget that is not itself a `GtkNative` is contained in one,
-// and you can get it with [method@Gtk.Widget.get_native].
+// and you can get it with [Widget.GetNative].
 //
-// To get the surface of a `GtkNative`, use [method@Gtk.Native.get_surface].
+// To get the surface of a `GtkNative`, use [Native.GetSurface].
 // It is also possible to find the `GtkNative` to which a surface
-// belongs, with [func@Gtk.Native.get_for_surface].
+// belongs, with [NativeGetForSurface].
 //
-// In addition to a [class@Gdk.Surface], a `GtkNative` also provides
-// a [class@Gsk.Renderer] for rendering on that surface. To get the
-// renderer, use [method@Gtk.Native.get_renderer].
+// In addition to a [gdk.Surface], a `GtkNative` also provides
+// a [gsk.Renderer] for rendering on that surface. To get the
+// renderer, use [Native.GetRenderer].
 type Native interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -96,10 +95,10 @@ func (x *NativeBase) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *NativeBase) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -152,21 +151,21 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xNativeGetForSurface, libs, "gtk_native_get_for_surface")
+	core.PuregoRegisterSince(&xNativeGetForSurface, libs, "gtk_native_get_for_surface", "GTK", "")
 
 	core.PuregoSafeRegister(&xNativeGLibType, libs, "gtk_native_get_type")
 
-	core.PuregoSafeRegister(&XGtkNativeGetRenderer, libs, "gtk_native_get_renderer")
-	core.PuregoSafeRegister(&XGtkNativeGetSurface, libs, "gtk_native_get_surface")
-	core.PuregoSafeRegister(&XGtkNativeGetSurfaceTransform, libs, "gtk_native_get_surface_transform")
-	core.PuregoSafeRegister(&XGtkNativeRealize, libs, "gtk_native_realize")
-	core.PuregoSafeRegister(&XGtkNativeUnrealize, libs, "gtk_native_unrealize")
+	core.PuregoRegisterSince(&XGtkNativeGetRenderer, libs, "gtk_native_get_renderer", "GTK", "")
+	core.PuregoRegisterSince(&XGtkNativeGetSurface, libs, "gtk_native_get_surface", "GTK", "")
+	core.PuregoRegisterSince(&XGtkNativeGetSurfaceTransform, libs, "gtk_native_get_surface_transform", "GTK", "")
+	core.PuregoRegisterSince(&XGtkNativeRealize, libs, "gtk_native_realize", "GTK", "")
+	core.PuregoRegisterSince(&XGtkNativeUnrealize, libs, "gtk_native_unrealize", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -43,7 +42,8 @@ func (x *NativeDialogClass) OverrideResponse(cb func(*NativeDialog, int)) {
 	if cb == nil {
 		x.xResponse = 0
 	} else {
-		x.xResponse = purego.NewCallback(func(SelfVarp uintptr, ResponseIdVarp int) {
+		x.xResponse = core.NewCallback(func(SelfVarp uintptr, ResponseIdVarp int) {
+			defer core.RecoverPanic()
 			cb(NativeDialogNewFromInternalPtr(SelfVarp), ResponseIdVarp)
 		})
 	}
@@ -56,7 +56,7 @@ func (x *NativeDialogClass) GetResponse() func(*NativeDialog, int) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ResponseIdVarp int)
-	purego.RegisterFunc(&rawCallback, x.xResponse)
+	core.RegisterFunc(&rawCallback, x.xResponse)
 	return func(SelfVar *NativeDialog, ResponseIdVar int) {
 		rawCallback(SelfVar.GoPointer(), ResponseIdVar)
 	}
@@ -67,7 +67,8 @@ func (x *NativeDialogClass) OverrideShow(cb func(*NativeDialog)) {
 	if cb == nil {
 		x.xShow = 0
 	} else {
-		x.xShow = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xShow = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NativeDialogNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -79,7 +80,7 @@ func (x *NativeDialogClass) GetShow() func(*NativeDialog) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShow)
+	core.RegisterFunc(&rawCallback, x.xShow)
 	return func(SelfVar *NativeDialog) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -90,7 +91,8 @@ func (x *NativeDialogClass) OverrideHide(cb func(*NativeDialog)) {
 	if cb == nil {
 		x.xHide = 0
 	} else {
-		x.xHide = purego.NewCallback(func(SelfVarp uintptr) {
+		x.xHide = core.NewCallback(func(SelfVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(NativeDialogNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -102,7 +104,7 @@ func (x *NativeDialogClass) GetHide() func(*NativeDialog) {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHide)
+	core.RegisterFunc(&rawCallback, x.xHide)
 	return func(SelfVar *NativeDialog) {
 		rawCallback(SelfVar.GoPointer())
 	}
@@ -113,7 +115,8 @@ func (x *NativeDialogClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -125,7 +128,7 @@ func (x *NativeDialogClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -136,7 +139,8 @@ func (x *NativeDialogClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -148,7 +152,7 @@ func (x *NativeDialogClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -159,7 +163,8 @@ func (x *NativeDialogClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -171,7 +176,7 @@ func (x *NativeDialogClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -182,7 +187,8 @@ func (x *NativeDialogClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -194,7 +200,7 @@ func (x *NativeDialogClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -206,11 +212,11 @@ func (x *NativeDialogClass) GetGtkReserved4() func() {
 // by looking the same as other native applications and supporting
 // platform specific features.
 //
-// The [class@Gtk.Dialog] functions cannot be used on such objects,
+// The [Dialog] functions cannot be used on such objects,
 // but we need a similar API in order to drive them. The `GtkNativeDialog`
 // object is an API that allows you to do this. It allows you to set
 // various common properties on the dialog, as well as show and hide
-// it and get a [signal@Gtk.NativeDialog::response] signal when the user
+// it and get a [NativeDialog.ConnectResponseFunc] signal when the user
 // finished with the dialog.
 //
 // Note that unlike `GtkDialog`, `GtkNativeDialog` objects are not
@@ -300,9 +306,9 @@ var xNativeDialogHide func(uintptr)
 
 // Hides the dialog if it is visible, aborting any interaction.
 //
-// Once this is called the [signal@Gtk.NativeDialog::response] signal
+// Once this is called the [NativeDialog.ConnectResponseFunc] signal
 // will *not* be emitted until after the next call to
-// [method@Gtk.NativeDialog.show].
+// [NativeDialog.Show].
 //
 // If the dialog is not visible this does nothing.
 func (x *NativeDialog) Hide() {
@@ -317,7 +323,7 @@ var xNativeDialogSetModal func(uintptr, bool)
 //
 // Modal dialogs prevent interaction with other windows in the same
 // application. To keep modal dialogs on top of main application
-// windows, use [method@Gtk.NativeDialog.set_transient_for] to make
+// windows, use [NativeDialog.SetTransientFor] to make
 // the dialog transient for the parent; most window managers will
 // then disallow lowering the dialog below the parent.
 func (x *NativeDialog) SetModal(ModalVar bool) {
@@ -343,7 +349,7 @@ var xNativeDialogSetTransientFor func(uintptr, uintptr)
 // This allows window managers to e.g. keep the dialog on top of the
 // main window, or center the dialog over the main window.
 //
-// Passing %NULL for @parent unsets the current transient window.
+// Passing nil for parent unsets the current transient window.
 func (x *NativeDialog) SetTransientFor(ParentVar *Window) {
 
 	xNativeDialogSetTransientFor(x.GoPointer(), ParentVar.GoPointer())
@@ -355,7 +361,7 @@ var xNativeDialogShow func(uintptr)
 // Shows the dialog on the display.
 //
 // When the user accepts the state of the dialog the dialog will
-// be automatically hidden and the [signal@Gtk.NativeDialog::response]
+// be automatically hidden and the [NativeDialog.ConnectResponseFunc]
 // signal will be emitted.
 //
 // Multiple calls while the dialog is visible will be ignored.
@@ -431,29 +437,51 @@ func (x *NativeDialog) GetPropertyVisible() bool {
 //
 // When this is called the dialog has been hidden.
 //
-// If you call [method@Gtk.NativeDialog.hide] before the user
+// If you call [NativeDialog.Hide] before the user
 // responds to the dialog this signal will not be emitted.
+//
+// Deprecated: use ConnectResponseFunc, which also accepts method values and closures.
 func (x *NativeDialog) ConnectResponse(cb *func(NativeDialog, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResponseIdVarp int) {
+			defer core.RecoverPanic()
+			fa := NativeDialog{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResponseIdVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "response", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ResponseIdVarp int) {
+var xNativeDialogResponseTrampoline uintptr
+
+func xNativeDialogResponseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResponseIdVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(NativeDialog, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := NativeDialog{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResponseIdVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "response", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectResponseFunc connects cb to the "response" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *NativeDialog) ConnectResponseFunc(cb func(NativeDialog, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "response", &xNativeDialogResponseTrampoline, xNativeDialogResponseNewTrampoline, cb)
 }
 
 func init() {
@@ -461,7 +489,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -470,15 +498,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xNativeDialogGLibType, libs, "gtk_native_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNativeDialogDestroy, libs, "gtk_native_dialog_destroy")
-	core.PuregoSafeRegister(&xNativeDialogGetModal, libs, "gtk_native_dialog_get_modal")
-	core.PuregoSafeRegister(&xNativeDialogGetTitle, libs, "gtk_native_dialog_get_title")
-	core.PuregoSafeRegister(&xNativeDialogGetTransientFor, libs, "gtk_native_dialog_get_transient_for")
-	core.PuregoSafeRegister(&xNativeDialogGetVisible, libs, "gtk_native_dialog_get_visible")
-	core.PuregoSafeRegister(&xNativeDialogHide, libs, "gtk_native_dialog_hide")
-	core.PuregoSafeRegister(&xNativeDialogSetModal, libs, "gtk_native_dialog_set_modal")
-	core.PuregoSafeRegister(&xNativeDialogSetTitle, libs, "gtk_native_dialog_set_title")
-	core.PuregoSafeRegister(&xNativeDialogSetTransientFor, libs, "gtk_native_dialog_set_transient_for")
-	core.PuregoSafeRegister(&xNativeDialogShow, libs, "gtk_native_dialog_show")
+	gobject.CheckClassSize("GtkNativeDialogClass", xNativeDialogGLibType, unsafe.Sizeof(NativeDialogClass{}))
+
+	core.PuregoRegisterSince(&xNativeDialogDestroy, libs, "gtk_native_dialog_destroy", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogGetModal, libs, "gtk_native_dialog_get_modal", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogGetTitle, libs, "gtk_native_dialog_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogGetTransientFor, libs, "gtk_native_dialog_get_transient_for", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogGetVisible, libs, "gtk_native_dialog_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogHide, libs, "gtk_native_dialog_hide", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogSetModal, libs, "gtk_native_dialog_set_modal", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogSetTitle, libs, "gtk_native_dialog_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogSetTransientFor, libs, "gtk_native_dialog_set_transient_for", "GTK", "")
+	core.PuregoRegisterSince(&xNativeDialogShow, libs, "gtk_native_dialog_show", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -46,7 +45,7 @@ func NoSelectionNewFromInternalPtr(ptr uintptr) *NoSelection {
 
 var xNewNoSelection func(uintptr) uintptr
 
-// Creates a new selection to handle @model.
+// Creates a new selection to handle model.
 func NewNoSelection(ModelVar gio.ListModel) *NoSelection {
 	var cls *NoSelection
 
@@ -62,7 +61,7 @@ func NewNoSelection(ModelVar gio.ListModel) *NoSelection {
 
 var xNoSelectionGetModel func(uintptr) uintptr
 
-// Gets the model that @self is wrapping.
+// Gets the model that self is wrapping.
 func (x *NoSelection) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -79,9 +78,9 @@ func (x *NoSelection) GetModel() *gio.ListModelBase {
 
 var xNoSelectionSetModel func(uintptr, uintptr)
 
-// Sets the model that @self should wrap.
+// Sets the model that self should wrap.
 //
-// If @model is %NULL, this model will be empty.
+// If model is nil, this model will be empty.
 func (x *NoSelection) SetModel(ModelVar gio.ListModel) {
 
 	xNoSelectionSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -100,35 +99,37 @@ func (c *NoSelection) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *NoSelection) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *NoSelection) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *NoSelection) GetItemType() types.GType {
 
@@ -136,29 +137,29 @@ func (x *NoSelection) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *NoSelection) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *NoSelection) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -172,20 +173,20 @@ func (x *NoSelection) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -209,20 +210,20 @@ func (x *NoSelection) GetSection(PositionVar uint, OutStartVar *uint, OutEndVar
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *NoSelection) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -233,8 +234,8 @@ func (x *NoSelection) SectionsChanged(PositionVar uint, NItemsVar uint) {
 // Gets the set containing all currently selected items in the model.
 //
 // This function may be slow, so if you are only interested in single item,
-// consider using [method@Gtk.SelectionModel.is_selected] or if you are only
-// interested in a few, consider [method@Gtk.SelectionModel.get_selection_in_range].
+// consider using [SelectionModel.IsSelected] or if you are only
+// interested in a few, consider [SelectionModel.GetSelectionInRange].
 func (x *NoSelection) GetSelection() *Bitset {
 
 	cret := XGtkSelectionModelGetSelection(x.GoPointer())
@@ -244,9 +245,9 @@ func (x *NoSelection) GetSelection() *Bitset {
 // Gets the set of selected items in a range.
 //
 // This function is an optimization for
-// [method@Gtk.SelectionModel.get_selection] when you are only
+// [SelectionModel.GetSelection] when you are only
 // interested in part of the model's selected state. A common use
-// case is in response to the [signal@Gtk.SelectionModel::selection-changed]
+// case is in response to the SelectionModel::selection-changed
 // signal.
 func (x *NoSelection) GetSelectionInRange(PositionVar uint, NItemsVar uint) *Bitset {
 
@@ -285,7 +286,7 @@ func (x *NoSelection) SelectRange(PositionVar uint, NItemsVar uint, UnselectRest
 // Helper function for implementations of `GtkSelectionModel`.
 //
 // Call this when the selection changes to emit the
-// [signal@Gtk.SelectionModel::selection-changed] signal.
+// SelectionModel::selection-changed signal.
 func (x *NoSelection) SelectionChanged(PositionVar uint, NItemsVar uint) {
 
 	XGtkSelectionModelSelectionChanged(x.GoPointer(), PositionVar, NItemsVar)
@@ -299,33 +300,28 @@ func (x *NoSelection) SelectionChanged(PositionVar uint, NItemsVar uint) {
 // you should try the simpler versions, as implementations are more
 // likely to implement support for those.
 //
-// Requests that the selection state of all positions set in @mask
-// be updated to the respective value in the @selected bitmask.
+// Requests that the selection state of all positions set in mask
+// be updated to the respective value in the selected bitmask.
 //
 // In pseudocode, it would look something like this:
 //
-// ```c
-// for (i = 0; i &lt; n_items; i++)
-//
-//	{
-//	  // don't change values not in the mask
-//	  if (!gtk_bitset_contains (mask, i))
-//	    continue;
-//
-//	  if (gtk_bitset_contains (selected, i))
-//	    select_item (i);
-//	  else
-//	    unselect_item (i);
-//	}
+//	for (i = 0; i < n_items; i++)
+//	  {
+//	    // don't change values not in the mask
+//	    if (!gtk_bitset_contains (mask, i))
+//	      continue;
 //
-// gtk_selection_model_selection_changed (model,
+//	    if (gtk_bitset_contains (selected, i))
+//	      select_item (i);
+//	    else
+//	      unselect_item (i);
+//	  }
 //
-//	first_changed_item,
-//	n_changed_items);
+//	gtk_selection_model_selection_changed (model,
+//	                                       first_changed_item,
+//	                                       n_changed_items);
 //
-// ```
-//
-// @mask and @selected must not be modified. They may refer to the
+// mask and selected must not be modified. They may refer to the
 // same bitset, which would mean that every item in the set should
 // be selected.
 func (x *NoSelection) SetSelection(SelectedVar *Bitset, MaskVar *Bitset) bool {
@@ -360,7 +356,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -369,9 +365,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xNoSelectionGLibType, libs, "gtk_no_selection_get_type")
 
-	core.PuregoSafeRegister(&xNewNoSelection, libs, "gtk_no_selection_new")
+	gobject.CheckClassSize("GtkNoSelectionClass", xNoSelectionGLibType, unsafe.Sizeof(NoSelectionClass{}))
+
+	core.PuregoRegisterSince(&xNewNoSelection, libs, "gtk_no_selection_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xNoSelectionGetModel, libs, "gtk_no_selection_get_model")
-	core.PuregoSafeRegister(&xNoSelectionSetModel, libs, "gtk_no_selection_set_model")
+	core.PuregoRegisterSince(&xNoSelectionGetModel, libs, "gtk_no_selection_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xNoSelectionSetModel, libs, "gtk_no_selection_set_model", "GTK", "")
 
 }
@@ -2,9 +2,9 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -29,70 +29,92 @@ const (
 	NotebookTabLastValue NotebookTab = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x NotebookTab) String() string {
+	switch x {
+	case NotebookTabFirstValue:
+		return "GTK_NOTEBOOK_TAB_FIRST"
+	case NotebookTabLastValue:
+		return "GTK_NOTEBOOK_TAB_LAST"
+	}
+	return fmt.Sprintf("NotebookTab(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x NotebookTab) Nick() string {
+	switch x {
+	case NotebookTabFirstValue:
+		return "first"
+	case NotebookTabLastValue:
+		return "last"
+	}
+	return ""
+}
+
+// NotebookTabFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func NotebookTabFromString(s string) (NotebookTab, bool) {
+	switch s {
+	case "GTK_NOTEBOOK_TAB_FIRST", "first":
+		return NotebookTabFirstValue, true
+	case "GTK_NOTEBOOK_TAB_LAST", "last":
+		return NotebookTabLastValue, true
+	}
+	return 0, false
+}
+
 // Switches between children using tabs.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="notebook-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkNotebook" src="notebook.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // There are many configuration options for `GtkNotebook`. Among
 // other things, you can choose on which edge the tabs appear
-// (see [method@Gtk.Notebook.set_tab_pos]), whether, if there are
+// (see [Notebook.SetTabPos]), whether, if there are
 // too many tabs to fit the notebook should be made bigger or scrolling
-// arrows added (see [method@Gtk.Notebook.set_scrollable]), and whether
+// arrows added (see [Notebook.SetScrollable]), and whether
 // there will be a popup menu allowing the users to switch pages.
-// (see [method@Gtk.Notebook.popup_enable]).
+// (see [Notebook.PopupEnable]).
 //
 // # GtkNotebook as GtkBuildable
 //
 // The `GtkNotebook` implementation of the `GtkBuildable` interface
 // supports placing children into tabs by specifying “tab” as the
-// “type” attribute of a `&lt;child&gt;` element. Note that the content
+// “type” attribute of a `<child>` element. Note that the content
 // of the tab must be created before the tab can be filled.
-// A tab child can be specified without specifying a `&lt;child&gt;`
+// A tab child can be specified without specifying a `<child>`
 // type attribute.
 //
 // To add a child widget in the notebooks action area, specify
 // "action-start" or “action-end” as the “type” attribute of the
-// `&lt;child&gt;` element.
+// `<child>` element.
 //
 // An example of a UI definition fragment with `GtkNotebook`:
 //
-// ```xml
-// &lt;object class="GtkNotebook"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="GtkLabel" id="notebook-content"&gt;
-//	    &lt;property name="label"&gt;Content&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child type="tab"&gt;
-//	  &lt;object class="GtkLabel" id="notebook-tab"&gt;
-//	    &lt;property name="label"&gt;Tab&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkNotebook">
+//	  <child>
+//	    <object class="GtkLabel" id="notebook-content">
+//	      <property name="label">Content</property>
+//	    </object>
+//	  </child>
+//	  <child type="tab">
+//	    <object class="GtkLabel" id="notebook-tab">
+//	      <property name="label">Tab</property>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // # Shortcuts and Gestures
 //
 // `GtkNotebook` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
-// - &lt;kbd&gt;Home&lt;/kbd&gt; moves the focus to the first tab.
-// - &lt;kbd&gt;End&lt;/kbd&gt; moves the focus to the last tab.
+// - Shift+F10 or Menu opens the context menu.
+// - Home moves the focus to the first tab.
+// - End moves the focus to the last tab.
 //
 // Additionally, the following signals have default keybindings:
 //
-// - [signal@Gtk.Notebook::change-current-page]
-// - [signal@Gtk.Notebook::focus-tab]
-// - [signal@Gtk.Notebook::move-focus-out]
-// - [signal@Gtk.Notebook::reorder-tab]
-// - [signal@Gtk.Notebook::select-page]
+// - [Notebook.ConnectChangeCurrentPageFunc]
+// - [Notebook.ConnectFocusTabFunc]
+// - [Notebook.ConnectMoveFocusOutFunc]
+// - [Notebook.ConnectReorderTabFunc]
+// - [Notebook.ConnectSelectPageFunc]
 //
 // Tabs support drag-and-drop between notebooks sharing the same `group-name`,
 // or to new windows by handling the `::create-window` signal.
@@ -105,27 +127,23 @@ const (
 //
 // # CSS nodes
 //
-// ```
-// notebook
-// ├── header.top
-// │   ├── [&lt;action widget&gt;]
-// │   ├── tabs
-// │   │   ├── [arrow]
-// │   │   ├── tab
-// │   │   │   ╰── &lt;tab label&gt;
-// ┊   ┊   ┊
-// │   │   ├── tab[.reorderable-page]
-// │   │   │   ╰── &lt;tab label&gt;
-// │   │   ╰── [arrow]
-// │   ╰── [&lt;action widget&gt;]
-// │
-// ╰── stack
-//
-//	├── &lt;child&gt;
-//	┊
-//	╰── &lt;child&gt;
-//
-// ```
+//	notebook
+//	├── header.top
+//	│   ├── [<action widget>]
+//	│   ├── tabs
+//	│   │   ├── [arrow]
+//	│   │   ├── tab
+//	│   │   │   ╰── <tab label>
+//	┊   ┊   ┊
+//	│   │   ├── tab[.reorderable-page]
+//	│   │   │   ╰── <tab label>
+//	│   │   ╰── [arrow]
+//	│   ╰── [<action widget>]
+//	│
+//	╰── stack
+//	    ├── <child>
+//	    ┊
+//	    ╰── <child>
 //
 // `GtkNotebook` has a main CSS node with name `notebook`, a subnode
 // with name `header` and below that a subnode with name `tabs` which
@@ -136,7 +154,7 @@ const (
 // name `arrow` are placed as first and last child of the `tabs` node.
 //
 // The main node gets the `.frame` style class when the notebook
-// has a border (see [method@Gtk.Notebook.set_show_border]).
+// has a border (see [Notebook.SetShowBorder]).
 //
 // The header node gets one of the style class `.top`, `.bottom`,
 // `.left` or `.right`, depending on where the tabs are placed. For
@@ -150,10 +168,10 @@ const (
 //
 // `GtkNotebook` uses the following roles:
 //
-//   - [enum@Gtk.AccessibleRole.group] for the notebook widget
-//   - [enum@Gtk.AccessibleRole.tab_list] for the list of tabs
-//   - [enum@Gtk.AccessibleRole.tab] role for each tab
-//   - [enum@Gtk.AccessibleRole.tab_panel] for each page
+//   - [AccessibleRoleGroupValue] for the notebook widget
+//   - [AccessibleRoleTabListValue] for the list of tabs
+//   - [AccessibleRoleTabValue] role for each tab
+//   - [AccessibleRoleTabPanelValue] for each page
 type Notebook struct {
 	Widget
 }
@@ -189,7 +207,7 @@ func NewNotebook() *Notebook {
 
 var xNotebookAppendPage func(uintptr, uintptr, uintptr) int
 
-// Appends a page to @notebook.
+// Appends a page to notebook.
 func (x *Notebook) AppendPage(ChildVar *Widget, TabLabelVar *Widget) int {
 
 	cret := xNotebookAppendPage(x.GoPointer(), ChildVar.GoPointer(), TabLabelVar.GoPointer())
@@ -198,7 +216,7 @@ func (x *Notebook) AppendPage(ChildVar *Widget, TabLabelVar *Widget) int {
 
 var xNotebookAppendPageMenu func(uintptr, uintptr, uintptr, uintptr) int
 
-// Appends a page to @notebook, specifying the widget to use as the
+// Appends a page to notebook, specifying the widget to use as the
 // label in the popup menu.
 func (x *Notebook) AppendPageMenu(ChildVar *Widget, TabLabelVar *Widget, MenuLabelVar *Widget) int {
 
@@ -210,7 +228,7 @@ var xNotebookDetachTab func(uintptr, uintptr)
 
 // Removes the child from the notebook.
 //
-// This function is very similar to [method@Gtk.Notebook.remove_page],
+// This function is very similar to [Notebook.RemovePage],
 // but additionally informs the notebook that the removal
 // is happening as part of a tab DND operation, which should
 // not be cancelled.
@@ -224,7 +242,7 @@ var xNotebookGetActionWidget func(uintptr, PackType) uintptr
 
 // Gets one of the action widgets.
 //
-// See [method@Gtk.Notebook.set_action_widget].
+// See [Notebook.SetActionWidget].
 func (x *Notebook) GetActionWidget(PackTypeVar PackType) *Widget {
 	var cls *Widget
 
@@ -250,7 +268,7 @@ func (x *Notebook) GetCurrentPage() int {
 
 var xNotebookGetGroupName func(uintptr) string
 
-// Gets the current group name for @notebook.
+// Gets the current group name for notebook.
 func (x *Notebook) GetGroupName() string {
 
 	cret := xNotebookGetGroupName(x.GoPointer())
@@ -259,7 +277,7 @@ func (x *Notebook) GetGroupName() string {
 
 var xNotebookGetMenuLabel func(uintptr, uintptr) uintptr
 
-// Retrieves the menu label widget of the page containing @child.
+// Retrieves the menu label widget of the page containing child.
 func (x *Notebook) GetMenuLabel(ChildVar *Widget) *Widget {
 	var cls *Widget
 
@@ -277,7 +295,7 @@ func (x *Notebook) GetMenuLabel(ChildVar *Widget) *Widget {
 var xNotebookGetMenuLabelText func(uintptr, uintptr) string
 
 // Retrieves the text of the menu label for the page containing
-// @child.
+// child.
 func (x *Notebook) GetMenuLabelText(ChildVar *Widget) string {
 
 	cret := xNotebookGetMenuLabelText(x.GoPointer(), ChildVar.GoPointer())
@@ -295,7 +313,7 @@ func (x *Notebook) GetNPages() int {
 
 var xNotebookGetNthPage func(uintptr, int) uintptr
 
-// Returns the child widget contained in page number @page_num.
+// Returns the child widget contained in page number page_num.
 func (x *Notebook) GetNthPage(PageNumVar int) *Widget {
 	var cls *Widget
 
@@ -312,7 +330,7 @@ func (x *Notebook) GetNthPage(PageNumVar int) *Widget {
 
 var xNotebookGetPage func(uintptr, uintptr) uintptr
 
-// Returns the `GtkNotebookPage` for @child.
+// Returns the `GtkNotebookPage` for child.
 func (x *Notebook) GetPage(ChildVar *Widget) *NotebookPage {
 	var cls *NotebookPage
 
@@ -332,7 +350,7 @@ var xNotebookGetPages func(uintptr) uintptr
 // Returns a `GListModel` that contains the pages of the notebook.
 //
 // This can be used to keep an up-to-date view. The model also
-// implements [iface@Gtk.SelectionModel] and can be used to track
+// implements [SelectionModel] and can be used to track
 // and modify the visible page.
 func (x *Notebook) GetPages() *gio.ListModelBase {
 	var cls *gio.ListModelBase
@@ -376,7 +394,7 @@ func (x *Notebook) GetShowTabs() bool {
 
 var xNotebookGetTabDetachable func(uintptr, uintptr) bool
 
-// Returns whether the tab contents can be detached from @notebook.
+// Returns whether the tab contents can be detached from notebook.
 func (x *Notebook) GetTabDetachable(ChildVar *Widget) bool {
 
 	cret := xNotebookGetTabDetachable(x.GoPointer(), ChildVar.GoPointer())
@@ -385,10 +403,10 @@ func (x *Notebook) GetTabDetachable(ChildVar *Widget) bool {
 
 var xNotebookGetTabLabel func(uintptr, uintptr) uintptr
 
-// Returns the tab label widget for the page @child.
+// Returns the tab label widget for the page child.
 //
-// %NULL is returned if @child is not in @notebook or
-// if no tab label has specifically been set for @child.
+// nil is returned if child is not in notebook or
+// if no tab label has specifically been set for child.
 func (x *Notebook) GetTabLabel(ChildVar *Widget) *Widget {
 	var cls *Widget
 
@@ -406,7 +424,7 @@ func (x *Notebook) GetTabLabel(ChildVar *Widget) *Widget {
 var xNotebookGetTabLabelText func(uintptr, uintptr) string
 
 // Retrieves the text of the tab label for the page containing
-// @child.
+// child.
 func (x *Notebook) GetTabLabelText(ChildVar *Widget) string {
 
 	cret := xNotebookGetTabLabelText(x.GoPointer(), ChildVar.GoPointer())
@@ -433,7 +451,7 @@ func (x *Notebook) GetTabReorderable(ChildVar *Widget) bool {
 
 var xNotebookInsertPage func(uintptr, uintptr, uintptr, int) int
 
-// Insert a page into @notebook at the given position.
+// Insert a page into notebook at the given position.
 func (x *Notebook) InsertPage(ChildVar *Widget, TabLabelVar *Widget, PositionVar int) int {
 
 	cret := xNotebookInsertPage(x.GoPointer(), ChildVar.GoPointer(), TabLabelVar.GoPointer(), PositionVar)
@@ -442,7 +460,7 @@ func (x *Notebook) InsertPage(ChildVar *Widget, TabLabelVar *Widget, PositionVar
 
 var xNotebookInsertPageMenu func(uintptr, uintptr, uintptr, uintptr, int) int
 
-// Insert a page into @notebook at the given position, specifying
+// Insert a page into notebook at the given position, specifying
 // the widget to use as the label in the popup menu.
 func (x *Notebook) InsertPageMenu(ChildVar *Widget, TabLabelVar *Widget, MenuLabelVar *Widget, PositionVar int) int {
 
@@ -494,7 +512,7 @@ func (x *Notebook) PopupEnable() {
 
 var xNotebookPrependPage func(uintptr, uintptr, uintptr) int
 
-// Prepends a page to @notebook.
+// Prepends a page to notebook.
 func (x *Notebook) PrependPage(ChildVar *Widget, TabLabelVar *Widget) int {
 
 	cret := xNotebookPrependPage(x.GoPointer(), ChildVar.GoPointer(), TabLabelVar.GoPointer())
@@ -503,7 +521,7 @@ func (x *Notebook) PrependPage(ChildVar *Widget, TabLabelVar *Widget) int {
 
 var xNotebookPrependPageMenu func(uintptr, uintptr, uintptr, uintptr) int
 
-// Prepends a page to @notebook, specifying the widget to use as the
+// Prepends a page to notebook, specifying the widget to use as the
 // label in the popup menu.
 func (x *Notebook) PrependPageMenu(ChildVar *Widget, TabLabelVar *Widget, MenuLabelVar *Widget) int {
 
@@ -534,11 +552,11 @@ func (x *Notebook) RemovePage(PageNumVar int) {
 
 var xNotebookReorderChild func(uintptr, uintptr, int)
 
-// Reorders the page containing @child, so that it appears in position
-// @position.
+// Reorders the page containing child, so that it appears in position
+// position.
 //
-// If @position is greater than or equal to the number of children in
-// the list or negative, @child will be moved to the end of the list.
+// If position is greater than or equal to the number of children in
+// the list or negative, child will be moved to the end of the list.
 func (x *Notebook) ReorderChild(ChildVar *Widget, PositionVar int) {
 
 	xNotebookReorderChild(x.GoPointer(), ChildVar.GoPointer(), PositionVar)
@@ -547,7 +565,7 @@ func (x *Notebook) ReorderChild(ChildVar *Widget, PositionVar int) {
 
 var xNotebookSetActionWidget func(uintptr, uintptr, PackType)
 
-// Sets @widget as one of the action widgets.
+// Sets widget as one of the action widgets.
 //
 // Depending on the pack type the widget will be placed before
 // or after the tabs. You can use a `GtkBox` if you need to pack
@@ -560,7 +578,7 @@ func (x *Notebook) SetActionWidget(WidgetVar *Widget, PackTypeVar PackType) {
 
 var xNotebookSetCurrentPage func(uintptr, int)
 
-// Switches to the page number @page_num.
+// Switches to the page number page_num.
 //
 // Note that due to historical reasons, GtkNotebook refuses
 // to switch to a page unless the child widget is visible.
@@ -574,10 +592,10 @@ func (x *Notebook) SetCurrentPage(PageNumVar int) {
 
 var xNotebookSetGroupName func(uintptr, uintptr)
 
-// Sets a group name for @notebook.
+// Sets a group name for notebook.
 //
 // Notebooks with the same name will be able to exchange tabs
-// via drag and drop. A notebook with a %NULL group name will
+// via drag and drop. A notebook with a nil group name will
 // not be able to exchange tabs with any other notebook.
 func (x *Notebook) SetGroupName(GroupNameVar *string) {
 
@@ -590,7 +608,7 @@ func (x *Notebook) SetGroupName(GroupNameVar *string) {
 
 var xNotebookSetMenuLabel func(uintptr, uintptr, uintptr)
 
-// Changes the menu label for the page containing @child.
+// Changes the menu label for the page containing child.
 func (x *Notebook) SetMenuLabel(ChildVar *Widget, MenuLabelVar *Widget) {
 
 	xNotebookSetMenuLabel(x.GoPointer(), ChildVar.GoPointer(), MenuLabelVar.GoPointer())
@@ -599,7 +617,7 @@ func (x *Notebook) SetMenuLabel(ChildVar *Widget, MenuLabelVar *Widget) {
 
 var xNotebookSetMenuLabelText func(uintptr, uintptr, string)
 
-// Creates a new label and sets it as the menu label of @child.
+// Creates a new label and sets it as the menu label of child.
 func (x *Notebook) SetMenuLabelText(ChildVar *Widget, MenuTextVar string) {
 
 	xNotebookSetMenuLabelText(x.GoPointer(), ChildVar.GoPointer(), MenuTextVar)
@@ -638,36 +656,33 @@ func (x *Notebook) SetShowTabs(ShowTabsVar bool) {
 
 var xNotebookSetTabDetachable func(uintptr, uintptr, bool)
 
-// Sets whether the tab can be detached from @notebook to another
+// Sets whether the tab can be detached from notebook to another
 // notebook or widget.
 //
 // Note that two notebooks must share a common group identifier
-// (see [method@Gtk.Notebook.set_group_name]) to allow automatic tabs
+// (see [Notebook.SetGroupName]) to allow automatic tabs
 // interchange between them.
 //
 // If you want a widget to interact with a notebook through DnD
 // (i.e.: accept dragged tabs from it) it must be set as a drop
-// destination by adding to it a [class@Gtk.DropTarget] controller that accepts
+// destination by adding to it a [DropTarget] controller that accepts
 // the GType `GTK_TYPE_NOTEBOOK_PAGE`. The `:value` of said drop target will be
-// preloaded with a [class@Gtk.NotebookPage] object that corresponds to the
+// preloaded with a [NotebookPage] object that corresponds to the
 // dropped tab, so you can process the value via `::accept` or `::drop` signals.
 //
-// Note that you should use [method@Gtk.Notebook.detach_tab] instead
-// of [method@Gtk.Notebook.remove_page] if you want to remove the tab
+// Note that you should use [Notebook.DetachTab] instead
+// of [Notebook.RemovePage] if you want to remove the tab
 // from the source notebook as part of accepting a drop. Otherwise,
 // the source notebook will think that the dragged tab was removed
 // from underneath the ongoing drag operation, and will initiate a
 // drag cancel animation.
 //
-// ```c
-// static void
-// on_drag_data_received (GtkWidget        *widget,
-//
-//	GdkDrop          *drop,
-//	GtkSelectionData *data,
-//	guint             time,
-//	gpointer          user_data)
-//
+//	static void
+//	on_drag_data_received (GtkWidget        *widget,
+//	                       GdkDrop          *drop,
+//	                       GtkSelectionData *data,
+//	                       guint             time,
+//	                       gpointer          user_data)
 //	{
 //	  GtkDrag *drag;
 //	  GtkWidget *notebook;
@@ -682,8 +697,6 @@ var xNotebookSetTabDetachable func(uintptr, uintptr, bool)
 //	  gtk_notebook_detach_tab (GTK_NOTEBOOK (notebook), *child);
 //	}
 //
-// ```
-//
 // If you want a notebook to accept drags from other widgets,
 // you will have to set your own DnD code to do it.
 func (x *Notebook) SetTabDetachable(ChildVar *Widget, DetachableVar bool) {
@@ -694,9 +707,9 @@ func (x *Notebook) SetTabDetachable(ChildVar *Widget, DetachableVar bool) {
 
 var xNotebookSetTabLabel func(uintptr, uintptr, uintptr)
 
-// Changes the tab label for @child.
+// Changes the tab label for child.
 //
-// If %NULL is specified for @tab_label, then the page will
+// If nil is specified for tab_label, then the page will
 // have the label “page N”.
 func (x *Notebook) SetTabLabel(ChildVar *Widget, TabLabelVar *Widget) {
 
@@ -707,7 +720,7 @@ func (x *Notebook) SetTabLabel(ChildVar *Widget, TabLabelVar *Widget) {
 var xNotebookSetTabLabelText func(uintptr, uintptr, string)
 
 // Creates a new label and sets it as the tab label for the page
-// containing @child.
+// containing child.
 func (x *Notebook) SetTabLabelText(ChildVar *Widget, TabTextVar string) {
 
 	xNotebookSetTabLabelText(x.GoPointer(), ChildVar.GoPointer(), TabTextVar)
@@ -745,7 +758,7 @@ func (c *Notebook) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyEnablePopup sets the "enable-popup" property.
-// If %TRUE, pressing the right mouse button on the notebook shows a page switching menu.
+// If true, pressing the right mouse button on the notebook shows a page switching menu.
 func (x *Notebook) SetPropertyEnablePopup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -754,7 +767,7 @@ func (x *Notebook) SetPropertyEnablePopup(value bool) {
 }
 
 // GetPropertyEnablePopup gets the "enable-popup" property.
-// If %TRUE, pressing the right mouse button on the notebook shows a page switching menu.
+// If true, pressing the right mouse button on the notebook shows a page switching menu.
 func (x *Notebook) GetPropertyEnablePopup() bool {
 	var v gobject.Value
 	x.GetProperty("enable-popup", &v)
@@ -796,7 +809,7 @@ func (x *Notebook) GetPropertyPage() int {
 }
 
 // SetPropertyScrollable sets the "scrollable" property.
-// If %TRUE, scroll arrows are added if there are too many pages to fit.
+// If true, scroll arrows are added if there are too many pages to fit.
 func (x *Notebook) SetPropertyScrollable(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -805,7 +818,7 @@ func (x *Notebook) SetPropertyScrollable(value bool) {
 }
 
 // GetPropertyScrollable gets the "scrollable" property.
-// If %TRUE, scroll arrows are added if there are too many pages to fit.
+// If true, scroll arrows are added if there are too many pages to fit.
 func (x *Notebook) GetPropertyScrollable() bool {
 	var v gobject.Value
 	x.GetProperty("scrollable", &v)
@@ -849,30 +862,52 @@ func (x *Notebook) GetPropertyShowTabs() bool {
 // Emitted when the current page should be changed.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;PgUp&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;PgDn&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgUp&lt;/kbd&gt; and &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgDn&lt;/kbd&gt;.
+// Ctrl+Alt+PgUp,
+// Ctrl+Alt+PgDn,
+// Ctrl+PgUp and Ctrl+PgDn.
+//
+// Deprecated: use ConnectChangeCurrentPageFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectChangeCurrentPage(cb *func(Notebook, int) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-current-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp int) bool {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PageVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-current-page", cbRefPtr, cbPtr)
+}
+
+var xNotebookChangeCurrentPageTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PageVarp int) bool {
+func xNotebookChangeCurrentPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp int, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, int) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PageVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-current-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeCurrentPageFunc connects cb to the "change-current-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectChangeCurrentPageFunc(cb func(Notebook, int) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-current-page", &xNotebookChangeCurrentPageTrampoline, xNotebookChangeCurrentPageNewTrampoline, cb)
 }
 
 // The ::create-window signal is emitted when a detachable
@@ -883,237 +918,436 @@ func (x *Notebook) ConnectChangeCurrentPage(cb *func(Notebook, int) bool) uint {
 // responsible for moving/resizing the window and adding the
 // necessary properties to the notebook (e.g. the
 // `GtkNotebook`:group-name ).
+//
+// Deprecated: use ConnectCreateWindowFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectCreateWindow(cb *func(Notebook, uintptr) Notebook) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "create-window", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			CreateWindowCls := cbFn(fa, PageVarp)
+			return CreateWindowCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "create-window", cbRefPtr, cbPtr)
+}
+
+var xNotebookCreateWindowTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr) uintptr {
+func xNotebookCreateWindowNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, uintptr) Notebook)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		CreateWindowCls := cbFn(fa, PageVarp)
 		return CreateWindowCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "create-window", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCreateWindowFunc connects cb to the "create-window" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectCreateWindowFunc(cb func(Notebook, uintptr) Notebook) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "create-window", &xNotebookCreateWindowTrampoline, xNotebookCreateWindowNewTrampoline, cb)
 }
 
 // Emitted when a tab should be focused.
+//
+// Deprecated: use ConnectFocusTabFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectFocusTab(cb *func(Notebook, NotebookTab) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "focus-tab", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TabVarp NotebookTab) bool {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, TabVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "focus-tab", cbRefPtr, cbPtr)
+}
+
+var xNotebookFocusTabTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TabVarp NotebookTab) bool {
+func xNotebookFocusTabNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TabVarp NotebookTab, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, NotebookTab) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, TabVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "focus-tab", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectFocusTabFunc connects cb to the "focus-tab" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectFocusTabFunc(cb func(Notebook, NotebookTab) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "focus-tab", &xNotebookFocusTabTrampoline, xNotebookFocusTabNewTrampoline, cb)
 }
 
 // Emitted when focus was moved out.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt; and &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt;.
+// Ctrl+Tab,
+// Ctrl+Shift+Tab,
+// Ctrl+←, Ctrl+→,
+// Ctrl+↑ and Ctrl+↓.
+//
+// Deprecated: use ConnectMoveFocusOutFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectMoveFocusOut(cb *func(Notebook, DirectionType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus-out", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp DirectionType) {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DirectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-focus-out", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DirectionVarp DirectionType) {
+var xNotebookMoveFocusOutTrampoline uintptr
+
+func xNotebookMoveFocusOutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp DirectionType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, DirectionType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DirectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus-out", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveFocusOutFunc connects cb to the "move-focus-out" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectMoveFocusOutFunc(cb func(Notebook, DirectionType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-focus-out", &xNotebookMoveFocusOutTrampoline, xNotebookMoveFocusOutNewTrampoline, cb)
 }
 
 // the ::page-added signal is emitted in the notebook
 // right after a page is added to the notebook.
+//
+// Deprecated: use ConnectPageAddedFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectPageAdded(cb *func(Notebook, uintptr, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChildVarp, PageNumVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-added", cbRefPtr, cbPtr)
+}
+
+var xNotebookPageAddedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+func xNotebookPageAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, uintptr, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChildVarp, PageNumVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPageAddedFunc connects cb to the "page-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectPageAddedFunc(cb func(Notebook, uintptr, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-added", &xNotebookPageAddedTrampoline, xNotebookPageAddedNewTrampoline, cb)
 }
 
 // the ::page-removed signal is emitted in the notebook
 // right after a page is removed from the notebook.
+//
+// Deprecated: use ConnectPageRemovedFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectPageRemoved(cb *func(Notebook, uintptr, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChildVarp, PageNumVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-removed", cbRefPtr, cbPtr)
+}
+
+var xNotebookPageRemovedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+func xNotebookPageRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, uintptr, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChildVarp, PageNumVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPageRemovedFunc connects cb to the "page-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectPageRemovedFunc(cb func(Notebook, uintptr, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-removed", &xNotebookPageRemovedTrampoline, xNotebookPageRemovedNewTrampoline, cb)
 }
 
 // the ::page-reordered signal is emitted in the notebook
 // right after a page has been reordered.
+//
+// Deprecated: use ConnectPageReorderedFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectPageReordered(cb *func(Notebook, uintptr, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "page-reordered", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChildVarp, PageNumVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "page-reordered", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint) {
+var xNotebookPageReorderedTrampoline uintptr
+
+func xNotebookPageReorderedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChildVarp uintptr, PageNumVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, uintptr, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChildVarp, PageNumVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "page-reordered", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPageReorderedFunc connects cb to the "page-reordered" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectPageReorderedFunc(cb func(Notebook, uintptr, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "page-reordered", &xNotebookPageReorderedTrampoline, xNotebookPageReorderedNewTrampoline, cb)
 }
 
 // Emitted when the tab should be reordered.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;Home&lt;/kbd&gt;, &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;End&lt;/kbd&gt;,
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;PgUp&lt;/kbd&gt;, &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;PgDn&lt;/kbd&gt;,
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt;,
-// &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt; and &lt;kbd&gt;Alt&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt;.
+// Alt+Home, Alt+End,
+// Alt+PgUp, Alt+PgDn,
+// Alt+←, Alt+→,
+// Alt+↑ and Alt+↓.
+//
+// Deprecated: use ConnectReorderTabFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectReorderTab(cb *func(Notebook, DirectionType, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "reorder-tab", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp DirectionType, MoveToLastVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DirectionVarp, MoveToLastVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "reorder-tab", cbRefPtr, cbPtr)
+}
+
+var xNotebookReorderTabTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DirectionVarp DirectionType, MoveToLastVarp bool) bool {
+func xNotebookReorderTabNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp DirectionType, MoveToLastVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, DirectionType, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DirectionVarp, MoveToLastVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "reorder-tab", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectReorderTabFunc connects cb to the "reorder-tab" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectReorderTabFunc(cb func(Notebook, DirectionType, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "reorder-tab", &xNotebookReorderTabTrampoline, xNotebookReorderTabNewTrampoline, cb)
 }
 
 // Emitted when a page should be selected.
 //
-// The default binding for this signal is &lt;kbd&gt;␣&lt;/kbd&gt;.
+// The default binding for this signal is ␣.
+//
+// Deprecated: use ConnectSelectPageFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectSelectPage(cb *func(Notebook, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "select-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MoveFocusVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, MoveFocusVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "select-page", cbRefPtr, cbPtr)
+}
+
+var xNotebookSelectPageTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MoveFocusVarp bool) bool {
+func xNotebookSelectPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MoveFocusVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, MoveFocusVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "select-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectPageFunc connects cb to the "select-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectSelectPageFunc(cb func(Notebook, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "select-page", &xNotebookSelectPageTrampoline, xNotebookSelectPageNewTrampoline, cb)
 }
 
 // Emitted when the user or a function changes the current page.
+//
+// Deprecated: use ConnectSwitchPageFunc, which also accepts method values and closures.
 func (x *Notebook) ConnectSwitchPage(cb *func(Notebook, uintptr, uint)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "switch-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PageVarp uintptr, PageNumVarp uint) {
+			defer core.RecoverPanic()
+			fa := Notebook{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PageVarp, PageNumVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "switch-page", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PageVarp uintptr, PageNumVarp uint) {
+var xNotebookSwitchPageTrampoline uintptr
+
+func xNotebookSwitchPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PageVarp uintptr, PageNumVarp uint, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Notebook, uintptr, uint))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Notebook{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PageVarp, PageNumVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "switch-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSwitchPageFunc connects cb to the "switch-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Notebook) ConnectSwitchPageFunc(cb func(Notebook, uintptr, uint)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "switch-page", &xNotebookSwitchPageTrampoline, xNotebookSwitchPageNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1277,7 +1511,7 @@ func (x *Notebook) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1285,14 +1519,13 @@ func (x *Notebook) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Notebook) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1316,19 +1549,17 @@ func (x *Notebook) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Notebook) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1349,7 +1580,7 @@ func (x *Notebook) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1358,14 +1589,12 @@ func (x *Notebook) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Notebook) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1384,10 +1613,10 @@ func (x *Notebook) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Notebook) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1413,7 +1642,7 @@ func NotebookPageNewFromInternalPtr(ptr uintptr) *NotebookPage {
 
 var xNotebookPageGetChild func(uintptr) uintptr
 
-// Returns the notebook child to which @page belongs.
+// Returns the notebook child to which page belongs.
 func (x *NotebookPage) GetChild() *Widget {
 	var cls *Widget
 
@@ -1563,7 +1792,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1574,55 +1803,55 @@ func init() {
 
 	core.PuregoSafeRegister(&xNotebookGLibType, libs, "gtk_notebook_get_type")
 
-	core.PuregoSafeRegister(&xNewNotebook, libs, "gtk_notebook_new")
-
-	core.PuregoSafeRegister(&xNotebookAppendPage, libs, "gtk_notebook_append_page")
-	core.PuregoSafeRegister(&xNotebookAppendPageMenu, libs, "gtk_notebook_append_page_menu")
-	core.PuregoSafeRegister(&xNotebookDetachTab, libs, "gtk_notebook_detach_tab")
-	core.PuregoSafeRegister(&xNotebookGetActionWidget, libs, "gtk_notebook_get_action_widget")
-	core.PuregoSafeRegister(&xNotebookGetCurrentPage, libs, "gtk_notebook_get_current_page")
-	core.PuregoSafeRegister(&xNotebookGetGroupName, libs, "gtk_notebook_get_group_name")
-	core.PuregoSafeRegister(&xNotebookGetMenuLabel, libs, "gtk_notebook_get_menu_label")
-	core.PuregoSafeRegister(&xNotebookGetMenuLabelText, libs, "gtk_notebook_get_menu_label_text")
-	core.PuregoSafeRegister(&xNotebookGetNPages, libs, "gtk_notebook_get_n_pages")
-	core.PuregoSafeRegister(&xNotebookGetNthPage, libs, "gtk_notebook_get_nth_page")
-	core.PuregoSafeRegister(&xNotebookGetPage, libs, "gtk_notebook_get_page")
-	core.PuregoSafeRegister(&xNotebookGetPages, libs, "gtk_notebook_get_pages")
-	core.PuregoSafeRegister(&xNotebookGetScrollable, libs, "gtk_notebook_get_scrollable")
-	core.PuregoSafeRegister(&xNotebookGetShowBorder, libs, "gtk_notebook_get_show_border")
-	core.PuregoSafeRegister(&xNotebookGetShowTabs, libs, "gtk_notebook_get_show_tabs")
-	core.PuregoSafeRegister(&xNotebookGetTabDetachable, libs, "gtk_notebook_get_tab_detachable")
-	core.PuregoSafeRegister(&xNotebookGetTabLabel, libs, "gtk_notebook_get_tab_label")
-	core.PuregoSafeRegister(&xNotebookGetTabLabelText, libs, "gtk_notebook_get_tab_label_text")
-	core.PuregoSafeRegister(&xNotebookGetTabPos, libs, "gtk_notebook_get_tab_pos")
-	core.PuregoSafeRegister(&xNotebookGetTabReorderable, libs, "gtk_notebook_get_tab_reorderable")
-	core.PuregoSafeRegister(&xNotebookInsertPage, libs, "gtk_notebook_insert_page")
-	core.PuregoSafeRegister(&xNotebookInsertPageMenu, libs, "gtk_notebook_insert_page_menu")
-	core.PuregoSafeRegister(&xNotebookNextPage, libs, "gtk_notebook_next_page")
-	core.PuregoSafeRegister(&xNotebookPageNum, libs, "gtk_notebook_page_num")
-	core.PuregoSafeRegister(&xNotebookPopupDisable, libs, "gtk_notebook_popup_disable")
-	core.PuregoSafeRegister(&xNotebookPopupEnable, libs, "gtk_notebook_popup_enable")
-	core.PuregoSafeRegister(&xNotebookPrependPage, libs, "gtk_notebook_prepend_page")
-	core.PuregoSafeRegister(&xNotebookPrependPageMenu, libs, "gtk_notebook_prepend_page_menu")
-	core.PuregoSafeRegister(&xNotebookPrevPage, libs, "gtk_notebook_prev_page")
-	core.PuregoSafeRegister(&xNotebookRemovePage, libs, "gtk_notebook_remove_page")
-	core.PuregoSafeRegister(&xNotebookReorderChild, libs, "gtk_notebook_reorder_child")
-	core.PuregoSafeRegister(&xNotebookSetActionWidget, libs, "gtk_notebook_set_action_widget")
-	core.PuregoSafeRegister(&xNotebookSetCurrentPage, libs, "gtk_notebook_set_current_page")
-	core.PuregoSafeRegister(&xNotebookSetGroupName, libs, "gtk_notebook_set_group_name")
-	core.PuregoSafeRegister(&xNotebookSetMenuLabel, libs, "gtk_notebook_set_menu_label")
-	core.PuregoSafeRegister(&xNotebookSetMenuLabelText, libs, "gtk_notebook_set_menu_label_text")
-	core.PuregoSafeRegister(&xNotebookSetScrollable, libs, "gtk_notebook_set_scrollable")
-	core.PuregoSafeRegister(&xNotebookSetShowBorder, libs, "gtk_notebook_set_show_border")
-	core.PuregoSafeRegister(&xNotebookSetShowTabs, libs, "gtk_notebook_set_show_tabs")
-	core.PuregoSafeRegister(&xNotebookSetTabDetachable, libs, "gtk_notebook_set_tab_detachable")
-	core.PuregoSafeRegister(&xNotebookSetTabLabel, libs, "gtk_notebook_set_tab_label")
-	core.PuregoSafeRegister(&xNotebookSetTabLabelText, libs, "gtk_notebook_set_tab_label_text")
-	core.PuregoSafeRegister(&xNotebookSetTabPos, libs, "gtk_notebook_set_tab_pos")
-	core.PuregoSafeRegister(&xNotebookSetTabReorderable, libs, "gtk_notebook_set_tab_reorderable")
+	core.PuregoRegisterSince(&xNewNotebook, libs, "gtk_notebook_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xNotebookAppendPage, libs, "gtk_notebook_append_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookAppendPageMenu, libs, "gtk_notebook_append_page_menu", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookDetachTab, libs, "gtk_notebook_detach_tab", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetActionWidget, libs, "gtk_notebook_get_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetCurrentPage, libs, "gtk_notebook_get_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetGroupName, libs, "gtk_notebook_get_group_name", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetMenuLabel, libs, "gtk_notebook_get_menu_label", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetMenuLabelText, libs, "gtk_notebook_get_menu_label_text", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetNPages, libs, "gtk_notebook_get_n_pages", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetNthPage, libs, "gtk_notebook_get_nth_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetPage, libs, "gtk_notebook_get_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetPages, libs, "gtk_notebook_get_pages", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetScrollable, libs, "gtk_notebook_get_scrollable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetShowBorder, libs, "gtk_notebook_get_show_border", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetShowTabs, libs, "gtk_notebook_get_show_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetTabDetachable, libs, "gtk_notebook_get_tab_detachable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetTabLabel, libs, "gtk_notebook_get_tab_label", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetTabLabelText, libs, "gtk_notebook_get_tab_label_text", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetTabPos, libs, "gtk_notebook_get_tab_pos", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookGetTabReorderable, libs, "gtk_notebook_get_tab_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookInsertPage, libs, "gtk_notebook_insert_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookInsertPageMenu, libs, "gtk_notebook_insert_page_menu", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookNextPage, libs, "gtk_notebook_next_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPageNum, libs, "gtk_notebook_page_num", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPopupDisable, libs, "gtk_notebook_popup_disable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPopupEnable, libs, "gtk_notebook_popup_enable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPrependPage, libs, "gtk_notebook_prepend_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPrependPageMenu, libs, "gtk_notebook_prepend_page_menu", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookPrevPage, libs, "gtk_notebook_prev_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookRemovePage, libs, "gtk_notebook_remove_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookReorderChild, libs, "gtk_notebook_reorder_child", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetActionWidget, libs, "gtk_notebook_set_action_widget", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetCurrentPage, libs, "gtk_notebook_set_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetGroupName, libs, "gtk_notebook_set_group_name", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetMenuLabel, libs, "gtk_notebook_set_menu_label", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetMenuLabelText, libs, "gtk_notebook_set_menu_label_text", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetScrollable, libs, "gtk_notebook_set_scrollable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetShowBorder, libs, "gtk_notebook_set_show_border", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetShowTabs, libs, "gtk_notebook_set_show_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetTabDetachable, libs, "gtk_notebook_set_tab_detachable", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetTabLabel, libs, "gtk_notebook_set_tab_label", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetTabLabelText, libs, "gtk_notebook_set_tab_label_text", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetTabPos, libs, "gtk_notebook_set_tab_pos", "GTK", "")
+	core.PuregoRegisterSince(&xNotebookSetTabReorderable, libs, "gtk_notebook_set_tab_reorderable", "GTK", "")
 
 	core.PuregoSafeRegister(&xNotebookPageGLibType, libs, "gtk_notebook_page_get_type")
 
-	core.PuregoSafeRegister(&xNotebookPageGetChild, libs, "gtk_notebook_page_get_child")
+	core.PuregoRegisterSince(&xNotebookPageGetChild, libs, "gtk_notebook_page_get_child", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -24,7 +23,7 @@ func (x *NumericSorterClass) GoPointer() uintptr {
 // Sorts items numerically.
 //
 // To obtain the numbers to compare, this sorter evaluates a
-// [class@Gtk.Expression].
+// [Expression].
 type NumericSorter struct {
 	Sorter
 }
@@ -43,10 +42,10 @@ func NumericSorterNewFromInternalPtr(ptr uintptr) *NumericSorter {
 
 var xNewNumericSorter func(uintptr) uintptr
 
-// Creates a new numeric sorter using the given @expression.
+// Creates a new numeric sorter using the given expression.
 //
 // Smaller numbers will be sorted first. You can call
-// [method@Gtk.NumericSorter.set_sort_order] to change this.
+// [NumericSorter.SetSortOrder] to change this.
 func NewNumericSorter(ExpressionVar *Expression) *NumericSorter {
 	var cls *NumericSorter
 
@@ -90,11 +89,11 @@ var xNumericSorterSetExpression func(uintptr, uintptr)
 
 // Sets the expression that is evaluated to obtain numbers from items.
 //
-// Unless an expression is set on @self, the sorter will always
+// Unless an expression is set on self, the sorter will always
 // compare items as invalid.
 //
 // The expression must have a return type that can be compared
-// numerically, such as %G_TYPE_INT or %G_TYPE_DOUBLE.
+// numerically, such as G_TYPE_INT or G_TYPE_DOUBLE.
 func (x *NumericSorter) SetExpression(ExpressionVar *Expression) {
 
 	xNumericSorterSetExpression(x.GoPointer(), ExpressionVar.GoPointer())
@@ -126,7 +125,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -135,11 +134,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xNumericSorterGLibType, libs, "gtk_numeric_sorter_get_type")
 
-	core.PuregoSafeRegister(&xNewNumericSorter, libs, "gtk_numeric_sorter_new")
+	gobject.CheckClassSize("GtkNumericSorterClass", xNumericSorterGLibType, unsafe.Sizeof(NumericSorterClass{}))
 
-	core.PuregoSafeRegister(&xNumericSorterGetExpression, libs, "gtk_numeric_sorter_get_expression")
-	core.PuregoSafeRegister(&xNumericSorterGetSortOrder, libs, "gtk_numeric_sorter_get_sort_order")
-	core.PuregoSafeRegister(&xNumericSorterSetExpression, libs, "gtk_numeric_sorter_set_expression")
-	core.PuregoSafeRegister(&xNumericSorterSetSortOrder, libs, "gtk_numeric_sorter_set_sort_order")
+	core.PuregoRegisterSince(&xNewNumericSorter, libs, "gtk_numeric_sorter_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xNumericSorterGetExpression, libs, "gtk_numeric_sorter_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xNumericSorterGetSortOrder, libs, "gtk_numeric_sorter_get_sort_order", "GTK", "")
+	core.PuregoRegisterSince(&xNumericSorterSetExpression, libs, "gtk_numeric_sorter_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xNumericSorterSetSortOrder, libs, "gtk_numeric_sorter_set_sort_order", "GTK", "")
 
 }
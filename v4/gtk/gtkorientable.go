@@ -5,15 +5,15 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 type OrientableIface struct {
 	_ structs.HostLayout
 
-	BaseIface uintptr
+	BaseIface gobject.TypeInterface
 }
 
 func (x *OrientableIface) GoPointer() uintptr {
@@ -25,11 +25,11 @@ func (x *OrientableIface) GoPointer() uintptr {
 // `GtkOrientable` is more flexible in that it allows the orientation to be
 // changed at runtime, allowing the widgets to “flip”.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkWidget` types implementing the `GtkOrientable` interface will
 // automatically acquire the `horizontal` or `vertical` CSS class depending on
-// the value of the [property@Gtk.Orientable:orientation] property.
+// the value of the Orientable:orientation property.
 type Orientable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -58,14 +58,14 @@ func (x *OrientableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *OrientableBase) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *OrientableBase) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -80,7 +80,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -89,7 +89,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xOrientableGLibType, libs, "gtk_orientable_get_type")
 
-	core.PuregoSafeRegister(&XGtkOrientableGetOrientation, libs, "gtk_orientable_get_orientation")
-	core.PuregoSafeRegister(&XGtkOrientableSetOrientation, libs, "gtk_orientable_set_orientation")
+	gobject.CheckClassSize("GtkOrientableIface", xOrientableGLibType, unsafe.Sizeof(OrientableIface{}))
+
+	core.PuregoRegisterSince(&XGtkOrientableGetOrientation, libs, "gtk_orientable_get_orientation", "GTK", "")
+	core.PuregoRegisterSince(&XGtkOrientableSetOrientation, libs, "gtk_orientable_set_orientation", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,24 +12,17 @@ import (
 
 // Places “overlay” widgets on top of a single main child.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="overlay-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkOverlay" src="overlay.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The position of each overlay widget is determined by its
-// [property@Gtk.Widget:halign] and [property@Gtk.Widget:valign]
-// properties. E.g. a widget with both alignments set to %GTK_ALIGN_START
+// Widget:halign and Widget:valign
+// properties. E.g. a widget with both alignments set to [AlignStartValue]
 // will be placed at the top left corner of the `GtkOverlay` container,
-// whereas an overlay with halign set to %GTK_ALIGN_CENTER and valign set
-// to %GTK_ALIGN_END will be placed a the bottom edge of the `GtkOverlay`,
+// whereas an overlay with halign set to [AlignCenterValue] and valign set
+// to [AlignEndValue] will be placed a the bottom edge of the `GtkOverlay`,
 // horizontally centered. The position can be adjusted by setting the margin
 // properties of the child to non-zero values.
 //
 // More complicated placement of overlays is possible by connecting
-// to the [signal@Gtk.Overlay::get-child-position] signal.
+// to the [Overlay.ConnectGetChildPositionFunc] signal.
 //
 // An overlay’s minimum and natural sizes are those of its main child.
 // The sizes of overlay children are not considered when measuring these
@@ -40,7 +32,7 @@ import (
 //
 // The `GtkOverlay` implementation of the `GtkBuildable` interface
 // supports placing a child as an overlay by specifying “overlay” as
-// the “type” attribute of a `&lt;child&gt;` element.
+// the “type” attribute of a `<child>` element.
 //
 // # CSS nodes
 //
@@ -82,14 +74,14 @@ func NewOverlay() *Overlay {
 
 var xOverlayAddOverlay func(uintptr, uintptr)
 
-// Adds @widget to @overlay.
+// Adds widget to overlay.
 //
 // The widget will be stacked on top of the main widget
-// added with [method@Gtk.Overlay.set_child].
+// added with [Overlay.SetChild].
 //
-// The position at which @widget is placed is determined
-// from its [property@Gtk.Widget:halign] and
-// [property@Gtk.Widget:valign] properties.
+// The position at which widget is placed is determined
+// from its Widget:halign and
+// Widget:valign properties.
 func (x *Overlay) AddOverlay(WidgetVar *Widget) {
 
 	xOverlayAddOverlay(x.GoPointer(), WidgetVar.GoPointer())
@@ -98,7 +90,7 @@ func (x *Overlay) AddOverlay(WidgetVar *Widget) {
 
 var xOverlayGetChild func(uintptr) uintptr
 
-// Gets the child widget of @overlay.
+// Gets the child widget of overlay.
 func (x *Overlay) GetChild() *Widget {
 	var cls *Widget
 
@@ -115,7 +107,7 @@ func (x *Overlay) GetChild() *Widget {
 
 var xOverlayGetClipOverlay func(uintptr, uintptr) bool
 
-// Gets whether @widget should be clipped within the parent.
+// Gets whether widget should be clipped within the parent.
 func (x *Overlay) GetClipOverlay(WidgetVar *Widget) bool {
 
 	cret := xOverlayGetClipOverlay(x.GoPointer(), WidgetVar.GoPointer())
@@ -124,8 +116,8 @@ func (x *Overlay) GetClipOverlay(WidgetVar *Widget) bool {
 
 var xOverlayGetMeasureOverlay func(uintptr, uintptr) bool
 
-// Gets whether @widget's size is included in the measurement of
-// @overlay.
+// Gets whether widget's size is included in the measurement of
+// overlay.
 func (x *Overlay) GetMeasureOverlay(WidgetVar *Widget) bool {
 
 	cret := xOverlayGetMeasureOverlay(x.GoPointer(), WidgetVar.GoPointer())
@@ -134,7 +126,7 @@ func (x *Overlay) GetMeasureOverlay(WidgetVar *Widget) bool {
 
 var xOverlayRemoveOverlay func(uintptr, uintptr)
 
-// Removes an overlay that was added with gtk_overlay_add_overlay().
+// Removes an overlay that was added with [Overlay.AddOverlay].
 func (x *Overlay) RemoveOverlay(WidgetVar *Widget) {
 
 	xOverlayRemoveOverlay(x.GoPointer(), WidgetVar.GoPointer())
@@ -143,7 +135,7 @@ func (x *Overlay) RemoveOverlay(WidgetVar *Widget) {
 
 var xOverlaySetChild func(uintptr, uintptr)
 
-// Sets the child widget of @overlay.
+// Sets the child widget of overlay.
 func (x *Overlay) SetChild(ChildVar *Widget) {
 
 	xOverlaySetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -152,7 +144,7 @@ func (x *Overlay) SetChild(ChildVar *Widget) {
 
 var xOverlaySetClipOverlay func(uintptr, uintptr, bool)
 
-// Sets whether @widget should be clipped within the parent.
+// Sets whether widget should be clipped within the parent.
 func (x *Overlay) SetClipOverlay(WidgetVar *Widget, ClipOverlayVar bool) {
 
 	xOverlaySetClipOverlay(x.GoPointer(), WidgetVar.GoPointer(), ClipOverlayVar)
@@ -161,11 +153,11 @@ func (x *Overlay) SetClipOverlay(WidgetVar *Widget, ClipOverlayVar bool) {
 
 var xOverlaySetMeasureOverlay func(uintptr, uintptr, bool)
 
-// Sets whether @widget is included in the measured size of @overlay.
+// Sets whether widget is included in the measured size of overlay.
 //
 // The overlay will request the size of the largest child that has
-// this property set to %TRUE. Children who are not included may
-// be drawn outside of @overlay's allocation if they are too large.
+// this property set to true. Children who are not included may
+// be drawn outside of overlay's allocation if they are too large.
 func (x *Overlay) SetMeasureOverlay(WidgetVar *Widget, MeasureVar bool) {
 
 	xOverlaySetMeasureOverlay(x.GoPointer(), WidgetVar.GoPointer(), MeasureVar)
@@ -186,38 +178,60 @@ func (c *Overlay) SetGoPointer(ptr uintptr) {
 // Emitted to determine the position and size of any overlay
 // child widgets.
 //
-// A handler for this signal should fill @allocation with
-// the desired position and size for @widget, relative to
-// the 'main' child of @overlay.
+// A handler for this signal should fill allocation with
+// the desired position and size for widget, relative to
+// the 'main' child of overlay.
 //
-// The default handler for this signal uses the @widget's
+// The default handler for this signal uses the widget's
 // halign and valign properties to determine the position
 // and gives the widget its natural size (except that an
-// alignment of %GTK_ALIGN_FILL will cause the overlay to
+// alignment of [AlignFillValue] will cause the overlay to
 // be full-width/height). If the main child is a
 // `GtkScrolledWindow`, the overlays are placed relative
 // to its contents.
+//
+// Deprecated: use ConnectGetChildPositionFunc, which also accepts method values and closures.
 func (x *Overlay) ConnectGetChildPosition(cb *func(Overlay, uintptr, *uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "get-child-position", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidgetVarp uintptr, AllocationVarp *uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Overlay{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, WidgetVarp, AllocationVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "get-child-position", cbRefPtr, cbPtr)
+}
+
+var xOverlayGetChildPositionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, WidgetVarp uintptr, AllocationVarp *uintptr) bool {
+func xOverlayGetChildPositionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidgetVarp uintptr, AllocationVarp *uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Overlay, uintptr, *uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Overlay{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, WidgetVarp, AllocationVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "get-child-position", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectGetChildPositionFunc connects cb to the "get-child-position" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Overlay) ConnectGetChildPositionFunc(cb func(Overlay, uintptr, *uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "get-child-position", &xOverlayGetChildPositionTrampoline, xOverlayGetChildPositionNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -381,7 +395,7 @@ func (x *Overlay) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -389,14 +403,13 @@ func (x *Overlay) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Overlay) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -420,19 +433,17 @@ func (x *Overlay) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Overlay) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -453,7 +464,7 @@ func (x *Overlay) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -462,14 +473,12 @@ func (x *Overlay) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Overlay) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -488,10 +497,10 @@ func (x *Overlay) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Overlay) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -503,7 +512,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -512,15 +521,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xOverlayGLibType, libs, "gtk_overlay_get_type")
 
-	core.PuregoSafeRegister(&xNewOverlay, libs, "gtk_overlay_new")
+	core.PuregoRegisterSince(&xNewOverlay, libs, "gtk_overlay_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xOverlayAddOverlay, libs, "gtk_overlay_add_overlay")
-	core.PuregoSafeRegister(&xOverlayGetChild, libs, "gtk_overlay_get_child")
-	core.PuregoSafeRegister(&xOverlayGetClipOverlay, libs, "gtk_overlay_get_clip_overlay")
-	core.PuregoSafeRegister(&xOverlayGetMeasureOverlay, libs, "gtk_overlay_get_measure_overlay")
-	core.PuregoSafeRegister(&xOverlayRemoveOverlay, libs, "gtk_overlay_remove_overlay")
-	core.PuregoSafeRegister(&xOverlaySetChild, libs, "gtk_overlay_set_child")
-	core.PuregoSafeRegister(&xOverlaySetClipOverlay, libs, "gtk_overlay_set_clip_overlay")
-	core.PuregoSafeRegister(&xOverlaySetMeasureOverlay, libs, "gtk_overlay_set_measure_overlay")
+	core.PuregoRegisterSince(&xOverlayAddOverlay, libs, "gtk_overlay_add_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayGetChild, libs, "gtk_overlay_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayGetClipOverlay, libs, "gtk_overlay_get_clip_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayGetMeasureOverlay, libs, "gtk_overlay_get_measure_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayRemoveOverlay, libs, "gtk_overlay_remove_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlaySetChild, libs, "gtk_overlay_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xOverlaySetClipOverlay, libs, "gtk_overlay_set_clip_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlaySetMeasureOverlay, libs, "gtk_overlay_set_measure_overlay", "GTK", "")
 
 }
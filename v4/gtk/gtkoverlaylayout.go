@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -31,7 +30,7 @@ func (x *OverlayLayoutClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// The layout manager used by [class@Gtk.Overlay].
+// The layout manager used by [Overlay].
 //
 // It places widgets as overlays on top of the main child.
 //
@@ -186,7 +185,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -195,13 +194,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xOverlayLayoutGLibType, libs, "gtk_overlay_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewOverlayLayout, libs, "gtk_overlay_layout_new")
+	gobject.CheckClassSize("GtkOverlayLayoutClass", xOverlayLayoutGLibType, unsafe.Sizeof(OverlayLayoutClass{}))
+
+	core.PuregoRegisterSince(&xNewOverlayLayout, libs, "gtk_overlay_layout_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xOverlayLayoutChildGLibType, libs, "gtk_overlay_layout_child_get_type")
 
-	core.PuregoSafeRegister(&xOverlayLayoutChildGetClipOverlay, libs, "gtk_overlay_layout_child_get_clip_overlay")
-	core.PuregoSafeRegister(&xOverlayLayoutChildGetMeasure, libs, "gtk_overlay_layout_child_get_measure")
-	core.PuregoSafeRegister(&xOverlayLayoutChildSetClipOverlay, libs, "gtk_overlay_layout_child_set_clip_overlay")
-	core.PuregoSafeRegister(&xOverlayLayoutChildSetMeasure, libs, "gtk_overlay_layout_child_set_measure")
+	gobject.CheckClassSize("GtkOverlayLayoutChildClass", xOverlayLayoutChildGLibType, unsafe.Sizeof(OverlayLayoutChildClass{}))
+
+	core.PuregoRegisterSince(&xOverlayLayoutChildGetClipOverlay, libs, "gtk_overlay_layout_child_get_clip_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayLayoutChildGetMeasure, libs, "gtk_overlay_layout_child_get_measure", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayLayoutChildSetClipOverlay, libs, "gtk_overlay_layout_child_set_clip_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xOverlayLayoutChildSetMeasure, libs, "gtk_overlay_layout_child_set_measure", "GTK", "")
 
 }
@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -60,6 +60,51 @@ const (
 	PadActionDialValue PadActionType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PadActionType) String() string {
+	switch x {
+	case PadActionButtonValue:
+		return "GTK_PAD_ACTION_BUTTON"
+	case PadActionRingValue:
+		return "GTK_PAD_ACTION_RING"
+	case PadActionStripValue:
+		return "GTK_PAD_ACTION_STRIP"
+	case PadActionDialValue:
+		return "GTK_PAD_ACTION_DIAL"
+	}
+	return fmt.Sprintf("PadActionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PadActionType) Nick() string {
+	switch x {
+	case PadActionButtonValue:
+		return "button"
+	case PadActionRingValue:
+		return "ring"
+	case PadActionStripValue:
+		return "strip"
+	case PadActionDialValue:
+		return "dial"
+	}
+	return ""
+}
+
+// PadActionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PadActionTypeFromString(s string) (PadActionType, bool) {
+	switch s {
+	case "GTK_PAD_ACTION_BUTTON", "button":
+		return PadActionButtonValue, true
+	case "GTK_PAD_ACTION_RING", "ring":
+		return PadActionRingValue, true
+	case "GTK_PAD_ACTION_STRIP", "strip":
+		return PadActionStripValue, true
+	case "GTK_PAD_ACTION_DIAL", "dial":
+		return PadActionDialValue, true
+	}
+	return 0, false
+}
+
 // Handles input from the pads found in drawing tablets.
 //
 // Pads are the collection of buttons and tactile sensors often found around
@@ -67,45 +112,42 @@ const (
 //
 // These buttons and sensors have no implicit meaning, and by default they
 // perform no action. `GtkPadController` is provided to map those to
-// [iface@Gio.Action] objects, thus letting the application give them a more
+// [gio.Action] objects, thus letting the application give them a more
 // semantic meaning.
 //
 // Buttons and sensors are not constrained to triggering a single action,
-// some %GDK_SOURCE_TABLET_PAD devices feature multiple "modes". All these
+// some [gdk.SourceTabletPadValue] devices feature multiple "modes". All these
 // input elements have one current mode, which may determine the final action
 // being triggered.
 //
 // Pad devices often divide buttons and sensors into groups. All elements
 // in a group share the same current mode, but different groups may have
-// different modes. See [method@Gdk.DevicePad.get_n_groups] and
-// [method@Gdk.DevicePad.get_group_n_modes].
+// different modes. See [gdk.DevicePad.GetNGroups] and
+// [gdk.DevicePad.GetGroupNModes].
 //
 // Each of the actions that a given button/strip/ring performs for a given mode
-// is defined by a [struct@Gtk.PadActionEntry]. It contains an action name that
-// will be looked up in the given [iface@Gio.ActionGroup] and activated whenever
+// is defined by a [PadActionEntry]. It contains an action name that
+// will be looked up in the given [gio.ActionGroup] and activated whenever
 // the specified input element and mode are triggered.
 //
 // A simple example of `GtkPadController` usage: Assigning button 1 in all
 // modes and pad devices to an "invert-selection" action:
 //
-// ```c
-//
 //	GtkPadActionEntry *pad_actions[] = {
 //	  { GTK_PAD_ACTION_BUTTON, 1, -1, "Invert selection", "pad-actions.invert-selection" },
 //	  …
 //	};
 //
-// …
-// action_group = g_simple_action_group_new ();
-// action = g_simple_action_new ("pad-actions.invert-selection", NULL);
-// g_signal_connect (action, "activate", on_invert_selection_activated, NULL);
-// g_action_map_add_action (G_ACTION_MAP (action_group), action);
-// …
-// pad_controller = gtk_pad_controller_new (action_group, NULL);
-// ```
+//	…
+//	action_group = g_simple_action_group_new ();
+//	action = g_simple_action_new ("pad-actions.invert-selection", NULL);
+//	g_signal_connect (action, "activate", on_invert_selection_activated, NULL);
+//	g_action_map_add_action (G_ACTION_MAP (action_group), action);
+//	…
+//	pad_controller = gtk_pad_controller_new (action_group, NULL);
 //
 // The actions belonging to rings/strips/dials will be activated with a parameter
-// of type %G_VARIANT_TYPE_DOUBLE bearing the value of the given axis, it
+// of type G_VARIANT_TYPE_DOUBLE bearing the value of the given axis, it
 // is required that those are made stateful and accepting this `GVariantType`.
 // For rings the value is the angle of the ring position in degrees with 0
 // facing up. For strips the value is the absolute position on the strip, normalized
@@ -132,17 +174,17 @@ func PadControllerNewFromInternalPtr(ptr uintptr) *PadController {
 
 var xNewPadController func(uintptr, uintptr) uintptr
 
-// Creates a new `GtkPadController` that will associate events from @pad to
+// Creates a new `GtkPadController` that will associate events from pad to
 // actions.
 //
-// A %NULL pad may be provided so the controller manages all pad devices
+// A nil pad may be provided so the controller manages all pad devices
 // generically, it is discouraged to mix `GtkPadController` objects with
-// %NULL and non-%NULL @pad argument on the same toplevel window, as execution
+// nil and non-nil pad argument on the same toplevel window, as execution
 // order is not guaranteed.
 //
 // The `GtkPadController` is created with no mapped actions. In order to
-// map pad events to actions, use [method@Gtk.PadController.set_action_entries]
-// or [method@Gtk.PadController.set_action].
+// map pad events to actions, use [PadController.SetActionEntries]
+// or [PadController.SetAction].
 //
 // Be aware that pad events will only be delivered to `GtkWindow`s, so adding
 // a pad controller to any other type of widget will not have an effect.
@@ -161,13 +203,13 @@ func NewPadController(GroupVar gio.ActionGroup, PadVar *gdk.Device) *PadControll
 
 var xPadControllerSetAction func(uintptr, PadActionType, int, int, string, string)
 
-// Adds an individual action to @controller.
+// Adds an individual action to controller.
 //
 // This action will only be activated if the given button/ring/strip number
-// in @index is interacted while the current mode is @mode. -1 may be used
+// in index is interacted while the current mode is mode. -1 may be used
 // for simple cases, so the action is triggered on all modes.
 //
-// The given @label should be considered user-visible, so internationalization
+// The given label should be considered user-visible, so internationalization
 // rules apply. Some windowing systems may be able to use those for user
 // feedback.
 func (x *PadController) SetAction(TypeVar PadActionType, IndexVar int, ModeVar int, LabelVar string, ActionNameVar string) {
@@ -179,9 +221,9 @@ func (x *PadController) SetAction(TypeVar PadActionType, IndexVar int, ModeVar i
 var xPadControllerSetActionEntries func(uintptr, []PadActionEntry, int)
 
 // A convenience function to add a group of action entries on
-// @controller.
+// controller.
 //
-// See [struct@Gtk.PadActionEntry] and [method@Gtk.PadController.set_action].
+// See [PadActionEntry] and [PadController.SetAction].
 func (x *PadController) SetActionEntries(EntriesVar []PadActionEntry, NEntriesVar int) {
 
 	xPadControllerSetActionEntries(x.GoPointer(), EntriesVar, NEntriesVar)
@@ -204,7 +246,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -215,9 +257,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xPadControllerGLibType, libs, "gtk_pad_controller_get_type")
 
-	core.PuregoSafeRegister(&xNewPadController, libs, "gtk_pad_controller_new")
+	core.PuregoRegisterSince(&xNewPadController, libs, "gtk_pad_controller_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xPadControllerSetAction, libs, "gtk_pad_controller_set_action")
-	core.PuregoSafeRegister(&xPadControllerSetActionEntries, libs, "gtk_pad_controller_set_action_entries")
+	core.PuregoRegisterSince(&xPadControllerSetAction, libs, "gtk_pad_controller_set_action", "GTK", "")
+	core.PuregoRegisterSince(&xPadControllerSetActionEntries, libs, "gtk_pad_controller_set_action_entries", "GTK", "")
 
 }
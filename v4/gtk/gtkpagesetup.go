@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -17,26 +16,24 @@ import (
 // these affect the actual layout of the page, and thus need to be set
 // long before user prints.
 //
-// ## Margins
+// # Margins
 //
 // The margins specified in this object are the “print margins”, i.e. the
 // parts of the page that the printer cannot print on. These are different
 // from the layout margins that a word processor uses; they are typically
 // used to determine the minimal size for the layout margins.
 //
-// To obtain a `GtkPageSetup` use [ctor@Gtk.PageSetup.new] to get the defaults,
-// or use [func@Gtk.print_run_page_setup_dialog] to show the page setup dialog
+// To obtain a `GtkPageSetup` use [NewPageSetup] to get the defaults,
+// or use [PrintRunPageSetupDialog] to show the page setup dialog
 // and receive the resulting page setup.
 //
-// ## A page setup dialog
+// # A page setup dialog
 //
-// ```c
-// static GtkPrintSettings *settings = NULL;
-// static GtkPageSetup *page_setup = NULL;
-//
-// static void
-// do_page_setup (void)
+//	static GtkPrintSettings *settings = NULL;
+//	static GtkPageSetup *page_setup = NULL;
 //
+//	static void
+//	do_page_setup (void)
 //	{
 //	  GtkPageSetup *new_page_setup;
 //
@@ -51,8 +48,6 @@ import (
 //
 //	  page_setup = new_page_setup;
 //	}
-//
-// ```
 type PageSetup struct {
 	gobject.Object
 }
@@ -87,11 +82,11 @@ func NewPageSetup() *PageSetup {
 
 var xNewPageSetupFromFile func(string, **glib.Error) uintptr
 
-// Reads the page setup from the file @file_name.
+// Reads the page setup from the file file_name.
 //
 // Returns a new `GtkPageSetup` object with the restored
-// page setup, or %NULL if an error occurred.
-// See [method@Gtk.PageSetup.to_file].
+// page setup, or nil if an error occurred.
+// See [PageSetup.ToFile].
 func NewPageSetupFromFile(FileNameVar string) (*PageSetup, error) {
 	var cls *PageSetup
 	var cerr *glib.Error
@@ -99,12 +94,15 @@ func NewPageSetupFromFile(FileNameVar string) (*PageSetup, error) {
 	cret := xNewPageSetupFromFile(FileNameVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PageSetup{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -115,7 +113,7 @@ var xNewPageSetupFromGvariant func(*glib.Variant) uintptr
 // Desrialize a page setup from an a{sv} variant.
 //
 // The variant must be in the format produced by
-// [method@Gtk.PageSetup.to_gvariant].
+// [PageSetup.ToGvariant].
 func NewPageSetupFromGvariant(VariantVar *glib.Variant) *PageSetup {
 	var cls *PageSetup
 
@@ -131,11 +129,11 @@ func NewPageSetupFromGvariant(VariantVar *glib.Variant) *PageSetup {
 
 var xNewPageSetupFromKeyFile func(*glib.KeyFile, uintptr, **glib.Error) uintptr
 
-// Reads the page setup from the group @group_name in the key file
-// @key_file.
+// Reads the page setup from the group group_name in the key file
+// key_file.
 //
 // Returns a new `GtkPageSetup` object with the restored
-// page setup, or %NULL if an error occurred.
+// page setup, or nil if an error occurred.
 func NewPageSetupFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (*PageSetup, error) {
 	var cls *PageSetup
 	var cerr *glib.Error
@@ -146,12 +144,15 @@ func NewPageSetupFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (*P
 	cret := xNewPageSetupFromKeyFile(KeyFileVar, GroupNameVarPtr, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PageSetup{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -175,7 +176,7 @@ func (x *PageSetup) Copy() *PageSetup {
 
 var xPageSetupGetBottomMargin func(uintptr, Unit) float64
 
-// Gets the bottom margin in units of @unit.
+// Gets the bottom margin in units of unit.
 func (x *PageSetup) GetBottomMargin(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetBottomMargin(x.GoPointer(), UnitVar)
@@ -184,7 +185,7 @@ func (x *PageSetup) GetBottomMargin(UnitVar Unit) float64 {
 
 var xPageSetupGetLeftMargin func(uintptr, Unit) float64
 
-// Gets the left margin in units of @unit.
+// Gets the left margin in units of unit.
 func (x *PageSetup) GetLeftMargin(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetLeftMargin(x.GoPointer(), UnitVar)
@@ -202,11 +203,11 @@ func (x *PageSetup) GetOrientation() PageOrientation {
 
 var xPageSetupGetPageHeight func(uintptr, Unit) float64
 
-// Returns the page height in units of @unit.
+// Returns the page height in units of unit.
 //
 // Note that this function takes orientation
 // and margins into consideration.
-// See [method@Gtk.PageSetup.get_paper_height].
+// See [PageSetup.GetPaperHeight].
 func (x *PageSetup) GetPageHeight(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetPageHeight(x.GoPointer(), UnitVar)
@@ -215,11 +216,11 @@ func (x *PageSetup) GetPageHeight(UnitVar Unit) float64 {
 
 var xPageSetupGetPageWidth func(uintptr, Unit) float64
 
-// Returns the page width in units of @unit.
+// Returns the page width in units of unit.
 //
 // Note that this function takes orientation
 // and margins into consideration.
-// See [method@Gtk.PageSetup.get_paper_width].
+// See [PageSetup.GetPaperWidth].
 func (x *PageSetup) GetPageWidth(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetPageWidth(x.GoPointer(), UnitVar)
@@ -228,11 +229,11 @@ func (x *PageSetup) GetPageWidth(UnitVar Unit) float64 {
 
 var xPageSetupGetPaperHeight func(uintptr, Unit) float64
 
-// Returns the paper height in units of @unit.
+// Returns the paper height in units of unit.
 //
 // Note that this function takes orientation,
 // but not margins into consideration.
-// See [method@Gtk.PageSetup.get_page_height].
+// See [PageSetup.GetPageHeight].
 func (x *PageSetup) GetPaperHeight(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetPaperHeight(x.GoPointer(), UnitVar)
@@ -250,11 +251,11 @@ func (x *PageSetup) GetPaperSize() *PaperSize {
 
 var xPageSetupGetPaperWidth func(uintptr, Unit) float64
 
-// Returns the paper width in units of @unit.
+// Returns the paper width in units of unit.
 //
 // Note that this function takes orientation,
 // but not margins into consideration.
-// See [method@Gtk.PageSetup.get_page_width].
+// See [PageSetup.GetPageWidth].
 func (x *PageSetup) GetPaperWidth(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetPaperWidth(x.GoPointer(), UnitVar)
@@ -263,7 +264,7 @@ func (x *PageSetup) GetPaperWidth(UnitVar Unit) float64 {
 
 var xPageSetupGetRightMargin func(uintptr, Unit) float64
 
-// Gets the right margin in units of @unit.
+// Gets the right margin in units of unit.
 func (x *PageSetup) GetRightMargin(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetRightMargin(x.GoPointer(), UnitVar)
@@ -272,7 +273,7 @@ func (x *PageSetup) GetRightMargin(UnitVar Unit) float64 {
 
 var xPageSetupGetTopMargin func(uintptr, Unit) float64
 
-// Gets the top margin in units of @unit.
+// Gets the top margin in units of unit.
 func (x *PageSetup) GetTopMargin(UnitVar Unit) float64 {
 
 	cret := xPageSetupGetTopMargin(x.GoPointer(), UnitVar)
@@ -281,15 +282,15 @@ func (x *PageSetup) GetTopMargin(UnitVar Unit) float64 {
 
 var xPageSetupLoadFile func(uintptr, string, **glib.Error) bool
 
-// Reads the page setup from the file @file_name.
+// Reads the page setup from the file file_name.
 //
-// See [method@Gtk.PageSetup.to_file].
+// See [PageSetup.ToFile].
 func (x *PageSetup) LoadFile(FileNameVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPageSetupLoadFile(x.GoPointer(), FileNameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -297,8 +298,8 @@ func (x *PageSetup) LoadFile(FileNameVar string) (bool, error) {
 
 var xPageSetupLoadKeyFile func(uintptr, *glib.KeyFile, uintptr, **glib.Error) bool
 
-// Reads the page setup from the group @group_name in the key file
-// @key_file.
+// Reads the page setup from the group group_name in the key file
+// key_file.
 func (x *PageSetup) LoadKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (bool, error) {
 	var cerr *glib.Error
 
@@ -307,7 +308,7 @@ func (x *PageSetup) LoadKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string)
 
 	cret := xPageSetupLoadKeyFile(x.GoPointer(), KeyFileVar, GroupNameVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -345,7 +346,7 @@ var xPageSetupSetPaperSize func(uintptr, *PaperSize)
 // Sets the paper size of the `GtkPageSetup` without
 // changing the margins.
 //
-// See [method@Gtk.PageSetup.set_paper_size_and_default_margins].
+// See [PageSetup.SetPaperSizeAndDefaultMargins].
 func (x *PageSetup) SetPaperSize(SizeVar *PaperSize) {
 
 	xPageSetupSetPaperSize(x.GoPointer(), SizeVar)
@@ -382,13 +383,13 @@ func (x *PageSetup) SetTopMargin(MarginVar float64, UnitVar Unit) {
 
 var xPageSetupToFile func(uintptr, string, **glib.Error) bool
 
-// This function saves the information from @setup to @file_name.
+// This function saves the information from setup to file_name.
 func (x *PageSetup) ToFile(FileNameVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPageSetupToFile(x.GoPointer(), FileNameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -405,7 +406,7 @@ func (x *PageSetup) ToGvariant() *glib.Variant {
 
 var xPageSetupToKeyFile func(uintptr, *glib.KeyFile, uintptr)
 
-// This function adds the page setup from @setup to @key_file.
+// This function adds the page setup from setup to key_file.
 func (x *PageSetup) ToKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) {
 
 	GroupNameVarPtr := core.GStrdupNullable(GroupNameVar)
@@ -431,7 +432,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -440,33 +441,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xPageSetupGLibType, libs, "gtk_page_setup_get_type")
 
-	core.PuregoSafeRegister(&xNewPageSetup, libs, "gtk_page_setup_new")
-	core.PuregoSafeRegister(&xNewPageSetupFromFile, libs, "gtk_page_setup_new_from_file")
-	core.PuregoSafeRegister(&xNewPageSetupFromGvariant, libs, "gtk_page_setup_new_from_gvariant")
-	core.PuregoSafeRegister(&xNewPageSetupFromKeyFile, libs, "gtk_page_setup_new_from_key_file")
-
-	core.PuregoSafeRegister(&xPageSetupCopy, libs, "gtk_page_setup_copy")
-	core.PuregoSafeRegister(&xPageSetupGetBottomMargin, libs, "gtk_page_setup_get_bottom_margin")
-	core.PuregoSafeRegister(&xPageSetupGetLeftMargin, libs, "gtk_page_setup_get_left_margin")
-	core.PuregoSafeRegister(&xPageSetupGetOrientation, libs, "gtk_page_setup_get_orientation")
-	core.PuregoSafeRegister(&xPageSetupGetPageHeight, libs, "gtk_page_setup_get_page_height")
-	core.PuregoSafeRegister(&xPageSetupGetPageWidth, libs, "gtk_page_setup_get_page_width")
-	core.PuregoSafeRegister(&xPageSetupGetPaperHeight, libs, "gtk_page_setup_get_paper_height")
-	core.PuregoSafeRegister(&xPageSetupGetPaperSize, libs, "gtk_page_setup_get_paper_size")
-	core.PuregoSafeRegister(&xPageSetupGetPaperWidth, libs, "gtk_page_setup_get_paper_width")
-	core.PuregoSafeRegister(&xPageSetupGetRightMargin, libs, "gtk_page_setup_get_right_margin")
-	core.PuregoSafeRegister(&xPageSetupGetTopMargin, libs, "gtk_page_setup_get_top_margin")
-	core.PuregoSafeRegister(&xPageSetupLoadFile, libs, "gtk_page_setup_load_file")
-	core.PuregoSafeRegister(&xPageSetupLoadKeyFile, libs, "gtk_page_setup_load_key_file")
-	core.PuregoSafeRegister(&xPageSetupSetBottomMargin, libs, "gtk_page_setup_set_bottom_margin")
-	core.PuregoSafeRegister(&xPageSetupSetLeftMargin, libs, "gtk_page_setup_set_left_margin")
-	core.PuregoSafeRegister(&xPageSetupSetOrientation, libs, "gtk_page_setup_set_orientation")
-	core.PuregoSafeRegister(&xPageSetupSetPaperSize, libs, "gtk_page_setup_set_paper_size")
-	core.PuregoSafeRegister(&xPageSetupSetPaperSizeAndDefaultMargins, libs, "gtk_page_setup_set_paper_size_and_default_margins")
-	core.PuregoSafeRegister(&xPageSetupSetRightMargin, libs, "gtk_page_setup_set_right_margin")
-	core.PuregoSafeRegister(&xPageSetupSetTopMargin, libs, "gtk_page_setup_set_top_margin")
-	core.PuregoSafeRegister(&xPageSetupToFile, libs, "gtk_page_setup_to_file")
-	core.PuregoSafeRegister(&xPageSetupToGvariant, libs, "gtk_page_setup_to_gvariant")
-	core.PuregoSafeRegister(&xPageSetupToKeyFile, libs, "gtk_page_setup_to_key_file")
+	core.PuregoRegisterSince(&xNewPageSetup, libs, "gtk_page_setup_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewPageSetupFromFile, libs, "gtk_page_setup_new_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewPageSetupFromGvariant, libs, "gtk_page_setup_new_from_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xNewPageSetupFromKeyFile, libs, "gtk_page_setup_new_from_key_file", "GTK", "")
+
+	core.PuregoRegisterSince(&xPageSetupCopy, libs, "gtk_page_setup_copy", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetBottomMargin, libs, "gtk_page_setup_get_bottom_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetLeftMargin, libs, "gtk_page_setup_get_left_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetOrientation, libs, "gtk_page_setup_get_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetPageHeight, libs, "gtk_page_setup_get_page_height", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetPageWidth, libs, "gtk_page_setup_get_page_width", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetPaperHeight, libs, "gtk_page_setup_get_paper_height", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetPaperSize, libs, "gtk_page_setup_get_paper_size", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetPaperWidth, libs, "gtk_page_setup_get_paper_width", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetRightMargin, libs, "gtk_page_setup_get_right_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupGetTopMargin, libs, "gtk_page_setup_get_top_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupLoadFile, libs, "gtk_page_setup_load_file", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupLoadKeyFile, libs, "gtk_page_setup_load_key_file", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetBottomMargin, libs, "gtk_page_setup_set_bottom_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetLeftMargin, libs, "gtk_page_setup_set_left_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetOrientation, libs, "gtk_page_setup_set_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetPaperSize, libs, "gtk_page_setup_set_paper_size", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetPaperSizeAndDefaultMargins, libs, "gtk_page_setup_set_paper_size_and_default_margins", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetRightMargin, libs, "gtk_page_setup_set_right_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupSetTopMargin, libs, "gtk_page_setup_set_top_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupToFile, libs, "gtk_page_setup_to_file", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupToGvariant, libs, "gtk_page_setup_to_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupToKeyFile, libs, "gtk_page_setup_to_key_file", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,18 +12,11 @@ import (
 // Presents a page setup dialog for platforms which don’t provide
 // a native page setup dialog, like Unix.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="pagesetupdialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPageSetupUnixDialog" src="pagesetupdialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It can be used very much like any other GTK dialog, at the
 // cost of the portability offered by the high-level printing
-// API in [class@Gtk.PrintOperation].
+// API in [PrintOperation].
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkPageSetupUnixDialog` has a single CSS node with the name `window` and
 // style class `.pagesetup`.
@@ -290,7 +282,7 @@ func (x *PageSetupUnixDialog) UpdatePlatformState(StateVar AccessiblePlatformSta
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -298,14 +290,13 @@ func (x *PageSetupUnixDialog) UpdatePlatformState(StateVar AccessiblePlatformSta
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PageSetupUnixDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -329,19 +320,17 @@ func (x *PageSetupUnixDialog) UpdatePropertyValue(NPropertiesVar int, Properties
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PageSetupUnixDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -362,7 +351,7 @@ func (x *PageSetupUnixDialog) UpdateRelationValue(NRelationsVar int, RelationsVa
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -371,14 +360,12 @@ func (x *PageSetupUnixDialog) UpdateRelationValue(NRelationsVar int, RelationsVa
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PageSetupUnixDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -397,10 +384,10 @@ func (x *PageSetupUnixDialog) UpdateStateValue(NStatesVar int, StatesVar []Acces
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PageSetupUnixDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -437,10 +424,10 @@ func (x *PageSetupUnixDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *PageSetupUnixDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -484,7 +471,7 @@ func (x *PageSetupUnixDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *PageSetupUnixDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -500,13 +487,13 @@ func (x *PageSetupUnixDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *PageSetupUnixDialog) SetFocus(FocusVar *Widget) {
 
@@ -519,7 +506,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -528,11 +515,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xPageSetupUnixDialogGLibType, libs, "gtk_page_setup_unix_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewPageSetupUnixDialog, libs, "gtk_page_setup_unix_dialog_new")
+	core.PuregoRegisterSince(&xNewPageSetupUnixDialog, libs, "gtk_page_setup_unix_dialog_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xPageSetupUnixDialogGetPageSetup, libs, "gtk_page_setup_unix_dialog_get_page_setup")
-	core.PuregoSafeRegister(&xPageSetupUnixDialogGetPrintSettings, libs, "gtk_page_setup_unix_dialog_get_print_settings")
-	core.PuregoSafeRegister(&xPageSetupUnixDialogSetPageSetup, libs, "gtk_page_setup_unix_dialog_set_page_setup")
-	core.PuregoSafeRegister(&xPageSetupUnixDialogSetPrintSettings, libs, "gtk_page_setup_unix_dialog_set_print_settings")
+	core.PuregoRegisterSince(&xPageSetupUnixDialogGetPageSetup, libs, "gtk_page_setup_unix_dialog_get_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupUnixDialogGetPrintSettings, libs, "gtk_page_setup_unix_dialog_get_print_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupUnixDialogSetPageSetup, libs, "gtk_page_setup_unix_dialog_set_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPageSetupUnixDialogSetPrintSettings, libs, "gtk_page_setup_unix_dialog_set_print_settings", "GTK", "")
 
 }
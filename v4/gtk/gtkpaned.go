@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,18 +12,11 @@ import (
 
 // Arranges its children in two panes, horizontally or vertically.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="panes-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPaned" src="panes.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The division between the two panes is adjustable by the user
 // by dragging a handle.
 //
 // Child widgets are added to the panes of the widget with
-// [method@Gtk.Paned.set_start_child] and [method@Gtk.Paned.set_end_child].
+// [Paned.SetStartChild] and [Paned.SetEndChild].
 // The division between the two children is set by default from the size
 // requests of the children, but it can be adjusted by the user.
 //
@@ -32,7 +24,7 @@ import (
 // small handle that the user can drag to adjust the division. It does not
 // draw any relief around the children or around the separator. (The space
 // in which the separator is called the gutter.) Often, it is useful to put
-// each child inside a [class@Gtk.Frame] so that the gutter appears as a
+// each child inside a [Frame] so that the gutter appears as a
 // ridge. No separator is drawn if one of the children is missing.
 //
 // Each child has two options that can be set, "resize" and "shrink". If
@@ -44,27 +36,25 @@ import (
 // "resize" is true for both children.
 //
 // The application can set the position of the slider as if it were set
-// by the user, by calling [method@Gtk.Paned.set_position].
+// by the user, by calling [Paned.SetPosition].
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.Paned::accept-position]
-// - [signal@Gtk.Paned::cancel-position]
-// - [signal@Gtk.Paned::cycle-child-focus]
-// - [signal@Gtk.Paned::cycle-handle-focus]
-// - [signal@Gtk.Paned::move-handle]
-// - [signal@Gtk.Paned::toggle-handle-focus]
+// - [Paned.ConnectAcceptPositionFunc]
+// - [Paned.ConnectCancelPositionFunc]
+// - [Paned.ConnectCycleChildFocusFunc]
+// - [Paned.ConnectCycleHandleFocusFunc]
+// - [Paned.ConnectMoveHandleFunc]
+// - [Paned.ConnectToggleHandleFocusFunc]
 //
 // # CSS nodes
 //
-// ```
-// paned
-// ├── &lt;child&gt;
-// ├── separator[.wide]
-// ╰── &lt;child&gt;
-// ```
+//	paned
+//	├── <child>
+//	├── separator[.wide]
+//	╰── <child>
 //
 // `GtkPaned` has a main CSS node with name paned, and a subnode for
 // the separator with name separator. The subnode gets a .wide style
@@ -75,25 +65,23 @@ import (
 // leftmost child, while it will select the rightmost child in
 // RTL layouts.
 //
-// ## Creating a paned widget with minimum sizes.
+// # Creating a paned widget with minimum sizes.
 //
-// ```c
-// GtkWidget *hpaned = gtk_paned_new (GTK_ORIENTATION_HORIZONTAL);
-// GtkWidget *frame1 = gtk_frame_new (NULL);
-// GtkWidget *frame2 = gtk_frame_new (NULL);
+//	GtkWidget *hpaned = gtk_paned_new (GTK_ORIENTATION_HORIZONTAL);
+//	GtkWidget *frame1 = gtk_frame_new (NULL);
+//	GtkWidget *frame2 = gtk_frame_new (NULL);
 //
-// gtk_widget_set_size_request (hpaned, 200, -1);
+//	gtk_widget_set_size_request (hpaned, 200, -1);
 //
-// gtk_paned_set_start_child (GTK_PANED (hpaned), frame1);
-// gtk_paned_set_resize_start_child (GTK_PANED (hpaned), TRUE);
-// gtk_paned_set_shrink_start_child (GTK_PANED (hpaned), FALSE);
-// gtk_widget_set_size_request (frame1, 50, -1);
+//	gtk_paned_set_start_child (GTK_PANED (hpaned), frame1);
+//	gtk_paned_set_resize_start_child (GTK_PANED (hpaned), TRUE);
+//	gtk_paned_set_shrink_start_child (GTK_PANED (hpaned), FALSE);
+//	gtk_widget_set_size_request (frame1, 50, -1);
 //
-// gtk_paned_set_end_child (GTK_PANED (hpaned), frame2);
-// gtk_paned_set_resize_end_child (GTK_PANED (hpaned), FALSE);
-// gtk_paned_set_shrink_end_child (GTK_PANED (hpaned), FALSE);
-// gtk_widget_set_size_request (frame2, 50, -1);
-// ```
+//	gtk_paned_set_end_child (GTK_PANED (hpaned), frame2);
+//	gtk_paned_set_resize_end_child (GTK_PANED (hpaned), FALSE);
+//	gtk_paned_set_shrink_end_child (GTK_PANED (hpaned), FALSE);
+//	gtk_widget_set_size_request (frame2, 50, -1);
 type Paned struct {
 	Widget
 }
@@ -155,7 +143,7 @@ func (x *Paned) GetPosition() int {
 
 var xPanedGetResizeEndChild func(uintptr) bool
 
-// Returns whether the [property@Gtk.Paned:end-child] can be resized.
+// Returns whether the Paned:end-child can be resized.
 func (x *Paned) GetResizeEndChild() bool {
 
 	cret := xPanedGetResizeEndChild(x.GoPointer())
@@ -164,7 +152,7 @@ func (x *Paned) GetResizeEndChild() bool {
 
 var xPanedGetResizeStartChild func(uintptr) bool
 
-// Returns whether the [property@Gtk.Paned:start-child] can be resized.
+// Returns whether the Paned:start-child can be resized.
 func (x *Paned) GetResizeStartChild() bool {
 
 	cret := xPanedGetResizeStartChild(x.GoPointer())
@@ -173,7 +161,7 @@ func (x *Paned) GetResizeStartChild() bool {
 
 var xPanedGetShrinkEndChild func(uintptr) bool
 
-// Returns whether the [property@Gtk.Paned:end-child] can shrink.
+// Returns whether the Paned:end-child can shrink.
 func (x *Paned) GetShrinkEndChild() bool {
 
 	cret := xPanedGetShrinkEndChild(x.GoPointer())
@@ -182,7 +170,7 @@ func (x *Paned) GetShrinkEndChild() bool {
 
 var xPanedGetShrinkStartChild func(uintptr) bool
 
-// Returns whether the [property@Gtk.Paned:start-child] can shrink.
+// Returns whether the Paned:start-child can shrink.
 func (x *Paned) GetShrinkStartChild() bool {
 
 	cret := xPanedGetShrinkStartChild(x.GoPointer())
@@ -217,9 +205,9 @@ func (x *Paned) GetWideHandle() bool {
 
 var xPanedSetEndChild func(uintptr, uintptr)
 
-// Sets the end child of @paned to @child.
+// Sets the end child of paned to child.
 //
-// If @child is `NULL`, the existing child will be removed.
+// If child is `NULL`, the existing child will be removed.
 func (x *Paned) SetEndChild(ChildVar *Widget) {
 
 	xPanedSetEndChild(x.GoPointer(), ChildVar.GoPointer())
@@ -237,7 +225,7 @@ func (x *Paned) SetPosition(PositionVar int) {
 
 var xPanedSetResizeEndChild func(uintptr, bool)
 
-// Sets whether the [property@Gtk.Paned:end-child] can be resized.
+// Sets whether the Paned:end-child can be resized.
 func (x *Paned) SetResizeEndChild(ResizeVar bool) {
 
 	xPanedSetResizeEndChild(x.GoPointer(), ResizeVar)
@@ -246,7 +234,7 @@ func (x *Paned) SetResizeEndChild(ResizeVar bool) {
 
 var xPanedSetResizeStartChild func(uintptr, bool)
 
-// Sets whether the [property@Gtk.Paned:start-child] can be resized.
+// Sets whether the Paned:start-child can be resized.
 func (x *Paned) SetResizeStartChild(ResizeVar bool) {
 
 	xPanedSetResizeStartChild(x.GoPointer(), ResizeVar)
@@ -255,7 +243,7 @@ func (x *Paned) SetResizeStartChild(ResizeVar bool) {
 
 var xPanedSetShrinkEndChild func(uintptr, bool)
 
-// Sets whether the [property@Gtk.Paned:end-child] can shrink.
+// Sets whether the Paned:end-child can shrink.
 func (x *Paned) SetShrinkEndChild(ResizeVar bool) {
 
 	xPanedSetShrinkEndChild(x.GoPointer(), ResizeVar)
@@ -264,7 +252,7 @@ func (x *Paned) SetShrinkEndChild(ResizeVar bool) {
 
 var xPanedSetShrinkStartChild func(uintptr, bool)
 
-// Sets whether the [property@Gtk.Paned:start-child] can shrink.
+// Sets whether the Paned:start-child can shrink.
 func (x *Paned) SetShrinkStartChild(ResizeVar bool) {
 
 	xPanedSetShrinkStartChild(x.GoPointer(), ResizeVar)
@@ -273,9 +261,9 @@ func (x *Paned) SetShrinkStartChild(ResizeVar bool) {
 
 var xPanedSetStartChild func(uintptr, uintptr)
 
-// Sets the start child of @paned to @child.
+// Sets the start child of paned to child.
 //
-// If @child is `NULL`, the existing child will be removed.
+// If child is `NULL`, the existing child will be removed.
 func (x *Paned) SetStartChild(ChildVar *Widget) {
 
 	xPanedSetStartChild(x.GoPointer(), ChildVar.GoPointer())
@@ -303,7 +291,7 @@ func (c *Paned) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyMaxPosition gets the "max-position" property.
-// The largest possible value for the [property@Gtk.Paned:position]
+// The largest possible value for the [Paned.GetPropertyPosition]
 // property.
 //
 // This property is derived from the size and shrinkability
@@ -315,7 +303,7 @@ func (x *Paned) GetPropertyMaxPosition() int {
 }
 
 // GetPropertyMinPosition gets the "min-position" property.
-// The smallest possible value for the [property@Gtk.Paned:position]
+// The smallest possible value for the [Paned.GetPropertyPosition]
 // property.
 //
 // This property is derived from the size and shrinkability
@@ -344,7 +332,7 @@ func (x *Paned) GetPropertyPosition() int {
 }
 
 // SetPropertyPositionSet sets the "position-set" property.
-// Whether the [property@Gtk.Paned:position] property has been set.
+// Whether the [Paned.GetPropertyPosition] property has been set.
 func (x *Paned) SetPropertyPositionSet(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -353,7 +341,7 @@ func (x *Paned) SetPropertyPositionSet(value bool) {
 }
 
 // GetPropertyPositionSet gets the "position-set" property.
-// Whether the [property@Gtk.Paned:position] property has been set.
+// Whether the [Paned.GetPropertyPosition] property has been set.
 func (x *Paned) GetPropertyPositionSet() bool {
 	var v gobject.Value
 	x.GetProperty("position-set", &v)
@@ -440,7 +428,7 @@ func (x *Paned) GetPropertyShrinkStartChild() bool {
 // Whether the `GtkPaned` should provide a stronger visual separation.
 //
 // For example, this could be set when a paned contains two
-// [class@Gtk.Notebook]s, whose tab rows would otherwise merge visually.
+// [Notebook]s, whose tab rows would otherwise merge visually.
 func (x *Paned) SetPropertyWideHandle(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -452,7 +440,7 @@ func (x *Paned) SetPropertyWideHandle(value bool) {
 // Whether the `GtkPaned` should provide a stronger visual separation.
 //
 // For example, this could be set when a paned contains two
-// [class@Gtk.Notebook]s, whose tab rows would otherwise merge visually.
+// [Notebook]s, whose tab rows would otherwise merge visually.
 func (x *Paned) GetPropertyWideHandle() bool {
 	var v gobject.Value
 	x.GetProperty("wide-handle", &v)
@@ -462,31 +450,53 @@ func (x *Paned) GetPropertyWideHandle() bool {
 // Emitted to accept the current position of the handle when
 // moving it using key bindings.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default binding for this signal is Return or
+// Space.
 //
-// The default binding for this signal is &lt;kbd&gt;Return&lt;/kbd&gt; or
-// &lt;kbd&gt;Space&lt;/kbd&gt;.
+// Deprecated: use ConnectAcceptPositionFunc, which also accepts method values and closures.
 func (x *Paned) ConnectAcceptPosition(cb *func(Paned) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "accept-position", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "accept-position", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xPanedAcceptPositionTrampoline uintptr
+
+func xPanedAcceptPositionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "accept-position", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAcceptPositionFunc connects cb to the "accept-position" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectAcceptPositionFunc(cb func(Paned) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "accept-position", &xPanedAcceptPositionTrampoline, xPanedAcceptPositionNewTrampoline, cb)
 }
 
 // Emitted to cancel moving the position of the handle using key
@@ -495,149 +505,259 @@ func (x *Paned) ConnectAcceptPosition(cb *func(Paned) bool) uint {
 // The position of the handle will be reset to the value prior to
 // moving it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;Escape&lt;/kbd&gt;.
+// The default binding for this signal is Escape.
+//
+// Deprecated: use ConnectCancelPositionFunc, which also accepts method values and closures.
 func (x *Paned) ConnectCancelPosition(cb *func(Paned) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cancel-position", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cancel-position", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xPanedCancelPositionTrampoline uintptr
+
+func xPanedCancelPositionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cancel-position", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCancelPositionFunc connects cb to the "cancel-position" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectCancelPositionFunc(cb func(Paned) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cancel-position", &xPanedCancelPositionTrampoline, xPanedCancelPositionNewTrampoline, cb)
 }
 
 // Emitted to cycle the focus between the children of the paned.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding is &lt;kbd&gt;F6&lt;/kbd&gt;.
+// The default binding is F6.
+//
+// Deprecated: use ConnectCycleChildFocusFunc, which also accepts method values and closures.
 func (x *Paned) ConnectCycleChildFocus(cb *func(Paned, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cycle-child-focus", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ReversedVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ReversedVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cycle-child-focus", cbRefPtr, cbPtr)
+}
+
+var xPanedCycleChildFocusTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ReversedVarp bool) bool {
+func xPanedCycleChildFocusNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ReversedVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ReversedVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cycle-child-focus", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCycleChildFocusFunc connects cb to the "cycle-child-focus" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectCycleChildFocusFunc(cb func(Paned, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cycle-child-focus", &xPanedCycleChildFocusTrampoline, xPanedCycleChildFocusNewTrampoline, cb)
 }
 
 // Emitted to cycle whether the paned should grab focus to allow
 // the user to change position of the handle by using key bindings.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;F8&lt;/kbd&gt;.
+// The default binding for this signal is F8.
+//
+// Deprecated: use ConnectCycleHandleFocusFunc, which also accepts method values and closures.
 func (x *Paned) ConnectCycleHandleFocus(cb *func(Paned, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cycle-handle-focus", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ReversedVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ReversedVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cycle-handle-focus", cbRefPtr, cbPtr)
+}
+
+var xPanedCycleHandleFocusTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ReversedVarp bool) bool {
+func xPanedCycleHandleFocusNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ReversedVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ReversedVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cycle-handle-focus", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCycleHandleFocusFunc connects cb to the "cycle-handle-focus" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectCycleHandleFocusFunc(cb func(Paned, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cycle-handle-focus", &xPanedCycleHandleFocusTrampoline, xPanedCycleHandleFocusNewTrampoline, cb)
 }
 
 // Emitted to move the handle with key bindings.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;←&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;↓&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;,
-// &lt;kbd&gt;PgUp&lt;/kbd&gt;, &lt;kbd&gt;PgDn&lt;/kbd&gt;, &lt;kbd&gt;Home&lt;/kbd&gt;, &lt;kbd&gt;End&lt;/kbd&gt;.
+// Ctrl+←, ←,
+// Ctrl+→, →,
+// Ctrl+↑, ↑,
+// Ctrl+↓, ↓,
+// PgUp, PgDn, Home, End.
+//
+// Deprecated: use ConnectMoveHandleFunc, which also accepts method values and closures.
 func (x *Paned) ConnectMoveHandle(cb *func(Paned, ScrollType) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-handle", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ScrollTypeVarp ScrollType) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ScrollTypeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-handle", cbRefPtr, cbPtr)
+}
+
+var xPanedMoveHandleTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ScrollTypeVarp ScrollType) bool {
+func xPanedMoveHandleNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ScrollTypeVarp ScrollType, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned, ScrollType) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ScrollTypeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-handle", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveHandleFunc connects cb to the "move-handle" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectMoveHandleFunc(cb func(Paned, ScrollType) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-handle", &xPanedMoveHandleTrampoline, xPanedMoveHandleNewTrampoline, cb)
 }
 
 // Emitted to accept the current position of the handle and then
 // move focus to the next widget in the focus chain.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default binding is Tab.
 //
-// The default binding is &lt;kbd&gt;Tab&lt;/kbd&gt;.
+// Deprecated: use ConnectToggleHandleFocusFunc, which also accepts method values and closures.
 func (x *Paned) ConnectToggleHandleFocus(cb *func(Paned) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-handle-focus", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Paned{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-handle-focus", cbRefPtr, cbPtr)
+}
+
+var xPanedToggleHandleFocusTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) bool {
+func xPanedToggleHandleFocusNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Paned) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Paned{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-handle-focus", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleHandleFocusFunc connects cb to the "toggle-handle-focus" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Paned) ConnectToggleHandleFocusFunc(cb func(Paned) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-handle-focus", &xPanedToggleHandleFocusTrampoline, xPanedToggleHandleFocusNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -801,7 +921,7 @@ func (x *Paned) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -809,14 +929,13 @@ func (x *Paned) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Paned) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -840,19 +959,17 @@ func (x *Paned) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Paned) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -873,7 +990,7 @@ func (x *Paned) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -882,14 +999,12 @@ func (x *Paned) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Paned) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -908,24 +1023,24 @@ func (x *Paned) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Paned) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Paned) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Paned) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -937,7 +1052,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -946,23 +1061,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xPanedGLibType, libs, "gtk_paned_get_type")
 
-	core.PuregoSafeRegister(&xNewPaned, libs, "gtk_paned_new")
-
-	core.PuregoSafeRegister(&xPanedGetEndChild, libs, "gtk_paned_get_end_child")
-	core.PuregoSafeRegister(&xPanedGetPosition, libs, "gtk_paned_get_position")
-	core.PuregoSafeRegister(&xPanedGetResizeEndChild, libs, "gtk_paned_get_resize_end_child")
-	core.PuregoSafeRegister(&xPanedGetResizeStartChild, libs, "gtk_paned_get_resize_start_child")
-	core.PuregoSafeRegister(&xPanedGetShrinkEndChild, libs, "gtk_paned_get_shrink_end_child")
-	core.PuregoSafeRegister(&xPanedGetShrinkStartChild, libs, "gtk_paned_get_shrink_start_child")
-	core.PuregoSafeRegister(&xPanedGetStartChild, libs, "gtk_paned_get_start_child")
-	core.PuregoSafeRegister(&xPanedGetWideHandle, libs, "gtk_paned_get_wide_handle")
-	core.PuregoSafeRegister(&xPanedSetEndChild, libs, "gtk_paned_set_end_child")
-	core.PuregoSafeRegister(&xPanedSetPosition, libs, "gtk_paned_set_position")
-	core.PuregoSafeRegister(&xPanedSetResizeEndChild, libs, "gtk_paned_set_resize_end_child")
-	core.PuregoSafeRegister(&xPanedSetResizeStartChild, libs, "gtk_paned_set_resize_start_child")
-	core.PuregoSafeRegister(&xPanedSetShrinkEndChild, libs, "gtk_paned_set_shrink_end_child")
-	core.PuregoSafeRegister(&xPanedSetShrinkStartChild, libs, "gtk_paned_set_shrink_start_child")
-	core.PuregoSafeRegister(&xPanedSetStartChild, libs, "gtk_paned_set_start_child")
-	core.PuregoSafeRegister(&xPanedSetWideHandle, libs, "gtk_paned_set_wide_handle")
+	core.PuregoRegisterSince(&xNewPaned, libs, "gtk_paned_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPanedGetEndChild, libs, "gtk_paned_get_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetPosition, libs, "gtk_paned_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetResizeEndChild, libs, "gtk_paned_get_resize_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetResizeStartChild, libs, "gtk_paned_get_resize_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetShrinkEndChild, libs, "gtk_paned_get_shrink_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetShrinkStartChild, libs, "gtk_paned_get_shrink_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetStartChild, libs, "gtk_paned_get_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedGetWideHandle, libs, "gtk_paned_get_wide_handle", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetEndChild, libs, "gtk_paned_set_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetPosition, libs, "gtk_paned_set_position", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetResizeEndChild, libs, "gtk_paned_set_resize_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetResizeStartChild, libs, "gtk_paned_set_resize_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetShrinkEndChild, libs, "gtk_paned_set_shrink_end_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetShrinkStartChild, libs, "gtk_paned_set_shrink_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetStartChild, libs, "gtk_paned_set_start_child", "GTK", "")
+	core.PuregoRegisterSince(&xPanedSetWideHandle, libs, "gtk_paned_set_wide_handle", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -14,7 +13,7 @@ import (
 // `GtkPaperSize` handles paper sizes.
 //
 // It uses the standard called
-// [PWG 5101.1-2002 PWG: Standard for Media Standardized Names](http://www.pwg.org/standards.html)
+// [PWG 5101.1-2002 PWG: Standard for Media Standardized Names]
 // to name the paper sizes (and to get the data for the page sizes).
 // In addition to standard paper sizes, `GtkPaperSize` allows to
 // construct custom paper sizes with arbitrary dimensions.
@@ -22,6 +21,8 @@ import (
 // The `GtkPaperSize` object stores not only the dimensions (width
 // and height) of a paper size and its name, it also provides
 // default print margins.
+//
+// [PWG 5101.1-2002 PWG: Standard for Media Standardized Names]: http://www.pwg.org/standards.html
 type PaperSize struct {
 	_ structs.HostLayout
 }
@@ -39,11 +40,11 @@ func (x *PaperSize) GoPointer() uintptr {
 var xNewPaperSize func(uintptr) *PaperSize
 
 // Creates a new `GtkPaperSize` object by parsing a
-// [PWG 5101.1-2002](ftp://ftp.pwg.org/pub/pwg/candidates/cs-pwgmsn10-20020226-5101.1.pdf)
+// PWG 5101.1-2002
 // paper name.
 //
-// If @name is %NULL, the default paper size is returned,
-// see [func@Gtk.PaperSize.get_default].
+// If name is nil, the default paper size is returned,
+// see [PaperSizeGetDefault].
 func NewPaperSize(NameVar *string) *PaperSize {
 
 	NameVarPtr := core.GStrdupNullable(NameVar)
@@ -68,7 +69,7 @@ var xNewPaperSizeFromGvariant func(*glib.Variant) *PaperSize
 // Deserialize a paper size from a `GVariant`.
 //
 // The `GVariant must be in the format produced by
-// [method@Gtk.PaperSize.to_gvariant].
+// [PaperSize.ToGvariant].
 func NewPaperSizeFromGvariant(VariantVar *glib.Variant) *PaperSize {
 
 	cret := xNewPaperSizeFromGvariant(VariantVar)
@@ -80,8 +81,8 @@ var xNewPaperSizeFromIpp func(string, float64, float64) *PaperSize
 // Creates a new `GtkPaperSize` object by using
 // IPP information.
 //
-// If @ipp_name is not a recognized paper name,
-// @width and @height are used to
+// If ipp_name is not a recognized paper name,
+// width and height are used to
 // construct a custom `GtkPaperSize` object.
 func NewPaperSizeFromIpp(IppNameVar string, WidthVar float64, HeightVar float64) *PaperSize {
 
@@ -91,8 +92,8 @@ func NewPaperSizeFromIpp(IppNameVar string, WidthVar float64, HeightVar float64)
 
 var xNewPaperSizeFromKeyFile func(*glib.KeyFile, uintptr, **glib.Error) *PaperSize
 
-// Reads a paper size from the group @group_name in the key file
-// @key_file.
+// Reads a paper size from the group group_name in the key file
+// key_file.
 func NewPaperSizeFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (*PaperSize, error) {
 	var cerr *glib.Error
 
@@ -101,7 +102,7 @@ func NewPaperSizeFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (*P
 
 	cret := xNewPaperSizeFromKeyFile(KeyFileVar, GroupNameVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -112,8 +113,8 @@ var xNewPaperSizeFromPpd func(string, string, float64, float64) *PaperSize
 // Creates a new `GtkPaperSize` object by using
 // PPD information.
 //
-// If @ppd_name is not a recognized PPD paper name,
-// @ppd_display_name, @width and @height are used to
+// If ppd_name is not a recognized PPD paper name,
+// ppd_display_name, width and height are used to
 // construct a custom `GtkPaperSize` object.
 func NewPaperSizeFromPpd(PpdNameVar string, PpdDisplayNameVar string, WidthVar float64, HeightVar float64) *PaperSize {
 
@@ -187,7 +188,7 @@ func (x *PaperSize) GetDisplayName() string {
 var xPaperSizeGetHeight func(uintptr, Unit) float64
 
 // Gets the paper height of the `GtkPaperSize`, in
-// units of @unit.
+// units of unit.
 func (x *PaperSize) GetHeight(UnitVar Unit) float64 {
 
 	cret := xPaperSizeGetHeight(x.GoPointer(), UnitVar)
@@ -206,7 +207,7 @@ func (x *PaperSize) GetName() string {
 var xPaperSizeGetPpdName func(uintptr) string
 
 // Gets the PPD name of the `GtkPaperSize`, which
-// may be %NULL.
+// may be nil.
 func (x *PaperSize) GetPpdName() string {
 
 	cret := xPaperSizeGetPpdName(x.GoPointer())
@@ -216,7 +217,7 @@ func (x *PaperSize) GetPpdName() string {
 var xPaperSizeGetWidth func(uintptr, Unit) float64
 
 // Gets the paper width of the `GtkPaperSize`, in
-// units of @unit.
+// units of unit.
 func (x *PaperSize) GetWidth(UnitVar Unit) float64 {
 
 	cret := xPaperSizeGetWidth(x.GoPointer(), UnitVar)
@@ -225,7 +226,7 @@ func (x *PaperSize) GetWidth(UnitVar Unit) float64 {
 
 var xPaperSizeIsCustom func(uintptr) bool
 
-// Returns %TRUE if @size is not a standard paper size.
+// Returns true if size is not a standard paper size.
 func (x *PaperSize) IsCustom() bool {
 
 	cret := xPaperSizeIsCustom(x.GoPointer())
@@ -243,7 +244,7 @@ func (x *PaperSize) IsEqual(Size2Var *PaperSize) bool {
 
 var xPaperSizeIsIpp func(uintptr) bool
 
-// Returns %TRUE if @size is an IPP standard paper size.
+// Returns true if size is an IPP standard paper size.
 func (x *PaperSize) IsIpp() bool {
 
 	cret := xPaperSizeIsIpp(x.GoPointer())
@@ -252,7 +253,7 @@ func (x *PaperSize) IsIpp() bool {
 
 var xPaperSizeSetSize func(uintptr, float64, float64, Unit)
 
-// Changes the dimensions of a @size to @width x @height.
+// Changes the dimensions of a size to width x height.
 func (x *PaperSize) SetSize(WidthVar float64, HeightVar float64, UnitVar Unit) {
 
 	xPaperSizeSetSize(x.GoPointer(), WidthVar, HeightVar, UnitVar)
@@ -270,7 +271,7 @@ func (x *PaperSize) ToGvariant() *glib.Variant {
 
 var xPaperSizeToKeyFile func(uintptr, *glib.KeyFile, string)
 
-// This function adds the paper size from @size to @key_file.
+// This function adds the paper size from size to key_file.
 func (x *PaperSize) ToKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar string) {
 
 	xPaperSizeToKeyFile(x.GoPointer(), KeyFileVar, GroupNameVar)
@@ -318,41 +319,41 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xPaperSizeGetDefault, libs, "gtk_paper_size_get_default")
-	core.PuregoSafeRegister(&xPaperSizeGetPaperSizes, libs, "gtk_paper_size_get_paper_sizes")
+	core.PuregoRegisterSince(&xPaperSizeGetDefault, libs, "gtk_paper_size_get_default", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetPaperSizes, libs, "gtk_paper_size_get_paper_sizes", "GTK", "")
 
 	core.PuregoSafeRegister(&xPaperSizeGLibType, libs, "gtk_paper_size_get_type")
 
-	core.PuregoSafeRegister(&xNewPaperSize, libs, "gtk_paper_size_new")
-	core.PuregoSafeRegister(&xNewPaperSizeCustom, libs, "gtk_paper_size_new_custom")
-	core.PuregoSafeRegister(&xNewPaperSizeFromGvariant, libs, "gtk_paper_size_new_from_gvariant")
-	core.PuregoSafeRegister(&xNewPaperSizeFromIpp, libs, "gtk_paper_size_new_from_ipp")
-	core.PuregoSafeRegister(&xNewPaperSizeFromKeyFile, libs, "gtk_paper_size_new_from_key_file")
-	core.PuregoSafeRegister(&xNewPaperSizeFromPpd, libs, "gtk_paper_size_new_from_ppd")
-
-	core.PuregoSafeRegister(&xPaperSizeCopy, libs, "gtk_paper_size_copy")
-	core.PuregoSafeRegister(&xPaperSizeFree, libs, "gtk_paper_size_free")
-	core.PuregoSafeRegister(&xPaperSizeGetDefaultBottomMargin, libs, "gtk_paper_size_get_default_bottom_margin")
-	core.PuregoSafeRegister(&xPaperSizeGetDefaultLeftMargin, libs, "gtk_paper_size_get_default_left_margin")
-	core.PuregoSafeRegister(&xPaperSizeGetDefaultRightMargin, libs, "gtk_paper_size_get_default_right_margin")
-	core.PuregoSafeRegister(&xPaperSizeGetDefaultTopMargin, libs, "gtk_paper_size_get_default_top_margin")
-	core.PuregoSafeRegister(&xPaperSizeGetDisplayName, libs, "gtk_paper_size_get_display_name")
-	core.PuregoSafeRegister(&xPaperSizeGetHeight, libs, "gtk_paper_size_get_height")
-	core.PuregoSafeRegister(&xPaperSizeGetName, libs, "gtk_paper_size_get_name")
-	core.PuregoSafeRegister(&xPaperSizeGetPpdName, libs, "gtk_paper_size_get_ppd_name")
-	core.PuregoSafeRegister(&xPaperSizeGetWidth, libs, "gtk_paper_size_get_width")
-	core.PuregoSafeRegister(&xPaperSizeIsCustom, libs, "gtk_paper_size_is_custom")
-	core.PuregoSafeRegister(&xPaperSizeIsEqual, libs, "gtk_paper_size_is_equal")
-	core.PuregoSafeRegister(&xPaperSizeIsIpp, libs, "gtk_paper_size_is_ipp")
-	core.PuregoSafeRegister(&xPaperSizeSetSize, libs, "gtk_paper_size_set_size")
-	core.PuregoSafeRegister(&xPaperSizeToGvariant, libs, "gtk_paper_size_to_gvariant")
-	core.PuregoSafeRegister(&xPaperSizeToKeyFile, libs, "gtk_paper_size_to_key_file")
+	core.PuregoRegisterSince(&xNewPaperSize, libs, "gtk_paper_size_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewPaperSizeCustom, libs, "gtk_paper_size_new_custom", "GTK", "")
+	core.PuregoRegisterSince(&xNewPaperSizeFromGvariant, libs, "gtk_paper_size_new_from_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xNewPaperSizeFromIpp, libs, "gtk_paper_size_new_from_ipp", "GTK", "")
+	core.PuregoRegisterSince(&xNewPaperSizeFromKeyFile, libs, "gtk_paper_size_new_from_key_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewPaperSizeFromPpd, libs, "gtk_paper_size_new_from_ppd", "GTK", "")
+
+	core.PuregoRegisterSince(&xPaperSizeCopy, libs, "gtk_paper_size_copy", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeFree, libs, "gtk_paper_size_free", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetDefaultBottomMargin, libs, "gtk_paper_size_get_default_bottom_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetDefaultLeftMargin, libs, "gtk_paper_size_get_default_left_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetDefaultRightMargin, libs, "gtk_paper_size_get_default_right_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetDefaultTopMargin, libs, "gtk_paper_size_get_default_top_margin", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetDisplayName, libs, "gtk_paper_size_get_display_name", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetHeight, libs, "gtk_paper_size_get_height", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetName, libs, "gtk_paper_size_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetPpdName, libs, "gtk_paper_size_get_ppd_name", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeGetWidth, libs, "gtk_paper_size_get_width", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeIsCustom, libs, "gtk_paper_size_is_custom", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeIsEqual, libs, "gtk_paper_size_is_equal", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeIsIpp, libs, "gtk_paper_size_is_ipp", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeSetSize, libs, "gtk_paper_size_set_size", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeToGvariant, libs, "gtk_paper_size_to_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xPaperSizeToKeyFile, libs, "gtk_paper_size_to_key_file", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -23,13 +22,6 @@ func (x *PasswordEntryClass) GoPointer() uintptr {
 
 // A single-line text entry widget for entering passwords and other secrets.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="password-entry-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPasswordEntry" src="password-entry.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It does not show its contents in clear text, does not allow to copy it
 // to the clipboard, and it shows a warning when Caps Lock is engaged. If
 // the underlying platform allows it, `GtkPasswordEntry` will also place
@@ -39,18 +31,14 @@ func (x *PasswordEntryClass) GoPointer() uintptr {
 // Optionally, it can offer a way to reveal the contents in clear text.
 //
 // `GtkPasswordEntry` provides only minimal API and should be used with
-// the [iface@Gtk.Editable] API.
+// the [Editable] API.
 //
 // # CSS Nodes
 //
-// ```
-// entry.password
-// ╰── text
-//
-//	├── image.caps-lock-indicator
-//	┊
-//
-// ```
+//	entry.password
+//	╰── text
+//	    ├── image.caps-lock-indicator
+//	    ┊
 //
 // `GtkPasswordEntry` has a single CSS node with name entry that carries
 // a .passwordstyle class. The text Css node below it has a child with
@@ -59,7 +47,7 @@ func (x *PasswordEntryClass) GoPointer() uintptr {
 //
 // # Accessibility
 //
-// `GtkPasswordEntry` uses the [enum@Gtk.AccessibleRole.text_box] role.
+// `GtkPasswordEntry` uses the [AccessibleRoleTextBoxValue] role.
 type PasswordEntry struct {
 	Widget
 }
@@ -95,7 +83,7 @@ func NewPasswordEntry() *PasswordEntry {
 
 var xPasswordEntryGetExtraMenu func(uintptr) uintptr
 
-// Gets the menu model set with gtk_password_entry_set_extra_menu().
+// Gets the menu model set with [PasswordEntry.SetExtraMenu].
 func (x *PasswordEntry) GetExtraMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -123,7 +111,7 @@ func (x *PasswordEntry) GetShowPeekIcon() bool {
 var xPasswordEntrySetExtraMenu func(uintptr, uintptr)
 
 // Sets a menu model to add when constructing
-// the context menu for @entry.
+// the context menu for entry.
 func (x *PasswordEntry) SetExtraMenu(ModelVar *gio.MenuModel) {
 
 	xPasswordEntrySetExtraMenu(x.GoPointer(), ModelVar.GoPointer())
@@ -135,7 +123,7 @@ var xPasswordEntrySetShowPeekIcon func(uintptr, bool)
 // Sets whether the entry should have a clickable icon
 // to reveal the contents.
 //
-// Setting this to %FALSE also hides the text again.
+// Setting this to false also hides the text again.
 func (x *PasswordEntry) SetShowPeekIcon(ShowPeekIconVar bool) {
 
 	xPasswordEntrySetShowPeekIcon(x.GoPointer(), ShowPeekIconVar)
@@ -209,27 +197,49 @@ func (x *PasswordEntry) GetPropertyShowPeekIcon() bool {
 // Emitted when the entry is activated.
 //
 // The keybindings for this signal are all forms of the Enter key.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *PasswordEntry) ConnectActivate(cb *func(PasswordEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := PasswordEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xPasswordEntryActivateTrampoline uintptr
+
+func xPasswordEntryActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PasswordEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PasswordEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PasswordEntry) ConnectActivateFunc(cb func(PasswordEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xPasswordEntryActivateTrampoline, xPasswordEntryActivateNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -393,7 +403,7 @@ func (x *PasswordEntry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -401,14 +411,13 @@ func (x *PasswordEntry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntry) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -432,19 +441,17 @@ func (x *PasswordEntry) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntry) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -465,7 +472,7 @@ func (x *PasswordEntry) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -474,14 +481,12 @@ func (x *PasswordEntry) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PasswordEntry) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -500,10 +505,10 @@ func (x *PasswordEntry) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PasswordEntry) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -516,35 +521,29 @@ func (x *PasswordEntry) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *PasswordEntry) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -564,8 +563,8 @@ func (x *PasswordEntry) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -575,7 +574,7 @@ func (x *PasswordEntry) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -595,8 +594,8 @@ func (x *PasswordEntry) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -606,10 +605,10 @@ func (x *PasswordEntry) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *PasswordEntry) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -624,21 +623,21 @@ func (x *PasswordEntry) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *PasswordEntry) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *PasswordEntry) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *PasswordEntry) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -657,9 +656,9 @@ func (x *PasswordEntry) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *PasswordEntry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -668,7 +667,7 @@ func (x *PasswordEntry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) boo
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *PasswordEntry) GetText() string {
@@ -688,7 +687,7 @@ func (x *PasswordEntry) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -698,11 +697,11 @@ func (x *PasswordEntry) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *PasswordEntry) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -713,9 +712,9 @@ func (x *PasswordEntry) InsertText(TextVar string, LengthVar int, PositionVar in
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *PasswordEntry) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -741,19 +740,19 @@ func (x *PasswordEntry) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *PasswordEntry) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *PasswordEntry) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -766,7 +765,7 @@ func (x *PasswordEntry) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *PasswordEntry) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -783,11 +782,11 @@ func (x *PasswordEntry) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *PasswordEntry) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -799,7 +798,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -808,11 +807,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xPasswordEntryGLibType, libs, "gtk_password_entry_get_type")
 
-	core.PuregoSafeRegister(&xNewPasswordEntry, libs, "gtk_password_entry_new")
+	core.PuregoRegisterSince(&xNewPasswordEntry, libs, "gtk_password_entry_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xPasswordEntryGetExtraMenu, libs, "gtk_password_entry_get_extra_menu")
-	core.PuregoSafeRegister(&xPasswordEntryGetShowPeekIcon, libs, "gtk_password_entry_get_show_peek_icon")
-	core.PuregoSafeRegister(&xPasswordEntrySetExtraMenu, libs, "gtk_password_entry_set_extra_menu")
-	core.PuregoSafeRegister(&xPasswordEntrySetShowPeekIcon, libs, "gtk_password_entry_set_show_peek_icon")
+	core.PuregoRegisterSince(&xPasswordEntryGetExtraMenu, libs, "gtk_password_entry_get_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xPasswordEntryGetShowPeekIcon, libs, "gtk_password_entry_get_show_peek_icon", "GTK", "")
+	core.PuregoRegisterSince(&xPasswordEntrySetExtraMenu, libs, "gtk_password_entry_set_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xPasswordEntrySetShowPeekIcon, libs, "gtk_password_entry_set_show_peek_icon", "GTK", "")
 
 }
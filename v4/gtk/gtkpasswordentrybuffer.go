@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -72,7 +72,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -81,6 +81,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xPasswordEntryBufferGLibType, libs, "gtk_password_entry_buffer_get_type")
 
-	core.PuregoSafeRegister(&xNewPasswordEntryBuffer, libs, "gtk_password_entry_buffer_new")
+	gobject.CheckClassSize("GtkPasswordEntryBufferClass", xPasswordEntryBufferGLibType, unsafe.Sizeof(PasswordEntryBufferClass{}))
+
+	core.PuregoRegisterSince(&xNewPasswordEntryBuffer, libs, "gtk_password_entry_buffer_new", "GTK", "4.4")
 
 }
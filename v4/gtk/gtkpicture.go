@@ -5,10 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
-	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,55 +24,46 @@ func (x *PictureClass) GoPointer() uintptr {
 
 // Displays a `GdkPaintable`.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="picture-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPicture" src="picture.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Many convenience functions are provided to make pictures simple to use.
 // For example, if you want to load an image from a file, and then display
 // it, there’s a convenience function to do this:
 //
-// ```c
-// GtkWidget *widget = gtk_picture_new_for_filename ("myfile.png");
-// ```
+//	GtkWidget *widget = gtk_picture_new_for_filename ("myfile.png");
 //
 // If the file isn’t loaded successfully, the picture will contain a
 // “broken image” icon similar to that used in many web browsers.
 // If you want to handle errors in loading the file yourself,
 // for example by displaying an error message, then load the image with
 // and image loading framework such as libglycin, then create the `GtkPicture`
-// with [ctor@Gtk.Picture.new_for_paintable].
+// with [NewPictureForPaintable].
 //
 // Sometimes an application will want to avoid depending on external data
 // files, such as image files. See the documentation of `GResource` for details.
-// In this case, [ctor@Gtk.Picture.new_for_resource] and
-// [method@Gtk.Picture.set_resource] should be used.
+// In this case, [NewPictureForResource] and
+// [Picture.SetResource] should be used.
 //
-// `GtkPicture` displays an image at its natural size. See [class@Gtk.Image]
+// `GtkPicture` displays an image at its natural size. See [Image]
 // if you want to display a fixed-size image, such as an icon.
 //
-// ## Sizing the paintable
+// # Sizing the paintable
 //
 // You can influence how the paintable is displayed inside the `GtkPicture`
-// by changing [property@Gtk.Picture:content-fit]. See [enum@Gtk.ContentFit]
-// for details. [property@Gtk.Picture:can-shrink] can be unset to make sure
+// by changing Picture:content-fit. See [ContentFit]
+// for details. [Picture.GetPropertyCanShrink] can be unset to make sure
 // that paintables are never made smaller than their ideal size - but
 // be careful if you do not know the size of the paintable in use (like
 // when displaying user-loaded images). This can easily cause the picture to
-// grow larger than the screen. And [property@Gtk.Widget:halign] and
-// [property@Gtk.Widget:valign] can be used to make sure the paintable doesn't
+// grow larger than the screen. And Widget:halign and
+// Widget:valign can be used to make sure the paintable doesn't
 // fill all available space but is instead displayed at its original size.
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkPicture` has a single CSS node with the name `picture`.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkPicture` uses the [enum@Gtk.AccessibleRole.img] role.
+// `GtkPicture` uses the [AccessibleRoleImgValue] role.
 type Picture struct {
 	Widget
 }
@@ -110,7 +99,7 @@ func NewPicture() *Picture {
 
 var xNewPictureForFile func(uintptr) uintptr
 
-// Creates a new `GtkPicture` displaying the given @file.
+// Creates a new `GtkPicture` displaying the given file.
 //
 // If the file isn’t found or can’t be loaded, the resulting
 // `GtkPicture` is empty.
@@ -134,9 +123,9 @@ func NewPictureForFile(FileVar gio.File) *Picture {
 
 var xNewPictureForFilename func(uintptr) uintptr
 
-// Creates a new `GtkPicture` displaying the file @filename.
+// Creates a new `GtkPicture` displaying the file filename.
 //
-// This is a utility function that calls [ctor@Gtk.Picture.new_for_file].
+// This is a utility function that calls [NewPictureForFile].
 // See that function for details.
 func NewPictureForFilename(FilenameVar *string) *Picture {
 	var cls *Picture
@@ -157,9 +146,9 @@ func NewPictureForFilename(FilenameVar *string) *Picture {
 
 var xNewPictureForPaintable func(uintptr) uintptr
 
-// Creates a new `GtkPicture` displaying @paintable.
+// Creates a new `GtkPicture` displaying paintable.
 //
-// The `GtkPicture` will track changes to the @paintable and update
+// The `GtkPicture` will track changes to the paintable and update
 // its size and contents in response to it.
 func NewPictureForPaintable(PaintableVar gdk.Paintable) *Picture {
 	var cls *Picture
@@ -175,33 +164,11 @@ func NewPictureForPaintable(PaintableVar gdk.Paintable) *Picture {
 	return cls
 }
 
-var xNewPictureForPixbuf func(uintptr) uintptr
-
-// Creates a new `GtkPicture` displaying @pixbuf.
-//
-// This is a utility function that calls [ctor@Gtk.Picture.new_for_paintable],
-// See that function for details.
-//
-// The pixbuf must not be modified after passing it to this function.
-func NewPictureForPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Picture {
-	var cls *Picture
-
-	cret := xNewPictureForPixbuf(PixbufVar.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Picture{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xNewPictureForResource func(uintptr) uintptr
 
-// Creates a new `GtkPicture` displaying the resource at @resource_path.
+// Creates a new `GtkPicture` displaying the resource at resource_path.
 //
-// This is a utility function that calls [ctor@Gtk.Picture.new_for_file].
+// This is a utility function that calls [NewPictureForFile].
 // See that function for details.
 func NewPictureForResource(ResourcePathVar *string) *Picture {
 	var cls *Picture
@@ -224,7 +191,7 @@ var xPictureGetAlternativeText func(uintptr) string
 
 // Gets the alternative textual description of the picture.
 //
-// The returned string will be %NULL if the picture cannot be described textually.
+// The returned string will be nil if the picture cannot be described textually.
 func (x *Picture) GetAlternativeText() string {
 
 	cret := xPictureGetAlternativeText(x.GoPointer())
@@ -244,7 +211,7 @@ var xPictureGetContentFit func(uintptr) ContentFit
 
 // Returns the fit mode for the content of the `GtkPicture`.
 //
-// See [enum@Gtk.ContentFit] for details.
+// See [ContentFit] for details.
 func (x *Picture) GetContentFit() ContentFit {
 
 	cret := xPictureGetContentFit(x.GoPointer())
@@ -253,10 +220,10 @@ func (x *Picture) GetContentFit() ContentFit {
 
 var xPictureGetFile func(uintptr) uintptr
 
-// Gets the `GFile` currently displayed if @self is displaying a file.
+// Gets the `GFile` currently displayed if self is displaying a file.
 //
-// If @self is not displaying a file, for example when
-// [method@Gtk.Picture.set_paintable] was used, then %NULL is returned.
+// If self is not displaying a file, for example when
+// [Picture.SetPaintable] was used, then nil is returned.
 func (x *Picture) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
 
@@ -271,15 +238,6 @@ func (x *Picture) GetFile() *gio.FileBase {
 	return cls
 }
 
-var xPictureGetKeepAspectRatio func(uintptr) bool
-
-// Returns whether the `GtkPicture` preserves its contents aspect ratio.
-func (x *Picture) GetKeepAspectRatio() bool {
-
-	cret := xPictureGetKeepAspectRatio(x.GoPointer())
-	return cret
-}
-
 var xPictureGetPaintable func(uintptr) uintptr
 
 // Gets the `GdkPaintable` being displayed by the `GtkPicture`.
@@ -305,7 +263,7 @@ var xPictureSetAlternativeText func(uintptr, uintptr)
 //
 // This text will be made available to accessibility tools.
 //
-// If the picture cannot be described textually, set this property to %NULL.
+// If the picture cannot be described textually, set this property to nil.
 func (x *Picture) SetAlternativeText(AlternativeTextVar *string) {
 
 	AlternativeTextVarPtr := core.GStrdupNullable(AlternativeTextVar)
@@ -317,16 +275,16 @@ func (x *Picture) SetAlternativeText(AlternativeTextVar *string) {
 
 var xPictureSetCanShrink func(uintptr, bool)
 
-// If set to %TRUE, the @self can be made smaller than its contents.
+// If set to true, the self can be made smaller than its contents.
 //
 // The contents will then be scaled down when rendering.
 //
 // If you want to still force a minimum size manually, consider using
-// [method@Gtk.Widget.set_size_request].
+// [Widget.SetSizeRequest].
 //
 // Also of note is that a similar function for growing does not exist
 // because the grow behavior can be controlled via
-// [method@Gtk.Widget.set_halign] and [method@Gtk.Widget.set_valign].
+// [Widget.SetHalign] and [Widget.SetValign].
 func (x *Picture) SetCanShrink(CanShrinkVar bool) {
 
 	xPictureSetCanShrink(x.GoPointer(), CanShrinkVar)
@@ -337,7 +295,7 @@ var xPictureSetContentFit func(uintptr, ContentFit)
 
 // Sets how the content should be resized to fit the `GtkPicture`.
 //
-// See [enum@Gtk.ContentFit] for details.
+// See [ContentFit] for details.
 func (x *Picture) SetContentFit(ContentFitVar ContentFit) {
 
 	xPictureSetContentFit(x.GoPointer(), ContentFitVar)
@@ -346,16 +304,16 @@ func (x *Picture) SetContentFit(ContentFitVar ContentFit) {
 
 var xPictureSetFile func(uintptr, uintptr)
 
-// Makes @self load and display @file.
+// Makes self load and display file.
 //
-// See [ctor@Gtk.Picture.new_for_file] for details.
+// See [NewPictureForFile] for details.
 //
 // ::: warning
 //
 //	Note that this function should not be used with untrusted data.
 //	Use a proper image loading framework such as libglycin, which can
 //	load many image formats into a `GdkTexture`, and then use
-//	[method@Gtk.Image.set_from_paintable].
+//	[Image.SetFromPaintable].
 func (x *Picture) SetFile(FileVar gio.File) {
 
 	xPictureSetFile(x.GoPointer(), FileVar.GoPointer())
@@ -364,16 +322,16 @@ func (x *Picture) SetFile(FileVar gio.File) {
 
 var xPictureSetFilename func(uintptr, uintptr)
 
-// Makes @self load and display the given @filename.
+// Makes self load and display the given filename.
 //
-// This is a utility function that calls [method@Gtk.Picture.set_file].
+// This is a utility function that calls [Picture.SetFile].
 //
 // ::: warning
 //
 //	Note that this function should not be used with untrusted data.
 //	Use a proper image loading framework such as libglycin, which can
 //	load many image formats into a `GdkTexture`, and then use
-//	[method@Gtk.Image.set_from_paintable].
+//	[Image.SetFromPaintable].
 func (x *Picture) SetFilename(FilenameVar *string) {
 
 	FilenameVarPtr := core.GStrdupNullable(FilenameVar)
@@ -383,54 +341,25 @@ func (x *Picture) SetFilename(FilenameVar *string) {
 
 }
 
-var xPictureSetKeepAspectRatio func(uintptr, bool)
-
-// If set to %TRUE, the @self will render its contents according to
-// their aspect ratio.
-//
-// That means that empty space may show up at the top/bottom or
-// left/right of @self.
-//
-// If set to %FALSE or if the contents provide no aspect ratio,
-// the contents will be stretched over the picture's whole area.
-func (x *Picture) SetKeepAspectRatio(KeepAspectRatioVar bool) {
-
-	xPictureSetKeepAspectRatio(x.GoPointer(), KeepAspectRatioVar)
-
-}
-
 var xPictureSetPaintable func(uintptr, uintptr)
 
-// Makes @self display the given @paintable.
+// Makes self display the given paintable.
 //
-// If @paintable is `NULL`, nothing will be displayed.
+// If paintable is `NULL`, nothing will be displayed.
 //
-// See [ctor@Gtk.Picture.new_for_paintable] for details.
+// See [NewPictureForPaintable] for details.
 func (x *Picture) SetPaintable(PaintableVar gdk.Paintable) {
 
 	xPictureSetPaintable(x.GoPointer(), PaintableVar.GoPointer())
 
 }
 
-var xPictureSetPixbuf func(uintptr, uintptr)
-
-// Sets a `GtkPicture` to show a `GdkPixbuf`.
-//
-// See [ctor@Gtk.Picture.new_for_pixbuf] for details.
-//
-// This is a utility function that calls [method@Gtk.Picture.set_paintable].
-func (x *Picture) SetPixbuf(PixbufVar *gdkpixbuf.Pixbuf) {
-
-	xPictureSetPixbuf(x.GoPointer(), PixbufVar.GoPointer())
-
-}
-
 var xPictureSetResource func(uintptr, uintptr)
 
-// Makes @self load and display the resource at the given
-// @resource_path.
+// Makes self load and display the resource at the given
+// resource_path.
 //
-// This is a utility function that calls [method@Gtk.Picture.set_file].
+// This is a utility function that calls [Picture.SetFile].
 func (x *Picture) SetResource(ResourcePathVar *string) {
 
 	ResourcePathVarPtr := core.GStrdupNullable(ResourcePathVar)
@@ -488,6 +417,8 @@ func (x *Picture) GetPropertyCanShrink() bool {
 // SetPropertyKeepAspectRatio sets the "keep-aspect-ratio" property.
 // Whether the GtkPicture will render its contents trying to preserve the aspect
 // ratio.
+//
+// Deprecated: since 4.8. Use Picture:content-fit instead.
 func (x *Picture) SetPropertyKeepAspectRatio(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -498,6 +429,8 @@ func (x *Picture) SetPropertyKeepAspectRatio(value bool) {
 // GetPropertyKeepAspectRatio gets the "keep-aspect-ratio" property.
 // Whether the GtkPicture will render its contents trying to preserve the aspect
 // ratio.
+//
+// Deprecated: since 4.8. Use Picture:content-fit instead.
 func (x *Picture) GetPropertyKeepAspectRatio() bool {
 	var v gobject.Value
 	x.GetProperty("keep-aspect-ratio", &v)
@@ -665,7 +598,7 @@ func (x *Picture) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -673,14 +606,13 @@ func (x *Picture) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Picture) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -704,19 +636,17 @@ func (x *Picture) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Picture) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -737,7 +667,7 @@ func (x *Picture) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -746,14 +676,12 @@ func (x *Picture) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Picture) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -772,10 +700,10 @@ func (x *Picture) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Picture) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -787,7 +715,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -796,27 +724,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xPictureGLibType, libs, "gtk_picture_get_type")
 
-	core.PuregoSafeRegister(&xNewPicture, libs, "gtk_picture_new")
-	core.PuregoSafeRegister(&xNewPictureForFile, libs, "gtk_picture_new_for_file")
-	core.PuregoSafeRegister(&xNewPictureForFilename, libs, "gtk_picture_new_for_filename")
-	core.PuregoSafeRegister(&xNewPictureForPaintable, libs, "gtk_picture_new_for_paintable")
-	core.PuregoSafeRegister(&xNewPictureForPixbuf, libs, "gtk_picture_new_for_pixbuf")
-	core.PuregoSafeRegister(&xNewPictureForResource, libs, "gtk_picture_new_for_resource")
-
-	core.PuregoSafeRegister(&xPictureGetAlternativeText, libs, "gtk_picture_get_alternative_text")
-	core.PuregoSafeRegister(&xPictureGetCanShrink, libs, "gtk_picture_get_can_shrink")
-	core.PuregoSafeRegister(&xPictureGetContentFit, libs, "gtk_picture_get_content_fit")
-	core.PuregoSafeRegister(&xPictureGetFile, libs, "gtk_picture_get_file")
-	core.PuregoSafeRegister(&xPictureGetKeepAspectRatio, libs, "gtk_picture_get_keep_aspect_ratio")
-	core.PuregoSafeRegister(&xPictureGetPaintable, libs, "gtk_picture_get_paintable")
-	core.PuregoSafeRegister(&xPictureSetAlternativeText, libs, "gtk_picture_set_alternative_text")
-	core.PuregoSafeRegister(&xPictureSetCanShrink, libs, "gtk_picture_set_can_shrink")
-	core.PuregoSafeRegister(&xPictureSetContentFit, libs, "gtk_picture_set_content_fit")
-	core.PuregoSafeRegister(&xPictureSetFile, libs, "gtk_picture_set_file")
-	core.PuregoSafeRegister(&xPictureSetFilename, libs, "gtk_picture_set_filename")
-	core.PuregoSafeRegister(&xPictureSetKeepAspectRatio, libs, "gtk_picture_set_keep_aspect_ratio")
-	core.PuregoSafeRegister(&xPictureSetPaintable, libs, "gtk_picture_set_paintable")
-	core.PuregoSafeRegister(&xPictureSetPixbuf, libs, "gtk_picture_set_pixbuf")
-	core.PuregoSafeRegister(&xPictureSetResource, libs, "gtk_picture_set_resource")
+	gobject.CheckClassSize("GtkPictureClass", xPictureGLibType, unsafe.Sizeof(PictureClass{}))
+
+	core.PuregoRegisterSince(&xNewPicture, libs, "gtk_picture_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewPictureForFile, libs, "gtk_picture_new_for_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewPictureForFilename, libs, "gtk_picture_new_for_filename", "GTK", "")
+	core.PuregoRegisterSince(&xNewPictureForPaintable, libs, "gtk_picture_new_for_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xNewPictureForResource, libs, "gtk_picture_new_for_resource", "GTK", "")
+
+	core.PuregoRegisterSince(&xPictureGetAlternativeText, libs, "gtk_picture_get_alternative_text", "GTK", "")
+	core.PuregoRegisterSince(&xPictureGetCanShrink, libs, "gtk_picture_get_can_shrink", "GTK", "")
+	core.PuregoRegisterSince(&xPictureGetContentFit, libs, "gtk_picture_get_content_fit", "GTK", "4.8")
+	core.PuregoRegisterSince(&xPictureGetFile, libs, "gtk_picture_get_file", "GTK", "")
+	core.PuregoRegisterSince(&xPictureGetPaintable, libs, "gtk_picture_get_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetAlternativeText, libs, "gtk_picture_set_alternative_text", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetCanShrink, libs, "gtk_picture_set_can_shrink", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetContentFit, libs, "gtk_picture_set_content_fit", "GTK", "4.8")
+	core.PuregoRegisterSince(&xPictureSetFile, libs, "gtk_picture_set_file", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetFilename, libs, "gtk_picture_set_filename", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetPaintable, libs, "gtk_picture_set_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetResource, libs, "gtk_picture_set_resource", "GTK", "")
 
 }
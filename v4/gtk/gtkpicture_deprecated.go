@@ -0,0 +1,107 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdkpixbuf"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewPictureForPixbuf func(uintptr) uintptr
+
+// Creates a new `GtkPicture` displaying pixbuf.
+//
+// This is a utility function that calls [NewPictureForPaintable],
+// See that function for details.
+//
+// The pixbuf must not be modified after passing it to this function.
+//
+// Deprecated: since 4.12. Use [NewPictureForPaintable] and
+//
+//	[gdk.NewTextureForPixbuf] instead
+func NewPictureForPixbuf(PixbufVar *gdkpixbuf.Pixbuf) *Picture {
+	var cls *Picture
+
+	cret := xNewPictureForPixbuf(PixbufVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Picture{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPictureGetKeepAspectRatio func(uintptr) bool
+
+// Returns whether the `GtkPicture` preserves its contents aspect ratio.
+//
+// Deprecated: since 4.8. Use [Picture.GetContentFit] instead. This will
+//
+//	now return `FALSE` only if Picture:content-fit is
+//	`GTK_CONTENT_FIT_FILL`. Returns `TRUE` otherwise.
+func (x *Picture) GetKeepAspectRatio() bool {
+
+	cret := xPictureGetKeepAspectRatio(x.GoPointer())
+	return cret
+}
+
+var xPictureSetKeepAspectRatio func(uintptr, bool)
+
+// If set to true, the self will render its contents according to
+// their aspect ratio.
+//
+// That means that empty space may show up at the top/bottom or
+// left/right of self.
+//
+// If set to false or if the contents provide no aspect ratio,
+// the contents will be stretched over the picture's whole area.
+//
+// Deprecated: since 4.8. Use [Picture.SetContentFit] instead. If still
+//
+//	used, this method will always set the Picture:content-fit
+//	property to `GTK_CONTENT_FIT_CONTAIN` if keep_aspect_ratio is true,
+//	otherwise it will set it to `GTK_CONTENT_FIT_FILL`.
+func (x *Picture) SetKeepAspectRatio(KeepAspectRatioVar bool) {
+
+	xPictureSetKeepAspectRatio(x.GoPointer(), KeepAspectRatioVar)
+
+}
+
+var xPictureSetPixbuf func(uintptr, uintptr)
+
+// Sets a `GtkPicture` to show a `GdkPixbuf`.
+//
+// See [NewPictureForPixbuf] for details.
+//
+// This is a utility function that calls [Picture.SetPaintable].
+//
+// Deprecated: since 4.12. Use [Picture.SetPaintable] instead
+func (x *Picture) SetPixbuf(PixbufVar *gdkpixbuf.Pixbuf) {
+
+	xPictureSetPixbuf(x.GoPointer(), PixbufVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewPictureForPixbuf, libs, "gtk_picture_new_for_pixbuf", "GTK", "")
+
+	core.PuregoRegisterSince(&xPictureGetKeepAspectRatio, libs, "gtk_picture_get_keep_aspect_ratio", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetKeepAspectRatio, libs, "gtk_picture_set_keep_aspect_ratio", "GTK", "")
+	core.PuregoRegisterSince(&xPictureSetPixbuf, libs, "gtk_picture_set_pixbuf", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -35,7 +34,8 @@ func (x *PopoverClass) OverrideClosed(cb func(*Popover)) {
 	if cb == nil {
 		x.xClosed = 0
 	} else {
-		x.xClosed = purego.NewCallback(func(PopoverVarp uintptr) {
+		x.xClosed = core.NewCallback(func(PopoverVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PopoverNewFromInternalPtr(PopoverVarp))
 		})
 	}
@@ -47,7 +47,7 @@ func (x *PopoverClass) GetClosed() func(*Popover) {
 		return nil
 	}
 	var rawCallback func(PopoverVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xClosed)
+	core.RegisterFunc(&rawCallback, x.xClosed)
 	return func(PopoverVar *Popover) {
 		rawCallback(PopoverVar.GoPointer())
 	}
@@ -58,7 +58,8 @@ func (x *PopoverClass) OverrideActivateDefault(cb func(*Popover)) {
 	if cb == nil {
 		x.xActivateDefault = 0
 	} else {
-		x.xActivateDefault = purego.NewCallback(func(PopoverVarp uintptr) {
+		x.xActivateDefault = core.NewCallback(func(PopoverVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PopoverNewFromInternalPtr(PopoverVarp))
 		})
 	}
@@ -70,7 +71,7 @@ func (x *PopoverClass) GetActivateDefault() func(*Popover) {
 		return nil
 	}
 	var rawCallback func(PopoverVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivateDefault)
+	core.RegisterFunc(&rawCallback, x.xActivateDefault)
 	return func(PopoverVar *Popover) {
 		rawCallback(PopoverVar.GoPointer())
 	}
@@ -78,88 +79,73 @@ func (x *PopoverClass) GetActivateDefault() func(*Popover) {
 
 // Presents a bubble-like popup.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="popover-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPopover" src="popover.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It is primarily meant to provide context-dependent information
 // or options. Popovers are attached to a parent widget. The parent widget
-// must support popover children, as [class@Gtk.MenuButton] and
-// [class@Gtk.PopoverMenuBar] do. If you want to make a custom widget that
-// has an attached popover, you need to call [method@Gtk.Popover.present]
-// in your [vfunc@Gtk.Widget.size_allocate] vfunc, in order to update the
+// must support popover children, as [MenuButton] and
+// [PopoverMenuBar] do. If you want to make a custom widget that
+// has an attached popover, you need to call [Popover.Present]
+// in your Widget.size_allocate vfunc, in order to update the
 // positioning of the popover.
 //
 // The position of a popover relative to the widget it is attached to
-// can also be changed with [method@Gtk.Popover.set_position]. By default,
+// can also be changed with [Popover.SetPosition]. By default,
 // it points to the whole widget area, but it can be made to point to
-// a specific area using [method@Gtk.Popover.set_pointing_to].
+// a specific area using [Popover.SetPointingTo].
 //
 // By default, `GtkPopover` performs a grab, in order to ensure input
 // events get redirected to it while it is shown, and also so the popover
 // is dismissed in the expected situations (clicks outside the popover,
 // or the Escape key being pressed). If no such modal behavior is desired
-// on a popover, [method@Gtk.Popover.set_autohide] may be called on it to
+// on a popover, [Popover.SetAutohide] may be called on it to
 // tweak its behavior.
 //
-// ## GtkPopover as menu replacement
+// # GtkPopover as menu replacement
 //
 // `GtkPopover` is often used to replace menus. The best way to do this
-// is to use the [class@Gtk.PopoverMenu] subclass which supports being
-// populated from a `GMenuModel` with [ctor@Gtk.PopoverMenu.new_from_model].
-//
-// ```xml
-// &lt;section&gt;
-//
-//	&lt;attribute name="display-hint"&gt;horizontal-buttons&lt;/attribute&gt;
-//	&lt;item&gt;
-//	  &lt;attribute name="label"&gt;Cut&lt;/attribute&gt;
-//	  &lt;attribute name="action"&gt;app.cut&lt;/attribute&gt;
-//	  &lt;attribute name="verb-icon"&gt;edit-cut-symbolic&lt;/attribute&gt;
-//	&lt;/item&gt;
-//	&lt;item&gt;
-//	  &lt;attribute name="label"&gt;Copy&lt;/attribute&gt;
-//	  &lt;attribute name="action"&gt;app.copy&lt;/attribute&gt;
-//	  &lt;attribute name="verb-icon"&gt;edit-copy-symbolic&lt;/attribute&gt;
-//	&lt;/item&gt;
-//	&lt;item&gt;
-//	  &lt;attribute name="label"&gt;Paste&lt;/attribute&gt;
-//	  &lt;attribute name="action"&gt;app.paste&lt;/attribute&gt;
-//	  &lt;attribute name="verb-icon"&gt;edit-paste-symbolic&lt;/attribute&gt;
-//	&lt;/item&gt;
-//
-// &lt;/section&gt;
-// ```
+// is to use the [PopoverMenu] subclass which supports being
+// populated from a `GMenuModel` with [NewPopoverMenuFromModel].
+//
+//	<section>
+//	  <attribute name="display-hint">horizontal-buttons</attribute>
+//	  <item>
+//	    <attribute name="label">Cut</attribute>
+//	    <attribute name="action">app.cut</attribute>
+//	    <attribute name="verb-icon">edit-cut-symbolic</attribute>
+//	  </item>
+//	  <item>
+//	    <attribute name="label">Copy</attribute>
+//	    <attribute name="action">app.copy</attribute>
+//	    <attribute name="verb-icon">edit-copy-symbolic</attribute>
+//	  </item>
+//	  <item>
+//	    <attribute name="label">Paste</attribute>
+//	    <attribute name="action">app.paste</attribute>
+//	    <attribute name="verb-icon">edit-paste-symbolic</attribute>
+//	  </item>
+//	</section>
 //
 // # Shortcuts and Gestures
 //
 // `GtkPopover` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Escape&lt;/kbd&gt; closes the popover.
-// - &lt;kbd&gt;Alt&lt;/kbd&gt; makes the mnemonics visible.
+// - Escape closes the popover.
+// - Alt makes the mnemonics visible.
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.Popover::activate-default]
+// - [Popover.ConnectActivateDefaultFunc]
 //
 // # CSS nodes
 //
-// ```
-// popover.background[.menu]
-// ├── arrow
-// ╰── contents
-//
-//	╰── &lt;child&gt;
-//
-// ```
+//	popover.background[.menu]
+//	├── arrow
+//	╰── contents
+//	    ╰── <child>
 //
 // `GtkPopover` has a main node with name `popover`, an arrow with name `arrow`,
 // and another node for the content named `contents`. The `popover` node always
 // gets the `.background` style class. It also gets the `.menu` style class
-// if the popover is menu-like, e.g. is a [class@Gtk.PopoverMenu].
+// if the popover is menu-like, e.g. is a [PopoverMenu].
 //
 // Particular uses of `GtkPopover`, such as touch selection popups or
 // magnifiers in `GtkEntry` or `GtkTextView` get style classes like
@@ -215,7 +201,7 @@ var xPopoverGetAutohide func(uintptr) bool
 
 // Returns whether the popover is modal.
 //
-// See [method@Gtk.Popover.set_autohide] for the
+// See [Popover.SetAutohide] for the
 // implications of this.
 func (x *Popover) GetAutohide() bool {
 
@@ -234,7 +220,7 @@ func (x *Popover) GetCascadePopdown() bool {
 
 var xPopoverGetChild func(uintptr) uintptr
 
-// Gets the child widget of @popover.
+// Gets the child widget of popover.
 func (x *Popover) GetChild() *Widget {
 	var cls *Widget
 
@@ -270,7 +256,7 @@ func (x *Popover) GetMnemonicsVisible() bool {
 
 var xPopoverGetOffset func(uintptr, *int, *int)
 
-// Gets the offset previous set with [method@Gtk.Popover.set_offset].
+// Gets the offset previous set with [Popover.SetOffset].
 func (x *Popover) GetOffset(XOffsetVar *int, YOffsetVar *int) {
 
 	xPopoverGetOffset(x.GoPointer(), XOffsetVar, YOffsetVar)
@@ -282,8 +268,8 @@ var xPopoverGetPointingTo func(uintptr, *gdk.Rectangle) bool
 // Gets the rectangle that the popover points to.
 //
 // If a rectangle to point to has been set, this function will
-// return %TRUE and fill in @rect with such rectangle, otherwise
-// it will return %FALSE and fill in @rect with the parent
+// return true and fill in rect with such rectangle, otherwise
+// it will return false and fill in rect with the parent
 // widget coordinates.
 func (x *Popover) GetPointingTo(RectVar *gdk.Rectangle) bool {
 
@@ -293,7 +279,7 @@ func (x *Popover) GetPointingTo(RectVar *gdk.Rectangle) bool {
 
 var xPopoverGetPosition func(uintptr) PositionType
 
-// Returns the preferred position of @popover.
+// Returns the preferred position of popover.
 func (x *Popover) GetPosition() PositionType {
 
 	cret := xPopoverGetPosition(x.GoPointer())
@@ -302,10 +288,10 @@ func (x *Popover) GetPosition() PositionType {
 
 var xPopoverPopdown func(uintptr)
 
-// Pops @popover down.
+// Pops popover down.
 //
 // This may have the side-effect of closing a parent popover
-// as well. See [property@Gtk.Popover:cascade-popdown].
+// as well. See [Popover.GetPropertyCascadePopdown].
 func (x *Popover) Popdown() {
 
 	xPopoverPopdown(x.GoPointer())
@@ -314,7 +300,7 @@ func (x *Popover) Popdown() {
 
 var xPopoverPopup func(uintptr)
 
-// Pops @popover up.
+// Pops popover up.
 func (x *Popover) Popup() {
 
 	xPopoverPopup(x.GoPointer())
@@ -329,7 +315,7 @@ var xPopoverPresent func(uintptr)
 // who have a `GtkPopover` as child. When using a layout manager,
 // this is happening automatically.
 //
-// To make a popover appear on screen, use [method@Gtk.Popover.popup].
+// To make a popover appear on screen, use [Popover.Popup].
 func (x *Popover) Present() {
 
 	xPopoverPresent(x.GoPointer())
@@ -338,7 +324,7 @@ func (x *Popover) Present() {
 
 var xPopoverSetAutohide func(uintptr, bool)
 
-// Sets whether @popover is modal.
+// Sets whether popover is modal.
 //
 // A modal popover will grab the keyboard focus on it when being
 // displayed. Focus will wrap around within the popover. Clicking
@@ -355,10 +341,10 @@ func (x *Popover) SetAutohide(AutohideVar bool) {
 
 var xPopoverSetCascadePopdown func(uintptr, bool)
 
-// If @cascade_popdown is %TRUE, the popover will be
+// If cascade_popdown is true, the popover will be
 // closed when a child modal popover is closed.
 //
-// If %FALSE, @popover will stay visible.
+// If false, popover will stay visible.
 func (x *Popover) SetCascadePopdown(CascadePopdownVar bool) {
 
 	xPopoverSetCascadePopdown(x.GoPointer(), CascadePopdownVar)
@@ -367,7 +353,7 @@ func (x *Popover) SetCascadePopdown(CascadePopdownVar bool) {
 
 var xPopoverSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @popover.
+// Sets the child widget of popover.
 func (x *Popover) SetChild(ChildVar *Widget) {
 
 	xPopoverSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -411,7 +397,7 @@ var xPopoverSetOffset func(uintptr, int, int)
 // Sets the offset to use when calculating the position
 // of the popover.
 //
-// These values are used when preparing the [struct@Gdk.PopupLayout]
+// These values are used when preparing the [gdk.PopupLayout]
 // for positioning the popover.
 func (x *Popover) SetOffset(XOffsetVar int, YOffsetVar int) {
 
@@ -421,9 +407,9 @@ func (x *Popover) SetOffset(XOffsetVar int, YOffsetVar int) {
 
 var xPopoverSetPointingTo func(uintptr, *gdk.Rectangle)
 
-// Sets the rectangle that @popover points to.
+// Sets the rectangle that popover points to.
 //
-// This is in the coordinate space of the @popover parent.
+// This is in the coordinate space of the popover parent.
 func (x *Popover) SetPointingTo(RectVar *gdk.Rectangle) {
 
 	xPopoverSetPointingTo(x.GoPointer(), RectVar)
@@ -432,9 +418,9 @@ func (x *Popover) SetPointingTo(RectVar *gdk.Rectangle) {
 
 var xPopoverSetPosition func(uintptr, PositionType)
 
-// Sets the preferred position for @popover to appear.
+// Sets the preferred position for popover to appear.
 //
-// If the @popover is currently visible, it will be immediately
+// If the popover is currently visible, it will be immediately
 // updated.
 //
 // This preference will be respected where possible, although
@@ -548,54 +534,98 @@ func (x *Popover) GetPropertyPointingTo() uintptr {
 
 // Emitted whend the user activates the default widget.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default binding for this signal is Enter.
 //
-// The default binding for this signal is &lt;kbd&gt;Enter&lt;/kbd&gt;.
+// Deprecated: use ConnectActivateDefaultFunc, which also accepts method values and closures.
 func (x *Popover) ConnectActivateDefault(cb *func(Popover)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-default", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Popover{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-default", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xPopoverActivateDefaultTrampoline uintptr
+
+func xPopoverActivateDefaultNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Popover))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Popover{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-default", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateDefaultFunc connects cb to the "activate-default" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Popover) ConnectActivateDefaultFunc(cb func(Popover)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-default", &xPopoverActivateDefaultTrampoline, xPopoverActivateDefaultNewTrampoline, cb)
 }
 
 // Emitted when the popover is closed.
+//
+// Deprecated: use ConnectClosedFunc, which also accepts method values and closures.
 func (x *Popover) ConnectClosed(cb *func(Popover)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Popover{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "closed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xPopoverClosedTrampoline uintptr
+
+func xPopoverClosedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Popover))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Popover{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "closed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectClosedFunc connects cb to the "closed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Popover) ConnectClosedFunc(cb func(Popover)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "closed", &xPopoverClosedTrampoline, xPopoverClosedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -759,7 +789,7 @@ func (x *Popover) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -767,14 +797,13 @@ func (x *Popover) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Popover) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -798,19 +827,17 @@ func (x *Popover) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Popover) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -831,7 +858,7 @@ func (x *Popover) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -840,14 +867,12 @@ func (x *Popover) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Popover) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -866,10 +891,10 @@ func (x *Popover) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Popover) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -906,10 +931,10 @@ func (x *Popover) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *Popover) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -939,7 +964,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -948,27 +973,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xPopoverGLibType, libs, "gtk_popover_get_type")
 
-	core.PuregoSafeRegister(&xNewPopover, libs, "gtk_popover_new")
-
-	core.PuregoSafeRegister(&xPopoverGetAutohide, libs, "gtk_popover_get_autohide")
-	core.PuregoSafeRegister(&xPopoverGetCascadePopdown, libs, "gtk_popover_get_cascade_popdown")
-	core.PuregoSafeRegister(&xPopoverGetChild, libs, "gtk_popover_get_child")
-	core.PuregoSafeRegister(&xPopoverGetHasArrow, libs, "gtk_popover_get_has_arrow")
-	core.PuregoSafeRegister(&xPopoverGetMnemonicsVisible, libs, "gtk_popover_get_mnemonics_visible")
-	core.PuregoSafeRegister(&xPopoverGetOffset, libs, "gtk_popover_get_offset")
-	core.PuregoSafeRegister(&xPopoverGetPointingTo, libs, "gtk_popover_get_pointing_to")
-	core.PuregoSafeRegister(&xPopoverGetPosition, libs, "gtk_popover_get_position")
-	core.PuregoSafeRegister(&xPopoverPopdown, libs, "gtk_popover_popdown")
-	core.PuregoSafeRegister(&xPopoverPopup, libs, "gtk_popover_popup")
-	core.PuregoSafeRegister(&xPopoverPresent, libs, "gtk_popover_present")
-	core.PuregoSafeRegister(&xPopoverSetAutohide, libs, "gtk_popover_set_autohide")
-	core.PuregoSafeRegister(&xPopoverSetCascadePopdown, libs, "gtk_popover_set_cascade_popdown")
-	core.PuregoSafeRegister(&xPopoverSetChild, libs, "gtk_popover_set_child")
-	core.PuregoSafeRegister(&xPopoverSetDefaultWidget, libs, "gtk_popover_set_default_widget")
-	core.PuregoSafeRegister(&xPopoverSetHasArrow, libs, "gtk_popover_set_has_arrow")
-	core.PuregoSafeRegister(&xPopoverSetMnemonicsVisible, libs, "gtk_popover_set_mnemonics_visible")
-	core.PuregoSafeRegister(&xPopoverSetOffset, libs, "gtk_popover_set_offset")
-	core.PuregoSafeRegister(&xPopoverSetPointingTo, libs, "gtk_popover_set_pointing_to")
-	core.PuregoSafeRegister(&xPopoverSetPosition, libs, "gtk_popover_set_position")
+	gobject.CheckClassSize("GtkPopoverClass", xPopoverGLibType, unsafe.Sizeof(PopoverClass{}))
+
+	core.PuregoRegisterSince(&xNewPopover, libs, "gtk_popover_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPopoverGetAutohide, libs, "gtk_popover_get_autohide", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetCascadePopdown, libs, "gtk_popover_get_cascade_popdown", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetChild, libs, "gtk_popover_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetHasArrow, libs, "gtk_popover_get_has_arrow", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetMnemonicsVisible, libs, "gtk_popover_get_mnemonics_visible", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetOffset, libs, "gtk_popover_get_offset", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetPointingTo, libs, "gtk_popover_get_pointing_to", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverGetPosition, libs, "gtk_popover_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverPopdown, libs, "gtk_popover_popdown", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverPopup, libs, "gtk_popover_popup", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverPresent, libs, "gtk_popover_present", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetAutohide, libs, "gtk_popover_set_autohide", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetCascadePopdown, libs, "gtk_popover_set_cascade_popdown", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetChild, libs, "gtk_popover_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetDefaultWidget, libs, "gtk_popover_set_default_widget", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetHasArrow, libs, "gtk_popover_set_has_arrow", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetMnemonicsVisible, libs, "gtk_popover_set_mnemonics_visible", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetOffset, libs, "gtk_popover_set_offset", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetPointingTo, libs, "gtk_popover_set_pointing_to", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverSetPosition, libs, "gtk_popover_set_position", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -13,58 +12,47 @@ import (
 
 // A subclass of `GtkPopover` that implements menu behavior.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="menu-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPopoverMenu" src="menu.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // `GtkPopoverMenu` treats its children like menus and allows switching
 // between them. It can open submenus as traditional, nested submenus,
 // or in a more touch-friendly sliding fashion.
-// The property [property@Gtk.PopoverMenu:flags] controls this appearance.
+// The property PopoverMenu:flags controls this appearance.
 //
 // `GtkPopoverMenu` is meant to be used primarily with menu models,
-// using [ctor@Gtk.PopoverMenu.new_from_model]. If you need to put
+// using [NewPopoverMenuFromModel]. If you need to put
 // other widgets such as a `GtkSpinButton` or a `GtkSwitch` into a popover,
-// you can use [method@Gtk.PopoverMenu.add_child].
+// you can use [PopoverMenu.AddChild].
 //
 // For more dialog-like behavior, use a plain `GtkPopover`.
 //
-// ## Menu models
+// # Menu models
 //
 // The XML format understood by `GtkBuilder` for `GMenuModel` consists
-// of a toplevel `&lt;menu&gt;` element, which contains one or more `&lt;item&gt;`
-// elements. Each `&lt;item&gt;` element contains `&lt;attribute&gt;` and `&lt;link&gt;`
-// elements with a mandatory name attribute. `&lt;link&gt;` elements have the
-// same content model as `&lt;menu&gt;`. Instead of `&lt;link name="submenu"&gt;`
-// or `&lt;link name="section"&gt;`, you can use `&lt;submenu&gt;` or `&lt;section&gt;`
+// of a toplevel `<menu>` element, which contains one or more `<item>`
+// elements. Each `<item>` element contains `<attribute>` and `<link>`
+// elements with a mandatory name attribute. `<link>` elements have the
+// same content model as `<menu>`. Instead of `<link name="submenu">`
+// or `<link name="section">`, you can use `<submenu>` or `<section>`
 // elements.
 //
-// ```xml
-// &lt;menu id='app-menu'&gt;
-//
-//	&lt;section&gt;
-//	  &lt;item&gt;
-//	    &lt;attribute name='label' translatable='yes'&gt;_New Window&lt;/attribute&gt;
-//	    &lt;attribute name='action'&gt;app.new&lt;/attribute&gt;
-//	  &lt;/item&gt;
-//	  &lt;item&gt;
-//	    &lt;attribute name='label' translatable='yes'&gt;_About Sunny&lt;/attribute&gt;
-//	    &lt;attribute name='action'&gt;app.about&lt;/attribute&gt;
-//	  &lt;/item&gt;
-//	  &lt;item&gt;
-//	    &lt;attribute name='label' translatable='yes'&gt;_Quit&lt;/attribute&gt;
-//	    &lt;attribute name='action'&gt;app.quit&lt;/attribute&gt;
-//	  &lt;/item&gt;
-//	&lt;/section&gt;
-//
-// &lt;/menu&gt;
-// ```
+//	<menu id='app-menu'>
+//	  <section>
+//	    <item>
+//	      <attribute name='label' translatable='yes'>_New Window</attribute>
+//	      <attribute name='action'>app.new</attribute>
+//	    </item>
+//	    <item>
+//	      <attribute name='label' translatable='yes'>_About Sunny</attribute>
+//	      <attribute name='action'>app.about</attribute>
+//	    </item>
+//	    <item>
+//	      <attribute name='label' translatable='yes'>_Quit</attribute>
+//	      <attribute name='action'>app.quit</attribute>
+//	    </item>
+//	  </section>
+//	</menu>
 //
 // Attribute values can be translated using gettext, like other `GtkBuilder`
-// content. `&lt;attribute&gt;` elements can be marked for translation with a
+// content. `<attribute>` elements can be marked for translation with a
 // `translatable="yes"` attribute. It is also possible to specify message
 // context and translator comments, using the context and comments attributes.
 // To make use of this, the `GtkBuilder` must have been given the gettext
@@ -73,7 +61,7 @@ import (
 // The following attributes are used when constructing menu items:
 //
 //   - "label": a user-visible string to display
-//   - "use-markup": whether the text in the menu item includes [Pango markup](https://docs.gtk.org/Pango/pango_markup.html)
+//   - "use-markup": whether the text in the menu item includes [Pango markup]
 //   - "action": the prefixed name of the action to trigger
 //   - "target": the parameter to use when activating the action
 //   - "icon" and "verb-icon": names of icons that may be displayed
@@ -81,10 +69,10 @@ import (
 //     whether a submenu is open
 //   - "hidden-when": a string used to determine when the item will be hidden.
 //     Possible values include "action-disabled", "action-missing", "macos-menubar".
-//     This is mainly useful for exported menus, see [method@Gtk.Application.set_menubar].
+//     This is mainly useful for exported menus, see [Application.SetMenubar].
 //   - "custom": a string used to match against the ID of a custom child added with
-//     [method@Gtk.PopoverMenu.add_child], [method@Gtk.PopoverMenuBar.add_child],
-//     or in the ui file with `&lt;child type="ID"&gt;`.
+//     [PopoverMenu.AddChild], [PopoverMenuBar.AddChild],
+//     or in the ui file with `<child type="ID">`.
 //
 // The following attributes are used when constructing sections:
 //
@@ -102,18 +90,18 @@ import (
 //   - "label": a user-visible string to display
 //   - "icon": icon name to display
 //   - "gtk-macos-special": (macOS only, ignored by others) Add special meaning to a menu
-//     in the macOS menu bar. See [Using GTK on Apple macOS](osx.html).
+//     in the macOS menu bar. See Using GTK on Apple macOS.
 //
 // Menu items will also show accelerators, which are usually associated
-// with actions via [method@Gtk.Application.set_accels_for_action],
-// [method@WidgetClass.add_binding_action] or
-// [method@Gtk.ShortcutController.add_shortcut].
+// with actions via [Application.SetAccelsForAction],
+// add_binding_action or
+// [ShortcutController.AddShortcut].
 //
 // # Shortcuts and Gestures
 //
 // `GtkPopoverMenu` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Space&lt;/kbd&gt; activates the default widget.
+// - Space activates the default widget.
 //
 // # CSS Nodes
 //
@@ -130,10 +118,12 @@ import (
 //
 // # Accessibility
 //
-// `GtkPopoverMenu` uses the [enum@Gtk.AccessibleRole.menu] role, and its
-// items use the [enum@Gtk.AccessibleRole.menu_item],
-// [enum@Gtk.AccessibleRole.checkbox] or [enum@Gtk.AccessibleRole.menu_item_radio]
+// `GtkPopoverMenu` uses the [AccessibleRoleMenuValue] role, and its
+// items use the [AccessibleRoleMenuItemValue],
+// [AccessibleRoleCheckboxValue] or [AccessibleRoleMenuItemRadioValue]
 // roles, depending on the action they are connected to.
+//
+// [Pango markup]: https://docs.gtk.org/Pango/pango_markup.html
 type PopoverMenu struct {
 	Popover
 }
@@ -152,18 +142,18 @@ func PopoverMenuNewFromInternalPtr(ptr uintptr) *PopoverMenu {
 
 var xNewPopoverMenuFromModel func(uintptr) uintptr
 
-// Creates a `GtkPopoverMenu` and populates it according to @model.
+// Creates a `GtkPopoverMenu` and populates it according to model.
 //
 // The created buttons are connected to actions found in the
 // `GtkApplicationWindow` to which the popover belongs - typically
 // by means of being attached to a widget that is contained within
 // the `GtkApplicationWindow`s widget hierarchy.
 //
-// Actions can also be added using [method@Gtk.Widget.insert_action_group]
+// Actions can also be added using [Widget.InsertActionGroup]
 // on the menus attach widget or on any of its parent widgets.
 //
 // This function creates menus with sliding submenus.
-// See [ctor@Gtk.PopoverMenu.new_from_model_full] for a way
+// See [NewPopoverMenuFromModelFull] for a way
 // to control this.
 func NewPopoverMenuFromModel(ModelVar *gio.MenuModel) *PopoverMenu {
 	var cls *PopoverMenu
@@ -181,12 +171,12 @@ func NewPopoverMenuFromModel(ModelVar *gio.MenuModel) *PopoverMenu {
 
 var xNewPopoverMenuFromModelFull func(uintptr, PopoverMenuFlags) uintptr
 
-// Creates a `GtkPopoverMenu` and populates it according to @model.
+// Creates a `GtkPopoverMenu` and populates it according to model.
 //
 // The created buttons are connected to actions found in the
 // action groups that are accessible from the parent widget.
 // This includes the `GtkApplicationWindow` to which the popover
-// belongs. Actions can also be added using [method@Gtk.Widget.insert_action_group]
+// belongs. Actions can also be added using [Widget.InsertActionGroup]
 // on the parent widget or on any of its parent widgets.
 func NewPopoverMenuFromModelFull(ModelVar *gio.MenuModel, FlagsVar PopoverMenuFlags) *PopoverMenu {
 	var cls *PopoverMenu
@@ -206,8 +196,8 @@ var xPopoverMenuAddChild func(uintptr, uintptr, string) bool
 
 // Adds a custom widget to a generated menu.
 //
-// For this to work, the menu model of @popover must have
-// an item with a `custom` attribute that matches @id.
+// For this to work, the menu model of popover must have
+// an item with a `custom` attribute that matches id.
 func (x *PopoverMenu) AddChild(ChildVar *Widget, IdVar string) bool {
 
 	cret := xPopoverMenuAddChild(x.GoPointer(), ChildVar.GoPointer(), IdVar)
@@ -216,7 +206,7 @@ func (x *PopoverMenu) AddChild(ChildVar *Widget, IdVar string) bool {
 
 var xPopoverMenuGetFlags func(uintptr) PopoverMenuFlags
 
-// Returns the flags that @popover uses to create/display a menu from its model.
+// Returns the flags that popover uses to create/display a menu from its model.
 func (x *PopoverMenu) GetFlags() PopoverMenuFlags {
 
 	cret := xPopoverMenuGetFlags(x.GoPointer())
@@ -243,7 +233,7 @@ func (x *PopoverMenu) GetMenuModel() *gio.MenuModel {
 var xPopoverMenuRemoveChild func(uintptr, uintptr) bool
 
 // Removes a widget that has previously been added with
-// [method@Gtk.PopoverMenu.add_child]
+// [PopoverMenu.AddChild]
 func (x *PopoverMenu) RemoveChild(ChildVar *Widget) bool {
 
 	cret := xPopoverMenuRemoveChild(x.GoPointer(), ChildVar.GoPointer())
@@ -252,7 +242,7 @@ func (x *PopoverMenu) RemoveChild(ChildVar *Widget) bool {
 
 var xPopoverMenuSetFlags func(uintptr, PopoverMenuFlags)
 
-// Sets the flags that @popover uses to create/display a menu from its model.
+// Sets the flags that popover uses to create/display a menu from its model.
 //
 // If a model is set and the flags change, contents are rebuilt, so if setting
 // properties individually, set flags before model to avoid a redundant rebuild.
@@ -264,11 +254,11 @@ func (x *PopoverMenu) SetFlags(FlagsVar PopoverMenuFlags) {
 
 var xPopoverMenuSetMenuModel func(uintptr, uintptr)
 
-// Sets a new menu model on @popover.
+// Sets a new menu model on popover.
 //
-// The existing contents of @popover are removed, and
-// the @popover is populated with new contents according
-// to @model.
+// The existing contents of popover are removed, and
+// the popover is populated with new contents according
+// to model.
 func (x *PopoverMenu) SetMenuModel(ModelVar *gio.MenuModel) {
 
 	xPopoverMenuSetMenuModel(x.GoPointer(), ModelVar.GoPointer())
@@ -464,7 +454,7 @@ func (x *PopoverMenu) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -472,14 +462,13 @@ func (x *PopoverMenu) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenu) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -503,19 +492,17 @@ func (x *PopoverMenu) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenu) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -536,7 +523,7 @@ func (x *PopoverMenu) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -545,14 +532,12 @@ func (x *PopoverMenu) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenu) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -571,10 +556,10 @@ func (x *PopoverMenu) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PopoverMenu) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -611,10 +596,10 @@ func (x *PopoverMenu) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *PopoverMenu) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -644,7 +629,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -653,14 +638,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xPopoverMenuGLibType, libs, "gtk_popover_menu_get_type")
 
-	core.PuregoSafeRegister(&xNewPopoverMenuFromModel, libs, "gtk_popover_menu_new_from_model")
-	core.PuregoSafeRegister(&xNewPopoverMenuFromModelFull, libs, "gtk_popover_menu_new_from_model_full")
+	core.PuregoRegisterSince(&xNewPopoverMenuFromModel, libs, "gtk_popover_menu_new_from_model", "GTK", "")
+	core.PuregoRegisterSince(&xNewPopoverMenuFromModelFull, libs, "gtk_popover_menu_new_from_model_full", "GTK", "")
 
-	core.PuregoSafeRegister(&xPopoverMenuAddChild, libs, "gtk_popover_menu_add_child")
-	core.PuregoSafeRegister(&xPopoverMenuGetFlags, libs, "gtk_popover_menu_get_flags")
-	core.PuregoSafeRegister(&xPopoverMenuGetMenuModel, libs, "gtk_popover_menu_get_menu_model")
-	core.PuregoSafeRegister(&xPopoverMenuRemoveChild, libs, "gtk_popover_menu_remove_child")
-	core.PuregoSafeRegister(&xPopoverMenuSetFlags, libs, "gtk_popover_menu_set_flags")
-	core.PuregoSafeRegister(&xPopoverMenuSetMenuModel, libs, "gtk_popover_menu_set_menu_model")
+	core.PuregoRegisterSince(&xPopoverMenuAddChild, libs, "gtk_popover_menu_add_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuGetFlags, libs, "gtk_popover_menu_get_flags", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPopoverMenuGetMenuModel, libs, "gtk_popover_menu_get_menu_model", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuRemoveChild, libs, "gtk_popover_menu_remove_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuSetFlags, libs, "gtk_popover_menu_set_flags", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPopoverMenuSetMenuModel, libs, "gtk_popover_menu_set_menu_model", "GTK", "")
 
 }
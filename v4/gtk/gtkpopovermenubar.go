@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -11,27 +10,16 @@ import (
 
 // Presents a horizontal bar of items that pop up menus when clicked.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="menubar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPopoverMenuBar" src="menubar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The only way to create instances of `GtkPopoverMenuBar` is
 // from a `GMenuModel`.
 //
 // # CSS nodes
 //
-// ```
-// menubar
-// ├── item[.active]
-// ┊   ╰── popover
-// ╰── item
-//
-//	╰── popover
-//
-// ```
+//	menubar
+//	├── item[.active]
+//	┊   ╰── popover
+//	╰── item
+//	    ╰── popover
 //
 // `GtkPopoverMenuBar` has a single CSS node with name menubar, below which
 // each item has its CSS node, and below that the corresponding popover.
@@ -41,9 +29,9 @@ import (
 //
 // # Accessibility
 //
-// `GtkPopoverMenuBar` uses the [enum@Gtk.AccessibleRole.menu_bar] role,
-// the menu items use the [enum@Gtk.AccessibleRole.menu_item] role and
-// the menus use the [enum@Gtk.AccessibleRole.menu] role.
+// `GtkPopoverMenuBar` uses the [AccessibleRoleMenuBarValue] role,
+// the menu items use the [AccessibleRoleMenuItemValue] role and
+// the menus use the [AccessibleRoleMenuValue] role.
 type PopoverMenuBar struct {
 	Widget
 }
@@ -81,8 +69,8 @@ var xPopoverMenuBarAddChild func(uintptr, uintptr, string) bool
 
 // Adds a custom widget to a generated menubar.
 //
-// For this to work, the menu model of @bar must have an
-// item with a `custom` attribute that matches @id.
+// For this to work, the menu model of bar must have an
+// item with a `custom` attribute that matches id.
 func (x *PopoverMenuBar) AddChild(ChildVar *Widget, IdVar string) bool {
 
 	cret := xPopoverMenuBarAddChild(x.GoPointer(), ChildVar.GoPointer(), IdVar)
@@ -91,7 +79,7 @@ func (x *PopoverMenuBar) AddChild(ChildVar *Widget, IdVar string) bool {
 
 var xPopoverMenuBarGetMenuModel func(uintptr) uintptr
 
-// Returns the model from which the contents of @bar are taken.
+// Returns the model from which the contents of bar are taken.
 func (x *PopoverMenuBar) GetMenuModel() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -109,7 +97,7 @@ func (x *PopoverMenuBar) GetMenuModel() *gio.MenuModel {
 var xPopoverMenuBarRemoveChild func(uintptr, uintptr) bool
 
 // Removes a widget that has previously been added with
-// gtk_popover_menu_bar_add_child().
+// [PopoverMenuBar.AddChild].
 func (x *PopoverMenuBar) RemoveChild(ChildVar *Widget) bool {
 
 	cret := xPopoverMenuBarRemoveChild(x.GoPointer(), ChildVar.GoPointer())
@@ -118,7 +106,7 @@ func (x *PopoverMenuBar) RemoveChild(ChildVar *Widget) bool {
 
 var xPopoverMenuBarSetMenuModel func(uintptr, uintptr)
 
-// Sets a menu model from which @bar should take
+// Sets a menu model from which bar should take
 // its contents.
 func (x *PopoverMenuBar) SetMenuModel(ModelVar *gio.MenuModel) {
 
@@ -298,7 +286,7 @@ func (x *PopoverMenuBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -306,14 +294,13 @@ func (x *PopoverMenuBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenuBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -337,19 +324,17 @@ func (x *PopoverMenuBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenuBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -370,7 +355,7 @@ func (x *PopoverMenuBar) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -379,14 +364,12 @@ func (x *PopoverMenuBar) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PopoverMenuBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -405,10 +388,10 @@ func (x *PopoverMenuBar) UpdateStateValue(NStatesVar int, StatesVar []Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PopoverMenuBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -420,7 +403,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -429,11 +412,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xPopoverMenuBarGLibType, libs, "gtk_popover_menu_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewPopoverMenuBarFromModel, libs, "gtk_popover_menu_bar_new_from_model")
+	core.PuregoRegisterSince(&xNewPopoverMenuBarFromModel, libs, "gtk_popover_menu_bar_new_from_model", "GTK", "")
 
-	core.PuregoSafeRegister(&xPopoverMenuBarAddChild, libs, "gtk_popover_menu_bar_add_child")
-	core.PuregoSafeRegister(&xPopoverMenuBarGetMenuModel, libs, "gtk_popover_menu_bar_get_menu_model")
-	core.PuregoSafeRegister(&xPopoverMenuBarRemoveChild, libs, "gtk_popover_menu_bar_remove_child")
-	core.PuregoSafeRegister(&xPopoverMenuBarSetMenuModel, libs, "gtk_popover_menu_bar_set_menu_model")
+	core.PuregoRegisterSince(&xPopoverMenuBarAddChild, libs, "gtk_popover_menu_bar_add_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuBarGetMenuModel, libs, "gtk_popover_menu_bar_get_menu_model", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuBarRemoveChild, libs, "gtk_popover_menu_bar_remove_child", "GTK", "")
+	core.PuregoRegisterSince(&xPopoverMenuBarSetMenuModel, libs, "gtk_popover_menu_bar_set_menu_model", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -14,25 +13,22 @@ import (
 // drawing pages for printing.
 //
 // This includes the cairo context and important parameters like page size
-// and resolution. It also lets you easily create [class@Pango.Layout] and
-// [class@Pango.Context] objects that match the font metrics of the cairo surface.
+// and resolution. It also lets you easily create [pango.Layout] and
+// [pango.Context] objects that match the font metrics of the cairo surface.
 //
 // `GtkPrintContext` objects get passed to the
-// [signal@Gtk.PrintOperation::begin-print],
-// [signal@Gtk.PrintOperation::end-print],
-// [signal@Gtk.PrintOperation::request-page-setup] and
-// [signal@Gtk.PrintOperation::draw-page] signals on the
-// [class@Gtk.PrintOperation] object.
+// [PrintOperation.ConnectBeginPrintFunc],
+// [PrintOperation.ConnectEndPrintFunc],
+// [PrintOperation.ConnectRequestPageSetupFunc] and
+// [PrintOperation.ConnectDrawPageFunc] signals on the
+// [PrintOperation] object.
 //
-// ## Using GtkPrintContext in a ::draw-page callback
-//
-// ```c
-// static void
-// draw_page (GtkPrintOperation *operation,
-//
-//	GtkPrintContext   *context,
-//	int                page_nr)
+// # Using GtkPrintContext in a ::draw-page callback
 //
+//	static void
+//	draw_page (GtkPrintOperation *operation,
+//	           GtkPrintContext   *context,
+//	           int                page_nr)
 //	{
 //	  cairo_t *cr;
 //	  PangoLayout *layout;
@@ -80,8 +76,6 @@ import (
 //
 //	  g_object_unref (layout);
 //	}
-//
-// ```
 type PrintContext struct {
 	gobject.Object
 }
@@ -256,7 +250,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -265,16 +259,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintContextGLibType, libs, "gtk_print_context_get_type")
 
-	core.PuregoSafeRegister(&xPrintContextCreatePangoContext, libs, "gtk_print_context_create_pango_context")
-	core.PuregoSafeRegister(&xPrintContextCreatePangoLayout, libs, "gtk_print_context_create_pango_layout")
-	core.PuregoSafeRegister(&xPrintContextGetCairoContext, libs, "gtk_print_context_get_cairo_context")
-	core.PuregoSafeRegister(&xPrintContextGetDpiX, libs, "gtk_print_context_get_dpi_x")
-	core.PuregoSafeRegister(&xPrintContextGetDpiY, libs, "gtk_print_context_get_dpi_y")
-	core.PuregoSafeRegister(&xPrintContextGetHardMargins, libs, "gtk_print_context_get_hard_margins")
-	core.PuregoSafeRegister(&xPrintContextGetHeight, libs, "gtk_print_context_get_height")
-	core.PuregoSafeRegister(&xPrintContextGetPageSetup, libs, "gtk_print_context_get_page_setup")
-	core.PuregoSafeRegister(&xPrintContextGetPangoFontmap, libs, "gtk_print_context_get_pango_fontmap")
-	core.PuregoSafeRegister(&xPrintContextGetWidth, libs, "gtk_print_context_get_width")
-	core.PuregoSafeRegister(&xPrintContextSetCairoContext, libs, "gtk_print_context_set_cairo_context")
+	core.PuregoRegisterSince(&xPrintContextCreatePangoContext, libs, "gtk_print_context_create_pango_context", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextCreatePangoLayout, libs, "gtk_print_context_create_pango_layout", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetCairoContext, libs, "gtk_print_context_get_cairo_context", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetDpiX, libs, "gtk_print_context_get_dpi_x", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetDpiY, libs, "gtk_print_context_get_dpi_y", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetHardMargins, libs, "gtk_print_context_get_hard_margins", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetHeight, libs, "gtk_print_context_get_height", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetPageSetup, libs, "gtk_print_context_get_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetPangoFontmap, libs, "gtk_print_context_get_pango_fontmap", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextGetWidth, libs, "gtk_print_context_get_width", "GTK", "")
+	core.PuregoRegisterSince(&xPrintContextSetCairoContext, libs, "gtk_print_context_set_cairo_context", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -25,8 +24,8 @@ func (x *PrintDialogClass) GoPointer() uintptr {
 
 // An auxiliary object for printing that allows decoupling the setup from the printing.
 //
-// A print setup is obtained by calling [method@Gtk.PrintDialog.setup],
-// and can later be passed to print functions such as [method@Gtk.PrintDialog.print].
+// A print setup is obtained by calling [PrintDialog.Setup],
+// and can later be passed to print functions such as [PrintDialog.Print].
 //
 // Print setups can be reused for multiple print calls.
 //
@@ -48,7 +47,7 @@ func (x *PrintSetup) GoPointer() uintptr {
 
 var xPrintSetupGetPageSetup func(uintptr) uintptr
 
-// Returns the page setup of @setup.
+// Returns the page setup of setup.
 //
 // It may be different from the `GtkPrintDialog`'s page setup
 // if the user changed it during the setup process.
@@ -68,7 +67,7 @@ func (x *PrintSetup) GetPageSetup() *PageSetup {
 
 var xPrintSetupGetPrintSettings func(uintptr) uintptr
 
-// Returns the print settings of @setup.
+// Returns the print settings of setup.
 //
 // They may be different from the `GtkPrintDialog`'s settings
 // if the user changed them during the setup process.
@@ -88,7 +87,7 @@ func (x *PrintSetup) GetPrintSettings() *PrintSettings {
 
 var xPrintSetupRef func(uintptr) *PrintSetup
 
-// Increase the reference count of @setup.
+// Increase the reference count of setup.
 func (x *PrintSetup) Ref() *PrintSetup {
 
 	cret := xPrintSetupRef(x.GoPointer())
@@ -97,7 +96,7 @@ func (x *PrintSetup) Ref() *PrintSetup {
 
 var xPrintSetupUnref func(uintptr)
 
-// Decrease the reference count of @setup.
+// Decrease the reference count of setup.
 //
 // If the reference count reaches zero,
 // the object is freed.
@@ -114,10 +113,10 @@ func (x *PrintSetup) Unref() {
 //	the dialog, such as a title for the dialog and whether it should
 //	be modal.
 //
-// The dialog is shown with the [method@Gtk.PrintDialog.setup] function.
+// The dialog is shown with the [PrintDialog.Setup] function.
 //
-// The actual printing can be done with [method@Gtk.PrintDialog.print] or
-// [method@Gtk.PrintDialog.print_file]. These APIs follows the GIO async pattern,
+// The actual printing can be done with [PrintDialog.Print] or
+// [PrintDialog.PrintFile]. These APIs follows the GIO async pattern,
 // and the results can be obtained by calling the corresponding finish methods.
 type PrintDialog struct {
 	gobject.Object
@@ -220,10 +219,10 @@ var xPrintDialogPrint func(uintptr, uintptr, *PrintSetup, uintptr, uintptr, uint
 
 // This function prints content from a stream.
 //
-// If you pass `NULL` as @setup, then this method will present a print dialog.
+// If you pass `NULL` as setup, then this method will present a print dialog.
 // Otherwise, it will attempt to print directly, without user interaction.
 //
-// The @callback will be called when the printing is done.
+// The callback will be called when the printing is done.
 func (x *PrintDialog) Print(ParentVar *Window, SetupVar *PrintSetup, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
 	var CallbackVarRef uintptr
@@ -233,11 +232,12 @@ func (x *PrintDialog) Print(ParentVar *Window, SetupVar *PrintSetup, Cancellable
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -249,7 +249,7 @@ var xPrintDialogPrintFile func(uintptr, uintptr, *PrintSetup, uintptr, uintptr,
 
 // This function prints a file.
 //
-// If you pass `NULL` as @setup, then this method will present a print dialog.
+// If you pass `NULL` as setup, then this method will present a print dialog.
 // Otherwise, it will attempt to print directly, without user interaction.
 func (x *PrintDialog) PrintFile(ParentVar *Window, SetupVar *PrintSetup, FileVar gio.File, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -260,11 +260,12 @@ func (x *PrintDialog) PrintFile(ParentVar *Window, SetupVar *PrintSetup, FileVar
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -274,17 +275,17 @@ func (x *PrintDialog) PrintFile(ParentVar *Window, SetupVar *PrintSetup, FileVar
 
 var xPrintDialogPrintFileFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes the [method@Gtk.PrintDialog.print_file] call and
+// Finishes the [PrintDialog.PrintFile] call and
 // returns the results.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *PrintDialog) PrintFileFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPrintDialogPrintFileFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -292,20 +293,20 @@ func (x *PrintDialog) PrintFileFinish(ResultVar gio.AsyncResult) (bool, error) {
 
 var xPrintDialogPrintFinish func(uintptr, uintptr, **glib.Error) uintptr
 
-// Finishes the [method@Gtk.PrintDialog.print] call and
+// Finishes the [PrintDialog.Print] call and
 // returns the results.
 //
 // If the call was successful, the content to be printed should be
 // written to the returned output stream. Otherwise, `NULL` is returned.
 //
 // The overall results of the print operation will be returned in the
-// [method@Gio.OutputStream.close] call, so if you are interested in the
+// [gio.OutputStream.Close] call, so if you are interested in the
 // results, you need to explicitly close the output stream (it will be
 // closed automatically if you just unref it). Be aware that the close
 // call may not be instant as it operation will for the printer to finish
 // printing.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *PrintDialog) PrintFinish(ResultVar gio.AsyncResult) (*gio.OutputStream, error) {
 	var cls *gio.OutputStream
@@ -314,12 +315,15 @@ func (x *PrintDialog) PrintFinish(ResultVar gio.AsyncResult) (*gio.OutputStream,
 	cret := xPrintDialogPrintFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.OutputStream{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -329,7 +333,7 @@ var xPrintDialogSetAcceptLabel func(uintptr, string)
 
 // Sets the label that will be shown on the
 // accept button of the print dialog shown for
-// [method@Gtk.PrintDialog.setup].
+// [PrintDialog.Setup].
 func (x *PrintDialog) SetAcceptLabel(AcceptLabelVar string) {
 
 	xPrintDialogSetAcceptLabel(x.GoPointer(), AcceptLabelVar)
@@ -379,13 +383,13 @@ var xPrintDialogSetup func(uintptr, uintptr, uintptr, uintptr, uintptr)
 // This function presents a print dialog to let the user select a printer,
 // and set up print settings and page setup.
 //
-// The @callback will be called when the dialog is dismissed.
-// The obtained [struct@Gtk.PrintSetup] can then be passed
-// to [method@Gtk.PrintDialog.print] or [method@Gtk.PrintDialog.print_file].
+// The callback will be called when the dialog is dismissed.
+// The obtained [PrintSetup] can then be passed
+// to [PrintDialog.Print] or [PrintDialog.PrintFile].
 //
 // One possible use for this method is to have the user select a printer,
 // then show a page setup UI in the application (e.g. to arrange images
-// on a page), then call [method@Gtk.PrintDialog.print] on @self
+// on a page), then call [PrintDialog.Print] on self
 // to do the printing without further user interaction.
 func (x *PrintDialog) Setup(ParentVar *Window, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
 
@@ -396,11 +400,12 @@ func (x *PrintDialog) Setup(ParentVar *Window, CancellableVar *gio.Cancellable,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -410,20 +415,20 @@ func (x *PrintDialog) Setup(ParentVar *Window, CancellableVar *gio.Cancellable,
 
 var xPrintDialogSetupFinish func(uintptr, uintptr, **glib.Error) *PrintSetup
 
-// Finishes the [method@Gtk.PrintDialog.setup] call.
+// Finishes the [PrintDialog.Setup] call.
 //
-// If the call was successful, it returns a [struct@Gtk.PrintSetup]
+// If the call was successful, it returns a [PrintSetup]
 // which contains the print settings and page setup information that
 // will be used to print.
 //
-// Note that this function returns a [error@Gtk.DialogError.DISMISSED]
+// Note that this function returns a DialogError.DISMISSED
 // error if the user cancels the dialog.
 func (x *PrintDialog) SetupFinish(ResultVar gio.AsyncResult) (*PrintSetup, error) {
 	var cerr *glib.Error
 
 	cret := xPrintDialogSetupFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -442,7 +447,7 @@ func (c *PrintDialog) SetGoPointer(ptr uintptr) {
 
 // SetPropertyAcceptLabel sets the "accept-label" property.
 // A label that may be shown on the accept button of a print dialog
-// that is presented by [method@Gtk.PrintDialog.setup].
+// that is presented by [PrintDialog.Setup].
 func (x *PrintDialog) SetPropertyAcceptLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -452,7 +457,7 @@ func (x *PrintDialog) SetPropertyAcceptLabel(value string) {
 
 // GetPropertyAcceptLabel gets the "accept-label" property.
 // A label that may be shown on the accept button of a print dialog
-// that is presented by [method@Gtk.PrintDialog.setup].
+// that is presented by [PrintDialog.Setup].
 func (x *PrintDialog) GetPropertyAcceptLabel() string {
 	var v gobject.Value
 	x.GetProperty("accept-label", &v)
@@ -478,7 +483,7 @@ func (x *PrintDialog) GetPropertyModal() bool {
 
 // SetPropertyTitle sets the "title" property.
 // A title that may be shown on the print dialog that is
-// presented by [method@Gtk.PrintDialog.setup].
+// presented by [PrintDialog.Setup].
 func (x *PrintDialog) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -488,7 +493,7 @@ func (x *PrintDialog) SetPropertyTitle(value string) {
 
 // GetPropertyTitle gets the "title" property.
 // A title that may be shown on the print dialog that is
-// presented by [method@Gtk.PrintDialog.setup].
+// presented by [PrintDialog.Setup].
 func (x *PrintDialog) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -500,7 +505,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -509,30 +514,32 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintSetupGLibType, libs, "gtk_print_setup_get_type")
 
-	core.PuregoSafeRegister(&xPrintSetupGetPageSetup, libs, "gtk_print_setup_get_page_setup")
-	core.PuregoSafeRegister(&xPrintSetupGetPrintSettings, libs, "gtk_print_setup_get_print_settings")
-	core.PuregoSafeRegister(&xPrintSetupRef, libs, "gtk_print_setup_ref")
-	core.PuregoSafeRegister(&xPrintSetupUnref, libs, "gtk_print_setup_unref")
+	core.PuregoRegisterSince(&xPrintSetupGetPageSetup, libs, "gtk_print_setup_get_page_setup", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintSetupGetPrintSettings, libs, "gtk_print_setup_get_print_settings", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintSetupRef, libs, "gtk_print_setup_ref", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintSetupUnref, libs, "gtk_print_setup_unref", "GTK", "4.14")
 
 	core.PuregoSafeRegister(&xPrintDialogGLibType, libs, "gtk_print_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewPrintDialog, libs, "gtk_print_dialog_new")
-
-	core.PuregoSafeRegister(&xPrintDialogGetAcceptLabel, libs, "gtk_print_dialog_get_accept_label")
-	core.PuregoSafeRegister(&xPrintDialogGetModal, libs, "gtk_print_dialog_get_modal")
-	core.PuregoSafeRegister(&xPrintDialogGetPageSetup, libs, "gtk_print_dialog_get_page_setup")
-	core.PuregoSafeRegister(&xPrintDialogGetPrintSettings, libs, "gtk_print_dialog_get_print_settings")
-	core.PuregoSafeRegister(&xPrintDialogGetTitle, libs, "gtk_print_dialog_get_title")
-	core.PuregoSafeRegister(&xPrintDialogPrint, libs, "gtk_print_dialog_print")
-	core.PuregoSafeRegister(&xPrintDialogPrintFile, libs, "gtk_print_dialog_print_file")
-	core.PuregoSafeRegister(&xPrintDialogPrintFileFinish, libs, "gtk_print_dialog_print_file_finish")
-	core.PuregoSafeRegister(&xPrintDialogPrintFinish, libs, "gtk_print_dialog_print_finish")
-	core.PuregoSafeRegister(&xPrintDialogSetAcceptLabel, libs, "gtk_print_dialog_set_accept_label")
-	core.PuregoSafeRegister(&xPrintDialogSetModal, libs, "gtk_print_dialog_set_modal")
-	core.PuregoSafeRegister(&xPrintDialogSetPageSetup, libs, "gtk_print_dialog_set_page_setup")
-	core.PuregoSafeRegister(&xPrintDialogSetPrintSettings, libs, "gtk_print_dialog_set_print_settings")
-	core.PuregoSafeRegister(&xPrintDialogSetTitle, libs, "gtk_print_dialog_set_title")
-	core.PuregoSafeRegister(&xPrintDialogSetup, libs, "gtk_print_dialog_setup")
-	core.PuregoSafeRegister(&xPrintDialogSetupFinish, libs, "gtk_print_dialog_setup_finish")
+	gobject.CheckClassSize("GtkPrintDialogClass", xPrintDialogGLibType, unsafe.Sizeof(PrintDialogClass{}))
+
+	core.PuregoRegisterSince(&xNewPrintDialog, libs, "gtk_print_dialog_new", "GTK", "4.14")
+
+	core.PuregoRegisterSince(&xPrintDialogGetAcceptLabel, libs, "gtk_print_dialog_get_accept_label", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogGetModal, libs, "gtk_print_dialog_get_modal", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogGetPageSetup, libs, "gtk_print_dialog_get_page_setup", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogGetPrintSettings, libs, "gtk_print_dialog_get_print_settings", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogGetTitle, libs, "gtk_print_dialog_get_title", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogPrint, libs, "gtk_print_dialog_print", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogPrintFile, libs, "gtk_print_dialog_print_file", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogPrintFileFinish, libs, "gtk_print_dialog_print_file_finish", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogPrintFinish, libs, "gtk_print_dialog_print_finish", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetAcceptLabel, libs, "gtk_print_dialog_set_accept_label", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetModal, libs, "gtk_print_dialog_set_modal", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetPageSetup, libs, "gtk_print_dialog_set_page_setup", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetPrintSettings, libs, "gtk_print_dialog_set_print_settings", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetTitle, libs, "gtk_print_dialog_set_title", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetup, libs, "gtk_print_dialog_setup", "GTK", "4.14")
+	core.PuregoRegisterSince(&xPrintDialogSetupFinish, libs, "gtk_print_dialog_setup_finish", "GTK", "4.14")
 
 }
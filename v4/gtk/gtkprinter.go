@@ -2,19 +2,20 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of function passed to gtk_enumerate_printers().
+// The type of function passed to [EnumeratePrinters].
 //
-// Note that you need to ref @printer, if you want to keep
+// Note that you need to ref printer, if you want to keep
 // a reference to it after the function has returned.
 type PrinterFunc func(uintptr, uintptr) bool
 
@@ -29,10 +30,10 @@ func (x *PrintBackend) GoPointer() uintptr {
 
 // Specifies which features the print dialog should offer.
 //
-// If neither %GTK_PRINT_CAPABILITY_GENERATE_PDF nor
-// %GTK_PRINT_CAPABILITY_GENERATE_PS is specified, GTK assumes that all
+// If neither [PrintCapabilityGeneratePdfValue] nor
+// [PrintCapabilityGeneratePsValue] is specified, GTK assumes that all
 // formats are supported.
-type PrintCapabilities int
+type PrintCapabilities uint32
 
 var xPrintCapabilitiesGLibType func() types.GType
 
@@ -68,11 +69,84 @@ const (
 	PrintCapabilityNumberUpLayoutValue PrintCapabilities = 512
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (PrintCapabilities) GLibType() types.GType {
+	return xPrintCapabilitiesGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x PrintCapabilities) Has(flags PrintCapabilities) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x PrintCapabilities) Set(flags PrintCapabilities) PrintCapabilities {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x PrintCapabilities) Clear(flags PrintCapabilities) PrintCapabilities {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x PrintCapabilities) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&PrintCapabilityPageSetValue != 0 && x&PrintCapabilityPageSetValue == PrintCapabilityPageSetValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_PAGE_SET")
+		rest &^= PrintCapabilityPageSetValue
+	}
+	if rest&PrintCapabilityCopiesValue != 0 && x&PrintCapabilityCopiesValue == PrintCapabilityCopiesValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_COPIES")
+		rest &^= PrintCapabilityCopiesValue
+	}
+	if rest&PrintCapabilityCollateValue != 0 && x&PrintCapabilityCollateValue == PrintCapabilityCollateValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_COLLATE")
+		rest &^= PrintCapabilityCollateValue
+	}
+	if rest&PrintCapabilityReverseValue != 0 && x&PrintCapabilityReverseValue == PrintCapabilityReverseValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_REVERSE")
+		rest &^= PrintCapabilityReverseValue
+	}
+	if rest&PrintCapabilityScaleValue != 0 && x&PrintCapabilityScaleValue == PrintCapabilityScaleValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_SCALE")
+		rest &^= PrintCapabilityScaleValue
+	}
+	if rest&PrintCapabilityGeneratePdfValue != 0 && x&PrintCapabilityGeneratePdfValue == PrintCapabilityGeneratePdfValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_GENERATE_PDF")
+		rest &^= PrintCapabilityGeneratePdfValue
+	}
+	if rest&PrintCapabilityGeneratePsValue != 0 && x&PrintCapabilityGeneratePsValue == PrintCapabilityGeneratePsValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_GENERATE_PS")
+		rest &^= PrintCapabilityGeneratePsValue
+	}
+	if rest&PrintCapabilityPreviewValue != 0 && x&PrintCapabilityPreviewValue == PrintCapabilityPreviewValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_PREVIEW")
+		rest &^= PrintCapabilityPreviewValue
+	}
+	if rest&PrintCapabilityNumberUpValue != 0 && x&PrintCapabilityNumberUpValue == PrintCapabilityNumberUpValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_NUMBER_UP")
+		rest &^= PrintCapabilityNumberUpValue
+	}
+	if rest&PrintCapabilityNumberUpLayoutValue != 0 && x&PrintCapabilityNumberUpLayoutValue == PrintCapabilityNumberUpLayoutValue {
+		names = append(names, "GTK_PRINT_CAPABILITY_NUMBER_UP_LAYOUT")
+		rest &^= PrintCapabilityNumberUpLayoutValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xEnumeratePrinters func(uintptr, uintptr, uintptr, bool)
 
 // Calls a function for all printers that are known to GTK.
 //
-// If @func returns true, the enumeration is stopped.
+// If func returns true, the enumeration is stopped.
 func EnumeratePrinters(FuncVar *PrinterFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify, WaitVar bool) {
 
 	var FuncVarRef uintptr
@@ -82,11 +156,12 @@ func EnumeratePrinters(FuncVar *PrinterFunc, DataVar uintptr, DestroyVar *glib.D
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -97,11 +172,12 @@ func EnumeratePrinters(FuncVar *PrinterFunc, DataVar uintptr, DestroyVar *glib.D
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -112,12 +188,12 @@ func EnumeratePrinters(FuncVar *PrinterFunc, DataVar uintptr, DestroyVar *glib.D
 // Represents a printer.
 //
 // You only need to deal directly with printers if you use the
-// non-portable [class@Gtk.PrintUnixDialog] API.
+// non-portable [PrintUnixDialog] API.
 //
 // A `GtkPrinter` allows to get status information about the printer,
 // such as its description, its location, the number of queued jobs,
 // etc. Most importantly, a `GtkPrinter` object can be used to create
-// a [class@Gtk.PrintJob] object, which lets you print to the printer.
+// a [PrintJob] object, which lets you print to the printer.
 type Printer struct {
 	gobject.Object
 }
@@ -197,8 +273,8 @@ var xPrinterGetCapabilities func(uintptr) PrintCapabilities
 // the printer can handle and which you must handle yourself.
 //
 // This will return 0 unless the printer’s details are
-// available, see [method@Gtk.Printer.has_details] and
-// [method@Gtk.Printer.request_details].
+// available, see [Printer.HasDetails] and
+// [Printer.RequestDetails].
 func (x *Printer) GetCapabilities() PrintCapabilities {
 
 	cret := xPrinterGetCapabilities(x.GoPointer())
@@ -207,7 +283,7 @@ func (x *Printer) GetCapabilities() PrintCapabilities {
 
 var xPrinterGetDefaultPageSize func(uintptr) uintptr
 
-// Returns default page size of @printer.
+// Returns default page size of printer.
 func (x *Printer) GetDefaultPageSize() *PageSetup {
 	var cls *PageSetup
 
@@ -232,14 +308,14 @@ func (x *Printer) GetDescription() string {
 
 var xPrinterGetHardMargins func(uintptr, *float64, *float64, *float64, *float64) bool
 
-// Retrieve the hard margins of @printer.
+// Retrieve the hard margins of printer.
 //
 // These are the margins that define the area at the borders
 // of the paper that the printer cannot print to.
 //
 // Note: This will not succeed unless the printer’s details are
-// available, see [method@Gtk.Printer.has_details] and
-// [method@Gtk.Printer.request_details].
+// available, see [Printer.HasDetails] and
+// [Printer.RequestDetails].
 func (x *Printer) GetHardMargins(TopVar *float64, BottomVar *float64, LeftVar *float64, RightVar *float64) bool {
 
 	cret := xPrinterGetHardMargins(x.GoPointer(), TopVar, BottomVar, LeftVar, RightVar)
@@ -248,14 +324,14 @@ func (x *Printer) GetHardMargins(TopVar *float64, BottomVar *float64, LeftVar *f
 
 var xPrinterGetHardMarginsForPaperSize func(uintptr, *PaperSize, *float64, *float64, *float64, *float64) bool
 
-// Retrieve the hard margins of @printer for @paper_size.
+// Retrieve the hard margins of printer for paper_size.
 //
 // These are the margins that define the area at the borders
 // of the paper that the printer cannot print to.
 //
 // Note: This will not succeed unless the printer’s details are
-// available, see [method@Gtk.Printer.has_details] and
-// [method@Gtk.Printer.request_details].
+// available, see [Printer.HasDetails] and
+// [Printer.RequestDetails].
 func (x *Printer) GetHardMarginsForPaperSize(PaperSizeVar *PaperSize, TopVar *float64, BottomVar *float64, LeftVar *float64, RightVar *float64) bool {
 
 	cret := xPrinterGetHardMarginsForPaperSize(x.GoPointer(), PaperSizeVar, TopVar, BottomVar, LeftVar, RightVar)
@@ -370,11 +446,11 @@ func (x *Printer) IsVirtual() bool {
 
 var xPrinterListPapers func(uintptr) *glib.List
 
-// Lists all the paper sizes @printer supports.
+// Lists all the paper sizes printer supports.
 //
 // This will return and empty list unless the printer’s details
-// are available, see [method@Gtk.Printer.has_details] and
-// [method@Gtk.Printer.request_details].
+// are available, see [Printer.HasDetails] and
+// [Printer.RequestDetails].
 func (x *Printer) ListPapers() *glib.List {
 
 	cret := xPrinterListPapers(x.GoPointer())
@@ -386,8 +462,8 @@ var xPrinterRequestDetails func(uintptr)
 // Requests the printer details.
 //
 // When the details are available, the
-// [signal@Gtk.Printer::details-acquired] signal
-// will be emitted on @printer.
+// [Printer.ConnectDetailsAcquiredFunc] signal
+// will be emitted on printer.
 func (x *Printer) RequestDetails() {
 
 	xPrinterRequestDetails(x.GoPointer())
@@ -406,7 +482,7 @@ func (c *Printer) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyAcceptingJobs gets the "accepting-jobs" property.
-// %TRUE if the printer is accepting jobs.
+// true if the printer is accepting jobs.
 func (x *Printer) GetPropertyAcceptingJobs() bool {
 	var v gobject.Value
 	x.GetProperty("accepting-jobs", &v)
@@ -414,7 +490,7 @@ func (x *Printer) GetPropertyAcceptingJobs() bool {
 }
 
 // SetPropertyAcceptsPdf sets the "accepts-pdf" property.
-// %TRUE if this printer can accept PDF.
+// true if this printer can accept PDF.
 func (x *Printer) SetPropertyAcceptsPdf(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -423,7 +499,7 @@ func (x *Printer) SetPropertyAcceptsPdf(value bool) {
 }
 
 // GetPropertyAcceptsPdf gets the "accepts-pdf" property.
-// %TRUE if this printer can accept PDF.
+// true if this printer can accept PDF.
 func (x *Printer) GetPropertyAcceptsPdf() bool {
 	var v gobject.Value
 	x.GetProperty("accepts-pdf", &v)
@@ -431,7 +507,7 @@ func (x *Printer) GetPropertyAcceptsPdf() bool {
 }
 
 // SetPropertyAcceptsPs sets the "accepts-ps" property.
-// %TRUE if this printer can accept PostScript.
+// true if this printer can accept PostScript.
 func (x *Printer) SetPropertyAcceptsPs(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -440,7 +516,7 @@ func (x *Printer) SetPropertyAcceptsPs(value bool) {
 }
 
 // GetPropertyAcceptsPs gets the "accepts-ps" property.
-// %TRUE if this printer can accept PostScript.
+// true if this printer can accept PostScript.
 func (x *Printer) GetPropertyAcceptsPs() bool {
 	var v gobject.Value
 	x.GetProperty("accepts-ps", &v)
@@ -456,7 +532,7 @@ func (x *Printer) GetPropertyIconName() string {
 }
 
 // SetPropertyIsVirtual sets the "is-virtual" property.
-// %FALSE if this represents a real hardware device.
+// false if this represents a real hardware device.
 func (x *Printer) SetPropertyIsVirtual(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -465,7 +541,7 @@ func (x *Printer) SetPropertyIsVirtual(value bool) {
 }
 
 // GetPropertyIsVirtual gets the "is-virtual" property.
-// %FALSE if this represents a real hardware device.
+// false if this represents a real hardware device.
 func (x *Printer) GetPropertyIsVirtual() bool {
 	var v gobject.Value
 	x.GetProperty("is-virtual", &v)
@@ -506,7 +582,7 @@ func (x *Printer) GetPropertyName() string {
 }
 
 // GetPropertyPaused gets the "paused" property.
-// %TRUE if this printer is paused.
+// true if this printer is paused.
 //
 // A paused printer still accepts jobs, but it does
 // not print them.
@@ -527,29 +603,51 @@ func (x *Printer) GetPropertyStateMessage() string {
 // Emitted in response to a request for detailed information
 // about a printer from the print backend.
 //
-// The @success parameter indicates if the information was
+// The success parameter indicates if the information was
 // actually obtained.
+//
+// Deprecated: use ConnectDetailsAcquiredFunc, which also accepts method values and closures.
 func (x *Printer) ConnectDetailsAcquired(cb *func(Printer, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "details-acquired", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SuccessVarp bool) {
+			defer core.RecoverPanic()
+			fa := Printer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SuccessVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "details-acquired", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, SuccessVarp bool) {
+var xPrinterDetailsAcquiredTrampoline uintptr
+
+func xPrinterDetailsAcquiredNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SuccessVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Printer, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Printer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SuccessVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "details-acquired", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDetailsAcquiredFunc connects cb to the "details-acquired" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Printer) ConnectDetailsAcquiredFunc(cb func(Printer, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "details-acquired", &xPrinterDetailsAcquiredTrampoline, xPrinterDetailsAcquiredNewTrampoline, cb)
 }
 
 func init() {
@@ -557,7 +655,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -566,33 +664,33 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintCapabilitiesGLibType, libs, "gtk_print_capabilities_get_type")
 
-	core.PuregoSafeRegister(&xEnumeratePrinters, libs, "gtk_enumerate_printers")
+	core.PuregoRegisterSince(&xEnumeratePrinters, libs, "gtk_enumerate_printers", "GTK", "")
 
 	core.PuregoSafeRegister(&xPrinterGLibType, libs, "gtk_printer_get_type")
 
-	core.PuregoSafeRegister(&xNewPrinter, libs, "gtk_printer_new")
-
-	core.PuregoSafeRegister(&xPrinterAcceptsPdf, libs, "gtk_printer_accepts_pdf")
-	core.PuregoSafeRegister(&xPrinterAcceptsPs, libs, "gtk_printer_accepts_ps")
-	core.PuregoSafeRegister(&xPrinterCompare, libs, "gtk_printer_compare")
-	core.PuregoSafeRegister(&xPrinterGetBackend, libs, "gtk_printer_get_backend")
-	core.PuregoSafeRegister(&xPrinterGetCapabilities, libs, "gtk_printer_get_capabilities")
-	core.PuregoSafeRegister(&xPrinterGetDefaultPageSize, libs, "gtk_printer_get_default_page_size")
-	core.PuregoSafeRegister(&xPrinterGetDescription, libs, "gtk_printer_get_description")
-	core.PuregoSafeRegister(&xPrinterGetHardMargins, libs, "gtk_printer_get_hard_margins")
-	core.PuregoSafeRegister(&xPrinterGetHardMarginsForPaperSize, libs, "gtk_printer_get_hard_margins_for_paper_size")
-	core.PuregoSafeRegister(&xPrinterGetIconName, libs, "gtk_printer_get_icon_name")
-	core.PuregoSafeRegister(&xPrinterGetJobCount, libs, "gtk_printer_get_job_count")
-	core.PuregoSafeRegister(&xPrinterGetLocation, libs, "gtk_printer_get_location")
-	core.PuregoSafeRegister(&xPrinterGetName, libs, "gtk_printer_get_name")
-	core.PuregoSafeRegister(&xPrinterGetStateMessage, libs, "gtk_printer_get_state_message")
-	core.PuregoSafeRegister(&xPrinterHasDetails, libs, "gtk_printer_has_details")
-	core.PuregoSafeRegister(&xPrinterIsAcceptingJobs, libs, "gtk_printer_is_accepting_jobs")
-	core.PuregoSafeRegister(&xPrinterIsActive, libs, "gtk_printer_is_active")
-	core.PuregoSafeRegister(&xPrinterIsDefault, libs, "gtk_printer_is_default")
-	core.PuregoSafeRegister(&xPrinterIsPaused, libs, "gtk_printer_is_paused")
-	core.PuregoSafeRegister(&xPrinterIsVirtual, libs, "gtk_printer_is_virtual")
-	core.PuregoSafeRegister(&xPrinterListPapers, libs, "gtk_printer_list_papers")
-	core.PuregoSafeRegister(&xPrinterRequestDetails, libs, "gtk_printer_request_details")
+	core.PuregoRegisterSince(&xNewPrinter, libs, "gtk_printer_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPrinterAcceptsPdf, libs, "gtk_printer_accepts_pdf", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterAcceptsPs, libs, "gtk_printer_accepts_ps", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterCompare, libs, "gtk_printer_compare", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetBackend, libs, "gtk_printer_get_backend", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetCapabilities, libs, "gtk_printer_get_capabilities", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetDefaultPageSize, libs, "gtk_printer_get_default_page_size", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetDescription, libs, "gtk_printer_get_description", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetHardMargins, libs, "gtk_printer_get_hard_margins", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetHardMarginsForPaperSize, libs, "gtk_printer_get_hard_margins_for_paper_size", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetIconName, libs, "gtk_printer_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetJobCount, libs, "gtk_printer_get_job_count", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetLocation, libs, "gtk_printer_get_location", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetName, libs, "gtk_printer_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterGetStateMessage, libs, "gtk_printer_get_state_message", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterHasDetails, libs, "gtk_printer_has_details", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterIsAcceptingJobs, libs, "gtk_printer_is_accepting_jobs", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterIsActive, libs, "gtk_printer_is_active", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterIsDefault, libs, "gtk_printer_is_default", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterIsPaused, libs, "gtk_printer_is_paused", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterIsVirtual, libs, "gtk_printer_is_virtual", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterListPapers, libs, "gtk_printer_list_papers", "GTK", "")
+	core.PuregoRegisterSince(&xPrinterRequestDetails, libs, "gtk_printer_request_details", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -12,7 +11,7 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// The type of callback that is passed to gtk_print_job_send().
+// The type of callback that is passed to [PrintJob.Send].
 //
 // It is called when the print job has been completely sent.
 type PrintJobCompleteFunc func(uintptr, uintptr, *glib.Error)
@@ -20,13 +19,13 @@ type PrintJobCompleteFunc func(uintptr, uintptr, *glib.Error)
 // Represents a job that is sent to a printer.
 //
 // You only need to deal directly with print jobs if you use the
-// non-portable [class@Gtk.PrintUnixDialog] API.
+// non-portable [PrintUnixDialog] API.
 //
-// Use [method@Gtk.PrintJob.get_surface] to obtain the cairo surface
-// onto which the pages must be drawn. Use [method@Gtk.PrintJob.send]
+// Use [PrintJob.GetSurface] to obtain the cairo surface
+// onto which the pages must be drawn. Use [PrintJob.Send]
 // to send the finished job to the printer. If you don’t use cairo
 // `GtkPrintJob` also supports printing of manually generated PostScript,
-// via [method@Gtk.PrintJob.set_source_file].
+// via [PrintJob.SetSourceFile].
 type PrintJob struct {
 	gobject.Object
 }
@@ -201,7 +200,7 @@ func (x *PrintJob) GetSurface() (*cairo.Surface, error) {
 
 	cret := xPrintJobGetSurface(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -220,7 +219,7 @@ var xPrintJobGetTrackPrintStatus func(uintptr) bool
 
 // Returns whether jobs will be tracked after printing.
 //
-// For details, see [method@Gtk.PrintJob.set_track_print_status].
+// For details, see [PrintJob.SetTrackPrintStatus].
 func (x *PrintJob) GetTrackPrintStatus() bool {
 
 	cret := xPrintJobGetTrackPrintStatus(x.GoPointer())
@@ -239,11 +238,12 @@ func (x *PrintJob) Send(CallbackVar *PrintJobCompleteFunc, UserDataVar uintptr,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 *glib.Error) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -254,11 +254,12 @@ func (x *PrintJob) Send(CallbackVar *PrintJobCompleteFunc, UserDataVar uintptr,
 			DnotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DnotifyVar
 				cbFn(arg0)
 			}
-			DnotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DnotifyVarPtr, DnotifyVarRef, DnotifyVar)
+			DnotifyVarRef = core.NewCallback(fcb)
+			DnotifyVarRef = glib.SaveCallbackWithClosure(DnotifyVarPtr, DnotifyVarRef, DnotifyVar)
 		}
 	}
 
@@ -365,10 +366,10 @@ var xPrintJobSetSourceFd func(uintptr, int, **glib.Error) bool
 //
 // The file can be in any format understood by the platforms
 // printing system (typically PostScript, but on many platforms
-// PDF may work too). See [method@Gtk.Printer.accepts_pdf] and
-// [method@Gtk.Printer.accepts_ps].
+// PDF may work too). See [Printer.AcceptsPdf] and
+// [Printer.AcceptsPs].
 //
-// This is similar to [method@Gtk.PrintJob.set_source_file],
+// This is similar to [PrintJob.SetSourceFile],
 // but takes expects an open file descriptor for the file,
 // instead of a filename.
 func (x *PrintJob) SetSourceFd(FdVar int) (bool, error) {
@@ -376,7 +377,7 @@ func (x *PrintJob) SetSourceFd(FdVar int) (bool, error) {
 
 	cret := xPrintJobSetSourceFd(x.GoPointer(), FdVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -389,14 +390,14 @@ var xPrintJobSetSourceFile func(uintptr, string, **glib.Error) bool
 //
 // The file can be in any format understood by the platforms
 // printing system (typically PostScript, but on many platforms
-// PDF may work too). See [method@Gtk.Printer.accepts_pdf] and
-// [method@Gtk.Printer.accepts_ps].
+// PDF may work too). See [Printer.AcceptsPdf] and
+// [Printer.AcceptsPs].
 func (x *PrintJob) SetSourceFile(FilenameVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPrintJobSetSourceFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -404,7 +405,7 @@ func (x *PrintJob) SetSourceFile(FilenameVar string) (bool, error) {
 
 var xPrintJobSetTrackPrintStatus func(uintptr, bool)
 
-// If track_status is %TRUE, the print job will try to continue report
+// If track_status is true, the print job will try to continue report
 // on the status of the print job in the printer queues and printer.
 //
 // This can allow your application to show things like “out of paper”
@@ -447,7 +448,7 @@ func (x *PrintJob) GetPropertyTitle() string {
 }
 
 // SetPropertyTrackPrintStatus sets the "track-print-status" property.
-// %TRUE if the print job will continue to emit status-changed
+// true if the print job will continue to emit status-changed
 // signals after the print data has been setn to the printer.
 func (x *PrintJob) SetPropertyTrackPrintStatus(value bool) {
 	var v gobject.Value
@@ -457,7 +458,7 @@ func (x *PrintJob) SetPropertyTrackPrintStatus(value bool) {
 }
 
 // GetPropertyTrackPrintStatus gets the "track-print-status" property.
-// %TRUE if the print job will continue to emit status-changed
+// true if the print job will continue to emit status-changed
 // signals after the print data has been setn to the printer.
 func (x *PrintJob) GetPropertyTrackPrintStatus() bool {
 	var v gobject.Value
@@ -467,29 +468,51 @@ func (x *PrintJob) GetPropertyTrackPrintStatus() bool {
 
 // Emitted when the status of a job changes.
 //
-// The signal handler can use [method@Gtk.PrintJob.get_status]
+// The signal handler can use [PrintJob.GetStatus]
 // to obtain the new status.
+//
+// Deprecated: use ConnectStatusChangedFunc, which also accepts method values and closures.
 func (x *PrintJob) ConnectStatusChanged(cb *func(PrintJob)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "status-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintJob{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "status-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xPrintJobStatusChangedTrampoline uintptr
+
+func xPrintJobStatusChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintJob))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintJob{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "status-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStatusChangedFunc connects cb to the "status-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintJob) ConnectStatusChangedFunc(cb func(PrintJob)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "status-changed", &xPrintJobStatusChangedTrampoline, xPrintJobStatusChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -497,7 +520,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -506,37 +529,37 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintJobGLibType, libs, "gtk_print_job_get_type")
 
-	core.PuregoSafeRegister(&xNewPrintJob, libs, "gtk_print_job_new")
-
-	core.PuregoSafeRegister(&xPrintJobGetCollate, libs, "gtk_print_job_get_collate")
-	core.PuregoSafeRegister(&xPrintJobGetNUp, libs, "gtk_print_job_get_n_up")
-	core.PuregoSafeRegister(&xPrintJobGetNUpLayout, libs, "gtk_print_job_get_n_up_layout")
-	core.PuregoSafeRegister(&xPrintJobGetNumCopies, libs, "gtk_print_job_get_num_copies")
-	core.PuregoSafeRegister(&xPrintJobGetPageRanges, libs, "gtk_print_job_get_page_ranges")
-	core.PuregoSafeRegister(&xPrintJobGetPageSet, libs, "gtk_print_job_get_page_set")
-	core.PuregoSafeRegister(&xPrintJobGetPages, libs, "gtk_print_job_get_pages")
-	core.PuregoSafeRegister(&xPrintJobGetPrinter, libs, "gtk_print_job_get_printer")
-	core.PuregoSafeRegister(&xPrintJobGetReverse, libs, "gtk_print_job_get_reverse")
-	core.PuregoSafeRegister(&xPrintJobGetRotate, libs, "gtk_print_job_get_rotate")
-	core.PuregoSafeRegister(&xPrintJobGetScale, libs, "gtk_print_job_get_scale")
-	core.PuregoSafeRegister(&xPrintJobGetSettings, libs, "gtk_print_job_get_settings")
-	core.PuregoSafeRegister(&xPrintJobGetStatus, libs, "gtk_print_job_get_status")
-	core.PuregoSafeRegister(&xPrintJobGetSurface, libs, "gtk_print_job_get_surface")
-	core.PuregoSafeRegister(&xPrintJobGetTitle, libs, "gtk_print_job_get_title")
-	core.PuregoSafeRegister(&xPrintJobGetTrackPrintStatus, libs, "gtk_print_job_get_track_print_status")
-	core.PuregoSafeRegister(&xPrintJobSend, libs, "gtk_print_job_send")
-	core.PuregoSafeRegister(&xPrintJobSetCollate, libs, "gtk_print_job_set_collate")
-	core.PuregoSafeRegister(&xPrintJobSetNUp, libs, "gtk_print_job_set_n_up")
-	core.PuregoSafeRegister(&xPrintJobSetNUpLayout, libs, "gtk_print_job_set_n_up_layout")
-	core.PuregoSafeRegister(&xPrintJobSetNumCopies, libs, "gtk_print_job_set_num_copies")
-	core.PuregoSafeRegister(&xPrintJobSetPageRanges, libs, "gtk_print_job_set_page_ranges")
-	core.PuregoSafeRegister(&xPrintJobSetPageSet, libs, "gtk_print_job_set_page_set")
-	core.PuregoSafeRegister(&xPrintJobSetPages, libs, "gtk_print_job_set_pages")
-	core.PuregoSafeRegister(&xPrintJobSetReverse, libs, "gtk_print_job_set_reverse")
-	core.PuregoSafeRegister(&xPrintJobSetRotate, libs, "gtk_print_job_set_rotate")
-	core.PuregoSafeRegister(&xPrintJobSetScale, libs, "gtk_print_job_set_scale")
-	core.PuregoSafeRegister(&xPrintJobSetSourceFd, libs, "gtk_print_job_set_source_fd")
-	core.PuregoSafeRegister(&xPrintJobSetSourceFile, libs, "gtk_print_job_set_source_file")
-	core.PuregoSafeRegister(&xPrintJobSetTrackPrintStatus, libs, "gtk_print_job_set_track_print_status")
+	core.PuregoRegisterSince(&xNewPrintJob, libs, "gtk_print_job_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPrintJobGetCollate, libs, "gtk_print_job_get_collate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetNUp, libs, "gtk_print_job_get_n_up", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetNUpLayout, libs, "gtk_print_job_get_n_up_layout", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetNumCopies, libs, "gtk_print_job_get_num_copies", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetPageRanges, libs, "gtk_print_job_get_page_ranges", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetPageSet, libs, "gtk_print_job_get_page_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetPages, libs, "gtk_print_job_get_pages", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetPrinter, libs, "gtk_print_job_get_printer", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetReverse, libs, "gtk_print_job_get_reverse", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetRotate, libs, "gtk_print_job_get_rotate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetScale, libs, "gtk_print_job_get_scale", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetSettings, libs, "gtk_print_job_get_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetStatus, libs, "gtk_print_job_get_status", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetSurface, libs, "gtk_print_job_get_surface", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetTitle, libs, "gtk_print_job_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobGetTrackPrintStatus, libs, "gtk_print_job_get_track_print_status", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSend, libs, "gtk_print_job_send", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetCollate, libs, "gtk_print_job_set_collate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetNUp, libs, "gtk_print_job_set_n_up", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetNUpLayout, libs, "gtk_print_job_set_n_up_layout", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetNumCopies, libs, "gtk_print_job_set_num_copies", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetPageRanges, libs, "gtk_print_job_set_page_ranges", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetPageSet, libs, "gtk_print_job_set_page_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetPages, libs, "gtk_print_job_set_pages", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetReverse, libs, "gtk_print_job_set_reverse", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetRotate, libs, "gtk_print_job_set_rotate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetScale, libs, "gtk_print_job_set_scale", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetSourceFd, libs, "gtk_print_job_set_source_fd", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetSourceFile, libs, "gtk_print_job_set_source_file", "GTK", "")
+	core.PuregoRegisterSince(&xPrintJobSetTrackPrintStatus, libs, "gtk_print_job_set_track_print_status", "GTK", "")
 
 }
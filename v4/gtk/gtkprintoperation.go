@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,10 +13,10 @@ import (
 )
 
 // The type of function that is passed to
-// gtk_print_run_page_setup_dialog_async().
+// [PrintRunPageSetupDialogAsync].
 //
 // This function will be called when the page setup dialog
-// is dismissed, and also serves as destroy notify for @data.
+// is dismissed, and also serves as destroy notify for data.
 type PageSetupDoneFunc func(uintptr, uintptr)
 
 type PrintOperationClass struct {
@@ -61,7 +61,8 @@ func (x *PrintOperationClass) OverrideDone(cb func(*PrintOperation, PrintOperati
 	if cb == nil {
 		x.xDone = 0
 	} else {
-		x.xDone = purego.NewCallback(func(OperationVarp uintptr, ResultVarp PrintOperationResult) {
+		x.xDone = core.NewCallback(func(OperationVarp uintptr, ResultVarp PrintOperationResult) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), ResultVarp)
 		})
 	}
@@ -76,7 +77,7 @@ func (x *PrintOperationClass) GetDone() func(*PrintOperation, PrintOperationResu
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ResultVarp PrintOperationResult)
-	purego.RegisterFunc(&rawCallback, x.xDone)
+	core.RegisterFunc(&rawCallback, x.xDone)
 	return func(OperationVar *PrintOperation, ResultVar PrintOperationResult) {
 		rawCallback(OperationVar.GoPointer(), ResultVar)
 	}
@@ -90,7 +91,8 @@ func (x *PrintOperationClass) OverrideBeginPrint(cb func(*PrintOperation, *Print
 	if cb == nil {
 		x.xBeginPrint = 0
 	} else {
-		x.xBeginPrint = purego.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) {
+		x.xBeginPrint = core.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), PrintContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -105,7 +107,7 @@ func (x *PrintOperationClass) GetBeginPrint() func(*PrintOperation, *PrintContex
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xBeginPrint)
+	core.RegisterFunc(&rawCallback, x.xBeginPrint)
 	return func(OperationVar *PrintOperation, ContextVar *PrintContext) {
 		rawCallback(OperationVar.GoPointer(), ContextVar.GoPointer())
 	}
@@ -119,7 +121,8 @@ func (x *PrintOperationClass) OverridePaginate(cb func(*PrintOperation, *PrintCo
 	if cb == nil {
 		x.xPaginate = 0
 	} else {
-		x.xPaginate = purego.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) bool {
+		x.xPaginate = core.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PrintOperationNewFromInternalPtr(OperationVarp), PrintContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -134,7 +137,7 @@ func (x *PrintOperationClass) GetPaginate() func(*PrintOperation, *PrintContext)
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ContextVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPaginate)
+	core.RegisterFunc(&rawCallback, x.xPaginate)
 	return func(OperationVar *PrintOperation, ContextVar *PrintContext) bool {
 		return rawCallback(OperationVar.GoPointer(), ContextVar.GoPointer())
 	}
@@ -148,7 +151,8 @@ func (x *PrintOperationClass) OverrideRequestPageSetup(cb func(*PrintOperation,
 	if cb == nil {
 		x.xRequestPageSetup = 0
 	} else {
-		x.xRequestPageSetup = purego.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr) {
+		x.xRequestPageSetup = core.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), PrintContextNewFromInternalPtr(ContextVarp), PageNrVarp, PageSetupNewFromInternalPtr(SetupVarp))
 		})
 	}
@@ -163,7 +167,7 @@ func (x *PrintOperationClass) GetRequestPageSetup() func(*PrintOperation, *Print
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRequestPageSetup)
+	core.RegisterFunc(&rawCallback, x.xRequestPageSetup)
 	return func(OperationVar *PrintOperation, ContextVar *PrintContext, PageNrVar int, SetupVar *PageSetup) {
 		rawCallback(OperationVar.GoPointer(), ContextVar.GoPointer(), PageNrVar, SetupVar.GoPointer())
 	}
@@ -175,7 +179,8 @@ func (x *PrintOperationClass) OverrideDrawPage(cb func(*PrintOperation, *PrintCo
 	if cb == nil {
 		x.xDrawPage = 0
 	} else {
-		x.xDrawPage = purego.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int) {
+		x.xDrawPage = core.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), PrintContextNewFromInternalPtr(ContextVarp), PageNrVarp)
 		})
 	}
@@ -188,7 +193,7 @@ func (x *PrintOperationClass) GetDrawPage() func(*PrintOperation, *PrintContext,
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ContextVarp uintptr, PageNrVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawPage)
+	core.RegisterFunc(&rawCallback, x.xDrawPage)
 	return func(OperationVar *PrintOperation, ContextVar *PrintContext, PageNrVar int) {
 		rawCallback(OperationVar.GoPointer(), ContextVar.GoPointer(), PageNrVar)
 	}
@@ -200,7 +205,8 @@ func (x *PrintOperationClass) OverrideEndPrint(cb func(*PrintOperation, *PrintCo
 	if cb == nil {
 		x.xEndPrint = 0
 	} else {
-		x.xEndPrint = purego.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) {
+		x.xEndPrint = core.NewCallback(func(OperationVarp uintptr, ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), PrintContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -213,7 +219,7 @@ func (x *PrintOperationClass) GetEndPrint() func(*PrintOperation, *PrintContext)
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEndPrint)
+	core.RegisterFunc(&rawCallback, x.xEndPrint)
 	return func(OperationVar *PrintOperation, ContextVar *PrintContext) {
 		rawCallback(OperationVar.GoPointer(), ContextVar.GoPointer())
 	}
@@ -227,7 +233,8 @@ func (x *PrintOperationClass) OverrideStatusChanged(cb func(*PrintOperation)) {
 	if cb == nil {
 		x.xStatusChanged = 0
 	} else {
-		x.xStatusChanged = purego.NewCallback(func(OperationVarp uintptr) {
+		x.xStatusChanged = core.NewCallback(func(OperationVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp))
 		})
 	}
@@ -242,7 +249,7 @@ func (x *PrintOperationClass) GetStatusChanged() func(*PrintOperation) {
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xStatusChanged)
+	core.RegisterFunc(&rawCallback, x.xStatusChanged)
 	return func(OperationVar *PrintOperation) {
 		rawCallback(OperationVar.GoPointer())
 	}
@@ -254,7 +261,8 @@ func (x *PrintOperationClass) OverrideCreateCustomWidget(cb func(*PrintOperation
 	if cb == nil {
 		x.xCreateCustomWidget = 0
 	} else {
-		x.xCreateCustomWidget = purego.NewCallback(func(OperationVarp uintptr) uintptr {
+		x.xCreateCustomWidget = core.NewCallback(func(OperationVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(PrintOperationNewFromInternalPtr(OperationVarp))
 			if ret == nil {
 				return 0
@@ -271,7 +279,7 @@ func (x *PrintOperationClass) GetCreateCustomWidget() func(*PrintOperation) *Wid
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateCustomWidget)
+	core.RegisterFunc(&rawCallback, x.xCreateCustomWidget)
 	return func(OperationVar *PrintOperation) *Widget {
 		rawRet := rawCallback(OperationVar.GoPointer())
 		if rawRet == 0 {
@@ -291,7 +299,8 @@ func (x *PrintOperationClass) OverrideCustomWidgetApply(cb func(*PrintOperation,
 	if cb == nil {
 		x.xCustomWidgetApply = 0
 	} else {
-		x.xCustomWidgetApply = purego.NewCallback(func(OperationVarp uintptr, WidgetVarp uintptr) {
+		x.xCustomWidgetApply = core.NewCallback(func(OperationVarp uintptr, WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -306,7 +315,7 @@ func (x *PrintOperationClass) GetCustomWidgetApply() func(*PrintOperation, *Widg
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCustomWidgetApply)
+	core.RegisterFunc(&rawCallback, x.xCustomWidgetApply)
 	return func(OperationVar *PrintOperation, WidgetVar *Widget) {
 		rawCallback(OperationVar.GoPointer(), WidgetVar.GoPointer())
 	}
@@ -320,7 +329,8 @@ func (x *PrintOperationClass) OverridePreview(cb func(*PrintOperation, PrintOper
 	if cb == nil {
 		x.xPreview = 0
 	} else {
-		x.xPreview = purego.NewCallback(func(OperationVarp uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr) bool {
+		x.xPreview = core.NewCallback(func(OperationVarp uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(PrintOperationNewFromInternalPtr(OperationVarp), &PrintOperationPreviewBase{Ptr: PreviewVarp}, PrintContextNewFromInternalPtr(ContextVarp), WindowNewFromInternalPtr(ParentVarp))
 		})
 	}
@@ -335,7 +345,7 @@ func (x *PrintOperationClass) GetPreview() func(*PrintOperation, PrintOperationP
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xPreview)
+	core.RegisterFunc(&rawCallback, x.xPreview)
 	return func(OperationVar *PrintOperation, PreviewVar PrintOperationPreview, ContextVar *PrintContext, ParentVar *Window) bool {
 		return rawCallback(OperationVar.GoPointer(), PreviewVar.GoPointer(), ContextVar.GoPointer(), ParentVar.GoPointer())
 	}
@@ -347,7 +357,8 @@ func (x *PrintOperationClass) OverrideUpdateCustomWidget(cb func(*PrintOperation
 	if cb == nil {
 		x.xUpdateCustomWidget = 0
 	} else {
-		x.xUpdateCustomWidget = purego.NewCallback(func(OperationVarp uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr) {
+		x.xUpdateCustomWidget = core.NewCallback(func(OperationVarp uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(PrintOperationNewFromInternalPtr(OperationVarp), WidgetNewFromInternalPtr(WidgetVarp), PageSetupNewFromInternalPtr(SetupVarp), PrintSettingsNewFromInternalPtr(SettingsVarp))
 		})
 	}
@@ -360,7 +371,7 @@ func (x *PrintOperationClass) GetUpdateCustomWidget() func(*PrintOperation, *Wid
 		return nil
 	}
 	var rawCallback func(OperationVarp uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUpdateCustomWidget)
+	core.RegisterFunc(&rawCallback, x.xUpdateCustomWidget)
 	return func(OperationVar *PrintOperation, WidgetVar *Widget, SetupVar *PageSetup, SettingsVar *PrintSettings) {
 		rawCallback(OperationVar.GoPointer(), WidgetVar.GoPointer(), SetupVar.GoPointer(), SettingsVar.GoPointer())
 	}
@@ -397,9 +408,74 @@ const (
 	PrintErrorInvalidFileValue PrintError = 3
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (PrintError) ErrorDomain() string {
+	return "gtk-print-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x PrintError) Error() string {
+	switch x {
+	case PrintErrorGeneralValue:
+		return "GTK_PRINT_ERROR_GENERAL"
+	case PrintErrorInternalErrorValue:
+		return "GTK_PRINT_ERROR_INTERNAL_ERROR"
+	case PrintErrorNomemValue:
+		return "GTK_PRINT_ERROR_NOMEM"
+	case PrintErrorInvalidFileValue:
+		return "GTK_PRINT_ERROR_INVALID_FILE"
+	}
+	return fmt.Sprintf("gtk-print-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintError) String() string {
+	switch x {
+	case PrintErrorGeneralValue:
+		return "GTK_PRINT_ERROR_GENERAL"
+	case PrintErrorInternalErrorValue:
+		return "GTK_PRINT_ERROR_INTERNAL_ERROR"
+	case PrintErrorNomemValue:
+		return "GTK_PRINT_ERROR_NOMEM"
+	case PrintErrorInvalidFileValue:
+		return "GTK_PRINT_ERROR_INVALID_FILE"
+	}
+	return fmt.Sprintf("PrintError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintError) Nick() string {
+	switch x {
+	case PrintErrorGeneralValue:
+		return "general"
+	case PrintErrorInternalErrorValue:
+		return "internal-error"
+	case PrintErrorNomemValue:
+		return "nomem"
+	case PrintErrorInvalidFileValue:
+		return "invalid-file"
+	}
+	return ""
+}
+
+// PrintErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintErrorFromString(s string) (PrintError, bool) {
+	switch s {
+	case "GTK_PRINT_ERROR_GENERAL", "general":
+		return PrintErrorGeneralValue, true
+	case "GTK_PRINT_ERROR_INTERNAL_ERROR", "internal-error":
+		return PrintErrorInternalErrorValue, true
+	case "GTK_PRINT_ERROR_NOMEM", "nomem":
+		return PrintErrorNomemValue, true
+	case "GTK_PRINT_ERROR_INVALID_FILE", "invalid-file":
+		return PrintErrorInvalidFileValue, true
+	}
+	return 0, false
+}
+
 // Determines what action the print operation should perform.
 //
-// A parameter of this typs is passed to [method@Gtk.PrintOperation.run].
+// A parameter of this typs is passed to [PrintOperation.Run].
 type PrintOperationAction int
 
 var xPrintOperationActionGLibType func() types.GType
@@ -423,9 +499,54 @@ const (
 	PrintOperationActionExportValue PrintOperationAction = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintOperationAction) String() string {
+	switch x {
+	case PrintOperationActionPrintDialogValue:
+		return "GTK_PRINT_OPERATION_ACTION_PRINT_DIALOG"
+	case PrintOperationActionPrintValue:
+		return "GTK_PRINT_OPERATION_ACTION_PRINT"
+	case PrintOperationActionPreviewValue:
+		return "GTK_PRINT_OPERATION_ACTION_PREVIEW"
+	case PrintOperationActionExportValue:
+		return "GTK_PRINT_OPERATION_ACTION_EXPORT"
+	}
+	return fmt.Sprintf("PrintOperationAction(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintOperationAction) Nick() string {
+	switch x {
+	case PrintOperationActionPrintDialogValue:
+		return "print-dialog"
+	case PrintOperationActionPrintValue:
+		return "print"
+	case PrintOperationActionPreviewValue:
+		return "preview"
+	case PrintOperationActionExportValue:
+		return "export"
+	}
+	return ""
+}
+
+// PrintOperationActionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintOperationActionFromString(s string) (PrintOperationAction, bool) {
+	switch s {
+	case "GTK_PRINT_OPERATION_ACTION_PRINT_DIALOG", "print-dialog":
+		return PrintOperationActionPrintDialogValue, true
+	case "GTK_PRINT_OPERATION_ACTION_PRINT", "print":
+		return PrintOperationActionPrintValue, true
+	case "GTK_PRINT_OPERATION_ACTION_PREVIEW", "preview":
+		return PrintOperationActionPreviewValue, true
+	case "GTK_PRINT_OPERATION_ACTION_EXPORT", "export":
+		return PrintOperationActionExportValue, true
+	}
+	return 0, false
+}
+
 // The result of a print operation.
 //
-// A value of this type is returned by [method@Gtk.PrintOperation.run].
+// A value of this type is returned by [PrintOperation.Run].
 type PrintOperationResult int
 
 var xPrintOperationResultGLibType func() types.GType
@@ -448,6 +569,51 @@ const (
 	PrintOperationResultInProgressValue PrintOperationResult = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintOperationResult) String() string {
+	switch x {
+	case PrintOperationResultErrorValue:
+		return "GTK_PRINT_OPERATION_RESULT_ERROR"
+	case PrintOperationResultApplyValue:
+		return "GTK_PRINT_OPERATION_RESULT_APPLY"
+	case PrintOperationResultCancelValue:
+		return "GTK_PRINT_OPERATION_RESULT_CANCEL"
+	case PrintOperationResultInProgressValue:
+		return "GTK_PRINT_OPERATION_RESULT_IN_PROGRESS"
+	}
+	return fmt.Sprintf("PrintOperationResult(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintOperationResult) Nick() string {
+	switch x {
+	case PrintOperationResultErrorValue:
+		return "error"
+	case PrintOperationResultApplyValue:
+		return "apply"
+	case PrintOperationResultCancelValue:
+		return "cancel"
+	case PrintOperationResultInProgressValue:
+		return "in-progress"
+	}
+	return ""
+}
+
+// PrintOperationResultFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintOperationResultFromString(s string) (PrintOperationResult, bool) {
+	switch s {
+	case "GTK_PRINT_OPERATION_RESULT_ERROR", "error":
+		return PrintOperationResultErrorValue, true
+	case "GTK_PRINT_OPERATION_RESULT_APPLY", "apply":
+		return PrintOperationResultApplyValue, true
+	case "GTK_PRINT_OPERATION_RESULT_CANCEL", "cancel":
+		return PrintOperationResultCancelValue, true
+	case "GTK_PRINT_OPERATION_RESULT_IN_PROGRESS", "in-progress":
+		return PrintOperationResultInProgressValue, true
+	}
+	return 0, false
+}
+
 // The status gives a rough indication of the completion of a running
 // print operation.
 type PrintStatus int
@@ -486,6 +652,81 @@ const (
 	PrintStatusFinishedAbortedValue PrintStatus = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PrintStatus) String() string {
+	switch x {
+	case PrintStatusInitialValue:
+		return "GTK_PRINT_STATUS_INITIAL"
+	case PrintStatusPreparingValue:
+		return "GTK_PRINT_STATUS_PREPARING"
+	case PrintStatusGeneratingDataValue:
+		return "GTK_PRINT_STATUS_GENERATING_DATA"
+	case PrintStatusSendingDataValue:
+		return "GTK_PRINT_STATUS_SENDING_DATA"
+	case PrintStatusPendingValue:
+		return "GTK_PRINT_STATUS_PENDING"
+	case PrintStatusPendingIssueValue:
+		return "GTK_PRINT_STATUS_PENDING_ISSUE"
+	case PrintStatusPrintingValue:
+		return "GTK_PRINT_STATUS_PRINTING"
+	case PrintStatusFinishedValue:
+		return "GTK_PRINT_STATUS_FINISHED"
+	case PrintStatusFinishedAbortedValue:
+		return "GTK_PRINT_STATUS_FINISHED_ABORTED"
+	}
+	return fmt.Sprintf("PrintStatus(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PrintStatus) Nick() string {
+	switch x {
+	case PrintStatusInitialValue:
+		return "initial"
+	case PrintStatusPreparingValue:
+		return "preparing"
+	case PrintStatusGeneratingDataValue:
+		return "generating-data"
+	case PrintStatusSendingDataValue:
+		return "sending-data"
+	case PrintStatusPendingValue:
+		return "pending"
+	case PrintStatusPendingIssueValue:
+		return "pending-issue"
+	case PrintStatusPrintingValue:
+		return "printing"
+	case PrintStatusFinishedValue:
+		return "finished"
+	case PrintStatusFinishedAbortedValue:
+		return "finished-aborted"
+	}
+	return ""
+}
+
+// PrintStatusFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PrintStatusFromString(s string) (PrintStatus, bool) {
+	switch s {
+	case "GTK_PRINT_STATUS_INITIAL", "initial":
+		return PrintStatusInitialValue, true
+	case "GTK_PRINT_STATUS_PREPARING", "preparing":
+		return PrintStatusPreparingValue, true
+	case "GTK_PRINT_STATUS_GENERATING_DATA", "generating-data":
+		return PrintStatusGeneratingDataValue, true
+	case "GTK_PRINT_STATUS_SENDING_DATA", "sending-data":
+		return PrintStatusSendingDataValue, true
+	case "GTK_PRINT_STATUS_PENDING", "pending":
+		return PrintStatusPendingValue, true
+	case "GTK_PRINT_STATUS_PENDING_ISSUE", "pending-issue":
+		return PrintStatusPendingIssueValue, true
+	case "GTK_PRINT_STATUS_PRINTING", "printing":
+		return PrintStatusPrintingValue, true
+	case "GTK_PRINT_STATUS_FINISHED", "finished":
+		return PrintStatusFinishedValue, true
+	case "GTK_PRINT_STATUS_FINISHED_ABORTED", "finished-aborted":
+		return PrintStatusFinishedAbortedValue, true
+	}
+	return 0, false
+}
+
 var xPrintErrorQuark func() glib.Quark
 
 // Registers an error quark for `GtkPrintOperation` if necessary.
@@ -497,14 +738,14 @@ func PrintErrorQuark() glib.Quark {
 
 var xPrintRunPageSetupDialog func(uintptr, uintptr, uintptr) uintptr
 
-// Runs a page setup dialog, letting the user modify the values from @page_setup.
+// Runs a page setup dialog, letting the user modify the values from page_setup.
 //
 // If the user cancels the dialog, the returned `GtkPageSetup` is identical
-// to the passed in @page_setup, otherwise it contains the modifications
+// to the passed in page_setup, otherwise it contains the modifications
 // done in the dialog.
 //
 // Note that this function may use a recursive mainloop to show the page
-// setup dialog. See [func@Gtk.print_run_page_setup_dialog_async] if this is
+// setup dialog. See [PrintRunPageSetupDialogAsync] if this is
 // a problem.
 func PrintRunPageSetupDialog(ParentVar *Window, PageSetupVar *PageSetup, SettingsVar *PrintSettings) *PageSetup {
 	var cls *PageSetup
@@ -521,11 +762,11 @@ func PrintRunPageSetupDialog(ParentVar *Window, PageSetupVar *PageSetup, Setting
 
 var xPrintRunPageSetupDialogAsync func(uintptr, uintptr, uintptr, uintptr, uintptr)
 
-// Runs a page setup dialog, letting the user modify the values from @page_setup.
+// Runs a page setup dialog, letting the user modify the values from page_setup.
 //
-// In contrast to [func@Gtk.print_run_page_setup_dialog], this function  returns
+// In contrast to [PrintRunPageSetupDialog], this function  returns
 // after showing the page setup dialog on platforms that support this, and calls
-// @done_cb from a signal handler for the ::response signal of the dialog.
+// done_cb from a signal handler for the ::response signal of the dialog.
 func PrintRunPageSetupDialogAsync(ParentVar *Window, PageSetupVar *PageSetup, SettingsVar *PrintSettings, DoneCbVar *PageSetupDoneFunc, DataVar uintptr) {
 
 	var DoneCbVarRef uintptr
@@ -535,11 +776,12 @@ func PrintRunPageSetupDialogAsync(ParentVar *Window, PageSetupVar *PageSetup, Se
 			DoneCbVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DoneCbVar
 				cbFn(arg0, arg1)
 			}
-			DoneCbVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DoneCbVarPtr, DoneCbVarRef, DoneCbVar)
+			DoneCbVarRef = core.NewCallback(fcb)
+			DoneCbVarRef = glib.SaveCallbackWithClosure(DoneCbVarPtr, DoneCbVarRef, DoneCbVar)
 		}
 	}
 
@@ -554,29 +796,27 @@ func PrintRunPageSetupDialogAsync(ParentVar *Window, PageSetupVar *PageSetup, Se
 // infrastructure to implement a good print dialog. On such
 // platforms, `GtkPrintOperation` uses the native print dialog.
 // On platforms which do not provide a native print dialog, GTK
-// uses its own, see [class@Gtk.PrintUnixDialog].
+// uses its own, see [PrintUnixDialog].
 //
 // The typical way to use the high-level printing API is to create
-// a `GtkPrintOperation` object with [ctor@Gtk.PrintOperation.new]
+// a `GtkPrintOperation` object with [NewPrintOperation]
 // when the user selects to print. Then you set some properties on it,
-// e.g. the page size, any [class@Gtk.PrintSettings] from previous print
+// e.g. the page size, any [PrintSettings] from previous print
 // operations, the number of pages, the current page, etc.
 //
-// Then you start the print operation by calling [method@Gtk.PrintOperation.run].
+// Then you start the print operation by calling [PrintOperation.Run].
 // It will then show a dialog, let the user select a printer and options.
 // When the user finished the dialog, various signals will be emitted on
 // the `GtkPrintOperation`, the main one being
-// [signal@Gtk.PrintOperation::draw-page], which you are supposed to handle
-// and render the page on the provided [class@Gtk.PrintContext] using Cairo.
+// [PrintOperation.ConnectDrawPageFunc], which you are supposed to handle
+// and render the page on the provided [PrintContext] using Cairo.
 //
 // # The high-level printing API
 //
-// ```c
-// static GtkPrintSettings *settings = NULL;
-//
-// static void
-// do_print (void)
+//	static GtkPrintSettings *settings = NULL;
 //
+//	static void
+//	do_print (void)
 //	{
 //	  GtkPrintOperation *print;
 //	  GtkPrintOperationResult res;
@@ -602,14 +842,12 @@ func PrintRunPageSetupDialogAsync(ParentVar *Window, PageSetupVar *PageSetup, Se
 //	  g_object_unref (print);
 //	}
 //
-// ```
-//
 // By default `GtkPrintOperation` uses an external application to do
 // print preview. To implement a custom print preview, an application
 // must connect to the preview signal. The functions
-// [method@Gtk.PrintOperationPreview.render_page],
-// [method@Gtk.PrintOperationPreview.end_preview] and
-// [method@Gtk.PrintOperationPreview.is_selected]
+// [PrintOperationPreview.RenderPage],
+// [PrintOperationPreview.EndPreview] and
+// [PrintOperationPreview.IsSelected]
 // are useful when implementing a print preview.
 type PrintOperation struct {
 	gobject.Object
@@ -647,8 +885,8 @@ var xPrintOperationCancel func(uintptr)
 
 // Cancels a running print operation.
 //
-// This function may be called from a [signal@Gtk.PrintOperation::begin-print],
-// [signal@Gtk.PrintOperation::paginate] or [signal@Gtk.PrintOperation::draw-page]
+// This function may be called from a [PrintOperation.ConnectBeginPrintFunc],
+// [PrintOperation.ConnectPaginateFunc] or [PrintOperation.ConnectDrawPageFunc]
 // signal handler to stop the currently running print operation.
 func (x *PrintOperation) Cancel() {
 
@@ -661,7 +899,7 @@ var xPrintOperationDrawPageFinish func(uintptr)
 // Signal that drawing of particular page is complete.
 //
 // It is called after completion of page drawing (e.g. drawing
-// in another thread). If [method@Gtk.PrintOperation.set_defer_drawing]
+// in another thread). If [PrintOperation.SetDeferDrawing]
 // was called before, then this function has to be called by application.
 // Otherwise it is called by GTK itself.
 func (x *PrintOperation) DrawPageFinish() {
@@ -699,10 +937,10 @@ func (x *PrintOperation) GetEmbedPageSetup() bool {
 var xPrintOperationGetError func(uintptr)
 
 // Call this when the result of a print operation is
-// %GTK_PRINT_OPERATION_RESULT_ERROR.
+// [PrintOperationResultErrorValue].
 //
-// It can be called either after [method@Gtk.PrintOperation.run]
-// returns, or in the [signal@Gtk.PrintOperation::done] signal
+// It can be called either after [PrintOperation.Run]
+// returns, or in the [PrintOperation.ConnectDoneFunc] signal
 // handler.
 //
 // The returned `GError` will contain more details on what went wrong.
@@ -711,7 +949,7 @@ func (x *PrintOperation) GetError() error {
 
 	xPrintOperationGetError(x.GoPointer())
 	if cerr == nil {
-		return nil
+		return core.PlatformError
 	}
 	return cerr
 
@@ -731,11 +969,11 @@ var xPrintOperationGetNPagesToPrint func(uintptr) int
 // Returns the number of pages that will be printed.
 //
 // Note that this value is set during print preparation phase
-// (%GTK_PRINT_STATUS_PREPARING), so this function should never be
-// called before the data generation phase (%GTK_PRINT_STATUS_GENERATING_DATA).
-// You can connect to the [signal@Gtk.PrintOperation::status-changed]
-// signal and call gtk_print_operation_get_n_pages_to_print() when
-// print status is %GTK_PRINT_STATUS_GENERATING_DATA.
+// ([PrintStatusPreparingValue]), so this function should never be
+// called before the data generation phase ([PrintStatusGeneratingDataValue]).
+// You can connect to the [PrintOperation.ConnectStatusChangedFunc]
+// signal and call [PrintOperation.GetNPagesToPrint] when
+// print status is [PrintStatusGeneratingDataValue].
 //
 // This is typically used to track the progress of print operation.
 func (x *PrintOperation) GetNPagesToPrint() int {
@@ -748,9 +986,9 @@ var xPrintOperationGetPrintSettings func(uintptr) uintptr
 
 // Returns the current print settings.
 //
-// Note that the return value is %NULL until either
-// [method@Gtk.PrintOperation.set_print_settings] or
-// [method@Gtk.PrintOperation.run] have been called.
+// Note that the return value is nil until either
+// [PrintOperation.SetPrintSettings] or
+// [PrintOperation.Run] have been called.
 func (x *PrintOperation) GetPrintSettings() *PrintSettings {
 	var cls *PrintSettings
 
@@ -769,7 +1007,7 @@ var xPrintOperationGetStatus func(uintptr) PrintStatus
 
 // Returns the status of the print operation.
 //
-// Also see [method@Gtk.PrintOperation.get_status_string].
+// Also see [PrintOperation.GetStatusString].
 func (x *PrintOperation) GetStatus() PrintStatus {
 
 	cret := xPrintOperationGetStatus(x.GoPointer())
@@ -784,7 +1022,7 @@ var xPrintOperationGetStatusString func(uintptr) string
 // The string is translated and suitable for displaying
 // the print status e.g. in a `GtkStatusbar`.
 //
-// Use [method@Gtk.PrintOperation.get_status] to obtain
+// Use [PrintOperation.GetStatus] to obtain
 // a status value that is suitable for programmatic use.
 func (x *PrintOperation) GetStatusString() string {
 
@@ -807,7 +1045,7 @@ var xPrintOperationIsFinished func(uintptr) bool
 // is finished.
 //
 // a print operation is finished if its status is either
-// %GTK_PRINT_STATUS_FINISHED or %GTK_PRINT_STATUS_FINISHED_ABORTED.
+// [PrintStatusFinishedValue] or [PrintStatusFinishedAbortedValue].
 //
 // Note: when you enable print status tracking the print operation
 // can be in a non-finished state even after done has been called, as
@@ -824,75 +1062,61 @@ var xPrintOperationRun func(uintptr, PrintOperationAction, uintptr, **glib.Error
 //
 // Normally that this function does not return until the rendering
 // of all pages is complete. You can connect to the
-// [signal@Gtk.PrintOperation::status-changed] signal on @op to obtain
+// [PrintOperation.ConnectStatusChangedFunc] signal on op to obtain
 // some information about the progress of the print operation.
 //
 // Furthermore, it may use a recursive mainloop to show the print dialog.
 //
 // If you set the [Gtk.PrintOperation:allow-async] property, the operation
 // will run asynchronously if this is supported on the platform. The
-// [signal@Gtk.PrintOperation::done] signal will be emitted with the result
+// [PrintOperation.ConnectDoneFunc] signal will be emitted with the result
 // of the operation when the it is done (i.e. when the dialog is canceled,
 // or when the print succeeds or fails).
 //
-// ```c
-// if (settings != NULL)
-//
-//	gtk_print_operation_set_print_settings (print, settings);
-//
-// if (page_setup != NULL)
-//
-//	gtk_print_operation_set_default_page_setup (print, page_setup);
-//
-// g_signal_connect (print, "begin-print",
-//
-//	G_CALLBACK (begin_print), &amp;data);
-//
-// g_signal_connect (print, "draw-page",
+//	if (settings != NULL)
+//	  gtk_print_operation_set_print_settings (print, settings);
 //
-//	G_CALLBACK (draw_page), &amp;data);
+//	if (page_setup != NULL)
+//	  gtk_print_operation_set_default_page_setup (print, page_setup);
 //
-// res = gtk_print_operation_run (print,
+//	g_signal_connect (print, "begin-print",
+//	                  G_CALLBACK (begin_print), &data);
+//	g_signal_connect (print, "draw-page",
+//	                  G_CALLBACK (draw_page), &data);
 //
-//	GTK_PRINT_OPERATION_ACTION_PRINT_DIALOG,
-//	parent,
-//	&amp;error);
-//
-// if (res == GTK_PRINT_OPERATION_RESULT_ERROR)
+//	res = gtk_print_operation_run (print,
+//	                               GTK_PRINT_OPERATION_ACTION_PRINT_DIALOG,
+//	                               parent,
+//	                               &error);
 //
+//	if (res == GTK_PRINT_OPERATION_RESULT_ERROR)
 //	 {
 //	   error_dialog = gtk_message_dialog_new (GTK_WINDOW (parent),
 //	  			                     GTK_DIALOG_DESTROY_WITH_PARENT,
 //						     GTK_MESSAGE_ERROR,
 //						     GTK_BUTTONS_CLOSE,
 //						     "Error printing file:\n%s",
-//						     error-&gt;message);
+//						     error->message);
 //	   g_signal_connect (error_dialog, "response",
 //	                     G_CALLBACK (gtk_window_destroy), NULL);
 //	   gtk_window_present (GTK_WINDOW (error_dialog));
 //	   g_error_free (error);
 //	 }
+//	else if (res == GTK_PRINT_OPERATION_RESULT_APPLY)
+//	 {
+//	   if (settings != NULL)
+//	g_object_unref (settings);
+//	   settings = g_object_ref (gtk_print_operation_get_print_settings (print));
+//	 }
 //
-// else if (res == GTK_PRINT_OPERATION_RESULT_APPLY)
-//
-//	{
-//	  if (settings != NULL)
-//
-// g_object_unref (settings);
-//
-//	  settings = g_object_ref (gtk_print_operation_get_print_settings (print));
-//	}
-//
-// ```
-//
-// Note that gtk_print_operation_run() can only be called once on a
+// Note that [PrintOperation.Run] can only be called once on a
 // given `GtkPrintOperation`.
 func (x *PrintOperation) Run(ActionVar PrintOperationAction, ParentVar *Window) (PrintOperationResult, error) {
 	var cerr *glib.Error
 
 	cret := xPrintOperationRun(x.GoPointer(), ActionVar, ParentVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -900,7 +1124,7 @@ func (x *PrintOperation) Run(ActionVar PrintOperationAction, ParentVar *Window)
 
 var xPrintOperationSetAllowAsync func(uintptr, bool)
 
-// Sets whether gtk_print_operation_run() may return
+// Sets whether [PrintOperation.Run] may return
 // before the print operation is completed.
 //
 // Note that some platforms may not allow asynchronous
@@ -915,7 +1139,7 @@ var xPrintOperationSetCurrentPage func(uintptr, int)
 
 // Sets the current page.
 //
-// If this is called before [method@Gtk.PrintOperation.run],
+// If this is called before [PrintOperation.Run],
 // the user will be able to select to print only the current page.
 //
 // Note that this only makes sense for pre-paginated documents.
@@ -939,11 +1163,11 @@ func (x *PrintOperation) SetCustomTabLabel(LabelVar *string) {
 
 var xPrintOperationSetDefaultPageSetup func(uintptr, uintptr)
 
-// Makes @default_page_setup the default page setup for @op.
+// Makes default_page_setup the default page setup for op.
 //
-// This page setup will be used by [method@Gtk.PrintOperation.run],
+// This page setup will be used by [PrintOperation.Run],
 // but it can be overridden on a per-page basis by connecting
-// to the [signal@Gtk.PrintOperation::request-page-setup] signal.
+// to the [PrintOperation.ConnectRequestPageSetupFunc] signal.
 func (x *PrintOperation) SetDefaultPageSetup(DefaultPageSetupVar *PageSetup) {
 
 	xPrintOperationSetDefaultPageSetup(x.GoPointer(), DefaultPageSetupVar.GoPointer())
@@ -958,7 +1182,7 @@ var xPrintOperationSetDeferDrawing func(uintptr)
 // This can be used for drawing page in another thread.
 //
 // This function must be called in the callback of the
-// [signal@Gtk.PrintOperation::draw-page] signal.
+// [PrintOperation.ConnectDrawPageFunc] signal.
 func (x *PrintOperation) SetDeferDrawing() {
 
 	xPrintOperationSetDeferDrawing(x.GoPointer())
@@ -999,8 +1223,8 @@ var xPrintOperationSetHasSelection func(uintptr, bool)
 // Sets whether there is a selection to print.
 //
 // Application has to set number of pages to which the selection
-// will draw by [method@Gtk.PrintOperation.set_n_pages] in a handler
-// for the [signal@Gtk.PrintOperation::begin-print] signal.
+// will draw by [PrintOperation.SetNPages] in a handler
+// for the [PrintOperation.ConnectBeginPrintFunc] signal.
 func (x *PrintOperation) SetHasSelection(HasSelectionVar bool) {
 
 	xPrintOperationSetHasSelection(x.GoPointer(), HasSelectionVar)
@@ -1027,14 +1251,14 @@ var xPrintOperationSetNPages func(uintptr, int)
 // Sets the number of pages in the document.
 //
 // This must be set to a positive number before the rendering
-// starts. It may be set in a [signal@Gtk.PrintOperation::begin-print]
+// starts. It may be set in a [PrintOperation.ConnectBeginPrintFunc]
 // signal handler.
 //
 // Note that the page numbers passed to the
-// [signal@Gtk.PrintOperation::request-page-setup]
-// and [signal@Gtk.PrintOperation::draw-page] signals are 0-based, i.e.
+// [PrintOperation.ConnectRequestPageSetupFunc]
+// and [PrintOperation.ConnectDrawPageFunc] signals are 0-based, i.e.
 // if the user chooses to print all pages, the last ::draw-page signal
-// will be for page @n_pages - 1.
+// will be for page n_pages - 1.
 func (x *PrintOperation) SetNPages(NPagesVar int) {
 
 	xPrintOperationSetNPages(x.GoPointer(), NPagesVar)
@@ -1043,10 +1267,10 @@ func (x *PrintOperation) SetNPages(NPagesVar int) {
 
 var xPrintOperationSetPrintSettings func(uintptr, uintptr)
 
-// Sets the print settings for @op.
+// Sets the print settings for op.
 //
 // This is typically used to re-establish print settings
-// from a previous print operation, see [method@Gtk.PrintOperation.run].
+// from a previous print operation, see [PrintOperation.Run].
 func (x *PrintOperation) SetPrintSettings(PrintSettingsVar *PrintSettings) {
 
 	xPrintOperationSetPrintSettings(x.GoPointer(), PrintSettingsVar.GoPointer())
@@ -1055,7 +1279,7 @@ func (x *PrintOperation) SetPrintSettings(PrintSettingsVar *PrintSettings) {
 
 var xPrintOperationSetShowProgress func(uintptr, bool)
 
-// If @show_progress is %TRUE, the print operation will show
+// If show_progress is true, the print operation will show
 // a progress dialog during the print operation.
 func (x *PrintOperation) SetShowProgress(ShowProgressVar bool) {
 
@@ -1074,7 +1298,7 @@ func (x *PrintOperation) SetSupportSelection(SupportSelectionVar bool) {
 
 var xPrintOperationSetTrackPrintStatus func(uintptr, bool)
 
-// If track_status is %TRUE, the print operation will try to continue
+// If track_status is true, the print operation will try to continue
 // report on the status of the print job in the printer queues and printer.
 //
 // This can allow your application to show things like “out of paper”
@@ -1092,7 +1316,7 @@ var xPrintOperationSetUnit func(uintptr, Unit)
 
 // Sets up the transformation for the cairo context obtained from
 // `GtkPrintContext` in such a way that distances are measured in
-// units of @unit.
+// units of unit.
 func (x *PrintOperation) SetUnit(UnitVar Unit) {
 
 	xPrintOperationSetUnit(x.GoPointer(), UnitVar)
@@ -1101,7 +1325,7 @@ func (x *PrintOperation) SetUnit(UnitVar Unit) {
 
 var xPrintOperationSetUseFullPage func(uintptr, bool)
 
-// If @full_page is %TRUE, the transformation for the cairo context
+// If full_page is true, the transformation for the cairo context
 // obtained from `GtkPrintContext` puts the origin at the top left
 // corner of the page.
 //
@@ -1129,13 +1353,13 @@ func (c *PrintOperation) SetGoPointer(ptr uintptr) {
 // Determines whether the print operation may run asynchronously or not.
 //
 // Some systems don't support asynchronous printing, but those that do
-// will return %GTK_PRINT_OPERATION_RESULT_IN_PROGRESS as the status, and
-// emit the [signal@Gtk.PrintOperation::done] signal when the operation
+// will return [PrintOperationResultInProgressValue] as the status, and
+// emit the [PrintOperation.ConnectDoneFunc] signal when the operation
 // is actually done.
 //
 // The Windows port does not support asynchronous operation at all (this
 // is unlikely to change). On other platforms, all actions except for
-// %GTK_PRINT_OPERATION_ACTION_EXPORT support asynchronous operation.
+// [PrintOperationActionExportValue] support asynchronous operation.
 func (x *PrintOperation) SetPropertyAllowAsync(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1147,13 +1371,13 @@ func (x *PrintOperation) SetPropertyAllowAsync(value bool) {
 // Determines whether the print operation may run asynchronously or not.
 //
 // Some systems don't support asynchronous printing, but those that do
-// will return %GTK_PRINT_OPERATION_RESULT_IN_PROGRESS as the status, and
-// emit the [signal@Gtk.PrintOperation::done] signal when the operation
+// will return [PrintOperationResultInProgressValue] as the status, and
+// emit the [PrintOperation.ConnectDoneFunc] signal when the operation
 // is actually done.
 //
 // The Windows port does not support asynchronous operation at all (this
 // is unlikely to change). On other platforms, all actions except for
-// %GTK_PRINT_OPERATION_ACTION_EXPORT support asynchronous operation.
+// [PrintOperationActionExportValue] support asynchronous operation.
 func (x *PrintOperation) GetPropertyAllowAsync() bool {
 	var v gobject.Value
 	x.GetProperty("allow-async", &v)
@@ -1163,7 +1387,7 @@ func (x *PrintOperation) GetPropertyAllowAsync() bool {
 // SetPropertyCurrentPage sets the "current-page" property.
 // The current page in the document.
 //
-// If this is set before [method@Gtk.PrintOperation.run],
+// If this is set before [PrintOperation.Run],
 // the user will be able to select to print only the current page.
 //
 // Note that this only makes sense for pre-paginated documents.
@@ -1177,7 +1401,7 @@ func (x *PrintOperation) SetPropertyCurrentPage(value int) {
 // GetPropertyCurrentPage gets the "current-page" property.
 // The current page in the document.
 //
-// If this is set before [method@Gtk.PrintOperation.run],
+// If this is set before [PrintOperation.Run],
 // the user will be able to select to print only the current page.
 //
 // Note that this only makes sense for pre-paginated documents.
@@ -1192,7 +1416,7 @@ func (x *PrintOperation) GetPropertyCurrentPage() int {
 //
 // Note that this property may be ignored on some platforms.
 //
-// If this is %NULL, GTK uses a default label.
+// If this is nil, GTK uses a default label.
 func (x *PrintOperation) SetPropertyCustomTabLabel(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1205,7 +1429,7 @@ func (x *PrintOperation) SetPropertyCustomTabLabel(value string) {
 //
 // Note that this property may be ignored on some platforms.
 //
-// If this is %NULL, GTK uses a default label.
+// If this is nil, GTK uses a default label.
 func (x *PrintOperation) GetPropertyCustomTabLabel() string {
 	var v gobject.Value
 	x.GetProperty("custom-tab-label", &v)
@@ -1213,7 +1437,7 @@ func (x *PrintOperation) GetPropertyCustomTabLabel() string {
 }
 
 // SetPropertyEmbedPageSetup sets the "embed-page-setup" property.
-// If %TRUE, page size combo box and orientation combo box
+// If true, page size combo box and orientation combo box
 // are embedded into page setup page.
 func (x *PrintOperation) SetPropertyEmbedPageSetup(value bool) {
 	var v gobject.Value
@@ -1223,7 +1447,7 @@ func (x *PrintOperation) SetPropertyEmbedPageSetup(value bool) {
 }
 
 // GetPropertyEmbedPageSetup gets the "embed-page-setup" property.
-// If %TRUE, page size combo box and orientation combo box
+// If true, page size combo box and orientation combo box
 // are embedded into page setup page.
 func (x *PrintOperation) GetPropertyEmbedPageSetup() bool {
 	var v gobject.Value
@@ -1318,14 +1542,14 @@ func (x *PrintOperation) GetPropertyJobName() string {
 // The number of pages in the document.
 //
 // This must be set to a positive number before the rendering
-// starts. It may be set in a [signal@Gtk.PrintOperation::begin-print]
+// starts. It may be set in a [PrintOperation.ConnectBeginPrintFunc]
 // signal handler.
 //
 // Note that the page numbers passed to the
-// [signal@Gtk.PrintOperation::request-page-setup] and
-// [signal@Gtk.PrintOperation::draw-page] signals are 0-based, i.e.
+// [PrintOperation.ConnectRequestPageSetupFunc] and
+// [PrintOperation.ConnectDrawPageFunc] signals are 0-based, i.e.
 // if the user chooses to print all pages, the last ::draw-page signal
-// will be for page @n_pages - 1.
+// will be for page n_pages - 1.
 func (x *PrintOperation) SetPropertyNPages(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -1337,14 +1561,14 @@ func (x *PrintOperation) SetPropertyNPages(value int) {
 // The number of pages in the document.
 //
 // This must be set to a positive number before the rendering
-// starts. It may be set in a [signal@Gtk.PrintOperation::begin-print]
+// starts. It may be set in a [PrintOperation.ConnectBeginPrintFunc]
 // signal handler.
 //
 // Note that the page numbers passed to the
-// [signal@Gtk.PrintOperation::request-page-setup] and
-// [signal@Gtk.PrintOperation::draw-page] signals are 0-based, i.e.
+// [PrintOperation.ConnectRequestPageSetupFunc] and
+// [PrintOperation.ConnectDrawPageFunc] signals are 0-based, i.e.
 // if the user chooses to print all pages, the last ::draw-page signal
-// will be for page @n_pages - 1.
+// will be for page n_pages - 1.
 func (x *PrintOperation) GetPropertyNPages() int {
 	var v gobject.Value
 	x.GetProperty("n-pages", &v)
@@ -1355,11 +1579,11 @@ func (x *PrintOperation) GetPropertyNPages() int {
 // The number of pages that will be printed.
 //
 // Note that this value is set during print preparation phase
-// (%GTK_PRINT_STATUS_PREPARING), so this value should never be
-// get before the data generation phase (%GTK_PRINT_STATUS_GENERATING_DATA).
-// You can connect to the [signal@Gtk.PrintOperation::status-changed] signal
-// and call [method@Gtk.PrintOperation.get_n_pages_to_print] when
-// print status is %GTK_PRINT_STATUS_GENERATING_DATA.
+// ([PrintStatusPreparingValue]), so this value should never be
+// get before the data generation phase ([PrintStatusGeneratingDataValue]).
+// You can connect to the [PrintOperation.ConnectStatusChangedFunc] signal
+// and call [PrintOperation.GetNPagesToPrint] when
+// print status is [PrintStatusGeneratingDataValue].
 //
 // This is typically used to track the progress of print operation.
 func (x *PrintOperation) GetPropertyNPagesToPrint() int {
@@ -1393,7 +1617,7 @@ func (x *PrintOperation) GetPropertyShowProgress() bool {
 // The string is translated and suitable for displaying the print
 // status e.g. in a `GtkStatusbar`.
 //
-// See the [property@Gtk.PrintOperation:status] property for a status
+// See the PrintOperation:status property for a status
 // value that is suitable for programmatic use.
 func (x *PrintOperation) GetPropertyStatusString() string {
 	var v gobject.Value
@@ -1402,7 +1626,7 @@ func (x *PrintOperation) GetPropertyStatusString() string {
 }
 
 // SetPropertySupportSelection sets the "support-selection" property.
-// If %TRUE, the print operation will support print of selection.
+// If true, the print operation will support print of selection.
 //
 // This allows the print dialog to show a "Selection" button.
 func (x *PrintOperation) SetPropertySupportSelection(value bool) {
@@ -1413,7 +1637,7 @@ func (x *PrintOperation) SetPropertySupportSelection(value bool) {
 }
 
 // GetPropertySupportSelection gets the "support-selection" property.
-// If %TRUE, the print operation will support print of selection.
+// If true, the print operation will support print of selection.
 //
 // This allows the print dialog to show a "Selection" button.
 func (x *PrintOperation) GetPropertySupportSelection() bool {
@@ -1423,7 +1647,7 @@ func (x *PrintOperation) GetPropertySupportSelection() bool {
 }
 
 // SetPropertyTrackPrintStatus sets the "track-print-status" property.
-// If %TRUE, the print operation will try to continue report on
+// If true, the print operation will try to continue report on
 // the status of the print job in the printer queues and printer.
 //
 // This can allow your application to show things like “out of paper”
@@ -1438,7 +1662,7 @@ func (x *PrintOperation) SetPropertyTrackPrintStatus(value bool) {
 }
 
 // GetPropertyTrackPrintStatus gets the "track-print-status" property.
-// If %TRUE, the print operation will try to continue report on
+// If true, the print operation will try to continue report on
 // the status of the print job in the printer queues and printer.
 //
 // This can allow your application to show things like “out of paper”
@@ -1452,7 +1676,7 @@ func (x *PrintOperation) GetPropertyTrackPrintStatus() bool {
 }
 
 // SetPropertyUseFullPage sets the "use-full-page" property.
-// If %TRUE, the transformation for the cairo context obtained
+// If true, the transformation for the cairo context obtained
 // from `GtkPrintContext` puts the origin at the top left corner
 // of the page.
 //
@@ -1468,7 +1692,7 @@ func (x *PrintOperation) SetPropertyUseFullPage(value bool) {
 }
 
 // GetPropertyUseFullPage gets the "use-full-page" property.
-// If %TRUE, the transformation for the cairo context obtained
+// If true, the transformation for the cairo context obtained
 // from `GtkPrintContext` puts the origin at the top left corner
 // of the page.
 //
@@ -1486,30 +1710,52 @@ func (x *PrintOperation) GetPropertyUseFullPage() bool {
 // in the dialog, before the actual rendering starts.
 //
 // A typical use for ::begin-print is to use the parameters from the
-// [class@Gtk.PrintContext] and paginate the document accordingly,
+// [PrintContext] and paginate the document accordingly,
 // and then set the number of pages with
-// [method@Gtk.PrintOperation.set_n_pages].
+// [PrintOperation.SetNPages].
+//
+// Deprecated: use ConnectBeginPrintFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectBeginPrint(cb *func(PrintOperation, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "begin-print", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "begin-print", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr) {
+var xPrintOperationBeginPrintTrampoline uintptr
+
+func xPrintOperationBeginPrintNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "begin-print", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBeginPrintFunc connects cb to the "begin-print" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectBeginPrintFunc(cb func(PrintOperation, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "begin-print", &xPrintOperationBeginPrintTrampoline, xPrintOperationBeginPrintNewTrampoline, cb)
 }
 
 // Emitted when displaying the print dialog.
@@ -1520,31 +1766,54 @@ func (x *PrintOperation) ConnectBeginPrint(cb *func(PrintOperation, uintptr)) ui
 //
 // The print dialog owns the returned widget, and its lifetime is not
 // controlled by the application. However, the widget is guaranteed
-// to stay around until the [signal@Gtk.PrintOperation::custom-widget-apply]
+// to stay around until the [PrintOperation.ConnectCustomWidgetApplyFunc]
 // signal is emitted on the operation. Then you can read out any
 // information you need from the widgets.
+//
+// Deprecated: use ConnectCreateCustomWidgetFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectCreateCustomWidget(cb *func(PrintOperation) gobject.Object) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "create-custom-widget", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) uintptr {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			CreateCustomWidgetCls := cbFn(fa)
+			return CreateCustomWidgetCls.Ptr
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "create-custom-widget", cbRefPtr, cbPtr)
+}
+
+var xPrintOperationCreateCustomWidgetTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) uintptr {
+func xPrintOperationCreateCustomWidgetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation) gobject.Object)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		CreateCustomWidgetCls := cbFn(fa)
 		return CreateCustomWidgetCls.Ptr
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "create-custom-widget", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCreateCustomWidgetFunc connects cb to the "create-custom-widget" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectCreateCustomWidgetFunc(cb func(PrintOperation) gobject.Object) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "create-custom-widget", &xPrintOperationCreateCustomWidgetTrampoline, xPrintOperationCreateCustomWidgetNewTrampoline, cb)
 }
 
 // Emitted right before ::begin-print if you added
@@ -1553,76 +1822,117 @@ func (x *PrintOperation) ConnectCreateCustomWidget(cb *func(PrintOperation) gobj
 // When you get this signal you should read the information from the
 // custom widgets, as the widgets are not guaranteed to be around at a
 // later time.
+//
+// Deprecated: use ConnectCustomWidgetApplyFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectCustomWidgetApply(cb *func(PrintOperation, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "custom-widget-apply", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidgetVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidgetVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "custom-widget-apply", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WidgetVarp uintptr) {
+var xPrintOperationCustomWidgetApplyTrampoline uintptr
+
+func xPrintOperationCustomWidgetApplyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidgetVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidgetVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "custom-widget-apply", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCustomWidgetApplyFunc connects cb to the "custom-widget-apply" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectCustomWidgetApplyFunc(cb func(PrintOperation, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "custom-widget-apply", &xPrintOperationCustomWidgetApplyTrampoline, xPrintOperationCustomWidgetApplyNewTrampoline, cb)
 }
 
 // Emitted when the print operation run has finished doing
 // everything required for printing.
 //
-// @result gives you information about what happened during the run.
-// If @result is %GTK_PRINT_OPERATION_RESULT_ERROR then you can call
-// [method@Gtk.PrintOperation.get_error] for more information.
+// result gives you information about what happened during the run.
+// If result is [PrintOperationResultErrorValue] then you can call
+// [PrintOperation.GetError] for more information.
 //
 // If you enabled print status tracking then
-// [method@Gtk.PrintOperation.is_finished] may still return %FALSE
+// [PrintOperation.IsFinished] may still return false
 // after the ::done signal was emitted.
+//
+// Deprecated: use ConnectDoneFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectDone(cb *func(PrintOperation, PrintOperationResult)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "done", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ResultVarp PrintOperationResult) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ResultVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "done", cbRefPtr, cbPtr)
+}
+
+var xPrintOperationDoneTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ResultVarp PrintOperationResult) {
+func xPrintOperationDoneNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ResultVarp PrintOperationResult, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, PrintOperationResult))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ResultVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "done", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDoneFunc connects cb to the "done" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectDoneFunc(cb func(PrintOperation, PrintOperationResult)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "done", &xPrintOperationDoneTrampoline, xPrintOperationDoneNewTrampoline, cb)
 }
 
 // Emitted for every page that is printed.
 //
-// The signal handler must render the @page_nr's page onto the cairo
-// context obtained from @context using
-// [method@Gtk.PrintContext.get_cairo_context].
-//
-// ```c
-// static void
-// draw_page (GtkPrintOperation *operation,
-//
-//	GtkPrintContext   *context,
-//	int                page_nr,
-//	gpointer           user_data)
+// The signal handler must render the page_nr's page onto the cairo
+// context obtained from context using
+// [PrintContext.GetCairoContext].
 //
+//	static void
+//	draw_page (GtkPrintOperation *operation,
+//	           GtkPrintContext   *context,
+//	           int                page_nr,
+//	           gpointer           user_data)
 //	{
 //	  cairo_t *cr;
 //	  PangoLayout *layout;
@@ -1648,7 +1958,7 @@ func (x *PrintOperation) ConnectDone(cb *func(PrintOperation, PrintOperationResu
 //	  pango_layout_set_width (layout, width * PANGO_SCALE);
 //	  pango_layout_set_alignment (layout, PANGO_ALIGN_CENTER);
 //
-//	  pango_layout_get_size (layout, NULL, &amp;layout_height);
+//	  pango_layout_get_size (layout, NULL, &layout_height);
 //	  text_height = (double)layout_height / PANGO_SCALE;
 //
 //	  cairo_move_to (cr, width / 2,  (HEADER_HEIGHT - text_height) / 2);
@@ -1657,97 +1967,161 @@ func (x *PrintOperation) ConnectDone(cb *func(PrintOperation, PrintOperationResu
 //	  g_object_unref (layout);
 //	}
 //
-// ```
-//
-// Use [method@Gtk.PrintOperation.set_use_full_page] and
-// [method@Gtk.PrintOperation.set_unit] before starting the print
+// Use [PrintOperation.SetUseFullPage] and
+// [PrintOperation.SetUnit] before starting the print
 // operation to set up the transformation of the cairo context
 // according to your needs.
+//
+// Deprecated: use ConnectDrawPageFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectDrawPage(cb *func(PrintOperation, uintptr, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "draw-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextVarp, PageNrVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "draw-page", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int) {
+var xPrintOperationDrawPageTrampoline uintptr
+
+func xPrintOperationDrawPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextVarp, PageNrVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "draw-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDrawPageFunc connects cb to the "draw-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectDrawPageFunc(cb func(PrintOperation, uintptr, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "draw-page", &xPrintOperationDrawPageTrampoline, xPrintOperationDrawPageNewTrampoline, cb)
 }
 
 // Emitted after all pages have been rendered.
 //
 // A handler for this signal can clean up any resources that have
-// been allocated in the [signal@Gtk.PrintOperation::begin-print] handler.
+// been allocated in the [PrintOperation.ConnectBeginPrintFunc] handler.
+//
+// Deprecated: use ConnectEndPrintFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectEndPrint(cb *func(PrintOperation, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "end-print", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "end-print", cbRefPtr, cbPtr)
+}
+
+var xPrintOperationEndPrintTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr) {
+func xPrintOperationEndPrintNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "end-print", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEndPrintFunc connects cb to the "end-print" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectEndPrintFunc(cb func(PrintOperation, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "end-print", &xPrintOperationEndPrintTrampoline, xPrintOperationEndPrintNewTrampoline, cb)
 }
 
 // Emitted after the ::begin-print signal, but before the actual rendering
 // starts.
 //
-// It keeps getting emitted until a connected signal handler returns %TRUE.
+// It keeps getting emitted until a connected signal handler returns true.
 //
 // The ::paginate signal is intended to be used for paginating a document
 // in small chunks, to avoid blocking the user interface for a long
 // time. The signal handler should update the number of pages using
-// [method@Gtk.PrintOperation.set_n_pages], and return %TRUE if the document
+// [PrintOperation.SetNPages], and return true if the document
 // has been completely paginated.
 //
 // If you don't need to do pagination in chunks, you can simply do
 // it all in the ::begin-print handler, and set the number of pages
 // from there.
+//
+// Deprecated: use ConnectPaginateFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectPaginate(cb *func(PrintOperation, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "paginate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ContextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "paginate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr) bool {
+var xPrintOperationPaginateTrampoline uintptr
+
+func xPrintOperationPaginateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ContextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "paginate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPaginateFunc connects cb to the "paginate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectPaginateFunc(cb func(PrintOperation, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "paginate", &xPrintOperationPaginateTrampoline, xPrintOperationPaginateNewTrampoline, cb)
 }
 
 // Gets emitted when a preview is requested from the native dialog.
@@ -1756,121 +2130,209 @@ func (x *PrintOperation) ConnectPaginate(cb *func(PrintOperation, uintptr) bool)
 // application to preview.
 //
 // To implement a custom print preview, an application must return
-// %TRUE from its handler for this signal. In order to use the
-// provided @context for the preview implementation, it must be
+// true from its handler for this signal. In order to use the
+// provided context for the preview implementation, it must be
 // given a suitable cairo context with
-// [method@Gtk.PrintContext.set_cairo_context].
+// [PrintContext.SetCairoContext].
 //
 // The custom preview implementation can use
-// [method@Gtk.PrintOperationPreview.is_selected] and
-// [method@Gtk.PrintOperationPreview.render_page] to find pages which
+// [PrintOperationPreview.IsSelected] and
+// [PrintOperationPreview.RenderPage] to find pages which
 // are selected for print and render them. The preview must be
-// finished by calling [method@Gtk.PrintOperationPreview.end_preview]
+// finished by calling [PrintOperationPreview.EndPreview]
 // (typically in response to the user clicking a close button).
+//
+// Deprecated: use ConnectPreviewFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectPreview(cb *func(PrintOperation, uintptr, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preview", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, PreviewVarp, ContextVarp, ParentVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preview", cbRefPtr, cbPtr)
+}
+
+var xPrintOperationPreviewTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr) bool {
+func xPrintOperationPreviewNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PreviewVarp uintptr, ContextVarp uintptr, ParentVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, PreviewVarp, ContextVarp, ParentVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preview", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreviewFunc connects cb to the "preview" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectPreviewFunc(cb func(PrintOperation, uintptr, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preview", &xPrintOperationPreviewTrampoline, xPrintOperationPreviewNewTrampoline, cb)
 }
 
 // Emitted once for every page that is printed.
 //
 // This gives the application a chance to modify the page setup.
-// Any changes done to @setup will be in force only for printing
+// Any changes done to setup will be in force only for printing
 // this page.
+//
+// Deprecated: use ConnectRequestPageSetupFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectRequestPageSetup(cb *func(PrintOperation, uintptr, int, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "request-page-setup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextVarp, PageNrVarp, SetupVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "request-page-setup", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr) {
+var xPrintOperationRequestPageSetupTrampoline uintptr
+
+func xPrintOperationRequestPageSetupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextVarp uintptr, PageNrVarp int, SetupVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr, int, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextVarp, PageNrVarp, SetupVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "request-page-setup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRequestPageSetupFunc connects cb to the "request-page-setup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectRequestPageSetupFunc(cb func(PrintOperation, uintptr, int, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "request-page-setup", &xPrintOperationRequestPageSetupTrampoline, xPrintOperationRequestPageSetupNewTrampoline, cb)
 }
 
 // Emitted at between the various phases of the print operation.
 //
-// See [enum@Gtk.PrintStatus] for the phases that are being discriminated.
-// Use [method@Gtk.PrintOperation.get_status] to find out the current
+// See [PrintStatus] for the phases that are being discriminated.
+// Use [PrintOperation.GetStatus] to find out the current
 // status.
+//
+// Deprecated: use ConnectStatusChangedFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectStatusChanged(cb *func(PrintOperation)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "status-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "status-changed", cbRefPtr, cbPtr)
+}
+
+var xPrintOperationStatusChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xPrintOperationStatusChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "status-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStatusChangedFunc connects cb to the "status-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectStatusChangedFunc(cb func(PrintOperation)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "status-changed", &xPrintOperationStatusChangedTrampoline, xPrintOperationStatusChangedNewTrampoline, cb)
 }
 
 // Emitted after change of selected printer.
 //
 // The actual page setup and print settings are passed to the custom
 // widget, which can actualize itself according to this change.
+//
+// Deprecated: use ConnectUpdateCustomWidgetFunc, which also accepts method values and closures.
 func (x *PrintOperation) ConnectUpdateCustomWidget(cb *func(PrintOperation, uintptr, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "update-custom-widget", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := PrintOperation{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, WidgetVarp, SetupVarp, SettingsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "update-custom-widget", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr) {
+var xPrintOperationUpdateCustomWidgetTrampoline uintptr
+
+func xPrintOperationUpdateCustomWidgetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, WidgetVarp uintptr, SetupVarp uintptr, SettingsVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(PrintOperation, uintptr, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := PrintOperation{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, WidgetVarp, SetupVarp, SettingsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "update-custom-widget", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUpdateCustomWidgetFunc connects cb to the "update-custom-widget" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *PrintOperation) ConnectUpdateCustomWidgetFunc(cb func(PrintOperation, uintptr, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "update-custom-widget", &xPrintOperationUpdateCustomWidgetTrampoline, xPrintOperationUpdateCustomWidgetNewTrampoline, cb)
 }
 
 // Ends a preview.
@@ -1893,8 +2355,8 @@ func (x *PrintOperation) IsSelected(PageNrVar int) bool {
 // Renders a page to the preview.
 //
 // This is using the print context that was passed to the
-// [signal@Gtk.PrintOperation::preview] handler together
-// with @preview.
+// [PrintOperation.ConnectPreviewFunc] handler together
+// with preview.
 //
 // A custom print preview should use this function to render
 // the currently selected page.
@@ -1912,7 +2374,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1927,42 +2389,44 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintStatusGLibType, libs, "gtk_print_status_get_type")
 
-	core.PuregoSafeRegister(&xPrintErrorQuark, libs, "gtk_print_error_quark")
-	core.PuregoSafeRegister(&xPrintRunPageSetupDialog, libs, "gtk_print_run_page_setup_dialog")
-	core.PuregoSafeRegister(&xPrintRunPageSetupDialogAsync, libs, "gtk_print_run_page_setup_dialog_async")
+	core.PuregoRegisterSince(&xPrintErrorQuark, libs, "gtk_print_error_quark", "GTK", "")
+	core.PuregoRegisterSince(&xPrintRunPageSetupDialog, libs, "gtk_print_run_page_setup_dialog", "GTK", "")
+	core.PuregoRegisterSince(&xPrintRunPageSetupDialogAsync, libs, "gtk_print_run_page_setup_dialog_async", "GTK", "")
 
 	core.PuregoSafeRegister(&xPrintOperationGLibType, libs, "gtk_print_operation_get_type")
 
-	core.PuregoSafeRegister(&xNewPrintOperation, libs, "gtk_print_operation_new")
-
-	core.PuregoSafeRegister(&xPrintOperationCancel, libs, "gtk_print_operation_cancel")
-	core.PuregoSafeRegister(&xPrintOperationDrawPageFinish, libs, "gtk_print_operation_draw_page_finish")
-	core.PuregoSafeRegister(&xPrintOperationGetDefaultPageSetup, libs, "gtk_print_operation_get_default_page_setup")
-	core.PuregoSafeRegister(&xPrintOperationGetEmbedPageSetup, libs, "gtk_print_operation_get_embed_page_setup")
-	core.PuregoSafeRegister(&xPrintOperationGetError, libs, "gtk_print_operation_get_error")
-	core.PuregoSafeRegister(&xPrintOperationGetHasSelection, libs, "gtk_print_operation_get_has_selection")
-	core.PuregoSafeRegister(&xPrintOperationGetNPagesToPrint, libs, "gtk_print_operation_get_n_pages_to_print")
-	core.PuregoSafeRegister(&xPrintOperationGetPrintSettings, libs, "gtk_print_operation_get_print_settings")
-	core.PuregoSafeRegister(&xPrintOperationGetStatus, libs, "gtk_print_operation_get_status")
-	core.PuregoSafeRegister(&xPrintOperationGetStatusString, libs, "gtk_print_operation_get_status_string")
-	core.PuregoSafeRegister(&xPrintOperationGetSupportSelection, libs, "gtk_print_operation_get_support_selection")
-	core.PuregoSafeRegister(&xPrintOperationIsFinished, libs, "gtk_print_operation_is_finished")
-	core.PuregoSafeRegister(&xPrintOperationRun, libs, "gtk_print_operation_run")
-	core.PuregoSafeRegister(&xPrintOperationSetAllowAsync, libs, "gtk_print_operation_set_allow_async")
-	core.PuregoSafeRegister(&xPrintOperationSetCurrentPage, libs, "gtk_print_operation_set_current_page")
-	core.PuregoSafeRegister(&xPrintOperationSetCustomTabLabel, libs, "gtk_print_operation_set_custom_tab_label")
-	core.PuregoSafeRegister(&xPrintOperationSetDefaultPageSetup, libs, "gtk_print_operation_set_default_page_setup")
-	core.PuregoSafeRegister(&xPrintOperationSetDeferDrawing, libs, "gtk_print_operation_set_defer_drawing")
-	core.PuregoSafeRegister(&xPrintOperationSetEmbedPageSetup, libs, "gtk_print_operation_set_embed_page_setup")
-	core.PuregoSafeRegister(&xPrintOperationSetExportFilename, libs, "gtk_print_operation_set_export_filename")
-	core.PuregoSafeRegister(&xPrintOperationSetHasSelection, libs, "gtk_print_operation_set_has_selection")
-	core.PuregoSafeRegister(&xPrintOperationSetJobName, libs, "gtk_print_operation_set_job_name")
-	core.PuregoSafeRegister(&xPrintOperationSetNPages, libs, "gtk_print_operation_set_n_pages")
-	core.PuregoSafeRegister(&xPrintOperationSetPrintSettings, libs, "gtk_print_operation_set_print_settings")
-	core.PuregoSafeRegister(&xPrintOperationSetShowProgress, libs, "gtk_print_operation_set_show_progress")
-	core.PuregoSafeRegister(&xPrintOperationSetSupportSelection, libs, "gtk_print_operation_set_support_selection")
-	core.PuregoSafeRegister(&xPrintOperationSetTrackPrintStatus, libs, "gtk_print_operation_set_track_print_status")
-	core.PuregoSafeRegister(&xPrintOperationSetUnit, libs, "gtk_print_operation_set_unit")
-	core.PuregoSafeRegister(&xPrintOperationSetUseFullPage, libs, "gtk_print_operation_set_use_full_page")
+	gobject.CheckClassSize("GtkPrintOperationClass", xPrintOperationGLibType, unsafe.Sizeof(PrintOperationClass{}))
+
+	core.PuregoRegisterSince(&xNewPrintOperation, libs, "gtk_print_operation_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPrintOperationCancel, libs, "gtk_print_operation_cancel", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationDrawPageFinish, libs, "gtk_print_operation_draw_page_finish", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetDefaultPageSetup, libs, "gtk_print_operation_get_default_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetEmbedPageSetup, libs, "gtk_print_operation_get_embed_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetError, libs, "gtk_print_operation_get_error", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetHasSelection, libs, "gtk_print_operation_get_has_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetNPagesToPrint, libs, "gtk_print_operation_get_n_pages_to_print", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetPrintSettings, libs, "gtk_print_operation_get_print_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetStatus, libs, "gtk_print_operation_get_status", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetStatusString, libs, "gtk_print_operation_get_status_string", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationGetSupportSelection, libs, "gtk_print_operation_get_support_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationIsFinished, libs, "gtk_print_operation_is_finished", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationRun, libs, "gtk_print_operation_run", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetAllowAsync, libs, "gtk_print_operation_set_allow_async", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetCurrentPage, libs, "gtk_print_operation_set_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetCustomTabLabel, libs, "gtk_print_operation_set_custom_tab_label", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetDefaultPageSetup, libs, "gtk_print_operation_set_default_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetDeferDrawing, libs, "gtk_print_operation_set_defer_drawing", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetEmbedPageSetup, libs, "gtk_print_operation_set_embed_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetExportFilename, libs, "gtk_print_operation_set_export_filename", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetHasSelection, libs, "gtk_print_operation_set_has_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetJobName, libs, "gtk_print_operation_set_job_name", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetNPages, libs, "gtk_print_operation_set_n_pages", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetPrintSettings, libs, "gtk_print_operation_set_print_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetShowProgress, libs, "gtk_print_operation_set_show_progress", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetSupportSelection, libs, "gtk_print_operation_set_support_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetTrackPrintStatus, libs, "gtk_print_operation_set_track_print_status", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetUnit, libs, "gtk_print_operation_set_unit", "GTK", "")
+	core.PuregoRegisterSince(&xPrintOperationSetUseFullPage, libs, "gtk_print_operation_set_use_full_page", "GTK", "")
 
 }
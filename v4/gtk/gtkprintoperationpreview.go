@@ -5,15 +5,15 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 type PrintOperationPreviewIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xReady uintptr
 
@@ -51,7 +51,8 @@ func (x *PrintOperationPreviewIface) OverrideReady(cb func(PrintOperationPreview
 	if cb == nil {
 		x.xReady = 0
 	} else {
-		x.xReady = purego.NewCallback(func(PreviewVarp uintptr, ContextVarp uintptr) {
+		x.xReady = core.NewCallback(func(PreviewVarp uintptr, ContextVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&PrintOperationPreviewBase{Ptr: PreviewVarp}, PrintContextNewFromInternalPtr(ContextVarp))
 		})
 	}
@@ -63,7 +64,7 @@ func (x *PrintOperationPreviewIface) GetReady() func(PrintOperationPreview, *Pri
 		return nil
 	}
 	var rawCallback func(PreviewVarp uintptr, ContextVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xReady)
+	core.RegisterFunc(&rawCallback, x.xReady)
 	return func(PreviewVar PrintOperationPreview, ContextVar *PrintContext) {
 		rawCallback(PreviewVar.GoPointer(), ContextVar.GoPointer())
 	}
@@ -74,7 +75,8 @@ func (x *PrintOperationPreviewIface) OverrideGotPageSize(cb func(PrintOperationP
 	if cb == nil {
 		x.xGotPageSize = 0
 	} else {
-		x.xGotPageSize = purego.NewCallback(func(PreviewVarp uintptr, ContextVarp uintptr, PageSetupVarp uintptr) {
+		x.xGotPageSize = core.NewCallback(func(PreviewVarp uintptr, ContextVarp uintptr, PageSetupVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&PrintOperationPreviewBase{Ptr: PreviewVarp}, PrintContextNewFromInternalPtr(ContextVarp), PageSetupNewFromInternalPtr(PageSetupVarp))
 		})
 	}
@@ -86,7 +88,7 @@ func (x *PrintOperationPreviewIface) GetGotPageSize() func(PrintOperationPreview
 		return nil
 	}
 	var rawCallback func(PreviewVarp uintptr, ContextVarp uintptr, PageSetupVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xGotPageSize)
+	core.RegisterFunc(&rawCallback, x.xGotPageSize)
 	return func(PreviewVar PrintOperationPreview, ContextVar *PrintContext, PageSetupVar *PageSetup) {
 		rawCallback(PreviewVar.GoPointer(), ContextVar.GoPointer(), PageSetupVar.GoPointer())
 	}
@@ -97,7 +99,8 @@ func (x *PrintOperationPreviewIface) OverrideRenderPage(cb func(PrintOperationPr
 	if cb == nil {
 		x.xRenderPage = 0
 	} else {
-		x.xRenderPage = purego.NewCallback(func(PreviewVarp uintptr, PageNrVarp int) {
+		x.xRenderPage = core.NewCallback(func(PreviewVarp uintptr, PageNrVarp int) {
+			defer core.RecoverPanic()
 			cb(&PrintOperationPreviewBase{Ptr: PreviewVarp}, PageNrVarp)
 		})
 	}
@@ -109,7 +112,7 @@ func (x *PrintOperationPreviewIface) GetRenderPage() func(PrintOperationPreview,
 		return nil
 	}
 	var rawCallback func(PreviewVarp uintptr, PageNrVarp int)
-	purego.RegisterFunc(&rawCallback, x.xRenderPage)
+	core.RegisterFunc(&rawCallback, x.xRenderPage)
 	return func(PreviewVar PrintOperationPreview, PageNrVar int) {
 		rawCallback(PreviewVar.GoPointer(), PageNrVar)
 	}
@@ -120,7 +123,8 @@ func (x *PrintOperationPreviewIface) OverrideIsSelected(cb func(PrintOperationPr
 	if cb == nil {
 		x.xIsSelected = 0
 	} else {
-		x.xIsSelected = purego.NewCallback(func(PreviewVarp uintptr, PageNrVarp int) bool {
+		x.xIsSelected = core.NewCallback(func(PreviewVarp uintptr, PageNrVarp int) bool {
+			defer core.RecoverPanic()
 			return cb(&PrintOperationPreviewBase{Ptr: PreviewVarp}, PageNrVarp)
 		})
 	}
@@ -132,7 +136,7 @@ func (x *PrintOperationPreviewIface) GetIsSelected() func(PrintOperationPreview,
 		return nil
 	}
 	var rawCallback func(PreviewVarp uintptr, PageNrVarp int) bool
-	purego.RegisterFunc(&rawCallback, x.xIsSelected)
+	core.RegisterFunc(&rawCallback, x.xIsSelected)
 	return func(PreviewVar PrintOperationPreview, PageNrVar int) bool {
 		return rawCallback(PreviewVar.GoPointer(), PageNrVar)
 	}
@@ -143,7 +147,8 @@ func (x *PrintOperationPreviewIface) OverrideEndPreview(cb func(PrintOperationPr
 	if cb == nil {
 		x.xEndPreview = 0
 	} else {
-		x.xEndPreview = purego.NewCallback(func(PreviewVarp uintptr) {
+		x.xEndPreview = core.NewCallback(func(PreviewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&PrintOperationPreviewBase{Ptr: PreviewVarp})
 		})
 	}
@@ -155,7 +160,7 @@ func (x *PrintOperationPreviewIface) GetEndPreview() func(PrintOperationPreview)
 		return nil
 	}
 	var rawCallback func(PreviewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEndPreview)
+	core.RegisterFunc(&rawCallback, x.xEndPreview)
 	return func(PreviewVar PrintOperationPreview) {
 		rawCallback(PreviewVar.GoPointer())
 	}
@@ -166,7 +171,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -178,7 +184,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -189,7 +195,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -201,7 +208,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -212,7 +219,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -224,7 +232,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -235,7 +243,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -247,7 +256,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -258,7 +267,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved5(cb func()) {
 	if cb == nil {
 		x.xGtkReserved5 = 0
 	} else {
-		x.xGtkReserved5 = purego.NewCallback(func() {
+		x.xGtkReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -270,7 +280,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved5)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -281,7 +291,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved6(cb func()) {
 	if cb == nil {
 		x.xGtkReserved6 = 0
 	} else {
-		x.xGtkReserved6 = purego.NewCallback(func() {
+		x.xGtkReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -293,7 +304,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved6)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -304,7 +315,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved7(cb func()) {
 	if cb == nil {
 		x.xGtkReserved7 = 0
 	} else {
-		x.xGtkReserved7 = purego.NewCallback(func() {
+		x.xGtkReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -316,7 +328,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved7)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -327,7 +339,8 @@ func (x *PrintOperationPreviewIface) OverrideGtkReserved8(cb func()) {
 	if cb == nil {
 		x.xGtkReserved8 = 0
 	} else {
-		x.xGtkReserved8 = purego.NewCallback(func() {
+		x.xGtkReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -339,7 +352,7 @@ func (x *PrintOperationPreviewIface) GetGtkReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved8)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -348,8 +361,8 @@ func (x *PrintOperationPreviewIface) GetGtkReserved8() func() {
 // The interface that is used to implement print preview.
 //
 // A `GtkPrintOperationPreview` object is passed to the
-// [signal@Gtk.PrintOperation::preview] signal by
-// [class@Gtk.PrintOperation].
+// [PrintOperation.ConnectPreviewFunc] signal by
+// [PrintOperation].
 type PrintOperationPreview interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -379,6 +392,58 @@ func (x *PrintOperationPreviewBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementPrintOperationPreview adds the PrintOperationPreview interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - Ready(*PrintContext)
+//   - GotPageSize(*PrintContext, *PageSetup)
+//   - RenderPage(int)
+//   - IsSelected(int) bool
+//   - EndPreview()
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see PrintOperationPreviewIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementPrintOperationPreview[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, PrintOperationPreviewGLibType(), func(iface *PrintOperationPreviewIface) {
+		if _, ok := any(zero).(interface{ Ready(*PrintContext) }); ok {
+			iface.OverrideReady(func(self PrintOperationPreview, arg0 *PrintContext) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ Ready(*PrintContext) })
+				impl.Ready(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GotPageSize(*PrintContext, *PageSetup)
+		}); ok {
+			iface.OverrideGotPageSize(func(self PrintOperationPreview, arg0 *PrintContext, arg1 *PageSetup) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GotPageSize(*PrintContext, *PageSetup)
+				})
+				impl.GotPageSize(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ RenderPage(int) }); ok {
+			iface.OverrideRenderPage(func(self PrintOperationPreview, arg0 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RenderPage(int) })
+				impl.RenderPage(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ IsSelected(int) bool }); ok {
+			iface.OverrideIsSelected(func(self PrintOperationPreview, arg0 int) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsSelected(int) bool })
+				return impl.IsSelected(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ EndPreview() }); ok {
+			iface.OverrideEndPreview(func(self PrintOperationPreview) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ EndPreview() })
+				impl.EndPreview()
+			})
+		}
+	})
+}
+
 // Ends a preview.
 //
 // This function must be called to finish a custom print preview.
@@ -399,8 +464,8 @@ func (x *PrintOperationPreviewBase) IsSelected(PageNrVar int) bool {
 // Renders a page to the preview.
 //
 // This is using the print context that was passed to the
-// [signal@Gtk.PrintOperation::preview] handler together
-// with @preview.
+// [PrintOperation.ConnectPreviewFunc] handler together
+// with preview.
 //
 // A custom print preview should use this function to render
 // the currently selected page.
@@ -422,7 +487,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -431,8 +496,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintOperationPreviewGLibType, libs, "gtk_print_operation_preview_get_type")
 
-	core.PuregoSafeRegister(&XGtkPrintOperationPreviewEndPreview, libs, "gtk_print_operation_preview_end_preview")
-	core.PuregoSafeRegister(&XGtkPrintOperationPreviewIsSelected, libs, "gtk_print_operation_preview_is_selected")
-	core.PuregoSafeRegister(&XGtkPrintOperationPreviewRenderPage, libs, "gtk_print_operation_preview_render_page")
+	gobject.CheckClassSize("GtkPrintOperationPreviewIface", xPrintOperationPreviewGLibType, unsafe.Sizeof(PrintOperationPreviewIface{}))
+
+	core.PuregoRegisterSince(&XGtkPrintOperationPreviewEndPreview, libs, "gtk_print_operation_preview_end_preview", "GTK", "")
+	core.PuregoRegisterSince(&XGtkPrintOperationPreviewIsSelected, libs, "gtk_print_operation_preview_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&XGtkPrintOperationPreviewRenderPage, libs, "gtk_print_operation_preview_render_page", "GTK", "")
 
 }
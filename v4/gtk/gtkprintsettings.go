@@ -5,20 +5,19 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Function called by [method@Gtk.PrintSettings.foreach] on every key/value pair
-// inside a [class@Gtk.PrintSettings].
+// Function called by [PrintSettings.Foreach] on every key/value pair
+// inside a [PrintSettings].
 type PrintSettingsFunc func(string, string, uintptr)
 
 // A range of pages to print.
 //
-// See also [method@Gtk.PrintSettings.set_page_ranges].
+// See also [PrintSettings.SetPageRanges].
 type PageRange struct {
 	_ structs.HostLayout
 
@@ -163,13 +162,13 @@ func NewPrintSettings() *PrintSettings {
 
 var xNewPrintSettingsFromFile func(string, **glib.Error) uintptr
 
-// Reads the print settings from @file_name.
+// Reads the print settings from file_name.
 //
 // Returns a new `GtkPrintSettings` object with the restored settings,
-// or %NULL if an error occurred. If the file could not be loaded then
+// or nil if an error occurred. If the file could not be loaded then
 // error is set to either a `GFileError` or `GKeyFileError`.
 //
-// See [method@Gtk.PrintSettings.to_file].
+// See [PrintSettings.ToFile].
 func NewPrintSettingsFromFile(FileNameVar string) (*PrintSettings, error) {
 	var cls *PrintSettings
 	var cerr *glib.Error
@@ -177,12 +176,15 @@ func NewPrintSettingsFromFile(FileNameVar string) (*PrintSettings, error) {
 	cret := xNewPrintSettingsFromFile(FileNameVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PrintSettings{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -193,7 +195,7 @@ var xNewPrintSettingsFromGvariant func(*glib.Variant) uintptr
 // Deserialize print settings from an a{sv} variant.
 //
 // The variant must be in the format produced by
-// [method@Gtk.PrintSettings.to_gvariant].
+// [PrintSettings.ToGvariant].
 func NewPrintSettingsFromGvariant(VariantVar *glib.Variant) *PrintSettings {
 	var cls *PrintSettings
 
@@ -209,10 +211,10 @@ func NewPrintSettingsFromGvariant(VariantVar *glib.Variant) *PrintSettings {
 
 var xNewPrintSettingsFromKeyFile func(*glib.KeyFile, uintptr, **glib.Error) uintptr
 
-// Reads the print settings from the group @group_name in @key_file.
+// Reads the print settings from the group group_name in key_file.
 //
 // Returns a new `GtkPrintSettings` object with the restored settings,
-// or %NULL if an error occurred. If the file could not be loaded then
+// or nil if an error occurred. If the file could not be loaded then
 // error is set to either `GFileError` or `GKeyFileError`.
 func NewPrintSettingsFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) (*PrintSettings, error) {
 	var cls *PrintSettings
@@ -224,12 +226,15 @@ func NewPrintSettingsFromKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string)
 	cret := xNewPrintSettingsFromKeyFile(KeyFileVar, GroupNameVarPtr, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &PrintSettings{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -253,7 +258,7 @@ func (x *PrintSettings) Copy() *PrintSettings {
 
 var xPrintSettingsForeach func(uintptr, uintptr, uintptr)
 
-// Calls @func for each key-value pair of @settings.
+// Calls func for each key-value pair of settings.
 func (x *PrintSettings) Foreach(FuncVar *PrintSettingsFunc, UserDataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -263,11 +268,12 @@ func (x *PrintSettings) Foreach(FuncVar *PrintSettingsFunc, UserDataVar uintptr)
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(core.GoString(arg0), core.GoString(arg1), arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -277,7 +283,7 @@ func (x *PrintSettings) Foreach(FuncVar *PrintSettingsFunc, UserDataVar uintptr)
 
 var xPrintSettingsGet func(uintptr, string) string
 
-// Looks up the string value associated with @key.
+// Looks up the string value associated with key.
 func (x *PrintSettings) Get(KeyVar string) string {
 
 	cret := xPrintSettingsGet(x.GoPointer(), KeyVar)
@@ -287,10 +293,10 @@ func (x *PrintSettings) Get(KeyVar string) string {
 var xPrintSettingsGetBool func(uintptr, string) bool
 
 // Returns the boolean represented by the value
-// that is associated with @key.
+// that is associated with key.
 //
-// The string “true” represents %TRUE, any other
-// string %FALSE.
+// The string “true” represents true, any other
+// string false.
 func (x *PrintSettings) GetBool(KeyVar string) bool {
 
 	cret := xPrintSettingsGetBool(x.GoPointer(), KeyVar)
@@ -299,7 +305,7 @@ func (x *PrintSettings) GetBool(KeyVar string) bool {
 
 var xPrintSettingsGetCollate func(uintptr) bool
 
-// Gets the value of %GTK_PRINT_SETTINGS_COLLATE.
+// Gets the value of [PRINT_SETTINGS_COLLATE].
 func (x *PrintSettings) GetCollate() bool {
 
 	cret := xPrintSettingsGetCollate(x.GoPointer())
@@ -308,7 +314,7 @@ func (x *PrintSettings) GetCollate() bool {
 
 var xPrintSettingsGetDefaultSource func(uintptr) string
 
-// Gets the value of %GTK_PRINT_SETTINGS_DEFAULT_SOURCE.
+// Gets the value of [PRINT_SETTINGS_DEFAULT_SOURCE].
 func (x *PrintSettings) GetDefaultSource() string {
 
 	cret := xPrintSettingsGetDefaultSource(x.GoPointer())
@@ -317,7 +323,7 @@ func (x *PrintSettings) GetDefaultSource() string {
 
 var xPrintSettingsGetDither func(uintptr) string
 
-// Gets the value of %GTK_PRINT_SETTINGS_DITHER.
+// Gets the value of [PRINT_SETTINGS_DITHER].
 func (x *PrintSettings) GetDither() string {
 
 	cret := xPrintSettingsGetDither(x.GoPointer())
@@ -326,7 +332,7 @@ func (x *PrintSettings) GetDither() string {
 
 var xPrintSettingsGetDouble func(uintptr, string) float64
 
-// Returns the double value associated with @key, or 0.
+// Returns the double value associated with key, or 0.
 func (x *PrintSettings) GetDouble(KeyVar string) float64 {
 
 	cret := xPrintSettingsGetDouble(x.GoPointer(), KeyVar)
@@ -336,10 +342,10 @@ func (x *PrintSettings) GetDouble(KeyVar string) float64 {
 var xPrintSettingsGetDoubleWithDefault func(uintptr, string, float64) float64
 
 // Returns the floating point number represented by
-// the value that is associated with @key, or @default_val
+// the value that is associated with key, or default_val
 // if the value does not represent a floating point number.
 //
-// Floating point numbers are parsed with g_ascii_strtod().
+// Floating point numbers are parsed with [glib.AsciiStrtod].
 func (x *PrintSettings) GetDoubleWithDefault(KeyVar string, DefVar float64) float64 {
 
 	cret := xPrintSettingsGetDoubleWithDefault(x.GoPointer(), KeyVar, DefVar)
@@ -348,7 +354,7 @@ func (x *PrintSettings) GetDoubleWithDefault(KeyVar string, DefVar float64) floa
 
 var xPrintSettingsGetDuplex func(uintptr) PrintDuplex
 
-// Gets the value of %GTK_PRINT_SETTINGS_DUPLEX.
+// Gets the value of [PRINT_SETTINGS_DUPLEX].
 func (x *PrintSettings) GetDuplex() PrintDuplex {
 
 	cret := xPrintSettingsGetDuplex(x.GoPointer())
@@ -357,7 +363,7 @@ func (x *PrintSettings) GetDuplex() PrintDuplex {
 
 var xPrintSettingsGetFinishings func(uintptr) string
 
-// Gets the value of %GTK_PRINT_SETTINGS_FINISHINGS.
+// Gets the value of [PRINT_SETTINGS_FINISHINGS].
 func (x *PrintSettings) GetFinishings() string {
 
 	cret := xPrintSettingsGetFinishings(x.GoPointer())
@@ -366,7 +372,7 @@ func (x *PrintSettings) GetFinishings() string {
 
 var xPrintSettingsGetInt func(uintptr, string) int
 
-// Returns the integer value of @key, or 0.
+// Returns the integer value of key, or 0.
 func (x *PrintSettings) GetInt(KeyVar string) int {
 
 	cret := xPrintSettingsGetInt(x.GoPointer(), KeyVar)
@@ -375,7 +381,7 @@ func (x *PrintSettings) GetInt(KeyVar string) int {
 
 var xPrintSettingsGetIntWithDefault func(uintptr, string, int) int
 
-// Returns the value of @key, interpreted as
+// Returns the value of key, interpreted as
 // an integer, or the default value.
 func (x *PrintSettings) GetIntWithDefault(KeyVar string, DefVar int) int {
 
@@ -385,10 +391,10 @@ func (x *PrintSettings) GetIntWithDefault(KeyVar string, DefVar int) int {
 
 var xPrintSettingsGetLength func(uintptr, string, Unit) float64
 
-// Returns the value associated with @key, interpreted
+// Returns the value associated with key, interpreted
 // as a length.
 //
-// The returned value is converted to @units.
+// The returned value is converted to units.
 func (x *PrintSettings) GetLength(KeyVar string, UnitVar Unit) float64 {
 
 	cret := xPrintSettingsGetLength(x.GoPointer(), KeyVar, UnitVar)
@@ -397,7 +403,7 @@ func (x *PrintSettings) GetLength(KeyVar string, UnitVar Unit) float64 {
 
 var xPrintSettingsGetMediaType func(uintptr) string
 
-// Gets the value of %GTK_PRINT_SETTINGS_MEDIA_TYPE.
+// Gets the value of [PRINT_SETTINGS_MEDIA_TYPE].
 //
 // The set of media types is defined in PWG 5101.1-2002 PWG.
 func (x *PrintSettings) GetMediaType() string {
@@ -408,7 +414,7 @@ func (x *PrintSettings) GetMediaType() string {
 
 var xPrintSettingsGetNCopies func(uintptr) int
 
-// Gets the value of %GTK_PRINT_SETTINGS_N_COPIES.
+// Gets the value of [PRINT_SETTINGS_N_COPIES].
 func (x *PrintSettings) GetNCopies() int {
 
 	cret := xPrintSettingsGetNCopies(x.GoPointer())
@@ -417,7 +423,7 @@ func (x *PrintSettings) GetNCopies() int {
 
 var xPrintSettingsGetNumberUp func(uintptr) int
 
-// Gets the value of %GTK_PRINT_SETTINGS_NUMBER_UP.
+// Gets the value of [PRINT_SETTINGS_NUMBER_UP].
 func (x *PrintSettings) GetNumberUp() int {
 
 	cret := xPrintSettingsGetNumberUp(x.GoPointer())
@@ -426,7 +432,7 @@ func (x *PrintSettings) GetNumberUp() int {
 
 var xPrintSettingsGetNumberUpLayout func(uintptr) NumberUpLayout
 
-// Gets the value of %GTK_PRINT_SETTINGS_NUMBER_UP_LAYOUT.
+// Gets the value of [PRINT_SETTINGS_NUMBER_UP_LAYOUT].
 func (x *PrintSettings) GetNumberUpLayout() NumberUpLayout {
 
 	cret := xPrintSettingsGetNumberUpLayout(x.GoPointer())
@@ -435,7 +441,7 @@ func (x *PrintSettings) GetNumberUpLayout() NumberUpLayout {
 
 var xPrintSettingsGetOrientation func(uintptr) PageOrientation
 
-// Get the value of %GTK_PRINT_SETTINGS_ORIENTATION,
+// Get the value of [PRINT_SETTINGS_ORIENTATION],
 // converted to a `GtkPageOrientation`.
 func (x *PrintSettings) GetOrientation() PageOrientation {
 
@@ -445,7 +451,7 @@ func (x *PrintSettings) GetOrientation() PageOrientation {
 
 var xPrintSettingsGetOutputBin func(uintptr) string
 
-// Gets the value of %GTK_PRINT_SETTINGS_OUTPUT_BIN.
+// Gets the value of [PRINT_SETTINGS_OUTPUT_BIN].
 func (x *PrintSettings) GetOutputBin() string {
 
 	cret := xPrintSettingsGetOutputBin(x.GoPointer())
@@ -454,7 +460,7 @@ func (x *PrintSettings) GetOutputBin() string {
 
 var xPrintSettingsGetPageRanges func(uintptr, *int) uintptr
 
-// Gets the value of %GTK_PRINT_SETTINGS_PAGE_RANGES.
+// Gets the value of [PRINT_SETTINGS_PAGE_RANGES].
 func (x *PrintSettings) GetPageRanges(NumRangesVar *int) uintptr {
 
 	cret := xPrintSettingsGetPageRanges(x.GoPointer(), NumRangesVar)
@@ -463,7 +469,7 @@ func (x *PrintSettings) GetPageRanges(NumRangesVar *int) uintptr {
 
 var xPrintSettingsGetPageSet func(uintptr) PageSet
 
-// Gets the value of %GTK_PRINT_SETTINGS_PAGE_SET.
+// Gets the value of [PRINT_SETTINGS_PAGE_SET].
 func (x *PrintSettings) GetPageSet() PageSet {
 
 	cret := xPrintSettingsGetPageSet(x.GoPointer())
@@ -472,8 +478,8 @@ func (x *PrintSettings) GetPageSet() PageSet {
 
 var xPrintSettingsGetPaperHeight func(uintptr, Unit) float64
 
-// Gets the value of %GTK_PRINT_SETTINGS_PAPER_HEIGHT,
-// converted to @unit.
+// Gets the value of [PRINT_SETTINGS_PAPER_HEIGHT],
+// converted to unit.
 func (x *PrintSettings) GetPaperHeight(UnitVar Unit) float64 {
 
 	cret := xPrintSettingsGetPaperHeight(x.GoPointer(), UnitVar)
@@ -482,7 +488,7 @@ func (x *PrintSettings) GetPaperHeight(UnitVar Unit) float64 {
 
 var xPrintSettingsGetPaperSize func(uintptr) *PaperSize
 
-// Gets the value of %GTK_PRINT_SETTINGS_PAPER_FORMAT,
+// Gets the value of [PRINT_SETTINGS_PAPER_FORMAT],
 // converted to a `GtkPaperSize`.
 func (x *PrintSettings) GetPaperSize() *PaperSize {
 
@@ -492,8 +498,8 @@ func (x *PrintSettings) GetPaperSize() *PaperSize {
 
 var xPrintSettingsGetPaperWidth func(uintptr, Unit) float64
 
-// Gets the value of %GTK_PRINT_SETTINGS_PAPER_WIDTH,
-// converted to @unit.
+// Gets the value of [PRINT_SETTINGS_PAPER_WIDTH],
+// converted to unit.
 func (x *PrintSettings) GetPaperWidth(UnitVar Unit) float64 {
 
 	cret := xPrintSettingsGetPaperWidth(x.GoPointer(), UnitVar)
@@ -502,7 +508,7 @@ func (x *PrintSettings) GetPaperWidth(UnitVar Unit) float64 {
 
 var xPrintSettingsGetPrintPages func(uintptr) PrintPages
 
-// Gets the value of %GTK_PRINT_SETTINGS_PRINT_PAGES.
+// Gets the value of [PRINT_SETTINGS_PRINT_PAGES].
 func (x *PrintSettings) GetPrintPages() PrintPages {
 
 	cret := xPrintSettingsGetPrintPages(x.GoPointer())
@@ -512,7 +518,7 @@ func (x *PrintSettings) GetPrintPages() PrintPages {
 var xPrintSettingsGetPrinter func(uintptr) string
 
 // Convenience function to obtain the value of
-// %GTK_PRINT_SETTINGS_PRINTER.
+// [PRINT_SETTINGS_PRINTER].
 func (x *PrintSettings) GetPrinter() string {
 
 	cret := xPrintSettingsGetPrinter(x.GoPointer())
@@ -521,7 +527,7 @@ func (x *PrintSettings) GetPrinter() string {
 
 var xPrintSettingsGetPrinterLpi func(uintptr) float64
 
-// Gets the value of %GTK_PRINT_SETTINGS_PRINTER_LPI.
+// Gets the value of [PRINT_SETTINGS_PRINTER_LPI].
 func (x *PrintSettings) GetPrinterLpi() float64 {
 
 	cret := xPrintSettingsGetPrinterLpi(x.GoPointer())
@@ -530,7 +536,7 @@ func (x *PrintSettings) GetPrinterLpi() float64 {
 
 var xPrintSettingsGetQuality func(uintptr) PrintQuality
 
-// Gets the value of %GTK_PRINT_SETTINGS_QUALITY.
+// Gets the value of [PRINT_SETTINGS_QUALITY].
 func (x *PrintSettings) GetQuality() PrintQuality {
 
 	cret := xPrintSettingsGetQuality(x.GoPointer())
@@ -539,7 +545,7 @@ func (x *PrintSettings) GetQuality() PrintQuality {
 
 var xPrintSettingsGetResolution func(uintptr) int
 
-// Gets the value of %GTK_PRINT_SETTINGS_RESOLUTION.
+// Gets the value of [PRINT_SETTINGS_RESOLUTION].
 func (x *PrintSettings) GetResolution() int {
 
 	cret := xPrintSettingsGetResolution(x.GoPointer())
@@ -548,7 +554,7 @@ func (x *PrintSettings) GetResolution() int {
 
 var xPrintSettingsGetResolutionX func(uintptr) int
 
-// Gets the value of %GTK_PRINT_SETTINGS_RESOLUTION_X.
+// Gets the value of [PRINT_SETTINGS_RESOLUTION_X].
 func (x *PrintSettings) GetResolutionX() int {
 
 	cret := xPrintSettingsGetResolutionX(x.GoPointer())
@@ -557,7 +563,7 @@ func (x *PrintSettings) GetResolutionX() int {
 
 var xPrintSettingsGetResolutionY func(uintptr) int
 
-// Gets the value of %GTK_PRINT_SETTINGS_RESOLUTION_Y.
+// Gets the value of [PRINT_SETTINGS_RESOLUTION_Y].
 func (x *PrintSettings) GetResolutionY() int {
 
 	cret := xPrintSettingsGetResolutionY(x.GoPointer())
@@ -566,7 +572,7 @@ func (x *PrintSettings) GetResolutionY() int {
 
 var xPrintSettingsGetReverse func(uintptr) bool
 
-// Gets the value of %GTK_PRINT_SETTINGS_REVERSE.
+// Gets the value of [PRINT_SETTINGS_REVERSE].
 func (x *PrintSettings) GetReverse() bool {
 
 	cret := xPrintSettingsGetReverse(x.GoPointer())
@@ -575,7 +581,7 @@ func (x *PrintSettings) GetReverse() bool {
 
 var xPrintSettingsGetScale func(uintptr) float64
 
-// Gets the value of %GTK_PRINT_SETTINGS_SCALE.
+// Gets the value of [PRINT_SETTINGS_SCALE].
 func (x *PrintSettings) GetScale() float64 {
 
 	cret := xPrintSettingsGetScale(x.GoPointer())
@@ -584,7 +590,7 @@ func (x *PrintSettings) GetScale() float64 {
 
 var xPrintSettingsGetUseColor func(uintptr) bool
 
-// Gets the value of %GTK_PRINT_SETTINGS_USE_COLOR.
+// Gets the value of [PRINT_SETTINGS_USE_COLOR].
 func (x *PrintSettings) GetUseColor() bool {
 
 	cret := xPrintSettingsGetUseColor(x.GoPointer())
@@ -593,7 +599,7 @@ func (x *PrintSettings) GetUseColor() bool {
 
 var xPrintSettingsHasKey func(uintptr, string) bool
 
-// Returns %TRUE, if a value is associated with @key.
+// Returns true, if a value is associated with key.
 func (x *PrintSettings) HasKey(KeyVar string) bool {
 
 	cret := xPrintSettingsHasKey(x.GoPointer(), KeyVar)
@@ -602,18 +608,18 @@ func (x *PrintSettings) HasKey(KeyVar string) bool {
 
 var xPrintSettingsLoadFile func(uintptr, string, **glib.Error) bool
 
-// Reads the print settings from @file_name.
+// Reads the print settings from file_name.
 //
 // If the file could not be loaded then error is set to either
 // a `GFileError` or `GKeyFileError`.
 //
-// See [method@Gtk.PrintSettings.to_file].
+// See [PrintSettings.ToFile].
 func (x *PrintSettings) LoadFile(FileNameVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xPrintSettingsLoadFile(x.GoPointer(), FileNameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -621,7 +627,7 @@ func (x *PrintSettings) LoadFile(FileNameVar string) (bool, error) {
 
 var xPrintSettingsLoadKeyFile func(uintptr, *glib.KeyFile, uintptr, **glib.Error) bool
 
-// Reads the print settings from the group @group_name in @key_file.
+// Reads the print settings from the group group_name in key_file.
 //
 // If the file could not be loaded then error is set to either a
 // `GFileError` or `GKeyFileError`.
@@ -633,7 +639,7 @@ func (x *PrintSettings) LoadKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *stri
 
 	cret := xPrintSettingsLoadKeyFile(x.GoPointer(), KeyFileVar, GroupNameVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -641,7 +647,7 @@ func (x *PrintSettings) LoadKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *stri
 
 var xPrintSettingsSet func(uintptr, string, uintptr)
 
-// Associates @value with @key.
+// Associates value with key.
 func (x *PrintSettings) Set(KeyVar string, ValueVar *string) {
 
 	ValueVarPtr := core.GStrdupNullable(ValueVar)
@@ -653,7 +659,7 @@ func (x *PrintSettings) Set(KeyVar string, ValueVar *string) {
 
 var xPrintSettingsSetBool func(uintptr, string, bool)
 
-// Sets @key to a boolean value.
+// Sets key to a boolean value.
 func (x *PrintSettings) SetBool(KeyVar string, ValueVar bool) {
 
 	xPrintSettingsSetBool(x.GoPointer(), KeyVar, ValueVar)
@@ -662,7 +668,7 @@ func (x *PrintSettings) SetBool(KeyVar string, ValueVar bool) {
 
 var xPrintSettingsSetCollate func(uintptr, bool)
 
-// Sets the value of %GTK_PRINT_SETTINGS_COLLATE.
+// Sets the value of [PRINT_SETTINGS_COLLATE].
 func (x *PrintSettings) SetCollate(CollateVar bool) {
 
 	xPrintSettingsSetCollate(x.GoPointer(), CollateVar)
@@ -671,7 +677,7 @@ func (x *PrintSettings) SetCollate(CollateVar bool) {
 
 var xPrintSettingsSetDefaultSource func(uintptr, string)
 
-// Sets the value of %GTK_PRINT_SETTINGS_DEFAULT_SOURCE.
+// Sets the value of [PRINT_SETTINGS_DEFAULT_SOURCE].
 func (x *PrintSettings) SetDefaultSource(DefaultSourceVar string) {
 
 	xPrintSettingsSetDefaultSource(x.GoPointer(), DefaultSourceVar)
@@ -680,7 +686,7 @@ func (x *PrintSettings) SetDefaultSource(DefaultSourceVar string) {
 
 var xPrintSettingsSetDither func(uintptr, string)
 
-// Sets the value of %GTK_PRINT_SETTINGS_DITHER.
+// Sets the value of [PRINT_SETTINGS_DITHER].
 func (x *PrintSettings) SetDither(DitherVar string) {
 
 	xPrintSettingsSetDither(x.GoPointer(), DitherVar)
@@ -689,7 +695,7 @@ func (x *PrintSettings) SetDither(DitherVar string) {
 
 var xPrintSettingsSetDouble func(uintptr, string, float64)
 
-// Sets @key to a double value.
+// Sets key to a double value.
 func (x *PrintSettings) SetDouble(KeyVar string, ValueVar float64) {
 
 	xPrintSettingsSetDouble(x.GoPointer(), KeyVar, ValueVar)
@@ -698,7 +704,7 @@ func (x *PrintSettings) SetDouble(KeyVar string, ValueVar float64) {
 
 var xPrintSettingsSetDuplex func(uintptr, PrintDuplex)
 
-// Sets the value of %GTK_PRINT_SETTINGS_DUPLEX.
+// Sets the value of [PRINT_SETTINGS_DUPLEX].
 func (x *PrintSettings) SetDuplex(DuplexVar PrintDuplex) {
 
 	xPrintSettingsSetDuplex(x.GoPointer(), DuplexVar)
@@ -707,7 +713,7 @@ func (x *PrintSettings) SetDuplex(DuplexVar PrintDuplex) {
 
 var xPrintSettingsSetFinishings func(uintptr, string)
 
-// Sets the value of %GTK_PRINT_SETTINGS_FINISHINGS.
+// Sets the value of [PRINT_SETTINGS_FINISHINGS].
 func (x *PrintSettings) SetFinishings(FinishingsVar string) {
 
 	xPrintSettingsSetFinishings(x.GoPointer(), FinishingsVar)
@@ -716,7 +722,7 @@ func (x *PrintSettings) SetFinishings(FinishingsVar string) {
 
 var xPrintSettingsSetInt func(uintptr, string, int)
 
-// Sets @key to an integer value.
+// Sets key to an integer value.
 func (x *PrintSettings) SetInt(KeyVar string, ValueVar int) {
 
 	xPrintSettingsSetInt(x.GoPointer(), KeyVar, ValueVar)
@@ -725,7 +731,7 @@ func (x *PrintSettings) SetInt(KeyVar string, ValueVar int) {
 
 var xPrintSettingsSetLength func(uintptr, string, float64, Unit)
 
-// Associates a length in units of @unit with @key.
+// Associates a length in units of unit with key.
 func (x *PrintSettings) SetLength(KeyVar string, ValueVar float64, UnitVar Unit) {
 
 	xPrintSettingsSetLength(x.GoPointer(), KeyVar, ValueVar, UnitVar)
@@ -734,7 +740,7 @@ func (x *PrintSettings) SetLength(KeyVar string, ValueVar float64, UnitVar Unit)
 
 var xPrintSettingsSetMediaType func(uintptr, string)
 
-// Sets the value of %GTK_PRINT_SETTINGS_MEDIA_TYPE.
+// Sets the value of [PRINT_SETTINGS_MEDIA_TYPE].
 //
 // The set of media types is defined in PWG 5101.1-2002 PWG.
 func (x *PrintSettings) SetMediaType(MediaTypeVar string) {
@@ -745,7 +751,7 @@ func (x *PrintSettings) SetMediaType(MediaTypeVar string) {
 
 var xPrintSettingsSetNCopies func(uintptr, int)
 
-// Sets the value of %GTK_PRINT_SETTINGS_N_COPIES.
+// Sets the value of [PRINT_SETTINGS_N_COPIES].
 func (x *PrintSettings) SetNCopies(NumCopiesVar int) {
 
 	xPrintSettingsSetNCopies(x.GoPointer(), NumCopiesVar)
@@ -754,7 +760,7 @@ func (x *PrintSettings) SetNCopies(NumCopiesVar int) {
 
 var xPrintSettingsSetNumberUp func(uintptr, int)
 
-// Sets the value of %GTK_PRINT_SETTINGS_NUMBER_UP.
+// Sets the value of [PRINT_SETTINGS_NUMBER_UP].
 func (x *PrintSettings) SetNumberUp(NumberUpVar int) {
 
 	xPrintSettingsSetNumberUp(x.GoPointer(), NumberUpVar)
@@ -763,7 +769,7 @@ func (x *PrintSettings) SetNumberUp(NumberUpVar int) {
 
 var xPrintSettingsSetNumberUpLayout func(uintptr, NumberUpLayout)
 
-// Sets the value of %GTK_PRINT_SETTINGS_NUMBER_UP_LAYOUT.
+// Sets the value of [PRINT_SETTINGS_NUMBER_UP_LAYOUT].
 func (x *PrintSettings) SetNumberUpLayout(NumberUpLayoutVar NumberUpLayout) {
 
 	xPrintSettingsSetNumberUpLayout(x.GoPointer(), NumberUpLayoutVar)
@@ -772,7 +778,7 @@ func (x *PrintSettings) SetNumberUpLayout(NumberUpLayoutVar NumberUpLayout) {
 
 var xPrintSettingsSetOrientation func(uintptr, PageOrientation)
 
-// Sets the value of %GTK_PRINT_SETTINGS_ORIENTATION.
+// Sets the value of [PRINT_SETTINGS_ORIENTATION].
 func (x *PrintSettings) SetOrientation(OrientationVar PageOrientation) {
 
 	xPrintSettingsSetOrientation(x.GoPointer(), OrientationVar)
@@ -781,7 +787,7 @@ func (x *PrintSettings) SetOrientation(OrientationVar PageOrientation) {
 
 var xPrintSettingsSetOutputBin func(uintptr, string)
 
-// Sets the value of %GTK_PRINT_SETTINGS_OUTPUT_BIN.
+// Sets the value of [PRINT_SETTINGS_OUTPUT_BIN].
 func (x *PrintSettings) SetOutputBin(OutputBinVar string) {
 
 	xPrintSettingsSetOutputBin(x.GoPointer(), OutputBinVar)
@@ -790,7 +796,7 @@ func (x *PrintSettings) SetOutputBin(OutputBinVar string) {
 
 var xPrintSettingsSetPageRanges func(uintptr, []PageRange, int)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PAGE_RANGES.
+// Sets the value of [PRINT_SETTINGS_PAGE_RANGES].
 func (x *PrintSettings) SetPageRanges(PageRangesVar []PageRange, NumRangesVar int) {
 
 	xPrintSettingsSetPageRanges(x.GoPointer(), PageRangesVar, NumRangesVar)
@@ -799,7 +805,7 @@ func (x *PrintSettings) SetPageRanges(PageRangesVar []PageRange, NumRangesVar in
 
 var xPrintSettingsSetPageSet func(uintptr, PageSet)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PAGE_SET.
+// Sets the value of [PRINT_SETTINGS_PAGE_SET].
 func (x *PrintSettings) SetPageSet(PageSetVar PageSet) {
 
 	xPrintSettingsSetPageSet(x.GoPointer(), PageSetVar)
@@ -808,7 +814,7 @@ func (x *PrintSettings) SetPageSet(PageSetVar PageSet) {
 
 var xPrintSettingsSetPaperHeight func(uintptr, float64, Unit)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PAPER_HEIGHT.
+// Sets the value of [PRINT_SETTINGS_PAPER_HEIGHT].
 func (x *PrintSettings) SetPaperHeight(HeightVar float64, UnitVar Unit) {
 
 	xPrintSettingsSetPaperHeight(x.GoPointer(), HeightVar, UnitVar)
@@ -817,9 +823,9 @@ func (x *PrintSettings) SetPaperHeight(HeightVar float64, UnitVar Unit) {
 
 var xPrintSettingsSetPaperSize func(uintptr, *PaperSize)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PAPER_FORMAT,
-// %GTK_PRINT_SETTINGS_PAPER_WIDTH and
-// %GTK_PRINT_SETTINGS_PAPER_HEIGHT.
+// Sets the value of [PRINT_SETTINGS_PAPER_FORMAT],
+// [PRINT_SETTINGS_PAPER_WIDTH] and
+// [PRINT_SETTINGS_PAPER_HEIGHT].
 func (x *PrintSettings) SetPaperSize(PaperSizeVar *PaperSize) {
 
 	xPrintSettingsSetPaperSize(x.GoPointer(), PaperSizeVar)
@@ -828,7 +834,7 @@ func (x *PrintSettings) SetPaperSize(PaperSizeVar *PaperSize) {
 
 var xPrintSettingsSetPaperWidth func(uintptr, float64, Unit)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PAPER_WIDTH.
+// Sets the value of [PRINT_SETTINGS_PAPER_WIDTH].
 func (x *PrintSettings) SetPaperWidth(WidthVar float64, UnitVar Unit) {
 
 	xPrintSettingsSetPaperWidth(x.GoPointer(), WidthVar, UnitVar)
@@ -837,7 +843,7 @@ func (x *PrintSettings) SetPaperWidth(WidthVar float64, UnitVar Unit) {
 
 var xPrintSettingsSetPrintPages func(uintptr, PrintPages)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PRINT_PAGES.
+// Sets the value of [PRINT_SETTINGS_PRINT_PAGES].
 func (x *PrintSettings) SetPrintPages(PagesVar PrintPages) {
 
 	xPrintSettingsSetPrintPages(x.GoPointer(), PagesVar)
@@ -846,8 +852,8 @@ func (x *PrintSettings) SetPrintPages(PagesVar PrintPages) {
 
 var xPrintSettingsSetPrinter func(uintptr, string)
 
-// Convenience function to set %GTK_PRINT_SETTINGS_PRINTER
-// to @printer.
+// Convenience function to set [PRINT_SETTINGS_PRINTER]
+// to printer.
 func (x *PrintSettings) SetPrinter(PrinterVar string) {
 
 	xPrintSettingsSetPrinter(x.GoPointer(), PrinterVar)
@@ -856,7 +862,7 @@ func (x *PrintSettings) SetPrinter(PrinterVar string) {
 
 var xPrintSettingsSetPrinterLpi func(uintptr, float64)
 
-// Sets the value of %GTK_PRINT_SETTINGS_PRINTER_LPI.
+// Sets the value of [PRINT_SETTINGS_PRINTER_LPI].
 func (x *PrintSettings) SetPrinterLpi(LpiVar float64) {
 
 	xPrintSettingsSetPrinterLpi(x.GoPointer(), LpiVar)
@@ -865,7 +871,7 @@ func (x *PrintSettings) SetPrinterLpi(LpiVar float64) {
 
 var xPrintSettingsSetQuality func(uintptr, PrintQuality)
 
-// Sets the value of %GTK_PRINT_SETTINGS_QUALITY.
+// Sets the value of [PRINT_SETTINGS_QUALITY].
 func (x *PrintSettings) SetQuality(QualityVar PrintQuality) {
 
 	xPrintSettingsSetQuality(x.GoPointer(), QualityVar)
@@ -874,9 +880,9 @@ func (x *PrintSettings) SetQuality(QualityVar PrintQuality) {
 
 var xPrintSettingsSetResolution func(uintptr, int)
 
-// Sets the values of %GTK_PRINT_SETTINGS_RESOLUTION,
-// %GTK_PRINT_SETTINGS_RESOLUTION_X and
-// %GTK_PRINT_SETTINGS_RESOLUTION_Y.
+// Sets the values of [PRINT_SETTINGS_RESOLUTION],
+// [PRINT_SETTINGS_RESOLUTION_X] and
+// [PRINT_SETTINGS_RESOLUTION_Y].
 func (x *PrintSettings) SetResolution(ResolutionVar int) {
 
 	xPrintSettingsSetResolution(x.GoPointer(), ResolutionVar)
@@ -885,9 +891,9 @@ func (x *PrintSettings) SetResolution(ResolutionVar int) {
 
 var xPrintSettingsSetResolutionXy func(uintptr, int, int)
 
-// Sets the values of %GTK_PRINT_SETTINGS_RESOLUTION,
-// %GTK_PRINT_SETTINGS_RESOLUTION_X and
-// %GTK_PRINT_SETTINGS_RESOLUTION_Y.
+// Sets the values of [PRINT_SETTINGS_RESOLUTION],
+// [PRINT_SETTINGS_RESOLUTION_X] and
+// [PRINT_SETTINGS_RESOLUTION_Y].
 func (x *PrintSettings) SetResolutionXy(ResolutionXVar int, ResolutionYVar int) {
 
 	xPrintSettingsSetResolutionXy(x.GoPointer(), ResolutionXVar, ResolutionYVar)
@@ -896,7 +902,7 @@ func (x *PrintSettings) SetResolutionXy(ResolutionXVar int, ResolutionYVar int)
 
 var xPrintSettingsSetReverse func(uintptr, bool)
 
-// Sets the value of %GTK_PRINT_SETTINGS_REVERSE.
+// Sets the value of [PRINT_SETTINGS_REVERSE].
 func (x *PrintSettings) SetReverse(ReverseVar bool) {
 
 	xPrintSettingsSetReverse(x.GoPointer(), ReverseVar)
@@ -905,7 +911,7 @@ func (x *PrintSettings) SetReverse(ReverseVar bool) {
 
 var xPrintSettingsSetScale func(uintptr, float64)
 
-// Sets the value of %GTK_PRINT_SETTINGS_SCALE.
+// Sets the value of [PRINT_SETTINGS_SCALE].
 func (x *PrintSettings) SetScale(ScaleVar float64) {
 
 	xPrintSettingsSetScale(x.GoPointer(), ScaleVar)
@@ -914,7 +920,7 @@ func (x *PrintSettings) SetScale(ScaleVar float64) {
 
 var xPrintSettingsSetUseColor func(uintptr, bool)
 
-// Sets the value of %GTK_PRINT_SETTINGS_USE_COLOR.
+// Sets the value of [PRINT_SETTINGS_USE_COLOR].
 func (x *PrintSettings) SetUseColor(UseColorVar bool) {
 
 	xPrintSettingsSetUseColor(x.GoPointer(), UseColorVar)
@@ -923,7 +929,7 @@ func (x *PrintSettings) SetUseColor(UseColorVar bool) {
 
 var xPrintSettingsToFile func(uintptr, string, **glib.Error) bool
 
-// This function saves the print settings from @settings to @file_name.
+// This function saves the print settings from settings to file_name.
 //
 // If the file could not be written then error is set to either a
 // `GFileError` or `GKeyFileError`.
@@ -932,7 +938,7 @@ func (x *PrintSettings) ToFile(FileNameVar string) (bool, error) {
 
 	cret := xPrintSettingsToFile(x.GoPointer(), FileNameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -949,7 +955,7 @@ func (x *PrintSettings) ToGvariant() *glib.Variant {
 
 var xPrintSettingsToKeyFile func(uintptr, *glib.KeyFile, uintptr)
 
-// This function adds the print settings from @settings to @key_file.
+// This function adds the print settings from settings to key_file.
 func (x *PrintSettings) ToKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string) {
 
 	GroupNameVarPtr := core.GStrdupNullable(GroupNameVar)
@@ -961,9 +967,9 @@ func (x *PrintSettings) ToKeyFile(KeyFileVar *glib.KeyFile, GroupNameVar *string
 
 var xPrintSettingsUnset func(uintptr, string)
 
-// Removes any value associated with @key.
+// Removes any value associated with key.
 //
-// This has the same effect as setting the value to %NULL.
+// This has the same effect as setting the value to nil.
 func (x *PrintSettings) Unset(KeyVar string) {
 
 	xPrintSettingsUnset(x.GoPointer(), KeyVar)
@@ -986,7 +992,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -995,82 +1001,82 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintSettingsGLibType, libs, "gtk_print_settings_get_type")
 
-	core.PuregoSafeRegister(&xNewPrintSettings, libs, "gtk_print_settings_new")
-	core.PuregoSafeRegister(&xNewPrintSettingsFromFile, libs, "gtk_print_settings_new_from_file")
-	core.PuregoSafeRegister(&xNewPrintSettingsFromGvariant, libs, "gtk_print_settings_new_from_gvariant")
-	core.PuregoSafeRegister(&xNewPrintSettingsFromKeyFile, libs, "gtk_print_settings_new_from_key_file")
-
-	core.PuregoSafeRegister(&xPrintSettingsCopy, libs, "gtk_print_settings_copy")
-	core.PuregoSafeRegister(&xPrintSettingsForeach, libs, "gtk_print_settings_foreach")
-	core.PuregoSafeRegister(&xPrintSettingsGet, libs, "gtk_print_settings_get")
-	core.PuregoSafeRegister(&xPrintSettingsGetBool, libs, "gtk_print_settings_get_bool")
-	core.PuregoSafeRegister(&xPrintSettingsGetCollate, libs, "gtk_print_settings_get_collate")
-	core.PuregoSafeRegister(&xPrintSettingsGetDefaultSource, libs, "gtk_print_settings_get_default_source")
-	core.PuregoSafeRegister(&xPrintSettingsGetDither, libs, "gtk_print_settings_get_dither")
-	core.PuregoSafeRegister(&xPrintSettingsGetDouble, libs, "gtk_print_settings_get_double")
-	core.PuregoSafeRegister(&xPrintSettingsGetDoubleWithDefault, libs, "gtk_print_settings_get_double_with_default")
-	core.PuregoSafeRegister(&xPrintSettingsGetDuplex, libs, "gtk_print_settings_get_duplex")
-	core.PuregoSafeRegister(&xPrintSettingsGetFinishings, libs, "gtk_print_settings_get_finishings")
-	core.PuregoSafeRegister(&xPrintSettingsGetInt, libs, "gtk_print_settings_get_int")
-	core.PuregoSafeRegister(&xPrintSettingsGetIntWithDefault, libs, "gtk_print_settings_get_int_with_default")
-	core.PuregoSafeRegister(&xPrintSettingsGetLength, libs, "gtk_print_settings_get_length")
-	core.PuregoSafeRegister(&xPrintSettingsGetMediaType, libs, "gtk_print_settings_get_media_type")
-	core.PuregoSafeRegister(&xPrintSettingsGetNCopies, libs, "gtk_print_settings_get_n_copies")
-	core.PuregoSafeRegister(&xPrintSettingsGetNumberUp, libs, "gtk_print_settings_get_number_up")
-	core.PuregoSafeRegister(&xPrintSettingsGetNumberUpLayout, libs, "gtk_print_settings_get_number_up_layout")
-	core.PuregoSafeRegister(&xPrintSettingsGetOrientation, libs, "gtk_print_settings_get_orientation")
-	core.PuregoSafeRegister(&xPrintSettingsGetOutputBin, libs, "gtk_print_settings_get_output_bin")
-	core.PuregoSafeRegister(&xPrintSettingsGetPageRanges, libs, "gtk_print_settings_get_page_ranges")
-	core.PuregoSafeRegister(&xPrintSettingsGetPageSet, libs, "gtk_print_settings_get_page_set")
-	core.PuregoSafeRegister(&xPrintSettingsGetPaperHeight, libs, "gtk_print_settings_get_paper_height")
-	core.PuregoSafeRegister(&xPrintSettingsGetPaperSize, libs, "gtk_print_settings_get_paper_size")
-	core.PuregoSafeRegister(&xPrintSettingsGetPaperWidth, libs, "gtk_print_settings_get_paper_width")
-	core.PuregoSafeRegister(&xPrintSettingsGetPrintPages, libs, "gtk_print_settings_get_print_pages")
-	core.PuregoSafeRegister(&xPrintSettingsGetPrinter, libs, "gtk_print_settings_get_printer")
-	core.PuregoSafeRegister(&xPrintSettingsGetPrinterLpi, libs, "gtk_print_settings_get_printer_lpi")
-	core.PuregoSafeRegister(&xPrintSettingsGetQuality, libs, "gtk_print_settings_get_quality")
-	core.PuregoSafeRegister(&xPrintSettingsGetResolution, libs, "gtk_print_settings_get_resolution")
-	core.PuregoSafeRegister(&xPrintSettingsGetResolutionX, libs, "gtk_print_settings_get_resolution_x")
-	core.PuregoSafeRegister(&xPrintSettingsGetResolutionY, libs, "gtk_print_settings_get_resolution_y")
-	core.PuregoSafeRegister(&xPrintSettingsGetReverse, libs, "gtk_print_settings_get_reverse")
-	core.PuregoSafeRegister(&xPrintSettingsGetScale, libs, "gtk_print_settings_get_scale")
-	core.PuregoSafeRegister(&xPrintSettingsGetUseColor, libs, "gtk_print_settings_get_use_color")
-	core.PuregoSafeRegister(&xPrintSettingsHasKey, libs, "gtk_print_settings_has_key")
-	core.PuregoSafeRegister(&xPrintSettingsLoadFile, libs, "gtk_print_settings_load_file")
-	core.PuregoSafeRegister(&xPrintSettingsLoadKeyFile, libs, "gtk_print_settings_load_key_file")
-	core.PuregoSafeRegister(&xPrintSettingsSet, libs, "gtk_print_settings_set")
-	core.PuregoSafeRegister(&xPrintSettingsSetBool, libs, "gtk_print_settings_set_bool")
-	core.PuregoSafeRegister(&xPrintSettingsSetCollate, libs, "gtk_print_settings_set_collate")
-	core.PuregoSafeRegister(&xPrintSettingsSetDefaultSource, libs, "gtk_print_settings_set_default_source")
-	core.PuregoSafeRegister(&xPrintSettingsSetDither, libs, "gtk_print_settings_set_dither")
-	core.PuregoSafeRegister(&xPrintSettingsSetDouble, libs, "gtk_print_settings_set_double")
-	core.PuregoSafeRegister(&xPrintSettingsSetDuplex, libs, "gtk_print_settings_set_duplex")
-	core.PuregoSafeRegister(&xPrintSettingsSetFinishings, libs, "gtk_print_settings_set_finishings")
-	core.PuregoSafeRegister(&xPrintSettingsSetInt, libs, "gtk_print_settings_set_int")
-	core.PuregoSafeRegister(&xPrintSettingsSetLength, libs, "gtk_print_settings_set_length")
-	core.PuregoSafeRegister(&xPrintSettingsSetMediaType, libs, "gtk_print_settings_set_media_type")
-	core.PuregoSafeRegister(&xPrintSettingsSetNCopies, libs, "gtk_print_settings_set_n_copies")
-	core.PuregoSafeRegister(&xPrintSettingsSetNumberUp, libs, "gtk_print_settings_set_number_up")
-	core.PuregoSafeRegister(&xPrintSettingsSetNumberUpLayout, libs, "gtk_print_settings_set_number_up_layout")
-	core.PuregoSafeRegister(&xPrintSettingsSetOrientation, libs, "gtk_print_settings_set_orientation")
-	core.PuregoSafeRegister(&xPrintSettingsSetOutputBin, libs, "gtk_print_settings_set_output_bin")
-	core.PuregoSafeRegister(&xPrintSettingsSetPageRanges, libs, "gtk_print_settings_set_page_ranges")
-	core.PuregoSafeRegister(&xPrintSettingsSetPageSet, libs, "gtk_print_settings_set_page_set")
-	core.PuregoSafeRegister(&xPrintSettingsSetPaperHeight, libs, "gtk_print_settings_set_paper_height")
-	core.PuregoSafeRegister(&xPrintSettingsSetPaperSize, libs, "gtk_print_settings_set_paper_size")
-	core.PuregoSafeRegister(&xPrintSettingsSetPaperWidth, libs, "gtk_print_settings_set_paper_width")
-	core.PuregoSafeRegister(&xPrintSettingsSetPrintPages, libs, "gtk_print_settings_set_print_pages")
-	core.PuregoSafeRegister(&xPrintSettingsSetPrinter, libs, "gtk_print_settings_set_printer")
-	core.PuregoSafeRegister(&xPrintSettingsSetPrinterLpi, libs, "gtk_print_settings_set_printer_lpi")
-	core.PuregoSafeRegister(&xPrintSettingsSetQuality, libs, "gtk_print_settings_set_quality")
-	core.PuregoSafeRegister(&xPrintSettingsSetResolution, libs, "gtk_print_settings_set_resolution")
-	core.PuregoSafeRegister(&xPrintSettingsSetResolutionXy, libs, "gtk_print_settings_set_resolution_xy")
-	core.PuregoSafeRegister(&xPrintSettingsSetReverse, libs, "gtk_print_settings_set_reverse")
-	core.PuregoSafeRegister(&xPrintSettingsSetScale, libs, "gtk_print_settings_set_scale")
-	core.PuregoSafeRegister(&xPrintSettingsSetUseColor, libs, "gtk_print_settings_set_use_color")
-	core.PuregoSafeRegister(&xPrintSettingsToFile, libs, "gtk_print_settings_to_file")
-	core.PuregoSafeRegister(&xPrintSettingsToGvariant, libs, "gtk_print_settings_to_gvariant")
-	core.PuregoSafeRegister(&xPrintSettingsToKeyFile, libs, "gtk_print_settings_to_key_file")
-	core.PuregoSafeRegister(&xPrintSettingsUnset, libs, "gtk_print_settings_unset")
+	core.PuregoRegisterSince(&xNewPrintSettings, libs, "gtk_print_settings_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewPrintSettingsFromFile, libs, "gtk_print_settings_new_from_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewPrintSettingsFromGvariant, libs, "gtk_print_settings_new_from_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xNewPrintSettingsFromKeyFile, libs, "gtk_print_settings_new_from_key_file", "GTK", "")
+
+	core.PuregoRegisterSince(&xPrintSettingsCopy, libs, "gtk_print_settings_copy", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsForeach, libs, "gtk_print_settings_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGet, libs, "gtk_print_settings_get", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetBool, libs, "gtk_print_settings_get_bool", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetCollate, libs, "gtk_print_settings_get_collate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetDefaultSource, libs, "gtk_print_settings_get_default_source", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetDither, libs, "gtk_print_settings_get_dither", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetDouble, libs, "gtk_print_settings_get_double", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetDoubleWithDefault, libs, "gtk_print_settings_get_double_with_default", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetDuplex, libs, "gtk_print_settings_get_duplex", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetFinishings, libs, "gtk_print_settings_get_finishings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetInt, libs, "gtk_print_settings_get_int", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetIntWithDefault, libs, "gtk_print_settings_get_int_with_default", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetLength, libs, "gtk_print_settings_get_length", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetMediaType, libs, "gtk_print_settings_get_media_type", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetNCopies, libs, "gtk_print_settings_get_n_copies", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetNumberUp, libs, "gtk_print_settings_get_number_up", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetNumberUpLayout, libs, "gtk_print_settings_get_number_up_layout", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetOrientation, libs, "gtk_print_settings_get_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetOutputBin, libs, "gtk_print_settings_get_output_bin", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPageRanges, libs, "gtk_print_settings_get_page_ranges", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPageSet, libs, "gtk_print_settings_get_page_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPaperHeight, libs, "gtk_print_settings_get_paper_height", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPaperSize, libs, "gtk_print_settings_get_paper_size", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPaperWidth, libs, "gtk_print_settings_get_paper_width", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPrintPages, libs, "gtk_print_settings_get_print_pages", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPrinter, libs, "gtk_print_settings_get_printer", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetPrinterLpi, libs, "gtk_print_settings_get_printer_lpi", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetQuality, libs, "gtk_print_settings_get_quality", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetResolution, libs, "gtk_print_settings_get_resolution", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetResolutionX, libs, "gtk_print_settings_get_resolution_x", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetResolutionY, libs, "gtk_print_settings_get_resolution_y", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetReverse, libs, "gtk_print_settings_get_reverse", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetScale, libs, "gtk_print_settings_get_scale", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsGetUseColor, libs, "gtk_print_settings_get_use_color", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsHasKey, libs, "gtk_print_settings_has_key", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsLoadFile, libs, "gtk_print_settings_load_file", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsLoadKeyFile, libs, "gtk_print_settings_load_key_file", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSet, libs, "gtk_print_settings_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetBool, libs, "gtk_print_settings_set_bool", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetCollate, libs, "gtk_print_settings_set_collate", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetDefaultSource, libs, "gtk_print_settings_set_default_source", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetDither, libs, "gtk_print_settings_set_dither", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetDouble, libs, "gtk_print_settings_set_double", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetDuplex, libs, "gtk_print_settings_set_duplex", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetFinishings, libs, "gtk_print_settings_set_finishings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetInt, libs, "gtk_print_settings_set_int", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetLength, libs, "gtk_print_settings_set_length", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetMediaType, libs, "gtk_print_settings_set_media_type", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetNCopies, libs, "gtk_print_settings_set_n_copies", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetNumberUp, libs, "gtk_print_settings_set_number_up", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetNumberUpLayout, libs, "gtk_print_settings_set_number_up_layout", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetOrientation, libs, "gtk_print_settings_set_orientation", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetOutputBin, libs, "gtk_print_settings_set_output_bin", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPageRanges, libs, "gtk_print_settings_set_page_ranges", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPageSet, libs, "gtk_print_settings_set_page_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPaperHeight, libs, "gtk_print_settings_set_paper_height", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPaperSize, libs, "gtk_print_settings_set_paper_size", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPaperWidth, libs, "gtk_print_settings_set_paper_width", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPrintPages, libs, "gtk_print_settings_set_print_pages", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPrinter, libs, "gtk_print_settings_set_printer", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetPrinterLpi, libs, "gtk_print_settings_set_printer_lpi", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetQuality, libs, "gtk_print_settings_set_quality", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetResolution, libs, "gtk_print_settings_set_resolution", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetResolutionXy, libs, "gtk_print_settings_set_resolution_xy", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetReverse, libs, "gtk_print_settings_set_reverse", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetScale, libs, "gtk_print_settings_set_scale", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsSetUseColor, libs, "gtk_print_settings_set_use_color", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsToFile, libs, "gtk_print_settings_to_file", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsToGvariant, libs, "gtk_print_settings_to_gvariant", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsToKeyFile, libs, "gtk_print_settings_to_key_file", "GTK", "")
+	core.PuregoRegisterSince(&xPrintSettingsUnset, libs, "gtk_print_settings_unset", "GTK", "")
 
 }
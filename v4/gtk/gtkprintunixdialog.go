@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,61 +12,50 @@ import (
 // A print dialog for platforms which don’t provide a native
 // print dialog, like Unix.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="printdialog-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkPrintUnixDialog" src="printdialog.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It can be used very much like any other GTK dialog, at the cost of
 // the portability offered by the high-level printing API with
-// [class@Gtk.PrintOperation].
+// [PrintOperation].
 //
 // In order to print something with `GtkPrintUnixDialog`, you need to
-// use [method@Gtk.PrintUnixDialog.get_selected_printer] to obtain a
-// [class@Gtk.Printer] object and use it to construct a [class@Gtk.PrintJob]
-// using [ctor@Gtk.PrintJob.new].
+// use [PrintUnixDialog.GetSelectedPrinter] to obtain a
+// [Printer] object and use it to construct a [PrintJob]
+// using [NewPrintJob].
 //
 // `GtkPrintUnixDialog` uses the following response values:
 //
-// - %GTK_RESPONSE_OK: for the “Print” button
-// - %GTK_RESPONSE_APPLY: for the “Preview” button
-// - %GTK_RESPONSE_CANCEL: for the “Cancel” button
+// - [ResponseOkValue]: for the “Print” button
+// - [ResponseApplyValue]: for the “Preview” button
+// - [ResponseCancelValue]: for the “Cancel” button
 //
 // # GtkPrintUnixDialog as GtkBuildable
 //
 // The `GtkPrintUnixDialog` implementation of the `GtkBuildable` interface
-// exposes its @notebook internal children with the name “notebook”.
+// exposes its notebook internal children with the name “notebook”.
 //
 // An example of a `GtkPrintUnixDialog` UI definition fragment:
 //
-// ```xml
-// &lt;object class="GtkPrintUnixDialog" id="dialog1"&gt;
-//
-//	&lt;child internal-child="notebook"&gt;
-//	  &lt;object class="GtkNotebook" id="notebook"&gt;
-//	    &lt;child&gt;
-//	      &lt;object type="GtkNotebookPage"&gt;
-//	        &lt;property name="tab_expand"&gt;False&lt;/property&gt;
-//	        &lt;property name="tab_fill"&gt;False&lt;/property&gt;
-//	        &lt;property name="tab"&gt;
-//	          &lt;object class="GtkLabel" id="tablabel"&gt;
-//	            &lt;property name="label"&gt;Tab label&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	        &lt;property name="child"&gt;
-//	          &lt;object class="GtkLabel" id="tabcontent"&gt;
-//	            &lt;property name="label"&gt;Content on notebook tab&lt;/property&gt;
-//	          &lt;/object&gt;
-//	        &lt;/property&gt;
-//	      &lt;/object&gt;
-//	    &lt;/child&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkPrintUnixDialog" id="dialog1">
+//	  <child internal-child="notebook">
+//	    <object class="GtkNotebook" id="notebook">
+//	      <child>
+//	        <object type="GtkNotebookPage">
+//	          <property name="tab_expand">False</property>
+//	          <property name="tab_fill">False</property>
+//	          <property name="tab">
+//	            <object class="GtkLabel" id="tablabel">
+//	              <property name="label">Tab label</property>
+//	            </object>
+//	          </property>
+//	          <property name="child">
+//	            <object class="GtkLabel" id="tabcontent">
+//	              <property name="label">Content on notebook tab</property>
+//	            </object>
+//	          </property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // # CSS nodes
 //
@@ -230,7 +218,7 @@ var xPrintUnixDialogSetCurrentPage func(uintptr, int)
 
 // Sets the current page number.
 //
-// If @current_page is not -1, this enables the current page choice
+// If current_page is not -1, this enables the current page choice
 // for the range of pages to print.
 func (x *PrintUnixDialog) SetCurrentPage(CurrentPageVar int) {
 
@@ -262,7 +250,7 @@ var xPrintUnixDialogSetManualCapabilities func(uintptr, PrintCapabilities)
 // supports.
 //
 // For instance, if you can handle scaling the output then you pass
-// %GTK_PRINT_CAPABILITY_SCALE. If you don’t pass that, then the dialog
+// [PrintCapabilityScaleValue]. If you don’t pass that, then the dialog
 // will only let you select the scale if the printing system automatically
 // handles scaling.
 func (x *PrintUnixDialog) SetManualCapabilities(CapabilitiesVar PrintCapabilities) {
@@ -331,7 +319,7 @@ func (x *PrintUnixDialog) GetPropertyCurrentPage() int {
 }
 
 // SetPropertyEmbedPageSetup sets the "embed-page-setup" property.
-// %TRUE if the page setup controls are embedded.
+// true if the page setup controls are embedded.
 func (x *PrintUnixDialog) SetPropertyEmbedPageSetup(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -340,7 +328,7 @@ func (x *PrintUnixDialog) SetPropertyEmbedPageSetup(value bool) {
 }
 
 // GetPropertyEmbedPageSetup gets the "embed-page-setup" property.
-// %TRUE if the page setup controls are embedded.
+// true if the page setup controls are embedded.
 func (x *PrintUnixDialog) GetPropertyEmbedPageSetup() bool {
 	var v gobject.Value
 	x.GetProperty("embed-page-setup", &v)
@@ -542,7 +530,7 @@ func (x *PrintUnixDialog) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -550,14 +538,13 @@ func (x *PrintUnixDialog) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *PrintUnixDialog) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -581,19 +568,17 @@ func (x *PrintUnixDialog) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *PrintUnixDialog) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -614,7 +599,7 @@ func (x *PrintUnixDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -623,14 +608,12 @@ func (x *PrintUnixDialog) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *PrintUnixDialog) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -649,10 +632,10 @@ func (x *PrintUnixDialog) UpdateStateValue(NStatesVar int, StatesVar []Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *PrintUnixDialog) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -689,10 +672,10 @@ func (x *PrintUnixDialog) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *PrintUnixDialog) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -736,7 +719,7 @@ func (x *PrintUnixDialog) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *PrintUnixDialog) GetFocus() *Widget {
 	var cls *Widget
@@ -752,13 +735,13 @@ func (x *PrintUnixDialog) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *PrintUnixDialog) SetFocus(FocusVar *Widget) {
 
@@ -771,7 +754,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -780,24 +763,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xPrintUnixDialogGLibType, libs, "gtk_print_unix_dialog_get_type")
 
-	core.PuregoSafeRegister(&xNewPrintUnixDialog, libs, "gtk_print_unix_dialog_new")
-
-	core.PuregoSafeRegister(&xPrintUnixDialogAddCustomTab, libs, "gtk_print_unix_dialog_add_custom_tab")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetCurrentPage, libs, "gtk_print_unix_dialog_get_current_page")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetEmbedPageSetup, libs, "gtk_print_unix_dialog_get_embed_page_setup")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetHasSelection, libs, "gtk_print_unix_dialog_get_has_selection")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetManualCapabilities, libs, "gtk_print_unix_dialog_get_manual_capabilities")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetPageSetup, libs, "gtk_print_unix_dialog_get_page_setup")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetPageSetupSet, libs, "gtk_print_unix_dialog_get_page_setup_set")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetSelectedPrinter, libs, "gtk_print_unix_dialog_get_selected_printer")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetSettings, libs, "gtk_print_unix_dialog_get_settings")
-	core.PuregoSafeRegister(&xPrintUnixDialogGetSupportSelection, libs, "gtk_print_unix_dialog_get_support_selection")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetCurrentPage, libs, "gtk_print_unix_dialog_set_current_page")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetEmbedPageSetup, libs, "gtk_print_unix_dialog_set_embed_page_setup")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetHasSelection, libs, "gtk_print_unix_dialog_set_has_selection")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetManualCapabilities, libs, "gtk_print_unix_dialog_set_manual_capabilities")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetPageSetup, libs, "gtk_print_unix_dialog_set_page_setup")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetSettings, libs, "gtk_print_unix_dialog_set_settings")
-	core.PuregoSafeRegister(&xPrintUnixDialogSetSupportSelection, libs, "gtk_print_unix_dialog_set_support_selection")
+	core.PuregoRegisterSince(&xNewPrintUnixDialog, libs, "gtk_print_unix_dialog_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xPrintUnixDialogAddCustomTab, libs, "gtk_print_unix_dialog_add_custom_tab", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetCurrentPage, libs, "gtk_print_unix_dialog_get_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetEmbedPageSetup, libs, "gtk_print_unix_dialog_get_embed_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetHasSelection, libs, "gtk_print_unix_dialog_get_has_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetManualCapabilities, libs, "gtk_print_unix_dialog_get_manual_capabilities", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetPageSetup, libs, "gtk_print_unix_dialog_get_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetPageSetupSet, libs, "gtk_print_unix_dialog_get_page_setup_set", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetSelectedPrinter, libs, "gtk_print_unix_dialog_get_selected_printer", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetSettings, libs, "gtk_print_unix_dialog_get_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogGetSupportSelection, libs, "gtk_print_unix_dialog_get_support_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetCurrentPage, libs, "gtk_print_unix_dialog_set_current_page", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetEmbedPageSetup, libs, "gtk_print_unix_dialog_set_embed_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetHasSelection, libs, "gtk_print_unix_dialog_set_has_selection", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetManualCapabilities, libs, "gtk_print_unix_dialog_set_manual_capabilities", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetPageSetup, libs, "gtk_print_unix_dialog_set_page_setup", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetSettings, libs, "gtk_print_unix_dialog_set_settings", "GTK", "")
+	core.PuregoRegisterSince(&xPrintUnixDialogSetSupportSelection, libs, "gtk_print_unix_dialog_set_support_selection", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -14,24 +13,17 @@ import (
 // `GtkProgressBar` provides a visual clue that processing is underway.
 // It can be used in two different modes: percentage mode and activity mode.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="progressbar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkProgressBar" src="progressbar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // When an application can determine how much work needs to take place
 // (e.g. read a fixed number of bytes from a file) and can monitor its
 // progress, it can use the `GtkProgressBar` in percentage mode and the
 // user sees a growing bar indicating the percentage of the work that
 // has been completed. In this mode, the application is required to call
-// [method@Gtk.ProgressBar.set_fraction] periodically to update the progress bar.
+// [ProgressBar.SetFraction] periodically to update the progress bar.
 //
 // When an application has no accurate way of knowing the amount of work
 // to do, it can use the `GtkProgressBar` in activity mode, which shows
 // activity by a block moving back and forth within the progress area. In
-// this mode, the application is required to call [method@Gtk.ProgressBar.pulse]
+// this mode, the application is required to call [ProgressBar.Pulse]
 // periodically to update the progress bar.
 //
 // There is quite a bit of flexibility provided to control the appearance
@@ -41,14 +33,10 @@ import (
 //
 // # CSS nodes
 //
-// ```
-// progressbar[.osd]
-// ├── [text]
-// ╰── trough[.empty][.full]
-//
-//	╰── progress[.pulse]
-//
-// ```
+//	progressbar[.osd]
+//	├── [text]
+//	╰── trough[.empty][.full]
+//	    ╰── progress[.pulse]
 //
 // `GtkProgressBar` has a main CSS node with name progressbar and subnodes with
 // names text and trough, of which the latter has a subnode named progress. The
@@ -60,8 +48,8 @@ import (
 //
 // # Accessibility
 //
-// `GtkProgressBar` uses the [enum@Gtk.AccessibleRole.progress_bar] role
-// and sets the [enum@Gtk.AccessibleProperty.value_min], [enum@Gtk.AccessibleProperty.value_max] and [enum@Gtk.AccessibleProperty.value_now] properties to reflect
+// `GtkProgressBar` uses the [AccessibleRoleProgressBarValue] role
+// and sets the [AccessiblePropertyValueMinValue], [AccessiblePropertyValueMaxValue] and [AccessiblePropertyValueNowValue] properties to reflect
 // the progress.
 type ProgressBar struct {
 	Widget
@@ -100,7 +88,7 @@ var xProgressBarGetEllipsize func(uintptr) pango.EllipsizeMode
 
 // Returns the ellipsizing position of the progress bar.
 //
-// See [method@Gtk.ProgressBar.set_ellipsize].
+// See [ProgressBar.SetEllipsize].
 func (x *ProgressBar) GetEllipsize() pango.EllipsizeMode {
 
 	cret := xProgressBarGetEllipsize(x.GoPointer())
@@ -129,7 +117,7 @@ var xProgressBarGetPulseStep func(uintptr) float64
 
 // Retrieves the pulse step.
 //
-// See [method@Gtk.ProgressBar.set_pulse_step].
+// See [ProgressBar.SetPulseStep].
 func (x *ProgressBar) GetPulseStep() float64 {
 
 	cret := xProgressBarGetPulseStep(x.GoPointer())
@@ -140,7 +128,7 @@ var xProgressBarGetShowText func(uintptr) bool
 
 // Returns whether the `GtkProgressBar` shows text.
 //
-// See [method@Gtk.ProgressBar.set_show_text].
+// See [ProgressBar.SetShowText].
 func (x *ProgressBar) GetShowText() bool {
 
 	cret := xProgressBarGetShowText(x.GoPointer())
@@ -164,9 +152,9 @@ var xProgressBarPulse func(uintptr)
 // Indicates that some progress has been made, but you don’t know how much.
 //
 // Causes the progress bar to enter “activity mode,” where a block
-// bounces back and forth. Each call to [method@Gtk.ProgressBar.pulse]
+// bounces back and forth. Each call to [ProgressBar.Pulse]
 // causes the block to move by a little bit (the amount of movement
-// per pulse is determined by [method@Gtk.ProgressBar.set_pulse_step]).
+// per pulse is determined by [ProgressBar.SetPulseStep]).
 func (x *ProgressBar) Pulse() {
 
 	xProgressBarPulse(x.GoPointer())
@@ -214,7 +202,7 @@ var xProgressBarSetPulseStep func(uintptr, float64)
 // Sets the fraction of total progress bar length to move the
 // bouncing block.
 //
-// The bouncing block is moved when [method@Gtk.ProgressBar.pulse]
+// The bouncing block is moved when [ProgressBar.Pulse]
 // is called.
 func (x *ProgressBar) SetPulseStep(FractionVar float64) {
 
@@ -226,13 +214,13 @@ var xProgressBarSetShowText func(uintptr, bool)
 
 // Sets whether the progress bar will show text next to the bar.
 //
-// The shown text is either the value of the [property@Gtk.ProgressBar:text]
-// property or, if that is %NULL, the [property@Gtk.ProgressBar:fraction] value,
+// The shown text is either the value of the [ProgressBar.GetPropertyText]
+// property or, if that is nil, the [ProgressBar.GetPropertyFraction] value,
 // as a percentage.
 //
 // To make a progress bar that is styled and sized suitably for containing
-// text (even if the actual text is blank), set [property@Gtk.ProgressBar:show-text]
-// to %TRUE and [property@Gtk.ProgressBar:text] to the empty string (not %NULL).
+// text (even if the actual text is blank), set [ProgressBar.GetPropertyShowText]
+// to true and [ProgressBar.GetPropertyText] to the empty string (not nil).
 func (x *ProgressBar) SetShowText(ShowTextVar bool) {
 
 	xProgressBarSetShowText(x.GoPointer(), ShowTextVar)
@@ -241,17 +229,17 @@ func (x *ProgressBar) SetShowText(ShowTextVar bool) {
 
 var xProgressBarSetText func(uintptr, uintptr)
 
-// Causes the given @text to appear next to the progress bar.
+// Causes the given text to appear next to the progress bar.
 //
-// If @text is %NULL and [property@Gtk.ProgressBar:show-text] is %TRUE,
-// the current value of [property@Gtk.ProgressBar:fraction] will be displayed
+// If text is nil and [ProgressBar.GetPropertyShowText] is true,
+// the current value of [ProgressBar.GetPropertyFraction] will be displayed
 // as a percentage.
 //
-// If @text is non-%NULL and [property@Gtk.ProgressBar:show-text] is %TRUE,
+// If text is non-nil and [ProgressBar.GetPropertyShowText] is true,
 // the text will be displayed. In this case, it will not display the progress
-// percentage. If @text is the empty string, the progress bar will still
+// percentage. If text is the empty string, the progress bar will still
 // be styled and sized suitably for containing text, as long as
-// [property@Gtk.ProgressBar:show-text] is %TRUE.
+// [ProgressBar.GetPropertyShowText] is true.
 func (x *ProgressBar) SetText(TextVar *string) {
 
 	TextVarPtr := core.GStrdupNullable(TextVar)
@@ -327,13 +315,13 @@ func (x *ProgressBar) GetPropertyPulseStep() float64 {
 // Sets whether the progress bar will show a text in addition
 // to the bar itself.
 //
-// The shown text is either the value of the [property@Gtk.ProgressBar:text]
-// property or, if that is %NULL, the [property@Gtk.ProgressBar:fraction]
+// The shown text is either the value of the [ProgressBar.GetPropertyText]
+// property or, if that is nil, the [ProgressBar.GetPropertyFraction]
 // value, as a percentage.
 //
 // To make a progress bar that is styled and sized suitably for showing text
-// (even if the actual text is blank), set [property@Gtk.ProgressBar:show-text]
-// to %TRUE and [property@Gtk.ProgressBar:text] to the empty string (not %NULL).
+// (even if the actual text is blank), set [ProgressBar.GetPropertyShowText]
+// to true and [ProgressBar.GetPropertyText] to the empty string (not nil).
 func (x *ProgressBar) SetPropertyShowText(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -345,13 +333,13 @@ func (x *ProgressBar) SetPropertyShowText(value bool) {
 // Sets whether the progress bar will show a text in addition
 // to the bar itself.
 //
-// The shown text is either the value of the [property@Gtk.ProgressBar:text]
-// property or, if that is %NULL, the [property@Gtk.ProgressBar:fraction]
+// The shown text is either the value of the [ProgressBar.GetPropertyText]
+// property or, if that is nil, the [ProgressBar.GetPropertyFraction]
 // value, as a percentage.
 //
 // To make a progress bar that is styled and sized suitably for showing text
-// (even if the actual text is blank), set [property@Gtk.ProgressBar:show-text]
-// to %TRUE and [property@Gtk.ProgressBar:text] to the empty string (not %NULL).
+// (even if the actual text is blank), set [ProgressBar.GetPropertyShowText]
+// to true and [ProgressBar.GetPropertyText] to the empty string (not nil).
 func (x *ProgressBar) GetPropertyShowText() bool {
 	var v gobject.Value
 	x.GetProperty("show-text", &v)
@@ -536,7 +524,7 @@ func (x *ProgressBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -544,14 +532,13 @@ func (x *ProgressBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ProgressBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -575,19 +562,17 @@ func (x *ProgressBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ProgressBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -608,7 +593,7 @@ func (x *ProgressBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -617,14 +602,12 @@ func (x *ProgressBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ProgressBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -643,24 +626,24 @@ func (x *ProgressBar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ProgressBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ProgressBar) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ProgressBar) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -672,7 +655,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -681,20 +664,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xProgressBarGLibType, libs, "gtk_progress_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewProgressBar, libs, "gtk_progress_bar_new")
-
-	core.PuregoSafeRegister(&xProgressBarGetEllipsize, libs, "gtk_progress_bar_get_ellipsize")
-	core.PuregoSafeRegister(&xProgressBarGetFraction, libs, "gtk_progress_bar_get_fraction")
-	core.PuregoSafeRegister(&xProgressBarGetInverted, libs, "gtk_progress_bar_get_inverted")
-	core.PuregoSafeRegister(&xProgressBarGetPulseStep, libs, "gtk_progress_bar_get_pulse_step")
-	core.PuregoSafeRegister(&xProgressBarGetShowText, libs, "gtk_progress_bar_get_show_text")
-	core.PuregoSafeRegister(&xProgressBarGetText, libs, "gtk_progress_bar_get_text")
-	core.PuregoSafeRegister(&xProgressBarPulse, libs, "gtk_progress_bar_pulse")
-	core.PuregoSafeRegister(&xProgressBarSetEllipsize, libs, "gtk_progress_bar_set_ellipsize")
-	core.PuregoSafeRegister(&xProgressBarSetFraction, libs, "gtk_progress_bar_set_fraction")
-	core.PuregoSafeRegister(&xProgressBarSetInverted, libs, "gtk_progress_bar_set_inverted")
-	core.PuregoSafeRegister(&xProgressBarSetPulseStep, libs, "gtk_progress_bar_set_pulse_step")
-	core.PuregoSafeRegister(&xProgressBarSetShowText, libs, "gtk_progress_bar_set_show_text")
-	core.PuregoSafeRegister(&xProgressBarSetText, libs, "gtk_progress_bar_set_text")
+	core.PuregoRegisterSince(&xNewProgressBar, libs, "gtk_progress_bar_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xProgressBarGetEllipsize, libs, "gtk_progress_bar_get_ellipsize", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarGetFraction, libs, "gtk_progress_bar_get_fraction", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarGetInverted, libs, "gtk_progress_bar_get_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarGetPulseStep, libs, "gtk_progress_bar_get_pulse_step", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarGetShowText, libs, "gtk_progress_bar_get_show_text", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarGetText, libs, "gtk_progress_bar_get_text", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarPulse, libs, "gtk_progress_bar_pulse", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetEllipsize, libs, "gtk_progress_bar_set_ellipsize", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetFraction, libs, "gtk_progress_bar_set_fraction", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetInverted, libs, "gtk_progress_bar_set_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetPulseStep, libs, "gtk_progress_bar_set_pulse_step", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetShowText, libs, "gtk_progress_bar_set_show_text", "GTK", "")
+	core.PuregoRegisterSince(&xProgressBarSetText, libs, "gtk_progress_bar_set_text", "GTK", "")
 
 }
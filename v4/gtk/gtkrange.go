@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -40,7 +39,8 @@ func (x *RangeClass) OverrideValueChanged(cb func(*Range)) {
 	if cb == nil {
 		x.xValueChanged = 0
 	} else {
-		x.xValueChanged = purego.NewCallback(func(RangeVarp uintptr) {
+		x.xValueChanged = core.NewCallback(func(RangeVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(RangeNewFromInternalPtr(RangeVarp))
 		})
 	}
@@ -52,7 +52,7 @@ func (x *RangeClass) GetValueChanged() func(*Range) {
 		return nil
 	}
 	var rawCallback func(RangeVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xValueChanged)
+	core.RegisterFunc(&rawCallback, x.xValueChanged)
 	return func(RangeVar *Range) {
 		rawCallback(RangeVar.GoPointer())
 	}
@@ -63,7 +63,8 @@ func (x *RangeClass) OverrideAdjustBounds(cb func(*Range, float64)) {
 	if cb == nil {
 		x.xAdjustBounds = 0
 	} else {
-		x.xAdjustBounds = purego.NewCallback(func(RangeVarp uintptr, NewValueVarp float64) {
+		x.xAdjustBounds = core.NewCallback(func(RangeVarp uintptr, NewValueVarp float64) {
+			defer core.RecoverPanic()
 			cb(RangeNewFromInternalPtr(RangeVarp), NewValueVarp)
 		})
 	}
@@ -75,7 +76,7 @@ func (x *RangeClass) GetAdjustBounds() func(*Range, float64) {
 		return nil
 	}
 	var rawCallback func(RangeVarp uintptr, NewValueVarp float64)
-	purego.RegisterFunc(&rawCallback, x.xAdjustBounds)
+	core.RegisterFunc(&rawCallback, x.xAdjustBounds)
 	return func(RangeVar *Range, NewValueVar float64) {
 		rawCallback(RangeVar.GoPointer(), NewValueVar)
 	}
@@ -86,7 +87,8 @@ func (x *RangeClass) OverrideMoveSlider(cb func(*Range, ScrollType)) {
 	if cb == nil {
 		x.xMoveSlider = 0
 	} else {
-		x.xMoveSlider = purego.NewCallback(func(RangeVarp uintptr, ScrollVarp ScrollType) {
+		x.xMoveSlider = core.NewCallback(func(RangeVarp uintptr, ScrollVarp ScrollType) {
+			defer core.RecoverPanic()
 			cb(RangeNewFromInternalPtr(RangeVarp), ScrollVarp)
 		})
 	}
@@ -98,7 +100,7 @@ func (x *RangeClass) GetMoveSlider() func(*Range, ScrollType) {
 		return nil
 	}
 	var rawCallback func(RangeVarp uintptr, ScrollVarp ScrollType)
-	purego.RegisterFunc(&rawCallback, x.xMoveSlider)
+	core.RegisterFunc(&rawCallback, x.xMoveSlider)
 	return func(RangeVar *Range, ScrollVar ScrollType) {
 		rawCallback(RangeVar.GoPointer(), ScrollVar)
 	}
@@ -109,7 +111,8 @@ func (x *RangeClass) OverrideGetRangeBorder(cb func(*Range, *Border)) {
 	if cb == nil {
 		x.xGetRangeBorder = 0
 	} else {
-		x.xGetRangeBorder = purego.NewCallback(func(RangeVarp uintptr, BorderVarp *Border) {
+		x.xGetRangeBorder = core.NewCallback(func(RangeVarp uintptr, BorderVarp *Border) {
+			defer core.RecoverPanic()
 			cb(RangeNewFromInternalPtr(RangeVarp), BorderVarp)
 		})
 	}
@@ -121,7 +124,7 @@ func (x *RangeClass) GetGetRangeBorder() func(*Range, *Border) {
 		return nil
 	}
 	var rawCallback func(RangeVarp uintptr, BorderVarp *Border)
-	purego.RegisterFunc(&rawCallback, x.xGetRangeBorder)
+	core.RegisterFunc(&rawCallback, x.xGetRangeBorder)
 	return func(RangeVar *Range, BorderVar *Border) {
 		rawCallback(RangeVar.GoPointer(), BorderVar)
 	}
@@ -132,7 +135,8 @@ func (x *RangeClass) OverrideChangeValue(cb func(*Range, ScrollType, float64) bo
 	if cb == nil {
 		x.xChangeValue = 0
 	} else {
-		x.xChangeValue = purego.NewCallback(func(RangeVarp uintptr, ScrollVarp ScrollType, NewValueVarp float64) bool {
+		x.xChangeValue = core.NewCallback(func(RangeVarp uintptr, ScrollVarp ScrollType, NewValueVarp float64) bool {
+			defer core.RecoverPanic()
 			return cb(RangeNewFromInternalPtr(RangeVarp), ScrollVarp, NewValueVarp)
 		})
 	}
@@ -144,7 +148,7 @@ func (x *RangeClass) GetChangeValue() func(*Range, ScrollType, float64) bool {
 		return nil
 	}
 	var rawCallback func(RangeVarp uintptr, ScrollVarp ScrollType, NewValueVarp float64) bool
-	purego.RegisterFunc(&rawCallback, x.xChangeValue)
+	core.RegisterFunc(&rawCallback, x.xChangeValue)
 	return func(RangeVar *Range, ScrollVar ScrollType, NewValueVar float64) bool {
 		return rawCallback(RangeVar.GoPointer(), ScrollVar, NewValueVar)
 	}
@@ -153,15 +157,15 @@ func (x *RangeClass) GetChangeValue() func(*Range, ScrollType, float64) bool {
 // Base class for widgets which visualize an adjustment.
 //
 // Widgets that are derived from `GtkRange` include
-// [class@Gtk.Scale] and [class@Gtk.Scrollbar].
+// [Scale] and [Scrollbar].
 //
 // Apart from signals for monitoring the parameters of the adjustment,
 // `GtkRange` provides properties and methods for setting a
-// “fill level” on range widgets. See [method@Gtk.Range.set_fill_level].
+// “fill level” on range widgets. See [Range.SetFillLevel].
 //
 // # Shortcuts and Gestures
 //
-// The `GtkRange` slider is draggable. Holding the &lt;kbd&gt;Shift&lt;/kbd&gt; key while
+// The `GtkRange` slider is draggable. Holding the Shift key while
 // dragging, or initiating the drag with a long-press will enable the
 // fine-tuning mode.
 type Range struct {
@@ -210,7 +214,7 @@ var xRangeGetFlippable func(uintptr) bool
 
 // Gets whether the `GtkRange` respects text direction.
 //
-// See [method@Gtk.Range.set_flippable].
+// See [Range.SetFlippable].
 func (x *Range) GetFlippable() bool {
 
 	cret := xRangeGetFlippable(x.GoPointer())
@@ -221,7 +225,7 @@ var xRangeGetInverted func(uintptr) bool
 
 // Gets whether the range is inverted.
 //
-// See [method@Gtk.Range.set_inverted].
+// See [Range.SetInverted].
 func (x *Range) GetInverted() bool {
 
 	cret := xRangeGetInverted(x.GoPointer())
@@ -231,7 +235,7 @@ func (x *Range) GetInverted() bool {
 var xRangeGetRangeRect func(uintptr, *gdk.Rectangle)
 
 // This function returns the area that contains the range’s trough,
-// in coordinates relative to @range's origin.
+// in coordinates relative to range's origin.
 //
 // This function is useful mainly for `GtkRange` subclasses.
 func (x *Range) GetRangeRect(RangeRectVar *gdk.Rectangle) {
@@ -254,7 +258,7 @@ var xRangeGetRoundDigits func(uintptr) int
 // Gets the number of digits to round the value to when
 // it changes.
 //
-// See [signal@Gtk.Range::change-value].
+// See [Range.ConnectChangeValueFunc].
 func (x *Range) GetRoundDigits() int {
 
 	cret := xRangeGetRoundDigits(x.GoPointer())
@@ -273,7 +277,7 @@ func (x *Range) GetShowFillLevel() bool {
 var xRangeGetSliderRange func(uintptr, *int, *int)
 
 // This function returns sliders range along the long dimension,
-// in widget-&gt;window coordinates.
+// in widget->window coordinates.
 //
 // This function is useful mainly for `GtkRange` subclasses.
 func (x *Range) GetSliderRange(SliderStartVar *int, SliderEndVar *int) {
@@ -286,7 +290,7 @@ var xRangeGetSliderSizeFixed func(uintptr) bool
 
 // This function is useful mainly for `GtkRange` subclasses.
 //
-// See [method@Gtk.Range.set_slider_size_fixed].
+// See [Range.SetSliderSizeFixed].
 func (x *Range) GetSliderSizeFixed() bool {
 
 	cret := xRangeGetSliderSizeFixed(x.GoPointer())
@@ -331,12 +335,12 @@ var xRangeSetFillLevel func(uintptr, float64)
 //
 // This amount of prebuffering can be displayed on the range’s trough
 // and is themeable separately from the trough. To enable fill level
-// display, use [method@Gtk.Range.set_show_fill_level]. The range defaults
+// display, use [Range.SetShowFillLevel]. The range defaults
 // to not showing the fill level.
 //
 // Additionally, it’s possible to restrict the range’s slider position
 // to values which are smaller than the fill level. This is controlled
-// by [method@Gtk.Range.set_restrict_to_fill_level] and is by default
+// by [Range.SetRestrictToFillLevel] and is by default
 // enabled.
 func (x *Range) SetFillLevel(FillLevelVar float64) {
 
@@ -349,9 +353,9 @@ var xRangeSetFlippable func(uintptr, bool)
 // Sets whether the `GtkRange` respects text direction.
 //
 // If a range is flippable, it will switch its direction
-// if it is horizontal and its direction is %GTK_TEXT_DIR_RTL.
+// if it is horizontal and its direction is [TextDirRtlValue].
 //
-// See [method@Gtk.Widget.get_direction].
+// See [Widget.GetDirection].
 func (x *Range) SetFlippable(FlippableVar bool) {
 
 	xRangeSetFlippable(x.GoPointer(), FlippableVar)
@@ -389,9 +393,9 @@ var xRangeSetRange func(uintptr, float64, float64)
 
 // Sets the allowable values in the `GtkRange`.
 //
-// The range value is clamped to be between @min and @max.
+// The range value is clamped to be between min and max.
 // (If the range has a non-zero page size, it is clamped
-// between @min and @max - page-size.)
+// between min and max - page-size.)
 func (x *Range) SetRange(MinVar float64, MaxVar float64) {
 
 	xRangeSetRange(x.GoPointer(), MinVar, MaxVar)
@@ -402,7 +406,7 @@ var xRangeSetRestrictToFillLevel func(uintptr, bool)
 
 // Sets whether the slider is restricted to the fill level.
 //
-// See [method@Gtk.Range.set_fill_level] for a general description
+// See [Range.SetFillLevel] for a general description
 // of the fill level concept.
 func (x *Range) SetRestrictToFillLevel(RestrictToFillLevelVar bool) {
 
@@ -415,7 +419,7 @@ var xRangeSetRoundDigits func(uintptr, int)
 // Sets the number of digits to round the value to when
 // it changes.
 //
-// See [signal@Gtk.Range::change-value].
+// See [Range.ConnectChangeValueFunc].
 func (x *Range) SetRoundDigits(RoundDigitsVar int) {
 
 	xRangeSetRoundDigits(x.GoPointer(), RoundDigitsVar)
@@ -426,7 +430,7 @@ var xRangeSetShowFillLevel func(uintptr, bool)
 
 // Sets whether a graphical fill level is show on the trough.
 //
-// See [method@Gtk.Range.set_fill_level] for a general description
+// See [Range.SetFillLevel] for a general description
 // of the fill level concept.
 func (x *Range) SetShowFillLevel(ShowFillLevelVar bool) {
 
@@ -452,7 +456,7 @@ var xRangeSetValue func(uintptr, float64)
 //
 // If the value is outside the minimum or maximum range values,
 // it will be clamped to fit inside them. The range emits the
-// [signal@Gtk.Range::value-changed] signal if the value changes.
+// [Range.ConnectValueChangedFunc] signal if the value changes.
 func (x *Range) SetValue(ValueVar float64) {
 
 	xRangeSetValue(x.GoPointer(), ValueVar)
@@ -488,7 +492,7 @@ func (x *Range) GetPropertyFillLevel() float64 {
 }
 
 // SetPropertyInverted sets the "inverted" property.
-// If %TRUE, the direction in which the slider moves is inverted.
+// If true, the direction in which the slider moves is inverted.
 func (x *Range) SetPropertyInverted(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -497,7 +501,7 @@ func (x *Range) SetPropertyInverted(value bool) {
 }
 
 // GetPropertyInverted gets the "inverted" property.
-// If %TRUE, the direction in which the slider moves is inverted.
+// If true, the direction in which the slider moves is inverted.
 func (x *Range) GetPropertyInverted() bool {
 	var v gobject.Value
 	x.GetProperty("inverted", &v)
@@ -527,7 +531,7 @@ func (x *Range) GetPropertyRestrictToFillLevel() bool {
 // The number of digits to round the value to when
 // it changes.
 //
-// See [signal@Gtk.Range::change-value].
+// See [Range.ConnectChangeValueFunc].
 func (x *Range) SetPropertyRoundDigits(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -539,7 +543,7 @@ func (x *Range) SetPropertyRoundDigits(value int) {
 // The number of digits to round the value to when
 // it changes.
 //
-// See [signal@Gtk.Range::change-value].
+// See [Range.ConnectChangeValueFunc].
 func (x *Range) GetPropertyRoundDigits() int {
 	var v gobject.Value
 	x.GetProperty("round-digits", &v)
@@ -567,112 +571,200 @@ func (x *Range) GetPropertyShowFillLevel() bool {
 
 // Emitted before clamping a value, to give the application a
 // chance to adjust the bounds.
+//
+// Deprecated: use ConnectAdjustBoundsFunc, which also accepts method values and closures.
 func (x *Range) ConnectAdjustBounds(cb *func(Range, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "adjust-bounds", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp float64) {
+			defer core.RecoverPanic()
+			fa := Range{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "adjust-bounds", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ValueVarp float64) {
+var xRangeAdjustBoundsTrampoline uintptr
+
+func xRangeAdjustBoundsNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ValueVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Range, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Range{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "adjust-bounds", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectAdjustBoundsFunc connects cb to the "adjust-bounds" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Range) ConnectAdjustBoundsFunc(cb func(Range, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "adjust-bounds", &xRangeAdjustBoundsTrampoline, xRangeAdjustBoundsNewTrampoline, cb)
 }
 
 // Emitted when a scroll action is performed on a range.
 //
 // It allows an application to determine the type of scroll event
 // that occurred and the resultant new value. The application can
-// handle the event itself and return %TRUE to prevent further
-// processing. Or, by returning %FALSE, it can pass the event to
+// handle the event itself and return true to prevent further
+// processing. Or, by returning false, it can pass the event to
 // other handlers until the default GTK handler is reached.
 //
 // The value parameter is unrounded. An application that overrides
 // the ::change-value signal is responsible for clamping the value
 // to the desired number of decimal digits; the default GTK
-// handler clamps the value based on [property@Gtk.Range:round-digits].
+// handler clamps the value based on [Range.GetPropertyRoundDigits].
+//
+// Deprecated: use ConnectChangeValueFunc, which also accepts method values and closures.
 func (x *Range) ConnectChangeValue(cb *func(Range, ScrollType, float64) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-value", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ScrollVarp ScrollType, ValueVarp float64) bool {
+			defer core.RecoverPanic()
+			fa := Range{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ScrollVarp, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-value", cbRefPtr, cbPtr)
+}
+
+var xRangeChangeValueTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ScrollVarp ScrollType, ValueVarp float64) bool {
+func xRangeChangeValueNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ScrollVarp ScrollType, ValueVarp float64, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Range, ScrollType, float64) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Range{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ScrollVarp, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-value", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeValueFunc connects cb to the "change-value" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Range) ConnectChangeValueFunc(cb func(Range, ScrollType, float64) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-value", &xRangeChangeValueTrampoline, xRangeChangeValueNewTrampoline, cb)
 }
 
 // Virtual function that moves the slider.
 //
 // Used for keybindings.
+//
+// Deprecated: use ConnectMoveSliderFunc, which also accepts method values and closures.
 func (x *Range) ConnectMoveSlider(cb *func(Range, ScrollType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-slider", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp ScrollType) {
+			defer core.RecoverPanic()
+			fa := Range{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-slider", cbRefPtr, cbPtr)
+}
+
+var xRangeMoveSliderTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StepVarp ScrollType) {
+func xRangeMoveSliderNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp ScrollType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Range, ScrollType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Range{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-slider", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveSliderFunc connects cb to the "move-slider" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Range) ConnectMoveSliderFunc(cb func(Range, ScrollType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-slider", &xRangeMoveSliderTrampoline, xRangeMoveSliderNewTrampoline, cb)
 }
 
 // Emitted when the range value changes.
+//
+// Deprecated: use ConnectValueChangedFunc, which also accepts method values and closures.
 func (x *Range) ConnectValueChanged(cb *func(Range)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Range{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "value-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xRangeValueChangedTrampoline uintptr
+
+func xRangeValueChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Range))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Range{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectValueChangedFunc connects cb to the "value-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Range) ConnectValueChangedFunc(cb func(Range)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "value-changed", &xRangeValueChangedTrampoline, xRangeValueChangedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -836,7 +928,7 @@ func (x *Range) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -844,14 +936,13 @@ func (x *Range) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Range) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -875,19 +966,17 @@ func (x *Range) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Range) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -908,7 +997,7 @@ func (x *Range) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -917,14 +1006,12 @@ func (x *Range) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Range) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -943,24 +1030,24 @@ func (x *Range) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Range) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Range) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Range) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -972,7 +1059,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -981,27 +1068,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xRangeGLibType, libs, "gtk_range_get_type")
 
-	core.PuregoSafeRegister(&xRangeGetAdjustment, libs, "gtk_range_get_adjustment")
-	core.PuregoSafeRegister(&xRangeGetFillLevel, libs, "gtk_range_get_fill_level")
-	core.PuregoSafeRegister(&xRangeGetFlippable, libs, "gtk_range_get_flippable")
-	core.PuregoSafeRegister(&xRangeGetInverted, libs, "gtk_range_get_inverted")
-	core.PuregoSafeRegister(&xRangeGetRangeRect, libs, "gtk_range_get_range_rect")
-	core.PuregoSafeRegister(&xRangeGetRestrictToFillLevel, libs, "gtk_range_get_restrict_to_fill_level")
-	core.PuregoSafeRegister(&xRangeGetRoundDigits, libs, "gtk_range_get_round_digits")
-	core.PuregoSafeRegister(&xRangeGetShowFillLevel, libs, "gtk_range_get_show_fill_level")
-	core.PuregoSafeRegister(&xRangeGetSliderRange, libs, "gtk_range_get_slider_range")
-	core.PuregoSafeRegister(&xRangeGetSliderSizeFixed, libs, "gtk_range_get_slider_size_fixed")
-	core.PuregoSafeRegister(&xRangeGetValue, libs, "gtk_range_get_value")
-	core.PuregoSafeRegister(&xRangeSetAdjustment, libs, "gtk_range_set_adjustment")
-	core.PuregoSafeRegister(&xRangeSetFillLevel, libs, "gtk_range_set_fill_level")
-	core.PuregoSafeRegister(&xRangeSetFlippable, libs, "gtk_range_set_flippable")
-	core.PuregoSafeRegister(&xRangeSetIncrements, libs, "gtk_range_set_increments")
-	core.PuregoSafeRegister(&xRangeSetInverted, libs, "gtk_range_set_inverted")
-	core.PuregoSafeRegister(&xRangeSetRange, libs, "gtk_range_set_range")
-	core.PuregoSafeRegister(&xRangeSetRestrictToFillLevel, libs, "gtk_range_set_restrict_to_fill_level")
-	core.PuregoSafeRegister(&xRangeSetRoundDigits, libs, "gtk_range_set_round_digits")
-	core.PuregoSafeRegister(&xRangeSetShowFillLevel, libs, "gtk_range_set_show_fill_level")
-	core.PuregoSafeRegister(&xRangeSetSliderSizeFixed, libs, "gtk_range_set_slider_size_fixed")
-	core.PuregoSafeRegister(&xRangeSetValue, libs, "gtk_range_set_value")
+	gobject.CheckClassSize("GtkRangeClass", xRangeGLibType, unsafe.Sizeof(RangeClass{}))
+
+	core.PuregoRegisterSince(&xRangeGetAdjustment, libs, "gtk_range_get_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetFillLevel, libs, "gtk_range_get_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetFlippable, libs, "gtk_range_get_flippable", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetInverted, libs, "gtk_range_get_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetRangeRect, libs, "gtk_range_get_range_rect", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetRestrictToFillLevel, libs, "gtk_range_get_restrict_to_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetRoundDigits, libs, "gtk_range_get_round_digits", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetShowFillLevel, libs, "gtk_range_get_show_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetSliderRange, libs, "gtk_range_get_slider_range", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetSliderSizeFixed, libs, "gtk_range_get_slider_size_fixed", "GTK", "")
+	core.PuregoRegisterSince(&xRangeGetValue, libs, "gtk_range_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetAdjustment, libs, "gtk_range_set_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetFillLevel, libs, "gtk_range_set_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetFlippable, libs, "gtk_range_set_flippable", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetIncrements, libs, "gtk_range_set_increments", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetInverted, libs, "gtk_range_set_inverted", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetRange, libs, "gtk_range_set_range", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetRestrictToFillLevel, libs, "gtk_range_set_restrict_to_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetRoundDigits, libs, "gtk_range_set_round_digits", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetShowFillLevel, libs, "gtk_range_set_show_fill_level", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetSliderSizeFixed, libs, "gtk_range_set_slider_size_fixed", "GTK", "")
+	core.PuregoRegisterSince(&xRangeSetValue, libs, "gtk_range_set_value", "GTK", "")
 
 }
@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -13,7 +13,7 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Meta-data to be passed to gtk_recent_manager_add_full() when
+// Meta-data to be passed to [RecentManager.AddFull] when
 // registering a recently used resource.
 type RecentData struct {
 	_ structs.HostLayout
@@ -57,8 +57,8 @@ var xRecentInfoCreateAppInfo func(uintptr, uintptr, **glib.Error) uintptr
 
 // Creates a `GAppInfo` for the specified `GtkRecentInfo`
 //
-// In case of error, @error will be set either with a
-// %GTK_RECENT_MANAGER_ERROR or a %G_IO_ERROR
+// In case of error, error will be set either with a
+// GTK_RECENT_MANAGER_ERROR or a G_IO_ERROR
 func (x *RecentInfo) CreateAppInfo(AppNameVar *string) (*gio.AppInfoBase, error) {
 	var cls *gio.AppInfoBase
 	var cerr *glib.Error
@@ -69,12 +69,15 @@ func (x *RecentInfo) CreateAppInfo(AppNameVar *string) (*gio.AppInfoBase, error)
 	cret := xRecentInfoCreateAppInfo(x.GoPointer(), AppNameVarPtr, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &gio.AppInfoBase{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -82,7 +85,7 @@ func (x *RecentInfo) CreateAppInfo(AppNameVar *string) (*gio.AppInfoBase, error)
 
 var xRecentInfoExists func(uintptr) bool
 
-// Checks whether the resource pointed by @info still exists.
+// Checks whether the resource pointed by info still exists.
 // At the moment this check is done only on resources pointing
 // to local files.
 func (x *RecentInfo) Exists() bool {
@@ -104,7 +107,7 @@ func (x *RecentInfo) GetAdded() *glib.DateTime {
 var xRecentInfoGetAge func(uintptr) int
 
 // Gets the number of days elapsed since the last update
-// of the resource pointed by @info.
+// of the resource pointed by info.
 func (x *RecentInfo) GetAge() int {
 
 	cret := xRecentInfoGetAge(x.GoPointer())
@@ -114,7 +117,7 @@ func (x *RecentInfo) GetAge() int {
 var xRecentInfoGetApplicationInfo func(uintptr, string, *string, *uint, **glib.DateTime) bool
 
 // Gets the data regarding the application that has registered the resource
-// pointed by @info.
+// pointed by info.
 //
 // If the command line contains any escape characters defined inside the
 // storage specification, they will be expanded.
@@ -124,13 +127,13 @@ func (x *RecentInfo) GetApplicationInfo(AppNameVar string, AppExecVar *string, C
 	return cret
 }
 
-var xRecentInfoGetApplications func(uintptr, *uint) []string
+var xRecentInfoGetApplications func(uintptr, *uint) uintptr
 
 // Retrieves the list of applications that have registered this resource.
 func (x *RecentInfo) GetApplications(LengthVar *uint) []string {
 
 	cret := xRecentInfoGetApplications(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xRecentInfoGetDescription func(uintptr) string
@@ -170,16 +173,16 @@ func (x *RecentInfo) GetGicon() *gio.IconBase {
 	return cls
 }
 
-var xRecentInfoGetGroups func(uintptr, *uint) []string
+var xRecentInfoGetGroups func(uintptr, *uint) uintptr
 
-// Returns all groups registered for the recently used item @info.
+// Returns all groups registered for the recently used item info.
 //
-// The array of returned group names will be %NULL terminated, so
-// length might optionally be %NULL.
+// The array of returned group names will be nil terminated, so
+// length might optionally be nil.
 func (x *RecentInfo) GetGroups(LengthVar *uint) []string {
 
 	cret := xRecentInfoGetGroups(x.GoPointer(), LengthVar)
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xRecentInfoGetMimeType func(uintptr) string
@@ -206,7 +209,7 @@ var xRecentInfoGetPrivateHint func(uintptr) bool
 // Gets the value of the “private” flag.
 //
 // Resources in the recently used list that have this flag
-// set to %TRUE should only be displayed by the applications
+// set to true should only be displayed by the applications
 // that have registered them.
 func (x *RecentInfo) GetPrivateHint() bool {
 
@@ -242,7 +245,7 @@ var xRecentInfoGetUriDisplay func(uintptr) string
 //
 // If the resource is local, it returns a local path; if the
 // resource is not local, it returns the UTF-8 encoded content
-// of [method@Gtk.RecentInfo.get_uri].
+// of [RecentInfo.GetUri].
 func (x *RecentInfo) GetUriDisplay() string {
 
 	cret := xRecentInfoGetUriDisplay(x.GoPointer())
@@ -261,7 +264,7 @@ func (x *RecentInfo) GetVisited() *glib.DateTime {
 
 var xRecentInfoHasApplication func(uintptr, string) bool
 
-// Checks whether an application registered this resource using @app_name.
+// Checks whether an application registered this resource using app_name.
 func (x *RecentInfo) HasApplication(AppNameVar string) bool {
 
 	cret := xRecentInfoHasApplication(x.GoPointer(), AppNameVar)
@@ -270,8 +273,8 @@ func (x *RecentInfo) HasApplication(AppNameVar string) bool {
 
 var xRecentInfoHasGroup func(uintptr, string) bool
 
-// Checks whether @group_name appears inside the groups
-// registered for the recently used item @info.
+// Checks whether group_name appears inside the groups
+// registered for the recently used item info.
 func (x *RecentInfo) HasGroup(GroupNameVar string) bool {
 
 	cret := xRecentInfoHasGroup(x.GoPointer(), GroupNameVar)
@@ -291,7 +294,7 @@ func (x *RecentInfo) IsLocal() bool {
 var xRecentInfoLastApplication func(uintptr) string
 
 // Gets the name of the last application that have registered the
-// recently used resource represented by @info.
+// recently used resource represented by info.
 func (x *RecentInfo) LastApplication() string {
 
 	cret := xRecentInfoLastApplication(x.GoPointer())
@@ -309,7 +312,7 @@ func (x *RecentInfo) Match(InfoBVar *RecentInfo) bool {
 
 var xRecentInfoRef func(uintptr) *RecentInfo
 
-// Increases the reference count of @recent_info by one.
+// Increases the reference count of recent_info by one.
 func (x *RecentInfo) Ref() *RecentInfo {
 
 	cret := xRecentInfoRef(x.GoPointer())
@@ -318,9 +321,9 @@ func (x *RecentInfo) Ref() *RecentInfo {
 
 var xRecentInfoUnref func(uintptr)
 
-// Decreases the reference count of @info by one.
+// Decreases the reference count of info by one.
 //
-// If the reference count reaches zero, @info is
+// If the reference count reaches zero, info is
 // deallocated, and the memory freed.
 func (x *RecentInfo) Unref() {
 
@@ -354,7 +357,8 @@ func (x *RecentManagerClass) OverrideChanged(cb func(*RecentManager)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(ManagerVarp uintptr) {
+		x.xChanged = core.NewCallback(func(ManagerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(RecentManagerNewFromInternalPtr(ManagerVarp))
 		})
 	}
@@ -366,7 +370,7 @@ func (x *RecentManagerClass) GetChanged() func(*RecentManager) {
 		return nil
 	}
 	var rawCallback func(ManagerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(ManagerVar *RecentManager) {
 		rawCallback(ManagerVar.GoPointer())
 	}
@@ -377,7 +381,8 @@ func (x *RecentManagerClass) OverrideGtkRecent1(cb func()) {
 	if cb == nil {
 		x.xGtkRecent1 = 0
 	} else {
-		x.xGtkRecent1 = purego.NewCallback(func() {
+		x.xGtkRecent1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -389,7 +394,7 @@ func (x *RecentManagerClass) GetGtkRecent1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkRecent1)
+	core.RegisterFunc(&rawCallback, x.xGtkRecent1)
 	return func() {
 		rawCallback()
 	}
@@ -400,7 +405,8 @@ func (x *RecentManagerClass) OverrideGtkRecent2(cb func()) {
 	if cb == nil {
 		x.xGtkRecent2 = 0
 	} else {
-		x.xGtkRecent2 = purego.NewCallback(func() {
+		x.xGtkRecent2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -412,7 +418,7 @@ func (x *RecentManagerClass) GetGtkRecent2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkRecent2)
+	core.RegisterFunc(&rawCallback, x.xGtkRecent2)
 	return func() {
 		rawCallback()
 	}
@@ -423,7 +429,8 @@ func (x *RecentManagerClass) OverrideGtkRecent3(cb func()) {
 	if cb == nil {
 		x.xGtkRecent3 = 0
 	} else {
-		x.xGtkRecent3 = purego.NewCallback(func() {
+		x.xGtkRecent3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -435,7 +442,7 @@ func (x *RecentManagerClass) GetGtkRecent3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkRecent3)
+	core.RegisterFunc(&rawCallback, x.xGtkRecent3)
 	return func() {
 		rawCallback()
 	}
@@ -446,7 +453,8 @@ func (x *RecentManagerClass) OverrideGtkRecent4(cb func()) {
 	if cb == nil {
 		x.xGtkRecent4 = 0
 	} else {
-		x.xGtkRecent4 = purego.NewCallback(func() {
+		x.xGtkRecent4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -458,7 +466,7 @@ func (x *RecentManagerClass) GetGtkRecent4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkRecent4)
+	core.RegisterFunc(&rawCallback, x.xGtkRecent4)
 	return func() {
 		rawCallback()
 	}
@@ -504,9 +512,98 @@ const (
 	RecentManagerErrorUnknownValue RecentManagerError = 6
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (RecentManagerError) ErrorDomain() string {
+	return "gtk-recent-manager-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x RecentManagerError) Error() string {
+	switch x {
+	case RecentManagerErrorNotFoundValue:
+		return "GTK_RECENT_MANAGER_ERROR_NOT_FOUND"
+	case RecentManagerErrorInvalidUriValue:
+		return "GTK_RECENT_MANAGER_ERROR_INVALID_URI"
+	case RecentManagerErrorInvalidEncodingValue:
+		return "GTK_RECENT_MANAGER_ERROR_INVALID_ENCODING"
+	case RecentManagerErrorNotRegisteredValue:
+		return "GTK_RECENT_MANAGER_ERROR_NOT_REGISTERED"
+	case RecentManagerErrorReadValue:
+		return "GTK_RECENT_MANAGER_ERROR_READ"
+	case RecentManagerErrorWriteValue:
+		return "GTK_RECENT_MANAGER_ERROR_WRITE"
+	case RecentManagerErrorUnknownValue:
+		return "GTK_RECENT_MANAGER_ERROR_UNKNOWN"
+	}
+	return fmt.Sprintf("gtk-recent-manager-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x RecentManagerError) String() string {
+	switch x {
+	case RecentManagerErrorNotFoundValue:
+		return "GTK_RECENT_MANAGER_ERROR_NOT_FOUND"
+	case RecentManagerErrorInvalidUriValue:
+		return "GTK_RECENT_MANAGER_ERROR_INVALID_URI"
+	case RecentManagerErrorInvalidEncodingValue:
+		return "GTK_RECENT_MANAGER_ERROR_INVALID_ENCODING"
+	case RecentManagerErrorNotRegisteredValue:
+		return "GTK_RECENT_MANAGER_ERROR_NOT_REGISTERED"
+	case RecentManagerErrorReadValue:
+		return "GTK_RECENT_MANAGER_ERROR_READ"
+	case RecentManagerErrorWriteValue:
+		return "GTK_RECENT_MANAGER_ERROR_WRITE"
+	case RecentManagerErrorUnknownValue:
+		return "GTK_RECENT_MANAGER_ERROR_UNKNOWN"
+	}
+	return fmt.Sprintf("RecentManagerError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RecentManagerError) Nick() string {
+	switch x {
+	case RecentManagerErrorNotFoundValue:
+		return "not-found"
+	case RecentManagerErrorInvalidUriValue:
+		return "invalid-uri"
+	case RecentManagerErrorInvalidEncodingValue:
+		return "invalid-encoding"
+	case RecentManagerErrorNotRegisteredValue:
+		return "not-registered"
+	case RecentManagerErrorReadValue:
+		return "read"
+	case RecentManagerErrorWriteValue:
+		return "write"
+	case RecentManagerErrorUnknownValue:
+		return "unknown"
+	}
+	return ""
+}
+
+// RecentManagerErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RecentManagerErrorFromString(s string) (RecentManagerError, bool) {
+	switch s {
+	case "GTK_RECENT_MANAGER_ERROR_NOT_FOUND", "not-found":
+		return RecentManagerErrorNotFoundValue, true
+	case "GTK_RECENT_MANAGER_ERROR_INVALID_URI", "invalid-uri":
+		return RecentManagerErrorInvalidUriValue, true
+	case "GTK_RECENT_MANAGER_ERROR_INVALID_ENCODING", "invalid-encoding":
+		return RecentManagerErrorInvalidEncodingValue, true
+	case "GTK_RECENT_MANAGER_ERROR_NOT_REGISTERED", "not-registered":
+		return RecentManagerErrorNotRegisteredValue, true
+	case "GTK_RECENT_MANAGER_ERROR_READ", "read":
+		return RecentManagerErrorReadValue, true
+	case "GTK_RECENT_MANAGER_ERROR_WRITE", "write":
+		return RecentManagerErrorWriteValue, true
+	case "GTK_RECENT_MANAGER_ERROR_UNKNOWN", "unknown":
+		return RecentManagerErrorUnknownValue, true
+	}
+	return 0, false
+}
+
 var xRecentManagerErrorQuark func() glib.Quark
 
-// Registers an error quark for [class@RecentManager] errors.
+// Registers an error quark for RecentManager errors.
 func RecentManagerErrorQuark() glib.Quark {
 
 	cret := xRecentManagerErrorQuark()
@@ -530,48 +627,40 @@ func RecentManagerErrorQuark() glib.Quark {
 //
 // Adding a new recently used file is as simple as:
 //
-// ```c
-// GtkRecentManager *manager;
+//	GtkRecentManager *manager;
 //
-// manager = gtk_recent_manager_get_default ();
-// gtk_recent_manager_add_item (manager, file_uri);
-// ```
+//	manager = gtk_recent_manager_get_default ();
+//	gtk_recent_manager_add_item (manager, file_uri);
 //
 // The `GtkRecentManager` will try to gather all the needed information
 // from the file itself through GIO.
 //
 // Looking up the meta-data associated with a recently used file
-// given its URI requires calling [method@Gtk.RecentManager.lookup_item]:
-//
-// ```c
-// GtkRecentManager *manager;
-// GtkRecentInfo *info;
-// GError *error = NULL;
-//
-// manager = gtk_recent_manager_get_default ();
-// info = gtk_recent_manager_lookup_item (manager, file_uri, &amp;error);
-// if (error)
-//
-//	{
-//	  g_warning ("Could not find the file: %s", error-&gt;message);
-//	  g_error_free (error);
-//	}
-//
-// else
-//
-//	{
-//	  // Use the info object
-//	  gtk_recent_info_unref (info);
-//	}
-//
-// ```
+// given its URI requires calling [RecentManager.LookupItem]:
+//
+//	GtkRecentManager *manager;
+//	GtkRecentInfo *info;
+//	GError *error = NULL;
+//
+//	manager = gtk_recent_manager_get_default ();
+//	info = gtk_recent_manager_lookup_item (manager, file_uri, &error);
+//	if (error)
+//	  {
+//	    g_warning ("Could not find the file: %s", error->message);
+//	    g_error_free (error);
+//	  }
+//	else
+//	 {
+//	   // Use the info object
+//	   gtk_recent_info_unref (info);
+//	 }
 //
 // In order to retrieve the list of recently used files, you can use
-// [method@Gtk.RecentManager.get_items], which returns a list of
-// [struct@Gtk.RecentInfo].
+// [RecentManager.GetItems], which returns a list of
+// [RecentInfo].
 //
 // Note that the maximum age of the recently used files list is
-// controllable through the [property@Gtk.Settings:gtk-recent-files-max-age]
+// controllable through the [Settings.GetPropertyGtkRecentFilesMaxAge]
 // property.
 type RecentManager struct {
 	gobject.Object
@@ -595,11 +684,11 @@ var xNewRecentManager func() uintptr
 //
 // Recent manager objects are used to handle the list of recently used
 // resources. A `GtkRecentManager` object monitors the recently used
-// resources list, and emits the [signal@Gtk.RecentManager::changed]
+// resources list, and emits the [RecentManager.ConnectChangedFunc]
 // signal each time something inside the list changes.
 //
 // `GtkRecentManager` objects are expensive: be sure to create them
-// only when needed. You should use [func@Gtk.RecentManager.get_default]
+// only when needed. You should use [RecentManagerGetDefault]
 // instead.
 func NewRecentManager() *RecentManager {
 	var cls *RecentManager
@@ -616,9 +705,9 @@ func NewRecentManager() *RecentManager {
 
 var xRecentManagerAddFull func(uintptr, string, *RecentData) bool
 
-// Adds a new resource, pointed by @uri, into the recently used
+// Adds a new resource, pointed by uri, into the recently used
 // resources list, using the metadata specified inside the
-// `GtkRecentData` passed in @recent_data.
+// `GtkRecentData` passed in recent_data.
 //
 // The passed URI will be used to identify this resource inside the
 // list.
@@ -643,15 +732,15 @@ func (x *RecentManager) AddFull(UriVar string, RecentDataVar *RecentData) bool {
 
 var xRecentManagerAddItem func(uintptr, string) bool
 
-// Adds a new resource, pointed by @uri, into the recently used
+// Adds a new resource, pointed by uri, into the recently used
 // resources list.
 //
 // This function automatically retrieves some of the needed
 // metadata and setting other metadata to common default values;
-// it then feeds the data to [method@Gtk.RecentManager.add_full].
+// it then feeds the data to [RecentManager.AddFull].
 //
-// See [method@Gtk.RecentManager.add_full] if you want to explicitly
-// define the metadata for the resource pointed by @uri.
+// See [RecentManager.AddFull] if you want to explicitly
+// define the metadata for the resource pointed by uri.
 func (x *RecentManager) AddItem(UriVar string) bool {
 
 	cret := xRecentManagerAddItem(x.GoPointer(), UriVar)
@@ -670,7 +759,7 @@ func (x *RecentManager) GetItems() *glib.List {
 var xRecentManagerHasItem func(uintptr, string) bool
 
 // Checks whether there is a recently used resource registered
-// with @uri inside the recent manager.
+// with uri inside the recent manager.
 func (x *RecentManager) HasItem(UriVar string) bool {
 
 	cret := xRecentManagerHasItem(x.GoPointer(), UriVar)
@@ -687,7 +776,7 @@ func (x *RecentManager) LookupItem(UriVar string) (*RecentInfo, error) {
 
 	cret := xRecentManagerLookupItem(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -695,7 +784,7 @@ func (x *RecentManager) LookupItem(UriVar string) (*RecentInfo, error) {
 
 var xRecentManagerMoveItem func(uintptr, string, uintptr, **glib.Error) bool
 
-// Changes the location of a recently used resource from @uri to @new_uri.
+// Changes the location of a recently used resource from uri to new_uri.
 //
 // Please note that this function will not affect the resource pointed
 // by the URIs, but only the URI used in the recently used resources list.
@@ -707,7 +796,7 @@ func (x *RecentManager) MoveItem(UriVar string, NewUriVar *string) (bool, error)
 
 	cret := xRecentManagerMoveItem(x.GoPointer(), UriVar, NewUriVarPtr, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -721,7 +810,7 @@ func (x *RecentManager) PurgeItems() (int, error) {
 
 	cret := xRecentManagerPurgeItems(x.GoPointer())
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -729,14 +818,14 @@ func (x *RecentManager) PurgeItems() (int, error) {
 
 var xRecentManagerRemoveItem func(uintptr, string, **glib.Error) bool
 
-// Removes a resource pointed by @uri from the recently used resources
+// Removes a resource pointed by uri from the recently used resources
 // list handled by a recent manager.
 func (x *RecentManager) RemoveItem(UriVar string) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xRecentManagerRemoveItem(x.GoPointer(), UriVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -783,29 +872,51 @@ func (x *RecentManager) GetPropertySize() int {
 // Emitted when the current recently used resources manager changes
 // its contents.
 //
-// This can happen either by calling [method@Gtk.RecentManager.add_item]
+// This can happen either by calling [RecentManager.AddItem]
 // or by another application.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *RecentManager) ConnectChanged(cb *func(RecentManager)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := RecentManager{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
+
+var xRecentManagerChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xRecentManagerChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(RecentManager))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := RecentManager{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *RecentManager) ConnectChangedFunc(cb func(RecentManager)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xRecentManagerChangedTrampoline, xRecentManagerChangedNewTrampoline, cb)
 }
 
 var xRecentManagerGetDefault func() uintptr
@@ -831,7 +942,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -840,48 +951,50 @@ func init() {
 
 	core.PuregoSafeRegister(&xRecentManagerErrorGLibType, libs, "gtk_recent_manager_error_get_type")
 
-	core.PuregoSafeRegister(&xRecentManagerErrorQuark, libs, "gtk_recent_manager_error_quark")
+	core.PuregoRegisterSince(&xRecentManagerErrorQuark, libs, "gtk_recent_manager_error_quark", "GTK", "")
 
 	core.PuregoSafeRegister(&xRecentInfoGLibType, libs, "gtk_recent_info_get_type")
 
-	core.PuregoSafeRegister(&xRecentInfoCreateAppInfo, libs, "gtk_recent_info_create_app_info")
-	core.PuregoSafeRegister(&xRecentInfoExists, libs, "gtk_recent_info_exists")
-	core.PuregoSafeRegister(&xRecentInfoGetAdded, libs, "gtk_recent_info_get_added")
-	core.PuregoSafeRegister(&xRecentInfoGetAge, libs, "gtk_recent_info_get_age")
-	core.PuregoSafeRegister(&xRecentInfoGetApplicationInfo, libs, "gtk_recent_info_get_application_info")
-	core.PuregoSafeRegister(&xRecentInfoGetApplications, libs, "gtk_recent_info_get_applications")
-	core.PuregoSafeRegister(&xRecentInfoGetDescription, libs, "gtk_recent_info_get_description")
-	core.PuregoSafeRegister(&xRecentInfoGetDisplayName, libs, "gtk_recent_info_get_display_name")
-	core.PuregoSafeRegister(&xRecentInfoGetGicon, libs, "gtk_recent_info_get_gicon")
-	core.PuregoSafeRegister(&xRecentInfoGetGroups, libs, "gtk_recent_info_get_groups")
-	core.PuregoSafeRegister(&xRecentInfoGetMimeType, libs, "gtk_recent_info_get_mime_type")
-	core.PuregoSafeRegister(&xRecentInfoGetModified, libs, "gtk_recent_info_get_modified")
-	core.PuregoSafeRegister(&xRecentInfoGetPrivateHint, libs, "gtk_recent_info_get_private_hint")
-	core.PuregoSafeRegister(&xRecentInfoGetShortName, libs, "gtk_recent_info_get_short_name")
-	core.PuregoSafeRegister(&xRecentInfoGetUri, libs, "gtk_recent_info_get_uri")
-	core.PuregoSafeRegister(&xRecentInfoGetUriDisplay, libs, "gtk_recent_info_get_uri_display")
-	core.PuregoSafeRegister(&xRecentInfoGetVisited, libs, "gtk_recent_info_get_visited")
-	core.PuregoSafeRegister(&xRecentInfoHasApplication, libs, "gtk_recent_info_has_application")
-	core.PuregoSafeRegister(&xRecentInfoHasGroup, libs, "gtk_recent_info_has_group")
-	core.PuregoSafeRegister(&xRecentInfoIsLocal, libs, "gtk_recent_info_is_local")
-	core.PuregoSafeRegister(&xRecentInfoLastApplication, libs, "gtk_recent_info_last_application")
-	core.PuregoSafeRegister(&xRecentInfoMatch, libs, "gtk_recent_info_match")
-	core.PuregoSafeRegister(&xRecentInfoRef, libs, "gtk_recent_info_ref")
-	core.PuregoSafeRegister(&xRecentInfoUnref, libs, "gtk_recent_info_unref")
+	core.PuregoRegisterSince(&xRecentInfoCreateAppInfo, libs, "gtk_recent_info_create_app_info", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoExists, libs, "gtk_recent_info_exists", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetAdded, libs, "gtk_recent_info_get_added", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetAge, libs, "gtk_recent_info_get_age", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetApplicationInfo, libs, "gtk_recent_info_get_application_info", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetApplications, libs, "gtk_recent_info_get_applications", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetDescription, libs, "gtk_recent_info_get_description", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetDisplayName, libs, "gtk_recent_info_get_display_name", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetGicon, libs, "gtk_recent_info_get_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetGroups, libs, "gtk_recent_info_get_groups", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetMimeType, libs, "gtk_recent_info_get_mime_type", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetModified, libs, "gtk_recent_info_get_modified", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetPrivateHint, libs, "gtk_recent_info_get_private_hint", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetShortName, libs, "gtk_recent_info_get_short_name", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetUri, libs, "gtk_recent_info_get_uri", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetUriDisplay, libs, "gtk_recent_info_get_uri_display", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoGetVisited, libs, "gtk_recent_info_get_visited", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoHasApplication, libs, "gtk_recent_info_has_application", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoHasGroup, libs, "gtk_recent_info_has_group", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoIsLocal, libs, "gtk_recent_info_is_local", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoLastApplication, libs, "gtk_recent_info_last_application", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoMatch, libs, "gtk_recent_info_match", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoRef, libs, "gtk_recent_info_ref", "GTK", "")
+	core.PuregoRegisterSince(&xRecentInfoUnref, libs, "gtk_recent_info_unref", "GTK", "")
 
 	core.PuregoSafeRegister(&xRecentManagerGLibType, libs, "gtk_recent_manager_get_type")
 
-	core.PuregoSafeRegister(&xNewRecentManager, libs, "gtk_recent_manager_new")
+	gobject.CheckClassSize("GtkRecentManagerClass", xRecentManagerGLibType, unsafe.Sizeof(RecentManagerClass{}))
+
+	core.PuregoRegisterSince(&xNewRecentManager, libs, "gtk_recent_manager_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xRecentManagerAddFull, libs, "gtk_recent_manager_add_full")
-	core.PuregoSafeRegister(&xRecentManagerAddItem, libs, "gtk_recent_manager_add_item")
-	core.PuregoSafeRegister(&xRecentManagerGetItems, libs, "gtk_recent_manager_get_items")
-	core.PuregoSafeRegister(&xRecentManagerHasItem, libs, "gtk_recent_manager_has_item")
-	core.PuregoSafeRegister(&xRecentManagerLookupItem, libs, "gtk_recent_manager_lookup_item")
-	core.PuregoSafeRegister(&xRecentManagerMoveItem, libs, "gtk_recent_manager_move_item")
-	core.PuregoSafeRegister(&xRecentManagerPurgeItems, libs, "gtk_recent_manager_purge_items")
-	core.PuregoSafeRegister(&xRecentManagerRemoveItem, libs, "gtk_recent_manager_remove_item")
+	core.PuregoRegisterSince(&xRecentManagerAddFull, libs, "gtk_recent_manager_add_full", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerAddItem, libs, "gtk_recent_manager_add_item", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerGetItems, libs, "gtk_recent_manager_get_items", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerHasItem, libs, "gtk_recent_manager_has_item", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerLookupItem, libs, "gtk_recent_manager_lookup_item", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerMoveItem, libs, "gtk_recent_manager_move_item", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerPurgeItems, libs, "gtk_recent_manager_purge_items", "GTK", "")
+	core.PuregoRegisterSince(&xRecentManagerRemoveItem, libs, "gtk_recent_manager_remove_item", "GTK", "")
 
-	core.PuregoSafeRegister(&xRecentManagerGetDefault, libs, "gtk_recent_manager_get_default")
+	core.PuregoRegisterSince(&xRecentManagerGetDefault, libs, "gtk_recent_manager_get_default", "GTK", "")
 
 }
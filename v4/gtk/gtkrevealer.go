@@ -2,7 +2,8 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -42,12 +43,93 @@ const (
 	RevealerTransitionTypeSwingDownValue RevealerTransitionType = 9
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x RevealerTransitionType) String() string {
+	switch x {
+	case RevealerTransitionTypeNoneValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_NONE"
+	case RevealerTransitionTypeCrossfadeValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_CROSSFADE"
+	case RevealerTransitionTypeSlideRightValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SLIDE_RIGHT"
+	case RevealerTransitionTypeSlideLeftValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SLIDE_LEFT"
+	case RevealerTransitionTypeSlideUpValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SLIDE_UP"
+	case RevealerTransitionTypeSlideDownValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SLIDE_DOWN"
+	case RevealerTransitionTypeSwingRightValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SWING_RIGHT"
+	case RevealerTransitionTypeSwingLeftValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SWING_LEFT"
+	case RevealerTransitionTypeSwingUpValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SWING_UP"
+	case RevealerTransitionTypeSwingDownValue:
+		return "GTK_REVEALER_TRANSITION_TYPE_SWING_DOWN"
+	}
+	return fmt.Sprintf("RevealerTransitionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RevealerTransitionType) Nick() string {
+	switch x {
+	case RevealerTransitionTypeNoneValue:
+		return "none"
+	case RevealerTransitionTypeCrossfadeValue:
+		return "crossfade"
+	case RevealerTransitionTypeSlideRightValue:
+		return "slide-right"
+	case RevealerTransitionTypeSlideLeftValue:
+		return "slide-left"
+	case RevealerTransitionTypeSlideUpValue:
+		return "slide-up"
+	case RevealerTransitionTypeSlideDownValue:
+		return "slide-down"
+	case RevealerTransitionTypeSwingRightValue:
+		return "swing-right"
+	case RevealerTransitionTypeSwingLeftValue:
+		return "swing-left"
+	case RevealerTransitionTypeSwingUpValue:
+		return "swing-up"
+	case RevealerTransitionTypeSwingDownValue:
+		return "swing-down"
+	}
+	return ""
+}
+
+// RevealerTransitionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RevealerTransitionTypeFromString(s string) (RevealerTransitionType, bool) {
+	switch s {
+	case "GTK_REVEALER_TRANSITION_TYPE_NONE", "none":
+		return RevealerTransitionTypeNoneValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_CROSSFADE", "crossfade":
+		return RevealerTransitionTypeCrossfadeValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SLIDE_RIGHT", "slide-right":
+		return RevealerTransitionTypeSlideRightValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SLIDE_LEFT", "slide-left":
+		return RevealerTransitionTypeSlideLeftValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SLIDE_UP", "slide-up":
+		return RevealerTransitionTypeSlideUpValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SLIDE_DOWN", "slide-down":
+		return RevealerTransitionTypeSlideDownValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SWING_RIGHT", "swing-right":
+		return RevealerTransitionTypeSwingRightValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SWING_LEFT", "swing-left":
+		return RevealerTransitionTypeSwingLeftValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SWING_UP", "swing-up":
+		return RevealerTransitionTypeSwingUpValue, true
+	case "GTK_REVEALER_TRANSITION_TYPE_SWING_DOWN", "swing-down":
+		return RevealerTransitionTypeSwingDownValue, true
+	}
+	return 0, false
+}
+
 // Animates the transition of its child from invisible to visible.
 //
 // The style of transition can be controlled with
-// [method@Gtk.Revealer.set_transition_type].
+// [Revealer.SetTransitionType].
 //
-// These animations respect the [property@Gtk.Settings:gtk-enable-animations]
+// These animations respect the [Settings.GetPropertyGtkEnableAnimations]
 // setting.
 //
 // # CSS nodes
@@ -55,11 +137,11 @@ const (
 // `GtkRevealer` has a single CSS node with name revealer.
 // When styling `GtkRevealer` using CSS, remember that it only hides its contents,
 // not itself. That means applied margin, padding and borders will be visible even
-// when the [property@Gtk.Revealer:reveal-child] property is set to %FALSE.
+// when the [Revealer.GetPropertyRevealChild] property is set to false.
 //
 // # Accessibility
 //
-// `GtkRevealer` uses the [enum@Gtk.AccessibleRole.group] role.
+// `GtkRevealer` uses the [AccessibleRoleGroupValue] role.
 //
 // The child of `GtkRevealer`, if set, is always available in the accessibility
 // tree, regardless of the state of the revealer widget.
@@ -98,7 +180,7 @@ func NewRevealer() *Revealer {
 
 var xRevealerGetChild func(uintptr) uintptr
 
-// Gets the child widget of @revealer.
+// Gets the child widget of revealer.
 func (x *Revealer) GetChild() *Widget {
 	var cls *Widget
 
@@ -129,10 +211,10 @@ var xRevealerGetRevealChild func(uintptr) bool
 
 // Returns whether the child is currently revealed.
 //
-// This function returns %TRUE as soon as the transition
+// This function returns true as soon as the transition
 // is to the revealed state is started. To learn whether
 // the child is fully revealed (ie the transition is completed),
-// use [method@Gtk.Revealer.get_child_revealed].
+// use [Revealer.GetChildRevealed].
 func (x *Revealer) GetRevealChild() bool {
 
 	cret := xRevealerGetRevealChild(x.GoPointer())
@@ -152,7 +234,7 @@ func (x *Revealer) GetTransitionDuration() uint {
 var xRevealerGetTransitionType func(uintptr) RevealerTransitionType
 
 // Gets the type of animation that will be used
-// for transitions in @revealer.
+// for transitions in revealer.
 func (x *Revealer) GetTransitionType() RevealerTransitionType {
 
 	cret := xRevealerGetTransitionType(x.GoPointer())
@@ -161,7 +243,7 @@ func (x *Revealer) GetTransitionType() RevealerTransitionType {
 
 var xRevealerSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @revealer.
+// Sets the child widget of revealer.
 func (x *Revealer) SetChild(ChildVar *Widget) {
 
 	xRevealerSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -173,7 +255,7 @@ var xRevealerSetRevealChild func(uintptr, bool)
 // Tells the `GtkRevealer` to reveal or conceal its child.
 //
 // The transition will be animated with the current
-// transition type of @revealer.
+// transition type of revealer.
 func (x *Revealer) SetRevealChild(RevealChildVar bool) {
 
 	xRevealerSetRevealChild(x.GoPointer(), RevealChildVar)
@@ -192,7 +274,7 @@ func (x *Revealer) SetTransitionDuration(DurationVar uint) {
 var xRevealerSetTransitionType func(uintptr, RevealerTransitionType)
 
 // Sets the type of animation that will be used for
-// transitions in @revealer.
+// transitions in revealer.
 //
 // Available types include various kinds of fades and slides.
 func (x *Revealer) SetTransitionType(TransitionVar RevealerTransitionType) {
@@ -415,7 +497,7 @@ func (x *Revealer) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -423,14 +505,13 @@ func (x *Revealer) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Revealer) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -454,19 +535,17 @@ func (x *Revealer) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Revealer) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -487,7 +566,7 @@ func (x *Revealer) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -496,14 +575,12 @@ func (x *Revealer) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Revealer) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -522,10 +599,10 @@ func (x *Revealer) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Revealer) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -537,7 +614,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -548,16 +625,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xRevealerGLibType, libs, "gtk_revealer_get_type")
 
-	core.PuregoSafeRegister(&xNewRevealer, libs, "gtk_revealer_new")
-
-	core.PuregoSafeRegister(&xRevealerGetChild, libs, "gtk_revealer_get_child")
-	core.PuregoSafeRegister(&xRevealerGetChildRevealed, libs, "gtk_revealer_get_child_revealed")
-	core.PuregoSafeRegister(&xRevealerGetRevealChild, libs, "gtk_revealer_get_reveal_child")
-	core.PuregoSafeRegister(&xRevealerGetTransitionDuration, libs, "gtk_revealer_get_transition_duration")
-	core.PuregoSafeRegister(&xRevealerGetTransitionType, libs, "gtk_revealer_get_transition_type")
-	core.PuregoSafeRegister(&xRevealerSetChild, libs, "gtk_revealer_set_child")
-	core.PuregoSafeRegister(&xRevealerSetRevealChild, libs, "gtk_revealer_set_reveal_child")
-	core.PuregoSafeRegister(&xRevealerSetTransitionDuration, libs, "gtk_revealer_set_transition_duration")
-	core.PuregoSafeRegister(&xRevealerSetTransitionType, libs, "gtk_revealer_set_transition_type")
+	core.PuregoRegisterSince(&xNewRevealer, libs, "gtk_revealer_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xRevealerGetChild, libs, "gtk_revealer_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerGetChildRevealed, libs, "gtk_revealer_get_child_revealed", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerGetRevealChild, libs, "gtk_revealer_get_reveal_child", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerGetTransitionDuration, libs, "gtk_revealer_get_transition_duration", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerGetTransitionType, libs, "gtk_revealer_get_transition_type", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerSetChild, libs, "gtk_revealer_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerSetRevealChild, libs, "gtk_revealer_set_reveal_child", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerSetTransitionDuration, libs, "gtk_revealer_set_transition_duration", "GTK", "")
+	core.PuregoRegisterSince(&xRevealerSetTransitionType, libs, "gtk_revealer_set_transition_type", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -29,10 +28,10 @@ func (x *RootInterface) GoPointer() uintptr {
 // The obvious example of a `GtkRoot` is `GtkWindow`.
 //
 // To get the display to which a `GtkRoot` belongs, use
-// [method@Gtk.Root.get_display].
+// [Root.GetDisplay].
 //
 // `GtkRoot` also maintains the location of keyboard focus inside its widget
-// hierarchy, with [method@Gtk.Root.set_focus] and [method@Gtk.Root.get_focus].
+// hierarchy, with [Root.SetFocus] and [Root.GetFocus].
 type Root interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -81,7 +80,7 @@ func (x *RootBase) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *RootBase) GetFocus() *Widget {
 	var cls *Widget
@@ -97,13 +96,13 @@ func (x *RootBase) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *RootBase) SetFocus(FocusVar *Widget) {
 
@@ -120,7 +119,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -129,8 +128,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xRootGLibType, libs, "gtk_root_get_type")
 
-	core.PuregoSafeRegister(&XGtkRootGetDisplay, libs, "gtk_root_get_display")
-	core.PuregoSafeRegister(&XGtkRootGetFocus, libs, "gtk_root_get_focus")
-	core.PuregoSafeRegister(&XGtkRootSetFocus, libs, "gtk_root_set_focus")
+	core.PuregoRegisterSince(&XGtkRootGetDisplay, libs, "gtk_root_get_display", "GTK", "")
+	core.PuregoRegisterSince(&XGtkRootGetFocus, libs, "gtk_root_get_focus", "GTK", "")
+	core.PuregoRegisterSince(&XGtkRootSetFocus, libs, "gtk_root_set_focus", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 
 // Function that formats the value of a scale.
 //
-// See [method@Gtk.Scale.set_format_value_func].
+// See [Scale.SetFormatValueFunc].
 type ScaleFormatValueFunc func(uintptr, float64, uintptr) string
 
 type ScaleClass struct {
@@ -37,7 +36,8 @@ func (x *ScaleClass) OverrideGetLayoutOffsets(cb func(*Scale, *int, *int)) {
 	if cb == nil {
 		x.xGetLayoutOffsets = 0
 	} else {
-		x.xGetLayoutOffsets = purego.NewCallback(func(ScaleVarp uintptr, XVarp *int, YVarp *int) {
+		x.xGetLayoutOffsets = core.NewCallback(func(ScaleVarp uintptr, XVarp *int, YVarp *int) {
+			defer core.RecoverPanic()
 			cb(ScaleNewFromInternalPtr(ScaleVarp), XVarp, YVarp)
 		})
 	}
@@ -49,7 +49,7 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 		return nil
 	}
 	var rawCallback func(ScaleVarp uintptr, XVarp *int, YVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xGetLayoutOffsets)
+	core.RegisterFunc(&rawCallback, x.xGetLayoutOffsets)
 	return func(ScaleVar *Scale, XVar *int, YVar *int) {
 		rawCallback(ScaleVar.GoPointer(), XVar, YVar)
 	}
@@ -57,18 +57,11 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 
 // Allows to select a numeric value with a slider control.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="scales-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkScale" src="scales.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // To use it, you’ll probably want to investigate the methods on its base
-// class, [class@Gtk.Range], in addition to the methods for `GtkScale` itself.
-// To set the value of a scale, you would normally use [method@Gtk.Range.set_value].
+// class, [Range], in addition to the methods for `GtkScale` itself.
+// To set the value of a scale, you would normally use [Range.SetValue].
 // To detect changes to the value, you would normally use the
-// [signal@Gtk.Range::value-changed] signal.
+// [Range.ConnectValueChangedFunc] signal.
 //
 // Note that using the same upper and lower bounds for the `GtkScale` (through
 // the `GtkRange` methods) will hide the slider itself. This is useful for
@@ -77,9 +70,9 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 //
 // # GtkScale as GtkBuildable
 //
-// `GtkScale` supports a custom `&lt;marks&gt;` element, which can contain multiple
-// `&lt;mark\&gt;` elements. The “value” and “position” attributes have the same
-// meaning as [method@Gtk.Scale.add_mark] parameters of the same name. If
+// `GtkScale` supports a custom `<marks>` element, which can contain multiple
+// `<mark\>` elements. The “value” and “position” attributes have the same
+// meaning as [Scale.AddMark] parameters of the same name. If
 // the element is not empty, its content is taken as the markup to show at
 // the mark. It can be translated with the usual ”translatable” and
 // “context” attributes.
@@ -88,35 +81,31 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 //
 // `GtkPopoverMenu` supports the following keyboard shortcuts:
 //
-//   - Arrow keys, &lt;kbd&gt;+&lt;/kbd&gt; and &lt;kbd&gt;-&lt;/kbd&gt; will increment or decrement
-//     by step, or by page when combined with &lt;kbd&gt;Ctrl&lt;/kbd&gt;.
-//   - &lt;kbd&gt;PgUp&lt;/kbd&gt; and &lt;kbd&gt;PgDn&lt;/kbd&gt; will increment or decrement by page.
-//   - &lt;kbd&gt;Home&lt;/kbd&gt; and &lt;kbd&gt;End&lt;/kbd&gt; will set the minimum or maximum value.
+//   - Arrow keys, + and - will increment or decrement
+//     by step, or by page when combined with Ctrl.
+//   - PgUp and PgDn will increment or decrement by page.
+//   - Home and End will set the minimum or maximum value.
 //
 // # CSS nodes
 //
-// ```
-// scale[.fine-tune][.marks-before][.marks-after]
-// ├── [value][.top][.right][.bottom][.left]
-// ├── marks.top
-// │   ├── mark
-// │   ┊    ├── [label]
-// │   ┊    ╰── indicator
-// ┊   ┊
-// │   ╰── mark
-// ├── marks.bottom
-// │   ├── mark
-// │   ┊    ├── indicator
-// │   ┊    ╰── [label]
-// ┊   ┊
-// │   ╰── mark
-// ╰── trough
-//
-//	├── [fill]
-//	├── [highlight]
-//	╰── slider
-//
-// ```
+//	scale[.fine-tune][.marks-before][.marks-after]
+//	├── [value][.top][.right][.bottom][.left]
+//	├── marks.top
+//	│   ├── mark
+//	│   ┊    ├── [label]
+//	│   ┊    ╰── indicator
+//	┊   ┊
+//	│   ╰── mark
+//	├── marks.bottom
+//	│   ├── mark
+//	│   ┊    ├── indicator
+//	│   ┊    ╰── [label]
+//	┊   ┊
+//	│   ╰── mark
+//	╰── trough
+//	    ├── [fill]
+//	    ├── [highlight]
+//	    ╰── slider
 //
 // `GtkScale` has a main CSS node with name scale and a subnode for its contents,
 // with subnodes named trough and slider.
@@ -124,11 +113,11 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 // The main node gets the style class .fine-tune added when the scale is in
 // 'fine-tuning' mode.
 //
-// If the scale has an origin (see [method@Gtk.Scale.set_has_origin]), there is
+// If the scale has an origin (see [Scale.SetHasOrigin]), there is
 // a subnode with name highlight below the trough node that is used for rendering
 // the highlighted part of the trough.
 //
-// If the scale is showing a fill level (see [method@Gtk.Range.set_show_fill_level]),
+// If the scale is showing a fill level (see [Range.SetShowFillLevel]),
 // there is a subnode with name fill below the trough node that is used for
 // rendering the filled in part of the trough.
 //
@@ -144,13 +133,13 @@ func (x *ScaleClass) GetGetLayoutOffsets() func(*Scale, *int, *int) {
 // The main CSS node gets the 'marks-before' and/or 'marks-after' style classes
 // added depending on what marks are present.
 //
-// If the scale is displaying the value (see [property@Gtk.Scale:draw-value]),
+// If the scale is displaying the value (see [Scale.GetPropertyDrawValue]),
 // there is subnode with name value. This node will get the .top or .bottom style
 // classes similar to the marks node.
 //
 // # Accessibility
 //
-// `GtkScale` uses the [enum@Gtk.AccessibleRole.slider] role.
+// `GtkScale` uses the [AccessibleRoleSliderValue] role.
 type Scale struct {
 	Range
 }
@@ -186,17 +175,17 @@ func NewScale(OrientationVar Orientation, AdjustmentVar *Adjustment) *Scale {
 
 var xNewScaleWithRange func(Orientation, float64, float64, float64) uintptr
 
-// Creates a new scale widget with a range from @min to @max.
+// Creates a new scale widget with a range from min to max.
 //
 // The returns scale will have the given orientation and will let the
-// user input a number between @min and @max (including @min and @max)
-// with the increment @step. @step must be nonzero; it’s the distance
+// user input a number between min and max (including min and max)
+// with the increment step. step must be nonzero; it’s the distance
 // the slider moves when using the arrow keys to adjust the scale
 // value.
 //
 // Note that the way in which the precision is derived works best if
-// @step is a power of ten. If the resulting precision is not suitable
-// for your needs, use [method@Gtk.Scale.set_digits] to correct it.
+// step is a power of ten. If the resulting precision is not suitable
+// for your needs, use [Scale.SetDigits] to correct it.
 func NewScaleWithRange(OrientationVar Orientation, MinVar float64, MaxVar float64, StepVar float64) *Scale {
 	var cls *Scale
 
@@ -213,15 +202,15 @@ func NewScaleWithRange(OrientationVar Orientation, MinVar float64, MaxVar float6
 
 var xScaleAddMark func(uintptr, float64, PositionType, uintptr)
 
-// Adds a mark at @value.
+// Adds a mark at value.
 //
 // A mark is indicated visually by drawing a tick mark next to the scale,
 // and GTK makes it easy for the user to position the scale exactly at the
 // marks value.
 //
-// If @markup is not %NULL, text is shown next to the tick mark.
+// If markup is not nil, text is shown next to the tick mark.
 //
-// To remove marks from a scale, use [method@Gtk.Scale.clear_marks].
+// To remove marks from a scale, use [Scale.ClearMarks].
 func (x *Scale) AddMark(ValueVar float64, PositionVar PositionType, MarkupVar *string) {
 
 	MarkupVarPtr := core.GStrdupNullable(MarkupVar)
@@ -296,7 +285,7 @@ var xScaleGetLayoutOffsets func(uintptr, *int, *int)
 // Remember when using the `PangoLayout` function you need to
 // convert to and from pixels using `PANGO_PIXELS()` or `PANGO_SCALE`.
 //
-// If the [property@Gtk.Scale:draw-value] property is %FALSE, the return
+// If the [Scale.GetPropertyDrawValue] property is false, the return
 // values are undefined.
 func (x *Scale) GetLayoutOffsets(XVar *int, YVar *int) {
 
@@ -319,13 +308,13 @@ var xScaleSetDigits func(uintptr, int)
 //
 // Also causes the value of the adjustment to be rounded to this number
 // of digits, so the retrieved value matches the displayed one, if
-// [property@Gtk.Scale:draw-value] is %TRUE when the value changes. If
-// you want to enforce rounding the value when [property@Gtk.Scale:draw-value]
-// is %FALSE, you can set [property@Gtk.Range:round-digits] instead.
+// [Scale.GetPropertyDrawValue] is true when the value changes. If
+// you want to enforce rounding the value when [Scale.GetPropertyDrawValue]
+// is false, you can set [Range.GetPropertyRoundDigits] instead.
 //
 // Note that rounding to a small number of digits can interfere with
 // the smooth autoscrolling that is built into `GtkScale`. As an alternative,
-// you can use [method@Gtk.Scale.set_format_value_func] to format the displayed
+// you can use [Scale.SetFormatValueFunc] to format the displayed
 // value yourself.
 func (x *Scale) SetDigits(DigitsVar int) {
 
@@ -345,14 +334,14 @@ func (x *Scale) SetDrawValue(DrawValueVar bool) {
 
 var xScaleSetFormatValueFunc func(uintptr, uintptr, uintptr, uintptr)
 
-// @func allows you to change how the scale value is displayed.
+// func allows you to change how the scale value is displayed.
 //
 // The given function will return an allocated string representing
-// @value. That string will then be used to display the scale's value.
+// value. That string will then be used to display the scale's value.
 //
-// If #NULL is passed as @func, the value will be displayed on
+// If NULL is passed as func, the value will be displayed on
 // its own, rounded according to the value of the
-// [property@Gtk.Scale:digits] property.
+// [Scale.GetPropertyDigits] property.
 func (x *Scale) SetFormatValueFunc(FuncVar *ScaleFormatValueFunc, UserDataVar uintptr, DestroyNotifyVar *glib.DestroyNotify) {
 
 	var FuncVarRef uintptr
@@ -362,11 +351,12 @@ func (x *Scale) SetFormatValueFunc(FuncVar *ScaleFormatValueFunc, UserDataVar ui
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 float64, arg2 uintptr) string {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -377,11 +367,12 @@ func (x *Scale) SetFormatValueFunc(FuncVar *ScaleFormatValueFunc, UserDataVar ui
 			DestroyNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyNotifyVar
 				cbFn(arg0)
 			}
-			DestroyNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
+			DestroyNotifyVarRef = core.NewCallback(fcb)
+			DestroyNotifyVarRef = glib.SaveCallbackWithClosure(DestroyNotifyVarPtr, DestroyNotifyVarRef, DestroyNotifyVar)
 		}
 	}
 
@@ -393,7 +384,7 @@ var xScaleSetHasOrigin func(uintptr, bool)
 
 // Sets whether the scale has an origin.
 //
-// If [property@Gtk.Scale:has-origin] is set to %TRUE (the default),
+// If [Scale.GetPropertyHasOrigin] is set to true (the default),
 // the scale will highlight the part of the trough between the origin
 // (bottom or left side) and the current value.
 func (x *Scale) SetHasOrigin(HasOriginVar bool) {
@@ -634,7 +625,7 @@ func (x *Scale) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -642,14 +633,13 @@ func (x *Scale) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Scale) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -673,19 +663,17 @@ func (x *Scale) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Scale) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -706,7 +694,7 @@ func (x *Scale) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -715,14 +703,12 @@ func (x *Scale) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Scale) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -741,24 +727,24 @@ func (x *Scale) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Scale) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Scale) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Scale) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -770,7 +756,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -779,21 +765,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xScaleGLibType, libs, "gtk_scale_get_type")
 
-	core.PuregoSafeRegister(&xNewScale, libs, "gtk_scale_new")
-	core.PuregoSafeRegister(&xNewScaleWithRange, libs, "gtk_scale_new_with_range")
-
-	core.PuregoSafeRegister(&xScaleAddMark, libs, "gtk_scale_add_mark")
-	core.PuregoSafeRegister(&xScaleClearMarks, libs, "gtk_scale_clear_marks")
-	core.PuregoSafeRegister(&xScaleGetDigits, libs, "gtk_scale_get_digits")
-	core.PuregoSafeRegister(&xScaleGetDrawValue, libs, "gtk_scale_get_draw_value")
-	core.PuregoSafeRegister(&xScaleGetHasOrigin, libs, "gtk_scale_get_has_origin")
-	core.PuregoSafeRegister(&xScaleGetLayout, libs, "gtk_scale_get_layout")
-	core.PuregoSafeRegister(&xScaleGetLayoutOffsets, libs, "gtk_scale_get_layout_offsets")
-	core.PuregoSafeRegister(&xScaleGetValuePos, libs, "gtk_scale_get_value_pos")
-	core.PuregoSafeRegister(&xScaleSetDigits, libs, "gtk_scale_set_digits")
-	core.PuregoSafeRegister(&xScaleSetDrawValue, libs, "gtk_scale_set_draw_value")
-	core.PuregoSafeRegister(&xScaleSetFormatValueFunc, libs, "gtk_scale_set_format_value_func")
-	core.PuregoSafeRegister(&xScaleSetHasOrigin, libs, "gtk_scale_set_has_origin")
-	core.PuregoSafeRegister(&xScaleSetValuePos, libs, "gtk_scale_set_value_pos")
+	gobject.CheckClassSize("GtkScaleClass", xScaleGLibType, unsafe.Sizeof(ScaleClass{}))
+
+	core.PuregoRegisterSince(&xNewScale, libs, "gtk_scale_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewScaleWithRange, libs, "gtk_scale_new_with_range", "GTK", "")
+
+	core.PuregoRegisterSince(&xScaleAddMark, libs, "gtk_scale_add_mark", "GTK", "")
+	core.PuregoRegisterSince(&xScaleClearMarks, libs, "gtk_scale_clear_marks", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetDigits, libs, "gtk_scale_get_digits", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetDrawValue, libs, "gtk_scale_get_draw_value", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetHasOrigin, libs, "gtk_scale_get_has_origin", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetLayout, libs, "gtk_scale_get_layout", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetLayoutOffsets, libs, "gtk_scale_get_layout_offsets", "GTK", "")
+	core.PuregoRegisterSince(&xScaleGetValuePos, libs, "gtk_scale_get_value_pos", "GTK", "")
+	core.PuregoRegisterSince(&xScaleSetDigits, libs, "gtk_scale_set_digits", "GTK", "")
+	core.PuregoRegisterSince(&xScaleSetDrawValue, libs, "gtk_scale_set_draw_value", "GTK", "")
+	core.PuregoRegisterSince(&xScaleSetFormatValueFunc, libs, "gtk_scale_set_format_value_func", "GTK", "")
+	core.PuregoRegisterSince(&xScaleSetHasOrigin, libs, "gtk_scale_set_has_origin", "GTK", "")
+	core.PuregoRegisterSince(&xScaleSetValuePos, libs, "gtk_scale_set_value_pos", "GTK", "")
 
 }
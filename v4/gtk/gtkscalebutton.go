@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +30,8 @@ func (x *ScaleButtonClass) OverrideValueChanged(cb func(*ScaleButton, float64))
 	if cb == nil {
 		x.xValueChanged = 0
 	} else {
-		x.xValueChanged = purego.NewCallback(func(ButtonVarp uintptr, ValueVarp float64) {
+		x.xValueChanged = core.NewCallback(func(ButtonVarp uintptr, ValueVarp float64) {
+			defer core.RecoverPanic()
 			cb(ScaleButtonNewFromInternalPtr(ButtonVarp), ValueVarp)
 		})
 	}
@@ -43,7 +43,7 @@ func (x *ScaleButtonClass) GetValueChanged() func(*ScaleButton, float64) {
 		return nil
 	}
 	var rawCallback func(ButtonVarp uintptr, ValueVarp float64)
-	purego.RegisterFunc(&rawCallback, x.xValueChanged)
+	core.RegisterFunc(&rawCallback, x.xValueChanged)
 	return func(ButtonVar *ScaleButton, ValueVar float64) {
 		rawCallback(ButtonVar.GoPointer(), ValueVar)
 	}
@@ -52,24 +52,20 @@ func (x *ScaleButtonClass) GetValueChanged() func(*ScaleButton, float64) {
 // Provides a button which pops up a scale widget.
 //
 // This kind of widget is commonly used for volume controls in multimedia
-// applications, and GTK provides a [class@Gtk.VolumeButton] subclass that
+// applications, and GTK provides a [VolumeButton] subclass that
 // is tailored for this use case.
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.ScaleButton::popup]
+// - [ScaleButton.ConnectPopupFunc]
 //
 // # CSS nodes
 //
-// ```
-// scalebutton.scale
-// ╰── button.toggle
-//
-//	╰── &lt;icon&gt;
-//
-// ```
+//	scalebutton.scale
+//	╰── button.toggle
+//	    ╰── <icon>
 //
 // `GtkScaleButton` has a single CSS node with name scalebutton and `.scale`
 // style class, and contains a `button` node with a `.toggle` style class.
@@ -93,8 +89,8 @@ var xNewScaleButton func(float64, float64, float64, []string) uintptr
 
 // Creates a `GtkScaleButton`.
 //
-// The new scale button has a range between @min and @max,
-// with a stepping of @step.
+// The new scale button has a range between min and max,
+// with a stepping of step.
 func NewScaleButton(MinVar float64, MaxVar float64, StepVar float64, IconsVar []string) *ScaleButton {
 	var cls *ScaleButton
 
@@ -113,7 +109,7 @@ var xScaleButtonGetActive func(uintptr) bool
 
 // Queries a `GtkScaleButton` and returns its current state.
 //
-// Returns %TRUE if the scale button is pressed in and %FALSE
+// Returns true if the scale button is pressed in and false
 // if it is raised.
 func (x *ScaleButton) GetActive() bool {
 
@@ -125,7 +121,7 @@ var xScaleButtonGetAdjustment func(uintptr) uintptr
 
 // Gets the `GtkAdjustment` associated with the `GtkScaleButton`’s scale.
 //
-// See [method@Gtk.Range.get_adjustment] for details.
+// See [Range.GetAdjustment] for details.
 func (x *ScaleButton) GetAdjustment() *Adjustment {
 	var cls *Adjustment
 
@@ -214,7 +210,7 @@ var xScaleButtonSetAdjustment func(uintptr, uintptr)
 // Sets the `GtkAdjustment` to be used as a model
 // for the `GtkScaleButton`’s scale.
 //
-// See [method@Gtk.Range.set_adjustment] for details.
+// See [Range.SetAdjustment] for details.
 func (x *ScaleButton) SetAdjustment(AdjustmentVar *Adjustment) {
 
 	xScaleButtonSetAdjustment(x.GoPointer(), AdjustmentVar.GoPointer())
@@ -246,7 +242,7 @@ var xScaleButtonSetValue func(uintptr, float64)
 // If the value is outside the minimum or maximum range values,
 // it will be clamped to fit inside them.
 //
-// The scale button emits the [signal@Gtk.ScaleButton::value-changed]
+// The scale button emits the [ScaleButton.ConnectValueChangedFunc]
 // signal if the value changes.
 func (x *ScaleButton) SetValue(ValueVar float64) {
 
@@ -299,9 +295,9 @@ func (x *ScaleButton) GetPropertyHasFrame() bool {
 // be used for all the other values, spread evenly over the
 // range of values.
 //
-// If there's only one icon name in the @icons array, it will
+// If there's only one icon name in the icons array, it will
 // be used for all the values. If only two icon names are in
-// the @icons array, the first one will be used for the bottom
+// the icons array, the first one will be used for the bottom
 // 50% of the scale, and the second one for the top 50%.
 //
 // It is recommended to use at least 3 icons so that the
@@ -323,9 +319,9 @@ func (x *ScaleButton) SetPropertyIcons(value []string) {
 // be used for all the other values, spread evenly over the
 // range of values.
 //
-// If there's only one icon name in the @icons array, it will
+// If there's only one icon name in the icons array, it will
 // be used for all the values. If only two icon names are in
-// the @icons array, the first one will be used for the bottom
+// the icons array, the first one will be used for the bottom
 // 50% of the scale, and the second one for the top 50%.
 //
 // It is recommended to use at least 3 icons so that the
@@ -356,83 +352,149 @@ func (x *ScaleButton) GetPropertyValue() float64 {
 
 // Emitted to dismiss the popup.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;Escape&lt;/kbd&gt;.
+// The default binding for this signal is Escape.
+//
+// Deprecated: use ConnectPopdownFunc, which also accepts method values and closures.
 func (x *ScaleButton) ConnectPopdown(cb *func(ScaleButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "popdown", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ScaleButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "popdown", cbRefPtr, cbPtr)
+}
+
+var xScaleButtonPopdownTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xScaleButtonPopdownNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScaleButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScaleButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "popdown", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPopdownFunc connects cb to the "popdown" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScaleButton) ConnectPopdownFunc(cb func(ScaleButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "popdown", &xScaleButtonPopdownTrampoline, xScaleButtonPopdownNewTrampoline, cb)
 }
 
 // Emitted to popup the scale widget.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default bindings for this signal are Space,
+// Enter and Return.
 //
-// The default bindings for this signal are &lt;kbd&gt;Space&lt;/kbd&gt;,
-// &lt;kbd&gt;Enter&lt;/kbd&gt; and &lt;kbd&gt;Return&lt;/kbd&gt;.
+// Deprecated: use ConnectPopupFunc, which also accepts method values and closures.
 func (x *ScaleButton) ConnectPopup(cb *func(ScaleButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "popup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ScaleButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "popup", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xScaleButtonPopupTrampoline uintptr
+
+func xScaleButtonPopupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScaleButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScaleButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "popup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPopupFunc connects cb to the "popup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScaleButton) ConnectPopupFunc(cb func(ScaleButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "popup", &xScaleButtonPopupTrampoline, xScaleButtonPopupNewTrampoline, cb)
 }
 
 // Emitted when the value field has changed.
+//
+// Deprecated: use ConnectValueChangedFunc, which also accepts method values and closures.
 func (x *ScaleButton) ConnectValueChanged(cb *func(ScaleButton, float64)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp float64) {
+			defer core.RecoverPanic()
+			fa := ScaleButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "value-changed", cbRefPtr, cbPtr)
+}
+
+var xScaleButtonValueChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ValueVarp float64) {
+func xScaleButtonValueChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ValueVarp float64, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScaleButton, float64))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScaleButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectValueChangedFunc connects cb to the "value-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScaleButton) ConnectValueChangedFunc(cb func(ScaleButton, float64)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "value-changed", &xScaleButtonValueChangedTrampoline, xScaleButtonValueChangedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -596,7 +658,7 @@ func (x *ScaleButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -604,14 +666,13 @@ func (x *ScaleButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ScaleButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -635,19 +696,17 @@ func (x *ScaleButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ScaleButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -668,7 +727,7 @@ func (x *ScaleButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -677,14 +736,12 @@ func (x *ScaleButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ScaleButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -703,24 +760,24 @@ func (x *ScaleButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ScaleButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ScaleButton) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ScaleButton) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -732,7 +789,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -741,18 +798,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xScaleButtonGLibType, libs, "gtk_scale_button_get_type")
 
-	core.PuregoSafeRegister(&xNewScaleButton, libs, "gtk_scale_button_new")
-
-	core.PuregoSafeRegister(&xScaleButtonGetActive, libs, "gtk_scale_button_get_active")
-	core.PuregoSafeRegister(&xScaleButtonGetAdjustment, libs, "gtk_scale_button_get_adjustment")
-	core.PuregoSafeRegister(&xScaleButtonGetHasFrame, libs, "gtk_scale_button_get_has_frame")
-	core.PuregoSafeRegister(&xScaleButtonGetMinusButton, libs, "gtk_scale_button_get_minus_button")
-	core.PuregoSafeRegister(&xScaleButtonGetPlusButton, libs, "gtk_scale_button_get_plus_button")
-	core.PuregoSafeRegister(&xScaleButtonGetPopup, libs, "gtk_scale_button_get_popup")
-	core.PuregoSafeRegister(&xScaleButtonGetValue, libs, "gtk_scale_button_get_value")
-	core.PuregoSafeRegister(&xScaleButtonSetAdjustment, libs, "gtk_scale_button_set_adjustment")
-	core.PuregoSafeRegister(&xScaleButtonSetHasFrame, libs, "gtk_scale_button_set_has_frame")
-	core.PuregoSafeRegister(&xScaleButtonSetIcons, libs, "gtk_scale_button_set_icons")
-	core.PuregoSafeRegister(&xScaleButtonSetValue, libs, "gtk_scale_button_set_value")
+	gobject.CheckClassSize("GtkScaleButtonClass", xScaleButtonGLibType, unsafe.Sizeof(ScaleButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewScaleButton, libs, "gtk_scale_button_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xScaleButtonGetActive, libs, "gtk_scale_button_get_active", "GTK", "4.10")
+	core.PuregoRegisterSince(&xScaleButtonGetAdjustment, libs, "gtk_scale_button_get_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonGetHasFrame, libs, "gtk_scale_button_get_has_frame", "GTK", "4.14")
+	core.PuregoRegisterSince(&xScaleButtonGetMinusButton, libs, "gtk_scale_button_get_minus_button", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonGetPlusButton, libs, "gtk_scale_button_get_plus_button", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonGetPopup, libs, "gtk_scale_button_get_popup", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonGetValue, libs, "gtk_scale_button_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonSetAdjustment, libs, "gtk_scale_button_set_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonSetHasFrame, libs, "gtk_scale_button_set_has_frame", "GTK", "4.14")
+	core.PuregoRegisterSince(&xScaleButtonSetIcons, libs, "gtk_scale_button_set_icons", "GTK", "")
+	core.PuregoRegisterSince(&xScaleButtonSetValue, libs, "gtk_scale_button_set_value", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -14,7 +13,7 @@ import (
 type ScrollableInterface struct {
 	_ structs.HostLayout
 
-	BaseIface uintptr
+	BaseIface gobject.TypeInterface
 
 	xGetBorder uintptr
 }
@@ -28,7 +27,8 @@ func (x *ScrollableInterface) OverrideGetBorder(cb func(Scrollable, *Border) boo
 	if cb == nil {
 		x.xGetBorder = 0
 	} else {
-		x.xGetBorder = purego.NewCallback(func(ScrollableVarp uintptr, BorderVarp *Border) bool {
+		x.xGetBorder = core.NewCallback(func(ScrollableVarp uintptr, BorderVarp *Border) bool {
+			defer core.RecoverPanic()
 			return cb(&ScrollableBase{Ptr: ScrollableVarp}, BorderVarp)
 		})
 	}
@@ -40,7 +40,7 @@ func (x *ScrollableInterface) GetGetBorder() func(Scrollable, *Border) bool {
 		return nil
 	}
 	var rawCallback func(ScrollableVarp uintptr, BorderVarp *Border) bool
-	purego.RegisterFunc(&rawCallback, x.xGetBorder)
+	core.RegisterFunc(&rawCallback, x.xGetBorder)
 	return func(ScrollableVar Scrollable, BorderVar *Border) bool {
 		return rawCallback(ScrollableVar.GoPointer(), BorderVar)
 	}
@@ -49,30 +49,30 @@ func (x *ScrollableInterface) GetGetBorder() func(Scrollable, *Border) bool {
 // An interface for widgets with native scrolling ability.
 //
 // To implement this interface you should override the
-// [property@Gtk.Scrollable:hadjustment] and
-// [property@Gtk.Scrollable:vadjustment] properties.
+// Scrollable:hadjustment and
+// Scrollable:vadjustment properties.
 //
-// ## Creating a scrollable widget
+// # Creating a scrollable widget
 //
 // All scrollable widgets should do the following.
 //
 //   - When a parent widget sets the scrollable child widget’s adjustments,
-//     the widget should connect to the [signal@Gtk.Adjustment::value-changed]
+//     the widget should connect to the [Adjustment.ConnectValueChangedFunc]
 //     signal. The child widget should then populate the adjustments’ properties
 //     as soon as possible, which usually means queueing an allocation right away
-//     and populating the properties in the [vfunc@Gtk.Widget.size_allocate]
+//     and populating the properties in the Widget.size_allocate
 //     implementation.
 //
 //   - Because its preferred size is the size for a fully expanded widget,
 //     the scrollable widget must be able to cope with underallocations.
 //     This means that it must accept any value passed to its
-//     [vfunc@Gtk.Widget.size_allocate] implementation.
+//     Widget.size_allocate implementation.
 //
 //   - When the parent allocates space to the scrollable child widget,
 //     the widget must ensure the adjustments’ property values are correct and up
-//     to date, for example using [method@Gtk.Adjustment.configure].
+//     to date, for example using [Adjustment.Configure].
 //
-//   - When any of the adjustments emits the [signal@Gtk.Adjustment::value-changed]
+//   - When any of the adjustments emits the [Adjustment.ConnectValueChangedFunc]
 //     signal, the scrollable widget should scroll its contents.
 type Scrollable interface {
 	GoPointer() uintptr
@@ -109,6 +109,26 @@ func (x *ScrollableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementScrollable adds the Scrollable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetBorder(*Border) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ScrollableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementScrollable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ScrollableGLibType(), func(iface *ScrollableInterface) {
+		if _, ok := any(zero).(interface{ GetBorder(*Border) bool }); ok {
+			iface.OverrideGetBorder(func(self Scrollable, arg0 *Border) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetBorder(*Border) bool })
+				return impl.GetBorder(arg0)
+			})
+		}
+	})
+}
+
 // Returns the size of a non-scrolling border around the
 // outside of the scrollable.
 //
@@ -214,7 +234,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -223,14 +243,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xScrollableGLibType, libs, "gtk_scrollable_get_type")
 
-	core.PuregoSafeRegister(&XGtkScrollableGetBorder, libs, "gtk_scrollable_get_border")
-	core.PuregoSafeRegister(&XGtkScrollableGetHadjustment, libs, "gtk_scrollable_get_hadjustment")
-	core.PuregoSafeRegister(&XGtkScrollableGetHscrollPolicy, libs, "gtk_scrollable_get_hscroll_policy")
-	core.PuregoSafeRegister(&XGtkScrollableGetVadjustment, libs, "gtk_scrollable_get_vadjustment")
-	core.PuregoSafeRegister(&XGtkScrollableGetVscrollPolicy, libs, "gtk_scrollable_get_vscroll_policy")
-	core.PuregoSafeRegister(&XGtkScrollableSetHadjustment, libs, "gtk_scrollable_set_hadjustment")
-	core.PuregoSafeRegister(&XGtkScrollableSetHscrollPolicy, libs, "gtk_scrollable_set_hscroll_policy")
-	core.PuregoSafeRegister(&XGtkScrollableSetVadjustment, libs, "gtk_scrollable_set_vadjustment")
-	core.PuregoSafeRegister(&XGtkScrollableSetVscrollPolicy, libs, "gtk_scrollable_set_vscroll_policy")
+	gobject.CheckClassSize("GtkScrollableInterface", xScrollableGLibType, unsafe.Sizeof(ScrollableInterface{}))
+
+	core.PuregoRegisterSince(&XGtkScrollableGetBorder, libs, "gtk_scrollable_get_border", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableGetHadjustment, libs, "gtk_scrollable_get_hadjustment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableGetHscrollPolicy, libs, "gtk_scrollable_get_hscroll_policy", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableGetVadjustment, libs, "gtk_scrollable_get_vadjustment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableGetVscrollPolicy, libs, "gtk_scrollable_get_vscroll_policy", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableSetHadjustment, libs, "gtk_scrollable_set_hadjustment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableSetHscrollPolicy, libs, "gtk_scrollable_set_hscroll_policy", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableSetVadjustment, libs, "gtk_scrollable_set_vadjustment", "GTK", "")
+	core.PuregoRegisterSince(&XGtkScrollableSetVscrollPolicy, libs, "gtk_scrollable_set_vscroll_policy", "GTK", "")
 
 }
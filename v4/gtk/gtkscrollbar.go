@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,37 +9,26 @@ import (
 
 // Shows a horizontal or vertical scrollbar.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="scrollbar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkScrollbar" src="scrollbar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Its position and movement are controlled by the adjustment that is passed to
-// or created by [ctor@Gtk.Scrollbar.new]. See [class@Gtk.Adjustment] for more
-// details. The [property@Gtk.Adjustment:value] field sets the position of the
-// thumb and must be between [property@Gtk.Adjustment:lower] and
-// [property@Gtk.Adjustment:upper] - [property@Gtk.Adjustment:page-size].
-// The [property@Gtk.Adjustment:page-size] represents the size of the visible
+// or created by [NewScrollbar]. See [Adjustment] for more
+// details. The [Adjustment.GetPropertyValue] field sets the position of the
+// thumb and must be between [Adjustment.GetPropertyLower] and
+// [Adjustment.GetPropertyUpper] - [Adjustment.GetPropertyPageSize].
+// The [Adjustment.GetPropertyPageSize] represents the size of the visible
 // scrollable area.
 //
-// The fields [property@Gtk.Adjustment:step-increment] and
-// [property@Gtk.Adjustment:page-increment] fields are added to or subtracted
-// from the [property@Gtk.Adjustment:value] when the user asks to move by a step
+// The fields [Adjustment.GetPropertyStepIncrement] and
+// [Adjustment.GetPropertyPageIncrement] fields are added to or subtracted
+// from the [Adjustment.GetPropertyValue] when the user asks to move by a step
 // (using e.g. the cursor arrow keys) or by a page (using e.g. the Page Down/Up
 // keys).
 //
 // # CSS nodes
 //
-// ```
-// scrollbar
-// ╰── range[.fine-tune]
-//
-//	╰── trough
-//	    ╰── slider
-//
-// ```
+//	scrollbar
+//	╰── range[.fine-tune]
+//	    ╰── trough
+//	        ╰── slider
 //
 // `GtkScrollbar` has a main CSS node with name scrollbar and a subnode for its
 // contents. The main node gets the .horizontal or .vertical style classes applied,
@@ -50,13 +38,13 @@ import (
 // in 'fine-tuning' mode.
 //
 // Other style classes that may be added to scrollbars inside
-// [class@Gtk.ScrolledWindow] include the positional classes (.left, .right,
+// [ScrolledWindow] include the positional classes (.left, .right,
 // .top, .bottom) and style classes related to overlay scrolling (.overlay-indicator,
 // .dragging, .hovering).
 //
 // # Accessibility
 //
-// `GtkScrollbar` uses the [enum@Gtk.AccessibleRole.scrollbar] role.
+// `GtkScrollbar` uses the [AccessibleRoleScrollbarValue] role.
 type Scrollbar struct {
 	Widget
 }
@@ -288,7 +276,7 @@ func (x *Scrollbar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -296,14 +284,13 @@ func (x *Scrollbar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Scrollbar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -327,19 +314,17 @@ func (x *Scrollbar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Scrollbar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -360,7 +345,7 @@ func (x *Scrollbar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -369,14 +354,12 @@ func (x *Scrollbar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Scrollbar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -395,24 +378,24 @@ func (x *Scrollbar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Scrollbar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *Scrollbar) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *Scrollbar) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -424,7 +407,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -433,9 +416,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xScrollbarGLibType, libs, "gtk_scrollbar_get_type")
 
-	core.PuregoSafeRegister(&xNewScrollbar, libs, "gtk_scrollbar_new")
+	core.PuregoRegisterSince(&xNewScrollbar, libs, "gtk_scrollbar_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xScrollbarGetAdjustment, libs, "gtk_scrollbar_get_adjustment")
-	core.PuregoSafeRegister(&xScrollbarSetAdjustment, libs, "gtk_scrollbar_set_adjustment")
+	core.PuregoRegisterSince(&xScrollbarGetAdjustment, libs, "gtk_scrollbar_get_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScrollbarSetAdjustment, libs, "gtk_scrollbar_set_adjustment", "GTK", "")
 
 }
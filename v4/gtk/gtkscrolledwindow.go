@@ -2,9 +2,9 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -39,6 +39,51 @@ const (
 	CornerBottomRightValue CornerType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CornerType) String() string {
+	switch x {
+	case CornerTopLeftValue:
+		return "GTK_CORNER_TOP_LEFT"
+	case CornerBottomLeftValue:
+		return "GTK_CORNER_BOTTOM_LEFT"
+	case CornerTopRightValue:
+		return "GTK_CORNER_TOP_RIGHT"
+	case CornerBottomRightValue:
+		return "GTK_CORNER_BOTTOM_RIGHT"
+	}
+	return fmt.Sprintf("CornerType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CornerType) Nick() string {
+	switch x {
+	case CornerTopLeftValue:
+		return "top-left"
+	case CornerBottomLeftValue:
+		return "bottom-left"
+	case CornerTopRightValue:
+		return "top-right"
+	case CornerBottomRightValue:
+		return "bottom-right"
+	}
+	return ""
+}
+
+// CornerTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CornerTypeFromString(s string) (CornerType, bool) {
+	switch s {
+	case "GTK_CORNER_TOP_LEFT", "top-left":
+		return CornerTopLeftValue, true
+	case "GTK_CORNER_BOTTOM_LEFT", "bottom-left":
+		return CornerBottomLeftValue, true
+	case "GTK_CORNER_TOP_RIGHT", "top-right":
+		return CornerTopRightValue, true
+	case "GTK_CORNER_BOTTOM_RIGHT", "bottom-right":
+		return CornerBottomRightValue, true
+	}
+	return 0, false
+}
+
 // Determines how the size should be computed to achieve the one of the
 // visibility mode for the scrollbars.
 type PolicyType int
@@ -66,35 +111,73 @@ const (
 	PolicyExternalValue PolicyType = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x PolicyType) String() string {
+	switch x {
+	case PolicyAlwaysValue:
+		return "GTK_POLICY_ALWAYS"
+	case PolicyAutomaticValue:
+		return "GTK_POLICY_AUTOMATIC"
+	case PolicyNeverValue:
+		return "GTK_POLICY_NEVER"
+	case PolicyExternalValue:
+		return "GTK_POLICY_EXTERNAL"
+	}
+	return fmt.Sprintf("PolicyType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x PolicyType) Nick() string {
+	switch x {
+	case PolicyAlwaysValue:
+		return "always"
+	case PolicyAutomaticValue:
+		return "automatic"
+	case PolicyNeverValue:
+		return "never"
+	case PolicyExternalValue:
+		return "external"
+	}
+	return ""
+}
+
+// PolicyTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func PolicyTypeFromString(s string) (PolicyType, bool) {
+	switch s {
+	case "GTK_POLICY_ALWAYS", "always":
+		return PolicyAlwaysValue, true
+	case "GTK_POLICY_AUTOMATIC", "automatic":
+		return PolicyAutomaticValue, true
+	case "GTK_POLICY_NEVER", "never":
+		return PolicyNeverValue, true
+	case "GTK_POLICY_EXTERNAL", "external":
+		return PolicyExternalValue, true
+	}
+	return 0, false
+}
+
 // Makes its child scrollable.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="scrolledwindow-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkScrolledWindow" src="scrolledwindow.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It does so using either internally added scrollbars or externally
 // associated adjustments, and optionally draws a frame around the child.
 //
 // Widgets with native scrolling support, i.e. those whose classes implement
-// the [iface@Gtk.Scrollable] interface, are added directly. For other types
-// of widget, the class [class@Gtk.Viewport] acts as an adaptor, giving
-// scrollability to other widgets. [method@Gtk.ScrolledWindow.set_child]
+// the [Scrollable] interface, are added directly. For other types
+// of widget, the class [Viewport] acts as an adaptor, giving
+// scrollability to other widgets. [ScrolledWindow.SetChild]
 // intelligently accounts for whether or not the added child is a `GtkScrollable`.
 // If it isn’t, then it wraps the child in a `GtkViewport`. Therefore, you can
 // just add any child widget and not worry about the details.
 //
-// If [method@Gtk.ScrolledWindow.set_child] has added a `GtkViewport` for you,
+// If [ScrolledWindow.SetChild] has added a `GtkViewport` for you,
 // it will be automatically removed when you unset the child.
-// Unless [property@Gtk.ScrolledWindow:hscrollbar-policy] and
-// [property@Gtk.ScrolledWindow:vscrollbar-policy] are %GTK_POLICY_NEVER or
-// %GTK_POLICY_EXTERNAL, `GtkScrolledWindow` adds internal `GtkScrollbar` widgets
+// Unless ScrolledWindow:hscrollbar-policy and
+// ScrolledWindow:vscrollbar-policy are [PolicyNeverValue] or
+// [PolicyExternalValue], `GtkScrolledWindow` adds internal `GtkScrollbar` widgets
 // around its child. The scroll position of the child, and if applicable the
-// scrollbars, is controlled by the [property@Gtk.ScrolledWindow:hadjustment]
-// and [property@Gtk.ScrolledWindow:vadjustment] that are associated with the
-// `GtkScrolledWindow`. See the docs on [class@Gtk.Scrollbar] for the details,
+// scrollbars, is controlled by the ScrolledWindow:hadjustment
+// and ScrolledWindow:vadjustment that are associated with the
+// `GtkScrolledWindow`. See the docs on [Scrollbar] for the details,
 // but note that the “step_increment” and “page_increment” fields are only
 // effective if the policy causes scrollbars to be present.
 //
@@ -107,29 +190,29 @@ const (
 // `GtkScrolledWindow` has built-in support for touch devices. When a
 // touchscreen is used, swiping will move the scrolled window, and will
 // expose 'kinetic' behavior. This can be turned off with the
-// [property@Gtk.ScrolledWindow:kinetic-scrolling] property if it is undesired.
+// [ScrolledWindow.GetPropertyKineticScrolling] property if it is undesired.
 //
 // `GtkScrolledWindow` also displays visual 'overshoot' indication when
 // the content is pulled beyond the end, and this situation can be
-// captured with the [signal@Gtk.ScrolledWindow::edge-overshot] signal.
+// captured with the [ScrolledWindow.ConnectEdgeOvershotFunc] signal.
 //
 // If no mouse device is present, the scrollbars will overlaid as
 // narrow, auto-hiding indicators over the content. If traditional
 // scrollbars are desired although no mouse is present, this behaviour
-// can be turned off with the [property@Gtk.ScrolledWindow:overlay-scrolling]
+// can be turned off with the [ScrolledWindow.GetPropertyOverlayScrolling]
 // property.
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.ScrolledWindow::scroll-child]
+// - [ScrolledWindow.ConnectScrollChildFunc]
 //
 // # CSS nodes
 //
 // `GtkScrolledWindow` has a main CSS node with name scrolledwindow.
-// It gets a .frame style class added when [property@Gtk.ScrolledWindow:has-frame]
-// is %TRUE.
+// It gets a .frame style class added when [ScrolledWindow.GetPropertyHasFrame]
+// is true.
 //
 // It uses subnodes with names overshoot and undershoot to draw the overflow
 // and underflow indications. These nodes get the .left, .right, .top or .bottom
@@ -144,9 +227,9 @@ const (
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkScrolledWindow` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkScrolledWindow` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkScrolledWindow` uses the [enum@Gtk.AccessibleRole.generic]
+// Starting from GTK 4.12, `GtkScrolledWindow` uses the [AccessibleRoleGenericValue]
 // role.
 type ScrolledWindow struct {
 	Widget
@@ -183,11 +266,11 @@ func NewScrolledWindow() *ScrolledWindow {
 
 var xScrolledWindowGetChild func(uintptr) uintptr
 
-// Gets the child widget of @scrolled_window.
+// Gets the child widget of scrolled_window.
 //
-// If the scrolled window automatically added a [class@Gtk.Viewport], this
+// If the scrolled window automatically added a [Viewport], this
 // function will return the viewport widget, and you can retrieve its child
-// using [method@Gtk.Viewport.get_child].
+// using [Viewport.GetChild].
 func (x *ScrolledWindow) GetChild() *Widget {
 	var cls *Widget
 
@@ -233,7 +316,7 @@ func (x *ScrolledWindow) GetHasFrame() bool {
 
 var xScrolledWindowGetHscrollbar func(uintptr) uintptr
 
-// Returns the horizontal scrollbar of @scrolled_window.
+// Returns the horizontal scrollbar of scrolled_window.
 func (x *ScrolledWindow) GetHscrollbar() *Widget {
 	var cls *Widget
 
@@ -277,7 +360,7 @@ func (x *ScrolledWindow) GetMaxContentWidth() int {
 
 var xScrolledWindowGetMinContentHeight func(uintptr) int
 
-// Gets the minimal content height of @scrolled_window.
+// Gets the minimal content height of scrolled_window.
 func (x *ScrolledWindow) GetMinContentHeight() int {
 
 	cret := xScrolledWindowGetMinContentHeight(x.GoPointer())
@@ -286,7 +369,7 @@ func (x *ScrolledWindow) GetMinContentHeight() int {
 
 var xScrolledWindowGetMinContentWidth func(uintptr) int
 
-// Gets the minimum content width of @scrolled_window.
+// Gets the minimum content width of scrolled_window.
 func (x *ScrolledWindow) GetMinContentWidth() int {
 
 	cret := xScrolledWindowGetMinContentWidth(x.GoPointer())
@@ -316,7 +399,7 @@ var xScrolledWindowGetPolicy func(uintptr, *PolicyType, *PolicyType)
 // Retrieves the current policy values for the horizontal and vertical
 // scrollbars.
 //
-// See [method@Gtk.ScrolledWindow.set_policy].
+// See [ScrolledWindow.SetPolicy].
 func (x *ScrolledWindow) GetPolicy(HscrollbarPolicyVar *PolicyType, VscrollbarPolicyVar *PolicyType) {
 
 	xScrolledWindowGetPolicy(x.GoPointer(), HscrollbarPolicyVar, VscrollbarPolicyVar)
@@ -365,7 +448,7 @@ func (x *ScrolledWindow) GetVadjustment() *Adjustment {
 
 var xScrolledWindowGetVscrollbar func(uintptr) uintptr
 
-// Returns the vertical scrollbar of @scrolled_window.
+// Returns the vertical scrollbar of scrolled_window.
 func (x *ScrolledWindow) GetVscrollbar() *Widget {
 	var cls *Widget
 
@@ -382,10 +465,10 @@ func (x *ScrolledWindow) GetVscrollbar() *Widget {
 
 var xScrolledWindowSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @scrolled_window.
+// Sets the child widget of scrolled_window.
 //
-// If @child does not implement the [iface@Gtk.Scrollable] interface,
-// the scrolled window will add @child to a [class@Gtk.Viewport] instance
+// If child does not implement the [Scrollable] interface,
+// the scrolled window will add child to a [Viewport] instance
 // and then add the viewport as its child widget.
 func (x *ScrolledWindow) SetChild(ChildVar *Widget) {
 
@@ -404,7 +487,7 @@ func (x *ScrolledWindow) SetHadjustment(HadjustmentVar *Adjustment) {
 
 var xScrolledWindowSetHasFrame func(uintptr, bool)
 
-// Changes the frame drawn around the contents of @scrolled_window.
+// Changes the frame drawn around the contents of scrolled_window.
 func (x *ScrolledWindow) SetHasFrame(HasFrameVar bool) {
 
 	xScrolledWindowSetHasFrame(x.GoPointer(), HasFrameVar)
@@ -416,7 +499,7 @@ var xScrolledWindowSetKineticScrolling func(uintptr, bool)
 // Turns kinetic scrolling on or off.
 //
 // Kinetic scrolling only applies to devices with source
-// %GDK_SOURCE_TOUCHSCREEN.
+// [gdk.SourceTouchscreenValue].
 func (x *ScrolledWindow) SetKineticScrolling(KineticScrollingVar bool) {
 
 	xScrolledWindowSetKineticScrolling(x.GoPointer(), KineticScrollingVar)
@@ -425,13 +508,13 @@ func (x *ScrolledWindow) SetKineticScrolling(KineticScrollingVar bool) {
 
 var xScrolledWindowSetMaxContentHeight func(uintptr, int)
 
-// Sets the maximum height that @scrolled_window should keep visible.
+// Sets the maximum height that scrolled_window should keep visible.
 //
-// The @scrolled_window will grow up to this height before it starts
+// The scrolled_window will grow up to this height before it starts
 // scrolling the content.
 //
 // It is a programming error to set the maximum content height to a value
-// smaller than [property@Gtk.ScrolledWindow:min-content-height].
+// smaller than [ScrolledWindow.GetPropertyMinContentHeight].
 func (x *ScrolledWindow) SetMaxContentHeight(HeightVar int) {
 
 	xScrolledWindowSetMaxContentHeight(x.GoPointer(), HeightVar)
@@ -440,13 +523,13 @@ func (x *ScrolledWindow) SetMaxContentHeight(HeightVar int) {
 
 var xScrolledWindowSetMaxContentWidth func(uintptr, int)
 
-// Sets the maximum width that @scrolled_window should keep visible.
+// Sets the maximum width that scrolled_window should keep visible.
 //
-// The @scrolled_window will grow up to this width before it starts
+// The scrolled_window will grow up to this width before it starts
 // scrolling the content.
 //
 // It is a programming error to set the maximum content width to a
-// value smaller than [property@Gtk.ScrolledWindow:min-content-width].
+// value smaller than [ScrolledWindow.GetPropertyMinContentWidth].
 func (x *ScrolledWindow) SetMaxContentWidth(WidthVar int) {
 
 	xScrolledWindowSetMaxContentWidth(x.GoPointer(), WidthVar)
@@ -455,13 +538,13 @@ func (x *ScrolledWindow) SetMaxContentWidth(WidthVar int) {
 
 var xScrolledWindowSetMinContentHeight func(uintptr, int)
 
-// Sets the minimum height that @scrolled_window should keep visible.
+// Sets the minimum height that scrolled_window should keep visible.
 //
 // Note that this can and (usually will) be smaller than the minimum
 // size of the content.
 //
 // It is a programming error to set the minimum content height to a
-// value greater than [property@Gtk.ScrolledWindow:max-content-height].
+// value greater than [ScrolledWindow.GetPropertyMaxContentHeight].
 func (x *ScrolledWindow) SetMinContentHeight(HeightVar int) {
 
 	xScrolledWindowSetMinContentHeight(x.GoPointer(), HeightVar)
@@ -470,13 +553,13 @@ func (x *ScrolledWindow) SetMinContentHeight(HeightVar int) {
 
 var xScrolledWindowSetMinContentWidth func(uintptr, int)
 
-// Sets the minimum width that @scrolled_window should keep visible.
+// Sets the minimum width that scrolled_window should keep visible.
 //
 // Note that this can and (usually will) be smaller than the minimum
 // size of the content.
 //
 // It is a programming error to set the minimum content width to a
-// value greater than [property@Gtk.ScrolledWindow:max-content-width].
+// value greater than [ScrolledWindow.GetPropertyMaxContentWidth].
 func (x *ScrolledWindow) SetMinContentWidth(WidthVar int) {
 
 	xScrolledWindowSetMinContentWidth(x.GoPointer(), WidthVar)
@@ -497,13 +580,13 @@ var xScrolledWindowSetPlacement func(uintptr, CornerType)
 // Sets the placement of the contents with respect to the scrollbars
 // for the scrolled window.
 //
-// The default is %GTK_CORNER_TOP_LEFT, meaning the child is
+// The default is [CornerTopLeftValue], meaning the child is
 // in the top left, with the scrollbars underneath and to the right.
-// Other values in [enum@Gtk.CornerType] are %GTK_CORNER_TOP_RIGHT,
-// %GTK_CORNER_BOTTOM_LEFT, and %GTK_CORNER_BOTTOM_RIGHT.
+// Other values in [CornerType] are [CornerTopRightValue],
+// [CornerBottomLeftValue], and [CornerBottomRightValue].
 //
-// See also [method@Gtk.ScrolledWindow.get_placement] and
-// [method@Gtk.ScrolledWindow.unset_placement].
+// See also [ScrolledWindow.GetPlacement] and
+// [ScrolledWindow.UnsetPlacement].
 func (x *ScrolledWindow) SetPlacement(WindowPlacementVar CornerType) {
 
 	xScrolledWindowSetPlacement(x.GoPointer(), WindowPlacementVar)
@@ -515,9 +598,9 @@ var xScrolledWindowSetPolicy func(uintptr, PolicyType, PolicyType)
 // Sets the scrollbar policy for the horizontal and vertical scrollbars.
 //
 // The policy determines when the scrollbar should appear; it is a value
-// from the [enum@Gtk.PolicyType] enumeration. If %GTK_POLICY_ALWAYS, the
-// scrollbar is always present; if %GTK_POLICY_NEVER, the scrollbar is
-// never present; if %GTK_POLICY_AUTOMATIC, the scrollbar is present only
+// from the [PolicyType] enumeration. If [PolicyAlwaysValue], the
+// scrollbar is always present; if [PolicyNeverValue], the scrollbar is
+// never present; if [PolicyAutomaticValue], the scrollbar is present only
 // if needed (that is, if the slider part of the bar would be smaller
 // than the trough — the display is larger than the page size).
 func (x *ScrolledWindow) SetPolicy(HscrollbarPolicyVar PolicyType, VscrollbarPolicyVar PolicyType) {
@@ -560,7 +643,7 @@ var xScrolledWindowUnsetPlacement func(uintptr)
 // Unsets the placement of the contents with respect to the scrollbars.
 //
 // If no window placement is set for a scrolled window,
-// it defaults to %GTK_CORNER_TOP_LEFT.
+// it defaults to [CornerTopLeftValue].
 func (x *ScrolledWindow) UnsetPlacement() {
 
 	xScrolledWindowUnsetPlacement(x.GoPointer())
@@ -598,7 +681,7 @@ func (x *ScrolledWindow) GetPropertyHasFrame() bool {
 // SetPropertyKineticScrolling sets the "kinetic-scrolling" property.
 // Whether kinetic scrolling is enabled or not.
 //
-// Kinetic scrolling only applies to devices with source %GDK_SOURCE_TOUCHSCREEN.
+// Kinetic scrolling only applies to devices with source [gdk.SourceTouchscreenValue].
 func (x *ScrolledWindow) SetPropertyKineticScrolling(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -609,7 +692,7 @@ func (x *ScrolledWindow) SetPropertyKineticScrolling(value bool) {
 // GetPropertyKineticScrolling gets the "kinetic-scrolling" property.
 // Whether kinetic scrolling is enabled or not.
 //
-// Kinetic scrolling only applies to devices with source %GDK_SOURCE_TOUCHSCREEN.
+// Kinetic scrolling only applies to devices with source [gdk.SourceTouchscreenValue].
 func (x *ScrolledWindow) GetPropertyKineticScrolling() bool {
 	var v gobject.Value
 	x.GetProperty("kinetic-scrolling", &v)
@@ -617,7 +700,7 @@ func (x *ScrolledWindow) GetPropertyKineticScrolling() bool {
 }
 
 // SetPropertyMaxContentHeight sets the "max-content-height" property.
-// The maximum content height of @scrolled_window.
+// The maximum content height of scrolled_window.
 func (x *ScrolledWindow) SetPropertyMaxContentHeight(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -626,7 +709,7 @@ func (x *ScrolledWindow) SetPropertyMaxContentHeight(value int) {
 }
 
 // GetPropertyMaxContentHeight gets the "max-content-height" property.
-// The maximum content height of @scrolled_window.
+// The maximum content height of scrolled_window.
 func (x *ScrolledWindow) GetPropertyMaxContentHeight() int {
 	var v gobject.Value
 	x.GetProperty("max-content-height", &v)
@@ -634,7 +717,7 @@ func (x *ScrolledWindow) GetPropertyMaxContentHeight() int {
 }
 
 // SetPropertyMaxContentWidth sets the "max-content-width" property.
-// The maximum content width of @scrolled_window.
+// The maximum content width of scrolled_window.
 func (x *ScrolledWindow) SetPropertyMaxContentWidth(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -643,7 +726,7 @@ func (x *ScrolledWindow) SetPropertyMaxContentWidth(value int) {
 }
 
 // GetPropertyMaxContentWidth gets the "max-content-width" property.
-// The maximum content width of @scrolled_window.
+// The maximum content width of scrolled_window.
 func (x *ScrolledWindow) GetPropertyMaxContentWidth() int {
 	var v gobject.Value
 	x.GetProperty("max-content-width", &v)
@@ -651,7 +734,7 @@ func (x *ScrolledWindow) GetPropertyMaxContentWidth() int {
 }
 
 // SetPropertyMinContentHeight sets the "min-content-height" property.
-// The minimum content height of @scrolled_window.
+// The minimum content height of scrolled_window.
 func (x *ScrolledWindow) SetPropertyMinContentHeight(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -660,7 +743,7 @@ func (x *ScrolledWindow) SetPropertyMinContentHeight(value int) {
 }
 
 // GetPropertyMinContentHeight gets the "min-content-height" property.
-// The minimum content height of @scrolled_window.
+// The minimum content height of scrolled_window.
 func (x *ScrolledWindow) GetPropertyMinContentHeight() int {
 	var v gobject.Value
 	x.GetProperty("min-content-height", &v)
@@ -668,7 +751,7 @@ func (x *ScrolledWindow) GetPropertyMinContentHeight() int {
 }
 
 // SetPropertyMinContentWidth sets the "min-content-width" property.
-// The minimum content width of @scrolled_window.
+// The minimum content width of scrolled_window.
 func (x *ScrolledWindow) SetPropertyMinContentWidth(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -677,7 +760,7 @@ func (x *ScrolledWindow) SetPropertyMinContentWidth(value int) {
 }
 
 // GetPropertyMinContentWidth gets the "min-content-width" property.
-// The minimum content width of @scrolled_window.
+// The minimum content width of scrolled_window.
 func (x *ScrolledWindow) GetPropertyMinContentWidth() int {
 	var v gobject.Value
 	x.GetProperty("min-content-width", &v)
@@ -692,7 +775,7 @@ func (x *ScrolledWindow) GetPropertyMinContentWidth() int {
 // the content, as narrow indicators.
 //
 // Note that overlay scrolling can also be globally disabled, with
-// the [property@Gtk.Settings:gtk-overlay-scrolling] setting.
+// the [Settings.GetPropertyGtkOverlayScrolling] setting.
 func (x *ScrolledWindow) SetPropertyOverlayScrolling(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -708,7 +791,7 @@ func (x *ScrolledWindow) SetPropertyOverlayScrolling(value bool) {
 // the content, as narrow indicators.
 //
 // Note that overlay scrolling can also be globally disabled, with
-// the [property@Gtk.Settings:gtk-overlay-scrolling] setting.
+// the [Settings.GetPropertyGtkOverlayScrolling] setting.
 func (x *ScrolledWindow) GetPropertyOverlayScrolling() bool {
 	var v gobject.Value
 	x.GetProperty("overlay-scrolling", &v)
@@ -770,31 +853,53 @@ func (x *ScrolledWindow) GetPropertyPropagateNaturalWidth() bool {
 // in that orientation.
 //
 // A similar behavior without edge resistance is provided by the
-// [signal@Gtk.ScrolledWindow::edge-reached] signal.
+// [ScrolledWindow.ConnectEdgeReachedFunc] signal.
 //
-// Note: The @pos argument is LTR/RTL aware, so callers should be
+// Note: The pos argument is LTR/RTL aware, so callers should be
 // aware too if intending to provide behavior on horizontal edges.
+//
+// Deprecated: use ConnectEdgeOvershotFunc, which also accepts method values and closures.
 func (x *ScrolledWindow) ConnectEdgeOvershot(cb *func(ScrolledWindow, PositionType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "edge-overshot", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PosVarp PositionType) {
+			defer core.RecoverPanic()
+			fa := ScrolledWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PosVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "edge-overshot", cbRefPtr, cbPtr)
+}
+
+var xScrolledWindowEdgeOvershotTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PosVarp PositionType) {
+func xScrolledWindowEdgeOvershotNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PosVarp PositionType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScrolledWindow, PositionType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScrolledWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PosVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "edge-overshot", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEdgeOvershotFunc connects cb to the "edge-overshot" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScrolledWindow) ConnectEdgeOvershotFunc(cb func(ScrolledWindow, PositionType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "edge-overshot", &xScrolledWindowEdgeOvershotTrampoline, xScrolledWindowEdgeOvershotNewTrampoline, cb)
 }
 
 // Emitted whenever user-initiated scrolling makes the scrolled
@@ -802,91 +907,157 @@ func (x *ScrolledWindow) ConnectEdgeOvershot(cb *func(ScrolledWindow, PositionTy
 // adjustment in that orientation.
 //
 // A similar behavior with edge resistance is provided by the
-// [signal@Gtk.ScrolledWindow::edge-overshot] signal.
+// [ScrolledWindow.ConnectEdgeOvershotFunc] signal.
 //
-// Note: The @pos argument is LTR/RTL aware, so callers should be
+// Note: The pos argument is LTR/RTL aware, so callers should be
 // aware too if intending to provide behavior on horizontal edges.
+//
+// Deprecated: use ConnectEdgeReachedFunc, which also accepts method values and closures.
 func (x *ScrolledWindow) ConnectEdgeReached(cb *func(ScrolledWindow, PositionType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "edge-reached", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PosVarp PositionType) {
+			defer core.RecoverPanic()
+			fa := ScrolledWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PosVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "edge-reached", cbRefPtr, cbPtr)
+}
+
+var xScrolledWindowEdgeReachedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PosVarp PositionType) {
+func xScrolledWindowEdgeReachedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PosVarp PositionType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScrolledWindow, PositionType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScrolledWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PosVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "edge-reached", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEdgeReachedFunc connects cb to the "edge-reached" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScrolledWindow) ConnectEdgeReachedFunc(cb func(ScrolledWindow, PositionType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "edge-reached", &xScrolledWindowEdgeReachedTrampoline, xScrolledWindowEdgeReachedNewTrampoline, cb)
 }
 
 // Emitted when focus is moved away from the scrolled window by a
 // keybinding.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; to move forward and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt;` to move backward.
+// Ctrl+Tab to move forward and
+// Ctrl+Shift+Tab` to move backward.
+//
+// Deprecated: use ConnectMoveFocusOutFunc, which also accepts method values and closures.
 func (x *ScrolledWindow) ConnectMoveFocusOut(cb *func(ScrolledWindow, DirectionType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus-out", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionTypeVarp DirectionType) {
+			defer core.RecoverPanic()
+			fa := ScrolledWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DirectionTypeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-focus-out", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DirectionTypeVarp DirectionType) {
+var xScrolledWindowMoveFocusOutTrampoline uintptr
+
+func xScrolledWindowMoveFocusOutNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionTypeVarp DirectionType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScrolledWindow, DirectionType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScrolledWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DirectionTypeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus-out", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveFocusOutFunc connects cb to the "move-focus-out" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScrolledWindow) ConnectMoveFocusOutFunc(cb func(ScrolledWindow, DirectionType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-focus-out", &xScrolledWindowMoveFocusOutTrampoline, xScrolledWindowMoveFocusOutNewTrampoline, cb)
 }
 
 // Emitted when a keybinding that scrolls is pressed.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The horizontal or vertical adjustment is updated which triggers a
 // signal that the scrolled window’s child may listen to and scroll itself.
+//
+// Deprecated: use ConnectScrollChildFunc, which also accepts method values and closures.
 func (x *ScrolledWindow) ConnectScrollChild(cb *func(ScrolledWindow, ScrollType, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-child", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ScrollVarp ScrollType, HorizontalVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := ScrolledWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ScrollVarp, HorizontalVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "scroll-child", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ScrollVarp ScrollType, HorizontalVarp bool) bool {
+var xScrolledWindowScrollChildTrampoline uintptr
+
+func xScrolledWindowScrollChildNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ScrollVarp ScrollType, HorizontalVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ScrolledWindow, ScrollType, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ScrolledWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ScrollVarp, HorizontalVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "scroll-child", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectScrollChildFunc connects cb to the "scroll-child" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ScrolledWindow) ConnectScrollChildFunc(cb func(ScrolledWindow, ScrollType, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "scroll-child", &xScrolledWindowScrollChildTrampoline, xScrolledWindowScrollChildNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1050,7 +1221,7 @@ func (x *ScrolledWindow) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1058,14 +1229,13 @@ func (x *ScrolledWindow) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ScrolledWindow) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1089,19 +1259,17 @@ func (x *ScrolledWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ScrolledWindow) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1122,7 +1290,7 @@ func (x *ScrolledWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1131,14 +1299,12 @@ func (x *ScrolledWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ScrolledWindow) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1157,10 +1323,10 @@ func (x *ScrolledWindow) UpdateStateValue(NStatesVar int, StatesVar []Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ScrolledWindow) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1172,7 +1338,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1185,38 +1351,38 @@ func init() {
 
 	core.PuregoSafeRegister(&xScrolledWindowGLibType, libs, "gtk_scrolled_window_get_type")
 
-	core.PuregoSafeRegister(&xNewScrolledWindow, libs, "gtk_scrolled_window_new")
-
-	core.PuregoSafeRegister(&xScrolledWindowGetChild, libs, "gtk_scrolled_window_get_child")
-	core.PuregoSafeRegister(&xScrolledWindowGetHadjustment, libs, "gtk_scrolled_window_get_hadjustment")
-	core.PuregoSafeRegister(&xScrolledWindowGetHasFrame, libs, "gtk_scrolled_window_get_has_frame")
-	core.PuregoSafeRegister(&xScrolledWindowGetHscrollbar, libs, "gtk_scrolled_window_get_hscrollbar")
-	core.PuregoSafeRegister(&xScrolledWindowGetKineticScrolling, libs, "gtk_scrolled_window_get_kinetic_scrolling")
-	core.PuregoSafeRegister(&xScrolledWindowGetMaxContentHeight, libs, "gtk_scrolled_window_get_max_content_height")
-	core.PuregoSafeRegister(&xScrolledWindowGetMaxContentWidth, libs, "gtk_scrolled_window_get_max_content_width")
-	core.PuregoSafeRegister(&xScrolledWindowGetMinContentHeight, libs, "gtk_scrolled_window_get_min_content_height")
-	core.PuregoSafeRegister(&xScrolledWindowGetMinContentWidth, libs, "gtk_scrolled_window_get_min_content_width")
-	core.PuregoSafeRegister(&xScrolledWindowGetOverlayScrolling, libs, "gtk_scrolled_window_get_overlay_scrolling")
-	core.PuregoSafeRegister(&xScrolledWindowGetPlacement, libs, "gtk_scrolled_window_get_placement")
-	core.PuregoSafeRegister(&xScrolledWindowGetPolicy, libs, "gtk_scrolled_window_get_policy")
-	core.PuregoSafeRegister(&xScrolledWindowGetPropagateNaturalHeight, libs, "gtk_scrolled_window_get_propagate_natural_height")
-	core.PuregoSafeRegister(&xScrolledWindowGetPropagateNaturalWidth, libs, "gtk_scrolled_window_get_propagate_natural_width")
-	core.PuregoSafeRegister(&xScrolledWindowGetVadjustment, libs, "gtk_scrolled_window_get_vadjustment")
-	core.PuregoSafeRegister(&xScrolledWindowGetVscrollbar, libs, "gtk_scrolled_window_get_vscrollbar")
-	core.PuregoSafeRegister(&xScrolledWindowSetChild, libs, "gtk_scrolled_window_set_child")
-	core.PuregoSafeRegister(&xScrolledWindowSetHadjustment, libs, "gtk_scrolled_window_set_hadjustment")
-	core.PuregoSafeRegister(&xScrolledWindowSetHasFrame, libs, "gtk_scrolled_window_set_has_frame")
-	core.PuregoSafeRegister(&xScrolledWindowSetKineticScrolling, libs, "gtk_scrolled_window_set_kinetic_scrolling")
-	core.PuregoSafeRegister(&xScrolledWindowSetMaxContentHeight, libs, "gtk_scrolled_window_set_max_content_height")
-	core.PuregoSafeRegister(&xScrolledWindowSetMaxContentWidth, libs, "gtk_scrolled_window_set_max_content_width")
-	core.PuregoSafeRegister(&xScrolledWindowSetMinContentHeight, libs, "gtk_scrolled_window_set_min_content_height")
-	core.PuregoSafeRegister(&xScrolledWindowSetMinContentWidth, libs, "gtk_scrolled_window_set_min_content_width")
-	core.PuregoSafeRegister(&xScrolledWindowSetOverlayScrolling, libs, "gtk_scrolled_window_set_overlay_scrolling")
-	core.PuregoSafeRegister(&xScrolledWindowSetPlacement, libs, "gtk_scrolled_window_set_placement")
-	core.PuregoSafeRegister(&xScrolledWindowSetPolicy, libs, "gtk_scrolled_window_set_policy")
-	core.PuregoSafeRegister(&xScrolledWindowSetPropagateNaturalHeight, libs, "gtk_scrolled_window_set_propagate_natural_height")
-	core.PuregoSafeRegister(&xScrolledWindowSetPropagateNaturalWidth, libs, "gtk_scrolled_window_set_propagate_natural_width")
-	core.PuregoSafeRegister(&xScrolledWindowSetVadjustment, libs, "gtk_scrolled_window_set_vadjustment")
-	core.PuregoSafeRegister(&xScrolledWindowUnsetPlacement, libs, "gtk_scrolled_window_unset_placement")
+	core.PuregoRegisterSince(&xNewScrolledWindow, libs, "gtk_scrolled_window_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xScrolledWindowGetChild, libs, "gtk_scrolled_window_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetHadjustment, libs, "gtk_scrolled_window_get_hadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetHasFrame, libs, "gtk_scrolled_window_get_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetHscrollbar, libs, "gtk_scrolled_window_get_hscrollbar", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetKineticScrolling, libs, "gtk_scrolled_window_get_kinetic_scrolling", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetMaxContentHeight, libs, "gtk_scrolled_window_get_max_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetMaxContentWidth, libs, "gtk_scrolled_window_get_max_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetMinContentHeight, libs, "gtk_scrolled_window_get_min_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetMinContentWidth, libs, "gtk_scrolled_window_get_min_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetOverlayScrolling, libs, "gtk_scrolled_window_get_overlay_scrolling", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetPlacement, libs, "gtk_scrolled_window_get_placement", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetPolicy, libs, "gtk_scrolled_window_get_policy", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetPropagateNaturalHeight, libs, "gtk_scrolled_window_get_propagate_natural_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetPropagateNaturalWidth, libs, "gtk_scrolled_window_get_propagate_natural_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetVadjustment, libs, "gtk_scrolled_window_get_vadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowGetVscrollbar, libs, "gtk_scrolled_window_get_vscrollbar", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetChild, libs, "gtk_scrolled_window_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetHadjustment, libs, "gtk_scrolled_window_set_hadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetHasFrame, libs, "gtk_scrolled_window_set_has_frame", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetKineticScrolling, libs, "gtk_scrolled_window_set_kinetic_scrolling", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetMaxContentHeight, libs, "gtk_scrolled_window_set_max_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetMaxContentWidth, libs, "gtk_scrolled_window_set_max_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetMinContentHeight, libs, "gtk_scrolled_window_set_min_content_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetMinContentWidth, libs, "gtk_scrolled_window_set_min_content_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetOverlayScrolling, libs, "gtk_scrolled_window_set_overlay_scrolling", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetPlacement, libs, "gtk_scrolled_window_set_placement", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetPolicy, libs, "gtk_scrolled_window_set_policy", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetPropagateNaturalHeight, libs, "gtk_scrolled_window_set_propagate_natural_height", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetPropagateNaturalWidth, libs, "gtk_scrolled_window_set_propagate_natural_width", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowSetVadjustment, libs, "gtk_scrolled_window_set_vadjustment", "GTK", "")
+	core.PuregoRegisterSince(&xScrolledWindowUnsetPlacement, libs, "gtk_scrolled_window_unset_placement", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,13 +9,6 @@ import (
 
 // Reveals a search entry when search is started.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="search-bar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkSearchBar" src="search-bar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It can also contain additional widgets, such as drop-down menus,
 // or buttons.  The search bar would appear when a search is started
 // through typing on the keyboard, or the application’s search mode
@@ -24,38 +16,34 @@ import (
 //
 // For keyboard presses to start a search, the search bar must be told
 // of a widget to capture key events from through
-// [method@Gtk.SearchBar.set_key_capture_widget]. This widget will
+// [SearchBar.SetKeyCaptureWidget]. This widget will
 // typically be the top-level window, or a parent container of the
 // search bar. Common shortcuts such as Ctrl+F should be handled as an
 // application action, or through the menu items.
 //
 // You will also need to tell the search bar about which entry you
-// are using as your search entry using [method@Gtk.SearchBar.connect_entry].
+// are using as your search entry using [SearchBar.ConnectEntry].
 //
-// ## Creating a search bar
+// # Creating a search bar
 //
 // The following example shows you how to create a more complex search
 // entry.
 //
-// [A simple example](https://gitlab.gnome.org/GNOME/gtk/tree/main/examples/search-bar.c)
+// [A simple example]
 //
 // # Shortcuts and Gestures
 //
 // `GtkSearchBar` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;Escape&lt;/kbd&gt; hides the search bar.
+// - Escape hides the search bar.
 //
 // # CSS nodes
 //
-// ```
-// searchbar
-// ╰── revealer
-//
-//	╰── box
-//	     ├── [child]
-//	     ╰── [button.close]
-//
-// ```
+//	searchbar
+//	╰── revealer
+//	    ╰── box
+//	         ├── [child]
+//	         ╰── [button.close]
 //
 // `GtkSearchBar` has a main CSS node with name searchbar. It has a child
 // node with name revealer that contains a node with name box. The box node
@@ -64,7 +52,9 @@ import (
 //
 // # Accessibility
 //
-// `GtkSearchBar` uses the [enum@Gtk.AccessibleRole.search] role.
+// `GtkSearchBar` uses the [AccessibleRoleSearchValue] role.
+//
+// [A simple example]: https://gitlab.gnome.org/GNOME/gtk/tree/main/examples/search-bar.c
 type SearchBar struct {
 	Widget
 }
@@ -86,7 +76,7 @@ var xNewSearchBar func() uintptr
 // Creates a `GtkSearchBar`.
 //
 // You will need to tell it about which widget is going to be your text
-// entry using [method@Gtk.SearchBar.connect_entry].
+// entry using [SearchBar.ConnectEntry].
 func NewSearchBar() *SearchBar {
 	var cls *SearchBar
 
@@ -117,7 +107,7 @@ func (x *SearchBar) ConnectEntry(EntryVar Editable) {
 
 var xSearchBarGetChild func(uintptr) uintptr
 
-// Gets the child widget of @bar.
+// Gets the child widget of bar.
 func (x *SearchBar) GetChild() *Widget {
 	var cls *Widget
 
@@ -134,7 +124,7 @@ func (x *SearchBar) GetChild() *Widget {
 
 var xSearchBarGetKeyCaptureWidget func(uintptr) uintptr
 
-// Gets the widget that @bar is capturing key events from.
+// Gets the widget that bar is capturing key events from.
 func (x *SearchBar) GetKeyCaptureWidget() *Widget {
 	var cls *Widget
 
@@ -169,7 +159,7 @@ func (x *SearchBar) GetShowCloseButton() bool {
 
 var xSearchBarSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @bar.
+// Sets the child widget of bar.
 func (x *SearchBar) SetChild(ChildVar *Widget) {
 
 	xSearchBarSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -178,7 +168,7 @@ func (x *SearchBar) SetChild(ChildVar *Widget) {
 
 var xSearchBarSetKeyCaptureWidget func(uintptr, uintptr)
 
-// Sets @widget as the widget that @bar will capture key events
+// Sets widget as the widget that bar will capture key events
 // from.
 //
 // If key events are handled by the search bar, the bar will
@@ -186,10 +176,10 @@ var xSearchBarSetKeyCaptureWidget func(uintptr, uintptr)
 //
 // Note that despite the name of this function, the events
 // are only 'captured' in the bubble phase, which means that
-// editable child widgets of @widget will receive text input
+// editable child widgets of widget will receive text input
 // before it gets captured. If that is not desired, you can
 // capture and forward the events yourself with
-// [method@Gtk.EventControllerKey.forward].
+// [EventControllerKey.Forward].
 func (x *SearchBar) SetKeyCaptureWidget(WidgetVar *Widget) {
 
 	xSearchBarSetKeyCaptureWidget(x.GoPointer(), WidgetVar.GoPointer())
@@ -424,7 +414,7 @@ func (x *SearchBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -432,14 +422,13 @@ func (x *SearchBar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SearchBar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -463,19 +452,17 @@ func (x *SearchBar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SearchBar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -496,7 +483,7 @@ func (x *SearchBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -505,14 +492,12 @@ func (x *SearchBar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SearchBar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -531,10 +516,10 @@ func (x *SearchBar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SearchBar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -546,7 +531,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -555,16 +540,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xSearchBarGLibType, libs, "gtk_search_bar_get_type")
 
-	core.PuregoSafeRegister(&xNewSearchBar, libs, "gtk_search_bar_new")
-
-	core.PuregoSafeRegister(&xSearchBarConnectEntry, libs, "gtk_search_bar_connect_entry")
-	core.PuregoSafeRegister(&xSearchBarGetChild, libs, "gtk_search_bar_get_child")
-	core.PuregoSafeRegister(&xSearchBarGetKeyCaptureWidget, libs, "gtk_search_bar_get_key_capture_widget")
-	core.PuregoSafeRegister(&xSearchBarGetSearchMode, libs, "gtk_search_bar_get_search_mode")
-	core.PuregoSafeRegister(&xSearchBarGetShowCloseButton, libs, "gtk_search_bar_get_show_close_button")
-	core.PuregoSafeRegister(&xSearchBarSetChild, libs, "gtk_search_bar_set_child")
-	core.PuregoSafeRegister(&xSearchBarSetKeyCaptureWidget, libs, "gtk_search_bar_set_key_capture_widget")
-	core.PuregoSafeRegister(&xSearchBarSetSearchMode, libs, "gtk_search_bar_set_search_mode")
-	core.PuregoSafeRegister(&xSearchBarSetShowCloseButton, libs, "gtk_search_bar_set_show_close_button")
+	core.PuregoRegisterSince(&xNewSearchBar, libs, "gtk_search_bar_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xSearchBarConnectEntry, libs, "gtk_search_bar_connect_entry", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarGetChild, libs, "gtk_search_bar_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarGetKeyCaptureWidget, libs, "gtk_search_bar_get_key_capture_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarGetSearchMode, libs, "gtk_search_bar_get_search_mode", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarGetShowCloseButton, libs, "gtk_search_bar_get_show_close_button", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarSetChild, libs, "gtk_search_bar_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarSetKeyCaptureWidget, libs, "gtk_search_bar_set_key_capture_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarSetSearchMode, libs, "gtk_search_bar_set_search_mode", "GTK", "")
+	core.PuregoRegisterSince(&xSearchBarSetShowCloseButton, libs, "gtk_search_bar_set_show_close_button", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -16,13 +15,6 @@ import (
 // The main API for interacting with a `GtkSearchEntry` as entry
 // is the `GtkEditable` interface.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="search-entry-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkSearchEntry" src="search-entry.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It will show an inactive symbolic “find” icon when the search
 // entry is empty, and a symbolic “clear” icon when there is text.
 // Clicking on the “clear” icon will empty the search entry.
@@ -30,44 +22,42 @@ import (
 // To make filtering appear more reactive, it is a good idea to
 // not react to every change in the entry text immediately, but
 // only after a short delay. To support this, `GtkSearchEntry`
-// emits the [signal@Gtk.SearchEntry::search-changed] signal which
-// can be used instead of the [signal@Gtk.Editable::changed] signal.
+// emits the [SearchEntry.ConnectSearchChangedFunc] signal which
+// can be used instead of the Editable::changed signal.
 //
-// The [signal@Gtk.SearchEntry::previous-match],
-// [signal@Gtk.SearchEntry::next-match] and
-// [signal@Gtk.SearchEntry::stop-search] signals can be used to
+// The [SearchEntry.ConnectPreviousMatchFunc],
+// [SearchEntry.ConnectNextMatchFunc] and
+// [SearchEntry.ConnectStopSearchFunc] signals can be used to
 // implement moving between search results and ending the search.
 //
 // Often, `GtkSearchEntry` will be fed events by means of being
-// placed inside a [class@Gtk.SearchBar]. If that is not the case,
-// you can use [method@Gtk.SearchEntry.set_key_capture_widget] to
+// placed inside a [SearchBar]. If that is not the case,
+// you can use [SearchEntry.SetKeyCaptureWidget] to
 // let it capture key input from another widget.
 //
 // `GtkSearchEntry` provides only minimal API and should be used with
-// the [iface@Gtk.Editable] API.
+// the [Editable] API.
 //
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.SearchEntry::activate]
-// - [signal@Gtk.SearchEntry::next-match]
-// - [signal@Gtk.SearchEntry::previous-match]
-// - [signal@Gtk.SearchEntry::stop-search]
+// - [SearchEntry.ConnectActivateFunc]
+// - [SearchEntry.ConnectNextMatchFunc]
+// - [SearchEntry.ConnectPreviousMatchFunc]
+// - [SearchEntry.ConnectStopSearchFunc]
 //
-// ## CSS Nodes
+// # CSS Nodes
 //
-// ```
-// entry.search
-// ╰── text
-// ```
+//	entry.search
+//	╰── text
 //
 // `GtkSearchEntry` has a single CSS node with name entry that carries
 // a `.search` style class, and the text node is a child of that.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkSearchEntry` uses the [enum@Gtk.AccessibleRole.search_box] role.
+// `GtkSearchEntry` uses the [AccessibleRoleSearchBoxValue] role.
 type SearchEntry struct {
 	Widget
 }
@@ -103,7 +93,7 @@ func NewSearchEntry() *SearchEntry {
 
 var xSearchEntryGetInputHints func(uintptr) InputHints
 
-// Gets the input purpose for @entry.
+// Gets the input purpose for entry.
 func (x *SearchEntry) GetInputHints() InputHints {
 
 	cret := xSearchEntryGetInputHints(x.GoPointer())
@@ -112,7 +102,7 @@ func (x *SearchEntry) GetInputHints() InputHints {
 
 var xSearchEntryGetInputPurpose func(uintptr) InputPurpose
 
-// Gets the input purpose of @entry.
+// Gets the input purpose of entry.
 func (x *SearchEntry) GetInputPurpose() InputPurpose {
 
 	cret := xSearchEntryGetInputPurpose(x.GoPointer())
@@ -121,7 +111,7 @@ func (x *SearchEntry) GetInputPurpose() InputPurpose {
 
 var xSearchEntryGetKeyCaptureWidget func(uintptr) uintptr
 
-// Gets the widget that @entry is capturing key events from.
+// Gets the widget that entry is capturing key events from.
 func (x *SearchEntry) GetKeyCaptureWidget() *Widget {
 	var cls *Widget
 
@@ -138,7 +128,7 @@ func (x *SearchEntry) GetKeyCaptureWidget() *Widget {
 
 var xSearchEntryGetPlaceholderText func(uintptr) string
 
-// Gets the placeholder text associated with @entry.
+// Gets the placeholder text associated with entry.
 func (x *SearchEntry) GetPlaceholderText() string {
 
 	cret := xSearchEntryGetPlaceholderText(x.GoPointer())
@@ -148,7 +138,7 @@ func (x *SearchEntry) GetPlaceholderText() string {
 var xSearchEntryGetSearchDelay func(uintptr) uint
 
 // Get the delay to be used between the last keypress and the
-// [signal@Gtk.SearchEntry::search-changed] signal being emitted.
+// [SearchEntry.ConnectSearchChangedFunc] signal being emitted.
 func (x *SearchEntry) GetSearchDelay() uint {
 
 	cret := xSearchEntryGetSearchDelay(x.GoPointer())
@@ -157,7 +147,7 @@ func (x *SearchEntry) GetSearchDelay() uint {
 
 var xSearchEntrySetInputHints func(uintptr, InputHints)
 
-// Sets the input hints for @entry.
+// Sets the input hints for entry.
 func (x *SearchEntry) SetInputHints(HintsVar InputHints) {
 
 	xSearchEntrySetInputHints(x.GoPointer(), HintsVar)
@@ -166,7 +156,7 @@ func (x *SearchEntry) SetInputHints(HintsVar InputHints) {
 
 var xSearchEntrySetInputPurpose func(uintptr, InputPurpose)
 
-// Sets the input purpose of @entry.
+// Sets the input purpose of entry.
 func (x *SearchEntry) SetInputPurpose(PurposeVar InputPurpose) {
 
 	xSearchEntrySetInputPurpose(x.GoPointer(), PurposeVar)
@@ -175,23 +165,23 @@ func (x *SearchEntry) SetInputPurpose(PurposeVar InputPurpose) {
 
 var xSearchEntrySetKeyCaptureWidget func(uintptr, uintptr)
 
-// Sets @widget as the widget that @entry will capture key
+// Sets widget as the widget that entry will capture key
 // events from.
 //
 // Key events are consumed by the search entry to start or
 // continue a search.
 //
 // If the entry is part of a `GtkSearchBar`, it is preferable
-// to call [method@Gtk.SearchBar.set_key_capture_widget] instead,
+// to call [SearchBar.SetKeyCaptureWidget] instead,
 // which will reveal the entry in addition to triggering the
 // search entry.
 //
 // Note that despite the name of this function, the events
 // are only 'captured' in the bubble phase, which means that
-// editable child widgets of @widget will receive text input
+// editable child widgets of widget will receive text input
 // before it gets captured. If that is not desired, you can
 // capture and forward the events yourself with
-// [method@Gtk.EventControllerKey.forward].
+// [EventControllerKey.Forward].
 func (x *SearchEntry) SetKeyCaptureWidget(WidgetVar *Widget) {
 
 	xSearchEntrySetKeyCaptureWidget(x.GoPointer(), WidgetVar.GoPointer())
@@ -200,7 +190,7 @@ func (x *SearchEntry) SetKeyCaptureWidget(WidgetVar *Widget) {
 
 var xSearchEntrySetPlaceholderText func(uintptr, uintptr)
 
-// Sets the placeholder text associated with @entry.
+// Sets the placeholder text associated with entry.
 func (x *SearchEntry) SetPlaceholderText(TextVar *string) {
 
 	TextVarPtr := core.GStrdupNullable(TextVar)
@@ -213,7 +203,7 @@ func (x *SearchEntry) SetPlaceholderText(TextVar *string) {
 var xSearchEntrySetSearchDelay func(uintptr, uint)
 
 // Set the delay to be used between the last keypress and the
-// [signal@Gtk.SearchEntry::search-changed] signal being emitted.
+// [SearchEntry.ConnectSearchChangedFunc] signal being emitted.
 func (x *SearchEntry) SetSearchDelay(DelayVar uint) {
 
 	xSearchEntrySetSearchDelay(x.GoPointer(), DelayVar)
@@ -288,174 +278,306 @@ func (x *SearchEntry) GetPropertySearchDelay() uint {
 
 // Emitted when the entry is activated.
 //
-// The keybindings for this signal are all forms of the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// The keybindings for this signal are all forms of the Enter key.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectActivate(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSearchEntryActivateTrampoline uintptr
+
+func xSearchEntryActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectActivateFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xSearchEntryActivateTrampoline, xSearchEntryActivateNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a move to the next match
 // for the current search string.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // Applications should connect to it, to implement moving
 // between matches.
 //
-// The default bindings for this signal is &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;g&lt;/kbd&gt;.
+// The default bindings for this signal is Ctrl+g.
+//
+// Deprecated: use ConnectNextMatchFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectNextMatch(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "next-match", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "next-match", cbRefPtr, cbPtr)
+}
+
+var xSearchEntryNextMatchTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSearchEntryNextMatchNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "next-match", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectNextMatchFunc connects cb to the "next-match" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectNextMatchFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "next-match", &xSearchEntryNextMatchTrampoline, xSearchEntryNextMatchNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a move to the previous match
 // for the current search string.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // Applications should connect to it, to implement moving
 // between matches.
 //
 // The default bindings for this signal is
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;g&lt;/kbd&gt;.
+// Ctrl+Shift+g.
+//
+// Deprecated: use ConnectPreviousMatchFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectPreviousMatch(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "previous-match", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "previous-match", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSearchEntryPreviousMatchTrampoline uintptr
+
+func xSearchEntryPreviousMatchNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "previous-match", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreviousMatchFunc connects cb to the "previous-match" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectPreviousMatchFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "previous-match", &xSearchEntryPreviousMatchTrampoline, xSearchEntryPreviousMatchNewTrampoline, cb)
 }
 
 // Emitted with a delay. The length of the delay can be
-// changed with the [property@Gtk.SearchEntry:search-delay]
+// changed with the [SearchEntry.GetPropertySearchDelay]
 // property.
+//
+// Deprecated: use ConnectSearchChangedFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectSearchChanged(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "search-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "search-changed", cbRefPtr, cbPtr)
+}
+
+var xSearchEntrySearchChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSearchEntrySearchChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "search-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSearchChangedFunc connects cb to the "search-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectSearchChangedFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "search-changed", &xSearchEntrySearchChangedTrampoline, xSearchEntrySearchChangedNewTrampoline, cb)
 }
 
 // Emitted when the user initiated a search on the entry.
+//
+// Deprecated: use ConnectSearchStartedFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectSearchStarted(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "search-started", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "search-started", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xSearchEntrySearchStartedTrampoline uintptr
+
+func xSearchEntrySearchStartedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "search-started", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSearchStartedFunc connects cb to the "search-started" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectSearchStartedFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "search-started", &xSearchEntrySearchStartedTrampoline, xSearchEntrySearchStartedNewTrampoline, cb)
 }
 
 // Emitted when the user stops a search via keyboard input.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // Applications should connect to it, to implement hiding
 // the search entry in this case.
 //
-// The default bindings for this signal is &lt;kbd&gt;Escape&lt;/kbd&gt;.
+// The default bindings for this signal is Escape.
+//
+// Deprecated: use ConnectStopSearchFunc, which also accepts method values and closures.
 func (x *SearchEntry) ConnectStopSearch(cb *func(SearchEntry)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "stop-search", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SearchEntry{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "stop-search", cbRefPtr, cbPtr)
+}
+
+var xSearchEntryStopSearchTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSearchEntryStopSearchNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SearchEntry))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SearchEntry{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "stop-search", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStopSearchFunc connects cb to the "stop-search" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SearchEntry) ConnectStopSearchFunc(cb func(SearchEntry)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "stop-search", &xSearchEntryStopSearchTrampoline, xSearchEntryStopSearchNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -619,7 +741,7 @@ func (x *SearchEntry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -627,14 +749,13 @@ func (x *SearchEntry) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SearchEntry) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -658,19 +779,17 @@ func (x *SearchEntry) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Ac
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SearchEntry) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -691,7 +810,7 @@ func (x *SearchEntry) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -700,14 +819,12 @@ func (x *SearchEntry) UpdateRelationValue(NRelationsVar int, RelationsVar []Acce
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SearchEntry) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -726,10 +843,10 @@ func (x *SearchEntry) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSta
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SearchEntry) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -742,35 +859,29 @@ func (x *SearchEntry) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *SearchEntry) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -790,8 +901,8 @@ func (x *SearchEntry) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -801,7 +912,7 @@ func (x *SearchEntry) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -821,8 +932,8 @@ func (x *SearchEntry) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -832,10 +943,10 @@ func (x *SearchEntry) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *SearchEntry) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -850,21 +961,21 @@ func (x *SearchEntry) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *SearchEntry) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *SearchEntry) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *SearchEntry) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -883,9 +994,9 @@ func (x *SearchEntry) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SearchEntry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -894,7 +1005,7 @@ func (x *SearchEntry) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *SearchEntry) GetText() string {
@@ -914,7 +1025,7 @@ func (x *SearchEntry) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -924,11 +1035,11 @@ func (x *SearchEntry) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *SearchEntry) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -939,9 +1050,9 @@ func (x *SearchEntry) InsertText(TextVar string, LengthVar int, PositionVar int)
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SearchEntry) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -967,19 +1078,19 @@ func (x *SearchEntry) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *SearchEntry) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *SearchEntry) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -992,7 +1103,7 @@ func (x *SearchEntry) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *SearchEntry) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -1009,11 +1120,11 @@ func (x *SearchEntry) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *SearchEntry) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -1025,7 +1136,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1034,17 +1145,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xSearchEntryGLibType, libs, "gtk_search_entry_get_type")
 
-	core.PuregoSafeRegister(&xNewSearchEntry, libs, "gtk_search_entry_new")
-
-	core.PuregoSafeRegister(&xSearchEntryGetInputHints, libs, "gtk_search_entry_get_input_hints")
-	core.PuregoSafeRegister(&xSearchEntryGetInputPurpose, libs, "gtk_search_entry_get_input_purpose")
-	core.PuregoSafeRegister(&xSearchEntryGetKeyCaptureWidget, libs, "gtk_search_entry_get_key_capture_widget")
-	core.PuregoSafeRegister(&xSearchEntryGetPlaceholderText, libs, "gtk_search_entry_get_placeholder_text")
-	core.PuregoSafeRegister(&xSearchEntryGetSearchDelay, libs, "gtk_search_entry_get_search_delay")
-	core.PuregoSafeRegister(&xSearchEntrySetInputHints, libs, "gtk_search_entry_set_input_hints")
-	core.PuregoSafeRegister(&xSearchEntrySetInputPurpose, libs, "gtk_search_entry_set_input_purpose")
-	core.PuregoSafeRegister(&xSearchEntrySetKeyCaptureWidget, libs, "gtk_search_entry_set_key_capture_widget")
-	core.PuregoSafeRegister(&xSearchEntrySetPlaceholderText, libs, "gtk_search_entry_set_placeholder_text")
-	core.PuregoSafeRegister(&xSearchEntrySetSearchDelay, libs, "gtk_search_entry_set_search_delay")
+	core.PuregoRegisterSince(&xNewSearchEntry, libs, "gtk_search_entry_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xSearchEntryGetInputHints, libs, "gtk_search_entry_get_input_hints", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSearchEntryGetInputPurpose, libs, "gtk_search_entry_get_input_purpose", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSearchEntryGetKeyCaptureWidget, libs, "gtk_search_entry_get_key_capture_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSearchEntryGetPlaceholderText, libs, "gtk_search_entry_get_placeholder_text", "GTK", "4.10")
+	core.PuregoRegisterSince(&xSearchEntryGetSearchDelay, libs, "gtk_search_entry_get_search_delay", "GTK", "4.8")
+	core.PuregoRegisterSince(&xSearchEntrySetInputHints, libs, "gtk_search_entry_set_input_hints", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSearchEntrySetInputPurpose, libs, "gtk_search_entry_set_input_purpose", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSearchEntrySetKeyCaptureWidget, libs, "gtk_search_entry_set_key_capture_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSearchEntrySetPlaceholderText, libs, "gtk_search_entry_set_placeholder_text", "GTK", "4.10")
+	core.PuregoRegisterSince(&xSearchEntrySetSearchDelay, libs, "gtk_search_entry_set_search_delay", "GTK", "4.8")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -16,7 +16,7 @@ import (
 type SectionModelInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xGetSection uintptr
 }
@@ -34,7 +34,8 @@ func (x *SectionModelInterface) OverrideGetSection(cb func(SectionModel, uint, *
 	if cb == nil {
 		x.xGetSection = 0
 	} else {
-		x.xGetSection = purego.NewCallback(func(SelfVarp uintptr, PositionVarp uint, OutStartVarp *uint, OutEndVarp *uint) {
+		x.xGetSection = core.NewCallback(func(SelfVarp uintptr, PositionVarp uint, OutStartVarp *uint, OutEndVarp *uint) {
+			defer core.RecoverPanic()
 			cb(&SectionModelBase{Ptr: SelfVarp}, PositionVarp, OutStartVarp, OutEndVarp)
 		})
 	}
@@ -50,7 +51,7 @@ func (x *SectionModelInterface) GetGetSection() func(SectionModel, uint, *uint,
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, PositionVarp uint, OutStartVarp *uint, OutEndVarp *uint)
-	purego.RegisterFunc(&rawCallback, x.xGetSection)
+	core.RegisterFunc(&rawCallback, x.xGetSection)
 	return func(SelfVar SectionModel, PositionVar uint, OutStartVar *uint, OutEndVar *uint) {
 		rawCallback(SelfVar.GoPointer(), PositionVar, OutStartVar, OutEndVar)
 	}
@@ -66,10 +67,10 @@ func (x *SectionModelInterface) GetGetSection() func(SectionModel, uint, *uint,
 // of a model they are wrapping.
 //
 // When the section groupings of a model change, the model will emit the
-// [signal@Gtk.SectionModel::sections-changed] signal by calling the
-// [method@Gtk.SectionModel.sections_changed] function. All sections in the given range
+// SectionModel::sections-changed signal by calling the
+// [SectionModel.SectionsChanged] function. All sections in the given range
 // then need to be queried again.
-// The [signal@Gio.ListModel::items-changed] signal has the same effect, all sections in
+// The ListModel::items-changed signal has the same effect, all sections in
 // that range are invalidated, too.
 type SectionModel interface {
 	GoPointer() uintptr
@@ -99,6 +100,26 @@ func (x *SectionModelBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementSectionModel adds the SectionModel interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - GetSection(uint, *uint, *uint)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SectionModelInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSectionModel[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SectionModelGLibType(), func(iface *SectionModelInterface) {
+		if _, ok := any(zero).(interface{ GetSection(uint, *uint, *uint) }); ok {
+			iface.OverrideGetSection(func(self SectionModel, arg0 uint, arg1 *uint, arg2 *uint) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSection(uint, *uint, *uint) })
+				impl.GetSection(arg0, arg1, arg2)
+			})
+		}
+	})
+}
+
 // Query the section that covers the given position. The number of
 // items in the section can be computed by `out_end - out_start`.
 //
@@ -110,20 +131,20 @@ func (x *SectionModelBase) GetSection(PositionVar uint, OutStartVar *uint, OutEn
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *SectionModelBase) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -139,7 +160,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -148,7 +169,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xSectionModelGLibType, libs, "gtk_section_model_get_type")
 
-	core.PuregoSafeRegister(&XGtkSectionModelGetSection, libs, "gtk_section_model_get_section")
-	core.PuregoSafeRegister(&XGtkSectionModelSectionsChanged, libs, "gtk_section_model_sections_changed")
+	gobject.CheckClassSize("GtkSectionModelInterface", xSectionModelGLibType, unsafe.Sizeof(SectionModelInterface{}))
+
+	core.PuregoRegisterSince(&XGtkSectionModelGetSection, libs, "gtk_section_model_get_section", "GTK", "4.12")
+	core.PuregoRegisterSince(&XGtkSectionModelSectionsChanged, libs, "gtk_section_model_sections_changed", "GTK", "4.12")
 
 }
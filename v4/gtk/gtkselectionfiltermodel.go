@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -58,7 +57,7 @@ func NewSelectionFilterModel(ModelVar SelectionModel) *SelectionFilterModel {
 
 var xSelectionFilterModelGetModel func(uintptr) uintptr
 
-// Gets the model currently filtered or %NULL if none.
+// Gets the model currently filtered or nil if none.
 func (x *SelectionFilterModel) GetModel() *SelectionModelBase {
 	var cls *SelectionModelBase
 
@@ -77,8 +76,8 @@ var xSelectionFilterModelSetModel func(uintptr, uintptr)
 
 // Sets the model to be filtered.
 //
-// Note that GTK makes no effort to ensure that @model conforms to
-// the item type of @self. It assumes that the caller knows what they
+// Note that GTK makes no effort to ensure that model conforms to
+// the item type of self. It assumes that the caller knows what they
 // are doing and have set up an appropriate filter to ensure that item
 // types match.
 func (x *SelectionFilterModel) SetModel(ModelVar SelectionModel) {
@@ -99,35 +98,37 @@ func (c *SelectionFilterModel) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *SelectionFilterModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *SelectionFilterModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *SelectionFilterModel) GetItemType() types.GType {
 
@@ -135,29 +136,29 @@ func (x *SelectionFilterModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *SelectionFilterModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *SelectionFilterModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -171,20 +172,20 @@ func (x *SelectionFilterModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -202,7 +203,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -211,9 +212,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSelectionFilterModelGLibType, libs, "gtk_selection_filter_model_get_type")
 
-	core.PuregoSafeRegister(&xNewSelectionFilterModel, libs, "gtk_selection_filter_model_new")
+	gobject.CheckClassSize("GtkSelectionFilterModelClass", xSelectionFilterModelGLibType, unsafe.Sizeof(SelectionFilterModelClass{}))
+
+	core.PuregoRegisterSince(&xNewSelectionFilterModel, libs, "gtk_selection_filter_model_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSelectionFilterModelGetModel, libs, "gtk_selection_filter_model_get_model")
-	core.PuregoSafeRegister(&xSelectionFilterModelSetModel, libs, "gtk_selection_filter_model_set_model")
+	core.PuregoRegisterSince(&xSelectionFilterModelGetModel, libs, "gtk_selection_filter_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xSelectionFilterModelSetModel, libs, "gtk_selection_filter_model_set_model", "GTK", "")
 
 }
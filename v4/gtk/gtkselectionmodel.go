@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -25,7 +25,7 @@ import (
 type SelectionModelInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xIsSelected uintptr
 
@@ -56,7 +56,8 @@ func (x *SelectionModelInterface) OverrideIsSelected(cb func(SelectionModel, uin
 	if cb == nil {
 		x.xIsSelected = 0
 	} else {
-		x.xIsSelected = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint) bool {
+		x.xIsSelected = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp)
 		})
 	}
@@ -69,7 +70,7 @@ func (x *SelectionModelInterface) GetIsSelected() func(SelectionModel, uint) boo
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint) bool
-	purego.RegisterFunc(&rawCallback, x.xIsSelected)
+	core.RegisterFunc(&rawCallback, x.xIsSelected)
 	return func(ModelVar SelectionModel, PositionVar uint) bool {
 		return rawCallback(ModelVar.GoPointer(), PositionVar)
 	}
@@ -84,7 +85,8 @@ func (x *SelectionModelInterface) OverrideGetSelectionInRange(cb func(SelectionM
 	if cb == nil {
 		x.xGetSelectionInRange = 0
 	} else {
-		x.xGetSelectionInRange = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) *Bitset {
+		x.xGetSelectionInRange = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) *Bitset {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp, NItemsVarp)
 		})
 	}
@@ -100,7 +102,7 @@ func (x *SelectionModelInterface) GetGetSelectionInRange() func(SelectionModel,
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) *Bitset
-	purego.RegisterFunc(&rawCallback, x.xGetSelectionInRange)
+	core.RegisterFunc(&rawCallback, x.xGetSelectionInRange)
 	return func(ModelVar SelectionModel, PositionVar uint, NItemsVar uint) *Bitset {
 		return rawCallback(ModelVar.GoPointer(), PositionVar, NItemsVar)
 	}
@@ -109,12 +111,13 @@ func (x *SelectionModelInterface) GetGetSelectionInRange() func(SelectionModel,
 // OverrideSelectItem sets the "select_item" callback function.
 // Select the item in the given position. If the operation
 //
-//	is known to fail, return %FALSE.
+//	is known to fail, return false.
 func (x *SelectionModelInterface) OverrideSelectItem(cb func(SelectionModel, uint, bool) bool) {
 	if cb == nil {
 		x.xSelectItem = 0
 	} else {
-		x.xSelectItem = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint, UnselectRestVarp bool) bool {
+		x.xSelectItem = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint, UnselectRestVarp bool) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp, UnselectRestVarp)
 		})
 	}
@@ -123,13 +126,13 @@ func (x *SelectionModelInterface) OverrideSelectItem(cb func(SelectionModel, uin
 // GetSelectItem gets the "select_item" callback function.
 // Select the item in the given position. If the operation
 //
-//	is known to fail, return %FALSE.
+//	is known to fail, return false.
 func (x *SelectionModelInterface) GetSelectItem() func(SelectionModel, uint, bool) bool {
 	if x.xSelectItem == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint, UnselectRestVarp bool) bool
-	purego.RegisterFunc(&rawCallback, x.xSelectItem)
+	core.RegisterFunc(&rawCallback, x.xSelectItem)
 	return func(ModelVar SelectionModel, PositionVar uint, UnselectRestVar bool) bool {
 		return rawCallback(ModelVar.GoPointer(), PositionVar, UnselectRestVar)
 	}
@@ -138,12 +141,13 @@ func (x *SelectionModelInterface) GetSelectItem() func(SelectionModel, uint, boo
 // OverrideUnselectItem sets the "unselect_item" callback function.
 // Unselect the item in the given position. If the
 //
-//	operation is known to fail, return %FALSE.
+//	operation is known to fail, return false.
 func (x *SelectionModelInterface) OverrideUnselectItem(cb func(SelectionModel, uint) bool) {
 	if cb == nil {
 		x.xUnselectItem = 0
 	} else {
-		x.xUnselectItem = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint) bool {
+		x.xUnselectItem = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp)
 		})
 	}
@@ -152,13 +156,13 @@ func (x *SelectionModelInterface) OverrideUnselectItem(cb func(SelectionModel, u
 // GetUnselectItem gets the "unselect_item" callback function.
 // Unselect the item in the given position. If the
 //
-//	operation is known to fail, return %FALSE.
+//	operation is known to fail, return false.
 func (x *SelectionModelInterface) GetUnselectItem() func(SelectionModel, uint) bool {
 	if x.xUnselectItem == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint) bool
-	purego.RegisterFunc(&rawCallback, x.xUnselectItem)
+	core.RegisterFunc(&rawCallback, x.xUnselectItem)
 	return func(ModelVar SelectionModel, PositionVar uint) bool {
 		return rawCallback(ModelVar.GoPointer(), PositionVar)
 	}
@@ -167,12 +171,13 @@ func (x *SelectionModelInterface) GetUnselectItem() func(SelectionModel, uint) b
 // OverrideSelectRange sets the "select_range" callback function.
 // Select all items in the given range. If the operation
 //
-//	is unsupported or known to fail for all items, return %FALSE.
+//	is unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) OverrideSelectRange(cb func(SelectionModel, uint, uint, bool) bool) {
 	if cb == nil {
 		x.xSelectRange = 0
 	} else {
-		x.xSelectRange = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint, UnselectRestVarp bool) bool {
+		x.xSelectRange = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint, UnselectRestVarp bool) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp, NItemsVarp, UnselectRestVarp)
 		})
 	}
@@ -181,13 +186,13 @@ func (x *SelectionModelInterface) OverrideSelectRange(cb func(SelectionModel, ui
 // GetSelectRange gets the "select_range" callback function.
 // Select all items in the given range. If the operation
 //
-//	is unsupported or known to fail for all items, return %FALSE.
+//	is unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) GetSelectRange() func(SelectionModel, uint, uint, bool) bool {
 	if x.xSelectRange == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint, UnselectRestVarp bool) bool
-	purego.RegisterFunc(&rawCallback, x.xSelectRange)
+	core.RegisterFunc(&rawCallback, x.xSelectRange)
 	return func(ModelVar SelectionModel, PositionVar uint, NItemsVar uint, UnselectRestVar bool) bool {
 		return rawCallback(ModelVar.GoPointer(), PositionVar, NItemsVar, UnselectRestVar)
 	}
@@ -197,12 +202,13 @@ func (x *SelectionModelInterface) GetSelectRange() func(SelectionModel, uint, ui
 // Unselect all items in the given range. If the
 //
 //	operation is unsupported or known to fail for all items, return
-//	%FALSE.
+//	false.
 func (x *SelectionModelInterface) OverrideUnselectRange(cb func(SelectionModel, uint, uint) bool) {
 	if cb == nil {
 		x.xUnselectRange = 0
 	} else {
-		x.xUnselectRange = purego.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) bool {
+		x.xUnselectRange = core.NewCallback(func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, PositionVarp, NItemsVarp)
 		})
 	}
@@ -212,13 +218,13 @@ func (x *SelectionModelInterface) OverrideUnselectRange(cb func(SelectionModel,
 // Unselect all items in the given range. If the
 //
 //	operation is unsupported or known to fail for all items, return
-//	%FALSE.
+//	false.
 func (x *SelectionModelInterface) GetUnselectRange() func(SelectionModel, uint, uint) bool {
 	if x.xUnselectRange == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, PositionVarp uint, NItemsVarp uint) bool
-	purego.RegisterFunc(&rawCallback, x.xUnselectRange)
+	core.RegisterFunc(&rawCallback, x.xUnselectRange)
 	return func(ModelVar SelectionModel, PositionVar uint, NItemsVar uint) bool {
 		return rawCallback(ModelVar.GoPointer(), PositionVar, NItemsVar)
 	}
@@ -227,12 +233,13 @@ func (x *SelectionModelInterface) GetUnselectRange() func(SelectionModel, uint,
 // OverrideSelectAll sets the "select_all" callback function.
 // Select all items in the model. If the operation is
 //
-//	unsupported or known to fail for all items, return %FALSE.
+//	unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) OverrideSelectAll(cb func(SelectionModel) bool) {
 	if cb == nil {
 		x.xSelectAll = 0
 	} else {
-		x.xSelectAll = purego.NewCallback(func(ModelVarp uintptr) bool {
+		x.xSelectAll = core.NewCallback(func(ModelVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp})
 		})
 	}
@@ -241,13 +248,13 @@ func (x *SelectionModelInterface) OverrideSelectAll(cb func(SelectionModel) bool
 // GetSelectAll gets the "select_all" callback function.
 // Select all items in the model. If the operation is
 //
-//	unsupported or known to fail for all items, return %FALSE.
+//	unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) GetSelectAll() func(SelectionModel) bool {
 	if x.xSelectAll == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xSelectAll)
+	core.RegisterFunc(&rawCallback, x.xSelectAll)
 	return func(ModelVar SelectionModel) bool {
 		return rawCallback(ModelVar.GoPointer())
 	}
@@ -256,12 +263,13 @@ func (x *SelectionModelInterface) GetSelectAll() func(SelectionModel) bool {
 // OverrideUnselectAll sets the "unselect_all" callback function.
 // Unselect all items in the model. If the operation is
 //
-//	unsupported or known to fail for all items, return %FALSE.
+//	unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) OverrideUnselectAll(cb func(SelectionModel) bool) {
 	if cb == nil {
 		x.xUnselectAll = 0
 	} else {
-		x.xUnselectAll = purego.NewCallback(func(ModelVarp uintptr) bool {
+		x.xUnselectAll = core.NewCallback(func(ModelVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp})
 		})
 	}
@@ -270,13 +278,13 @@ func (x *SelectionModelInterface) OverrideUnselectAll(cb func(SelectionModel) bo
 // GetUnselectAll gets the "unselect_all" callback function.
 // Unselect all items in the model. If the operation is
 //
-//	unsupported or known to fail for all items, return %FALSE.
+//	unsupported or known to fail for all items, return false.
 func (x *SelectionModelInterface) GetUnselectAll() func(SelectionModel) bool {
 	if x.xUnselectAll == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xUnselectAll)
+	core.RegisterFunc(&rawCallback, x.xUnselectAll)
 	return func(ModelVar SelectionModel) bool {
 		return rawCallback(ModelVar.GoPointer())
 	}
@@ -285,13 +293,14 @@ func (x *SelectionModelInterface) GetUnselectAll() func(SelectionModel) bool {
 // OverrideSetSelection sets the "set_selection" callback function.
 // Set selection state of all items in mask to selected.
 //
-//	See gtk_selection_model_set_selection() for a detailed explanation
+//	See [SelectionModel.SetSelection] for a detailed explanation
 //	of this function.
 func (x *SelectionModelInterface) OverrideSetSelection(cb func(SelectionModel, *Bitset, *Bitset) bool) {
 	if cb == nil {
 		x.xSetSelection = 0
 	} else {
-		x.xSetSelection = purego.NewCallback(func(ModelVarp uintptr, SelectedVarp *Bitset, MaskVarp *Bitset) bool {
+		x.xSetSelection = core.NewCallback(func(ModelVarp uintptr, SelectedVarp *Bitset, MaskVarp *Bitset) bool {
+			defer core.RecoverPanic()
 			return cb(&SelectionModelBase{Ptr: ModelVarp}, SelectedVarp, MaskVarp)
 		})
 	}
@@ -300,14 +309,14 @@ func (x *SelectionModelInterface) OverrideSetSelection(cb func(SelectionModel, *
 // GetSetSelection gets the "set_selection" callback function.
 // Set selection state of all items in mask to selected.
 //
-//	See gtk_selection_model_set_selection() for a detailed explanation
+//	See [SelectionModel.SetSelection] for a detailed explanation
 //	of this function.
 func (x *SelectionModelInterface) GetSetSelection() func(SelectionModel, *Bitset, *Bitset) bool {
 	if x.xSetSelection == 0 {
 		return nil
 	}
 	var rawCallback func(ModelVarp uintptr, SelectedVarp *Bitset, MaskVarp *Bitset) bool
-	purego.RegisterFunc(&rawCallback, x.xSetSelection)
+	core.RegisterFunc(&rawCallback, x.xSetSelection)
 	return func(ModelVar SelectionModel, SelectedVar *Bitset, MaskVar *Bitset) bool {
 		return rawCallback(ModelVar.GoPointer(), SelectedVar, MaskVar)
 	}
@@ -319,21 +328,21 @@ func (x *SelectionModelInterface) GetSetSelection() func(SelectionModel, *Bitset
 // to select and unselect various items.
 //
 // GTK provides default implementations of the most common selection modes such
-// as [class@Gtk.SingleSelection], so you will only need to implement this
+// as [SingleSelection], so you will only need to implement this
 // interface if you want detailed control about how selections should be handled.
 //
 // A `GtkSelectionModel` supports a single boolean per item indicating if an item is
-// selected or not. This can be queried via [method@Gtk.SelectionModel.is_selected].
+// selected or not. This can be queried via [SelectionModel.IsSelected].
 // When the selected state of one or more items changes, the model will emit the
-// [signal@Gtk.SelectionModel::selection-changed] signal by calling the
-// [method@Gtk.SelectionModel.selection_changed] function. The positions given
+// SelectionModel::selection-changed signal by calling the
+// [SelectionModel.SelectionChanged] function. The positions given
 // in that signal may have their selection state changed, though that is not a
 // requirement. If new items added to the model via the
-// [signal@Gio.ListModel::items-changed] signal are selected or not is up to the
+// ListModel::items-changed signal are selected or not is up to the
 // implementation.
 //
-// Note that items added via [signal@Gio.ListModel::items-changed] may already
-// be selected and no [signal@Gtk.SelectionModel::selection-changed] will be
+// Note that items added via ListModel::items-changed may already
+// be selected and no SelectionModel::selection-changed will be
 // emitted for them. So to track which items are selected, it is necessary to
 // listen to both signals.
 //
@@ -387,11 +396,87 @@ func (x *SelectionModelBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementSelectionModel adds the SelectionModel interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - IsSelected(uint) bool
+//   - GetSelectionInRange(uint, uint) *Bitset
+//   - SelectItem(uint, bool) bool
+//   - UnselectItem(uint) bool
+//   - SelectRange(uint, uint, bool) bool
+//   - UnselectRange(uint, uint) bool
+//   - SelectAll() bool
+//   - UnselectAll() bool
+//   - SetSelection(*Bitset, *Bitset) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SelectionModelInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSelectionModel[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SelectionModelGLibType(), func(iface *SelectionModelInterface) {
+		if _, ok := any(zero).(interface{ IsSelected(uint) bool }); ok {
+			iface.OverrideIsSelected(func(self SelectionModel, arg0 uint) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IsSelected(uint) bool })
+				return impl.IsSelected(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSelectionInRange(uint, uint) *Bitset }); ok {
+			iface.OverrideGetSelectionInRange(func(self SelectionModel, arg0 uint, arg1 uint) *Bitset {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSelectionInRange(uint, uint) *Bitset })
+				return impl.GetSelectionInRange(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ SelectItem(uint, bool) bool }); ok {
+			iface.OverrideSelectItem(func(self SelectionModel, arg0 uint, arg1 bool) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SelectItem(uint, bool) bool })
+				return impl.SelectItem(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnselectItem(uint) bool }); ok {
+			iface.OverrideUnselectItem(func(self SelectionModel, arg0 uint) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnselectItem(uint) bool })
+				return impl.UnselectItem(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ SelectRange(uint, uint, bool) bool }); ok {
+			iface.OverrideSelectRange(func(self SelectionModel, arg0 uint, arg1 uint, arg2 bool) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SelectRange(uint, uint, bool) bool })
+				return impl.SelectRange(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnselectRange(uint, uint) bool }); ok {
+			iface.OverrideUnselectRange(func(self SelectionModel, arg0 uint, arg1 uint) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnselectRange(uint, uint) bool })
+				return impl.UnselectRange(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ SelectAll() bool }); ok {
+			iface.OverrideSelectAll(func(self SelectionModel) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SelectAll() bool })
+				return impl.SelectAll()
+			})
+		}
+		if _, ok := any(zero).(interface{ UnselectAll() bool }); ok {
+			iface.OverrideUnselectAll(func(self SelectionModel) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnselectAll() bool })
+				return impl.UnselectAll()
+			})
+		}
+		if _, ok := any(zero).(interface{ SetSelection(*Bitset, *Bitset) bool }); ok {
+			iface.OverrideSetSelection(func(self SelectionModel, arg0 *Bitset, arg1 *Bitset) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetSelection(*Bitset, *Bitset) bool })
+				return impl.SetSelection(arg0, arg1)
+			})
+		}
+	})
+}
+
 // Gets the set containing all currently selected items in the model.
 //
 // This function may be slow, so if you are only interested in single item,
-// consider using [method@Gtk.SelectionModel.is_selected] or if you are only
-// interested in a few, consider [method@Gtk.SelectionModel.get_selection_in_range].
+// consider using [SelectionModel.IsSelected] or if you are only
+// interested in a few, consider [SelectionModel.GetSelectionInRange].
 func (x *SelectionModelBase) GetSelection() *Bitset {
 
 	cret := XGtkSelectionModelGetSelection(x.GoPointer())
@@ -401,9 +486,9 @@ func (x *SelectionModelBase) GetSelection() *Bitset {
 // Gets the set of selected items in a range.
 //
 // This function is an optimization for
-// [method@Gtk.SelectionModel.get_selection] when you are only
+// [SelectionModel.GetSelection] when you are only
 // interested in part of the model's selected state. A common use
-// case is in response to the [signal@Gtk.SelectionModel::selection-changed]
+// case is in response to the SelectionModel::selection-changed
 // signal.
 func (x *SelectionModelBase) GetSelectionInRange(PositionVar uint, NItemsVar uint) *Bitset {
 
@@ -442,7 +527,7 @@ func (x *SelectionModelBase) SelectRange(PositionVar uint, NItemsVar uint, Unsel
 // Helper function for implementations of `GtkSelectionModel`.
 //
 // Call this when the selection changes to emit the
-// [signal@Gtk.SelectionModel::selection-changed] signal.
+// SelectionModel::selection-changed signal.
 func (x *SelectionModelBase) SelectionChanged(PositionVar uint, NItemsVar uint) {
 
 	XGtkSelectionModelSelectionChanged(x.GoPointer(), PositionVar, NItemsVar)
@@ -456,33 +541,28 @@ func (x *SelectionModelBase) SelectionChanged(PositionVar uint, NItemsVar uint)
 // you should try the simpler versions, as implementations are more
 // likely to implement support for those.
 //
-// Requests that the selection state of all positions set in @mask
-// be updated to the respective value in the @selected bitmask.
+// Requests that the selection state of all positions set in mask
+// be updated to the respective value in the selected bitmask.
 //
 // In pseudocode, it would look something like this:
 //
-// ```c
-// for (i = 0; i &lt; n_items; i++)
-//
-//	{
-//	  // don't change values not in the mask
-//	  if (!gtk_bitset_contains (mask, i))
-//	    continue;
-//
-//	  if (gtk_bitset_contains (selected, i))
-//	    select_item (i);
-//	  else
-//	    unselect_item (i);
-//	}
-//
-// gtk_selection_model_selection_changed (model,
+//	for (i = 0; i < n_items; i++)
+//	  {
+//	    // don't change values not in the mask
+//	    if (!gtk_bitset_contains (mask, i))
+//	      continue;
 //
-//	first_changed_item,
-//	n_changed_items);
+//	    if (gtk_bitset_contains (selected, i))
+//	      select_item (i);
+//	    else
+//	      unselect_item (i);
+//	  }
 //
-// ```
+//	gtk_selection_model_selection_changed (model,
+//	                                       first_changed_item,
+//	                                       n_changed_items);
 //
-// @mask and @selected must not be modified. They may refer to the
+// mask and selected must not be modified. They may refer to the
 // same bitset, which would mean that every item in the set should
 // be selected.
 func (x *SelectionModelBase) SetSelection(SelectedVar *Bitset, MaskVar *Bitset) bool {
@@ -529,7 +609,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -538,16 +618,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xSelectionModelGLibType, libs, "gtk_selection_model_get_type")
 
-	core.PuregoSafeRegister(&XGtkSelectionModelGetSelection, libs, "gtk_selection_model_get_selection")
-	core.PuregoSafeRegister(&XGtkSelectionModelGetSelectionInRange, libs, "gtk_selection_model_get_selection_in_range")
-	core.PuregoSafeRegister(&XGtkSelectionModelIsSelected, libs, "gtk_selection_model_is_selected")
-	core.PuregoSafeRegister(&XGtkSelectionModelSelectAll, libs, "gtk_selection_model_select_all")
-	core.PuregoSafeRegister(&XGtkSelectionModelSelectItem, libs, "gtk_selection_model_select_item")
-	core.PuregoSafeRegister(&XGtkSelectionModelSelectRange, libs, "gtk_selection_model_select_range")
-	core.PuregoSafeRegister(&XGtkSelectionModelSelectionChanged, libs, "gtk_selection_model_selection_changed")
-	core.PuregoSafeRegister(&XGtkSelectionModelSetSelection, libs, "gtk_selection_model_set_selection")
-	core.PuregoSafeRegister(&XGtkSelectionModelUnselectAll, libs, "gtk_selection_model_unselect_all")
-	core.PuregoSafeRegister(&XGtkSelectionModelUnselectItem, libs, "gtk_selection_model_unselect_item")
-	core.PuregoSafeRegister(&XGtkSelectionModelUnselectRange, libs, "gtk_selection_model_unselect_range")
+	gobject.CheckClassSize("GtkSelectionModelInterface", xSelectionModelGLibType, unsafe.Sizeof(SelectionModelInterface{}))
+
+	core.PuregoRegisterSince(&XGtkSelectionModelGetSelection, libs, "gtk_selection_model_get_selection", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelGetSelectionInRange, libs, "gtk_selection_model_get_selection_in_range", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelIsSelected, libs, "gtk_selection_model_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelSelectAll, libs, "gtk_selection_model_select_all", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelSelectItem, libs, "gtk_selection_model_select_item", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelSelectRange, libs, "gtk_selection_model_select_range", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelSelectionChanged, libs, "gtk_selection_model_selection_changed", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelSetSelection, libs, "gtk_selection_model_set_selection", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelUnselectAll, libs, "gtk_selection_model_unselect_all", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelUnselectItem, libs, "gtk_selection_model_unselect_item", "GTK", "")
+	core.PuregoRegisterSince(&XGtkSelectionModelUnselectRange, libs, "gtk_selection_model_unselect_range", "GTK", "")
 
 }
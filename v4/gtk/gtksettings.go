@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,12 +11,12 @@ import (
 // Provides a mechanism to share global settings between applications.
 //
 // On the X window system, this sharing is realized by an
-// [XSettings](http://www.freedesktop.org/wiki/Specifications/xsettings-spec)
+// [XSettings]
 // manager that is usually part of the desktop environment, along with
 // utilities that let the user change these settings.
 //
 // On Wayland, the settings are obtained either via a settings portal,
-// or by reading desktop settings from [class@Gio.Settings].
+// or by reading desktop settings from [gio.Settings].
 //
 // On macOS, the settings are obtained from `NSUserDefaults`.
 //
@@ -29,13 +28,15 @@ import (
 // next to their `gtk.css` file.
 //
 // Applications can override system-wide settings by setting the property
-// of the `GtkSettings` object with g_object_set(). This should be restricted
+// of the `GtkSettings` object with [gobject.Object.Set]. This should be restricted
 // to special cases though; `GtkSettings` are not meant as an application
 // configuration facility.
 //
 // There is one `GtkSettings` instance per display. It can be obtained with
-// [func@Gtk.Settings.get_for_display], but in many cases, it is more
-// convenient to use [method@Gtk.Widget.get_settings].
+// [SettingsGetForDisplay], but in many cases, it is more
+// convenient to use [Widget.GetSettings].
+//
+// [XSettings]: http://www.freedesktop.org/wiki/Specifications/xsettings-spec
 type Settings struct {
 	gobject.Object
 }
@@ -54,7 +55,7 @@ func SettingsNewFromInternalPtr(ptr uintptr) *Settings {
 
 var xSettingsResetProperty func(uintptr, string)
 
-// Undoes the effect of calling g_object_set() to install an
+// Undoes the effect of calling [gobject.Object.Set] to install an
 // application-specific value for a setting.
 //
 // After this call, the setting will again follow the session-wide
@@ -98,7 +99,7 @@ func (x *Settings) GetPropertyGtkAlternativeButtonOrder() bool {
 // views.
 //
 // By default an arrow pointing down means the column is sorted
-// in ascending order. When set to %TRUE, this order will be inverted.
+// in ascending order. When set to true, this order will be inverted.
 func (x *Settings) SetPropertyGtkAlternativeSortArrows(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -111,7 +112,7 @@ func (x *Settings) SetPropertyGtkAlternativeSortArrows(value bool) {
 // views.
 //
 // By default an arrow pointing down means the column is sorted
-// in ascending order. When set to %TRUE, this order will be inverted.
+// in ascending order. When set to true, this order will be inverted.
 func (x *Settings) GetPropertyGtkAlternativeSortArrows() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-alternative-sort-arrows", &v)
@@ -133,6 +134,8 @@ func (x *Settings) GetPropertyGtkAlternativeSortArrows() bool {
 // Dark themes should not be used for documents, where large spaces
 // are white/light and the dark chrome creates too much contrast
 // (web browser, text editor...).
+//
+// Deprecated: since 4.20. Use `GtkCssProvider` properties instead
 func (x *Settings) SetPropertyGtkApplicationPreferDarkTheme(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -155,6 +158,8 @@ func (x *Settings) SetPropertyGtkApplicationPreferDarkTheme(value bool) {
 // Dark themes should not be used for documents, where large spaces
 // are white/light and the dark chrome creates too much contrast
 // (web browser, text editor...).
+//
+// Deprecated: since 4.20. Use `GtkCssProvider` properties instead
 func (x *Settings) GetPropertyGtkApplicationPreferDarkTheme() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-application-prefer-dark-theme", &v)
@@ -181,7 +186,7 @@ func (x *Settings) GetPropertyGtkCursorAspectRatio() float64 {
 // SetPropertyGtkCursorBlink sets the "gtk-cursor-blink" property.
 // Whether the cursor should blink.
 //
-// Also see the [property@Gtk.Settings:gtk-cursor-blink-timeout] setting,
+// Also see the [Settings.GetPropertyGtkCursorBlinkTimeout] setting,
 // which allows more flexible control over cursor blinking.
 func (x *Settings) SetPropertyGtkCursorBlink(value bool) {
 	var v gobject.Value
@@ -193,7 +198,7 @@ func (x *Settings) SetPropertyGtkCursorBlink(value bool) {
 // GetPropertyGtkCursorBlink gets the "gtk-cursor-blink" property.
 // Whether the cursor should blink.
 //
-// Also see the [property@Gtk.Settings:gtk-cursor-blink-timeout] setting,
+// Also see the [Settings.GetPropertyGtkCursorBlinkTimeout] setting,
 // which allows more flexible control over cursor blinking.
 func (x *Settings) GetPropertyGtkCursorBlink() bool {
 	var v gobject.Value
@@ -224,7 +229,7 @@ func (x *Settings) GetPropertyGtkCursorBlinkTime() int {
 // The timer is reset after each user interaction.
 //
 // Setting this to zero has the same effect as setting
-// [property@Gtk.Settings:gtk-cursor-blink] to %FALSE.
+// [Settings.GetPropertyGtkCursorBlink] to false.
 func (x *Settings) SetPropertyGtkCursorBlinkTimeout(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -238,7 +243,7 @@ func (x *Settings) SetPropertyGtkCursorBlinkTimeout(value int) {
 // The timer is reset after each user interaction.
 //
 // Setting this to zero has the same effect as setting
-// [property@Gtk.Settings:gtk-cursor-blink] to %FALSE.
+// [Settings.GetPropertyGtkCursorBlink] to false.
 func (x *Settings) GetPropertyGtkCursorBlinkTimeout() int {
 	var v gobject.Value
 	x.GetProperty("gtk-cursor-blink-timeout", &v)
@@ -248,7 +253,7 @@ func (x *Settings) GetPropertyGtkCursorBlinkTimeout() int {
 // SetPropertyGtkCursorThemeName sets the "gtk-cursor-theme-name" property.
 // Name of the cursor theme to use.
 //
-// Use %NULL to use the default theme.
+// Use nil to use the default theme.
 func (x *Settings) SetPropertyGtkCursorThemeName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -259,7 +264,7 @@ func (x *Settings) SetPropertyGtkCursorThemeName(value string) {
 // GetPropertyGtkCursorThemeName gets the "gtk-cursor-theme-name" property.
 // Name of the cursor theme to use.
 //
-// Use %NULL to use the default theme.
+// Use nil to use the default theme.
 func (x *Settings) GetPropertyGtkCursorThemeName() string {
 	var v gobject.Value
 	x.GetProperty("gtk-cursor-theme-name", &v)
@@ -307,7 +312,7 @@ func (x *Settings) GetPropertyGtkCursorThemeSize() int {
 // that can be closed.
 //
 // Also note that the setting can be overridden with the
-// [property@Gtk.HeaderBar:decoration-layout] property.
+// [HeaderBar.GetPropertyDecorationLayout] property.
 func (x *Settings) SetPropertyGtkDecorationLayout(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -335,7 +340,7 @@ func (x *Settings) SetPropertyGtkDecorationLayout(value string) {
 // that can be closed.
 //
 // Also note that the setting can be overridden with the
-// [property@Gtk.HeaderBar:decoration-layout] property.
+// [HeaderBar.GetPropertyDecorationLayout] property.
 func (x *Settings) GetPropertyGtkDecorationLayout() string {
 	var v gobject.Value
 	x.GetProperty("gtk-decoration-layout", &v)
@@ -463,11 +468,13 @@ func (x *Settings) GetPropertyGtkEnableAnimations() bool {
 // SetPropertyGtkEnableEventSounds sets the "gtk-enable-event-sounds" property.
 // Whether to play any event sounds at all.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use a loadable
 // module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) SetPropertyGtkEnableEventSounds(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -478,11 +485,13 @@ func (x *Settings) SetPropertyGtkEnableEventSounds(value bool) {
 // GetPropertyGtkEnableEventSounds gets the "gtk-enable-event-sounds" property.
 // Whether to play any event sounds at all.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use a loadable
 // module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) GetPropertyGtkEnableEventSounds() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-enable-event-sounds", &v)
@@ -492,11 +501,13 @@ func (x *Settings) GetPropertyGtkEnableEventSounds() bool {
 // SetPropertyGtkEnableInputFeedbackSounds sets the "gtk-enable-input-feedback-sounds" property.
 // Whether to play event sounds as feedback to user input.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use a loadable
 // module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) SetPropertyGtkEnableInputFeedbackSounds(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -507,11 +518,13 @@ func (x *Settings) SetPropertyGtkEnableInputFeedbackSounds(value bool) {
 // GetPropertyGtkEnableInputFeedbackSounds gets the "gtk-enable-input-feedback-sounds" property.
 // Whether to play event sounds as feedback to user input.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use a loadable
 // module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) GetPropertyGtkEnableInputFeedbackSounds() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-enable-input-feedback-sounds", &v)
@@ -580,10 +593,10 @@ func (x *Settings) GetPropertyGtkEntrySelectOnFocus() bool {
 }
 
 // SetPropertyGtkErrorBell sets the "gtk-error-bell" property.
-// When %TRUE, keyboard navigation and other input-related errors
+// When true, keyboard navigation and other input-related errors
 // will cause a beep.
 //
-// Since the error bell is implemented using gdk_surface_beep(), the
+// Since the error bell is implemented using [gdk.Surface.Beep], the
 // windowing system may offer ways to configure the error bell in many
 // ways, such as flashing the window or similar visual effects.
 func (x *Settings) SetPropertyGtkErrorBell(value bool) {
@@ -594,10 +607,10 @@ func (x *Settings) SetPropertyGtkErrorBell(value bool) {
 }
 
 // GetPropertyGtkErrorBell gets the "gtk-error-bell" property.
-// When %TRUE, keyboard navigation and other input-related errors
+// When true, keyboard navigation and other input-related errors
 // will cause a beep.
 //
-// Since the error bell is implemented using gdk_surface_beep(), the
+// Since the error bell is implemented using [gdk.Surface.Beep], the
 // windowing system may offer ways to configure the error bell in many
 // ways, such as flashing the window or similar visual effects.
 func (x *Settings) GetPropertyGtkErrorBell() bool {
@@ -670,7 +683,7 @@ func (x *Settings) GetPropertyGtkHintFontMetrics() bool {
 // SetPropertyGtkIconThemeName sets the "gtk-icon-theme-name" property.
 // Name of the icon theme to use.
 //
-// See [class@Gtk.IconTheme] for details about how
+// See [IconTheme] for details about how
 // GTK handles icon themes.
 func (x *Settings) SetPropertyGtkIconThemeName(value string) {
 	var v gobject.Value
@@ -682,7 +695,7 @@ func (x *Settings) SetPropertyGtkIconThemeName(value string) {
 // GetPropertyGtkIconThemeName gets the "gtk-icon-theme-name" property.
 // Name of the icon theme to use.
 //
-// See [class@Gtk.IconTheme] for details about how
+// See [IconTheme] for details about how
 // GTK handles icon themes.
 func (x *Settings) GetPropertyGtkIconThemeName() string {
 	var v gobject.Value
@@ -698,7 +711,7 @@ func (x *Settings) GetPropertyGtkIconThemeName() string {
 // This also can be a colon-separated list of input methods, which GTK
 // will try in turn until it finds one available on the system.
 //
-// See [class@Gtk.IMContext].
+// See [IMContext].
 func (x *Settings) SetPropertyGtkImModule(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -714,7 +727,7 @@ func (x *Settings) SetPropertyGtkImModule(value string) {
 // This also can be a colon-separated list of input methods, which GTK
 // will try in turn until it finds one available on the system.
 //
-// See [class@Gtk.IMContext].
+// See [IMContext].
 func (x *Settings) GetPropertyGtkImModule() string {
 	var v gobject.Value
 	x.GetProperty("gtk-im-module", &v)
@@ -766,7 +779,7 @@ func (x *Settings) GetPropertyGtkLabelSelectOnFocus() bool {
 // SetPropertyGtkLongPressTime sets the "gtk-long-press-time" property.
 // The time for a button or touch press to be considered a “long press”.
 //
-// See [class@Gtk.GestureLongPress].
+// See [GestureLongPress].
 func (x *Settings) SetPropertyGtkLongPressTime(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -777,7 +790,7 @@ func (x *Settings) SetPropertyGtkLongPressTime(value uint) {
 // GetPropertyGtkLongPressTime gets the "gtk-long-press-time" property.
 // The time for a button or touch press to be considered a “long press”.
 //
-// See [class@Gtk.GestureLongPress].
+// See [GestureLongPress].
 func (x *Settings) GetPropertyGtkLongPressTime() uint {
 	var v gobject.Value
 	x.GetProperty("gtk-long-press-time", &v)
@@ -787,7 +800,7 @@ func (x *Settings) GetPropertyGtkLongPressTime() uint {
 // SetPropertyGtkOverlayScrolling sets the "gtk-overlay-scrolling" property.
 // Whether scrolled windows may use overlaid scrolling indicators.
 //
-// If this is set to %FALSE, scrolled windows will have permanent
+// If this is set to false, scrolled windows will have permanent
 // scrollbars.
 func (x *Settings) SetPropertyGtkOverlayScrolling(value bool) {
 	var v gobject.Value
@@ -799,7 +812,7 @@ func (x *Settings) SetPropertyGtkOverlayScrolling(value bool) {
 // GetPropertyGtkOverlayScrolling gets the "gtk-overlay-scrolling" property.
 // Whether scrolled windows may use overlaid scrolling indicators.
 //
-// If this is set to %FALSE, scrolled windows will have permanent
+// If this is set to false, scrolled windows will have permanent
 // scrollbars.
 func (x *Settings) GetPropertyGtkOverlayScrolling() bool {
 	var v gobject.Value
@@ -808,11 +821,11 @@ func (x *Settings) GetPropertyGtkOverlayScrolling() bool {
 }
 
 // SetPropertyGtkPrimaryButtonWarpsSlider sets the "gtk-primary-button-warps-slider" property.
-// If the value of this setting is %TRUE, clicking the primary button in a
+// If the value of this setting is true, clicking the primary button in a
 // `GtkRange` trough will move the slider, and hence set the range’s value, to
 // the point that you clicked.
 //
-// If it is %FALSE, a primary click will cause the slider/value to move
+// If it is false, a primary click will cause the slider/value to move
 // by the range’s page-size towards the point clicked.
 //
 // Whichever action you choose for the primary button, the other action will
@@ -826,11 +839,11 @@ func (x *Settings) SetPropertyGtkPrimaryButtonWarpsSlider(value bool) {
 }
 
 // GetPropertyGtkPrimaryButtonWarpsSlider gets the "gtk-primary-button-warps-slider" property.
-// If the value of this setting is %TRUE, clicking the primary button in a
+// If the value of this setting is true, clicking the primary button in a
 // `GtkRange` trough will move the slider, and hence set the range’s value, to
 // the point that you clicked.
 //
-// If it is %FALSE, a primary click will cause the slider/value to move
+// If it is false, a primary click will cause the slider/value to move
 // by the range’s page-size towards the point clicked.
 //
 // Whichever action you choose for the primary button, the other action will
@@ -874,7 +887,7 @@ func (x *Settings) GetPropertyGtkPrintBackends() string {
 // replaced by the path to the pdf file. The command may also
 // contain a `%s` placeholder, which will get replaced by the
 // path to a file containing the print settings in the format
-// produced by [method@Gtk.PrintSettings.to_file].
+// produced by [PrintSettings.ToFile].
 //
 // The preview application is responsible for removing the pdf
 // file and the print settings file when it is done.
@@ -892,7 +905,7 @@ func (x *Settings) SetPropertyGtkPrintPreviewCommand(value string) {
 // replaced by the path to the pdf file. The command may also
 // contain a `%s` placeholder, which will get replaced by the
 // path to a file containing the print settings in the format
-// produced by [method@Gtk.PrintSettings.to_file].
+// produced by [PrintSettings.ToFile].
 //
 // The preview application is responsible for removing the pdf
 // file and the print settings file when it is done.
@@ -906,7 +919,7 @@ func (x *Settings) GetPropertyGtkPrintPreviewCommand() string {
 // Whether GTK should keep track of items inside the recently used
 // resources list.
 //
-// If set to %FALSE, the list will always be empty.
+// If set to false, the list will always be empty.
 func (x *Settings) SetPropertyGtkRecentFilesEnabled(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -918,7 +931,7 @@ func (x *Settings) SetPropertyGtkRecentFilesEnabled(value bool) {
 // Whether GTK should keep track of items inside the recently used
 // resources list.
 //
-// If set to %FALSE, the list will always be empty.
+// If set to false, the list will always be empty.
 func (x *Settings) GetPropertyGtkRecentFilesEnabled() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-recent-files-enabled", &v)
@@ -953,8 +966,10 @@ func (x *Settings) GetPropertyGtkRecentFilesMaxAge() int {
 }
 
 // SetPropertyGtkShellShowsAppMenu sets the "gtk-shell-shows-app-menu" property.
-// Set to %TRUE if the desktop environment is displaying
-// the app menu, %FALSE if the app should display it itself.
+// Set to true if the desktop environment is displaying
+// the app menu, false if the app should display it itself.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) SetPropertyGtkShellShowsAppMenu(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -963,8 +978,10 @@ func (x *Settings) SetPropertyGtkShellShowsAppMenu(value bool) {
 }
 
 // GetPropertyGtkShellShowsAppMenu gets the "gtk-shell-shows-app-menu" property.
-// Set to %TRUE if the desktop environment is displaying
-// the app menu, %FALSE if the app should display it itself.
+// Set to true if the desktop environment is displaying
+// the app menu, false if the app should display it itself.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) GetPropertyGtkShellShowsAppMenu() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-shell-shows-app-menu", &v)
@@ -972,8 +989,10 @@ func (x *Settings) GetPropertyGtkShellShowsAppMenu() bool {
 }
 
 // SetPropertyGtkShellShowsDesktop sets the "gtk-shell-shows-desktop" property.
-// Set to %TRUE if the desktop environment is displaying
-// the desktop folder, %FALSE if not.
+// Set to true if the desktop environment is displaying
+// the desktop folder, false if not.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) SetPropertyGtkShellShowsDesktop(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -982,8 +1001,10 @@ func (x *Settings) SetPropertyGtkShellShowsDesktop(value bool) {
 }
 
 // GetPropertyGtkShellShowsDesktop gets the "gtk-shell-shows-desktop" property.
-// Set to %TRUE if the desktop environment is displaying
-// the desktop folder, %FALSE if not.
+// Set to true if the desktop environment is displaying
+// the desktop folder, false if not.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) GetPropertyGtkShellShowsDesktop() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-shell-shows-desktop", &v)
@@ -991,8 +1012,10 @@ func (x *Settings) GetPropertyGtkShellShowsDesktop() bool {
 }
 
 // SetPropertyGtkShellShowsMenubar sets the "gtk-shell-shows-menubar" property.
-// Set to %TRUE if the desktop environment is displaying
-// the menubar, %FALSE if the app should display it itself.
+// Set to true if the desktop environment is displaying
+// the menubar, false if the app should display it itself.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) SetPropertyGtkShellShowsMenubar(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1001,8 +1024,10 @@ func (x *Settings) SetPropertyGtkShellShowsMenubar(value bool) {
 }
 
 // GetPropertyGtkShellShowsMenubar gets the "gtk-shell-shows-menubar" property.
-// Set to %TRUE if the desktop environment is displaying
-// the menubar, %FALSE if the app should display it itself.
+// Set to true if the desktop environment is displaying
+// the menubar, false if the app should display it itself.
+//
+// Deprecated: since 4.20. This setting is not relevant anymore
 func (x *Settings) GetPropertyGtkShellShowsMenubar() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-shell-shows-menubar", &v)
@@ -1010,7 +1035,7 @@ func (x *Settings) GetPropertyGtkShellShowsMenubar() bool {
 }
 
 // SetPropertyGtkShowStatusShapes sets the "gtk-show-status-shapes" property.
-// When %TRUE, widgets like switches include shapes to indicate their on/off state.
+// When true, widgets like switches include shapes to indicate their on/off state.
 func (x *Settings) SetPropertyGtkShowStatusShapes(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -1019,7 +1044,7 @@ func (x *Settings) SetPropertyGtkShowStatusShapes(value bool) {
 }
 
 // GetPropertyGtkShowStatusShapes gets the "gtk-show-status-shapes" property.
-// When %TRUE, widgets like switches include shapes to indicate their on/off state.
+// When true, widgets like switches include shapes to indicate their on/off state.
 func (x *Settings) GetPropertyGtkShowStatusShapes() bool {
 	var v gobject.Value
 	x.GetProperty("gtk-show-status-shapes", &v)
@@ -1029,11 +1054,13 @@ func (x *Settings) GetPropertyGtkShowStatusShapes() bool {
 // SetPropertyGtkSoundThemeName sets the "gtk-sound-theme-name" property.
 // The XDG sound theme to use for event sounds.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use
 // a loadable module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) SetPropertyGtkSoundThemeName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1044,11 +1071,13 @@ func (x *Settings) SetPropertyGtkSoundThemeName(value string) {
 // GetPropertyGtkSoundThemeName gets the "gtk-sound-theme-name" property.
 // The XDG sound theme to use for event sounds.
 //
-// See the [Sound Theme Specifications](http://www.freedesktop.org/wiki/Specifications/sound-theme-spec)
+// See the [Sound Theme Specifications]
 // for more information on event sounds and sound themes.
 //
 // GTK itself does not support event sounds, you have to use
 // a loadable module like the one that comes with libcanberra.
+//
+// [Sound Theme Specifications]: http://www.freedesktop.org/wiki/Specifications/sound-theme-spec
 func (x *Settings) GetPropertyGtkSoundThemeName() string {
 	var v gobject.Value
 	x.GetProperty("gtk-sound-theme-name", &v)
@@ -1077,7 +1106,7 @@ func (x *Settings) GetPropertyGtkSplitCursor() bool {
 // SetPropertyGtkThemeName sets the "gtk-theme-name" property.
 // Name of the theme to load.
 //
-// See [class@Gtk.CssProvider] for details about how
+// See [CssProvider] for details about how
 // GTK finds the CSS stylesheet for a theme.
 func (x *Settings) SetPropertyGtkThemeName(value string) {
 	var v gobject.Value
@@ -1089,7 +1118,7 @@ func (x *Settings) SetPropertyGtkThemeName(value string) {
 // GetPropertyGtkThemeName gets the "gtk-theme-name" property.
 // Name of the theme to load.
 //
-// See [class@Gtk.CssProvider] for details about how
+// See [CssProvider] for details about how
 // GTK finds the CSS stylesheet for a theme.
 func (x *Settings) GetPropertyGtkThemeName() string {
 	var v gobject.Value
@@ -1290,7 +1319,7 @@ var xSettingsGetDefault func() uintptr
 // Gets the `GtkSettings` object for the default display, creating
 // it if necessary.
 //
-// See [func@Gtk.Settings.get_for_display].
+// See [SettingsGetForDisplay].
 func SettingsGetDefault() *Settings {
 	var cls *Settings
 
@@ -1307,7 +1336,7 @@ func SettingsGetDefault() *Settings {
 
 var xSettingsGetForDisplay func(uintptr) uintptr
 
-// Gets the `GtkSettings` object for @display, creating it if necessary.
+// Gets the `GtkSettings` object for display, creating it if necessary.
 func SettingsGetForDisplay(DisplayVar *gdk.Display) *Settings {
 	var cls *Settings
 
@@ -1327,7 +1356,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1336,9 +1365,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xSettingsGLibType, libs, "gtk_settings_get_type")
 
-	core.PuregoSafeRegister(&xSettingsResetProperty, libs, "gtk_settings_reset_property")
+	core.PuregoRegisterSince(&xSettingsResetProperty, libs, "gtk_settings_reset_property", "GTK", "")
 
-	core.PuregoSafeRegister(&xSettingsGetDefault, libs, "gtk_settings_get_default")
-	core.PuregoSafeRegister(&xSettingsGetForDisplay, libs, "gtk_settings_get_for_display")
+	core.PuregoRegisterSince(&xSettingsGetDefault, libs, "gtk_settings_get_default", "GTK", "")
+	core.PuregoRegisterSince(&xSettingsGetForDisplay, libs, "gtk_settings_get_for_display", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,10 +24,10 @@ func (x *ShortcutClass) GoPointer() uintptr {
 // Describes a keyboard shortcut.
 //
 // It contains a description of how to trigger the shortcut via a
-// [class@Gtk.ShortcutTrigger] and a way to activate the shortcut
-// on a widget via a [class@Gtk.ShortcutAction].
+// [ShortcutTrigger] and a way to activate the shortcut
+// on a widget via a [ShortcutAction].
 //
-// The actual work is usually done via [class@Gtk.ShortcutController],
+// The actual work is usually done via [ShortcutController],
 // which decides if and when to activate a shortcut. Using that controller
 // directly however is rarely necessary as various higher level
 // convenience APIs exist on `GtkWidget`s that make it easier to use
@@ -56,7 +55,7 @@ func ShortcutNewFromInternalPtr(ptr uintptr) *Shortcut {
 var xNewShortcut func(uintptr, uintptr) uintptr
 
 // Creates a new `GtkShortcut` that is triggered by
-// @trigger and then activates @action.
+// trigger and then activates action.
 func NewShortcut(TriggerVar *ShortcutTrigger, ActionVar *ShortcutAction) *Shortcut {
 	var cls *Shortcut
 
@@ -72,8 +71,8 @@ func NewShortcut(TriggerVar *ShortcutTrigger, ActionVar *ShortcutAction) *Shortc
 
 var xNewShortcutWithArguments func(uintptr, uintptr, uintptr, ...interface{}) uintptr
 
-// Creates a new `GtkShortcut` that is triggered by @trigger and then activates
-// @action with arguments given by @format_string.
+// Creates a new `GtkShortcut` that is triggered by trigger and then activates
+// action with arguments given by format_string.
 func NewShortcutWithArguments(TriggerVar *ShortcutTrigger, ActionVar *ShortcutAction, FormatStringVar *string, varArgs ...interface{}) *Shortcut {
 	var cls *Shortcut
 
@@ -118,7 +117,7 @@ func (x *Shortcut) GetArguments() *glib.Variant {
 
 var xShortcutGetTrigger func(uintptr) uintptr
 
-// Gets the trigger used to trigger @self.
+// Gets the trigger used to trigger self.
 func (x *Shortcut) GetTrigger() *ShortcutTrigger {
 	var cls *ShortcutTrigger
 
@@ -135,7 +134,7 @@ func (x *Shortcut) GetTrigger() *ShortcutTrigger {
 
 var xShortcutSetAction func(uintptr, uintptr)
 
-// Sets the new action for @self to be @action.
+// Sets the new action for self to be action.
 func (x *Shortcut) SetAction(ActionVar *ShortcutAction) {
 
 	xShortcutSetAction(x.GoPointer(), ActionVar.GoPointer())
@@ -153,7 +152,7 @@ func (x *Shortcut) SetArguments(ArgsVar *glib.Variant) {
 
 var xShortcutSetTrigger func(uintptr, uintptr)
 
-// Sets the new trigger for @self to be @trigger.
+// Sets the new trigger for self to be trigger.
 func (x *Shortcut) SetTrigger(TriggerVar *ShortcutTrigger) {
 
 	xShortcutSetTrigger(x.GoPointer(), TriggerVar.GoPointer())
@@ -193,7 +192,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -202,14 +201,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutGLibType, libs, "gtk_shortcut_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcut, libs, "gtk_shortcut_new")
-	core.PuregoSafeRegister(&xNewShortcutWithArguments, libs, "gtk_shortcut_new_with_arguments")
+	gobject.CheckClassSize("GtkShortcutClass", xShortcutGLibType, unsafe.Sizeof(ShortcutClass{}))
 
-	core.PuregoSafeRegister(&xShortcutGetAction, libs, "gtk_shortcut_get_action")
-	core.PuregoSafeRegister(&xShortcutGetArguments, libs, "gtk_shortcut_get_arguments")
-	core.PuregoSafeRegister(&xShortcutGetTrigger, libs, "gtk_shortcut_get_trigger")
-	core.PuregoSafeRegister(&xShortcutSetAction, libs, "gtk_shortcut_set_action")
-	core.PuregoSafeRegister(&xShortcutSetArguments, libs, "gtk_shortcut_set_arguments")
-	core.PuregoSafeRegister(&xShortcutSetTrigger, libs, "gtk_shortcut_set_trigger")
+	core.PuregoRegisterSince(&xNewShortcut, libs, "gtk_shortcut_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewShortcutWithArguments, libs, "gtk_shortcut_new_with_arguments", "GTK", "")
+
+	core.PuregoRegisterSince(&xShortcutGetAction, libs, "gtk_shortcut_get_action", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutGetArguments, libs, "gtk_shortcut_get_arguments", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutGetTrigger, libs, "gtk_shortcut_get_trigger", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutSetAction, libs, "gtk_shortcut_set_action", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutSetArguments, libs, "gtk_shortcut_set_arguments", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutSetTrigger, libs, "gtk_shortcut_set_trigger", "GTK", "")
 
 }
@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -74,7 +75,7 @@ func (x *SignalActionClass) GoPointer() uintptr {
 // Flags that can be passed to action activation.
 //
 // More flags may be added in the future.
-type ShortcutActionFlags int
+type ShortcutActionFlags uint32
 
 var xShortcutActionFlagsGLibType func() types.GType
 
@@ -90,9 +91,46 @@ const (
 	ShortcutActionExclusiveValue ShortcutActionFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ShortcutActionFlags) GLibType() types.GType {
+	return xShortcutActionFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ShortcutActionFlags) Has(flags ShortcutActionFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ShortcutActionFlags) Set(flags ShortcutActionFlags) ShortcutActionFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ShortcutActionFlags) Clear(flags ShortcutActionFlags) ShortcutActionFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ShortcutActionFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&ShortcutActionExclusiveValue != 0 && x&ShortcutActionExclusiveValue == ShortcutActionExclusiveValue {
+		names = append(names, "GTK_SHORTCUT_ACTION_EXCLUSIVE")
+		rest &^= ShortcutActionExclusiveValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Activates a widget.
 //
-// Widgets are activated by calling [method@Gtk.Widget.activate].
+// Widgets are activated by calling [Widget.Activate].
 type ActivateAction struct {
 	ShortcutAction
 }
@@ -124,7 +162,7 @@ var xActivateActionGet func() uintptr
 
 // Gets the activate action.
 //
-// This is an action that calls gtk_widget_activate()
+// This is an action that calls [Widget.Activate]
 // on the given widget upon activation.
 func ActivateActionGet() *ActivateAction {
 	var cls *ActivateAction
@@ -159,7 +197,7 @@ func CallbackActionNewFromInternalPtr(ptr uintptr) *CallbackAction {
 
 var xNewCallbackAction func(uintptr, uintptr, uintptr) uintptr
 
-// Create a custom action that calls the given @callback when
+// Create a custom action that calls the given callback when
 // activated.
 func NewCallbackAction(CallbackVar *ShortcutFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) *CallbackAction {
 	var cls *CallbackAction
@@ -171,11 +209,12 @@ func NewCallbackAction(CallbackVar *ShortcutFunc, DataVar uintptr, DestroyVar *g
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *glib.Variant, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -186,11 +225,12 @@ func NewCallbackAction(CallbackVar *ShortcutFunc, DataVar uintptr, DestroyVar *g
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -217,7 +257,7 @@ func (c *CallbackAction) SetGoPointer(ptr uintptr) {
 
 // Activates a widget with a mnemonic.
 //
-// This means that [method@Gtk.Widget.mnemonic_activate] is called.
+// This means that [Widget.MnemonicActivate] is called.
 type MnemonicAction struct {
 	ShortcutAction
 }
@@ -249,7 +289,7 @@ var xMnemonicActionGet func() uintptr
 
 // Gets the mnemonic action.
 //
-// This is an action that calls gtk_widget_mnemonic_activate()
+// This is an action that calls [Widget.MnemonicActivate]
 // on the given widget upon activation.
 func MnemonicActionGet() *MnemonicAction {
 	var cls *MnemonicAction
@@ -267,8 +307,8 @@ func MnemonicActionGet() *MnemonicAction {
 
 // Activates a named action.
 //
-// See [method@Gtk.WidgetClass.install_action] and
-// [method@Gtk.Widget.insert_action_group] for ways
+// See [WidgetClass.InstallAction] and
+// [Widget.InsertActionGroup] for ways
 // to associate named actions with widgets.
 type NamedAction struct {
 	ShortcutAction
@@ -293,7 +333,7 @@ var xNewNamedAction func(string) uintptr
 //
 // It also passes the given arguments to it.
 //
-// See [method@Gtk.Widget.insert_action_group] for
+// See [Widget.InsertActionGroup] for
 // how to add actions to widgets.
 func NewNamedAction(NameVar string) *NamedAction {
 	var cls *NamedAction
@@ -403,22 +443,22 @@ func NothingActionGet() *NothingAction {
 // have to replace it with a new one. If you need to pass arguments to
 // an action, these are specified by the higher-level `GtkShortcut` object.
 //
-// To activate a `GtkShortcutAction` manually, [method@Gtk.ShortcutAction.activate]
+// To activate a `GtkShortcutAction` manually, [ShortcutAction.Activate]
 // can be called.
 //
 // GTK provides various actions:
 //
-//   - [class@Gtk.MnemonicAction]: a shortcut action that calls
-//     gtk_widget_mnemonic_activate()
-//   - [class@Gtk.CallbackAction]: a shortcut action that invokes
+//   - [MnemonicAction]: a shortcut action that calls
+//     [Widget.MnemonicActivate]
+//   - [CallbackAction]: a shortcut action that invokes
 //     a given callback
-//   - [class@Gtk.SignalAction]: a shortcut action that emits a
+//   - [SignalAction]: a shortcut action that emits a
 //     given signal
-//   - [class@Gtk.ActivateAction]: a shortcut action that calls
-//     gtk_widget_activate()
-//   - [class@Gtk.NamedAction]: a shortcut action that calls
-//     gtk_widget_activate_action()
-//   - [class@Gtk.NothingAction]: a shortcut action that does nothing
+//   - [ActivateAction]: a shortcut action that calls
+//     [Widget.Activate]
+//   - [NamedAction]: a shortcut action that calls
+//     [Widget.ActivateAction]
+//   - [NothingAction]: a shortcut action that does nothing
 type ShortcutAction struct {
 	gobject.Object
 }
@@ -440,7 +480,7 @@ var xShortcutActionParseString func(string) uintptr
 // Tries to parse the given string into an action.
 //
 // On success, the parsed action is returned. When parsing
-// failed, %NULL is returned.
+// failed, nil is returned.
 //
 // The accepted strings are:
 //
@@ -464,13 +504,13 @@ func ShortcutActionParseString(StringVar string) *ShortcutAction {
 
 var xShortcutActionActivate func(uintptr, ShortcutActionFlags, uintptr, *glib.Variant) bool
 
-// Activates the action on the @widget with the given @args.
+// Activates the action on the widget with the given args.
 //
-// Note that some actions ignore the passed in @flags, @widget or @args.
+// Note that some actions ignore the passed in flags, widget or args.
 //
 // Activation of an action can fail for various reasons. If the action
-// is not supported by the @widget, if the @args don't match the action
-// or if the activation otherwise had no effect, %FALSE will be returned.
+// is not supported by the widget, if the args don't match the action
+// or if the activation otherwise had no effect, false will be returned.
 func (x *ShortcutAction) Activate(FlagsVar ShortcutActionFlags, WidgetVar *Widget, ArgsVar *glib.Variant) bool {
 
 	cret := xShortcutActionActivate(x.GoPointer(), FlagsVar, WidgetVar.GoPointer(), ArgsVar)
@@ -495,7 +535,7 @@ var xShortcutActionToString func(uintptr) string
 
 // Prints the given action into a human-readable string.
 //
-// This is a small wrapper around [method@Gtk.ShortcutAction.print]
+// This is a small wrapper around [ShortcutAction.Print]
 // to help when debugging.
 func (x *ShortcutAction) ToString() string {
 
@@ -595,7 +635,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -606,38 +646,38 @@ func init() {
 
 	core.PuregoSafeRegister(&xActivateActionGLibType, libs, "gtk_activate_action_get_type")
 
-	core.PuregoSafeRegister(&xActivateActionGet, libs, "gtk_activate_action_get")
+	core.PuregoRegisterSince(&xActivateActionGet, libs, "gtk_activate_action_get", "GTK", "")
 
 	core.PuregoSafeRegister(&xCallbackActionGLibType, libs, "gtk_callback_action_get_type")
 
-	core.PuregoSafeRegister(&xNewCallbackAction, libs, "gtk_callback_action_new")
+	core.PuregoRegisterSince(&xNewCallbackAction, libs, "gtk_callback_action_new", "GTK", "")
 
 	core.PuregoSafeRegister(&xMnemonicActionGLibType, libs, "gtk_mnemonic_action_get_type")
 
-	core.PuregoSafeRegister(&xMnemonicActionGet, libs, "gtk_mnemonic_action_get")
+	core.PuregoRegisterSince(&xMnemonicActionGet, libs, "gtk_mnemonic_action_get", "GTK", "")
 
 	core.PuregoSafeRegister(&xNamedActionGLibType, libs, "gtk_named_action_get_type")
 
-	core.PuregoSafeRegister(&xNewNamedAction, libs, "gtk_named_action_new")
+	core.PuregoRegisterSince(&xNewNamedAction, libs, "gtk_named_action_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xNamedActionGetActionName, libs, "gtk_named_action_get_action_name")
+	core.PuregoRegisterSince(&xNamedActionGetActionName, libs, "gtk_named_action_get_action_name", "GTK", "")
 
 	core.PuregoSafeRegister(&xNothingActionGLibType, libs, "gtk_nothing_action_get_type")
 
-	core.PuregoSafeRegister(&xNothingActionGet, libs, "gtk_nothing_action_get")
+	core.PuregoRegisterSince(&xNothingActionGet, libs, "gtk_nothing_action_get", "GTK", "")
 
 	core.PuregoSafeRegister(&xShortcutActionGLibType, libs, "gtk_shortcut_action_get_type")
 
-	core.PuregoSafeRegister(&xShortcutActionParseString, libs, "gtk_shortcut_action_parse_string")
+	core.PuregoRegisterSince(&xShortcutActionParseString, libs, "gtk_shortcut_action_parse_string", "GTK", "")
 
-	core.PuregoSafeRegister(&xShortcutActionActivate, libs, "gtk_shortcut_action_activate")
-	core.PuregoSafeRegister(&xShortcutActionPrint, libs, "gtk_shortcut_action_print")
-	core.PuregoSafeRegister(&xShortcutActionToString, libs, "gtk_shortcut_action_to_string")
+	core.PuregoRegisterSince(&xShortcutActionActivate, libs, "gtk_shortcut_action_activate", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutActionPrint, libs, "gtk_shortcut_action_print", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutActionToString, libs, "gtk_shortcut_action_to_string", "GTK", "")
 
 	core.PuregoSafeRegister(&xSignalActionGLibType, libs, "gtk_signal_action_get_type")
 
-	core.PuregoSafeRegister(&xNewSignalAction, libs, "gtk_signal_action_new")
+	core.PuregoRegisterSince(&xNewSignalAction, libs, "gtk_signal_action_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSignalActionGetSignalName, libs, "gtk_signal_action_get_signal_name")
+	core.PuregoRegisterSince(&xSignalActionGetSignalName, libs, "gtk_signal_action_get_signal_name", "GTK", "")
 
 }
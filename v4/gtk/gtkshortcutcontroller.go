@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -24,44 +23,41 @@ func (x *ShortcutControllerClass) GoPointer() uintptr {
 // Manages keyboard shortcuts and their activation.
 //
 // Most common shortcuts are using this controller implicitly, e.g. by
-// adding a mnemonic underline to a [class@Gtk.Label], or by installing a key
-// binding using [method@Gtk.WidgetClass.add_binding], or by adding accelerators
-// to global actions using [method@Gtk.Application.set_accels_for_action].
+// adding a mnemonic underline to a [Label], or by installing a key
+// binding using [WidgetClass.AddBinding], or by adding accelerators
+// to global actions using [Application.SetAccelsForAction].
 //
 // But it is possible to create your own shortcut controller, and add
 // shortcuts to it.
 //
-// `GtkShortcutController` implements [iface@Gio.ListModel] for querying the
+// `GtkShortcutController` implements [gio.ListModel] for querying the
 // shortcuts that have been added to it.
 //
 // # GtkShortcutController as GtkBuildable
 //
-// `GtkShortcutController`s can be created in [class@Gtk.Builder] ui files, to set up
+// `GtkShortcutController`s can be created in [Builder] ui files, to set up
 // shortcuts in the same place as the widgets.
 //
 // An example of a UI definition fragment with `GtkShortcutController`:
-// ```xml
-//
-//	&lt;object class='GtkButton'&gt;
-//	  &lt;child&gt;
-//	    &lt;object class='GtkShortcutController'&gt;
-//	      &lt;property name='scope'&gt;managed&lt;/property&gt;
-//	      &lt;child&gt;
-//	        &lt;object class='GtkShortcut'&gt;
-//	          &lt;property name='trigger'&gt;&amp;lt;Control&amp;gt;k&lt;/property&gt;
-//	          &lt;property name='action'&gt;activate&lt;/property&gt;
-//	        &lt;/object&gt;
-//	      &lt;/child&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	&lt;/object&gt;
-//
-// ```
-//
-// This example creates a [class@Gtk.ActivateAction] for triggering the
-// `activate` signal of the [class@Gtk.Button]. See [ctor@Gtk.ShortcutAction.parse_string]
-// for the syntax for other kinds of [class@Gtk.ShortcutAction]. See
-// [ctor@Gtk.ShortcutTrigger.parse_string] to learn more about the syntax
+//
+//	<object class='GtkButton'>
+//	  <child>
+//	    <object class='GtkShortcutController'>
+//	      <property name='scope'>managed</property>
+//	      <child>
+//	        <object class='GtkShortcut'>
+//	          <property name='trigger'>&lt;Control&gt;k</property>
+//	          <property name='action'>activate</property>
+//	        </object>
+//	      </child>
+//	    </object>
+//	  </child>
+//	</object>
+//
+// This example creates a [ActivateAction] for triggering the
+// `activate` signal of the [Button]. See [ShortcutActionParseString]
+// for the syntax for other kinds of [ShortcutAction]. See
+// [ShortcutTriggerParseString] to learn more about the syntax
 // for triggers.
 type ShortcutController struct {
 	EventController
@@ -118,7 +114,7 @@ func NewShortcutControllerForModel(ModelVar gio.ListModel) *ShortcutController {
 
 var xShortcutControllerAddShortcut func(uintptr, uintptr)
 
-// Adds @shortcut to the list of shortcuts handled by @self.
+// Adds shortcut to the list of shortcuts handled by self.
 //
 // If this controller uses an external shortcut list, this
 // function does nothing.
@@ -141,7 +137,7 @@ var xShortcutControllerGetScope func(uintptr) ShortcutScope
 
 // Gets the scope for when this controller activates its shortcuts.
 //
-// See [method@Gtk.ShortcutController.set_scope] for details.
+// See [ShortcutController.SetScope] for details.
 func (x *ShortcutController) GetScope() ShortcutScope {
 
 	cret := xShortcutControllerGetScope(x.GoPointer())
@@ -150,9 +146,9 @@ func (x *ShortcutController) GetScope() ShortcutScope {
 
 var xShortcutControllerRemoveShortcut func(uintptr, uintptr)
 
-// Removes @shortcut from the list of shortcuts handled by @self.
+// Removes shortcut from the list of shortcuts handled by self.
 //
-// If @shortcut had not been added to @controller or this controller
+// If shortcut had not been added to controller or this controller
 // uses an external shortcut list, this function does nothing.
 func (x *ShortcutController) RemoveShortcut(ShortcutVar *Shortcut) {
 
@@ -183,14 +179,14 @@ func (x *ShortcutController) SetMnemonicsModifiers(ModifiersVar gdk.ModifierType
 
 var xShortcutControllerSetScope func(uintptr, ShortcutScope)
 
-// Sets the controller to have the given @scope.
+// Sets the controller to have the given scope.
 //
 // The scope allows shortcuts to be activated outside of the normal
 // event propagation. In particular, it allows installing global
 // keyboard shortcuts that can be activated even when a widget does
 // not have focus.
 //
-// With %GTK_SHORTCUT_SCOPE_LOCAL, shortcuts will only be activated
+// With [ShortcutScopeLocalValue], shortcuts will only be activated
 // when the widget has focus.
 func (x *ShortcutController) SetScope(ScopeVar ShortcutScope) {
 
@@ -210,35 +206,37 @@ func (c *ShortcutController) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *ShortcutController) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *ShortcutController) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *ShortcutController) GetItemType() types.GType {
 
@@ -246,29 +244,29 @@ func (x *ShortcutController) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *ShortcutController) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *ShortcutController) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -282,20 +280,20 @@ func (x *ShortcutController) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -308,10 +306,10 @@ func (x *ShortcutController) ItemsChanged(PositionVar uint, RemovedVar uint, Add
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutController) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -323,7 +321,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -332,14 +330,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xShortcutControllerGLibType, libs, "gtk_shortcut_controller_get_type")
 
-	core.PuregoSafeRegister(&xNewShortcutController, libs, "gtk_shortcut_controller_new")
-	core.PuregoSafeRegister(&xNewShortcutControllerForModel, libs, "gtk_shortcut_controller_new_for_model")
+	core.PuregoRegisterSince(&xNewShortcutController, libs, "gtk_shortcut_controller_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewShortcutControllerForModel, libs, "gtk_shortcut_controller_new_for_model", "GTK", "")
 
-	core.PuregoSafeRegister(&xShortcutControllerAddShortcut, libs, "gtk_shortcut_controller_add_shortcut")
-	core.PuregoSafeRegister(&xShortcutControllerGetMnemonicsModifiers, libs, "gtk_shortcut_controller_get_mnemonics_modifiers")
-	core.PuregoSafeRegister(&xShortcutControllerGetScope, libs, "gtk_shortcut_controller_get_scope")
-	core.PuregoSafeRegister(&xShortcutControllerRemoveShortcut, libs, "gtk_shortcut_controller_remove_shortcut")
-	core.PuregoSafeRegister(&xShortcutControllerSetMnemonicsModifiers, libs, "gtk_shortcut_controller_set_mnemonics_modifiers")
-	core.PuregoSafeRegister(&xShortcutControllerSetScope, libs, "gtk_shortcut_controller_set_scope")
+	core.PuregoRegisterSince(&xShortcutControllerAddShortcut, libs, "gtk_shortcut_controller_add_shortcut", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutControllerGetMnemonicsModifiers, libs, "gtk_shortcut_controller_get_mnemonics_modifiers", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutControllerGetScope, libs, "gtk_shortcut_controller_get_scope", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutControllerRemoveShortcut, libs, "gtk_shortcut_controller_remove_shortcut", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutControllerSetMnemonicsModifiers, libs, "gtk_shortcut_controller_set_mnemonics_modifiers", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutControllerSetScope, libs, "gtk_shortcut_controller_set_scope", "GTK", "")
 
 }
@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -21,7 +19,9 @@ func (x *ShortcutLabelClass) GoPointer() uintptr {
 
 // `GtkShortcutLabel` displays a single keyboard shortcut or gesture.
 //
-// The main use case for `GtkShortcutLabel` is inside a [class@Gtk.ShortcutsWindow].
+// The main use case for `GtkShortcutLabel` is inside a [ShortcutsWindow].
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 type ShortcutLabel struct {
 	Widget
 }
@@ -38,59 +38,6 @@ func ShortcutLabelNewFromInternalPtr(ptr uintptr) *ShortcutLabel {
 	return cls
 }
 
-var xNewShortcutLabel func(string) uintptr
-
-// Creates a new `GtkShortcutLabel` with @accelerator set.
-func NewShortcutLabel(AcceleratorVar string) *ShortcutLabel {
-	var cls *ShortcutLabel
-
-	cret := xNewShortcutLabel(AcceleratorVar)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &ShortcutLabel{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xShortcutLabelGetAccelerator func(uintptr) string
-
-// Retrieves the current accelerator of @self.
-func (x *ShortcutLabel) GetAccelerator() string {
-
-	cret := xShortcutLabelGetAccelerator(x.GoPointer())
-	return cret
-}
-
-var xShortcutLabelGetDisabledText func(uintptr) string
-
-// Retrieves the text that is displayed when no accelerator is set.
-func (x *ShortcutLabel) GetDisabledText() string {
-
-	cret := xShortcutLabelGetDisabledText(x.GoPointer())
-	return cret
-}
-
-var xShortcutLabelSetAccelerator func(uintptr, string)
-
-// Sets the accelerator to be displayed by @self.
-func (x *ShortcutLabel) SetAccelerator(AcceleratorVar string) {
-
-	xShortcutLabelSetAccelerator(x.GoPointer(), AcceleratorVar)
-
-}
-
-var xShortcutLabelSetDisabledText func(uintptr, string)
-
-// Sets the text to be displayed by @self when no accelerator is set.
-func (x *ShortcutLabel) SetDisabledText(DisabledTextVar string) {
-
-	xShortcutLabelSetDisabledText(x.GoPointer(), DisabledTextVar)
-
-}
-
 func (c *ShortcutLabel) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -103,10 +50,12 @@ func (c *ShortcutLabel) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyAccelerator sets the "accelerator" property.
-// The accelerator that @self displays.
+// The accelerator that self displays.
 //
-// See [property@Gtk.ShortcutsShortcut:accelerator]
+// See [ShortcutsShortcut.GetPropertyAccelerator]
 // for the accepted syntax.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutLabel) SetPropertyAccelerator(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -115,10 +64,12 @@ func (x *ShortcutLabel) SetPropertyAccelerator(value string) {
 }
 
 // GetPropertyAccelerator gets the "accelerator" property.
-// The accelerator that @self displays.
+// The accelerator that self displays.
 //
-// See [property@Gtk.ShortcutsShortcut:accelerator]
+// See [ShortcutsShortcut.GetPropertyAccelerator]
 // for the accepted syntax.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutLabel) GetPropertyAccelerator() string {
 	var v gobject.Value
 	x.GetProperty("accelerator", &v)
@@ -127,6 +78,8 @@ func (x *ShortcutLabel) GetPropertyAccelerator() string {
 
 // SetPropertyDisabledText sets the "disabled-text" property.
 // The text that is displayed when no accelerator is set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutLabel) SetPropertyDisabledText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -136,6 +89,8 @@ func (x *ShortcutLabel) SetPropertyDisabledText(value string) {
 
 // GetPropertyDisabledText gets the "disabled-text" property.
 // The text that is displayed when no accelerator is set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutLabel) GetPropertyDisabledText() string {
 	var v gobject.Value
 	x.GetProperty("disabled-text", &v)
@@ -303,7 +258,7 @@ func (x *ShortcutLabel) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -311,14 +266,13 @@ func (x *ShortcutLabel) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -342,19 +296,17 @@ func (x *ShortcutLabel) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -375,7 +327,7 @@ func (x *ShortcutLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -384,14 +336,12 @@ func (x *ShortcutLabel) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutLabel) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -410,35 +360,12 @@ func (x *ShortcutLabel) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutLabel) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xShortcutLabelGLibType, libs, "gtk_shortcut_label_get_type")
-
-	core.PuregoSafeRegister(&xNewShortcutLabel, libs, "gtk_shortcut_label_new")
-
-	core.PuregoSafeRegister(&xShortcutLabelGetAccelerator, libs, "gtk_shortcut_label_get_accelerator")
-	core.PuregoSafeRegister(&xShortcutLabelGetDisabledText, libs, "gtk_shortcut_label_get_disabled_text")
-	core.PuregoSafeRegister(&xShortcutLabelSetAccelerator, libs, "gtk_shortcut_label_set_accelerator")
-	core.PuregoSafeRegister(&xShortcutLabelSetDisabledText, libs, "gtk_shortcut_label_set_disabled_text")
-
-}
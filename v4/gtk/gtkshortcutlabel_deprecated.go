@@ -0,0 +1,93 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewShortcutLabel func(string) uintptr
+
+// Creates a new `GtkShortcutLabel` with accelerator set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func NewShortcutLabel(AcceleratorVar string) *ShortcutLabel {
+	var cls *ShortcutLabel
+
+	cret := xNewShortcutLabel(AcceleratorVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &ShortcutLabel{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xShortcutLabelGetAccelerator func(uintptr) string
+
+// Retrieves the current accelerator of self.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutLabel) GetAccelerator() string {
+
+	cret := xShortcutLabelGetAccelerator(x.GoPointer())
+	return cret
+}
+
+var xShortcutLabelGetDisabledText func(uintptr) string
+
+// Retrieves the text that is displayed when no accelerator is set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutLabel) GetDisabledText() string {
+
+	cret := xShortcutLabelGetDisabledText(x.GoPointer())
+	return cret
+}
+
+var xShortcutLabelSetAccelerator func(uintptr, string)
+
+// Sets the accelerator to be displayed by self.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutLabel) SetAccelerator(AcceleratorVar string) {
+
+	xShortcutLabelSetAccelerator(x.GoPointer(), AcceleratorVar)
+
+}
+
+var xShortcutLabelSetDisabledText func(uintptr, string)
+
+// Sets the text to be displayed by self when no accelerator is set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutLabel) SetDisabledText(DisabledTextVar string) {
+
+	xShortcutLabelSetDisabledText(x.GoPointer(), DisabledTextVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewShortcutLabel, libs, "gtk_shortcut_label_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xShortcutLabelGetAccelerator, libs, "gtk_shortcut_label_get_accelerator", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutLabelGetDisabledText, libs, "gtk_shortcut_label_get_disabled_text", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutLabelSetAccelerator, libs, "gtk_shortcut_label_set_accelerator", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutLabelSetDisabledText, libs, "gtk_shortcut_label_set_disabled_text", "GTK", "")
+
+}
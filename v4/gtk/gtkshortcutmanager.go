@@ -5,7 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -17,7 +18,7 @@ import (
 type ShortcutManagerInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xAddController uintptr
 
@@ -34,7 +35,8 @@ func (x *ShortcutManagerInterface) OverrideAddController(cb func(ShortcutManager
 	if cb == nil {
 		x.xAddController = 0
 	} else {
-		x.xAddController = purego.NewCallback(func(SelfVarp uintptr, ControllerVarp uintptr) {
+		x.xAddController = core.NewCallback(func(SelfVarp uintptr, ControllerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ShortcutManagerBase{Ptr: SelfVarp}, ShortcutControllerNewFromInternalPtr(ControllerVarp))
 		})
 	}
@@ -47,7 +49,7 @@ func (x *ShortcutManagerInterface) GetAddController() func(ShortcutManager, *Sho
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ControllerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xAddController)
+	core.RegisterFunc(&rawCallback, x.xAddController)
 	return func(SelfVar ShortcutManager, ControllerVar *ShortcutController) {
 		rawCallback(SelfVar.GoPointer(), ControllerVar.GoPointer())
 	}
@@ -61,7 +63,8 @@ func (x *ShortcutManagerInterface) OverrideRemoveController(cb func(ShortcutMana
 	if cb == nil {
 		x.xRemoveController = 0
 	} else {
-		x.xRemoveController = purego.NewCallback(func(SelfVarp uintptr, ControllerVarp uintptr) {
+		x.xRemoveController = core.NewCallback(func(SelfVarp uintptr, ControllerVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&ShortcutManagerBase{Ptr: SelfVarp}, ShortcutControllerNewFromInternalPtr(ControllerVarp))
 		})
 	}
@@ -76,7 +79,7 @@ func (x *ShortcutManagerInterface) GetRemoveController() func(ShortcutManager, *
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ControllerVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRemoveController)
+	core.RegisterFunc(&rawCallback, x.xRemoveController)
 	return func(SelfVar ShortcutManager, ControllerVar *ShortcutController) {
 		rawCallback(SelfVar.GoPointer(), ControllerVar.GoPointer())
 	}
@@ -84,12 +87,12 @@ func (x *ShortcutManagerInterface) GetRemoveController() func(ShortcutManager, *
 
 // An interface that is used to implement shortcut scopes.
 //
-// This is important for [iface@Gtk.Native] widgets that have their
+// This is important for [Native] widgets that have their
 // own surface, since the event controllers that are used to implement
 // managed and global scopes are limited to the same native.
 //
 // Examples for widgets implementing `GtkShortcutManager` are
-// [class@Gtk.Window] and [class@Gtk.Popover].
+// [Window] and [Popover].
 //
 // Every widget that implements `GtkShortcutManager` will be used as a
 // `GTK_SHORTCUT_SCOPE_MANAGED`.
@@ -118,3 +121,30 @@ func (x *ShortcutManagerBase) GoPointer() uintptr {
 func (x *ShortcutManagerBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
+
+// ImplementShortcutManager adds the ShortcutManager interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - AddController(*ShortcutController)
+//   - RemoveController(*ShortcutController)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see ShortcutManagerInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementShortcutManager[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, ShortcutManagerGLibType(), func(iface *ShortcutManagerInterface) {
+		if _, ok := any(zero).(interface{ AddController(*ShortcutController) }); ok {
+			iface.OverrideAddController(func(self ShortcutManager, arg0 *ShortcutController) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ AddController(*ShortcutController) })
+				impl.AddController(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ RemoveController(*ShortcutController) }); ok {
+			iface.OverrideRemoveController(func(self ShortcutManager, arg0 *ShortcutController) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RemoveController(*ShortcutController) })
+				impl.RemoveController(arg0)
+			})
+		}
+	})
+}
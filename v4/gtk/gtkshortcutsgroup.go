@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -26,15 +24,17 @@ func (x *ShortcutsGroupClass) GoPointer() uintptr {
 // of the application, which can be used to show only relevant shortcuts
 // depending on the application context.
 //
-// This widget is only meant to be used with [class@Gtk.ShortcutsWindow].
+// This widget is only meant to be used with [ShortcutsWindow].
 //
 // The recommended way to construct a `GtkShortcutsGroup` is with
-// [class@Gtk.Builder], by using the `&lt;child&gt;` tag to populate a
-// `GtkShortcutsGroup` with one or more [class@Gtk.ShortcutsShortcut]
+// [Builder], by using the `<child>` tag to populate a
+// `GtkShortcutsGroup` with one or more [ShortcutsShortcut]
 // instances.
 //
 // If you need to add a shortcut programmatically, use
-// [method@Gtk.ShortcutsGroup.add_shortcut].
+// [ShortcutsGroup.AddShortcut].
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 type ShortcutsGroup struct {
 	Box
 }
@@ -51,19 +51,6 @@ func ShortcutsGroupNewFromInternalPtr(ptr uintptr) *ShortcutsGroup {
 	return cls
 }
 
-var xShortcutsGroupAddShortcut func(uintptr, uintptr)
-
-// Adds a shortcut to the shortcuts group.
-//
-// This is the programmatic equivalent to using [class@Gtk.Builder] and a
-// `&lt;child&gt;` tag to add the child. Adding children with other API is not
-// appropriate as `GtkShortcutsGroup` manages its children internally.
-func (x *ShortcutsGroup) AddShortcut(ShortcutVar *ShortcutsShortcut) {
-
-	xShortcutsGroupAddShortcut(x.GoPointer(), ShortcutVar.GoPointer())
-
-}
-
 func (c *ShortcutsGroup) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -79,6 +66,8 @@ func (c *ShortcutsGroup) SetGoPointer(ptr uintptr) {
 // A rough measure for the number of lines in this group.
 //
 // This is used internally by GTK, and is not useful for applications.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsGroup) GetPropertyHeight() uint {
 	var v gobject.Value
 	x.GetProperty("height", &v)
@@ -87,6 +76,8 @@ func (x *ShortcutsGroup) GetPropertyHeight() uint {
 
 // SetPropertyTitle sets the "title" property.
 // The title for this group of shortcuts.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsGroup) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -96,6 +87,8 @@ func (x *ShortcutsGroup) SetPropertyTitle(value string) {
 
 // GetPropertyTitle gets the "title" property.
 // The title for this group of shortcuts.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsGroup) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -105,10 +98,12 @@ func (x *ShortcutsGroup) GetPropertyTitle() string {
 // SetPropertyView sets the "view" property.
 // An optional view that the shortcuts in this group are relevant for.
 //
-// The group will be hidden if the [property@Gtk.ShortcutsWindow:view-name]
+// The group will be hidden if the [ShortcutsWindow.GetPropertyViewName]
 // property does not match the view of this group.
 //
-// Set this to %NULL to make the group always visible.
+// Set this to nil to make the group always visible.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsGroup) SetPropertyView(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -119,10 +114,12 @@ func (x *ShortcutsGroup) SetPropertyView(value string) {
 // GetPropertyView gets the "view" property.
 // An optional view that the shortcuts in this group are relevant for.
 //
-// The group will be hidden if the [property@Gtk.ShortcutsWindow:view-name]
+// The group will be hidden if the [ShortcutsWindow.GetPropertyViewName]
 // property does not match the view of this group.
 //
-// Set this to %NULL to make the group always visible.
+// Set this to nil to make the group always visible.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsGroup) GetPropertyView() string {
 	var v gobject.Value
 	x.GetProperty("view", &v)
@@ -290,7 +287,7 @@ func (x *ShortcutsGroup) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -298,14 +295,13 @@ func (x *ShortcutsGroup) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsGroup) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -329,19 +325,17 @@ func (x *ShortcutsGroup) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsGroup) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -362,7 +356,7 @@ func (x *ShortcutsGroup) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -371,14 +365,12 @@ func (x *ShortcutsGroup) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsGroup) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -397,44 +389,26 @@ func (x *ShortcutsGroup) UpdateStateValue(NStatesVar int, StatesVar []Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsGroup) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ShortcutsGroup) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ShortcutsGroup) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xShortcutsGroupGLibType, libs, "gtk_shortcuts_group_get_type")
-
-	core.PuregoSafeRegister(&xShortcutsGroupAddShortcut, libs, "gtk_shortcuts_group_add_shortcut")
-
-}
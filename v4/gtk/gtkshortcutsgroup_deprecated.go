@@ -0,0 +1,39 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xShortcutsGroupAddShortcut func(uintptr, uintptr)
+
+// Adds a shortcut to the shortcuts group.
+//
+// This is the programmatic equivalent to using [Builder] and a
+// `<child>` tag to add the child. Adding children with other API is not
+// appropriate as `GtkShortcutsGroup` manages its children internally.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutsGroup) AddShortcut(ShortcutVar *ShortcutsShortcut) {
+
+	xShortcutsGroupAddShortcut(x.GoPointer(), ShortcutVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xShortcutsGroupAddShortcut, libs, "gtk_shortcuts_group_add_shortcut", "GTK", "4.14")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,24 +23,24 @@ func (x *ShortcutsSectionClass) GoPointer() uintptr {
 // for a major application mode.
 //
 // If your application needs multiple sections, you should give each
-// section a unique [property@Gtk.ShortcutsSection:section-name] and
-// a [property@Gtk.ShortcutsSection:title] that can be shown in the
-// section selector of the [class@Gtk.ShortcutsWindow].
+// section a unique [ShortcutsSection.GetPropertySectionName] and
+// a [ShortcutsSection.GetPropertyTitle] that can be shown in the
+// section selector of the [ShortcutsWindow].
 //
-// The [property@Gtk.ShortcutsSection:max-height] property can be used
+// The [ShortcutsSection.GetPropertyMaxHeight] property can be used
 // to influence how the groups in the section are distributed over pages
 // and columns.
 //
-// This widget is only meant to be used with [class@Gtk.ShortcutsWindow].
+// This widget is only meant to be used with [ShortcutsWindow].
 //
 // The recommended way to construct a `GtkShortcutsSection` is with
-// [class@Gtk.Builder], by using the `&lt;child&gt;` tag to populate a
-// `GtkShortcutsSection` with one or more [class@Gtk.ShortcutsGroup]
-// instances, which in turn contain one or more [class@Gtk.ShortcutsShortcut]
+// [Builder], by using the `<child>` tag to populate a
+// `GtkShortcutsSection` with one or more [ShortcutsGroup]
+// instances, which in turn contain one or more [ShortcutsShortcut]
 // objects.
 //
 // If you need to add a group programmatically, use
-// [method@Gtk.ShortcutsSection.add_group].
+// [ShortcutsSection.AddGroup].
 //
 // # Shortcuts and Gestures
 //
@@ -49,7 +48,9 @@ func (x *ShortcutsSectionClass) GoPointer() uintptr {
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.ShortcutsSection::change-current-page]
+// - [ShortcutsSection.ConnectChangeCurrentPageFunc]
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 type ShortcutsSection struct {
 	Box
 }
@@ -66,21 +67,6 @@ func ShortcutsSectionNewFromInternalPtr(ptr uintptr) *ShortcutsSection {
 	return cls
 }
 
-var xShortcutsSectionAddGroup func(uintptr, uintptr)
-
-// Adds a group to the shortcuts section.
-//
-// This is the programmatic equivalent to using [class@Gtk.Builder] and a
-// `&lt;child&gt;` tag to add the child.
-//
-// Adding children with the `GtkBox` API is not appropriate, as
-// `GtkShortcutsSection` manages its children internally.
-func (x *ShortcutsSection) AddGroup(GroupVar *ShortcutsGroup) {
-
-	xShortcutsSectionAddGroup(x.GoPointer(), GroupVar.GoPointer())
-
-}
-
 func (c *ShortcutsSection) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -98,6 +84,8 @@ func (c *ShortcutsSection) SetGoPointer(ptr uintptr) {
 // This property can be used to influence how the groups in this
 // section are distributed across pages and columns. The default
 // value of 15 should work in most cases.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) SetPropertyMaxHeight(value uint) {
 	var v gobject.Value
 	v.Init(gobject.TypeUintVal)
@@ -111,6 +99,8 @@ func (x *ShortcutsSection) SetPropertyMaxHeight(value uint) {
 // This property can be used to influence how the groups in this
 // section are distributed across pages and columns. The default
 // value of 15 should work in most cases.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) GetPropertyMaxHeight() uint {
 	var v gobject.Value
 	x.GetProperty("max-height", &v)
@@ -121,8 +111,10 @@ func (x *ShortcutsSection) GetPropertyMaxHeight() uint {
 // A unique name to identify this section among the sections
 // added to the `GtkShortcutsWindow`.
 //
-// Setting the [property@Gtk.ShortcutsWindow:section-name] property
+// Setting the [ShortcutsWindow.GetPropertySectionName] property
 // to this string will make this section shown in the `GtkShortcutsWindow`.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) SetPropertySectionName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -134,8 +126,10 @@ func (x *ShortcutsSection) SetPropertySectionName(value string) {
 // A unique name to identify this section among the sections
 // added to the `GtkShortcutsWindow`.
 //
-// Setting the [property@Gtk.ShortcutsWindow:section-name] property
+// Setting the [ShortcutsWindow.GetPropertySectionName] property
 // to this string will make this section shown in the `GtkShortcutsWindow`.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) GetPropertySectionName() string {
 	var v gobject.Value
 	x.GetProperty("section-name", &v)
@@ -148,6 +142,8 @@ func (x *ShortcutsSection) GetPropertySectionName() string {
 //
 // If there is only one section, you don't need to set a title,
 // since the section selector will not be shown in this case.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -161,6 +157,8 @@ func (x *ShortcutsSection) SetPropertyTitle(value string) {
 //
 // If there is only one section, you don't need to set a title,
 // since the section selector will not be shown in this case.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -170,11 +168,13 @@ func (x *ShortcutsSection) GetPropertyTitle() string {
 // SetPropertyViewName sets the "view-name" property.
 // A view name to filter the groups in this section by.
 //
-// See [property@Gtk.ShortcutsGroup:view].
+// See [ShortcutsGroup.GetPropertyView].
 //
 // Applications are expected to use the
-// [property@Gtk.ShortcutsWindow:view-name] property
+// [ShortcutsWindow.GetPropertyViewName] property
 // for this purpose.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) SetPropertyViewName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -185,11 +185,13 @@ func (x *ShortcutsSection) SetPropertyViewName(value string) {
 // GetPropertyViewName gets the "view-name" property.
 // A view name to filter the groups in this section by.
 //
-// See [property@Gtk.ShortcutsGroup:view].
+// See [ShortcutsGroup.GetPropertyView].
 //
 // Applications are expected to use the
-// [property@Gtk.ShortcutsWindow:view-name] property
+// [ShortcutsWindow.GetPropertyViewName] property
 // for this purpose.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) GetPropertyViewName() string {
 	var v gobject.Value
 	x.GetProperty("view-name", &v)
@@ -199,29 +201,53 @@ func (x *ShortcutsSection) GetPropertyViewName() string {
 // Emitted when we change the current page.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgUp&lt;/kbd&gt;, &lt;kbd&gt;PgUp&lt;/kbd&gt;,
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgDn&lt;/kbd&gt;, &lt;kbd&gt;PgDn&lt;/kbd&gt;.
+// Ctrl+PgUp, PgUp,
+// Ctrl+PgDn, PgDn.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsSection) ConnectChangeCurrentPage(cb *func(ShortcutsSection, int) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-current-page", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, OffsetVarp int) bool {
+			defer core.RecoverPanic()
+			fa := ShortcutsSection{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, OffsetVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-current-page", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, OffsetVarp int) bool {
+var xShortcutsSectionChangeCurrentPageTrampoline uintptr
+
+func xShortcutsSectionChangeCurrentPageNewTrampoline() interface{} {
+	return func(clsPtr uintptr, OffsetVarp int, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ShortcutsSection, int) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ShortcutsSection{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, OffsetVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-current-page", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeCurrentPageFunc connects cb to the "change-current-page" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "change-current-page" signal is deprecated, see ConnectChangeCurrentPage.
+func (x *ShortcutsSection) ConnectChangeCurrentPageFunc(cb func(ShortcutsSection, int) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-current-page", &xShortcutsSectionChangeCurrentPageTrampoline, xShortcutsSectionChangeCurrentPageNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -385,7 +411,7 @@ func (x *ShortcutsSection) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -393,14 +419,13 @@ func (x *ShortcutsSection) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsSection) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -424,19 +449,17 @@ func (x *ShortcutsSection) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsSection) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -457,7 +480,7 @@ func (x *ShortcutsSection) UpdateRelationValue(NRelationsVar int, RelationsVar [
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -466,14 +489,12 @@ func (x *ShortcutsSection) UpdateRelationValue(NRelationsVar int, RelationsVar [
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsSection) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -492,44 +513,26 @@ func (x *ShortcutsSection) UpdateStateValue(NStatesVar int, StatesVar []Accessib
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsSection) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *ShortcutsSection) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *ShortcutsSection) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xShortcutsSectionGLibType, libs, "gtk_shortcuts_section_get_type")
-
-	core.PuregoSafeRegister(&xShortcutsSectionAddGroup, libs, "gtk_shortcuts_section_add_group")
-
-}
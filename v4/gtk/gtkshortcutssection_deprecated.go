@@ -0,0 +1,41 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xShortcutsSectionAddGroup func(uintptr, uintptr)
+
+// Adds a group to the shortcuts section.
+//
+// This is the programmatic equivalent to using [Builder] and a
+// `<child>` tag to add the child.
+//
+// Adding children with the `GtkBox` API is not appropriate, as
+// `GtkShortcutsSection` manages its children internally.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutsSection) AddGroup(GroupVar *ShortcutsGroup) {
+
+	xShortcutsSectionAddGroup(x.GoPointer(), GroupVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xShortcutsSectionAddGroup, libs, "gtk_shortcuts_section_add_group", "GTK", "4.14")
+
+}
@@ -2,9 +2,11 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
+	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -54,10 +56,93 @@ const (
 	ShortcutGestureSwipeRightValue ShortcutType = 9
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x ShortcutType) String() string {
+	switch x {
+	case ShortcutAcceleratorValue:
+		return "GTK_SHORTCUT_ACCELERATOR"
+	case ShortcutGesturePinchValue:
+		return "GTK_SHORTCUT_GESTURE_PINCH"
+	case ShortcutGestureStretchValue:
+		return "GTK_SHORTCUT_GESTURE_STRETCH"
+	case ShortcutGestureRotateClockwiseValue:
+		return "GTK_SHORTCUT_GESTURE_ROTATE_CLOCKWISE"
+	case ShortcutGestureRotateCounterclockwiseValue:
+		return "GTK_SHORTCUT_GESTURE_ROTATE_COUNTERCLOCKWISE"
+	case ShortcutGestureTwoFingerSwipeLeftValue:
+		return "GTK_SHORTCUT_GESTURE_TWO_FINGER_SWIPE_LEFT"
+	case ShortcutGestureTwoFingerSwipeRightValue:
+		return "GTK_SHORTCUT_GESTURE_TWO_FINGER_SWIPE_RIGHT"
+	case ShortcutGestureValue:
+		return "GTK_SHORTCUT_GESTURE"
+	case ShortcutGestureSwipeLeftValue:
+		return "GTK_SHORTCUT_GESTURE_SWIPE_LEFT"
+	case ShortcutGestureSwipeRightValue:
+		return "GTK_SHORTCUT_GESTURE_SWIPE_RIGHT"
+	}
+	return fmt.Sprintf("ShortcutType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x ShortcutType) Nick() string {
+	switch x {
+	case ShortcutAcceleratorValue:
+		return "accelerator"
+	case ShortcutGesturePinchValue:
+		return "gesture-pinch"
+	case ShortcutGestureStretchValue:
+		return "gesture-stretch"
+	case ShortcutGestureRotateClockwiseValue:
+		return "gesture-rotate-clockwise"
+	case ShortcutGestureRotateCounterclockwiseValue:
+		return "gesture-rotate-counterclockwise"
+	case ShortcutGestureTwoFingerSwipeLeftValue:
+		return "gesture-two-finger-swipe-left"
+	case ShortcutGestureTwoFingerSwipeRightValue:
+		return "gesture-two-finger-swipe-right"
+	case ShortcutGestureValue:
+		return "gesture"
+	case ShortcutGestureSwipeLeftValue:
+		return "gesture-swipe-left"
+	case ShortcutGestureSwipeRightValue:
+		return "gesture-swipe-right"
+	}
+	return ""
+}
+
+// ShortcutTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ShortcutTypeFromString(s string) (ShortcutType, bool) {
+	switch s {
+	case "GTK_SHORTCUT_ACCELERATOR", "accelerator":
+		return ShortcutAcceleratorValue, true
+	case "GTK_SHORTCUT_GESTURE_PINCH", "gesture-pinch":
+		return ShortcutGesturePinchValue, true
+	case "GTK_SHORTCUT_GESTURE_STRETCH", "gesture-stretch":
+		return ShortcutGestureStretchValue, true
+	case "GTK_SHORTCUT_GESTURE_ROTATE_CLOCKWISE", "gesture-rotate-clockwise":
+		return ShortcutGestureRotateClockwiseValue, true
+	case "GTK_SHORTCUT_GESTURE_ROTATE_COUNTERCLOCKWISE", "gesture-rotate-counterclockwise":
+		return ShortcutGestureRotateCounterclockwiseValue, true
+	case "GTK_SHORTCUT_GESTURE_TWO_FINGER_SWIPE_LEFT", "gesture-two-finger-swipe-left":
+		return ShortcutGestureTwoFingerSwipeLeftValue, true
+	case "GTK_SHORTCUT_GESTURE_TWO_FINGER_SWIPE_RIGHT", "gesture-two-finger-swipe-right":
+		return ShortcutGestureTwoFingerSwipeRightValue, true
+	case "GTK_SHORTCUT_GESTURE", "gesture":
+		return ShortcutGestureValue, true
+	case "GTK_SHORTCUT_GESTURE_SWIPE_LEFT", "gesture-swipe-left":
+		return ShortcutGestureSwipeLeftValue, true
+	case "GTK_SHORTCUT_GESTURE_SWIPE_RIGHT", "gesture-swipe-right":
+		return ShortcutGestureSwipeRightValue, true
+	}
+	return 0, false
+}
+
 // A `GtkShortcutsShortcut` represents a single keyboard shortcut or gesture
 // with a short text.
 //
 // This widget is only meant to be used with `GtkShortcutsWindow`.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 type ShortcutsShortcut struct {
 	Widget
 }
@@ -88,31 +173,33 @@ func (c *ShortcutsShortcut) SetGoPointer(ptr uintptr) {
 // SetPropertyAccelerator sets the "accelerator" property.
 // The accelerator(s) represented by this object.
 //
-// This property is used if [property@Gtk.ShortcutsShortcut:shortcut-type]
-// is set to %GTK_SHORTCUT_ACCELERATOR.
+// This property is used if ShortcutsShortcut:shortcut-type
+// is set to [ShortcutAcceleratorValue].
 //
 // The syntax of this property is (an extension of) the syntax understood
-// by [func@Gtk.accelerator_parse]. Multiple accelerators can be specified
+// by [AcceleratorParse]. Multiple accelerators can be specified
 // by separating them with a space, but keep in mind that the available width
 // is limited.
 //
 // It is also possible to specify ranges of shortcuts, using `...` between
-// the keys. Sequences of keys can be specified using a `+` or `&amp;` between
+// the keys. Sequences of keys can be specified using a `+` or `&` between
 // the keys.
 //
 // Examples:
 //
-// - A single shortcut: `&lt;ctl&gt;&lt;alt&gt;delete`
-// - Two alternative shortcuts: `&lt;shift&gt;a Home`
-// - A range of shortcuts: `&lt;alt&gt;1...&lt;alt&gt;9`
-// - Several keys pressed together: `Control_L&amp;Control_R`
-// - A sequence of shortcuts or keys: `&lt;ctl&gt;c+&lt;ctl&gt;x`
+// - A single shortcut: `<ctl><alt>delete`
+// - Two alternative shortcuts: `<shift>a Home`
+// - A range of shortcuts: `<alt>1...<alt>9`
+// - Several keys pressed together: `Control_L&Control_R`
+// - A sequence of shortcuts or keys: `<ctl>c+<ctl>x`
 //
-// Use "+" instead of "&amp;" when the keys may (or have to be) pressed
+// Use "+" instead of "&" when the keys may (or have to be) pressed
 // sequentially (e.g use "t+t" for 'press the t key twice').
 //
-// Note that `&lt;`, `&gt;` and `&amp;` need to be escaped as `&amp;lt;`, `&amp;gt`; and `&amp;amp`; when used
+// Note that `<`, `>` and `&` need to be escaped as `&lt;`, `&gt`; and `&amp`; when used
 // in .ui files.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertyAccelerator(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -123,31 +210,33 @@ func (x *ShortcutsShortcut) SetPropertyAccelerator(value string) {
 // GetPropertyAccelerator gets the "accelerator" property.
 // The accelerator(s) represented by this object.
 //
-// This property is used if [property@Gtk.ShortcutsShortcut:shortcut-type]
-// is set to %GTK_SHORTCUT_ACCELERATOR.
+// This property is used if ShortcutsShortcut:shortcut-type
+// is set to [ShortcutAcceleratorValue].
 //
 // The syntax of this property is (an extension of) the syntax understood
-// by [func@Gtk.accelerator_parse]. Multiple accelerators can be specified
+// by [AcceleratorParse]. Multiple accelerators can be specified
 // by separating them with a space, but keep in mind that the available width
 // is limited.
 //
 // It is also possible to specify ranges of shortcuts, using `...` between
-// the keys. Sequences of keys can be specified using a `+` or `&amp;` between
+// the keys. Sequences of keys can be specified using a `+` or `&` between
 // the keys.
 //
 // Examples:
 //
-// - A single shortcut: `&lt;ctl&gt;&lt;alt&gt;delete`
-// - Two alternative shortcuts: `&lt;shift&gt;a Home`
-// - A range of shortcuts: `&lt;alt&gt;1...&lt;alt&gt;9`
-// - Several keys pressed together: `Control_L&amp;Control_R`
-// - A sequence of shortcuts or keys: `&lt;ctl&gt;c+&lt;ctl&gt;x`
+// - A single shortcut: `<ctl><alt>delete`
+// - Two alternative shortcuts: `<shift>a Home`
+// - A range of shortcuts: `<alt>1...<alt>9`
+// - Several keys pressed together: `Control_L&Control_R`
+// - A sequence of shortcuts or keys: `<ctl>c+<ctl>x`
 //
-// Use "+" instead of "&amp;" when the keys may (or have to be) pressed
+// Use "+" instead of "&" when the keys may (or have to be) pressed
 // sequentially (e.g use "t+t" for 'press the t key twice').
 //
-// Note that `&lt;`, `&gt;` and `&amp;` need to be escaped as `&amp;lt;`, `&amp;gt`; and `&amp;amp`; when used
+// Note that `<`, `>` and `&` need to be escaped as `&lt;`, `&gt`; and `&amp`; when used
 // in .ui files.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertyAccelerator() string {
 	var v gobject.Value
 	x.GetProperty("accelerator", &v)
@@ -157,10 +246,12 @@ func (x *ShortcutsShortcut) GetPropertyAccelerator() string {
 // SetPropertyActionName sets the "action-name" property.
 // A detailed action name.
 //
-// If this is set for a shortcut of type %GTK_SHORTCUT_ACCELERATOR,
+// If this is set for a shortcut of type [ShortcutAcceleratorValue],
 // then GTK will use the accelerators that are associated with the
-// action via [method@Gtk.Application.set_accels_for_action], and
-// setting [property@Gtk.ShortcutsShortcut:accelerator] is not necessary.
+// action via [Application.SetAccelsForAction], and
+// setting [ShortcutsShortcut.GetPropertyAccelerator] is not necessary.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertyActionName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -171,10 +262,12 @@ func (x *ShortcutsShortcut) SetPropertyActionName(value string) {
 // GetPropertyActionName gets the "action-name" property.
 // A detailed action name.
 //
-// If this is set for a shortcut of type %GTK_SHORTCUT_ACCELERATOR,
+// If this is set for a shortcut of type [ShortcutAcceleratorValue],
 // then GTK will use the accelerators that are associated with the
-// action via [method@Gtk.Application.set_accels_for_action], and
-// setting [property@Gtk.ShortcutsShortcut:accelerator] is not necessary.
+// action via [Application.SetAccelsForAction], and
+// setting [ShortcutsShortcut.GetPropertyAccelerator] is not necessary.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertyActionName() string {
 	var v gobject.Value
 	x.GetProperty("action-name", &v)
@@ -182,7 +275,9 @@ func (x *ShortcutsShortcut) GetPropertyActionName() string {
 }
 
 // SetPropertyIconSet sets the "icon-set" property.
-// %TRUE if an icon has been set.
+// true if an icon has been set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertyIconSet(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -191,7 +286,9 @@ func (x *ShortcutsShortcut) SetPropertyIconSet(value bool) {
 }
 
 // GetPropertyIconSet gets the "icon-set" property.
-// %TRUE if an icon has been set.
+// true if an icon has been set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertyIconSet() bool {
 	var v gobject.Value
 	x.GetProperty("icon-set", &v)
@@ -204,6 +301,8 @@ func (x *ShortcutsShortcut) GetPropertyIconSet() bool {
 // This is typically used for gestures and should be a short, one-line
 // text that describes the gesture itself. For the predefined gesture
 // types, GTK provides a subtitle on its own.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertySubtitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -217,6 +316,8 @@ func (x *ShortcutsShortcut) SetPropertySubtitle(value string) {
 // This is typically used for gestures and should be a short, one-line
 // text that describes the gesture itself. For the predefined gesture
 // types, GTK provides a subtitle on its own.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertySubtitle() string {
 	var v gobject.Value
 	x.GetProperty("subtitle", &v)
@@ -224,7 +325,9 @@ func (x *ShortcutsShortcut) GetPropertySubtitle() string {
 }
 
 // SetPropertySubtitleSet sets the "subtitle-set" property.
-// %TRUE if a subtitle has been set.
+// true if a subtitle has been set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertySubtitleSet(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -233,7 +336,9 @@ func (x *ShortcutsShortcut) SetPropertySubtitleSet(value bool) {
 }
 
 // GetPropertySubtitleSet gets the "subtitle-set" property.
-// %TRUE if a subtitle has been set.
+// true if a subtitle has been set.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertySubtitleSet() bool {
 	var v gobject.Value
 	x.GetProperty("subtitle-set", &v)
@@ -245,6 +350,8 @@ func (x *ShortcutsShortcut) GetPropertySubtitleSet() bool {
 // this object.
 //
 // This should be a short string that can fit in a single line.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) SetPropertyTitle(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -257,6 +364,8 @@ func (x *ShortcutsShortcut) SetPropertyTitle(value string) {
 // this object.
 //
 // This should be a short string that can fit in a single line.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsShortcut) GetPropertyTitle() string {
 	var v gobject.Value
 	x.GetProperty("title", &v)
@@ -424,7 +533,7 @@ func (x *ShortcutsShortcut) UpdatePlatformState(StateVar AccessiblePlatformState
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -432,14 +541,13 @@ func (x *ShortcutsShortcut) UpdatePlatformState(StateVar AccessiblePlatformState
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsShortcut) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -463,19 +571,17 @@ func (x *ShortcutsShortcut) UpdatePropertyValue(NPropertiesVar int, PropertiesVa
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsShortcut) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -496,7 +602,7 @@ func (x *ShortcutsShortcut) UpdateRelationValue(NRelationsVar int, RelationsVar
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -505,14 +611,12 @@ func (x *ShortcutsShortcut) UpdateRelationValue(NRelationsVar int, RelationsVar
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsShortcut) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -531,12 +635,30 @@ func (x *ShortcutsShortcut) UpdateStateValue(NStatesVar int, StatesVar []Accessi
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsShortcut) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xShortcutTypeGLibType, libs, "gtk_shortcut_type_get_type")
+
+	core.PuregoSafeRegister(&xShortcutsShortcutGLibType, libs, "gtk_shortcuts_shortcut_get_type")
+
+}
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -23,69 +22,52 @@ import (
 // showing information that is not relevant in the current application context.
 //
 // The recommended way to construct a `GtkShortcutsWindow` is with
-// [class@Gtk.Builder], by using the `&lt;child&gt;` tag to populate a
-// `GtkShortcutsWindow` with one or more [class@Gtk.ShortcutsSection] objects,
-// which contain one or more [class@Gtk.ShortcutsGroup] instances, which, in turn,
-// contain [class@Gtk.ShortcutsShortcut] instances.
+// [Builder], by using the `<child>` tag to populate a
+// `GtkShortcutsWindow` with one or more [ShortcutsSection] objects,
+// which contain one or more [ShortcutsGroup] instances, which, in turn,
+// contain [ShortcutsShortcut] instances.
 //
-// If you need to add a section programmatically, use [method@Gtk.ShortcutsWindow.add_section]
-// instead of [method@Gtk.Window.set_child], as the shortcuts window manages
+// If you need to add a section programmatically, use [ShortcutsWindow.AddSection]
+// instead of [Window.SetChild], as the shortcuts window manages
 // its children directly.
 //
 // # A simple example:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="gedit-shortcuts-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="A simple example" src="gedit-shortcuts.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // This example has as single section. As you can see, the shortcut groups
 // are arranged in columns, and spread across several pages if there are too
 // many to find on a single page.
 //
-// The .ui file for this example can be found [here](https://gitlab.gnome.org/GNOME/gtk/tree/main/demos/gtk-demo/shortcuts-gedit.ui).
+// The .ui file for this example can be found [here].
 //
 // # An example with multiple views:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="clocks-shortcuts-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example with multiple views" src="clocks-shortcuts.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // This example shows a `GtkShortcutsWindow` that has been configured to show only
 // the shortcuts relevant to the “Stopwatch” view.
 //
-// The .ui file for this example can be found [here](https://gitlab.gnome.org/GNOME/gtk/tree/main/demos/gtk-demo/shortcuts-clocks.ui).
+// The .ui file for this example can be found [here].
 //
 // # An example with multiple sections:
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="builder-shortcuts-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example with multiple sections" src="builder-shortcuts.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // This example shows a `GtkShortcutsWindow` with two sections, “Editor Shortcuts”
 // and “Terminal Shortcuts”.
 //
-// The .ui file for this example can be found [here](https://gitlab.gnome.org/GNOME/gtk/tree/main/demos/gtk-demo/shortcuts-builder.ui).
+// The .ui file for this example can be found [here].
 //
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.ShortcutsWindow::close]
-// - [signal@Gtk.ShortcutsWindow::search]
+// - [ShortcutsWindow.ConnectCloseFunc]
+// - [ShortcutsWindow.ConnectSearchFunc]
 //
 // # CSS nodes
 //
 // `GtkShortcutsWindow` has a single CSS node with the name `window` and style
 // class `.shortcuts`.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+//
+// [here]: https://gitlab.gnome.org/GNOME/gtk/tree/main/demos/gtk-demo/shortcuts-gedit.ui
 type ShortcutsWindow struct {
 	Window
 }
@@ -102,21 +84,6 @@ func ShortcutsWindowNewFromInternalPtr(ptr uintptr) *ShortcutsWindow {
 	return cls
 }
 
-var xShortcutsWindowAddSection func(uintptr, uintptr)
-
-// Adds a section to the shortcuts window.
-//
-// This is the programmatic equivalent to using [class@Gtk.Builder] and a
-// `&lt;child&gt;` tag to add the child.
-//
-// Using [method@Gtk.Window.set_child] is not appropriate as the shortcuts
-// window manages its children internally.
-func (x *ShortcutsWindow) AddSection(SectionVar *ShortcutsSection) {
-
-	xShortcutsWindowAddSection(x.GoPointer(), SectionVar.GoPointer())
-
-}
-
 func (c *ShortcutsWindow) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -133,6 +100,8 @@ func (c *ShortcutsWindow) SetGoPointer(ptr uintptr) {
 //
 // This should be the section-name of one of the `GtkShortcutsSection`
 // objects that are in this shortcuts window.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) SetPropertySectionName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -145,6 +114,8 @@ func (x *ShortcutsWindow) SetPropertySectionName(value string) {
 //
 // This should be the section-name of one of the `GtkShortcutsSection`
 // objects that are in this shortcuts window.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) GetPropertySectionName() string {
 	var v gobject.Value
 	x.GetProperty("section-name", &v)
@@ -154,11 +125,13 @@ func (x *ShortcutsWindow) GetPropertySectionName() string {
 // SetPropertyViewName sets the "view-name" property.
 // The view name by which to filter the contents.
 //
-// This should correspond to the [property@Gtk.ShortcutsGroup:view]
-// property of some of the [class@Gtk.ShortcutsGroup] objects that
+// This should correspond to the [ShortcutsGroup.GetPropertyView]
+// property of some of the [ShortcutsGroup] objects that
 // are inside this shortcuts window.
 //
-// Set this to %NULL to show all groups.
+// Set this to nil to show all groups.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) SetPropertyViewName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -169,11 +142,13 @@ func (x *ShortcutsWindow) SetPropertyViewName(value string) {
 // GetPropertyViewName gets the "view-name" property.
 // The view name by which to filter the contents.
 //
-// This should correspond to the [property@Gtk.ShortcutsGroup:view]
-// property of some of the [class@Gtk.ShortcutsGroup] objects that
+// This should correspond to the [ShortcutsGroup.GetPropertyView]
+// property of some of the [ShortcutsGroup] objects that
 // are inside this shortcuts window.
 //
-// Set this to %NULL to show all groups.
+// Set this to nil to show all groups.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) GetPropertyViewName() string {
 	var v gobject.Value
 	x.GetProperty("view-name", &v)
@@ -182,58 +157,106 @@ func (x *ShortcutsWindow) GetPropertyViewName() string {
 
 // Emitted when the user uses a keybinding to close the window.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default binding for this signal is the Escape key.
 //
-// The default binding for this signal is the &lt;kbd&gt;Escape&lt;/kbd&gt; key.
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) ConnectClose(cb *func(ShortcutsWindow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ShortcutsWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close", cbRefPtr, cbPtr)
+}
+
+var xShortcutsWindowCloseTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xShortcutsWindowCloseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ShortcutsWindow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ShortcutsWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCloseFunc connects cb to the "close" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "close" signal is deprecated, see ConnectClose.
+func (x *ShortcutsWindow) ConnectCloseFunc(cb func(ShortcutsWindow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close", &xShortcutsWindowCloseTrampoline, xShortcutsWindowCloseNewTrampoline, cb)
 }
 
 // Emitted when the user uses a keybinding to start a search.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default binding for this signal is Control+F.
 //
-// The default binding for this signal is &lt;kbd&gt;Control&lt;/kbd&gt;+&lt;kbd&gt;F&lt;/kbd&gt;.
+// Deprecated: since 4.18. This widget will be removed in GTK 5
 func (x *ShortcutsWindow) ConnectSearch(cb *func(ShortcutsWindow)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "search", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ShortcutsWindow{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "search", cbRefPtr, cbPtr)
+}
+
+var xShortcutsWindowSearchTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xShortcutsWindowSearchNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ShortcutsWindow))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ShortcutsWindow{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "search", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSearchFunc connects cb to the "search" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "search" signal is deprecated, see ConnectSearch.
+func (x *ShortcutsWindow) ConnectSearchFunc(cb func(ShortcutsWindow)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "search", &xShortcutsWindowSearchTrampoline, xShortcutsWindowSearchNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -397,7 +420,7 @@ func (x *ShortcutsWindow) UpdatePlatformState(StateVar AccessiblePlatformState)
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -405,14 +428,13 @@ func (x *ShortcutsWindow) UpdatePlatformState(StateVar AccessiblePlatformState)
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsWindow) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -436,19 +458,17 @@ func (x *ShortcutsWindow) UpdatePropertyValue(NPropertiesVar int, PropertiesVar
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsWindow) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -469,7 +489,7 @@ func (x *ShortcutsWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -478,14 +498,12 @@ func (x *ShortcutsWindow) UpdateRelationValue(NRelationsVar int, RelationsVar []
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ShortcutsWindow) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -504,10 +522,10 @@ func (x *ShortcutsWindow) UpdateStateValue(NStatesVar int, StatesVar []Accessibl
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ShortcutsWindow) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -544,10 +562,10 @@ func (x *ShortcutsWindow) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *ShortcutsWindow) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -591,7 +609,7 @@ func (x *ShortcutsWindow) GetDisplay() *gdk.Display {
 //
 // Note that this is the widget that would have the focus
 // if the root is active; if the root is not focused then
-// `gtk_widget_has_focus (widget)` will be %FALSE for the
+// `gtk_widget_has_focus (widget)` will be false for the
 // widget.
 func (x *ShortcutsWindow) GetFocus() *Widget {
 	var cls *Widget
@@ -607,34 +625,16 @@ func (x *ShortcutsWindow) GetFocus() *Widget {
 	return cls
 }
 
-// If @focus is not the current focus widget, and is focusable, sets
+// If focus is not the current focus widget, and is focusable, sets
 // it as the focus widget for the root.
 //
-// If @focus is %NULL, unsets the focus widget for the root.
+// If focus is nil, unsets the focus widget for the root.
 //
 // To set the focus to a particular widget in the root, it is usually
-// more convenient to use [method@Gtk.Widget.grab_focus] instead of
+// more convenient to use [Widget.GrabFocus] instead of
 // this function.
 func (x *ShortcutsWindow) SetFocus(FocusVar *Widget) {
 
 	XGtkRootSetFocus(x.GoPointer(), FocusVar.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xShortcutsWindowGLibType, libs, "gtk_shortcuts_window_get_type")
-
-	core.PuregoSafeRegister(&xShortcutsWindowAddSection, libs, "gtk_shortcuts_window_add_section")
-
-}
@@ -0,0 +1,41 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xShortcutsWindowAddSection func(uintptr, uintptr)
+
+// Adds a section to the shortcuts window.
+//
+// This is the programmatic equivalent to using [Builder] and a
+// `<child>` tag to add the child.
+//
+// Using [Window.SetChild] is not appropriate as the shortcuts
+// window manages its children internally.
+//
+// Deprecated: since 4.18. This widget will be removed in GTK 5
+func (x *ShortcutsWindow) AddSection(SectionVar *ShortcutsSection) {
+
+	xShortcutsWindowAddSection(x.GoPointer(), SectionVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xShortcutsWindowAddSection, libs, "gtk_shortcuts_window_add_section", "GTK", "4.14")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -97,9 +96,9 @@ func NewAlternativeTrigger(FirstVar *ShortcutTrigger, SecondVar *ShortcutTrigger
 var xAlternativeTriggerGetFirst func(uintptr) uintptr
 
 // Gets the first of the two alternative triggers that may
-// trigger @self.
+// trigger self.
 //
-// [method@Gtk.AlternativeTrigger.get_second] will return
+// [AlternativeTrigger.GetSecond] will return
 // the other one.
 func (x *AlternativeTrigger) GetFirst() *ShortcutTrigger {
 	var cls *ShortcutTrigger
@@ -118,9 +117,9 @@ func (x *AlternativeTrigger) GetFirst() *ShortcutTrigger {
 var xAlternativeTriggerGetSecond func(uintptr) uintptr
 
 // Gets the second of the two alternative triggers that may
-// trigger @self.
+// trigger self.
 //
-// [method@Gtk.AlternativeTrigger.get_first] will return
+// [AlternativeTrigger.GetFirst] will return
 // the other one.
 func (x *AlternativeTrigger) GetSecond() *ShortcutTrigger {
 	var cls *ShortcutTrigger
@@ -167,7 +166,7 @@ func KeyvalTriggerNewFromInternalPtr(ptr uintptr) *KeyvalTrigger {
 var xNewKeyvalTrigger func(uint, gdk.ModifierType) uintptr
 
 // Creates a `GtkShortcutTrigger` that will trigger whenever
-// the key with the given @keyval and @modifiers is pressed.
+// the key with the given keyval and modifiers is pressed.
 func NewKeyvalTrigger(KeyvalVar uint, ModifiersVar gdk.ModifierType) *KeyvalTrigger {
 	var cls *KeyvalTrigger
 
@@ -184,7 +183,7 @@ func NewKeyvalTrigger(KeyvalVar uint, ModifiersVar gdk.ModifierType) *KeyvalTrig
 var xKeyvalTriggerGetKeyval func(uintptr) uint
 
 // Gets the keyval that must be pressed to succeed
-// triggering @self.
+// triggering self.
 func (x *KeyvalTrigger) GetKeyval() uint {
 
 	cret := xKeyvalTriggerGetKeyval(x.GoPointer())
@@ -194,7 +193,7 @@ func (x *KeyvalTrigger) GetKeyval() uint {
 var xKeyvalTriggerGetModifiers func(uintptr) gdk.ModifierType
 
 // Gets the modifiers that must be present to succeed
-// triggering @self.
+// triggering self.
 func (x *KeyvalTrigger) GetModifiers() gdk.ModifierType {
 
 	cret := xKeyvalTriggerGetModifiers(x.GoPointer())
@@ -231,7 +230,7 @@ func (x *KeyvalTrigger) GetPropertyKeyval() uint {
 
 // Triggers when a specific mnemonic is pressed.
 //
-// Mnemonics require a *mnemonic modifier* (typically &lt;kbd&gt;Alt&lt;/kbd&gt;) to be
+// Mnemonics require a *mnemonic modifier* (typically Alt) to be
 // pressed together with the mnemonic key.
 type MnemonicTrigger struct {
 	ShortcutTrigger
@@ -252,7 +251,7 @@ func MnemonicTriggerNewFromInternalPtr(ptr uintptr) *MnemonicTrigger {
 var xNewMnemonicTrigger func(uint) uintptr
 
 // Creates a `GtkShortcutTrigger` that will trigger whenever the key with
-// the given @keyval is pressed and mnemonics have been activated.
+// the given keyval is pressed and mnemonics have been activated.
 //
 // Mnemonics are activated by calling code when a key event with the right
 // modifiers is detected.
@@ -271,7 +270,7 @@ func NewMnemonicTrigger(KeyvalVar uint) *MnemonicTrigger {
 
 var xMnemonicTriggerGetKeyval func(uintptr) uint
 
-// Gets the keyval that must be pressed to succeed triggering @self.
+// Gets the keyval that must be pressed to succeed triggering self.
 func (x *MnemonicTrigger) GetKeyval() uint {
 
 	cret := xMnemonicTriggerGetKeyval(x.GoPointer())
@@ -339,7 +338,7 @@ var xNeverTriggerGet func() uintptr
 // Gets the never trigger.
 //
 // This is a singleton for a trigger that never triggers.
-// Use this trigger instead of %NULL because it implements
+// Use this trigger instead of nil because it implements
 // all virtual functions.
 func NeverTriggerGet() *NeverTrigger {
 	var cls *NeverTrigger
@@ -358,7 +357,7 @@ func NeverTriggerGet() *NeverTrigger {
 // Tracks how a `GtkShortcut` can be activated.
 //
 // To find out if a `GtkShortcutTrigger` triggers, you can call
-// [method@Gtk.ShortcutTrigger.trigger] on a `GdkEvent`.
+// [ShortcutTrigger.Trigger] on a `GdkEvent`.
 //
 // `GtkShortcutTriggers` contain functions that allow easy presentation
 // to end users as well as being printed for debugging.
@@ -387,19 +386,19 @@ var xShortcutTriggerParseString func(string) uintptr
 // Tries to parse the given string into a trigger.
 //
 // On success, the parsed trigger is returned.
-// When parsing failed, %NULL is returned.
+// When parsing failed, nil is returned.
 //
 // The accepted strings are:
 //
 //   - `never`, for `GtkNeverTrigger`
-//   - a string parsed by gtk_accelerator_parse(), for a `GtkKeyvalTrigger`, e.g. `&lt;Control&gt;C`
+//   - a string parsed by [AcceleratorParse], for a `GtkKeyvalTrigger`, e.g. `<Control>C`
 //   - underscore, followed by a single character, for `GtkMnemonicTrigger`, e.g. `_l`
 //   - two valid trigger strings, separated by a `|` character, for a
-//     `GtkAlternativeTrigger`: `&lt;Control&gt;q|&lt;Control&gt;w`
+//     `GtkAlternativeTrigger`: `<Control>q|<Control>w`
 //
-// Note that you will have to escape the `&lt;` and `&gt;` characters when specifying
-// triggers in XML files, such as GtkBuilder ui files. Use `&amp;lt;` instead of
-// `&lt;` and `&amp;gt;` instead of `&gt;`.
+// Note that you will have to escape the `<` and `>` characters when specifying
+// triggers in XML files, such as GtkBuilder ui files. Use `&lt;` instead of
+// `<` and `&gt;` instead of `>`.
 func ShortcutTriggerParseString(StringVar string) *ShortcutTrigger {
 	var cls *ShortcutTrigger
 
@@ -415,7 +414,7 @@ func ShortcutTriggerParseString(StringVar string) *ShortcutTrigger {
 
 var xShortcutTriggerCompare func(uintptr, uintptr) int
 
-// The types of @trigger1 and @trigger2 are `gconstpointer` only to allow
+// The types of trigger1 and trigger2 are `gconstpointer` only to allow
 // use of this function as a `GCompareFunc`.
 //
 // They must each be a `GtkShortcutTrigger`.
@@ -427,9 +426,9 @@ func (x *ShortcutTrigger) Compare(Trigger2Var uintptr) int {
 
 var xShortcutTriggerEqual func(uintptr, uintptr) bool
 
-// Checks if @trigger1 and @trigger2 trigger under the same conditions.
+// Checks if trigger1 and trigger2 trigger under the same conditions.
 //
-// The types of @one and @two are `gconstpointer` only to allow use of this
+// The types of one and two are `gconstpointer` only to allow use of this
 // function with `GHashTable`. They must each be a `GtkShortcutTrigger`.
 func (x *ShortcutTrigger) Equal(Trigger2Var uintptr) bool {
 
@@ -446,7 +445,7 @@ var xShortcutTriggerHash func(uintptr) uint
 // architectures or even different versions of GTK. Do not use this
 // function as a basis for building protocols or file formats.
 //
-// The types of @trigger is `gconstpointer` only to allow use of this
+// The types of trigger is `gconstpointer` only to allow use of this
 // function with `GHashTable`. They must each be a `GtkShortcutTrigger`.
 func (x *ShortcutTrigger) Hash() uint {
 
@@ -474,7 +473,7 @@ var xShortcutTriggerPrintLabel func(uintptr, uintptr, *glib.String) bool
 // This function is returning a translated string for presentation
 // to end users for example in menu items or in help texts.
 //
-// The @display in use may influence the resulting string in
+// The display in use may influence the resulting string in
 // various forms, such as resolving hardware keycodes or by
 // causing display-specific modifier names.
 //
@@ -494,7 +493,7 @@ var xShortcutTriggerToLabel func(uintptr, uintptr) string
 // presentation to end users for example in menu items
 // or in help texts.
 //
-// The @display in use may influence the resulting string in
+// The display in use may influence the resulting string in
 // various forms, such as resolving hardware keycodes or by
 // causing display-specific modifier names.
 //
@@ -510,7 +509,7 @@ var xShortcutTriggerToString func(uintptr) string
 
 // Prints the given trigger into a human-readable string.
 //
-// This is a small wrapper around [method@Gtk.ShortcutTrigger.print]
+// This is a small wrapper around [ShortcutTrigger.Print]
 // to help when debugging.
 func (x *ShortcutTrigger) ToString() string {
 
@@ -520,7 +519,7 @@ func (x *ShortcutTrigger) ToString() string {
 
 var xShortcutTriggerTrigger func(uintptr, uintptr, bool) gdk.KeyMatch
 
-// Checks if the given @event triggers @self.
+// Checks if the given event triggers self.
 func (x *ShortcutTrigger) Trigger(EventVar *gdk.Event, EnableMnemonicsVar bool) gdk.KeyMatch {
 
 	cret := xShortcutTriggerTrigger(x.GoPointer(), EventVar.GoPointer(), EnableMnemonicsVar)
@@ -543,7 +542,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -552,39 +551,39 @@ func init() {
 
 	core.PuregoSafeRegister(&xAlternativeTriggerGLibType, libs, "gtk_alternative_trigger_get_type")
 
-	core.PuregoSafeRegister(&xNewAlternativeTrigger, libs, "gtk_alternative_trigger_new")
+	core.PuregoRegisterSince(&xNewAlternativeTrigger, libs, "gtk_alternative_trigger_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xAlternativeTriggerGetFirst, libs, "gtk_alternative_trigger_get_first")
-	core.PuregoSafeRegister(&xAlternativeTriggerGetSecond, libs, "gtk_alternative_trigger_get_second")
+	core.PuregoRegisterSince(&xAlternativeTriggerGetFirst, libs, "gtk_alternative_trigger_get_first", "GTK", "")
+	core.PuregoRegisterSince(&xAlternativeTriggerGetSecond, libs, "gtk_alternative_trigger_get_second", "GTK", "")
 
 	core.PuregoSafeRegister(&xKeyvalTriggerGLibType, libs, "gtk_keyval_trigger_get_type")
 
-	core.PuregoSafeRegister(&xNewKeyvalTrigger, libs, "gtk_keyval_trigger_new")
+	core.PuregoRegisterSince(&xNewKeyvalTrigger, libs, "gtk_keyval_trigger_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xKeyvalTriggerGetKeyval, libs, "gtk_keyval_trigger_get_keyval")
-	core.PuregoSafeRegister(&xKeyvalTriggerGetModifiers, libs, "gtk_keyval_trigger_get_modifiers")
+	core.PuregoRegisterSince(&xKeyvalTriggerGetKeyval, libs, "gtk_keyval_trigger_get_keyval", "GTK", "")
+	core.PuregoRegisterSince(&xKeyvalTriggerGetModifiers, libs, "gtk_keyval_trigger_get_modifiers", "GTK", "")
 
 	core.PuregoSafeRegister(&xMnemonicTriggerGLibType, libs, "gtk_mnemonic_trigger_get_type")
 
-	core.PuregoSafeRegister(&xNewMnemonicTrigger, libs, "gtk_mnemonic_trigger_new")
+	core.PuregoRegisterSince(&xNewMnemonicTrigger, libs, "gtk_mnemonic_trigger_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xMnemonicTriggerGetKeyval, libs, "gtk_mnemonic_trigger_get_keyval")
+	core.PuregoRegisterSince(&xMnemonicTriggerGetKeyval, libs, "gtk_mnemonic_trigger_get_keyval", "GTK", "")
 
 	core.PuregoSafeRegister(&xNeverTriggerGLibType, libs, "gtk_never_trigger_get_type")
 
-	core.PuregoSafeRegister(&xNeverTriggerGet, libs, "gtk_never_trigger_get")
+	core.PuregoRegisterSince(&xNeverTriggerGet, libs, "gtk_never_trigger_get", "GTK", "")
 
 	core.PuregoSafeRegister(&xShortcutTriggerGLibType, libs, "gtk_shortcut_trigger_get_type")
 
-	core.PuregoSafeRegister(&xShortcutTriggerParseString, libs, "gtk_shortcut_trigger_parse_string")
+	core.PuregoRegisterSince(&xShortcutTriggerParseString, libs, "gtk_shortcut_trigger_parse_string", "GTK", "")
 
-	core.PuregoSafeRegister(&xShortcutTriggerCompare, libs, "gtk_shortcut_trigger_compare")
-	core.PuregoSafeRegister(&xShortcutTriggerEqual, libs, "gtk_shortcut_trigger_equal")
-	core.PuregoSafeRegister(&xShortcutTriggerHash, libs, "gtk_shortcut_trigger_hash")
-	core.PuregoSafeRegister(&xShortcutTriggerPrint, libs, "gtk_shortcut_trigger_print")
-	core.PuregoSafeRegister(&xShortcutTriggerPrintLabel, libs, "gtk_shortcut_trigger_print_label")
-	core.PuregoSafeRegister(&xShortcutTriggerToLabel, libs, "gtk_shortcut_trigger_to_label")
-	core.PuregoSafeRegister(&xShortcutTriggerToString, libs, "gtk_shortcut_trigger_to_string")
-	core.PuregoSafeRegister(&xShortcutTriggerTrigger, libs, "gtk_shortcut_trigger_trigger")
+	core.PuregoRegisterSince(&xShortcutTriggerCompare, libs, "gtk_shortcut_trigger_compare", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerEqual, libs, "gtk_shortcut_trigger_equal", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerHash, libs, "gtk_shortcut_trigger_hash", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerPrint, libs, "gtk_shortcut_trigger_print", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerPrintLabel, libs, "gtk_shortcut_trigger_print_label", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerToLabel, libs, "gtk_shortcut_trigger_to_label", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerToString, libs, "gtk_shortcut_trigger_to_string", "GTK", "")
+	core.PuregoRegisterSince(&xShortcutTriggerTrigger, libs, "gtk_shortcut_trigger_trigger", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,41 +23,41 @@ func (x *SignalListItemFactoryClass) GoPointer() uintptr {
 //
 // Signals are emitted for every listitem in the same order:
 //
-//  1. [signal@Gtk.SignalListItemFactory::setup] is emitted to set up permanent
+//  1. [SignalListItemFactory.ConnectSetupFunc] is emitted to set up permanent
 //     things on the listitem. This usually means constructing the widgets used in
 //     the row and adding them to the listitem.
 //
-//  2. [signal@Gtk.SignalListItemFactory::bind] is emitted to bind the item passed
-//     via [property@Gtk.ListItem:item] to the widgets that have been created in
+//  2. [SignalListItemFactory.ConnectBindFunc] is emitted to bind the item passed
+//     via ListItem:item to the widgets that have been created in
 //     step 1 or to add item-specific widgets. Signals are connected to listen to
 //     changes - both to changes in the item to update the widgets or to changes
 //     in the widgets to update the item. After this signal has been called, the
 //     listitem may be shown in a list widget.
 //
-//  3. [signal@Gtk.SignalListItemFactory::unbind] is emitted to undo everything
+//  3. [SignalListItemFactory.ConnectUnbindFunc] is emitted to undo everything
 //     done in step 2. Usually this means disconnecting signal handlers. Once this
 //     signal has been called, the listitem will no longer be used in a list
 //     widget.
 //
-//  4. [signal@Gtk.SignalListItemFactory::bind] and
-//     [signal@Gtk.SignalListItemFactory::unbind] may be emitted multiple times
+//  4. [SignalListItemFactory.ConnectBindFunc] and
+//     [SignalListItemFactory.ConnectUnbindFunc] may be emitted multiple times
 //     again to bind the listitem for use with new items. By reusing listitems,
 //     potentially costly setup can be avoided. However, it means code needs to
 //     make sure to properly clean up the listitem in step 3 so that no information
 //     from the previous use leaks into the next one.
 //
-//  5. [signal@Gtk.SignalListItemFactory::teardown] is emitted to allow undoing
-//     the effects of [signal@Gtk.SignalListItemFactory::setup]. After this signal
+//  5. [SignalListItemFactory.ConnectTeardownFunc] is emitted to allow undoing
+//     the effects of [SignalListItemFactory.ConnectSetupFunc]. After this signal
 //     was emitted on a listitem, the listitem will be destroyed and not be used again.
 //
 // Note that during the signal emissions, changing properties on the listitems
 // passed will not trigger notify signals as the listitem's notifications are
-// frozen. See [method@GObject.Object.freeze_notify] for details.
+// frozen. See [gobject.Object.FreezeNotify] for details.
 //
 // For tracking changes in other properties in the listitem, the
 // ::notify signal is recommended. The signal can be connected in the
-// [signal@Gtk.SignalListItemFactory::setup] signal and removed again during
-// [signal@Gtk.SignalListItemFactory::teardown].
+// [SignalListItemFactory.ConnectSetupFunc] signal and removed again during
+// [SignalListItemFactory.ConnectTeardownFunc].
 type SignalListItemFactory struct {
 	ListItemFactory
 }
@@ -110,124 +109,212 @@ func (c *SignalListItemFactory) SetGoPointer(ptr uintptr) {
 // to populate the listitem with widgets.
 //
 // After this signal was emitted, the object might be shown in
-// a [class@Gtk.ListView] or other widget.
+// a [ListView] or other widget.
 //
-// The [signal@Gtk.SignalListItemFactory::unbind] signal is the
+// The [SignalListItemFactory.ConnectUnbindFunc] signal is the
 // opposite of this signal and can be used to undo everything done
 // in this signal.
+//
+// Deprecated: use ConnectBindFunc, which also accepts method values and closures.
 func (x *SignalListItemFactory) ConnectBind(cb *func(SignalListItemFactory, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "bind", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalListItemFactory{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "bind", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+var xSignalListItemFactoryBindTrampoline uintptr
+
+func xSignalListItemFactoryBindNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SignalListItemFactory, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalListItemFactory{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "bind", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBindFunc connects cb to the "bind" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalListItemFactory) ConnectBindFunc(cb func(SignalListItemFactory, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "bind", &xSignalListItemFactoryBindTrampoline, xSignalListItemFactoryBindNewTrampoline, cb)
 }
 
 // Emitted when a newly created listitem needs to be prepared for use.
 //
 // It is the first signal emitted for every listitem.
 //
-// The handler for this signal must call [method@Gtk.ListItem.set_child]
+// The handler for this signal must call [ListItem.SetChild]
 // to populate the listitem with widgets.
 //
-// The [signal@Gtk.SignalListItemFactory::teardown] signal is the opposite
+// The [SignalListItemFactory.ConnectTeardownFunc] signal is the opposite
 // of this signal and can be used to undo everything done in this signal.
+//
+// Deprecated: use ConnectSetupFunc, which also accepts method values and closures.
 func (x *SignalListItemFactory) ConnectSetup(cb *func(SignalListItemFactory, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "setup", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalListItemFactory{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "setup", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+var xSignalListItemFactorySetupTrampoline uintptr
+
+func xSignalListItemFactorySetupNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SignalListItemFactory, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalListItemFactory{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "setup", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSetupFunc connects cb to the "setup" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalListItemFactory) ConnectSetupFunc(cb func(SignalListItemFactory, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "setup", &xSignalListItemFactorySetupTrampoline, xSignalListItemFactorySetupNewTrampoline, cb)
 }
 
 // Emitted when an object is about to be destroyed.
 //
-// It is the last signal ever emitted for this @object.
+// It is the last signal ever emitted for this object.
 //
-// This signal is the opposite of the [signal@Gtk.SignalListItemFactory::setup]
+// This signal is the opposite of the [SignalListItemFactory.ConnectSetupFunc]
 // signal and should be used to undo everything done in that signal.
+//
+// Deprecated: use ConnectTeardownFunc, which also accepts method values and closures.
 func (x *SignalListItemFactory) ConnectTeardown(cb *func(SignalListItemFactory, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "teardown", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalListItemFactory{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "teardown", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+var xSignalListItemFactoryTeardownTrampoline uintptr
+
+func xSignalListItemFactoryTeardownNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SignalListItemFactory, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalListItemFactory{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "teardown", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectTeardownFunc connects cb to the "teardown" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalListItemFactory) ConnectTeardownFunc(cb func(SignalListItemFactory, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "teardown", &xSignalListItemFactoryTeardownTrampoline, xSignalListItemFactoryTeardownNewTrampoline, cb)
 }
 
 // Emitted when an object has been unbound from its item.
 //
 // This happens for example when a listitem was removed from use
-// in a list widget and its [property@Gtk.ListItem:item] is about
+// in a list widget and its ListItem:item is about
 // to be unset.
 //
-// This signal is the opposite of the [signal@Gtk.SignalListItemFactory::bind]
+// This signal is the opposite of the [SignalListItemFactory.ConnectBindFunc]
 // signal and should be used to undo everything done in that signal.
+//
+// Deprecated: use ConnectUnbindFunc, which also accepts method values and closures.
 func (x *SignalListItemFactory) ConnectUnbind(cb *func(SignalListItemFactory, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unbind", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := SignalListItemFactory{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ObjectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unbind", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ObjectVarp uintptr) {
+var xSignalListItemFactoryUnbindTrampoline uintptr
+
+func xSignalListItemFactoryUnbindNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ObjectVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SignalListItemFactory, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SignalListItemFactory{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ObjectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unbind", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnbindFunc connects cb to the "unbind" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SignalListItemFactory) ConnectUnbindFunc(cb func(SignalListItemFactory, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unbind", &xSignalListItemFactoryUnbindTrampoline, xSignalListItemFactoryUnbindNewTrampoline, cb)
 }
 
 func init() {
@@ -235,7 +322,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -244,6 +331,6 @@ func init() {
 
 	core.PuregoSafeRegister(&xSignalListItemFactoryGLibType, libs, "gtk_signal_list_item_factory_get_type")
 
-	core.PuregoSafeRegister(&xNewSignalListItemFactory, libs, "gtk_signal_list_item_factory_new")
+	core.PuregoRegisterSince(&xNewSignalListItemFactory, libs, "gtk_signal_list_item_factory_new", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -25,7 +24,7 @@ func (x *SingleSelectionClass) GoPointer() uintptr {
 // A selection model that allows selecting a single item.
 //
 // Note that the selection is *persistent* -- if the selected item is removed
-// and re-added in the same [signal@Gio.ListModel::items-changed] emission, it
+// and re-added in the same ListModel::items-changed emission, it
 // stays selected. In particular, this means that changing the sort order of an
 // underlying sort model will preserve the selection.
 type SingleSelection struct {
@@ -46,7 +45,7 @@ func SingleSelectionNewFromInternalPtr(ptr uintptr) *SingleSelection {
 
 var xNewSingleSelection func(uintptr) uintptr
 
-// Creates a new selection to handle @model.
+// Creates a new selection to handle model.
 func NewSingleSelection(ModelVar gio.ListModel) *SingleSelection {
 	var cls *SingleSelection
 
@@ -63,7 +62,7 @@ func NewSingleSelection(ModelVar gio.ListModel) *SingleSelection {
 var xSingleSelectionGetAutoselect func(uintptr) bool
 
 // Checks if autoselect has been enabled or disabled via
-// gtk_single_selection_set_autoselect().
+// [SingleSelection.SetAutoselect].
 func (x *SingleSelection) GetAutoselect() bool {
 
 	cret := xSingleSelectionGetAutoselect(x.GoPointer())
@@ -72,7 +71,7 @@ func (x *SingleSelection) GetAutoselect() bool {
 
 var xSingleSelectionGetCanUnselect func(uintptr) bool
 
-// If %TRUE, gtk_selection_model_unselect_item() is supported and allows
+// If true, [SelectionModel.UnselectItem] is supported and allows
 // unselecting the selected item.
 func (x *SingleSelection) GetCanUnselect() bool {
 
@@ -82,7 +81,7 @@ func (x *SingleSelection) GetCanUnselect() bool {
 
 var xSingleSelectionGetModel func(uintptr) uintptr
 
-// Gets the model that @self is wrapping.
+// Gets the model that self is wrapping.
 func (x *SingleSelection) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -101,7 +100,7 @@ var xSingleSelectionGetSelected func(uintptr) uint
 
 // Gets the position of the selected item.
 //
-// If no item is selected, %GTK_INVALID_LIST_POSITION is returned.
+// If no item is selected, [INVALID_LIST_POSITION] is returned.
 func (x *SingleSelection) GetSelected() uint {
 
 	cret := xSingleSelectionGetSelected(x.GoPointer())
@@ -112,7 +111,7 @@ var xSingleSelectionGetSelectedItem func(uintptr) uintptr
 
 // Gets the selected item.
 //
-// If no item is selected, %NULL is returned.
+// If no item is selected, nil is returned.
 func (x *SingleSelection) GetSelectedItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -131,7 +130,7 @@ var xSingleSelectionSetAutoselect func(uintptr, bool)
 
 // Enables or disables autoselect.
 //
-// If @autoselect is %TRUE, @self will enforce that an item is always
+// If autoselect is true, self will enforce that an item is always
 // selected. It will select a new item when the currently selected
 // item is deleted and it will disallow unselecting the current item.
 func (x *SingleSelection) SetAutoselect(AutoselectVar bool) {
@@ -142,10 +141,10 @@ func (x *SingleSelection) SetAutoselect(AutoselectVar bool) {
 
 var xSingleSelectionSetCanUnselect func(uintptr, bool)
 
-// If %TRUE, unselecting the current item via
-// gtk_selection_model_unselect_item() is supported.
+// If true, unselecting the current item via
+// [SelectionModel.UnselectItem] is supported.
 //
-// Note that setting [property@Gtk.SingleSelection:autoselect] will
+// Note that setting [SingleSelection.GetPropertyAutoselect] will
 // cause unselecting to not work, so it practically makes no sense
 // to set both at the same time.
 func (x *SingleSelection) SetCanUnselect(CanUnselectVar bool) {
@@ -156,9 +155,9 @@ func (x *SingleSelection) SetCanUnselect(CanUnselectVar bool) {
 
 var xSingleSelectionSetModel func(uintptr, uintptr)
 
-// Sets the model that @self should wrap.
+// Sets the model that self should wrap.
 //
-// If @model is %NULL, @self will be empty.
+// If model is nil, self will be empty.
 func (x *SingleSelection) SetModel(ModelVar gio.ListModel) {
 
 	xSingleSelectionSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -169,13 +168,13 @@ var xSingleSelectionSetSelected func(uintptr, uint)
 
 // Selects the item at the given position.
 //
-// If the list does not have an item at @position or
-// %GTK_INVALID_LIST_POSITION is given, the behavior depends on the
-// value of the [property@Gtk.SingleSelection:autoselect] property:
+// If the list does not have an item at position or
+// [INVALID_LIST_POSITION] is given, the behavior depends on the
+// value of the [SingleSelection.GetPropertyAutoselect] property:
 // If it is set, no change will occur and the old item will stay
 // selected. If it is unset, the selection will be unset and no item
-// will be selected. This also applies if [property@Gtk.SingleSelection:can-unselect]
-// is set to %FALSE.
+// will be selected. This also applies if [SingleSelection.GetPropertyCanUnselect]
+// is set to false.
 func (x *SingleSelection) SetSelected(PositionVar uint) {
 
 	xSingleSelectionSetSelected(x.GoPointer(), PositionVar)
@@ -228,7 +227,7 @@ func (x *SingleSelection) GetPropertyCanUnselect() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *SingleSelection) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -252,28 +251,30 @@ func (x *SingleSelection) GetPropertySelected() uint {
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *SingleSelection) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *SingleSelection) GetItemType() types.GType {
 
@@ -281,29 +282,29 @@ func (x *SingleSelection) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *SingleSelection) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *SingleSelection) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -317,20 +318,20 @@ func (x *SingleSelection) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -354,20 +355,20 @@ func (x *SingleSelection) GetSection(PositionVar uint, OutStartVar *uint, OutEnd
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *SingleSelection) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -378,8 +379,8 @@ func (x *SingleSelection) SectionsChanged(PositionVar uint, NItemsVar uint) {
 // Gets the set containing all currently selected items in the model.
 //
 // This function may be slow, so if you are only interested in single item,
-// consider using [method@Gtk.SelectionModel.is_selected] or if you are only
-// interested in a few, consider [method@Gtk.SelectionModel.get_selection_in_range].
+// consider using [SelectionModel.IsSelected] or if you are only
+// interested in a few, consider [SelectionModel.GetSelectionInRange].
 func (x *SingleSelection) GetSelection() *Bitset {
 
 	cret := XGtkSelectionModelGetSelection(x.GoPointer())
@@ -389,9 +390,9 @@ func (x *SingleSelection) GetSelection() *Bitset {
 // Gets the set of selected items in a range.
 //
 // This function is an optimization for
-// [method@Gtk.SelectionModel.get_selection] when you are only
+// [SelectionModel.GetSelection] when you are only
 // interested in part of the model's selected state. A common use
-// case is in response to the [signal@Gtk.SelectionModel::selection-changed]
+// case is in response to the SelectionModel::selection-changed
 // signal.
 func (x *SingleSelection) GetSelectionInRange(PositionVar uint, NItemsVar uint) *Bitset {
 
@@ -430,7 +431,7 @@ func (x *SingleSelection) SelectRange(PositionVar uint, NItemsVar uint, Unselect
 // Helper function for implementations of `GtkSelectionModel`.
 //
 // Call this when the selection changes to emit the
-// [signal@Gtk.SelectionModel::selection-changed] signal.
+// SelectionModel::selection-changed signal.
 func (x *SingleSelection) SelectionChanged(PositionVar uint, NItemsVar uint) {
 
 	XGtkSelectionModelSelectionChanged(x.GoPointer(), PositionVar, NItemsVar)
@@ -444,33 +445,28 @@ func (x *SingleSelection) SelectionChanged(PositionVar uint, NItemsVar uint) {
 // you should try the simpler versions, as implementations are more
 // likely to implement support for those.
 //
-// Requests that the selection state of all positions set in @mask
-// be updated to the respective value in the @selected bitmask.
+// Requests that the selection state of all positions set in mask
+// be updated to the respective value in the selected bitmask.
 //
 // In pseudocode, it would look something like this:
 //
-// ```c
-// for (i = 0; i &lt; n_items; i++)
-//
-//	{
-//	  // don't change values not in the mask
-//	  if (!gtk_bitset_contains (mask, i))
-//	    continue;
-//
-//	  if (gtk_bitset_contains (selected, i))
-//	    select_item (i);
-//	  else
-//	    unselect_item (i);
-//	}
+//	for (i = 0; i < n_items; i++)
+//	  {
+//	    // don't change values not in the mask
+//	    if (!gtk_bitset_contains (mask, i))
+//	      continue;
 //
-// gtk_selection_model_selection_changed (model,
+//	    if (gtk_bitset_contains (selected, i))
+//	      select_item (i);
+//	    else
+//	      unselect_item (i);
+//	  }
 //
-//	first_changed_item,
-//	n_changed_items);
+//	gtk_selection_model_selection_changed (model,
+//	                                       first_changed_item,
+//	                                       n_changed_items);
 //
-// ```
-//
-// @mask and @selected must not be modified. They may refer to the
+// mask and selected must not be modified. They may refer to the
 // same bitset, which would mean that every item in the set should
 // be selected.
 func (x *SingleSelection) SetSelection(SelectedVar *Bitset, MaskVar *Bitset) bool {
@@ -505,7 +501,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -514,16 +510,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xSingleSelectionGLibType, libs, "gtk_single_selection_get_type")
 
-	core.PuregoSafeRegister(&xNewSingleSelection, libs, "gtk_single_selection_new")
-
-	core.PuregoSafeRegister(&xSingleSelectionGetAutoselect, libs, "gtk_single_selection_get_autoselect")
-	core.PuregoSafeRegister(&xSingleSelectionGetCanUnselect, libs, "gtk_single_selection_get_can_unselect")
-	core.PuregoSafeRegister(&xSingleSelectionGetModel, libs, "gtk_single_selection_get_model")
-	core.PuregoSafeRegister(&xSingleSelectionGetSelected, libs, "gtk_single_selection_get_selected")
-	core.PuregoSafeRegister(&xSingleSelectionGetSelectedItem, libs, "gtk_single_selection_get_selected_item")
-	core.PuregoSafeRegister(&xSingleSelectionSetAutoselect, libs, "gtk_single_selection_set_autoselect")
-	core.PuregoSafeRegister(&xSingleSelectionSetCanUnselect, libs, "gtk_single_selection_set_can_unselect")
-	core.PuregoSafeRegister(&xSingleSelectionSetModel, libs, "gtk_single_selection_set_model")
-	core.PuregoSafeRegister(&xSingleSelectionSetSelected, libs, "gtk_single_selection_set_selected")
+	gobject.CheckClassSize("GtkSingleSelectionClass", xSingleSelectionGLibType, unsafe.Sizeof(SingleSelectionClass{}))
+
+	core.PuregoRegisterSince(&xNewSingleSelection, libs, "gtk_single_selection_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xSingleSelectionGetAutoselect, libs, "gtk_single_selection_get_autoselect", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionGetCanUnselect, libs, "gtk_single_selection_get_can_unselect", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionGetModel, libs, "gtk_single_selection_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionGetSelected, libs, "gtk_single_selection_get_selected", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionGetSelectedItem, libs, "gtk_single_selection_get_selected_item", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionSetAutoselect, libs, "gtk_single_selection_set_autoselect", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionSetCanUnselect, libs, "gtk_single_selection_set_can_unselect", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionSetModel, libs, "gtk_single_selection_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xSingleSelectionSetSelected, libs, "gtk_single_selection_set_selected", "GTK", "")
 
 }
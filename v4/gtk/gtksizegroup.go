@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,12 +11,12 @@ import (
 // Groups widgets together so they all request the same size.
 //
 // This is typically useful when you want a column of widgets to have
-// the same size, but you can’t use a [class@Gtk.Grid] or [class@Gtk.Box].
+// the same size, but you can’t use a [Grid] or [Box].
 //
 // In detail, the size requested for each widget in a `GtkSizeGroup` is
 // the maximum of the sizes that would have been requested for each
 // widget in the size group if they were not in the size group. The
-// [mode][method@Gtk.SizeGroup.set_mode] of the size group determines
+// [mode][SizeGroup.SetMode] of the size group determines
 // whether this applies to the horizontal size, the vertical size, or
 // both sizes.
 //
@@ -26,13 +25,13 @@ import (
 // a `GtkSizeGroup` to actually be the same size, you need to pack them in
 // such a way that they get the size they request and not more. In
 // particular it doesn't make a lot of sense to set
-// [the expand flags][method@Gtk.Widget.set_hexpand] on the widgets that
+// [the expand flags][Widget.SetHexpand] on the widgets that
 // are members of a size group.
 //
 // `GtkSizeGroup` objects are referenced by each widget in the size group,
 // so once you have added all widgets to a `GtkSizeGroup`, you can drop
 // the initial reference to the size group with
-// [method@GObject.Object.unref]. If the widgets in the size group are
+// [gobject.Object.Unref]. If the widgets in the size group are
 // subsequently destroyed, then they will be removed from the size group
 // and drop their references on the size group; when all widgets have been
 // removed, the size group will be freed.
@@ -40,10 +39,10 @@ import (
 // Widgets can be part of multiple size groups; GTK will compute the
 // horizontal size of a widget from the horizontal requisition of all widgets
 // that can be reached from the widget by a chain of size groups with mode
-// [enum@Gtk.SizeGroupMode.HORIZONTAL] or [enum@Gtk.SizeGroupMode.BOTH], and
+// SizeGroupMode.HORIZONTAL or SizeGroupMode.BOTH, and
 // the vertical size from the vertical requisition of all widgets that can be
 // reached from the widget by a chain of size groups with mode
-// [enum@Gtk.SizeGroupMode.VERTICAL] or [enum@Gtk.SizeGroupMode.BOTH].
+// SizeGroupMode.VERTICAL or SizeGroupMode.BOTH.
 //
 // # Size groups and trading height-for-width
 //
@@ -53,15 +52,15 @@ import (
 //	trade height for width (or width for height), such as wrappable
 //	labels. Avoid using size groups with such widgets.
 //
-// A size group with mode [enum@Gtk.SizeGroupMode.HORIZONTAL] or
-// [enum@Gtk.SizeGroupMode.VERTICAL] only consults non-contextual sizes
+// A size group with mode SizeGroupMode.HORIZONTAL or
+// SizeGroupMode.VERTICAL only consults non-contextual sizes
 // of widgets other than the one being measured, since it has no
 // knowledge of what size a widget will get allocated in the other
 // orientation. This can lead to widgets in a group actually requesting
 // different contextual sizes, contrary to the purpose of
 // `GtkSizeGroup`.
 //
-// In contrast, a size group with mode [enum@Gtk.SizeGroupMode.BOTH] can
+// In contrast, a size group with mode SizeGroupMode.BOTH can
 // properly propagate the available size in the opposite orientation
 // when measuring widgets in the group, which results in consistent and
 // accurate measurements.
@@ -72,7 +71,7 @@ import (
 // the same width to all its children), and the size group is only
 // really needed to also make the widgets request the same size in the
 // other orientation, it is beneficial to still set the group's mode to
-// [enum@Gtk.SizeGroupMode.BOTH]. This lets the group assume and count
+// SizeGroupMode.BOTH. This lets the group assume and count
 // on sizes of the widgets in the former orientation being the same,
 // which enables it to propagate the available size as described above.
 //
@@ -85,37 +84,34 @@ import (
 //
 // Various container widgets and layout managers support a homogeneous
 // layout mode, where they will explicitly give the same size to their
-// children (see [property@Gtk.Box:homogeneous]). Using homogeneous mode
+// children (see [Box.GetPropertyHomogeneous]). Using homogeneous mode
 // can also have large performance benefits compared to either the same
 // container in non-homogeneous mode, or to size groups.
 //
-// [class@Gtk.Grid] can be used to position widgets into rows and
+// [Grid] can be used to position widgets into rows and
 // columns. Members of each column will have the same width among them;
 // likewise, members of each row will have the same height. On top of
 // that, the heights can be made equal between all rows with
-// [property@Gtk.Grid:row-homogeneous], and the widths can be made equal
-// between all columns with [property@Gtk.Grid:column-homogeneous].
+// [Grid.GetPropertyRowHomogeneous], and the widths can be made equal
+// between all columns with [Grid.GetPropertyColumnHomogeneous].
 //
 // # GtkSizeGroup as GtkBuildable
 //
-// Size groups can be specified in a UI definition by placing an `&lt;object&gt;`
+// Size groups can be specified in a UI definition by placing an `<object>`
 // element with `class="GtkSizeGroup"` somewhere in the UI definition. The
-// widgets that belong to the size group are specified by a `&lt;widgets&gt;` element
-// that may contain multiple `&lt;widget&gt;` elements, one for each member of the
+// widgets that belong to the size group are specified by a `<widgets>` element
+// that may contain multiple `<widget>` elements, one for each member of the
 // size group. The ”name” attribute gives the id of the widget.
 //
 // An example of a UI definition fragment with `GtkSizeGroup`:
-// ```xml
-// &lt;object class="GtkSizeGroup"&gt;
 //
-//	&lt;property name="mode"&gt;horizontal&lt;/property&gt;
-//	&lt;widgets&gt;
-//	  &lt;widget name="radio1"/&gt;
-//	  &lt;widget name="radio2"/&gt;
-//	&lt;/widgets&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkSizeGroup">
+//	  <property name="mode">horizontal</property>
+//	  <widgets>
+//	    <widget name="radio1"/>
+//	    <widget name="radio2"/>
+//	  </widgets>
+//	</object>
 type SizeGroup struct {
 	gobject.Object
 }
@@ -157,7 +153,7 @@ var xSizeGroupAddWidget func(uintptr, uintptr)
 // and the requisition of the other widgets in the size group.
 // Whether this applies horizontally, vertically, or in both directions
 // depends on the mode of the size group.
-// See [method@Gtk.SizeGroup.set_mode].
+// See [SizeGroup.SetMode].
 //
 // When the widget is destroyed or no longer referenced elsewhere, it
 // will be removed from the size group.
@@ -178,7 +174,7 @@ func (x *SizeGroup) GetMode() SizeGroupMode {
 
 var xSizeGroupGetWidgets func(uintptr) *glib.SList
 
-// Returns the list of widgets associated with @size_group.
+// Returns the list of widgets associated with size_group.
 func (x *SizeGroup) GetWidgets() *glib.SList {
 
 	cret := xSizeGroupGetWidgets(x.GoPointer())
@@ -200,9 +196,9 @@ var xSizeGroupSetMode func(uintptr, SizeGroupMode)
 //
 // The mode of the size group determines whether the widgets in the
 // size group should all have the same horizontal requisition
-// (%GTK_SIZE_GROUP_HORIZONTAL) all have the same vertical requisition
-// (%GTK_SIZE_GROUP_VERTICAL), or should all have the same requisition
-// in both directions (%GTK_SIZE_GROUP_BOTH).
+// ([SizeGroupHorizontalValue]) all have the same vertical requisition
+// ([SizeGroupVerticalValue]), or should all have the same requisition
+// in both directions ([SizeGroupBothValue]).
 func (x *SizeGroup) SetMode(ModeVar SizeGroupMode) {
 
 	xSizeGroupSetMode(x.GoPointer(), ModeVar)
@@ -220,10 +216,10 @@ func (c *SizeGroup) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SizeGroup) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -235,7 +231,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -244,12 +240,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xSizeGroupGLibType, libs, "gtk_size_group_get_type")
 
-	core.PuregoSafeRegister(&xNewSizeGroup, libs, "gtk_size_group_new")
+	core.PuregoRegisterSince(&xNewSizeGroup, libs, "gtk_size_group_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSizeGroupAddWidget, libs, "gtk_size_group_add_widget")
-	core.PuregoSafeRegister(&xSizeGroupGetMode, libs, "gtk_size_group_get_mode")
-	core.PuregoSafeRegister(&xSizeGroupGetWidgets, libs, "gtk_size_group_get_widgets")
-	core.PuregoSafeRegister(&xSizeGroupRemoveWidget, libs, "gtk_size_group_remove_widget")
-	core.PuregoSafeRegister(&xSizeGroupSetMode, libs, "gtk_size_group_set_mode")
+	core.PuregoRegisterSince(&xSizeGroupAddWidget, libs, "gtk_size_group_add_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSizeGroupGetMode, libs, "gtk_size_group_get_mode", "GTK", "")
+	core.PuregoRegisterSince(&xSizeGroupGetWidgets, libs, "gtk_size_group_get_widgets", "GTK", "")
+	core.PuregoRegisterSince(&xSizeGroupRemoveWidget, libs, "gtk_size_group_remove_widget", "GTK", "")
+	core.PuregoRegisterSince(&xSizeGroupSetMode, libs, "gtk_size_group_set_mode", "GTK", "")
 
 }
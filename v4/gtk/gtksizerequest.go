@@ -5,14 +5,13 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 // Represents a request of a screen object in a given orientation.
 //
 // These are primarily used in container implementations when allocating
-// a natural size for children. See [func@distribute_natural_allocation].
+// a natural size for children. See distribute_natural_allocation.
 type RequestedSize struct {
 	_ structs.HostLayout
 
@@ -29,10 +28,10 @@ func (x *RequestedSize) GoPointer() uintptr {
 
 var xDistributeNaturalAllocation func(int, uint, []RequestedSize) int
 
-// Distributes @extra_space to child @sizes by bringing smaller
+// Distributes extra_space to child sizes by bringing smaller
 // children up to natural size first.
 //
-// The remaining space will be added to the @minimum_size member of the
+// The remaining space will be added to the minimum_size member of the
 // `GtkRequestedSize` struct. If all sizes reach their natural size then
 // the remaining space is returned.
 func DistributeNaturalAllocation(ExtraSpaceVar int, NRequestedSizesVar uint, SizesVar []RequestedSize) int {
@@ -46,13 +45,13 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xDistributeNaturalAllocation, libs, "gtk_distribute_natural_allocation")
+	core.PuregoRegisterSince(&xDistributeNaturalAllocation, libs, "gtk_distribute_natural_allocation", "GTK", "")
 
 }
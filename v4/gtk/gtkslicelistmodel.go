@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -49,8 +48,8 @@ var xNewSliceListModel func(uintptr, uint, uint) uintptr
 
 // Creates a new slice model.
 //
-// It presents the slice from @offset to offset + @size
-// of the given @model.
+// It presents the slice from offset to offset + size
+// of the given model.
 func NewSliceListModel(ModelVar gio.ListModel, OffsetVar uint, SizeVar uint) *SliceListModel {
 	var cls *SliceListModel
 
@@ -66,7 +65,7 @@ func NewSliceListModel(ModelVar gio.ListModel, OffsetVar uint, SizeVar uint) *Sl
 
 var xSliceListModelGetModel func(uintptr) uintptr
 
-// Gets the model that is currently being used or %NULL if none.
+// Gets the model that is currently being used or nil if none.
 func (x *SliceListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -83,7 +82,7 @@ func (x *SliceListModel) GetModel() *gio.ListModelBase {
 
 var xSliceListModelGetOffset func(uintptr) uint
 
-// Gets the offset set via gtk_slice_list_model_set_offset().
+// Gets the offset set via [SliceListModel.SetOffset].
 func (x *SliceListModel) GetOffset() uint {
 
 	cret := xSliceListModelGetOffset(x.GoPointer())
@@ -92,7 +91,7 @@ func (x *SliceListModel) GetOffset() uint {
 
 var xSliceListModelGetSize func(uintptr) uint
 
-// Gets the size set via gtk_slice_list_model_set_size().
+// Gets the size set via [SliceListModel.SetSize].
 func (x *SliceListModel) GetSize() uint {
 
 	cret := xSliceListModelGetSize(x.GoPointer())
@@ -103,7 +102,7 @@ var xSliceListModelSetModel func(uintptr, uintptr)
 
 // Sets the model to show a slice of.
 //
-// The model's item type must conform to @self's item type.
+// The model's item type must conform to self's item type.
 func (x *SliceListModel) SetModel(ModelVar gio.ListModel) {
 
 	xSliceListModelSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -115,7 +114,7 @@ var xSliceListModelSetOffset func(uintptr, uint)
 // Sets the offset into the original model for this slice.
 //
 // If the offset is too large for the sliced model,
-// @self will end up empty.
+// self will end up empty.
 func (x *SliceListModel) SetOffset(OffsetVar uint) {
 
 	xSliceListModelSetOffset(x.GoPointer(), OffsetVar)
@@ -124,8 +123,8 @@ func (x *SliceListModel) SetOffset(OffsetVar uint) {
 
 var xSliceListModelSetSize func(uintptr, uint)
 
-// Sets the maximum size. @self will never have more items
-// than @size.
+// Sets the maximum size. self will never have more items
+// than size.
 //
 // It can however have fewer items if the offset is too large
 // or the model sliced from doesn't have enough items.
@@ -147,7 +146,7 @@ func (c *SliceListModel) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *SliceListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -188,28 +187,30 @@ func (x *SliceListModel) GetPropertySize() uint {
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *SliceListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *SliceListModel) GetItemType() types.GType {
 
@@ -217,29 +218,29 @@ func (x *SliceListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *SliceListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *SliceListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -253,20 +254,20 @@ func (x *SliceListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -290,20 +291,20 @@ func (x *SliceListModel) GetSection(PositionVar uint, OutStartVar *uint, OutEndV
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *SliceListModel) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -316,7 +317,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -325,13 +326,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xSliceListModelGLibType, libs, "gtk_slice_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewSliceListModel, libs, "gtk_slice_list_model_new")
+	gobject.CheckClassSize("GtkSliceListModelClass", xSliceListModelGLibType, unsafe.Sizeof(SliceListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewSliceListModel, libs, "gtk_slice_list_model_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSliceListModelGetModel, libs, "gtk_slice_list_model_get_model")
-	core.PuregoSafeRegister(&xSliceListModelGetOffset, libs, "gtk_slice_list_model_get_offset")
-	core.PuregoSafeRegister(&xSliceListModelGetSize, libs, "gtk_slice_list_model_get_size")
-	core.PuregoSafeRegister(&xSliceListModelSetModel, libs, "gtk_slice_list_model_set_model")
-	core.PuregoSafeRegister(&xSliceListModelSetOffset, libs, "gtk_slice_list_model_set_offset")
-	core.PuregoSafeRegister(&xSliceListModelSetSize, libs, "gtk_slice_list_model_set_size")
+	core.PuregoRegisterSince(&xSliceListModelGetModel, libs, "gtk_slice_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xSliceListModelGetOffset, libs, "gtk_slice_list_model_get_offset", "GTK", "")
+	core.PuregoRegisterSince(&xSliceListModelGetSize, libs, "gtk_slice_list_model_get_size", "GTK", "")
+	core.PuregoRegisterSince(&xSliceListModelSetModel, libs, "gtk_slice_list_model_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xSliceListModelSetOffset, libs, "gtk_slice_list_model_set_offset", "GTK", "")
+	core.PuregoRegisterSince(&xSliceListModelSetSize, libs, "gtk_slice_list_model_set_size", "GTK", "")
 
 }
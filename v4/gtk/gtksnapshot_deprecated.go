@@ -0,0 +1,164 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+	"github.com/jwijenbergh/puregotk/v4/gsk"
+	"github.com/jwijenbergh/puregotk/v4/pango"
+)
+
+var xSnapshotGlShaderPopTexture func(uintptr)
+
+// Removes the top element from the stack of render nodes and
+// adds it to the nearest [gsk.GLShaderNode] below it.
+//
+// This must be called the same number of times as the number
+// of textures is needed for the shader in
+// [Snapshot.PushGlShader].
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use [GLArea] for
+//	OpenGL rendering.
+func (x *Snapshot) GlShaderPopTexture() {
+
+	xSnapshotGlShaderPopTexture(x.GoPointer())
+
+}
+
+var xSnapshotPushGlShader func(uintptr, uintptr, *graphene.Rect, uintptr)
+
+// Push a [gsk.GLShaderNode].
+//
+// The node uses the given [gsk.GLShader] and uniform values
+// Additionally this takes a list of n_children other nodes
+// which will be passed to the [gsk.GLShaderNode].
+//
+// The take_args argument is a block of data to use for uniform
+// arguments, as per types and offsets defined by the shader.
+// Normally this is generated by [gsk.GLShader.FormatArgs]
+// or [gsk.ShaderArgsBuilder].
+//
+// The snapshotter takes ownership of take_args, so the caller should
+// not free it after this.
+//
+// If the renderer doesn't support GL shaders, or if there is any
+// problem when compiling the shader, then the node will draw pink.
+// You should use [gsk.GLShader.Compile] to ensure the shader
+// will work for the renderer before using it.
+//
+// If the shader requires textures (see [gsk.GLShader.GetNTextures]),
+// then it is expected that you call [Snapshot.GlShaderPopTexture]
+// the number of times that are required. Each of these calls will generate
+// a node that is added as a child to the `GskGLShaderNode`, which in turn
+// will render these offscreen and pass as a texture to the shader.
+//
+// Once all textures (if any) are pop:ed, you must call the regular
+// [Snapshot.Pop].
+//
+// If you want to use pre-existing textures as input to the shader rather
+// than rendering new ones, use [Snapshot.AppendTexture] to
+// push a texture node. These will be used directly rather than being
+// re-rendered.
+//
+// For details on how to write shaders, see [gsk.GLShader].
+//
+// Deprecated: since 4.16. GTK's new Vulkan-focused rendering
+//
+//	does not support this feature. Use [GLArea] for
+//	OpenGL rendering.
+func (x *Snapshot) PushGlShader(ShaderVar *gsk.GLShader, BoundsVar *graphene.Rect, TakeArgsVar *glib.Bytes) {
+
+	xSnapshotPushGlShader(x.GoPointer(), ShaderVar.GoPointer(), BoundsVar, gobject.BoxedCopy(glib.BytesGLibType(), TakeArgsVar.GoPointer()))
+
+}
+
+var xSnapshotRenderBackground func(uintptr, uintptr, float64, float64, float64, float64)
+
+// Creates a render node for the CSS background according to context,
+// and appends it to the current node of snapshot, without changing
+// the current node.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Snapshot) RenderBackground(ContextVar *StyleContext, XVar float64, YVar float64, WidthVar float64, HeightVar float64) {
+
+	xSnapshotRenderBackground(x.GoPointer(), ContextVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
+
+}
+
+var xSnapshotRenderFocus func(uintptr, uintptr, float64, float64, float64, float64)
+
+// Creates a render node for the focus outline according to context,
+// and appends it to the current node of snapshot, without changing
+// the current node.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Snapshot) RenderFocus(ContextVar *StyleContext, XVar float64, YVar float64, WidthVar float64, HeightVar float64) {
+
+	xSnapshotRenderFocus(x.GoPointer(), ContextVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
+
+}
+
+var xSnapshotRenderFrame func(uintptr, uintptr, float64, float64, float64, float64)
+
+// Creates a render node for the CSS border according to context,
+// and appends it to the current node of snapshot, without changing
+// the current node.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Snapshot) RenderFrame(ContextVar *StyleContext, XVar float64, YVar float64, WidthVar float64, HeightVar float64) {
+
+	xSnapshotRenderFrame(x.GoPointer(), ContextVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
+
+}
+
+var xSnapshotRenderInsertionCursor func(uintptr, uintptr, float64, float64, uintptr, int, pango.Direction)
+
+// Draws a text caret using snapshot at the specified index of layout.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Snapshot) RenderInsertionCursor(ContextVar *StyleContext, XVar float64, YVar float64, LayoutVar *pango.Layout, IndexVar int, DirectionVar pango.Direction) {
+
+	xSnapshotRenderInsertionCursor(x.GoPointer(), ContextVar.GoPointer(), XVar, YVar, LayoutVar.GoPointer(), IndexVar, DirectionVar)
+
+}
+
+var xSnapshotRenderLayout func(uintptr, uintptr, float64, float64, uintptr)
+
+// Creates a render node for rendering layout according to the style
+// information in context, and appends it to the current node of snapshot,
+// without changing the current node.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *Snapshot) RenderLayout(ContextVar *StyleContext, XVar float64, YVar float64, LayoutVar *pango.Layout) {
+
+	xSnapshotRenderLayout(x.GoPointer(), ContextVar.GoPointer(), XVar, YVar, LayoutVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xSnapshotGlShaderPopTexture, libs, "gtk_snapshot_gl_shader_pop_texture", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotPushGlShader, libs, "gtk_snapshot_push_gl_shader", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotRenderBackground, libs, "gtk_snapshot_render_background", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotRenderFocus, libs, "gtk_snapshot_render_focus", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotRenderFrame, libs, "gtk_snapshot_render_frame", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotRenderInsertionCursor, libs, "gtk_snapshot_render_insertion_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xSnapshotRenderLayout, libs, "gtk_snapshot_render_layout", "GTK", "")
+
+}
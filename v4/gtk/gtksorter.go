@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -44,25 +44,26 @@ func (x *SorterClass) GoPointer() uintptr {
 }
 
 // OverrideCompare sets the "compare" callback function.
-// Compare two items. See gtk_sorter_compare() for details.
+// Compare two items. See [Sorter.Compare] for details.
 func (x *SorterClass) OverrideCompare(cb func(*Sorter, *gobject.Object, *gobject.Object) Ordering) {
 	if cb == nil {
 		x.xCompare = 0
 	} else {
-		x.xCompare = purego.NewCallback(func(SelfVarp uintptr, Item1Varp uintptr, Item2Varp uintptr) Ordering {
+		x.xCompare = core.NewCallback(func(SelfVarp uintptr, Item1Varp uintptr, Item2Varp uintptr) Ordering {
+			defer core.RecoverPanic()
 			return cb(SorterNewFromInternalPtr(SelfVarp), gobject.ObjectNewFromInternalPtr(Item1Varp), gobject.ObjectNewFromInternalPtr(Item2Varp))
 		})
 	}
 }
 
 // GetCompare gets the "compare" callback function.
-// Compare two items. See gtk_sorter_compare() for details.
+// Compare two items. See [Sorter.Compare] for details.
 func (x *SorterClass) GetCompare() func(*Sorter, *gobject.Object, *gobject.Object) Ordering {
 	if x.xCompare == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, Item1Varp uintptr, Item2Varp uintptr) Ordering
-	purego.RegisterFunc(&rawCallback, x.xCompare)
+	core.RegisterFunc(&rawCallback, x.xCompare)
 	return func(SelfVar *Sorter, Item1Var *gobject.Object, Item2Var *gobject.Object) Ordering {
 		return rawCallback(SelfVar.GoPointer(), Item1Var.GoPointer(), Item2Var.GoPointer())
 	}
@@ -71,12 +72,13 @@ func (x *SorterClass) GetCompare() func(*Sorter, *gobject.Object, *gobject.Objec
 // OverrideGetOrder sets the "get_order" callback function.
 // Get the `GtkSorderOrder` that applies to the current sorter.
 //
-//	If unimplemented, it returns %GTK_SORTER_ORDER_PARTIAL.
+//	If unimplemented, it returns [SorterOrderPartialValue].
 func (x *SorterClass) OverrideGetOrder(cb func(*Sorter) SorterOrder) {
 	if cb == nil {
 		x.xGetOrder = 0
 	} else {
-		x.xGetOrder = purego.NewCallback(func(SelfVarp uintptr) SorterOrder {
+		x.xGetOrder = core.NewCallback(func(SelfVarp uintptr) SorterOrder {
+			defer core.RecoverPanic()
 			return cb(SorterNewFromInternalPtr(SelfVarp))
 		})
 	}
@@ -85,13 +87,13 @@ func (x *SorterClass) OverrideGetOrder(cb func(*Sorter) SorterOrder) {
 // GetGetOrder gets the "get_order" callback function.
 // Get the `GtkSorderOrder` that applies to the current sorter.
 //
-//	If unimplemented, it returns %GTK_SORTER_ORDER_PARTIAL.
+//	If unimplemented, it returns [SorterOrderPartialValue].
 func (x *SorterClass) GetGetOrder() func(*Sorter) SorterOrder {
 	if x.xGetOrder == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr) SorterOrder
-	purego.RegisterFunc(&rawCallback, x.xGetOrder)
+	core.RegisterFunc(&rawCallback, x.xGetOrder)
 	return func(SelfVar *Sorter) SorterOrder {
 		return rawCallback(SelfVar.GoPointer())
 	}
@@ -102,7 +104,8 @@ func (x *SorterClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -114,7 +117,7 @@ func (x *SorterClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -125,7 +128,8 @@ func (x *SorterClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -137,7 +141,7 @@ func (x *SorterClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -148,7 +152,8 @@ func (x *SorterClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -160,7 +165,7 @@ func (x *SorterClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -171,7 +176,8 @@ func (x *SorterClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -183,7 +189,7 @@ func (x *SorterClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -194,7 +200,8 @@ func (x *SorterClass) OverrideGtkReserved5(cb func()) {
 	if cb == nil {
 		x.xGtkReserved5 = 0
 	} else {
-		x.xGtkReserved5 = purego.NewCallback(func() {
+		x.xGtkReserved5 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -206,7 +213,7 @@ func (x *SorterClass) GetGtkReserved5() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved5)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved5)
 	return func() {
 		rawCallback()
 	}
@@ -217,7 +224,8 @@ func (x *SorterClass) OverrideGtkReserved6(cb func()) {
 	if cb == nil {
 		x.xGtkReserved6 = 0
 	} else {
-		x.xGtkReserved6 = purego.NewCallback(func() {
+		x.xGtkReserved6 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -229,7 +237,7 @@ func (x *SorterClass) GetGtkReserved6() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved6)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved6)
 	return func() {
 		rawCallback()
 	}
@@ -240,7 +248,8 @@ func (x *SorterClass) OverrideGtkReserved7(cb func()) {
 	if cb == nil {
 		x.xGtkReserved7 = 0
 	} else {
-		x.xGtkReserved7 = purego.NewCallback(func() {
+		x.xGtkReserved7 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -252,7 +261,7 @@ func (x *SorterClass) GetGtkReserved7() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved7)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved7)
 	return func() {
 		rawCallback()
 	}
@@ -263,7 +272,8 @@ func (x *SorterClass) OverrideGtkReserved8(cb func()) {
 	if cb == nil {
 		x.xGtkReserved8 = 0
 	} else {
-		x.xGtkReserved8 = purego.NewCallback(func() {
+		x.xGtkReserved8 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -275,7 +285,7 @@ func (x *SorterClass) GetGtkReserved8() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved8)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved8)
 	return func() {
 		rawCallback()
 	}
@@ -297,17 +307,62 @@ const (
 	//   by any of the other enumeration values
 	SorterChangeDifferentValue SorterChange = 0
 	// The sort order was inverted. Comparisons
-	//   that returned %GTK_ORDERING_SMALLER now return %GTK_ORDERING_LARGER
+	//   that returned [OrderingSmallerValue] now return [OrderingLargerValue]
 	//   and vice versa. Other comparisons return the same values as before.
 	SorterChangeInvertedValue SorterChange = 1
 	// The sorter is less strict: Comparisons
-	//   may now return %GTK_ORDERING_EQUAL that did not do so before.
+	//   may now return [OrderingEqualValue] that did not do so before.
 	SorterChangeLessStrictValue SorterChange = 2
 	// The sorter is more strict: Comparisons
-	//   that did return %GTK_ORDERING_EQUAL may not do so anymore.
+	//   that did return [OrderingEqualValue] may not do so anymore.
 	SorterChangeMoreStrictValue SorterChange = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SorterChange) String() string {
+	switch x {
+	case SorterChangeDifferentValue:
+		return "GTK_SORTER_CHANGE_DIFFERENT"
+	case SorterChangeInvertedValue:
+		return "GTK_SORTER_CHANGE_INVERTED"
+	case SorterChangeLessStrictValue:
+		return "GTK_SORTER_CHANGE_LESS_STRICT"
+	case SorterChangeMoreStrictValue:
+		return "GTK_SORTER_CHANGE_MORE_STRICT"
+	}
+	return fmt.Sprintf("SorterChange(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SorterChange) Nick() string {
+	switch x {
+	case SorterChangeDifferentValue:
+		return "different"
+	case SorterChangeInvertedValue:
+		return "inverted"
+	case SorterChangeLessStrictValue:
+		return "less-strict"
+	case SorterChangeMoreStrictValue:
+		return "more-strict"
+	}
+	return ""
+}
+
+// SorterChangeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SorterChangeFromString(s string) (SorterChange, bool) {
+	switch s {
+	case "GTK_SORTER_CHANGE_DIFFERENT", "different":
+		return SorterChangeDifferentValue, true
+	case "GTK_SORTER_CHANGE_INVERTED", "inverted":
+		return SorterChangeInvertedValue, true
+	case "GTK_SORTER_CHANGE_LESS_STRICT", "less-strict":
+		return SorterChangeLessStrictValue, true
+	case "GTK_SORTER_CHANGE_MORE_STRICT", "more-strict":
+		return SorterChangeMoreStrictValue, true
+	}
+	return 0, false
+}
+
 // Describes the type of order that a `GtkSorter` may produce.
 type SorterOrder int
 
@@ -322,30 +377,69 @@ const (
 	// A partial order. Any `GtkOrdering` is possible.
 	SorterOrderPartialValue SorterOrder = 0
 	// No order, all elements are considered equal.
-	//   gtk_sorter_compare() will only return %GTK_ORDERING_EQUAL.
+	//   [Sorter.Compare] will only return [OrderingEqualValue].
 	SorterOrderNoneValue SorterOrder = 1
-	// A total order. gtk_sorter_compare() will only
-	//   return %GTK_ORDERING_EQUAL if an item is compared with itself. Two
+	// A total order. [Sorter.Compare] will only
+	//   return [OrderingEqualValue] if an item is compared with itself. Two
 	//   different items will never cause this value to be returned.
 	SorterOrderTotalValue SorterOrder = 2
 )
 
-// Describes sorting criteria for a [class@Gtk.SortListModel].
+// String returns the C name of the value, e.g. for debugging output
+func (x SorterOrder) String() string {
+	switch x {
+	case SorterOrderPartialValue:
+		return "GTK_SORTER_ORDER_PARTIAL"
+	case SorterOrderNoneValue:
+		return "GTK_SORTER_ORDER_NONE"
+	case SorterOrderTotalValue:
+		return "GTK_SORTER_ORDER_TOTAL"
+	}
+	return fmt.Sprintf("SorterOrder(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SorterOrder) Nick() string {
+	switch x {
+	case SorterOrderPartialValue:
+		return "partial"
+	case SorterOrderNoneValue:
+		return "none"
+	case SorterOrderTotalValue:
+		return "total"
+	}
+	return ""
+}
+
+// SorterOrderFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SorterOrderFromString(s string) (SorterOrder, bool) {
+	switch s {
+	case "GTK_SORTER_ORDER_PARTIAL", "partial":
+		return SorterOrderPartialValue, true
+	case "GTK_SORTER_ORDER_NONE", "none":
+		return SorterOrderNoneValue, true
+	case "GTK_SORTER_ORDER_TOTAL", "total":
+		return SorterOrderTotalValue, true
+	}
+	return 0, false
+}
+
+// Describes sorting criteria for a [SortListModel].
 //
-// Its primary user is [class@Gtk.SortListModel]
+// Its primary user is [SortListModel]
 //
 // The model will use a sorter to determine the order in which
-// its items should appear by calling [method@Gtk.Sorter.compare]
+// its items should appear by calling [Sorter.Compare]
 // for pairs of items.
 //
 // Sorters may change their sorting behavior through their lifetime.
-// In that case, they will emit the [signal@Gtk.Sorter::changed] signal
+// In that case, they will emit the [Sorter.ConnectChangedFunc] signal
 // to notify that the sort order is no longer valid and should be updated
-// by calling gtk_sorter_compare() again.
+// by calling [Sorter.Compare] again.
 //
 // GTK provides various pre-made sorter implementations for common sorting
-// operations. [class@Gtk.ColumnView] has built-in support for sorting lists
-// via the [property@Gtk.ColumnViewColumn:sorter] property, where the user can
+// operations. [ColumnView] has built-in support for sorting lists
+// via the ColumnViewColumn:sorter property, where the user can
 // change the sorting by clicking on list headers.
 //
 // Of course, in particular for large lists, it is also possible to subclass
@@ -370,13 +464,13 @@ var xSorterChanged func(uintptr, SorterChange)
 
 // Notifies all users of the sorter that it has changed.
 //
-// This emits the [signal@Gtk.Sorter::changed] signal. Users
+// This emits the [Sorter.ConnectChangedFunc] signal. Users
 // of the sorter should then update the sort order via
-// [method@Gtk.Sorter.compare].
+// [Sorter.Compare].
 //
-// Depending on the @change parameter, it may be possible to
+// Depending on the change parameter, it may be possible to
 // update the sort order without a full resorting. Refer to
-// the [enum@Gtk.SorterChange] documentation for details.
+// the [SorterChange] documentation for details.
 //
 // This function is intended for implementers of `GtkSorter`
 // subclasses and should not be called from other functions.
@@ -394,12 +488,12 @@ var xSorterCompare func(uintptr, uintptr, uintptr) Ordering
 // Sorters implement a partial order:
 //
 //   - It is reflexive, ie a = a
-//   - It is antisymmetric, ie if a &lt; b and b &lt; a, then a = b
+//   - It is antisymmetric, ie if a < b and b < a, then a = b
 //   - It is transitive, ie given any 3 items with a ≤ b and b ≤ c,
 //     then a ≤ c
 //
 // The sorter may signal it conforms to additional constraints
-// via the return value of [method@Gtk.Sorter.get_order].
+// via the return value of [Sorter.GetOrder].
 func (x *Sorter) Compare(Item1Var *gobject.Object, Item2Var *gobject.Object) Ordering {
 
 	cret := xSorterCompare(x.GoPointer(), Item1Var.GoPointer(), Item2Var.GoPointer())
@@ -408,9 +502,9 @@ func (x *Sorter) Compare(Item1Var *gobject.Object, Item2Var *gobject.Object) Ord
 
 var xSorterGetOrder func(uintptr) SorterOrder
 
-// Gets the order that @self conforms to.
+// Gets the order that self conforms to.
 //
-// See [enum@Gtk.SorterOrder] for details
+// See [SorterOrder] for details
 // of the possible return values.
 //
 // This function is intended to allow optimizations.
@@ -434,34 +528,56 @@ func (c *Sorter) SetGoPointer(ptr uintptr) {
 // Emitted whenever the sorter changed.
 //
 // Users of the sorter should then update the sort order
-// again via gtk_sorter_compare().
+// again via [Sorter.Compare].
 //
-// [class@Gtk.SortListModel] handles this signal automatically.
+// [SortListModel] handles this signal automatically.
 //
-// Depending on the @change parameter, it may be possible to update
+// Depending on the change parameter, it may be possible to update
 // the sort order without a full resorting. Refer to the
-// [enum@Gtk.SorterChange] documentation for details.
+// [SorterChange] documentation for details.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *Sorter) ConnectChanged(cb *func(Sorter, SorterChange)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ChangeVarp SorterChange) {
+			defer core.RecoverPanic()
+			fa := Sorter{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ChangeVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ChangeVarp SorterChange) {
+var xSorterChangedTrampoline uintptr
+
+func xSorterChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ChangeVarp SorterChange, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Sorter, SorterChange))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Sorter{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ChangeVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Sorter) ConnectChangedFunc(cb func(Sorter, SorterChange)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xSorterChangedTrampoline, xSorterChangedNewTrampoline, cb)
 }
 
 func init() {
@@ -469,7 +585,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -482,8 +598,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xSorterGLibType, libs, "gtk_sorter_get_type")
 
-	core.PuregoSafeRegister(&xSorterChanged, libs, "gtk_sorter_changed")
-	core.PuregoSafeRegister(&xSorterCompare, libs, "gtk_sorter_compare")
-	core.PuregoSafeRegister(&xSorterGetOrder, libs, "gtk_sorter_get_order")
+	gobject.CheckClassSize("GtkSorterClass", xSorterGLibType, unsafe.Sizeof(SorterClass{}))
+
+	core.PuregoRegisterSince(&xSorterChanged, libs, "gtk_sorter_changed", "GTK", "")
+	core.PuregoRegisterSince(&xSorterCompare, libs, "gtk_sorter_compare", "GTK", "")
+	core.PuregoRegisterSince(&xSorterGetOrder, libs, "gtk_sorter_get_order", "GTK", "")
 
 }
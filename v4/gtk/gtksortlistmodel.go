@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -36,7 +35,7 @@ func (x *SortListModelClass) GoPointer() uintptr {
 //
 // The model can be set up to do incremental sorting, so that
 // sorting long lists doesn't block the UI. See
-// [method@Gtk.SortListModel.set_incremental] for details.
+// [SortListModel.SetIncremental] for details.
 //
 // `GtkSortListModel` is a generic model and because of that it
 // cannot take advantage of any external knowledge when sorting.
@@ -45,10 +44,10 @@ func (x *SortListModelClass) GoPointer() uintptr {
 // model.
 //
 // `GtkSortListModel` allows sorting the items into sections. It
-// implements `GtkSectionModel` and when [property@Gtk.SortListModel:section-sorter]
+// implements `GtkSectionModel` and when SortListModel:section-sorter
 // is set, it will sort all items with that sorter and items comparing
 // equal with it will be put into the same section.
-// The [property@Gtk.SortListModel:sorter] will then be used to sort items
+// The SortListModel:sorter will then be used to sort items
 // inside their sections.
 type SortListModel struct {
 	gobject.Object
@@ -68,7 +67,7 @@ func SortListModelNewFromInternalPtr(ptr uintptr) *SortListModel {
 
 var xNewSortListModel func(uintptr, uintptr) uintptr
 
-// Creates a new sort list model that uses the @sorter to sort @model.
+// Creates a new sort list model that uses the sorter to sort model.
 func NewSortListModel(ModelVar gio.ListModel, SorterVar *Sorter) *SortListModel {
 	var cls *SortListModel
 
@@ -86,7 +85,7 @@ var xSortListModelGetIncremental func(uintptr) bool
 
 // Returns whether incremental sorting is enabled.
 //
-// See [method@Gtk.SortListModel.set_incremental].
+// See [SortListModel.SetIncremental].
 func (x *SortListModel) GetIncremental() bool {
 
 	cret := xSortListModelGetIncremental(x.GoPointer())
@@ -95,7 +94,7 @@ func (x *SortListModel) GetIncremental() bool {
 
 var xSortListModelGetModel func(uintptr) uintptr
 
-// Gets the model currently sorted or %NULL if none.
+// Gets the model currently sorted or nil if none.
 func (x *SortListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -120,14 +119,13 @@ var xSortListModelGetPending func(uintptr) uint
 // already correctly sorted.
 //
 // If you want to estimate the progress, you can use code like this:
-// ```c
-// pending = gtk_sort_list_model_get_pending (self);
-// model = gtk_sort_list_model_get_model (self);
-// progress = 1.0 - pending / (double) MAX (1, g_list_model_get_n_items (model));
-// ```
+//
+//	pending = gtk_sort_list_model_get_pending (self);
+//	model = gtk_sort_list_model_get_model (self);
+//	progress = 1.0 - pending / (double) MAX (1, g_list_model_get_n_items (model));
 //
 // If no sort operation is ongoing - in particular when
-// [property@Gtk.SortListModel:incremental] is %FALSE - this
+// [SortListModel.GetPropertyIncremental] is false - this
 // function returns 0.
 func (x *SortListModel) GetPending() uint {
 
@@ -137,7 +135,7 @@ func (x *SortListModel) GetPending() uint {
 
 var xSortListModelGetSectionSorter func(uintptr) uintptr
 
-// Gets the section sorter that is used to sort items of @self into
+// Gets the section sorter that is used to sort items of self into
 // sections.
 func (x *SortListModel) GetSectionSorter() *Sorter {
 	var cls *Sorter
@@ -155,7 +153,7 @@ func (x *SortListModel) GetSectionSorter() *Sorter {
 
 var xSortListModelGetSorter func(uintptr) uintptr
 
-// Gets the sorter that is used to sort @self.
+// Gets the sorter that is used to sort self.
 func (x *SortListModel) GetSorter() *Sorter {
 	var cls *Sorter
 
@@ -186,7 +184,7 @@ var xSortListModelSetIncremental func(uintptr, bool)
 //
 // By default, incremental sorting is disabled.
 //
-// See [method@Gtk.SortListModel.get_pending] for progress information
+// See [SortListModel.GetPending] for progress information
 // about an ongoing incremental sorting operation.
 func (x *SortListModel) SetIncremental(IncrementalVar bool) {
 
@@ -198,7 +196,7 @@ var xSortListModelSetModel func(uintptr, uintptr)
 
 // Sets the model to be sorted.
 //
-// The @model's item type must conform to the item type of @self.
+// The model's item type must conform to the item type of self.
 func (x *SortListModel) SetModel(ModelVar gio.ListModel) {
 
 	xSortListModelSetModel(x.GoPointer(), ModelVar.GoPointer())
@@ -207,7 +205,7 @@ func (x *SortListModel) SetModel(ModelVar gio.ListModel) {
 
 var xSortListModelSetSectionSorter func(uintptr, uintptr)
 
-// Sets a new section sorter on @self.
+// Sets a new section sorter on self.
 func (x *SortListModel) SetSectionSorter(SorterVar *Sorter) {
 
 	xSortListModelSetSectionSorter(x.GoPointer(), SorterVar.GoPointer())
@@ -216,7 +214,7 @@ func (x *SortListModel) SetSectionSorter(SorterVar *Sorter) {
 
 var xSortListModelSetSorter func(uintptr, uintptr)
 
-// Sets a new sorter on @self.
+// Sets a new sorter on self.
 func (x *SortListModel) SetSorter(SorterVar *Sorter) {
 
 	xSortListModelSetSorter(x.GoPointer(), SorterVar.GoPointer())
@@ -252,7 +250,7 @@ func (x *SortListModel) GetPropertyIncremental() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *SortListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -267,28 +265,30 @@ func (x *SortListModel) GetPropertyPending() uint {
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *SortListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *SortListModel) GetItemType() types.GType {
 
@@ -296,29 +296,29 @@ func (x *SortListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *SortListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *SortListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -332,20 +332,20 @@ func (x *SortListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -369,20 +369,20 @@ func (x *SortListModel) GetSection(PositionVar uint, OutStartVar *uint, OutEndVa
 
 }
 
-// This function emits the [signal@Gtk.SectionModel::sections-changed]
+// This function emits the SectionModel::sections-changed
 // signal to notify about changes to sections.
 //
 // It must cover all positions that used to be a section start or that
 // are now a section start. It does not have to cover all positions for
 // which the section has changed.
 //
-// The [signal@Gio.ListModel::items-changed] implies the effect of the
-// [signal@Gtk.SectionModel::sections-changed] signal for all the items
+// The ListModel::items-changed implies the effect of the
+// SectionModel::sections-changed signal for all the items
 // it covers.
 //
 // It is recommended that when changes to the items cause section changes
 // in a larger range, that the larger range is included in the emission
-// of the [signal@Gio.ListModel::items-changed] instead of emitting
+// of the ListModel::items-changed instead of emitting
 // two signals.
 func (x *SortListModel) SectionsChanged(PositionVar uint, NItemsVar uint) {
 
@@ -395,7 +395,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -404,16 +404,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xSortListModelGLibType, libs, "gtk_sort_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewSortListModel, libs, "gtk_sort_list_model_new")
-
-	core.PuregoSafeRegister(&xSortListModelGetIncremental, libs, "gtk_sort_list_model_get_incremental")
-	core.PuregoSafeRegister(&xSortListModelGetModel, libs, "gtk_sort_list_model_get_model")
-	core.PuregoSafeRegister(&xSortListModelGetPending, libs, "gtk_sort_list_model_get_pending")
-	core.PuregoSafeRegister(&xSortListModelGetSectionSorter, libs, "gtk_sort_list_model_get_section_sorter")
-	core.PuregoSafeRegister(&xSortListModelGetSorter, libs, "gtk_sort_list_model_get_sorter")
-	core.PuregoSafeRegister(&xSortListModelSetIncremental, libs, "gtk_sort_list_model_set_incremental")
-	core.PuregoSafeRegister(&xSortListModelSetModel, libs, "gtk_sort_list_model_set_model")
-	core.PuregoSafeRegister(&xSortListModelSetSectionSorter, libs, "gtk_sort_list_model_set_section_sorter")
-	core.PuregoSafeRegister(&xSortListModelSetSorter, libs, "gtk_sort_list_model_set_sorter")
+	gobject.CheckClassSize("GtkSortListModelClass", xSortListModelGLibType, unsafe.Sizeof(SortListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewSortListModel, libs, "gtk_sort_list_model_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xSortListModelGetIncremental, libs, "gtk_sort_list_model_get_incremental", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelGetModel, libs, "gtk_sort_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelGetPending, libs, "gtk_sort_list_model_get_pending", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelGetSectionSorter, libs, "gtk_sort_list_model_get_section_sorter", "GTK", "4.12")
+	core.PuregoRegisterSince(&xSortListModelGetSorter, libs, "gtk_sort_list_model_get_sorter", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelSetIncremental, libs, "gtk_sort_list_model_set_incremental", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelSetModel, libs, "gtk_sort_list_model_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xSortListModelSetSectionSorter, libs, "gtk_sort_list_model_set_section_sorter", "GTK", "4.12")
+	core.PuregoRegisterSince(&xSortListModelSetSorter, libs, "gtk_sort_list_model_set_sorter", "GTK", "")
 
 }
@@ -2,9 +2,9 @@
 package gtk
 
 import (
+	"fmt"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -16,14 +16,14 @@ const (
 	// Constant to return from a signal handler for the ::input
 	// signal in case of conversion failure.
 	//
-	// See [signal@Gtk.SpinButton::input].
+	// See [SpinButton.ConnectInputFunc].
 	INPUT_ERROR int = -1
 )
 
 // Determines whether the spin button displays values outside the adjustment
 // bounds.
 //
-// See [method@Gtk.SpinButton.set_update_policy].
+// See [SpinButton.SetUpdatePolicy].
 type SpinButtonUpdatePolicy int
 
 var xSpinButtonUpdatePolicyGLibType func() types.GType
@@ -43,8 +43,41 @@ const (
 	UpdateIfValidValue SpinButtonUpdatePolicy = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SpinButtonUpdatePolicy) String() string {
+	switch x {
+	case UpdateAlwaysValue:
+		return "GTK_UPDATE_ALWAYS"
+	case UpdateIfValidValue:
+		return "GTK_UPDATE_IF_VALID"
+	}
+	return fmt.Sprintf("SpinButtonUpdatePolicy(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SpinButtonUpdatePolicy) Nick() string {
+	switch x {
+	case UpdateAlwaysValue:
+		return "always"
+	case UpdateIfValidValue:
+		return "if-valid"
+	}
+	return ""
+}
+
+// SpinButtonUpdatePolicyFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SpinButtonUpdatePolicyFromString(s string) (SpinButtonUpdatePolicy, bool) {
+	switch s {
+	case "GTK_UPDATE_ALWAYS", "always":
+		return UpdateAlwaysValue, true
+	case "GTK_UPDATE_IF_VALID", "if-valid":
+		return UpdateIfValidValue, true
+	}
+	return 0, false
+}
+
 // The values of the GtkSpinType enumeration are used to specify the
-// change to make in gtk_spin_button_spin().
+// change to make in [SpinButton.Spin].
 type SpinType int
 
 var xSpinTypeGLibType func() types.GType
@@ -71,15 +104,71 @@ const (
 	SpinUserDefinedValue SpinType = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x SpinType) String() string {
+	switch x {
+	case SpinStepForwardValue:
+		return "GTK_SPIN_STEP_FORWARD"
+	case SpinStepBackwardValue:
+		return "GTK_SPIN_STEP_BACKWARD"
+	case SpinPageForwardValue:
+		return "GTK_SPIN_PAGE_FORWARD"
+	case SpinPageBackwardValue:
+		return "GTK_SPIN_PAGE_BACKWARD"
+	case SpinHomeValue:
+		return "GTK_SPIN_HOME"
+	case SpinEndValue:
+		return "GTK_SPIN_END"
+	case SpinUserDefinedValue:
+		return "GTK_SPIN_USER_DEFINED"
+	}
+	return fmt.Sprintf("SpinType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x SpinType) Nick() string {
+	switch x {
+	case SpinStepForwardValue:
+		return "step-forward"
+	case SpinStepBackwardValue:
+		return "step-backward"
+	case SpinPageForwardValue:
+		return "page-forward"
+	case SpinPageBackwardValue:
+		return "page-backward"
+	case SpinHomeValue:
+		return "home"
+	case SpinEndValue:
+		return "end"
+	case SpinUserDefinedValue:
+		return "user-defined"
+	}
+	return ""
+}
+
+// SpinTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func SpinTypeFromString(s string) (SpinType, bool) {
+	switch s {
+	case "GTK_SPIN_STEP_FORWARD", "step-forward":
+		return SpinStepForwardValue, true
+	case "GTK_SPIN_STEP_BACKWARD", "step-backward":
+		return SpinStepBackwardValue, true
+	case "GTK_SPIN_PAGE_FORWARD", "page-forward":
+		return SpinPageForwardValue, true
+	case "GTK_SPIN_PAGE_BACKWARD", "page-backward":
+		return SpinPageBackwardValue, true
+	case "GTK_SPIN_HOME", "home":
+		return SpinHomeValue, true
+	case "GTK_SPIN_END", "end":
+		return SpinEndValue, true
+	case "GTK_SPIN_USER_DEFINED", "user-defined":
+		return SpinUserDefinedValue, true
+	}
+	return 0, false
+}
+
 // Allows to enter or change numeric values.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="spinbutton-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkSpinButton" src="spinbutton.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Rather than having to directly type a number into a `GtkEntry`,
 // `GtkSpinButton` allows the user to click on one of two arrows
 // to increment or decrement the displayed value. A value can still be
@@ -87,32 +176,29 @@ const (
 // given range.
 //
 // The main properties of a `GtkSpinButton` are through an adjustment.
-// See the [class@Gtk.Adjustment] documentation for more details about
+// See the [Adjustment] documentation for more details about
 // an adjustment's properties.
 //
 // Note that `GtkSpinButton` will by default make its entry large enough
 // to accommodate the lower and upper bounds of the adjustment. If this
 // is not desired, the automatic sizing can be turned off by explicitly
-// setting [property@Gtk.Editable:width-chars] to a value != -1.
-//
-// ## Using a GtkSpinButton to get an integer
+// setting [EditableBase.GetPropertyWidthChars] to a value != -1.
 //
-// ```c
-// // Provides a function to retrieve an integer value from a GtkSpinButton
-// // and creates a spin button to model percentage values.
+// # Using a GtkSpinButton to get an integer
 //
-// int
-// grab_int_value (GtkSpinButton *button,
-//
-//	gpointer       user_data)
+//	// Provides a function to retrieve an integer value from a GtkSpinButton
+//	// and creates a spin button to model percentage values.
 //
+//	int
+//	grab_int_value (GtkSpinButton *button,
+//	                gpointer       user_data)
 //	{
 //	  return gtk_spin_button_get_value_as_int (button);
 //	}
 //
-// void
-// create_integer_spin_button (void)
-// {
+//	void
+//	create_integer_spin_button (void)
+//	{
 //
 //	  GtkWidget *window, *button;
 //	  GtkAdjustment *adjustment;
@@ -128,26 +214,20 @@ const (
 //	  gtk_window_present (GTK_WINDOW (window));
 //	}
 //
-// ```
-//
-// ## Using a GtkSpinButton to get a floating point value
-//
-// ```c
-// // Provides a function to retrieve a floating point value from a
-// // GtkSpinButton, and creates a high precision spin button.
-//
-// float
-// grab_float_value (GtkSpinButton *button,
+// # Using a GtkSpinButton to get a floating point value
 //
-//	gpointer       user_data)
+//	// Provides a function to retrieve a floating point value from a
+//	// GtkSpinButton, and creates a high precision spin button.
 //
+//	float
+//	grab_float_value (GtkSpinButton *button,
+//	                  gpointer       user_data)
 //	{
 //	  return gtk_spin_button_get_value (button);
 //	}
 //
-// void
-// create_floating_spin_button (void)
-//
+//	void
+//	create_floating_spin_button (void)
 //	{
 //	  GtkWidget *window, *button;
 //	  GtkAdjustment *adjustment;
@@ -163,33 +243,27 @@ const (
 //	  gtk_window_present (GTK_WINDOW (window));
 //	}
 //
-// ```
-//
 // # Shortcuts and Gestures
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.SpinButton::change-value]
+// - [SpinButton.ConnectChangeValueFunc]
 //
 // # CSS nodes
 //
-// ```
-// spinbutton.horizontal
-// ├── text
-// │    ├── undershoot.left
-// │    ╰── undershoot.right
-// ├── button.down
-// ╰── button.up
-// ```
-//
-// ```
-// spinbutton.vertical
-// ├── button.up
-// ├── text
-// │    ├── undershoot.left
-// │    ╰── undershoot.right
-// ╰── button.down
-// ```
+//	spinbutton.horizontal
+//	├── text
+//	│    ├── undershoot.left
+//	│    ╰── undershoot.right
+//	├── button.down
+//	╰── button.up
+//
+//	spinbutton.vertical
+//	├── button.up
+//	├── text
+//	│    ├── undershoot.left
+//	│    ╰── undershoot.right
+//	╰── button.down
 //
 // `GtkSpinButton`s main CSS node has the name spinbutton. It creates subnodes
 // for the entry and the two buttons, with these names. The button nodes have
@@ -199,7 +273,7 @@ const (
 //
 // # Accessibility
 //
-// `GtkSpinButton` uses the [enum@Gtk.AccessibleRole.spin_button] role.
+// `GtkSpinButton` uses the [AccessibleRoleSpinButtonValue] role.
 type SpinButton struct {
 	Widget
 }
@@ -240,14 +314,14 @@ var xNewSpinButtonWithRange func(float64, float64, float64) uintptr
 // This is a convenience constructor that allows creation
 // of a numeric `GtkSpinButton` without manually creating
 // an adjustment. The value is initially set to the minimum
-// value and a page increment of 10 * @step is the default.
+// value and a page increment of 10 * step is the default.
 // The precision of the spin button is equivalent to the
-// precision of @step.
+// precision of step.
 //
 // Note that the way in which the precision is derived works
-// best if @step is a power of ten. If the resulting precision
+// best if step is a power of ten. If the resulting precision
 // is not suitable for your needs, use
-// [method@Gtk.SpinButton.set_digits] to correct it.
+// [SpinButton.SetDigits] to correct it.
 func NewSpinButtonWithRange(MinVar float64, MaxVar float64, StepVar float64) *SpinButton {
 	var cls *SpinButton
 
@@ -276,7 +350,7 @@ func (x *SpinButton) Configure(AdjustmentVar *Adjustment, ClimbRateVar float64,
 
 var xSpinButtonGetActivatesDefault func(uintptr) bool
 
-// Retrieves the value set by [method@Gtk.SpinButton.set_activates_default].
+// Retrieves the value set by [SpinButton.SetActivatesDefault].
 func (x *SpinButton) GetActivatesDefault() bool {
 
 	cret := xSpinButtonGetActivatesDefault(x.GoPointer())
@@ -311,7 +385,7 @@ func (x *SpinButton) GetClimbRate() float64 {
 
 var xSpinButtonGetDigits func(uintptr) uint
 
-// Fetches the precision of @spin_button.
+// Fetches the precision of spin_button.
 func (x *SpinButton) GetDigits() uint {
 
 	cret := xSpinButtonGetDigits(x.GoPointer())
@@ -321,9 +395,9 @@ func (x *SpinButton) GetDigits() uint {
 var xSpinButtonGetIncrements func(uintptr, *float64, *float64)
 
 // Gets the current step and page the increments
-// used by @spin_button.
+// used by spin_button.
 //
-// See [method@Gtk.SpinButton.set_increments].
+// See [SpinButton.SetIncrements].
 func (x *SpinButton) GetIncrements(StepVar *float64, PageVar *float64) {
 
 	xSpinButtonGetIncrements(x.GoPointer(), StepVar, PageVar)
@@ -341,9 +415,9 @@ func (x *SpinButton) GetNumeric() bool {
 
 var xSpinButtonGetRange func(uintptr, *float64, *float64)
 
-// Gets the range allowed for @spin_button.
+// Gets the range allowed for spin_button.
 //
-// See [method@Gtk.SpinButton.set_range].
+// See [SpinButton.SetRange].
 func (x *SpinButton) GetRange(MinVar *float64, MaxVar *float64) {
 
 	xSpinButtonGetRange(x.GoPointer(), MinVar, MaxVar)
@@ -363,7 +437,7 @@ var xSpinButtonGetUpdatePolicy func(uintptr) SpinButtonUpdatePolicy
 
 // Gets the update behavior of a spin button.
 //
-// See [method@Gtk.SpinButton.set_update_policy].
+// See [SpinButton.SetUpdatePolicy].
 func (x *SpinButton) GetUpdatePolicy() SpinButtonUpdatePolicy {
 
 	cret := xSpinButtonGetUpdatePolicy(x.GoPointer())
@@ -372,7 +446,7 @@ func (x *SpinButton) GetUpdatePolicy() SpinButtonUpdatePolicy {
 
 var xSpinButtonGetValue func(uintptr) float64
 
-// Get the value in the @spin_button.
+// Get the value in the spin_button.
 func (x *SpinButton) GetValue() float64 {
 
 	cret := xSpinButtonGetValue(x.GoPointer())
@@ -381,7 +455,7 @@ func (x *SpinButton) GetValue() float64 {
 
 var xSpinButtonGetValueAsInt func(uintptr) int
 
-// Get the value @spin_button represented as an integer.
+// Get the value spin_button represented as an integer.
 func (x *SpinButton) GetValueAsInt() int {
 
 	cret := xSpinButtonGetValueAsInt(x.GoPointer())
@@ -404,7 +478,7 @@ var xSpinButtonSetActivatesDefault func(uintptr, bool)
 // Sets whether activating the spin button will activate the default
 // widget for the window containing the spin button.
 //
-// See [signal@Gtk.SpinButton::activate] for what counts as activation.
+// See [SpinButton.ConnectActivateFunc] for what counts as activation.
 func (x *SpinButton) SetActivatesDefault(ActivatesDefaultVar bool) {
 
 	xSpinButtonSetActivatesDefault(x.GoPointer(), ActivatesDefaultVar)
@@ -413,7 +487,7 @@ func (x *SpinButton) SetActivatesDefault(ActivatesDefaultVar bool) {
 
 var xSpinButtonSetAdjustment func(uintptr, uintptr)
 
-// Replaces the `GtkAdjustment` associated with @spin_button.
+// Replaces the `GtkAdjustment` associated with spin_button.
 func (x *SpinButton) SetAdjustment(AdjustmentVar *Adjustment) {
 
 	xSpinButtonSetAdjustment(x.GoPointer(), AdjustmentVar.GoPointer())
@@ -432,7 +506,7 @@ func (x *SpinButton) SetClimbRate(ClimbRateVar float64) {
 
 var xSpinButtonSetDigits func(uintptr, uint)
 
-// Set the precision to be displayed by @spin_button.
+// Set the precision to be displayed by spin_button.
 //
 // Up to 20 digit precision is allowed.
 func (x *SpinButton) SetDigits(DigitsVar uint) {
@@ -465,7 +539,7 @@ func (x *SpinButton) SetNumeric(NumericVar bool) {
 
 var xSpinButtonSetRange func(uintptr, float64, float64)
 
-// Sets the minimum and maximum allowable values for @spin_button.
+// Sets the minimum and maximum allowable values for spin_button.
 //
 // If the current value is outside this range, it will be adjusted
 // to fit within the range, otherwise it will remain unchanged.
@@ -500,7 +574,7 @@ func (x *SpinButton) SetUpdatePolicy(PolicyVar SpinButtonUpdatePolicy) {
 
 var xSpinButtonSetValue func(uintptr, float64)
 
-// Sets the value of @spin_button.
+// Sets the value of spin_button.
 func (x *SpinButton) SetValue(ValueVar float64) {
 
 	xSpinButtonSetValue(x.GoPointer(), ValueVar)
@@ -551,7 +625,7 @@ func (c *SpinButton) SetGoPointer(ptr uintptr) {
 // SetPropertyActivatesDefault sets the "activates-default" property.
 // Whether to activate the default widget when the spin button is activated.
 //
-// See [signal@Gtk.SpinButton::activate] for what counts as activation.
+// See [SpinButton.ConnectActivateFunc] for what counts as activation.
 func (x *SpinButton) SetPropertyActivatesDefault(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -562,7 +636,7 @@ func (x *SpinButton) SetPropertyActivatesDefault(value bool) {
 // GetPropertyActivatesDefault gets the "activates-default" property.
 // Whether to activate the default widget when the spin button is activated.
 //
-// See [signal@Gtk.SpinButton::activate] for what counts as activation.
+// See [SpinButton.ConnectActivateFunc] for what counts as activation.
 func (x *SpinButton) GetPropertyActivatesDefault() bool {
 	var v gobject.Value
 	x.GetProperty("activates-default", &v)
@@ -675,105 +749,168 @@ func (x *SpinButton) GetPropertyWrap() bool {
 
 // Emitted when the spin button is activated.
 //
-// The keybindings for this signal are all forms of the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// The keybindings for this signal are all forms of the Enter key.
 //
-// If the &lt;kbd&gt;Enter&lt;/kbd&gt; key results in the value being committed to the
-// spin button, then activation does not occur until &lt;kbd&gt;Enter&lt;/kbd&gt; is
+// If the Enter key results in the value being committed to the
+// spin button, then activation does not occur until Enter is
 // pressed again.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectActivate(cb *func(SpinButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xSpinButtonActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSpinButtonActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectActivateFunc(cb func(SpinButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xSpinButtonActivateTrampoline, xSpinButtonActivateNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a value change.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // Applications should not connect to it, but may emit it with
-// g_signal_emit_by_name() if they need to control the cursor
+// [gobject.SignalEmitByName] if they need to control the cursor
 // programmatically.
 //
 // The default bindings for this signal are Up/Down and PageUp/PageDown.
+//
+// Deprecated: use ConnectChangeValueFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectChangeValue(cb *func(SpinButton, ScrollType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "change-value", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ScrollVarp ScrollType) {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ScrollVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "change-value", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, ScrollVarp ScrollType) {
+var xSpinButtonChangeValueTrampoline uintptr
+
+func xSpinButtonChangeValueNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ScrollVarp ScrollType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton, ScrollType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ScrollVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "change-value", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangeValueFunc connects cb to the "change-value" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectChangeValueFunc(cb func(SpinButton, ScrollType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "change-value", &xSpinButtonChangeValueTrampoline, xSpinButtonChangeValueNewTrampoline, cb)
 }
 
 // Emitted to convert the users input into a double value.
 //
-// The signal handler is expected to use [method@Gtk.Editable.get_text]
-// to retrieve the text of the spinbutton and set @new_value to the
+// The signal handler is expected to use [Editable.GetText]
+// to retrieve the text of the spinbutton and set new_value to the
 // new value.
 //
-// The default conversion uses g_strtod().
+// The default conversion uses [glib.Strtod].
+//
+// Deprecated: use ConnectInputFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectInput(cb *func(SpinButton, *float64) int) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "input", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, NewValueVarp *float64) int {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, NewValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "input", cbRefPtr, cbPtr)
+}
+
+var xSpinButtonInputTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, NewValueVarp *float64) int {
+func xSpinButtonInputNewTrampoline() interface{} {
+	return func(clsPtr uintptr, NewValueVarp *float64, data uintptr) (ret int) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton, *float64) int)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, NewValueVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "input", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInputFunc connects cb to the "input" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectInputFunc(cb func(SpinButton, *float64) int) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "input", &xSpinButtonInputTrampoline, xSpinButtonInputNewTrampoline, cb)
 }
 
 // Emitted to tweak the formatting of the value for display.
 //
-// ```c
-// // show leading zeros
-// static gboolean
-// on_output (GtkSpinButton *spin,
-//
-//	gpointer       data)
-//
+//	// show leading zeros
+//	static gboolean
+//	on_output (GtkSpinButton *spin,
+//	           gpointer       data)
 //	{
 //	   char *text;
 //	   int value;
@@ -786,79 +923,143 @@ func (x *SpinButton) ConnectInput(cb *func(SpinButton, *float64) int) uint {
 //	   return TRUE;
 //	}
 //
-// ```
+// Deprecated: use ConnectOutputFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectOutput(cb *func(SpinButton) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "output", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "output", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xSpinButtonOutputTrampoline uintptr
+
+func xSpinButtonOutputNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "output", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectOutputFunc connects cb to the "output" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectOutputFunc(cb func(SpinButton) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "output", &xSpinButtonOutputTrampoline, xSpinButtonOutputNewTrampoline, cb)
 }
 
 // Emitted when the value is changed.
 //
-// Also see the [signal@Gtk.SpinButton::output] signal.
+// Also see the [SpinButton.ConnectOutputFunc] signal.
+//
+// Deprecated: use ConnectValueChangedFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectValueChanged(cb *func(SpinButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "value-changed", cbRefPtr, cbPtr)
+}
+
+var xSpinButtonValueChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSpinButtonValueChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "value-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectValueChangedFunc connects cb to the "value-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectValueChangedFunc(cb func(SpinButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "value-changed", &xSpinButtonValueChangedTrampoline, xSpinButtonValueChangedNewTrampoline, cb)
 }
 
 // Emitted right after the spinbutton wraps from its maximum
 // to its minimum value or vice-versa.
+//
+// Deprecated: use ConnectWrappedFunc, which also accepts method values and closures.
 func (x *SpinButton) ConnectWrapped(cb *func(SpinButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "wrapped", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := SpinButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "wrapped", cbRefPtr, cbPtr)
+}
+
+var xSpinButtonWrappedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSpinButtonWrappedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(SpinButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := SpinButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "wrapped", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectWrappedFunc connects cb to the "wrapped" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *SpinButton) ConnectWrappedFunc(cb func(SpinButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "wrapped", &xSpinButtonWrappedTrampoline, xSpinButtonWrappedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1022,7 +1223,7 @@ func (x *SpinButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1030,14 +1231,13 @@ func (x *SpinButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *SpinButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1061,19 +1261,17 @@ func (x *SpinButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acc
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *SpinButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1094,7 +1292,7 @@ func (x *SpinButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1103,14 +1301,12 @@ func (x *SpinButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acces
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *SpinButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1129,10 +1325,10 @@ func (x *SpinButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleStat
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *SpinButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1140,6 +1336,8 @@ func (x *SpinButton) GetBuildableId() string {
 }
 
 // Emits the `GtkCellEditable::editing-done` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *SpinButton) EditingDone() {
 
 	XGtkCellEditableEditingDone(x.GoPointer())
@@ -1147,21 +1345,23 @@ func (x *SpinButton) EditingDone() {
 }
 
 // Emits the `GtkCellEditable::remove-widget` signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *SpinButton) RemoveWidget() {
 
 	XGtkCellEditableRemoveWidget(x.GoPointer())
 
 }
 
-// Begins editing on a @cell_editable.
+// Begins editing on a cell_editable.
 //
 // The `GtkCellRenderer` for the cell creates and returns a `GtkCellEditable` from
-// gtk_cell_renderer_start_editing(), configured for the `GtkCellRenderer` type.
+// [CellRenderer.StartEditing], configured for the `GtkCellRenderer` type.
 //
-// gtk_cell_editable_start_editing() can then set up @cell_editable suitably for
+// [CellEditable.StartEditing] can then set up cell_editable suitably for
 // editing a cell, e.g. making the Esc key emit `GtkCellEditable::editing-done`.
 //
-// Note that the @cell_editable is created on-demand for the current edit; its
+// Note that the cell_editable is created on-demand for the current edit; its
 // lifetime is temporary and does not persist across other edits and/or cells.
 func (x *SpinButton) StartEditing(EventVar *gdk.Event) {
 
@@ -1175,35 +1375,29 @@ func (x *SpinButton) StartEditing(EventVar *gdk.Event) {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *SpinButton) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -1223,8 +1417,8 @@ func (x *SpinButton) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -1234,7 +1428,7 @@ func (x *SpinButton) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -1254,8 +1448,8 @@ func (x *SpinButton) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -1265,10 +1459,10 @@ func (x *SpinButton) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *SpinButton) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -1283,21 +1477,21 @@ func (x *SpinButton) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *SpinButton) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *SpinButton) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *SpinButton) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -1316,9 +1510,9 @@ func (x *SpinButton) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SpinButton) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -1327,7 +1521,7 @@ func (x *SpinButton) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *SpinButton) GetText() string {
@@ -1347,7 +1541,7 @@ func (x *SpinButton) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -1357,11 +1551,11 @@ func (x *SpinButton) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *SpinButton) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -1372,9 +1566,9 @@ func (x *SpinButton) InsertText(TextVar string, LengthVar int, PositionVar int)
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *SpinButton) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -1400,19 +1594,19 @@ func (x *SpinButton) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *SpinButton) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *SpinButton) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -1425,7 +1619,7 @@ func (x *SpinButton) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *SpinButton) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -1442,25 +1636,25 @@ func (x *SpinButton) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *SpinButton) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
 
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *SpinButton) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *SpinButton) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -1472,7 +1666,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1485,34 +1679,34 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpinButtonGLibType, libs, "gtk_spin_button_get_type")
 
-	core.PuregoSafeRegister(&xNewSpinButton, libs, "gtk_spin_button_new")
-	core.PuregoSafeRegister(&xNewSpinButtonWithRange, libs, "gtk_spin_button_new_with_range")
-
-	core.PuregoSafeRegister(&xSpinButtonConfigure, libs, "gtk_spin_button_configure")
-	core.PuregoSafeRegister(&xSpinButtonGetActivatesDefault, libs, "gtk_spin_button_get_activates_default")
-	core.PuregoSafeRegister(&xSpinButtonGetAdjustment, libs, "gtk_spin_button_get_adjustment")
-	core.PuregoSafeRegister(&xSpinButtonGetClimbRate, libs, "gtk_spin_button_get_climb_rate")
-	core.PuregoSafeRegister(&xSpinButtonGetDigits, libs, "gtk_spin_button_get_digits")
-	core.PuregoSafeRegister(&xSpinButtonGetIncrements, libs, "gtk_spin_button_get_increments")
-	core.PuregoSafeRegister(&xSpinButtonGetNumeric, libs, "gtk_spin_button_get_numeric")
-	core.PuregoSafeRegister(&xSpinButtonGetRange, libs, "gtk_spin_button_get_range")
-	core.PuregoSafeRegister(&xSpinButtonGetSnapToTicks, libs, "gtk_spin_button_get_snap_to_ticks")
-	core.PuregoSafeRegister(&xSpinButtonGetUpdatePolicy, libs, "gtk_spin_button_get_update_policy")
-	core.PuregoSafeRegister(&xSpinButtonGetValue, libs, "gtk_spin_button_get_value")
-	core.PuregoSafeRegister(&xSpinButtonGetValueAsInt, libs, "gtk_spin_button_get_value_as_int")
-	core.PuregoSafeRegister(&xSpinButtonGetWrap, libs, "gtk_spin_button_get_wrap")
-	core.PuregoSafeRegister(&xSpinButtonSetActivatesDefault, libs, "gtk_spin_button_set_activates_default")
-	core.PuregoSafeRegister(&xSpinButtonSetAdjustment, libs, "gtk_spin_button_set_adjustment")
-	core.PuregoSafeRegister(&xSpinButtonSetClimbRate, libs, "gtk_spin_button_set_climb_rate")
-	core.PuregoSafeRegister(&xSpinButtonSetDigits, libs, "gtk_spin_button_set_digits")
-	core.PuregoSafeRegister(&xSpinButtonSetIncrements, libs, "gtk_spin_button_set_increments")
-	core.PuregoSafeRegister(&xSpinButtonSetNumeric, libs, "gtk_spin_button_set_numeric")
-	core.PuregoSafeRegister(&xSpinButtonSetRange, libs, "gtk_spin_button_set_range")
-	core.PuregoSafeRegister(&xSpinButtonSetSnapToTicks, libs, "gtk_spin_button_set_snap_to_ticks")
-	core.PuregoSafeRegister(&xSpinButtonSetUpdatePolicy, libs, "gtk_spin_button_set_update_policy")
-	core.PuregoSafeRegister(&xSpinButtonSetValue, libs, "gtk_spin_button_set_value")
-	core.PuregoSafeRegister(&xSpinButtonSetWrap, libs, "gtk_spin_button_set_wrap")
-	core.PuregoSafeRegister(&xSpinButtonSpin, libs, "gtk_spin_button_spin")
-	core.PuregoSafeRegister(&xSpinButtonUpdate, libs, "gtk_spin_button_update")
+	core.PuregoRegisterSince(&xNewSpinButton, libs, "gtk_spin_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewSpinButtonWithRange, libs, "gtk_spin_button_new_with_range", "GTK", "")
+
+	core.PuregoRegisterSince(&xSpinButtonConfigure, libs, "gtk_spin_button_configure", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetActivatesDefault, libs, "gtk_spin_button_get_activates_default", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSpinButtonGetAdjustment, libs, "gtk_spin_button_get_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetClimbRate, libs, "gtk_spin_button_get_climb_rate", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetDigits, libs, "gtk_spin_button_get_digits", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetIncrements, libs, "gtk_spin_button_get_increments", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetNumeric, libs, "gtk_spin_button_get_numeric", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetRange, libs, "gtk_spin_button_get_range", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetSnapToTicks, libs, "gtk_spin_button_get_snap_to_ticks", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetUpdatePolicy, libs, "gtk_spin_button_get_update_policy", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetValue, libs, "gtk_spin_button_get_value", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetValueAsInt, libs, "gtk_spin_button_get_value_as_int", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonGetWrap, libs, "gtk_spin_button_get_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetActivatesDefault, libs, "gtk_spin_button_set_activates_default", "GTK", "4.14")
+	core.PuregoRegisterSince(&xSpinButtonSetAdjustment, libs, "gtk_spin_button_set_adjustment", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetClimbRate, libs, "gtk_spin_button_set_climb_rate", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetDigits, libs, "gtk_spin_button_set_digits", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetIncrements, libs, "gtk_spin_button_set_increments", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetNumeric, libs, "gtk_spin_button_set_numeric", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetRange, libs, "gtk_spin_button_set_range", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetSnapToTicks, libs, "gtk_spin_button_set_snap_to_ticks", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetUpdatePolicy, libs, "gtk_spin_button_set_update_policy", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetValue, libs, "gtk_spin_button_set_value", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSetWrap, libs, "gtk_spin_button_set_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonSpin, libs, "gtk_spin_button_spin", "GTK", "")
+	core.PuregoRegisterSince(&xSpinButtonUpdate, libs, "gtk_spin_button_update", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,18 +9,11 @@ import (
 
 // Displays an icon-size spinning animation.
 //
-// It is often used as an alternative to a [class@Gtk.ProgressBar]
+// It is often used as an alternative to a [ProgressBar]
 // for displaying indefinite activity, instead of actual progress.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="spinner-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkSpinner" src="spinner.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// To start the animation, use [method@Gtk.Spinner.start], to stop it
-// use [method@Gtk.Spinner.stop].
+// To start the animation, use [Spinner.Start], to stop it
+// use [Spinner.Stop].
 //
 // # CSS nodes
 //
@@ -31,7 +23,7 @@ import (
 //
 // # Accessibility
 //
-// `GtkSpinner` uses the [enum@Gtk.AccessibleRole.progress_bar] role.
+// `GtkSpinner` uses the [AccessibleRoleProgressBarValue] role.
 type Spinner struct {
 	Widget
 }
@@ -290,7 +282,7 @@ func (x *Spinner) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -298,14 +290,13 @@ func (x *Spinner) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -329,19 +320,17 @@ func (x *Spinner) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Access
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -362,7 +351,7 @@ func (x *Spinner) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -371,14 +360,12 @@ func (x *Spinner) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessib
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Spinner) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -397,10 +384,10 @@ func (x *Spinner) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Spinner) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -412,7 +399,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -421,11 +408,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSpinnerGLibType, libs, "gtk_spinner_get_type")
 
-	core.PuregoSafeRegister(&xNewSpinner, libs, "gtk_spinner_new")
+	core.PuregoRegisterSince(&xNewSpinner, libs, "gtk_spinner_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSpinnerGetSpinning, libs, "gtk_spinner_get_spinning")
-	core.PuregoSafeRegister(&xSpinnerSetSpinning, libs, "gtk_spinner_set_spinning")
-	core.PuregoSafeRegister(&xSpinnerStart, libs, "gtk_spinner_start")
-	core.PuregoSafeRegister(&xSpinnerStop, libs, "gtk_spinner_stop")
+	core.PuregoRegisterSince(&xSpinnerGetSpinning, libs, "gtk_spinner_get_spinning", "GTK", "")
+	core.PuregoRegisterSince(&xSpinnerSetSpinning, libs, "gtk_spinner_set_spinning", "GTK", "")
+	core.PuregoRegisterSince(&xSpinnerStart, libs, "gtk_spinner_start", "GTK", "")
+	core.PuregoRegisterSince(&xSpinnerStop, libs, "gtk_spinner_stop", "GTK", "")
 
 }
@@ -2,7 +2,8 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -69,52 +70,200 @@ const (
 	StackTransitionTypeRotateLeftRightValue StackTransitionType = 22
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x StackTransitionType) String() string {
+	switch x {
+	case StackTransitionTypeNoneValue:
+		return "GTK_STACK_TRANSITION_TYPE_NONE"
+	case StackTransitionTypeCrossfadeValue:
+		return "GTK_STACK_TRANSITION_TYPE_CROSSFADE"
+	case StackTransitionTypeSlideRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_RIGHT"
+	case StackTransitionTypeSlideLeftValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_LEFT"
+	case StackTransitionTypeSlideUpValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_UP"
+	case StackTransitionTypeSlideDownValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_DOWN"
+	case StackTransitionTypeSlideLeftRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_LEFT_RIGHT"
+	case StackTransitionTypeSlideUpDownValue:
+		return "GTK_STACK_TRANSITION_TYPE_SLIDE_UP_DOWN"
+	case StackTransitionTypeOverUpValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_UP"
+	case StackTransitionTypeOverDownValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_DOWN"
+	case StackTransitionTypeOverLeftValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_LEFT"
+	case StackTransitionTypeOverRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_RIGHT"
+	case StackTransitionTypeUnderUpValue:
+		return "GTK_STACK_TRANSITION_TYPE_UNDER_UP"
+	case StackTransitionTypeUnderDownValue:
+		return "GTK_STACK_TRANSITION_TYPE_UNDER_DOWN"
+	case StackTransitionTypeUnderLeftValue:
+		return "GTK_STACK_TRANSITION_TYPE_UNDER_LEFT"
+	case StackTransitionTypeUnderRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_UNDER_RIGHT"
+	case StackTransitionTypeOverUpDownValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_UP_DOWN"
+	case StackTransitionTypeOverDownUpValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_DOWN_UP"
+	case StackTransitionTypeOverLeftRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_LEFT_RIGHT"
+	case StackTransitionTypeOverRightLeftValue:
+		return "GTK_STACK_TRANSITION_TYPE_OVER_RIGHT_LEFT"
+	case StackTransitionTypeRotateLeftValue:
+		return "GTK_STACK_TRANSITION_TYPE_ROTATE_LEFT"
+	case StackTransitionTypeRotateRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_ROTATE_RIGHT"
+	case StackTransitionTypeRotateLeftRightValue:
+		return "GTK_STACK_TRANSITION_TYPE_ROTATE_LEFT_RIGHT"
+	}
+	return fmt.Sprintf("StackTransitionType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x StackTransitionType) Nick() string {
+	switch x {
+	case StackTransitionTypeNoneValue:
+		return "none"
+	case StackTransitionTypeCrossfadeValue:
+		return "crossfade"
+	case StackTransitionTypeSlideRightValue:
+		return "slide-right"
+	case StackTransitionTypeSlideLeftValue:
+		return "slide-left"
+	case StackTransitionTypeSlideUpValue:
+		return "slide-up"
+	case StackTransitionTypeSlideDownValue:
+		return "slide-down"
+	case StackTransitionTypeSlideLeftRightValue:
+		return "slide-left-right"
+	case StackTransitionTypeSlideUpDownValue:
+		return "slide-up-down"
+	case StackTransitionTypeOverUpValue:
+		return "over-up"
+	case StackTransitionTypeOverDownValue:
+		return "over-down"
+	case StackTransitionTypeOverLeftValue:
+		return "over-left"
+	case StackTransitionTypeOverRightValue:
+		return "over-right"
+	case StackTransitionTypeUnderUpValue:
+		return "under-up"
+	case StackTransitionTypeUnderDownValue:
+		return "under-down"
+	case StackTransitionTypeUnderLeftValue:
+		return "under-left"
+	case StackTransitionTypeUnderRightValue:
+		return "under-right"
+	case StackTransitionTypeOverUpDownValue:
+		return "over-up-down"
+	case StackTransitionTypeOverDownUpValue:
+		return "over-down-up"
+	case StackTransitionTypeOverLeftRightValue:
+		return "over-left-right"
+	case StackTransitionTypeOverRightLeftValue:
+		return "over-right-left"
+	case StackTransitionTypeRotateLeftValue:
+		return "rotate-left"
+	case StackTransitionTypeRotateRightValue:
+		return "rotate-right"
+	case StackTransitionTypeRotateLeftRightValue:
+		return "rotate-left-right"
+	}
+	return ""
+}
+
+// StackTransitionTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StackTransitionTypeFromString(s string) (StackTransitionType, bool) {
+	switch s {
+	case "GTK_STACK_TRANSITION_TYPE_NONE", "none":
+		return StackTransitionTypeNoneValue, true
+	case "GTK_STACK_TRANSITION_TYPE_CROSSFADE", "crossfade":
+		return StackTransitionTypeCrossfadeValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_RIGHT", "slide-right":
+		return StackTransitionTypeSlideRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_LEFT", "slide-left":
+		return StackTransitionTypeSlideLeftValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_UP", "slide-up":
+		return StackTransitionTypeSlideUpValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_DOWN", "slide-down":
+		return StackTransitionTypeSlideDownValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_LEFT_RIGHT", "slide-left-right":
+		return StackTransitionTypeSlideLeftRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_SLIDE_UP_DOWN", "slide-up-down":
+		return StackTransitionTypeSlideUpDownValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_UP", "over-up":
+		return StackTransitionTypeOverUpValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_DOWN", "over-down":
+		return StackTransitionTypeOverDownValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_LEFT", "over-left":
+		return StackTransitionTypeOverLeftValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_RIGHT", "over-right":
+		return StackTransitionTypeOverRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_UNDER_UP", "under-up":
+		return StackTransitionTypeUnderUpValue, true
+	case "GTK_STACK_TRANSITION_TYPE_UNDER_DOWN", "under-down":
+		return StackTransitionTypeUnderDownValue, true
+	case "GTK_STACK_TRANSITION_TYPE_UNDER_LEFT", "under-left":
+		return StackTransitionTypeUnderLeftValue, true
+	case "GTK_STACK_TRANSITION_TYPE_UNDER_RIGHT", "under-right":
+		return StackTransitionTypeUnderRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_UP_DOWN", "over-up-down":
+		return StackTransitionTypeOverUpDownValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_DOWN_UP", "over-down-up":
+		return StackTransitionTypeOverDownUpValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_LEFT_RIGHT", "over-left-right":
+		return StackTransitionTypeOverLeftRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_OVER_RIGHT_LEFT", "over-right-left":
+		return StackTransitionTypeOverRightLeftValue, true
+	case "GTK_STACK_TRANSITION_TYPE_ROTATE_LEFT", "rotate-left":
+		return StackTransitionTypeRotateLeftValue, true
+	case "GTK_STACK_TRANSITION_TYPE_ROTATE_RIGHT", "rotate-right":
+		return StackTransitionTypeRotateRightValue, true
+	case "GTK_STACK_TRANSITION_TYPE_ROTATE_LEFT_RIGHT", "rotate-left-right":
+		return StackTransitionTypeRotateLeftRightValue, true
+	}
+	return 0, false
+}
+
 // Shows one of its children at a time.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="stack-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkStack" src="stack.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // In contrast to `GtkNotebook`, `GtkStack` does not provide a means
 // for users to change the visible child. Instead, a separate widget
-// such as [class@Gtk.StackSwitcher] or [class@Gtk.StackSidebar] can
+// such as [StackSwitcher] or [StackSidebar] can
 // be used with `GtkStack` to provide this functionality.
 //
 // Transitions between pages can be animated as slides or fades. This
-// can be controlled with [method@Gtk.Stack.set_transition_type].
-// These animations respect the [property@Gtk.Settings:gtk-enable-animations]
+// can be controlled with [Stack.SetTransitionType].
+// These animations respect the [Settings.GetPropertyGtkEnableAnimations]
 // setting.
 //
-// `GtkStack` maintains a [class@Gtk.StackPage] object for each added
+// `GtkStack` maintains a [StackPage] object for each added
 // child, which holds additional per-child properties. You
-// obtain the `GtkStackPage` for a child with [method@Gtk.Stack.get_page]
+// obtain the `GtkStackPage` for a child with [Stack.GetPage]
 // and you can obtain a `GtkSelectionModel` containing all the pages
-// with [method@Gtk.Stack.get_pages].
+// with [Stack.GetPages].
 //
 // # GtkStack as GtkBuildable
 //
 // To set child-specific properties in a .ui file, create `GtkStackPage`
 // objects explicitly, and set the child widget as a property on it:
 //
-// ```xml
-//
-//	&lt;object class="GtkStack" id="stack"&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="GtkStackPage"&gt;
-//	      &lt;property name="name"&gt;page1&lt;/property&gt;
-//	      &lt;property name="title"&gt;In the beginning…&lt;/property&gt;
-//	      &lt;property name="child"&gt;
-//	        &lt;object class="GtkLabel"&gt;
-//	          &lt;property name="label"&gt;It was dark&lt;/property&gt;
-//	        &lt;/object&gt;
-//	      &lt;/property&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//
-// ```
+//	<object class="GtkStack" id="stack">
+//	  <child>
+//	    <object class="GtkStackPage">
+//	      <property name="name">page1</property>
+//	      <property name="title">In the beginning…</property>
+//	      <property name="child">
+//	        <object class="GtkLabel">
+//	          <property name="label">It was dark</property>
+//	        </object>
+//	      </property>
+//	    </object>
+//	  </child>
 //
 // # CSS nodes
 //
@@ -122,7 +271,7 @@ const (
 //
 // # Accessibility
 //
-// `GtkStack` uses the [enum@Gtk.AccessibleRole.tab_panel] role for the stack
+// `GtkStack` uses the [AccessibleRoleTabPanelValue] role for the stack
 // pages, which are the accessible parent objects of the child widgets.
 type Stack struct {
 	Widget
@@ -159,7 +308,7 @@ func NewStack() *Stack {
 
 var xStackAddChild func(uintptr, uintptr) uintptr
 
-// Adds a child to @stack.
+// Adds a child to stack.
 func (x *Stack) AddChild(ChildVar *Widget) *StackPage {
 	var cls *StackPage
 
@@ -176,9 +325,9 @@ func (x *Stack) AddChild(ChildVar *Widget) *StackPage {
 
 var xStackAddNamed func(uintptr, uintptr, uintptr) uintptr
 
-// Adds a child to @stack.
+// Adds a child to stack.
 //
-// The child is identified by the @name.
+// The child is identified by the name.
 func (x *Stack) AddNamed(ChildVar *Widget, NameVar *string) *StackPage {
 	var cls *StackPage
 
@@ -198,11 +347,11 @@ func (x *Stack) AddNamed(ChildVar *Widget, NameVar *string) *StackPage {
 
 var xStackAddTitled func(uintptr, uintptr, uintptr, string) uintptr
 
-// Adds a child to @stack.
+// Adds a child to stack.
 //
-// The child is identified by the @name. The @title
+// The child is identified by the name. The title
 // will be used by `GtkStackSwitcher` to represent
-// @child in a tab bar, so it should be short.
+// child in a tab bar, so it should be short.
 func (x *Stack) AddTitled(ChildVar *Widget, NameVar *string, TitleVar string) *StackPage {
 	var cls *StackPage
 
@@ -224,7 +373,7 @@ var xStackGetChildByName func(uintptr, string) uintptr
 
 // Finds the child with the name given as the argument.
 //
-// Returns %NULL if there is no child with this name.
+// Returns nil if there is no child with this name.
 func (x *Stack) GetChildByName(NameVar string) *Widget {
 	var cls *Widget
 
@@ -241,7 +390,7 @@ func (x *Stack) GetChildByName(NameVar string) *Widget {
 
 var xStackGetHhomogeneous func(uintptr) bool
 
-// Gets whether @stack is horizontally homogeneous.
+// Gets whether stack is horizontally homogeneous.
 func (x *Stack) GetHhomogeneous() bool {
 
 	cret := xStackGetHhomogeneous(x.GoPointer())
@@ -260,7 +409,7 @@ func (x *Stack) GetInterpolateSize() bool {
 
 var xStackGetPage func(uintptr, uintptr) uintptr
 
-// Returns the `GtkStackPage` object for @child.
+// Returns the `GtkStackPage` object for child.
 func (x *Stack) GetPage(ChildVar *Widget) *StackPage {
 	var cls *StackPage
 
@@ -280,7 +429,7 @@ var xStackGetPages func(uintptr) uintptr
 // Returns a `GListModel` that contains the pages of the stack.
 //
 // This can be used to keep an up-to-date view. The model also
-// implements [iface@Gtk.SelectionModel] and can be used to track
+// implements [SelectionModel] and can be used to track
 // and modify the visible page.
 func (x *Stack) GetPages() *SelectionModelBase {
 	var cls *SelectionModelBase
@@ -298,7 +447,7 @@ func (x *Stack) GetPages() *SelectionModelBase {
 var xStackGetTransitionDuration func(uintptr) uint
 
 // Returns the amount of time (in milliseconds) that
-// transitions between pages in @stack will take.
+// transitions between pages in stack will take.
 func (x *Stack) GetTransitionDuration() uint {
 
 	cret := xStackGetTransitionDuration(x.GoPointer())
@@ -307,7 +456,7 @@ func (x *Stack) GetTransitionDuration() uint {
 
 var xStackGetTransitionRunning func(uintptr) bool
 
-// Returns whether the @stack is currently in a transition from one page to
+// Returns whether the stack is currently in a transition from one page to
 // another.
 func (x *Stack) GetTransitionRunning() bool {
 
@@ -318,7 +467,7 @@ func (x *Stack) GetTransitionRunning() bool {
 var xStackGetTransitionType func(uintptr) StackTransitionType
 
 // Gets the type of animation that will be used
-// for transitions between pages in @stack.
+// for transitions between pages in stack.
 func (x *Stack) GetTransitionType() StackTransitionType {
 
 	cret := xStackGetTransitionType(x.GoPointer())
@@ -327,7 +476,7 @@ func (x *Stack) GetTransitionType() StackTransitionType {
 
 var xStackGetVhomogeneous func(uintptr) bool
 
-// Gets whether @stack is vertically homogeneous.
+// Gets whether stack is vertically homogeneous.
 func (x *Stack) GetVhomogeneous() bool {
 
 	cret := xStackGetVhomogeneous(x.GoPointer())
@@ -336,9 +485,9 @@ func (x *Stack) GetVhomogeneous() bool {
 
 var xStackGetVisibleChild func(uintptr) uintptr
 
-// Gets the currently visible child of @stack.
+// Gets the currently visible child of stack.
 //
-// Returns %NULL if there are no visible children.
+// Returns nil if there are no visible children.
 func (x *Stack) GetVisibleChild() *Widget {
 	var cls *Widget
 
@@ -355,9 +504,9 @@ func (x *Stack) GetVisibleChild() *Widget {
 
 var xStackGetVisibleChildName func(uintptr) string
 
-// Returns the name of the currently visible child of @stack.
+// Returns the name of the currently visible child of stack.
 //
-// Returns %NULL if there is no visible child.
+// Returns nil if there is no visible child.
 func (x *Stack) GetVisibleChildName() string {
 
 	cret := xStackGetVisibleChildName(x.GoPointer())
@@ -366,7 +515,7 @@ func (x *Stack) GetVisibleChildName() string {
 
 var xStackRemove func(uintptr, uintptr)
 
-// Removes a child widget from @stack.
+// Removes a child widget from stack.
 func (x *Stack) Remove(ChildVar *Widget) {
 
 	xStackRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -388,11 +537,11 @@ func (x *Stack) SetHhomogeneous(HhomogeneousVar bool) {
 
 var xStackSetInterpolateSize func(uintptr, bool)
 
-// Sets whether or not @stack will interpolate its size when
+// Sets whether or not stack will interpolate its size when
 // changing the visible child.
 //
-// If the [property@Gtk.Stack:interpolate-size] property is set
-// to %TRUE, @stack will interpolate its size between the current
+// If the [Stack.GetPropertyInterpolateSize] property is set
+// to true, stack will interpolate its size between the current
 // one and the one it'll take after changing the visible child,
 // according to the set transition duration.
 func (x *Stack) SetInterpolateSize(InterpolateSizeVar bool) {
@@ -403,7 +552,7 @@ func (x *Stack) SetInterpolateSize(InterpolateSizeVar bool) {
 
 var xStackSetTransitionDuration func(uintptr, uint)
 
-// Sets the duration that transitions between pages in @stack
+// Sets the duration that transitions between pages in stack
 // will take.
 func (x *Stack) SetTransitionDuration(DurationVar uint) {
 
@@ -414,7 +563,7 @@ func (x *Stack) SetTransitionDuration(DurationVar uint) {
 var xStackSetTransitionType func(uintptr, StackTransitionType)
 
 // Sets the type of animation that will be used for
-// transitions between pages in @stack.
+// transitions between pages in stack.
 //
 // Available types include various kinds of fades and slides.
 //
@@ -442,15 +591,15 @@ func (x *Stack) SetVhomogeneous(VhomogeneousVar bool) {
 
 var xStackSetVisibleChild func(uintptr, uintptr)
 
-// Makes @child the visible child of @stack.
+// Makes child the visible child of stack.
 //
-// If @child is different from the currently visible child,
+// If child is different from the currently visible child,
 // the transition between the two will be animated with the
-// current transition type of @stack.
+// current transition type of stack.
 //
-// Note that the @child widget has to be visible itself
-// (see [method@Gtk.Widget.show]) in order to become the visible
-// child of @stack.
+// Note that the child widget has to be visible itself
+// (see [Widget.Show]) in order to become the visible
+// child of stack.
 func (x *Stack) SetVisibleChild(ChildVar *Widget) {
 
 	xStackSetVisibleChild(x.GoPointer(), ChildVar.GoPointer())
@@ -462,8 +611,8 @@ var xStackSetVisibleChildFull func(uintptr, string, StackTransitionType)
 // Makes the child with the given name visible.
 //
 // Note that the child widget has to be visible itself
-// (see [method@Gtk.Widget.show]) in order to become the visible
-// child of @stack.
+// (see [Widget.Show]) in order to become the visible
+// child of stack.
 func (x *Stack) SetVisibleChildFull(NameVar string, TransitionVar StackTransitionType) {
 
 	xStackSetVisibleChildFull(x.GoPointer(), NameVar, TransitionVar)
@@ -474,13 +623,13 @@ var xStackSetVisibleChildName func(uintptr, string)
 
 // Makes the child with the given name visible.
 //
-// If @child is different from the currently visible child,
+// If child is different from the currently visible child,
 // the transition between the two will be animated with the
-// current transition type of @stack.
+// current transition type of stack.
 //
 // Note that the child widget has to be visible itself
-// (see [method@Gtk.Widget.show]) in order to become the visible
-// child of @stack.
+// (see [Widget.Show]) in order to become the visible
+// child of stack.
 func (x *Stack) SetVisibleChildName(NameVar string) {
 
 	xStackSetVisibleChildName(x.GoPointer(), NameVar)
@@ -499,7 +648,7 @@ func (c *Stack) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyHhomogeneous sets the "hhomogeneous" property.
-// %TRUE if the stack allocates the same width for all children.
+// true if the stack allocates the same width for all children.
 func (x *Stack) SetPropertyHhomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -508,7 +657,7 @@ func (x *Stack) SetPropertyHhomogeneous(value bool) {
 }
 
 // GetPropertyHhomogeneous gets the "hhomogeneous" property.
-// %TRUE if the stack allocates the same width for all children.
+// true if the stack allocates the same width for all children.
 func (x *Stack) GetPropertyHhomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("hhomogeneous", &v)
@@ -558,7 +707,7 @@ func (x *Stack) GetPropertyTransitionRunning() bool {
 }
 
 // SetPropertyVhomogeneous sets the "vhomogeneous" property.
-// %TRUE if the stack allocates the same height for all children.
+// true if the stack allocates the same height for all children.
 func (x *Stack) SetPropertyVhomogeneous(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -567,7 +716,7 @@ func (x *Stack) SetPropertyVhomogeneous(value bool) {
 }
 
 // GetPropertyVhomogeneous gets the "vhomogeneous" property.
-// %TRUE if the stack allocates the same height for all children.
+// true if the stack allocates the same height for all children.
 func (x *Stack) GetPropertyVhomogeneous() bool {
 	var v gobject.Value
 	x.GetProperty("vhomogeneous", &v)
@@ -752,7 +901,7 @@ func (x *Stack) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -760,14 +909,13 @@ func (x *Stack) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Stack) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -791,19 +939,17 @@ func (x *Stack) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Stack) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -824,7 +970,7 @@ func (x *Stack) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -833,14 +979,12 @@ func (x *Stack) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Stack) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -859,10 +1003,10 @@ func (x *Stack) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Stack) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -888,7 +1032,7 @@ func StackPageNewFromInternalPtr(ptr uintptr) *StackPage {
 
 var xStackPageGetChild func(uintptr) uintptr
 
-// Returns the stack child to which @self belongs.
+// Returns the stack child to which self belongs.
 func (x *StackPage) GetChild() *Widget {
 	var cls *Widget
 
@@ -950,9 +1094,9 @@ func (x *StackPage) GetUseUnderline() bool {
 
 var xStackPageGetVisible func(uintptr) bool
 
-// Returns whether @page is visible in its `GtkStack`.
+// Returns whether page is visible in its `GtkStack`.
 //
-// This is independent from the [property@Gtk.Widget:visible]
+// This is independent from the [Widget.GetPropertyVisible]
 // property of its widget.
 func (x *StackPage) GetVisible() bool {
 
@@ -1007,7 +1151,7 @@ func (x *StackPage) SetUseUnderline(SettingVar bool) {
 
 var xStackPageSetVisible func(uintptr, bool)
 
-// Sets whether @page is visible in its `GtkStack`.
+// Sets whether page is visible in its `GtkStack`.
 func (x *StackPage) SetVisible(VisibleVar bool) {
 
 	xStackPageSetVisible(x.GoPointer(), VisibleVar)
@@ -1062,7 +1206,7 @@ func (x *StackPage) GetPropertyName() string {
 // SetPropertyNeedsAttention sets the "needs-attention" property.
 // Whether the page requires the user attention.
 //
-// This is used by the [class@Gtk.StackSwitcher] to change the
+// This is used by the [StackSwitcher] to change the
 // appearance of the corresponding button when a page needs
 // attention and it is not the current one.
 func (x *StackPage) SetPropertyNeedsAttention(value bool) {
@@ -1075,7 +1219,7 @@ func (x *StackPage) SetPropertyNeedsAttention(value bool) {
 // GetPropertyNeedsAttention gets the "needs-attention" property.
 // Whether the page requires the user attention.
 //
-// This is used by the [class@Gtk.StackSwitcher] to change the
+// This is used by the [StackSwitcher] to change the
 // appearance of the corresponding button when a page needs
 // attention and it is not the current one.
 func (x *StackPage) GetPropertyNeedsAttention() bool {
@@ -1296,7 +1440,7 @@ func (x *StackPage) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1304,14 +1448,13 @@ func (x *StackPage) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *StackPage) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1335,19 +1478,17 @@ func (x *StackPage) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *StackPage) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1368,7 +1509,7 @@ func (x *StackPage) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1377,14 +1518,12 @@ func (x *StackPage) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *StackPage) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1408,7 +1547,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1419,46 +1558,46 @@ func init() {
 
 	core.PuregoSafeRegister(&xStackGLibType, libs, "gtk_stack_get_type")
 
-	core.PuregoSafeRegister(&xNewStack, libs, "gtk_stack_new")
-
-	core.PuregoSafeRegister(&xStackAddChild, libs, "gtk_stack_add_child")
-	core.PuregoSafeRegister(&xStackAddNamed, libs, "gtk_stack_add_named")
-	core.PuregoSafeRegister(&xStackAddTitled, libs, "gtk_stack_add_titled")
-	core.PuregoSafeRegister(&xStackGetChildByName, libs, "gtk_stack_get_child_by_name")
-	core.PuregoSafeRegister(&xStackGetHhomogeneous, libs, "gtk_stack_get_hhomogeneous")
-	core.PuregoSafeRegister(&xStackGetInterpolateSize, libs, "gtk_stack_get_interpolate_size")
-	core.PuregoSafeRegister(&xStackGetPage, libs, "gtk_stack_get_page")
-	core.PuregoSafeRegister(&xStackGetPages, libs, "gtk_stack_get_pages")
-	core.PuregoSafeRegister(&xStackGetTransitionDuration, libs, "gtk_stack_get_transition_duration")
-	core.PuregoSafeRegister(&xStackGetTransitionRunning, libs, "gtk_stack_get_transition_running")
-	core.PuregoSafeRegister(&xStackGetTransitionType, libs, "gtk_stack_get_transition_type")
-	core.PuregoSafeRegister(&xStackGetVhomogeneous, libs, "gtk_stack_get_vhomogeneous")
-	core.PuregoSafeRegister(&xStackGetVisibleChild, libs, "gtk_stack_get_visible_child")
-	core.PuregoSafeRegister(&xStackGetVisibleChildName, libs, "gtk_stack_get_visible_child_name")
-	core.PuregoSafeRegister(&xStackRemove, libs, "gtk_stack_remove")
-	core.PuregoSafeRegister(&xStackSetHhomogeneous, libs, "gtk_stack_set_hhomogeneous")
-	core.PuregoSafeRegister(&xStackSetInterpolateSize, libs, "gtk_stack_set_interpolate_size")
-	core.PuregoSafeRegister(&xStackSetTransitionDuration, libs, "gtk_stack_set_transition_duration")
-	core.PuregoSafeRegister(&xStackSetTransitionType, libs, "gtk_stack_set_transition_type")
-	core.PuregoSafeRegister(&xStackSetVhomogeneous, libs, "gtk_stack_set_vhomogeneous")
-	core.PuregoSafeRegister(&xStackSetVisibleChild, libs, "gtk_stack_set_visible_child")
-	core.PuregoSafeRegister(&xStackSetVisibleChildFull, libs, "gtk_stack_set_visible_child_full")
-	core.PuregoSafeRegister(&xStackSetVisibleChildName, libs, "gtk_stack_set_visible_child_name")
+	core.PuregoRegisterSince(&xNewStack, libs, "gtk_stack_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xStackAddChild, libs, "gtk_stack_add_child", "GTK", "")
+	core.PuregoRegisterSince(&xStackAddNamed, libs, "gtk_stack_add_named", "GTK", "")
+	core.PuregoRegisterSince(&xStackAddTitled, libs, "gtk_stack_add_titled", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetChildByName, libs, "gtk_stack_get_child_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetHhomogeneous, libs, "gtk_stack_get_hhomogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetInterpolateSize, libs, "gtk_stack_get_interpolate_size", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetPage, libs, "gtk_stack_get_page", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetPages, libs, "gtk_stack_get_pages", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetTransitionDuration, libs, "gtk_stack_get_transition_duration", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetTransitionRunning, libs, "gtk_stack_get_transition_running", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetTransitionType, libs, "gtk_stack_get_transition_type", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetVhomogeneous, libs, "gtk_stack_get_vhomogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetVisibleChild, libs, "gtk_stack_get_visible_child", "GTK", "")
+	core.PuregoRegisterSince(&xStackGetVisibleChildName, libs, "gtk_stack_get_visible_child_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackRemove, libs, "gtk_stack_remove", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetHhomogeneous, libs, "gtk_stack_set_hhomogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetInterpolateSize, libs, "gtk_stack_set_interpolate_size", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetTransitionDuration, libs, "gtk_stack_set_transition_duration", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetTransitionType, libs, "gtk_stack_set_transition_type", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetVhomogeneous, libs, "gtk_stack_set_vhomogeneous", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetVisibleChild, libs, "gtk_stack_set_visible_child", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetVisibleChildFull, libs, "gtk_stack_set_visible_child_full", "GTK", "")
+	core.PuregoRegisterSince(&xStackSetVisibleChildName, libs, "gtk_stack_set_visible_child_name", "GTK", "")
 
 	core.PuregoSafeRegister(&xStackPageGLibType, libs, "gtk_stack_page_get_type")
 
-	core.PuregoSafeRegister(&xStackPageGetChild, libs, "gtk_stack_page_get_child")
-	core.PuregoSafeRegister(&xStackPageGetIconName, libs, "gtk_stack_page_get_icon_name")
-	core.PuregoSafeRegister(&xStackPageGetName, libs, "gtk_stack_page_get_name")
-	core.PuregoSafeRegister(&xStackPageGetNeedsAttention, libs, "gtk_stack_page_get_needs_attention")
-	core.PuregoSafeRegister(&xStackPageGetTitle, libs, "gtk_stack_page_get_title")
-	core.PuregoSafeRegister(&xStackPageGetUseUnderline, libs, "gtk_stack_page_get_use_underline")
-	core.PuregoSafeRegister(&xStackPageGetVisible, libs, "gtk_stack_page_get_visible")
-	core.PuregoSafeRegister(&xStackPageSetIconName, libs, "gtk_stack_page_set_icon_name")
-	core.PuregoSafeRegister(&xStackPageSetName, libs, "gtk_stack_page_set_name")
-	core.PuregoSafeRegister(&xStackPageSetNeedsAttention, libs, "gtk_stack_page_set_needs_attention")
-	core.PuregoSafeRegister(&xStackPageSetTitle, libs, "gtk_stack_page_set_title")
-	core.PuregoSafeRegister(&xStackPageSetUseUnderline, libs, "gtk_stack_page_set_use_underline")
-	core.PuregoSafeRegister(&xStackPageSetVisible, libs, "gtk_stack_page_set_visible")
+	core.PuregoRegisterSince(&xStackPageGetChild, libs, "gtk_stack_page_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetIconName, libs, "gtk_stack_page_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetName, libs, "gtk_stack_page_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetNeedsAttention, libs, "gtk_stack_page_get_needs_attention", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetTitle, libs, "gtk_stack_page_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetUseUnderline, libs, "gtk_stack_page_get_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageGetVisible, libs, "gtk_stack_page_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetIconName, libs, "gtk_stack_page_set_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetName, libs, "gtk_stack_page_set_name", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetNeedsAttention, libs, "gtk_stack_page_set_needs_attention", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetTitle, libs, "gtk_stack_page_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetUseUnderline, libs, "gtk_stack_page_set_use_underline", "GTK", "")
+	core.PuregoRegisterSince(&xStackPageSetVisible, libs, "gtk_stack_page_set_visible", "GTK", "")
 
 }
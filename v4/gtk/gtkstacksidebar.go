@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,16 +9,9 @@ import (
 
 // Uses a sidebar to switch between `GtkStack` pages.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="sidebar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkStackSidebar" src="sidebar.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // In order to use a `GtkStackSidebar`, you simply use a `GtkStack` to
 // organize your UI flow, and add the sidebar to your sidebar area. You
-// can use [method@Gtk.StackSidebar.set_stack] to connect the `GtkStackSidebar`
+// can use [StackSidebar.SetStack] to connect the `GtkStackSidebar`
 // to the `GtkStack`.
 //
 // # CSS nodes
@@ -264,7 +256,7 @@ func (x *StackSidebar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -272,14 +264,13 @@ func (x *StackSidebar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *StackSidebar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -303,19 +294,17 @@ func (x *StackSidebar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *StackSidebar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -336,7 +325,7 @@ func (x *StackSidebar) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -345,14 +334,12 @@ func (x *StackSidebar) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *StackSidebar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -371,10 +358,10 @@ func (x *StackSidebar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *StackSidebar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -386,7 +373,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -395,9 +382,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xStackSidebarGLibType, libs, "gtk_stack_sidebar_get_type")
 
-	core.PuregoSafeRegister(&xNewStackSidebar, libs, "gtk_stack_sidebar_new")
+	core.PuregoRegisterSince(&xNewStackSidebar, libs, "gtk_stack_sidebar_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xStackSidebarGetStack, libs, "gtk_stack_sidebar_get_stack")
-	core.PuregoSafeRegister(&xStackSidebarSetStack, libs, "gtk_stack_sidebar_set_stack")
+	core.PuregoRegisterSince(&xStackSidebarGetStack, libs, "gtk_stack_sidebar_get_stack", "GTK", "")
+	core.PuregoRegisterSince(&xStackSidebarSetStack, libs, "gtk_stack_sidebar_set_stack", "GTK", "")
 
 }
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -10,17 +9,10 @@ import (
 
 // Shows a row of buttons to switch between `GtkStack` pages.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="stackswitcher-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkStackSwitcher" src="stackswitcher.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // It acts as a controller for the associated `GtkStack`.
 //
 // All the content for the buttons comes from the properties of the stacks
-// [class@Gtk.StackPage] objects; the button visibility in a `GtkStackSwitcher`
+// [StackPage] objects; the button visibility in a `GtkStackSwitcher`
 // widget is controlled by the visibility of the child in the `GtkStack`.
 //
 // It is possible to associate multiple `GtkStackSwitcher` widgets
@@ -37,14 +29,14 @@ import (
 //
 // # Accessibility
 //
-// `GtkStackSwitcher` uses the [enum@Gtk.AccessibleRole.tab_list] role
-// and uses the [enum@Gtk.AccessibleRole.tab] role for its buttons.
+// `GtkStackSwitcher` uses the [AccessibleRoleTabListValue] role
+// and uses the [AccessibleRoleTabValue] role for its buttons.
 //
 // # Orientable
 //
 // Since GTK 4.4, `GtkStackSwitcher` implements `GtkOrientable` allowing
 // the stack switcher to be made vertical with
-// `gtk_orientable_set_orientation()`.
+// `[Orientable.SetOrientation]`.
 type StackSwitcher struct {
 	Widget
 }
@@ -276,7 +268,7 @@ func (x *StackSwitcher) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -284,14 +276,13 @@ func (x *StackSwitcher) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *StackSwitcher) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -315,19 +306,17 @@ func (x *StackSwitcher) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *StackSwitcher) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -348,7 +337,7 @@ func (x *StackSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -357,14 +346,12 @@ func (x *StackSwitcher) UpdateRelationValue(NRelationsVar int, RelationsVar []Ac
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *StackSwitcher) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -383,24 +370,24 @@ func (x *StackSwitcher) UpdateStateValue(NStatesVar int, StatesVar []AccessibleS
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *StackSwitcher) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *StackSwitcher) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *StackSwitcher) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
@@ -412,7 +399,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -421,9 +408,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xStackSwitcherGLibType, libs, "gtk_stack_switcher_get_type")
 
-	core.PuregoSafeRegister(&xNewStackSwitcher, libs, "gtk_stack_switcher_new")
+	core.PuregoRegisterSince(&xNewStackSwitcher, libs, "gtk_stack_switcher_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xStackSwitcherGetStack, libs, "gtk_stack_switcher_get_stack")
-	core.PuregoSafeRegister(&xStackSwitcherSetStack, libs, "gtk_stack_switcher_set_stack")
+	core.PuregoRegisterSince(&xStackSwitcherGetStack, libs, "gtk_stack_switcher_get_stack", "GTK", "")
+	core.PuregoRegisterSince(&xStackSwitcherSetStack, libs, "gtk_stack_switcher_set_stack", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -12,14 +11,7 @@ import (
 )
 
 // A `GtkStatusbar` widget is usually placed along the bottom of an application's
-// main [class@Gtk.Window].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="statusbar-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkStatusbar" src="statusbar.png"&gt;
-//
-// &lt;/picture&gt;
+// main [Window].
 //
 // A `GtkStatusBar` may provide a regular commentary of the application's
 // status (as is usually the case in a web browser, for example), or may be
@@ -31,7 +23,7 @@ import (
 //
 // Any messages added to a statusbar’s stack must specify a context id that
 // is used to uniquely identify the source of a message. This context id can
-// be generated by [method@Gtk.Statusbar.get_context_id], given a message and
+// be generated by [Statusbar.GetContextId], given a message and
 // the statusbar that it will be added to. Note that messages are stored in a
 // stack, and when choosing which message to display, the stack structure is
 // adhered to, regardless of the context identifier of a message.
@@ -40,18 +32,20 @@ import (
 // display purposes, but allows multiple message producers to maintain
 // sub-stacks of the messages they produced (via context ids).
 //
-// Status bars are created using [ctor@Gtk.Statusbar.new].
+// Status bars are created using [NewStatusbar].
 //
-// Messages are added to the bar’s stack with [method@Gtk.Statusbar.push].
+// Messages are added to the bar’s stack with [Statusbar.Push].
 //
 // The message at the top of the stack can be removed using
-// [method@Gtk.Statusbar.pop]. A message can be removed from anywhere in the
+// [Statusbar.Pop]. A message can be removed from anywhere in the
 // stack if its message id was recorded at the time it was added. This is done
-// using [method@Gtk.Statusbar.remove].
+// using [Statusbar.Remove].
 //
-// ## CSS node
+// # CSS node
 //
 // `GtkStatusbar` has a single CSS node with name `statusbar`.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 type Statusbar struct {
 	Widget
 }
@@ -68,78 +62,6 @@ func StatusbarNewFromInternalPtr(ptr uintptr) *Statusbar {
 	return cls
 }
 
-var xNewStatusbar func() uintptr
-
-// Creates a new `GtkStatusbar` ready for messages.
-func NewStatusbar() *Statusbar {
-	var cls *Statusbar
-
-	cret := xNewStatusbar()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &Statusbar{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xStatusbarGetContextId func(uintptr, string) uint
-
-// Returns a new context identifier, given a description
-// of the actual context.
-//
-// Note that the description is not shown in the UI.
-func (x *Statusbar) GetContextId(ContextDescriptionVar string) uint {
-
-	cret := xStatusbarGetContextId(x.GoPointer(), ContextDescriptionVar)
-	return cret
-}
-
-var xStatusbarPop func(uintptr, uint)
-
-// Removes the first message in the `GtkStatusbar`’s stack
-// with the given context id.
-//
-// Note that this may not change the displayed message,
-// if the message at the top of the stack has a different
-// context id.
-func (x *Statusbar) Pop(ContextIdVar uint) {
-
-	xStatusbarPop(x.GoPointer(), ContextIdVar)
-
-}
-
-var xStatusbarPush func(uintptr, uint, string) uint
-
-// Pushes a new message onto a statusbar’s stack.
-func (x *Statusbar) Push(ContextIdVar uint, TextVar string) uint {
-
-	cret := xStatusbarPush(x.GoPointer(), ContextIdVar, TextVar)
-	return cret
-}
-
-var xStatusbarRemove func(uintptr, uint, uint)
-
-// Forces the removal of a message from a statusbar’s stack.
-// The exact @context_id and @message_id must be specified.
-func (x *Statusbar) Remove(ContextIdVar uint, MessageIdVar uint) {
-
-	xStatusbarRemove(x.GoPointer(), ContextIdVar, MessageIdVar)
-
-}
-
-var xStatusbarRemoveAll func(uintptr, uint)
-
-// Forces the removal of all messages from a statusbar's
-// stack with the exact @context_id.
-func (x *Statusbar) RemoveAll(ContextIdVar uint) {
-
-	xStatusbarRemoveAll(x.GoPointer(), ContextIdVar)
-
-}
-
 func (c *Statusbar) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -152,51 +74,99 @@ func (c *Statusbar) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted whenever a new message is popped off a statusbar's stack.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Statusbar) ConnectTextPopped(cb *func(Statusbar, uint, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "text-popped", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextIdVarp uint, TextVarp string) {
+			defer core.RecoverPanic()
+			fa := Statusbar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextIdVarp, TextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "text-popped", cbRefPtr, cbPtr)
+}
+
+var xStatusbarTextPoppedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ContextIdVarp uint, TextVarp string) {
+func xStatusbarTextPoppedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextIdVarp uint, TextVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Statusbar, uint, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Statusbar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextIdVarp, TextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "text-popped", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectTextPoppedFunc connects cb to the "text-popped" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "text-popped" signal is deprecated, see ConnectTextPopped.
+func (x *Statusbar) ConnectTextPoppedFunc(cb func(Statusbar, uint, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "text-popped", &xStatusbarTextPoppedTrampoline, xStatusbarTextPoppedNewTrampoline, cb)
 }
 
 // Emitted whenever a new message gets pushed onto a statusbar's stack.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *Statusbar) ConnectTextPushed(cb *func(Statusbar, uint, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "text-pushed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ContextIdVarp uint, TextVarp string) {
+			defer core.RecoverPanic()
+			fa := Statusbar{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ContextIdVarp, TextVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "text-pushed", cbRefPtr, cbPtr)
+}
+
+var xStatusbarTextPushedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ContextIdVarp uint, TextVarp string) {
+func xStatusbarTextPushedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ContextIdVarp uint, TextVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Statusbar, uint, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Statusbar{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ContextIdVarp, TextVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "text-pushed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectTextPushedFunc connects cb to the "text-pushed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "text-pushed" signal is deprecated, see ConnectTextPushed.
+func (x *Statusbar) ConnectTextPushedFunc(cb func(Statusbar, uint, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "text-pushed", &xStatusbarTextPushedTrampoline, xStatusbarTextPushedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -360,7 +330,7 @@ func (x *Statusbar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -368,14 +338,13 @@ func (x *Statusbar) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Statusbar) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -399,19 +368,17 @@ func (x *Statusbar) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acce
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Statusbar) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -432,7 +399,7 @@ func (x *Statusbar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -441,14 +408,12 @@ func (x *Statusbar) UpdateRelationValue(NRelationsVar int, RelationsVar []Access
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Statusbar) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -467,36 +432,12 @@ func (x *Statusbar) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Statusbar) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xStatusbarGLibType, libs, "gtk_statusbar_get_type")
-
-	core.PuregoSafeRegister(&xNewStatusbar, libs, "gtk_statusbar_new")
-
-	core.PuregoSafeRegister(&xStatusbarGetContextId, libs, "gtk_statusbar_get_context_id")
-	core.PuregoSafeRegister(&xStatusbarPop, libs, "gtk_statusbar_pop")
-	core.PuregoSafeRegister(&xStatusbarPush, libs, "gtk_statusbar_push")
-	core.PuregoSafeRegister(&xStatusbarRemove, libs, "gtk_statusbar_remove")
-	core.PuregoSafeRegister(&xStatusbarRemoveAll, libs, "gtk_statusbar_remove_all")
-
-}
@@ -0,0 +1,115 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewStatusbar func() uintptr
+
+// Creates a new `GtkStatusbar` ready for messages.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewStatusbar() *Statusbar {
+	var cls *Statusbar
+
+	cret := xNewStatusbar()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Statusbar{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xStatusbarGetContextId func(uintptr, string) uint
+
+// Returns a new context identifier, given a description
+// of the actual context.
+//
+// Note that the description is not shown in the UI.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Statusbar) GetContextId(ContextDescriptionVar string) uint {
+
+	cret := xStatusbarGetContextId(x.GoPointer(), ContextDescriptionVar)
+	return cret
+}
+
+var xStatusbarPop func(uintptr, uint)
+
+// Removes the first message in the `GtkStatusbar`’s stack
+// with the given context id.
+//
+// Note that this may not change the displayed message,
+// if the message at the top of the stack has a different
+// context id.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Statusbar) Pop(ContextIdVar uint) {
+
+	xStatusbarPop(x.GoPointer(), ContextIdVar)
+
+}
+
+var xStatusbarPush func(uintptr, uint, string) uint
+
+// Pushes a new message onto a statusbar’s stack.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Statusbar) Push(ContextIdVar uint, TextVar string) uint {
+
+	cret := xStatusbarPush(x.GoPointer(), ContextIdVar, TextVar)
+	return cret
+}
+
+var xStatusbarRemove func(uintptr, uint, uint)
+
+// Forces the removal of a message from a statusbar’s stack.
+// The exact context_id and message_id must be specified.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Statusbar) Remove(ContextIdVar uint, MessageIdVar uint) {
+
+	xStatusbarRemove(x.GoPointer(), ContextIdVar, MessageIdVar)
+
+}
+
+var xStatusbarRemoveAll func(uintptr, uint)
+
+// Forces the removal of all messages from a statusbar's
+// stack with the exact context_id.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func (x *Statusbar) RemoveAll(ContextIdVar uint) {
+
+	xStatusbarRemoveAll(x.GoPointer(), ContextIdVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewStatusbar, libs, "gtk_statusbar_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xStatusbarGetContextId, libs, "gtk_statusbar_get_context_id", "GTK", "")
+	core.PuregoRegisterSince(&xStatusbarPop, libs, "gtk_statusbar_pop", "GTK", "")
+	core.PuregoRegisterSince(&xStatusbarPush, libs, "gtk_statusbar_push", "GTK", "")
+	core.PuregoRegisterSince(&xStatusbarRemove, libs, "gtk_statusbar_remove", "GTK", "")
+	core.PuregoRegisterSince(&xStatusbarRemoveAll, libs, "gtk_statusbar_remove_all", "GTK", "")
+
+}
@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -43,18 +43,57 @@ const (
 	StringFilterMatchModePrefixValue StringFilterMatchMode = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x StringFilterMatchMode) String() string {
+	switch x {
+	case StringFilterMatchModeExactValue:
+		return "GTK_STRING_FILTER_MATCH_MODE_EXACT"
+	case StringFilterMatchModeSubstringValue:
+		return "GTK_STRING_FILTER_MATCH_MODE_SUBSTRING"
+	case StringFilterMatchModePrefixValue:
+		return "GTK_STRING_FILTER_MATCH_MODE_PREFIX"
+	}
+	return fmt.Sprintf("StringFilterMatchMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x StringFilterMatchMode) Nick() string {
+	switch x {
+	case StringFilterMatchModeExactValue:
+		return "exact"
+	case StringFilterMatchModeSubstringValue:
+		return "substring"
+	case StringFilterMatchModePrefixValue:
+		return "prefix"
+	}
+	return ""
+}
+
+// StringFilterMatchModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StringFilterMatchModeFromString(s string) (StringFilterMatchMode, bool) {
+	switch s {
+	case "GTK_STRING_FILTER_MATCH_MODE_EXACT", "exact":
+		return StringFilterMatchModeExactValue, true
+	case "GTK_STRING_FILTER_MATCH_MODE_SUBSTRING", "substring":
+		return StringFilterMatchModeSubstringValue, true
+	case "GTK_STRING_FILTER_MATCH_MODE_PREFIX", "prefix":
+		return StringFilterMatchModePrefixValue, true
+	}
+	return 0, false
+}
+
 // Determines whether to include items by comparing strings to a fixed search term.
 //
 // The strings are obtained from the items by evaluating an expression
-// set with [method@Gtk.StringFilter.set_expression], and they are
-// compared against a search term set with [method@Gtk.StringFilter.set_search].
+// set with [StringFilter.SetExpression], and they are
+// compared against a search term set with [StringFilter.SetSearch].
 //
 // `GtkStringFilter` has several different modes of comparison - it
 // can match the whole string, just a prefix, or any substring. Use
-// [method@Gtk.StringFilter.set_match_mode] choose a mode.
+// [StringFilter.SetMatchMode] choose a mode.
 //
 // It is also possible to make case-insensitive comparisons, with
-// [method@Gtk.StringFilter.set_ignore_case].
+// [StringFilter.SetIgnoreCase].
 type StringFilter struct {
 	Filter
 }
@@ -227,7 +266,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -238,15 +277,17 @@ func init() {
 
 	core.PuregoSafeRegister(&xStringFilterGLibType, libs, "gtk_string_filter_get_type")
 
-	core.PuregoSafeRegister(&xNewStringFilter, libs, "gtk_string_filter_new")
+	gobject.CheckClassSize("GtkStringFilterClass", xStringFilterGLibType, unsafe.Sizeof(StringFilterClass{}))
+
+	core.PuregoRegisterSince(&xNewStringFilter, libs, "gtk_string_filter_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xStringFilterGetExpression, libs, "gtk_string_filter_get_expression")
-	core.PuregoSafeRegister(&xStringFilterGetIgnoreCase, libs, "gtk_string_filter_get_ignore_case")
-	core.PuregoSafeRegister(&xStringFilterGetMatchMode, libs, "gtk_string_filter_get_match_mode")
-	core.PuregoSafeRegister(&xStringFilterGetSearch, libs, "gtk_string_filter_get_search")
-	core.PuregoSafeRegister(&xStringFilterSetExpression, libs, "gtk_string_filter_set_expression")
-	core.PuregoSafeRegister(&xStringFilterSetIgnoreCase, libs, "gtk_string_filter_set_ignore_case")
-	core.PuregoSafeRegister(&xStringFilterSetMatchMode, libs, "gtk_string_filter_set_match_mode")
-	core.PuregoSafeRegister(&xStringFilterSetSearch, libs, "gtk_string_filter_set_search")
+	core.PuregoRegisterSince(&xStringFilterGetExpression, libs, "gtk_string_filter_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterGetIgnoreCase, libs, "gtk_string_filter_get_ignore_case", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterGetMatchMode, libs, "gtk_string_filter_get_match_mode", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterGetSearch, libs, "gtk_string_filter_get_search", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterSetExpression, libs, "gtk_string_filter_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterSetIgnoreCase, libs, "gtk_string_filter_set_ignore_case", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterSetMatchMode, libs, "gtk_string_filter_set_match_mode", "GTK", "")
+	core.PuregoRegisterSince(&xStringFilterSetSearch, libs, "gtk_string_filter_set_search", "GTK", "")
 
 }
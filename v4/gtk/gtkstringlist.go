@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -35,33 +34,29 @@ func (x *StringObjectClass) GoPointer() uintptr {
 
 // A list model that wraps an array of strings.
 //
-// The objects in the model are of type [class@Gtk.StringObject] and have
+// The objects in the model are of type [StringObject] and have
 // a "string" property that can be used inside expressions.
 //
 // `GtkStringList` is well-suited for any place where you would
 // typically use a `char*[]`, but need a list model.
 //
-// ## GtkStringList as GtkBuildable
+// # GtkStringList as GtkBuildable
 //
 // The `GtkStringList` implementation of the `GtkBuildable` interface
-// supports adding items directly using the `&lt;items&gt;` element and
-// specifying `&lt;item&gt;` elements for each item. Each `&lt;item&gt;` element
+// supports adding items directly using the `<items>` element and
+// specifying `<item>` elements for each item. Each `<item>` element
 // supports the regular translation attributes “translatable”,
 // “context” and “comments”.
 //
 // Here is a UI definition fragment specifying a `GtkStringList`
 //
-// ```xml
-// &lt;object class="GtkStringList"&gt;
-//
-//	&lt;items&gt;
-//	  &lt;item translatable="yes"&gt;Factory&lt;/item&gt;
-//	  &lt;item translatable="yes"&gt;Home&lt;/item&gt;
-//	  &lt;item translatable="yes"&gt;Subway&lt;/item&gt;
-//	&lt;/items&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkStringList">
+//	  <items>
+//	    <item translatable="yes">Factory</item>
+//	    <item translatable="yes">Home</item>
+//	    <item translatable="yes">Subway</item>
+//	  </items>
+//	</object>
 type StringList struct {
 	gobject.Object
 }
@@ -80,7 +75,7 @@ func StringListNewFromInternalPtr(ptr uintptr) *StringList {
 
 var xNewStringList func([]string) uintptr
 
-// Creates a new `GtkStringList` with the given @strings.
+// Creates a new `GtkStringList` with the given strings.
 func NewStringList(StringsVar []string) *StringList {
 	var cls *StringList
 
@@ -96,10 +91,10 @@ func NewStringList(StringsVar []string) *StringList {
 
 var xStringListAppend func(uintptr, string)
 
-// Appends @string to @self.
+// Appends string to self.
 //
-// The @string will be copied. See
-// [method@Gtk.StringList.take] for a way to avoid that.
+// The string will be copied. See
+// [StringList.Take] for a way to avoid that.
 func (x *StringList) Append(StringVar string) {
 
 	xStringListAppend(x.GoPointer(), StringVar)
@@ -108,9 +103,9 @@ func (x *StringList) Append(StringVar string) {
 
 var xStringListFind func(uintptr, string) uint
 
-// Gets the position of the @string in @self.
+// Gets the position of the string in self.
 //
-// If @self does not contain @string item, `G_MAXUINT` is returned.
+// If self does not contain string item, `G_MAXUINT` is returned.
 func (x *StringList) Find(StringVar string) uint {
 
 	cret := xStringListFind(x.GoPointer(), StringVar)
@@ -119,12 +114,12 @@ func (x *StringList) Find(StringVar string) uint {
 
 var xStringListGetString func(uintptr, uint) string
 
-// Gets the string that is at @position in @self.
+// Gets the string that is at position in self.
 //
-// If @self does not contain @position items, %NULL is returned.
+// If self does not contain position items, nil is returned.
 //
 // This function returns the const char *. To get the
-// object wrapping it, use g_list_model_get_item().
+// object wrapping it, use [gio.ListModel.GetItem].
 func (x *StringList) GetString(PositionVar uint) string {
 
 	cret := xStringListGetString(x.GoPointer(), PositionVar)
@@ -133,9 +128,9 @@ func (x *StringList) GetString(PositionVar uint) string {
 
 var xStringListRemove func(uintptr, uint)
 
-// Removes the string at @position from @self.
+// Removes the string at position from self.
 //
-// @position must be smaller than the current
+// position must be smaller than the current
 // length of the list.
 func (x *StringList) Remove(PositionVar uint) {
 
@@ -145,17 +140,17 @@ func (x *StringList) Remove(PositionVar uint) {
 
 var xStringListSplice func(uintptr, uint, uint, []string)
 
-// Changes @self by removing @n_removals strings and adding @additions
+// Changes self by removing n_removals strings and adding additions
 // to it.
 //
-// This function is more efficient than [method@Gtk.StringList.append]
-// and [method@Gtk.StringList.remove], because it only emits the
+// This function is more efficient than [StringList.Append]
+// and [StringList.Remove], because it only emits the
 // ::items-changed signal once for the change.
 //
-// This function copies the strings in @additions.
+// This function copies the strings in additions.
 //
-// The parameters @position and @n_removals must be correct (ie:
-// @position + @n_removals must be less than or equal to the length
+// The parameters position and n_removals must be correct (ie:
+// position + n_removals must be less than or equal to the length
 // of the list at the time this function is called).
 func (x *StringList) Splice(PositionVar uint, NRemovalsVar uint, AdditionsVar []string) {
 
@@ -165,15 +160,13 @@ func (x *StringList) Splice(PositionVar uint, NRemovalsVar uint, AdditionsVar []
 
 var xStringListTake func(uintptr, uintptr)
 
-// Adds @string to self at the end, and takes
+// Adds string to self at the end, and takes
 // ownership of it.
 //
-// This variant of [method@Gtk.StringList.append]
+// This variant of [StringList.Append]
 // is convenient for formatting strings:
 //
-// ```c
-// gtk_string_list_take (self, g_strdup_print ("%d dollars", lots));
-// ```
+//	gtk_string_list_take (self, g_strdup_print ("%d dollars", lots));
 func (x *StringList) Take(StringVar string) {
 
 	xStringListTake(x.GoPointer(), core.GStrdup(StringVar))
@@ -192,7 +185,7 @@ func (c *StringList) SetGoPointer(ptr uintptr) {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *StringList) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -208,28 +201,30 @@ func (x *StringList) SetPropertyStrings(value []string) {
 	x.SetProperty("strings", &v)
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *StringList) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *StringList) GetItemType() types.GType {
 
@@ -237,29 +232,29 @@ func (x *StringList) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *StringList) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *StringList) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -273,20 +268,20 @@ func (x *StringList) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -299,10 +294,10 @@ func (x *StringList) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar ui
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *StringList) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -312,7 +307,7 @@ func (x *StringList) GetBuildableId() string {
 // The type of items in a `GtkStringList`.
 //
 // A `GtkStringObject` is a wrapper around a `const char*`; it has
-// a [property@Gtk.StringObject:string] property that can be used
+// a [StringObject.GetPropertyString] property that can be used
 // for property bindings and expressions.
 type StringObject struct {
 	gobject.Object
@@ -379,7 +374,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -388,19 +383,23 @@ func init() {
 
 	core.PuregoSafeRegister(&xStringListGLibType, libs, "gtk_string_list_get_type")
 
-	core.PuregoSafeRegister(&xNewStringList, libs, "gtk_string_list_new")
+	gobject.CheckClassSize("GtkStringListClass", xStringListGLibType, unsafe.Sizeof(StringListClass{}))
 
-	core.PuregoSafeRegister(&xStringListAppend, libs, "gtk_string_list_append")
-	core.PuregoSafeRegister(&xStringListFind, libs, "gtk_string_list_find")
-	core.PuregoSafeRegister(&xStringListGetString, libs, "gtk_string_list_get_string")
-	core.PuregoSafeRegister(&xStringListRemove, libs, "gtk_string_list_remove")
-	core.PuregoSafeRegister(&xStringListSplice, libs, "gtk_string_list_splice")
-	core.PuregoSafeRegister(&xStringListTake, libs, "gtk_string_list_take")
+	core.PuregoRegisterSince(&xNewStringList, libs, "gtk_string_list_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xStringListAppend, libs, "gtk_string_list_append", "GTK", "")
+	core.PuregoRegisterSince(&xStringListFind, libs, "gtk_string_list_find", "GTK", "4.18")
+	core.PuregoRegisterSince(&xStringListGetString, libs, "gtk_string_list_get_string", "GTK", "")
+	core.PuregoRegisterSince(&xStringListRemove, libs, "gtk_string_list_remove", "GTK", "")
+	core.PuregoRegisterSince(&xStringListSplice, libs, "gtk_string_list_splice", "GTK", "")
+	core.PuregoRegisterSince(&xStringListTake, libs, "gtk_string_list_take", "GTK", "")
 
 	core.PuregoSafeRegister(&xStringObjectGLibType, libs, "gtk_string_object_get_type")
 
-	core.PuregoSafeRegister(&xNewStringObject, libs, "gtk_string_object_new")
+	gobject.CheckClassSize("GtkStringObjectClass", xStringObjectGLibType, unsafe.Sizeof(StringObjectClass{}))
+
+	core.PuregoRegisterSince(&xNewStringObject, libs, "gtk_string_object_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xStringObjectGetString, libs, "gtk_string_object_get_string")
+	core.PuregoRegisterSince(&xStringObjectGetString, libs, "gtk_string_object_get_string", "GTK", "")
 
 }
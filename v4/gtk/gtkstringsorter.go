@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -21,7 +21,7 @@ func (x *StringSorterClass) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// Describes how a [class@Gtk.StringSorter] turns strings into sort keys to
+// Describes how a [StringSorter] turns strings into sort keys to
 // compare them.
 //
 // Note that the result of sorting will in general depend on the current locale
@@ -38,16 +38,55 @@ const (
 
 	// Don't do any collation
 	CollationNoneValue Collation = 0
-	// Use [func@GLib.utf8_collate_key]
+	// Use [glib.Utf8CollateKey]
 	CollationUnicodeValue Collation = 1
-	// Use [func@GLib.utf8_collate_key_for_filename]
+	// Use [glib.Utf8CollateKeyForFilename]
 	CollationFilenameValue Collation = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Collation) String() string {
+	switch x {
+	case CollationNoneValue:
+		return "GTK_COLLATION_NONE"
+	case CollationUnicodeValue:
+		return "GTK_COLLATION_UNICODE"
+	case CollationFilenameValue:
+		return "GTK_COLLATION_FILENAME"
+	}
+	return fmt.Sprintf("Collation(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Collation) Nick() string {
+	switch x {
+	case CollationNoneValue:
+		return "none"
+	case CollationUnicodeValue:
+		return "unicode"
+	case CollationFilenameValue:
+		return "filename"
+	}
+	return ""
+}
+
+// CollationFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CollationFromString(s string) (Collation, bool) {
+	switch s {
+	case "GTK_COLLATION_NONE", "none":
+		return CollationNoneValue, true
+	case "GTK_COLLATION_UNICODE", "unicode":
+		return CollationUnicodeValue, true
+	case "GTK_COLLATION_FILENAME", "filename":
+		return CollationFilenameValue, true
+	}
+	return 0, false
+}
+
 // Sorts items by comparing strings.
 //
 // To obtain the strings to compare, this sorter evaluates a
-// [class@Gtk.Expression].
+// [Expression].
 //
 // It does the comparison in a linguistically correct way using the
 // current locale by normalizing Unicode strings and possibly case-folding
@@ -71,7 +110,7 @@ func StringSorterNewFromInternalPtr(ptr uintptr) *StringSorter {
 var xNewStringSorter func(uintptr) uintptr
 
 // Creates a new string sorter that compares items using the given
-// @expression.
+// expression.
 //
 // Unless an expression is set on it, this sorter will always
 // compare items as invalid.
@@ -136,7 +175,7 @@ var xStringSorterSetExpression func(uintptr, uintptr)
 
 // Sets the expression that is evaluated to obtain strings from items.
 //
-// The expression must have the type %G_TYPE_STRING.
+// The expression must have the type G_TYPE_STRING.
 func (x *StringSorter) SetExpression(ExpressionVar *Expression) {
 
 	xStringSorterSetExpression(x.GoPointer(), ExpressionVar.GoPointer())
@@ -185,7 +224,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -196,13 +235,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xStringSorterGLibType, libs, "gtk_string_sorter_get_type")
 
-	core.PuregoSafeRegister(&xNewStringSorter, libs, "gtk_string_sorter_new")
+	gobject.CheckClassSize("GtkStringSorterClass", xStringSorterGLibType, unsafe.Sizeof(StringSorterClass{}))
+
+	core.PuregoRegisterSince(&xNewStringSorter, libs, "gtk_string_sorter_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xStringSorterGetCollation, libs, "gtk_string_sorter_get_collation")
-	core.PuregoSafeRegister(&xStringSorterGetExpression, libs, "gtk_string_sorter_get_expression")
-	core.PuregoSafeRegister(&xStringSorterGetIgnoreCase, libs, "gtk_string_sorter_get_ignore_case")
-	core.PuregoSafeRegister(&xStringSorterSetCollation, libs, "gtk_string_sorter_set_collation")
-	core.PuregoSafeRegister(&xStringSorterSetExpression, libs, "gtk_string_sorter_set_expression")
-	core.PuregoSafeRegister(&xStringSorterSetIgnoreCase, libs, "gtk_string_sorter_set_ignore_case")
+	core.PuregoRegisterSince(&xStringSorterGetCollation, libs, "gtk_string_sorter_get_collation", "GTK", "4.10")
+	core.PuregoRegisterSince(&xStringSorterGetExpression, libs, "gtk_string_sorter_get_expression", "GTK", "")
+	core.PuregoRegisterSince(&xStringSorterGetIgnoreCase, libs, "gtk_string_sorter_get_ignore_case", "GTK", "")
+	core.PuregoRegisterSince(&xStringSorterSetCollation, libs, "gtk_string_sorter_set_collation", "GTK", "4.10")
+	core.PuregoRegisterSince(&xStringSorterSetExpression, libs, "gtk_string_sorter_set_expression", "GTK", "")
+	core.PuregoRegisterSince(&xStringSorterSetIgnoreCase, libs, "gtk_string_sorter_set_ignore_case", "GTK", "")
 
 }
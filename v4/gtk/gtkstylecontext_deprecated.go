@@ -0,0 +1,315 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xStyleContextAddClass func(uintptr, string)
+
+// Adds a style class to context, so later uses of the
+// style context will make use of this new class for styling.
+//
+// In the CSS file format, a `GtkEntry` defining a “search”
+// class, would be matched by:
+//
+//	entry.search { ... }
+//
+// While any widget defining a “search” class would be
+// matched by:
+//
+//	.search { ... }
+//
+// Deprecated: since 4.10. Use [Widget.AddCssClass] instead
+func (x *StyleContext) AddClass(ClassNameVar string) {
+
+	xStyleContextAddClass(x.GoPointer(), ClassNameVar)
+
+}
+
+var xStyleContextAddProvider func(uintptr, uintptr, uint)
+
+// Adds a style provider to context, to be used in style construction.
+//
+// Note that a style provider added by this function only affects
+// the style of the widget to which context belongs. If you want
+// to affect the style of all widgets, use
+// [StyleContextAddProviderForDisplay].
+//
+// Note: If both priorities are the same, a `GtkStyleProvider`
+// added through this function takes precedence over another added
+// through [StyleContextAddProviderForDisplay].
+//
+// Deprecated: since 4.10. Use style classes instead
+func (x *StyleContext) AddProvider(ProviderVar StyleProvider, PriorityVar uint) {
+
+	xStyleContextAddProvider(x.GoPointer(), ProviderVar.GoPointer(), PriorityVar)
+
+}
+
+var xStyleContextGetBorder func(uintptr, *Border)
+
+// Gets the border for a given state as a `GtkBorder`.
+//
+// Deprecated: since 4.10. This api will be removed in GTK 5
+func (x *StyleContext) GetBorder(BorderVar *Border) {
+
+	xStyleContextGetBorder(x.GoPointer(), BorderVar)
+
+}
+
+var xStyleContextGetColor func(uintptr, *gdk.RGBA)
+
+// Gets the foreground color for a given state.
+//
+// Deprecated: since 4.10. Use [Widget.GetColor] instead
+func (x *StyleContext) GetColor(ColorVar *gdk.RGBA) {
+
+	xStyleContextGetColor(x.GoPointer(), ColorVar)
+
+}
+
+var xStyleContextGetDisplay func(uintptr) uintptr
+
+// Returns the `GdkDisplay` to which context is attached.
+//
+// Deprecated: since 4.10. Use [Widget.GetDisplay] instead
+func (x *StyleContext) GetDisplay() *gdk.Display {
+	var cls *gdk.Display
+
+	cret := xStyleContextGetDisplay(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gdk.Display{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xStyleContextGetMargin func(uintptr, *Border)
+
+// Gets the margin for a given state as a `GtkBorder`.
+//
+// Deprecated: since 4.10. This api will be removed in GTK 5
+func (x *StyleContext) GetMargin(MarginVar *Border) {
+
+	xStyleContextGetMargin(x.GoPointer(), MarginVar)
+
+}
+
+var xStyleContextGetPadding func(uintptr, *Border)
+
+// Gets the padding for a given state as a `GtkBorder`.
+//
+// Deprecated: since 4.10. This api will be removed in GTK 5
+func (x *StyleContext) GetPadding(PaddingVar *Border) {
+
+	xStyleContextGetPadding(x.GoPointer(), PaddingVar)
+
+}
+
+var xStyleContextGetScale func(uintptr) int
+
+// Returns the scale used for assets.
+//
+// Deprecated: since 4.10. Use [Widget.GetScaleFactor] instead
+func (x *StyleContext) GetScale() int {
+
+	cret := xStyleContextGetScale(x.GoPointer())
+	return cret
+}
+
+var xStyleContextGetState func(uintptr) StateFlags
+
+// Returns the state used for style matching.
+//
+// This method should only be used to retrieve the `GtkStateFlags`
+// to pass to `GtkStyleContext` methods, like
+// [StyleContext.GetPadding].
+// If you need to retrieve the current state of a `GtkWidget`, use
+// [Widget.GetStateFlags].
+//
+// Deprecated: since 4.10. Use [Widget.GetStateFlags] instead
+func (x *StyleContext) GetState() StateFlags {
+
+	cret := xStyleContextGetState(x.GoPointer())
+	return cret
+}
+
+var xStyleContextHasClass func(uintptr, string) bool
+
+// Returns true if context currently has defined the
+// given class name.
+//
+// Deprecated: since 4.10. Use [Widget.HasCssClass] instead
+func (x *StyleContext) HasClass(ClassNameVar string) bool {
+
+	cret := xStyleContextHasClass(x.GoPointer(), ClassNameVar)
+	return cret
+}
+
+var xStyleContextLookupColor func(uintptr, string, *gdk.RGBA) bool
+
+// Looks up and resolves a color name in the context color map.
+//
+// Deprecated: since 4.10. This api will be removed in GTK 5
+func (x *StyleContext) LookupColor(ColorNameVar string, ColorVar *gdk.RGBA) bool {
+
+	cret := xStyleContextLookupColor(x.GoPointer(), ColorNameVar, ColorVar)
+	return cret
+}
+
+var xStyleContextRemoveClass func(uintptr, string)
+
+// Removes class_name from context.
+//
+// Deprecated: since 4.10. Use [Widget.RemoveCssClass] instead
+func (x *StyleContext) RemoveClass(ClassNameVar string) {
+
+	xStyleContextRemoveClass(x.GoPointer(), ClassNameVar)
+
+}
+
+var xStyleContextRemoveProvider func(uintptr, uintptr)
+
+// Removes provider from the style providers list in context.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *StyleContext) RemoveProvider(ProviderVar StyleProvider) {
+
+	xStyleContextRemoveProvider(x.GoPointer(), ProviderVar.GoPointer())
+
+}
+
+var xStyleContextRestore func(uintptr)
+
+// Restores context state to a previous stage.
+//
+// See [StyleContext.Save].
+//
+// Deprecated: since 4.10. This API will be removed in GTK 5
+func (x *StyleContext) Restore() {
+
+	xStyleContextRestore(x.GoPointer())
+
+}
+
+var xStyleContextSave func(uintptr)
+
+// Saves the context state.
+//
+// This allows temporary modifications done through
+// [StyleContext.AddClass],
+// [StyleContext.RemoveClass],
+// [StyleContext.SetState] to be quickly
+// reverted in one go through [StyleContext.Restore].
+//
+// The matching call to [StyleContext.Restore]
+// must be done before GTK returns to the main loop.
+//
+// Deprecated: since 4.10. This API will be removed in GTK 5
+func (x *StyleContext) Save() {
+
+	xStyleContextSave(x.GoPointer())
+
+}
+
+var xStyleContextSetDisplay func(uintptr, uintptr)
+
+// Attaches context to the given display.
+//
+// The display is used to add style information from “global”
+// style providers, such as the display's `GtkSettings` instance.
+//
+// If you are using a `GtkStyleContext` returned from
+// [Widget.GetStyleContext], you do not need to
+// call this yourself.
+//
+// Deprecated: since 4.10. You should not use this api
+func (x *StyleContext) SetDisplay(DisplayVar *gdk.Display) {
+
+	xStyleContextSetDisplay(x.GoPointer(), DisplayVar.GoPointer())
+
+}
+
+var xStyleContextSetScale func(uintptr, int)
+
+// Sets the scale to use when getting image assets for the style.
+//
+// Deprecated: since 4.10. You should not use this api
+func (x *StyleContext) SetScale(ScaleVar int) {
+
+	xStyleContextSetScale(x.GoPointer(), ScaleVar)
+
+}
+
+var xStyleContextSetState func(uintptr, StateFlags)
+
+// Sets the state to be used for style matching.
+//
+// Deprecated: since 4.10. You should not use this api
+func (x *StyleContext) SetState(FlagsVar StateFlags) {
+
+	xStyleContextSetState(x.GoPointer(), FlagsVar)
+
+}
+
+var xStyleContextToString func(uintptr, StyleContextPrintFlags) string
+
+// Converts the style context into a string representation.
+//
+// The string representation always includes information about
+// the name, state, id, visibility and style classes of the CSS
+// node that is backing context. Depending on the flags, more
+// information may be included.
+//
+// This function is intended for testing and debugging of the
+// CSS implementation in GTK. There are no guarantees about
+// the format of the returned string, it may change.
+//
+// Deprecated: since 4.10. This api will be removed in GTK 5
+func (x *StyleContext) ToString(FlagsVar StyleContextPrintFlags) string {
+
+	cret := xStyleContextToString(x.GoPointer(), FlagsVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xStyleContextAddClass, libs, "gtk_style_context_add_class", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextAddProvider, libs, "gtk_style_context_add_provider", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetBorder, libs, "gtk_style_context_get_border", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetColor, libs, "gtk_style_context_get_color", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetDisplay, libs, "gtk_style_context_get_display", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetMargin, libs, "gtk_style_context_get_margin", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetPadding, libs, "gtk_style_context_get_padding", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetScale, libs, "gtk_style_context_get_scale", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextGetState, libs, "gtk_style_context_get_state", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextHasClass, libs, "gtk_style_context_has_class", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextLookupColor, libs, "gtk_style_context_lookup_color", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextRemoveClass, libs, "gtk_style_context_remove_class", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextRemoveProvider, libs, "gtk_style_context_remove_provider", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextRestore, libs, "gtk_style_context_restore", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextSave, libs, "gtk_style_context_save", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextSetDisplay, libs, "gtk_style_context_set_display", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextSetScale, libs, "gtk_style_context_set_scale", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextSetState, libs, "gtk_style_context_set_state", "GTK", "")
+	core.PuregoRegisterSince(&xStyleContextToString, libs, "gtk_style_context_to_string", "GTK", "")
+
+}
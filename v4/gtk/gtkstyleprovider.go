@@ -3,14 +3,14 @@ package gtk
 
 import "github.com/jwijenbergh/puregotk/v4/gobject/types"
 
-// An interface for style information used by [class@Gtk.StyleContext].
+// An interface for style information used by [StyleContext].
 //
-// See [method@Gtk.StyleContext.add_provider] and
-// [func@Gtk.StyleContext.add_provider_for_display] for
+// See [StyleContext.AddProvider] and
+// [StyleContextAddProviderForDisplay] for
 // adding `GtkStyleProviders`.
 //
 // GTK uses the `GtkStyleProvider` implementation for CSS in
-// [class@Gtk.CssProvider].
+// [CssProvider].
 type StyleProvider interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -52,7 +52,7 @@ const (
 	// The priority used for style information provided
 	// via `GtkSettings`.
 	//
-	// This priority is higher than %GTK_STYLE_PROVIDER_PRIORITY_THEME
+	// This priority is higher than [STYLE_PROVIDER_PRIORITY_THEME]
 	// to let settings override themes.
 	STYLE_PROVIDER_PRIORITY_SETTINGS int = 400
 	// The priority used for style information provided
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -13,30 +12,16 @@ import (
 
 // Shows a "light switch" that has two states: on or off.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="switch-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkSwitch" src="switch.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The user can control which state should be active by clicking the
 // empty area, or by dragging the slider.
 //
 // `GtkSwitch` can also express situations where the underlying state changes
 // with a delay. In this case, the slider position indicates the user's recent
-// change (represented by the [property@Gtk.Switch:active] property), while the
+// change (represented by the [Switch.GetPropertyActive] property), while the
 // trough color indicates the present underlying state (represented by the
-// [property@Gtk.Switch:state] property).
-//
-// &lt;picture&gt;
+// [Switch.GetPropertyState] property).
 //
-//	&lt;source srcset="switch-state-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="GtkSwitch with delayed state change" src="switch-state.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// See [signal@Gtk.Switch::state-set] for details.
+// See [Switch.ConnectStateSetFunc] for details.
 //
 // # Shortcuts and Gestures
 //
@@ -44,12 +29,10 @@ import (
 //
 // # CSS nodes
 //
-// ```
-// switch
-// ├── image
-// ├── image
-// ╰── slider
-// ```
+//	switch
+//	├── image
+//	├── image
+//	╰── slider
 //
 // `GtkSwitch` has four css nodes, the main node with the name switch and
 // subnodes for the slider and the on and off images. Neither of them is
@@ -57,7 +40,7 @@ import (
 //
 // # Accessibility
 //
-// `GtkSwitch` uses the [enum@Gtk.AccessibleRole.switch] role.
+// `GtkSwitch` uses the [AccessibleRoleSwitchValue] role.
 type Switch struct {
 	Widget
 }
@@ -111,7 +94,7 @@ func (x *Switch) GetState() bool {
 
 var xSwitchSetActive func(uintptr, bool)
 
-// Changes the state of @self to the desired one.
+// Changes the state of self to the desired one.
 func (x *Switch) SetActive(IsActiveVar bool) {
 
 	xSwitchSetActive(x.GoPointer(), IsActiveVar)
@@ -122,10 +105,10 @@ var xSwitchSetState func(uintptr, bool)
 
 // Sets the underlying state of the `GtkSwitch`.
 //
-// This function is typically called from a [signal@Gtk.Switch::state-set]
+// This function is typically called from a [Switch.ConnectStateSetFunc]
 // signal handler in order to set up delayed state changes.
 //
-// See [signal@Gtk.Switch::state-set] for details.
+// See [Switch.ConnectStateSetFunc] for details.
 func (x *Switch) SetState(StateVar bool) {
 
 	xSwitchSetState(x.GoPointer(), StateVar)
@@ -163,11 +146,11 @@ func (x *Switch) GetPropertyActive() bool {
 // SetPropertyState sets the "state" property.
 // The backend state that is controlled by the switch.
 //
-// Applications should usually set the [property@Gtk.Switch:active] property,
+// Applications should usually set the [Switch.GetPropertyActive] property,
 // except when indicating a change to the backend state which occurs
 // separately from the user's interaction.
 //
-// See [signal@Gtk.Switch::state-set] for details.
+// See [Switch.ConnectStateSetFunc] for details.
 func (x *Switch) SetPropertyState(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -178,11 +161,11 @@ func (x *Switch) SetPropertyState(value bool) {
 // GetPropertyState gets the "state" property.
 // The backend state that is controlled by the switch.
 //
-// Applications should usually set the [property@Gtk.Switch:active] property,
+// Applications should usually set the [Switch.GetPropertyActive] property,
 // except when indicating a change to the backend state which occurs
 // separately from the user's interaction.
 //
-// See [signal@Gtk.Switch::state-set] for details.
+// See [Switch.ConnectStateSetFunc] for details.
 func (x *Switch) GetPropertyState() bool {
 	var v gobject.Value
 	x.GetProperty("state", &v)
@@ -192,62 +175,106 @@ func (x *Switch) GetPropertyState() bool {
 // Emitted to animate the switch.
 //
 // Applications should never connect to this signal,
-// but use the [property@Gtk.Switch:active] property.
+// but use the [Switch.GetPropertyActive] property.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Switch) ConnectActivate(cb *func(Switch)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Switch{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xSwitchActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xSwitchActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Switch))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Switch{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Switch) ConnectActivateFunc(cb func(Switch)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xSwitchActivateTrampoline, xSwitchActivateNewTrampoline, cb)
 }
 
 // Emitted to change the underlying state.
 //
 // The ::state-set signal is emitted when the user changes the switch
-// position. The default handler calls [method@Gtk.Switch.set_state] with the
-// value of @state.
+// position. The default handler calls [Switch.SetState] with the
+// value of state.
 //
 // To implement delayed state change, applications can connect to this
 // signal, initiate the change of the underlying state, and call
-// [method@Gtk.Switch.set_state] when the underlying state change is
-// complete. The signal handler should return %TRUE to prevent the
+// [Switch.SetState] when the underlying state change is
+// complete. The signal handler should return true to prevent the
 // default handler from running.
+//
+// Deprecated: use ConnectStateSetFunc, which also accepts method values and closures.
 func (x *Switch) ConnectStateSet(cb *func(Switch, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "state-set", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StateVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Switch{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, StateVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "state-set", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StateVarp bool) bool {
+var xSwitchStateSetTrampoline uintptr
+
+func xSwitchStateSetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StateVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Switch, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Switch{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, StateVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "state-set", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectStateSetFunc connects cb to the "state-set" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Switch) ConnectStateSetFunc(cb func(Switch, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "state-set", &xSwitchStateSetTrampoline, xSwitchStateSetNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -411,7 +438,7 @@ func (x *Switch) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -419,14 +446,13 @@ func (x *Switch) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Switch) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -450,19 +476,17 @@ func (x *Switch) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Switch) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -483,7 +507,7 @@ func (x *Switch) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -492,14 +516,12 @@ func (x *Switch) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Switch) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -518,14 +540,14 @@ func (x *Switch) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *Switch) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *Switch) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -535,15 +557,15 @@ func (x *Switch) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *Switch) SetActionName(ActionNameVar *string) {
 
@@ -556,13 +578,13 @@ func (x *Switch) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *Switch) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -571,14 +593,14 @@ func (x *Switch) SetActionTarget(FormatStringVar string, varArgs ...interface{})
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -596,18 +618,18 @@ func (x *Switch) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *Switch) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Switch) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -619,7 +641,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -628,11 +650,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xSwitchGLibType, libs, "gtk_switch_get_type")
 
-	core.PuregoSafeRegister(&xNewSwitch, libs, "gtk_switch_new")
+	core.PuregoRegisterSince(&xNewSwitch, libs, "gtk_switch_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xSwitchGetActive, libs, "gtk_switch_get_active")
-	core.PuregoSafeRegister(&xSwitchGetState, libs, "gtk_switch_get_state")
-	core.PuregoSafeRegister(&xSwitchSetActive, libs, "gtk_switch_set_active")
-	core.PuregoSafeRegister(&xSwitchSetState, libs, "gtk_switch_set_state")
+	core.PuregoRegisterSince(&xSwitchGetActive, libs, "gtk_switch_get_active", "GTK", "")
+	core.PuregoRegisterSince(&xSwitchGetState, libs, "gtk_switch_get_state", "GTK", "")
+	core.PuregoRegisterSince(&xSwitchSetActive, libs, "gtk_switch_set_active", "GTK", "")
+	core.PuregoRegisterSince(&xSwitchSetState, libs, "gtk_switch_set_state", "GTK", "")
 
 }
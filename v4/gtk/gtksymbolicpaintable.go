@@ -5,9 +5,9 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -16,7 +16,7 @@ import (
 type SymbolicPaintableInterface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSnapshotSymbolic uintptr
 }
@@ -29,13 +29,14 @@ func (x *SymbolicPaintableInterface) GoPointer() uintptr {
 // Snapshot the paintable using the given colors.
 //
 //	See `GtkSymbolicPaintable::snapshot_symbolic()` for details.
-//	If this function is not implemented, [vfunc@Gdk.Paintable.snapshot]
+//	If this function is not implemented, Paintable.snapshot
 //	will be called.
 func (x *SymbolicPaintableInterface) OverrideSnapshotSymbolic(cb func(SymbolicPaintable, *gdk.Snapshot, float64, float64, []gdk.RGBA, uint)) {
 	if cb == nil {
 		x.xSnapshotSymbolic = 0
 	} else {
-		x.xSnapshotSymbolic = purego.NewCallback(func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64, ColorsVarp []gdk.RGBA, NColorsVarp uint) {
+		x.xSnapshotSymbolic = core.NewCallback(func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64, ColorsVarp []gdk.RGBA, NColorsVarp uint) {
+			defer core.RecoverPanic()
 			cb(&SymbolicPaintableBase{Ptr: PaintableVarp}, gdk.SnapshotNewFromInternalPtr(SnapshotVarp), WidthVarp, HeightVarp, ColorsVarp, NColorsVarp)
 		})
 	}
@@ -45,14 +46,14 @@ func (x *SymbolicPaintableInterface) OverrideSnapshotSymbolic(cb func(SymbolicPa
 // Snapshot the paintable using the given colors.
 //
 //	See `GtkSymbolicPaintable::snapshot_symbolic()` for details.
-//	If this function is not implemented, [vfunc@Gdk.Paintable.snapshot]
+//	If this function is not implemented, Paintable.snapshot
 //	will be called.
 func (x *SymbolicPaintableInterface) GetSnapshotSymbolic() func(SymbolicPaintable, *gdk.Snapshot, float64, float64, []gdk.RGBA, uint) {
 	if x.xSnapshotSymbolic == 0 {
 		return nil
 	}
 	var rawCallback func(PaintableVarp uintptr, SnapshotVarp uintptr, WidthVarp float64, HeightVarp float64, ColorsVarp []gdk.RGBA, NColorsVarp uint)
-	purego.RegisterFunc(&rawCallback, x.xSnapshotSymbolic)
+	core.RegisterFunc(&rawCallback, x.xSnapshotSymbolic)
 	return func(PaintableVar SymbolicPaintable, SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64, ColorsVar []gdk.RGBA, NColorsVar uint) {
 		rawCallback(PaintableVar.GoPointer(), SnapshotVar.GoPointer(), WidthVar, HeightVar, ColorsVar, NColorsVar)
 	}
@@ -61,7 +62,7 @@ func (x *SymbolicPaintableInterface) GetSnapshotSymbolic() func(SymbolicPaintabl
 // An interface that supports symbolic colors in paintables.
 //
 // `GdkPaintable`s implementing the interface will have the
-// [vfunc@Gtk.SymbolicPaintable.snapshot_symbolic] function called and
+// SymbolicPaintable.snapshot_symbolic function called and
 // have the colors for drawing symbolic icons passed. At least 4 colors are guaranteed
 // to be passed every time.
 //
@@ -96,6 +97,30 @@ func (x *SymbolicPaintableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
+// ImplementSymbolicPaintable adds the SymbolicPaintable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - SnapshotSymbolic(*gdk.Snapshot, float64, float64, []gdk.RGBA, uint)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see SymbolicPaintableInterface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementSymbolicPaintable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, SymbolicPaintableGLibType(), func(iface *SymbolicPaintableInterface) {
+		if _, ok := any(zero).(interface {
+			SnapshotSymbolic(*gdk.Snapshot, float64, float64, []gdk.RGBA, uint)
+		}); ok {
+			iface.OverrideSnapshotSymbolic(func(self SymbolicPaintable, arg0 *gdk.Snapshot, arg1 float64, arg2 float64, arg3 []gdk.RGBA, arg4 uint) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SnapshotSymbolic(*gdk.Snapshot, float64, float64, []gdk.RGBA, uint)
+				})
+				impl.SnapshotSymbolic(arg0, arg1, arg2, arg3, arg4)
+			})
+		}
+	})
+}
+
 // Snapshots the paintable with the given colors.
 //
 // If less than 4 colors are provided, GTK will pad the array with default
@@ -113,7 +138,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -122,6 +147,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xSymbolicPaintableGLibType, libs, "gtk_symbolic_paintable_get_type")
 
-	core.PuregoSafeRegister(&XGtkSymbolicPaintableSnapshotSymbolic, libs, "gtk_symbolic_paintable_snapshot_symbolic")
+	gobject.CheckClassSize("GtkSymbolicPaintableInterface", xSymbolicPaintableGLibType, unsafe.Sizeof(SymbolicPaintableInterface{}))
+
+	core.PuregoRegisterSince(&XGtkSymbolicPaintableSnapshotSymbolic, libs, "gtk_symbolic_paintable_snapshot_symbolic", "GTK", "4.6")
 
 }
@@ -0,0 +1,246 @@
+// Package gtktest renders widgets to images and compares them against golden images for visual regression tests.
+//
+// Widgets are shown in an undecorated window, so a display is needed.
+// Call Main from TestMain to start a headless display server when there is none, e.g. in CI:
+//
+//	func TestMain(m *testing.M) {
+//		gtktest.Main(m)
+//	}
+//
+//	func TestHeader(t *testing.T) {
+//		header := gtk.NewLabel("Title")
+//		gtktest.AssertGolden(t, &header.Widget, 200, 40, "testdata/header.png", gtktest.Options{Tolerance: 8})
+//	}
+//
+// Run the tests with PUREGOTK_UPDATE_GOLDEN=1 to write the golden images instead of comparing them.
+package gtktest
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Timeout is how long Render waits for the widget to be shown.
+var Timeout = 5 * time.Second
+
+// ErrNoDisplay is returned when GTK cannot be initialized because no display is available.
+var ErrNoDisplay = errors.New("gtktest: GTK could not be initialized, no display available")
+
+// Render shows w in a window of width x height pixels, waits until it is laid out and renders it to a texture.
+// The texture has the size that w was allocated, which is larger than requested if the widget does not fit.
+// The widget is removed from the window again before returning, so it can be rendered again.
+// Rendering is done on the GTK thread, see Do.
+func Render(w *gtk.Widget, width, height int) (texture *gdk.Texture, err error) {
+	Do(func() {
+		texture, err = render(w, width, height)
+	})
+	return texture, err
+}
+
+func render(w *gtk.Widget, width, height int) (*gdk.Texture, error) {
+	if !gtk.InitCheck() {
+		return nil, ErrNoDisplay
+	}
+	window := gtk.NewWindow()
+	defer window.Destroy()
+	window.SetDecorated(false)
+	window.SetDefaultSize(width, height)
+	window.SetChild(w)
+	defer window.SetChild(nil)
+	window.Present()
+
+	ctx := glib.MainContextDefault()
+	deadline := time.Now().Add(Timeout)
+	for !w.GetMapped() || w.GetWidth() == 0 || w.GetHeight() == 0 {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("gtktest: widget was not shown within %s", Timeout)
+		}
+		ctx.Iteration(false)
+		time.Sleep(time.Millisecond)
+	}
+	// let pending layout and style changes settle
+	for ctx.Pending() && time.Now().Before(deadline) {
+		ctx.Iteration(false)
+	}
+
+	ww, wh := w.GetWidth(), w.GetHeight()
+	paintable := gtk.NewWidgetPaintable(w)
+	defer paintable.Unref()
+	snapshot := gtk.NewSnapshot()
+	paintable.Snapshot(&snapshot.Snapshot, float64(ww), float64(wh))
+	node := snapshot.ToNode()
+	if node == nil {
+		return nil, errors.New("gtktest: widget did not draw anything")
+	}
+	defer node.Unref()
+
+	renderer := window.GetRenderer()
+	if renderer == nil {
+		return nil, errors.New("gtktest: window has no renderer")
+	}
+	defer renderer.Unref()
+	var viewport graphene.Rect
+	viewport.Init(0, 0, float32(ww), float32(wh))
+	texture := renderer.RenderTexture(node, &viewport)
+	if texture == nil {
+		return nil, errors.New("gtktest: rendering failed")
+	}
+	return texture, nil
+}
+
+// RenderImage renders w like Render and returns the pixels as an image.
+func RenderImage(w *gtk.Widget, width, height int) (*image.NRGBA, error) {
+	texture, err := Render(w, width, height)
+	if err != nil {
+		return nil, err
+	}
+	defer texture.Unref()
+	return TextureImage(texture), nil
+}
+
+// TextureImage copies the pixels of texture into an image.
+func TextureImage(texture *gdk.Texture) *image.NRGBA {
+	data, stride := texture.DownloadBytesFormat(gdk.MemoryR8g8b8a8Value)
+	return &image.NRGBA{
+		Pix:    data,
+		Stride: stride,
+		Rect:   image.Rect(0, 0, texture.GetWidth(), texture.GetHeight()),
+	}
+}
+
+// Options configure the comparison with a golden image.
+type Options struct {
+	// Tolerance is the difference per color channel up to which pixels are considered equal,
+	// a small tolerance hides antialiasing differences between machines
+	Tolerance uint8
+	// MaxDiffRatio is the ratio of pixels that may differ, from 0 to 1
+	MaxDiffRatio float64
+}
+
+// Diff is the result of comparing two images.
+type Diff struct {
+	// SizeMismatch is true if the images have different sizes, the other fields are unset then
+	SizeMismatch bool
+	// Pixels is the number of pixels that differ more than the tolerance
+	Pixels int
+	// Ratio is Pixels divided by the number of pixels
+	Ratio float64
+	// Image shows the differing pixels in red over a faded copy of the wanted image
+	Image *image.NRGBA
+}
+
+// Compare compares got to want pixel by pixel.
+func Compare(got, want image.Image, tolerance uint8) Diff {
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Dx() != wb.Dx() || gb.Dy() != wb.Dy() {
+		return Diff{SizeMismatch: true}
+	}
+	d := Diff{Image: image.NewNRGBA(image.Rect(0, 0, wb.Dx(), wb.Dy()))}
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			g := color.NRGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y)).(color.NRGBA)
+			w := color.NRGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y)).(color.NRGBA)
+			if channelDiff(g.R, w.R) > tolerance || channelDiff(g.G, w.G) > tolerance ||
+				channelDiff(g.B, w.B) > tolerance || channelDiff(g.A, w.A) > tolerance {
+				d.Pixels++
+				d.Image.SetNRGBA(x, y, color.NRGBA{R: 255, A: 255})
+				continue
+			}
+			gray := uint8((uint16(w.R) + uint16(w.G) + uint16(w.B)) / 3)
+			d.Image.SetNRGBA(x, y, color.NRGBA{R: gray, G: gray, B: gray, A: w.A / 4})
+		}
+	}
+	if n := wb.Dx() * wb.Dy(); n > 0 {
+		d.Ratio = float64(d.Pixels) / float64(n)
+	}
+	return d
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// SavePNG writes img to path as a PNG, creating the directory if needed.
+func SavePNG(img image.Image, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadPNG reads the PNG at path.
+func LoadPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+// updateGolden returns whether golden images should be written instead of compared
+func updateGolden() bool {
+	v := os.Getenv("PUREGOTK_UPDATE_GOLDEN")
+	return v != "" && v != "0"
+}
+
+// AssertGolden renders w like Render and compares it to the golden PNG image at path.
+// On a mismatch the test fails and the rendered and diff images are written next to the golden image,
+// with the suffixes .actual.png and .diff.png.
+// The test is skipped if no display is available.
+func AssertGolden(t testing.TB, w *gtk.Widget, width, height int, path string, opts Options) {
+	t.Helper()
+	got, err := RenderImage(w, width, height)
+	if errors.Is(err, ErrNoDisplay) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updateGolden() {
+		if err := SavePNG(got, path); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := LoadPNG(path)
+	if err != nil {
+		t.Fatalf("gtktest: reading golden image: %v, run with PUREGOTK_UPDATE_GOLDEN=1 to create it", err)
+	}
+
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	d := Compare(got, want, opts.Tolerance)
+	if d.SizeMismatch {
+		SavePNG(got, base+".actual.png")
+		t.Fatalf("gtktest: rendered size %v differs from golden size %v of %s", got.Bounds().Size(), want.Bounds().Size(), path)
+	}
+	if d.Ratio > opts.MaxDiffRatio {
+		SavePNG(got, base+".actual.png")
+		SavePNG(d.Image, base+".diff.png")
+		t.Fatalf("gtktest: %d pixels (%.2f%%) differ from %s, see %s.diff.png", d.Pixels, d.Ratio*100, path, base)
+	}
+}
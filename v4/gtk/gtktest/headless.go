@@ -0,0 +1,311 @@
+package gtktest
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+func init() {
+	// GTK must be used from the thread it was initialized on,
+	// Main keeps the main goroutine on the main thread to run GTK there
+	runtime.LockOSThread()
+}
+
+// Backend is a headless display server that tests can run on.
+type Backend string
+
+const (
+	// BackendAuto uses the display of the environment if there is one,
+	// and starts Broadway or Weston otherwise, whichever is installed
+	BackendAuto Backend = ""
+	// BackendBroadway starts the Broadway display server of GTK, gtk4-broadwayd
+	BackendBroadway Backend = "broadway"
+	// BackendWeston starts Weston with its headless backend as nested Wayland compositor
+	BackendWeston Backend = "weston"
+	// BackendNone never starts a display server, tests without a display are skipped
+	BackendNone Backend = "none"
+)
+
+// StartTimeout is how long StartHeadless waits for the display server to accept clients.
+var StartTimeout = 10 * time.Second
+
+// Display is a display server started by StartHeadless.
+type Display struct {
+	// Backend is the display server that was started
+	Backend Backend
+	// Name is the display name, e.g. ":5" for Broadway or the socket name for Weston
+	Name string
+	cmd  *exec.Cmd
+	dir  string
+	env  map[string]*string
+}
+
+// hasDisplay returns whether the environment has a display that GTK can connect to
+func hasDisplay() bool {
+	for _, v := range []string{"GDK_BACKEND", "WAYLAND_DISPLAY", "DISPLAY", "BROADWAY_DISPLAY"} {
+		if os.Getenv(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// StartHeadless starts a headless display server and points GDK_BACKEND and the display variables of the process at it.
+// GTK must not be initialized yet.
+// For BackendAuto nil is returned if the environment already has a display.
+// Call Stop to terminate the server and restore the environment.
+func StartHeadless(backend Backend) (*Display, error) {
+	switch backend {
+	case BackendNone:
+		return nil, nil
+	case BackendAuto:
+		if hasDisplay() {
+			return nil, nil
+		}
+		var errs []error
+		for _, b := range []Backend{BackendBroadway, BackendWeston} {
+			d, err := StartHeadless(b)
+			if err == nil {
+				return d, nil
+			}
+			errs = append(errs, err)
+		}
+		return nil, errors.Join(errs...)
+	case BackendBroadway:
+		return startBroadway()
+	case BackendWeston:
+		return startWeston()
+	}
+	return nil, fmt.Errorf("gtktest: unknown backend %q", backend)
+}
+
+// lookPath returns the first of the executable names that is installed
+func lookPath(names ...string) (string, error) {
+	for _, n := range names {
+		if p, err := exec.LookPath(n); err == nil {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("gtktest: %s not found in PATH", names[0])
+}
+
+func startBroadway() (*Display, error) {
+	bin, err := lookPath("gtk4-broadwayd", "broadwayd")
+	if err != nil {
+		return nil, err
+	}
+	// broadwayd serves display :n over http on port 8080+n, pick a free one
+	n := -1
+	for i := 20; i < 100; i++ {
+		if l, err := net.Listen("tcp", "127.0.0.1:"+strconv.Itoa(8080+i)); err == nil {
+			l.Close()
+			n = i
+			break
+		}
+	}
+	if n < 0 {
+		return nil, errors.New("gtktest: no free broadway display")
+	}
+	d := &Display{Backend: BackendBroadway, Name: ":" + strconv.Itoa(n)}
+	if err := d.ensureRuntimeDir(); err != nil {
+		return nil, err
+	}
+	d.cmd = exec.Command(bin, d.Name)
+	if err := d.start(func() bool {
+		c, err := net.Dial("tcp", "127.0.0.1:"+strconv.Itoa(8080+n))
+		if err != nil {
+			return false
+		}
+		c.Close()
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	d.setenv("GDK_BACKEND", "broadway")
+	d.setenv("BROADWAY_DISPLAY", d.Name)
+	return d, nil
+}
+
+func startWeston() (*Display, error) {
+	bin, err := lookPath("weston")
+	if err != nil {
+		return nil, err
+	}
+	d := &Display{Backend: BackendWeston, Name: "puregotk-test-" + strconv.Itoa(os.Getpid())}
+	if err := d.ensureRuntimeDir(); err != nil {
+		return nil, err
+	}
+	socket := filepath.Join(os.Getenv("XDG_RUNTIME_DIR"), d.Name)
+	d.cmd = exec.Command(bin, "--backend=headless", "--socket="+d.Name, "--idle-time=0")
+	if err := d.start(func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}); err != nil {
+		return nil, err
+	}
+	d.setenv("GDK_BACKEND", "wayland")
+	d.setenv("WAYLAND_DISPLAY", d.Name)
+	return d, nil
+}
+
+// ensureRuntimeDir creates XDG_RUNTIME_DIR if it is unset, which is common in containers
+// both display servers create their sockets in it
+func (d *Display) ensureRuntimeDir() error {
+	if os.Getenv("XDG_RUNTIME_DIR") != "" {
+		return nil
+	}
+	dir, err := os.MkdirTemp("", "puregotk-runtime-")
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(dir, 0o700); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	d.dir = dir
+	d.setenv("XDG_RUNTIME_DIR", dir)
+	return nil
+}
+
+// start runs the display server and waits until ready returns true
+func (d *Display) start(ready func() bool) error {
+	d.cmd.Stdout = os.Stderr
+	d.cmd.Stderr = os.Stderr
+	if err := d.cmd.Start(); err != nil {
+		d.Stop()
+		return fmt.Errorf("gtktest: starting %s: %w", d.Backend, err)
+	}
+	exited := make(chan struct{})
+	go func() {
+		d.cmd.Wait()
+		close(exited)
+	}()
+	deadline := time.Now().Add(StartTimeout)
+	for !ready() {
+		select {
+		case <-exited:
+			d.cmd = nil
+			d.Stop()
+			return fmt.Errorf("gtktest: %s exited while starting", d.Backend)
+		case <-time.After(20 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			d.Stop()
+			return fmt.Errorf("gtktest: %s did not start within %s", d.Backend, StartTimeout)
+		}
+	}
+	return nil
+}
+
+// setenv sets the environment variable and remembers the old value for Stop
+func (d *Display) setenv(key, value string) {
+	if d.env == nil {
+		d.env = make(map[string]*string)
+	}
+	if _, ok := d.env[key]; !ok {
+		if old, ok := os.LookupEnv(key); ok {
+			d.env[key] = &old
+		} else {
+			d.env[key] = nil
+		}
+	}
+	os.Setenv(key, value)
+}
+
+// Stop terminates the display server and restores the environment.
+func (d *Display) Stop() {
+	if d == nil {
+		return
+	}
+	if d.cmd != nil && d.cmd.Process != nil {
+		d.cmd.Process.Kill()
+		d.cmd = nil
+	}
+	for k, v := range d.env {
+		if v == nil {
+			os.Unsetenv(k)
+		} else {
+			os.Setenv(k, *v)
+		}
+	}
+	d.env = nil
+	if d.dir != "" {
+		os.RemoveAll(d.dir)
+		d.dir = ""
+	}
+}
+
+// mainCalls receives the functions that Do runs on the main thread while Main runs the tests
+var mainCalls chan func()
+
+// Do runs fn on the thread that GTK was initialized on and waits for it to return.
+// Without Main, fn is run directly.
+// fn must not call t.Fatal or t.Skip, as those stop the goroutine that runs fn.
+func Do(fn func()) {
+	if mainCalls == nil {
+		fn()
+		return
+	}
+	var recovered interface{}
+	done := make(chan struct{})
+	mainCalls <- func() {
+		defer close(done)
+		defer func() { recovered = recover() }()
+		fn()
+	}
+	<-done
+	if recovered != nil {
+		panic(recovered)
+	}
+}
+
+// Main runs the tests of a package that uses GTK, call it from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		gtktest.Main(m)
+//	}
+//
+// If the environment has no display, e.g. in a CI container, a headless display server is started first.
+// The backend is picked with the PUREGOTK_TEST_BACKEND environment variable,
+// "broadway", "weston" or "none", the default is to use whichever is installed.
+// GTK is initialized on the main thread, which then runs the functions passed to Do and Render
+// while the tests run on their own goroutines, so create and change widgets inside Do as well.
+// Main exits the process with the result of the tests.
+func Main(m *testing.M) {
+	display, err := StartHeadless(Backend(os.Getenv("PUREGOTK_TEST_BACKEND")))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gtktest: no headless display, GTK tests are skipped: %v\n", err)
+	}
+	if !gtk.InitCheck() {
+		display.Stop()
+		os.Exit(m.Run())
+	}
+
+	mainCalls = make(chan func())
+	result := make(chan int)
+	go func() {
+		result <- m.Run()
+	}()
+	code := 0
+	for running := true; running; {
+		select {
+		case fn := <-mainCalls:
+			fn()
+		case code = <-result:
+			running = false
+		}
+	}
+	mainCalls = nil
+	display.Stop()
+	os.Exit(code)
+}
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -17,7 +16,7 @@ func TestAccessibleAssertionMessageRole(DomainVar string, FileVar string, LineVa
 
 var xTestAccessibleCheckProperty func(uintptr, AccessibleProperty, ...interface{}) string
 
-// Checks whether the accessible @property of @accessible is set to
+// Checks whether the accessible property of accessible is set to
 // a specific value.
 func TestAccessibleCheckProperty(AccessibleVar Accessible, PropertyVar AccessibleProperty, varArgs ...interface{}) string {
 
@@ -27,7 +26,7 @@ func TestAccessibleCheckProperty(AccessibleVar Accessible, PropertyVar Accessibl
 
 var xTestAccessibleCheckRelation func(uintptr, AccessibleRelation, ...interface{}) string
 
-// Checks whether the accessible @relation of @accessible is set to
+// Checks whether the accessible relation of accessible is set to
 // a specific value.
 func TestAccessibleCheckRelation(AccessibleVar Accessible, RelationVar AccessibleRelation, varArgs ...interface{}) string {
 
@@ -37,7 +36,7 @@ func TestAccessibleCheckRelation(AccessibleVar Accessible, RelationVar Accessibl
 
 var xTestAccessibleCheckState func(uintptr, AccessibleState, ...interface{}) string
 
-// Checks whether the accessible @state of @accessible is set to
+// Checks whether the accessible state of accessible is set to
 // a specific value.
 func TestAccessibleCheckState(AccessibleVar Accessible, StateVar AccessibleState, varArgs ...interface{}) string {
 
@@ -47,7 +46,7 @@ func TestAccessibleCheckState(AccessibleVar Accessible, StateVar AccessibleState
 
 var xTestAccessibleHasProperty func(uintptr, AccessibleProperty) bool
 
-// Checks whether the `GtkAccessible` has @property set.
+// Checks whether the `GtkAccessible` has property set.
 func TestAccessibleHasProperty(AccessibleVar Accessible, PropertyVar AccessibleProperty) bool {
 
 	cret := xTestAccessibleHasProperty(AccessibleVar.GoPointer(), PropertyVar)
@@ -56,7 +55,7 @@ func TestAccessibleHasProperty(AccessibleVar Accessible, PropertyVar AccessibleP
 
 var xTestAccessibleHasRelation func(uintptr, AccessibleRelation) bool
 
-// Checks whether the `GtkAccessible` has @relation set.
+// Checks whether the `GtkAccessible` has relation set.
 func TestAccessibleHasRelation(AccessibleVar Accessible, RelationVar AccessibleRelation) bool {
 
 	cret := xTestAccessibleHasRelation(AccessibleVar.GoPointer(), RelationVar)
@@ -66,7 +65,7 @@ func TestAccessibleHasRelation(AccessibleVar Accessible, RelationVar AccessibleR
 var xTestAccessibleHasRole func(uintptr, AccessibleRole) bool
 
 // Checks whether the `GtkAccessible:accessible-role` of the accessible
-// is @role.
+// is role.
 func TestAccessibleHasRole(AccessibleVar Accessible, RoleVar AccessibleRole) bool {
 
 	cret := xTestAccessibleHasRole(AccessibleVar.GoPointer(), RoleVar)
@@ -75,7 +74,7 @@ func TestAccessibleHasRole(AccessibleVar Accessible, RoleVar AccessibleRole) boo
 
 var xTestAccessibleHasState func(uintptr, AccessibleState) bool
 
-// Checks whether the `GtkAccessible` has @state set.
+// Checks whether the `GtkAccessible` has state set.
 func TestAccessibleHasState(AccessibleVar Accessible, StateVar AccessibleState) bool {
 
 	cret := xTestAccessibleHasState(AccessibleVar.GoPointer(), StateVar)
@@ -87,20 +86,20 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTestAccessibleAssertionMessageRole, libs, "gtk_test_accessible_assertion_message_role")
-	core.PuregoSafeRegister(&xTestAccessibleCheckProperty, libs, "gtk_test_accessible_check_property")
-	core.PuregoSafeRegister(&xTestAccessibleCheckRelation, libs, "gtk_test_accessible_check_relation")
-	core.PuregoSafeRegister(&xTestAccessibleCheckState, libs, "gtk_test_accessible_check_state")
-	core.PuregoSafeRegister(&xTestAccessibleHasProperty, libs, "gtk_test_accessible_has_property")
-	core.PuregoSafeRegister(&xTestAccessibleHasRelation, libs, "gtk_test_accessible_has_relation")
-	core.PuregoSafeRegister(&xTestAccessibleHasRole, libs, "gtk_test_accessible_has_role")
-	core.PuregoSafeRegister(&xTestAccessibleHasState, libs, "gtk_test_accessible_has_state")
+	core.PuregoRegisterSince(&xTestAccessibleAssertionMessageRole, libs, "gtk_test_accessible_assertion_message_role", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleCheckProperty, libs, "gtk_test_accessible_check_property", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleCheckRelation, libs, "gtk_test_accessible_check_relation", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleCheckState, libs, "gtk_test_accessible_check_state", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleHasProperty, libs, "gtk_test_accessible_has_property", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleHasRelation, libs, "gtk_test_accessible_has_relation", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleHasRole, libs, "gtk_test_accessible_has_role", "GTK", "")
+	core.PuregoRegisterSince(&xTestAccessibleHasState, libs, "gtk_test_accessible_has_state", "GTK", "")
 
 }
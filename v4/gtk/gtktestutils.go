@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -10,13 +9,13 @@ var xTestInit func(int, []string, ...interface{})
 
 // This function is used to initialize a GTK test program.
 //
-// It will in turn call g_test_init() and gtk_init() to properly
+// It will in turn call [glib.TestInit] and [Init] to properly
 // initialize the testing framework and graphical toolkit. It’ll
 // also set the program’s locale to “C”. This is done to make test
 // program environments as deterministic as possible.
 //
-// Like gtk_init() and g_test_init(), any known arguments will be
-// processed and stripped from @argc and @argv.
+// Like [Init] and [glib.TestInit], any known arguments will be
+// processed and stripped from argc and argv.
 func TestInit(ArgcpVar int, ArgvpVar []string, varArgs ...interface{}) {
 
 	xTestInit(ArgcpVar, ArgvpVar, varArgs...)
@@ -26,7 +25,7 @@ func TestInit(ArgcpVar int, ArgvpVar []string, varArgs ...interface{}) {
 var xTestListAllTypes func(*uint) uintptr
 
 // Return the type ids that have been registered after
-// calling gtk_test_register_all_types().
+// calling [TestRegisterAllTypes].
 func TestListAllTypes(NTypesVar *uint) uintptr {
 
 	cret := xTestListAllTypes(NTypesVar)
@@ -38,7 +37,7 @@ var xTestRegisterAllTypes func()
 // Force registration of all core GTK object types.
 //
 // This allows to refer to any of those object types via
-// g_type_from_name() after calling this function.
+// [gobject.TypeFromName] after calling this function.
 func TestRegisterAllTypes() {
 
 	xTestRegisterAllTypes()
@@ -47,13 +46,13 @@ func TestRegisterAllTypes() {
 
 var xTestWidgetWaitForDraw func(uintptr)
 
-// Enters the main loop and waits for @widget to be “drawn”.
+// Enters the main loop and waits for widget to be “drawn”.
 //
 // In this context that means it waits for the frame clock of
-// @widget to have run a full styling, layout and drawing cycle.
+// widget to have run a full styling, layout and drawing cycle.
 //
 // This function is intended to be used for syncing with actions that
-// depend on @widget relayouting or on interaction with the display
+// depend on widget relayouting or on interaction with the display
 // server.
 func TestWidgetWaitForDraw(WidgetVar *Widget) {
 
@@ -66,16 +65,16 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTestInit, libs, "gtk_test_init")
-	core.PuregoSafeRegister(&xTestListAllTypes, libs, "gtk_test_list_all_types")
-	core.PuregoSafeRegister(&xTestRegisterAllTypes, libs, "gtk_test_register_all_types")
-	core.PuregoSafeRegister(&xTestWidgetWaitForDraw, libs, "gtk_test_widget_wait_for_draw")
+	core.PuregoRegisterSince(&xTestInit, libs, "gtk_test_init", "GTK", "")
+	core.PuregoRegisterSince(&xTestListAllTypes, libs, "gtk_test_list_all_types", "GTK", "")
+	core.PuregoRegisterSince(&xTestRegisterAllTypes, libs, "gtk_test_register_all_types", "GTK", "")
+	core.PuregoRegisterSince(&xTestWidgetWaitForDraw, libs, "gtk_test_widget_wait_for_draw", "GTK", "")
 
 }
@@ -4,7 +4,6 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -17,53 +16,53 @@ import (
 // A single-line text entry.
 //
 // `GtkText` is the common implementation of single-line text editing
-// that is shared between [class@Gtk.Entry], [class@Gtk.PasswordEntry],
-// [class@Gtk.SpinButton], and other widgets. In all of these, a `GtkText`
-// instance is used as the delegate for the [iface@Gtk.Editable] implementation.
+// that is shared between [Entry], [PasswordEntry],
+// [SpinButton], and other widgets. In all of these, a `GtkText`
+// instance is used as the delegate for the [Editable] implementation.
 //
 // A large number of key bindings s supported by default. If the entered
 // text is longer than the allocation of the widget, the widget will scroll
 // so that the cursor position is visible.
 //
 // When using an entry for passwords and other sensitive information,
-// it can be put into “password mode” using [method@Gtk.Text.set_visibility].
+// it can be put into “password mode” using [Text.SetVisibility].
 // In this mode, entered text is displayed using an “invisible” character.
 // By default, GTK picks the best invisible character that is available
 // in the current font, but it can be changed with
-// [method@Gtk.Text.set_invisible_char].
+// [Text.SetInvisibleChar].
 //
 // If you want to add icons or progress display in an entry, look at
-// [class@Gtk.Entry]. There are other alternatives for more specialized
-// use cases, such as [class@Gtk.SearchEntry].
+// [Entry]. There are other alternatives for more specialized
+// use cases, such as [SearchEntry].
 //
-// If you need multi-line editable text, use [class@Gtk.TextView].
+// If you need multi-line editable text, use [TextView].
 //
 // # Shortcuts and Gestures
 //
 // `GtkText` supports the following keyboard shortcuts:
 //
-//   - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;A&lt;/kbd&gt; or &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;&amp;sol;&lt;/kbd&gt;
+//   - Shift+F10 or Menu opens the context menu.
+//   - Ctrl+A or Ctrl+&sol;
 //     selects all the text.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;A&lt;/kbd&gt; or
-//     &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;&amp;bsol;&lt;/kbd&gt; unselects all.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Z&lt;/kbd&gt; undoes the last modification.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Y&lt;/kbd&gt; or &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Z&lt;/kbd&gt;
+//   - Ctrl+Shift+A or
+//     Ctrl+&bsol; unselects all.
+//   - Ctrl+Z undoes the last modification.
+//   - Ctrl+Y or Ctrl+Shift+Z
 //     redoes the last undone modification.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;T&lt;/kbd&gt; toggles the text direction.
-//   - &lt;kbd&gt;Clear&lt;/kbd&gt; clears the content.
+//   - Ctrl+Shift+T toggles the text direction.
+//   - Clear clears the content.
 //
 // Additionally, the following signals have default keybindings:
 //
-// - [signal@Gtk.Text::activate]
-// - [signal@Gtk.Text::backspace]
-// - [signal@Gtk.Text::copy-clipboard]
-// - [signal@Gtk.Text::cut-clipboard]
-// - [signal@Gtk.Text::delete-from-cursor]
-// - [signal@Gtk.Text::insert-emoji]
-// - [signal@Gtk.Text::move-cursor]
-// - [signal@Gtk.Text::paste-clipboard]
-// - [signal@Gtk.Text::toggle-overwrite]
+// - [Text.ConnectActivateFunc]
+// - [Text.ConnectBackspaceFunc]
+// - [Text.ConnectCopyClipboardFunc]
+// - [Text.ConnectCutClipboardFunc]
+// - [Text.ConnectDeleteFromCursorFunc]
+// - [Text.ConnectInsertEmojiFunc]
+// - [Text.ConnectMoveCursorFunc]
+// - [Text.ConnectPasteClipboardFunc]
+// - [Text.ConnectToggleOverwriteFunc]
 //
 // # Actions
 //
@@ -85,18 +84,16 @@ import (
 //
 // # CSS nodes
 //
-// ```
-// text[.read-only]
-// ├── placeholder
-// ├── undershoot.left
-// ├── undershoot.right
-// ├── [selection]
-// ├── [cursor-handle[.top]
-// ├── [cursor-handle.bottom]
-// ├── [block-cursor]
-// ├── [cursor-handle[.top/.bottom][.insertion-cursor]]
-// ╰── [window.popup]
-// ```
+//	text[.read-only]
+//	├── placeholder
+//	├── undershoot.left
+//	├── undershoot.right
+//	├── [selection]
+//	├── [cursor-handle[.top]
+//	├── [cursor-handle.bottom]
+//	├── [block-cursor]
+//	├── [cursor-handle[.top/.bottom][.insertion-cursor]]
+//	╰── [window.popup]
 //
 // `GtkText` has a main node with the name `text`. Depending on the properties
 // of the widget, the `.read-only` style class may appear.
@@ -120,7 +117,7 @@ import (
 //
 // # Accessibility
 //
-// `GtkText` uses the [enum@Gtk.AccessibleRole.none] role, which causes it to be
+// `GtkText` uses the [AccessibleRoleNoneValue] role, which causes it to be
 // skipped for accessibility. This is because `GtkText` is expected to be used
 // as a delegate for a `GtkEditable` implementation that will be represented
 // to accessibility.
@@ -194,10 +191,10 @@ func (x *Text) ComputeCursorExtents(PositionVar uint, StrongVar *graphene.Rect,
 
 var xTextGetActivatesDefault func(uintptr) bool
 
-// Returns whether pressing &lt;kbd&gt;Enter&lt;/kbd&gt; will activate
+// Returns whether pressing Enter will activate
 // the default widget for the window containing the widget.
 //
-// See [method@Gtk.Text.set_activates_default].
+// See [Text.SetActivatesDefault].
 func (x *Text) GetActivatesDefault() bool {
 
 	cret := xTextGetActivatesDefault(x.GoPointer())
@@ -208,7 +205,7 @@ var xTextGetAttributes func(uintptr) *pango.AttrList
 
 // Gets the attribute list that was set on the text widget.
 //
-// See [method@Gtk.Text.set_attributes].
+// See [Text.SetAttributes].
 func (x *Text) GetAttributes() *pango.AttrList {
 
 	cret := xTextGetAttributes(x.GoPointer())
@@ -246,7 +243,7 @@ var xTextGetExtraMenu func(uintptr) uintptr
 
 // Gets the extra menu model of the text widget.
 //
-// See [method@Gtk.Text.set_extra_menu].
+// See [Text.SetExtraMenu].
 func (x *Text) GetExtraMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -279,14 +276,14 @@ func (x *Text) GetInputPurpose() InputPurpose {
 	return cret
 }
 
-var xTextGetInvisibleChar func(uintptr) uint32
+var xTextGetInvisibleChar func(uintptr) rune
 
 // Retrieves the character displayed when visibility is set to false.
 //
 // Note that GTK does not compute this value unless it needs it,
 // so the value returned by this function is not very useful unless
-// it has been explicitly set with [method@Gtk.Text.set_invisible_char].
-func (x *Text) GetInvisibleChar() uint32 {
+// it has been explicitly set with [Text.SetInvisibleChar].
+func (x *Text) GetInvisibleChar() rune {
 
 	cret := xTextGetInvisibleChar(x.GoPointer())
 	return cret
@@ -296,10 +293,10 @@ var xTextGetMaxLength func(uintptr) int
 
 // Retrieves the maximum allowed length of the contents.
 //
-// See [method@Gtk.Text.set_max_length].
+// See [Text.SetMaxLength].
 //
-// This is equivalent to getting @self's `GtkEntryBuffer` and
-// calling [method@Gtk.EntryBuffer.get_max_length] on it.
+// This is equivalent to getting self's `GtkEntryBuffer` and
+// calling [EntryBuffer.GetMaxLength] on it.
 func (x *Text) GetMaxLength() int {
 
 	cret := xTextGetMaxLength(x.GoPointer())
@@ -310,7 +307,7 @@ var xTextGetOverwriteMode func(uintptr) bool
 
 // Gets whether text is overwritten when typing.
 //
-// See [method@Gtk.Text.set_overwrite_mode].
+// See [Text.SetOverwriteMode].
 func (x *Text) GetOverwriteMode() bool {
 
 	cret := xTextGetOverwriteMode(x.GoPointer())
@@ -322,7 +319,7 @@ var xTextGetPlaceholderText func(uintptr) string
 // Retrieves the text that will be displayed when the text widget
 // is empty and unfocused
 //
-// See [method@Gtk.Text.set_placeholder_text].
+// See [Text.SetPlaceholderText].
 func (x *Text) GetPlaceholderText() string {
 
 	cret := xTextGetPlaceholderText(x.GoPointer())
@@ -343,7 +340,7 @@ var xTextGetTabs func(uintptr) *pango.TabArray
 
 // Gets the tab stops for the text widget.
 //
-// See [method@Gtk.Text.set_tabs].
+// See [Text.SetTabs].
 func (x *Text) GetTabs() *pango.TabArray {
 
 	cret := xTextGetTabs(x.GoPointer())
@@ -354,8 +351,8 @@ var xTextGetTextLength func(uintptr) uint16
 
 // Retrieves the length of the contents.
 //
-// This is equivalent to getting @self's `GtkEntryBuffer`
-// and calling [method@Gtk.EntryBuffer.get_length] on it.
+// This is equivalent to getting self's `GtkEntryBuffer`
+// and calling [EntryBuffer.GetLength] on it.
 func (x *Text) GetTextLength() uint16 {
 
 	cret := xTextGetTextLength(x.GoPointer())
@@ -384,8 +381,8 @@ var xTextGrabFocusWithoutSelecting func(uintptr) bool
 
 // Causes the text widget to have the keyboard focus.
 //
-// It behaves like [method@Gtk.Widget.grab_focus],
-// except that it does not select the contents of @self.
+// It behaves like [Widget.GrabFocus],
+// except that it does not select the contents of self.
 //
 // You only want to call this on some special entries
 // which the user usually doesn't want to replace all
@@ -398,10 +395,10 @@ func (x *Text) GrabFocusWithoutSelecting() bool {
 
 var xTextSetActivatesDefault func(uintptr, bool)
 
-// Sets whether pressing &lt;kbd&gt;Enter&lt;/kbd&gt; will activate
+// Sets whether pressing Enter will activate
 // the default widget.
 //
-// This usually means that the dialog containing @self will
+// This usually means that the dialog containing self will
 // be closed, since the default widget is usually one of
 // the dialog buttons.
 func (x *Text) SetActivatesDefault(ActivatesVar bool) {
@@ -472,7 +469,7 @@ func (x *Text) SetInputPurpose(PurposeVar InputPurpose) {
 
 }
 
-var xTextSetInvisibleChar func(uintptr, uint32)
+var xTextSetInvisibleChar func(uintptr, rune)
 
 // Sets the character to use when in “password mode”.
 //
@@ -480,7 +477,7 @@ var xTextSetInvisibleChar func(uintptr, uint32)
 // current font. If you set the invisible char to 0, then the user
 // will get no feedback at all; there will be no text on the screen
 // as they type.
-func (x *Text) SetInvisibleChar(ChVar uint32) {
+func (x *Text) SetInvisibleChar(ChVar rune) {
 
 	xTextSetInvisibleChar(x.GoPointer(), ChVar)
 
@@ -493,8 +490,8 @@ var xTextSetMaxLength func(uintptr, int)
 // If the current contents are longer than the given length,
 // they will be truncated to fit.
 //
-// This is equivalent to getting @self's `GtkEntryBuffer` and
-// calling [method@Gtk.EntryBuffer.set_max_length] on it.
+// This is equivalent to getting self's `GtkEntryBuffer` and
+// calling [EntryBuffer.SetMaxLength] on it.
 func (x *Text) SetMaxLength(LengthVar int) {
 
 	xTextSetMaxLength(x.GoPointer(), LengthVar)
@@ -563,10 +560,10 @@ var xTextSetVisibility func(uintptr, bool)
 //
 // By default, GTK picks the best invisible character available
 // in the current font, but it can be changed with
-// [method@Gtk.Text.set_invisible_char].
+// [Text.SetInvisibleChar].
 //
-// Note that you probably want to set [property@Gtk.Text:input-purpose]
-// to [enum@Gtk.InputPurpose.password] or [enum@Gtk.InputPurpose.pin]
+// Note that you probably want to set Text:input-purpose
+// to [InputPurposePasswordValue] or [InputPurposePinValue]
 // to inform input methods about the purpose of this widget, in addition
 // to setting visibility to false.
 func (x *Text) SetVisibility(VisibleVar bool) {
@@ -598,7 +595,7 @@ func (c *Text) SetGoPointer(ptr uintptr) {
 }
 
 // SetPropertyActivatesDefault sets the "activates-default" property.
-// Whether to activate the default widget when &lt;kbd&gt;Enter&lt;/kbd&gt; is pressed.
+// Whether to activate the default widget when Enter is pressed.
 func (x *Text) SetPropertyActivatesDefault(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -607,7 +604,7 @@ func (x *Text) SetPropertyActivatesDefault(value bool) {
 }
 
 // GetPropertyActivatesDefault gets the "activates-default" property.
-// Whether to activate the default widget when &lt;kbd&gt;Enter&lt;/kbd&gt; is pressed.
+// Whether to activate the default widget when Enter is pressed.
 func (x *Text) GetPropertyActivatesDefault() bool {
 	var v gobject.Value
 	x.GetProperty("activates-default", &v)
@@ -619,7 +616,7 @@ func (x *Text) GetPropertyActivatesDefault() bool {
 //
 // This is mainly useful to change the size or weight of the text.
 //
-// The `PangoAttribute`'s @start_index and @end_index must refer to the
+// The `PangoAttribute`'s start_index and end_index must refer to the
 // `GtkEntryBuffer` text, i.e. without the preedit string.
 func (x *Text) SetPropertyAttributes(value uintptr) {
 	var v gobject.Value
@@ -633,7 +630,7 @@ func (x *Text) SetPropertyAttributes(value uintptr) {
 //
 // This is mainly useful to change the size or weight of the text.
 //
-// The `PangoAttribute`'s @start_index and @end_index must refer to the
+// The `PangoAttribute`'s start_index and end_index must refer to the
 // `GtkEntryBuffer` text, i.e. without the preedit string.
 func (x *Text) GetPropertyAttributes() uintptr {
 	var v gobject.Value
@@ -661,10 +658,10 @@ func (x *Text) GetPropertyEnableEmojiCompletion() bool {
 // SetPropertyImModule sets the "im-module" property.
 // Which input method module should be used.
 //
-// See [class@Gtk.IMMulticontext].
+// See [IMMulticontext].
 //
 // Setting this to a non-`NULL` value overrides the system-wide
-// input method. See the [property@Gtk.Settings:gtk-im-module]
+// input method. See the [Settings.GetPropertyGtkImModule]
 // setting.
 func (x *Text) SetPropertyImModule(value string) {
 	var v gobject.Value
@@ -676,10 +673,10 @@ func (x *Text) SetPropertyImModule(value string) {
 // GetPropertyImModule gets the "im-module" property.
 // Which input method module should be used.
 //
-// See [class@Gtk.IMMulticontext].
+// See [IMMulticontext].
 //
 // Setting this to a non-`NULL` value overrides the system-wide
-// input method. See the [property@Gtk.Settings:gtk-im-module]
+// input method. See the [Settings.GetPropertyGtkImModule]
 // setting.
 func (x *Text) GetPropertyImModule() string {
 	var v gobject.Value
@@ -854,285 +851,483 @@ func (x *Text) GetPropertyVisibility() bool {
 	return v.GetBoolean()
 }
 
-// Emitted when the user hits the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// Emitted when the user hits the Enter key.
 //
 // The default bindings for this signal are all forms
-// of the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// of the Enter key.
+//
+// Deprecated: use ConnectActivateFunc, which also accepts method values and closures.
 func (x *Text) ConnectActivate(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate", cbRefPtr, cbPtr)
+}
+
+var xTextActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFunc connects cb to the "activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectActivateFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate", &xTextActivateTrampoline, xTextActivateNewTrampoline, cb)
 }
 
 // Emitted when the user asks for it.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Backspace&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Backspace&lt;/kbd&gt;.
+// Backspace and Shift+Backspace.
+//
+// Deprecated: use ConnectBackspaceFunc, which also accepts method values and closures.
 func (x *Text) ConnectBackspace(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "backspace", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "backspace", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextBackspaceTrampoline uintptr
+
+func xTextBackspaceNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "backspace", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBackspaceFunc connects cb to the "backspace" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectBackspaceFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "backspace", &xTextBackspaceTrampoline, xTextBackspaceNewTrampoline, cb)
 }
 
 // Emitted to copy the selection to the clipboard.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;c&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Ctrl+c and
+// Ctrl+Insert.
+//
+// Deprecated: use ConnectCopyClipboardFunc, which also accepts method values and closures.
 func (x *Text) ConnectCopyClipboard(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "copy-clipboard", cbRefPtr, cbPtr)
+}
+
+var xTextCopyClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextCopyClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCopyClipboardFunc connects cb to the "copy-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectCopyClipboardFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "copy-clipboard", &xTextCopyClipboardTrampoline, xTextCopyClipboardNewTrampoline, cb)
 }
 
 // Emitted to cut the selection to the clipboard.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;x&lt;/kbd&gt; and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Delete&lt;/kbd&gt;.
+// Ctrl+x and
+// Shift+Delete.
+//
+// Deprecated: use ConnectCutClipboardFunc, which also accepts method values and closures.
 func (x *Text) ConnectCutClipboard(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cut-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cut-clipboard", cbRefPtr, cbPtr)
+}
+
+var xTextCutClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextCutClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cut-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCutClipboardFunc connects cb to the "cut-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectCutClipboardFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cut-clipboard", &xTextCutClipboardTrampoline, xTextCutClipboardNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a text deletion.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// If the @type is [enum@Gtk.DeleteType.chars], GTK deletes the
+// If the type is [DeleteCharsValue], GTK deletes the
 // selection if there is one, otherwise it deletes the requested
 // number of characters.
 //
-// The default bindings for this signal are &lt;kbd&gt;Delete&lt;/kbd&gt;
-// for deleting a character and &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Delete&lt;/kbd&gt;
+// The default bindings for this signal are Delete
+// for deleting a character and Ctrl+Delete
 // for deleting a word.
+//
+// Deprecated: use ConnectDeleteFromCursorFunc, which also accepts method values and closures.
 func (x *Text) ConnectDeleteFromCursor(cb *func(Text, DeleteType, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "delete-from-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TypeVarp, CountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "delete-from-cursor", cbRefPtr, cbPtr)
+}
+
+var xTextDeleteFromCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int) {
+func xTextDeleteFromCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text, DeleteType, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TypeVarp, CountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "delete-from-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeleteFromCursorFunc connects cb to the "delete-from-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectDeleteFromCursorFunc(cb func(Text, DeleteType, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "delete-from-cursor", &xTextDeleteFromCursorTrampoline, xTextDeleteFromCursorNewTrampoline, cb)
 }
 
 // Emitted when the user initiates the insertion of a
 // fixed string at the cursor.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // This signal has no default bindings.
+//
+// Deprecated: use ConnectInsertAtCursorFunc, which also accepts method values and closures.
 func (x *Text) ConnectInsertAtCursor(cb *func(Text, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-at-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StringVarp string) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StringVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-at-cursor", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StringVarp string) {
+var xTextInsertAtCursorTrampoline uintptr
+
+func xTextInsertAtCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StringVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StringVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-at-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertAtCursorFunc connects cb to the "insert-at-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectInsertAtCursorFunc(cb func(Text, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-at-cursor", &xTextInsertAtCursorTrampoline, xTextInsertAtCursorNewTrampoline, cb)
 }
 
 // Emitted to present the Emoji chooser.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;.&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;;&lt;/kbd&gt;
+// Ctrl+. and
+// Ctrl+;
+//
+// Deprecated: use ConnectInsertEmojiFunc, which also accepts method values and closures.
 func (x *Text) ConnectInsertEmoji(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-emoji", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-emoji", cbRefPtr, cbPtr)
+}
+
+var xTextInsertEmojiTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextInsertEmojiNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-emoji", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertEmojiFunc connects cb to the "insert-emoji" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectInsertEmojiFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-emoji", &xTextInsertEmojiTrampoline, xTextInsertEmojiNewTrampoline, cb)
 }
 
 // Emitted when the user initiates a cursor movement.
 //
-// If the cursor is not visible in @self, this signal causes
+// If the cursor is not visible in self, this signal causes
 // the viewport to be moved instead.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // Applications should not connect to it, but may emit it with
-// [func@GObject.signal_emit_by_name] if they need to control
+// [gobject.SignalEmitByName] if they need to control
 // the cursor programmatically.
 //
 // The default bindings for this signal come in two variants,
-// the variant with the &lt;kbd&gt;Shift&lt;/kbd&gt; modifier extends the
+// the variant with the Shift modifier extends the
 // selection, the variant without it does not.
 // There are too many key combinations to list them all here.
 //
-//   - &lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;
+//   - ←, →, ↑, ↓
 //     move by individual characters/lines
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, etc. move by words/paragraphs
-//   - &lt;kbd&gt;Home&lt;/kbd&gt; and &lt;kbd&gt;End&lt;/kbd&gt; move to the ends of the buffer
+//   - Ctrl+←, etc. move by words/paragraphs
+//   - Home and End move to the ends of the buffer
+//
+// Deprecated: use ConnectMoveCursorFunc, which also accepts method values and closures.
 func (x *Text) ConnectMoveCursor(cb *func(Text, MovementStep, int, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp, CountVarp, ExtendVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-cursor", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool) {
+var xTextMoveCursorTrampoline uintptr
+
+func xTextMoveCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text, MovementStep, int, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp, CountVarp, ExtendVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveCursorFunc connects cb to the "move-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectMoveCursorFunc(cb func(Text, MovementStep, int, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-cursor", &xTextMoveCursorTrampoline, xTextMoveCursorNewTrampoline, cb)
 }
 
 // Emitted to paste the contents of the clipboard.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;v&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Ctrl+v and Shift+Insert.
+//
+// Deprecated: use ConnectPasteClipboardFunc, which also accepts method values and closures.
 func (x *Text) ConnectPasteClipboard(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "paste-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "paste-clipboard", cbRefPtr, cbPtr)
+}
+
+var xTextPasteClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextPasteClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "paste-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPasteClipboardFunc connects cb to the "paste-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectPasteClipboardFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "paste-clipboard", &xTextPasteClipboardTrampoline, xTextPasteClipboardNewTrampoline, cb)
 }
 
 // Emitted when the preedit text changes.
@@ -1140,55 +1335,99 @@ func (x *Text) ConnectPasteClipboard(cb *func(Text)) uint {
 // If an input method is used, the typed text will not immediately
 // be committed to the buffer. So if you are interested in the text,
 // connect to this signal.
+//
+// Deprecated: use ConnectPreeditChangedFunc, which also accepts method values and closures.
 func (x *Text) ConnectPreeditChanged(cb *func(Text, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PreeditVarp string) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PreeditVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preedit-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PreeditVarp string) {
+var xTextPreeditChangedTrampoline uintptr
+
+func xTextPreeditChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PreeditVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PreeditVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreeditChangedFunc connects cb to the "preedit-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectPreeditChangedFunc(cb func(Text, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preedit-changed", &xTextPreeditChangedTrampoline, xTextPreeditChangedNewTrampoline, cb)
 }
 
 // Emitted to toggle the overwrite mode.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The default bindings for this signal is Insert.
 //
-// The default bindings for this signal is &lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Deprecated: use ConnectToggleOverwriteFunc, which also accepts method values and closures.
 func (x *Text) ConnectToggleOverwrite(cb *func(Text)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-overwrite", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Text{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-overwrite", cbRefPtr, cbPtr)
+}
+
+var xTextToggleOverwriteTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextToggleOverwriteNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Text))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Text{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-overwrite", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleOverwriteFunc connects cb to the "toggle-overwrite" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Text) ConnectToggleOverwriteFunc(cb func(Text)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-overwrite", &xTextToggleOverwriteTrampoline, xTextToggleOverwriteNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1352,7 +1591,7 @@ func (x *Text) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1360,14 +1599,13 @@ func (x *Text) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Text) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1391,19 +1629,17 @@ func (x *Text) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessibl
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Text) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1424,7 +1660,7 @@ func (x *Text) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleR
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1433,14 +1669,12 @@ func (x *Text) UpdateRelationValue(NRelationsVar int, RelationsVar []AccessibleR
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Text) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1496,10 +1730,10 @@ func (x *Text) UpdateSelectionBound() {
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Text) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -1512,35 +1746,29 @@ func (x *Text) GetBuildableId() string {
 // `GtkEditable` interface implementations using a delegate pattern.
 //
 // You should call this function in your editable widget implementation
-// of the [vfunc@Gtk.Accessible.get_platform_state] virtual function, for
+// of the Accessible.get_platform_state virtual function, for
 // instance:
 //
-// ```c
-// static void
-// accessible_interface_init (GtkAccessibleInterface *iface)
-//
+//	static void
+//	accessible_interface_init (GtkAccessibleInterface *iface)
 //	{
-//	  iface-&gt;get_platform_state = your_editable_get_accessible_platform_state;
+//	  iface->get_platform_state = your_editable_get_accessible_platform_state;
 //	}
 //
-// static gboolean
-// your_editable_get_accessible_platform_state (GtkAccessible *accessible,
-//
-//	GtkAccessiblePlatformState state)
-//
+//	static gboolean
+//	your_editable_get_accessible_platform_state (GtkAccessible *accessible,
+//	                                             GtkAccessiblePlatformState state)
 //	{
 //	  return gtk_editable_delegate_get_accessible_platform_state (GTK_EDITABLE (accessible), state);
 //	}
 //
-// ```
-//
 // Note that the widget which is the delegate *must* be a direct child of
-// this widget, otherwise your implementation of [vfunc@Gtk.Accessible.get_platform_state]
+// this widget, otherwise your implementation of Accessible.get_platform_state
 // might not even be called, as the platform change will originate from
 // the parent of the delegate, and, as a result, will not work properly.
 //
 // So, if you can't ensure the direct child condition, you should give the
-// delegate the %GTK_ACCESSIBLE_ROLE_TEXT_BOX role, or you can
+// delegate the [AccessibleRoleTextBoxValue] role, or you can
 // change your tree to allow this function to work.
 func (x *Text) DelegateGetAccessiblePlatformState(StateVar AccessiblePlatformState) bool {
 
@@ -1560,8 +1788,8 @@ func (x *Text) DeleteSelection() {
 // Deletes a sequence of characters.
 //
 // The characters that are deleted are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
-// negative, then the characters deleted are those from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is
+// negative, then the characters deleted are those from start_pos to
 // the end of the text.
 //
 // Note that the positions are specified in characters, not bytes.
@@ -1571,7 +1799,7 @@ func (x *Text) DeleteText(StartPosVar int, EndPosVar int) {
 
 }
 
-// Undoes the setup done by [method@Gtk.Editable.init_delegate].
+// Undoes the setup done by [Editable.InitDelegate].
 //
 // This is a helper function that should be called from dispose,
 // before removing the delegate object.
@@ -1591,8 +1819,8 @@ func (x *Text) GetAlignment() float32 {
 // Retrieves a sequence of characters.
 //
 // The characters that are retrieved are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is negative,
-// then the characters retrieved are those characters from @start_pos to
+// from start_pos up to, but not including end_pos. If end_pos is negative,
+// then the characters retrieved are those characters from start_pos to
 // the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
@@ -1602,10 +1830,10 @@ func (x *Text) GetChars(StartPosVar int, EndPosVar int) string {
 	return cret
 }
 
-// Gets the `GtkEditable` that @editable is delegating its
+// Gets the `GtkEditable` that editable is delegating its
 // implementation to.
 //
-// Typically, the delegate is a [class@Gtk.Text] widget.
+// Typically, the delegate is a [Text] widget.
 func (x *Text) GetDelegate() *EditableBase {
 	var cls *EditableBase
 
@@ -1620,21 +1848,21 @@ func (x *Text) GetDelegate() *EditableBase {
 	return cls
 }
 
-// Retrieves whether @editable is editable.
+// Retrieves whether editable is editable.
 func (x *Text) GetEditable() bool {
 
 	cret := XGtkEditableGetEditable(x.GoPointer())
 	return cret
 }
 
-// Gets if undo/redo actions are enabled for @editable
+// Gets if undo/redo actions are enabled for editable
 func (x *Text) GetEnableUndo() bool {
 
 	cret := XGtkEditableGetEnableUndo(x.GoPointer())
 	return cret
 }
 
-// Retrieves the desired maximum width of @editable, in characters.
+// Retrieves the desired maximum width of editable, in characters.
 func (x *Text) GetMaxWidthChars() int {
 
 	cret := XGtkEditableGetMaxWidthChars(x.GoPointer())
@@ -1653,9 +1881,9 @@ func (x *Text) GetPosition() int {
 
 // Retrieves the selection bound of the editable.
 //
-// @start_pos will be filled with the start of the selection and
-// @end_pos with end. If no text was selected both will be identical
-// and %FALSE will be returned.
+// start_pos will be filled with the start of the selection and
+// end_pos with end. If no text was selected both will be identical
+// and false will be returned.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *Text) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
@@ -1664,7 +1892,7 @@ func (x *Text) GetSelectionBounds(StartPosVar *int, EndPosVar *int) bool {
 	return cret
 }
 
-// Retrieves the contents of @editable.
+// Retrieves the contents of editable.
 //
 // The returned string is owned by GTK and must not be modified or freed.
 func (x *Text) GetText() string {
@@ -1684,7 +1912,7 @@ func (x *Text) GetWidthChars() int {
 // Sets up a delegate for `GtkEditable`.
 //
 // This is assuming that the get_delegate vfunc in the `GtkEditable`
-// interface has been set up for the @editable's type.
+// interface has been set up for the editable's type.
 //
 // This is a helper function that should be called in instance init,
 // after creating the delegate object.
@@ -1694,11 +1922,11 @@ func (x *Text) InitDelegate() {
 
 }
 
-// Inserts @length bytes of @text into the contents of the
-// widget, at position @position.
+// Inserts length bytes of text into the contents of the
+// widget, at position position.
 //
 // Note that the position is in characters, not in bytes.
-// The function updates @position to point after the newly
+// The function updates position to point after the newly
 // inserted text.
 func (x *Text) InsertText(TextVar string, LengthVar int, PositionVar int) {
 
@@ -1709,9 +1937,9 @@ func (x *Text) InsertText(TextVar string, LengthVar int, PositionVar int) {
 // Selects a region of text.
 //
 // The characters that are selected are those characters at positions
-// from @start_pos up to, but not including @end_pos. If @end_pos is
+// from start_pos up to, but not including end_pos. If end_pos is
 // negative, then the characters selected are those characters from
-// @start_pos to  the end of the text.
+// start_pos to  the end of the text.
 //
 // Note that positions are specified in characters, not bytes.
 func (x *Text) SelectRegion(StartPosVar int, EndPosVar int) {
@@ -1737,19 +1965,19 @@ func (x *Text) SetEditable(IsEditableVar bool) {
 
 }
 
-// If enabled, changes to @editable will be saved for undo/redo
+// If enabled, changes to editable will be saved for undo/redo
 // actions.
 //
 // This results in an additional copy of text changes and are not
 // stored in secure memory. As such, undo is forcefully disabled
-// when [property@Gtk.Text:visibility] is set to %FALSE.
+// when [Text.GetPropertyVisibility] is set to false.
 func (x *Text) SetEnableUndo(EnableUndoVar bool) {
 
 	XGtkEditableSetEnableUndo(x.GoPointer(), EnableUndoVar)
 
 }
 
-// Sets the desired maximum width in characters of @editable.
+// Sets the desired maximum width in characters of editable.
 func (x *Text) SetMaxWidthChars(NCharsVar int) {
 
 	XGtkEditableSetMaxWidthChars(x.GoPointer(), NCharsVar)
@@ -1762,7 +1990,7 @@ func (x *Text) SetMaxWidthChars(NCharsVar int) {
 // index in the contents of the editable. The value must be less than
 // or equal to the number of characters in the editable. A value of -1
 // indicates that the position should be set after the last character
-// of the editable. Note that @position is in characters, not in bytes.
+// of the editable. Note that position is in characters, not in bytes.
 func (x *Text) SetPosition(PositionVar int) {
 
 	XGtkEditableSetPosition(x.GoPointer(), PositionVar)
@@ -1779,11 +2007,11 @@ func (x *Text) SetText(TextVar string) {
 }
 
 // Changes the size request of the editable to be about the
-// right size for @n_chars characters.
+// right size for n_chars characters.
 //
 // Note that it changes the size request, the size can still
 // be affected by how you pack the widget into containers.
-// If @n_chars is -1, the size reverts to the default size.
+// If n_chars is -1, the size reverts to the default size.
 func (x *Text) SetWidthChars(NCharsVar int) {
 
 	XGtkEditableSetWidthChars(x.GoPointer(), NCharsVar)
@@ -1795,7 +2023,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1804,42 +2032,42 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextGLibType, libs, "gtk_text_get_type")
 
-	core.PuregoSafeRegister(&xNewText, libs, "gtk_text_new")
-	core.PuregoSafeRegister(&xNewTextWithBuffer, libs, "gtk_text_new_with_buffer")
-
-	core.PuregoSafeRegister(&xTextComputeCursorExtents, libs, "gtk_text_compute_cursor_extents")
-	core.PuregoSafeRegister(&xTextGetActivatesDefault, libs, "gtk_text_get_activates_default")
-	core.PuregoSafeRegister(&xTextGetAttributes, libs, "gtk_text_get_attributes")
-	core.PuregoSafeRegister(&xTextGetBuffer, libs, "gtk_text_get_buffer")
-	core.PuregoSafeRegister(&xTextGetEnableEmojiCompletion, libs, "gtk_text_get_enable_emoji_completion")
-	core.PuregoSafeRegister(&xTextGetExtraMenu, libs, "gtk_text_get_extra_menu")
-	core.PuregoSafeRegister(&xTextGetInputHints, libs, "gtk_text_get_input_hints")
-	core.PuregoSafeRegister(&xTextGetInputPurpose, libs, "gtk_text_get_input_purpose")
-	core.PuregoSafeRegister(&xTextGetInvisibleChar, libs, "gtk_text_get_invisible_char")
-	core.PuregoSafeRegister(&xTextGetMaxLength, libs, "gtk_text_get_max_length")
-	core.PuregoSafeRegister(&xTextGetOverwriteMode, libs, "gtk_text_get_overwrite_mode")
-	core.PuregoSafeRegister(&xTextGetPlaceholderText, libs, "gtk_text_get_placeholder_text")
-	core.PuregoSafeRegister(&xTextGetPropagateTextWidth, libs, "gtk_text_get_propagate_text_width")
-	core.PuregoSafeRegister(&xTextGetTabs, libs, "gtk_text_get_tabs")
-	core.PuregoSafeRegister(&xTextGetTextLength, libs, "gtk_text_get_text_length")
-	core.PuregoSafeRegister(&xTextGetTruncateMultiline, libs, "gtk_text_get_truncate_multiline")
-	core.PuregoSafeRegister(&xTextGetVisibility, libs, "gtk_text_get_visibility")
-	core.PuregoSafeRegister(&xTextGrabFocusWithoutSelecting, libs, "gtk_text_grab_focus_without_selecting")
-	core.PuregoSafeRegister(&xTextSetActivatesDefault, libs, "gtk_text_set_activates_default")
-	core.PuregoSafeRegister(&xTextSetAttributes, libs, "gtk_text_set_attributes")
-	core.PuregoSafeRegister(&xTextSetBuffer, libs, "gtk_text_set_buffer")
-	core.PuregoSafeRegister(&xTextSetEnableEmojiCompletion, libs, "gtk_text_set_enable_emoji_completion")
-	core.PuregoSafeRegister(&xTextSetExtraMenu, libs, "gtk_text_set_extra_menu")
-	core.PuregoSafeRegister(&xTextSetInputHints, libs, "gtk_text_set_input_hints")
-	core.PuregoSafeRegister(&xTextSetInputPurpose, libs, "gtk_text_set_input_purpose")
-	core.PuregoSafeRegister(&xTextSetInvisibleChar, libs, "gtk_text_set_invisible_char")
-	core.PuregoSafeRegister(&xTextSetMaxLength, libs, "gtk_text_set_max_length")
-	core.PuregoSafeRegister(&xTextSetOverwriteMode, libs, "gtk_text_set_overwrite_mode")
-	core.PuregoSafeRegister(&xTextSetPlaceholderText, libs, "gtk_text_set_placeholder_text")
-	core.PuregoSafeRegister(&xTextSetPropagateTextWidth, libs, "gtk_text_set_propagate_text_width")
-	core.PuregoSafeRegister(&xTextSetTabs, libs, "gtk_text_set_tabs")
-	core.PuregoSafeRegister(&xTextSetTruncateMultiline, libs, "gtk_text_set_truncate_multiline")
-	core.PuregoSafeRegister(&xTextSetVisibility, libs, "gtk_text_set_visibility")
-	core.PuregoSafeRegister(&xTextUnsetInvisibleChar, libs, "gtk_text_unset_invisible_char")
+	core.PuregoRegisterSince(&xNewText, libs, "gtk_text_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTextWithBuffer, libs, "gtk_text_new_with_buffer", "GTK", "")
+
+	core.PuregoRegisterSince(&xTextComputeCursorExtents, libs, "gtk_text_compute_cursor_extents", "GTK", "4.4")
+	core.PuregoRegisterSince(&xTextGetActivatesDefault, libs, "gtk_text_get_activates_default", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetAttributes, libs, "gtk_text_get_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetBuffer, libs, "gtk_text_get_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetEnableEmojiCompletion, libs, "gtk_text_get_enable_emoji_completion", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetExtraMenu, libs, "gtk_text_get_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetInputHints, libs, "gtk_text_get_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetInputPurpose, libs, "gtk_text_get_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetInvisibleChar, libs, "gtk_text_get_invisible_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetMaxLength, libs, "gtk_text_get_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetOverwriteMode, libs, "gtk_text_get_overwrite_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetPlaceholderText, libs, "gtk_text_get_placeholder_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetPropagateTextWidth, libs, "gtk_text_get_propagate_text_width", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetTabs, libs, "gtk_text_get_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetTextLength, libs, "gtk_text_get_text_length", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetTruncateMultiline, libs, "gtk_text_get_truncate_multiline", "GTK", "")
+	core.PuregoRegisterSince(&xTextGetVisibility, libs, "gtk_text_get_visibility", "GTK", "")
+	core.PuregoRegisterSince(&xTextGrabFocusWithoutSelecting, libs, "gtk_text_grab_focus_without_selecting", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetActivatesDefault, libs, "gtk_text_set_activates_default", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetAttributes, libs, "gtk_text_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetBuffer, libs, "gtk_text_set_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetEnableEmojiCompletion, libs, "gtk_text_set_enable_emoji_completion", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetExtraMenu, libs, "gtk_text_set_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetInputHints, libs, "gtk_text_set_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetInputPurpose, libs, "gtk_text_set_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetInvisibleChar, libs, "gtk_text_set_invisible_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetMaxLength, libs, "gtk_text_set_max_length", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetOverwriteMode, libs, "gtk_text_set_overwrite_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetPlaceholderText, libs, "gtk_text_set_placeholder_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetPropagateTextWidth, libs, "gtk_text_set_propagate_text_width", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetTabs, libs, "gtk_text_set_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetTruncateMultiline, libs, "gtk_text_set_truncate_multiline", "GTK", "")
+	core.PuregoRegisterSince(&xTextSetVisibility, libs, "gtk_text_set_visibility", "GTK", "")
+	core.PuregoRegisterSince(&xTextUnsetInvisibleChar, libs, "gtk_text_unset_invisible_char", "GTK", "")
 
 }
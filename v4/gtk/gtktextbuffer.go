@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -13,38 +12,38 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A notification callback used by [method@Gtk.TextBuffer.add_commit_notify].
+// A notification callback used by [TextBuffer.AddCommitNotify].
 //
-// You may not modify the [class@Gtk.TextBuffer] from a
-// [callback@Gtk.TextBufferCommitNotify] callback and that is enforced
-// by the [class@Gtk.TextBuffer] API.
+// You may not modify the [TextBuffer] from a
+// [TextBufferCommitNotify] callback and that is enforced
+// by the [TextBuffer] API.
 //
-// [callback@Gtk.TextBufferCommitNotify] may be used to be notified about
+// [TextBufferCommitNotify] may be used to be notified about
 // changes to the underlying buffer right before-or-after the changes are
 // committed to the underlying B-Tree. This is useful if you want to observe
 // changes to the buffer without other signal handlers potentially modifying
 // state on the way to the default signal handler.
 //
-// When @flags is `GTK_TEXT_BUFFER_NOTIFY_BEFORE_INSERT`, `position` is set to
+// When flags is `GTK_TEXT_BUFFER_NOTIFY_BEFORE_INSERT`, `position` is set to
 // the offset in characters from the start of the buffer where the insertion
 // will occur. `length` is set to the number of characters to be inserted.  You
 // may not yet retrieve the text until it has been inserted. You may access the
 // text from `GTK_TEXT_BUFFER_NOTIFY_AFTER_INSERT` using
-// [method@Gtk.TextBuffer.get_slice].
+// [TextBuffer.GetSlice].
 //
-// When @flags is `GTK_TEXT_BUFFER_NOTIFY_AFTER_INSERT`, `position` is set to
+// When flags is `GTK_TEXT_BUFFER_NOTIFY_AFTER_INSERT`, `position` is set to
 // offset in characters where the insertion occurred and `length` is set
 // to the number of characters inserted.
 //
-// When @flags is `GTK_TEXT_BUFFER_NOTIFY_BEFORE_DELETE`, `position` is set to
+// When flags is `GTK_TEXT_BUFFER_NOTIFY_BEFORE_DELETE`, `position` is set to
 // offset in characters where the deletion will occur and `length` is set
 // to the number of characters that will be removed. You may still retrieve
 // the text from this handler using `position` and `length`.
 //
-// When @flags is `GTK_TEXT_BUFFER_NOTIFY_AFTER_DELETE`, `length` is set to
+// When flags is `GTK_TEXT_BUFFER_NOTIFY_AFTER_DELETE`, `length` is set to
 // zero to denote that the delete-range has already been committed to the
 // underlying B-Tree. You may no longer retrieve the text that has been
-// deleted from the [class@Gtk.TextBuffer].
+// deleted from the [TextBuffer].
 type TextBufferCommitNotify func(uintptr, TextBufferNotifyFlags, uint, uint, uintptr)
 
 // The class structure for `GtkTextBuffer`.
@@ -102,7 +101,8 @@ func (x *TextBufferClass) OverrideInsertText(cb func(*TextBuffer, *TextIter, str
 	if cb == nil {
 		x.xInsertText = 0
 	} else {
-		x.xInsertText = purego.NewCallback(func(BufferVarp uintptr, PosVarp *TextIter, NewTextVarp string, NewTextLengthVarp int) {
+		x.xInsertText = core.NewCallback(func(BufferVarp uintptr, PosVarp *TextIter, NewTextVarp string, NewTextLengthVarp int) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), PosVarp, NewTextVarp, NewTextLengthVarp)
 		})
 	}
@@ -115,7 +115,7 @@ func (x *TextBufferClass) GetInsertText() func(*TextBuffer, *TextIter, string, i
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, PosVarp *TextIter, NewTextVarp string, NewTextLengthVarp int)
-	purego.RegisterFunc(&rawCallback, x.xInsertText)
+	core.RegisterFunc(&rawCallback, x.xInsertText)
 	return func(BufferVar *TextBuffer, PosVar *TextIter, NewTextVar string, NewTextLengthVar int) {
 		rawCallback(BufferVar.GoPointer(), PosVar, NewTextVar, NewTextLengthVar)
 	}
@@ -127,7 +127,8 @@ func (x *TextBufferClass) OverrideInsertPaintable(cb func(*TextBuffer, *TextIter
 	if cb == nil {
 		x.xInsertPaintable = 0
 	} else {
-		x.xInsertPaintable = purego.NewCallback(func(BufferVarp uintptr, IterVarp *TextIter, PaintableVarp uintptr) {
+		x.xInsertPaintable = core.NewCallback(func(BufferVarp uintptr, IterVarp *TextIter, PaintableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), IterVarp, &gdk.PaintableBase{Ptr: PaintableVarp})
 		})
 	}
@@ -140,7 +141,7 @@ func (x *TextBufferClass) GetInsertPaintable() func(*TextBuffer, *TextIter, gdk.
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, IterVarp *TextIter, PaintableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInsertPaintable)
+	core.RegisterFunc(&rawCallback, x.xInsertPaintable)
 	return func(BufferVar *TextBuffer, IterVar *TextIter, PaintableVar gdk.Paintable) {
 		rawCallback(BufferVar.GoPointer(), IterVar, PaintableVar.GoPointer())
 	}
@@ -152,7 +153,8 @@ func (x *TextBufferClass) OverrideInsertChildAnchor(cb func(*TextBuffer, *TextIt
 	if cb == nil {
 		x.xInsertChildAnchor = 0
 	} else {
-		x.xInsertChildAnchor = purego.NewCallback(func(BufferVarp uintptr, IterVarp *TextIter, AnchorVarp uintptr) {
+		x.xInsertChildAnchor = core.NewCallback(func(BufferVarp uintptr, IterVarp *TextIter, AnchorVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), IterVarp, TextChildAnchorNewFromInternalPtr(AnchorVarp))
 		})
 	}
@@ -165,7 +167,7 @@ func (x *TextBufferClass) GetInsertChildAnchor() func(*TextBuffer, *TextIter, *T
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, IterVarp *TextIter, AnchorVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInsertChildAnchor)
+	core.RegisterFunc(&rawCallback, x.xInsertChildAnchor)
 	return func(BufferVar *TextBuffer, IterVar *TextIter, AnchorVar *TextChildAnchor) {
 		rawCallback(BufferVar.GoPointer(), IterVar, AnchorVar.GoPointer())
 	}
@@ -177,7 +179,8 @@ func (x *TextBufferClass) OverrideDeleteRange(cb func(*TextBuffer, *TextIter, *T
 	if cb == nil {
 		x.xDeleteRange = 0
 	} else {
-		x.xDeleteRange = purego.NewCallback(func(BufferVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+		x.xDeleteRange = core.NewCallback(func(BufferVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), StartVarp, EndVarp)
 		})
 	}
@@ -190,7 +193,7 @@ func (x *TextBufferClass) GetDeleteRange() func(*TextBuffer, *TextIter, *TextIte
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, StartVarp *TextIter, EndVarp *TextIter)
-	purego.RegisterFunc(&rawCallback, x.xDeleteRange)
+	core.RegisterFunc(&rawCallback, x.xDeleteRange)
 	return func(BufferVar *TextBuffer, StartVar *TextIter, EndVar *TextIter) {
 		rawCallback(BufferVar.GoPointer(), StartVar, EndVar)
 	}
@@ -202,7 +205,8 @@ func (x *TextBufferClass) OverrideChanged(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xChanged = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -215,7 +219,7 @@ func (x *TextBufferClass) GetChanged() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -227,7 +231,8 @@ func (x *TextBufferClass) OverrideModifiedChanged(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xModifiedChanged = 0
 	} else {
-		x.xModifiedChanged = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xModifiedChanged = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -240,7 +245,7 @@ func (x *TextBufferClass) GetModifiedChanged() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xModifiedChanged)
+	core.RegisterFunc(&rawCallback, x.xModifiedChanged)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -252,7 +257,8 @@ func (x *TextBufferClass) OverrideMarkSet(cb func(*TextBuffer, *TextIter, *TextM
 	if cb == nil {
 		x.xMarkSet = 0
 	} else {
-		x.xMarkSet = purego.NewCallback(func(BufferVarp uintptr, LocationVarp *TextIter, MarkVarp uintptr) {
+		x.xMarkSet = core.NewCallback(func(BufferVarp uintptr, LocationVarp *TextIter, MarkVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), LocationVarp, TextMarkNewFromInternalPtr(MarkVarp))
 		})
 	}
@@ -265,7 +271,7 @@ func (x *TextBufferClass) GetMarkSet() func(*TextBuffer, *TextIter, *TextMark) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, LocationVarp *TextIter, MarkVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMarkSet)
+	core.RegisterFunc(&rawCallback, x.xMarkSet)
 	return func(BufferVar *TextBuffer, LocationVar *TextIter, MarkVar *TextMark) {
 		rawCallback(BufferVar.GoPointer(), LocationVar, MarkVar.GoPointer())
 	}
@@ -277,7 +283,8 @@ func (x *TextBufferClass) OverrideMarkDeleted(cb func(*TextBuffer, *TextMark)) {
 	if cb == nil {
 		x.xMarkDeleted = 0
 	} else {
-		x.xMarkDeleted = purego.NewCallback(func(BufferVarp uintptr, MarkVarp uintptr) {
+		x.xMarkDeleted = core.NewCallback(func(BufferVarp uintptr, MarkVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), TextMarkNewFromInternalPtr(MarkVarp))
 		})
 	}
@@ -290,7 +297,7 @@ func (x *TextBufferClass) GetMarkDeleted() func(*TextBuffer, *TextMark) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, MarkVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMarkDeleted)
+	core.RegisterFunc(&rawCallback, x.xMarkDeleted)
 	return func(BufferVar *TextBuffer, MarkVar *TextMark) {
 		rawCallback(BufferVar.GoPointer(), MarkVar.GoPointer())
 	}
@@ -302,7 +309,8 @@ func (x *TextBufferClass) OverrideApplyTag(cb func(*TextBuffer, *TextTag, *TextI
 	if cb == nil {
 		x.xApplyTag = 0
 	} else {
-		x.xApplyTag = purego.NewCallback(func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+		x.xApplyTag = core.NewCallback(func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), TextTagNewFromInternalPtr(TagVarp), StartVarp, EndVarp)
 		})
 	}
@@ -315,7 +323,7 @@ func (x *TextBufferClass) GetApplyTag() func(*TextBuffer, *TextTag, *TextIter, *
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter)
-	purego.RegisterFunc(&rawCallback, x.xApplyTag)
+	core.RegisterFunc(&rawCallback, x.xApplyTag)
 	return func(BufferVar *TextBuffer, TagVar *TextTag, StartVar *TextIter, EndVar *TextIter) {
 		rawCallback(BufferVar.GoPointer(), TagVar.GoPointer(), StartVar, EndVar)
 	}
@@ -327,7 +335,8 @@ func (x *TextBufferClass) OverrideRemoveTag(cb func(*TextBuffer, *TextTag, *Text
 	if cb == nil {
 		x.xRemoveTag = 0
 	} else {
-		x.xRemoveTag = purego.NewCallback(func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+		x.xRemoveTag = core.NewCallback(func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), TextTagNewFromInternalPtr(TagVarp), StartVarp, EndVarp)
 		})
 	}
@@ -340,7 +349,7 @@ func (x *TextBufferClass) GetRemoveTag() func(*TextBuffer, *TextTag, *TextIter,
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, TagVarp uintptr, StartVarp *TextIter, EndVarp *TextIter)
-	purego.RegisterFunc(&rawCallback, x.xRemoveTag)
+	core.RegisterFunc(&rawCallback, x.xRemoveTag)
 	return func(BufferVar *TextBuffer, TagVar *TextTag, StartVar *TextIter, EndVar *TextIter) {
 		rawCallback(BufferVar.GoPointer(), TagVar.GoPointer(), StartVar, EndVar)
 	}
@@ -352,7 +361,8 @@ func (x *TextBufferClass) OverrideBeginUserAction(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xBeginUserAction = 0
 	} else {
-		x.xBeginUserAction = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xBeginUserAction = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -365,7 +375,7 @@ func (x *TextBufferClass) GetBeginUserAction() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xBeginUserAction)
+	core.RegisterFunc(&rawCallback, x.xBeginUserAction)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -377,7 +387,8 @@ func (x *TextBufferClass) OverrideEndUserAction(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xEndUserAction = 0
 	} else {
-		x.xEndUserAction = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xEndUserAction = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -390,7 +401,7 @@ func (x *TextBufferClass) GetEndUserAction() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEndUserAction)
+	core.RegisterFunc(&rawCallback, x.xEndUserAction)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -402,7 +413,8 @@ func (x *TextBufferClass) OverridePasteDone(cb func(*TextBuffer, *gdk.Clipboard)
 	if cb == nil {
 		x.xPasteDone = 0
 	} else {
-		x.xPasteDone = purego.NewCallback(func(BufferVarp uintptr, ClipboardVarp uintptr) {
+		x.xPasteDone = core.NewCallback(func(BufferVarp uintptr, ClipboardVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp), gdk.ClipboardNewFromInternalPtr(ClipboardVarp))
 		})
 	}
@@ -415,7 +427,7 @@ func (x *TextBufferClass) GetPasteDone() func(*TextBuffer, *gdk.Clipboard) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr, ClipboardVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPasteDone)
+	core.RegisterFunc(&rawCallback, x.xPasteDone)
 	return func(BufferVar *TextBuffer, ClipboardVar *gdk.Clipboard) {
 		rawCallback(BufferVar.GoPointer(), ClipboardVar.GoPointer())
 	}
@@ -427,7 +439,8 @@ func (x *TextBufferClass) OverrideUndo(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xUndo = 0
 	} else {
-		x.xUndo = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xUndo = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -440,7 +453,7 @@ func (x *TextBufferClass) GetUndo() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUndo)
+	core.RegisterFunc(&rawCallback, x.xUndo)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -452,7 +465,8 @@ func (x *TextBufferClass) OverrideRedo(cb func(*TextBuffer)) {
 	if cb == nil {
 		x.xRedo = 0
 	} else {
-		x.xRedo = purego.NewCallback(func(BufferVarp uintptr) {
+		x.xRedo = core.NewCallback(func(BufferVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextBufferNewFromInternalPtr(BufferVarp))
 		})
 	}
@@ -465,7 +479,7 @@ func (x *TextBufferClass) GetRedo() func(*TextBuffer) {
 		return nil
 	}
 	var rawCallback func(BufferVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRedo)
+	core.RegisterFunc(&rawCallback, x.xRedo)
 	return func(BufferVar *TextBuffer) {
 		rawCallback(BufferVar.GoPointer())
 	}
@@ -476,7 +490,8 @@ func (x *TextBufferClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -488,7 +503,7 @@ func (x *TextBufferClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -499,7 +514,8 @@ func (x *TextBufferClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -511,7 +527,7 @@ func (x *TextBufferClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -522,7 +538,8 @@ func (x *TextBufferClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -534,7 +551,7 @@ func (x *TextBufferClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -545,7 +562,8 @@ func (x *TextBufferClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -557,7 +575,7 @@ func (x *TextBufferClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -574,12 +592,12 @@ func (x *TextBufferPrivate) GoPointer() uintptr {
 // Stores text and attributes for display in a `GtkTextView`.
 //
 // You may wish to begin by reading the
-// [text widget conceptual overview](section-text-widget.html),
+// text widget conceptual overview,
 // which gives an overview of all the objects and data types
 // related to the text widget and how they work together.
 //
 // GtkTextBuffer can support undoing changes to the buffer
-// content, see [method@Gtk.TextBuffer.set_enable_undo].
+// content, see [TextBuffer.SetEnableUndo].
 type TextBuffer struct {
 	gobject.Object
 }
@@ -614,17 +632,17 @@ func NewTextBuffer(TableVar *TextTagTable) *TextBuffer {
 
 var xTextBufferAddCommitNotify func(uintptr, TextBufferNotifyFlags, uintptr, uintptr, uintptr) uint
 
-// Adds a [callback@Gtk.TextBufferCommitNotify] to be called when a change
-// is to be made to the [type@Gtk.TextBuffer].
+// Adds a [TextBufferCommitNotify] to be called when a change
+// is to be made to the [TextBuffer].
 //
 // Functions are explicitly forbidden from making changes to the
-// [type@Gtk.TextBuffer] from this callback. It is intended for tracking
+// [TextBuffer] from this callback. It is intended for tracking
 // changes to the buffer only.
 //
-// It may be advantageous to use [callback@Gtk.TextBufferCommitNotify] over
-// connecting to the [signal@Gtk.TextBuffer::insert-text] or
-// [signal@Gtk.TextBuffer::delete-range] signals to avoid ordering issues with
-// other signal handlers which may further modify the [type@Gtk.TextBuffer].
+// It may be advantageous to use [TextBufferCommitNotify] over
+// connecting to the [TextBuffer.ConnectInsertTextFunc] or
+// [TextBuffer.ConnectDeleteRangeFunc] signals to avoid ordering issues with
+// other signal handlers which may further modify the [TextBuffer].
 func (x *TextBuffer) AddCommitNotify(FlagsVar TextBufferNotifyFlags, CommitNotifyVar *TextBufferCommitNotify, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) uint {
 
 	var CommitNotifyVarRef uintptr
@@ -634,11 +652,12 @@ func (x *TextBuffer) AddCommitNotify(FlagsVar TextBufferNotifyFlags, CommitNotif
 			CommitNotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 TextBufferNotifyFlags, arg2 uint, arg3 uint, arg4 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CommitNotifyVar
 				cbFn(arg0, arg1, arg2, arg3, arg4)
 			}
-			CommitNotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CommitNotifyVarPtr, CommitNotifyVarRef, CommitNotifyVar)
+			CommitNotifyVarRef = core.NewCallback(fcb)
+			CommitNotifyVarRef = glib.SaveCallbackWithClosure(CommitNotifyVarPtr, CommitNotifyVarRef, CommitNotifyVar)
 		}
 	}
 
@@ -649,11 +668,12 @@ func (x *TextBuffer) AddCommitNotify(FlagsVar TextBufferNotifyFlags, CommitNotif
 			DestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyVar
 				cbFn(arg0)
 			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
 		}
 	}
 
@@ -663,13 +683,13 @@ func (x *TextBuffer) AddCommitNotify(FlagsVar TextBufferNotifyFlags, CommitNotif
 
 var xTextBufferAddMark func(uintptr, uintptr, *TextIter)
 
-// Adds the mark at position @where.
+// Adds the mark at position where.
 //
 // The mark must not be added to another buffer, and if its name
-// is not %NULL then there must not be another mark in the buffer
+// is not nil then there must not be another mark in the buffer
 // with the same name.
 //
-// Emits the [signal@Gtk.TextBuffer::mark-set] signal as notification
+// Emits the [TextBuffer.ConnectMarkSetFunc] signal as notification
 // of the mark's initial placement.
 func (x *TextBuffer) AddMark(MarkVar *TextMark, WhereVar *TextIter) {
 
@@ -679,11 +699,11 @@ func (x *TextBuffer) AddMark(MarkVar *TextMark, WhereVar *TextIter) {
 
 var xTextBufferAddSelectionClipboard func(uintptr, uintptr)
 
-// Adds @clipboard to the list of clipboards in which the selection
-// contents of @buffer are available.
+// Adds clipboard to the list of clipboards in which the selection
+// contents of buffer are available.
 //
-// In most cases, @clipboard will be the `GdkClipboard` returned by
-// [method@Gtk.Widget.get_primary_clipboard] for a view of @buffer.
+// In most cases, clipboard will be the `GdkClipboard` returned by
+// [Widget.GetPrimaryClipboard] for a view of buffer.
 func (x *TextBuffer) AddSelectionClipboard(ClipboardVar *gdk.Clipboard) {
 
 	xTextBufferAddSelectionClipboard(x.GoPointer(), ClipboardVar.GoPointer())
@@ -692,10 +712,10 @@ func (x *TextBuffer) AddSelectionClipboard(ClipboardVar *gdk.Clipboard) {
 
 var xTextBufferApplyTag func(uintptr, uintptr, *TextIter, *TextIter)
 
-// Emits the “apply-tag” signal on @buffer.
+// Emits the “apply-tag” signal on buffer.
 //
 // The default handler for the signal applies
-// @tag to the given range. @start and @end do
+// tag to the given range. start and end do
 // not have to be in order.
 func (x *TextBuffer) ApplyTag(TagVar *TextTag, StartVar *TextIter, EndVar *TextIter) {
 
@@ -705,11 +725,11 @@ func (x *TextBuffer) ApplyTag(TagVar *TextTag, StartVar *TextIter, EndVar *TextI
 
 var xTextBufferApplyTagByName func(uintptr, string, *TextIter, *TextIter)
 
-// Emits the “apply-tag” signal on @buffer.
+// Emits the “apply-tag” signal on buffer.
 //
-// Calls [method@Gtk.TextTagTable.lookup] on the buffer’s
+// Calls [TextTagTable.Lookup] on the buffer’s
 // tag table to get a `GtkTextTag`, then calls
-// [method@Gtk.TextBuffer.apply_tag].
+// [TextBuffer.ApplyTag].
 func (x *TextBuffer) ApplyTagByName(NameVar string, StartVar *TextIter, EndVar *TextIter) {
 
 	xTextBufferApplyTagByName(x.GoPointer(), NameVar, StartVar, EndVar)
@@ -719,7 +739,7 @@ func (x *TextBuffer) ApplyTagByName(NameVar string, StartVar *TextIter, EndVar *
 var xTextBufferBackspace func(uintptr, *TextIter, bool, bool) bool
 
 // Performs the appropriate action as if the user hit the delete
-// key with the cursor at the position specified by @iter.
+// key with the cursor at the position specified by iter.
 //
 // In the normal case a single character will be deleted, but when
 // combining accents are involved, more than one character can
@@ -727,7 +747,7 @@ var xTextBufferBackspace func(uintptr, *TextIter, bool, bool) bool
 // are involved, less than one character will be deleted.
 //
 // Because the buffer is modified, all outstanding iterators become
-// invalid after calling this function; however, the @iter will be
+// invalid after calling this function; however, the iter will be
 // re-initialized to point to the location where text was deleted.
 func (x *TextBuffer) Backspace(IterVar *TextIter, InteractiveVar bool, DefaultEditableVar bool) bool {
 
@@ -743,11 +763,11 @@ var xTextBufferBeginIrreversibleAction func(uintptr)
 // to be cleared.
 //
 // This should be paired with a call to
-// [method@Gtk.TextBuffer.end_irreversible_action] after the irreversible
+// [TextBuffer.EndIrreversibleAction] after the irreversible
 // action has completed.
 //
-// You may nest calls to gtk_text_buffer_begin_irreversible_action()
-// and gtk_text_buffer_end_irreversible_action() pairs.
+// You may nest calls to [TextBuffer.BeginIrreversibleAction]
+// and [TextBuffer.EndIrreversibleAction] pairs.
 func (x *TextBuffer) BeginIrreversibleAction() {
 
 	xTextBufferBeginIrreversibleAction(x.GoPointer())
@@ -757,20 +777,20 @@ func (x *TextBuffer) BeginIrreversibleAction() {
 var xTextBufferBeginUserAction func(uintptr)
 
 // Called to indicate that the buffer operations between here and a
-// call to gtk_text_buffer_end_user_action() are part of a single
+// call to [TextBuffer.EndUserAction] are part of a single
 // user-visible operation.
 //
-// The operations between gtk_text_buffer_begin_user_action() and
-// gtk_text_buffer_end_user_action() can then be grouped when creating
+// The operations between [TextBuffer.BeginUserAction] and
+// [TextBuffer.EndUserAction] can then be grouped when creating
 // an undo stack. `GtkTextBuffer` maintains a count of calls to
-// gtk_text_buffer_begin_user_action() that have not been closed with
-// a call to gtk_text_buffer_end_user_action(), and emits the
+// [TextBuffer.BeginUserAction] that have not been closed with
+// a call to [TextBuffer.EndUserAction], and emits the
 // “begin-user-action” and “end-user-action” signals only for the
 // outermost pair of calls. This allows you to build user actions
 // from other user actions.
 //
 // The “interactive” buffer mutation functions, such as
-// [method@Gtk.TextBuffer.insert_interactive], automatically call
+// [TextBuffer.InsertInteractive], automatically call
 // begin/end user action around the buffer operations they perform,
 // so there's no need to add extra calls if you user action consists
 // solely of a single call to one of those functions.
@@ -794,8 +814,8 @@ var xTextBufferCreateChildAnchor func(uintptr, *TextIter) uintptr
 // Creates and inserts a child anchor.
 //
 // This is a convenience function which simply creates a child anchor
-// with [ctor@Gtk.TextChildAnchor.new] and inserts it into the buffer
-// with [method@Gtk.TextBuffer.insert_child_anchor].
+// with [NewTextChildAnchor] and inserts it into the buffer
+// with [TextBuffer.InsertChildAnchor].
 //
 // The new anchor is owned by the buffer; no reference count is
 // returned to the caller of this function.
@@ -815,14 +835,14 @@ func (x *TextBuffer) CreateChildAnchor(IterVar *TextIter) *TextChildAnchor {
 
 var xTextBufferCreateMark func(uintptr, uintptr, *TextIter, bool) uintptr
 
-// Creates a mark at position @where.
+// Creates a mark at position where.
 //
-// If @mark_name is %NULL, the mark is anonymous; otherwise, the mark
-// can be retrieved by name using [method@Gtk.TextBuffer.get_mark].
+// If mark_name is nil, the mark is anonymous; otherwise, the mark
+// can be retrieved by name using [TextBuffer.GetMark].
 // If a mark has left gravity, and text is inserted at the mark’s
 // current location, the mark will be moved to the left of the
 // newly-inserted text. If the mark has right gravity
-// (@left_gravity = %FALSE), the mark will end up on the right of
+// (left_gravity = false), the mark will end up on the right of
 // newly-inserted text. The standard left-to-right cursor is a mark
 // with right gravity (when you type, the cursor stays on the right
 // side of the text you’re typing).
@@ -832,7 +852,7 @@ var xTextBufferCreateMark func(uintptr, uintptr, *TextIter, bool) uintptr
 // return value if you like. Marks are owned by the buffer and go
 // away when the buffer does.
 //
-// Emits the [signal@Gtk.TextBuffer::mark-set] signal as notification
+// Emits the [TextBuffer.ConnectMarkSetFunc] signal as notification
 // of the mark's initial placement.
 func (x *TextBuffer) CreateMark(MarkNameVar *string, WhereVar *TextIter, LeftGravityVar bool) *TextMark {
 	var cls *TextMark
@@ -853,37 +873,21 @@ func (x *TextBuffer) CreateMark(MarkNameVar *string, WhereVar *TextIter, LeftGra
 
 var xTextBufferCreateTag func(uintptr, uintptr, uintptr, ...interface{}) uintptr
 
-// Creates a tag and adds it to the tag table for @buffer.
+// Creates a tag and adds it to the tag table for buffer.
 //
-// Equivalent to calling [ctor@Gtk.TextTag.new] and then adding the
+// Equivalent to calling [NewTextTag] and then adding the
 // tag to the buffer’s tag table. The returned tag is owned by
 // the buffer’s tag table, so the ref count will be equal to one.
 //
-// If @tag_name is %NULL, the tag is anonymous.
+// If tag_name is nil, the tag is anonymous.
 //
-// If @tag_name is non-%NULL, a tag called @tag_name must not already
+// If tag_name is non-nil, a tag called tag_name must not already
 // exist in the tag table for this buffer.
 //
-// The @first_property_name argument and subsequent arguments are a list
-// of properties to set on the tag, as with g_object_set().
+// The first_property_name argument and subsequent arguments are a list
+// of properties to set on the tag, as with [gobject.Object.Set].
 func (x *TextBuffer) CreateTag(TagNameVar *string, FirstPropertyNameVar *string, varArgs ...interface{}) *TextTag {
-	var cls *TextTag
-
-	TagNameVarPtr := core.GStrdupNullable(TagNameVar)
-	defer core.GFreeNullable(TagNameVarPtr)
-
-	FirstPropertyNameVarPtr := core.GStrdupNullable(FirstPropertyNameVar)
-	defer core.GFreeNullable(FirstPropertyNameVarPtr)
-
-	cret := xTextBufferCreateTag(x.GoPointer(), TagNameVarPtr, FirstPropertyNameVarPtr, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &TextTag{}
-	cls.Ptr = cret
-	return cls
+	return textBufferCreateTagVarArgs(x, TagNameVar, FirstPropertyNameVar, varArgs...)
 }
 
 var xTextBufferCutClipboard func(uintptr, uintptr, bool)
@@ -898,15 +902,15 @@ func (x *TextBuffer) CutClipboard(ClipboardVar *gdk.Clipboard, DefaultEditableVa
 
 var xTextBufferDelete func(uintptr, *TextIter, *TextIter)
 
-// Deletes text between @start and @end.
+// Deletes text between start and end.
 //
-// The order of @start and @end is not actually relevant;
-// gtk_text_buffer_delete() will reorder them.
+// The order of start and end is not actually relevant;
+// [TextBuffer.Delete] will reorder them.
 //
 // This function actually emits the “delete-range” signal, and
 // the default handler of that signal deletes the text. Because the
 // buffer is modified, all outstanding iterators become invalid after
-// calling this function; however, the @start and @end will be
+// calling this function; however, the start and end will be
 // re-initialized to point to the location where text was deleted.
 func (x *TextBuffer) Delete(StartVar *TextIter, EndVar *TextIter) {
 
@@ -918,8 +922,8 @@ var xTextBufferDeleteInteractive func(uintptr, *TextIter, *TextIter, bool) bool
 
 // Deletes all editable text in the given range.
 //
-// Calls [method@Gtk.TextBuffer.delete] for each editable
-// sub-range of [@start,@end). @start and @end are revalidated
+// Calls [TextBuffer.Delete] for each editable
+// sub-range of [start,end). start and end are revalidated
 // to point to the location of the last deleted range, or left
 // untouched if no text was deleted.
 func (x *TextBuffer) DeleteInteractive(StartIterVar *TextIter, EndIterVar *TextIter, DefaultEditableVar bool) bool {
@@ -930,17 +934,17 @@ func (x *TextBuffer) DeleteInteractive(StartIterVar *TextIter, EndIterVar *TextI
 
 var xTextBufferDeleteMark func(uintptr, uintptr)
 
-// Deletes @mark, so that it’s no longer located anywhere in the
+// Deletes mark, so that it’s no longer located anywhere in the
 // buffer.
 //
 // Removes the reference the buffer holds to the mark, so if
-// you haven’t called g_object_ref() on the mark, it will be freed.
-// Even if the mark isn’t freed, most operations on @mark become
+// you haven’t called [gobject.Object.Ref] on the mark, it will be freed.
+// Even if the mark isn’t freed, most operations on mark become
 // invalid, until it gets added to a buffer again with
-// [method@Gtk.TextBuffer.add_mark]. Use [method@Gtk.TextMark.get_deleted]
+// [TextBuffer.AddMark]. Use [TextMark.GetDeleted]
 // to find out if a mark has been removed from its buffer.
 //
-// The [signal@Gtk.TextBuffer::mark-deleted] signal will be emitted as
+// The [TextBuffer.ConnectMarkDeletedFunc] signal will be emitted as
 // notification after the mark is deleted.
 func (x *TextBuffer) DeleteMark(MarkVar *TextMark) {
 
@@ -950,9 +954,9 @@ func (x *TextBuffer) DeleteMark(MarkVar *TextMark) {
 
 var xTextBufferDeleteMarkByName func(uintptr, string)
 
-// Deletes the mark named @name; the mark must exist.
+// Deletes the mark named name; the mark must exist.
 //
-// See [method@Gtk.TextBuffer.delete_mark] for details.
+// See [TextBuffer.DeleteMark] for details.
 func (x *TextBuffer) DeleteMarkByName(NameVar string) {
 
 	xTextBufferDeleteMarkByName(x.GoPointer(), NameVar)
@@ -964,7 +968,7 @@ var xTextBufferDeleteSelection func(uintptr, bool, bool) bool
 // Deletes the range between the “insert” and “selection_bound” marks,
 // that is, the currently-selected text.
 //
-// If @interactive is %TRUE, the editability of the selection will be
+// If interactive is true, the editability of the selection will be
 // considered (users can’t delete uneditable text).
 func (x *TextBuffer) DeleteSelection(InteractiveVar bool, DefaultEditableVar bool) bool {
 
@@ -980,11 +984,11 @@ var xTextBufferEndIrreversibleAction func(uintptr)
 // queue to be cleared.
 //
 // This should be called after completing modifications to the
-// text buffer after [method@Gtk.TextBuffer.begin_irreversible_action]
+// text buffer after [TextBuffer.BeginIrreversibleAction]
 // was called.
 //
-// You may nest calls to gtk_text_buffer_begin_irreversible_action()
-// and gtk_text_buffer_end_irreversible_action() pairs.
+// You may nest calls to [TextBuffer.BeginIrreversibleAction]
+// and [TextBuffer.EndIrreversibleAction] pairs.
 func (x *TextBuffer) EndIrreversibleAction() {
 
 	xTextBufferEndIrreversibleAction(x.GoPointer())
@@ -996,7 +1000,7 @@ var xTextBufferEndUserAction func(uintptr)
 // Ends a user-visible operation.
 //
 // Should be paired with a call to
-// [method@Gtk.TextBuffer.begin_user_action].
+// [TextBuffer.BeginUserAction].
 // See that function for a full explanation.
 func (x *TextBuffer) EndUserAction() {
 
@@ -1007,7 +1011,7 @@ func (x *TextBuffer) EndUserAction() {
 var xTextBufferGetBounds func(uintptr, *TextIter, *TextIter)
 
 // Retrieves the first and last iterators in the buffer, i.e. the
-// entire buffer lies within the range [@start,@end).
+// entire buffer lies within the range [start,end).
 func (x *TextBuffer) GetBounds(StartVar *TextIter, EndVar *TextIter) {
 
 	xTextBufferGetBounds(x.GoPointer(), StartVar, EndVar)
@@ -1052,8 +1056,8 @@ var xTextBufferGetEnableUndo func(uintptr) bool
 // Gets whether the buffer is saving modifications to the buffer
 // to allow for undo and redo actions.
 //
-// See [method@Gtk.TextBuffer.begin_irreversible_action] and
-// [method@Gtk.TextBuffer.end_irreversible_action] to create
+// See [TextBuffer.BeginIrreversibleAction] and
+// [TextBuffer.EndIrreversibleAction] to create
 // changes to the buffer that cannot be undone.
 func (x *TextBuffer) GetEnableUndo() bool {
 
@@ -1063,13 +1067,13 @@ func (x *TextBuffer) GetEnableUndo() bool {
 
 var xTextBufferGetEndIter func(uintptr, *TextIter)
 
-// Initializes @iter with the “end iterator,” one past the last valid
+// Initializes iter with the “end iterator,” one past the last valid
 // character in the text buffer.
 //
-// If dereferenced with [method@Gtk.TextIter.get_char], the end
+// If dereferenced with [TextIter.GetChar], the end
 // iterator has a character value of 0.
 // The entire buffer lies in the range from the first position in
-// the buffer (call [method@Gtk.TextBuffer.get_start_iter] to get
+// the buffer (call [TextBuffer.GetStartIter] to get
 // character position 0) to the end iterator.
 func (x *TextBuffer) GetEndIter(IterVar *TextIter) {
 
@@ -1090,7 +1094,7 @@ var xTextBufferGetInsert func(uintptr) uintptr
 
 // Returns the mark that represents the cursor (insertion point).
 //
-// Equivalent to calling [method@Gtk.TextBuffer.get_mark]
+// Equivalent to calling [TextBuffer.GetMark]
 // to get the mark named “insert”, but very slightly more
 // efficient, and involves less typing.
 func (x *TextBuffer) GetInsert() *TextMark {
@@ -1109,7 +1113,7 @@ func (x *TextBuffer) GetInsert() *TextMark {
 
 var xTextBufferGetIterAtChildAnchor func(uintptr, *TextIter, uintptr)
 
-// Obtains the location of @anchor within @buffer.
+// Obtains the location of anchor within buffer.
 func (x *TextBuffer) GetIterAtChildAnchor(IterVar *TextIter, AnchorVar *TextChildAnchor) {
 
 	xTextBufferGetIterAtChildAnchor(x.GoPointer(), IterVar, AnchorVar.GoPointer())
@@ -1118,10 +1122,10 @@ func (x *TextBuffer) GetIterAtChildAnchor(IterVar *TextIter, AnchorVar *TextChil
 
 var xTextBufferGetIterAtLine func(uintptr, *TextIter, int) bool
 
-// Initializes @iter to the start of the given line.
+// Initializes iter to the start of the given line.
 //
-// If @line_number is greater than or equal to the number of lines
-// in the @buffer, the end iterator is returned.
+// If line_number is greater than or equal to the number of lines
+// in the buffer, the end iterator is returned.
 func (x *TextBuffer) GetIterAtLine(IterVar *TextIter, LineNumberVar int) bool {
 
 	cret := xTextBufferGetIterAtLine(x.GoPointer(), IterVar, LineNumberVar)
@@ -1130,13 +1134,13 @@ func (x *TextBuffer) GetIterAtLine(IterVar *TextIter, LineNumberVar int) bool {
 
 var xTextBufferGetIterAtLineIndex func(uintptr, *TextIter, int, int) bool
 
-// Obtains an iterator pointing to @byte_index within the given line.
+// Obtains an iterator pointing to byte_index within the given line.
 //
-// @byte_index must be the start of a UTF-8 character. Note bytes, not
+// byte_index must be the start of a UTF-8 character. Note bytes, not
 // characters; UTF-8 may encode one character as multiple bytes.
 //
-// If @line_number is greater than or equal to the number of lines in the @buffer,
-// the end iterator is returned. And if @byte_index is off the
+// If line_number is greater than or equal to the number of lines in the buffer,
+// the end iterator is returned. And if byte_index is off the
 // end of the line, the iterator at the end of the line is returned.
 func (x *TextBuffer) GetIterAtLineIndex(IterVar *TextIter, LineNumberVar int, ByteIndexVar int) bool {
 
@@ -1146,13 +1150,13 @@ func (x *TextBuffer) GetIterAtLineIndex(IterVar *TextIter, LineNumberVar int, By
 
 var xTextBufferGetIterAtLineOffset func(uintptr, *TextIter, int, int) bool
 
-// Obtains an iterator pointing to @char_offset within the given line.
+// Obtains an iterator pointing to char_offset within the given line.
 //
 // Note characters, not bytes; UTF-8 may encode one character as multiple
 // bytes.
 //
-// If @line_number is greater than or equal to the number of lines in the @buffer,
-// the end iterator is returned. And if @char_offset is off the
+// If line_number is greater than or equal to the number of lines in the buffer,
+// the end iterator is returned. And if char_offset is off the
 // end of the line, the iterator at the end of the line is returned.
 func (x *TextBuffer) GetIterAtLineOffset(IterVar *TextIter, LineNumberVar int, CharOffsetVar int) bool {
 
@@ -1162,7 +1166,7 @@ func (x *TextBuffer) GetIterAtLineOffset(IterVar *TextIter, LineNumberVar int, C
 
 var xTextBufferGetIterAtMark func(uintptr, *TextIter, uintptr)
 
-// Initializes @iter with the current position of @mark.
+// Initializes iter with the current position of mark.
 func (x *TextBuffer) GetIterAtMark(IterVar *TextIter, MarkVar *TextMark) {
 
 	xTextBufferGetIterAtMark(x.GoPointer(), IterVar, MarkVar.GoPointer())
@@ -1171,11 +1175,11 @@ func (x *TextBuffer) GetIterAtMark(IterVar *TextIter, MarkVar *TextMark) {
 
 var xTextBufferGetIterAtOffset func(uintptr, *TextIter, int)
 
-// Initializes @iter to a position @char_offset chars from the start
+// Initializes iter to a position char_offset chars from the start
 // of the entire buffer.
 //
-// If @char_offset is -1 or greater than the number
-// of characters in the buffer, @iter is initialized to the end iterator,
+// If char_offset is -1 or greater than the number
+// of characters in the buffer, iter is initialized to the end iterator,
 // the iterator one past the last valid character in the buffer.
 func (x *TextBuffer) GetIterAtOffset(IterVar *TextIter, CharOffsetVar int) {
 
@@ -1196,7 +1200,7 @@ func (x *TextBuffer) GetLineCount() int {
 
 var xTextBufferGetMark func(uintptr, string) uintptr
 
-// Returns the mark named @name in buffer @buffer, or %NULL if no such
+// Returns the mark named name in buffer buffer, or nil if no such
 // mark exists in the buffer.
 func (x *TextBuffer) GetMark(NameVar string) *TextMark {
 	var cls *TextMark
@@ -1228,8 +1232,8 @@ func (x *TextBuffer) GetMaxUndoLevels() uint {
 var xTextBufferGetModified func(uintptr) bool
 
 // Indicates whether the buffer has been modified since the last call
-// to [method@Gtk.TextBuffer.set_modified] set the modification flag to
-// %FALSE.
+// to [TextBuffer.SetModified] set the modification flag to
+// false.
 //
 // Used for example to enable a “save” function in a text editor.
 func (x *TextBuffer) GetModified() bool {
@@ -1242,14 +1246,14 @@ var xTextBufferGetSelectionBound func(uintptr) uintptr
 
 // Returns the mark that represents the selection bound.
 //
-// Equivalent to calling [method@Gtk.TextBuffer.get_mark]
+// Equivalent to calling [TextBuffer.GetMark]
 // to get the mark named “selection_bound”, but very slightly
 // more efficient, and involves less typing.
 //
-// The currently-selected text in @buffer is the region between the
+// The currently-selected text in buffer is the region between the
 // “selection_bound” and “insert” marks. If “selection_bound” and
 // “insert” are in the same place, then there is no current selection.
-// [method@Gtk.TextBuffer.get_selection_bounds] is another convenient
+// [TextBuffer.GetSelectionBounds] is another convenient
 // function for handling the selection, if you just want to know whether
 // there’s a selection and what its bounds are.
 func (x *TextBuffer) GetSelectionBound() *TextMark {
@@ -1268,12 +1272,12 @@ func (x *TextBuffer) GetSelectionBound() *TextMark {
 
 var xTextBufferGetSelectionBounds func(uintptr, *TextIter, *TextIter) bool
 
-// Returns %TRUE if some text is selected; places the bounds
-// of the selection in @start and @end.
+// Returns true if some text is selected; places the bounds
+// of the selection in start and end.
 //
-// If the selection has length 0, then @start and @end are filled
-// in with the same value. @start and @end will be in ascending order.
-// If @start and @end are %NULL, then they are not filled in, but the
+// If the selection has length 0, then start and end are filled
+// in with the same value. start and end will be in ascending order.
+// If start and end are nil, then they are not filled in, but the
 // return value still indicates whether text is selected.
 func (x *TextBuffer) GetSelectionBounds(StartVar *TextIter, EndVar *TextIter) bool {
 
@@ -1285,8 +1289,8 @@ var xTextBufferGetSelectionContent func(uintptr) uintptr
 
 // Get a content provider for this buffer.
 //
-// It can be used to make the content of @buffer available
-// in a `GdkClipboard`, see [method@Gdk.Clipboard.set_content].
+// It can be used to make the content of buffer available
+// in a `GdkClipboard`, see [gdk.Clipboard.SetContent].
 func (x *TextBuffer) GetSelectionContent() *gdk.ContentProvider {
 	var cls *gdk.ContentProvider
 
@@ -1302,14 +1306,14 @@ func (x *TextBuffer) GetSelectionContent() *gdk.ContentProvider {
 
 var xTextBufferGetSlice func(uintptr, *TextIter, *TextIter, bool) string
 
-// Returns the text in the range [@start,@end).
+// Returns the text in the range [start,end).
 //
 // Excludes undisplayed text (text marked with tags that set the
-// invisibility attribute) if @include_hidden_chars is %FALSE.
+// invisibility attribute) if include_hidden_chars is false.
 // The returned string includes a 0xFFFC character whenever the
 // buffer contains embedded images, so byte and character indexes
 // into the returned string do correspond to byte and character
-// indexes into the buffer. Contrast with [method@Gtk.TextBuffer.get_text].
+// indexes into the buffer. Contrast with [TextBuffer.GetText].
 // Note that 0xFFFC can occur in normal text as well, so it is not a
 // reliable indicator that a paintable or widget is in the buffer.
 func (x *TextBuffer) GetSlice(StartVar *TextIter, EndVar *TextIter, IncludeHiddenCharsVar bool) string {
@@ -1320,9 +1324,9 @@ func (x *TextBuffer) GetSlice(StartVar *TextIter, EndVar *TextIter, IncludeHidde
 
 var xTextBufferGetStartIter func(uintptr, *TextIter)
 
-// Initialized @iter with the first position in the text buffer.
+// Initialized iter with the first position in the text buffer.
 //
-// This is the same as using [method@Gtk.TextBuffer.get_iter_at_offset]
+// This is the same as using [TextBuffer.GetIterAtOffset]
 // to get the iter at character offset 0.
 func (x *TextBuffer) GetStartIter(IterVar *TextIter) {
 
@@ -1349,14 +1353,14 @@ func (x *TextBuffer) GetTagTable() *TextTagTable {
 
 var xTextBufferGetText func(uintptr, *TextIter, *TextIter, bool) string
 
-// Returns the text in the range [@start,@end).
+// Returns the text in the range [start,end).
 //
 // Excludes undisplayed text (text marked with tags that set the
-// invisibility attribute) if @include_hidden_chars is %FALSE.
+// invisibility attribute) if include_hidden_chars is false.
 // Does not include characters representing embedded images, so
 // byte and character indexes into the returned string do not
 // correspond to byte and character indexes into the buffer.
-// Contrast with [method@Gtk.TextBuffer.get_slice].
+// Contrast with [TextBuffer.GetSlice].
 func (x *TextBuffer) GetText(StartVar *TextIter, EndVar *TextIter, IncludeHiddenCharsVar bool) string {
 
 	cret := xTextBufferGetText(x.GoPointer(), StartVar, EndVar, IncludeHiddenCharsVar)
@@ -1365,11 +1369,11 @@ func (x *TextBuffer) GetText(StartVar *TextIter, EndVar *TextIter, IncludeHidden
 
 var xTextBufferInsert func(uintptr, *TextIter, string, int)
 
-// Inserts @len bytes of @text at position @iter.
+// Inserts len bytes of text at position iter.
 //
-// If @len is -1, @text must be nul-terminated and will be inserted in its
+// If len is -1, text must be nul-terminated and will be inserted in its
 // entirety. Emits the “insert-text” signal; insertion actually occurs
-// in the default handler for the signal. @iter is invalidated when
+// in the default handler for the signal. iter is invalidated when
 // insertion occurs (because the buffer contents change), but the
 // default signal handler revalidates it to point to the end of the
 // inserted text.
@@ -1381,9 +1385,9 @@ func (x *TextBuffer) Insert(IterVar *TextIter, TextVar string, LenVar int) {
 
 var xTextBufferInsertAtCursor func(uintptr, string, int)
 
-// Inserts @text in @buffer.
+// Inserts text in buffer.
 //
-// Simply calls [method@Gtk.TextBuffer.insert],
+// Simply calls [TextBuffer.Insert],
 // using the current cursor position as the insertion point.
 func (x *TextBuffer) InsertAtCursor(TextVar string, LenVar int) {
 
@@ -1393,17 +1397,17 @@ func (x *TextBuffer) InsertAtCursor(TextVar string, LenVar int) {
 
 var xTextBufferInsertChildAnchor func(uintptr, *TextIter, uintptr)
 
-// Inserts a child widget anchor into the text buffer at @iter.
+// Inserts a child widget anchor into the text buffer at iter.
 //
 // The anchor will be counted as one character in character counts, and
 // when obtaining the buffer contents as a string, will be represented
 // by the Unicode “object replacement character” 0xFFFC. Note that the
 // “slice” variants for obtaining portions of the buffer as a string
 // include this character for child anchors, but the “text” variants do
-// not. E.g. see [method@Gtk.TextBuffer.get_slice] and
-// [method@Gtk.TextBuffer.get_text].
+// not. E.g. see [TextBuffer.GetSlice] and
+// [TextBuffer.GetText].
 //
-// Consider [method@Gtk.TextBuffer.create_child_anchor] as a more
+// Consider [TextBuffer.CreateChildAnchor] as a more
 // convenient alternative to this function. The buffer will add a
 // reference to the anchor, so you can unref it after insertion.
 func (x *TextBuffer) InsertChildAnchor(IterVar *TextIter, AnchorVar *TextChildAnchor) {
@@ -1414,16 +1418,16 @@ func (x *TextBuffer) InsertChildAnchor(IterVar *TextIter, AnchorVar *TextChildAn
 
 var xTextBufferInsertInteractive func(uintptr, *TextIter, string, int, bool) bool
 
-// Inserts @text in @buffer.
+// Inserts text in buffer.
 //
-// Like [method@Gtk.TextBuffer.insert], but the insertion will not occur
-// if @iter is at a non-editable location in the buffer. Usually you
+// Like [TextBuffer.Insert], but the insertion will not occur
+// if iter is at a non-editable location in the buffer. Usually you
 // want to prevent insertions at ineditable locations if the insertion
 // results from a user action (is interactive).
 //
-// @default_editable indicates the editability of text that doesn't
+// default_editable indicates the editability of text that doesn't
 // have a tag affecting editability applied to it. Typically the
-// result of [method@Gtk.TextView.get_editable] is appropriate here.
+// result of [TextView.GetEditable] is appropriate here.
 func (x *TextBuffer) InsertInteractive(IterVar *TextIter, TextVar string, LenVar int, DefaultEditableVar bool) bool {
 
 	cret := xTextBufferInsertInteractive(x.GoPointer(), IterVar, TextVar, LenVar, DefaultEditableVar)
@@ -1432,14 +1436,14 @@ func (x *TextBuffer) InsertInteractive(IterVar *TextIter, TextVar string, LenVar
 
 var xTextBufferInsertInteractiveAtCursor func(uintptr, string, int, bool) bool
 
-// Inserts @text in @buffer.
+// Inserts text in buffer.
 //
-// Calls [method@Gtk.TextBuffer.insert_interactive]
+// Calls [TextBuffer.InsertInteractive]
 // at the cursor position.
 //
-// @default_editable indicates the editability of text that doesn't
+// default_editable indicates the editability of text that doesn't
 // have a tag affecting editability applied to it. Typically the
-// result of [method@Gtk.TextView.get_editable] is appropriate here.
+// result of [TextView.GetEditable] is appropriate here.
 func (x *TextBuffer) InsertInteractiveAtCursor(TextVar string, LenVar int, DefaultEditableVar bool) bool {
 
 	cret := xTextBufferInsertInteractiveAtCursor(x.GoPointer(), TextVar, LenVar, DefaultEditableVar)
@@ -1448,12 +1452,12 @@ func (x *TextBuffer) InsertInteractiveAtCursor(TextVar string, LenVar int, Defau
 
 var xTextBufferInsertMarkup func(uintptr, *TextIter, string, int)
 
-// Inserts the text in @markup at position @iter.
+// Inserts the text in markup at position iter.
 //
-// @markup will be inserted in its entirety and must be nul-terminated
-// and valid UTF-8. Emits the [signal@Gtk.TextBuffer::insert-text] signal,
+// markup will be inserted in its entirety and must be nul-terminated
+// and valid UTF-8. Emits the [TextBuffer.ConnectInsertTextFunc] signal,
 // possibly multiple times; insertion actually occurs in the default handler
-// for the signal. @iter will point to the end of the inserted text on return.
+// for the signal. iter will point to the end of the inserted text on return.
 func (x *TextBuffer) InsertMarkup(IterVar *TextIter, MarkupVar string, LenVar int) {
 
 	xTextBufferInsertMarkup(x.GoPointer(), IterVar, MarkupVar, LenVar)
@@ -1462,15 +1466,15 @@ func (x *TextBuffer) InsertMarkup(IterVar *TextIter, MarkupVar string, LenVar in
 
 var xTextBufferInsertPaintable func(uintptr, *TextIter, uintptr)
 
-// Inserts an image into the text buffer at @iter.
+// Inserts an image into the text buffer at iter.
 //
 // The image will be counted as one character in character counts,
 // and when obtaining the buffer contents as a string, will be
 // represented by the Unicode “object replacement character” 0xFFFC.
 // Note that the “slice” variants for obtaining portions of the buffer
 // as a string include this character for paintable, but the “text”
-// variants do not. e.g. see [method@Gtk.TextBuffer.get_slice] and
-// [method@Gtk.TextBuffer.get_text].
+// variants do not. e.g. see [TextBuffer.GetSlice] and
+// [TextBuffer.GetText].
 func (x *TextBuffer) InsertPaintable(IterVar *TextIter, PaintableVar gdk.Paintable) {
 
 	xTextBufferInsertPaintable(x.GoPointer(), IterVar, PaintableVar.GoPointer())
@@ -1479,14 +1483,14 @@ func (x *TextBuffer) InsertPaintable(IterVar *TextIter, PaintableVar gdk.Paintab
 
 var xTextBufferInsertRange func(uintptr, *TextIter, *TextIter, *TextIter)
 
-// Copies text, tags, and paintables between @start and @end
-// and inserts the copy at @iter.
+// Copies text, tags, and paintables between start and end
+// and inserts the copy at iter.
 //
-// The order of @start and @end doesn’t matter.
+// The order of start and end doesn’t matter.
 //
 // Used instead of simply getting/inserting text because it preserves
-// images and tags. If @start and @end are in a different buffer from
-// @buffer, the two buffers must share the same tag table.
+// images and tags. If start and end are in a different buffer from
+// buffer, the two buffers must share the same tag table.
 //
 // Implemented via emissions of the ::insert-text and ::apply-tag signals,
 // so expect those.
@@ -1498,14 +1502,14 @@ func (x *TextBuffer) InsertRange(IterVar *TextIter, StartVar *TextIter, EndVar *
 
 var xTextBufferInsertRangeInteractive func(uintptr, *TextIter, *TextIter, *TextIter, bool) bool
 
-// Copies text, tags, and paintables between @start and @end
-// and inserts the copy at @iter.
+// Copies text, tags, and paintables between start and end
+// and inserts the copy at iter.
 //
-// Same as [method@Gtk.TextBuffer.insert_range], but does nothing
-// if the insertion point isn’t editable. The @default_editable
-// parameter indicates whether the text is editable at @iter if
-// no tags enclosing @iter affect editability. Typically the result
-// of [method@Gtk.TextView.get_editable] is appropriate here.
+// Same as [TextBuffer.InsertRange], but does nothing
+// if the insertion point isn’t editable. The default_editable
+// parameter indicates whether the text is editable at iter if
+// no tags enclosing iter affect editability. Typically the result
+// of [TextView.GetEditable] is appropriate here.
 func (x *TextBuffer) InsertRangeInteractive(IterVar *TextIter, StartVar *TextIter, EndVar *TextIter, DefaultEditableVar bool) bool {
 
 	cret := xTextBufferInsertRangeInteractive(x.GoPointer(), IterVar, StartVar, EndVar, DefaultEditableVar)
@@ -1514,12 +1518,12 @@ func (x *TextBuffer) InsertRangeInteractive(IterVar *TextIter, StartVar *TextIte
 
 var xTextBufferInsertWithTags func(uintptr, *TextIter, string, int, uintptr, ...interface{})
 
-// Inserts @text into @buffer at @iter, applying the list of tags to
+// Inserts text into buffer at iter, applying the list of tags to
 // the newly-inserted text.
 //
-// The last tag specified must be %NULL to terminate the list.
-// Equivalent to calling [method@Gtk.TextBuffer.insert],
-// then [method@Gtk.TextBuffer.apply_tag] on the inserted text;
+// The last tag specified must be nil to terminate the list.
+// Equivalent to calling [TextBuffer.Insert],
+// then [TextBuffer.ApplyTag] on the inserted text;
 // this is just a convenience function.
 func (x *TextBuffer) InsertWithTags(IterVar *TextIter, TextVar string, LenVar int, FirstTagVar *TextTag, varArgs ...interface{}) {
 
@@ -1529,10 +1533,10 @@ func (x *TextBuffer) InsertWithTags(IterVar *TextIter, TextVar string, LenVar in
 
 var xTextBufferInsertWithTagsByName func(uintptr, *TextIter, string, int, string, ...interface{})
 
-// Inserts @text into @buffer at @iter, applying the list of tags to
+// Inserts text into buffer at iter, applying the list of tags to
 // the newly-inserted text.
 //
-// Same as [method@Gtk.TextBuffer.insert_with_tags], but allows you
+// Same as [TextBuffer.InsertWithTags], but allows you
 // to pass in tag names instead of tag objects.
 func (x *TextBuffer) InsertWithTagsByName(IterVar *TextIter, TextVar string, LenVar int, FirstTagNameVar string, varArgs ...interface{}) {
 
@@ -1542,9 +1546,9 @@ func (x *TextBuffer) InsertWithTagsByName(IterVar *TextIter, TextVar string, Len
 
 var xTextBufferMoveMark func(uintptr, uintptr, *TextIter)
 
-// Moves @mark to the new location @where.
+// Moves mark to the new location where.
 //
-// Emits the [signal@Gtk.TextBuffer::mark-set] signal
+// Emits the [TextBuffer.ConnectMarkSetFunc] signal
 // as notification of the move.
 func (x *TextBuffer) MoveMark(MarkVar *TextMark, WhereVar *TextIter) {
 
@@ -1554,9 +1558,9 @@ func (x *TextBuffer) MoveMark(MarkVar *TextMark, WhereVar *TextIter) {
 
 var xTextBufferMoveMarkByName func(uintptr, string, *TextIter)
 
-// Moves the mark named @name (which must exist) to location @where.
+// Moves the mark named name (which must exist) to location where.
 //
-// See [method@Gtk.TextBuffer.move_mark] for details.
+// See [TextBuffer.MoveMark] for details.
 func (x *TextBuffer) MoveMarkByName(NameVar string, WhereVar *TextIter) {
 
 	xTextBufferMoveMarkByName(x.GoPointer(), NameVar, WhereVar)
@@ -1567,7 +1571,7 @@ var xTextBufferPasteClipboard func(uintptr, uintptr, *TextIter, bool)
 
 // Pastes the contents of a clipboard.
 //
-// If @override_location is %NULL, the pasted text will be inserted
+// If override_location is nil, the pasted text will be inserted
 // at the cursor position, or the buffer selection will be replaced
 // if the selection is non-empty.
 //
@@ -1586,7 +1590,7 @@ var xTextBufferPlaceCursor func(uintptr, *TextIter)
 // simultaneously.
 //
 // If you move them to the same place in two steps with
-// [method@Gtk.TextBuffer.move_mark], you will temporarily select a
+// [TextBuffer.MoveMark], you will temporarily select a
 // region in between their old and new locations, which can be pretty
 // inefficient since the temporarily-selected region will force stuff
 // to be recalculated. This function moves them as a unit, which can
@@ -1608,7 +1612,7 @@ func (x *TextBuffer) Redo() {
 
 var xTextBufferRemoveAllTags func(uintptr, *TextIter, *TextIter)
 
-// Removes all tags in the range between @start and @end.
+// Removes all tags in the range between start and end.
 //
 // Be careful with this function; it could remove tags added in code
 // unrelated to the code you’re currently writing. That is, using this
@@ -1623,7 +1627,7 @@ func (x *TextBuffer) RemoveAllTags(StartVar *TextIter, EndVar *TextIter) {
 var xTextBufferRemoveCommitNotify func(uintptr, uint)
 
 // Removes the `GtkTextBufferCommitNotify` handler previously registered
-// with [method@Gtk.TextBuffer.add_commit_notify].
+// with [TextBuffer.AddCommitNotify].
 //
 // This may result in the `user_data_destroy` being called that was passed when registering
 // the commit notify functions.
@@ -1636,7 +1640,7 @@ func (x *TextBuffer) RemoveCommitNotify(CommitNotifyHandlerVar uint) {
 var xTextBufferRemoveSelectionClipboard func(uintptr, uintptr)
 
 // Removes a `GdkClipboard` added with
-// [method@Gtk.TextBuffer.add_selection_clipboard]
+// [TextBuffer.AddSelectionClipboard]
 func (x *TextBuffer) RemoveSelectionClipboard(ClipboardVar *gdk.Clipboard) {
 
 	xTextBufferRemoveSelectionClipboard(x.GoPointer(), ClipboardVar.GoPointer())
@@ -1648,7 +1652,7 @@ var xTextBufferRemoveTag func(uintptr, uintptr, *TextIter, *TextIter)
 // Emits the “remove-tag” signal.
 //
 // The default handler for the signal removes all occurrences
-// of @tag from the given range. @start and @end don’t have
+// of tag from the given range. start and end don’t have
 // to be in order.
 func (x *TextBuffer) RemoveTag(TagVar *TextTag, StartVar *TextIter, EndVar *TextIter) {
 
@@ -1660,9 +1664,9 @@ var xTextBufferRemoveTagByName func(uintptr, string, *TextIter, *TextIter)
 
 // Emits the “remove-tag” signal.
 //
-// Calls [method@Gtk.TextTagTable.lookup] on the buffer’s
+// Calls [TextTagTable.Lookup] on the buffer’s
 // tag table to get a `GtkTextTag`, then calls
-// [method@Gtk.TextBuffer.remove_tag].
+// [TextBuffer.RemoveTag].
 func (x *TextBuffer) RemoveTagByName(NameVar string, StartVar *TextIter, EndVar *TextIter) {
 
 	xTextBufferRemoveTagByName(x.GoPointer(), NameVar, StartVar, EndVar)
@@ -1675,7 +1679,7 @@ var xTextBufferSelectRange func(uintptr, *TextIter, *TextIter)
 // simultaneously.
 //
 // If you move them in two steps with
-// [method@Gtk.TextBuffer.move_mark], you will temporarily select a
+// [TextBuffer.MoveMark], you will temporarily select a
 // region in between their old and new locations, which can be pretty
 // inefficient since the temporarily-selected region will force stuff
 // to be recalculated. This function moves them as a unit, which can
@@ -1694,10 +1698,10 @@ var xTextBufferSetEnableUndo func(uintptr, bool)
 // the buffer. Changes to tags and marks are not tracked.
 //
 // If enabled, the user will be able to undo the last number of actions
-// up to [method@Gtk.TextBuffer.get_max_undo_levels].
+// up to [TextBuffer.GetMaxUndoLevels].
 //
-// See [method@Gtk.TextBuffer.begin_irreversible_action] and
-// [method@Gtk.TextBuffer.end_irreversible_action] to create
+// See [TextBuffer.BeginIrreversibleAction] and
+// [TextBuffer.EndIrreversibleAction] to create
 // changes to the buffer that cannot be undone.
 func (x *TextBuffer) SetEnableUndo(EnableUndoVar bool) {
 
@@ -1724,11 +1728,11 @@ var xTextBufferSetModified func(uintptr, bool)
 // modified since the last time it was saved.
 //
 // Whenever the buffer is saved to disk, call
-// `gtk_text_buffer_set_modified (@buffer, FALSE)`.
+// `gtk_text_buffer_set_modified (buffer, FALSE)`.
 // When the buffer is modified, it will automatically
 // toggle on the modified bit again. When the modified
 // bit flips, the buffer emits the
-// [signal@Gtk.TextBuffer::modified-changed] signal.
+// [TextBuffer.ConnectModifiedChangedFunc] signal.
 func (x *TextBuffer) SetModified(SettingVar bool) {
 
 	xTextBufferSetModified(x.GoPointer(), SettingVar)
@@ -1737,13 +1741,13 @@ func (x *TextBuffer) SetModified(SettingVar bool) {
 
 var xTextBufferSetText func(uintptr, string, int)
 
-// Deletes current contents of @buffer, and inserts @text instead. This is
+// Deletes current contents of buffer, and inserts text instead. This is
 // automatically marked as an irreversible action in the undo stack. If you
 // wish to mark this action as part of a larger undo operation, call
-// [method@TextBuffer.delete] and [method@TextBuffer.insert] directly instead.
+// delete and insert directly instead.
 //
-// If @len is -1, @text must be nul-terminated.
-// @text must be valid UTF-8.
+// If len is -1, text must be nul-terminated.
+// text must be valid UTF-8.
 func (x *TextBuffer) SetText(TextVar string, LenVar int) {
 
 	xTextBufferSetText(x.GoPointer(), TextVar, LenVar)
@@ -1826,7 +1830,7 @@ func (x *TextBuffer) GetPropertyHasSelection() bool {
 // The text content of the buffer.
 //
 // Without child widgets and images,
-// see [method@Gtk.TextBuffer.get_text] for more information.
+// see [TextBuffer.GetText] for more information.
 func (x *TextBuffer) SetPropertyText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1838,7 +1842,7 @@ func (x *TextBuffer) SetPropertyText(value string) {
 // The text content of the buffer.
 //
 // Without child widgets and images,
-// see [method@Gtk.TextBuffer.get_text] for more information.
+// see [TextBuffer.GetText] for more information.
 func (x *TextBuffer) GetPropertyText() string {
 	var v gobject.Value
 	x.GetProperty("text", &v)
@@ -1850,159 +1854,269 @@ func (x *TextBuffer) GetPropertyText() string {
 // Applying actually occurs in the default handler.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @start and @end iters (or has to
+// it must not invalidate the start and end iters (or has to
 // revalidate them).
 //
 // See also:
-// [method@Gtk.TextBuffer.apply_tag],
-// [method@Gtk.TextBuffer.insert_with_tags],
-// [method@Gtk.TextBuffer.insert_range].
+// [TextBuffer.ApplyTag],
+// [TextBuffer.InsertWithTags],
+// [TextBuffer.InsertRange].
+//
+// Deprecated: use ConnectApplyTagFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectApplyTag(cb *func(TextBuffer, uintptr, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "apply-tag", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TagVarp, StartVarp, EndVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "apply-tag", cbRefPtr, cbPtr)
+}
+
+var xTextBufferApplyTagTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr) {
+func xTextBufferApplyTagNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TagVarp, StartVarp, EndVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "apply-tag", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectApplyTagFunc connects cb to the "apply-tag" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectApplyTagFunc(cb func(TextBuffer, uintptr, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "apply-tag", &xTextBufferApplyTagTrampoline, xTextBufferApplyTagNewTrampoline, cb)
 }
 
 // Emitted at the beginning of a single user-visible
 // operation on a `GtkTextBuffer`.
 //
 // See also:
-// [method@Gtk.TextBuffer.begin_user_action],
-// [method@Gtk.TextBuffer.insert_interactive],
-// [method@Gtk.TextBuffer.insert_range_interactive],
-// [method@Gtk.TextBuffer.delete_interactive],
-// [method@Gtk.TextBuffer.backspace],
-// [method@Gtk.TextBuffer.delete_selection].
+// [TextBuffer.BeginUserAction],
+// [TextBuffer.InsertInteractive],
+// [TextBuffer.InsertRangeInteractive],
+// [TextBuffer.DeleteInteractive],
+// [TextBuffer.Backspace],
+// [TextBuffer.DeleteSelection].
+//
+// Deprecated: use ConnectBeginUserActionFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectBeginUserAction(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "begin-user-action", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "begin-user-action", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextBufferBeginUserActionTrampoline uintptr
+
+func xTextBufferBeginUserActionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "begin-user-action", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBeginUserActionFunc connects cb to the "begin-user-action" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectBeginUserActionFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "begin-user-action", &xTextBufferBeginUserActionTrampoline, xTextBufferBeginUserActionNewTrampoline, cb)
 }
 
 // Emitted when the content of a `GtkTextBuffer` has changed.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectChanged(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextBufferChangedTrampoline uintptr
+
+func xTextBufferChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectChangedFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xTextBufferChangedTrampoline, xTextBufferChangedNewTrampoline, cb)
 }
 
 // Emitted to delete a range from a `GtkTextBuffer`.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @start and @end iters (or has
+// it must not invalidate the start and end iters (or has
 // to revalidate them). The default signal handler revalidates
-// the @start and @end iters to both point to the location
+// the start and end iters to both point to the location
 // where text was deleted. Handlers which run after the default
 // handler (see g_signal_connect_after()) do not have access to
 // the deleted text.
 //
-// See also: [method@Gtk.TextBuffer.delete].
+// See also: [TextBuffer.Delete].
+//
+// Deprecated: use ConnectDeleteRangeFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectDeleteRange(cb *func(TextBuffer, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "delete-range", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StartVarp uintptr, EndVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StartVarp, EndVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "delete-range", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StartVarp uintptr, EndVarp uintptr) {
+var xTextBufferDeleteRangeTrampoline uintptr
+
+func xTextBufferDeleteRangeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StartVarp uintptr, EndVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StartVarp, EndVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "delete-range", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDeleteRangeFunc connects cb to the "delete-range" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectDeleteRangeFunc(cb func(TextBuffer, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "delete-range", &xTextBufferDeleteRangeTrampoline, xTextBufferDeleteRangeNewTrampoline, cb)
 }
 
 // Emitted at the end of a single user-visible
 // operation on the `GtkTextBuffer`.
 //
 // See also:
-// [method@Gtk.TextBuffer.end_user_action],
-// [method@Gtk.TextBuffer.insert_interactive],
-// [method@Gtk.TextBuffer.insert_range_interactive],
-// [method@Gtk.TextBuffer.delete_interactive],
-// [method@Gtk.TextBuffer.backspace],
-// [method@Gtk.TextBuffer.delete_selection],
-// [method@Gtk.TextBuffer.backspace].
+// [TextBuffer.EndUserAction],
+// [TextBuffer.InsertInteractive],
+// [TextBuffer.InsertRangeInteractive],
+// [TextBuffer.DeleteInteractive],
+// [TextBuffer.Backspace],
+// [TextBuffer.DeleteSelection],
+// [TextBuffer.Backspace].
+//
+// Deprecated: use ConnectEndUserActionFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectEndUserAction(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "end-user-action", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "end-user-action", cbRefPtr, cbPtr)
+}
+
+var xTextBufferEndUserActionTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextBufferEndUserActionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "end-user-action", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEndUserActionFunc connects cb to the "end-user-action" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectEndUserActionFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "end-user-action", &xTextBufferEndUserActionTrampoline, xTextBufferEndUserActionNewTrampoline, cb)
 }
 
 // Emitted to insert a `GtkTextChildAnchor` in a `GtkTextBuffer`.
@@ -2010,32 +2124,54 @@ func (x *TextBuffer) ConnectEndUserAction(cb *func(TextBuffer)) uint {
 // Insertion actually occurs in the default handler.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @location iter (or has to
+// it must not invalidate the location iter (or has to
 // revalidate it). The default signal handler revalidates
-// it to be placed after the inserted @anchor.
+// it to be placed after the inserted anchor.
+//
+// See also: [TextBuffer.InsertChildAnchor].
 //
-// See also: [method@Gtk.TextBuffer.insert_child_anchor].
+// Deprecated: use ConnectInsertChildAnchorFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectInsertChildAnchor(cb *func(TextBuffer, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-child-anchor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LocationVarp uintptr, AnchorVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, LocationVarp, AnchorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-child-anchor", cbRefPtr, cbPtr)
+}
+
+var xTextBufferInsertChildAnchorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, LocationVarp uintptr, AnchorVarp uintptr) {
+func xTextBufferInsertChildAnchorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LocationVarp uintptr, AnchorVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, LocationVarp, AnchorVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-child-anchor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertChildAnchorFunc connects cb to the "insert-child-anchor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectInsertChildAnchorFunc(cb func(TextBuffer, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-child-anchor", &xTextBufferInsertChildAnchorTrampoline, xTextBufferInsertChildAnchorNewTrampoline, cb)
 }
 
 // Emitted to insert a `GdkPaintable` in a `GtkTextBuffer`.
@@ -2043,32 +2179,54 @@ func (x *TextBuffer) ConnectInsertChildAnchor(cb *func(TextBuffer, uintptr, uint
 // Insertion actually occurs in the default handler.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @location iter (or has to
+// it must not invalidate the location iter (or has to
 // revalidate it). The default signal handler revalidates
-// it to be placed after the inserted @paintable.
+// it to be placed after the inserted paintable.
+//
+// See also: [TextBuffer.InsertPaintable].
 //
-// See also: [method@Gtk.TextBuffer.insert_paintable].
+// Deprecated: use ConnectInsertPaintableFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectInsertPaintable(cb *func(TextBuffer, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-paintable", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LocationVarp uintptr, PaintableVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, LocationVarp, PaintableVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-paintable", cbRefPtr, cbPtr)
+}
+
+var xTextBufferInsertPaintableTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, LocationVarp uintptr, PaintableVarp uintptr) {
+func xTextBufferInsertPaintableNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LocationVarp uintptr, PaintableVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, LocationVarp, PaintableVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-paintable", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertPaintableFunc connects cb to the "insert-paintable" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectInsertPaintableFunc(cb func(TextBuffer, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-paintable", &xTextBufferInsertPaintableTrampoline, xTextBufferInsertPaintableNewTrampoline, cb)
 }
 
 // Emitted to insert text in a `GtkTextBuffer`.
@@ -2076,225 +2234,401 @@ func (x *TextBuffer) ConnectInsertPaintable(cb *func(TextBuffer, uintptr, uintpt
 // Insertion actually occurs in the default handler.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @location iter (or has to
+// it must not invalidate the location iter (or has to
 // revalidate it). The default signal handler revalidates
 // it to point to the end of the inserted text.
 //
-// See also: [method@Gtk.TextBuffer.insert],
-// [method@Gtk.TextBuffer.insert_range].
+// See also: [TextBuffer.Insert],
+// [TextBuffer.InsertRange].
+//
+// Deprecated: use ConnectInsertTextFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectInsertText(cb *func(TextBuffer, uintptr, string, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-text", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LocationVarp uintptr, TextVarp string, LenVarp int) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, LocationVarp, TextVarp, LenVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-text", cbRefPtr, cbPtr)
+}
+
+var xTextBufferInsertTextTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, LocationVarp uintptr, TextVarp string, LenVarp int) {
+func xTextBufferInsertTextNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LocationVarp uintptr, TextVarp string, LenVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, string, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, LocationVarp, TextVarp, LenVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-text", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertTextFunc connects cb to the "insert-text" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectInsertTextFunc(cb func(TextBuffer, uintptr, string, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-text", &xTextBufferInsertTextTrampoline, xTextBufferInsertTextNewTrampoline, cb)
 }
 
 // Emitted as notification after a `GtkTextMark` is deleted.
 //
-// See also: [method@Gtk.TextBuffer.delete_mark].
+// See also: [TextBuffer.DeleteMark].
+//
+// Deprecated: use ConnectMarkDeletedFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectMarkDeleted(cb *func(TextBuffer, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mark-deleted", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, MarkVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, MarkVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mark-deleted", cbRefPtr, cbPtr)
+}
+
+var xTextBufferMarkDeletedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, MarkVarp uintptr) {
+func xTextBufferMarkDeletedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, MarkVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, MarkVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mark-deleted", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMarkDeletedFunc connects cb to the "mark-deleted" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectMarkDeletedFunc(cb func(TextBuffer, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mark-deleted", &xTextBufferMarkDeletedTrampoline, xTextBufferMarkDeletedNewTrampoline, cb)
 }
 
 // Emitted as notification after a `GtkTextMark` is set.
 //
 // See also:
-// [method@Gtk.TextBuffer.create_mark],
-// [method@Gtk.TextBuffer.move_mark].
+// [TextBuffer.CreateMark],
+// [TextBuffer.MoveMark].
+//
+// Deprecated: use ConnectMarkSetFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectMarkSet(cb *func(TextBuffer, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mark-set", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LocationVarp uintptr, MarkVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, LocationVarp, MarkVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mark-set", cbRefPtr, cbPtr)
+}
+
+var xTextBufferMarkSetTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, LocationVarp uintptr, MarkVarp uintptr) {
+func xTextBufferMarkSetNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LocationVarp uintptr, MarkVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, LocationVarp, MarkVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mark-set", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMarkSetFunc connects cb to the "mark-set" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectMarkSetFunc(cb func(TextBuffer, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mark-set", &xTextBufferMarkSetTrampoline, xTextBufferMarkSetNewTrampoline, cb)
 }
 
 // Emitted when the modified bit of a `GtkTextBuffer` flips.
 //
-// See also: [method@Gtk.TextBuffer.set_modified].
+// See also: [TextBuffer.SetModified].
+//
+// Deprecated: use ConnectModifiedChangedFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectModifiedChanged(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "modified-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "modified-changed", cbRefPtr, cbPtr)
+}
+
+var xTextBufferModifiedChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextBufferModifiedChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "modified-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectModifiedChangedFunc connects cb to the "modified-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectModifiedChangedFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "modified-changed", &xTextBufferModifiedChangedTrampoline, xTextBufferModifiedChangedNewTrampoline, cb)
 }
 
 // Emitted after paste operation has been completed.
 //
 // This is useful to properly scroll the view to the end
-// of the pasted text. See [method@Gtk.TextBuffer.paste_clipboard]
+// of the pasted text. See [TextBuffer.PasteClipboard]
 // for more details.
+//
+// Deprecated: use ConnectPasteDoneFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectPasteDone(cb *func(TextBuffer, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "paste-done", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ClipboardVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ClipboardVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "paste-done", cbRefPtr, cbPtr)
+}
+
+var xTextBufferPasteDoneTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ClipboardVarp uintptr) {
+func xTextBufferPasteDoneNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ClipboardVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, ClipboardVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "paste-done", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPasteDoneFunc connects cb to the "paste-done" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectPasteDoneFunc(cb func(TextBuffer, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "paste-done", &xTextBufferPasteDoneTrampoline, xTextBufferPasteDoneNewTrampoline, cb)
 }
 
 // Emitted when a request has been made to redo the
 // previously undone operation.
+//
+// Deprecated: use ConnectRedoFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectRedo(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "redo", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "redo", cbRefPtr, cbPtr)
+}
+
+var xTextBufferRedoTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextBufferRedoNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "redo", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted to remove all occurrences of @tag from a range
+// ConnectRedoFunc connects cb to the "redo" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectRedoFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "redo", &xTextBufferRedoTrampoline, xTextBufferRedoNewTrampoline, cb)
+}
+
+// Emitted to remove all occurrences of tag from a range
 // of text in a `GtkTextBuffer`.
 //
 // Removal actually occurs in the default handler.
 //
 // Note that if your handler runs before the default handler
-// it must not invalidate the @start and @end iters (or has
+// it must not invalidate the start and end iters (or has
 // to revalidate them).
 //
-// See also: [method@Gtk.TextBuffer.remove_tag].
+// See also: [TextBuffer.RemoveTag].
+//
+// Deprecated: use ConnectRemoveTagFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectRemoveTag(cb *func(TextBuffer, uintptr, uintptr, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "remove-tag", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TagVarp, StartVarp, EndVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "remove-tag", cbRefPtr, cbPtr)
+}
+
+var xTextBufferRemoveTagTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr) {
+func xTextBufferRemoveTagNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TagVarp uintptr, StartVarp uintptr, EndVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer, uintptr, uintptr, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TagVarp, StartVarp, EndVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "remove-tag", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectRemoveTagFunc connects cb to the "remove-tag" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectRemoveTagFunc(cb func(TextBuffer, uintptr, uintptr, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "remove-tag", &xTextBufferRemoveTagTrampoline, xTextBufferRemoveTagNewTrampoline, cb)
 }
 
 // Emitted when a request has been made to undo the
 // previous operation or set of operations that have
 // been grouped together.
+//
+// Deprecated: use ConnectUndoFunc, which also accepts method values and closures.
 func (x *TextBuffer) ConnectUndo(cb *func(TextBuffer)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "undo", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextBuffer{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "undo", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextBufferUndoTrampoline uintptr
+
+func xTextBufferUndoNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextBuffer))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextBuffer{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "undo", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUndoFunc connects cb to the "undo" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextBuffer) ConnectUndoFunc(cb func(TextBuffer)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "undo", &xTextBufferUndoTrampoline, xTextBufferUndoNewTrampoline, cb)
 }
 
 func init() {
@@ -2302,7 +2636,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2311,79 +2645,81 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextBufferGLibType, libs, "gtk_text_buffer_get_type")
 
-	core.PuregoSafeRegister(&xNewTextBuffer, libs, "gtk_text_buffer_new")
-
-	core.PuregoSafeRegister(&xTextBufferAddCommitNotify, libs, "gtk_text_buffer_add_commit_notify")
-	core.PuregoSafeRegister(&xTextBufferAddMark, libs, "gtk_text_buffer_add_mark")
-	core.PuregoSafeRegister(&xTextBufferAddSelectionClipboard, libs, "gtk_text_buffer_add_selection_clipboard")
-	core.PuregoSafeRegister(&xTextBufferApplyTag, libs, "gtk_text_buffer_apply_tag")
-	core.PuregoSafeRegister(&xTextBufferApplyTagByName, libs, "gtk_text_buffer_apply_tag_by_name")
-	core.PuregoSafeRegister(&xTextBufferBackspace, libs, "gtk_text_buffer_backspace")
-	core.PuregoSafeRegister(&xTextBufferBeginIrreversibleAction, libs, "gtk_text_buffer_begin_irreversible_action")
-	core.PuregoSafeRegister(&xTextBufferBeginUserAction, libs, "gtk_text_buffer_begin_user_action")
-	core.PuregoSafeRegister(&xTextBufferCopyClipboard, libs, "gtk_text_buffer_copy_clipboard")
-	core.PuregoSafeRegister(&xTextBufferCreateChildAnchor, libs, "gtk_text_buffer_create_child_anchor")
-	core.PuregoSafeRegister(&xTextBufferCreateMark, libs, "gtk_text_buffer_create_mark")
-	core.PuregoSafeRegister(&xTextBufferCreateTag, libs, "gtk_text_buffer_create_tag")
-	core.PuregoSafeRegister(&xTextBufferCutClipboard, libs, "gtk_text_buffer_cut_clipboard")
-	core.PuregoSafeRegister(&xTextBufferDelete, libs, "gtk_text_buffer_delete")
-	core.PuregoSafeRegister(&xTextBufferDeleteInteractive, libs, "gtk_text_buffer_delete_interactive")
-	core.PuregoSafeRegister(&xTextBufferDeleteMark, libs, "gtk_text_buffer_delete_mark")
-	core.PuregoSafeRegister(&xTextBufferDeleteMarkByName, libs, "gtk_text_buffer_delete_mark_by_name")
-	core.PuregoSafeRegister(&xTextBufferDeleteSelection, libs, "gtk_text_buffer_delete_selection")
-	core.PuregoSafeRegister(&xTextBufferEndIrreversibleAction, libs, "gtk_text_buffer_end_irreversible_action")
-	core.PuregoSafeRegister(&xTextBufferEndUserAction, libs, "gtk_text_buffer_end_user_action")
-	core.PuregoSafeRegister(&xTextBufferGetBounds, libs, "gtk_text_buffer_get_bounds")
-	core.PuregoSafeRegister(&xTextBufferGetCanRedo, libs, "gtk_text_buffer_get_can_redo")
-	core.PuregoSafeRegister(&xTextBufferGetCanUndo, libs, "gtk_text_buffer_get_can_undo")
-	core.PuregoSafeRegister(&xTextBufferGetCharCount, libs, "gtk_text_buffer_get_char_count")
-	core.PuregoSafeRegister(&xTextBufferGetEnableUndo, libs, "gtk_text_buffer_get_enable_undo")
-	core.PuregoSafeRegister(&xTextBufferGetEndIter, libs, "gtk_text_buffer_get_end_iter")
-	core.PuregoSafeRegister(&xTextBufferGetHasSelection, libs, "gtk_text_buffer_get_has_selection")
-	core.PuregoSafeRegister(&xTextBufferGetInsert, libs, "gtk_text_buffer_get_insert")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtChildAnchor, libs, "gtk_text_buffer_get_iter_at_child_anchor")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtLine, libs, "gtk_text_buffer_get_iter_at_line")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtLineIndex, libs, "gtk_text_buffer_get_iter_at_line_index")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtLineOffset, libs, "gtk_text_buffer_get_iter_at_line_offset")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtMark, libs, "gtk_text_buffer_get_iter_at_mark")
-	core.PuregoSafeRegister(&xTextBufferGetIterAtOffset, libs, "gtk_text_buffer_get_iter_at_offset")
-	core.PuregoSafeRegister(&xTextBufferGetLineCount, libs, "gtk_text_buffer_get_line_count")
-	core.PuregoSafeRegister(&xTextBufferGetMark, libs, "gtk_text_buffer_get_mark")
-	core.PuregoSafeRegister(&xTextBufferGetMaxUndoLevels, libs, "gtk_text_buffer_get_max_undo_levels")
-	core.PuregoSafeRegister(&xTextBufferGetModified, libs, "gtk_text_buffer_get_modified")
-	core.PuregoSafeRegister(&xTextBufferGetSelectionBound, libs, "gtk_text_buffer_get_selection_bound")
-	core.PuregoSafeRegister(&xTextBufferGetSelectionBounds, libs, "gtk_text_buffer_get_selection_bounds")
-	core.PuregoSafeRegister(&xTextBufferGetSelectionContent, libs, "gtk_text_buffer_get_selection_content")
-	core.PuregoSafeRegister(&xTextBufferGetSlice, libs, "gtk_text_buffer_get_slice")
-	core.PuregoSafeRegister(&xTextBufferGetStartIter, libs, "gtk_text_buffer_get_start_iter")
-	core.PuregoSafeRegister(&xTextBufferGetTagTable, libs, "gtk_text_buffer_get_tag_table")
-	core.PuregoSafeRegister(&xTextBufferGetText, libs, "gtk_text_buffer_get_text")
-	core.PuregoSafeRegister(&xTextBufferInsert, libs, "gtk_text_buffer_insert")
-	core.PuregoSafeRegister(&xTextBufferInsertAtCursor, libs, "gtk_text_buffer_insert_at_cursor")
-	core.PuregoSafeRegister(&xTextBufferInsertChildAnchor, libs, "gtk_text_buffer_insert_child_anchor")
-	core.PuregoSafeRegister(&xTextBufferInsertInteractive, libs, "gtk_text_buffer_insert_interactive")
-	core.PuregoSafeRegister(&xTextBufferInsertInteractiveAtCursor, libs, "gtk_text_buffer_insert_interactive_at_cursor")
-	core.PuregoSafeRegister(&xTextBufferInsertMarkup, libs, "gtk_text_buffer_insert_markup")
-	core.PuregoSafeRegister(&xTextBufferInsertPaintable, libs, "gtk_text_buffer_insert_paintable")
-	core.PuregoSafeRegister(&xTextBufferInsertRange, libs, "gtk_text_buffer_insert_range")
-	core.PuregoSafeRegister(&xTextBufferInsertRangeInteractive, libs, "gtk_text_buffer_insert_range_interactive")
-	core.PuregoSafeRegister(&xTextBufferInsertWithTags, libs, "gtk_text_buffer_insert_with_tags")
-	core.PuregoSafeRegister(&xTextBufferInsertWithTagsByName, libs, "gtk_text_buffer_insert_with_tags_by_name")
-	core.PuregoSafeRegister(&xTextBufferMoveMark, libs, "gtk_text_buffer_move_mark")
-	core.PuregoSafeRegister(&xTextBufferMoveMarkByName, libs, "gtk_text_buffer_move_mark_by_name")
-	core.PuregoSafeRegister(&xTextBufferPasteClipboard, libs, "gtk_text_buffer_paste_clipboard")
-	core.PuregoSafeRegister(&xTextBufferPlaceCursor, libs, "gtk_text_buffer_place_cursor")
-	core.PuregoSafeRegister(&xTextBufferRedo, libs, "gtk_text_buffer_redo")
-	core.PuregoSafeRegister(&xTextBufferRemoveAllTags, libs, "gtk_text_buffer_remove_all_tags")
-	core.PuregoSafeRegister(&xTextBufferRemoveCommitNotify, libs, "gtk_text_buffer_remove_commit_notify")
-	core.PuregoSafeRegister(&xTextBufferRemoveSelectionClipboard, libs, "gtk_text_buffer_remove_selection_clipboard")
-	core.PuregoSafeRegister(&xTextBufferRemoveTag, libs, "gtk_text_buffer_remove_tag")
-	core.PuregoSafeRegister(&xTextBufferRemoveTagByName, libs, "gtk_text_buffer_remove_tag_by_name")
-	core.PuregoSafeRegister(&xTextBufferSelectRange, libs, "gtk_text_buffer_select_range")
-	core.PuregoSafeRegister(&xTextBufferSetEnableUndo, libs, "gtk_text_buffer_set_enable_undo")
-	core.PuregoSafeRegister(&xTextBufferSetMaxUndoLevels, libs, "gtk_text_buffer_set_max_undo_levels")
-	core.PuregoSafeRegister(&xTextBufferSetModified, libs, "gtk_text_buffer_set_modified")
-	core.PuregoSafeRegister(&xTextBufferSetText, libs, "gtk_text_buffer_set_text")
-	core.PuregoSafeRegister(&xTextBufferUndo, libs, "gtk_text_buffer_undo")
+	gobject.CheckClassSize("GtkTextBufferClass", xTextBufferGLibType, unsafe.Sizeof(TextBufferClass{}))
+
+	core.PuregoRegisterSince(&xNewTextBuffer, libs, "gtk_text_buffer_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xTextBufferAddCommitNotify, libs, "gtk_text_buffer_add_commit_notify", "GTK", "4.16")
+	core.PuregoRegisterSince(&xTextBufferAddMark, libs, "gtk_text_buffer_add_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferAddSelectionClipboard, libs, "gtk_text_buffer_add_selection_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferApplyTag, libs, "gtk_text_buffer_apply_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferApplyTagByName, libs, "gtk_text_buffer_apply_tag_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferBackspace, libs, "gtk_text_buffer_backspace", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferBeginIrreversibleAction, libs, "gtk_text_buffer_begin_irreversible_action", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferBeginUserAction, libs, "gtk_text_buffer_begin_user_action", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferCopyClipboard, libs, "gtk_text_buffer_copy_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferCreateChildAnchor, libs, "gtk_text_buffer_create_child_anchor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferCreateMark, libs, "gtk_text_buffer_create_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferCreateTag, libs, "gtk_text_buffer_create_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferCutClipboard, libs, "gtk_text_buffer_cut_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferDelete, libs, "gtk_text_buffer_delete", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferDeleteInteractive, libs, "gtk_text_buffer_delete_interactive", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferDeleteMark, libs, "gtk_text_buffer_delete_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferDeleteMarkByName, libs, "gtk_text_buffer_delete_mark_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferDeleteSelection, libs, "gtk_text_buffer_delete_selection", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferEndIrreversibleAction, libs, "gtk_text_buffer_end_irreversible_action", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferEndUserAction, libs, "gtk_text_buffer_end_user_action", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetBounds, libs, "gtk_text_buffer_get_bounds", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetCanRedo, libs, "gtk_text_buffer_get_can_redo", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetCanUndo, libs, "gtk_text_buffer_get_can_undo", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetCharCount, libs, "gtk_text_buffer_get_char_count", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetEnableUndo, libs, "gtk_text_buffer_get_enable_undo", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetEndIter, libs, "gtk_text_buffer_get_end_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetHasSelection, libs, "gtk_text_buffer_get_has_selection", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetInsert, libs, "gtk_text_buffer_get_insert", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtChildAnchor, libs, "gtk_text_buffer_get_iter_at_child_anchor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtLine, libs, "gtk_text_buffer_get_iter_at_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtLineIndex, libs, "gtk_text_buffer_get_iter_at_line_index", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtLineOffset, libs, "gtk_text_buffer_get_iter_at_line_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtMark, libs, "gtk_text_buffer_get_iter_at_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetIterAtOffset, libs, "gtk_text_buffer_get_iter_at_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetLineCount, libs, "gtk_text_buffer_get_line_count", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetMark, libs, "gtk_text_buffer_get_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetMaxUndoLevels, libs, "gtk_text_buffer_get_max_undo_levels", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetModified, libs, "gtk_text_buffer_get_modified", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetSelectionBound, libs, "gtk_text_buffer_get_selection_bound", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetSelectionBounds, libs, "gtk_text_buffer_get_selection_bounds", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetSelectionContent, libs, "gtk_text_buffer_get_selection_content", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetSlice, libs, "gtk_text_buffer_get_slice", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetStartIter, libs, "gtk_text_buffer_get_start_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetTagTable, libs, "gtk_text_buffer_get_tag_table", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferGetText, libs, "gtk_text_buffer_get_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsert, libs, "gtk_text_buffer_insert", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertAtCursor, libs, "gtk_text_buffer_insert_at_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertChildAnchor, libs, "gtk_text_buffer_insert_child_anchor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertInteractive, libs, "gtk_text_buffer_insert_interactive", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertInteractiveAtCursor, libs, "gtk_text_buffer_insert_interactive_at_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertMarkup, libs, "gtk_text_buffer_insert_markup", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertPaintable, libs, "gtk_text_buffer_insert_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertRange, libs, "gtk_text_buffer_insert_range", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertRangeInteractive, libs, "gtk_text_buffer_insert_range_interactive", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertWithTags, libs, "gtk_text_buffer_insert_with_tags", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferInsertWithTagsByName, libs, "gtk_text_buffer_insert_with_tags_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferMoveMark, libs, "gtk_text_buffer_move_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferMoveMarkByName, libs, "gtk_text_buffer_move_mark_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferPasteClipboard, libs, "gtk_text_buffer_paste_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferPlaceCursor, libs, "gtk_text_buffer_place_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferRedo, libs, "gtk_text_buffer_redo", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferRemoveAllTags, libs, "gtk_text_buffer_remove_all_tags", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferRemoveCommitNotify, libs, "gtk_text_buffer_remove_commit_notify", "GTK", "4.16")
+	core.PuregoRegisterSince(&xTextBufferRemoveSelectionClipboard, libs, "gtk_text_buffer_remove_selection_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferRemoveTag, libs, "gtk_text_buffer_remove_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferRemoveTagByName, libs, "gtk_text_buffer_remove_tag_by_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferSelectRange, libs, "gtk_text_buffer_select_range", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferSetEnableUndo, libs, "gtk_text_buffer_set_enable_undo", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferSetMaxUndoLevels, libs, "gtk_text_buffer_set_max_undo_levels", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferSetModified, libs, "gtk_text_buffer_set_modified", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferSetText, libs, "gtk_text_buffer_set_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextBufferUndo, libs, "gtk_text_buffer_undo", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -34,7 +33,8 @@ func (x *TextChildAnchorClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -46,7 +46,7 @@ func (x *TextChildAnchorClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -57,7 +57,8 @@ func (x *TextChildAnchorClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -69,7 +70,7 @@ func (x *TextChildAnchorClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -80,7 +81,8 @@ func (x *TextChildAnchorClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -92,7 +94,7 @@ func (x *TextChildAnchorClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -103,7 +105,8 @@ func (x *TextChildAnchorClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -115,7 +118,7 @@ func (x *TextChildAnchorClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -145,9 +148,9 @@ var xNewTextChildAnchor func() uintptr
 // Creates a new `GtkTextChildAnchor`.
 //
 // Usually you would then insert it into a `GtkTextBuffer` with
-// [method@Gtk.TextBuffer.insert_child_anchor]. To perform the
+// [TextBuffer.InsertChildAnchor]. To perform the
 // creation and insertion in one step, use the convenience
-// function [method@Gtk.TextBuffer.create_child_anchor].
+// function [TextBuffer.CreateChildAnchor].
 func NewTextChildAnchor() *TextChildAnchor {
 	var cls *TextChildAnchor
 
@@ -166,7 +169,7 @@ var xNewTextChildAnchorWithReplacement func(string) uintptr
 // Creates a new `GtkTextChildAnchor` with the given replacement character.
 //
 // Usually you would then insert it into a `GtkTextBuffer` with
-// [method@Gtk.TextBuffer.insert_child_anchor].
+// [TextBuffer.InsertChildAnchor].
 func NewTextChildAnchorWithReplacement(CharacterVar string) *TextChildAnchor {
 	var cls *TextChildAnchor
 
@@ -187,7 +190,7 @@ var xTextChildAnchorGetDeleted func(uintptr) bool
 //
 // Keep in mind that the child anchor will be unreferenced
 // when removed from the buffer, so you need to hold your own
-// reference (with g_object_ref()) if you plan to use this
+// reference (with [gobject.Object.Ref]) if you plan to use this
 // function — otherwise all deleted child anchors will also
 // be finalized.
 func (x *TextChildAnchor) GetDeleted() bool {
@@ -223,7 +226,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -232,10 +235,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextChildAnchorGLibType, libs, "gtk_text_child_anchor_get_type")
 
-	core.PuregoSafeRegister(&xNewTextChildAnchor, libs, "gtk_text_child_anchor_new")
-	core.PuregoSafeRegister(&xNewTextChildAnchorWithReplacement, libs, "gtk_text_child_anchor_new_with_replacement")
+	gobject.CheckClassSize("GtkTextChildAnchorClass", xTextChildAnchorGLibType, unsafe.Sizeof(TextChildAnchorClass{}))
 
-	core.PuregoSafeRegister(&xTextChildAnchorGetDeleted, libs, "gtk_text_child_anchor_get_deleted")
-	core.PuregoSafeRegister(&xTextChildAnchorGetWidgets, libs, "gtk_text_child_anchor_get_widgets")
+	core.PuregoRegisterSince(&xNewTextChildAnchor, libs, "gtk_text_child_anchor_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTextChildAnchorWithReplacement, libs, "gtk_text_child_anchor_new_with_replacement", "GTK", "4.6")
+
+	core.PuregoRegisterSince(&xTextChildAnchorGetDeleted, libs, "gtk_text_child_anchor_get_deleted", "GTK", "")
+	core.PuregoRegisterSince(&xTextChildAnchorGetWidgets, libs, "gtk_text_child_anchor_get_widgets", "GTK", "")
 
 }
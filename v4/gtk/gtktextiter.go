@@ -2,10 +2,11 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,14 +15,14 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/pango"
 )
 
-// The predicate function used by gtk_text_iter_forward_find_char() and
-// gtk_text_iter_backward_find_char().
-type TextCharPredicate func(uint32, uintptr) bool
+// The predicate function used by [TextIter.ForwardFindChar] and
+// [TextIter.BackwardFindChar].
+type TextCharPredicate func(rune, uintptr) bool
 
 // Iterates over the contents of a `GtkTextBuffer`.
 //
 // You may wish to begin by reading the
-// [text widget conceptual overview](section-text-widget.html),
+// text widget conceptual overview,
 // which gives an overview of all the objects and data types
 // related to the text widget and how they work together.
 type TextIter struct {
@@ -66,9 +67,17 @@ func (x *TextIter) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewTextIterAlloc returns a zeroed TextIter allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated TextIter. Release it with Free.
+func NewTextIterAlloc() *TextIter {
+	cret := core.GMalloc0(max(unsafe.Sizeof(TextIter{}), 112))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*TextIter)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xTextIterAssign func(uintptr, *TextIter)
 
-// Assigns the value of @other to @iter.
+// Assigns the value of other to iter.
 //
 // This function is not useful in applications, because
 // iterators can be assigned with `GtkTextIter i = j;`.
@@ -84,8 +93,8 @@ var xTextIterBackwardChar func(uintptr) bool
 
 // Moves backward by one character offset.
 //
-// Returns %TRUE if movement was possible; if @iter was the first
-// in the buffer (character offset 0), this function returns %FALSE
+// Returns true if movement was possible; if iter was the first
+// in the buffer (character offset 0), this function returns false
 // for convenience when writing loops.
 func (x *TextIter) BackwardChar() bool {
 
@@ -95,15 +104,15 @@ func (x *TextIter) BackwardChar() bool {
 
 var xTextIterBackwardChars func(uintptr, int) bool
 
-// Moves @count characters backward, if possible.
+// Moves count characters backward, if possible.
 //
-// If @count would move past the start or end of the buffer, moves
+// If count would move past the start or end of the buffer, moves
 // to the start or end of the buffer.
 //
 // The return value indicates whether the iterator moved
 // onto a dereferenceable position; if the iterator didn’t move, or
-// moved onto the end iterator, then %FALSE is returned. If @count is 0,
-// the function does nothing and returns %FALSE.
+// moved onto the end iterator, then false is returned. If count is 0,
+// the function does nothing and returns false.
 func (x *TextIter) BackwardChars(CountVar int) bool {
 
 	cret := xTextIterBackwardChars(x.GoPointer(), CountVar)
@@ -112,7 +121,7 @@ func (x *TextIter) BackwardChars(CountVar int) bool {
 
 var xTextIterBackwardCursorPosition func(uintptr) bool
 
-// Like [method@Gtk.TextIter.forward_cursor_position], but moves backward.
+// Like [TextIter.ForwardCursorPosition], but moves backward.
 func (x *TextIter) BackwardCursorPosition() bool {
 
 	cret := xTextIterBackwardCursorPosition(x.GoPointer())
@@ -121,9 +130,9 @@ func (x *TextIter) BackwardCursorPosition() bool {
 
 var xTextIterBackwardCursorPositions func(uintptr, int) bool
 
-// Moves up to @count cursor positions.
+// Moves up to count cursor positions.
 //
-// See [method@Gtk.TextIter.forward_cursor_position] for details.
+// See [TextIter.ForwardCursorPosition] for details.
 func (x *TextIter) BackwardCursorPositions(CountVar int) bool {
 
 	cret := xTextIterBackwardCursorPositions(x.GoPointer(), CountVar)
@@ -132,8 +141,8 @@ func (x *TextIter) BackwardCursorPositions(CountVar int) bool {
 
 var xTextIterBackwardFindChar func(uintptr, uintptr, uintptr, *TextIter) bool
 
-// Same as [method@Gtk.TextIter.forward_find_char],
-// but goes backward from @iter.
+// Same as [TextIter.ForwardFindChar],
+// but goes backward from iter.
 func (x *TextIter) BackwardFindChar(PredVar *TextCharPredicate, UserDataVar uintptr, LimitVar *TextIter) bool {
 
 	var PredVarRef uintptr
@@ -142,12 +151,13 @@ func (x *TextIter) BackwardFindChar(PredVar *TextCharPredicate, UserDataVar uint
 		if cbRefPtr, ok := glib.GetCallback(PredVarPtr); ok {
 			PredVarRef = cbRefPtr
 		} else {
-			fcb := func(arg0 uint32, arg1 uintptr) bool {
+			fcb := func(arg0 rune, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *PredVar
 				return cbFn(arg0, arg1)
 			}
-			PredVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(PredVarPtr, PredVarRef, PredVar)
+			PredVarRef = core.NewCallback(fcb)
+			PredVarRef = glib.SaveCallbackWithClosure(PredVarPtr, PredVarRef, PredVar)
 		}
 	}
 
@@ -157,13 +167,13 @@ func (x *TextIter) BackwardFindChar(PredVar *TextCharPredicate, UserDataVar uint
 
 var xTextIterBackwardLine func(uintptr) bool
 
-// Moves @iter to the start of the previous line.
+// Moves iter to the start of the previous line.
 //
-// Returns %TRUE if @iter could be moved; i.e. if @iter was at
-// character offset 0, this function returns %FALSE. Therefore,
-// if @iter was already on line 0, but not at the start of the line,
-// @iter is snapped to the start of the line and the function returns
-// %TRUE. (Note that this implies that
+// Returns true if iter could be moved; i.e. if iter was at
+// character offset 0, this function returns false. Therefore,
+// if iter was already on line 0, but not at the start of the line,
+// iter is snapped to the start of the line and the function returns
+// true. (Note that this implies that
 // in a loop calling this function, the line number may not change on
 // every iteration, if your first iteration is on line 0.)
 func (x *TextIter) BackwardLine() bool {
@@ -174,16 +184,16 @@ func (x *TextIter) BackwardLine() bool {
 
 var xTextIterBackwardLines func(uintptr, int) bool
 
-// Moves @count lines backward, if possible.
+// Moves count lines backward, if possible.
 //
-// If @count would move past the start or end of the buffer, moves to
+// If count would move past the start or end of the buffer, moves to
 // the start or end of the buffer.
 //
 // The return value indicates whether the iterator moved
 // onto a dereferenceable position; if the iterator didn’t move, or
-// moved onto the end iterator, then %FALSE is returned. If @count is 0,
-// the function does nothing and returns %FALSE. If @count is negative,
-// moves forward by 0 - @count lines.
+// moved onto the end iterator, then false is returned. If count is 0,
+// the function does nothing and returns false. If count is negative,
+// moves forward by 0 - count lines.
 func (x *TextIter) BackwardLines(CountVar int) bool {
 
 	cret := xTextIterBackwardLines(x.GoPointer(), CountVar)
@@ -192,10 +202,10 @@ func (x *TextIter) BackwardLines(CountVar int) bool {
 
 var xTextIterBackwardSearch func(uintptr, string, TextSearchFlags, *TextIter, *TextIter, *TextIter) bool
 
-// Same as [method@Gtk.TextIter.forward_search], but moves backward.
+// Same as [TextIter.ForwardSearch], but moves backward.
 //
-// @match_end will never be set to a `GtkTextIter` located after @iter,
-// even if there is a possible @match_start before or at @iter.
+// match_end will never be set to a `GtkTextIter` located after iter,
+// even if there is a possible match_start before or at iter.
 func (x *TextIter) BackwardSearch(StrVar string, FlagsVar TextSearchFlags, MatchStartVar *TextIter, MatchEndVar *TextIter, LimitVar *TextIter) bool {
 
 	cret := xTextIterBackwardSearch(x.GoPointer(), StrVar, FlagsVar, MatchStartVar, MatchEndVar, LimitVar)
@@ -206,7 +216,7 @@ var xTextIterBackwardSentenceStart func(uintptr) bool
 
 // Moves backward to the previous sentence start.
 //
-// If @iter is already at the start of a sentence, moves backward
+// If iter is already at the start of a sentence, moves backward
 // to the next one.
 //
 // Sentence boundaries are determined by Pango and should
@@ -219,9 +229,9 @@ func (x *TextIter) BackwardSentenceStart() bool {
 
 var xTextIterBackwardSentenceStarts func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.backward_sentence_start] up to @count times.
+// Calls [TextIter.BackwardSentenceStart] up to count times.
 //
-// If @count is negative, moves forward instead of backward.
+// If count is negative, moves forward instead of backward.
 func (x *TextIter) BackwardSentenceStarts(CountVar int) bool {
 
 	cret := xTextIterBackwardSentenceStarts(x.GoPointer(), CountVar)
@@ -231,12 +241,12 @@ func (x *TextIter) BackwardSentenceStarts(CountVar int) bool {
 var xTextIterBackwardToTagToggle func(uintptr, uintptr) bool
 
 // Moves backward to the next toggle (on or off) of the
-// @tag, or to the next toggle of any tag if
-// @tag is %NULL.
+// tag, or to the next toggle of any tag if
+// tag is nil.
 //
 // If no matching tag toggles are found,
-// returns %FALSE, otherwise %TRUE. Does not return toggles
-// located at @iter, only toggles before @iter. Sets @iter
+// returns false, otherwise true. Does not return toggles
+// located at iter, only toggles before iter. Sets iter
 // to the location of the toggle, or the start of the buffer
 // if no toggle is found.
 func (x *TextIter) BackwardToTagToggle(TagVar *TextTag) bool {
@@ -247,9 +257,9 @@ func (x *TextIter) BackwardToTagToggle(TagVar *TextTag) bool {
 
 var xTextIterBackwardVisibleCursorPosition func(uintptr) bool
 
-// Moves @iter backward to the previous visible cursor position.
+// Moves iter backward to the previous visible cursor position.
 //
-// See [method@Gtk.TextIter.backward_cursor_position] for details.
+// See [TextIter.BackwardCursorPosition] for details.
 func (x *TextIter) BackwardVisibleCursorPosition() bool {
 
 	cret := xTextIterBackwardVisibleCursorPosition(x.GoPointer())
@@ -258,9 +268,9 @@ func (x *TextIter) BackwardVisibleCursorPosition() bool {
 
 var xTextIterBackwardVisibleCursorPositions func(uintptr, int) bool
 
-// Moves up to @count visible cursor positions.
+// Moves up to count visible cursor positions.
 //
-// See [method@Gtk.TextIter.backward_cursor_position] for details.
+// See [TextIter.BackwardCursorPosition] for details.
 func (x *TextIter) BackwardVisibleCursorPositions(CountVar int) bool {
 
 	cret := xTextIterBackwardVisibleCursorPositions(x.GoPointer(), CountVar)
@@ -269,13 +279,13 @@ func (x *TextIter) BackwardVisibleCursorPositions(CountVar int) bool {
 
 var xTextIterBackwardVisibleLine func(uintptr) bool
 
-// Moves @iter to the start of the previous visible line.
+// Moves iter to the start of the previous visible line.
 //
-// Returns %TRUE if
-// @iter could be moved; i.e. if @iter was at character offset 0, this
-// function returns %FALSE. Therefore if @iter was already on line 0,
-// but not at the start of the line, @iter is snapped to the start of
-// the line and the function returns %TRUE. (Note that this implies that
+// Returns true if
+// iter could be moved; i.e. if iter was at character offset 0, this
+// function returns false. Therefore if iter was already on line 0,
+// but not at the start of the line, iter is snapped to the start of
+// the line and the function returns true. (Note that this implies that
 // in a loop calling this function, the line number may not change on
 // every iteration, if your first iteration is on line 0.)
 func (x *TextIter) BackwardVisibleLine() bool {
@@ -286,16 +296,16 @@ func (x *TextIter) BackwardVisibleLine() bool {
 
 var xTextIterBackwardVisibleLines func(uintptr, int) bool
 
-// Moves @count visible lines backward, if possible.
+// Moves count visible lines backward, if possible.
 //
-// If @count would move past the start or end of the buffer, moves to
+// If count would move past the start or end of the buffer, moves to
 // the start or end of the buffer.
 //
 // The return value indicates whether the iterator moved
 // onto a dereferenceable position; if the iterator didn’t move, or
-// moved onto the end iterator, then %FALSE is returned. If @count is 0,
-// the function does nothing and returns %FALSE. If @count is negative,
-// moves forward by 0 - @count lines.
+// moved onto the end iterator, then false is returned. If count is 0,
+// the function does nothing and returns false. If count is negative,
+// moves forward by 0 - count lines.
 func (x *TextIter) BackwardVisibleLines(CountVar int) bool {
 
 	cret := xTextIterBackwardVisibleLines(x.GoPointer(), CountVar)
@@ -306,7 +316,7 @@ var xTextIterBackwardVisibleWordStart func(uintptr) bool
 
 // Moves backward to the previous visible word start.
 //
-// If @iter is currently on a word start, moves backward to the
+// If iter is currently on a word start, moves backward to the
 // next one after that.
 //
 // Word breaks are determined by Pango and should be correct
@@ -319,7 +329,7 @@ func (x *TextIter) BackwardVisibleWordStart() bool {
 
 var xTextIterBackwardVisibleWordStarts func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.backward_visible_word_start] up to @count times.
+// Calls [TextIter.BackwardVisibleWordStart] up to count times.
 func (x *TextIter) BackwardVisibleWordStarts(CountVar int) bool {
 
 	cret := xTextIterBackwardVisibleWordStarts(x.GoPointer(), CountVar)
@@ -330,7 +340,7 @@ var xTextIterBackwardWordStart func(uintptr) bool
 
 // Moves backward to the previous word start.
 //
-// If @iter is currently on a word start, moves backward to the
+// If iter is currently on a word start, moves backward to the
 // next one after that.
 //
 // Word breaks are determined by Pango and should be correct
@@ -343,7 +353,7 @@ func (x *TextIter) BackwardWordStart() bool {
 
 var xTextIterBackwardWordStarts func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.backward_word_start] up to @count times.
+// Calls [TextIter.BackwardWordStart] up to count times.
 func (x *TextIter) BackwardWordStarts(CountVar int) bool {
 
 	cret := xTextIterBackwardWordStarts(x.GoPointer(), CountVar)
@@ -353,12 +363,12 @@ func (x *TextIter) BackwardWordStarts(CountVar int) bool {
 var xTextIterCanInsert func(uintptr, bool) bool
 
 // Considering the default editability of the buffer, and tags that
-// affect editability, determines whether text inserted at @iter would
+// affect editability, determines whether text inserted at iter would
 // be editable.
 //
-// If text inserted at @iter would be editable then the
-// user should be allowed to insert text at @iter.
-// [method@Gtk.TextBuffer.insert_interactive] uses this function
+// If text inserted at iter would be editable then the
+// user should be allowed to insert text at iter.
+// [TextBuffer.InsertInteractive] uses this function
 // to decide whether insertions are allowed at a given position.
 func (x *TextIter) CanInsert(DefaultEditabilityVar bool) bool {
 
@@ -368,8 +378,8 @@ func (x *TextIter) CanInsert(DefaultEditabilityVar bool) bool {
 
 var xTextIterCompare func(uintptr, *TextIter) int
 
-// A qsort()-style function that returns negative if @lhs is less than
-// @rhs, positive if @lhs is greater than @rhs, and 0 if they’re equal.
+// A qsort()-style function that returns negative if lhs is less than
+// rhs, positive if lhs is greater than rhs, and 0 if they’re equal.
 //
 // Ordering is in character offset order, i.e. the first character
 // in the buffer is less than the second character in the buffer.
@@ -396,18 +406,18 @@ func (x *TextIter) Copy() *TextIter {
 
 var xTextIterEditable func(uintptr, bool) bool
 
-// Returns whether the character at @iter is within an editable region
+// Returns whether the character at iter is within an editable region
 // of text.
 //
 // Non-editable text is “locked” and can’t be changed by the
 // user via `GtkTextView`. If no tags applied to this text affect
-// editability, @default_setting will be returned.
+// editability, default_setting will be returned.
 //
 // You don’t want to use this function to decide whether text can be
-// inserted at @iter, because for insertion you don’t want to know
-// whether the char at @iter is inside an editable range, you want to
-// know whether a new character inserted at @iter would be inside an
-// editable range. Use [method@Gtk.TextIter.can_insert] to handle this
+// inserted at iter, because for insertion you don’t want to know
+// whether the char at iter is inside an editable range, you want to
+// know whether a new character inserted at iter would be inside an
+// editable range. Use [TextIter.CanInsert] to handle this
 // case.
 func (x *TextIter) Editable(DefaultSettingVar bool) bool {
 
@@ -417,7 +427,7 @@ func (x *TextIter) Editable(DefaultSettingVar bool) bool {
 
 var xTextIterEndsLine func(uintptr) bool
 
-// Returns %TRUE if @iter points to the start of the paragraph
+// Returns true if iter points to the start of the paragraph
 // delimiter characters for a line.
 //
 // Delimiters will be either a newline, a carriage return, a carriage
@@ -436,7 +446,7 @@ func (x *TextIter) EndsLine() bool {
 
 var xTextIterEndsSentence func(uintptr) bool
 
-// Determines whether @iter ends a sentence.
+// Determines whether iter ends a sentence.
 //
 // Sentence boundaries are determined by Pango and should
 // be correct for nearly any language.
@@ -448,16 +458,16 @@ func (x *TextIter) EndsSentence() bool {
 
 var xTextIterEndsTag func(uintptr, uintptr) bool
 
-// Returns %TRUE if @tag is toggled off at exactly this point.
+// Returns true if tag is toggled off at exactly this point.
 //
-// If @tag is %NULL, returns %TRUE if any tag is toggled off at this point.
+// If tag is nil, returns true if any tag is toggled off at this point.
 //
-// Note that if this function returns %TRUE, it means that
-// @iter is at the end of the tagged range, but that the character
-// at @iter is outside the tagged range. In other words,
-// unlike [method@Gtk.TextIter.starts_tag], if this function
-// returns %TRUE, [method@Gtk.TextIter.has_tag] will return
-// %FALSE for the same parameters.
+// Note that if this function returns true, it means that
+// iter is at the end of the tagged range, but that the character
+// at iter is outside the tagged range. In other words,
+// unlike [TextIter.StartsTag], if this function
+// returns true, [TextIter.HasTag] will return
+// false for the same parameters.
 func (x *TextIter) EndsTag(TagVar *TextTag) bool {
 
 	cret := xTextIterEndsTag(x.GoPointer(), TagVar.GoPointer())
@@ -466,7 +476,7 @@ func (x *TextIter) EndsTag(TagVar *TextTag) bool {
 
 var xTextIterEndsWord func(uintptr) bool
 
-// Determines whether @iter ends a natural-language word.
+// Determines whether iter ends a natural-language word.
 //
 // Word breaks are determined by Pango and should be correct
 // for nearly any language.
@@ -484,7 +494,7 @@ var xTextIterEqual func(uintptr, *TextIter) bool
 // This function is very fast; you can expect it to perform
 // better than e.g. getting the character offset for each
 // iterator and comparing the offsets yourself. Also, it’s a
-// bit faster than [method@Gtk.TextIter.compare].
+// bit faster than [TextIter.Compare].
 func (x *TextIter) Equal(RhsVar *TextIter) bool {
 
 	cret := xTextIterEqual(x.GoPointer(), RhsVar)
@@ -493,13 +503,13 @@ func (x *TextIter) Equal(RhsVar *TextIter) bool {
 
 var xTextIterForwardChar func(uintptr) bool
 
-// Moves @iter forward by one character offset.
+// Moves iter forward by one character offset.
 //
 // Note that images embedded in the buffer occupy 1 character slot, so
 // this function may actually move onto an image instead of a character,
-// if you have images in your buffer. If @iter is the end iterator or
-// one character before it, @iter will now point at the end iterator,
-// and this function returns %FALSE for convenience when writing loops.
+// if you have images in your buffer. If iter is the end iterator or
+// one character before it, iter will now point at the end iterator,
+// and this function returns false for convenience when writing loops.
 func (x *TextIter) ForwardChar() bool {
 
 	cret := xTextIterForwardChar(x.GoPointer())
@@ -508,15 +518,15 @@ func (x *TextIter) ForwardChar() bool {
 
 var xTextIterForwardChars func(uintptr, int) bool
 
-// Moves @count characters if possible.
+// Moves count characters if possible.
 //
-// If @count would move past the start or end of the buffer,
+// If count would move past the start or end of the buffer,
 // moves to the start or end of the buffer.
 //
 // The return value indicates whether the new position of
-// @iter is different from its original position, and dereferenceable
-// (the last iterator in the buffer is not dereferenceable). If @count
-// is 0, the function does nothing and returns %FALSE.
+// iter is different from its original position, and dereferenceable
+// (the last iterator in the buffer is not dereferenceable). If count
+// is 0, the function does nothing and returns false.
 func (x *TextIter) ForwardChars(CountVar int) bool {
 
 	cret := xTextIterForwardChars(x.GoPointer(), CountVar)
@@ -525,7 +535,7 @@ func (x *TextIter) ForwardChars(CountVar int) bool {
 
 var xTextIterForwardCursorPosition func(uintptr) bool
 
-// Moves @iter forward by a single cursor position.
+// Moves iter forward by a single cursor position.
 //
 // Cursor positions are (unsurprisingly) positions where the
 // cursor can appear. Perhaps surprisingly, there may not be
@@ -538,7 +548,7 @@ var xTextIterForwardCursorPosition func(uintptr) bool
 // the letter then a "combining mark" that causes the accent to be
 // rendered; so the cursor can’t go between those two characters.
 //
-// See also the [struct@Pango.LogAttr] struct and the [func@Pango.break]
+// See also the [pango.LogAttr] struct and the [pango.Break]
 // function.
 func (x *TextIter) ForwardCursorPosition() bool {
 
@@ -548,9 +558,9 @@ func (x *TextIter) ForwardCursorPosition() bool {
 
 var xTextIterForwardCursorPositions func(uintptr, int) bool
 
-// Moves up to @count cursor positions.
+// Moves up to count cursor positions.
 //
-// See [method@Gtk.TextIter.forward_cursor_position] for details.
+// See [TextIter.ForwardCursorPosition] for details.
 func (x *TextIter) ForwardCursorPositions(CountVar int) bool {
 
 	cret := xTextIterForwardCursorPositions(x.GoPointer(), CountVar)
@@ -559,11 +569,11 @@ func (x *TextIter) ForwardCursorPositions(CountVar int) bool {
 
 var xTextIterForwardFindChar func(uintptr, uintptr, uintptr, *TextIter) bool
 
-// Advances @iter, calling @pred on each character.
+// Advances iter, calling pred on each character.
 //
-// If @pred returns %TRUE, returns %TRUE and stops scanning.
-// If @pred never returns %TRUE, @iter is set to @limit if
-// @limit is non-%NULL, otherwise to the end iterator.
+// If pred returns true, returns true and stops scanning.
+// If pred never returns true, iter is set to limit if
+// limit is non-nil, otherwise to the end iterator.
 func (x *TextIter) ForwardFindChar(PredVar *TextCharPredicate, UserDataVar uintptr, LimitVar *TextIter) bool {
 
 	var PredVarRef uintptr
@@ -572,12 +582,13 @@ func (x *TextIter) ForwardFindChar(PredVar *TextCharPredicate, UserDataVar uintp
 		if cbRefPtr, ok := glib.GetCallback(PredVarPtr); ok {
 			PredVarRef = cbRefPtr
 		} else {
-			fcb := func(arg0 uint32, arg1 uintptr) bool {
+			fcb := func(arg0 rune, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *PredVar
 				return cbFn(arg0, arg1)
 			}
-			PredVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(PredVarPtr, PredVarRef, PredVar)
+			PredVarRef = core.NewCallback(fcb)
+			PredVarRef = glib.SaveCallbackWithClosure(PredVarPtr, PredVarRef, PredVar)
 		}
 	}
 
@@ -587,12 +598,12 @@ func (x *TextIter) ForwardFindChar(PredVar *TextCharPredicate, UserDataVar uintp
 
 var xTextIterForwardLine func(uintptr) bool
 
-// Moves @iter to the start of the next line.
+// Moves iter to the start of the next line.
 //
 // If the iter is already on the last line of the buffer,
 // moves the iter to the end of the current line. If after
 // the operation, the iter is at the end of the buffer and not
-// dereferenceable, returns %FALSE. Otherwise, returns %TRUE.
+// dereferenceable, returns false. Otherwise, returns true.
 func (x *TextIter) ForwardLine() bool {
 
 	cret := xTextIterForwardLine(x.GoPointer())
@@ -601,16 +612,16 @@ func (x *TextIter) ForwardLine() bool {
 
 var xTextIterForwardLines func(uintptr, int) bool
 
-// Moves @count lines forward, if possible.
+// Moves count lines forward, if possible.
 //
-// If @count would move past the start or end of the buffer, moves to
+// If count would move past the start or end of the buffer, moves to
 // the start or end of the buffer.
 //
 // The return value indicates whether the iterator moved
 // onto a dereferenceable position; if the iterator didn’t move, or
-// moved onto the end iterator, then %FALSE is returned. If @count is 0,
-// the function does nothing and returns %FALSE. If @count is negative,
-// moves backward by 0 - @count lines.
+// moved onto the end iterator, then false is returned. If count is 0,
+// the function does nothing and returns false. If count is negative,
+// moves backward by 0 - count lines.
 func (x *TextIter) ForwardLines(CountVar int) bool {
 
 	cret := xTextIterForwardLines(x.GoPointer(), CountVar)
@@ -619,16 +630,16 @@ func (x *TextIter) ForwardLines(CountVar int) bool {
 
 var xTextIterForwardSearch func(uintptr, string, TextSearchFlags, *TextIter, *TextIter, *TextIter) bool
 
-// Searches forward for @str.
+// Searches forward for str.
 //
-// Any match is returned by setting @match_start to the first character
-// of the match and @match_end to the first character after the match.
-// The search will not continue past @limit. Note that a search is a
-// linear or O(n) operation, so you may wish to use @limit to avoid
+// Any match is returned by setting match_start to the first character
+// of the match and match_end to the first character after the match.
+// The search will not continue past limit. Note that a search is a
+// linear or O(n) operation, so you may wish to use limit to avoid
 // locking up your UI on large buffers.
 //
-// @match_start will never be set to a `GtkTextIter` located before @iter,
-// even if there is a possible @match_end after or at @iter.
+// match_start will never be set to a `GtkTextIter` located before iter,
+// even if there is a possible match_end after or at iter.
 func (x *TextIter) ForwardSearch(StrVar string, FlagsVar TextSearchFlags, MatchStartVar *TextIter, MatchEndVar *TextIter, LimitVar *TextIter) bool {
 
 	cret := xTextIterForwardSearch(x.GoPointer(), StrVar, FlagsVar, MatchStartVar, MatchEndVar, LimitVar)
@@ -639,7 +650,7 @@ var xTextIterForwardSentenceEnd func(uintptr) bool
 
 // Moves forward to the next sentence end.
 //
-// If @iter is at the end of a sentence, moves to the next
+// If iter is at the end of a sentence, moves to the next
 // end of sentence.
 //
 // Sentence boundaries are determined by Pango and should
@@ -652,9 +663,9 @@ func (x *TextIter) ForwardSentenceEnd() bool {
 
 var xTextIterForwardSentenceEnds func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.forward_sentence_end] @count times.
+// Calls [TextIter.ForwardSentenceEnd] count times.
 //
-// If @count is negative, moves backward instead of forward.
+// If count is negative, moves backward instead of forward.
 func (x *TextIter) ForwardSentenceEnds(CountVar int) bool {
 
 	cret := xTextIterForwardSentenceEnds(x.GoPointer(), CountVar)
@@ -663,10 +674,10 @@ func (x *TextIter) ForwardSentenceEnds(CountVar int) bool {
 
 var xTextIterForwardToEnd func(uintptr)
 
-// Moves @iter forward to the “end iterator”, which points
+// Moves iter forward to the “end iterator”, which points
 // one past the last valid character in the buffer.
 //
-// [method@Gtk.TextIter.get_char] called on the end iterator
+// [TextIter.GetChar] called on the end iterator
 // returns 0, which is convenient for writing loops.
 func (x *TextIter) ForwardToEnd() {
 
@@ -684,9 +695,9 @@ var xTextIterForwardToLineEnd func(uintptr) bool
 //
 // If the iterator is already at the paragraph delimiter
 // characters, moves to the paragraph delimiter characters for the
-// next line. If @iter is on the last line in the buffer, which does
+// next line. If iter is on the last line in the buffer, which does
 // not end in paragraph delimiters, moves to the end iterator (end of
-// the last line), and returns %FALSE.
+// the last line), and returns false.
 func (x *TextIter) ForwardToLineEnd() bool {
 
 	cret := xTextIterForwardToLineEnd(x.GoPointer())
@@ -696,12 +707,12 @@ func (x *TextIter) ForwardToLineEnd() bool {
 var xTextIterForwardToTagToggle func(uintptr, uintptr) bool
 
 // Moves forward to the next toggle (on or off) of the
-// @tag, or to the next toggle of any tag if
-// @tag is %NULL.
+// tag, or to the next toggle of any tag if
+// tag is nil.
 //
 // If no matching tag toggles are found,
-// returns %FALSE, otherwise %TRUE. Does not return toggles
-// located at @iter, only toggles after @iter. Sets @iter to
+// returns false, otherwise true. Does not return toggles
+// located at iter, only toggles after iter. Sets iter to
 // the location of the toggle, or to the end of the buffer
 // if no toggle is found.
 func (x *TextIter) ForwardToTagToggle(TagVar *TextTag) bool {
@@ -712,9 +723,9 @@ func (x *TextIter) ForwardToTagToggle(TagVar *TextTag) bool {
 
 var xTextIterForwardVisibleCursorPosition func(uintptr) bool
 
-// Moves @iter forward to the next visible cursor position.
+// Moves iter forward to the next visible cursor position.
 //
-// See [method@Gtk.TextIter.forward_cursor_position] for details.
+// See [TextIter.ForwardCursorPosition] for details.
 func (x *TextIter) ForwardVisibleCursorPosition() bool {
 
 	cret := xTextIterForwardVisibleCursorPosition(x.GoPointer())
@@ -723,9 +734,9 @@ func (x *TextIter) ForwardVisibleCursorPosition() bool {
 
 var xTextIterForwardVisibleCursorPositions func(uintptr, int) bool
 
-// Moves up to @count visible cursor positions.
+// Moves up to count visible cursor positions.
 //
-// See [method@Gtk.TextIter.forward_cursor_position] for details.
+// See [TextIter.ForwardCursorPosition] for details.
 func (x *TextIter) ForwardVisibleCursorPositions(CountVar int) bool {
 
 	cret := xTextIterForwardVisibleCursorPositions(x.GoPointer(), CountVar)
@@ -734,11 +745,11 @@ func (x *TextIter) ForwardVisibleCursorPositions(CountVar int) bool {
 
 var xTextIterForwardVisibleLine func(uintptr) bool
 
-// Moves @iter to the start of the next visible line.
+// Moves iter to the start of the next visible line.
 //
-// Returns %TRUE if there
-// was a next line to move to, and %FALSE if @iter was simply moved to
-// the end of the buffer and is now not dereferenceable, or if @iter was
+// Returns true if there
+// was a next line to move to, and false if iter was simply moved to
+// the end of the buffer and is now not dereferenceable, or if iter was
 // already at the end of the buffer.
 func (x *TextIter) ForwardVisibleLine() bool {
 
@@ -748,16 +759,16 @@ func (x *TextIter) ForwardVisibleLine() bool {
 
 var xTextIterForwardVisibleLines func(uintptr, int) bool
 
-// Moves @count visible lines forward, if possible.
+// Moves count visible lines forward, if possible.
 //
-// If @count would move past the start or end of the buffer, moves to
+// If count would move past the start or end of the buffer, moves to
 // the start or end of the buffer.
 //
 // The return value indicates whether the iterator moved
 // onto a dereferenceable position; if the iterator didn’t move, or
-// moved onto the end iterator, then %FALSE is returned. If @count is 0,
-// the function does nothing and returns %FALSE. If @count is negative,
-// moves backward by 0 - @count lines.
+// moved onto the end iterator, then false is returned. If count is 0,
+// the function does nothing and returns false. If count is negative,
+// moves backward by 0 - count lines.
 func (x *TextIter) ForwardVisibleLines(CountVar int) bool {
 
 	cret := xTextIterForwardVisibleLines(x.GoPointer(), CountVar)
@@ -768,7 +779,7 @@ var xTextIterForwardVisibleWordEnd func(uintptr) bool
 
 // Moves forward to the next visible word end.
 //
-// If @iter is currently on a word end, moves forward to the
+// If iter is currently on a word end, moves forward to the
 // next one after that.
 //
 // Word breaks are determined by Pango and should be correct
@@ -781,7 +792,7 @@ func (x *TextIter) ForwardVisibleWordEnd() bool {
 
 var xTextIterForwardVisibleWordEnds func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.forward_visible_word_end] up to @count times.
+// Calls [TextIter.ForwardVisibleWordEnd] up to count times.
 func (x *TextIter) ForwardVisibleWordEnds(CountVar int) bool {
 
 	cret := xTextIterForwardVisibleWordEnds(x.GoPointer(), CountVar)
@@ -792,7 +803,7 @@ var xTextIterForwardWordEnd func(uintptr) bool
 
 // Moves forward to the next word end.
 //
-// If @iter is currently on a word end, moves forward to the
+// If iter is currently on a word end, moves forward to the
 // next one after that.
 //
 // Word breaks are determined by Pango and should be correct
@@ -805,7 +816,7 @@ func (x *TextIter) ForwardWordEnd() bool {
 
 var xTextIterForwardWordEnds func(uintptr, int) bool
 
-// Calls [method@Gtk.TextIter.forward_word_end] up to @count times.
+// Calls [TextIter.ForwardWordEnd] up to count times.
 func (x *TextIter) ForwardWordEnds(CountVar int) bool {
 
 	cret := xTextIterForwardWordEnds(x.GoPointer(), CountVar)
@@ -844,7 +855,7 @@ func (x *TextIter) GetBuffer() *TextBuffer {
 
 var xTextIterGetBytesInLine func(uintptr) int
 
-// Returns the number of bytes in the line containing @iter,
+// Returns the number of bytes in the line containing iter,
 // including the paragraph delimiters.
 func (x *TextIter) GetBytesInLine() int {
 
@@ -852,7 +863,7 @@ func (x *TextIter) GetBytesInLine() int {
 	return cret
 }
 
-var xTextIterGetChar func(uintptr) uint32
+var xTextIterGetChar func(uintptr) rune
 
 // The Unicode character at this iterator is returned.
 //
@@ -863,7 +874,7 @@ var xTextIterGetChar func(uintptr) uint32
 // zero is not a valid Unicode character.
 //
 // So you can write a loop which ends when this function returns 0.
-func (x *TextIter) GetChar() uint32 {
+func (x *TextIter) GetChar() rune {
 
 	cret := xTextIterGetChar(x.GoPointer())
 	return cret
@@ -871,7 +882,7 @@ func (x *TextIter) GetChar() uint32 {
 
 var xTextIterGetCharsInLine func(uintptr) int
 
-// Returns the number of characters in the line containing @iter,
+// Returns the number of characters in the line containing iter,
 // including the paragraph delimiters.
 func (x *TextIter) GetCharsInLine() int {
 
@@ -881,10 +892,10 @@ func (x *TextIter) GetCharsInLine() int {
 
 var xTextIterGetChildAnchor func(uintptr) uintptr
 
-// If the location at @iter contains a child anchor, the
+// If the location at iter contains a child anchor, the
 // anchor is returned.
 //
-// Otherwise, %NULL is returned.
+// Otherwise, nil is returned.
 func (x *TextIter) GetChildAnchor() *TextChildAnchor {
 	var cls *TextChildAnchor
 
@@ -901,10 +912,10 @@ func (x *TextIter) GetChildAnchor() *TextChildAnchor {
 
 var xTextIterGetLanguage func(uintptr) *pango.Language
 
-// Returns the language in effect at @iter.
+// Returns the language in effect at iter.
 //
-// If no tags affecting language apply to @iter, the return
-// value is identical to that of [func@Gtk.get_default_language].
+// If no tags affecting language apply to iter, the return
+// value is identical to that of [GetDefaultLanguage].
 func (x *TextIter) GetLanguage() *pango.Language {
 
 	cret := xTextIterGetLanguage(x.GoPointer())
@@ -970,7 +981,7 @@ var xTextIterGetOffset func(uintptr) int
 //
 // Each character in a `GtkTextBuffer` has an offset,
 // starting with 0 for the first character in the buffer.
-// Use [method@Gtk.TextBuffer.get_iter_at_offset] to convert
+// Use [TextBuffer.GetIterAtOffset] to convert
 // an offset back into an iterator.
 func (x *TextIter) GetOffset() int {
 
@@ -980,9 +991,9 @@ func (x *TextIter) GetOffset() int {
 
 var xTextIterGetPaintable func(uintptr) uintptr
 
-// If the element at @iter is a paintable, the paintable is returned.
+// If the element at iter is a paintable, the paintable is returned.
 //
-// Otherwise, %NULL is returned.
+// Otherwise, nil is returned.
 func (x *TextIter) GetPaintable() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -1017,7 +1028,7 @@ func (x *TextIter) GetSlice(EndVar *TextIter) string {
 
 var xTextIterGetTags func(uintptr) *glib.SList
 
-// Returns a list of tags that apply to @iter, in ascending order of
+// Returns a list of tags that apply to iter, in ascending order of
 // priority.
 //
 // The highest-priority tags are last.
@@ -1038,7 +1049,7 @@ var xTextIterGetText func(uintptr, *TextIter) string
 // contains non-text elements such as images, the character and byte
 // offsets in the returned string will not correspond to character and
 // byte offsets in the buffer. If you want offsets to correspond, see
-// [method@Gtk.TextIter.get_slice].
+// [TextIter.GetSlice].
 func (x *TextIter) GetText(EndVar *TextIter) string {
 
 	cret := xTextIterGetText(x.GoPointer(), EndVar)
@@ -1050,10 +1061,10 @@ var xTextIterGetToggledTags func(uintptr, bool) *glib.SList
 // Returns a list of `GtkTextTag` that are toggled on or off at this
 // point.
 //
-// If @toggled_on is %TRUE, the list contains tags that are
-// toggled on. If a tag is toggled on at @iter, then some non-empty
-// range of characters following @iter has that tag applied to it.  If
-// a tag is toggled off, then some non-empty range following @iter
+// If toggled_on is true, the list contains tags that are
+// toggled on. If a tag is toggled on at iter, then some non-empty
+// range of characters following iter has that tag applied to it.  If
+// a tag is toggled off, then some non-empty range following iter
 // does not have the tag applied to it.
 func (x *TextIter) GetToggledTags(ToggledOnVar bool) *glib.SList {
 
@@ -1064,7 +1075,7 @@ func (x *TextIter) GetToggledTags(ToggledOnVar bool) *glib.SList {
 var xTextIterGetVisibleLineIndex func(uintptr) int
 
 // Returns the number of bytes from the start of the
-// line to the given @iter, not counting bytes that
+// line to the given iter, not counting bytes that
 // are invisible due to tags with the “invisible” flag
 // toggled on.
 func (x *TextIter) GetVisibleLineIndex() int {
@@ -1076,7 +1087,7 @@ func (x *TextIter) GetVisibleLineIndex() int {
 var xTextIterGetVisibleLineOffset func(uintptr) int
 
 // Returns the offset in characters from the start of the
-// line to the given @iter, not counting characters that
+// line to the given iter, not counting characters that
 // are invisible due to tags with the “invisible” flag
 // toggled on.
 func (x *TextIter) GetVisibleLineOffset() int {
@@ -1089,7 +1100,7 @@ var xTextIterGetVisibleSlice func(uintptr, *TextIter) string
 
 // Returns visible text in the given range.
 //
-// Like [method@Gtk.TextIter.get_slice], but invisible text
+// Like [TextIter.GetSlice], but invisible text
 // is not included. Invisible text is usually invisible because
 // a `GtkTextTag` with the “invisible” attribute turned on has
 // been applied to it.
@@ -1103,7 +1114,7 @@ var xTextIterGetVisibleText func(uintptr, *TextIter) string
 
 // Returns visible text in the given range.
 //
-// Like [method@Gtk.TextIter.get_text], but invisible text
+// Like [TextIter.GetText], but invisible text
 // is not included. Invisible text is usually invisible because
 // a `GtkTextTag` with the “invisible” attribute turned on has
 // been applied to it.
@@ -1115,11 +1126,11 @@ func (x *TextIter) GetVisibleText(EndVar *TextIter) string {
 
 var xTextIterHasTag func(uintptr, uintptr) bool
 
-// Returns %TRUE if @iter points to a character that is part
-// of a range tagged with @tag.
+// Returns true if iter points to a character that is part
+// of a range tagged with tag.
 //
-// See also [method@Gtk.TextIter.starts_tag] and
-// [method@Gtk.TextIter.ends_tag].
+// See also [TextIter.StartsTag] and
+// [TextIter.EndsTag].
 func (x *TextIter) HasTag(TagVar *TextTag) bool {
 
 	cret := xTextIterHasTag(x.GoPointer(), TagVar.GoPointer())
@@ -1128,9 +1139,9 @@ func (x *TextIter) HasTag(TagVar *TextTag) bool {
 
 var xTextIterInRange func(uintptr, *TextIter, *TextIter) bool
 
-// Checks whether @iter falls in the range [@start, @end).
+// Checks whether iter falls in the range [start, end).
 //
-// @start and @end must be in ascending order.
+// start and end must be in ascending order.
 func (x *TextIter) InRange(StartVar *TextIter, EndVar *TextIter) bool {
 
 	cret := xTextIterInRange(x.GoPointer(), StartVar, EndVar)
@@ -1139,7 +1150,7 @@ func (x *TextIter) InRange(StartVar *TextIter, EndVar *TextIter) bool {
 
 var xTextIterInsideSentence func(uintptr) bool
 
-// Determines whether @iter is inside a sentence (as opposed to in
+// Determines whether iter is inside a sentence (as opposed to in
 // between two sentences, e.g. after a period and before the first
 // letter of the next sentence).
 //
@@ -1153,14 +1164,14 @@ func (x *TextIter) InsideSentence() bool {
 
 var xTextIterInsideWord func(uintptr) bool
 
-// Determines whether the character pointed by @iter is part of a
+// Determines whether the character pointed by iter is part of a
 // natural-language word (as opposed to say inside some whitespace).
 //
 // Word breaks are determined by Pango and should be correct
 // for nearly any language.
 //
-// Note that if [method@Gtk.TextIter.starts_word] returns %TRUE,
-// then this function returns %TRUE too, since @iter points to
+// Note that if [TextIter.StartsWord] returns true,
+// then this function returns true too, since iter points to
 // the first character of the word.
 func (x *TextIter) InsideWord() bool {
 
@@ -1170,10 +1181,10 @@ func (x *TextIter) InsideWord() bool {
 
 var xTextIterIsCursorPosition func(uintptr) bool
 
-// Determine if @iter is at a cursor position.
+// Determine if iter is at a cursor position.
 //
-// See [method@Gtk.TextIter.forward_cursor_position] or
-// [struct@Pango.LogAttr] or [func@Pango.break] for details
+// See [TextIter.ForwardCursorPosition] or
+// [pango.LogAttr] or [pango.Break] for details
 // on what a cursor position is.
 func (x *TextIter) IsCursorPosition() bool {
 
@@ -1183,10 +1194,10 @@ func (x *TextIter) IsCursorPosition() bool {
 
 var xTextIterIsEnd func(uintptr) bool
 
-// Returns %TRUE if @iter is the end iterator.
+// Returns true if iter is the end iterator.
 //
 // This means it is one past the last dereferenceable iterator
-// in the buffer. [method@Gtk.TextIter.is_end] is the most efficient
+// in the buffer. [TextIter.IsEnd] is the most efficient
 // way to check whether an iterator is the end iterator.
 func (x *TextIter) IsEnd() bool {
 
@@ -1196,7 +1207,7 @@ func (x *TextIter) IsEnd() bool {
 
 var xTextIterIsStart func(uintptr) bool
 
-// Returns %TRUE if @iter is the first iterator in the buffer.
+// Returns true if iter is the first iterator in the buffer.
 func (x *TextIter) IsStart() bool {
 
 	cret := xTextIterIsStart(x.GoPointer())
@@ -1205,14 +1216,14 @@ func (x *TextIter) IsStart() bool {
 
 var xTextIterOrder func(uintptr, *TextIter)
 
-// Swaps the value of @first and @second if @second comes before
-// @first in the buffer.
+// Swaps the value of first and second if second comes before
+// first in the buffer.
 //
-// That is, ensures that @first and @second are in sequence.
+// That is, ensures that first and second are in sequence.
 // Most text buffer functions that take a range call this
 // automatically on your behalf, so there’s no real reason to
 // call it yourself in those cases. There are some exceptions,
-// such as [method@Gtk.TextIter.in_range], that expect a
+// such as [TextIter.InRange], that expect a
 // pre-sorted range.
 func (x *TextIter) Order(SecondVar *TextIter) {
 
@@ -1222,10 +1233,10 @@ func (x *TextIter) Order(SecondVar *TextIter) {
 
 var xTextIterSetLine func(uintptr, int)
 
-// Moves iterator @iter to the start of the line @line_number.
+// Moves iterator iter to the start of the line line_number.
 //
-// If @line_number is negative or larger than or equal to the number of lines
-// in the buffer, moves @iter to the start of the last line in the buffer.
+// If line_number is negative or larger than or equal to the number of lines
+// in the buffer, moves iter to the start of the last line in the buffer.
 func (x *TextIter) SetLine(LineNumberVar int) {
 
 	xTextIterSetLine(x.GoPointer(), LineNumberVar)
@@ -1234,7 +1245,7 @@ func (x *TextIter) SetLine(LineNumberVar int) {
 
 var xTextIterSetLineIndex func(uintptr, int)
 
-// Same as [method@Gtk.TextIter.set_line_offset], but works with a
+// Same as [TextIter.SetLineOffset], but works with a
 // byte index. The given byte index must be at
 // the start of a character, it can’t be in the middle of a UTF-8
 // encoded character.
@@ -1246,11 +1257,11 @@ func (x *TextIter) SetLineIndex(ByteOnLineVar int) {
 
 var xTextIterSetLineOffset func(uintptr, int)
 
-// Moves @iter within a line, to a new character (not byte) offset.
+// Moves iter within a line, to a new character (not byte) offset.
 //
 // The given character offset must be less than or equal to the number
-// of characters in the line; if equal, @iter moves to the start of the
-// next line. See [method@Gtk.TextIter.set_line_index] if you have a byte
+// of characters in the line; if equal, iter moves to the start of the
+// next line. See [TextIter.SetLineIndex] if you have a byte
 // index rather than a character offset.
 func (x *TextIter) SetLineOffset(CharOnLineVar int) {
 
@@ -1260,9 +1271,9 @@ func (x *TextIter) SetLineOffset(CharOnLineVar int) {
 
 var xTextIterSetOffset func(uintptr, int)
 
-// Sets @iter to point to @char_offset.
+// Sets iter to point to char_offset.
 //
-// @char_offset counts from the start
+// char_offset counts from the start
 // of the entire text buffer, starting with 0.
 func (x *TextIter) SetOffset(CharOffsetVar int) {
 
@@ -1272,7 +1283,7 @@ func (x *TextIter) SetOffset(CharOffsetVar int) {
 
 var xTextIterSetVisibleLineIndex func(uintptr, int)
 
-// Like [method@Gtk.TextIter.set_line_index], but the index is in visible
+// Like [TextIter.SetLineIndex], but the index is in visible
 // bytes, i.e. text with a tag making it invisible is not counted
 // in the index.
 func (x *TextIter) SetVisibleLineIndex(ByteOnLineVar int) {
@@ -1283,7 +1294,7 @@ func (x *TextIter) SetVisibleLineIndex(ByteOnLineVar int) {
 
 var xTextIterSetVisibleLineOffset func(uintptr, int)
 
-// Like [method@Gtk.TextIter.set_line_offset], but the offset is in visible
+// Like [TextIter.SetLineOffset], but the offset is in visible
 // characters, i.e. text with a tag making it invisible is not
 // counted in the offset.
 func (x *TextIter) SetVisibleLineOffset(CharOnLineVar int) {
@@ -1294,11 +1305,11 @@ func (x *TextIter) SetVisibleLineOffset(CharOnLineVar int) {
 
 var xTextIterStartsLine func(uintptr) bool
 
-// Returns %TRUE if @iter begins a paragraph.
+// Returns true if iter begins a paragraph.
 //
-// This is the case if [method@Gtk.TextIter.get_line_offset]
+// This is the case if [TextIter.GetLineOffset]
 // would return 0. However this function is potentially more
-// efficient than [method@Gtk.TextIter.get_line_offset], because
+// efficient than [TextIter.GetLineOffset], because
 // it doesn’t have to compute the offset, it just has to see
 // whether it’s 0.
 func (x *TextIter) StartsLine() bool {
@@ -1309,7 +1320,7 @@ func (x *TextIter) StartsLine() bool {
 
 var xTextIterStartsSentence func(uintptr) bool
 
-// Determines whether @iter begins a sentence.
+// Determines whether iter begins a sentence.
 //
 // Sentence boundaries are determined by Pango and
 // should be correct for nearly any language.
@@ -1321,16 +1332,16 @@ func (x *TextIter) StartsSentence() bool {
 
 var xTextIterStartsTag func(uintptr, uintptr) bool
 
-// Returns %TRUE if @tag is toggled on at exactly this point.
+// Returns true if tag is toggled on at exactly this point.
 //
-// If @tag is %NULL, returns %TRUE if any tag is toggled on at this point.
+// If tag is nil, returns true if any tag is toggled on at this point.
 //
-// Note that if this function returns %TRUE, it means that
-// @iter is at the beginning of the tagged range, and that the
-// character at @iter is inside the tagged range. In other
-// words, unlike [method@Gtk.TextIter.ends_tag], if
-// this function returns %TRUE, [method@Gtk.TextIter.has_tag]
-// will also return %TRUE for the same parameters.
+// Note that if this function returns true, it means that
+// iter is at the beginning of the tagged range, and that the
+// character at iter is inside the tagged range. In other
+// words, unlike [TextIter.EndsTag], if
+// this function returns true, [TextIter.HasTag]
+// will also return true for the same parameters.
 func (x *TextIter) StartsTag(TagVar *TextTag) bool {
 
 	cret := xTextIterStartsTag(x.GoPointer(), TagVar.GoPointer())
@@ -1339,7 +1350,7 @@ func (x *TextIter) StartsTag(TagVar *TextTag) bool {
 
 var xTextIterStartsWord func(uintptr) bool
 
-// Determines whether @iter begins a natural-language word.
+// Determines whether iter begins a natural-language word.
 //
 // Word breaks are determined by Pango and should be correct
 // for nearly any language.
@@ -1351,11 +1362,11 @@ func (x *TextIter) StartsWord() bool {
 
 var xTextIterTogglesTag func(uintptr, uintptr) bool
 
-// Gets whether a range with @tag applied to it begins
-// or ends at @iter.
+// Gets whether a range with tag applied to it begins
+// or ends at iter.
 //
-// This is equivalent to (gtk_text_iter_starts_tag() ||
-// gtk_text_iter_ends_tag())
+// This is equivalent to ([TextIter.StartsTag] ||
+// [TextIter.EndsTag])
 func (x *TextIter) TogglesTag(TagVar *TextTag) bool {
 
 	cret := xTextIterTogglesTag(x.GoPointer(), TagVar.GoPointer())
@@ -1367,7 +1378,7 @@ func (x *TextIter) TogglesTag(TagVar *TextTag) bool {
 // If neither `GTK_TEXT_SEARCH_VISIBLE_ONLY` nor `GTK_TEXT_SEARCH_TEXT_ONLY`
 // are enabled, the match must be exact; the special 0xFFFC character will
 // match embedded paintables or child widgets.
-type TextSearchFlags int
+type TextSearchFlags uint32
 
 var xTextSearchFlagsGLibType func() types.GType
 
@@ -1388,12 +1399,57 @@ const (
 	TextSearchCaseInsensitiveValue TextSearchFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TextSearchFlags) GLibType() types.GType {
+	return xTextSearchFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x TextSearchFlags) Has(flags TextSearchFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x TextSearchFlags) Set(flags TextSearchFlags) TextSearchFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x TextSearchFlags) Clear(flags TextSearchFlags) TextSearchFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TextSearchFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&TextSearchVisibleOnlyValue != 0 && x&TextSearchVisibleOnlyValue == TextSearchVisibleOnlyValue {
+		names = append(names, "GTK_TEXT_SEARCH_VISIBLE_ONLY")
+		rest &^= TextSearchVisibleOnlyValue
+	}
+	if rest&TextSearchTextOnlyValue != 0 && x&TextSearchTextOnlyValue == TextSearchTextOnlyValue {
+		names = append(names, "GTK_TEXT_SEARCH_TEXT_ONLY")
+		rest &^= TextSearchTextOnlyValue
+	}
+	if rest&TextSearchCaseInsensitiveValue != 0 && x&TextSearchCaseInsensitiveValue == TextSearchCaseInsensitiveValue {
+		names = append(names, "GTK_TEXT_SEARCH_CASE_INSENSITIVE")
+		rest &^= TextSearchCaseInsensitiveValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 func init() {
 	core.SetPackageName("GTK", "gtk4")
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1404,95 +1460,95 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextIterGLibType, libs, "gtk_text_iter_get_type")
 
-	core.PuregoSafeRegister(&xTextIterAssign, libs, "gtk_text_iter_assign")
-	core.PuregoSafeRegister(&xTextIterBackwardChar, libs, "gtk_text_iter_backward_char")
-	core.PuregoSafeRegister(&xTextIterBackwardChars, libs, "gtk_text_iter_backward_chars")
-	core.PuregoSafeRegister(&xTextIterBackwardCursorPosition, libs, "gtk_text_iter_backward_cursor_position")
-	core.PuregoSafeRegister(&xTextIterBackwardCursorPositions, libs, "gtk_text_iter_backward_cursor_positions")
-	core.PuregoSafeRegister(&xTextIterBackwardFindChar, libs, "gtk_text_iter_backward_find_char")
-	core.PuregoSafeRegister(&xTextIterBackwardLine, libs, "gtk_text_iter_backward_line")
-	core.PuregoSafeRegister(&xTextIterBackwardLines, libs, "gtk_text_iter_backward_lines")
-	core.PuregoSafeRegister(&xTextIterBackwardSearch, libs, "gtk_text_iter_backward_search")
-	core.PuregoSafeRegister(&xTextIterBackwardSentenceStart, libs, "gtk_text_iter_backward_sentence_start")
-	core.PuregoSafeRegister(&xTextIterBackwardSentenceStarts, libs, "gtk_text_iter_backward_sentence_starts")
-	core.PuregoSafeRegister(&xTextIterBackwardToTagToggle, libs, "gtk_text_iter_backward_to_tag_toggle")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleCursorPosition, libs, "gtk_text_iter_backward_visible_cursor_position")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleCursorPositions, libs, "gtk_text_iter_backward_visible_cursor_positions")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleLine, libs, "gtk_text_iter_backward_visible_line")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleLines, libs, "gtk_text_iter_backward_visible_lines")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleWordStart, libs, "gtk_text_iter_backward_visible_word_start")
-	core.PuregoSafeRegister(&xTextIterBackwardVisibleWordStarts, libs, "gtk_text_iter_backward_visible_word_starts")
-	core.PuregoSafeRegister(&xTextIterBackwardWordStart, libs, "gtk_text_iter_backward_word_start")
-	core.PuregoSafeRegister(&xTextIterBackwardWordStarts, libs, "gtk_text_iter_backward_word_starts")
-	core.PuregoSafeRegister(&xTextIterCanInsert, libs, "gtk_text_iter_can_insert")
-	core.PuregoSafeRegister(&xTextIterCompare, libs, "gtk_text_iter_compare")
-	core.PuregoSafeRegister(&xTextIterCopy, libs, "gtk_text_iter_copy")
-	core.PuregoSafeRegister(&xTextIterEditable, libs, "gtk_text_iter_editable")
-	core.PuregoSafeRegister(&xTextIterEndsLine, libs, "gtk_text_iter_ends_line")
-	core.PuregoSafeRegister(&xTextIterEndsSentence, libs, "gtk_text_iter_ends_sentence")
-	core.PuregoSafeRegister(&xTextIterEndsTag, libs, "gtk_text_iter_ends_tag")
-	core.PuregoSafeRegister(&xTextIterEndsWord, libs, "gtk_text_iter_ends_word")
-	core.PuregoSafeRegister(&xTextIterEqual, libs, "gtk_text_iter_equal")
-	core.PuregoSafeRegister(&xTextIterForwardChar, libs, "gtk_text_iter_forward_char")
-	core.PuregoSafeRegister(&xTextIterForwardChars, libs, "gtk_text_iter_forward_chars")
-	core.PuregoSafeRegister(&xTextIterForwardCursorPosition, libs, "gtk_text_iter_forward_cursor_position")
-	core.PuregoSafeRegister(&xTextIterForwardCursorPositions, libs, "gtk_text_iter_forward_cursor_positions")
-	core.PuregoSafeRegister(&xTextIterForwardFindChar, libs, "gtk_text_iter_forward_find_char")
-	core.PuregoSafeRegister(&xTextIterForwardLine, libs, "gtk_text_iter_forward_line")
-	core.PuregoSafeRegister(&xTextIterForwardLines, libs, "gtk_text_iter_forward_lines")
-	core.PuregoSafeRegister(&xTextIterForwardSearch, libs, "gtk_text_iter_forward_search")
-	core.PuregoSafeRegister(&xTextIterForwardSentenceEnd, libs, "gtk_text_iter_forward_sentence_end")
-	core.PuregoSafeRegister(&xTextIterForwardSentenceEnds, libs, "gtk_text_iter_forward_sentence_ends")
-	core.PuregoSafeRegister(&xTextIterForwardToEnd, libs, "gtk_text_iter_forward_to_end")
-	core.PuregoSafeRegister(&xTextIterForwardToLineEnd, libs, "gtk_text_iter_forward_to_line_end")
-	core.PuregoSafeRegister(&xTextIterForwardToTagToggle, libs, "gtk_text_iter_forward_to_tag_toggle")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleCursorPosition, libs, "gtk_text_iter_forward_visible_cursor_position")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleCursorPositions, libs, "gtk_text_iter_forward_visible_cursor_positions")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleLine, libs, "gtk_text_iter_forward_visible_line")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleLines, libs, "gtk_text_iter_forward_visible_lines")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleWordEnd, libs, "gtk_text_iter_forward_visible_word_end")
-	core.PuregoSafeRegister(&xTextIterForwardVisibleWordEnds, libs, "gtk_text_iter_forward_visible_word_ends")
-	core.PuregoSafeRegister(&xTextIterForwardWordEnd, libs, "gtk_text_iter_forward_word_end")
-	core.PuregoSafeRegister(&xTextIterForwardWordEnds, libs, "gtk_text_iter_forward_word_ends")
-	core.PuregoSafeRegister(&xTextIterFree, libs, "gtk_text_iter_free")
-	core.PuregoSafeRegister(&xTextIterGetBuffer, libs, "gtk_text_iter_get_buffer")
-	core.PuregoSafeRegister(&xTextIterGetBytesInLine, libs, "gtk_text_iter_get_bytes_in_line")
-	core.PuregoSafeRegister(&xTextIterGetChar, libs, "gtk_text_iter_get_char")
-	core.PuregoSafeRegister(&xTextIterGetCharsInLine, libs, "gtk_text_iter_get_chars_in_line")
-	core.PuregoSafeRegister(&xTextIterGetChildAnchor, libs, "gtk_text_iter_get_child_anchor")
-	core.PuregoSafeRegister(&xTextIterGetLanguage, libs, "gtk_text_iter_get_language")
-	core.PuregoSafeRegister(&xTextIterGetLine, libs, "gtk_text_iter_get_line")
-	core.PuregoSafeRegister(&xTextIterGetLineIndex, libs, "gtk_text_iter_get_line_index")
-	core.PuregoSafeRegister(&xTextIterGetLineOffset, libs, "gtk_text_iter_get_line_offset")
-	core.PuregoSafeRegister(&xTextIterGetMarks, libs, "gtk_text_iter_get_marks")
-	core.PuregoSafeRegister(&xTextIterGetOffset, libs, "gtk_text_iter_get_offset")
-	core.PuregoSafeRegister(&xTextIterGetPaintable, libs, "gtk_text_iter_get_paintable")
-	core.PuregoSafeRegister(&xTextIterGetSlice, libs, "gtk_text_iter_get_slice")
-	core.PuregoSafeRegister(&xTextIterGetTags, libs, "gtk_text_iter_get_tags")
-	core.PuregoSafeRegister(&xTextIterGetText, libs, "gtk_text_iter_get_text")
-	core.PuregoSafeRegister(&xTextIterGetToggledTags, libs, "gtk_text_iter_get_toggled_tags")
-	core.PuregoSafeRegister(&xTextIterGetVisibleLineIndex, libs, "gtk_text_iter_get_visible_line_index")
-	core.PuregoSafeRegister(&xTextIterGetVisibleLineOffset, libs, "gtk_text_iter_get_visible_line_offset")
-	core.PuregoSafeRegister(&xTextIterGetVisibleSlice, libs, "gtk_text_iter_get_visible_slice")
-	core.PuregoSafeRegister(&xTextIterGetVisibleText, libs, "gtk_text_iter_get_visible_text")
-	core.PuregoSafeRegister(&xTextIterHasTag, libs, "gtk_text_iter_has_tag")
-	core.PuregoSafeRegister(&xTextIterInRange, libs, "gtk_text_iter_in_range")
-	core.PuregoSafeRegister(&xTextIterInsideSentence, libs, "gtk_text_iter_inside_sentence")
-	core.PuregoSafeRegister(&xTextIterInsideWord, libs, "gtk_text_iter_inside_word")
-	core.PuregoSafeRegister(&xTextIterIsCursorPosition, libs, "gtk_text_iter_is_cursor_position")
-	core.PuregoSafeRegister(&xTextIterIsEnd, libs, "gtk_text_iter_is_end")
-	core.PuregoSafeRegister(&xTextIterIsStart, libs, "gtk_text_iter_is_start")
-	core.PuregoSafeRegister(&xTextIterOrder, libs, "gtk_text_iter_order")
-	core.PuregoSafeRegister(&xTextIterSetLine, libs, "gtk_text_iter_set_line")
-	core.PuregoSafeRegister(&xTextIterSetLineIndex, libs, "gtk_text_iter_set_line_index")
-	core.PuregoSafeRegister(&xTextIterSetLineOffset, libs, "gtk_text_iter_set_line_offset")
-	core.PuregoSafeRegister(&xTextIterSetOffset, libs, "gtk_text_iter_set_offset")
-	core.PuregoSafeRegister(&xTextIterSetVisibleLineIndex, libs, "gtk_text_iter_set_visible_line_index")
-	core.PuregoSafeRegister(&xTextIterSetVisibleLineOffset, libs, "gtk_text_iter_set_visible_line_offset")
-	core.PuregoSafeRegister(&xTextIterStartsLine, libs, "gtk_text_iter_starts_line")
-	core.PuregoSafeRegister(&xTextIterStartsSentence, libs, "gtk_text_iter_starts_sentence")
-	core.PuregoSafeRegister(&xTextIterStartsTag, libs, "gtk_text_iter_starts_tag")
-	core.PuregoSafeRegister(&xTextIterStartsWord, libs, "gtk_text_iter_starts_word")
-	core.PuregoSafeRegister(&xTextIterTogglesTag, libs, "gtk_text_iter_toggles_tag")
+	core.PuregoRegisterSince(&xTextIterAssign, libs, "gtk_text_iter_assign", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardChar, libs, "gtk_text_iter_backward_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardChars, libs, "gtk_text_iter_backward_chars", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardCursorPosition, libs, "gtk_text_iter_backward_cursor_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardCursorPositions, libs, "gtk_text_iter_backward_cursor_positions", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardFindChar, libs, "gtk_text_iter_backward_find_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardLine, libs, "gtk_text_iter_backward_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardLines, libs, "gtk_text_iter_backward_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardSearch, libs, "gtk_text_iter_backward_search", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardSentenceStart, libs, "gtk_text_iter_backward_sentence_start", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardSentenceStarts, libs, "gtk_text_iter_backward_sentence_starts", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardToTagToggle, libs, "gtk_text_iter_backward_to_tag_toggle", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleCursorPosition, libs, "gtk_text_iter_backward_visible_cursor_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleCursorPositions, libs, "gtk_text_iter_backward_visible_cursor_positions", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleLine, libs, "gtk_text_iter_backward_visible_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleLines, libs, "gtk_text_iter_backward_visible_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleWordStart, libs, "gtk_text_iter_backward_visible_word_start", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardVisibleWordStarts, libs, "gtk_text_iter_backward_visible_word_starts", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardWordStart, libs, "gtk_text_iter_backward_word_start", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterBackwardWordStarts, libs, "gtk_text_iter_backward_word_starts", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterCanInsert, libs, "gtk_text_iter_can_insert", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterCompare, libs, "gtk_text_iter_compare", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterCopy, libs, "gtk_text_iter_copy", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEditable, libs, "gtk_text_iter_editable", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEndsLine, libs, "gtk_text_iter_ends_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEndsSentence, libs, "gtk_text_iter_ends_sentence", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEndsTag, libs, "gtk_text_iter_ends_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEndsWord, libs, "gtk_text_iter_ends_word", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterEqual, libs, "gtk_text_iter_equal", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardChar, libs, "gtk_text_iter_forward_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardChars, libs, "gtk_text_iter_forward_chars", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardCursorPosition, libs, "gtk_text_iter_forward_cursor_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardCursorPositions, libs, "gtk_text_iter_forward_cursor_positions", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardFindChar, libs, "gtk_text_iter_forward_find_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardLine, libs, "gtk_text_iter_forward_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardLines, libs, "gtk_text_iter_forward_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardSearch, libs, "gtk_text_iter_forward_search", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardSentenceEnd, libs, "gtk_text_iter_forward_sentence_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardSentenceEnds, libs, "gtk_text_iter_forward_sentence_ends", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardToEnd, libs, "gtk_text_iter_forward_to_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardToLineEnd, libs, "gtk_text_iter_forward_to_line_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardToTagToggle, libs, "gtk_text_iter_forward_to_tag_toggle", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleCursorPosition, libs, "gtk_text_iter_forward_visible_cursor_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleCursorPositions, libs, "gtk_text_iter_forward_visible_cursor_positions", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleLine, libs, "gtk_text_iter_forward_visible_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleLines, libs, "gtk_text_iter_forward_visible_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleWordEnd, libs, "gtk_text_iter_forward_visible_word_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardVisibleWordEnds, libs, "gtk_text_iter_forward_visible_word_ends", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardWordEnd, libs, "gtk_text_iter_forward_word_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterForwardWordEnds, libs, "gtk_text_iter_forward_word_ends", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterFree, libs, "gtk_text_iter_free", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetBuffer, libs, "gtk_text_iter_get_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetBytesInLine, libs, "gtk_text_iter_get_bytes_in_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetChar, libs, "gtk_text_iter_get_char", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetCharsInLine, libs, "gtk_text_iter_get_chars_in_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetChildAnchor, libs, "gtk_text_iter_get_child_anchor", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetLanguage, libs, "gtk_text_iter_get_language", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetLine, libs, "gtk_text_iter_get_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetLineIndex, libs, "gtk_text_iter_get_line_index", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetLineOffset, libs, "gtk_text_iter_get_line_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetMarks, libs, "gtk_text_iter_get_marks", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetOffset, libs, "gtk_text_iter_get_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetPaintable, libs, "gtk_text_iter_get_paintable", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetSlice, libs, "gtk_text_iter_get_slice", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetTags, libs, "gtk_text_iter_get_tags", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetText, libs, "gtk_text_iter_get_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetToggledTags, libs, "gtk_text_iter_get_toggled_tags", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetVisibleLineIndex, libs, "gtk_text_iter_get_visible_line_index", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetVisibleLineOffset, libs, "gtk_text_iter_get_visible_line_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetVisibleSlice, libs, "gtk_text_iter_get_visible_slice", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterGetVisibleText, libs, "gtk_text_iter_get_visible_text", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterHasTag, libs, "gtk_text_iter_has_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterInRange, libs, "gtk_text_iter_in_range", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterInsideSentence, libs, "gtk_text_iter_inside_sentence", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterInsideWord, libs, "gtk_text_iter_inside_word", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterIsCursorPosition, libs, "gtk_text_iter_is_cursor_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterIsEnd, libs, "gtk_text_iter_is_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterIsStart, libs, "gtk_text_iter_is_start", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterOrder, libs, "gtk_text_iter_order", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetLine, libs, "gtk_text_iter_set_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetLineIndex, libs, "gtk_text_iter_set_line_index", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetLineOffset, libs, "gtk_text_iter_set_line_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetOffset, libs, "gtk_text_iter_set_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetVisibleLineIndex, libs, "gtk_text_iter_set_visible_line_index", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterSetVisibleLineOffset, libs, "gtk_text_iter_set_visible_line_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterStartsLine, libs, "gtk_text_iter_starts_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterStartsSentence, libs, "gtk_text_iter_starts_sentence", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterStartsTag, libs, "gtk_text_iter_starts_tag", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterStartsWord, libs, "gtk_text_iter_starts_word", "GTK", "")
+	core.PuregoRegisterSince(&xTextIterTogglesTag, libs, "gtk_text_iter_toggles_tag", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -27,13 +26,13 @@ func (x *TextMarkClass) GoPointer() uintptr {
 // across modifications.
 //
 // You may wish to begin by reading the
-// [text widget conceptual overview](section-text-widget.html),
+// text widget conceptual overview,
 // which gives an overview of all the objects and data types
 // related to the text widget and how they work together.
 //
 // A `GtkTextMark` is like a bookmark in a text buffer; it preserves
 // a position in the text. You can convert the mark to an iterator using
-// [method@Gtk.TextBuffer.get_iter_at_mark]. Unlike iterators, marks remain
+// [TextBuffer.GetIterAtMark]. Unlike iterators, marks remain
 // valid across buffer mutations, because their behavior is defined when
 // text is inserted or deleted. When text containing a mark is deleted,
 // the mark remains in the position originally occupied by the deleted
@@ -48,13 +47,13 @@ func (x *TextMarkClass) GoPointer() uintptr {
 //
 // Marks are reference counted, but the reference count only controls
 // the validity of the memory; marks can be deleted from the buffer at
-// any time with [method@Gtk.TextBuffer.delete_mark]. Once deleted from
+// any time with [TextBuffer.DeleteMark]. Once deleted from
 // the buffer, a mark is essentially useless.
 //
 // Marks optionally have names; these can be convenient to avoid passing
 // the `GtkTextMark` object around.
 //
-// Marks are typically created using the [method@Gtk.TextBuffer.create_mark]
+// Marks are typically created using the [TextBuffer.CreateMark]
 // function.
 type TextMark struct {
 	gobject.Object
@@ -76,12 +75,12 @@ var xNewTextMark func(uintptr, bool) uintptr
 
 // Creates a text mark.
 //
-// Add it to a buffer using [method@Gtk.TextBuffer.add_mark].
-// If @name is %NULL, the mark is anonymous; otherwise, the mark can be
-// retrieved by name using [method@Gtk.TextBuffer.get_mark]. If a mark
+// Add it to a buffer using [TextBuffer.AddMark].
+// If name is nil, the mark is anonymous; otherwise, the mark can be
+// retrieved by name using [TextBuffer.GetMark]. If a mark
 // has left gravity, and text is inserted at the mark’s current location,
 // the mark will be moved to the left of the newly-inserted text. If the
-// mark has right gravity (@left_gravity = %FALSE), the mark will end up
+// mark has right gravity (left_gravity = false), the mark will end up
 // on the right of newly-inserted text. The standard left-to-right cursor
 // is a mark with right gravity (when you type, the cursor stays on the
 // right side of the text you’re typing).
@@ -105,7 +104,7 @@ var xTextMarkGetBuffer func(uintptr) uintptr
 
 // Gets the buffer this mark is located inside.
 //
-// Returns %NULL if the mark is deleted.
+// Returns nil if the mark is deleted.
 func (x *TextMark) GetBuffer() *TextBuffer {
 	var cls *TextBuffer
 
@@ -122,9 +121,9 @@ func (x *TextMark) GetBuffer() *TextBuffer {
 
 var xTextMarkGetDeleted func(uintptr) bool
 
-// Returns %TRUE if the mark has been removed from its buffer.
+// Returns true if the mark has been removed from its buffer.
 //
-// See [method@Gtk.TextBuffer.add_mark] for a way to add it
+// See [TextBuffer.AddMark] for a way to add it
 // to a buffer again.
 func (x *TextMark) GetDeleted() bool {
 
@@ -145,7 +144,7 @@ var xTextMarkGetName func(uintptr) string
 
 // Returns the mark name.
 //
-// Returns %NULL for anonymous marks.
+// Returns nil for anonymous marks.
 func (x *TextMark) GetName() string {
 
 	cret := xTextMarkGetName(x.GoPointer())
@@ -154,7 +153,7 @@ func (x *TextMark) GetName() string {
 
 var xTextMarkGetVisible func(uintptr) bool
 
-// Returns %TRUE if the mark is visible.
+// Returns true if the mark is visible.
 //
 // A cursor is displayed for visible marks.
 func (x *TextMark) GetVisible() bool {
@@ -165,7 +164,7 @@ func (x *TextMark) GetVisible() bool {
 
 var xTextMarkSetVisible func(uintptr, bool)
 
-// Sets the visibility of @mark.
+// Sets the visibility of mark.
 //
 // The insertion point is normally visible, i.e. you can see it as
 // a vertical bar. Also, the text widget uses a visible mark to
@@ -216,7 +215,7 @@ func (x *TextMark) GetPropertyLeftGravity() bool {
 }
 
 // SetPropertyName sets the "name" property.
-// The name of the mark or %NULL if the mark is anonymous.
+// The name of the mark or nil if the mark is anonymous.
 func (x *TextMark) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -225,7 +224,7 @@ func (x *TextMark) SetPropertyName(value string) {
 }
 
 // GetPropertyName gets the "name" property.
-// The name of the mark or %NULL if the mark is anonymous.
+// The name of the mark or nil if the mark is anonymous.
 func (x *TextMark) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -237,7 +236,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -246,13 +245,15 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextMarkGLibType, libs, "gtk_text_mark_get_type")
 
-	core.PuregoSafeRegister(&xNewTextMark, libs, "gtk_text_mark_new")
+	gobject.CheckClassSize("GtkTextMarkClass", xTextMarkGLibType, unsafe.Sizeof(TextMarkClass{}))
 
-	core.PuregoSafeRegister(&xTextMarkGetBuffer, libs, "gtk_text_mark_get_buffer")
-	core.PuregoSafeRegister(&xTextMarkGetDeleted, libs, "gtk_text_mark_get_deleted")
-	core.PuregoSafeRegister(&xTextMarkGetLeftGravity, libs, "gtk_text_mark_get_left_gravity")
-	core.PuregoSafeRegister(&xTextMarkGetName, libs, "gtk_text_mark_get_name")
-	core.PuregoSafeRegister(&xTextMarkGetVisible, libs, "gtk_text_mark_get_visible")
-	core.PuregoSafeRegister(&xTextMarkSetVisible, libs, "gtk_text_mark_set_visible")
+	core.PuregoRegisterSince(&xNewTextMark, libs, "gtk_text_mark_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xTextMarkGetBuffer, libs, "gtk_text_mark_get_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextMarkGetDeleted, libs, "gtk_text_mark_get_deleted", "GTK", "")
+	core.PuregoRegisterSince(&xTextMarkGetLeftGravity, libs, "gtk_text_mark_get_left_gravity", "GTK", "")
+	core.PuregoRegisterSince(&xTextMarkGetName, libs, "gtk_text_mark_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xTextMarkGetVisible, libs, "gtk_text_mark_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTextMarkSetVisible, libs, "gtk_text_mark_set_visible", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -34,14 +33,14 @@ func (x *TextTagPrivate) GoPointer() uintptr {
 // Can be applied to text contained in a `GtkTextBuffer`.
 //
 // You may wish to begin by reading the
-// [text widget conceptual overview](section-text-widget.html),
+// text widget conceptual overview,
 // which gives an overview of all the objects and data types
 // related to the text widget and how they work together.
 //
-// Tags should be in the [class@Gtk.TextTagTable] for a given
+// Tags should be in the [TextTagTable] for a given
 // `GtkTextBuffer` before using them with that buffer.
 //
-// [method@Gtk.TextBuffer.create_tag] is the best way to create tags.
+// [TextBuffer.CreateTag] is the best way to create tags.
 // See “gtk4-demo” for numerous examples.
 //
 // For each property of `GtkTextTag`, there is a “set” property, e.g.
@@ -86,7 +85,7 @@ func NewTextTag(NameVar *string) *TextTag {
 
 var xTextTagChanged func(uintptr, bool)
 
-// Emits the [signal@Gtk.TextTagTable::tag-changed] signal on the
+// Emits the [TextTagTable.ConnectTagChangedFunc] signal on the
 // `GtkTextTagTable` where the tag is included.
 //
 // The signal is already emitted when setting a `GtkTextTag` property.
@@ -111,7 +110,7 @@ var xTextTagSetPriority func(uintptr, int)
 // Sets the priority of a `GtkTextTag`.
 //
 // Valid priorities start at 0 and go to one less than
-// [method@Gtk.TextTagTable.get_size]. Each tag in a table
+// [TextTagTable.GetSize]. Each tag in a table
 // has a unique priority; setting the priority of one tag shifts
 // the priorities of all the other tags in the table to maintain
 // a unique priority for each tag.
@@ -120,7 +119,7 @@ var xTextTagSetPriority func(uintptr, int)
 // attribute. When adding a tag to a tag table, it will be assigned
 // the highest priority in the table by default; so normally the
 // precedence of a set of tags is the order in which they were added
-// to the table, or created with [method@Gtk.TextBuffer.create_tag],
+// to the table, or created with [TextBuffer.CreateTag],
 // which adds the tag to the buffer’s table automatically.
 func (x *TextTag) SetPriority(PriorityVar int) {
 
@@ -142,8 +141,8 @@ func (c *TextTag) SetGoPointer(ptr uintptr) {
 // SetPropertyAccumulativeMargin sets the "accumulative-margin" property.
 // Whether the margins accumulate or override each other.
 //
-// When set to %TRUE the margins of this tag are added to the margins
-// of any other non-accumulative margins present. When set to %FALSE
+// When set to true the margins of this tag are added to the margins
+// of any other non-accumulative margins present. When set to false
 // the margins override one another (the default).
 func (x *TextTag) SetPropertyAccumulativeMargin(value bool) {
 	var v gobject.Value
@@ -155,8 +154,8 @@ func (x *TextTag) SetPropertyAccumulativeMargin(value bool) {
 // GetPropertyAccumulativeMargin gets the "accumulative-margin" property.
 // Whether the margins accumulate or override each other.
 //
-// When set to %TRUE the margins of this tag are added to the margins
-// of any other non-accumulative margins present. When set to %FALSE
+// When set to true the margins of this tag are added to the margins
+// of any other non-accumulative margins present. When set to false
 // the margins override one another (the default).
 func (x *TextTag) GetPropertyAccumulativeMargin() bool {
 	var v gobject.Value
@@ -314,7 +313,7 @@ func (x *TextTag) GetPropertyEditableSet() bool {
 // SetPropertyFallback sets the "fallback" property.
 // Whether font fallback is enabled.
 //
-// When set to %TRUE, other fonts will be substituted
+// When set to true, other fonts will be substituted
 // where the current font is missing glyphs.
 func (x *TextTag) SetPropertyFallback(value bool) {
 	var v gobject.Value
@@ -326,7 +325,7 @@ func (x *TextTag) SetPropertyFallback(value bool) {
 // GetPropertyFallback gets the "fallback" property.
 // Whether font fallback is enabled.
 //
-// When set to %TRUE, other fonts will be substituted
+// When set to true, other fonts will be substituted
 // where the current font is missing glyphs.
 func (x *TextTag) GetPropertyFallback() bool {
 	var v gobject.Value
@@ -644,7 +643,7 @@ func (x *TextTag) GetPropertyJustificationSet() bool {
 // If not set, an appropriate default will be used.
 //
 // Note that the initial value of this property depends
-// on the current locale, see also [func@Gtk.get_default_language].
+// on the current locale, see also [GetDefaultLanguage].
 func (x *TextTag) SetPropertyLanguage(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -659,7 +658,7 @@ func (x *TextTag) SetPropertyLanguage(value string) {
 // If not set, an appropriate default will be used.
 //
 // Note that the initial value of this property depends
-// on the current locale, see also [func@Gtk.get_default_language].
+// on the current locale, see also [GetDefaultLanguage].
 func (x *TextTag) GetPropertyLanguage() string {
 	var v gobject.Value
 	x.GetProperty("language", &v)
@@ -788,7 +787,7 @@ func (x *TextTag) GetPropertyLineHeightSet() bool {
 // SetPropertyName sets the "name" property.
 // The name used to refer to the tag.
 //
-// %NULL for anonymous tags.
+// nil for anonymous tags.
 func (x *TextTag) SetPropertyName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -799,7 +798,7 @@ func (x *TextTag) SetPropertyName(value string) {
 // GetPropertyName gets the "name" property.
 // The name used to refer to the tag.
 //
-// %NULL for anonymous tags.
+// nil for anonymous tags.
 func (x *TextTag) GetPropertyName() string {
 	var v gobject.Value
 	x.GetProperty("name", &v)
@@ -1082,7 +1081,7 @@ func (x *TextTag) GetPropertyRiseSet() bool {
 // Font size as a scale factor relative to the default font size.
 //
 // This properly adapts to theme changes, etc. so is recommended.
-// Pango predefines some scales such as %PANGO_SCALE_X_LARGE.
+// Pango predefines some scales such as PANGO_SCALE_X_LARGE.
 func (x *TextTag) SetPropertyScale(value float64) {
 	var v gobject.Value
 	v.Init(gobject.TypeDoubleVal)
@@ -1094,7 +1093,7 @@ func (x *TextTag) SetPropertyScale(value float64) {
 // Font size as a scale factor relative to the default font size.
 //
 // This properly adapts to theme changes, etc. so is recommended.
-// Pango predefines some scales such as %PANGO_SCALE_X_LARGE.
+// Pango predefines some scales such as PANGO_SCALE_X_LARGE.
 func (x *TextTag) GetPropertyScale() float64 {
 	var v gobject.Value
 	x.GetProperty("scale", &v)
@@ -1386,7 +1385,7 @@ func (x *TextTag) GetPropertyTextTransformSet() bool {
 //
 // If not set, underlines will use the foreground color.
 //
-// If [property@Gtk.TextTag:underline] is set to %PANGO_UNDERLINE_ERROR,
+// If TextTag:underline is set to [pango.UnderlineErrorValue],
 // an alternate color may be applied instead of the foreground. Setting
 // this property will always override those defaults.
 func (x *TextTag) SetPropertyUnderlineRgba(value uintptr) {
@@ -1401,7 +1400,7 @@ func (x *TextTag) SetPropertyUnderlineRgba(value uintptr) {
 //
 // If not set, underlines will use the foreground color.
 //
-// If [property@Gtk.TextTag:underline] is set to %PANGO_UNDERLINE_ERROR,
+// If TextTag:underline is set to [pango.UnderlineErrorValue],
 // an alternate color may be applied instead of the foreground. Setting
 // this property will always override those defaults.
 func (x *TextTag) GetPropertyUnderlineRgba() uintptr {
@@ -1555,7 +1554,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1564,10 +1563,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextTagGLibType, libs, "gtk_text_tag_get_type")
 
-	core.PuregoSafeRegister(&xNewTextTag, libs, "gtk_text_tag_new")
+	gobject.CheckClassSize("GtkTextTagClass", xTextTagGLibType, unsafe.Sizeof(TextTagClass{}))
 
-	core.PuregoSafeRegister(&xTextTagChanged, libs, "gtk_text_tag_changed")
-	core.PuregoSafeRegister(&xTextTagGetPriority, libs, "gtk_text_tag_get_priority")
-	core.PuregoSafeRegister(&xTextTagSetPriority, libs, "gtk_text_tag_set_priority")
+	core.PuregoRegisterSince(&xNewTextTag, libs, "gtk_text_tag_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xTextTagChanged, libs, "gtk_text_tag_changed", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagGetPriority, libs, "gtk_text_tag_get_priority", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagSetPriority, libs, "gtk_text_tag_set_priority", "GTK", "")
 
 }
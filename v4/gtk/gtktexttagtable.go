@@ -4,21 +4,20 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A function used with gtk_text_tag_table_foreach(),
+// A function used with [TextTagTable.Foreach],
 // to iterate over every `GtkTextTag` inside a `GtkTextTagTable`.
 type TextTagTableForeach func(uintptr, uintptr)
 
 // Collects the tags in a `GtkTextBuffer`.
 //
 // You may wish to begin by reading the
-// [text widget conceptual overview](section-text-widget.html),
+// text widget conceptual overview,
 // which gives an overview of all the objects and data types
 // related to the text widget and how they work together.
 //
@@ -26,18 +25,15 @@ type TextTagTableForeach func(uintptr, uintptr)
 //
 // The `GtkTextTagTable` implementation of the `GtkBuildable` interface
 // supports adding tags by specifying “tag” as the “type” attribute
-// of a `&lt;child&gt;` element.
+// of a `<child>` element.
 //
 // An example of a UI definition fragment specifying tags:
-// ```xml
-// &lt;object class="GtkTextTagTable"&gt;
 //
-//	&lt;child type="tag"&gt;
-//	  &lt;object class="GtkTextTag"/&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	<object class="GtkTextTagTable">
+//	 <child type="tag">
+//	   <object class="GtkTextTag"/>
+//	 </child>
+//	</object>
 type TextTagTable struct {
 	gobject.Object
 }
@@ -78,7 +74,7 @@ var xTextTagTableAdd func(uintptr, uintptr) bool
 //
 // The tag is assigned the highest priority in the table.
 //
-// @tag must not be in a tag table already, and may not have
+// tag must not be in a tag table already, and may not have
 // the same name as an already-added tag.
 func (x *TextTagTable) Add(TagVar *TextTag) bool {
 
@@ -88,7 +84,7 @@ func (x *TextTagTable) Add(TagVar *TextTag) bool {
 
 var xTextTagTableForeach func(uintptr, uintptr, uintptr)
 
-// Calls @func on each tag in @table, with user data @data.
+// Calls func on each tag in table, with user data data.
 //
 // Note that the table may not be modified while iterating
 // over it (you can’t add/remove tags).
@@ -101,11 +97,12 @@ func (x *TextTagTable) Foreach(FuncVar *TextTagTableForeach, DataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -143,7 +140,7 @@ var xTextTagTableRemove func(uintptr, uintptr)
 
 // Remove a tag from the table.
 //
-// If a `GtkTextBuffer` has @table as its tag table, the tag is
+// If a `GtkTextBuffer` has table as its tag table, the tag is
 // removed from the buffer. The table’s reference to the tag is
 // removed, so the tag will end up destroyed if you don’t have
 // a reference to it.
@@ -165,84 +162,150 @@ func (c *TextTagTable) SetGoPointer(ptr uintptr) {
 }
 
 // Emitted every time a new tag is added in the `GtkTextTagTable`.
+//
+// Deprecated: use ConnectTagAddedFunc, which also accepts method values and closures.
 func (x *TextTagTable) ConnectTagAdded(cb *func(TextTagTable, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tag-added", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TagVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextTagTable{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TagVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tag-added", cbRefPtr, cbPtr)
+}
+
+var xTextTagTableTagAddedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TagVarp uintptr) {
+func xTextTagTableTagAddedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TagVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextTagTable, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextTagTable{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TagVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tag-added", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectTagAddedFunc connects cb to the "tag-added" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextTagTable) ConnectTagAddedFunc(cb func(TextTagTable, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tag-added", &xTextTagTableTagAddedTrampoline, xTextTagTableTagAddedNewTrampoline, cb)
 }
 
 // Emitted every time a tag in the `GtkTextTagTable` changes.
+//
+// Deprecated: use ConnectTagChangedFunc, which also accepts method values and closures.
 func (x *TextTagTable) ConnectTagChanged(cb *func(TextTagTable, uintptr, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tag-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TagVarp uintptr, SizeChangedVarp bool) {
+			defer core.RecoverPanic()
+			fa := TextTagTable{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TagVarp, SizeChangedVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tag-changed", cbRefPtr, cbPtr)
+}
+
+var xTextTagTableTagChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TagVarp uintptr, SizeChangedVarp bool) {
+func xTextTagTableTagChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TagVarp uintptr, SizeChangedVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextTagTable, uintptr, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextTagTable{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TagVarp, SizeChangedVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tag-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectTagChangedFunc connects cb to the "tag-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextTagTable) ConnectTagChangedFunc(cb func(TextTagTable, uintptr, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tag-changed", &xTextTagTableTagChangedTrampoline, xTextTagTableTagChangedNewTrampoline, cb)
 }
 
 // Emitted every time a tag is removed from the `GtkTextTagTable`.
 //
-// The @tag is still valid by the time the signal is emitted, but
+// The tag is still valid by the time the signal is emitted, but
 // it is not associated with a tag table any more.
+//
+// Deprecated: use ConnectTagRemovedFunc, which also accepts method values and closures.
 func (x *TextTagTable) ConnectTagRemoved(cb *func(TextTagTable, uintptr)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "tag-removed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TagVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := TextTagTable{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TagVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "tag-removed", cbRefPtr, cbPtr)
+}
+
+var xTextTagTableTagRemovedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TagVarp uintptr) {
+func xTextTagTableTagRemovedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TagVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextTagTable, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextTagTable{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TagVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "tag-removed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets the ID of the @buildable object.
+// ConnectTagRemovedFunc connects cb to the "tag-removed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextTagTable) ConnectTagRemovedFunc(cb func(TextTagTable, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "tag-removed", &xTextTagTableTagRemovedTrampoline, xTextTagTableTagRemovedNewTrampoline, cb)
+}
+
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TextTagTable) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -254,7 +317,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -263,12 +326,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextTagTableGLibType, libs, "gtk_text_tag_table_get_type")
 
-	core.PuregoSafeRegister(&xNewTextTagTable, libs, "gtk_text_tag_table_new")
+	core.PuregoRegisterSince(&xNewTextTagTable, libs, "gtk_text_tag_table_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xTextTagTableAdd, libs, "gtk_text_tag_table_add")
-	core.PuregoSafeRegister(&xTextTagTableForeach, libs, "gtk_text_tag_table_foreach")
-	core.PuregoSafeRegister(&xTextTagTableGetSize, libs, "gtk_text_tag_table_get_size")
-	core.PuregoSafeRegister(&xTextTagTableLookup, libs, "gtk_text_tag_table_lookup")
-	core.PuregoSafeRegister(&xTextTagTableRemove, libs, "gtk_text_tag_table_remove")
+	core.PuregoRegisterSince(&xTextTagTableAdd, libs, "gtk_text_tag_table_add", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagTableForeach, libs, "gtk_text_tag_table_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagTableGetSize, libs, "gtk_text_tag_table_get_size", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagTableLookup, libs, "gtk_text_tag_table_lookup", "GTK", "")
+	core.PuregoRegisterSince(&xTextTagTableRemove, libs, "gtk_text_tag_table_remove", "GTK", "")
 
 }
@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -61,7 +61,8 @@ func (x *TextViewClass) OverrideMoveCursor(cb func(*TextView, MovementStep, int,
 	if cb == nil {
 		x.xMoveCursor = 0
 	} else {
-		x.xMoveCursor = purego.NewCallback(func(TextViewVarp uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+		x.xMoveCursor = core.NewCallback(func(TextViewVarp uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp), StepVarp, CountVarp, ExtendSelectionVarp)
 		})
 	}
@@ -76,7 +77,7 @@ func (x *TextViewClass) GetMoveCursor() func(*TextView, MovementStep, int, bool)
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xMoveCursor)
+	core.RegisterFunc(&rawCallback, x.xMoveCursor)
 	return func(TextViewVar *TextView, StepVar MovementStep, CountVar int, ExtendSelectionVar bool) {
 		rawCallback(TextViewVar.GoPointer(), StepVar, CountVar, ExtendSelectionVar)
 	}
@@ -90,7 +91,8 @@ func (x *TextViewClass) OverrideSetAnchor(cb func(*TextView)) {
 	if cb == nil {
 		x.xSetAnchor = 0
 	} else {
-		x.xSetAnchor = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xSetAnchor = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -105,7 +107,7 @@ func (x *TextViewClass) GetSetAnchor() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetAnchor)
+	core.RegisterFunc(&rawCallback, x.xSetAnchor)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -119,7 +121,8 @@ func (x *TextViewClass) OverrideInsertAtCursor(cb func(*TextView, string)) {
 	if cb == nil {
 		x.xInsertAtCursor = 0
 	} else {
-		x.xInsertAtCursor = purego.NewCallback(func(TextViewVarp uintptr, StrVarp string) {
+		x.xInsertAtCursor = core.NewCallback(func(TextViewVarp uintptr, StrVarp string) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp), StrVarp)
 		})
 	}
@@ -134,7 +137,7 @@ func (x *TextViewClass) GetInsertAtCursor() func(*TextView, string) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr, StrVarp string)
-	purego.RegisterFunc(&rawCallback, x.xInsertAtCursor)
+	core.RegisterFunc(&rawCallback, x.xInsertAtCursor)
 	return func(TextViewVar *TextView, StrVar string) {
 		rawCallback(TextViewVar.GoPointer(), StrVar)
 	}
@@ -148,7 +151,8 @@ func (x *TextViewClass) OverrideDeleteFromCursor(cb func(*TextView, DeleteType,
 	if cb == nil {
 		x.xDeleteFromCursor = 0
 	} else {
-		x.xDeleteFromCursor = purego.NewCallback(func(TextViewVarp uintptr, TypeVarp DeleteType, CountVarp int) {
+		x.xDeleteFromCursor = core.NewCallback(func(TextViewVarp uintptr, TypeVarp DeleteType, CountVarp int) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp), TypeVarp, CountVarp)
 		})
 	}
@@ -163,7 +167,7 @@ func (x *TextViewClass) GetDeleteFromCursor() func(*TextView, DeleteType, int) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr, TypeVarp DeleteType, CountVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDeleteFromCursor)
+	core.RegisterFunc(&rawCallback, x.xDeleteFromCursor)
 	return func(TextViewVar *TextView, TypeVar DeleteType, CountVar int) {
 		rawCallback(TextViewVar.GoPointer(), TypeVar, CountVar)
 	}
@@ -177,7 +181,8 @@ func (x *TextViewClass) OverrideBackspace(cb func(*TextView)) {
 	if cb == nil {
 		x.xBackspace = 0
 	} else {
-		x.xBackspace = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xBackspace = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -192,7 +197,7 @@ func (x *TextViewClass) GetBackspace() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xBackspace)
+	core.RegisterFunc(&rawCallback, x.xBackspace)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -206,7 +211,8 @@ func (x *TextViewClass) OverrideCutClipboard(cb func(*TextView)) {
 	if cb == nil {
 		x.xCutClipboard = 0
 	} else {
-		x.xCutClipboard = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xCutClipboard = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -221,7 +227,7 @@ func (x *TextViewClass) GetCutClipboard() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCutClipboard)
+	core.RegisterFunc(&rawCallback, x.xCutClipboard)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -235,7 +241,8 @@ func (x *TextViewClass) OverrideCopyClipboard(cb func(*TextView)) {
 	if cb == nil {
 		x.xCopyClipboard = 0
 	} else {
-		x.xCopyClipboard = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xCopyClipboard = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -250,7 +257,7 @@ func (x *TextViewClass) GetCopyClipboard() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xCopyClipboard)
+	core.RegisterFunc(&rawCallback, x.xCopyClipboard)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -264,7 +271,8 @@ func (x *TextViewClass) OverridePasteClipboard(cb func(*TextView)) {
 	if cb == nil {
 		x.xPasteClipboard = 0
 	} else {
-		x.xPasteClipboard = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xPasteClipboard = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -279,7 +287,7 @@ func (x *TextViewClass) GetPasteClipboard() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xPasteClipboard)
+	core.RegisterFunc(&rawCallback, x.xPasteClipboard)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -293,7 +301,8 @@ func (x *TextViewClass) OverrideToggleOverwrite(cb func(*TextView)) {
 	if cb == nil {
 		x.xToggleOverwrite = 0
 	} else {
-		x.xToggleOverwrite = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xToggleOverwrite = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -308,7 +317,7 @@ func (x *TextViewClass) GetToggleOverwrite() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xToggleOverwrite)
+	core.RegisterFunc(&rawCallback, x.xToggleOverwrite)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -318,12 +327,13 @@ func (x *TextViewClass) GetToggleOverwrite() func(*TextView) {
 // The create_buffer vfunc is called to create a `GtkTextBuffer`
 //
 //	for the text view. The default implementation is to just call
-//	gtk_text_buffer_new().
+//	[NewTextBuffer].
 func (x *TextViewClass) OverrideCreateBuffer(cb func(*TextView) *TextBuffer) {
 	if cb == nil {
 		x.xCreateBuffer = 0
 	} else {
-		x.xCreateBuffer = purego.NewCallback(func(TextViewVarp uintptr) uintptr {
+		x.xCreateBuffer = core.NewCallback(func(TextViewVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(TextViewNewFromInternalPtr(TextViewVarp))
 			if ret == nil {
 				return 0
@@ -337,13 +347,13 @@ func (x *TextViewClass) OverrideCreateBuffer(cb func(*TextView) *TextBuffer) {
 // The create_buffer vfunc is called to create a `GtkTextBuffer`
 //
 //	for the text view. The default implementation is to just call
-//	gtk_text_buffer_new().
+//	[NewTextBuffer].
 func (x *TextViewClass) GetCreateBuffer() func(*TextView) *TextBuffer {
 	if x.xCreateBuffer == 0 {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateBuffer)
+	core.RegisterFunc(&rawCallback, x.xCreateBuffer)
 	return func(TextViewVar *TextView) *TextBuffer {
 		rawRet := rawCallback(TextViewVar.GoPointer())
 		if rawRet == 0 {
@@ -360,13 +370,14 @@ func (x *TextViewClass) GetCreateBuffer() func(*TextView) *TextBuffer {
 //
 //	view is drawing its own text. Applications can override this vfunc
 //	in a subclass to draw customized content underneath or above the
-//	text. In the %GTK_TEXT_VIEW_LAYER_BELOW_TEXT and %GTK_TEXT_VIEW_LAYER_ABOVE_TEXT
+//	text. In the [TextViewLayerBelowTextValue] and [TextViewLayerAboveTextValue]
 //	layers the drawing is done in the buffer coordinate space.
 func (x *TextViewClass) OverrideSnapshotLayer(cb func(*TextView, TextViewLayer, *Snapshot)) {
 	if cb == nil {
 		x.xSnapshotLayer = 0
 	} else {
-		x.xSnapshotLayer = purego.NewCallback(func(TextViewVarp uintptr, LayerVarp TextViewLayer, SnapshotVarp uintptr) {
+		x.xSnapshotLayer = core.NewCallback(func(TextViewVarp uintptr, LayerVarp TextViewLayer, SnapshotVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp), LayerVarp, SnapshotNewFromInternalPtr(SnapshotVarp))
 		})
 	}
@@ -377,14 +388,14 @@ func (x *TextViewClass) OverrideSnapshotLayer(cb func(*TextView, TextViewLayer,
 //
 //	view is drawing its own text. Applications can override this vfunc
 //	in a subclass to draw customized content underneath or above the
-//	text. In the %GTK_TEXT_VIEW_LAYER_BELOW_TEXT and %GTK_TEXT_VIEW_LAYER_ABOVE_TEXT
+//	text. In the [TextViewLayerBelowTextValue] and [TextViewLayerAboveTextValue]
 //	layers the drawing is done in the buffer coordinate space.
 func (x *TextViewClass) GetSnapshotLayer() func(*TextView, TextViewLayer, *Snapshot) {
 	if x.xSnapshotLayer == 0 {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr, LayerVarp TextViewLayer, SnapshotVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSnapshotLayer)
+	core.RegisterFunc(&rawCallback, x.xSnapshotLayer)
 	return func(TextViewVar *TextView, LayerVar TextViewLayer, SnapshotVar *Snapshot) {
 		rawCallback(TextViewVar.GoPointer(), LayerVar, SnapshotVar.GoPointer())
 	}
@@ -396,7 +407,8 @@ func (x *TextViewClass) OverrideExtendSelection(cb func(*TextView, TextExtendSel
 	if cb == nil {
 		x.xExtendSelection = 0
 	} else {
-		x.xExtendSelection = purego.NewCallback(func(TextViewVarp uintptr, GranularityVarp TextExtendSelection, LocationVarp *TextIter, StartVarp *TextIter, EndVarp *TextIter) bool {
+		x.xExtendSelection = core.NewCallback(func(TextViewVarp uintptr, GranularityVarp TextExtendSelection, LocationVarp *TextIter, StartVarp *TextIter, EndVarp *TextIter) bool {
+			defer core.RecoverPanic()
 			return cb(TextViewNewFromInternalPtr(TextViewVarp), GranularityVarp, LocationVarp, StartVarp, EndVarp)
 		})
 	}
@@ -409,7 +421,7 @@ func (x *TextViewClass) GetExtendSelection() func(*TextView, TextExtendSelection
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr, GranularityVarp TextExtendSelection, LocationVarp *TextIter, StartVarp *TextIter, EndVarp *TextIter) bool
-	purego.RegisterFunc(&rawCallback, x.xExtendSelection)
+	core.RegisterFunc(&rawCallback, x.xExtendSelection)
 	return func(TextViewVar *TextView, GranularityVar TextExtendSelection, LocationVar *TextIter, StartVar *TextIter, EndVar *TextIter) bool {
 		return rawCallback(TextViewVar.GoPointer(), GranularityVar, LocationVar, StartVar, EndVar)
 	}
@@ -421,7 +433,8 @@ func (x *TextViewClass) OverrideInsertEmoji(cb func(*TextView)) {
 	if cb == nil {
 		x.xInsertEmoji = 0
 	} else {
-		x.xInsertEmoji = purego.NewCallback(func(TextViewVarp uintptr) {
+		x.xInsertEmoji = core.NewCallback(func(TextViewVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(TextViewNewFromInternalPtr(TextViewVarp))
 		})
 	}
@@ -434,7 +447,7 @@ func (x *TextViewClass) GetInsertEmoji() func(*TextView) {
 		return nil
 	}
 	var rawCallback func(TextViewVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xInsertEmoji)
+	core.RegisterFunc(&rawCallback, x.xInsertEmoji)
 	return func(TextViewVar *TextView) {
 		rawCallback(TextViewVar.GoPointer())
 	}
@@ -474,6 +487,39 @@ const (
 	TextExtendSelectionLineValue TextExtendSelection = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TextExtendSelection) String() string {
+	switch x {
+	case TextExtendSelectionWordValue:
+		return "GTK_TEXT_EXTEND_SELECTION_WORD"
+	case TextExtendSelectionLineValue:
+		return "GTK_TEXT_EXTEND_SELECTION_LINE"
+	}
+	return fmt.Sprintf("TextExtendSelection(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextExtendSelection) Nick() string {
+	switch x {
+	case TextExtendSelectionWordValue:
+		return "word"
+	case TextExtendSelectionLineValue:
+		return "line"
+	}
+	return ""
+}
+
+// TextExtendSelectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextExtendSelectionFromString(s string) (TextExtendSelection, bool) {
+	switch s {
+	case "GTK_TEXT_EXTEND_SELECTION_WORD", "word":
+		return TextExtendSelectionWordValue, true
+	case "GTK_TEXT_EXTEND_SELECTION_LINE", "line":
+		return TextExtendSelectionLineValue, true
+	}
+	return 0, false
+}
+
 // Used to reference the layers of `GtkTextView` for the purpose of customized
 // drawing with the ::snapshot_layer vfunc.
 type TextViewLayer int
@@ -492,6 +538,39 @@ const (
 	TextViewLayerAboveTextValue TextViewLayer = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TextViewLayer) String() string {
+	switch x {
+	case TextViewLayerBelowTextValue:
+		return "GTK_TEXT_VIEW_LAYER_BELOW_TEXT"
+	case TextViewLayerAboveTextValue:
+		return "GTK_TEXT_VIEW_LAYER_ABOVE_TEXT"
+	}
+	return fmt.Sprintf("TextViewLayer(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextViewLayer) Nick() string {
+	switch x {
+	case TextViewLayerBelowTextValue:
+		return "below-text"
+	case TextViewLayerAboveTextValue:
+		return "above-text"
+	}
+	return ""
+}
+
+// TextViewLayerFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextViewLayerFromString(s string) (TextViewLayer, bool) {
+	switch s {
+	case "GTK_TEXT_VIEW_LAYER_BELOW_TEXT", "below-text":
+		return TextViewLayerBelowTextValue, true
+	case "GTK_TEXT_VIEW_LAYER_ABOVE_TEXT", "above-text":
+		return TextViewLayerAboveTextValue, true
+	}
+	return 0, false
+}
+
 // Used to reference the parts of `GtkTextView`.
 type TextWindowType int
 
@@ -517,43 +596,93 @@ const (
 	TextWindowBottomValue TextWindowType = 6
 )
 
-// Displays the contents of a [class@Gtk.TextBuffer].
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="multiline-text-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkTextView" src="multiline-text.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// You may wish to begin by reading the [conceptual overview](section-text-widget.html),
+// String returns the C name of the value, e.g. for debugging output
+func (x TextWindowType) String() string {
+	switch x {
+	case TextWindowWidgetValue:
+		return "GTK_TEXT_WINDOW_WIDGET"
+	case TextWindowTextValue:
+		return "GTK_TEXT_WINDOW_TEXT"
+	case TextWindowLeftValue:
+		return "GTK_TEXT_WINDOW_LEFT"
+	case TextWindowRightValue:
+		return "GTK_TEXT_WINDOW_RIGHT"
+	case TextWindowTopValue:
+		return "GTK_TEXT_WINDOW_TOP"
+	case TextWindowBottomValue:
+		return "GTK_TEXT_WINDOW_BOTTOM"
+	}
+	return fmt.Sprintf("TextWindowType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextWindowType) Nick() string {
+	switch x {
+	case TextWindowWidgetValue:
+		return "widget"
+	case TextWindowTextValue:
+		return "text"
+	case TextWindowLeftValue:
+		return "left"
+	case TextWindowRightValue:
+		return "right"
+	case TextWindowTopValue:
+		return "top"
+	case TextWindowBottomValue:
+		return "bottom"
+	}
+	return ""
+}
+
+// TextWindowTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextWindowTypeFromString(s string) (TextWindowType, bool) {
+	switch s {
+	case "GTK_TEXT_WINDOW_WIDGET", "widget":
+		return TextWindowWidgetValue, true
+	case "GTK_TEXT_WINDOW_TEXT", "text":
+		return TextWindowTextValue, true
+	case "GTK_TEXT_WINDOW_LEFT", "left":
+		return TextWindowLeftValue, true
+	case "GTK_TEXT_WINDOW_RIGHT", "right":
+		return TextWindowRightValue, true
+	case "GTK_TEXT_WINDOW_TOP", "top":
+		return TextWindowTopValue, true
+	case "GTK_TEXT_WINDOW_BOTTOM", "bottom":
+		return TextWindowBottomValue, true
+	}
+	return 0, false
+}
+
+// Displays the contents of a [TextBuffer].
+//
+// You may wish to begin by reading the conceptual overview,
 // which gives an overview of all the objects and data types related to the
 // text widget and how they work together.
 //
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // `GtkTextView` supports the following keyboard shortcuts:
 //
-//   - &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;F10&lt;/kbd&gt; or &lt;kbd&gt;Menu&lt;/kbd&gt; opens the context menu.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Z&lt;/kbd&gt; undoes the last modification.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Y&lt;/kbd&gt; or &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Z&lt;/kbd&gt;
+//   - Shift+F10 or Menu opens the context menu.
+//   - Ctrl+Z undoes the last modification.
+//   - Ctrl+Y or Ctrl+Shift+Z
 //     redoes the last undone modification.
-//   - &lt;kbd&gt;Clear&lt;/kbd&gt; clears the content.
+//   - Clear clears the content.
 //
 // Additionally, the following signals have default keybindings:
 //
-// - [signal@Gtk.TextView::backspace]
-// - [signal@Gtk.TextView::copy-clipboard]
-// - [signal@Gtk.TextView::cut-clipboard]
-// - [signal@Gtk.TextView::delete-from-cursor]
-// - [signal@Gtk.TextView::insert-emoji]
-// - [signal@Gtk.TextView::move-cursor]
-// - [signal@Gtk.TextView::paste-clipboard]
-// - [signal@Gtk.TextView::select-all]
-// - [signal@Gtk.TextView::toggle-cursor-visible]
-// - [signal@Gtk.TextView::toggle-overwrite]
+// - [TextView.ConnectBackspaceFunc]
+// - [TextView.ConnectCopyClipboardFunc]
+// - [TextView.ConnectCutClipboardFunc]
+// - [TextView.ConnectDeleteFromCursorFunc]
+// - [TextView.ConnectInsertEmojiFunc]
+// - [TextView.ConnectMoveCursorFunc]
+// - [TextView.ConnectPasteClipboardFunc]
+// - [TextView.ConnectSelectAllFunc]
+// - [TextView.ConnectToggleCursorVisibleFunc]
+// - [TextView.ConnectToggleOverwriteFunc]
 //
-// ## Actions
+// # Actions
 //
 // `GtkTextView` defines a set of built-in actions:
 //
@@ -569,18 +698,16 @@ const (
 //   - `text.undo` undoes the last change to the contents.
 //   - `text.clear` clears the content.
 //
-// ## CSS nodes
+// # CSS nodes
 //
-// ```
-// textview.view
-// ├── border.top
-// ├── border.left
-// ├── text
-// │   ╰── [selection]
-// ├── border.right
-// ├── border.bottom
-// ╰── [window.popup]
-// ```
+//	textview.view
+//	├── border.top
+//	├── border.left
+//	├── text
+//	│   ╰── [selection]
+//	├── border.right
+//	├── border.bottom
+//	╰── [window.popup]
 //
 // `GtkTextView` has a main css node with name textview and style class .view,
 // and subnodes for each of the border windows, and the main text area,
@@ -592,9 +719,9 @@ const (
 // If a context menu is opened, the window node will appear as a subnode
 // of the main node.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkTextView` uses the [enum@Gtk.AccessibleRole.text_box] role.
+// `GtkTextView` uses the [AccessibleRoleTextBoxValue] role.
 type TextView struct {
 	Widget
 }
@@ -615,10 +742,10 @@ var xNewTextView func() uintptr
 
 // Creates a new `GtkTextView`.
 //
-// If you don’t call [method@Gtk.TextView.set_buffer] before using the
+// If you don’t call [TextView.SetBuffer] before using the
 // text view, an empty default buffer will be created for you. Get the
-// buffer with [method@Gtk.TextView.get_buffer]. If you want to specify
-// your own buffer, consider [ctor@Gtk.TextView.new_with_buffer].
+// buffer with [TextView.GetBuffer]. If you want to specify
+// your own buffer, consider [NewTextViewWithBuffer].
 func NewTextView() *TextView {
 	var cls *TextView
 
@@ -635,11 +762,11 @@ func NewTextView() *TextView {
 
 var xNewTextViewWithBuffer func(uintptr) uintptr
 
-// Creates a new `GtkTextView` widget displaying the buffer @buffer.
+// Creates a new `GtkTextView` widget displaying the buffer buffer.
 //
-// One buffer can be shared among many widgets. @buffer may be %NULL
+// One buffer can be shared among many widgets. buffer may be nil
 // to create a default buffer, in which case this function is equivalent
-// to [ctor@Gtk.TextView.new]. The text view adds its own reference count
+// to [NewTextView]. The text view adds its own reference count
 // to the buffer; it does not take over an existing reference.
 func NewTextViewWithBuffer(BufferVar *TextBuffer) *TextView {
 	var cls *TextView
@@ -657,7 +784,7 @@ func NewTextViewWithBuffer(BufferVar *TextBuffer) *TextView {
 
 var xTextViewAddChildAtAnchor func(uintptr, uintptr, uintptr)
 
-// Adds a child widget in the text buffer, at the given @anchor.
+// Adds a child widget in the text buffer, at the given anchor.
 func (x *TextView) AddChildAtAnchor(ChildVar *Widget, AnchorVar *TextChildAnchor) {
 
 	xTextViewAddChildAtAnchor(x.GoPointer(), ChildVar.GoPointer(), AnchorVar.GoPointer())
@@ -666,13 +793,13 @@ func (x *TextView) AddChildAtAnchor(ChildVar *Widget, AnchorVar *TextChildAnchor
 
 var xTextViewAddOverlay func(uintptr, uintptr, int, int)
 
-// Adds @child at a fixed coordinate in the `GtkTextView`'s text window.
+// Adds child at a fixed coordinate in the `GtkTextView`'s text window.
 //
-// The @xpos and @ypos must be in buffer coordinates (see
-// [method@Gtk.TextView.get_iter_location] to convert to
+// The xpos and ypos must be in buffer coordinates (see
+// [TextView.GetIterLocation] to convert to
 // buffer coordinates).
 //
-// @child will scroll with the text view.
+// child will scroll with the text view.
 //
 // If instead you want a widget that will not move with the
 // `GtkTextView` contents see `GtkOverlay`.
@@ -684,7 +811,7 @@ func (x *TextView) AddOverlay(ChildVar *Widget, XposVar int, YposVar int) {
 
 var xTextViewBackwardDisplayLine func(uintptr, *TextIter) bool
 
-// Moves the given @iter backward by one display (wrapped) line.
+// Moves the given iter backward by one display (wrapped) line.
 //
 // A display line is different from a paragraph. Paragraphs are
 // separated by newlines or other paragraph separator characters.
@@ -701,7 +828,7 @@ func (x *TextView) BackwardDisplayLine(IterVar *TextIter) bool {
 
 var xTextViewBackwardDisplayLineStart func(uintptr, *TextIter) bool
 
-// Moves the given @iter backward to the next display line start.
+// Moves the given iter backward to the next display line start.
 //
 // A display line is different from a paragraph. Paragraphs are
 // separated by newlines or other paragraph separator characters.
@@ -727,7 +854,7 @@ func (x *TextView) BufferToWindowCoords(WinVar TextWindowType, BufferXVar int, B
 
 var xTextViewForwardDisplayLine func(uintptr, *TextIter) bool
 
-// Moves the given @iter forward by one display (wrapped) line.
+// Moves the given iter forward by one display (wrapped) line.
 //
 // A display line is different from a paragraph. Paragraphs are
 // separated by newlines or other paragraph separator characters.
@@ -744,7 +871,7 @@ func (x *TextView) ForwardDisplayLine(IterVar *TextIter) bool {
 
 var xTextViewForwardDisplayLineEnd func(uintptr, *TextIter) bool
 
-// Moves the given @iter forward to the next display line end.
+// Moves the given iter forward to the next display line end.
 //
 // A display line is different from a paragraph. Paragraphs are
 // separated by newlines or other paragraph separator characters.
@@ -761,9 +888,9 @@ func (x *TextView) ForwardDisplayLineEnd(IterVar *TextIter) bool {
 
 var xTextViewGetAcceptsTab func(uintptr) bool
 
-// Returns whether pressing the &lt;kbd&gt;Tab&lt;/kbd&gt; key inserts a tab characters.
+// Returns whether pressing the Tab key inserts a tab characters.
 //
-// See [method@Gtk.TextView.set_accepts_tab].
+// See [TextView.SetAcceptsTab].
 func (x *TextView) GetAcceptsTab() bool {
 
 	cret := xTextViewGetAcceptsTab(x.GoPointer())
@@ -772,7 +899,7 @@ func (x *TextView) GetAcceptsTab() bool {
 
 var xTextViewGetBottomMargin func(uintptr) int
 
-// Gets the bottom margin for text in the @text_view.
+// Gets the bottom margin for text in the text_view.
 func (x *TextView) GetBottomMargin() int {
 
 	cret := xTextViewGetBottomMargin(x.GoPointer())
@@ -802,7 +929,7 @@ func (x *TextView) GetBuffer() *TextBuffer {
 var xTextViewGetCursorLocations func(uintptr, *TextIter, *gdk.Rectangle, *gdk.Rectangle)
 
 // Determine the positions of the strong and weak cursors if the
-// insertion point is at @iter.
+// insertion point is at iter.
 //
 // The position of each cursor is stored as a zero-width rectangle.
 // The strong cursor location is the location where characters of
@@ -811,15 +938,15 @@ var xTextViewGetCursorLocations func(uintptr, *TextIter, *gdk.Rectangle, *gdk.Re
 // characters of the directionality opposite to the base direction
 // of the paragraph are inserted.
 //
-// If @iter is %NULL, the actual cursor position is used.
+// If iter is nil, the actual cursor position is used.
 //
-// Note that if @iter happens to be the actual cursor position, and
+// Note that if iter happens to be the actual cursor position, and
 // there is currently an IM preedit sequence being entered, the
 // returned locations will be adjusted to account for the preedit
 // cursor’s offset within the preedit sequence.
 //
 // The rectangle position is in buffer coordinates; use
-// [method@Gtk.TextView.buffer_to_window_coords] to convert these
+// [TextView.BufferToWindowCoords] to convert these
 // coordinates to coordinates for one of the windows in the text view.
 func (x *TextView) GetCursorLocations(IterVar *TextIter, StrongVar *gdk.Rectangle, WeakVar *gdk.Rectangle) {
 
@@ -850,7 +977,7 @@ func (x *TextView) GetEditable() bool {
 var xTextViewGetExtraMenu func(uintptr) uintptr
 
 // Gets the menu model that gets added to the context menu
-// or %NULL if none has been set.
+// or nil if none has been set.
 func (x *TextView) GetExtraMenu() *gio.MenuModel {
 	var cls *gio.MenuModel
 
@@ -869,10 +996,10 @@ var xTextViewGetGutter func(uintptr, TextWindowType) uintptr
 
 // Gets a `GtkWidget` that has previously been set as gutter.
 //
-// See [method@Gtk.TextView.set_gutter].
+// See [TextView.SetGutter].
 //
-// @win must be one of %GTK_TEXT_WINDOW_LEFT, %GTK_TEXT_WINDOW_RIGHT,
-// %GTK_TEXT_WINDOW_TOP, or %GTK_TEXT_WINDOW_BOTTOM.
+// win must be one of [TextWindowLeftValue], [TextWindowRightValue],
+// [TextWindowTopValue], or [TextWindowBottomValue].
 func (x *TextView) GetGutter(WinVar TextWindowType) *Widget {
 	var cls *Widget
 
@@ -889,7 +1016,7 @@ func (x *TextView) GetGutter(WinVar TextWindowType) *Widget {
 
 var xTextViewGetIndent func(uintptr) int
 
-// Gets the default indentation of paragraphs in @text_view.
+// Gets the default indentation of paragraphs in text_view.
 //
 // Tags in the view’s buffer may override the default.
 // The indentation may be negative.
@@ -919,12 +1046,12 @@ func (x *TextView) GetInputPurpose() InputPurpose {
 
 var xTextViewGetIterAtLocation func(uintptr, *TextIter, int, int) bool
 
-// Retrieves the iterator at buffer coordinates @x and @y.
+// Retrieves the iterator at buffer coordinates x and y.
 //
 // Buffer coordinates are coordinates for the entire buffer, not just
 // the currently-displayed portion. If you have coordinates from an
 // event, you have to convert those to buffer coordinates with
-// [method@Gtk.TextView.window_to_buffer_coords].
+// [TextView.WindowToBufferCoords].
 func (x *TextView) GetIterAtLocation(IterVar *TextIter, XVar int, YVar int) bool {
 
 	cret := xTextViewGetIterAtLocation(x.GoPointer(), IterVar, XVar, YVar)
@@ -934,14 +1061,14 @@ func (x *TextView) GetIterAtLocation(IterVar *TextIter, XVar int, YVar int) bool
 var xTextViewGetIterAtPosition func(uintptr, *TextIter, *int, int, int) bool
 
 // Retrieves the iterator pointing to the character at buffer
-// coordinates @x and @y.
+// coordinates x and y.
 //
 // Buffer coordinates are coordinates for the entire buffer, not just
 // the currently-displayed portion. If you have coordinates from an event,
 // you have to convert those to buffer coordinates with
-// [method@Gtk.TextView.window_to_buffer_coords].
+// [TextView.WindowToBufferCoords].
 //
-// Note that this is different from [method@Gtk.TextView.get_iter_at_location],
+// Note that this is different from [TextView.GetIterAtLocation],
 // which returns cursor locations, i.e. positions between characters.
 func (x *TextView) GetIterAtPosition(IterVar *TextIter, TrailingVar *int, XVar int, YVar int) bool {
 
@@ -951,10 +1078,10 @@ func (x *TextView) GetIterAtPosition(IterVar *TextIter, TrailingVar *int, XVar i
 
 var xTextViewGetIterLocation func(uintptr, *TextIter, *gdk.Rectangle)
 
-// Gets a rectangle which roughly contains the character at @iter.
+// Gets a rectangle which roughly contains the character at iter.
 //
 // The rectangle position is in buffer coordinates; use
-// [method@Gtk.TextView.buffer_to_window_coords] to convert these
+// [TextView.BufferToWindowCoords] to convert these
 // coordinates to coordinates for one of the windows in the text view.
 func (x *TextView) GetIterLocation(IterVar *TextIter, LocationVar *gdk.Rectangle) {
 
@@ -964,7 +1091,7 @@ func (x *TextView) GetIterLocation(IterVar *TextIter, LocationVar *gdk.Rectangle
 
 var xTextViewGetJustification func(uintptr) Justification
 
-// Gets the default justification of paragraphs in @text_view.
+// Gets the default justification of paragraphs in text_view.
 //
 // Tags in the buffer may override the default.
 func (x *TextView) GetJustification() Justification {
@@ -975,7 +1102,7 @@ func (x *TextView) GetJustification() Justification {
 
 var xTextViewGetLeftMargin func(uintptr) int
 
-// Gets the default left margin size of paragraphs in the @text_view.
+// Gets the default left margin size of paragraphs in the text_view.
 //
 // Tags in the buffer may override the default.
 func (x *TextView) GetLeftMargin() int {
@@ -987,11 +1114,11 @@ func (x *TextView) GetLeftMargin() int {
 var xTextViewGetLineAtY func(uintptr, *TextIter, int, *int)
 
 // Gets the `GtkTextIter` at the start of the line containing
-// the coordinate @y.
+// the coordinate y.
 //
-// @y is in buffer coordinates, convert from window coordinates with
-// [method@Gtk.TextView.window_to_buffer_coords]. If non-%NULL,
-// @line_top will be filled with the coordinate of the top edge
+// y is in buffer coordinates, convert from window coordinates with
+// [TextView.WindowToBufferCoords]. If non-nil,
+// line_top will be filled with the coordinate of the top edge
 // of the line.
 func (x *TextView) GetLineAtY(TargetIterVar *TextIter, YVar int, LineTopVar *int) {
 
@@ -1001,11 +1128,11 @@ func (x *TextView) GetLineAtY(TargetIterVar *TextIter, YVar int, LineTopVar *int
 
 var xTextViewGetLineYrange func(uintptr, *TextIter, *int, *int)
 
-// Gets the y coordinate of the top of the line containing @iter,
+// Gets the y coordinate of the top of the line containing iter,
 // and the height of the line.
 //
 // The coordinate is a buffer coordinate; convert to window
-// coordinates with [method@Gtk.TextView.buffer_to_window_coords].
+// coordinates with [TextView.BufferToWindowCoords].
 func (x *TextView) GetLineYrange(IterVar *TextIter, YVar *int, HeightVar *int) {
 
 	xTextViewGetLineYrange(x.GoPointer(), IterVar, YVar, HeightVar)
@@ -1054,7 +1181,7 @@ var xTextViewGetPixelsAboveLines func(uintptr) int
 
 // Gets the default number of pixels to put above paragraphs.
 //
-// Adding this function with [method@Gtk.TextView.get_pixels_below_lines]
+// Adding this function with [TextView.GetPixelsBelowLines]
 // is equal to the line space between each paragraph.
 func (x *TextView) GetPixelsAboveLines() int {
 
@@ -1067,7 +1194,7 @@ var xTextViewGetPixelsBelowLines func(uintptr) int
 // Gets the default number of pixels to put below paragraphs.
 //
 // The line space is the sum of the value returned by this function and
-// the value returned by [method@Gtk.TextView.get_pixels_above_lines].
+// the value returned by [TextView.GetPixelsAboveLines].
 func (x *TextView) GetPixelsBelowLines() int {
 
 	cret := xTextViewGetPixelsBelowLines(x.GoPointer())
@@ -1086,7 +1213,7 @@ func (x *TextView) GetPixelsInsideWrap() int {
 
 var xTextViewGetRightMargin func(uintptr) int
 
-// Gets the default right margin for text in @text_view.
+// Gets the default right margin for text in text_view.
 //
 // Tags in the buffer may override the default.
 func (x *TextView) GetRightMargin() int {
@@ -1117,11 +1244,11 @@ func (x *TextView) GetRtlContext() *pango.Context {
 
 var xTextViewGetTabs func(uintptr) *pango.TabArray
 
-// Gets the default tabs for @text_view.
+// Gets the default tabs for text_view.
 //
 // Tags in the buffer may override the defaults. The returned array
-// will be %NULL if “standard” (8-space) tabs are used. Free the
-// return value with [method@Pango.TabArray.free].
+// will be nil if “standard” (8-space) tabs are used. Free the
+// return value with [pango.TabArray.Free].
 func (x *TextView) GetTabs() *pango.TabArray {
 
 	cret := xTextViewGetTabs(x.GoPointer())
@@ -1130,7 +1257,7 @@ func (x *TextView) GetTabs() *pango.TabArray {
 
 var xTextViewGetTopMargin func(uintptr) int
 
-// Gets the top margin for text in the @text_view.
+// Gets the top margin for text in the text_view.
 func (x *TextView) GetTopMargin() int {
 
 	cret := xTextViewGetTopMargin(x.GoPointer())
@@ -1143,7 +1270,7 @@ var xTextViewGetVisibleOffset func(uintptr, *float64, *float64)
 // the textview's text contents.
 //
 // This allows for more-precise positioning than what is provided by
-// [method@Gtk.TextView.get_visible_rect] as you can discover what
+// [TextView.GetVisibleRect] as you can discover what
 // device pixel is being quantized for text positioning.
 //
 // You might want this when making ulterior widgets align with quantized
@@ -1156,11 +1283,11 @@ func (x *TextView) GetVisibleOffset(XOffsetVar *float64, YOffsetVar *float64) {
 
 var xTextViewGetVisibleRect func(uintptr, *gdk.Rectangle)
 
-// Fills @visible_rect with the currently-visible
+// Fills visible_rect with the currently-visible
 // region of the buffer, in buffer coordinates.
 //
 // Convert to window coordinates with
-// [method@Gtk.TextView.buffer_to_window_coords].
+// [TextView.BufferToWindowCoords].
 func (x *TextView) GetVisibleRect(VisibleRectVar *gdk.Rectangle) {
 
 	xTextViewGetVisibleRect(x.GoPointer(), VisibleRectVar)
@@ -1181,24 +1308,21 @@ var xTextViewImContextFilterKeypress func(uintptr, uintptr) bool
 // Allow the `GtkTextView` input method to internally handle key press
 // and release events.
 //
-// If this function returns %TRUE, then no further processing should be
-// done for this key event. See [method@Gtk.IMContext.filter_keypress].
+// If this function returns true, then no further processing should be
+// done for this key event. See [IMContext.FilterKeypress].
 //
 // Note that you are expected to call this function from your handler
 // when overriding key event handling. This is needed in the case when
 // you need to insert your own key handling between the input method
 // and the default key event handling of the `GtkTextView`.
 //
-// ```c
-// static gboolean
-// gtk_foo_bar_key_press_event (GtkWidget *widget,
-//
-//	GdkEvent  *event)
-//
+//	static gboolean
+//	gtk_foo_bar_key_press_event (GtkWidget *widget,
+//	                             GdkEvent  *event)
 //	{
 //	  guint keyval;
 //
-//	  gdk_event_get_keyval ((GdkEvent*)event, &amp;keyval);
+//	  gdk_event_get_keyval ((GdkEvent*)event, &keyval);
 //
 //	  if (keyval == GDK_KEY_Return || keyval == GDK_KEY_KP_Enter)
 //	    {
@@ -1208,10 +1332,8 @@ var xTextViewImContextFilterKeypress func(uintptr, uintptr) bool
 //
 //	  // Do some stuff
 //
-//	  return GTK_WIDGET_CLASS (gtk_foo_bar_parent_class)-&gt;key_press_event (widget, event);
+//	  return GTK_WIDGET_CLASS (gtk_foo_bar_parent_class)->key_press_event (widget, event);
 //	}
-//
-// ```
 func (x *TextView) ImContextFilterKeypress(EventVar *gdk.Event) bool {
 
 	cret := xTextViewImContextFilterKeypress(x.GoPointer(), EventVar.GoPointer())
@@ -1232,7 +1354,7 @@ var xTextViewMoveOverlay func(uintptr, uintptr, int, int)
 
 // Updates the position of a child.
 //
-// See [method@Gtk.TextView.add_overlay].
+// See [TextView.AddOverlay].
 func (x *TextView) MoveOverlay(ChildVar *Widget, XposVar int, YposVar int) {
 
 	xTextViewMoveOverlay(x.GoPointer(), ChildVar.GoPointer(), XposVar, YposVar)
@@ -1244,10 +1366,10 @@ var xTextViewMoveVisually func(uintptr, *TextIter, int) bool
 // Move the iterator a given number of characters visually, treating
 // it as the strong cursor position.
 //
-// If @count is positive, then the new strong cursor position will
-// be @count positions to the right of the old cursor position.
-// If @count is negative then the new strong cursor position will
-// be @count positions to the left of the old cursor position.
+// If count is positive, then the new strong cursor position will
+// be count positions to the right of the old cursor position.
+// If count is negative then the new strong cursor position will
+// be count positions to the left of the old cursor position.
 //
 // In the presence of bi-directional text, the correspondence
 // between logical and visual order will depend on the direction
@@ -1271,7 +1393,7 @@ func (x *TextView) PlaceCursorOnscreen() bool {
 
 var xTextViewRemove func(uintptr, uintptr)
 
-// Removes a child widget from @text_view.
+// Removes a child widget from text_view.
 func (x *TextView) Remove(ChildVar *Widget) {
 
 	xTextViewRemove(x.GoPointer(), ChildVar.GoPointer())
@@ -1308,7 +1430,7 @@ func (x *TextView) ResetImContext() {
 
 var xTextViewScrollMarkOnscreen func(uintptr, uintptr)
 
-// Scrolls @text_view the minimum distance such that @mark is contained
+// Scrolls text_view the minimum distance such that mark is contained
 // within the visible area of the widget.
 func (x *TextView) ScrollMarkOnscreen(MarkVar *TextMark) {
 
@@ -1318,20 +1440,20 @@ func (x *TextView) ScrollMarkOnscreen(MarkVar *TextMark) {
 
 var xTextViewScrollToIter func(uintptr, *TextIter, float64, bool, float64, float64) bool
 
-// Scrolls @text_view so that @iter is on the screen in the position
-// indicated by @xalign and @yalign.
+// Scrolls text_view so that iter is on the screen in the position
+// indicated by xalign and yalign.
 //
 // An alignment of 0.0 indicates left or top, 1.0 indicates right or
-// bottom, 0.5 means center. If @use_align is %FALSE, the text scrolls
+// bottom, 0.5 means center. If use_align is false, the text scrolls
 // the minimal distance to get the mark onscreen, possibly not scrolling
 // at all. The effective screen for purposes of this function is reduced
-// by a margin of size @within_margin.
+// by a margin of size within_margin.
 //
 // Note that this function uses the currently-computed height of the
 // lines in the text buffer. Line heights are computed in an idle
 // handler; so this function may not have the desired effect if it’s
 // called before the height computations. To avoid oddness, consider
-// using [method@Gtk.TextView.scroll_to_mark] which saves a point to be
+// using [TextView.ScrollToMark] which saves a point to be
 // scrolled to after line validation.
 func (x *TextView) ScrollToIter(IterVar *TextIter, WithinMarginVar float64, UseAlignVar bool, XalignVar float64, YalignVar float64) bool {
 
@@ -1341,14 +1463,14 @@ func (x *TextView) ScrollToIter(IterVar *TextIter, WithinMarginVar float64, UseA
 
 var xTextViewScrollToMark func(uintptr, uintptr, float64, bool, float64, float64)
 
-// Scrolls @text_view so that @mark is on the screen in the position
-// indicated by @xalign and @yalign.
+// Scrolls text_view so that mark is on the screen in the position
+// indicated by xalign and yalign.
 //
 // An alignment of 0.0 indicates left or top, 1.0 indicates right or
-// bottom, 0.5 means center. If @use_align is %FALSE, the text scrolls
+// bottom, 0.5 means center. If use_align is false, the text scrolls
 // the minimal distance to get the mark onscreen, possibly not scrolling
 // at all. The effective screen for purposes of this function is reduced
-// by a margin of size @within_margin.
+// by a margin of size within_margin.
 func (x *TextView) ScrollToMark(MarkVar *TextMark, WithinMarginVar float64, UseAlignVar bool, XalignVar float64, YalignVar float64) {
 
 	xTextViewScrollToMark(x.GoPointer(), MarkVar.GoPointer(), WithinMarginVar, UseAlignVar, XalignVar, YalignVar)
@@ -1357,13 +1479,13 @@ func (x *TextView) ScrollToMark(MarkVar *TextMark, WithinMarginVar float64, UseA
 
 var xTextViewSetAcceptsTab func(uintptr, bool)
 
-// Sets the behavior of the text widget when the &lt;kbd&gt;Tab&lt;/kbd&gt; key is pressed.
+// Sets the behavior of the text widget when the Tab key is pressed.
 //
-// If @accepts_tab is %TRUE, a tab character is inserted. If @accepts_tab
-// is %FALSE the keyboard focus is moved to the next widget in the focus
+// If accepts_tab is true, a tab character is inserted. If accepts_tab
+// is false the keyboard focus is moved to the next widget in the focus
 // chain.
 //
-// Focus can always be moved using &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt;.
+// Focus can always be moved using Ctrl+Tab.
 func (x *TextView) SetAcceptsTab(AcceptsTabVar bool) {
 
 	xTextViewSetAcceptsTab(x.GoPointer(), AcceptsTabVar)
@@ -1372,7 +1494,7 @@ func (x *TextView) SetAcceptsTab(AcceptsTabVar bool) {
 
 var xTextViewSetBottomMargin func(uintptr, int)
 
-// Sets the bottom margin for text in @text_view.
+// Sets the bottom margin for text in text_view.
 //
 // Note that this function is confusingly named.
 // In CSS terms, the value set here is padding.
@@ -1384,10 +1506,10 @@ func (x *TextView) SetBottomMargin(BottomMarginVar int) {
 
 var xTextViewSetBuffer func(uintptr, uintptr)
 
-// Sets @buffer as the buffer being displayed by @text_view.
+// Sets buffer as the buffer being displayed by text_view.
 //
 // The previous buffer displayed by the text view is unreferenced, and
-// a reference is added to @buffer. If you owned a reference to @buffer
+// a reference is added to buffer. If you owned a reference to buffer
 // before passing it to this function, you must remove that reference
 // yourself; `GtkTextView` will not “adopt” it.
 func (x *TextView) SetBuffer(BufferVar *TextBuffer) {
@@ -1404,7 +1526,7 @@ var xTextViewSetCursorVisible func(uintptr, bool)
 // cursor, so you may want to turn the cursor off.
 //
 // Note that this property may be overridden by the
-// [property@Gtk.Settings:gtk-keynav-use-caret] setting.
+// [Settings.GetPropertyGtkKeynavUseCaret] setting.
 func (x *TextView) SetCursorVisible(SettingVar bool) {
 
 	xTextViewSetCursorVisible(x.GoPointer(), SettingVar)
@@ -1426,9 +1548,9 @@ func (x *TextView) SetEditable(SettingVar bool) {
 var xTextViewSetExtraMenu func(uintptr, uintptr)
 
 // Sets a menu model to add when constructing the context
-// menu for @text_view.
+// menu for text_view.
 //
-// You can pass %NULL to remove a previously set extra menu.
+// You can pass nil to remove a previously set extra menu.
 func (x *TextView) SetExtraMenu(ModelVar *gio.MenuModel) {
 
 	xTextViewSetExtraMenu(x.GoPointer(), ModelVar.GoPointer())
@@ -1437,10 +1559,10 @@ func (x *TextView) SetExtraMenu(ModelVar *gio.MenuModel) {
 
 var xTextViewSetGutter func(uintptr, TextWindowType, uintptr)
 
-// Places @widget into the gutter specified by @win.
+// Places widget into the gutter specified by win.
 //
-// @win must be one of %GTK_TEXT_WINDOW_LEFT, %GTK_TEXT_WINDOW_RIGHT,
-// %GTK_TEXT_WINDOW_TOP, or %GTK_TEXT_WINDOW_BOTTOM.
+// win must be one of [TextWindowLeftValue], [TextWindowRightValue],
+// [TextWindowTopValue], or [TextWindowBottomValue].
 func (x *TextView) SetGutter(WinVar TextWindowType, WidgetVar *Widget) {
 
 	xTextViewSetGutter(x.GoPointer(), WinVar, WidgetVar.GoPointer())
@@ -1449,7 +1571,7 @@ func (x *TextView) SetGutter(WinVar TextWindowType, WidgetVar *Widget) {
 
 var xTextViewSetIndent func(uintptr, int)
 
-// Sets the default indentation for paragraphs in @text_view.
+// Sets the default indentation for paragraphs in text_view.
 //
 // Tags in the buffer may override the default.
 func (x *TextView) SetIndent(IndentVar int) {
@@ -1484,7 +1606,7 @@ func (x *TextView) SetInputPurpose(PurposeVar InputPurpose) {
 
 var xTextViewSetJustification func(uintptr, Justification)
 
-// Sets the default justification of text in @text_view.
+// Sets the default justification of text in text_view.
 //
 // Tags in the view’s buffer may override the default.
 func (x *TextView) SetJustification(JustificationVar Justification) {
@@ -1495,7 +1617,7 @@ func (x *TextView) SetJustification(JustificationVar Justification) {
 
 var xTextViewSetLeftMargin func(uintptr, int)
 
-// Sets the default left margin for text in @text_view.
+// Sets the default left margin for text in text_view.
 //
 // Tags in the buffer may override the default.
 //
@@ -1528,9 +1650,9 @@ func (x *TextView) SetOverwrite(OverwriteVar bool) {
 
 var xTextViewSetPixelsAboveLines func(uintptr, int)
 
-// Sets the default number of blank pixels above paragraphs in @text_view.
+// Sets the default number of blank pixels above paragraphs in text_view.
 //
-// Tags in the buffer for @text_view may override the defaults.
+// Tags in the buffer for text_view may override the defaults.
 func (x *TextView) SetPixelsAboveLines(PixelsAboveLinesVar int) {
 
 	xTextViewSetPixelsAboveLines(x.GoPointer(), PixelsAboveLinesVar)
@@ -1540,9 +1662,9 @@ func (x *TextView) SetPixelsAboveLines(PixelsAboveLinesVar int) {
 var xTextViewSetPixelsBelowLines func(uintptr, int)
 
 // Sets the default number of pixels of blank space
-// to put below paragraphs in @text_view.
+// to put below paragraphs in text_view.
 //
-// May be overridden by tags applied to @text_view’s buffer.
+// May be overridden by tags applied to text_view’s buffer.
 func (x *TextView) SetPixelsBelowLines(PixelsBelowLinesVar int) {
 
 	xTextViewSetPixelsBelowLines(x.GoPointer(), PixelsBelowLinesVar)
@@ -1554,7 +1676,7 @@ var xTextViewSetPixelsInsideWrap func(uintptr, int)
 // Sets the default number of pixels of blank space to leave between
 // display/wrapped lines within a paragraph.
 //
-// May be overridden by tags in @text_view’s buffer.
+// May be overridden by tags in text_view’s buffer.
 func (x *TextView) SetPixelsInsideWrap(PixelsInsideWrapVar int) {
 
 	xTextViewSetPixelsInsideWrap(x.GoPointer(), PixelsInsideWrapVar)
@@ -1577,7 +1699,7 @@ func (x *TextView) SetRightMargin(RightMarginVar int) {
 
 var xTextViewSetTabs func(uintptr, *pango.TabArray)
 
-// Sets the default tab stops for paragraphs in @text_view.
+// Sets the default tab stops for paragraphs in text_view.
 //
 // Tags in the buffer may override the default.
 func (x *TextView) SetTabs(TabsVar *pango.TabArray) {
@@ -1588,7 +1710,7 @@ func (x *TextView) SetTabs(TabsVar *pango.TabArray) {
 
 var xTextViewSetTopMargin func(uintptr, int)
 
-// Sets the top margin for text in @text_view.
+// Sets the top margin for text in text_view.
 //
 // Note that this function is confusingly named.
 // In CSS terms, the value set here is padding.
@@ -1609,9 +1731,9 @@ func (x *TextView) SetWrapMode(WrapModeVar WrapMode) {
 
 var xTextViewStartsDisplayLine func(uintptr, *TextIter) bool
 
-// Determines whether @iter is at the start of a display line.
+// Determines whether iter is at the start of a display line.
 //
-// See [method@Gtk.TextView.forward_display_line] for an
+// See [TextView.ForwardDisplayLine] for an
 // explanation of display lines vs. paragraphs.
 func (x *TextView) StartsDisplayLine(IterVar *TextIter) bool {
 
@@ -1621,7 +1743,7 @@ func (x *TextView) StartsDisplayLine(IterVar *TextIter) bool {
 
 var xTextViewWindowToBufferCoords func(uintptr, TextWindowType, int, int, *int, *int)
 
-// Converts coordinates on the window identified by @win to buffer
+// Converts coordinates on the window identified by win to buffer
 // coordinates.
 func (x *TextView) WindowToBufferCoords(WinVar TextWindowType, WindowXVar int, WindowYVar int, BufferXVar *int, BufferYVar *int) {
 
@@ -1664,7 +1786,7 @@ func (x *TextView) GetPropertyAcceptsTab() bool {
 // the value set here is padding, and it is applied in addition
 // to the padding from the theme.
 //
-// Don't confuse this property with [property@Gtk.Widget:margin-bottom].
+// Don't confuse this property with [Widget.GetPropertyMarginBottom].
 func (x *TextView) SetPropertyBottomMargin(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -1679,7 +1801,7 @@ func (x *TextView) SetPropertyBottomMargin(value int) {
 // the value set here is padding, and it is applied in addition
 // to the padding from the theme.
 //
-// Don't confuse this property with [property@Gtk.Widget:margin-bottom].
+// Don't confuse this property with [Widget.GetPropertyMarginBottom].
 func (x *TextView) GetPropertyBottomMargin() int {
 	var v gobject.Value
 	x.GetProperty("bottom-margin", &v)
@@ -1723,10 +1845,10 @@ func (x *TextView) GetPropertyEditable() bool {
 // SetPropertyImModule sets the "im-module" property.
 // Which IM (input method) module should be used for this text_view.
 //
-// See [class@Gtk.IMMulticontext].
+// See [IMMulticontext].
 //
-// Setting this to a non-%NULL value overrides the system-wide IM module
-// setting. See the GtkSettings [property@Gtk.Settings:gtk-im-module] property.
+// Setting this to a non-nil value overrides the system-wide IM module
+// setting. See the GtkSettings [Settings.GetPropertyGtkImModule] property.
 func (x *TextView) SetPropertyImModule(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1737,10 +1859,10 @@ func (x *TextView) SetPropertyImModule(value string) {
 // GetPropertyImModule gets the "im-module" property.
 // Which IM (input method) module should be used for this text_view.
 //
-// See [class@Gtk.IMMulticontext].
+// See [IMMulticontext].
 //
-// Setting this to a non-%NULL value overrides the system-wide IM module
-// setting. See the GtkSettings [property@Gtk.Settings:gtk-im-module] property.
+// Setting this to a non-nil value overrides the system-wide IM module
+// setting. See the GtkSettings [Settings.GetPropertyGtkImModule] property.
 func (x *TextView) GetPropertyImModule() string {
 	var v gobject.Value
 	x.GetProperty("im-module", &v)
@@ -1804,7 +1926,7 @@ func (x *TextView) GetPropertyLeftMargin() int {
 // SetPropertyMonospace sets the "monospace" property.
 // Whether text should be displayed in a monospace font.
 //
-// If %TRUE, set the .monospace style class on the
+// If true, set the .monospace style class on the
 // text view to indicate that a monospace font is desired.
 func (x *TextView) SetPropertyMonospace(value bool) {
 	var v gobject.Value
@@ -1816,7 +1938,7 @@ func (x *TextView) SetPropertyMonospace(value bool) {
 // GetPropertyMonospace gets the "monospace" property.
 // Whether text should be displayed in a monospace font.
 //
-// If %TRUE, set the .monospace style class on the
+// If true, set the .monospace style class on the
 // text view to indicate that a monospace font is desired.
 func (x *TextView) GetPropertyMonospace() bool {
 	var v gobject.Value
@@ -1945,7 +2067,7 @@ func (x *TextView) GetPropertyTabs() uintptr {
 // the value set here is padding, and it is applied in addition
 // to the padding from the theme.
 //
-// Don't confuse this property with [property@Gtk.Widget:margin-top].
+// Don't confuse this property with [Widget.GetPropertyMarginTop].
 func (x *TextView) SetPropertyTopMargin(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -1960,7 +2082,7 @@ func (x *TextView) SetPropertyTopMargin(value int) {
 // the value set here is padding, and it is applied in addition
 // to the padding from the theme.
 //
-// Don't confuse this property with [property@Gtk.Widget:margin-top].
+// Don't confuse this property with [Widget.GetPropertyMarginTop].
 func (x *TextView) GetPropertyTopMargin() int {
 	var v gobject.Value
 	x.GetProperty("top-margin", &v)
@@ -1969,316 +2091,536 @@ func (x *TextView) GetPropertyTopMargin() int {
 
 // Gets emitted when the user asks for it.
 //
-// The ::backspace signal is a [keybinding signal](class.SignalAction.html).
+// The ::backspace signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Backspace&lt;/kbd&gt; and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Backspace&lt;/kbd&gt;.
+// Backspace and Shift+Backspace.
+//
+// Deprecated: use ConnectBackspaceFunc, which also accepts method values and closures.
 func (x *TextView) ConnectBackspace(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "backspace", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "backspace", cbRefPtr, cbPtr)
+}
+
+var xTextViewBackspaceTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextViewBackspaceNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "backspace", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectBackspaceFunc connects cb to the "backspace" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectBackspaceFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "backspace", &xTextViewBackspaceTrampoline, xTextViewBackspaceNewTrampoline, cb)
 }
 
 // Gets emitted to copy the selection to the clipboard.
 //
-// The ::copy-clipboard signal is a [keybinding signal](class.SignalAction.html).
+// The ::copy-clipboard signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;c&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Ctrl+c and
+// Ctrl+Insert.
+//
+// Deprecated: use ConnectCopyClipboardFunc, which also accepts method values and closures.
 func (x *TextView) ConnectCopyClipboard(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "copy-clipboard", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextViewCopyClipboardTrampoline uintptr
+
+func xTextViewCopyClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "copy-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCopyClipboardFunc connects cb to the "copy-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectCopyClipboardFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "copy-clipboard", &xTextViewCopyClipboardTrampoline, xTextViewCopyClipboardNewTrampoline, cb)
 }
 
 // Gets emitted to cut the selection to the clipboard.
 //
-// The ::cut-clipboard signal is a [keybinding signal](class.SignalAction.html).
+// The ::cut-clipboard signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;x&lt;/kbd&gt; and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Delete&lt;/kbd&gt;.
+// Ctrl+x and
+// Shift+Delete.
+//
+// Deprecated: use ConnectCutClipboardFunc, which also accepts method values and closures.
 func (x *TextView) ConnectCutClipboard(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "cut-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "cut-clipboard", cbRefPtr, cbPtr)
+}
+
+var xTextViewCutClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextViewCutClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "cut-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCutClipboardFunc connects cb to the "cut-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectCutClipboardFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "cut-clipboard", &xTextViewCutClipboardTrampoline, xTextViewCutClipboardNewTrampoline, cb)
 }
 
 // Gets emitted when the user initiates a text deletion.
 //
-// The ::delete-from-cursor signal is a [keybinding signal](class.SignalAction.html).
+// The ::delete-from-cursor signal is a keybinding signal.
 //
-// If the @type is %GTK_DELETE_CHARS, GTK deletes the selection
+// If the type is [DeleteCharsValue], GTK deletes the selection
 // if there is one, otherwise it deletes the requested number
 // of characters.
 //
-// The default bindings for this signal are &lt;kbd&gt;Delete&lt;/kbd&gt; for
-// deleting a character, &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Delete&lt;/kbd&gt; for
-// deleting a word and &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Backspace&lt;/kbd&gt; for
+// The default bindings for this signal are Delete for
+// deleting a character, Ctrl+Delete for
+// deleting a word and Ctrl+Backspace for
 // deleting a word backwards.
+//
+// Deprecated: use ConnectDeleteFromCursorFunc, which also accepts method values and closures.
 func (x *TextView) ConnectDeleteFromCursor(cb *func(TextView, DeleteType, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "delete-from-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, TypeVarp, CountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "delete-from-cursor", cbRefPtr, cbPtr)
+}
+
+var xTextViewDeleteFromCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int) {
+func xTextViewDeleteFromCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, TypeVarp DeleteType, CountVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, DeleteType, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, TypeVarp, CountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "delete-from-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the selection needs to be extended at @location.
+// ConnectDeleteFromCursorFunc connects cb to the "delete-from-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectDeleteFromCursorFunc(cb func(TextView, DeleteType, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "delete-from-cursor", &xTextViewDeleteFromCursorTrampoline, xTextViewDeleteFromCursorNewTrampoline, cb)
+}
+
+// Emitted when the selection needs to be extended at location.
+//
+// Deprecated: use ConnectExtendSelectionFunc, which also accepts method values and closures.
 func (x *TextView) ConnectExtendSelection(cb *func(TextView, TextExtendSelection, uintptr, uintptr, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "extend-selection", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, GranularityVarp TextExtendSelection, LocationVarp uintptr, StartVarp uintptr, EndVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, GranularityVarp, LocationVarp, StartVarp, EndVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "extend-selection", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, GranularityVarp TextExtendSelection, LocationVarp uintptr, StartVarp uintptr, EndVarp uintptr) bool {
+var xTextViewExtendSelectionTrampoline uintptr
+
+func xTextViewExtendSelectionNewTrampoline() interface{} {
+	return func(clsPtr uintptr, GranularityVarp TextExtendSelection, LocationVarp uintptr, StartVarp uintptr, EndVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, TextExtendSelection, uintptr, uintptr, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, GranularityVarp, LocationVarp, StartVarp, EndVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "extend-selection", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectExtendSelectionFunc connects cb to the "extend-selection" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectExtendSelectionFunc(cb func(TextView, TextExtendSelection, uintptr, uintptr, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "extend-selection", &xTextViewExtendSelectionTrampoline, xTextViewExtendSelectionNewTrampoline, cb)
 }
 
 // Gets emitted when the user initiates the insertion of a
 // fixed string at the cursor.
 //
-// The ::insert-at-cursor signal is a [keybinding signal](class.SignalAction.html).
+// The ::insert-at-cursor signal is a keybinding signal.
 //
 // This signal has no default bindings.
+//
+// Deprecated: use ConnectInsertAtCursorFunc, which also accepts method values and closures.
 func (x *TextView) ConnectInsertAtCursor(cb *func(TextView, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-at-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StringVarp string) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StringVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-at-cursor", cbRefPtr, cbPtr)
+}
+
+var xTextViewInsertAtCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StringVarp string) {
+func xTextViewInsertAtCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StringVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StringVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-at-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Gets emitted to present the Emoji chooser for the @text_view.
+// ConnectInsertAtCursorFunc connects cb to the "insert-at-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectInsertAtCursorFunc(cb func(TextView, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-at-cursor", &xTextViewInsertAtCursorTrampoline, xTextViewInsertAtCursorNewTrampoline, cb)
+}
+
+// Gets emitted to present the Emoji chooser for the text_view.
 //
-// The ::insert-emoji signal is a [keybinding signal](class.SignalAction.html).
+// The ::insert-emoji signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;.&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;;&lt;/kbd&gt;
+// Ctrl+. and
+// Ctrl+;
+//
+// Deprecated: use ConnectInsertEmojiFunc, which also accepts method values and closures.
 func (x *TextView) ConnectInsertEmoji(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "insert-emoji", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "insert-emoji", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextViewInsertEmojiTrampoline uintptr
+
+func xTextViewInsertEmojiNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "insert-emoji", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectInsertEmojiFunc connects cb to the "insert-emoji" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectInsertEmojiFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "insert-emoji", &xTextViewInsertEmojiTrampoline, xTextViewInsertEmojiNewTrampoline, cb)
 }
 
 // Gets emitted when the user initiates a cursor movement.
 //
-// The ::move-cursor signal is a [keybinding signal](class.SignalAction.html).
-// If the cursor is not visible in @text_view, this signal causes
+// The ::move-cursor signal is a keybinding signal.
+// If the cursor is not visible in text_view, this signal causes
 // the viewport to be moved instead.
 //
 // Applications should not connect to it, but may emit it with
-// g_signal_emit_by_name() if they need to control the cursor
+// [gobject.SignalEmitByName] if they need to control the cursor
 // programmatically.
 //
 // The default bindings for this signal come in two variants,
-// the variant with the &lt;kbd&gt;Shift&lt;/kbd&gt; modifier extends the
+// the variant with the Shift modifier extends the
 // selection, the variant without it does not.
 // There are too many key combinations to list them all here.
 //
-//   - &lt;kbd&gt;←&lt;/kbd&gt;, &lt;kbd&gt;→&lt;/kbd&gt;, &lt;kbd&gt;↑&lt;/kbd&gt;, &lt;kbd&gt;↓&lt;/kbd&gt;
+//   - ←, →, ↑, ↓
 //     move by individual characters/lines
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;←&lt;/kbd&gt;, etc. move by words/paragraphs
-//   - &lt;kbd&gt;Home&lt;/kbd&gt; and &lt;kbd&gt;End&lt;/kbd&gt; move to the ends of the buffer
-//   - &lt;kbd&gt;PgUp&lt;/kbd&gt; and &lt;kbd&gt;PgDn&lt;/kbd&gt; move vertically by pages
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgUp&lt;/kbd&gt; and &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;PgDn&lt;/kbd&gt;
+//   - Ctrl+←, etc. move by words/paragraphs
+//   - Home and End move to the ends of the buffer
+//   - PgUp and PgDn move vertically by pages
+//   - Ctrl+PgUp and Ctrl+PgDn
 //     move horizontally by pages
+//
+// Deprecated: use ConnectMoveCursorFunc, which also accepts method values and closures.
 func (x *TextView) ConnectMoveCursor(cb *func(TextView, MovementStep, int, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp, CountVarp, ExtendSelectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-cursor", cbRefPtr, cbPtr)
+}
+
+var xTextViewMoveCursorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool) {
+func xTextViewMoveCursorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp MovementStep, CountVarp int, ExtendSelectionVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, MovementStep, int, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp, CountVarp, ExtendSelectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-cursor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveCursorFunc connects cb to the "move-cursor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectMoveCursorFunc(cb func(TextView, MovementStep, int, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-cursor", &xTextViewMoveCursorTrampoline, xTextViewMoveCursorNewTrampoline, cb)
 }
 
 // Gets emitted to move the viewport.
 //
-// The ::move-viewport signal is a [keybinding signal](class.SignalAction.html),
+// The ::move-viewport signal is a keybinding signal,
 // which can be bound to key combinations to allow the user to move the viewport,
 // i.e. change what part of the text view is visible in a containing scrolled
 // window.
 //
 // There are no default bindings for this signal.
+//
+// Deprecated: use ConnectMoveViewportFunc, which also accepts method values and closures.
 func (x *TextView) ConnectMoveViewport(cb *func(TextView, ScrollStep, int)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-viewport", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, StepVarp ScrollStep, CountVarp int) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, StepVarp, CountVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-viewport", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, StepVarp ScrollStep, CountVarp int) {
+var xTextViewMoveViewportTrampoline uintptr
+
+func xTextViewMoveViewportNewTrampoline() interface{} {
+	return func(clsPtr uintptr, StepVarp ScrollStep, CountVarp int, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, ScrollStep, int))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, StepVarp, CountVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-viewport", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveViewportFunc connects cb to the "move-viewport" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectMoveViewportFunc(cb func(TextView, ScrollStep, int)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-viewport", &xTextViewMoveViewportTrampoline, xTextViewMoveViewportNewTrampoline, cb)
 }
 
 // Gets emitted to paste the contents of the clipboard
 // into the text view.
 //
-// The ::paste-clipboard signal is a [keybinding signal](class.SignalAction.html).
+// The ::paste-clipboard signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;v&lt;/kbd&gt; and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Ctrl+v and
+// Shift+Insert.
+//
+// Deprecated: use ConnectPasteClipboardFunc, which also accepts method values and closures.
 func (x *TextView) ConnectPasteClipboard(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "paste-clipboard", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "paste-clipboard", cbRefPtr, cbPtr)
+}
+
+var xTextViewPasteClipboardTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextViewPasteClipboardNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "paste-clipboard", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPasteClipboardFunc connects cb to the "paste-clipboard" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectPasteClipboardFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "paste-clipboard", &xTextViewPasteClipboardTrampoline, xTextViewPasteClipboardNewTrampoline, cb)
 }
 
 // Emitted when preedit text of the active IM changes.
@@ -2289,147 +2631,257 @@ func (x *TextView) ConnectPasteClipboard(cb *func(TextView)) uint {
 //
 // This signal is only emitted if the text at the given position
 // is actually editable.
+//
+// Deprecated: use ConnectPreeditChangedFunc, which also accepts method values and closures.
 func (x *TextView) ConnectPreeditChanged(cb *func(TextView, string)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PreeditVarp string) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PreeditVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "preedit-changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, PreeditVarp string) {
+var xTextViewPreeditChangedTrampoline uintptr
+
+func xTextViewPreeditChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PreeditVarp string, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, string))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PreeditVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "preedit-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectPreeditChangedFunc connects cb to the "preedit-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectPreeditChangedFunc(cb func(TextView, string)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "preedit-changed", &xTextViewPreeditChangedTrampoline, xTextViewPreeditChangedNewTrampoline, cb)
 }
 
 // Gets emitted to select or unselect the complete contents of the text view.
 //
-// The ::select-all signal is a [keybinding signal](class.SignalAction.html).
+// The ::select-all signal is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;a&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;/&lt;/kbd&gt; for selecting and
-// &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;a&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;\&lt;/kbd&gt; for unselecting.
+// Ctrl+a and
+// Ctrl+/ for selecting and
+// Shift+Ctrl+a and
+// Ctrl+\ for unselecting.
+//
+// Deprecated: use ConnectSelectAllFunc, which also accepts method values and closures.
 func (x *TextView) ConnectSelectAll(cb *func(TextView, bool)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, SelectVarp bool) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, SelectVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "select-all", cbRefPtr, cbPtr)
+}
+
+var xTextViewSelectAllTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, SelectVarp bool) {
+func xTextViewSelectAllNewTrampoline() interface{} {
+	return func(clsPtr uintptr, SelectVarp bool, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView, bool))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, SelectVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "select-all", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSelectAllFunc connects cb to the "select-all" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectSelectAllFunc(cb func(TextView, bool)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "select-all", &xTextViewSelectAllTrampoline, xTextViewSelectAllNewTrampoline, cb)
 }
 
 // Gets emitted when the user initiates settings the "anchor" mark.
 //
-// The ::set-anchor signal is a [keybinding signal](class.SignalAction.html)
+// The ::set-anchor signal is a keybinding signal
 // which gets emitted when the user initiates setting the "anchor"
 // mark. The "anchor" mark gets placed at the same position as the
 // "insert" mark.
 //
 // This signal has no default bindings.
+//
+// Deprecated: use ConnectSetAnchorFunc, which also accepts method values and closures.
 func (x *TextView) ConnectSetAnchor(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "set-anchor", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "set-anchor", cbRefPtr, cbPtr)
+}
+
+var xTextViewSetAnchorTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextViewSetAnchorNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "set-anchor", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectSetAnchorFunc connects cb to the "set-anchor" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectSetAnchorFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "set-anchor", &xTextViewSetAnchorTrampoline, xTextViewSetAnchorNewTrampoline, cb)
 }
 
 // Gets emitted to toggle the `cursor-visible` property.
 //
 // The ::toggle-cursor-visible signal is a
-// [keybinding signal](class.SignalAction.html).
+// keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;F7&lt;/kbd&gt;.
+// The default binding for this signal is F7.
+//
+// Deprecated: use ConnectToggleCursorVisibleFunc, which also accepts method values and closures.
 func (x *TextView) ConnectToggleCursorVisible(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-visible", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-cursor-visible", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTextViewToggleCursorVisibleTrampoline uintptr
+
+func xTextViewToggleCursorVisibleNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-cursor-visible", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleCursorVisibleFunc connects cb to the "toggle-cursor-visible" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectToggleCursorVisibleFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-cursor-visible", &xTextViewToggleCursorVisibleTrampoline, xTextViewToggleCursorVisibleNewTrampoline, cb)
 }
 
 // Gets emitted to toggle the overwrite mode of the text view.
 //
-// The ::toggle-overwrite signal is a [keybinding signal](class.SignalAction.html).
+// The ::toggle-overwrite signal is a keybinding signal.
+//
+// The default binding for this signal is Insert.
 //
-// The default binding for this signal is &lt;kbd&gt;Insert&lt;/kbd&gt;.
+// Deprecated: use ConnectToggleOverwriteFunc, which also accepts method values and closures.
 func (x *TextView) ConnectToggleOverwrite(cb *func(TextView)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-overwrite", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TextView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggle-overwrite", cbRefPtr, cbPtr)
+}
+
+var xTextViewToggleOverwriteTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xTextViewToggleOverwriteNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TextView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TextView{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggle-overwrite", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggleOverwriteFunc connects cb to the "toggle-overwrite" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TextView) ConnectToggleOverwriteFunc(cb func(TextView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggle-overwrite", &xTextViewToggleOverwriteTrampoline, xTextViewToggleOverwriteNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -2593,7 +3045,7 @@ func (x *TextView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -2601,14 +3053,13 @@ func (x *TextView) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TextView) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -2632,19 +3083,17 @@ func (x *TextView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TextView) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -2665,7 +3114,7 @@ func (x *TextView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -2674,14 +3123,12 @@ func (x *TextView) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TextView) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -2737,10 +3184,10 @@ func (x *TextView) UpdateSelectionBound() {
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TextView) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -2842,7 +3289,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2857,80 +3304,82 @@ func init() {
 
 	core.PuregoSafeRegister(&xTextViewGLibType, libs, "gtk_text_view_get_type")
 
-	core.PuregoSafeRegister(&xNewTextView, libs, "gtk_text_view_new")
-	core.PuregoSafeRegister(&xNewTextViewWithBuffer, libs, "gtk_text_view_new_with_buffer")
-
-	core.PuregoSafeRegister(&xTextViewAddChildAtAnchor, libs, "gtk_text_view_add_child_at_anchor")
-	core.PuregoSafeRegister(&xTextViewAddOverlay, libs, "gtk_text_view_add_overlay")
-	core.PuregoSafeRegister(&xTextViewBackwardDisplayLine, libs, "gtk_text_view_backward_display_line")
-	core.PuregoSafeRegister(&xTextViewBackwardDisplayLineStart, libs, "gtk_text_view_backward_display_line_start")
-	core.PuregoSafeRegister(&xTextViewBufferToWindowCoords, libs, "gtk_text_view_buffer_to_window_coords")
-	core.PuregoSafeRegister(&xTextViewForwardDisplayLine, libs, "gtk_text_view_forward_display_line")
-	core.PuregoSafeRegister(&xTextViewForwardDisplayLineEnd, libs, "gtk_text_view_forward_display_line_end")
-	core.PuregoSafeRegister(&xTextViewGetAcceptsTab, libs, "gtk_text_view_get_accepts_tab")
-	core.PuregoSafeRegister(&xTextViewGetBottomMargin, libs, "gtk_text_view_get_bottom_margin")
-	core.PuregoSafeRegister(&xTextViewGetBuffer, libs, "gtk_text_view_get_buffer")
-	core.PuregoSafeRegister(&xTextViewGetCursorLocations, libs, "gtk_text_view_get_cursor_locations")
-	core.PuregoSafeRegister(&xTextViewGetCursorVisible, libs, "gtk_text_view_get_cursor_visible")
-	core.PuregoSafeRegister(&xTextViewGetEditable, libs, "gtk_text_view_get_editable")
-	core.PuregoSafeRegister(&xTextViewGetExtraMenu, libs, "gtk_text_view_get_extra_menu")
-	core.PuregoSafeRegister(&xTextViewGetGutter, libs, "gtk_text_view_get_gutter")
-	core.PuregoSafeRegister(&xTextViewGetIndent, libs, "gtk_text_view_get_indent")
-	core.PuregoSafeRegister(&xTextViewGetInputHints, libs, "gtk_text_view_get_input_hints")
-	core.PuregoSafeRegister(&xTextViewGetInputPurpose, libs, "gtk_text_view_get_input_purpose")
-	core.PuregoSafeRegister(&xTextViewGetIterAtLocation, libs, "gtk_text_view_get_iter_at_location")
-	core.PuregoSafeRegister(&xTextViewGetIterAtPosition, libs, "gtk_text_view_get_iter_at_position")
-	core.PuregoSafeRegister(&xTextViewGetIterLocation, libs, "gtk_text_view_get_iter_location")
-	core.PuregoSafeRegister(&xTextViewGetJustification, libs, "gtk_text_view_get_justification")
-	core.PuregoSafeRegister(&xTextViewGetLeftMargin, libs, "gtk_text_view_get_left_margin")
-	core.PuregoSafeRegister(&xTextViewGetLineAtY, libs, "gtk_text_view_get_line_at_y")
-	core.PuregoSafeRegister(&xTextViewGetLineYrange, libs, "gtk_text_view_get_line_yrange")
-	core.PuregoSafeRegister(&xTextViewGetLtrContext, libs, "gtk_text_view_get_ltr_context")
-	core.PuregoSafeRegister(&xTextViewGetMonospace, libs, "gtk_text_view_get_monospace")
-	core.PuregoSafeRegister(&xTextViewGetOverwrite, libs, "gtk_text_view_get_overwrite")
-	core.PuregoSafeRegister(&xTextViewGetPixelsAboveLines, libs, "gtk_text_view_get_pixels_above_lines")
-	core.PuregoSafeRegister(&xTextViewGetPixelsBelowLines, libs, "gtk_text_view_get_pixels_below_lines")
-	core.PuregoSafeRegister(&xTextViewGetPixelsInsideWrap, libs, "gtk_text_view_get_pixels_inside_wrap")
-	core.PuregoSafeRegister(&xTextViewGetRightMargin, libs, "gtk_text_view_get_right_margin")
-	core.PuregoSafeRegister(&xTextViewGetRtlContext, libs, "gtk_text_view_get_rtl_context")
-	core.PuregoSafeRegister(&xTextViewGetTabs, libs, "gtk_text_view_get_tabs")
-	core.PuregoSafeRegister(&xTextViewGetTopMargin, libs, "gtk_text_view_get_top_margin")
-	core.PuregoSafeRegister(&xTextViewGetVisibleOffset, libs, "gtk_text_view_get_visible_offset")
-	core.PuregoSafeRegister(&xTextViewGetVisibleRect, libs, "gtk_text_view_get_visible_rect")
-	core.PuregoSafeRegister(&xTextViewGetWrapMode, libs, "gtk_text_view_get_wrap_mode")
-	core.PuregoSafeRegister(&xTextViewImContextFilterKeypress, libs, "gtk_text_view_im_context_filter_keypress")
-	core.PuregoSafeRegister(&xTextViewMoveMarkOnscreen, libs, "gtk_text_view_move_mark_onscreen")
-	core.PuregoSafeRegister(&xTextViewMoveOverlay, libs, "gtk_text_view_move_overlay")
-	core.PuregoSafeRegister(&xTextViewMoveVisually, libs, "gtk_text_view_move_visually")
-	core.PuregoSafeRegister(&xTextViewPlaceCursorOnscreen, libs, "gtk_text_view_place_cursor_onscreen")
-	core.PuregoSafeRegister(&xTextViewRemove, libs, "gtk_text_view_remove")
-	core.PuregoSafeRegister(&xTextViewResetCursorBlink, libs, "gtk_text_view_reset_cursor_blink")
-	core.PuregoSafeRegister(&xTextViewResetImContext, libs, "gtk_text_view_reset_im_context")
-	core.PuregoSafeRegister(&xTextViewScrollMarkOnscreen, libs, "gtk_text_view_scroll_mark_onscreen")
-	core.PuregoSafeRegister(&xTextViewScrollToIter, libs, "gtk_text_view_scroll_to_iter")
-	core.PuregoSafeRegister(&xTextViewScrollToMark, libs, "gtk_text_view_scroll_to_mark")
-	core.PuregoSafeRegister(&xTextViewSetAcceptsTab, libs, "gtk_text_view_set_accepts_tab")
-	core.PuregoSafeRegister(&xTextViewSetBottomMargin, libs, "gtk_text_view_set_bottom_margin")
-	core.PuregoSafeRegister(&xTextViewSetBuffer, libs, "gtk_text_view_set_buffer")
-	core.PuregoSafeRegister(&xTextViewSetCursorVisible, libs, "gtk_text_view_set_cursor_visible")
-	core.PuregoSafeRegister(&xTextViewSetEditable, libs, "gtk_text_view_set_editable")
-	core.PuregoSafeRegister(&xTextViewSetExtraMenu, libs, "gtk_text_view_set_extra_menu")
-	core.PuregoSafeRegister(&xTextViewSetGutter, libs, "gtk_text_view_set_gutter")
-	core.PuregoSafeRegister(&xTextViewSetIndent, libs, "gtk_text_view_set_indent")
-	core.PuregoSafeRegister(&xTextViewSetInputHints, libs, "gtk_text_view_set_input_hints")
-	core.PuregoSafeRegister(&xTextViewSetInputPurpose, libs, "gtk_text_view_set_input_purpose")
-	core.PuregoSafeRegister(&xTextViewSetJustification, libs, "gtk_text_view_set_justification")
-	core.PuregoSafeRegister(&xTextViewSetLeftMargin, libs, "gtk_text_view_set_left_margin")
-	core.PuregoSafeRegister(&xTextViewSetMonospace, libs, "gtk_text_view_set_monospace")
-	core.PuregoSafeRegister(&xTextViewSetOverwrite, libs, "gtk_text_view_set_overwrite")
-	core.PuregoSafeRegister(&xTextViewSetPixelsAboveLines, libs, "gtk_text_view_set_pixels_above_lines")
-	core.PuregoSafeRegister(&xTextViewSetPixelsBelowLines, libs, "gtk_text_view_set_pixels_below_lines")
-	core.PuregoSafeRegister(&xTextViewSetPixelsInsideWrap, libs, "gtk_text_view_set_pixels_inside_wrap")
-	core.PuregoSafeRegister(&xTextViewSetRightMargin, libs, "gtk_text_view_set_right_margin")
-	core.PuregoSafeRegister(&xTextViewSetTabs, libs, "gtk_text_view_set_tabs")
-	core.PuregoSafeRegister(&xTextViewSetTopMargin, libs, "gtk_text_view_set_top_margin")
-	core.PuregoSafeRegister(&xTextViewSetWrapMode, libs, "gtk_text_view_set_wrap_mode")
-	core.PuregoSafeRegister(&xTextViewStartsDisplayLine, libs, "gtk_text_view_starts_display_line")
-	core.PuregoSafeRegister(&xTextViewWindowToBufferCoords, libs, "gtk_text_view_window_to_buffer_coords")
+	gobject.CheckClassSize("GtkTextViewClass", xTextViewGLibType, unsafe.Sizeof(TextViewClass{}))
+
+	core.PuregoRegisterSince(&xNewTextView, libs, "gtk_text_view_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTextViewWithBuffer, libs, "gtk_text_view_new_with_buffer", "GTK", "")
+
+	core.PuregoRegisterSince(&xTextViewAddChildAtAnchor, libs, "gtk_text_view_add_child_at_anchor", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewAddOverlay, libs, "gtk_text_view_add_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewBackwardDisplayLine, libs, "gtk_text_view_backward_display_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewBackwardDisplayLineStart, libs, "gtk_text_view_backward_display_line_start", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewBufferToWindowCoords, libs, "gtk_text_view_buffer_to_window_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewForwardDisplayLine, libs, "gtk_text_view_forward_display_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewForwardDisplayLineEnd, libs, "gtk_text_view_forward_display_line_end", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetAcceptsTab, libs, "gtk_text_view_get_accepts_tab", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetBottomMargin, libs, "gtk_text_view_get_bottom_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetBuffer, libs, "gtk_text_view_get_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetCursorLocations, libs, "gtk_text_view_get_cursor_locations", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetCursorVisible, libs, "gtk_text_view_get_cursor_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetEditable, libs, "gtk_text_view_get_editable", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetExtraMenu, libs, "gtk_text_view_get_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetGutter, libs, "gtk_text_view_get_gutter", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetIndent, libs, "gtk_text_view_get_indent", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetInputHints, libs, "gtk_text_view_get_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetInputPurpose, libs, "gtk_text_view_get_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetIterAtLocation, libs, "gtk_text_view_get_iter_at_location", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetIterAtPosition, libs, "gtk_text_view_get_iter_at_position", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetIterLocation, libs, "gtk_text_view_get_iter_location", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetJustification, libs, "gtk_text_view_get_justification", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetLeftMargin, libs, "gtk_text_view_get_left_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetLineAtY, libs, "gtk_text_view_get_line_at_y", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetLineYrange, libs, "gtk_text_view_get_line_yrange", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetLtrContext, libs, "gtk_text_view_get_ltr_context", "GTK", "4.4")
+	core.PuregoRegisterSince(&xTextViewGetMonospace, libs, "gtk_text_view_get_monospace", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetOverwrite, libs, "gtk_text_view_get_overwrite", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetPixelsAboveLines, libs, "gtk_text_view_get_pixels_above_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetPixelsBelowLines, libs, "gtk_text_view_get_pixels_below_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetPixelsInsideWrap, libs, "gtk_text_view_get_pixels_inside_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetRightMargin, libs, "gtk_text_view_get_right_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetRtlContext, libs, "gtk_text_view_get_rtl_context", "GTK", "4.4")
+	core.PuregoRegisterSince(&xTextViewGetTabs, libs, "gtk_text_view_get_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetTopMargin, libs, "gtk_text_view_get_top_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetVisibleOffset, libs, "gtk_text_view_get_visible_offset", "GTK", "4.18")
+	core.PuregoRegisterSince(&xTextViewGetVisibleRect, libs, "gtk_text_view_get_visible_rect", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewGetWrapMode, libs, "gtk_text_view_get_wrap_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewImContextFilterKeypress, libs, "gtk_text_view_im_context_filter_keypress", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewMoveMarkOnscreen, libs, "gtk_text_view_move_mark_onscreen", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewMoveOverlay, libs, "gtk_text_view_move_overlay", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewMoveVisually, libs, "gtk_text_view_move_visually", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewPlaceCursorOnscreen, libs, "gtk_text_view_place_cursor_onscreen", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewRemove, libs, "gtk_text_view_remove", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewResetCursorBlink, libs, "gtk_text_view_reset_cursor_blink", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewResetImContext, libs, "gtk_text_view_reset_im_context", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewScrollMarkOnscreen, libs, "gtk_text_view_scroll_mark_onscreen", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewScrollToIter, libs, "gtk_text_view_scroll_to_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewScrollToMark, libs, "gtk_text_view_scroll_to_mark", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetAcceptsTab, libs, "gtk_text_view_set_accepts_tab", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetBottomMargin, libs, "gtk_text_view_set_bottom_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetBuffer, libs, "gtk_text_view_set_buffer", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetCursorVisible, libs, "gtk_text_view_set_cursor_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetEditable, libs, "gtk_text_view_set_editable", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetExtraMenu, libs, "gtk_text_view_set_extra_menu", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetGutter, libs, "gtk_text_view_set_gutter", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetIndent, libs, "gtk_text_view_set_indent", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetInputHints, libs, "gtk_text_view_set_input_hints", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetInputPurpose, libs, "gtk_text_view_set_input_purpose", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetJustification, libs, "gtk_text_view_set_justification", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetLeftMargin, libs, "gtk_text_view_set_left_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetMonospace, libs, "gtk_text_view_set_monospace", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetOverwrite, libs, "gtk_text_view_set_overwrite", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetPixelsAboveLines, libs, "gtk_text_view_set_pixels_above_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetPixelsBelowLines, libs, "gtk_text_view_set_pixels_below_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetPixelsInsideWrap, libs, "gtk_text_view_set_pixels_inside_wrap", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetRightMargin, libs, "gtk_text_view_set_right_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetTabs, libs, "gtk_text_view_set_tabs", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetTopMargin, libs, "gtk_text_view_set_top_margin", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewSetWrapMode, libs, "gtk_text_view_set_wrap_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewStartsDisplayLine, libs, "gtk_text_view_starts_display_line", "GTK", "")
+	core.PuregoRegisterSince(&xTextViewWindowToBufferCoords, libs, "gtk_text_view_window_to_buffer_coords", "GTK", "")
 
 }
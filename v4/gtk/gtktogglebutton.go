@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -31,7 +30,8 @@ func (x *ToggleButtonClass) OverrideToggled(cb func(*ToggleButton)) {
 	if cb == nil {
 		x.xToggled = 0
 	} else {
-		x.xToggled = purego.NewCallback(func(ToggleButtonVarp uintptr) {
+		x.xToggled = core.NewCallback(func(ToggleButtonVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(ToggleButtonNewFromInternalPtr(ToggleButtonVarp))
 		})
 	}
@@ -43,7 +43,7 @@ func (x *ToggleButtonClass) GetToggled() func(*ToggleButton) {
 		return nil
 	}
 	var rawCallback func(ToggleButtonVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xToggled)
+	core.RegisterFunc(&rawCallback, x.xToggled)
 	return func(ToggleButtonVar *ToggleButton) {
 		rawCallback(ToggleButtonVar.GoPointer())
 	}
@@ -51,58 +51,47 @@ func (x *ToggleButtonClass) GetToggled() func(*ToggleButton) {
 
 // Shows a button which remains “pressed-in” when clicked.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="toggle-button-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Example GtkToggleButtons" src="toggle-button.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Clicking again will cause the toggle button to return to its normal state.
 //
-// A toggle button is created by calling either [ctor@Gtk.ToggleButton.new] or
-// [ctor@Gtk.ToggleButton.new_with_label]. If using the former, it is advisable
+// A toggle button is created by calling either [NewToggleButton] or
+// [NewToggleButtonWithLabel]. If using the former, it is advisable
 // to pack a widget, (such as a `GtkLabel` and/or a `GtkImage`), into the toggle
-// button’s container. (See [class@Gtk.Button] for more information).
+// button’s container. (See [Button] for more information).
 //
 // The state of a `GtkToggleButton` can be set specifically using
-// [method@Gtk.ToggleButton.set_active], and retrieved using
-// [method@Gtk.ToggleButton.get_active].
+// [ToggleButton.SetActive], and retrieved using
+// [ToggleButton.GetActive].
 //
-// ## Grouping
+// # Grouping
 //
 // Toggle buttons can be grouped together, to form mutually exclusive
 // groups - only one of the buttons can be toggled at a time, and toggling
 // another one will switch the currently toggled one off.
 //
-// To add a `GtkToggleButton` to a group, use [method@Gtk.ToggleButton.set_group].
+// To add a `GtkToggleButton` to a group, use [ToggleButton.SetGroup].
 //
-// ## CSS nodes
+// # CSS nodes
 //
 // `GtkToggleButton` has a single CSS node with name button. To differentiate
 // it from a plain `GtkButton`, it gets the `.toggle` style class.
 //
-// ## Accessibility
+// # Accessibility
 //
-// `GtkToggleButton` uses the [enum@Gtk.AccessibleRole.toggle_button] role.
+// `GtkToggleButton` uses the [AccessibleRoleToggleButtonValue] role.
 //
-// ## Creating two `GtkToggleButton` widgets.
-//
-// ```c
-// static void
-// output_state (GtkToggleButton *source,
-//
-//	gpointer         user_data)
+// # Creating two `GtkToggleButton` widgets.
 //
+//	static void
+//	output_state (GtkToggleButton *source,
+//	              gpointer         user_data)
 //	{
 //	  g_print ("Toggle button "%s" is active: %s",
 //	           gtk_button_get_label (GTK_BUTTON (source)),
 //	           gtk_toggle_button_get_active (source) ? "Yes" : "No");
 //	}
 //
-// static void
-// make_toggles (void)
-//
+//	static void
+//	make_toggles (void)
 //	{
 //	  GtkWidget *window, *toggle1, *toggle2;
 //	  GtkWidget *box;
@@ -129,8 +118,6 @@ func (x *ToggleButtonClass) GetToggled() func(*ToggleButton) {
 //	  gtk_window_set_child (GTK_WINDOW (window), box);
 //	  gtk_window_present (GTK_WINDOW (window));
 //	}
-//
-// ```
 type ToggleButton struct {
 	Button
 }
@@ -151,7 +138,7 @@ var xNewToggleButton func() uintptr
 
 // Creates a new toggle button.
 //
-// A widget should be packed into the button, as in [ctor@Gtk.Button.new].
+// A widget should be packed into the button, as in [NewButton].
 func NewToggleButton() *ToggleButton {
 	var cls *ToggleButton
 
@@ -187,8 +174,8 @@ var xNewToggleButtonWithMnemonic func(string) uintptr
 
 // Creates a new `GtkToggleButton` containing a label.
 //
-// The label will be created using [ctor@Gtk.Label.new_with_mnemonic],
-// so underscores in @label indicate the mnemonic for the button.
+// The label will be created using [NewLabelWithMnemonic],
+// so underscores in label indicate the mnemonic for the button.
 func NewToggleButtonWithMnemonic(LabelVar string) *ToggleButton {
 	var cls *ToggleButton
 
@@ -207,7 +194,7 @@ var xToggleButtonGetActive func(uintptr) bool
 
 // Queries a `GtkToggleButton` and returns its current state.
 //
-// Returns %TRUE if the toggle button is pressed in and %FALSE
+// Returns true if the toggle button is pressed in and false
 // if it is raised.
 func (x *ToggleButton) GetActive() bool {
 
@@ -219,11 +206,11 @@ var xToggleButtonSetActive func(uintptr, bool)
 
 // Sets the status of the toggle button.
 //
-// Set to %TRUE if you want the `GtkToggleButton` to be “pressed in”,
-// and %FALSE to raise it.
+// Set to true if you want the `GtkToggleButton` to be “pressed in”,
+// and false to raise it.
 //
 // If the status of the button changes, this action causes the
-// [signal@Gtk.ToggleButton::toggled] signal to be emitted.
+// [ToggleButton.ConnectToggledFunc] signal to be emitted.
 func (x *ToggleButton) SetActive(IsActiveVar bool) {
 
 	xToggleButtonSetActive(x.GoPointer(), IsActiveVar)
@@ -232,14 +219,14 @@ func (x *ToggleButton) SetActive(IsActiveVar bool) {
 
 var xToggleButtonSetGroup func(uintptr, uintptr)
 
-// Adds @self to the group of @group.
+// Adds self to the group of group.
 //
 // In a group of multiple toggle buttons, only one button can be active
 // at a time.
 //
 // Setting up groups in a cycle leads to undefined behavior.
 //
-// Note that the same effect can be achieved via the [iface@Gtk.Actionable]
+// Note that the same effect can be achieved via the [Actionable]
 // API, by using the same action with parameter type and state type 's'
 // for all buttons in the group, and giving each button its own target
 // value.
@@ -249,15 +236,6 @@ func (x *ToggleButton) SetGroup(GroupVar *ToggleButton) {
 
 }
 
-var xToggleButtonToggled func(uintptr)
-
-// Emits the ::toggled signal on the `GtkToggleButton`.
-func (x *ToggleButton) Toggled() {
-
-	xToggleButtonToggled(x.GoPointer())
-
-}
-
 func (c *ToggleButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -287,27 +265,49 @@ func (x *ToggleButton) GetPropertyActive() bool {
 }
 
 // Emitted whenever the `GtkToggleButton`'s state is changed.
+//
+// Deprecated: use ConnectToggledFunc, which also accepts method values and closures.
 func (x *ToggleButton) ConnectToggled(cb *func(ToggleButton)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := ToggleButton{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "toggled", cbRefPtr, cbPtr)
+}
+
+var xToggleButtonToggledTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xToggleButtonToggledNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(ToggleButton))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := ToggleButton{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "toggled", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectToggledFunc connects cb to the "toggled" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *ToggleButton) ConnectToggledFunc(cb func(ToggleButton)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "toggled", &xToggleButtonToggledTrampoline, xToggleButtonToggledNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -471,7 +471,7 @@ func (x *ToggleButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -479,14 +479,13 @@ func (x *ToggleButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *ToggleButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -510,19 +509,17 @@ func (x *ToggleButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *ToggleButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -543,7 +540,7 @@ func (x *ToggleButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -552,14 +549,12 @@ func (x *ToggleButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *ToggleButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -578,14 +573,14 @@ func (x *ToggleButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the action name for @actionable.
+// Gets the action name for actionable.
 func (x *ToggleButton) GetActionName() string {
 
 	cret := XGtkActionableGetActionName(x.GoPointer())
 	return cret
 }
 
-// Gets the current target value of @actionable.
+// Gets the current target value of actionable.
 func (x *ToggleButton) GetActionTargetValue() *glib.Variant {
 
 	cret := XGtkActionableGetActionTargetValue(x.GoPointer())
@@ -595,15 +590,15 @@ func (x *ToggleButton) GetActionTargetValue() *glib.Variant {
 // Specifies the name of the action with which this widget should be
 // associated.
 //
-// If @action_name is %NULL then the widget will be unassociated from
+// If action_name is nil then the widget will be unassociated from
 // any previous action.
 //
 // Usually this function is used when the widget is located (or will be
 // located) within the hierarchy of a `GtkApplicationWindow`.
 //
 // Names are of the form “win.save” or “app.quit” for actions on the
-// containing [class@ApplicationWindow] or its associated [class@Application],
-// respectively. This is the same form used for actions in the [class@Gio.Menu]
+// containing ApplicationWindow or its associated Application,
+// respectively. This is the same form used for actions in the [gio.Menu]
 // associated with the window.
 func (x *ToggleButton) SetActionName(ActionNameVar *string) {
 
@@ -616,13 +611,13 @@ func (x *ToggleButton) SetActionName(ActionNameVar *string) {
 
 // Sets the target of an actionable widget.
 //
-// This is a convenience function that calls [ctor@GLib.Variant.new] for
-// @format_string and uses the result to call
-// [method@Gtk.Actionable.set_action_target_value].
+// This is a convenience function that calls [glib.NewVariant] for
+// format_string and uses the result to call
+// [Actionable.SetActionTargetValue].
 //
 // If you are setting a string-valued target and want to set
 // the action name at the same time, you can use
-// [method@Gtk.Actionable.set_detailed_action_name].
+// [Actionable.SetDetailedActionName].
 func (x *ToggleButton) SetActionTarget(FormatStringVar string, varArgs ...interface{}) {
 
 	XGtkActionableSetActionTarget(x.GoPointer(), FormatStringVar, varArgs...)
@@ -631,14 +626,14 @@ func (x *ToggleButton) SetActionTarget(FormatStringVar string, varArgs ...interf
 
 // Sets the target value of an actionable widget.
 //
-// If @target_value is %NULL then the target value is unset.
+// If target_value is nil then the target value is unset.
 //
 // The target value has two purposes. First, it is used as the parameter
 // to activation of the action associated with the `GtkActionable` widget.
 // Second, it is used to determine if the widget should be rendered as
 // “active” — the widget is active if the state is equal to the given target.
 //
-// Consider the example of associating a set of buttons with a [iface@Gio.Action]
+// Consider the example of associating a set of buttons with a [gio.Action]
 // with string state in a typical “radio button” situation. Each button
 // will be associated with the same action, but with a different target
 // value for that action. Clicking on a particular button will activate
@@ -656,18 +651,18 @@ func (x *ToggleButton) SetActionTargetValue(TargetValueVar *glib.Variant) {
 // Sets the action-name and associated string target value of an
 // actionable widget.
 //
-// @detailed_action_name is a string in the format accepted by
-// [func@Gio.Action.parse_detailed_name].
+// detailed_action_name is a string in the format accepted by
+// [gio.ActionParseDetailedName].
 func (x *ToggleButton) SetDetailedActionName(DetailedActionNameVar string) {
 
 	XGtkActionableSetDetailedActionName(x.GoPointer(), DetailedActionNameVar)
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *ToggleButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -679,7 +674,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -688,13 +683,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xToggleButtonGLibType, libs, "gtk_toggle_button_get_type")
 
-	core.PuregoSafeRegister(&xNewToggleButton, libs, "gtk_toggle_button_new")
-	core.PuregoSafeRegister(&xNewToggleButtonWithLabel, libs, "gtk_toggle_button_new_with_label")
-	core.PuregoSafeRegister(&xNewToggleButtonWithMnemonic, libs, "gtk_toggle_button_new_with_mnemonic")
+	gobject.CheckClassSize("GtkToggleButtonClass", xToggleButtonGLibType, unsafe.Sizeof(ToggleButtonClass{}))
+
+	core.PuregoRegisterSince(&xNewToggleButton, libs, "gtk_toggle_button_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewToggleButtonWithLabel, libs, "gtk_toggle_button_new_with_label", "GTK", "")
+	core.PuregoRegisterSince(&xNewToggleButtonWithMnemonic, libs, "gtk_toggle_button_new_with_mnemonic", "GTK", "")
 
-	core.PuregoSafeRegister(&xToggleButtonGetActive, libs, "gtk_toggle_button_get_active")
-	core.PuregoSafeRegister(&xToggleButtonSetActive, libs, "gtk_toggle_button_set_active")
-	core.PuregoSafeRegister(&xToggleButtonSetGroup, libs, "gtk_toggle_button_set_group")
-	core.PuregoSafeRegister(&xToggleButtonToggled, libs, "gtk_toggle_button_toggled")
+	core.PuregoRegisterSince(&xToggleButtonGetActive, libs, "gtk_toggle_button_get_active", "GTK", "")
+	core.PuregoRegisterSince(&xToggleButtonSetActive, libs, "gtk_toggle_button_set_active", "GTK", "")
+	core.PuregoRegisterSince(&xToggleButtonSetGroup, libs, "gtk_toggle_button_set_group", "GTK", "")
 
 }
@@ -0,0 +1,35 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xToggleButtonToggled func(uintptr)
+
+// Emits the ::toggled signal on the `GtkToggleButton`.
+//
+// Deprecated: since 4.10. There is no good reason for an application ever to call this function.
+func (x *ToggleButton) Toggled() {
+
+	xToggleButtonToggled(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xToggleButtonToggled, libs, "gtk_toggle_button_toggled", "GTK", "")
+
+}
@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -13,8 +12,8 @@ import (
 // Represents a widget tooltip.
 //
 // Basic tooltips can be realized simply by using
-// [method@Gtk.Widget.set_tooltip_text] or
-// [method@Gtk.Widget.set_tooltip_markup] without
+// [Widget.SetTooltipText] or
+// [Widget.SetTooltipMarkup] without
 // any explicit tooltip object.
 //
 // When you need a tooltip with a little more fancy contents,
@@ -22,21 +21,21 @@ import (
 // contents per `GtkTreeView` row or cell, you will have to do a
 // little more work:
 //
-//   - Set the [property@Gtk.Widget:has-tooltip] property to %TRUE.
+//   - Set the [Widget.GetPropertyHasTooltip] property to true.
 //     This will make GTK monitor the widget for motion and related events
 //     which are needed to determine when and where to show a tooltip.
 //
-//   - Connect to the [signal@Gtk.Widget::query-tooltip] signal.
+//   - Connect to the [Widget.ConnectQueryTooltipFunc] signal.
 //     This signal will be emitted when a tooltip is supposed to be shown.
 //     One of the arguments passed to the signal handler is a `GtkTooltip`
 //     object. This is the object that we are about to display as a tooltip,
 //     and can be manipulated in your callback using functions like
-//     [method@Gtk.Tooltip.set_icon]. There are functions for setting
+//     [Tooltip.SetIcon]. There are functions for setting
 //     the tooltip’s markup, setting an image from a named icon, or even
 //     putting in a custom widget.
 //
-//   - Return %TRUE from your ::query-tooltip handler. This causes the tooltip
-//     to be show. If you return %FALSE, it will not be shown.
+//   - Return true from your ::query-tooltip handler. This causes the tooltip
+//     to be show. If you return false, it will not be shown.
 type Tooltip struct {
 	gobject.Object
 }
@@ -56,11 +55,11 @@ func TooltipNewFromInternalPtr(ptr uintptr) *Tooltip {
 var xTooltipSetCustom func(uintptr, uintptr)
 
 // Replaces the widget packed into the tooltip with
-// @custom_widget. @custom_widget does not get destroyed when the tooltip goes
+// custom_widget. custom_widget does not get destroyed when the tooltip goes
 // away.
 // By default a box with a `GtkImage` and `GtkLabel` is embedded in
-// the tooltip, which can be configured using gtk_tooltip_set_markup()
-// and gtk_tooltip_set_icon().
+// the tooltip, which can be configured using [Tooltip.SetMarkup]
+// and [Tooltip.SetIcon].
 func (x *Tooltip) SetCustom(CustomWidgetVar *Widget) {
 
 	xTooltipSetCustom(x.GoPointer(), CustomWidgetVar.GoPointer())
@@ -70,7 +69,7 @@ func (x *Tooltip) SetCustom(CustomWidgetVar *Widget) {
 var xTooltipSetIcon func(uintptr, uintptr)
 
 // Sets the icon of the tooltip (which is in front of the text) to be
-// @paintable.  If @paintable is %NULL, the image will be hidden.
+// paintable.  If paintable is nil, the image will be hidden.
 func (x *Tooltip) SetIcon(PaintableVar gdk.Paintable) {
 
 	xTooltipSetIcon(x.GoPointer(), PaintableVar.GoPointer())
@@ -80,8 +79,8 @@ func (x *Tooltip) SetIcon(PaintableVar gdk.Paintable) {
 var xTooltipSetIconFromGicon func(uintptr, uintptr)
 
 // Sets the icon of the tooltip (which is in front of the text)
-// to be the icon indicated by @gicon with the size indicated
-// by @size. If @gicon is %NULL, the image will be hidden.
+// to be the icon indicated by gicon with the size indicated
+// by size. If gicon is nil, the image will be hidden.
 func (x *Tooltip) SetIconFromGicon(GiconVar gio.Icon) {
 
 	xTooltipSetIconFromGicon(x.GoPointer(), GiconVar.GoPointer())
@@ -91,8 +90,8 @@ func (x *Tooltip) SetIconFromGicon(GiconVar gio.Icon) {
 var xTooltipSetIconFromIconName func(uintptr, uintptr)
 
 // Sets the icon of the tooltip (which is in front of the text) to be
-// the icon indicated by @icon_name with the size indicated
-// by @size.  If @icon_name is %NULL, the image will be hidden.
+// the icon indicated by icon_name with the size indicated
+// by size.  If icon_name is nil, the image will be hidden.
 func (x *Tooltip) SetIconFromIconName(IconNameVar *string) {
 
 	IconNameVarPtr := core.GStrdupNullable(IconNameVar)
@@ -104,10 +103,10 @@ func (x *Tooltip) SetIconFromIconName(IconNameVar *string) {
 
 var xTooltipSetMarkup func(uintptr, uintptr)
 
-// Sets the text of the tooltip to be @markup.
+// Sets the text of the tooltip to be markup.
 //
 // The string must be marked up with Pango markup.
-// If @markup is %NULL, the label will be hidden.
+// If markup is nil, the label will be hidden.
 func (x *Tooltip) SetMarkup(MarkupVar *string) {
 
 	MarkupVarPtr := core.GStrdupNullable(MarkupVar)
@@ -119,10 +118,10 @@ func (x *Tooltip) SetMarkup(MarkupVar *string) {
 
 var xTooltipSetText func(uintptr, uintptr)
 
-// Sets the text of the tooltip to be @text.
+// Sets the text of the tooltip to be text.
 //
-// If @text is %NULL, the label will be hidden.
-// See also [method@Gtk.Tooltip.set_markup].
+// If text is nil, the label will be hidden.
+// See also [Tooltip.SetMarkup].
 func (x *Tooltip) SetText(TextVar *string) {
 
 	TextVarPtr := core.GStrdupNullable(TextVar)
@@ -135,13 +134,13 @@ func (x *Tooltip) SetText(TextVar *string) {
 var xTooltipSetTipArea func(uintptr, *gdk.Rectangle)
 
 // Sets the area of the widget, where the contents of this tooltip apply,
-// to be @rect (in widget coordinates).  This is especially useful for
+// to be rect (in widget coordinates).  This is especially useful for
 // properly setting tooltips on `GtkTreeView` rows and cells, `GtkIconViews`,
 // etc.
 //
 // For setting tooltips on `GtkTreeView`, please refer to the convenience
-// functions for this: gtk_tree_view_set_tooltip_row() and
-// gtk_tree_view_set_tooltip_cell().
+// functions for this: [TreeView.SetTooltipRow] and
+// [TreeView.SetTooltipCell].
 func (x *Tooltip) SetTipArea(RectVar *gdk.Rectangle) {
 
 	xTooltipSetTipArea(x.GoPointer(), RectVar)
@@ -164,7 +163,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -173,12 +172,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xTooltipGLibType, libs, "gtk_tooltip_get_type")
 
-	core.PuregoSafeRegister(&xTooltipSetCustom, libs, "gtk_tooltip_set_custom")
-	core.PuregoSafeRegister(&xTooltipSetIcon, libs, "gtk_tooltip_set_icon")
-	core.PuregoSafeRegister(&xTooltipSetIconFromGicon, libs, "gtk_tooltip_set_icon_from_gicon")
-	core.PuregoSafeRegister(&xTooltipSetIconFromIconName, libs, "gtk_tooltip_set_icon_from_icon_name")
-	core.PuregoSafeRegister(&xTooltipSetMarkup, libs, "gtk_tooltip_set_markup")
-	core.PuregoSafeRegister(&xTooltipSetText, libs, "gtk_tooltip_set_text")
-	core.PuregoSafeRegister(&xTooltipSetTipArea, libs, "gtk_tooltip_set_tip_area")
+	core.PuregoRegisterSince(&xTooltipSetCustom, libs, "gtk_tooltip_set_custom", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetIcon, libs, "gtk_tooltip_set_icon", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetIconFromGicon, libs, "gtk_tooltip_set_icon_from_gicon", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetIconFromIconName, libs, "gtk_tooltip_set_icon_from_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetMarkup, libs, "gtk_tooltip_set_markup", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetText, libs, "gtk_tooltip_set_text", "GTK", "")
+	core.PuregoRegisterSince(&xTooltipSetTipArea, libs, "gtk_tooltip_set_tip_area", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -15,7 +14,7 @@ import (
 type TreeDragDestIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xDragDataReceived uintptr
 
@@ -35,7 +34,8 @@ func (x *TreeDragDestIface) OverrideDragDataReceived(cb func(TreeDragDest, *Tree
 	if cb == nil {
 		x.xDragDataReceived = 0
 	} else {
-		x.xDragDataReceived = purego.NewCallback(func(DragDestVarp uintptr, DestVarp *TreePath, ValueVarp *gobject.Value) bool {
+		x.xDragDataReceived = core.NewCallback(func(DragDestVarp uintptr, DestVarp *TreePath, ValueVarp *gobject.Value) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeDragDestBase{Ptr: DragDestVarp}, DestVarp, ValueVarp)
 		})
 	}
@@ -51,7 +51,7 @@ func (x *TreeDragDestIface) GetDragDataReceived() func(TreeDragDest, *TreePath,
 		return nil
 	}
 	var rawCallback func(DragDestVarp uintptr, DestVarp *TreePath, ValueVarp *gobject.Value) bool
-	purego.RegisterFunc(&rawCallback, x.xDragDataReceived)
+	core.RegisterFunc(&rawCallback, x.xDragDataReceived)
 	return func(DragDestVar TreeDragDest, DestVar *TreePath, ValueVar *gobject.Value) bool {
 		return rawCallback(DragDestVar.GoPointer(), DestVar, ValueVar)
 	}
@@ -65,7 +65,8 @@ func (x *TreeDragDestIface) OverrideRowDropPossible(cb func(TreeDragDest, *TreeP
 	if cb == nil {
 		x.xRowDropPossible = 0
 	} else {
-		x.xRowDropPossible = purego.NewCallback(func(DragDestVarp uintptr, DestPathVarp *TreePath, ValueVarp *gobject.Value) bool {
+		x.xRowDropPossible = core.NewCallback(func(DragDestVarp uintptr, DestPathVarp *TreePath, ValueVarp *gobject.Value) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeDragDestBase{Ptr: DragDestVarp}, DestPathVarp, ValueVarp)
 		})
 	}
@@ -80,7 +81,7 @@ func (x *TreeDragDestIface) GetRowDropPossible() func(TreeDragDest, *TreePath, *
 		return nil
 	}
 	var rawCallback func(DragDestVarp uintptr, DestPathVarp *TreePath, ValueVarp *gobject.Value) bool
-	purego.RegisterFunc(&rawCallback, x.xRowDropPossible)
+	core.RegisterFunc(&rawCallback, x.xRowDropPossible)
 	return func(DragDestVar TreeDragDest, DestPathVar *TreePath, ValueVar *gobject.Value) bool {
 		return rawCallback(DragDestVar.GoPointer(), DestPathVar, ValueVar)
 	}
@@ -89,7 +90,7 @@ func (x *TreeDragDestIface) GetRowDropPossible() func(TreeDragDest, *TreePath, *
 type TreeDragSourceIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xRowDraggable uintptr
 
@@ -110,7 +111,8 @@ func (x *TreeDragSourceIface) OverrideRowDraggable(cb func(TreeDragSource, *Tree
 	if cb == nil {
 		x.xRowDraggable = 0
 	} else {
-		x.xRowDraggable = purego.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) bool {
+		x.xRowDraggable = core.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeDragSourceBase{Ptr: DragSourceVarp}, PathVarp)
 		})
 	}
@@ -125,7 +127,7 @@ func (x *TreeDragSourceIface) GetRowDraggable() func(TreeDragSource, *TreePath)
 		return nil
 	}
 	var rawCallback func(DragSourceVarp uintptr, PathVarp *TreePath) bool
-	purego.RegisterFunc(&rawCallback, x.xRowDraggable)
+	core.RegisterFunc(&rawCallback, x.xRowDraggable)
 	return func(DragSourceVar TreeDragSource, PathVar *TreePath) bool {
 		return rawCallback(DragSourceVar.GoPointer(), PathVar)
 	}
@@ -139,7 +141,8 @@ func (x *TreeDragSourceIface) OverrideDragDataGet(cb func(TreeDragSource, *TreeP
 	if cb == nil {
 		x.xDragDataGet = 0
 	} else {
-		x.xDragDataGet = purego.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) uintptr {
+		x.xDragDataGet = core.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(&TreeDragSourceBase{Ptr: DragSourceVarp}, PathVarp)
 			if ret == nil {
 				return 0
@@ -158,7 +161,7 @@ func (x *TreeDragSourceIface) GetDragDataGet() func(TreeDragSource, *TreePath) *
 		return nil
 	}
 	var rawCallback func(DragSourceVarp uintptr, PathVarp *TreePath) uintptr
-	purego.RegisterFunc(&rawCallback, x.xDragDataGet)
+	core.RegisterFunc(&rawCallback, x.xDragDataGet)
 	return func(DragSourceVar TreeDragSource, PathVar *TreePath) *gdk.ContentProvider {
 		rawRet := rawCallback(DragSourceVar.GoPointer(), PathVar)
 		if rawRet == 0 {
@@ -178,7 +181,8 @@ func (x *TreeDragSourceIface) OverrideDragDataDelete(cb func(TreeDragSource, *Tr
 	if cb == nil {
 		x.xDragDataDelete = 0
 	} else {
-		x.xDragDataDelete = purego.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) bool {
+		x.xDragDataDelete = core.NewCallback(func(DragSourceVarp uintptr, PathVarp *TreePath) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeDragSourceBase{Ptr: DragSourceVarp}, PathVarp)
 		})
 	}
@@ -193,13 +197,17 @@ func (x *TreeDragSourceIface) GetDragDataDelete() func(TreeDragSource, *TreePath
 		return nil
 	}
 	var rawCallback func(DragSourceVarp uintptr, PathVarp *TreePath) bool
-	purego.RegisterFunc(&rawCallback, x.xDragDataDelete)
+	core.RegisterFunc(&rawCallback, x.xDragDataDelete)
 	return func(DragSourceVar TreeDragSource, PathVar *TreePath) bool {
 		return rawCallback(DragSourceVar.GoPointer(), PathVar)
 	}
 }
 
 // Interface for Drag-and-Drop destinations in `GtkTreeView`.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You can use [DropTarget] to implement a drop destination
 type TreeDragDest interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -228,23 +236,62 @@ func (x *TreeDragDestBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Asks the `GtkTreeDragDest` to insert a row before the path @dest,
-// deriving the contents of the row from @value. If @dest is
-// outside the tree so that inserting before it is impossible, %FALSE
-// will be returned. Also, %FALSE may be returned if the new row is
+// ImplementTreeDragDest adds the TreeDragDest interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - DragDataReceived(*TreePath, *gobject.Value) bool
+//   - RowDropPossible(*TreePath, *gobject.Value) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TreeDragDestIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTreeDragDest[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TreeDragDestGLibType(), func(iface *TreeDragDestIface) {
+		if _, ok := any(zero).(interface {
+			DragDataReceived(*TreePath, *gobject.Value) bool
+		}); ok {
+			iface.OverrideDragDataReceived(func(self TreeDragDest, arg0 *TreePath, arg1 *gobject.Value) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					DragDataReceived(*TreePath, *gobject.Value) bool
+				})
+				return impl.DragDataReceived(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			RowDropPossible(*TreePath, *gobject.Value) bool
+		}); ok {
+			iface.OverrideRowDropPossible(func(self TreeDragDest, arg0 *TreePath, arg1 *gobject.Value) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					RowDropPossible(*TreePath, *gobject.Value) bool
+				})
+				return impl.RowDropPossible(arg0, arg1)
+			})
+		}
+	})
+}
+
+// Asks the `GtkTreeDragDest` to insert a row before the path dest,
+// deriving the contents of the row from value. If dest is
+// outside the tree so that inserting before it is impossible, false
+// will be returned. Also, false may be returned if the new row is
 // not created for some model-specific reason.  Should robustly handle
-// a @dest no longer found in the model!
+// a dest no longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeDragDestBase) DragDataReceived(DestVar *TreePath, ValueVar *gobject.Value) bool {
 
 	cret := XGtkTreeDragDestDragDataReceived(x.GoPointer(), DestVar, ValueVar)
 	return cret
 }
 
-// Determines whether a drop is possible before the given @dest_path,
-// at the same depth as @dest_path. i.e., can we drop the data in
-// @value at that location. @dest_path does not have to
-// exist; the return value will almost certainly be %FALSE if the
-// parent of @dest_path doesn’t exist, though.
+// Determines whether a drop is possible before the given dest_path,
+// at the same depth as dest_path. i.e., can we drop the data in
+// value at that location. dest_path does not have to
+// exist; the return value will almost certainly be false if the
+// parent of dest_path doesn’t exist, though.
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeDragDestBase) RowDropPossible(DestPathVar *TreePath, ValueVar *gobject.Value) bool {
 
 	cret := XGtkTreeDragDestRowDropPossible(x.GoPointer(), DestPathVar, ValueVar)
@@ -255,6 +302,10 @@ var XGtkTreeDragDestDragDataReceived func(uintptr, *TreePath, *gobject.Value) bo
 var XGtkTreeDragDestRowDropPossible func(uintptr, *TreePath, *gobject.Value) bool
 
 // Interface for Drag-and-Drop destinations in `GtkTreeView`.
+//
+// Deprecated: since 4.10. List views use widgets to display their contents.
+//
+//	You can use [DragSource] to implement a drag source
 type TreeDragSource interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -284,11 +335,51 @@ func (x *TreeDragSourceBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Asks the `GtkTreeDragSource` to delete the row at @path, because
-// it was moved somewhere else via drag-and-drop. Returns %FALSE
-// if the deletion fails because @path no longer exists, or for
-// some model-specific reason. Should robustly handle a @path no
+// ImplementTreeDragSource adds the TreeDragSource interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - RowDraggable(*TreePath) bool
+//   - DragDataGet(*TreePath) *gdk.ContentProvider
+//   - DragDataDelete(*TreePath) bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TreeDragSourceIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTreeDragSource[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TreeDragSourceGLibType(), func(iface *TreeDragSourceIface) {
+		if _, ok := any(zero).(interface{ RowDraggable(*TreePath) bool }); ok {
+			iface.OverrideRowDraggable(func(self TreeDragSource, arg0 *TreePath) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RowDraggable(*TreePath) bool })
+				return impl.RowDraggable(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			DragDataGet(*TreePath) *gdk.ContentProvider
+		}); ok {
+			iface.OverrideDragDataGet(func(self TreeDragSource, arg0 *TreePath) *gdk.ContentProvider {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					DragDataGet(*TreePath) *gdk.ContentProvider
+				})
+				return impl.DragDataGet(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ DragDataDelete(*TreePath) bool }); ok {
+			iface.OverrideDragDataDelete(func(self TreeDragSource, arg0 *TreePath) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ DragDataDelete(*TreePath) bool })
+				return impl.DragDataDelete(arg0)
+			})
+		}
+	})
+}
+
+// Asks the `GtkTreeDragSource` to delete the row at path, because
+// it was moved somewhere else via drag-and-drop. Returns false
+// if the deletion fails because path no longer exists, or for
+// some model-specific reason. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeDragSourceBase) DragDataDelete(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceDragDataDelete(x.GoPointer(), PathVar)
@@ -296,8 +387,10 @@ func (x *TreeDragSourceBase) DragDataDelete(PathVar *TreePath) bool {
 }
 
 // Asks the `GtkTreeDragSource` to return a `GdkContentProvider` representing
-// the row at @path. Should robustly handle a @path no
+// the row at path. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeDragSourceBase) DragDataGet(PathVar *TreePath) *gdk.ContentProvider {
 	var cls *gdk.ContentProvider
 
@@ -314,6 +407,8 @@ func (x *TreeDragSourceBase) DragDataGet(PathVar *TreePath) *gdk.ContentProvider
 // Asks the `GtkTreeDragSource` whether a particular row can be used as
 // the source of a DND operation. If the source doesn’t implement
 // this interface, the row is assumed draggable.
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeDragSourceBase) RowDraggable(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceRowDraggable(x.GoPointer(), PathVar)
@@ -324,58 +419,31 @@ var XGtkTreeDragSourceDragDataDelete func(uintptr, *TreePath) bool
 var XGtkTreeDragSourceDragDataGet func(uintptr, *TreePath) uintptr
 var XGtkTreeDragSourceRowDraggable func(uintptr, *TreePath) bool
 
-var xTreeCreateRowDragContent func(uintptr, *TreePath) uintptr
-
-// Creates a content provider for dragging @path from @tree_model.
-func TreeCreateRowDragContent(TreeModelVar TreeModel, PathVar *TreePath) *gdk.ContentProvider {
-	var cls *gdk.ContentProvider
-
-	cret := xTreeCreateRowDragContent(TreeModelVar.GoPointer(), PathVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &gdk.ContentProvider{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xTreeGetRowDragData func(*gobject.Value, **TreeModel, **TreePath) bool
-
-// Obtains a @tree_model and @path from value of target type
-// %GTK_TYPE_TREE_ROW_DATA.
-//
-// The returned path must be freed with gtk_tree_path_free().
-func TreeGetRowDragData(ValueVar *gobject.Value, TreeModelVar **TreeModel, PathVar **TreePath) bool {
-
-	cret := xTreeGetRowDragData(ValueVar, TreeModelVar, PathVar)
-	return cret
-}
-
 func init() {
 	core.SetPackageName("GTK", "gtk4")
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xTreeCreateRowDragContent, libs, "gtk_tree_create_row_drag_content")
-	core.PuregoSafeRegister(&xTreeGetRowDragData, libs, "gtk_tree_get_row_drag_data")
-
 	core.PuregoSafeRegister(&xTreeDragDestGLibType, libs, "gtk_tree_drag_dest_get_type")
 
-	core.PuregoSafeRegister(&XGtkTreeDragDestDragDataReceived, libs, "gtk_tree_drag_dest_drag_data_received")
-	core.PuregoSafeRegister(&XGtkTreeDragDestRowDropPossible, libs, "gtk_tree_drag_dest_row_drop_possible")
+	gobject.CheckClassSize("GtkTreeDragDestIface", xTreeDragDestGLibType, unsafe.Sizeof(TreeDragDestIface{}))
+
+	core.PuregoRegisterSince(&XGtkTreeDragDestDragDataReceived, libs, "gtk_tree_drag_dest_drag_data_received", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeDragDestRowDropPossible, libs, "gtk_tree_drag_dest_row_drop_possible", "GTK", "")
 
 	core.PuregoSafeRegister(&xTreeDragSourceGLibType, libs, "gtk_tree_drag_source_get_type")
 
-	core.PuregoSafeRegister(&XGtkTreeDragSourceDragDataDelete, libs, "gtk_tree_drag_source_drag_data_delete")
-	core.PuregoSafeRegister(&XGtkTreeDragSourceDragDataGet, libs, "gtk_tree_drag_source_drag_data_get")
-	core.PuregoSafeRegister(&XGtkTreeDragSourceRowDraggable, libs, "gtk_tree_drag_source_row_draggable")
+	gobject.CheckClassSize("GtkTreeDragSourceIface", xTreeDragSourceGLibType, unsafe.Sizeof(TreeDragSourceIface{}))
+
+	core.PuregoRegisterSince(&XGtkTreeDragSourceDragDataDelete, libs, "gtk_tree_drag_source_drag_data_delete", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeDragSourceDragDataGet, libs, "gtk_tree_drag_source_drag_data_get", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeDragSourceRowDraggable, libs, "gtk_tree_drag_source_row_draggable", "GTK", "")
 
 }
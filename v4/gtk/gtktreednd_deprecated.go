@@ -0,0 +1,59 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xTreeCreateRowDragContent func(uintptr, *TreePath) uintptr
+
+// Creates a content provider for dragging path from tree_model.
+//
+// Deprecated: since 4.10. Use list models instead
+func TreeCreateRowDragContent(TreeModelVar TreeModel, PathVar *TreePath) *gdk.ContentProvider {
+	var cls *gdk.ContentProvider
+
+	cret := xTreeCreateRowDragContent(TreeModelVar.GoPointer(), PathVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gdk.ContentProvider{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeGetRowDragData func(*gobject.Value, **TreeModel, **TreePath) bool
+
+// Obtains a tree_model and path from value of target type
+// GTK_TYPE_TREE_ROW_DATA.
+//
+// The returned path must be freed with [TreePath.Free].
+//
+// Deprecated: since 4.10. Use list models instead
+func TreeGetRowDragData(ValueVar *gobject.Value, TreeModelVar **TreeModel, PathVar **TreePath) bool {
+
+	cret := xTreeGetRowDragData(ValueVar, TreeModelVar, PathVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeCreateRowDragContent, libs, "gtk_tree_create_row_drag_content", "GTK", "")
+	core.PuregoRegisterSince(&xTreeGetRowDragData, libs, "gtk_tree_get_row_drag_data", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -25,7 +24,7 @@ func (x *TreeExpanderClass) GoPointer() uintptr {
 //
 // It is typically placed as a bottommost child into a `GtkListView`
 // to allow users to expand and collapse children in a list with a
-// [class@Gtk.TreeListModel]. `GtkTreeExpander` provides the common UI
+// [TreeListModel]. `GtkTreeExpander` provides the common UI
 // elements, gestures and keybindings for this purpose.
 //
 // On top of this, the "listitem.expand", "listitem.collapse" and
@@ -33,40 +32,40 @@ func (x *TreeExpanderClass) GoPointer() uintptr {
 // UI for managing expanded state.
 //
 // It is important to mention that you want to set the
-// [property@Gtk.ListItem:focusable] property to FALSE when using this
+// [ListItem.GetPropertyFocusable] property to FALSE when using this
 // widget, as you want the keyboard focus to be in the treexpander, and not
 // inside the list to make use of the keybindings.
 //
 // The `GtkTreeListModel` must be set to not be passthrough. Then it
-// will provide [class@Gtk.TreeListRow] items which can be set via
-// [method@Gtk.TreeExpander.set_list_row] on the expander.
+// will provide [TreeListRow] items which can be set via
+// [TreeExpander.SetListRow] on the expander.
 // The expander will then watch that row item automatically.
-// [method@Gtk.TreeExpander.set_child] sets the widget that displays
+// [TreeExpander.SetChild] sets the widget that displays
 // the actual row contents.
 //
 // `GtkTreeExpander` can be modified with properties such as
-// [property@Gtk.TreeExpander:indent-for-icon],
-// [property@Gtk.TreeExpander:indent-for-depth], and
-// [property@Gtk.TreeExpander:hide-expander] to achieve a different appearance.
+// [TreeExpander.GetPropertyIndentForIcon],
+// [TreeExpander.GetPropertyIndentForDepth], and
+// [TreeExpander.GetPropertyHideExpander] to achieve a different appearance.
 // This can even be done to influence individual rows, for example by binding
-// the [property@Gtk.TreeExpander:hide-expander] property to the item count of
+// the [TreeExpander.GetPropertyHideExpander] property to the item count of
 // the model of the treelistrow, to hide the expander for rows without children,
 // even if the row is expandable.
 //
-// ## Shortcuts and Gestures
+// # Shortcuts and Gestures
 //
 // `GtkTreeExpander` supports the following keyboard shortcuts:
 //
-//   - &lt;kbd&gt;+&lt;/kbd&gt; or &lt;kbd&gt;*&lt;/kbd&gt; expands the expander.
-//   - &lt;kbd&gt;-&lt;/kbd&gt; or &lt;kbd&gt;/&lt;/kbd&gt; collapses the expander.
-//   - Left and right arrow keys, when combined with &lt;kbd&gt;Shift&lt;/kbd&gt; or
-//     &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;, will expand or collapse, depending on
+//   - + or * expands the expander.
+//   - - or / collapses the expander.
+//   - Left and right arrow keys, when combined with Shift or
+//     Ctrl+Shift, will expand or collapse, depending on
 //     the locale's text direction.
-//   - &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;␣&lt;/kbd&gt; toggles the expander state.
+//   - Ctrl+␣ toggles the expander state.
 //
 // The row can also expand on drag gestures.
 //
-// ## Actions
+// # Actions
 //
 // `GtkTreeExpander` defines a set of built-in actions:
 //
@@ -75,14 +74,12 @@ func (x *TreeExpanderClass) GoPointer() uintptr {
 //   - `listitem.toggle-expand` tries to expand the expander if it was collapsed
 //     or collapses it if it was expanded.
 //
-// ## CSS nodes
+// # CSS nodes
 //
-// ```
-// treeexpander
-// ├── [indent]*
-// ├── [expander]
-// ╰── &lt;child&gt;
-// ```
+//	treeexpander
+//	├── [indent]*
+//	├── [expander]
+//	╰── <child>
 //
 // `GtkTreeExpander` has zero or one CSS nodes with the name "expander" that
 // should display the expander icon. The node will be `:checked` when it
@@ -91,11 +88,11 @@ func (x *TreeExpanderClass) GoPointer() uintptr {
 //
 // For every level of depth, another "indent" node is prepended.
 //
-// ## Accessibility
+// # Accessibility
 //
-// Until GTK 4.10, `GtkTreeExpander` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkTreeExpander` used the [AccessibleRoleGroupValue] role.
 //
-// Since GTK 4.12, `GtkTreeExpander` uses the [enum@Gtk.AccessibleRole.button] role.
+// Since GTK 4.12, `GtkTreeExpander` uses the [AccessibleRoleButtonValue] role.
 // Toggling it will change the `GTK_ACCESSIBLE_STATE_EXPANDED` state.
 type TreeExpander struct {
 	Widget
@@ -132,7 +129,7 @@ func NewTreeExpander() *TreeExpander {
 
 var xTreeExpanderGetChild func(uintptr) uintptr
 
-// Gets the child widget displayed by @self.
+// Gets the child widget displayed by self.
 func (x *TreeExpander) GetChild() *Widget {
 	var cls *Widget
 
@@ -176,13 +173,11 @@ func (x *TreeExpander) GetIndentForIcon() bool {
 
 var xTreeExpanderGetItem func(uintptr) uintptr
 
-// Forwards the item set on the `GtkTreeListRow` that @self is managing.
+// Forwards the item set on the `GtkTreeListRow` that self is managing.
 //
 // This call is essentially equivalent to calling:
 //
-// ```c
-// gtk_tree_list_row_get_item (gtk_tree_expander_get_list_row (@self));
-// ```
+//	gtk_tree_list_row_get_item (gtk_tree_expander_get_list_row (@self));
 func (x *TreeExpander) GetItem() *gobject.Object {
 	var cls *gobject.Object
 
@@ -198,7 +193,7 @@ func (x *TreeExpander) GetItem() *gobject.Object {
 
 var xTreeExpanderGetListRow func(uintptr) uintptr
 
-// Gets the list row managed by @self.
+// Gets the list row managed by self.
 func (x *TreeExpander) GetListRow() *TreeListRow {
 	var cls *TreeListRow
 
@@ -491,7 +486,7 @@ func (x *TreeExpander) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -499,14 +494,13 @@ func (x *TreeExpander) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *TreeExpander) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -530,19 +524,17 @@ func (x *TreeExpander) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *TreeExpander) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -563,7 +555,7 @@ func (x *TreeExpander) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -572,14 +564,12 @@ func (x *TreeExpander) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *TreeExpander) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -598,10 +588,10 @@ func (x *TreeExpander) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TreeExpander) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -613,7 +603,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -622,18 +612,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeExpanderGLibType, libs, "gtk_tree_expander_get_type")
 
-	core.PuregoSafeRegister(&xNewTreeExpander, libs, "gtk_tree_expander_new")
-
-	core.PuregoSafeRegister(&xTreeExpanderGetChild, libs, "gtk_tree_expander_get_child")
-	core.PuregoSafeRegister(&xTreeExpanderGetHideExpander, libs, "gtk_tree_expander_get_hide_expander")
-	core.PuregoSafeRegister(&xTreeExpanderGetIndentForDepth, libs, "gtk_tree_expander_get_indent_for_depth")
-	core.PuregoSafeRegister(&xTreeExpanderGetIndentForIcon, libs, "gtk_tree_expander_get_indent_for_icon")
-	core.PuregoSafeRegister(&xTreeExpanderGetItem, libs, "gtk_tree_expander_get_item")
-	core.PuregoSafeRegister(&xTreeExpanderGetListRow, libs, "gtk_tree_expander_get_list_row")
-	core.PuregoSafeRegister(&xTreeExpanderSetChild, libs, "gtk_tree_expander_set_child")
-	core.PuregoSafeRegister(&xTreeExpanderSetHideExpander, libs, "gtk_tree_expander_set_hide_expander")
-	core.PuregoSafeRegister(&xTreeExpanderSetIndentForDepth, libs, "gtk_tree_expander_set_indent_for_depth")
-	core.PuregoSafeRegister(&xTreeExpanderSetIndentForIcon, libs, "gtk_tree_expander_set_indent_for_icon")
-	core.PuregoSafeRegister(&xTreeExpanderSetListRow, libs, "gtk_tree_expander_set_list_row")
+	gobject.CheckClassSize("GtkTreeExpanderClass", xTreeExpanderGLibType, unsafe.Sizeof(TreeExpanderClass{}))
+
+	core.PuregoRegisterSince(&xNewTreeExpander, libs, "gtk_tree_expander_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeExpanderGetChild, libs, "gtk_tree_expander_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xTreeExpanderGetHideExpander, libs, "gtk_tree_expander_get_hide_expander", "GTK", "4.10")
+	core.PuregoRegisterSince(&xTreeExpanderGetIndentForDepth, libs, "gtk_tree_expander_get_indent_for_depth", "GTK", "4.10")
+	core.PuregoRegisterSince(&xTreeExpanderGetIndentForIcon, libs, "gtk_tree_expander_get_indent_for_icon", "GTK", "4.6")
+	core.PuregoRegisterSince(&xTreeExpanderGetItem, libs, "gtk_tree_expander_get_item", "GTK", "")
+	core.PuregoRegisterSince(&xTreeExpanderGetListRow, libs, "gtk_tree_expander_get_list_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeExpanderSetChild, libs, "gtk_tree_expander_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xTreeExpanderSetHideExpander, libs, "gtk_tree_expander_set_hide_expander", "GTK", "4.10")
+	core.PuregoRegisterSince(&xTreeExpanderSetIndentForDepth, libs, "gtk_tree_expander_set_indent_for_depth", "GTK", "4.10")
+	core.PuregoRegisterSince(&xTreeExpanderSetIndentForIcon, libs, "gtk_tree_expander_set_indent_for_icon", "GTK", "4.6")
+	core.PuregoRegisterSince(&xTreeExpanderSetListRow, libs, "gtk_tree_expander_set_list_row", "GTK", "")
 
 }
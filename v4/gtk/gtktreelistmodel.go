@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,9 +13,9 @@ import (
 )
 
 // Prototype of the function called to create new child models when
-// gtk_tree_list_row_set_expanded() is called.
+// [TreeListRow.SetExpanded] is called.
 //
-// This function can return %NULL to indicate that @item is guaranteed to be
+// This function can return nil to indicate that item is guaranteed to be
 // a leaf node and will never have children. If it does not have children but
 // may get children later, it should return an empty model that is filled once
 // children arrive.
@@ -61,7 +60,7 @@ func TreeListModelNewFromInternalPtr(ptr uintptr) *TreeListModel {
 
 var xNewTreeListModel func(uintptr, bool, bool, uintptr, uintptr, uintptr) uintptr
 
-// Creates a new empty `GtkTreeListModel` displaying @root
+// Creates a new empty `GtkTreeListModel` displaying root
 // with all rows collapsed.
 func NewTreeListModel(RootVar gio.ListModel, PassthroughVar bool, AutoexpandVar bool, CreateFuncVar *TreeListModelCreateModelFunc, UserDataVar uintptr, UserDestroyVar *glib.DestroyNotify) *TreeListModel {
 	var cls *TreeListModel
@@ -73,11 +72,12 @@ func NewTreeListModel(RootVar gio.ListModel, PassthroughVar bool, AutoexpandVar
 			CreateFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CreateFuncVar
 				return cbFn(arg0, arg1)
 			}
-			CreateFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CreateFuncVarPtr, CreateFuncVarRef, CreateFuncVar)
+			CreateFuncVarRef = core.NewCallback(fcb)
+			CreateFuncVarRef = glib.SaveCallbackWithClosure(CreateFuncVarPtr, CreateFuncVarRef, CreateFuncVar)
 		}
 	}
 
@@ -88,11 +88,12 @@ func NewTreeListModel(RootVar gio.ListModel, PassthroughVar bool, AutoexpandVar
 			UserDestroyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *UserDestroyVar
 				cbFn(arg0)
 			}
-			UserDestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
+			UserDestroyVarRef = core.NewCallback(fcb)
+			UserDestroyVarRef = glib.SaveCallbackWithClosure(UserDestroyVarPtr, UserDestroyVarRef, UserDestroyVar)
 		}
 	}
 
@@ -112,7 +113,7 @@ var xTreeListModelGetAutoexpand func(uintptr) bool
 // that get added.
 //
 // This can be either rows added by changes to the underlying
-// models or via [method@Gtk.TreeListRow.set_expanded].
+// models or via [TreeListRow.SetExpanded].
 func (x *TreeListModel) GetAutoexpand() bool {
 
 	cret := xTreeListModelGetAutoexpand(x.GoPointer())
@@ -121,13 +122,13 @@ func (x *TreeListModel) GetAutoexpand() bool {
 
 var xTreeListModelGetChildRow func(uintptr, uint) uintptr
 
-// Gets the row item corresponding to the child at index @position for
-// @self's root model.
+// Gets the row item corresponding to the child at index position for
+// self's root model.
 //
-// If @position is greater than the number of children in the root model,
-// %NULL is returned.
+// If position is greater than the number of children in the root model,
+// nil is returned.
 //
-// Do not confuse this function with [method@Gtk.TreeListModel.get_row].
+// Do not confuse this function with [TreeListModel.GetRow].
 func (x *TreeListModel) GetChildRow(PositionVar uint) *TreeListRow {
 	var cls *TreeListRow
 
@@ -143,7 +144,7 @@ func (x *TreeListModel) GetChildRow(PositionVar uint) *TreeListRow {
 
 var xTreeListModelGetModel func(uintptr) uintptr
 
-// Gets the root model that @self was created with.
+// Gets the root model that self was created with.
 func (x *TreeListModel) GetModel() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -162,13 +163,13 @@ var xTreeListModelGetPassthrough func(uintptr) bool
 
 // Gets whether the model is passing through original row items.
 //
-// If this function returns %FALSE, the `GListModel` functions for @self
+// If this function returns false, the `GListModel` functions for self
 // return custom `GtkTreeListRow` objects. You need to call
-// [method@Gtk.TreeListRow.get_item] on these objects to get the original
+// [TreeListRow.GetItem] on these objects to get the original
 // item.
 //
-// If %TRUE, the values of the child models are passed through in their
-// original state. You then need to call [method@Gtk.TreeListModel.get_row]
+// If true, the values of the child models are passed through in their
+// original state. You then need to call [TreeListModel.GetRow]
 // to get the custom `GtkTreeListRow`s.
 func (x *TreeListModel) GetPassthrough() bool {
 
@@ -180,21 +181,21 @@ var xTreeListModelGetRow func(uintptr, uint) uintptr
 
 // Gets the row object for the given row.
 //
-// If @position is greater than the number of items in @self,
-// %NULL is returned.
+// If position is greater than the number of items in self,
+// nil is returned.
 //
 // The row object can be used to expand and collapse rows as
 // well as to inspect its position in the tree. See its
 // documentation for details.
 //
 // This row object is persistent and will refer to the current
-// item as long as the row is present in @self, independent of
+// item as long as the row is present in self, independent of
 // other rows being added or removed.
 //
-// If @self is set to not be passthrough, this function is
-// equivalent to calling g_list_model_get_item().
+// If self is set to not be passthrough, this function is
+// equivalent to calling [gio.ListModel.GetItem].
 //
-// Do not confuse this function with [method@Gtk.TreeListModel.get_child_row].
+// Do not confuse this function with [TreeListModel.GetChildRow].
 func (x *TreeListModel) GetRow(PositionVar uint) *TreeListRow {
 	var cls *TreeListRow
 
@@ -212,9 +213,9 @@ var xTreeListModelSetAutoexpand func(uintptr, bool)
 
 // Sets whether the model should autoexpand.
 //
-// If set to %TRUE, the model will recursively expand all rows that
+// If set to true, the model will recursively expand all rows that
 // get added to the model. This can be either rows added by changes
-// to the underlying models or via [method@Gtk.TreeListRow.set_expanded].
+// to the underlying models or via [TreeListRow.SetExpanded].
 func (x *TreeListModel) SetAutoexpand(AutoexpandVar bool) {
 
 	xTreeListModelSetAutoexpand(x.GoPointer(), AutoexpandVar)
@@ -250,7 +251,7 @@ func (x *TreeListModel) GetPropertyAutoexpand() bool {
 }
 
 // GetPropertyNItems gets the "n-items" property.
-// The number of items. See [method@Gio.ListModel.get_n_items].
+// The number of items. See [gio.ListModel.GetNItems].
 func (x *TreeListModel) GetPropertyNItems() uint {
 	var v gobject.Value
 	x.GetProperty("n-items", &v)
@@ -260,8 +261,8 @@ func (x *TreeListModel) GetPropertyNItems() uint {
 // SetPropertyPassthrough sets the "passthrough" property.
 // Gets whether the model is in passthrough mode.
 //
-// If %FALSE, the `GListModel` functions for this object return custom
-// [class@Gtk.TreeListRow] objects. If %TRUE, the values of the child
+// If false, the `GListModel` functions for this object return custom
+// [TreeListRow] objects. If true, the values of the child
 // models are pass through unmodified.
 func (x *TreeListModel) SetPropertyPassthrough(value bool) {
 	var v gobject.Value
@@ -273,8 +274,8 @@ func (x *TreeListModel) SetPropertyPassthrough(value bool) {
 // GetPropertyPassthrough gets the "passthrough" property.
 // Gets whether the model is in passthrough mode.
 //
-// If %FALSE, the `GListModel` functions for this object return custom
-// [class@Gtk.TreeListRow] objects. If %TRUE, the values of the child
+// If false, the `GListModel` functions for this object return custom
+// [TreeListRow] objects. If true, the values of the child
 // models are pass through unmodified.
 func (x *TreeListModel) GetPropertyPassthrough() bool {
 	var v gobject.Value
@@ -282,28 +283,30 @@ func (x *TreeListModel) GetPropertyPassthrough() bool {
 	return v.GetBoolean()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *TreeListModel) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *TreeListModel) GetItemType() types.GType {
 
@@ -311,29 +314,29 @@ func (x *TreeListModel) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *TreeListModel) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *TreeListModel) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -347,20 +350,20 @@ func (x *TreeListModel) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -378,11 +381,11 @@ func (x *TreeListModel) ItemsChanged(PositionVar uint, RemovedVar uint, AddedVar
 // It allows navigating the model as a tree and modify the state of rows.
 //
 // `GtkTreeListRow` instances are created by a `GtkTreeListModel` only
-// when the [property@Gtk.TreeListModel:passthrough] property is not set.
+// when the [TreeListModel.GetPropertyPassthrough] property is not set.
 //
 // There are various support objects that can make use of `GtkTreeListRow`
-// objects, such as the [class@Gtk.TreeExpander] widget that allows displaying
-// an icon to expand or collapse a row or [class@Gtk.TreeListRowSorter] that
+// objects, such as the [TreeExpander] widget that allows displaying
+// an icon to expand or collapse a row or [TreeListRowSorter] that
 // makes it possible to sort trees properly.
 type TreeListRow struct {
 	gobject.Object
@@ -402,8 +405,8 @@ func TreeListRowNewFromInternalPtr(ptr uintptr) *TreeListRow {
 
 var xTreeListRowGetChildRow func(uintptr, uint) uintptr
 
-// If @self is not expanded or @position is greater than the
-// number of children, %NULL is returned.
+// If self is not expanded or position is greater than the
+// number of children, nil is returned.
 func (x *TreeListRow) GetChildRow(PositionVar uint) *TreeListRow {
 	var cls *TreeListRow
 
@@ -419,12 +422,12 @@ func (x *TreeListRow) GetChildRow(PositionVar uint) *TreeListRow {
 
 var xTreeListRowGetChildren func(uintptr) uintptr
 
-// If the row is expanded, gets the model holding the children of @self.
+// If the row is expanded, gets the model holding the children of self.
 //
 // This model is the model created by the
-// [callback@Gtk.TreeListModelCreateModelFunc]
+// [TreeListModelCreateModelFunc]
 // and contains the original items, no matter what value
-// [property@Gtk.TreeListModel:passthrough] is set to.
+// [TreeListModel.GetPropertyPassthrough] is set to.
 func (x *TreeListRow) GetChildren() *gio.ListModelBase {
 	var cls *gio.ListModelBase
 
@@ -482,17 +485,17 @@ func (x *TreeListRow) GetItem() *gobject.Object {
 
 var xTreeListRowGetParent func(uintptr) uintptr
 
-// Gets the row representing the parent for @self.
+// Gets the row representing the parent for self.
 //
 // That is the row that would need to be collapsed
 // to make this row disappear.
 //
-// If @self is a row corresponding to the root model,
-// %NULL is returned.
+// If self is a row corresponding to the root model,
+// nil is returned.
 //
 // The value returned by this function never changes
 // until the row is removed from its model at which point
-// it will forever return %NULL.
+// it will forever return nil.
 func (x *TreeListRow) GetParent() *TreeListRow {
 	var cls *TreeListRow
 
@@ -508,7 +511,7 @@ func (x *TreeListRow) GetParent() *TreeListRow {
 
 var xTreeListRowGetPosition func(uintptr) uint
 
-// Returns the position in the `GtkTreeListModel` that @self occupies
+// Returns the position in the `GtkTreeListModel` that self occupies
 // at the moment.
 func (x *TreeListRow) GetPosition() uint {
 
@@ -521,10 +524,10 @@ var xTreeListRowIsExpandable func(uintptr) bool
 // Checks if a row can be expanded.
 //
 // This does not mean that the row is actually expanded,
-// this can be checked with [method@Gtk.TreeListRow.get_expanded].
+// this can be checked with [TreeListRow.GetExpanded].
 //
 // If a row is expandable never changes until the row is removed
-// from its model at which point it will forever return %FALSE.
+// from its model at which point it will forever return false.
 func (x *TreeListRow) IsExpandable() bool {
 
 	cret := xTreeListRowIsExpandable(x.GoPointer())
@@ -536,7 +539,7 @@ var xTreeListRowSetExpanded func(uintptr, bool)
 // Expands or collapses a row.
 //
 // If a row is expanded, the model of calling the
-// [callback@Gtk.TreeListModelCreateModelFunc] for the row's
+// [TreeListModelCreateModelFunc] for the row's
 // item will be inserted after this row. If a row is collapsed,
 // those items will be removed from the model.
 //
@@ -596,7 +599,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -605,25 +608,29 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeListModelGLibType, libs, "gtk_tree_list_model_get_type")
 
-	core.PuregoSafeRegister(&xNewTreeListModel, libs, "gtk_tree_list_model_new")
+	gobject.CheckClassSize("GtkTreeListModelClass", xTreeListModelGLibType, unsafe.Sizeof(TreeListModelClass{}))
+
+	core.PuregoRegisterSince(&xNewTreeListModel, libs, "gtk_tree_list_model_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xTreeListModelGetAutoexpand, libs, "gtk_tree_list_model_get_autoexpand")
-	core.PuregoSafeRegister(&xTreeListModelGetChildRow, libs, "gtk_tree_list_model_get_child_row")
-	core.PuregoSafeRegister(&xTreeListModelGetModel, libs, "gtk_tree_list_model_get_model")
-	core.PuregoSafeRegister(&xTreeListModelGetPassthrough, libs, "gtk_tree_list_model_get_passthrough")
-	core.PuregoSafeRegister(&xTreeListModelGetRow, libs, "gtk_tree_list_model_get_row")
-	core.PuregoSafeRegister(&xTreeListModelSetAutoexpand, libs, "gtk_tree_list_model_set_autoexpand")
+	core.PuregoRegisterSince(&xTreeListModelGetAutoexpand, libs, "gtk_tree_list_model_get_autoexpand", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListModelGetChildRow, libs, "gtk_tree_list_model_get_child_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListModelGetModel, libs, "gtk_tree_list_model_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListModelGetPassthrough, libs, "gtk_tree_list_model_get_passthrough", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListModelGetRow, libs, "gtk_tree_list_model_get_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListModelSetAutoexpand, libs, "gtk_tree_list_model_set_autoexpand", "GTK", "")
 
 	core.PuregoSafeRegister(&xTreeListRowGLibType, libs, "gtk_tree_list_row_get_type")
 
-	core.PuregoSafeRegister(&xTreeListRowGetChildRow, libs, "gtk_tree_list_row_get_child_row")
-	core.PuregoSafeRegister(&xTreeListRowGetChildren, libs, "gtk_tree_list_row_get_children")
-	core.PuregoSafeRegister(&xTreeListRowGetDepth, libs, "gtk_tree_list_row_get_depth")
-	core.PuregoSafeRegister(&xTreeListRowGetExpanded, libs, "gtk_tree_list_row_get_expanded")
-	core.PuregoSafeRegister(&xTreeListRowGetItem, libs, "gtk_tree_list_row_get_item")
-	core.PuregoSafeRegister(&xTreeListRowGetParent, libs, "gtk_tree_list_row_get_parent")
-	core.PuregoSafeRegister(&xTreeListRowGetPosition, libs, "gtk_tree_list_row_get_position")
-	core.PuregoSafeRegister(&xTreeListRowIsExpandable, libs, "gtk_tree_list_row_is_expandable")
-	core.PuregoSafeRegister(&xTreeListRowSetExpanded, libs, "gtk_tree_list_row_set_expanded")
+	gobject.CheckClassSize("GtkTreeListRowClass", xTreeListRowGLibType, unsafe.Sizeof(TreeListRowClass{}))
+
+	core.PuregoRegisterSince(&xTreeListRowGetChildRow, libs, "gtk_tree_list_row_get_child_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetChildren, libs, "gtk_tree_list_row_get_children", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetDepth, libs, "gtk_tree_list_row_get_depth", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetExpanded, libs, "gtk_tree_list_row_get_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetItem, libs, "gtk_tree_list_row_get_item", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetParent, libs, "gtk_tree_list_row_get_parent", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowGetPosition, libs, "gtk_tree_list_row_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowIsExpandable, libs, "gtk_tree_list_row_is_expandable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowSetExpanded, libs, "gtk_tree_list_row_set_expanded", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,13 +25,11 @@ func (x *TreeListRowSorterClass) GoPointer() uintptr {
 // Here is an example for setting up a column view with a tree model and
 // a `GtkTreeListSorter`:
 //
-// ```c
-// column_sorter = gtk_column_view_get_sorter (view);
-// sorter = gtk_tree_list_row_sorter_new (g_object_ref (column_sorter));
-// sort_model = gtk_sort_list_model_new (tree_model, sorter);
-// selection = gtk_single_selection_new (sort_model);
-// gtk_column_view_set_model (view, G_LIST_MODEL (selection));
-// ```
+//	column_sorter = gtk_column_view_get_sorter (view);
+//	sorter = gtk_tree_list_row_sorter_new (g_object_ref (column_sorter));
+//	sort_model = gtk_sort_list_model_new (tree_model, sorter);
+//	selection = gtk_single_selection_new (sort_model);
+//	gtk_column_view_set_model (view, G_LIST_MODEL (selection));
 type TreeListRowSorter struct {
 	Sorter
 }
@@ -52,10 +49,10 @@ func TreeListRowSorterNewFromInternalPtr(ptr uintptr) *TreeListRowSorter {
 var xNewTreeListRowSorter func(uintptr) uintptr
 
 // Create a special-purpose sorter that applies the sorting
-// of @sorter to the levels of a `GtkTreeListModel`.
+// of sorter to the levels of a `GtkTreeListModel`.
 //
-// Note that this sorter relies on [property@Gtk.TreeListModel:passthrough]
-// being %FALSE as it can only sort [class@Gtk.TreeListRow]s.
+// Note that this sorter relies on [TreeListModel.GetPropertyPassthrough]
+// being false as it can only sort [TreeListRow]s.
 func NewTreeListRowSorter(SorterVar *Sorter) *TreeListRowSorter {
 	var cls *TreeListRowSorter
 
@@ -71,7 +68,7 @@ func NewTreeListRowSorter(SorterVar *Sorter) *TreeListRowSorter {
 
 var xTreeListRowSorterGetSorter func(uintptr) uintptr
 
-// Returns the sorter used by @self.
+// Returns the sorter used by self.
 func (x *TreeListRowSorter) GetSorter() *Sorter {
 	var cls *Sorter
 
@@ -90,8 +87,8 @@ var xTreeListRowSorterSetSorter func(uintptr, uintptr)
 
 // Sets the sorter to use for items with the same parent.
 //
-// This sorter will be passed the [property@Gtk.TreeListRow:item] of
-// the tree list rows passed to @self.
+// This sorter will be passed the TreeListRow:item of
+// the tree list rows passed to self.
 func (x *TreeListRowSorter) SetSorter(SorterVar *Sorter) {
 
 	xTreeListRowSorterSetSorter(x.GoPointer(), SorterVar.GoPointer())
@@ -114,7 +111,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -123,9 +120,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeListRowSorterGLibType, libs, "gtk_tree_list_row_sorter_get_type")
 
-	core.PuregoSafeRegister(&xNewTreeListRowSorter, libs, "gtk_tree_list_row_sorter_new")
+	gobject.CheckClassSize("GtkTreeListRowSorterClass", xTreeListRowSorterGLibType, unsafe.Sizeof(TreeListRowSorterClass{}))
 
-	core.PuregoSafeRegister(&xTreeListRowSorterGetSorter, libs, "gtk_tree_list_row_sorter_get_sorter")
-	core.PuregoSafeRegister(&xTreeListRowSorterSetSorter, libs, "gtk_tree_list_row_sorter_set_sorter")
+	core.PuregoRegisterSince(&xNewTreeListRowSorter, libs, "gtk_tree_list_row_sorter_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeListRowSorterGetSorter, libs, "gtk_tree_list_row_sorter_get_sorter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeListRowSorterSetSorter, libs, "gtk_tree_list_row_sorter_set_sorter", "GTK", "")
 
 }
@@ -2,25 +2,30 @@
 package gtk
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// Type of the callback passed to gtk_tree_model_foreach() to
+// Type of the callback passed to [TreeModel.Foreach] to
 // iterate over the rows in a tree model.
+//
+// Deprecated: since 4.20. There is no replacement.
 type TreeModelForeachFunc func(uintptr, *TreePath, *TreeIter, uintptr) bool
 
 // The `GtkTreeIter` is the primary structure
 // for accessing a `GtkTreeModel`. Models are expected to put a unique
-// integer in the @stamp member, and put
-// model-specific data in the three @user_data
+// integer in the stamp member, and put
+// model-specific data in the three user_data
 // members.
+//
+// Deprecated: since 4.10. do not use it in new code.
 type TreeIter struct {
 	_ structs.HostLayout
 
@@ -43,35 +48,18 @@ func (x *TreeIter) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xTreeIterCopy func(uintptr) *TreeIter
-
-// Creates a dynamically allocated tree iterator as a copy of @iter.
-//
-// This function is not intended for use in applications,
-// because you can just copy the structs by value
-// (`GtkTreeIter new_iter = iter;`).
-// You must free this iter with gtk_tree_iter_free().
-func (x *TreeIter) Copy() *TreeIter {
-
-	cret := xTreeIterCopy(x.GoPointer())
-	return cret
-}
-
-var xTreeIterFree func(uintptr)
-
-// Frees an iterator that has been allocated by gtk_tree_iter_copy().
-//
-// This function is mainly used for language bindings.
-func (x *TreeIter) Free() {
-
-	xTreeIterFree(x.GoPointer())
-
+// NewTreeIterAlloc returns a zeroed TreeIter allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated TreeIter. Release it with Free.
+func NewTreeIterAlloc() *TreeIter {
+	cret := core.GMalloc0(max(unsafe.Sizeof(TreeIter{}), 32))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*TreeIter)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
 }
 
 type TreeModelIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xRowChanged uintptr
 
@@ -124,7 +112,8 @@ func (x *TreeModelIface) OverrideRowChanged(cb func(TreeModel, *TreePath, *TreeI
 	if cb == nil {
 		x.xRowChanged = 0
 	} else {
-		x.xRowChanged = purego.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+		x.xRowChanged = core.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, PathVarp, IterVarp)
 		})
 	}
@@ -137,7 +126,7 @@ func (x *TreeModelIface) GetRowChanged() func(TreeModel, *TreePath, *TreeIter) {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter)
-	purego.RegisterFunc(&rawCallback, x.xRowChanged)
+	core.RegisterFunc(&rawCallback, x.xRowChanged)
 	return func(TreeModelVar TreeModel, PathVar *TreePath, IterVar *TreeIter) {
 		rawCallback(TreeModelVar.GoPointer(), PathVar, IterVar)
 	}
@@ -151,7 +140,8 @@ func (x *TreeModelIface) OverrideRowInserted(cb func(TreeModel, *TreePath, *Tree
 	if cb == nil {
 		x.xRowInserted = 0
 	} else {
-		x.xRowInserted = purego.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+		x.xRowInserted = core.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, PathVarp, IterVarp)
 		})
 	}
@@ -166,7 +156,7 @@ func (x *TreeModelIface) GetRowInserted() func(TreeModel, *TreePath, *TreeIter)
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter)
-	purego.RegisterFunc(&rawCallback, x.xRowInserted)
+	core.RegisterFunc(&rawCallback, x.xRowInserted)
 	return func(TreeModelVar TreeModel, PathVar *TreePath, IterVar *TreeIter) {
 		rawCallback(TreeModelVar.GoPointer(), PathVar, IterVar)
 	}
@@ -180,7 +170,8 @@ func (x *TreeModelIface) OverrideRowHasChildToggled(cb func(TreeModel, *TreePath
 	if cb == nil {
 		x.xRowHasChildToggled = 0
 	} else {
-		x.xRowHasChildToggled = purego.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+		x.xRowHasChildToggled = core.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, PathVarp, IterVarp)
 		})
 	}
@@ -195,7 +186,7 @@ func (x *TreeModelIface) GetRowHasChildToggled() func(TreeModel, *TreePath, *Tre
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter)
-	purego.RegisterFunc(&rawCallback, x.xRowHasChildToggled)
+	core.RegisterFunc(&rawCallback, x.xRowHasChildToggled)
 	return func(TreeModelVar TreeModel, PathVar *TreePath, IterVar *TreeIter) {
 		rawCallback(TreeModelVar.GoPointer(), PathVar, IterVar)
 	}
@@ -207,7 +198,8 @@ func (x *TreeModelIface) OverrideRowDeleted(cb func(TreeModel, *TreePath)) {
 	if cb == nil {
 		x.xRowDeleted = 0
 	} else {
-		x.xRowDeleted = purego.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath) {
+		x.xRowDeleted = core.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, PathVarp)
 		})
 	}
@@ -220,7 +212,7 @@ func (x *TreeModelIface) GetRowDeleted() func(TreeModel, *TreePath) {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, PathVarp *TreePath)
-	purego.RegisterFunc(&rawCallback, x.xRowDeleted)
+	core.RegisterFunc(&rawCallback, x.xRowDeleted)
 	return func(TreeModelVar TreeModel, PathVar *TreePath) {
 		rawCallback(TreeModelVar.GoPointer(), PathVar)
 	}
@@ -234,7 +226,8 @@ func (x *TreeModelIface) OverrideRowsReordered(cb func(TreeModel, *TreePath, *Tr
 	if cb == nil {
 		x.xRowsReordered = 0
 	} else {
-		x.xRowsReordered = purego.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter, NewOrderVarp int) {
+		x.xRowsReordered = core.NewCallback(func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter, NewOrderVarp int) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, PathVarp, IterVarp, NewOrderVarp)
 		})
 	}
@@ -249,7 +242,7 @@ func (x *TreeModelIface) GetRowsReordered() func(TreeModel, *TreePath, *TreeIter
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, PathVarp *TreePath, IterVarp *TreeIter, NewOrderVarp int)
-	purego.RegisterFunc(&rawCallback, x.xRowsReordered)
+	core.RegisterFunc(&rawCallback, x.xRowsReordered)
 	return func(TreeModelVar TreeModel, PathVar *TreePath, IterVar *TreeIter, NewOrderVar int) {
 		rawCallback(TreeModelVar.GoPointer(), PathVar, IterVar, NewOrderVar)
 	}
@@ -261,7 +254,8 @@ func (x *TreeModelIface) OverrideGetFlags(cb func(TreeModel) TreeModelFlags) {
 	if cb == nil {
 		x.xGetFlags = 0
 	} else {
-		x.xGetFlags = purego.NewCallback(func(TreeModelVarp uintptr) TreeModelFlags {
+		x.xGetFlags = core.NewCallback(func(TreeModelVarp uintptr) TreeModelFlags {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp})
 		})
 	}
@@ -274,7 +268,7 @@ func (x *TreeModelIface) GetGetFlags() func(TreeModel) TreeModelFlags {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr) TreeModelFlags
-	purego.RegisterFunc(&rawCallback, x.xGetFlags)
+	core.RegisterFunc(&rawCallback, x.xGetFlags)
 	return func(TreeModelVar TreeModel) TreeModelFlags {
 		return rawCallback(TreeModelVar.GoPointer())
 	}
@@ -286,7 +280,8 @@ func (x *TreeModelIface) OverrideGetNColumns(cb func(TreeModel) int) {
 	if cb == nil {
 		x.xGetNColumns = 0
 	} else {
-		x.xGetNColumns = purego.NewCallback(func(TreeModelVarp uintptr) int {
+		x.xGetNColumns = core.NewCallback(func(TreeModelVarp uintptr) int {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp})
 		})
 	}
@@ -299,7 +294,7 @@ func (x *TreeModelIface) GetGetNColumns() func(TreeModel) int {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr) int
-	purego.RegisterFunc(&rawCallback, x.xGetNColumns)
+	core.RegisterFunc(&rawCallback, x.xGetNColumns)
 	return func(TreeModelVar TreeModel) int {
 		return rawCallback(TreeModelVar.GoPointer())
 	}
@@ -311,7 +306,8 @@ func (x *TreeModelIface) OverrideGetColumnType(cb func(TreeModel, int) types.GTy
 	if cb == nil {
 		x.xGetColumnType = 0
 	} else {
-		x.xGetColumnType = purego.NewCallback(func(TreeModelVarp uintptr, IndexVarp int) types.GType {
+		x.xGetColumnType = core.NewCallback(func(TreeModelVarp uintptr, IndexVarp int) types.GType {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IndexVarp)
 		})
 	}
@@ -324,7 +320,7 @@ func (x *TreeModelIface) GetGetColumnType() func(TreeModel, int) types.GType {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IndexVarp int) types.GType
-	purego.RegisterFunc(&rawCallback, x.xGetColumnType)
+	core.RegisterFunc(&rawCallback, x.xGetColumnType)
 	return func(TreeModelVar TreeModel, IndexVar int) types.GType {
 		return rawCallback(TreeModelVar.GoPointer(), IndexVar)
 	}
@@ -336,7 +332,8 @@ func (x *TreeModelIface) OverrideGetIter(cb func(TreeModel, *TreeIter, *TreePath
 	if cb == nil {
 		x.xGetIter = 0
 	} else {
-		x.xGetIter = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, PathVarp *TreePath) bool {
+		x.xGetIter = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, PathVarp *TreePath) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp, PathVarp)
 		})
 	}
@@ -349,7 +346,7 @@ func (x *TreeModelIface) GetGetIter() func(TreeModel, *TreeIter, *TreePath) bool
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter, PathVarp *TreePath) bool
-	purego.RegisterFunc(&rawCallback, x.xGetIter)
+	core.RegisterFunc(&rawCallback, x.xGetIter)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter, PathVar *TreePath) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar, PathVar)
 	}
@@ -361,7 +358,8 @@ func (x *TreeModelIface) OverrideGetPath(cb func(TreeModel, *TreeIter) *TreePath
 	if cb == nil {
 		x.xGetPath = 0
 	} else {
-		x.xGetPath = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) *TreePath {
+		x.xGetPath = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) *TreePath {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -374,7 +372,7 @@ func (x *TreeModelIface) GetGetPath() func(TreeModel, *TreeIter) *TreePath {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter) *TreePath
-	purego.RegisterFunc(&rawCallback, x.xGetPath)
+	core.RegisterFunc(&rawCallback, x.xGetPath)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) *TreePath {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -386,7 +384,8 @@ func (x *TreeModelIface) OverrideGetValue(cb func(TreeModel, *TreeIter, int, *go
 	if cb == nil {
 		x.xGetValue = 0
 	} else {
-		x.xGetValue = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ColumnVarp int, ValueVarp *gobject.Value) {
+		x.xGetValue = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ColumnVarp int, ValueVarp *gobject.Value) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp, ColumnVarp, ValueVarp)
 		})
 	}
@@ -399,7 +398,7 @@ func (x *TreeModelIface) GetGetValue() func(TreeModel, *TreeIter, int, *gobject.
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter, ColumnVarp int, ValueVarp *gobject.Value)
-	purego.RegisterFunc(&rawCallback, x.xGetValue)
+	core.RegisterFunc(&rawCallback, x.xGetValue)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
 		rawCallback(TreeModelVar.GoPointer(), IterVar, ColumnVar, ValueVar)
 	}
@@ -413,7 +412,8 @@ func (x *TreeModelIface) OverrideIterNext(cb func(TreeModel, *TreeIter) bool) {
 	if cb == nil {
 		x.xIterNext = 0
 	} else {
-		x.xIterNext = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+		x.xIterNext = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -428,7 +428,7 @@ func (x *TreeModelIface) GetIterNext() func(TreeModel, *TreeIter) bool {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xIterNext)
+	core.RegisterFunc(&rawCallback, x.xIterNext)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -442,7 +442,8 @@ func (x *TreeModelIface) OverrideIterPrevious(cb func(TreeModel, *TreeIter) bool
 	if cb == nil {
 		x.xIterPrevious = 0
 	} else {
-		x.xIterPrevious = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+		x.xIterPrevious = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -457,7 +458,7 @@ func (x *TreeModelIface) GetIterPrevious() func(TreeModel, *TreeIter) bool {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xIterPrevious)
+	core.RegisterFunc(&rawCallback, x.xIterPrevious)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -469,7 +470,8 @@ func (x *TreeModelIface) OverrideIterChildren(cb func(TreeModel, *TreeIter, *Tre
 	if cb == nil {
 		x.xIterChildren = 0
 	} else {
-		x.xIterChildren = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter) bool {
+		x.xIterChildren = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp, ParentVarp)
 		})
 	}
@@ -482,32 +484,33 @@ func (x *TreeModelIface) GetIterChildren() func(TreeModel, *TreeIter, *TreeIter)
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xIterChildren)
+	core.RegisterFunc(&rawCallback, x.xIterChildren)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter, ParentVar *TreeIter) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar, ParentVar)
 	}
 }
 
 // OverrideIterHasChild sets the "iter_has_child" callback function.
-// %TRUE if iter has children, %FALSE otherwise.
+// true if iter has children, false otherwise.
 func (x *TreeModelIface) OverrideIterHasChild(cb func(TreeModel, *TreeIter) bool) {
 	if cb == nil {
 		x.xIterHasChild = 0
 	} else {
-		x.xIterHasChild = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+		x.xIterHasChild = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
 }
 
 // GetIterHasChild gets the "iter_has_child" callback function.
-// %TRUE if iter has children, %FALSE otherwise.
+// true if iter has children, false otherwise.
 func (x *TreeModelIface) GetIterHasChild() func(TreeModel, *TreeIter) bool {
 	if x.xIterHasChild == 0 {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xIterHasChild)
+	core.RegisterFunc(&rawCallback, x.xIterHasChild)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -519,7 +522,8 @@ func (x *TreeModelIface) OverrideIterNChildren(cb func(TreeModel, *TreeIter) int
 	if cb == nil {
 		x.xIterNChildren = 0
 	} else {
-		x.xIterNChildren = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) int {
+		x.xIterNChildren = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) int {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -532,7 +536,7 @@ func (x *TreeModelIface) GetIterNChildren() func(TreeModel, *TreeIter) int {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter) int
-	purego.RegisterFunc(&rawCallback, x.xIterNChildren)
+	core.RegisterFunc(&rawCallback, x.xIterNChildren)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) int {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -546,7 +550,8 @@ func (x *TreeModelIface) OverrideIterNthChild(cb func(TreeModel, *TreeIter, *Tre
 	if cb == nil {
 		x.xIterNthChild = 0
 	} else {
-		x.xIterNthChild = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter, NVarp int) bool {
+		x.xIterNthChild = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter, NVarp int) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp, ParentVarp, NVarp)
 		})
 	}
@@ -561,7 +566,7 @@ func (x *TreeModelIface) GetIterNthChild() func(TreeModel, *TreeIter, *TreeIter,
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter, ParentVarp *TreeIter, NVarp int) bool
-	purego.RegisterFunc(&rawCallback, x.xIterNthChild)
+	core.RegisterFunc(&rawCallback, x.xIterNthChild)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter, ParentVar *TreeIter, NVar int) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar, ParentVar, NVar)
 	}
@@ -573,7 +578,8 @@ func (x *TreeModelIface) OverrideIterParent(cb func(TreeModel, *TreeIter, *TreeI
 	if cb == nil {
 		x.xIterParent = 0
 	} else {
-		x.xIterParent = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ChildVarp *TreeIter) bool {
+		x.xIterParent = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter, ChildVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp, ChildVarp)
 		})
 	}
@@ -586,7 +592,7 @@ func (x *TreeModelIface) GetIterParent() func(TreeModel, *TreeIter, *TreeIter) b
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter, ChildVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xIterParent)
+	core.RegisterFunc(&rawCallback, x.xIterParent)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter, ChildVar *TreeIter) bool {
 		return rawCallback(TreeModelVar.GoPointer(), IterVar, ChildVar)
 	}
@@ -598,7 +604,8 @@ func (x *TreeModelIface) OverrideRefNode(cb func(TreeModel, *TreeIter)) {
 	if cb == nil {
 		x.xRefNode = 0
 	} else {
-		x.xRefNode = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) {
+		x.xRefNode = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -611,7 +618,7 @@ func (x *TreeModelIface) GetRefNode() func(TreeModel, *TreeIter) {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter)
-	purego.RegisterFunc(&rawCallback, x.xRefNode)
+	core.RegisterFunc(&rawCallback, x.xRefNode)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) {
 		rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
@@ -623,7 +630,8 @@ func (x *TreeModelIface) OverrideUnrefNode(cb func(TreeModel, *TreeIter)) {
 	if cb == nil {
 		x.xUnrefNode = 0
 	} else {
-		x.xUnrefNode = purego.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) {
+		x.xUnrefNode = core.NewCallback(func(TreeModelVarp uintptr, IterVarp *TreeIter) {
+			defer core.RecoverPanic()
 			cb(&TreeModelBase{Ptr: TreeModelVarp}, IterVarp)
 		})
 	}
@@ -636,13 +644,15 @@ func (x *TreeModelIface) GetUnrefNode() func(TreeModel, *TreeIter) {
 		return nil
 	}
 	var rawCallback func(TreeModelVarp uintptr, IterVarp *TreeIter)
-	purego.RegisterFunc(&rawCallback, x.xUnrefNode)
+	core.RegisterFunc(&rawCallback, x.xUnrefNode)
 	return func(TreeModelVar TreeModel, IterVar *TreeIter) {
 		rawCallback(TreeModelVar.GoPointer(), IterVar)
 	}
 }
 
 // An opaque structure representing a path to a row in a model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 type TreePath struct {
 	_ structs.HostLayout
 }
@@ -657,221 +667,11 @@ func (x *TreePath) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xNewTreePath func() *TreePath
-
-// Creates a new `GtkTreePath`
-// This refers to a row.
-func NewTreePath() *TreePath {
-
-	cret := xNewTreePath()
-	return cret
-}
-
-var xNewTreePathFirst func() *TreePath
-
-// Creates a new `GtkTreePath`.
-//
-// The string representation of this path is “0”.
-func NewTreePathFirst() *TreePath {
-
-	cret := xNewTreePathFirst()
-	return cret
-}
-
-var xNewTreePathFromIndices func(int, ...interface{}) *TreePath
-
-// Creates a new path with @first_index and @varargs as indices.
-func NewTreePathFromIndices(FirstIndexVar int, varArgs ...interface{}) *TreePath {
-
-	cret := xNewTreePathFromIndices(FirstIndexVar, varArgs...)
-	return cret
-}
-
-var xNewTreePathFromIndicesv func([]int, uint) *TreePath
-
-// Creates a new path with the given @indices array of @length.
-func NewTreePathFromIndicesv(IndicesVar []int, LengthVar uint) *TreePath {
-
-	cret := xNewTreePathFromIndicesv(IndicesVar, LengthVar)
-	return cret
-}
-
-var xNewTreePathFromString func(string) *TreePath
-
-// Creates a new `GtkTreePath` initialized to @path.
-//
-// @path is expected to be a colon separated list of numbers.
-// For example, the string “10:4:0” would create a path of depth
-// 3 pointing to the 11th child of the root node, the 5th
-// child of that 11th child, and the 1st child of that 5th child.
-// If an invalid path string is passed in, %NULL is returned.
-func NewTreePathFromString(PathVar string) *TreePath {
-
-	cret := xNewTreePathFromString(PathVar)
-	return cret
-}
-
-var xTreePathAppendIndex func(uintptr, int)
-
-// Appends a new index to a path.
-//
-// As a result, the depth of the path is increased.
-func (x *TreePath) AppendIndex(IndexVar int) {
-
-	xTreePathAppendIndex(x.GoPointer(), IndexVar)
-
-}
-
-var xTreePathCompare func(uintptr, *TreePath) int
-
-// Compares two paths.
-//
-// If @a appears before @b in a tree, then -1 is returned.
-// If @b appears before @a, then 1 is returned.
-// If the two nodes are equal, then 0 is returned.
-func (x *TreePath) Compare(BVar *TreePath) int {
-
-	cret := xTreePathCompare(x.GoPointer(), BVar)
-	return cret
-}
-
-var xTreePathCopy func(uintptr) *TreePath
-
-// Creates a new `GtkTreePath` as a copy of @path.
-func (x *TreePath) Copy() *TreePath {
-
-	cret := xTreePathCopy(x.GoPointer())
-	return cret
-}
-
-var xTreePathDown func(uintptr)
-
-// Moves @path to point to the first child of the current path.
-func (x *TreePath) Down() {
-
-	xTreePathDown(x.GoPointer())
-
-}
-
-var xTreePathFree func(uintptr)
-
-// Frees @path. If @path is %NULL, it simply returns.
-func (x *TreePath) Free() {
-
-	xTreePathFree(x.GoPointer())
-
-}
-
-var xTreePathGetDepth func(uintptr) int
-
-// Returns the current depth of @path.
-func (x *TreePath) GetDepth() int {
-
-	cret := xTreePathGetDepth(x.GoPointer())
-	return cret
-}
-
-var xTreePathGetIndices func(uintptr) int
-
-// Returns the current indices of @path.
-//
-// This is an array of integers, each representing a node in a tree.
-// This value should not be freed.
-//
-// The length of the array can be obtained with gtk_tree_path_get_depth().
-func (x *TreePath) GetIndices() int {
-
-	cret := xTreePathGetIndices(x.GoPointer())
-	return cret
-}
-
-var xTreePathGetIndicesWithDepth func(uintptr, *int) uintptr
-
-// Returns the current indices of @path.
-//
-// This is an array of integers, each representing a node in a tree.
-// It also returns the number of elements in the array.
-// The array should not be freed.
-func (x *TreePath) GetIndicesWithDepth(DepthVar *int) uintptr {
-
-	cret := xTreePathGetIndicesWithDepth(x.GoPointer(), DepthVar)
-	return cret
-}
-
-var xTreePathIsAncestor func(uintptr, *TreePath) bool
-
-// Returns %TRUE if @descendant is a descendant of @path.
-func (x *TreePath) IsAncestor(DescendantVar *TreePath) bool {
-
-	cret := xTreePathIsAncestor(x.GoPointer(), DescendantVar)
-	return cret
-}
-
-var xTreePathIsDescendant func(uintptr, *TreePath) bool
-
-// Returns %TRUE if @path is a descendant of @ancestor.
-func (x *TreePath) IsDescendant(AncestorVar *TreePath) bool {
-
-	cret := xTreePathIsDescendant(x.GoPointer(), AncestorVar)
-	return cret
-}
-
-var xTreePathNext func(uintptr)
-
-// Moves the @path to point to the next node at the current depth.
-func (x *TreePath) Next() {
-
-	xTreePathNext(x.GoPointer())
-
-}
-
-var xTreePathPrependIndex func(uintptr, int)
-
-// Prepends a new index to a path.
-//
-// As a result, the depth of the path is increased.
-func (x *TreePath) PrependIndex(IndexVar int) {
-
-	xTreePathPrependIndex(x.GoPointer(), IndexVar)
-
-}
-
-var xTreePathPrev func(uintptr) bool
-
-// Moves the @path to point to the previous node at the
-// current depth, if it exists.
-func (x *TreePath) Prev() bool {
-
-	cret := xTreePathPrev(x.GoPointer())
-	return cret
-}
-
-var xTreePathToString func(uintptr) string
-
-// Generates a string representation of the path.
-//
-// This string is a “:” separated list of numbers.
-// For example, “4:10:0:3” would be an acceptable
-// return value for this string. If the path has
-// depth 0, %NULL is returned.
-func (x *TreePath) ToString() string {
-
-	cret := xTreePathToString(x.GoPointer())
-	return cret
-}
-
-var xTreePathUp func(uintptr) bool
-
-// Moves the @path to point to its parent node, if it has a parent.
-func (x *TreePath) Up() bool {
-
-	cret := xTreePathUp(x.GoPointer())
-	return cret
-}
-
 // A GtkTreeRowReference tracks model changes so that it always refers to the
 // same row (a `GtkTreePath` refers to a position, not a fixed row). Create a
-// new GtkTreeRowReference with gtk_tree_row_reference_new().
+// new GtkTreeRowReference with [NewTreeRowReference].
+//
+// Deprecated: since 4.10. Use [gio.ListModel] instead
 type TreeRowReference struct {
 	_ structs.HostLayout
 }
@@ -886,107 +686,6 @@ func (x *TreeRowReference) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-var xNewTreeRowReference func(uintptr, *TreePath) *TreeRowReference
-
-// Creates a row reference based on @path.
-//
-// This reference will keep pointing to the node pointed to
-// by @path, so long as it exists. Any changes that occur on @model are
-// propagated, and the path is updated appropriately. If
-// @path isn’t a valid path in @model, then %NULL is returned.
-func NewTreeRowReference(ModelVar TreeModel, PathVar *TreePath) *TreeRowReference {
-
-	cret := xNewTreeRowReference(ModelVar.GoPointer(), PathVar)
-	return cret
-}
-
-var xNewTreeRowReferenceProxy func(uintptr, uintptr, *TreePath) *TreeRowReference
-
-// You do not need to use this function.
-//
-// Creates a row reference based on @path.
-//
-// This reference will keep pointing to the node pointed to
-// by @path, so long as it exists. If @path isn’t a valid
-// path in @model, then %NULL is returned. However, unlike
-// references created with gtk_tree_row_reference_new(), it
-// does not listen to the model for changes. The creator of
-// the row reference must do this explicitly using
-// gtk_tree_row_reference_inserted(), gtk_tree_row_reference_deleted(),
-// gtk_tree_row_reference_reordered().
-//
-// These functions must be called exactly once per proxy when the
-// corresponding signal on the model is emitted. This single call
-// updates all row references for that proxy. Since built-in GTK
-// objects like `GtkTreeView` already use this mechanism internally,
-// using them as the proxy object will produce unpredictable results.
-// Further more, passing the same object as @model and @proxy
-// doesn’t work for reasons of internal implementation.
-//
-// This type of row reference is primarily meant by structures that
-// need to carefully monitor exactly when a row reference updates
-// itself, and is not generally needed by most applications.
-func NewTreeRowReferenceProxy(ProxyVar *gobject.Object, ModelVar TreeModel, PathVar *TreePath) *TreeRowReference {
-
-	cret := xNewTreeRowReferenceProxy(ProxyVar.GoPointer(), ModelVar.GoPointer(), PathVar)
-	return cret
-}
-
-var xTreeRowReferenceCopy func(uintptr) *TreeRowReference
-
-// Copies a `GtkTreeRowReference`.
-func (x *TreeRowReference) Copy() *TreeRowReference {
-
-	cret := xTreeRowReferenceCopy(x.GoPointer())
-	return cret
-}
-
-var xTreeRowReferenceFree func(uintptr)
-
-// Free’s @reference. @reference may be %NULL
-func (x *TreeRowReference) Free() {
-
-	xTreeRowReferenceFree(x.GoPointer())
-
-}
-
-var xTreeRowReferenceGetModel func(uintptr) uintptr
-
-// Returns the model that the row reference is monitoring.
-func (x *TreeRowReference) GetModel() *TreeModelBase {
-	var cls *TreeModelBase
-
-	cret := xTreeRowReferenceGetModel(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &TreeModelBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xTreeRowReferenceGetPath func(uintptr) *TreePath
-
-// Returns a path that the row reference currently points to,
-// or %NULL if the path pointed to is no longer valid.
-func (x *TreeRowReference) GetPath() *TreePath {
-
-	cret := xTreeRowReferenceGetPath(x.GoPointer())
-	return cret
-}
-
-var xTreeRowReferenceValid func(uintptr) bool
-
-// Returns %TRUE if the @reference is non-%NULL and refers to
-// a current valid path.
-func (x *TreeRowReference) Valid() bool {
-
-	cret := xTreeRowReferenceValid(x.GoPointer())
-	return cret
-}
-
 // The tree interface used by GtkTreeView
 //
 // The `GtkTreeModel` interface defines a generic tree interface for
@@ -999,7 +698,7 @@ func (x *TreeRowReference) Valid() bool {
 // columned data. In other words, the model can be seen as a tree where
 // every node has different values depending on which column is being
 // queried. The type of data found in a column is determined by using
-// the GType system (ie. %G_TYPE_INT, %GTK_TYPE_BUTTON, %G_TYPE_POINTER,
+// the GType system (ie. G_TYPE_INT, GTK_TYPE_BUTTON, G_TYPE_POINTER,
 // etc). The types are homogeneous per column across all nodes. It is
 // important to note that this interface only provides a way of examining
 // a model and observing changes. The implementation of each individual
@@ -1017,24 +716,24 @@ func (x *TreeRowReference) Valid() bool {
 // Models are accessed on a node/column level of granularity. One can
 // query for the value of a model at a certain node and a certain
 // column on that node. There are two structures used to reference a
-// particular node in a model. They are the [struct@Gtk.TreePath] and
-// the [struct@Gtk.TreeIter] (“iter” is short for iterator). Most of the
-// interface consists of operations on a [struct@Gtk.TreeIter].
+// particular node in a model. They are the [TreePath] and
+// the [TreeIter] (“iter” is short for iterator). Most of the
+// interface consists of operations on a [TreeIter].
 //
 // A path is essentially a potential node. It is a location on a model
 // that may or may not actually correspond to a node on a specific
-// model. A [struct@Gtk.TreePath] can be converted into either an
+// model. A [TreePath] can be converted into either an
 // array of unsigned integers or a string. The string form is a list
 // of numbers separated by a colon. Each number refers to the offset
 // at that level. Thus, the path `0` refers to the root
 // node and the path `2:4` refers to the fifth child of
 // the third node.
 //
-// By contrast, a [struct@Gtk.TreeIter] is a reference to a specific node on
+// By contrast, a [TreeIter] is a reference to a specific node on
 // a specific model. It is a generic struct with an integer and three
 // generic pointers. These are filled in by the model in a model-specific
 // way. One can convert a path to an iterator by calling
-// gtk_tree_model_get_iter(). These iterators are the primary way
+// [TreeModel.GetIter]. These iterators are the primary way
 // of accessing a model and are similar to the iterators used by
 // `GtkTextBuffer`. They are generally statically allocated on the
 // stack and only used for a short time. The model interface defines
@@ -1058,7 +757,7 @@ func (x *TreeRowReference) Valid() bool {
 // Although generally uninteresting, as one always has to allow for
 // the case where iterators do not persist beyond a signal, some very
 // important performance enhancements were made in the sort model.
-// As a result, the %GTK_TREE_MODEL_ITERS_PERSIST flag was added to
+// As a result, the [TreeModelItersPersistValue] flag was added to
 // indicate this behavior.
 //
 // To help show some common operation of a model, some examples are
@@ -1067,41 +766,32 @@ func (x *TreeRowReference) Valid() bool {
 // easier, the second is much more common, as you often get paths from
 // callbacks.
 //
-// ## Acquiring a `GtkTreeIter`
-//
-// ```c
-// // Three ways of getting the iter pointing to the location
-// GtkTreePath *path;
-// GtkTreeIter iter;
-// GtkTreeIter parent_iter;
-//
-// // get the iterator from a string
-// gtk_tree_model_get_iter_from_string (model,
-//
-//	&amp;iter,
-//	"3:2:5");
-//
-// // get the iterator from a path
-// path = gtk_tree_path_new_from_string ("3:2:5");
-// gtk_tree_model_get_iter (model, &amp;iter, path);
-// gtk_tree_path_free (path);
-//
-// // walk the tree to find the iterator
-// gtk_tree_model_iter_nth_child (model, &amp;iter,
-//
-//	NULL, 3);
-//
-// parent_iter = iter;
-// gtk_tree_model_iter_nth_child (model, &amp;iter,
-//
-//	&amp;parent_iter, 2);
-//
-// parent_iter = iter;
-// gtk_tree_model_iter_nth_child (model, &amp;iter,
-//
-//	&amp;parent_iter, 5);
-//
-// ```
+// # Acquiring a `GtkTreeIter`
+//
+//	// Three ways of getting the iter pointing to the location
+//	GtkTreePath *path;
+//	GtkTreeIter iter;
+//	GtkTreeIter parent_iter;
+//
+//	// get the iterator from a string
+//	gtk_tree_model_get_iter_from_string (model,
+//	                                     &iter,
+//	                                     "3:2:5");
+//
+//	// get the iterator from a path
+//	path = gtk_tree_path_new_from_string ("3:2:5");
+//	gtk_tree_model_get_iter (model, &iter, path);
+//	gtk_tree_path_free (path);
+//
+//	// walk the tree to find the iterator
+//	gtk_tree_model_iter_nth_child (model, &iter,
+//	                               NULL, 3);
+//	parent_iter = iter;
+//	gtk_tree_model_iter_nth_child (model, &iter,
+//	                               &parent_iter, 2);
+//	parent_iter = iter;
+//	gtk_tree_model_iter_nth_child (model, &iter,
+//	                               &parent_iter, 5);
 //
 // This second example shows a quick way of iterating through a list
 // and getting a string and an integer from each row. The
@@ -1109,66 +799,58 @@ func (x *TreeRowReference) Valid() bool {
 // shown, as it is specific to the `GtkListStore`. For information on
 // how to write such a function, see the `GtkListStore` documentation.
 //
-// ## Reading data from a `GtkTreeModel`
-//
-// ```c
-// enum
+// # Reading data from a `GtkTreeModel`
 //
+//	enum
 //	{
 //	  STRING_COLUMN,
 //	  INT_COLUMN,
 //	  N_COLUMNS
 //	};
 //
-// ...
+//	...
 //
-// GtkTreeModel *list_store;
-// GtkTreeIter iter;
-// gboolean valid;
-// int row_count = 0;
+//	GtkTreeModel *list_store;
+//	GtkTreeIter iter;
+//	gboolean valid;
+//	int row_count = 0;
 //
-// // make a new list_store
-// list_store = gtk_list_store_new (N_COLUMNS,
+//	// make a new list_store
+//	list_store = gtk_list_store_new (N_COLUMNS,
+//	                                 G_TYPE_STRING,
+//	                                 G_TYPE_INT);
 //
-//	G_TYPE_STRING,
-//	G_TYPE_INT);
+//	// Fill the list store with data
+//	populate_model (list_store);
 //
-// // Fill the list store with data
-// populate_model (list_store);
+//	// Get the first iter in the list, check it is valid and walk
+//	// through the list, reading each row.
 //
-// // Get the first iter in the list, check it is valid and walk
-// // through the list, reading each row.
+//	valid = gtk_tree_model_get_iter_first (list_store,
+//	                                       &iter);
+//	while (valid)
+//	 {
+//	   char *str_data;
+//	   int    int_data;
 //
-// valid = gtk_tree_model_get_iter_first (list_store,
+//	   // Make sure you terminate calls to gtk_tree_model_get() with a “-1” value
+//	   gtk_tree_model_get (list_store, &iter,
+//	                       STRING_COLUMN, &str_data,
+//	                       INT_COLUMN, &int_data,
+//	                       -1);
 //
-//	&amp;iter);
+//	   // Do something with the data
+//	   g_print ("Row %d: (%s,%d)\n",
+//	            row_count, str_data, int_data);
+//	   g_free (str_data);
 //
-// while (valid)
-//
-//	{
-//	  char *str_data;
-//	  int    int_data;
-//
-//	  // Make sure you terminate calls to gtk_tree_model_get() with a “-1” value
-//	  gtk_tree_model_get (list_store, &amp;iter,
-//	                      STRING_COLUMN, &amp;str_data,
-//	                      INT_COLUMN, &amp;int_data,
-//	                      -1);
-//
-//	  // Do something with the data
-//	  g_print ("Row %d: (%s,%d)\n",
-//	           row_count, str_data, int_data);
-//	  g_free (str_data);
-//
-//	  valid = gtk_tree_model_iter_next (list_store,
-//	                                    &amp;iter);
-//	  row_count++;
-//	}
-//
-// ```
+//	   valid = gtk_tree_model_iter_next (list_store,
+//	                                     &iter);
+//	   row_count++;
+//	 }
 //
 // The `GtkTreeModel` interface contains two methods for reference
-// counting: gtk_tree_model_ref_node() and gtk_tree_model_unref_node().
+// counting: [TreeModel.RefNode] and [TreeModel.UnrefNode].
 // These two methods are optional to implement. The reference counting
 // is meant as a way for views to let models know when nodes are being
 // displayed. `GtkTreeView` will take a reference on a node when it is
@@ -1196,6 +878,8 @@ func (x *TreeRowReference) Valid() bool {
 //     required for levels in which nodes are referenced. For the root level
 //     however, signals must be emitted at all times (however the root level
 //     is always referenced when any view is attached).
+//
+// Deprecated: since 4.10. Use [gio.ListModel] instead
 type TreeModel interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -1250,8 +934,187 @@ func (x *TreeModelBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Creates a new `GtkTreeModel`, with @child_model as the child_model
-// and @root as the virtual root.
+// ImplementTreeModel adds the TreeModel interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - RowChanged(*TreePath, *TreeIter)
+//   - RowInserted(*TreePath, *TreeIter)
+//   - RowHasChildToggled(*TreePath, *TreeIter)
+//   - RowDeleted(*TreePath)
+//   - RowsReordered(*TreePath, *TreeIter, int)
+//   - GetFlags() TreeModelFlags
+//   - GetNColumns() int
+//   - GetColumnType(int) types.GType
+//   - GetIter(*TreeIter, *TreePath) bool
+//   - GetPath(*TreeIter) *TreePath
+//   - GetValue(*TreeIter, int, *gobject.Value)
+//   - IterNext(*TreeIter) bool
+//   - IterPrevious(*TreeIter) bool
+//   - IterChildren(*TreeIter, *TreeIter) bool
+//   - IterHasChild(*TreeIter) bool
+//   - IterNChildren(*TreeIter) int
+//   - IterNthChild(*TreeIter, *TreeIter, int) bool
+//   - IterParent(*TreeIter, *TreeIter) bool
+//   - RefNode(*TreeIter)
+//   - UnrefNode(*TreeIter)
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TreeModelIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTreeModel[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TreeModelGLibType(), func(iface *TreeModelIface) {
+		if _, ok := any(zero).(interface{ RowChanged(*TreePath, *TreeIter) }); ok {
+			iface.OverrideRowChanged(func(self TreeModel, arg0 *TreePath, arg1 *TreeIter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RowChanged(*TreePath, *TreeIter) })
+				impl.RowChanged(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ RowInserted(*TreePath, *TreeIter) }); ok {
+			iface.OverrideRowInserted(func(self TreeModel, arg0 *TreePath, arg1 *TreeIter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RowInserted(*TreePath, *TreeIter) })
+				impl.RowInserted(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ RowHasChildToggled(*TreePath, *TreeIter) }); ok {
+			iface.OverrideRowHasChildToggled(func(self TreeModel, arg0 *TreePath, arg1 *TreeIter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RowHasChildToggled(*TreePath, *TreeIter) })
+				impl.RowHasChildToggled(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ RowDeleted(*TreePath) }); ok {
+			iface.OverrideRowDeleted(func(self TreeModel, arg0 *TreePath) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RowDeleted(*TreePath) })
+				impl.RowDeleted(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			RowsReordered(*TreePath, *TreeIter, int)
+		}); ok {
+			iface.OverrideRowsReordered(func(self TreeModel, arg0 *TreePath, arg1 *TreeIter, arg2 int) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					RowsReordered(*TreePath, *TreeIter, int)
+				})
+				impl.RowsReordered(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetFlags() TreeModelFlags }); ok {
+			iface.OverrideGetFlags(func(self TreeModel) TreeModelFlags {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetFlags() TreeModelFlags })
+				return impl.GetFlags()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetNColumns() int }); ok {
+			iface.OverrideGetNColumns(func(self TreeModel) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetNColumns() int })
+				return impl.GetNColumns()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetColumnType(int) types.GType }); ok {
+			iface.OverrideGetColumnType(func(self TreeModel, arg0 int) types.GType {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetColumnType(int) types.GType })
+				return impl.GetColumnType(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetIter(*TreeIter, *TreePath) bool
+		}); ok {
+			iface.OverrideGetIter(func(self TreeModel, arg0 *TreeIter, arg1 *TreePath) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetIter(*TreeIter, *TreePath) bool
+				})
+				return impl.GetIter(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ GetPath(*TreeIter) *TreePath }); ok {
+			iface.OverrideGetPath(func(self TreeModel, arg0 *TreeIter) *TreePath {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetPath(*TreeIter) *TreePath })
+				return impl.GetPath(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			GetValue(*TreeIter, int, *gobject.Value)
+		}); ok {
+			iface.OverrideGetValue(func(self TreeModel, arg0 *TreeIter, arg1 int, arg2 *gobject.Value) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					GetValue(*TreeIter, int, *gobject.Value)
+				})
+				impl.GetValue(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ IterNext(*TreeIter) bool }); ok {
+			iface.OverrideIterNext(func(self TreeModel, arg0 *TreeIter) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IterNext(*TreeIter) bool })
+				return impl.IterNext(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ IterPrevious(*TreeIter) bool }); ok {
+			iface.OverrideIterPrevious(func(self TreeModel, arg0 *TreeIter) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IterPrevious(*TreeIter) bool })
+				return impl.IterPrevious(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			IterChildren(*TreeIter, *TreeIter) bool
+		}); ok {
+			iface.OverrideIterChildren(func(self TreeModel, arg0 *TreeIter, arg1 *TreeIter) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					IterChildren(*TreeIter, *TreeIter) bool
+				})
+				return impl.IterChildren(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ IterHasChild(*TreeIter) bool }); ok {
+			iface.OverrideIterHasChild(func(self TreeModel, arg0 *TreeIter) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IterHasChild(*TreeIter) bool })
+				return impl.IterHasChild(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ IterNChildren(*TreeIter) int }); ok {
+			iface.OverrideIterNChildren(func(self TreeModel, arg0 *TreeIter) int {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ IterNChildren(*TreeIter) int })
+				return impl.IterNChildren(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			IterNthChild(*TreeIter, *TreeIter, int) bool
+		}); ok {
+			iface.OverrideIterNthChild(func(self TreeModel, arg0 *TreeIter, arg1 *TreeIter, arg2 int) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					IterNthChild(*TreeIter, *TreeIter, int) bool
+				})
+				return impl.IterNthChild(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			IterParent(*TreeIter, *TreeIter) bool
+		}); ok {
+			iface.OverrideIterParent(func(self TreeModel, arg0 *TreeIter, arg1 *TreeIter) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					IterParent(*TreeIter, *TreeIter) bool
+				})
+				return impl.IterParent(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ RefNode(*TreeIter) }); ok {
+			iface.OverrideRefNode(func(self TreeModel, arg0 *TreeIter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ RefNode(*TreeIter) })
+				impl.RefNode(arg0)
+			})
+		}
+		if _, ok := any(zero).(interface{ UnrefNode(*TreeIter) }); ok {
+			iface.OverrideUnrefNode(func(self TreeModel, arg0 *TreeIter) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ UnrefNode(*TreeIter) })
+				impl.UnrefNode(arg0)
+			})
+		}
+	})
+}
+
+// Creates a new `GtkTreeModel`, with child_model as the child_model
+// and root as the virtual root.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) FilterNew(RootVar *TreePath) *TreeModelBase {
 	var cls *TreeModelBase
 
@@ -1265,29 +1128,49 @@ func (x *TreeModelBase) FilterNew(RootVar *TreePath) *TreeModelBase {
 	return cls
 }
 
-// Calls @func on each node in model in a depth-first fashion.
+// Calls func on each node in model in a depth-first fashion.
+//
+// If func returns true, then the tree ceases to be walked,
+// and [TreeModel.Foreach] returns.
 //
-// If @func returns %TRUE, then the tree ceases to be walked,
-// and gtk_tree_model_foreach() returns.
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) Foreach(FuncVar *TreeModelForeachFunc, UserDataVar uintptr) {
 
-	XGtkTreeModelForeach(x.GoPointer(), glib.NewCallback(FuncVar), UserDataVar)
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreePath, arg2 *TreeIter, arg3 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2, arg3)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	XGtkTreeModelForeach(x.GoPointer(), FuncVarRef, UserDataVar)
 
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
 // The variable argument list should contain integer column numbers,
 // each column number followed by a place to store the value being
 // retrieved.  The list is terminated by a -1. For example, to get a
-// value from column 0 with type %G_TYPE_STRING, you would
-// write: `gtk_tree_model_get (model, iter, 0, &amp;place_string_here, -1)`,
+// value from column 0 with type G_TYPE_STRING, you would
+// write: `gtk_tree_model_get (model, iter, 0, &place_string_here, -1)`,
 // where `place_string_here` is a #gchararray
 // to be filled with the string.
 //
-// Returned values with type %G_TYPE_OBJECT have to be unreferenced,
-// values with type %G_TYPE_STRING or %G_TYPE_BOXED have to be freed.
+// Returned values with type G_TYPE_OBJECT have to be unreferenced,
+// values with type G_TYPE_STRING or G_TYPE_BOXED have to be freed.
 // Other values are passed by value.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) Get(IterVar *TreeIter, varArgs ...interface{}) {
 
 	XGtkTreeModelGet(x.GoPointer(), IterVar, varArgs...)
@@ -1295,6 +1178,8 @@ func (x *TreeModelBase) Get(IterVar *TreeIter, varArgs ...interface{}) {
 }
 
 // Returns the type of the column.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetColumnType(IndexVar int) types.GType {
 
 	cret := XGtkTreeModelGetColumnType(x.GoPointer(), IndexVar)
@@ -1305,53 +1190,65 @@ func (x *TreeModelBase) GetColumnType(IndexVar int) types.GType {
 //
 // The flags are a bitwise combination of `GtkTreeModel`Flags.
 // The flags supported should not change during the lifetime
-// of the @tree_model.
+// of the tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetFlags() TreeModelFlags {
 
 	cret := XGtkTreeModelGetFlags(x.GoPointer())
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path.
+// Sets iter to a valid iterator pointing to path.
 //
-// If @path does not exist, @iter is set to an invalid
-// iterator and %FALSE is returned.
+// If path does not exist, iter is set to an invalid
+// iterator and false is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetIter(IterVar *TreeIter, PathVar *TreePath) bool {
 
 	cret := XGtkTreeModelGetIter(x.GoPointer(), IterVar, PathVar)
 	return cret
 }
 
-// Initializes @iter with the first iterator in the tree
+// Initializes iter with the first iterator in the tree
 // (the one at the path "0").
 //
-// Returns %FALSE if the tree is empty, %TRUE otherwise.
+// Returns false if the tree is empty, true otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetIterFirst(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelGetIterFirst(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path_string, if it
+// Sets iter to a valid iterator pointing to path_string, if it
 // exists.
 //
-// Otherwise, @iter is left invalid and %FALSE is returned.
+// Otherwise, iter is left invalid and false is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetIterFromString(IterVar *TreeIter, PathStringVar string) bool {
 
 	cret := XGtkTreeModelGetIterFromString(x.GoPointer(), IterVar, PathStringVar)
 	return cret
 }
 
-// Returns the number of columns supported by @tree_model.
+// Returns the number of columns supported by tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetNColumns() int {
 
 	cret := XGtkTreeModelGetNColumns(x.GoPointer())
 	return cret
 }
 
-// Returns a newly-created `GtkTreePath` referenced by @iter.
+// Returns a newly-created `GtkTreePath` referenced by iter.
 //
-// This path should be freed with gtk_tree_path_free().
+// This path should be freed with [TreePath.Free].
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetPath(IterVar *TreeIter) *TreePath {
 
 	cret := XGtkTreeModelGetPath(x.GoPointer(), IterVar)
@@ -1363,105 +1260,125 @@ func (x *TreeModelBase) GetPath(IterVar *TreeIter) *TreePath {
 // This string is a “:” separated list of numbers.
 // For example, “4:10:0:3” would be an acceptable
 // return value for this string.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetStringFromIter(IterVar *TreeIter) string {
 
 	cret := XGtkTreeModelGetStringFromIter(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
-// See [method@Gtk.TreeModel.get], this version takes a va_list
+// See [TreeModel.Get], this version takes a va_list
 // for language bindings to use.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
 
 	XGtkTreeModelGetValist(x.GoPointer(), IterVar, VarArgsVar)
 
 }
 
-// Initializes and sets @value to that at @column.
+// Initializes and sets value to that at column.
 //
-// When done with @value, g_value_unset() needs to be called
+// When done with value, [gobject.Value.Unset] needs to be called
 // to free any allocated memory.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) GetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
 
 	XGtkTreeModelGetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
 
 }
 
-// Sets @iter to point to the first child of @parent.
+// Sets iter to point to the first child of parent.
 //
-// If @parent has no children, %FALSE is returned and @iter is
-// set to be invalid. @parent will remain a valid node after this
+// If parent has no children, false is returned and iter is
+// set to be invalid. parent will remain a valid node after this
 // function has been called.
 //
-// If @parent is %NULL returns the first node, equivalent to
+// If parent is nil returns the first node, equivalent to
 // `gtk_tree_model_get_iter_first (tree_model, iter);`
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterChildren(IterVar *TreeIter, ParentVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterChildren(x.GoPointer(), IterVar, ParentVar)
 	return cret
 }
 
-// Returns %TRUE if @iter has children, %FALSE otherwise.
+// Returns true if iter has children, false otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterHasChild(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterHasChild(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Returns the number of children that @iter has.
+// Returns the number of children that iter has.
 //
-// As a special case, if @iter is %NULL, then the number
+// As a special case, if iter is nil, then the number
 // of toplevel nodes is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterNChildren(IterVar *TreeIter) int {
 
 	cret := XGtkTreeModelIterNChildren(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to point to the node following it at the current level.
+// Sets iter to point to the node following it at the current level.
 //
-// If there is no next @iter, %FALSE is returned and @iter is set
+// If there is no next iter, false is returned and iter is set
 // to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterNext(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterNext(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to be the child of @parent, using the given index.
+// Sets iter to be the child of parent, using the given index.
 //
-// The first index is 0. If @n is too big, or @parent has no children,
-// @iter is set to an invalid iterator and %FALSE is returned. @parent
+// The first index is 0. If n is too big, or parent has no children,
+// iter is set to an invalid iterator and false is returned. parent
 // will remain a valid node after this function has been called. As a
-// special case, if @parent is %NULL, then the @n-th root node
+// special case, if parent is nil, then the n-th root node
 // is set.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterNthChild(IterVar *TreeIter, ParentVar *TreeIter, NVar int) bool {
 
 	cret := XGtkTreeModelIterNthChild(x.GoPointer(), IterVar, ParentVar, NVar)
 	return cret
 }
 
-// Sets @iter to be the parent of @child.
+// Sets iter to be the parent of child.
 //
-// If @child is at the toplevel, and doesn’t have a parent, then
-// @iter is set to an invalid iterator and %FALSE is returned.
-// @child will remain a valid node after this function has been
+// If child is at the toplevel, and doesn’t have a parent, then
+// iter is set to an invalid iterator and false is returned.
+// child will remain a valid node after this function has been
 // called.
 //
-// @iter will be initialized before the lookup is performed, so @child
-// and @iter cannot point to the same memory location.
+// iter will be initialized before the lookup is performed, so child
+// and iter cannot point to the same memory location.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterParent(IterVar *TreeIter, ChildVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterParent(x.GoPointer(), IterVar, ChildVar)
 	return cret
 }
 
-// Sets @iter to point to the previous node at the current level.
+// Sets iter to point to the previous node at the current level.
 //
-// If there is no previous @iter, %FALSE is returned and @iter is
+// If there is no previous iter, false is returned and iter is
 // set to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) IterPrevious(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterPrevious(x.GoPointer(), IterVar)
@@ -1485,76 +1402,92 @@ func (x *TreeModelBase) IterPrevious(IterVar *TreeIter) bool {
 //
 // A model should be expected to be able to get an iter independent
 // of its reffed state.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelRefNode(x.GoPointer(), IterVar)
 
 }
 
-// Emits the ::row-changed signal on @tree_model.
+// Emits the ::row-changed signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-changed].
+// See TreeModel::row-changed.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RowChanged(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowChanged(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-deleted signal on @tree_model.
+// Emits the ::row-deleted signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-deleted].
+// See TreeModel::row-deleted.
 //
 // This should be called by models after a row has been removed.
-// The location pointed to by @path should be the location that
+// The location pointed to by path should be the location that
 // the row previously was at. It may not be a valid location anymore.
 //
 // Nodes that are deleted are not unreffed, this means that any
 // outstanding references on the deleted node should not be released.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RowDeleted(PathVar *TreePath) {
 
 	XGtkTreeModelRowDeleted(x.GoPointer(), PathVar)
 
 }
 
-// Emits the ::row-has-child-toggled signal on @tree_model.
+// Emits the ::row-has-child-toggled signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-has-child-toggled].
+// See TreeModel::row-has-child-toggled.
 //
 // This should be called by models after the child
 // state of a node changes.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RowHasChildToggled(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowHasChildToggled(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-inserted signal on @tree_model.
+// Emits the ::row-inserted signal on tree_model.
+//
+// See TreeModel::row-inserted.
 //
-// See [signal@Gtk.TreeModel::row-inserted].
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RowInserted(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowInserted(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// See [RowsReorderedWithLength] for the version of this function that language bindings are meant to use.
 func (x *TreeModelBase) RowsReordered(PathVar *TreePath, IterVar *TreeIter, NewOrderVar int) {
 
 	XGtkTreeModelRowsReordered(x.GoPointer(), PathVar, IterVar, NewOrderVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) RowsReorderedWithLength(PathVar *TreePath, IterVar *TreeIter, NewOrderVar []int, LengthVar int) {
 
 	XGtkTreeModelRowsReorderedWithLength(x.GoPointer(), PathVar, IterVar, NewOrderVar, LengthVar)
@@ -1566,9 +1499,11 @@ func (x *TreeModelBase) RowsReorderedWithLength(PathVar *TreePath, IterVar *Tree
 // This is an optional method for models to implement.
 // To be more specific, models may ignore this call as it exists
 // primarily for performance reasons. For more information on what
-// this means, see gtk_tree_model_ref_node().
+// this means, see [TreeModel.RefNode].
 //
 // Please note that nodes that are deleted are not unreffed.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelBase) UnrefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelUnrefNode(x.GoPointer(), IterVar)
@@ -1606,11 +1541,13 @@ var XGtkTreeModelUnrefNode func(uintptr, *TreeIter)
 
 // These flags indicate various properties of a `GtkTreeModel`.
 //
-// They are returned by [method@Gtk.TreeModel.get_flags], and must be
+// They are returned by [TreeModel.GetFlags], and must be
 // static for the lifetime of the object. A more complete description
-// of %GTK_TREE_MODEL_ITERS_PERSIST can be found in the overview of
+// of [TreeModelItersPersistValue] can be found in the overview of
 // this section.
-type TreeModelFlags int
+//
+// Deprecated: since 4.10. There is no replacement
+type TreeModelFlags uint32
 
 var xTreeModelFlagsGLibType func() types.GType
 
@@ -1628,37 +1565,45 @@ const (
 	TreeModelListOnlyValue TreeModelFlags = 2
 )
 
-var xTreeRowReferenceDeleted func(uintptr, *TreePath)
-
-// Lets a set of row reference created by
-// gtk_tree_row_reference_new_proxy() know that the
-// model emitted the ::row-deleted signal.
-func TreeRowReferenceDeleted(ProxyVar *gobject.Object, PathVar *TreePath) {
-
-	xTreeRowReferenceDeleted(ProxyVar.GoPointer(), PathVar)
-
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (TreeModelFlags) GLibType() types.GType {
+	return xTreeModelFlagsGLibType()
 }
 
-var xTreeRowReferenceInserted func(uintptr, *TreePath)
-
-// Lets a set of row reference created by
-// gtk_tree_row_reference_new_proxy() know that the
-// model emitted the ::row-inserted signal.
-func TreeRowReferenceInserted(ProxyVar *gobject.Object, PathVar *TreePath) {
-
-	xTreeRowReferenceInserted(ProxyVar.GoPointer(), PathVar)
-
+// Has reports whether all bits of flags are set in x
+func (x TreeModelFlags) Has(flags TreeModelFlags) bool {
+	return x&flags == flags
 }
 
-var xTreeRowReferenceReordered func(uintptr, *TreePath, *TreeIter, []int)
-
-// Lets a set of row reference created by
-// gtk_tree_row_reference_new_proxy() know that the
-// model emitted the ::rows-reordered signal.
-func TreeRowReferenceReordered(ProxyVar *gobject.Object, PathVar *TreePath, IterVar *TreeIter, NewOrderVar []int) {
+// Set returns x with the bits of flags set
+func (x TreeModelFlags) Set(flags TreeModelFlags) TreeModelFlags {
+	return x | flags
+}
 
-	xTreeRowReferenceReordered(ProxyVar.GoPointer(), PathVar, IterVar, NewOrderVar)
+// Clear returns x with the bits of flags cleared
+func (x TreeModelFlags) Clear(flags TreeModelFlags) TreeModelFlags {
+	return x &^ flags
+}
 
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x TreeModelFlags) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&TreeModelItersPersistValue != 0 && x&TreeModelItersPersistValue == TreeModelItersPersistValue {
+		names = append(names, "GTK_TREE_MODEL_ITERS_PERSIST")
+		rest &^= TreeModelItersPersistValue
+	}
+	if rest&TreeModelListOnlyValue != 0 && x&TreeModelListOnlyValue == TreeModelListOnlyValue {
+		names = append(names, "GTK_TREE_MODEL_LIST_ONLY")
+		rest &^= TreeModelListOnlyValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
 }
 
 func init() {
@@ -1666,7 +1611,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1675,79 +1620,43 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeModelFlagsGLibType, libs, "gtk_tree_model_flags_get_type")
 
-	core.PuregoSafeRegister(&xTreeRowReferenceDeleted, libs, "gtk_tree_row_reference_deleted")
-	core.PuregoSafeRegister(&xTreeRowReferenceInserted, libs, "gtk_tree_row_reference_inserted")
-	core.PuregoSafeRegister(&xTreeRowReferenceReordered, libs, "gtk_tree_row_reference_reordered")
-
 	core.PuregoSafeRegister(&xTreeIterGLibType, libs, "gtk_tree_iter_get_type")
 
-	core.PuregoSafeRegister(&xTreeIterCopy, libs, "gtk_tree_iter_copy")
-	core.PuregoSafeRegister(&xTreeIterFree, libs, "gtk_tree_iter_free")
-
 	core.PuregoSafeRegister(&xTreePathGLibType, libs, "gtk_tree_path_get_type")
 
-	core.PuregoSafeRegister(&xNewTreePath, libs, "gtk_tree_path_new")
-	core.PuregoSafeRegister(&xNewTreePathFirst, libs, "gtk_tree_path_new_first")
-	core.PuregoSafeRegister(&xNewTreePathFromIndices, libs, "gtk_tree_path_new_from_indices")
-	core.PuregoSafeRegister(&xNewTreePathFromIndicesv, libs, "gtk_tree_path_new_from_indicesv")
-	core.PuregoSafeRegister(&xNewTreePathFromString, libs, "gtk_tree_path_new_from_string")
-
-	core.PuregoSafeRegister(&xTreePathAppendIndex, libs, "gtk_tree_path_append_index")
-	core.PuregoSafeRegister(&xTreePathCompare, libs, "gtk_tree_path_compare")
-	core.PuregoSafeRegister(&xTreePathCopy, libs, "gtk_tree_path_copy")
-	core.PuregoSafeRegister(&xTreePathDown, libs, "gtk_tree_path_down")
-	core.PuregoSafeRegister(&xTreePathFree, libs, "gtk_tree_path_free")
-	core.PuregoSafeRegister(&xTreePathGetDepth, libs, "gtk_tree_path_get_depth")
-	core.PuregoSafeRegister(&xTreePathGetIndices, libs, "gtk_tree_path_get_indices")
-	core.PuregoSafeRegister(&xTreePathGetIndicesWithDepth, libs, "gtk_tree_path_get_indices_with_depth")
-	core.PuregoSafeRegister(&xTreePathIsAncestor, libs, "gtk_tree_path_is_ancestor")
-	core.PuregoSafeRegister(&xTreePathIsDescendant, libs, "gtk_tree_path_is_descendant")
-	core.PuregoSafeRegister(&xTreePathNext, libs, "gtk_tree_path_next")
-	core.PuregoSafeRegister(&xTreePathPrependIndex, libs, "gtk_tree_path_prepend_index")
-	core.PuregoSafeRegister(&xTreePathPrev, libs, "gtk_tree_path_prev")
-	core.PuregoSafeRegister(&xTreePathToString, libs, "gtk_tree_path_to_string")
-	core.PuregoSafeRegister(&xTreePathUp, libs, "gtk_tree_path_up")
-
 	core.PuregoSafeRegister(&xTreeRowReferenceGLibType, libs, "gtk_tree_row_reference_get_type")
 
-	core.PuregoSafeRegister(&xNewTreeRowReference, libs, "gtk_tree_row_reference_new")
-	core.PuregoSafeRegister(&xNewTreeRowReferenceProxy, libs, "gtk_tree_row_reference_new_proxy")
-
-	core.PuregoSafeRegister(&xTreeRowReferenceCopy, libs, "gtk_tree_row_reference_copy")
-	core.PuregoSafeRegister(&xTreeRowReferenceFree, libs, "gtk_tree_row_reference_free")
-	core.PuregoSafeRegister(&xTreeRowReferenceGetModel, libs, "gtk_tree_row_reference_get_model")
-	core.PuregoSafeRegister(&xTreeRowReferenceGetPath, libs, "gtk_tree_row_reference_get_path")
-	core.PuregoSafeRegister(&xTreeRowReferenceValid, libs, "gtk_tree_row_reference_valid")
-
 	core.PuregoSafeRegister(&xTreeModelGLibType, libs, "gtk_tree_model_get_type")
 
-	core.PuregoSafeRegister(&XGtkTreeModelFilterNew, libs, "gtk_tree_model_filter_new")
-	core.PuregoSafeRegister(&XGtkTreeModelForeach, libs, "gtk_tree_model_foreach")
-	core.PuregoSafeRegister(&XGtkTreeModelGet, libs, "gtk_tree_model_get")
-	core.PuregoSafeRegister(&XGtkTreeModelGetColumnType, libs, "gtk_tree_model_get_column_type")
-	core.PuregoSafeRegister(&XGtkTreeModelGetFlags, libs, "gtk_tree_model_get_flags")
-	core.PuregoSafeRegister(&XGtkTreeModelGetIter, libs, "gtk_tree_model_get_iter")
-	core.PuregoSafeRegister(&XGtkTreeModelGetIterFirst, libs, "gtk_tree_model_get_iter_first")
-	core.PuregoSafeRegister(&XGtkTreeModelGetIterFromString, libs, "gtk_tree_model_get_iter_from_string")
-	core.PuregoSafeRegister(&XGtkTreeModelGetNColumns, libs, "gtk_tree_model_get_n_columns")
-	core.PuregoSafeRegister(&XGtkTreeModelGetPath, libs, "gtk_tree_model_get_path")
-	core.PuregoSafeRegister(&XGtkTreeModelGetStringFromIter, libs, "gtk_tree_model_get_string_from_iter")
-	core.PuregoSafeRegister(&XGtkTreeModelGetValist, libs, "gtk_tree_model_get_valist")
-	core.PuregoSafeRegister(&XGtkTreeModelGetValue, libs, "gtk_tree_model_get_value")
-	core.PuregoSafeRegister(&XGtkTreeModelIterChildren, libs, "gtk_tree_model_iter_children")
-	core.PuregoSafeRegister(&XGtkTreeModelIterHasChild, libs, "gtk_tree_model_iter_has_child")
-	core.PuregoSafeRegister(&XGtkTreeModelIterNChildren, libs, "gtk_tree_model_iter_n_children")
-	core.PuregoSafeRegister(&XGtkTreeModelIterNext, libs, "gtk_tree_model_iter_next")
-	core.PuregoSafeRegister(&XGtkTreeModelIterNthChild, libs, "gtk_tree_model_iter_nth_child")
-	core.PuregoSafeRegister(&XGtkTreeModelIterParent, libs, "gtk_tree_model_iter_parent")
-	core.PuregoSafeRegister(&XGtkTreeModelIterPrevious, libs, "gtk_tree_model_iter_previous")
-	core.PuregoSafeRegister(&XGtkTreeModelRefNode, libs, "gtk_tree_model_ref_node")
-	core.PuregoSafeRegister(&XGtkTreeModelRowChanged, libs, "gtk_tree_model_row_changed")
-	core.PuregoSafeRegister(&XGtkTreeModelRowDeleted, libs, "gtk_tree_model_row_deleted")
-	core.PuregoSafeRegister(&XGtkTreeModelRowHasChildToggled, libs, "gtk_tree_model_row_has_child_toggled")
-	core.PuregoSafeRegister(&XGtkTreeModelRowInserted, libs, "gtk_tree_model_row_inserted")
-	core.PuregoSafeRegister(&XGtkTreeModelRowsReordered, libs, "gtk_tree_model_rows_reordered")
-	core.PuregoSafeRegister(&XGtkTreeModelRowsReorderedWithLength, libs, "gtk_tree_model_rows_reordered_with_length")
-	core.PuregoSafeRegister(&XGtkTreeModelUnrefNode, libs, "gtk_tree_model_unref_node")
+	gobject.CheckClassSize("GtkTreeModelIface", xTreeModelGLibType, unsafe.Sizeof(TreeModelIface{}))
+
+	core.PuregoRegisterSince(&XGtkTreeModelFilterNew, libs, "gtk_tree_model_filter_new", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelForeach, libs, "gtk_tree_model_foreach", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGet, libs, "gtk_tree_model_get", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetColumnType, libs, "gtk_tree_model_get_column_type", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetFlags, libs, "gtk_tree_model_get_flags", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetIter, libs, "gtk_tree_model_get_iter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetIterFirst, libs, "gtk_tree_model_get_iter_first", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetIterFromString, libs, "gtk_tree_model_get_iter_from_string", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetNColumns, libs, "gtk_tree_model_get_n_columns", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetPath, libs, "gtk_tree_model_get_path", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetStringFromIter, libs, "gtk_tree_model_get_string_from_iter", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetValist, libs, "gtk_tree_model_get_valist", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelGetValue, libs, "gtk_tree_model_get_value", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterChildren, libs, "gtk_tree_model_iter_children", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterHasChild, libs, "gtk_tree_model_iter_has_child", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterNChildren, libs, "gtk_tree_model_iter_n_children", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterNext, libs, "gtk_tree_model_iter_next", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterNthChild, libs, "gtk_tree_model_iter_nth_child", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterParent, libs, "gtk_tree_model_iter_parent", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelIterPrevious, libs, "gtk_tree_model_iter_previous", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRefNode, libs, "gtk_tree_model_ref_node", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowChanged, libs, "gtk_tree_model_row_changed", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowDeleted, libs, "gtk_tree_model_row_deleted", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowHasChildToggled, libs, "gtk_tree_model_row_has_child_toggled", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowInserted, libs, "gtk_tree_model_row_inserted", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowsReordered, libs, "gtk_tree_model_rows_reordered", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelRowsReorderedWithLength, libs, "gtk_tree_model_rows_reordered_with_length", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeModelUnrefNode, libs, "gtk_tree_model_unref_node", "GTK", "")
 
 }
@@ -0,0 +1,500 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xTreeIterCopy func(uintptr) *TreeIter
+
+// Creates a dynamically allocated tree iterator as a copy of iter.
+//
+// This function is not intended for use in applications,
+// because you can just copy the structs by value
+// (`GtkTreeIter new_iter = iter;`).
+// You must free this iter with [TreeIter.Free].
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeIter) Copy() *TreeIter {
+
+	cret := xTreeIterCopy(x.GoPointer())
+	return cret
+}
+
+var xTreeIterFree func(uintptr)
+
+// Frees an iterator that has been allocated by [TreeIter.Copy].
+//
+// This function is mainly used for language bindings.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeIter) Free() {
+
+	xTreeIterFree(x.GoPointer())
+
+}
+
+var xNewTreePath func() *TreePath
+
+// Creates a new `GtkTreePath`
+// This refers to a row.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreePath() *TreePath {
+
+	cret := xNewTreePath()
+	return cret
+}
+
+var xNewTreePathFirst func() *TreePath
+
+// Creates a new `GtkTreePath`.
+//
+// The string representation of this path is “0”.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreePathFirst() *TreePath {
+
+	cret := xNewTreePathFirst()
+	return cret
+}
+
+var xNewTreePathFromIndices func(int, ...interface{}) *TreePath
+
+// Creates a new path with first_index and varargs as indices.
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// See [NewTreePathFromIndicesv] for the version of this function that language bindings are meant to use.
+func NewTreePathFromIndices(FirstIndexVar int, varArgs ...interface{}) *TreePath {
+
+	cret := xNewTreePathFromIndices(FirstIndexVar, varArgs...)
+	return cret
+}
+
+var xNewTreePathFromIndicesv func([]int, uint) *TreePath
+
+// Creates a new path with the given indices array of length.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreePathFromIndicesv(IndicesVar []int, LengthVar uint) *TreePath {
+
+	cret := xNewTreePathFromIndicesv(IndicesVar, LengthVar)
+	return cret
+}
+
+var xNewTreePathFromString func(string) *TreePath
+
+// Creates a new `GtkTreePath` initialized to path.
+//
+// path is expected to be a colon separated list of numbers.
+// For example, the string “10:4:0” would create a path of depth
+// 3 pointing to the 11th child of the root node, the 5th
+// child of that 11th child, and the 1st child of that 5th child.
+// If an invalid path string is passed in, nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreePathFromString(PathVar string) *TreePath {
+
+	cret := xNewTreePathFromString(PathVar)
+	return cret
+}
+
+var xTreePathAppendIndex func(uintptr, int)
+
+// Appends a new index to a path.
+//
+// As a result, the depth of the path is increased.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) AppendIndex(IndexVar int) {
+
+	xTreePathAppendIndex(x.GoPointer(), IndexVar)
+
+}
+
+var xTreePathCompare func(uintptr, *TreePath) int
+
+// Compares two paths.
+//
+// If a appears before b in a tree, then -1 is returned.
+// If b appears before a, then 1 is returned.
+// If the two nodes are equal, then 0 is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Compare(BVar *TreePath) int {
+
+	cret := xTreePathCompare(x.GoPointer(), BVar)
+	return cret
+}
+
+var xTreePathCopy func(uintptr) *TreePath
+
+// Creates a new `GtkTreePath` as a copy of path.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Copy() *TreePath {
+
+	cret := xTreePathCopy(x.GoPointer())
+	return cret
+}
+
+var xTreePathDown func(uintptr)
+
+// Moves path to point to the first child of the current path.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Down() {
+
+	xTreePathDown(x.GoPointer())
+
+}
+
+var xTreePathFree func(uintptr)
+
+// Frees path. If path is nil, it simply returns.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Free() {
+
+	xTreePathFree(x.GoPointer())
+
+}
+
+var xTreePathGetDepth func(uintptr) int
+
+// Returns the current depth of path.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) GetDepth() int {
+
+	cret := xTreePathGetDepth(x.GoPointer())
+	return cret
+}
+
+var xTreePathGetIndices func(uintptr) int
+
+// Returns the current indices of path.
+//
+// This is an array of integers, each representing a node in a tree.
+// This value should not be freed.
+//
+// The length of the array can be obtained with [TreePath.GetDepth].
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// See [GetIndicesWithDepth] for the version of this function that language bindings are meant to use.
+func (x *TreePath) GetIndices() int {
+
+	cret := xTreePathGetIndices(x.GoPointer())
+	return cret
+}
+
+var xTreePathGetIndicesWithDepth func(uintptr, *int) uintptr
+
+// Returns the current indices of path.
+//
+// This is an array of integers, each representing a node in a tree.
+// It also returns the number of elements in the array.
+// The array should not be freed.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) GetIndicesWithDepth(DepthVar *int) uintptr {
+
+	cret := xTreePathGetIndicesWithDepth(x.GoPointer(), DepthVar)
+	return cret
+}
+
+var xTreePathIsAncestor func(uintptr, *TreePath) bool
+
+// Returns true if descendant is a descendant of path.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) IsAncestor(DescendantVar *TreePath) bool {
+
+	cret := xTreePathIsAncestor(x.GoPointer(), DescendantVar)
+	return cret
+}
+
+var xTreePathIsDescendant func(uintptr, *TreePath) bool
+
+// Returns true if path is a descendant of ancestor.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) IsDescendant(AncestorVar *TreePath) bool {
+
+	cret := xTreePathIsDescendant(x.GoPointer(), AncestorVar)
+	return cret
+}
+
+var xTreePathNext func(uintptr)
+
+// Moves the path to point to the next node at the current depth.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Next() {
+
+	xTreePathNext(x.GoPointer())
+
+}
+
+var xTreePathPrependIndex func(uintptr, int)
+
+// Prepends a new index to a path.
+//
+// As a result, the depth of the path is increased.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) PrependIndex(IndexVar int) {
+
+	xTreePathPrependIndex(x.GoPointer(), IndexVar)
+
+}
+
+var xTreePathPrev func(uintptr) bool
+
+// Moves the path to point to the previous node at the
+// current depth, if it exists.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Prev() bool {
+
+	cret := xTreePathPrev(x.GoPointer())
+	return cret
+}
+
+var xTreePathToString func(uintptr) string
+
+// Generates a string representation of the path.
+//
+// This string is a “:” separated list of numbers.
+// For example, “4:10:0:3” would be an acceptable
+// return value for this string. If the path has
+// depth 0, nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) ToString() string {
+
+	cret := xTreePathToString(x.GoPointer())
+	return cret
+}
+
+var xTreePathUp func(uintptr) bool
+
+// Moves the path to point to its parent node, if it has a parent.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreePath) Up() bool {
+
+	cret := xTreePathUp(x.GoPointer())
+	return cret
+}
+
+var xNewTreeRowReference func(uintptr, *TreePath) *TreeRowReference
+
+// Creates a row reference based on path.
+//
+// This reference will keep pointing to the node pointed to
+// by path, so long as it exists. Any changes that occur on model are
+// propagated, and the path is updated appropriately. If
+// path isn’t a valid path in model, then nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreeRowReference(ModelVar TreeModel, PathVar *TreePath) *TreeRowReference {
+
+	cret := xNewTreeRowReference(ModelVar.GoPointer(), PathVar)
+	return cret
+}
+
+var xNewTreeRowReferenceProxy func(uintptr, uintptr, *TreePath) *TreeRowReference
+
+// You do not need to use this function.
+//
+// Creates a row reference based on path.
+//
+// This reference will keep pointing to the node pointed to
+// by path, so long as it exists. If path isn’t a valid
+// path in model, then nil is returned. However, unlike
+// references created with [NewTreeRowReference], it
+// does not listen to the model for changes. The creator of
+// the row reference must do this explicitly using
+// [TreeRowReferenceInserted], [TreeRowReferenceDeleted],
+// [TreeRowReferenceReordered].
+//
+// These functions must be called exactly once per proxy when the
+// corresponding signal on the model is emitted. This single call
+// updates all row references for that proxy. Since built-in GTK
+// objects like `GtkTreeView` already use this mechanism internally,
+// using them as the proxy object will produce unpredictable results.
+// Further more, passing the same object as model and proxy
+// doesn’t work for reasons of internal implementation.
+//
+// This type of row reference is primarily meant by structures that
+// need to carefully monitor exactly when a row reference updates
+// itself, and is not generally needed by most applications.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func NewTreeRowReferenceProxy(ProxyVar *gobject.Object, ModelVar TreeModel, PathVar *TreePath) *TreeRowReference {
+
+	cret := xNewTreeRowReferenceProxy(ProxyVar.GoPointer(), ModelVar.GoPointer(), PathVar)
+	return cret
+}
+
+var xTreeRowReferenceCopy func(uintptr) *TreeRowReference
+
+// Copies a `GtkTreeRowReference`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeRowReference) Copy() *TreeRowReference {
+
+	cret := xTreeRowReferenceCopy(x.GoPointer())
+	return cret
+}
+
+var xTreeRowReferenceFree func(uintptr)
+
+// Free’s reference. reference may be nil
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeRowReference) Free() {
+
+	xTreeRowReferenceFree(x.GoPointer())
+
+}
+
+var xTreeRowReferenceGetModel func(uintptr) uintptr
+
+// Returns the model that the row reference is monitoring.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeRowReference) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xTreeRowReferenceGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeRowReferenceGetPath func(uintptr) *TreePath
+
+// Returns a path that the row reference currently points to,
+// or nil if the path pointed to is no longer valid.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeRowReference) GetPath() *TreePath {
+
+	cret := xTreeRowReferenceGetPath(x.GoPointer())
+	return cret
+}
+
+var xTreeRowReferenceValid func(uintptr) bool
+
+// Returns true if the reference is non-nil and refers to
+// a current valid path.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeRowReference) Valid() bool {
+
+	cret := xTreeRowReferenceValid(x.GoPointer())
+	return cret
+}
+
+var xTreeRowReferenceDeleted func(uintptr, *TreePath)
+
+// Lets a set of row reference created by
+// [NewTreeRowReferenceProxy] know that the
+// model emitted the ::row-deleted signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func TreeRowReferenceDeleted(ProxyVar *gobject.Object, PathVar *TreePath) {
+
+	xTreeRowReferenceDeleted(ProxyVar.GoPointer(), PathVar)
+
+}
+
+var xTreeRowReferenceInserted func(uintptr, *TreePath)
+
+// Lets a set of row reference created by
+// [NewTreeRowReferenceProxy] know that the
+// model emitted the ::row-inserted signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func TreeRowReferenceInserted(ProxyVar *gobject.Object, PathVar *TreePath) {
+
+	xTreeRowReferenceInserted(ProxyVar.GoPointer(), PathVar)
+
+}
+
+var xTreeRowReferenceReordered func(uintptr, *TreePath, *TreeIter, []int)
+
+// Lets a set of row reference created by
+// [NewTreeRowReferenceProxy] know that the
+// model emitted the ::rows-reordered signal.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func TreeRowReferenceReordered(ProxyVar *gobject.Object, PathVar *TreePath, IterVar *TreeIter, NewOrderVar []int) {
+
+	xTreeRowReferenceReordered(ProxyVar.GoPointer(), PathVar, IterVar, NewOrderVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeRowReferenceDeleted, libs, "gtk_tree_row_reference_deleted", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceInserted, libs, "gtk_tree_row_reference_inserted", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceReordered, libs, "gtk_tree_row_reference_reordered", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeIterCopy, libs, "gtk_tree_iter_copy", "GTK", "")
+	core.PuregoRegisterSince(&xTreeIterFree, libs, "gtk_tree_iter_free", "GTK", "")
+
+	core.PuregoRegisterSince(&xNewTreePath, libs, "gtk_tree_path_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreePathFirst, libs, "gtk_tree_path_new_first", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreePathFromIndices, libs, "gtk_tree_path_new_from_indices", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreePathFromIndicesv, libs, "gtk_tree_path_new_from_indicesv", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreePathFromString, libs, "gtk_tree_path_new_from_string", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreePathAppendIndex, libs, "gtk_tree_path_append_index", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathCompare, libs, "gtk_tree_path_compare", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathCopy, libs, "gtk_tree_path_copy", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathDown, libs, "gtk_tree_path_down", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathFree, libs, "gtk_tree_path_free", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathGetDepth, libs, "gtk_tree_path_get_depth", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathGetIndices, libs, "gtk_tree_path_get_indices", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathGetIndicesWithDepth, libs, "gtk_tree_path_get_indices_with_depth", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathIsAncestor, libs, "gtk_tree_path_is_ancestor", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathIsDescendant, libs, "gtk_tree_path_is_descendant", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathNext, libs, "gtk_tree_path_next", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathPrependIndex, libs, "gtk_tree_path_prepend_index", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathPrev, libs, "gtk_tree_path_prev", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathToString, libs, "gtk_tree_path_to_string", "GTK", "")
+	core.PuregoRegisterSince(&xTreePathUp, libs, "gtk_tree_path_up", "GTK", "")
+
+	core.PuregoRegisterSince(&xNewTreeRowReference, libs, "gtk_tree_row_reference_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreeRowReferenceProxy, libs, "gtk_tree_row_reference_new_proxy", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeRowReferenceCopy, libs, "gtk_tree_row_reference_copy", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceFree, libs, "gtk_tree_row_reference_free", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceGetModel, libs, "gtk_tree_row_reference_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceGetPath, libs, "gtk_tree_row_reference_get_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeRowReferenceValid, libs, "gtk_tree_row_reference_valid", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -14,14 +13,18 @@ import (
 )
 
 // A function which calculates display values from raw values in the model.
-// It must fill @value with the display value for the column @column in the
-// row indicated by @iter.
+// It must fill value with the display value for the column column in the
+// row indicated by iter.
 //
 // Since this function is called for each data access, it’s not a
 // particularly efficient operation.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeModelFilterModifyFunc func(uintptr, *TreeIter, *gobject.Value, int, uintptr)
 
-// A function which decides whether the row indicated by @iter is visible.
+// A function which decides whether the row indicated by iter is visible.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeModelFilterVisibleFunc func(uintptr, *TreeIter, uintptr) bool
 
 type TreeModelFilterClass struct {
@@ -41,25 +44,30 @@ func (x *TreeModelFilterClass) GoPointer() uintptr {
 }
 
 // OverrideVisible sets the "visible" callback function.
-// A function which decides whether the row indicated by @iter is visible.
+// A function which decides whether the row indicated by iter is visible.
+//
+// Deprecated: since 4.20. There is no replacement
 func (x *TreeModelFilterClass) OverrideVisible(cb func(*TreeModelFilter, TreeModel, *TreeIter) bool) {
 	if cb == nil {
 		x.xVisible = 0
 	} else {
-		x.xVisible = purego.NewCallback(func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter) bool {
+		x.xVisible = core.NewCallback(func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter) bool {
+			defer core.RecoverPanic()
 			return cb(TreeModelFilterNewFromInternalPtr(SelfVarp), &TreeModelBase{Ptr: ChildModelVarp}, IterVarp)
 		})
 	}
 }
 
 // GetVisible gets the "visible" callback function.
-// A function which decides whether the row indicated by @iter is visible.
+// A function which decides whether the row indicated by iter is visible.
+//
+// Deprecated: since 4.20. There is no replacement
 func (x *TreeModelFilterClass) GetVisible() func(*TreeModelFilter, TreeModel, *TreeIter) bool {
 	if x.xVisible == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter) bool
-	purego.RegisterFunc(&rawCallback, x.xVisible)
+	core.RegisterFunc(&rawCallback, x.xVisible)
 	return func(SelfVar *TreeModelFilter, ChildModelVar TreeModel, IterVar *TreeIter) bool {
 		return rawCallback(SelfVar.GoPointer(), ChildModelVar.GoPointer(), IterVar)
 	}
@@ -67,16 +75,19 @@ func (x *TreeModelFilterClass) GetVisible() func(*TreeModelFilter, TreeModel, *T
 
 // OverrideModify sets the "modify" callback function.
 // A function which calculates display values from raw values in the model.
-// It must fill @value with the display value for the column @column in the
-// row indicated by @iter.
+// It must fill value with the display value for the column column in the
+// row indicated by iter.
 //
 // Since this function is called for each data access, it’s not a
 // particularly efficient operation.
+//
+// Deprecated: since 4.20. There is no replacement
 func (x *TreeModelFilterClass) OverrideModify(cb func(*TreeModelFilter, TreeModel, *TreeIter, *gobject.Value, int)) {
 	if cb == nil {
 		x.xModify = 0
 	} else {
-		x.xModify = purego.NewCallback(func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter, ValueVarp *gobject.Value, ColumnVarp int) {
+		x.xModify = core.NewCallback(func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter, ValueVarp *gobject.Value, ColumnVarp int) {
+			defer core.RecoverPanic()
 			cb(TreeModelFilterNewFromInternalPtr(SelfVarp), &TreeModelBase{Ptr: ChildModelVarp}, IterVarp, ValueVarp, ColumnVarp)
 		})
 	}
@@ -84,17 +95,19 @@ func (x *TreeModelFilterClass) OverrideModify(cb func(*TreeModelFilter, TreeMode
 
 // GetModify gets the "modify" callback function.
 // A function which calculates display values from raw values in the model.
-// It must fill @value with the display value for the column @column in the
-// row indicated by @iter.
+// It must fill value with the display value for the column column in the
+// row indicated by iter.
 //
 // Since this function is called for each data access, it’s not a
 // particularly efficient operation.
+//
+// Deprecated: since 4.20. There is no replacement
 func (x *TreeModelFilterClass) GetModify() func(*TreeModelFilter, TreeModel, *TreeIter, *gobject.Value, int) {
 	if x.xModify == 0 {
 		return nil
 	}
 	var rawCallback func(SelfVarp uintptr, ChildModelVarp uintptr, IterVarp *TreeIter, ValueVarp *gobject.Value, ColumnVarp int)
-	purego.RegisterFunc(&rawCallback, x.xModify)
+	core.RegisterFunc(&rawCallback, x.xModify)
 	return func(SelfVar *TreeModelFilter, ChildModelVar TreeModel, IterVar *TreeIter, ValueVar *gobject.Value, ColumnVar int) {
 		rawCallback(SelfVar.GoPointer(), ChildModelVar.GoPointer(), IterVar, ValueVar, ColumnVar)
 	}
@@ -175,6 +188,8 @@ func (x *TreeModelFilterPrivate) GoPointer() uintptr {
 // because it does not implement reference counting, or for models that
 // do implement reference counting, obtain references on these child levels
 // yourself.
+//
+// Deprecated: since 4.10. Use [FilterListModel] instead.
 type TreeModelFilter struct {
 	gobject.Object
 }
@@ -191,232 +206,6 @@ func TreeModelFilterNewFromInternalPtr(ptr uintptr) *TreeModelFilter {
 	return cls
 }
 
-var xTreeModelFilterClearCache func(uintptr)
-
-// This function should almost never be called. It clears the @filter
-// of any cached iterators that haven’t been reffed with
-// gtk_tree_model_ref_node(). This might be useful if the child model
-// being filtered is static (and doesn’t change often) and there has been
-// a lot of unreffed access to nodes. As a side effect of this function,
-// all unreffed iters will be invalid.
-func (x *TreeModelFilter) ClearCache() {
-
-	xTreeModelFilterClearCache(x.GoPointer())
-
-}
-
-var xTreeModelFilterConvertChildIterToIter func(uintptr, *TreeIter, *TreeIter) bool
-
-// Sets @filter_iter to point to the row in @filter that corresponds to the
-// row pointed at by @child_iter.  If @filter_iter was not set, %FALSE is
-// returned.
-func (x *TreeModelFilter) ConvertChildIterToIter(FilterIterVar *TreeIter, ChildIterVar *TreeIter) bool {
-
-	cret := xTreeModelFilterConvertChildIterToIter(x.GoPointer(), FilterIterVar, ChildIterVar)
-	return cret
-}
-
-var xTreeModelFilterConvertChildPathToPath func(uintptr, *TreePath) *TreePath
-
-// Converts @child_path to a path relative to @filter. That is, @child_path
-// points to a path in the child model. The rerturned path will point to the
-// same row in the filtered model. If @child_path isn’t a valid path on the
-// child model or points to a row which is not visible in @filter, then %NULL
-// is returned.
-func (x *TreeModelFilter) ConvertChildPathToPath(ChildPathVar *TreePath) *TreePath {
-
-	cret := xTreeModelFilterConvertChildPathToPath(x.GoPointer(), ChildPathVar)
-	return cret
-}
-
-var xTreeModelFilterConvertIterToChildIter func(uintptr, *TreeIter, *TreeIter)
-
-// Sets @child_iter to point to the row pointed to by @filter_iter.
-func (x *TreeModelFilter) ConvertIterToChildIter(ChildIterVar *TreeIter, FilterIterVar *TreeIter) {
-
-	xTreeModelFilterConvertIterToChildIter(x.GoPointer(), ChildIterVar, FilterIterVar)
-
-}
-
-var xTreeModelFilterConvertPathToChildPath func(uintptr, *TreePath) *TreePath
-
-// Converts @filter_path to a path on the child model of @filter. That is,
-// @filter_path points to a location in @filter. The returned path will
-// point to the same location in the model not being filtered. If @filter_path
-// does not point to a location in the child model, %NULL is returned.
-func (x *TreeModelFilter) ConvertPathToChildPath(FilterPathVar *TreePath) *TreePath {
-
-	cret := xTreeModelFilterConvertPathToChildPath(x.GoPointer(), FilterPathVar)
-	return cret
-}
-
-var xTreeModelFilterGetModel func(uintptr) uintptr
-
-// Returns a pointer to the child model of @filter.
-func (x *TreeModelFilter) GetModel() *TreeModelBase {
-	var cls *TreeModelBase
-
-	cret := xTreeModelFilterGetModel(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &TreeModelBase{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xTreeModelFilterRefilter func(uintptr)
-
-// Emits ::row_changed for each row in the child model, which causes
-// the filter to re-evaluate whether a row is visible or not.
-func (x *TreeModelFilter) Refilter() {
-
-	xTreeModelFilterRefilter(x.GoPointer())
-
-}
-
-var xTreeModelFilterSetModifyFunc func(uintptr, int, []types.GType, uintptr, uintptr, uintptr)
-
-// With the @n_columns and @types parameters, you give an array of column
-// types for this model (which will be exposed to the parent model/view).
-// The @func, @data and @destroy parameters are for specifying the modify
-// function. The modify function will get called for each
-// data access, the goal of the modify function is to return the data which
-// should be displayed at the location specified using the parameters of the
-// modify function.
-//
-// Note that gtk_tree_model_filter_set_modify_func()
-// can only be called once for a given filter model.
-func (x *TreeModelFilter) SetModifyFunc(NColumnsVar int, TypesVar []types.GType, FuncVar *TreeModelFilterModifyFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 *gobject.Value, arg3 int, arg4 uintptr) {
-				cbFn := *FuncVar
-				cbFn(arg0, arg1, arg2, arg3, arg4)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	var DestroyVarRef uintptr
-	if DestroyVar != nil {
-		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
-		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
-			DestroyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *DestroyVar
-				cbFn(arg0)
-			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
-		}
-	}
-
-	xTreeModelFilterSetModifyFunc(x.GoPointer(), NColumnsVar, TypesVar, FuncVarRef, DataVar, DestroyVarRef)
-
-}
-
-var xTreeModelFilterSetVisibleColumn func(uintptr, int)
-
-// Sets @column of the child_model to be the column where @filter should
-// look for visibility information. @columns should be a column of type
-// %G_TYPE_BOOLEAN, where %TRUE means that a row is visible, and %FALSE
-// if not.
-//
-// Note that gtk_tree_model_filter_set_visible_func() or
-// gtk_tree_model_filter_set_visible_column() can only be called
-// once for a given filter model.
-func (x *TreeModelFilter) SetVisibleColumn(ColumnVar int) {
-
-	xTreeModelFilterSetVisibleColumn(x.GoPointer(), ColumnVar)
-
-}
-
-var xTreeModelFilterSetVisibleFunc func(uintptr, uintptr, uintptr, uintptr)
-
-// Sets the visible function used when filtering the @filter to be @func.
-// The function should return %TRUE if the given row should be visible and
-// %FALSE otherwise.
-//
-// If the condition calculated by the function changes over time (e.g.
-// because it depends on some global parameters), you must call
-// gtk_tree_model_filter_refilter() to keep the visibility information
-// of the model up-to-date.
-//
-// Note that @func is called whenever a row is inserted, when it may still
-// be empty. The visible function should therefore take special care of empty
-// rows, like in the example below.
-//
-// ```c
-// static gboolean
-// visible_func (GtkTreeModel *model,
-//
-//	GtkTreeIter  *iter,
-//	gpointer      data)
-//
-//	{
-//	  // Visible if row is non-empty and first column is “HI”
-//	  char *str;
-//	  gboolean visible = FALSE;
-//
-//	  gtk_tree_model_get (model, iter, 0, &amp;str, -1);
-//	  if (str &amp;&amp; strcmp (str, "HI") == 0)
-//	    visible = TRUE;
-//	  g_free (str);
-//
-//	  return visible;
-//	}
-//
-// ```
-//
-// Note that gtk_tree_model_filter_set_visible_func() or
-// gtk_tree_model_filter_set_visible_column() can only be called
-// once for a given filter model.
-func (x *TreeModelFilter) SetVisibleFunc(FuncVar *TreeModelFilterVisibleFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 uintptr) bool {
-				cbFn := *FuncVar
-				return cbFn(arg0, arg1, arg2)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	var DestroyVarRef uintptr
-	if DestroyVar != nil {
-		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
-		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
-			DestroyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *DestroyVar
-				cbFn(arg0)
-			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
-		}
-	}
-
-	xTreeModelFilterSetVisibleFunc(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
-
-}
-
 func (c *TreeModelFilter) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -445,11 +234,13 @@ func (x *TreeModelFilter) GetPropertyVirtualRoot() uintptr {
 	return v.GetPointer()
 }
 
-// Asks the `GtkTreeDragSource` to delete the row at @path, because
-// it was moved somewhere else via drag-and-drop. Returns %FALSE
-// if the deletion fails because @path no longer exists, or for
-// some model-specific reason. Should robustly handle a @path no
+// Asks the `GtkTreeDragSource` to delete the row at path, because
+// it was moved somewhere else via drag-and-drop. Returns false
+// if the deletion fails because path no longer exists, or for
+// some model-specific reason. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeModelFilter) DragDataDelete(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceDragDataDelete(x.GoPointer(), PathVar)
@@ -457,8 +248,10 @@ func (x *TreeModelFilter) DragDataDelete(PathVar *TreePath) bool {
 }
 
 // Asks the `GtkTreeDragSource` to return a `GdkContentProvider` representing
-// the row at @path. Should robustly handle a @path no
+// the row at path. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeModelFilter) DragDataGet(PathVar *TreePath) *gdk.ContentProvider {
 	var cls *gdk.ContentProvider
 
@@ -475,14 +268,18 @@ func (x *TreeModelFilter) DragDataGet(PathVar *TreePath) *gdk.ContentProvider {
 // Asks the `GtkTreeDragSource` whether a particular row can be used as
 // the source of a DND operation. If the source doesn’t implement
 // this interface, the row is assumed draggable.
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeModelFilter) RowDraggable(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceRowDraggable(x.GoPointer(), PathVar)
 	return cret
 }
 
-// Creates a new `GtkTreeModel`, with @child_model as the child_model
-// and @root as the virtual root.
+// Creates a new `GtkTreeModel`, with child_model as the child_model
+// and root as the virtual root.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) FilterNew(RootVar *TreePath) *TreeModelBase {
 	var cls *TreeModelBase
 
@@ -496,29 +293,33 @@ func (x *TreeModelFilter) FilterNew(RootVar *TreePath) *TreeModelBase {
 	return cls
 }
 
-// Calls @func on each node in model in a depth-first fashion.
+// Calls func on each node in model in a depth-first fashion.
 //
-// If @func returns %TRUE, then the tree ceases to be walked,
-// and gtk_tree_model_foreach() returns.
+// If func returns true, then the tree ceases to be walked,
+// and [TreeModel.Foreach] returns.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) Foreach(FuncVar *TreeModelForeachFunc, UserDataVar uintptr) {
 
 	XGtkTreeModelForeach(x.GoPointer(), glib.NewCallback(FuncVar), UserDataVar)
 
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
 // The variable argument list should contain integer column numbers,
 // each column number followed by a place to store the value being
 // retrieved.  The list is terminated by a -1. For example, to get a
-// value from column 0 with type %G_TYPE_STRING, you would
-// write: `gtk_tree_model_get (model, iter, 0, &amp;place_string_here, -1)`,
+// value from column 0 with type G_TYPE_STRING, you would
+// write: `gtk_tree_model_get (model, iter, 0, &place_string_here, -1)`,
 // where `place_string_here` is a #gchararray
 // to be filled with the string.
 //
-// Returned values with type %G_TYPE_OBJECT have to be unreferenced,
-// values with type %G_TYPE_STRING or %G_TYPE_BOXED have to be freed.
+// Returned values with type G_TYPE_OBJECT have to be unreferenced,
+// values with type G_TYPE_STRING or G_TYPE_BOXED have to be freed.
 // Other values are passed by value.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) Get(IterVar *TreeIter, varArgs ...interface{}) {
 
 	XGtkTreeModelGet(x.GoPointer(), IterVar, varArgs...)
@@ -526,6 +327,8 @@ func (x *TreeModelFilter) Get(IterVar *TreeIter, varArgs ...interface{}) {
 }
 
 // Returns the type of the column.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetColumnType(IndexVar int) types.GType {
 
 	cret := XGtkTreeModelGetColumnType(x.GoPointer(), IndexVar)
@@ -536,53 +339,65 @@ func (x *TreeModelFilter) GetColumnType(IndexVar int) types.GType {
 //
 // The flags are a bitwise combination of `GtkTreeModel`Flags.
 // The flags supported should not change during the lifetime
-// of the @tree_model.
+// of the tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetFlags() TreeModelFlags {
 
 	cret := XGtkTreeModelGetFlags(x.GoPointer())
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path.
+// Sets iter to a valid iterator pointing to path.
+//
+// If path does not exist, iter is set to an invalid
+// iterator and false is returned.
 //
-// If @path does not exist, @iter is set to an invalid
-// iterator and %FALSE is returned.
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetIter(IterVar *TreeIter, PathVar *TreePath) bool {
 
 	cret := XGtkTreeModelGetIter(x.GoPointer(), IterVar, PathVar)
 	return cret
 }
 
-// Initializes @iter with the first iterator in the tree
+// Initializes iter with the first iterator in the tree
 // (the one at the path "0").
 //
-// Returns %FALSE if the tree is empty, %TRUE otherwise.
+// Returns false if the tree is empty, true otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetIterFirst(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelGetIterFirst(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path_string, if it
+// Sets iter to a valid iterator pointing to path_string, if it
 // exists.
 //
-// Otherwise, @iter is left invalid and %FALSE is returned.
+// Otherwise, iter is left invalid and false is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetIterFromString(IterVar *TreeIter, PathStringVar string) bool {
 
 	cret := XGtkTreeModelGetIterFromString(x.GoPointer(), IterVar, PathStringVar)
 	return cret
 }
 
-// Returns the number of columns supported by @tree_model.
+// Returns the number of columns supported by tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetNColumns() int {
 
 	cret := XGtkTreeModelGetNColumns(x.GoPointer())
 	return cret
 }
 
-// Returns a newly-created `GtkTreePath` referenced by @iter.
+// Returns a newly-created `GtkTreePath` referenced by iter.
+//
+// This path should be freed with [TreePath.Free].
 //
-// This path should be freed with gtk_tree_path_free().
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetPath(IterVar *TreeIter) *TreePath {
 
 	cret := XGtkTreeModelGetPath(x.GoPointer(), IterVar)
@@ -594,105 +409,125 @@ func (x *TreeModelFilter) GetPath(IterVar *TreeIter) *TreePath {
 // This string is a “:” separated list of numbers.
 // For example, “4:10:0:3” would be an acceptable
 // return value for this string.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetStringFromIter(IterVar *TreeIter) string {
 
 	cret := XGtkTreeModelGetStringFromIter(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
-// See [method@Gtk.TreeModel.get], this version takes a va_list
+// See [TreeModel.Get], this version takes a va_list
 // for language bindings to use.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
 
 	XGtkTreeModelGetValist(x.GoPointer(), IterVar, VarArgsVar)
 
 }
 
-// Initializes and sets @value to that at @column.
+// Initializes and sets value to that at column.
 //
-// When done with @value, g_value_unset() needs to be called
+// When done with value, [gobject.Value.Unset] needs to be called
 // to free any allocated memory.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) GetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
 
 	XGtkTreeModelGetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
 
 }
 
-// Sets @iter to point to the first child of @parent.
+// Sets iter to point to the first child of parent.
 //
-// If @parent has no children, %FALSE is returned and @iter is
-// set to be invalid. @parent will remain a valid node after this
+// If parent has no children, false is returned and iter is
+// set to be invalid. parent will remain a valid node after this
 // function has been called.
 //
-// If @parent is %NULL returns the first node, equivalent to
+// If parent is nil returns the first node, equivalent to
 // `gtk_tree_model_get_iter_first (tree_model, iter);`
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterChildren(IterVar *TreeIter, ParentVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterChildren(x.GoPointer(), IterVar, ParentVar)
 	return cret
 }
 
-// Returns %TRUE if @iter has children, %FALSE otherwise.
+// Returns true if iter has children, false otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterHasChild(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterHasChild(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Returns the number of children that @iter has.
+// Returns the number of children that iter has.
 //
-// As a special case, if @iter is %NULL, then the number
+// As a special case, if iter is nil, then the number
 // of toplevel nodes is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterNChildren(IterVar *TreeIter) int {
 
 	cret := XGtkTreeModelIterNChildren(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to point to the node following it at the current level.
+// Sets iter to point to the node following it at the current level.
 //
-// If there is no next @iter, %FALSE is returned and @iter is set
+// If there is no next iter, false is returned and iter is set
 // to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterNext(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterNext(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to be the child of @parent, using the given index.
+// Sets iter to be the child of parent, using the given index.
 //
-// The first index is 0. If @n is too big, or @parent has no children,
-// @iter is set to an invalid iterator and %FALSE is returned. @parent
+// The first index is 0. If n is too big, or parent has no children,
+// iter is set to an invalid iterator and false is returned. parent
 // will remain a valid node after this function has been called. As a
-// special case, if @parent is %NULL, then the @n-th root node
+// special case, if parent is nil, then the n-th root node
 // is set.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterNthChild(IterVar *TreeIter, ParentVar *TreeIter, NVar int) bool {
 
 	cret := XGtkTreeModelIterNthChild(x.GoPointer(), IterVar, ParentVar, NVar)
 	return cret
 }
 
-// Sets @iter to be the parent of @child.
+// Sets iter to be the parent of child.
 //
-// If @child is at the toplevel, and doesn’t have a parent, then
-// @iter is set to an invalid iterator and %FALSE is returned.
-// @child will remain a valid node after this function has been
+// If child is at the toplevel, and doesn’t have a parent, then
+// iter is set to an invalid iterator and false is returned.
+// child will remain a valid node after this function has been
 // called.
 //
-// @iter will be initialized before the lookup is performed, so @child
-// and @iter cannot point to the same memory location.
+// iter will be initialized before the lookup is performed, so child
+// and iter cannot point to the same memory location.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterParent(IterVar *TreeIter, ChildVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterParent(x.GoPointer(), IterVar, ChildVar)
 	return cret
 }
 
-// Sets @iter to point to the previous node at the current level.
+// Sets iter to point to the previous node at the current level.
 //
-// If there is no previous @iter, %FALSE is returned and @iter is
+// If there is no previous iter, false is returned and iter is
 // set to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) IterPrevious(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterPrevious(x.GoPointer(), IterVar)
@@ -716,76 +551,92 @@ func (x *TreeModelFilter) IterPrevious(IterVar *TreeIter) bool {
 //
 // A model should be expected to be able to get an iter independent
 // of its reffed state.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelRefNode(x.GoPointer(), IterVar)
 
 }
 
-// Emits the ::row-changed signal on @tree_model.
+// Emits the ::row-changed signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-changed].
+// See TreeModel::row-changed.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RowChanged(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowChanged(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-deleted signal on @tree_model.
+// Emits the ::row-deleted signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-deleted].
+// See TreeModel::row-deleted.
 //
 // This should be called by models after a row has been removed.
-// The location pointed to by @path should be the location that
+// The location pointed to by path should be the location that
 // the row previously was at. It may not be a valid location anymore.
 //
 // Nodes that are deleted are not unreffed, this means that any
 // outstanding references on the deleted node should not be released.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RowDeleted(PathVar *TreePath) {
 
 	XGtkTreeModelRowDeleted(x.GoPointer(), PathVar)
 
 }
 
-// Emits the ::row-has-child-toggled signal on @tree_model.
+// Emits the ::row-has-child-toggled signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-has-child-toggled].
+// See TreeModel::row-has-child-toggled.
 //
 // This should be called by models after the child
 // state of a node changes.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RowHasChildToggled(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowHasChildToggled(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-inserted signal on @tree_model.
+// Emits the ::row-inserted signal on tree_model.
+//
+// See TreeModel::row-inserted.
 //
-// See [signal@Gtk.TreeModel::row-inserted].
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RowInserted(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowInserted(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// See [RowsReorderedWithLength] for the version of this function that language bindings are meant to use.
 func (x *TreeModelFilter) RowsReordered(PathVar *TreePath, IterVar *TreeIter, NewOrderVar int) {
 
 	XGtkTreeModelRowsReordered(x.GoPointer(), PathVar, IterVar, NewOrderVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) RowsReorderedWithLength(PathVar *TreePath, IterVar *TreeIter, NewOrderVar []int, LengthVar int) {
 
 	XGtkTreeModelRowsReorderedWithLength(x.GoPointer(), PathVar, IterVar, NewOrderVar, LengthVar)
@@ -797,38 +648,13 @@ func (x *TreeModelFilter) RowsReorderedWithLength(PathVar *TreePath, IterVar *Tr
 // This is an optional method for models to implement.
 // To be more specific, models may ignore this call as it exists
 // primarily for performance reasons. For more information on what
-// this means, see gtk_tree_model_ref_node().
+// this means, see [TreeModel.RefNode].
 //
 // Please note that nodes that are deleted are not unreffed.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeModelFilter) UnrefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelUnrefNode(x.GoPointer(), IterVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xTreeModelFilterGLibType, libs, "gtk_tree_model_filter_get_type")
-
-	core.PuregoSafeRegister(&xTreeModelFilterClearCache, libs, "gtk_tree_model_filter_clear_cache")
-	core.PuregoSafeRegister(&xTreeModelFilterConvertChildIterToIter, libs, "gtk_tree_model_filter_convert_child_iter_to_iter")
-	core.PuregoSafeRegister(&xTreeModelFilterConvertChildPathToPath, libs, "gtk_tree_model_filter_convert_child_path_to_path")
-	core.PuregoSafeRegister(&xTreeModelFilterConvertIterToChildIter, libs, "gtk_tree_model_filter_convert_iter_to_child_iter")
-	core.PuregoSafeRegister(&xTreeModelFilterConvertPathToChildPath, libs, "gtk_tree_model_filter_convert_path_to_child_path")
-	core.PuregoSafeRegister(&xTreeModelFilterGetModel, libs, "gtk_tree_model_filter_get_model")
-	core.PuregoSafeRegister(&xTreeModelFilterRefilter, libs, "gtk_tree_model_filter_refilter")
-	core.PuregoSafeRegister(&xTreeModelFilterSetModifyFunc, libs, "gtk_tree_model_filter_set_modify_func")
-	core.PuregoSafeRegister(&xTreeModelFilterSetVisibleColumn, libs, "gtk_tree_model_filter_set_visible_column")
-	core.PuregoSafeRegister(&xTreeModelFilterSetVisibleFunc, libs, "gtk_tree_model_filter_set_visible_func")
-
-}
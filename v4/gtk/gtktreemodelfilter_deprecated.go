@@ -0,0 +1,283 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xTreeModelFilterClearCache func(uintptr)
+
+// This function should almost never be called. It clears the filter
+// of any cached iterators that haven’t been reffed with
+// [TreeModel.RefNode]. This might be useful if the child model
+// being filtered is static (and doesn’t change often) and there has been
+// a lot of unreffed access to nodes. As a side effect of this function,
+// all unreffed iters will be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) ClearCache() {
+
+	xTreeModelFilterClearCache(x.GoPointer())
+
+}
+
+var xTreeModelFilterConvertChildIterToIter func(uintptr, *TreeIter, *TreeIter) bool
+
+// Sets filter_iter to point to the row in filter that corresponds to the
+// row pointed at by child_iter.  If filter_iter was not set, false is
+// returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) ConvertChildIterToIter(FilterIterVar *TreeIter, ChildIterVar *TreeIter) bool {
+
+	cret := xTreeModelFilterConvertChildIterToIter(x.GoPointer(), FilterIterVar, ChildIterVar)
+	return cret
+}
+
+var xTreeModelFilterConvertChildPathToPath func(uintptr, *TreePath) *TreePath
+
+// Converts child_path to a path relative to filter. That is, child_path
+// points to a path in the child model. The rerturned path will point to the
+// same row in the filtered model. If child_path isn’t a valid path on the
+// child model or points to a row which is not visible in filter, then nil
+// is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) ConvertChildPathToPath(ChildPathVar *TreePath) *TreePath {
+
+	cret := xTreeModelFilterConvertChildPathToPath(x.GoPointer(), ChildPathVar)
+	return cret
+}
+
+var xTreeModelFilterConvertIterToChildIter func(uintptr, *TreeIter, *TreeIter)
+
+// Sets child_iter to point to the row pointed to by filter_iter.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) ConvertIterToChildIter(ChildIterVar *TreeIter, FilterIterVar *TreeIter) {
+
+	xTreeModelFilterConvertIterToChildIter(x.GoPointer(), ChildIterVar, FilterIterVar)
+
+}
+
+var xTreeModelFilterConvertPathToChildPath func(uintptr, *TreePath) *TreePath
+
+// Converts filter_path to a path on the child model of filter. That is,
+// filter_path points to a location in filter. The returned path will
+// point to the same location in the model not being filtered. If filter_path
+// does not point to a location in the child model, nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) ConvertPathToChildPath(FilterPathVar *TreePath) *TreePath {
+
+	cret := xTreeModelFilterConvertPathToChildPath(x.GoPointer(), FilterPathVar)
+	return cret
+}
+
+var xTreeModelFilterGetModel func(uintptr) uintptr
+
+// Returns a pointer to the child model of filter.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xTreeModelFilterGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeModelFilterRefilter func(uintptr)
+
+// Emits ::row_changed for each row in the child model, which causes
+// the filter to re-evaluate whether a row is visible or not.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) Refilter() {
+
+	xTreeModelFilterRefilter(x.GoPointer())
+
+}
+
+var xTreeModelFilterSetModifyFunc func(uintptr, int, []types.GType, uintptr, uintptr, uintptr)
+
+// With the n_columns and types parameters, you give an array of column
+// types for this model (which will be exposed to the parent model/view).
+// The func, data and destroy parameters are for specifying the modify
+// function. The modify function will get called for each
+// data access, the goal of the modify function is to return the data which
+// should be displayed at the location specified using the parameters of the
+// modify function.
+//
+// Note that [TreeModelFilter.SetModifyFunc]
+// can only be called once for a given filter model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) SetModifyFunc(NColumnsVar int, TypesVar []types.GType, FuncVar *TreeModelFilterModifyFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 *gobject.Value, arg3 int, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeModelFilterSetModifyFunc(x.GoPointer(), NColumnsVar, TypesVar, FuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+var xTreeModelFilterSetVisibleColumn func(uintptr, int)
+
+// Sets column of the child_model to be the column where filter should
+// look for visibility information. columns should be a column of type
+// G_TYPE_BOOLEAN, where true means that a row is visible, and false
+// if not.
+//
+// Note that [TreeModelFilter.SetVisibleFunc] or
+// [TreeModelFilter.SetVisibleColumn] can only be called
+// once for a given filter model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) SetVisibleColumn(ColumnVar int) {
+
+	xTreeModelFilterSetVisibleColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xTreeModelFilterSetVisibleFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the visible function used when filtering the filter to be func.
+// The function should return true if the given row should be visible and
+// false otherwise.
+//
+// If the condition calculated by the function changes over time (e.g.
+// because it depends on some global parameters), you must call
+// [TreeModelFilter.Refilter] to keep the visibility information
+// of the model up-to-date.
+//
+// Note that func is called whenever a row is inserted, when it may still
+// be empty. The visible function should therefore take special care of empty
+// rows, like in the example below.
+//
+//	static gboolean
+//	visible_func (GtkTreeModel *model,
+//	              GtkTreeIter  *iter,
+//	              gpointer      data)
+//	{
+//	  // Visible if row is non-empty and first column is “HI”
+//	  char *str;
+//	  gboolean visible = FALSE;
+//
+//	  gtk_tree_model_get (model, iter, 0, &str, -1);
+//	  if (str && strcmp (str, "HI") == 0)
+//	    visible = TRUE;
+//	  g_free (str);
+//
+//	  return visible;
+//	}
+//
+// Note that [TreeModelFilter.SetVisibleFunc] or
+// [TreeModelFilter.SetVisibleColumn] can only be called
+// once for a given filter model.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelFilter) SetVisibleFunc(FuncVar *TreeModelFilterVisibleFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeModelFilterSetVisibleFunc(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeModelFilterClearCache, libs, "gtk_tree_model_filter_clear_cache", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterConvertChildIterToIter, libs, "gtk_tree_model_filter_convert_child_iter_to_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterConvertChildPathToPath, libs, "gtk_tree_model_filter_convert_child_path_to_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterConvertIterToChildIter, libs, "gtk_tree_model_filter_convert_iter_to_child_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterConvertPathToChildPath, libs, "gtk_tree_model_filter_convert_path_to_child_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterGetModel, libs, "gtk_tree_model_filter_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterRefilter, libs, "gtk_tree_model_filter_refilter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterSetModifyFunc, libs, "gtk_tree_model_filter_set_modify_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterSetVisibleColumn, libs, "gtk_tree_model_filter_set_visible_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelFilterSetVisibleFunc, libs, "gtk_tree_model_filter_set_visible_func", "GTK", "")
+
+}
@@ -0,0 +1,127 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xTreeModelSortClearCache func(uintptr)
+
+// This function should almost never be called.  It clears the tree_model_sort
+// of any cached iterators that haven’t been reffed with
+// [TreeModel.RefNode].  This might be useful if the child model being
+// sorted is static (and doesn’t change often) and there has been a lot of
+// unreffed access to nodes.  As a side effect of this function, all unreffed
+// iters will be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ClearCache() {
+
+	xTreeModelSortClearCache(x.GoPointer())
+
+}
+
+var xTreeModelSortConvertChildIterToIter func(uintptr, *TreeIter, *TreeIter) bool
+
+// Sets sort_iter to point to the row in tree_model_sort that corresponds to
+// the row pointed at by child_iter.  If sort_iter was not set, false
+// is returned.  Note: a boolean is only returned since 2.14.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ConvertChildIterToIter(SortIterVar *TreeIter, ChildIterVar *TreeIter) bool {
+
+	cret := xTreeModelSortConvertChildIterToIter(x.GoPointer(), SortIterVar, ChildIterVar)
+	return cret
+}
+
+var xTreeModelSortConvertChildPathToPath func(uintptr, *TreePath) *TreePath
+
+// Converts child_path to a path relative to tree_model_sort.  That is,
+// child_path points to a path in the child model.  The returned path will
+// point to the same row in the sorted model.  If child_path isn’t a valid
+// path on the child model, then nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ConvertChildPathToPath(ChildPathVar *TreePath) *TreePath {
+
+	cret := xTreeModelSortConvertChildPathToPath(x.GoPointer(), ChildPathVar)
+	return cret
+}
+
+var xTreeModelSortConvertIterToChildIter func(uintptr, *TreeIter, *TreeIter)
+
+// Sets child_iter to point to the row pointed to by sorted_iter.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ConvertIterToChildIter(ChildIterVar *TreeIter, SortedIterVar *TreeIter) {
+
+	xTreeModelSortConvertIterToChildIter(x.GoPointer(), ChildIterVar, SortedIterVar)
+
+}
+
+var xTreeModelSortConvertPathToChildPath func(uintptr, *TreePath) *TreePath
+
+// Converts sorted_path to a path on the child model of tree_model_sort.
+// That is, sorted_path points to a location in tree_model_sort.  The
+// returned path will point to the same location in the model not being
+// sorted.  If sorted_path does not point to a location in the child model,
+// nil is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ConvertPathToChildPath(SortedPathVar *TreePath) *TreePath {
+
+	cret := xTreeModelSortConvertPathToChildPath(x.GoPointer(), SortedPathVar)
+	return cret
+}
+
+var xTreeModelSortIterIsValid func(uintptr, *TreeIter) bool
+
+// > This function is slow. Only use it for debugging and/or testing
+// > purposes.
+//
+// Checks if the given iter is a valid iter for this `GtkTreeModelSort`.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) IterIsValid(IterVar *TreeIter) bool {
+
+	cret := xTreeModelSortIterIsValid(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xTreeModelSortResetDefaultSortFunc func(uintptr)
+
+// This resets the default sort function to be in the “unsorted” state.  That
+// is, it is in the same order as the child model. It will re-sort the model
+// to be in the same order as the child model only if the `GtkTreeModelSort`
+// is in “unsorted” state.
+//
+// Deprecated: since 4.10. do not use it in new code.
+func (x *TreeModelSort) ResetDefaultSortFunc() {
+
+	xTreeModelSortResetDefaultSortFunc(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeModelSortClearCache, libs, "gtk_tree_model_sort_clear_cache", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortConvertChildIterToIter, libs, "gtk_tree_model_sort_convert_child_iter_to_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortConvertChildPathToPath, libs, "gtk_tree_model_sort_convert_child_path_to_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortConvertIterToChildIter, libs, "gtk_tree_model_sort_convert_iter_to_child_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortConvertPathToChildPath, libs, "gtk_tree_model_sort_convert_path_to_child_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortIterIsValid, libs, "gtk_tree_model_sort_iter_is_valid", "GTK", "")
+	core.PuregoRegisterSince(&xTreeModelSortResetDefaultSortFunc, libs, "gtk_tree_model_sort_reset_default_sort_func", "GTK", "")
+
+}
@@ -4,23 +4,26 @@ package gtk
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
-// A function used by gtk_tree_selection_selected_foreach() to map all
+// A function used by [TreeSelection.SelectedForeach] to map all
 // selected rows.  It will be called on every selected row in the view.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeSelectionForeachFunc func(uintptr, *TreePath, *TreeIter, uintptr)
 
-// A function used by gtk_tree_selection_set_select_function() to filter
+// A function used by [TreeSelection.SetSelectFunction] to filter
 // whether or not a row may be selected. It is called whenever a row's
 // state might change.
 //
-// A return value of %TRUE indicates to @selection that it is okay to
+// A return value of true indicates to selection that it is okay to
 // change the selection.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeSelectionFunc func(uintptr, uintptr, *TreePath, bool, uintptr) bool
 
 // The selection object for GtkTreeView
@@ -34,7 +37,7 @@ type TreeSelectionFunc func(uintptr, uintptr, *TreePath, bool, uintptr) bool
 // methods on the `GtkTreeView` widget instead of a separate function.
 //
 // The `GtkTreeSelection` object is gotten from a `GtkTreeView` by calling
-// gtk_tree_view_get_selection().  It can be manipulated to check the
+// [TreeView.GetSelection].  It can be manipulated to check the
 // selection status of the tree, as well as select and deselect individual
 // rows.  Selection is done completely view side.  As a result, multiple
 // views of the same model can have completely different selections.
@@ -48,6 +51,8 @@ type TreeSelectionFunc func(uintptr, uintptr, *TreePath, bool, uintptr) bool
 // Additionally, it may on occasion emit a `GtkTreeSelection`::changed signal
 // when nothing has happened (mostly as a result of programmers calling
 // select_row on an already selected row).
+//
+// Deprecated: since 4.10. Use [SelectionModel] instead
 type TreeSelection struct {
 	gobject.Object
 }
@@ -64,266 +69,6 @@ func TreeSelectionNewFromInternalPtr(ptr uintptr) *TreeSelection {
 	return cls
 }
 
-var xTreeSelectionCountSelectedRows func(uintptr) int
-
-// Returns the number of rows that have been selected in @tree.
-func (x *TreeSelection) CountSelectedRows() int {
-
-	cret := xTreeSelectionCountSelectedRows(x.GoPointer())
-	return cret
-}
-
-var xTreeSelectionGetMode func(uintptr) SelectionMode
-
-// Gets the selection mode for @selection. See
-// gtk_tree_selection_set_mode().
-func (x *TreeSelection) GetMode() SelectionMode {
-
-	cret := xTreeSelectionGetMode(x.GoPointer())
-	return cret
-}
-
-var xTreeSelectionGetSelectFunction func(uintptr) uintptr
-
-// Returns the current selection function.
-func (x *TreeSelection) GetSelectFunction() uintptr {
-
-	cret := xTreeSelectionGetSelectFunction(x.GoPointer())
-	return cret
-}
-
-var xTreeSelectionGetSelected func(uintptr, **TreeModel, *TreeIter) bool
-
-// Sets @iter to the currently selected node if @selection is set to
-// %GTK_SELECTION_SINGLE or %GTK_SELECTION_BROWSE.  @iter may be NULL if you
-// just want to test if @selection has any selected nodes.  @model is filled
-// with the current model as a convenience.  This function will not work if you
-// use @selection is %GTK_SELECTION_MULTIPLE.
-func (x *TreeSelection) GetSelected(ModelVar **TreeModel, IterVar *TreeIter) bool {
-
-	cret := xTreeSelectionGetSelected(x.GoPointer(), ModelVar, IterVar)
-	return cret
-}
-
-var xTreeSelectionGetSelectedRows func(uintptr, **TreeModel) *glib.List
-
-// Creates a list of path of all selected rows. Additionally, if you are
-// planning on modifying the model after calling this function, you may
-// want to convert the returned list into a list of `GtkTreeRowReference`s.
-// To do this, you can use gtk_tree_row_reference_new().
-//
-// To free the return value, use:
-//
-// ```c
-// g_list_free_full (list, (GDestroyNotify) gtk_tree_path_free);
-// ```
-func (x *TreeSelection) GetSelectedRows(ModelVar **TreeModel) *glib.List {
-
-	cret := xTreeSelectionGetSelectedRows(x.GoPointer(), ModelVar)
-	return cret
-}
-
-var xTreeSelectionGetTreeView func(uintptr) uintptr
-
-// Returns the tree view associated with @selection.
-func (x *TreeSelection) GetTreeView() *TreeView {
-	var cls *TreeView
-
-	cret := xTreeSelectionGetTreeView(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &TreeView{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xTreeSelectionGetUserData func(uintptr) uintptr
-
-// Returns the user data for the selection function.
-func (x *TreeSelection) GetUserData() uintptr {
-
-	cret := xTreeSelectionGetUserData(x.GoPointer())
-	return cret
-}
-
-var xTreeSelectionIterIsSelected func(uintptr, *TreeIter) bool
-
-// Returns %TRUE if the row at @iter is currently selected.
-func (x *TreeSelection) IterIsSelected(IterVar *TreeIter) bool {
-
-	cret := xTreeSelectionIterIsSelected(x.GoPointer(), IterVar)
-	return cret
-}
-
-var xTreeSelectionPathIsSelected func(uintptr, *TreePath) bool
-
-// Returns %TRUE if the row pointed to by @path is currently selected.  If @path
-// does not point to a valid location, %FALSE is returned
-func (x *TreeSelection) PathIsSelected(PathVar *TreePath) bool {
-
-	cret := xTreeSelectionPathIsSelected(x.GoPointer(), PathVar)
-	return cret
-}
-
-var xTreeSelectionSelectAll func(uintptr)
-
-// Selects all the nodes. @selection must be set to %GTK_SELECTION_MULTIPLE
-// mode.
-func (x *TreeSelection) SelectAll() {
-
-	xTreeSelectionSelectAll(x.GoPointer())
-
-}
-
-var xTreeSelectionSelectIter func(uintptr, *TreeIter)
-
-// Selects the specified iterator.
-func (x *TreeSelection) SelectIter(IterVar *TreeIter) {
-
-	xTreeSelectionSelectIter(x.GoPointer(), IterVar)
-
-}
-
-var xTreeSelectionSelectPath func(uintptr, *TreePath)
-
-// Select the row at @path.
-func (x *TreeSelection) SelectPath(PathVar *TreePath) {
-
-	xTreeSelectionSelectPath(x.GoPointer(), PathVar)
-
-}
-
-var xTreeSelectionSelectRange func(uintptr, *TreePath, *TreePath)
-
-// Selects a range of nodes, determined by @start_path and @end_path inclusive.
-// @selection must be set to %GTK_SELECTION_MULTIPLE mode.
-func (x *TreeSelection) SelectRange(StartPathVar *TreePath, EndPathVar *TreePath) {
-
-	xTreeSelectionSelectRange(x.GoPointer(), StartPathVar, EndPathVar)
-
-}
-
-var xTreeSelectionSelectedForeach func(uintptr, uintptr, uintptr)
-
-// Calls a function for each selected node. Note that you cannot modify
-// the tree or selection from within this function. As a result,
-// gtk_tree_selection_get_selected_rows() might be more useful.
-func (x *TreeSelection) SelectedForeach(FuncVar *TreeSelectionForeachFunc, DataVar uintptr) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 *TreePath, arg2 *TreeIter, arg3 uintptr) {
-				cbFn := *FuncVar
-				cbFn(arg0, arg1, arg2, arg3)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	xTreeSelectionSelectedForeach(x.GoPointer(), FuncVarRef, DataVar)
-
-}
-
-var xTreeSelectionSetMode func(uintptr, SelectionMode)
-
-// Sets the selection mode of the @selection.  If the previous type was
-// %GTK_SELECTION_MULTIPLE, then the anchor is kept selected, if it was
-// previously selected.
-func (x *TreeSelection) SetMode(TypeVar SelectionMode) {
-
-	xTreeSelectionSetMode(x.GoPointer(), TypeVar)
-
-}
-
-var xTreeSelectionSetSelectFunction func(uintptr, uintptr, uintptr, uintptr)
-
-// Sets the selection function.
-//
-// If set, this function is called before any node is selected or unselected,
-// giving some control over which nodes are selected. The select function
-// should return %TRUE if the state of the node may be toggled, and %FALSE
-// if the state of the node should be left unchanged.
-func (x *TreeSelection) SetSelectFunction(FuncVar *TreeSelectionFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
-
-	var FuncVarRef uintptr
-	if FuncVar != nil {
-		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
-		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
-			FuncVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr, arg1 uintptr, arg2 *TreePath, arg3 bool, arg4 uintptr) bool {
-				cbFn := *FuncVar
-				return cbFn(arg0, arg1, arg2, arg3, arg4)
-			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
-		}
-	}
-
-	var DestroyVarRef uintptr
-	if DestroyVar != nil {
-		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
-		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
-			DestroyVarRef = cbRefPtr
-		} else {
-			fcb := func(arg0 uintptr) {
-				cbFn := *DestroyVar
-				cbFn(arg0)
-			}
-			DestroyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
-		}
-	}
-
-	xTreeSelectionSetSelectFunction(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
-
-}
-
-var xTreeSelectionUnselectAll func(uintptr)
-
-// Unselects all the nodes.
-func (x *TreeSelection) UnselectAll() {
-
-	xTreeSelectionUnselectAll(x.GoPointer())
-
-}
-
-var xTreeSelectionUnselectIter func(uintptr, *TreeIter)
-
-// Unselects the specified iterator.
-func (x *TreeSelection) UnselectIter(IterVar *TreeIter) {
-
-	xTreeSelectionUnselectIter(x.GoPointer(), IterVar)
-
-}
-
-var xTreeSelectionUnselectPath func(uintptr, *TreePath)
-
-// Unselects the row at @path.
-func (x *TreeSelection) UnselectPath(PathVar *TreePath) {
-
-	xTreeSelectionUnselectPath(x.GoPointer(), PathVar)
-
-}
-
-var xTreeSelectionUnselectRange func(uintptr, *TreePath, *TreePath)
-
-// Unselects a range of nodes, determined by @start_path and @end_path
-// inclusive.
-func (x *TreeSelection) UnselectRange(StartPathVar *TreePath, EndPathVar *TreePath) {
-
-	xTreeSelectionUnselectRange(x.GoPointer(), StartPathVar, EndPathVar)
-
-}
-
 func (c *TreeSelection) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -339,62 +84,47 @@ func (c *TreeSelection) SetGoPointer(ptr uintptr) {
 // this signal is mostly a hint.  It may only be emitted once when a range
 // of rows are selected, and it may occasionally be emitted when nothing
 // has happened.
+//
+// Deprecated: use ConnectChangedFunc, which also accepts method values and closures.
 func (x *TreeSelection) ConnectChanged(cb *func(TreeSelection)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := TreeSelection{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "changed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xTreeSelectionChangedTrampoline uintptr
+
+func xTreeSelectionChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(TreeSelection))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := TreeSelection{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xTreeSelectionGLibType, libs, "gtk_tree_selection_get_type")
-
-	core.PuregoSafeRegister(&xTreeSelectionCountSelectedRows, libs, "gtk_tree_selection_count_selected_rows")
-	core.PuregoSafeRegister(&xTreeSelectionGetMode, libs, "gtk_tree_selection_get_mode")
-	core.PuregoSafeRegister(&xTreeSelectionGetSelectFunction, libs, "gtk_tree_selection_get_select_function")
-	core.PuregoSafeRegister(&xTreeSelectionGetSelected, libs, "gtk_tree_selection_get_selected")
-	core.PuregoSafeRegister(&xTreeSelectionGetSelectedRows, libs, "gtk_tree_selection_get_selected_rows")
-	core.PuregoSafeRegister(&xTreeSelectionGetTreeView, libs, "gtk_tree_selection_get_tree_view")
-	core.PuregoSafeRegister(&xTreeSelectionGetUserData, libs, "gtk_tree_selection_get_user_data")
-	core.PuregoSafeRegister(&xTreeSelectionIterIsSelected, libs, "gtk_tree_selection_iter_is_selected")
-	core.PuregoSafeRegister(&xTreeSelectionPathIsSelected, libs, "gtk_tree_selection_path_is_selected")
-	core.PuregoSafeRegister(&xTreeSelectionSelectAll, libs, "gtk_tree_selection_select_all")
-	core.PuregoSafeRegister(&xTreeSelectionSelectIter, libs, "gtk_tree_selection_select_iter")
-	core.PuregoSafeRegister(&xTreeSelectionSelectPath, libs, "gtk_tree_selection_select_path")
-	core.PuregoSafeRegister(&xTreeSelectionSelectRange, libs, "gtk_tree_selection_select_range")
-	core.PuregoSafeRegister(&xTreeSelectionSelectedForeach, libs, "gtk_tree_selection_selected_foreach")
-	core.PuregoSafeRegister(&xTreeSelectionSetMode, libs, "gtk_tree_selection_set_mode")
-	core.PuregoSafeRegister(&xTreeSelectionSetSelectFunction, libs, "gtk_tree_selection_set_select_function")
-	core.PuregoSafeRegister(&xTreeSelectionUnselectAll, libs, "gtk_tree_selection_unselect_all")
-	core.PuregoSafeRegister(&xTreeSelectionUnselectIter, libs, "gtk_tree_selection_unselect_iter")
-	core.PuregoSafeRegister(&xTreeSelectionUnselectPath, libs, "gtk_tree_selection_unselect_path")
-	core.PuregoSafeRegister(&xTreeSelectionUnselectRange, libs, "gtk_tree_selection_unselect_range")
-
+// ConnectChangedFunc connects cb to the "changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *TreeSelection) ConnectChangedFunc(cb func(TreeSelection)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "changed", &xTreeSelectionChangedTrampoline, xTreeSelectionChangedNewTrampoline, cb)
 }
@@ -0,0 +1,348 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xTreeSelectionCountSelectedRows func(uintptr) int
+
+// Returns the number of rows that have been selected in tree.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) CountSelectedRows() int {
+
+	cret := xTreeSelectionCountSelectedRows(x.GoPointer())
+	return cret
+}
+
+var xTreeSelectionGetMode func(uintptr) SelectionMode
+
+// Gets the selection mode for selection. See
+// [TreeSelection.SetMode].
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetMode() SelectionMode {
+
+	cret := xTreeSelectionGetMode(x.GoPointer())
+	return cret
+}
+
+var xTreeSelectionGetSelectFunction func(uintptr) uintptr
+
+// Returns the current selection function.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetSelectFunction() uintptr {
+
+	cret := xTreeSelectionGetSelectFunction(x.GoPointer())
+	return cret
+}
+
+var xTreeSelectionGetSelected func(uintptr, **TreeModel, *TreeIter) bool
+
+// Sets iter to the currently selected node if selection is set to
+// [SelectionSingleValue] or [SelectionBrowseValue].  iter may be NULL if you
+// just want to test if selection has any selected nodes.  model is filled
+// with the current model as a convenience.  This function will not work if you
+// use selection is [SelectionMultipleValue].
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetSelected(ModelVar **TreeModel, IterVar *TreeIter) bool {
+
+	cret := xTreeSelectionGetSelected(x.GoPointer(), ModelVar, IterVar)
+	return cret
+}
+
+var xTreeSelectionGetSelectedRows func(uintptr, **TreeModel) *glib.List
+
+// Creates a list of path of all selected rows. Additionally, if you are
+// planning on modifying the model after calling this function, you may
+// want to convert the returned list into a list of `GtkTreeRowReference`s.
+// To do this, you can use [NewTreeRowReference].
+//
+// To free the return value, use:
+//
+//	g_list_free_full (list, (GDestroyNotify) gtk_tree_path_free);
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetSelectedRows(ModelVar **TreeModel) *glib.List {
+
+	cret := xTreeSelectionGetSelectedRows(x.GoPointer(), ModelVar)
+	return cret
+}
+
+var xTreeSelectionGetTreeView func(uintptr) uintptr
+
+// Returns the tree view associated with selection.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetTreeView() *TreeView {
+	var cls *TreeView
+
+	cret := xTreeSelectionGetTreeView(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeSelectionGetUserData func(uintptr) uintptr
+
+// Returns the user data for the selection function.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) GetUserData() uintptr {
+
+	cret := xTreeSelectionGetUserData(x.GoPointer())
+	return cret
+}
+
+var xTreeSelectionIterIsSelected func(uintptr, *TreeIter) bool
+
+// Returns true if the row at iter is currently selected.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) IterIsSelected(IterVar *TreeIter) bool {
+
+	cret := xTreeSelectionIterIsSelected(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xTreeSelectionPathIsSelected func(uintptr, *TreePath) bool
+
+// Returns true if the row pointed to by path is currently selected.  If path
+// does not point to a valid location, false is returned
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) PathIsSelected(PathVar *TreePath) bool {
+
+	cret := xTreeSelectionPathIsSelected(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xTreeSelectionSelectAll func(uintptr)
+
+// Selects all the nodes. selection must be set to [SelectionMultipleValue]
+// mode.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SelectAll() {
+
+	xTreeSelectionSelectAll(x.GoPointer())
+
+}
+
+var xTreeSelectionSelectIter func(uintptr, *TreeIter)
+
+// Selects the specified iterator.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SelectIter(IterVar *TreeIter) {
+
+	xTreeSelectionSelectIter(x.GoPointer(), IterVar)
+
+}
+
+var xTreeSelectionSelectPath func(uintptr, *TreePath)
+
+// Select the row at path.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SelectPath(PathVar *TreePath) {
+
+	xTreeSelectionSelectPath(x.GoPointer(), PathVar)
+
+}
+
+var xTreeSelectionSelectRange func(uintptr, *TreePath, *TreePath)
+
+// Selects a range of nodes, determined by start_path and end_path inclusive.
+// selection must be set to [SelectionMultipleValue] mode.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SelectRange(StartPathVar *TreePath, EndPathVar *TreePath) {
+
+	xTreeSelectionSelectRange(x.GoPointer(), StartPathVar, EndPathVar)
+
+}
+
+var xTreeSelectionSelectedForeach func(uintptr, uintptr, uintptr)
+
+// Calls a function for each selected node. Note that you cannot modify
+// the tree or selection from within this function. As a result,
+// [TreeSelection.GetSelectedRows] might be more useful.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SelectedForeach(FuncVar *TreeSelectionForeachFunc, DataVar uintptr) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreePath, arg2 *TreeIter, arg3 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2, arg3)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	xTreeSelectionSelectedForeach(x.GoPointer(), FuncVarRef, DataVar)
+
+}
+
+var xTreeSelectionSetMode func(uintptr, SelectionMode)
+
+// Sets the selection mode of the selection.  If the previous type was
+// [SelectionMultipleValue], then the anchor is kept selected, if it was
+// previously selected.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SetMode(TypeVar SelectionMode) {
+
+	xTreeSelectionSetMode(x.GoPointer(), TypeVar)
+
+}
+
+var xTreeSelectionSetSelectFunction func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the selection function.
+//
+// If set, this function is called before any node is selected or unselected,
+// giving some control over which nodes are selected. The select function
+// should return true if the state of the node may be toggled, and false
+// if the state of the node should be left unchanged.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) SetSelectFunction(FuncVar *TreeSelectionFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 *TreePath, arg3 bool, arg4 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeSelectionSetSelectFunction(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+var xTreeSelectionUnselectAll func(uintptr)
+
+// Unselects all the nodes.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) UnselectAll() {
+
+	xTreeSelectionUnselectAll(x.GoPointer())
+
+}
+
+var xTreeSelectionUnselectIter func(uintptr, *TreeIter)
+
+// Unselects the specified iterator.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) UnselectIter(IterVar *TreeIter) {
+
+	xTreeSelectionUnselectIter(x.GoPointer(), IterVar)
+
+}
+
+var xTreeSelectionUnselectPath func(uintptr, *TreePath)
+
+// Unselects the row at path.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) UnselectPath(PathVar *TreePath) {
+
+	xTreeSelectionUnselectPath(x.GoPointer(), PathVar)
+
+}
+
+var xTreeSelectionUnselectRange func(uintptr, *TreePath, *TreePath)
+
+// Unselects a range of nodes, determined by start_path and end_path
+// inclusive.
+//
+// Deprecated: since 4.10. Use GtkListView or GtkColumnView
+func (x *TreeSelection) UnselectRange(StartPathVar *TreePath, EndPathVar *TreePath) {
+
+	xTreeSelectionUnselectRange(x.GoPointer(), StartPathVar, EndPathVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xTreeSelectionCountSelectedRows, libs, "gtk_tree_selection_count_selected_rows", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetMode, libs, "gtk_tree_selection_get_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetSelectFunction, libs, "gtk_tree_selection_get_select_function", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetSelected, libs, "gtk_tree_selection_get_selected", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetSelectedRows, libs, "gtk_tree_selection_get_selected_rows", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetTreeView, libs, "gtk_tree_selection_get_tree_view", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionGetUserData, libs, "gtk_tree_selection_get_user_data", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionIterIsSelected, libs, "gtk_tree_selection_iter_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionPathIsSelected, libs, "gtk_tree_selection_path_is_selected", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSelectAll, libs, "gtk_tree_selection_select_all", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSelectIter, libs, "gtk_tree_selection_select_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSelectPath, libs, "gtk_tree_selection_select_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSelectRange, libs, "gtk_tree_selection_select_range", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSelectedForeach, libs, "gtk_tree_selection_selected_foreach", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSetMode, libs, "gtk_tree_selection_set_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionSetSelectFunction, libs, "gtk_tree_selection_set_select_function", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionUnselectAll, libs, "gtk_tree_selection_unselect_all", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionUnselectIter, libs, "gtk_tree_selection_unselect_iter", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionUnselectPath, libs, "gtk_tree_selection_unselect_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeSelectionUnselectRange, libs, "gtk_tree_selection_unselect_range", "GTK", "")
+
+}
@@ -5,14 +5,14 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 // A GtkTreeIterCompareFunc should return a negative integer, zero, or a positive
-// integer if @a sorts before @b, @a sorts with @b, or @a sorts after @b
+// integer if a sorts before b, a sorts with b, or a sorts after b
 // respectively.
 //
 // If two iters compare as equal, their order in the sorted model
@@ -20,15 +20,17 @@ import (
 // expected, the GtkTreeIterCompareFunc must define a partial order on
 // the model, i.e. it must be reflexive, antisymmetric and transitive.
 //
-// For example, if @model is a product catalogue, then a compare function
+// For example, if model is a product catalogue, then a compare function
 // for the “price” column could be one which returns
-// `price_of(@a) - price_of(@b)`.
+// `price_of(a) - price_of(b)`.
+//
+// Deprecated: since 4.20. There is no replacement
 type TreeIterCompareFunc func(uintptr, *TreeIter, *TreeIter, uintptr) int
 
 type TreeSortableIface struct {
 	_ structs.HostLayout
 
-	GIface uintptr
+	GIface gobject.TypeInterface
 
 	xSortColumnChanged uintptr
 
@@ -55,7 +57,8 @@ func (x *TreeSortableIface) OverrideSortColumnChanged(cb func(TreeSortable)) {
 	if cb == nil {
 		x.xSortColumnChanged = 0
 	} else {
-		x.xSortColumnChanged = purego.NewCallback(func(SortableVarp uintptr) {
+		x.xSortColumnChanged = core.NewCallback(func(SortableVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&TreeSortableBase{Ptr: SortableVarp})
 		})
 	}
@@ -70,7 +73,7 @@ func (x *TreeSortableIface) GetSortColumnChanged() func(TreeSortable) {
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSortColumnChanged)
+	core.RegisterFunc(&rawCallback, x.xSortColumnChanged)
 	return func(SortableVar TreeSortable) {
 		rawCallback(SortableVar.GoPointer())
 	}
@@ -84,7 +87,8 @@ func (x *TreeSortableIface) OverrideGetSortColumnId(cb func(TreeSortable, *int,
 	if cb == nil {
 		x.xGetSortColumnId = 0
 	} else {
-		x.xGetSortColumnId = purego.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp *int, OrderVarp *SortType) bool {
+		x.xGetSortColumnId = core.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp *int, OrderVarp *SortType) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeSortableBase{Ptr: SortableVarp}, SortColumnIdVarp, OrderVarp)
 		})
 	}
@@ -99,7 +103,7 @@ func (x *TreeSortableIface) GetGetSortColumnId() func(TreeSortable, *int, *SortT
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr, SortColumnIdVarp *int, OrderVarp *SortType) bool
-	purego.RegisterFunc(&rawCallback, x.xGetSortColumnId)
+	core.RegisterFunc(&rawCallback, x.xGetSortColumnId)
 	return func(SortableVar TreeSortable, SortColumnIdVar *int, OrderVar *SortType) bool {
 		return rawCallback(SortableVar.GoPointer(), SortColumnIdVar, OrderVar)
 	}
@@ -113,7 +117,8 @@ func (x *TreeSortableIface) OverrideSetSortColumnId(cb func(TreeSortable, int, S
 	if cb == nil {
 		x.xSetSortColumnId = 0
 	} else {
-		x.xSetSortColumnId = purego.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp int, OrderVarp SortType) {
+		x.xSetSortColumnId = core.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp int, OrderVarp SortType) {
+			defer core.RecoverPanic()
 			cb(&TreeSortableBase{Ptr: SortableVarp}, SortColumnIdVarp, OrderVarp)
 		})
 	}
@@ -128,7 +133,7 @@ func (x *TreeSortableIface) GetSetSortColumnId() func(TreeSortable, int, SortTyp
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr, SortColumnIdVarp int, OrderVarp SortType)
-	purego.RegisterFunc(&rawCallback, x.xSetSortColumnId)
+	core.RegisterFunc(&rawCallback, x.xSetSortColumnId)
 	return func(SortableVar TreeSortable, SortColumnIdVar int, OrderVar SortType) {
 		rawCallback(SortableVar.GoPointer(), SortColumnIdVar, OrderVar)
 	}
@@ -142,7 +147,8 @@ func (x *TreeSortableIface) OverrideSetSortFunc(cb func(TreeSortable, int, *Tree
 	if cb == nil {
 		x.xSetSortFunc = 0
 	} else {
-		x.xSetSortFunc = purego.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp int, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+		x.xSetSortFunc = core.NewCallback(func(SortableVarp uintptr, SortColumnIdVarp int, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&TreeSortableBase{Ptr: SortableVarp}, SortColumnIdVarp, (*TreeIterCompareFunc)(unsafe.Pointer(SortFuncVarp)), UserDataVarp, (*glib.DestroyNotify)(unsafe.Pointer(DestroyVarp)))
 		})
 	}
@@ -157,7 +163,7 @@ func (x *TreeSortableIface) GetSetSortFunc() func(TreeSortable, int, *TreeIterCo
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr, SortColumnIdVarp int, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetSortFunc)
+	core.RegisterFunc(&rawCallback, x.xSetSortFunc)
 	return func(SortableVar TreeSortable, SortColumnIdVar int, SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 		rawCallback(SortableVar.GoPointer(), SortColumnIdVar, glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
 	}
@@ -171,7 +177,8 @@ func (x *TreeSortableIface) OverrideSetDefaultSortFunc(cb func(TreeSortable, *Tr
 	if cb == nil {
 		x.xSetDefaultSortFunc = 0
 	} else {
-		x.xSetDefaultSortFunc = purego.NewCallback(func(SortableVarp uintptr, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+		x.xSetDefaultSortFunc = core.NewCallback(func(SortableVarp uintptr, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(&TreeSortableBase{Ptr: SortableVarp}, (*TreeIterCompareFunc)(unsafe.Pointer(SortFuncVarp)), UserDataVarp, (*glib.DestroyNotify)(unsafe.Pointer(DestroyVarp)))
 		})
 	}
@@ -186,34 +193,35 @@ func (x *TreeSortableIface) GetSetDefaultSortFunc() func(TreeSortable, *TreeIter
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr, SortFuncVarp uintptr, UserDataVarp uintptr, DestroyVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetDefaultSortFunc)
+	core.RegisterFunc(&rawCallback, x.xSetDefaultSortFunc)
 	return func(SortableVar TreeSortable, SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 		rawCallback(SortableVar.GoPointer(), glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
 	}
 }
 
 // OverrideHasDefaultSortFunc sets the "has_default_sort_func" callback function.
-// %TRUE if the model has a default sort
+// true if the model has a default sort
 // function.
 func (x *TreeSortableIface) OverrideHasDefaultSortFunc(cb func(TreeSortable) bool) {
 	if cb == nil {
 		x.xHasDefaultSortFunc = 0
 	} else {
-		x.xHasDefaultSortFunc = purego.NewCallback(func(SortableVarp uintptr) bool {
+		x.xHasDefaultSortFunc = core.NewCallback(func(SortableVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(&TreeSortableBase{Ptr: SortableVarp})
 		})
 	}
 }
 
 // GetHasDefaultSortFunc gets the "has_default_sort_func" callback function.
-// %TRUE if the model has a default sort
+// true if the model has a default sort
 // function.
 func (x *TreeSortableIface) GetHasDefaultSortFunc() func(TreeSortable) bool {
 	if x.xHasDefaultSortFunc == 0 {
 		return nil
 	}
 	var rawCallback func(SortableVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xHasDefaultSortFunc)
+	core.RegisterFunc(&rawCallback, x.xHasDefaultSortFunc)
 	return func(SortableVar TreeSortable) bool {
 		return rawCallback(SortableVar.GoPointer())
 	}
@@ -224,6 +232,10 @@ func (x *TreeSortableIface) GetHasDefaultSortFunc() func(TreeSortable) bool {
 // `GtkTreeSortable` is an interface to be implemented by tree models which
 // support sorting. The `GtkTreeView` uses the methods provided by this interface
 // to sort the model.
+//
+// Deprecated: since 4.10. There is no replacement for this interface. You should
+//
+//	use [SortListModel] to wrap your list model instead
 type TreeSortable interface {
 	GoPointer() uintptr
 	SetGoPointer(uintptr)
@@ -256,65 +268,204 @@ func (x *TreeSortableBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Fills in @sort_column_id and @order with the current sort column and the
-// order. It returns %TRUE unless the @sort_column_id is
-// %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID or
-// %GTK_TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID.
+// ImplementTreeSortable adds the TreeSortable interface to the type instanceType registered by Go, its virtual functions call the methods
+// of the Go value that instance returns for an instance of the type, e.g. a value attached with gobject.SetData.
+// T must be a concrete type, e.g. a struct pointer, and may have any of the methods:
+//
+//   - SortColumnChanged()
+//   - GetSortColumnId(*int, *SortType) bool
+//   - SetSortColumnId(int, SortType)
+//   - SetSortFunc(int, *TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+//   - SetDefaultSortFunc(*TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+//   - HasDefaultSortFunc() bool
+//
+// The virtual functions whose method T does not have keep the default implementation of the interface, see TreeSortableIface.
+// Interfaces must be added before the first instance of the type is created.
+func ImplementTreeSortable[T any](instanceType types.GType, instance func(obj *gobject.Object) T) {
+	var zero T
+	gobject.ImplementInterface(instanceType, TreeSortableGLibType(), func(iface *TreeSortableIface) {
+		if _, ok := any(zero).(interface{ SortColumnChanged() }); ok {
+			iface.OverrideSortColumnChanged(func(self TreeSortable) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SortColumnChanged() })
+				impl.SortColumnChanged()
+			})
+		}
+		if _, ok := any(zero).(interface{ GetSortColumnId(*int, *SortType) bool }); ok {
+			iface.OverrideGetSortColumnId(func(self TreeSortable, arg0 *int, arg1 *SortType) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ GetSortColumnId(*int, *SortType) bool })
+				return impl.GetSortColumnId(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface{ SetSortColumnId(int, SortType) }); ok {
+			iface.OverrideSetSortColumnId(func(self TreeSortable, arg0 int, arg1 SortType) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ SetSortColumnId(int, SortType) })
+				impl.SetSortColumnId(arg0, arg1)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetSortFunc(int, *TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+		}); ok {
+			iface.OverrideSetSortFunc(func(self TreeSortable, arg0 int, arg1 *TreeIterCompareFunc, arg2 uintptr, arg3 *glib.DestroyNotify) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetSortFunc(int, *TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+				})
+				impl.SetSortFunc(arg0, arg1, arg2, arg3)
+			})
+		}
+		if _, ok := any(zero).(interface {
+			SetDefaultSortFunc(*TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+		}); ok {
+			iface.OverrideSetDefaultSortFunc(func(self TreeSortable, arg0 *TreeIterCompareFunc, arg1 uintptr, arg2 *glib.DestroyNotify) {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface {
+					SetDefaultSortFunc(*TreeIterCompareFunc, uintptr, *glib.DestroyNotify)
+				})
+				impl.SetDefaultSortFunc(arg0, arg1, arg2)
+			})
+		}
+		if _, ok := any(zero).(interface{ HasDefaultSortFunc() bool }); ok {
+			iface.OverrideHasDefaultSortFunc(func(self TreeSortable) bool {
+				impl := any(instance(&gobject.Object{Ptr: self.GoPointer()})).(interface{ HasDefaultSortFunc() bool })
+				return impl.HasDefaultSortFunc()
+			})
+		}
+	})
+}
+
+// Fills in sort_column_id and order with the current sort column and the
+// order. It returns true unless the sort_column_id is
+// [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID] or
+// [TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID].
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) GetSortColumnId(SortColumnIdVar *int, OrderVar *SortType) bool {
 
 	cret := XGtkTreeSortableGetSortColumnId(x.GoPointer(), SortColumnIdVar, OrderVar)
 	return cret
 }
 
-// Returns %TRUE if the model has a default sort function. This is used
+// Returns true if the model has a default sort function. This is used
 // primarily by GtkTreeViewColumns in order to determine if a model can
 // go back to the default state, or not.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) HasDefaultSortFunc() bool {
 
 	cret := XGtkTreeSortableHasDefaultSortFunc(x.GoPointer())
 	return cret
 }
 
-// Sets the default comparison function used when sorting to be @sort_func.
-// If the current sort column id of @sortable is
-// %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID, then the model will sort using
+// Sets the default comparison function used when sorting to be sort_func.
+// If the current sort column id of sortable is
+// [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID], then the model will sort using
 // this function.
 //
-// If @sort_func is %NULL, then there will be no default comparison function.
+// If sort_func is nil, then there will be no default comparison function.
 // This means that once the model  has been sorted, it can’t go back to the
-// default state. In this case, when the current sort column id of @sortable
-// is %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID, the model will be unsorted.
+// default state. In this case, when the current sort column id of sortable
+// is [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID], the model will be unsorted.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) SetDefaultSortFunc(SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
-	XGtkTreeSortableSetDefaultSortFunc(x.GoPointer(), glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
+	var SortFuncVarRef uintptr
+	if SortFuncVar != nil {
+		SortFuncVarPtr := uintptr(unsafe.Pointer(SortFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(SortFuncVarPtr); ok {
+			SortFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 *TreeIter, arg3 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *SortFuncVar
+				return cbFn(arg0, arg1, arg2, arg3)
+			}
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	XGtkTreeSortableSetDefaultSortFunc(x.GoPointer(), SortFuncVarRef, UserDataVar, DestroyVarRef)
 
 }
 
-// Sets the current sort column to be @sort_column_id. The @sortable will
+// Sets the current sort column to be sort_column_id. The sortable will
 // resort itself to reflect this change, after emitting a
-// `GtkTreeSortable::sort-column-changed` signal. @sort_column_id may either be
+// `GtkTreeSortable::sort-column-changed` signal. sort_column_id may either be
 // a regular column id, or one of the following special values:
 //
-//   - %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID: the default sort function
+//   - [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID]: the default sort function
 //     will be used, if it is set
 //
-// - %GTK_TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID: no sorting will occur
+// - [TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID]: no sorting will occur
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) SetSortColumnId(SortColumnIdVar int, OrderVar SortType) {
 
 	XGtkTreeSortableSetSortColumnId(x.GoPointer(), SortColumnIdVar, OrderVar)
 
 }
 
-// Sets the comparison function used when sorting to be @sort_func. If the
-// current sort column id of @sortable is the same as @sort_column_id, then
+// Sets the comparison function used when sorting to be sort_func. If the
+// current sort column id of sortable is the same as sort_column_id, then
 // the model will sort using this function.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) SetSortFunc(SortColumnIdVar int, SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
-	XGtkTreeSortableSetSortFunc(x.GoPointer(), SortColumnIdVar, glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
+	var SortFuncVarRef uintptr
+	if SortFuncVar != nil {
+		SortFuncVarPtr := uintptr(unsafe.Pointer(SortFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(SortFuncVarPtr); ok {
+			SortFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 *TreeIter, arg3 uintptr) int {
+				defer core.RecoverPanic()
+				cbFn := *SortFuncVar
+				return cbFn(arg0, arg1, arg2, arg3)
+			}
+			SortFuncVarRef = core.NewCallback(fcb)
+			SortFuncVarRef = glib.SaveCallbackWithClosure(SortFuncVarPtr, SortFuncVarRef, SortFuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	XGtkTreeSortableSetSortFunc(x.GoPointer(), SortColumnIdVar, SortFuncVarRef, UserDataVar, DestroyVarRef)
 
 }
 
-// Emits a `GtkTreeSortable::sort-column-changed` signal on @sortable.
+// Emits a `GtkTreeSortable::sort-column-changed` signal on sortable.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeSortableBase) SortColumnChanged() {
 
 	XGtkTreeSortableSortColumnChanged(x.GoPointer())
@@ -329,13 +480,17 @@ var XGtkTreeSortableSetSortFunc func(uintptr, int, uintptr, uintptr, uintptr)
 var XGtkTreeSortableSortColumnChanged func(uintptr)
 
 const (
-	// Uses the default sort function in a [iface@Gtk.TreeSortable].
+	// Uses the default sort function in a [TreeSortable].
+	//
+	// See also: [TreeSortable.SetSortColumnId]
 	//
-	// See also: [method@Gtk.TreeSortable.set_sort_column_id]
+	// Deprecated: since 4.20. There is no replacement
 	TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID int = -1
-	// Disables sorting in a [iface@Gtk.TreeSortable].
+	// Disables sorting in a [TreeSortable].
 	//
-	// See also: [method@Gtk.TreeSortable.set_sort_column_id]
+	// See also: [TreeSortable.SetSortColumnId]
+	//
+	// Deprecated: since 4.20. There is no replacement
 	TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID int = -2
 )
 
@@ -344,7 +499,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -353,11 +508,13 @@ func init() {
 
 	core.PuregoSafeRegister(&xTreeSortableGLibType, libs, "gtk_tree_sortable_get_type")
 
-	core.PuregoSafeRegister(&XGtkTreeSortableGetSortColumnId, libs, "gtk_tree_sortable_get_sort_column_id")
-	core.PuregoSafeRegister(&XGtkTreeSortableHasDefaultSortFunc, libs, "gtk_tree_sortable_has_default_sort_func")
-	core.PuregoSafeRegister(&XGtkTreeSortableSetDefaultSortFunc, libs, "gtk_tree_sortable_set_default_sort_func")
-	core.PuregoSafeRegister(&XGtkTreeSortableSetSortColumnId, libs, "gtk_tree_sortable_set_sort_column_id")
-	core.PuregoSafeRegister(&XGtkTreeSortableSetSortFunc, libs, "gtk_tree_sortable_set_sort_func")
-	core.PuregoSafeRegister(&XGtkTreeSortableSortColumnChanged, libs, "gtk_tree_sortable_sort_column_changed")
+	gobject.CheckClassSize("GtkTreeSortableIface", xTreeSortableGLibType, unsafe.Sizeof(TreeSortableIface{}))
+
+	core.PuregoRegisterSince(&XGtkTreeSortableGetSortColumnId, libs, "gtk_tree_sortable_get_sort_column_id", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeSortableHasDefaultSortFunc, libs, "gtk_tree_sortable_has_default_sort_func", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeSortableSetDefaultSortFunc, libs, "gtk_tree_sortable_set_default_sort_func", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeSortableSetSortColumnId, libs, "gtk_tree_sortable_set_sort_column_id", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeSortableSetSortFunc, libs, "gtk_tree_sortable_set_sort_func", "GTK", "")
+	core.PuregoRegisterSince(&XGtkTreeSortableSortColumnChanged, libs, "gtk_tree_sortable_sort_column_changed", "GTK", "")
 
 }
@@ -5,8 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -33,39 +31,37 @@ func (x *TreeStorePrivate) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
-// A tree-like data structure that can be used with the [class@Gtk.TreeView].
+// A tree-like data structure that can be used with the [TreeView].
 //
 // The `GtkTreeStore` object is a list model for use with a `GtkTreeView`
-// widget. It implements the [iface@Gtk.TreeModel] interface, and consequently,
+// widget. It implements the [TreeModel] interface, and consequently,
 // can use all of the methods available there. It also implements the
-// [iface@Gtk.TreeSortable] interface so it can be sorted by the view.
-// Finally, it also implements the tree [drag][iface@Gtk.TreeDragSource]
-// and [drop][iface@Gtk.TreeDragDest] interfaces.
+// [TreeSortable] interface so it can be sorted by the view.
+// Finally, it also implements the tree [drag][TreeDragSource]
+// and [drop][TreeDragDest] interfaces.
 //
 // `GtkTreeStore` is deprecated since GTK 4.10, and should not be used in newly
-// written code. You should use [class@Gtk.TreeListModel] for a tree-like model
+// written code. You should use [TreeListModel] for a tree-like model
 // object.
 //
-// ## GtkTreeStore as GtkBuildable
+// # GtkTreeStore as GtkBuildable
 //
 // The GtkTreeStore implementation of the `GtkBuildable` interface allows
-// to specify the model columns with a `&lt;columns&gt;` element that may contain
-// multiple `&lt;column&gt;` elements, each specifying one model column. The “type”
+// to specify the model columns with a `<columns>` element that may contain
+// multiple `<column>` elements, each specifying one model column. The “type”
 // attribute specifies the data type for the column.
 //
 // An example of a UI Definition fragment for a tree store:
 //
-// ```xml
-// &lt;object class="GtkTreeStore"&gt;
+//	<object class="GtkTreeStore">
+//	  <columns>
+//	    <column type="gchararray"/>
+//	    <column type="gchararray"/>
+//	    <column type="gint"/>
+//	  </columns>
+//	</object>
 //
-//	&lt;columns&gt;
-//	  &lt;column type="gchararray"/&gt;
-//	  &lt;column type="gchararray"/&gt;
-//	  &lt;column type="gint"/&gt;
-//	&lt;/columns&gt;
-//
-// &lt;/object&gt;
-// ```
+// Deprecated: since 4.10. Use [TreeListModel] instead
 type TreeStore struct {
 	gobject.Object
 }
@@ -82,376 +78,6 @@ func TreeStoreNewFromInternalPtr(ptr uintptr) *TreeStore {
 	return cls
 }
 
-var xNewTreeStore func(int, ...interface{}) uintptr
-
-// Creates a new tree store.
-//
-// The tree store will have @n_columns, with each column using the
-// corresponding type passed to this function.
-//
-// Note that only types derived from standard GObject fundamental types
-// are supported.
-//
-// As an example:
-//
-// ```c
-// gtk_tree_store_new (3, G_TYPE_INT, G_TYPE_STRING, GDK_TYPE_TEXTURE);
-// ```
-//
-// will create a new `GtkTreeStore` with three columns of type
-// `int`, `gchararray`, and `GdkTexture` respectively.
-func NewTreeStore(NColumnsVar int, varArgs ...interface{}) *TreeStore {
-	var cls *TreeStore
-
-	cret := xNewTreeStore(NColumnsVar, varArgs...)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &TreeStore{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xNewTreeStorev func(int, []types.GType) uintptr
-
-// Creates a new tree store.
-//
-// This constructor is meant for language bindings.
-func NewTreeStorev(NColumnsVar int, TypesVar []types.GType) *TreeStore {
-	var cls *TreeStore
-
-	cret := xNewTreeStorev(NColumnsVar, TypesVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &TreeStore{}
-	cls.Ptr = cret
-	return cls
-}
-
-var xTreeStoreAppend func(uintptr, *TreeIter, *TreeIter)
-
-// Appends a new row to @tree_store.
-//
-// If @parent is non-%NULL, then it will append the new row after the last
-// child of @parent, otherwise it will append a row to the top level.
-//
-// The @iter parameter will be changed to point to this new row. The row will
-// be empty after this function is called. To fill in values, you need to call
-// gtk_tree_store_set() or gtk_tree_store_set_value().
-func (x *TreeStore) Append(IterVar *TreeIter, ParentVar *TreeIter) {
-
-	xTreeStoreAppend(x.GoPointer(), IterVar, ParentVar)
-
-}
-
-var xTreeStoreClear func(uintptr)
-
-// Removes all rows from @tree_store
-func (x *TreeStore) Clear() {
-
-	xTreeStoreClear(x.GoPointer())
-
-}
-
-var xTreeStoreInsert func(uintptr, *TreeIter, *TreeIter, int)
-
-// Creates a new row at @position.
-//
-// If parent is non-%NULL, then the row will be made a child of @parent.
-// Otherwise, the row will be created at the toplevel.
-//
-// If @position is `-1` or is larger than the number of rows at that level,
-// then the new row will be inserted to the end of the list.
-//
-// The @iter parameter will be changed to point to this new row. The row
-// will be empty after this function is called. To fill in values, you
-// need to call gtk_tree_store_set() or gtk_tree_store_set_value().
-func (x *TreeStore) Insert(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int) {
-
-	xTreeStoreInsert(x.GoPointer(), IterVar, ParentVar, PositionVar)
-
-}
-
-var xTreeStoreInsertAfter func(uintptr, *TreeIter, *TreeIter, *TreeIter)
-
-// Inserts a new row after @sibling.
-//
-// If @sibling is %NULL, then the row will be prepended to @parent’s children.
-//
-// If @parent and @sibling are %NULL, then the row will be prepended to the
-// toplevel.
-//
-// If both @sibling and @parent are set, then @parent must be the parent
-// of @sibling. When @sibling is set, @parent is optional.
-//
-// The @iter parameter will be changed to point to this new row. The row will
-// be empty after this function is called. To fill in values, you need to call
-// gtk_tree_store_set() or gtk_tree_store_set_value().
-func (x *TreeStore) InsertAfter(IterVar *TreeIter, ParentVar *TreeIter, SiblingVar *TreeIter) {
-
-	xTreeStoreInsertAfter(x.GoPointer(), IterVar, ParentVar, SiblingVar)
-
-}
-
-var xTreeStoreInsertBefore func(uintptr, *TreeIter, *TreeIter, *TreeIter)
-
-// Inserts a new row before @sibling.
-//
-// If @sibling is %NULL, then the row will be appended to @parent’s children.
-//
-// If @parent and @sibling are %NULL, then the row will be appended to the
-// toplevel.
-//
-// If both @sibling and @parent are set, then @parent must be the parent
-// of @sibling. When @sibling is set, @parent is optional.
-//
-// The @iter parameter will be changed to point to this new row. The row will
-// be empty after this function is called. To fill in values, you need to call
-// gtk_tree_store_set() or gtk_tree_store_set_value().
-func (x *TreeStore) InsertBefore(IterVar *TreeIter, ParentVar *TreeIter, SiblingVar *TreeIter) {
-
-	xTreeStoreInsertBefore(x.GoPointer(), IterVar, ParentVar, SiblingVar)
-
-}
-
-var xTreeStoreInsertWithValues func(uintptr, *TreeIter, *TreeIter, int, ...interface{})
-
-// Creates a new row at the given @position.
-//
-// The @iter parameter will be changed to point to this  new row.
-//
-// If @position is -1, or larger than the number of rows on the list, then
-// the new row will be appended to the list. The row will be filled with
-// the values given to this function.
-//
-// Calling
-//
-//	gtk_tree_store_insert_with_values (tree_store, iter, position, ...)
-//
-// has the same effect as calling
-//
-// ```c
-// gtk_tree_store_insert (tree_store, iter, position);
-// gtk_tree_store_set (tree_store, iter, ...);
-// ```
-//
-// with the different that the former will only emit a row_inserted signal,
-// while the latter will emit row_inserted, row_changed and if the tree store
-// is sorted, rows_reordered.
-//
-// Since emitting the rows_reordered signal repeatedly can affect the
-// performance of the program, gtk_tree_store_insert_with_values() should
-// generally be preferred when inserting rows in a sorted tree store.
-func (x *TreeStore) InsertWithValues(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int, varArgs ...interface{}) {
-
-	xTreeStoreInsertWithValues(x.GoPointer(), IterVar, ParentVar, PositionVar, varArgs...)
-
-}
-
-var xTreeStoreInsertWithValuesv func(uintptr, *TreeIter, *TreeIter, int, []int, []gobject.Value, int)
-
-// A variant of gtk_tree_store_insert_with_values() which takes
-// the columns and values as two arrays, instead of varargs.
-//
-// This function is mainly intended for language bindings.
-func (x *TreeStore) InsertWithValuesv(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
-
-	xTreeStoreInsertWithValuesv(x.GoPointer(), IterVar, ParentVar, PositionVar, ColumnsVar, ValuesVar, NValuesVar)
-
-}
-
-var xTreeStoreIsAncestor func(uintptr, *TreeIter, *TreeIter) bool
-
-// Checks if @iter is an ancestor of @descendant.
-func (x *TreeStore) IsAncestor(IterVar *TreeIter, DescendantVar *TreeIter) bool {
-
-	cret := xTreeStoreIsAncestor(x.GoPointer(), IterVar, DescendantVar)
-	return cret
-}
-
-var xTreeStoreIterDepth func(uintptr, *TreeIter) int
-
-// Returns the depth of the position pointed by the iterator
-//
-// The depth will be 0 for anything on the root level, 1 for anything down
-// a level, etc.
-func (x *TreeStore) IterDepth(IterVar *TreeIter) int {
-
-	cret := xTreeStoreIterDepth(x.GoPointer(), IterVar)
-	return cret
-}
-
-var xTreeStoreIterIsValid func(uintptr, *TreeIter) bool
-
-// Checks if the given iter is a valid iter for this `GtkTreeStore`.
-//
-// This function is slow. Only use it for debugging and/or testing
-// purposes.
-func (x *TreeStore) IterIsValid(IterVar *TreeIter) bool {
-
-	cret := xTreeStoreIterIsValid(x.GoPointer(), IterVar)
-	return cret
-}
-
-var xTreeStoreMoveAfter func(uintptr, *TreeIter, *TreeIter)
-
-// Moves @iter in @tree_store to the position after @position.
-//
-// @iter and @position should be in the same level.
-//
-// Note that this function only works with unsorted stores.
-//
-// If @position is %NULL, @iter will be moved to the start of the level.
-func (x *TreeStore) MoveAfter(IterVar *TreeIter, PositionVar *TreeIter) {
-
-	xTreeStoreMoveAfter(x.GoPointer(), IterVar, PositionVar)
-
-}
-
-var xTreeStoreMoveBefore func(uintptr, *TreeIter, *TreeIter)
-
-// Moves @iter in @tree_store to the position before @position.
-//
-// @iter and @position should be in the same level.
-//
-// Note that this function only works with unsorted stores.
-//
-// If @position is %NULL, @iter will be moved to the end of the level.
-func (x *TreeStore) MoveBefore(IterVar *TreeIter, PositionVar *TreeIter) {
-
-	xTreeStoreMoveBefore(x.GoPointer(), IterVar, PositionVar)
-
-}
-
-var xTreeStorePrepend func(uintptr, *TreeIter, *TreeIter)
-
-// Prepends a new row to @tree_store.
-//
-// If @parent is non-%NULL, then it will prepend the new row before the first
-// child of @parent, otherwise it will prepend a row to the top level. The
-// `iter` parameter will be changed to point to this new row.  The row will
-// be empty after this function is called. To fill in values, you need to
-// call gtk_tree_store_set() or gtk_tree_store_set_value().
-func (x *TreeStore) Prepend(IterVar *TreeIter, ParentVar *TreeIter) {
-
-	xTreeStorePrepend(x.GoPointer(), IterVar, ParentVar)
-
-}
-
-var xTreeStoreRemove func(uintptr, *TreeIter) bool
-
-// Removes @iter from @tree_store.
-//
-// After being removed, @iter is set to the next valid row at that level, or
-// invalidated if it previously pointed to the last one.
-func (x *TreeStore) Remove(IterVar *TreeIter) bool {
-
-	cret := xTreeStoreRemove(x.GoPointer(), IterVar)
-	return cret
-}
-
-var xTreeStoreReorder func(uintptr, *TreeIter, []int)
-
-// Reorders the children of @parent in @tree_store to follow the order
-// indicated by @new_order.
-//
-// Note that this function only works with unsorted stores.
-func (x *TreeStore) Reorder(ParentVar *TreeIter, NewOrderVar []int) {
-
-	xTreeStoreReorder(x.GoPointer(), ParentVar, NewOrderVar)
-
-}
-
-var xTreeStoreSet func(uintptr, *TreeIter, ...interface{})
-
-// Sets the value of one or more cells in the row referenced by @iter.
-//
-// The variable argument list should contain integer column numbers,
-// each column number followed by the value to be set.
-//
-// The list is terminated by a value of `-1`.
-//
-// For example, to set column 0 with type `G_TYPE_STRING` to “Foo”, you would
-// write
-//
-// ```c
-// gtk_tree_store_set (store, iter, 0, "Foo", -1);
-// ```
-//
-// The value will be referenced by the store if it is a `G_TYPE_OBJECT`, and it
-// will be copied if it is a `G_TYPE_STRING` or `G_TYPE_BOXED`.
-func (x *TreeStore) Set(IterVar *TreeIter, varArgs ...interface{}) {
-
-	xTreeStoreSet(x.GoPointer(), IterVar, varArgs...)
-
-}
-
-var xTreeStoreSetColumnTypes func(uintptr, int, []types.GType)
-
-// Sets the type of the columns in a tree store.
-//
-// This function is meant primarily for types that inherit from
-// `GtkTreeStore`, and should only be used when constructing a new
-// `GtkTreeStore`.
-//
-// This functions cannot be called after a row has been added,
-// or a method on the `GtkTreeModel` interface is called on the
-// tree store.
-func (x *TreeStore) SetColumnTypes(NColumnsVar int, TypesVar []types.GType) {
-
-	xTreeStoreSetColumnTypes(x.GoPointer(), NColumnsVar, TypesVar)
-
-}
-
-var xTreeStoreSetValist func(uintptr, *TreeIter, []interface{})
-
-// A version of gtk_tree_store_set() using `va_list`.
-func (x *TreeStore) SetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
-
-	xTreeStoreSetValist(x.GoPointer(), IterVar, VarArgsVar)
-
-}
-
-var xTreeStoreSetValue func(uintptr, *TreeIter, int, *gobject.Value)
-
-// Sets the data in the cell specified by @iter and @column.
-//
-// The type of @value must be convertible to the type of the
-// column.
-func (x *TreeStore) SetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
-
-	xTreeStoreSetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
-
-}
-
-var xTreeStoreSetValuesv func(uintptr, *TreeIter, []int, []gobject.Value, int)
-
-// A variant of gtk_tree_store_set_valist() which takes
-// the columns and values as two arrays, instead of using variadic
-// arguments.
-//
-// This function is mainly intended for language bindings or in case
-// the number of columns to change is not known until run-time.
-func (x *TreeStore) SetValuesv(IterVar *TreeIter, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
-
-	xTreeStoreSetValuesv(x.GoPointer(), IterVar, ColumnsVar, ValuesVar, NValuesVar)
-
-}
-
-var xTreeStoreSwap func(uintptr, *TreeIter, *TreeIter)
-
-// Swaps @a and @b in the same level of @tree_store.
-//
-// Note that this function only works with unsorted stores.
-func (x *TreeStore) Swap(AVar *TreeIter, BVar *TreeIter) {
-
-	xTreeStoreSwap(x.GoPointer(), AVar, BVar)
-
-}
-
 func (c *TreeStore) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -463,44 +89,50 @@ func (c *TreeStore) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *TreeStore) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Asks the `GtkTreeDragDest` to insert a row before the path @dest,
-// deriving the contents of the row from @value. If @dest is
-// outside the tree so that inserting before it is impossible, %FALSE
-// will be returned. Also, %FALSE may be returned if the new row is
+// Asks the `GtkTreeDragDest` to insert a row before the path dest,
+// deriving the contents of the row from value. If dest is
+// outside the tree so that inserting before it is impossible, false
+// will be returned. Also, false may be returned if the new row is
 // not created for some model-specific reason.  Should robustly handle
-// a @dest no longer found in the model!
+// a dest no longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeStore) DragDataReceived(DestVar *TreePath, ValueVar *gobject.Value) bool {
 
 	cret := XGtkTreeDragDestDragDataReceived(x.GoPointer(), DestVar, ValueVar)
 	return cret
 }
 
-// Determines whether a drop is possible before the given @dest_path,
-// at the same depth as @dest_path. i.e., can we drop the data in
-// @value at that location. @dest_path does not have to
-// exist; the return value will almost certainly be %FALSE if the
-// parent of @dest_path doesn’t exist, though.
+// Determines whether a drop is possible before the given dest_path,
+// at the same depth as dest_path. i.e., can we drop the data in
+// value at that location. dest_path does not have to
+// exist; the return value will almost certainly be false if the
+// parent of dest_path doesn’t exist, though.
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeStore) RowDropPossible(DestPathVar *TreePath, ValueVar *gobject.Value) bool {
 
 	cret := XGtkTreeDragDestRowDropPossible(x.GoPointer(), DestPathVar, ValueVar)
 	return cret
 }
 
-// Asks the `GtkTreeDragSource` to delete the row at @path, because
-// it was moved somewhere else via drag-and-drop. Returns %FALSE
-// if the deletion fails because @path no longer exists, or for
-// some model-specific reason. Should robustly handle a @path no
+// Asks the `GtkTreeDragSource` to delete the row at path, because
+// it was moved somewhere else via drag-and-drop. Returns false
+// if the deletion fails because path no longer exists, or for
+// some model-specific reason. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeStore) DragDataDelete(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceDragDataDelete(x.GoPointer(), PathVar)
@@ -508,8 +140,10 @@ func (x *TreeStore) DragDataDelete(PathVar *TreePath) bool {
 }
 
 // Asks the `GtkTreeDragSource` to return a `GdkContentProvider` representing
-// the row at @path. Should robustly handle a @path no
+// the row at path. Should robustly handle a path no
 // longer found in the model!
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeStore) DragDataGet(PathVar *TreePath) *gdk.ContentProvider {
 	var cls *gdk.ContentProvider
 
@@ -526,14 +160,18 @@ func (x *TreeStore) DragDataGet(PathVar *TreePath) *gdk.ContentProvider {
 // Asks the `GtkTreeDragSource` whether a particular row can be used as
 // the source of a DND operation. If the source doesn’t implement
 // this interface, the row is assumed draggable.
+//
+// Deprecated: since 4.10. Use list models instead
 func (x *TreeStore) RowDraggable(PathVar *TreePath) bool {
 
 	cret := XGtkTreeDragSourceRowDraggable(x.GoPointer(), PathVar)
 	return cret
 }
 
-// Creates a new `GtkTreeModel`, with @child_model as the child_model
-// and @root as the virtual root.
+// Creates a new `GtkTreeModel`, with child_model as the child_model
+// and root as the virtual root.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) FilterNew(RootVar *TreePath) *TreeModelBase {
 	var cls *TreeModelBase
 
@@ -547,29 +185,33 @@ func (x *TreeStore) FilterNew(RootVar *TreePath) *TreeModelBase {
 	return cls
 }
 
-// Calls @func on each node in model in a depth-first fashion.
+// Calls func on each node in model in a depth-first fashion.
 //
-// If @func returns %TRUE, then the tree ceases to be walked,
-// and gtk_tree_model_foreach() returns.
+// If func returns true, then the tree ceases to be walked,
+// and [TreeModel.Foreach] returns.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) Foreach(FuncVar *TreeModelForeachFunc, UserDataVar uintptr) {
 
 	XGtkTreeModelForeach(x.GoPointer(), glib.NewCallback(FuncVar), UserDataVar)
 
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
 // The variable argument list should contain integer column numbers,
 // each column number followed by a place to store the value being
 // retrieved.  The list is terminated by a -1. For example, to get a
-// value from column 0 with type %G_TYPE_STRING, you would
-// write: `gtk_tree_model_get (model, iter, 0, &amp;place_string_here, -1)`,
+// value from column 0 with type G_TYPE_STRING, you would
+// write: `gtk_tree_model_get (model, iter, 0, &place_string_here, -1)`,
 // where `place_string_here` is a #gchararray
 // to be filled with the string.
 //
-// Returned values with type %G_TYPE_OBJECT have to be unreferenced,
-// values with type %G_TYPE_STRING or %G_TYPE_BOXED have to be freed.
+// Returned values with type G_TYPE_OBJECT have to be unreferenced,
+// values with type G_TYPE_STRING or G_TYPE_BOXED have to be freed.
 // Other values are passed by value.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) Get(IterVar *TreeIter, varArgs ...interface{}) {
 
 	XGtkTreeModelGet(x.GoPointer(), IterVar, varArgs...)
@@ -577,6 +219,8 @@ func (x *TreeStore) Get(IterVar *TreeIter, varArgs ...interface{}) {
 }
 
 // Returns the type of the column.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetColumnType(IndexVar int) types.GType {
 
 	cret := XGtkTreeModelGetColumnType(x.GoPointer(), IndexVar)
@@ -587,53 +231,65 @@ func (x *TreeStore) GetColumnType(IndexVar int) types.GType {
 //
 // The flags are a bitwise combination of `GtkTreeModel`Flags.
 // The flags supported should not change during the lifetime
-// of the @tree_model.
+// of the tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetFlags() TreeModelFlags {
 
 	cret := XGtkTreeModelGetFlags(x.GoPointer())
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path.
+// Sets iter to a valid iterator pointing to path.
+//
+// If path does not exist, iter is set to an invalid
+// iterator and false is returned.
 //
-// If @path does not exist, @iter is set to an invalid
-// iterator and %FALSE is returned.
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetIter(IterVar *TreeIter, PathVar *TreePath) bool {
 
 	cret := XGtkTreeModelGetIter(x.GoPointer(), IterVar, PathVar)
 	return cret
 }
 
-// Initializes @iter with the first iterator in the tree
+// Initializes iter with the first iterator in the tree
 // (the one at the path "0").
 //
-// Returns %FALSE if the tree is empty, %TRUE otherwise.
+// Returns false if the tree is empty, true otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetIterFirst(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelGetIterFirst(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to a valid iterator pointing to @path_string, if it
+// Sets iter to a valid iterator pointing to path_string, if it
 // exists.
 //
-// Otherwise, @iter is left invalid and %FALSE is returned.
+// Otherwise, iter is left invalid and false is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetIterFromString(IterVar *TreeIter, PathStringVar string) bool {
 
 	cret := XGtkTreeModelGetIterFromString(x.GoPointer(), IterVar, PathStringVar)
 	return cret
 }
 
-// Returns the number of columns supported by @tree_model.
+// Returns the number of columns supported by tree_model.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetNColumns() int {
 
 	cret := XGtkTreeModelGetNColumns(x.GoPointer())
 	return cret
 }
 
-// Returns a newly-created `GtkTreePath` referenced by @iter.
+// Returns a newly-created `GtkTreePath` referenced by iter.
+//
+// This path should be freed with [TreePath.Free].
 //
-// This path should be freed with gtk_tree_path_free().
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetPath(IterVar *TreeIter) *TreePath {
 
 	cret := XGtkTreeModelGetPath(x.GoPointer(), IterVar)
@@ -645,105 +301,125 @@ func (x *TreeStore) GetPath(IterVar *TreeIter) *TreePath {
 // This string is a “:” separated list of numbers.
 // For example, “4:10:0:3” would be an acceptable
 // return value for this string.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetStringFromIter(IterVar *TreeIter) string {
 
 	cret := XGtkTreeModelGetStringFromIter(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Gets the value of one or more cells in the row referenced by @iter.
+// Gets the value of one or more cells in the row referenced by iter.
 //
-// See [method@Gtk.TreeModel.get], this version takes a va_list
+// See [TreeModel.Get], this version takes a va_list
 // for language bindings to use.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
 
 	XGtkTreeModelGetValist(x.GoPointer(), IterVar, VarArgsVar)
 
 }
 
-// Initializes and sets @value to that at @column.
+// Initializes and sets value to that at column.
 //
-// When done with @value, g_value_unset() needs to be called
+// When done with value, [gobject.Value.Unset] needs to be called
 // to free any allocated memory.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
 
 	XGtkTreeModelGetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
 
 }
 
-// Sets @iter to point to the first child of @parent.
+// Sets iter to point to the first child of parent.
 //
-// If @parent has no children, %FALSE is returned and @iter is
-// set to be invalid. @parent will remain a valid node after this
+// If parent has no children, false is returned and iter is
+// set to be invalid. parent will remain a valid node after this
 // function has been called.
 //
-// If @parent is %NULL returns the first node, equivalent to
+// If parent is nil returns the first node, equivalent to
 // `gtk_tree_model_get_iter_first (tree_model, iter);`
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterChildren(IterVar *TreeIter, ParentVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterChildren(x.GoPointer(), IterVar, ParentVar)
 	return cret
 }
 
-// Returns %TRUE if @iter has children, %FALSE otherwise.
+// Returns true if iter has children, false otherwise.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterHasChild(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterHasChild(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Returns the number of children that @iter has.
+// Returns the number of children that iter has.
 //
-// As a special case, if @iter is %NULL, then the number
+// As a special case, if iter is nil, then the number
 // of toplevel nodes is returned.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterNChildren(IterVar *TreeIter) int {
 
 	cret := XGtkTreeModelIterNChildren(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to point to the node following it at the current level.
+// Sets iter to point to the node following it at the current level.
 //
-// If there is no next @iter, %FALSE is returned and @iter is set
+// If there is no next iter, false is returned and iter is set
 // to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterNext(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterNext(x.GoPointer(), IterVar)
 	return cret
 }
 
-// Sets @iter to be the child of @parent, using the given index.
+// Sets iter to be the child of parent, using the given index.
 //
-// The first index is 0. If @n is too big, or @parent has no children,
-// @iter is set to an invalid iterator and %FALSE is returned. @parent
+// The first index is 0. If n is too big, or parent has no children,
+// iter is set to an invalid iterator and false is returned. parent
 // will remain a valid node after this function has been called. As a
-// special case, if @parent is %NULL, then the @n-th root node
+// special case, if parent is nil, then the n-th root node
 // is set.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterNthChild(IterVar *TreeIter, ParentVar *TreeIter, NVar int) bool {
 
 	cret := XGtkTreeModelIterNthChild(x.GoPointer(), IterVar, ParentVar, NVar)
 	return cret
 }
 
-// Sets @iter to be the parent of @child.
+// Sets iter to be the parent of child.
 //
-// If @child is at the toplevel, and doesn’t have a parent, then
-// @iter is set to an invalid iterator and %FALSE is returned.
-// @child will remain a valid node after this function has been
+// If child is at the toplevel, and doesn’t have a parent, then
+// iter is set to an invalid iterator and false is returned.
+// child will remain a valid node after this function has been
 // called.
 //
-// @iter will be initialized before the lookup is performed, so @child
-// and @iter cannot point to the same memory location.
+// iter will be initialized before the lookup is performed, so child
+// and iter cannot point to the same memory location.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterParent(IterVar *TreeIter, ChildVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterParent(x.GoPointer(), IterVar, ChildVar)
 	return cret
 }
 
-// Sets @iter to point to the previous node at the current level.
+// Sets iter to point to the previous node at the current level.
 //
-// If there is no previous @iter, %FALSE is returned and @iter is
+// If there is no previous iter, false is returned and iter is
 // set to be invalid.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) IterPrevious(IterVar *TreeIter) bool {
 
 	cret := XGtkTreeModelIterPrevious(x.GoPointer(), IterVar)
@@ -767,76 +443,92 @@ func (x *TreeStore) IterPrevious(IterVar *TreeIter) bool {
 //
 // A model should be expected to be able to get an iter independent
 // of its reffed state.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelRefNode(x.GoPointer(), IterVar)
 
 }
 
-// Emits the ::row-changed signal on @tree_model.
+// Emits the ::row-changed signal on tree_model.
+//
+// See TreeModel::row-changed.
 //
-// See [signal@Gtk.TreeModel::row-changed].
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RowChanged(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowChanged(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-deleted signal on @tree_model.
+// Emits the ::row-deleted signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-deleted].
+// See TreeModel::row-deleted.
 //
 // This should be called by models after a row has been removed.
-// The location pointed to by @path should be the location that
+// The location pointed to by path should be the location that
 // the row previously was at. It may not be a valid location anymore.
 //
 // Nodes that are deleted are not unreffed, this means that any
 // outstanding references on the deleted node should not be released.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RowDeleted(PathVar *TreePath) {
 
 	XGtkTreeModelRowDeleted(x.GoPointer(), PathVar)
 
 }
 
-// Emits the ::row-has-child-toggled signal on @tree_model.
+// Emits the ::row-has-child-toggled signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-has-child-toggled].
+// See TreeModel::row-has-child-toggled.
 //
 // This should be called by models after the child
 // state of a node changes.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RowHasChildToggled(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowHasChildToggled(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::row-inserted signal on @tree_model.
+// Emits the ::row-inserted signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::row-inserted].
+// See TreeModel::row-inserted.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RowInserted(PathVar *TreePath, IterVar *TreeIter) {
 
 	XGtkTreeModelRowInserted(x.GoPointer(), PathVar, IterVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
+//
+// See [RowsReorderedWithLength] for the version of this function that language bindings are meant to use.
 func (x *TreeStore) RowsReordered(PathVar *TreePath, IterVar *TreeIter, NewOrderVar int) {
 
 	XGtkTreeModelRowsReordered(x.GoPointer(), PathVar, IterVar, NewOrderVar)
 
 }
 
-// Emits the ::rows-reordered signal on @tree_model.
+// Emits the ::rows-reordered signal on tree_model.
 //
-// See [signal@Gtk.TreeModel::rows-reordered].
+// See TreeModel::rows-reordered.
 //
 // This should be called by models when their rows have been
 // reordered.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) RowsReorderedWithLength(PathVar *TreePath, IterVar *TreeIter, NewOrderVar []int, LengthVar int) {
 
 	XGtkTreeModelRowsReorderedWithLength(x.GoPointer(), PathVar, IterVar, NewOrderVar, LengthVar)
@@ -848,117 +540,90 @@ func (x *TreeStore) RowsReorderedWithLength(PathVar *TreePath, IterVar *TreeIter
 // This is an optional method for models to implement.
 // To be more specific, models may ignore this call as it exists
 // primarily for performance reasons. For more information on what
-// this means, see gtk_tree_model_ref_node().
+// this means, see [TreeModel.RefNode].
 //
 // Please note that nodes that are deleted are not unreffed.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) UnrefNode(IterVar *TreeIter) {
 
 	XGtkTreeModelUnrefNode(x.GoPointer(), IterVar)
 
 }
 
-// Fills in @sort_column_id and @order with the current sort column and the
-// order. It returns %TRUE unless the @sort_column_id is
-// %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID or
-// %GTK_TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID.
+// Fills in sort_column_id and order with the current sort column and the
+// order. It returns true unless the sort_column_id is
+// [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID] or
+// [TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID].
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) GetSortColumnId(SortColumnIdVar *int, OrderVar *SortType) bool {
 
 	cret := XGtkTreeSortableGetSortColumnId(x.GoPointer(), SortColumnIdVar, OrderVar)
 	return cret
 }
 
-// Returns %TRUE if the model has a default sort function. This is used
+// Returns true if the model has a default sort function. This is used
 // primarily by GtkTreeViewColumns in order to determine if a model can
 // go back to the default state, or not.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) HasDefaultSortFunc() bool {
 
 	cret := XGtkTreeSortableHasDefaultSortFunc(x.GoPointer())
 	return cret
 }
 
-// Sets the default comparison function used when sorting to be @sort_func.
-// If the current sort column id of @sortable is
-// %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID, then the model will sort using
+// Sets the default comparison function used when sorting to be sort_func.
+// If the current sort column id of sortable is
+// [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID], then the model will sort using
 // this function.
 //
-// If @sort_func is %NULL, then there will be no default comparison function.
+// If sort_func is nil, then there will be no default comparison function.
 // This means that once the model  has been sorted, it can’t go back to the
-// default state. In this case, when the current sort column id of @sortable
-// is %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID, the model will be unsorted.
+// default state. In this case, when the current sort column id of sortable
+// is [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID], the model will be unsorted.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) SetDefaultSortFunc(SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	XGtkTreeSortableSetDefaultSortFunc(x.GoPointer(), glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
 
 }
 
-// Sets the current sort column to be @sort_column_id. The @sortable will
+// Sets the current sort column to be sort_column_id. The sortable will
 // resort itself to reflect this change, after emitting a
-// `GtkTreeSortable::sort-column-changed` signal. @sort_column_id may either be
+// `GtkTreeSortable::sort-column-changed` signal. sort_column_id may either be
 // a regular column id, or one of the following special values:
 //
-//   - %GTK_TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID: the default sort function
+//   - [TREE_SORTABLE_DEFAULT_SORT_COLUMN_ID]: the default sort function
 //     will be used, if it is set
 //
-// - %GTK_TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID: no sorting will occur
+// - [TREE_SORTABLE_UNSORTED_SORT_COLUMN_ID]: no sorting will occur
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) SetSortColumnId(SortColumnIdVar int, OrderVar SortType) {
 
 	XGtkTreeSortableSetSortColumnId(x.GoPointer(), SortColumnIdVar, OrderVar)
 
 }
 
-// Sets the comparison function used when sorting to be @sort_func. If the
-// current sort column id of @sortable is the same as @sort_column_id, then
+// Sets the comparison function used when sorting to be sort_func. If the
+// current sort column id of sortable is the same as sort_column_id, then
 // the model will sort using this function.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) SetSortFunc(SortColumnIdVar int, SortFuncVar *TreeIterCompareFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
 
 	XGtkTreeSortableSetSortFunc(x.GoPointer(), SortColumnIdVar, glib.NewCallback(SortFuncVar), UserDataVar, glib.NewCallbackNullable(DestroyVar))
 
 }
 
-// Emits a `GtkTreeSortable::sort-column-changed` signal on @sortable.
+// Emits a `GtkTreeSortable::sort-column-changed` signal on sortable.
+//
+// Deprecated: since 4.10. do not use it in new code.
 func (x *TreeStore) SortColumnChanged() {
 
 	XGtkTreeSortableSortColumnChanged(x.GoPointer())
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xTreeStoreGLibType, libs, "gtk_tree_store_get_type")
-
-	core.PuregoSafeRegister(&xNewTreeStore, libs, "gtk_tree_store_new")
-	core.PuregoSafeRegister(&xNewTreeStorev, libs, "gtk_tree_store_newv")
-
-	core.PuregoSafeRegister(&xTreeStoreAppend, libs, "gtk_tree_store_append")
-	core.PuregoSafeRegister(&xTreeStoreClear, libs, "gtk_tree_store_clear")
-	core.PuregoSafeRegister(&xTreeStoreInsert, libs, "gtk_tree_store_insert")
-	core.PuregoSafeRegister(&xTreeStoreInsertAfter, libs, "gtk_tree_store_insert_after")
-	core.PuregoSafeRegister(&xTreeStoreInsertBefore, libs, "gtk_tree_store_insert_before")
-	core.PuregoSafeRegister(&xTreeStoreInsertWithValues, libs, "gtk_tree_store_insert_with_values")
-	core.PuregoSafeRegister(&xTreeStoreInsertWithValuesv, libs, "gtk_tree_store_insert_with_valuesv")
-	core.PuregoSafeRegister(&xTreeStoreIsAncestor, libs, "gtk_tree_store_is_ancestor")
-	core.PuregoSafeRegister(&xTreeStoreIterDepth, libs, "gtk_tree_store_iter_depth")
-	core.PuregoSafeRegister(&xTreeStoreIterIsValid, libs, "gtk_tree_store_iter_is_valid")
-	core.PuregoSafeRegister(&xTreeStoreMoveAfter, libs, "gtk_tree_store_move_after")
-	core.PuregoSafeRegister(&xTreeStoreMoveBefore, libs, "gtk_tree_store_move_before")
-	core.PuregoSafeRegister(&xTreeStorePrepend, libs, "gtk_tree_store_prepend")
-	core.PuregoSafeRegister(&xTreeStoreRemove, libs, "gtk_tree_store_remove")
-	core.PuregoSafeRegister(&xTreeStoreReorder, libs, "gtk_tree_store_reorder")
-	core.PuregoSafeRegister(&xTreeStoreSet, libs, "gtk_tree_store_set")
-	core.PuregoSafeRegister(&xTreeStoreSetColumnTypes, libs, "gtk_tree_store_set_column_types")
-	core.PuregoSafeRegister(&xTreeStoreSetValist, libs, "gtk_tree_store_set_valist")
-	core.PuregoSafeRegister(&xTreeStoreSetValue, libs, "gtk_tree_store_set_value")
-	core.PuregoSafeRegister(&xTreeStoreSetValuesv, libs, "gtk_tree_store_set_valuesv")
-	core.PuregoSafeRegister(&xTreeStoreSwap, libs, "gtk_tree_store_swap")
-
-}
@@ -0,0 +1,452 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xNewTreeStore func(int, ...interface{}) uintptr
+
+// Creates a new tree store.
+//
+// The tree store will have n_columns, with each column using the
+// corresponding type passed to this function.
+//
+// Note that only types derived from standard GObject fundamental types
+// are supported.
+//
+// As an example:
+//
+//	gtk_tree_store_new (3, G_TYPE_INT, G_TYPE_STRING, GDK_TYPE_TEXTURE);
+//
+// will create a new `GtkTreeStore` with three columns of type
+// `int`, `gchararray`, and `GdkTexture` respectively.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+//
+// See [NewTreeStorev] for the version of this function that language bindings are meant to use.
+func NewTreeStore(NColumnsVar int, varArgs ...interface{}) *TreeStore {
+	return newTreeStoreVarArgs(NColumnsVar, varArgs...)
+}
+
+var xNewTreeStorev func(int, []types.GType) uintptr
+
+// Creates a new tree store.
+//
+// This constructor is meant for language bindings.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func NewTreeStorev(NColumnsVar int, TypesVar []types.GType) *TreeStore {
+	var cls *TreeStore
+
+	cret := xNewTreeStorev(NColumnsVar, TypesVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &TreeStore{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeStoreAppend func(uintptr, *TreeIter, *TreeIter)
+
+// Appends a new row to tree_store.
+//
+// If parent is non-nil, then it will append the new row after the last
+// child of parent, otherwise it will append a row to the top level.
+//
+// The iter parameter will be changed to point to this new row. The row will
+// be empty after this function is called. To fill in values, you need to call
+// [TreeStore.Set] or [TreeStore.SetValue].
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Append(IterVar *TreeIter, ParentVar *TreeIter) {
+
+	xTreeStoreAppend(x.GoPointer(), IterVar, ParentVar)
+
+}
+
+var xTreeStoreClear func(uintptr)
+
+// Removes all rows from tree_store
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Clear() {
+
+	xTreeStoreClear(x.GoPointer())
+
+}
+
+var xTreeStoreInsert func(uintptr, *TreeIter, *TreeIter, int)
+
+// Creates a new row at position.
+//
+// If parent is non-nil, then the row will be made a child of parent.
+// Otherwise, the row will be created at the toplevel.
+//
+// If position is `-1` or is larger than the number of rows at that level,
+// then the new row will be inserted to the end of the list.
+//
+// The iter parameter will be changed to point to this new row. The row
+// will be empty after this function is called. To fill in values, you
+// need to call [TreeStore.Set] or [TreeStore.SetValue].
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Insert(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int) {
+
+	xTreeStoreInsert(x.GoPointer(), IterVar, ParentVar, PositionVar)
+
+}
+
+var xTreeStoreInsertAfter func(uintptr, *TreeIter, *TreeIter, *TreeIter)
+
+// Inserts a new row after sibling.
+//
+// If sibling is nil, then the row will be prepended to parent’s children.
+//
+// If parent and sibling are nil, then the row will be prepended to the
+// toplevel.
+//
+// If both sibling and parent are set, then parent must be the parent
+// of sibling. When sibling is set, parent is optional.
+//
+// The iter parameter will be changed to point to this new row. The row will
+// be empty after this function is called. To fill in values, you need to call
+// [TreeStore.Set] or [TreeStore.SetValue].
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) InsertAfter(IterVar *TreeIter, ParentVar *TreeIter, SiblingVar *TreeIter) {
+
+	xTreeStoreInsertAfter(x.GoPointer(), IterVar, ParentVar, SiblingVar)
+
+}
+
+var xTreeStoreInsertBefore func(uintptr, *TreeIter, *TreeIter, *TreeIter)
+
+// Inserts a new row before sibling.
+//
+// If sibling is nil, then the row will be appended to parent’s children.
+//
+// If parent and sibling are nil, then the row will be appended to the
+// toplevel.
+//
+// If both sibling and parent are set, then parent must be the parent
+// of sibling. When sibling is set, parent is optional.
+//
+// The iter parameter will be changed to point to this new row. The row will
+// be empty after this function is called. To fill in values, you need to call
+// [TreeStore.Set] or [TreeStore.SetValue].
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) InsertBefore(IterVar *TreeIter, ParentVar *TreeIter, SiblingVar *TreeIter) {
+
+	xTreeStoreInsertBefore(x.GoPointer(), IterVar, ParentVar, SiblingVar)
+
+}
+
+var xTreeStoreInsertWithValues func(uintptr, *TreeIter, *TreeIter, int, ...interface{})
+
+// Creates a new row at the given position.
+//
+// The iter parameter will be changed to point to this  new row.
+//
+// If position is -1, or larger than the number of rows on the list, then
+// the new row will be appended to the list. The row will be filled with
+// the values given to this function.
+//
+// Calling
+//
+//	gtk_tree_store_insert_with_values (tree_store, iter, position, ...)
+//
+// has the same effect as calling
+//
+//	gtk_tree_store_insert (tree_store, iter, position);
+//	gtk_tree_store_set (tree_store, iter, ...);
+//
+// with the different that the former will only emit a row_inserted signal,
+// while the latter will emit row_inserted, row_changed and if the tree store
+// is sorted, rows_reordered.
+//
+// Since emitting the rows_reordered signal repeatedly can affect the
+// performance of the program, [TreeStore.InsertWithValues] should
+// generally be preferred when inserting rows in a sorted tree store.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+//
+// See [InsertWithValuesv] for the version of this function that language bindings are meant to use.
+func (x *TreeStore) InsertWithValues(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int, varArgs ...interface{}) {
+	treeStoreInsertWithValuesVarArgs(x, IterVar, ParentVar, PositionVar, varArgs...)
+}
+
+var xTreeStoreInsertWithValuesv func(uintptr, *TreeIter, *TreeIter, int, []int, []gobject.Value, int)
+
+// A variant of [TreeStore.InsertWithValues] which takes
+// the columns and values as two arrays, instead of varargs.
+//
+// This function is mainly intended for language bindings.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) InsertWithValuesv(IterVar *TreeIter, ParentVar *TreeIter, PositionVar int, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
+
+	xTreeStoreInsertWithValuesv(x.GoPointer(), IterVar, ParentVar, PositionVar, ColumnsVar, ValuesVar, NValuesVar)
+
+}
+
+var xTreeStoreIsAncestor func(uintptr, *TreeIter, *TreeIter) bool
+
+// Checks if iter is an ancestor of descendant.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) IsAncestor(IterVar *TreeIter, DescendantVar *TreeIter) bool {
+
+	cret := xTreeStoreIsAncestor(x.GoPointer(), IterVar, DescendantVar)
+	return cret
+}
+
+var xTreeStoreIterDepth func(uintptr, *TreeIter) int
+
+// Returns the depth of the position pointed by the iterator
+//
+// The depth will be 0 for anything on the root level, 1 for anything down
+// a level, etc.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) IterDepth(IterVar *TreeIter) int {
+
+	cret := xTreeStoreIterDepth(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xTreeStoreIterIsValid func(uintptr, *TreeIter) bool
+
+// Checks if the given iter is a valid iter for this `GtkTreeStore`.
+//
+// This function is slow. Only use it for debugging and/or testing
+// purposes.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) IterIsValid(IterVar *TreeIter) bool {
+
+	cret := xTreeStoreIterIsValid(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xTreeStoreMoveAfter func(uintptr, *TreeIter, *TreeIter)
+
+// Moves iter in tree_store to the position after position.
+//
+// iter and position should be in the same level.
+//
+// Note that this function only works with unsorted stores.
+//
+// If position is nil, iter will be moved to the start of the level.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) MoveAfter(IterVar *TreeIter, PositionVar *TreeIter) {
+
+	xTreeStoreMoveAfter(x.GoPointer(), IterVar, PositionVar)
+
+}
+
+var xTreeStoreMoveBefore func(uintptr, *TreeIter, *TreeIter)
+
+// Moves iter in tree_store to the position before position.
+//
+// iter and position should be in the same level.
+//
+// Note that this function only works with unsorted stores.
+//
+// If position is nil, iter will be moved to the end of the level.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) MoveBefore(IterVar *TreeIter, PositionVar *TreeIter) {
+
+	xTreeStoreMoveBefore(x.GoPointer(), IterVar, PositionVar)
+
+}
+
+var xTreeStorePrepend func(uintptr, *TreeIter, *TreeIter)
+
+// Prepends a new row to tree_store.
+//
+// If parent is non-nil, then it will prepend the new row before the first
+// child of parent, otherwise it will prepend a row to the top level. The
+// `iter` parameter will be changed to point to this new row.  The row will
+// be empty after this function is called. To fill in values, you need to
+// call [TreeStore.Set] or [TreeStore.SetValue].
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Prepend(IterVar *TreeIter, ParentVar *TreeIter) {
+
+	xTreeStorePrepend(x.GoPointer(), IterVar, ParentVar)
+
+}
+
+var xTreeStoreRemove func(uintptr, *TreeIter) bool
+
+// Removes iter from tree_store.
+//
+// After being removed, iter is set to the next valid row at that level, or
+// invalidated if it previously pointed to the last one.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Remove(IterVar *TreeIter) bool {
+
+	cret := xTreeStoreRemove(x.GoPointer(), IterVar)
+	return cret
+}
+
+var xTreeStoreReorder func(uintptr, *TreeIter, []int)
+
+// Reorders the children of parent in tree_store to follow the order
+// indicated by new_order.
+//
+// Note that this function only works with unsorted stores.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Reorder(ParentVar *TreeIter, NewOrderVar []int) {
+
+	xTreeStoreReorder(x.GoPointer(), ParentVar, NewOrderVar)
+
+}
+
+var xTreeStoreSet func(uintptr, *TreeIter, ...interface{})
+
+// Sets the value of one or more cells in the row referenced by iter.
+//
+// The variable argument list should contain integer column numbers,
+// each column number followed by the value to be set.
+//
+// The list is terminated by a value of `-1`.
+//
+// For example, to set column 0 with type `G_TYPE_STRING` to “Foo”, you would
+// write
+//
+//	gtk_tree_store_set (store, iter, 0, "Foo", -1);
+//
+// The value will be referenced by the store if it is a `G_TYPE_OBJECT`, and it
+// will be copied if it is a `G_TYPE_STRING` or `G_TYPE_BOXED`.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+//
+// See [SetValuesv] for the version of this function that language bindings are meant to use.
+func (x *TreeStore) Set(IterVar *TreeIter, varArgs ...interface{}) {
+	treeStoreSetVarArgs(x, IterVar, varArgs...)
+}
+
+var xTreeStoreSetColumnTypes func(uintptr, int, []types.GType)
+
+// Sets the type of the columns in a tree store.
+//
+// This function is meant primarily for types that inherit from
+// `GtkTreeStore`, and should only be used when constructing a new
+// `GtkTreeStore`.
+//
+// This functions cannot be called after a row has been added,
+// or a method on the `GtkTreeModel` interface is called on the
+// tree store.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) SetColumnTypes(NColumnsVar int, TypesVar []types.GType) {
+
+	xTreeStoreSetColumnTypes(x.GoPointer(), NColumnsVar, TypesVar)
+
+}
+
+var xTreeStoreSetValist func(uintptr, *TreeIter, []interface{})
+
+// A version of [TreeStore.Set] using `va_list`.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) SetValist(IterVar *TreeIter, VarArgsVar []interface{}) {
+
+	xTreeStoreSetValist(x.GoPointer(), IterVar, VarArgsVar)
+
+}
+
+var xTreeStoreSetValue func(uintptr, *TreeIter, int, *gobject.Value)
+
+// Sets the data in the cell specified by iter and column.
+//
+// The type of value must be convertible to the type of the
+// column.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) SetValue(IterVar *TreeIter, ColumnVar int, ValueVar *gobject.Value) {
+
+	xTreeStoreSetValue(x.GoPointer(), IterVar, ColumnVar, ValueVar)
+
+}
+
+var xTreeStoreSetValuesv func(uintptr, *TreeIter, []int, []gobject.Value, int)
+
+// A variant of [TreeStore.SetValist] which takes
+// the columns and values as two arrays, instead of using variadic
+// arguments.
+//
+// This function is mainly intended for language bindings or in case
+// the number of columns to change is not known until run-time.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) SetValuesv(IterVar *TreeIter, ColumnsVar []int, ValuesVar []gobject.Value, NValuesVar int) {
+
+	xTreeStoreSetValuesv(x.GoPointer(), IterVar, ColumnsVar, ValuesVar, NValuesVar)
+
+}
+
+var xTreeStoreSwap func(uintptr, *TreeIter, *TreeIter)
+
+// Swaps a and b in the same level of tree_store.
+//
+// Note that this function only works with unsorted stores.
+//
+// Deprecated: since 4.10. Use [TreeListModel] instead
+func (x *TreeStore) Swap(AVar *TreeIter, BVar *TreeIter) {
+
+	xTreeStoreSwap(x.GoPointer(), AVar, BVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewTreeStore, libs, "gtk_tree_store_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreeStorev, libs, "gtk_tree_store_newv", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeStoreAppend, libs, "gtk_tree_store_append", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreClear, libs, "gtk_tree_store_clear", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreInsert, libs, "gtk_tree_store_insert", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreInsertAfter, libs, "gtk_tree_store_insert_after", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreInsertBefore, libs, "gtk_tree_store_insert_before", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreInsertWithValues, libs, "gtk_tree_store_insert_with_values", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreInsertWithValuesv, libs, "gtk_tree_store_insert_with_valuesv", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreIsAncestor, libs, "gtk_tree_store_is_ancestor", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreIterDepth, libs, "gtk_tree_store_iter_depth", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreIterIsValid, libs, "gtk_tree_store_iter_is_valid", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreMoveAfter, libs, "gtk_tree_store_move_after", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreMoveBefore, libs, "gtk_tree_store_move_before", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStorePrepend, libs, "gtk_tree_store_prepend", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreRemove, libs, "gtk_tree_store_remove", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreReorder, libs, "gtk_tree_store_reorder", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSet, libs, "gtk_tree_store_set", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSetColumnTypes, libs, "gtk_tree_store_set_column_types", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSetValist, libs, "gtk_tree_store_set_valist", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSetValue, libs, "gtk_tree_store_set_value", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSetValuesv, libs, "gtk_tree_store_set_valuesv", "GTK", "")
+	core.PuregoRegisterSince(&xTreeStoreSwap, libs, "gtk_tree_store_swap", "GTK", "")
+
+}
@@ -0,0 +1,1578 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewTreeView func() uintptr
+
+// Creates a new `GtkTreeView` widget.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func NewTreeView() *TreeView {
+	var cls *TreeView
+
+	cret := xNewTreeView()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewTreeViewWithModel func(uintptr) uintptr
+
+// Creates a new `GtkTreeView` widget with the model initialized to model.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func NewTreeViewWithModel(ModelVar TreeModel) *TreeView {
+	var cls *TreeView
+
+	cret := xNewTreeViewWithModel(ModelVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewAppendColumn func(uintptr, uintptr) int
+
+// Appends column to the list of columns. If tree_view has “fixed_height”
+// mode enabled, then column must have its “sizing” property set to be
+// GTK_TREE_VIEW_COLUMN_FIXED.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) AppendColumn(ColumnVar *TreeViewColumn) int {
+
+	cret := xTreeViewAppendColumn(x.GoPointer(), ColumnVar.GoPointer())
+	return cret
+}
+
+var xTreeViewCollapseAll func(uintptr)
+
+// Recursively collapses all visible, expanded nodes in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) CollapseAll() {
+
+	xTreeViewCollapseAll(x.GoPointer())
+
+}
+
+var xTreeViewCollapseRow func(uintptr, *TreePath) bool
+
+// Collapses a row (hides its child rows, if they exist).
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) CollapseRow(PathVar *TreePath) bool {
+
+	cret := xTreeViewCollapseRow(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xTreeViewColumnsAutosize func(uintptr)
+
+// Resizes all columns to their optimal width. Only works after the
+// treeview has been realized.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ColumnsAutosize() {
+
+	xTreeViewColumnsAutosize(x.GoPointer())
+
+}
+
+var xTreeViewConvertBinWindowToTreeCoords func(uintptr, int, int, *int, *int)
+
+// Converts bin_window coordinates to coordinates for the
+// tree (the full scrollable area of the tree).
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertBinWindowToTreeCoords(BxVar int, ByVar int, TxVar *int, TyVar *int) {
+
+	xTreeViewConvertBinWindowToTreeCoords(x.GoPointer(), BxVar, ByVar, TxVar, TyVar)
+
+}
+
+var xTreeViewConvertBinWindowToWidgetCoords func(uintptr, int, int, *int, *int)
+
+// Converts bin_window coordinates to widget relative coordinates.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertBinWindowToWidgetCoords(BxVar int, ByVar int, WxVar *int, WyVar *int) {
+
+	xTreeViewConvertBinWindowToWidgetCoords(x.GoPointer(), BxVar, ByVar, WxVar, WyVar)
+
+}
+
+var xTreeViewConvertTreeToBinWindowCoords func(uintptr, int, int, *int, *int)
+
+// Converts tree coordinates (coordinates in full scrollable area of the tree)
+// to bin_window coordinates.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertTreeToBinWindowCoords(TxVar int, TyVar int, BxVar *int, ByVar *int) {
+
+	xTreeViewConvertTreeToBinWindowCoords(x.GoPointer(), TxVar, TyVar, BxVar, ByVar)
+
+}
+
+var xTreeViewConvertTreeToWidgetCoords func(uintptr, int, int, *int, *int)
+
+// Converts tree coordinates (coordinates in full scrollable area of the tree)
+// to widget coordinates.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertTreeToWidgetCoords(TxVar int, TyVar int, WxVar *int, WyVar *int) {
+
+	xTreeViewConvertTreeToWidgetCoords(x.GoPointer(), TxVar, TyVar, WxVar, WyVar)
+
+}
+
+var xTreeViewConvertWidgetToBinWindowCoords func(uintptr, int, int, *int, *int)
+
+// Converts widget coordinates to coordinates for the bin_window.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertWidgetToBinWindowCoords(WxVar int, WyVar int, BxVar *int, ByVar *int) {
+
+	xTreeViewConvertWidgetToBinWindowCoords(x.GoPointer(), WxVar, WyVar, BxVar, ByVar)
+
+}
+
+var xTreeViewConvertWidgetToTreeCoords func(uintptr, int, int, *int, *int)
+
+// Converts widget coordinates to coordinates for the
+// tree (the full scrollable area of the tree).
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ConvertWidgetToTreeCoords(WxVar int, WyVar int, TxVar *int, TyVar *int) {
+
+	xTreeViewConvertWidgetToTreeCoords(x.GoPointer(), WxVar, WyVar, TxVar, TyVar)
+
+}
+
+var xTreeViewCreateRowDragIcon func(uintptr, *TreePath) uintptr
+
+// Creates a `cairo_surface_t` representation of the row at path.
+// This image is used for a drag icon.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) CreateRowDragIcon(PathVar *TreePath) *gdk.PaintableBase {
+	var cls *gdk.PaintableBase
+
+	cret := xTreeViewCreateRowDragIcon(x.GoPointer(), PathVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gdk.PaintableBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewEnableModelDragDest func(uintptr, *gdk.ContentFormats, gdk.DragAction)
+
+// Turns tree_view into a drop destination for automatic DND. Calling
+// this method sets `GtkTreeView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) EnableModelDragDest(FormatsVar *gdk.ContentFormats, ActionsVar gdk.DragAction) {
+
+	xTreeViewEnableModelDragDest(x.GoPointer(), FormatsVar, ActionsVar)
+
+}
+
+var xTreeViewEnableModelDragSource func(uintptr, gdk.ModifierType, *gdk.ContentFormats, gdk.DragAction)
+
+// Turns tree_view into a drag source for automatic DND. Calling this
+// method sets `GtkTreeView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) EnableModelDragSource(StartButtonMaskVar gdk.ModifierType, FormatsVar *gdk.ContentFormats, ActionsVar gdk.DragAction) {
+
+	xTreeViewEnableModelDragSource(x.GoPointer(), StartButtonMaskVar, FormatsVar, ActionsVar)
+
+}
+
+var xTreeViewExpandAll func(uintptr)
+
+// Recursively expands all nodes in the tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ExpandAll() {
+
+	xTreeViewExpandAll(x.GoPointer())
+
+}
+
+var xTreeViewExpandRow func(uintptr, *TreePath, bool) bool
+
+// Opens the row so its children are visible.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ExpandRow(PathVar *TreePath, OpenAllVar bool) bool {
+
+	cret := xTreeViewExpandRow(x.GoPointer(), PathVar, OpenAllVar)
+	return cret
+}
+
+var xTreeViewExpandToPath func(uintptr, *TreePath)
+
+// Expands the row at path. This will also expand all parent rows of
+// path as necessary.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ExpandToPath(PathVar *TreePath) {
+
+	xTreeViewExpandToPath(x.GoPointer(), PathVar)
+
+}
+
+var xTreeViewGetActivateOnSingleClick func(uintptr) bool
+
+// Gets the setting set by [TreeView.SetActivateOnSingleClick].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetActivateOnSingleClick() bool {
+
+	cret := xTreeViewGetActivateOnSingleClick(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetBackgroundArea func(uintptr, *TreePath, uintptr, *gdk.Rectangle)
+
+// Fills the bounding rectangle in bin_window coordinates for the cell at the
+// row specified by path and the column specified by column.  If path is
+// nil, or points to a node not found in the tree, the y and height fields of
+// the rectangle will be filled with 0. If column is nil, the x and width
+// fields will be filled with 0.  The returned rectangle is equivalent to the
+// background_area passed to gtk_cell_renderer_render().  These background
+// areas tile to cover the entire bin window.  Contrast with the cell_area,
+// returned by [TreeView.GetCellArea], which returns only the cell
+// itself, excluding surrounding borders and the tree expander area.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetBackgroundArea(PathVar *TreePath, ColumnVar *TreeViewColumn, RectVar *gdk.Rectangle) {
+
+	xTreeViewGetBackgroundArea(x.GoPointer(), PathVar, ColumnVar.GoPointer(), RectVar)
+
+}
+
+var xTreeViewGetCellArea func(uintptr, *TreePath, uintptr, *gdk.Rectangle)
+
+// Fills the bounding rectangle in bin_window coordinates for the cell at the
+// row specified by path and the column specified by column.  If path is
+// nil, or points to a path not currently displayed, the y and height fields
+// of the rectangle will be filled with 0. If column is nil, the x and width
+// fields will be filled with 0.  The sum of all cell rects does not cover the
+// entire tree; there are extra pixels in between rows, for example. The
+// returned rectangle is equivalent to the cell_area passed to
+// gtk_cell_renderer_render().  This function is only valid if tree_view is
+// realized.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetCellArea(PathVar *TreePath, ColumnVar *TreeViewColumn, RectVar *gdk.Rectangle) {
+
+	xTreeViewGetCellArea(x.GoPointer(), PathVar, ColumnVar.GoPointer(), RectVar)
+
+}
+
+var xTreeViewGetColumn func(uintptr, int) uintptr
+
+// Gets the `GtkTreeViewColumn` at the given position in the #tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetColumn(NVar int) *TreeViewColumn {
+	var cls *TreeViewColumn
+
+	cret := xTreeViewGetColumn(x.GoPointer(), NVar)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeViewColumn{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewGetColumns func(uintptr) *glib.List
+
+// Returns a `GList` of all the `GtkTreeViewColumn`s currently in tree_view.
+// The returned list must be freed with g_list_free ().
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetColumns() *glib.List {
+
+	cret := xTreeViewGetColumns(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetCursor func(uintptr, **TreePath, **TreeViewColumn)
+
+// Fills in path and focus_column with the current path and focus column.  If
+// the cursor isn’t currently set, then *path will be nil.  If no column
+// currently has focus, then *focus_column will be nil.
+//
+// The returned `GtkTreePath` must be freed with [TreePath.Free] when
+// you are done with it.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetCursor(PathVar **TreePath, FocusColumnVar **TreeViewColumn) {
+
+	xTreeViewGetCursor(x.GoPointer(), PathVar, FocusColumnVar)
+
+}
+
+var xTreeViewGetDestRowAtPos func(uintptr, int, int, **TreePath, *TreeViewDropPosition) bool
+
+// Determines the destination row for a given position.  drag_x and
+// drag_y are expected to be in widget coordinates.  This function is only
+// meaningful if tree_view is realized.  Therefore this function will always
+// return false if tree_view is not realized or does not have a model.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetDestRowAtPos(DragXVar int, DragYVar int, PathVar **TreePath, PosVar *TreeViewDropPosition) bool {
+
+	cret := xTreeViewGetDestRowAtPos(x.GoPointer(), DragXVar, DragYVar, PathVar, PosVar)
+	return cret
+}
+
+var xTreeViewGetDragDestRow func(uintptr, **TreePath, *TreeViewDropPosition)
+
+// Gets information about the row that is highlighted for feedback.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetDragDestRow(PathVar **TreePath, PosVar *TreeViewDropPosition) {
+
+	xTreeViewGetDragDestRow(x.GoPointer(), PathVar, PosVar)
+
+}
+
+var xTreeViewGetEnableSearch func(uintptr) bool
+
+// Returns whether or not the tree allows to start interactive searching
+// by typing in text.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetEnableSearch() bool {
+
+	cret := xTreeViewGetEnableSearch(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetEnableTreeLines func(uintptr) bool
+
+// Returns whether or not tree lines are drawn in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetEnableTreeLines() bool {
+
+	cret := xTreeViewGetEnableTreeLines(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetExpanderColumn func(uintptr) uintptr
+
+// Returns the column that is the current expander column,
+// or nil if none has been set.
+// This column has the expander arrow drawn next to it.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetExpanderColumn() *TreeViewColumn {
+	var cls *TreeViewColumn
+
+	cret := xTreeViewGetExpanderColumn(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeViewColumn{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewGetFixedHeightMode func(uintptr) bool
+
+// Returns whether fixed height mode is turned on for tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetFixedHeightMode() bool {
+
+	cret := xTreeViewGetFixedHeightMode(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetGridLines func(uintptr) TreeViewGridLines
+
+// Returns which grid lines are enabled in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetGridLines() TreeViewGridLines {
+
+	cret := xTreeViewGetGridLines(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetHeadersClickable func(uintptr) bool
+
+// Returns whether all header columns are clickable.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetHeadersClickable() bool {
+
+	cret := xTreeViewGetHeadersClickable(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetHeadersVisible func(uintptr) bool
+
+// Returns true if the headers on the tree_view are visible.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetHeadersVisible() bool {
+
+	cret := xTreeViewGetHeadersVisible(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetHoverExpand func(uintptr) bool
+
+// Returns whether hover expansion mode is turned on for tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetHoverExpand() bool {
+
+	cret := xTreeViewGetHoverExpand(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetHoverSelection func(uintptr) bool
+
+// Returns whether hover selection mode is turned on for tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetHoverSelection() bool {
+
+	cret := xTreeViewGetHoverSelection(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetLevelIndentation func(uintptr) int
+
+// Returns the amount, in pixels, of extra indentation for child levels
+// in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetLevelIndentation() int {
+
+	cret := xTreeViewGetLevelIndentation(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetModel func(uintptr) uintptr
+
+// Returns the model the `GtkTreeView` is based on.  Returns nil if the
+// model is unset.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetModel() *TreeModelBase {
+	var cls *TreeModelBase
+
+	cret := xTreeViewGetModel(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeModelBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewGetNColumns func(uintptr) uint
+
+// Queries the number of columns in the given tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetNColumns() uint {
+
+	cret := xTreeViewGetNColumns(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetPathAtPos func(uintptr, int, int, **TreePath, **TreeViewColumn, *int, *int) bool
+
+// Finds the path at the point (x, y), relative to bin_window coordinates.
+// That is, x and y are relative to an events coordinates. Widget-relative
+// coordinates must be converted using
+// [TreeView.ConvertWidgetToBinWindowCoords]. It is primarily for
+// things like popup menus. If path is non-nil, then it will be filled
+// with the `GtkTreePath` at that point.  This path should be freed with
+// [TreePath.Free].  If column is non-nil, then it will be filled
+// with the column at that point.  cell_x and cell_y return the coordinates
+// relative to the cell background (i.e. the background_area passed to
+// gtk_cell_renderer_render()).  This function is only meaningful if
+// tree_view is realized.  Therefore this function will always return false
+// if tree_view is not realized or does not have a model.
+//
+// For converting widget coordinates (eg. the ones you get from
+// GtkWidget::query-tooltip), please see
+// [TreeView.ConvertWidgetToBinWindowCoords].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetPathAtPos(XVar int, YVar int, PathVar **TreePath, ColumnVar **TreeViewColumn, CellXVar *int, CellYVar *int) bool {
+
+	cret := xTreeViewGetPathAtPos(x.GoPointer(), XVar, YVar, PathVar, ColumnVar, CellXVar, CellYVar)
+	return cret
+}
+
+var xTreeViewGetReorderable func(uintptr) bool
+
+// Retrieves whether the user can reorder the tree via drag-and-drop. See
+// [TreeView.SetReorderable].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetReorderable() bool {
+
+	cret := xTreeViewGetReorderable(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetRowSeparatorFunc func(uintptr) uintptr
+
+// Returns the current row separator function.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetRowSeparatorFunc() uintptr {
+
+	cret := xTreeViewGetRowSeparatorFunc(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetRubberBanding func(uintptr) bool
+
+// Returns whether rubber banding is turned on for tree_view.  If the
+// selection mode is [SelectionMultipleValue], rubber banding will allow the
+// user to select multiple rows by dragging the mouse.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetRubberBanding() bool {
+
+	cret := xTreeViewGetRubberBanding(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetSearchColumn func(uintptr) int
+
+// Gets the column searched on by the interactive search code.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetSearchColumn() int {
+
+	cret := xTreeViewGetSearchColumn(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetSearchEntry func(uintptr) uintptr
+
+// Returns the `GtkEntry` which is currently in use as interactive search
+// entry for tree_view.  In case the built-in entry is being used, nil
+// will be returned.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetSearchEntry() *EditableBase {
+	var cls *EditableBase
+
+	cret := xTreeViewGetSearchEntry(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &EditableBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewGetSearchEqualFunc func(uintptr) uintptr
+
+// Returns the compare function currently in use.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetSearchEqualFunc() uintptr {
+
+	cret := xTreeViewGetSearchEqualFunc(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetSelection func(uintptr) uintptr
+
+// Gets the `GtkTreeSelection` associated with tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetSelection() *TreeSelection {
+	var cls *TreeSelection
+
+	cret := xTreeViewGetSelection(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeSelection{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewGetShowExpanders func(uintptr) bool
+
+// Returns whether or not expanders are drawn in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetShowExpanders() bool {
+
+	cret := xTreeViewGetShowExpanders(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetTooltipColumn func(uintptr) int
+
+// Returns the column of tree_view’s model which is being used for
+// displaying tooltips on tree_view’s rows.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetTooltipColumn() int {
+
+	cret := xTreeViewGetTooltipColumn(x.GoPointer())
+	return cret
+}
+
+var xTreeViewGetTooltipContext func(uintptr, int, int, bool, **TreeModel, **TreePath, *TreeIter) bool
+
+// This function is supposed to be used in a ::query-tooltip
+// signal handler for `GtkTreeView`. The x, y and keyboard_tip values
+// which are received in the signal handler, should be passed to this
+// function without modification.
+//
+// The return value indicates whether there is a tree view row at the given
+// coordinates (true) or not (false) for mouse tooltips. For keyboard
+// tooltips the row returned will be the cursor row. When true, then any of
+// model, path and iter which have been provided will be set to point to
+// that row and the corresponding model. x and y will always be converted
+// to be relative to tree_view’s bin_window if keyboard_tooltip is false.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetTooltipContext(XVar int, YVar int, KeyboardTipVar bool, ModelVar **TreeModel, PathVar **TreePath, IterVar *TreeIter) bool {
+
+	cret := xTreeViewGetTooltipContext(x.GoPointer(), XVar, YVar, KeyboardTipVar, ModelVar, PathVar, IterVar)
+	return cret
+}
+
+var xTreeViewGetVisibleRange func(uintptr, **TreePath, **TreePath) bool
+
+// Sets start_path and end_path to be the first and last visible path.
+// Note that there may be invisible paths in between.
+//
+// The paths should be freed with [TreePath.Free] after use.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetVisibleRange(StartPathVar **TreePath, EndPathVar **TreePath) bool {
+
+	cret := xTreeViewGetVisibleRange(x.GoPointer(), StartPathVar, EndPathVar)
+	return cret
+}
+
+var xTreeViewGetVisibleRect func(uintptr, *gdk.Rectangle)
+
+// Fills visible_rect with the currently-visible region of the
+// buffer, in tree coordinates. Convert to bin_window coordinates with
+// [TreeView.ConvertTreeToBinWindowCoords].
+// Tree coordinates start at 0,0 for row 0 of the tree, and cover the entire
+// scrollable area of the tree.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) GetVisibleRect(VisibleRectVar *gdk.Rectangle) {
+
+	xTreeViewGetVisibleRect(x.GoPointer(), VisibleRectVar)
+
+}
+
+var xTreeViewInsertColumn func(uintptr, uintptr, int) int
+
+// This inserts the column into the tree_view at position.  If position is
+// -1, then the column is inserted at the end. If tree_view has
+// “fixed_height” mode enabled, then column must have its “sizing” property
+// set to be GTK_TREE_VIEW_COLUMN_FIXED.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) InsertColumn(ColumnVar *TreeViewColumn, PositionVar int) int {
+
+	cret := xTreeViewInsertColumn(x.GoPointer(), ColumnVar.GoPointer(), PositionVar)
+	return cret
+}
+
+var xTreeViewInsertColumnWithAttributes func(uintptr, int, string, uintptr, ...interface{}) int
+
+// Creates a new `GtkTreeViewColumn` and inserts it into the tree_view at
+// position.  If position is -1, then the newly created column is inserted at
+// the end.  The column is initialized with the attributes given. If tree_view
+// has “fixed_height” mode enabled, then the new column will have its sizing
+// property set to be GTK_TREE_VIEW_COLUMN_FIXED.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) InsertColumnWithAttributes(PositionVar int, TitleVar string, CellVar *CellRenderer, varArgs ...interface{}) int {
+
+	cret := xTreeViewInsertColumnWithAttributes(x.GoPointer(), PositionVar, TitleVar, CellVar.GoPointer(), varArgs...)
+	return cret
+}
+
+var xTreeViewInsertColumnWithDataFunc func(uintptr, int, string, uintptr, uintptr, uintptr, uintptr) int
+
+// Convenience function that inserts a new column into the `GtkTreeView`
+// with the given cell renderer and a `GtkTreeCellDataFunc` to set cell renderer
+// attributes (normally using data from the model). See also
+// [TreeViewColumn.SetCellDataFunc], [TreeViewColumn.PackStart].
+// If tree_view has “fixed_height” mode enabled, then the new column will have its
+// “sizing” property set to be GTK_TREE_VIEW_COLUMN_FIXED.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) InsertColumnWithDataFunc(PositionVar int, TitleVar string, CellVar *CellRenderer, FuncVar *TreeCellDataFunc, DataVar uintptr, DnotifyVar *glib.DestroyNotify) int {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 *TreeIter, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DnotifyVarRef uintptr
+	if DnotifyVar != nil {
+		DnotifyVarPtr := uintptr(unsafe.Pointer(DnotifyVar))
+		if cbRefPtr, ok := glib.GetCallback(DnotifyVarPtr); ok {
+			DnotifyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DnotifyVar
+				cbFn(arg0)
+			}
+			DnotifyVarRef = core.NewCallback(fcb)
+			DnotifyVarRef = glib.SaveCallbackWithClosure(DnotifyVarPtr, DnotifyVarRef, DnotifyVar)
+		}
+	}
+
+	cret := xTreeViewInsertColumnWithDataFunc(x.GoPointer(), PositionVar, TitleVar, CellVar.GoPointer(), FuncVarRef, DataVar, DnotifyVarRef)
+	return cret
+}
+
+var xTreeViewIsBlankAtPos func(uintptr, int, int, **TreePath, **TreeViewColumn, *int, *int) bool
+
+// Determine whether the point (x, y) in tree_view is blank, that is no
+// cell content nor an expander arrow is drawn at the location. If so, the
+// location can be considered as the background. You might wish to take
+// special action on clicks on the background, such as clearing a current
+// selection, having a custom context menu or starting rubber banding.
+//
+// The x and y coordinate that are provided must be relative to bin_window
+// coordinates.  Widget-relative coordinates must be converted using
+// [TreeView.ConvertWidgetToBinWindowCoords].
+//
+// For converting widget coordinates (eg. the ones you get from
+// GtkWidget::query-tooltip), please see
+// [TreeView.ConvertWidgetToBinWindowCoords].
+//
+// The path, column, cell_x and cell_y arguments will be filled in
+// likewise as for [TreeView.GetPathAtPos].  Please see
+// [TreeView.GetPathAtPos] for more information.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) IsBlankAtPos(XVar int, YVar int, PathVar **TreePath, ColumnVar **TreeViewColumn, CellXVar *int, CellYVar *int) bool {
+
+	cret := xTreeViewIsBlankAtPos(x.GoPointer(), XVar, YVar, PathVar, ColumnVar, CellXVar, CellYVar)
+	return cret
+}
+
+var xTreeViewIsRubberBandingActive func(uintptr) bool
+
+// Returns whether a rubber banding operation is currently being done
+// in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) IsRubberBandingActive() bool {
+
+	cret := xTreeViewIsRubberBandingActive(x.GoPointer())
+	return cret
+}
+
+var xTreeViewMapExpandedRows func(uintptr, uintptr, uintptr)
+
+// Calls func on all expanded rows.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) MapExpandedRows(FuncVar *TreeViewMappingFunc, DataVar uintptr) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreePath, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	xTreeViewMapExpandedRows(x.GoPointer(), FuncVarRef, DataVar)
+
+}
+
+var xTreeViewMoveColumnAfter func(uintptr, uintptr, uintptr)
+
+// Moves column to be after to base_column.  If base_column is nil, then
+// column is placed in the first position.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) MoveColumnAfter(ColumnVar *TreeViewColumn, BaseColumnVar *TreeViewColumn) {
+
+	xTreeViewMoveColumnAfter(x.GoPointer(), ColumnVar.GoPointer(), BaseColumnVar.GoPointer())
+
+}
+
+var xTreeViewRemoveColumn func(uintptr, uintptr) int
+
+// Removes column from tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) RemoveColumn(ColumnVar *TreeViewColumn) int {
+
+	cret := xTreeViewRemoveColumn(x.GoPointer(), ColumnVar.GoPointer())
+	return cret
+}
+
+var xTreeViewRowActivated func(uintptr, *TreePath, uintptr)
+
+// Activates the cell determined by path and column.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) RowActivated(PathVar *TreePath, ColumnVar *TreeViewColumn) {
+
+	xTreeViewRowActivated(x.GoPointer(), PathVar, ColumnVar.GoPointer())
+
+}
+
+var xTreeViewRowExpanded func(uintptr, *TreePath) bool
+
+// Returns true if the node pointed to by path is expanded in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) RowExpanded(PathVar *TreePath) bool {
+
+	cret := xTreeViewRowExpanded(x.GoPointer(), PathVar)
+	return cret
+}
+
+var xTreeViewScrollToCell func(uintptr, *TreePath, uintptr, bool, float32, float32)
+
+// Moves the alignments of tree_view to the position specified by column and
+// path.  If column is nil, then no horizontal scrolling occurs.  Likewise,
+// if path is nil no vertical scrolling occurs.  At a minimum, one of column
+// or path need to be non-nil.  row_align determines where the row is
+// placed, and col_align determines where column is placed.  Both are expected
+// to be between 0.0 and 1.0. 0.0 means left/top alignment, 1.0 means
+// right/bottom alignment, 0.5 means center.
+//
+// If use_align is false, then the alignment arguments are ignored, and the
+// tree does the minimum amount of work to scroll the cell onto the screen.
+// This means that the cell will be scrolled to the edge closest to its current
+// position.  If the cell is currently visible on the screen, nothing is done.
+//
+// This function only works if the model is set, and path is a valid row on the
+// model.  If the model changes before the tree_view is realized, the centered
+// path will be modified to reflect this change.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ScrollToCell(PathVar *TreePath, ColumnVar *TreeViewColumn, UseAlignVar bool, RowAlignVar float32, ColAlignVar float32) {
+
+	xTreeViewScrollToCell(x.GoPointer(), PathVar, ColumnVar.GoPointer(), UseAlignVar, RowAlignVar, ColAlignVar)
+
+}
+
+var xTreeViewScrollToPoint func(uintptr, int, int)
+
+// Scrolls the tree view such that the top-left corner of the visible
+// area is tree_x, tree_y, where tree_x and tree_y are specified
+// in tree coordinates.  The tree_view must be realized before
+// this function is called.  If it isn't, you probably want to be
+// using [TreeView.ScrollToCell].
+//
+// If either tree_x or tree_y are -1, then that direction isn’t scrolled.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) ScrollToPoint(TreeXVar int, TreeYVar int) {
+
+	xTreeViewScrollToPoint(x.GoPointer(), TreeXVar, TreeYVar)
+
+}
+
+var xTreeViewSetActivateOnSingleClick func(uintptr, bool)
+
+// Cause the `GtkTreeView`::row-activated signal to be emitted
+// on a single click instead of a double click.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetActivateOnSingleClick(SingleVar bool) {
+
+	xTreeViewSetActivateOnSingleClick(x.GoPointer(), SingleVar)
+
+}
+
+var xTreeViewSetColumnDragFunction func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets a user function for determining where a column may be dropped when
+// dragged.  This function is called on every column pair in turn at the
+// beginning of a column drag to determine where a drop can take place.  The
+// arguments passed to func are: the tree_view, the `GtkTreeViewColumn` being
+// dragged, the two `GtkTreeViewColumn`s determining the drop spot, and
+// user_data.  If either of the `GtkTreeViewColumn` arguments for the drop spot
+// are nil, then they indicate an edge.  If func is set to be nil, then
+// tree_view reverts to the default behavior of allowing all columns to be
+// dropped everywhere.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetColumnDragFunction(FuncVar *TreeViewColumnDropFunc, UserDataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr, arg4 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeViewSetColumnDragFunction(x.GoPointer(), FuncVarRef, UserDataVar, DestroyVarRef)
+
+}
+
+var xTreeViewSetCursor func(uintptr, *TreePath, uintptr, bool)
+
+// Sets the current keyboard focus to be at path, and selects it.  This is
+// useful when you want to focus the user’s attention on a particular row.  If
+// focus_column is not nil, then focus is given to the column specified by
+// it. Additionally, if focus_column is specified, and start_editing is
+// true, then editing should be started in the specified cell.
+// This function is often followed by gtk_widget_grab_focus (tree_view)
+// in order to give keyboard focus to the widget.  Please note that editing
+// can only happen when the widget is realized.
+//
+// If path is invalid for model, the current cursor (if any) will be unset
+// and the function will return without failing.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetCursor(PathVar *TreePath, FocusColumnVar *TreeViewColumn, StartEditingVar bool) {
+
+	xTreeViewSetCursor(x.GoPointer(), PathVar, FocusColumnVar.GoPointer(), StartEditingVar)
+
+}
+
+var xTreeViewSetCursorOnCell func(uintptr, *TreePath, uintptr, uintptr, bool)
+
+// Sets the current keyboard focus to be at path, and selects it.  This is
+// useful when you want to focus the user’s attention on a particular row.  If
+// focus_column is not nil, then focus is given to the column specified by
+// it. If focus_column and focus_cell are not nil, and focus_column
+// contains 2 or more editable or activatable cells, then focus is given to
+// the cell specified by focus_cell. Additionally, if focus_column is
+// specified, and start_editing is true, then editing should be started in
+// the specified cell.  This function is often followed by
+// gtk_widget_grab_focus (tree_view) in order to give keyboard focus to the
+// widget.  Please note that editing can only happen when the widget is
+// realized.
+//
+// If path is invalid for model, the current cursor (if any) will be unset
+// and the function will return without failing.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetCursorOnCell(PathVar *TreePath, FocusColumnVar *TreeViewColumn, FocusCellVar *CellRenderer, StartEditingVar bool) {
+
+	xTreeViewSetCursorOnCell(x.GoPointer(), PathVar, FocusColumnVar.GoPointer(), FocusCellVar.GoPointer(), StartEditingVar)
+
+}
+
+var xTreeViewSetDragDestRow func(uintptr, *TreePath, TreeViewDropPosition)
+
+// Sets the row that is highlighted for feedback.
+// If path is nil, an existing highlight is removed.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetDragDestRow(PathVar *TreePath, PosVar TreeViewDropPosition) {
+
+	xTreeViewSetDragDestRow(x.GoPointer(), PathVar, PosVar)
+
+}
+
+var xTreeViewSetEnableSearch func(uintptr, bool)
+
+// If enable_search is set, then the user can type in text to search through
+// the tree interactively (this is sometimes called "typeahead find").
+//
+// Note that even if this is false, the user can still initiate a search
+// using the “start-interactive-search” key binding.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetEnableSearch(EnableSearchVar bool) {
+
+	xTreeViewSetEnableSearch(x.GoPointer(), EnableSearchVar)
+
+}
+
+var xTreeViewSetEnableTreeLines func(uintptr, bool)
+
+// Sets whether to draw lines interconnecting the expanders in tree_view.
+// This does not have any visible effects for lists.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetEnableTreeLines(EnabledVar bool) {
+
+	xTreeViewSetEnableTreeLines(x.GoPointer(), EnabledVar)
+
+}
+
+var xTreeViewSetExpanderColumn func(uintptr, uintptr)
+
+// Sets the column to draw the expander arrow at. It must be in tree_view.
+// If column is nil, then the expander arrow is always at the first
+// visible column.
+//
+// If you do not want expander arrow to appear in your tree, set the
+// expander column to a hidden column.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetExpanderColumn(ColumnVar *TreeViewColumn) {
+
+	xTreeViewSetExpanderColumn(x.GoPointer(), ColumnVar.GoPointer())
+
+}
+
+var xTreeViewSetFixedHeightMode func(uintptr, bool)
+
+// Enables or disables the fixed height mode of tree_view.
+// Fixed height mode speeds up `GtkTreeView` by assuming that all
+// rows have the same height.
+// Only enable this option if all rows are the same height and all
+// columns are of type [TreeViewColumnFixedValue].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetFixedHeightMode(EnableVar bool) {
+
+	xTreeViewSetFixedHeightMode(x.GoPointer(), EnableVar)
+
+}
+
+var xTreeViewSetGridLines func(uintptr, TreeViewGridLines)
+
+// Sets which grid lines to draw in tree_view.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetGridLines(GridLinesVar TreeViewGridLines) {
+
+	xTreeViewSetGridLines(x.GoPointer(), GridLinesVar)
+
+}
+
+var xTreeViewSetHeadersClickable func(uintptr, bool)
+
+// Allow the column title buttons to be clicked.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetHeadersClickable(SettingVar bool) {
+
+	xTreeViewSetHeadersClickable(x.GoPointer(), SettingVar)
+
+}
+
+var xTreeViewSetHeadersVisible func(uintptr, bool)
+
+// Sets the visibility state of the headers.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetHeadersVisible(HeadersVisibleVar bool) {
+
+	xTreeViewSetHeadersVisible(x.GoPointer(), HeadersVisibleVar)
+
+}
+
+var xTreeViewSetHoverExpand func(uintptr, bool)
+
+// Enables or disables the hover expansion mode of tree_view.
+// Hover expansion makes rows expand or collapse if the pointer
+// moves over them.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetHoverExpand(ExpandVar bool) {
+
+	xTreeViewSetHoverExpand(x.GoPointer(), ExpandVar)
+
+}
+
+var xTreeViewSetHoverSelection func(uintptr, bool)
+
+// Enables or disables the hover selection mode of tree_view.
+// Hover selection makes the selected row follow the pointer.
+// Currently, this works only for the selection modes
+// [SelectionSingleValue] and [SelectionBrowseValue].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetHoverSelection(HoverVar bool) {
+
+	xTreeViewSetHoverSelection(x.GoPointer(), HoverVar)
+
+}
+
+var xTreeViewSetLevelIndentation func(uintptr, int)
+
+// Sets the amount of extra indentation for child levels to use in tree_view
+// in addition to the default indentation.  The value should be specified in
+// pixels, a value of 0 disables this feature and in this case only the default
+// indentation will be used.
+// This does not have any visible effects for lists.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetLevelIndentation(IndentationVar int) {
+
+	xTreeViewSetLevelIndentation(x.GoPointer(), IndentationVar)
+
+}
+
+var xTreeViewSetModel func(uintptr, uintptr)
+
+// Sets the model for a `GtkTreeView`.  If the tree_view already has a model
+// set, it will remove it before setting the new model.  If model is nil,
+// then it will unset the old model.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetModel(ModelVar TreeModel) {
+
+	xTreeViewSetModel(x.GoPointer(), ModelVar.GoPointer())
+
+}
+
+var xTreeViewSetReorderable func(uintptr, bool)
+
+// This function is a convenience function to allow you to reorder
+// models that support the `GtkTreeDragSourceIface` and the
+// `GtkTreeDragDestIface`.  Both `GtkTreeStore` and `GtkListStore` support
+// these.  If reorderable is true, then the user can reorder the
+// model by dragging and dropping rows. The developer can listen to
+// these changes by connecting to the model’s `GtkTreeModel::row-inserted`
+// and `GtkTreeModel::row-deleted` signals. The reordering is implemented
+// by setting up the tree view as a drag source and destination.
+// Therefore, drag and drop can not be used in a reorderable view for any
+// other purpose.
+//
+// This function does not give you any degree of control over the order -- any
+// reordering is allowed.  If more control is needed, you should probably
+// handle drag and drop manually.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetReorderable(ReorderableVar bool) {
+
+	xTreeViewSetReorderable(x.GoPointer(), ReorderableVar)
+
+}
+
+var xTreeViewSetRowSeparatorFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the row separator function, which is used to determine
+// whether a row should be drawn as a separator. If the row separator
+// function is nil, no separators are drawn. This is the default value.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetRowSeparatorFunc(FuncVar *TreeViewRowSeparatorFunc, DataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 *TreeIter, arg2 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				return cbFn(arg0, arg1, arg2)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeViewSetRowSeparatorFunc(x.GoPointer(), FuncVarRef, DataVar, DestroyVarRef)
+
+}
+
+var xTreeViewSetRubberBanding func(uintptr, bool)
+
+// Enables or disables rubber banding in tree_view.  If the selection mode
+// is [SelectionMultipleValue], rubber banding will allow the user to select
+// multiple rows by dragging the mouse.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetRubberBanding(EnableVar bool) {
+
+	xTreeViewSetRubberBanding(x.GoPointer(), EnableVar)
+
+}
+
+var xTreeViewSetSearchColumn func(uintptr, int)
+
+// Sets column as the column where the interactive search code should
+// search in for the current model.
+//
+// If the search column is set, users can use the “start-interactive-search”
+// key binding to bring up search popup. The enable-search property controls
+// whether simply typing text will also start an interactive search.
+//
+// Note that column refers to a column of the current model. The search
+// column is reset to -1 when the model is changed.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetSearchColumn(ColumnVar int) {
+
+	xTreeViewSetSearchColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xTreeViewSetSearchEntry func(uintptr, uintptr)
+
+// Sets the entry which the interactive search code will use for this
+// tree_view.  This is useful when you want to provide a search entry
+// in our interface at all time at a fixed position.  Passing nil for
+// entry will make the interactive search code use the built-in popup
+// entry again.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetSearchEntry(EntryVar Editable) {
+
+	xTreeViewSetSearchEntry(x.GoPointer(), EntryVar.GoPointer())
+
+}
+
+var xTreeViewSetSearchEqualFunc func(uintptr, uintptr, uintptr, uintptr)
+
+// Sets the compare function for the interactive search capabilities; note
+// that somewhat like strcmp() returning 0 for equality
+// `GtkTreeView`SearchEqualFunc returns false on matches.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetSearchEqualFunc(SearchEqualFuncVar *TreeViewSearchEqualFunc, SearchUserDataVar uintptr, SearchDestroyVar *glib.DestroyNotify) {
+
+	var SearchEqualFuncVarRef uintptr
+	if SearchEqualFuncVar != nil {
+		SearchEqualFuncVarPtr := uintptr(unsafe.Pointer(SearchEqualFuncVar))
+		if cbRefPtr, ok := glib.GetCallback(SearchEqualFuncVarPtr); ok {
+			SearchEqualFuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 int, arg2 uintptr, arg3 *TreeIter, arg4 uintptr) bool {
+				defer core.RecoverPanic()
+				cbFn := *SearchEqualFuncVar
+				return cbFn(arg0, arg1, core.GoString(arg2), arg3, arg4)
+			}
+			SearchEqualFuncVarRef = core.NewCallback(fcb)
+			SearchEqualFuncVarRef = glib.SaveCallbackWithClosure(SearchEqualFuncVarPtr, SearchEqualFuncVarRef, SearchEqualFuncVar)
+		}
+	}
+
+	var SearchDestroyVarRef uintptr
+	if SearchDestroyVar != nil {
+		SearchDestroyVarPtr := uintptr(unsafe.Pointer(SearchDestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(SearchDestroyVarPtr); ok {
+			SearchDestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *SearchDestroyVar
+				cbFn(arg0)
+			}
+			SearchDestroyVarRef = core.NewCallback(fcb)
+			SearchDestroyVarRef = glib.SaveCallbackWithClosure(SearchDestroyVarPtr, SearchDestroyVarRef, SearchDestroyVar)
+		}
+	}
+
+	xTreeViewSetSearchEqualFunc(x.GoPointer(), SearchEqualFuncVarRef, SearchUserDataVar, SearchDestroyVarRef)
+
+}
+
+var xTreeViewSetShowExpanders func(uintptr, bool)
+
+// Sets whether to draw and enable expanders and indent child rows in
+// tree_view.  When disabled there will be no expanders visible in trees
+// and there will be no way to expand and collapse rows by default.  Also
+// note that hiding the expanders will disable the default indentation.  You
+// can set a custom indentation in this case using
+// [TreeView.SetLevelIndentation].
+// This does not have any visible effects for lists.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetShowExpanders(EnabledVar bool) {
+
+	xTreeViewSetShowExpanders(x.GoPointer(), EnabledVar)
+
+}
+
+var xTreeViewSetTooltipCell func(uintptr, uintptr, *TreePath, uintptr, uintptr)
+
+// Sets the tip area of tooltip to the area path, column and cell have
+// in common.  For example if path is nil and column is set, the tip
+// area will be set to the full area covered by column.  See also
+// [Tooltip.SetTipArea].
+//
+// Note that if path is not specified and cell is set and part of a column
+// containing the expander, the tooltip might not show and hide at the correct
+// position.  In such cases path must be set to the current node under the
+// mouse cursor for this function to operate correctly.
+//
+// See also [TreeView.SetTooltipColumn] for a simpler alternative.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetTooltipCell(TooltipVar *Tooltip, PathVar *TreePath, ColumnVar *TreeViewColumn, CellVar *CellRenderer) {
+
+	xTreeViewSetTooltipCell(x.GoPointer(), TooltipVar.GoPointer(), PathVar, ColumnVar.GoPointer(), CellVar.GoPointer())
+
+}
+
+var xTreeViewSetTooltipColumn func(uintptr, int)
+
+// If you only plan to have simple (text-only) tooltips on full rows, you
+// can use this function to have `GtkTreeView` handle these automatically
+// for you. column should be set to the column in tree_view’s model
+// containing the tooltip texts, or -1 to disable this feature.
+//
+// When enabled, `GtkWidget:has-tooltip` will be set to true and
+// tree_view will connect a `GtkWidget::query-tooltip` signal handler.
+//
+// Note that the signal handler sets the text with [Tooltip.SetMarkup],
+// so &, <, etc have to be escaped in the text.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetTooltipColumn(ColumnVar int) {
+
+	xTreeViewSetTooltipColumn(x.GoPointer(), ColumnVar)
+
+}
+
+var xTreeViewSetTooltipRow func(uintptr, uintptr, *TreePath)
+
+// Sets the tip area of tooltip to be the area covered by the row at path.
+// See also [TreeView.SetTooltipColumn] for a simpler alternative.
+// See also [Tooltip.SetTipArea].
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) SetTooltipRow(TooltipVar *Tooltip, PathVar *TreePath) {
+
+	xTreeViewSetTooltipRow(x.GoPointer(), TooltipVar.GoPointer(), PathVar)
+
+}
+
+var xTreeViewUnsetRowsDragDest func(uintptr)
+
+// Undoes the effect of
+// [TreeView.EnableModelDragDest]. Calling this method sets
+// `GtkTreeView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) UnsetRowsDragDest() {
+
+	xTreeViewUnsetRowsDragDest(x.GoPointer())
+
+}
+
+var xTreeViewUnsetRowsDragSource func(uintptr)
+
+// Undoes the effect of
+// [TreeView.EnableModelDragSource]. Calling this method sets
+// `GtkTreeView`:reorderable to false.
+//
+// Deprecated: since 4.10. Use [ListView] or [ColumnView] instead
+func (x *TreeView) UnsetRowsDragSource() {
+
+	xTreeViewUnsetRowsDragSource(x.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewTreeView, libs, "gtk_tree_view_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreeViewWithModel, libs, "gtk_tree_view_new_with_model", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeViewAppendColumn, libs, "gtk_tree_view_append_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewCollapseAll, libs, "gtk_tree_view_collapse_all", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewCollapseRow, libs, "gtk_tree_view_collapse_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnsAutosize, libs, "gtk_tree_view_columns_autosize", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertBinWindowToTreeCoords, libs, "gtk_tree_view_convert_bin_window_to_tree_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertBinWindowToWidgetCoords, libs, "gtk_tree_view_convert_bin_window_to_widget_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertTreeToBinWindowCoords, libs, "gtk_tree_view_convert_tree_to_bin_window_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertTreeToWidgetCoords, libs, "gtk_tree_view_convert_tree_to_widget_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertWidgetToBinWindowCoords, libs, "gtk_tree_view_convert_widget_to_bin_window_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewConvertWidgetToTreeCoords, libs, "gtk_tree_view_convert_widget_to_tree_coords", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewCreateRowDragIcon, libs, "gtk_tree_view_create_row_drag_icon", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewEnableModelDragDest, libs, "gtk_tree_view_enable_model_drag_dest", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewEnableModelDragSource, libs, "gtk_tree_view_enable_model_drag_source", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewExpandAll, libs, "gtk_tree_view_expand_all", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewExpandRow, libs, "gtk_tree_view_expand_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewExpandToPath, libs, "gtk_tree_view_expand_to_path", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetActivateOnSingleClick, libs, "gtk_tree_view_get_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetBackgroundArea, libs, "gtk_tree_view_get_background_area", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetCellArea, libs, "gtk_tree_view_get_cell_area", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetColumn, libs, "gtk_tree_view_get_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetColumns, libs, "gtk_tree_view_get_columns", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetCursor, libs, "gtk_tree_view_get_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetDestRowAtPos, libs, "gtk_tree_view_get_dest_row_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetDragDestRow, libs, "gtk_tree_view_get_drag_dest_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetEnableSearch, libs, "gtk_tree_view_get_enable_search", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetEnableTreeLines, libs, "gtk_tree_view_get_enable_tree_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetExpanderColumn, libs, "gtk_tree_view_get_expander_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetFixedHeightMode, libs, "gtk_tree_view_get_fixed_height_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetGridLines, libs, "gtk_tree_view_get_grid_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetHeadersClickable, libs, "gtk_tree_view_get_headers_clickable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetHeadersVisible, libs, "gtk_tree_view_get_headers_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetHoverExpand, libs, "gtk_tree_view_get_hover_expand", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetHoverSelection, libs, "gtk_tree_view_get_hover_selection", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetLevelIndentation, libs, "gtk_tree_view_get_level_indentation", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetModel, libs, "gtk_tree_view_get_model", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetNColumns, libs, "gtk_tree_view_get_n_columns", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetPathAtPos, libs, "gtk_tree_view_get_path_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetReorderable, libs, "gtk_tree_view_get_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetRowSeparatorFunc, libs, "gtk_tree_view_get_row_separator_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetRubberBanding, libs, "gtk_tree_view_get_rubber_banding", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetSearchColumn, libs, "gtk_tree_view_get_search_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetSearchEntry, libs, "gtk_tree_view_get_search_entry", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetSearchEqualFunc, libs, "gtk_tree_view_get_search_equal_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetSelection, libs, "gtk_tree_view_get_selection", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetShowExpanders, libs, "gtk_tree_view_get_show_expanders", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetTooltipColumn, libs, "gtk_tree_view_get_tooltip_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetTooltipContext, libs, "gtk_tree_view_get_tooltip_context", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetVisibleRange, libs, "gtk_tree_view_get_visible_range", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewGetVisibleRect, libs, "gtk_tree_view_get_visible_rect", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewInsertColumn, libs, "gtk_tree_view_insert_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewInsertColumnWithAttributes, libs, "gtk_tree_view_insert_column_with_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewInsertColumnWithDataFunc, libs, "gtk_tree_view_insert_column_with_data_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewIsBlankAtPos, libs, "gtk_tree_view_is_blank_at_pos", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewIsRubberBandingActive, libs, "gtk_tree_view_is_rubber_banding_active", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewMapExpandedRows, libs, "gtk_tree_view_map_expanded_rows", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewMoveColumnAfter, libs, "gtk_tree_view_move_column_after", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewRemoveColumn, libs, "gtk_tree_view_remove_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewRowActivated, libs, "gtk_tree_view_row_activated", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewRowExpanded, libs, "gtk_tree_view_row_expanded", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewScrollToCell, libs, "gtk_tree_view_scroll_to_cell", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewScrollToPoint, libs, "gtk_tree_view_scroll_to_point", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetActivateOnSingleClick, libs, "gtk_tree_view_set_activate_on_single_click", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetColumnDragFunction, libs, "gtk_tree_view_set_column_drag_function", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetCursor, libs, "gtk_tree_view_set_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetCursorOnCell, libs, "gtk_tree_view_set_cursor_on_cell", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetDragDestRow, libs, "gtk_tree_view_set_drag_dest_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetEnableSearch, libs, "gtk_tree_view_set_enable_search", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetEnableTreeLines, libs, "gtk_tree_view_set_enable_tree_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetExpanderColumn, libs, "gtk_tree_view_set_expander_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetFixedHeightMode, libs, "gtk_tree_view_set_fixed_height_mode", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetGridLines, libs, "gtk_tree_view_set_grid_lines", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetHeadersClickable, libs, "gtk_tree_view_set_headers_clickable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetHeadersVisible, libs, "gtk_tree_view_set_headers_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetHoverExpand, libs, "gtk_tree_view_set_hover_expand", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetHoverSelection, libs, "gtk_tree_view_set_hover_selection", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetLevelIndentation, libs, "gtk_tree_view_set_level_indentation", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetModel, libs, "gtk_tree_view_set_model", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetReorderable, libs, "gtk_tree_view_set_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetRowSeparatorFunc, libs, "gtk_tree_view_set_row_separator_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetRubberBanding, libs, "gtk_tree_view_set_rubber_banding", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetSearchColumn, libs, "gtk_tree_view_set_search_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetSearchEntry, libs, "gtk_tree_view_set_search_entry", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetSearchEqualFunc, libs, "gtk_tree_view_set_search_equal_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetShowExpanders, libs, "gtk_tree_view_set_show_expanders", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetTooltipCell, libs, "gtk_tree_view_set_tooltip_cell", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetTooltipColumn, libs, "gtk_tree_view_set_tooltip_column", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewSetTooltipRow, libs, "gtk_tree_view_set_tooltip_row", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewUnsetRowsDragDest, libs, "gtk_tree_view_unset_rows_drag_dest", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewUnsetRowsDragSource, libs, "gtk_tree_view_unset_rows_drag_source", "GTK", "")
+
+}
@@ -0,0 +1,853 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewTreeViewColumn func() uintptr
+
+// Creates a new `GtkTreeViewColumn`.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func NewTreeViewColumn() *TreeViewColumn {
+	var cls *TreeViewColumn
+
+	cret := xNewTreeViewColumn()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeViewColumn{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewTreeViewColumnWithArea func(uintptr) uintptr
+
+// Creates a new `GtkTreeViewColumn` using area to render its cells.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func NewTreeViewColumnWithArea(AreaVar *CellArea) *TreeViewColumn {
+	var cls *TreeViewColumn
+
+	cret := xNewTreeViewColumnWithArea(AreaVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeViewColumn{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewTreeViewColumnWithAttributes func(string, uintptr, ...interface{}) uintptr
+
+// Creates a new `GtkTreeViewColumn` with a number of default values.
+// This is equivalent to calling [TreeViewColumn.SetTitle],
+// [TreeViewColumn.PackStart], and
+// [TreeViewColumn.SetAttributes] on the newly created `GtkTreeViewColumn`.
+//
+// Here’s a simple example:
+//
+//	enum { TEXT_COLUMN, COLOR_COLUMN, N_COLUMNS };
+//	// ...
+//	{
+//	  GtkTreeViewColumn *column;
+//	  GtkCellRenderer   *renderer = gtk_cell_renderer_text_new ();
+//
+//	  column = gtk_tree_view_column_new_with_attributes ("Title",
+//	                                                     renderer,
+//	                                                     "text", TEXT_COLUMN,
+//	                                                     "foreground", COLOR_COLUMN,
+//	                                                     NULL);
+//	}
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func NewTreeViewColumnWithAttributes(TitleVar string, CellVar *CellRenderer, varArgs ...interface{}) *TreeViewColumn {
+	var cls *TreeViewColumn
+
+	cret := xNewTreeViewColumnWithAttributes(TitleVar, CellVar.GoPointer(), varArgs...)
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &TreeViewColumn{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewColumnAddAttribute func(uintptr, uintptr, string, int)
+
+// Adds an attribute mapping to the list in tree_column.
+//
+// The column is the
+// column of the model to get a value from, and the attribute is the
+// parameter on cell_renderer to be set from the value. So for example
+// if column 2 of the model contains strings, you could have the
+// “text” attribute of a `GtkCellRendererText` get its values from
+// column 2.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) AddAttribute(CellRendererVar *CellRenderer, AttributeVar string, ColumnVar int) {
+
+	xTreeViewColumnAddAttribute(x.GoPointer(), CellRendererVar.GoPointer(), AttributeVar, ColumnVar)
+
+}
+
+var xTreeViewColumnCellGetPosition func(uintptr, uintptr, *int, *int) bool
+
+// Obtains the horizontal position and size of a cell in a column.
+//
+// If the  cell is not found in the column, start_pos and width
+// are not changed and false is returned.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) CellGetPosition(CellRendererVar *CellRenderer, XOffsetVar *int, WidthVar *int) bool {
+
+	cret := xTreeViewColumnCellGetPosition(x.GoPointer(), CellRendererVar.GoPointer(), XOffsetVar, WidthVar)
+	return cret
+}
+
+var xTreeViewColumnCellGetSize func(uintptr, *int, *int, *int, *int)
+
+// Obtains the width and height needed to render the column.  This is used
+// primarily by the `GtkTreeView`.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) CellGetSize(XOffsetVar *int, YOffsetVar *int, WidthVar *int, HeightVar *int) {
+
+	xTreeViewColumnCellGetSize(x.GoPointer(), XOffsetVar, YOffsetVar, WidthVar, HeightVar)
+
+}
+
+var xTreeViewColumnCellIsVisible func(uintptr) bool
+
+// Returns true if any of the cells packed into the tree_column are visible.
+// For this to be meaningful, you must first initialize the cells with
+// [TreeViewColumn.CellSetCellData]
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) CellIsVisible() bool {
+
+	cret := xTreeViewColumnCellIsVisible(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnCellSetCellData func(uintptr, uintptr, *TreeIter, bool, bool)
+
+// Sets the cell renderer based on the tree_model and iter.  That is, for
+// every attribute mapping in tree_column, it will get a value from the set
+// column on the iter, and use that value to set the attribute on the cell
+// renderer.  This is used primarily by the `GtkTreeView`.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) CellSetCellData(TreeModelVar TreeModel, IterVar *TreeIter, IsExpanderVar bool, IsExpandedVar bool) {
+
+	xTreeViewColumnCellSetCellData(x.GoPointer(), TreeModelVar.GoPointer(), IterVar, IsExpanderVar, IsExpandedVar)
+
+}
+
+var xTreeViewColumnClear func(uintptr)
+
+// Unsets all the mappings on all renderers on the tree_column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) Clear() {
+
+	xTreeViewColumnClear(x.GoPointer())
+
+}
+
+var xTreeViewColumnClearAttributes func(uintptr, uintptr)
+
+// Clears all existing attributes previously set with
+// [TreeViewColumn.SetAttributes].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) ClearAttributes(CellRendererVar *CellRenderer) {
+
+	xTreeViewColumnClearAttributes(x.GoPointer(), CellRendererVar.GoPointer())
+
+}
+
+var xTreeViewColumnClicked func(uintptr)
+
+// Emits the “clicked” signal on the column.  This function will only work if
+// tree_column is clickable.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) Clicked() {
+
+	xTreeViewColumnClicked(x.GoPointer())
+
+}
+
+var xTreeViewColumnFocusCell func(uintptr, uintptr)
+
+// Sets the current keyboard focus to be at cell, if the column contains
+// 2 or more editable and activatable cells.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) FocusCell(CellVar *CellRenderer) {
+
+	xTreeViewColumnFocusCell(x.GoPointer(), CellVar.GoPointer())
+
+}
+
+var xTreeViewColumnGetAlignment func(uintptr) float32
+
+// Returns the current x alignment of tree_column.  This value can range
+// between 0.0 and 1.0.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetAlignment() float32 {
+
+	cret := xTreeViewColumnGetAlignment(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetButton func(uintptr) uintptr
+
+// Returns the button used in the treeview column header
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetButton() *Widget {
+	var cls *Widget
+
+	cret := xTreeViewColumnGetButton(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewColumnGetClickable func(uintptr) bool
+
+// Returns true if the user can click on the header for the column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetClickable() bool {
+
+	cret := xTreeViewColumnGetClickable(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetExpand func(uintptr) bool
+
+// Returns true if the column expands to fill available space.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetExpand() bool {
+
+	cret := xTreeViewColumnGetExpand(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetFixedWidth func(uintptr) int
+
+// Gets the fixed width of the column.  This may not be the actual displayed
+// width of the column; for that, use [TreeViewColumn.GetWidth].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetFixedWidth() int {
+
+	cret := xTreeViewColumnGetFixedWidth(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetMaxWidth func(uintptr) int
+
+// Returns the maximum width in pixels of the tree_column, or -1 if no maximum
+// width is set.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetMaxWidth() int {
+
+	cret := xTreeViewColumnGetMaxWidth(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetMinWidth func(uintptr) int
+
+// Returns the minimum width in pixels of the tree_column, or -1 if no minimum
+// width is set.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetMinWidth() int {
+
+	cret := xTreeViewColumnGetMinWidth(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetReorderable func(uintptr) bool
+
+// Returns true if the tree_column can be reordered by the user.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetReorderable() bool {
+
+	cret := xTreeViewColumnGetReorderable(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetResizable func(uintptr) bool
+
+// Returns true if the tree_column can be resized by the end user.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetResizable() bool {
+
+	cret := xTreeViewColumnGetResizable(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetSizing func(uintptr) TreeViewColumnSizing
+
+// Returns the current type of tree_column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetSizing() TreeViewColumnSizing {
+
+	cret := xTreeViewColumnGetSizing(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetSortColumnId func(uintptr) int
+
+// Gets the logical sort_column_id that the model sorts on
+// when this column is selected for sorting.
+//
+// See [TreeViewColumn.SetSortColumnId].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetSortColumnId() int {
+
+	cret := xTreeViewColumnGetSortColumnId(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetSortIndicator func(uintptr) bool
+
+// Gets the value set by [TreeViewColumn.SetSortIndicator].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetSortIndicator() bool {
+
+	cret := xTreeViewColumnGetSortIndicator(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetSortOrder func(uintptr) SortType
+
+// Gets the value set by [TreeViewColumn.SetSortOrder].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetSortOrder() SortType {
+
+	cret := xTreeViewColumnGetSortOrder(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetSpacing func(uintptr) int
+
+// Returns the spacing of tree_column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetSpacing() int {
+
+	cret := xTreeViewColumnGetSpacing(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetTitle func(uintptr) string
+
+// Returns the title of the widget.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetTitle() string {
+
+	cret := xTreeViewColumnGetTitle(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetTreeView func(uintptr) uintptr
+
+// Returns the `GtkTreeView` wherein tree_column has been inserted.
+// If column is currently not inserted in any tree view, nil is
+// returned.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetTreeView() *Widget {
+	var cls *Widget
+
+	cret := xTreeViewColumnGetTreeView(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewColumnGetVisible func(uintptr) bool
+
+// Returns true if tree_column is visible.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetVisible() bool {
+
+	cret := xTreeViewColumnGetVisible(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetWidget func(uintptr) uintptr
+
+// Returns the `GtkWidget` in the button on the column header.
+//
+// If a custom widget has not been set then nil is returned.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetWidget() *Widget {
+	var cls *Widget
+
+	cret := xTreeViewColumnGetWidget(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Widget{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xTreeViewColumnGetWidth func(uintptr) int
+
+// Returns the current size of tree_column in pixels.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetWidth() int {
+
+	cret := xTreeViewColumnGetWidth(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnGetXOffset func(uintptr) int
+
+// Returns the current X offset of tree_column in pixels.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) GetXOffset() int {
+
+	cret := xTreeViewColumnGetXOffset(x.GoPointer())
+	return cret
+}
+
+var xTreeViewColumnPackEnd func(uintptr, uintptr, bool)
+
+// Adds the cell to end of the column. If expand is false, then the cell
+// is allocated no more space than it needs. Any unused space is divided
+// evenly between cells for which expand is true.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) PackEnd(CellVar *CellRenderer, ExpandVar bool) {
+
+	xTreeViewColumnPackEnd(x.GoPointer(), CellVar.GoPointer(), ExpandVar)
+
+}
+
+var xTreeViewColumnPackStart func(uintptr, uintptr, bool)
+
+// Packs the cell into the beginning of the column. If expand is false, then
+// the cell is allocated no more space than it needs. Any unused space is divided
+// evenly between cells for which expand is true.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) PackStart(CellVar *CellRenderer, ExpandVar bool) {
+
+	xTreeViewColumnPackStart(x.GoPointer(), CellVar.GoPointer(), ExpandVar)
+
+}
+
+var xTreeViewColumnQueueResize func(uintptr)
+
+// Flags the column, and the cell renderers added to this column, to have
+// their sizes renegotiated.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) QueueResize() {
+
+	xTreeViewColumnQueueResize(x.GoPointer())
+
+}
+
+var xTreeViewColumnSetAlignment func(uintptr, float32)
+
+// Sets the alignment of the title or custom widget inside the column header.
+// The alignment determines its location inside the button -- 0.0 for left, 0.5
+// for center, 1.0 for right.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetAlignment(XalignVar float32) {
+
+	xTreeViewColumnSetAlignment(x.GoPointer(), XalignVar)
+
+}
+
+var xTreeViewColumnSetAttributes func(uintptr, uintptr, ...interface{})
+
+// Sets the attributes in the list as the attributes of tree_column.
+//
+// The attributes should be in attribute/column order, as in
+// [TreeViewColumn.AddAttribute]. All existing attributes
+// are removed, and replaced with the new attributes.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetAttributes(CellRendererVar *CellRenderer, varArgs ...interface{}) {
+
+	xTreeViewColumnSetAttributes(x.GoPointer(), CellRendererVar.GoPointer(), varArgs...)
+
+}
+
+var xTreeViewColumnSetCellDataFunc func(uintptr, uintptr, uintptr, uintptr, uintptr)
+
+// Sets the `GtkTreeCellDataFunc` to use for the column.
+//
+// This
+// function is used instead of the standard attributes mapping for
+// setting the column value, and should set the value of tree_column's
+// cell renderer as appropriate.  func may be nil to remove an
+// older one.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetCellDataFunc(CellRendererVar *CellRenderer, FuncVar *TreeCellDataFunc, FuncDataVar uintptr, DestroyVar *glib.DestroyNotify) {
+
+	var FuncVarRef uintptr
+	if FuncVar != nil {
+		FuncVarPtr := uintptr(unsafe.Pointer(FuncVar))
+		if cbRefPtr, ok := glib.GetCallback(FuncVarPtr); ok {
+			FuncVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr, arg3 *TreeIter, arg4 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *FuncVar
+				cbFn(arg0, arg1, arg2, arg3, arg4)
+			}
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+		}
+	}
+
+	var DestroyVarRef uintptr
+	if DestroyVar != nil {
+		DestroyVarPtr := uintptr(unsafe.Pointer(DestroyVar))
+		if cbRefPtr, ok := glib.GetCallback(DestroyVarPtr); ok {
+			DestroyVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *DestroyVar
+				cbFn(arg0)
+			}
+			DestroyVarRef = core.NewCallback(fcb)
+			DestroyVarRef = glib.SaveCallbackWithClosure(DestroyVarPtr, DestroyVarRef, DestroyVar)
+		}
+	}
+
+	xTreeViewColumnSetCellDataFunc(x.GoPointer(), CellRendererVar.GoPointer(), FuncVarRef, FuncDataVar, DestroyVarRef)
+
+}
+
+var xTreeViewColumnSetClickable func(uintptr, bool)
+
+// Sets the header to be active if clickable is true.  When the header is
+// active, then it can take keyboard focus, and can be clicked.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetClickable(ClickableVar bool) {
+
+	xTreeViewColumnSetClickable(x.GoPointer(), ClickableVar)
+
+}
+
+var xTreeViewColumnSetExpand func(uintptr, bool)
+
+// Sets the column to take available extra space.  This space is shared equally
+// amongst all columns that have the expand set to true.  If no column has this
+// option set, then the last column gets all extra space.  By default, every
+// column is created with this false.
+//
+// Along with “fixed-width”, the “expand” property changes when the column is
+// resized by the user.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetExpand(ExpandVar bool) {
+
+	xTreeViewColumnSetExpand(x.GoPointer(), ExpandVar)
+
+}
+
+var xTreeViewColumnSetFixedWidth func(uintptr, int)
+
+// If fixed_width is not -1, sets the fixed width of tree_column; otherwise
+// unsets it.  The effective value of fixed_width is clamped between the
+// minimum and maximum width of the column; however, the value stored in the
+// “fixed-width” property is not clamped.  If the column sizing is
+// [TreeViewColumnGrowOnlyValue] or [TreeViewColumnAutosizeValue], setting
+// a fixed width overrides the automatically calculated width.  Note that
+// fixed_width is only a hint to GTK; the width actually allocated to the
+// column may be greater or less than requested.
+//
+// Along with “expand”, the “fixed-width” property changes when the column is
+// resized by the user.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetFixedWidth(FixedWidthVar int) {
+
+	xTreeViewColumnSetFixedWidth(x.GoPointer(), FixedWidthVar)
+
+}
+
+var xTreeViewColumnSetMaxWidth func(uintptr, int)
+
+// Sets the maximum width of the tree_column.  If max_width is -1, then the
+// maximum width is unset.  Note, the column can actually be wider than max
+// width if it’s the last column in a view.  In this case, the column expands to
+// fill any extra space.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetMaxWidth(MaxWidthVar int) {
+
+	xTreeViewColumnSetMaxWidth(x.GoPointer(), MaxWidthVar)
+
+}
+
+var xTreeViewColumnSetMinWidth func(uintptr, int)
+
+// Sets the minimum width of the tree_column.  If min_width is -1, then the
+// minimum width is unset.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetMinWidth(MinWidthVar int) {
+
+	xTreeViewColumnSetMinWidth(x.GoPointer(), MinWidthVar)
+
+}
+
+var xTreeViewColumnSetReorderable func(uintptr, bool)
+
+// If reorderable is true, then the column can be reordered by the end user
+// dragging the header.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetReorderable(ReorderableVar bool) {
+
+	xTreeViewColumnSetReorderable(x.GoPointer(), ReorderableVar)
+
+}
+
+var xTreeViewColumnSetResizable func(uintptr, bool)
+
+// If resizable is true, then the user can explicitly resize the column by
+// grabbing the outer edge of the column button.
+//
+// If resizable is true and
+// sizing mode of the column is [TreeViewColumnAutosizeValue], then the sizing
+// mode is changed to [TreeViewColumnGrowOnlyValue].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetResizable(ResizableVar bool) {
+
+	xTreeViewColumnSetResizable(x.GoPointer(), ResizableVar)
+
+}
+
+var xTreeViewColumnSetSizing func(uintptr, TreeViewColumnSizing)
+
+// Sets the growth behavior of tree_column to type.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetSizing(TypeVar TreeViewColumnSizing) {
+
+	xTreeViewColumnSetSizing(x.GoPointer(), TypeVar)
+
+}
+
+var xTreeViewColumnSetSortColumnId func(uintptr, int)
+
+// Sets the logical sort_column_id that this column sorts on when this column
+// is selected for sorting.  Doing so makes the column header clickable.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetSortColumnId(SortColumnIdVar int) {
+
+	xTreeViewColumnSetSortColumnId(x.GoPointer(), SortColumnIdVar)
+
+}
+
+var xTreeViewColumnSetSortIndicator func(uintptr, bool)
+
+// Call this function with a setting of true to display an arrow in
+// the header button indicating the column is sorted. Call
+// [TreeViewColumn.SetSortOrder] to change the direction of
+// the arrow.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetSortIndicator(SettingVar bool) {
+
+	xTreeViewColumnSetSortIndicator(x.GoPointer(), SettingVar)
+
+}
+
+var xTreeViewColumnSetSortOrder func(uintptr, SortType)
+
+// Changes the appearance of the sort indicator.
+//
+// This does not actually sort the model.  Use
+// [TreeViewColumn.SetSortColumnId] if you want automatic sorting
+// support.  This function is primarily for custom sorting behavior, and should
+// be used in conjunction with [TreeSortable.SetSortColumnId] to do
+// that. For custom models, the mechanism will vary.
+//
+// The sort indicator changes direction to indicate normal sort or reverse sort.
+// Note that you must have the sort indicator enabled to see anything when
+// calling this function; see [TreeViewColumn.SetSortIndicator].
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetSortOrder(OrderVar SortType) {
+
+	xTreeViewColumnSetSortOrder(x.GoPointer(), OrderVar)
+
+}
+
+var xTreeViewColumnSetSpacing func(uintptr, int)
+
+// Sets the spacing field of tree_column, which is the number of pixels to
+// place between cell renderers packed into it.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetSpacing(SpacingVar int) {
+
+	xTreeViewColumnSetSpacing(x.GoPointer(), SpacingVar)
+
+}
+
+var xTreeViewColumnSetTitle func(uintptr, string)
+
+// Sets the title of the tree_column.  If a custom widget has been set, then
+// this value is ignored.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetTitle(TitleVar string) {
+
+	xTreeViewColumnSetTitle(x.GoPointer(), TitleVar)
+
+}
+
+var xTreeViewColumnSetVisible func(uintptr, bool)
+
+// Sets the visibility of tree_column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetVisible(VisibleVar bool) {
+
+	xTreeViewColumnSetVisible(x.GoPointer(), VisibleVar)
+
+}
+
+var xTreeViewColumnSetWidget func(uintptr, uintptr)
+
+// Sets the widget in the header to be widget.  If widget is nil, then the
+// header button is set with a `GtkLabel` set to the title of tree_column.
+//
+// Deprecated: since 4.10. Use GtkColumnView instead
+func (x *TreeViewColumn) SetWidget(WidgetVar *Widget) {
+
+	xTreeViewColumnSetWidget(x.GoPointer(), WidgetVar.GoPointer())
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewTreeViewColumn, libs, "gtk_tree_view_column_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreeViewColumnWithArea, libs, "gtk_tree_view_column_new_with_area", "GTK", "")
+	core.PuregoRegisterSince(&xNewTreeViewColumnWithAttributes, libs, "gtk_tree_view_column_new_with_attributes", "GTK", "")
+
+	core.PuregoRegisterSince(&xTreeViewColumnAddAttribute, libs, "gtk_tree_view_column_add_attribute", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnCellGetPosition, libs, "gtk_tree_view_column_cell_get_position", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnCellGetSize, libs, "gtk_tree_view_column_cell_get_size", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnCellIsVisible, libs, "gtk_tree_view_column_cell_is_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnCellSetCellData, libs, "gtk_tree_view_column_cell_set_cell_data", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnClear, libs, "gtk_tree_view_column_clear", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnClearAttributes, libs, "gtk_tree_view_column_clear_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnClicked, libs, "gtk_tree_view_column_clicked", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnFocusCell, libs, "gtk_tree_view_column_focus_cell", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetAlignment, libs, "gtk_tree_view_column_get_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetButton, libs, "gtk_tree_view_column_get_button", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetClickable, libs, "gtk_tree_view_column_get_clickable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetExpand, libs, "gtk_tree_view_column_get_expand", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetFixedWidth, libs, "gtk_tree_view_column_get_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetMaxWidth, libs, "gtk_tree_view_column_get_max_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetMinWidth, libs, "gtk_tree_view_column_get_min_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetReorderable, libs, "gtk_tree_view_column_get_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetResizable, libs, "gtk_tree_view_column_get_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetSizing, libs, "gtk_tree_view_column_get_sizing", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetSortColumnId, libs, "gtk_tree_view_column_get_sort_column_id", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetSortIndicator, libs, "gtk_tree_view_column_get_sort_indicator", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetSortOrder, libs, "gtk_tree_view_column_get_sort_order", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetSpacing, libs, "gtk_tree_view_column_get_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetTitle, libs, "gtk_tree_view_column_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetTreeView, libs, "gtk_tree_view_column_get_tree_view", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetVisible, libs, "gtk_tree_view_column_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetWidget, libs, "gtk_tree_view_column_get_widget", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetWidth, libs, "gtk_tree_view_column_get_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnGetXOffset, libs, "gtk_tree_view_column_get_x_offset", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnPackEnd, libs, "gtk_tree_view_column_pack_end", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnPackStart, libs, "gtk_tree_view_column_pack_start", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnQueueResize, libs, "gtk_tree_view_column_queue_resize", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetAlignment, libs, "gtk_tree_view_column_set_alignment", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetAttributes, libs, "gtk_tree_view_column_set_attributes", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetCellDataFunc, libs, "gtk_tree_view_column_set_cell_data_func", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetClickable, libs, "gtk_tree_view_column_set_clickable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetExpand, libs, "gtk_tree_view_column_set_expand", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetFixedWidth, libs, "gtk_tree_view_column_set_fixed_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetMaxWidth, libs, "gtk_tree_view_column_set_max_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetMinWidth, libs, "gtk_tree_view_column_set_min_width", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetReorderable, libs, "gtk_tree_view_column_set_reorderable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetResizable, libs, "gtk_tree_view_column_set_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetSizing, libs, "gtk_tree_view_column_set_sizing", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetSortColumnId, libs, "gtk_tree_view_column_set_sort_column_id", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetSortIndicator, libs, "gtk_tree_view_column_set_sort_indicator", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetSortOrder, libs, "gtk_tree_view_column_set_sort_order", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetSpacing, libs, "gtk_tree_view_column_set_spacing", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetTitle, libs, "gtk_tree_view_column_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetVisible, libs, "gtk_tree_view_column_set_visible", "GTK", "")
+	core.PuregoRegisterSince(&xTreeViewColumnSetWidget, libs, "gtk_tree_view_column_set_widget", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -14,17 +13,19 @@ import (
 //
 // Another name for this data structure is “bitmap”.
 //
-// The current implementation is based on [roaring bitmaps](https://roaringbitmap.org/).
+// The current implementation is based on [roaring bitmaps].
 //
 // A bitset allows adding a set of integers and provides support for set operations
 // like unions, intersections and checks for equality or if a value is contained
 // in the set. `GtkBitset` also contains various functions to query metadata about
 // the bitset, such as the minimum or maximum values or its size.
 //
-// The fastest way to iterate values in a bitset is [struct@Gtk.BitsetIter].
+// The fastest way to iterate values in a bitset is [BitsetIter].
 //
 // The main use case for `GtkBitset` is implementing complex selections for
-// [iface@Gtk.SelectionModel].
+// [SelectionModel].
+//
+// [roaring bitmaps]: https://roaringbitmap.org/
 type Bitset struct {
 	_ structs.HostLayout
 }
@@ -59,7 +60,7 @@ func NewBitsetRange(StartVar uint, NItemsVar uint) *Bitset {
 
 var xBitsetAdd func(uintptr, uint) bool
 
-// Adds @value to @self if it wasn't part of it before.
+// Adds value to self if it wasn't part of it before.
 func (x *Bitset) Add(ValueVar uint) bool {
 
 	cret := xBitsetAdd(x.GoPointer(), ValueVar)
@@ -68,8 +69,8 @@ func (x *Bitset) Add(ValueVar uint) bool {
 
 var xBitsetAddRange func(uintptr, uint, uint)
 
-// Adds all values from @start (inclusive) to @start + @n_items
-// (exclusive) in @self.
+// Adds all values from start (inclusive) to start + n_items
+// (exclusive) in self.
 func (x *Bitset) AddRange(StartVar uint, NItemsVar uint) {
 
 	xBitsetAddRange(x.GoPointer(), StartVar, NItemsVar)
@@ -78,8 +79,8 @@ func (x *Bitset) AddRange(StartVar uint, NItemsVar uint) {
 
 var xBitsetAddRangeClosed func(uintptr, uint, uint)
 
-// Adds the closed range [@first, @last], so @first, @last and all
-// values in between. @first must be smaller than @last.
+// Adds the closed range [first, last], so first, last and all
+// values in between. first must be smaller than last.
 func (x *Bitset) AddRangeClosed(FirstVar uint, LastVar uint) {
 
 	xBitsetAddRangeClosed(x.GoPointer(), FirstVar, LastVar)
@@ -88,8 +89,8 @@ func (x *Bitset) AddRangeClosed(FirstVar uint, LastVar uint) {
 
 var xBitsetAddRectangle func(uintptr, uint, uint, uint, uint)
 
-// Interprets the values as a 2-dimensional boolean grid with the given @stride
-// and inside that grid, adds a rectangle with the given @width and @height.
+// Interprets the values as a 2-dimensional boolean grid with the given stride
+// and inside that grid, adds a rectangle with the given width and height.
 func (x *Bitset) AddRectangle(StartVar uint, WidthVar uint, HeightVar uint, StrideVar uint) {
 
 	xBitsetAddRectangle(x.GoPointer(), StartVar, WidthVar, HeightVar, StrideVar)
@@ -98,7 +99,7 @@ func (x *Bitset) AddRectangle(StartVar uint, WidthVar uint, HeightVar uint, Stri
 
 var xBitsetContains func(uintptr, uint) bool
 
-// Checks if the given @value has been added to @self
+// Checks if the given value has been added to self
 func (x *Bitset) Contains(ValueVar uint) bool {
 
 	cret := xBitsetContains(x.GoPointer(), ValueVar)
@@ -107,7 +108,7 @@ func (x *Bitset) Contains(ValueVar uint) bool {
 
 var xBitsetCopy func(uintptr) *Bitset
 
-// Creates a copy of @self.
+// Creates a copy of self.
 func (x *Bitset) Copy() *Bitset {
 
 	cret := xBitsetCopy(x.GoPointer())
@@ -116,13 +117,13 @@ func (x *Bitset) Copy() *Bitset {
 
 var xBitsetDifference func(uintptr, *Bitset)
 
-// Sets @self to be the symmetric difference of @self and @other.
+// Sets self to be the symmetric difference of self and other.
 //
-// The symmetric difference is set @self to contain all values that
-// were either contained in @self or in @other, but not in both.
+// The symmetric difference is set self to contain all values that
+// were either contained in self or in other, but not in both.
 // This operation is also called an XOR.
 //
-// It is allowed for @self and @other to be the same bitset. The bitset
+// It is allowed for self and other to be the same bitset. The bitset
 // will be emptied in that case.
 func (x *Bitset) Difference(OtherVar *Bitset) {
 
@@ -132,7 +133,7 @@ func (x *Bitset) Difference(OtherVar *Bitset) {
 
 var xBitsetEquals func(uintptr, *Bitset) bool
 
-// Returns %TRUE if @self and @other contain the same values.
+// Returns true if self and other contain the same values.
 func (x *Bitset) Equals(OtherVar *Bitset) bool {
 
 	cret := xBitsetEquals(x.GoPointer(), OtherVar)
@@ -141,9 +142,9 @@ func (x *Bitset) Equals(OtherVar *Bitset) bool {
 
 var xBitsetGetMaximum func(uintptr) uint
 
-// Returns the largest value in @self.
+// Returns the largest value in self.
 //
-// If @self is empty, 0 is returned.
+// If self is empty, 0 is returned.
 func (x *Bitset) GetMaximum() uint {
 
 	cret := xBitsetGetMaximum(x.GoPointer())
@@ -152,9 +153,9 @@ func (x *Bitset) GetMaximum() uint {
 
 var xBitsetGetMinimum func(uintptr) uint
 
-// Returns the smallest value in @self.
+// Returns the smallest value in self.
 //
-// If @self is empty, `G_MAXUINT` is returned.
+// If self is empty, `G_MAXUINT` is returned.
 func (x *Bitset) GetMinimum() uint {
 
 	cret := xBitsetGetMinimum(x.GoPointer())
@@ -163,9 +164,9 @@ func (x *Bitset) GetMinimum() uint {
 
 var xBitsetGetNth func(uintptr, uint) uint
 
-// Returns the value of the @nth item in self.
+// Returns the value of the nth item in self.
 //
-// If @nth is &gt;= the size of @self, 0 is returned.
+// If nth is >= the size of self, 0 is returned.
 func (x *Bitset) GetNth(NthVar uint) uint {
 
 	cret := xBitsetGetNth(x.GoPointer(), NthVar)
@@ -190,7 +191,7 @@ func (x *Bitset) GetSize() uint64 {
 
 var xBitsetGetSizeInRange func(uintptr, uint, uint) uint64
 
-// Gets the number of values that are part of the set from @first to @last
+// Gets the number of values that are part of the set from first to last
 // (inclusive).
 //
 // Note that this function returns a `guint64`, because when all values are
@@ -204,11 +205,11 @@ func (x *Bitset) GetSizeInRange(FirstVar uint, LastVar uint) uint64 {
 
 var xBitsetIntersect func(uintptr, *Bitset)
 
-// Sets @self to be the intersection of @self and @other.
+// Sets self to be the intersection of self and other.
 //
-// In other words, remove all values from @self that are not part of @other.
+// In other words, remove all values from self that are not part of other.
 //
-// It is allowed for @self and @other to be the same bitset. Nothing will
+// It is allowed for self and other to be the same bitset. Nothing will
 // happen in that case.
 func (x *Bitset) Intersect(OtherVar *Bitset) {
 
@@ -236,7 +237,7 @@ func (x *Bitset) Ref() *Bitset {
 
 var xBitsetRemove func(uintptr, uint) bool
 
-// Removes @value from @self if it was part of it before.
+// Removes value from self if it was part of it before.
 func (x *Bitset) Remove(ValueVar uint) bool {
 
 	cret := xBitsetRemove(x.GoPointer(), ValueVar)
@@ -254,8 +255,8 @@ func (x *Bitset) RemoveAll() {
 
 var xBitsetRemoveRange func(uintptr, uint, uint)
 
-// Removes all values from @start (inclusive) to @start + @n_items (exclusive)
-// in @self.
+// Removes all values from start (inclusive) to start + n_items (exclusive)
+// in self.
 func (x *Bitset) RemoveRange(StartVar uint, NItemsVar uint) {
 
 	xBitsetRemoveRange(x.GoPointer(), StartVar, NItemsVar)
@@ -264,8 +265,8 @@ func (x *Bitset) RemoveRange(StartVar uint, NItemsVar uint) {
 
 var xBitsetRemoveRangeClosed func(uintptr, uint, uint)
 
-// Removes the closed range [@first, @last], so @first, @last and all
-// values in between. @first must be smaller than @last.
+// Removes the closed range [first, last], so first, last and all
+// values in between. first must be smaller than last.
 func (x *Bitset) RemoveRangeClosed(FirstVar uint, LastVar uint) {
 
 	xBitsetRemoveRangeClosed(x.GoPointer(), FirstVar, LastVar)
@@ -274,8 +275,8 @@ func (x *Bitset) RemoveRangeClosed(FirstVar uint, LastVar uint) {
 
 var xBitsetRemoveRectangle func(uintptr, uint, uint, uint, uint)
 
-// Interprets the values as a 2-dimensional boolean grid with the given @stride
-// and inside that grid, removes a rectangle with the given @width and @height.
+// Interprets the values as a 2-dimensional boolean grid with the given stride
+// and inside that grid, removes a rectangle with the given width and height.
 func (x *Bitset) RemoveRectangle(StartVar uint, WidthVar uint, HeightVar uint, StrideVar uint) {
 
 	xBitsetRemoveRectangle(x.GoPointer(), StartVar, WidthVar, HeightVar, StrideVar)
@@ -284,9 +285,9 @@ func (x *Bitset) RemoveRectangle(StartVar uint, WidthVar uint, HeightVar uint, S
 
 var xBitsetShiftLeft func(uintptr, uint)
 
-// Shifts all values in @self to the left by @amount.
+// Shifts all values in self to the left by amount.
 //
-// Values smaller than @amount are discarded.
+// Values smaller than amount are discarded.
 func (x *Bitset) ShiftLeft(AmountVar uint) {
 
 	xBitsetShiftLeft(x.GoPointer(), AmountVar)
@@ -295,7 +296,7 @@ func (x *Bitset) ShiftLeft(AmountVar uint) {
 
 var xBitsetShiftRight func(uintptr, uint)
 
-// Shifts all values in @self to the right by @amount.
+// Shifts all values in self to the right by amount.
 //
 // Values that end up too large to be held in a #guint are discarded.
 func (x *Bitset) ShiftRight(AmountVar uint) {
@@ -309,12 +310,12 @@ var xBitsetSplice func(uintptr, uint, uint, uint)
 // This is a support function for `GListModel` handling, by mirroring
 // the `GlistModel::items-changed` signal.
 //
-// First, it "cuts" the values from @position to @removed from
+// First, it "cuts" the values from position to removed from
 // the bitset. That is, it removes all those values and shifts
-// all larger values to the left by @removed places.
+// all larger values to the left by removed places.
 //
 // Then, it "pastes" new room into the bitset by shifting all values
-// larger than @position by @added spaces to the right. This frees
+// larger than position by added spaces to the right. This frees
 // up space that can then be filled.
 func (x *Bitset) Splice(PositionVar uint, RemovedVar uint, AddedVar uint) {
 
@@ -324,11 +325,11 @@ func (x *Bitset) Splice(PositionVar uint, RemovedVar uint, AddedVar uint) {
 
 var xBitsetSubtract func(uintptr, *Bitset)
 
-// Sets @self to be the subtraction of @other from @self.
+// Sets self to be the subtraction of other from self.
 //
-// In other words, remove all values from @self that are part of @other.
+// In other words, remove all values from self that are part of other.
 //
-// It is allowed for @self and @other to be the same bitset. The bitset
+// It is allowed for self and other to be the same bitset. The bitset
 // will be emptied in that case.
 func (x *Bitset) Subtract(OtherVar *Bitset) {
 
@@ -338,11 +339,11 @@ func (x *Bitset) Subtract(OtherVar *Bitset) {
 
 var xBitsetUnion func(uintptr, *Bitset)
 
-// Sets @self to be the union of @self and @other.
+// Sets self to be the union of self and other.
 //
-// That is, add all values from @other into @self that weren't part of it.
+// That is, add all values from other into self that weren't part of it.
 //
-// It is allowed for @self and @other to be the same bitset. Nothing will
+// It is allowed for self and other to be the same bitset. Nothing will
 // happen in that case.
 func (x *Bitset) Union(OtherVar *Bitset) {
 
@@ -354,7 +355,7 @@ var xBitsetUnref func(uintptr)
 
 // Releases a reference on the given `GtkBitset`.
 //
-// If the reference was the last, the resources associated to the @self are
+// If the reference was the last, the resources associated to the self are
 // freed.
 func (x *Bitset) Unref() {
 
@@ -472,7 +473,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -481,46 +482,46 @@ func init() {
 
 	core.PuregoSafeRegister(&xBitsetGLibType, libs, "gtk_bitset_get_type")
 
-	core.PuregoSafeRegister(&xNewBitsetEmpty, libs, "gtk_bitset_new_empty")
-	core.PuregoSafeRegister(&xNewBitsetRange, libs, "gtk_bitset_new_range")
-
-	core.PuregoSafeRegister(&xBitsetAdd, libs, "gtk_bitset_add")
-	core.PuregoSafeRegister(&xBitsetAddRange, libs, "gtk_bitset_add_range")
-	core.PuregoSafeRegister(&xBitsetAddRangeClosed, libs, "gtk_bitset_add_range_closed")
-	core.PuregoSafeRegister(&xBitsetAddRectangle, libs, "gtk_bitset_add_rectangle")
-	core.PuregoSafeRegister(&xBitsetContains, libs, "gtk_bitset_contains")
-	core.PuregoSafeRegister(&xBitsetCopy, libs, "gtk_bitset_copy")
-	core.PuregoSafeRegister(&xBitsetDifference, libs, "gtk_bitset_difference")
-	core.PuregoSafeRegister(&xBitsetEquals, libs, "gtk_bitset_equals")
-	core.PuregoSafeRegister(&xBitsetGetMaximum, libs, "gtk_bitset_get_maximum")
-	core.PuregoSafeRegister(&xBitsetGetMinimum, libs, "gtk_bitset_get_minimum")
-	core.PuregoSafeRegister(&xBitsetGetNth, libs, "gtk_bitset_get_nth")
-	core.PuregoSafeRegister(&xBitsetGetSize, libs, "gtk_bitset_get_size")
-	core.PuregoSafeRegister(&xBitsetGetSizeInRange, libs, "gtk_bitset_get_size_in_range")
-	core.PuregoSafeRegister(&xBitsetIntersect, libs, "gtk_bitset_intersect")
-	core.PuregoSafeRegister(&xBitsetIsEmpty, libs, "gtk_bitset_is_empty")
-	core.PuregoSafeRegister(&xBitsetRef, libs, "gtk_bitset_ref")
-	core.PuregoSafeRegister(&xBitsetRemove, libs, "gtk_bitset_remove")
-	core.PuregoSafeRegister(&xBitsetRemoveAll, libs, "gtk_bitset_remove_all")
-	core.PuregoSafeRegister(&xBitsetRemoveRange, libs, "gtk_bitset_remove_range")
-	core.PuregoSafeRegister(&xBitsetRemoveRangeClosed, libs, "gtk_bitset_remove_range_closed")
-	core.PuregoSafeRegister(&xBitsetRemoveRectangle, libs, "gtk_bitset_remove_rectangle")
-	core.PuregoSafeRegister(&xBitsetShiftLeft, libs, "gtk_bitset_shift_left")
-	core.PuregoSafeRegister(&xBitsetShiftRight, libs, "gtk_bitset_shift_right")
-	core.PuregoSafeRegister(&xBitsetSplice, libs, "gtk_bitset_splice")
-	core.PuregoSafeRegister(&xBitsetSubtract, libs, "gtk_bitset_subtract")
-	core.PuregoSafeRegister(&xBitsetUnion, libs, "gtk_bitset_union")
-	core.PuregoSafeRegister(&xBitsetUnref, libs, "gtk_bitset_unref")
+	core.PuregoRegisterSince(&xNewBitsetEmpty, libs, "gtk_bitset_new_empty", "GTK", "")
+	core.PuregoRegisterSince(&xNewBitsetRange, libs, "gtk_bitset_new_range", "GTK", "")
+
+	core.PuregoRegisterSince(&xBitsetAdd, libs, "gtk_bitset_add", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetAddRange, libs, "gtk_bitset_add_range", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetAddRangeClosed, libs, "gtk_bitset_add_range_closed", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetAddRectangle, libs, "gtk_bitset_add_rectangle", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetContains, libs, "gtk_bitset_contains", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetCopy, libs, "gtk_bitset_copy", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetDifference, libs, "gtk_bitset_difference", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetEquals, libs, "gtk_bitset_equals", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetGetMaximum, libs, "gtk_bitset_get_maximum", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetGetMinimum, libs, "gtk_bitset_get_minimum", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetGetNth, libs, "gtk_bitset_get_nth", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetGetSize, libs, "gtk_bitset_get_size", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetGetSizeInRange, libs, "gtk_bitset_get_size_in_range", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIntersect, libs, "gtk_bitset_intersect", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetIsEmpty, libs, "gtk_bitset_is_empty", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRef, libs, "gtk_bitset_ref", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRemove, libs, "gtk_bitset_remove", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRemoveAll, libs, "gtk_bitset_remove_all", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRemoveRange, libs, "gtk_bitset_remove_range", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRemoveRangeClosed, libs, "gtk_bitset_remove_range_closed", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetRemoveRectangle, libs, "gtk_bitset_remove_rectangle", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetShiftLeft, libs, "gtk_bitset_shift_left", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetShiftRight, libs, "gtk_bitset_shift_right", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetSplice, libs, "gtk_bitset_splice", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetSubtract, libs, "gtk_bitset_subtract", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetUnion, libs, "gtk_bitset_union", "GTK", "")
+	core.PuregoRegisterSince(&xBitsetUnref, libs, "gtk_bitset_unref", "GTK", "")
 
 	core.PuregoSafeRegister(&xScrollInfoGLibType, libs, "gtk_scroll_info_get_type")
 
-	core.PuregoSafeRegister(&xNewScrollInfo, libs, "gtk_scroll_info_new")
+	core.PuregoRegisterSince(&xNewScrollInfo, libs, "gtk_scroll_info_new", "GTK", "4.12")
 
-	core.PuregoSafeRegister(&xScrollInfoGetEnableHorizontal, libs, "gtk_scroll_info_get_enable_horizontal")
-	core.PuregoSafeRegister(&xScrollInfoGetEnableVertical, libs, "gtk_scroll_info_get_enable_vertical")
-	core.PuregoSafeRegister(&xScrollInfoRef, libs, "gtk_scroll_info_ref")
-	core.PuregoSafeRegister(&xScrollInfoSetEnableHorizontal, libs, "gtk_scroll_info_set_enable_horizontal")
-	core.PuregoSafeRegister(&xScrollInfoSetEnableVertical, libs, "gtk_scroll_info_set_enable_vertical")
-	core.PuregoSafeRegister(&xScrollInfoUnref, libs, "gtk_scroll_info_unref")
+	core.PuregoRegisterSince(&xScrollInfoGetEnableHorizontal, libs, "gtk_scroll_info_get_enable_horizontal", "GTK", "4.12")
+	core.PuregoRegisterSince(&xScrollInfoGetEnableVertical, libs, "gtk_scroll_info_get_enable_vertical", "GTK", "4.12")
+	core.PuregoRegisterSince(&xScrollInfoRef, libs, "gtk_scroll_info_ref", "GTK", "4.12")
+	core.PuregoRegisterSince(&xScrollInfoSetEnableHorizontal, libs, "gtk_scroll_info_set_enable_horizontal", "GTK", "4.12")
+	core.PuregoRegisterSince(&xScrollInfoSetEnableVertical, libs, "gtk_scroll_info_set_enable_vertical", "GTK", "4.12")
+	core.PuregoRegisterSince(&xScrollInfoUnref, libs, "gtk_scroll_info_unref", "GTK", "4.12")
 
 }
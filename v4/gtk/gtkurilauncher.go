@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -31,9 +30,9 @@ func (x *UriLauncherClass) GoPointer() uintptr {
 // may or may not show an app chooser dialog or launch the default application
 // right away.
 //
-// The operation is started with the [method@Gtk.UriLauncher.launch] function.
+// The operation is started with the [UriLauncher.Launch] function.
 //
-// To launch a file, use [class@Gtk.FileLauncher].
+// To launch a file, use [FileLauncher].
 type UriLauncher struct {
 	gobject.Object
 }
@@ -105,11 +104,12 @@ func (x *UriLauncher) Launch(ParentVar *Window, CancellableVar *gio.Cancellable,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -119,14 +119,14 @@ func (x *UriLauncher) Launch(ParentVar *Window, CancellableVar *gio.Cancellable,
 
 var xUriLauncherLaunchFinish func(uintptr, uintptr, **glib.Error) bool
 
-// Finishes the [method@Gtk.UriLauncher.launch] call and
+// Finishes the [UriLauncher.Launch] call and
 // returns the result.
 func (x *UriLauncher) LaunchFinish(ResultVar gio.AsyncResult) (bool, error) {
 	var cerr *glib.Error
 
 	cret := xUriLauncherLaunchFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -177,7 +177,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -186,12 +186,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xUriLauncherGLibType, libs, "gtk_uri_launcher_get_type")
 
-	core.PuregoSafeRegister(&xNewUriLauncher, libs, "gtk_uri_launcher_new")
+	gobject.CheckClassSize("GtkUriLauncherClass", xUriLauncherGLibType, unsafe.Sizeof(UriLauncherClass{}))
 
-	core.PuregoSafeRegister(&xUriLauncherCanLaunch, libs, "gtk_uri_launcher_can_launch")
-	core.PuregoSafeRegister(&xUriLauncherGetUri, libs, "gtk_uri_launcher_get_uri")
-	core.PuregoSafeRegister(&xUriLauncherLaunch, libs, "gtk_uri_launcher_launch")
-	core.PuregoSafeRegister(&xUriLauncherLaunchFinish, libs, "gtk_uri_launcher_launch_finish")
-	core.PuregoSafeRegister(&xUriLauncherSetUri, libs, "gtk_uri_launcher_set_uri")
+	core.PuregoRegisterSince(&xNewUriLauncher, libs, "gtk_uri_launcher_new", "GTK", "4.10")
+
+	core.PuregoRegisterSince(&xUriLauncherCanLaunch, libs, "gtk_uri_launcher_can_launch", "GTK", "4.20")
+	core.PuregoRegisterSince(&xUriLauncherGetUri, libs, "gtk_uri_launcher_get_uri", "GTK", "4.10")
+	core.PuregoRegisterSince(&xUriLauncherLaunch, libs, "gtk_uri_launcher_launch", "GTK", "4.10")
+	core.PuregoRegisterSince(&xUriLauncherLaunchFinish, libs, "gtk_uri_launcher_launch_finish", "GTK", "4.10")
+	core.PuregoRegisterSince(&xUriLauncherSetUri, libs, "gtk_uri_launcher_set_uri", "GTK", "4.10")
 
 }
@@ -2,28 +2,27 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
 const (
-	// Like [func@get_binary_age], but from the headers used at
+	// Like get_binary_age, but from the headers used at
 	// application compile time, rather than from the library linked
 	// against at application run time.
 	BINARY_AGE int = 2002
-	// Like [func@get_interface_age], but from the headers used at
+	// Like get_interface_age, but from the headers used at
 	// application compile time, rather than from the library linked
 	// against at application run time.
 	INTERFACE_AGE int = 2
-	// Like [func@get_major_version], but from the headers used at
+	// Like get_major_version, but from the headers used at
 	// application compile time, rather than from the library linked
 	// against at application run time.
 	MAJOR_VERSION int = 4
-	// Like [func@get_micro_version], but from the headers used at
+	// Like get_micro_version, but from the headers used at
 	// application compile time, rather than from the library linked
 	// against at application run time.
 	MICRO_VERSION int = 2
-	// Like [func@get_minor_version], but from the headers used at
+	// Like get_minor_version, but from the headers used at
 	// application compile time, rather than from the library linked
 	// against at application run time.
 	MINOR_VERSION int = 20
@@ -34,17 +33,17 @@ var xCheckVersion func(uint, uint, uint) string
 // Checks that the GTK library in use is compatible with the
 // given version.
 //
-// Generally you would pass in the constants %GTK_MAJOR_VERSION,
-// %GTK_MINOR_VERSION, %GTK_MICRO_VERSION as the three arguments
+// Generally you would pass in the constants [MAJOR_VERSION],
+// [MINOR_VERSION], [MICRO_VERSION] as the three arguments
 // to this function; that produces a check that the library in
 // use is compatible with the version of GTK the application or
 // module was compiled against.
 //
 // Compatibility is defined by two things: first the version
 // of the running library is newer than the version
-// @required_major.required_minor.@required_micro. Second
+// required_major.required_minor.@required_micro. Second
 // the running library must be binary compatible with the
-// version @required_major.required_minor.@required_micro
+// version required_major.required_minor.@required_micro
 // (same major version.)
 //
 // This function is primarily for GTK modules; the module
@@ -52,7 +51,7 @@ var xCheckVersion func(uint, uint, uint) string
 // into an incompatible version of GTK. However, such a
 // check isn’t completely reliable, since the module may be
 // linked against an old version of GTK and calling the
-// old version of gtk_check_version(), but still get loaded
+// old version of [CheckVersion], but still get loaded
 // into an application using a newer version of GTK.
 func CheckVersion(RequiredMajorVar uint, RequiredMinorVar uint, RequiredMicroVar uint) string {
 
@@ -89,7 +88,7 @@ var xGetMajorVersion func() uint
 // For example, in GTK version 3.1.5 this is 3.
 //
 // This function is in the library, so it represents the GTK library
-// your code is running against. Contrast with the %GTK_MAJOR_VERSION
+// your code is running against. Contrast with the [MAJOR_VERSION]
 // macro, which represents the major version of the GTK headers you
 // have included when compiling your code.
 func GetMajorVersion() uint {
@@ -106,7 +105,7 @@ var xGetMicroVersion func() uint
 //
 // This function is in the library, so it represents the GTK library
 // your code is are running against. Contrast with the
-// %GTK_MICRO_VERSION macro, which represents the micro version of the
+// [MICRO_VERSION] macro, which represents the micro version of the
 // GTK headers you have included when compiling your code.
 func GetMicroVersion() uint {
 
@@ -122,7 +121,7 @@ var xGetMinorVersion func() uint
 //
 // This function is in the library, so it represents the GTK library
 // your code is are running against. Contrast with the
-// %GTK_MINOR_VERSION macro, which represents the minor version of the
+// [MINOR_VERSION] macro, which represents the minor version of the
 // GTK headers you have included when compiling your code.
 func GetMinorVersion() uint {
 
@@ -130,23 +129,35 @@ func GetMinorVersion() uint {
 	return cret
 }
 
+// CheckVersionAtLeast returns whether the loaded GTK library is version major.minor.micro or later,
+// calling a function that was added in a later version panics with a core.MissingSymbolError.
+func CheckVersionAtLeast(major, minor, micro uint) bool {
+	if v := GetMajorVersion(); v != major {
+		return v > major
+	}
+	if v := GetMinorVersion(); v != minor {
+		return v > minor
+	}
+	return GetMicroVersion() >= micro
+}
+
 func init() {
 	core.SetPackageName("GTK", "gtk4")
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xCheckVersion, libs, "gtk_check_version")
-	core.PuregoSafeRegister(&xGetBinaryAge, libs, "gtk_get_binary_age")
-	core.PuregoSafeRegister(&xGetInterfaceAge, libs, "gtk_get_interface_age")
-	core.PuregoSafeRegister(&xGetMajorVersion, libs, "gtk_get_major_version")
-	core.PuregoSafeRegister(&xGetMicroVersion, libs, "gtk_get_micro_version")
-	core.PuregoSafeRegister(&xGetMinorVersion, libs, "gtk_get_minor_version")
+	core.PuregoRegisterSince(&xCheckVersion, libs, "gtk_check_version", "GTK", "")
+	core.PuregoRegisterSince(&xGetBinaryAge, libs, "gtk_get_binary_age", "GTK", "")
+	core.PuregoRegisterSince(&xGetInterfaceAge, libs, "gtk_get_interface_age", "GTK", "")
+	core.PuregoRegisterSince(&xGetMajorVersion, libs, "gtk_get_major_version", "GTK", "")
+	core.PuregoRegisterSince(&xGetMicroVersion, libs, "gtk_get_micro_version", "GTK", "")
+	core.PuregoRegisterSince(&xGetMinorVersion, libs, "gtk_get_minor_version", "GTK", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -24,22 +23,15 @@ func (x *VideoClass) GoPointer() uintptr {
 
 // Shows a `GtkMediaStream` with media controls.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="video-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkVideo" src="video.png"&gt;
-//
-// &lt;/picture&gt;
-//
-// The controls are available separately as [class@Gtk.MediaControls].
+// The controls are available separately as [MediaControls].
 // If you just want to display a video without controls, you can treat it
-// like any other paintable and for example put it into a [class@Gtk.Picture].
+// like any other paintable and for example put it into a [Picture].
 //
 // `GtkVideo` aims to cover use cases such as previews, embedded animations,
 // etc. It supports autoplay, looping, and simple media controls. It does
 // not have support for video overlays, multichannel audio, device
 // selection, or input. If you are writing a full-fledged video player,
-// you may want to use the [iface@Gdk.Paintable] API and a media framework
+// you may want to use the [gdk.Paintable] API and a media framework
 // such as Gstreamer directly.
 type Video struct {
 	Widget
@@ -76,7 +68,7 @@ func NewVideo() *Video {
 
 var xNewVideoForFile func(uintptr) uintptr
 
-// Creates a `GtkVideo` to play back the given @file.
+// Creates a `GtkVideo` to play back the given file.
 func NewVideoForFile(FileVar gio.File) *Video {
 	var cls *Video
 
@@ -93,9 +85,9 @@ func NewVideoForFile(FileVar gio.File) *Video {
 
 var xNewVideoForFilename func(uintptr) uintptr
 
-// Creates a `GtkVideo` to play back the given @filename.
+// Creates a `GtkVideo` to play back the given filename.
 //
-// This is a utility function that calls [ctor@Gtk.Video.new_for_file],
+// This is a utility function that calls [NewVideoForFile],
 // See that function for details.
 func NewVideoForFilename(FilenameVar *string) *Video {
 	var cls *Video
@@ -116,7 +108,7 @@ func NewVideoForFilename(FilenameVar *string) *Video {
 
 var xNewVideoForMediaStream func(uintptr) uintptr
 
-// Creates a `GtkVideo` to play back the given @stream.
+// Creates a `GtkVideo` to play back the given stream.
 func NewVideoForMediaStream(StreamVar *MediaStream) *Video {
 	var cls *Video
 
@@ -134,9 +126,9 @@ func NewVideoForMediaStream(StreamVar *MediaStream) *Video {
 var xNewVideoForResource func(uintptr) uintptr
 
 // Creates a `GtkVideo` to play back the resource at the
-// given @resource_path.
+// given resource_path.
 //
-// This is a utility function that calls [ctor@Gtk.Video.new_for_file].
+// This is a utility function that calls [NewVideoForFile].
 func NewVideoForResource(ResourcePathVar *string) *Video {
 	var cls *Video
 
@@ -156,7 +148,7 @@ func NewVideoForResource(ResourcePathVar *string) *Video {
 
 var xVideoGetAutoplay func(uintptr) bool
 
-// Returns %TRUE if videos have been set to loop.
+// Returns true if videos have been set to loop.
 func (x *Video) GetAutoplay() bool {
 
 	cret := xVideoGetAutoplay(x.GoPointer())
@@ -165,7 +157,7 @@ func (x *Video) GetAutoplay() bool {
 
 var xVideoGetFile func(uintptr) uintptr
 
-// Gets the file played by @self or %NULL if not playing back
+// Gets the file played by self or nil if not playing back
 // a file.
 func (x *Video) GetFile() *gio.FileBase {
 	var cls *gio.FileBase
@@ -185,7 +177,7 @@ var xVideoGetGraphicsOffload func(uintptr) GraphicsOffloadEnabled
 
 // Returns whether graphics offload is enabled.
 //
-// See [class@Gtk.GraphicsOffload] for more information on graphics offload.
+// See [GraphicsOffload] for more information on graphics offload.
 func (x *Video) GetGraphicsOffload() GraphicsOffloadEnabled {
 
 	cret := xVideoGetGraphicsOffload(x.GoPointer())
@@ -194,7 +186,7 @@ func (x *Video) GetGraphicsOffload() GraphicsOffloadEnabled {
 
 var xVideoGetLoop func(uintptr) bool
 
-// Returns %TRUE if videos have been set to loop.
+// Returns true if videos have been set to loop.
 func (x *Video) GetLoop() bool {
 
 	cret := xVideoGetLoop(x.GoPointer())
@@ -203,7 +195,7 @@ func (x *Video) GetLoop() bool {
 
 var xVideoGetMediaStream func(uintptr) uintptr
 
-// Gets the media stream managed by @self or %NULL if none.
+// Gets the media stream managed by self or nil if none.
 func (x *Video) GetMediaStream() *MediaStream {
 	var cls *MediaStream
 
@@ -220,7 +212,7 @@ func (x *Video) GetMediaStream() *MediaStream {
 
 var xVideoSetAutoplay func(uintptr, bool)
 
-// Sets whether @self automatically starts playback when it
+// Sets whether self automatically starts playback when it
 // becomes visible or when a new file gets loaded.
 func (x *Video) SetAutoplay(AutoplayVar bool) {
 
@@ -230,7 +222,7 @@ func (x *Video) SetAutoplay(AutoplayVar bool) {
 
 var xVideoSetFile func(uintptr, uintptr)
 
-// Makes @self play the given @file.
+// Makes self play the given file.
 func (x *Video) SetFile(FileVar gio.File) {
 
 	xVideoSetFile(x.GoPointer(), FileVar.GoPointer())
@@ -239,9 +231,9 @@ func (x *Video) SetFile(FileVar gio.File) {
 
 var xVideoSetFilename func(uintptr, uintptr)
 
-// Makes @self play the given @filename.
+// Makes self play the given filename.
 //
-// This is a utility function that calls gtk_video_set_file(),
+// This is a utility function that calls [Video.SetFile],
 func (x *Video) SetFilename(FilenameVar *string) {
 
 	FilenameVarPtr := core.GStrdupNullable(FilenameVar)
@@ -255,7 +247,7 @@ var xVideoSetGraphicsOffload func(uintptr, GraphicsOffloadEnabled)
 
 // Sets whether to enable graphics offload.
 //
-// See [class@Gtk.GraphicsOffload] for more information on graphics offload.
+// See [GraphicsOffload] for more information on graphics offload.
 func (x *Video) SetGraphicsOffload(EnabledVar GraphicsOffloadEnabled) {
 
 	xVideoSetGraphicsOffload(x.GoPointer(), EnabledVar)
@@ -264,7 +256,7 @@ func (x *Video) SetGraphicsOffload(EnabledVar GraphicsOffloadEnabled) {
 
 var xVideoSetLoop func(uintptr, bool)
 
-// Sets whether new files loaded by @self should be set to loop.
+// Sets whether new files loaded by self should be set to loop.
 func (x *Video) SetLoop(LoopVar bool) {
 
 	xVideoSetLoop(x.GoPointer(), LoopVar)
@@ -275,11 +267,11 @@ var xVideoSetMediaStream func(uintptr, uintptr)
 
 // Sets the media stream to be played back.
 //
-// @self will take full control of managing the media stream. If you
+// self will take full control of managing the media stream. If you
 // want to manage a media stream yourself, consider using a
-// [class@Gtk.Picture] for display.
+// [Picture] for display.
 //
-// If you want to display a file, consider using [method@Gtk.Video.set_file]
+// If you want to display a file, consider using [Video.SetFile]
 // instead.
 func (x *Video) SetMediaStream(StreamVar *MediaStream) {
 
@@ -289,9 +281,9 @@ func (x *Video) SetMediaStream(StreamVar *MediaStream) {
 
 var xVideoSetResource func(uintptr, uintptr)
 
-// Makes @self play the resource at the given @resource_path.
+// Makes self play the resource at the given resource_path.
 //
-// This is a utility function that calls [method@Gtk.Video.set_file].
+// This is a utility function that calls [Video.SetFile].
 func (x *Video) SetResource(ResourcePathVar *string) {
 
 	ResourcePathVarPtr := core.GStrdupNullable(ResourcePathVar)
@@ -507,7 +499,7 @@ func (x *Video) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -515,14 +507,13 @@ func (x *Video) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Video) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -546,19 +537,17 @@ func (x *Video) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessib
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Video) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -579,7 +568,7 @@ func (x *Video) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -588,14 +577,12 @@ func (x *Video) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessible
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Video) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -614,10 +601,10 @@ func (x *Video) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, Va
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Video) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -629,7 +616,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -638,23 +625,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xVideoGLibType, libs, "gtk_video_get_type")
 
-	core.PuregoSafeRegister(&xNewVideo, libs, "gtk_video_new")
-	core.PuregoSafeRegister(&xNewVideoForFile, libs, "gtk_video_new_for_file")
-	core.PuregoSafeRegister(&xNewVideoForFilename, libs, "gtk_video_new_for_filename")
-	core.PuregoSafeRegister(&xNewVideoForMediaStream, libs, "gtk_video_new_for_media_stream")
-	core.PuregoSafeRegister(&xNewVideoForResource, libs, "gtk_video_new_for_resource")
-
-	core.PuregoSafeRegister(&xVideoGetAutoplay, libs, "gtk_video_get_autoplay")
-	core.PuregoSafeRegister(&xVideoGetFile, libs, "gtk_video_get_file")
-	core.PuregoSafeRegister(&xVideoGetGraphicsOffload, libs, "gtk_video_get_graphics_offload")
-	core.PuregoSafeRegister(&xVideoGetLoop, libs, "gtk_video_get_loop")
-	core.PuregoSafeRegister(&xVideoGetMediaStream, libs, "gtk_video_get_media_stream")
-	core.PuregoSafeRegister(&xVideoSetAutoplay, libs, "gtk_video_set_autoplay")
-	core.PuregoSafeRegister(&xVideoSetFile, libs, "gtk_video_set_file")
-	core.PuregoSafeRegister(&xVideoSetFilename, libs, "gtk_video_set_filename")
-	core.PuregoSafeRegister(&xVideoSetGraphicsOffload, libs, "gtk_video_set_graphics_offload")
-	core.PuregoSafeRegister(&xVideoSetLoop, libs, "gtk_video_set_loop")
-	core.PuregoSafeRegister(&xVideoSetMediaStream, libs, "gtk_video_set_media_stream")
-	core.PuregoSafeRegister(&xVideoSetResource, libs, "gtk_video_set_resource")
+	gobject.CheckClassSize("GtkVideoClass", xVideoGLibType, unsafe.Sizeof(VideoClass{}))
+
+	core.PuregoRegisterSince(&xNewVideo, libs, "gtk_video_new", "GTK", "")
+	core.PuregoRegisterSince(&xNewVideoForFile, libs, "gtk_video_new_for_file", "GTK", "")
+	core.PuregoRegisterSince(&xNewVideoForFilename, libs, "gtk_video_new_for_filename", "GTK", "")
+	core.PuregoRegisterSince(&xNewVideoForMediaStream, libs, "gtk_video_new_for_media_stream", "GTK", "")
+	core.PuregoRegisterSince(&xNewVideoForResource, libs, "gtk_video_new_for_resource", "GTK", "")
+
+	core.PuregoRegisterSince(&xVideoGetAutoplay, libs, "gtk_video_get_autoplay", "GTK", "")
+	core.PuregoRegisterSince(&xVideoGetFile, libs, "gtk_video_get_file", "GTK", "")
+	core.PuregoRegisterSince(&xVideoGetGraphicsOffload, libs, "gtk_video_get_graphics_offload", "GTK", "4.14")
+	core.PuregoRegisterSince(&xVideoGetLoop, libs, "gtk_video_get_loop", "GTK", "")
+	core.PuregoRegisterSince(&xVideoGetMediaStream, libs, "gtk_video_get_media_stream", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetAutoplay, libs, "gtk_video_set_autoplay", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetFile, libs, "gtk_video_set_file", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetFilename, libs, "gtk_video_set_filename", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetGraphicsOffload, libs, "gtk_video_set_graphics_offload", "GTK", "4.14")
+	core.PuregoRegisterSince(&xVideoSetLoop, libs, "gtk_video_set_loop", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetMediaStream, libs, "gtk_video_set_media_stream", "GTK", "")
+	core.PuregoRegisterSince(&xVideoSetResource, libs, "gtk_video_set_resource", "GTK", "")
 
 }
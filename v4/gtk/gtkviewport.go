@@ -2,7 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -23,9 +22,9 @@ import (
 //
 // # Accessibility
 //
-// Until GTK 4.10, `GtkViewport` used the [enum@Gtk.AccessibleRole.group] role.
+// Until GTK 4.10, `GtkViewport` used the [AccessibleRoleGroupValue] role.
 //
-// Starting from GTK 4.12, `GtkViewport` uses the [enum@Gtk.AccessibleRole.generic] role.
+// Starting from GTK 4.12, `GtkViewport` uses the [AccessibleRoleGenericValue] role.
 type Viewport struct {
 	Widget
 }
@@ -64,7 +63,7 @@ func NewViewport(HadjustmentVar *Adjustment, VadjustmentVar *Adjustment) *Viewpo
 
 var xViewportGetChild func(uintptr) uintptr
 
-// Gets the child widget of @viewport.
+// Gets the child widget of viewport.
 func (x *Viewport) GetChild() *Widget {
 	var cls *Widget
 
@@ -89,7 +88,7 @@ func (x *Viewport) GetScrollToFocus() bool {
 	return cret
 }
 
-var xViewportScrollTo func(uintptr, uintptr, *ScrollInfo)
+var xViewportScrollTo func(uintptr, uintptr, uintptr)
 
 // Scrolls a descendant of the viewport into view.
 //
@@ -97,13 +96,13 @@ var xViewportScrollTo func(uintptr, uintptr, *ScrollInfo)
 // this function to work, otherwise no scrolling will be performed.
 func (x *Viewport) ScrollTo(DescendantVar *Widget, ScrollVar *ScrollInfo) {
 
-	xViewportScrollTo(x.GoPointer(), DescendantVar.GoPointer(), ScrollVar)
+	xViewportScrollTo(x.GoPointer(), DescendantVar.GoPointer(), gobject.BoxedCopyNullable(ScrollInfoGLibType(), ScrollVar.GoPointer()))
 
 }
 
 var xViewportSetChild func(uintptr, uintptr)
 
-// Sets the child widget of @viewport.
+// Sets the child widget of viewport.
 func (x *Viewport) SetChild(ChildVar *Widget) {
 
 	xViewportSetChild(x.GoPointer(), ChildVar.GoPointer())
@@ -317,7 +316,7 @@ func (x *Viewport) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -325,14 +324,13 @@ func (x *Viewport) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Viewport) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -356,19 +354,17 @@ func (x *Viewport) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Acces
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Viewport) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -389,7 +385,7 @@ func (x *Viewport) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -398,14 +394,12 @@ func (x *Viewport) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessi
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Viewport) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -424,10 +418,10 @@ func (x *Viewport) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState,
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Viewport) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -529,7 +523,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -538,12 +532,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xViewportGLibType, libs, "gtk_viewport_get_type")
 
-	core.PuregoSafeRegister(&xNewViewport, libs, "gtk_viewport_new")
+	core.PuregoRegisterSince(&xNewViewport, libs, "gtk_viewport_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xViewportGetChild, libs, "gtk_viewport_get_child")
-	core.PuregoSafeRegister(&xViewportGetScrollToFocus, libs, "gtk_viewport_get_scroll_to_focus")
-	core.PuregoSafeRegister(&xViewportScrollTo, libs, "gtk_viewport_scroll_to")
-	core.PuregoSafeRegister(&xViewportSetChild, libs, "gtk_viewport_set_child")
-	core.PuregoSafeRegister(&xViewportSetScrollToFocus, libs, "gtk_viewport_set_scroll_to_focus")
+	core.PuregoRegisterSince(&xViewportGetChild, libs, "gtk_viewport_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xViewportGetScrollToFocus, libs, "gtk_viewport_get_scroll_to_focus", "GTK", "")
+	core.PuregoRegisterSince(&xViewportScrollTo, libs, "gtk_viewport_scroll_to", "GTK", "4.12")
+	core.PuregoRegisterSince(&xViewportSetChild, libs, "gtk_viewport_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xViewportSetScrollToFocus, libs, "gtk_viewport_set_scroll_to_focus", "GTK", "")
 
 }
@@ -2,8 +2,6 @@
 package gtk
 
 import (
-	"github.com/jwijenbergh/purego"
-	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -11,12 +9,7 @@ import (
 // `GtkVolumeButton` is a `GtkScaleButton` subclass tailored for
 // volume control.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="volumebutton-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkVolumeButton" src="volumebutton.png"&gt;
-//
-// &lt;/picture&gt;
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 type VolumeButton struct {
 	ScaleButton
 }
@@ -33,27 +26,6 @@ func VolumeButtonNewFromInternalPtr(ptr uintptr) *VolumeButton {
 	return cls
 }
 
-var xNewVolumeButton func() uintptr
-
-// Creates a `GtkVolumeButton`.
-//
-// The button has a range between 0.0 and 1.0, with a stepping of 0.02.
-// Volume values can be obtained and modified using the functions from
-// [class@Gtk.ScaleButton].
-func NewVolumeButton() *VolumeButton {
-	var cls *VolumeButton
-
-	cret := xNewVolumeButton()
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &VolumeButton{}
-	cls.Ptr = cret
-	return cls
-}
-
 func (c *VolumeButton) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -70,6 +42,8 @@ func (c *VolumeButton) SetGoPointer(ptr uintptr) {
 //
 // Note that if the symbolic icons are not available in your installed
 // theme, then the normal (potentially colorful) icons will be used.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *VolumeButton) SetPropertyUseSymbolic(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -82,6 +56,8 @@ func (x *VolumeButton) SetPropertyUseSymbolic(value bool) {
 //
 // Note that if the symbolic icons are not available in your installed
 // theme, then the normal (potentially colorful) icons will be used.
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
 func (x *VolumeButton) GetPropertyUseSymbolic() bool {
 	var v gobject.Value
 	x.GetProperty("use-symbolic", &v)
@@ -249,7 +225,7 @@ func (x *VolumeButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -257,14 +233,13 @@ func (x *VolumeButton) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *VolumeButton) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -288,19 +263,17 @@ func (x *VolumeButton) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []A
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *VolumeButton) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -321,7 +294,7 @@ func (x *VolumeButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -330,14 +303,12 @@ func (x *VolumeButton) UpdateRelationValue(NRelationsVar int, RelationsVar []Acc
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *VolumeButton) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -356,44 +327,26 @@ func (x *VolumeButton) UpdateStateValue(NStatesVar int, StatesVar []AccessibleSt
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *VolumeButton) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
 	return cret
 }
 
-// Retrieves the orientation of the @orientable.
+// Retrieves the orientation of the orientable.
 func (x *VolumeButton) GetOrientation() Orientation {
 
 	cret := XGtkOrientableGetOrientation(x.GoPointer())
 	return cret
 }
 
-// Sets the orientation of the @orientable.
+// Sets the orientation of the orientable.
 func (x *VolumeButton) SetOrientation(OrientationVar Orientation) {
 
 	XGtkOrientableSetOrientation(x.GoPointer(), OrientationVar)
 
 }
-
-func init() {
-	core.SetPackageName("GTK", "gtk4")
-	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
-	var libs []uintptr
-	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
-		if err != nil {
-			panic(err)
-		}
-		libs = append(libs, lib)
-	}
-
-	core.PuregoSafeRegister(&xVolumeButtonGLibType, libs, "gtk_volume_button_get_type")
-
-	core.PuregoSafeRegister(&xNewVolumeButton, libs, "gtk_volume_button_new")
-
-}
@@ -0,0 +1,48 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xNewVolumeButton func() uintptr
+
+// Creates a `GtkVolumeButton`.
+//
+// The button has a range between 0.0 and 1.0, with a stepping of 0.02.
+// Volume values can be obtained and modified using the functions from
+// [ScaleButton].
+//
+// Deprecated: since 4.10. This widget will be removed in GTK 5
+func NewVolumeButton() *VolumeButton {
+	var cls *VolumeButton
+
+	cret := xNewVolumeButton()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &VolumeButton{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xNewVolumeButton, libs, "gtk_volume_button_new", "GTK", "")
+
+}
@@ -5,9 +5,7 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
-	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -20,18 +18,18 @@ import (
 
 // Callback type for adding a function to update animations.
 //
-// See [method@Gtk.Widget.add_tick_callback].
+// See [Widget.AddTickCallback].
 type TickCallback func(uintptr, uintptr, uintptr) bool
 
 // The type of the callback functions used for activating
-// actions installed with [method@Gtk.WidgetClass.install_action].
+// actions installed with [WidgetClass.InstallAction].
 //
-// The @parameter must match the @parameter_type of the action.
+// The parameter must match the parameter_type of the action.
 type WidgetActionActivateFunc func(uintptr, string, *glib.Variant)
 
 // Represents the desired size of a widget.
 //
-// See [GtkWidget’s geometry management section](class.Widget.html#height-for-width-geometry-management)
+// See GtkWidget’s geometry management section
 // for more information.
 type Requisition struct {
 	_ structs.HostLayout
@@ -51,6 +49,14 @@ func (x *Requisition) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewRequisitionAlloc returns a zeroed Requisition allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated Requisition. Release it with Free.
+func NewRequisitionAlloc() *Requisition {
+	cret := core.GMalloc0(max(unsafe.Sizeof(Requisition{}), 8))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Requisition)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xNewRequisition func() *Requisition
 
 // Allocates a new `GtkRequisition`.
@@ -146,16 +152,16 @@ func (x *WidgetClass) GoPointer() uintptr {
 
 var xWidgetClassAddBinding func(uintptr, uint, gdk.ModifierType, uintptr, uintptr, ...interface{})
 
-// Creates a new shortcut for @widget_class that calls the given @callback
-// with arguments according to @format_string.
+// Creates a new shortcut for widget_class that calls the given callback
+// with arguments according to format_string.
 //
 // The arguments and format string must be provided in the same way as
-// with [ctor@GLib.Variant.new].
+// with [glib.NewVariant].
 //
 // This function is a convenience wrapper around
-// [method@Gtk.WidgetClass.add_shortcut] and must be called during class
+// [WidgetClass.AddShortcut] and must be called during class
 // initialization. It does not provide for user data, if you need that,
-// you will have to use [method@Gtk.WidgetClass.add_shortcut] with a custom
+// you will have to use [WidgetClass.AddShortcut] with a custom
 // shortcut.
 func (x *WidgetClass) AddBinding(KeyvalVar uint, ModsVar gdk.ModifierType, CallbackVar *ShortcutFunc, FormatStringVar *string, varArgs ...interface{}) {
 
@@ -166,11 +172,12 @@ func (x *WidgetClass) AddBinding(KeyvalVar uint, ModsVar gdk.ModifierType, Callb
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 *glib.Variant, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -183,14 +190,14 @@ func (x *WidgetClass) AddBinding(KeyvalVar uint, ModsVar gdk.ModifierType, Callb
 
 var xWidgetClassAddBindingAction func(uintptr, uint, gdk.ModifierType, string, uintptr, ...interface{})
 
-// Creates a new shortcut for @widget_class that activates the given
-// @action_name with arguments read according to @format_string.
+// Creates a new shortcut for widget_class that activates the given
+// action_name with arguments read according to format_string.
 //
 // The arguments and format string must be provided in the same way as
-// with [ctor@GLib.Variant.new].
+// with [glib.NewVariant].
 //
 // This function is a convenience wrapper around
-// [method@Gtk.WidgetClass.add_shortcut] and must be called during class
+// [WidgetClass.AddShortcut] and must be called during class
 // initialization.
 func (x *WidgetClass) AddBindingAction(KeyvalVar uint, ModsVar gdk.ModifierType, ActionNameVar string, FormatStringVar *string, varArgs ...interface{}) {
 
@@ -203,14 +210,14 @@ func (x *WidgetClass) AddBindingAction(KeyvalVar uint, ModsVar gdk.ModifierType,
 
 var xWidgetClassAddBindingSignal func(uintptr, uint, gdk.ModifierType, string, uintptr, ...interface{})
 
-// Creates a new shortcut for @widget_class that emits the given action
-// @signal with arguments read according to @format_string.
+// Creates a new shortcut for widget_class that emits the given action
+// signal with arguments read according to format_string.
 //
 // The arguments and format string must be provided in the same way as
-// with [ctor@GLib.Variant.new].
+// with [glib.NewVariant].
 //
 // This function is a convenience wrapper around
-// [method@Gtk.WidgetClass.add_shortcut] and must be called during class
+// [WidgetClass.AddShortcut] and must be called during class
 // initialization.
 func (x *WidgetClass) AddBindingSignal(KeyvalVar uint, ModsVar gdk.ModifierType, SignalVar string, FormatStringVar *string, varArgs ...interface{}) {
 
@@ -223,12 +230,12 @@ func (x *WidgetClass) AddBindingSignal(KeyvalVar uint, ModsVar gdk.ModifierType,
 
 var xWidgetClassAddShortcut func(uintptr, uintptr)
 
-// Installs a shortcut in @widget_class.
+// Installs a shortcut in widget_class.
 //
-// Every instance created for @widget_class or its subclasses will
+// Every instance created for widget_class or its subclasses will
 // inherit this shortcut and trigger it.
 //
-// Shortcuts added this way will be triggered in the [enum@Gtk.PropagationPhase.bubble]
+// Shortcuts added this way will be triggered in the [PhaseBubbleValue]
 // phase, which means they may also trigger if child widgets have focus.
 //
 // This function must only be used in class initialization functions
@@ -243,13 +250,13 @@ var xWidgetClassBindTemplateCallbackFull func(uintptr, string, uintptr)
 
 // Associates a name to be used in GtkBuilder XML with a symbol.
 //
-// This function is not supported after [method@Gtk.WidgetClass.set_template_scope]
-// has been used on @widget_class.
+// This function is not supported after [WidgetClass.SetTemplateScope]
+// has been used on widget_class.
 //
-// See [method@Gtk.BuilderCScope.add_callback_symbol].
+// See [BuilderCScope.AddCallbackSymbol].
 //
 // Note that this must be called from a composite widget classes
-// class initializer after calling [method@Gtk.WidgetClass.set_template].
+// class initializer after calling [WidgetClass.SetTemplate].
 func (x *WidgetClass) BindTemplateCallbackFull(CallbackNameVar string, CallbackSymbolVar *gobject.Callback) {
 
 	var CallbackSymbolVarRef uintptr
@@ -259,11 +266,12 @@ func (x *WidgetClass) BindTemplateCallbackFull(CallbackNameVar string, CallbackS
 			CallbackSymbolVarRef = cbRefPtr
 		} else {
 			fcb := func() {
+				defer core.RecoverPanic()
 				cbFn := *CallbackSymbolVar
 				cbFn()
 			}
-			CallbackSymbolVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackSymbolVarPtr, CallbackSymbolVarRef, CallbackSymbolVar)
+			CallbackSymbolVarRef = core.NewCallback(fcb)
+			CallbackSymbolVarRef = glib.SaveCallbackWithClosure(CallbackSymbolVarPtr, CallbackSymbolVarRef, CallbackSymbolVar)
 		}
 	}
 
@@ -275,32 +283,32 @@ var xWidgetClassBindTemplateChildFull func(uintptr, string, bool, int)
 
 // Assigns an object declared in the class template XML to be set to
 // a location on a freshly built instance’s private data, or
-// alternatively accessible via [method@Gtk.Widget.get_template_child].
+// alternatively accessible via [Widget.GetTemplateChild].
 //
 // The struct can point either into the public instance, then you should
-// use `G_STRUCT_OFFSET(WidgetType, member)` for @struct_offset, or in the
+// use `G_STRUCT_OFFSET(WidgetType, member)` for struct_offset, or in the
 // private struct, then you should use `G_PRIVATE_OFFSET(WidgetType, member)`.
 //
 // An explicit strong reference will be held automatically for the duration
 // of your instance’s life cycle, it will be released automatically when
-// `GObjectClass.dispose()` runs on your instance and if a nonzero @struct_offset
+// `GObjectClass.dispose()` runs on your instance and if a nonzero struct_offset
 // is specified, then the automatic location in your instance public or private
 // data will be set to `NULL`. You can however access an automated child pointer
 // the first time your classes `GObjectClass.dispose()` runs, or alternatively
-// in [signal@Gtk.Widget::destroy].
+// in [Widget.ConnectDestroyFunc].
 //
-// If @internal_child is specified, [vfunc@Gtk.Buildable.get_internal_child]
+// If internal_child is specified, Buildable.get_internal_child
 // will be automatically implemented by the widget class so there is no
 // need to implement it manually.
 //
-// The wrapper macros [func@Gtk.widget_class_bind_template_child],
-// [func@Gtk.widget_class_bind_template_child_internal],
-// [func@Gtk.widget_class_bind_template_child_private] and
-// [func@Gtk.widget_class_bind_template_child_internal_private]
+// The wrapper macros widget_class_bind_template_child,
+// widget_class_bind_template_child_internal,
+// widget_class_bind_template_child_private and
+// widget_class_bind_template_child_internal_private
 // might be more convenient to use.
 //
 // Note that this must be called from a composite widget classes class
-// initializer after calling [method@Gtk.WidgetClass.set_template].
+// initializer after calling [WidgetClass.SetTemplate].
 func (x *WidgetClass) BindTemplateChildFull(NameVar string, InternalChildVar bool, StructOffsetVar int) {
 
 	xWidgetClassBindTemplateChildFull(x.GoPointer(), NameVar, InternalChildVar, StructOffsetVar)
@@ -314,7 +322,7 @@ var xWidgetClassGetAccessibleRole func(uintptr) AccessibleRole
 // Different accessible roles have different states, and are rendered
 // differently by assistive technologies.
 //
-// See also: [method@Gtk.Accessible.get_accessible_role].
+// See also: [Accessible.GetAccessibleRole].
 func (x *WidgetClass) GetAccessibleRole() AccessibleRole {
 
 	cret := xWidgetClassGetAccessibleRole(x.GoPointer())
@@ -326,7 +334,7 @@ var xWidgetClassGetActivateSignal func(uintptr) uint
 // Retrieves the signal id for the activation signal.
 //
 // The activation signal is set using
-// [method@Gtk.WidgetClass.set_activate_signal].
+// [WidgetClass.SetActivateSignal].
 func (x *WidgetClass) GetActivateSignal() uint {
 
 	cret := xWidgetClassGetActivateSignal(x.GoPointer())
@@ -337,7 +345,7 @@ var xWidgetClassGetCssName func(uintptr) string
 
 // Gets the name used by this class for matching in CSS code.
 //
-// See [method@Gtk.WidgetClass.set_css_name] for details.
+// See [WidgetClass.SetCssName] for details.
 func (x *WidgetClass) GetCssName() string {
 
 	cret := xWidgetClassGetCssName(x.GoPointer())
@@ -346,10 +354,10 @@ func (x *WidgetClass) GetCssName() string {
 
 var xWidgetClassGetLayoutManagerType func(uintptr) types.GType
 
-// Retrieves the type of the [class@Gtk.LayoutManager]
-// used by widgets of class @widget_class.
+// Retrieves the type of the [LayoutManager]
+// used by widgets of class widget_class.
 //
-// See also: [method@Gtk.WidgetClass.set_layout_manager_type].
+// See also: [WidgetClass.SetLayoutManagerType].
 func (x *WidgetClass) GetLayoutManagerType() types.GType {
 
 	cret := xWidgetClassGetLayoutManagerType(x.GoPointer())
@@ -364,7 +372,7 @@ var xWidgetClassInstallAction func(uintptr, string, uintptr, uintptr)
 //
 // Actions installed by this function are stateless. The only state
 // they have is whether they are enabled or not (which can be changed
-// with [method@Gtk.Widget.action_set_enabled]).
+// with [Widget.ActionSetEnabled]).
 func (x *WidgetClass) InstallAction(ActionNameVar string, ParameterTypeVar *string, ActivateVar *WidgetActionActivateFunc) {
 
 	var ActivateVarRef uintptr
@@ -374,11 +382,12 @@ func (x *WidgetClass) InstallAction(ActionNameVar string, ParameterTypeVar *stri
 			ActivateVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 *glib.Variant) {
+				defer core.RecoverPanic()
 				cbFn := *ActivateVar
 				cbFn(arg0, core.GoString(arg1), arg2)
 			}
-			ActivateVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(ActivateVarPtr, ActivateVarRef, ActivateVar)
+			ActivateVarRef = core.NewCallback(fcb)
+			ActivateVarRef = glib.SaveCallbackWithClosure(ActivateVarPtr, ActivateVarRef, ActivateVar)
 		}
 	}
 
@@ -391,11 +400,11 @@ func (x *WidgetClass) InstallAction(ActionNameVar string, ParameterTypeVar *stri
 
 var xWidgetClassInstallPropertyAction func(uintptr, string, string)
 
-// Installs an action called @action_name on @widget_class and
-// binds its state to the value of the @property_name property.
+// Installs an action called action_name on widget_class and
+// binds its state to the value of the property_name property.
 //
 // This function will perform a few sanity checks on the property selected
-// via @property_name. Namely, the property must exist, must be readable,
+// via property_name. Namely, the property must exist, must be readable,
 // writable and must not be construct-only. There are also restrictions
 // on the type of the given property, it must be boolean, int, unsigned int,
 // double or string. If any of these conditions are not met, a critical
@@ -415,14 +424,14 @@ func (x *WidgetClass) InstallPropertyAction(ActionNameVar string, PropertyNameVa
 var xWidgetClassQueryAction func(uintptr, uint, *types.GType, *string, **glib.VariantType, *string) bool
 
 // Returns details about an action that has been
-// installed for @widget_class.
+// installed for widget_class.
 //
-// See [method@Gtk.WidgetClass.install_action] for details on
+// See [WidgetClass.InstallAction] for details on
 // how to install actions.
 //
 // Note that this function will also return actions defined
 // by parent classes. You can identify those by looking
-// at @owner.
+// at owner.
 func (x *WidgetClass) QueryAction(IndexVar uint, OwnerVar *types.GType, ActionNameVar *string, ParameterTypeVar **glib.VariantType, PropertyNameVar *string) bool {
 
 	cret := xWidgetClassQueryAction(x.GoPointer(), IndexVar, OwnerVar, ActionNameVar, ParameterTypeVar, PropertyNameVar)
@@ -445,10 +454,10 @@ var xWidgetClassSetActivateSignal func(uintptr, uint)
 
 // Sets the activation signal for a widget class.
 //
-// The signal will be emitted when calling [method@Gtk.Widget.activate].
+// The signal will be emitted when calling [Widget.Activate].
 //
-// The @signal_id must have been registered with [function.GObject.signal_new]
-// or [func@GObject.signal_newv] before calling this function.
+// The signal_id must have been registered with [function.GObject.signal_new]
+// or [gobject.SignalNewv] before calling this function.
 func (x *WidgetClass) SetActivateSignal(SignalIdVar uint) {
 
 	xWidgetClassSetActivateSignal(x.GoPointer(), SignalIdVar)
@@ -459,12 +468,12 @@ var xWidgetClassSetActivateSignalFromName func(uintptr, string)
 
 // Sets the activation signal for a widget class.
 //
-// The signal id will by looked up by @signal_name.
+// The signal id will by looked up by signal_name.
 //
-// The signal will be emitted when calling [method@Gtk.Widget.activate].
+// The signal will be emitted when calling [Widget.Activate].
 //
-// The @signal_name must have been registered with [function.GObject.signal_new]
-// or [func@GObject.signal_newv] before calling this function.
+// The signal_name must have been registered with [function.GObject.signal_new]
+// or [gobject.SignalNewv] before calling this function.
 func (x *WidgetClass) SetActivateSignalFromName(SignalNameVar string) {
 
 	xWidgetClassSetActivateSignalFromName(x.GoPointer(), SignalNameVar)
@@ -487,9 +496,9 @@ func (x *WidgetClass) SetCssName(NameVar string) {
 var xWidgetClassSetLayoutManagerType func(uintptr, types.GType)
 
 // Sets the type to be used for creating layout managers for
-// widgets of @widget_class.
+// widgets of widget_class.
 //
-// The given @type must be a subtype of [class@Gtk.LayoutManager].
+// The given type must be a subtype of [LayoutManager].
 //
 // This function should only be called from class init functions
 // of widgets.
@@ -504,11 +513,11 @@ var xWidgetClassSetTemplate func(uintptr, *glib.Bytes)
 // This should be called at class initialization time to specify
 // the `GtkBuilder` XML to be used to extend a widget.
 //
-// For convenience, [method@Gtk.WidgetClass.set_template_from_resource]
+// For convenience, [WidgetClass.SetTemplateFromResource]
 // is also provided.
 //
 // Note that any class that installs templates must call
-// [method@Gtk.Widget.init_template] in the widget’s instance initializer.
+// [Widget.InitTemplate] in the widget’s instance initializer.
 func (x *WidgetClass) SetTemplate(TemplateBytesVar *glib.Bytes) {
 
 	xWidgetClassSetTemplate(x.GoPointer(), TemplateBytesVar)
@@ -517,11 +526,11 @@ func (x *WidgetClass) SetTemplate(TemplateBytesVar *glib.Bytes) {
 
 var xWidgetClassSetTemplateFromResource func(uintptr, string)
 
-// A convenience function that calls [method@Gtk.WidgetClass.set_template]
+// A convenience function that calls [WidgetClass.SetTemplate]
 // with the contents of a resource.
 //
 // Note that any class that installs templates must call
-// [method@Gtk.Widget.init_template] in the widget’s instance
+// [Widget.InitTemplate] in the widget’s instance
 // initializer.
 func (x *WidgetClass) SetTemplateFromResource(ResourceNameVar string) {
 
@@ -536,7 +545,7 @@ var xWidgetClassSetTemplateScope func(uintptr, uintptr)
 // This function is intended for language bindings.
 //
 // Note that this must be called from a composite widget classes class
-// initializer after calling [method@Gtk.WidgetClass.set_template].
+// initializer after calling [WidgetClass.SetTemplate].
 func (x *WidgetClass) SetTemplateScope(ScopeVar BuilderScope) {
 
 	xWidgetClassSetTemplateScope(x.GoPointer(), ScopeVar.GoPointer())
@@ -549,7 +558,8 @@ func (x *WidgetClass) OverrideShow(cb func(*Widget)) {
 	if cb == nil {
 		x.xShow = 0
 	} else {
-		x.xShow = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xShow = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -562,7 +572,7 @@ func (x *WidgetClass) GetShow() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xShow)
+	core.RegisterFunc(&rawCallback, x.xShow)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -574,7 +584,8 @@ func (x *WidgetClass) OverrideHide(cb func(*Widget)) {
 	if cb == nil {
 		x.xHide = 0
 	} else {
-		x.xHide = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xHide = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -587,7 +598,7 @@ func (x *WidgetClass) GetHide() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xHide)
+	core.RegisterFunc(&rawCallback, x.xHide)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -597,13 +608,14 @@ func (x *WidgetClass) GetHide() func(*Widget) {
 // Signal emitted when widget is going to be mapped, that is
 //
 //	when the widget is visible (which is controlled with
-//	gtk_widget_set_visible()) and all its parents up to the toplevel
+//	[Widget.SetVisible]) and all its parents up to the toplevel
 //	widget are also visible.
 func (x *WidgetClass) OverrideMap(cb func(*Widget)) {
 	if cb == nil {
 		x.xMap = 0
 	} else {
-		x.xMap = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xMap = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -613,14 +625,14 @@ func (x *WidgetClass) OverrideMap(cb func(*Widget)) {
 // Signal emitted when widget is going to be mapped, that is
 //
 //	when the widget is visible (which is controlled with
-//	gtk_widget_set_visible()) and all its parents up to the toplevel
+//	[Widget.SetVisible]) and all its parents up to the toplevel
 //	widget are also visible.
 func (x *WidgetClass) GetMap() func(*Widget) {
 	if x.xMap == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xMap)
+	core.RegisterFunc(&rawCallback, x.xMap)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -635,7 +647,8 @@ func (x *WidgetClass) OverrideUnmap(cb func(*Widget)) {
 	if cb == nil {
 		x.xUnmap = 0
 	} else {
-		x.xUnmap = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xUnmap = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -651,7 +664,7 @@ func (x *WidgetClass) GetUnmap() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnmap)
+	core.RegisterFunc(&rawCallback, x.xUnmap)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -660,13 +673,14 @@ func (x *WidgetClass) GetUnmap() func(*Widget) {
 // OverrideRealize sets the "realize" callback function.
 // Signal emitted when widget is associated with a
 //
-//	`GdkSurface`, which means that gtk_widget_realize() has been called or
+//	`GdkSurface`, which means that [Widget.Realize] has been called or
 //	the widget has been mapped (that is, it is going to be drawn).
 func (x *WidgetClass) OverrideRealize(cb func(*Widget)) {
 	if cb == nil {
 		x.xRealize = 0
 	} else {
-		x.xRealize = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xRealize = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -675,14 +689,14 @@ func (x *WidgetClass) OverrideRealize(cb func(*Widget)) {
 // GetRealize gets the "realize" callback function.
 // Signal emitted when widget is associated with a
 //
-//	`GdkSurface`, which means that gtk_widget_realize() has been called or
+//	`GdkSurface`, which means that [Widget.Realize] has been called or
 //	the widget has been mapped (that is, it is going to be drawn).
 func (x *WidgetClass) GetRealize() func(*Widget) {
 	if x.xRealize == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRealize)
+	core.RegisterFunc(&rawCallback, x.xRealize)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -691,14 +705,15 @@ func (x *WidgetClass) GetRealize() func(*Widget) {
 // OverrideUnrealize sets the "unrealize" callback function.
 // Signal emitted when the GdkSurface associated with
 //
-//	widget is destroyed, which means that gtk_widget_unrealize() has
+//	widget is destroyed, which means that [Widget.Unrealize] has
 //	been called or the widget has been unmapped (that is, it is going
 //	to be hidden).
 func (x *WidgetClass) OverrideUnrealize(cb func(*Widget)) {
 	if cb == nil {
 		x.xUnrealize = 0
 	} else {
-		x.xUnrealize = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xUnrealize = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -707,7 +722,7 @@ func (x *WidgetClass) OverrideUnrealize(cb func(*Widget)) {
 // GetUnrealize gets the "unrealize" callback function.
 // Signal emitted when the GdkSurface associated with
 //
-//	widget is destroyed, which means that gtk_widget_unrealize() has
+//	widget is destroyed, which means that [Widget.Unrealize] has
 //	been called or the widget has been unmapped (that is, it is going
 //	to be hidden).
 func (x *WidgetClass) GetUnrealize() func(*Widget) {
@@ -715,7 +730,7 @@ func (x *WidgetClass) GetUnrealize() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnrealize)
+	core.RegisterFunc(&rawCallback, x.xUnrealize)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -729,7 +744,8 @@ func (x *WidgetClass) OverrideRoot(cb func(*Widget)) {
 	if cb == nil {
 		x.xRoot = 0
 	} else {
-		x.xRoot = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xRoot = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -744,7 +760,7 @@ func (x *WidgetClass) GetRoot() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xRoot)
+	core.RegisterFunc(&rawCallback, x.xRoot)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -758,7 +774,8 @@ func (x *WidgetClass) OverrideUnroot(cb func(*Widget)) {
 	if cb == nil {
 		x.xUnroot = 0
 	} else {
-		x.xUnroot = purego.NewCallback(func(WidgetVarp uintptr) {
+		x.xUnroot = core.NewCallback(func(WidgetVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -773,7 +790,7 @@ func (x *WidgetClass) GetUnroot() func(*Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xUnroot)
+	core.RegisterFunc(&rawCallback, x.xUnroot)
 	return func(WidgetVar *Widget) {
 		rawCallback(WidgetVar.GoPointer())
 	}
@@ -787,7 +804,8 @@ func (x *WidgetClass) OverrideSizeAllocate(cb func(*Widget, int, int, int)) {
 	if cb == nil {
 		x.xSizeAllocate = 0
 	} else {
-		x.xSizeAllocate = purego.NewCallback(func(WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int) {
+		x.xSizeAllocate = core.NewCallback(func(WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), WidthVarp, HeightVarp, BaselineVarp)
 		})
 	}
@@ -802,7 +820,7 @@ func (x *WidgetClass) GetSizeAllocate() func(*Widget, int, int, int) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, WidthVarp int, HeightVarp int, BaselineVarp int)
-	purego.RegisterFunc(&rawCallback, x.xSizeAllocate)
+	core.RegisterFunc(&rawCallback, x.xSizeAllocate)
 	return func(WidgetVar *Widget, WidthVar int, HeightVar int, BaselineVar int) {
 		rawCallback(WidgetVar.GoPointer(), WidthVar, HeightVar, BaselineVar)
 	}
@@ -811,12 +829,13 @@ func (x *WidgetClass) GetSizeAllocate() func(*Widget, int, int, int) {
 // OverrideStateFlagsChanged sets the "state_flags_changed" callback function.
 // Signal emitted when the widget state changes,
 //
-//	see gtk_widget_get_state_flags().
+//	see [Widget.GetStateFlags].
 func (x *WidgetClass) OverrideStateFlagsChanged(cb func(*Widget, StateFlags)) {
 	if cb == nil {
 		x.xStateFlagsChanged = 0
 	} else {
-		x.xStateFlagsChanged = purego.NewCallback(func(WidgetVarp uintptr, PreviousStateFlagsVarp StateFlags) {
+		x.xStateFlagsChanged = core.NewCallback(func(WidgetVarp uintptr, PreviousStateFlagsVarp StateFlags) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), PreviousStateFlagsVarp)
 		})
 	}
@@ -825,13 +844,13 @@ func (x *WidgetClass) OverrideStateFlagsChanged(cb func(*Widget, StateFlags)) {
 // GetStateFlagsChanged gets the "state_flags_changed" callback function.
 // Signal emitted when the widget state changes,
 //
-//	see gtk_widget_get_state_flags().
+//	see [Widget.GetStateFlags].
 func (x *WidgetClass) GetStateFlagsChanged() func(*Widget, StateFlags) {
 	if x.xStateFlagsChanged == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, PreviousStateFlagsVarp StateFlags)
-	purego.RegisterFunc(&rawCallback, x.xStateFlagsChanged)
+	core.RegisterFunc(&rawCallback, x.xStateFlagsChanged)
 	return func(WidgetVar *Widget, PreviousStateFlagsVar StateFlags) {
 		rawCallback(WidgetVar.GoPointer(), PreviousStateFlagsVar)
 	}
@@ -845,7 +864,8 @@ func (x *WidgetClass) OverrideDirectionChanged(cb func(*Widget, TextDirection))
 	if cb == nil {
 		x.xDirectionChanged = 0
 	} else {
-		x.xDirectionChanged = purego.NewCallback(func(WidgetVarp uintptr, PreviousDirectionVarp TextDirection) {
+		x.xDirectionChanged = core.NewCallback(func(WidgetVarp uintptr, PreviousDirectionVarp TextDirection) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), PreviousDirectionVarp)
 		})
 	}
@@ -860,7 +880,7 @@ func (x *WidgetClass) GetDirectionChanged() func(*Widget, TextDirection) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, PreviousDirectionVarp TextDirection)
-	purego.RegisterFunc(&rawCallback, x.xDirectionChanged)
+	core.RegisterFunc(&rawCallback, x.xDirectionChanged)
 	return func(WidgetVar *Widget, PreviousDirectionVar TextDirection) {
 		rawCallback(WidgetVar.GoPointer(), PreviousDirectionVar)
 	}
@@ -871,23 +891,24 @@ func (x *WidgetClass) GetDirectionChanged() func(*Widget, TextDirection) {
 //
 //	does not have a layout manager.
 //	This allows a widget to tell its parent container whether
-//	it prefers to be allocated in %GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH or
-//	%GTK_SIZE_REQUEST_WIDTH_FOR_HEIGHT mode.
-//	%GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH means the widget prefers to have
+//	it prefers to be allocated in [SizeRequestHeightForWidthValue] or
+//	[SizeRequestWidthForHeightValue] mode.
+//	[SizeRequestHeightForWidthValue] means the widget prefers to have
 //	`GtkWidgetClass.measure()` called first to get the default width (passing
 //	a for_size of -1), then again to get the height for said default width.
-//	%GTK_SIZE_REQUEST_CONSTANT_SIZE disables any height-for-width or
+//	[SizeRequestConstantSizeValue] disables any height-for-width or
 //	width-for-height geometry management for said widget and is the
 //	default return.
 //	It’s important to note that any widget
 //	which trades height-for-width or width-for-height must respond properly
-//	to a for_size value &gt;= -1 passed to `GtkWidgetClass.measure`, for both
+//	to a for_size value >= -1 passed to `GtkWidgetClass.measure`, for both
 //	possible orientations.
 func (x *WidgetClass) OverrideGetRequestMode(cb func(*Widget) SizeRequestMode) {
 	if cb == nil {
 		x.xGetRequestMode = 0
 	} else {
-		x.xGetRequestMode = purego.NewCallback(func(WidgetVarp uintptr) SizeRequestMode {
+		x.xGetRequestMode = core.NewCallback(func(WidgetVarp uintptr) SizeRequestMode {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
@@ -898,24 +919,24 @@ func (x *WidgetClass) OverrideGetRequestMode(cb func(*Widget) SizeRequestMode) {
 //
 //	does not have a layout manager.
 //	This allows a widget to tell its parent container whether
-//	it prefers to be allocated in %GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH or
-//	%GTK_SIZE_REQUEST_WIDTH_FOR_HEIGHT mode.
-//	%GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH means the widget prefers to have
+//	it prefers to be allocated in [SizeRequestHeightForWidthValue] or
+//	[SizeRequestWidthForHeightValue] mode.
+//	[SizeRequestHeightForWidthValue] means the widget prefers to have
 //	`GtkWidgetClass.measure()` called first to get the default width (passing
 //	a for_size of -1), then again to get the height for said default width.
-//	%GTK_SIZE_REQUEST_CONSTANT_SIZE disables any height-for-width or
+//	[SizeRequestConstantSizeValue] disables any height-for-width or
 //	width-for-height geometry management for said widget and is the
 //	default return.
 //	It’s important to note that any widget
 //	which trades height-for-width or width-for-height must respond properly
-//	to a for_size value &gt;= -1 passed to `GtkWidgetClass.measure`, for both
+//	to a for_size value >= -1 passed to `GtkWidgetClass.measure`, for both
 //	possible orientations.
 func (x *WidgetClass) GetGetRequestMode() func(*Widget) SizeRequestMode {
 	if x.xGetRequestMode == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr) SizeRequestMode
-	purego.RegisterFunc(&rawCallback, x.xGetRequestMode)
+	core.RegisterFunc(&rawCallback, x.xGetRequestMode)
 	return func(WidgetVar *Widget) SizeRequestMode {
 		return rawCallback(WidgetVar.GoPointer())
 	}
@@ -932,7 +953,8 @@ func (x *WidgetClass) OverrideMeasure(cb func(*Widget, Orientation, int, *int, *
 	if cb == nil {
 		x.xMeasure = 0
 	} else {
-		x.xMeasure = purego.NewCallback(func(WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int) {
+		x.xMeasure = core.NewCallback(func(WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), OrientationVarp, ForSizeVarp, MinimumVarp, NaturalVarp, MinimumBaselineVarp, NaturalBaselineVarp)
 		})
 	}
@@ -950,57 +972,59 @@ func (x *WidgetClass) GetMeasure() func(*Widget, Orientation, int, *int, *int, *
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, OrientationVarp Orientation, ForSizeVarp int, MinimumVarp *int, NaturalVarp *int, MinimumBaselineVarp *int, NaturalBaselineVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xMeasure)
+	core.RegisterFunc(&rawCallback, x.xMeasure)
 	return func(WidgetVar *Widget, OrientationVar Orientation, ForSizeVar int, MinimumVar *int, NaturalVar *int, MinimumBaselineVar *int, NaturalBaselineVar *int) {
 		rawCallback(WidgetVar.GoPointer(), OrientationVar, ForSizeVar, MinimumVar, NaturalVar, MinimumBaselineVar, NaturalBaselineVar)
 	}
 }
 
 // OverrideMnemonicActivate sets the "mnemonic_activate" callback function.
-// Activates the @widget if @group_cycling is
+// Activates the widget if group_cycling is
 //
-//	%FALSE, and just grabs the focus if @group_cycling is %TRUE.
+//	false, and just grabs the focus if group_cycling is true.
 func (x *WidgetClass) OverrideMnemonicActivate(cb func(*Widget, bool) bool) {
 	if cb == nil {
 		x.xMnemonicActivate = 0
 	} else {
-		x.xMnemonicActivate = purego.NewCallback(func(WidgetVarp uintptr, GroupCyclingVarp bool) bool {
+		x.xMnemonicActivate = core.NewCallback(func(WidgetVarp uintptr, GroupCyclingVarp bool) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp), GroupCyclingVarp)
 		})
 	}
 }
 
 // GetMnemonicActivate gets the "mnemonic_activate" callback function.
-// Activates the @widget if @group_cycling is
+// Activates the widget if group_cycling is
 //
-//	%FALSE, and just grabs the focus if @group_cycling is %TRUE.
+//	false, and just grabs the focus if group_cycling is true.
 func (x *WidgetClass) GetMnemonicActivate() func(*Widget, bool) bool {
 	if x.xMnemonicActivate == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, GroupCyclingVarp bool) bool
-	purego.RegisterFunc(&rawCallback, x.xMnemonicActivate)
+	core.RegisterFunc(&rawCallback, x.xMnemonicActivate)
 	return func(WidgetVar *Widget, GroupCyclingVar bool) bool {
 		return rawCallback(WidgetVar.GoPointer(), GroupCyclingVar)
 	}
 }
 
 // OverrideGrabFocus sets the "grab_focus" callback function.
-// Causes @widget to have the keyboard focus for the
+// Causes widget to have the keyboard focus for the
 //
 //	`GtkWindow` it’s inside.
 func (x *WidgetClass) OverrideGrabFocus(cb func(*Widget) bool) {
 	if cb == nil {
 		x.xGrabFocus = 0
 	} else {
-		x.xGrabFocus = purego.NewCallback(func(WidgetVarp uintptr) bool {
+		x.xGrabFocus = core.NewCallback(func(WidgetVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp))
 		})
 	}
 }
 
 // GetGrabFocus gets the "grab_focus" callback function.
-// Causes @widget to have the keyboard focus for the
+// Causes widget to have the keyboard focus for the
 //
 //	`GtkWindow` it’s inside.
 func (x *WidgetClass) GetGrabFocus() func(*Widget) bool {
@@ -1008,32 +1032,33 @@ func (x *WidgetClass) GetGrabFocus() func(*Widget) bool {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xGrabFocus)
+	core.RegisterFunc(&rawCallback, x.xGrabFocus)
 	return func(WidgetVar *Widget) bool {
 		return rawCallback(WidgetVar.GoPointer())
 	}
 }
 
 // OverrideFocus sets the "focus" callback function.
-// Vfunc for gtk_widget_child_focus()
+// Vfunc for [Widget.ChildFocus]
 func (x *WidgetClass) OverrideFocus(cb func(*Widget, DirectionType) bool) {
 	if cb == nil {
 		x.xFocus = 0
 	} else {
-		x.xFocus = purego.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) bool {
+		x.xFocus = core.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp), DirectionVarp)
 		})
 	}
 }
 
 // GetFocus gets the "focus" callback function.
-// Vfunc for gtk_widget_child_focus()
+// Vfunc for [Widget.ChildFocus]
 func (x *WidgetClass) GetFocus() func(*Widget, DirectionType) bool {
 	if x.xFocus == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, DirectionVarp DirectionType) bool
-	purego.RegisterFunc(&rawCallback, x.xFocus)
+	core.RegisterFunc(&rawCallback, x.xFocus)
 	return func(WidgetVar *Widget, DirectionVar DirectionType) bool {
 		return rawCallback(WidgetVar.GoPointer(), DirectionVar)
 	}
@@ -1045,7 +1070,8 @@ func (x *WidgetClass) OverrideSetFocusChild(cb func(*Widget, *Widget)) {
 	if cb == nil {
 		x.xSetFocusChild = 0
 	} else {
-		x.xSetFocusChild = purego.NewCallback(func(WidgetVarp uintptr, ChildVarp uintptr) {
+		x.xSetFocusChild = core.NewCallback(func(WidgetVarp uintptr, ChildVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), WidgetNewFromInternalPtr(ChildVarp))
 		})
 	}
@@ -1058,7 +1084,7 @@ func (x *WidgetClass) GetSetFocusChild() func(*Widget, *Widget) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, ChildVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSetFocusChild)
+	core.RegisterFunc(&rawCallback, x.xSetFocusChild)
 	return func(WidgetVar *Widget, ChildVar *Widget) {
 		rawCallback(WidgetVar.GoPointer(), ChildVar.GoPointer())
 	}
@@ -1070,7 +1096,8 @@ func (x *WidgetClass) OverrideMoveFocus(cb func(*Widget, DirectionType)) {
 	if cb == nil {
 		x.xMoveFocus = 0
 	} else {
-		x.xMoveFocus = purego.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) {
+		x.xMoveFocus = core.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), DirectionVarp)
 		})
 	}
@@ -1083,7 +1110,7 @@ func (x *WidgetClass) GetMoveFocus() func(*Widget, DirectionType) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, DirectionVarp DirectionType)
-	purego.RegisterFunc(&rawCallback, x.xMoveFocus)
+	core.RegisterFunc(&rawCallback, x.xMoveFocus)
 	return func(WidgetVar *Widget, DirectionVar DirectionType) {
 		rawCallback(WidgetVar.GoPointer(), DirectionVar)
 	}
@@ -1095,7 +1122,8 @@ func (x *WidgetClass) OverrideKeynavFailed(cb func(*Widget, DirectionType) bool)
 	if cb == nil {
 		x.xKeynavFailed = 0
 	} else {
-		x.xKeynavFailed = purego.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) bool {
+		x.xKeynavFailed = core.NewCallback(func(WidgetVarp uintptr, DirectionVarp DirectionType) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp), DirectionVarp)
 		})
 	}
@@ -1108,14 +1136,14 @@ func (x *WidgetClass) GetKeynavFailed() func(*Widget, DirectionType) bool {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, DirectionVarp DirectionType) bool
-	purego.RegisterFunc(&rawCallback, x.xKeynavFailed)
+	core.RegisterFunc(&rawCallback, x.xKeynavFailed)
 	return func(WidgetVar *Widget, DirectionVar DirectionType) bool {
 		return rawCallback(WidgetVar.GoPointer(), DirectionVar)
 	}
 }
 
 // OverrideQueryTooltip sets the "query_tooltip" callback function.
-// Signal emitted when “has-tooltip” is %TRUE and the
+// Signal emitted when “has-tooltip” is true and the
 //
 //	hover timeout has expired with the cursor hovering “above”
 //	widget; or emitted when widget got focus in keyboard mode.
@@ -1123,14 +1151,15 @@ func (x *WidgetClass) OverrideQueryTooltip(cb func(*Widget, int, int, bool, *Too
 	if cb == nil {
 		x.xQueryTooltip = 0
 	} else {
-		x.xQueryTooltip = purego.NewCallback(func(WidgetVarp uintptr, XVarp int, YVarp int, KeyboardTooltipVarp bool, TooltipVarp uintptr) bool {
+		x.xQueryTooltip = core.NewCallback(func(WidgetVarp uintptr, XVarp int, YVarp int, KeyboardTooltipVarp bool, TooltipVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp), XVarp, YVarp, KeyboardTooltipVarp, TooltipNewFromInternalPtr(TooltipVarp))
 		})
 	}
 }
 
 // GetQueryTooltip gets the "query_tooltip" callback function.
-// Signal emitted when “has-tooltip” is %TRUE and the
+// Signal emitted when “has-tooltip” is true and the
 //
 //	hover timeout has expired with the cursor hovering “above”
 //	widget; or emitted when widget got focus in keyboard mode.
@@ -1139,7 +1168,7 @@ func (x *WidgetClass) GetQueryTooltip() func(*Widget, int, int, bool, *Tooltip)
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, XVarp int, YVarp int, KeyboardTooltipVarp bool, TooltipVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xQueryTooltip)
+	core.RegisterFunc(&rawCallback, x.xQueryTooltip)
 	return func(WidgetVar *Widget, XVar int, YVar int, KeyboardTooltipVar bool, TooltipVar *Tooltip) bool {
 		return rawCallback(WidgetVar.GoPointer(), XVar, YVar, KeyboardTooltipVar, TooltipVar.GoPointer())
 	}
@@ -1153,7 +1182,8 @@ func (x *WidgetClass) OverrideComputeExpand(cb func(*Widget, bool, bool)) {
 	if cb == nil {
 		x.xComputeExpand = 0
 	} else {
-		x.xComputeExpand = purego.NewCallback(func(WidgetVarp uintptr, HexpandPVarp bool, VexpandPVarp bool) {
+		x.xComputeExpand = core.NewCallback(func(WidgetVarp uintptr, HexpandPVarp bool, VexpandPVarp bool) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), HexpandPVarp, VexpandPVarp)
 		})
 	}
@@ -1168,7 +1198,7 @@ func (x *WidgetClass) GetComputeExpand() func(*Widget, bool, bool) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, HexpandPVarp bool, VexpandPVarp bool)
-	purego.RegisterFunc(&rawCallback, x.xComputeExpand)
+	core.RegisterFunc(&rawCallback, x.xComputeExpand)
 	return func(WidgetVar *Widget, HexpandPVar bool, VexpandPVar bool) {
 		rawCallback(WidgetVar.GoPointer(), HexpandPVar, VexpandPVar)
 	}
@@ -1184,7 +1214,8 @@ func (x *WidgetClass) OverrideCssChanged(cb func(*Widget, *CssStyleChange)) {
 	if cb == nil {
 		x.xCssChanged = 0
 	} else {
-		x.xCssChanged = purego.NewCallback(func(WidgetVarp uintptr, ChangeVarp *CssStyleChange) {
+		x.xCssChanged = core.NewCallback(func(WidgetVarp uintptr, ChangeVarp *CssStyleChange) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), ChangeVarp)
 		})
 	}
@@ -1201,7 +1232,7 @@ func (x *WidgetClass) GetCssChanged() func(*Widget, *CssStyleChange) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, ChangeVarp *CssStyleChange)
-	purego.RegisterFunc(&rawCallback, x.xCssChanged)
+	core.RegisterFunc(&rawCallback, x.xCssChanged)
 	return func(WidgetVar *Widget, ChangeVar *CssStyleChange) {
 		rawCallback(WidgetVar.GoPointer(), ChangeVar)
 	}
@@ -1213,7 +1244,8 @@ func (x *WidgetClass) OverrideSystemSettingChanged(cb func(*Widget, SystemSettin
 	if cb == nil {
 		x.xSystemSettingChanged = 0
 	} else {
-		x.xSystemSettingChanged = purego.NewCallback(func(WidgetVarp uintptr, SettingsVarp SystemSetting) {
+		x.xSystemSettingChanged = core.NewCallback(func(WidgetVarp uintptr, SettingsVarp SystemSetting) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), SettingsVarp)
 		})
 	}
@@ -1226,7 +1258,7 @@ func (x *WidgetClass) GetSystemSettingChanged() func(*Widget, SystemSetting) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, SettingsVarp SystemSetting)
-	purego.RegisterFunc(&rawCallback, x.xSystemSettingChanged)
+	core.RegisterFunc(&rawCallback, x.xSystemSettingChanged)
 	return func(WidgetVar *Widget, SettingsVar SystemSetting) {
 		rawCallback(WidgetVar.GoPointer(), SettingsVar)
 	}
@@ -1238,7 +1270,8 @@ func (x *WidgetClass) OverrideSnapshot(cb func(*Widget, *Snapshot)) {
 	if cb == nil {
 		x.xSnapshot = 0
 	} else {
-		x.xSnapshot = purego.NewCallback(func(WidgetVarp uintptr, SnapshotVarp uintptr) {
+		x.xSnapshot = core.NewCallback(func(WidgetVarp uintptr, SnapshotVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WidgetNewFromInternalPtr(WidgetVarp), SnapshotNewFromInternalPtr(SnapshotVarp))
 		})
 	}
@@ -1251,32 +1284,33 @@ func (x *WidgetClass) GetSnapshot() func(*Widget, *Snapshot) {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, SnapshotVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xSnapshot)
+	core.RegisterFunc(&rawCallback, x.xSnapshot)
 	return func(WidgetVar *Widget, SnapshotVar *Snapshot) {
 		rawCallback(WidgetVar.GoPointer(), SnapshotVar.GoPointer())
 	}
 }
 
 // OverrideContains sets the "contains" callback function.
-// Vfunc for gtk_widget_contains().
+// Vfunc for [Widget.Contains].
 func (x *WidgetClass) OverrideContains(cb func(*Widget, float64, float64) bool) {
 	if cb == nil {
 		x.xContains = 0
 	} else {
-		x.xContains = purego.NewCallback(func(WidgetVarp uintptr, XVarp float64, YVarp float64) bool {
+		x.xContains = core.NewCallback(func(WidgetVarp uintptr, XVarp float64, YVarp float64) bool {
+			defer core.RecoverPanic()
 			return cb(WidgetNewFromInternalPtr(WidgetVarp), XVarp, YVarp)
 		})
 	}
 }
 
 // GetContains gets the "contains" callback function.
-// Vfunc for gtk_widget_contains().
+// Vfunc for [Widget.Contains].
 func (x *WidgetClass) GetContains() func(*Widget, float64, float64) bool {
 	if x.xContains == 0 {
 		return nil
 	}
 	var rawCallback func(WidgetVarp uintptr, XVarp float64, YVarp float64) bool
-	purego.RegisterFunc(&rawCallback, x.xContains)
+	core.RegisterFunc(&rawCallback, x.xContains)
 	return func(WidgetVar *Widget, XVar float64, YVar float64) bool {
 		return rawCallback(WidgetVar.GoPointer(), XVar, YVar)
 	}
@@ -1305,7 +1339,7 @@ type Allocation = uintptr
 //
 // It manages the widget lifecycle, layout, states and style.
 //
-// ### Height-for-width Geometry Management
+// # Height-for-width Geometry Management
 //
 // GTK uses a height-for-width (and width-for-height) geometry management
 // system. Height-for-width means that a widget can change how much
@@ -1317,44 +1351,44 @@ type Allocation = uintptr
 // Height-for-width geometry management is implemented in GTK by way
 // of two virtual methods:
 //
-// - [vfunc@Gtk.Widget.get_request_mode]
-// - [vfunc@Gtk.Widget.measure]
+// - Widget.get_request_mode
+// - Widget.measure
 //
 // There are some important things to keep in mind when implementing
 // height-for-width and when using it in widget implementations.
 //
 // If you implement a direct `GtkWidget` subclass that supports
 // height-for-width or width-for-height geometry management for itself
-// or its child widgets, the [vfunc@Gtk.Widget.get_request_mode] virtual
+// or its child widgets, the Widget.get_request_mode virtual
 // function must be implemented as well and return the widget's preferred
 // request mode. The default implementation of this virtual function
-// returns %GTK_SIZE_REQUEST_CONSTANT_SIZE, which means that the widget will
+// returns [SizeRequestConstantSizeValue], which means that the widget will
 // only ever get -1 passed as the for_size value to its
-// [vfunc@Gtk.Widget.measure] implementation.
+// Widget.measure implementation.
 //
 // The geometry management system will query a widget hierarchy in
 // only one orientation at a time. When widgets are initially queried
 // for their minimum sizes it is generally done in two initial passes
-// in the [enum@Gtk.SizeRequestMode] chosen by the toplevel.
+// in the [SizeRequestMode] chosen by the toplevel.
 //
-// For example, when queried in the normal %GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH mode:
+// For example, when queried in the normal [SizeRequestHeightForWidthValue] mode:
 //
 // First, the default minimum and natural width for each widget
-// in the interface will be computed using [method@Gtk.Widget.measure] with an
-// orientation of %GTK_ORIENTATION_HORIZONTAL and a for_size of -1.
+// in the interface will be computed using [Widget.Measure] with an
+// orientation of [OrientationHorizontalValue] and a for_size of -1.
 // Because the preferred widths for each widget depend on the preferred
 // widths of their children, this information propagates up the hierarchy,
 // and finally a minimum and natural width is determined for the entire
 // toplevel. Next, the toplevel will use the minimum width to query for the
-// minimum height contextual to that width using [method@Gtk.Widget.measure] with an
-// orientation of %GTK_ORIENTATION_VERTICAL and a for_size of the just computed
+// minimum height contextual to that width using [Widget.Measure] with an
+// orientation of [OrientationVerticalValue] and a for_size of the just computed
 // width. This will also be a highly recursive operation. The minimum height
 // for the minimum width is normally used to set the minimum size constraint
 // on the toplevel.
 //
 // After the toplevel window has initially requested its size in both
 // dimensions it can go on to allocate itself a reasonable size (or a size
-// previously specified with [method@Gtk.Window.set_default_size]). During the
+// previously specified with [Window.SetDefaultSize]). During the
 // recursive allocation process it’s important to note that request cycles
 // will be recursively executed while widgets allocate their children.
 // Each widget, once allocated a size, will go on to first share the
@@ -1371,28 +1405,25 @@ type Allocation = uintptr
 // `GtkSizeRequestMode`s even if the widget in question only
 // trades sizes in a single orientation.
 //
-// For instance, a [class@Gtk.Label] that does height-for-width word wrapping
-// will not expect to have [vfunc@Gtk.Widget.measure] with an orientation of
-// %GTK_ORIENTATION_VERTICAL called because that call is specific to a
+// For instance, a [Label] that does height-for-width word wrapping
+// will not expect to have Widget.measure with an orientation of
+// [OrientationVerticalValue] called because that call is specific to a
 // width-for-height request. In this case the label must return the height
 // required for its own minimum possible width. By following this rule any
 // widget that handles height-for-width or width-for-height requests will
 // always be allocated at least enough space to fit its own content.
 //
-// Here are some examples of how a %GTK_SIZE_REQUEST_HEIGHT_FOR_WIDTH widget
+// Here are some examples of how a [SizeRequestHeightForWidthValue] widget
 // generally deals with width-for-height requests:
 //
-// ```c
-// static void
-// foo_widget_measure (GtkWidget      *widget,
-//
-//	GtkOrientation  orientation,
-//	int             for_size,
-//	int            *minimum_size,
-//	int            *natural_size,
-//	int            *minimum_baseline,
-//	int            *natural_baseline)
-//
+//	static void
+//	foo_widget_measure (GtkWidget      *widget,
+//	                    GtkOrientation  orientation,
+//	                    int             for_size,
+//	                    int            *minimum_size,
+//	                    int            *natural_size,
+//	                    int            *minimum_baseline,
+//	                    int            *natural_baseline)
 //	{
 //	  if (orientation == GTK_ORIENTATION_HORIZONTAL)
 //	    {
@@ -1405,13 +1436,13 @@ type Allocation = uintptr
 //	          int min_width, dummy;
 //
 //	          // First, get the minimum width of our widget
-//	          GTK_WIDGET_GET_CLASS (widget)-&gt;measure (widget, GTK_ORIENTATION_HORIZONTAL, -1,
-//	                                                  &amp;min_width, &amp;dummy, &amp;dummy, &amp;dummy);
+//	          GTK_WIDGET_GET_CLASS (widget)->measure (widget, GTK_ORIENTATION_HORIZONTAL, -1,
+//	                                                  &min_width, &dummy, &dummy, &dummy);
 //
 //	          // Now use the minimum width to retrieve the minimum and natural height to display
 //	          // that width.
-//	          GTK_WIDGET_GET_CLASS (widget)-&gt;measure (widget, GTK_ORIENTATION_VERTICAL, min_width,
-//	                                                  minimum_size, natural_size, &amp;dummy, &amp;dummy);
+//	          GTK_WIDGET_GET_CLASS (widget)->measure (widget, GTK_ORIENTATION_VERTICAL, min_width,
+//	                                                  minimum_size, natural_size, &dummy, &dummy);
 //	        }
 //	      else
 //	        {
@@ -1420,8 +1451,6 @@ type Allocation = uintptr
 //	    }
 //	}
 //
-// ```
-//
 // Often a widget needs to get its own request during size request or
 // allocation. For example, when computing height it may need to also
 // compute width. Or when deciding how to use an allocation, the widget
@@ -1429,9 +1458,9 @@ type Allocation = uintptr
 // be careful to call its virtual methods directly, like in the code
 // example above.
 //
-// It will not work to use the wrapper function [method@Gtk.Widget.measure]
-// inside your own [vfunc@Gtk.Widget.size_allocate] implementation.
-// These return a request adjusted by [class@Gtk.SizeGroup], the widget's
+// It will not work to use the wrapper function [Widget.Measure]
+// inside your own Widget.size_allocate implementation.
+// These return a request adjusted by [SizeGroup], the widget's
 // align and expand flags, as well as its CSS style.
 //
 // If a widget used the wrappers inside its virtual method implementations,
@@ -1440,8 +1469,8 @@ type Allocation = uintptr
 // to do it.
 //
 // Of course if you are getting the size request for another widget, such
-// as a child widget, you must use [method@Gtk.Widget.measure]; otherwise, you
-// would not properly consider widget margins, [class@Gtk.SizeGroup], and
+// as a child widget, you must use [Widget.Measure]; otherwise, you
+// would not properly consider widget margins, [SizeGroup], and
 // so forth.
 //
 // GTK also supports baseline vertical alignment of widgets. This
@@ -1452,151 +1481,133 @@ type Allocation = uintptr
 // the baseline, or a baseline assigned to it by the grandparent.
 //
 // Baseline alignment support for a widget is also done by the
-// [vfunc@Gtk.Widget.measure] virtual function. It allows you to report
+// Widget.measure virtual function. It allows you to report
 // both a minimum and natural size.
 //
 // If a widget ends up baseline aligned it will be allocated all the space in
-// the parent as if it was %GTK_ALIGN_FILL, but the selected baseline can be
-// found via [method@Gtk.Widget.get_baseline]. If the baseline has a
+// the parent as if it was [AlignFillValue], but the selected baseline can be
+// found via [Widget.GetBaseline]. If the baseline has a
 // value other than -1 you need to align the widget such that the baseline
 // appears at the position.
 //
-// ### GtkWidget as GtkBuildable
+// # GtkWidget as GtkBuildable
 //
 // The `GtkWidget` implementation of the `GtkBuildable` interface
 // supports various custom elements to specify additional aspects of widgets
 // that are not directly expressed as properties.
 //
-// If the widget uses a [class@Gtk.LayoutManager], `GtkWidget` supports
-// a custom `&lt;layout&gt;` element, used to define layout properties:
-//
-// ```xml
-// &lt;object class="GtkGrid" id="my_grid"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="GtkLabel" id="label1"&gt;
-//	    &lt;property name="label"&gt;Description&lt;/property&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;0&lt;/property&gt;
-//	      &lt;property name="row"&gt;0&lt;/property&gt;
-//	      &lt;property name="row-span"&gt;1&lt;/property&gt;
-//	      &lt;property name="column-span"&gt;1&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//	&lt;child&gt;
-//	  &lt;object class="GtkEntry" id="description_entry"&gt;
-//	    &lt;layout&gt;
-//	      &lt;property name="column"&gt;1&lt;/property&gt;
-//	      &lt;property name="row"&gt;0&lt;/property&gt;
-//	      &lt;property name="row-span"&gt;1&lt;/property&gt;
-//	      &lt;property name="column-span"&gt;1&lt;/property&gt;
-//	    &lt;/layout&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+// If the widget uses a [LayoutManager], `GtkWidget` supports
+// a custom `<layout>` element, used to define layout properties:
+//
+//	<object class="GtkGrid" id="my_grid">
+//	  <child>
+//	    <object class="GtkLabel" id="label1">
+//	      <property name="label">Description</property>
+//	      <layout>
+//	        <property name="column">0</property>
+//	        <property name="row">0</property>
+//	        <property name="row-span">1</property>
+//	        <property name="column-span">1</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	  <child>
+//	    <object class="GtkEntry" id="description_entry">
+//	      <layout>
+//	        <property name="column">1</property>
+//	        <property name="row">0</property>
+//	        <property name="row-span">1</property>
+//	        <property name="column-span">1</property>
+//	      </layout>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // `GtkWidget` allows style information such as style classes to
-// be associated with widgets, using the custom `&lt;style&gt;` element:
-//
-// ```xml
-// &lt;object class="GtkButton" id="button1"&gt;
-//
-//	&lt;style&gt;
-//	  &lt;class name="my-special-button-class"/&gt;
-//	  &lt;class name="dark-button"/&gt;
-//	&lt;/style&gt;
+// be associated with widgets, using the custom `<style>` element:
 //
-// &lt;/object&gt;
-// ```
+//	<object class="GtkButton" id="button1">
+//	  <style>
+//	    <class name="my-special-button-class"/>
+//	    <class name="dark-button"/>
+//	  </style>
+//	</object>
 //
 // `GtkWidget` allows defining accessibility information, such as properties,
-// relations, and states, using the custom `&lt;accessibility&gt;` element:
+// relations, and states, using the custom `<accessibility>` element:
 //
-// ```xml
-// &lt;object class="GtkButton" id="button1"&gt;
+//	<object class="GtkButton" id="button1">
+//	  <accessibility>
+//	    <property name="label">Download</property>
+//	    <relation name="labelled-by">label1</relation>
+//	  </accessibility>
+//	</object>
 //
-//	&lt;accessibility&gt;
-//	  &lt;property name="label"&gt;Download&lt;/property&gt;
-//	  &lt;relation name="labelled-by"&gt;label1&lt;/relation&gt;
-//	&lt;/accessibility&gt;
-//
-// &lt;/object&gt;
-// ```
-//
-// ### Building composite widgets from template XML
+// # Building composite widgets from template XML
 //
 // `GtkWidget `exposes some facilities to automate the procedure
 // of creating composite widgets using "templates".
 //
 // To create composite widgets with `GtkBuilder` XML, one must associate
 // the interface description with the widget class at class initialization
-// time using [method@Gtk.WidgetClass.set_template].
+// time using [WidgetClass.SetTemplate].
 //
 // The interface description semantics expected in composite template descriptions
-// is slightly different from regular [class@Gtk.Builder] XML.
+// is slightly different from regular [Builder] XML.
 //
-// Unlike regular interface descriptions, [method@Gtk.WidgetClass.set_template]
-// will expect a `&lt;template&gt;` tag as a direct child of the toplevel
-// `&lt;interface&gt;` tag. The `&lt;template&gt;` tag must specify the “class” attribute
+// Unlike regular interface descriptions, [WidgetClass.SetTemplate]
+// will expect a `<template>` tag as a direct child of the toplevel
+// `<interface>` tag. The `<template>` tag must specify the “class” attribute
 // which must be the type name of the widget. Optionally, the “parent”
 // attribute may be specified to specify the direct parent type of the widget
 // type; this is ignored by `GtkBuilder` but can be used by UI design tools to
 // introspect what kind of properties and internal children exist for a given
 // type when the actual type does not exist.
 //
-// The XML which is contained inside the `&lt;template&gt;` tag behaves as if it were
-// added to the `&lt;object&gt;` tag defining the widget itself. You may set properties
-// on a widget by inserting `&lt;property&gt;` tags into the `&lt;template&gt;` tag, and also
-// add `&lt;child&gt;` tags to add children and extend a widget in the normal way you
-// would with `&lt;object&gt;` tags.
+// The XML which is contained inside the `<template>` tag behaves as if it were
+// added to the `<object>` tag defining the widget itself. You may set properties
+// on a widget by inserting `<property>` tags into the `<template>` tag, and also
+// add `<child>` tags to add children and extend a widget in the normal way you
+// would with `<object>` tags.
 //
-// Additionally, `&lt;object&gt;` tags can also be added before and after the initial
-// `&lt;template&gt;` tag in the normal way, allowing one to define auxiliary objects
+// Additionally, `<object>` tags can also be added before and after the initial
+// `<template>` tag in the normal way, allowing one to define auxiliary objects
 // which might be referenced by other widgets declared as children of the
-// `&lt;template&gt;` tag.
+// `<template>` tag.
 //
-// Since, unlike the `&lt;object&gt;` tag, the `&lt;template&gt;` tag does not contain an
+// Since, unlike the `<object>` tag, the `<template>` tag does not contain an
 // “id” attribute, if you need to refer to the instance of the object itself that
 // the template will create, simply refer to the template class name in an
 // applicable element content.
 //
 // Here is an example of a template definition, which includes an example of
-// this in the `&lt;signal&gt;` tag:
-//
-// ```xml
-// &lt;interface&gt;
-//
-//	&lt;template class="FooWidget" parent="GtkBox"&gt;
-//	  &lt;property name="orientation"&gt;horizontal&lt;/property&gt;
-//	  &lt;property name="spacing"&gt;4&lt;/property&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="GtkButton" id="hello_button"&gt;
-//	      &lt;property name="label"&gt;Hello World&lt;/property&gt;
-//	      &lt;signal name="clicked" handler="hello_button_clicked" object="FooWidget" swapped="yes"/&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	  &lt;child&gt;
-//	    &lt;object class="GtkButton" id="goodbye_button"&gt;
-//	      &lt;property name="label"&gt;Goodbye World&lt;/property&gt;
-//	    &lt;/object&gt;
-//	  &lt;/child&gt;
-//	&lt;/template&gt;
-//
-// &lt;/interface&gt;
-// ```
+// this in the `<signal>` tag:
+//
+//	<interface>
+//	  <template class="FooWidget" parent="GtkBox">
+//	    <property name="orientation">horizontal</property>
+//	    <property name="spacing">4</property>
+//	    <child>
+//	      <object class="GtkButton" id="hello_button">
+//	        <property name="label">Hello World</property>
+//	        <signal name="clicked" handler="hello_button_clicked" object="FooWidget" swapped="yes"/>
+//	      </object>
+//	    </child>
+//	    <child>
+//	      <object class="GtkButton" id="goodbye_button">
+//	        <property name="label">Goodbye World</property>
+//	      </object>
+//	    </child>
+//	  </template>
+//	</interface>
 //
 // Typically, you'll place the template fragment into a file that is
 // bundled with your project, using `GResource`. In order to load the
-// template, you need to call [method@Gtk.WidgetClass.set_template_from_resource]
+// template, you need to call [WidgetClass.SetTemplateFromResource]
 // from the class initialization of your `GtkWidget` type:
 //
-// ```c
-// static void
-// foo_widget_class_init (FooWidgetClass *klass)
-//
+//	static void
+//	foo_widget_class_init (FooWidgetClass *klass)
 //	{
 //	  // ...
 //
@@ -1604,30 +1615,22 @@ type Allocation = uintptr
 //	                                               "/com/example/ui/foowidget.ui");
 //	}
 //
-// ```
-//
-// You will also need to call [method@Gtk.Widget.init_template] from the
+// You will also need to call [Widget.InitTemplate] from the
 // instance initialization function:
 //
-// ```c
-// static void
-// foo_widget_init (FooWidget *self)
-//
+//	static void
+//	foo_widget_init (FooWidget *self)
 //	{
 //	  gtk_widget_init_template (GTK_WIDGET (self));
 //
 //	  // Initialize the rest of the widget...
 //	}
 //
-// ```
-//
-// as well as calling [method@Gtk.Widget.dispose_template] from the dispose
+// as well as calling [Widget.DisposeTemplate] from the dispose
 // function:
 //
-// ```c
-// static void
-// foo_widget_dispose (GObject *gobject)
-//
+//	static void
+//	foo_widget_dispose (GObject *gobject)
 //	{
 //	  FooWidget *self = FOO_WIDGET (gobject);
 //
@@ -1636,43 +1639,37 @@ type Allocation = uintptr
 //	  // Clear the template children for this widget type
 //	  gtk_widget_dispose_template (GTK_WIDGET (self), FOO_TYPE_WIDGET);
 //
-//	  G_OBJECT_CLASS (foo_widget_parent_class)-&gt;dispose (gobject);
+//	  G_OBJECT_CLASS (foo_widget_parent_class)->dispose (gobject);
 //	}
 //
-// ```
-//
 // You can access widgets defined in the template using the
-// [method@Gtk.Widget.get_template_child] function, but you will typically declare
+// [Widget.GetTemplateChild] function, but you will typically declare
 // a pointer in the instance private data structure of your type using the same
 // name as the widget in the template definition, and call
-// [method@Gtk.WidgetClass.bind_template_child_full] (or one of its wrapper macros
-// [func@Gtk.widget_class_bind_template_child] and [func@Gtk.widget_class_bind_template_child_private])
+// [WidgetClass.BindTemplateChildFull] (or one of its wrapper macros
+// widget_class_bind_template_child and widget_class_bind_template_child_private)
 // with that name, e.g.
 //
-// ```c
-//
 //	typedef struct {
 //	  GtkWidget *hello_button;
 //	  GtkWidget *goodbye_button;
 //	} FooWidgetPrivate;
 //
-// G_DEFINE_TYPE_WITH_PRIVATE (FooWidget, foo_widget, GTK_TYPE_BOX)
-//
-// static void
-// foo_widget_dispose (GObject *gobject)
+//	G_DEFINE_TYPE_WITH_PRIVATE (FooWidget, foo_widget, GTK_TYPE_BOX)
 //
+//	static void
+//	foo_widget_dispose (GObject *gobject)
 //	{
 //	  gtk_widget_dispose_template (GTK_WIDGET (gobject), FOO_TYPE_WIDGET);
 //
-//	  G_OBJECT_CLASS (foo_widget_parent_class)-&gt;dispose (gobject);
+//	  G_OBJECT_CLASS (foo_widget_parent_class)->dispose (gobject);
 //	}
 //
-// static void
-// foo_widget_class_init (FooWidgetClass *klass)
-//
+//	static void
+//	foo_widget_class_init (FooWidgetClass *klass)
 //	{
 //	  // ...
-//	  G_OBJECT_CLASS (klass)-&gt;dispose = foo_widget_dispose;
+//	  G_OBJECT_CLASS (klass)->dispose = foo_widget_dispose;
 //
 //	  gtk_widget_class_set_template_from_resource (GTK_WIDGET_CLASS (klass),
 //	                                               "/com/example/ui/foowidget.ui");
@@ -1682,43 +1679,34 @@ type Allocation = uintptr
 //	                                                FooWidget, goodbye_button);
 //	}
 //
-// static void
-// foo_widget_init (FooWidget *widget)
-//
+//	static void
+//	foo_widget_init (FooWidget *widget)
 //	{
 //	  gtk_widget_init_template (GTK_WIDGET (widget));
 //	}
 //
-// ```
-//
-// You can also use [method@Gtk.WidgetClass.bind_template_callback_full] (or
-// is wrapper macro [func@Gtk.widget_class_bind_template_callback]) to connect
+// You can also use [WidgetClass.BindTemplateCallbackFull] (or
+// is wrapper macro widget_class_bind_template_callback) to connect
 // a signal callback defined in the template with a function visible in the
 // scope of the class, e.g.
 //
-// ```c
-// // the signal handler has the instance and user data swapped
-// // because of the swapped="yes" attribute in the template XML
-// static void
-// hello_button_clicked (FooWidget *self,
-//
-//	GtkButton *button)
-//
+//	// the signal handler has the instance and user data swapped
+//	// because of the swapped="yes" attribute in the template XML
+//	static void
+//	hello_button_clicked (FooWidget *self,
+//	                      GtkButton *button)
 //	{
 //	  g_print ("Hello, world!\n");
 //	}
 //
-// static void
-// foo_widget_class_init (FooWidgetClass *klass)
-//
+//	static void
+//	foo_widget_class_init (FooWidgetClass *klass)
 //	{
 //	  // ...
 //	  gtk_widget_class_set_template_from_resource (GTK_WIDGET_CLASS (klass),
 //	                                               "/com/example/ui/foowidget.ui");
 //	  gtk_widget_class_bind_template_callback (GTK_WIDGET_CLASS (klass), hello_button_clicked);
 //	}
-//
-// ```
 type Widget struct {
 	gobject.InitiallyUnowned
 }
@@ -1738,7 +1726,7 @@ func WidgetNewFromInternalPtr(ptr uintptr) *Widget {
 var xWidgetActionSetEnabled func(uintptr, string, bool)
 
 // Enables or disables an action installed with
-// [method@Gtk.WidgetClass.install_action].
+// [WidgetClass.InstallAction].
 func (x *Widget) ActionSetEnabled(ActionNameVar string, EnabledVar bool) {
 
 	xWidgetActionSetEnabled(x.GoPointer(), ActionNameVar, EnabledVar)
@@ -1750,17 +1738,17 @@ var xWidgetActivate func(uintptr) bool
 // Activates the widget.
 //
 // The activation will emit the signal set using
-// [method@Gtk.WidgetClass.set_activate_signal]
+// [WidgetClass.SetActivateSignal]
 // during class initialization.
 //
-// Activation is what happens when you press &lt;kbd&gt;Enter&lt;/kbd&gt;
+// Activation is what happens when you press Enter
 // on a widget.
 //
 // If you wish to handle the activation keybinding yourself,
-// it is recommended to use [method@Gtk.WidgetClass.add_shortcut]
-// with an action created with [ctor@Gtk.SignalAction.new].
+// it is recommended to use [WidgetClass.AddShortcut]
+// with an action created with [NewSignalAction].
 //
-// If @widget is not activatable, the function returns false.
+// If widget is not activatable, the function returns false.
 func (x *Widget) Activate() bool {
 
 	cret := xWidgetActivate(x.GoPointer())
@@ -1772,10 +1760,12 @@ var xWidgetActivateAction func(uintptr, string, uintptr, ...interface{}) bool
 // Activates an action for the widget.
 //
 // The action is looked up in the action groups associated with
-// @widget and its ancestors.
+// widget and its ancestors.
+//
+// This is a wrapper around [Widget.ActivateActionVariant]
+// that constructs the args variant according to format_string.
 //
-// This is a wrapper around [method@Gtk.Widget.activate_action_variant]
-// that constructs the @args variant according to @format_string.
+// Deprecated: use [ActivateActionVariant], the version of this function that language bindings are meant to use.
 func (x *Widget) ActivateAction(NameVar string, FormatStringVar *string, varArgs ...interface{}) bool {
 
 	FormatStringVarPtr := core.GStrdupNullable(FormatStringVar)
@@ -1790,15 +1780,15 @@ var xWidgetActivateActionVariant func(uintptr, string, *glib.Variant) bool
 // Activates an action for the widget.
 //
 // The action is looked up in the action groups associated with
-// @widget and its ancestors.
+// widget and its ancestors.
 //
 // If the action is in an action group added with
-// [method@Gtk.Widget.insert_action_group], the @name is expected
+// [Widget.InsertActionGroup], the name is expected
 // to be prefixed with the prefix that was used when the group was
 // inserted.
 //
 // The arguments must match the actions expected parameter type,
-// as returned by [method@Gio.Action.get_parameter_type].
+// as returned by [gio.Action.GetParameterType].
 func (x *Widget) ActivateActionVariant(NameVar string, ArgsVar *glib.Variant) bool {
 
 	cret := xWidgetActivateActionVariant(x.GoPointer(), NameVar, ArgsVar)
@@ -1810,7 +1800,7 @@ var xWidgetActivateDefault func(uintptr)
 // Activates the `default.activate` action for the widget.
 //
 // The action is looked up in the same was as for
-// [method@Gtk.Widget.activate_action].
+// [Widget.ActivateAction].
 func (x *Widget) ActivateDefault() {
 
 	xWidgetActivateDefault(x.GoPointer())
@@ -1825,7 +1815,7 @@ var xWidgetAddController func(uintptr, uintptr)
 // propagated to the widget.
 //
 // You will usually want to call this function right after
-// creating any kind of [class@Gtk.EventController].
+// creating any kind of [EventController].
 func (x *Widget) AddController(ControllerVar *EventController) {
 
 	xWidgetAddController(x.GoPointer(), ControllerVar.GoPointer())
@@ -1837,9 +1827,9 @@ var xWidgetAddCssClass func(uintptr, string)
 // Adds a style class to the widget.
 //
 // After calling this function, the widget’s style will match
-// for @css_class, according to CSS matching rules.
+// for css_class, according to CSS matching rules.
 //
-// Use [method@Gtk.Widget.remove_css_class] to remove the
+// Use [Widget.RemoveCssClass] to remove the
 // style again.
 func (x *Widget) AddCssClass(CssClassVar string) {
 
@@ -1851,7 +1841,7 @@ var xWidgetAddMnemonicLabel func(uintptr, uintptr)
 
 // Adds a widget to the list of mnemonic labels for this widget.
 //
-// See [method@Gtk.Widget.list_mnemonic_labels].
+// See [Widget.ListMnemonicLabels].
 //
 // Note that the list of mnemonic labels for the widget is cleared
 // when the widget is destroyed, so the caller must make sure
@@ -1876,20 +1866,20 @@ var xWidgetAddTickCallback func(uintptr, uintptr, uintptr, uintptr) uint
 // The tick callback does not automatically imply a relayout or repaint.
 // If you want a repaint or relayout, and aren’t changing widget properties
 // that would trigger that (for example, changing the text of a label),
-// then you will have to call [method@Gtk.Widget.queue_resize] or
-// [method@Gtk.Widget.queue_draw] yourself.
+// then you will have to call [Widget.QueueResize] or
+// [Widget.QueueDraw] yourself.
 //
-// [method@Gdk.FrameClock.get_frame_time] should generally be used
+// [gdk.FrameClock.GetFrameTime] should generally be used
 // for timing continuous animations and
-// [method@Gdk.FrameTimings.get_predicted_presentation_time] should be
+// [gdk.FrameTimings.GetPredictedPresentationTime] should be
 // used if you are trying to display isolated frames at particular times.
 //
 // This is a more convenient alternative to connecting directly to the
-// [signal@Gdk.FrameClock::update] signal of the frame clock, since you
+// [gdk.FrameClock.ConnectUpdateFunc] signal of the frame clock, since you
 // don't have to worry about when a frame clock is assigned to a widget.
 //
 // To remove a tick callback, pass the ID that is returned by this function
-// to [method@Gtk.Widget.remove_tick_callback].
+// to [Widget.RemoveTickCallback].
 func (x *Widget) AddTickCallback(CallbackVar *TickCallback, UserDataVar uintptr, NotifyVar *glib.DestroyNotify) uint {
 
 	var CallbackVarRef uintptr
@@ -1899,11 +1889,12 @@ func (x *Widget) AddTickCallback(CallbackVar *TickCallback, UserDataVar uintptr,
 			CallbackVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *CallbackVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			CallbackVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
 		}
 	}
 
@@ -1914,11 +1905,12 @@ func (x *Widget) AddTickCallback(CallbackVar *TickCallback, UserDataVar uintptr,
 			NotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *NotifyVar
 				cbFn(arg0)
 			}
-			NotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
+			NotifyVarRef = core.NewCallback(fcb)
+			NotifyVarRef = glib.SaveCallbackWithClosure(NotifyVarPtr, NotifyVarRef, NotifyVar)
 		}
 	}
 
@@ -1926,7 +1918,7 @@ func (x *Widget) AddTickCallback(CallbackVar *TickCallback, UserDataVar uintptr,
 	return cret
 }
 
-var xWidgetAllocate func(uintptr, int, int, int, *gsk.Transform)
+var xWidgetAllocate func(uintptr, int, int, int, uintptr)
 
 // Assigns size, position, (optionally) a baseline and transform
 // to a child widget.
@@ -1938,10 +1930,10 @@ var xWidgetAllocate func(uintptr, int, int, int, *gsk.Transform)
 // This function is only used by widget implementations.
 //
 // For a version that does not take a transform, see
-// [method@Gtk.Widget.size_allocate].
+// [Widget.SizeAllocate].
 func (x *Widget) Allocate(WidthVar int, HeightVar int, BaselineVar int, TransformVar *gsk.Transform) {
 
-	xWidgetAllocate(x.GoPointer(), WidthVar, HeightVar, BaselineVar, TransformVar)
+	xWidgetAllocate(x.GoPointer(), WidthVar, HeightVar, BaselineVar, gobject.BoxedCopyNullable(gsk.TransformGLibType(), TransformVar.GoPointer()))
 
 }
 
@@ -1950,22 +1942,22 @@ var xWidgetChildFocus func(uintptr, DirectionType) bool
 // Called by widgets as the user moves around the window using
 // keyboard shortcuts.
 //
-// The @direction argument indicates what kind of motion is taking
+// The direction argument indicates what kind of motion is taking
 // place (up, down, left, right, tab forward, tab backward).
 //
-// This function calls the [vfunc@Gtk.Widget.focus] virtual function;
+// This function calls the Widget.focus virtual function;
 // widgets can override the virtual function in order to implement
 // appropriate focus behavior.
 //
 // The default `focus()` virtual function for a widget should return
-// true if moving in @direction left the focus on a focusable location
-// inside that widget, and false if moving in @direction moved the focus
+// true if moving in direction left the focus on a focusable location
+// inside that widget, and false if moving in direction moved the focus
 // outside the widget. When returning true, widgets normally call
-// [method@Gtk.Widget.grab_focus] to place the focus accordingly;
+// [Widget.GrabFocus] to place the focus accordingly;
 // when returning false, they don’t modify the current focus location.
 //
 // This function is used by custom widget implementations; if you're
-// writing an app, you’d use [method@Gtk.Widget.grab_focus] to move
+// writing an app, you’d use [Widget.GrabFocus] to move
 // the focus to a particular widget.
 func (x *Widget) ChildFocus(DirectionVar DirectionType) bool {
 
@@ -1975,18 +1967,18 @@ func (x *Widget) ChildFocus(DirectionVar DirectionType) bool {
 
 var xWidgetComputeBounds func(uintptr, uintptr, *graphene.Rect) bool
 
-// Computes the bounds for @widget in the coordinate space of @target.
+// Computes the bounds for widget in the coordinate space of target.
 //
 // The bounds of widget are (the bounding box of) the region that it is
-// expected to draw in. See the [coordinate system](coordinates.html)
+// expected to draw in. See the coordinate system
 // overview to learn more.
 //
-// If the operation is successful, true is returned. If @widget has no
-// bounds or the bounds cannot be expressed in @target's coordinate space
+// If the operation is successful, true is returned. If widget has no
+// bounds or the bounds cannot be expressed in target's coordinate space
 // (for example if both widgets are in different windows), false is
-// returned and @bounds is set to the zero rectangle.
+// returned and bounds is set to the zero rectangle.
 //
-// It is valid for @widget and @target to be the same widget.
+// It is valid for widget and target to be the same widget.
 func (x *Widget) ComputeBounds(TargetVar *Widget, OutBoundsVar *graphene.Rect) bool {
 
 	cret := xWidgetComputeBounds(x.GoPointer(), TargetVar.GoPointer(), OutBoundsVar)
@@ -1999,7 +1991,7 @@ var xWidgetComputeExpand func(uintptr, Orientation) bool
 // extra space when possible.
 //
 // Widgets with children should check this, rather than looking at
-// [method@Gtk.Widget.get_hexpand] or [method@Gtk.Widget.get_vexpand].
+// [Widget.GetHexpand] or [Widget.GetVexpand].
 //
 // This function already checks whether the widget is visible, so
 // visibility does not need to be checked separately. Non-visible
@@ -2016,11 +2008,11 @@ func (x *Widget) ComputeExpand(OrientationVar Orientation) bool {
 
 var xWidgetComputePoint func(uintptr, uintptr, *graphene.Point, *graphene.Point) bool
 
-// Translates the given @point in @widget's coordinates to coordinates
-// in @target’s coordinate system.
+// Translates the given point in widget's coordinates to coordinates
+// in target’s coordinate system.
 //
 // In order to perform this operation, both widgets must share a
-// a common ancestor. If that is not the case, @out_point is set
+// a common ancestor. If that is not the case, out_point is set
 // to (0, 0) and false is returned.
 func (x *Widget) ComputePoint(TargetVar *Widget, PointVar *graphene.Point, OutPointVar *graphene.Point) bool {
 
@@ -2031,14 +2023,14 @@ func (x *Widget) ComputePoint(TargetVar *Widget, PointVar *graphene.Point, OutPo
 var xWidgetComputeTransform func(uintptr, uintptr, *graphene.Matrix) bool
 
 // Computes a matrix suitable to describe a transformation from
-// @widget's coordinate system into @target's coordinate system.
+// widget's coordinate system into target's coordinate system.
 //
 // The transform can not be computed in certain cases, for example
-// when @widget and @target do not share a common ancestor. In that
-// case @out_transform gets set to the identity matrix.
+// when widget and target do not share a common ancestor. In that
+// case out_transform gets set to the identity matrix.
 //
 // To learn more about widget coordinate systems, see the coordinate
-// system [overview](coordinates.html).
+// system overview.
 func (x *Widget) ComputeTransform(TargetVar *Widget, OutTransformVar *graphene.Matrix) bool {
 
 	cret := xWidgetComputeTransform(x.GoPointer(), TargetVar.GoPointer(), OutTransformVar)
@@ -2050,7 +2042,7 @@ var xWidgetContains func(uintptr, float64, float64) bool
 // Tests if a given point is contained in the widget.
 //
 // The coordinates for (x, y) must be in widget coordinates, so
-// (0, 0) is assumed to be the top left of @widget's content area.
+// (0, 0) is assumed to be the top left of widget's content area.
 func (x *Widget) Contains(XVar float64, YVar float64) bool {
 
 	cret := xWidgetContains(x.GoPointer(), XVar, YVar)
@@ -2064,7 +2056,7 @@ var xWidgetCreatePangoContext func(uintptr) uintptr
 // The `PangoContext` will have the appropriate font map,
 // font options, font description, and base direction set.
 //
-// See also [method@Gtk.Widget.get_pango_context].
+// See also [Widget.GetPangoContext].
 func (x *Widget) CreatePangoContext() *pango.Context {
 	var cls *pango.Context
 
@@ -2088,7 +2080,7 @@ var xWidgetCreatePangoLayout func(uintptr, uintptr) uintptr
 // If you keep a `PangoLayout` created in this way around,
 // you need to re-create it when the widgets `PangoContext`
 // is replaced. This can be tracked by listening to changes
-// of the [property@Gtk.Widget:root] property on the widget.
+// of the Widget:root property on the widget.
 func (x *Widget) CreatePangoLayout(TextVar *string) *pango.Layout {
 	var cls *pango.Layout
 
@@ -2109,31 +2101,27 @@ var xWidgetDisposeTemplate func(uintptr, types.GType)
 
 // Clears the template children for the widget.
 //
-// This function is the opposite of [method@Gtk.Widget.init_template],
+// This function is the opposite of [Widget.InitTemplate],
 // and it is used to clear all the template children from a widget
 // instance. If you bound a template child to a field in the instance
 // structure, or in the instance private data structure, the field will
 // be set to `NULL` after this function returns.
 //
 // You should call this function inside the `GObjectClass.dispose()`
-// implementation of any widget that called [method@Gtk.Widget.init_template].
+// implementation of any widget that called [Widget.InitTemplate].
 // Typically, you will want to call this function last, right before
 // chaining up to the parent type's dispose implementation, e.g.
 //
-// ```c
-// static void
-// some_widget_dispose (GObject *gobject)
-//
+//	static void
+//	some_widget_dispose (GObject *gobject)
 //	{
 //	  SomeWidget *self = SOME_WIDGET (gobject);
 //
 //	  // Clear the template data for SomeWidget
 //	  gtk_widget_dispose_template (GTK_WIDGET (self), SOME_TYPE_WIDGET);
 //
-//	  G_OBJECT_CLASS (some_widget_parent_class)-&gt;dispose (gobject);
+//	  G_OBJECT_CLASS (some_widget_parent_class)->dispose (gobject);
 //	}
-//
-// ```
 func (x *Widget) DisposeTemplate(WidgetTypeVar types.GType) {
 
 	xWidgetDisposeTemplate(x.GoPointer(), WidgetTypeVar)
@@ -2153,10 +2141,10 @@ var xWidgetErrorBell func(uintptr)
 
 // Notifies the user about an input-related error on the widget.
 //
-// If the [property@Gtk.Settings:gtk-error-bell] setting is true,
-// it calls [method@Gdk.Surface.beep], otherwise it does nothing.
+// If the [Settings.GetPropertyGtkErrorBell] setting is true,
+// it calls [gdk.Surface.Beep], otherwise it does nothing.
 //
-// Note that the effect of [method@Gdk.Surface.beep] can be configured
+// Note that the effect of [gdk.Surface.Beep] can be configured
 // in many ways, depending on the windowing backend and the desktop
 // environment or window manager that is used.
 func (x *Widget) ErrorBell() {
@@ -2165,77 +2153,17 @@ func (x *Widget) ErrorBell() {
 
 }
 
-var xWidgetGetAllocatedBaseline func(uintptr) int
-
-// Returns the baseline that has currently been allocated to the widget.
-//
-// This function is intended to be used when implementing handlers
-// for the `GtkWidget`Class.snapshot() function, and when allocating
-// child widgets in `GtkWidget`Class.size_allocate().
-func (x *Widget) GetAllocatedBaseline() int {
-
-	cret := xWidgetGetAllocatedBaseline(x.GoPointer())
-	return cret
-}
-
-var xWidgetGetAllocatedHeight func(uintptr) int
-
-// Returns the height that has currently been allocated to the widget.
-//
-// To learn more about widget sizes, see the coordinate
-// system [overview](coordinates.html).
-func (x *Widget) GetAllocatedHeight() int {
-
-	cret := xWidgetGetAllocatedHeight(x.GoPointer())
-	return cret
-}
-
-var xWidgetGetAllocatedWidth func(uintptr) int
-
-// Returns the width that has currently been allocated to the widget.
-//
-// To learn more about widget sizes, see the coordinate
-// system [overview](coordinates.html).
-func (x *Widget) GetAllocatedWidth() int {
-
-	cret := xWidgetGetAllocatedWidth(x.GoPointer())
-	return cret
-}
-
-var xWidgetGetAllocation func(uintptr, *Allocation)
-
-// Retrieves the widget’s allocation.
-//
-// Note, when implementing a layout widget: a widget’s allocation
-// will be its “adjusted” allocation, that is, the widget’s parent
-// typically calls [method@Gtk.Widget.size_allocate] with an allocation,
-// and that allocation is then adjusted (to handle margin
-// and alignment for example) before assignment to the widget.
-// [method@Gtk.Widget.get_allocation] returns the adjusted allocation that
-// was actually assigned to the widget. The adjusted allocation is
-// guaranteed to be completely contained within the
-// [method@Gtk.Widget.size_allocate] allocation, however.
-//
-// So a layout widget is guaranteed that its children stay inside
-// the assigned bounds, but not that they have exactly the bounds the
-// widget assigned.
-func (x *Widget) GetAllocation(AllocationVar *Allocation) {
-
-	xWidgetGetAllocation(x.GoPointer(), AllocationVar)
-
-}
-
 var xWidgetGetAncestor func(uintptr, types.GType) uintptr
 
-// Gets the first ancestor of the widget with type @widget_type.
+// Gets the first ancestor of the widget with type widget_type.
 //
 // For example, `gtk_widget_get_ancestor (widget, GTK_TYPE_BOX)`
-// gets the first `GtkBox` that’s an ancestor of @widget. No
+// gets the first `GtkBox` that’s an ancestor of widget. No
 // reference will be added to the returned widget; it should
 // not be unreferenced.
 //
-// Note that unlike [method@Gtk.Widget.is_ancestor], this function
-// considers @widget to be an ancestor of itself.
+// Note that unlike [Widget.IsAncestor], this function
+// considers widget to be an ancestor of itself.
 func (x *Widget) GetAncestor(WidgetTypeVar types.GType) *Widget {
 	var cls *Widget
 
@@ -2268,7 +2196,7 @@ var xWidgetGetCanFocus func(uintptr) bool
 // Determines whether the input focus can enter the widget or any
 // of its children.
 //
-// See [method@Gtk.Widget.set_can_focus].
+// See [Widget.SetCanFocus].
 func (x *Widget) GetCanFocus() bool {
 
 	cret := xWidgetGetCanFocus(x.GoPointer())
@@ -2286,7 +2214,7 @@ func (x *Widget) GetCanTarget() bool {
 
 var xWidgetGetChildVisible func(uintptr) bool
 
-// Gets the value set with [method@Gtk.Widget.set_child_visible].
+// Gets the value set with [Widget.SetChildVisible].
 //
 // If you feel a need to use this function, your code probably
 // needs reorganization.
@@ -2304,9 +2232,9 @@ var xWidgetGetClipboard func(uintptr) uintptr
 // Gets the clipboard object for the widget.
 //
 // This is a utility function to get the clipboard object for the
-// display that @widget is using.
+// display that widget is using.
 //
-// Note that this function always works, even when @widget is not
+// Note that this function always works, even when widget is not
 // realized yet.
 func (x *Widget) GetClipboard() *gdk.Clipboard {
 	var cls *gdk.Clipboard
@@ -2335,13 +2263,13 @@ func (x *Widget) GetColor(ColorVar *gdk.RGBA) {
 
 }
 
-var xWidgetGetCssClasses func(uintptr) []string
+var xWidgetGetCssClasses func(uintptr) uintptr
 
 // Returns the list of style classes applied to the widget.
 func (x *Widget) GetCssClasses() []string {
 
 	cret := xWidgetGetCssClasses(x.GoPointer())
-	return cret
+	return core.GoStringSliceFull(cret)
 }
 
 var xWidgetGetCssName func(uintptr) string
@@ -2357,7 +2285,7 @@ var xWidgetGetCursor func(uintptr) uintptr
 
 // Gets the cursor set on the widget.
 //
-// See [method@Gtk.Widget.set_cursor] for details.
+// See [Widget.SetCursor] for details.
 func (x *Widget) GetCursor() *gdk.Cursor {
 	var cls *gdk.Cursor
 
@@ -2376,7 +2304,7 @@ var xWidgetGetDirection func(uintptr) TextDirection
 
 // Gets the reading direction for the widget.
 //
-// See [method@Gtk.Widget.set_direction].
+// See [Widget.SetDirection].
 func (x *Widget) GetDirection() TextDirection {
 
 	cret := xWidgetGetDirection(x.GoPointer())
@@ -2448,7 +2376,7 @@ var xWidgetGetFocusOnClick func(uintptr) bool
 // Returns whether the widget should grab focus when it is clicked
 // with the mouse.
 //
-// See [method@Gtk.Widget.set_focus_on_click].
+// See [Widget.SetFocusOnClick].
 func (x *Widget) GetFocusOnClick() bool {
 
 	cret := xWidgetGetFocusOnClick(x.GoPointer())
@@ -2459,7 +2387,7 @@ var xWidgetGetFocusable func(uintptr) bool
 
 // Determines whether the widget can own the input focus.
 //
-// See [method@Gtk.Widget.set_focusable].
+// See [Widget.SetFocusable].
 func (x *Widget) GetFocusable() bool {
 
 	cret := xWidgetGetFocusable(x.GoPointer())
@@ -2470,7 +2398,7 @@ var xWidgetGetFontMap func(uintptr) uintptr
 
 // Gets the font map of the widget.
 //
-// See [method@Gtk.Widget.set_font_map].
+// See [Widget.SetFontMap].
 func (x *Widget) GetFontMap() *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -2485,34 +2413,23 @@ func (x *Widget) GetFontMap() *pango.FontMap {
 	return cls
 }
 
-var xWidgetGetFontOptions func(uintptr) *cairo.FontOptions
-
-// Returns the `cairo_font_options_t` of the widget.
-//
-// Seee [method@Gtk.Widget.set_font_options].
-func (x *Widget) GetFontOptions() *cairo.FontOptions {
-
-	cret := xWidgetGetFontOptions(x.GoPointer())
-	return cret
-}
-
 var xWidgetGetFrameClock func(uintptr) uintptr
 
 // Obtains the frame clock for a widget.
 //
 // The frame clock is a global “ticker” that can be used to drive
 // animations and repaints. The most common reason to get the frame
-// clock is to call [method@Gdk.FrameClock.get_frame_time], in order
+// clock is to call [gdk.FrameClock.GetFrameTime], in order
 // to get a time to use for animating. For example you might record
 // the start of the animation with an initial value from
-// [method@Gdk.FrameClock.get_frame_time], and then update the animation
-// by calling [method@Gdk.FrameClock.get_frame_time] again during each repaint.
+// [gdk.FrameClock.GetFrameTime], and then update the animation
+// by calling [gdk.FrameClock.GetFrameTime] again during each repaint.
 //
-// [method@Gdk.FrameClock.request_phase] will result in a new frame on the
+// [gdk.FrameClock.RequestPhase] will result in a new frame on the
 // clock, but won’t necessarily repaint any widgets. To repaint a widget,
-// you have to use [method@Gtk.Widget.queue_draw] which invalidates the
+// you have to use [Widget.QueueDraw] which invalidates the
 // widget (thus scheduling it to receive a draw on the next frame).
-// [method@Gtk.Widget.queue_draw] will also end up requesting a frame
+// [Widget.QueueDraw] will also end up requesting a frame
 // on the appropriate frame clock.
 //
 // A widget’s frame clock will not change while the widget is mapped.
@@ -2540,7 +2457,7 @@ var xWidgetGetHalign func(uintptr) Align
 //
 // For backwards compatibility reasons this method will never return
 // one of the baseline alignments, but instead it will convert it to
-// [enum@Gtk.Align.fill] or [enum@Gtk.Align.center].
+// [AlignFillValue] or [AlignCenterValue].
 //
 // Baselines are not supported for horizontal alignment.
 func (x *Widget) GetHalign() Align {
@@ -2564,12 +2481,12 @@ var xWidgetGetHeight func(uintptr) int
 //
 // This function returns the height passed to its
 // size-allocate implementation, which is the height you
-// should be using in [vfunc@Gtk.Widget.snapshot].
+// should be using in Widget.snapshot.
 //
-// For pointer events, see [method@Gtk.Widget.contains].
+// For pointer events, see [Widget.Contains].
 //
 // To learn more about widget sizes, see the coordinate
-// system [overview](coordinates.html).
+// system overview.
 func (x *Widget) GetHeight() int {
 
 	cret := xWidgetGetHeight(x.GoPointer())
@@ -2585,7 +2502,7 @@ var xWidgetGetHexpand func(uintptr) bool
 // receive the extra space. For example, a list or scrollable area
 // or document in your window would often be set to expand.
 //
-// Widgets with children should use [method@Gtk.Widget.compute_expand]
+// Widgets with children should use [Widget.ComputeExpand]
 // rather than this function, to see whether any of its children,
 // has the expand flag set. If any child of a widget wants to
 // expand, the parent may ask to expand also.
@@ -2603,7 +2520,7 @@ var xWidgetGetHexpandSet func(uintptr) bool
 
 // Gets whether the `hexpand` flag has been explicitly set.
 //
-// If [property@Gtk.Widget:hexpand] property is set, then it
+// If [Widget.GetPropertyHexpand] property is set, then it
 // overrides any computed expand value based on child widgets.
 // If `hexpand` is not set, then the expand value depends on
 // whether any children of the widget would like to expand.
@@ -2639,7 +2556,7 @@ var xWidgetGetLayoutManager func(uintptr) uintptr
 
 // Retrieves the layout manager of the widget.
 //
-// See [method@Gtk.Widget.set_layout_manager].
+// See [Widget.SetLayoutManager].
 func (x *Widget) GetLayoutManager() *LayoutManager {
 	var cls *LayoutManager
 
@@ -2656,7 +2573,7 @@ func (x *Widget) GetLayoutManager() *LayoutManager {
 
 var xWidgetGetLimitEvents func(uintptr) bool
 
-// Gets the value of the [property@Gtk.Widget:limit-events] property.
+// Gets the value of the [Widget.GetPropertyLimitEvents] property.
 func (x *Widget) GetLimitEvents() bool {
 
 	cret := xWidgetGetLimitEvents(x.GoPointer())
@@ -2712,7 +2629,7 @@ var xWidgetGetName func(uintptr) string
 
 // Retrieves the name of a widget.
 //
-// See [method@Gtk.Widget.set_name] for the significance of widget names.
+// See [Widget.SetName] for the significance of widget names.
 func (x *Widget) GetName() string {
 
 	cret := xWidgetGetName(x.GoPointer())
@@ -2764,7 +2681,7 @@ var xWidgetGetOpacity func(uintptr) float64
 
 // Fetches the requested opacity for the widget.
 //
-// See [method@Gtk.Widget.set_opacity].
+// See [Widget.SetOpacity].
 func (x *Widget) GetOpacity() float64 {
 
 	cret := xWidgetGetOpacity(x.GoPointer())
@@ -2787,12 +2704,12 @@ var xWidgetGetPangoContext func(uintptr) uintptr
 // The `PangoContext` will have the appropriate font map, font description,
 // and base direction set.
 //
-// Unlike the context returned by [method@Gtk.Widget.create_pango_context],
+// Unlike the context returned by [Widget.CreatePangoContext],
 // this context is owned by the widget (it can be used until the screen
 // for the widget changes or the widget is removed from its toplevel),
 // and will be updated to match any changes to the widget’s attributes.
 // This can be tracked by listening to changes of the
-// [property@Gtk.Widget:root] property on the widget.
+// Widget:root property on the widget.
 func (x *Widget) GetPangoContext() *pango.Context {
 	var cls *pango.Context
 
@@ -2839,7 +2756,7 @@ var xWidgetGetPreferredSize func(uintptr, *Requisition, *Requisition)
 // the required height for the natural width is generally smaller than the
 // required height for the minimum width.
 //
-// Use [method@Gtk.Widget.measure] if you want to support baseline alignment.
+// Use [Widget.Measure] if you want to support baseline alignment.
 func (x *Widget) GetPreferredSize(MinimumSizeVar *Requisition, NaturalSizeVar *Requisition) {
 
 	xWidgetGetPreferredSize(x.GoPointer(), MinimumSizeVar, NaturalSizeVar)
@@ -2870,9 +2787,9 @@ var xWidgetGetPrimaryClipboard func(uintptr) uintptr
 // Gets the primary clipboard of the widget.
 //
 // This is a utility function to get the primary clipboard object
-// for the display that @widget is using.
+// for the display that widget is using.
 //
-// Note that this function always works, even when @widget is not
+// Note that this function always works, even when widget is not
 // realized yet.
 func (x *Widget) GetPrimaryClipboard() *gdk.Clipboard {
 	var cls *gdk.Clipboard
@@ -2903,7 +2820,7 @@ var xWidgetGetReceivesDefault func(uintptr) bool
 // within its toplevel when it has the focus, even if another widget
 // is the default.
 //
-// See [method@Gtk.Widget.set_receives_default].
+// See [Widget.SetReceivesDefault].
 func (x *Widget) GetReceivesDefault() bool {
 
 	cret := xWidgetGetReceivesDefault(x.GoPointer())
@@ -2955,12 +2872,12 @@ var xWidgetGetScaleFactor func(uintptr) int
 // On traditional systems this is 1, on high density outputs,
 // it can be a higher value (typically 2).
 //
-// See [method@Gdk.Surface.get_scale_factor].
+// See [gdk.Surface.GetScaleFactor].
 //
 // Note that modern systems may support *fractional* scaling,
 // where the scale factor is not an integer. On such systems,
 // this function will return the next higher integer value,
-// but you probably want to use [method@Gdk.Surface.get_scale]
+// but you probably want to use [gdk.Surface.GetScale]
 // to get the fractional scale value.
 func (x *Widget) GetScaleFactor() int {
 
@@ -2973,11 +2890,11 @@ var xWidgetGetSensitive func(uintptr) bool
 // Returns the widget’s sensitivity.
 //
 // This function returns the value that has been set using
-// [method@Gtk.Widget.set_sensitive]).
+// [Widget.SetSensitive]).
 //
 // The effective sensitivity of a widget is however determined
 // by both its own and its parent widget’s sensitivity.
-// See [method@Gtk.Widget.is_sensitive].
+// See [Widget.IsSensitive].
 func (x *Widget) GetSensitive() bool {
 
 	cret := xWidgetGetSensitive(x.GoPointer())
@@ -3010,16 +2927,16 @@ var xWidgetGetSize func(uintptr, Orientation) int
 
 // Returns the content width or height of the widget.
 //
-// Which dimension is returned depends on @orientation.
+// Which dimension is returned depends on orientation.
 //
-// This is equivalent to calling [method@Gtk.Widget.get_width]
-// for [enum@Gtk.Orientation.horizontal] or [method@Gtk.Widget.get_height]
-// for [enum@Gtk.Orientation.vertical], but can be used when
+// This is equivalent to calling [Widget.GetWidth]
+// for [OrientationHorizontalValue] or [Widget.GetHeight]
+// for [OrientationVerticalValue], but can be used when
 // writing orientation-independent code, such as when
-// implementing [iface@Gtk.Orientable] widgets.
+// implementing [Orientable] widgets.
 //
 // To learn more about widget sizes, see the coordinate
-// system [overview](coordinates.html).
+// system overview.
 func (x *Widget) GetSize(OrientationVar Orientation) int {
 
 	cret := xWidgetGetSize(x.GoPointer(), OrientationVar)
@@ -3030,14 +2947,14 @@ var xWidgetGetSizeRequest func(uintptr, *int, *int)
 
 // Gets the size request that was explicitly set for the widget.
 //
-// A value of -1 stored in @width or @height indicates that that
+// A value of -1 stored in width or height indicates that that
 // dimension has not been set explicitly and the natural requisition
 // of the widget will be used instead.
 //
-// See [method@Gtk.Widget.set_size_request].
+// See [Widget.SetSizeRequest].
 //
 // To get the size a widget will actually request, call
-// [method@Gtk.Widget.measure] instead of this function.
+// [Widget.Measure] instead of this function.
 func (x *Widget) GetSizeRequest(WidthVar *int, HeightVar *int) {
 
 	xWidgetGetSizeRequest(x.GoPointer(), WidthVar, HeightVar)
@@ -3048,50 +2965,30 @@ var xWidgetGetStateFlags func(uintptr) StateFlags
 
 // Returns the widget state as a flag set.
 //
-// It is worth mentioning that the effective [flags@Gtk.StateFlags.insensitive]
+// It is worth mentioning that the effective [StateFlagInsensitiveValue]
 // state will be returned, that is, also based on parent insensitivity,
-// even if @widget itself is sensitive.
+// even if widget itself is sensitive.
 //
 // Also note that if you are looking for a way to obtain the
-// [flags@Gtk.StateFlags] to pass to a [class@Gtk.StyleContext]
-// method, you should look at [method@Gtk.StyleContext.get_state].
+// [StateFlags] to pass to a [StyleContext]
+// method, you should look at [StyleContext.GetState].
 func (x *Widget) GetStateFlags() StateFlags {
 
 	cret := xWidgetGetStateFlags(x.GoPointer())
 	return cret
 }
 
-var xWidgetGetStyleContext func(uintptr) uintptr
-
-// Returns the style context associated to the widget.
-//
-// The returned object is guaranteed to be the same
-// for the lifetime of @widget.
-func (x *Widget) GetStyleContext() *StyleContext {
-	var cls *StyleContext
-
-	cret := xWidgetGetStyleContext(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	gobject.IncreaseRef(cret)
-	cls = &StyleContext{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xWidgetGetTemplateChild func(uintptr, types.GType, string) uintptr
 
-// Fetches an object build from the template XML for @widget_type in
+// Fetches an object build from the template XML for widget_type in
 // the widget.
 //
 // This will only report children which were previously declared
-// with [method@Gtk.WidgetClass.bind_template_child_full] or one of its
+// with [WidgetClass.BindTemplateChildFull] or one of its
 // variants.
 //
 // This function is only meant to be called for code which is private
-// to the @widget_type which declared the child and is meant for language
+// to the widget_type which declared the child and is meant for language
 // bindings which cannot easily make use of the GObject structure offsets.
 func (x *Widget) GetTemplateChild(WidgetTypeVar types.GType, NameVar string) *gobject.Object {
 	var cls *gobject.Object
@@ -3112,7 +3009,7 @@ var xWidgetGetTooltipMarkup func(uintptr) string
 // Gets the contents of the tooltip for the widget.
 //
 // If the tooltip has not been set using
-// [method@Gtk.Widget.set_tooltip_markup], this
+// [Widget.SetTooltipMarkup], this
 // function returns `NULL`.
 func (x *Widget) GetTooltipMarkup() string {
 
@@ -3124,8 +3021,8 @@ var xWidgetGetTooltipText func(uintptr) string
 
 // Gets the contents of the tooltip for the widget.
 //
-// If the @widget's tooltip was set using
-// [method@Gtk.Widget.set_tooltip_markup],
+// If the widget's tooltip was set using
+// [Widget.SetTooltipMarkup],
 // this function will return the escaped text.
 func (x *Widget) GetTooltipText() string {
 
@@ -3147,7 +3044,7 @@ var xWidgetGetVexpand func(uintptr) bool
 // Gets whether the widget would like any available extra vertical
 // space.
 //
-// See [method@Gtk.Widget.get_hexpand] for more detail.
+// See [Widget.GetHexpand] for more detail.
 func (x *Widget) GetVexpand() bool {
 
 	cret := xWidgetGetVexpand(x.GoPointer())
@@ -3158,7 +3055,7 @@ var xWidgetGetVexpandSet func(uintptr) bool
 
 // Gets whether the `vexpand` flag has been explicitly set.
 //
-// See [method@Gtk.Widget.get_hexpand_set] for more detail.
+// See [Widget.GetHexpandSet] for more detail.
 func (x *Widget) GetVexpandSet() bool {
 
 	cret := xWidgetGetVexpandSet(x.GoPointer())
@@ -3171,12 +3068,12 @@ var xWidgetGetVisible func(uintptr) bool
 //
 // If you want to take into account whether the widget’s
 // parent is also marked as visible, use
-// [method@Gtk.Widget.is_visible] instead.
+// [Widget.IsVisible] instead.
 //
 // This function does not check if the widget is
 // obscured in any way.
 //
-// See [method@Gtk.Widget.set_visible].
+// See [Widget.SetVisible].
 func (x *Widget) GetVisible() bool {
 
 	cret := xWidgetGetVisible(x.GoPointer())
@@ -3189,12 +3086,12 @@ var xWidgetGetWidth func(uintptr) int
 //
 // This function returns the width passed to its
 // size-allocate implementation, which is the width you
-// should be using in [vfunc@Gtk.Widget.snapshot].
+// should be using in Widget.snapshot.
 //
-// For pointer events, see [method@Gtk.Widget.contains].
+// For pointer events, see [Widget.Contains].
 //
 // To learn more about widget sizes, see the coordinate
-// system [overview](coordinates.html).
+// system overview.
 func (x *Widget) GetWidth() int {
 
 	cret := xWidgetGetWidth(x.GoPointer())
@@ -3203,14 +3100,14 @@ func (x *Widget) GetWidth() int {
 
 var xWidgetGrabFocus func(uintptr) bool
 
-// Causes @widget to have the keyboard focus for the window
+// Causes widget to have the keyboard focus for the window
 // that it belongs to.
 //
-// If @widget is not focusable, or its [vfunc@Gtk.Widget.grab_focus]
-// implementation cannot transfer the focus to a descendant of @widget
+// If widget is not focusable, or its Widget.grab_focus
+// implementation cannot transfer the focus to a descendant of widget
 // that is focusable, it will not take focus and false will be returned.
 //
-// Calling [method@Gtk.Widget.grab_focus] on an already focused widget
+// Calling [Widget.GrabFocus] on an already focused widget
 // is allowed, should not have an effect, and return true.
 func (x *Widget) GrabFocus() bool {
 
@@ -3241,7 +3138,7 @@ var xWidgetHasFocus func(uintptr) bool
 
 // Determines if the widget has the global input focus.
 //
-// See [method@Gtk.Widget.is_focus] for the difference between
+// See [Widget.IsFocus] for the difference between
 // having the global input focus, and only having the focus
 // within a toplevel.
 func (x *Widget) HasFocus() bool {
@@ -3257,28 +3154,17 @@ var xWidgetHasVisibleFocus func(uintptr) bool
 //
 // This is a convenience function that takes into account whether
 // focus indication should currently be shown in the toplevel window
-// of @widget. See [method@Gtk.Window.get_focus_visible] for more
+// of widget. See [Window.GetFocusVisible] for more
 // information about focus indication.
 //
 // To find out if the widget has the global input focus, use
-// [method@Gtk.Widget.has_focus].
+// [Widget.HasFocus].
 func (x *Widget) HasVisibleFocus() bool {
 
 	cret := xWidgetHasVisibleFocus(x.GoPointer())
 	return cret
 }
 
-var xWidgetHide func(uintptr)
-
-// Reverses the effects of [method.Gtk.Widget.show].
-//
-// This is causing the widget to be hidden (invisible to the user).
-func (x *Widget) Hide() {
-
-	xWidgetHide(x.GoPointer())
-
-}
-
 var xWidgetInDestruction func(uintptr) bool
 
 // Returns whether the widget is currently being destroyed.
@@ -3297,7 +3183,7 @@ var xWidgetInitTemplate func(uintptr)
 //
 // This function must be called in the instance initializer
 // for any class which assigned itself a template using
-// [method@Gtk.WidgetClass.set_template].
+// [WidgetClass.SetTemplate].
 //
 // It is important to call this function in the instance initializer
 // of a widget subclass and not in `GObject.constructed()` or
@@ -3306,9 +3192,9 @@ var xWidgetInitTemplate func(uintptr)
 //   - derived widgets will assume that the composite widgets
 //     defined by its parent classes have been created in their
 //     relative instance initializers
-//   - when calling `g_object_new()` on a widget with composite templates,
+//   - when calling `[gobject.NewObject]` on a widget with composite templates,
 //     it’s important to build the composite widgets before the construct
-//     properties are set. Properties passed to `g_object_new()` should
+//     properties are set. Properties passed to `[gobject.NewObject]` should
 //     take precedence over properties set in the private template XML
 //
 // A good rule of thumb is to call this function as the first thing in
@@ -3323,17 +3209,17 @@ var xWidgetInsertActionGroup func(uintptr, string, uintptr)
 
 // Inserts an action group into the widget's actions.
 //
-// Children of @widget that implement [iface@Gtk.Actionable] can
-// then be associated with actions in @group by setting their
-// “action-name” to @prefix.`action-name`.
+// Children of widget that implement [Actionable] can
+// then be associated with actions in group by setting their
+// “action-name” to prefix.`action-name`.
 //
 // Note that inheritance is defined for individual actions. I.e.
-// even if you insert a group with prefix @prefix, actions with
+// even if you insert a group with prefix prefix, actions with
 // the same prefix will still be inherited from the parent, unless
 // the group contains an action with the same name.
 //
-// If @group is `NULL`, a previously inserted group for @name is
-// removed from @widget.
+// If group is `NULL`, a previously inserted group for name is
+// removed from widget.
 func (x *Widget) InsertActionGroup(NameVar string, GroupVar gio.ActionGroup) {
 
 	xWidgetInsertActionGroup(x.GoPointer(), NameVar, GroupVar.GoPointer())
@@ -3344,19 +3230,19 @@ var xWidgetInsertAfter func(uintptr, uintptr, uintptr)
 
 // Sets the parent widget of the widget.
 //
-// In contrast to [method@Gtk.Widget.set_parent], this function
-// inserts @widget at a specific position into the list of children
-// of the @parent widget.
+// In contrast to [Widget.SetParent], this function
+// inserts widget at a specific position into the list of children
+// of the parent widget.
 //
-// It will be placed after @previous_sibling, or at the beginning if
-// @previous_sibling is `NULL`.
+// It will be placed after previous_sibling, or at the beginning if
+// previous_sibling is `NULL`.
 //
 // After calling this function, `gtk_widget_get_prev_sibling (widget)`
-// will return @previous_sibling.
+// will return previous_sibling.
 //
-// If @parent is already set as the parent widget of @widget, this
-// function can also be used to reorder @widget in the child widget
-// list of @parent.
+// If parent is already set as the parent widget of widget, this
+// function can also be used to reorder widget in the child widget
+// list of parent.
 //
 // This function is primarily meant for widget implementations; if you are
 // just using a widget, you *must* use its own API for adding children.
@@ -3370,18 +3256,18 @@ var xWidgetInsertBefore func(uintptr, uintptr, uintptr)
 
 // Sets the parent widget of the widget.
 //
-// In contrast to [method@Gtk.Widget.set_parent], this function
-// inserts @widget at a specific position into the list of children
-// of the @parent widget.
+// In contrast to [Widget.SetParent], this function
+// inserts widget at a specific position into the list of children
+// of the parent widget.
 //
-// It will be placed before @next_sibling, or at the end if
-// @next_sibling is `NULL`.
+// It will be placed before next_sibling, or at the end if
+// next_sibling is `NULL`.
 //
 // After calling this function, `gtk_widget_get_next_sibling (widget)`
-// will return @next_sibling.
+// will return next_sibling.
 //
-// If @parent is already set as the parent widget of @widget, this function
-// can also be used to reorder @widget in the child widget list of @parent.
+// If parent is already set as the parent widget of widget, this function
+// can also be used to reorder widget in the child widget list of parent.
 //
 // This function is primarily meant for widget implementations; if you are
 // just using a widget, you *must* use its own API for adding children.
@@ -3393,7 +3279,7 @@ func (x *Widget) InsertBefore(ParentVar *Widget, NextSiblingVar *Widget) {
 
 var xWidgetIsAncestor func(uintptr, uintptr) bool
 
-// Determines whether the widget is a descendent of @ancestor.
+// Determines whether the widget is a descendent of ancestor.
 func (x *Widget) IsAncestor(AncestorVar *Widget) bool {
 
 	cret := xWidgetIsAncestor(x.GoPointer(), AncestorVar.GoPointer())
@@ -3416,8 +3302,8 @@ var xWidgetIsFocus func(uintptr) bool
 // Determines if the widget is the focus widget within its
 // toplevel.
 //
-// This does not mean that the [property@Gtk.Widget:has-focus]
-// property is necessarily set; [property@Gtk.Widget:has-focus]
+// This does not mean that the [Widget.GetPropertyHasFocus]
+// property is necessarily set; [Widget.GetPropertyHasFocus]
 // will only be set if the toplevel widget additionally has the
 // global input focus.
 func (x *Widget) IsFocus() bool {
@@ -3445,8 +3331,8 @@ var xWidgetIsVisible func(uintptr) bool
 //
 // This function does not check if the widget is obscured in any way.
 //
-// See also [method@Gtk.Widget.get_visible] and
-// [method@Gtk.Widget.set_visible].
+// See also [Widget.GetVisible] and
+// [Widget.SetVisible].
 func (x *Widget) IsVisible() bool {
 
 	cret := xWidgetIsVisible(x.GoPointer())
@@ -3455,32 +3341,32 @@ func (x *Widget) IsVisible() bool {
 
 var xWidgetKeynavFailed func(uintptr, DirectionType) bool
 
-// Emits the [signal@Gtk.Widget::keynav-failed] signal on the widget.
+// Emits the [Widget.ConnectKeynavFailedFunc] signal on the widget.
 //
 // This function should be called whenever keyboard navigation
 // within a single widget hits a boundary.
 //
 // The return value of this function should be interpreted
 // in a way similar to the return value of
-// [method@Gtk.Widget.child_focus]. When true is returned,
+// [Widget.ChildFocus]. When true is returned,
 // stay in the widget, the failed keyboard navigation is ok
 // and/or there is nowhere we can/should move the focus to.
 // When false is returned, the caller should continue with
 // keyboard navigation outside the widget, e.g. by calling
-// [method@Gtk.Widget.child_focus] on the widget’s toplevel.
+// [Widget.ChildFocus] on the widget’s toplevel.
 //
-// The default [signal@Gtk.Widget::keynav-failed] handler returns
-// false for [enum@Gtk.DirectionType.tab-forward] and
-// [enum@Gtk.DirectionType.tab-backward]. For the other values
-// of [enum@Gtk.DirectionType] it returns true.
+// The default [Widget.ConnectKeynavFailedFunc] handler returns
+// false for DirectionType.tab-forward and
+// DirectionType.tab-backward. For the other values
+// of [DirectionType] it returns true.
 //
 // Whenever the default handler returns true, it also calls
-// [method@Gtk.Widget.error_bell] to notify the user of the
+// [Widget.ErrorBell] to notify the user of the
 // failed keyboard navigation.
 //
 // A use case for providing an own implementation of `::keynav-failed`
 // (either by connecting to it or by overriding it) would be a row of
-// [class@Gtk.Entry] widgets where the user should be able to navigate
+// [Entry] widgets where the user should be able to navigate
 // the entire row with the cursor keys, as e.g. known from user
 // interfaces that require entering license keys.
 func (x *Widget) KeynavFailed(DirectionVar DirectionType) bool {
@@ -3495,7 +3381,7 @@ var xWidgetListMnemonicLabels func(uintptr) *glib.List
 // mnemonic.
 //
 // Typically, these widgets will be labels. See, for example,
-// [method@Gtk.Label.set_mnemonic_widget].
+// [Label.SetMnemonicWidget].
 //
 // The widgets in the list are not individually referenced.
 // If you want to iterate through the list and perform actions
@@ -3521,13 +3407,13 @@ func (x *Widget) Map() {
 
 var xWidgetMeasure func(uintptr, Orientation, int, *int, *int, *int, *int)
 
-// Measures @widget in the orientation @orientation and for the given @for_size.
+// Measures widget in the orientation orientation and for the given for_size.
 //
-// As an example, if @orientation is %GTK_ORIENTATION_HORIZONTAL and @for_size
-// is 300, this functions will compute the minimum and natural width of @widget
+// As an example, if orientation is [OrientationHorizontalValue] and for_size
+// is 300, this functions will compute the minimum and natural width of widget
 // if it is allocated at a height of 300 pixels.
 //
-// See [GtkWidget’s geometry management section](class.Widget.html#height-for-width-geometry-management) for
+// See GtkWidget’s geometry management section for
 // a more details on implementing `GtkWidgetClass.measure()`.
 func (x *Widget) Measure(OrientationVar Orientation, ForSizeVar int, MinimumVar *int, NaturalVar *int, MinimumBaselineVar *int, NaturalBaselineVar *int) {
 
@@ -3537,7 +3423,7 @@ func (x *Widget) Measure(OrientationVar Orientation, ForSizeVar int, MinimumVar
 
 var xWidgetMnemonicActivate func(uintptr, bool) bool
 
-// Emits the [signal@Gtk.Widget::mnemonic-activate] signal.
+// Emits the [Widget.ConnectMnemonicActivateFunc] signal.
 func (x *Widget) MnemonicActivate(GroupCyclingVar bool) bool {
 
 	cret := xWidgetMnemonicActivate(x.GoPointer(), GroupCyclingVar)
@@ -3595,10 +3481,10 @@ var xWidgetPick func(uintptr, float64, float64, PickFlags) uintptr
 // Finds the descendant of the widget closest to a point.
 //
 // The point (x, y) must be given in widget coordinates, so (0, 0)
-// is assumed to be the top left of @widget's content area.
+// is assumed to be the top left of widget's content area.
 //
 // Usually widgets will return `NULL` if the given coordinate is not
-// contained in @widget checked via [method@Gtk.Widget.contains].
+// contained in widget checked via [Widget.Contains].
 // Otherwise they will recursively try to find a child that does
 // not return `NULL`. Widgets are however free to customize their
 // picking algorithm.
@@ -3622,14 +3508,14 @@ func (x *Widget) Pick(XVar float64, YVar float64, FlagsVar PickFlags) *Widget {
 
 var xWidgetQueueAllocate func(uintptr)
 
-// Flags the widget for a rerun of the [vfunc@Gtk.Widget.size_allocate]
+// Flags the widget for a rerun of the Widget.size_allocate
 // function.
 //
-// Use this function instead of [method@Gtk.Widget.queue_resize]
-// when the @widget's size request didn't change but it wants to
+// Use this function instead of [Widget.QueueResize]
+// when the widget's size request didn't change but it wants to
 // reposition its contents.
 //
-// An example user of this function is [method@Gtk.Widget.set_halign].
+// An example user of this function is [Widget.SetHalign].
 //
 // This function is only for use in widget implementations.
 func (x *Widget) QueueAllocate() {
@@ -3645,7 +3531,7 @@ var xWidgetQueueDraw func(uintptr)
 // The redraw will happen in the paint phase
 // of the current or the next frame.
 //
-// This means @widget's [vfunc@Gtk.Widget.snapshot]
+// This means widget's Widget.snapshot
 // implementation will be called.
 func (x *Widget) QueueDraw() {
 
@@ -3659,13 +3545,13 @@ var xWidgetQueueResize func(uintptr)
 //
 // This should be called when a widget for some reason has a new
 // size request. For example, when you change the text in a
-// [class@Gtk.Label], the label queues a resize to ensure there’s
+// [Label], the label queues a resize to ensure there’s
 // enough space for the new text.
 //
-// Note that you cannot call gtk_widget_queue_resize() on a widget
-// from inside its implementation of the [vfunc@Gtk.Widget.size_allocate]
-// virtual method. Calls to gtk_widget_queue_resize() from inside
-// [vfunc@Gtk.Widget.size_allocate] will be silently ignored.
+// Note that you cannot call [Widget.QueueResize] on a widget
+// from inside its implementation of the Widget.size_allocate
+// virtual method. Calls to [Widget.QueueResize] from inside
+// Widget.size_allocate will be silently ignored.
 //
 // This function is only for use in widget implementations.
 func (x *Widget) QueueResize() {
@@ -3684,14 +3570,14 @@ var xWidgetRealize func(uintptr)
 //
 // Realizing a widget requires all the widget’s parent widgets to be
 // realized; calling this function realizes the widget’s parents
-// in addition to @widget itself. If a widget is not yet inside a
+// in addition to widget itself. If a widget is not yet inside a
 // toplevel window when you realize it, bad things will happen.
 //
 // This function is primarily used in widget implementations, and
 // isn’t very useful otherwise. Many times when you think you might
 // need it, a better approach is to connect to a signal that will be
 // called after the widget is realized automatically, such as
-// [signal@Gtk.Widget::realize].
+// [Widget.ConnectRealizeFunc].
 func (x *Widget) Realize() {
 
 	xWidgetRealize(x.GoPointer())
@@ -3717,7 +3603,7 @@ var xWidgetRemoveCssClass func(uintptr, string)
 
 // Removes a style from the widget.
 //
-// After this, the style of @widget will stop matching for @css_class.
+// After this, the style of widget will stop matching for css_class.
 func (x *Widget) RemoveCssClass(CssClassVar string) {
 
 	xWidgetRemoveCssClass(x.GoPointer(), CssClassVar)
@@ -3728,10 +3614,10 @@ var xWidgetRemoveMnemonicLabel func(uintptr, uintptr)
 
 // Removes a widget from the list of mnemonic labels for this widget.
 //
-// See [method@Gtk.Widget.list_mnemonic_labels].
+// See [Widget.ListMnemonicLabels].
 //
 // The widget must have previously been added to the list with
-// [method@Gtk.Widget.add_mnemonic_label].
+// [Widget.AddMnemonicLabel].
 func (x *Widget) RemoveMnemonicLabel(LabelVar *Widget) {
 
 	xWidgetRemoveMnemonicLabel(x.GoPointer(), LabelVar.GoPointer())
@@ -3741,7 +3627,7 @@ func (x *Widget) RemoveMnemonicLabel(LabelVar *Widget) {
 var xWidgetRemoveTickCallback func(uintptr, uint)
 
 // Removes a tick callback previously registered with
-// [method@Gtk.Widget.add_tick_callback].
+// [Widget.AddTickCallback].
 func (x *Widget) RemoveTickCallback(IdVar uint) {
 
 	xWidgetRemoveTickCallback(x.GoPointer(), IdVar)
@@ -3753,16 +3639,16 @@ var xWidgetSetCanFocus func(uintptr, bool)
 // Sets whether the input focus can enter the widget or
 // any of its children.
 //
-// Applications should set @can_focus to false to mark a
+// Applications should set can_focus to false to mark a
 // widget as for pointer/touch use only.
 //
-// Note that having @can_focus be true is only one of the
+// Note that having can_focus be true is only one of the
 // necessary conditions for being focusable. A widget must
 // also be sensitive and focusable and not have an ancestor
 // that is marked as not can-focus in order to receive input
 // focus.
 //
-// See [method@Gtk.Widget.grab_focus] for actually setting
+// See [Widget.GrabFocus] for actually setting
 // the input focus on a widget.
 func (x *Widget) SetCanFocus(CanFocusVar bool) {
 
@@ -3784,7 +3670,7 @@ var xWidgetSetChildVisible func(uintptr, bool)
 // Sets whether the widget should be mapped along with its parent.
 //
 // The child visibility can be set for widget before it is added
-// to a container with [method@Gtk.Widget.set_parent], to avoid
+// to a container with [Widget.SetParent], to avoid
 // mapping children unnecessary before immediately unmapping them.
 // However it will be reset to its default state of true when the
 // widget is removed from a container.
@@ -3805,7 +3691,7 @@ func (x *Widget) SetChildVisible(ChildVisibleVar bool) {
 
 var xWidgetSetCssClasses func(uintptr, []string)
 
-// Replaces the current style classes of the widget with @classes.
+// Replaces the current style classes of the widget with classes.
 func (x *Widget) SetCssClasses(ClassesVar []string) {
 
 	xWidgetSetCssClasses(x.GoPointer(), ClassesVar)
@@ -3817,7 +3703,7 @@ var xWidgetSetCursor func(uintptr, uintptr)
 // Sets the cursor to be shown when the pointer hovers over
 // the widget.
 //
-// If the @cursor is `NULL`, @widget will use the cursor
+// If the cursor is `NULL`, widget will use the cursor
 // inherited from its parent.
 func (x *Widget) SetCursor(CursorVar *gdk.Cursor) {
 
@@ -3831,12 +3717,12 @@ var xWidgetSetCursorFromName func(uintptr, uintptr)
 // the widget.
 //
 // This is a utility function that creates a cursor via
-// [ctor@Gdk.Cursor.new_from_name] and then sets it on @widget
-// with [method@Gtk.Widget.set_cursor]. See those functions for
+// [gdk.NewCursorFromName] and then sets it on widget
+// with [Widget.SetCursor]. See those functions for
 // details.
 //
-// On top of that, this function allows @name to be `NULL`, which
-// will do the same as calling [method@Gtk.Widget.set_cursor]
+// On top of that, this function allows name to be `NULL`, which
+// will do the same as calling [Widget.SetCursor]
 // with a `NULL` cursor.
 func (x *Widget) SetCursorFromName(NameVar *string) {
 
@@ -3862,8 +3748,8 @@ var xWidgetSetDirection func(uintptr, TextDirection)
 // an order that is explicitly visual rather than logical (such as
 // buttons for text justification).
 //
-// If the direction is set to [enum@Gtk.TextDirection.none], then
-// the value set by [func@Gtk.Widget.set_default_direction] will be used.
+// If the direction is set to [TextDirNoneValue], then
+// the value set by [WidgetSetDefaultDirection] will be used.
 func (x *Widget) SetDirection(DirVar TextDirection) {
 
 	xWidgetSetDirection(x.GoPointer(), DirVar)
@@ -3876,7 +3762,7 @@ var xWidgetSetFocusChild func(uintptr, uintptr)
 //
 // This function is only suitable for widget implementations.
 // If you want a certain widget to get the input focus, call
-// [method@Gtk.Widget.grab_focus] on it.
+// [Widget.GrabFocus] on it.
 func (x *Widget) SetFocusChild(ChildVar *Widget) {
 
 	xWidgetSetFocusChild(x.GoPointer(), ChildVar.GoPointer())
@@ -3901,16 +3787,16 @@ var xWidgetSetFocusable func(uintptr, bool)
 
 // Sets whether the widget can own the input focus.
 //
-// Widget implementations should set @focusable to true in
+// Widget implementations should set focusable to true in
 // their init() function if they want to receive keyboard input.
 //
-// Note that having @focusable be true is only one of the
+// Note that having focusable be true is only one of the
 // necessary conditions for being focusable. A widget must
 // also be sensitive and can-focus and not have an ancestor
 // that is marked as not can-focus in order to receive input
 // focus.
 //
-// See [method@Gtk.Widget.grab_focus] for actually setting
+// See [Widget.GrabFocus] for actually setting
 // the input focus on a widget.
 func (x *Widget) SetFocusable(FocusableVar bool) {
 
@@ -3934,19 +3820,6 @@ func (x *Widget) SetFontMap(FontMapVar *pango.FontMap) {
 
 }
 
-var xWidgetSetFontOptions func(uintptr, *cairo.FontOptions)
-
-// Sets the `cairo_font_options_t` used for text rendering
-// in the widget.
-//
-// When not set, the default font options for the `GdkDisplay`
-// will be used.
-func (x *Widget) SetFontOptions(OptionsVar *cairo.FontOptions) {
-
-	xWidgetSetFontOptions(x.GoPointer(), OptionsVar)
-
-}
-
 var xWidgetSetHalign func(uintptr, Align)
 
 // Sets the horizontal alignment of the widget.
@@ -3980,7 +3853,7 @@ var xWidgetSetHexpand func(uintptr, bool)
 //
 // By default, widgets automatically expand if any of their children
 // want to expand. (To see if a widget will automatically expand given
-// its current children and state, call [method@Gtk.Widget.compute_expand].
+// its current children and state, call [Widget.ComputeExpand].
 // A widget can decide how the expandability of children affects its
 // own expansion by overriding the `compute_expand` virtual method on
 // `GtkWidget`.).
@@ -3990,8 +3863,8 @@ var xWidgetSetHexpand func(uintptr, bool)
 //
 // This function forces the widget to expand or not to expand,
 // regardless of children. The override occurs because
-// [method@Gtk.Widget.set_hexpand] sets the hexpand-set property (see
-// [method@Gtk.Widget.set_hexpand_set]) which causes the widget’s hexpand
+// [Widget.SetHexpand] sets the hexpand-set property (see
+// [Widget.SetHexpandSet]) which causes the widget’s hexpand
 // value to be used, rather than looking at children and widget state.
 func (x *Widget) SetHexpand(ExpandVar bool) {
 
@@ -4003,8 +3876,8 @@ var xWidgetSetHexpandSet func(uintptr, bool)
 
 // Sets whether the hexpand flag will be used.
 //
-// The [property@Gtk.Widget:hexpand-set] property will be set
-// automatically when you call [method@Gtk.Widget.set_hexpand]
+// The [Widget.GetPropertyHexpandSet] property will be set
+// automatically when you call [Widget.SetHexpand]
 // to set hexpand, so the most likely reason to use this function
 // would be to unset an explicit expand flag.
 //
@@ -4084,10 +3957,10 @@ var xWidgetSetName func(uintptr, string)
 // Setting a name allows you to refer to the widget from a
 // CSS file. You can apply a style to widgets with a particular name
 // in the CSS file. See the documentation for the CSS syntax (on the
-// same page as the docs for [class@Gtk.StyleContext].
+// same page as the docs for [StyleContext].
 //
 // Note that the CSS syntax has certain special characters to delimit
-// and represent elements in a selector (period, #, &gt;, *...), so using
+// and represent elements in a selector (period, #, >, *...), so using
 // these will make your widget impossible to match by name. Any combination
 // of alphanumeric symbols, dashes and underscores will suffice.
 func (x *Widget) SetName(NameVar string) {
@@ -4107,7 +3980,7 @@ var xWidgetSetOpacity func(uintptr, float64)
 // there are some limitations: For toplevel widgets, applying opacity
 // depends on the capabilities of the windowing system. On X11, this
 // has any effect only on X displays with a compositing manager, see
-// [method@Gdk.Display.is_composited]. On Windows and Wayland it will
+// [gdk.Display.IsComposited]. On Windows and Wayland it will
 // always work, although setting a window’s opacity after the window
 // has been shown may cause some flicker.
 //
@@ -4116,8 +3989,8 @@ var xWidgetSetOpacity func(uintptr, float64)
 // appear translucent, since it is ultimatively rendered on that
 // toplevel. The opacity value itself is not inherited by child
 // widgets (since that would make widgets deeper in the hierarchy
-// progressively more translucent). As a consequence, [class@Gtk.Popover]
-// instances and other [iface@Gtk.Native] widgets with their own surface
+// progressively more translucent). As a consequence, [Popover]
+// instances and other [Native] widgets with their own surface
 // will use their own opacity value, and thus by default appear
 // non-translucent, even if they are attached to a toplevel that
 // is translucent.
@@ -4132,12 +4005,12 @@ var xWidgetSetOverflow func(uintptr, Overflow)
 // Sets how the widget treats content that is drawn outside the
 // it's content area.
 //
-// See the definition of [enum@Gtk.Overflow] for details.
+// See the definition of [Overflow] for details.
 //
 // This setting is provided for widget implementations and
 // should not be used by application code.
 //
-// The default value is [enum@Gtk.Overflow.visible].
+// The default value is [OverflowVisibleValue].
 func (x *Widget) SetOverflow(OverflowVar Overflow) {
 
 	xWidgetSetOverflow(x.GoPointer(), OverflowVar)
@@ -4150,7 +4023,7 @@ var xWidgetSetParent func(uintptr, uintptr)
 //
 // This takes care of details such as updating the state and style
 // of the child to reflect its new location and resizing the parent.
-// The opposite function is [method@Gtk.Widget.unparent].
+// The opposite function is [Widget.Unparent].
 //
 // This function is useful only when implementing subclasses of
 // `GtkWidget`.
@@ -4189,11 +4062,11 @@ var xWidgetSetSizeRequest func(uintptr, int, int)
 
 // Sets the minimum size of the widget.
 //
-// That is, the widget’s size request will be at least @width
-// by @height. You can use this function to force a widget to
+// That is, the widget’s size request will be at least width
+// by height. You can use this function to force a widget to
 // be larger than it normally would be.
 //
-// In most cases, [method@Gtk.Window.set_default_size] is a better
+// In most cases, [Window.SetDefaultSize] is a better
 // choice for toplevel windows than this function; setting the default
 // size will still allow users to shrink the window. Setting the size
 // request will force them to leave the window at least as large as
@@ -4215,10 +4088,10 @@ var xWidgetSetSizeRequest func(uintptr, int, int)
 //
 // The size request set here does not include any margin from the
 // properties
-// [property@Gtk.Widget:margin-start],
-// [property@Gtk.Widget:margin-end],
-// [property@Gtk.Widget:margin-top], and
-// [property@Gtk.Widget:margin-bottom], but it does include pretty
+// [Widget.GetPropertyMarginStart],
+// [Widget.GetPropertyMarginEnd],
+// [Widget.GetPropertyMarginTop], and
+// [Widget.GetPropertyMarginBottom], but it does include pretty
 // much all other padding or border properties set by any subclass
 // of `GtkWidget`.
 func (x *Widget) SetSizeRequest(WidthVar int, HeightVar int) {
@@ -4233,9 +4106,9 @@ var xWidgetSetStateFlags func(uintptr, StateFlags, bool)
 //
 // Typical widget states are insensitive, prelighted, etc.
 //
-// This function accepts the values [flags@Gtk.StateFlags.dir-ltr] and
-// [flags@Gtk.StateFlags.dir-rtl] but ignores them. If you want to set
-// the widget's direction, use [method@Gtk.Widget.set_direction].
+// This function accepts the values StateFlags.dir-ltr and
+// StateFlags.dir-rtl but ignores them. If you want to set
+// the widget's direction, use [Widget.SetDirection].
 //
 // This function is for use in widget implementations.
 func (x *Widget) SetStateFlags(FlagsVar StateFlags, ClearVar bool) {
@@ -4248,13 +4121,13 @@ var xWidgetSetTooltipMarkup func(uintptr, uintptr)
 
 // Sets the contents of the tooltip for widget.
 //
-// @markup must contain Pango markup.
+// markup must contain Pango markup.
 //
 // This function will take care of setting the
-// [property@Gtk.Widget:has-tooltip] as a side effect, and of the
-// default handler for the [signal@Gtk.Widget::query-tooltip] signal.
+// [Widget.GetPropertyHasTooltip] as a side effect, and of the
+// default handler for the [Widget.ConnectQueryTooltipFunc] signal.
 //
-// See also [method@Gtk.Tooltip.set_markup].
+// See also [Tooltip.SetMarkup].
 func (x *Widget) SetTooltipMarkup(MarkupVar *string) {
 
 	MarkupVarPtr := core.GStrdupNullable(MarkupVar)
@@ -4268,14 +4141,14 @@ var xWidgetSetTooltipText func(uintptr, uintptr)
 
 // Sets the contents of the tooltip for the widget.
 //
-// If @text contains any markup, it will be escaped.
+// If text contains any markup, it will be escaped.
 //
 // This function will take care of setting
-// [property@Gtk.Widget:has-tooltip] as a side effect,
+// [Widget.GetPropertyHasTooltip] as a side effect,
 // and of the default handler for the
-// [signal@Gtk.Widget::query-tooltip] signal.
+// [Widget.ConnectQueryTooltipFunc] signal.
 //
-// See also [method@Gtk.Tooltip.set_text].
+// See also [Tooltip.SetText].
 func (x *Widget) SetTooltipText(TextVar *string) {
 
 	TextVarPtr := core.GStrdupNullable(TextVar)
@@ -4299,7 +4172,7 @@ var xWidgetSetVexpand func(uintptr, bool)
 // Sets whether the widget would like any available extra vertical
 // space.
 //
-// See [method@Gtk.Widget.set_hexpand] for more detail.
+// See [Widget.SetHexpand] for more detail.
 func (x *Widget) SetVexpand(ExpandVar bool) {
 
 	xWidgetSetVexpand(x.GoPointer(), ExpandVar)
@@ -4310,7 +4183,7 @@ var xWidgetSetVexpandSet func(uintptr, bool)
 
 // Sets whether the vexpand flag will be used.
 //
-// See [method@Gtk.Widget.set_hexpand_set] for more detail.
+// See [Widget.SetHexpandSet] for more detail.
 func (x *Widget) SetVexpandSet(SetVar bool) {
 
 	xWidgetSetVexpandSet(x.GoPointer(), SetVar)
@@ -4319,10 +4192,10 @@ func (x *Widget) SetVexpandSet(SetVar bool) {
 
 var xWidgetSetVisible func(uintptr, bool)
 
-// Sets the visibility state of @widget.
+// Sets the visibility state of widget.
 //
 // Note that setting this to true doesn’t mean the widget is
-// actually viewable, see [method@Gtk.Widget.get_visible].
+// actually viewable, see [Widget.GetVisible].
 func (x *Widget) SetVisible(VisibleVar bool) {
 
 	xWidgetSetVisible(x.GoPointer(), VisibleVar)
@@ -4336,37 +4209,19 @@ var xWidgetShouldLayout func(uintptr) bool
 //
 // This is false for invisible children, but also
 // for children that have their own surface, such
-// as [class@Gtk.Popover] instances.
+// as [Popover] instances.
 func (x *Widget) ShouldLayout() bool {
 
 	cret := xWidgetShouldLayout(x.GoPointer())
 	return cret
 }
 
-var xWidgetShow func(uintptr)
-
-// Flags a widget to be displayed.
-//
-// Any widget that isn’t shown will not appear on the screen.
-//
-// Remember that you have to show the containers containing a widget,
-// in addition to the widget itself, before it will appear onscreen.
-//
-// When a toplevel widget is shown, it is immediately realized and
-// mapped; other shown widgets are realized and mapped when their
-// toplevel widget is realized and mapped.
-func (x *Widget) Show() {
-
-	xWidgetShow(x.GoPointer())
-
-}
-
 var xWidgetSizeAllocate func(uintptr, *Allocation, int)
 
 // Allocates widget with a transformation that translates
-// the origin to the position in @allocation.
+// the origin to the position in allocation.
 //
-// This is a simple form of [method@Gtk.Widget.allocate].
+// This is a simple form of [Widget.Allocate].
 func (x *Widget) SizeAllocate(AllocationVar *Allocation, BaselineVar int) {
 
 	xWidgetSizeAllocate(x.GoPointer(), AllocationVar, BaselineVar)
@@ -4378,14 +4233,14 @@ var xWidgetSnapshotChild func(uintptr, uintptr, uintptr)
 // Snapshots a child of the widget.
 //
 // When a widget receives a call to the snapshot function,
-// it must send synthetic [vfunc@Gtk.Widget.snapshot] calls
+// it must send synthetic Widget.snapshot calls
 // to all children. This function provides a convenient way
 // of doing this. A widget, when it receives a call to its
-// [vfunc@Gtk.Widget.snapshot] function, calls
-// gtk_widget_snapshot_child() once for each child, passing in
-// the @snapshot the widget received.
+// Widget.snapshot function, calls
+// [Widget.SnapshotChild] once for each child, passing in
+// the snapshot the widget received.
 //
-// This function takes care of translating the origin of @snapshot,
+// This function takes care of translating the origin of snapshot,
 // and deciding whether the child needs to be snapshot.
 //
 // It does nothing for children that implement `GtkNative`.
@@ -4395,20 +4250,6 @@ func (x *Widget) SnapshotChild(ChildVar *Widget, SnapshotVar *Snapshot) {
 
 }
 
-var xWidgetTranslateCoordinates func(uintptr, uintptr, float64, float64, *float64, *float64) bool
-
-// Translates coordinates relative to @src_widget’s allocation
-// to coordinates relative to @dest_widget’s allocations.
-//
-// In order to perform this operation, both widget must share
-// a common ancestor. If that is not the case, @dest_x and @dest_y
-// are set to 0 and false is returned.
-func (x *Widget) TranslateCoordinates(DestWidgetVar *Widget, SrcXVar float64, SrcYVar float64, DestXVar *float64, DestYVar *float64) bool {
-
-	cret := xWidgetTranslateCoordinates(x.GoPointer(), DestWidgetVar.GoPointer(), SrcXVar, SrcYVar, DestXVar, DestYVar)
-	return cret
-}
-
 var xWidgetTriggerTooltipQuery func(uintptr)
 
 // Triggers a tooltip query on the display of the widget.
@@ -4431,7 +4272,7 @@ func (x *Widget) Unmap() {
 
 var xWidgetUnparent func(uintptr)
 
-// Removes @widget from its parent.
+// Removes widget from its parent.
 //
 // This function is only for use in widget implementations,
 // typically in dispose.
@@ -4458,7 +4299,7 @@ var xWidgetUnsetStateFlags func(uintptr, StateFlags)
 
 // Turns off flag values for the current widget state.
 //
-// See [method@Gtk.Widget.set_state_flags].
+// See [Widget.SetStateFlags].
 //
 // This function is for use in widget implementations.
 func (x *Widget) UnsetStateFlags(FlagsVar StateFlags) {
@@ -4615,11 +4456,11 @@ func (x *Widget) GetPropertyHasFocus() bool {
 }
 
 // SetPropertyHasTooltip sets the "has-tooltip" property.
-// Enables or disables the emission of the [signal@Gtk.Widget::query-tooltip]
-// signal on @widget.
+// Enables or disables the emission of the [Widget.ConnectQueryTooltipFunc]
+// signal on widget.
 //
-// A true value indicates that @widget can have a tooltip, in this case
-// the widget will be queried using [signal@Gtk.Widget::query-tooltip] to
+// A true value indicates that widget can have a tooltip, in this case
+// the widget will be queried using [Widget.ConnectQueryTooltipFunc] to
 // determine whether it will provide a tooltip or not.
 func (x *Widget) SetPropertyHasTooltip(value bool) {
 	var v gobject.Value
@@ -4629,11 +4470,11 @@ func (x *Widget) SetPropertyHasTooltip(value bool) {
 }
 
 // GetPropertyHasTooltip gets the "has-tooltip" property.
-// Enables or disables the emission of the [signal@Gtk.Widget::query-tooltip]
-// signal on @widget.
+// Enables or disables the emission of the [Widget.ConnectQueryTooltipFunc]
+// signal on widget.
 //
-// A true value indicates that @widget can have a tooltip, in this case
-// the widget will be queried using [signal@Gtk.Widget::query-tooltip] to
+// A true value indicates that widget can have a tooltip, in this case
+// the widget will be queried using [Widget.ConnectQueryTooltipFunc] to
 // determine whether it will provide a tooltip or not.
 func (x *Widget) GetPropertyHasTooltip() bool {
 	var v gobject.Value
@@ -4702,7 +4543,7 @@ func (x *Widget) GetPropertyHexpandSet() bool {
 //
 // Global event controllers will not handle events with targets
 // inside the widget, unless they are set up to ignore propagation
-// limits. See [method@Gtk.EventController.set_propagation_limit].
+// limits. See [EventController.SetPropagationLimit].
 func (x *Widget) SetPropertyLimitEvents(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -4716,7 +4557,7 @@ func (x *Widget) SetPropertyLimitEvents(value bool) {
 //
 // Global event controllers will not handle events with targets
 // inside the widget, unless they are set up to ignore propagation
-// limits. See [method@Gtk.EventController.set_propagation_limit].
+// limits. See [EventController.SetPropagationLimit].
 func (x *Widget) GetPropertyLimitEvents() bool {
 	var v gobject.Value
 	x.GetProperty("limit-events", &v)
@@ -4728,7 +4569,7 @@ func (x *Widget) GetPropertyLimitEvents() bool {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) SetPropertyMarginBottom(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -4741,7 +4582,7 @@ func (x *Widget) SetPropertyMarginBottom(value int) {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) GetPropertyMarginBottom() int {
 	var v gobject.Value
 	x.GetProperty("margin-bottom", &v)
@@ -4756,7 +4597,7 @@ func (x *Widget) GetPropertyMarginBottom() int {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) SetPropertyMarginEnd(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -4772,7 +4613,7 @@ func (x *Widget) SetPropertyMarginEnd(value int) {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) GetPropertyMarginEnd() int {
 	var v gobject.Value
 	x.GetProperty("margin-end", &v)
@@ -4787,7 +4628,7 @@ func (x *Widget) GetPropertyMarginEnd() int {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) SetPropertyMarginStart(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -4803,7 +4644,7 @@ func (x *Widget) SetPropertyMarginStart(value int) {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) GetPropertyMarginStart() int {
 	var v gobject.Value
 	x.GetProperty("margin-start", &v)
@@ -4815,7 +4656,7 @@ func (x *Widget) GetPropertyMarginStart() int {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) SetPropertyMarginTop(value int) {
 	var v gobject.Value
 	v.Init(gobject.TypeIntVal)
@@ -4828,7 +4669,7 @@ func (x *Widget) SetPropertyMarginTop(value int) {
 //
 // This property adds margin outside of the widget's normal size
 // request, the margin will be added in addition to the size from
-// [method@Gtk.Widget.set_size_request] for example.
+// [Widget.SetSizeRequest] for example.
 func (x *Widget) GetPropertyMarginTop() int {
 	var v gobject.Value
 	x.GetProperty("margin-top", &v)
@@ -4915,16 +4756,16 @@ func (x *Widget) GetPropertySensitive() bool {
 // Sets the text of tooltip to be the given string, which is marked up
 // with Pango markup.
 //
-// Also see [method@Gtk.Tooltip.set_markup].
+// Also see [Tooltip.SetMarkup].
 //
 // This is a convenience property which will take care of getting the
 // tooltip shown if the given string is not `NULL`:
-// [property@Gtk.Widget:has-tooltip] will automatically be set to true
-// and there will be taken care of [signal@Gtk.Widget::query-tooltip] in
+// [Widget.GetPropertyHasTooltip] will automatically be set to true
+// and there will be taken care of [Widget.ConnectQueryTooltipFunc] in
 // the default signal handler.
 //
-// Note that if both [property@Gtk.Widget:tooltip-text] and
-// [property@Gtk.Widget:tooltip-markup] are set, the last one wins.
+// Note that if both [Widget.GetPropertyTooltipText] and
+// [Widget.GetPropertyTooltipMarkup] are set, the last one wins.
 func (x *Widget) SetPropertyTooltipMarkup(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -4936,16 +4777,16 @@ func (x *Widget) SetPropertyTooltipMarkup(value string) {
 // Sets the text of tooltip to be the given string, which is marked up
 // with Pango markup.
 //
-// Also see [method@Gtk.Tooltip.set_markup].
+// Also see [Tooltip.SetMarkup].
 //
 // This is a convenience property which will take care of getting the
 // tooltip shown if the given string is not `NULL`:
-// [property@Gtk.Widget:has-tooltip] will automatically be set to true
-// and there will be taken care of [signal@Gtk.Widget::query-tooltip] in
+// [Widget.GetPropertyHasTooltip] will automatically be set to true
+// and there will be taken care of [Widget.ConnectQueryTooltipFunc] in
 // the default signal handler.
 //
-// Note that if both [property@Gtk.Widget:tooltip-text] and
-// [property@Gtk.Widget:tooltip-markup] are set, the last one wins.
+// Note that if both [Widget.GetPropertyTooltipText] and
+// [Widget.GetPropertyTooltipMarkup] are set, the last one wins.
 func (x *Widget) GetPropertyTooltipMarkup() string {
 	var v gobject.Value
 	x.GetProperty("tooltip-markup", &v)
@@ -4955,16 +4796,16 @@ func (x *Widget) GetPropertyTooltipMarkup() string {
 // SetPropertyTooltipText sets the "tooltip-text" property.
 // Sets the text of tooltip to be the given string.
 //
-// Also see [method@Gtk.Tooltip.set_text].
+// Also see [Tooltip.SetText].
 //
 // This is a convenience property which will take care of getting the
 // tooltip shown if the given string is not `NULL`:
-// [property@Gtk.Widget:has-tooltip] will automatically be set to true
-// and there will be taken care of [signal@Gtk.Widget::query-tooltip] in
+// [Widget.GetPropertyHasTooltip] will automatically be set to true
+// and there will be taken care of [Widget.ConnectQueryTooltipFunc] in
 // the default signal handler.
 //
-// Note that if both [property@Gtk.Widget:tooltip-text] and
-// [property@Gtk.Widget:tooltip-markup] are set, the last one wins.
+// Note that if both [Widget.GetPropertyTooltipText] and
+// [Widget.GetPropertyTooltipMarkup] are set, the last one wins.
 func (x *Widget) SetPropertyTooltipText(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -4975,16 +4816,16 @@ func (x *Widget) SetPropertyTooltipText(value string) {
 // GetPropertyTooltipText gets the "tooltip-text" property.
 // Sets the text of tooltip to be the given string.
 //
-// Also see [method@Gtk.Tooltip.set_text].
+// Also see [Tooltip.SetText].
 //
 // This is a convenience property which will take care of getting the
 // tooltip shown if the given string is not `NULL`:
-// [property@Gtk.Widget:has-tooltip] will automatically be set to true
-// and there will be taken care of [signal@Gtk.Widget::query-tooltip] in
+// [Widget.GetPropertyHasTooltip] will automatically be set to true
+// and there will be taken care of [Widget.ConnectQueryTooltipFunc] in
 // the default signal handler.
 //
-// Note that if both [property@Gtk.Widget:tooltip-text] and
-// [property@Gtk.Widget:tooltip-markup] are set, the last one wins.
+// Note that if both [Widget.GetPropertyTooltipText] and
+// [Widget.GetPropertyTooltipMarkup] are set, the last one wins.
 func (x *Widget) GetPropertyTooltipText() string {
 	var v gobject.Value
 	x.GetProperty("tooltip-text", &v)
@@ -5069,359 +4910,645 @@ func (x *Widget) GetPropertyWidthRequest() int {
 // May result in finalization of the widget if all references are released.
 //
 // This signal is not suitable for saving widget state.
+//
+// Deprecated: use ConnectDestroyFunc, which also accepts method values and closures.
 func (x *Widget) ConnectDestroy(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "destroy", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "destroy", cbRefPtr, cbPtr)
+}
+
+var xWidgetDestroyTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWidgetDestroyNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "destroy", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectDestroyFunc connects cb to the "destroy" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectDestroyFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "destroy", &xWidgetDestroyTrampoline, xWidgetDestroyNewTrampoline, cb)
 }
 
 // Emitted when the text direction of a widget changes.
+//
+// Deprecated: use ConnectDirectionChangedFunc, which also accepts method values and closures.
 func (x *Widget) ConnectDirectionChanged(cb *func(Widget, TextDirection)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "direction-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, PreviousDirectionVarp TextDirection) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, PreviousDirectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "direction-changed", cbRefPtr, cbPtr)
+}
+
+var xWidgetDirectionChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, PreviousDirectionVarp TextDirection) {
+func xWidgetDirectionChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, PreviousDirectionVarp TextDirection, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, TextDirection))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, PreviousDirectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "direction-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @widget is hidden.
+// ConnectDirectionChangedFunc connects cb to the "direction-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectDirectionChangedFunc(cb func(Widget, TextDirection)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "direction-changed", &xWidgetDirectionChangedTrampoline, xWidgetDirectionChangedNewTrampoline, cb)
+}
+
+// Emitted when widget is hidden.
+//
+// Deprecated: use ConnectHideFunc, which also accepts method values and closures.
 func (x *Widget) ConnectHide(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "hide", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "hide", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xWidgetHideTrampoline uintptr
+
+func xWidgetHideNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "hide", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectHideFunc connects cb to the "hide" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectHideFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "hide", &xWidgetHideTrampoline, xWidgetHideNewTrampoline, cb)
 }
 
 // Emitted if keyboard navigation fails.
 //
-// See [method@Gtk.Widget.keynav_failed] for details.
+// See [Widget.KeynavFailed] for details.
+//
+// Deprecated: use ConnectKeynavFailedFunc, which also accepts method values and closures.
 func (x *Widget) ConnectKeynavFailed(cb *func(Widget, DirectionType) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "keynav-failed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp DirectionType) bool {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, DirectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "keynav-failed", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, DirectionVarp DirectionType) bool {
+var xWidgetKeynavFailedTrampoline uintptr
+
+func xWidgetKeynavFailedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp DirectionType, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, DirectionType) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, DirectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "keynav-failed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @widget is going to be mapped.
+// ConnectKeynavFailedFunc connects cb to the "keynav-failed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectKeynavFailedFunc(cb func(Widget, DirectionType) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "keynav-failed", &xWidgetKeynavFailedTrampoline, xWidgetKeynavFailedNewTrampoline, cb)
+}
+
+// Emitted when widget is going to be mapped.
 //
 // A widget is mapped when the widget is visible (which is controlled with
-// [property@Gtk.Widget:visible]) and all its parents up to the toplevel widget
+// [Widget.GetPropertyVisible]) and all its parents up to the toplevel widget
 // are also visible.
 //
 // The `::map` signal can be used to determine whether a widget will be drawn,
 // for instance it can resume an animation that was stopped during the
-// emission of [signal@Gtk.Widget::unmap].
+// emission of [Widget.ConnectUnmapFunc].
+//
+// Deprecated: use ConnectMapFunc, which also accepts method values and closures.
 func (x *Widget) ConnectMap(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "map", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "map", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xWidgetMapTrampoline uintptr
+
+func xWidgetMapNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "map", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMapFunc connects cb to the "map" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectMapFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "map", &xWidgetMapTrampoline, xWidgetMapNewTrampoline, cb)
 }
 
 // Emitted when a widget is activated via a mnemonic.
 //
-// The default handler for this signal activates @widget if @group_cycling
-// is false, or just makes @widget grab focus if @group_cycling is true.
+// The default handler for this signal activates widget if group_cycling
+// is false, or just makes widget grab focus if group_cycling is true.
+//
+// Deprecated: use ConnectMnemonicActivateFunc, which also accepts method values and closures.
 func (x *Widget) ConnectMnemonicActivate(cb *func(Widget, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "mnemonic-activate", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, GroupCyclingVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, GroupCyclingVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "mnemonic-activate", cbRefPtr, cbPtr)
+}
+
+var xWidgetMnemonicActivateTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, GroupCyclingVarp bool) bool {
+func xWidgetMnemonicActivateNewTrampoline() interface{} {
+	return func(clsPtr uintptr, GroupCyclingVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, GroupCyclingVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "mnemonic-activate", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMnemonicActivateFunc connects cb to the "mnemonic-activate" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectMnemonicActivateFunc(cb func(Widget, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "mnemonic-activate", &xWidgetMnemonicActivateTrampoline, xWidgetMnemonicActivateNewTrampoline, cb)
 }
 
 // Emitted when the focus is moved.
 //
-// The `::move-focus` signal is a [keybinding signal](class.SignalAction.html).
+// The `::move-focus` signal is a keybinding signal.
+//
+// The default bindings for this signal are Tab to move forward,
+// and Shift+Tab to move backward.
 //
-// The default bindings for this signal are &lt;kbd&gt;Tab&lt;/kbd&gt; to move forward,
-// and &lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;Tab&lt;/kbd&gt; to move backward.
+// Deprecated: use ConnectMoveFocusFunc, which also accepts method values and closures.
 func (x *Widget) ConnectMoveFocus(cb *func(Widget, DirectionType)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, DirectionVarp DirectionType) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, DirectionVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "move-focus", cbRefPtr, cbPtr)
+}
+
+var xWidgetMoveFocusTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, DirectionVarp DirectionType) {
+func xWidgetMoveFocusNewTrampoline() interface{} {
+	return func(clsPtr uintptr, DirectionVarp DirectionType, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, DirectionType))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, DirectionVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "move-focus", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectMoveFocusFunc connects cb to the "move-focus" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectMoveFocusFunc(cb func(Widget, DirectionType)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "move-focus", &xWidgetMoveFocusTrampoline, xWidgetMoveFocusNewTrampoline, cb)
 }
 
 // Emitted when the widget’s tooltip is about to be shown.
 //
-// This happens when the [property@Gtk.Widget:has-tooltip] property
+// This happens when the [Widget.GetPropertyHasTooltip] property
 // is true and the hover timeout has expired with the cursor hovering
-// above @widget; or emitted when @widget got focus in keyboard mode.
+// above widget; or emitted when widget got focus in keyboard mode.
 //
 // Using the given coordinates, the signal handler should determine
-// whether a tooltip should be shown for @widget. If this is the case
-// true should be returned, false otherwise. Note that if @keyboard_mode
-// is true, the values of @x and @y are undefined and should not be used.
+// whether a tooltip should be shown for widget. If this is the case
+// true should be returned, false otherwise. Note that if keyboard_mode
+// is true, the values of x and y are undefined and should not be used.
 //
-// The signal handler is free to manipulate @tooltip with the therefore
+// The signal handler is free to manipulate tooltip with the therefore
 // destined function calls.
+//
+// Deprecated: use ConnectQueryTooltipFunc, which also accepts method values and closures.
 func (x *Widget) ConnectQueryTooltip(cb *func(Widget, int, int, bool, uintptr) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "query-tooltip", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, XVarp int, YVarp int, KeyboardModeVarp bool, TooltipVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, XVarp, YVarp, KeyboardModeVarp, TooltipVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "query-tooltip", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr, XVarp int, YVarp int, KeyboardModeVarp bool, TooltipVarp uintptr) bool {
+var xWidgetQueryTooltipTrampoline uintptr
+
+func xWidgetQueryTooltipNewTrampoline() interface{} {
+	return func(clsPtr uintptr, XVarp int, YVarp int, KeyboardModeVarp bool, TooltipVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, int, int, bool, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, XVarp, YVarp, KeyboardModeVarp, TooltipVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "query-tooltip", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @widget is associated with a `GdkSurface`.
+// ConnectQueryTooltipFunc connects cb to the "query-tooltip" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectQueryTooltipFunc(cb func(Widget, int, int, bool, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "query-tooltip", &xWidgetQueryTooltipTrampoline, xWidgetQueryTooltipNewTrampoline, cb)
+}
+
+// Emitted when widget is associated with a `GdkSurface`.
 //
-// This means that [method@Gtk.Widget.realize] has been called
+// This means that [Widget.Realize] has been called
 // or the widget has been mapped (that is, it is going to be drawn).
+//
+// Deprecated: use ConnectRealizeFunc, which also accepts method values and closures.
 func (x *Widget) ConnectRealize(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "realize", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "realize", cbRefPtr, cbPtr)
+}
+
+var xWidgetRealizeTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWidgetRealizeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "realize", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @widget is shown.
+// ConnectRealizeFunc connects cb to the "realize" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectRealizeFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "realize", &xWidgetRealizeTrampoline, xWidgetRealizeNewTrampoline, cb)
+}
+
+// Emitted when widget is shown.
+//
+// Deprecated: use ConnectShowFunc, which also accepts method values and closures.
 func (x *Widget) ConnectShow(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "show", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "show", cbRefPtr, cbPtr)
+}
+
+var xWidgetShowTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWidgetShowNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "show", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectShowFunc connects cb to the "show" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectShowFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "show", &xWidgetShowTrampoline, xWidgetShowNewTrampoline, cb)
 }
 
 // Emitted when the widget state changes.
 //
-// See [method@Gtk.Widget.get_state_flags].
+// See [Widget.GetStateFlags].
+//
+// Deprecated: use ConnectStateFlagsChangedFunc, which also accepts method values and closures.
 func (x *Widget) ConnectStateFlagsChanged(cb *func(Widget, StateFlags)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "state-flags-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, FlagsVarp StateFlags) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, FlagsVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "state-flags-changed", cbRefPtr, cbPtr)
+}
+
+var xWidgetStateFlagsChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, FlagsVarp StateFlags) {
+func xWidgetStateFlagsChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, FlagsVarp StateFlags, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget, StateFlags))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa, FlagsVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "state-flags-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when @widget is going to be unmapped.
+// ConnectStateFlagsChangedFunc connects cb to the "state-flags-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectStateFlagsChangedFunc(cb func(Widget, StateFlags)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "state-flags-changed", &xWidgetStateFlagsChangedTrampoline, xWidgetStateFlagsChangedNewTrampoline, cb)
+}
+
+// Emitted when widget is going to be unmapped.
 //
 // A widget is unmapped when either it or any of its parents up to the
 // toplevel widget have been set as hidden.
 //
 // As `::unmap` indicates that a widget will not be shown any longer,
 // it can be used to, for example, stop an animation on the widget.
+//
+// Deprecated: use ConnectUnmapFunc, which also accepts method values and closures.
 func (x *Widget) ConnectUnmap(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unmap", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unmap", cbRefPtr, cbPtr)
+}
+
+var xWidgetUnmapTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWidgetUnmapNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unmap", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
 }
 
-// Emitted when the `GdkSurface` associated with @widget is destroyed.
+// ConnectUnmapFunc connects cb to the "unmap" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectUnmapFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unmap", &xWidgetUnmapTrampoline, xWidgetUnmapNewTrampoline, cb)
+}
+
+// Emitted when the `GdkSurface` associated with widget is destroyed.
 //
-// This means that [method@Gtk.Widget.unrealize] has been called
+// This means that [Widget.Unrealize] has been called
 // or the widget has been unmapped (that is, it is going to be hidden).
+//
+// Deprecated: use ConnectUnrealizeFunc, which also accepts method values and closures.
 func (x *Widget) ConnectUnrealize(cb *func(Widget)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "unrealize", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Widget{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "unrealize", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xWidgetUnrealizeTrampoline uintptr
+
+func xWidgetUnrealizeNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Widget))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Widget{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "unrealize", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectUnrealizeFunc connects cb to the "unrealize" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Widget) ConnectUnrealizeFunc(cb func(Widget)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "unrealize", &xWidgetUnrealizeTrampoline, xWidgetUnrealizeNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -5585,7 +5712,7 @@ func (x *Widget) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -5593,14 +5720,13 @@ func (x *Widget) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Widget) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -5624,19 +5750,17 @@ func (x *Widget) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Widget) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -5657,7 +5781,7 @@ func (x *Widget) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -5666,14 +5790,12 @@ func (x *Widget) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Widget) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -5692,10 +5814,10 @@ func (x *Widget) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Widget) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -5706,7 +5828,7 @@ var xWidgetGetDefaultDirection func() TextDirection
 
 // Obtains the default reading direction.
 //
-// See [func@Gtk.Widget.set_default_direction].
+// See [WidgetSetDefaultDirection].
 func WidgetGetDefaultDirection() TextDirection {
 
 	cret := xWidgetGetDefaultDirection()
@@ -5717,7 +5839,7 @@ var xWidgetSetDefaultDirection func(TextDirection)
 
 // Sets the default reading direction for widgets.
 //
-// See [method@Gtk.Widget.set_direction].
+// See [Widget.SetDirection].
 func WidgetSetDefaultDirection(DirVar TextDirection) {
 
 	xWidgetSetDefaultDirection(DirVar)
@@ -5729,7 +5851,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -5738,201 +5860,193 @@ func init() {
 
 	core.PuregoSafeRegister(&xRequisitionGLibType, libs, "gtk_requisition_get_type")
 
-	core.PuregoSafeRegister(&xNewRequisition, libs, "gtk_requisition_new")
-
-	core.PuregoSafeRegister(&xRequisitionCopy, libs, "gtk_requisition_copy")
-	core.PuregoSafeRegister(&xRequisitionFree, libs, "gtk_requisition_free")
-
-	core.PuregoSafeRegister(&xWidgetClassAddBinding, libs, "gtk_widget_class_add_binding")
-	core.PuregoSafeRegister(&xWidgetClassAddBindingAction, libs, "gtk_widget_class_add_binding_action")
-	core.PuregoSafeRegister(&xWidgetClassAddBindingSignal, libs, "gtk_widget_class_add_binding_signal")
-	core.PuregoSafeRegister(&xWidgetClassAddShortcut, libs, "gtk_widget_class_add_shortcut")
-	core.PuregoSafeRegister(&xWidgetClassBindTemplateCallbackFull, libs, "gtk_widget_class_bind_template_callback_full")
-	core.PuregoSafeRegister(&xWidgetClassBindTemplateChildFull, libs, "gtk_widget_class_bind_template_child_full")
-	core.PuregoSafeRegister(&xWidgetClassGetAccessibleRole, libs, "gtk_widget_class_get_accessible_role")
-	core.PuregoSafeRegister(&xWidgetClassGetActivateSignal, libs, "gtk_widget_class_get_activate_signal")
-	core.PuregoSafeRegister(&xWidgetClassGetCssName, libs, "gtk_widget_class_get_css_name")
-	core.PuregoSafeRegister(&xWidgetClassGetLayoutManagerType, libs, "gtk_widget_class_get_layout_manager_type")
-	core.PuregoSafeRegister(&xWidgetClassInstallAction, libs, "gtk_widget_class_install_action")
-	core.PuregoSafeRegister(&xWidgetClassInstallPropertyAction, libs, "gtk_widget_class_install_property_action")
-	core.PuregoSafeRegister(&xWidgetClassQueryAction, libs, "gtk_widget_class_query_action")
-	core.PuregoSafeRegister(&xWidgetClassSetAccessibleRole, libs, "gtk_widget_class_set_accessible_role")
-	core.PuregoSafeRegister(&xWidgetClassSetActivateSignal, libs, "gtk_widget_class_set_activate_signal")
-	core.PuregoSafeRegister(&xWidgetClassSetActivateSignalFromName, libs, "gtk_widget_class_set_activate_signal_from_name")
-	core.PuregoSafeRegister(&xWidgetClassSetCssName, libs, "gtk_widget_class_set_css_name")
-	core.PuregoSafeRegister(&xWidgetClassSetLayoutManagerType, libs, "gtk_widget_class_set_layout_manager_type")
-	core.PuregoSafeRegister(&xWidgetClassSetTemplate, libs, "gtk_widget_class_set_template")
-	core.PuregoSafeRegister(&xWidgetClassSetTemplateFromResource, libs, "gtk_widget_class_set_template_from_resource")
-	core.PuregoSafeRegister(&xWidgetClassSetTemplateScope, libs, "gtk_widget_class_set_template_scope")
+	core.PuregoRegisterSince(&xNewRequisition, libs, "gtk_requisition_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xRequisitionCopy, libs, "gtk_requisition_copy", "GTK", "")
+	core.PuregoRegisterSince(&xRequisitionFree, libs, "gtk_requisition_free", "GTK", "")
+
+	core.PuregoRegisterSince(&xWidgetClassAddBinding, libs, "gtk_widget_class_add_binding", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassAddBindingAction, libs, "gtk_widget_class_add_binding_action", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassAddBindingSignal, libs, "gtk_widget_class_add_binding_signal", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassAddShortcut, libs, "gtk_widget_class_add_shortcut", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassBindTemplateCallbackFull, libs, "gtk_widget_class_bind_template_callback_full", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassBindTemplateChildFull, libs, "gtk_widget_class_bind_template_child_full", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassGetAccessibleRole, libs, "gtk_widget_class_get_accessible_role", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassGetActivateSignal, libs, "gtk_widget_class_get_activate_signal", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassGetCssName, libs, "gtk_widget_class_get_css_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassGetLayoutManagerType, libs, "gtk_widget_class_get_layout_manager_type", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassInstallAction, libs, "gtk_widget_class_install_action", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassInstallPropertyAction, libs, "gtk_widget_class_install_property_action", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassQueryAction, libs, "gtk_widget_class_query_action", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetAccessibleRole, libs, "gtk_widget_class_set_accessible_role", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetActivateSignal, libs, "gtk_widget_class_set_activate_signal", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetActivateSignalFromName, libs, "gtk_widget_class_set_activate_signal_from_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetCssName, libs, "gtk_widget_class_set_css_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetLayoutManagerType, libs, "gtk_widget_class_set_layout_manager_type", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetTemplate, libs, "gtk_widget_class_set_template", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetTemplateFromResource, libs, "gtk_widget_class_set_template_from_resource", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetClassSetTemplateScope, libs, "gtk_widget_class_set_template_scope", "GTK", "")
 
 	core.PuregoSafeRegister(&xWidgetGLibType, libs, "gtk_widget_get_type")
 
-	core.PuregoSafeRegister(&xWidgetActionSetEnabled, libs, "gtk_widget_action_set_enabled")
-	core.PuregoSafeRegister(&xWidgetActivate, libs, "gtk_widget_activate")
-	core.PuregoSafeRegister(&xWidgetActivateAction, libs, "gtk_widget_activate_action")
-	core.PuregoSafeRegister(&xWidgetActivateActionVariant, libs, "gtk_widget_activate_action_variant")
-	core.PuregoSafeRegister(&xWidgetActivateDefault, libs, "gtk_widget_activate_default")
-	core.PuregoSafeRegister(&xWidgetAddController, libs, "gtk_widget_add_controller")
-	core.PuregoSafeRegister(&xWidgetAddCssClass, libs, "gtk_widget_add_css_class")
-	core.PuregoSafeRegister(&xWidgetAddMnemonicLabel, libs, "gtk_widget_add_mnemonic_label")
-	core.PuregoSafeRegister(&xWidgetAddTickCallback, libs, "gtk_widget_add_tick_callback")
-	core.PuregoSafeRegister(&xWidgetAllocate, libs, "gtk_widget_allocate")
-	core.PuregoSafeRegister(&xWidgetChildFocus, libs, "gtk_widget_child_focus")
-	core.PuregoSafeRegister(&xWidgetComputeBounds, libs, "gtk_widget_compute_bounds")
-	core.PuregoSafeRegister(&xWidgetComputeExpand, libs, "gtk_widget_compute_expand")
-	core.PuregoSafeRegister(&xWidgetComputePoint, libs, "gtk_widget_compute_point")
-	core.PuregoSafeRegister(&xWidgetComputeTransform, libs, "gtk_widget_compute_transform")
-	core.PuregoSafeRegister(&xWidgetContains, libs, "gtk_widget_contains")
-	core.PuregoSafeRegister(&xWidgetCreatePangoContext, libs, "gtk_widget_create_pango_context")
-	core.PuregoSafeRegister(&xWidgetCreatePangoLayout, libs, "gtk_widget_create_pango_layout")
-	core.PuregoSafeRegister(&xWidgetDisposeTemplate, libs, "gtk_widget_dispose_template")
-	core.PuregoSafeRegister(&xWidgetDragCheckThreshold, libs, "gtk_drag_check_threshold")
-	core.PuregoSafeRegister(&xWidgetErrorBell, libs, "gtk_widget_error_bell")
-	core.PuregoSafeRegister(&xWidgetGetAllocatedBaseline, libs, "gtk_widget_get_allocated_baseline")
-	core.PuregoSafeRegister(&xWidgetGetAllocatedHeight, libs, "gtk_widget_get_allocated_height")
-	core.PuregoSafeRegister(&xWidgetGetAllocatedWidth, libs, "gtk_widget_get_allocated_width")
-	core.PuregoSafeRegister(&xWidgetGetAllocation, libs, "gtk_widget_get_allocation")
-	core.PuregoSafeRegister(&xWidgetGetAncestor, libs, "gtk_widget_get_ancestor")
-	core.PuregoSafeRegister(&xWidgetGetBaseline, libs, "gtk_widget_get_baseline")
-	core.PuregoSafeRegister(&xWidgetGetCanFocus, libs, "gtk_widget_get_can_focus")
-	core.PuregoSafeRegister(&xWidgetGetCanTarget, libs, "gtk_widget_get_can_target")
-	core.PuregoSafeRegister(&xWidgetGetChildVisible, libs, "gtk_widget_get_child_visible")
-	core.PuregoSafeRegister(&xWidgetGetClipboard, libs, "gtk_widget_get_clipboard")
-	core.PuregoSafeRegister(&xWidgetGetColor, libs, "gtk_widget_get_color")
-	core.PuregoSafeRegister(&xWidgetGetCssClasses, libs, "gtk_widget_get_css_classes")
-	core.PuregoSafeRegister(&xWidgetGetCssName, libs, "gtk_widget_get_css_name")
-	core.PuregoSafeRegister(&xWidgetGetCursor, libs, "gtk_widget_get_cursor")
-	core.PuregoSafeRegister(&xWidgetGetDirection, libs, "gtk_widget_get_direction")
-	core.PuregoSafeRegister(&xWidgetGetDisplay, libs, "gtk_widget_get_display")
-	core.PuregoSafeRegister(&xWidgetGetFirstChild, libs, "gtk_widget_get_first_child")
-	core.PuregoSafeRegister(&xWidgetGetFocusChild, libs, "gtk_widget_get_focus_child")
-	core.PuregoSafeRegister(&xWidgetGetFocusOnClick, libs, "gtk_widget_get_focus_on_click")
-	core.PuregoSafeRegister(&xWidgetGetFocusable, libs, "gtk_widget_get_focusable")
-	core.PuregoSafeRegister(&xWidgetGetFontMap, libs, "gtk_widget_get_font_map")
-	core.PuregoSafeRegister(&xWidgetGetFontOptions, libs, "gtk_widget_get_font_options")
-	core.PuregoSafeRegister(&xWidgetGetFrameClock, libs, "gtk_widget_get_frame_clock")
-	core.PuregoSafeRegister(&xWidgetGetHalign, libs, "gtk_widget_get_halign")
-	core.PuregoSafeRegister(&xWidgetGetHasTooltip, libs, "gtk_widget_get_has_tooltip")
-	core.PuregoSafeRegister(&xWidgetGetHeight, libs, "gtk_widget_get_height")
-	core.PuregoSafeRegister(&xWidgetGetHexpand, libs, "gtk_widget_get_hexpand")
-	core.PuregoSafeRegister(&xWidgetGetHexpandSet, libs, "gtk_widget_get_hexpand_set")
-	core.PuregoSafeRegister(&xWidgetGetLastChild, libs, "gtk_widget_get_last_child")
-	core.PuregoSafeRegister(&xWidgetGetLayoutManager, libs, "gtk_widget_get_layout_manager")
-	core.PuregoSafeRegister(&xWidgetGetLimitEvents, libs, "gtk_widget_get_limit_events")
-	core.PuregoSafeRegister(&xWidgetGetMapped, libs, "gtk_widget_get_mapped")
-	core.PuregoSafeRegister(&xWidgetGetMarginBottom, libs, "gtk_widget_get_margin_bottom")
-	core.PuregoSafeRegister(&xWidgetGetMarginEnd, libs, "gtk_widget_get_margin_end")
-	core.PuregoSafeRegister(&xWidgetGetMarginStart, libs, "gtk_widget_get_margin_start")
-	core.PuregoSafeRegister(&xWidgetGetMarginTop, libs, "gtk_widget_get_margin_top")
-	core.PuregoSafeRegister(&xWidgetGetName, libs, "gtk_widget_get_name")
-	core.PuregoSafeRegister(&xWidgetGetNative, libs, "gtk_widget_get_native")
-	core.PuregoSafeRegister(&xWidgetGetNextSibling, libs, "gtk_widget_get_next_sibling")
-	core.PuregoSafeRegister(&xWidgetGetOpacity, libs, "gtk_widget_get_opacity")
-	core.PuregoSafeRegister(&xWidgetGetOverflow, libs, "gtk_widget_get_overflow")
-	core.PuregoSafeRegister(&xWidgetGetPangoContext, libs, "gtk_widget_get_pango_context")
-	core.PuregoSafeRegister(&xWidgetGetParent, libs, "gtk_widget_get_parent")
-	core.PuregoSafeRegister(&xWidgetGetPreferredSize, libs, "gtk_widget_get_preferred_size")
-	core.PuregoSafeRegister(&xWidgetGetPrevSibling, libs, "gtk_widget_get_prev_sibling")
-	core.PuregoSafeRegister(&xWidgetGetPrimaryClipboard, libs, "gtk_widget_get_primary_clipboard")
-	core.PuregoSafeRegister(&xWidgetGetRealized, libs, "gtk_widget_get_realized")
-	core.PuregoSafeRegister(&xWidgetGetReceivesDefault, libs, "gtk_widget_get_receives_default")
-	core.PuregoSafeRegister(&xWidgetGetRequestMode, libs, "gtk_widget_get_request_mode")
-	core.PuregoSafeRegister(&xWidgetGetRoot, libs, "gtk_widget_get_root")
-	core.PuregoSafeRegister(&xWidgetGetScaleFactor, libs, "gtk_widget_get_scale_factor")
-	core.PuregoSafeRegister(&xWidgetGetSensitive, libs, "gtk_widget_get_sensitive")
-	core.PuregoSafeRegister(&xWidgetGetSettings, libs, "gtk_widget_get_settings")
-	core.PuregoSafeRegister(&xWidgetGetSize, libs, "gtk_widget_get_size")
-	core.PuregoSafeRegister(&xWidgetGetSizeRequest, libs, "gtk_widget_get_size_request")
-	core.PuregoSafeRegister(&xWidgetGetStateFlags, libs, "gtk_widget_get_state_flags")
-	core.PuregoSafeRegister(&xWidgetGetStyleContext, libs, "gtk_widget_get_style_context")
-	core.PuregoSafeRegister(&xWidgetGetTemplateChild, libs, "gtk_widget_get_template_child")
-	core.PuregoSafeRegister(&xWidgetGetTooltipMarkup, libs, "gtk_widget_get_tooltip_markup")
-	core.PuregoSafeRegister(&xWidgetGetTooltipText, libs, "gtk_widget_get_tooltip_text")
-	core.PuregoSafeRegister(&xWidgetGetValign, libs, "gtk_widget_get_valign")
-	core.PuregoSafeRegister(&xWidgetGetVexpand, libs, "gtk_widget_get_vexpand")
-	core.PuregoSafeRegister(&xWidgetGetVexpandSet, libs, "gtk_widget_get_vexpand_set")
-	core.PuregoSafeRegister(&xWidgetGetVisible, libs, "gtk_widget_get_visible")
-	core.PuregoSafeRegister(&xWidgetGetWidth, libs, "gtk_widget_get_width")
-	core.PuregoSafeRegister(&xWidgetGrabFocus, libs, "gtk_widget_grab_focus")
-	core.PuregoSafeRegister(&xWidgetHasCssClass, libs, "gtk_widget_has_css_class")
-	core.PuregoSafeRegister(&xWidgetHasDefault, libs, "gtk_widget_has_default")
-	core.PuregoSafeRegister(&xWidgetHasFocus, libs, "gtk_widget_has_focus")
-	core.PuregoSafeRegister(&xWidgetHasVisibleFocus, libs, "gtk_widget_has_visible_focus")
-	core.PuregoSafeRegister(&xWidgetHide, libs, "gtk_widget_hide")
-	core.PuregoSafeRegister(&xWidgetInDestruction, libs, "gtk_widget_in_destruction")
-	core.PuregoSafeRegister(&xWidgetInitTemplate, libs, "gtk_widget_init_template")
-	core.PuregoSafeRegister(&xWidgetInsertActionGroup, libs, "gtk_widget_insert_action_group")
-	core.PuregoSafeRegister(&xWidgetInsertAfter, libs, "gtk_widget_insert_after")
-	core.PuregoSafeRegister(&xWidgetInsertBefore, libs, "gtk_widget_insert_before")
-	core.PuregoSafeRegister(&xWidgetIsAncestor, libs, "gtk_widget_is_ancestor")
-	core.PuregoSafeRegister(&xWidgetIsDrawable, libs, "gtk_widget_is_drawable")
-	core.PuregoSafeRegister(&xWidgetIsFocus, libs, "gtk_widget_is_focus")
-	core.PuregoSafeRegister(&xWidgetIsSensitive, libs, "gtk_widget_is_sensitive")
-	core.PuregoSafeRegister(&xWidgetIsVisible, libs, "gtk_widget_is_visible")
-	core.PuregoSafeRegister(&xWidgetKeynavFailed, libs, "gtk_widget_keynav_failed")
-	core.PuregoSafeRegister(&xWidgetListMnemonicLabels, libs, "gtk_widget_list_mnemonic_labels")
-	core.PuregoSafeRegister(&xWidgetMap, libs, "gtk_widget_map")
-	core.PuregoSafeRegister(&xWidgetMeasure, libs, "gtk_widget_measure")
-	core.PuregoSafeRegister(&xWidgetMnemonicActivate, libs, "gtk_widget_mnemonic_activate")
-	core.PuregoSafeRegister(&xWidgetObserveChildren, libs, "gtk_widget_observe_children")
-	core.PuregoSafeRegister(&xWidgetObserveControllers, libs, "gtk_widget_observe_controllers")
-	core.PuregoSafeRegister(&xWidgetPick, libs, "gtk_widget_pick")
-	core.PuregoSafeRegister(&xWidgetQueueAllocate, libs, "gtk_widget_queue_allocate")
-	core.PuregoSafeRegister(&xWidgetQueueDraw, libs, "gtk_widget_queue_draw")
-	core.PuregoSafeRegister(&xWidgetQueueResize, libs, "gtk_widget_queue_resize")
-	core.PuregoSafeRegister(&xWidgetRealize, libs, "gtk_widget_realize")
-	core.PuregoSafeRegister(&xWidgetRemoveController, libs, "gtk_widget_remove_controller")
-	core.PuregoSafeRegister(&xWidgetRemoveCssClass, libs, "gtk_widget_remove_css_class")
-	core.PuregoSafeRegister(&xWidgetRemoveMnemonicLabel, libs, "gtk_widget_remove_mnemonic_label")
-	core.PuregoSafeRegister(&xWidgetRemoveTickCallback, libs, "gtk_widget_remove_tick_callback")
-	core.PuregoSafeRegister(&xWidgetSetCanFocus, libs, "gtk_widget_set_can_focus")
-	core.PuregoSafeRegister(&xWidgetSetCanTarget, libs, "gtk_widget_set_can_target")
-	core.PuregoSafeRegister(&xWidgetSetChildVisible, libs, "gtk_widget_set_child_visible")
-	core.PuregoSafeRegister(&xWidgetSetCssClasses, libs, "gtk_widget_set_css_classes")
-	core.PuregoSafeRegister(&xWidgetSetCursor, libs, "gtk_widget_set_cursor")
-	core.PuregoSafeRegister(&xWidgetSetCursorFromName, libs, "gtk_widget_set_cursor_from_name")
-	core.PuregoSafeRegister(&xWidgetSetDirection, libs, "gtk_widget_set_direction")
-	core.PuregoSafeRegister(&xWidgetSetFocusChild, libs, "gtk_widget_set_focus_child")
-	core.PuregoSafeRegister(&xWidgetSetFocusOnClick, libs, "gtk_widget_set_focus_on_click")
-	core.PuregoSafeRegister(&xWidgetSetFocusable, libs, "gtk_widget_set_focusable")
-	core.PuregoSafeRegister(&xWidgetSetFontMap, libs, "gtk_widget_set_font_map")
-	core.PuregoSafeRegister(&xWidgetSetFontOptions, libs, "gtk_widget_set_font_options")
-	core.PuregoSafeRegister(&xWidgetSetHalign, libs, "gtk_widget_set_halign")
-	core.PuregoSafeRegister(&xWidgetSetHasTooltip, libs, "gtk_widget_set_has_tooltip")
-	core.PuregoSafeRegister(&xWidgetSetHexpand, libs, "gtk_widget_set_hexpand")
-	core.PuregoSafeRegister(&xWidgetSetHexpandSet, libs, "gtk_widget_set_hexpand_set")
-	core.PuregoSafeRegister(&xWidgetSetLayoutManager, libs, "gtk_widget_set_layout_manager")
-	core.PuregoSafeRegister(&xWidgetSetLimitEvents, libs, "gtk_widget_set_limit_events")
-	core.PuregoSafeRegister(&xWidgetSetMarginBottom, libs, "gtk_widget_set_margin_bottom")
-	core.PuregoSafeRegister(&xWidgetSetMarginEnd, libs, "gtk_widget_set_margin_end")
-	core.PuregoSafeRegister(&xWidgetSetMarginStart, libs, "gtk_widget_set_margin_start")
-	core.PuregoSafeRegister(&xWidgetSetMarginTop, libs, "gtk_widget_set_margin_top")
-	core.PuregoSafeRegister(&xWidgetSetName, libs, "gtk_widget_set_name")
-	core.PuregoSafeRegister(&xWidgetSetOpacity, libs, "gtk_widget_set_opacity")
-	core.PuregoSafeRegister(&xWidgetSetOverflow, libs, "gtk_widget_set_overflow")
-	core.PuregoSafeRegister(&xWidgetSetParent, libs, "gtk_widget_set_parent")
-	core.PuregoSafeRegister(&xWidgetSetReceivesDefault, libs, "gtk_widget_set_receives_default")
-	core.PuregoSafeRegister(&xWidgetSetSensitive, libs, "gtk_widget_set_sensitive")
-	core.PuregoSafeRegister(&xWidgetSetSizeRequest, libs, "gtk_widget_set_size_request")
-	core.PuregoSafeRegister(&xWidgetSetStateFlags, libs, "gtk_widget_set_state_flags")
-	core.PuregoSafeRegister(&xWidgetSetTooltipMarkup, libs, "gtk_widget_set_tooltip_markup")
-	core.PuregoSafeRegister(&xWidgetSetTooltipText, libs, "gtk_widget_set_tooltip_text")
-	core.PuregoSafeRegister(&xWidgetSetValign, libs, "gtk_widget_set_valign")
-	core.PuregoSafeRegister(&xWidgetSetVexpand, libs, "gtk_widget_set_vexpand")
-	core.PuregoSafeRegister(&xWidgetSetVexpandSet, libs, "gtk_widget_set_vexpand_set")
-	core.PuregoSafeRegister(&xWidgetSetVisible, libs, "gtk_widget_set_visible")
-	core.PuregoSafeRegister(&xWidgetShouldLayout, libs, "gtk_widget_should_layout")
-	core.PuregoSafeRegister(&xWidgetShow, libs, "gtk_widget_show")
-	core.PuregoSafeRegister(&xWidgetSizeAllocate, libs, "gtk_widget_size_allocate")
-	core.PuregoSafeRegister(&xWidgetSnapshotChild, libs, "gtk_widget_snapshot_child")
-	core.PuregoSafeRegister(&xWidgetTranslateCoordinates, libs, "gtk_widget_translate_coordinates")
-	core.PuregoSafeRegister(&xWidgetTriggerTooltipQuery, libs, "gtk_widget_trigger_tooltip_query")
-	core.PuregoSafeRegister(&xWidgetUnmap, libs, "gtk_widget_unmap")
-	core.PuregoSafeRegister(&xWidgetUnparent, libs, "gtk_widget_unparent")
-	core.PuregoSafeRegister(&xWidgetUnrealize, libs, "gtk_widget_unrealize")
-	core.PuregoSafeRegister(&xWidgetUnsetStateFlags, libs, "gtk_widget_unset_state_flags")
-
-	core.PuregoSafeRegister(&xWidgetGetDefaultDirection, libs, "gtk_widget_get_default_direction")
-	core.PuregoSafeRegister(&xWidgetSetDefaultDirection, libs, "gtk_widget_set_default_direction")
+	gobject.CheckClassSize("GtkWidgetClass", xWidgetGLibType, unsafe.Sizeof(WidgetClass{}))
+
+	core.PuregoRegisterSince(&xWidgetActionSetEnabled, libs, "gtk_widget_action_set_enabled", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetActivate, libs, "gtk_widget_activate", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetActivateAction, libs, "gtk_widget_activate_action", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetActivateActionVariant, libs, "gtk_widget_activate_action_variant", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetActivateDefault, libs, "gtk_widget_activate_default", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetAddController, libs, "gtk_widget_add_controller", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetAddCssClass, libs, "gtk_widget_add_css_class", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetAddMnemonicLabel, libs, "gtk_widget_add_mnemonic_label", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetAddTickCallback, libs, "gtk_widget_add_tick_callback", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetAllocate, libs, "gtk_widget_allocate", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetChildFocus, libs, "gtk_widget_child_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetComputeBounds, libs, "gtk_widget_compute_bounds", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetComputeExpand, libs, "gtk_widget_compute_expand", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetComputePoint, libs, "gtk_widget_compute_point", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetComputeTransform, libs, "gtk_widget_compute_transform", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetContains, libs, "gtk_widget_contains", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetCreatePangoContext, libs, "gtk_widget_create_pango_context", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetCreatePangoLayout, libs, "gtk_widget_create_pango_layout", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetDisposeTemplate, libs, "gtk_widget_dispose_template", "GTK", "4.8")
+	core.PuregoRegisterSince(&xWidgetDragCheckThreshold, libs, "gtk_drag_check_threshold", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetErrorBell, libs, "gtk_widget_error_bell", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetAncestor, libs, "gtk_widget_get_ancestor", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetBaseline, libs, "gtk_widget_get_baseline", "GTK", "4.12")
+	core.PuregoRegisterSince(&xWidgetGetCanFocus, libs, "gtk_widget_get_can_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetCanTarget, libs, "gtk_widget_get_can_target", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetChildVisible, libs, "gtk_widget_get_child_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetClipboard, libs, "gtk_widget_get_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetColor, libs, "gtk_widget_get_color", "GTK", "4.10")
+	core.PuregoRegisterSince(&xWidgetGetCssClasses, libs, "gtk_widget_get_css_classes", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetCssName, libs, "gtk_widget_get_css_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetCursor, libs, "gtk_widget_get_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetDirection, libs, "gtk_widget_get_direction", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetDisplay, libs, "gtk_widget_get_display", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFirstChild, libs, "gtk_widget_get_first_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFocusChild, libs, "gtk_widget_get_focus_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFocusOnClick, libs, "gtk_widget_get_focus_on_click", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFocusable, libs, "gtk_widget_get_focusable", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFontMap, libs, "gtk_widget_get_font_map", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFrameClock, libs, "gtk_widget_get_frame_clock", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetHalign, libs, "gtk_widget_get_halign", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetHasTooltip, libs, "gtk_widget_get_has_tooltip", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetHeight, libs, "gtk_widget_get_height", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetHexpand, libs, "gtk_widget_get_hexpand", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetHexpandSet, libs, "gtk_widget_get_hexpand_set", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetLastChild, libs, "gtk_widget_get_last_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetLayoutManager, libs, "gtk_widget_get_layout_manager", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetLimitEvents, libs, "gtk_widget_get_limit_events", "GTK", "4.18")
+	core.PuregoRegisterSince(&xWidgetGetMapped, libs, "gtk_widget_get_mapped", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetMarginBottom, libs, "gtk_widget_get_margin_bottom", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetMarginEnd, libs, "gtk_widget_get_margin_end", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetMarginStart, libs, "gtk_widget_get_margin_start", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetMarginTop, libs, "gtk_widget_get_margin_top", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetName, libs, "gtk_widget_get_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetNative, libs, "gtk_widget_get_native", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetNextSibling, libs, "gtk_widget_get_next_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetOpacity, libs, "gtk_widget_get_opacity", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetOverflow, libs, "gtk_widget_get_overflow", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetPangoContext, libs, "gtk_widget_get_pango_context", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetParent, libs, "gtk_widget_get_parent", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetPreferredSize, libs, "gtk_widget_get_preferred_size", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetPrevSibling, libs, "gtk_widget_get_prev_sibling", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetPrimaryClipboard, libs, "gtk_widget_get_primary_clipboard", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetRealized, libs, "gtk_widget_get_realized", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetReceivesDefault, libs, "gtk_widget_get_receives_default", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetRequestMode, libs, "gtk_widget_get_request_mode", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetRoot, libs, "gtk_widget_get_root", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetScaleFactor, libs, "gtk_widget_get_scale_factor", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetSensitive, libs, "gtk_widget_get_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetSettings, libs, "gtk_widget_get_settings", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetSize, libs, "gtk_widget_get_size", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetSizeRequest, libs, "gtk_widget_get_size_request", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetStateFlags, libs, "gtk_widget_get_state_flags", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetTemplateChild, libs, "gtk_widget_get_template_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetTooltipMarkup, libs, "gtk_widget_get_tooltip_markup", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetTooltipText, libs, "gtk_widget_get_tooltip_text", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetValign, libs, "gtk_widget_get_valign", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetVexpand, libs, "gtk_widget_get_vexpand", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetVexpandSet, libs, "gtk_widget_get_vexpand_set", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetVisible, libs, "gtk_widget_get_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetWidth, libs, "gtk_widget_get_width", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGrabFocus, libs, "gtk_widget_grab_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetHasCssClass, libs, "gtk_widget_has_css_class", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetHasDefault, libs, "gtk_widget_has_default", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetHasFocus, libs, "gtk_widget_has_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetHasVisibleFocus, libs, "gtk_widget_has_visible_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetInDestruction, libs, "gtk_widget_in_destruction", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetInitTemplate, libs, "gtk_widget_init_template", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetInsertActionGroup, libs, "gtk_widget_insert_action_group", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetInsertAfter, libs, "gtk_widget_insert_after", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetInsertBefore, libs, "gtk_widget_insert_before", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetIsAncestor, libs, "gtk_widget_is_ancestor", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetIsDrawable, libs, "gtk_widget_is_drawable", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetIsFocus, libs, "gtk_widget_is_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetIsSensitive, libs, "gtk_widget_is_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetIsVisible, libs, "gtk_widget_is_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetKeynavFailed, libs, "gtk_widget_keynav_failed", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetListMnemonicLabels, libs, "gtk_widget_list_mnemonic_labels", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetMap, libs, "gtk_widget_map", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetMeasure, libs, "gtk_widget_measure", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetMnemonicActivate, libs, "gtk_widget_mnemonic_activate", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetObserveChildren, libs, "gtk_widget_observe_children", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetObserveControllers, libs, "gtk_widget_observe_controllers", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetPick, libs, "gtk_widget_pick", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetQueueAllocate, libs, "gtk_widget_queue_allocate", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetQueueDraw, libs, "gtk_widget_queue_draw", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetQueueResize, libs, "gtk_widget_queue_resize", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetRealize, libs, "gtk_widget_realize", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetRemoveController, libs, "gtk_widget_remove_controller", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetRemoveCssClass, libs, "gtk_widget_remove_css_class", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetRemoveMnemonicLabel, libs, "gtk_widget_remove_mnemonic_label", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetRemoveTickCallback, libs, "gtk_widget_remove_tick_callback", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetCanFocus, libs, "gtk_widget_set_can_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetCanTarget, libs, "gtk_widget_set_can_target", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetChildVisible, libs, "gtk_widget_set_child_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetCssClasses, libs, "gtk_widget_set_css_classes", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetCursor, libs, "gtk_widget_set_cursor", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetCursorFromName, libs, "gtk_widget_set_cursor_from_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetDirection, libs, "gtk_widget_set_direction", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetFocusChild, libs, "gtk_widget_set_focus_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetFocusOnClick, libs, "gtk_widget_set_focus_on_click", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetFocusable, libs, "gtk_widget_set_focusable", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetFontMap, libs, "gtk_widget_set_font_map", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetHalign, libs, "gtk_widget_set_halign", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetHasTooltip, libs, "gtk_widget_set_has_tooltip", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetHexpand, libs, "gtk_widget_set_hexpand", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetHexpandSet, libs, "gtk_widget_set_hexpand_set", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetLayoutManager, libs, "gtk_widget_set_layout_manager", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetLimitEvents, libs, "gtk_widget_set_limit_events", "GTK", "4.18")
+	core.PuregoRegisterSince(&xWidgetSetMarginBottom, libs, "gtk_widget_set_margin_bottom", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetMarginEnd, libs, "gtk_widget_set_margin_end", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetMarginStart, libs, "gtk_widget_set_margin_start", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetMarginTop, libs, "gtk_widget_set_margin_top", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetName, libs, "gtk_widget_set_name", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetOpacity, libs, "gtk_widget_set_opacity", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetOverflow, libs, "gtk_widget_set_overflow", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetParent, libs, "gtk_widget_set_parent", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetReceivesDefault, libs, "gtk_widget_set_receives_default", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetSensitive, libs, "gtk_widget_set_sensitive", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetSizeRequest, libs, "gtk_widget_set_size_request", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetStateFlags, libs, "gtk_widget_set_state_flags", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetTooltipMarkup, libs, "gtk_widget_set_tooltip_markup", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetTooltipText, libs, "gtk_widget_set_tooltip_text", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetValign, libs, "gtk_widget_set_valign", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetVexpand, libs, "gtk_widget_set_vexpand", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetVexpandSet, libs, "gtk_widget_set_vexpand_set", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetVisible, libs, "gtk_widget_set_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetShouldLayout, libs, "gtk_widget_should_layout", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSizeAllocate, libs, "gtk_widget_size_allocate", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSnapshotChild, libs, "gtk_widget_snapshot_child", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetTriggerTooltipQuery, libs, "gtk_widget_trigger_tooltip_query", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetUnmap, libs, "gtk_widget_unmap", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetUnparent, libs, "gtk_widget_unparent", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetUnrealize, libs, "gtk_widget_unrealize", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetUnsetStateFlags, libs, "gtk_widget_unset_state_flags", "GTK", "")
+
+	core.PuregoRegisterSince(&xWidgetGetDefaultDirection, libs, "gtk_widget_get_default_direction", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetDefaultDirection, libs, "gtk_widget_set_default_direction", "GTK", "")
 
 }
@@ -0,0 +1,203 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var xWidgetGetAllocatedBaseline func(uintptr) int
+
+// Returns the baseline that has currently been allocated to the widget.
+//
+// This function is intended to be used when implementing handlers
+// for the `GtkWidget`Class.snapshot() function, and when allocating
+// child widgets in `GtkWidget`Class.size_allocate().
+//
+// Deprecated: since 4.12. Use [Widget.GetBaseline] instead
+func (x *Widget) GetAllocatedBaseline() int {
+
+	cret := xWidgetGetAllocatedBaseline(x.GoPointer())
+	return cret
+}
+
+var xWidgetGetAllocatedHeight func(uintptr) int
+
+// Returns the height that has currently been allocated to the widget.
+//
+// To learn more about widget sizes, see the coordinate
+// system overview.
+//
+// Deprecated: since 4.12. Use [Widget.GetHeight] instead
+func (x *Widget) GetAllocatedHeight() int {
+
+	cret := xWidgetGetAllocatedHeight(x.GoPointer())
+	return cret
+}
+
+var xWidgetGetAllocatedWidth func(uintptr) int
+
+// Returns the width that has currently been allocated to the widget.
+//
+// To learn more about widget sizes, see the coordinate
+// system overview.
+//
+// Deprecated: since 4.12. Use [Widget.GetWidth] instead
+func (x *Widget) GetAllocatedWidth() int {
+
+	cret := xWidgetGetAllocatedWidth(x.GoPointer())
+	return cret
+}
+
+var xWidgetGetAllocation func(uintptr, *Allocation)
+
+// Retrieves the widget’s allocation.
+//
+// Note, when implementing a layout widget: a widget’s allocation
+// will be its “adjusted” allocation, that is, the widget’s parent
+// typically calls [Widget.SizeAllocate] with an allocation,
+// and that allocation is then adjusted (to handle margin
+// and alignment for example) before assignment to the widget.
+// [Widget.GetAllocation] returns the adjusted allocation that
+// was actually assigned to the widget. The adjusted allocation is
+// guaranteed to be completely contained within the
+// [Widget.SizeAllocate] allocation, however.
+//
+// So a layout widget is guaranteed that its children stay inside
+// the assigned bounds, but not that they have exactly the bounds the
+// widget assigned.
+//
+// Deprecated: since 4.12. Use [Widget.ComputeBounds],
+// [Widget.GetWidth] or [Widget.GetHeight] instead.
+func (x *Widget) GetAllocation(AllocationVar *Allocation) {
+
+	xWidgetGetAllocation(x.GoPointer(), AllocationVar)
+
+}
+
+var xWidgetGetFontOptions func(uintptr) *cairo.FontOptions
+
+// Returns the `cairo_font_options_t` of the widget.
+//
+// Seee [Widget.SetFontOptions].
+//
+// Deprecated: since 4.16. do not use it in new code.
+func (x *Widget) GetFontOptions() *cairo.FontOptions {
+
+	cret := xWidgetGetFontOptions(x.GoPointer())
+	return cret
+}
+
+var xWidgetGetStyleContext func(uintptr) uintptr
+
+// Returns the style context associated to the widget.
+//
+// The returned object is guaranteed to be the same
+// for the lifetime of widget.
+//
+// Deprecated: since 4.10. Style contexts will be removed in GTK 5
+func (x *Widget) GetStyleContext() *StyleContext {
+	var cls *StyleContext
+
+	cret := xWidgetGetStyleContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &StyleContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xWidgetHide func(uintptr)
+
+// Reverses the effects of [method.Gtk.Widget.show].
+//
+// This is causing the widget to be hidden (invisible to the user).
+//
+// Deprecated: since 4.10. Use [Widget.SetVisible] instead
+func (x *Widget) Hide() {
+
+	xWidgetHide(x.GoPointer())
+
+}
+
+var xWidgetSetFontOptions func(uintptr, *cairo.FontOptions)
+
+// Sets the `cairo_font_options_t` used for text rendering
+// in the widget.
+//
+// When not set, the default font options for the `GdkDisplay`
+// will be used.
+//
+// Deprecated: since 4.16. do not use it in new code.
+func (x *Widget) SetFontOptions(OptionsVar *cairo.FontOptions) {
+
+	xWidgetSetFontOptions(x.GoPointer(), OptionsVar)
+
+}
+
+var xWidgetShow func(uintptr)
+
+// Flags a widget to be displayed.
+//
+// Any widget that isn’t shown will not appear on the screen.
+//
+// Remember that you have to show the containers containing a widget,
+// in addition to the widget itself, before it will appear onscreen.
+//
+// When a toplevel widget is shown, it is immediately realized and
+// mapped; other shown widgets are realized and mapped when their
+// toplevel widget is realized and mapped.
+//
+// Deprecated: since 4.10. Use [Widget.SetVisible] instead
+func (x *Widget) Show() {
+
+	xWidgetShow(x.GoPointer())
+
+}
+
+var xWidgetTranslateCoordinates func(uintptr, uintptr, float64, float64, *float64, *float64) bool
+
+// Translates coordinates relative to src_widget’s allocation
+// to coordinates relative to dest_widget’s allocations.
+//
+// In order to perform this operation, both widget must share
+// a common ancestor. If that is not the case, dest_x and dest_y
+// are set to 0 and false is returned.
+//
+// Deprecated: since 4.12. Use [Widget.ComputePoint] instead
+func (x *Widget) TranslateCoordinates(DestWidgetVar *Widget, SrcXVar float64, SrcYVar float64, DestXVar *float64, DestYVar *float64) bool {
+
+	cret := xWidgetTranslateCoordinates(x.GoPointer(), DestWidgetVar.GoPointer(), SrcXVar, SrcYVar, DestXVar, DestYVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xWidgetGetAllocatedBaseline, libs, "gtk_widget_get_allocated_baseline", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetAllocatedHeight, libs, "gtk_widget_get_allocated_height", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetAllocatedWidth, libs, "gtk_widget_get_allocated_width", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetAllocation, libs, "gtk_widget_get_allocation", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetFontOptions, libs, "gtk_widget_get_font_options", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetGetStyleContext, libs, "gtk_widget_get_style_context", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetHide, libs, "gtk_widget_hide", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetSetFontOptions, libs, "gtk_widget_set_font_options", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetShow, libs, "gtk_widget_show", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetTranslateCoordinates, libs, "gtk_widget_translate_coordinates", "GTK", "")
+
+}
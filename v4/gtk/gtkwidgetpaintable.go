@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -30,16 +29,16 @@ func (x *WidgetPaintableClass) GoPointer() uintptr {
 // report no size in that case.
 //
 // Of course, `GtkWidgetPaintable` allows you to monitor widgets for size
-// changes by emitting the [signal@Gdk.Paintable::invalidate-size] signal
+// changes by emitting the Paintable::invalidate-size signal
 // whenever the size of the widget changes as well as for visual changes by
-// emitting the [signal@Gdk.Paintable::invalidate-contents] signal whenever
+// emitting the Paintable::invalidate-contents signal whenever
 // the widget changes.
 //
 // You can use a `GtkWidgetPaintable` everywhere a `GdkPaintable` is allowed,
 // including using it on a `GtkPicture` (or one of its parents) that it was
-// set on itself via gtk_picture_set_paintable(). The paintable will take care
+// set on itself via [Picture.SetPaintable]. The paintable will take care
 // of recursion when this happens. If you do this however, ensure that the
-// [property@Gtk.Picture:can-shrink] property is set to %TRUE or you might
+// [Picture.GetPropertyCanShrink] property is set to true or you might
 // end up with an infinitely growing widget.
 type WidgetPaintable struct {
 	gobject.Object
@@ -75,7 +74,7 @@ func NewWidgetPaintable(WidgetVar *Widget) *WidgetPaintable {
 
 var xWidgetPaintableGetWidget func(uintptr) uintptr
 
-// Returns the widget that is observed or %NULL if none.
+// Returns the widget that is observed or nil if none.
 func (x *WidgetPaintable) GetWidget() *Widget {
 	var cls *Widget
 
@@ -113,25 +112,27 @@ func (c *WidgetPaintable) SetGoPointer(ptr uintptr) {
 // Compute a concrete size for the `GdkPaintable`.
 //
 // Applies the sizing algorithm outlined in the
-// [CSS Image spec](https://drafts.csswg.org/css-images-3/#default-sizing)
-// to the given @paintable. See that link for more details.
+// [CSS Image spec]
+// to the given paintable. See that link for more details.
 //
-// It is not necessary to call this function when both @specified_width
-// and @specified_height are known, but it is useful to call this
+// It is not necessary to call this function when both specified_width
+// and specified_height are known, but it is useful to call this
 // function in GtkWidget:measure implementations to compute the
 // other dimension when only one dimension is given.
+//
+// [CSS Image spec]: https://drafts.csswg.org/css-images-3/#default-sizing
 func (x *WidgetPaintable) ComputeConcreteSize(SpecifiedWidthVar float64, SpecifiedHeightVar float64, DefaultWidthVar float64, DefaultHeightVar float64, ConcreteWidthVar *float64, ConcreteHeightVar *float64) {
 
 	gdk.XGdkPaintableComputeConcreteSize(x.GoPointer(), SpecifiedWidthVar, SpecifiedHeightVar, DefaultWidthVar, DefaultHeightVar, ConcreteWidthVar, ConcreteHeightVar)
 
 }
 
-// Gets an immutable paintable for the current contents displayed by @paintable.
+// Gets an immutable paintable for the current contents displayed by paintable.
 //
 // This is useful when you want to retain the current state of an animation,
 // for example to take a screenshot of a running animation.
 //
-// If the @paintable is already immutable, it will return itself.
+// If the paintable is already immutable, it will return itself.
 func (x *WidgetPaintable) GetCurrentImage() *gdk.PaintableBase {
 	var cls *gdk.PaintableBase
 
@@ -149,29 +150,29 @@ func (x *WidgetPaintable) GetCurrentImage() *gdk.PaintableBase {
 //
 // This is oftentimes useful for optimizations.
 //
-// See [flags@Gdk.PaintableFlags] for the flags and what they mean.
+// See [gdk.PaintableFlags] for the flags and what they mean.
 func (x *WidgetPaintable) GetFlags() gdk.PaintableFlags {
 
 	cret := gdk.XGdkPaintableGetFlags(x.GoPointer())
 	return cret
 }
 
-// Gets the preferred aspect ratio the @paintable would like to be displayed at.
+// Gets the preferred aspect ratio the paintable would like to be displayed at.
 //
 // The aspect ratio is the width divided by the height, so a value of 0.5
-// means that the @paintable prefers to be displayed twice as high as it
+// means that the paintable prefers to be displayed twice as high as it
 // is wide. Consumers of this interface can use this to preserve aspect
 // ratio when displaying the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// Usually when a @paintable returns nonzero values from
-// [method@Gdk.Paintable.get_intrinsic_width] and
-// [method@Gdk.Paintable.get_intrinsic_height] the aspect ratio
+// Usually when a paintable returns nonzero values from
+// [gdk.Paintable.GetIntrinsicWidth] and
+// [gdk.Paintable.GetIntrinsicHeight] the aspect ratio
 // should conform to those values, though that is not required.
 //
-// If the @paintable does not have a preferred aspect ratio,
+// If the paintable does not have a preferred aspect ratio,
 // it returns 0. Negative values are never returned.
 func (x *WidgetPaintable) GetIntrinsicAspectRatio() float64 {
 
@@ -179,15 +180,15 @@ func (x *WidgetPaintable) GetIntrinsicAspectRatio() float64 {
 	return cret
 }
 
-// Gets the preferred height the @paintable would like to be displayed at.
+// Gets the preferred height the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred height, it returns 0.
+// If the paintable does not have a preferred height, it returns 0.
 // Negative values are never returned.
 func (x *WidgetPaintable) GetIntrinsicHeight() int {
 
@@ -195,15 +196,15 @@ func (x *WidgetPaintable) GetIntrinsicHeight() int {
 	return cret
 }
 
-// Gets the preferred width the @paintable would like to be displayed at.
+// Gets the preferred width the paintable would like to be displayed at.
 //
 // Consumers of this interface can use this to reserve enough space to draw
 // the paintable.
 //
 // This is a purely informational value and does not in any way limit the
-// values that may be passed to [method@Gdk.Paintable.snapshot].
+// values that may be passed to [gdk.Paintable.Snapshot].
 //
-// If the @paintable does not have a preferred width, it returns 0.
+// If the paintable does not have a preferred width, it returns 0.
 // Negative values are never returned.
 func (x *WidgetPaintable) GetIntrinsicWidth() int {
 
@@ -214,12 +215,12 @@ func (x *WidgetPaintable) GetIntrinsicWidth() int {
 // Called by implementations of `GdkPaintable` to invalidate their contents.
 //
 // Unless the contents are invalidated, implementations must guarantee that
-// multiple calls of [method@Gdk.Paintable.snapshot] produce the same output.
+// multiple calls of [gdk.Paintable.Snapshot] produce the same output.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-contents]
+// This function will emit the Paintable::invalidate-contents
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_CONTENTS flag,
+// If a paintable reports the [gdk.PaintableStaticContentsValue] flag,
 // it must not call this function.
 func (x *WidgetPaintable) InvalidateContents() {
 
@@ -229,13 +230,13 @@ func (x *WidgetPaintable) InvalidateContents() {
 
 // Called by implementations of `GdkPaintable` to invalidate their size.
 //
-// As long as the size is not invalidated, @paintable must return the same
+// As long as the size is not invalidated, paintable must return the same
 // values for its intrinsic width, height and aspect ratio.
 //
-// This function will emit the [signal@Gdk.Paintable::invalidate-size]
+// This function will emit the Paintable::invalidate-size
 // signal.
 //
-// If a @paintable reports the %GDK_PAINTABLE_STATIC_SIZE flag,
+// If a paintable reports the [gdk.PaintableStaticSizeValue] flag,
 // it must not call this function.
 func (x *WidgetPaintable) InvalidateSize() {
 
@@ -243,10 +244,10 @@ func (x *WidgetPaintable) InvalidateSize() {
 
 }
 
-// Snapshots the given paintable with the given @width and @height.
+// Snapshots the given paintable with the given width and height.
 //
-// The paintable is drawn at the current (0,0) offset of the @snapshot.
-// If @width and @height are not larger than zero, this function will
+// The paintable is drawn at the current (0,0) offset of the snapshot.
+// If width and height are not larger than zero, this function will
 // do nothing.
 func (x *WidgetPaintable) Snapshot(SnapshotVar *gdk.Snapshot, WidthVar float64, HeightVar float64) {
 
@@ -259,7 +260,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -268,9 +269,11 @@ func init() {
 
 	core.PuregoSafeRegister(&xWidgetPaintableGLibType, libs, "gtk_widget_paintable_get_type")
 
-	core.PuregoSafeRegister(&xNewWidgetPaintable, libs, "gtk_widget_paintable_new")
+	gobject.CheckClassSize("GtkWidgetPaintableClass", xWidgetPaintableGLibType, unsafe.Sizeof(WidgetPaintableClass{}))
+
+	core.PuregoRegisterSince(&xNewWidgetPaintable, libs, "gtk_widget_paintable_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xWidgetPaintableGetWidget, libs, "gtk_widget_paintable_get_widget")
-	core.PuregoSafeRegister(&xWidgetPaintableSetWidget, libs, "gtk_widget_paintable_set_widget")
+	core.PuregoRegisterSince(&xWidgetPaintableGetWidget, libs, "gtk_widget_paintable_get_widget", "GTK", "")
+	core.PuregoRegisterSince(&xWidgetPaintableSetWidget, libs, "gtk_widget_paintable_set_widget", "GTK", "")
 
 }
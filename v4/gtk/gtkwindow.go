@@ -2,10 +2,10 @@
 package gtk
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
@@ -43,7 +43,8 @@ func (x *WindowClass) OverrideActivateFocus(cb func(*Window)) {
 	if cb == nil {
 		x.xActivateFocus = 0
 	} else {
-		x.xActivateFocus = purego.NewCallback(func(WindowVarp uintptr) {
+		x.xActivateFocus = core.NewCallback(func(WindowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -56,7 +57,7 @@ func (x *WindowClass) GetActivateFocus() func(*Window) {
 		return nil
 	}
 	var rawCallback func(WindowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivateFocus)
+	core.RegisterFunc(&rawCallback, x.xActivateFocus)
 	return func(WindowVar *Window) {
 		rawCallback(WindowVar.GoPointer())
 	}
@@ -68,7 +69,8 @@ func (x *WindowClass) OverrideActivateDefault(cb func(*Window)) {
 	if cb == nil {
 		x.xActivateDefault = 0
 	} else {
-		x.xActivateDefault = purego.NewCallback(func(WindowVarp uintptr) {
+		x.xActivateDefault = core.NewCallback(func(WindowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -81,7 +83,7 @@ func (x *WindowClass) GetActivateDefault() func(*Window) {
 		return nil
 	}
 	var rawCallback func(WindowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xActivateDefault)
+	core.RegisterFunc(&rawCallback, x.xActivateDefault)
 	return func(WindowVar *Window) {
 		rawCallback(WindowVar.GoPointer())
 	}
@@ -95,7 +97,8 @@ func (x *WindowClass) OverrideKeysChanged(cb func(*Window)) {
 	if cb == nil {
 		x.xKeysChanged = 0
 	} else {
-		x.xKeysChanged = purego.NewCallback(func(WindowVarp uintptr) {
+		x.xKeysChanged = core.NewCallback(func(WindowVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -110,7 +113,7 @@ func (x *WindowClass) GetKeysChanged() func(*Window) {
 		return nil
 	}
 	var rawCallback func(WindowVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xKeysChanged)
+	core.RegisterFunc(&rawCallback, x.xKeysChanged)
 	return func(WindowVar *Window) {
 		rawCallback(WindowVar.GoPointer())
 	}
@@ -124,7 +127,8 @@ func (x *WindowClass) OverrideEnableDebugging(cb func(*Window, bool) bool) {
 	if cb == nil {
 		x.xEnableDebugging = 0
 	} else {
-		x.xEnableDebugging = purego.NewCallback(func(WindowVarp uintptr, ToggleVarp bool) bool {
+		x.xEnableDebugging = core.NewCallback(func(WindowVarp uintptr, ToggleVarp bool) bool {
+			defer core.RecoverPanic()
 			return cb(WindowNewFromInternalPtr(WindowVarp), ToggleVarp)
 		})
 	}
@@ -139,7 +143,7 @@ func (x *WindowClass) GetEnableDebugging() func(*Window, bool) bool {
 		return nil
 	}
 	var rawCallback func(WindowVarp uintptr, ToggleVarp bool) bool
-	purego.RegisterFunc(&rawCallback, x.xEnableDebugging)
+	core.RegisterFunc(&rawCallback, x.xEnableDebugging)
 	return func(WindowVar *Window, ToggleVar bool) bool {
 		return rawCallback(WindowVar.GoPointer(), ToggleVar)
 	}
@@ -150,7 +154,8 @@ func (x *WindowClass) OverrideCloseRequest(cb func(*Window) bool) {
 	if cb == nil {
 		x.xCloseRequest = 0
 	} else {
-		x.xCloseRequest = purego.NewCallback(func(WindowVarp uintptr) bool {
+		x.xCloseRequest = core.NewCallback(func(WindowVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(WindowNewFromInternalPtr(WindowVarp))
 		})
 	}
@@ -162,7 +167,7 @@ func (x *WindowClass) GetCloseRequest() func(*Window) bool {
 		return nil
 	}
 	var rawCallback func(WindowVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xCloseRequest)
+	core.RegisterFunc(&rawCallback, x.xCloseRequest)
 	return func(WindowVar *Window) bool {
 		return rawCallback(WindowVar.GoPointer())
 	}
@@ -226,37 +231,141 @@ const (
 	WindowGravityBottomEndValue WindowGravity = 14
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x WindowGravity) String() string {
+	switch x {
+	case WindowGravityTopLeftValue:
+		return "GTK_WINDOW_GRAVITY_TOP_LEFT"
+	case WindowGravityTopValue:
+		return "GTK_WINDOW_GRAVITY_TOP"
+	case WindowGravityTopRightValue:
+		return "GTK_WINDOW_GRAVITY_TOP_RIGHT"
+	case WindowGravityLeftValue:
+		return "GTK_WINDOW_GRAVITY_LEFT"
+	case WindowGravityCenterValue:
+		return "GTK_WINDOW_GRAVITY_CENTER"
+	case WindowGravityRightValue:
+		return "GTK_WINDOW_GRAVITY_RIGHT"
+	case WindowGravityBottomLeftValue:
+		return "GTK_WINDOW_GRAVITY_BOTTOM_LEFT"
+	case WindowGravityBottomValue:
+		return "GTK_WINDOW_GRAVITY_BOTTOM"
+	case WindowGravityBottomRightValue:
+		return "GTK_WINDOW_GRAVITY_BOTTOM_RIGHT"
+	case WindowGravityTopStartValue:
+		return "GTK_WINDOW_GRAVITY_TOP_START"
+	case WindowGravityTopEndValue:
+		return "GTK_WINDOW_GRAVITY_TOP_END"
+	case WindowGravityStartValue:
+		return "GTK_WINDOW_GRAVITY_START"
+	case WindowGravityEndValue:
+		return "GTK_WINDOW_GRAVITY_END"
+	case WindowGravityBottomStartValue:
+		return "GTK_WINDOW_GRAVITY_BOTTOM_START"
+	case WindowGravityBottomEndValue:
+		return "GTK_WINDOW_GRAVITY_BOTTOM_END"
+	}
+	return fmt.Sprintf("WindowGravity(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x WindowGravity) Nick() string {
+	switch x {
+	case WindowGravityTopLeftValue:
+		return "top-left"
+	case WindowGravityTopValue:
+		return "top"
+	case WindowGravityTopRightValue:
+		return "top-right"
+	case WindowGravityLeftValue:
+		return "left"
+	case WindowGravityCenterValue:
+		return "center"
+	case WindowGravityRightValue:
+		return "right"
+	case WindowGravityBottomLeftValue:
+		return "bottom-left"
+	case WindowGravityBottomValue:
+		return "bottom"
+	case WindowGravityBottomRightValue:
+		return "bottom-right"
+	case WindowGravityTopStartValue:
+		return "top-start"
+	case WindowGravityTopEndValue:
+		return "top-end"
+	case WindowGravityStartValue:
+		return "start"
+	case WindowGravityEndValue:
+		return "end"
+	case WindowGravityBottomStartValue:
+		return "bottom-start"
+	case WindowGravityBottomEndValue:
+		return "bottom-end"
+	}
+	return ""
+}
+
+// WindowGravityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func WindowGravityFromString(s string) (WindowGravity, bool) {
+	switch s {
+	case "GTK_WINDOW_GRAVITY_TOP_LEFT", "top-left":
+		return WindowGravityTopLeftValue, true
+	case "GTK_WINDOW_GRAVITY_TOP", "top":
+		return WindowGravityTopValue, true
+	case "GTK_WINDOW_GRAVITY_TOP_RIGHT", "top-right":
+		return WindowGravityTopRightValue, true
+	case "GTK_WINDOW_GRAVITY_LEFT", "left":
+		return WindowGravityLeftValue, true
+	case "GTK_WINDOW_GRAVITY_CENTER", "center":
+		return WindowGravityCenterValue, true
+	case "GTK_WINDOW_GRAVITY_RIGHT", "right":
+		return WindowGravityRightValue, true
+	case "GTK_WINDOW_GRAVITY_BOTTOM_LEFT", "bottom-left":
+		return WindowGravityBottomLeftValue, true
+	case "GTK_WINDOW_GRAVITY_BOTTOM", "bottom":
+		return WindowGravityBottomValue, true
+	case "GTK_WINDOW_GRAVITY_BOTTOM_RIGHT", "bottom-right":
+		return WindowGravityBottomRightValue, true
+	case "GTK_WINDOW_GRAVITY_TOP_START", "top-start":
+		return WindowGravityTopStartValue, true
+	case "GTK_WINDOW_GRAVITY_TOP_END", "top-end":
+		return WindowGravityTopEndValue, true
+	case "GTK_WINDOW_GRAVITY_START", "start":
+		return WindowGravityStartValue, true
+	case "GTK_WINDOW_GRAVITY_END", "end":
+		return WindowGravityEndValue, true
+	case "GTK_WINDOW_GRAVITY_BOTTOM_START", "bottom-start":
+		return WindowGravityBottomStartValue, true
+	case "GTK_WINDOW_GRAVITY_BOTTOM_END", "bottom-end":
+		return WindowGravityBottomEndValue, true
+	}
+	return 0, false
+}
+
 // A toplevel window which can contain other widgets.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="window-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkWindow" src="window.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // Windows normally have decorations that are under the control
 // of the windowing system and allow the user to manipulate the window
 // (resize it, move it, close it,...).
 //
 // # GtkWindow as GtkBuildable
 //
-// The `GtkWindow` implementation of the [iface@Gtk.Buildable] interface supports
+// The `GtkWindow` implementation of the [Buildable] interface supports
 // setting a child as the titlebar by specifying “titlebar” as the “type”
-// attribute of a `&lt;child&gt;` element.
+// attribute of a `<child>` element.
 //
 // # Shortcuts and Gestures
 //
 // `GtkWindow` supports the following keyboard shortcuts:
 //
-// - &lt;kbd&gt;F10&lt;/kbd&gt; activates the menubar, if present.
-// - &lt;kbd&gt;Alt&lt;/kbd&gt; makes the mnemonics visible while pressed.
+// - F10 activates the menubar, if present.
+// - Alt makes the mnemonics visible while pressed.
 //
 // The following signals have default keybindings:
 //
-// - [signal@Gtk.Window::activate-default]
-// - [signal@Gtk.Window::activate-focus]
-// - [signal@Gtk.Window::enable-debugging]
+// - [Window.ConnectActivateDefaultFunc]
+// - [Window.ConnectActivateFocusFunc]
+// - [Window.ConnectEnableDebuggingFunc]
 //
 // # Actions
 //
@@ -269,11 +378,9 @@ const (
 //
 // # CSS nodes
 //
-// ```
-// window.background [.csd / .solid-csd / .ssd] [.maximized / .fullscreen / .tiled]
-// ├── &lt;child&gt;
-// ╰── &lt;titlebar child&gt;.titlebar [.default-decoration]
-// ```
+//	window.background [.csd / .solid-csd / .ssd] [.maximized / .fullscreen / .tiled]
+//	├── <child>
+//	╰── <titlebar child>.titlebar [.default-decoration]
 //
 // `GtkWindow` has a main CSS node with name window and style class .background.
 //
@@ -299,9 +406,9 @@ const (
 //
 // # Accessibility
 //
-// `GtkWindow` uses the [enum@Gtk.AccessibleRole.window] role.
+// `GtkWindow` uses the [AccessibleRoleWindowValue] role.
 //
-// From GTK 4.12 to 4.18, it used the [enum@Gtk.AccessibleRole.application] role.
+// From GTK 4.12 to 4.18, it used the [AccessibleRoleApplicationValue] role.
 type Window struct {
 	Widget
 }
@@ -323,15 +430,15 @@ var xNewWindow func() uintptr
 // Creates a new `GtkWindow`.
 //
 // To get an undecorated window (without window borders),
-// use [method@Gtk.Window.set_decorated].
+// use [Window.SetDecorated].
 //
 // All top-level windows created by this function are stored
 // in an internal top-level window list. This list can be obtained
-// from [func@Gtk.Window.list_toplevels]. Due to GTK keeping a
+// from [WindowListToplevels]. Due to GTK keeping a
 // reference to the window internally, this function does not
 // return a reference to the caller.
 //
-// To delete a `GtkWindow`, call [method@Gtk.Window.destroy].
+// To delete a `GtkWindow`, call [Window.Destroy].
 func NewWindow() *Window {
 	var cls *Window
 
@@ -383,8 +490,8 @@ var xWindowFullscreen func(uintptr)
 // it is shown, the initial state is at the window managers discretion.
 //
 // You can track the result of this operation via the
-// [property@Gdk.Toplevel:state] property, or by listening to
-// notifications of the [property@Gtk.Window:fullscreened] property.
+// Toplevel:state property, or by listening to
+// notifications of the [Window.GetPropertyFullscreened] property.
 func (x *Window) Fullscreen() {
 
 	xWindowFullscreen(x.GoPointer())
@@ -400,8 +507,8 @@ var xWindowFullscreenOnMonitor func(uintptr, uintptr)
 // any given monitor.
 //
 // You can track the result of this operation via the
-// [property@Gdk.Toplevel:state] property, or by listening to
-// notifications of the [property@Gtk.Window:fullscreened] property.
+// Toplevel:state property, or by listening to
+// notifications of the [Window.GetPropertyFullscreened] property.
 func (x *Window) FullscreenOnMonitor(MonitorVar *gdk.Monitor) {
 
 	xWindowFullscreenOnMonitor(x.GoPointer(), MonitorVar.GoPointer())
@@ -469,7 +576,7 @@ func (x *Window) GetDefaultSize(WidthVar *int, HeightVar *int) {
 
 var xWindowGetDefaultWidget func(uintptr) uintptr
 
-// Returns the default widget for @window.
+// Returns the default widget for window.
 func (x *Window) GetDefaultWidget() *Widget {
 	var cls *Widget
 
@@ -563,7 +670,7 @@ func (x *Window) GetGroup() *WindowGroup {
 
 var xWindowGetHandleMenubarAccel func(uintptr) bool
 
-// Returns whether this window reacts to &lt;kbd&gt;F10&lt;/kbd&gt;
+// Returns whether this window reacts to F10
 // presses by activating a menubar it contains.
 func (x *Window) GetHandleMenubarAccel() bool {
 
@@ -629,7 +736,7 @@ func (x *Window) GetTitle() string {
 var xWindowGetTitlebar func(uintptr) uintptr
 
 // Returns the titlebar that has been set with
-// [method@Gtk.Window.set_titlebar].
+// [Window.SetTitlebar].
 func (x *Window) GetTitlebar() *Widget {
 	var cls *Widget
 
@@ -676,7 +783,7 @@ var xWindowIsActive func(uintptr) bool
 //
 // The active toplevel is the window receiving keystrokes.
 //
-// The return value is %TRUE if the window is active toplevel itself.
+// The return value is true if the window is active toplevel itself.
 // You might use this function if you wanted to draw a widget
 // differently in an active window from a widget in an inactive window.
 func (x *Window) IsActive() bool {
@@ -693,7 +800,7 @@ var xWindowIsFullscreen func(uintptr) bool
 // manager and happens asynchronously to an application request, you
 // shouldn’t assume the return value of this function changing
 // immediately (or at all), as an effect of calling
-// [method@Gtk.Window.fullscreen] or [method@Gtk.Window.unfullscreen].
+// [Window.Fullscreen] or [Window.Unfullscreen].
 //
 // If the window isn't yet mapped, the value returned will whether the
 // initial requested state is fullscreen.
@@ -711,7 +818,7 @@ var xWindowIsMaximized func(uintptr) bool
 // manager and happens asynchronously to an application request, you
 // shouldn’t assume the return value of this function changing
 // immediately (or at all), as an effect of calling
-// [method@Gtk.Window.maximize] or [method@Gtk.Window.unmaximize].
+// [Window.Maximize] or [Window.Unmaximize].
 //
 // If the window isn't yet mapped, the value returned will whether the
 // initial requested state is maximized.
@@ -753,8 +860,8 @@ var xWindowMaximize func(uintptr)
 // screen.
 //
 // You can track the result of this operation via the
-// [property@Gdk.Toplevel:state] property, or by listening to
-// notifications on the [property@Gtk.Window:maximized]
+// Toplevel:state property, or by listening to
+// notifications on the [Window.GetPropertyMaximized]
 // property.
 func (x *Window) Maximize() {
 
@@ -777,7 +884,7 @@ var xWindowMinimize func(uintptr)
 // onscreen.
 //
 // You can track result of this operation via the
-// [property@Gdk.Toplevel:state] property.
+// Toplevel:state property.
 func (x *Window) Minimize() {
 
 	xWindowMinimize(x.GoPointer())
@@ -793,42 +900,27 @@ var xWindowPresent func(uintptr)
 // giving it the keyboard focus (possibly dependent on the user’s
 // platform, window manager and preferences).
 //
-// If @window is hidden, this function also makes it visible.
+// If window is hidden, this function also makes it visible.
 func (x *Window) Present() {
 
 	xWindowPresent(x.GoPointer())
 
 }
 
-var xWindowPresentWithTime func(uintptr, uint32)
-
-// Presents a window to the user in response to an user interaction.
-//
-// See [method@Gtk.Window.present] for more details.
-//
-// The timestamp should be gathered when the window was requested
-// to be shown (when clicking a link for example), rather than once
-// the window is ready to be shown.
-func (x *Window) PresentWithTime(TimestampVar uint32) {
-
-	xWindowPresentWithTime(x.GoPointer(), TimestampVar)
-
-}
-
 var xWindowSetApplication func(uintptr, uintptr)
 
 // Sets or unsets the application object associated with the window.
 //
 // The application will be kept alive for at least as long as it has
-// any windows associated with it (see [method@Gio.Application.hold]
+// any windows associated with it (see [gio.Application.Hold]
 // for a way to keep it alive without windows).
 //
 // Normally, the connection between the application and the window will
 // remain until the window is destroyed, but you can explicitly remove
-// it by setting the @application to %NULL.
+// it by setting the application to nil.
 //
-// This is equivalent to calling [method@Gtk.Application.remove_window]
-// and/or [method@Gtk.Application.add_window] on the old/new applications
+// This is equivalent to calling [Application.RemoveWindow]
+// and/or [Application.AddWindow] on the old/new applications
 // as relevant.
 func (x *Window) SetApplication(ApplicationVar *Application) {
 
@@ -856,7 +948,7 @@ var xWindowSetDecorated func(uintptr, bool)
 // convince the window manager not to decorate the window. Depending on
 // the system, this function may not have any effect when called on a
 // window that is already visible, so you should call it before calling
-// [method@Gtk.Widget.show].
+// [Widget.Show].
 //
 // On Windows, this function always works, since there’s no window manager
 // policy involved.
@@ -880,12 +972,12 @@ var xWindowSetDefaultSize func(uintptr, int, int)
 // If the window’s minimum size request is larger than
 // the default, the default will be ignored.
 //
-// Setting the default size to a value &lt;= 0 will cause it to be
+// Setting the default size to a value <= 0 will cause it to be
 // ignored and the natural size request will be used instead. It
 // is possible to do this while the window is showing to "reset"
 // it to its initial size.
 //
-// Unlike [method@Gtk.Widget.set_size_request], which sets a size
+// Unlike [Widget.SetSizeRequest], which sets a size
 // request for a widget and thus would keep users from shrinking
 // the window, this function only sets the initial size, just as
 // if the user had resized the window themselves. Users can still
@@ -895,7 +987,7 @@ var xWindowSetDefaultSize func(uintptr, int, int)
 //
 // If you use this function to reestablish a previously saved window size,
 // note that the appropriate size to save is the one returned by
-// [method@Gtk.Window.get_default_size]. Using the window allocation
+// [Window.GetDefaultSize]. Using the window allocation
 // directly will not work in all circumstances and can lead to growing
 // or shrinking windows.
 func (x *Window) SetDefaultSize(WidthVar int, HeightVar int) {
@@ -909,7 +1001,7 @@ var xWindowSetDefaultWidget func(uintptr, uintptr)
 // Sets the default widget.
 //
 // The default widget is the widget that is activated
-// when the user presses &lt;kbd&gt;Enter&lt;/kbd&gt; in a dialog
+// when the user presses Enter in a dialog
 // (for example).
 func (x *Window) SetDefaultWidget(DefaultWidgetVar *Widget) {
 
@@ -927,7 +1019,7 @@ var xWindowSetDeletable func(uintptr, bool)
 // will do its best to convince the window manager not to show a
 // close button. Depending on the system, this function may not
 // have any effect when called on a window that is already visible,
-// so you should call it before calling [method@Gtk.Widget.show].
+// so you should call it before calling [Widget.Show].
 //
 // On Windows, this function always works, since there’s no window
 // manager policy involved.
@@ -965,11 +1057,11 @@ var xWindowSetFocus func(uintptr, uintptr)
 
 // Sets the focus widget.
 //
-// If @focus is not the current focus widget, and is focusable,
-// sets it as the focus widget for the window. If @focus is %NULL,
+// If focus is not the current focus widget, and is focusable,
+// sets it as the focus widget for the window. If focus is nil,
 // unsets the focus widget for this window. To set the focus to a
 // particular widget in the toplevel, it is usually more convenient
-// to use [method@Gtk.Widget.grab_focus] instead of this function.
+// to use [Widget.GrabFocus] instead of this function.
 func (x *Window) SetFocus(FocusVar *Widget) {
 
 	xWindowSetFocus(x.GoPointer(), FocusVar.GoPointer())
@@ -999,7 +1091,7 @@ func (x *Window) SetGravity(GravityVar WindowGravity) {
 
 var xWindowSetHandleMenubarAccel func(uintptr, bool)
 
-// Sets whether this window should react to &lt;kbd&gt;F10&lt;/kbd&gt;
+// Sets whether this window should react to F10
 // presses by activating a menubar it contains.
 func (x *Window) SetHandleMenubarAccel(HandleMenubarAccelVar bool) {
 
@@ -1021,7 +1113,7 @@ var xWindowSetIconName func(uintptr, uintptr)
 
 // Sets the icon for the window from a named themed icon.
 //
-// See the docs for [class@Gtk.IconTheme] for more details.
+// See the docs for [IconTheme] for more details.
 // On some platforms, the window icon is not used at all.
 //
 // Note that this has nothing to do with the WM_ICON_NAME
@@ -1053,7 +1145,7 @@ var xWindowSetModal func(uintptr, bool)
 //
 // Modal windows prevent interaction with other windows in the same
 // application. To keep modal dialogs on top of main application windows,
-// use [method@Gtk.Window.set_transient_for] to make the dialog transient
+// use [Window.SetTransientFor] to make the dialog transient
 // for the parent; most window managers will then disallow lowering the
 // dialog below the parent.
 func (x *Window) SetModal(ModalVar bool) {
@@ -1085,7 +1177,7 @@ var xWindowSetStartupId func(uintptr, string)
 // Normally, startup identifier is managed automatically and you should
 // only use this function in special cases like transferring focus from
 // other processes. You should use this function before calling
-// [method@Gtk.Window.present] or any equivalent function generating
+// [Window.Present] or any equivalent function generating
 // a window map event.
 //
 // This function is only useful on Wayland or X11, not with other GDK
@@ -1121,7 +1213,7 @@ var xWindowSetTitlebar func(uintptr, uintptr)
 
 // Sets a custom titlebar for the window.
 //
-// A typical widget used here is [class@Gtk.HeaderBar], as it
+// A typical widget used here is [HeaderBar], as it
 // provides various features expected of a titlebar while allowing
 // the addition of child widgets to it.
 //
@@ -1129,7 +1221,7 @@ var xWindowSetTitlebar func(uintptr, uintptr)
 // the window manager not to put its own titlebar on the window.
 // Depending on the system, this function may not work for a window
 // that is already visible, so you set the titlebar before calling
-// [method@Gtk.Widget.show].
+// [Widget.Show].
 func (x *Window) SetTitlebar(TitlebarVar *Widget) {
 
 	xWindowSetTitlebar(x.GoPointer(), TitlebarVar.GoPointer())
@@ -1143,11 +1235,11 @@ var xWindowSetTransientFor func(uintptr, uintptr)
 // Dialog windows should be set transient for the main application
 // window they were spawned from. This allows window managers to e.g.
 // keep the dialog on top of the main window, or center the dialog
-// over the main window. [ctor@Gtk.Dialog.new_with_buttons] and other
+// over the main window. [NewDialogWithButtons] and other
 // convenience functions in GTK will sometimes call this function on
 // your behalf.
 //
-// Passing `NULL` for @parent unsets the current transient window.
+// Passing `NULL` for parent unsets the current transient window.
 //
 // On Windows, this function puts the child window on top of the parent,
 // much as the window manager would have done on X.
@@ -1173,8 +1265,8 @@ var xWindowUnfullscreen func(uintptr)
 // it is shown, the initial state is at the window managers discretion.
 //
 // You can track the result of this operation via the
-// [property@Gdk.Toplevel:state] property, or by listening to
-// notifications of the [property@Gtk.Window:fullscreened] property.
+// Toplevel:state property, or by listening to
+// notifications of the [Window.GetPropertyFullscreened] property.
 func (x *Window) Unfullscreen() {
 
 	xWindowUnfullscreen(x.GoPointer())
@@ -1196,8 +1288,8 @@ var xWindowUnmaximize func(uintptr)
 // screen.
 //
 // You can track the result of this operation via the
-// [property@Gdk.Toplevel:state] property, or by listening to
-// notifications on the [property@Gtk.Window:maximized] property.
+// Toplevel:state property, or by listening to
+// notifications on the [Window.GetPropertyMaximized] property.
 func (x *Window) Unmaximize() {
 
 	xWindowUnmaximize(x.GoPointer())
@@ -1215,7 +1307,7 @@ var xWindowUnminimize func(uintptr)
 // which case minimization isn’t possible, etc.
 //
 // You can track result of this operation via the
-// [property@Gdk.Toplevel:state] property.
+// Toplevel:state property.
 func (x *Window) Unminimize() {
 
 	xWindowUnminimize(x.GoPointer())
@@ -1345,7 +1437,7 @@ func (x *Window) GetPropertyFocusVisible() bool {
 // Whether the window is fullscreen.
 //
 // Setting this property is the equivalent of calling
-// [method@Gtk.Window.fullscreen] or [method@Gtk.Window.unfullscreen];
+// [Window.Fullscreen] or [Window.Unfullscreen];
 // either operation is asynchronous, which means you will need to
 // connect to the ::notify signal in order to know whether the
 // operation was successful.
@@ -1360,7 +1452,7 @@ func (x *Window) SetPropertyFullscreened(value bool) {
 // Whether the window is fullscreen.
 //
 // Setting this property is the equivalent of calling
-// [method@Gtk.Window.fullscreen] or [method@Gtk.Window.unfullscreen];
+// [Window.Fullscreen] or [Window.Unfullscreen];
 // either operation is asynchronous, which means you will need to
 // connect to the ::notify signal in order to know whether the
 // operation was successful.
@@ -1371,7 +1463,7 @@ func (x *Window) GetPropertyFullscreened() bool {
 }
 
 // SetPropertyHandleMenubarAccel sets the "handle-menubar-accel" property.
-// Whether the window frame should handle &lt;kbd&gt;F10&lt;/kbd&gt; for activating
+// Whether the window frame should handle F10 for activating
 // menubars.
 func (x *Window) SetPropertyHandleMenubarAccel(value bool) {
 	var v gobject.Value
@@ -1381,7 +1473,7 @@ func (x *Window) SetPropertyHandleMenubarAccel(value bool) {
 }
 
 // GetPropertyHandleMenubarAccel gets the "handle-menubar-accel" property.
-// Whether the window frame should handle &lt;kbd&gt;F10&lt;/kbd&gt; for activating
+// Whether the window frame should handle F10 for activating
 // menubars.
 func (x *Window) GetPropertyHandleMenubarAccel() bool {
 	var v gobject.Value
@@ -1411,7 +1503,7 @@ func (x *Window) GetPropertyHideOnClose() bool {
 // SetPropertyIconName sets the "icon-name" property.
 // Specifies the name of the themed icon to use as the window icon.
 //
-// See [class@Gtk.IconTheme] for more details.
+// See [IconTheme] for more details.
 func (x *Window) SetPropertyIconName(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -1422,7 +1514,7 @@ func (x *Window) SetPropertyIconName(value string) {
 // GetPropertyIconName gets the "icon-name" property.
 // Specifies the name of the themed icon to use as the window icon.
 //
-// See [class@Gtk.IconTheme] for more details.
+// See [IconTheme] for more details.
 func (x *Window) GetPropertyIconName() string {
 	var v gobject.Value
 	x.GetProperty("icon-name", &v)
@@ -1441,7 +1533,7 @@ func (x *Window) GetPropertyIsActive() bool {
 // Whether the window is maximized.
 //
 // Setting this property is the equivalent of calling
-// [method@Gtk.Window.maximize] or [method@Gtk.Window.unmaximize];
+// [Window.Maximize] or [Window.Unmaximize];
 // either operation is asynchronous, which means you will need to
 // connect to the ::notify signal in order to know whether the
 // operation was successful.
@@ -1456,7 +1548,7 @@ func (x *Window) SetPropertyMaximized(value bool) {
 // Whether the window is maximized.
 //
 // Setting this property is the equivalent of calling
-// [method@Gtk.Window.maximize] or [method@Gtk.Window.unmaximize];
+// [Window.Maximize] or [Window.Unmaximize];
 // either operation is asynchronous, which means you will need to
 // connect to the ::notify signal in order to know whether the
 // operation was successful.
@@ -1535,7 +1627,7 @@ func (x *Window) SetPropertyStartupId(value string) {
 // GetPropertySuspended gets the "suspended" property.
 // Whether the window is suspended.
 //
-// See [method@Gtk.Window.is_suspended] for details about what suspended means.
+// See [Window.IsSuspended] for details about what suspended means.
 func (x *Window) GetPropertySuspended() bool {
 	var v gobject.Value
 	x.GetProperty("suspended", &v)
@@ -1561,142 +1653,256 @@ func (x *Window) GetPropertyTitle() string {
 
 // Emitted when the user activates the default widget.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
+//
+// The keybindings for this signal are all forms of the Enter key.
 //
-// The keybindings for this signal are all forms of the &lt;kbd&gt;Enter&lt;/kbd&gt; key.
+// Deprecated: use ConnectActivateDefaultFunc, which also accepts method values and closures.
 func (x *Window) ConnectActivateDefault(cb *func(Window)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-default", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Window{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-default", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) {
+var xWindowActivateDefaultTrampoline uintptr
+
+func xWindowActivateDefaultNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Window))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Window{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-default", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateDefaultFunc connects cb to the "activate-default" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Window) ConnectActivateDefaultFunc(cb func(Window)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-default", &xWindowActivateDefaultTrampoline, xWindowActivateDefaultNewTrampoline, cb)
 }
 
 // Emitted when the user activates the currently focused
-// widget of @window.
+// widget of window.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
-// The default binding for this signal is &lt;kbd&gt;␣&lt;/kbd&gt;.
+// The default binding for this signal is ␣.
+//
+// Deprecated: use ConnectActivateFocusFunc, which also accepts method values and closures.
 func (x *Window) ConnectActivateFocus(cb *func(Window)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "activate-focus", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Window{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "activate-focus", cbRefPtr, cbPtr)
+}
+
+var xWindowActivateFocusTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWindowActivateFocusNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Window))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Window{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "activate-focus", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectActivateFocusFunc connects cb to the "activate-focus" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Window) ConnectActivateFocusFunc(cb func(Window)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "activate-focus", &xWindowActivateFocusTrampoline, xWindowActivateFocusNewTrampoline, cb)
 }
 
 // Emitted when the user clicks on the close button of the window.
+//
+// Deprecated: use ConnectCloseRequestFunc, which also accepts method values and closures.
 func (x *Window) ConnectCloseRequest(cb *func(Window) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "close-request", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) bool {
+			defer core.RecoverPanic()
+			fa := Window{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close-request", cbRefPtr, cbPtr)
+}
 
-	fcb := func(clsPtr uintptr) bool {
+var xWindowCloseRequestTrampoline uintptr
+
+func xWindowCloseRequestNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Window) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Window{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "close-request", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectCloseRequestFunc connects cb to the "close-request" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Window) ConnectCloseRequestFunc(cb func(Window) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close-request", &xWindowCloseRequestTrampoline, xWindowCloseRequestNewTrampoline, cb)
 }
 
 // Emitted when the user enables or disables interactive debugging.
 //
-// When @toggle is true, interactive debugging is toggled on or off,
+// When toggle is true, interactive debugging is toggled on or off,
 // when it is false, the debugger will be pointed at the widget
 // under the pointer.
 //
-// This is a [keybinding signal](class.SignalAction.html).
+// This is a keybinding signal.
 //
 // The default bindings for this signal are
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;I&lt;/kbd&gt; and
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;D&lt;/kbd&gt;.
+// Ctrl+Shift+I and
+// Ctrl+Shift+D.
+//
+// Deprecated: use ConnectEnableDebuggingFunc, which also accepts method values and closures.
 func (x *Window) ConnectEnableDebugging(cb *func(Window, bool) bool) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "enable-debugging", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ToggleVarp bool) bool {
+			defer core.RecoverPanic()
+			fa := Window{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, ToggleVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "enable-debugging", cbRefPtr, cbPtr)
+}
+
+var xWindowEnableDebuggingTrampoline uintptr
 
-	fcb := func(clsPtr uintptr, ToggleVarp bool) bool {
+func xWindowEnableDebuggingNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ToggleVarp bool, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Window, bool) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Window{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		return cbFn(fa, ToggleVarp)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "enable-debugging", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectEnableDebuggingFunc connects cb to the "enable-debugging" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Window) ConnectEnableDebuggingFunc(cb func(Window, bool) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "enable-debugging", &xWindowEnableDebuggingTrampoline, xWindowEnableDebuggingNewTrampoline, cb)
 }
 
 // Emitted when the set of accelerators or mnemonics that
 // are associated with the window changes.
+//
+// Deprecated: since 4.10. Use [Shortcut] and [EventController]
+//
+//	to implement keyboard shortcuts
 func (x *Window) ConnectKeysChanged(cb *func(Window)) uint {
 	cbPtr := uintptr(unsafe.Pointer(cb))
-	if cbRefPtr, ok := glib.GetCallback(cbPtr); ok {
-		handlerID := gobject.SignalConnect(x.GoPointer(), "keys-changed", cbRefPtr)
-		glib.SaveHandlerMapping(handlerID, cbPtr)
-		return handlerID
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := Window{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
 	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "keys-changed", cbRefPtr, cbPtr)
+}
+
+var xWindowKeysChangedTrampoline uintptr
 
-	fcb := func(clsPtr uintptr) {
+func xWindowKeysChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Window))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
 		fa := Window{}
 		fa.Ptr = clsPtr
-		cbFn := *cb
 
 		cbFn(fa)
 
 	}
-	cbRefPtr := purego.NewCallback(fcb)
-	glib.SaveCallbackWithClosure(cbPtr, cbRefPtr, cb)
-	handlerID := gobject.SignalConnect(x.GoPointer(), "keys-changed", cbRefPtr)
-	glib.SaveHandlerMapping(handlerID, cbPtr)
-	return handlerID
+}
+
+// ConnectKeysChangedFunc connects cb to the "keys-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+//
+// Deprecated: the "keys-changed" signal is deprecated, see ConnectKeysChanged.
+func (x *Window) ConnectKeysChangedFunc(cb func(Window)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "keys-changed", &xWindowKeysChangedTrampoline, xWindowKeysChangedNewTrampoline, cb)
 }
 
 // Requests the user's screen reader to announce the given message.
@@ -1860,7 +2066,7 @@ func (x *Window) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1868,14 +2074,13 @@ func (x *Window) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -1899,19 +2104,17 @@ func (x *Window) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []Accessi
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -1932,7 +2135,7 @@ func (x *Window) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -1941,14 +2144,12 @@ func (x *Window) UpdateRelationValue(NRelationsVar int, RelationsVar []Accessibl
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
-//
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *Window) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -1967,10 +2168,10 @@ func (x *Window) UpdateStateValue(NStatesVar int, StatesVar []AccessibleState, V
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *Window) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -2007,10 +2208,10 @@ func (x *Window) GetSurface() *gdk.Surface {
 	return cls
 }
 
-// Retrieves the surface transform of @self.
+// Retrieves the surface transform of self.
 //
-// This is the translation from @self's surface coordinates into
-// @self's widget coordinates.
+// This is the translation from self's surface coordinates into
+// self's widget coordinates.
 func (x *Window) GetSurfaceTransform(XVar *float64, YVar *float64) {
 
 	XGtkNativeGetSurfaceTransform(x.GoPointer(), XVar, YVar)
@@ -2056,7 +2257,7 @@ var xWindowGetDefaultIconName func() string
 //
 // The returned string is owned by GTK and should not
 // be modified. It is only valid until the next call to
-// [func@Gtk.Window.set_default_icon_name].
+// [WindowSetDefaultIconName].
 func WindowGetDefaultIconName() string {
 
 	cret := xWindowGetDefaultIconName()
@@ -2104,7 +2305,7 @@ var xWindowSetAutoStartupNotification func(bool)
 // Sets whether the window should request startup notification.
 //
 // By default, after showing the first window, GTK calls
-// [method@Gdk.Toplevel.set_startup_id]. Call this function
+// [gdk.Toplevel.SetStartupId]. Call this function
 // to disable the automatic startup notification. You might do this
 // if your first window is a splash screen, and you want to delay
 // notification until after your real main window has been shown,
@@ -2124,7 +2325,7 @@ var xWindowSetDefaultIconName func(string)
 // Sets an icon to be used as fallback.
 //
 // The fallback icon is used for windows that
-// haven't had [method@Gtk.Window.set_icon_name]
+// haven't had [Window.SetIconName]
 // called on them.
 func WindowSetDefaultIconName(NameVar string) {
 
@@ -2134,14 +2335,14 @@ func WindowSetDefaultIconName(NameVar string) {
 
 var xWindowSetInteractiveDebugging func(bool)
 
-// Opens or closes the [interactive debugger](running.html#interactive-debugging).
+// Opens or closes the interactive debugger.
 //
 // The debugger offers access to the widget hierarchy of the application
 // and to useful debugging tools.
 //
 // This function allows applications that already use
-// &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;I&lt;/kbd&gt;
-// (or &lt;kbd&gt;Ctrl&lt;/kbd&gt;+&lt;kbd&gt;Shift&lt;/kbd&gt;+&lt;kbd&gt;D&lt;/kbd&gt;)
+// Ctrl+Shift+I
+// (or Ctrl+Shift+D)
 // for their own key shortcuts to add a different shortcut to open the Inspector.
 //
 // If you are not overriding the default key shortcuts for the Inspector,
@@ -2157,7 +2358,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2168,71 +2369,72 @@ func init() {
 
 	core.PuregoSafeRegister(&xWindowGLibType, libs, "gtk_window_get_type")
 
-	core.PuregoSafeRegister(&xNewWindow, libs, "gtk_window_new")
-
-	core.PuregoSafeRegister(&xWindowClose, libs, "gtk_window_close")
-	core.PuregoSafeRegister(&xWindowDestroy, libs, "gtk_window_destroy")
-	core.PuregoSafeRegister(&xWindowFullscreen, libs, "gtk_window_fullscreen")
-	core.PuregoSafeRegister(&xWindowFullscreenOnMonitor, libs, "gtk_window_fullscreen_on_monitor")
-	core.PuregoSafeRegister(&xWindowGetApplication, libs, "gtk_window_get_application")
-	core.PuregoSafeRegister(&xWindowGetChild, libs, "gtk_window_get_child")
-	core.PuregoSafeRegister(&xWindowGetDecorated, libs, "gtk_window_get_decorated")
-	core.PuregoSafeRegister(&xWindowGetDefaultSize, libs, "gtk_window_get_default_size")
-	core.PuregoSafeRegister(&xWindowGetDefaultWidget, libs, "gtk_window_get_default_widget")
-	core.PuregoSafeRegister(&xWindowGetDeletable, libs, "gtk_window_get_deletable")
-	core.PuregoSafeRegister(&xWindowGetDestroyWithParent, libs, "gtk_window_get_destroy_with_parent")
-	core.PuregoSafeRegister(&xWindowGetFocus, libs, "gtk_window_get_focus")
-	core.PuregoSafeRegister(&xWindowGetFocusVisible, libs, "gtk_window_get_focus_visible")
-	core.PuregoSafeRegister(&xWindowGetGravity, libs, "gtk_window_get_gravity")
-	core.PuregoSafeRegister(&xWindowGetGroup, libs, "gtk_window_get_group")
-	core.PuregoSafeRegister(&xWindowGetHandleMenubarAccel, libs, "gtk_window_get_handle_menubar_accel")
-	core.PuregoSafeRegister(&xWindowGetHideOnClose, libs, "gtk_window_get_hide_on_close")
-	core.PuregoSafeRegister(&xWindowGetIconName, libs, "gtk_window_get_icon_name")
-	core.PuregoSafeRegister(&xWindowGetMnemonicsVisible, libs, "gtk_window_get_mnemonics_visible")
-	core.PuregoSafeRegister(&xWindowGetModal, libs, "gtk_window_get_modal")
-	core.PuregoSafeRegister(&xWindowGetResizable, libs, "gtk_window_get_resizable")
-	core.PuregoSafeRegister(&xWindowGetTitle, libs, "gtk_window_get_title")
-	core.PuregoSafeRegister(&xWindowGetTitlebar, libs, "gtk_window_get_titlebar")
-	core.PuregoSafeRegister(&xWindowGetTransientFor, libs, "gtk_window_get_transient_for")
-	core.PuregoSafeRegister(&xWindowHasGroup, libs, "gtk_window_has_group")
-	core.PuregoSafeRegister(&xWindowIsActive, libs, "gtk_window_is_active")
-	core.PuregoSafeRegister(&xWindowIsFullscreen, libs, "gtk_window_is_fullscreen")
-	core.PuregoSafeRegister(&xWindowIsMaximized, libs, "gtk_window_is_maximized")
-	core.PuregoSafeRegister(&xWindowIsSuspended, libs, "gtk_window_is_suspended")
-	core.PuregoSafeRegister(&xWindowMaximize, libs, "gtk_window_maximize")
-	core.PuregoSafeRegister(&xWindowMinimize, libs, "gtk_window_minimize")
-	core.PuregoSafeRegister(&xWindowPresent, libs, "gtk_window_present")
-	core.PuregoSafeRegister(&xWindowPresentWithTime, libs, "gtk_window_present_with_time")
-	core.PuregoSafeRegister(&xWindowSetApplication, libs, "gtk_window_set_application")
-	core.PuregoSafeRegister(&xWindowSetChild, libs, "gtk_window_set_child")
-	core.PuregoSafeRegister(&xWindowSetDecorated, libs, "gtk_window_set_decorated")
-	core.PuregoSafeRegister(&xWindowSetDefaultSize, libs, "gtk_window_set_default_size")
-	core.PuregoSafeRegister(&xWindowSetDefaultWidget, libs, "gtk_window_set_default_widget")
-	core.PuregoSafeRegister(&xWindowSetDeletable, libs, "gtk_window_set_deletable")
-	core.PuregoSafeRegister(&xWindowSetDestroyWithParent, libs, "gtk_window_set_destroy_with_parent")
-	core.PuregoSafeRegister(&xWindowSetDisplay, libs, "gtk_window_set_display")
-	core.PuregoSafeRegister(&xWindowSetFocus, libs, "gtk_window_set_focus")
-	core.PuregoSafeRegister(&xWindowSetFocusVisible, libs, "gtk_window_set_focus_visible")
-	core.PuregoSafeRegister(&xWindowSetGravity, libs, "gtk_window_set_gravity")
-	core.PuregoSafeRegister(&xWindowSetHandleMenubarAccel, libs, "gtk_window_set_handle_menubar_accel")
-	core.PuregoSafeRegister(&xWindowSetHideOnClose, libs, "gtk_window_set_hide_on_close")
-	core.PuregoSafeRegister(&xWindowSetIconName, libs, "gtk_window_set_icon_name")
-	core.PuregoSafeRegister(&xWindowSetMnemonicsVisible, libs, "gtk_window_set_mnemonics_visible")
-	core.PuregoSafeRegister(&xWindowSetModal, libs, "gtk_window_set_modal")
-	core.PuregoSafeRegister(&xWindowSetResizable, libs, "gtk_window_set_resizable")
-	core.PuregoSafeRegister(&xWindowSetStartupId, libs, "gtk_window_set_startup_id")
-	core.PuregoSafeRegister(&xWindowSetTitle, libs, "gtk_window_set_title")
-	core.PuregoSafeRegister(&xWindowSetTitlebar, libs, "gtk_window_set_titlebar")
-	core.PuregoSafeRegister(&xWindowSetTransientFor, libs, "gtk_window_set_transient_for")
-	core.PuregoSafeRegister(&xWindowUnfullscreen, libs, "gtk_window_unfullscreen")
-	core.PuregoSafeRegister(&xWindowUnmaximize, libs, "gtk_window_unmaximize")
-	core.PuregoSafeRegister(&xWindowUnminimize, libs, "gtk_window_unminimize")
-
-	core.PuregoSafeRegister(&xWindowGetDefaultIconName, libs, "gtk_window_get_default_icon_name")
-	core.PuregoSafeRegister(&xWindowGetToplevels, libs, "gtk_window_get_toplevels")
-	core.PuregoSafeRegister(&xWindowListToplevels, libs, "gtk_window_list_toplevels")
-	core.PuregoSafeRegister(&xWindowSetAutoStartupNotification, libs, "gtk_window_set_auto_startup_notification")
-	core.PuregoSafeRegister(&xWindowSetDefaultIconName, libs, "gtk_window_set_default_icon_name")
-	core.PuregoSafeRegister(&xWindowSetInteractiveDebugging, libs, "gtk_window_set_interactive_debugging")
+	gobject.CheckClassSize("GtkWindowClass", xWindowGLibType, unsafe.Sizeof(WindowClass{}))
+
+	core.PuregoRegisterSince(&xNewWindow, libs, "gtk_window_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xWindowClose, libs, "gtk_window_close", "GTK", "")
+	core.PuregoRegisterSince(&xWindowDestroy, libs, "gtk_window_destroy", "GTK", "")
+	core.PuregoRegisterSince(&xWindowFullscreen, libs, "gtk_window_fullscreen", "GTK", "")
+	core.PuregoRegisterSince(&xWindowFullscreenOnMonitor, libs, "gtk_window_fullscreen_on_monitor", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetApplication, libs, "gtk_window_get_application", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetChild, libs, "gtk_window_get_child", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetDecorated, libs, "gtk_window_get_decorated", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetDefaultSize, libs, "gtk_window_get_default_size", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetDefaultWidget, libs, "gtk_window_get_default_widget", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetDeletable, libs, "gtk_window_get_deletable", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetDestroyWithParent, libs, "gtk_window_get_destroy_with_parent", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetFocus, libs, "gtk_window_get_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetFocusVisible, libs, "gtk_window_get_focus_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetGravity, libs, "gtk_window_get_gravity", "GTK", "4.20")
+	core.PuregoRegisterSince(&xWindowGetGroup, libs, "gtk_window_get_group", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetHandleMenubarAccel, libs, "gtk_window_get_handle_menubar_accel", "GTK", "4.2")
+	core.PuregoRegisterSince(&xWindowGetHideOnClose, libs, "gtk_window_get_hide_on_close", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetIconName, libs, "gtk_window_get_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetMnemonicsVisible, libs, "gtk_window_get_mnemonics_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetModal, libs, "gtk_window_get_modal", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetResizable, libs, "gtk_window_get_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetTitle, libs, "gtk_window_get_title", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetTitlebar, libs, "gtk_window_get_titlebar", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetTransientFor, libs, "gtk_window_get_transient_for", "GTK", "")
+	core.PuregoRegisterSince(&xWindowHasGroup, libs, "gtk_window_has_group", "GTK", "")
+	core.PuregoRegisterSince(&xWindowIsActive, libs, "gtk_window_is_active", "GTK", "")
+	core.PuregoRegisterSince(&xWindowIsFullscreen, libs, "gtk_window_is_fullscreen", "GTK", "")
+	core.PuregoRegisterSince(&xWindowIsMaximized, libs, "gtk_window_is_maximized", "GTK", "")
+	core.PuregoRegisterSince(&xWindowIsSuspended, libs, "gtk_window_is_suspended", "GTK", "4.12")
+	core.PuregoRegisterSince(&xWindowMaximize, libs, "gtk_window_maximize", "GTK", "")
+	core.PuregoRegisterSince(&xWindowMinimize, libs, "gtk_window_minimize", "GTK", "")
+	core.PuregoRegisterSince(&xWindowPresent, libs, "gtk_window_present", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetApplication, libs, "gtk_window_set_application", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetChild, libs, "gtk_window_set_child", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDecorated, libs, "gtk_window_set_decorated", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDefaultSize, libs, "gtk_window_set_default_size", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDefaultWidget, libs, "gtk_window_set_default_widget", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDeletable, libs, "gtk_window_set_deletable", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDestroyWithParent, libs, "gtk_window_set_destroy_with_parent", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDisplay, libs, "gtk_window_set_display", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetFocus, libs, "gtk_window_set_focus", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetFocusVisible, libs, "gtk_window_set_focus_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetGravity, libs, "gtk_window_set_gravity", "GTK", "4.20")
+	core.PuregoRegisterSince(&xWindowSetHandleMenubarAccel, libs, "gtk_window_set_handle_menubar_accel", "GTK", "4.2")
+	core.PuregoRegisterSince(&xWindowSetHideOnClose, libs, "gtk_window_set_hide_on_close", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetIconName, libs, "gtk_window_set_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetMnemonicsVisible, libs, "gtk_window_set_mnemonics_visible", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetModal, libs, "gtk_window_set_modal", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetResizable, libs, "gtk_window_set_resizable", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetStartupId, libs, "gtk_window_set_startup_id", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetTitle, libs, "gtk_window_set_title", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetTitlebar, libs, "gtk_window_set_titlebar", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetTransientFor, libs, "gtk_window_set_transient_for", "GTK", "")
+	core.PuregoRegisterSince(&xWindowUnfullscreen, libs, "gtk_window_unfullscreen", "GTK", "")
+	core.PuregoRegisterSince(&xWindowUnmaximize, libs, "gtk_window_unmaximize", "GTK", "")
+	core.PuregoRegisterSince(&xWindowUnminimize, libs, "gtk_window_unminimize", "GTK", "")
+
+	core.PuregoRegisterSince(&xWindowGetDefaultIconName, libs, "gtk_window_get_default_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGetToplevels, libs, "gtk_window_get_toplevels", "GTK", "")
+	core.PuregoRegisterSince(&xWindowListToplevels, libs, "gtk_window_list_toplevels", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetAutoStartupNotification, libs, "gtk_window_set_auto_startup_notification", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetDefaultIconName, libs, "gtk_window_set_default_icon_name", "GTK", "")
+	core.PuregoRegisterSince(&xWindowSetInteractiveDebugging, libs, "gtk_window_set_interactive_debugging", "GTK", "")
 
 }
@@ -0,0 +1,41 @@
+//go:build !puregotk_no_deprecated
+
+// Package gtk was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xWindowPresentWithTime func(uintptr, uint32)
+
+// Presents a window to the user in response to an user interaction.
+//
+// See [Window.Present] for more details.
+//
+// The timestamp should be gathered when the window was requested
+// to be shown (when clicking a link for example), rather than once
+// the window is ready to be shown.
+//
+// Deprecated: since 4.14. Use [Window.Present]
+func (x *Window) PresentWithTime(TimestampVar uint32) {
+
+	xWindowPresentWithTime(x.GoPointer(), TimestampVar)
+
+}
+
+func init() {
+	core.SetPackageName("GTK", "gtk4")
+	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xWindowPresentWithTime, libs, "gtk_window_present_with_time", "GTK", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,58 +25,45 @@ func (x *WindowControlsClass) GoPointer() uintptr {
 // Typical window frame controls are minimize, maximize and close buttons,
 // and the window icon.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="windowcontrols-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="An example GtkWindowControls" src="windowcontrols.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // `GtkWindowControls` only displays start or end side of the controls (see
-// [property@Gtk.WindowControls:side]), so it's intended to be always used
+// WindowControls:side), so it's intended to be always used
 // in pair with another `GtkWindowControls` for the opposite side, for example:
 //
-// ```xml
-// &lt;object class="GtkBox"&gt;
-//
-//	&lt;child&gt;
-//	  &lt;object class="GtkWindowControls"&gt;
-//	    &lt;property name="side"&gt;start&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
+//	<object class="GtkBox">
+//	  <child>
+//	    <object class="GtkWindowControls">
+//	      <property name="side">start</property>
+//	    </object>
+//	  </child>
 //
-//	...
+//	  ...
 //
-//	&lt;child&gt;
-//	  &lt;object class="GtkWindowControls"&gt;
-//	    &lt;property name="side"&gt;end&lt;/property&gt;
-//	  &lt;/object&gt;
-//	&lt;/child&gt;
-//
-// &lt;/object&gt;
-// ```
+//	  <child>
+//	    <object class="GtkWindowControls">
+//	      <property name="side">end</property>
+//	    </object>
+//	  </child>
+//	</object>
 //
 // # CSS nodes
 //
-// ```
-// windowcontrols
-// ├── [image.icon]
-// ├── [button.minimize]
-// ├── [button.maximize]
-// ╰── [button.close]
-// ```
+//	windowcontrols
+//	├── [image.icon]
+//	├── [button.minimize]
+//	├── [button.maximize]
+//	╰── [button.close]
 //
 // A `GtkWindowControls`' CSS node is called windowcontrols. It contains
 // subnodes corresponding to each title button. Which of the title buttons
 // exist and where they are placed exactly depends on the desktop environment
-// and [property@Gtk.WindowControls:decoration-layout] value.
+// and [WindowControls.GetPropertyDecorationLayout] value.
 //
-// When [property@Gtk.WindowControls:empty] is true, it gets the .empty
+// When [WindowControls.GetPropertyEmpty] is true, it gets the .empty
 // style class.
 //
 // # Accessibility
 //
-// `GtkWindowControls` uses the [enum@Gtk.AccessibleRole.group] role.
+// `GtkWindowControls` uses the [AccessibleRoleGroupValue] role.
 type WindowControls struct {
 	Widget
 }
@@ -151,7 +137,7 @@ var xWindowControlsSetDecorationLayout func(uintptr, uintptr)
 
 // Sets the decoration layout for the title buttons.
 //
-// This overrides the [property@Gtk.Settings:gtk-decoration-layout]
+// This overrides the [Settings.GetPropertyGtkDecorationLayout]
 // setting.
 //
 // The format of the string is button names, separated by commas.
@@ -162,8 +148,8 @@ var xWindowControlsSetDecorationLayout func(uintptr, uintptr)
 // For example, “icon:minimize,maximize,close” specifies a icon
 // on the left, and minimize, maximize and close buttons on the right.
 //
-// If [property@Gtk.WindowControls:side] value is [enum@Gtk.PackType.start],
-// @self will display the part before the colon, otherwise after that.
+// If WindowControls:side value is [PackStartValue],
+// self will display the part before the colon, otherwise after that.
 func (x *WindowControls) SetDecorationLayout(LayoutVar *string) {
 
 	LayoutVarPtr := core.GStrdupNullable(LayoutVar)
@@ -178,7 +164,7 @@ var xWindowControlsSetSide func(uintptr, PackType)
 // Determines which part of decoration layout
 // the window controls widget uses.
 //
-// See [property@Gtk.WindowControls:decoration-layout].
+// See [WindowControls.GetPropertyDecorationLayout].
 func (x *WindowControls) SetSide(SideVar PackType) {
 
 	xWindowControlsSetSide(x.GoPointer(), SideVar)
@@ -192,7 +178,7 @@ var xWindowControlsSetUseNativeControls func(uintptr, bool)
 // This option shows the "stoplight" buttons on macOS.
 // For Linux, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *WindowControls) SetUseNativeControls(SettingVar bool) {
 
 	xWindowControlsSetUseNativeControls(x.GoPointer(), SettingVar)
@@ -214,7 +200,7 @@ func (c *WindowControls) SetGoPointer(ptr uintptr) {
 // The decoration layout for window buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [Settings.GetPropertyGtkDecorationLayout] setting is used.
 func (x *WindowControls) SetPropertyDecorationLayout(value string) {
 	var v gobject.Value
 	v.Init(gobject.TypeStringVal)
@@ -226,7 +212,7 @@ func (x *WindowControls) SetPropertyDecorationLayout(value string) {
 // The decoration layout for window buttons.
 //
 // If this property is not set, the
-// [property@Gtk.Settings:gtk-decoration-layout] setting is used.
+// [Settings.GetPropertyGtkDecorationLayout] setting is used.
 func (x *WindowControls) GetPropertyDecorationLayout() string {
 	var v gobject.Value
 	x.GetProperty("decoration-layout", &v)
@@ -244,12 +230,12 @@ func (x *WindowControls) GetPropertyEmpty() bool {
 // SetPropertyUseNativeControls sets the "use-native-controls" property.
 // Whether to show platform native close/minimize/maximize buttons.
 //
-// For macOS, the [property@Gtk.HeaderBar:decoration-layout] property
+// For macOS, the [HeaderBar.GetPropertyDecorationLayout] property
 // controls the use of native window controls.
 //
 // On other platforms, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *WindowControls) SetPropertyUseNativeControls(value bool) {
 	var v gobject.Value
 	v.Init(gobject.TypeBooleanVal)
@@ -260,12 +246,12 @@ func (x *WindowControls) SetPropertyUseNativeControls(value bool) {
 // GetPropertyUseNativeControls gets the "use-native-controls" property.
 // Whether to show platform native close/minimize/maximize buttons.
 //
-// For macOS, the [property@Gtk.HeaderBar:decoration-layout] property
+// For macOS, the [HeaderBar.GetPropertyDecorationLayout] property
 // controls the use of native window controls.
 //
 // On other platforms, this option has no effect.
 //
-// See also [Using GTK on Apple macOS](osx.html?native-window-controls).
+// See also Using GTK on Apple macOS.
 func (x *WindowControls) GetPropertyUseNativeControls() bool {
 	var v gobject.Value
 	x.GetProperty("use-native-controls", &v)
@@ -433,7 +419,7 @@ func (x *WindowControls) UpdatePlatformState(StateVar AccessiblePlatformState) {
 
 // Updates a list of accessible properties.
 //
-// See the [enum@Gtk.AccessibleProperty] documentation for the
+// See the [AccessibleProperty] documentation for the
 // value types of accessible properties.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -441,14 +427,13 @@ func (x *WindowControls) UpdatePlatformState(StateVar AccessiblePlatformState) {
 // technologies.
 //
 // Example:
-// ```c
-// value = gtk_adjustment_get_value (adjustment);
-// gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
 //
-//	GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
-//	-1);
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
 //
-// ```
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
 func (x *WindowControls) UpdateProperty(FirstPropertyVar AccessibleProperty, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
@@ -472,19 +457,17 @@ func (x *WindowControls) UpdatePropertyValue(NPropertiesVar int, PropertiesVar [
 // This function should be called by `GtkWidget` types whenever an accessible
 // relation change must be communicated to assistive technologies.
 //
-// If the [enum@Gtk.AccessibleRelation] requires a list of references,
+// If the [AccessibleRelation] requires a list of references,
 // you should pass each reference individually, followed by `NULL`, e.g.
 //
-// ```c
-// gtk_accessible_update_relation (accessible,
-//
-//	GTK_ACCESSIBLE_RELATION_CONTROLS,
-//	  ref1, NULL,
-//	GTK_ACCESSIBLE_RELATION_LABELLED_BY,
-//	  ref1, ref2, ref3, NULL,
-//	-1);
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
 //
-// ```
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
 func (x *WindowControls) UpdateRelation(FirstRelationVar AccessibleRelation, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
@@ -505,7 +488,7 @@ func (x *WindowControls) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 
 // Updates a list of accessible states.
 //
-// See the [enum@Gtk.AccessibleState] documentation for the
+// See the [AccessibleState] documentation for the
 // value types of accessible states.
 //
 // This function should be called by `GtkWidget` types whenever
@@ -514,14 +497,12 @@ func (x *WindowControls) UpdateRelationValue(NRelationsVar int, RelationsVar []A
 //
 // Example:
 //
-// ```c
-// value = GTK_ACCESSIBLE_TRISTATE_MIXED;
-// gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
 //
-//	GTK_ACCESSIBLE_STATE_CHECKED, value,
-//	-1);
-//
-// ```
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
 func (x *WindowControls) UpdateState(FirstStateVar AccessibleState, varArgs ...interface{}) {
 
 	XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
@@ -540,10 +521,10 @@ func (x *WindowControls) UpdateStateValue(NStatesVar int, StatesVar []Accessible
 
 }
 
-// Gets the ID of the @buildable object.
+// Gets the ID of the buildable object.
 //
 // `GtkBuilder` sets the name based on the ID attribute
-// of the `&lt;object&gt;` tag used to construct the @buildable.
+// of the `<object>` tag used to construct the buildable.
 func (x *WindowControls) GetBuildableId() string {
 
 	cret := XGtkBuildableGetBuildableId(x.GoPointer())
@@ -555,7 +536,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -564,14 +545,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xWindowControlsGLibType, libs, "gtk_window_controls_get_type")
 
-	core.PuregoSafeRegister(&xNewWindowControls, libs, "gtk_window_controls_new")
+	gobject.CheckClassSize("GtkWindowControlsClass", xWindowControlsGLibType, unsafe.Sizeof(WindowControlsClass{}))
+
+	core.PuregoRegisterSince(&xNewWindowControls, libs, "gtk_window_controls_new", "GTK", "")
 
-	core.PuregoSafeRegister(&xWindowControlsGetDecorationLayout, libs, "gtk_window_controls_get_decoration_layout")
-	core.PuregoSafeRegister(&xWindowControlsGetEmpty, libs, "gtk_window_controls_get_empty")
-	core.PuregoSafeRegister(&xWindowControlsGetSide, libs, "gtk_window_controls_get_side")
-	core.PuregoSafeRegister(&xWindowControlsGetUseNativeControls, libs, "gtk_window_controls_get_use_native_controls")
-	core.PuregoSafeRegister(&xWindowControlsSetDecorationLayout, libs, "gtk_window_controls_set_decoration_layout")
-	core.PuregoSafeRegister(&xWindowControlsSetSide, libs, "gtk_window_controls_set_side")
-	core.PuregoSafeRegister(&xWindowControlsSetUseNativeControls, libs, "gtk_window_controls_set_use_native_controls")
+	core.PuregoRegisterSince(&xWindowControlsGetDecorationLayout, libs, "gtk_window_controls_get_decoration_layout", "GTK", "")
+	core.PuregoRegisterSince(&xWindowControlsGetEmpty, libs, "gtk_window_controls_get_empty", "GTK", "")
+	core.PuregoRegisterSince(&xWindowControlsGetSide, libs, "gtk_window_controls_get_side", "GTK", "")
+	core.PuregoRegisterSince(&xWindowControlsGetUseNativeControls, libs, "gtk_window_controls_get_use_native_controls", "GTK", "4.18")
+	core.PuregoRegisterSince(&xWindowControlsSetDecorationLayout, libs, "gtk_window_controls_set_decoration_layout", "GTK", "")
+	core.PuregoRegisterSince(&xWindowControlsSetSide, libs, "gtk_window_controls_set_side", "GTK", "")
+	core.PuregoRegisterSince(&xWindowControlsSetUseNativeControls, libs, "gtk_window_controls_set_use_native_controls", "GTK", "4.18")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -35,7 +34,8 @@ func (x *WindowGroupClass) OverrideGtkReserved1(cb func()) {
 	if cb == nil {
 		x.xGtkReserved1 = 0
 	} else {
-		x.xGtkReserved1 = purego.NewCallback(func() {
+		x.xGtkReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -47,7 +47,7 @@ func (x *WindowGroupClass) GetGtkReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved1)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -58,7 +58,8 @@ func (x *WindowGroupClass) OverrideGtkReserved2(cb func()) {
 	if cb == nil {
 		x.xGtkReserved2 = 0
 	} else {
-		x.xGtkReserved2 = purego.NewCallback(func() {
+		x.xGtkReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -70,7 +71,7 @@ func (x *WindowGroupClass) GetGtkReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved2)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -81,7 +82,8 @@ func (x *WindowGroupClass) OverrideGtkReserved3(cb func()) {
 	if cb == nil {
 		x.xGtkReserved3 = 0
 	} else {
-		x.xGtkReserved3 = purego.NewCallback(func() {
+		x.xGtkReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -93,7 +95,7 @@ func (x *WindowGroupClass) GetGtkReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved3)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -104,7 +106,8 @@ func (x *WindowGroupClass) OverrideGtkReserved4(cb func()) {
 	if cb == nil {
 		x.xGtkReserved4 = 0
 	} else {
-		x.xGtkReserved4 = purego.NewCallback(func() {
+		x.xGtkReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -116,7 +119,7 @@ func (x *WindowGroupClass) GetGtkReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xGtkReserved4)
+	core.RegisterFunc(&rawCallback, x.xGtkReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -133,7 +136,7 @@ func (x *WindowGroupClass) GetGtkReserved4() func() {
 //
 // `GtkWindowGroup` objects are referenced by each window in the group,
 // so once you have added all windows to a `GtkWindowGroup`, you can drop
-// the initial reference to the window group with g_object_unref(). If the
+// the initial reference to the window group with [gobject.Object.Unref]. If the
 // windows in the window group are subsequently destroyed, then they will
 // be removed from the window group and drop their references on the window
 // group; when all window have been removed, the window group will be
@@ -184,7 +187,7 @@ func (x *WindowGroup) AddWindow(WindowVar *Window) {
 
 var xWindowGroupListWindows func(uintptr) *glib.List
 
-// Returns a list of the `GtkWindows` that belong to @window_group.
+// Returns a list of the `GtkWindows` that belong to window_group.
 func (x *WindowGroup) ListWindows() *glib.List {
 
 	cret := xWindowGroupListWindows(x.GoPointer())
@@ -216,7 +219,7 @@ func init() {
 	core.SetSharedLibraries("GTK", []string{"libgtk-4.so.1"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("GTK") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -225,10 +228,12 @@ func init() {
 
 	core.PuregoSafeRegister(&xWindowGroupGLibType, libs, "gtk_window_group_get_type")
 
-	core.PuregoSafeRegister(&xNewWindowGroup, libs, "gtk_window_group_new")
+	gobject.CheckClassSize("GtkWindowGroupClass", xWindowGroupGLibType, unsafe.Sizeof(WindowGroupClass{}))
 
-	core.PuregoSafeRegister(&xWindowGroupAddWindow, libs, "gtk_window_group_add_window")
-	core.PuregoSafeRegister(&xWindowGroupListWindows, libs, "gtk_window_group_list_windows")
-	core.PuregoSafeRegister(&xWindowGroupRemoveWindow, libs, "gtk_window_group_remove_window")
+	core.PuregoRegisterSince(&xNewWindowGroup, libs, "gtk_window_group_new", "GTK", "")
+
+	core.PuregoRegisterSince(&xWindowGroupAddWindow, libs, "gtk_window_group_add_window", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGroupListWindows, libs, "gtk_window_group_list_windows", "GTK", "")
+	core.PuregoRegisterSince(&xWindowGroupRemoveWindow, libs, "gtk_window_group_remove_window", "GTK", "")
 
 }
@@ -0,0 +1,216 @@
+package gtk
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+var (
+	// the generated *_value functions pass the enum arrays with Go sized ints, C expects an array of 32 bit enums
+	xAccessibleUpdatePropertyValues func(uintptr, int32, *int32, *gobject.Value)
+	xAccessibleUpdateStateValues    func(uintptr, int32, *int32, *gobject.Value)
+	xAccessibleUpdateRelationValues func(uintptr, int32, *int32, *gobject.Value)
+	xAccessibleListAppend           func(uintptr, uintptr) uintptr
+)
+
+// AccessiblePropertyPair is an accessible property with its value, create it with PropertyPair.
+type AccessiblePropertyPair struct {
+	Property AccessibleProperty
+	Value    interface{}
+}
+
+// PropertyPair pairs the accessible property with its value for UpdateAccessibleProperties.
+// The value is a string, a bool, a number or an enum such as AccessibleSort, as documented for the property.
+func PropertyPair(property AccessibleProperty, value interface{}) AccessiblePropertyPair {
+	return AccessiblePropertyPair{Property: property, Value: value}
+}
+
+// AccessibleStatePair is an accessible state with its value, create it with StatePair.
+type AccessibleStatePair struct {
+	State AccessibleState
+	Value interface{}
+}
+
+// StatePair pairs the accessible state with its value for UpdateAccessibleStates.
+// The value is a bool, an AccessibleTristate or an AccessibleInvalidState, as documented for the state.
+func StatePair(state AccessibleState, value interface{}) AccessibleStatePair {
+	return AccessibleStatePair{State: state, Value: value}
+}
+
+// AccessibleRelationPair is an accessible relation with its value, create it with RelationPair.
+type AccessibleRelationPair struct {
+	Relation AccessibleRelation
+	Value    interface{}
+}
+
+// RelationPair pairs the accessible relation with its value for UpdateAccessibleRelations.
+// The value is an int or a string, or for references an Accessible such as a *Widget or a []Accessible.
+func RelationPair(relation AccessibleRelation, value interface{}) AccessibleRelationPair {
+	return AccessibleRelationPair{Relation: relation, Value: value}
+}
+
+// UpdateAccessibleProperties updates the accessible properties of a, e.g. for a custom widget:
+//
+//	gtk.UpdateAccessibleProperties(&w.Widget,
+//		gtk.PropertyPair(gtk.AccessiblePropertyLabelValue, "Volume"),
+//		gtk.PropertyPair(gtk.AccessiblePropertyValueNowValue, 0.8),
+//	)
+//
+// It panics if a value does not have the type of its property.
+func UpdateAccessibleProperties(a Accessible, pairs ...AccessiblePropertyPair) {
+	if len(pairs) == 0 {
+		return
+	}
+	keys := make([]int32, len(pairs))
+	values := make([]gobject.Value, len(pairs))
+	defer unsetAccessibleValues(values)
+	for i, p := range pairs {
+		keys[i] = int32(p.Property)
+		number := p.Property >= AccessiblePropertyValueMaxValue && p.Property <= AccessiblePropertyValueNowValue
+		setAccessibleValue(&values[i], p.Value, number, false, fmt.Sprintf("accessible property %d", p.Property))
+	}
+	xAccessibleUpdatePropertyValues(a.GoPointer(), int32(len(pairs)), &keys[0], &values[0])
+}
+
+// UpdateAccessibleStates updates the accessible states of a, e.g. for a custom check box:
+//
+//	gtk.UpdateAccessibleStates(&w.Widget, gtk.StatePair(gtk.AccessibleStateCheckedValue, gtk.AccessibleTristateMixedValue))
+//
+// It panics if a value does not have the type of its state.
+func UpdateAccessibleStates(a Accessible, pairs ...AccessibleStatePair) {
+	if len(pairs) == 0 {
+		return
+	}
+	keys := make([]int32, len(pairs))
+	values := make([]gobject.Value, len(pairs))
+	defer unsetAccessibleValues(values)
+	for i, p := range pairs {
+		keys[i] = int32(p.State)
+		setAccessibleValue(&values[i], p.Value, false, false, fmt.Sprintf("accessible state %d", p.State))
+	}
+	xAccessibleUpdateStateValues(a.GoPointer(), int32(len(pairs)), &keys[0], &values[0])
+}
+
+// UpdateAccessibleRelations updates the accessible relations of a, e.g. to label a custom widget with a label:
+//
+//	gtk.UpdateAccessibleRelations(&w.Widget, gtk.RelationPair(gtk.AccessibleRelationLabelledByValue, &label.Widget))
+//
+// A single Accessible is accepted for relations that take a list of references as well.
+// It panics if a value does not have the type of its relation.
+func UpdateAccessibleRelations(a Accessible, pairs ...AccessibleRelationPair) {
+	if len(pairs) == 0 {
+		return
+	}
+	keys := make([]int32, len(pairs))
+	values := make([]gobject.Value, len(pairs))
+	defer unsetAccessibleValues(values)
+	for i, p := range pairs {
+		keys[i] = int32(p.Relation)
+		// the active descendant is the only relation that takes a single reference
+		list := p.Relation != AccessibleRelationActiveDescendantValue
+		setAccessibleValue(&values[i], p.Value, false, list, fmt.Sprintf("accessible relation %d", p.Relation))
+	}
+	xAccessibleUpdateRelationValues(a.GoPointer(), int32(len(pairs)), &keys[0], &values[0])
+}
+
+// setAccessibleValue initializes v with the Go value in the type GTK expects for it,
+// number is set for attributes that take a double and list for relations that take a list of references
+func setAccessibleValue(v *gobject.Value, value interface{}, number, list bool, name string) {
+	switch val := value.(type) {
+	case bool:
+		v.Init(gobject.TypeBooleanVal)
+		v.SetBoolean(val)
+		return
+	case string:
+		v.Init(gobject.TypeStringVal)
+		v.SetString(&val)
+		return
+	case []Accessible:
+		v.Init(gobject.TypePointerVal)
+		v.SetPointer(accessibleList(val))
+		return
+	case Accessible:
+		if list {
+			v.Init(gobject.TypePointerVal)
+			v.SetPointer(accessibleList([]Accessible{val}))
+			return
+		}
+		v.Init(AccessibleGLibType())
+		v.SetObject(&gobject.Object{Ptr: val.GoPointer()})
+		return
+	}
+
+	// numbers and enums, such as AccessibleTristate, by their kind
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if number {
+			v.Init(gobject.TypeDoubleVal)
+			v.SetDouble(float64(rv.Int()))
+			return
+		}
+		v.Init(gobject.TypeIntVal)
+		v.SetInt(int(rv.Int()))
+		return
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if number {
+			v.Init(gobject.TypeDoubleVal)
+			v.SetDouble(float64(rv.Uint()))
+			return
+		}
+		v.Init(gobject.TypeIntVal)
+		v.SetInt(int(rv.Uint()))
+		return
+	case reflect.Float32, reflect.Float64:
+		if number {
+			v.Init(gobject.TypeDoubleVal)
+			v.SetDouble(rv.Float())
+			return
+		}
+	}
+	panic(fmt.Sprintf("gtk: unsupported value %#v for %s", value, name))
+}
+
+// accessibleList returns the references as GList, which GTK takes ownership of
+func accessibleList(refs []Accessible) uintptr {
+	var list uintptr
+	for _, r := range refs {
+		list = xAccessibleListAppend(list, r.GoPointer())
+	}
+	return list
+}
+
+func unsetAccessibleValues(values []gobject.Value) {
+	for i := range values {
+		if values[i].GType != gobject.TypeInvalidVal {
+			values[i].Unset()
+		}
+	}
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xAccessibleUpdatePropertyValues, libs, "gtk_accessible_update_property_value")
+	core.PuregoSafeRegister(&xAccessibleUpdateStateValues, libs, "gtk_accessible_update_state_value")
+	core.PuregoSafeRegister(&xAccessibleUpdateRelationValues, libs, "gtk_accessible_update_relation_value")
+
+	libs = nil
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xAccessibleListAppend, libs, "g_list_append")
+}
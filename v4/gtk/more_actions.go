@@ -0,0 +1,53 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// WidgetActionFunc is called when an action installed with InstallActionFunc is activated.
+// widget is the instance the action was activated on and parameter is nil for actions without a parameter type.
+type WidgetActionFunc func(widget *Widget, parameter *glib.Variant)
+
+// InstallActionFunc adds an action for all instances of a widget class that calls activate.
+// This is a wrapper around InstallAction for Go subclasses and should be called at class initialization time.
+// parameterType is the GVariant type string of the parameter, or empty for an action without a parameter.
+func (x *WidgetClass) InstallActionFunc(actionName string, parameterType string, activate WidgetActionFunc) {
+	cb := WidgetActionActivateFunc(func(widget uintptr, _ string, parameter *glib.Variant) {
+		activate(WidgetNewFromInternalPtr(widget), parameter)
+	})
+	var pt *string
+	if parameterType != "" {
+		pt = &parameterType
+	}
+	x.InstallAction(actionName, pt, &cb)
+}
+
+// InsertActions creates a new action group with actions and inserts it into the widget under prefix.
+// The actions can then be activated by the widget and its descendants as "prefix.name", e.g. "win.open".
+// The returned group can be used to add or remove actions later on.
+func (x *Widget) InsertActions(prefix string, actions ...gio.Action) *gio.SimpleActionGroup {
+	group := gio.NewSimpleActionGroup()
+	for _, a := range actions {
+		group.AddAction(a)
+	}
+	x.InsertActionGroup(prefix, group)
+	return group
+}
+
+// ActivateActionTarget activates the action with name with a typed target value.
+// target is converted with glib.NewVariantFromGo, a nil target activates the action without a parameter.
+// It returns false if the action could not be found or the target could not be converted.
+func (x *Widget) ActivateActionTarget(name string, target interface{}) bool {
+	if target == nil {
+		return x.ActivateActionVariant(name, nil)
+	}
+	v, err := glib.NewVariantFromGo(target)
+	if err != nil {
+		return false
+	}
+	// GTK only consumes a floating target if the action is found
+	v.RefSink()
+	defer v.Unref()
+	return x.ActivateActionVariant(name, v)
+}
@@ -0,0 +1,114 @@
+package gtk
+
+import (
+	"errors"
+
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// IsCancelled returns whether err is the error of a dialog that was closed by its cancellable or by the application,
+// see DialogErrorCancelledValue.
+func IsCancelled(err error) bool {
+	var gerr *glib.Error
+	return errors.As(err, &gerr) && gerr.Domain == DialogErrorQuark() && gerr.Code == int32(DialogErrorCancelledValue)
+}
+
+// NewAlertDialogWithButtons creates an alert with the message, the detail text below it and the buttons from left to right.
+// Unlike NewAlertDialog the message is not a format string.
+func NewAlertDialogWithButtons(message, detail string, buttons ...string) *AlertDialog {
+	obj := gobject.NewObjectWithProperties(AlertDialogGLibType(), 0, nil, nil)
+	d := AlertDialogNewFromInternalPtr(obj.Ptr)
+	d.SetMessage(message)
+	if detail != "" {
+		d.SetDetail(detail)
+	}
+	if len(buttons) > 0 {
+		d.SetButtons(buttons)
+	}
+	return d
+}
+
+// ChooseGo shows the alert and calls fn with the index of the button that was clicked on the main loop.
+// If the alert is closed without a button, e.g. with Escape, button is the cancel button,
+// or -1 with an error for which IsDismissed returns true if the alert has no cancel button.
+func (x *AlertDialog) ChooseGo(parent *Window, cancellable *gio.Cancellable, fn func(button int, err error)) {
+	x.Choose(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		button, err := x.ChooseFinish(result)
+		if err != nil {
+			fn(-1, err)
+			return
+		}
+		// the index is a C int
+		fn(int(int32(button)), nil)
+	}))
+}
+
+// AlertResult is the result of ChooseChan.
+type AlertResult struct {
+	Button int
+	Err    error
+}
+
+// ChooseChan shows the alert and sends the index of the clicked button on the returned channel, see ChooseGo.
+// The main loop must run for the alert to finish, so do not block on the channel on the main thread.
+func (x *AlertDialog) ChooseChan(parent *Window, cancellable *gio.Cancellable) <-chan AlertResult {
+	ch := make(chan AlertResult, 1)
+	x.ChooseGo(parent, cancellable, func(button int, err error) {
+		ch <- AlertResult{Button: button, Err: err}
+	})
+	return ch
+}
+
+// ConfirmGo asks the user to confirm an action with an alert that has a Cancel button and a button labeled confirm,
+// e.g. "Delete", and calls fn with whether it was confirmed.
+// Closing the alert in any other way counts as not confirmed.
+func ConfirmGo(parent *Window, message, detail, confirm string, fn func(confirmed bool)) {
+	d := NewAlertDialogWithButtons(message, detail, "_Cancel", confirm)
+	d.SetCancelButton(0)
+	d.SetDefaultButton(1)
+	d.ChooseGo(parent, nil, func(button int, err error) {
+		defer d.Unref()
+		fn(err == nil && button == 1)
+	})
+}
+
+// messageButtons are the labels and responses of the predefined button sets of GtkMessageDialog
+var messageButtons = map[ButtonsType][]struct {
+	label    string
+	response ResponseType
+}{
+	ButtonsOkValue:       {{"_OK", ResponseOkValue}},
+	ButtonsCloseValue:    {{"_Close", ResponseCloseValue}},
+	ButtonsCancelValue:   {{"_Cancel", ResponseCancelValue}},
+	ButtonsYesNoValue:    {{"_No", ResponseNoValue}, {"_Yes", ResponseYesValue}},
+	ButtonsOkCancelValue: {{"_Cancel", ResponseCancelValue}, {"_OK", ResponseOkValue}},
+}
+
+// MessageDialogGo shows an alert like the deprecated MessageDialog with one of its predefined button sets
+// and calls fn with the response of the button that was clicked, which eases porting code from GtkMessageDialog.
+// Closing the alert without a button gives ResponseDeleteEventValue, like closing a MessageDialog.
+// The message type only mattered for the icon of MessageDialog, which alerts do not show, so it is not needed.
+func MessageDialogGo(parent *Window, buttons ButtonsType, message, detail string, fn func(response ResponseType)) {
+	set := messageButtons[buttons]
+	labels := make([]string, len(set))
+	for i, b := range set {
+		labels[i] = b.label
+	}
+	d := NewAlertDialogWithButtons(message, detail, labels...)
+	if len(set) > 0 {
+		d.SetDefaultButton(len(set) - 1)
+	}
+	d.ChooseGo(parent, nil, func(button int, err error) {
+		defer d.Unref()
+		if fn == nil {
+			return
+		}
+		if err != nil || button < 0 || button >= len(set) {
+			fn(ResponseDeleteEventValue)
+			return
+		}
+		fn(set[button].response)
+	})
+}
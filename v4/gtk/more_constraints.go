@@ -0,0 +1,219 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// ConstraintAnchor is an attribute of a constraint target, e.g. the left edge of a widget.
+// It is the starting point for building constraints:
+//
+//	layout.Add(
+//		gtk.Anchor(&button.Widget, gtk.ConstraintAttributeStartValue).Eq(gtk.ParentAnchor(gtk.ConstraintAttributeStartValue)).Plus(8),
+//		gtk.Anchor(&button.Widget, gtk.ConstraintAttributeWidthValue).Eq(gtk.Anchor(&entry.Widget, gtk.ConstraintAttributeWidthValue)).Times(0.5),
+//		gtk.Anchor(&entry.Widget, gtk.ConstraintAttributeWidthValue).GeConstant(120).WithStrength(gtk.ConstraintStrengthStrongValue),
+//	)
+type ConstraintAnchor struct {
+	// Target is the widget or guide, nil is the widget that uses the layout
+	Target ConstraintTarget
+	// Attribute is the attribute of Target
+	Attribute ConstraintAttribute
+}
+
+// Anchor returns the attribute attr of target.
+// Widgets and guides are targets, e.g. pass &button.Widget.
+func Anchor(target ConstraintTarget, attr ConstraintAttribute) ConstraintAnchor {
+	return ConstraintAnchor{Target: target, Attribute: attr}
+}
+
+// ParentAnchor returns the attribute attr of the widget that uses the layout.
+func ParentAnchor(attr ConstraintAttribute) ConstraintAnchor {
+	return ConstraintAnchor{Attribute: attr}
+}
+
+// Eq returns a constraint that makes the anchor equal to source.
+func (a ConstraintAnchor) Eq(source ConstraintAnchor) *ConstraintSpec {
+	return a.relate(ConstraintRelationEqValue, source)
+}
+
+// Ge returns a constraint that makes the anchor greater than or equal to source.
+func (a ConstraintAnchor) Ge(source ConstraintAnchor) *ConstraintSpec {
+	return a.relate(ConstraintRelationGeValue, source)
+}
+
+// Le returns a constraint that makes the anchor less than or equal to source.
+func (a ConstraintAnchor) Le(source ConstraintAnchor) *ConstraintSpec {
+	return a.relate(ConstraintRelationLeValue, source)
+}
+
+// EqConstant returns a constraint that makes the anchor equal to c.
+func (a ConstraintAnchor) EqConstant(c float64) *ConstraintSpec {
+	return a.constant(ConstraintRelationEqValue, c)
+}
+
+// GeConstant returns a constraint that makes the anchor greater than or equal to c.
+func (a ConstraintAnchor) GeConstant(c float64) *ConstraintSpec {
+	return a.constant(ConstraintRelationGeValue, c)
+}
+
+// LeConstant returns a constraint that makes the anchor less than or equal to c.
+func (a ConstraintAnchor) LeConstant(c float64) *ConstraintSpec {
+	return a.constant(ConstraintRelationLeValue, c)
+}
+
+func (a ConstraintAnchor) relate(rel ConstraintRelation, source ConstraintAnchor) *ConstraintSpec {
+	return &ConstraintSpec{
+		Target:     a,
+		Relation:   rel,
+		Source:     &source,
+		Multiplier: 1,
+		Strength:   ConstraintStrengthRequiredValue,
+	}
+}
+
+func (a ConstraintAnchor) constant(rel ConstraintRelation, c float64) *ConstraintSpec {
+	return &ConstraintSpec{
+		Target:     a,
+		Relation:   rel,
+		Multiplier: 1,
+		Constant:   c,
+		Strength:   ConstraintStrengthRequiredValue,
+	}
+}
+
+// ConstraintSpec describes the constraint
+//
+//	target.attribute relation source.attribute * multiplier + constant
+//
+// It is created with the relation methods of ConstraintAnchor
+// and turned into a *Constraint with Build or (*ConstraintLayout).Add.
+type ConstraintSpec struct {
+	Target   ConstraintAnchor
+	Relation ConstraintRelation
+	// Source is nil for a constraint on a constant value
+	Source     *ConstraintAnchor
+	Multiplier float64
+	Constant   float64
+	Strength   ConstraintStrength
+}
+
+// Times sets the multiplier of the source attribute.
+func (s *ConstraintSpec) Times(m float64) *ConstraintSpec {
+	s.Multiplier = m
+	return s
+}
+
+// Plus adds c to the constant.
+func (s *ConstraintSpec) Plus(c float64) *ConstraintSpec {
+	s.Constant += c
+	return s
+}
+
+// Minus subtracts c from the constant.
+func (s *ConstraintSpec) Minus(c float64) *ConstraintSpec {
+	s.Constant -= c
+	return s
+}
+
+// WithStrength sets the strength of the constraint, the default is ConstraintStrengthRequiredValue.
+func (s *ConstraintSpec) WithStrength(strength ConstraintStrength) *ConstraintSpec {
+	s.Strength = strength
+	return s
+}
+
+// Build creates the constraint.
+func (s *ConstraintSpec) Build() *Constraint {
+	target := constraintTargetOrParent(s.Target.Target)
+	if s.Source == nil {
+		return NewConstraintConstant(target, s.Target.Attribute, s.Relation, s.Constant, int(s.Strength))
+	}
+	source := constraintTargetOrParent(s.Source.Target)
+	return NewConstraint(target, s.Target.Attribute, s.Relation, source, s.Source.Attribute, s.Multiplier, s.Constant, int(s.Strength))
+}
+
+// constraintTargetOrParent returns a NULL target for nil, which GTK interprets as the layout widget
+func constraintTargetOrParent(t ConstraintTarget) ConstraintTarget {
+	if t == nil {
+		return &ConstraintTargetBase{}
+	}
+	return t
+}
+
+// Add builds all constraints and adds them to the layout.
+// The layout owns the returned constraints, they can be used with RemoveConstraint.
+func (x *ConstraintLayout) Add(specs ...*ConstraintSpec) []*Constraint {
+	ret := make([]*Constraint, len(specs))
+	for i, s := range specs {
+		ret[i] = s.Build()
+		x.AddConstraint(ret[i])
+	}
+	return ret
+}
+
+var (
+	xConstraintsHashTableNewFull func(uintptr, uintptr, uintptr, uintptr) *glib.HashTable
+	xConstraintsListFree         func(*glib.List)
+	constraintsStrHash           uintptr
+	constraintsStrEqual          uintptr
+	constraintsFree              uintptr
+)
+
+// AddVFL adds the constraints described by lines in the Visual Format Language, e.g.
+//
+//	layout.AddVFL([]string{
+//		"H:|-[label]-[entry(>=120)]-|",
+//		"V:|-[label]-|",
+//	}, 8, 8, map[string]gtk.ConstraintTarget{"label": &label.Widget, "entry": &entry.Widget})
+//
+// views maps the names used in lines to widgets or guides,
+// hspacing and vspacing are the default spacing for "-" or -1 to use the default of GTK.
+// See AddConstraintsFromDescriptionv for the full grammar.
+// The layout owns the returned constraints.
+func (x *ConstraintLayout) AddVFL(lines []string, hspacing, vspacing int, views map[string]ConstraintTarget) ([]*Constraint, error) {
+	table := xConstraintsHashTableNewFull(constraintsStrHash, constraintsStrEqual, constraintsFree, 0)
+	defer glib.HashTableUnref(table)
+	for name, view := range views {
+		glib.HashTableInsert(table, core.GStrdup(name), view.GoPointer())
+	}
+
+	list, err := x.AddConstraintsFromDescriptionv(lines, uint(len(lines)), hspacing, vspacing, table)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*Constraint
+	for l := list; l != nil; l = l.Next {
+		ret = append(ret, ConstraintNewFromInternalPtr(l.Data))
+	}
+	if list != nil {
+		xConstraintsListFree(list)
+	}
+	return ret, nil
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xConstraintsHashTableNewFull, libs, "g_hash_table_new_full")
+	core.PuregoSafeRegister(&xConstraintsListFree, libs, "g_list_free")
+	for _, lib := range libs {
+		for name, sym := range map[string]*uintptr{
+			"g_str_hash":  &constraintsStrHash,
+			"g_str_equal": &constraintsStrEqual,
+			"g_free":      &constraintsFree,
+		} {
+			if *sym != 0 {
+				continue
+			}
+			if ptr, err := core.Dlsym(lib, name); err == nil {
+				*sym = ptr
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package gtk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// CSSParseError is an error in CSS loaded by LoadCSSString, LoadCSSFile or WatchCSS.
+type CSSParseError struct {
+	// Start and End are the location of the error in the CSS, they are zero for errors reading the file
+	Start, End CssLocation
+	Message    string
+	located    bool
+}
+
+func (e *CSSParseError) Error() string {
+	if !e.located {
+		return "gtk: css: " + e.Message
+	}
+	return fmt.Sprintf("gtk: css: %d:%d: %s", e.Start.Lines+1, e.Start.LineChars+1, e.Message)
+}
+
+// CSSParseErrors are all errors found while loading CSS.
+type CSSParseErrors []*CSSParseError
+
+func (e CSSParseErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// CSS is a CssProvider that is applied to a display, see LoadCSSString, LoadCSSFile and WatchCSS.
+type CSS struct {
+	display  *gdk.Display
+	provider *CssProvider
+	path     string
+
+	mu      sync.Mutex
+	errs    *CSSParseErrors
+	monitor *gio.FileMonitor
+	onError func(error)
+}
+
+// newCSS adds a new provider to the default display with the priority
+// the parsing errors of the provider are collected by load
+func newCSS(priority int) (*CSS, error) {
+	display := gdk.DisplayGetDefault()
+	if display == nil {
+		return nil, errors.New("gtk: no default display to load CSS for")
+	}
+	c := &CSS{display: display, provider: NewCssProvider()}
+	c.provider.ConnectParsingErrorFunc(func(_ CssProvider, sectionPtr, errPtr uintptr) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.errs == nil || errPtr == 0 {
+			return
+		}
+		// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+		gerr := *(**glib.Error)(unsafe.Pointer(&errPtr))
+		e := &CSSParseError{Message: gerr.MessageGo()}
+		if sectionPtr != 0 {
+			section := *(**CssSection)(unsafe.Pointer(&sectionPtr))
+			e.Start = *section.GetStartLocation()
+			e.End = *section.GetEndLocation()
+			e.located = true
+		}
+		*c.errs = append(*c.errs, e)
+	})
+	StyleContextAddProviderForDisplay(display, c.provider, uint(priority))
+	return c, nil
+}
+
+// load runs fn, which loads CSS into the provider, and returns the parsing errors that it emitted
+func (c *CSS) load(fn func()) error {
+	var errs CSSParseErrors
+	c.mu.Lock()
+	c.errs = &errs
+	c.mu.Unlock()
+	fn()
+	c.mu.Lock()
+	c.errs = nil
+	c.mu.Unlock()
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// LoadCSSString applies the CSS to all windows of the default display with the priority,
+// e.g. STYLE_PROVIDER_PRIORITY_APPLICATION:
+//
+//	css, err := gtk.LoadCSSString(".sidebar { background: @card_bg_color; }", gtk.STYLE_PROVIDER_PRIORITY_APPLICATION)
+//
+// Parsing is tolerant, so the CSS is applied even if it has CSSParseErrors, the rules with errors are skipped.
+// LoadCSSString requires GTK 4.12 or later.
+func LoadCSSString(css string, priority int) (*CSS, error) {
+	c, err := newCSS(priority)
+	if err != nil {
+		return nil, err
+	}
+	return c, c.LoadString(css)
+}
+
+// LoadCSSFile applies the CSS of the file at path to all windows of the default display with the priority,
+// e.g. STYLE_PROVIDER_PRIORITY_APPLICATION.
+// Parsing is tolerant, so the CSS is applied even if it has CSSParseErrors, the rules with errors are skipped.
+// An error reading the file is returned as CSSParseErrors as well.
+func LoadCSSFile(path string, priority int) (*CSS, error) {
+	c, err := newCSS(priority)
+	if err != nil {
+		return nil, err
+	}
+	c.path = path
+	return c, c.Reload()
+}
+
+// WatchCSS applies the CSS of the file at path to all windows of the default display with the application priority
+// and reloads it whenever the file changes, such that styles can be edited while the app runs during development:
+//
+//	css, err := gtk.WatchCSS("style.css")
+//	if err != nil {
+//		log.Println(err)
+//	}
+//	defer css.Remove()
+//
+// Errors of reloads are printed to stderr, see OnError.
+// Call it from the main thread, the file is watched in its main context.
+func WatchCSS(path string) (*CSS, error) {
+	c, err := LoadCSSFile(path, STYLE_PROVIDER_PRIORITY_APPLICATION)
+	if c == nil {
+		return nil, err
+	}
+	if werr := c.Watch(); werr != nil {
+		c.Remove()
+		return nil, werr
+	}
+	return c, err
+}
+
+// Provider returns the provider of the CSS, it is owned by the CSS.
+func (c *CSS) Provider() *CssProvider {
+	return c.provider
+}
+
+// LoadString replaces the CSS by css, the windows are restyled immediately.
+// LoadString requires GTK 4.12 or later.
+func (c *CSS) LoadString(css string) error {
+	return c.load(func() {
+		c.provider.LoadFromString(css)
+	})
+}
+
+// Reload loads the file of the CSS again, it is a no-op for CSS loaded from a string.
+func (c *CSS) Reload() error {
+	if c.path == "" {
+		return nil
+	}
+	return c.load(func() {
+		c.provider.LoadFromPath(c.path)
+	})
+}
+
+// Watch reloads the CSS whenever its file changes, including when an editor replaces the file on save.
+// It returns an error for CSS loaded from a string.
+func (c *CSS) Watch() error {
+	if c.path == "" {
+		return errors.New("gtk: css: only CSS loaded from a file can be watched")
+	}
+	c.mu.Lock()
+	watching := c.monitor != nil
+	c.mu.Unlock()
+	if watching {
+		return nil
+	}
+	file := gio.FileNewForPath(c.path)
+	defer (&gobject.Object{Ptr: file.Ptr}).Unref()
+	monitor, err := file.MonitorFile(gio.GFileMonitorNoneValue, nil)
+	if err != nil {
+		return err
+	}
+	monitor.ConnectChangedFunc(func(_ gio.FileMonitor, _, _ uintptr, event gio.FileMonitorEvent) {
+		// changed is emitted while the file is written, reload once the writing is done
+		if event != gio.GFileMonitorEventChangesDoneHintValue && event != gio.GFileMonitorEventCreatedValue {
+			return
+		}
+		if err := c.Reload(); err != nil {
+			c.mu.Lock()
+			onError := c.onError
+			c.mu.Unlock()
+			if onError != nil {
+				onError(err)
+			} else {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+			}
+		}
+	})
+	c.mu.Lock()
+	c.monitor = monitor
+	c.mu.Unlock()
+	return nil
+}
+
+// OnError sets the function that is called with the errors of the reloads when the file changes,
+// instead of printing them to stderr.
+func (c *CSS) OnError(fn func(err error)) {
+	c.mu.Lock()
+	c.onError = fn
+	c.mu.Unlock()
+}
+
+// Unwatch stops reloading the CSS when its file changes, the CSS stays applied.
+func (c *CSS) Unwatch() {
+	c.mu.Lock()
+	monitor := c.monitor
+	c.monitor = nil
+	c.mu.Unlock()
+	if monitor != nil {
+		monitor.Cancel()
+		monitor.Unref()
+	}
+}
+
+// Remove stops watching and removes the CSS from the display, the styles of the windows are restored.
+// The CSS must not be used afterwards.
+func (c *CSS) Remove() {
+	c.Unwatch()
+	StyleContextRemoveProviderForDisplay(c.display, c.provider)
+	c.provider.Unref()
+}
@@ -0,0 +1,201 @@
+package gtk
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var (
+	xDndSListFree func(*glib.SList)
+
+	dndCallbacksOnce sync.Once
+	// dropCb is shared by the drop signals of DropTarget, with a GValue, and DropTargetAsync, with a GdkDrop
+	dropCb, prepareCb uintptr
+)
+
+func dndCallbacks() {
+	controllerCallbacks()
+	dndCallbacksOnce.Do(func() {
+		dropCb = core.NewCallback(func(_ uintptr, ptr uintptr, x float64, y float64, id uintptr) bool {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(ptr uintptr, x, y float64) bool](id); ok {
+				return fn(ptr, x, y)
+			}
+			return false
+		})
+		prepareCb = core.NewCallback(func(_ uintptr, x float64, y float64, id uintptr) uintptr {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(x, y float64) uintptr](id); ok {
+				return fn(x, y)
+			}
+			return 0
+		})
+	})
+}
+
+// DropValue are the Go types that DropTargetGo converts dropped data to.
+type DropValue interface {
+	string | []*gio.FileBase | *gdk.Texture | *gdk.RGBA
+}
+
+// dropValueType returns the GType of the drop target for the Go type of value
+func dropValueType(value interface{}) types.GType {
+	switch value.(type) {
+	case string:
+		return gobject.TypeStringVal
+	case []*gio.FileBase:
+		return gdk.FileListGLibType()
+	case *gdk.Texture:
+		return gdk.TextureGLibType()
+	case *gdk.RGBA:
+		return gdk.RGBAGLibType()
+	}
+	return gobject.TypeInvalidVal
+}
+
+// dropValue converts the dropped value to the Go type of value
+// release is called after the drop handler returned
+func dropValue(value interface{}, v *gobject.Value) (result interface{}, release func()) {
+	switch value.(type) {
+	case string:
+		return v.GetString(), func() {}
+	case []*gio.FileBase:
+		ptr := v.GetBoxed()
+		// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+		list := (*gdk.FileList)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))).GetFiles()
+		var files []*gio.FileBase
+		for l := list; l != nil; l = l.Next {
+			files = append(files, &gio.FileBase{Ptr: l.Data})
+		}
+		return files, func() { xDndSListFree(list) }
+	case *gdk.Texture:
+		obj := v.GetObject()
+		return gdk.TextureNewFromInternalPtr(obj.Ptr), obj.Unref
+	case *gdk.RGBA:
+		ptr := v.GetBoxed()
+		rgba := *(*gdk.RGBA)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr)))
+		return &rgba, func() {}
+	}
+	return nil, func() {}
+}
+
+// DropTargetGo creates a drop target that calls fn with the dropped data converted to T:
+// text as string, files as []*gio.FileBase, images as *gdk.Texture or colors as *gdk.RGBA.
+// x and y are the drop position relative to the widget and fn returns whether it accepted the drop.
+// Files and textures are valid while fn runs, Ref them to keep them.
+//
+//	target := gtk.DropTargetGo(gdk.ActionCopyValue, func(files []*gio.FileBase, x, y float64) bool {
+//		for _, f := range files {
+//			open(f.GetPath())
+//		}
+//		return true
+//	})
+//	widget.AddController(&target.EventController)
+func DropTargetGo[T DropValue](actions gdk.DragAction, fn func(value T, x, y float64) bool) *DropTarget {
+	var zero T
+	target := NewDropTarget(dropValueType(zero), actions)
+	dndCallbacks()
+	connectController(target.Ptr, "drop", &dropCb, func(ptr uintptr, x, y float64) bool {
+		value, release := dropValue(zero, (*gobject.Value)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))))
+		defer release()
+		return fn(value.(T), x, y)
+	})
+	return target
+}
+
+// DropTargetObjectGo creates a drop target for objects of the GType, e.g. widgets or list items that are dragged within the application
+// with DragSourceGo. fn is called with a reference to the object that it must Unref.
+func DropTargetObjectGo(actions gdk.DragAction, gtype types.GType, fn func(obj *gobject.Object, x, y float64) bool) *DropTarget {
+	target := NewDropTarget(gtype, actions)
+	dndCallbacks()
+	connectController(target.Ptr, "drop", &dropCb, func(ptr uintptr, x, y float64) bool {
+		return fn((*gobject.Value)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))).GetObject(), x, y)
+	})
+	return target
+}
+
+// preferredAction picks the action to finish a drop with if several are possible
+func preferredAction(actions gdk.DragAction) gdk.DragAction {
+	for _, a := range []gdk.DragAction{gdk.ActionCopyValue, gdk.ActionMoveValue, gdk.ActionLinkValue} {
+		if actions&a != 0 {
+			return a
+		}
+	}
+	return 0
+}
+
+// DropTargetBytesGo creates a drop target that reads the dropped data in the first of the mime types that is offered,
+// e.g. "application/json" or "text/uri-list".
+// fn is called on the main loop once the data is read and returns whether it accepted the drop.
+// Read errors are passed to fn with nil data.
+func DropTargetBytesGo(actions gdk.DragAction, mimeTypes []string, fn func(data []byte, mimeType string, x, y float64, err error) bool) *DropTargetAsync {
+	formats := gdk.NewContentFormats(mimeTypes, uint(len(mimeTypes)))
+	defer formats.Unref()
+	target := NewDropTargetAsync(formats, actions)
+	dndCallbacks()
+	connectController(target.Ptr, "drop", &dropCb, func(ptr uintptr, x, y float64) bool {
+		drop := &gdk.Drop{}
+		drop.Ptr = ptr
+		drop.Ref()
+		drop.ReadBytesGo(mimeTypes, nil, func(data []byte, mimeType string, err error) {
+			defer drop.Unref()
+			if !fn(data, mimeType, x, y, err) || err != nil {
+				drop.Finish(0)
+				return
+			}
+			drop.Finish(preferredAction(drop.GetActions() & target.GetActions()))
+		})
+		return true
+	})
+	return target
+}
+
+// DragSourceGo creates a drag source that calls prepare with the position where the drag starts
+// and offers the value it returns, see gdk.NewContentProviderGo for the supported types.
+// prepare returns nil to not start a drag at the position.
+//
+//	source := gtk.DragSourceGo(gdk.ActionCopyValue, func(x, y float64) interface{} {
+//		return row.GetTitle()
+//	})
+//	row.AddController(&source.EventController)
+func DragSourceGo(actions gdk.DragAction, prepare func(x, y float64) interface{}) *DragSource {
+	source := NewDragSource()
+	source.SetActions(actions)
+	dndCallbacks()
+	connectController(source.Ptr, "prepare", &prepareCb, func(x, y float64) uintptr {
+		value := prepare(x, y)
+		if value == nil {
+			return 0
+		}
+		provider, err := gdk.NewContentProviderGo(value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "gtk: drag source: %v\n", err)
+			return 0
+		}
+		// the provider is returned with its reference
+		return provider.Ptr
+	})
+	return source
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xDndSListFree, libs, "g_slist_free")
+}
@@ -0,0 +1,25 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/cairo"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// drawTrampoline is shared by all drawing areas
+// the draw function is looked up by the user data such that only one callback is allocated
+var drawTrampoline DrawingAreaDrawFunc = func(_ uintptr, cr *cairo.Context, width int, height int, id uintptr) {
+	if draw, ok := glib.LookupUserData[func(cr *cairo.Context, width, height int)](id); ok {
+		draw(cr, width, height)
+	}
+}
+
+// SetDrawFuncGo sets the function that draws the contents of the drawing area.
+// draw is called with the cairo context to draw on and the current width and height of the area.
+// The function is released when it is replaced, when nil is set or when the drawing area is finalized.
+func (x *DrawingArea) SetDrawFuncGo(draw func(cr *cairo.Context, width, height int)) {
+	if draw == nil {
+		x.SetDrawFunc(nil, 0, nil)
+		return
+	}
+	x.SetDrawFunc(&drawTrampoline, glib.RegisterUserData(draw), glib.UserDataDestroyNotify())
+}
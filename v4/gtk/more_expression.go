@@ -0,0 +1,54 @@
+package gtk
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// NewClosureExpressionFunc returns an expression that evaluates params and computes its value with fn,
+// e.g. the string that a DropDown shows and searches for its items:
+//
+//	expr := gtk.NewClosureExpressionFunc(gobject.TypeStringVal, func(this *gobject.Object, _ []gobject.Value) interface{} {
+//		return names[this.GoPointer()]
+//	})
+//	dropDown.SetExpression(&expr.Expression)
+//	dropDown.SetEnableSearch(true)
+//
+// fn is called with the this object of the evaluation, nil if there is none, and the values of params,
+// which are owned by the caller. The value that fn returns is converted to valueType as described for gobject.Value.InitGo,
+// a value that cannot be converted is reported on standard error and leaves the default value of valueType.
+// The expression takes ownership of params, as gtk_closure_expression_new does, and the caller owns the returned expression.
+func NewClosureExpressionFunc(valueType types.GType, fn func(this *gobject.Object, params []gobject.Value) interface{}, params ...*Expression) *ClosureExpression {
+	closure := gobject.NewClosureFunc(func(ret *gobject.Value, values []gobject.Value) {
+		if len(values) == 0 {
+			return
+		}
+		var this *gobject.Object
+		if gobject.TypeFundamental(values[0].GType) == gobject.TypeObjectVal {
+			this = values[0].GetObject()
+		}
+		v := fn(this, values[1:])
+		if ret == nil {
+			return
+		}
+		if err := ret.SetGo(v); err != nil {
+			fmt.Fprintf(os.Stderr, "gtk: closure expression: %v\n", err)
+		}
+	})
+	var ptrs []uintptr
+	for _, p := range params {
+		ptrs = append(ptrs, p.GoPointer())
+	}
+	var paramsPtr uintptr
+	if len(ptrs) > 0 {
+		paramsPtr = uintptr(unsafe.Pointer(&ptrs[0]))
+	}
+	expr := NewClosureExpression(valueType, closure, uint(len(ptrs)), paramsPtr)
+	runtime.KeepAlive(ptrs)
+	return expr
+}
@@ -0,0 +1,130 @@
+package gtk
+
+import (
+	"errors"
+
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+)
+
+// asyncReadyTrampoline is the single callback given to every asynchronous call of the Go helpers
+// the Go function is looked up by the user data such that only one callback is allocated
+var asyncReadyTrampoline gio.AsyncReadyCallback = func(_ uintptr, result uintptr, id uintptr) {
+	if fn, ok := glib.TakeUserData[func(result *gio.AsyncResultBase)](id); ok {
+		fn(&gio.AsyncResultBase{Ptr: result})
+	}
+}
+
+// asyncCall stores fn until the call finishes and returns the user data to pass to the call
+func asyncCall(fn func(result *gio.AsyncResultBase)) uintptr {
+	return glib.RegisterUserData(fn)
+}
+
+// IsDismissed returns whether err is the error of a dialog that the user closed without choosing, see DialogErrorDismissedValue.
+func IsDismissed(err error) bool {
+	var gerr *glib.Error
+	return errors.As(err, &gerr) && gerr.Domain == DialogErrorQuark() && gerr.Code == int32(DialogErrorDismissedValue)
+}
+
+// filePath returns the local path of the file and releases it
+func filePath(file *gio.FileBase, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	defer (&gobject.Object{Ptr: file.Ptr}).Unref()
+	return file.GetPath(), nil
+}
+
+// filePaths returns the local paths of the files in the list model and releases it
+func filePaths(files *gio.ListModelBase, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer (&gobject.Object{Ptr: files.Ptr}).Unref()
+	n := files.GetNItems()
+	paths := make([]string, 0, n)
+	for i := uint(0); i < n; i++ {
+		item := files.GetObject(i)
+		if item == nil {
+			continue
+		}
+		path, _ := filePath(&gio.FileBase{Ptr: item.Ptr}, nil)
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// OpenGo presents the dialog to choose a file and calls fn with its path on the main loop.
+// If the user closes the dialog without choosing, err is the dismissed error, see IsDismissed.
+// The path is empty for files without a local path, such as files on remote locations, use Open for those.
+func (x *FileDialog) OpenGo(parent *Window, cancellable *gio.Cancellable, fn func(path string, err error)) {
+	x.Open(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(filePath(x.OpenFinish(result)))
+	}))
+}
+
+// OpenMultipleGo presents the dialog to choose several files and calls fn with their paths on the main loop, see OpenGo.
+func (x *FileDialog) OpenMultipleGo(parent *Window, cancellable *gio.Cancellable, fn func(paths []string, err error)) {
+	x.OpenMultiple(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(filePaths(x.OpenMultipleFinish(result)))
+	}))
+}
+
+// SaveGo presents the dialog to choose a file to save to and calls fn with its path on the main loop, see OpenGo.
+func (x *FileDialog) SaveGo(parent *Window, cancellable *gio.Cancellable, fn func(path string, err error)) {
+	x.Save(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(filePath(x.SaveFinish(result)))
+	}))
+}
+
+// SelectFolderGo presents the dialog to choose a folder and calls fn with its path on the main loop, see OpenGo.
+func (x *FileDialog) SelectFolderGo(parent *Window, cancellable *gio.Cancellable, fn func(path string, err error)) {
+	x.SelectFolder(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(filePath(x.SelectFolderFinish(result)))
+	}))
+}
+
+// SelectMultipleFoldersGo presents the dialog to choose several folders and calls fn with their paths on the main loop, see OpenGo.
+func (x *FileDialog) SelectMultipleFoldersGo(parent *Window, cancellable *gio.Cancellable, fn func(paths []string, err error)) {
+	x.SelectMultipleFolders(parent, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(filePaths(x.SelectMultipleFoldersFinish(result)))
+	}))
+}
+
+// FileDialogResult is the result of the channel variants of the file dialog helpers.
+type FileDialogResult struct {
+	Path string
+	Err  error
+}
+
+// fileDialogChan adapts a callback helper to a channel
+func fileDialogChan(call func(fn func(path string, err error))) <-chan FileDialogResult {
+	ch := make(chan FileDialogResult, 1)
+	call(func(path string, err error) {
+		ch <- FileDialogResult{Path: path, Err: err}
+	})
+	return ch
+}
+
+// OpenChan presents the dialog to choose a file and sends the result on the returned channel, see OpenGo.
+// The main loop must run for the dialog to finish, so do not block on the channel on the main thread.
+func (x *FileDialog) OpenChan(parent *Window, cancellable *gio.Cancellable) <-chan FileDialogResult {
+	return fileDialogChan(func(fn func(path string, err error)) {
+		x.OpenGo(parent, cancellable, fn)
+	})
+}
+
+// SaveChan presents the dialog to choose a file to save to and sends the result on the returned channel, see OpenChan.
+func (x *FileDialog) SaveChan(parent *Window, cancellable *gio.Cancellable) <-chan FileDialogResult {
+	return fileDialogChan(func(fn func(path string, err error)) {
+		x.SaveGo(parent, cancellable, fn)
+	})
+}
+
+// SelectFolderChan presents the dialog to choose a folder and sends the result on the returned channel, see OpenChan.
+func (x *FileDialog) SelectFolderChan(parent *Window, cancellable *gio.Cancellable) <-chan FileDialogResult {
+	return fileDialogChan(func(fn func(path string, err error)) {
+		x.SelectFolderGo(parent, cancellable, fn)
+	})
+}
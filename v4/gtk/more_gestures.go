@@ -0,0 +1,150 @@
+package gtk
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+var (
+	xControllerSignalConnectData func(uintptr, string, uintptr, uintptr, uintptr, int) uint
+
+	controllerCallbacksOnce sync.Once
+	// the trampolines are shared by all handlers with the same signature
+	// the Go function of a handler is looked up by its user data such that only one callback per signature is allocated
+	pointerCb, clickCb, keyCb, scrollCb uintptr
+)
+
+func controllerCallbacks() {
+	controllerCallbacksOnce.Do(func() {
+		pointerCb = core.NewCallback(func(_ uintptr, x float64, y float64, id uintptr) {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(x, y float64)](id); ok {
+				fn(x, y)
+			}
+		})
+		clickCb = core.NewCallback(func(_ uintptr, nPress int32, x float64, y float64, id uintptr) {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(nPress int, x, y float64)](id); ok {
+				fn(int(nPress), x, y)
+			}
+		})
+		keyCb = core.NewCallback(func(_ uintptr, keyval uint32, keycode uint32, state uint32, id uintptr) bool {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(keyval, keycode uint, state gdk.ModifierType) bool](id); ok {
+				return fn(uint(keyval), uint(keycode), gdk.ModifierType(state))
+			}
+			return false
+		})
+		scrollCb = core.NewCallback(func(_ uintptr, dx float64, dy float64, id uintptr) bool {
+			defer core.RecoverPanic()
+			if fn, ok := glib.LookupUserData[func(dx, dy float64) bool](id); ok {
+				return fn(dx, dy)
+			}
+			return false
+		})
+	})
+}
+
+// connectController connects fn to the signal of the controller through the trampoline cb
+// fn is released when the handler is disconnected or the controller is finalized
+func connectController(controller uintptr, signal string, cb *uintptr, fn interface{}) uint {
+	controllerCallbacks()
+	return xControllerSignalConnectData(controller, signal, *cb, glib.RegisterUserData(fn), glib.UserDataDestroyCallback(), 0)
+}
+
+// OnClick adds a click gesture to the widget that calls fn when the primary button is pressed or the widget is touched.
+// nPress is the number of presses in a row, e.g. 2 for a double click, and x and y are relative to the widget.
+// The gesture is returned to change the button, e.g. SetButton(gdk.BUTTON_SECONDARY) for context menus,
+// or to remove it with RemoveController.
+func (x *Widget) OnClick(fn func(nPress int, x, y float64)) *GestureClick {
+	gesture := NewGestureClick()
+	connectController(gesture.Ptr, "pressed", &clickCb, fn)
+	x.AddController(&gesture.EventController)
+	return gesture
+}
+
+// OnReleased adds a click gesture to the widget that calls fn when the primary button is released,
+// see OnClick.
+func (x *Widget) OnReleased(fn func(nPress int, x, y float64)) *GestureClick {
+	gesture := NewGestureClick()
+	connectController(gesture.Ptr, "released", &clickCb, fn)
+	x.AddController(&gesture.EventController)
+	return gesture
+}
+
+// OnKeyPressed adds a key controller to the widget that calls fn when a key is pressed while the widget has the focus.
+// fn returns true if it handled the key, which stops other handlers from receiving it.
+func (x *Widget) OnKeyPressed(fn func(keyval, keycode uint, state gdk.ModifierType) bool) *EventControllerKey {
+	controller := NewEventControllerKey()
+	connectController(controller.Ptr, "key-pressed", &keyCb, fn)
+	x.AddController(&controller.EventController)
+	return controller
+}
+
+// OnKeyReleased adds a key controller to the widget that calls fn when a key is released while the widget has the focus.
+func (x *Widget) OnKeyReleased(fn func(keyval, keycode uint, state gdk.ModifierType)) *EventControllerKey {
+	controller := NewEventControllerKey()
+	connectController(controller.Ptr, "key-released", &keyCb, func(keyval, keycode uint, state gdk.ModifierType) bool {
+		fn(keyval, keycode, state)
+		return false
+	})
+	x.AddController(&controller.EventController)
+	return controller
+}
+
+// OnScroll adds a scroll controller to the widget that calls fn with the scroll deltas.
+// flags select the scroll axes and behavior, e.g. EventControllerScrollVerticalValue.
+// fn returns true if it handled the scroll, which stops it from scrolling parent widgets.
+func (x *Widget) OnScroll(flags EventControllerScrollFlags, fn func(dx, dy float64) bool) *EventControllerScroll {
+	controller := NewEventControllerScroll(flags)
+	connectController(controller.Ptr, "scroll", &scrollCb, fn)
+	x.AddController(&controller.EventController)
+	return controller
+}
+
+// OnMotion adds a motion controller to the widget that calls fn with the pointer position while it moves over the widget.
+func (x *Widget) OnMotion(fn func(x, y float64)) *EventControllerMotion {
+	controller := NewEventControllerMotion()
+	connectController(controller.Ptr, "motion", &pointerCb, fn)
+	x.AddController(&controller.EventController)
+	return controller
+}
+
+// OnLongPress adds a long press gesture to the widget that calls fn with the position
+// when the widget is pressed for a while without moving.
+func (x *Widget) OnLongPress(fn func(x, y float64)) *GestureLongPress {
+	gesture := NewGestureLongPress()
+	connectController(gesture.Ptr, "pressed", &pointerCb, fn)
+	x.AddController(&gesture.EventController)
+	return gesture
+}
+
+// OnDrag adds a drag gesture to the widget.
+// begin is called with the start position, update and end with the offset from the start position.
+// Any of the functions can be nil.
+func (x *Widget) OnDrag(begin, update, end func(x, y float64)) *GestureDrag {
+	gesture := NewGestureDrag()
+	for signal, fn := range map[string]func(x, y float64){"drag-begin": begin, "drag-update": update, "drag-end": end} {
+		if fn != nil {
+			connectController(gesture.Ptr, signal, &pointerCb, fn)
+		}
+	}
+	x.AddController(&gesture.EventController)
+	return gesture
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GOBJECT") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xControllerSignalConnectData, libs, "g_signal_connect_data")
+}
@@ -0,0 +1,174 @@
+package gtk
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+	"github.com/jwijenbergh/puregotk/v4/gsk"
+	"github.com/jwijenbergh/puregotk/v4/pango"
+	"github.com/jwijenbergh/puregotk/v4/pangocairo"
+)
+
+// LoadSymbolicIcon looks up the icon name in the icon theme of the default display
+// and renders it as a texture of size x size pixels at scale, recolored to fg.
+// extra are the optional error, warning and success colors of the symbolic icon, in that order.
+func LoadSymbolicIcon(name string, size, scale int, fg gdk.RGBA, extra ...gdk.RGBA) (*gdk.Texture, error) {
+	display := gdk.DisplayGetDefault()
+	if display == nil {
+		return nil, errors.New("gtk: no default display to look up icons")
+	}
+	icon := IconThemeGetForDisplay(display).LookupIcon(name, nil, size, scale, TextDirNoneValue, IconLookupForceSymbolicValue)
+	if icon == nil {
+		return nil, errors.New("gtk: icon " + name + " not found")
+	}
+	defer icon.Unref()
+
+	colors := append([]gdk.RGBA{fg}, extra...)
+	if len(colors) > 4 {
+		colors = colors[:4]
+	}
+	px := size * scale
+	snapshot := NewSnapshot()
+	icon.SnapshotSymbolic(&snapshot.Snapshot, float64(px), float64(px), colors, uint(len(colors)))
+	return renderSnapshot(snapshot, px, px)
+}
+
+// BadgePosition is the corner of a texture that a badge is placed in.
+type BadgePosition int
+
+const (
+	BadgeTopRight BadgePosition = iota
+	BadgeTopLeft
+	BadgeBottomRight
+	BadgeBottomLeft
+)
+
+// Badge is a status dot or a pill with a short text such as an unread count.
+type Badge struct {
+	// Text is drawn in the badge, an empty text draws a dot
+	Text string
+	// Background is the color of the badge
+	Background gdk.RGBA
+	// Foreground is the color of the text
+	Foreground gdk.RGBA
+	// Size is the height of the badge relative to the height of the texture,
+	// the default is 0.5 for a badge with text and 0.3 for a dot
+	Size float32
+	// Position is the corner of the badge, the default is the top right corner
+	Position BadgePosition
+}
+
+// CompositeBadge returns a new texture with badge drawn over the corner of base.
+// Badges with text grow horizontally to fit the text.
+func CompositeBadge(base *gdk.Texture, badge Badge) (*gdk.Texture, error) {
+	w, h := float32(base.GetWidth()), float32(base.GetHeight())
+	size := badge.Size
+	if size <= 0 {
+		size = 0.3
+		if badge.Text != "" {
+			size = 0.5
+		}
+	}
+	bh := size * h
+	bw := bh
+
+	var layout *pango.Layout
+	if badge.Text != "" {
+		fontMap := pangocairo.FontMapGetDefault()
+		ctx := fontMap.CreateContext()
+		fontMap.Unref()
+		layout = pango.NewLayout(ctx)
+		ctx.Unref()
+		defer layout.Unref()
+		desc := pango.FontDescriptionFromString("Sans Bold")
+		desc.SetAbsoluteSize(float64(bh*0.7) * 1024)
+		layout.SetFontDescription(desc)
+		desc.Free()
+		layout.SetText(badge.Text, -1)
+		tw, _ := layout.PixelSize()
+		if pill := float32(tw) + bh/2; pill > bw {
+			bw = pill
+		}
+	}
+
+	var x, y float32
+	switch badge.Position {
+	case BadgeTopRight:
+		x = w - bw
+	case BadgeBottomRight:
+		x, y = w-bw, h-bh
+	case BadgeBottomLeft:
+		y = h - bh
+	}
+
+	snapshot := NewSnapshot()
+	var bounds graphene.Rect
+	bounds.Init(0, 0, w, h)
+	snapshot.AppendTexture(base, &bounds)
+
+	var clip roundedRect
+	clip.Bounds.Init(x, y, bw, bh)
+	for i := range clip.Corner {
+		clip.Corner[i] = graphene.Size{Width: bh / 2, Height: bh / 2}
+	}
+	snapshot.PushRoundedClip((*gsk.RoundedRect)(unsafe.Pointer(&clip)))
+	snapshot.AppendColor(&badge.Background, &clip.Bounds)
+	snapshot.Pop()
+
+	if layout != nil {
+		tw, th := layout.PixelSize()
+		snapshot.Save()
+		snapshot.Translate(&graphene.Point{X: x + (bw-float32(tw))/2, Y: y + (bh-float32(th))/2})
+		snapshot.AppendLayout(layout, &badge.Foreground)
+		snapshot.Restore()
+	}
+	return renderSnapshot(snapshot, int(w), int(h))
+}
+
+// CompositeOverlay returns a new texture with overlay drawn over base in the rectangle x, y, width, height,
+// e.g. for emblems on file icons.
+func CompositeOverlay(base, overlay *gdk.Texture, x, y, width, height float32) (*gdk.Texture, error) {
+	w, h := base.GetWidth(), base.GetHeight()
+	snapshot := NewSnapshot()
+	var bounds graphene.Rect
+	bounds.Init(0, 0, float32(w), float32(h))
+	snapshot.AppendTexture(base, &bounds)
+	var ob graphene.Rect
+	ob.Init(x, y, width, height)
+	snapshot.AppendTexture(overlay, &ob)
+	return renderSnapshot(snapshot, w, h)
+}
+
+// roundedRect is the C layout of GskRoundedRect
+// the generated struct does not have the size of graphene_rect_t for its bounds
+type roundedRect struct {
+	Bounds graphene.Rect
+	Corner [4]graphene.Size
+}
+
+// renderSnapshot renders the snapshot into a texture of width x height pixels with the cairo renderer
+// the snapshot is freed
+func renderSnapshot(snapshot *Snapshot, width, height int) (*gdk.Texture, error) {
+	node := snapshot.FreeToNode()
+	if node == nil {
+		return nil, errors.New("gtk: nothing was drawn")
+	}
+	defer node.Unref()
+
+	renderer := gsk.NewCairoRenderer()
+	defer renderer.Unref()
+	if _, err := renderer.Realize(nil); err != nil {
+		return nil, err
+	}
+	defer renderer.Unrealize()
+
+	var viewport graphene.Rect
+	viewport.Init(0, 0, float32(width), float32(height))
+	texture := renderer.RenderTexture(node, &viewport)
+	if texture == nil {
+		return nil, errors.New("gtk: rendering failed")
+	}
+	return texture, nil
+}
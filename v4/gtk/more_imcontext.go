@@ -0,0 +1,157 @@
+package gtk
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/pango"
+)
+
+var (
+	// xIMContextGetPreeditStringPtr is gtk_im_context_get_preedit_string with the out parameters as pointers,
+	// the string and the attributes are owned by the caller
+	xIMContextGetPreeditStringPtr func(uintptr, *uintptr, *uintptr, *int32)
+	// xIMContextSetCursorLocationPtr is gtk_im_context_set_cursor_location with the rectangle as C ints
+	xIMContextSetCursorLocationPtr func(uintptr, *[4]int32)
+	xIMAttrListUnref               func(uintptr)
+)
+
+// TextInput connects an input method to a custom text widget,
+// such that text can be entered with dead keys, compose sequences and input methods for e.g. Chinese, Japanese or Korean.
+// Keys that the input method does not consume reach the other key handlers of the widget as usual.
+type TextInput struct {
+	// Context is the input method context, e.g. to add its input method menu
+	Context *IMMulticontext
+
+	widget    *Widget
+	keys      *EventControllerKey
+	focus     *EventControllerFocus
+	onPreedit func(text string, cursor int, attrs *pango.AttrList)
+}
+
+// NewTextInput adds an input method to the widget, which is made focusable,
+// and calls onCommit with the text that the user entered, e.g. a single character or a converted word.
+// The input method is active while the widget has the focus.
+func NewTextInput(widget *Widget, onCommit func(text string)) *TextInput {
+	t := &TextInput{
+		Context: NewIMMulticontext(),
+		widget:  widget,
+		keys:    NewEventControllerKey(),
+		focus:   NewEventControllerFocus(),
+	}
+	t.Context.SetClientWidget(widget)
+	t.Context.ConnectCommitFunc(func(_ IMContext, text string) {
+		onCommit(text)
+	})
+	t.Context.ConnectPreeditChangedFunc(func(IMContext) {
+		t.preeditChanged()
+	})
+	t.Context.ConnectPreeditEndFunc(func(IMContext) {
+		if t.onPreedit != nil {
+			t.onPreedit("", 0, nil)
+		}
+	})
+
+	// the key controller passes the key events to the input method first
+	t.keys.SetImContext(&t.Context.IMContext)
+	t.focus.ConnectEnterFunc(func(EventControllerFocus) {
+		t.Context.FocusIn()
+	})
+	t.focus.ConnectLeaveFunc(func(EventControllerFocus) {
+		t.Context.FocusOut()
+	})
+	widget.SetFocusable(true)
+	widget.AddController(&t.keys.EventController)
+	widget.AddController(&t.focus.EventController)
+	if widget.HasFocus() {
+		t.Context.FocusIn()
+	}
+	return t
+}
+
+// OnPreedit calls fn whenever the preedit text changes, which is the text that is being composed and not yet committed.
+// The widget should show it at the cursor, cursor is the position of the cursor within it in characters
+// and attrs are the attributes to draw it with, e.g. underlines. attrs is only valid during the call.
+// When composing ends, fn is called with an empty text and nil attributes.
+func (t *TextInput) OnPreedit(fn func(text string, cursor int, attrs *pango.AttrList)) {
+	t.onPreedit = fn
+}
+
+func (t *TextInput) preeditChanged() {
+	if t.onPreedit == nil {
+		return
+	}
+	var str, attrs uintptr
+	var cursor int32
+	xIMContextGetPreeditStringPtr(t.Context.GoPointer(), &str, &attrs, &cursor)
+	text := core.GoString(str)
+	glib.Free(str)
+	var list *pango.AttrList
+	if attrs != 0 {
+		defer xIMAttrListUnref(attrs)
+		// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+		list = (*pango.AttrList)(*(*unsafe.Pointer)(unsafe.Pointer(&attrs)))
+	}
+	t.onPreedit(text, int(cursor), list)
+}
+
+// OnSurrounding lets input methods that convert text in context, e.g. for Thai or Vietnamese, access the text around the cursor.
+// retrieve returns the text of the paragraph with the cursor and the selection anchor as byte offsets within it,
+// both are the same without a selection.
+// remove deletes nChars characters starting offset characters from the cursor and returns whether it did.
+func (t *TextInput) OnSurrounding(retrieve func() (text string, cursor, anchor int), remove func(offset, nChars int) bool) {
+	t.Context.ConnectRetrieveSurroundingFunc(func(IMContext) bool {
+		text, cursor, anchor := retrieve()
+		t.Context.SetSurroundingWithSelection(text, len(text), cursor, anchor)
+		return true
+	})
+	t.Context.ConnectDeleteSurroundingFunc(func(_ IMContext, offset, nChars int) bool {
+		// the arguments are C ints
+		return remove(int(int32(offset)), int(int32(nChars)))
+	})
+}
+
+// SetCursorLocation tells the input method where the cursor is in widget coordinates,
+// such that it can show its candidate window next to it. Call it whenever the cursor moves.
+func (t *TextInput) SetCursorLocation(x, y, width, height int) {
+	area := [4]int32{int32(x), int32(y), int32(width), int32(height)}
+	xIMContextSetCursorLocationPtr(t.Context.GoPointer(), &area)
+}
+
+// Reset discards the text that is being composed, e.g. when the cursor is moved with the mouse.
+func (t *TextInput) Reset() {
+	t.Context.Reset()
+}
+
+// Remove removes the input method from the widget.
+func (t *TextInput) Remove() {
+	t.Context.FocusOut()
+	t.widget.RemoveController(&t.keys.EventController)
+	t.widget.RemoveController(&t.focus.EventController)
+	t.Context.SetClientWidget(nil)
+	t.Context.Unref()
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xIMContextGetPreeditStringPtr, libs, "gtk_im_context_get_preedit_string")
+	core.PuregoSafeRegister(&xIMContextSetCursorLocationPtr, libs, "gtk_im_context_set_cursor_location")
+
+	libs = nil
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xIMAttrListUnref, libs, "pango_attr_list_unref")
+}
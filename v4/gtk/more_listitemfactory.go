@@ -0,0 +1,35 @@
+package gtk
+
+// NewListItemFactoryGo returns a factory for a ListView, GridView or ColumnViewColumn that calls the Go functions
+// for the list items it manages, any of them may be nil:
+//
+//	factory := gtk.NewListItemFactoryGo(func(item *gtk.ListItem) {
+//		item.SetChild(&gtk.NewLabel(nil).Widget)
+//	}, func(item *gtk.ListItem) {
+//		gtk.LabelNewFromInternalPtr(item.GetChild().GoPointer()).SetText(names[item.GetItem().GoPointer()])
+//	}, nil, nil)
+//	listView := gtk.NewListView(selection, &factory.ListItemFactory)
+//
+// setup creates the widgets of an item and teardown releases them, bind shows the item of the model in them
+// and unbind releases what bind acquired, e.g. signal handlers on the item of the model.
+// The items of a column view are ColumnViewCells, whose ListItem methods work as usual.
+// The functions are released when the factory is finalized, the list widget that takes the factory keeps it alive.
+// For a header factory, which manages ListHeaders, connect the signals of a SignalListItemFactory instead.
+func NewListItemFactoryGo(setup, bind, unbind, teardown func(item *ListItem)) *SignalListItemFactory {
+	factory := NewSignalListItemFactory()
+	connect := func(fn func(item *ListItem), connectFunc func(func(SignalListItemFactory, uintptr)) uint) {
+		if fn == nil {
+			return
+		}
+		connectFunc(func(_ SignalListItemFactory, item uintptr) {
+			if item != 0 {
+				fn(ListItemNewFromInternalPtr(item))
+			}
+		})
+	}
+	connect(setup, factory.ConnectSetupFunc)
+	connect(bind, factory.ConnectBindFunc)
+	connect(unbind, factory.ConnectUnbindFunc)
+	connect(teardown, factory.ConnectTeardownFunc)
+	return factory
+}
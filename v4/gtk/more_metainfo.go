@@ -0,0 +1,314 @@
+package gtk
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Metainfo is the information of an AppStream metainfo file that is shown in about dialogs.
+// Parse the file of the application, e.g. embedded with go:embed, with ParseMetainfo.
+type Metainfo struct {
+	ID              string
+	Name            string
+	Summary         string
+	DeveloperName   string
+	ProjectLicense  string
+	MetadataLicense string
+	// Description is the description of the application in the AppStream description markup
+	Description string
+	// URLs maps the url types, e.g. "homepage" or "bugtracker", to the url
+	URLs map[string]string
+	// Releases are the releases, newest first
+	Releases []Release
+}
+
+// Release is a release of the application.
+type Release struct {
+	Version string
+	// Date is the date as written in the file, e.g. "2024-05-01"
+	Date string
+	// Description are the release notes in the AppStream description markup
+	Description string
+}
+
+// localized is an element that can be repeated for translations
+type localized struct {
+	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+type rawDescription struct {
+	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Inner string `xml:",innerxml"`
+}
+
+type metainfoXML struct {
+	XMLName       xml.Name         `xml:"component"`
+	ID            string           `xml:"id"`
+	Names         []localized      `xml:"name"`
+	Summaries     []localized      `xml:"summary"`
+	Developer     []localized      `xml:"developer>name"`
+	DeveloperName []localized      `xml:"developer_name"`
+	ProjectLic    string           `xml:"project_license"`
+	MetadataLic   string           `xml:"metadata_license"`
+	Descriptions  []rawDescription `xml:"description"`
+	URLs          []struct {
+		Type  string `xml:"type,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"url"`
+	Releases []struct {
+		Version      string           `xml:"version,attr"`
+		Date         string           `xml:"date,attr"`
+		Timestamp    string           `xml:"timestamp,attr"`
+		Descriptions []rawDescription `xml:"description"`
+	} `xml:"releases>release"`
+}
+
+// untranslated returns the value without a language
+func untranslated(values []localized) string {
+	for _, v := range values {
+		if v.Lang == "" {
+			return strings.TrimSpace(v.Value)
+		}
+	}
+	return ""
+}
+
+// descriptionMarkup returns the untranslated description with all translated paragraphs removed
+func descriptionMarkup(descs []rawDescription) (string, error) {
+	for _, d := range descs {
+		if d.Lang == "" {
+			return stripTranslated(d.Inner)
+		}
+	}
+	return "", nil
+}
+
+// stripTranslated re-serializes the description markup without elements that have a xml:lang attribute
+func stripTranslated(inner string) (string, error) {
+	dec := xml.NewDecoder(strings.NewReader(inner))
+	var sb strings.Builder
+	skip := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			for _, a := range t.Attr {
+				if a.Name.Local == "lang" {
+					skip = 1
+				}
+			}
+			if skip == 0 {
+				sb.WriteString("<" + t.Name.Local + ">")
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			sb.WriteString("</" + t.Name.Local + ">")
+		case xml.CharData:
+			if skip == 0 {
+				xml.EscapeText(&sb, t)
+			}
+		}
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// ParseMetainfo parses an AppStream metainfo file.
+// Only the untranslated values are read.
+func ParseMetainfo(data []byte) (*Metainfo, error) {
+	var raw metainfoXML
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("metainfo: %w", err)
+	}
+	m := &Metainfo{
+		ID:              strings.TrimSpace(raw.ID),
+		Name:            untranslated(raw.Names),
+		Summary:         untranslated(raw.Summaries),
+		DeveloperName:   untranslated(raw.Developer),
+		ProjectLicense:  strings.TrimSpace(raw.ProjectLic),
+		MetadataLicense: strings.TrimSpace(raw.MetadataLic),
+		URLs:            make(map[string]string),
+	}
+	if m.DeveloperName == "" {
+		m.DeveloperName = untranslated(raw.DeveloperName)
+	}
+	var err error
+	if m.Description, err = descriptionMarkup(raw.Descriptions); err != nil {
+		return nil, fmt.Errorf("metainfo: description: %w", err)
+	}
+	for _, u := range raw.URLs {
+		m.URLs[u.Type] = strings.TrimSpace(u.Value)
+	}
+	for _, r := range raw.Releases {
+		rel := Release{Version: r.Version, Date: r.Date}
+		if rel.Description, err = descriptionMarkup(r.Descriptions); err != nil {
+			return nil, fmt.Errorf("metainfo: release %s: %w", r.Version, err)
+		}
+		m.Releases = append(m.Releases, rel)
+	}
+	return m, nil
+}
+
+// LatestRelease returns the newest release or nil if the file lists no releases.
+func (m *Metainfo) LatestRelease() *Release {
+	if len(m.Releases) == 0 {
+		return nil
+	}
+	return &m.Releases[0]
+}
+
+var spdxLicenses = map[string]License{
+	"GPL-2.0":           LicenseGpl20Value,
+	"GPL-2.0+":          LicenseGpl20Value,
+	"GPL-2.0-or-later":  LicenseGpl20Value,
+	"GPL-2.0-only":      LicenseGpl20OnlyValue,
+	"GPL-3.0":           LicenseGpl30Value,
+	"GPL-3.0+":          LicenseGpl30Value,
+	"GPL-3.0-or-later":  LicenseGpl30Value,
+	"GPL-3.0-only":      LicenseGpl30OnlyValue,
+	"LGPL-2.1":          LicenseLgpl21Value,
+	"LGPL-2.1+":         LicenseLgpl21Value,
+	"LGPL-2.1-or-later": LicenseLgpl21Value,
+	"LGPL-2.1-only":     LicenseLgpl21OnlyValue,
+	"LGPL-3.0":          LicenseLgpl30Value,
+	"LGPL-3.0+":         LicenseLgpl30Value,
+	"LGPL-3.0-or-later": LicenseLgpl30Value,
+	"LGPL-3.0-only":     LicenseLgpl30OnlyValue,
+	"AGPL-3.0":          LicenseAgpl30Value,
+	"AGPL-3.0-or-later": LicenseAgpl30Value,
+	"AGPL-3.0-only":     LicenseAgpl30OnlyValue,
+	"BSD-2-Clause":      LicenseBsdValue,
+	"BSD-3-Clause":      LicenseBsd3Value,
+	"MIT":               LicenseMitX11Value,
+	"X11":               LicenseMitX11Value,
+	"Artistic-2.0":      LicenseArtisticValue,
+	"Apache-2.0":        LicenseApache20Value,
+	"MPL-2.0":           LicenseMpl20Value,
+	"0BSD":              License0bsdValue,
+}
+
+// LicenseType returns the license type for the SPDX identifier of the project license,
+// LicenseCustomValue if there is no matching type and LicenseUnknownValue if the file has no project license.
+func (m *Metainfo) LicenseType() License {
+	if m.ProjectLicense == "" {
+		return LicenseUnknownValue
+	}
+	if l, ok := spdxLicenses[m.ProjectLicense]; ok {
+		return l
+	}
+	return LicenseCustomValue
+}
+
+// descriptionTags are the elements that are allowed in description markup
+var descriptionTags = map[string]bool{"p": true, "ul": true, "ol": true, "li": true, "em": true, "code": true}
+
+// Validate returns warnings for missing or malformed information that about dialogs and software centers show.
+func (m *Metainfo) Validate() []string {
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	required := []struct{ value, tag string }{
+		{m.ID, "id"},
+		{m.Name, "name"},
+		{m.Summary, "summary"},
+		{m.DeveloperName, "developer"},
+		{m.ProjectLicense, "project_license"},
+		{m.MetadataLicense, "metadata_license"},
+		{m.Description, "description"},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			warn("missing <%s>", r.tag)
+		}
+	}
+	if m.URLs["homepage"] == "" {
+		warn("missing <url type=\"homepage\">")
+	}
+	if m.ProjectLicense != "" && m.LicenseType() == LicenseCustomValue {
+		warn("project license %q has no matching GTK license type and is shown as custom license", m.ProjectLicense)
+	}
+	warnMarkup := func(where, markup string) {
+		for _, tag := range unsupportedTags(markup) {
+			warn("%s uses unsupported markup <%s>", where, tag)
+		}
+	}
+	warnMarkup("description", m.Description)
+	if len(m.Releases) == 0 {
+		warn("no <release> in <releases>")
+	}
+	for i, r := range m.Releases {
+		if r.Version == "" {
+			warn("release %d has no version", i+1)
+		}
+		if r.Date == "" {
+			warn("release %s has no date", r.Version)
+		}
+		if i > 0 && r.Date != "" && m.Releases[i-1].Date != "" && r.Date > m.Releases[i-1].Date {
+			warn("release %s is newer than release %s before it, releases must be listed newest first", r.Version, m.Releases[i-1].Version)
+		}
+		warnMarkup("release "+r.Version, r.Description)
+	}
+	return warnings
+}
+
+// unsupportedTags returns the element names in markup that are not allowed in description markup
+func unsupportedTags(markup string) []string {
+	var tags []string
+	dec := xml.NewDecoder(strings.NewReader(markup))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return tags
+		}
+		if s, ok := tok.(xml.StartElement); ok && !descriptionTags[s.Name.Local] {
+			tags = append(tags, s.Name.Local)
+		}
+	}
+}
+
+// ApplyMetainfo fills the dialog with the name, summary, version, website, license and developer of m.
+// GtkAboutDialog has no release notes, use the about dialog of libadwaita to show them.
+func (x *AboutDialog) ApplyMetainfo(m *Metainfo) {
+	if m.Name != "" {
+		x.SetProgramName(&m.Name)
+	}
+	if m.ID != "" {
+		x.SetLogoIconName(&m.ID)
+	}
+	if m.Summary != "" {
+		x.SetComments(&m.Summary)
+	}
+	if r := m.LatestRelease(); r != nil && r.Version != "" {
+		x.SetVersion(&r.Version)
+	}
+	if u := m.URLs["homepage"]; u != "" {
+		x.SetWebsite(&u)
+	}
+	if m.DeveloperName != "" {
+		x.SetAuthors([]string{m.DeveloperName})
+	}
+	switch t := m.LicenseType(); t {
+	case LicenseUnknownValue:
+	case LicenseCustomValue:
+		x.SetLicense(&m.ProjectLicense)
+	default:
+		x.SetLicenseType(t)
+	}
+}
@@ -0,0 +1,142 @@
+package gtk
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// goPaintableKey is the key of the Go state of a GoPaintable, see gobject.SetData
+const goPaintableKey = "puregotk-go-paintable"
+
+// GoPaintable is a gdk.Paintable whose contents are drawn by a Go function, see NewGoPaintable.
+type GoPaintable struct {
+	gdk.PaintableBase
+}
+
+// goPaintable is the Go state of a GoPaintable, its methods implement the virtual functions of gdk.Paintable
+// it is not GoPaintable itself as the methods of gdk.PaintableBase call the virtual functions
+type goPaintable struct {
+	mu     sync.Mutex
+	draw   func(snapshot *Snapshot, width, height float64)
+	width  int
+	height int
+}
+
+func (p *goPaintable) Snapshot(snapshot *gdk.Snapshot, width, height float64) {
+	p.mu.Lock()
+	draw := p.draw
+	p.mu.Unlock()
+	if draw != nil && snapshot != nil {
+		draw(&Snapshot{Snapshot: *snapshot}, width, height)
+	}
+}
+
+func (p *goPaintable) GetIntrinsicWidth() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.width
+}
+
+func (p *goPaintable) GetIntrinsicHeight() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.height
+}
+
+// paintableTypeQuery is the C layout of GTypeQuery, the sizes are guint
+type paintableTypeQuery struct {
+	gtype        types.GType
+	typeName     uintptr
+	classSize    uint32
+	instanceSize uint32
+}
+
+var (
+	goPaintableOnce sync.Once
+	goPaintableType types.GType
+)
+
+// goPaintableClassInit stays referenced as the class init function of the type
+var goPaintableClassInit gobject.ClassInitFunc = func(*gobject.TypeClass, uintptr) {}
+
+// registerGoPaintable registers PuregotkGoPaintable, a final subclass of GObject without fields of its own
+// that implements gdk.Paintable with the Go state attached to its instances
+func registerGoPaintable() types.GType {
+	goPaintableOnce.Do(func() {
+		var q paintableTypeQuery
+		gobject.NewTypeQuery(gobject.ObjectGLibType(), (*gobject.TypeQuery)(unsafe.Pointer(&q)))
+		goPaintableType = gobject.TypeRegisterStaticSimple(gobject.ObjectGLibType(), "PuregotkGoPaintable", uint(q.classSize), &goPaintableClassInit, uint(q.instanceSize), nil, gobject.GTypeFlagFinalValue)
+		gdk.ImplementPaintable(goPaintableType, func(obj *gobject.Object) *goPaintable {
+			p, _ := gobject.GetData[*goPaintable](obj, goPaintableKey)
+			return p
+		})
+	})
+	return goPaintableType
+}
+
+// NewGoPaintable returns a paintable whose contents are drawn by draw, e.g. to show content rendered by Go
+// in a Picture or an Image:
+//
+//	paintable := gtk.NewGoPaintable(func(snapshot *gtk.Snapshot, width, height float64) {
+//		rect := graphene.RectAlloc().Init(0, 0, float32(width), float32(height))
+//		snapshot.AppendColor(&color, rect)
+//	})
+//	picture.SetPaintable(paintable)
+//	paintable.Unref()
+//
+// draw is called with the snapshot to draw on and the size to draw at whenever GTK draws the paintable,
+// call Invalidate when the contents change, e.g. on every frame of an animation.
+// The paintable has no intrinsic size until SetIntrinsicSize is called, so it is scaled to the size of its widget.
+// The caller owns the returned reference.
+func NewGoPaintable(draw func(snapshot *Snapshot, width, height float64)) *GoPaintable {
+	obj := gobject.NewObjectWithProperties(registerGoPaintable(), 0, nil, nil)
+	gobject.SetData(obj, goPaintableKey, &goPaintable{draw: draw})
+	p := &GoPaintable{}
+	p.Ptr = obj.GoPointer()
+	return p
+}
+
+func (x *GoPaintable) state() *goPaintable {
+	p, _ := gobject.GetData[*goPaintable](&gobject.Object{Ptr: x.Ptr}, goPaintableKey)
+	return p
+}
+
+// SetDrawFunc replaces the function that draws the contents and redraws the paintable.
+func (x *GoPaintable) SetDrawFunc(draw func(snapshot *Snapshot, width, height float64)) {
+	if p := x.state(); p != nil {
+		p.mu.Lock()
+		p.draw = draw
+		p.mu.Unlock()
+	}
+	x.InvalidateContents()
+}
+
+// SetIntrinsicSize sets the size that the paintable prefers to be drawn at, 0 for no preference,
+// and lets the widgets that show it resize.
+func (x *GoPaintable) SetIntrinsicSize(width, height int) {
+	if p := x.state(); p != nil {
+		p.mu.Lock()
+		p.width, p.height = width, height
+		p.mu.Unlock()
+	}
+	x.InvalidateSize()
+}
+
+// Invalidate redraws the paintable, call it when the contents that draw renders have changed.
+func (x *GoPaintable) Invalidate() {
+	x.InvalidateContents()
+}
+
+// Ref increases the reference count of the paintable.
+func (x *GoPaintable) Ref() {
+	(&gobject.Object{Ptr: x.Ptr}).Ref()
+}
+
+// Unref decreases the reference count of the paintable, its draw function is released with the last reference.
+func (x *GoPaintable) Unref() {
+	(&gobject.Object{Ptr: x.Ptr}).Unref()
+}
@@ -0,0 +1,30 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// NewWithProperties creates an instance of the class with the properties set to the Go values, see gobject.Value.InitGo.
+// T is the generated class of gtype or one of its parents, e.g. for a class whose only C constructor is variadic:
+//
+//	dialog, err := gtk.NewWithProperties[gtk.Dialog](gtk.DialogGLibType(), map[string]interface{}{
+//		"use-header-bar": 1,
+//		"title":          "Settings",
+//		"modal":          true,
+//	})
+//
+// Like the generated constructors, widgets are returned with a floating reference.
+// It returns an error if the class has no such property or a value cannot be converted to the type of its property.
+func NewWithProperties[T any, PT interface {
+	*T
+	SetGoPointer(uintptr)
+}](gtype types.GType, properties map[string]interface{}) (*T, error) {
+	obj, err := gobject.NewObjectWithGoValues(gtype, properties)
+	if err != nil {
+		return nil, err
+	}
+	cls := PT(new(T))
+	cls.SetGoPointer(obj.Ptr)
+	return (*T)(cls), nil
+}
@@ -0,0 +1,94 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// sortTrampoline and filterTrampoline are shared by all custom sorters and filters
+// the Go function is looked up by the user data such that only one callback is allocated
+var sortTrampoline glib.CompareDataFunc = func(a, b uintptr, id uintptr) int {
+	compare, ok := glib.LookupUserData[func(a, b uintptr) int](id)
+	if !ok {
+		return int(OrderingEqualValue)
+	}
+	return compare(a, b)
+}
+
+var filterTrampoline CustomFilterFunc = func(item uintptr, id uintptr) bool {
+	match, ok := glib.LookupUserData[func(item uintptr) bool](id)
+	return ok && match(item)
+}
+
+// goPointerSetter is implemented by pointers to the generated classes, such as a *StringObject
+type goPointerSetter[T any] interface {
+	*T
+	SetGoPointer(uintptr)
+}
+
+// itemOf returns the item at ptr as a pointer to a generated class
+func itemOf[T any, PT goPointerSetter[T]](ptr uintptr) PT {
+	item := PT(new(T))
+	item.SetGoPointer(ptr)
+	return item
+}
+
+// NewCustomSorterGo returns a sorter that orders the items of a model with compare, which returns a negative number
+// if item a sorts before item b, a positive number if it sorts after and 0 if they are equal, like cmp.Compare.
+// compare is released when the sorter is finalized, call Changed on the sorter when its order changes.
+func NewCustomSorterGo(compare func(a, b uintptr) int) *CustomSorter {
+	if compare == nil {
+		return NewCustomSorter(nil, 0, nil)
+	}
+	return NewCustomSorter(&sortTrampoline, glib.RegisterUserData(compare), glib.UserDataDestroyNotify())
+}
+
+// NewCustomSorterOf is NewCustomSorterGo for models of a generated class, e.g.
+//
+//	sorter := gtk.NewCustomSorterOf(func(a, b *gtk.StringObject) int {
+//		return strings.Compare(a.GetString(), b.GetString())
+//	})
+func NewCustomSorterOf[T any, PT goPointerSetter[T]](compare func(a, b PT) int) *CustomSorter {
+	return NewCustomSorterGo(func(a, b uintptr) int {
+		return compare(itemOf[T, PT](a), itemOf[T, PT](b))
+	})
+}
+
+// SetSortFuncGo replaces the function that orders the items, see NewCustomSorterGo.
+// The previous function is released and the sorter emits "changed".
+func (x *CustomSorter) SetSortFuncGo(compare func(a, b uintptr) int) {
+	if compare == nil {
+		x.SetSortFunc(nil, 0, nil)
+		return
+	}
+	x.SetSortFunc(&sortTrampoline, glib.RegisterUserData(compare), glib.UserDataDestroyNotify())
+}
+
+// NewCustomFilterGo returns a filter that keeps the items of a model for which match returns true.
+// match is released when the filter is finalized, call Changed on the filter when the items it matches change.
+func NewCustomFilterGo(match func(item uintptr) bool) *CustomFilter {
+	if match == nil {
+		return NewCustomFilter(nil, 0, nil)
+	}
+	return NewCustomFilter(&filterTrampoline, glib.RegisterUserData(match), glib.UserDataDestroyNotify())
+}
+
+// NewCustomFilterOf is NewCustomFilterGo for models of a generated class, e.g.
+//
+//	filter := gtk.NewCustomFilterOf(func(item *gtk.StringObject) bool {
+//		return strings.Contains(item.GetString(), query)
+//	})
+func NewCustomFilterOf[T any, PT goPointerSetter[T]](match func(item PT) bool) *CustomFilter {
+	return NewCustomFilterGo(func(item uintptr) bool {
+		return match(itemOf[T, PT](item))
+	})
+}
+
+// SetFilterFuncGo replaces the function that matches the items, see NewCustomFilterGo.
+// The previous function is released and the filter emits "changed".
+func (x *CustomFilter) SetFilterFuncGo(match func(item uintptr) bool) {
+	if match == nil {
+		x.SetFilterFunc(nil, 0, nil)
+		return
+	}
+	x.SetFilterFunc(&filterTrampoline, glib.RegisterUserData(match), glib.UserDataDestroyNotify())
+}
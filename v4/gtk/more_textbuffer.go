@@ -0,0 +1,95 @@
+package gtk
+
+// The Range and At methods of TextBuffer take character offsets instead of TextIters, which they look up
+// with GetIterAtOffset. An offset counts characters, not bytes, and includes hidden text and the
+// characters of embedded paintables and child anchors. A negative offset or one past the end is the end of the buffer.
+
+// iterAt returns an iter at the character offset, at the end of the buffer if offset is negative or past the end
+func (x *TextBuffer) iterAt(offset int) *TextIter {
+	var iter TextIter
+	if offset < 0 {
+		offset = -1
+	}
+	x.GetIterAtOffset(&iter, offset)
+	return &iter
+}
+
+// iterRange returns the iters at the character offsets start and end
+func (x *TextBuffer) iterRange(start, end int) (*TextIter, *TextIter) {
+	return x.iterAt(start), x.iterAt(end)
+}
+
+// InsertAt inserts text at the character offset.
+func (x *TextBuffer) InsertAt(offset int, text string) {
+	x.Insert(x.iterAt(offset), text, -1)
+}
+
+// InsertMarkupAt inserts the Pango markup at the character offset, with tags for its formatting.
+func (x *TextBuffer) InsertMarkupAt(offset int, markup string) {
+	x.InsertMarkup(x.iterAt(offset), markup, -1)
+}
+
+// DeleteRange deletes the text between the character offsets start and end.
+func (x *TextBuffer) DeleteRange(start, end int) {
+	s, e := x.iterRange(start, end)
+	x.Delete(s, e)
+}
+
+// GetTextRange returns the text between the character offsets start and end, including hidden text,
+// such that the offsets into it match those of the buffer, except for paintables and child anchors, which are left out.
+func (x *TextBuffer) GetTextRange(start, end int) string {
+	s, e := x.iterRange(start, end)
+	return x.GetText(s, e, true)
+}
+
+// ApplyTagRange applies the tag to the text between the character offsets start and end.
+func (x *TextBuffer) ApplyTagRange(tag *TextTag, start, end int) {
+	s, e := x.iterRange(start, end)
+	x.ApplyTag(tag, s, e)
+}
+
+// ApplyTagByNameRange applies the tag called name in the tag table of the buffer
+// to the text between the character offsets start and end, e.g. a tag created with CreateTag.
+func (x *TextBuffer) ApplyTagByNameRange(name string, start, end int) {
+	s, e := x.iterRange(start, end)
+	x.ApplyTagByName(name, s, e)
+}
+
+// RemoveTagRange removes the tag from the text between the character offsets start and end.
+func (x *TextBuffer) RemoveTagRange(tag *TextTag, start, end int) {
+	s, e := x.iterRange(start, end)
+	x.RemoveTag(tag, s, e)
+}
+
+// RemoveTagByNameRange removes the tag called name from the text between the character offsets start and end.
+func (x *TextBuffer) RemoveTagByNameRange(name string, start, end int) {
+	s, e := x.iterRange(start, end)
+	x.RemoveTagByName(name, s, e)
+}
+
+// SelectRangeAt selects the text between the character offsets start and end, the cursor moves to start.
+func (x *TextBuffer) SelectRangeAt(start, end int) {
+	s, e := x.iterRange(start, end)
+	x.SelectRange(s, e)
+}
+
+// GetSelectionRange returns the character offsets of the selection, ok is false if nothing is selected,
+// then start and end are the offset of the cursor.
+func (x *TextBuffer) GetSelectionRange() (start, end int, ok bool) {
+	var s, e TextIter
+	ok = x.GetSelectionBounds(&s, &e)
+	return s.GetOffset(), e.GetOffset(), ok
+}
+
+// CreateMarkAt creates a mark at the character offset, see CreateMark.
+// A mark keeps its position in the text when text before it is inserted or deleted, use GetMarkOffset to look it up.
+func (x *TextBuffer) CreateMarkAt(name *string, offset int, leftGravity bool) *TextMark {
+	return x.CreateMark(name, x.iterAt(offset), leftGravity)
+}
+
+// GetMarkOffset returns the character offset of the mark.
+func (x *TextBuffer) GetMarkOffset(mark *TextMark) int {
+	var iter TextIter
+	x.GetIterAtMark(&iter, mark)
+	return iter.GetOffset()
+}
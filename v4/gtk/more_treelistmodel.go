@@ -0,0 +1,50 @@
+package gtk
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// treeChildTrampoline is shared by all tree list models
+// the Go function is looked up by the user data such that only one callback is allocated
+var treeChildTrampoline TreeListModelCreateModelFunc = func(item uintptr, id uintptr) uintptr {
+	children, ok := glib.LookupUserData[func(item uintptr) gio.ListModel](id)
+	if !ok {
+		return 0
+	}
+	model := children(item)
+	if model == nil {
+		return 0
+	}
+	return model.GoPointer()
+}
+
+// NewTreeListModelGo returns a tree of the items of root whose children are the models that children returns for an item,
+// or nil for an item that cannot be expanded, e.g. to show a directory tree in a ListView with TreeExpanders:
+//
+//	tree := gtk.NewTreeListModelGo(root, false, false, func(item uintptr) gio.ListModel {
+//		if !isDir(item) {
+//			return nil
+//		}
+//		return listDir(item)
+//	})
+//
+// children is called when a row is expanded, or for every row if autoexpand is set, and the tree takes
+// the reference of the model that it returns, as it takes the reference of root.
+// With passthrough the items of the tree are those of the models, otherwise they are TreeListRows
+// whose GetItem returns them; children is always called with the item of the model.
+// children is released when the tree is finalized.
+func NewTreeListModelGo(root gio.ListModel, passthrough bool, autoexpand bool, children func(item uintptr) gio.ListModel) *TreeListModel {
+	return NewTreeListModel(root, passthrough, autoexpand, &treeChildTrampoline, glib.RegisterUserData(children), glib.UserDataDestroyNotify())
+}
+
+// NewTreeListModelOf is NewTreeListModelGo for models of a generated class, e.g.
+//
+//	tree := gtk.NewTreeListModelOf(root, false, false, func(item *gtk.StringObject) gio.ListModel {
+//		return childrenOf(item.GetString())
+//	})
+func NewTreeListModelOf[T any, PT goPointerSetter[T]](root gio.ListModel, passthrough bool, autoexpand bool, children func(item PT) gio.ListModel) *TreeListModel {
+	return NewTreeListModelGo(root, passthrough, autoexpand, func(item uintptr) gio.ListModel {
+		return children(itemOf[T, PT](item))
+	})
+}
@@ -0,0 +1,141 @@
+package gtk
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// The variadic functions of GTK are generated with a call to these helpers, which use the non-variadic equivalents,
+// see internal/gir/types/varargs.go
+
+var (
+	// the generated *_valuesv functions pass the columns with Go sized ints, C expects an array of C ints
+	xListStoreSetValuesvPtr        func(uintptr, *TreeIter, *int32, *gobject.Value, int32)
+	xListStoreInsertWithValuesvPtr func(uintptr, *TreeIter, int32, *int32, *gobject.Value, int32)
+	xTreeStoreSetValuesvPtr        func(uintptr, *TreeIter, *int32, *gobject.Value, int32)
+	xTreeStoreInsertWithValuesvPtr func(uintptr, *TreeIter, *TreeIter, int32, *int32, *gobject.Value, int32)
+)
+
+// varArgsInt returns the Go integer, e.g. an int or a ResponseType, as int
+func varArgsInt(v interface{}) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// columnValues converts the column and value pairs of the variadic tree model functions,
+// the terminating -1 of the C functions may be left out
+func columnValues(columnType func(int) types.GType, varArgs []interface{}) ([]int32, []gobject.Value) {
+	var columns []int32
+	var values []gobject.Value
+	for i := 0; i < len(varArgs); i += 2 {
+		column, ok := varArgsInt(varArgs[i])
+		if !ok {
+			unsetColumnValues(values)
+			panic(fmt.Sprintf("gtk: expected a column, got %T", varArgs[i]))
+		}
+		if column == -1 {
+			break
+		}
+		if i+1 >= len(varArgs) {
+			unsetColumnValues(values)
+			panic(fmt.Sprintf("gtk: missing value for column %d", column))
+		}
+		var v gobject.Value
+		if err := v.InitGo(columnType(column), varArgs[i+1]); err != nil {
+			unsetColumnValues(values)
+			panic(fmt.Sprintf("%s for column %d", err, column))
+		}
+		columns = append(columns, int32(column))
+		values = append(values, v)
+	}
+	return columns, values
+}
+
+func unsetColumnValues(values []gobject.Value) {
+	for i := range values {
+		values[i].Unset()
+	}
+}
+
+// textBufferCreateTagVarArgs implements gtk_text_buffer_create_tag with g_object_setv and gtk_text_tag_table_add
+func textBufferCreateTagVarArgs(x *TextBuffer, TagNameVar *string, FirstPropertyNameVar *string, varArgs ...interface{}) *TextTag {
+	tag := NewTextTag(TagNameVar)
+	if FirstPropertyNameVar != nil {
+		tag.Set(*FirstPropertyNameVar, varArgs...)
+	}
+	// the table takes a reference, like gtk_text_buffer_create_tag the returned tag is owned by the table
+	defer tag.Unref()
+	if !x.GetTagTable().Add(tag) {
+		return nil
+	}
+	return tag
+}
+
+// listStoreSetVarArgs implements gtk_list_store_set with gtk_list_store_set_valuesv
+func listStoreSetVarArgs(x *ListStore, IterVar *TreeIter, varArgs ...interface{}) {
+	columns, values := columnValues(x.GetColumnType, varArgs)
+	if len(columns) == 0 {
+		return
+	}
+	defer unsetColumnValues(values)
+	xListStoreSetValuesvPtr(x.GoPointer(), IterVar, &columns[0], &values[0], int32(len(columns)))
+}
+
+// listStoreInsertWithValuesVarArgs implements gtk_list_store_insert_with_values with gtk_list_store_insert_with_valuesv
+func listStoreInsertWithValuesVarArgs(x *ListStore, IterVar *TreeIter, PositionVar int, varArgs ...interface{}) {
+	columns, values := columnValues(x.GetColumnType, varArgs)
+	defer unsetColumnValues(values)
+	var column *int32
+	var value *gobject.Value
+	if len(columns) > 0 {
+		column, value = &columns[0], &values[0]
+	}
+	xListStoreInsertWithValuesvPtr(x.GoPointer(), IterVar, int32(PositionVar), column, value, int32(len(columns)))
+}
+
+// treeStoreSetVarArgs implements gtk_tree_store_set with gtk_tree_store_set_valuesv
+func treeStoreSetVarArgs(x *TreeStore, IterVar *TreeIter, varArgs ...interface{}) {
+	columns, values := columnValues(x.GetColumnType, varArgs)
+	if len(columns) == 0 {
+		return
+	}
+	defer unsetColumnValues(values)
+	xTreeStoreSetValuesvPtr(x.GoPointer(), IterVar, &columns[0], &values[0], int32(len(columns)))
+}
+
+// treeStoreInsertWithValuesVarArgs implements gtk_tree_store_insert_with_values with gtk_tree_store_insert_with_valuesv
+func treeStoreInsertWithValuesVarArgs(x *TreeStore, IterVar *TreeIter, ParentVar *TreeIter, PositionVar int, varArgs ...interface{}) {
+	columns, values := columnValues(x.GetColumnType, varArgs)
+	defer unsetColumnValues(values)
+	var column *int32
+	var value *gobject.Value
+	if len(columns) > 0 {
+		column, value = &columns[0], &values[0]
+	}
+	xTreeStoreInsertWithValuesvPtr(x.GoPointer(), IterVar, ParentVar, int32(PositionVar), column, value, int32(len(columns)))
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GTK") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+	core.PuregoSafeRegister(&xListStoreSetValuesvPtr, libs, "gtk_list_store_set_valuesv")
+	core.PuregoSafeRegister(&xListStoreInsertWithValuesvPtr, libs, "gtk_list_store_insert_with_valuesv")
+	core.PuregoSafeRegister(&xTreeStoreSetValuesvPtr, libs, "gtk_tree_store_set_valuesv")
+	core.PuregoSafeRegister(&xTreeStoreInsertWithValuesvPtr, libs, "gtk_tree_store_insert_with_valuesv")
+}
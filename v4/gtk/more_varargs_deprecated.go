@@ -0,0 +1,143 @@
+//go:build !puregotk_no_deprecated
+
+package gtk
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// The helpers of the variadic functions that are deprecated, they use deprecated functions themselves,
+// see more_varargs.go
+
+// buttonPairs splits the text and response pairs of the variadic button functions,
+// the terminating nil of the C functions may be left out
+func buttonPairs(first *string, varArgs []interface{}) (texts []string, responses []int) {
+	if first == nil {
+		return nil, nil
+	}
+	text := *first
+	for i := 0; ; i++ {
+		if i >= len(varArgs) {
+			panic(fmt.Sprintf("gtk: missing response for button %s", text))
+		}
+		response, ok := varArgsInt(varArgs[i])
+		if !ok {
+			panic(fmt.Sprintf("gtk: expected a response for button %s, got %T", text, varArgs[i]))
+		}
+		texts = append(texts, text)
+		responses = append(responses, response)
+		i++
+		if i >= len(varArgs) || varArgs[i] == nil {
+			return texts, responses
+		}
+		if text, ok = varArgs[i].(string); !ok {
+			panic(fmt.Sprintf("gtk: expected a button text, got %T", varArgs[i]))
+		}
+	}
+}
+
+// newDialogWithButtonsVarArgs implements gtk_dialog_new_with_buttons with gtk_dialog_add_button
+func newDialogWithButtonsVarArgs(TitleVar *string, ParentVar *Window, FlagsVar DialogFlags, FirstButtonTextVar *string, varArgs ...interface{}) *Dialog {
+	// use-header-bar is an int property, it can only be set at construction
+	useHeaderBar := 0
+	if FlagsVar&DialogUseHeaderBarValue != 0 {
+		useHeaderBar = 1
+	}
+	obj := gobject.NewObject(DialogGLibType(), "use-header-bar", useHeaderBar)
+	if obj == nil {
+		return nil
+	}
+	dialog := DialogNewFromInternalPtr(obj.Ptr)
+	if TitleVar != nil {
+		dialog.SetTitle(TitleVar)
+	}
+	if ParentVar != nil {
+		dialog.SetTransientFor(ParentVar)
+	}
+	if FlagsVar&DialogModalValue != 0 {
+		dialog.SetModal(true)
+	}
+	if FlagsVar&DialogDestroyWithParentValue != 0 {
+		dialog.SetDestroyWithParent(true)
+	}
+	dialogAddButtons(dialog, FirstButtonTextVar, varArgs)
+	return dialog
+}
+
+func dialogAddButtons(x *Dialog, first *string, varArgs []interface{}) {
+	texts, responses := buttonPairs(first, varArgs)
+	for i, text := range texts {
+		x.AddButton(text, responses[i])
+	}
+}
+
+// dialogAddButtonsVarArgs implements gtk_dialog_add_buttons with gtk_dialog_add_button
+func dialogAddButtonsVarArgs(x *Dialog, FirstButtonTextVar string, varArgs ...interface{}) {
+	dialogAddButtons(x, &FirstButtonTextVar, varArgs)
+}
+
+// newInfoBarWithButtonsVarArgs implements gtk_info_bar_new_with_buttons with gtk_info_bar_add_button
+func newInfoBarWithButtonsVarArgs(FirstButtonTextVar *string, varArgs ...interface{}) *InfoBar {
+	bar := NewInfoBar()
+	texts, responses := buttonPairs(FirstButtonTextVar, varArgs)
+	for i, text := range texts {
+		bar.AddButton(text, responses[i])
+	}
+	return bar
+}
+
+// infoBarAddButtonsVarArgs implements gtk_info_bar_add_buttons with gtk_info_bar_add_button
+func infoBarAddButtonsVarArgs(x *InfoBar, FirstButtonTextVar string, varArgs ...interface{}) {
+	texts, responses := buttonPairs(&FirstButtonTextVar, varArgs)
+	for i, text := range texts {
+		x.AddButton(text, responses[i])
+	}
+}
+
+// newFileChooserDialogVarArgs implements gtk_file_chooser_dialog_new with g_object_new_with_properties and gtk_dialog_add_button
+func newFileChooserDialogVarArgs(TitleVar *string, ParentVar *Window, ActionVar FileChooserAction, FirstButtonTextVar *string, varArgs ...interface{}) *FileChooserDialog {
+	obj := gobject.NewObject(FileChooserDialogGLibType(), "title", TitleVar, "action", ActionVar)
+	if obj == nil {
+		return nil
+	}
+	dialog := FileChooserDialogNewFromInternalPtr(obj.Ptr)
+	if ParentVar != nil {
+		dialog.SetTransientFor(ParentVar)
+	}
+	dialogAddButtons(&dialog.Dialog, FirstButtonTextVar, varArgs)
+	return dialog
+}
+
+// newListStoreVarArgs implements gtk_list_store_new with gtk_list_store_newv
+func newListStoreVarArgs(NColumnsVar int, varArgs ...interface{}) *ListStore {
+	return NewListStorev(NColumnsVar, columnTypes(NColumnsVar, varArgs))
+}
+
+// newTreeStoreVarArgs implements gtk_tree_store_new with gtk_tree_store_newv
+func newTreeStoreVarArgs(NColumnsVar int, varArgs ...interface{}) *TreeStore {
+	return NewTreeStorev(NColumnsVar, columnTypes(NColumnsVar, varArgs))
+}
+
+func columnTypes(n int, varArgs []interface{}) []types.GType {
+	if len(varArgs) != n {
+		panic(fmt.Sprintf("gtk: expected %d column types, got %d", n, len(varArgs)))
+	}
+	gtypes := make([]types.GType, n)
+	for i, v := range varArgs {
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Uintptr:
+			gtypes[i] = types.GType(rv.Uint())
+		case reflect.Int:
+			// the untyped type constants, such as gobject.TypeStringVal
+			gtypes[i] = types.GType(rv.Int())
+		default:
+			panic(fmt.Sprintf("gtk: expected a GType for column %d, got %T", i, v))
+		}
+	}
+	return gtypes
+}
@@ -0,0 +1,427 @@
+// Package replay records user interaction with a widget tree into a script and plays it back.
+//
+// GTK has no public API to inject input events, so a script is not replayed as raw events.
+// Every step is replayed by the widget operation that the interaction results in:
+// clicks activate and focus the widget under the pointer, printable keys are inserted into the focused editable,
+// Return activates the focused widget, Tab moves the focus and scrolling moves the adjustments of the nearest scrolled window.
+// Widgets are found by their path in the widget tree, with the recorded position as a fallback.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/graphene"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Kind is the kind of a recorded step.
+type Kind string
+
+const (
+	// KindClick is a button press and release
+	KindClick Kind = "click"
+	// KindKey is a key press
+	KindKey Kind = "key"
+	// KindScroll is a scroll
+	KindScroll Kind = "scroll"
+)
+
+// Step is a single recorded interaction.
+type Step struct {
+	// Offset is the time since the first step
+	Offset time.Duration `json:"offset"`
+	Kind   Kind          `json:"kind"`
+	// Widget is the path of the target widget relative to the root, see WidgetPath
+	Widget string `json:"widget,omitempty"`
+	// X and Y are the position relative to the root
+	X float64 `json:"x,omitempty"`
+	Y float64 `json:"y,omitempty"`
+	// Button is the pointer button of a click
+	Button uint `json:"button,omitempty"`
+	// Keyval and Modifiers are the key of a key press
+	Keyval    uint             `json:"keyval,omitempty"`
+	Modifiers gdk.ModifierType `json:"modifiers,omitempty"`
+	// DeltaX and DeltaY are the scroll deltas in steps
+	DeltaX float64 `json:"dx,omitempty"`
+	DeltaY float64 `json:"dy,omitempty"`
+}
+
+// Script is a recorded sequence of interactions.
+type Script struct {
+	Steps []Step `json:"steps"`
+}
+
+// Save writes the script as JSON to w.
+func (s *Script) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Load reads a JSON script that was written with Save.
+func Load(r io.Reader) (*Script, error) {
+	var s Script
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func typeName(w *gtk.Widget) string {
+	ptr := w.GoPointer()
+	// We take the address and then dereference it to trick go vet from creating a possible misuse of unsafe.Pointer
+	return gobject.TypeNameFromInstance((*gobject.TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))))
+}
+
+func isA(w *gtk.Widget, t types.GType) bool {
+	ptr := w.GoPointer()
+	return gobject.TypeCheckInstanceIsA((*gobject.TypeInstance)(*(*unsafe.Pointer)(unsafe.Pointer(&ptr))), t)
+}
+
+// WidgetPath returns the path of w relative to root.
+// Every component is the type name of a widget and its index among its siblings, e.g. "GtkBox:0/GtkButton:2".
+// It returns an empty string if w is root or not a descendant of root.
+func WidgetPath(root, w *gtk.Widget) string {
+	var parts []string
+	for w != nil && w.GoPointer() != root.GoPointer() {
+		parent := w.GetParent()
+		if parent == nil {
+			return ""
+		}
+		i := 0
+		for c := parent.GetFirstChild(); c != nil && c.GoPointer() != w.GoPointer(); c = c.GetNextSibling() {
+			i++
+		}
+		parts = append([]string{typeName(w) + ":" + strconv.Itoa(i)}, parts...)
+		w = parent
+	}
+	return strings.Join(parts, "/")
+}
+
+// LookupPath returns the widget at path relative to root, see WidgetPath.
+func LookupPath(root *gtk.Widget, path string) (*gtk.Widget, error) {
+	w := root
+	if path == "" {
+		return w, nil
+	}
+	for _, part := range strings.Split(path, "/") {
+		name, index, ok := strings.Cut(part, ":")
+		i, err := strconv.Atoi(index)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("replay: invalid widget path component %q", part)
+		}
+		c := w.GetFirstChild()
+		for ; c != nil && i > 0; i-- {
+			c = c.GetNextSibling()
+		}
+		if c == nil || typeName(c) != name {
+			return nil, fmt.Errorf("replay: no widget at %q", path)
+		}
+		w = c
+	}
+	return w, nil
+}
+
+// Recorder records the interaction with a widget and its descendants.
+type Recorder struct {
+	root       *gtk.Widget
+	controller *gtk.EventControllerLegacy
+	start      uint32
+	pressed    string
+	script     Script
+}
+
+// NewRecorder creates a recorder for the interaction with root and its descendants.
+func NewRecorder(root *gtk.Widget) *Recorder {
+	return &Recorder{root: root}
+}
+
+// Start starts recording.
+func (r *Recorder) Start() {
+	if r.controller != nil {
+		return
+	}
+	r.controller = gtk.NewEventControllerLegacy()
+	r.controller.SetPropagationPhase(gtk.PhaseCaptureValue)
+	r.controller.ConnectEventFunc(r.onEvent)
+	r.root.AddController(&r.controller.EventController)
+}
+
+func (r *Recorder) onEvent(_ gtk.EventControllerLegacy, event uintptr) bool {
+	r.record(gdk.EventNewFromInternalPtr(event))
+	return false
+}
+
+// Stop stops recording and returns the recorded script.
+func (r *Recorder) Stop() *Script {
+	if r.controller != nil {
+		r.root.RemoveController(&r.controller.EventController)
+		r.controller = nil
+	}
+	s := r.script
+	return &s
+}
+
+// rootPosition converts the surface relative position of an event to a position relative to the root
+func (r *Recorder) rootPosition(e *gdk.EventData) (float64, float64, bool) {
+	native := r.root.GetNative()
+	if native == nil || !e.HasPosition {
+		return 0, 0, false
+	}
+	var tx, ty float64
+	native.GetSurfaceTransform(&tx, &ty)
+	nw := gtk.WidgetNewFromInternalPtr(native.GoPointer())
+	in := graphene.Point{X: float32(e.X - tx), Y: float32(e.Y - ty)}
+	var out graphene.Point
+	if !nw.ComputePoint(r.root, &in, &out) {
+		return 0, 0, false
+	}
+	return float64(out.X), float64(out.Y), true
+}
+
+func (r *Recorder) record(event *gdk.Event) {
+	decoded := event.Decode()
+	common := decoded.Common()
+	if len(r.script.Steps) == 0 && r.pressed == "" {
+		r.start = common.Time
+	}
+	step := Step{
+		Offset:    time.Duration(common.Time-r.start) * time.Millisecond,
+		Modifiers: common.Modifiers,
+	}
+	x, y, hasPosition := r.rootPosition(common)
+	step.X, step.Y = x, y
+
+	switch d := decoded.(type) {
+	case *gdk.ButtonEventData:
+		if !hasPosition {
+			return
+		}
+		if d.Pressed {
+			r.pressed = r.pathAt(x, y)
+			return
+		}
+		step.Kind = KindClick
+		step.Button = d.Button
+		step.Widget = r.pressed
+		r.pressed = ""
+	case *gdk.KeyEventData:
+		if !d.Pressed || d.IsModifier {
+			return
+		}
+		step.Kind = KindKey
+		step.Keyval = d.Keyval
+		step.X, step.Y = 0, 0
+	case *gdk.ScrollEventData:
+		if d.IsStop {
+			return
+		}
+		step.Kind = KindScroll
+		step.DeltaX, step.DeltaY = d.DeltaX, d.DeltaY
+		switch d.Direction {
+		case gdk.ScrollUpValue:
+			step.DeltaY = -1
+		case gdk.ScrollDownValue:
+			step.DeltaY = 1
+		case gdk.ScrollLeftValue:
+			step.DeltaX = -1
+		case gdk.ScrollRightValue:
+			step.DeltaX = 1
+		}
+		if hasPosition {
+			step.Widget = r.pathAt(x, y)
+		}
+	default:
+		return
+	}
+	r.script.Steps = append(r.script.Steps, step)
+}
+
+func (r *Recorder) pathAt(x, y float64) string {
+	w := r.root.Pick(x, y, gtk.PickDefaultValue)
+	if w == nil {
+		return ""
+	}
+	return WidgetPath(r.root, w)
+}
+
+// Player plays back a script against a widget tree.
+type Player struct {
+	root   *gtk.Widget
+	script *Script
+	// Speed is the playback speed relative to the recording, e.g. 2 plays twice as fast.
+	// A speed of 0 plays all steps without delays.
+	Speed float64
+}
+
+// NewPlayer creates a player that plays script against root.
+// root should be the widget, or an equivalent one, that the script was recorded on.
+func NewPlayer(root *gtk.Widget, script *Script) *Player {
+	return &Player{root: root, script: script, Speed: 1}
+}
+
+// Play plays the script asynchronously on the main loop and calls done when all steps are played or a step failed.
+func (p *Player) Play(done func(error)) {
+	var schedule func(i int, prev time.Duration)
+	schedule = func(i int, prev time.Duration) {
+		if i >= len(p.script.Steps) {
+			if done != nil {
+				done(nil)
+			}
+			return
+		}
+		step := p.script.Steps[i]
+		var delay uint
+		if p.Speed > 0 && step.Offset > prev {
+			delay = uint(float64((step.Offset - prev).Milliseconds()) / p.Speed)
+		}
+		fn := glib.SourceFunc(func(uintptr) bool {
+			if err := p.PlayStep(step); err != nil {
+				if done != nil {
+					done(fmt.Errorf("replay: step %d: %w", i, err))
+				}
+				return false
+			}
+			schedule(i+1, step.Offset)
+			return false
+		})
+		glib.TimeoutAddFull(glib.PRIORITY_DEFAULT, delay, &fn, 0, nil)
+	}
+	schedule(0, 0)
+}
+
+// PlayAll plays all steps synchronously without delays.
+func (p *Player) PlayAll() error {
+	for i, step := range p.script.Steps {
+		if err := p.PlayStep(step); err != nil {
+			return fmt.Errorf("replay: step %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// target returns the widget of a step by its path, falling back to its position
+func (p *Player) target(step Step) (*gtk.Widget, error) {
+	if step.Widget != "" {
+		if w, err := LookupPath(p.root, step.Widget); err == nil {
+			return w, nil
+		}
+	}
+	if w := p.root.Pick(step.X, step.Y, gtk.PickDefaultValue); w != nil {
+		return w, nil
+	}
+	return nil, fmt.Errorf("no widget at %q or position %v,%v", step.Widget, step.X, step.Y)
+}
+
+// PlayStep plays a single step.
+func (p *Player) PlayStep(step Step) error {
+	switch step.Kind {
+	case KindClick:
+		return p.click(step)
+	case KindKey:
+		return p.key(step)
+	case KindScroll:
+		return p.scroll(step)
+	}
+	return fmt.Errorf("unknown step kind %q", step.Kind)
+}
+
+func (p *Player) click(step Step) error {
+	w, err := p.target(step)
+	if err != nil {
+		return err
+	}
+	w.GrabFocus()
+	for a := w; a != nil; a = a.GetParent() {
+		if a.Activate() || a.GoPointer() == p.root.GoPointer() {
+			break
+		}
+	}
+	return nil
+}
+
+func (p *Player) focus() *gtk.Widget {
+	root := p.root.GetRoot()
+	if root == nil {
+		return nil
+	}
+	return root.GetFocus()
+}
+
+func (p *Player) key(step Step) error {
+	focus := p.focus()
+	switch step.Keyval {
+	case uint(gdk.KEY_Tab), uint(gdk.KEY_ISO_Left_Tab):
+		dir := gtk.DirTabForwardValue
+		if step.Keyval == uint(gdk.KEY_ISO_Left_Tab) || step.Modifiers&gdk.ShiftMaskValue != 0 {
+			dir = gtk.DirTabBackwardValue
+		}
+		if focus == nil || !focus.ChildFocus(dir) {
+			p.root.ChildFocus(dir)
+		}
+		return nil
+	}
+	if focus == nil {
+		return fmt.Errorf("no focused widget for key %d", step.Keyval)
+	}
+	switch step.Keyval {
+	case uint(gdk.KEY_Return), uint(gdk.KEY_KP_Enter):
+		focus.Activate()
+		return nil
+	}
+	if !isA(focus, gtk.EditableGLibType()) {
+		return fmt.Errorf("key %d can not be replayed on a %s", step.Keyval, typeName(focus))
+	}
+	editable := &gtk.EditableBase{Ptr: focus.GoPointer()}
+	pos := editable.GetPosition()
+	if step.Keyval == uint(gdk.KEY_BackSpace) {
+		if pos > 0 {
+			editable.DeleteText(pos-1, pos)
+		}
+		return nil
+	}
+	ch := gdk.KeyvalToUnicode(step.Keyval)
+	if ch < 0x20 || ch == 0x7f || step.Modifiers&(gdk.ControlMaskValue|gdk.AltMaskValue) != 0 {
+		return fmt.Errorf("key %d can not be replayed on a %s", step.Keyval, typeName(focus))
+	}
+	// positions are in characters
+	text := []rune(editable.GetText())
+	if pos < 0 || pos > len(text) {
+		pos = len(text)
+	}
+	text = append(text[:pos], append([]rune{rune(ch)}, text[pos:]...)...)
+	editable.SetText(string(text))
+	editable.SetPosition(pos + 1)
+	return nil
+}
+
+func (p *Player) scroll(step Step) error {
+	w, err := p.target(step)
+	if err != nil {
+		return err
+	}
+	for ; w != nil; w = w.GetParent() {
+		if !isA(w, gtk.ScrolledWindowGLibType()) {
+			continue
+		}
+		sw := gtk.ScrolledWindowNewFromInternalPtr(w.GoPointer())
+		if adj := sw.GetHadjustment(); step.DeltaX != 0 {
+			adj.SetValue(adj.GetValue() + step.DeltaX*adj.GetStepIncrement())
+		}
+		if adj := sw.GetVadjustment(); step.DeltaY != 0 {
+			adj.SetValue(adj.GetValue() + step.DeltaY*adj.GetStepIncrement())
+		}
+		return nil
+	}
+	return fmt.Errorf("no scrolled window at %q", step.Widget)
+}
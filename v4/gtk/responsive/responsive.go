@@ -0,0 +1,149 @@
+// Package responsive switches between layout variants when the width of a window crosses breakpoints.
+//
+// It is a small alternative to AdwBreakpoint for applications that do not depend on libadwaita:
+//
+//	responsive.Watch(window,
+//		responsive.Breakpoint{MinWidth: 0, Apply: useNarrowLayout},
+//		responsive.Breakpoint{MinWidth: 600, Apply: useWideLayout},
+//	)
+package responsive
+
+import (
+	"sort"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Breakpoint is a layout variant that is active from a minimum window width.
+type Breakpoint struct {
+	// MinWidth is the width in application pixels from which the breakpoint is active
+	MinWidth int
+	// Apply is called when the breakpoint becomes active
+	Apply func()
+}
+
+// StackPage returns a breakpoint that shows the page name of stack from minWidth.
+func StackPage(minWidth int, stack *gtk.Stack, name string) Breakpoint {
+	return Breakpoint{
+		MinWidth: minWidth,
+		Apply: func() {
+			stack.SetVisibleChildName(name)
+		},
+	}
+}
+
+// Layout watches the width of a window and applies the matching breakpoint.
+type Layout struct {
+	window      *gtk.Window
+	breakpoints []Breakpoint
+	current     int
+
+	surface     *gdk.Surface
+	onLayout    func(gdk.Surface, int, int)
+	onRealize   func(gtk.Widget)
+	onUnrealize func(gtk.Widget)
+	layoutID    uint
+	realizeID   uint
+	unrealizeID uint
+}
+
+// Watch applies the breakpoint with the largest MinWidth that is not larger than the width of window,
+// or the breakpoint with the smallest MinWidth if the window is narrower than all of them.
+// The breakpoint is applied immediately, using the default size if the window is not yet realized,
+// and again whenever a resize makes another breakpoint match.
+// A breakpoint is only applied when it changes, not on every resize.
+func Watch(window *gtk.Window, breakpoints ...Breakpoint) *Layout {
+	l := &Layout{
+		window:      window,
+		breakpoints: append([]Breakpoint(nil), breakpoints...),
+		current:     -1,
+	}
+	sort.SliceStable(l.breakpoints, func(i, j int) bool {
+		return l.breakpoints[i].MinWidth < l.breakpoints[j].MinWidth
+	})
+	l.onLayout = func(gdk.Surface, int, int) {
+		l.update(l.width())
+	}
+	l.onRealize = func(gtk.Widget) {
+		l.attach()
+	}
+	l.onUnrealize = func(gtk.Widget) {
+		l.detach()
+	}
+	l.realizeID = window.ConnectRealizeFunc(l.onRealize)
+	l.unrealizeID = window.ConnectUnrealizeFunc(l.onUnrealize)
+
+	if window.GetRealized() {
+		l.attach()
+	} else {
+		var w, h int
+		window.GetDefaultSize(&w, &h)
+		l.update(w)
+	}
+	return l
+}
+
+// attach starts listening to size changes of the surface of the window
+func (l *Layout) attach() {
+	l.detach()
+	l.surface = l.window.GetSurface()
+	if l.surface == nil {
+		return
+	}
+	l.layoutID = l.surface.ConnectLayoutFunc(l.onLayout)
+	l.update(l.width())
+}
+
+// detach stops listening to the surface, which is destroyed when the window is unrealized
+func (l *Layout) detach() {
+	if l.surface == nil {
+		return
+	}
+	gobject.SignalHandlerDisconnect(&l.surface.Object, types.ULong(l.layoutID))
+	l.surface.Unref()
+	l.surface = nil
+}
+
+// width returns the width of the window without the client side shadows that are part of the surface
+func (l *Layout) width() int {
+	var x, y float64
+	l.window.GetSurfaceTransform(&x, &y)
+	return l.surface.GetWidth() - 2*int(x)
+}
+
+func (l *Layout) update(width int) {
+	if len(l.breakpoints) == 0 || width <= 0 {
+		return
+	}
+	idx := 0
+	for i, b := range l.breakpoints {
+		if b.MinWidth <= width {
+			idx = i
+		}
+	}
+	if idx == l.current {
+		return
+	}
+	l.current = idx
+	if apply := l.breakpoints[idx].Apply; apply != nil {
+		apply()
+	}
+}
+
+// Current returns the active breakpoint and false if no breakpoint has been applied yet.
+func (l *Layout) Current() (Breakpoint, bool) {
+	if l.current < 0 {
+		return Breakpoint{}, false
+	}
+	return l.breakpoints[l.current], true
+}
+
+// Stop stops watching the window, the active layout variant is kept.
+func (l *Layout) Stop() {
+	l.detach()
+	gobject.SignalHandlerDisconnect(&l.window.Object, types.ULong(l.realizeID))
+	gobject.SignalHandlerDisconnect(&l.window.Object, types.ULong(l.unrealizeID))
+}
@@ -0,0 +1,167 @@
+// Package theme defines color palettes in Go and applies them as CSS on top of the theme of GTK or libadwaita,
+// such that apps can be themed without writing CSS strings for a CssProvider:
+//
+//	light := theme.Palette{Name: "light", Accent: "#3584e4", Success: "#2ec27e", Warning: "#e5a50a", Error: "#e01b24"}
+//	dark := light.With(map[string]string{"card_bg_color": "#303030"})
+//	dark.Name = "dark"
+//	t := theme.Apply(light)
+//	t.Add(dark)
+//	...
+//	t.Use("dark")
+//
+// Every color is defined with @define-color, e.g. @accent_color, and on GTK 4.16 and later as CSS variable as well,
+// e.g. var(--accent-color), which is what libadwaita 1.6 and later uses. CSS of the app can refer to both.
+package theme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Palette is a set of named colors. The colors are CSS colors, e.g. "#3584e4", "rgb(53, 132, 228)"
+// or "mix(@window_bg_color, black, 0.1)". Empty colors are left out, such that the theme keeps its own.
+type Palette struct {
+	// Name is the name of the palette, e.g. "dark", for switching palettes with Theme.Use
+	Name string
+	// Accent is the accent color, it defines accent_color and accent_bg_color
+	Accent string
+	// Success is the color for successful actions, it defines success_color and success_bg_color
+	Success string
+	// Warning is the color for warnings, it defines warning_color and warning_bg_color
+	Warning string
+	// Error is the color for errors and destructive actions, it defines error_color, error_bg_color,
+	// destructive_color and destructive_bg_color
+	Error string
+	// Colors are further colors by their @define-color name, e.g. "window_bg_color" or an app specific "sidebar_color"
+	Colors map[string]string
+}
+
+// With returns a copy of the palette with colors added to or replacing its Colors.
+func (p Palette) With(colors map[string]string) Palette {
+	merged := make(map[string]string, len(p.Colors)+len(colors))
+	for name, c := range p.Colors {
+		merged[name] = c
+	}
+	for name, c := range colors {
+		merged[name] = c
+	}
+	p.Colors = merged
+	return p
+}
+
+// Defines returns the colors of the palette by their @define-color name.
+func (p Palette) Defines() map[string]string {
+	colors := make(map[string]string)
+	set := func(c string, names ...string) {
+		if c == "" {
+			return
+		}
+		for _, name := range names {
+			colors[name] = c
+		}
+	}
+	set(p.Accent, "accent_color", "accent_bg_color")
+	set(p.Success, "success_color", "success_bg_color")
+	set(p.Warning, "warning_color", "warning_bg_color")
+	set(p.Error, "error_color", "error_bg_color", "destructive_color", "destructive_bg_color")
+	for name, c := range p.Colors {
+		set(c, name)
+	}
+	return colors
+}
+
+// CSS returns the style sheet of the palette, with CSS variables if vars is true.
+// The names of the variables are the @define-color names with dashes, e.g. --accent-bg-color for accent_bg_color.
+func (p Palette) CSS(vars bool) string {
+	colors := p.Defines()
+	names := make([]string, 0, len(colors))
+	for name := range colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "@define-color %s %s;\n", name, colors[name])
+	}
+	if vars && len(names) > 0 {
+		sb.WriteString(":root {\n")
+		for _, name := range names {
+			fmt.Fprintf(&sb, "  --%s: %s;\n", strings.ReplaceAll(name, "_", "-"), colors[name])
+		}
+		sb.WriteString("}\n")
+	}
+	return sb.String()
+}
+
+// SupportsVariables returns whether the GTK version at runtime supports CSS variables, which is GTK 4.16 and later.
+func SupportsVariables() bool {
+	// the versions are C guints
+	major, minor := uint32(gtk.GetMajorVersion()), uint32(gtk.GetMinorVersion())
+	return major > 4 || major == 4 && minor >= 16
+}
+
+// Theme is a palette that is applied to a display, its palette can be switched at runtime.
+type Theme struct {
+	display  *gdk.Display
+	provider *gtk.CssProvider
+	palette  Palette
+	palettes map[string]Palette
+}
+
+// Apply applies the palette to all windows of the default display, see ApplyForDisplay.
+func Apply(p Palette) *Theme {
+	return ApplyForDisplay(gdk.DisplayGetDefault(), p)
+}
+
+// ApplyForDisplay applies the palette to all windows of the display.
+// The CSS is added with the application priority, so it overrides the colors of the theme but not those of the user.
+func ApplyForDisplay(display *gdk.Display, p Palette) *Theme {
+	t := &Theme{
+		display:  display,
+		provider: gtk.NewCssProvider(),
+		palettes: make(map[string]Palette),
+	}
+	t.Add(p)
+	t.Set(p)
+	gtk.StyleContextAddProviderForDisplay(display, t.provider, uint(gtk.STYLE_PROVIDER_PRIORITY_APPLICATION))
+	return t
+}
+
+// Set switches the theme to the palette, the windows are restyled immediately.
+func (t *Theme) Set(p Palette) {
+	t.palette = p
+	t.provider.LoadFromString(p.CSS(SupportsVariables()))
+}
+
+// Add adds palettes that Use can switch to by their name, a palette replaces an added palette with the same name.
+// The palette that the theme is applied with is added as well.
+func (t *Theme) Add(palettes ...Palette) {
+	for _, p := range palettes {
+		t.palettes[p.Name] = p
+	}
+}
+
+// Use switches the theme to the added palette with the name.
+func (t *Theme) Use(name string) error {
+	p, ok := t.palettes[name]
+	if !ok {
+		return fmt.Errorf("theme: unknown palette %q", name)
+	}
+	t.Set(p)
+	return nil
+}
+
+// Palette returns the palette that is applied.
+func (t *Theme) Palette() Palette {
+	return t.palette
+}
+
+// Remove removes the palette from the display, the colors of the theme apply again.
+func (t *Theme) Remove() {
+	gtk.StyleContextRemoveProviderForDisplay(t.display, t.provider)
+}
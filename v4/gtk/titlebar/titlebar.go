@@ -0,0 +1,176 @@
+// Package titlebar builds client side titlebars from a small description,
+// either as a GtkHeaderBar or as a fully custom layout in a GtkWindowHandle:
+//
+//	titlebar.Set(window, titlebar.Spec{
+//		Title:    "Documents",
+//		Subtitle: "~/Documents",
+//		Start:    []*gtk.Widget{&back.Widget},
+//		Menu:     &menu.MenuModel,
+//	})
+package titlebar
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/jwijenbergh/puregotk/v4/pango"
+)
+
+// Spec describes a titlebar.
+type Spec struct {
+	// Title is shown in the center, if it is empty the title of the window is shown
+	Title string
+	// Subtitle is shown below the title, it is only shown if Title is set
+	Subtitle string
+	// Start are the widgets at the start, from the start towards the center
+	Start []*gtk.Widget
+	// End are the widgets at the end, from the center towards the end
+	End []*gtk.Widget
+	// Menu is the model of the primary menu, which gets a menu button at the end that F10 opens
+	Menu *gio.MenuModel
+	// MenuIcon is the icon of the menu button, the default is "open-menu-symbolic"
+	MenuIcon string
+	// DecorationLayout overrides the window controls, e.g. "icon:minimize,maximize,close",
+	// by default the layout of the desktop is used
+	DecorationLayout string
+	// HideControls hides the window controls, e.g. for dialogs that are closed with their own buttons
+	HideControls bool
+}
+
+// title returns the widget that shows the title and subtitle, or nil if the window title should be used
+func (s Spec) title() *gtk.Widget {
+	if s.Title == "" {
+		return nil
+	}
+	box := gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	box.SetValign(gtk.AlignCenterValue)
+	box.Append(&label(s.Title, "title").Widget)
+	if s.Subtitle != "" {
+		box.Append(&label(s.Subtitle, "subtitle").Widget)
+	}
+	return &box.Widget
+}
+
+// label returns a single line label for the titlebar with the style class
+func label(text, class string) *gtk.Label {
+	l := gtk.NewLabel(&text)
+	l.SetSingleLineMode(true)
+	l.SetEllipsize(pango.EllipsizeEndValue)
+	l.SetWidthChars(5)
+	l.AddCssClass(class)
+	return l
+}
+
+// menuButton returns the primary menu button or nil
+func (s Spec) menuButton() *gtk.MenuButton {
+	if s.Menu == nil {
+		return nil
+	}
+	b := gtk.NewMenuButton()
+	icon := s.MenuIcon
+	if icon == "" {
+		icon = "open-menu-symbolic"
+	}
+	b.SetIconName(icon)
+	b.SetMenuModel(s.Menu)
+	b.SetPrimary(true)
+	tooltip := "Main Menu"
+	b.SetTooltipText(&tooltip)
+	return b
+}
+
+// HeaderBar builds the titlebar as a GtkHeaderBar.
+func HeaderBar(s Spec) *gtk.HeaderBar {
+	hb := gtk.NewHeaderBar()
+	if t := s.title(); t != nil {
+		hb.SetTitleWidget(t)
+	}
+	for _, w := range s.Start {
+		hb.PackStart(w)
+	}
+	// widgets packed at the end are placed from the end towards the center
+	if b := s.menuButton(); b != nil {
+		hb.PackEnd(&b.Widget)
+	}
+	for i := len(s.End) - 1; i >= 0; i-- {
+		hb.PackEnd(s.End[i])
+	}
+	if s.DecorationLayout != "" {
+		hb.SetDecorationLayout(&s.DecorationLayout)
+	}
+	hb.SetShowTitleButtons(!s.HideControls)
+	return hb
+}
+
+// Custom builds the titlebar as a GtkWindowHandle with its own layout,
+// which can be dragged to move the window and has the window controls at the start and end.
+// Unlike a header bar, it does not show the window title if Title is empty, use SetCustom for that.
+func Custom(s Spec) *gtk.WindowHandle {
+	handle, _ := custom(s)
+	return handle
+}
+
+// custom builds the custom titlebar and returns the box of its center as well
+func custom(s Spec) (*gtk.WindowHandle, *gtk.CenterBox) {
+	start := gtk.NewBox(gtk.OrientationHorizontalValue, 6)
+	end := gtk.NewBox(gtk.OrientationHorizontalValue, 6)
+	if !s.HideControls {
+		start.Append(&controls(gtk.PackStartValue, s.DecorationLayout).Widget)
+	}
+	for _, w := range s.Start {
+		start.Append(w)
+	}
+	for _, w := range s.End {
+		end.Append(w)
+	}
+	if b := s.menuButton(); b != nil {
+		end.Append(&b.Widget)
+	}
+	if !s.HideControls {
+		end.Append(&controls(gtk.PackEndValue, s.DecorationLayout).Widget)
+	}
+
+	center := gtk.NewCenterBox()
+	center.SetStartWidget(&start.Widget)
+	center.SetEndWidget(&end.Widget)
+	if t := s.title(); t != nil {
+		center.SetCenterWidget(t)
+	}
+	center.SetMarginStart(6)
+	center.SetMarginEnd(6)
+	center.SetMarginTop(6)
+	center.SetMarginBottom(6)
+
+	handle := gtk.NewWindowHandle()
+	handle.SetChild(&center.Widget)
+	return handle, center
+}
+
+// controls returns the window controls of the side
+func controls(side gtk.PackType, layout string) *gtk.WindowControls {
+	c := gtk.NewWindowControls(side)
+	if layout != "" {
+		c.SetDecorationLayout(&layout)
+	}
+	return c
+}
+
+// Set builds the titlebar as a GtkHeaderBar and sets it as titlebar of the window.
+func Set(window *gtk.Window, s Spec) *gtk.HeaderBar {
+	hb := HeaderBar(s)
+	window.SetTitlebar(&hb.Widget)
+	return hb
+}
+
+// SetCustom builds the titlebar with Custom and sets it as titlebar of the window.
+// If Title is empty, the titlebar shows the title of the window and follows its changes.
+func SetCustom(window *gtk.Window, s Spec) *gtk.WindowHandle {
+	handle, center := custom(s)
+	if s.Title == "" {
+		l := label(window.GetTitle(), "title")
+		window.BindProperty("title", &l.Object, "label", gobject.GBindingSyncCreateValue)
+		center.SetCenterWidget(&l.Widget)
+	}
+	window.SetTitlebar(&handle.Widget)
+	return handle
+}
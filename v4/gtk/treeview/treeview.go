@@ -0,0 +1,399 @@
+// Package treeview is a small replacement for a GtkTreeView with a GtkListStore, which are deprecated in GTK 4,
+// for applications that are ported from GTK 3. The rows are Go values and the columns are made from the fields
+// of the row type, the view is a GtkColumnView:
+//
+//	type Track struct {
+//		Title    string
+//		Artist   string
+//		Duration time.Duration `treeview:"Length"`
+//		Rating   int
+//		path     string
+//	}
+//
+//	view := treeview.New[Track]()
+//	view.Append(tracks...)
+//	view.OnRowActivated(func(index int, t Track) {
+//		play(t.path)
+//	})
+//	window.SetChild(&view.Widget().Widget)
+//
+// Every exported field is a column titled with its name, the `treeview` tag sets another title or "-" to leave it out.
+// Columns of numbers, strings and booleans are sorted when their header is clicked.
+// Pass columns to New to choose them yourself, their Format functions render the cells like the cell data functions
+// of GtkTreeViewColumn, and Setup and Bind put other widgets into the cells. TextColumn and WidgetColumn declare them
+// without the factories and sorters of the column view:
+//
+//	view := treeview.New(
+//		treeview.TextColumn("Title", func(t Track) string { return t.Title }).
+//			Sorted(treeview.By(func(t Track) string { return t.Title })).
+//			Expanded(),
+//		treeview.WidgetColumn("Rating", func() *gtk.Widget {
+//			return &gtk.NewLevelBarForInterval(0, 5).Widget
+//		}, func(w *gtk.Widget, t Track) {
+//			gtk.LevelBarNewFromInternalPtr(w.GoPointer()).SetValue(float64(t.Rating))
+//		}),
+//	)
+//
+// Nested rows are not supported, use a GtkTreeListModel for them.
+// All functions must be called on the main thread.
+package treeview
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Column is a column of a View.
+type Column[T any] struct {
+	// Title is the text of the header
+	Title string
+	// Format returns the text of the cell of row
+	Format func(row T) string
+	// Less reports whether a sorts before b, the column is not sortable if it is nil
+	Less func(a, b T) bool
+	// Expand gives the column a share of the extra width of the view
+	Expand bool
+	// Setup creates the widget of a cell, a left aligned label if it is nil
+	Setup func() *gtk.Widget
+	// Bind shows row in the widget of a cell, it sets the text of the label to Format if it is nil
+	Bind func(widget *gtk.Widget, row T)
+}
+
+// TextColumn returns a column whose cells show the text that format returns for a row.
+func TextColumn[T any](title string, format func(row T) string) Column[T] {
+	return Column[T]{Title: title, Format: format}
+}
+
+// WidgetColumn returns a column whose cells are widgets created by setup, bind shows a row in a widget.
+// The widgets are reused for other rows when the view scrolls, so bind sets everything that differs between rows.
+func WidgetColumn[T any](title string, setup func() *gtk.Widget, bind func(widget *gtk.Widget, row T)) Column[T] {
+	return Column[T]{Title: title, Setup: setup, Bind: bind}
+}
+
+// Sorted returns the column sorted with less when its header is clicked.
+func (c Column[T]) Sorted(less func(a, b T) bool) Column[T] {
+	c.Less = less
+	return c
+}
+
+// Expanded returns the column with a share of the extra width of the view.
+func (c Column[T]) Expanded() Column[T] {
+	c.Expand = true
+	return c
+}
+
+// By returns the order of rows by the key of a row, for Column.Less.
+func By[T any, K cmp.Ordered](key func(row T) K) func(a, b T) bool {
+	return func(a, b T) bool {
+		return cmp.Less(key(a), key(b))
+	}
+}
+
+// Columns returns the columns that New makes from the fields of T, e.g. to change one of them before passing them to New.
+// T is a struct or a pointer to a struct, nil pointers have empty cells.
+func Columns[T any]() []Column[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	ptr := t.Kind() == reflect.Pointer
+	if ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("treeview: %s is not a struct", t))
+	}
+	field := func(row T, index []int) (reflect.Value, bool) {
+		v := reflect.ValueOf(&row).Elem()
+		if ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		return v.FieldByIndex(index), true
+	}
+	var columns []Column[T]
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		title := f.Name
+		if tag, ok := f.Tag.Lookup("treeview"); ok {
+			if tag == "-" {
+				continue
+			}
+			title = tag
+		}
+		index := f.Index
+		c := Column[T]{
+			Title: title,
+			Format: func(row T) string {
+				if v, ok := field(row, index); ok {
+					return fmt.Sprint(v.Interface())
+				}
+				return ""
+			},
+		}
+		if less := lessFunc(f.Type); less != nil {
+			c.Less = func(a, b T) bool {
+				va, okA := field(a, index)
+				vb, okB := field(b, index)
+				if !okA || !okB {
+					// nil rows sort first
+					return !okA && okB
+				}
+				return less(va, vb)
+			}
+		}
+		columns = append(columns, c)
+	}
+	return columns
+}
+
+// lessFunc returns the order of values of type t, or nil if they have none
+func lessFunc(t reflect.Type) func(a, b reflect.Value) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) bool { return a.Float() < b.Float() }
+	case reflect.String:
+		return func(a, b reflect.Value) bool { return a.String() < b.String() }
+	case reflect.Bool:
+		return func(a, b reflect.Value) bool { return !a.Bool() && b.Bool() }
+	}
+	return nil
+}
+
+// View shows rows of type T in a GtkColumnView, created by New.
+// The index of a row is its position in the order the rows were added, which does not change when the view is sorted.
+type View[T any] struct {
+	view      *gtk.ColumnView
+	list      *gtk.StringList
+	selection *gtk.SingleSelection
+	// ids are the keys of the rows in the order they were added, the string list holds the same keys
+	ids    []string
+	rows   map[string]T
+	nextID int
+}
+
+// New returns a view with the columns, or with the columns of the fields of T if there are none, see Columns.
+func New[T any](columns ...Column[T]) *View[T] {
+	if len(columns) == 0 {
+		columns = Columns[T]()
+	}
+	v := &View[T]{
+		list: gtk.NewStringList(nil),
+		rows: make(map[string]T),
+	}
+	// the models take the reference of the model they wrap, the column view holds the last one
+	sorted := gtk.NewSortListModel(v.list, nil)
+	v.selection = gtk.NewSingleSelection(sorted)
+	v.selection.SetAutoselect(false)
+	v.selection.SetCanUnselect(true)
+	v.view = gtk.NewColumnView(v.selection)
+	sorted.SetSorter(v.view.GetSorter())
+	for _, c := range columns {
+		v.addColumn(c)
+	}
+	return v
+}
+
+func (v *View[T]) addColumn(c Column[T]) {
+	format := c.Format
+	if format == nil {
+		format = func(row T) string { return fmt.Sprint(row) }
+	}
+	setup := c.Setup
+	if setup == nil {
+		setup = func() *gtk.Widget {
+			label := gtk.NewLabel(nil)
+			label.SetXalign(0)
+			return &label.Widget
+		}
+	}
+	bind := c.Bind
+	if bind == nil {
+		bind = func(widget *gtk.Widget, row T) {
+			gtk.LabelNewFromInternalPtr(widget.GoPointer()).SetText(format(row))
+		}
+	}
+	factory := gtk.NewListItemFactoryGo(func(item *gtk.ListItem) {
+		item.SetChild(setup())
+	}, func(item *gtk.ListItem) {
+		row, ok := v.rows[itemID(item.GetItem().GoPointer())]
+		if child := item.GetChild(); child != nil && ok {
+			bind(child, row)
+		}
+	}, nil, nil)
+	title := c.Title
+	// the column takes the reference of the factory
+	column := gtk.NewColumnViewColumn(&title, &factory.ListItemFactory)
+	defer column.Unref()
+	column.SetExpand(c.Expand)
+	column.SetResizable(true)
+	if less := c.Less; less != nil {
+		sorter := gtk.NewCustomSorterGo(func(a, b uintptr) int {
+			rowA, rowB := v.rows[itemID(a)], v.rows[itemID(b)]
+			switch {
+			case less(rowA, rowB):
+				return int(gtk.OrderingSmallerValue)
+			case less(rowB, rowA):
+				return int(gtk.OrderingLargerValue)
+			}
+			return int(gtk.OrderingEqualValue)
+		})
+		column.SetSorter(&sorter.Sorter)
+		sorter.Unref()
+	}
+	v.view.AppendColumn(column)
+}
+
+// itemID returns the key of the row of an item of the string list
+func itemID(item uintptr) string {
+	if item == 0 {
+		return ""
+	}
+	return gtk.StringObjectNewFromInternalPtr(item).GetString()
+}
+
+// Widget returns the column view that shows the rows, e.g. to put it into a GtkScrolledWindow.
+func (v *View[T]) Widget() *gtk.ColumnView {
+	return v.view
+}
+
+func (v *View[T]) newID(row T) string {
+	v.nextID++
+	id := strconv.Itoa(v.nextID)
+	v.rows[id] = row
+	return id
+}
+
+// Len returns the number of rows.
+func (v *View[T]) Len() int {
+	return len(v.ids)
+}
+
+// Row returns the row at index.
+func (v *View[T]) Row(index int) T {
+	return v.rows[v.ids[index]]
+}
+
+// Rows returns all rows in the order they were added.
+func (v *View[T]) Rows() []T {
+	rows := make([]T, len(v.ids))
+	for i, id := range v.ids {
+		rows[i] = v.rows[id]
+	}
+	return rows
+}
+
+// SetRows replaces all rows.
+func (v *View[T]) SetRows(rows []T) {
+	old := len(v.ids)
+	v.rows = make(map[string]T, len(rows))
+	v.ids = make([]string, len(rows))
+	for i, row := range rows {
+		v.ids[i] = v.newID(row)
+	}
+	if old > 0 || len(rows) > 0 {
+		v.list.Splice(0, uint(old), v.ids)
+	}
+}
+
+// Append adds rows at the end.
+func (v *View[T]) Append(rows ...T) {
+	v.Insert(len(v.ids), rows...)
+}
+
+// Insert adds rows before the row at index, an index of Len adds them at the end.
+func (v *View[T]) Insert(index int, rows ...T) {
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = v.newID(row)
+	}
+	v.ids = append(v.ids[:index], append(ids, v.ids[index:]...)...)
+	v.list.Splice(uint(index), 0, ids)
+}
+
+// Set replaces the row at index, its cells are rendered again.
+func (v *View[T]) Set(index int, row T) {
+	delete(v.rows, v.ids[index])
+	// a new key replaces the item, such that the column view binds its cells again
+	v.ids[index] = v.newID(row)
+	v.list.Splice(uint(index), 1, v.ids[index:index+1])
+}
+
+// Remove removes the row at index.
+func (v *View[T]) Remove(index int) {
+	delete(v.rows, v.ids[index])
+	v.ids = append(v.ids[:index], v.ids[index+1:]...)
+	v.list.Remove(uint(index))
+}
+
+// Clear removes all rows.
+func (v *View[T]) Clear() {
+	v.SetRows(nil)
+}
+
+// indexOf returns the index of the row with the key id, or -1
+func (v *View[T]) indexOf(id string) int {
+	for i, other := range v.ids {
+		if other == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Selected returns the selected row and its index, ok is false if no row is selected.
+func (v *View[T]) Selected() (index int, row T, ok bool) {
+	item := v.selection.GetSelectedItem()
+	if item == nil {
+		return -1, row, false
+	}
+	id := itemID(item.GoPointer())
+	if index = v.indexOf(id); index < 0 {
+		return -1, row, false
+	}
+	return index, v.rows[id], true
+}
+
+// Select selects the row at index, or unselects all rows if index is negative.
+func (v *View[T]) Select(index int) {
+	if index < 0 {
+		v.selection.UnselectAll()
+		return
+	}
+	id := v.ids[index]
+	// the position in the view differs from the index once the rows are sorted
+	for pos := uint(0); pos < v.selection.GetNItems(); pos++ {
+		item := v.selection.GetObject(pos)
+		match := itemID(item.GoPointer()) == id
+		item.Unref()
+		if match {
+			v.selection.SetSelected(pos)
+			return
+		}
+	}
+}
+
+// OnRowActivated calls fn when a row is activated, e.g. by a double click or the Enter key, like "row-activated" of a GtkTreeView.
+// It returns the handler ID.
+func (v *View[T]) OnRowActivated(fn func(index int, row T)) uint {
+	return v.view.ConnectActivateFunc(func(_ gtk.ColumnView, position uint) {
+		item := v.selection.GetObject(position)
+		if item == nil {
+			return
+		}
+		id := itemID(item.GoPointer())
+		item.Unref()
+		if index := v.indexOf(id); index >= 0 {
+			fn(index, v.rows[id])
+		}
+	})
+}
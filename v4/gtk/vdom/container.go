@@ -0,0 +1,82 @@
+package vdom
+
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Container adds, moves and removes the child widgets of a widget class, GTK 4 has no common API for this.
+// Boxes and widgets with a single "child" property, like windows, buttons and scrolled windows, are supported,
+// others can be added with RegisterContainer.
+type Container interface {
+	// Insert adds the child after the sibling, or as first child if sibling is nil
+	Insert(parent, child, sibling *gtk.Widget)
+	// Move moves the child after the sibling, or to the front if sibling is nil
+	Move(parent, child, sibling *gtk.Widget)
+	// Remove removes the child
+	Remove(parent, child *gtk.Widget)
+}
+
+var containers map[types.GType]Container
+
+func registry() map[types.GType]Container {
+	if containers == nil {
+		containers = map[types.GType]Container{
+			gtk.BoxGLibType(): box{},
+		}
+	}
+	return containers
+}
+
+// RegisterContainer sets how the children of widgets of the class gtype and its subclasses are managed,
+// replacing the container that was registered before.
+func RegisterContainer(gtype types.GType, c Container) {
+	glib.AssertMainThread("vdom.RegisterContainer")
+	registry()[gtype] = c
+}
+
+// containerFor returns the container registered for the class of w or one of its parents,
+// or the child property container if it has a "child" property that holds a widget
+func containerFor(gtype types.GType, w *gtk.Widget) (Container, error) {
+	for t := gtype; t != 0; t = gobject.TypeParent(t) {
+		if c, ok := registry()[t]; ok {
+			return c, nil
+		}
+	}
+	if ptype, ok := w.PropertyType("child"); ok && gobject.TypeIsA(ptype, gtk.WidgetGLibType()) {
+		return childProperty{}, nil
+	}
+	return nil, fmt.Errorf("vdom: %s cannot have children, register it with RegisterContainer", gobject.TypeName(gtype))
+}
+
+// box manages the children of a GtkBox
+type box struct{}
+
+func (box) Insert(parent, child, sibling *gtk.Widget) {
+	gtk.BoxNewFromInternalPtr(parent.Ptr).InsertChildAfter(child, sibling)
+}
+
+func (box) Move(parent, child, sibling *gtk.Widget) {
+	gtk.BoxNewFromInternalPtr(parent.Ptr).ReorderChildAfter(child, sibling)
+}
+
+func (box) Remove(parent, child *gtk.Widget) {
+	gtk.BoxNewFromInternalPtr(parent.Ptr).Remove(child)
+}
+
+// childProperty manages the single child of widgets with a "child" property
+type childProperty struct{}
+
+func (childProperty) Insert(parent, child, _ *gtk.Widget) {
+	parent.Set("child", child)
+}
+
+func (childProperty) Move(_, _, _ *gtk.Widget) {}
+
+func (childProperty) Remove(parent, _ *gtk.Widget) {
+	parent.Set("child", nil)
+}
@@ -0,0 +1,358 @@
+// Package vdom describes user interfaces as trees of Go values and updates the widgets when the tree changes,
+// so that applications can be written in the style of Elm or React, where the view is a function of the state:
+//
+//	func view(count int, add func()) *vdom.Node {
+//		return vdom.El(gtk.BoxGLibType(), vdom.Props{"orientation": gtk.OrientationVerticalValue, "spacing": 6},
+//			vdom.El(gtk.LabelGLibType(), vdom.Props{"label": fmt.Sprintf("%d clicks", count)}),
+//			vdom.El(gtk.ButtonGLibType(), vdom.Props{"label": "Add"}).On("clicked", func(*gtk.Widget) { add() }),
+//		)
+//	}
+//
+//	tree := vdom.New()
+//	count := 0
+//	var render func()
+//	render = func() {
+//		w, err := tree.Render(view(count, func() {
+//			count++
+//			render()
+//		}))
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		window.SetChild(w)
+//	}
+//	render()
+//
+// Rendering compares the tree with the previous one: widgets are created and destroyed only for nodes that were added,
+// removed or changed their type, other widgets only get the properties that changed.
+// Properties are compared with the previous node and not with the widget,
+// so a property that the user changed, like the text of an entry, is only set again when the node changes it.
+// Properties are set with gobject.Object.SetGoValues and reset to their default value when they are left out.
+//
+// The package is experimental. All functions must be called on the main thread.
+package vdom
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// Props are the properties of a widget by name, the values are converted as described for gobject.Value.InitGo.
+type Props = map[string]interface{}
+
+// Node describes a widget, create it with El.
+type Node struct {
+	// Type is the widget class, e.g. gtk.LabelGLibType()
+	Type types.GType
+	// Key identifies the node among its siblings, so that its widget is kept when siblings are inserted or removed.
+	// Nodes without a key are matched by their position among the siblings without a key.
+	Key string
+	// Props are the properties of the widget
+	Props Props
+	// Handlers are the functions called for signals of the widget by detailed signal name, e.g. "notify::label"
+	Handlers map[string]func(w *gtk.Widget)
+	// Children are the child widgets, nil children are skipped
+	Children []*Node
+}
+
+// El returns a node for a widget of the class gtype with the properties and children.
+func El(gtype types.GType, props Props, children ...*Node) *Node {
+	return &Node{
+		Type:     gtype,
+		Props:    props,
+		Children: children,
+	}
+}
+
+// WithKey sets the key of the node and returns it.
+func (n *Node) WithKey(key string) *Node {
+	n.Key = key
+	return n
+}
+
+// On calls fn with the widget when it emits the signal and returns the node.
+// The arguments of the signal are not passed, read the state of the widget instead.
+// Signals that have a return value are not supported.
+func (n *Node) On(signal string, fn func(w *gtk.Widget)) *Node {
+	if n.Handlers == nil {
+		n.Handlers = make(map[string]func(w *gtk.Widget))
+	}
+	n.Handlers[signal] = fn
+	return n
+}
+
+// Tree holds the widgets of the last rendered node, created by New.
+type Tree struct {
+	root *instance
+}
+
+// New returns an empty tree.
+func New() *Tree {
+	return &Tree{}
+}
+
+// Render updates the widgets to match the node and returns the root widget.
+// A new root widget is returned when the type of the root node changes, put it in place of the previous one.
+// If an error is returned, e.g. because a property does not exist, the widgets may be partially updated.
+func (t *Tree) Render(n *Node) (*gtk.Widget, error) {
+	glib.AssertMainThread("vdom.Tree.Render")
+	if t.root != nil && t.root.matches(n) {
+		return t.root.widget, t.root.patch(n)
+	}
+	inst, err := create(n)
+	if err != nil {
+		return nil, err
+	}
+	if t.root != nil {
+		t.root.release()
+	}
+	t.root = inst
+	return inst.widget, nil
+}
+
+// Widget returns the root widget, or nil if nothing was rendered.
+func (t *Tree) Widget() *gtk.Widget {
+	if t.root == nil {
+		return nil
+	}
+	return t.root.widget
+}
+
+// Destroy disconnects the handlers and releases the widgets of the tree.
+// The root widget is finalized once its parent releases it as well.
+func (t *Tree) Destroy() {
+	glib.AssertMainThread("vdom.Tree.Destroy")
+	if t.root != nil {
+		t.root.release()
+		t.root = nil
+	}
+}
+
+// instance is a node that was rendered
+type instance struct {
+	gtype types.GType
+	key   string
+	// widget holds a strong reference, so that it survives being moved between positions
+	widget   *gtk.Widget
+	props    Props
+	handlers map[string]*handler
+	children []*instance
+}
+
+type handler struct {
+	id uint
+	fn func(w *gtk.Widget)
+}
+
+func (inst *instance) matches(n *Node) bool {
+	return inst.gtype == n.Type && inst.key == n.Key
+}
+
+func create(n *Node) (*instance, error) {
+	if !gobject.TypeIsA(n.Type, gtk.WidgetGLibType()) {
+		return nil, fmt.Errorf("vdom: %s is not a widget type", gobject.TypeName(n.Type))
+	}
+	obj, err := gobject.NewObjectWithGoValues(n.Type, n.Props)
+	if err != nil {
+		return nil, err
+	}
+	obj.RefSink()
+	inst := &instance{
+		gtype:    n.Type,
+		key:      n.Key,
+		widget:   gtk.WidgetNewFromInternalPtr(obj.Ptr),
+		props:    copyProps(n.Props),
+		handlers: make(map[string]*handler),
+	}
+	if err := inst.connect(n.Handlers); err != nil {
+		inst.release()
+		return nil, err
+	}
+	if err := inst.patchChildren(n.Children); err != nil {
+		inst.release()
+		return nil, err
+	}
+	return inst, nil
+}
+
+// release disconnects the handlers and drops the references of the instance and its children
+func (inst *instance) release() {
+	for _, c := range inst.children {
+		c.release()
+	}
+	inst.children = nil
+	for signal, h := range inst.handlers {
+		inst.widget.DisconnectSignal(h.id)
+		delete(inst.handlers, signal)
+	}
+	inst.widget.Unref()
+}
+
+func (inst *instance) patch(n *Node) error {
+	changed := make(Props)
+	for name, v := range n.Props {
+		if old, ok := inst.props[name]; !ok || !reflect.DeepEqual(old, v) {
+			changed[name] = v
+		}
+	}
+	// the object methods, gtk.Widget has a ResetProperty method of its own for accessible properties
+	obj := &gobject.Object{Ptr: inst.widget.Ptr}
+	if err := obj.SetGoValues(changed); err != nil {
+		return err
+	}
+	for name := range inst.props {
+		if _, ok := n.Props[name]; ok {
+			continue
+		}
+		if err := obj.ResetProperty(name); err != nil {
+			return err
+		}
+	}
+	inst.props = copyProps(n.Props)
+	if err := inst.connect(n.Handlers); err != nil {
+		return err
+	}
+	return inst.patchChildren(n.Children)
+}
+
+// connect updates the handlers of the widget, signals that are still handled keep their connection
+func (inst *instance) connect(handlers map[string]func(w *gtk.Widget)) error {
+	for signal, h := range inst.handlers {
+		if fn, ok := handlers[signal]; ok && fn != nil {
+			h.fn = fn
+			continue
+		}
+		inst.widget.DisconnectSignal(h.id)
+		delete(inst.handlers, signal)
+	}
+	for signal, fn := range handlers {
+		if _, ok := inst.handlers[signal]; ok || fn == nil {
+			continue
+		}
+		var id uint
+		var detail glib.Quark
+		// the id is a C guint, it is 0 if the name cannot be parsed
+		gobject.SignalParseName(signal, inst.gtype, &id, &detail, true)
+		if uint32(id) == 0 {
+			return fmt.Errorf("vdom: %s has no signal %s", gobject.TypeName(inst.gtype), signal)
+		}
+		h := &handler{fn: fn}
+		w := inst.widget
+		cb := func() {
+			h.fn(w)
+		}
+		h.id = w.ConnectSignal(signal, &cb)
+		inst.handlers[signal] = h
+	}
+	return nil
+}
+
+// patchChildren updates the children of the widget to the nodes,
+// the instances of the previous children are reused by key or by position
+func (inst *instance) patchChildren(nodes []*Node) error {
+	nodes = skipNil(nodes)
+	if len(nodes) == 0 && len(inst.children) == 0 {
+		return nil
+	}
+	c, err := containerFor(inst.gtype, inst.widget)
+	if err != nil {
+		return err
+	}
+	if _, ok := c.(childProperty); ok && len(nodes) > 1 {
+		return fmt.Errorf("vdom: %s takes a single child, got %d", gobject.TypeName(inst.gtype), len(nodes))
+	}
+
+	keyed := make(map[string]*instance)
+	var unkeyed []*instance
+	for _, child := range inst.children {
+		if child.key != "" {
+			keyed[child.key] = child
+		} else {
+			unkeyed = append(unkeyed, child)
+		}
+	}
+	used := make(map[*instance]bool)
+	next := make([]*instance, 0, len(nodes))
+	created := make(map[*instance]bool)
+	fail := func(err error) error {
+		for child := range created {
+			child.release()
+		}
+		return err
+	}
+	for _, n := range nodes {
+		var old *instance
+		if n.Key != "" {
+			old = keyed[n.Key]
+		} else if len(unkeyed) > 0 {
+			old = unkeyed[0]
+			unkeyed = unkeyed[1:]
+		}
+		if old != nil && !used[old] && old.matches(n) {
+			used[old] = true
+			if err := old.patch(n); err != nil {
+				return fail(err)
+			}
+			next = append(next, old)
+			continue
+		}
+		child, err := create(n)
+		if err != nil {
+			return fail(err)
+		}
+		created[child] = true
+		next = append(next, child)
+	}
+
+	for _, child := range inst.children {
+		if !used[child] {
+			c.Remove(inst.widget, child.widget)
+			child.release()
+		}
+	}
+	var prev *gtk.Widget
+	for _, child := range next {
+		switch {
+		case created[child]:
+			c.Insert(inst.widget, child.widget, prev)
+		case !isPrevSibling(child.widget, prev):
+			c.Move(inst.widget, child.widget, prev)
+		}
+		prev = child.widget
+	}
+	inst.children = next
+	return nil
+}
+
+// isPrevSibling returns whether prev is the widget before w, nil for the first widget
+func isPrevSibling(w, prev *gtk.Widget) bool {
+	sibling := w.GetPrevSibling()
+	if sibling == nil {
+		return prev == nil
+	}
+	sibling.Unref()
+	return prev != nil && sibling.Ptr == prev.Ptr
+}
+
+func skipNil(nodes []*Node) []*Node {
+	ret := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n != nil {
+			ret = append(ret, n)
+		}
+	}
+	return ret
+}
+
+func copyProps(props Props) Props {
+	ret := make(Props, len(props))
+	for name, v := range props {
+		ret[name] = v
+	}
+	return ret
+}
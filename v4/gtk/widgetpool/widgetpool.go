@@ -0,0 +1,144 @@
+// Package widgetpool recycles widgets of one type, so UIs that rebuild large sections often,
+// e.g. chat logs or dashboards, do not create and style the same widgets over and over.
+//
+//	labels := widgetpool.New(func() *gtk.Label {
+//		return gtk.NewLabel(nil)
+//	}, func(l *gtk.Label) {
+//		l.SetText("")
+//		l.RemoveCssClass("unread")
+//	})
+//
+//	widgetpool.Recycle(&log.Widget) // puts the labels of the previous messages back
+//	for _, msg := range messages {
+//		l := labels.Get()
+//		l.SetText(msg.Text)
+//		log.Append(&l.Widget)
+//	}
+//
+// It does not need a GtkListView, but for very long lists the list widgets recycle rows themselves.
+// All functions must be called on the main thread.
+package widgetpool
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// dataKey is the key of the object data that returns a pooled widget to its pool
+const dataKey = "puregotk-widgetpool"
+
+// Widget is a generated widget class, e.g. *gtk.Label.
+type Widget interface {
+	GoPointer() uintptr
+}
+
+// Pool keeps widgets of type T that are not in use, created by New.
+type Pool[T Widget] struct {
+	create func() T
+	reset  func(T)
+	// free holds a strong reference on each widget
+	free []T
+	max  int
+}
+
+// New returns a pool that creates widgets with create when it is empty.
+// reset, which may be nil, is called when a widget is put back
+// and should undo everything the user of the widget changed, like text, CSS classes and signal handlers.
+func New[T Widget](create func() T, reset func(T)) *Pool[T] {
+	return &Pool[T]{
+		create: create,
+		reset:  reset,
+	}
+}
+
+// SetMax limits the number of widgets the pool keeps, widgets put back into a full pool are released.
+// Zero, the default, keeps all widgets.
+func (p *Pool[T]) SetMax(n int) {
+	p.max = n
+	if n > 0 && len(p.free) > n {
+		p.release(p.free[n:])
+		p.free = p.free[:n]
+	}
+}
+
+// Len returns the number of widgets in the pool that are not in use.
+func (p *Pool[T]) Len() int {
+	return len(p.free)
+}
+
+// Get returns a widget from the pool, or a new one if the pool is empty.
+// Like the generated constructors, the widget has a floating reference, so the parent it is added to owns it.
+func (p *Pool[T]) Get() T {
+	glib.AssertMainThread("widgetpool.Pool.Get")
+	if n := len(p.free); n > 0 {
+		w := p.free[n-1]
+		var zero T
+		p.free[n-1] = zero
+		p.free = p.free[:n-1]
+		// hand the reference of the pool to the caller, as if the widget was just created
+		(&gobject.Object{Ptr: w.GoPointer()}).ForceFloating()
+		return w
+	}
+	w := p.create()
+	gobject.SetData(&gobject.Object{Ptr: w.GoPointer()}, dataKey, func() {
+		p.Put(w)
+	})
+	return w
+}
+
+// Put resets the widget and returns it to the pool, removing it from its parent if it still has one.
+// Put a widget before removing it from its parent yourself,
+// the parent may hold the last reference and the widget is finalized when it is removed.
+func (p *Pool[T]) Put(w T) {
+	glib.AssertMainThread("widgetpool.Pool.Put")
+	obj := &gobject.Object{Ptr: w.GoPointer()}
+	obj.RefSink()
+	widget := gtk.WidgetNewFromInternalPtr(w.GoPointer())
+	if parent := widget.GetParent(); parent != nil {
+		parent.Unref()
+		widget.Unparent()
+	}
+	if p.reset != nil {
+		p.reset(w)
+	}
+	if p.max > 0 && len(p.free) >= p.max {
+		obj.Unref()
+		return
+	}
+	p.free = append(p.free, w)
+}
+
+// Clear releases all widgets in the pool, they are finalized unless they are referenced elsewhere.
+func (p *Pool[T]) Clear() {
+	p.release(p.free)
+	p.free = nil
+}
+
+func (p *Pool[T]) release(ws []T) {
+	for _, w := range ws {
+		(&gobject.Object{Ptr: w.GoPointer()}).Unref()
+	}
+}
+
+// Recycle puts the children of parent that were created by a pool back into their pool,
+// whatever the type of the pool is. Children that do not come from a pool are left in place.
+// It returns the number of recycled children.
+//
+// The children are removed with gtk_widget_unparent, which works for containers such as GtkBox, GtkGrid and GtkOverlay
+// but not for containers that wrap their children, like GtkListBox, there recycle the child of each row instead.
+func Recycle(parent *gtk.Widget) int {
+	glib.AssertMainThread("widgetpool.Recycle")
+	n := 0
+	child := parent.GetFirstChild()
+	for child != nil {
+		next := child.GetNextSibling()
+		if put, ok := gobject.GetData[func()](child, dataKey); ok {
+			put()
+			n++
+		}
+		child.Unref()
+		child = next
+	}
+	return n
+}
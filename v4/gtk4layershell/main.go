@@ -0,0 +1,474 @@
+// Package gtk4layershell was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package gtk4layershell
+
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+)
+
+// The edges of the output that a layer surface can be anchored to.
+type Edge int
+
+const (
+
+	// The left edge of the screen.
+	GtkLayerShellEdgeLeftValue Edge = 0
+	// The right edge of the screen.
+	GtkLayerShellEdgeRightValue Edge = 1
+	// The top edge of the screen.
+	GtkLayerShellEdgeTopValue Edge = 2
+	// The bottom edge of the screen.
+	GtkLayerShellEdgeBottomValue Edge = 3
+	// Should not be used except to get the number of entries.
+	GtkLayerShellEdgeEntryNumberValue Edge = 4
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x Edge) String() string {
+	switch x {
+	case GtkLayerShellEdgeLeftValue:
+		return "GTK_LAYER_SHELL_EDGE_LEFT"
+	case GtkLayerShellEdgeRightValue:
+		return "GTK_LAYER_SHELL_EDGE_RIGHT"
+	case GtkLayerShellEdgeTopValue:
+		return "GTK_LAYER_SHELL_EDGE_TOP"
+	case GtkLayerShellEdgeBottomValue:
+		return "GTK_LAYER_SHELL_EDGE_BOTTOM"
+	case GtkLayerShellEdgeEntryNumberValue:
+		return "GTK_LAYER_SHELL_EDGE_ENTRY_NUMBER"
+	}
+	return fmt.Sprintf("Edge(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Edge) Nick() string {
+	switch x {
+	case GtkLayerShellEdgeLeftValue:
+		return "left"
+	case GtkLayerShellEdgeRightValue:
+		return "right"
+	case GtkLayerShellEdgeTopValue:
+		return "top"
+	case GtkLayerShellEdgeBottomValue:
+		return "bottom"
+	case GtkLayerShellEdgeEntryNumberValue:
+		return "entry-number"
+	}
+	return ""
+}
+
+// EdgeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EdgeFromString(s string) (Edge, bool) {
+	switch s {
+	case "GTK_LAYER_SHELL_EDGE_LEFT", "left":
+		return GtkLayerShellEdgeLeftValue, true
+	case "GTK_LAYER_SHELL_EDGE_RIGHT", "right":
+		return GtkLayerShellEdgeRightValue, true
+	case "GTK_LAYER_SHELL_EDGE_TOP", "top":
+		return GtkLayerShellEdgeTopValue, true
+	case "GTK_LAYER_SHELL_EDGE_BOTTOM", "bottom":
+		return GtkLayerShellEdgeBottomValue, true
+	case "GTK_LAYER_SHELL_EDGE_ENTRY_NUMBER", "entry-number":
+		return GtkLayerShellEdgeEntryNumberValue, true
+	}
+	return 0, false
+}
+
+// How a layer surface receives keyboard focus.
+type KeyboardMode int
+
+const (
+
+	// This window should not receive keyboard events.
+	GtkLayerShellKeyboardModeNoneValue KeyboardMode = 0
+	// This window should have exclusive focus if it is on the top or overlay layer.
+	GtkLayerShellKeyboardModeExclusiveValue KeyboardMode = 1
+	// The user should be able to focus and unfocus this window in an implementation defined way.
+	GtkLayerShellKeyboardModeOnDemandValue KeyboardMode = 2
+	// Should not be used except to get the number of entries.
+	GtkLayerShellKeyboardModeEntryNumberValue KeyboardMode = 3
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x KeyboardMode) String() string {
+	switch x {
+	case GtkLayerShellKeyboardModeNoneValue:
+		return "GTK_LAYER_SHELL_KEYBOARD_MODE_NONE"
+	case GtkLayerShellKeyboardModeExclusiveValue:
+		return "GTK_LAYER_SHELL_KEYBOARD_MODE_EXCLUSIVE"
+	case GtkLayerShellKeyboardModeOnDemandValue:
+		return "GTK_LAYER_SHELL_KEYBOARD_MODE_ON_DEMAND"
+	case GtkLayerShellKeyboardModeEntryNumberValue:
+		return "GTK_LAYER_SHELL_KEYBOARD_MODE_ENTRY_NUMBER"
+	}
+	return fmt.Sprintf("KeyboardMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x KeyboardMode) Nick() string {
+	switch x {
+	case GtkLayerShellKeyboardModeNoneValue:
+		return "none"
+	case GtkLayerShellKeyboardModeExclusiveValue:
+		return "exclusive"
+	case GtkLayerShellKeyboardModeOnDemandValue:
+		return "on-demand"
+	case GtkLayerShellKeyboardModeEntryNumberValue:
+		return "entry-number"
+	}
+	return ""
+}
+
+// KeyboardModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func KeyboardModeFromString(s string) (KeyboardMode, bool) {
+	switch s {
+	case "GTK_LAYER_SHELL_KEYBOARD_MODE_NONE", "none":
+		return GtkLayerShellKeyboardModeNoneValue, true
+	case "GTK_LAYER_SHELL_KEYBOARD_MODE_EXCLUSIVE", "exclusive":
+		return GtkLayerShellKeyboardModeExclusiveValue, true
+	case "GTK_LAYER_SHELL_KEYBOARD_MODE_ON_DEMAND", "on-demand":
+		return GtkLayerShellKeyboardModeOnDemandValue, true
+	case "GTK_LAYER_SHELL_KEYBOARD_MODE_ENTRY_NUMBER", "entry-number":
+		return GtkLayerShellKeyboardModeEntryNumberValue, true
+	}
+	return 0, false
+}
+
+// The layers of the compositor that a layer surface can be rendered in.
+type Layer int
+
+const (
+
+	// The background layer.
+	GtkLayerShellLayerBackgroundValue Layer = 0
+	// The bottom layer, below normal windows.
+	GtkLayerShellLayerBottomValue Layer = 1
+	// The top layer, above normal windows.
+	GtkLayerShellLayerTopValue Layer = 2
+	// The overlay layer, above fullscreen windows.
+	GtkLayerShellLayerOverlayValue Layer = 3
+	// Should not be used except to get the number of entries.
+	GtkLayerShellLayerEntryNumberValue Layer = 4
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x Layer) String() string {
+	switch x {
+	case GtkLayerShellLayerBackgroundValue:
+		return "GTK_LAYER_SHELL_LAYER_BACKGROUND"
+	case GtkLayerShellLayerBottomValue:
+		return "GTK_LAYER_SHELL_LAYER_BOTTOM"
+	case GtkLayerShellLayerTopValue:
+		return "GTK_LAYER_SHELL_LAYER_TOP"
+	case GtkLayerShellLayerOverlayValue:
+		return "GTK_LAYER_SHELL_LAYER_OVERLAY"
+	case GtkLayerShellLayerEntryNumberValue:
+		return "GTK_LAYER_SHELL_LAYER_ENTRY_NUMBER"
+	}
+	return fmt.Sprintf("Layer(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Layer) Nick() string {
+	switch x {
+	case GtkLayerShellLayerBackgroundValue:
+		return "background"
+	case GtkLayerShellLayerBottomValue:
+		return "bottom"
+	case GtkLayerShellLayerTopValue:
+		return "top"
+	case GtkLayerShellLayerOverlayValue:
+		return "overlay"
+	case GtkLayerShellLayerEntryNumberValue:
+		return "entry-number"
+	}
+	return ""
+}
+
+// LayerFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LayerFromString(s string) (Layer, bool) {
+	switch s {
+	case "GTK_LAYER_SHELL_LAYER_BACKGROUND", "background":
+		return GtkLayerShellLayerBackgroundValue, true
+	case "GTK_LAYER_SHELL_LAYER_BOTTOM", "bottom":
+		return GtkLayerShellLayerBottomValue, true
+	case "GTK_LAYER_SHELL_LAYER_TOP", "top":
+		return GtkLayerShellLayerTopValue, true
+	case "GTK_LAYER_SHELL_LAYER_OVERLAY", "overlay":
+		return GtkLayerShellLayerOverlayValue, true
+	case "GTK_LAYER_SHELL_LAYER_ENTRY_NUMBER", "entry-number":
+		return GtkLayerShellLayerEntryNumberValue, true
+	}
+	return 0, false
+}
+
+var xAutoExclusiveZoneEnable func(uintptr)
+
+// Keeps the exclusive zone at the size of the surface, until [SetExclusiveZone] is called.
+func AutoExclusiveZoneEnable(WindowVar *gtk.Window) {
+
+	xAutoExclusiveZoneEnable(WindowVar.GoPointer())
+
+}
+
+var xAutoExclusiveZoneIsEnabled func(uintptr) bool
+
+// Returns whether the exclusive zone follows the size of the surface.
+func AutoExclusiveZoneIsEnabled(WindowVar *gtk.Window) bool {
+
+	cret := xAutoExclusiveZoneIsEnabled(WindowVar.GoPointer())
+	return cret
+}
+
+var xGetAnchor func(uintptr, Edge) bool
+
+// Returns whether the surface is anchored to the edge.
+func GetAnchor(WindowVar *gtk.Window, EdgeVar Edge) bool {
+
+	cret := xGetAnchor(WindowVar.GoPointer(), EdgeVar)
+	return cret
+}
+
+var xGetExclusiveZone func(uintptr) int
+
+// Returns the exclusive zone of the surface.
+func GetExclusiveZone(WindowVar *gtk.Window) int {
+
+	cret := xGetExclusiveZone(WindowVar.GoPointer())
+	return cret
+}
+
+var xGetKeyboardMode func(uintptr) KeyboardMode
+
+// Returns how the surface receives keyboard focus.
+func GetKeyboardMode(WindowVar *gtk.Window) KeyboardMode {
+
+	cret := xGetKeyboardMode(WindowVar.GoPointer())
+	return cret
+}
+
+var xGetLayer func(uintptr) Layer
+
+// Returns the layer of the surface.
+func GetLayer(WindowVar *gtk.Window) Layer {
+
+	cret := xGetLayer(WindowVar.GoPointer())
+	return cret
+}
+
+var xGetMajorVersion func() uint
+
+// Returns the major version of the loaded library.
+func GetMajorVersion() uint {
+
+	cret := xGetMajorVersion()
+	return cret
+}
+
+var xGetMargin func(uintptr, Edge) int
+
+// Returns the margin of the surface to the edge.
+func GetMargin(WindowVar *gtk.Window, EdgeVar Edge) int {
+
+	cret := xGetMargin(WindowVar.GoPointer(), EdgeVar)
+	return cret
+}
+
+var xGetMicroVersion func() uint
+
+// Returns the micro version of the loaded library.
+func GetMicroVersion() uint {
+
+	cret := xGetMicroVersion()
+	return cret
+}
+
+var xGetMinorVersion func() uint
+
+// Returns the minor version of the loaded library.
+func GetMinorVersion() uint {
+
+	cret := xGetMinorVersion()
+	return cret
+}
+
+var xGetMonitor func(uintptr) uintptr
+
+// Returns the monitor that was set with [SetMonitor], or nil.
+func GetMonitor(WindowVar *gtk.Window) *gdk.Monitor {
+	var cls *gdk.Monitor
+
+	cret := xGetMonitor(WindowVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &gdk.Monitor{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xGetNamespace func(uintptr) string
+
+// Returns the namespace of the surface.
+func GetNamespace(WindowVar *gtk.Window) string {
+
+	cret := xGetNamespace(WindowVar.GoPointer())
+	return cret
+}
+
+var xGetProtocolVersion func() uint
+
+// Returns the version of the layer shell protocol that the compositor supports, or 0 if it does not.
+func GetProtocolVersion() uint {
+
+	cret := xGetProtocolVersion()
+	return cret
+}
+
+var xInitForWindow func(uintptr)
+
+// Makes the window a layer surface. This has to be called before the window is realized,
+// i.e. before it is presented.
+func InitForWindow(WindowVar *gtk.Window) {
+
+	xInitForWindow(WindowVar.GoPointer())
+
+}
+
+var xIsLayerWindow func(uintptr) bool
+
+// Returns whether [InitForWindow] was called for the window.
+func IsLayerWindow(WindowVar *gtk.Window) bool {
+
+	cret := xIsLayerWindow(WindowVar.GoPointer())
+	return cret
+}
+
+var xSetAnchor func(uintptr, Edge, bool)
+
+// Anchors the surface to the edge of the monitor or releases it.
+// A surface anchored to two opposite edges is stretched between them.
+func SetAnchor(WindowVar *gtk.Window, EdgeVar Edge, AnchorToEdgeVar bool) {
+
+	xSetAnchor(WindowVar.GoPointer(), EdgeVar, AnchorToEdgeVar)
+
+}
+
+var xSetExclusiveZone func(uintptr, int)
+
+// Sets the size of the area at the anchored edge that other surfaces should not cover, e.g. the height of a bar.
+// 0 lets other surfaces move the surface aside, -1 makes it ignore the exclusive zones of other surfaces.
+func SetExclusiveZone(WindowVar *gtk.Window, ExclusiveZoneVar int) {
+
+	xSetExclusiveZone(WindowVar.GoPointer(), ExclusiveZoneVar)
+
+}
+
+var xSetKeyboardMode func(uintptr, KeyboardMode)
+
+// Sets how the surface receives keyboard focus, the default is [GtkLayerShellKeyboardModeNoneValue].
+func SetKeyboardMode(WindowVar *gtk.Window, ModeVar KeyboardMode) {
+
+	xSetKeyboardMode(WindowVar.GoPointer(), ModeVar)
+
+}
+
+var xSetLayer func(uintptr, Layer)
+
+// Sets the layer that the surface is rendered in, the default is [GtkLayerShellLayerTopValue].
+func SetLayer(WindowVar *gtk.Window, LayerVar Layer) {
+
+	xSetLayer(WindowVar.GoPointer(), LayerVar)
+
+}
+
+var xSetMargin func(uintptr, Edge, int)
+
+// Sets the distance of the surface to the edge in pixels, it only applies if the surface is anchored to the edge.
+func SetMargin(WindowVar *gtk.Window, EdgeVar Edge, MarginSizeVar int) {
+
+	xSetMargin(WindowVar.GoPointer(), EdgeVar, MarginSizeVar)
+
+}
+
+var xSetMonitor func(uintptr, uintptr)
+
+// Sets the monitor that the surface is shown on, nil lets the compositor choose.
+func SetMonitor(WindowVar *gtk.Window, MonitorVar *gdk.Monitor) {
+
+	xSetMonitor(WindowVar.GoPointer(), MonitorVar.GoPointer())
+
+}
+
+var xSetNamespace func(uintptr, uintptr)
+
+// Sets the namespace of the surface, which compositors use to apply rules to it, e.g. "panel".
+// It has to be set before the window is realized, the default is "gtk4-layer-shell".
+func SetNamespace(WindowVar *gtk.Window, NameSpaceVar *string) {
+
+	NameSpaceVarPtr := core.GStrdupNullable(NameSpaceVar)
+	defer core.GFreeNullable(NameSpaceVarPtr)
+
+	xSetNamespace(WindowVar.GoPointer(), NameSpaceVarPtr)
+
+}
+
+// CheckVersionAtLeast returns whether the loaded GTK4LAYERSHELL library is version major.minor.micro or later,
+// calling a function that was added in a later version panics with a core.MissingSymbolError.
+func CheckVersionAtLeast(major, minor, micro uint) bool {
+	if v := GetMajorVersion(); v != major {
+		return v > major
+	}
+	if v := GetMinorVersion(); v != minor {
+		return v > minor
+	}
+	return GetMicroVersion() >= micro
+}
+
+func init() {
+	core.SetPackageName("GTK4LAYERSHELL", "gtk4-layer-shell-0")
+	core.SetSharedLibraries("GTK4LAYERSHELL", []string{"libgtk4-layer-shell.so.0"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("GTK4LAYERSHELL")
+	if err != nil {
+		core.SetLibraryError("GTK4LAYERSHELL", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("GTK4LAYERSHELL", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xAutoExclusiveZoneEnable, libs, "gtk_layer_auto_exclusive_zone_enable", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xAutoExclusiveZoneIsEnabled, libs, "gtk_layer_auto_exclusive_zone_is_enabled", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetAnchor, libs, "gtk_layer_get_anchor", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetExclusiveZone, libs, "gtk_layer_get_exclusive_zone", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetKeyboardMode, libs, "gtk_layer_get_keyboard_mode", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetLayer, libs, "gtk_layer_get_layer", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetMajorVersion, libs, "gtk_layer_get_major_version", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetMargin, libs, "gtk_layer_get_margin", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetMicroVersion, libs, "gtk_layer_get_micro_version", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetMinorVersion, libs, "gtk_layer_get_minor_version", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetMonitor, libs, "gtk_layer_get_monitor", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetNamespace, libs, "gtk_layer_get_namespace", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xGetProtocolVersion, libs, "gtk_layer_get_protocol_version", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xInitForWindow, libs, "gtk_layer_init_for_window", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xIsLayerWindow, libs, "gtk_layer_is_layer_window", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetAnchor, libs, "gtk_layer_set_anchor", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetExclusiveZone, libs, "gtk_layer_set_exclusive_zone", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetKeyboardMode, libs, "gtk_layer_set_keyboard_mode", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetLayer, libs, "gtk_layer_set_layer", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetMargin, libs, "gtk_layer_set_margin", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetMonitor, libs, "gtk_layer_set_monitor", "GTK4LAYERSHELL", "")
+	core.PuregoRegisterSince(&xSetNamespace, libs, "gtk_layer_set_namespace", "GTK4LAYERSHELL", "")
+
+}
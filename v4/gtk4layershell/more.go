@@ -0,0 +1,45 @@
+// Package gtk4layershell binds gtk4-layer-shell, which turns GTK windows into Wayland layer surfaces,
+// e.g. for bars, launchers, docks and overlays on wlroots based compositors:
+//
+//	gtk4layershell.InitForWindow(win)
+//	gtk4layershell.SetLayer(win, gtk4layershell.GtkLayerShellLayerTopValue)
+//	gtk4layershell.SetAnchor(win, gtk4layershell.GtkLayerShellEdgeTopValue, true)
+//	gtk4layershell.AutoExclusiveZoneEnable(win)
+//
+// gtk4-layer-shell has to be loaded before libwayland-client, which GTK loads when the gtk package is initialized,
+// so start the application with LD_PRELOAD set to the library, e.g. LD_PRELOAD=libgtk4-layer-shell.so.
+// The library is looked up like the other libraries, PUREGOTK_GTK4LAYERSHELL_PATH overrides its path.
+//
+// The library is optional: if it is not installed, importing the package does not fail,
+// IsSupported returns false and the other functions panic with a *core.MissingSymbolError.
+// core.LibraryError("GTK4LAYERSHELL") returns why it could not be loaded.
+package gtk4layershell
+
+import (
+	"sync"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var (
+	xIsSupported    func() bool
+	isSupportedOnce sync.Once
+)
+
+// Returns whether the library is loaded and the compositor supports the layer shell protocol.
+// If it does not, e.g. on X11 or GNOME, layer windows are shown as normal windows.
+func IsSupported() bool {
+	isSupportedOnce.Do(func() {
+		if core.LibraryError("GTK4LAYERSHELL") != nil {
+			return
+		}
+		var libs []uintptr
+		for _, libPath := range core.GetPaths("GTK4LAYERSHELL") {
+			if lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL); err == nil {
+				libs = append(libs, lib)
+			}
+		}
+		core.PuregoSafeRegister(&xIsSupported, libs, "gtk_layer_is_supported")
+	})
+	return xIsSupported != nil && xIsSupported()
+}
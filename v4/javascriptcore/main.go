@@ -0,0 +1,580 @@
+// Package javascriptcore was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package javascriptcore
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// JSCContext represents a JavaScript execution context, where all operations take place and where the values will be associated.
+//
+// When a new context is created, a global object is allocated and the built-in JavaScript objects (Object, Function, String, Array) are populated.
+type Context struct {
+	gobject.Object
+}
+
+var xContextGLibType func() types.GType
+
+func ContextGLibType() types.GType {
+	return xContextGLibType()
+}
+
+func ContextNewFromInternalPtr(ptr uintptr) *Context {
+	cls := &Context{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewContext func() uintptr
+
+// Create a new [Context]. The context is created in a new JSCVirtualMachine.
+func NewContext() *Context {
+	var cls *Context
+
+	cret := xNewContext()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Context{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xContextClearException func(uintptr)
+
+// Clear the uncaught exception in context if any.
+func (x *Context) ClearException() {
+
+	xContextClearException(x.GoPointer())
+
+}
+
+var xContextEvaluate func(uintptr, string, int) uintptr
+
+// Evaluate code in context.
+func (x *Context) Evaluate(CodeVar string, LengthVar int) *Value {
+	var cls *Value
+
+	cret := xContextEvaluate(x.GoPointer(), CodeVar, LengthVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xContextGetException func(uintptr) uintptr
+
+// Get the last unhandled exception thrown in context by API functions calls.
+func (x *Context) GetException() *Exception {
+	var cls *Exception
+
+	cret := xContextGetException(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Exception{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xContextGetGlobalObject func(uintptr) uintptr
+
+// Get a [Value] referencing the context global object
+func (x *Context) GetGlobalObject() *Value {
+	var cls *Value
+
+	cret := xContextGetGlobalObject(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xContextGetValue func(uintptr, string) uintptr
+
+// Get a property of context global object with name.
+func (x *Context) GetValue(NameVar string) *Value {
+	var cls *Value
+
+	cret := xContextGetValue(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xContextSetValue func(uintptr, string, uintptr)
+
+// Set a property of context global object with name and value.
+func (x *Context) SetValue(NameVar string, ValueVar *Value) {
+
+	xContextSetValue(x.GoPointer(), NameVar, ValueVar.GoPointer())
+
+}
+
+var xContextThrow func(uintptr, string)
+
+// Throw an exception to context using the given error message. The created [Exception] can be retrieved with [Context.GetException].
+func (x *Context) Throw(ErrorMessageVar string) {
+
+	xContextThrow(x.GoPointer(), ErrorMessageVar)
+
+}
+
+func (c *Context) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Context) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// JSCException represents a JavaScript exception.
+type Exception struct {
+	gobject.Object
+}
+
+var xExceptionGLibType func() types.GType
+
+func ExceptionGLibType() types.GType {
+	return xExceptionGLibType()
+}
+
+func ExceptionNewFromInternalPtr(ptr uintptr) *Exception {
+	cls := &Exception{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xExceptionGetColumnNumber func(uintptr) uint
+
+// Get the column number at which exception happened.
+func (x *Exception) GetColumnNumber() uint {
+
+	cret := xExceptionGetColumnNumber(x.GoPointer())
+	return cret
+}
+
+var xExceptionGetLineNumber func(uintptr) uint
+
+// Get the line number at which exception happened.
+func (x *Exception) GetLineNumber() uint {
+
+	cret := xExceptionGetLineNumber(x.GoPointer())
+	return cret
+}
+
+var xExceptionGetMessage func(uintptr) string
+
+// Get the error message of exception.
+func (x *Exception) GetMessage() string {
+
+	cret := xExceptionGetMessage(x.GoPointer())
+	return cret
+}
+
+var xExceptionGetName func(uintptr) string
+
+// Get the error name of exception
+func (x *Exception) GetName() string {
+
+	cret := xExceptionGetName(x.GoPointer())
+	return cret
+}
+
+var xExceptionToString func(uintptr) string
+
+// Get the string representation of exception error.
+func (x *Exception) ToString() string {
+
+	cret := xExceptionToString(x.GoPointer())
+	return cret
+}
+
+func (c *Exception) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Exception) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// JSCValue represents a reference to a value in a [Context]. The JSCValue protects the referenced value from being garbage collected.
+type Value struct {
+	gobject.Object
+}
+
+var xValueGLibType func() types.GType
+
+func ValueGLibType() types.GType {
+	return xValueGLibType()
+}
+
+func ValueNewFromInternalPtr(ptr uintptr) *Value {
+	cls := &Value{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewValueBoolean func(uintptr, bool) uintptr
+
+// Create a new [Value] from value
+func NewValueBoolean(ContextVar *Context, ValueVar bool) *Value {
+	var cls *Value
+
+	cret := xNewValueBoolean(ContextVar.GoPointer(), ValueVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewValueFromJson func(uintptr, string) uintptr
+
+// Create a new [Value] referencing a new value created by parsing json.
+func NewValueFromJson(ContextVar *Context, JsonVar string) *Value {
+	var cls *Value
+
+	cret := xNewValueFromJson(ContextVar.GoPointer(), JsonVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewValueNull func(uintptr) uintptr
+
+// Create a new [Value] referencing <function>null</function> in context.
+func NewValueNull(ContextVar *Context) *Value {
+	var cls *Value
+
+	cret := xNewValueNull(ContextVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewValueNumber func(uintptr, float64) uintptr
+
+// Create a new [Value] from number.
+func NewValueNumber(ContextVar *Context, NumberVar float64) *Value {
+	var cls *Value
+
+	cret := xNewValueNumber(ContextVar.GoPointer(), NumberVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewValueString func(uintptr, uintptr) uintptr
+
+// Create a new [Value] from string. If you need to create a [Value] from a string containing null characters, use jsc_value_new_string_from_bytes() instead.
+func NewValueString(ContextVar *Context, StringVar *string) *Value {
+	var cls *Value
+
+	StringVarPtr := core.GStrdupNullable(StringVar)
+	defer core.GFreeNullable(StringVarPtr)
+
+	cret := xNewValueString(ContextVar.GoPointer(), StringVarPtr)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xNewValueUndefined func(uintptr) uintptr
+
+// Create a new [Value] referencing <function>undefined</function> in context.
+func NewValueUndefined(ContextVar *Context) *Value {
+	var cls *Value
+
+	cret := xNewValueUndefined(ContextVar.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xValueGetContext func(uintptr) uintptr
+
+// Get the [Context] in which value was created.
+func (x *Value) GetContext() *Context {
+	var cls *Context
+
+	cret := xValueGetContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Context{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xValueIsArray func(uintptr) bool
+
+// Get whether the value referenced by value is an array.
+func (x *Value) IsArray() bool {
+
+	cret := xValueIsArray(x.GoPointer())
+	return cret
+}
+
+var xValueIsBoolean func(uintptr) bool
+
+// Get whether the value referenced by value is a boolean.
+func (x *Value) IsBoolean() bool {
+
+	cret := xValueIsBoolean(x.GoPointer())
+	return cret
+}
+
+var xValueIsFunction func(uintptr) bool
+
+// Get whether the value referenced by value is a function.
+func (x *Value) IsFunction() bool {
+
+	cret := xValueIsFunction(x.GoPointer())
+	return cret
+}
+
+var xValueIsNull func(uintptr) bool
+
+// Get whether the value referenced by value is a null.
+func (x *Value) IsNull() bool {
+
+	cret := xValueIsNull(x.GoPointer())
+	return cret
+}
+
+var xValueIsNumber func(uintptr) bool
+
+// Get whether the value referenced by value is a number.
+func (x *Value) IsNumber() bool {
+
+	cret := xValueIsNumber(x.GoPointer())
+	return cret
+}
+
+var xValueIsObject func(uintptr) bool
+
+// Get whether the value referenced by value is an object.
+func (x *Value) IsObject() bool {
+
+	cret := xValueIsObject(x.GoPointer())
+	return cret
+}
+
+var xValueIsString func(uintptr) bool
+
+// Get whether the value referenced by value is a string.
+func (x *Value) IsString() bool {
+
+	cret := xValueIsString(x.GoPointer())
+	return cret
+}
+
+var xValueIsUndefined func(uintptr) bool
+
+// Get whether the value referenced by value is a undefined.
+func (x *Value) IsUndefined() bool {
+
+	cret := xValueIsUndefined(x.GoPointer())
+	return cret
+}
+
+var xValueObjectGetProperty func(uintptr, string) uintptr
+
+// Get property with name from value.
+func (x *Value) ObjectGetProperty(NameVar string) *Value {
+	var cls *Value
+
+	cret := xValueObjectGetProperty(x.GoPointer(), NameVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Value{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xValueObjectHasProperty func(uintptr, string) bool
+
+// Get whether value has property with name.
+func (x *Value) ObjectHasProperty(NameVar string) bool {
+
+	cret := xValueObjectHasProperty(x.GoPointer(), NameVar)
+	return cret
+}
+
+var xValueObjectSetProperty func(uintptr, string, uintptr)
+
+// Set property with name on value.
+func (x *Value) ObjectSetProperty(NameVar string, PropertyVar *Value) {
+
+	xValueObjectSetProperty(x.GoPointer(), NameVar, PropertyVar.GoPointer())
+
+}
+
+var xValueToBoolean func(uintptr) bool
+
+// Convert value to a boolean.
+func (x *Value) ToBoolean() bool {
+
+	cret := xValueToBoolean(x.GoPointer())
+	return cret
+}
+
+var xValueToDouble func(uintptr) float64
+
+// Convert value to a double.
+func (x *Value) ToDouble() float64 {
+
+	cret := xValueToDouble(x.GoPointer())
+	return cret
+}
+
+var xValueToInt32 func(uintptr) int32
+
+// Convert value to a #gint32.
+func (x *Value) ToInt32() int32 {
+
+	cret := xValueToInt32(x.GoPointer())
+	return cret
+}
+
+var xValueToJson func(uintptr, uint) string
+
+// Create a JSON string of value serialization. If indent is 0, the resulting JSON will not contain newlines. The size of the indent is clamped to 10 spaces.
+func (x *Value) ToJson(IndentVar uint) string {
+
+	cret := xValueToJson(x.GoPointer(), IndentVar)
+	return cret
+}
+
+var xValueToString func(uintptr) string
+
+// Convert value to a string. Use jsc_value_to_string_as_bytes() instead, if you need to handle strings containing null characters.
+func (x *Value) ToString() string {
+
+	cret := xValueToString(x.GoPointer())
+	return cret
+}
+
+func (c *Value) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Value) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+func init() {
+	core.SetPackageName("JAVASCRIPTCORE", "javascriptcoregtk-6.0")
+	core.SetSharedLibraries("JAVASCRIPTCORE", []string{"libjavascriptcoregtk-6.0.so.1"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("JAVASCRIPTCORE")
+	if err != nil {
+		core.SetLibraryError("JAVASCRIPTCORE", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("JAVASCRIPTCORE", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xContextGLibType, libs, "jsc_context_get_type")
+
+	core.PuregoRegisterSince(&xNewContext, libs, "jsc_context_new", "JAVASCRIPTCORE", "")
+
+	core.PuregoRegisterSince(&xContextClearException, libs, "jsc_context_clear_exception", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextEvaluate, libs, "jsc_context_evaluate", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextGetException, libs, "jsc_context_get_exception", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextGetGlobalObject, libs, "jsc_context_get_global_object", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextGetValue, libs, "jsc_context_get_value", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextSetValue, libs, "jsc_context_set_value", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xContextThrow, libs, "jsc_context_throw", "JAVASCRIPTCORE", "")
+
+	core.PuregoSafeRegister(&xExceptionGLibType, libs, "jsc_exception_get_type")
+
+	core.PuregoRegisterSince(&xExceptionGetColumnNumber, libs, "jsc_exception_get_column_number", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xExceptionGetLineNumber, libs, "jsc_exception_get_line_number", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xExceptionGetMessage, libs, "jsc_exception_get_message", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xExceptionGetName, libs, "jsc_exception_get_name", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xExceptionToString, libs, "jsc_exception_to_string", "JAVASCRIPTCORE", "")
+
+	core.PuregoSafeRegister(&xValueGLibType, libs, "jsc_value_get_type")
+
+	core.PuregoRegisterSince(&xNewValueBoolean, libs, "jsc_value_new_boolean", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xNewValueFromJson, libs, "jsc_value_new_from_json", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xNewValueNull, libs, "jsc_value_new_null", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xNewValueNumber, libs, "jsc_value_new_number", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xNewValueString, libs, "jsc_value_new_string", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xNewValueUndefined, libs, "jsc_value_new_undefined", "JAVASCRIPTCORE", "")
+
+	core.PuregoRegisterSince(&xValueGetContext, libs, "jsc_value_get_context", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsArray, libs, "jsc_value_is_array", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsBoolean, libs, "jsc_value_is_boolean", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsFunction, libs, "jsc_value_is_function", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsNull, libs, "jsc_value_is_null", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsNumber, libs, "jsc_value_is_number", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsObject, libs, "jsc_value_is_object", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsString, libs, "jsc_value_is_string", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueIsUndefined, libs, "jsc_value_is_undefined", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueObjectGetProperty, libs, "jsc_value_object_get_property", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueObjectHasProperty, libs, "jsc_value_object_has_property", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueObjectSetProperty, libs, "jsc_value_object_set_property", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueToBoolean, libs, "jsc_value_to_boolean", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueToDouble, libs, "jsc_value_to_double", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueToInt32, libs, "jsc_value_to_int32", "JAVASCRIPTCORE", "")
+	core.PuregoRegisterSince(&xValueToJson, libs, "jsc_value_to_json", "JAVASCRIPTCORE", "2.28")
+	core.PuregoRegisterSince(&xValueToString, libs, "jsc_value_to_string", "JAVASCRIPTCORE", "")
+
+}
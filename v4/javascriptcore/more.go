@@ -0,0 +1,93 @@
+// Package javascriptcore binds the values of JavaScriptCore, the JavaScript engine of WebKitGTK,
+// to use the results of the scripts that a webkit.WebView evaluates and the messages that its pages send.
+//
+// Value.GoValue converts a JavaScript value to a Go value and NewValueFromGo does the opposite.
+//
+// The library is optional: if it is not installed, importing the package does not fail
+// and the functions panic with a *core.MissingSymbolError.
+// core.LibraryError("JAVASCRIPTCORE") returns why it could not be loaded.
+package javascriptcore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+// the strings of the conversions are released, the generated ToJson and ToString copy them
+var (
+	xValueToJsonPtr   func(uintptr, uint) uintptr
+	xValueToStringPtr func(uintptr) uintptr
+)
+
+// takeString copies and releases a string that the caller owns
+func takeString(ptr uintptr) string {
+	s := core.GoString(ptr)
+	core.GFree(ptr)
+	return s
+}
+
+// GoValue converts a JavaScript value to a Go value.
+//
+// undefined and null become nil, a boolean a bool, a number a float64 and a string a string.
+// Arrays and objects are serialized to JSON and decoded like encoding/json decodes into an interface{},
+// i.e. an array becomes a []interface{} and an object a map[string]interface{}.
+// Functions and the objects that cannot be serialized, e.g. with cyclic references, return an error.
+func (x *Value) GoValue() (interface{}, error) {
+	switch {
+	case x.IsUndefined(), x.IsNull():
+		return nil, nil
+	case x.IsBoolean():
+		return x.ToBoolean(), nil
+	case x.IsNumber():
+		return x.ToDouble(), nil
+	case x.IsString():
+		return takeString(xValueToStringPtr(x.GoPointer())), nil
+	case x.IsFunction():
+		return nil, errors.New("javascriptcore: a function cannot be converted to a Go value")
+	}
+	ptr := xValueToJsonPtr(x.GoPointer(), 0)
+	if ptr == 0 {
+		return nil, x.exception()
+	}
+	var ret interface{}
+	if err := json.Unmarshal([]byte(takeString(ptr)), &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// exception returns and clears the exception that a conversion threw in the context of the value
+func (x *Value) exception() error {
+	ctx := x.GetContext()
+	exc := ctx.GetException()
+	if exc == nil {
+		return errors.New("javascriptcore: the value cannot be serialized to JSON")
+	}
+	defer ctx.ClearException()
+	return fmt.Errorf("javascriptcore: %s: %s", exc.GetName(), exc.GetMessage())
+}
+
+// NewValueFromGo converts v to a value of ctx by encoding it with encoding/json, see GoValue.
+func NewValueFromGo(ctx *Context, v interface{}) (*Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return NewValueFromJson(ctx, string(b)), nil
+}
+
+func init() {
+	// the generated init reports why the library could not be loaded
+	libPaths, _ := core.LookupPaths("JAVASCRIPTCORE")
+	var libs []uintptr
+	for _, libPath := range libPaths {
+		if lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL); err == nil {
+			libs = append(libs, lib)
+		}
+	}
+	core.PuregoRegisterSince(&xValueToJsonPtr, libs, "jsc_value_to_json", "JAVASCRIPTCORE", "2.28")
+	core.PuregoRegisterSince(&xValueToStringPtr, libs, "jsc_value_to_string", "JAVASCRIPTCORE", "")
+}
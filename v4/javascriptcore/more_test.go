@@ -0,0 +1,57 @@
+package javascriptcore_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/javascriptcore"
+)
+
+func TestGoValue(t *testing.T) {
+	if err := core.LibraryError("JAVASCRIPTCORE"); err != nil {
+		t.Skip("JavaScriptCore is not installed:", err)
+	}
+	ctx := javascriptcore.NewContext()
+	defer ctx.Unref()
+
+	for script, want := range map[string]interface{}{
+		"undefined":                        nil,
+		"null":                             nil,
+		"1 + 1 == 2":                       true,
+		"0.5 * 3":                          1.5,
+		"'puregotk'":                       "puregotk",
+		"[1, 'a', false]":                  []interface{}{1.0, "a", false},
+		"({name: 'x', list: [], n: null})": map[string]interface{}{"name": "x", "list": []interface{}{}, "n": nil},
+	} {
+		value := ctx.Evaluate(script, -1)
+		got, err := value.GoValue()
+		value.Unref()
+		if err != nil {
+			t.Fatalf("%s: %v", script, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s is %#v, want %#v", script, got, want)
+		}
+	}
+
+	for _, script := range []string{"(function() {})", "(() => { const o = {}; o.o = o; return o })()"} {
+		value := ctx.Evaluate(script, -1)
+		if _, err := value.GoValue(); err == nil {
+			t.Fatalf("%s was converted", script)
+		}
+		value.Unref()
+		if ctx.GetException() != nil {
+			t.Fatalf("the exception of %s was not cleared", script)
+		}
+	}
+
+	value, err := javascriptcore.NewValueFromGo(ctx, map[string]interface{}{"a": []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer value.Unref()
+	if !value.IsObject() || value.ObjectGetProperty("a").ToJson(0) != "[1,2]" {
+		t.Fatalf("the value is %s", value.ToJson(0))
+	}
+}
@@ -0,0 +1,233 @@
+package pango
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+// pangoScale is the number of Pango units in a device unit, PANGO_SCALE
+const pangoScale = 1024
+
+// Rect is a rectangle in Pango units or pixels.
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+// Extents are the ink and logical rectangles of a layout.
+// The ink rectangle covers the drawn glyphs, the logical rectangle is the space the text occupies for layout purposes.
+type Extents struct {
+	Ink, Logical Rect
+}
+
+// rectangle is the C layout of PangoRectangle, which has 32 bit fields
+type rectangle struct {
+	X, Y, Width, Height int32
+}
+
+func (r rectangle) rect() Rect {
+	return Rect{X: int(r.X), Y: int(r.Y), Width: int(r.Width), Height: int(r.Height)}
+}
+
+var (
+	xMoreLayoutGetSize         func(uintptr, *int32, *int32)
+	xMoreLayoutGetPixelSize    func(uintptr, *int32, *int32)
+	xMoreLayoutGetExtents      func(uintptr, *rectangle, *rectangle)
+	xMoreLayoutGetPixelExtents func(uintptr, *rectangle, *rectangle)
+	xMoreLayoutIndexToPos      func(uintptr, int32, *rectangle)
+	xMoreLayoutGetCursorPos    func(uintptr, int32, *rectangle, *rectangle)
+	xMoreLayoutGetBaseline     func(uintptr) int32
+)
+
+// PixelSize returns the logical width and height of the layout in pixels.
+func (x *Layout) PixelSize() (int, int) {
+	var w, h int32
+	xMoreLayoutGetPixelSize(x.GoPointer(), &w, &h)
+	return int(w), int(h)
+}
+
+// Size returns the logical width and height of the layout in Pango units.
+func (x *Layout) Size() (int, int) {
+	var w, h int32
+	xMoreLayoutGetSize(x.GoPointer(), &w, &h)
+	return int(w), int(h)
+}
+
+// ExtentsGo returns the ink and logical extents of the layout in Pango units.
+func (x *Layout) ExtentsGo() Extents {
+	var ink, logical rectangle
+	xMoreLayoutGetExtents(x.GoPointer(), &ink, &logical)
+	return Extents{Ink: ink.rect(), Logical: logical.rect()}
+}
+
+// PixelExtentsGo returns the ink and logical extents of the layout in pixels.
+func (x *Layout) PixelExtentsGo() Extents {
+	var ink, logical rectangle
+	xMoreLayoutGetPixelExtents(x.GoPointer(), &ink, &logical)
+	return Extents{Ink: ink.rect(), Logical: logical.rect()}
+}
+
+// BaselinePixels returns the distance from the top of the layout to the baseline of the first line in pixels.
+func (x *Layout) BaselinePixels() int {
+	return UnitsToPixels(int(xMoreLayoutGetBaseline(x.GoPointer())))
+}
+
+// IndexToPosGo returns the logical rectangle of the grapheme at the byte index of the text in Pango units.
+// Byte indexes are the same as the indexes of a Go string.
+func (x *Layout) IndexToPosGo(index int) Rect {
+	var pos rectangle
+	xMoreLayoutIndexToPos(x.GoPointer(), int32(index), &pos)
+	return pos.rect()
+}
+
+// CursorPosGo returns the strong and weak cursor positions at the byte index of the text in Pango units.
+func (x *Layout) CursorPosGo(index int) (Rect, Rect) {
+	var strong, weak rectangle
+	xMoreLayoutGetCursorPos(x.GoPointer(), int32(index), &strong, &weak)
+	return strong.rect(), weak.rect()
+}
+
+// UnitsToPixels converts Pango units to pixels, rounding to the nearest pixel.
+func UnitsToPixels(units int) int {
+	return int(math.Round(float64(units) / pangoScale))
+}
+
+// PixelsToUnits converts pixels to Pango units.
+func PixelsToUnits(pixels float64) int {
+	return int(math.Round(pixels * pangoScale))
+}
+
+// attribute is the C layout of the start of PangoAttribute, which has 32 bit indexes
+type attribute struct {
+	klass      uintptr
+	startIndex uint32
+	endIndex   uint32
+}
+
+// AttrListBuilder builds an attribute list for styling text without markup:
+//
+//	attrs := pango.NewAttrListBuilder().
+//		Bold(0, 5).
+//		Foreground("#e01b24", 6, -1).
+//		Build()
+//	layout.SetAttributes(attrs)
+//	attrs.Unref()
+//
+// Ranges are byte indexes into the text, the same as the indexes of a Go string,
+// an end of -1 extends the attribute to the end of the text.
+type AttrListBuilder struct {
+	list *AttrList
+}
+
+// NewAttrListBuilder creates a builder for a new attribute list.
+func NewAttrListBuilder() *AttrListBuilder {
+	return &AttrListBuilder{list: NewAttrList()}
+}
+
+// Add inserts attr for the range start to end, the list takes ownership of attr.
+func (b *AttrListBuilder) Add(attr *Attribute, start, end int) *AttrListBuilder {
+	if attr == nil {
+		return b
+	}
+	a := (*attribute)(unsafe.Pointer(attr))
+	a.startIndex = uint32(start)
+	a.endIndex = math.MaxUint32
+	if end >= 0 {
+		a.endIndex = uint32(end)
+	}
+	b.list.Insert(attr)
+	return b
+}
+
+// Weight sets the font weight.
+func (b *AttrListBuilder) Weight(weight Weight, start, end int) *AttrListBuilder {
+	return b.Add(AttrWeightNew(weight), start, end)
+}
+
+// Bold sets a bold font weight.
+func (b *AttrListBuilder) Bold(start, end int) *AttrListBuilder {
+	return b.Weight(WeightBoldValue, start, end)
+}
+
+// Italic sets an italic font style.
+func (b *AttrListBuilder) Italic(start, end int) *AttrListBuilder {
+	return b.Add(AttrStyleNew(StyleItalicValue), start, end)
+}
+
+// Underline sets a single underline.
+func (b *AttrListBuilder) Underline(start, end int) *AttrListBuilder {
+	return b.Add(AttrUnderlineNew(UnderlineSingleValue), start, end)
+}
+
+// Strikethrough strikes the text through.
+func (b *AttrListBuilder) Strikethrough(start, end int) *AttrListBuilder {
+	return b.Add(AttrStrikethroughNew(true), start, end)
+}
+
+// Family sets the font family, e.g. "Monospace".
+func (b *AttrListBuilder) Family(family string, start, end int) *AttrListBuilder {
+	return b.Add(AttrFamilyNew(family), start, end)
+}
+
+// Size sets the font size in points.
+func (b *AttrListBuilder) Size(points float64, start, end int) *AttrListBuilder {
+	return b.Add(AttrSizeNew(int(math.Round(points*pangoScale))), start, end)
+}
+
+// Scale scales the font size by factor, e.g. 1.2 for a larger font.
+func (b *AttrListBuilder) Scale(factor float64, start, end int) *AttrListBuilder {
+	return b.Add(AttrScaleNew(factor), start, end)
+}
+
+// Foreground sets the text color to spec, which is parsed by pango_color_parse, e.g. "#ff0000" or "red".
+// Specs that cannot be parsed are ignored.
+func (b *AttrListBuilder) Foreground(spec string, start, end int) *AttrListBuilder {
+	var c Color
+	if !c.Parse(spec) {
+		return b
+	}
+	return b.Add(AttrForegroundNew(c.Red, c.Green, c.Blue), start, end)
+}
+
+// Background sets the background color to spec, which is parsed by pango_color_parse.
+// Specs that cannot be parsed are ignored.
+func (b *AttrListBuilder) Background(spec string, start, end int) *AttrListBuilder {
+	var c Color
+	if !c.Parse(spec) {
+		return b
+	}
+	return b.Add(AttrBackgroundNew(c.Red, c.Green, c.Blue), start, end)
+}
+
+// LetterSpacing sets extra space between graphemes in pixels.
+func (b *AttrListBuilder) LetterSpacing(pixels float64, start, end int) *AttrListBuilder {
+	return b.Add(AttrLetterSpacingNew(PixelsToUnits(pixels)), start, end)
+}
+
+// Build returns the attribute list, the caller owns the reference.
+func (b *AttrListBuilder) Build() *AttrList {
+	return b.list
+}
+
+func init() {
+	// this file is initialized before the generated files, so the library names are registered here as well
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xMoreLayoutGetSize, libs, "pango_layout_get_size")
+	core.PuregoSafeRegister(&xMoreLayoutGetPixelSize, libs, "pango_layout_get_pixel_size")
+	core.PuregoSafeRegister(&xMoreLayoutGetExtents, libs, "pango_layout_get_extents")
+	core.PuregoSafeRegister(&xMoreLayoutGetPixelExtents, libs, "pango_layout_get_pixel_extents")
+	core.PuregoSafeRegister(&xMoreLayoutIndexToPos, libs, "pango_layout_index_to_pos")
+	core.PuregoSafeRegister(&xMoreLayoutGetCursorPos, libs, "pango_layout_get_cursor_pos")
+	core.PuregoSafeRegister(&xMoreLayoutGetBaseline, libs, "pango_layout_get_baseline")
+}
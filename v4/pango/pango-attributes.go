@@ -2,12 +2,14 @@
 package pango
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -41,12 +43,13 @@ func (x *AttrClass) GoPointer() uintptr {
 // OverrideCopy sets the "copy" callback function.
 // function to duplicate an attribute of this type
 //
-//	(see [method@Pango.Attribute.copy])
+//	(see [Attribute.Copy])
 func (x *AttrClass) OverrideCopy(cb func(*Attribute) *Attribute) {
 	if cb == nil {
 		x.xCopy = 0
 	} else {
-		x.xCopy = purego.NewCallback(func(AttrVarp *Attribute) *Attribute {
+		x.xCopy = core.NewCallback(func(AttrVarp *Attribute) *Attribute {
+			defer core.RecoverPanic()
 			return cb(AttrVarp)
 		})
 	}
@@ -55,13 +58,13 @@ func (x *AttrClass) OverrideCopy(cb func(*Attribute) *Attribute) {
 // GetCopy gets the "copy" callback function.
 // function to duplicate an attribute of this type
 //
-//	(see [method@Pango.Attribute.copy])
+//	(see [Attribute.Copy])
 func (x *AttrClass) GetCopy() func(*Attribute) *Attribute {
 	if x.xCopy == 0 {
 		return nil
 	}
 	var rawCallback func(AttrVarp *Attribute) *Attribute
-	purego.RegisterFunc(&rawCallback, x.xCopy)
+	core.RegisterFunc(&rawCallback, x.xCopy)
 	return func(AttrVar *Attribute) *Attribute {
 		return rawCallback(AttrVar)
 	}
@@ -70,12 +73,13 @@ func (x *AttrClass) GetCopy() func(*Attribute) *Attribute {
 // OverrideDestroy sets the "destroy" callback function.
 // function to free an attribute of this type
 //
-//	(see [method@Pango.Attribute.destroy])
+//	(see [Attribute.Destroy])
 func (x *AttrClass) OverrideDestroy(cb func(*Attribute)) {
 	if cb == nil {
 		x.xDestroy = 0
 	} else {
-		x.xDestroy = purego.NewCallback(func(AttrVarp *Attribute) {
+		x.xDestroy = core.NewCallback(func(AttrVarp *Attribute) {
+			defer core.RecoverPanic()
 			cb(AttrVarp)
 		})
 	}
@@ -84,13 +88,13 @@ func (x *AttrClass) OverrideDestroy(cb func(*Attribute)) {
 // GetDestroy gets the "destroy" callback function.
 // function to free an attribute of this type
 //
-//	(see [method@Pango.Attribute.destroy])
+//	(see [Attribute.Destroy])
 func (x *AttrClass) GetDestroy() func(*Attribute) {
 	if x.xDestroy == 0 {
 		return nil
 	}
 	var rawCallback func(AttrVarp *Attribute)
-	purego.RegisterFunc(&rawCallback, x.xDestroy)
+	core.RegisterFunc(&rawCallback, x.xDestroy)
 	return func(AttrVar *Attribute) {
 		rawCallback(AttrVar)
 	}
@@ -99,12 +103,13 @@ func (x *AttrClass) GetDestroy() func(*Attribute) {
 // OverrideEqual sets the "equal" callback function.
 // function to check two attributes of this type for equality
 //
-//	(see [method@Pango.Attribute.equal])
+//	(see [Attribute.Equal])
 func (x *AttrClass) OverrideEqual(cb func(*Attribute, *Attribute) bool) {
 	if cb == nil {
 		x.xEqual = 0
 	} else {
-		x.xEqual = purego.NewCallback(func(Attr1Varp *Attribute, Attr2Varp *Attribute) bool {
+		x.xEqual = core.NewCallback(func(Attr1Varp *Attribute, Attr2Varp *Attribute) bool {
+			defer core.RecoverPanic()
 			return cb(Attr1Varp, Attr2Varp)
 		})
 	}
@@ -113,13 +118,13 @@ func (x *AttrClass) OverrideEqual(cb func(*Attribute, *Attribute) bool) {
 // GetEqual gets the "equal" callback function.
 // function to check two attributes of this type for equality
 //
-//	(see [method@Pango.Attribute.equal])
+//	(see [Attribute.Equal])
 func (x *AttrClass) GetEqual() func(*Attribute, *Attribute) bool {
 	if x.xEqual == 0 {
 		return nil
 	}
 	var rawCallback func(Attr1Varp *Attribute, Attr2Varp *Attribute) bool
-	purego.RegisterFunc(&rawCallback, x.xEqual)
+	core.RegisterFunc(&rawCallback, x.xEqual)
 	return func(Attr1Var *Attribute, Attr2Var *Attribute) bool {
 		return rawCallback(Attr1Var, Attr2Var)
 	}
@@ -130,7 +135,7 @@ func (x *AttrClass) GetEqual() func(*Attribute, *Attribute) bool {
 type AttrColor struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Color uintptr
 }
@@ -144,7 +149,7 @@ func (x *AttrColor) GoPointer() uintptr {
 type AttrFloat struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Value float64
 }
@@ -158,7 +163,7 @@ func (x *AttrFloat) GoPointer() uintptr {
 type AttrFontDesc struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Desc *FontDescription
 }
@@ -172,7 +177,7 @@ func (x *AttrFontDesc) GoPointer() uintptr {
 type AttrFontFeatures struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Features uintptr
 }
@@ -186,7 +191,7 @@ func (x *AttrFontFeatures) GoPointer() uintptr {
 type AttrInt struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Value int
 }
@@ -197,9 +202,9 @@ func (x *AttrInt) GoPointer() uintptr {
 
 // A `PangoAttrIterator` is used to iterate through a `PangoAttrList`.
 //
-// A new iterator is created with [method@Pango.AttrList.get_iterator].
+// A new iterator is created with [AttrList.GetIterator].
 // Once the iterator is created, it can be advanced through the style
-// changes in the text using [method@Pango.AttrIterator.next]. At each
+// changes in the text using [AttrIterator.Next]. At each
 // style change, the range of the current style segment and the attributes
 // currently in effect can be queried.
 type AttrIterator struct {
@@ -216,6 +221,11 @@ func (x *AttrIterator) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Free releases the AttrIterator with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *AttrIterator) Free() {
+	gobject.BoxedFree(AttrIteratorGLibType(), x.GoPointer())
+}
+
 var xAttrIteratorCopy func(uintptr) *AttrIterator
 
 // Copy a `PangoAttrIterator`.
@@ -284,7 +294,7 @@ var xAttrIteratorRange func(uintptr, *int, *int)
 // Note that the stored return values are signed, not unsigned
 // like the values in `PangoAttribute`. To deal with this API
 // oversight, stored return values that wouldn't fit into
-// a signed integer are clamped to %G_MAXINT.
+// a signed integer are clamped to G_MAXINT.
 func (x *AttrIterator) Range(StartVar *int, EndVar *int) {
 
 	xAttrIteratorRange(x.GoPointer(), StartVar, EndVar)
@@ -296,7 +306,7 @@ func (x *AttrIterator) Range(StartVar *int, EndVar *int) {
 type AttrLanguage struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Value *Language
 }
@@ -310,7 +320,7 @@ func (x *AttrLanguage) GoPointer() uintptr {
 //
 // The attributes in a `PangoAttrList` are, in general, allowed to overlap in
 // an arbitrary fashion. However, if the attributes are manipulated only through
-// [method@Pango.AttrList.change], the overlap between properties will meet
+// [AttrList.Change], the overlap between properties will meet
 // stricter criteria.
 //
 // Since the `PangoAttrList` structure is stored as a linear list, it is not
@@ -340,7 +350,7 @@ func NewAttrList() *AttrList {
 	return cret
 }
 
-var xAttrListChange func(uintptr, *Attribute)
+var xAttrListChange func(uintptr, uintptr)
 
 // Insert the given attribute into the `PangoAttrList`.
 //
@@ -348,21 +358,21 @@ var xAttrListChange func(uintptr, *Attribute)
 // on that segment and be merged with any adjoining
 // attributes that are identical.
 //
-// This function is slower than [method@Pango.AttrList.insert]
+// This function is slower than [AttrList.Insert]
 // for creating an attribute list in order (potentially
 // much slower for large lists). However,
-// [method@Pango.AttrList.insert] is not suitable for
+// [AttrList.Insert] is not suitable for
 // continually changing a set of attributes since it
 // never removes or combines existing attributes.
 func (x *AttrList) Change(AttrVar *Attribute) {
 
-	xAttrListChange(x.GoPointer(), AttrVar)
+	xAttrListChange(x.GoPointer(), gobject.BoxedCopy(AttributeGLibType(), AttrVar.GoPointer()))
 
 }
 
 var xAttrListCopy func(uintptr) *AttrList
 
-// Copy @list and return an identical new list.
+// Copy list and return an identical new list.
 func (x *AttrList) Copy() *AttrList {
 
 	cret := xAttrListCopy(x.GoPointer())
@@ -371,7 +381,7 @@ func (x *AttrList) Copy() *AttrList {
 
 var xAttrListEqual func(uintptr, *AttrList) bool
 
-// Checks whether @list and @other_list contain the same
+// Checks whether list and other_list contain the same
 // attributes and whether those attributes apply to the
 // same ranges.
 //
@@ -386,7 +396,7 @@ func (x *AttrList) Equal(OtherListVar *AttrList) bool {
 var xAttrListFilter func(uintptr, uintptr, uintptr) *AttrList
 
 // Given a `PangoAttrList` and callback function, removes
-// any elements of @list for which @func returns %TRUE and
+// any elements of list for which func returns true and
 // inserts them into a new list.
 func (x *AttrList) Filter(FuncVar *AttrFilterFunc, DataVar uintptr) *AttrList {
 
@@ -397,11 +407,12 @@ func (x *AttrList) Filter(FuncVar *AttrFilterFunc, DataVar uintptr) *AttrList {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *Attribute, arg1 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -411,7 +422,7 @@ func (x *AttrList) Filter(FuncVar *AttrFilterFunc, DataVar uintptr) *AttrList {
 
 var xAttrListGetAttributes func(uintptr) *glib.SList
 
-// Gets a list of all attributes in @list.
+// Gets a list of all attributes in list.
 func (x *AttrList) GetAttributes() *glib.SList {
 
 	cret := xAttrListGetAttributes(x.GoPointer())
@@ -422,34 +433,34 @@ var xAttrListGetIterator func(uintptr) *AttrIterator
 
 // Create a iterator initialized to the beginning of the list.
 //
-// @list must not be modified until this iterator is freed.
+// list must not be modified until this iterator is freed.
 func (x *AttrList) GetIterator() *AttrIterator {
 
 	cret := xAttrListGetIterator(x.GoPointer())
 	return cret
 }
 
-var xAttrListInsert func(uintptr, *Attribute)
+var xAttrListInsert func(uintptr, uintptr)
 
 // Insert the given attribute into the `PangoAttrList`.
 //
 // It will be inserted after all other attributes with a
-// matching @start_index.
+// matching start_index.
 func (x *AttrList) Insert(AttrVar *Attribute) {
 
-	xAttrListInsert(x.GoPointer(), AttrVar)
+	xAttrListInsert(x.GoPointer(), gobject.BoxedCopy(AttributeGLibType(), AttrVar.GoPointer()))
 
 }
 
-var xAttrListInsertBefore func(uintptr, *Attribute)
+var xAttrListInsertBefore func(uintptr, uintptr)
 
 // Insert the given attribute into the `PangoAttrList`.
 //
 // It will be inserted before all other attributes with a
-// matching @start_index.
+// matching start_index.
 func (x *AttrList) InsertBefore(AttrVar *Attribute) {
 
-	xAttrListInsertBefore(x.GoPointer(), AttrVar)
+	xAttrListInsertBefore(x.GoPointer(), gobject.BoxedCopy(AttributeGLibType(), AttrVar.GoPointer()))
 
 }
 
@@ -465,22 +476,22 @@ func (x *AttrList) Ref() *AttrList {
 
 var xAttrListSplice func(uintptr, *AttrList, int, int)
 
-// This function opens up a hole in @list, fills it
+// This function opens up a hole in list, fills it
 // in with attributes from the left, and then merges
-// @other on top of the hole.
+// other on top of the hole.
 //
 // This operation is equivalent to stretching every attribute
-// that applies at position @pos in @list by an amount @len,
-// and then calling [method@Pango.AttrList.change] with a copy
-// of each attribute in @other in sequence (offset in position
-// by @pos, and limited in length to @len).
+// that applies at position pos in list by an amount len,
+// and then calling [AttrList.Change] with a copy
+// of each attribute in other in sequence (offset in position
+// by pos, and limited in length to len).
 //
 // This operation proves useful for, for instance, inserting
 // a pre-edit string in the middle of an edit buffer.
 //
 // For backwards compatibility, the function behaves differently
-// when @len is 0. In this case, the attributes from @other are
-// not imited to @len, and are just overlayed on top of @list.
+// when len is 0. In this case, the attributes from other are
+// not imited to len, and are just overlayed on top of list.
 //
 // This mode is useful for merging two lists of attributes together.
 func (x *AttrList) Splice(OtherVar *AttrList, PosVar int, LenVar int) {
@@ -510,8 +521,8 @@ var xAttrListToString func(uintptr) string
 // - strings as string, optionally quoted
 // - font features as quoted string
 // - PangoLanguage as string
-// - PangoFontDescription as serialized by [method@Pango.FontDescription.to_string], quoted
-// - PangoColor as serialized by [method@Pango.Color.to_string]
+// - PangoFontDescription as serialized by [FontDescription.ToString], quoted
+// - PangoColor as serialized by [Color.ToString]
 //
 // Examples:
 //
@@ -522,7 +533,7 @@ var xAttrListToString func(uintptr) string
 //
 //	weight bold
 //
-// To parse the returned value, use [func@Pango.AttrList.from_string].
+// To parse the returned value, use [AttrListFromString].
 //
 // Note that shape attributes can not be serialized.
 func (x *AttrList) ToString() string {
@@ -546,20 +557,20 @@ func (x *AttrList) Unref() {
 
 var xAttrListUpdate func(uintptr, int, int, int)
 
-// Update indices of attributes in @list for a change in the
+// Update indices of attributes in list for a change in the
 // text they refer to.
 //
-// The change that this function applies is removing @remove
-// bytes at position @pos and inserting @add bytes instead.
+// The change that this function applies is removing remove
+// bytes at position pos and inserting add bytes instead.
 //
-// Attributes that fall entirely in the (@pos, @pos + @remove)
+// Attributes that fall entirely in the (pos, pos + remove)
 // range are removed.
 //
-// Attributes that start or end inside the (@pos, @pos + @remove)
+// Attributes that start or end inside the (pos, pos + remove)
 // range are shortened to reflect the removal.
 //
 // Attributes start and end positions are updated if they are
-// behind @pos + @remove.
+// behind pos + remove.
 func (x *AttrList) Update(PosVar int, RemoveVar int, AddVar int) {
 
 	xAttrListUpdate(x.GoPointer(), PosVar, RemoveVar, AddVar)
@@ -571,7 +582,7 @@ func (x *AttrList) Update(PosVar int, RemoveVar int, AddVar int) {
 type AttrShape struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	InkRect uintptr
 
@@ -593,7 +604,7 @@ func (x *AttrShape) GoPointer() uintptr {
 type AttrSize struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Size int
 
@@ -609,7 +620,7 @@ func (x *AttrSize) GoPointer() uintptr {
 type AttrString struct {
 	_ structs.HostLayout
 
-	Attr uintptr
+	Attr Attribute
 
 	Value uintptr
 }
@@ -624,8 +635,8 @@ func (x *AttrString) GoPointer() uintptr {
 // Particular types of attributes include this structure as their initial
 // portion. The common portion of the attribute holds the range to which
 // the value in the type-specific part of the attribute applies and should
-// be initialized using [method@Pango.Attribute.init]. By default, an attribute
-// will have an all-inclusive range of [0,%G_MAXUINT].
+// be initialized using [Attribute.Init]. By default, an attribute
+// will have an all-inclusive range of [0,G_MAXUINT].
 type Attribute struct {
 	_ structs.HostLayout
 
@@ -646,6 +657,11 @@ func (x *Attribute) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Free releases the Attribute with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Attribute) Free() {
+	gobject.BoxedFree(AttributeGLibType(), x.GoPointer())
+}
+
 var xAttributeAsColor func(uintptr) *AttrColor
 
 // Returns the attribute cast to `PangoAttrColor`.
@@ -778,9 +794,9 @@ func (x *Attribute) Equal(Attr2Var *Attribute) bool {
 
 var xAttributeInit func(uintptr, *AttrClass)
 
-// Initializes @attr's klass to @klass, it's start_index to
-// %PANGO_ATTR_INDEX_FROM_TEXT_BEGINNING and end_index to
-// %PANGO_ATTR_INDEX_TO_TEXT_END such that the attribute applies
+// Initializes attr's klass to klass, it's start_index to
+// [ATTR_INDEX_FROM_TEXT_BEGINNING] and end_index to
+// [ATTR_INDEX_TO_TEXT_END] such that the attribute applies
 // to the entire text by default.
 func (x *Attribute) Init(KlassVar *AttrClass) {
 
@@ -789,17 +805,17 @@ func (x *Attribute) Init(KlassVar *AttrClass) {
 }
 
 const (
-	// Value for @start_index in `PangoAttribute` that indicates
+	// Value for start_index in `PangoAttribute` that indicates
 	// the beginning of the text.
 	ATTR_INDEX_FROM_TEXT_BEGINNING uint = 0
-	// Value for @end_index in `PangoAttribute` that indicates
+	// Value for end_index in `PangoAttribute` that indicates
 	// the end of the text.
 	ATTR_INDEX_TO_TEXT_END uint = 4294967295
 )
 
 // These flags affect how Pango treats characters that are normally
 // not visible in the output.
-type ShowFlags int
+type ShowFlags uint32
 
 var xShowFlagsGLibType func() types.GType
 
@@ -820,10 +836,55 @@ const (
 	ShowIgnorablesValue ShowFlags = 4
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ShowFlags) GLibType() types.GType {
+	return xShowFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ShowFlags) Has(flags ShowFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ShowFlags) Set(flags ShowFlags) ShowFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ShowFlags) Clear(flags ShowFlags) ShowFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ShowFlags) String() string {
+	if x == 0 {
+		return "PANGO_SHOW_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&ShowSpacesValue != 0 && x&ShowSpacesValue == ShowSpacesValue {
+		names = append(names, "PANGO_SHOW_SPACES")
+		rest &^= ShowSpacesValue
+	}
+	if rest&ShowLineBreaksValue != 0 && x&ShowLineBreaksValue == ShowLineBreaksValue {
+		names = append(names, "PANGO_SHOW_LINE_BREAKS")
+		rest &^= ShowLineBreaksValue
+	}
+	if rest&ShowIgnorablesValue != 0 && x&ShowIgnorablesValue == ShowIgnorablesValue {
+		names = append(names, "PANGO_SHOW_IGNORABLES")
+		rest &^= ShowIgnorablesValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // The `PangoAttrType` distinguishes between different types of attributes.
 //
 // Along with the predefined values, it is possible to allocate additional
-// values for custom attributes using [func@AttrType.register]. The predefined
+// values for custom attributes using register. The predefined
 // values are given below. The type of structure used to store the attribute is
 // listed in parentheses after the description.
 type AttrType int
@@ -838,82 +899,331 @@ const (
 
 	// does not happen
 	AttrInvalidValue AttrType = 0
-	// language ([struct@Pango.AttrLanguage])
+	// language ([AttrLanguage])
 	AttrLanguageValue AttrType = 1
-	// font family name list ([struct@Pango.AttrString])
+	// font family name list ([AttrString])
 	AttrFamilyValue AttrType = 2
-	// font slant style ([struct@Pango.AttrInt])
+	// font slant style ([AttrInt])
 	AttrStyleValue AttrType = 3
-	// font weight ([struct@Pango.AttrInt])
+	// font weight ([AttrInt])
 	AttrWeightValue AttrType = 4
-	// font variant (normal or small caps) ([struct@Pango.AttrInt])
+	// font variant (normal or small caps) ([AttrInt])
 	AttrVariantValue AttrType = 5
-	// font stretch ([struct@Pango.AttrInt])
+	// font stretch ([AttrInt])
 	AttrStretchValue AttrType = 6
-	// font size in points scaled by %PANGO_SCALE ([struct@Pango.AttrInt])
+	// font size in points scaled by [SCALE] ([AttrInt])
 	AttrSizeValue AttrType = 7
-	// font description ([struct@Pango.AttrFontDesc])
+	// font description ([AttrFontDesc])
 	AttrFontDescValue AttrType = 8
-	// foreground color ([struct@Pango.AttrColor])
+	// foreground color ([AttrColor])
 	AttrForegroundValue AttrType = 9
-	// background color ([struct@Pango.AttrColor])
+	// background color ([AttrColor])
 	AttrBackgroundValue AttrType = 10
-	// whether the text has an underline ([struct@Pango.AttrInt])
+	// whether the text has an underline ([AttrInt])
 	AttrUnderlineValue AttrType = 11
-	// whether the text is struck-through ([struct@Pango.AttrInt])
+	// whether the text is struck-through ([AttrInt])
 	AttrStrikethroughValue AttrType = 12
-	// baseline displacement ([struct@Pango.AttrInt])
+	// baseline displacement ([AttrInt])
 	AttrRiseValue AttrType = 13
-	// shape ([struct@Pango.AttrShape])
+	// shape ([AttrShape])
 	AttrShapeValue AttrType = 14
-	// font size scale factor ([struct@Pango.AttrFloat])
+	// font size scale factor ([AttrFloat])
 	AttrScaleValue AttrType = 15
-	// whether fallback is enabled ([struct@Pango.AttrInt])
+	// whether fallback is enabled ([AttrInt])
 	AttrFallbackValue AttrType = 16
-	// letter spacing ([struct@PangoAttrInt])
+	// letter spacing (PangoAttrInt)
 	AttrLetterSpacingValue AttrType = 17
-	// underline color ([struct@Pango.AttrColor])
+	// underline color ([AttrColor])
 	AttrUnderlineColorValue AttrType = 18
-	// strikethrough color ([struct@Pango.AttrColor])
+	// strikethrough color ([AttrColor])
 	AttrStrikethroughColorValue AttrType = 19
-	// font size in pixels scaled by %PANGO_SCALE ([struct@Pango.AttrInt])
+	// font size in pixels scaled by [SCALE] ([AttrInt])
 	AttrAbsoluteSizeValue AttrType = 20
-	// base text gravity ([struct@Pango.AttrInt])
+	// base text gravity ([AttrInt])
 	AttrGravityValue AttrType = 21
-	// gravity hint ([struct@Pango.AttrInt])
+	// gravity hint ([AttrInt])
 	AttrGravityHintValue AttrType = 22
-	// OpenType font features ([struct@Pango.AttrFontFeatures]). Since 1.38
+	// OpenType font features ([AttrFontFeatures]). Since 1.38
 	AttrFontFeaturesValue AttrType = 23
-	// foreground alpha ([struct@Pango.AttrInt]). Since 1.38
+	// foreground alpha ([AttrInt]). Since 1.38
 	AttrForegroundAlphaValue AttrType = 24
-	// background alpha ([struct@Pango.AttrInt]). Since 1.38
+	// background alpha ([AttrInt]). Since 1.38
 	AttrBackgroundAlphaValue AttrType = 25
-	// whether breaks are allowed ([struct@Pango.AttrInt]). Since 1.44
+	// whether breaks are allowed ([AttrInt]). Since 1.44
 	AttrAllowBreaksValue AttrType = 26
-	// how to render invisible characters ([struct@Pango.AttrInt]). Since 1.44
+	// how to render invisible characters ([AttrInt]). Since 1.44
 	AttrShowValue AttrType = 27
-	// whether to insert hyphens at intra-word line breaks ([struct@Pango.AttrInt]). Since 1.44
+	// whether to insert hyphens at intra-word line breaks ([AttrInt]). Since 1.44
 	AttrInsertHyphensValue AttrType = 28
-	// whether the text has an overline ([struct@Pango.AttrInt]). Since 1.46
+	// whether the text has an overline ([AttrInt]). Since 1.46
 	AttrOverlineValue AttrType = 29
-	// overline color ([struct@Pango.AttrColor]). Since 1.46
+	// overline color ([AttrColor]). Since 1.46
 	AttrOverlineColorValue AttrType = 30
-	// line height factor ([struct@Pango.AttrFloat]). Since: 1.50
+	// line height factor ([AttrFloat]). Since: 1.50
 	AttrLineHeightValue AttrType = 31
-	// line height ([struct@Pango.AttrInt]). Since: 1.50
+	// line height ([AttrInt]). Since: 1.50
 	AttrAbsoluteLineHeightValue AttrType = 32
 
 	AttrTextTransformValue AttrType = 33
-	// override segmentation to classify the range of the attribute as a single word ([struct@Pango.AttrInt]). Since 1.50
+	// override segmentation to classify the range of the attribute as a single word ([AttrInt]). Since 1.50
 	AttrWordValue AttrType = 34
-	// override segmentation to classify the range of the attribute as a single sentence ([struct@Pango.AttrInt]). Since 1.50
+	// override segmentation to classify the range of the attribute as a single sentence ([AttrInt]). Since 1.50
 	AttrSentenceValue AttrType = 35
-	// baseline displacement ([struct@Pango.AttrInt]). Since 1.50
+	// baseline displacement ([AttrInt]). Since 1.50
 	AttrBaselineShiftValue AttrType = 36
-	// font-relative size change ([struct@Pango.AttrInt]). Since 1.50
+	// font-relative size change ([AttrInt]). Since 1.50
 	AttrFontScaleValue AttrType = 37
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x AttrType) String() string {
+	switch x {
+	case AttrInvalidValue:
+		return "PANGO_ATTR_INVALID"
+	case AttrLanguageValue:
+		return "PANGO_ATTR_LANGUAGE"
+	case AttrFamilyValue:
+		return "PANGO_ATTR_FAMILY"
+	case AttrStyleValue:
+		return "PANGO_ATTR_STYLE"
+	case AttrWeightValue:
+		return "PANGO_ATTR_WEIGHT"
+	case AttrVariantValue:
+		return "PANGO_ATTR_VARIANT"
+	case AttrStretchValue:
+		return "PANGO_ATTR_STRETCH"
+	case AttrSizeValue:
+		return "PANGO_ATTR_SIZE"
+	case AttrFontDescValue:
+		return "PANGO_ATTR_FONT_DESC"
+	case AttrForegroundValue:
+		return "PANGO_ATTR_FOREGROUND"
+	case AttrBackgroundValue:
+		return "PANGO_ATTR_BACKGROUND"
+	case AttrUnderlineValue:
+		return "PANGO_ATTR_UNDERLINE"
+	case AttrStrikethroughValue:
+		return "PANGO_ATTR_STRIKETHROUGH"
+	case AttrRiseValue:
+		return "PANGO_ATTR_RISE"
+	case AttrShapeValue:
+		return "PANGO_ATTR_SHAPE"
+	case AttrScaleValue:
+		return "PANGO_ATTR_SCALE"
+	case AttrFallbackValue:
+		return "PANGO_ATTR_FALLBACK"
+	case AttrLetterSpacingValue:
+		return "PANGO_ATTR_LETTER_SPACING"
+	case AttrUnderlineColorValue:
+		return "PANGO_ATTR_UNDERLINE_COLOR"
+	case AttrStrikethroughColorValue:
+		return "PANGO_ATTR_STRIKETHROUGH_COLOR"
+	case AttrAbsoluteSizeValue:
+		return "PANGO_ATTR_ABSOLUTE_SIZE"
+	case AttrGravityValue:
+		return "PANGO_ATTR_GRAVITY"
+	case AttrGravityHintValue:
+		return "PANGO_ATTR_GRAVITY_HINT"
+	case AttrFontFeaturesValue:
+		return "PANGO_ATTR_FONT_FEATURES"
+	case AttrForegroundAlphaValue:
+		return "PANGO_ATTR_FOREGROUND_ALPHA"
+	case AttrBackgroundAlphaValue:
+		return "PANGO_ATTR_BACKGROUND_ALPHA"
+	case AttrAllowBreaksValue:
+		return "PANGO_ATTR_ALLOW_BREAKS"
+	case AttrShowValue:
+		return "PANGO_ATTR_SHOW"
+	case AttrInsertHyphensValue:
+		return "PANGO_ATTR_INSERT_HYPHENS"
+	case AttrOverlineValue:
+		return "PANGO_ATTR_OVERLINE"
+	case AttrOverlineColorValue:
+		return "PANGO_ATTR_OVERLINE_COLOR"
+	case AttrLineHeightValue:
+		return "PANGO_ATTR_LINE_HEIGHT"
+	case AttrAbsoluteLineHeightValue:
+		return "PANGO_ATTR_ABSOLUTE_LINE_HEIGHT"
+	case AttrTextTransformValue:
+		return "PANGO_ATTR_TEXT_TRANSFORM"
+	case AttrWordValue:
+		return "PANGO_ATTR_WORD"
+	case AttrSentenceValue:
+		return "PANGO_ATTR_SENTENCE"
+	case AttrBaselineShiftValue:
+		return "PANGO_ATTR_BASELINE_SHIFT"
+	case AttrFontScaleValue:
+		return "PANGO_ATTR_FONT_SCALE"
+	}
+	return fmt.Sprintf("AttrType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x AttrType) Nick() string {
+	switch x {
+	case AttrInvalidValue:
+		return "invalid"
+	case AttrLanguageValue:
+		return "language"
+	case AttrFamilyValue:
+		return "family"
+	case AttrStyleValue:
+		return "style"
+	case AttrWeightValue:
+		return "weight"
+	case AttrVariantValue:
+		return "variant"
+	case AttrStretchValue:
+		return "stretch"
+	case AttrSizeValue:
+		return "size"
+	case AttrFontDescValue:
+		return "font-desc"
+	case AttrForegroundValue:
+		return "foreground"
+	case AttrBackgroundValue:
+		return "background"
+	case AttrUnderlineValue:
+		return "underline"
+	case AttrStrikethroughValue:
+		return "strikethrough"
+	case AttrRiseValue:
+		return "rise"
+	case AttrShapeValue:
+		return "shape"
+	case AttrScaleValue:
+		return "scale"
+	case AttrFallbackValue:
+		return "fallback"
+	case AttrLetterSpacingValue:
+		return "letter-spacing"
+	case AttrUnderlineColorValue:
+		return "underline-color"
+	case AttrStrikethroughColorValue:
+		return "strikethrough-color"
+	case AttrAbsoluteSizeValue:
+		return "absolute-size"
+	case AttrGravityValue:
+		return "gravity"
+	case AttrGravityHintValue:
+		return "gravity-hint"
+	case AttrFontFeaturesValue:
+		return "font-features"
+	case AttrForegroundAlphaValue:
+		return "foreground-alpha"
+	case AttrBackgroundAlphaValue:
+		return "background-alpha"
+	case AttrAllowBreaksValue:
+		return "allow-breaks"
+	case AttrShowValue:
+		return "show"
+	case AttrInsertHyphensValue:
+		return "insert-hyphens"
+	case AttrOverlineValue:
+		return "overline"
+	case AttrOverlineColorValue:
+		return "overline-color"
+	case AttrLineHeightValue:
+		return "line-height"
+	case AttrAbsoluteLineHeightValue:
+		return "absolute-line-height"
+	case AttrTextTransformValue:
+		return "text-transform"
+	case AttrWordValue:
+		return "word"
+	case AttrSentenceValue:
+		return "sentence"
+	case AttrBaselineShiftValue:
+		return "baseline-shift"
+	case AttrFontScaleValue:
+		return "font-scale"
+	}
+	return ""
+}
+
+// AttrTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AttrTypeFromString(s string) (AttrType, bool) {
+	switch s {
+	case "PANGO_ATTR_INVALID", "invalid":
+		return AttrInvalidValue, true
+	case "PANGO_ATTR_LANGUAGE", "language":
+		return AttrLanguageValue, true
+	case "PANGO_ATTR_FAMILY", "family":
+		return AttrFamilyValue, true
+	case "PANGO_ATTR_STYLE", "style":
+		return AttrStyleValue, true
+	case "PANGO_ATTR_WEIGHT", "weight":
+		return AttrWeightValue, true
+	case "PANGO_ATTR_VARIANT", "variant":
+		return AttrVariantValue, true
+	case "PANGO_ATTR_STRETCH", "stretch":
+		return AttrStretchValue, true
+	case "PANGO_ATTR_SIZE", "size":
+		return AttrSizeValue, true
+	case "PANGO_ATTR_FONT_DESC", "font-desc":
+		return AttrFontDescValue, true
+	case "PANGO_ATTR_FOREGROUND", "foreground":
+		return AttrForegroundValue, true
+	case "PANGO_ATTR_BACKGROUND", "background":
+		return AttrBackgroundValue, true
+	case "PANGO_ATTR_UNDERLINE", "underline":
+		return AttrUnderlineValue, true
+	case "PANGO_ATTR_STRIKETHROUGH", "strikethrough":
+		return AttrStrikethroughValue, true
+	case "PANGO_ATTR_RISE", "rise":
+		return AttrRiseValue, true
+	case "PANGO_ATTR_SHAPE", "shape":
+		return AttrShapeValue, true
+	case "PANGO_ATTR_SCALE", "scale":
+		return AttrScaleValue, true
+	case "PANGO_ATTR_FALLBACK", "fallback":
+		return AttrFallbackValue, true
+	case "PANGO_ATTR_LETTER_SPACING", "letter-spacing":
+		return AttrLetterSpacingValue, true
+	case "PANGO_ATTR_UNDERLINE_COLOR", "underline-color":
+		return AttrUnderlineColorValue, true
+	case "PANGO_ATTR_STRIKETHROUGH_COLOR", "strikethrough-color":
+		return AttrStrikethroughColorValue, true
+	case "PANGO_ATTR_ABSOLUTE_SIZE", "absolute-size":
+		return AttrAbsoluteSizeValue, true
+	case "PANGO_ATTR_GRAVITY", "gravity":
+		return AttrGravityValue, true
+	case "PANGO_ATTR_GRAVITY_HINT", "gravity-hint":
+		return AttrGravityHintValue, true
+	case "PANGO_ATTR_FONT_FEATURES", "font-features":
+		return AttrFontFeaturesValue, true
+	case "PANGO_ATTR_FOREGROUND_ALPHA", "foreground-alpha":
+		return AttrForegroundAlphaValue, true
+	case "PANGO_ATTR_BACKGROUND_ALPHA", "background-alpha":
+		return AttrBackgroundAlphaValue, true
+	case "PANGO_ATTR_ALLOW_BREAKS", "allow-breaks":
+		return AttrAllowBreaksValue, true
+	case "PANGO_ATTR_SHOW", "show":
+		return AttrShowValue, true
+	case "PANGO_ATTR_INSERT_HYPHENS", "insert-hyphens":
+		return AttrInsertHyphensValue, true
+	case "PANGO_ATTR_OVERLINE", "overline":
+		return AttrOverlineValue, true
+	case "PANGO_ATTR_OVERLINE_COLOR", "overline-color":
+		return AttrOverlineColorValue, true
+	case "PANGO_ATTR_LINE_HEIGHT", "line-height":
+		return AttrLineHeightValue, true
+	case "PANGO_ATTR_ABSOLUTE_LINE_HEIGHT", "absolute-line-height":
+		return AttrAbsoluteLineHeightValue, true
+	case "PANGO_ATTR_TEXT_TRANSFORM", "text-transform":
+		return AttrTextTransformValue, true
+	case "PANGO_ATTR_WORD", "word":
+		return AttrWordValue, true
+	case "PANGO_ATTR_SENTENCE", "sentence":
+		return AttrSentenceValue, true
+	case "PANGO_ATTR_BASELINE_SHIFT", "baseline-shift":
+		return AttrBaselineShiftValue, true
+	case "PANGO_ATTR_FONT_SCALE", "font-scale":
+		return AttrFontScaleValue, true
+	}
+	return 0, false
+}
+
 // An enumeration that affects baseline shifts between runs.
 type BaselineShift int
 
@@ -935,6 +1245,45 @@ const (
 	BaselineShiftSubscriptValue BaselineShift = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x BaselineShift) String() string {
+	switch x {
+	case BaselineShiftNoneValue:
+		return "PANGO_BASELINE_SHIFT_NONE"
+	case BaselineShiftSuperscriptValue:
+		return "PANGO_BASELINE_SHIFT_SUPERSCRIPT"
+	case BaselineShiftSubscriptValue:
+		return "PANGO_BASELINE_SHIFT_SUBSCRIPT"
+	}
+	return fmt.Sprintf("BaselineShift(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BaselineShift) Nick() string {
+	switch x {
+	case BaselineShiftNoneValue:
+		return "none"
+	case BaselineShiftSuperscriptValue:
+		return "superscript"
+	case BaselineShiftSubscriptValue:
+		return "subscript"
+	}
+	return ""
+}
+
+// BaselineShiftFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BaselineShiftFromString(s string) (BaselineShift, bool) {
+	switch s {
+	case "PANGO_BASELINE_SHIFT_NONE", "none":
+		return BaselineShiftNoneValue, true
+	case "PANGO_BASELINE_SHIFT_SUPERSCRIPT", "superscript":
+		return BaselineShiftSuperscriptValue, true
+	case "PANGO_BASELINE_SHIFT_SUBSCRIPT", "subscript":
+		return BaselineShiftSubscriptValue, true
+	}
+	return 0, false
+}
+
 // An enumeration that affects font sizes for superscript
 // and subscript positioning and for (emulated) Small Caps.
 type FontScale int
@@ -957,6 +1306,51 @@ const (
 	FontScaleSmallCapsValue FontScale = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontScale) String() string {
+	switch x {
+	case FontScaleNoneValue:
+		return "PANGO_FONT_SCALE_NONE"
+	case FontScaleSuperscriptValue:
+		return "PANGO_FONT_SCALE_SUPERSCRIPT"
+	case FontScaleSubscriptValue:
+		return "PANGO_FONT_SCALE_SUBSCRIPT"
+	case FontScaleSmallCapsValue:
+		return "PANGO_FONT_SCALE_SMALL_CAPS"
+	}
+	return fmt.Sprintf("FontScale(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontScale) Nick() string {
+	switch x {
+	case FontScaleNoneValue:
+		return "none"
+	case FontScaleSuperscriptValue:
+		return "superscript"
+	case FontScaleSubscriptValue:
+		return "subscript"
+	case FontScaleSmallCapsValue:
+		return "small-caps"
+	}
+	return ""
+}
+
+// FontScaleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontScaleFromString(s string) (FontScale, bool) {
+	switch s {
+	case "PANGO_FONT_SCALE_NONE", "none":
+		return FontScaleNoneValue, true
+	case "PANGO_FONT_SCALE_SUPERSCRIPT", "superscript":
+		return FontScaleSuperscriptValue, true
+	case "PANGO_FONT_SCALE_SUBSCRIPT", "subscript":
+		return FontScaleSubscriptValue, true
+	case "PANGO_FONT_SCALE_SMALL_CAPS", "small-caps":
+		return FontScaleSmallCapsValue, true
+	}
+	return 0, false
+}
+
 // The `PangoOverline` enumeration is used to specify whether text
 // should be overlined, and if so, the type of line.
 type Overline int
@@ -976,6 +1370,39 @@ const (
 	OverlineSingleValue Overline = 1
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Overline) String() string {
+	switch x {
+	case OverlineNoneValue:
+		return "PANGO_OVERLINE_NONE"
+	case OverlineSingleValue:
+		return "PANGO_OVERLINE_SINGLE"
+	}
+	return fmt.Sprintf("Overline(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Overline) Nick() string {
+	switch x {
+	case OverlineNoneValue:
+		return "none"
+	case OverlineSingleValue:
+		return "single"
+	}
+	return ""
+}
+
+// OverlineFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func OverlineFromString(s string) (Overline, bool) {
+	switch s {
+	case "PANGO_OVERLINE_NONE", "none":
+		return OverlineNoneValue, true
+	case "PANGO_OVERLINE_SINGLE", "single":
+		return OverlineSingleValue, true
+	}
+	return 0, false
+}
+
 // An enumeration that affects how Pango treats characters during shaping.
 type TextTransform int
 
@@ -998,6 +1425,51 @@ const (
 	TextTransformCapitalizeValue TextTransform = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TextTransform) String() string {
+	switch x {
+	case TextTransformNoneValue:
+		return "PANGO_TEXT_TRANSFORM_NONE"
+	case TextTransformLowercaseValue:
+		return "PANGO_TEXT_TRANSFORM_LOWERCASE"
+	case TextTransformUppercaseValue:
+		return "PANGO_TEXT_TRANSFORM_UPPERCASE"
+	case TextTransformCapitalizeValue:
+		return "PANGO_TEXT_TRANSFORM_CAPITALIZE"
+	}
+	return fmt.Sprintf("TextTransform(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TextTransform) Nick() string {
+	switch x {
+	case TextTransformNoneValue:
+		return "none"
+	case TextTransformLowercaseValue:
+		return "lowercase"
+	case TextTransformUppercaseValue:
+		return "uppercase"
+	case TextTransformCapitalizeValue:
+		return "capitalize"
+	}
+	return ""
+}
+
+// TextTransformFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TextTransformFromString(s string) (TextTransform, bool) {
+	switch s {
+	case "PANGO_TEXT_TRANSFORM_NONE", "none":
+		return TextTransformNoneValue, true
+	case "PANGO_TEXT_TRANSFORM_LOWERCASE", "lowercase":
+		return TextTransformLowercaseValue, true
+	case "PANGO_TEXT_TRANSFORM_UPPERCASE", "uppercase":
+		return TextTransformUppercaseValue, true
+	case "PANGO_TEXT_TRANSFORM_CAPITALIZE", "capitalize":
+		return TextTransformCapitalizeValue, true
+	}
+	return 0, false
+}
+
 // The `PangoUnderline` enumeration is used to specify whether text
 // should be underlined, and if so, the type of underlining.
 type Underline int
@@ -1020,7 +1492,7 @@ const (
 	//   position beneath the ink extents of the text being
 	//   underlined. This should be used only for underlining
 	//   single characters, such as for keyboard accelerators.
-	//   %PANGO_UNDERLINE_SINGLE should be used for extended
+	//   [UnderlineSingleValue] should be used for extended
 	//   portions of text.
 	UnderlineLowValue Underline = 3
 	// an underline indicating an error should
@@ -1046,6 +1518,75 @@ const (
 	UnderlineErrorLineValue Underline = 7
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Underline) String() string {
+	switch x {
+	case UnderlineNoneValue:
+		return "PANGO_UNDERLINE_NONE"
+	case UnderlineSingleValue:
+		return "PANGO_UNDERLINE_SINGLE"
+	case UnderlineDoubleValue:
+		return "PANGO_UNDERLINE_DOUBLE"
+	case UnderlineLowValue:
+		return "PANGO_UNDERLINE_LOW"
+	case UnderlineErrorValue:
+		return "PANGO_UNDERLINE_ERROR"
+	case UnderlineSingleLineValue:
+		return "PANGO_UNDERLINE_SINGLE_LINE"
+	case UnderlineDoubleLineValue:
+		return "PANGO_UNDERLINE_DOUBLE_LINE"
+	case UnderlineErrorLineValue:
+		return "PANGO_UNDERLINE_ERROR_LINE"
+	}
+	return fmt.Sprintf("Underline(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Underline) Nick() string {
+	switch x {
+	case UnderlineNoneValue:
+		return "none"
+	case UnderlineSingleValue:
+		return "single"
+	case UnderlineDoubleValue:
+		return "double"
+	case UnderlineLowValue:
+		return "low"
+	case UnderlineErrorValue:
+		return "error"
+	case UnderlineSingleLineValue:
+		return "single-line"
+	case UnderlineDoubleLineValue:
+		return "double-line"
+	case UnderlineErrorLineValue:
+		return "error-line"
+	}
+	return ""
+}
+
+// UnderlineFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UnderlineFromString(s string) (Underline, bool) {
+	switch s {
+	case "PANGO_UNDERLINE_NONE", "none":
+		return UnderlineNoneValue, true
+	case "PANGO_UNDERLINE_SINGLE", "single":
+		return UnderlineSingleValue, true
+	case "PANGO_UNDERLINE_DOUBLE", "double":
+		return UnderlineDoubleValue, true
+	case "PANGO_UNDERLINE_LOW", "low":
+		return UnderlineLowValue, true
+	case "PANGO_UNDERLINE_ERROR", "error":
+		return UnderlineErrorValue, true
+	case "PANGO_UNDERLINE_SINGLE_LINE", "single-line":
+		return UnderlineSingleLineValue, true
+	case "PANGO_UNDERLINE_DOUBLE_LINE", "double-line":
+		return UnderlineDoubleLineValue, true
+	case "PANGO_UNDERLINE_ERROR_LINE", "error-line":
+		return UnderlineErrorLineValue, true
+	}
+	return 0, false
+}
+
 var xAttrAllowBreaksNew func(bool) *Attribute
 
 // Create a new allow-breaks attribute.
@@ -1082,13 +1623,6 @@ var xAttrBaselineShiftNew func(int) *Attribute
 //
 // The effect of this attribute is to shift the baseline of a run,
 // relative to the run of preceding run.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="baseline-shift-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Baseline Shift" src="baseline-shift-light.png"&gt;
-//
-// &lt;/picture&gt;
 func AttrBaselineShiftNew(ShiftVar int) *Attribute {
 
 	cret := xAttrBaselineShiftNew(ShiftVar)
@@ -1226,9 +1760,9 @@ var xAttrLineHeightNew func(float64) *Attribute
 // Modify the height of logical line extents by a factor.
 //
 // This affects the values returned by
-// [method@Pango.LayoutLine.get_extents],
-// [method@Pango.LayoutLine.get_pixel_extents] and
-// [method@Pango.LayoutIter.get_line_extents].
+// [LayoutLine.GetExtents],
+// [LayoutLine.GetPixelExtents] and
+// [LayoutIter.GetLineExtents].
 func AttrLineHeightNew(FactorVar float64) *Attribute {
 
 	cret := xAttrLineHeightNew(FactorVar)
@@ -1237,12 +1771,12 @@ func AttrLineHeightNew(FactorVar float64) *Attribute {
 
 var xAttrLineHeightNewAbsolute func(int) *Attribute
 
-// Override the height of logical line extents to be @height.
+// Override the height of logical line extents to be height.
 //
 // This affects the values returned by
-// [method@Pango.LayoutLine.get_extents],
-// [method@Pango.LayoutLine.get_pixel_extents] and
-// [method@Pango.LayoutIter.get_line_extents].
+// [LayoutLine.GetExtents],
+// [LayoutLine.GetPixelExtents] and
+// [LayoutIter.GetLineExtents].
 func AttrLineHeightNewAbsolute(HeightVar int) *Attribute {
 
 	cret := xAttrLineHeightNewAbsolute(HeightVar)
@@ -1253,7 +1787,7 @@ var xAttrListFromString func(string) *AttrList
 
 // Deserializes a `PangoAttrList` from a string.
 //
-// This is the counterpart to [method@Pango.AttrList.to_string].
+// This is the counterpart to [AttrList.ToString].
 // See that functions for details about the format.
 func AttrListFromString(TextVar string) *AttrList {
 
@@ -1296,7 +1830,7 @@ var xAttrScaleNew func(float64) *Attribute
 // Create a new font size scale attribute.
 //
 // The base font for the affected text will have
-// its size multiplied by @scale_factor.
+// its size multiplied by scale_factor.
 func AttrScaleNew(ScaleFactorVar float64) *Attribute {
 
 	cret := xAttrScaleNew(ScaleFactorVar)
@@ -1333,7 +1867,7 @@ var xAttrShapeNewWithData func(*Rectangle, *Rectangle, uintptr, uintptr, uintptr
 
 // Creates a new shape attribute.
 //
-// Like [func@Pango.AttrShape.new], but a user data pointer
+// Like [AttrShapeNew], but a user data pointer
 // is also provided; this pointer can be accessed when later
 // rendering the glyph.
 func AttrShapeNewWithData(InkRectVar *Rectangle, LogicalRectVar *Rectangle, DataVar uintptr, CopyFuncVar *AttrDataCopyFunc, DestroyFuncVar *glib.DestroyNotify) *Attribute {
@@ -1345,11 +1879,12 @@ func AttrShapeNewWithData(InkRectVar *Rectangle, LogicalRectVar *Rectangle, Data
 			CopyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) uintptr {
+				defer core.RecoverPanic()
 				cbFn := *CopyFuncVar
 				return cbFn(arg0)
 			}
-			CopyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
+			CopyFuncVarRef = core.NewCallback(fcb)
+			CopyFuncVarRef = glib.SaveCallbackWithClosure(CopyFuncVarPtr, CopyFuncVarRef, CopyFuncVar)
 		}
 	}
 
@@ -1360,11 +1895,12 @@ func AttrShapeNewWithData(InkRectVar *Rectangle, LogicalRectVar *Rectangle, Data
 			DestroyFuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DestroyFuncVar
 				cbFn(arg0)
 			}
-			DestroyFuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
+			DestroyFuncVarRef = core.NewCallback(fcb)
+			DestroyFuncVarRef = glib.SaveCallbackWithClosure(DestroyFuncVarPtr, DestroyFuncVarRef, DestroyFuncVar)
 		}
 	}
 
@@ -1455,10 +1991,10 @@ var xAttrTypeGetName func(AttrType) string
 //
 // The attribute type name is the string passed in
 // when registering the type using
-// [func@Pango.AttrType.register].
+// [AttrTypeRegister].
 //
 // The returned value is an interned string (see
-// g_intern_string() for what that means) that should
+// [glib.InternString] for what that means) that should
 // not be modified or freed.
 func AttrTypeGetName(TypeVar AttrType) string {
 
@@ -1471,7 +2007,7 @@ var xAttrTypeRegister func(string) AttrType
 // Allocate a new attribute type ID.
 //
 // The attribute type name can be accessed later
-// by using [func@Pango.AttrType.get_name].
+// by using [AttrTypeGetName].
 func AttrTypeRegister(NameVar string) AttrType {
 
 	cret := xAttrTypeRegister(NameVar)
@@ -1534,7 +2070,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1555,90 +2091,90 @@ func init() {
 
 	core.PuregoSafeRegister(&xUnderlineGLibType, libs, "pango_underline_get_type")
 
-	core.PuregoSafeRegister(&xAttrAllowBreaksNew, libs, "pango_attr_allow_breaks_new")
-	core.PuregoSafeRegister(&xAttrBackgroundAlphaNew, libs, "pango_attr_background_alpha_new")
-	core.PuregoSafeRegister(&xAttrBackgroundNew, libs, "pango_attr_background_new")
-	core.PuregoSafeRegister(&xAttrBaselineShiftNew, libs, "pango_attr_baseline_shift_new")
-	core.PuregoSafeRegister(&xAttrFallbackNew, libs, "pango_attr_fallback_new")
-	core.PuregoSafeRegister(&xAttrFamilyNew, libs, "pango_attr_family_new")
-	core.PuregoSafeRegister(&xAttrFontDescNew, libs, "pango_attr_font_desc_new")
-	core.PuregoSafeRegister(&xAttrFontFeaturesNew, libs, "pango_attr_font_features_new")
-	core.PuregoSafeRegister(&xAttrFontScaleNew, libs, "pango_attr_font_scale_new")
-	core.PuregoSafeRegister(&xAttrForegroundAlphaNew, libs, "pango_attr_foreground_alpha_new")
-	core.PuregoSafeRegister(&xAttrForegroundNew, libs, "pango_attr_foreground_new")
-	core.PuregoSafeRegister(&xAttrGravityHintNew, libs, "pango_attr_gravity_hint_new")
-	core.PuregoSafeRegister(&xAttrGravityNew, libs, "pango_attr_gravity_new")
-	core.PuregoSafeRegister(&xAttrInsertHyphensNew, libs, "pango_attr_insert_hyphens_new")
-	core.PuregoSafeRegister(&xAttrLanguageNew, libs, "pango_attr_language_new")
-	core.PuregoSafeRegister(&xAttrLetterSpacingNew, libs, "pango_attr_letter_spacing_new")
-	core.PuregoSafeRegister(&xAttrLineHeightNew, libs, "pango_attr_line_height_new")
-	core.PuregoSafeRegister(&xAttrLineHeightNewAbsolute, libs, "pango_attr_line_height_new_absolute")
-	core.PuregoSafeRegister(&xAttrListFromString, libs, "pango_attr_list_from_string")
-	core.PuregoSafeRegister(&xAttrOverlineColorNew, libs, "pango_attr_overline_color_new")
-	core.PuregoSafeRegister(&xAttrOverlineNew, libs, "pango_attr_overline_new")
-	core.PuregoSafeRegister(&xAttrRiseNew, libs, "pango_attr_rise_new")
-	core.PuregoSafeRegister(&xAttrScaleNew, libs, "pango_attr_scale_new")
-	core.PuregoSafeRegister(&xAttrSentenceNew, libs, "pango_attr_sentence_new")
-	core.PuregoSafeRegister(&xAttrShapeNew, libs, "pango_attr_shape_new")
-	core.PuregoSafeRegister(&xAttrShapeNewWithData, libs, "pango_attr_shape_new_with_data")
-	core.PuregoSafeRegister(&xAttrShowNew, libs, "pango_attr_show_new")
-	core.PuregoSafeRegister(&xAttrSizeNew, libs, "pango_attr_size_new")
-	core.PuregoSafeRegister(&xAttrSizeNewAbsolute, libs, "pango_attr_size_new_absolute")
-	core.PuregoSafeRegister(&xAttrStretchNew, libs, "pango_attr_stretch_new")
-	core.PuregoSafeRegister(&xAttrStrikethroughColorNew, libs, "pango_attr_strikethrough_color_new")
-	core.PuregoSafeRegister(&xAttrStrikethroughNew, libs, "pango_attr_strikethrough_new")
-	core.PuregoSafeRegister(&xAttrStyleNew, libs, "pango_attr_style_new")
-	core.PuregoSafeRegister(&xAttrTextTransformNew, libs, "pango_attr_text_transform_new")
-	core.PuregoSafeRegister(&xAttrTypeGetName, libs, "pango_attr_type_get_name")
-	core.PuregoSafeRegister(&xAttrTypeRegister, libs, "pango_attr_type_register")
-	core.PuregoSafeRegister(&xAttrUnderlineColorNew, libs, "pango_attr_underline_color_new")
-	core.PuregoSafeRegister(&xAttrUnderlineNew, libs, "pango_attr_underline_new")
-	core.PuregoSafeRegister(&xAttrVariantNew, libs, "pango_attr_variant_new")
-	core.PuregoSafeRegister(&xAttrWeightNew, libs, "pango_attr_weight_new")
-	core.PuregoSafeRegister(&xAttrWordNew, libs, "pango_attr_word_new")
+	core.PuregoRegisterSince(&xAttrAllowBreaksNew, libs, "pango_attr_allow_breaks_new", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xAttrBackgroundAlphaNew, libs, "pango_attr_background_alpha_new", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xAttrBackgroundNew, libs, "pango_attr_background_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrBaselineShiftNew, libs, "pango_attr_baseline_shift_new", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrFallbackNew, libs, "pango_attr_fallback_new", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xAttrFamilyNew, libs, "pango_attr_family_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrFontDescNew, libs, "pango_attr_font_desc_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrFontFeaturesNew, libs, "pango_attr_font_features_new", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xAttrFontScaleNew, libs, "pango_attr_font_scale_new", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrForegroundAlphaNew, libs, "pango_attr_foreground_alpha_new", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xAttrForegroundNew, libs, "pango_attr_foreground_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrGravityHintNew, libs, "pango_attr_gravity_hint_new", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xAttrGravityNew, libs, "pango_attr_gravity_new", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xAttrInsertHyphensNew, libs, "pango_attr_insert_hyphens_new", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xAttrLanguageNew, libs, "pango_attr_language_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrLetterSpacingNew, libs, "pango_attr_letter_spacing_new", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xAttrLineHeightNew, libs, "pango_attr_line_height_new", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrLineHeightNewAbsolute, libs, "pango_attr_line_height_new_absolute", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrListFromString, libs, "pango_attr_list_from_string", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrOverlineColorNew, libs, "pango_attr_overline_color_new", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xAttrOverlineNew, libs, "pango_attr_overline_new", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xAttrRiseNew, libs, "pango_attr_rise_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrScaleNew, libs, "pango_attr_scale_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrSentenceNew, libs, "pango_attr_sentence_new", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrShapeNew, libs, "pango_attr_shape_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrShapeNewWithData, libs, "pango_attr_shape_new_with_data", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xAttrShowNew, libs, "pango_attr_show_new", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xAttrSizeNew, libs, "pango_attr_size_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrSizeNewAbsolute, libs, "pango_attr_size_new_absolute", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xAttrStretchNew, libs, "pango_attr_stretch_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrStrikethroughColorNew, libs, "pango_attr_strikethrough_color_new", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xAttrStrikethroughNew, libs, "pango_attr_strikethrough_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrStyleNew, libs, "pango_attr_style_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrTextTransformNew, libs, "pango_attr_text_transform_new", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrTypeGetName, libs, "pango_attr_type_get_name", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xAttrTypeRegister, libs, "pango_attr_type_register", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrUnderlineColorNew, libs, "pango_attr_underline_color_new", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xAttrUnderlineNew, libs, "pango_attr_underline_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrVariantNew, libs, "pango_attr_variant_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrWeightNew, libs, "pango_attr_weight_new", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrWordNew, libs, "pango_attr_word_new", "PANGO", "1.50")
 
 	core.PuregoSafeRegister(&xAttrIteratorGLibType, libs, "pango_attr_iterator_get_type")
 
-	core.PuregoSafeRegister(&xAttrIteratorCopy, libs, "pango_attr_iterator_copy")
-	core.PuregoSafeRegister(&xAttrIteratorDestroy, libs, "pango_attr_iterator_destroy")
-	core.PuregoSafeRegister(&xAttrIteratorGet, libs, "pango_attr_iterator_get")
-	core.PuregoSafeRegister(&xAttrIteratorGetAttrs, libs, "pango_attr_iterator_get_attrs")
-	core.PuregoSafeRegister(&xAttrIteratorGetFont, libs, "pango_attr_iterator_get_font")
-	core.PuregoSafeRegister(&xAttrIteratorNext, libs, "pango_attr_iterator_next")
-	core.PuregoSafeRegister(&xAttrIteratorRange, libs, "pango_attr_iterator_range")
+	core.PuregoRegisterSince(&xAttrIteratorCopy, libs, "pango_attr_iterator_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrIteratorDestroy, libs, "pango_attr_iterator_destroy", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrIteratorGet, libs, "pango_attr_iterator_get", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrIteratorGetAttrs, libs, "pango_attr_iterator_get_attrs", "PANGO", "1.2")
+	core.PuregoRegisterSince(&xAttrIteratorGetFont, libs, "pango_attr_iterator_get_font", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrIteratorNext, libs, "pango_attr_iterator_next", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrIteratorRange, libs, "pango_attr_iterator_range", "PANGO", "")
 
 	core.PuregoSafeRegister(&xAttrListGLibType, libs, "pango_attr_list_get_type")
 
-	core.PuregoSafeRegister(&xNewAttrList, libs, "pango_attr_list_new")
-
-	core.PuregoSafeRegister(&xAttrListChange, libs, "pango_attr_list_change")
-	core.PuregoSafeRegister(&xAttrListCopy, libs, "pango_attr_list_copy")
-	core.PuregoSafeRegister(&xAttrListEqual, libs, "pango_attr_list_equal")
-	core.PuregoSafeRegister(&xAttrListFilter, libs, "pango_attr_list_filter")
-	core.PuregoSafeRegister(&xAttrListGetAttributes, libs, "pango_attr_list_get_attributes")
-	core.PuregoSafeRegister(&xAttrListGetIterator, libs, "pango_attr_list_get_iterator")
-	core.PuregoSafeRegister(&xAttrListInsert, libs, "pango_attr_list_insert")
-	core.PuregoSafeRegister(&xAttrListInsertBefore, libs, "pango_attr_list_insert_before")
-	core.PuregoSafeRegister(&xAttrListRef, libs, "pango_attr_list_ref")
-	core.PuregoSafeRegister(&xAttrListSplice, libs, "pango_attr_list_splice")
-	core.PuregoSafeRegister(&xAttrListToString, libs, "pango_attr_list_to_string")
-	core.PuregoSafeRegister(&xAttrListUnref, libs, "pango_attr_list_unref")
-	core.PuregoSafeRegister(&xAttrListUpdate, libs, "pango_attr_list_update")
+	core.PuregoRegisterSince(&xNewAttrList, libs, "pango_attr_list_new", "PANGO", "")
+
+	core.PuregoRegisterSince(&xAttrListChange, libs, "pango_attr_list_change", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListCopy, libs, "pango_attr_list_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListEqual, libs, "pango_attr_list_equal", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xAttrListFilter, libs, "pango_attr_list_filter", "PANGO", "1.2")
+	core.PuregoRegisterSince(&xAttrListGetAttributes, libs, "pango_attr_list_get_attributes", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xAttrListGetIterator, libs, "pango_attr_list_get_iterator", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListInsert, libs, "pango_attr_list_insert", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListInsertBefore, libs, "pango_attr_list_insert_before", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListRef, libs, "pango_attr_list_ref", "PANGO", "1.10")
+	core.PuregoRegisterSince(&xAttrListSplice, libs, "pango_attr_list_splice", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListToString, libs, "pango_attr_list_to_string", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttrListUnref, libs, "pango_attr_list_unref", "PANGO", "")
+	core.PuregoRegisterSince(&xAttrListUpdate, libs, "pango_attr_list_update", "PANGO", "1.44")
 
 	core.PuregoSafeRegister(&xAttributeGLibType, libs, "pango_attribute_get_type")
 
-	core.PuregoSafeRegister(&xAttributeAsColor, libs, "pango_attribute_as_color")
-	core.PuregoSafeRegister(&xAttributeAsFloat, libs, "pango_attribute_as_float")
-	core.PuregoSafeRegister(&xAttributeAsFontDesc, libs, "pango_attribute_as_font_desc")
-	core.PuregoSafeRegister(&xAttributeAsFontFeatures, libs, "pango_attribute_as_font_features")
-	core.PuregoSafeRegister(&xAttributeAsInt, libs, "pango_attribute_as_int")
-	core.PuregoSafeRegister(&xAttributeAsLanguage, libs, "pango_attribute_as_language")
-	core.PuregoSafeRegister(&xAttributeAsShape, libs, "pango_attribute_as_shape")
-	core.PuregoSafeRegister(&xAttributeAsSize, libs, "pango_attribute_as_size")
-	core.PuregoSafeRegister(&xAttributeAsString, libs, "pango_attribute_as_string")
-	core.PuregoSafeRegister(&xAttributeCopy, libs, "pango_attribute_copy")
-	core.PuregoSafeRegister(&xAttributeDestroy, libs, "pango_attribute_destroy")
-	core.PuregoSafeRegister(&xAttributeEqual, libs, "pango_attribute_equal")
-	core.PuregoSafeRegister(&xAttributeInit, libs, "pango_attribute_init")
+	core.PuregoRegisterSince(&xAttributeAsColor, libs, "pango_attribute_as_color", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsFloat, libs, "pango_attribute_as_float", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsFontDesc, libs, "pango_attribute_as_font_desc", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsFontFeatures, libs, "pango_attribute_as_font_features", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsInt, libs, "pango_attribute_as_int", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsLanguage, libs, "pango_attribute_as_language", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsShape, libs, "pango_attribute_as_shape", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsSize, libs, "pango_attribute_as_size", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeAsString, libs, "pango_attribute_as_string", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xAttributeCopy, libs, "pango_attribute_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xAttributeDestroy, libs, "pango_attribute_destroy", "PANGO", "")
+	core.PuregoRegisterSince(&xAttributeEqual, libs, "pango_attribute_equal", "PANGO", "")
+	core.PuregoRegisterSince(&xAttributeInit, libs, "pango_attribute_init", "PANGO", "1.20")
 
 }
@@ -2,7 +2,8 @@
 package pango
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -11,7 +12,11 @@ import (
 // type of a Unicode character.
 //
 // The values in this enumeration are specified by the
-// [Unicode bidirectional algorithm](http://www.unicode.org/reports/tr9/).
+// [Unicode bidirectional algorithm].
+//
+// Deprecated: since 1.44. Use fribidi for this information
+//
+// [Unicode bidirectional algorithm]: http://www.unicode.org/reports/tr9/
 type BidiType int
 
 var xBidiTypeGLibType func() types.GType
@@ -70,14 +75,173 @@ const (
 	BidiTypePdiValue BidiType = 22
 )
 
-var xBidiTypeForUnichar func(uint32) BidiType
+// String returns the C name of the value, e.g. for debugging output
+func (x BidiType) String() string {
+	switch x {
+	case BidiTypeLValue:
+		return "PANGO_BIDI_TYPE_L"
+	case BidiTypeLreValue:
+		return "PANGO_BIDI_TYPE_LRE"
+	case BidiTypeLroValue:
+		return "PANGO_BIDI_TYPE_LRO"
+	case BidiTypeRValue:
+		return "PANGO_BIDI_TYPE_R"
+	case BidiTypeAlValue:
+		return "PANGO_BIDI_TYPE_AL"
+	case BidiTypeRleValue:
+		return "PANGO_BIDI_TYPE_RLE"
+	case BidiTypeRloValue:
+		return "PANGO_BIDI_TYPE_RLO"
+	case BidiTypePdfValue:
+		return "PANGO_BIDI_TYPE_PDF"
+	case BidiTypeEnValue:
+		return "PANGO_BIDI_TYPE_EN"
+	case BidiTypeEsValue:
+		return "PANGO_BIDI_TYPE_ES"
+	case BidiTypeEtValue:
+		return "PANGO_BIDI_TYPE_ET"
+	case BidiTypeAnValue:
+		return "PANGO_BIDI_TYPE_AN"
+	case BidiTypeCsValue:
+		return "PANGO_BIDI_TYPE_CS"
+	case BidiTypeNsmValue:
+		return "PANGO_BIDI_TYPE_NSM"
+	case BidiTypeBnValue:
+		return "PANGO_BIDI_TYPE_BN"
+	case BidiTypeBValue:
+		return "PANGO_BIDI_TYPE_B"
+	case BidiTypeSValue:
+		return "PANGO_BIDI_TYPE_S"
+	case BidiTypeWsValue:
+		return "PANGO_BIDI_TYPE_WS"
+	case BidiTypeOnValue:
+		return "PANGO_BIDI_TYPE_ON"
+	case BidiTypeLriValue:
+		return "PANGO_BIDI_TYPE_LRI"
+	case BidiTypeRliValue:
+		return "PANGO_BIDI_TYPE_RLI"
+	case BidiTypeFsiValue:
+		return "PANGO_BIDI_TYPE_FSI"
+	case BidiTypePdiValue:
+		return "PANGO_BIDI_TYPE_PDI"
+	}
+	return fmt.Sprintf("BidiType(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x BidiType) Nick() string {
+	switch x {
+	case BidiTypeLValue:
+		return "l"
+	case BidiTypeLreValue:
+		return "lre"
+	case BidiTypeLroValue:
+		return "lro"
+	case BidiTypeRValue:
+		return "r"
+	case BidiTypeAlValue:
+		return "al"
+	case BidiTypeRleValue:
+		return "rle"
+	case BidiTypeRloValue:
+		return "rlo"
+	case BidiTypePdfValue:
+		return "pdf"
+	case BidiTypeEnValue:
+		return "en"
+	case BidiTypeEsValue:
+		return "es"
+	case BidiTypeEtValue:
+		return "et"
+	case BidiTypeAnValue:
+		return "an"
+	case BidiTypeCsValue:
+		return "cs"
+	case BidiTypeNsmValue:
+		return "nsm"
+	case BidiTypeBnValue:
+		return "bn"
+	case BidiTypeBValue:
+		return "b"
+	case BidiTypeSValue:
+		return "s"
+	case BidiTypeWsValue:
+		return "ws"
+	case BidiTypeOnValue:
+		return "on"
+	case BidiTypeLriValue:
+		return "lri"
+	case BidiTypeRliValue:
+		return "rli"
+	case BidiTypeFsiValue:
+		return "fsi"
+	case BidiTypePdiValue:
+		return "pdi"
+	}
+	return ""
+}
+
+// BidiTypeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func BidiTypeFromString(s string) (BidiType, bool) {
+	switch s {
+	case "PANGO_BIDI_TYPE_L", "l":
+		return BidiTypeLValue, true
+	case "PANGO_BIDI_TYPE_LRE", "lre":
+		return BidiTypeLreValue, true
+	case "PANGO_BIDI_TYPE_LRO", "lro":
+		return BidiTypeLroValue, true
+	case "PANGO_BIDI_TYPE_R", "r":
+		return BidiTypeRValue, true
+	case "PANGO_BIDI_TYPE_AL", "al":
+		return BidiTypeAlValue, true
+	case "PANGO_BIDI_TYPE_RLE", "rle":
+		return BidiTypeRleValue, true
+	case "PANGO_BIDI_TYPE_RLO", "rlo":
+		return BidiTypeRloValue, true
+	case "PANGO_BIDI_TYPE_PDF", "pdf":
+		return BidiTypePdfValue, true
+	case "PANGO_BIDI_TYPE_EN", "en":
+		return BidiTypeEnValue, true
+	case "PANGO_BIDI_TYPE_ES", "es":
+		return BidiTypeEsValue, true
+	case "PANGO_BIDI_TYPE_ET", "et":
+		return BidiTypeEtValue, true
+	case "PANGO_BIDI_TYPE_AN", "an":
+		return BidiTypeAnValue, true
+	case "PANGO_BIDI_TYPE_CS", "cs":
+		return BidiTypeCsValue, true
+	case "PANGO_BIDI_TYPE_NSM", "nsm":
+		return BidiTypeNsmValue, true
+	case "PANGO_BIDI_TYPE_BN", "bn":
+		return BidiTypeBnValue, true
+	case "PANGO_BIDI_TYPE_B", "b":
+		return BidiTypeBValue, true
+	case "PANGO_BIDI_TYPE_S", "s":
+		return BidiTypeSValue, true
+	case "PANGO_BIDI_TYPE_WS", "ws":
+		return BidiTypeWsValue, true
+	case "PANGO_BIDI_TYPE_ON", "on":
+		return BidiTypeOnValue, true
+	case "PANGO_BIDI_TYPE_LRI", "lri":
+		return BidiTypeLriValue, true
+	case "PANGO_BIDI_TYPE_RLI", "rli":
+		return BidiTypeRliValue, true
+	case "PANGO_BIDI_TYPE_FSI", "fsi":
+		return BidiTypeFsiValue, true
+	case "PANGO_BIDI_TYPE_PDI", "pdi":
+		return BidiTypePdiValue, true
+	}
+	return 0, false
+}
+
+var xBidiTypeForUnichar func(rune) BidiType
 
 // Determines the bidirectional type of a character.
 //
 // The bidirectional type is specified in the Unicode Character Database.
 //
-// A simplified version of this function is available as [func@unichar_direction].
-func BidiTypeForUnichar(ChVar uint32) BidiType {
+// A simplified version of this function is available as unichar_direction.
+func BidiTypeForUnichar(ChVar rune) BidiType {
 
 	cret := xBidiTypeForUnichar(ChVar)
 	return cret
@@ -93,18 +257,7 @@ func FindBaseDir(TextVar string, LengthVar int) Direction {
 	return cret
 }
 
-var xGetMirrorChar func(uint32, *uint32) bool
-
-// Returns the mirrored character of a Unicode character.
-//
-// Mirror characters are determined by the Unicode mirrored property.
-func GetMirrorChar(ChVar uint32, MirroredChVar *uint32) bool {
-
-	cret := xGetMirrorChar(ChVar, MirroredChVar)
-	return cret
-}
-
-var xUnicharDirection func(uint32) Direction
+var xUnicharDirection func(rune) Direction
 
 // Determines the inherent direction of a character.
 //
@@ -113,9 +266,9 @@ var xUnicharDirection func(uint32) Direction
 //
 // This function is useful to categorize characters into left-to-right
 // letters, right-to-left letters, and everything else. If full Unicode
-// bidirectional type of a character is needed, [func@Pango.BidiType.for_unichar]
+// bidirectional type of a character is needed, [BidiTypeForUnichar]
 // can be used instead.
-func UnicharDirection(ChVar uint32) Direction {
+func UnicharDirection(ChVar rune) Direction {
 
 	cret := xUnicharDirection(ChVar)
 	return cret
@@ -126,7 +279,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -135,9 +288,8 @@ func init() {
 
 	core.PuregoSafeRegister(&xBidiTypeGLibType, libs, "pango_bidi_type_get_type")
 
-	core.PuregoSafeRegister(&xBidiTypeForUnichar, libs, "pango_bidi_type_for_unichar")
-	core.PuregoSafeRegister(&xFindBaseDir, libs, "pango_find_base_dir")
-	core.PuregoSafeRegister(&xGetMirrorChar, libs, "pango_get_mirror_char")
-	core.PuregoSafeRegister(&xUnicharDirection, libs, "pango_unichar_direction")
+	core.PuregoRegisterSince(&xBidiTypeForUnichar, libs, "pango_bidi_type_for_unichar", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xFindBaseDir, libs, "pango_find_base_dir", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xUnicharDirection, libs, "pango_unichar_direction", "PANGO", "")
 
 }
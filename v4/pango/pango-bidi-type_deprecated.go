@@ -0,0 +1,39 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xGetMirrorChar func(rune, *rune) bool
+
+// Returns the mirrored character of a Unicode character.
+//
+// Mirror characters are determined by the Unicode mirrored property.
+//
+// Deprecated: since 1.30. Use [glib.UnicharGetMirrorChar] instead;
+//
+//	the docs for that function provide full details.
+func GetMirrorChar(ChVar rune, MirroredChVar *rune) bool {
+
+	cret := xGetMirrorChar(ChVar, MirroredChVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xGetMirrorChar, libs, "pango_get_mirror_char", "PANGO", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -53,39 +52,29 @@ func (x *LogAttr) GoPointer() uintptr {
 
 var xAttrBreak func(string, int, *AttrList, int, *[]LogAttr, int)
 
-// Apply customization from attributes to the breaks in @attrs.
+// Apply customization from attributes to the breaks in attrs.
 //
 // The line breaks are assumed to have been produced
-// by [func@Pango.default_break] and [func@Pango.tailor_break].
+// by [DefaultBreak] and [TailorBreak].
 func AttrBreak(TextVar string, LengthVar int, AttrListVar *AttrList, OffsetVar int, AttrsVar *[]LogAttr, AttrsLenVar int) {
 
 	xAttrBreak(TextVar, LengthVar, AttrListVar, OffsetVar, AttrsVar, AttrsLenVar)
 
 }
 
-var xBreak func(string, int, *Analysis, *[]LogAttr, int)
-
-// Determines possible line, word, and character breaks
-// for a string of Unicode text with a single analysis.
-//
-// For most purposes you may want to use [func@Pango.get_log_attrs].
-func Break(TextVar string, LengthVar int, AnalysisVar *Analysis, AttrsVar *[]LogAttr, AttrsLenVar int) {
-
-	xBreak(TextVar, LengthVar, AnalysisVar, AttrsVar, AttrsLenVar)
-
-}
-
 var xDefaultBreak func(string, int, *Analysis, *[]LogAttr, int)
 
 // This is the default break algorithm.
 //
-// It applies rules from the [Unicode Line Breaking Algorithm](http://www.unicode.org/unicode/reports/tr14/)
-// without language-specific tailoring, therefore the @analyis argument is unused
-// and can be %NULL.
+// It applies rules from the [Unicode Line Breaking Algorithm]
+// without language-specific tailoring, therefore the analyis argument is unused
+// and can be nil.
+//
+// See [TailorBreak] for language-specific breaks.
 //
-// See [func@Pango.tailor_break] for language-specific breaks.
+// See [AttrBreak] for attribute-based customization.
 //
-// See [func@Pango.attr_break] for attribute-based customization.
+// [Unicode Line Breaking Algorithm]: http://www.unicode.org/unicode/reports/tr14/
 func DefaultBreak(TextVar string, LengthVar int, AnalysisVar *Analysis, AttrsVar *[]LogAttr, AttrsLenVar int) {
 
 	xDefaultBreak(TextVar, LengthVar, AnalysisVar, AttrsVar, AttrsLenVar)
@@ -94,12 +83,12 @@ func DefaultBreak(TextVar string, LengthVar int, AnalysisVar *Analysis, AttrsVar
 
 var xGetLogAttrs func(string, int, int, *Language, *[]LogAttr, int)
 
-// Computes a `PangoLogAttr` for each character in @text.
+// Computes a `PangoLogAttr` for each character in text.
 //
-// The @attrs array must have one `PangoLogAttr` for
-// each position in @text; if @text contains N characters,
+// The attrs array must have one `PangoLogAttr` for
+// each position in text; if text contains N characters,
 // it has N+1 positions, including the last position at the
-// end of the text. @text should be an entire paragraph;
+// end of the text. text should be an entire paragraph;
 // logical attributes can't be computed without context
 // (for example you need to see spaces on either side of
 // a word to know the word is a word).
@@ -111,14 +100,14 @@ func GetLogAttrs(TextVar string, LengthVar int, LevelVar int, LanguageVar *Langu
 
 var xTailorBreak func(string, int, *Analysis, int, *[]LogAttr, int)
 
-// Apply language-specific tailoring to the breaks in @attrs.
+// Apply language-specific tailoring to the breaks in attrs.
 //
-// The line breaks are assumed to have been produced by [func@Pango.default_break].
+// The line breaks are assumed to have been produced by [DefaultBreak].
 //
-// If @offset is not -1, it is used to apply attributes from @analysis that are
+// If offset is not -1, it is used to apply attributes from analysis that are
 // relevant to line breaking.
 //
-// Note that it is better to pass -1 for @offset and use [func@Pango.attr_break]
+// Note that it is better to pass -1 for offset and use [AttrBreak]
 // to apply attributes to the whole paragraph.
 func TailorBreak(TextVar string, LengthVar int, AnalysisVar *Analysis, OffsetVar int, AttrsVar *[]LogAttr, AttrsLenVar int) {
 
@@ -131,17 +120,16 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xAttrBreak, libs, "pango_attr_break")
-	core.PuregoSafeRegister(&xBreak, libs, "pango_break")
-	core.PuregoSafeRegister(&xDefaultBreak, libs, "pango_default_break")
-	core.PuregoSafeRegister(&xGetLogAttrs, libs, "pango_get_log_attrs")
-	core.PuregoSafeRegister(&xTailorBreak, libs, "pango_tailor_break")
+	core.PuregoRegisterSince(&xAttrBreak, libs, "pango_attr_break", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xDefaultBreak, libs, "pango_default_break", "PANGO", "")
+	core.PuregoRegisterSince(&xGetLogAttrs, libs, "pango_get_log_attrs", "PANGO", "")
+	core.PuregoRegisterSince(&xTailorBreak, libs, "pango_tailor_break", "PANGO", "1.44")
 
 }
@@ -0,0 +1,40 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xBreak func(string, int, *Analysis, *[]LogAttr, int)
+
+// Determines possible line, word, and character breaks
+// for a string of Unicode text with a single analysis.
+//
+// For most purposes you may want to use [GetLogAttrs].
+//
+// Deprecated: since 1.44. Use [DefaultBreak],
+//
+//	[TailorBreak] and [AttrBreak].
+func Break(TextVar string, LengthVar int, AnalysisVar *Analysis, AttrsVar *[]LogAttr, AttrsLenVar int) {
+
+	xBreak(TextVar, LengthVar, AnalysisVar, AttrsVar, AttrsLenVar)
+
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xBreak, libs, "pango_break", "PANGO", "")
+
+}
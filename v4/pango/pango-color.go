@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -34,9 +33,9 @@ func (x *Color) GoPointer() uintptr {
 
 var xColorCopy func(uintptr) *Color
 
-// Creates a copy of @src.
+// Creates a copy of src.
 //
-// The copy should be freed with [method@Pango.Color.free].
+// The copy should be freed with [Color.Free].
 // Primarily used by language bindings, not that useful
 // otherwise (since colors can just be copied by assignment
 // in C).
@@ -48,7 +47,7 @@ func (x *Color) Copy() *Color {
 
 var xColorFree func(uintptr)
 
-// Frees a color allocated by [method@Pango.Color.copy].
+// Frees a color allocated by [Color.Copy].
 func (x *Color) Free() {
 
 	xColorFree(x.GoPointer())
@@ -60,12 +59,14 @@ var xColorParse func(uintptr, string) bool
 // Fill in the fields of a color from a string specification.
 //
 // The string can either one of a large set of standard names.
-// (Taken from the CSS Color [specification](https://www.w3.org/TR/css-color-4/#named-colors),
+// (Taken from the CSS Color [specification],
 // or it can be a value in the form `#rgb`, `#rrggbb`,
 // `#rrrgggbbb` or `#rrrrggggbbbb`, where `r`, `g` and `b`
 // are hex digits of the red, green, and blue components
 // of the color, respectively. (White in the four forms is
 // `#fff`, `#ffffff`, `#fffffffff` and `#ffffffffffff`.)
+//
+// [specification]: https://www.w3.org/TR/css-color-4/#named-colors
 func (x *Color) Parse(SpecVar string) bool {
 
 	cret := xColorParse(x.GoPointer(), SpecVar)
@@ -77,7 +78,7 @@ var xColorParseWithAlpha func(uintptr, *uint16, string) bool
 // Fill in the fields of a color from a string specification.
 //
 // The string can either one of a large set of standard names.
-// (Taken from the CSS Color [specification](https://www.w3.org/TR/css-color-4/#named-colors),
+// (Taken from the CSS Color [specification],
 // or it can be a hexadecimal value in the form `#rgb`,
 // `#rrggbb`, `#rrrgggbbb` or `#rrrrggggbbbb` where `r`, `g`
 // and `b` are hex digits of the red, green, and blue components
@@ -85,10 +86,12 @@ var xColorParseWithAlpha func(uintptr, *uint16, string) bool
 // `#fff`, `#ffffff`, `#fffffffff` and `#ffffffffffff`.)
 //
 // Additionally, parse strings of the form `#rgba`, `#rrggbbaa`,
-// `#rrrrggggbbbbaaaa`, if @alpha is not %NULL, and set @alpha
+// `#rrrrggggbbbbaaaa`, if alpha is not nil, and set alpha
 // to the value specified by the hex digits for `a`. If no alpha
-// component is found in @spec, @alpha is set to 0xffff (for a
+// component is found in spec, alpha is set to 0xffff (for a
 // solid color).
+//
+// [specification]: https://www.w3.org/TR/css-color-4/#named-colors
 func (x *Color) ParseWithAlpha(AlphaVar *uint16, SpecVar string) bool {
 
 	cret := xColorParseWithAlpha(x.GoPointer(), AlphaVar, SpecVar)
@@ -97,7 +100,7 @@ func (x *Color) ParseWithAlpha(AlphaVar *uint16, SpecVar string) bool {
 
 var xColorToString func(uintptr) string
 
-// Returns a textual specification of @color.
+// Returns a textual specification of color.
 //
 // The string is in the hexadecimal form `#rrrrggggbbbb`,
 // where `r`, `g` and `b` are hex digits representing the
@@ -113,7 +116,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -122,10 +125,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xColorGLibType, libs, "pango_color_get_type")
 
-	core.PuregoSafeRegister(&xColorCopy, libs, "pango_color_copy")
-	core.PuregoSafeRegister(&xColorFree, libs, "pango_color_free")
-	core.PuregoSafeRegister(&xColorParse, libs, "pango_color_parse")
-	core.PuregoSafeRegister(&xColorParseWithAlpha, libs, "pango_color_parse_with_alpha")
-	core.PuregoSafeRegister(&xColorToString, libs, "pango_color_to_string")
+	core.PuregoRegisterSince(&xColorCopy, libs, "pango_color_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xColorFree, libs, "pango_color_free", "PANGO", "")
+	core.PuregoRegisterSince(&xColorParse, libs, "pango_color_parse", "PANGO", "")
+	core.PuregoRegisterSince(&xColorParseWithAlpha, libs, "pango_color_parse_with_alpha", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xColorToString, libs, "pango_color_to_string", "PANGO", "1.16")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -26,7 +25,7 @@ func (x *ContextClass) GoPointer() uintptr {
 // to look up fonts, and default values such as the default language,
 // default gravity, or default font.
 //
-// To obtain a `PangoContext`, use [method@Pango.FontMap.create_context].
+// To obtain a `PangoContext`, use [FontMap.CreateContext].
 type Context struct {
 	gobject.Object
 }
@@ -48,14 +47,14 @@ var xNewContext func() uintptr
 // Creates a new `PangoContext` initialized to default values.
 //
 // This function is not particularly useful as it should always
-// be followed by a [method@Pango.Context.set_font_map] call, and the
-// function [method@Pango.FontMap.create_context] does these two steps
+// be followed by a [Context.SetFontMap] call, and the
+// function [FontMap.CreateContext] does these two steps
 // together and hence users are recommended to use that.
 //
 // If you are using Pango as part of a higher-level system,
 // that system may have it's own way of create a `PangoContext`.
 // For instance, the GTK toolkit has, among others,
-// `gtk_widget_get_pango_context()`. Use those instead.
+// `[gtk.Widget.GetPangoContext]`. Use those instead.
 func NewContext() *Context {
 	var cls *Context
 
@@ -88,7 +87,7 @@ var xContextGetBaseDir func(uintptr) Direction
 
 // Retrieves the base direction for the context.
 //
-// See [method@Pango.Context.set_base_dir].
+// See [Context.SetBaseDir].
 func (x *Context) GetBaseDir() Direction {
 
 	cret := xContextGetBaseDir(x.GoPointer())
@@ -99,7 +98,7 @@ var xContextGetBaseGravity func(uintptr) Gravity
 
 // Retrieves the base gravity for the context.
 //
-// See [method@Pango.Context.set_base_gravity].
+// See [Context.SetBaseGravity].
 func (x *Context) GetBaseGravity() Gravity {
 
 	cret := xContextGetBaseGravity(x.GoPointer())
@@ -136,9 +135,9 @@ var xContextGetGravity func(uintptr) Gravity
 
 // Retrieves the gravity for the context.
 //
-// This is similar to [method@Pango.Context.get_base_gravity],
-// except for when the base gravity is %PANGO_GRAVITY_AUTO for
-// which [func@Pango.Gravity.get_for_matrix] is used to return the
+// This is similar to [Context.GetBaseGravity],
+// except for when the base gravity is [GravityAutoValue] for
+// which [GravityGetForMatrix] is used to return the
 // gravity from the current context matrix.
 func (x *Context) GetGravity() Gravity {
 
@@ -150,7 +149,7 @@ var xContextGetGravityHint func(uintptr) GravityHint
 
 // Retrieves the gravity hint for the context.
 //
-// See [method@Pango.Context.set_gravity_hint] for details.
+// See [Context.SetGravityHint] for details.
 func (x *Context) GetGravityHint() GravityHint {
 
 	cret := xContextGetGravityHint(x.GoPointer())
@@ -171,7 +170,7 @@ var xContextGetMatrix func(uintptr) *Matrix
 // Gets the transformation matrix that will be applied when
 // rendering with this context.
 //
-// See [method@Pango.Context.set_matrix].
+// See [Context.SetMatrix].
 func (x *Context) GetMatrix() *Matrix {
 
 	cret := xContextGetMatrix(x.GoPointer())
@@ -186,7 +185,7 @@ var xContextGetMetrics func(uintptr, *FontDescription, *Language) *FontMetrics
 // a language tag can be provided to indicate that the metrics should be
 // retrieved that correspond to the script(s) used by that language.
 //
-// The `PangoFontDescription` is interpreted in the same way as by [func@itemize],
+// The `PangoFontDescription` is interpreted in the same way as by itemize,
 // and the family name may be a comma separated list of names. If characters
 // from multiple of these families would be used to render the string, then
 // the returned fonts would be a composite of the metrics for the fonts loaded
@@ -209,7 +208,7 @@ func (x *Context) GetRoundGlyphPositions() bool {
 
 var xContextGetSerial func(uintptr) uint
 
-// Returns the current serial number of @context.
+// Returns the current serial number of context.
 //
 // The serial number is initialized to an small number larger than zero
 // when a new context is created and is increased whenever the context
@@ -239,7 +238,7 @@ func (x *Context) ListFamilies(FamiliesVar *uintptr, NFamiliesVar *int) {
 var xContextLoadFont func(uintptr, *FontDescription) uintptr
 
 // Loads the font in one of the fontmaps in the context
-// that is the closest match for @desc.
+// that is the closest match for desc.
 func (x *Context) LoadFont(DescVar *FontDescription) *Font {
 	var cls *Font
 
@@ -256,7 +255,7 @@ func (x *Context) LoadFont(DescVar *FontDescription) *Font {
 var xContextLoadFontset func(uintptr, *FontDescription, *Language) uintptr
 
 // Load a set of fonts in the context that can be used to render
-// a font matching @desc.
+// a font matching desc.
 func (x *Context) LoadFontset(DescVar *FontDescription, LanguageVar *Language) *Fontset {
 	var cls *Fontset
 
@@ -275,10 +274,10 @@ var xContextSetBaseDir func(uintptr, Direction)
 // Sets the base direction for the context.
 //
 // The base direction is used in applying the Unicode bidirectional
-// algorithm; if the @direction is %PANGO_DIRECTION_LTR or
-// %PANGO_DIRECTION_RTL, then the value will be used as the paragraph
+// algorithm; if the direction is [DirectionLtrValue] or
+// [DirectionRtlValue], then the value will be used as the paragraph
 // direction in the Unicode bidirectional algorithm. A value of
-// %PANGO_DIRECTION_WEAK_LTR or %PANGO_DIRECTION_WEAK_RTL is used only
+// [DirectionWeakLtrValue] or [DirectionWeakRtlValue] is used only
 // for paragraphs that do not contain any strong characters themselves.
 func (x *Context) SetBaseDir(DirectionVar Direction) {
 
@@ -326,8 +325,8 @@ var xContextSetGravityHint func(uintptr, GravityHint)
 //
 // The gravity hint is used in laying vertical text out, and
 // is only relevant if gravity of the context as returned by
-// [method@Pango.Context.get_gravity] is set to %PANGO_GRAVITY_EAST
-// or %PANGO_GRAVITY_WEST.
+// [Context.GetGravity] is set to [GravityEastValue]
+// or [GravityWestValue].
 func (x *Context) SetGravityHint(HintVar GravityHint) {
 
 	xContextSetGravityHint(x.GoPointer(), HintVar)
@@ -339,7 +338,7 @@ var xContextSetLanguage func(uintptr, *Language)
 // Sets the global language tag for the context.
 //
 // The default language for the locale of the running process
-// can be found using [func@Pango.Language.get_default].
+// can be found using [LanguageGetDefault].
 func (x *Context) SetLanguage(LanguageVar *Language) {
 
 	xContextSetLanguage(x.GoPointer(), LanguageVar)
@@ -395,7 +394,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -404,30 +403,30 @@ func init() {
 
 	core.PuregoSafeRegister(&xContextGLibType, libs, "pango_context_get_type")
 
-	core.PuregoSafeRegister(&xNewContext, libs, "pango_context_new")
-
-	core.PuregoSafeRegister(&xContextChanged, libs, "pango_context_changed")
-	core.PuregoSafeRegister(&xContextGetBaseDir, libs, "pango_context_get_base_dir")
-	core.PuregoSafeRegister(&xContextGetBaseGravity, libs, "pango_context_get_base_gravity")
-	core.PuregoSafeRegister(&xContextGetFontDescription, libs, "pango_context_get_font_description")
-	core.PuregoSafeRegister(&xContextGetFontMap, libs, "pango_context_get_font_map")
-	core.PuregoSafeRegister(&xContextGetGravity, libs, "pango_context_get_gravity")
-	core.PuregoSafeRegister(&xContextGetGravityHint, libs, "pango_context_get_gravity_hint")
-	core.PuregoSafeRegister(&xContextGetLanguage, libs, "pango_context_get_language")
-	core.PuregoSafeRegister(&xContextGetMatrix, libs, "pango_context_get_matrix")
-	core.PuregoSafeRegister(&xContextGetMetrics, libs, "pango_context_get_metrics")
-	core.PuregoSafeRegister(&xContextGetRoundGlyphPositions, libs, "pango_context_get_round_glyph_positions")
-	core.PuregoSafeRegister(&xContextGetSerial, libs, "pango_context_get_serial")
-	core.PuregoSafeRegister(&xContextListFamilies, libs, "pango_context_list_families")
-	core.PuregoSafeRegister(&xContextLoadFont, libs, "pango_context_load_font")
-	core.PuregoSafeRegister(&xContextLoadFontset, libs, "pango_context_load_fontset")
-	core.PuregoSafeRegister(&xContextSetBaseDir, libs, "pango_context_set_base_dir")
-	core.PuregoSafeRegister(&xContextSetBaseGravity, libs, "pango_context_set_base_gravity")
-	core.PuregoSafeRegister(&xContextSetFontDescription, libs, "pango_context_set_font_description")
-	core.PuregoSafeRegister(&xContextSetFontMap, libs, "pango_context_set_font_map")
-	core.PuregoSafeRegister(&xContextSetGravityHint, libs, "pango_context_set_gravity_hint")
-	core.PuregoSafeRegister(&xContextSetLanguage, libs, "pango_context_set_language")
-	core.PuregoSafeRegister(&xContextSetMatrix, libs, "pango_context_set_matrix")
-	core.PuregoSafeRegister(&xContextSetRoundGlyphPositions, libs, "pango_context_set_round_glyph_positions")
+	core.PuregoRegisterSince(&xNewContext, libs, "pango_context_new", "PANGO", "")
+
+	core.PuregoRegisterSince(&xContextChanged, libs, "pango_context_changed", "PANGO", "1.32.4")
+	core.PuregoRegisterSince(&xContextGetBaseDir, libs, "pango_context_get_base_dir", "PANGO", "")
+	core.PuregoRegisterSince(&xContextGetBaseGravity, libs, "pango_context_get_base_gravity", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xContextGetFontDescription, libs, "pango_context_get_font_description", "PANGO", "")
+	core.PuregoRegisterSince(&xContextGetFontMap, libs, "pango_context_get_font_map", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xContextGetGravity, libs, "pango_context_get_gravity", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xContextGetGravityHint, libs, "pango_context_get_gravity_hint", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xContextGetLanguage, libs, "pango_context_get_language", "PANGO", "")
+	core.PuregoRegisterSince(&xContextGetMatrix, libs, "pango_context_get_matrix", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xContextGetMetrics, libs, "pango_context_get_metrics", "PANGO", "")
+	core.PuregoRegisterSince(&xContextGetRoundGlyphPositions, libs, "pango_context_get_round_glyph_positions", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xContextGetSerial, libs, "pango_context_get_serial", "PANGO", "1.32.4")
+	core.PuregoRegisterSince(&xContextListFamilies, libs, "pango_context_list_families", "PANGO", "")
+	core.PuregoRegisterSince(&xContextLoadFont, libs, "pango_context_load_font", "PANGO", "")
+	core.PuregoRegisterSince(&xContextLoadFontset, libs, "pango_context_load_fontset", "PANGO", "")
+	core.PuregoRegisterSince(&xContextSetBaseDir, libs, "pango_context_set_base_dir", "PANGO", "")
+	core.PuregoRegisterSince(&xContextSetBaseGravity, libs, "pango_context_set_base_gravity", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xContextSetFontDescription, libs, "pango_context_set_font_description", "PANGO", "")
+	core.PuregoRegisterSince(&xContextSetFontMap, libs, "pango_context_set_font_map", "PANGO", "")
+	core.PuregoRegisterSince(&xContextSetGravityHint, libs, "pango_context_set_gravity_hint", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xContextSetLanguage, libs, "pango_context_set_language", "PANGO", "")
+	core.PuregoRegisterSince(&xContextSetMatrix, libs, "pango_context_set_matrix", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xContextSetRoundGlyphPositions, libs, "pango_context_set_round_glyph_positions", "PANGO", "1.44")
 
 }
@@ -2,7 +2,8 @@
 package pango
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -11,7 +12,7 @@ import (
 // `PangoCoverageLevel` is used to indicate how well a font can
 // represent a particular Unicode character for a particular script.
 //
-// Since 1.44, only %PANGO_COVERAGE_NONE and %PANGO_COVERAGE_EXACT
+// Since 1.44, only [CoverageNoneValue] and [CoverageExactValue]
 // will be returned.
 type CoverageLevel int
 
@@ -41,8 +42,53 @@ const (
 	CoverageExactValue CoverageLevel = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x CoverageLevel) String() string {
+	switch x {
+	case CoverageNoneValue:
+		return "PANGO_COVERAGE_NONE"
+	case CoverageFallbackValue:
+		return "PANGO_COVERAGE_FALLBACK"
+	case CoverageApproximateValue:
+		return "PANGO_COVERAGE_APPROXIMATE"
+	case CoverageExactValue:
+		return "PANGO_COVERAGE_EXACT"
+	}
+	return fmt.Sprintf("CoverageLevel(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x CoverageLevel) Nick() string {
+	switch x {
+	case CoverageNoneValue:
+		return "none"
+	case CoverageFallbackValue:
+		return "fallback"
+	case CoverageApproximateValue:
+		return "approximate"
+	case CoverageExactValue:
+		return "exact"
+	}
+	return ""
+}
+
+// CoverageLevelFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func CoverageLevelFromString(s string) (CoverageLevel, bool) {
+	switch s {
+	case "PANGO_COVERAGE_NONE", "none":
+		return CoverageNoneValue, true
+	case "PANGO_COVERAGE_FALLBACK", "fallback":
+		return CoverageFallbackValue, true
+	case "PANGO_COVERAGE_APPROXIMATE", "approximate":
+		return CoverageApproximateValue, true
+	case "PANGO_COVERAGE_EXACT", "exact":
+		return CoverageExactValue, true
+	}
+	return 0, false
+}
+
 // A `PangoCoverage` structure is a map from Unicode characters
-// to [enum@Pango.CoverageLevel] values.
+// to [CoverageLevel] values.
 //
 // It is often necessary in Pango to determine if a particular
 // font can represent a particular character, and also how well
@@ -99,69 +145,22 @@ func (x *Coverage) Copy() *Coverage {
 
 var xCoverageGet func(uintptr, int) CoverageLevel
 
-// Determine whether a particular index is covered by @coverage.
+// Determine whether a particular index is covered by coverage.
 func (x *Coverage) Get(IndexVar int) CoverageLevel {
 
 	cret := xCoverageGet(x.GoPointer(), IndexVar)
 	return cret
 }
 
-var xCoverageMax func(uintptr, uintptr)
-
-// Set the coverage for each index in @coverage to be the max (better)
-// value of the current coverage for the index and the coverage for
-// the corresponding index in @other.
-func (x *Coverage) Max(OtherVar *Coverage) {
-
-	xCoverageMax(x.GoPointer(), OtherVar.GoPointer())
-
-}
-
-var xCoverageRef func(uintptr) uintptr
-
-// Increase the reference count on the `PangoCoverage` by one.
-func (x *Coverage) Ref() *Coverage {
-	var cls *Coverage
-
-	cret := xCoverageRef(x.GoPointer())
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Coverage{}
-	cls.Ptr = cret
-	return cls
-}
-
 var xCoverageSet func(uintptr, int, CoverageLevel)
 
-// Modify a particular index within @coverage
+// Modify a particular index within coverage
 func (x *Coverage) Set(IndexVar int, LevelVar CoverageLevel) {
 
 	xCoverageSet(x.GoPointer(), IndexVar, LevelVar)
 
 }
 
-var xCoverageToBytes func(uintptr, *[]byte, *int)
-
-// Convert a `PangoCoverage` structure into a flat binary format.
-func (x *Coverage) ToBytes(BytesVar *[]byte, NBytesVar *int) {
-
-	xCoverageToBytes(x.GoPointer(), BytesVar, NBytesVar)
-
-}
-
-var xCoverageUnref func(uintptr)
-
-// Decrease the reference count on the `PangoCoverage` by one.
-//
-// If the result is zero, free the coverage and all associated memory.
-func (x *Coverage) Unref() {
-
-	xCoverageUnref(x.GoPointer())
-
-}
-
 func (c *Coverage) GoPointer() uintptr {
 	if c == nil {
 		return 0
@@ -173,29 +172,12 @@ func (c *Coverage) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-var xCoverageFromBytes func([]byte, int) uintptr
-
-// Convert data generated from [method@Pango.Coverage.to_bytes]
-// back to a `PangoCoverage`.
-func CoverageFromBytes(BytesVar []byte, NBytesVar int) *Coverage {
-	var cls *Coverage
-
-	cret := xCoverageFromBytes(BytesVar, NBytesVar)
-
-	if cret == 0 {
-		return nil
-	}
-	cls = &Coverage{}
-	cls.Ptr = cret
-	return cls
-}
-
 func init() {
 	core.SetPackageName("PANGO", "pango")
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -206,16 +188,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xCoverageGLibType, libs, "pango_coverage_get_type")
 
-	core.PuregoSafeRegister(&xNewCoverage, libs, "pango_coverage_new")
-
-	core.PuregoSafeRegister(&xCoverageCopy, libs, "pango_coverage_copy")
-	core.PuregoSafeRegister(&xCoverageGet, libs, "pango_coverage_get")
-	core.PuregoSafeRegister(&xCoverageMax, libs, "pango_coverage_max")
-	core.PuregoSafeRegister(&xCoverageRef, libs, "pango_coverage_ref")
-	core.PuregoSafeRegister(&xCoverageSet, libs, "pango_coverage_set")
-	core.PuregoSafeRegister(&xCoverageToBytes, libs, "pango_coverage_to_bytes")
-	core.PuregoSafeRegister(&xCoverageUnref, libs, "pango_coverage_unref")
+	core.PuregoRegisterSince(&xNewCoverage, libs, "pango_coverage_new", "PANGO", "")
 
-	core.PuregoSafeRegister(&xCoverageFromBytes, libs, "pango_coverage_from_bytes")
+	core.PuregoRegisterSince(&xCoverageCopy, libs, "pango_coverage_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xCoverageGet, libs, "pango_coverage_get", "PANGO", "")
+	core.PuregoRegisterSince(&xCoverageSet, libs, "pango_coverage_set", "PANGO", "")
 
 }
@@ -0,0 +1,103 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xCoverageMax func(uintptr, uintptr)
+
+// Set the coverage for each index in coverage to be the max (better)
+// value of the current coverage for the index and the coverage for
+// the corresponding index in other.
+//
+// Deprecated: since 1.44. This function does nothing
+func (x *Coverage) Max(OtherVar *Coverage) {
+
+	xCoverageMax(x.GoPointer(), OtherVar.GoPointer())
+
+}
+
+var xCoverageRef func(uintptr) uintptr
+
+// Increase the reference count on the `PangoCoverage` by one.
+//
+// Deprecated: since 1.52. Use g_object_ref instead
+func (x *Coverage) Ref() *Coverage {
+	var cls *Coverage
+
+	cret := xCoverageRef(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Coverage{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xCoverageToBytes func(uintptr, *[]byte, *int)
+
+// Convert a `PangoCoverage` structure into a flat binary format.
+//
+// Deprecated: since 1.44. This returns nil
+func (x *Coverage) ToBytes(BytesVar *[]byte, NBytesVar *int) {
+
+	xCoverageToBytes(x.GoPointer(), BytesVar, NBytesVar)
+
+}
+
+var xCoverageUnref func(uintptr)
+
+// Decrease the reference count on the `PangoCoverage` by one.
+//
+// If the result is zero, free the coverage and all associated memory.
+//
+// Deprecated: since 1.52. Use g_object_unref instead
+func (x *Coverage) Unref() {
+
+	xCoverageUnref(x.GoPointer())
+
+}
+
+var xCoverageFromBytes func([]byte, int) uintptr
+
+// Convert data generated from [Coverage.ToBytes]
+// back to a `PangoCoverage`.
+//
+// Deprecated: since 1.44. This returns nil
+func CoverageFromBytes(BytesVar []byte, NBytesVar int) *Coverage {
+	var cls *Coverage
+
+	cret := xCoverageFromBytes(BytesVar, NBytesVar)
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Coverage{}
+	cls.Ptr = cret
+	return cls
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xCoverageMax, libs, "pango_coverage_max", "PANGO", "")
+	core.PuregoRegisterSince(&xCoverageRef, libs, "pango_coverage_ref", "PANGO", "")
+	core.PuregoRegisterSince(&xCoverageToBytes, libs, "pango_coverage_to_bytes", "PANGO", "")
+	core.PuregoRegisterSince(&xCoverageUnref, libs, "pango_coverage_unref", "PANGO", "")
+
+	core.PuregoRegisterSince(&xCoverageFromBytes, libs, "pango_coverage_from_bytes", "PANGO", "")
+
+}
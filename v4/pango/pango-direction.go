@@ -1,17 +1,22 @@
 // Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
 package pango
 
-import "github.com/jwijenbergh/puregotk/v4/gobject/types"
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
 
 // `PangoDirection` represents a direction in the Unicode bidirectional
 // algorithm.
 //
 // Not every value in this enumeration makes sense for every usage of
-// `PangoDirection`; for example, the return value of [func@unichar_direction]
-// and [func@find_base_dir] cannot be `PANGO_DIRECTION_WEAK_LTR` or
+// `PangoDirection`; for example, the return value of unichar_direction
+// and find_base_dir cannot be `PANGO_DIRECTION_WEAK_LTR` or
 // `PANGO_DIRECTION_WEAK_RTL`, since every character is either neutral
 // or has a strong direction; on the other hand `PANGO_DIRECTION_NEUTRAL`
-// doesn't make sense to pass to [func@itemize_with_base_dir].
+// doesn't make sense to pass to itemize_with_base_dir.
 //
 // The `PANGO_DIRECTION_TTB_LTR`, `PANGO_DIRECTION_TTB_RTL` values come from
 // an earlier interpretation of this enumeration as the writing direction
@@ -48,3 +53,82 @@ const (
 	// No direction specified
 	DirectionNeutralValue Direction = 6
 )
+
+// String returns the C name of the value, e.g. for debugging output
+func (x Direction) String() string {
+	switch x {
+	case DirectionLtrValue:
+		return "PANGO_DIRECTION_LTR"
+	case DirectionRtlValue:
+		return "PANGO_DIRECTION_RTL"
+	case DirectionTtbLtrValue:
+		return "PANGO_DIRECTION_TTB_LTR"
+	case DirectionTtbRtlValue:
+		return "PANGO_DIRECTION_TTB_RTL"
+	case DirectionWeakLtrValue:
+		return "PANGO_DIRECTION_WEAK_LTR"
+	case DirectionWeakRtlValue:
+		return "PANGO_DIRECTION_WEAK_RTL"
+	case DirectionNeutralValue:
+		return "PANGO_DIRECTION_NEUTRAL"
+	}
+	return fmt.Sprintf("Direction(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Direction) Nick() string {
+	switch x {
+	case DirectionLtrValue:
+		return "ltr"
+	case DirectionRtlValue:
+		return "rtl"
+	case DirectionTtbLtrValue:
+		return "ttb-ltr"
+	case DirectionTtbRtlValue:
+		return "ttb-rtl"
+	case DirectionWeakLtrValue:
+		return "weak-ltr"
+	case DirectionWeakRtlValue:
+		return "weak-rtl"
+	case DirectionNeutralValue:
+		return "neutral"
+	}
+	return ""
+}
+
+// DirectionFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func DirectionFromString(s string) (Direction, bool) {
+	switch s {
+	case "PANGO_DIRECTION_LTR", "ltr":
+		return DirectionLtrValue, true
+	case "PANGO_DIRECTION_RTL", "rtl":
+		return DirectionRtlValue, true
+	case "PANGO_DIRECTION_TTB_LTR", "ttb-ltr":
+		return DirectionTtbLtrValue, true
+	case "PANGO_DIRECTION_TTB_RTL", "ttb-rtl":
+		return DirectionTtbRtlValue, true
+	case "PANGO_DIRECTION_WEAK_LTR", "weak-ltr":
+		return DirectionWeakLtrValue, true
+	case "PANGO_DIRECTION_WEAK_RTL", "weak-rtl":
+		return DirectionWeakRtlValue, true
+	case "PANGO_DIRECTION_NEUTRAL", "neutral":
+		return DirectionNeutralValue, true
+	}
+	return 0, false
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xDirectionGLibType, libs, "pango_direction_get_type")
+
+}
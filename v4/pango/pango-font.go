@@ -2,10 +2,11 @@
 package pango
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -44,7 +45,8 @@ func (x *FontClass) OverrideDescribe(cb func(*Font) *FontDescription) {
 	if cb == nil {
 		x.xDescribe = 0
 	} else {
-		x.xDescribe = purego.NewCallback(func(FontVarp uintptr) *FontDescription {
+		x.xDescribe = core.NewCallback(func(FontVarp uintptr) *FontDescription {
+			defer core.RecoverPanic()
 			return cb(FontNewFromInternalPtr(FontVarp))
 		})
 	}
@@ -56,7 +58,7 @@ func (x *FontClass) GetDescribe() func(*Font) *FontDescription {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr) *FontDescription
-	purego.RegisterFunc(&rawCallback, x.xDescribe)
+	core.RegisterFunc(&rawCallback, x.xDescribe)
 	return func(FontVar *Font) *FontDescription {
 		return rawCallback(FontVar.GoPointer())
 	}
@@ -67,7 +69,8 @@ func (x *FontClass) OverrideGetCoverage(cb func(*Font, *Language) *Coverage) {
 	if cb == nil {
 		x.xGetCoverage = 0
 	} else {
-		x.xGetCoverage = purego.NewCallback(func(FontVarp uintptr, LanguageVarp *Language) uintptr {
+		x.xGetCoverage = core.NewCallback(func(FontVarp uintptr, LanguageVarp *Language) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontNewFromInternalPtr(FontVarp), LanguageVarp)
 			if ret == nil {
 				return 0
@@ -83,7 +86,7 @@ func (x *FontClass) GetGetCoverage() func(*Font, *Language) *Coverage {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr, LanguageVarp *Language) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetCoverage)
+	core.RegisterFunc(&rawCallback, x.xGetCoverage)
 	return func(FontVar *Font, LanguageVar *Language) *Coverage {
 		rawRet := rawCallback(FontVar.GoPointer(), LanguageVar)
 		if rawRet == 0 {
@@ -100,7 +103,8 @@ func (x *FontClass) OverrideGetGlyphExtents(cb func(*Font, Glyph, *Rectangle, *R
 	if cb == nil {
 		x.xGetGlyphExtents = 0
 	} else {
-		x.xGetGlyphExtents = purego.NewCallback(func(FontVarp uintptr, GlyphVarp Glyph, InkRectVarp *Rectangle, LogicalRectVarp *Rectangle) {
+		x.xGetGlyphExtents = core.NewCallback(func(FontVarp uintptr, GlyphVarp Glyph, InkRectVarp *Rectangle, LogicalRectVarp *Rectangle) {
+			defer core.RecoverPanic()
 			cb(FontNewFromInternalPtr(FontVarp), GlyphVarp, InkRectVarp, LogicalRectVarp)
 		})
 	}
@@ -112,7 +116,7 @@ func (x *FontClass) GetGetGlyphExtents() func(*Font, Glyph, *Rectangle, *Rectang
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr, GlyphVarp Glyph, InkRectVarp *Rectangle, LogicalRectVarp *Rectangle)
-	purego.RegisterFunc(&rawCallback, x.xGetGlyphExtents)
+	core.RegisterFunc(&rawCallback, x.xGetGlyphExtents)
 	return func(FontVar *Font, GlyphVar Glyph, InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 		rawCallback(FontVar.GoPointer(), GlyphVar, InkRectVar, LogicalRectVar)
 	}
@@ -123,7 +127,8 @@ func (x *FontClass) OverrideGetMetrics(cb func(*Font, *Language) *FontMetrics) {
 	if cb == nil {
 		x.xGetMetrics = 0
 	} else {
-		x.xGetMetrics = purego.NewCallback(func(FontVarp uintptr, LanguageVarp *Language) *FontMetrics {
+		x.xGetMetrics = core.NewCallback(func(FontVarp uintptr, LanguageVarp *Language) *FontMetrics {
+			defer core.RecoverPanic()
 			return cb(FontNewFromInternalPtr(FontVarp), LanguageVarp)
 		})
 	}
@@ -135,7 +140,7 @@ func (x *FontClass) GetGetMetrics() func(*Font, *Language) *FontMetrics {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr, LanguageVarp *Language) *FontMetrics
-	purego.RegisterFunc(&rawCallback, x.xGetMetrics)
+	core.RegisterFunc(&rawCallback, x.xGetMetrics)
 	return func(FontVar *Font, LanguageVar *Language) *FontMetrics {
 		return rawCallback(FontVar.GoPointer(), LanguageVar)
 	}
@@ -146,7 +151,8 @@ func (x *FontClass) OverrideGetFontMap(cb func(*Font) *FontMap) {
 	if cb == nil {
 		x.xGetFontMap = 0
 	} else {
-		x.xGetFontMap = purego.NewCallback(func(FontVarp uintptr) uintptr {
+		x.xGetFontMap = core.NewCallback(func(FontVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontNewFromInternalPtr(FontVarp))
 			if ret == nil {
 				return 0
@@ -162,7 +168,7 @@ func (x *FontClass) GetGetFontMap() func(*Font) *FontMap {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFontMap)
+	core.RegisterFunc(&rawCallback, x.xGetFontMap)
 	return func(FontVar *Font) *FontMap {
 		rawRet := rawCallback(FontVar.GoPointer())
 		if rawRet == 0 {
@@ -179,7 +185,8 @@ func (x *FontClass) OverrideDescribeAbsolute(cb func(*Font) *FontDescription) {
 	if cb == nil {
 		x.xDescribeAbsolute = 0
 	} else {
-		x.xDescribeAbsolute = purego.NewCallback(func(FontVarp uintptr) *FontDescription {
+		x.xDescribeAbsolute = core.NewCallback(func(FontVarp uintptr) *FontDescription {
+			defer core.RecoverPanic()
 			return cb(FontNewFromInternalPtr(FontVarp))
 		})
 	}
@@ -191,7 +198,7 @@ func (x *FontClass) GetDescribeAbsolute() func(*Font) *FontDescription {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr) *FontDescription
-	purego.RegisterFunc(&rawCallback, x.xDescribeAbsolute)
+	core.RegisterFunc(&rawCallback, x.xDescribeAbsolute)
 	return func(FontVar *Font) *FontDescription {
 		return rawCallback(FontVar.GoPointer())
 	}
@@ -202,7 +209,8 @@ func (x *FontClass) OverrideGetFeatures(cb func(*Font, *[]uintptr, uint, uint))
 	if cb == nil {
 		x.xGetFeatures = 0
 	} else {
-		x.xGetFeatures = purego.NewCallback(func(FontVarp uintptr, FeaturesVarp *[]uintptr, LenVarp uint, NumFeaturesVarp uint) {
+		x.xGetFeatures = core.NewCallback(func(FontVarp uintptr, FeaturesVarp *[]uintptr, LenVarp uint, NumFeaturesVarp uint) {
+			defer core.RecoverPanic()
 			cb(FontNewFromInternalPtr(FontVarp), FeaturesVarp, LenVarp, NumFeaturesVarp)
 		})
 	}
@@ -214,7 +222,7 @@ func (x *FontClass) GetGetFeatures() func(*Font, *[]uintptr, uint, uint) {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr, FeaturesVarp *[]uintptr, LenVarp uint, NumFeaturesVarp uint)
-	purego.RegisterFunc(&rawCallback, x.xGetFeatures)
+	core.RegisterFunc(&rawCallback, x.xGetFeatures)
 	return func(FontVar *Font, FeaturesVar *[]uintptr, LenVar uint, NumFeaturesVar uint) {
 		rawCallback(FontVar.GoPointer(), FeaturesVar, LenVar, NumFeaturesVar)
 	}
@@ -225,7 +233,8 @@ func (x *FontClass) OverrideCreateHbFont(cb func(*Font) uintptr) {
 	if cb == nil {
 		x.xCreateHbFont = 0
 	} else {
-		x.xCreateHbFont = purego.NewCallback(func(FontVarp uintptr) uintptr {
+		x.xCreateHbFont = core.NewCallback(func(FontVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			return cb(FontNewFromInternalPtr(FontVarp))
 		})
 	}
@@ -237,7 +246,7 @@ func (x *FontClass) GetCreateHbFont() func(*Font) uintptr {
 		return nil
 	}
 	var rawCallback func(FontVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xCreateHbFont)
+	core.RegisterFunc(&rawCallback, x.xCreateHbFont)
 	return func(FontVar *Font) uintptr {
 		return rawCallback(FontVar.GoPointer())
 	}
@@ -274,17 +283,17 @@ func NewFontDescription() *FontDescription {
 
 var xFontDescriptionBetterMatch func(uintptr, *FontDescription, *FontDescription) bool
 
-// Determines if the style attributes of @new_match are a closer match
-// for @desc than those of @old_match are, or if @old_match is %NULL,
-// determines if @new_match is a match at all.
+// Determines if the style attributes of new_match are a closer match
+// for desc than those of old_match are, or if old_match is nil,
+// determines if new_match is a match at all.
 //
 // Approximate matching is done for weight and style; other style attributes
 // must match exactly. Style attributes are all attributes other than family
 // and size-related attributes. Approximate matching for style considers
-// %PANGO_STYLE_OBLIQUE and %PANGO_STYLE_ITALIC as matches, but not as good
+// [StyleObliqueValue] and [StyleItalicValue] as matches, but not as good
 // a match as when the styles are equal.
 //
-// Note that @old_match must match @desc.
+// Note that old_match must match desc.
 func (x *FontDescription) BetterMatch(OldMatchVar *FontDescription, NewMatchVar *FontDescription) bool {
 
 	cret := xFontDescriptionBetterMatch(x.GoPointer(), OldMatchVar, NewMatchVar)
@@ -305,9 +314,9 @@ var xFontDescriptionCopyStatic func(uintptr) *FontDescription
 // Make a copy of a `PangoFontDescription`, but don't duplicate
 // allocated fields.
 //
-// This is like [method@Pango.FontDescription.copy], but only a shallow
+// This is like [FontDescription.Copy], but only a shallow
 // copy is made of the family name and other allocated fields. The result
-// can only be used until @desc is modified or freed. This is meant
+// can only be used until desc is modified or freed. This is meant
 // to be used when the copy is only needed temporarily.
 func (x *FontDescription) CopyStatic() *FontDescription {
 
@@ -322,7 +331,7 @@ var xFontDescriptionEqual func(uintptr, *FontDescription) bool
 // Two font descriptions are considered equal if the fonts they describe
 // are provably identical. This means that their masks do not have to match,
 // as long as other fields are all the same. (Two font descriptions may
-// result in identical fonts being loaded, but still compare %FALSE.)
+// result in identical fonts being loaded, but still compare false.)
 func (x *FontDescription) Equal(Desc2Var *FontDescription) bool {
 
 	cret := xFontDescriptionEqual(x.GoPointer(), Desc2Var)
@@ -354,7 +363,7 @@ var xFontDescriptionGetFamily func(uintptr) string
 
 // Gets the family name field of a font description.
 //
-// See [method@Pango.FontDescription.set_family].
+// See [FontDescription.SetFamily].
 func (x *FontDescription) GetFamily() string {
 
 	cret := xFontDescriptionGetFamily(x.GoPointer())
@@ -365,7 +374,7 @@ var xFontDescriptionGetFeatures func(uintptr) string
 
 // Gets the features field of a font description.
 //
-// See [method@Pango.FontDescription.set_features].
+// See [FontDescription.SetFeatures].
 func (x *FontDescription) GetFeatures() string {
 
 	cret := xFontDescriptionGetFeatures(x.GoPointer())
@@ -376,7 +385,7 @@ var xFontDescriptionGetGravity func(uintptr) Gravity
 
 // Gets the gravity field of a font description.
 //
-// See [method@Pango.FontDescription.set_gravity].
+// See [FontDescription.SetGravity].
 func (x *FontDescription) GetGravity() Gravity {
 
 	cret := xFontDescriptionGetGravity(x.GoPointer())
@@ -396,7 +405,7 @@ var xFontDescriptionGetSize func(uintptr) int
 
 // Gets the size field of a font description.
 //
-// See [method@Pango.FontDescription.set_size].
+// See [FontDescription.SetSize].
 func (x *FontDescription) GetSize() int {
 
 	cret := xFontDescriptionGetSize(x.GoPointer())
@@ -408,8 +417,8 @@ var xFontDescriptionGetSizeIsAbsolute func(uintptr) bool
 // Determines whether the size of the font is in points (not absolute)
 // or device units (absolute).
 //
-// See [method@Pango.FontDescription.set_size]
-// and [method@Pango.FontDescription.set_absolute_size].
+// See [FontDescription.SetSize]
+// and [FontDescription.SetAbsoluteSize].
 func (x *FontDescription) GetSizeIsAbsolute() bool {
 
 	cret := xFontDescriptionGetSizeIsAbsolute(x.GoPointer())
@@ -420,7 +429,7 @@ var xFontDescriptionGetStretch func(uintptr) Stretch
 
 // Gets the stretch field of a font description.
 //
-// See [method@Pango.FontDescription.set_stretch].
+// See [FontDescription.SetStretch].
 func (x *FontDescription) GetStretch() Stretch {
 
 	cret := xFontDescriptionGetStretch(x.GoPointer())
@@ -431,7 +440,7 @@ var xFontDescriptionGetStyle func(uintptr) Style
 
 // Gets the style field of a `PangoFontDescription`.
 //
-// See [method@Pango.FontDescription.set_style].
+// See [FontDescription.SetStyle].
 func (x *FontDescription) GetStyle() Style {
 
 	cret := xFontDescriptionGetStyle(x.GoPointer())
@@ -442,7 +451,7 @@ var xFontDescriptionGetVariant func(uintptr) Variant
 
 // Gets the variant field of a `PangoFontDescription`.
 //
-// See [method@Pango.FontDescription.set_variant].
+// See [FontDescription.SetVariant].
 func (x *FontDescription) GetVariant() Variant {
 
 	cret := xFontDescriptionGetVariant(x.GoPointer())
@@ -453,7 +462,7 @@ var xFontDescriptionGetVariations func(uintptr) string
 
 // Gets the variations field of a font description.
 //
-// See [method@Pango.FontDescription.set_variations].
+// See [FontDescription.SetVariations].
 func (x *FontDescription) GetVariations() string {
 
 	cret := xFontDescriptionGetVariations(x.GoPointer())
@@ -464,7 +473,7 @@ var xFontDescriptionGetWeight func(uintptr) Weight
 
 // Gets the weight field of a font description.
 //
-// See [method@Pango.FontDescription.set_weight].
+// See [FontDescription.SetWeight].
 func (x *FontDescription) GetWeight() Weight {
 
 	cret := xFontDescriptionGetWeight(x.GoPointer())
@@ -476,7 +485,7 @@ var xFontDescriptionHash func(uintptr) uint
 // Computes a hash of a `PangoFontDescription` structure.
 //
 // This is suitable to be used, for example, as an argument
-// to g_hash_table_new(). The hash value is independent of @desc-&gt;mask.
+// to g_hash_table_new(). The hash value is independent of desc->mask.
 func (x *FontDescription) Hash() uint {
 
 	cret := xFontDescriptionHash(x.GoPointer())
@@ -485,14 +494,14 @@ func (x *FontDescription) Hash() uint {
 
 var xFontDescriptionMerge func(uintptr, *FontDescription, bool)
 
-// Merges the fields that are set in @desc_to_merge into the fields in
-// @desc.
+// Merges the fields that are set in desc_to_merge into the fields in
+// desc.
 //
-// If @replace_existing is %FALSE, only fields in @desc that
-// are not already set are affected. If %TRUE, then fields that are
+// If replace_existing is false, only fields in desc that
+// are not already set are affected. If true, then fields that are
 // already set will be replaced as well.
 //
-// If @desc_to_merge is %NULL, this function performs nothing.
+// If desc_to_merge is nil, this function performs nothing.
 func (x *FontDescription) Merge(DescToMergeVar *FontDescription, ReplaceExistingVar bool) {
 
 	xFontDescriptionMerge(x.GoPointer(), DescToMergeVar, ReplaceExistingVar)
@@ -501,12 +510,12 @@ func (x *FontDescription) Merge(DescToMergeVar *FontDescription, ReplaceExisting
 
 var xFontDescriptionMergeStatic func(uintptr, *FontDescription, bool)
 
-// Merges the fields that are set in @desc_to_merge into the fields in
-// @desc, without copying allocated fields.
+// Merges the fields that are set in desc_to_merge into the fields in
+// desc, without copying allocated fields.
 //
-// This is like [method@Pango.FontDescription.merge], but only a shallow copy
-// is made of the family name and other allocated fields. @desc can only
-// be used until @desc_to_merge is modified or freed. This is meant to
+// This is like [FontDescription.Merge], but only a shallow copy
+// is made of the family name and other allocated fields. desc can only
+// be used until desc_to_merge is modified or freed. This is meant to
 // be used when the merged font description is only needed temporarily.
 func (x *FontDescription) MergeStatic(DescToMergeVar *FontDescription, ReplaceExistingVar bool) {
 
@@ -518,7 +527,7 @@ var xFontDescriptionSetAbsoluteSize func(uintptr, float64)
 
 // Sets the size field of a font description, in device units.
 //
-// This is mutually exclusive with [method@Pango.FontDescription.set_size]
+// This is mutually exclusive with [FontDescription.SetSize]
 // which sets the font size in points.
 func (x *FontDescription) SetAbsoluteSize(SizeVar float64) {
 
@@ -557,11 +566,11 @@ var xFontDescriptionSetFamilyStatic func(uintptr, string)
 
 // Sets the family name field of a font description, without copying the string.
 //
-// This is like [method@Pango.FontDescription.set_family], except that no
-// copy of @family is made. The caller must make sure that the
-// string passed in stays around until @desc has been freed or the
-// name is set again. This function can be used if @family is a static
-// string such as a C string literal, or if @desc is only needed temporarily.
+// This is like [FontDescription.SetFamily], except that no
+// copy of family is made. The caller must make sure that the
+// string passed in stays around until desc has been freed or the
+// name is set again. This function can be used if family is a static
+// string such as a C string literal, or if desc is only needed temporarily.
 func (x *FontDescription) SetFamilyStatic(FamilyVar string) {
 
 	xFontDescriptionSetFamilyStatic(x.GoPointer(), FamilyVar)
@@ -591,9 +600,11 @@ var xFontDescriptionSetFeatures func(uintptr, uintptr)
 //
 // Pango does not currently have a way to find supported OpenType features
 // of a font. Both harfbuzz and freetype have API for this. See for example
-// [hb_ot_layout_table_get_feature_tags](https://harfbuzz.github.io/harfbuzz-hb-ot-layout.html#hb-ot-layout-table-get-feature-tags).
+// [hb_ot_layout_table_get_feature_tags].
 //
 // Features that are not supported by the font are silently ignored.
+//
+// [hb_ot_layout_table_get_feature_tags]: https://harfbuzz.github.io/harfbuzz-hb-ot-layout.html#hb-ot-layout-table-get-feature-tags
 func (x *FontDescription) SetFeatures(FeaturesVar *string) {
 
 	FeaturesVarPtr := core.GStrdupNullable(FeaturesVar)
@@ -607,12 +618,12 @@ var xFontDescriptionSetFeaturesStatic func(uintptr, string)
 
 // Sets the features field of a font description.
 //
-// This is like [method@Pango.FontDescription.set_features], except
-// that no copy of @featuresis made. The caller must make sure that
-// the string passed in stays around until @desc has been freed
+// This is like [FontDescription.SetFeatures], except
+// that no copy of featuresis made. The caller must make sure that
+// the string passed in stays around until desc has been freed
 // or the name is set again. This function can be used if
-// @features is a static string such as a C string literal,
-// or if @desc is only needed temporarily.
+// features is a static string such as a C string literal,
+// or if desc is only needed temporarily.
 func (x *FontDescription) SetFeaturesStatic(FeaturesVar string) {
 
 	xFontDescriptionSetFeaturesStatic(x.GoPointer(), FeaturesVar)
@@ -624,8 +635,8 @@ var xFontDescriptionSetGravity func(uintptr, Gravity)
 // Sets the gravity field of a font description.
 //
 // The gravity field
-// specifies how the glyphs should be rotated. If @gravity is
-// %PANGO_GRAVITY_AUTO, this actually unsets the gravity mask on
+// specifies how the glyphs should be rotated. If gravity is
+// [GravityAutoValue], this actually unsets the gravity mask on
 // the font description.
 //
 // This function is seldom useful to the user. Gravity should normally
@@ -641,7 +652,7 @@ var xFontDescriptionSetSize func(uintptr, int)
 // Sets the size field of a font description in fractional points.
 //
 // This is mutually exclusive with
-// [method@Pango.FontDescription.set_absolute_size].
+// [FontDescription.SetAbsoluteSize].
 func (x *FontDescription) SetSize(SizeVar int) {
 
 	xFontDescriptionSetSize(x.GoPointer(), SizeVar)
@@ -652,7 +663,7 @@ var xFontDescriptionSetStretch func(uintptr, Stretch)
 
 // Sets the stretch field of a font description.
 //
-// The [enum@Pango.Stretch] field specifies how narrow or
+// The [Stretch] field specifies how narrow or
 // wide the font should be.
 func (x *FontDescription) SetStretch(StretchVar Stretch) {
 
@@ -664,9 +675,9 @@ var xFontDescriptionSetStyle func(uintptr, Style)
 
 // Sets the style field of a `PangoFontDescription`.
 //
-// The [enum@Pango.Style] enumeration describes whether the font is
+// The [Style] enumeration describes whether the font is
 // slanted and the manner in which it is slanted; it can be either
-// %PANGO_STYLE_NORMAL, %PANGO_STYLE_ITALIC, or %PANGO_STYLE_OBLIQUE.
+// [StyleNormalValue], [StyleItalicValue], or [StyleObliqueValue].
 //
 // Most fonts will either have a italic style or an oblique style,
 // but not both, and font matching in Pango will match italic
@@ -682,8 +693,8 @@ var xFontDescriptionSetVariant func(uintptr, Variant)
 
 // Sets the variant field of a font description.
 //
-// The [enum@Pango.Variant] can either be %PANGO_VARIANT_NORMAL
-// or %PANGO_VARIANT_SMALL_CAPS.
+// The [Variant] can either be [VariantNormalValue]
+// or [VariantSmallCapsValue].
 func (x *FontDescription) SetVariant(VariantVar Variant) {
 
 	xFontDescriptionSetVariant(x.GoPointer(), VariantVar)
@@ -707,7 +718,9 @@ var xFontDescriptionSetVariations func(uintptr, uintptr)
 //
 // Pango does not currently have a way to find supported axes of
 // a font. Both harfbuzz and freetype have API for this. See
-// for example [hb_ot_var_get_axis_infos](https://harfbuzz.github.io/harfbuzz-hb-ot-var.html#hb-ot-var-get-axis-infos).
+// for example [hb_ot_var_get_axis_infos].
+//
+// [hb_ot_var_get_axis_infos]: https://harfbuzz.github.io/harfbuzz-hb-ot-var.html#hb-ot-var-get-axis-infos
 func (x *FontDescription) SetVariations(VariationsVar *string) {
 
 	VariationsVarPtr := core.GStrdupNullable(VariationsVar)
@@ -721,12 +734,12 @@ var xFontDescriptionSetVariationsStatic func(uintptr, string)
 
 // Sets the variations field of a font description.
 //
-// This is like [method@Pango.FontDescription.set_variations], except
-// that no copy of @variations is made. The caller must make sure that
-// the string passed in stays around until @desc has been freed
+// This is like [FontDescription.SetVariations], except
+// that no copy of variations is made. The caller must make sure that
+// the string passed in stays around until desc has been freed
 // or the name is set again. This function can be used if
-// @variations is a static string such as a C string literal,
-// or if @desc is only needed temporarily.
+// variations is a static string such as a C string literal,
+// or if desc is only needed temporarily.
 func (x *FontDescription) SetVariationsStatic(VariationsVar string) {
 
 	xFontDescriptionSetVariationsStatic(x.GoPointer(), VariationsVar)
@@ -739,7 +752,7 @@ var xFontDescriptionSetWeight func(uintptr, Weight)
 //
 // The weight field
 // specifies how bold or light the font should be. In addition
-// to the values of the [enum@Pango.Weight] enumeration, other
+// to the values of the [Weight] enumeration, other
 // intermediate numeric values are possible.
 func (x *FontDescription) SetWeight(WeightVar Weight) {
 
@@ -752,7 +765,7 @@ var xFontDescriptionToFilename func(uintptr) string
 // Creates a filename representation of a font description.
 //
 // The filename is identical to the result from calling
-// [method@Pango.FontDescription.to_string], but with underscores
+// [FontDescription.ToString], but with underscores
 // instead of characters that are untypical in filenames, and in
 // lower case only.
 func (x *FontDescription) ToFilename() string {
@@ -765,7 +778,7 @@ var xFontDescriptionToString func(uintptr) string
 
 // Creates a string representation of a font description.
 //
-// See [func@Pango.FontDescription.from_string] for a description
+// See [FontDescriptionFromString] for a description
 // of the format of the string representation. The family list in
 // the string description will only have a terminating comma if
 // the last word of the list is a valid style option.
@@ -815,7 +828,8 @@ func (x *FontFaceClass) OverrideGetFaceName(cb func(*FontFace) string) {
 	if cb == nil {
 		x.xGetFaceName = 0
 	} else {
-		x.xGetFaceName = purego.NewCallback(func(FaceVarp uintptr) string {
+		x.xGetFaceName = core.NewCallback(func(FaceVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(FontFaceNewFromInternalPtr(FaceVarp))
 		})
 	}
@@ -827,7 +841,7 @@ func (x *FontFaceClass) GetGetFaceName() func(*FontFace) string {
 		return nil
 	}
 	var rawCallback func(FaceVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetFaceName)
+	core.RegisterFunc(&rawCallback, x.xGetFaceName)
 	return func(FaceVar *FontFace) string {
 		return rawCallback(FaceVar.GoPointer())
 	}
@@ -838,7 +852,8 @@ func (x *FontFaceClass) OverrideDescribe(cb func(*FontFace) *FontDescription) {
 	if cb == nil {
 		x.xDescribe = 0
 	} else {
-		x.xDescribe = purego.NewCallback(func(FaceVarp uintptr) *FontDescription {
+		x.xDescribe = core.NewCallback(func(FaceVarp uintptr) *FontDescription {
+			defer core.RecoverPanic()
 			return cb(FontFaceNewFromInternalPtr(FaceVarp))
 		})
 	}
@@ -850,7 +865,7 @@ func (x *FontFaceClass) GetDescribe() func(*FontFace) *FontDescription {
 		return nil
 	}
 	var rawCallback func(FaceVarp uintptr) *FontDescription
-	purego.RegisterFunc(&rawCallback, x.xDescribe)
+	core.RegisterFunc(&rawCallback, x.xDescribe)
 	return func(FaceVar *FontFace) *FontDescription {
 		return rawCallback(FaceVar.GoPointer())
 	}
@@ -861,7 +876,8 @@ func (x *FontFaceClass) OverrideListSizes(cb func(*FontFace, *[]int, *int)) {
 	if cb == nil {
 		x.xListSizes = 0
 	} else {
-		x.xListSizes = purego.NewCallback(func(FaceVarp uintptr, SizesVarp *[]int, NSizesVarp *int) {
+		x.xListSizes = core.NewCallback(func(FaceVarp uintptr, SizesVarp *[]int, NSizesVarp *int) {
+			defer core.RecoverPanic()
 			cb(FontFaceNewFromInternalPtr(FaceVarp), SizesVarp, NSizesVarp)
 		})
 	}
@@ -873,7 +889,7 @@ func (x *FontFaceClass) GetListSizes() func(*FontFace, *[]int, *int) {
 		return nil
 	}
 	var rawCallback func(FaceVarp uintptr, SizesVarp *[]int, NSizesVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xListSizes)
+	core.RegisterFunc(&rawCallback, x.xListSizes)
 	return func(FaceVar *FontFace, SizesVar *[]int, NSizesVar *int) {
 		rawCallback(FaceVar.GoPointer(), SizesVar, NSizesVar)
 	}
@@ -884,7 +900,8 @@ func (x *FontFaceClass) OverrideIsSynthesized(cb func(*FontFace) bool) {
 	if cb == nil {
 		x.xIsSynthesized = 0
 	} else {
-		x.xIsSynthesized = purego.NewCallback(func(FaceVarp uintptr) bool {
+		x.xIsSynthesized = core.NewCallback(func(FaceVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FontFaceNewFromInternalPtr(FaceVarp))
 		})
 	}
@@ -896,7 +913,7 @@ func (x *FontFaceClass) GetIsSynthesized() func(*FontFace) bool {
 		return nil
 	}
 	var rawCallback func(FaceVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsSynthesized)
+	core.RegisterFunc(&rawCallback, x.xIsSynthesized)
 	return func(FaceVar *FontFace) bool {
 		return rawCallback(FaceVar.GoPointer())
 	}
@@ -907,7 +924,8 @@ func (x *FontFaceClass) OverrideGetFamily(cb func(*FontFace) *FontFamily) {
 	if cb == nil {
 		x.xGetFamily = 0
 	} else {
-		x.xGetFamily = purego.NewCallback(func(FaceVarp uintptr) uintptr {
+		x.xGetFamily = core.NewCallback(func(FaceVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontFaceNewFromInternalPtr(FaceVarp))
 			if ret == nil {
 				return 0
@@ -923,7 +941,7 @@ func (x *FontFaceClass) GetGetFamily() func(*FontFace) *FontFamily {
 		return nil
 	}
 	var rawCallback func(FaceVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFamily)
+	core.RegisterFunc(&rawCallback, x.xGetFamily)
 	return func(FaceVar *FontFace) *FontFamily {
 		rawRet := rawCallback(FaceVar.GoPointer())
 		if rawRet == 0 {
@@ -940,7 +958,8 @@ func (x *FontFaceClass) OverridePangoReserved3(cb func()) {
 	if cb == nil {
 		x.xPangoReserved3 = 0
 	} else {
-		x.xPangoReserved3 = purego.NewCallback(func() {
+		x.xPangoReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -952,7 +971,7 @@ func (x *FontFaceClass) GetPangoReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved3)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -963,7 +982,8 @@ func (x *FontFaceClass) OverridePangoReserved4(cb func()) {
 	if cb == nil {
 		x.xPangoReserved4 = 0
 	} else {
-		x.xPangoReserved4 = purego.NewCallback(func() {
+		x.xPangoReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -975,7 +995,7 @@ func (x *FontFaceClass) GetPangoReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved4)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -1008,7 +1028,8 @@ func (x *FontFamilyClass) OverrideListFaces(cb func(*FontFamily, *uintptr, *int)
 	if cb == nil {
 		x.xListFaces = 0
 	} else {
-		x.xListFaces = purego.NewCallback(func(FamilyVarp uintptr, FacesVarp *uintptr, NFacesVarp *int) {
+		x.xListFaces = core.NewCallback(func(FamilyVarp uintptr, FacesVarp *uintptr, NFacesVarp *int) {
+			defer core.RecoverPanic()
 			cb(FontFamilyNewFromInternalPtr(FamilyVarp), FacesVarp, NFacesVarp)
 		})
 	}
@@ -1020,7 +1041,7 @@ func (x *FontFamilyClass) GetListFaces() func(*FontFamily, *uintptr, *int) {
 		return nil
 	}
 	var rawCallback func(FamilyVarp uintptr, FacesVarp *uintptr, NFacesVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xListFaces)
+	core.RegisterFunc(&rawCallback, x.xListFaces)
 	return func(FamilyVar *FontFamily, FacesVar *uintptr, NFacesVar *int) {
 		rawCallback(FamilyVar.GoPointer(), FacesVar, NFacesVar)
 	}
@@ -1031,7 +1052,8 @@ func (x *FontFamilyClass) OverrideGetName(cb func(*FontFamily) string) {
 	if cb == nil {
 		x.xGetName = 0
 	} else {
-		x.xGetName = purego.NewCallback(func(FamilyVarp uintptr) string {
+		x.xGetName = core.NewCallback(func(FamilyVarp uintptr) string {
+			defer core.RecoverPanic()
 			return cb(FontFamilyNewFromInternalPtr(FamilyVarp))
 		})
 	}
@@ -1043,7 +1065,7 @@ func (x *FontFamilyClass) GetGetName() func(*FontFamily) string {
 		return nil
 	}
 	var rawCallback func(FamilyVarp uintptr) string
-	purego.RegisterFunc(&rawCallback, x.xGetName)
+	core.RegisterFunc(&rawCallback, x.xGetName)
 	return func(FamilyVar *FontFamily) string {
 		return rawCallback(FamilyVar.GoPointer())
 	}
@@ -1054,7 +1076,8 @@ func (x *FontFamilyClass) OverrideIsMonospace(cb func(*FontFamily) bool) {
 	if cb == nil {
 		x.xIsMonospace = 0
 	} else {
-		x.xIsMonospace = purego.NewCallback(func(FamilyVarp uintptr) bool {
+		x.xIsMonospace = core.NewCallback(func(FamilyVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FontFamilyNewFromInternalPtr(FamilyVarp))
 		})
 	}
@@ -1066,7 +1089,7 @@ func (x *FontFamilyClass) GetIsMonospace() func(*FontFamily) bool {
 		return nil
 	}
 	var rawCallback func(FamilyVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsMonospace)
+	core.RegisterFunc(&rawCallback, x.xIsMonospace)
 	return func(FamilyVar *FontFamily) bool {
 		return rawCallback(FamilyVar.GoPointer())
 	}
@@ -1077,7 +1100,8 @@ func (x *FontFamilyClass) OverrideIsVariable(cb func(*FontFamily) bool) {
 	if cb == nil {
 		x.xIsVariable = 0
 	} else {
-		x.xIsVariable = purego.NewCallback(func(FamilyVarp uintptr) bool {
+		x.xIsVariable = core.NewCallback(func(FamilyVarp uintptr) bool {
+			defer core.RecoverPanic()
 			return cb(FontFamilyNewFromInternalPtr(FamilyVarp))
 		})
 	}
@@ -1089,7 +1113,7 @@ func (x *FontFamilyClass) GetIsVariable() func(*FontFamily) bool {
 		return nil
 	}
 	var rawCallback func(FamilyVarp uintptr) bool
-	purego.RegisterFunc(&rawCallback, x.xIsVariable)
+	core.RegisterFunc(&rawCallback, x.xIsVariable)
 	return func(FamilyVar *FontFamily) bool {
 		return rawCallback(FamilyVar.GoPointer())
 	}
@@ -1100,7 +1124,8 @@ func (x *FontFamilyClass) OverrideGetFace(cb func(*FontFamily, string) *FontFace
 	if cb == nil {
 		x.xGetFace = 0
 	} else {
-		x.xGetFace = purego.NewCallback(func(FamilyVarp uintptr, NameVarp string) uintptr {
+		x.xGetFace = core.NewCallback(func(FamilyVarp uintptr, NameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontFamilyNewFromInternalPtr(FamilyVarp), NameVarp)
 			if ret == nil {
 				return 0
@@ -1116,7 +1141,7 @@ func (x *FontFamilyClass) GetGetFace() func(*FontFamily, string) *FontFace {
 		return nil
 	}
 	var rawCallback func(FamilyVarp uintptr, NameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFace)
+	core.RegisterFunc(&rawCallback, x.xGetFace)
 	return func(FamilyVar *FontFamily, NameVar string) *FontFace {
 		rawRet := rawCallback(FamilyVar.GoPointer(), NameVar)
 		if rawRet == 0 {
@@ -1133,7 +1158,8 @@ func (x *FontFamilyClass) OverridePangoReserved2(cb func()) {
 	if cb == nil {
 		x.xPangoReserved2 = 0
 	} else {
-		x.xPangoReserved2 = purego.NewCallback(func() {
+		x.xPangoReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -1145,7 +1171,7 @@ func (x *FontFamilyClass) GetPangoReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved2)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -1160,13 +1186,6 @@ func (x *FontFamilyClass) GetPangoReserved2() func() {
 // for documentation of their meaning.
 //
 // For an overview of the most important metrics, see:
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="fontmetrics-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Font metrics" src="fontmetrics-light.png"&gt;
-//
-// &lt;/picture&gt;
 type FontMetrics struct {
 	_ structs.HostLayout
 
@@ -1222,7 +1241,7 @@ var xFontMetricsGetApproximateDigitWidth func(uintptr) int
 // determining the initial size for a window. Actual digits in
 // text can be wider or narrower than this, though this value
 // is generally somewhat more accurate than the result of
-// pango_font_metrics_get_approximate_char_width() for digits.
+// [FontMetrics.GetApproximateCharWidth] for digits.
 func (x *FontMetrics) GetApproximateDigitWidth() int {
 
 	cret := xFontMetricsGetApproximateDigitWidth(x.GoPointer())
@@ -1338,14 +1357,14 @@ const (
 	// A `PangoGlyph` value that indicates a zero-width empty glpyh.
 	//
 	// This is useful for example in shaper modules, to use as the glyph for
-	// various zero-width Unicode characters (those passing [func@is_zero_width]).
+	// various zero-width Unicode characters (those passing is_zero_width).
 	GLYPH_EMPTY Glyph = 268435455
 	// A `PangoGlyph` value for invalid input.
 	//
 	// `PangoLayout` produces one such glyph per invalid input UTF-8 byte and such
 	// a glyph is rendered as a crossed box.
 	//
-	// Note that this value is defined such that it has the %PANGO_GLYPH_UNKNOWN_FLAG
+	// Note that this value is defined such that it has the [GLYPH_UNKNOWN_FLAG]
 	// set.
 	GLYPH_INVALID_INPUT Glyph = 4294967295
 	// Flag used in `PangoGlyph` to turn a `gunichar` value of a valid Unicode
@@ -1357,7 +1376,7 @@ const (
 
 // The bits in a `PangoFontMask` correspond to the set fields in a
 // `PangoFontDescription`.
-type FontMask int
+type FontMask uint32
 
 var xFontMaskGLibType func() types.GType
 
@@ -1389,6 +1408,79 @@ const (
 	FontMaskColorValue FontMask = 512
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (FontMask) GLibType() types.GType {
+	return xFontMaskGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x FontMask) Has(flags FontMask) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x FontMask) Set(flags FontMask) FontMask {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x FontMask) Clear(flags FontMask) FontMask {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x FontMask) String() string {
+	if x == 0 {
+		return "0"
+	}
+	var names []string
+	rest := x
+	if rest&FontMaskFamilyValue != 0 && x&FontMaskFamilyValue == FontMaskFamilyValue {
+		names = append(names, "PANGO_FONT_MASK_FAMILY")
+		rest &^= FontMaskFamilyValue
+	}
+	if rest&FontMaskStyleValue != 0 && x&FontMaskStyleValue == FontMaskStyleValue {
+		names = append(names, "PANGO_FONT_MASK_STYLE")
+		rest &^= FontMaskStyleValue
+	}
+	if rest&FontMaskVariantValue != 0 && x&FontMaskVariantValue == FontMaskVariantValue {
+		names = append(names, "PANGO_FONT_MASK_VARIANT")
+		rest &^= FontMaskVariantValue
+	}
+	if rest&FontMaskWeightValue != 0 && x&FontMaskWeightValue == FontMaskWeightValue {
+		names = append(names, "PANGO_FONT_MASK_WEIGHT")
+		rest &^= FontMaskWeightValue
+	}
+	if rest&FontMaskStretchValue != 0 && x&FontMaskStretchValue == FontMaskStretchValue {
+		names = append(names, "PANGO_FONT_MASK_STRETCH")
+		rest &^= FontMaskStretchValue
+	}
+	if rest&FontMaskSizeValue != 0 && x&FontMaskSizeValue == FontMaskSizeValue {
+		names = append(names, "PANGO_FONT_MASK_SIZE")
+		rest &^= FontMaskSizeValue
+	}
+	if rest&FontMaskGravityValue != 0 && x&FontMaskGravityValue == FontMaskGravityValue {
+		names = append(names, "PANGO_FONT_MASK_GRAVITY")
+		rest &^= FontMaskGravityValue
+	}
+	if rest&FontMaskVariationsValue != 0 && x&FontMaskVariationsValue == FontMaskVariationsValue {
+		names = append(names, "PANGO_FONT_MASK_VARIATIONS")
+		rest &^= FontMaskVariationsValue
+	}
+	if rest&FontMaskFeaturesValue != 0 && x&FontMaskFeaturesValue == FontMaskFeaturesValue {
+		names = append(names, "PANGO_FONT_MASK_FEATURES")
+		rest &^= FontMaskFeaturesValue
+	}
+	if rest&FontMaskColorValue != 0 && x&FontMaskColorValue == FontMaskColorValue {
+		names = append(names, "PANGO_FONT_MASK_COLOR")
+		rest &^= FontMaskColorValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // Specifies whether a font should or should not have color glyphs.
 type FontColor int
 
@@ -1408,6 +1500,45 @@ const (
 	FontColorDontCareValue FontColor = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x FontColor) String() string {
+	switch x {
+	case FontColorForbiddenValue:
+		return "PANGO_FONT_COLOR_FORBIDDEN"
+	case FontColorRequiredValue:
+		return "PANGO_FONT_COLOR_REQUIRED"
+	case FontColorDontCareValue:
+		return "PANGO_FONT_COLOR_DONT_CARE"
+	}
+	return fmt.Sprintf("FontColor(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x FontColor) Nick() string {
+	switch x {
+	case FontColorForbiddenValue:
+		return "forbidden"
+	case FontColorRequiredValue:
+		return "required"
+	case FontColorDontCareValue:
+		return "dont-care"
+	}
+	return ""
+}
+
+// FontColorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func FontColorFromString(s string) (FontColor, bool) {
+	switch s {
+	case "PANGO_FONT_COLOR_FORBIDDEN", "forbidden":
+		return FontColorForbiddenValue, true
+	case "PANGO_FONT_COLOR_REQUIRED", "required":
+		return FontColorRequiredValue, true
+	case "PANGO_FONT_COLOR_DONT_CARE", "dont-care":
+		return FontColorDontCareValue, true
+	}
+	return 0, false
+}
+
 // An enumeration specifying the width of the font relative to other designs
 // within a family.
 type Stretch int
@@ -1440,6 +1571,81 @@ const (
 	StretchUltraExpandedValue Stretch = 8
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Stretch) String() string {
+	switch x {
+	case StretchUltraCondensedValue:
+		return "PANGO_STRETCH_ULTRA_CONDENSED"
+	case StretchExtraCondensedValue:
+		return "PANGO_STRETCH_EXTRA_CONDENSED"
+	case StretchCondensedValue:
+		return "PANGO_STRETCH_CONDENSED"
+	case StretchSemiCondensedValue:
+		return "PANGO_STRETCH_SEMI_CONDENSED"
+	case StretchNormalValue:
+		return "PANGO_STRETCH_NORMAL"
+	case StretchSemiExpandedValue:
+		return "PANGO_STRETCH_SEMI_EXPANDED"
+	case StretchExpandedValue:
+		return "PANGO_STRETCH_EXPANDED"
+	case StretchExtraExpandedValue:
+		return "PANGO_STRETCH_EXTRA_EXPANDED"
+	case StretchUltraExpandedValue:
+		return "PANGO_STRETCH_ULTRA_EXPANDED"
+	}
+	return fmt.Sprintf("Stretch(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Stretch) Nick() string {
+	switch x {
+	case StretchUltraCondensedValue:
+		return "ultra-condensed"
+	case StretchExtraCondensedValue:
+		return "extra-condensed"
+	case StretchCondensedValue:
+		return "condensed"
+	case StretchSemiCondensedValue:
+		return "semi-condensed"
+	case StretchNormalValue:
+		return "normal"
+	case StretchSemiExpandedValue:
+		return "semi-expanded"
+	case StretchExpandedValue:
+		return "expanded"
+	case StretchExtraExpandedValue:
+		return "extra-expanded"
+	case StretchUltraExpandedValue:
+		return "ultra-expanded"
+	}
+	return ""
+}
+
+// StretchFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StretchFromString(s string) (Stretch, bool) {
+	switch s {
+	case "PANGO_STRETCH_ULTRA_CONDENSED", "ultra-condensed":
+		return StretchUltraCondensedValue, true
+	case "PANGO_STRETCH_EXTRA_CONDENSED", "extra-condensed":
+		return StretchExtraCondensedValue, true
+	case "PANGO_STRETCH_CONDENSED", "condensed":
+		return StretchCondensedValue, true
+	case "PANGO_STRETCH_SEMI_CONDENSED", "semi-condensed":
+		return StretchSemiCondensedValue, true
+	case "PANGO_STRETCH_NORMAL", "normal":
+		return StretchNormalValue, true
+	case "PANGO_STRETCH_SEMI_EXPANDED", "semi-expanded":
+		return StretchSemiExpandedValue, true
+	case "PANGO_STRETCH_EXPANDED", "expanded":
+		return StretchExpandedValue, true
+	case "PANGO_STRETCH_EXTRA_EXPANDED", "extra-expanded":
+		return StretchExtraExpandedValue, true
+	case "PANGO_STRETCH_ULTRA_EXPANDED", "ultra-expanded":
+		return StretchUltraExpandedValue, true
+	}
+	return 0, false
+}
+
 // An enumeration specifying the various slant styles possible for a font.
 type Style int
 
@@ -1459,6 +1665,45 @@ const (
 	StyleItalicValue Style = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Style) String() string {
+	switch x {
+	case StyleNormalValue:
+		return "PANGO_STYLE_NORMAL"
+	case StyleObliqueValue:
+		return "PANGO_STYLE_OBLIQUE"
+	case StyleItalicValue:
+		return "PANGO_STYLE_ITALIC"
+	}
+	return fmt.Sprintf("Style(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Style) Nick() string {
+	switch x {
+	case StyleNormalValue:
+		return "normal"
+	case StyleObliqueValue:
+		return "oblique"
+	case StyleItalicValue:
+		return "italic"
+	}
+	return ""
+}
+
+// StyleFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func StyleFromString(s string) (Style, bool) {
+	switch s {
+	case "PANGO_STYLE_NORMAL", "normal":
+		return StyleNormalValue, true
+	case "PANGO_STYLE_OBLIQUE", "oblique":
+		return StyleObliqueValue, true
+	case "PANGO_STYLE_ITALIC", "italic":
+		return StyleItalicValue, true
+	}
+	return 0, false
+}
+
 // An enumeration specifying capitalization variant of the font.
 type Variant int
 
@@ -1494,6 +1739,69 @@ const (
 	VariantTitleCapsValue Variant = 6
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Variant) String() string {
+	switch x {
+	case VariantNormalValue:
+		return "PANGO_VARIANT_NORMAL"
+	case VariantSmallCapsValue:
+		return "PANGO_VARIANT_SMALL_CAPS"
+	case VariantAllSmallCapsValue:
+		return "PANGO_VARIANT_ALL_SMALL_CAPS"
+	case VariantPetiteCapsValue:
+		return "PANGO_VARIANT_PETITE_CAPS"
+	case VariantAllPetiteCapsValue:
+		return "PANGO_VARIANT_ALL_PETITE_CAPS"
+	case VariantUnicaseValue:
+		return "PANGO_VARIANT_UNICASE"
+	case VariantTitleCapsValue:
+		return "PANGO_VARIANT_TITLE_CAPS"
+	}
+	return fmt.Sprintf("Variant(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Variant) Nick() string {
+	switch x {
+	case VariantNormalValue:
+		return "normal"
+	case VariantSmallCapsValue:
+		return "small-caps"
+	case VariantAllSmallCapsValue:
+		return "all-small-caps"
+	case VariantPetiteCapsValue:
+		return "petite-caps"
+	case VariantAllPetiteCapsValue:
+		return "all-petite-caps"
+	case VariantUnicaseValue:
+		return "unicase"
+	case VariantTitleCapsValue:
+		return "title-caps"
+	}
+	return ""
+}
+
+// VariantFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func VariantFromString(s string) (Variant, bool) {
+	switch s {
+	case "PANGO_VARIANT_NORMAL", "normal":
+		return VariantNormalValue, true
+	case "PANGO_VARIANT_SMALL_CAPS", "small-caps":
+		return VariantSmallCapsValue, true
+	case "PANGO_VARIANT_ALL_SMALL_CAPS", "all-small-caps":
+		return VariantAllSmallCapsValue, true
+	case "PANGO_VARIANT_PETITE_CAPS", "petite-caps":
+		return VariantPetiteCapsValue, true
+	case "PANGO_VARIANT_ALL_PETITE_CAPS", "all-petite-caps":
+		return VariantAllPetiteCapsValue, true
+	case "PANGO_VARIANT_UNICASE", "unicase":
+		return VariantUnicaseValue, true
+	case "PANGO_VARIANT_TITLE_CAPS", "title-caps":
+		return VariantTitleCapsValue, true
+	}
+	return 0, false
+}
+
 // An enumeration specifying the weight (boldness) of a font.
 //
 // Weight is specified as a numeric value ranging from 100 to 1000.
@@ -1534,6 +1842,99 @@ const (
 	WeightUltraheavyValue Weight = 1000
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Weight) String() string {
+	switch x {
+	case WeightThinValue:
+		return "PANGO_WEIGHT_THIN"
+	case WeightUltralightValue:
+		return "PANGO_WEIGHT_ULTRALIGHT"
+	case WeightLightValue:
+		return "PANGO_WEIGHT_LIGHT"
+	case WeightSemilightValue:
+		return "PANGO_WEIGHT_SEMILIGHT"
+	case WeightBookValue:
+		return "PANGO_WEIGHT_BOOK"
+	case WeightNormalValue:
+		return "PANGO_WEIGHT_NORMAL"
+	case WeightMediumValue:
+		return "PANGO_WEIGHT_MEDIUM"
+	case WeightSemiboldValue:
+		return "PANGO_WEIGHT_SEMIBOLD"
+	case WeightBoldValue:
+		return "PANGO_WEIGHT_BOLD"
+	case WeightUltraboldValue:
+		return "PANGO_WEIGHT_ULTRABOLD"
+	case WeightHeavyValue:
+		return "PANGO_WEIGHT_HEAVY"
+	case WeightUltraheavyValue:
+		return "PANGO_WEIGHT_ULTRAHEAVY"
+	}
+	return fmt.Sprintf("Weight(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Weight) Nick() string {
+	switch x {
+	case WeightThinValue:
+		return "thin"
+	case WeightUltralightValue:
+		return "ultralight"
+	case WeightLightValue:
+		return "light"
+	case WeightSemilightValue:
+		return "semilight"
+	case WeightBookValue:
+		return "book"
+	case WeightNormalValue:
+		return "normal"
+	case WeightMediumValue:
+		return "medium"
+	case WeightSemiboldValue:
+		return "semibold"
+	case WeightBoldValue:
+		return "bold"
+	case WeightUltraboldValue:
+		return "ultrabold"
+	case WeightHeavyValue:
+		return "heavy"
+	case WeightUltraheavyValue:
+		return "ultraheavy"
+	}
+	return ""
+}
+
+// WeightFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func WeightFromString(s string) (Weight, bool) {
+	switch s {
+	case "PANGO_WEIGHT_THIN", "thin":
+		return WeightThinValue, true
+	case "PANGO_WEIGHT_ULTRALIGHT", "ultralight":
+		return WeightUltralightValue, true
+	case "PANGO_WEIGHT_LIGHT", "light":
+		return WeightLightValue, true
+	case "PANGO_WEIGHT_SEMILIGHT", "semilight":
+		return WeightSemilightValue, true
+	case "PANGO_WEIGHT_BOOK", "book":
+		return WeightBookValue, true
+	case "PANGO_WEIGHT_NORMAL", "normal":
+		return WeightNormalValue, true
+	case "PANGO_WEIGHT_MEDIUM", "medium":
+		return WeightMediumValue, true
+	case "PANGO_WEIGHT_SEMIBOLD", "semibold":
+		return WeightSemiboldValue, true
+	case "PANGO_WEIGHT_BOLD", "bold":
+		return WeightBoldValue, true
+	case "PANGO_WEIGHT_ULTRABOLD", "ultrabold":
+		return WeightUltraboldValue, true
+	case "PANGO_WEIGHT_HEAVY", "heavy":
+		return WeightHeavyValue, true
+	case "PANGO_WEIGHT_ULTRAHEAVY", "ultraheavy":
+		return WeightUltraheavyValue, true
+	}
+	return 0, false
+}
+
 var xFontDescriptionFromString func(string) *FontDescription
 
 // Creates a new font description from a string representation.
@@ -1581,7 +1982,7 @@ var xFontDescriptionFromString func(string) *FontDescription
 //
 // Any one of the options may be absent. If FAMILY-LIST is absent, then
 // the family_name field of the resulting font description will be
-// initialized to %NULL. If STYLE-OPTIONS is missing, then all style
+// initialized to nil. If STYLE-OPTIONS is missing, then all style
 // options will be set to the default values. If SIZE is missing, the
 // size in the resulting font description will be set to 0.
 //
@@ -1616,7 +2017,7 @@ var xFontDescribe func(uintptr) *FontDescription
 
 // Returns a description of the font, with font size set in points.
 //
-// Use [method@Pango.Font.describe_with_absolute_size] if you want
+// Use [Font.DescribeWithAbsoluteSize] if you want
 // the font size in device units.
 func (x *Font) Describe() *FontDescription {
 
@@ -1629,7 +2030,7 @@ var xFontDescribeWithAbsoluteSize func(uintptr) *FontDescription
 // Returns a description of the font, with absolute font size set
 // in device units.
 //
-// Use [method@Pango.Font.describe] if you want the font size in points.
+// Use [Font.Describe] if you want the font size in points.
 func (x *Font) DescribeWithAbsoluteSize() *FontDescription {
 
 	cret := xFontDescribeWithAbsoluteSize(x.GoPointer())
@@ -1654,7 +2055,7 @@ func (x *Font) GetCoverage(LanguageVar *Language) *Coverage {
 
 var xFontGetFace func(uintptr) uintptr
 
-// Gets the `PangoFontFace` to which @font belongs.
+// Gets the `PangoFontFace` to which font belongs.
 //
 // Note that this function can return `NULL` in cases
 // where the font outlives its font map.
@@ -1695,7 +2096,7 @@ var xFontGetFontMap func(uintptr) uintptr
 // the font map, so if all references to font map are
 // dropped, the font map will be finalized even if there
 // are fonts created with the font map that are still alive.
-// In that case this function will return %NULL.
+// In that case this function will return nil.
 //
 // It is the responsibility of the user to ensure that the
 // font map is kept alive. In most uses this is not an issue
@@ -1725,7 +2126,7 @@ var xFontGetGlyphExtents func(uintptr, Glyph, *Rectangle, *Rectangle)
 // from the extents rectangle to more traditional font metrics. The units
 // of the rectangles are in 1/PANGO_SCALE of a device unit.
 //
-// If @font is %NULL, this function gracefully sets some sane values in the
+// If font is nil, this function gracefully sets some sane values in the
 // output variables and returns.
 func (x *Font) GetGlyphExtents(GlyphVar Glyph, InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
@@ -1739,7 +2140,9 @@ var xFontGetHbFont func(uintptr) uintptr
 //
 // Note that the objects returned by this function are cached
 // and immutable. If you need to make changes to the `hb_font_t`,
-// use [hb_font_create_sub_font()](https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-create-sub-font).
+// use [hb_font_create_sub_font()].
+//
+// [hb_font_create_sub_font()]: https://harfbuzz.github.io/harfbuzz-hb-font.html#hb-font-create-sub-font
 func (x *Font) GetHbFont() uintptr {
 
 	cret := xFontGetHbFont(x.GoPointer())
@@ -1748,10 +2151,10 @@ func (x *Font) GetHbFont() uintptr {
 
 var xFontGetLanguages func(uintptr) uintptr
 
-// Returns the languages that are supported by @font.
+// Returns the languages that are supported by font.
 //
 // If the font backend does not provide this information,
-// %NULL is returned. For the fontconfig backend, this
+// nil is returned. For the fontconfig backend, this
 // corresponds to the FC_LANG member of the FcPattern.
 //
 // The returned array is only valid as long as the font
@@ -1770,7 +2173,7 @@ var xFontGetMetrics func(uintptr, *Language) *FontMetrics
 // a language tag can be provided to indicate that the metrics should be
 // retrieved that correspond to the script(s) used by that language.
 //
-// If @font is %NULL, this function gracefully sets some sane values in the
+// If font is nil, this function gracefully sets some sane values in the
 // output variables and returns.
 func (x *Font) GetMetrics(LanguageVar *Language) *FontMetrics {
 
@@ -1778,10 +2181,10 @@ func (x *Font) GetMetrics(LanguageVar *Language) *FontMetrics {
 	return cret
 }
 
-var xFontHasChar func(uintptr, uint32) bool
+var xFontHasChar func(uintptr, rune) bool
 
 // Returns whether the font provides a glyph for this character.
-func (x *Font) HasChar(WcVar uint32) bool {
+func (x *Font) HasChar(WcVar rune) bool {
 
 	cret := xFontHasChar(x.GoPointer(), WcVar)
 	return cret
@@ -1789,7 +2192,7 @@ func (x *Font) HasChar(WcVar uint32) bool {
 
 var xFontSerialize func(uintptr) *glib.Bytes
 
-// Serializes the @font in a way that can be uniquely identified.
+// Serializes the font in a way that can be uniquely identified.
 //
 // There are no guarantees about the format of the output across different
 // versions of Pango.
@@ -1797,7 +2200,7 @@ var xFontSerialize func(uintptr) *glib.Bytes
 // The intended use of this function is testing, benchmarking and debugging.
 // The format is not meant as a permanent storage format.
 //
-// To recreate a font from its serialized form, use [func@Pango.Font.deserialize].
+// To recreate a font from its serialized form, use [FontDeserialize].
 func (x *Font) Serialize() *glib.Bytes {
 
 	cret := xFontSerialize(x.GoPointer())
@@ -1815,23 +2218,14 @@ func (c *Font) SetGoPointer(ptr uintptr) {
 	c.Ptr = ptr
 }
 
-var xFontDescriptionsFree func(uintptr, int)
-
-// Frees an array of font descriptions.
-func FontDescriptionsFree(DescsVar uintptr, NDescsVar int) {
-
-	xFontDescriptionsFree(DescsVar, NDescsVar)
-
-}
-
 var xFontDeserialize func(uintptr, *glib.Bytes, **glib.Error) uintptr
 
-// Loads data previously created via [method@Pango.Font.serialize].
+// Loads data previously created via [Font.Serialize].
 //
 // For a discussion of the supported format, see that function.
 //
 // Note: to verify that the returned font is identical to
-// the one that was serialized, you can compare @bytes to the
+// the one that was serialized, you can compare bytes to the
 // result of serializing the font again.
 func FontDeserialize(ContextVar *Context, BytesVar *glib.Bytes) (*Font, error) {
 	var cls *Font
@@ -1840,12 +2234,15 @@ func FontDeserialize(ContextVar *Context, BytesVar *glib.Bytes) (*Font, error) {
 	cret := xFontDeserialize(ContextVar.GoPointer(), BytesVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Font{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1897,7 +2294,7 @@ func (x *FontFace) GetFaceName() string {
 
 var xFontFaceGetFamily func(uintptr) uintptr
 
-// Gets the `PangoFontFamily` that @face belongs to.
+// Gets the `PangoFontFamily` that face belongs to.
 func (x *FontFace) GetFamily() *FontFamily {
 	var cls *FontFamily
 
@@ -1930,8 +2327,8 @@ var xFontFaceListSizes func(uintptr, *[]int, *int)
 // List the available sizes for a font.
 //
 // This is only applicable to bitmap fonts. For scalable fonts, stores
-// %NULL at the location pointed to by @sizes and 0 at the location pointed
-// to by @n_sizes. The sizes returned are in Pango units and are sorted
+// nil at the location pointed to by sizes and 0 at the location pointed
+// to by n_sizes. The sizes returned are in Pango units and are sorted
 // in ascending order.
 func (x *FontFace) ListSizes(SizesVar *[]int, NSizesVar *int) {
 
@@ -1973,7 +2370,7 @@ func FontFamilyNewFromInternalPtr(ptr uintptr) *FontFamily {
 
 var xFontFamilyGetFace func(uintptr, uintptr) uintptr
 
-// Gets the `PangoFontFace` of @family with the given name.
+// Gets the `PangoFontFace` of family with the given name.
 func (x *FontFamily) GetFace(NameVar *string) *FontFace {
 	var cls *FontFace
 
@@ -2013,12 +2410,12 @@ var xFontFamilyIsMonospace func(uintptr) bool
 // mean that the advance width of all characters are the same, but
 // this categorization also includes Asian fonts which include
 // double-width characters: characters that occupy two grid cells.
-// g_unichar_iswide() returns a result that indicates whether a
+// [glib.UnicharIswide] returns a result that indicates whether a
 // character is typically double-width in a monospace font.
 //
 // The best way to find out the grid-cell size is to call
-// [method@Pango.FontMetrics.get_approximate_digit_width], since the
-// results of [method@Pango.FontMetrics.get_approximate_char_width] may
+// [FontMetrics.GetApproximateDigitWidth], since the
+// results of [FontMetrics.GetApproximateCharWidth] may
 // be affected by double-width characters.
 func (x *FontFamily) IsMonospace() bool {
 
@@ -2032,7 +2429,7 @@ var xFontFamilyIsVariable func(uintptr) bool
 // produce different faces.
 //
 // Such axes are also known as _variations_; see
-// [method@Pango.FontDescription.set_variations] for more information.
+// [FontDescription.SetVariations] for more information.
 func (x *FontFamily) IsVariable() bool {
 
 	cret := xFontFamilyIsVariable(x.GoPointer())
@@ -2041,7 +2438,7 @@ func (x *FontFamily) IsVariable() bool {
 
 var xFontFamilyListFaces func(uintptr, *uintptr, *int)
 
-// Lists the different font faces that make up @family.
+// Lists the different font faces that make up family.
 //
 // The faces in a family share a common design, but differ in slant, weight,
 // width and other aspects.
@@ -2049,7 +2446,7 @@ var xFontFamilyListFaces func(uintptr, *uintptr, *int)
 // Note that the returned faces are not in any particular order, and
 // multiple faces may have the same name or characteristics.
 //
-// `PangoFontFamily` also implemented the [iface@Gio.ListModel] interface
+// `PangoFontFamily` also implemented the [gio.ListModel] interface
 // for enumerating faces.
 func (x *FontFamily) ListFaces(FacesVar *uintptr, NFacesVar *int) {
 
@@ -2100,28 +2497,30 @@ func (x *FontFamily) GetPropertyName() string {
 	return v.GetString()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *FontFamily) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *FontFamily) GetItemType() types.GType {
 
@@ -2129,29 +2528,29 @@ func (x *FontFamily) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *FontFamily) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *FontFamily) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -2165,20 +2564,20 @@ func (x *FontFamily) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -2196,7 +2595,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -2215,96 +2614,101 @@ func init() {
 
 	core.PuregoSafeRegister(&xWeightGLibType, libs, "pango_weight_get_type")
 
-	core.PuregoSafeRegister(&xFontDescriptionFromString, libs, "pango_font_description_from_string")
+	core.PuregoRegisterSince(&xFontDescriptionFromString, libs, "pango_font_description_from_string", "PANGO", "")
 
 	core.PuregoSafeRegister(&xFontDescriptionGLibType, libs, "pango_font_description_get_type")
 
-	core.PuregoSafeRegister(&xNewFontDescription, libs, "pango_font_description_new")
-
-	core.PuregoSafeRegister(&xFontDescriptionBetterMatch, libs, "pango_font_description_better_match")
-	core.PuregoSafeRegister(&xFontDescriptionCopy, libs, "pango_font_description_copy")
-	core.PuregoSafeRegister(&xFontDescriptionCopyStatic, libs, "pango_font_description_copy_static")
-	core.PuregoSafeRegister(&xFontDescriptionEqual, libs, "pango_font_description_equal")
-	core.PuregoSafeRegister(&xFontDescriptionFree, libs, "pango_font_description_free")
-	core.PuregoSafeRegister(&xFontDescriptionGetColor, libs, "pango_font_description_get_color")
-	core.PuregoSafeRegister(&xFontDescriptionGetFamily, libs, "pango_font_description_get_family")
-	core.PuregoSafeRegister(&xFontDescriptionGetFeatures, libs, "pango_font_description_get_features")
-	core.PuregoSafeRegister(&xFontDescriptionGetGravity, libs, "pango_font_description_get_gravity")
-	core.PuregoSafeRegister(&xFontDescriptionGetSetFields, libs, "pango_font_description_get_set_fields")
-	core.PuregoSafeRegister(&xFontDescriptionGetSize, libs, "pango_font_description_get_size")
-	core.PuregoSafeRegister(&xFontDescriptionGetSizeIsAbsolute, libs, "pango_font_description_get_size_is_absolute")
-	core.PuregoSafeRegister(&xFontDescriptionGetStretch, libs, "pango_font_description_get_stretch")
-	core.PuregoSafeRegister(&xFontDescriptionGetStyle, libs, "pango_font_description_get_style")
-	core.PuregoSafeRegister(&xFontDescriptionGetVariant, libs, "pango_font_description_get_variant")
-	core.PuregoSafeRegister(&xFontDescriptionGetVariations, libs, "pango_font_description_get_variations")
-	core.PuregoSafeRegister(&xFontDescriptionGetWeight, libs, "pango_font_description_get_weight")
-	core.PuregoSafeRegister(&xFontDescriptionHash, libs, "pango_font_description_hash")
-	core.PuregoSafeRegister(&xFontDescriptionMerge, libs, "pango_font_description_merge")
-	core.PuregoSafeRegister(&xFontDescriptionMergeStatic, libs, "pango_font_description_merge_static")
-	core.PuregoSafeRegister(&xFontDescriptionSetAbsoluteSize, libs, "pango_font_description_set_absolute_size")
-	core.PuregoSafeRegister(&xFontDescriptionSetColor, libs, "pango_font_description_set_color")
-	core.PuregoSafeRegister(&xFontDescriptionSetFamily, libs, "pango_font_description_set_family")
-	core.PuregoSafeRegister(&xFontDescriptionSetFamilyStatic, libs, "pango_font_description_set_family_static")
-	core.PuregoSafeRegister(&xFontDescriptionSetFeatures, libs, "pango_font_description_set_features")
-	core.PuregoSafeRegister(&xFontDescriptionSetFeaturesStatic, libs, "pango_font_description_set_features_static")
-	core.PuregoSafeRegister(&xFontDescriptionSetGravity, libs, "pango_font_description_set_gravity")
-	core.PuregoSafeRegister(&xFontDescriptionSetSize, libs, "pango_font_description_set_size")
-	core.PuregoSafeRegister(&xFontDescriptionSetStretch, libs, "pango_font_description_set_stretch")
-	core.PuregoSafeRegister(&xFontDescriptionSetStyle, libs, "pango_font_description_set_style")
-	core.PuregoSafeRegister(&xFontDescriptionSetVariant, libs, "pango_font_description_set_variant")
-	core.PuregoSafeRegister(&xFontDescriptionSetVariations, libs, "pango_font_description_set_variations")
-	core.PuregoSafeRegister(&xFontDescriptionSetVariationsStatic, libs, "pango_font_description_set_variations_static")
-	core.PuregoSafeRegister(&xFontDescriptionSetWeight, libs, "pango_font_description_set_weight")
-	core.PuregoSafeRegister(&xFontDescriptionToFilename, libs, "pango_font_description_to_filename")
-	core.PuregoSafeRegister(&xFontDescriptionToString, libs, "pango_font_description_to_string")
-	core.PuregoSafeRegister(&xFontDescriptionUnsetFields, libs, "pango_font_description_unset_fields")
+	core.PuregoRegisterSince(&xNewFontDescription, libs, "pango_font_description_new", "PANGO", "")
+
+	core.PuregoRegisterSince(&xFontDescriptionBetterMatch, libs, "pango_font_description_better_match", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionCopy, libs, "pango_font_description_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionCopyStatic, libs, "pango_font_description_copy_static", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionEqual, libs, "pango_font_description_equal", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionFree, libs, "pango_font_description_free", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetColor, libs, "pango_font_description_get_color", "PANGO", "1.57")
+	core.PuregoRegisterSince(&xFontDescriptionGetFamily, libs, "pango_font_description_get_family", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetFeatures, libs, "pango_font_description_get_features", "PANGO", "1.56")
+	core.PuregoRegisterSince(&xFontDescriptionGetGravity, libs, "pango_font_description_get_gravity", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xFontDescriptionGetSetFields, libs, "pango_font_description_get_set_fields", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetSize, libs, "pango_font_description_get_size", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetSizeIsAbsolute, libs, "pango_font_description_get_size_is_absolute", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xFontDescriptionGetStretch, libs, "pango_font_description_get_stretch", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetStyle, libs, "pango_font_description_get_style", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetVariant, libs, "pango_font_description_get_variant", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionGetVariations, libs, "pango_font_description_get_variations", "PANGO", "1.42")
+	core.PuregoRegisterSince(&xFontDescriptionGetWeight, libs, "pango_font_description_get_weight", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionHash, libs, "pango_font_description_hash", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionMerge, libs, "pango_font_description_merge", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionMergeStatic, libs, "pango_font_description_merge_static", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetAbsoluteSize, libs, "pango_font_description_set_absolute_size", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xFontDescriptionSetColor, libs, "pango_font_description_set_color", "PANGO", "1.57")
+	core.PuregoRegisterSince(&xFontDescriptionSetFamily, libs, "pango_font_description_set_family", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetFamilyStatic, libs, "pango_font_description_set_family_static", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetFeatures, libs, "pango_font_description_set_features", "PANGO", "1.56")
+	core.PuregoRegisterSince(&xFontDescriptionSetFeaturesStatic, libs, "pango_font_description_set_features_static", "PANGO", "1.56")
+	core.PuregoRegisterSince(&xFontDescriptionSetGravity, libs, "pango_font_description_set_gravity", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xFontDescriptionSetSize, libs, "pango_font_description_set_size", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetStretch, libs, "pango_font_description_set_stretch", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetStyle, libs, "pango_font_description_set_style", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetVariant, libs, "pango_font_description_set_variant", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionSetVariations, libs, "pango_font_description_set_variations", "PANGO", "1.42")
+	core.PuregoRegisterSince(&xFontDescriptionSetVariationsStatic, libs, "pango_font_description_set_variations_static", "PANGO", "1.42")
+	core.PuregoRegisterSince(&xFontDescriptionSetWeight, libs, "pango_font_description_set_weight", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionToFilename, libs, "pango_font_description_to_filename", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionToString, libs, "pango_font_description_to_string", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescriptionUnsetFields, libs, "pango_font_description_unset_fields", "PANGO", "")
 
 	core.PuregoSafeRegister(&xFontMetricsGLibType, libs, "pango_font_metrics_get_type")
 
-	core.PuregoSafeRegister(&xFontMetricsGetApproximateCharWidth, libs, "pango_font_metrics_get_approximate_char_width")
-	core.PuregoSafeRegister(&xFontMetricsGetApproximateDigitWidth, libs, "pango_font_metrics_get_approximate_digit_width")
-	core.PuregoSafeRegister(&xFontMetricsGetAscent, libs, "pango_font_metrics_get_ascent")
-	core.PuregoSafeRegister(&xFontMetricsGetDescent, libs, "pango_font_metrics_get_descent")
-	core.PuregoSafeRegister(&xFontMetricsGetHeight, libs, "pango_font_metrics_get_height")
-	core.PuregoSafeRegister(&xFontMetricsGetStrikethroughPosition, libs, "pango_font_metrics_get_strikethrough_position")
-	core.PuregoSafeRegister(&xFontMetricsGetStrikethroughThickness, libs, "pango_font_metrics_get_strikethrough_thickness")
-	core.PuregoSafeRegister(&xFontMetricsGetUnderlinePosition, libs, "pango_font_metrics_get_underline_position")
-	core.PuregoSafeRegister(&xFontMetricsGetUnderlineThickness, libs, "pango_font_metrics_get_underline_thickness")
-	core.PuregoSafeRegister(&xFontMetricsRef, libs, "pango_font_metrics_ref")
-	core.PuregoSafeRegister(&xFontMetricsUnref, libs, "pango_font_metrics_unref")
+	core.PuregoRegisterSince(&xFontMetricsGetApproximateCharWidth, libs, "pango_font_metrics_get_approximate_char_width", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMetricsGetApproximateDigitWidth, libs, "pango_font_metrics_get_approximate_digit_width", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMetricsGetAscent, libs, "pango_font_metrics_get_ascent", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMetricsGetDescent, libs, "pango_font_metrics_get_descent", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMetricsGetHeight, libs, "pango_font_metrics_get_height", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xFontMetricsGetStrikethroughPosition, libs, "pango_font_metrics_get_strikethrough_position", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xFontMetricsGetStrikethroughThickness, libs, "pango_font_metrics_get_strikethrough_thickness", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xFontMetricsGetUnderlinePosition, libs, "pango_font_metrics_get_underline_position", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xFontMetricsGetUnderlineThickness, libs, "pango_font_metrics_get_underline_thickness", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xFontMetricsRef, libs, "pango_font_metrics_ref", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMetricsUnref, libs, "pango_font_metrics_unref", "PANGO", "")
 
 	core.PuregoSafeRegister(&xFontGLibType, libs, "pango_font_get_type")
 
-	core.PuregoSafeRegister(&xFontDescribe, libs, "pango_font_describe")
-	core.PuregoSafeRegister(&xFontDescribeWithAbsoluteSize, libs, "pango_font_describe_with_absolute_size")
-	core.PuregoSafeRegister(&xFontGetCoverage, libs, "pango_font_get_coverage")
-	core.PuregoSafeRegister(&xFontGetFace, libs, "pango_font_get_face")
-	core.PuregoSafeRegister(&xFontGetFeatures, libs, "pango_font_get_features")
-	core.PuregoSafeRegister(&xFontGetFontMap, libs, "pango_font_get_font_map")
-	core.PuregoSafeRegister(&xFontGetGlyphExtents, libs, "pango_font_get_glyph_extents")
-	core.PuregoSafeRegister(&xFontGetHbFont, libs, "pango_font_get_hb_font")
-	core.PuregoSafeRegister(&xFontGetLanguages, libs, "pango_font_get_languages")
-	core.PuregoSafeRegister(&xFontGetMetrics, libs, "pango_font_get_metrics")
-	core.PuregoSafeRegister(&xFontHasChar, libs, "pango_font_has_char")
-	core.PuregoSafeRegister(&xFontSerialize, libs, "pango_font_serialize")
-
-	core.PuregoSafeRegister(&xFontDescriptionsFree, libs, "pango_font_descriptions_free")
-	core.PuregoSafeRegister(&xFontDeserialize, libs, "pango_font_deserialize")
+	gobject.CheckClassSize("PangoFontClass", xFontGLibType, unsafe.Sizeof(FontClass{}))
+
+	core.PuregoRegisterSince(&xFontDescribe, libs, "pango_font_describe", "PANGO", "")
+	core.PuregoRegisterSince(&xFontDescribeWithAbsoluteSize, libs, "pango_font_describe_with_absolute_size", "PANGO", "1.14")
+	core.PuregoRegisterSince(&xFontGetCoverage, libs, "pango_font_get_coverage", "PANGO", "")
+	core.PuregoRegisterSince(&xFontGetFace, libs, "pango_font_get_face", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xFontGetFeatures, libs, "pango_font_get_features", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xFontGetFontMap, libs, "pango_font_get_font_map", "PANGO", "1.10")
+	core.PuregoRegisterSince(&xFontGetGlyphExtents, libs, "pango_font_get_glyph_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xFontGetHbFont, libs, "pango_font_get_hb_font", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xFontGetLanguages, libs, "pango_font_get_languages", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xFontGetMetrics, libs, "pango_font_get_metrics", "PANGO", "")
+	core.PuregoRegisterSince(&xFontHasChar, libs, "pango_font_has_char", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xFontSerialize, libs, "pango_font_serialize", "PANGO", "1.50")
+
+	core.PuregoRegisterSince(&xFontDeserialize, libs, "pango_font_deserialize", "PANGO", "1.50")
 
 	core.PuregoSafeRegister(&xFontFaceGLibType, libs, "pango_font_face_get_type")
 
-	core.PuregoSafeRegister(&xFontFaceDescribe, libs, "pango_font_face_describe")
-	core.PuregoSafeRegister(&xFontFaceGetFaceName, libs, "pango_font_face_get_face_name")
-	core.PuregoSafeRegister(&xFontFaceGetFamily, libs, "pango_font_face_get_family")
-	core.PuregoSafeRegister(&xFontFaceIsSynthesized, libs, "pango_font_face_is_synthesized")
-	core.PuregoSafeRegister(&xFontFaceListSizes, libs, "pango_font_face_list_sizes")
+	gobject.CheckClassSize("PangoFontFaceClass", xFontFaceGLibType, unsafe.Sizeof(FontFaceClass{}))
+
+	core.PuregoRegisterSince(&xFontFaceDescribe, libs, "pango_font_face_describe", "PANGO", "")
+	core.PuregoRegisterSince(&xFontFaceGetFaceName, libs, "pango_font_face_get_face_name", "PANGO", "")
+	core.PuregoRegisterSince(&xFontFaceGetFamily, libs, "pango_font_face_get_family", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xFontFaceIsSynthesized, libs, "pango_font_face_is_synthesized", "PANGO", "1.18")
+	core.PuregoRegisterSince(&xFontFaceListSizes, libs, "pango_font_face_list_sizes", "PANGO", "1.4")
 
 	core.PuregoSafeRegister(&xFontFamilyGLibType, libs, "pango_font_family_get_type")
 
-	core.PuregoSafeRegister(&xFontFamilyGetFace, libs, "pango_font_family_get_face")
-	core.PuregoSafeRegister(&xFontFamilyGetName, libs, "pango_font_family_get_name")
-	core.PuregoSafeRegister(&xFontFamilyIsMonospace, libs, "pango_font_family_is_monospace")
-	core.PuregoSafeRegister(&xFontFamilyIsVariable, libs, "pango_font_family_is_variable")
-	core.PuregoSafeRegister(&xFontFamilyListFaces, libs, "pango_font_family_list_faces")
+	gobject.CheckClassSize("PangoFontFamilyClass", xFontFamilyGLibType, unsafe.Sizeof(FontFamilyClass{}))
+
+	core.PuregoRegisterSince(&xFontFamilyGetFace, libs, "pango_font_family_get_face", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xFontFamilyGetName, libs, "pango_font_family_get_name", "PANGO", "")
+	core.PuregoRegisterSince(&xFontFamilyIsMonospace, libs, "pango_font_family_is_monospace", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xFontFamilyIsVariable, libs, "pango_font_family_is_variable", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xFontFamilyListFaces, libs, "pango_font_family_list_faces", "PANGO", "")
 
 }
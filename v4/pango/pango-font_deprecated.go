@@ -0,0 +1,35 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xFontDescriptionsFree func(uintptr, int)
+
+// Frees an array of font descriptions.
+//
+// Deprecated: since 1.56. Just use pango_font_description_free in a loop
+func FontDescriptionsFree(DescsVar uintptr, NDescsVar int) {
+
+	xFontDescriptionsFree(DescsVar, NDescsVar)
+
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xFontDescriptionsFree, libs, "pango_font_descriptions_free", "PANGO", "")
+
+}
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gio"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -43,12 +42,13 @@ func (x *FontMapClass) GoPointer() uintptr {
 
 // OverrideLoadFont sets the "load_font" callback function.
 // a function to load a font with a given description. See
-// pango_font_map_load_font().
+// [FontMap.LoadFont].
 func (x *FontMapClass) OverrideLoadFont(cb func(*FontMap, *Context, *FontDescription) *Font) {
 	if cb == nil {
 		x.xLoadFont = 0
 	} else {
-		x.xLoadFont = purego.NewCallback(func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription) uintptr {
+		x.xLoadFont = core.NewCallback(func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontMapNewFromInternalPtr(FontmapVarp), ContextNewFromInternalPtr(ContextVarp), DescVarp)
 			if ret == nil {
 				return 0
@@ -60,13 +60,13 @@ func (x *FontMapClass) OverrideLoadFont(cb func(*FontMap, *Context, *FontDescrip
 
 // GetLoadFont gets the "load_font" callback function.
 // a function to load a font with a given description. See
-// pango_font_map_load_font().
+// [FontMap.LoadFont].
 func (x *FontMapClass) GetLoadFont() func(*FontMap, *Context, *FontDescription) *Font {
 	if x.xLoadFont == 0 {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLoadFont)
+	core.RegisterFunc(&rawCallback, x.xLoadFont)
 	return func(FontmapVar *FontMap, ContextVar *Context, DescVar *FontDescription) *Font {
 		rawRet := rawCallback(FontmapVar.GoPointer(), ContextVar.GoPointer(), DescVar)
 		if rawRet == 0 {
@@ -80,12 +80,13 @@ func (x *FontMapClass) GetLoadFont() func(*FontMap, *Context, *FontDescription)
 
 // OverrideListFamilies sets the "list_families" callback function.
 // A function to list available font families. See
-// pango_font_map_list_families().
+// [FontMap.ListFamilies].
 func (x *FontMapClass) OverrideListFamilies(cb func(*FontMap, *uintptr, *int)) {
 	if cb == nil {
 		x.xListFamilies = 0
 	} else {
-		x.xListFamilies = purego.NewCallback(func(FontmapVarp uintptr, FamiliesVarp *uintptr, NFamiliesVarp *int) {
+		x.xListFamilies = core.NewCallback(func(FontmapVarp uintptr, FamiliesVarp *uintptr, NFamiliesVarp *int) {
+			defer core.RecoverPanic()
 			cb(FontMapNewFromInternalPtr(FontmapVarp), FamiliesVarp, NFamiliesVarp)
 		})
 	}
@@ -93,13 +94,13 @@ func (x *FontMapClass) OverrideListFamilies(cb func(*FontMap, *uintptr, *int)) {
 
 // GetListFamilies gets the "list_families" callback function.
 // A function to list available font families. See
-// pango_font_map_list_families().
+// [FontMap.ListFamilies].
 func (x *FontMapClass) GetListFamilies() func(*FontMap, *uintptr, *int) {
 	if x.xListFamilies == 0 {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr, FamiliesVarp *uintptr, NFamiliesVarp *int)
-	purego.RegisterFunc(&rawCallback, x.xListFamilies)
+	core.RegisterFunc(&rawCallback, x.xListFamilies)
 	return func(FontmapVar *FontMap, FamiliesVar *uintptr, NFamiliesVar *int) {
 		rawCallback(FontmapVar.GoPointer(), FamiliesVar, NFamiliesVar)
 	}
@@ -107,12 +108,13 @@ func (x *FontMapClass) GetListFamilies() func(*FontMap, *uintptr, *int) {
 
 // OverrideLoadFontset sets the "load_fontset" callback function.
 // a function to load a fontset with a given given description
-// suitable for a particular language. See pango_font_map_load_fontset().
+// suitable for a particular language. See [FontMap.LoadFontset].
 func (x *FontMapClass) OverrideLoadFontset(cb func(*FontMap, *Context, *FontDescription, *Language) *Fontset) {
 	if cb == nil {
 		x.xLoadFontset = 0
 	} else {
-		x.xLoadFontset = purego.NewCallback(func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription, LanguageVarp *Language) uintptr {
+		x.xLoadFontset = core.NewCallback(func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription, LanguageVarp *Language) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontMapNewFromInternalPtr(FontmapVarp), ContextNewFromInternalPtr(ContextVarp), DescVarp, LanguageVarp)
 			if ret == nil {
 				return 0
@@ -124,13 +126,13 @@ func (x *FontMapClass) OverrideLoadFontset(cb func(*FontMap, *Context, *FontDesc
 
 // GetLoadFontset gets the "load_fontset" callback function.
 // a function to load a fontset with a given given description
-// suitable for a particular language. See pango_font_map_load_fontset().
+// suitable for a particular language. See [FontMap.LoadFontset].
 func (x *FontMapClass) GetLoadFontset() func(*FontMap, *Context, *FontDescription, *Language) *Fontset {
 	if x.xLoadFontset == 0 {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr, ContextVarp uintptr, DescVarp *FontDescription, LanguageVarp *Language) uintptr
-	purego.RegisterFunc(&rawCallback, x.xLoadFontset)
+	core.RegisterFunc(&rawCallback, x.xLoadFontset)
 	return func(FontmapVar *FontMap, ContextVar *Context, DescVar *FontDescription, LanguageVar *Language) *Fontset {
 		rawRet := rawCallback(FontmapVar.GoPointer(), ContextVar.GoPointer(), DescVar, LanguageVar)
 		if rawRet == 0 {
@@ -144,12 +146,13 @@ func (x *FontMapClass) GetLoadFontset() func(*FontMap, *Context, *FontDescriptio
 
 // OverrideGetSerial sets the "get_serial" callback function.
 // a function to get the serial number of the fontmap.
-// See pango_font_map_get_serial().
+// See [FontMap.GetSerial].
 func (x *FontMapClass) OverrideGetSerial(cb func(*FontMap) uint) {
 	if cb == nil {
 		x.xGetSerial = 0
 	} else {
-		x.xGetSerial = purego.NewCallback(func(FontmapVarp uintptr) uint {
+		x.xGetSerial = core.NewCallback(func(FontmapVarp uintptr) uint {
+			defer core.RecoverPanic()
 			return cb(FontMapNewFromInternalPtr(FontmapVarp))
 		})
 	}
@@ -157,38 +160,39 @@ func (x *FontMapClass) OverrideGetSerial(cb func(*FontMap) uint) {
 
 // GetGetSerial gets the "get_serial" callback function.
 // a function to get the serial number of the fontmap.
-// See pango_font_map_get_serial().
+// See [FontMap.GetSerial].
 func (x *FontMapClass) GetGetSerial() func(*FontMap) uint {
 	if x.xGetSerial == 0 {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr) uint
-	purego.RegisterFunc(&rawCallback, x.xGetSerial)
+	core.RegisterFunc(&rawCallback, x.xGetSerial)
 	return func(FontmapVar *FontMap) uint {
 		return rawCallback(FontmapVar.GoPointer())
 	}
 }
 
 // OverrideChanged sets the "changed" callback function.
-// See pango_font_map_changed()
+// See [FontMap.Changed]
 func (x *FontMapClass) OverrideChanged(cb func(*FontMap)) {
 	if cb == nil {
 		x.xChanged = 0
 	} else {
-		x.xChanged = purego.NewCallback(func(FontmapVarp uintptr) {
+		x.xChanged = core.NewCallback(func(FontmapVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FontMapNewFromInternalPtr(FontmapVarp))
 		})
 	}
 }
 
 // GetChanged gets the "changed" callback function.
-// See pango_font_map_changed()
+// See [FontMap.Changed]
 func (x *FontMapClass) GetChanged() func(*FontMap) {
 	if x.xChanged == 0 {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xChanged)
+	core.RegisterFunc(&rawCallback, x.xChanged)
 	return func(FontmapVar *FontMap) {
 		rawCallback(FontmapVar.GoPointer())
 	}
@@ -199,7 +203,8 @@ func (x *FontMapClass) OverrideGetFamily(cb func(*FontMap, string) *FontFamily)
 	if cb == nil {
 		x.xGetFamily = 0
 	} else {
-		x.xGetFamily = purego.NewCallback(func(FontmapVarp uintptr, NameVarp string) uintptr {
+		x.xGetFamily = core.NewCallback(func(FontmapVarp uintptr, NameVarp string) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontMapNewFromInternalPtr(FontmapVarp), NameVarp)
 			if ret == nil {
 				return 0
@@ -215,7 +220,7 @@ func (x *FontMapClass) GetGetFamily() func(*FontMap, string) *FontFamily {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr, NameVarp string) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFamily)
+	core.RegisterFunc(&rawCallback, x.xGetFamily)
 	return func(FontmapVar *FontMap, NameVar string) *FontFamily {
 		rawRet := rawCallback(FontmapVar.GoPointer(), NameVar)
 		if rawRet == 0 {
@@ -232,7 +237,8 @@ func (x *FontMapClass) OverrideGetFace(cb func(*FontMap, *Font) *FontFace) {
 	if cb == nil {
 		x.xGetFace = 0
 	} else {
-		x.xGetFace = purego.NewCallback(func(FontmapVarp uintptr, FontVarp uintptr) uintptr {
+		x.xGetFace = core.NewCallback(func(FontmapVarp uintptr, FontVarp uintptr) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontMapNewFromInternalPtr(FontmapVarp), FontNewFromInternalPtr(FontVarp))
 			if ret == nil {
 				return 0
@@ -248,7 +254,7 @@ func (x *FontMapClass) GetGetFace() func(*FontMap, *Font) *FontFace {
 		return nil
 	}
 	var rawCallback func(FontmapVarp uintptr, FontVarp uintptr) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFace)
+	core.RegisterFunc(&rawCallback, x.xGetFace)
 	return func(FontmapVar *FontMap, FontVar *Font) *FontFace {
 		rawRet := rawCallback(FontmapVar.GoPointer(), FontVar.GoPointer())
 		if rawRet == 0 {
@@ -292,7 +298,7 @@ func (x *FontMap) AddFontFile(FilenameVar string) (bool, error) {
 
 	cret := xFontMapAddFontFile(x.GoPointer(), FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -315,15 +321,15 @@ func (x *FontMap) Changed() {
 
 var xFontMapCreateContext func(uintptr) uintptr
 
-// Creates a `PangoContext` connected to @fontmap.
+// Creates a `PangoContext` connected to fontmap.
 //
-// This is equivalent to [ctor@Pango.Context.new] followed by
-// [method@Pango.Context.set_font_map].
+// This is equivalent to [NewContext] followed by
+// [Context.SetFontMap].
 //
 // If you are using Pango as part of a higher-level system,
 // that system may have it's own way of create a `PangoContext`.
 // For instance, the GTK toolkit has, among others,
-// gtk_widget_get_pango_context(). Use those instead.
+// [gtk.Widget.GetPangoContext]. Use those instead.
 func (x *FontMap) CreateContext() *Context {
 	var cls *Context
 
@@ -356,7 +362,7 @@ func (x *FontMap) GetFamily(NameVar string) *FontFamily {
 
 var xFontMapGetSerial func(uintptr) uint
 
-// Returns the current serial number of @fontmap.
+// Returns the current serial number of fontmap.
 //
 // The serial number is initialized to an small number larger than zero
 // when a new fontmap is created and is increased whenever the fontmap
@@ -380,7 +386,7 @@ var xFontMapListFamilies func(uintptr, *uintptr, *int)
 //
 // Note that the returned families are not in any particular order.
 //
-// `PangoFontMap` also implemented the [iface@Gio.ListModel] interface
+// `PangoFontMap` also implemented the [gio.ListModel] interface
 // for enumerating families.
 func (x *FontMap) ListFamilies(FamiliesVar *uintptr, NFamiliesVar *int) {
 
@@ -390,7 +396,7 @@ func (x *FontMap) ListFamilies(FamiliesVar *uintptr, NFamiliesVar *int) {
 
 var xFontMapLoadFont func(uintptr, uintptr, *FontDescription) uintptr
 
-// Load the font in the fontmap that is the closest match for @desc.
+// Load the font in the fontmap that is the closest match for desc.
 func (x *FontMap) LoadFont(ContextVar *Context, DescVar *FontDescription) *Font {
 	var cls *Font
 
@@ -407,7 +413,7 @@ func (x *FontMap) LoadFont(ContextVar *Context, DescVar *FontDescription) *Font
 var xFontMapLoadFontset func(uintptr, uintptr, *FontDescription, *Language) uintptr
 
 // Load a set of fonts in the fontmap that can be used to render
-// a font matching @desc.
+// a font matching desc.
 func (x *FontMap) LoadFontset(ContextVar *Context, DescVar *FontDescription, LanguageVar *Language) *Fontset {
 	var cls *Fontset
 
@@ -423,10 +429,10 @@ func (x *FontMap) LoadFontset(ContextVar *Context, DescVar *FontDescription, Lan
 
 var xFontMapReloadFont func(uintptr, uintptr, float64, uintptr, uintptr) uintptr
 
-// Returns a new font that is like @font, except that it is scaled
-// by @scale, its backend-dependent configuration (e.g. cairo font options)
-// is replaced by the one in @context, and its variations are replaced
-// by @variations.
+// Returns a new font that is like font, except that it is scaled
+// by scale, its backend-dependent configuration (e.g. cairo font options)
+// is replaced by the one in context, and its variations are replaced
+// by variations.
 //
 // Note that the scaling here is meant to be linear, so this
 // scaling can be used to render a font on a hi-dpi display
@@ -466,28 +472,30 @@ func (x *FontMap) GetPropertyNItems() uint {
 	return v.GetUint()
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
+//
+// Deprecated: use [GetObject], the version of this function that language bindings are meant to use.
 func (x *FontMap) GetItem(PositionVar uint) uintptr {
 
 	cret := gio.XGListModelGetItem(x.GoPointer(), PositionVar)
 	return cret
 }
 
-// Gets the type of the items in @list.
+// Gets the type of the items in list.
 //
-// All items returned from g_list_model_get_item() are of the type
+// All items returned from [gio.ListModel.GetItem] are of the type
 // returned by this function, or a subtype, or if the type is an
 // interface, they are an implementation of that interface.
 //
-// The item type of a #GListModel can not change during the life of the
+// The item type of a [gio.ListModel] can not change during the life of the
 // model.
 func (x *FontMap) GetItemType() types.GType {
 
@@ -495,29 +503,29 @@ func (x *FontMap) GetItemType() types.GType {
 	return cret
 }
 
-// Gets the number of items in @list.
+// Gets the number of items in list.
 //
 // Depending on the model implementation, calling this function may be
 // less efficient than iterating the list with increasing values for
-// @position until g_list_model_get_item() returns %NULL.
+// position until [gio.ListModel.GetItem] returns nil.
 func (x *FontMap) GetNItems() uint {
 
 	cret := gio.XGListModelGetNItems(x.GoPointer())
 	return cret
 }
 
-// Get the item at @position.
+// Get the item at position.
 //
-// If @position is greater than the number of items in @list, %NULL is
+// If position is greater than the number of items in list, nil is
 // returned.
 //
-// %NULL is never returned for an index that is smaller than the length
+// nil is never returned for an index that is smaller than the length
 // of the list.
 //
 // This function is meant to be used by language bindings in place
-// of g_list_model_get_item().
+// of [gio.ListModel.GetItem].
 //
-// See also: g_list_model_get_n_items()
+// See also: [gio.ListModel.GetNItems]
 func (x *FontMap) GetObject(PositionVar uint) *gobject.Object {
 	var cls *gobject.Object
 
@@ -531,20 +539,20 @@ func (x *FontMap) GetObject(PositionVar uint) *gobject.Object {
 	return cls
 }
 
-// Emits the #GListModel::items-changed signal on @list.
+// Emits the GListModel::items-changed signal on list.
 //
 // This function should only be called by classes implementing
-// #GListModel. It has to be called after the internal representation
-// of @list has been updated, because handlers connected to this signal
+// [gio.ListModel]. It has to be called after the internal representation
+// of list has been updated, because handlers connected to this signal
 // might query the new state of the list.
 //
 // Implementations must only make changes to the model (as visible to
 // its consumer) in places that will not cause problems for that
 // consumer.  For models that are driven directly by a write API (such
-// as #GListStore), changes can be reported in response to uses of that
+// as [gio.ListStore]), changes can be reported in response to uses of that
 // API.  For models that represent remote data, changes should only be
 // made from a fresh mainloop dispatch.  It is particularly not
-// permitted to make changes in response to a call to the #GListModel
+// permitted to make changes in response to a call to the [gio.ListModel]
 // consumer API.
 //
 // Stated another way: in general, it is assumed that code making a
@@ -562,7 +570,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -571,14 +579,16 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontMapGLibType, libs, "pango_font_map_get_type")
 
-	core.PuregoSafeRegister(&xFontMapAddFontFile, libs, "pango_font_map_add_font_file")
-	core.PuregoSafeRegister(&xFontMapChanged, libs, "pango_font_map_changed")
-	core.PuregoSafeRegister(&xFontMapCreateContext, libs, "pango_font_map_create_context")
-	core.PuregoSafeRegister(&xFontMapGetFamily, libs, "pango_font_map_get_family")
-	core.PuregoSafeRegister(&xFontMapGetSerial, libs, "pango_font_map_get_serial")
-	core.PuregoSafeRegister(&xFontMapListFamilies, libs, "pango_font_map_list_families")
-	core.PuregoSafeRegister(&xFontMapLoadFont, libs, "pango_font_map_load_font")
-	core.PuregoSafeRegister(&xFontMapLoadFontset, libs, "pango_font_map_load_fontset")
-	core.PuregoSafeRegister(&xFontMapReloadFont, libs, "pango_font_map_reload_font")
+	gobject.CheckClassSize("PangoFontMapClass", xFontMapGLibType, unsafe.Sizeof(FontMapClass{}))
+
+	core.PuregoRegisterSince(&xFontMapAddFontFile, libs, "pango_font_map_add_font_file", "PANGO", "1.56")
+	core.PuregoRegisterSince(&xFontMapChanged, libs, "pango_font_map_changed", "PANGO", "1.34")
+	core.PuregoRegisterSince(&xFontMapCreateContext, libs, "pango_font_map_create_context", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xFontMapGetFamily, libs, "pango_font_map_get_family", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xFontMapGetSerial, libs, "pango_font_map_get_serial", "PANGO", "1.32.4")
+	core.PuregoRegisterSince(&xFontMapListFamilies, libs, "pango_font_map_list_families", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMapLoadFont, libs, "pango_font_map_load_font", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMapLoadFontset, libs, "pango_font_map_load_fontset", "PANGO", "")
+	core.PuregoRegisterSince(&xFontMapReloadFont, libs, "pango_font_map_reload_font", "PANGO", "1.52")
 
 }
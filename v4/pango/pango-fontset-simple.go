@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -59,7 +58,7 @@ var xFontsetSimpleAppend func(uintptr, uintptr)
 
 // Adds a font to the fontset.
 //
-// The fontset takes ownership of @font.
+// The fontset takes ownership of font.
 func (x *FontsetSimple) Append(FontVar *Font) {
 
 	xFontsetSimpleAppend(x.GoPointer(), FontVar.GoPointer())
@@ -91,7 +90,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -100,9 +99,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontsetSimpleGLibType, libs, "pango_fontset_simple_get_type")
 
-	core.PuregoSafeRegister(&xNewFontsetSimple, libs, "pango_fontset_simple_new")
+	core.PuregoRegisterSince(&xNewFontsetSimple, libs, "pango_fontset_simple_new", "PANGO", "")
 
-	core.PuregoSafeRegister(&xFontsetSimpleAppend, libs, "pango_fontset_simple_append")
-	core.PuregoSafeRegister(&xFontsetSimpleSize, libs, "pango_fontset_simple_size")
+	core.PuregoRegisterSince(&xFontsetSimpleAppend, libs, "pango_fontset_simple_append", "PANGO", "")
+	core.PuregoRegisterSince(&xFontsetSimpleSize, libs, "pango_fontset_simple_size", "PANGO", "")
 
 }
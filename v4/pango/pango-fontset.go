@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -14,7 +13,7 @@ import (
 
 // Callback used when enumerating fonts in a fontset.
 //
-// See [method@Pango.Fontset.foreach].
+// See [Fontset.Foreach].
 type FontsetForeachFunc func(uintptr, uintptr, uintptr) bool
 
 // The `PangoFontsetClass` structure holds the virtual functions for
@@ -48,12 +47,13 @@ func (x *FontsetClass) GoPointer() uintptr {
 // OverrideGetFont sets the "get_font" callback function.
 // a function to get the font in the fontset that contains the
 //
-//	best glyph for the given Unicode character; see [method@Pango.Fontset.get_font]
+//	best glyph for the given Unicode character; see [Fontset.GetFont]
 func (x *FontsetClass) OverrideGetFont(cb func(*Fontset, uint) *Font) {
 	if cb == nil {
 		x.xGetFont = 0
 	} else {
-		x.xGetFont = purego.NewCallback(func(FontsetVarp uintptr, WcVarp uint) uintptr {
+		x.xGetFont = core.NewCallback(func(FontsetVarp uintptr, WcVarp uint) uintptr {
+			defer core.RecoverPanic()
 			ret := cb(FontsetNewFromInternalPtr(FontsetVarp), WcVarp)
 			if ret == nil {
 				return 0
@@ -66,13 +66,13 @@ func (x *FontsetClass) OverrideGetFont(cb func(*Fontset, uint) *Font) {
 // GetGetFont gets the "get_font" callback function.
 // a function to get the font in the fontset that contains the
 //
-//	best glyph for the given Unicode character; see [method@Pango.Fontset.get_font]
+//	best glyph for the given Unicode character; see [Fontset.GetFont]
 func (x *FontsetClass) GetGetFont() func(*Fontset, uint) *Font {
 	if x.xGetFont == 0 {
 		return nil
 	}
 	var rawCallback func(FontsetVarp uintptr, WcVarp uint) uintptr
-	purego.RegisterFunc(&rawCallback, x.xGetFont)
+	core.RegisterFunc(&rawCallback, x.xGetFont)
 	return func(FontsetVar *Fontset, WcVar uint) *Font {
 		rawRet := rawCallback(FontsetVar.GoPointer(), WcVar)
 		if rawRet == 0 {
@@ -87,12 +87,13 @@ func (x *FontsetClass) GetGetFont() func(*Fontset, uint) *Font {
 // OverrideGetMetrics sets the "get_metrics" callback function.
 // a function to get overall metric information for the fonts
 //
-//	in the fontset; see [method@Pango.Fontset.get_metrics]
+//	in the fontset; see [Fontset.GetMetrics]
 func (x *FontsetClass) OverrideGetMetrics(cb func(*Fontset) *FontMetrics) {
 	if cb == nil {
 		x.xGetMetrics = 0
 	} else {
-		x.xGetMetrics = purego.NewCallback(func(FontsetVarp uintptr) *FontMetrics {
+		x.xGetMetrics = core.NewCallback(func(FontsetVarp uintptr) *FontMetrics {
+			defer core.RecoverPanic()
 			return cb(FontsetNewFromInternalPtr(FontsetVarp))
 		})
 	}
@@ -101,13 +102,13 @@ func (x *FontsetClass) OverrideGetMetrics(cb func(*Fontset) *FontMetrics) {
 // GetGetMetrics gets the "get_metrics" callback function.
 // a function to get overall metric information for the fonts
 //
-//	in the fontset; see [method@Pango.Fontset.get_metrics]
+//	in the fontset; see [Fontset.GetMetrics]
 func (x *FontsetClass) GetGetMetrics() func(*Fontset) *FontMetrics {
 	if x.xGetMetrics == 0 {
 		return nil
 	}
 	var rawCallback func(FontsetVarp uintptr) *FontMetrics
-	purego.RegisterFunc(&rawCallback, x.xGetMetrics)
+	core.RegisterFunc(&rawCallback, x.xGetMetrics)
 	return func(FontsetVar *Fontset) *FontMetrics {
 		return rawCallback(FontsetVar.GoPointer())
 	}
@@ -119,7 +120,8 @@ func (x *FontsetClass) OverrideGetLanguage(cb func(*Fontset) *Language) {
 	if cb == nil {
 		x.xGetLanguage = 0
 	} else {
-		x.xGetLanguage = purego.NewCallback(func(FontsetVarp uintptr) *Language {
+		x.xGetLanguage = core.NewCallback(func(FontsetVarp uintptr) *Language {
+			defer core.RecoverPanic()
 			return cb(FontsetNewFromInternalPtr(FontsetVarp))
 		})
 	}
@@ -132,7 +134,7 @@ func (x *FontsetClass) GetGetLanguage() func(*Fontset) *Language {
 		return nil
 	}
 	var rawCallback func(FontsetVarp uintptr) *Language
-	purego.RegisterFunc(&rawCallback, x.xGetLanguage)
+	core.RegisterFunc(&rawCallback, x.xGetLanguage)
 	return func(FontsetVar *Fontset) *Language {
 		return rawCallback(FontsetVar.GoPointer())
 	}
@@ -141,12 +143,13 @@ func (x *FontsetClass) GetGetLanguage() func(*Fontset) *Language {
 // OverrideForeach sets the "foreach" callback function.
 // Callback used when enumerating fonts in a fontset.
 //
-// See [method@Pango.Fontset.foreach].
+// See [Fontset.Foreach].
 func (x *FontsetClass) OverrideForeach(cb func(*Fontset, *FontsetForeachFunc, uintptr)) {
 	if cb == nil {
 		x.xForeach = 0
 	} else {
-		x.xForeach = purego.NewCallback(func(FontsetVarp uintptr, FuncVarp uintptr, DataVarp uintptr) {
+		x.xForeach = core.NewCallback(func(FontsetVarp uintptr, FuncVarp uintptr, DataVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(FontsetNewFromInternalPtr(FontsetVarp), (*FontsetForeachFunc)(unsafe.Pointer(FuncVarp)), DataVarp)
 		})
 	}
@@ -155,13 +158,13 @@ func (x *FontsetClass) OverrideForeach(cb func(*Fontset, *FontsetForeachFunc, ui
 // GetForeach gets the "foreach" callback function.
 // Callback used when enumerating fonts in a fontset.
 //
-// See [method@Pango.Fontset.foreach].
+// See [Fontset.Foreach].
 func (x *FontsetClass) GetForeach() func(*Fontset, *FontsetForeachFunc, uintptr) {
 	if x.xForeach == 0 {
 		return nil
 	}
 	var rawCallback func(FontsetVarp uintptr, FuncVarp uintptr, DataVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xForeach)
+	core.RegisterFunc(&rawCallback, x.xForeach)
 	return func(FontsetVar *Fontset, FuncVar *FontsetForeachFunc, DataVar uintptr) {
 		rawCallback(FontsetVar.GoPointer(), glib.NewCallback(FuncVar), DataVar)
 	}
@@ -172,7 +175,8 @@ func (x *FontsetClass) OverridePangoReserved1(cb func()) {
 	if cb == nil {
 		x.xPangoReserved1 = 0
 	} else {
-		x.xPangoReserved1 = purego.NewCallback(func() {
+		x.xPangoReserved1 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -184,7 +188,7 @@ func (x *FontsetClass) GetPangoReserved1() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved1)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved1)
 	return func() {
 		rawCallback()
 	}
@@ -195,7 +199,8 @@ func (x *FontsetClass) OverridePangoReserved2(cb func()) {
 	if cb == nil {
 		x.xPangoReserved2 = 0
 	} else {
-		x.xPangoReserved2 = purego.NewCallback(func() {
+		x.xPangoReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -207,7 +212,7 @@ func (x *FontsetClass) GetPangoReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved2)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -218,7 +223,8 @@ func (x *FontsetClass) OverridePangoReserved3(cb func()) {
 	if cb == nil {
 		x.xPangoReserved3 = 0
 	} else {
-		x.xPangoReserved3 = purego.NewCallback(func() {
+		x.xPangoReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -230,7 +236,7 @@ func (x *FontsetClass) GetPangoReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved3)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -241,7 +247,8 @@ func (x *FontsetClass) OverridePangoReserved4(cb func()) {
 	if cb == nil {
 		x.xPangoReserved4 = 0
 	} else {
-		x.xPangoReserved4 = purego.NewCallback(func() {
+		x.xPangoReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -253,7 +260,7 @@ func (x *FontsetClass) GetPangoReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved4)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -283,10 +290,10 @@ func FontsetNewFromInternalPtr(ptr uintptr) *Fontset {
 
 var xFontsetForeach func(uintptr, uintptr, uintptr)
 
-// Iterates through all the fonts in a fontset, calling @func for
+// Iterates through all the fonts in a fontset, calling func for
 // each one.
 //
-// If @func returns %TRUE, that stops the iteration.
+// If func returns true, that stops the iteration.
 func (x *Fontset) Foreach(FuncVar *FontsetForeachFunc, DataVar uintptr) {
 
 	var FuncVarRef uintptr
@@ -296,11 +303,12 @@ func (x *Fontset) Foreach(FuncVar *FontsetForeachFunc, DataVar uintptr) {
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) bool {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				return cbFn(arg0, arg1, arg2)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -350,7 +358,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -359,8 +367,10 @@ func init() {
 
 	core.PuregoSafeRegister(&xFontsetGLibType, libs, "pango_fontset_get_type")
 
-	core.PuregoSafeRegister(&xFontsetForeach, libs, "pango_fontset_foreach")
-	core.PuregoSafeRegister(&xFontsetGetFont, libs, "pango_fontset_get_font")
-	core.PuregoSafeRegister(&xFontsetGetMetrics, libs, "pango_fontset_get_metrics")
+	gobject.CheckClassSize("PangoFontsetClass", xFontsetGLibType, unsafe.Sizeof(FontsetClass{}))
+
+	core.PuregoRegisterSince(&xFontsetForeach, libs, "pango_fontset_foreach", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xFontsetGetFont, libs, "pango_fontset_get_font", "PANGO", "")
+	core.PuregoRegisterSince(&xFontsetGetMetrics, libs, "pango_fontset_get_metrics", "PANGO", "")
 
 }
@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -48,17 +47,17 @@ var xGlyphItemApplyAttrs func(uintptr, string, *AttrList) *glib.SList
 //
 // The idea is that if you have attributes that don't affect shaping,
 // such as color or underline, to avoid affecting shaping, you filter
-// them out ([method@Pango.AttrList.filter]), apply the shaping process
+// them out ([AttrList.Filter]), apply the shaping process
 // and then reapply them to the result using this function.
 //
 // All attributes that start or end inside a cluster are applied
 // to that cluster; for instance, if half of a cluster is underlined
 // and the other-half strikethrough, then the cluster will end
 // up with both underline and strikethrough attributes. In these
-// cases, it may happen that @item-&gt;extra_attrs for some of the
+// cases, it may happen that item->extra_attrs for some of the
 // result items can have multiple attributes of the same type.
 //
-// This function takes ownership of @glyph_item; it will be reused
+// This function takes ownership of glyph_item; it will be reused
 // as one of the elements in the list.
 func (x *GlyphItem) ApplyAttrs(TextVar string, ListVar *AttrList) *glib.SList {
 
@@ -92,7 +91,7 @@ var xGlyphItemGetLogicalWidths func(uintptr, string, *[]int)
 // When multiple characters compose a single cluster, the width of the
 // entire cluster is divided equally among the characters.
 //
-// See also [method@Pango.GlyphString.get_logical_widths].
+// See also [GlyphString.GetLogicalWidths].
 func (x *GlyphItem) GetLogicalWidths(TextVar string, LogicalWidthsVar *[]int) {
 
 	xGlyphItemGetLogicalWidths(x.GoPointer(), TextVar, LogicalWidthsVar)
@@ -101,7 +100,7 @@ func (x *GlyphItem) GetLogicalWidths(TextVar string, LogicalWidthsVar *[]int) {
 
 var xGlyphItemLetterSpace func(uintptr, string, []LogAttr, int)
 
-// Adds spacing between the graphemes of @glyph_item to
+// Adds spacing between the graphemes of glyph_item to
 // give the effect of typographic letter spacing.
 func (x *GlyphItem) LetterSpace(TextVar string, LogAttrsVar []LogAttr, LetterSpacingVar int) {
 
@@ -111,16 +110,16 @@ func (x *GlyphItem) LetterSpace(TextVar string, LogAttrsVar []LogAttr, LetterSpa
 
 var xGlyphItemSplit func(uintptr, string, int) *GlyphItem
 
-// Modifies @orig to cover only the text after @split_index, and
-// returns a new item that covers the text before @split_index that
-// used to be in @orig.
+// Modifies orig to cover only the text after split_index, and
+// returns a new item that covers the text before split_index that
+// used to be in orig.
 //
-// You can think of @split_index as the length of the returned item.
-// @split_index may not be 0, and it may not be greater than or equal
-// to the length of @orig (that is, there must be at least one byte
+// You can think of split_index as the length of the returned item.
+// split_index may not be 0, and it may not be greater than or equal
+// to the length of orig (that is, there must be at least one byte
 // assigned to each item, you can't create a zero-length item).
 //
-// This function is similar in function to pango_item_split() (and uses
+// This function is similar in function to [Item.Split] (and uses
 // it internally.)
 func (x *GlyphItem) Split(TextVar string, SplitIndexVar int) *GlyphItem {
 
@@ -132,41 +131,36 @@ func (x *GlyphItem) Split(TextVar string, SplitIndexVar int) *GlyphItem {
 // `PangoGlyphItem`.
 //
 // The *forward direction* of the iterator is the logical direction of text.
-// That is, with increasing @start_index and @start_char values. If @glyph_item
-// is right-to-left (that is, if `glyph_item-&gt;item-&gt;analysis.level` is odd),
-// then @start_glyph decreases as the iterator moves forward.  Moreover,
-// in right-to-left cases, @start_glyph is greater than @end_glyph.
+// That is, with increasing start_index and start_char values. If glyph_item
+// is right-to-left (that is, if `glyph_item->item->analysis.level` is odd),
+// then start_glyph decreases as the iterator moves forward.  Moreover,
+// in right-to-left cases, start_glyph is greater than end_glyph.
 //
 // An iterator should be initialized using either
-// pango_glyph_item_iter_init_start() or
-// pango_glyph_item_iter_init_end(), for forward and backward iteration
+// [GlyphItemIter.InitStart] or
+// [GlyphItemIter.InitEnd], for forward and backward iteration
 // respectively, and walked over using any desired mixture of
-// pango_glyph_item_iter_next_cluster() and
-// pango_glyph_item_iter_prev_cluster().
+// [GlyphItemIter.NextCluster] and
+// [GlyphItemIter.PrevCluster].
 //
 // A common idiom for doing a forward iteration over the clusters is:
 //
-// ```
-// PangoGlyphItemIter cluster_iter;
-// gboolean have_cluster;
-//
-// for (have_cluster = pango_glyph_item_iter_init_start (&amp;cluster_iter,
-//
-//	                                                 glyph_item, text);
-//	have_cluster;
-//	have_cluster = pango_glyph_item_iter_next_cluster (&amp;cluster_iter))
+//	PangoGlyphItemIter cluster_iter;
+//	gboolean have_cluster;
 //
+//	for (have_cluster = pango_glyph_item_iter_init_start (&cluster_iter,
+//	                                                      glyph_item, text);
+//	     have_cluster;
+//	     have_cluster = pango_glyph_item_iter_next_cluster (&cluster_iter))
 //	{
 //	  ...
 //	}
 //
-// ```
-//
-// Note that @text is the start of the text for layout, which is then
-// indexed by `glyph_item-&gt;item-&gt;offset` to get to the text of @glyph_item.
-// The @start_index and @end_index values can directly index into @text. The
-// @start_glyph, @end_glyph, @start_char, and @end_char values however are
-// zero-based for the @glyph_item.  For each cluster, the item pointed at by
+// Note that text is the start of the text for layout, which is then
+// indexed by `glyph_item->item->offset` to get to the text of glyph_item.
+// The start_index and end_index values can directly index into text. The
+// start_glyph, end_glyph, start_char, and end_char values however are
+// zero-based for the glyph_item.  For each cluster, the item pointed at by
 // the start variables is included in the cluster while the one pointed at by
 // end variables is not.
 //
@@ -269,7 +263,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -278,20 +272,20 @@ func init() {
 
 	core.PuregoSafeRegister(&xGlyphItemGLibType, libs, "pango_glyph_item_get_type")
 
-	core.PuregoSafeRegister(&xGlyphItemApplyAttrs, libs, "pango_glyph_item_apply_attrs")
-	core.PuregoSafeRegister(&xGlyphItemCopy, libs, "pango_glyph_item_copy")
-	core.PuregoSafeRegister(&xGlyphItemFree, libs, "pango_glyph_item_free")
-	core.PuregoSafeRegister(&xGlyphItemGetLogicalWidths, libs, "pango_glyph_item_get_logical_widths")
-	core.PuregoSafeRegister(&xGlyphItemLetterSpace, libs, "pango_glyph_item_letter_space")
-	core.PuregoSafeRegister(&xGlyphItemSplit, libs, "pango_glyph_item_split")
+	core.PuregoRegisterSince(&xGlyphItemApplyAttrs, libs, "pango_glyph_item_apply_attrs", "PANGO", "1.2")
+	core.PuregoRegisterSince(&xGlyphItemCopy, libs, "pango_glyph_item_copy", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xGlyphItemFree, libs, "pango_glyph_item_free", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xGlyphItemGetLogicalWidths, libs, "pango_glyph_item_get_logical_widths", "PANGO", "1.26")
+	core.PuregoRegisterSince(&xGlyphItemLetterSpace, libs, "pango_glyph_item_letter_space", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xGlyphItemSplit, libs, "pango_glyph_item_split", "PANGO", "1.2")
 
 	core.PuregoSafeRegister(&xGlyphItemIterGLibType, libs, "pango_glyph_item_iter_get_type")
 
-	core.PuregoSafeRegister(&xGlyphItemIterCopy, libs, "pango_glyph_item_iter_copy")
-	core.PuregoSafeRegister(&xGlyphItemIterFree, libs, "pango_glyph_item_iter_free")
-	core.PuregoSafeRegister(&xGlyphItemIterInitEnd, libs, "pango_glyph_item_iter_init_end")
-	core.PuregoSafeRegister(&xGlyphItemIterInitStart, libs, "pango_glyph_item_iter_init_start")
-	core.PuregoSafeRegister(&xGlyphItemIterNextCluster, libs, "pango_glyph_item_iter_next_cluster")
-	core.PuregoSafeRegister(&xGlyphItemIterPrevCluster, libs, "pango_glyph_item_iter_prev_cluster")
+	core.PuregoRegisterSince(&xGlyphItemIterCopy, libs, "pango_glyph_item_iter_copy", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xGlyphItemIterFree, libs, "pango_glyph_item_iter_free", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xGlyphItemIterInitEnd, libs, "pango_glyph_item_iter_init_end", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xGlyphItemIterInitStart, libs, "pango_glyph_item_iter_init_start", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xGlyphItemIterNextCluster, libs, "pango_glyph_item_iter_next_cluster", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xGlyphItemIterPrevCluster, libs, "pango_glyph_item_iter_prev_cluster", "PANGO", "1.22")
 
 }
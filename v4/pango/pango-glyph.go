@@ -2,10 +2,11 @@
 package pango
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -13,9 +14,9 @@ import (
 // The `PangoGlyphGeometry` structure contains width and positioning
 // information for a single glyph.
 //
-// Note that @width is not guaranteed to be the same as the glyph
+// Note that width is not guaranteed to be the same as the glyph
 // extents. Kerning and other positioning applied during shaping will
-// affect both the @width and the @x_offset for the glyphs in the
+// affect both the width and the x_offset for the glyphs in the
 // glyph string that results from shaping.
 //
 // The information in this struct is intended for rendering the glyphs,
@@ -82,6 +83,14 @@ func (x *GlyphString) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewGlyphStringAlloc returns a zeroed GlyphString allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated GlyphString. Release it with Free.
+func NewGlyphStringAlloc() *GlyphString {
+	cret := core.GMalloc0(max(unsafe.Sizeof(GlyphString{}), 32))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*GlyphString)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xNewGlyphString func() *GlyphString
 
 // Create a new `PangoGlyphString`.
@@ -104,12 +113,10 @@ var xGlyphStringExtents func(uintptr, uintptr, *Rectangle, *Rectangle)
 
 // Compute the logical and ink extents of a glyph string.
 //
-// See the documentation for [method@Pango.Font.get_glyph_extents] for details
+// See the documentation for [Font.GetGlyphExtents] for details
 // about the interpretation of the rectangles.
 //
 // Examples of logical (red) and ink (green) rects:
-//
-// ![](rects1.png) ![](rects2.png)
 func (x *GlyphString) Extents(FontVar *Font, InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
 	xGlyphStringExtents(x.GoPointer(), FontVar.GoPointer(), InkRectVar, LogicalRectVar)
@@ -146,7 +153,7 @@ var xGlyphStringGetLogicalWidths func(uintptr, string, int, int, *[]int)
 // When multiple characters compose a single cluster, the width of the
 // entire cluster is divided equally among the characters.
 //
-// See also [method@Pango.GlyphItem.get_logical_widths].
+// See also [GlyphItem.GetLogicalWidths].
 func (x *GlyphString) GetLogicalWidths(TextVar string, LengthVar int, EmbeddingLevelVar int, LogicalWidthsVar *[]int) {
 
 	xGlyphStringGetLogicalWidths(x.GoPointer(), TextVar, LengthVar, EmbeddingLevelVar, LogicalWidthsVar)
@@ -157,10 +164,10 @@ var xGlyphStringGetWidth func(uintptr) int
 
 // Computes the logical width of the glyph string.
 //
-// This can also be computed using [method@Pango.GlyphString.extents].
+// This can also be computed using [GlyphString.Extents].
 // However, since this only computes the width, it's much faster. This
 // is in fact only a convenience function that computes the sum of
-// @geometry.width for each glyph in the @glyphs.
+// geometry.width for each glyph in the glyphs.
 func (x *GlyphString) GetWidth() int {
 
 	cret := xGlyphStringGetWidth(x.GoPointer())
@@ -175,13 +182,6 @@ var xGlyphStringIndexToX func(uintptr, string, int, *Analysis, int, bool, *int)
 // Character positions are obtained using font metrics for ligatures
 // where available, and computed by dividing up each cluster
 // into equal portions, otherwise.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="glyphstring-positions-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Glyph positions" src="glyphstring-positions-light.png"&gt;
-//
-// &lt;/picture&gt;
 func (x *GlyphString) IndexToX(TextVar string, LengthVar int, AnalysisVar *Analysis, IndexVar int, TrailingVar bool, XPosVar *int) {
 
 	xGlyphStringIndexToX(x.GoPointer(), TextVar, LengthVar, AnalysisVar, IndexVar, TrailingVar, XPosVar)
@@ -192,7 +192,7 @@ var xGlyphStringIndexToXFull func(uintptr, string, int, *Analysis, *LogAttr, int
 
 // Converts from character position to x position.
 //
-// This variant of [method@Pango.GlyphString.index_to_x] additionally
+// This variant of [GlyphString.IndexToX] additionally
 // accepts a `PangoLogAttr` array. The grapheme boundary information
 // in it can be used to disambiguate positioning inside some complex
 // clusters.
@@ -263,8 +263,8 @@ type GlyphUnit = int32
 
 // Flags influencing the shaping process.
 //
-// `PangoShapeFlags` can be passed to [func@Pango.shape_with_flags].
-type ShapeFlags int
+// `PangoShapeFlags` can be passed to [ShapeWithFlags].
+type ShapeFlags uint32
 
 var xShapeFlagsGLibType func() types.GType
 
@@ -281,26 +281,63 @@ const (
 	ShapeRoundPositionsValue ShapeFlags = 1
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ShapeFlags) GLibType() types.GType {
+	return xShapeFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ShapeFlags) Has(flags ShapeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ShapeFlags) Set(flags ShapeFlags) ShapeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ShapeFlags) Clear(flags ShapeFlags) ShapeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ShapeFlags) String() string {
+	if x == 0 {
+		return "PANGO_SHAPE_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&ShapeRoundPositionsValue != 0 && x&ShapeRoundPositionsValue == ShapeRoundPositionsValue {
+		names = append(names, "PANGO_SHAPE_ROUND_POSITIONS")
+		rest &^= ShapeRoundPositionsValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 var xShape func(string, int, *Analysis, *GlyphString)
 
-// Convert the characters in @text into glyphs.
+// Convert the characters in text into glyphs.
 //
 // Given a segment of text and the corresponding `PangoAnalysis` structure
-// returned from [func@Pango.itemize], convert the characters into glyphs. You
-// may also pass in only a substring of the item from [func@Pango.itemize].
+// returned from [Itemize], convert the characters into glyphs. You
+// may also pass in only a substring of the item from [Itemize].
 //
-// It is recommended that you use [func@Pango.shape_full] instead, since
+// It is recommended that you use [ShapeFull] instead, since
 // that API allows for shaping interaction happening across text item
 // boundaries.
 //
 // Some aspects of hyphen insertion and text transformation (in particular,
 // capitalization) require log attrs, and thus can only be handled by
-// [func@Pango.shape_item].
+// [ShapeItem].
 //
-// Note that the extra attributes in the @analyis that is returned from
-// [func@Pango.itemize] have indices that are relative to the entire paragraph,
+// Note that the extra attributes in the analyis that is returned from
+// [Itemize] have indices that are relative to the entire paragraph,
 // so you need to subtract the item offset from their indices before
-// calling [func@Pango.shape].
+// calling [Shape].
 func Shape(TextVar string, LengthVar int, AnalysisVar *Analysis, GlyphsVar *GlyphString) {
 
 	xShape(TextVar, LengthVar, AnalysisVar, GlyphsVar)
@@ -309,27 +346,27 @@ func Shape(TextVar string, LengthVar int, AnalysisVar *Analysis, GlyphsVar *Glyp
 
 var xShapeFull func(string, int, uintptr, int, *Analysis, *GlyphString)
 
-// Convert the characters in @text into glyphs.
+// Convert the characters in text into glyphs.
 //
 // Given a segment of text and the corresponding `PangoAnalysis` structure
-// returned from [func@Pango.itemize], convert the characters into glyphs.
-// You may also pass in only a substring of the item from [func@Pango.itemize].
+// returned from [Itemize], convert the characters into glyphs.
+// You may also pass in only a substring of the item from [Itemize].
 //
-// This is similar to [func@Pango.shape], except it also can optionally take
+// This is similar to [Shape], except it also can optionally take
 // the full paragraph text as input, which will then be used to perform
 // certain cross-item shaping interactions. If you have access to the broader
-// text of which @item_text is part of, provide the broader text as
-// @paragraph_text. If @paragraph_text is %NULL, item text is used instead.
+// text of which item_text is part of, provide the broader text as
+// paragraph_text. If paragraph_text is nil, item text is used instead.
 //
 // Some aspects of hyphen insertion and text transformation (in particular,
 // capitalization) require log attrs, and thus can only be handled by
-// [func@Pango.shape_item].
+// [ShapeItem].
 //
-// Note that the extra attributes in the @analyis that is returned from
-// [func@Pango.itemize] have indices that are relative to the entire paragraph,
-// so you do not pass the full paragraph text as @paragraph_text, you need
+// Note that the extra attributes in the analyis that is returned from
+// [Itemize] have indices that are relative to the entire paragraph,
+// so you do not pass the full paragraph text as paragraph_text, you need
 // to subtract the item offset from their indices before calling
-// [func@Pango.shape_full].
+// [ShapeFull].
 func ShapeFull(ItemTextVar string, ItemLengthVar int, ParagraphTextVar *string, ParagraphLengthVar int, AnalysisVar *Analysis, GlyphsVar *GlyphString) {
 
 	ParagraphTextVarPtr := core.GStrdupNullable(ParagraphTextVar)
@@ -341,19 +378,19 @@ func ShapeFull(ItemTextVar string, ItemLengthVar int, ParagraphTextVar *string,
 
 var xShapeItem func(*Item, uintptr, int, *LogAttr, *GlyphString, ShapeFlags)
 
-// Convert the characters in @item into glyphs.
+// Convert the characters in item into glyphs.
 //
-// This is similar to [func@Pango.shape_with_flags], except it takes a
-// `PangoItem` instead of separate @item_text and @analysis arguments.
+// This is similar to [ShapeWithFlags], except it takes a
+// `PangoItem` instead of separate item_text and analysis arguments.
 //
-// It also takes @log_attrs, which are needed for implementing some aspects
+// It also takes log_attrs, which are needed for implementing some aspects
 // of hyphen insertion and text transforms (in particular, capitalization).
 //
-// Note that the extra attributes in the @analyis that is returned from
-// [func@Pango.itemize] have indices that are relative to the entire paragraph,
-// so you do not pass the full paragraph text as @paragraph_text, you need
+// Note that the extra attributes in the analyis that is returned from
+// [Itemize] have indices that are relative to the entire paragraph,
+// so you do not pass the full paragraph text as paragraph_text, you need
 // to subtract the item offset from their indices before calling
-// [func@Pango.shape_with_flags].
+// [ShapeWithFlags].
 func ShapeItem(ItemVar *Item, ParagraphTextVar *string, ParagraphLengthVar int, LogAttrsVar *LogAttr, GlyphsVar *GlyphString, FlagsVar ShapeFlags) {
 
 	ParagraphTextVarPtr := core.GStrdupNullable(ParagraphTextVar)
@@ -365,24 +402,24 @@ func ShapeItem(ItemVar *Item, ParagraphTextVar *string, ParagraphLengthVar int,
 
 var xShapeWithFlags func(string, int, uintptr, int, *Analysis, *GlyphString, ShapeFlags)
 
-// Convert the characters in @text into glyphs.
+// Convert the characters in text into glyphs.
 //
 // Given a segment of text and the corresponding `PangoAnalysis` structure
-// returned from [func@Pango.itemize], convert the characters into glyphs.
-// You may also pass in only a substring of the item from [func@Pango.itemize].
+// returned from [Itemize], convert the characters into glyphs.
+// You may also pass in only a substring of the item from [Itemize].
 //
-// This is similar to [func@Pango.shape_full], except it also takes flags
+// This is similar to [ShapeFull], except it also takes flags
 // that can influence the shaping process.
 //
 // Some aspects of hyphen insertion and text transformation (in particular,
 // capitalization) require log attrs, and thus can only be handled by
-// [func@Pango.shape_item].
+// [ShapeItem].
 //
-// Note that the extra attributes in the @analyis that is returned from
-// [func@Pango.itemize] have indices that are relative to the entire paragraph,
-// so you do not pass the full paragraph text as @paragraph_text, you need
+// Note that the extra attributes in the analyis that is returned from
+// [Itemize] have indices that are relative to the entire paragraph,
+// so you do not pass the full paragraph text as paragraph_text, you need
 // to subtract the item offset from their indices before calling
-// [func@Pango.shape_with_flags].
+// [ShapeWithFlags].
 func ShapeWithFlags(ItemTextVar string, ItemLengthVar int, ParagraphTextVar *string, ParagraphLengthVar int, AnalysisVar *Analysis, GlyphsVar *GlyphString, FlagsVar ShapeFlags) {
 
 	ParagraphTextVarPtr := core.GStrdupNullable(ParagraphTextVar)
@@ -397,7 +434,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -406,24 +443,24 @@ func init() {
 
 	core.PuregoSafeRegister(&xShapeFlagsGLibType, libs, "pango_shape_flags_get_type")
 
-	core.PuregoSafeRegister(&xShape, libs, "pango_shape")
-	core.PuregoSafeRegister(&xShapeFull, libs, "pango_shape_full")
-	core.PuregoSafeRegister(&xShapeItem, libs, "pango_shape_item")
-	core.PuregoSafeRegister(&xShapeWithFlags, libs, "pango_shape_with_flags")
+	core.PuregoRegisterSince(&xShape, libs, "pango_shape", "PANGO", "")
+	core.PuregoRegisterSince(&xShapeFull, libs, "pango_shape_full", "PANGO", "1.32")
+	core.PuregoRegisterSince(&xShapeItem, libs, "pango_shape_item", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xShapeWithFlags, libs, "pango_shape_with_flags", "PANGO", "1.44")
 
 	core.PuregoSafeRegister(&xGlyphStringGLibType, libs, "pango_glyph_string_get_type")
 
-	core.PuregoSafeRegister(&xNewGlyphString, libs, "pango_glyph_string_new")
-
-	core.PuregoSafeRegister(&xGlyphStringCopy, libs, "pango_glyph_string_copy")
-	core.PuregoSafeRegister(&xGlyphStringExtents, libs, "pango_glyph_string_extents")
-	core.PuregoSafeRegister(&xGlyphStringExtentsRange, libs, "pango_glyph_string_extents_range")
-	core.PuregoSafeRegister(&xGlyphStringFree, libs, "pango_glyph_string_free")
-	core.PuregoSafeRegister(&xGlyphStringGetLogicalWidths, libs, "pango_glyph_string_get_logical_widths")
-	core.PuregoSafeRegister(&xGlyphStringGetWidth, libs, "pango_glyph_string_get_width")
-	core.PuregoSafeRegister(&xGlyphStringIndexToX, libs, "pango_glyph_string_index_to_x")
-	core.PuregoSafeRegister(&xGlyphStringIndexToXFull, libs, "pango_glyph_string_index_to_x_full")
-	core.PuregoSafeRegister(&xGlyphStringSetSize, libs, "pango_glyph_string_set_size")
-	core.PuregoSafeRegister(&xGlyphStringXToIndex, libs, "pango_glyph_string_x_to_index")
+	core.PuregoRegisterSince(&xNewGlyphString, libs, "pango_glyph_string_new", "PANGO", "")
+
+	core.PuregoRegisterSince(&xGlyphStringCopy, libs, "pango_glyph_string_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringExtents, libs, "pango_glyph_string_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringExtentsRange, libs, "pango_glyph_string_extents_range", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringFree, libs, "pango_glyph_string_free", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringGetLogicalWidths, libs, "pango_glyph_string_get_logical_widths", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringGetWidth, libs, "pango_glyph_string_get_width", "PANGO", "1.14")
+	core.PuregoRegisterSince(&xGlyphStringIndexToX, libs, "pango_glyph_string_index_to_x", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringIndexToXFull, libs, "pango_glyph_string_index_to_x_full", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xGlyphStringSetSize, libs, "pango_glyph_string_set_size", "PANGO", "")
+	core.PuregoRegisterSince(&xGlyphStringXToIndex, libs, "pango_glyph_string_x_to_index", "PANGO", "")
 
 }
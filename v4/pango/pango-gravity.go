@@ -2,7 +2,8 @@
 package pango
 
 import (
-	"github.com/jwijenbergh/purego"
+	"fmt"
+
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -11,15 +12,15 @@ import (
 // of text.
 //
 // This is useful when rendering vertical text layouts. In those situations,
-// the layout is rotated using a non-identity [struct@Pango.Matrix], and then
+// the layout is rotated using a non-identity [Matrix], and then
 // glyph orientation is controlled using `PangoGravity`.
 //
 // Not every value in this enumeration makes sense for every usage of
-// `PangoGravity`; for example, %PANGO_GRAVITY_AUTO only can be passed to
-// [method@Pango.Context.set_base_gravity] and can only be returned by
-// [method@Pango.Context.get_base_gravity].
+// `PangoGravity`; for example, [GravityAutoValue] only can be passed to
+// [Context.SetBaseGravity] and can only be returned by
+// [Context.GetBaseGravity].
 //
-// See also: [enum@Pango.GravityHint]
+// See also: [GravityHint]
 type Gravity int
 
 var xGravityGLibType func() types.GType
@@ -30,24 +31,75 @@ func GravityGLibType() types.GType {
 
 const (
 
-	// Glyphs stand upright (default) &lt;img align="right" valign="center" src="m-south.png"&gt;
+	// Glyphs stand upright (default)
 	GravitySouthValue Gravity = 0
-	// Glyphs are rotated 90 degrees counter-clockwise. &lt;img align="right" valign="center" src="m-east.png"&gt;
+	// Glyphs are rotated 90 degrees counter-clockwise.
 	GravityEastValue Gravity = 1
-	// Glyphs are upside-down. &lt;img align="right" valign="cener" src="m-north.png"&gt;
+	// Glyphs are upside-down.
 	GravityNorthValue Gravity = 2
-	// Glyphs are rotated 90 degrees clockwise. &lt;img align="right" valign="center" src="m-west.png"&gt;
+	// Glyphs are rotated 90 degrees clockwise.
 	GravityWestValue Gravity = 3
 	// Gravity is resolved from the context matrix
 	GravityAutoValue Gravity = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Gravity) String() string {
+	switch x {
+	case GravitySouthValue:
+		return "PANGO_GRAVITY_SOUTH"
+	case GravityEastValue:
+		return "PANGO_GRAVITY_EAST"
+	case GravityNorthValue:
+		return "PANGO_GRAVITY_NORTH"
+	case GravityWestValue:
+		return "PANGO_GRAVITY_WEST"
+	case GravityAutoValue:
+		return "PANGO_GRAVITY_AUTO"
+	}
+	return fmt.Sprintf("Gravity(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Gravity) Nick() string {
+	switch x {
+	case GravitySouthValue:
+		return "south"
+	case GravityEastValue:
+		return "east"
+	case GravityNorthValue:
+		return "north"
+	case GravityWestValue:
+		return "west"
+	case GravityAutoValue:
+		return "auto"
+	}
+	return ""
+}
+
+// GravityFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GravityFromString(s string) (Gravity, bool) {
+	switch s {
+	case "PANGO_GRAVITY_SOUTH", "south":
+		return GravitySouthValue, true
+	case "PANGO_GRAVITY_EAST", "east":
+		return GravityEastValue, true
+	case "PANGO_GRAVITY_NORTH", "north":
+		return GravityNorthValue, true
+	case "PANGO_GRAVITY_WEST", "west":
+		return GravityWestValue, true
+	case "PANGO_GRAVITY_AUTO", "auto":
+		return GravityAutoValue, true
+	}
+	return 0, false
+}
+
 // `PangoGravityHint` defines how horizontal scripts should behave in a
 // vertical context.
 //
 // That is, English excerpts in a vertical paragraph for example.
 //
-// See also [enum@Pango.Gravity]
+// See also [Gravity]
 type GravityHint int
 
 var xGravityHintGLibType func() types.GType
@@ -71,6 +123,45 @@ const (
 	GravityHintLineValue GravityHint = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x GravityHint) String() string {
+	switch x {
+	case GravityHintNaturalValue:
+		return "PANGO_GRAVITY_HINT_NATURAL"
+	case GravityHintStrongValue:
+		return "PANGO_GRAVITY_HINT_STRONG"
+	case GravityHintLineValue:
+		return "PANGO_GRAVITY_HINT_LINE"
+	}
+	return fmt.Sprintf("GravityHint(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x GravityHint) Nick() string {
+	switch x {
+	case GravityHintNaturalValue:
+		return "natural"
+	case GravityHintStrongValue:
+		return "strong"
+	case GravityHintLineValue:
+		return "line"
+	}
+	return ""
+}
+
+// GravityHintFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func GravityHintFromString(s string) (GravityHint, bool) {
+	switch s {
+	case "PANGO_GRAVITY_HINT_NATURAL", "natural":
+		return GravityHintNaturalValue, true
+	case "PANGO_GRAVITY_HINT_STRONG", "strong":
+		return GravityHintStrongValue, true
+	case "PANGO_GRAVITY_HINT_LINE", "line":
+		return GravityHintLineValue, true
+	}
+	return 0, false
+}
+
 var xGravityGetForMatrix func(*Matrix) Gravity
 
 // Finds the gravity that best matches the rotation component
@@ -87,9 +178,9 @@ var xGravityGetForScript func(Script, Gravity, GravityHint) Gravity
 //
 // The gravity is determined based on the script, base gravity, and hint.
 //
-// If @base_gravity is %PANGO_GRAVITY_AUTO, it is first replaced with the
-// preferred gravity of @script.  To get the preferred gravity of a script,
-// pass %PANGO_GRAVITY_AUTO and %PANGO_GRAVITY_HINT_STRONG in.
+// If base_gravity is [GravityAutoValue], it is first replaced with the
+// preferred gravity of script.  To get the preferred gravity of a script,
+// pass [GravityAutoValue] and [GravityHintStrongValue] in.
 func GravityGetForScript(ScriptVar Script, BaseGravityVar Gravity, HintVar GravityHint) Gravity {
 
 	cret := xGravityGetForScript(ScriptVar, BaseGravityVar, HintVar)
@@ -104,15 +195,15 @@ var xGravityGetForScriptAndWidth func(Script, bool, Gravity, GravityHint) Gravit
 // The gravity is determined based on the script, East Asian width,
 // base gravity, and hint,
 //
-// This function is similar to [func@Pango.Gravity.get_for_script] except
+// This function is similar to [GravityGetForScript] except
 // that this function makes a distinction between narrow/half-width and
 // wide/full-width characters also. Wide/full-width characters always
 // stand *upright*, that is, they always take the base gravity,
 // whereas narrow/full-width characters are always rotated in vertical
 // context.
 //
-// If @base_gravity is %PANGO_GRAVITY_AUTO, it is first replaced with the
-// preferred gravity of @script.
+// If base_gravity is [GravityAutoValue], it is first replaced with the
+// preferred gravity of script.
 func GravityGetForScriptAndWidth(ScriptVar Script, WideVar bool, BaseGravityVar Gravity, HintVar GravityHint) Gravity {
 
 	cret := xGravityGetForScriptAndWidth(ScriptVar, WideVar, BaseGravityVar, HintVar)
@@ -123,7 +214,7 @@ var xGravityToRotation func(Gravity) float64
 
 // Converts a `PangoGravity` value to its natural rotation in radians.
 //
-// Note that [method@Pango.Matrix.rotate] takes angle in degrees, not radians.
+// Note that [Matrix.Rotate] takes angle in degrees, not radians.
 // So, to call [method@Pango.Matrix,rotate] with the output of this function
 // you should multiply it by (180. / G_PI).
 func GravityToRotation(GravityVar Gravity) float64 {
@@ -137,7 +228,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -148,9 +239,9 @@ func init() {
 
 	core.PuregoSafeRegister(&xGravityHintGLibType, libs, "pango_gravity_hint_get_type")
 
-	core.PuregoSafeRegister(&xGravityGetForMatrix, libs, "pango_gravity_get_for_matrix")
-	core.PuregoSafeRegister(&xGravityGetForScript, libs, "pango_gravity_get_for_script")
-	core.PuregoSafeRegister(&xGravityGetForScriptAndWidth, libs, "pango_gravity_get_for_script_and_width")
-	core.PuregoSafeRegister(&xGravityToRotation, libs, "pango_gravity_to_rotation")
+	core.PuregoRegisterSince(&xGravityGetForMatrix, libs, "pango_gravity_get_for_matrix", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xGravityGetForScript, libs, "pango_gravity_get_for_script", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xGravityGetForScriptAndWidth, libs, "pango_gravity_get_for_script_and_width", "PANGO", "1.26")
+	core.PuregoRegisterSince(&xGravityToRotation, libs, "pango_gravity_to_rotation", "PANGO", "1.16")
 
 }
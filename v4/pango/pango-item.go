@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -42,7 +41,7 @@ func (x *Analysis) GoPointer() uintptr {
 // The `PangoItem` structure stores information about a segment of text.
 //
 // You typically obtain `PangoItems` by itemizing a piece of text
-// with [func@itemize].
+// with itemize.
 type Item struct {
 	_ structs.HostLayout
 
@@ -80,10 +79,10 @@ var xItemApplyAttrs func(uintptr, *AttrIterator)
 //
 // The idea is that you have attributes that don't affect itemization,
 // such as font features, so you filter them out using
-// [method@Pango.AttrList.filter], itemize your text, then reapply the
+// [AttrList.Filter], itemize your text, then reapply the
 // attributes to the resulting items using this function.
 //
-// The @iter should be positioned before the range of the item,
+// The iter should be positioned before the range of the item,
 // and will be advanced past it. This function is meant to be called
 // in a loop over the items resulting from itemization, while passing
 // the iter to each call.
@@ -127,17 +126,17 @@ func (x *Item) GetCharOffset() int {
 
 var xItemSplit func(uintptr, int, int) *Item
 
-// Modifies @orig to cover only the text after @split_index, and
-// returns a new item that covers the text before @split_index that
-// used to be in @orig.
+// Modifies orig to cover only the text after split_index, and
+// returns a new item that covers the text before split_index that
+// used to be in orig.
 //
-// You can think of @split_index as the length of the returned item.
-// @split_index may not be 0, and it may not be greater than or equal
-// to the length of @orig (that is, there must be at least one byte
+// You can think of split_index as the length of the returned item.
+// split_index may not be 0, and it may not be greater than or equal
+// to the length of orig (that is, there must be at least one byte
 // assigned to each item, you can't create a zero-length item).
-// @split_offset is the length of the first item in chars, and must be
+// split_offset is the length of the first item in chars, and must be
 // provided because the text used to generate the item isn't available,
-// so `pango_item_split()` can't count the char length of the split items
+// so `[Item.Split]` can't count the char length of the split items
 // itself.
 func (x *Item) Split(SplitIndexVar int, SplitOffsetVar int) *Item {
 
@@ -161,15 +160,15 @@ var xItemize func(uintptr, string, int, int, *AttrList, *AttrIterator) *glib.Lis
 // Breaks a piece of text into segments with consistent directional
 // level and font.
 //
-// Each byte of @text will be contained in exactly one of the items in the
+// Each byte of text will be contained in exactly one of the items in the
 // returned list; the generated list of items will be in logical order (the
 // start offsets of the items are ascending).
 //
-// @cached_iter should be an iterator over @attrs currently positioned
-// at a range before or containing @start_index; @cached_iter will be
+// cached_iter should be an iterator over attrs currently positioned
+// at a range before or containing start_index; cached_iter will be
 // advanced to the range covering the position just after
-// @start_index + @length. (i.e. if itemizing in a loop, just keep passing
-// in the same @cached_iter).
+// start_index + length. (i.e. if itemizing in a loop, just keep passing
+// in the same cached_iter).
 func Itemize(ContextVar *Context, TextVar string, StartIndexVar int, LengthVar int, AttrsVar *AttrList, CachedIterVar *AttrIterator) *glib.List {
 
 	cret := xItemize(ContextVar.GoPointer(), TextVar, StartIndexVar, LengthVar, AttrsVar, CachedIterVar)
@@ -178,11 +177,11 @@ func Itemize(ContextVar *Context, TextVar string, StartIndexVar int, LengthVar i
 
 var xItemizeWithBaseDir func(uintptr, Direction, string, int, int, *AttrList, *AttrIterator) *glib.List
 
-// Like `pango_itemize()`, but with an explicitly specified base direction.
+// Like `[Itemize]`, but with an explicitly specified base direction.
 //
 // The base direction is used when computing bidirectional levels.
-// [func@itemize] gets the base direction from the `PangoContext`
-// (see [method@Pango.Context.set_base_dir]).
+// itemize gets the base direction from the `PangoContext`
+// (see [Context.SetBaseDir]).
 func ItemizeWithBaseDir(ContextVar *Context, BaseDirVar Direction, TextVar string, StartIndexVar int, LengthVar int, AttrsVar *AttrList, CachedIterVar *AttrIterator) *glib.List {
 
 	cret := xItemizeWithBaseDir(ContextVar.GoPointer(), BaseDirVar, TextVar, StartIndexVar, LengthVar, AttrsVar, CachedIterVar)
@@ -211,25 +210,25 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xItemize, libs, "pango_itemize")
-	core.PuregoSafeRegister(&xItemizeWithBaseDir, libs, "pango_itemize_with_base_dir")
-	core.PuregoSafeRegister(&xReorderItems, libs, "pango_reorder_items")
+	core.PuregoRegisterSince(&xItemize, libs, "pango_itemize", "PANGO", "")
+	core.PuregoRegisterSince(&xItemizeWithBaseDir, libs, "pango_itemize_with_base_dir", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xReorderItems, libs, "pango_reorder_items", "PANGO", "")
 
 	core.PuregoSafeRegister(&xItemGLibType, libs, "pango_item_get_type")
 
-	core.PuregoSafeRegister(&xNewItem, libs, "pango_item_new")
+	core.PuregoRegisterSince(&xNewItem, libs, "pango_item_new", "PANGO", "")
 
-	core.PuregoSafeRegister(&xItemApplyAttrs, libs, "pango_item_apply_attrs")
-	core.PuregoSafeRegister(&xItemCopy, libs, "pango_item_copy")
-	core.PuregoSafeRegister(&xItemFree, libs, "pango_item_free")
-	core.PuregoSafeRegister(&xItemGetCharOffset, libs, "pango_item_get_char_offset")
-	core.PuregoSafeRegister(&xItemSplit, libs, "pango_item_split")
+	core.PuregoRegisterSince(&xItemApplyAttrs, libs, "pango_item_apply_attrs", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xItemCopy, libs, "pango_item_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xItemFree, libs, "pango_item_free", "PANGO", "")
+	core.PuregoRegisterSince(&xItemGetCharOffset, libs, "pango_item_get_char_offset", "PANGO", "1.54")
+	core.PuregoRegisterSince(&xItemSplit, libs, "pango_item_split", "PANGO", "")
 
 }
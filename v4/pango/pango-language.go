@@ -2,7 +2,6 @@
 package pango
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 )
 
@@ -18,7 +17,7 @@ var xLanguageFromString func(uintptr) *Language
 // lowercase, mapping '_' to '-', and stripping all characters other
 // than letters and '-'.
 //
-// Use [func@Pango.Language.get_default] if you want to get the
+// Use [LanguageGetDefault] if you want to get the
 // `PangoLanguage` for the current locale of the process.
 func LanguageFromString(LanguageVar *string) *Language {
 
@@ -61,7 +60,7 @@ var xLanguageGetDefault func() *Language
 //
 // Also note that this function will not do the right thing if you
 // use per-thread locales with uselocale(). In that case, you should
-// just call pango_language_from_string() yourself.
+// just call [LanguageFromString] yourself.
 func LanguageGetDefault() *Language {
 
 	cret := xLanguageGetDefault()
@@ -75,10 +74,10 @@ var xLanguageGetPreferred func() uintptr
 // The list is specified by the `PANGO_LANGUAGE` or `LANGUAGE`
 // environment variables, in order of preference. Note that this
 // list does not necessarily include the language returned by
-// [func@Pango.Language.get_default].
+// [LanguageGetDefault].
 //
 // When choosing language-specific resources, such as the sample
-// text returned by [method@Pango.Language.get_sample_string],
+// text returned by [Language.GetSampleString],
 // you should first try the default language, followed by the
 // languages returned by this function.
 func LanguageGetPreferred() uintptr {
@@ -92,15 +91,15 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xLanguageFromString, libs, "pango_language_from_string")
-	core.PuregoSafeRegister(&xLanguageGetDefault, libs, "pango_language_get_default")
-	core.PuregoSafeRegister(&xLanguageGetPreferred, libs, "pango_language_get_preferred")
+	core.PuregoRegisterSince(&xLanguageFromString, libs, "pango_language_from_string", "PANGO", "")
+	core.PuregoRegisterSince(&xLanguageGetDefault, libs, "pango_language_get_default", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLanguageGetPreferred, libs, "pango_language_get_preferred", "PANGO", "1.48")
 
 }
@@ -2,10 +2,11 @@
 package pango
 
 import (
+	"fmt"
+	"strings"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
@@ -23,7 +24,7 @@ func (x *LayoutClass) GoPointer() uintptr {
 // A `PangoLayoutIter` can be used to iterate over the visual
 // extents of a `PangoLayout`.
 //
-// To obtain a `PangoLayoutIter`, use [method@Pango.Layout.get_iter].
+// To obtain a `PangoLayoutIter`, use [Layout.GetIter].
 //
 // The `PangoLayoutIter` structure is opaque, and has no user-visible fields.
 type LayoutIter struct {
@@ -42,7 +43,7 @@ func (x *LayoutIter) GoPointer() uintptr {
 
 var xLayoutIterAtLastLine func(uintptr) bool
 
-// Determines whether @iter is on the last line of the layout.
+// Determines whether iter is on the last line of the layout.
 func (x *LayoutIter) AtLastLine() bool {
 
 	cret := xLayoutIterAtLastLine(x.GoPointer())
@@ -111,7 +112,7 @@ var xLayoutIterGetIndex func(uintptr) int
 // Note that iterating forward by char moves in visual order,
 // not logical order, so indexes may not be sequential. Also,
 // the index may be equal to the length of the text in the
-// layout, if on the %NULL run (see [method@Pango.LayoutIter.get_run]).
+// layout, if on the nil run (see [LayoutIter.GetRun]).
 func (x *LayoutIter) GetIndex() int {
 
 	cret := xLayoutIterGetIndex(x.GoPointer())
@@ -148,7 +149,7 @@ var xLayoutIterGetLine func(uintptr) *LayoutLine
 
 // Gets the current line.
 //
-// Use the faster [method@Pango.LayoutIter.get_line_readonly] if
+// Use the faster [LayoutIter.GetLineReadonly] if
 // you do not plan to modify the contents of the line (glyphs,
 // glyph widths, etc.).
 func (x *LayoutIter) GetLine() *LayoutLine {
@@ -164,7 +165,7 @@ var xLayoutIterGetLineExtents func(uintptr, *Rectangle, *Rectangle)
 // Extents are in layout coordinates (origin is the top-left corner
 // of the entire `PangoLayout`). Thus the extents returned by this
 // function will be the same width/height but not at the same x/y
-// as the extents returned from [method@Pango.LayoutLine.get_extents].
+// as the extents returned from [LayoutLine.GetExtents].
 func (x *LayoutIter) GetLineExtents(InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
 	xLayoutIterGetLineExtents(x.GoPointer(), InkRectVar, LogicalRectVar)
@@ -175,7 +176,7 @@ var xLayoutIterGetLineReadonly func(uintptr) *LayoutLine
 
 // Gets the current line for read-only access.
 //
-// This is a faster alternative to [method@Pango.LayoutIter.get_line],
+// This is a faster alternative to [LayoutIter.GetLine],
 // but the user is not expected to modify the contents of the line
 // (glyphs, glyph widths, etc.).
 func (x *LayoutIter) GetLineReadonly() *LayoutLine {
@@ -191,7 +192,7 @@ var xLayoutIterGetLineYrange func(uintptr, *int, *int)
 // the current line.
 //
 // A line's range includes the line's logical extents. plus half of the
-// spacing above and below the line, if [method@Pango.Layout.set_spacing]
+// spacing above and below the line, if [Layout.SetSpacing]
 // has been called to set layout spacing. The Y positions are in layout
 // coordinates (origin at top left of the entire layout).
 //
@@ -208,11 +209,11 @@ var xLayoutIterGetRun func(uintptr) *LayoutRun
 // Gets the current run.
 //
 // When iterating by run, at the end of each line, there's a position
-// with a %NULL run, so this function can return %NULL. The %NULL run
+// with a nil run, so this function can return nil. The nil run
 // at the end of each line ensures that all lines have at least one run,
 // even lines consisting of only a newline.
 //
-// Use the faster [method@Pango.LayoutIter.get_run_readonly] if you do not
+// Use the faster [LayoutIter.GetRunReadonly] if you do not
 // plan to modify the contents of the run (glyphs, glyph widths, etc.).
 func (x *LayoutIter) GetRun() *LayoutRun {
 
@@ -251,11 +252,11 @@ var xLayoutIterGetRunReadonly func(uintptr) *LayoutRun
 // Gets the current run for read-only access.
 //
 // When iterating by run, at the end of each line, there's a position
-// with a %NULL run, so this function can return %NULL. The %NULL run
+// with a nil run, so this function can return nil. The nil run
 // at the end of each line ensures that all lines have at least one run,
 // even lines consisting of only a newline.
 //
-// This is a faster alternative to [method@Pango.LayoutIter.get_run],
+// This is a faster alternative to [LayoutIter.GetRun],
 // but the user is not expected to modify the contents of the run (glyphs,
 // glyph widths, etc.).
 func (x *LayoutIter) GetRunReadonly() *LayoutRun {
@@ -266,9 +267,9 @@ func (x *LayoutIter) GetRunReadonly() *LayoutRun {
 
 var xLayoutIterNextChar func(uintptr) bool
 
-// Moves @iter forward to the next character in visual order.
+// Moves iter forward to the next character in visual order.
 //
-// If @iter was already at the end of the layout, returns %FALSE.
+// If iter was already at the end of the layout, returns false.
 func (x *LayoutIter) NextChar() bool {
 
 	cret := xLayoutIterNextChar(x.GoPointer())
@@ -277,9 +278,9 @@ func (x *LayoutIter) NextChar() bool {
 
 var xLayoutIterNextCluster func(uintptr) bool
 
-// Moves @iter forward to the next cluster in visual order.
+// Moves iter forward to the next cluster in visual order.
 //
-// If @iter was already at the end of the layout, returns %FALSE.
+// If iter was already at the end of the layout, returns false.
 func (x *LayoutIter) NextCluster() bool {
 
 	cret := xLayoutIterNextCluster(x.GoPointer())
@@ -288,9 +289,9 @@ func (x *LayoutIter) NextCluster() bool {
 
 var xLayoutIterNextLine func(uintptr) bool
 
-// Moves @iter forward to the start of the next line.
+// Moves iter forward to the start of the next line.
 //
-// If @iter is already on the last line, returns %FALSE.
+// If iter is already on the last line, returns false.
 func (x *LayoutIter) NextLine() bool {
 
 	cret := xLayoutIterNextLine(x.GoPointer())
@@ -299,9 +300,9 @@ func (x *LayoutIter) NextLine() bool {
 
 var xLayoutIterNextRun func(uintptr) bool
 
-// Moves @iter forward to the next run in visual order.
+// Moves iter forward to the next run in visual order.
 //
-// If @iter was already at the end of the layout, returns %FALSE.
+// If iter was already at the end of the layout, returns false.
 func (x *LayoutIter) NextRun() bool {
 
 	cret := xLayoutIterNextRun(x.GoPointer())
@@ -312,7 +313,7 @@ func (x *LayoutIter) NextRun() bool {
 // out a paragraph via `PangoLayout`.
 //
 // `PangoLayoutLine` structures are obtained by calling
-// [method@Pango.Layout.get_line] and are only valid until the text,
+// [Layout.GetLine] and are only valid until the text,
 // attributes, or settings of the parent `PangoLayout` are modified.
 type LayoutLine struct {
 	_ structs.HostLayout
@@ -344,7 +345,7 @@ var xLayoutLineGetExtents func(uintptr, *Rectangle, *Rectangle)
 
 // Computes the logical and ink extents of a layout line.
 //
-// See [method@Pango.Font.get_glyph_extents] for details
+// See [Font.GetGlyphExtents] for details
 // about the interpretation of the rectangles.
 func (x *LayoutLine) GetExtents(InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
@@ -359,8 +360,8 @@ var xLayoutLineGetHeight func(uintptr, *int)
 //
 // Note that the actual baseline-to-baseline distance between lines
 // of text is influenced by other factors, such as
-// [method@Pango.Layout.set_spacing] and
-// [method@Pango.Layout.set_line_spacing].
+// [Layout.SetSpacing] and
+// [Layout.SetLineSpacing].
 func (x *LayoutLine) GetHeight(HeightVar *int) {
 
 	xLayoutLineGetHeight(x.GoPointer(), HeightVar)
@@ -378,12 +379,12 @@ func (x *LayoutLine) GetLength() int {
 
 var xLayoutLineGetPixelExtents func(uintptr, *Rectangle, *Rectangle)
 
-// Computes the logical and ink extents of @layout_line in device units.
+// Computes the logical and ink extents of layout_line in device units.
 //
-// This function just calls [method@Pango.LayoutLine.get_extents] followed by
-// two [func@extents_to_pixels] calls, rounding @ink_rect and @logical_rect
+// This function just calls [LayoutLine.GetExtents] followed by
+// two extents_to_pixels calls, rounding ink_rect and logical_rect
 // such that the rounded rectangles fully contain the unrounded one (that is,
-// passes them as first argument to [func@extents_to_pixels]).
+// passes them as first argument to extents_to_pixels).
 func (x *LayoutLine) GetPixelExtents(InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
 	xLayoutLineGetPixelExtents(x.GoPointer(), InkRectVar, LogicalRectVar)
@@ -467,13 +468,13 @@ var xLayoutLineXToIndex func(uintptr, int, *int, *int) bool
 // Converts from x offset to the byte index of the corresponding character
 // within the text of the layout.
 //
-// If @x_pos is outside the line, @index_ and @trailing will point to the very
+// If x_pos is outside the line, index_ and trailing will point to the very
 // first or very last position in the line. This determination is based on the
 // resolved direction of the paragraph; for example, if the resolved direction
 // is right-to-left, then an X position to the right of the line (after it)
-// results in 0 being stored in @index_ and @trailing. An X position to the
-// left of the line results in @index_ pointing to the (logical) last grapheme
-// in the line and @trailing being set to the number of characters in that
+// results in 0 being stored in index_ and trailing. An X position to the
+// left of the line results in index_ pointing to the (logical) last grapheme
+// in the line and trailing being set to the number of characters in that
 // grapheme. The reverse is true for a left-to-right line.
 func (x *LayoutLine) XToIndex(XPosVar int, IndexVar *int, TrailingVar *int) bool {
 
@@ -483,14 +484,14 @@ func (x *LayoutLine) XToIndex(XPosVar int, IndexVar *int, TrailingVar *int) bool
 
 // A `PangoLayoutRun` represents a single run within a `PangoLayoutLine`.
 //
-// It is simply an alternate name for [struct@Pango.GlyphItem].
-// See the [struct@Pango.GlyphItem] docs for details on the fields.
+// It is simply an alternate name for [GlyphItem].
+// See the [GlyphItem] docs for details on the fields.
 type LayoutRun = uintptr
 
-// Flags that influence the behavior of [func@Pango.Layout.deserialize].
+// Flags that influence the behavior of [LayoutDeserialize].
 //
 // New members may be added to this enumeration over time.
-type LayoutDeserializeFlags int
+type LayoutDeserializeFlags uint32
 
 var xLayoutDeserializeFlagsGLibType func() types.GType
 
@@ -507,10 +508,47 @@ const (
 	LayoutDeserializeContextValue LayoutDeserializeFlags = 1
 )
 
-// Flags that influence the behavior of [method@Pango.Layout.serialize].
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (LayoutDeserializeFlags) GLibType() types.GType {
+	return xLayoutDeserializeFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x LayoutDeserializeFlags) Has(flags LayoutDeserializeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x LayoutDeserializeFlags) Set(flags LayoutDeserializeFlags) LayoutDeserializeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x LayoutDeserializeFlags) Clear(flags LayoutDeserializeFlags) LayoutDeserializeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x LayoutDeserializeFlags) String() string {
+	if x == 0 {
+		return "PANGO_LAYOUT_DESERIALIZE_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&LayoutDeserializeContextValue != 0 && x&LayoutDeserializeContextValue == LayoutDeserializeContextValue {
+		names = append(names, "PANGO_LAYOUT_DESERIALIZE_CONTEXT")
+		rest &^= LayoutDeserializeContextValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Flags that influence the behavior of [Layout.Serialize].
 //
 // New members may be added to this enumeration over time.
-type LayoutSerializeFlags int
+type LayoutSerializeFlags uint32
 
 var xLayoutSerializeFlagsGLibType func() types.GType
 
@@ -528,13 +566,54 @@ const (
 	LayoutSerializeOutputValue LayoutSerializeFlags = 2
 )
 
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (LayoutSerializeFlags) GLibType() types.GType {
+	return xLayoutSerializeFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x LayoutSerializeFlags) Has(flags LayoutSerializeFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x LayoutSerializeFlags) Set(flags LayoutSerializeFlags) LayoutSerializeFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x LayoutSerializeFlags) Clear(flags LayoutSerializeFlags) LayoutSerializeFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x LayoutSerializeFlags) String() string {
+	if x == 0 {
+		return "PANGO_LAYOUT_SERIALIZE_DEFAULT"
+	}
+	var names []string
+	rest := x
+	if rest&LayoutSerializeContextValue != 0 && x&LayoutSerializeContextValue == LayoutSerializeContextValue {
+		names = append(names, "PANGO_LAYOUT_SERIALIZE_CONTEXT")
+		rest &^= LayoutSerializeContextValue
+	}
+	if rest&LayoutSerializeOutputValue != 0 && x&LayoutSerializeOutputValue == LayoutSerializeOutputValue {
+		names = append(names, "PANGO_LAYOUT_SERIALIZE_OUTPUT")
+		rest &^= LayoutSerializeOutputValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
 // `PangoAlignment` describes how to align the lines of a `PangoLayout`
 // within the available space.
 //
-// If the `PangoLayout` is set to justify using [method@Pango.Layout.set_justify],
+// If the `PangoLayout` is set to justify using [Layout.SetJustify],
 // this only affects partial lines.
 //
-// See [method@Pango.Layout.set_auto_dir] for how text direction affects
+// See [Layout.SetAutoDir] for how text direction affects
 // the interpretation of `PangoAlignment` values.
 type Alignment int
 
@@ -554,6 +633,45 @@ const (
 	AlignRightValue Alignment = 2
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x Alignment) String() string {
+	switch x {
+	case AlignLeftValue:
+		return "PANGO_ALIGN_LEFT"
+	case AlignCenterValue:
+		return "PANGO_ALIGN_CENTER"
+	case AlignRightValue:
+		return "PANGO_ALIGN_RIGHT"
+	}
+	return fmt.Sprintf("Alignment(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Alignment) Nick() string {
+	switch x {
+	case AlignLeftValue:
+		return "left"
+	case AlignCenterValue:
+		return "center"
+	case AlignRightValue:
+		return "right"
+	}
+	return ""
+}
+
+// AlignmentFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func AlignmentFromString(s string) (Alignment, bool) {
+	switch s {
+	case "PANGO_ALIGN_LEFT", "left":
+		return AlignLeftValue, true
+	case "PANGO_ALIGN_CENTER", "center":
+		return AlignCenterValue, true
+	case "PANGO_ALIGN_RIGHT", "right":
+		return AlignRightValue, true
+	}
+	return 0, false
+}
+
 // `PangoEllipsizeMode` describes what sort of ellipsization
 // should be applied to text.
 //
@@ -580,7 +698,52 @@ const (
 	EllipsizeEndValue EllipsizeMode = 3
 )
 
-// Errors that can be returned by [func@Pango.Layout.deserialize].
+// String returns the C name of the value, e.g. for debugging output
+func (x EllipsizeMode) String() string {
+	switch x {
+	case EllipsizeNoneValue:
+		return "PANGO_ELLIPSIZE_NONE"
+	case EllipsizeStartValue:
+		return "PANGO_ELLIPSIZE_START"
+	case EllipsizeMiddleValue:
+		return "PANGO_ELLIPSIZE_MIDDLE"
+	case EllipsizeEndValue:
+		return "PANGO_ELLIPSIZE_END"
+	}
+	return fmt.Sprintf("EllipsizeMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x EllipsizeMode) Nick() string {
+	switch x {
+	case EllipsizeNoneValue:
+		return "none"
+	case EllipsizeStartValue:
+		return "start"
+	case EllipsizeMiddleValue:
+		return "middle"
+	case EllipsizeEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// EllipsizeModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func EllipsizeModeFromString(s string) (EllipsizeMode, bool) {
+	switch s {
+	case "PANGO_ELLIPSIZE_NONE", "none":
+		return EllipsizeNoneValue, true
+	case "PANGO_ELLIPSIZE_START", "start":
+		return EllipsizeStartValue, true
+	case "PANGO_ELLIPSIZE_MIDDLE", "middle":
+		return EllipsizeMiddleValue, true
+	case "PANGO_ELLIPSIZE_END", "end":
+		return EllipsizeEndValue, true
+	}
+	return 0, false
+}
+
+// Errors that can be returned by [LayoutDeserialize].
 type LayoutDeserializeError int
 
 var xLayoutDeserializeErrorGLibType func() types.GType
@@ -601,6 +764,63 @@ const (
 	LayoutDeserializeMissingValueValue LayoutDeserializeError = 2
 )
 
+// ErrorDomain returns the GLib error domain of the codes, such that errors.Is and errors.As match them with a *glib.Error
+func (LayoutDeserializeError) ErrorDomain() string {
+	return "pango-layout-deserialize-error-quark"
+}
+
+// Error returns the C name of the error code
+func (x LayoutDeserializeError) Error() string {
+	switch x {
+	case LayoutDeserializeInvalidValue:
+		return "PANGO_LAYOUT_DESERIALIZE_INVALID"
+	case LayoutDeserializeInvalidValueValue:
+		return "PANGO_LAYOUT_DESERIALIZE_INVALID_VALUE"
+	case LayoutDeserializeMissingValueValue:
+		return "PANGO_LAYOUT_DESERIALIZE_MISSING_VALUE"
+	}
+	return fmt.Sprintf("pango-layout-deserialize-error-quark code %d", int(x))
+}
+
+// String returns the C name of the value, e.g. for debugging output
+func (x LayoutDeserializeError) String() string {
+	switch x {
+	case LayoutDeserializeInvalidValue:
+		return "PANGO_LAYOUT_DESERIALIZE_INVALID"
+	case LayoutDeserializeInvalidValueValue:
+		return "PANGO_LAYOUT_DESERIALIZE_INVALID_VALUE"
+	case LayoutDeserializeMissingValueValue:
+		return "PANGO_LAYOUT_DESERIALIZE_MISSING_VALUE"
+	}
+	return fmt.Sprintf("LayoutDeserializeError(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LayoutDeserializeError) Nick() string {
+	switch x {
+	case LayoutDeserializeInvalidValue:
+		return "invalid"
+	case LayoutDeserializeInvalidValueValue:
+		return "invalid-value"
+	case LayoutDeserializeMissingValueValue:
+		return "missing-value"
+	}
+	return ""
+}
+
+// LayoutDeserializeErrorFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LayoutDeserializeErrorFromString(s string) (LayoutDeserializeError, bool) {
+	switch s {
+	case "PANGO_LAYOUT_DESERIALIZE_INVALID", "invalid":
+		return LayoutDeserializeInvalidValue, true
+	case "PANGO_LAYOUT_DESERIALIZE_INVALID_VALUE", "invalid-value":
+		return LayoutDeserializeInvalidValueValue, true
+	case "PANGO_LAYOUT_DESERIALIZE_MISSING_VALUE", "missing-value":
+		return LayoutDeserializeMissingValueValue, true
+	}
+	return 0, false
+}
+
 // `PangoWrapMode` describes how to wrap the lines of a `PangoLayout`
 // to the desired width.
 //
@@ -629,6 +849,51 @@ const (
 	WrapNoneValue WrapMode = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x WrapMode) String() string {
+	switch x {
+	case WrapWordValue:
+		return "PANGO_WRAP_WORD"
+	case WrapCharValue:
+		return "PANGO_WRAP_CHAR"
+	case WrapWordCharValue:
+		return "PANGO_WRAP_WORD_CHAR"
+	case WrapNoneValue:
+		return "PANGO_WRAP_NONE"
+	}
+	return fmt.Sprintf("WrapMode(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x WrapMode) Nick() string {
+	switch x {
+	case WrapWordValue:
+		return "word"
+	case WrapCharValue:
+		return "char"
+	case WrapWordCharValue:
+		return "word-char"
+	case WrapNoneValue:
+		return "none"
+	}
+	return ""
+}
+
+// WrapModeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func WrapModeFromString(s string) (WrapMode, bool) {
+	switch s {
+	case "PANGO_WRAP_WORD", "word":
+		return WrapWordValue, true
+	case "PANGO_WRAP_CHAR", "char":
+		return WrapCharValue, true
+	case "PANGO_WRAP_WORD_CHAR", "word-char":
+		return WrapWordCharValue, true
+	case "PANGO_WRAP_NONE", "none":
+		return WrapNoneValue, true
+	}
+	return 0, false
+}
+
 // A `PangoLayout` structure represents an entire paragraph of text.
 //
 // While complete access to the layout capabilities of Pango is provided
@@ -650,21 +915,14 @@ const (
 // `PangoLayout`. The following image shows adjustable parameters
 // (on the left) and font metrics (on the right):
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="layout-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Pango Layout Parameters" src="layout-light.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The following images demonstrate the effect of alignment and
 // justification on the layout of text:
 //
 // | | |
 // | --- | --- |
-// | ![align=left](align-left.png) | ![align=left, justify](align-left-justify.png) |
-// | ![align=center](align-center.png) | ![align=center, justify](align-center-justify.png) |
-// | ![align=right](align-right.png) | ![align=right, justify](align-right-justify.png) |
+// |  |  |
+// |  |  |
+// |  |  |
 //
 // It is possible, as well, to ignore the 2-D setup,
 // and simply treat the results of a `PangoLayout` as a list of lines.
@@ -757,7 +1015,7 @@ var xLayoutGetAutoDir func(uintptr) bool
 // Gets whether to calculate the base direction for the layout
 // according to its contents.
 //
-// See [method@Pango.Layout.set_auto_dir].
+// See [Layout.SetAutoDir].
 func (x *Layout) GetAutoDir() bool {
 
 	cret := xLayoutGetAutoDir(x.GoPointer())
@@ -766,7 +1024,7 @@ func (x *Layout) GetAutoDir() bool {
 
 var xLayoutGetBaseline func(uintptr) int
 
-// Gets the Y position of baseline of the first line in @layout.
+// Gets the Y position of baseline of the first line in layout.
 func (x *Layout) GetBaseline() int {
 
 	cret := xLayoutGetBaseline(x.GoPointer())
@@ -778,16 +1036,9 @@ var xLayoutGetCaretPos func(uintptr, int, *Rectangle, *Rectangle)
 // Given an index within a layout, determines the positions that of the
 // strong and weak cursors if the insertion point is at that index.
 //
-// This is a variant of [method@Pango.Layout.get_cursor_pos] that applies
+// This is a variant of [Layout.GetCursorPos] that applies
 // font metric information about caret slope and offset to the positions
 // it returns.
-//
-// &lt;picture&gt;
-//
-//	&lt;source srcset="caret-metrics-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Caret metrics" src="caret-metrics-light.png"&gt;
-//
-// &lt;/picture&gt;
 func (x *Layout) GetCaretPos(IndexVar int, StrongPosVar *Rectangle, WeakPosVar *Rectangle) {
 
 	xLayoutGetCaretPos(x.GoPointer(), IndexVar, StrongPosVar, WeakPosVar)
@@ -797,7 +1048,7 @@ func (x *Layout) GetCaretPos(IndexVar int, StrongPosVar *Rectangle, WeakPosVar *
 var xLayoutGetCharacterCount func(uintptr) int
 
 // Returns the number of Unicode characters in the
-// the text of @layout.
+// the text of layout.
 func (x *Layout) GetCharacterCount() int {
 
 	cret := xLayoutGetCharacterCount(x.GoPointer())
@@ -829,13 +1080,6 @@ var xLayoutGetCursorPos func(uintptr, int, *Rectangle, *Rectangle)
 // The position of each cursor is stored as a zero-width rectangle
 // with the height of the run extents.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="cursor-positions-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Cursor positions" src="cursor-positions-light.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The strong cursor location is the location where characters of the
 // directionality equal to the base direction of the layout are inserted.
 // The weak cursor location is the location where characters of the
@@ -843,13 +1087,6 @@ var xLayoutGetCursorPos func(uintptr, int, *Rectangle, *Rectangle)
 //
 // The following example shows text with both a strong and a weak cursor.
 //
-// &lt;picture&gt;
-//
-//	&lt;source srcset="split-cursor-dark.png" media="(prefers-color-scheme: dark)"&gt;
-//	&lt;img alt="Strong and weak cursors" src="split-cursor-light.png"&gt;
-//
-// &lt;/picture&gt;
-//
 // The strong cursor has a little arrow pointing to the right, the weak
 // cursor to the left. Typing a 'c' in this situation will insert the
 // character after the 'b', and typing another Hebrew character, like 'ג',
@@ -862,7 +1099,7 @@ func (x *Layout) GetCursorPos(IndexVar int, StrongPosVar *Rectangle, WeakPosVar
 
 var xLayoutGetDirection func(uintptr, int) Direction
 
-// Gets the text direction at the given character position in @layout.
+// Gets the text direction at the given character position in layout.
 func (x *Layout) GetDirection(IndexVar int) Direction {
 
 	cret := xLayoutGetDirection(x.GoPointer(), IndexVar)
@@ -871,11 +1108,11 @@ func (x *Layout) GetDirection(IndexVar int) Direction {
 
 var xLayoutGetEllipsize func(uintptr) EllipsizeMode
 
-// Gets the type of ellipsization being performed for @layout.
+// Gets the type of ellipsization being performed for layout.
 //
-// See [method@Pango.Layout.set_ellipsize].
+// See [Layout.SetEllipsize].
 //
-// Use [method@Pango.Layout.is_ellipsized] to query whether any
+// Use [Layout.IsEllipsized] to query whether any
 // paragraphs were actually ellipsized.
 func (x *Layout) GetEllipsize() EllipsizeMode {
 
@@ -885,7 +1122,7 @@ func (x *Layout) GetEllipsize() EllipsizeMode {
 
 var xLayoutGetExtents func(uintptr, *Rectangle, *Rectangle)
 
-// Computes the logical and ink extents of @layout.
+// Computes the logical and ink extents of layout.
 //
 // Logical extents are usually what you want for positioning things. Note
 // that both extents may have non-zero x and y. You may want to use those
@@ -914,7 +1151,7 @@ var xLayoutGetHeight func(uintptr) int
 
 // Gets the height of layout used for ellipsization.
 //
-// See [method@Pango.Layout.set_height] for details.
+// See [Layout.SetHeight] for details.
 func (x *Layout) GetHeight() int {
 
 	cret := xLayoutGetHeight(x.GoPointer())
@@ -965,7 +1202,7 @@ var xLayoutGetLine func(uintptr, int) *LayoutLine
 
 // Retrieves a particular line from a `PangoLayout`.
 //
-// Use the faster [method@Pango.Layout.get_line_readonly] if you do not
+// Use the faster [Layout.GetLineReadonly] if you do not
 // plan to modify the contents of the line (glyphs, glyph widths, etc.).
 func (x *Layout) GetLine(LineVar int) *LayoutLine {
 
@@ -975,7 +1212,7 @@ func (x *Layout) GetLine(LineVar int) *LayoutLine {
 
 var xLayoutGetLineCount func(uintptr) int
 
-// Retrieves the count of lines for the @layout.
+// Retrieves the count of lines for the layout.
 func (x *Layout) GetLineCount() int {
 
 	cret := xLayoutGetLineCount(x.GoPointer())
@@ -986,7 +1223,7 @@ var xLayoutGetLineReadonly func(uintptr, int) *LayoutLine
 
 // Retrieves a particular line from a `PangoLayout`.
 //
-// This is a faster alternative to [method@Pango.Layout.get_line],
+// This is a faster alternative to [Layout.GetLine],
 // but the user is not expected to modify the contents of the line
 // (glyphs, glyph widths, etc.).
 func (x *Layout) GetLineReadonly(LineVar int) *LayoutLine {
@@ -997,9 +1234,9 @@ func (x *Layout) GetLineReadonly(LineVar int) *LayoutLine {
 
 var xLayoutGetLineSpacing func(uintptr) float32
 
-// Gets the line spacing factor of @layout.
+// Gets the line spacing factor of layout.
 //
-// See [method@Pango.Layout.set_line_spacing].
+// See [Layout.SetLineSpacing].
 func (x *Layout) GetLineSpacing() float32 {
 
 	cret := xLayoutGetLineSpacing(x.GoPointer())
@@ -1008,9 +1245,9 @@ func (x *Layout) GetLineSpacing() float32 {
 
 var xLayoutGetLines func(uintptr) *glib.SList
 
-// Returns the lines of the @layout as a list.
+// Returns the lines of the layout as a list.
 //
-// Use the faster [method@Pango.Layout.get_lines_readonly] if you do not
+// Use the faster [Layout.GetLinesReadonly] if you do not
 // plan to modify the contents of the lines (glyphs, glyph widths, etc.).
 func (x *Layout) GetLines() *glib.SList {
 
@@ -1020,9 +1257,9 @@ func (x *Layout) GetLines() *glib.SList {
 
 var xLayoutGetLinesReadonly func(uintptr) *glib.SList
 
-// Returns the lines of the @layout as a list.
+// Returns the lines of the layout as a list.
 //
-// This is a faster alternative to [method@Pango.Layout.get_lines],
+// This is a faster alternative to [Layout.GetLines],
 // but the user is not expected to modify the contents of the lines
 // (glyphs, glyph widths, etc.).
 func (x *Layout) GetLinesReadonly() *glib.SList {
@@ -1034,7 +1271,7 @@ func (x *Layout) GetLinesReadonly() *glib.SList {
 var xLayoutGetLogAttrs func(uintptr, *uintptr, *int)
 
 // Retrieves an array of logical attributes for each character in
-// the @layout.
+// the layout.
 func (x *Layout) GetLogAttrs(AttrsVar *uintptr, NAttrsVar *int) {
 
 	xLayoutGetLogAttrs(x.GoPointer(), AttrsVar, NAttrsVar)
@@ -1044,13 +1281,13 @@ func (x *Layout) GetLogAttrs(AttrsVar *uintptr, NAttrsVar *int) {
 var xLayoutGetLogAttrsReadonly func(uintptr, *int) uintptr
 
 // Retrieves an array of logical attributes for each character in
-// the @layout.
+// the layout.
 //
-// This is a faster alternative to [method@Pango.Layout.get_log_attrs].
-// The returned array is part of @layout and must not be modified.
+// This is a faster alternative to [Layout.GetLogAttrs].
+// The returned array is part of layout and must not be modified.
 // Modifying the layout will invalidate the returned array.
 //
-// The number of attributes returned in @n_attrs will be one more
+// The number of attributes returned in n_attrs will be one more
 // than the total number of characters in the layout, since there
 // need to be attributes corresponding to both the position before
 // the first character and the position after the last character.
@@ -1062,12 +1299,12 @@ func (x *Layout) GetLogAttrsReadonly(NAttrsVar *int) uintptr {
 
 var xLayoutGetPixelExtents func(uintptr, *Rectangle, *Rectangle)
 
-// Computes the logical and ink extents of @layout in device units.
+// Computes the logical and ink extents of layout in device units.
 //
-// This function just calls [method@Pango.Layout.get_extents] followed by
-// two [func@extents_to_pixels] calls, rounding @ink_rect and @logical_rect
+// This function just calls [Layout.GetExtents] followed by
+// two extents_to_pixels calls, rounding ink_rect and logical_rect
 // such that the rounded rectangles fully contain the unrounded one (that is,
-// passes them as first argument to [func@Pango.extents_to_pixels]).
+// passes them as first argument to [ExtentsToPixels]).
 func (x *Layout) GetPixelExtents(InkRectVar *Rectangle, LogicalRectVar *Rectangle) {
 
 	xLayoutGetPixelExtents(x.GoPointer(), InkRectVar, LogicalRectVar)
@@ -1079,9 +1316,9 @@ var xLayoutGetPixelSize func(uintptr, *int, *int)
 // Determines the logical width and height of a `PangoLayout` in device
 // units.
 //
-// [method@Pango.Layout.get_size] returns the width and height
-// scaled by %PANGO_SCALE. This is simply a convenience function
-// around [method@Pango.Layout.get_pixel_extents].
+// [Layout.GetSize] returns the width and height
+// scaled by [SCALE]. This is simply a convenience function
+// around [Layout.GetPixelExtents].
 func (x *Layout) GetPixelSize(WidthVar *int, HeightVar *int) {
 
 	xLayoutGetPixelSize(x.GoPointer(), WidthVar, HeightVar)
@@ -1090,7 +1327,7 @@ func (x *Layout) GetPixelSize(WidthVar *int, HeightVar *int) {
 
 var xLayoutGetSerial func(uintptr) uint
 
-// Returns the current serial number of @layout.
+// Returns the current serial number of layout.
 //
 // The serial number is initialized to an small number larger than zero
 // when a new layout is created and is increased whenever the layout is
@@ -1101,7 +1338,7 @@ var xLayoutGetSerial func(uintptr) uint
 // This can be used to automatically detect changes to a `PangoLayout`,
 // and is useful for example to decide whether a layout needs redrawing.
 // To force the serial to be increased, use
-// [method@Pango.Layout.context_changed].
+// [Layout.ContextChanged].
 func (x *Layout) GetSerial() uint {
 
 	cret := xLayoutGetSerial(x.GoPointer())
@@ -1110,9 +1347,9 @@ func (x *Layout) GetSerial() uint {
 
 var xLayoutGetSingleParagraphMode func(uintptr) bool
 
-// Obtains whether @layout is in single paragraph mode.
+// Obtains whether layout is in single paragraph mode.
 //
-// See [method@Pango.Layout.set_single_paragraph_mode].
+// See [Layout.SetSingleParagraphMode].
 func (x *Layout) GetSingleParagraphMode() bool {
 
 	cret := xLayoutGetSingleParagraphMode(x.GoPointer())
@@ -1124,7 +1361,7 @@ var xLayoutGetSize func(uintptr, *int, *int)
 // Determines the logical width and height of a `PangoLayout` in Pango
 // units.
 //
-// This is simply a convenience function around [method@Pango.Layout.get_extents].
+// This is simply a convenience function around [Layout.GetExtents].
 func (x *Layout) GetSize(WidthVar *int, HeightVar *int) {
 
 	xLayoutGetSize(x.GoPointer(), WidthVar, HeightVar)
@@ -1145,9 +1382,9 @@ var xLayoutGetTabs func(uintptr) *TabArray
 // Gets the current `PangoTabArray` used by this layout.
 //
 // If no `PangoTabArray` has been set, then the default tabs are
-// in use and %NULL is returned. Default tabs are every 8 spaces.
+// in use and nil is returned. Default tabs are every 8 spaces.
 //
-// The return value should be freed with [method@Pango.TabArray.free].
+// The return value should be freed with [TabArray.Free].
 func (x *Layout) GetTabs() *TabArray {
 
 	cret := xLayoutGetTabs(x.GoPointer())
@@ -1167,11 +1404,11 @@ func (x *Layout) GetText() string {
 
 var xLayoutGetUnknownGlyphsCount func(uintptr) int
 
-// Counts the number of unknown glyphs in @layout.
+// Counts the number of unknown glyphs in layout.
 //
 // This function can be used to determine if there are any fonts
 // available to render all characters in a certain string, or when
-// used in combination with %PANGO_ATTR_FALLBACK, to check if a
+// used in combination with [AttrFallbackValue], to check if a
 // certain font supports all the characters in the string.
 func (x *Layout) GetUnknownGlyphsCount() int {
 
@@ -1192,7 +1429,7 @@ var xLayoutGetWrap func(uintptr) WrapMode
 
 // Gets the wrap mode for the layout.
 //
-// Use [method@Pango.Layout.is_wrapped] to query whether
+// Use [Layout.IsWrapped] to query whether
 // any paragraphs were actually wrapped.
 func (x *Layout) GetWrap() WrapMode {
 
@@ -1202,7 +1439,7 @@ func (x *Layout) GetWrap() WrapMode {
 
 var xLayoutIndexToLineX func(uintptr, int, bool, *int, *int)
 
-// Converts from byte @index_ within the @layout to line and X position.
+// Converts from byte index_ within the layout to line and X position.
 //
 // The X position is measured from the left edge of the line.
 func (x *Layout) IndexToLineX(IndexVar int, TrailingVar bool, LineVar *int, XPosVar *int) {
@@ -1216,10 +1453,10 @@ var xLayoutIndexToPos func(uintptr, int, *Rectangle)
 // Converts from an index within a `PangoLayout` to the onscreen position
 // corresponding to the grapheme at that index.
 //
-// The returns is represented as rectangle. Note that `pos-&gt;x` is
-// always the leading edge of the grapheme and `pos-&gt;x + pos-&gt;width` the
+// The returns is represented as rectangle. Note that `pos->x` is
+// always the leading edge of the grapheme and `pos->x + pos->width` the
 // trailing edge of the grapheme. If the directionality of the grapheme
-// is right-to-left, then `pos-&gt;width` will be negative.
+// is right-to-left, then `pos->width` will be negative.
 func (x *Layout) IndexToPos(IndexVar int, PosVar *Rectangle) {
 
 	xLayoutIndexToPos(x.GoPointer(), IndexVar, PosVar)
@@ -1230,8 +1467,8 @@ var xLayoutIsEllipsized func(uintptr) bool
 
 // Queries whether the layout had to ellipsize any paragraphs.
 //
-// This returns %TRUE if the ellipsization mode for @layout
-// is not %PANGO_ELLIPSIZE_NONE, a positive width is set on @layout,
+// This returns true if the ellipsization mode for layout
+// is not [EllipsizeNoneValue], a positive width is set on layout,
 // and there are paragraphs exceeding that width that have to be
 // ellipsized.
 func (x *Layout) IsEllipsized() bool {
@@ -1244,7 +1481,7 @@ var xLayoutIsWrapped func(uintptr) bool
 
 // Queries whether the layout had to wrap any paragraphs.
 //
-// This returns %TRUE if a positive width is set on @layout,
+// This returns true if a positive width is set on layout,
 // and there are paragraphs exceeding the layout width that have
 // to be wrapped.
 func (x *Layout) IsWrapped() bool {
@@ -1257,9 +1494,9 @@ var xLayoutMoveCursorVisually func(uintptr, bool, int, int, int, *int, *int)
 
 // Computes a new cursor position from an old position and a direction.
 //
-// If @direction is positive, then the new position will cause the strong
+// If direction is positive, then the new position will cause the strong
 // or weak cursor to be displayed one position to right of where it was
-// with the old cursor position. If @direction is negative, it will be
+// with the old cursor position. If direction is negative, it will be
 // moved to the left.
 //
 // In the presence of bidirectional text, the correspondence between
@@ -1278,10 +1515,10 @@ func (x *Layout) MoveCursorVisually(StrongVar bool, OldIndexVar int, OldTrailing
 
 var xLayoutSerialize func(uintptr, LayoutSerializeFlags) *glib.Bytes
 
-// Serializes the @layout for later deserialization via [func@Pango.Layout.deserialize].
+// Serializes the layout for later deserialization via [LayoutDeserialize].
 //
 // There are no guarantees about the format of the output across different
-// versions of Pango and [func@Pango.Layout.deserialize] will reject data
+// versions of Pango and [LayoutDeserialize] will reject data
 // that it cannot parse.
 //
 // The intended use of this function is testing, benchmarking and debugging.
@@ -1297,7 +1534,7 @@ var xLayoutSetAlignment func(uintptr, Alignment)
 // Sets the alignment for the layout: how partial lines are
 // positioned within the horizontal space available.
 //
-// The default alignment is %PANGO_ALIGN_LEFT.
+// The default alignment is [AlignLeftValue].
 func (x *Layout) SetAlignment(AlignmentVar Alignment) {
 
 	xLayoutSetAlignment(x.GoPointer(), AlignmentVar)
@@ -1308,7 +1545,7 @@ var xLayoutSetAttributes func(uintptr, *AttrList)
 
 // Sets the text attributes for a layout object.
 //
-// References @attrs, so the caller can unref its reference.
+// References attrs, so the caller can unref its reference.
 func (x *Layout) SetAttributes(AttrsVar *AttrList) {
 
 	xLayoutSetAttributes(x.GoPointer(), AttrsVar)
@@ -1320,19 +1557,19 @@ var xLayoutSetAutoDir func(uintptr, bool)
 // Sets whether to calculate the base direction
 // for the layout according to its contents.
 //
-// When this flag is on (the default), then paragraphs in @layout that
+// When this flag is on (the default), then paragraphs in layout that
 // begin with strong right-to-left characters (Arabic and Hebrew principally),
 // will have right-to-left layout, paragraphs with letters from other scripts
 // will have left-to-right layout. Paragraphs with only neutral characters
 // get their direction from the surrounding paragraphs.
 //
-// When %FALSE, the choice between left-to-right and right-to-left
+// When false, the choice between left-to-right and right-to-left
 // layout is done according to the base direction of the layout's
-// `PangoContext`. (See [method@Pango.Context.set_base_dir]).
+// `PangoContext`. (See [Context.SetBaseDir]).
 //
 // When the auto-computed direction of a paragraph differs from the
 // base direction of the context, the interpretation of
-// %PANGO_ALIGN_LEFT and %PANGO_ALIGN_RIGHT are swapped.
+// [AlignLeftValue] and [AlignRightValue] are swapped.
 func (x *Layout) SetAutoDir(AutoDirVar bool) {
 
 	xLayoutSetAutoDir(x.GoPointer(), AutoDirVar)
@@ -1341,21 +1578,21 @@ func (x *Layout) SetAutoDir(AutoDirVar bool) {
 
 var xLayoutSetEllipsize func(uintptr, EllipsizeMode)
 
-// Sets the type of ellipsization being performed for @layout.
+// Sets the type of ellipsization being performed for layout.
 //
-// Depending on the ellipsization mode @ellipsize text is
+// Depending on the ellipsization mode ellipsize text is
 // removed from the start, middle, or end of text so they
 // fit within the width and height of layout set with
-// [method@Pango.Layout.set_width] and [method@Pango.Layout.set_height].
+// [Layout.SetWidth] and [Layout.SetHeight].
 //
 // If the layout contains characters such as newlines that
 // force it to be layed out in multiple paragraphs, then whether
 // each paragraph is ellipsized separately or the entire layout
 // is ellipsized as a whole depends on the set height of the layout.
 //
-// The default value is %PANGO_ELLIPSIZE_NONE.
+// The default value is [EllipsizeNoneValue].
 //
-// See [method@Pango.Layout.set_height] for details.
+// See [Layout.SetHeight] for details.
 func (x *Layout) SetEllipsize(EllipsizeVar EllipsizeMode) {
 
 	xLayoutSetEllipsize(x.GoPointer(), EllipsizeVar)
@@ -1378,29 +1615,31 @@ var xLayoutSetHeight func(uintptr, int)
 
 // Sets the height to which the `PangoLayout` should be ellipsized at.
 //
-// There are two different behaviors, based on whether @height is positive
+// There are two different behaviors, based on whether height is positive
 // or negative.
 //
-// If @height is positive, it will be the maximum height of the layout. Only
+// If height is positive, it will be the maximum height of the layout. Only
 // lines would be shown that would fit, and if there is any text omitted,
 // an ellipsis added. At least one line is included in each paragraph regardless
 // of how small the height value is. A value of zero will render exactly one
 // line for the entire layout.
 //
-// If @height is negative, it will be the (negative of) maximum number of lines
+// If height is negative, it will be the (negative of) maximum number of lines
 // per paragraph. That is, the total number of lines shown may well be more than
 // this value if the layout contains multiple paragraphs of text.
 // The default value of -1 means that the first line of each paragraph is ellipsized.
 // This behavior may be changed in the future to act per layout instead of per
 // paragraph. File a bug against pango at
-// [https://gitlab.gnome.org/gnome/pango](https://gitlab.gnome.org/gnome/pango)
+// [https://gitlab.gnome.org/gnome/pango]
 // if your code relies on this behavior.
 //
 // Height setting only has effect if a positive width is set on
-// @layout and ellipsization mode of @layout is not %PANGO_ELLIPSIZE_NONE.
+// layout and ellipsization mode of layout is not [EllipsizeNoneValue].
 // The behavior is undefined if a height other than -1 is set and
-// ellipsization mode is set to %PANGO_ELLIPSIZE_NONE, and may change in the
+// ellipsization mode is set to [EllipsizeNoneValue], and may change in the
 // future.
+//
+// [https://gitlab.gnome.org/gnome/pango]: https://gitlab.gnome.org/gnome/pango
 func (x *Layout) SetHeight(HeightVar int) {
 
 	xLayoutSetHeight(x.GoPointer(), HeightVar)
@@ -1411,12 +1650,12 @@ var xLayoutSetIndent func(uintptr, int)
 
 // Sets the width in Pango units to indent each paragraph.
 //
-// A negative value of @indent will produce a hanging indentation.
+// A negative value of indent will produce a hanging indentation.
 // That is, the first line will have the full width, and subsequent
-// lines will be indented by the absolute value of @indent.
+// lines will be indented by the absolute value of indent.
 //
 // The indent setting is ignored if layout alignment is set to
-// %PANGO_ALIGN_CENTER.
+// [AlignCenterValue].
 //
 // The default value is 0.
 func (x *Layout) SetIndent(IndentVar int) {
@@ -1441,9 +1680,9 @@ var xLayoutSetJustify func(uintptr, bool)
 // Justification will move content away from its tab-aligned
 // positions.
 //
-// The default value is %FALSE.
+// The default value is false.
 //
-// Also see [method@Pango.Layout.set_justify_last_line].
+// Also see [Layout.SetJustifyLastLine].
 func (x *Layout) SetJustify(JustifyVar bool) {
 
 	xLayoutSetJustify(x.GoPointer(), JustifyVar)
@@ -1455,10 +1694,10 @@ var xLayoutSetJustifyLastLine func(uintptr, bool)
 // Sets whether the last line should be stretched to fill the
 // entire width of the layout.
 //
-// This only has an effect if [method@Pango.Layout.set_justify] has
+// This only has an effect if [Layout.SetJustify] has
 // been called as well.
 //
-// The default value is %FALSE.
+// The default value is false.
 func (x *Layout) SetJustifyLastLine(JustifyVar bool) {
 
 	xLayoutSetJustifyLastLine(x.GoPointer(), JustifyVar)
@@ -1471,18 +1710,18 @@ var xLayoutSetLineSpacing func(uintptr, float32)
 //
 // Typical values are: 0, 1, 1.5, 2. The default values is 0.
 //
-// If @factor is non-zero, lines are placed so that
+// If factor is non-zero, lines are placed so that
 //
 //	baseline2 = baseline1 + factor * height2
 //
 // where height2 is the line height of the second line
 // (as determined by the font(s)). In this case, the spacing
-// set with [method@Pango.Layout.set_spacing] is ignored.
+// set with [Layout.SetSpacing] is ignored.
 //
-// If @factor is zero (the default), spacing is applied as before.
+// If factor is zero (the default), spacing is applied as before.
 //
 // Note: for semantics that are closer to the CSS line-height
-// property, see [func@Pango.attr_line_height_new].
+// property, see [AttrLineHeightNew].
 func (x *Layout) SetLineSpacing(FactorVar float32) {
 
 	xLayoutSetLineSpacing(x.GoPointer(), FactorVar)
@@ -1493,11 +1732,11 @@ var xLayoutSetMarkup func(uintptr, string, int)
 
 // Sets the layout text and attribute list from marked-up text.
 //
-// See [Pango Markup](pango_markup.html)).
+// See Pango Markup).
 //
 // Replaces the current text and attribute list.
 //
-// This is the same as [method@Pango.Layout.set_markup_with_accel],
+// This is the same as [Layout.SetMarkupWithAccel],
 // but the markup text isn't scanned for accelerators.
 func (x *Layout) SetMarkup(MarkupVar string, LengthVar int) {
 
@@ -1505,22 +1744,22 @@ func (x *Layout) SetMarkup(MarkupVar string, LengthVar int) {
 
 }
 
-var xLayoutSetMarkupWithAccel func(uintptr, string, int, uint32, *uint32)
+var xLayoutSetMarkupWithAccel func(uintptr, string, int, rune, *rune)
 
 // Sets the layout text and attribute list from marked-up text.
 //
-// See [Pango Markup](pango_markup.html)).
+// See Pango Markup).
 //
 // Replaces the current text and attribute list.
 //
-// If @accel_marker is nonzero, the given character will mark the
-// character following it as an accelerator. For example, @accel_marker
+// If accel_marker is nonzero, the given character will mark the
+// character following it as an accelerator. For example, accel_marker
 // might be an ampersand or underscore. All characters marked
-// as an accelerator will receive a %PANGO_UNDERLINE_LOW attribute,
-// and the first character so marked will be returned in @accel_char.
-// Two @accel_marker characters following each other produce a single
-// literal @accel_marker character.
-func (x *Layout) SetMarkupWithAccel(MarkupVar string, LengthVar int, AccelMarkerVar uint32, AccelCharVar *uint32) {
+// as an accelerator will receive a [UnderlineLowValue] attribute,
+// and the first character so marked will be returned in accel_char.
+// Two accel_marker characters following each other produce a single
+// literal accel_marker character.
+func (x *Layout) SetMarkupWithAccel(MarkupVar string, LengthVar int, AccelMarkerVar rune, AccelCharVar *rune) {
 
 	xLayoutSetMarkupWithAccel(x.GoPointer(), MarkupVar, LengthVar, AccelMarkerVar, AccelCharVar)
 
@@ -1528,14 +1767,14 @@ func (x *Layout) SetMarkupWithAccel(MarkupVar string, LengthVar int, AccelMarker
 
 var xLayoutSetSingleParagraphMode func(uintptr, bool)
 
-// Sets the single paragraph mode of @layout.
+// Sets the single paragraph mode of layout.
 //
-// If @setting is %TRUE, do not treat newlines and similar characters
+// If setting is true, do not treat newlines and similar characters
 // as paragraph separators; instead, keep all text in a single paragraph,
 // and display a glyph for paragraph separator characters. Used when
 // you want to allow editing of newlines on a single text line.
 //
-// The default value is %FALSE.
+// The default value is false.
 func (x *Layout) SetSingleParagraphMode(SettingVar bool) {
 
 	xLayoutSetSingleParagraphMode(x.GoPointer(), SettingVar)
@@ -1555,11 +1794,11 @@ var xLayoutSetSpacing func(uintptr, int)
 //
 // Note: Since 1.44, Pango is using the line height (as determined
 // by the font) for placing lines when the line spacing factor is set
-// to a non-zero value with [method@Pango.Layout.set_line_spacing].
-// In that case, the @spacing set with this function is ignored.
+// to a non-zero value with [Layout.SetLineSpacing].
+// In that case, the spacing set with this function is ignored.
 //
 // Note: for semantics that are closer to the CSS line-height
-// property, see [func@Pango.attr_line_height_new].
+// property, see [AttrLineHeightNew].
 func (x *Layout) SetSpacing(SpacingVar int) {
 
 	xLayoutSetSpacing(x.GoPointer(), SpacingVar)
@@ -1568,19 +1807,19 @@ func (x *Layout) SetSpacing(SpacingVar int) {
 
 var xLayoutSetTabs func(uintptr, *TabArray)
 
-// Sets the tabs to use for @layout, overriding the default tabs.
+// Sets the tabs to use for layout, overriding the default tabs.
 //
 // `PangoLayout` will place content at the next tab position
 // whenever it meets a Tab character (U+0009).
 //
-// By default, tabs are every 8 spaces. If @tabs is %NULL, the
-// default tabs are reinstated. @tabs is copied into the layout;
-// you must free your copy of @tabs yourself.
+// By default, tabs are every 8 spaces. If tabs is nil, the
+// default tabs are reinstated. tabs is copied into the layout;
+// you must free your copy of tabs yourself.
 //
 // Note that tabs and justification conflict with each other:
 // Justification will move content away from its tab-aligned
 // positions. The same is true for alignments other than
-// %PANGO_ALIGN_LEFT.
+// [AlignLeftValue].
 func (x *Layout) SetTabs(TabsVar *TabArray) {
 
 	xLayoutSetTabs(x.GoPointer(), TabsVar)
@@ -1591,12 +1830,12 @@ var xLayoutSetText func(uintptr, string, int)
 
 // Sets the text of the layout.
 //
-// This function validates @text and renders invalid UTF-8
+// This function validates text and renders invalid UTF-8
 // with a placeholder glyph.
 //
-// Note that if you have used [method@Pango.Layout.set_markup] or
-// [method@Pango.Layout.set_markup_with_accel] on @layout before, you
-// may want to call [method@Pango.Layout.set_attributes] to clear the
+// Note that if you have used [Layout.SetMarkup] or
+// [Layout.SetMarkupWithAccel] on layout before, you
+// may want to call [Layout.SetAttributes] to clear the
 // attributes set on the layout from the markup as this function does
 // not clear attributes.
 func (x *Layout) SetText(TextVar string, LengthVar int) {
@@ -1622,10 +1861,10 @@ var xLayoutSetWrap func(uintptr, WrapMode)
 // Sets the wrap mode.
 //
 // The wrap mode only has effect if a width is set on the layout
-// with [method@Pango.Layout.set_width]. To turn off wrapping,
+// with [Layout.SetWidth]. To turn off wrapping,
 // set the width to -1.
 //
-// The default value is %PANGO_WRAP_WORD.
+// The default value is [WrapWordValue].
 func (x *Layout) SetWrap(WrapVar WrapMode) {
 
 	xLayoutSetWrap(x.GoPointer(), WrapVar)
@@ -1636,8 +1875,8 @@ var xLayoutWriteToFile func(uintptr, LayoutSerializeFlags, string, **glib.Error)
 
 // A convenience method to serialize a layout to a file.
 //
-// It is equivalent to calling [method@Pango.Layout.serialize]
-// followed by [func@GLib.file_set_contents].
+// It is equivalent to calling [Layout.Serialize]
+// followed by [glib.FileSetContents].
 //
 // See those two functions for details on the arguments.
 //
@@ -1648,7 +1887,7 @@ func (x *Layout) WriteToFile(FlagsVar LayoutSerializeFlags, FilenameVar string)
 
 	cret := xLayoutWriteToFile(x.GoPointer(), FlagsVar, FilenameVar, &cerr)
 	if cerr == nil {
-		return cret, nil
+		return cret, core.PlatformError
 	}
 	return cret, cerr
 
@@ -1662,9 +1901,9 @@ var xLayoutXyToIndex func(uintptr, int, int, *int, *int) bool
 // If the Y position is not inside the layout, the closest position is
 // chosen (the position will be clamped inside the layout). If the X position
 // is not within the layout, then the start or the end of the line is
-// chosen as described for [method@Pango.LayoutLine.x_to_index]. If either
+// chosen as described for [LayoutLine.XToIndex]. If either
 // the X or Y positions were not inside the layout, then the function returns
-// %FALSE; on an exact hit, it returns %TRUE.
+// false; on an exact hit, it returns true.
 func (x *Layout) XyToIndex(XVar int, YVar int, IndexVar *int, TrailingVar *int) bool {
 
 	cret := xLayoutXyToIndex(x.GoPointer(), XVar, YVar, IndexVar, TrailingVar)
@@ -1684,12 +1923,12 @@ func (c *Layout) SetGoPointer(ptr uintptr) {
 
 var xLayoutDeserialize func(uintptr, *glib.Bytes, LayoutDeserializeFlags, **glib.Error) uintptr
 
-// Loads data previously created via [method@Pango.Layout.serialize].
+// Loads data previously created via [Layout.Serialize].
 //
 // For a discussion of the supported format, see that function.
 //
 // Note: to verify that the returned layout is identical to
-// the one that was serialized, you can compare @bytes to the
+// the one that was serialized, you can compare bytes to the
 // result of serializing the layout again.
 func LayoutDeserialize(ContextVar *Context, BytesVar *glib.Bytes, FlagsVar LayoutDeserializeFlags) (*Layout, error) {
 	var cls *Layout
@@ -1698,12 +1937,15 @@ func LayoutDeserialize(ContextVar *Context, BytesVar *glib.Bytes, FlagsVar Layou
 	cret := xLayoutDeserialize(ContextVar.GoPointer(), BytesVar, FlagsVar, &cerr)
 
 	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
 		return nil, cerr
 	}
 	cls = &Layout{}
 	cls.Ptr = cret
 	if cerr == nil {
-		return cls, nil
+		return cls, core.PlatformError
 	}
 	return cls, cerr
 
@@ -1714,7 +1956,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -1735,112 +1977,112 @@ func init() {
 
 	core.PuregoSafeRegister(&xLayoutIterGLibType, libs, "pango_layout_iter_get_type")
 
-	core.PuregoSafeRegister(&xLayoutIterAtLastLine, libs, "pango_layout_iter_at_last_line")
-	core.PuregoSafeRegister(&xLayoutIterCopy, libs, "pango_layout_iter_copy")
-	core.PuregoSafeRegister(&xLayoutIterFree, libs, "pango_layout_iter_free")
-	core.PuregoSafeRegister(&xLayoutIterGetBaseline, libs, "pango_layout_iter_get_baseline")
-	core.PuregoSafeRegister(&xLayoutIterGetCharExtents, libs, "pango_layout_iter_get_char_extents")
-	core.PuregoSafeRegister(&xLayoutIterGetClusterExtents, libs, "pango_layout_iter_get_cluster_extents")
-	core.PuregoSafeRegister(&xLayoutIterGetIndex, libs, "pango_layout_iter_get_index")
-	core.PuregoSafeRegister(&xLayoutIterGetLayout, libs, "pango_layout_iter_get_layout")
-	core.PuregoSafeRegister(&xLayoutIterGetLayoutExtents, libs, "pango_layout_iter_get_layout_extents")
-	core.PuregoSafeRegister(&xLayoutIterGetLine, libs, "pango_layout_iter_get_line")
-	core.PuregoSafeRegister(&xLayoutIterGetLineExtents, libs, "pango_layout_iter_get_line_extents")
-	core.PuregoSafeRegister(&xLayoutIterGetLineReadonly, libs, "pango_layout_iter_get_line_readonly")
-	core.PuregoSafeRegister(&xLayoutIterGetLineYrange, libs, "pango_layout_iter_get_line_yrange")
-	core.PuregoSafeRegister(&xLayoutIterGetRun, libs, "pango_layout_iter_get_run")
-	core.PuregoSafeRegister(&xLayoutIterGetRunBaseline, libs, "pango_layout_iter_get_run_baseline")
-	core.PuregoSafeRegister(&xLayoutIterGetRunExtents, libs, "pango_layout_iter_get_run_extents")
-	core.PuregoSafeRegister(&xLayoutIterGetRunReadonly, libs, "pango_layout_iter_get_run_readonly")
-	core.PuregoSafeRegister(&xLayoutIterNextChar, libs, "pango_layout_iter_next_char")
-	core.PuregoSafeRegister(&xLayoutIterNextCluster, libs, "pango_layout_iter_next_cluster")
-	core.PuregoSafeRegister(&xLayoutIterNextLine, libs, "pango_layout_iter_next_line")
-	core.PuregoSafeRegister(&xLayoutIterNextRun, libs, "pango_layout_iter_next_run")
+	core.PuregoRegisterSince(&xLayoutIterAtLastLine, libs, "pango_layout_iter_at_last_line", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterCopy, libs, "pango_layout_iter_copy", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xLayoutIterFree, libs, "pango_layout_iter_free", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetBaseline, libs, "pango_layout_iter_get_baseline", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetCharExtents, libs, "pango_layout_iter_get_char_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetClusterExtents, libs, "pango_layout_iter_get_cluster_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetIndex, libs, "pango_layout_iter_get_index", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetLayout, libs, "pango_layout_iter_get_layout", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xLayoutIterGetLayoutExtents, libs, "pango_layout_iter_get_layout_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetLine, libs, "pango_layout_iter_get_line", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetLineExtents, libs, "pango_layout_iter_get_line_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetLineReadonly, libs, "pango_layout_iter_get_line_readonly", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutIterGetLineYrange, libs, "pango_layout_iter_get_line_yrange", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetRun, libs, "pango_layout_iter_get_run", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetRunBaseline, libs, "pango_layout_iter_get_run_baseline", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutIterGetRunExtents, libs, "pango_layout_iter_get_run_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterGetRunReadonly, libs, "pango_layout_iter_get_run_readonly", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutIterNextChar, libs, "pango_layout_iter_next_char", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterNextCluster, libs, "pango_layout_iter_next_cluster", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterNextLine, libs, "pango_layout_iter_next_line", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIterNextRun, libs, "pango_layout_iter_next_run", "PANGO", "")
 
 	core.PuregoSafeRegister(&xLayoutLineGLibType, libs, "pango_layout_line_get_type")
 
-	core.PuregoSafeRegister(&xLayoutLineGetExtents, libs, "pango_layout_line_get_extents")
-	core.PuregoSafeRegister(&xLayoutLineGetHeight, libs, "pango_layout_line_get_height")
-	core.PuregoSafeRegister(&xLayoutLineGetLength, libs, "pango_layout_line_get_length")
-	core.PuregoSafeRegister(&xLayoutLineGetPixelExtents, libs, "pango_layout_line_get_pixel_extents")
-	core.PuregoSafeRegister(&xLayoutLineGetResolvedDirection, libs, "pango_layout_line_get_resolved_direction")
-	core.PuregoSafeRegister(&xLayoutLineGetStartIndex, libs, "pango_layout_line_get_start_index")
-	core.PuregoSafeRegister(&xLayoutLineGetXRanges, libs, "pango_layout_line_get_x_ranges")
-	core.PuregoSafeRegister(&xLayoutLineIndexToX, libs, "pango_layout_line_index_to_x")
-	core.PuregoSafeRegister(&xLayoutLineIsParagraphStartFn, libs, "pango_layout_line_is_paragraph_start")
-	core.PuregoSafeRegister(&xLayoutLineRef, libs, "pango_layout_line_ref")
-	core.PuregoSafeRegister(&xLayoutLineUnref, libs, "pango_layout_line_unref")
-	core.PuregoSafeRegister(&xLayoutLineXToIndex, libs, "pango_layout_line_x_to_index")
+	core.PuregoRegisterSince(&xLayoutLineGetExtents, libs, "pango_layout_line_get_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutLineGetHeight, libs, "pango_layout_line_get_height", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xLayoutLineGetLength, libs, "pango_layout_line_get_length", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutLineGetPixelExtents, libs, "pango_layout_line_get_pixel_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutLineGetResolvedDirection, libs, "pango_layout_line_get_resolved_direction", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutLineGetStartIndex, libs, "pango_layout_line_get_start_index", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutLineGetXRanges, libs, "pango_layout_line_get_x_ranges", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutLineIndexToX, libs, "pango_layout_line_index_to_x", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutLineIsParagraphStartFn, libs, "pango_layout_line_is_paragraph_start", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutLineRef, libs, "pango_layout_line_ref", "PANGO", "1.10")
+	core.PuregoRegisterSince(&xLayoutLineUnref, libs, "pango_layout_line_unref", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutLineXToIndex, libs, "pango_layout_line_x_to_index", "PANGO", "")
 
 	core.PuregoSafeRegister(&xLayoutGLibType, libs, "pango_layout_get_type")
 
-	core.PuregoSafeRegister(&xNewLayout, libs, "pango_layout_new")
-
-	core.PuregoSafeRegister(&xLayoutContextChanged, libs, "pango_layout_context_changed")
-	core.PuregoSafeRegister(&xLayoutCopy, libs, "pango_layout_copy")
-	core.PuregoSafeRegister(&xLayoutGetAlignment, libs, "pango_layout_get_alignment")
-	core.PuregoSafeRegister(&xLayoutGetAttributes, libs, "pango_layout_get_attributes")
-	core.PuregoSafeRegister(&xLayoutGetAutoDir, libs, "pango_layout_get_auto_dir")
-	core.PuregoSafeRegister(&xLayoutGetBaseline, libs, "pango_layout_get_baseline")
-	core.PuregoSafeRegister(&xLayoutGetCaretPos, libs, "pango_layout_get_caret_pos")
-	core.PuregoSafeRegister(&xLayoutGetCharacterCount, libs, "pango_layout_get_character_count")
-	core.PuregoSafeRegister(&xLayoutGetContext, libs, "pango_layout_get_context")
-	core.PuregoSafeRegister(&xLayoutGetCursorPos, libs, "pango_layout_get_cursor_pos")
-	core.PuregoSafeRegister(&xLayoutGetDirection, libs, "pango_layout_get_direction")
-	core.PuregoSafeRegister(&xLayoutGetEllipsize, libs, "pango_layout_get_ellipsize")
-	core.PuregoSafeRegister(&xLayoutGetExtents, libs, "pango_layout_get_extents")
-	core.PuregoSafeRegister(&xLayoutGetFontDescription, libs, "pango_layout_get_font_description")
-	core.PuregoSafeRegister(&xLayoutGetHeight, libs, "pango_layout_get_height")
-	core.PuregoSafeRegister(&xLayoutGetIndent, libs, "pango_layout_get_indent")
-	core.PuregoSafeRegister(&xLayoutGetIter, libs, "pango_layout_get_iter")
-	core.PuregoSafeRegister(&xLayoutGetJustify, libs, "pango_layout_get_justify")
-	core.PuregoSafeRegister(&xLayoutGetJustifyLastLine, libs, "pango_layout_get_justify_last_line")
-	core.PuregoSafeRegister(&xLayoutGetLine, libs, "pango_layout_get_line")
-	core.PuregoSafeRegister(&xLayoutGetLineCount, libs, "pango_layout_get_line_count")
-	core.PuregoSafeRegister(&xLayoutGetLineReadonly, libs, "pango_layout_get_line_readonly")
-	core.PuregoSafeRegister(&xLayoutGetLineSpacing, libs, "pango_layout_get_line_spacing")
-	core.PuregoSafeRegister(&xLayoutGetLines, libs, "pango_layout_get_lines")
-	core.PuregoSafeRegister(&xLayoutGetLinesReadonly, libs, "pango_layout_get_lines_readonly")
-	core.PuregoSafeRegister(&xLayoutGetLogAttrs, libs, "pango_layout_get_log_attrs")
-	core.PuregoSafeRegister(&xLayoutGetLogAttrsReadonly, libs, "pango_layout_get_log_attrs_readonly")
-	core.PuregoSafeRegister(&xLayoutGetPixelExtents, libs, "pango_layout_get_pixel_extents")
-	core.PuregoSafeRegister(&xLayoutGetPixelSize, libs, "pango_layout_get_pixel_size")
-	core.PuregoSafeRegister(&xLayoutGetSerial, libs, "pango_layout_get_serial")
-	core.PuregoSafeRegister(&xLayoutGetSingleParagraphMode, libs, "pango_layout_get_single_paragraph_mode")
-	core.PuregoSafeRegister(&xLayoutGetSize, libs, "pango_layout_get_size")
-	core.PuregoSafeRegister(&xLayoutGetSpacing, libs, "pango_layout_get_spacing")
-	core.PuregoSafeRegister(&xLayoutGetTabs, libs, "pango_layout_get_tabs")
-	core.PuregoSafeRegister(&xLayoutGetText, libs, "pango_layout_get_text")
-	core.PuregoSafeRegister(&xLayoutGetUnknownGlyphsCount, libs, "pango_layout_get_unknown_glyphs_count")
-	core.PuregoSafeRegister(&xLayoutGetWidth, libs, "pango_layout_get_width")
-	core.PuregoSafeRegister(&xLayoutGetWrap, libs, "pango_layout_get_wrap")
-	core.PuregoSafeRegister(&xLayoutIndexToLineX, libs, "pango_layout_index_to_line_x")
-	core.PuregoSafeRegister(&xLayoutIndexToPos, libs, "pango_layout_index_to_pos")
-	core.PuregoSafeRegister(&xLayoutIsEllipsized, libs, "pango_layout_is_ellipsized")
-	core.PuregoSafeRegister(&xLayoutIsWrapped, libs, "pango_layout_is_wrapped")
-	core.PuregoSafeRegister(&xLayoutMoveCursorVisually, libs, "pango_layout_move_cursor_visually")
-	core.PuregoSafeRegister(&xLayoutSerialize, libs, "pango_layout_serialize")
-	core.PuregoSafeRegister(&xLayoutSetAlignment, libs, "pango_layout_set_alignment")
-	core.PuregoSafeRegister(&xLayoutSetAttributes, libs, "pango_layout_set_attributes")
-	core.PuregoSafeRegister(&xLayoutSetAutoDir, libs, "pango_layout_set_auto_dir")
-	core.PuregoSafeRegister(&xLayoutSetEllipsize, libs, "pango_layout_set_ellipsize")
-	core.PuregoSafeRegister(&xLayoutSetFontDescription, libs, "pango_layout_set_font_description")
-	core.PuregoSafeRegister(&xLayoutSetHeight, libs, "pango_layout_set_height")
-	core.PuregoSafeRegister(&xLayoutSetIndent, libs, "pango_layout_set_indent")
-	core.PuregoSafeRegister(&xLayoutSetJustify, libs, "pango_layout_set_justify")
-	core.PuregoSafeRegister(&xLayoutSetJustifyLastLine, libs, "pango_layout_set_justify_last_line")
-	core.PuregoSafeRegister(&xLayoutSetLineSpacing, libs, "pango_layout_set_line_spacing")
-	core.PuregoSafeRegister(&xLayoutSetMarkup, libs, "pango_layout_set_markup")
-	core.PuregoSafeRegister(&xLayoutSetMarkupWithAccel, libs, "pango_layout_set_markup_with_accel")
-	core.PuregoSafeRegister(&xLayoutSetSingleParagraphMode, libs, "pango_layout_set_single_paragraph_mode")
-	core.PuregoSafeRegister(&xLayoutSetSpacing, libs, "pango_layout_set_spacing")
-	core.PuregoSafeRegister(&xLayoutSetTabs, libs, "pango_layout_set_tabs")
-	core.PuregoSafeRegister(&xLayoutSetText, libs, "pango_layout_set_text")
-	core.PuregoSafeRegister(&xLayoutSetWidth, libs, "pango_layout_set_width")
-	core.PuregoSafeRegister(&xLayoutSetWrap, libs, "pango_layout_set_wrap")
-	core.PuregoSafeRegister(&xLayoutWriteToFile, libs, "pango_layout_write_to_file")
-	core.PuregoSafeRegister(&xLayoutXyToIndex, libs, "pango_layout_xy_to_index")
-
-	core.PuregoSafeRegister(&xLayoutDeserialize, libs, "pango_layout_deserialize")
+	core.PuregoRegisterSince(&xNewLayout, libs, "pango_layout_new", "PANGO", "")
+
+	core.PuregoRegisterSince(&xLayoutContextChanged, libs, "pango_layout_context_changed", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutCopy, libs, "pango_layout_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetAlignment, libs, "pango_layout_get_alignment", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetAttributes, libs, "pango_layout_get_attributes", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetAutoDir, libs, "pango_layout_get_auto_dir", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xLayoutGetBaseline, libs, "pango_layout_get_baseline", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xLayoutGetCaretPos, libs, "pango_layout_get_caret_pos", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutGetCharacterCount, libs, "pango_layout_get_character_count", "PANGO", "1.30")
+	core.PuregoRegisterSince(&xLayoutGetContext, libs, "pango_layout_get_context", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetCursorPos, libs, "pango_layout_get_cursor_pos", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetDirection, libs, "pango_layout_get_direction", "PANGO", "1.46")
+	core.PuregoRegisterSince(&xLayoutGetEllipsize, libs, "pango_layout_get_ellipsize", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xLayoutGetExtents, libs, "pango_layout_get_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetFontDescription, libs, "pango_layout_get_font_description", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xLayoutGetHeight, libs, "pango_layout_get_height", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xLayoutGetIndent, libs, "pango_layout_get_indent", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetIter, libs, "pango_layout_get_iter", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetJustify, libs, "pango_layout_get_justify", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetJustifyLastLine, libs, "pango_layout_get_justify_last_line", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutGetLine, libs, "pango_layout_get_line", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetLineCount, libs, "pango_layout_get_line_count", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetLineReadonly, libs, "pango_layout_get_line_readonly", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutGetLineSpacing, libs, "pango_layout_get_line_spacing", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xLayoutGetLines, libs, "pango_layout_get_lines", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetLinesReadonly, libs, "pango_layout_get_lines_readonly", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutGetLogAttrs, libs, "pango_layout_get_log_attrs", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetLogAttrsReadonly, libs, "pango_layout_get_log_attrs_readonly", "PANGO", "1.30")
+	core.PuregoRegisterSince(&xLayoutGetPixelExtents, libs, "pango_layout_get_pixel_extents", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetPixelSize, libs, "pango_layout_get_pixel_size", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetSerial, libs, "pango_layout_get_serial", "PANGO", "1.32.4")
+	core.PuregoRegisterSince(&xLayoutGetSingleParagraphMode, libs, "pango_layout_get_single_paragraph_mode", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetSize, libs, "pango_layout_get_size", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetSpacing, libs, "pango_layout_get_spacing", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetTabs, libs, "pango_layout_get_tabs", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetText, libs, "pango_layout_get_text", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetUnknownGlyphsCount, libs, "pango_layout_get_unknown_glyphs_count", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutGetWidth, libs, "pango_layout_get_width", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutGetWrap, libs, "pango_layout_get_wrap", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIndexToLineX, libs, "pango_layout_index_to_line_x", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIndexToPos, libs, "pango_layout_index_to_pos", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutIsEllipsized, libs, "pango_layout_is_ellipsized", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutIsWrapped, libs, "pango_layout_is_wrapped", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xLayoutMoveCursorVisually, libs, "pango_layout_move_cursor_visually", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSerialize, libs, "pango_layout_serialize", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutSetAlignment, libs, "pango_layout_set_alignment", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetAttributes, libs, "pango_layout_set_attributes", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetAutoDir, libs, "pango_layout_set_auto_dir", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xLayoutSetEllipsize, libs, "pango_layout_set_ellipsize", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xLayoutSetFontDescription, libs, "pango_layout_set_font_description", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetHeight, libs, "pango_layout_set_height", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xLayoutSetIndent, libs, "pango_layout_set_indent", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetJustify, libs, "pango_layout_set_justify", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetJustifyLastLine, libs, "pango_layout_set_justify_last_line", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutSetLineSpacing, libs, "pango_layout_set_line_spacing", "PANGO", "1.44")
+	core.PuregoRegisterSince(&xLayoutSetMarkup, libs, "pango_layout_set_markup", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetMarkupWithAccel, libs, "pango_layout_set_markup_with_accel", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetSingleParagraphMode, libs, "pango_layout_set_single_paragraph_mode", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetSpacing, libs, "pango_layout_set_spacing", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetTabs, libs, "pango_layout_set_tabs", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetText, libs, "pango_layout_set_text", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetWidth, libs, "pango_layout_set_width", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutSetWrap, libs, "pango_layout_set_wrap", "PANGO", "")
+	core.PuregoRegisterSince(&xLayoutWriteToFile, libs, "pango_layout_write_to_file", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xLayoutXyToIndex, libs, "pango_layout_xy_to_index", "PANGO", "")
+
+	core.PuregoRegisterSince(&xLayoutDeserialize, libs, "pango_layout_deserialize", "PANGO", "1.50")
 
 }
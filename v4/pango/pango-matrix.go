@@ -5,7 +5,6 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -13,12 +12,10 @@ import (
 // A `PangoMatrix` specifies a transformation between user-space
 // and device coordinates.
 //
-// # The transformation is given by
+// The transformation is given by
 //
-// ```
-// x_device = x_user * matrix-&gt;xx + y_user * matrix-&gt;xy + matrix-&gt;x0;
-// y_device = x_user * matrix-&gt;yx + y_user * matrix-&gt;yy + matrix-&gt;y0;
-// ```
+//	x_device = x_user * matrix->xx + y_user * matrix->xy + matrix->x0;
+//	y_device = x_user * matrix->yx + y_user * matrix->yy + matrix->y0;
 type Matrix struct {
 	_ structs.HostLayout
 
@@ -47,9 +44,9 @@ func (x *Matrix) GoPointer() uintptr {
 
 var xMatrixConcat func(uintptr, *Matrix)
 
-// Changes the transformation represented by @matrix to be the
+// Changes the transformation represented by matrix to be the
 // transformation given by first applying transformation
-// given by @new_matrix then applying the original transformation.
+// given by new_matrix then applying the original transformation.
 func (x *Matrix) Concat(NewMatrixVar *Matrix) {
 
 	xMatrixConcat(x.GoPointer(), NewMatrixVar)
@@ -80,7 +77,7 @@ var xMatrixGetFontScaleFactor func(uintptr) float64
 //
 // That is, the scale factor in the direction perpendicular to the
 // vector that the X coordinate is mapped to.  If the scale in the X
-// coordinate is needed as well, use [method@Pango.Matrix.get_font_scale_factors].
+// coordinate is needed as well, use [Matrix.GetFontScaleFactors].
 func (x *Matrix) GetFontScaleFactor() float64 {
 
 	cret := xMatrixGetFontScaleFactor(x.GoPointer())
@@ -91,8 +88,8 @@ var xMatrixGetFontScaleFactors func(uintptr, *float64, *float64)
 
 // Calculates the scale factor of a matrix on the width and height of the font.
 //
-// That is, @xscale is the scale factor in the direction of the X coordinate,
-// and @yscale is the scale factor in the direction perpendicular to the
+// That is, xscale is the scale factor in the direction of the X coordinate,
+// and yscale is the scale factor in the direction perpendicular to the
 // vector that the X coordinate is mapped to.
 //
 // Note that output numbers will always be non-negative.
@@ -120,8 +117,8 @@ func (x *Matrix) GetSlantRatio() float64 {
 
 var xMatrixRotate func(uintptr, float64)
 
-// Changes the transformation represented by @matrix to be the
-// transformation given by first rotating by @degrees degrees
+// Changes the transformation represented by matrix to be the
+// transformation given by first rotating by degrees degrees
 // counter-clockwise then applying the original transformation.
 func (x *Matrix) Rotate(DegreesVar float64) {
 
@@ -131,9 +128,9 @@ func (x *Matrix) Rotate(DegreesVar float64) {
 
 var xMatrixScale func(uintptr, float64, float64)
 
-// Changes the transformation represented by @matrix to be the
-// transformation given by first scaling by @sx in the X direction
-// and @sy in the Y direction then applying the original
+// Changes the transformation represented by matrix to be the
+// transformation given by first scaling by sx in the X direction
+// and sy in the Y direction then applying the original
 // transformation.
 func (x *Matrix) Scale(ScaleXVar float64, ScaleYVar float64) {
 
@@ -143,21 +140,19 @@ func (x *Matrix) Scale(ScaleXVar float64, ScaleYVar float64) {
 
 var xMatrixTransformDistance func(uintptr, float64, float64)
 
-// Transforms the distance vector (@dx,@dy) by @matrix.
+// Transforms the distance vector (dx,dy) by matrix.
 //
-// This is similar to [method@Pango.Matrix.transform_point],
+// This is similar to [Matrix.TransformPoint],
 // except that the translation components of the transformation
 // are ignored. The calculation of the returned vector is as follows:
 //
-// ```
-// dx2 = dx1 * xx + dy1 * xy;
-// dy2 = dx1 * yx + dy1 * yy;
-// ```
+//	dx2 = dx1 * xx + dy1 * xy;
+//	dy2 = dx1 * yx + dy1 * yy;
 //
 // Affine transformations are position invariant, so the same vector
-// always transforms to the same vector. If (@x1,@y1) transforms
-// to (@x2,@y2) then (@x1+@dx1,@y1+@dy1) will transform to
-// (@x1+@dx2,@y1+@dy2) for all values of @x1 and @x2.
+// always transforms to the same vector. If (x1,y1) transforms
+// to (x2,y2) then (x1+dx1,y1+dy1) will transform to
+// (x1+dx2,y1+dy2) for all values of x1 and x2.
 func (x *Matrix) TransformDistance(DxVar float64, DyVar float64) {
 
 	xMatrixTransformDistance(x.GoPointer(), DxVar, DyVar)
@@ -166,16 +161,16 @@ func (x *Matrix) TransformDistance(DxVar float64, DyVar float64) {
 
 var xMatrixTransformPixelRectangle func(uintptr, *Rectangle)
 
-// First transforms the @rect using @matrix, then calculates the bounding box
+// First transforms the rect using matrix, then calculates the bounding box
 // of the transformed rectangle.
 //
 // This function is useful for example when you want to draw a rotated
 // @PangoLayout to an image buffer, and want to know how large the image
 // should be and how much you should shift the layout when rendering.
 //
-// For better accuracy, you should use [method@Pango.Matrix.transform_rectangle]
+// For better accuracy, you should use [Matrix.TransformRectangle]
 // on original rectangle in Pango units and convert to pixels afterward
-// using [func@extents_to_pixels]'s first argument.
+// using extents_to_pixels's first argument.
 func (x *Matrix) TransformPixelRectangle(RectVar *Rectangle) {
 
 	xMatrixTransformPixelRectangle(x.GoPointer(), RectVar)
@@ -184,7 +179,7 @@ func (x *Matrix) TransformPixelRectangle(RectVar *Rectangle) {
 
 var xMatrixTransformPoint func(uintptr, float64, float64)
 
-// Transforms the point (@x, @y) by @matrix.
+// Transforms the point (x, y) by matrix.
 func (x *Matrix) TransformPoint(XVar float64, YVar float64) {
 
 	xMatrixTransformPoint(x.GoPointer(), XVar, YVar)
@@ -193,7 +188,7 @@ func (x *Matrix) TransformPoint(XVar float64, YVar float64) {
 
 var xMatrixTransformRectangle func(uintptr, *Rectangle)
 
-// First transforms @rect using @matrix, then calculates the bounding box
+// First transforms rect using matrix, then calculates the bounding box
 // of the transformed rectangle.
 //
 // This function is useful for example when you want to draw a rotated
@@ -201,11 +196,11 @@ var xMatrixTransformRectangle func(uintptr, *Rectangle)
 // should be and how much you should shift the layout when rendering.
 //
 // If you have a rectangle in device units (pixels), use
-// [method@Pango.Matrix.transform_pixel_rectangle].
+// [Matrix.TransformPixelRectangle].
 //
 // If you have the rectangle in Pango units and want to convert to
 // transformed pixel bounding box, it is more accurate to transform it first
-// (using this function) and pass the result to pango_extents_to_pixels(),
+// (using this function) and pass the result to [ExtentsToPixels],
 // first argument, for an inclusive rounded rectangle.
 // However, there are valid reasons that you may want to convert
 // to pixels first and then transform, for example when the transformed
@@ -219,8 +214,8 @@ func (x *Matrix) TransformRectangle(RectVar *Rectangle) {
 
 var xMatrixTranslate func(uintptr, float64, float64)
 
-// Changes the transformation represented by @matrix to be the
-// transformation given by first translating by (@tx, @ty)
+// Changes the transformation represented by matrix to be the
+// transformation given by first translating by (tx, ty)
 // then applying the original transformation.
 func (x *Matrix) Translate(TxVar float64, TyVar float64) {
 
@@ -233,7 +228,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -242,18 +237,18 @@ func init() {
 
 	core.PuregoSafeRegister(&xMatrixGLibType, libs, "pango_matrix_get_type")
 
-	core.PuregoSafeRegister(&xMatrixConcat, libs, "pango_matrix_concat")
-	core.PuregoSafeRegister(&xMatrixCopy, libs, "pango_matrix_copy")
-	core.PuregoSafeRegister(&xMatrixFree, libs, "pango_matrix_free")
-	core.PuregoSafeRegister(&xMatrixGetFontScaleFactor, libs, "pango_matrix_get_font_scale_factor")
-	core.PuregoSafeRegister(&xMatrixGetFontScaleFactors, libs, "pango_matrix_get_font_scale_factors")
-	core.PuregoSafeRegister(&xMatrixGetSlantRatio, libs, "pango_matrix_get_slant_ratio")
-	core.PuregoSafeRegister(&xMatrixRotate, libs, "pango_matrix_rotate")
-	core.PuregoSafeRegister(&xMatrixScale, libs, "pango_matrix_scale")
-	core.PuregoSafeRegister(&xMatrixTransformDistance, libs, "pango_matrix_transform_distance")
-	core.PuregoSafeRegister(&xMatrixTransformPixelRectangle, libs, "pango_matrix_transform_pixel_rectangle")
-	core.PuregoSafeRegister(&xMatrixTransformPoint, libs, "pango_matrix_transform_point")
-	core.PuregoSafeRegister(&xMatrixTransformRectangle, libs, "pango_matrix_transform_rectangle")
-	core.PuregoSafeRegister(&xMatrixTranslate, libs, "pango_matrix_translate")
+	core.PuregoRegisterSince(&xMatrixConcat, libs, "pango_matrix_concat", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xMatrixCopy, libs, "pango_matrix_copy", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xMatrixFree, libs, "pango_matrix_free", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xMatrixGetFontScaleFactor, libs, "pango_matrix_get_font_scale_factor", "PANGO", "1.12")
+	core.PuregoRegisterSince(&xMatrixGetFontScaleFactors, libs, "pango_matrix_get_font_scale_factors", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xMatrixGetSlantRatio, libs, "pango_matrix_get_slant_ratio", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xMatrixRotate, libs, "pango_matrix_rotate", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xMatrixScale, libs, "pango_matrix_scale", "PANGO", "1.6")
+	core.PuregoRegisterSince(&xMatrixTransformDistance, libs, "pango_matrix_transform_distance", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xMatrixTransformPixelRectangle, libs, "pango_matrix_transform_pixel_rectangle", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xMatrixTransformPoint, libs, "pango_matrix_transform_point", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xMatrixTransformRectangle, libs, "pango_matrix_transform_rectangle", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xMatrixTranslate, libs, "pango_matrix_translate", "PANGO", "1.6")
 
 }
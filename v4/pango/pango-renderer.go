@@ -2,10 +2,10 @@
 package pango
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
@@ -71,7 +71,8 @@ func (x *RendererClass) OverrideDrawGlyphs(cb func(*Renderer, *Font, *GlyphStrin
 	if cb == nil {
 		x.xDrawGlyphs = 0
 	} else {
-		x.xDrawGlyphs = purego.NewCallback(func(RendererVarp uintptr, FontVarp uintptr, GlyphsVarp *GlyphString, XVarp int, YVarp int) {
+		x.xDrawGlyphs = core.NewCallback(func(RendererVarp uintptr, FontVarp uintptr, GlyphsVarp *GlyphString, XVarp int, YVarp int) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), FontNewFromInternalPtr(FontVarp), GlyphsVarp, XVarp, YVarp)
 		})
 	}
@@ -84,7 +85,7 @@ func (x *RendererClass) GetDrawGlyphs() func(*Renderer, *Font, *GlyphString, int
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, FontVarp uintptr, GlyphsVarp *GlyphString, XVarp int, YVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawGlyphs)
+	core.RegisterFunc(&rawCallback, x.xDrawGlyphs)
 	return func(RendererVar *Renderer, FontVar *Font, GlyphsVar *GlyphString, XVar int, YVar int) {
 		rawCallback(RendererVar.GoPointer(), FontVar.GoPointer(), GlyphsVar, XVar, YVar)
 	}
@@ -96,7 +97,8 @@ func (x *RendererClass) OverrideDrawRectangle(cb func(*Renderer, RenderPart, int
 	if cb == nil {
 		x.xDrawRectangle = 0
 	} else {
-		x.xDrawRectangle = purego.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+		x.xDrawRectangle = core.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), PartVarp, XVarp, YVarp, WidthVarp, HeightVarp)
 		})
 	}
@@ -109,7 +111,7 @@ func (x *RendererClass) GetDrawRectangle() func(*Renderer, RenderPart, int, int,
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, PartVarp RenderPart, XVarp int, YVarp int, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawRectangle)
+	core.RegisterFunc(&rawCallback, x.xDrawRectangle)
 	return func(RendererVar *Renderer, PartVar RenderPart, XVar int, YVar int, WidthVar int, HeightVar int) {
 		rawCallback(RendererVar.GoPointer(), PartVar, XVar, YVar, WidthVar, HeightVar)
 	}
@@ -123,7 +125,8 @@ func (x *RendererClass) OverrideDrawErrorUnderline(cb func(*Renderer, int, int,
 	if cb == nil {
 		x.xDrawErrorUnderline = 0
 	} else {
-		x.xDrawErrorUnderline = purego.NewCallback(func(RendererVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+		x.xDrawErrorUnderline = core.NewCallback(func(RendererVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), XVarp, YVarp, WidthVarp, HeightVarp)
 		})
 	}
@@ -138,7 +141,7 @@ func (x *RendererClass) GetDrawErrorUnderline() func(*Renderer, int, int, int, i
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, XVarp int, YVarp int, WidthVarp int, HeightVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawErrorUnderline)
+	core.RegisterFunc(&rawCallback, x.xDrawErrorUnderline)
 	return func(RendererVar *Renderer, XVar int, YVar int, WidthVar int, HeightVar int) {
 		rawCallback(RendererVar.GoPointer(), XVar, YVar, WidthVar, HeightVar)
 	}
@@ -147,13 +150,14 @@ func (x *RendererClass) GetDrawErrorUnderline() func(*Renderer, int, int, int, i
 // OverrideDrawShape sets the "draw_shape" callback function.
 // draw content for a glyph shaped with `PangoAttrShape`
 //
-//	@x, @y are the coordinates of the left edge of the baseline,
+//	x, y are the coordinates of the left edge of the baseline,
 //	in user coordinates.
 func (x *RendererClass) OverrideDrawShape(cb func(*Renderer, *AttrShape, int, int)) {
 	if cb == nil {
 		x.xDrawShape = 0
 	} else {
-		x.xDrawShape = purego.NewCallback(func(RendererVarp uintptr, AttrVarp *AttrShape, XVarp int, YVarp int) {
+		x.xDrawShape = core.NewCallback(func(RendererVarp uintptr, AttrVarp *AttrShape, XVarp int, YVarp int) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), AttrVarp, XVarp, YVarp)
 		})
 	}
@@ -162,14 +166,14 @@ func (x *RendererClass) OverrideDrawShape(cb func(*Renderer, *AttrShape, int, in
 // GetDrawShape gets the "draw_shape" callback function.
 // draw content for a glyph shaped with `PangoAttrShape`
 //
-//	@x, @y are the coordinates of the left edge of the baseline,
+//	x, y are the coordinates of the left edge of the baseline,
 //	in user coordinates.
 func (x *RendererClass) GetDrawShape() func(*Renderer, *AttrShape, int, int) {
 	if x.xDrawShape == 0 {
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, AttrVarp *AttrShape, XVarp int, YVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawShape)
+	core.RegisterFunc(&rawCallback, x.xDrawShape)
 	return func(RendererVar *Renderer, AttrVar *AttrShape, XVar int, YVar int) {
 		rawCallback(RendererVar.GoPointer(), AttrVar, XVar, YVar)
 	}
@@ -181,7 +185,8 @@ func (x *RendererClass) OverrideDrawTrapezoid(cb func(*Renderer, RenderPart, flo
 	if cb == nil {
 		x.xDrawTrapezoid = 0
 	} else {
-		x.xDrawTrapezoid = purego.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart, Y1Varp float64, X11Varp float64, X21Varp float64, Y2Varp float64, X12Varp float64, X22Varp float64) {
+		x.xDrawTrapezoid = core.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart, Y1Varp float64, X11Varp float64, X21Varp float64, Y2Varp float64, X12Varp float64, X22Varp float64) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), PartVarp, Y1Varp, X11Varp, X21Varp, Y2Varp, X12Varp, X22Varp)
 		})
 	}
@@ -194,7 +199,7 @@ func (x *RendererClass) GetDrawTrapezoid() func(*Renderer, RenderPart, float64,
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, PartVarp RenderPart, Y1Varp float64, X11Varp float64, X21Varp float64, Y2Varp float64, X12Varp float64, X22Varp float64)
-	purego.RegisterFunc(&rawCallback, x.xDrawTrapezoid)
+	core.RegisterFunc(&rawCallback, x.xDrawTrapezoid)
 	return func(RendererVar *Renderer, PartVar RenderPart, Y1Var float64, X11Var float64, X21Var float64, Y2Var float64, X12Var float64, X22Var float64) {
 		rawCallback(RendererVar.GoPointer(), PartVar, Y1Var, X11Var, X21Var, Y2Var, X12Var, X22Var)
 	}
@@ -206,7 +211,8 @@ func (x *RendererClass) OverrideDrawGlyph(cb func(*Renderer, *Font, Glyph, float
 	if cb == nil {
 		x.xDrawGlyph = 0
 	} else {
-		x.xDrawGlyph = purego.NewCallback(func(RendererVarp uintptr, FontVarp uintptr, GlyphVarp Glyph, XVarp float64, YVarp float64) {
+		x.xDrawGlyph = core.NewCallback(func(RendererVarp uintptr, FontVarp uintptr, GlyphVarp Glyph, XVarp float64, YVarp float64) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), FontNewFromInternalPtr(FontVarp), GlyphVarp, XVarp, YVarp)
 		})
 	}
@@ -219,7 +225,7 @@ func (x *RendererClass) GetDrawGlyph() func(*Renderer, *Font, Glyph, float64, fl
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, FontVarp uintptr, GlyphVarp Glyph, XVarp float64, YVarp float64)
-	purego.RegisterFunc(&rawCallback, x.xDrawGlyph)
+	core.RegisterFunc(&rawCallback, x.xDrawGlyph)
 	return func(RendererVar *Renderer, FontVar *Font, GlyphVar Glyph, XVar float64, YVar float64) {
 		rawCallback(RendererVar.GoPointer(), FontVar.GoPointer(), GlyphVar, XVar, YVar)
 	}
@@ -233,7 +239,8 @@ func (x *RendererClass) OverridePartChanged(cb func(*Renderer, RenderPart)) {
 	if cb == nil {
 		x.xPartChanged = 0
 	} else {
-		x.xPartChanged = purego.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart) {
+		x.xPartChanged = core.NewCallback(func(RendererVarp uintptr, PartVarp RenderPart) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), PartVarp)
 		})
 	}
@@ -248,7 +255,7 @@ func (x *RendererClass) GetPartChanged() func(*Renderer, RenderPart) {
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, PartVarp RenderPart)
-	purego.RegisterFunc(&rawCallback, x.xPartChanged)
+	core.RegisterFunc(&rawCallback, x.xPartChanged)
 	return func(RendererVar *Renderer, PartVar RenderPart) {
 		rawCallback(RendererVar.GoPointer(), PartVar)
 	}
@@ -260,7 +267,8 @@ func (x *RendererClass) OverrideBegin(cb func(*Renderer)) {
 	if cb == nil {
 		x.xBegin = 0
 	} else {
-		x.xBegin = purego.NewCallback(func(RendererVarp uintptr) {
+		x.xBegin = core.NewCallback(func(RendererVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp))
 		})
 	}
@@ -273,7 +281,7 @@ func (x *RendererClass) GetBegin() func(*Renderer) {
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xBegin)
+	core.RegisterFunc(&rawCallback, x.xBegin)
 	return func(RendererVar *Renderer) {
 		rawCallback(RendererVar.GoPointer())
 	}
@@ -285,7 +293,8 @@ func (x *RendererClass) OverrideEnd(cb func(*Renderer)) {
 	if cb == nil {
 		x.xEnd = 0
 	} else {
-		x.xEnd = purego.NewCallback(func(RendererVarp uintptr) {
+		x.xEnd = core.NewCallback(func(RendererVarp uintptr) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp))
 		})
 	}
@@ -298,7 +307,7 @@ func (x *RendererClass) GetEnd() func(*Renderer) {
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr)
-	purego.RegisterFunc(&rawCallback, x.xEnd)
+	core.RegisterFunc(&rawCallback, x.xEnd)
 	return func(RendererVar *Renderer) {
 		rawCallback(RendererVar.GoPointer())
 	}
@@ -310,7 +319,8 @@ func (x *RendererClass) OverridePrepareRun(cb func(*Renderer, *LayoutRun)) {
 	if cb == nil {
 		x.xPrepareRun = 0
 	} else {
-		x.xPrepareRun = purego.NewCallback(func(RendererVarp uintptr, RunVarp *LayoutRun) {
+		x.xPrepareRun = core.NewCallback(func(RendererVarp uintptr, RunVarp *LayoutRun) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), RunVarp)
 		})
 	}
@@ -323,7 +333,7 @@ func (x *RendererClass) GetPrepareRun() func(*Renderer, *LayoutRun) {
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, RunVarp *LayoutRun)
-	purego.RegisterFunc(&rawCallback, x.xPrepareRun)
+	core.RegisterFunc(&rawCallback, x.xPrepareRun)
 	return func(RendererVar *Renderer, RunVar *LayoutRun) {
 		rawCallback(RendererVar.GoPointer(), RunVar)
 	}
@@ -335,7 +345,8 @@ func (x *RendererClass) OverrideDrawGlyphItem(cb func(*Renderer, string, *GlyphI
 	if cb == nil {
 		x.xDrawGlyphItem = 0
 	} else {
-		x.xDrawGlyphItem = purego.NewCallback(func(RendererVarp uintptr, TextVarp string, GlyphItemVarp *GlyphItem, XVarp int, YVarp int) {
+		x.xDrawGlyphItem = core.NewCallback(func(RendererVarp uintptr, TextVarp string, GlyphItemVarp *GlyphItem, XVarp int, YVarp int) {
+			defer core.RecoverPanic()
 			cb(RendererNewFromInternalPtr(RendererVarp), TextVarp, GlyphItemVarp, XVarp, YVarp)
 		})
 	}
@@ -348,7 +359,7 @@ func (x *RendererClass) GetDrawGlyphItem() func(*Renderer, string, *GlyphItem, i
 		return nil
 	}
 	var rawCallback func(RendererVarp uintptr, TextVarp string, GlyphItemVarp *GlyphItem, XVarp int, YVarp int)
-	purego.RegisterFunc(&rawCallback, x.xDrawGlyphItem)
+	core.RegisterFunc(&rawCallback, x.xDrawGlyphItem)
 	return func(RendererVar *Renderer, TextVar string, GlyphItemVar *GlyphItem, XVar int, YVar int) {
 		rawCallback(RendererVar.GoPointer(), TextVar, GlyphItemVar, XVar, YVar)
 	}
@@ -359,7 +370,8 @@ func (x *RendererClass) OverridePangoReserved2(cb func()) {
 	if cb == nil {
 		x.xPangoReserved2 = 0
 	} else {
-		x.xPangoReserved2 = purego.NewCallback(func() {
+		x.xPangoReserved2 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -371,7 +383,7 @@ func (x *RendererClass) GetPangoReserved2() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved2)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved2)
 	return func() {
 		rawCallback()
 	}
@@ -382,7 +394,8 @@ func (x *RendererClass) OverridePangoReserved3(cb func()) {
 	if cb == nil {
 		x.xPangoReserved3 = 0
 	} else {
-		x.xPangoReserved3 = purego.NewCallback(func() {
+		x.xPangoReserved3 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -394,7 +407,7 @@ func (x *RendererClass) GetPangoReserved3() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved3)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved3)
 	return func() {
 		rawCallback()
 	}
@@ -405,7 +418,8 @@ func (x *RendererClass) OverridePangoReserved4(cb func()) {
 	if cb == nil {
 		x.xPangoReserved4 = 0
 	} else {
-		x.xPangoReserved4 = purego.NewCallback(func() {
+		x.xPangoReserved4 = core.NewCallback(func() {
+			defer core.RecoverPanic()
 			cb()
 		})
 	}
@@ -417,7 +431,7 @@ func (x *RendererClass) GetPangoReserved4() func() {
 		return nil
 	}
 	var rawCallback func()
-	purego.RegisterFunc(&rawCallback, x.xPangoReserved4)
+	core.RegisterFunc(&rawCallback, x.xPangoReserved4)
 	return func() {
 		rawCallback()
 	}
@@ -455,11 +469,62 @@ const (
 	RenderPartOverlineValue RenderPart = 4
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x RenderPart) String() string {
+	switch x {
+	case RenderPartForegroundValue:
+		return "PANGO_RENDER_PART_FOREGROUND"
+	case RenderPartBackgroundValue:
+		return "PANGO_RENDER_PART_BACKGROUND"
+	case RenderPartUnderlineValue:
+		return "PANGO_RENDER_PART_UNDERLINE"
+	case RenderPartStrikethroughValue:
+		return "PANGO_RENDER_PART_STRIKETHROUGH"
+	case RenderPartOverlineValue:
+		return "PANGO_RENDER_PART_OVERLINE"
+	}
+	return fmt.Sprintf("RenderPart(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x RenderPart) Nick() string {
+	switch x {
+	case RenderPartForegroundValue:
+		return "foreground"
+	case RenderPartBackgroundValue:
+		return "background"
+	case RenderPartUnderlineValue:
+		return "underline"
+	case RenderPartStrikethroughValue:
+		return "strikethrough"
+	case RenderPartOverlineValue:
+		return "overline"
+	}
+	return ""
+}
+
+// RenderPartFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func RenderPartFromString(s string) (RenderPart, bool) {
+	switch s {
+	case "PANGO_RENDER_PART_FOREGROUND", "foreground":
+		return RenderPartForegroundValue, true
+	case "PANGO_RENDER_PART_BACKGROUND", "background":
+		return RenderPartBackgroundValue, true
+	case "PANGO_RENDER_PART_UNDERLINE", "underline":
+		return RenderPartUnderlineValue, true
+	case "PANGO_RENDER_PART_STRIKETHROUGH", "strikethrough":
+		return RenderPartStrikethroughValue, true
+	case "PANGO_RENDER_PART_OVERLINE", "overline":
+		return RenderPartOverlineValue, true
+	}
+	return 0, false
+}
+
 // `PangoRenderer` is a base class for objects that can render text
 // provided as `PangoGlyphString` or `PangoLayout`.
 //
 // By subclassing `PangoRenderer` and overriding operations such as
-// @draw_glyphs and @draw_rectangle, renderers for particular font
+// draw_glyphs and draw_rectangle, renderers for particular font
 // backends and destinations can be created.
 type Renderer struct {
 	gobject.Object
@@ -479,14 +544,14 @@ func RendererNewFromInternalPtr(ptr uintptr) *Renderer {
 
 var xRendererActivate func(uintptr)
 
-// Does initial setup before rendering operations on @renderer.
+// Does initial setup before rendering operations on renderer.
 //
-// [method@Pango.Renderer.deactivate] should be called when done drawing.
-// Calls such as [method@Pango.Renderer.draw_layout] automatically
+// [Renderer.Deactivate] should be called when done drawing.
+// Calls such as [Renderer.DrawLayout] automatically
 // activate the layout before drawing on it.
 //
-// Calls to [method@Pango.Renderer.activate] and
-// [method@Pango.Renderer.deactivate] can be nested and the
+// Calls to [Renderer.Activate] and
+// [Renderer.Deactivate] can be nested and the
 // renderer will only be initialized and deinitialized once.
 func (x *Renderer) Activate() {
 
@@ -496,9 +561,9 @@ func (x *Renderer) Activate() {
 
 var xRendererDeactivate func(uintptr)
 
-// Cleans up after rendering operations on @renderer.
+// Cleans up after rendering operations on renderer.
 //
-// See docs for [method@Pango.Renderer.activate].
+// See docs for [Renderer.Activate].
 func (x *Renderer) Deactivate() {
 
 	xRendererDeactivate(x.GoPointer())
@@ -514,8 +579,8 @@ var xRendererDrawErrorUnderline func(uintptr, int, int, int, int)
 // of up/down segments and the resulting rectangle is centered
 // in the original rectangle.
 //
-// This should be called while @renderer is already active.
-// Use [method@Pango.Renderer.activate] to activate a renderer.
+// This should be called while renderer is already active.
+// Use [Renderer.Activate] to activate a renderer.
 func (x *Renderer) DrawErrorUnderline(XVar int, YVar int, WidthVar int, HeightVar int) {
 
 	xRendererDrawErrorUnderline(x.GoPointer(), XVar, YVar, WidthVar, HeightVar)
@@ -533,24 +598,24 @@ func (x *Renderer) DrawGlyph(FontVar *Font, GlyphVar Glyph, XVar float64, YVar f
 
 var xRendererDrawGlyphItem func(uintptr, uintptr, *GlyphItem, int, int)
 
-// Draws the glyphs in @glyph_item with the specified `PangoRenderer`,
+// Draws the glyphs in glyph_item with the specified `PangoRenderer`,
 // embedding the text associated with the glyphs in the output if the
 // output format supports it.
 //
 // This is useful for rendering text in PDF.
 //
-// Note that this method does not handle attributes in @glyph_item.
+// Note that this method does not handle attributes in glyph_item.
 // If you want colors, shapes and lines handled automatically according
-// to those attributes, you need to use pango_renderer_draw_layout_line()
-// or pango_renderer_draw_layout().
+// to those attributes, you need to use [Renderer.DrawLayoutLine]
+// or [Renderer.DrawLayout].
 //
-// Note that @text is the start of the text for layout, which is then
-// indexed by `glyph_item-&gt;item-&gt;offset`.
+// Note that text is the start of the text for layout, which is then
+// indexed by `glyph_item->item->offset`.
 //
-// If @text is %NULL, this simply calls [method@Pango.Renderer.draw_glyphs].
+// If text is nil, this simply calls [Renderer.DrawGlyphs].
 //
 // The default implementation of this method simply falls back to
-// [method@Pango.Renderer.draw_glyphs].
+// [Renderer.DrawGlyphs].
 func (x *Renderer) DrawGlyphItem(TextVar *string, GlyphItemVar *GlyphItem, XVar int, YVar int) {
 
 	TextVarPtr := core.GStrdupNullable(TextVar)
@@ -562,7 +627,7 @@ func (x *Renderer) DrawGlyphItem(TextVar *string, GlyphItemVar *GlyphItem, XVar
 
 var xRendererDrawGlyphs func(uintptr, uintptr, *GlyphString, int, int)
 
-// Draws the glyphs in @glyphs with the specified `PangoRenderer`.
+// Draws the glyphs in glyphs with the specified `PangoRenderer`.
 func (x *Renderer) DrawGlyphs(FontVar *Font, GlyphsVar *GlyphString, XVar int, YVar int) {
 
 	xRendererDrawGlyphs(x.GoPointer(), FontVar.GoPointer(), GlyphsVar, XVar, YVar)
@@ -571,10 +636,10 @@ func (x *Renderer) DrawGlyphs(FontVar *Font, GlyphsVar *GlyphString, XVar int, Y
 
 var xRendererDrawLayout func(uintptr, uintptr, int, int)
 
-// Draws @layout with the specified `PangoRenderer`.
+// Draws layout with the specified `PangoRenderer`.
 //
 // This is equivalent to drawing the lines of the layout, at their
-// respective positions relative to @x, @y.
+// respective positions relative to x, y.
 func (x *Renderer) DrawLayout(LayoutVar *Layout, XVar int, YVar int) {
 
 	xRendererDrawLayout(x.GoPointer(), LayoutVar.GoPointer(), XVar, YVar)
@@ -583,7 +648,7 @@ func (x *Renderer) DrawLayout(LayoutVar *Layout, XVar int, YVar int) {
 
 var xRendererDrawLayoutLine func(uintptr, *LayoutLine, int, int)
 
-// Draws @line with the specified `PangoRenderer`.
+// Draws line with the specified `PangoRenderer`.
 //
 // This draws the glyph items that make up the line, as well as
 // shapes, backgrounds and lines that are specified by the attributes
@@ -599,8 +664,8 @@ var xRendererDrawRectangle func(uintptr, RenderPart, int, int, int, int)
 // Draws an axis-aligned rectangle in user space coordinates with the
 // specified `PangoRenderer`.
 //
-// This should be called while @renderer is already active.
-// Use [method@Pango.Renderer.activate] to activate a renderer.
+// This should be called while renderer is already active.
+// Use [Renderer.Activate] to activate a renderer.
 func (x *Renderer) DrawRectangle(PartVar RenderPart, XVar int, YVar int, WidthVar int, HeightVar int) {
 
 	xRendererDrawRectangle(x.GoPointer(), PartVar, XVar, YVar, WidthVar, HeightVar)
@@ -637,7 +702,7 @@ func (x *Renderer) GetColor(PartVar RenderPart) *Color {
 
 var xRendererGetLayout func(uintptr) uintptr
 
-// Gets the layout currently being rendered using @renderer.
+// Gets the layout currently being rendered using renderer.
 //
 // Calling this function only makes sense from inside a subclass's
 // methods, like in its draw_shape vfunc, for example.
@@ -660,7 +725,7 @@ func (x *Renderer) GetLayout() *Layout {
 
 var xRendererGetLayoutLine func(uintptr) *LayoutLine
 
-// Gets the layout line currently being rendered using @renderer.
+// Gets the layout line currently being rendered using renderer.
 //
 // Calling this function only makes sense from inside a subclass's
 // methods, like in its draw_shape vfunc, for example.
@@ -678,7 +743,7 @@ var xRendererGetMatrix func(uintptr) *Matrix
 // Gets the transformation matrix that will be applied when
 // rendering.
 //
-// See [method@Pango.Renderer.set_matrix].
+// See [Renderer.SetMatrix].
 func (x *Renderer) GetMatrix() *Matrix {
 
 	cret := xRendererGetMatrix(x.GoPointer())
@@ -688,20 +753,18 @@ func (x *Renderer) GetMatrix() *Matrix {
 var xRendererPartChanged func(uintptr, RenderPart)
 
 // Informs Pango that the way that the rendering is done
-// for @part has changed.
+// for part has changed.
 //
 // This should be called if the rendering changes in a way that would
 // prevent multiple pieces being joined together into one drawing call.
 // For instance, if a subclass of `PangoRenderer` was to add a stipple
 // option for drawing underlines, it needs to call
 //
-// ```
-// pango_renderer_part_changed (render, PANGO_RENDER_PART_UNDERLINE);
-// ```
+//	pango_renderer_part_changed (render, PANGO_RENDER_PART_UNDERLINE);
 //
 // When the stipple changes or underlines with different stipples
 // might be joined together. Pango automatically calls this for
-// changes to colors. (See [method@Pango.Renderer.set_color])
+// changes to colors. (See [Renderer.SetColor])
 func (x *Renderer) PartChanged(PartVar RenderPart) {
 
 	xRendererPartChanged(x.GoPointer(), PartVar)
@@ -713,7 +776,7 @@ var xRendererSetAlpha func(uintptr, RenderPart, uint16)
 // Sets the alpha for part of the rendering.
 //
 // Note that the alpha may only be used if a color is
-// specified for @part as well.
+// specified for part as well.
 func (x *Renderer) SetAlpha(PartVar RenderPart, AlphaVar uint16) {
 
 	xRendererSetAlpha(x.GoPointer(), PartVar, AlphaVar)
@@ -724,7 +787,7 @@ var xRendererSetColor func(uintptr, RenderPart, *Color)
 
 // Sets the color for part of the rendering.
 //
-// Also see [method@Pango.Renderer.set_alpha].
+// Also see [Renderer.SetAlpha].
 func (x *Renderer) SetColor(PartVar RenderPart, ColorVar *Color) {
 
 	xRendererSetColor(x.GoPointer(), PartVar, ColorVar)
@@ -756,7 +819,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -767,24 +830,26 @@ func init() {
 
 	core.PuregoSafeRegister(&xRendererGLibType, libs, "pango_renderer_get_type")
 
-	core.PuregoSafeRegister(&xRendererActivate, libs, "pango_renderer_activate")
-	core.PuregoSafeRegister(&xRendererDeactivate, libs, "pango_renderer_deactivate")
-	core.PuregoSafeRegister(&xRendererDrawErrorUnderline, libs, "pango_renderer_draw_error_underline")
-	core.PuregoSafeRegister(&xRendererDrawGlyph, libs, "pango_renderer_draw_glyph")
-	core.PuregoSafeRegister(&xRendererDrawGlyphItem, libs, "pango_renderer_draw_glyph_item")
-	core.PuregoSafeRegister(&xRendererDrawGlyphs, libs, "pango_renderer_draw_glyphs")
-	core.PuregoSafeRegister(&xRendererDrawLayout, libs, "pango_renderer_draw_layout")
-	core.PuregoSafeRegister(&xRendererDrawLayoutLine, libs, "pango_renderer_draw_layout_line")
-	core.PuregoSafeRegister(&xRendererDrawRectangle, libs, "pango_renderer_draw_rectangle")
-	core.PuregoSafeRegister(&xRendererDrawTrapezoid, libs, "pango_renderer_draw_trapezoid")
-	core.PuregoSafeRegister(&xRendererGetAlpha, libs, "pango_renderer_get_alpha")
-	core.PuregoSafeRegister(&xRendererGetColor, libs, "pango_renderer_get_color")
-	core.PuregoSafeRegister(&xRendererGetLayout, libs, "pango_renderer_get_layout")
-	core.PuregoSafeRegister(&xRendererGetLayoutLine, libs, "pango_renderer_get_layout_line")
-	core.PuregoSafeRegister(&xRendererGetMatrix, libs, "pango_renderer_get_matrix")
-	core.PuregoSafeRegister(&xRendererPartChanged, libs, "pango_renderer_part_changed")
-	core.PuregoSafeRegister(&xRendererSetAlpha, libs, "pango_renderer_set_alpha")
-	core.PuregoSafeRegister(&xRendererSetColor, libs, "pango_renderer_set_color")
-	core.PuregoSafeRegister(&xRendererSetMatrix, libs, "pango_renderer_set_matrix")
+	gobject.CheckClassSize("PangoRendererClass", xRendererGLibType, unsafe.Sizeof(RendererClass{}))
+
+	core.PuregoRegisterSince(&xRendererActivate, libs, "pango_renderer_activate", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDeactivate, libs, "pango_renderer_deactivate", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawErrorUnderline, libs, "pango_renderer_draw_error_underline", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawGlyph, libs, "pango_renderer_draw_glyph", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawGlyphItem, libs, "pango_renderer_draw_glyph_item", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xRendererDrawGlyphs, libs, "pango_renderer_draw_glyphs", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawLayout, libs, "pango_renderer_draw_layout", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawLayoutLine, libs, "pango_renderer_draw_layout_line", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawRectangle, libs, "pango_renderer_draw_rectangle", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererDrawTrapezoid, libs, "pango_renderer_draw_trapezoid", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererGetAlpha, libs, "pango_renderer_get_alpha", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xRendererGetColor, libs, "pango_renderer_get_color", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererGetLayout, libs, "pango_renderer_get_layout", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xRendererGetLayoutLine, libs, "pango_renderer_get_layout_line", "PANGO", "1.20")
+	core.PuregoRegisterSince(&xRendererGetMatrix, libs, "pango_renderer_get_matrix", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererPartChanged, libs, "pango_renderer_part_changed", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererSetAlpha, libs, "pango_renderer_set_alpha", "PANGO", "1.38")
+	core.PuregoRegisterSince(&xRendererSetColor, libs, "pango_renderer_set_color", "PANGO", "1.8")
+	core.PuregoRegisterSince(&xRendererSetMatrix, libs, "pango_renderer_set_matrix", "PANGO", "1.8")
 
 }
@@ -2,11 +2,12 @@
 package pango
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -26,14 +27,24 @@ func (x *ScriptIter) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the ScriptIter made with g_boxed_copy, release it with Free.
+func (x *ScriptIter) Copy() *ScriptIter {
+	cret := gobject.BoxedCopy(ScriptIterGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*ScriptIter)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
 var xNewScriptIter func(string, int) *ScriptIter
 
 // Create a new `PangoScriptIter`, used to break a string of
 // Unicode text into runs by Unicode script.
 //
-// No copy is made of @text, so the caller needs to make
+// No copy is made of text, so the caller needs to make
 // sure it remains valid until the iterator is freed with
-// [method@Pango.ScriptIter.free].
+// [ScriptIter.Free].
 func NewScriptIter(TextVar string, LengthVar int) *ScriptIter {
 
 	cret := xNewScriptIter(TextVar, LengthVar)
@@ -51,12 +62,12 @@ func (x *ScriptIter) Free() {
 
 var xScriptIterGetRange func(uintptr, *string, *string, *Script)
 
-// Gets information about the range to which @iter currently points.
+// Gets information about the range to which iter currently points.
 //
-// The range is the set of locations p where *start &lt;= p &lt; *end.
+// The range is the set of locations p where *start <= p < *end.
 // (That is, it doesn't include the character stored at *end)
 //
-// Note that while the type of the @script argument is declared
+// Note that while the type of the script argument is declared
 // as `PangoScript`, as of Pango 1.18, this function simply returns
 // `GUnicodeScript` values. Callers must be prepared to handle unknown
 // values.
@@ -70,8 +81,8 @@ var xScriptIterNext func(uintptr) bool
 
 // Advances a `PangoScriptIter` to the next range.
 //
-// If @iter is already at the end, it is left unchanged
-// and %FALSE is returned.
+// If iter is already at the end, it is left unchanged
+// and false is returned.
 func (x *ScriptIter) Next() bool {
 
 	cret := xScriptIterNext(x.GoPointer())
@@ -82,12 +93,14 @@ func (x *ScriptIter) Next() bool {
 // systems.
 //
 // The values correspond to the names as defined in the Unicode standard. See
-// [Unicode Standard Annex 24: Script names](http://www.unicode.org/reports/tr24/)
+// [Unicode Standard Annex 24: Script names]
 //
 // Note that this enumeration is deprecated and will not be updated to include values
 // in newer versions of the Unicode standard. Applications should use the
-// [enum@GLib.UnicodeScript] enumeration instead,
+// [glib.UnicodeScript] enumeration instead,
 // whose values are interchangeable with `PangoScript`.
+//
+// [Unicode Standard Annex 24: Script names]: http://www.unicode.org/reports/tr24/
 type Script int
 
 var xScriptGLibType func() types.GType
@@ -98,7 +111,7 @@ func ScriptGLibType() types.GType {
 
 const (
 
-	// a value never returned from pango_script_for_unichar()
+	// a value never returned from [ScriptForUnichar]
 	ScriptInvalidCodeValue Script = -1
 	// a character used by multiple different scripts
 	ScriptCommonValue Script = 0
@@ -337,38 +350,747 @@ const (
 	ScriptSignwritingValue Script = 116
 )
 
-var xScriptForUnichar func(uint32) Script
+// String returns the C name of the value, e.g. for debugging output
+func (x Script) String() string {
+	switch x {
+	case ScriptInvalidCodeValue:
+		return "PANGO_SCRIPT_INVALID_CODE"
+	case ScriptCommonValue:
+		return "PANGO_SCRIPT_COMMON"
+	case ScriptInheritedValue:
+		return "PANGO_SCRIPT_INHERITED"
+	case ScriptArabicValue:
+		return "PANGO_SCRIPT_ARABIC"
+	case ScriptArmenianValue:
+		return "PANGO_SCRIPT_ARMENIAN"
+	case ScriptBengaliValue:
+		return "PANGO_SCRIPT_BENGALI"
+	case ScriptBopomofoValue:
+		return "PANGO_SCRIPT_BOPOMOFO"
+	case ScriptCherokeeValue:
+		return "PANGO_SCRIPT_CHEROKEE"
+	case ScriptCopticValue:
+		return "PANGO_SCRIPT_COPTIC"
+	case ScriptCyrillicValue:
+		return "PANGO_SCRIPT_CYRILLIC"
+	case ScriptDeseretValue:
+		return "PANGO_SCRIPT_DESERET"
+	case ScriptDevanagariValue:
+		return "PANGO_SCRIPT_DEVANAGARI"
+	case ScriptEthiopicValue:
+		return "PANGO_SCRIPT_ETHIOPIC"
+	case ScriptGeorgianValue:
+		return "PANGO_SCRIPT_GEORGIAN"
+	case ScriptGothicValue:
+		return "PANGO_SCRIPT_GOTHIC"
+	case ScriptGreekValue:
+		return "PANGO_SCRIPT_GREEK"
+	case ScriptGujaratiValue:
+		return "PANGO_SCRIPT_GUJARATI"
+	case ScriptGurmukhiValue:
+		return "PANGO_SCRIPT_GURMUKHI"
+	case ScriptHanValue:
+		return "PANGO_SCRIPT_HAN"
+	case ScriptHangulValue:
+		return "PANGO_SCRIPT_HANGUL"
+	case ScriptHebrewValue:
+		return "PANGO_SCRIPT_HEBREW"
+	case ScriptHiraganaValue:
+		return "PANGO_SCRIPT_HIRAGANA"
+	case ScriptKannadaValue:
+		return "PANGO_SCRIPT_KANNADA"
+	case ScriptKatakanaValue:
+		return "PANGO_SCRIPT_KATAKANA"
+	case ScriptKhmerValue:
+		return "PANGO_SCRIPT_KHMER"
+	case ScriptLaoValue:
+		return "PANGO_SCRIPT_LAO"
+	case ScriptLatinValue:
+		return "PANGO_SCRIPT_LATIN"
+	case ScriptMalayalamValue:
+		return "PANGO_SCRIPT_MALAYALAM"
+	case ScriptMongolianValue:
+		return "PANGO_SCRIPT_MONGOLIAN"
+	case ScriptMyanmarValue:
+		return "PANGO_SCRIPT_MYANMAR"
+	case ScriptOghamValue:
+		return "PANGO_SCRIPT_OGHAM"
+	case ScriptOldItalicValue:
+		return "PANGO_SCRIPT_OLD_ITALIC"
+	case ScriptOriyaValue:
+		return "PANGO_SCRIPT_ORIYA"
+	case ScriptRunicValue:
+		return "PANGO_SCRIPT_RUNIC"
+	case ScriptSinhalaValue:
+		return "PANGO_SCRIPT_SINHALA"
+	case ScriptSyriacValue:
+		return "PANGO_SCRIPT_SYRIAC"
+	case ScriptTamilValue:
+		return "PANGO_SCRIPT_TAMIL"
+	case ScriptTeluguValue:
+		return "PANGO_SCRIPT_TELUGU"
+	case ScriptThaanaValue:
+		return "PANGO_SCRIPT_THAANA"
+	case ScriptThaiValue:
+		return "PANGO_SCRIPT_THAI"
+	case ScriptTibetanValue:
+		return "PANGO_SCRIPT_TIBETAN"
+	case ScriptCanadianAboriginalValue:
+		return "PANGO_SCRIPT_CANADIAN_ABORIGINAL"
+	case ScriptYiValue:
+		return "PANGO_SCRIPT_YI"
+	case ScriptTagalogValue:
+		return "PANGO_SCRIPT_TAGALOG"
+	case ScriptHanunooValue:
+		return "PANGO_SCRIPT_HANUNOO"
+	case ScriptBuhidValue:
+		return "PANGO_SCRIPT_BUHID"
+	case ScriptTagbanwaValue:
+		return "PANGO_SCRIPT_TAGBANWA"
+	case ScriptBrailleValue:
+		return "PANGO_SCRIPT_BRAILLE"
+	case ScriptCypriotValue:
+		return "PANGO_SCRIPT_CYPRIOT"
+	case ScriptLimbuValue:
+		return "PANGO_SCRIPT_LIMBU"
+	case ScriptOsmanyaValue:
+		return "PANGO_SCRIPT_OSMANYA"
+	case ScriptShavianValue:
+		return "PANGO_SCRIPT_SHAVIAN"
+	case ScriptLinearBValue:
+		return "PANGO_SCRIPT_LINEAR_B"
+	case ScriptTaiLeValue:
+		return "PANGO_SCRIPT_TAI_LE"
+	case ScriptUgariticValue:
+		return "PANGO_SCRIPT_UGARITIC"
+	case ScriptNewTaiLueValue:
+		return "PANGO_SCRIPT_NEW_TAI_LUE"
+	case ScriptBugineseValue:
+		return "PANGO_SCRIPT_BUGINESE"
+	case ScriptGlagoliticValue:
+		return "PANGO_SCRIPT_GLAGOLITIC"
+	case ScriptTifinaghValue:
+		return "PANGO_SCRIPT_TIFINAGH"
+	case ScriptSylotiNagriValue:
+		return "PANGO_SCRIPT_SYLOTI_NAGRI"
+	case ScriptOldPersianValue:
+		return "PANGO_SCRIPT_OLD_PERSIAN"
+	case ScriptKharoshthiValue:
+		return "PANGO_SCRIPT_KHAROSHTHI"
+	case ScriptUnknownValue:
+		return "PANGO_SCRIPT_UNKNOWN"
+	case ScriptBalineseValue:
+		return "PANGO_SCRIPT_BALINESE"
+	case ScriptCuneiformValue:
+		return "PANGO_SCRIPT_CUNEIFORM"
+	case ScriptPhoenicianValue:
+		return "PANGO_SCRIPT_PHOENICIAN"
+	case ScriptPhagsPaValue:
+		return "PANGO_SCRIPT_PHAGS_PA"
+	case ScriptNkoValue:
+		return "PANGO_SCRIPT_NKO"
+	case ScriptKayahLiValue:
+		return "PANGO_SCRIPT_KAYAH_LI"
+	case ScriptLepchaValue:
+		return "PANGO_SCRIPT_LEPCHA"
+	case ScriptRejangValue:
+		return "PANGO_SCRIPT_REJANG"
+	case ScriptSundaneseValue:
+		return "PANGO_SCRIPT_SUNDANESE"
+	case ScriptSaurashtraValue:
+		return "PANGO_SCRIPT_SAURASHTRA"
+	case ScriptChamValue:
+		return "PANGO_SCRIPT_CHAM"
+	case ScriptOlChikiValue:
+		return "PANGO_SCRIPT_OL_CHIKI"
+	case ScriptVaiValue:
+		return "PANGO_SCRIPT_VAI"
+	case ScriptCarianValue:
+		return "PANGO_SCRIPT_CARIAN"
+	case ScriptLycianValue:
+		return "PANGO_SCRIPT_LYCIAN"
+	case ScriptLydianValue:
+		return "PANGO_SCRIPT_LYDIAN"
+	case ScriptBatakValue:
+		return "PANGO_SCRIPT_BATAK"
+	case ScriptBrahmiValue:
+		return "PANGO_SCRIPT_BRAHMI"
+	case ScriptMandaicValue:
+		return "PANGO_SCRIPT_MANDAIC"
+	case ScriptChakmaValue:
+		return "PANGO_SCRIPT_CHAKMA"
+	case ScriptMeroiticCursiveValue:
+		return "PANGO_SCRIPT_MEROITIC_CURSIVE"
+	case ScriptMeroiticHieroglyphsValue:
+		return "PANGO_SCRIPT_MEROITIC_HIEROGLYPHS"
+	case ScriptMiaoValue:
+		return "PANGO_SCRIPT_MIAO"
+	case ScriptSharadaValue:
+		return "PANGO_SCRIPT_SHARADA"
+	case ScriptSoraSompengValue:
+		return "PANGO_SCRIPT_SORA_SOMPENG"
+	case ScriptTakriValue:
+		return "PANGO_SCRIPT_TAKRI"
+	case ScriptBassaVahValue:
+		return "PANGO_SCRIPT_BASSA_VAH"
+	case ScriptCaucasianAlbanianValue:
+		return "PANGO_SCRIPT_CAUCASIAN_ALBANIAN"
+	case ScriptDuployanValue:
+		return "PANGO_SCRIPT_DUPLOYAN"
+	case ScriptElbasanValue:
+		return "PANGO_SCRIPT_ELBASAN"
+	case ScriptGranthaValue:
+		return "PANGO_SCRIPT_GRANTHA"
+	case ScriptKhojkiValue:
+		return "PANGO_SCRIPT_KHOJKI"
+	case ScriptKhudawadiValue:
+		return "PANGO_SCRIPT_KHUDAWADI"
+	case ScriptLinearAValue:
+		return "PANGO_SCRIPT_LINEAR_A"
+	case ScriptMahajaniValue:
+		return "PANGO_SCRIPT_MAHAJANI"
+	case ScriptManichaeanValue:
+		return "PANGO_SCRIPT_MANICHAEAN"
+	case ScriptMendeKikakuiValue:
+		return "PANGO_SCRIPT_MENDE_KIKAKUI"
+	case ScriptModiValue:
+		return "PANGO_SCRIPT_MODI"
+	case ScriptMroValue:
+		return "PANGO_SCRIPT_MRO"
+	case ScriptNabataeanValue:
+		return "PANGO_SCRIPT_NABATAEAN"
+	case ScriptOldNorthArabianValue:
+		return "PANGO_SCRIPT_OLD_NORTH_ARABIAN"
+	case ScriptOldPermicValue:
+		return "PANGO_SCRIPT_OLD_PERMIC"
+	case ScriptPahawhHmongValue:
+		return "PANGO_SCRIPT_PAHAWH_HMONG"
+	case ScriptPalmyreneValue:
+		return "PANGO_SCRIPT_PALMYRENE"
+	case ScriptPauCinHauValue:
+		return "PANGO_SCRIPT_PAU_CIN_HAU"
+	case ScriptPsalterPahlaviValue:
+		return "PANGO_SCRIPT_PSALTER_PAHLAVI"
+	case ScriptSiddhamValue:
+		return "PANGO_SCRIPT_SIDDHAM"
+	case ScriptTirhutaValue:
+		return "PANGO_SCRIPT_TIRHUTA"
+	case ScriptWarangCitiValue:
+		return "PANGO_SCRIPT_WARANG_CITI"
+	case ScriptAhomValue:
+		return "PANGO_SCRIPT_AHOM"
+	case ScriptAnatolianHieroglyphsValue:
+		return "PANGO_SCRIPT_ANATOLIAN_HIEROGLYPHS"
+	case ScriptHatranValue:
+		return "PANGO_SCRIPT_HATRAN"
+	case ScriptMultaniValue:
+		return "PANGO_SCRIPT_MULTANI"
+	case ScriptOldHungarianValue:
+		return "PANGO_SCRIPT_OLD_HUNGARIAN"
+	case ScriptSignwritingValue:
+		return "PANGO_SCRIPT_SIGNWRITING"
+	}
+	return fmt.Sprintf("Script(%d)", int(x))
+}
 
-// Looks up the script for a particular character.
-//
-// The script of a character is defined by
-// [Unicode Standard Annex 24: Script names](http://www.unicode.org/reports/tr24/).
-//
-// No check is made for @ch being a valid Unicode character; if you pass
-// in invalid character, the result is undefined.
-//
-// Note that while the return type of this function is declared
-// as `PangoScript`, as of Pango 1.18, this function simply returns
-// the return value of [func@GLib.unichar_get_script]. Callers must be
-// prepared to handle unknown values.
-func ScriptForUnichar(ChVar uint32) Script {
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x Script) Nick() string {
+	switch x {
+	case ScriptInvalidCodeValue:
+		return "invalid-code"
+	case ScriptCommonValue:
+		return "common"
+	case ScriptInheritedValue:
+		return "inherited"
+	case ScriptArabicValue:
+		return "arabic"
+	case ScriptArmenianValue:
+		return "armenian"
+	case ScriptBengaliValue:
+		return "bengali"
+	case ScriptBopomofoValue:
+		return "bopomofo"
+	case ScriptCherokeeValue:
+		return "cherokee"
+	case ScriptCopticValue:
+		return "coptic"
+	case ScriptCyrillicValue:
+		return "cyrillic"
+	case ScriptDeseretValue:
+		return "deseret"
+	case ScriptDevanagariValue:
+		return "devanagari"
+	case ScriptEthiopicValue:
+		return "ethiopic"
+	case ScriptGeorgianValue:
+		return "georgian"
+	case ScriptGothicValue:
+		return "gothic"
+	case ScriptGreekValue:
+		return "greek"
+	case ScriptGujaratiValue:
+		return "gujarati"
+	case ScriptGurmukhiValue:
+		return "gurmukhi"
+	case ScriptHanValue:
+		return "han"
+	case ScriptHangulValue:
+		return "hangul"
+	case ScriptHebrewValue:
+		return "hebrew"
+	case ScriptHiraganaValue:
+		return "hiragana"
+	case ScriptKannadaValue:
+		return "kannada"
+	case ScriptKatakanaValue:
+		return "katakana"
+	case ScriptKhmerValue:
+		return "khmer"
+	case ScriptLaoValue:
+		return "lao"
+	case ScriptLatinValue:
+		return "latin"
+	case ScriptMalayalamValue:
+		return "malayalam"
+	case ScriptMongolianValue:
+		return "mongolian"
+	case ScriptMyanmarValue:
+		return "myanmar"
+	case ScriptOghamValue:
+		return "ogham"
+	case ScriptOldItalicValue:
+		return "old-italic"
+	case ScriptOriyaValue:
+		return "oriya"
+	case ScriptRunicValue:
+		return "runic"
+	case ScriptSinhalaValue:
+		return "sinhala"
+	case ScriptSyriacValue:
+		return "syriac"
+	case ScriptTamilValue:
+		return "tamil"
+	case ScriptTeluguValue:
+		return "telugu"
+	case ScriptThaanaValue:
+		return "thaana"
+	case ScriptThaiValue:
+		return "thai"
+	case ScriptTibetanValue:
+		return "tibetan"
+	case ScriptCanadianAboriginalValue:
+		return "canadian-aboriginal"
+	case ScriptYiValue:
+		return "yi"
+	case ScriptTagalogValue:
+		return "tagalog"
+	case ScriptHanunooValue:
+		return "hanunoo"
+	case ScriptBuhidValue:
+		return "buhid"
+	case ScriptTagbanwaValue:
+		return "tagbanwa"
+	case ScriptBrailleValue:
+		return "braille"
+	case ScriptCypriotValue:
+		return "cypriot"
+	case ScriptLimbuValue:
+		return "limbu"
+	case ScriptOsmanyaValue:
+		return "osmanya"
+	case ScriptShavianValue:
+		return "shavian"
+	case ScriptLinearBValue:
+		return "linear-b"
+	case ScriptTaiLeValue:
+		return "tai-le"
+	case ScriptUgariticValue:
+		return "ugaritic"
+	case ScriptNewTaiLueValue:
+		return "new-tai-lue"
+	case ScriptBugineseValue:
+		return "buginese"
+	case ScriptGlagoliticValue:
+		return "glagolitic"
+	case ScriptTifinaghValue:
+		return "tifinagh"
+	case ScriptSylotiNagriValue:
+		return "syloti-nagri"
+	case ScriptOldPersianValue:
+		return "old-persian"
+	case ScriptKharoshthiValue:
+		return "kharoshthi"
+	case ScriptUnknownValue:
+		return "unknown"
+	case ScriptBalineseValue:
+		return "balinese"
+	case ScriptCuneiformValue:
+		return "cuneiform"
+	case ScriptPhoenicianValue:
+		return "phoenician"
+	case ScriptPhagsPaValue:
+		return "phags-pa"
+	case ScriptNkoValue:
+		return "nko"
+	case ScriptKayahLiValue:
+		return "kayah-li"
+	case ScriptLepchaValue:
+		return "lepcha"
+	case ScriptRejangValue:
+		return "rejang"
+	case ScriptSundaneseValue:
+		return "sundanese"
+	case ScriptSaurashtraValue:
+		return "saurashtra"
+	case ScriptChamValue:
+		return "cham"
+	case ScriptOlChikiValue:
+		return "ol-chiki"
+	case ScriptVaiValue:
+		return "vai"
+	case ScriptCarianValue:
+		return "carian"
+	case ScriptLycianValue:
+		return "lycian"
+	case ScriptLydianValue:
+		return "lydian"
+	case ScriptBatakValue:
+		return "batak"
+	case ScriptBrahmiValue:
+		return "brahmi"
+	case ScriptMandaicValue:
+		return "mandaic"
+	case ScriptChakmaValue:
+		return "chakma"
+	case ScriptMeroiticCursiveValue:
+		return "meroitic-cursive"
+	case ScriptMeroiticHieroglyphsValue:
+		return "meroitic-hieroglyphs"
+	case ScriptMiaoValue:
+		return "miao"
+	case ScriptSharadaValue:
+		return "sharada"
+	case ScriptSoraSompengValue:
+		return "sora-sompeng"
+	case ScriptTakriValue:
+		return "takri"
+	case ScriptBassaVahValue:
+		return "bassa-vah"
+	case ScriptCaucasianAlbanianValue:
+		return "caucasian-albanian"
+	case ScriptDuployanValue:
+		return "duployan"
+	case ScriptElbasanValue:
+		return "elbasan"
+	case ScriptGranthaValue:
+		return "grantha"
+	case ScriptKhojkiValue:
+		return "khojki"
+	case ScriptKhudawadiValue:
+		return "khudawadi"
+	case ScriptLinearAValue:
+		return "linear-a"
+	case ScriptMahajaniValue:
+		return "mahajani"
+	case ScriptManichaeanValue:
+		return "manichaean"
+	case ScriptMendeKikakuiValue:
+		return "mende-kikakui"
+	case ScriptModiValue:
+		return "modi"
+	case ScriptMroValue:
+		return "mro"
+	case ScriptNabataeanValue:
+		return "nabataean"
+	case ScriptOldNorthArabianValue:
+		return "old-north-arabian"
+	case ScriptOldPermicValue:
+		return "old-permic"
+	case ScriptPahawhHmongValue:
+		return "pahawh-hmong"
+	case ScriptPalmyreneValue:
+		return "palmyrene"
+	case ScriptPauCinHauValue:
+		return "pau-cin-hau"
+	case ScriptPsalterPahlaviValue:
+		return "psalter-pahlavi"
+	case ScriptSiddhamValue:
+		return "siddham"
+	case ScriptTirhutaValue:
+		return "tirhuta"
+	case ScriptWarangCitiValue:
+		return "warang-citi"
+	case ScriptAhomValue:
+		return "ahom"
+	case ScriptAnatolianHieroglyphsValue:
+		return "anatolian-hieroglyphs"
+	case ScriptHatranValue:
+		return "hatran"
+	case ScriptMultaniValue:
+		return "multani"
+	case ScriptOldHungarianValue:
+		return "old-hungarian"
+	case ScriptSignwritingValue:
+		return "signwriting"
+	}
+	return ""
+}
 
-	cret := xScriptForUnichar(ChVar)
-	return cret
+// ScriptFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func ScriptFromString(s string) (Script, bool) {
+	switch s {
+	case "PANGO_SCRIPT_INVALID_CODE", "invalid-code":
+		return ScriptInvalidCodeValue, true
+	case "PANGO_SCRIPT_COMMON", "common":
+		return ScriptCommonValue, true
+	case "PANGO_SCRIPT_INHERITED", "inherited":
+		return ScriptInheritedValue, true
+	case "PANGO_SCRIPT_ARABIC", "arabic":
+		return ScriptArabicValue, true
+	case "PANGO_SCRIPT_ARMENIAN", "armenian":
+		return ScriptArmenianValue, true
+	case "PANGO_SCRIPT_BENGALI", "bengali":
+		return ScriptBengaliValue, true
+	case "PANGO_SCRIPT_BOPOMOFO", "bopomofo":
+		return ScriptBopomofoValue, true
+	case "PANGO_SCRIPT_CHEROKEE", "cherokee":
+		return ScriptCherokeeValue, true
+	case "PANGO_SCRIPT_COPTIC", "coptic":
+		return ScriptCopticValue, true
+	case "PANGO_SCRIPT_CYRILLIC", "cyrillic":
+		return ScriptCyrillicValue, true
+	case "PANGO_SCRIPT_DESERET", "deseret":
+		return ScriptDeseretValue, true
+	case "PANGO_SCRIPT_DEVANAGARI", "devanagari":
+		return ScriptDevanagariValue, true
+	case "PANGO_SCRIPT_ETHIOPIC", "ethiopic":
+		return ScriptEthiopicValue, true
+	case "PANGO_SCRIPT_GEORGIAN", "georgian":
+		return ScriptGeorgianValue, true
+	case "PANGO_SCRIPT_GOTHIC", "gothic":
+		return ScriptGothicValue, true
+	case "PANGO_SCRIPT_GREEK", "greek":
+		return ScriptGreekValue, true
+	case "PANGO_SCRIPT_GUJARATI", "gujarati":
+		return ScriptGujaratiValue, true
+	case "PANGO_SCRIPT_GURMUKHI", "gurmukhi":
+		return ScriptGurmukhiValue, true
+	case "PANGO_SCRIPT_HAN", "han":
+		return ScriptHanValue, true
+	case "PANGO_SCRIPT_HANGUL", "hangul":
+		return ScriptHangulValue, true
+	case "PANGO_SCRIPT_HEBREW", "hebrew":
+		return ScriptHebrewValue, true
+	case "PANGO_SCRIPT_HIRAGANA", "hiragana":
+		return ScriptHiraganaValue, true
+	case "PANGO_SCRIPT_KANNADA", "kannada":
+		return ScriptKannadaValue, true
+	case "PANGO_SCRIPT_KATAKANA", "katakana":
+		return ScriptKatakanaValue, true
+	case "PANGO_SCRIPT_KHMER", "khmer":
+		return ScriptKhmerValue, true
+	case "PANGO_SCRIPT_LAO", "lao":
+		return ScriptLaoValue, true
+	case "PANGO_SCRIPT_LATIN", "latin":
+		return ScriptLatinValue, true
+	case "PANGO_SCRIPT_MALAYALAM", "malayalam":
+		return ScriptMalayalamValue, true
+	case "PANGO_SCRIPT_MONGOLIAN", "mongolian":
+		return ScriptMongolianValue, true
+	case "PANGO_SCRIPT_MYANMAR", "myanmar":
+		return ScriptMyanmarValue, true
+	case "PANGO_SCRIPT_OGHAM", "ogham":
+		return ScriptOghamValue, true
+	case "PANGO_SCRIPT_OLD_ITALIC", "old-italic":
+		return ScriptOldItalicValue, true
+	case "PANGO_SCRIPT_ORIYA", "oriya":
+		return ScriptOriyaValue, true
+	case "PANGO_SCRIPT_RUNIC", "runic":
+		return ScriptRunicValue, true
+	case "PANGO_SCRIPT_SINHALA", "sinhala":
+		return ScriptSinhalaValue, true
+	case "PANGO_SCRIPT_SYRIAC", "syriac":
+		return ScriptSyriacValue, true
+	case "PANGO_SCRIPT_TAMIL", "tamil":
+		return ScriptTamilValue, true
+	case "PANGO_SCRIPT_TELUGU", "telugu":
+		return ScriptTeluguValue, true
+	case "PANGO_SCRIPT_THAANA", "thaana":
+		return ScriptThaanaValue, true
+	case "PANGO_SCRIPT_THAI", "thai":
+		return ScriptThaiValue, true
+	case "PANGO_SCRIPT_TIBETAN", "tibetan":
+		return ScriptTibetanValue, true
+	case "PANGO_SCRIPT_CANADIAN_ABORIGINAL", "canadian-aboriginal":
+		return ScriptCanadianAboriginalValue, true
+	case "PANGO_SCRIPT_YI", "yi":
+		return ScriptYiValue, true
+	case "PANGO_SCRIPT_TAGALOG", "tagalog":
+		return ScriptTagalogValue, true
+	case "PANGO_SCRIPT_HANUNOO", "hanunoo":
+		return ScriptHanunooValue, true
+	case "PANGO_SCRIPT_BUHID", "buhid":
+		return ScriptBuhidValue, true
+	case "PANGO_SCRIPT_TAGBANWA", "tagbanwa":
+		return ScriptTagbanwaValue, true
+	case "PANGO_SCRIPT_BRAILLE", "braille":
+		return ScriptBrailleValue, true
+	case "PANGO_SCRIPT_CYPRIOT", "cypriot":
+		return ScriptCypriotValue, true
+	case "PANGO_SCRIPT_LIMBU", "limbu":
+		return ScriptLimbuValue, true
+	case "PANGO_SCRIPT_OSMANYA", "osmanya":
+		return ScriptOsmanyaValue, true
+	case "PANGO_SCRIPT_SHAVIAN", "shavian":
+		return ScriptShavianValue, true
+	case "PANGO_SCRIPT_LINEAR_B", "linear-b":
+		return ScriptLinearBValue, true
+	case "PANGO_SCRIPT_TAI_LE", "tai-le":
+		return ScriptTaiLeValue, true
+	case "PANGO_SCRIPT_UGARITIC", "ugaritic":
+		return ScriptUgariticValue, true
+	case "PANGO_SCRIPT_NEW_TAI_LUE", "new-tai-lue":
+		return ScriptNewTaiLueValue, true
+	case "PANGO_SCRIPT_BUGINESE", "buginese":
+		return ScriptBugineseValue, true
+	case "PANGO_SCRIPT_GLAGOLITIC", "glagolitic":
+		return ScriptGlagoliticValue, true
+	case "PANGO_SCRIPT_TIFINAGH", "tifinagh":
+		return ScriptTifinaghValue, true
+	case "PANGO_SCRIPT_SYLOTI_NAGRI", "syloti-nagri":
+		return ScriptSylotiNagriValue, true
+	case "PANGO_SCRIPT_OLD_PERSIAN", "old-persian":
+		return ScriptOldPersianValue, true
+	case "PANGO_SCRIPT_KHAROSHTHI", "kharoshthi":
+		return ScriptKharoshthiValue, true
+	case "PANGO_SCRIPT_UNKNOWN", "unknown":
+		return ScriptUnknownValue, true
+	case "PANGO_SCRIPT_BALINESE", "balinese":
+		return ScriptBalineseValue, true
+	case "PANGO_SCRIPT_CUNEIFORM", "cuneiform":
+		return ScriptCuneiformValue, true
+	case "PANGO_SCRIPT_PHOENICIAN", "phoenician":
+		return ScriptPhoenicianValue, true
+	case "PANGO_SCRIPT_PHAGS_PA", "phags-pa":
+		return ScriptPhagsPaValue, true
+	case "PANGO_SCRIPT_NKO", "nko":
+		return ScriptNkoValue, true
+	case "PANGO_SCRIPT_KAYAH_LI", "kayah-li":
+		return ScriptKayahLiValue, true
+	case "PANGO_SCRIPT_LEPCHA", "lepcha":
+		return ScriptLepchaValue, true
+	case "PANGO_SCRIPT_REJANG", "rejang":
+		return ScriptRejangValue, true
+	case "PANGO_SCRIPT_SUNDANESE", "sundanese":
+		return ScriptSundaneseValue, true
+	case "PANGO_SCRIPT_SAURASHTRA", "saurashtra":
+		return ScriptSaurashtraValue, true
+	case "PANGO_SCRIPT_CHAM", "cham":
+		return ScriptChamValue, true
+	case "PANGO_SCRIPT_OL_CHIKI", "ol-chiki":
+		return ScriptOlChikiValue, true
+	case "PANGO_SCRIPT_VAI", "vai":
+		return ScriptVaiValue, true
+	case "PANGO_SCRIPT_CARIAN", "carian":
+		return ScriptCarianValue, true
+	case "PANGO_SCRIPT_LYCIAN", "lycian":
+		return ScriptLycianValue, true
+	case "PANGO_SCRIPT_LYDIAN", "lydian":
+		return ScriptLydianValue, true
+	case "PANGO_SCRIPT_BATAK", "batak":
+		return ScriptBatakValue, true
+	case "PANGO_SCRIPT_BRAHMI", "brahmi":
+		return ScriptBrahmiValue, true
+	case "PANGO_SCRIPT_MANDAIC", "mandaic":
+		return ScriptMandaicValue, true
+	case "PANGO_SCRIPT_CHAKMA", "chakma":
+		return ScriptChakmaValue, true
+	case "PANGO_SCRIPT_MEROITIC_CURSIVE", "meroitic-cursive":
+		return ScriptMeroiticCursiveValue, true
+	case "PANGO_SCRIPT_MEROITIC_HIEROGLYPHS", "meroitic-hieroglyphs":
+		return ScriptMeroiticHieroglyphsValue, true
+	case "PANGO_SCRIPT_MIAO", "miao":
+		return ScriptMiaoValue, true
+	case "PANGO_SCRIPT_SHARADA", "sharada":
+		return ScriptSharadaValue, true
+	case "PANGO_SCRIPT_SORA_SOMPENG", "sora-sompeng":
+		return ScriptSoraSompengValue, true
+	case "PANGO_SCRIPT_TAKRI", "takri":
+		return ScriptTakriValue, true
+	case "PANGO_SCRIPT_BASSA_VAH", "bassa-vah":
+		return ScriptBassaVahValue, true
+	case "PANGO_SCRIPT_CAUCASIAN_ALBANIAN", "caucasian-albanian":
+		return ScriptCaucasianAlbanianValue, true
+	case "PANGO_SCRIPT_DUPLOYAN", "duployan":
+		return ScriptDuployanValue, true
+	case "PANGO_SCRIPT_ELBASAN", "elbasan":
+		return ScriptElbasanValue, true
+	case "PANGO_SCRIPT_GRANTHA", "grantha":
+		return ScriptGranthaValue, true
+	case "PANGO_SCRIPT_KHOJKI", "khojki":
+		return ScriptKhojkiValue, true
+	case "PANGO_SCRIPT_KHUDAWADI", "khudawadi":
+		return ScriptKhudawadiValue, true
+	case "PANGO_SCRIPT_LINEAR_A", "linear-a":
+		return ScriptLinearAValue, true
+	case "PANGO_SCRIPT_MAHAJANI", "mahajani":
+		return ScriptMahajaniValue, true
+	case "PANGO_SCRIPT_MANICHAEAN", "manichaean":
+		return ScriptManichaeanValue, true
+	case "PANGO_SCRIPT_MENDE_KIKAKUI", "mende-kikakui":
+		return ScriptMendeKikakuiValue, true
+	case "PANGO_SCRIPT_MODI", "modi":
+		return ScriptModiValue, true
+	case "PANGO_SCRIPT_MRO", "mro":
+		return ScriptMroValue, true
+	case "PANGO_SCRIPT_NABATAEAN", "nabataean":
+		return ScriptNabataeanValue, true
+	case "PANGO_SCRIPT_OLD_NORTH_ARABIAN", "old-north-arabian":
+		return ScriptOldNorthArabianValue, true
+	case "PANGO_SCRIPT_OLD_PERMIC", "old-permic":
+		return ScriptOldPermicValue, true
+	case "PANGO_SCRIPT_PAHAWH_HMONG", "pahawh-hmong":
+		return ScriptPahawhHmongValue, true
+	case "PANGO_SCRIPT_PALMYRENE", "palmyrene":
+		return ScriptPalmyreneValue, true
+	case "PANGO_SCRIPT_PAU_CIN_HAU", "pau-cin-hau":
+		return ScriptPauCinHauValue, true
+	case "PANGO_SCRIPT_PSALTER_PAHLAVI", "psalter-pahlavi":
+		return ScriptPsalterPahlaviValue, true
+	case "PANGO_SCRIPT_SIDDHAM", "siddham":
+		return ScriptSiddhamValue, true
+	case "PANGO_SCRIPT_TIRHUTA", "tirhuta":
+		return ScriptTirhutaValue, true
+	case "PANGO_SCRIPT_WARANG_CITI", "warang-citi":
+		return ScriptWarangCitiValue, true
+	case "PANGO_SCRIPT_AHOM", "ahom":
+		return ScriptAhomValue, true
+	case "PANGO_SCRIPT_ANATOLIAN_HIEROGLYPHS", "anatolian-hieroglyphs":
+		return ScriptAnatolianHieroglyphsValue, true
+	case "PANGO_SCRIPT_HATRAN", "hatran":
+		return ScriptHatranValue, true
+	case "PANGO_SCRIPT_MULTANI", "multani":
+		return ScriptMultaniValue, true
+	case "PANGO_SCRIPT_OLD_HUNGARIAN", "old-hungarian":
+		return ScriptOldHungarianValue, true
+	case "PANGO_SCRIPT_SIGNWRITING", "signwriting":
+		return ScriptSignwritingValue, true
+	}
+	return 0, false
 }
 
 var xScriptGetSampleLanguage func(Script) *Language
 
-// Finds a language tag that is reasonably representative of @script.
+// Finds a language tag that is reasonably representative of script.
 //
 // The language will usually be the most widely spoken or used language
 // written in that script: for instance, the sample language for
-// %PANGO_SCRIPT_CYRILLIC is ru (Russian), the sample language for
-// %PANGO_SCRIPT_ARABIC is ar.
+// [ScriptCyrillicValue] is ru (Russian), the sample language for
+// [ScriptArabicValue] is ar.
 //
 // For some scripts, no sample language will be returned because
 // there is no language that is sufficiently representative. The
-// best example of this is %PANGO_SCRIPT_HAN, where various different
+// best example of this is [ScriptHanValue], where various different
 // variants of written Chinese, Japanese, and Korean all use
 // significantly different sets of Han characters and forms
 // of shared characters. No sample language can be provided
@@ -379,14 +1101,14 @@ var xScriptGetSampleLanguage func(Script) *Language
 // If one of them is set, it is parsed as a list of language tags
 // separated by colons or other separators. This function
 // will return the first language in the parsed list that Pango
-// believes may use @script for writing. This last predicate
-// is tested using [method@Pango.Language.includes_script]. This can
+// believes may use script for writing. This last predicate
+// is tested using [Language.IncludesScript]. This can
 // be used to control Pango's font selection for non-primary
 // languages. For example, a `PANGO_LANGUAGE` enviroment variable
 // set to "en:fa" makes Pango choose fonts suitable for Persian (fa)
 // instead of Arabic (ar) when a segment of Arabic text is found
 // in an otherwise non-Arabic text. The same trick can be used to
-// choose a default language for %PANGO_SCRIPT_HAN when setting
+// choose a default language for [ScriptHanValue] when setting
 // context language is not feasible.
 func ScriptGetSampleLanguage(ScriptVar Script) *Language {
 
@@ -399,7 +1121,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -408,15 +1130,14 @@ func init() {
 
 	core.PuregoSafeRegister(&xScriptGLibType, libs, "pango_script_get_type")
 
-	core.PuregoSafeRegister(&xScriptForUnichar, libs, "pango_script_for_unichar")
-	core.PuregoSafeRegister(&xScriptGetSampleLanguage, libs, "pango_script_get_sample_language")
+	core.PuregoRegisterSince(&xScriptGetSampleLanguage, libs, "pango_script_get_sample_language", "PANGO", "1.4")
 
 	core.PuregoSafeRegister(&xScriptIterGLibType, libs, "pango_script_iter_get_type")
 
-	core.PuregoSafeRegister(&xNewScriptIter, libs, "pango_script_iter_new")
+	core.PuregoRegisterSince(&xNewScriptIter, libs, "pango_script_iter_new", "PANGO", "1.4")
 
-	core.PuregoSafeRegister(&xScriptIterFree, libs, "pango_script_iter_free")
-	core.PuregoSafeRegister(&xScriptIterGetRange, libs, "pango_script_iter_get_range")
-	core.PuregoSafeRegister(&xScriptIterNext, libs, "pango_script_iter_next")
+	core.PuregoRegisterSince(&xScriptIterFree, libs, "pango_script_iter_free", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xScriptIterGetRange, libs, "pango_script_iter_get_range", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xScriptIterNext, libs, "pango_script_iter_next", "PANGO", "1.4")
 
 }
@@ -0,0 +1,48 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+)
+
+var xScriptForUnichar func(rune) Script
+
+// Looks up the script for a particular character.
+//
+// The script of a character is defined by
+// [Unicode Standard Annex 24: Script names].
+//
+// No check is made for ch being a valid Unicode character; if you pass
+// in invalid character, the result is undefined.
+//
+// Note that while the return type of this function is declared
+// as `PangoScript`, as of Pango 1.18, this function simply returns
+// the return value of [glib.UnicharGetScript]. Callers must be
+// prepared to handle unknown values.
+//
+// Deprecated: since 1.44.. Use [glib.UnicharGetScript]
+//
+// [Unicode Standard Annex 24: Script names]: http://www.unicode.org/reports/tr24/
+func ScriptForUnichar(ChVar rune) Script {
+
+	cret := xScriptForUnichar(ChVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xScriptForUnichar, libs, "pango_script_for_unichar", "PANGO", "1.4")
+
+}
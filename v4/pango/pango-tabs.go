@@ -2,10 +2,10 @@
 package pango
 
 import (
+	"fmt"
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
@@ -31,9 +31,9 @@ func (x *TabArray) GoPointer() uintptr {
 
 var xNewTabArray func(int, bool) *TabArray
 
-// Creates an array of @initial_size tab stops.
+// Creates an array of initial_size tab stops.
 //
-// Tab stops are specified in pixel units if @positions_in_pixels is %TRUE,
+// Tab stops are specified in pixel units if positions_in_pixels is true,
 // otherwise in Pango units. All stops are initially at position 0.
 func NewTabArray(InitialSizeVar int, PositionsInPixelsVar bool) *TabArray {
 
@@ -46,7 +46,7 @@ var xNewTabArrayWithPositions func(int, bool, TabAlign, int, ...interface{}) *Ta
 // Creates a `PangoTabArray` and allows you to specify the alignment
 // and position of each tab stop.
 //
-// You **must** provide an alignment and position for @size tab stops.
+// You **must** provide an alignment and position for size tab stops.
 func NewTabArrayWithPositions(SizeVar int, PositionsInPixelsVar bool, FirstAlignmentVar TabAlign, FirstPositionVar int, varArgs ...interface{}) *TabArray {
 
 	cret := xNewTabArrayWithPositions(SizeVar, PositionsInPixelsVar, FirstAlignmentVar, FirstPositionVar, varArgs...)
@@ -71,17 +71,17 @@ func (x *TabArray) Free() {
 
 }
 
-var xTabArrayGetDecimalPoint func(uintptr, int) uint32
+var xTabArrayGetDecimalPoint func(uintptr, int) rune
 
 // Gets the Unicode character to use as decimal point.
 //
-// This is only relevant for tabs with %PANGO_TAB_DECIMAL alignment,
+// This is only relevant for tabs with [TabDecimalValue] alignment,
 // which align content at the first occurrence of the decimal point
 // character.
 //
 // The default value of 0 means that Pango will use the
 // decimal point according to the current locale.
-func (x *TabArray) GetDecimalPoint(TabIndexVar int) uint32 {
+func (x *TabArray) GetDecimalPoint(TabIndexVar int) rune {
 
 	cret := xTabArrayGetDecimalPoint(x.GoPointer(), TabIndexVar)
 	return cret
@@ -89,8 +89,8 @@ func (x *TabArray) GetDecimalPoint(TabIndexVar int) uint32 {
 
 var xTabArrayGetPositionsInPixels func(uintptr) bool
 
-// Returns %TRUE if the tab positions are in pixels,
-// %FALSE if they are in Pango units.
+// Returns true if the tab positions are in pixels,
+// false if they are in Pango units.
 func (x *TabArray) GetPositionsInPixels() bool {
 
 	cret := xTabArrayGetPositionsInPixels(x.GoPointer())
@@ -99,7 +99,7 @@ func (x *TabArray) GetPositionsInPixels() bool {
 
 var xTabArrayGetSize func(uintptr) int
 
-// Gets the number of tab stops in @tab_array.
+// Gets the number of tab stops in tab_array.
 func (x *TabArray) GetSize() int {
 
 	cret := xTabArrayGetSize(x.GoPointer())
@@ -117,10 +117,10 @@ func (x *TabArray) GetTab(TabIndexVar int, AlignmentVar *TabAlign, LocationVar *
 
 var xTabArrayGetTabs func(uintptr, **TabAlign, *[]int)
 
-// If non-%NULL, @alignments and @locations are filled with allocated
+// If non-nil, alignments and locations are filled with allocated
 // arrays.
 //
-// The arrays are of length [method@Pango.TabArray.get_size].
+// The arrays are of length [TabArray.GetSize].
 // You must free the returned array.
 func (x *TabArray) GetTabs(AlignmentsVar **TabAlign, LocationsVar *[]int) {
 
@@ -140,17 +140,17 @@ func (x *TabArray) Resize(NewSizeVar int) {
 
 }
 
-var xTabArraySetDecimalPoint func(uintptr, int, uint32)
+var xTabArraySetDecimalPoint func(uintptr, int, rune)
 
 // Sets the Unicode character to use as decimal point.
 //
-// This is only relevant for tabs with %PANGO_TAB_DECIMAL alignment,
+// This is only relevant for tabs with [TabDecimalValue] alignment,
 // which align content at the first occurrence of the decimal point
 // character.
 //
 // By default, Pango uses the decimal point according
 // to the current locale.
-func (x *TabArray) SetDecimalPoint(TabIndexVar int, DecimalPointVar uint32) {
+func (x *TabArray) SetDecimalPoint(TabIndexVar int, DecimalPointVar rune) {
 
 	xTabArraySetDecimalPoint(x.GoPointer(), TabIndexVar, DecimalPointVar)
 
@@ -236,11 +236,56 @@ const (
 	TabDecimalValue TabAlign = 3
 )
 
+// String returns the C name of the value, e.g. for debugging output
+func (x TabAlign) String() string {
+	switch x {
+	case TabLeftValue:
+		return "PANGO_TAB_LEFT"
+	case TabRightValue:
+		return "PANGO_TAB_RIGHT"
+	case TabCenterValue:
+		return "PANGO_TAB_CENTER"
+	case TabDecimalValue:
+		return "PANGO_TAB_DECIMAL"
+	}
+	return fmt.Sprintf("TabAlign(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x TabAlign) Nick() string {
+	switch x {
+	case TabLeftValue:
+		return "left"
+	case TabRightValue:
+		return "right"
+	case TabCenterValue:
+		return "center"
+	case TabDecimalValue:
+		return "decimal"
+	}
+	return ""
+}
+
+// TabAlignFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func TabAlignFromString(s string) (TabAlign, bool) {
+	switch s {
+	case "PANGO_TAB_LEFT", "left":
+		return TabLeftValue, true
+	case "PANGO_TAB_RIGHT", "right":
+		return TabRightValue, true
+	case "PANGO_TAB_CENTER", "center":
+		return TabCenterValue, true
+	case "PANGO_TAB_DECIMAL", "decimal":
+		return TabDecimalValue, true
+	}
+	return 0, false
+}
+
 var xTabArrayFromString func(string) *TabArray
 
 // Deserializes a `PangoTabArray` from a string.
 //
-// This is the counterpart to [method@Pango.TabArray.to_string].
+// This is the counterpart to [TabArray.ToString].
 // See that functions for details about the format.
 func TabArrayFromString(TextVar string) *TabArray {
 
@@ -253,7 +298,7 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
@@ -262,25 +307,25 @@ func init() {
 
 	core.PuregoSafeRegister(&xTabAlignGLibType, libs, "pango_tab_align_get_type")
 
-	core.PuregoSafeRegister(&xTabArrayFromString, libs, "pango_tab_array_from_string")
+	core.PuregoRegisterSince(&xTabArrayFromString, libs, "pango_tab_array_from_string", "PANGO", "1.50")
 
 	core.PuregoSafeRegister(&xTabArrayGLibType, libs, "pango_tab_array_get_type")
 
-	core.PuregoSafeRegister(&xNewTabArray, libs, "pango_tab_array_new")
-	core.PuregoSafeRegister(&xNewTabArrayWithPositions, libs, "pango_tab_array_new_with_positions")
-
-	core.PuregoSafeRegister(&xTabArrayCopy, libs, "pango_tab_array_copy")
-	core.PuregoSafeRegister(&xTabArrayFree, libs, "pango_tab_array_free")
-	core.PuregoSafeRegister(&xTabArrayGetDecimalPoint, libs, "pango_tab_array_get_decimal_point")
-	core.PuregoSafeRegister(&xTabArrayGetPositionsInPixels, libs, "pango_tab_array_get_positions_in_pixels")
-	core.PuregoSafeRegister(&xTabArrayGetSize, libs, "pango_tab_array_get_size")
-	core.PuregoSafeRegister(&xTabArrayGetTab, libs, "pango_tab_array_get_tab")
-	core.PuregoSafeRegister(&xTabArrayGetTabs, libs, "pango_tab_array_get_tabs")
-	core.PuregoSafeRegister(&xTabArrayResize, libs, "pango_tab_array_resize")
-	core.PuregoSafeRegister(&xTabArraySetDecimalPoint, libs, "pango_tab_array_set_decimal_point")
-	core.PuregoSafeRegister(&xTabArraySetPositionsInPixels, libs, "pango_tab_array_set_positions_in_pixels")
-	core.PuregoSafeRegister(&xTabArraySetTab, libs, "pango_tab_array_set_tab")
-	core.PuregoSafeRegister(&xTabArraySort, libs, "pango_tab_array_sort")
-	core.PuregoSafeRegister(&xTabArrayToString, libs, "pango_tab_array_to_string")
+	core.PuregoRegisterSince(&xNewTabArray, libs, "pango_tab_array_new", "PANGO", "")
+	core.PuregoRegisterSince(&xNewTabArrayWithPositions, libs, "pango_tab_array_new_with_positions", "PANGO", "")
+
+	core.PuregoRegisterSince(&xTabArrayCopy, libs, "pango_tab_array_copy", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayFree, libs, "pango_tab_array_free", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayGetDecimalPoint, libs, "pango_tab_array_get_decimal_point", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xTabArrayGetPositionsInPixels, libs, "pango_tab_array_get_positions_in_pixels", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayGetSize, libs, "pango_tab_array_get_size", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayGetTab, libs, "pango_tab_array_get_tab", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayGetTabs, libs, "pango_tab_array_get_tabs", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArrayResize, libs, "pango_tab_array_resize", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArraySetDecimalPoint, libs, "pango_tab_array_set_decimal_point", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xTabArraySetPositionsInPixels, libs, "pango_tab_array_set_positions_in_pixels", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xTabArraySetTab, libs, "pango_tab_array_set_tab", "PANGO", "")
+	core.PuregoRegisterSince(&xTabArraySort, libs, "pango_tab_array_sort", "PANGO", "1.50")
+	core.PuregoRegisterSince(&xTabArrayToString, libs, "pango_tab_array_to_string", "PANGO", "1.50")
 
 }
@@ -5,8 +5,8 @@ import (
 	"structs"
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
 	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
@@ -29,6 +29,21 @@ func (x *Language) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// Copy returns a copy of the Language made with g_boxed_copy, release it with Free.
+func (x *Language) Copy() *Language {
+	cret := gobject.BoxedCopy(LanguageGLibType(), x.GoPointer())
+	if cret == 0 {
+		return nil
+	}
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Language)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Language with g_boxed_free, it must have been allocated by GLib, e.g. returned by Copy.
+func (x *Language) Free() {
+	gobject.BoxedFree(LanguageGLibType(), x.GoPointer())
+}
+
 var xLanguageGetSampleString func(uintptr) string
 
 // Get a string that is representative of the characters needed to
@@ -39,17 +54,15 @@ var xLanguageGetSampleString func(uintptr) string
 // font feature requirements unique to the language. It is suitable for use
 // as sample text in a font selection dialog.
 //
-// If @language is %NULL, the default language as found by
-// [func@Pango.Language.get_default] is used.
+// If language is nil, the default language as found by
+// [LanguageGetDefault] is used.
 //
-// If Pango does not have a sample string for @language, the classic
+// If Pango does not have a sample string for language, the classic
 // "The quick brown fox..." is returned.  This can be detected by
 // comparing the returned pointer value to that returned for (non-existent)
 // language code "xx".  That is, compare to:
 //
-// ```
-// pango_language_get_sample_string (pango_language_from_string ("xx"))
-// ```
+//	pango_language_get_sample_string (pango_language_from_string ("xx"))
 func (x *Language) GetSampleString() string {
 
 	cret := xLanguageGetSampleString(x.GoPointer())
@@ -58,24 +71,24 @@ func (x *Language) GetSampleString() string {
 
 var xLanguageGetScripts func(uintptr, *int) uintptr
 
-// Determines the scripts used to to write @language.
+// Determines the scripts used to to write language.
 //
-// If nothing is known about the language tag @language,
-// or if @language is %NULL, then %NULL is returned.
+// If nothing is known about the language tag language,
+// or if language is nil, then nil is returned.
 // The list of scripts returned starts with the script that the
 // language uses most and continues to the one it uses least.
 //
-// The value @num_script points at will be set to the number
-// of scripts in the returned array (or zero if %NULL is returned).
+// The value num_script points at will be set to the number
+// of scripts in the returned array (or zero if nil is returned).
 //
 // Most languages use only one script for writing, but there are
 // some that use two (Latin and Cyrillic for example), and a few
 // use three (Japanese for example). Applications should not make
 // any assumptions on the maximum number of scripts returned
 // though, except that it is positive if the return value is not
-// %NULL, and it is a small number.
+// nil, and it is a small number.
 //
-// The [method@Pango.Language.includes_script] function uses this
+// The [Language.IncludesScript] function uses this
 // function internally.
 //
 // Note: while the return value is declared as `PangoScript`, the
@@ -89,19 +102,19 @@ func (x *Language) GetScripts(NumScriptsVar *int) uintptr {
 
 var xLanguageIncludesScript func(uintptr, Script) bool
 
-// Determines if @script is one of the scripts used to
-// write @language.
+// Determines if script is one of the scripts used to
+// write language.
 //
 // The returned value is conservative; if nothing is known about
-// the language tag @language, %TRUE will be returned, since, as
-// far as Pango knows, @script might be used to write @language.
+// the language tag language, true will be returned, since, as
+// far as Pango knows, script might be used to write language.
 //
 // This routine is used in Pango's itemization process when
 // determining if a supplied language tag is relevant to
 // a particular section of text. It probably is not useful
 // for applications in most circumstances.
 //
-// This function uses [method@Pango.Language.get_scripts] internally.
+// This function uses [Language.GetScripts] internally.
 func (x *Language) IncludesScript(ScriptVar Script) bool {
 
 	cret := xLanguageIncludesScript(x.GoPointer(), ScriptVar)
@@ -137,7 +150,7 @@ func (x *Language) ToString() string {
 //
 // `PangoRectangle` is frequently used to represent the logical or ink
 // extents of a single glyph or section of text. (See, for instance,
-// [method@Pango.Font.get_glyph_extents].)
+// [Font.GetGlyphExtents].)
 type Rectangle struct {
 	_ structs.HostLayout
 
@@ -154,6 +167,19 @@ func (x *Rectangle) GoPointer() uintptr {
 	return uintptr(unsafe.Pointer(x))
 }
 
+// NewRectangleAlloc returns a zeroed Rectangle allocated with g_malloc0 that is large enough for the C struct,
+// e.g. to pass to functions that fill a caller allocated Rectangle. Release it with Free.
+func NewRectangleAlloc() *Rectangle {
+	cret := core.GMalloc0(max(unsafe.Sizeof(Rectangle{}), 16))
+	// the address is dereferenced to keep go vet from reporting a possible misuse of unsafe.Pointer
+	return (*Rectangle)(*(*unsafe.Pointer)(unsafe.Pointer(&cret)))
+}
+
+// Free releases the Rectangle with g_free, it must have been allocated by GLib, e.g. with NewRectangleAlloc.
+func (x *Rectangle) Free() {
+	core.GFree(x.GoPointer())
+}
+
 // A `PangoGlyph` represents a single glyph in the output form of a string.
 type Glyph = uint32
 
@@ -161,7 +187,7 @@ const (
 	// The scale between dimensions used for Pango distances and device units.
 	//
 	// The definition of device units is dependent on the output device; it will
-	// typically be pixels for a screen, and points for a printer. %PANGO_SCALE is
+	// typically be pixels for a screen, and points for a printer. [SCALE] is
 	// currently 1024, but this may be changed in the future.
 	//
 	// When setting font sizes, device units are always considered to be
@@ -173,21 +199,21 @@ var xExtentsToPixels func(*Rectangle, *Rectangle)
 
 // Converts extents from Pango units to device units.
 //
-// The conversion is done by dividing by the %PANGO_SCALE factor and
+// The conversion is done by dividing by the [SCALE] factor and
 // performing rounding.
 //
-// The @inclusive rectangle is converted by flooring the x/y coordinates
+// The inclusive rectangle is converted by flooring the x/y coordinates
 // and extending width/height, such that the final rectangle completely
 // includes the original rectangle.
 //
-// The @nearest rectangle is converted by rounding the coordinates
+// The nearest rectangle is converted by rounding the coordinates
 // of the rectangle to the nearest device unit (pixel).
 //
 // The rule to which argument to use is: if you want the resulting device-space
 // rectangle to completely contain the original rectangle, pass it in as
-// @inclusive. If you want two touching-but-not-overlapping rectangles stay
+// inclusive. If you want two touching-but-not-overlapping rectangles stay
 // touching-but-not-overlapping after rounding to device units, pass them in
-// as @nearest.
+// as nearest.
 func ExtentsToPixels(InclusiveVar *Rectangle, NearestVar *Rectangle) {
 
 	xExtentsToPixels(InclusiveVar, NearestVar)
@@ -198,7 +224,7 @@ var xUnitsFromDouble func(float64) int
 
 // Converts a floating-point number to Pango units.
 //
-// The conversion is done by multiplying @d by %PANGO_SCALE and
+// The conversion is done by multiplying d by [SCALE] and
 // rounding the result to nearest integer.
 func UnitsFromDouble(DVar float64) int {
 
@@ -210,7 +236,7 @@ var xUnitsToDouble func(int) float64
 
 // Converts a number in Pango units to floating-point.
 //
-// The conversion is done by dividing @i by %PANGO_SCALE.
+// The conversion is done by dividing i by [SCALE].
 func UnitsToDouble(IVar int) float64 {
 
 	cret := xUnitsToDouble(IVar)
@@ -222,23 +248,23 @@ func init() {
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xExtentsToPixels, libs, "pango_extents_to_pixels")
-	core.PuregoSafeRegister(&xUnitsFromDouble, libs, "pango_units_from_double")
-	core.PuregoSafeRegister(&xUnitsToDouble, libs, "pango_units_to_double")
+	core.PuregoRegisterSince(&xExtentsToPixels, libs, "pango_extents_to_pixels", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xUnitsFromDouble, libs, "pango_units_from_double", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xUnitsToDouble, libs, "pango_units_to_double", "PANGO", "1.16")
 
 	core.PuregoSafeRegister(&xLanguageGLibType, libs, "pango_language_get_type")
 
-	core.PuregoSafeRegister(&xLanguageGetSampleString, libs, "pango_language_get_sample_string")
-	core.PuregoSafeRegister(&xLanguageGetScripts, libs, "pango_language_get_scripts")
-	core.PuregoSafeRegister(&xLanguageIncludesScript, libs, "pango_language_includes_script")
-	core.PuregoSafeRegister(&xLanguageMatches, libs, "pango_language_matches")
-	core.PuregoSafeRegister(&xLanguageToString, libs, "pango_language_to_string")
+	core.PuregoRegisterSince(&xLanguageGetSampleString, libs, "pango_language_get_sample_string", "PANGO", "")
+	core.PuregoRegisterSince(&xLanguageGetScripts, libs, "pango_language_get_scripts", "PANGO", "1.22")
+	core.PuregoRegisterSince(&xLanguageIncludesScript, libs, "pango_language_includes_script", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xLanguageMatches, libs, "pango_language_matches", "PANGO", "")
+	core.PuregoRegisterSince(&xLanguageToString, libs, "pango_language_to_string", "PANGO", "")
 
 }
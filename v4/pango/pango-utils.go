@@ -2,27 +2,24 @@
 package pango
 
 import (
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
-	"github.com/jwijenbergh/puregotk/v4/glib"
-	"github.com/jwijenbergh/puregotk/v4/gobject/types"
 )
 
 var xFindParagraphBoundary func(string, int, *int, *int)
 
-// Locates a paragraph boundary in @text.
+// Locates a paragraph boundary in text.
 //
 // A boundary is caused by delimiter characters, such as
 // a newline, carriage return, carriage return-newline pair,
 // or Unicode paragraph separator character.
 //
 // The index of the run of delimiters is returned in
-// @paragraph_delimiter_index. The index of the start of the
+// paragraph_delimiter_index. The index of the start of the
 // next paragraph (index after all delimiters) is stored n
-// @next_paragraph_start.
+// next_paragraph_start.
 //
-// If no delimiters are found, both @paragraph_delimiter_index
-// and @next_paragraph_start are filled with the length of @text
+// If no delimiters are found, both paragraph_delimiter_index
+// and next_paragraph_start are filled with the length of text
 // (an index one off the end).
 func FindParagraphBoundary(TextVar string, LengthVar int, ParagraphDelimiterIndexVar *int, NextParagraphStartVar *int) {
 
@@ -30,15 +27,15 @@ func FindParagraphBoundary(TextVar string, LengthVar int, ParagraphDelimiterInde
 
 }
 
-var xIsZeroWidth func(uint32) bool
+var xIsZeroWidth func(rune) bool
 
 // Checks if a character that should not be normally rendered.
 //
 // This includes all Unicode characters with "ZERO WIDTH" in their name,
 // as well as *bidi* formatting characters, and a few other ones.
 //
-// This is totally different from [func@GLib.unichar_iszerowidth] and is at best misnamed.
-func IsZeroWidth(ChVar uint32) bool {
+// This is totally different from [glib.UnicharIszerowidth] and is at best misnamed.
+func IsZeroWidth(ChVar rune) bool {
 
 	cret := xIsZeroWidth(ChVar)
 	return cret
@@ -59,27 +56,6 @@ func Log2visGetEmbeddingLevels(TextVar string, LengthVar int, PbaseDirVar *Direc
 	return cret
 }
 
-var xParseEnum func(types.GType, uintptr, *int, bool, *string) bool
-
-// Parses an enum type and stores the result in @value.
-//
-// If @str does not match the nick name of any of the possible values
-// for the enum and is not an integer, %FALSE is returned, a warning
-// is issued if @warn is %TRUE, and a string representing the list of
-// possible values is stored in @possible_values. The list is
-// slash-separated, eg. "none/start/middle/end".
-//
-// If failed and @possible_values is not %NULL, returned string should
-// be freed using g_free().
-func ParseEnum(TypeVar types.GType, StrVar *string, ValueVar *int, WarnVar bool, PossibleValuesVar *string) bool {
-
-	StrVarPtr := core.GStrdupNullable(StrVar)
-	defer core.GFreeNullable(StrVarPtr)
-
-	cret := xParseEnum(TypeVar, StrVarPtr, ValueVar, WarnVar, PossibleValuesVar)
-	return cret
-}
-
 var xParseStretch func(string, *Stretch, bool) bool
 
 // Parses a font stretch.
@@ -141,8 +117,8 @@ var xQuantizeLineGeometry func(int, int)
 // This is typically used for underline or strikethrough. The purpose of
 // this function is to avoid such lines looking blurry.
 //
-// Care is taken to make sure @thickness is at least one pixel when this
-// function returns, but returned @position may become zero as a result
+// Care is taken to make sure thickness is at least one pixel when this
+// function returns, but returned position may become zero as a result
 // of rounding.
 func QuantizeLineGeometry(ThicknessVar int, PositionVar int) {
 
@@ -150,91 +126,11 @@ func QuantizeLineGeometry(ThicknessVar int, PositionVar int) {
 
 }
 
-var xReadLine func(uintptr, *glib.String) int
-
-// Reads an entire line from a file into a buffer.
-//
-// Lines may be delimited with '\n', '\r', '\n\r', or '\r\n'. The delimiter
-// is not written into the buffer. Text after a '#' character is treated as
-// a comment and skipped. '\' can be used to escape a # character.
-// '\' proceeding a line delimiter combines adjacent lines. A '\' proceeding
-// any other character is ignored and written into the output buffer
-// unmodified.
-func ReadLine(StreamVar uintptr, StrVar *glib.String) int {
-
-	cret := xReadLine(StreamVar, StrVar)
-	return cret
-}
-
-var xScanInt func(string, *int) bool
-
-// Scans an integer.
-//
-// Leading white space is skipped.
-func ScanInt(PosVar string, OutVar *int) bool {
-
-	cret := xScanInt(PosVar, OutVar)
-	return cret
-}
-
-var xScanString func(string, *glib.String) bool
-
-// Scans a string into a `GString` buffer.
-//
-// The string may either be a sequence of non-white-space characters,
-// or a quoted string with '"'. Instead a quoted string, '\"' represents
-// a literal quote. Leading white space outside of quotes is skipped.
-func ScanString(PosVar string, OutVar *glib.String) bool {
-
-	cret := xScanString(PosVar, OutVar)
-	return cret
-}
-
-var xScanWord func(string, *glib.String) bool
-
-// Scans a word into a `GString` buffer.
-//
-// A word consists of [A-Za-z_] followed by zero or more
-// [A-Za-z_0-9]. Leading white space is skipped.
-func ScanWord(PosVar string, OutVar *glib.String) bool {
-
-	cret := xScanWord(PosVar, OutVar)
-	return cret
-}
-
-var xSkipSpace func(string) bool
-
-// Skips 0 or more characters of white space.
-func SkipSpace(PosVar string) bool {
-
-	cret := xSkipSpace(PosVar)
-	return cret
-}
-
-var xSplitFileList func(string) []string
-
-// Splits a %G_SEARCHPATH_SEPARATOR-separated list of files, stripping
-// white space and substituting ~/ with $HOME/.
-func SplitFileList(StrVar string) []string {
-
-	cret := xSplitFileList(StrVar)
-	return cret
-}
-
-var xTrimString func(string) string
-
-// Trims leading and trailing whitespace from a string.
-func TrimString(StrVar string) string {
-
-	cret := xTrimString(StrVar)
-	return cret
-}
-
 var xVersion func() int
 
 // Returns the encoded version of Pango available at run-time.
 //
-// This is similar to the macro %PANGO_VERSION except that the macro
+// This is similar to the macro PANGO_VERSION except that the macro
 // returns the encoded version available at compile-time. A version
 // number can be encoded into an integer using PANGO_VERSION_ENCODE().
 func Version() int {
@@ -248,17 +144,17 @@ var xVersionCheck func(int, int, int) string
 // Checks that the Pango library in use is compatible with the
 // given version.
 //
-// Generally you would pass in the constants %PANGO_VERSION_MAJOR,
-// %PANGO_VERSION_MINOR, %PANGO_VERSION_MICRO as the three arguments
+// Generally you would pass in the constants [VERSION_MAJOR],
+// [VERSION_MINOR], [VERSION_MICRO] as the three arguments
 // to this function; that produces a check that the library in use at
 // run-time is compatible with the version of Pango the application or
 // module was compiled against.
 //
 // Compatibility is defined by two things: first the version
 // of the running library is newer than the version
-// @required_major.required_minor.@required_micro. Second
+// required_major.required_minor.@required_micro. Second
 // the running library must be binary compatible with the
-// version @required_major.required_minor.@required_micro
+// version required_major.required_minor.@required_micro
 // (same major version.)
 //
 // For compile-time version checking use PANGO_VERSION_CHECK().
@@ -272,7 +168,7 @@ var xVersionString func() string
 
 // Returns the version of Pango available at run-time.
 //
-// This is similar to the macro %PANGO_VERSION_STRING except that the
+// This is similar to the macro [VERSION_STRING] except that the
 // macro returns the version available at compile-time.
 func VersionString() string {
 
@@ -280,36 +176,35 @@ func VersionString() string {
 	return cret
 }
 
+// CheckVersionAtLeast returns whether the loaded PANGO library is version major.minor.micro or later,
+// calling a function that was added in a later version panics with a core.MissingSymbolError.
+// It is false for another major version, see VersionCheck.
+func CheckVersionAtLeast(major, minor, micro uint) bool {
+	return VersionCheck(int(major), int(minor), int(micro)) == ""
+}
+
 func init() {
 	core.SetPackageName("PANGO", "pango")
 	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xFindParagraphBoundary, libs, "pango_find_paragraph_boundary")
-	core.PuregoSafeRegister(&xIsZeroWidth, libs, "pango_is_zero_width")
-	core.PuregoSafeRegister(&xLog2visGetEmbeddingLevels, libs, "pango_log2vis_get_embedding_levels")
-	core.PuregoSafeRegister(&xParseEnum, libs, "pango_parse_enum")
-	core.PuregoSafeRegister(&xParseStretch, libs, "pango_parse_stretch")
-	core.PuregoSafeRegister(&xParseStyle, libs, "pango_parse_style")
-	core.PuregoSafeRegister(&xParseVariant, libs, "pango_parse_variant")
-	core.PuregoSafeRegister(&xParseWeight, libs, "pango_parse_weight")
-	core.PuregoSafeRegister(&xQuantizeLineGeometry, libs, "pango_quantize_line_geometry")
-	core.PuregoSafeRegister(&xReadLine, libs, "pango_read_line")
-	core.PuregoSafeRegister(&xScanInt, libs, "pango_scan_int")
-	core.PuregoSafeRegister(&xScanString, libs, "pango_scan_string")
-	core.PuregoSafeRegister(&xScanWord, libs, "pango_scan_word")
-	core.PuregoSafeRegister(&xSkipSpace, libs, "pango_skip_space")
-	core.PuregoSafeRegister(&xSplitFileList, libs, "pango_split_file_list")
-	core.PuregoSafeRegister(&xTrimString, libs, "pango_trim_string")
-	core.PuregoSafeRegister(&xVersion, libs, "pango_version")
-	core.PuregoSafeRegister(&xVersionCheck, libs, "pango_version_check")
-	core.PuregoSafeRegister(&xVersionString, libs, "pango_version_string")
+	core.PuregoRegisterSince(&xFindParagraphBoundary, libs, "pango_find_paragraph_boundary", "PANGO", "")
+	core.PuregoRegisterSince(&xIsZeroWidth, libs, "pango_is_zero_width", "PANGO", "1.10")
+	core.PuregoRegisterSince(&xLog2visGetEmbeddingLevels, libs, "pango_log2vis_get_embedding_levels", "PANGO", "1.4")
+	core.PuregoRegisterSince(&xParseStretch, libs, "pango_parse_stretch", "PANGO", "")
+	core.PuregoRegisterSince(&xParseStyle, libs, "pango_parse_style", "PANGO", "")
+	core.PuregoRegisterSince(&xParseVariant, libs, "pango_parse_variant", "PANGO", "")
+	core.PuregoRegisterSince(&xParseWeight, libs, "pango_parse_weight", "PANGO", "")
+	core.PuregoRegisterSince(&xQuantizeLineGeometry, libs, "pango_quantize_line_geometry", "PANGO", "1.12")
+	core.PuregoRegisterSince(&xVersion, libs, "pango_version", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xVersionCheck, libs, "pango_version_check", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xVersionString, libs, "pango_version_string", "PANGO", "1.16")
 
 }
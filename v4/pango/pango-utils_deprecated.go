@@ -0,0 +1,150 @@
+//go:build !puregotk_no_deprecated
+
+// Package pango was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package pango
+
+import (
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+var xParseEnum func(types.GType, uintptr, *int, bool, *string) bool
+
+// Parses an enum type and stores the result in value.
+//
+// If str does not match the nick name of any of the possible values
+// for the enum and is not an integer, false is returned, a warning
+// is issued if warn is true, and a string representing the list of
+// possible values is stored in possible_values. The list is
+// slash-separated, eg. "none/start/middle/end".
+//
+// If failed and possible_values is not nil, returned string should
+// be freed using [glib.Free].
+//
+// Deprecated: since 1.38. do not use it in new code.
+func ParseEnum(TypeVar types.GType, StrVar *string, ValueVar *int, WarnVar bool, PossibleValuesVar *string) bool {
+
+	StrVarPtr := core.GStrdupNullable(StrVar)
+	defer core.GFreeNullable(StrVarPtr)
+
+	cret := xParseEnum(TypeVar, StrVarPtr, ValueVar, WarnVar, PossibleValuesVar)
+	return cret
+}
+
+var xReadLine func(uintptr, *glib.String) int
+
+// Reads an entire line from a file into a buffer.
+//
+// Lines may be delimited with '\n', '\r', '\n\r', or '\r\n'. The delimiter
+// is not written into the buffer. Text after a '#' character is treated as
+// a comment and skipped. '\' can be used to escape a # character.
+// '\' proceeding a line delimiter combines adjacent lines. A '\' proceeding
+// any other character is ignored and written into the output buffer
+// unmodified.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func ReadLine(StreamVar uintptr, StrVar *glib.String) int {
+
+	cret := xReadLine(StreamVar, StrVar)
+	return cret
+}
+
+var xScanInt func(string, *int) bool
+
+// Scans an integer.
+//
+// Leading white space is skipped.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func ScanInt(PosVar string, OutVar *int) bool {
+
+	cret := xScanInt(PosVar, OutVar)
+	return cret
+}
+
+var xScanString func(string, *glib.String) bool
+
+// Scans a string into a `GString` buffer.
+//
+// The string may either be a sequence of non-white-space characters,
+// or a quoted string with '"'. Instead a quoted string, '\"' represents
+// a literal quote. Leading white space outside of quotes is skipped.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func ScanString(PosVar string, OutVar *glib.String) bool {
+
+	cret := xScanString(PosVar, OutVar)
+	return cret
+}
+
+var xScanWord func(string, *glib.String) bool
+
+// Scans a word into a `GString` buffer.
+//
+// A word consists of [A-Za-z_] followed by zero or more
+// [A-Za-z_0-9]. Leading white space is skipped.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func ScanWord(PosVar string, OutVar *glib.String) bool {
+
+	cret := xScanWord(PosVar, OutVar)
+	return cret
+}
+
+var xSkipSpace func(string) bool
+
+// Skips 0 or more characters of white space.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func SkipSpace(PosVar string) bool {
+
+	cret := xSkipSpace(PosVar)
+	return cret
+}
+
+var xSplitFileList func(string) uintptr
+
+// Splits a [glib.SEARCHPATH_SEPARATOR]-separated list of files, stripping
+// white space and substituting ~/ with $HOME/.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func SplitFileList(StrVar string) []string {
+
+	cret := xSplitFileList(StrVar)
+	return core.GoStringSliceFull(cret)
+}
+
+var xTrimString func(string) string
+
+// Trims leading and trailing whitespace from a string.
+//
+// Deprecated: since 1.38. do not use it in new code.
+func TrimString(StrVar string) string {
+
+	cret := xTrimString(StrVar)
+	return cret
+}
+
+func init() {
+	core.SetPackageName("PANGO", "pango")
+	core.SetSharedLibraries("PANGO", []string{"libpango-1.0.so.0"})
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("PANGO") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoRegisterSince(&xParseEnum, libs, "pango_parse_enum", "PANGO", "1.16")
+	core.PuregoRegisterSince(&xReadLine, libs, "pango_read_line", "PANGO", "")
+	core.PuregoRegisterSince(&xScanInt, libs, "pango_scan_int", "PANGO", "")
+	core.PuregoRegisterSince(&xScanString, libs, "pango_scan_string", "PANGO", "")
+	core.PuregoRegisterSince(&xScanWord, libs, "pango_scan_word", "PANGO", "")
+	core.PuregoRegisterSince(&xSkipSpace, libs, "pango_skip_space", "PANGO", "")
+	core.PuregoRegisterSince(&xSplitFileList, libs, "pango_split_file_list", "PANGO", "")
+	core.PuregoRegisterSince(&xTrimString, libs, "pango_trim_string", "PANGO", "")
+
+}
@@ -4,7 +4,6 @@ package pangocairo
 import (
 	"unsafe"
 
-	"github.com/jwijenbergh/purego"
 	"github.com/jwijenbergh/puregotk/pkg/core"
 	"github.com/jwijenbergh/puregotk/v4/cairo"
 	"github.com/jwijenbergh/puregotk/v4/glib"
@@ -13,7 +12,7 @@ import (
 	"github.com/jwijenbergh/puregotk/v4/pango"
 )
 
-// Function type for rendering attributes of type %PANGO_ATTR_SHAPE
+// Function type for rendering attributes of type [pango.AttrShapeValue]
 // with Pango's Cairo renderer.
 type ShapeRendererFunc func(*cairo.Context, *pango.AttrShape, bool, uintptr)
 
@@ -49,7 +48,7 @@ func (x *FontBase) SetGoPointer(ptr uintptr) {
 	x.Ptr = ptr
 }
 
-// Gets the `cairo_scaled_font_t` used by @font.
+// Gets the `cairo_scaled_font_t` used by font.
 // The scaled font can be referenced and kept using
 // cairo_scaled_font_reference().
 func (x *FontBase) GetScaledFont() *cairo.ScaledFont {
@@ -97,6 +96,8 @@ func (x *FontMapBase) SetGoPointer(ptr uintptr) {
 }
 
 // Create a `PangoContext` for the given fontmap.
+//
+// Deprecated: since 1.22. Use [pango.FontMap.CreateContext] instead.
 func (x *FontMapBase) CreateContext() *pango.Context {
 	var cls *pango.Context
 
@@ -110,7 +111,7 @@ func (x *FontMapBase) CreateContext() *pango.Context {
 	return cls
 }
 
-// Gets the type of Cairo font backend that @fontmap uses.
+// Gets the type of Cairo font backend that fontmap uses.
 func (x *FontMapBase) GetFontType() cairo.FontType {
 
 	cret := XPangoCairoFontMapGetFontType(x.GoPointer())
@@ -119,7 +120,7 @@ func (x *FontMapBase) GetFontType() cairo.FontType {
 
 // Gets the resolution for the fontmap.
 //
-// See [method@PangoCairo.FontMap.set_resolution].
+// See [FontMap.SetResolution].
 func (x *FontMapBase) GetResolution() float64 {
 
 	cret := XPangoCairoFontMapGetResolution(x.GoPointer())
@@ -136,11 +137,11 @@ func (x *FontMapBase) GetResolution() float64 {
 // This function only changes the default fontmap for
 // the current thread. Default fontmaps of existing threads
 // are not changed. Default fontmaps of any new threads will
-// still be created using [func@PangoCairo.FontMap.new].
+// still be created using [FontMapNew].
 //
-// A value of %NULL for @fontmap will cause the current default
+// A value of nil for fontmap will cause the current default
 // font map to be released and a new default font map to be created
-// on demand, using [func@PangoCairo.FontMap.new].
+// on demand, using [FontMapNew].
 func (x *FontMapBase) SetDefault() {
 
 	XPangoCairoFontMapSetDefault(x.GoPointer())
@@ -168,10 +169,10 @@ var XPangoCairoFontMapSetResolution func(uintptr, float64)
 var xContextGetFontOptions func(uintptr) *cairo.FontOptions
 
 // Retrieves any font rendering options previously set with
-// [func@PangoCairo.context_set_font_options].
+// [ContextSetFontOptions].
 //
 // This function does not report options that are derived from
-// the target surface by [func@update_context].
+// the target surface by update_context.
 func ContextGetFontOptions(ContextVar *pango.Context) *cairo.FontOptions {
 
 	cret := xContextGetFontOptions(ContextVar.GoPointer())
@@ -182,7 +183,7 @@ var xContextGetResolution func(uintptr) float64
 
 // Gets the resolution for the context.
 //
-// See [func@PangoCairo.context_set_resolution]
+// See [ContextSetResolution]
 func ContextGetResolution(ContextVar *pango.Context) float64 {
 
 	cret := xContextGetResolution(ContextVar.GoPointer())
@@ -192,13 +193,13 @@ func ContextGetResolution(ContextVar *pango.Context) float64 {
 var xContextGetShapeRenderer func(uintptr, uintptr) uintptr
 
 // Sets callback function for context to use for rendering attributes
-// of type %PANGO_ATTR_SHAPE.
+// of type [pango.AttrShapeValue].
 //
 // See `PangoCairoShapeRendererFunc` for details.
 //
 // Retrieves callback function and associated user data for rendering
-// attributes of type %PANGO_ATTR_SHAPE as set by
-// [func@PangoCairo.context_set_shape_renderer], if any.
+// attributes of type [pango.AttrShapeValue] as set by
+// [ContextSetShapeRenderer], if any.
 func ContextGetShapeRenderer(ContextVar *pango.Context, DataVar uintptr) uintptr {
 
 	cret := xContextGetShapeRenderer(ContextVar.GoPointer(), DataVar)
@@ -209,7 +210,7 @@ var xContextSetFontOptions func(uintptr, *cairo.FontOptions)
 
 // Sets the font options used when rendering text with this context.
 //
-// These options override any options that [func@update_context]
+// These options override any options that update_context
 // derives from the target surface.
 func ContextSetFontOptions(ContextVar *pango.Context, OptionsVar *cairo.FontOptions) {
 
@@ -233,7 +234,7 @@ func ContextSetResolution(ContextVar *pango.Context, DpiVar float64) {
 var xContextSetShapeRenderer func(uintptr, uintptr, uintptr, uintptr)
 
 // Sets callback function for context to use for rendering attributes
-// of type %PANGO_ATTR_SHAPE.
+// of type [pango.AttrShapeValue].
 //
 // See `PangoCairoShapeRendererFunc` for details.
 func ContextSetShapeRenderer(ContextVar *pango.Context, FuncVar *ShapeRendererFunc, DataVar uintptr, DnotifyVar *glib.DestroyNotify) {
@@ -245,11 +246,12 @@ func ContextSetShapeRenderer(ContextVar *pango.Context, FuncVar *ShapeRendererFu
 			FuncVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 *cairo.Context, arg1 *pango.AttrShape, arg2 bool, arg3 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *FuncVar
 				cbFn(arg0, arg1, arg2, arg3)
 			}
-			FuncVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
+			FuncVarRef = core.NewCallback(fcb)
+			FuncVarRef = glib.SaveCallbackWithClosure(FuncVarPtr, FuncVarRef, FuncVar)
 		}
 	}
 
@@ -260,11 +262,12 @@ func ContextSetShapeRenderer(ContextVar *pango.Context, FuncVar *ShapeRendererFu
 			DnotifyVarRef = cbRefPtr
 		} else {
 			fcb := func(arg0 uintptr) {
+				defer core.RecoverPanic()
 				cbFn := *DnotifyVar
 				cbFn(arg0)
 			}
-			DnotifyVarRef = purego.NewCallback(fcb)
-			glib.SaveCallbackWithClosure(DnotifyVarPtr, DnotifyVarRef, DnotifyVar)
+			DnotifyVarRef = core.NewCallback(fcb)
+			DnotifyVarRef = glib.SaveCallbackWithClosure(DnotifyVarPtr, DnotifyVarRef, DnotifyVar)
 		}
 	}
 
@@ -278,12 +281,12 @@ var xCreateContext func(*cairo.Context) uintptr
 // and target surface of the Cairo context.
 //
 // This context can then be
-// used to create a layout using [ctor@Pango.Layout.new].
+// used to create a layout using [pango.NewLayout].
 //
 // This function is a convenience function that creates a context using
-// the default font map, then updates it to @cr. If you just need to
-// create a layout for use with @cr and do not need to access `PangoContext`
-// directly, you can use [func@create_layout] instead.
+// the default font map, then updates it to cr. If you just need to
+// create a layout for use with cr and do not need to access `PangoContext`
+// directly, you can use create_layout instead.
 func CreateContext(CrVar *cairo.Context) *pango.Context {
 	var cls *pango.Context
 
@@ -303,9 +306,9 @@ var xCreateLayout func(*cairo.Context) uintptr
 // and target surface of the Cairo context.
 //
 // This layout can then be used for text measurement with functions
-// like [method@Pango.Layout.get_size] or drawing with functions like
-// [func@show_layout]. If you change the transformation or target
-// surface for @cr, you need to call [func@update_layout].
+// like [pango.Layout.GetSize] or drawing with functions like
+// show_layout. If you change the transformation or target
+// surface for cr, you need to call update_layout.
 //
 // This function is the most convenient way to use Cairo with Pango,
 // however it is slightly inefficient since it creates a separate
@@ -348,7 +351,7 @@ var xFontMapGetDefault func() uintptr
 // interfaces on the returned object.
 //
 // The default Cairo fontmap can be changed by using
-// [method@PangoCairo.FontMap.set_default]. This can be used to
+// [FontMap.SetDefault]. This can be used to
 // change the Cairo font backend that the default fontmap uses
 // for example.
 //
@@ -384,7 +387,7 @@ var xFontMapNew func() uintptr
 // `PangoCairoFontMap` interfaces on the returned object.
 //
 // You can override the type of backend returned by using an
-// environment variable %PANGOCAIRO_BACKEND. Supported types,
+// environment variable PANGOCAIRO_BACKEND. Supported types,
 // based on your build, are fc (fontconfig), win32, and coretext.
 // If requested type is not available, NULL is returned. Ie.
 // this is only useful for testing, when at least two backends
@@ -405,10 +408,10 @@ func FontMapNew() *pango.FontMap {
 var xFontMapNewForFontType func(cairo.FontType) uintptr
 
 // Creates a new `PangoCairoFontMap` object of the type suitable
-// to be used with cairo font backend of type @fonttype.
+// to be used with cairo font backend of type fonttype.
 //
-// In most cases one should simply use [func@PangoCairo.FontMap.new], or
-// in fact in most of those cases, just use [func@PangoCairo.FontMap.get_default].
+// In most cases one should simply use [FontMapNew], or
+// in fact in most of those cases, just use [FontMapGetDefault].
 func FontMapNewForFontType(FonttypeVar cairo.FontType) *pango.FontMap {
 	var cls *pango.FontMap
 
@@ -424,7 +427,7 @@ func FontMapNewForFontType(FonttypeVar cairo.FontType) *pango.FontMap {
 
 var xGlyphStringPath func(*cairo.Context, uintptr, *pango.GlyphString)
 
-// Adds the glyphs in @glyphs to the current path in the specified
+// Adds the glyphs in glyphs to the current path in the specified
 // cairo context.
 //
 // The origin of the glyphs (the left edge of the baseline)
@@ -478,17 +481,17 @@ func ShowErrorUnderline(CrVar *cairo.Context, XVar float64, YVar float64, WidthV
 
 var xShowGlyphItem func(*cairo.Context, string, *pango.GlyphItem)
 
-// Draws the glyphs in @glyph_item in the specified cairo context,
+// Draws the glyphs in glyph_item in the specified cairo context,
 //
 // embedding the text associated with the glyphs in the output if the
 // output format supports it (PDF for example), otherwise it acts
-// similar to [func@show_glyph_string].
+// similar to show_glyph_string.
 //
 // The origin of the glyphs (the left edge of the baseline) will
 // be drawn at the current point of the cairo context.
 //
-// Note that @text is the start of the text for layout, which is then
-// indexed by `glyph_item-&gt;item-&gt;offset`.
+// Note that text is the start of the text for layout, which is then
+// indexed by `glyph_item->item->offset`.
 func ShowGlyphItem(CrVar *cairo.Context, TextVar string, GlyphItemVar *pango.GlyphItem) {
 
 	xShowGlyphItem(CrVar, TextVar, GlyphItemVar)
@@ -497,7 +500,7 @@ func ShowGlyphItem(CrVar *cairo.Context, TextVar string, GlyphItemVar *pango.Gly
 
 var xShowGlyphString func(*cairo.Context, uintptr, *pango.GlyphString)
 
-// Draws the glyphs in @glyphs in the specified cairo context.
+// Draws the glyphs in glyphs in the specified cairo context.
 //
 // The origin of the glyphs (the left edge of the baseline) will
 // be drawn at the current point of the cairo context.
@@ -538,7 +541,7 @@ var xUpdateContext func(*cairo.Context, uintptr)
 // context.
 //
 // If any layouts have been created for the context, it's necessary
-// to call [method@Pango.Layout.context_changed] on those layouts.
+// to call [pango.Layout.ContextChanged] on those layouts.
 func UpdateContext(CrVar *cairo.Context, ContextVar *pango.Context) {
 
 	xUpdateContext(CrVar, ContextVar.GoPointer())
@@ -548,7 +551,7 @@ func UpdateContext(CrVar *cairo.Context, ContextVar *pango.Context) {
 var xUpdateLayout func(*cairo.Context, uintptr)
 
 // Updates the private `PangoContext` of a `PangoLayout` created with
-// [func@create_layout] to match the current transformation and target
+// create_layout to match the current transformation and target
 // surface of a Cairo context.
 func UpdateLayout(CrVar *cairo.Context, LayoutVar *pango.Layout) {
 
@@ -561,46 +564,46 @@ func init() {
 	core.SetSharedLibraries("PANGOCAIRO", []string{"libpangocairo-1.0.so.0"})
 	var libs []uintptr
 	for _, libPath := range core.GetPaths("PANGOCAIRO") {
-		lib, err := purego.Dlopen(libPath, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
 		if err != nil {
 			panic(err)
 		}
 		libs = append(libs, lib)
 	}
 
-	core.PuregoSafeRegister(&xContextGetFontOptions, libs, "pango_cairo_context_get_font_options")
-	core.PuregoSafeRegister(&xContextGetResolution, libs, "pango_cairo_context_get_resolution")
-	core.PuregoSafeRegister(&xContextGetShapeRenderer, libs, "pango_cairo_context_get_shape_renderer")
-	core.PuregoSafeRegister(&xContextSetFontOptions, libs, "pango_cairo_context_set_font_options")
-	core.PuregoSafeRegister(&xContextSetResolution, libs, "pango_cairo_context_set_resolution")
-	core.PuregoSafeRegister(&xContextSetShapeRenderer, libs, "pango_cairo_context_set_shape_renderer")
-	core.PuregoSafeRegister(&xCreateContext, libs, "pango_cairo_create_context")
-	core.PuregoSafeRegister(&xCreateLayout, libs, "pango_cairo_create_layout")
-	core.PuregoSafeRegister(&xErrorUnderlinePath, libs, "pango_cairo_error_underline_path")
-	core.PuregoSafeRegister(&xFontMapGetDefault, libs, "pango_cairo_font_map_get_default")
-	core.PuregoSafeRegister(&xFontMapNew, libs, "pango_cairo_font_map_new")
-	core.PuregoSafeRegister(&xFontMapNewForFontType, libs, "pango_cairo_font_map_new_for_font_type")
-	core.PuregoSafeRegister(&xGlyphStringPath, libs, "pango_cairo_glyph_string_path")
-	core.PuregoSafeRegister(&xLayoutLinePath, libs, "pango_cairo_layout_line_path")
-	core.PuregoSafeRegister(&xLayoutPath, libs, "pango_cairo_layout_path")
-	core.PuregoSafeRegister(&xShowErrorUnderline, libs, "pango_cairo_show_error_underline")
-	core.PuregoSafeRegister(&xShowGlyphItem, libs, "pango_cairo_show_glyph_item")
-	core.PuregoSafeRegister(&xShowGlyphString, libs, "pango_cairo_show_glyph_string")
-	core.PuregoSafeRegister(&xShowLayout, libs, "pango_cairo_show_layout")
-	core.PuregoSafeRegister(&xShowLayoutLine, libs, "pango_cairo_show_layout_line")
-	core.PuregoSafeRegister(&xUpdateContext, libs, "pango_cairo_update_context")
-	core.PuregoSafeRegister(&xUpdateLayout, libs, "pango_cairo_update_layout")
+	core.PuregoRegisterSince(&xContextGetFontOptions, libs, "pango_cairo_context_get_font_options", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xContextGetResolution, libs, "pango_cairo_context_get_resolution", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xContextGetShapeRenderer, libs, "pango_cairo_context_get_shape_renderer", "PANGOCAIRO", "1.18")
+	core.PuregoRegisterSince(&xContextSetFontOptions, libs, "pango_cairo_context_set_font_options", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xContextSetResolution, libs, "pango_cairo_context_set_resolution", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xContextSetShapeRenderer, libs, "pango_cairo_context_set_shape_renderer", "PANGOCAIRO", "1.18")
+	core.PuregoRegisterSince(&xCreateContext, libs, "pango_cairo_create_context", "PANGOCAIRO", "1.22")
+	core.PuregoRegisterSince(&xCreateLayout, libs, "pango_cairo_create_layout", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xErrorUnderlinePath, libs, "pango_cairo_error_underline_path", "PANGOCAIRO", "1.14")
+	core.PuregoRegisterSince(&xFontMapGetDefault, libs, "pango_cairo_font_map_get_default", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xFontMapNew, libs, "pango_cairo_font_map_new", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xFontMapNewForFontType, libs, "pango_cairo_font_map_new_for_font_type", "PANGOCAIRO", "1.18")
+	core.PuregoRegisterSince(&xGlyphStringPath, libs, "pango_cairo_glyph_string_path", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xLayoutLinePath, libs, "pango_cairo_layout_line_path", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xLayoutPath, libs, "pango_cairo_layout_path", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xShowErrorUnderline, libs, "pango_cairo_show_error_underline", "PANGOCAIRO", "1.14")
+	core.PuregoRegisterSince(&xShowGlyphItem, libs, "pango_cairo_show_glyph_item", "PANGOCAIRO", "1.22")
+	core.PuregoRegisterSince(&xShowGlyphString, libs, "pango_cairo_show_glyph_string", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xShowLayout, libs, "pango_cairo_show_layout", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xShowLayoutLine, libs, "pango_cairo_show_layout_line", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xUpdateContext, libs, "pango_cairo_update_context", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&xUpdateLayout, libs, "pango_cairo_update_layout", "PANGOCAIRO", "1.10")
 
 	core.PuregoSafeRegister(&xFontGLibType, libs, "pango_cairo_font_get_type")
 
-	core.PuregoSafeRegister(&XPangoCairoFontGetScaledFont, libs, "pango_cairo_font_get_scaled_font")
+	core.PuregoRegisterSince(&XPangoCairoFontGetScaledFont, libs, "pango_cairo_font_get_scaled_font", "PANGOCAIRO", "1.18")
 
 	core.PuregoSafeRegister(&xFontMapGLibType, libs, "pango_cairo_font_map_get_type")
 
-	core.PuregoSafeRegister(&XPangoCairoFontMapCreateContext, libs, "pango_cairo_font_map_create_context")
-	core.PuregoSafeRegister(&XPangoCairoFontMapGetFontType, libs, "pango_cairo_font_map_get_font_type")
-	core.PuregoSafeRegister(&XPangoCairoFontMapGetResolution, libs, "pango_cairo_font_map_get_resolution")
-	core.PuregoSafeRegister(&XPangoCairoFontMapSetDefault, libs, "pango_cairo_font_map_set_default")
-	core.PuregoSafeRegister(&XPangoCairoFontMapSetResolution, libs, "pango_cairo_font_map_set_resolution")
+	core.PuregoRegisterSince(&XPangoCairoFontMapCreateContext, libs, "pango_cairo_font_map_create_context", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&XPangoCairoFontMapGetFontType, libs, "pango_cairo_font_map_get_font_type", "PANGOCAIRO", "1.18")
+	core.PuregoRegisterSince(&XPangoCairoFontMapGetResolution, libs, "pango_cairo_font_map_get_resolution", "PANGOCAIRO", "1.10")
+	core.PuregoRegisterSince(&XPangoCairoFontMapSetDefault, libs, "pango_cairo_font_map_set_default", "PANGOCAIRO", "1.22")
+	core.PuregoRegisterSince(&XPangoCairoFontMapSetResolution, libs, "pango_cairo_font_map_set_resolution", "PANGOCAIRO", "1.10")
 
 }
@@ -0,0 +1,1914 @@
+// Package webkit was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package webkit
+
+import (
+	"fmt"
+	"structs"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/jwijenbergh/puregotk/v4/javascriptcore"
+)
+
+// A JavaScript snippet which can be injected in loaded pages.
+type UserScript struct {
+	_ structs.HostLayout
+}
+
+var xUserScriptGLibType func() types.GType
+
+func UserScriptGLibType() types.GType {
+	return xUserScriptGLibType()
+}
+
+func (x *UserScript) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xNewUserScript func(string, UserContentInjectedFrames, UserScriptInjectionTime, []string, []string) *UserScript
+
+// Creates a new user script.
+//
+// Scripts can be applied to some URIs only by passing non-null values for allow_list or block_list. Passing a nil allow_list implies that all URIs are on the allow_list. The script is applied if an URI matches the allow_list and not the block_list. URI patterns must be of the form `[protocol]://[host]/[path]`, where the *host* and *path* components can contain the wildcard character (`*`) to represent zero or more other characters.
+func NewUserScript(SourceVar string, InjectedFramesVar UserContentInjectedFrames, InjectionTimeVar UserScriptInjectionTime, AllowListVar []string, BlockListVar []string) *UserScript {
+
+	cret := xNewUserScript(SourceVar, InjectedFramesVar, InjectionTimeVar, AllowListVar, BlockListVar)
+	return cret
+}
+
+var xUserScriptRef func(uintptr) *UserScript
+
+// Atomically increments the reference count of user_script by one.
+//
+// This function is MT-safe and may be called from any thread.
+func (x *UserScript) Ref() *UserScript {
+
+	cret := xUserScriptRef(x.GoPointer())
+	return cret
+}
+
+var xUserScriptUnref func(uintptr)
+
+// Atomically decrements the reference count of user_script by one.
+//
+// If the reference count drops to 0, all memory allocated by [UserScript] is released. This function is MT-safe and may be called from any thread.
+func (x *UserScript) Unref() {
+
+	xUserScriptUnref(x.GoPointer())
+
+}
+
+// A CSS style sheet which can be injected in loaded pages.
+type UserStyleSheet struct {
+	_ structs.HostLayout
+}
+
+var xUserStyleSheetGLibType func() types.GType
+
+func UserStyleSheetGLibType() types.GType {
+	return xUserStyleSheetGLibType()
+}
+
+func (x *UserStyleSheet) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xNewUserStyleSheet func(string, UserContentInjectedFrames, UserStyleLevel, []string, []string) *UserStyleSheet
+
+// Creates a new user style sheet.
+//
+// Style sheets can be applied to some URIs only by passing non-null values for allow_list or block_list, see [NewUserScript].
+func NewUserStyleSheet(SourceVar string, InjectedFramesVar UserContentInjectedFrames, LevelVar UserStyleLevel, AllowListVar []string, BlockListVar []string) *UserStyleSheet {
+
+	cret := xNewUserStyleSheet(SourceVar, InjectedFramesVar, LevelVar, AllowListVar, BlockListVar)
+	return cret
+}
+
+var xUserStyleSheetRef func(uintptr) *UserStyleSheet
+
+// Atomically increments the reference count of user_style_sheet by one.
+//
+// This function is MT-safe and may be called from any thread.
+func (x *UserStyleSheet) Ref() *UserStyleSheet {
+
+	cret := xUserStyleSheetRef(x.GoPointer())
+	return cret
+}
+
+var xUserStyleSheetUnref func(uintptr)
+
+// Atomically decrements the reference count of user_style_sheet by one.
+//
+// If the reference count drops to 0, all memory allocated by [UserStyleSheet] is released. This function is MT-safe and may be called from any thread.
+func (x *UserStyleSheet) Unref() {
+
+	xUserStyleSheetUnref(x.GoPointer())
+
+}
+
+// Enum values used to denote the different events that happen during a [WebView] load operation.
+type LoadEvent int
+
+var xLoadEventGLibType func() types.GType
+
+func LoadEventGLibType() types.GType {
+	return xLoadEventGLibType()
+}
+
+const (
+
+	// A new load request has been made. No data has been received yet, empty structures have been allocated to perform the load; the load may still fail due to transport issues such as not being able to resolve a name, or connect to a port.
+	LoadStartedValue LoadEvent = 0
+	// A provisional data source received a server redirect.
+	LoadRedirectedValue LoadEvent = 1
+	// The content started arriving for a page load. The necessary transport requirements are established, and the load is being performed.
+	LoadCommittedValue LoadEvent = 2
+	// Load completed. All resources are done loading or there was an error during the load operation.
+	LoadFinishedValue LoadEvent = 3
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x LoadEvent) String() string {
+	switch x {
+	case LoadStartedValue:
+		return "WEBKIT_LOAD_STARTED"
+	case LoadRedirectedValue:
+		return "WEBKIT_LOAD_REDIRECTED"
+	case LoadCommittedValue:
+		return "WEBKIT_LOAD_COMMITTED"
+	case LoadFinishedValue:
+		return "WEBKIT_LOAD_FINISHED"
+	}
+	return fmt.Sprintf("LoadEvent(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x LoadEvent) Nick() string {
+	switch x {
+	case LoadStartedValue:
+		return "started"
+	case LoadRedirectedValue:
+		return "redirected"
+	case LoadCommittedValue:
+		return "committed"
+	case LoadFinishedValue:
+		return "finished"
+	}
+	return ""
+}
+
+// LoadEventFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func LoadEventFromString(s string) (LoadEvent, bool) {
+	switch s {
+	case "WEBKIT_LOAD_STARTED", "started":
+		return LoadStartedValue, true
+	case "WEBKIT_LOAD_REDIRECTED", "redirected":
+		return LoadRedirectedValue, true
+	case "WEBKIT_LOAD_COMMITTED", "committed":
+		return LoadCommittedValue, true
+	case "WEBKIT_LOAD_FINISHED", "finished":
+		return LoadFinishedValue, true
+	}
+	return 0, false
+}
+
+// Specifies in which frames user style sheets are to be inserted in.
+type UserContentInjectedFrames int
+
+var xUserContentInjectedFramesGLibType func() types.GType
+
+func UserContentInjectedFramesGLibType() types.GType {
+	return xUserContentInjectedFramesGLibType()
+}
+
+const (
+
+	// Insert the user style sheet in all the frames loaded by the web view, including nested frames.
+	UserContentInjectAllFramesValue UserContentInjectedFrames = 0
+	// Insert the user style sheet *only* in the top-level frame loaded by the web view, and *not* on the nested frames.
+	UserContentInjectTopFrameValue UserContentInjectedFrames = 1
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x UserContentInjectedFrames) String() string {
+	switch x {
+	case UserContentInjectAllFramesValue:
+		return "WEBKIT_USER_CONTENT_INJECT_ALL_FRAMES"
+	case UserContentInjectTopFrameValue:
+		return "WEBKIT_USER_CONTENT_INJECT_TOP_FRAME"
+	}
+	return fmt.Sprintf("UserContentInjectedFrames(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UserContentInjectedFrames) Nick() string {
+	switch x {
+	case UserContentInjectAllFramesValue:
+		return "all-frames"
+	case UserContentInjectTopFrameValue:
+		return "top-frame"
+	}
+	return ""
+}
+
+// UserContentInjectedFramesFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UserContentInjectedFramesFromString(s string) (UserContentInjectedFrames, bool) {
+	switch s {
+	case "WEBKIT_USER_CONTENT_INJECT_ALL_FRAMES", "all-frames":
+		return UserContentInjectAllFramesValue, true
+	case "WEBKIT_USER_CONTENT_INJECT_TOP_FRAME", "top-frame":
+		return UserContentInjectTopFrameValue, true
+	}
+	return 0, false
+}
+
+// Specifies at which place of documents an user script will be inserted.
+type UserScriptInjectionTime int
+
+var xUserScriptInjectionTimeGLibType func() types.GType
+
+func UserScriptInjectionTimeGLibType() types.GType {
+	return xUserScriptInjectionTimeGLibType()
+}
+
+const (
+
+	// Insert the code of the user script at the beginning of loaded documents. This is the default.
+	UserScriptInjectAtDocumentStartValue UserScriptInjectionTime = 0
+	// Insert the code of the user script at the end of the loaded documents.
+	UserScriptInjectAtDocumentEndValue UserScriptInjectionTime = 1
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x UserScriptInjectionTime) String() string {
+	switch x {
+	case UserScriptInjectAtDocumentStartValue:
+		return "WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_START"
+	case UserScriptInjectAtDocumentEndValue:
+		return "WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_END"
+	}
+	return fmt.Sprintf("UserScriptInjectionTime(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UserScriptInjectionTime) Nick() string {
+	switch x {
+	case UserScriptInjectAtDocumentStartValue:
+		return "start"
+	case UserScriptInjectAtDocumentEndValue:
+		return "end"
+	}
+	return ""
+}
+
+// UserScriptInjectionTimeFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UserScriptInjectionTimeFromString(s string) (UserScriptInjectionTime, bool) {
+	switch s {
+	case "WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_START", "start":
+		return UserScriptInjectAtDocumentStartValue, true
+	case "WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_END", "end":
+		return UserScriptInjectAtDocumentEndValue, true
+	}
+	return 0, false
+}
+
+// Specifies how to treat an user style sheet.
+type UserStyleLevel int
+
+var xUserStyleLevelGLibType func() types.GType
+
+func UserStyleLevelGLibType() types.GType {
+	return xUserStyleLevelGLibType()
+}
+
+const (
+
+	// The style sheet is an user style sheet, its contents always override other style sheets. This is the default.
+	UserStyleLevelUserValue UserStyleLevel = 0
+	// The style sheet will be treated as if it was provided by the loaded documents. That means other user style sheets may still override it.
+	UserStyleLevelAuthorValue UserStyleLevel = 1
+)
+
+// String returns the C name of the value, e.g. for debugging output
+func (x UserStyleLevel) String() string {
+	switch x {
+	case UserStyleLevelUserValue:
+		return "WEBKIT_USER_STYLE_LEVEL_USER"
+	case UserStyleLevelAuthorValue:
+		return "WEBKIT_USER_STYLE_LEVEL_AUTHOR"
+	}
+	return fmt.Sprintf("UserStyleLevel(%d)", int(x))
+}
+
+// Nick returns the short name of the value, which GSettings and GtkBuilder use, or an empty string for an unknown value
+func (x UserStyleLevel) Nick() string {
+	switch x {
+	case UserStyleLevelUserValue:
+		return "user"
+	case UserStyleLevelAuthorValue:
+		return "author"
+	}
+	return ""
+}
+
+// UserStyleLevelFromString returns the value with the C name or the nick s, e.g. to read it from settings
+func UserStyleLevelFromString(s string) (UserStyleLevel, bool) {
+	switch s {
+	case "WEBKIT_USER_STYLE_LEVEL_USER", "user":
+		return UserStyleLevelUserValue, true
+	case "WEBKIT_USER_STYLE_LEVEL_AUTHOR", "author":
+		return UserStyleLevelAuthorValue, true
+	}
+	return 0, false
+}
+
+// Control the behaviour of a [WebView].
+//
+// [Settings] can be applied to a [WebView] to control text charset, color, font sizes, printing mode, script support, loading of images and various other things on a [WebView].
+type Settings struct {
+	gobject.Object
+}
+
+var xSettingsGLibType func() types.GType
+
+func SettingsGLibType() types.GType {
+	return xSettingsGLibType()
+}
+
+func SettingsNewFromInternalPtr(ptr uintptr) *Settings {
+	cls := &Settings{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewSettings func() uintptr
+
+// Creates a new [Settings] instance with default values.
+//
+// It must be manually attached to a [WebView]. See also webkit_settings_new_with_settings().
+func NewSettings() *Settings {
+	var cls *Settings
+
+	cret := xNewSettings()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Settings{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xSettingsGetJavascriptCanAccessClipboard func(uintptr) bool
+
+// Get the [Settings.GetPropertyJavascriptCanAccessClipboard] property.
+func (x *Settings) GetJavascriptCanAccessClipboard() bool {
+
+	cret := xSettingsGetJavascriptCanAccessClipboard(x.GoPointer())
+	return cret
+}
+
+var xSettingsSetJavascriptCanAccessClipboard func(uintptr, bool)
+
+// Set the [Settings.GetPropertyJavascriptCanAccessClipboard] property.
+func (x *Settings) SetJavascriptCanAccessClipboard(JavascriptCanAccessClipboardVar bool) {
+
+	xSettingsSetJavascriptCanAccessClipboard(x.GoPointer(), JavascriptCanAccessClipboardVar)
+
+}
+
+var xSettingsGetEnableDeveloperExtras func(uintptr) bool
+
+// Get the [Settings.GetPropertyEnableDeveloperExtras] property.
+func (x *Settings) GetEnableDeveloperExtras() bool {
+
+	cret := xSettingsGetEnableDeveloperExtras(x.GoPointer())
+	return cret
+}
+
+var xSettingsSetEnableDeveloperExtras func(uintptr, bool)
+
+// Set the [Settings.GetPropertyEnableDeveloperExtras] property.
+func (x *Settings) SetEnableDeveloperExtras(EnableDeveloperExtrasVar bool) {
+
+	xSettingsSetEnableDeveloperExtras(x.GoPointer(), EnableDeveloperExtrasVar)
+
+}
+
+var xSettingsGetEnableJavascript func(uintptr) bool
+
+// Get the [Settings.GetPropertyEnableJavascript] property.
+func (x *Settings) GetEnableJavascript() bool {
+
+	cret := xSettingsGetEnableJavascript(x.GoPointer())
+	return cret
+}
+
+var xSettingsSetEnableJavascript func(uintptr, bool)
+
+// Set the [Settings.GetPropertyEnableJavascript] property.
+func (x *Settings) SetEnableJavascript(EnableJavascriptVar bool) {
+
+	xSettingsSetEnableJavascript(x.GoPointer(), EnableJavascriptVar)
+
+}
+
+var xSettingsGetUserAgent func(uintptr) string
+
+// Get the [Settings.GetPropertyUserAgent] property.
+func (x *Settings) GetUserAgent() string {
+
+	cret := xSettingsGetUserAgent(x.GoPointer())
+	return cret
+}
+
+var xSettingsSetUserAgent func(uintptr, uintptr)
+
+// Set the [Settings.GetPropertyUserAgent] property.
+func (x *Settings) SetUserAgent(UserAgentVar *string) {
+
+	UserAgentVarPtr := core.GStrdupNullable(UserAgentVar)
+	defer core.GFreeNullable(UserAgentVarPtr)
+
+	xSettingsSetUserAgent(x.GoPointer(), UserAgentVarPtr)
+
+}
+
+var xSettingsSetUserAgentWithApplicationDetails func(uintptr, uintptr, uintptr)
+
+// Set the [Settings.GetPropertyUserAgent] property by appending the application details.
+//
+// Set the [Settings.GetPropertyUserAgent] property by appending the application details to the default user agent. If no application name or version is given, the default user agent used will be used. If only the version is given, the default engine version is used with the given application name.
+func (x *Settings) SetUserAgentWithApplicationDetails(ApplicationNameVar *string, ApplicationVersionVar *string) {
+
+	ApplicationNameVarPtr := core.GStrdupNullable(ApplicationNameVar)
+	defer core.GFreeNullable(ApplicationNameVarPtr)
+
+	ApplicationVersionVarPtr := core.GStrdupNullable(ApplicationVersionVar)
+	defer core.GFreeNullable(ApplicationVersionVarPtr)
+
+	xSettingsSetUserAgentWithApplicationDetails(x.GoPointer(), ApplicationNameVarPtr, ApplicationVersionVarPtr)
+
+}
+
+func (c *Settings) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Settings) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// SetPropertyJavascriptCanAccessClipboard sets the "javascript-can-access-clipboard" property.
+// Whether JavaScript can access the clipboard.
+func (x *Settings) SetPropertyJavascriptCanAccessClipboard(value bool) {
+	var v gobject.Value
+	v.Init(gobject.TypeBooleanVal)
+	v.SetBoolean(value)
+	x.SetProperty("javascript-can-access-clipboard", &v)
+}
+
+// GetPropertyJavascriptCanAccessClipboard gets the "javascript-can-access-clipboard" property.
+// Whether JavaScript can access the clipboard.
+func (x *Settings) GetPropertyJavascriptCanAccessClipboard() bool {
+	var v gobject.Value
+	x.GetProperty("javascript-can-access-clipboard", &v)
+	return v.GetBoolean()
+}
+
+// SetPropertyEnableDeveloperExtras sets the "enable-developer-extras" property.
+// Whether the developer extensions should be enabled, e.g. the web inspector.
+func (x *Settings) SetPropertyEnableDeveloperExtras(value bool) {
+	var v gobject.Value
+	v.Init(gobject.TypeBooleanVal)
+	v.SetBoolean(value)
+	x.SetProperty("enable-developer-extras", &v)
+}
+
+// GetPropertyEnableDeveloperExtras gets the "enable-developer-extras" property.
+// Whether the developer extensions should be enabled, e.g. the web inspector.
+func (x *Settings) GetPropertyEnableDeveloperExtras() bool {
+	var v gobject.Value
+	x.GetProperty("enable-developer-extras", &v)
+	return v.GetBoolean()
+}
+
+// SetPropertyEnableJavascript sets the "enable-javascript" property.
+// Determines whether or not JavaScript executes within a page.
+func (x *Settings) SetPropertyEnableJavascript(value bool) {
+	var v gobject.Value
+	v.Init(gobject.TypeBooleanVal)
+	v.SetBoolean(value)
+	x.SetProperty("enable-javascript", &v)
+}
+
+// GetPropertyEnableJavascript gets the "enable-javascript" property.
+// Determines whether or not JavaScript executes within a page.
+func (x *Settings) GetPropertyEnableJavascript() bool {
+	var v gobject.Value
+	x.GetProperty("enable-javascript", &v)
+	return v.GetBoolean()
+}
+
+// SetPropertyUserAgent sets the "user-agent" property.
+// The user-agent string used by WebKit.
+func (x *Settings) SetPropertyUserAgent(value string) {
+	var v gobject.Value
+	v.Init(gobject.TypeStringVal)
+	v.SetString(&value)
+	x.SetProperty("user-agent", &v)
+}
+
+// GetPropertyUserAgent gets the "user-agent" property.
+// The user-agent string used by WebKit.
+func (x *Settings) GetPropertyUserAgent() string {
+	var v gobject.Value
+	x.GetProperty("user-agent", &v)
+	return v.GetString()
+}
+
+// Manages user-defined content which affects web pages.
+//
+// Using a [UserContentManager] user CSS style sheets can be set to be injected in the web pages loaded by a [WebView], by [UserContentManager.AddStyleSheet].
+//
+// To use a [UserContentManager], it must be created using [NewUserContentManager], and then used to construct a [WebView]. User style sheets can be created with [NewUserStyleSheet].
+//
+// User style sheets can be added and removed at any time, but they will affect the web pages loaded afterwards.
+type UserContentManager struct {
+	gobject.Object
+}
+
+var xUserContentManagerGLibType func() types.GType
+
+func UserContentManagerGLibType() types.GType {
+	return xUserContentManagerGLibType()
+}
+
+func UserContentManagerNewFromInternalPtr(ptr uintptr) *UserContentManager {
+	cls := &UserContentManager{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewUserContentManager func() uintptr
+
+// Creates a new user content manager.
+func NewUserContentManager() *UserContentManager {
+	var cls *UserContentManager
+
+	cret := xNewUserContentManager()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &UserContentManager{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xUserContentManagerAddScript func(uintptr, *UserScript)
+
+// Adds a [UserScript] to the given [UserContentManager].
+//
+// The same [UserScript] can be reused with multiple [UserContentManager] instances.
+func (x *UserContentManager) AddScript(ScriptVar *UserScript) {
+
+	xUserContentManagerAddScript(x.GoPointer(), ScriptVar)
+
+}
+
+var xUserContentManagerAddStyleSheet func(uintptr, *UserStyleSheet)
+
+// Adds a [UserStyleSheet] to the given [UserContentManager].
+//
+// The same [UserStyleSheet] can be reused with multiple [UserContentManager] instances.
+func (x *UserContentManager) AddStyleSheet(StylesheetVar *UserStyleSheet) {
+
+	xUserContentManagerAddStyleSheet(x.GoPointer(), StylesheetVar)
+
+}
+
+var xUserContentManagerRegisterScriptMessageHandler func(uintptr, string, uintptr) bool
+
+// Registers a new user script message handler in script world.
+//
+// After it is registered, scripts can use `window.webkit.messageHandlers.<name>.postMessage(value)` to send messages. Those messages are received by connecting handlers to the [UserContentManager.ConnectScriptMessageReceivedFunc] signal. The handler name is used as the detail of the signal. To avoid race conditions between registering the handler name, and starting to receive the signals, it is recommended to connect to the signal *before* registering the handler name.
+//
+// If nil is passed as the world_name, the default world will be used.
+func (x *UserContentManager) RegisterScriptMessageHandler(NameVar string, WorldNameVar *string) bool {
+
+	WorldNameVarPtr := core.GStrdupNullable(WorldNameVar)
+	defer core.GFreeNullable(WorldNameVarPtr)
+
+	cret := xUserContentManagerRegisterScriptMessageHandler(x.GoPointer(), NameVar, WorldNameVarPtr)
+	return cret
+}
+
+var xUserContentManagerRemoveAllScripts func(uintptr)
+
+// Removes all user scripts from the given [UserContentManager]
+func (x *UserContentManager) RemoveAllScripts() {
+
+	xUserContentManagerRemoveAllScripts(x.GoPointer())
+
+}
+
+var xUserContentManagerRemoveAllStyleSheets func(uintptr)
+
+// Removes all user style sheets from the given [UserContentManager].
+func (x *UserContentManager) RemoveAllStyleSheets() {
+
+	xUserContentManagerRemoveAllStyleSheets(x.GoPointer())
+
+}
+
+var xUserContentManagerUnregisterScriptMessageHandler func(uintptr, string, uintptr)
+
+// Unregisters a previously registered message handler in script world with name world_name.
+//
+// Note that this does *not* disconnect handlers for the [UserContentManager.ConnectScriptMessageReceivedFunc] signal; they will be kept connected, but the signal will not be emitted unless the handler name is registered again.
+//
+// If nil is passed as the world_name, the default world will be used.
+func (x *UserContentManager) UnregisterScriptMessageHandler(NameVar string, WorldNameVar *string) {
+
+	WorldNameVarPtr := core.GStrdupNullable(WorldNameVar)
+	defer core.GFreeNullable(WorldNameVarPtr)
+
+	xUserContentManagerUnregisterScriptMessageHandler(x.GoPointer(), NameVar, WorldNameVarPtr)
+
+}
+
+func (c *UserContentManager) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *UserContentManager) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// This signal is emitted when JavaScript in a web view calls window.webkit.messageHandlers.&lt;name&gt;.postMessage(), after registering &lt;name&gt; using [UserContentManager.RegisterScriptMessageHandler]
+//
+// Deprecated: use ConnectScriptMessageReceivedFunc, which also accepts method values and closures.
+func (x *UserContentManager) ConnectScriptMessageReceived(cb *func(UserContentManager, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := UserContentManager{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "script-message-received", cbRefPtr, cbPtr)
+}
+
+// ConnectScriptMessageReceivedWithDetail connects to the "script-message-received" signal with a detail string.
+// The detail is appended as "script-message-received::<detail>".
+//
+// Deprecated: use ConnectScriptMessageReceivedWithDetailFunc, which also accepts method values and closures.
+func (x *UserContentManager) ConnectScriptMessageReceivedWithDetail(detail string, cb *func(UserContentManager, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	signalName := fmt.Sprintf("script-message-received::%s", detail)
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, ValueVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := UserContentManager{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, ValueVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), signalName, cbRefPtr, cbPtr)
+}
+
+var xUserContentManagerScriptMessageReceivedTrampoline uintptr
+
+func xUserContentManagerScriptMessageReceivedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, ValueVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(UserContentManager, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := UserContentManager{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, ValueVarp)
+
+	}
+}
+
+// ConnectScriptMessageReceivedFunc connects cb to the "script-message-received" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *UserContentManager) ConnectScriptMessageReceivedFunc(cb func(UserContentManager, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "script-message-received", &xUserContentManagerScriptMessageReceivedTrampoline, xUserContentManagerScriptMessageReceivedNewTrampoline, cb)
+}
+
+// ConnectScriptMessageReceivedWithDetailFunc connects cb to the "script-message-received" signal with a detail string, see ConnectScriptMessageReceivedFunc.
+// The detail is appended as "script-message-received::<detail>".
+func (x *UserContentManager) ConnectScriptMessageReceivedWithDetailFunc(detail string, cb func(UserContentManager, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), fmt.Sprintf("script-message-received::%s", detail), &xUserContentManagerScriptMessageReceivedTrampoline, xUserContentManagerScriptMessageReceivedNewTrampoline, cb)
+}
+
+// The central class of the WPE WebKit and WebKitGTK APIs.
+//
+// [WebView] is the central class of the WPE WebKit and WebKitGTK APIs. It is responsible for managing the drawing of the content and forwarding of events. You can load any URI into the [WebView] or a data string. With [Settings] you can control various aspects of the rendering and loading of the content.
+type WebView struct {
+	WebViewBase
+}
+
+var xWebViewGLibType func() types.GType
+
+func WebViewGLibType() types.GType {
+	return xWebViewGLibType()
+}
+
+func WebViewNewFromInternalPtr(ptr uintptr) *WebView {
+	cls := &WebView{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewWebView func() uintptr
+
+// Creates a new [WebView] with the default WebKitWebContext.
+//
+// Creates a new [WebView] with the default WebKitWebContext and no [UserContentManager] associated with it. See also webkit_web_view_new_with_context(), webkit_web_view_new_with_user_content_manager(), and webkit_web_view_new_with_settings().
+func NewWebView() *WebView {
+	var cls *WebView
+
+	cret := xNewWebView()
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &WebView{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xWebViewCanGoBack func(uintptr) bool
+
+// Determines whether web_view has a previous history item.
+func (x *WebView) CanGoBack() bool {
+
+	cret := xWebViewCanGoBack(x.GoPointer())
+	return cret
+}
+
+var xWebViewCanGoForward func(uintptr) bool
+
+// Determines whether web_view has a next history item.
+func (x *WebView) CanGoForward() bool {
+
+	cret := xWebViewCanGoForward(x.GoPointer())
+	return cret
+}
+
+var xWebViewEvaluateJavascript func(uintptr, string, int, uintptr, uintptr, uintptr, uintptr, uintptr)
+
+// Asynchronously evaluate script in the script world with name world_name of the main frame current context in web_view. If world_name is nil, the default world is used. Any value that is not nil is a distinct world. The source_uri will be shown in exceptions and doesn't affect the behavior of the script. When not provided, the document URL is used.
+//
+// Note that if [Settings.GetPropertyEnableJavascript] is false, this method will do nothing. If you want to use this method but still prevent web content from executing its own JavaScript, then use WebKitSettings:enable-javascript-markup.
+//
+// When the operation is finished, callback will be called. You can then call [WebView.EvaluateJavascriptFinish] to get the result of the operation.
+func (x *WebView) EvaluateJavascript(ScriptVar string, LengthVar int, WorldNameVar *string, SourceUriVar *string, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	WorldNameVarPtr := core.GStrdupNullable(WorldNameVar)
+	defer core.GFreeNullable(WorldNameVarPtr)
+
+	SourceUriVarPtr := core.GStrdupNullable(SourceUriVar)
+	defer core.GFreeNullable(SourceUriVarPtr)
+
+	xWebViewEvaluateJavascript(x.GoPointer(), ScriptVar, LengthVar, WorldNameVarPtr, SourceUriVarPtr, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
+
+}
+
+var xWebViewEvaluateJavascriptFinish func(uintptr, uintptr, **glib.Error) uintptr
+
+// Finish an asynchronous operation started with [WebView.EvaluateJavascript].
+func (x *WebView) EvaluateJavascriptFinish(ResultVar gio.AsyncResult) (*javascriptcore.Value, error) {
+	var cls *javascriptcore.Value
+	var cerr *glib.Error
+
+	cret := xWebViewEvaluateJavascriptFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+
+	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
+		return nil, cerr
+	}
+	cls = &javascriptcore.Value{}
+	cls.Ptr = cret
+	if cerr == nil {
+		return cls, core.PlatformError
+	}
+	return cls, cerr
+
+}
+
+var xWebViewGetEstimatedLoadProgress func(uintptr) float64
+
+// Gets the value of the [WebView.GetPropertyEstimatedLoadProgress] property.
+//
+// You can monitor the estimated progress of a load operation by connecting to the notify::estimated-load-progress signal of web_view.
+func (x *WebView) GetEstimatedLoadProgress() float64 {
+
+	cret := xWebViewGetEstimatedLoadProgress(x.GoPointer())
+	return cret
+}
+
+var xWebViewGetSettings func(uintptr) uintptr
+
+// Gets the [Settings] currently applied to web_view.
+//
+// If no other [Settings] have been explicitly applied to web_view with [WebView.SetSettings], the default [Settings] will be returned. This method always returns a valid [Settings] object. To modify any of the web_view settings, you can either create a new [Settings] object with [NewSettings], setting the desired preferences, and then replace the existing web_view settings with [WebView.SetSettings] or get the existing web_view settings and update it directly. [Settings] objects can be shared by multiple [WebView]<!-- -->s, so modifying the settings of a [WebView] would affect other [WebView]<!-- -->s using the same [Settings].
+func (x *WebView) GetSettings() *Settings {
+	var cls *Settings
+
+	cret := xWebViewGetSettings(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &Settings{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xWebViewGetTitle func(uintptr) string
+
+// Gets the value of the [WebView.GetPropertyTitle] property.
+//
+// You can connect to notify::title signal of web_view to be notified when the title has been received.
+func (x *WebView) GetTitle() string {
+
+	cret := xWebViewGetTitle(x.GoPointer())
+	return cret
+}
+
+var xWebViewGetUri func(uintptr) string
+
+// Returns the current active URI of web_view.
+//
+// The active URI might change during a load operation. You can monitor the active URI by connecting to the notify::uri signal of web_view.
+func (x *WebView) GetUri() string {
+
+	cret := xWebViewGetUri(x.GoPointer())
+	return cret
+}
+
+var xWebViewGetUserContentManager func(uintptr) uintptr
+
+// Gets the user content manager associated to web_view.
+func (x *WebView) GetUserContentManager() *UserContentManager {
+	var cls *UserContentManager
+
+	cret := xWebViewGetUserContentManager(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	gobject.IncreaseRef(cret)
+	cls = &UserContentManager{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xWebViewGetZoomLevel func(uintptr) float64
+
+// Set the zoom level of web_view.
+//
+// Get the zoom level of web_view, i.e. the factor by which the view contents are scaled with respect to their original size.
+func (x *WebView) GetZoomLevel() float64 {
+
+	cret := xWebViewGetZoomLevel(x.GoPointer())
+	return cret
+}
+
+var xWebViewGoBack func(uintptr)
+
+// Loads the previous history item.
+//
+// You can monitor the load operation by connecting to [WebView.ConnectLoadChangedFunc] signal.
+func (x *WebView) GoBack() {
+
+	xWebViewGoBack(x.GoPointer())
+
+}
+
+var xWebViewGoForward func(uintptr)
+
+// Loads the next history item.
+//
+// You can monitor the load operation by connecting to [WebView.ConnectLoadChangedFunc] signal.
+func (x *WebView) GoForward() {
+
+	xWebViewGoForward(x.GoPointer())
+
+}
+
+var xWebViewIsLoading func(uintptr) bool
+
+// Gets the value of the [WebView.GetPropertyIsLoading] property.
+//
+// You can monitor when a [WebView] is loading a page by connecting to notify::is-loading signal of web_view. This is useful when you are interesting in knowing when the view is loading something but not in the details about the status of the load operation, for example to start a spinner when the view is loading a page and stop it when it finishes.
+func (x *WebView) IsLoading() bool {
+
+	cret := xWebViewIsLoading(x.GoPointer())
+	return cret
+}
+
+var xWebViewLoadHtml func(uintptr, string, uintptr)
+
+// Load the given content string with the specified base_uri.
+//
+// If base_uri is not nil, relative URLs in the content will be resolved against base_uri and absolute local paths must be children of the base_uri. For security reasons absolute local paths that are not children of base_uri will cause the web process to terminate. If you need to include URLs in content that are local paths in a different directory than base_uri you can build a data URI for them. When base_uri is nil, it defaults to "about:blank". The mime type of the document will be "text/html". You can monitor the load operation by connecting to [WebView.ConnectLoadChangedFunc] signal.
+func (x *WebView) LoadHtml(ContentVar string, BaseUriVar *string) {
+
+	BaseUriVarPtr := core.GStrdupNullable(BaseUriVar)
+	defer core.GFreeNullable(BaseUriVarPtr)
+
+	xWebViewLoadHtml(x.GoPointer(), ContentVar, BaseUriVarPtr)
+
+}
+
+var xWebViewLoadPlainText func(uintptr, string)
+
+// Load the specified plain_text string into web_view.
+//
+// The mime type of document will be "text/plain". You can monitor the load operation by connecting to [WebView.ConnectLoadChangedFunc] signal.
+func (x *WebView) LoadPlainText(PlainTextVar string) {
+
+	xWebViewLoadPlainText(x.GoPointer(), PlainTextVar)
+
+}
+
+var xWebViewLoadUri func(uintptr, string)
+
+// Requests loading of the specified URI string.
+//
+// You can monitor the load operation by connecting to [WebView.ConnectLoadChangedFunc] signal.
+func (x *WebView) LoadUri(UriVar string) {
+
+	xWebViewLoadUri(x.GoPointer(), UriVar)
+
+}
+
+var xWebViewReload func(uintptr)
+
+// Reloads the current contents of web_view.
+//
+// See also webkit_web_view_reload_bypass_cache().
+func (x *WebView) Reload() {
+
+	xWebViewReload(x.GoPointer())
+
+}
+
+var xWebViewSetSettings func(uintptr, uintptr)
+
+// Sets the [Settings] to be applied to web_view.
+//
+// The existing [Settings] of web_view will be replaced by settings. New settings are applied immediately on web_view. The same [Settings] object can be shared by multiple [WebView]<!-- -->s.
+func (x *WebView) SetSettings(SettingsVar *Settings) {
+
+	xWebViewSetSettings(x.GoPointer(), SettingsVar.GoPointer())
+
+}
+
+var xWebViewSetZoomLevel func(uintptr, float64)
+
+// Set the zoom level of web_view.
+//
+// Set the zoom level of web_view, i.e. the factor by which the view contents are scaled with respect to their original size.
+func (x *WebView) SetZoomLevel(ZoomLevelVar float64) {
+
+	xWebViewSetZoomLevel(x.GoPointer(), ZoomLevelVar)
+
+}
+
+var xWebViewStopLoading func(uintptr)
+
+// Stops any ongoing loading operation in web_view.
+//
+// This method does nothing if no content is being loaded. If there is a loading operation in progress, it will be cancelled and [WebView.ConnectLoadFailedFunc] signal will be emitted with WEBKIT_NETWORK_ERROR_CANCELLED error.
+func (x *WebView) StopLoading() {
+
+	xWebViewStopLoading(x.GoPointer())
+
+}
+
+func (c *WebView) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *WebView) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// GetPropertyEstimatedLoadProgress gets the "estimated-load-progress" property.
+// An estimate of the percent completion for the current loading operation.
+func (x *WebView) GetPropertyEstimatedLoadProgress() float64 {
+	var v gobject.Value
+	x.GetProperty("estimated-load-progress", &v)
+	return v.GetDouble()
+}
+
+// GetPropertyIsLoading gets the "is-loading" property.
+// Whether the [WebView] is currently loading a page.
+func (x *WebView) GetPropertyIsLoading() bool {
+	var v gobject.Value
+	x.GetProperty("is-loading", &v)
+	return v.GetBoolean()
+}
+
+// GetPropertyTitle gets the "title" property.
+// The main frame document title of this [WebView].
+func (x *WebView) GetPropertyTitle() string {
+	var v gobject.Value
+	x.GetProperty("title", &v)
+	return v.GetString()
+}
+
+// GetPropertyUri gets the "uri" property.
+// The current active URI of the [WebView].
+func (x *WebView) GetPropertyUri() string {
+	var v gobject.Value
+	x.GetProperty("uri", &v)
+	return v.GetString()
+}
+
+// SetPropertyZoomLevel sets the "zoom-level" property.
+// The zoom level of the [WebView] content.
+func (x *WebView) SetPropertyZoomLevel(value float64) {
+	var v gobject.Value
+	v.Init(gobject.TypeDoubleVal)
+	v.SetDouble(value)
+	x.SetProperty("zoom-level", &v)
+}
+
+// GetPropertyZoomLevel gets the "zoom-level" property.
+// The zoom level of the [WebView] content.
+func (x *WebView) GetPropertyZoomLevel() float64 {
+	var v gobject.Value
+	x.GetProperty("zoom-level", &v)
+	return v.GetDouble()
+}
+
+// Emitted when closing a [WebView] is requested.
+//
+// This occurs when a call is made from JavaScript's <function>window.close</function> function or after trying to close the web_view with webkit_web_view_try_close(). It is the owner's responsibility to handle this signal to hide or destroy the [WebView], if necessary.
+//
+// Deprecated: use ConnectCloseFunc, which also accepts method values and closures.
+func (x *WebView) ConnectClose(cb *func(WebView)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr) {
+			defer core.RecoverPanic()
+			fa := WebView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "close", cbRefPtr, cbPtr)
+}
+
+var xWebViewCloseTrampoline uintptr
+
+func xWebViewCloseNewTrampoline() interface{} {
+	return func(clsPtr uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(WebView))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := WebView{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa)
+
+	}
+}
+
+// ConnectCloseFunc connects cb to the "close" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *WebView) ConnectCloseFunc(cb func(WebView)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "close", &xWebViewCloseTrampoline, xWebViewCloseNewTrampoline, cb)
+}
+
+// Emitted when a load operation in web_view changes.
+//
+// The signal is always emitted with [LoadStartedValue] when a new load request is made and [LoadFinishedValue] when the load finishes successfully or due to an error. When the ongoing load operation fails [WebView.ConnectLoadFailedFunc] signal is emitted before [WebView.ConnectLoadChangedFunc] is emitted with [LoadFinishedValue].
+//
+// Deprecated: use ConnectLoadChangedFunc, which also accepts method values and closures.
+func (x *WebView) ConnectLoadChanged(cb *func(WebView, LoadEvent)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LoadEventVarp LoadEvent) {
+			defer core.RecoverPanic()
+			fa := WebView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, LoadEventVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "load-changed", cbRefPtr, cbPtr)
+}
+
+var xWebViewLoadChangedTrampoline uintptr
+
+func xWebViewLoadChangedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LoadEventVarp LoadEvent, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(WebView, LoadEvent))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := WebView{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, LoadEventVarp)
+
+	}
+}
+
+// ConnectLoadChangedFunc connects cb to the "load-changed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *WebView) ConnectLoadChangedFunc(cb func(WebView, LoadEvent)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "load-changed", &xWebViewLoadChangedTrampoline, xWebViewLoadChangedNewTrampoline, cb)
+}
+
+// Emitted when an error occurs during a load operation.
+//
+// If the error happened when starting to load data for a page load_event will be [LoadStartedValue]. If it happened while loading a committed data source load_event will be [LoadCommittedValue]. Since a load error causes the load operation to finish, the signal WebKitWebView::load-changed will always be emitted with [LoadFinishedValue] event right after this one.
+//
+// By default, if the signal is not handled, a stock error page will be displayed. You need to handle the signal if you want to provide your own error page.
+//
+// Deprecated: use ConnectLoadFailedFunc, which also accepts method values and closures.
+func (x *WebView) ConnectLoadFailed(cb *func(WebView, LoadEvent, string, uintptr) bool) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, LoadEventVarp LoadEvent, FailingUriVarp string, ErrorVarp uintptr) bool {
+			defer core.RecoverPanic()
+			fa := WebView{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			return cbFn(fa, LoadEventVarp, FailingUriVarp, ErrorVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "load-failed", cbRefPtr, cbPtr)
+}
+
+var xWebViewLoadFailedTrampoline uintptr
+
+func xWebViewLoadFailedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, LoadEventVarp LoadEvent, FailingUriVarp string, ErrorVarp uintptr, data uintptr) (ret bool) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(WebView, LoadEvent, string, uintptr) bool)
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := WebView{}
+		fa.Ptr = clsPtr
+
+		return cbFn(fa, LoadEventVarp, FailingUriVarp, ErrorVarp)
+
+	}
+}
+
+// ConnectLoadFailedFunc connects cb to the "load-failed" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *WebView) ConnectLoadFailedFunc(cb func(WebView, LoadEvent, string, uintptr) bool) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "load-failed", &xWebViewLoadFailedTrampoline, xWebViewLoadFailedNewTrampoline, cb)
+}
+
+// Requests the user's screen reader to announce the given message.
+//
+// This kind of notification is useful for messages that
+// either have only a visual representation or that are not
+// exposed visually at all, e.g. a notification about a
+// successful operation.
+//
+// Also, by using this API, you can ensure that the message
+// does not interrupts the user's current screen reader output.
+func (x *WebView) Announce(MessageVar string, PriorityVar gtk.AccessibleAnnouncementPriority) {
+
+	gtk.XGtkAccessibleAnnounce(x.GoPointer(), MessageVar, PriorityVar)
+
+}
+
+// Retrieves the accessible parent for an accessible object.
+//
+// This function returns `NULL` for top level widgets.
+func (x *WebView) GetAccessibleParent() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetAccessibleParent(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Retrieves the accessible role of an accessible object.
+func (x *WebView) GetAccessibleRole() gtk.AccessibleRole {
+
+	cret := gtk.XGtkAccessibleGetAccessibleRole(x.GoPointer())
+	return cret
+}
+
+// Retrieves the implementation for the given accessible object.
+func (x *WebView) GetAtContext() *gtk.ATContext {
+	var cls *gtk.ATContext
+
+	cret := gtk.XGtkAccessibleGetAtContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.ATContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Queries the coordinates and dimensions of this accessible
+//
+// This functionality can be overridden by `GtkAccessible`
+// implementations, e.g. to get the bounds from an ignored
+// child widget.
+func (x *WebView) GetBounds(XVar *int, YVar *int, WidthVar *int, HeightVar *int) bool {
+
+	cret := gtk.XGtkAccessibleGetBounds(x.GoPointer(), XVar, YVar, WidthVar, HeightVar)
+	return cret
+}
+
+// Retrieves the first accessible child of an accessible object.
+func (x *WebView) GetFirstAccessibleChild() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetFirstAccessibleChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Retrieves the next accessible sibling of an accessible object
+func (x *WebView) GetNextAccessibleSibling() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetNextAccessibleSibling(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Queries a platform state, such as focus.
+//
+// This functionality can be overridden by `GtkAccessible`
+// implementations, e.g. to get platform state from an ignored
+// child widget, as is the case for `GtkText` wrappers.
+func (x *WebView) GetPlatformState(StateVar gtk.AccessiblePlatformState) bool {
+
+	cret := gtk.XGtkAccessibleGetPlatformState(x.GoPointer(), StateVar)
+	return cret
+}
+
+// Resets the accessible property to its default value.
+func (x *WebView) ResetProperty(PropertyVar gtk.AccessibleProperty) {
+
+	gtk.XGtkAccessibleResetProperty(x.GoPointer(), PropertyVar)
+
+}
+
+// Resets the accessible relation to its default value.
+func (x *WebView) ResetRelation(RelationVar gtk.AccessibleRelation) {
+
+	gtk.XGtkAccessibleResetRelation(x.GoPointer(), RelationVar)
+
+}
+
+// Resets the accessible state to its default value.
+func (x *WebView) ResetState(StateVar gtk.AccessibleState) {
+
+	gtk.XGtkAccessibleResetState(x.GoPointer(), StateVar)
+
+}
+
+// Sets the parent and sibling of an accessible object.
+//
+// This function is meant to be used by accessible implementations that are
+// not part of the widget hierarchy, and but act as a logical bridge between
+// widgets. For instance, if a widget creates an object that holds metadata
+// for each child, and you want that object to implement the `GtkAccessible`
+// interface, you will use this function to ensure that the parent of each
+// child widget is the metadata object, and the parent of each metadata
+// object is the container widget.
+func (x *WebView) SetAccessibleParent(ParentVar gtk.Accessible, NextSiblingVar gtk.Accessible) {
+
+	gtk.XGtkAccessibleSetAccessibleParent(x.GoPointer(), ParentVar.GoPointer(), NextSiblingVar.GoPointer())
+
+}
+
+// Updates the next accessible sibling.
+//
+// That might be useful when a new child of a custom accessible
+// is created, and it needs to be linked to a previous child.
+func (x *WebView) UpdateNextAccessibleSibling(NewSiblingVar gtk.Accessible) {
+
+	gtk.XGtkAccessibleUpdateNextAccessibleSibling(x.GoPointer(), NewSiblingVar.GoPointer())
+
+}
+
+// Informs ATs that the platform state has changed.
+//
+// This function should be used by `GtkAccessible` implementations that
+// have a platform state but are not widgets. Widgets handle platform
+// states automatically.
+func (x *WebView) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
+
+	gtk.XGtkAccessibleUpdatePlatformState(x.GoPointer(), StateVar)
+
+}
+
+// Updates a list of accessible properties.
+//
+// See the [gtk.AccessibleProperty] documentation for the
+// value types of accessible properties.
+//
+// This function should be called by `GtkWidget` types whenever
+// an accessible property change must be communicated to assistive
+// technologies.
+//
+// Example:
+//
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
+//
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
+func (x *WebView) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
+
+}
+
+// Updates an array of accessible properties.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// property change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebView) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.AccessibleProperty, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdatePropertyValue(x.GoPointer(), NPropertiesVar, PropertiesVar, ValuesVar)
+
+}
+
+// Updates a list of accessible relations.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// relation change must be communicated to assistive technologies.
+//
+// If the [gtk.AccessibleRelation] requires a list of references,
+// you should pass each reference individually, followed by `NULL`, e.g.
+//
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
+//
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
+func (x *WebView) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
+
+}
+
+// Updates an array of accessible relations.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// relation change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebView) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.AccessibleRelation, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdateRelationValue(x.GoPointer(), NRelationsVar, RelationsVar, ValuesVar)
+
+}
+
+// Updates a list of accessible states.
+//
+// See the [gtk.AccessibleState] documentation for the
+// value types of accessible states.
+//
+// This function should be called by `GtkWidget` types whenever
+// an accessible state change must be communicated to assistive
+// technologies.
+//
+// Example:
+//
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
+//
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
+func (x *WebView) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
+
+}
+
+// Updates an array of accessible states.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// state change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebView) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleState, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdateStateValue(x.GoPointer(), NStatesVar, StatesVar, ValuesVar)
+
+}
+
+// Gets the ID of the buildable object.
+//
+// `GtkBuilder` sets the name based on the ID attribute
+// of the `<object>` tag used to construct the buildable.
+func (x *WebView) GetBuildableId() string {
+
+	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
+	return cret
+}
+
+// Internal base class.
+type WebViewBase struct {
+	gtk.Widget
+}
+
+var xWebViewBaseGLibType func() types.GType
+
+func WebViewBaseGLibType() types.GType {
+	return xWebViewBaseGLibType()
+}
+
+func WebViewBaseNewFromInternalPtr(ptr uintptr) *WebViewBase {
+	cls := &WebViewBase{}
+	cls.Ptr = ptr
+	return cls
+}
+
+func (c *WebViewBase) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *WebViewBase) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// Requests the user's screen reader to announce the given message.
+//
+// This kind of notification is useful for messages that
+// either have only a visual representation or that are not
+// exposed visually at all, e.g. a notification about a
+// successful operation.
+//
+// Also, by using this API, you can ensure that the message
+// does not interrupts the user's current screen reader output.
+func (x *WebViewBase) Announce(MessageVar string, PriorityVar gtk.AccessibleAnnouncementPriority) {
+
+	gtk.XGtkAccessibleAnnounce(x.GoPointer(), MessageVar, PriorityVar)
+
+}
+
+// Retrieves the accessible parent for an accessible object.
+//
+// This function returns `NULL` for top level widgets.
+func (x *WebViewBase) GetAccessibleParent() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetAccessibleParent(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Retrieves the accessible role of an accessible object.
+func (x *WebViewBase) GetAccessibleRole() gtk.AccessibleRole {
+
+	cret := gtk.XGtkAccessibleGetAccessibleRole(x.GoPointer())
+	return cret
+}
+
+// Retrieves the implementation for the given accessible object.
+func (x *WebViewBase) GetAtContext() *gtk.ATContext {
+	var cls *gtk.ATContext
+
+	cret := gtk.XGtkAccessibleGetAtContext(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.ATContext{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Queries the coordinates and dimensions of this accessible
+//
+// This functionality can be overridden by `GtkAccessible`
+// implementations, e.g. to get the bounds from an ignored
+// child widget.
+func (x *WebViewBase) GetBounds(XVar *int, YVar *int, WidthVar *int, HeightVar *int) bool {
+
+	cret := gtk.XGtkAccessibleGetBounds(x.GoPointer(), XVar, YVar, WidthVar, HeightVar)
+	return cret
+}
+
+// Retrieves the first accessible child of an accessible object.
+func (x *WebViewBase) GetFirstAccessibleChild() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetFirstAccessibleChild(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Retrieves the next accessible sibling of an accessible object
+func (x *WebViewBase) GetNextAccessibleSibling() *gtk.AccessibleBase {
+	var cls *gtk.AccessibleBase
+
+	cret := gtk.XGtkAccessibleGetNextAccessibleSibling(x.GoPointer())
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &gtk.AccessibleBase{}
+	cls.Ptr = cret
+	return cls
+}
+
+// Queries a platform state, such as focus.
+//
+// This functionality can be overridden by `GtkAccessible`
+// implementations, e.g. to get platform state from an ignored
+// child widget, as is the case for `GtkText` wrappers.
+func (x *WebViewBase) GetPlatformState(StateVar gtk.AccessiblePlatformState) bool {
+
+	cret := gtk.XGtkAccessibleGetPlatformState(x.GoPointer(), StateVar)
+	return cret
+}
+
+// Resets the accessible property to its default value.
+func (x *WebViewBase) ResetProperty(PropertyVar gtk.AccessibleProperty) {
+
+	gtk.XGtkAccessibleResetProperty(x.GoPointer(), PropertyVar)
+
+}
+
+// Resets the accessible relation to its default value.
+func (x *WebViewBase) ResetRelation(RelationVar gtk.AccessibleRelation) {
+
+	gtk.XGtkAccessibleResetRelation(x.GoPointer(), RelationVar)
+
+}
+
+// Resets the accessible state to its default value.
+func (x *WebViewBase) ResetState(StateVar gtk.AccessibleState) {
+
+	gtk.XGtkAccessibleResetState(x.GoPointer(), StateVar)
+
+}
+
+// Sets the parent and sibling of an accessible object.
+//
+// This function is meant to be used by accessible implementations that are
+// not part of the widget hierarchy, and but act as a logical bridge between
+// widgets. For instance, if a widget creates an object that holds metadata
+// for each child, and you want that object to implement the `GtkAccessible`
+// interface, you will use this function to ensure that the parent of each
+// child widget is the metadata object, and the parent of each metadata
+// object is the container widget.
+func (x *WebViewBase) SetAccessibleParent(ParentVar gtk.Accessible, NextSiblingVar gtk.Accessible) {
+
+	gtk.XGtkAccessibleSetAccessibleParent(x.GoPointer(), ParentVar.GoPointer(), NextSiblingVar.GoPointer())
+
+}
+
+// Updates the next accessible sibling.
+//
+// That might be useful when a new child of a custom accessible
+// is created, and it needs to be linked to a previous child.
+func (x *WebViewBase) UpdateNextAccessibleSibling(NewSiblingVar gtk.Accessible) {
+
+	gtk.XGtkAccessibleUpdateNextAccessibleSibling(x.GoPointer(), NewSiblingVar.GoPointer())
+
+}
+
+// Informs ATs that the platform state has changed.
+//
+// This function should be used by `GtkAccessible` implementations that
+// have a platform state but are not widgets. Widgets handle platform
+// states automatically.
+func (x *WebViewBase) UpdatePlatformState(StateVar gtk.AccessiblePlatformState) {
+
+	gtk.XGtkAccessibleUpdatePlatformState(x.GoPointer(), StateVar)
+
+}
+
+// Updates a list of accessible properties.
+//
+// See the [gtk.AccessibleProperty] documentation for the
+// value types of accessible properties.
+//
+// This function should be called by `GtkWidget` types whenever
+// an accessible property change must be communicated to assistive
+// technologies.
+//
+// Example:
+//
+//	value = gtk_adjustment_get_value (adjustment);
+//	gtk_accessible_update_property (GTK_ACCESSIBLE (spin_button),
+//	                                   GTK_ACCESSIBLE_PROPERTY_VALUE_NOW, value,
+//	                                   -1);
+//
+// Deprecated: use [UpdatePropertyValue], the version of this function that language bindings are meant to use.
+func (x *WebViewBase) UpdateProperty(FirstPropertyVar gtk.AccessibleProperty, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateProperty(x.GoPointer(), FirstPropertyVar, varArgs...)
+
+}
+
+// Updates an array of accessible properties.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// property change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebViewBase) UpdatePropertyValue(NPropertiesVar int, PropertiesVar []gtk.AccessibleProperty, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdatePropertyValue(x.GoPointer(), NPropertiesVar, PropertiesVar, ValuesVar)
+
+}
+
+// Updates a list of accessible relations.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// relation change must be communicated to assistive technologies.
+//
+// If the [gtk.AccessibleRelation] requires a list of references,
+// you should pass each reference individually, followed by `NULL`, e.g.
+//
+//	gtk_accessible_update_relation (accessible,
+//	                                GTK_ACCESSIBLE_RELATION_CONTROLS,
+//	                                  ref1, NULL,
+//	                                GTK_ACCESSIBLE_RELATION_LABELLED_BY,
+//	                                  ref1, ref2, ref3, NULL,
+//	                                -1);
+//
+// Deprecated: use [UpdateRelationValue], the version of this function that language bindings are meant to use.
+func (x *WebViewBase) UpdateRelation(FirstRelationVar gtk.AccessibleRelation, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateRelation(x.GoPointer(), FirstRelationVar, varArgs...)
+
+}
+
+// Updates an array of accessible relations.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// relation change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebViewBase) UpdateRelationValue(NRelationsVar int, RelationsVar []gtk.AccessibleRelation, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdateRelationValue(x.GoPointer(), NRelationsVar, RelationsVar, ValuesVar)
+
+}
+
+// Updates a list of accessible states.
+//
+// See the [gtk.AccessibleState] documentation for the
+// value types of accessible states.
+//
+// This function should be called by `GtkWidget` types whenever
+// an accessible state change must be communicated to assistive
+// technologies.
+//
+// Example:
+//
+//	value = GTK_ACCESSIBLE_TRISTATE_MIXED;
+//	gtk_accessible_update_state (GTK_ACCESSIBLE (check_button),
+//	                             GTK_ACCESSIBLE_STATE_CHECKED, value,
+//	                             -1);
+//
+// Deprecated: use [UpdateStateValue], the version of this function that language bindings are meant to use.
+func (x *WebViewBase) UpdateState(FirstStateVar gtk.AccessibleState, varArgs ...interface{}) {
+
+	gtk.XGtkAccessibleUpdateState(x.GoPointer(), FirstStateVar, varArgs...)
+
+}
+
+// Updates an array of accessible states.
+//
+// This function should be called by `GtkWidget` types whenever an accessible
+// state change must be communicated to assistive technologies.
+//
+// This function is meant to be used by language bindings.
+func (x *WebViewBase) UpdateStateValue(NStatesVar int, StatesVar []gtk.AccessibleState, ValuesVar []gobject.Value) {
+
+	gtk.XGtkAccessibleUpdateStateValue(x.GoPointer(), NStatesVar, StatesVar, ValuesVar)
+
+}
+
+// Gets the ID of the buildable object.
+//
+// `GtkBuilder` sets the name based on the ID attribute
+// of the `<object>` tag used to construct the buildable.
+func (x *WebViewBase) GetBuildableId() string {
+
+	cret := gtk.XGtkBuildableGetBuildableId(x.GoPointer())
+	return cret
+}
+
+func init() {
+	core.SetPackageName("WEBKIT", "webkitgtk-6.0")
+	core.SetSharedLibraries("WEBKIT", []string{"libwebkitgtk-6.0.so.4"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("WEBKIT")
+	if err != nil {
+		core.SetLibraryError("WEBKIT", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("WEBKIT", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xLoadEventGLibType, libs, "webkit_load_event_get_type")
+
+	core.PuregoSafeRegister(&xUserContentInjectedFramesGLibType, libs, "webkit_user_content_injected_frames_get_type")
+
+	core.PuregoSafeRegister(&xUserScriptInjectionTimeGLibType, libs, "webkit_user_script_injection_time_get_type")
+
+	core.PuregoSafeRegister(&xUserStyleLevelGLibType, libs, "webkit_user_style_level_get_type")
+
+	core.PuregoSafeRegister(&xUserScriptGLibType, libs, "webkit_user_script_get_type")
+
+	core.PuregoRegisterSince(&xNewUserScript, libs, "webkit_user_script_new", "WEBKIT", "")
+
+	core.PuregoRegisterSince(&xUserScriptRef, libs, "webkit_user_script_ref", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserScriptUnref, libs, "webkit_user_script_unref", "WEBKIT", "")
+
+	core.PuregoSafeRegister(&xUserStyleSheetGLibType, libs, "webkit_user_style_sheet_get_type")
+
+	core.PuregoRegisterSince(&xNewUserStyleSheet, libs, "webkit_user_style_sheet_new", "WEBKIT", "")
+
+	core.PuregoRegisterSince(&xUserStyleSheetRef, libs, "webkit_user_style_sheet_ref", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserStyleSheetUnref, libs, "webkit_user_style_sheet_unref", "WEBKIT", "")
+
+	core.PuregoSafeRegister(&xSettingsGLibType, libs, "webkit_settings_get_type")
+
+	core.PuregoRegisterSince(&xNewSettings, libs, "webkit_settings_new", "WEBKIT", "")
+
+	core.PuregoRegisterSince(&xSettingsGetJavascriptCanAccessClipboard, libs, "webkit_settings_get_javascript_can_access_clipboard", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsSetJavascriptCanAccessClipboard, libs, "webkit_settings_set_javascript_can_access_clipboard", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsGetEnableDeveloperExtras, libs, "webkit_settings_get_enable_developer_extras", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsSetEnableDeveloperExtras, libs, "webkit_settings_set_enable_developer_extras", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsGetEnableJavascript, libs, "webkit_settings_get_enable_javascript", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsSetEnableJavascript, libs, "webkit_settings_set_enable_javascript", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsGetUserAgent, libs, "webkit_settings_get_user_agent", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsSetUserAgent, libs, "webkit_settings_set_user_agent", "WEBKIT", "")
+	core.PuregoRegisterSince(&xSettingsSetUserAgentWithApplicationDetails, libs, "webkit_settings_set_user_agent_with_application_details", "WEBKIT", "")
+
+	core.PuregoSafeRegister(&xUserContentManagerGLibType, libs, "webkit_user_content_manager_get_type")
+
+	core.PuregoRegisterSince(&xNewUserContentManager, libs, "webkit_user_content_manager_new", "WEBKIT", "")
+
+	core.PuregoRegisterSince(&xUserContentManagerAddScript, libs, "webkit_user_content_manager_add_script", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserContentManagerAddStyleSheet, libs, "webkit_user_content_manager_add_style_sheet", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserContentManagerRegisterScriptMessageHandler, libs, "webkit_user_content_manager_register_script_message_handler", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserContentManagerRemoveAllScripts, libs, "webkit_user_content_manager_remove_all_scripts", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserContentManagerRemoveAllStyleSheets, libs, "webkit_user_content_manager_remove_all_style_sheets", "WEBKIT", "")
+	core.PuregoRegisterSince(&xUserContentManagerUnregisterScriptMessageHandler, libs, "webkit_user_content_manager_unregister_script_message_handler", "WEBKIT", "")
+
+	core.PuregoSafeRegister(&xWebViewGLibType, libs, "webkit_web_view_get_type")
+
+	core.PuregoRegisterSince(&xNewWebView, libs, "webkit_web_view_new", "WEBKIT", "")
+
+	core.PuregoRegisterSince(&xWebViewCanGoBack, libs, "webkit_web_view_can_go_back", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewCanGoForward, libs, "webkit_web_view_can_go_forward", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewEvaluateJavascript, libs, "webkit_web_view_evaluate_javascript", "WEBKIT", "2.40")
+	core.PuregoRegisterSince(&xWebViewEvaluateJavascriptFinish, libs, "webkit_web_view_evaluate_javascript_finish", "WEBKIT", "2.40")
+	core.PuregoRegisterSince(&xWebViewGetEstimatedLoadProgress, libs, "webkit_web_view_get_estimated_load_progress", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGetSettings, libs, "webkit_web_view_get_settings", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGetTitle, libs, "webkit_web_view_get_title", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGetUri, libs, "webkit_web_view_get_uri", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGetUserContentManager, libs, "webkit_web_view_get_user_content_manager", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGetZoomLevel, libs, "webkit_web_view_get_zoom_level", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGoBack, libs, "webkit_web_view_go_back", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewGoForward, libs, "webkit_web_view_go_forward", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewIsLoading, libs, "webkit_web_view_is_loading", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewLoadHtml, libs, "webkit_web_view_load_html", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewLoadPlainText, libs, "webkit_web_view_load_plain_text", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewLoadUri, libs, "webkit_web_view_load_uri", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewReload, libs, "webkit_web_view_reload", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewSetSettings, libs, "webkit_web_view_set_settings", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewSetZoomLevel, libs, "webkit_web_view_set_zoom_level", "WEBKIT", "")
+	core.PuregoRegisterSince(&xWebViewStopLoading, libs, "webkit_web_view_stop_loading", "WEBKIT", "")
+
+	core.PuregoSafeRegister(&xWebViewBaseGLibType, libs, "webkit_web_view_base_get_type")
+
+}
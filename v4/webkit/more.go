@@ -0,0 +1,65 @@
+// Package webkit binds WebKitGTK 6, the web engine for GTK 4: the web view, its settings and the user content manager.
+//
+//	view := webkit.NewWebView()
+//	view.LoadUri("https://example.org")
+//	view.EvaluateJavascriptGo("document.title", nil, func(value interface{}, err error) {
+//		...
+//	})
+//
+// The results of scripts and the messages of pages are converted to Go values by javascriptcore.Value.GoValue.
+// A page sends messages with window.webkit.messageHandlers.<name>.postMessage(value),
+// which are received with UserContentManager.ConnectScriptMessageFunc.
+//
+// The library is optional: if it is not installed, importing the package does not fail
+// and the functions panic with a *core.MissingSymbolError.
+// core.LibraryError("WEBKIT") returns why it could not be loaded.
+package webkit
+
+import (
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/javascriptcore"
+)
+
+// asyncReadyTrampoline is the single callback given to every asynchronous call of the Go helpers
+// the Go function is looked up by the user data such that only one callback is allocated
+var asyncReadyTrampoline gio.AsyncReadyCallback = func(_ uintptr, result uintptr, id uintptr) {
+	if fn, ok := glib.TakeUserData[func(result *gio.AsyncResultBase)](id); ok {
+		fn(&gio.AsyncResultBase{Ptr: result})
+	}
+}
+
+// asyncCall stores fn until the call finishes and returns the user data to pass to the call
+func asyncCall(fn func(result *gio.AsyncResultBase)) uintptr {
+	return glib.RegisterUserData(fn)
+}
+
+// EvaluateJavascriptGo evaluates script in the main frame of the view
+// and calls fn on the main loop with the value of its last statement converted by javascriptcore.Value.GoValue.
+// The error is a *glib.Error if the script threw an exception.
+func (x *WebView) EvaluateJavascriptGo(script string, cancellable *gio.Cancellable, fn func(value interface{}, err error)) {
+	x.EvaluateJavascript(script, -1, nil, nil, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		value, err := x.EvaluateJavascriptFinish(result)
+		if err != nil {
+			fn(nil, err)
+			return
+		}
+		defer value.Unref()
+		fn(value.GoValue())
+	}))
+}
+
+// ConnectScriptMessageFunc registers the script message handler name in the default script world
+// and calls fn with the messages sent to it converted by javascriptcore.Value.GoValue.
+// It returns the ID of the signal handler, or 0 if a handler with this name is already registered.
+func (x *UserContentManager) ConnectScriptMessageFunc(name string, fn func(value interface{}, err error)) uint {
+	// the signal is connected first to not miss the messages sent right after the registration
+	id := x.ConnectScriptMessageReceivedWithDetailFunc(name, func(_ UserContentManager, value uintptr) {
+		fn(javascriptcore.ValueNewFromInternalPtr(value).GoValue())
+	})
+	if !x.RegisterScriptMessageHandler(name, nil) {
+		x.DisconnectSignal(id)
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,940 @@
+// Package xdp was automatically generated by github.com/jwijenbergh/puregotk DO NOT EDIT
+package xdp
+
+import (
+	"fmt"
+	"strings"
+	"structs"
+	"unsafe"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gobject"
+	"github.com/jwijenbergh/puregotk/v4/gobject/types"
+)
+
+// A struct that provides information about parent windows.
+//
+// The members of this struct are private to libportal and should not be accessed by applications.
+type Parent struct {
+	_ structs.HostLayout
+}
+
+var xParentGLibType func() types.GType
+
+func ParentGLibType() types.GType {
+	return xParentGLibType()
+}
+
+func (x *Parent) GoPointer() uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+var xParentCopy func(uintptr) *Parent
+
+// Copies source into a new Parent.
+func (x *Parent) Copy() *Parent {
+
+	cret := xParentCopy(x.GoPointer())
+	return cret
+}
+
+var xParentFree func(uintptr)
+
+// Frees parent.
+func (x *Parent) Free() {
+
+	xParentFree(x.GoPointer())
+
+}
+
+// Options for request_background.
+type BackgroundFlags uint32
+
+var xBackgroundFlagsGLibType func() types.GType
+
+func BackgroundFlagsGLibType() types.GType {
+	return xBackgroundFlagsGLibType()
+}
+
+const (
+
+	// No options
+	BackgroundFlagNoneValue BackgroundFlags = 0
+	// Request autostart as well
+	BackgroundFlagAutostartValue BackgroundFlags = 1
+	// Whether the application is D-Bus-activatable
+	BackgroundFlagActivatableValue BackgroundFlags = 2
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (BackgroundFlags) GLibType() types.GType {
+	return xBackgroundFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x BackgroundFlags) Has(flags BackgroundFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x BackgroundFlags) Set(flags BackgroundFlags) BackgroundFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x BackgroundFlags) Clear(flags BackgroundFlags) BackgroundFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x BackgroundFlags) String() string {
+	if x == 0 {
+		return "XDP_BACKGROUND_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&BackgroundFlagAutostartValue != 0 && x&BackgroundFlagAutostartValue == BackgroundFlagAutostartValue {
+		names = append(names, "XDP_BACKGROUND_FLAG_AUTOSTART")
+		rest &^= BackgroundFlagAutostartValue
+	}
+	if rest&BackgroundFlagActivatableValue != 0 && x&BackgroundFlagActivatableValue == BackgroundFlagActivatableValue {
+		names = append(names, "XDP_BACKGROUND_FLAG_ACTIVATABLE")
+		rest &^= BackgroundFlagActivatableValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Options for sending a notification.
+type NotificationFlags uint32
+
+var xNotificationFlagsGLibType func() types.GType
+
+func NotificationFlagsGLibType() types.GType {
+	return xNotificationFlagsGLibType()
+}
+
+const (
+
+	// No options
+	NotificationFlagNoneValue NotificationFlags = 0
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (NotificationFlags) GLibType() types.GType {
+	return xNotificationFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x NotificationFlags) Has(flags NotificationFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x NotificationFlags) Set(flags NotificationFlags) NotificationFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x NotificationFlags) Clear(flags NotificationFlags) NotificationFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x NotificationFlags) String() string {
+	if x == 0 {
+		return "XDP_NOTIFICATION_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Options for opening files.
+type OpenFileFlags uint32
+
+var xOpenFileFlagsGLibType func() types.GType
+
+func OpenFileFlagsGLibType() types.GType {
+	return xOpenFileFlagsGLibType()
+}
+
+const (
+
+	// No options
+	OpenFileFlagNoneValue OpenFileFlags = 0
+	// Allow selecting multiple files
+	OpenFileFlagMultipleValue OpenFileFlags = 1
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (OpenFileFlags) GLibType() types.GType {
+	return xOpenFileFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x OpenFileFlags) Has(flags OpenFileFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x OpenFileFlags) Set(flags OpenFileFlags) OpenFileFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x OpenFileFlags) Clear(flags OpenFileFlags) OpenFileFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x OpenFileFlags) String() string {
+	if x == 0 {
+		return "XDP_OPEN_FILE_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&OpenFileFlagMultipleValue != 0 && x&OpenFileFlagMultipleValue == OpenFileFlagMultipleValue {
+		names = append(names, "XDP_OPEN_FILE_FLAG_MULTIPLE")
+		rest &^= OpenFileFlagMultipleValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Options for opening uris.
+type OpenUriFlags uint32
+
+var xOpenUriFlagsGLibType func() types.GType
+
+func OpenUriFlagsGLibType() types.GType {
+	return xOpenUriFlagsGLibType()
+}
+
+const (
+
+	// No options
+	OpenUriFlagNoneValue OpenUriFlags = 0
+	// Use an application chooser for the given uri
+	OpenUriFlagAskValue OpenUriFlags = 1
+	// Allow writing to file (if uri points to a local file that is exported in the document portal and app is sandboxed itself)
+	OpenUriFlagWritableValue OpenUriFlags = 2
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (OpenUriFlags) GLibType() types.GType {
+	return xOpenUriFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x OpenUriFlags) Has(flags OpenUriFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x OpenUriFlags) Set(flags OpenUriFlags) OpenUriFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x OpenUriFlags) Clear(flags OpenUriFlags) OpenUriFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x OpenUriFlags) String() string {
+	if x == 0 {
+		return "XDP_OPEN_URI_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&OpenUriFlagAskValue != 0 && x&OpenUriFlagAskValue == OpenUriFlagAskValue {
+		names = append(names, "XDP_OPEN_URI_FLAG_ASK")
+		rest &^= OpenUriFlagAskValue
+	}
+	if rest&OpenUriFlagWritableValue != 0 && x&OpenUriFlagWritableValue == OpenUriFlagWritableValue {
+		names = append(names, "XDP_OPEN_URI_FLAG_WRITABLE")
+		rest &^= OpenUriFlagWritableValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Options for saving files.
+type SaveFileFlags uint32
+
+var xSaveFileFlagsGLibType func() types.GType
+
+func SaveFileFlagsGLibType() types.GType {
+	return xSaveFileFlagsGLibType()
+}
+
+const (
+
+	// No options
+	SaveFileFlagNoneValue SaveFileFlags = 0
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (SaveFileFlags) GLibType() types.GType {
+	return xSaveFileFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x SaveFileFlags) Has(flags SaveFileFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x SaveFileFlags) Set(flags SaveFileFlags) SaveFileFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x SaveFileFlags) Clear(flags SaveFileFlags) SaveFileFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x SaveFileFlags) String() string {
+	if x == 0 {
+		return "XDP_SAVE_FILE_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Options for taking screenshots.
+type ScreenshotFlags uint32
+
+var xScreenshotFlagsGLibType func() types.GType
+
+func ScreenshotFlagsGLibType() types.GType {
+	return xScreenshotFlagsGLibType()
+}
+
+const (
+
+	// No options
+	ScreenshotFlagNoneValue ScreenshotFlags = 0
+	// Allow the user to choose what to capture
+	ScreenshotFlagInteractiveValue ScreenshotFlags = 1
+)
+
+// GLibType returns the GLib type of the flags, such that gobject.Value stores them as G_TYPE_FLAGS
+func (ScreenshotFlags) GLibType() types.GType {
+	return xScreenshotFlagsGLibType()
+}
+
+// Has reports whether all bits of flags are set in x
+func (x ScreenshotFlags) Has(flags ScreenshotFlags) bool {
+	return x&flags == flags
+}
+
+// Set returns x with the bits of flags set
+func (x ScreenshotFlags) Set(flags ScreenshotFlags) ScreenshotFlags {
+	return x | flags
+}
+
+// Clear returns x with the bits of flags cleared
+func (x ScreenshotFlags) Clear(flags ScreenshotFlags) ScreenshotFlags {
+	return x &^ flags
+}
+
+// String returns the C names of the flags set in x joined by " | ", bits without a name are added as a hexadecimal number
+func (x ScreenshotFlags) String() string {
+	if x == 0 {
+		return "XDP_SCREENSHOT_FLAG_NONE"
+	}
+	var names []string
+	rest := x
+	if rest&ScreenshotFlagInteractiveValue != 0 && x&ScreenshotFlagInteractiveValue == ScreenshotFlagInteractiveValue {
+		names = append(names, "XDP_SCREENSHOT_FLAG_INTERACTIVE")
+		rest &^= ScreenshotFlagInteractiveValue
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("%#x", int(rest)))
+	}
+	return strings.Join(names, " | ")
+}
+
+// Context for portal calls.
+//
+// The XdpPortal object provides the main context object for the portal operations of libportal.
+//
+// Typically, an application will create a single XdpPortal object with new and use it throughout its lifetime.
+type Portal struct {
+	gobject.Object
+}
+
+var xPortalGLibType func() types.GType
+
+func PortalGLibType() types.GType {
+	return xPortalGLibType()
+}
+
+func PortalNewFromInternalPtr(ptr uintptr) *Portal {
+	cls := &Portal{}
+	cls.Ptr = ptr
+	return cls
+}
+
+var xNewPortal func() uintptr
+
+// Creates a new Portal object. If D-Bus is unavailable this API will abort. We recommend using [PortalInitableNew] to safely handle this failure.
+func NewPortal() *Portal {
+	var cls *Portal
+
+	cret := xNewPortal()
+
+	if cret == 0 {
+		return nil
+	}
+	cls = &Portal{}
+	cls.Ptr = cret
+	return cls
+}
+
+var xPortalInitableNew func() uintptr
+
+// Creates a new Portal object.
+func PortalInitableNew() (*Portal, error) {
+	var cls *Portal
+	var cerr *glib.Error
+
+	cret := xPortalInitableNew()
+
+	if cret == 0 {
+		if cerr == nil {
+			return nil, core.PlatformError
+		}
+		return nil, cerr
+	}
+	cls = &Portal{}
+	cls.Ptr = cret
+	if cerr == nil {
+		return cls, core.PlatformError
+	}
+	return cls, cerr
+
+}
+
+var xPortalAddNotification func(uintptr, string, *glib.Variant, NotificationFlags, uintptr, uintptr, uintptr)
+
+// Sends a desktop notification.
+//
+// The following keys may be present in notification:
+//
+// - title `s`: a user-visible string to display as title
+// - body `s`: a user-visible string to display as body
+// - icon `v`: a serialized icon (in the format produced by [gio.Icon.Serialize])
+// - priority `s`: "low", "normal", "high" or "urgent"
+// - default-action `s`: name of an action that will be activated when the user clicks on the notification
+// - default-action-target `v`: target parameter to send along when activating the default action.
+// - buttons `aa{sv}`: array of serialized buttons
+//
+// Each serialized button is a dictionary with the following supported keys:
+//
+// - label `s`: user-visible label for the button. Mandatory
+// - action `s`: name of an action that will be activated when the user clicks on the button. Mandatory
+// - target `v`: target parameter to send along when activating the button
+//
+// Actions with a prefix of "app." are assumed to be exported by the application and will be activated via the org.freedesktop.Application interface, others are activated by emitting the Portal::notification-action-invoked signal.
+//
+// It is the callers responsibility to ensure that the ID is unique among all notifications.
+//
+// To withdraw a notification, use remove_notification.
+func (x *Portal) AddNotification(IdVar string, NotificationVar *glib.Variant, FlagsVar NotificationFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, DataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xPortalAddNotification(x.GoPointer(), IdVar, NotificationVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, DataVar)
+
+}
+
+var xPortalAddNotificationFinish func(uintptr, uintptr, **glib.Error) bool
+
+// Finishes the notification request.
+//
+// Returns the result as a boolean.
+func (x *Portal) AddNotificationFinish(ResultVar gio.AsyncResult) (bool, error) {
+	var cerr *glib.Error
+
+	cret := xPortalAddNotificationFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalOpenFile func(uintptr, *Parent, string, *glib.Variant, *glib.Variant, *glib.Variant, OpenFileFlags, uintptr, uintptr, uintptr)
+
+// Asks the user to open one or more files.
+//
+// The format for the filters argument is `a(sa(us))`. Each item in the array specifies a single filter to offer to the user. The first string is a user-visible name for the filter. The `a(us)` specifies a list of filter strings, which can be either a glob pattern (indicated by 0) or a mimetype (indicated by 1).
+//
+// Example: `[('Images', [(0, '*.ico'), (1, 'image/png')]), ('Text', [(0, '*.txt')])]`
+//
+// The format for the choices argument is `a(ssa(ss)s)`. For each element, the first string is an ID that will be returned with the response, the second string is a user-visible label. The `a(ss)` is the list of choices, each being a is an ID and a user-visible label. The final string is the initial selection, or `""`, to let the portal decide which choice will be initially selected. None of the strings, except for the initial selection, should be empty.
+//
+// As a special case, passing an empty array for the list of choices indicates a boolean choice that is typically displayed as a check button, using `"true"` and `"false"` as the choices.
+//
+// Example: `[('encoding', 'Encoding', [('utf8', 'Unicode (UTF-8)'), ('latin15', 'Western')], 'latin15'), ('reencode', 'Reencode', [], 'false')]`
+//
+// When the request is done, callback will be called. You can then call open_file_finish to get the results.
+func (x *Portal) OpenFile(ParentVar *Parent, TitleVar string, FiltersVar *glib.Variant, CurrentFilterVar *glib.Variant, ChoicesVar *glib.Variant, FlagsVar OpenFileFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, DataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xPortalOpenFile(x.GoPointer(), ParentVar, TitleVar, FiltersVar, CurrentFilterVar, ChoicesVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, DataVar)
+
+}
+
+var xPortalOpenFileFinish func(uintptr, uintptr, **glib.Error) *glib.Variant
+
+// Finishes the open-file request
+//
+// Returns the result in the form of a [glib.Variant] dictionary containing the following fields:
+//
+// - uris `as`: an array of strings containing the uris of selected files
+// - choices `a(ss)`: an array of pairs of strings, the first string being the ID of a combobox that was passed into this call, the second string being the selected option.
+func (x *Portal) OpenFileFinish(ResultVar gio.AsyncResult) (*glib.Variant, error) {
+	var cerr *glib.Error
+
+	cret := xPortalOpenFileFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalOpenUri func(uintptr, *Parent, string, OpenUriFlags, uintptr, uintptr, uintptr)
+
+// Opens uri with an external handler.
+func (x *Portal) OpenUri(ParentVar *Parent, UriVar string, FlagsVar OpenUriFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, DataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xPortalOpenUri(x.GoPointer(), ParentVar, UriVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, DataVar)
+
+}
+
+var xPortalOpenUriFinish func(uintptr, uintptr, **glib.Error) bool
+
+// Finishes the open-uri request.
+//
+// Returns the result in the form of a boolean.
+func (x *Portal) OpenUriFinish(ResultVar gio.AsyncResult) (bool, error) {
+	var cerr *glib.Error
+
+	cret := xPortalOpenUriFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalRemoveNotification func(uintptr, string)
+
+// Withdraws a desktop notification.
+func (x *Portal) RemoveNotification(IdVar string) {
+
+	xPortalRemoveNotification(x.GoPointer(), IdVar)
+
+}
+
+var xPortalRequestBackground func(uintptr, *Parent, uintptr, uintptr, BackgroundFlags, uintptr, uintptr, uintptr)
+
+// Requests background permissions.
+//
+// When the request is done, callback will be called. You can then call request_background_finish to get the results.
+func (x *Portal) RequestBackground(ParentVar *Parent, ReasonVar *string, CommandlineVar uintptr, FlagsVar BackgroundFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, UserDataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	ReasonVarPtr := core.GStrdupNullable(ReasonVar)
+	defer core.GFreeNullable(ReasonVarPtr)
+
+	xPortalRequestBackground(x.GoPointer(), ParentVar, ReasonVarPtr, CommandlineVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, UserDataVar)
+
+}
+
+var xPortalRequestBackgroundFinish func(uintptr, uintptr, **glib.Error) bool
+
+// Finishes the request.
+//
+// Returns true if successful.
+func (x *Portal) RequestBackgroundFinish(ResultVar gio.AsyncResult) (bool, error) {
+	var cerr *glib.Error
+
+	cret := xPortalRequestBackgroundFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalSaveFile func(uintptr, *Parent, string, uintptr, uintptr, uintptr, *glib.Variant, *glib.Variant, *glib.Variant, SaveFileFlags, uintptr, uintptr, uintptr)
+
+// Asks the user for a location to save a file.
+//
+// The format for the filters argument is the same as for open_file.
+//
+// The format for the choices argument is the same as for open_file.
+//
+// When the request is done, callback will be called. You can then call save_file_finish to get the results.
+func (x *Portal) SaveFile(ParentVar *Parent, TitleVar string, CurrentNameVar *string, CurrentFolderVar *string, CurrentFileVar *string, FiltersVar *glib.Variant, CurrentFilterVar *glib.Variant, ChoicesVar *glib.Variant, FlagsVar SaveFileFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, DataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	CurrentNameVarPtr := core.GStrdupNullable(CurrentNameVar)
+	defer core.GFreeNullable(CurrentNameVarPtr)
+
+	CurrentFolderVarPtr := core.GStrdupNullable(CurrentFolderVar)
+	defer core.GFreeNullable(CurrentFolderVarPtr)
+
+	CurrentFileVarPtr := core.GStrdupNullable(CurrentFileVar)
+	defer core.GFreeNullable(CurrentFileVarPtr)
+
+	xPortalSaveFile(x.GoPointer(), ParentVar, TitleVar, CurrentNameVarPtr, CurrentFolderVarPtr, CurrentFileVarPtr, FiltersVar, CurrentFilterVar, ChoicesVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, DataVar)
+
+}
+
+var xPortalSaveFileFinish func(uintptr, uintptr, **glib.Error) *glib.Variant
+
+// Finishes the save-file request.
+//
+// Returns the result in the form of a [glib.Variant] dictionary containing the following fields:
+//
+// - uris `(as)`: an array of strings containing the uri of the selected file
+// - choices `a(ss)`: an array of pairs of strings, the first string being the ID of a combobox that was passed into this call, the second string being the selected option.
+func (x *Portal) SaveFileFinish(ResultVar gio.AsyncResult) (*glib.Variant, error) {
+	var cerr *glib.Error
+
+	cret := xPortalSaveFileFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalTakeScreenshot func(uintptr, *Parent, ScreenshotFlags, uintptr, uintptr, uintptr)
+
+// Takes a screenshot.
+//
+// When the request is done, callback will be called. You can then call take_screenshot_finish to get the results.
+func (x *Portal) TakeScreenshot(ParentVar *Parent, FlagsVar ScreenshotFlags, CancellableVar *gio.Cancellable, CallbackVar *gio.AsyncReadyCallback, DataVar uintptr) {
+
+	var CallbackVarRef uintptr
+	if CallbackVar != nil {
+		CallbackVarPtr := uintptr(unsafe.Pointer(CallbackVar))
+		if cbRefPtr, ok := glib.GetCallback(CallbackVarPtr); ok {
+			CallbackVarRef = cbRefPtr
+		} else {
+			fcb := func(arg0 uintptr, arg1 uintptr, arg2 uintptr) {
+				defer core.RecoverPanic()
+				cbFn := *CallbackVar
+				cbFn(arg0, arg1, arg2)
+			}
+			CallbackVarRef = core.NewCallback(fcb)
+			CallbackVarRef = glib.SaveCallbackWithClosure(CallbackVarPtr, CallbackVarRef, CallbackVar)
+		}
+	}
+
+	xPortalTakeScreenshot(x.GoPointer(), ParentVar, FlagsVar, CancellableVar.GoPointer(), CallbackVarRef, DataVar)
+
+}
+
+var xPortalTakeScreenshotFinish func(uintptr, uintptr, **glib.Error) string
+
+// Finishes a screenshot request.
+//
+// Returns the result in the form of a URI pointing to an image file.
+func (x *Portal) TakeScreenshotFinish(ResultVar gio.AsyncResult) (string, error) {
+	var cerr *glib.Error
+
+	cret := xPortalTakeScreenshotFinish(x.GoPointer(), ResultVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+func (c *Portal) GoPointer() uintptr {
+	if c == nil {
+		return 0
+	}
+	return c.Ptr
+}
+
+func (c *Portal) SetGoPointer(ptr uintptr) {
+	c.Ptr = ptr
+}
+
+// Emitted when a non-exported action is activated on a notification.
+//
+// Deprecated: use ConnectNotificationActionInvokedFunc, which also accepts method values and closures.
+func (x *Portal) ConnectNotificationActionInvoked(cb *func(Portal, string, string, uintptr)) uint {
+	cbPtr := uintptr(unsafe.Pointer(cb))
+	cbRefPtr, ok := glib.AcquireSignalCallback(cbPtr)
+	if !ok {
+		fcb := func(clsPtr uintptr, IdVarp string, ActionVarp string, ParameterVarp uintptr) {
+			defer core.RecoverPanic()
+			fa := Portal{}
+			fa.Ptr = clsPtr
+			cbFn := *cb
+
+			cbFn(fa, IdVarp, ActionVarp, ParameterVarp)
+
+		}
+		cbRefPtr = glib.SaveSignalCallback(cbPtr, core.NewCallback(fcb), cb)
+	}
+	return gobject.SignalConnectCallback(x.GoPointer(), "notification-action-invoked", cbRefPtr, cbPtr)
+}
+
+var xPortalNotificationActionInvokedTrampoline uintptr
+
+func xPortalNotificationActionInvokedNewTrampoline() interface{} {
+	return func(clsPtr uintptr, IdVarp string, ActionVarp string, ParameterVarp uintptr, data uintptr) {
+		defer core.RecoverPanic()
+		cbFn, ok := gobject.SignalFunc(data).(func(Portal, string, string, uintptr))
+		if !ok {
+			// the handler was disconnected while the signal was emitted
+			return
+		}
+		fa := Portal{}
+		fa.Ptr = clsPtr
+
+		cbFn(fa, IdVarp, ActionVarp, ParameterVarp)
+
+	}
+}
+
+// ConnectNotificationActionInvokedFunc connects cb to the "notification-action-invoked" signal.
+// cb can be any function, e.g. a method value or a closure, it is released when the handler is disconnected
+// or the instance is finalized.
+func (x *Portal) ConnectNotificationActionInvokedFunc(cb func(Portal, string, string, uintptr)) uint {
+	return gobject.SignalConnectFunc(x.GoPointer(), "notification-action-invoked", &xPortalNotificationActionInvokedTrampoline, xPortalNotificationActionInvokedNewTrampoline, cb)
+}
+
+// Initializes the object implementing the interface.
+//
+// This method is intended for language bindings. If writing in C,
+// g_initable_new() should typically be used instead.
+//
+// The object must be initialized before any real use after initial
+// construction, either with this function or [gio.AsyncInitable.InitAsync].
+//
+// Implementations may also support cancellation. If cancellable is not nil,
+// then initialization can be cancelled by triggering the cancellable object
+// from another thread. If the operation was cancelled, the error
+// [gio.GIoErrorCancelledValue] will be returned. If cancellable is not nil and
+// the object doesn't support cancellable initialization the error
+// [gio.GIoErrorNotSupportedValue] will be returned.
+//
+// If the object is not initialized, or initialization returns with an
+// error, then all operations on the object except [gobject.Object.Ref] and
+// [gobject.Object.Unref] are considered to be invalid, and have undefined
+// behaviour. See the [description][iface@Gio.Initable#description] for more details.
+//
+// Callers should not assume that a class which implements [gio.Initable] can be
+// initialized multiple times, unless the class explicitly documents itself as
+// supporting this. Generally, a class’ implementation of init() can assume
+// (and assert) that it will only be called once. Previously, this documentation
+// recommended all [gio.Initable] implementations should be idempotent; that
+// recommendation was relaxed in GLib 2.54.
+//
+// If a class explicitly supports being initialized multiple times, it is
+// recommended that the method is idempotent: multiple calls with the same
+// arguments should return the same results. Only the first call initializes
+// the object; further calls return the result of the first call.
+//
+// One reason why a class might need to support idempotent initialization is if
+// it is designed to be used via the singleton pattern, with a
+// [gobject.ObjectClass].constructor that sometimes returns an existing instance.
+// In this pattern, a caller would expect to be able to call [gio.Initable.Init]
+// on the result of [gobject.NewObject], regardless of whether it is in fact a new
+// instance.
+func (x *Portal) Init(CancellableVar *gio.Cancellable) (bool, error) {
+	var cerr *glib.Error
+
+	cret := gio.XGInitableInit(x.GoPointer(), CancellableVar.GoPointer(), &cerr)
+	if cerr == nil {
+		return cret, core.PlatformError
+	}
+	return cret, cerr
+
+}
+
+var xPortalRunningUnderFlatpak func() bool
+
+// Detects if running inside of a Flatpak or WebKit sandbox.
+//
+// See also: running_under_sandbox.
+func PortalRunningUnderFlatpak() bool {
+
+	cret := xPortalRunningUnderFlatpak()
+	return cret
+}
+
+var xPortalRunningUnderSandbox func() bool
+
+// This function tries to determine if the current process is running under a sandbox that requires the use of portals.
+//
+// If you need to check error conditions see running_under_snap.
+//
+// Note that these functions are all cached and will always return the same result for the current process.
+func PortalRunningUnderSandbox() bool {
+
+	cret := xPortalRunningUnderSandbox()
+	return cret
+}
+
+func init() {
+	core.SetPackageName("XDP", "libportal")
+	core.SetSharedLibraries("XDP", []string{"libportal.so.1"})
+	var libs []uintptr
+	libPaths, err := core.LookupPaths("XDP")
+	if err != nil {
+		core.SetLibraryError("XDP", err)
+	}
+	for _, libPath := range libPaths {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			core.SetLibraryError("XDP", err)
+			continue
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xBackgroundFlagsGLibType, libs, "xdp_background_flags_get_type")
+
+	core.PuregoSafeRegister(&xNotificationFlagsGLibType, libs, "xdp_notification_flags_get_type")
+
+	core.PuregoSafeRegister(&xOpenFileFlagsGLibType, libs, "xdp_open_file_flags_get_type")
+
+	core.PuregoSafeRegister(&xOpenUriFlagsGLibType, libs, "xdp_open_uri_flags_get_type")
+
+	core.PuregoSafeRegister(&xSaveFileFlagsGLibType, libs, "xdp_save_file_flags_get_type")
+
+	core.PuregoSafeRegister(&xScreenshotFlagsGLibType, libs, "xdp_screenshot_flags_get_type")
+
+	core.PuregoSafeRegister(&xParentGLibType, libs, "xdp_parent_get_type")
+
+	core.PuregoRegisterSince(&xParentCopy, libs, "xdp_parent_copy", "XDP", "0.7")
+	core.PuregoRegisterSince(&xParentFree, libs, "xdp_parent_free", "XDP", "0.7")
+
+	core.PuregoSafeRegister(&xPortalGLibType, libs, "xdp_portal_get_type")
+
+	core.PuregoRegisterSince(&xNewPortal, libs, "xdp_portal_new", "XDP", "")
+	core.PuregoRegisterSince(&xPortalInitableNew, libs, "xdp_portal_initable_new", "XDP", "0.7")
+
+	core.PuregoRegisterSince(&xPortalAddNotification, libs, "xdp_portal_add_notification", "XDP", "")
+	core.PuregoRegisterSince(&xPortalAddNotificationFinish, libs, "xdp_portal_add_notification_finish", "XDP", "")
+	core.PuregoRegisterSince(&xPortalOpenFile, libs, "xdp_portal_open_file", "XDP", "")
+	core.PuregoRegisterSince(&xPortalOpenFileFinish, libs, "xdp_portal_open_file_finish", "XDP", "")
+	core.PuregoRegisterSince(&xPortalOpenUri, libs, "xdp_portal_open_uri", "XDP", "")
+	core.PuregoRegisterSince(&xPortalOpenUriFinish, libs, "xdp_portal_open_uri_finish", "XDP", "")
+	core.PuregoRegisterSince(&xPortalRemoveNotification, libs, "xdp_portal_remove_notification", "XDP", "")
+	core.PuregoRegisterSince(&xPortalRequestBackground, libs, "xdp_portal_request_background", "XDP", "")
+	core.PuregoRegisterSince(&xPortalRequestBackgroundFinish, libs, "xdp_portal_request_background_finish", "XDP", "")
+	core.PuregoRegisterSince(&xPortalSaveFile, libs, "xdp_portal_save_file", "XDP", "")
+	core.PuregoRegisterSince(&xPortalSaveFileFinish, libs, "xdp_portal_save_file_finish", "XDP", "")
+	core.PuregoRegisterSince(&xPortalTakeScreenshot, libs, "xdp_portal_take_screenshot", "XDP", "")
+	core.PuregoRegisterSince(&xPortalTakeScreenshotFinish, libs, "xdp_portal_take_screenshot_finish", "XDP", "")
+
+	core.PuregoRegisterSince(&xPortalRunningUnderFlatpak, libs, "xdp_portal_running_under_flatpak", "XDP", "0.7")
+	core.PuregoRegisterSince(&xPortalRunningUnderSandbox, libs, "xdp_portal_running_under_sandbox", "XDP", "0.7")
+
+}
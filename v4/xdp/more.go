@@ -0,0 +1,146 @@
+// Package xdp binds libportal, the client library of the XDG desktop portals,
+// which give sandboxed applications, e.g. in a Flatpak, access to files, screenshots and background permissions.
+//
+//	portal := xdp.NewPortal()
+//	parent := xdpgtk4.ParentNewGtk(window)
+//	portal.OpenFileGo(parent, "Open", xdp.OpenFileFlagMultipleValue, nil, func(uris []string, err error) {
+//		parent.Free()
+//		...
+//	})
+//
+// The parent of a GTK window is created by the xdpgtk4 package, nil is passed if there is no window.
+//
+// The library is optional: if it is not installed, importing the package does not fail
+// and the functions panic with a *core.MissingSymbolError.
+// core.LibraryError("XDP") returns why it could not be loaded.
+package xdp
+
+import (
+	"fmt"
+
+	"github.com/jwijenbergh/puregotk/pkg/core"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+)
+
+// asyncReadyTrampoline is the single callback given to every asynchronous call of the Go helpers
+// the Go function is looked up by the user data such that only one callback is allocated
+var asyncReadyTrampoline gio.AsyncReadyCallback = func(_ uintptr, result uintptr, id uintptr) {
+	if fn, ok := glib.TakeUserData[func(result *gio.AsyncResultBase)](id); ok {
+		fn(&gio.AsyncResultBase{Ptr: result})
+	}
+}
+
+// asyncCall stores fn until the call finishes and returns the user data to pass to the call
+func asyncCall(fn func(result *gio.AsyncResultBase)) uintptr {
+	return glib.RegisterUserData(fn)
+}
+
+// resultValue converts the a{sv} result of a portal request to a map and releases it
+func resultValue(v *glib.Variant, err error) (map[string]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer v.Unref()
+	value, err := v.GoValue()
+	if err != nil {
+		return nil, err
+	}
+	ret, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("xdp: unexpected result of type %s", v.GetTypeString())
+	}
+	return ret, nil
+}
+
+// resultURIs returns the "uris" of the result of a file chooser request
+func resultURIs(v *glib.Variant, err error) ([]string, error) {
+	ret, err := resultValue(v, err)
+	if err != nil {
+		return nil, err
+	}
+	uris, _ := ret["uris"].([]string)
+	return uris, nil
+}
+
+// OpenFileGo asks the user to choose files and calls fn with their URIs on the main loop.
+// The error is a *glib.Error with gio.GIoErrorCancelledValue if the user closed the dialog without choosing.
+func (x *Portal) OpenFileGo(parent *Parent, title string, flags OpenFileFlags, cancellable *gio.Cancellable, fn func(uris []string, err error)) {
+	x.OpenFile(parent, title, nil, nil, nil, flags, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(resultURIs(x.OpenFileFinish(result)))
+	}))
+}
+
+// SaveFileGo asks the user for a location to save a file, currentName is the suggested name or empty,
+// and calls fn with the chosen URI on the main loop, see OpenFileGo.
+func (x *Portal) SaveFileGo(parent *Parent, title string, currentName string, flags SaveFileFlags, cancellable *gio.Cancellable, fn func(uri string, err error)) {
+	var name *string
+	if currentName != "" {
+		name = &currentName
+	}
+	x.SaveFile(parent, title, name, nil, nil, nil, nil, nil, flags, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		uris, err := resultURIs(x.SaveFileFinish(result))
+		if len(uris) == 0 {
+			fn("", err)
+			return
+		}
+		fn(uris[0], err)
+	}))
+}
+
+// TakeScreenshotGo takes a screenshot and calls fn with the URI of the image on the main loop, see OpenFileGo.
+func (x *Portal) TakeScreenshotGo(parent *Parent, flags ScreenshotFlags, cancellable *gio.Cancellable, fn func(uri string, err error)) {
+	x.TakeScreenshot(parent, flags, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(x.TakeScreenshotFinish(result))
+	}))
+}
+
+var (
+	xPtrArrayNewWithFreeFunc func(uintptr) uintptr
+	xPtrArrayAdd             func(uintptr, uintptr)
+	xPtrArrayUnref           func(uintptr)
+	xdpFree                  uintptr
+)
+
+// RequestBackgroundGo requests to run in the background and calls fn on the main loop with whether it was granted.
+// commandline is the command to start the application with if flags has BackgroundFlagAutostartValue,
+// nil uses the command of the Flatpak.
+func (x *Portal) RequestBackgroundGo(parent *Parent, reason string, commandline []string, flags BackgroundFlags, cancellable *gio.Cancellable, fn func(granted bool, err error)) {
+	var array uintptr
+	if commandline != nil {
+		// the portal copies the array, the strings are released with it
+		array = xPtrArrayNewWithFreeFunc(xdpFree)
+		for _, arg := range commandline {
+			xPtrArrayAdd(array, core.GStrdup(arg))
+		}
+		defer xPtrArrayUnref(array)
+	}
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+	x.RequestBackground(parent, reasonPtr, array, flags, cancellable, &asyncReadyTrampoline, asyncCall(func(result *gio.AsyncResultBase) {
+		fn(x.RequestBackgroundFinish(result))
+	}))
+}
+
+func init() {
+	var libs []uintptr
+	for _, libPath := range core.GetPaths("GLIB") {
+		lib, err := core.Dlopen(libPath, core.RTLD_NOW|core.RTLD_GLOBAL)
+		if err != nil {
+			panic(err)
+		}
+		libs = append(libs, lib)
+	}
+
+	core.PuregoSafeRegister(&xPtrArrayNewWithFreeFunc, libs, "g_ptr_array_new_with_free_func")
+	core.PuregoSafeRegister(&xPtrArrayAdd, libs, "g_ptr_array_add")
+	core.PuregoSafeRegister(&xPtrArrayUnref, libs, "g_ptr_array_unref")
+	for _, lib := range libs {
+		if ptr, err := core.Dlsym(lib, "g_free"); err == nil {
+			xdpFree = ptr
+			break
+		}
+	}
+}